@@ -0,0 +1,139 @@
+// Package asset looks up files embedded in a game by ID. A manifest maps each
+// ID, such as "avatars/f_01_s", to a file, images are decoded the first time
+// they are asked for and the decoded image is shared from then on.
+package asset
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/png" // required to decode png assets
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// ErrMissing is wrapped by the error returned when an ID is not in the manifest
+var ErrMissing = errors.New("missing asset")
+
+// Manifest maps asset IDs to the paths of files within a file system
+type Manifest map[string]string
+
+// Registry holds the manifest along with every image decoded so far
+type Registry struct {
+	fsys     fs.FS
+	manifest Manifest
+
+	mu     sync.Mutex
+	images map[string]*ebiten.Image
+}
+
+// Load reads the manifest at path from fsys. Every file named in the
+// manifest must exist in fsys, the error lists each one which does not
+func Load(fsys fs.FS, path string) (*Registry, error) {
+	b, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read asset manifest: %v", err)
+	}
+
+	manifest := Manifest{}
+	if err := json.Unmarshal(b, &manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse asset manifest %s: %v", path, err)
+	}
+
+	return New(fsys, manifest)
+}
+
+// New makes a registry from a manifest which has already been read
+func New(fsys fs.FS, manifest Manifest) (*Registry, error) {
+	errs := []string{}
+	for _, id := range manifest.IDs() {
+		if _, err := fs.Stat(fsys, manifest[id]); err != nil {
+			errs = append(errs, fmt.Sprintf("asset %q: file %s is missing", id, manifest[id]))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid asset manifest:\n  %s", strings.Join(errs, "\n  "))
+	}
+
+	return &Registry{
+		fsys:     fsys,
+		manifest: manifest,
+		images:   map[string]*ebiten.Image{},
+	}, nil
+}
+
+// IDs returns every ID in the manifest in order
+func (m Manifest) IDs() []string {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// IDs returns every asset ID in order
+func (r *Registry) IDs() []string {
+	return r.manifest.IDs()
+}
+
+// Has reports whether id is in the manifest
+func (r *Registry) Has(id string) bool {
+	_, ok := r.manifest[id]
+	return ok
+}
+
+// Bytes returns the contents of the file for id
+func (r *Registry) Bytes(id string) ([]byte, error) {
+	path, ok := r.manifest[id]
+	if !ok {
+		return nil, fmt.Errorf("asset %q: %w", id, ErrMissing)
+	}
+	b, err := fs.ReadFile(r.fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("asset %q: %v", id, err)
+	}
+	return b, nil
+}
+
+// Decode decodes the image for id, the result is not cached so Image should
+// be preferred unless the pixels are needed
+func (r *Registry) Decode(id string) (image.Image, error) {
+	b, err := r.Bytes(id)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("asset %q: unable to decode %s: %v", id, r.manifest[id], err)
+	}
+	return img, nil
+}
+
+// Image returns the image for id, it is decoded on first use and the same
+// *ebiten.Image is returned every time after that
+func (r *Registry) Image(id string) (*ebiten.Image, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if img, ok := r.images[id]; ok {
+		return img, nil
+	}
+
+	src, err := r.Decode(id)
+	if err != nil {
+		return nil, err
+	}
+	img, err := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	if err != nil {
+		return nil, fmt.Errorf("asset %q: %v", id, err)
+	}
+	r.images[id] = img
+	return img, nil
+}
@@ -0,0 +1,392 @@
+// Command assetgen checks the images and sounds in a resources directory,
+// makes any sized variants which are missing, then writes the asset manifest
+// and the embed directive which carries the files into the game.
+//
+// It is run with go generate from the resources package and exits with a
+// non-zero status if any check fails, in which case nothing is written.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// thumbnailSize is the width and height of the "_s" variant of an image
+const thumbnailSize = 100
+
+// extensions are the file types which are embedded, anything else in an
+// asset directory is reported
+var extensions = map[string]bool{".png": true, ".wav": true, ".ogg": true}
+
+// validName is the naming convention for asset files
+var validName = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*\.(png|wav|ogg)$`)
+
+// asset is a file found in the resources directory
+type asset struct {
+	id   string // path without the extension, such as "avatars/f_01_s"
+	path string // path relative to the resources directory
+	ext  string
+	img  image.Image // decoded image, nil for sounds
+
+	variantOf string // id of the source image if this is a variant
+}
+
+func main() {
+	dir := flag.String("dir", ".", "resources directory to check")
+	config := flag.String("variants", "variants.json", "file listing the variants to make of each image, relative to -dir")
+	pkg := flag.String("pkg", "resources", "package name of the generated embed file")
+	force := flag.Bool("force", false, "remake variants which already exist")
+	check := flag.Bool("check", false, "only run the checks, write nothing")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("assetgen: ")
+
+	variants, err := loadVariants(filepath.Join(*dir, *config))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	assets, errs := scan(os.DirFS(*dir))
+	findVariants(assets, variants)
+	errs = append(errs, checkNames(assets)...)
+	errs = append(errs, checkVariants(assets, variants)...)
+	errs = append(errs, checkDuplicates(assets)...)
+
+	if len(errs) > 0 {
+		for _, e := range errs {
+			log.Println(e)
+		}
+		log.Fatalf("%d problems found", len(errs))
+	}
+	if *check {
+		return
+	}
+
+	made, err := makeVariants(*dir, assets, variants, *force)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, a := range made {
+		fmt.Printf("made %s\n", a.path)
+		assets[a.id] = a
+	}
+
+	if err := writeManifest(*dir, assets); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeEmbed(*dir, *pkg, assets); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadVariants reads the variants file, it maps an id pattern such as
+// "avatars/*" to the suffixes of the variants wanted for matching images. A
+// suffix is either "s" for a thumbnail or a width in pixels
+func loadVariants(file string) (map[string][]string, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	variants := map[string][]string{}
+	if err := json.Unmarshal(b, &variants); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", file, err)
+	}
+	for pattern, suffixes := range variants {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("%s: bad pattern %q: %v", file, pattern, err)
+		}
+		for _, suffix := range suffixes {
+			if _, err := variantSize(suffix, 1, 1); err != nil {
+				return nil, fmt.Errorf("%s: pattern %q: %v", file, pattern, err)
+			}
+		}
+	}
+	return variants, nil
+}
+
+// scan finds every asset in the subdirectories of fsys and decodes the images
+func scan(fsys fs.FS) (map[string]*asset, []string) {
+	assets := map[string]*asset{}
+	errs := []string{}
+
+	fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			errs = append(errs, err.Error())
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") && p != "." {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		// files at the top level are the package itself, not assets
+		if d.IsDir() || !strings.Contains(p, "/") {
+			return nil
+		}
+
+		ext := path.Ext(p)
+		if !extensions[strings.ToLower(ext)] {
+			errs = append(errs, fmt.Sprintf("%s: unsupported file type", p))
+			return nil
+		}
+
+		a := &asset{id: strings.TrimSuffix(p, ext), path: p, ext: strings.ToLower(ext)}
+		if other, ok := assets[a.id]; ok {
+			errs = append(errs, fmt.Sprintf("%s: has the same asset ID as %s", p, other.path))
+			return nil
+		}
+
+		if a.ext == ".png" {
+			f, err := fsys.Open(p)
+			if err != nil {
+				errs = append(errs, err.Error())
+				return nil
+			}
+			a.img, err = png.Decode(f)
+			f.Close()
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: unable to decode: %v", p, err))
+				return nil
+			}
+		}
+
+		assets[a.id] = a
+		return nil
+	})
+
+	return assets, errs
+}
+
+// suffixesFor returns the variant suffixes wanted for an image
+func suffixesFor(id string, variants map[string][]string) []string {
+	suffixes := []string{}
+	for _, pattern := range sortedKeys(variants) {
+		if ok, _ := path.Match(pattern, id); ok {
+			suffixes = append(suffixes, variants[pattern]...)
+		}
+	}
+	return suffixes
+}
+
+// findVariants marks each image which is a variant of another image
+func findVariants(assets map[string]*asset, variants map[string][]string) {
+	for _, a := range assets {
+		if a.img == nil {
+			continue
+		}
+		for _, suffix := range suffixesFor(a.id, variants) {
+			if v, ok := assets[a.id+"_"+suffix]; ok && v.img != nil {
+				v.variantOf = a.id
+			}
+		}
+	}
+}
+
+// checkNames reports files which don't follow the naming convention
+func checkNames(assets map[string]*asset) []string {
+	errs := []string{}
+	for _, id := range sortedKeys(assets) {
+		a := assets[id]
+		if !validName.MatchString(path.Base(a.path)) {
+			errs = append(errs, fmt.Sprintf("%s: name must be lower case letters, digits and underscores with a lower case extension", a.path))
+		}
+	}
+	return errs
+}
+
+// variantSize returns the size of a variant of a w x h image
+func variantSize(suffix string, w, h int) (image.Point, error) {
+	if suffix == "s" {
+		if w != h {
+			return image.Point{}, fmt.Errorf("thumbnails can only be made of square images, not %dx%d", w, h)
+		}
+		return image.Pt(thumbnailSize, thumbnailSize), nil
+	}
+	width, err := strconv.Atoi(suffix)
+	if err != nil || width <= 0 {
+		return image.Point{}, fmt.Errorf("variant suffix %q is neither \"s\" nor a width", suffix)
+	}
+	return image.Pt(width, (width*h+w/2)/w), nil
+}
+
+// checkVariants checks the size of every variant against its source image and
+// reports images which look like variants but have no source
+func checkVariants(assets map[string]*asset, variants map[string][]string) []string {
+	errs := []string{}
+	for _, id := range sortedKeys(assets) {
+		a := assets[id]
+		if a.img == nil {
+			continue
+		}
+
+		if a.variantOf == "" {
+			if strings.HasSuffix(a.id, "_s") {
+				errs = append(errs, fmt.Sprintf("%s: thumbnail has no source image %s", a.path, strings.TrimSuffix(a.id, "_s")))
+			}
+			continue
+		}
+
+		src := assets[a.variantOf]
+		sb := src.img.Bounds()
+		want, err := variantSize(strings.TrimPrefix(a.id, src.id+"_"), sb.Dx(), sb.Dy())
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", src.path, err))
+			continue
+		}
+		got := a.img.Bounds().Size()
+		if got.X != want.X || abs(got.Y-want.Y) > 1 {
+			errs = append(errs, fmt.Sprintf("%s: is %dx%d, expected %dx%d from %s", a.path, got.X, got.Y, want.X, want.Y, src.path))
+		}
+	}
+	return errs
+}
+
+// checkDuplicates reports images with the same pixels as another image
+func checkDuplicates(assets map[string]*asset) []string {
+	seen := map[[sha256.Size]byte]string{}
+	errs := []string{}
+	for _, id := range sortedKeys(assets) {
+		a := assets[id]
+		if a.img == nil {
+			continue
+		}
+		sum := pixelHash(a.img)
+		if other, ok := seen[sum]; ok {
+			errs = append(errs, fmt.Sprintf("%s: is a duplicate of %s", a.path, assets[other].path))
+			continue
+		}
+		seen[sum] = id
+	}
+	return errs
+}
+
+// pixelHash hashes the size and pixels of an image, so the same picture saved
+// twice is found even if the files differ
+func pixelHash(img image.Image) [sha256.Size]byte {
+	b := img.Bounds()
+	rgba := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%dx%d:", b.Dx(), b.Dy())
+	h.Write(rgba.Pix)
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// makeVariants writes the variants which don't exist yet, or every variant
+// if force is set
+func makeVariants(dir string, assets map[string]*asset, variants map[string][]string, force bool) ([]*asset, error) {
+	made := []*asset{}
+	for _, id := range sortedKeys(assets) {
+		src := assets[id]
+		if src.img == nil || src.variantOf != "" {
+			continue
+		}
+		for _, suffix := range suffixesFor(id, variants) {
+			vid := id + "_" + suffix
+			if _, ok := assets[vid]; ok && !force {
+				continue
+			}
+
+			b := src.img.Bounds()
+			size, err := variantSize(suffix, b.Dx(), b.Dy())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", src.path, err)
+			}
+			v := &asset{
+				id:        vid,
+				path:      vid + ".png",
+				ext:       ".png",
+				img:       resize(src.img, size.X, size.Y),
+				variantOf: id,
+			}
+			if err := writePNG(filepath.Join(dir, filepath.FromSlash(v.path)), v.img); err != nil {
+				return nil, err
+			}
+			made = append(made, v)
+		}
+	}
+	return made, nil
+}
+
+func writePNG(file string, img image.Image) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeManifest writes manifest.json, which maps every asset ID to its file
+func writeManifest(dir string, assets map[string]*asset) error {
+	manifest := map[string]string{}
+	for id, a := range assets {
+		manifest[id] = a.path
+	}
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "manifest.json"), append(b, '\n'), 0644)
+}
+
+// writeEmbed writes embed.go, which embeds the manifest and every asset
+func writeEmbed(dir, pkg string, assets map[string]*asset) error {
+	patterns := map[string]bool{}
+	for _, a := range assets {
+		patterns[path.Dir(a.path)+"/*"+path.Ext(a.path)] = true
+	}
+
+	src := fmt.Sprintf(`// Code generated by assetgen. DO NOT EDIT.
+
+package %s
+
+import "embed"
+
+// FS holds the manifest and every embedded file
+//
+//go:embed manifest.json %s
+var FS embed.FS
+`, pkg, strings.Join(sortedKeys(patterns), " "))
+
+	return os.WriteFile(filepath.Join(dir, "embed.go"), []byte(src), 0644)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
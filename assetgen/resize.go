@@ -0,0 +1,44 @@
+package main
+
+import (
+	"image"
+	"image/draw"
+)
+
+// resize scales an image to w x h. Each pixel is the average of the source
+// pixels it covers, which keeps thumbnails smooth when shrinking a lot
+func resize(src image.Image, w, h int) image.Image {
+	b := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, b.Min, draw.Src)
+	sw, sh := b.Dx(), b.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		y0, y1 := y*sh/h, max((y+1)*sh/h, y*sh/h+1)
+		for x := 0; x < w; x++ {
+			x0, x1 := x*sw/w, max((x+1)*sw/w, x*sw/w+1)
+
+			// colours are premultiplied so transparent pixels don't darken
+			// the edges
+			var r, g, bl, a, n int
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					i := rgba.PixOffset(sx, sy)
+					r += int(rgba.Pix[i])
+					g += int(rgba.Pix[i+1])
+					bl += int(rgba.Pix[i+2])
+					a += int(rgba.Pix[i+3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8((r + n/2) / n)
+			dst.Pix[i+1] = uint8((g + n/2) / n)
+			dst.Pix[i+2] = uint8((bl + n/2) / n)
+			dst.Pix[i+3] = uint8((a + n/2) / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,131 @@
+// Package atlas packs many small images into a few large sheets. Drawing
+// several images cut from the same sheet lets Ebiten batch the draws together
+// instead of switching texture for every image.
+package atlas
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// padding is the gap left around each image, so filtering at the edge of one
+// image never samples the pixels of its neighbour
+const padding = 1
+
+// Source decodes images by ID, *asset.Registry is a Source
+type Source interface {
+	Decode(id string) (image.Image, error)
+}
+
+// Placement is where an image was packed, Rect does not include the padding
+type Placement struct {
+	Sheet int
+	Rect  image.Rectangle
+}
+
+// Atlas holds the packed sheets and where each image is on them
+type Atlas struct {
+	sheets []*ebiten.Image
+	images map[string]*ebiten.Image
+}
+
+// New decodes the images for ids from src and packs them into square sheets
+// of sheetSize pixels, as many sheets are used as are needed
+func New(src Source, ids []string, sheetSize int) (*Atlas, error) {
+	decoded := make([]image.Image, len(ids))
+	sizes := make([]image.Point, len(ids))
+	for i, id := range ids {
+		img, err := src.Decode(id)
+		if err != nil {
+			return nil, err
+		}
+		decoded[i] = img
+		sizes[i] = img.Bounds().Size()
+	}
+
+	places, err := Pack(sizes, sheetSize)
+	if err != nil {
+		return nil, err
+	}
+
+	sheets := []*image.NRGBA{}
+	for i, p := range places {
+		for len(sheets) <= p.Sheet {
+			sheets = append(sheets, image.NewNRGBA(image.Rect(0, 0, sheetSize, sheetSize)))
+		}
+		draw.Draw(sheets[p.Sheet], p.Rect, decoded[i], decoded[i].Bounds().Min, draw.Src)
+	}
+
+	a := &Atlas{images: map[string]*ebiten.Image{}}
+	for _, s := range sheets {
+		img, err := ebiten.NewImageFromImage(s, ebiten.FilterDefault)
+		if err != nil {
+			return nil, err
+		}
+		a.sheets = append(a.sheets, img)
+	}
+	for i, id := range ids {
+		p := places[i]
+		a.images[id] = a.sheets[p.Sheet].SubImage(p.Rect).(*ebiten.Image)
+	}
+	return a, nil
+}
+
+// Image returns the part of a sheet holding the image for id
+func (a *Atlas) Image(id string) (*ebiten.Image, error) {
+	img, ok := a.images[id]
+	if !ok {
+		return nil, fmt.Errorf("image %q is not in the atlas", id)
+	}
+	return img, nil
+}
+
+// Has reports whether the image for id is in the atlas
+func (a *Atlas) Has(id string) bool {
+	_, ok := a.images[id]
+	return ok
+}
+
+// Sheets returns how many sheets the images were packed into
+func (a *Atlas) Sheets() int {
+	return len(a.sheets)
+}
+
+// Pack places rectangles of the given sizes onto square sheets using shelves,
+// the tallest rectangles are placed first and each shelf is filled from left
+// to right. Placements are returned in the same order as sizes
+func Pack(sizes []image.Point, sheetSize int) ([]Placement, error) {
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return sizes[order[a]].Y > sizes[order[b]].Y
+	})
+
+	places := make([]Placement, len(sizes))
+	sheet, x, y, shelf := 0, 0, 0, 0 // shelf is the height of the current shelf
+	for _, i := range order {
+		w, h := sizes[i].X+padding*2, sizes[i].Y+padding*2
+		if w > sheetSize || h > sheetSize {
+			return nil, fmt.Errorf("a %dx%d image does not fit on a %dx%d sheet", sizes[i].X, sizes[i].Y, sheetSize, sheetSize)
+		}
+
+		if x+w > sheetSize {
+			x, y, shelf = 0, y+shelf, 0
+		}
+		if y+h > sheetSize {
+			sheet, x, y, shelf = sheet+1, 0, 0, 0
+		}
+
+		pos := image.Pt(x+padding, y+padding)
+		places[i] = Placement{Sheet: sheet, Rect: image.Rectangle{Min: pos, Max: pos.Add(sizes[i])}}
+		x += w
+		shelf = max(shelf, h)
+	}
+	return places, nil
+}
@@ -0,0 +1,217 @@
+package atlas
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// images can only be drawn while the game loop runs, so the benchmarks are
+// run from inside the first frame. That needs a window, so one is only opened
+// when benchmarks are asked for and there is a display to open it on, the
+// benchmarks skip themselves otherwise. Tests never need one
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if flag.Lookup("test.bench").Value.String() == "" || !hasDisplay() {
+		os.Exit(m.Run())
+	}
+
+	code := 0
+	done := errors.New("done")
+	err := ebiten.Run(func(*ebiten.Image) error {
+		inGameLoop = true
+		code = m.Run()
+		return done
+	}, 320, 240, 1, "atlas benchmark")
+	if err != nil && err != done {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+// inGameLoop is true while the tests are run from inside the game loop
+var inGameLoop bool
+
+// hasDisplay reports whether a window can be opened, only X11 and Wayland
+// systems can be without one
+func hasDisplay() bool {
+	switch runtime.GOOS {
+	case "windows", "darwin", "android", "ios", "js":
+		return true
+	}
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
+
+// needGameLoop skips a benchmark which draws when there is no game loop
+func needGameLoop(b *testing.B) {
+	if !inGameLoop {
+		b.Skip("drawing needs a display")
+	}
+}
+
+// the same number and size of images as the avatar thumbnails
+const (
+	benchImages = 40
+	benchSize   = 100
+)
+
+// fakeSource makes a plain image of a different colour for each ID
+type fakeSource struct{}
+
+func (fakeSource) Decode(id string) (image.Image, error) {
+	var n int
+	fmt.Sscanf(id, "%d", &n)
+	img := image.NewNRGBA(image.Rect(0, 0, benchSize, benchSize))
+	c := color.NRGBA{uint8(n * 6), uint8(255 - n*6), 0x80, 0xff}
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img, nil
+}
+
+func benchIDs() []string {
+	ids := []string{}
+	for i := 0; i < benchImages; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+	return ids
+}
+
+// drawAll draws every image in a grid then reads a pixel back, which makes
+// Ebiten flush the queued draws to the GPU
+func drawAll(b *testing.B, dst *ebiten.Image, images []*ebiten.Image) {
+	for i, img := range images {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(0.25, 0.25)
+		op.GeoM.Translate(float64(i%10*benchSize/4), float64(i/10*benchSize/4))
+		if err := dst.DrawImage(img, op); err != nil {
+			b.Fatal(err)
+		}
+	}
+	dst.At(0, 0)
+}
+
+// BenchmarkDrawSeparate draws images which each have their own texture, as
+// the image menus do
+func BenchmarkDrawSeparate(b *testing.B) {
+	needGameLoop(b)
+	images := []*ebiten.Image{}
+	for _, id := range benchIDs() {
+		src, _ := fakeSource{}.Decode(id)
+		img, err := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+		if err != nil {
+			b.Fatal(err)
+		}
+		images = append(images, img)
+	}
+	dst, _ := ebiten.NewImage(320, 240, ebiten.FilterDefault)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		drawAll(b, dst, images)
+	}
+}
+
+// BenchmarkDrawAtlas draws the same images cut from a single sheet
+func BenchmarkDrawAtlas(b *testing.B) {
+	needGameLoop(b)
+	ids := benchIDs()
+	a, err := New(fakeSource{}, ids, 1024)
+	if err != nil {
+		b.Fatal(err)
+	}
+	images := []*ebiten.Image{}
+	for _, id := range ids {
+		img, _ := a.Image(id)
+		images = append(images, img)
+	}
+	dst, _ := ebiten.NewImage(320, 240, ebiten.FilterDefault)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		drawAll(b, dst, images)
+	}
+}
+
+// sizes returns n rectangles of varied sizes, no bigger than maxSize
+func sizes(n, maxSize int) []image.Point {
+	found := []image.Point{}
+	for i := 0; i < n; i++ {
+		found = append(found, image.Pt(1+i*7%maxSize, 1+i*13%maxSize))
+	}
+	return found
+}
+
+func TestPackNoOverlap(t *testing.T) {
+	const sheetSize = 128
+	places, err := Pack(sizes(60, 40), sheetSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, a := range places {
+		for j, b := range places[:i] {
+			// the padding of neighbours may touch but never the images
+			// or an image and its neighbour's padding
+			if a.Sheet == b.Sheet && a.Rect.Inset(-padding).Overlaps(b.Rect) {
+				t.Errorf("placement %d %v is within the padding of placement %d %v on sheet %d", i, a.Rect, j, b.Rect, a.Sheet)
+			}
+		}
+	}
+}
+
+func TestPackInsideSheet(t *testing.T) {
+	const sheetSize = 128
+	size := sizes(60, 40)
+	places, err := Pack(size, sheetSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet := image.Rect(0, 0, sheetSize, sheetSize)
+	for i, p := range places {
+		if !p.Rect.Inset(-padding).In(sheet) {
+			t.Errorf("placement %d %v with its padding is outside the %v sheet", i, p.Rect, sheet)
+		}
+		if p.Rect.Size() != size[i] {
+			t.Errorf("placement %d is %v, want %v", i, p.Rect.Size(), size[i])
+		}
+	}
+}
+
+func TestPackSpillsOntoNextSheet(t *testing.T) {
+	// four images fill a sheet once padded, the fifth needs another
+	const sheetSize = 100
+	half := sheetSize/2 - padding*2
+	size := []image.Point{}
+	for i := 0; i < 5; i++ {
+		size = append(size, image.Pt(half, half))
+	}
+	places, err := Pack(size, sheetSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, p := range places[:4] {
+		if p.Sheet != 0 {
+			t.Errorf("placement %d is on sheet %d, want 0", i, p.Sheet)
+		}
+	}
+	if places[4].Sheet != 1 {
+		t.Errorf("placement 4 is on sheet %d, want 1", places[4].Sheet)
+	}
+}
+
+func TestPackRejectsOversized(t *testing.T) {
+	const sheetSize = 64
+	for _, size := range []image.Point{{65, 10}, {10, 65}, {sheetSize, sheetSize}} {
+		if _, err := Pack([]image.Point{{8, 8}, size}, sheetSize); err == nil {
+			t.Errorf("a %v image was packed onto a %d sheet", size, sheetSize)
+		}
+	}
+}
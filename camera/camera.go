@@ -0,0 +1,174 @@
+// Package camera decides which part of a world is on screen. A camera follows
+// a target, such as the player, and converts between world coordinates, which
+// are pixels on the map, and screen coordinates.
+//
+// Draw world things with the camera's GeoM rather than translating by hand,
+// and convert the mouse cursor with ScreenToWorld before picking what is under
+// it, so both keep working when the camera moves or zooms.
+package camera
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// Camera looks at a point in the world, the point is drawn in the middle of
+// the viewport
+type Camera struct {
+	// Width and Height are the size of the viewport in screen pixels
+	Width, Height int
+
+	// Deadzone is how far, in screen pixels, a target can move from the
+	// middle of the viewport before the camera follows it
+	DeadzoneX, DeadzoneY float64
+
+	// Smoothing is how quickly the camera catches up with its target, the
+	// larger it is the quicker it catches up. 0 moves straight to the target
+	Smoothing float64
+
+	// Bounds keeps the viewport inside an area of the world, usually the
+	// map. An empty rectangle lets the camera go anywhere
+	Bounds image.Rectangle
+
+	// MinZoom and MaxZoom limit SetZoom
+	MinZoom, MaxZoom float64
+
+	x, y float64 // the point being looked at
+	zoom float64
+}
+
+// New returns a camera with a viewport of width x height screen pixels, it
+// looks at the world's origin with no zoom
+func New(width, height int) *Camera {
+	return &Camera{
+		Width:   width,
+		Height:  height,
+		MinZoom: 1,
+		MaxZoom: 1,
+		zoom:    1,
+	}
+}
+
+// Position returns the point in the world the camera is looking at
+func (c *Camera) Position() (x, y float64) {
+	return c.x, c.y
+}
+
+// LookAt moves the camera straight to a point, ignoring the deadzone and
+// smoothing
+func (c *Camera) LookAt(x, y float64) {
+	c.x, c.y = x, y
+	c.clamp()
+}
+
+// Follow moves the camera towards a target for dt seconds. The camera does not
+// move while the target is inside the deadzone
+func (c *Camera) Follow(x, y, dt float64) {
+	// the deadzone is in screen pixels, so it covers less of the world when
+	// zoomed in
+	toX := deadzone(c.x, x, c.DeadzoneX/c.zoom)
+	toY := deadzone(c.y, y, c.DeadzoneY/c.zoom)
+
+	if c.Smoothing <= 0 {
+		c.x, c.y = toX, toY
+	} else {
+		// cover the same share of the distance each second whatever the
+		// frame rate
+		t := 1 - math.Exp(-c.Smoothing*dt)
+		c.x += (toX - c.x) * t
+		c.y += (toY - c.y) * t
+		// stop creeping once close enough that nothing would change on screen
+		if math.Abs(toX-c.x) < 0.01 {
+			c.x = toX
+		}
+		if math.Abs(toY-c.y) < 0.01 {
+			c.y = toY
+		}
+	}
+	c.clamp()
+}
+
+// deadzone returns where the camera at pos must move to so target is no more
+// than half from it
+func deadzone(pos, target, half float64) float64 {
+	switch {
+	case target < pos-half:
+		return target + half
+	case target > pos+half:
+		return target - half
+	}
+	return pos
+}
+
+// Zoom returns how many screen pixels a world pixel covers
+func (c *Camera) Zoom() float64 {
+	return c.zoom
+}
+
+// SetZoom changes the zoom, it is kept between MinZoom and MaxZoom
+func (c *Camera) SetZoom(zoom float64) {
+	c.zoom = math.Max(c.MinZoom, math.Min(zoom, c.MaxZoom))
+	if c.zoom <= 0 {
+		c.zoom = 1
+	}
+	c.clamp()
+}
+
+// clamp keeps the viewport inside the bounds, a world smaller than the
+// viewport is kept in the middle
+func (c *Camera) clamp() {
+	if c.Bounds.Empty() {
+		return
+	}
+	halfW, halfH := float64(c.Width)/2/c.zoom, float64(c.Height)/2/c.zoom
+	c.x = clampAxis(c.x, halfW, float64(c.Bounds.Min.X), float64(c.Bounds.Max.X))
+	c.y = clampAxis(c.y, halfH, float64(c.Bounds.Min.Y), float64(c.Bounds.Max.Y))
+}
+
+func clampAxis(pos, half, min, max float64) float64 {
+	if max-min < half*2 {
+		return (min + max) / 2
+	}
+	return math.Max(min+half, math.Min(pos, max-half))
+}
+
+// origin returns the world point drawn at the top left of the viewport. It is
+// rounded to a whole screen pixel so tiles don't shimmer as the camera moves
+func (c *Camera) origin() (x, y float64) {
+	x = c.x - float64(c.Width)/2/c.zoom
+	y = c.y - float64(c.Height)/2/c.zoom
+	return math.Round(x*c.zoom) / c.zoom, math.Round(y*c.zoom) / c.zoom
+}
+
+// View returns the area of the world on screen, rounded out to whole pixels
+func (c *Camera) View() image.Rectangle {
+	x0, y0 := c.origin()
+	x1 := x0 + float64(c.Width)/c.zoom
+	y1 := y0 + float64(c.Height)/c.zoom
+	return image.Rect(int(math.Floor(x0)), int(math.Floor(y0)), int(math.Ceil(x1)), int(math.Ceil(y1)))
+}
+
+// GeoM returns the transform from world to screen coordinates, concat it
+// onto the GeoM of anything drawn in the world
+func (c *Camera) GeoM() ebiten.GeoM {
+	x, y := c.origin()
+	g := ebiten.GeoM{}
+	g.Translate(-x, -y)
+	g.Scale(c.zoom, c.zoom)
+	return g
+}
+
+// WorldToScreen converts a point in the world to a point on the screen
+func (c *Camera) WorldToScreen(x, y float64) (sx, sy float64) {
+	ox, oy := c.origin()
+	return (x - ox) * c.zoom, (y - oy) * c.zoom
+}
+
+// ScreenToWorld converts a point on the screen, such as the mouse cursor, to
+// a point in the world
+func (c *Camera) ScreenToWorld(sx, sy float64) (x, y float64) {
+	ox, oy := c.origin()
+	return sx/c.zoom + ox, sy/c.zoom + oy
+}
@@ -0,0 +1,104 @@
+package camera
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+func TestLookAtClamps(t *testing.T) {
+	world := image.Rect(0, 0, 800, 600)
+	tests := []struct {
+		name         string
+		bounds       image.Rectangle
+		zoom         float64
+		x, y         float64 // where the camera is asked to look
+		wantX, wantY float64
+	}{
+		{name: "inside", bounds: world, zoom: 1, x: 400, y: 300, wantX: 400, wantY: 300},
+		{name: "top left corner", bounds: world, zoom: 1, x: 0, y: 0, wantX: 200, wantY: 150},
+		{name: "bottom right corner", bounds: world, zoom: 1, x: 800, y: 600, wantX: 600, wantY: 450},
+		// zoomed in the viewport covers less of the world so it can get
+		// closer to the edges
+		{name: "zoomed corner", bounds: world, zoom: 2, x: 0, y: 0, wantX: 100, wantY: 75},
+		{name: "world smaller than viewport", bounds: image.Rect(0, 0, 200, 100), zoom: 1, x: 0, y: 0, wantX: 100, wantY: 50},
+		{name: "no bounds", zoom: 1, x: -1000, y: 5000, wantX: -1000, wantY: 5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(400, 300)
+			c.Bounds = tt.bounds
+			c.MaxZoom = 4
+			c.SetZoom(tt.zoom)
+			c.LookAt(tt.x, tt.y)
+			if x, y := c.Position(); x != tt.wantX || y != tt.wantY {
+				t.Errorf("looking at %v, %v, want %v, %v", x, y, tt.wantX, tt.wantY)
+			}
+			if !tt.bounds.Empty() && tt.bounds.Dx() >= 400 && !c.View().In(tt.bounds) {
+				t.Errorf("view %v is outside the bounds %v", c.View(), tt.bounds)
+			}
+		})
+	}
+}
+
+func TestSetZoomLimits(t *testing.T) {
+	c := New(400, 300)
+	c.MinZoom, c.MaxZoom = 1, 3
+	for _, tt := range []struct{ zoom, want float64 }{{0.5, 1}, {2, 2}, {10, 3}} {
+		c.SetZoom(tt.zoom)
+		if c.Zoom() != tt.want {
+			t.Errorf("SetZoom(%v) zoomed to %v, want %v", tt.zoom, c.Zoom(), tt.want)
+		}
+	}
+}
+
+func TestFollowDeadzone(t *testing.T) {
+	c := New(400, 300)
+	c.DeadzoneX, c.DeadzoneY = 20, 10
+
+	c.Follow(15, -8, 1.0/60)
+	if x, y := c.Position(); x != 0 || y != 0 {
+		t.Errorf("the camera moved to %v, %v for a target inside the deadzone", x, y)
+	}
+	c.Follow(50, -30, 1.0/60)
+	if x, y := c.Position(); x != 30 || y != -20 {
+		t.Errorf("the camera moved to %v, %v, want the target on the edge of the deadzone at 30, -20", x, y)
+	}
+}
+
+func TestFollowSmoothingIgnoresTPS(t *testing.T) {
+	// half a second of following should get as close whatever the frame rate
+	positions := []float64{}
+	for _, tps := range []int{30, 60, 144} {
+		c := New(400, 300)
+		c.Smoothing = 4
+		for i := 0; i < tps/2; i++ {
+			c.Follow(100, 0, 1/float64(tps))
+		}
+		x, _ := c.Position()
+		positions = append(positions, x)
+	}
+	for _, x := range positions[1:] {
+		if math.Abs(x-positions[0]) > 1e-6 {
+			t.Errorf("after half a second the camera is at %v at 30, 60 and 144 TPS, want the same", positions)
+			break
+		}
+	}
+}
+
+func TestScreenToWorld(t *testing.T) {
+	c := New(400, 300)
+	c.MaxZoom = 2
+	c.SetZoom(2)
+	c.LookAt(500, 400)
+
+	// the middle of the screen is the point looked at
+	if x, y := c.ScreenToWorld(200, 150); x != 500 || y != 400 {
+		t.Errorf("the middle of the screen is %v, %v in the world, want 500, 400", x, y)
+	}
+	sx, sy := c.WorldToScreen(c.ScreenToWorld(37, 91))
+	if sx != 37 || sy != 91 {
+		t.Errorf("converting 37, 91 to the world and back gave %v, %v", sx, sy)
+	}
+}
@@ -0,0 +1,261 @@
+// Package combat runs turn-based battles between the player's side and a side
+// of enemies.
+//
+// Each round every combatant still standing takes a turn, fastest first. On
+// its turn a combatant attacks, uses its skill, or does something the game
+// handles itself such as using an item or trying to flee, the battle is over
+// once one side has nobody left standing.
+//
+// Names are whatever the game likes, such as locale message IDs, the package
+// reports what happened as events for the game to describe.
+package combat
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// how damage is worked out
+const (
+	minVariance   = 0.85 // damage is scaled by a random amount between these
+	maxVariance   = 1.15
+	critChance    = 0.0625 // chance of an attack or skill doing critical damage
+	critScale     = 1.5
+	skillCooldown = 3 // turns a combatant waits after using its skill before it can use it again
+)
+
+// Side is which side of a battle a combatant fights on
+type Side int
+
+const (
+	Players Side = iota
+	Enemies
+)
+
+// Stats are what a combatant fights with
+type Stats struct {
+	MaxHP   int
+	Attack  int // physical damage dealt
+	Defence int // physical damage taken away
+	Magic   int // adds to the damage of skills
+	Speed   int // faster combatants act first in a round
+}
+
+// Combatant is someone taking part in a battle
+type Combatant struct {
+	Name  string
+	Side  Side
+	Stats Stats
+	HP    int
+
+	cooldown int // turns until the skill can be used again
+}
+
+// Alive reports whether the combatant is still standing
+func (c *Combatant) Alive() bool {
+	return c.HP > 0
+}
+
+// Cooldown returns how many turns until the combatant can use its skill, 0 if
+// it can use it now
+func (c *Combatant) Cooldown() int {
+	return c.cooldown
+}
+
+// Action is what a combatant does on its turn
+type Action int
+
+const (
+	Attack Action = iota
+	Skill
+	Item // the game does whatever the item does
+	Flee
+)
+
+// Event is something which happened during a battle
+type Event struct {
+	Actor    *Combatant
+	Target   *Combatant // nil if the action had no target
+	Action   Action
+	Amount   int  // damage done, or hit points healed by an item
+	Critical bool // the damage was critical
+	Fled     bool // a try to flee worked
+	Defeated bool // the target was defeated
+}
+
+// Outcome is how a battle ended
+type Outcome int
+
+const (
+	Ongoing Outcome = iota
+	Won             // every enemy was defeated
+	Lost            // every player was defeated
+	Fled            // the players got away
+)
+
+// Battle is a fight between players and enemies
+type Battle struct {
+	Combatants []*Combatant
+	Round      int // starts at 1
+
+	order []*Combatant // turns of the current round, fastest first
+	turn  int          // index into order of whoever's turn it is
+	fled  bool
+	rng   *rand.Rand
+}
+
+// New starts a battle, rng decides damage and the ties in turn order so a
+// battle can be replayed with the same source
+func New(rng *rand.Rand, combatants ...*Combatant) *Battle {
+	b := &Battle{Combatants: combatants, rng: rng}
+	b.nextRound()
+	return b
+}
+
+// nextRound works out the turn order of a new round from everyone standing,
+// ties are broken randomly
+func (b *Battle) nextRound() {
+	b.Round++
+	b.order = b.order[:0]
+	for _, c := range b.rng.Perm(len(b.Combatants)) {
+		if b.Combatants[c].Alive() {
+			b.order = append(b.order, b.Combatants[c])
+		}
+	}
+	sort.SliceStable(b.order, func(i, j int) bool {
+		return b.order[i].Stats.Speed > b.order[j].Stats.Speed
+	})
+	b.turn = 0
+}
+
+// Order returns who takes a turn this round, in order, including anyone
+// defeated since the round began
+func (b *Battle) Order() []*Combatant {
+	return append([]*Combatant{}, b.order...)
+}
+
+// Current returns whose turn it is, nil once the battle is over
+func (b *Battle) Current() *Combatant {
+	if b.Outcome() != Ongoing {
+		return nil
+	}
+	return b.order[b.turn]
+}
+
+// Outcome reports whether the battle is over and how it ended
+func (b *Battle) Outcome() Outcome {
+	switch {
+	case b.fled:
+		return Fled
+	case len(b.Standing(Enemies)) == 0:
+		return Won
+	case len(b.Standing(Players)) == 0:
+		return Lost
+	}
+	return Ongoing
+}
+
+// Standing returns everyone still standing on a side
+func (b *Battle) Standing(side Side) []*Combatant {
+	found := []*Combatant{}
+	for _, c := range b.Combatants {
+		if c.Side == side && c.Alive() {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// Act makes the current combatant do something and ends its turn. Items are
+// used by the game before calling Act, amount is what the item healed and is
+// only used for the event. target is ignored when fleeing
+func (b *Battle) Act(action Action, target *Combatant, amount int) Event {
+	actor := b.Current()
+	e := Event{Actor: actor, Target: target, Action: action}
+	if actor == nil {
+		return e
+	}
+
+	switch action {
+	case Attack:
+		e.Amount, e.Critical = b.damage(actor.Stats.Attack*2, target.Stats.Defence)
+	case Skill:
+		e.Amount, e.Critical = b.damage(actor.Stats.Attack+actor.Stats.Magic*2, target.Stats.Defence/2)
+		actor.cooldown = skillCooldown + 1 // the turn ending takes one off
+	case Item:
+		e.Amount = amount
+	case Flee:
+		e.Target = nil
+		e.Fled = b.rng.Float64() < b.fleeChance(actor)
+		b.fled = e.Fled
+	}
+	if action == Attack || action == Skill {
+		target.HP = max(target.HP-e.Amount, 0)
+		e.Defeated = !target.Alive()
+	}
+
+	b.endTurn()
+	return e
+}
+
+// damage returns the damage of a blow of some power against some defence,
+// every blow does at least 1 damage
+func (b *Battle) damage(power, defence int) (int, bool) {
+	d := float64(power-defence) * (minVariance + b.rng.Float64()*(maxVariance-minVariance))
+	crit := b.rng.Float64() < critChance
+	if crit {
+		d *= critScale
+	}
+	return max(int(math.Round(d)), 1), crit
+}
+
+// fleeChance returns the chance of a combatant getting away, better the
+// faster it is than the fastest of the other side
+func (b *Battle) fleeChance(c *Combatant) float64 {
+	fastest := 0
+	for _, o := range b.Combatants {
+		if o.Side != c.Side && o.Alive() {
+			fastest = max(fastest, o.Stats.Speed)
+		}
+	}
+	return math.Min(math.Max(0.5+0.1*float64(c.Stats.Speed-fastest), 0.1), 0.9)
+}
+
+// endTurn moves on to the next combatant still standing, starting a new round
+// once everyone has had a turn
+func (b *Battle) endTurn() {
+	if c := b.order[b.turn]; c.cooldown > 0 {
+		c.cooldown--
+	}
+	if b.Outcome() != Ongoing {
+		return
+	}
+	for {
+		b.turn++
+		if b.turn >= len(b.order) {
+			b.nextRound()
+		}
+		if b.order[b.turn].Alive() {
+			return
+		}
+	}
+}
+
+// Choose picks what an enemy does on its turn, it uses its skill when it can
+// if it has any magic and attacks a random player otherwise
+func (b *Battle) Choose(c *Combatant) (Action, *Combatant) {
+	side := Players
+	if c.Side == Players {
+		side = Enemies
+	}
+	targets := b.Standing(side)
+	if len(targets) == 0 {
+		return Attack, nil
+	}
+	target := targets[b.rng.Intn(len(targets))]
+	if c.Stats.Magic > 0 && c.Cooldown() == 0 {
+		return Skill, target
+	}
+	return Attack, target
+}
@@ -0,0 +1,176 @@
+package combat
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
+
+func hero(speed int) *Combatant {
+	return &Combatant{Name: "hero", Side: Players, HP: 20, Stats: Stats{MaxHP: 20, Attack: 5, Defence: 2, Magic: 2, Speed: speed}}
+}
+
+func foe(name string, speed int) *Combatant {
+	return &Combatant{Name: name, Side: Enemies, HP: 20, Stats: Stats{MaxHP: 20, Attack: 5, Defence: 2, Speed: speed}}
+}
+
+func TestTurnOrderBySpeed(t *testing.T) {
+	slow, fast := foe("slow", 1), foe("fast", 9)
+	h := hero(5)
+	b := New(newRand(), h, slow, fast)
+
+	want := []*Combatant{fast, h, slow}
+	for round := 1; round <= 2; round++ {
+		order := b.Order()
+		for i, c := range want {
+			if order[i] != c {
+				t.Fatalf("round %d: turn %d is %s, want %s", round, i, order[i].Name, c.Name)
+			}
+		}
+		for _, c := range want {
+			if b.Current() != c {
+				t.Fatalf("round %d: it is %s's turn, want %s", round, b.Current().Name, c.Name)
+			}
+			b.Act(Item, c, 0)
+		}
+	}
+	if b.Round != 3 {
+		t.Errorf("round is %d after two rounds, want 3", b.Round)
+	}
+}
+
+func TestTurnOrderSkipsDefeated(t *testing.T) {
+	a, d := foe("a", 3), foe("d", 2)
+	h := hero(5)
+	h.Stats.Attack = 100
+	b := New(newRand(), h, a, d)
+
+	b.Act(Attack, a, 0)
+	if b.Current() != d {
+		t.Fatalf("it is %s's turn, want d as a was defeated", b.Current().Name)
+	}
+	b.Act(Item, d, 0)
+	for _, c := range b.Order() {
+		if c == a {
+			t.Error("a defeated combatant is in the next round's order")
+		}
+	}
+}
+
+func TestSkillCooldown(t *testing.T) {
+	h, f := hero(9), foe("foe", 1)
+	f.HP = 1000
+	b := New(newRand(), h, f)
+
+	b.Act(Skill, f, 0)
+	for want := skillCooldown; want > 0; want-- {
+		if h.Cooldown() != want {
+			t.Fatalf("cooldown is %d, want %d", h.Cooldown(), want)
+		}
+		if action, _ := b.Choose(h); action != Attack {
+			t.Errorf("with a cooldown of %d Choose picked %v, want an attack", want, action)
+		}
+		b.Act(Item, h, 0) // foe's turn, which doesn't count down the hero
+		if h.Cooldown() != want {
+			t.Fatalf("cooldown went down to %d on another combatant's turn", h.Cooldown())
+		}
+		b.Act(Attack, f, 0) // hero's turn
+	}
+	if h.Cooldown() != 0 {
+		t.Errorf("cooldown is %d after %d turns, want 0", h.Cooldown(), skillCooldown)
+	}
+	if action, _ := b.Choose(h); action != Skill {
+		t.Errorf("Choose picked %v once the skill was ready, want the skill", action)
+	}
+}
+
+func TestMinimumDamage(t *testing.T) {
+	h, f := hero(9), foe("foe", 1)
+	f.Stats.Defence = 1000
+	b := New(newRand(), h, f)
+
+	for _, action := range []Action{Attack, Skill} {
+		e := b.Act(action, f, 0)
+		if e.Amount != 1 {
+			t.Errorf("action %v against huge defence did %d damage, want 1", action, e.Amount)
+		}
+		b.Act(Item, f, 0)
+	}
+	if f.HP != 18 {
+		t.Errorf("foe has %d hp, want 18", f.HP)
+	}
+}
+
+func TestFleeChanceBounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		speed      int
+		foeSpeed   int
+		wantChance float64
+	}{
+		{name: "as fast", speed: 5, foeSpeed: 5, wantChance: 0.5},
+		{name: "much slower", speed: 1, foeSpeed: 50, wantChance: 0.1},
+		{name: "much faster", speed: 50, foeSpeed: 1, wantChance: 0.9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := hero(tt.speed)
+			b := New(newRand(), h, foe("foe", tt.foeSpeed))
+			if got := b.fleeChance(h); got < tt.wantChance-1e-9 || got > tt.wantChance+1e-9 {
+				t.Errorf("flee chance is %v, want %v", got, tt.wantChance)
+			}
+		})
+	}
+}
+
+func TestOutcomes(t *testing.T) {
+	t.Run("won", func(t *testing.T) {
+		h, f := hero(9), foe("foe", 1)
+		f.HP = 1
+		b := New(newRand(), h, f)
+		e := b.Act(Attack, f, 0)
+		if !e.Defeated || b.Outcome() != Won || b.Current() != nil {
+			t.Errorf("after defeating the only enemy the outcome is %v, want Won", b.Outcome())
+		}
+	})
+
+	t.Run("lost", func(t *testing.T) {
+		h, f := hero(1), foe("foe", 9)
+		h.HP = 1
+		b := New(newRand(), h, f)
+		b.Act(Attack, h, 0)
+		if b.Outcome() != Lost || b.Current() != nil {
+			t.Errorf("after the only player was defeated the outcome is %v, want Lost", b.Outcome())
+		}
+	})
+
+	t.Run("fled", func(t *testing.T) {
+		// fleeing from something much slower has a 0.9 chance of working,
+		// the source is seeded so it works within the first few tries
+		h, f := hero(50), foe("foe", 1)
+		b := New(newRand(), h, f)
+		for try := 0; b.Outcome() == Ongoing && try < 10; try++ {
+			b.Act(Flee, nil, 0)
+			if b.Outcome() == Ongoing {
+				b.Act(Item, f, 0)
+			}
+		}
+		if b.Outcome() != Fled {
+			t.Errorf("outcome is %v, want Fled", b.Outcome())
+		}
+		if f.HP != f.Stats.MaxHP {
+			t.Error("fleeing hurt the enemy")
+		}
+	})
+
+	t.Run("ongoing", func(t *testing.T) {
+		b := New(newRand(), hero(5), foe("foe", 1))
+		if b.Outcome() != Ongoing {
+			t.Errorf("a new battle's outcome is %v, want Ongoing", b.Outcome())
+		}
+	})
+}
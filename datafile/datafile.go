@@ -0,0 +1,40 @@
+// Package datafile reads the JSON files games keep their data in, such as
+// menu definitions and catalogues. Files are read strictly, a field the Go
+// type doesn't have is an error, so a typo in a hand edited file is reported
+// rather than quietly ignored.
+//
+// Checking the values is left to the caller, which lists every problem it
+// finds so they can all be fixed at once and reports them with Invalid.
+package datafile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// Decode reads the JSON file at path in fsys into v, unknown fields are an
+// error
+func Decode(fsys fs.FS, path string, v any) error {
+	b, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+// Invalid returns an error listing the problems found with the file at path,
+// one to a line, or nil if there are none
+func Invalid(path string, problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s is invalid:\n\t%s", path, strings.Join(problems, "\n\t"))
+}
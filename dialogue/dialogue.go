@@ -0,0 +1,282 @@
+// Package dialogue loads branching conversations from JSON files and walks
+// through them.
+//
+// A conversation is a tree of nodes, each node is a line said by a speaker
+// which leads on to another node, either straight away, through branches which
+// depend on game flags, or through choices the player picks from. Nodes and
+// choices can have effects on the game, such as setting a flag or giving gold.
+//
+// Text and speakers are IDs, such as locale message IDs and avatar IDs, the
+// package does not look them up so games check them after loading.
+package dialogue
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+)
+
+// Tree is a conversation loaded from a dialogue file
+type Tree struct {
+	Start string           `json:"start"` // ID of the first node
+	Nodes map[string]*Node `json:"nodes"`
+}
+
+// Node is one line of a conversation. A node without text says nothing and
+// moves straight on, which is useful for branching
+type Node struct {
+	Speaker  string   `json:"speaker,omitempty"` // empty for narration
+	Text     string   `json:"text,omitempty"`
+	Effects  []Effect `json:"effects,omitempty"`  // applied when the node is reached
+	Choices  []Choice `json:"choices,omitempty"`  // offered once the text is read
+	Branches []Branch `json:"branches,omitempty"` // the first whose conditions hold is followed
+	Next     string   `json:"next,omitempty"`     // followed if no choice or branch is, empty ends the conversation
+}
+
+// Choice is a reply the player can pick
+type Choice struct {
+	Text    string    `json:"text"`
+	If      Condition `json:"if,omitempty"` // the choice is only offered if this holds
+	Effects []Effect  `json:"effects,omitempty"`
+	Next    string    `json:"next,omitempty"` // empty ends the conversation
+}
+
+// Branch leads to another node when its conditions hold
+type Branch struct {
+	If   Condition `json:"if"`
+	Next string    `json:"next,omitempty"` // empty ends the conversation
+}
+
+// Condition is a list of flag names which must all be set, a name starting
+// with ! must not be set instead
+type Condition []string
+
+// Effect changes the game, exactly one of its fields is set
+type Effect struct {
+	Set    string `json:"set,omitempty"`    // sets a flag
+	Clear  string `json:"clear,omitempty"`  // clears a flag
+	Gold   int    `json:"gold,omitempty"`   // gives gold, or takes it if negative
+	Combat string `json:"combat,omitempty"` // starts a fight with an enemy once the conversation is over
+}
+
+// Load reads a dialogue file from fsys and checks it with Validate
+func Load(fsys fs.FS, path string) (*Tree, error) {
+	t := &Tree{}
+	if err := datafile.Decode(fsys, path, t); err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, t.Validate()); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// NodeIDs returns the ID of every node in order
+func (t *Tree) NodeIDs() []string {
+	ids := []string{}
+	for id := range t.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// links returns the IDs of the nodes a node can lead to, an empty ID ends the
+// conversation so is left out
+func (n *Node) links() []string {
+	links := []string{}
+	add := func(id string) {
+		if id != "" {
+			links = append(links, id)
+		}
+	}
+	for _, c := range n.Choices {
+		add(c.Next)
+	}
+	for _, b := range n.Branches {
+		add(b.Next)
+	}
+	add(n.Next)
+	return links
+}
+
+// silentLinks returns the nodes a node without text moves straight on to
+func (n *Node) silentLinks() []string {
+	links := []string{}
+	for _, b := range n.Branches {
+		if b.Next != "" {
+			links = append(links, b.Next)
+		}
+	}
+	if n.Next != "" {
+		links = append(links, n.Next)
+	}
+	return links
+}
+
+// Validate returns a description of every problem with the tree, including
+// links to nodes which don't exist and nodes which can never be reached
+func (t *Tree) Validate() []string {
+	errs := []string{}
+
+	if len(t.Nodes) == 0 {
+		return append(errs, "there are no nodes")
+	}
+	if t.Start == "" {
+		errs = append(errs, "there is no start node")
+	} else if t.Nodes[t.Start] == nil {
+		errs = append(errs, fmt.Sprintf("start node %q does not exist", t.Start))
+	}
+
+	for _, id := range t.NodeIDs() {
+		n := t.Nodes[id]
+		where := fmt.Sprintf("node %q", id)
+		if n == nil {
+			errs = append(errs, where+" is empty")
+			continue
+		}
+
+		if n.Text == "" {
+			if n.Speaker != "" {
+				errs = append(errs, where+" has a speaker but no text")
+			}
+			if len(n.Choices) > 0 {
+				errs = append(errs, where+" has choices but no text")
+			}
+		}
+		errs = append(errs, checkEffects(where, n.Effects)...)
+
+		for i, c := range n.Choices {
+			where := fmt.Sprintf("node %q choice %d", id, i+1)
+			if c.Text == "" {
+				errs = append(errs, where+" has no text")
+			}
+			errs = append(errs, t.checkLink(where, c.Next)...)
+			errs = append(errs, checkCondition(where, c.If)...)
+			errs = append(errs, checkEffects(where, c.Effects)...)
+		}
+		for i, b := range n.Branches {
+			where := fmt.Sprintf("node %q branch %d", id, i+1)
+			if len(b.If) == 0 {
+				errs = append(errs, where+" has no conditions, use next instead")
+			}
+			errs = append(errs, t.checkLink(where, b.Next)...)
+			errs = append(errs, checkCondition(where, b.If)...)
+		}
+		errs = append(errs, t.checkLink(where, n.Next)...)
+	}
+
+	if len(errs) > 0 {
+		// the checks below follow links so need them to be sound
+		return errs
+	}
+
+	reached := t.reachable()
+	for _, id := range t.NodeIDs() {
+		if !reached[id] {
+			errs = append(errs, fmt.Sprintf("node %q can never be reached", id))
+		}
+	}
+	if loop := t.silentLoop(); len(loop) > 0 {
+		errs = append(errs, fmt.Sprintf("nodes %s loop without any text so the conversation would never end", strings.Join(loop, " -> ")))
+	}
+	return errs
+}
+
+// checkLink reports a link to a node which doesn't exist
+func (t *Tree) checkLink(where, id string) []string {
+	if id != "" && t.Nodes[id] == nil {
+		return []string{fmt.Sprintf("%s leads to node %q which does not exist", where, id)}
+	}
+	return nil
+}
+
+func checkCondition(where string, c Condition) []string {
+	errs := []string{}
+	for _, flag := range c {
+		if strings.TrimPrefix(flag, "!") == "" {
+			errs = append(errs, where+" has a condition with no flag name")
+		}
+	}
+	return errs
+}
+
+func checkEffects(where string, effects []Effect) []string {
+	errs := []string{}
+	for i, e := range effects {
+		set := 0
+		for _, ok := range []bool{e.Set != "", e.Clear != "", e.Gold != 0, e.Combat != ""} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			errs = append(errs, fmt.Sprintf("%s effect %d must have exactly one of set, clear, gold or combat", where, i+1))
+		}
+	}
+	return errs
+}
+
+// reachable returns every node which can be reached from the start node,
+// ignoring conditions as any of them might hold
+func (t *Tree) reachable() map[string]bool {
+	reached := map[string]bool{}
+	todo := []string{t.Start}
+	for len(todo) > 0 {
+		id := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		if reached[id] {
+			continue
+		}
+		reached[id] = true
+		todo = append(todo, t.Nodes[id].links()...)
+	}
+	return reached
+}
+
+// silentLoop returns a loop of nodes without text, the runner would go round
+// such a loop forever without showing anything
+func (t *Tree) silentLoop() []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		n := t.Nodes[id]
+		if n.Text != "" || state[id] == done {
+			return nil
+		}
+		if state[id] == visiting {
+			for i, p := range path {
+				if p == id {
+					return append(append([]string{}, path[i:]...), id)
+				}
+			}
+		}
+		state[id] = visiting
+		path = append(path, id)
+		for _, next := range n.silentLinks() {
+			if loop := visit(next); loop != nil {
+				return loop
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, id := range t.NodeIDs() {
+		if loop := visit(id); loop != nil {
+			return loop
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package dialogue
+
+import "strings"
+
+// State is the game as seen by a conversation
+type State interface {
+	Flag(name string) bool // reports whether a flag is set
+	Apply(e Effect)        // makes an effect happen
+}
+
+// Line is what is being said, Choices only holds the choices whose conditions
+// hold
+type Line struct {
+	Speaker string
+	Text    string
+	Choices []Choice
+}
+
+// Runner walks through a tree, applying effects to the game as nodes are
+// reached and choices picked
+type Runner struct {
+	tree    *Tree
+	state   State
+	node    *Node    // the node being said, nil once the conversation is over
+	choices []Choice // choices of the node which can be picked
+}
+
+// Run starts a conversation at the tree's start node
+func (t *Tree) Run(state State) *Runner {
+	r := &Runner{tree: t, state: state}
+	r.enter(t.Start)
+	return r
+}
+
+// Line returns what is being said, ok is false once the conversation is over
+func (r *Runner) Line() (l Line, ok bool) {
+	if r.node == nil {
+		return Line{}, false
+	}
+	return Line{Speaker: r.node.Speaker, Text: r.node.Text, Choices: r.choices}, true
+}
+
+// Over reports whether the conversation has finished
+func (r *Runner) Over() bool {
+	return r.node == nil
+}
+
+// Next moves on once the current line is read. choice is the index of the
+// choice picked from the line's choices, it is ignored if there are none
+func (r *Runner) Next(choice int) {
+	if r.node == nil {
+		return
+	}
+	if len(r.choices) > 0 && choice >= 0 && choice < len(r.choices) {
+		c := r.choices[choice]
+		r.apply(c.Effects)
+		r.enter(c.Next)
+		return
+	}
+	r.enter(r.follow(r.node))
+}
+
+// enter moves to a node and applies its effects, nodes without text are
+// passed straight through
+func (r *Runner) enter(id string) {
+	// Validate rejects loops of nodes without text, the limit stops a tree
+	// which wasn't validated hanging the game
+	for i := 0; i <= len(r.tree.Nodes); i++ {
+		n := r.tree.Nodes[id]
+		if n == nil {
+			break
+		}
+		r.apply(n.Effects)
+		if n.Text != "" {
+			r.node = n
+			r.choices = r.visible(n.Choices)
+			return
+		}
+		id = r.follow(n)
+	}
+	r.node, r.choices = nil, nil
+}
+
+// follow returns the node a node leads to when no choice is picked
+func (r *Runner) follow(n *Node) string {
+	for _, b := range n.Branches {
+		if r.holds(b.If) {
+			return b.Next
+		}
+	}
+	return n.Next
+}
+
+// visible returns the choices whose conditions hold
+func (r *Runner) visible(choices []Choice) []Choice {
+	found := []Choice{}
+	for _, c := range choices {
+		if r.holds(c.If) {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// holds reports whether every flag of a condition is as it should be
+func (r *Runner) holds(c Condition) bool {
+	for _, flag := range c {
+		name, not := strings.CutPrefix(flag, "!")
+		if r.state.Flag(name) == not {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Runner) apply(effects []Effect) {
+	for _, e := range effects {
+		r.state.Apply(e)
+	}
+}
@@ -0,0 +1,272 @@
+// Package entity moves things such as the player and other characters around
+// a tile map. Entities either step from tile to tile like a classic RPG or
+// move freely a pixel at a time, and collide with blocking tiles and with
+// each other.
+//
+// Speeds are in pixels per second and every update is given the time it
+// covers, so entities move at the same speed whatever the frame rate.
+package entity
+
+import (
+	"math"
+)
+
+// Mode is how an entity moves
+type Mode int
+
+const (
+	GridMode Mode = iota // steps a whole tile at a time
+	FreeMode             // moves freely, a pixel at a time
+)
+
+// Direction is the way an entity faces
+type Direction int
+
+const (
+	Down Direction = iota
+	Up
+	Left
+	Right
+)
+
+// Tiles is the map entities move around, *tilemap.Map is Tiles
+type Tiles interface {
+	Walkable(tx, ty int) bool
+}
+
+// Entity is anything which has a position on the map. Its position is the top
+// left of its hitbox, the area which collides with tiles and other entities
+type Entity struct {
+	Name   string
+	X, Y   float64 // top left of the hitbox in pixels
+	W, H   float64 // size of the hitbox, it must be smaller than a tile
+	Speed  float64 // pixels per second
+	Solid  bool    // other entities can't move through solid entities
+	Facing Direction
+
+	// a grid step in progress
+	stepping         bool
+	stepToX, stepToY float64
+}
+
+// Rect returns the hitbox
+func (e *Entity) Rect() (x0, y0, x1, y1 float64) {
+	return e.X, e.Y, e.X + e.W, e.Y + e.H
+}
+
+// Centre returns the middle of the hitbox
+func (e *Entity) Centre() (x, y float64) {
+	return e.X + e.W/2, e.Y + e.H/2
+}
+
+// SetCentre moves the entity so the middle of its hitbox is at x, y,
+// cancelling any step in progress
+func (e *Entity) SetCentre(x, y float64) {
+	e.X, e.Y = x-e.W/2, y-e.H/2
+	e.stepping = false
+}
+
+// Moving reports whether the entity is part way through a grid step
+func (e *Entity) Moving() bool {
+	return e.stepping
+}
+
+// Space holds the map and every entity on it
+type Space struct {
+	Tiles      Tiles
+	TileWidth  int
+	TileHeight int
+	Mode       Mode
+	Entities   []*Entity
+}
+
+// Add adds an entity to the space
+func (s *Space) Add(e *Entity) {
+	s.Entities = append(s.Entities, e)
+}
+
+// Remove removes an entity from the space
+func (s *Space) Remove(e *Entity) {
+	for i, other := range s.Entities {
+		if other == e {
+			s.Entities = append(s.Entities[:i], s.Entities[i+1:]...)
+			return
+		}
+	}
+}
+
+// Tile returns the tile the middle of an entity's hitbox is on
+func (s *Space) Tile(e *Entity) (tx, ty int) {
+	return int(math.Floor((e.X + e.W/2) / float64(s.TileWidth))),
+		int(math.Floor((e.Y + e.H/2) / float64(s.TileHeight)))
+}
+
+// PlaceOnTile moves an entity so its hitbox is in the middle of a tile,
+// cancelling any step in progress
+func (s *Space) PlaceOnTile(e *Entity, tx, ty int) {
+	e.X = float64(tx*s.TileWidth) + (float64(s.TileWidth)-e.W)/2
+	e.Y = float64(ty*s.TileHeight) + (float64(s.TileHeight)-e.H)/2
+	e.stepping = false
+}
+
+// Move moves an entity for dt seconds in the direction dx, dy, where each is
+// -1, 0 or 1. It returns true if the entity moved
+func (s *Space) Move(e *Entity, dx, dy int, dt float64) bool {
+	if s.Mode == GridMode {
+		return s.moveGrid(e, dx, dy, dt)
+	}
+	return s.moveFree(e, dx, dy, dt)
+}
+
+// face turns an entity towards a direction, horizontal wins if both are held
+func face(e *Entity, dx, dy int) {
+	switch {
+	case dx < 0:
+		e.Facing = Left
+	case dx > 0:
+		e.Facing = Right
+	case dy < 0:
+		e.Facing = Up
+	case dy > 0:
+		e.Facing = Down
+	}
+}
+
+// moveGrid carries on with a step in progress, or starts a step to the next
+// tile if the direction is held and the tile is free
+func (s *Space) moveGrid(e *Entity, dx, dy int, dt float64) bool {
+	dist := e.Speed * dt
+	moved := false
+
+	for dist > 0 {
+		if !e.stepping {
+			// grid steps only go one way at a time
+			if dx != 0 {
+				dy = 0
+			}
+			if dx == 0 && dy == 0 {
+				break
+			}
+			face(e, dx, dy)
+
+			tx, ty := s.Tile(e)
+			if !s.tileFree(e, tx+dx, ty+dy) {
+				break
+			}
+			s.PlaceOnTile(e, tx, ty)
+			e.stepping = true
+			e.stepToX = e.X + float64(dx*s.TileWidth)
+			e.stepToY = e.Y + float64(dy*s.TileHeight)
+		}
+
+		// any distance left over when the step finishes starts the next
+		// step, so holding a direction moves smoothly
+		remaining := math.Abs(e.stepToX-e.X) + math.Abs(e.stepToY-e.Y)
+		step := math.Min(dist, remaining)
+		e.X += math.Copysign(math.Min(step, math.Abs(e.stepToX-e.X)), e.stepToX-e.X)
+		e.Y += math.Copysign(math.Min(step, math.Abs(e.stepToY-e.Y)), e.stepToY-e.Y)
+		dist -= step
+		moved = true
+
+		if step == remaining {
+			e.X, e.Y = e.stepToX, e.stepToY
+			e.stepping = false
+		}
+	}
+	return moved
+}
+
+// tileFree reports whether an entity can step onto a tile
+func (s *Space) tileFree(e *Entity, tx, ty int) bool {
+	if !s.Tiles.Walkable(tx, ty) {
+		return false
+	}
+	for _, other := range s.Entities {
+		if other == e || !other.Solid {
+			continue
+		}
+		ox, oy := s.Tile(other)
+		if ox == tx && oy == ty {
+			return false
+		}
+		// an entity part way through a step has claimed the tile it is
+		// stepping to
+		if other.stepping {
+			sx := int(math.Floor((other.stepToX + other.W/2) / float64(s.TileWidth)))
+			sy := int(math.Floor((other.stepToY + other.H/2) / float64(s.TileHeight)))
+			if sx == tx && sy == ty {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// moveFree moves an entity along each axis in turn, stopping at the first
+// blocking tile or entity. Diagonal movement is scaled down so it is no
+// faster than moving along one axis
+func (s *Space) moveFree(e *Entity, dx, dy int, dt float64) bool {
+	if dx == 0 && dy == 0 {
+		return false
+	}
+	face(e, dx, dy)
+
+	dist := e.Speed * dt
+	if dx != 0 && dy != 0 {
+		dist /= math.Sqrt2
+	}
+
+	startX, startY := e.X, e.Y
+	s.slide(e, &e.X, float64(dx)*dist)
+	s.slide(e, &e.Y, float64(dy)*dist)
+	return e.X != startX || e.Y != startY
+}
+
+// slide moves one coordinate of an entity by delta, a pixel at a time so it
+// stops against whatever it hits
+func (s *Space) slide(e *Entity, pos *float64, delta float64) {
+	for delta != 0 {
+		step := math.Copysign(math.Min(math.Abs(delta), 1), delta)
+		*pos += step
+		if s.blocked(e) {
+			*pos -= step
+			// close the gap, halving the step until it is too small to see
+			for step = step / 2; math.Abs(step) > 1.0/64; step /= 2 {
+				*pos += step
+				if s.blocked(e) {
+					*pos -= step
+				}
+			}
+			return
+		}
+		delta -= step
+	}
+}
+
+// blocked reports whether an entity's hitbox overlaps a blocking tile or a
+// solid entity
+func (s *Space) blocked(e *Entity) bool {
+	x0, y0, x1, y1 := e.Rect()
+	tw, th := float64(s.TileWidth), float64(s.TileHeight)
+	for ty := int(math.Floor(y0 / th)); ty <= int(math.Ceil(y1/th))-1; ty++ {
+		for tx := int(math.Floor(x0 / tw)); tx <= int(math.Ceil(x1/tw))-1; tx++ {
+			if !s.Tiles.Walkable(tx, ty) {
+				return true
+			}
+		}
+	}
+
+	if !e.Solid {
+		return false
+	}
+	for _, other := range s.Entities {
+		if other == e || !other.Solid {
+			continue
+		}
+		ox0, oy0, ox1, oy1 := other.Rect()
+		if x0 < ox1 && ox0 < x1 && y0 < oy1 && oy0 < y1 {
+			return true
+		}
+	}
+	return false
+}
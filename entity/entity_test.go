@@ -0,0 +1,172 @@
+package entity
+
+import (
+	"math"
+	"testing"
+)
+
+const tileSize = 16
+
+// grid is a map drawn as text, # is a wall and anything else is walkable.
+// Positions off the map are walls
+type grid []string
+
+func (g grid) Walkable(tx, ty int) bool {
+	if ty < 0 || ty >= len(g) || tx < 0 || tx >= len(g[ty]) {
+		return false
+	}
+	return g[ty][tx] != '#'
+}
+
+var open = grid{
+	"..........",
+	"..........",
+	"..........",
+	"..........",
+	"..........",
+}
+
+// newSpace returns a space holding one entity in the middle of tile 1, 1
+func newSpace(tiles Tiles, mode Mode) (*Space, *Entity) {
+	s := &Space{Tiles: tiles, TileWidth: tileSize, TileHeight: tileSize, Mode: mode}
+	e := &Entity{Name: "player", W: 12, H: 12, Speed: 32, Solid: true}
+	s.Add(e)
+	s.PlaceOnTile(e, 1, 1)
+	return s, e
+}
+
+// run moves an entity for a number of seconds split into tps updates a second
+func run(s *Space, e *Entity, dx, dy int, seconds float64, tps int) {
+	dt := 1 / float64(tps)
+	for i := 0; i < int(math.Round(seconds*float64(tps))); i++ {
+		s.Move(e, dx, dy, dt)
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestSameSpeedAtAnyTPS(t *testing.T) {
+	tests := []struct {
+		name string
+		mode Mode
+		tps  int
+	}{
+		{name: "grid 30", mode: GridMode, tps: 30},
+		{name: "grid 60", mode: GridMode, tps: 60},
+		{name: "grid 144", mode: GridMode, tps: 144},
+		{name: "free 30", mode: FreeMode, tps: 30},
+		{name: "free 60", mode: FreeMode, tps: 60},
+		{name: "free 144", mode: FreeMode, tps: 144},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, e := newSpace(open, tt.mode)
+			startX, startY := e.X, e.Y
+			// a second at 32 pixels a second is exactly two tiles
+			run(s, e, 1, 0, 1, tt.tps)
+			if !near(e.X-startX, 32) || e.Y != startY {
+				t.Errorf("moved %v, %v, want 32, 0", e.X-startX, e.Y-startY)
+			}
+		})
+	}
+}
+
+func TestGridStepsFinishOnTiles(t *testing.T) {
+	s, e := newSpace(open, GridMode)
+	// half a tile then let go, the step carries on to the next tile
+	run(s, e, 1, 0, 0.25, 60)
+	if !e.Moving() {
+		t.Fatal("the entity stopped part way through a step")
+	}
+	run(s, e, 0, 0, 1, 60)
+	if e.Moving() {
+		t.Error("the entity is still stepping")
+	}
+	if tx, ty := s.Tile(e); tx != 2 || ty != 1 {
+		t.Errorf("the entity is on tile %d, %d, want 2, 1", tx, ty)
+	}
+}
+
+func TestDiagonalMovement(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   Mode
+		dx, dy float64 // distance moved in one second
+	}{
+		// free movement is scaled so diagonals are no faster
+		{name: "free", mode: FreeMode, dx: 32 / math.Sqrt2, dy: 32 / math.Sqrt2},
+		// grid steps only go one way, horizontal wins
+		{name: "grid", mode: GridMode, dx: 32, dy: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, e := newSpace(open, tt.mode)
+			startX, startY := e.X, e.Y
+			run(s, e, 1, 1, 1, 60)
+			if !near(e.X-startX, tt.dx) || !near(e.Y-startY, tt.dy) {
+				t.Errorf("moved %v, %v, want %v, %v", e.X-startX, e.Y-startY, tt.dx, tt.dy)
+			}
+			if e.Facing != Right {
+				t.Errorf("facing %v, want right", e.Facing)
+			}
+		})
+	}
+}
+
+func TestWallsBlock(t *testing.T) {
+	walled := grid{
+		"......",
+		"..#...",
+		"......",
+	}
+
+	tests := []struct {
+		name   string
+		mode   Mode
+		dx, dy int
+		wantX  float64 // right edge of the hitbox once blocked, 0 if it mustn't move
+	}{
+		{name: "grid right", mode: GridMode, dx: 1},
+		{name: "free right", mode: FreeMode, dx: 1, wantX: 2 * tileSize},
+		{name: "grid diagonal", mode: GridMode, dx: 1, dy: 1}, // horizontal wins, so it doesn't step down
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, e := newSpace(walled, tt.mode)
+			startX, startY := e.X, e.Y
+			run(s, e, tt.dx, tt.dy, 1, 60)
+
+			switch {
+			case tt.wantX == 0 && (e.X != startX || e.Y != startY):
+				t.Errorf("moved to %v, %v, want to stay at %v, %v", e.X, e.Y, startX, startY)
+			case tt.wantX != 0 && math.Abs(e.X+e.W-tt.wantX) > 1.0/32:
+				t.Errorf("right edge is at %v, want it against the wall at %v", e.X+e.W, tt.wantX)
+			}
+			if _, y0, _, y1 := e.Rect(); tt.dy == 0 && (y0 < tileSize || y1 > 2*tileSize) {
+				t.Errorf("the entity left its row, it is at y %v to %v", y0, y1)
+			}
+		})
+	}
+}
+
+func TestSolidEntitiesBlock(t *testing.T) {
+	for _, mode := range []Mode{GridMode, FreeMode} {
+		s, e := newSpace(open, mode)
+		other := &Entity{Name: "villager", W: 12, H: 12, Solid: true}
+		s.Add(other)
+		s.PlaceOnTile(other, 3, 1)
+
+		run(s, e, 1, 0, 2, 60)
+		if _, _, x1, _ := e.Rect(); x1 > other.X+1.0/32 {
+			t.Errorf("mode %v: the entity reached %v, past the villager at %v", mode, x1, other.X)
+		}
+		if tx, _ := s.Tile(e); mode == GridMode && tx != 2 {
+			t.Errorf("grid mode: the entity stopped on tile %d, want 2", tx)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package input
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+// button and axis numbers of the common xbox style layout, the d-pad is
+// reported as buttons after the face and shoulder buttons
+const (
+	buttonA  ebiten.GamepadButton = ebiten.GamepadButton0
+	buttonB  ebiten.GamepadButton = ebiten.GamepadButton1
+	padUp    ebiten.GamepadButton = ebiten.GamepadButton11
+	padRight ebiten.GamepadButton = ebiten.GamepadButton12
+	padDown  ebiten.GamepadButton = ebiten.GamepadButton13
+	padLeft  ebiten.GamepadButton = ebiten.GamepadButton14
+	stickX                        = 0
+	stickY                        = 1
+)
+
+// DeadZone is how far an axis must be pushed from the centre, from 0 to 1,
+// before it counts as pressed. It stops worn sticks from drifting through menus
+var DeadZone = 0.3
+
+var (
+	gamepads   []int                // ids of the gamepads connected this frame
+	axisNow    = map[Binding]bool{} // axis directions pushed past the dead zone this frame
+	axisBefore = map[Binding]bool{} // axis directions pushed past the dead zone last frame
+)
+
+// updateGamepads reads every connected gamepad, it is called by Update so
+// gamepads can be plugged in and unplugged at any time
+func updateGamepads() {
+	for _, id := range inpututil.JustConnectedGamepadIDs() {
+		log.Printf("gamepad %d connected: %s\n", id, ebiten.GamepadName(id))
+	}
+	for _, id := range gamepads {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			log.Printf("gamepad %d disconnected\n", id)
+		}
+	}
+
+	gamepads = ebiten.GamepadIDs()
+	sort.Ints(gamepads)
+
+	axisBefore, axisNow = axisNow, map[Binding]bool{}
+	for _, id := range gamepads {
+		for axis := 0; axis < ebiten.GamepadAxisNum(id); axis++ {
+			v := ebiten.GamepadAxis(id, axis)
+			if math.Abs(v) <= DeadZone {
+				continue
+			}
+			dir := 1
+			if v < 0 {
+				dir = -1
+			}
+			axisNow[AxisBinding(axis, dir)] = true
+		}
+	}
+}
+
+// Gamepads returns a description of each connected gamepad
+func Gamepads() []string {
+	names := []string{}
+	for _, id := range gamepads {
+		names = append(names, fmt.Sprintf("%d: %s", id+1, ebiten.GamepadName(id)))
+	}
+	return names
+}
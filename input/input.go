@@ -0,0 +1,417 @@
+// Package input maps physical inputs, such as keys, to named actions such as
+// Confirm. Scenes check actions instead of keys so players can rebind them.
+package input
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+// Action is something the player can do, it is named so bindings can be saved
+type Action string
+
+// actions shared by every menu
+const (
+	MenuUp    Action = "menu_up"
+	MenuDown  Action = "menu_down"
+	MenuLeft  Action = "menu_left"
+	MenuRight Action = "menu_right"
+	Confirm   Action = "confirm"
+	Back      Action = "back"
+)
+
+// maxBindings is the most keys and mouse buttons, and separately the most
+// gamepad inputs, which can be bound to a single action
+const maxBindings = 3
+
+type bindingKind int
+
+const (
+	keyBinding bindingKind = iota
+	mouseBinding
+	buttonBinding
+	axisBinding
+)
+
+// Binding is a physical input which triggers an action, a key, a mouse
+// button, a gamepad button or one direction of a gamepad axis
+type Binding struct {
+	kind   bindingKind
+	key    ebiten.Key
+	mouse  ebiten.MouseButton
+	button ebiten.GamepadButton
+	axis   int
+	dir    int // -1 or 1, the direction the axis must be pushed in
+}
+
+// KeyBinding returns a binding for a key
+func KeyBinding(k ebiten.Key) Binding {
+	return Binding{kind: keyBinding, key: k}
+}
+
+// MouseBinding returns a binding for a mouse button
+func MouseBinding(b ebiten.MouseButton) Binding {
+	return Binding{kind: mouseBinding, mouse: b}
+}
+
+// ButtonBinding returns a binding for a button on any gamepad
+func ButtonBinding(b ebiten.GamepadButton) Binding {
+	return Binding{kind: buttonBinding, button: b}
+}
+
+// AxisBinding returns a binding for pushing an axis of any gamepad in a
+// direction, dir is -1 or 1
+func AxisBinding(axis, dir int) Binding {
+	if dir < 0 {
+		dir = -1
+	} else {
+		dir = 1
+	}
+	return Binding{kind: axisBinding, axis: axis, dir: dir}
+}
+
+// IsGamepad returns true if the binding is a gamepad button or axis, keys and
+// mouse buttons are both keyboard and mouse bindings
+func (b Binding) IsGamepad() bool {
+	return b.kind == buttonBinding || b.kind == axisBinding
+}
+
+// String returns the name the binding is saved with
+func (b Binding) String() string {
+	switch b.kind {
+	case mouseBinding:
+		return mouseNames[b.mouse]
+	case buttonBinding:
+		return fmt.Sprintf("Button%d", b.button)
+	case axisBinding:
+		if b.dir < 0 {
+			return fmt.Sprintf("Axis%d-", b.axis)
+		}
+		return fmt.Sprintf("Axis%d+", b.axis)
+	}
+	return keyNames[b.key]
+}
+
+// ParseBinding parses the name of a binding, as returned by Binding.String
+func ParseBinding(name string) (Binding, error) {
+	if k, ok := keysByName[name]; ok {
+		return KeyBinding(k), nil
+	}
+	for m, mname := range mouseNames {
+		if name == mname {
+			return MouseBinding(m), nil
+		}
+	}
+
+	var n int
+	if _, err := fmt.Sscanf(name, "Button%d", &n); err == nil && n >= 0 && n <= int(ebiten.GamepadButtonMax) {
+		return ButtonBinding(ebiten.GamepadButton(n)), nil
+	}
+
+	if strings.HasPrefix(name, "Axis") && len(name) > 5 {
+		dir := name[len(name)-1:]
+		if _, err := fmt.Sscanf(name[:len(name)-1], "Axis%d", &n); err == nil && n >= 0 {
+			switch dir {
+			case "-":
+				return AxisBinding(n, -1), nil
+			case "+":
+				return AxisBinding(n, 1), nil
+			}
+		}
+	}
+
+	return Binding{}, fmt.Errorf("unknown input %q", name)
+}
+
+func (b Binding) pressed() bool {
+	switch b.kind {
+	case mouseBinding:
+		return ebiten.IsMouseButtonPressed(b.mouse)
+	case buttonBinding:
+		for _, id := range gamepads {
+			if ebiten.IsGamepadButtonPressed(id, b.button) {
+				return true
+			}
+		}
+		return false
+	case axisBinding:
+		return axisNow[b]
+	}
+	return ebiten.IsKeyPressed(b.key)
+}
+
+func (b Binding) justPressed() bool {
+	switch b.kind {
+	case mouseBinding:
+		return inpututil.IsMouseButtonJustPressed(b.mouse)
+	case buttonBinding:
+		for _, id := range gamepads {
+			if inpututil.IsGamepadButtonJustPressed(id, b.button) {
+				return true
+			}
+		}
+		return false
+	case axisBinding:
+		return axisNow[b] && !axisBefore[b]
+	}
+	return inpututil.IsKeyJustPressed(b.key)
+}
+
+var (
+	actions  []Action // every action, in the order they are listed to the player
+	defaults = map[Action][]Binding{}
+	bindings = map[Action][]Binding{}
+)
+
+func init() {
+	Register(MenuUp, KeyBinding(ebiten.KeyUp), KeyBinding(ebiten.KeyW), ButtonBinding(padUp), AxisBinding(stickY, -1))
+	Register(MenuDown, KeyBinding(ebiten.KeyDown), KeyBinding(ebiten.KeyS), ButtonBinding(padDown), AxisBinding(stickY, 1))
+	Register(MenuLeft, KeyBinding(ebiten.KeyLeft), KeyBinding(ebiten.KeyA), ButtonBinding(padLeft), AxisBinding(stickX, -1))
+	Register(MenuRight, KeyBinding(ebiten.KeyRight), KeyBinding(ebiten.KeyD), ButtonBinding(padRight), AxisBinding(stickX, 1))
+	Register(Confirm, KeyBinding(ebiten.KeyEnter), KeyBinding(ebiten.KeySpace), ButtonBinding(buttonA))
+	Register(Back, KeyBinding(ebiten.KeyEscape), MouseBinding(ebiten.MouseButtonRight), ButtonBinding(buttonB))
+}
+
+// Update reads the mouse and gamepads, it must be called once at the start of
+// every frame before any actions are checked
+func Update() {
+	updateMouse()
+	updateGamepads()
+}
+
+// Register adds an action along with its default bindings, games use it for
+// actions beyond the shared menu actions
+func Register(a Action, defaultBindings ...Binding) {
+	if _, ok := defaults[a]; !ok {
+		actions = append(actions, a)
+	}
+	defaults[a] = defaultBindings
+	bindings[a] = append([]Binding{}, defaultBindings...)
+}
+
+// Actions returns every registered action
+func Actions() []Action {
+	return append([]Action{}, actions...)
+}
+
+// Pressed returns true while any input bound to the action is held down
+func Pressed(a Action) bool {
+	for _, b := range bindings[a] {
+		if b.pressed() {
+			return true
+		}
+	}
+	return false
+}
+
+// JustPressed returns true on the frame any input bound to the action is pressed
+func JustPressed(a Action) bool {
+	for _, b := range bindings[a] {
+		if b.justPressed() {
+			return true
+		}
+	}
+	return false
+}
+
+// GamepadJustPressed returns true on the frame any gamepad input bound to the
+// action is pressed, for screens where the keyboard is used for typing
+func GamepadJustPressed(a Action) bool {
+	for _, b := range bindings[a] {
+		if b.IsGamepad() && b.justPressed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Bindings returns the inputs bound to an action
+func Bindings(a Action) []Binding {
+	return append([]Binding{}, bindings[a]...)
+}
+
+// Describe returns the names of the inputs bound to an action, for display
+func Describe(a Action) string {
+	names := []string{}
+	for _, b := range bindings[a] {
+		names = append(names, b.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+// Bind adds an input to an action, taking it from any other action so one
+// input never triggers two actions. If the action already has the most
+// bindings allowed for that kind of device its oldest one is dropped.
+//
+// An action is never left without a binding for keyboard and mouse, or for
+// gamepads, as the player would have no way to use it. If taking the input
+// would do that, the action it came from is given the binding the action it
+// goes to drops, or else that action's oldest binding of the same kind, so the
+// two swap. Bind returns false without binding anything when there is nothing
+// to swap
+func Bind(a Action, b Binding) bool {
+	var from Action
+	taken := false
+	for other, bs := range bindings {
+		if other != a && slices.Contains(bs, b) {
+			from, taken = other, true
+		}
+	}
+
+	bs := append(without(bindings[a], b), b)
+	var dropped []Binding
+	if countKind(bs, b) > maxBindings {
+		i := indexKind(bs, b)
+		dropped = []Binding{bs[i]}
+		bs = append(bs[:i:i], bs[i+1:]...)
+	}
+
+	if taken {
+		rest := without(bindings[from], b)
+		if countKind(rest, b) == 0 {
+			swap := dropped
+			if len(swap) == 0 {
+				i := indexKind(bs, b)
+				if bs[i] == b {
+					return false
+				}
+				swap = []Binding{bs[i]}
+				bs = append(bs[:i:i], bs[i+1:]...)
+			}
+			rest = append(rest, swap...)
+		}
+		bindings[from] = rest
+	}
+	bindings[a] = bs
+	return true
+}
+
+// countKind returns how many bindings are for the same kind of device as b
+func countKind(bs []Binding, b Binding) int {
+	n := 0
+	for _, other := range bs {
+		if other.IsGamepad() == b.IsGamepad() {
+			n++
+		}
+	}
+	return n
+}
+
+// indexKind returns the index of the first binding for the same kind of device
+// as b, or -1
+func indexKind(bs []Binding, b Binding) int {
+	for i, other := range bs {
+		if other.IsGamepad() == b.IsGamepad() {
+			return i
+		}
+	}
+	return -1
+}
+
+// ResetBindings puts every action back to its default bindings
+func ResetBindings() {
+	for a, bs := range defaults {
+		bindings[a] = append([]Binding{}, bs...)
+	}
+}
+
+// JustPressedBinding returns the first bindable input pressed this frame, it is
+// used to listen for the input to bind when a player rebinds an action
+func JustPressedBinding() (Binding, bool) {
+	for k := range keyNames {
+		if inpututil.IsKeyJustPressed(k) {
+			return KeyBinding(k), true
+		}
+	}
+
+	for m := range mouseNames {
+		if inpututil.IsMouseButtonJustPressed(m) {
+			return MouseBinding(m), true
+		}
+	}
+
+	for _, id := range gamepads {
+		for b := ebiten.GamepadButton0; b <= ebiten.GamepadButtonMax; b++ {
+			if inpututil.IsGamepadButtonJustPressed(id, b) {
+				return ButtonBinding(b), true
+			}
+		}
+	}
+
+	for b, now := range axisNow {
+		if now && !axisBefore[b] {
+			return b, true
+		}
+	}
+	return Binding{}, false
+}
+
+// Export returns every action's bindings by name, ready to be saved
+func Export() map[string][]string {
+	saved := map[string][]string{}
+	for a, bs := range bindings {
+		names := []string{}
+		for _, b := range bs {
+			names = append(names, b.String())
+		}
+		saved[string(a)] = names
+	}
+	return saved
+}
+
+// Import replaces the bindings of the actions named in saved, as returned by
+// Export. Actions not named keep their bindings, unknown actions and inputs
+// are skipped and reported in the error. An action which would be left with
+// no bindings gets its defaults instead
+func Import(saved map[string][]string) error {
+	problems := []string{}
+
+	names := []string{}
+	for name := range saved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		a := Action(name)
+		if _, ok := defaults[a]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown action %q", name))
+			continue
+		}
+
+		bs := []Binding{}
+		for _, bname := range saved[name] {
+			b, err := ParseBinding(bname)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("action %q: %v", name, err))
+				continue
+			}
+			bs = append(bs, b)
+		}
+		if len(bs) == 0 {
+			problems = append(problems, fmt.Sprintf("action %q has no bindings, using the defaults", name))
+			bs = append(bs, defaults[a]...)
+		}
+		bindings[a] = bs
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("bindings: %s", strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func without(bs []Binding, b Binding) []Binding {
+	kept := []Binding{}
+	for _, other := range bs {
+		if other != b {
+			kept = append(kept, other)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,115 @@
+package input
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// resetForTest puts every action back to its defaults once the test is over
+func resetForTest(t *testing.T) {
+	ResetBindings()
+	t.Cleanup(ResetBindings)
+}
+
+func TestBindTakesInputFromOtherActions(t *testing.T) {
+	resetForTest(t)
+	space := KeyBinding(ebiten.KeySpace)
+	if !slices.Contains(Bindings(Confirm), space) {
+		t.Fatal("space is not a default binding of confirm")
+	}
+
+	if !Bind(MenuUp, space) {
+		t.Fatal("binding space to up was refused")
+	}
+	if slices.Contains(Bindings(Confirm), space) {
+		t.Error("space is bound to confirm and up")
+	}
+	if !slices.Contains(Bindings(MenuUp), space) {
+		t.Error("space is not bound to up")
+	}
+}
+
+func TestBindNeverLeavesAnActionUnbound(t *testing.T) {
+	resetForTest(t)
+	for _, b := range Bindings(Confirm) {
+		Bind(MenuUp, b)
+	}
+
+	if countKind(Bindings(Confirm), KeyBinding(ebiten.KeyEnter)) == 0 {
+		t.Errorf("confirm has no keyboard bindings left: %v", Bindings(Confirm))
+	}
+	if countKind(Bindings(Confirm), ButtonBinding(ebiten.GamepadButton0)) == 0 {
+		t.Errorf("confirm has no gamepad bindings left: %v", Bindings(Confirm))
+	}
+	for _, a := range Actions() {
+		for _, b := range Bindings(a) {
+			for _, other := range Actions() {
+				if other != a && slices.Contains(Bindings(other), b) {
+					t.Errorf("%s is bound to %s and %s", b, a, other)
+				}
+			}
+		}
+	}
+}
+
+func TestBindCountsMouseAsKeyboard(t *testing.T) {
+	resetForTest(t)
+	buttonB := ButtonBinding(ebiten.GamepadButton1)
+	rightClick := MouseBinding(ebiten.MouseButtonRight)
+	if !slices.Contains(Bindings(Back), buttonB) || !slices.Contains(Bindings(Back), rightClick) {
+		t.Fatalf("back is bound to %v, want gamepad button 1 and right click", Bindings(Back))
+	}
+
+	// back's only gamepad button, the right click must not count as one
+	if !Bind(MenuUp, buttonB) {
+		t.Fatal("binding gamepad button 1 to up was refused")
+	}
+	if !slices.Contains(Bindings(Back), ButtonBinding(padUp)) {
+		t.Errorf("back is bound to %v, want up's d-pad button swapped in", Bindings(Back))
+	}
+
+	// with escape gone the right click is back's only keyboard and mouse
+	// binding, so taking it swaps in one of up's keys
+	bindings[Back] = without(Bindings(Back), KeyBinding(ebiten.KeyEscape))
+	if !Bind(MenuUp, rightClick) {
+		t.Fatal("binding right click to up was refused")
+	}
+	if !slices.Contains(Bindings(Back), KeyBinding(ebiten.KeyUp)) {
+		t.Errorf("back is bound to %v, want up's arrow key swapped in", Bindings(Back))
+	}
+	if rightClick.IsGamepad() {
+		t.Error("right click is counted as a gamepad button")
+	}
+}
+
+func TestBindRefusesWithNothingToSwap(t *testing.T) {
+	resetForTest(t)
+	enter := KeyBinding(ebiten.KeyEnter)
+	bindings[Confirm] = []Binding{enter}
+	bindings[MenuUp] = []Binding{ButtonBinding(ebiten.GamepadButton11)}
+
+	if Bind(MenuUp, enter) {
+		t.Error("confirm's last key was taken")
+	}
+	if !slices.Equal(Bindings(Confirm), []Binding{enter}) {
+		t.Errorf("confirm is bound to %v, want enter", Bindings(Confirm))
+	}
+}
+
+func TestImportEmptyUsesDefaults(t *testing.T) {
+	resetForTest(t)
+	defaultBack := Bindings(Back)
+
+	err := Import(map[string][]string{"back": {}, "confirm": {"not a key"}})
+	if err == nil {
+		t.Error("importing empty bindings reported no problem")
+	}
+	if !slices.Equal(Bindings(Back), defaultBack) {
+		t.Errorf("back is bound to %v, want the defaults %v", Bindings(Back), defaultBack)
+	}
+	if len(Bindings(Confirm)) == 0 {
+		t.Error("confirm was left with no bindings")
+	}
+}
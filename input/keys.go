@@ -0,0 +1,52 @@
+package input
+
+import "github.com/hajimehoshi/ebiten"
+
+// keyNames are the names keys are saved with, only these keys can be bound
+var keyNames = map[ebiten.Key]string{
+	ebiten.Key0: "0", ebiten.Key1: "1", ebiten.Key2: "2", ebiten.Key3: "3", ebiten.Key4: "4",
+	ebiten.Key5: "5", ebiten.Key6: "6", ebiten.Key7: "7", ebiten.Key8: "8", ebiten.Key9: "9",
+	ebiten.KeyA: "A", ebiten.KeyB: "B", ebiten.KeyC: "C", ebiten.KeyD: "D", ebiten.KeyE: "E",
+	ebiten.KeyF: "F", ebiten.KeyG: "G", ebiten.KeyH: "H", ebiten.KeyI: "I", ebiten.KeyJ: "J",
+	ebiten.KeyK: "K", ebiten.KeyL: "L", ebiten.KeyM: "M", ebiten.KeyN: "N", ebiten.KeyO: "O",
+	ebiten.KeyP: "P", ebiten.KeyQ: "Q", ebiten.KeyR: "R", ebiten.KeyS: "S", ebiten.KeyT: "T",
+	ebiten.KeyU: "U", ebiten.KeyV: "V", ebiten.KeyW: "W", ebiten.KeyX: "X", ebiten.KeyY: "Y",
+	ebiten.KeyZ:            "Z",
+	ebiten.KeyUp:           "Up",
+	ebiten.KeyDown:         "Down",
+	ebiten.KeyLeft:         "Left",
+	ebiten.KeyRight:        "Right",
+	ebiten.KeyEnter:        "Enter",
+	ebiten.KeyEscape:       "Escape",
+	ebiten.KeySpace:        "Space",
+	ebiten.KeyTab:          "Tab",
+	ebiten.KeyBackspace:    "Backspace",
+	ebiten.KeyShift:        "Shift",
+	ebiten.KeyControl:      "Control",
+	ebiten.KeyAlt:          "Alt",
+	ebiten.KeyComma:        "Comma",
+	ebiten.KeyPeriod:       "Period",
+	ebiten.KeySlash:        "Slash",
+	ebiten.KeySemicolon:    "Semicolon",
+	ebiten.KeyApostrophe:   "Apostrophe",
+	ebiten.KeyLeftBracket:  "LeftBracket",
+	ebiten.KeyRightBracket: "RightBracket",
+	ebiten.KeyMinus:        "Minus",
+	ebiten.KeyEqual:        "Equal",
+	ebiten.KeyHome:         "Home",
+	ebiten.KeyEnd:          "End",
+	ebiten.KeyPageUp:       "PageUp",
+	ebiten.KeyPageDown:     "PageDown",
+	ebiten.KeyInsert:       "Insert",
+	ebiten.KeyDelete:       "Delete",
+	ebiten.KeyKPEnter:      "KPEnter",
+}
+
+// keysByName is the reverse of keyNames
+var keysByName = map[string]ebiten.Key{}
+
+func init() {
+	for k, name := range keyNames {
+		keysByName[name] = k
+	}
+}
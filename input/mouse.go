@@ -0,0 +1,70 @@
+package input
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+)
+
+// mouseNames are the names mouse buttons are saved with
+var mouseNames = map[ebiten.MouseButton]string{
+	ebiten.MouseButtonLeft:   "MouseLeft",
+	ebiten.MouseButtonRight:  "MouseRight",
+	ebiten.MouseButtonMiddle: "MouseMiddle",
+}
+
+var (
+	cursor       image.Point // cursor position this frame
+	cursorBefore image.Point // cursor position last frame
+)
+
+// updateMouse records where the cursor is, it is called by Update
+func updateMouse() {
+	cursorBefore = cursor
+	cursor = image.Pt(ebiten.CursorPosition())
+}
+
+// Cursor returns the position of the mouse cursor on the screen
+func Cursor() image.Point {
+	return cursor
+}
+
+// CursorMoved returns true if the cursor moved since the last frame, menus only
+// follow the cursor when it moves so it does not fight the keyboard
+func CursorMoved() bool {
+	return cursor != cursorBefore
+}
+
+// Clicked returns true on the frame the left mouse button is pressed
+func Clicked() bool {
+	return inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
+}
+
+// Hovering returns true if the cursor is inside r
+func Hovering(r image.Rectangle) bool {
+	return cursor.In(r)
+}
+
+// ClickedIn returns true on the frame the left mouse button is pressed while
+// the cursor is inside r
+func ClickedIn(r image.Rectangle) bool {
+	return Clicked() && cursor.In(r)
+}
+
+// Scrolled returns how far the mouse wheel was scrolled this frame, it is
+// positive when scrolled up
+func Scrolled() float64 {
+	_, dy := ebiten.Wheel()
+	return dy
+}
+
+// MouseDown returns true while the left mouse button is held, for dragging
+func MouseDown() bool {
+	return ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+}
+
+// Released returns true on the frame the left mouse button is let go
+func Released() bool {
+	return inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft)
+}
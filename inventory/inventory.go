@@ -0,0 +1,332 @@
+// Package inventory keeps track of the items a character carries. Items are
+// described by definitions loaded from a data file, an inventory is a fixed
+// number of slots which each hold a stack of one kind of item, along with the
+// items the character has equipped.
+//
+// What using or equipping an item does is up to the game, definitions carry
+// named amounts for the game to interpret.
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+)
+
+// errors returned when items don't fit
+var (
+	ErrFull     = errors.New("inventory is full")
+	ErrTooHeavy = errors.New("inventory is too heavy")
+)
+
+// Def describes a kind of item, name and description are locale message IDs
+// and icon is an asset ID
+type Def struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Icon        string         `json:"icon"`
+	Stack       int            `json:"stack"`             // most items one slot can hold, 1 for items which don't stack
+	Weight      float64        `json:"weight"`            // weight of one item
+	Slot        string         `json:"slot,omitempty"`    // equipment slot the item is worn in, empty if it can't be equipped
+	Use         map[string]int `json:"use,omitempty"`     // what using the item does, it is used up
+	Bonuses     map[string]int `json:"bonuses,omitempty"` // what the item adds to its wearer while equipped
+}
+
+// Catalogue holds every item definition
+type Catalogue struct {
+	Slots []string `json:"slots"` // equipment slots, in the order they are shown
+	Items []*Def   `json:"items"`
+
+	byID map[string]*Def
+}
+
+// Load reads and validates an item catalogue
+func Load(fsys fs.FS, path string) (*Catalogue, error) {
+	c := &Catalogue{}
+	if err := datafile.Decode(fsys, path, c); err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, c.validate()); err != nil {
+		return nil, err
+	}
+
+	c.byID = map[string]*Def{}
+	for _, d := range c.Items {
+		c.byID[d.ID] = d
+	}
+	return c, nil
+}
+
+// validate checks every item has a unique ID, a name, an icon and a sensible
+// stack and weight, and that only items worn in a known slot have bonuses
+func (c *Catalogue) validate() []string {
+	errs := []string{}
+	if len(c.Items) == 0 {
+		errs = append(errs, "there are no items")
+	}
+
+	seen := map[string]bool{}
+	for i, d := range c.Items {
+		if d == nil {
+			errs = append(errs, fmt.Sprintf("item %d is empty", i+1))
+			continue
+		}
+		where := fmt.Sprintf("item %d (%q)", i+1, d.ID)
+		if d.ID == "" {
+			errs = append(errs, where+" has no id")
+		}
+		if seen[d.ID] {
+			errs = append(errs, where+" has the same id as an earlier item")
+		}
+		seen[d.ID] = true
+
+		if d.Name == "" {
+			errs = append(errs, where+" has no name")
+		}
+		if d.Icon == "" {
+			errs = append(errs, where+" has no icon")
+		}
+		if d.Stack < 1 {
+			errs = append(errs, where+": stack must be at least 1")
+		}
+		if d.Weight < 0 {
+			errs = append(errs, where+": weight must not be negative")
+		}
+		if d.Slot != "" && !slices.Contains(c.Slots, d.Slot) {
+			errs = append(errs, fmt.Sprintf("%s has unknown equipment slot %q, expected one of %s", where, d.Slot, strings.Join(c.Slots, ", ")))
+		}
+		if d.Slot != "" && d.Stack != 1 {
+			errs = append(errs, where+" can be equipped so must have a stack of 1")
+		}
+		if d.Slot == "" && len(d.Bonuses) > 0 {
+			errs = append(errs, where+" has bonuses but no equipment slot")
+		}
+	}
+	return errs
+}
+
+// Get returns the definition of an item
+func (c *Catalogue) Get(id string) (*Def, bool) {
+	d, ok := c.byID[id]
+	return d, ok
+}
+
+// Icons returns the icon asset ID of every item
+func (c *Catalogue) Icons() []string {
+	ids := []string{}
+	for _, d := range c.Items {
+		ids = append(ids, d.Icon)
+	}
+	return ids
+}
+
+// Stack is some of one kind of item, an empty stack has no ID
+type Stack struct {
+	ID    string `json:"id,omitempty"`
+	Count int    `json:"count,omitempty"`
+}
+
+// Empty reports whether the stack holds nothing
+func (s Stack) Empty() bool {
+	return s.ID == "" || s.Count <= 0
+}
+
+// Contents is what an inventory holds, it is what games save
+type Contents struct {
+	Slots    []Stack           `json:"slots"`
+	Equipped map[string]string `json:"equipped,omitempty"` // equipment slot to item ID
+}
+
+// Inventory is a character's items along with the limits on what it can hold
+type Inventory struct {
+	Contents
+	Items     *Catalogue
+	MaxWeight float64 // 0 for no limit
+}
+
+// New returns an inventory with a number of slots holding contents, contents
+// with more slots than that are cut short. Items which aren't in the catalogue
+// are dropped so old saves keep working when items are removed from the game
+func New(items *Catalogue, slots int, maxWeight float64, contents Contents) *Inventory {
+	inv := &Inventory{Items: items, MaxWeight: maxWeight}
+	inv.Slots = make([]Stack, slots)
+	for i := 0; i < slots && i < len(contents.Slots); i++ {
+		s := contents.Slots[i]
+		if _, ok := items.Get(s.ID); ok && !s.Empty() {
+			inv.Slots[i] = s
+		}
+	}
+	inv.Equipped = map[string]string{}
+	for slot, id := range contents.Equipped {
+		if d, ok := items.Get(id); ok && d.Slot == slot {
+			inv.Equipped[slot] = id
+		}
+	}
+	return inv
+}
+
+// Weight returns the weight of everything carried, including equipped items
+func (inv *Inventory) Weight() float64 {
+	w := 0.0
+	for _, s := range inv.Slots {
+		if d, ok := inv.Items.Get(s.ID); ok && !s.Empty() {
+			w += d.Weight * float64(s.Count)
+		}
+	}
+	for _, id := range inv.Equipped {
+		if d, ok := inv.Items.Get(id); ok {
+			w += d.Weight
+		}
+	}
+	return w
+}
+
+// Count returns how many of an item are in the slots
+func (inv *Inventory) Count(id string) int {
+	n := 0
+	for _, s := range inv.Slots {
+		if s.ID == id {
+			n += s.Count
+		}
+	}
+	return n
+}
+
+// Add puts n of an item into the inventory, topping up stacks of the item
+// before using empty slots. It returns how many were added, which is less than
+// n along with ErrFull or ErrTooHeavy if they didn't all fit
+func (inv *Inventory) Add(id string, n int) (int, error) {
+	d, ok := inv.Items.Get(id)
+	if !ok {
+		return 0, fmt.Errorf("unknown item %q", id)
+	}
+
+	var err error
+	if inv.MaxWeight > 0 && d.Weight > 0 {
+		fit := int((inv.MaxWeight - inv.Weight() + 1e-9) / d.Weight)
+		if fit < n {
+			n, err = max(fit, 0), ErrTooHeavy
+		}
+	}
+
+	added := 0
+	for pass := 0; pass < 2 && added < n; pass++ {
+		for i := range inv.Slots {
+			s := &inv.Slots[i]
+			// top up existing stacks first, then fill empty slots
+			if pass == 0 && s.ID != id || pass == 1 && !s.Empty() {
+				continue
+			}
+			if s.Empty() {
+				*s = Stack{ID: id}
+			}
+			take := min(d.Stack-s.Count, n-added)
+			s.Count += take
+			added += take
+			if added == n {
+				break
+			}
+		}
+	}
+	if added < n {
+		err = ErrFull
+	}
+	return added, err
+}
+
+// Remove takes up to n items out of a slot and returns how many were taken
+func (inv *Inventory) Remove(slot, n int) int {
+	s := &inv.Slots[slot]
+	take := min(n, s.Count)
+	s.Count -= take
+	if s.Count <= 0 {
+		*s = Stack{}
+	}
+	return take
+}
+
+// Move moves the stack in one slot to another. Stacks of the same item are
+// merged as far as they can be, anything else is swapped
+func (inv *Inventory) Move(from, to int) {
+	if from == to {
+		return
+	}
+	src, dst := &inv.Slots[from], &inv.Slots[to]
+	if !src.Empty() && src.ID == dst.ID {
+		d, _ := inv.Items.Get(src.ID)
+		take := min(d.Stack-dst.Count, src.Count)
+		dst.Count += take
+		inv.Remove(from, take)
+		return
+	}
+	*src, *dst = *dst, *src
+}
+
+// Equip wears the item in a slot, anything already worn in its equipment slot
+// is put back in the slot the item came from
+func (inv *Inventory) Equip(slot int) error {
+	s := inv.Slots[slot]
+	d, ok := inv.Items.Get(s.ID)
+	if !ok || s.Empty() {
+		return errors.New("there is nothing to equip")
+	}
+	if d.Slot == "" {
+		return fmt.Errorf("%s can't be equipped", d.ID)
+	}
+
+	inv.Slots[slot] = Stack{}
+	if worn, ok := inv.Equipped[d.Slot]; ok {
+		inv.Slots[slot] = Stack{ID: worn, Count: 1}
+	}
+	inv.Equipped[d.Slot] = d.ID
+	return nil
+}
+
+// Unequip takes off the item worn in an equipment slot and puts it in slot to,
+// or the first empty slot if to is -1 or taken
+func (inv *Inventory) Unequip(equipSlot string, to int) error {
+	id, ok := inv.Equipped[equipSlot]
+	if !ok {
+		return nil
+	}
+	if to < 0 || to >= len(inv.Slots) || !inv.Slots[to].Empty() {
+		to = -1
+		for i, s := range inv.Slots {
+			if s.Empty() {
+				to = i
+				break
+			}
+		}
+	}
+	if to < 0 {
+		return ErrFull
+	}
+	inv.Slots[to] = Stack{ID: id, Count: 1}
+	delete(inv.Equipped, equipSlot)
+	return nil
+}
+
+// Bonus returns the total of a bonus over every equipped item
+func (inv *Inventory) Bonus(name string) int {
+	total := 0
+	for _, id := range inv.Equipped {
+		if d, ok := inv.Items.Get(id); ok {
+			total += d.Bonuses[name]
+		}
+	}
+	return total
+}
+
+// Save returns a copy of what the inventory holds, for saving
+func (inv *Inventory) Save() Contents {
+	c := Contents{Slots: append([]Stack{}, inv.Slots...), Equipped: map[string]string{}}
+	for slot, id := range inv.Equipped {
+		c.Equipped[slot] = id
+	}
+	return c
+}
@@ -0,0 +1,184 @@
+package inventory
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+// testItems is a small catalogue, potions stack to 5 and weigh 1 each
+const testItems = `{
+	"slots": ["weapon"],
+	"items": [
+		{"id": "potion", "name": "potion", "icon": "potion", "stack": 5, "weight": 1},
+		{"id": "sword", "name": "sword", "icon": "sword", "stack": 1, "weight": 3, "slot": "weapon", "bonuses": {"attack": 2}},
+		{"id": "axe", "name": "axe", "icon": "axe", "stack": 1, "weight": 4, "slot": "weapon", "bonuses": {"attack": 3}}
+	]
+}`
+
+// newTest returns an empty inventory of the test items
+func newTest(t *testing.T, slots int, maxWeight float64) *Inventory {
+	t.Helper()
+	c, err := Load(fstest.MapFS{"items.json": {Data: []byte(testItems)}}, "items.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(c, slots, maxWeight, Contents{})
+}
+
+func TestAddFillsStacksThenSlots(t *testing.T) {
+	inv := newTest(t, 3, 0)
+	inv.Slots[1] = Stack{ID: "potion", Count: 3}
+
+	if n, err := inv.Add("potion", 4); n != 4 || err != nil {
+		t.Fatalf("Add returned %d, %v, want 4, nil", n, err)
+	}
+	want := []Stack{{ID: "potion", Count: 2}, {ID: "potion", Count: 5}, {}}
+	for i, s := range inv.Slots {
+		if s != want[i] {
+			t.Errorf("slot %d is %+v, want %+v", i, s, want[i])
+		}
+	}
+}
+
+func TestAddPartial(t *testing.T) {
+	tests := []struct {
+		name      string
+		slots     int
+		maxWeight float64
+		add       int
+		want      int
+		err       error
+	}{
+		{name: "too heavy", slots: 4, maxWeight: 6, add: 10, want: 6, err: ErrTooHeavy},
+		{name: "full", slots: 2, maxWeight: 0, add: 12, want: 10, err: ErrFull},
+		{name: "full before too heavy", slots: 1, maxWeight: 8, add: 10, want: 5, err: ErrFull},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := newTest(t, tt.slots, tt.maxWeight)
+			n, err := inv.Add("potion", tt.add)
+			if n != tt.want || err != tt.err {
+				t.Errorf("Add returned %d, %v, want %d, %v", n, err, tt.want, tt.err)
+			}
+			if got := inv.Count("potion"); got != tt.want {
+				t.Errorf("holds %d potions, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to Stack
+		wantFrom Stack
+		wantTo   Stack
+	}{
+		{
+			name:     "merge",
+			from:     Stack{ID: "potion", Count: 2},
+			to:       Stack{ID: "potion", Count: 1},
+			wantFrom: Stack{},
+			wantTo:   Stack{ID: "potion", Count: 3},
+		},
+		{
+			name:     "merge up to the stack size",
+			from:     Stack{ID: "potion", Count: 4},
+			to:       Stack{ID: "potion", Count: 3},
+			wantFrom: Stack{ID: "potion", Count: 2},
+			wantTo:   Stack{ID: "potion", Count: 5},
+		},
+		{
+			name:     "swap",
+			from:     Stack{ID: "potion", Count: 2},
+			to:       Stack{ID: "sword", Count: 1},
+			wantFrom: Stack{ID: "sword", Count: 1},
+			wantTo:   Stack{ID: "potion", Count: 2},
+		},
+		{
+			name:     "into an empty slot",
+			from:     Stack{ID: "sword", Count: 1},
+			wantFrom: Stack{},
+			wantTo:   Stack{ID: "sword", Count: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := newTest(t, 2, 0)
+			inv.Slots[0], inv.Slots[1] = tt.from, tt.to
+			inv.Move(0, 1)
+			if inv.Slots[0] != tt.wantFrom || inv.Slots[1] != tt.wantTo {
+				t.Errorf("slots are %+v, want %+v", inv.Slots, []Stack{tt.wantFrom, tt.wantTo})
+			}
+		})
+	}
+}
+
+func TestEquipSwapsWornItem(t *testing.T) {
+	inv := newTest(t, 2, 0)
+	inv.Slots[0] = Stack{ID: "sword", Count: 1}
+	inv.Slots[1] = Stack{ID: "axe", Count: 1}
+
+	if err := inv.Equip(0); err != nil {
+		t.Fatal(err)
+	}
+	if !inv.Slots[0].Empty() || inv.Equipped["weapon"] != "sword" {
+		t.Fatalf("after equipping the sword slots are %+v and %v is worn", inv.Slots, inv.Equipped)
+	}
+
+	if err := inv.Equip(1); err != nil {
+		t.Fatal(err)
+	}
+	if inv.Equipped["weapon"] != "axe" {
+		t.Errorf("wearing %q, want %q", inv.Equipped["weapon"], "axe")
+	}
+	if want := (Stack{ID: "sword", Count: 1}); inv.Slots[1] != want {
+		t.Errorf("slot the axe came from holds %+v, want %+v", inv.Slots[1], want)
+	}
+	if got := inv.Bonus("attack"); got != 3 {
+		t.Errorf("attack bonus is %d, want 3", got)
+	}
+}
+
+func TestEquipRefusesItemsWithoutASlot(t *testing.T) {
+	inv := newTest(t, 1, 0)
+	inv.Slots[0] = Stack{ID: "potion", Count: 1}
+	if err := inv.Equip(0); err == nil {
+		t.Error("a potion was equipped")
+	}
+}
+
+func TestUnequip(t *testing.T) {
+	inv := newTest(t, 2, 0)
+	inv.Equipped["weapon"] = "sword"
+	inv.Slots[0] = Stack{ID: "potion", Count: 1}
+
+	// slot 0 is taken so the sword goes in the first empty slot
+	if err := inv.Unequip("weapon", 0); err != nil {
+		t.Fatal(err)
+	}
+	if want := (Stack{ID: "sword", Count: 1}); inv.Slots[1] != want {
+		t.Errorf("slot 1 holds %+v, want %+v", inv.Slots[1], want)
+	}
+	if _, ok := inv.Equipped["weapon"]; ok {
+		t.Error("the sword is still worn")
+	}
+}
+
+func TestUnequipFull(t *testing.T) {
+	inv := newTest(t, 1, 0)
+	inv.Equipped["weapon"] = "sword"
+	inv.Slots[0] = Stack{ID: "potion", Count: 1}
+
+	if err := inv.Unequip("weapon", -1); err != ErrFull {
+		t.Errorf("Unequip returned %v, want %v", err, ErrFull)
+	}
+	if inv.Equipped["weapon"] != "sword" {
+		t.Error("the sword was taken off with nowhere to put it")
+	}
+	if want := (Stack{ID: "potion", Count: 1}); inv.Slots[0] != want {
+		t.Errorf("slot 0 holds %+v, want %+v", inv.Slots[0], want)
+	}
+}
@@ -0,0 +1,218 @@
+// Package locale looks up translated strings by message ID.
+//
+// Each language has a JSON file in this directory named after its language
+// code, for example en.json. A message is either a string, or an object of
+// plural forms keyed by "one" and "other". Messages missing from the current
+// language fall back to English, then to the message ID itself. Each file
+// names its language in its own words with the message "language.name".
+package locale
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Fallback is the language used for messages missing from the current language
+const Fallback = "en"
+
+//go:embed *.json
+var files embed.FS
+
+// message is a translated string, messages without plural forms only set other
+type message struct {
+	one   string
+	other string
+}
+
+func (m *message) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		m.other = s
+		return nil
+	}
+
+	var forms struct {
+		One   string `json:"one"`
+		Other string `json:"other"`
+	}
+	if err := json.Unmarshal(b, &forms); err != nil {
+		return fmt.Errorf("message must be a string or an object of plural forms: %v", err)
+	}
+	if forms.Other == "" {
+		return fmt.Errorf("plural message is missing the \"other\" form")
+	}
+	m.one, m.other = forms.One, forms.Other
+	return nil
+}
+
+// table is every message of a single language
+type table map[string]message
+
+var (
+	mu       sync.RWMutex
+	tables   map[string]table
+	current  = Fallback
+	onChange []func()
+)
+
+func init() {
+	var err error
+	if tables, err = loadTables(); err != nil {
+		panic(err)
+	}
+}
+
+// loadTables reads every embedded language file
+func loadTables() (map[string]table, error) {
+	names, err := files.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := map[string]table{}
+	for _, f := range names {
+		b, err := files.ReadFile(f.Name())
+		if err != nil {
+			return nil, err
+		}
+		t := table{}
+		if err := json.Unmarshal(b, &t); err != nil {
+			return nil, fmt.Errorf("locale file %s: %v", f.Name(), err)
+		}
+		loaded[strings.TrimSuffix(f.Name(), path.Ext(f.Name()))] = t
+	}
+
+	if _, ok := loaded[Fallback]; !ok {
+		return nil, fmt.Errorf("locale file %s.json is missing", Fallback)
+	}
+	return loaded, nil
+}
+
+// Languages returns the codes of every language with a locale file
+func Languages() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	codes := []string{}
+	for code := range tables {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Name returns what a language calls itself, its "language.name" message, or
+// its code if its file doesn't have one
+func Name(code string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if m, ok := tables[code]["language.name"]; ok {
+		return m.other
+	}
+	return code
+}
+
+// Language returns the code of the current language
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// SetLanguage changes the current language, functions registered with OnChange
+// are called if the language is different to before
+func SetLanguage(code string) error {
+	mu.Lock()
+	if _, ok := tables[code]; !ok {
+		mu.Unlock()
+		return fmt.Errorf("no locale file for language %q", code)
+	}
+	changed := code != current
+	current = code
+	listeners := onChange
+	mu.Unlock()
+
+	if changed {
+		for _, f := range listeners {
+			f()
+		}
+	}
+	return nil
+}
+
+// OnChange registers a function to call whenever the language is changed, for
+// example to rebuild menus with the new text
+func OnChange(f func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	onChange = append(onChange, f)
+}
+
+// T returns the message with the given ID in the current language, formatted
+// with args as fmt.Sprintf would
+func T(id string, args ...interface{}) string {
+	m, ok := lookup(id)
+	if !ok {
+		return id
+	}
+	return format(m.other, args)
+}
+
+// N returns the plural form of a message which suits the count n. The count is
+// passed as the first formatting argument, followed by args
+func N(id string, n int, args ...interface{}) string {
+	m, ok := lookup(id)
+	if !ok {
+		return id
+	}
+
+	s := m.other
+	if m.one != "" && pluralForm(Language(), n) == "one" {
+		s = m.one
+	}
+	return format(s, append([]interface{}{n}, args...))
+}
+
+// Has reports whether there is a message with the given ID, in the current
+// language or the fallback, data files use it to check their message IDs
+func Has(id string) bool {
+	_, ok := lookup(id)
+	return ok
+}
+
+// lookup finds a message in the current language, falling back to English
+func lookup(id string) (message, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if m, ok := tables[current][id]; ok {
+		return m, true
+	}
+	m, ok := tables[Fallback][id]
+	return m, ok
+}
+
+// pluralForm returns the plural form a language uses for the count n
+func pluralForm(code string, n int) string {
+	switch code {
+	case "ja":
+		return "other" // japanese nouns do not change with count
+	default:
+		if n == 1 {
+			return "one"
+		}
+		return "other"
+	}
+}
+
+func format(s string, args []interface{}) string {
+	if len(args) == 0 {
+		return s
+	}
+	return fmt.Sprintf(s, args...)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"log"
+
+	"github.com/Rosalita/my-ebiten-examples/asset"
+	"github.com/Rosalita/my-ebiten-examples/atlas"
+	"github.com/Rosalita/my-ebiten-examples/resources"
+)
+
+// assets looks up the embedded images and sounds by ID, it is loaded before
+// any init function runs so they can all use it
+var assets = loadAssets()
+
+// atlasSize is the width and height of each sheet in the sprite atlas
+const atlasSize = 1024
+
+// sprites holds the images used by the menus packed into as few textures as
+// possible, it is built along with the menus
+var sprites *atlas.Atlas
+
+func loadAssets() *asset.Registry {
+	r, err := asset.Load(resources.FS, "manifest.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	return r
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"log"
+
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/Rosalita/my-ebiten-examples/sound"
+)
+
+// soundManager plays every sound in the game, it is nil if the audio device
+// could not be opened, in which case the game carries on silently
+var soundManager *sound.Manager
+
+// musicScene can be implemented by a scene to choose the music played while
+// it is on top of the stack, scenes which don't implement it leave whatever
+// music is playing alone
+type musicScene interface {
+	music() string
+}
+
+// initSounds opens the audio device and loads every sound effect and track
+func initSounds() {
+	m, err := sound.NewManager()
+	if err != nil {
+		log.Printf("unable to open audio device: %+v\n", err)
+		return
+	}
+
+	for _, name := range []string{"nav", "confirm", "back"} {
+		b, err := assets.Bytes("sounds/" + name)
+		if err == nil {
+			err = m.AddEffect(name, b)
+		}
+		if err != nil {
+			log.Printf("unable to load sound: %+v\n", err)
+		}
+	}
+
+	for _, name := range []string{"title", "world"} {
+		b, err := assets.Bytes("sounds/" + name)
+		if err == nil {
+			err = m.AddMusic(name, b)
+		}
+		if err != nil {
+			log.Printf("unable to load sound: %+v\n", err)
+		}
+	}
+
+	soundManager = m
+}
+
+// menuNext moves a menu's selection down and plays the navigation sound
+func menuNext(m selector) {
+	m.IncrementSelected()
+	soundManager.PlayEffect("nav")
+}
+
+// menuPrev moves a menu's selection up and plays the navigation sound
+func menuPrev(m selector) {
+	m.DecrementSelected()
+	soundManager.PlayEffect("nav")
+}
+
+// playConfirm and playBack are played when a choice is made or undone
+func playConfirm() { soundManager.PlayEffect("confirm") }
+func playBack()    { soundManager.PlayEffect("back") }
+
+// updateSound plays the music of the top scene and moves any crossfade along
+func updateSound(top scene.Scene) {
+	if s, ok := top.(musicScene); ok {
+		soundManager.PlayMusic(s.music())
+	}
+	soundManager.Update()
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"slices"
+
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+)
+
+// avatar groups, a human avatar is either female or male
+const (
+	avatarFemale   = "female"
+	avatarMale     = "male"
+	avatarCreature = "creature"
+)
+
+// characterGroups maps the groups chosen on the character creation screen to
+// the avatar groups they offer
+var characterGroups = map[string][]string{
+	"human":    {avatarFemale, avatarMale},
+	"creature": {avatarCreature},
+}
+
+// Avatar is a portrait the player can choose for their character, name and
+// description are locale message IDs
+type Avatar struct {
+	ID          string   `json:"id"`
+	Group       string   `json:"group"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// Thumbnail returns the asset ID of the small image shown in menus
+func (a Avatar) Thumbnail() string {
+	return "avatars/" + a.ID + "_s"
+}
+
+// FullSize returns the asset ID of the full size image
+func (a Avatar) FullSize() string {
+	return "avatars/" + a.ID
+}
+
+// DisplayName returns the avatar's name in the current language
+func (a Avatar) DisplayName() string {
+	return locale.T(a.Name)
+}
+
+// DisplayDescription returns the avatar's description in the current language
+func (a Avatar) DisplayDescription() string {
+	return locale.T(a.Description)
+}
+
+// HasTag returns true if the avatar has the tag
+func (a Avatar) HasTag(tag string) bool {
+	return slices.Contains(a.Tags, tag)
+}
+
+// avatarFilter picks avatars out of the catalogue, empty fields match anything
+type avatarFilter struct {
+	Groups []string // avatar must be in one of these groups
+	Tag    string   // avatar must have this tag
+}
+
+func (f avatarFilter) match(a Avatar) bool {
+	if len(f.Groups) > 0 && !slices.Contains(f.Groups, a.Group) {
+		return false
+	}
+	if f.Tag != "" && !a.HasTag(f.Tag) {
+		return false
+	}
+	return true
+}
+
+// avatarCatalogue is the layout of data/avatars.json, avatars are kept in the
+// order they are listed which is the order menus show them in
+type avatarCatalogue struct {
+	Avatars []Avatar `json:"avatars"`
+
+	byID map[string]int
+}
+
+// avatars holds the catalogue once it has been loaded
+var avatars *avatarCatalogue
+
+// loadAvatarFile reads and validates an avatar catalogue
+func loadAvatarFile(fsys fs.FS, path string) (*avatarCatalogue, error) {
+	c := &avatarCatalogue{}
+	if err := datafile.Decode(fsys, path, c); err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, c.validate()); err != nil {
+		return nil, err
+	}
+
+	c.byID = map[string]int{}
+	for i, a := range c.Avatars {
+		c.byID[a.ID] = i
+	}
+	return c, nil
+}
+
+// validate checks every avatar has a unique ID, a known group, a name and
+// description, and both of its images
+func (c *avatarCatalogue) validate() []string {
+	errs := []string{}
+	if len(c.Avatars) == 0 {
+		errs = append(errs, "there are no avatars")
+	}
+
+	seen := map[string]bool{}
+	for i, a := range c.Avatars {
+		where := fmt.Sprintf("avatar %d (%q)", i+1, a.ID)
+		if a.ID == "" {
+			errs = append(errs, where+" has no id")
+		}
+		if seen[a.ID] {
+			errs = append(errs, where+" has the same id as an earlier avatar")
+		}
+		seen[a.ID] = true
+
+		if a.Group != avatarFemale && a.Group != avatarMale && a.Group != avatarCreature {
+			errs = append(errs, fmt.Sprintf("%s has unknown group %q, expected %s, %s or %s", where, a.Group, avatarFemale, avatarMale, avatarCreature))
+		}
+		if a.Name == "" {
+			errs = append(errs, where+" has no name")
+		}
+		if a.Description == "" {
+			errs = append(errs, where+" has no description")
+		}
+		if !assets.Has(a.Thumbnail()) {
+			errs = append(errs, fmt.Sprintf("%s has no thumbnail image %q", where, a.Thumbnail()))
+		}
+		if !assets.Has(a.FullSize()) {
+			errs = append(errs, fmt.Sprintf("%s has no full size image %q", where, a.FullSize()))
+		}
+	}
+	return errs
+}
+
+// Get returns the avatar with an ID
+func (c *avatarCatalogue) Get(id string) (Avatar, bool) {
+	i, ok := c.byID[id]
+	if !ok {
+		return Avatar{}, false
+	}
+	return c.Avatars[i], true
+}
+
+// All returns every avatar
+func (c *avatarCatalogue) All() []Avatar {
+	return append([]Avatar{}, c.Avatars...)
+}
+
+// Filter returns the avatars which match a filter
+func (c *avatarCatalogue) Filter(f avatarFilter) []Avatar {
+	found := []Avatar{}
+	for _, a := range c.Avatars {
+		if f.match(a) {
+			found = append(found, a)
+		}
+	}
+	return found
+}
+
+// Thumbnails returns the thumbnail asset IDs of every avatar
+func (c *avatarCatalogue) Thumbnails() []string {
+	ids := []string{}
+	for _, a := range c.Avatars {
+		ids = append(ids, a.Thumbnail())
+	}
+	return ids
+}
+
+// menuItems makes image menu items for avatars, each item is named after its
+// avatar's ID
+func menuItems(list []Avatar) []imageItemDef {
+	items := []imageItemDef{}
+	for _, a := range list {
+		items = append(items, imageItemDef{Name: a.ID, Image: a.Thumbnail()})
+	}
+	return items
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Rosalita/my-ebiten-examples/inventory"
+)
+
+// Stats are the attributes of a character
+type Stats struct {
+	Strength  int `json:"strength"`
+	Agility   int `json:"agility"`
+	Intellect int `json:"intellect"`
+	Vitality  int `json:"vitality"`
+}
+
+const (
+	minStat     = 1  // lowest value any single stat can have
+	maxStat     = 10 // highest value any single stat can have
+	startStat   = 3  // value every stat starts at before points are spent
+	statsPoints = 8  // number of points the player can spend on stats
+
+	baseHP        = 4 // hit points of a character with no vitality
+	hpPerVitality = 2 // hit points each point of vitality adds
+
+	xpPerLevel     = 20 // XP needed to reach level 2, each level after needs this much more than the last
+	hpPerLevel     = 2  // hit points each level after the first adds
+	attackPerLevel = 1  // attack each level after the first adds
+)
+
+// statNames are the locale message IDs of the stats' names
+var statNames = []string{"create.stat.strength", "create.stat.agility", "create.stat.intellect", "create.stat.vitality"}
+
+// get returns a pointer to the stat at index i, in the same order as statNames
+func (s *Stats) get(i int) *int {
+	switch i {
+	case 0:
+		return &s.Strength
+	case 1:
+		return &s.Agility
+	case 2:
+		return &s.Intellect
+	default:
+		return &s.Vitality
+	}
+}
+
+// Character is the player character made on the character creation screen
+type Character struct {
+	AvatarID string `json:"avatar_id"` // ID of the avatar in the catalogue, for example "f_03" or "c_17"
+	Group    string `json:"group"`     // name of the group item chosen, either "human" or "creature"
+	Name     string `json:"name"`
+	Stats    Stats  `json:"stats"`
+	HP       int    `json:"hp"` // hit points left, from 0 to MaxHP
+	XP       int    `json:"xp"` // experience earned in combat, it decides the character's level
+
+	Inventory inventory.Contents `json:"inventory"`
+}
+
+// MaxHP returns the character's hit points when unhurt
+func (c Character) MaxHP() int {
+	return baseHP + hpPerVitality*c.Stats.Vitality + hpPerLevel*(c.Level()-1)
+}
+
+// Level returns the character's level, which starts at 1
+func (c Character) Level() int {
+	level, _ := levelFor(c.XP)
+	return level
+}
+
+// NextLevelXP returns the XP the character needs to reach the next level
+func (c Character) NextLevelXP() int {
+	_, next := levelFor(c.XP)
+	return next
+}
+
+// levelFor returns the level reached with some XP and the XP the next level
+// needs
+func levelFor(xp int) (level, next int) {
+	level, next = 1, xpPerLevel
+	for xp >= next {
+		level++
+		next += xpPerLevel * level
+	}
+	return level, next
+}
+
+// String returns a summary of the character
+func (c Character) String() string {
+	return fmt.Sprintf("%s the %s (%s) LV %d\nSTR %d AGI %d INT %d VIT %d HP %d/%d",
+		c.Name, c.Group, c.AvatarID, c.Level(),
+		c.Stats.Strength, c.Stats.Agility, c.Stats.Intellect, c.Stats.Vitality, c.HP, c.MaxHP())
+}
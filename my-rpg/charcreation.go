@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+	"github.com/hajimehoshi/ebiten/inpututil"  // required for isKeyJustPressed
+)
+
+type creationStep int
+
+const (
+	pickAvatar creationStep = iota
+	enterName
+	allocateStats
+	confirm
+)
+
+// focus is the row of the avatar step which reacts to left and right
+type focus int
+
+const (
+	focusGroup focus = iota
+	focusAvatar
+)
+
+const maxNameLength = 12
+
+// layout of the rows on the allocate stats step
+const (
+	statRowY      = 48
+	statRowHeight = 24
+	statMinusX    = 150
+	statPlusX     = 200
+)
+
+var focusColour = pink
+
+// charCreationScene is a wizard where the player builds their character one
+// step at a time, confirm moves to the next step and back to the previous one
+type charCreationScene struct {
+	slot         int // save slot the new game will be saved to
+	step         creationStep
+	focus        focus
+	char         Character
+	statSelected int    // index of the stat being changed on the allocate stats step
+	pointsLeft   int    // points still to be spent on stats
+	suggested    string // name given to the character until the player types one
+	preview      avatarPreview
+}
+
+func (s *charCreationScene) Enter() {
+	s.step = pickAvatar
+	s.focus = focusGroup
+	s.char = Character{
+		Group: charGroupMenu.GetSelectedItem(),
+		Stats: Stats{
+			Strength:  startStat,
+			Agility:   startStat,
+			Intellect: startStat,
+			Vitality:  startStat,
+		},
+	}
+	s.pointsLeft = statsPoints
+}
+
+func (s *charCreationScene) Exit() {}
+
+func (s *charCreationScene) music() string { return "title" }
+
+// avatarMenu returns the avatar menu for the chosen group
+func (s *charCreationScene) avatarMenu() *imageMenu {
+	if s.char.Group == "creature" {
+		return &creatureMenu
+	}
+	return &humanMenu
+}
+
+// buttons returns the buttons used to move through the wizard with the mouse
+func (s *charCreationScene) buttons() (back, next textButton) {
+	back = textButton{text: locale.T("create.back"), x: 8, y: 272}
+	next = textButton{text: locale.T("create.next"), x: 320, y: 272}
+	return back, next
+}
+
+// statRect returns the area of the screen covered by row i on the allocate
+// stats step
+func statRect(i int) image.Rectangle {
+	y := statRowY + statRowHeight*i
+	return image.Rect(0, y, statPlusX+arrowWidth, y+statRowHeight)
+}
+
+// statMinusRect returns the area which lowers the stat on row i when clicked
+func statMinusRect(i int) image.Rectangle {
+	r := statRect(i)
+	return image.Rect(statMinusX, r.Min.Y, statMinusX+arrowWidth/2, r.Max.Y)
+}
+
+// statPlusRect returns the area which raises the stat on row i when clicked
+func statPlusRect(i int) image.Rectangle {
+	r := statRect(i)
+	return image.Rect(statPlusX, r.Min.Y, statPlusX+arrowWidth/2, r.Max.Y)
+}
+
+// suggestName fills in the name of the chosen avatar, which confirm accepts so
+// a player without a keyboard can carry on. A name the player typed is kept
+func (s *charCreationScene) suggestName() {
+	if s.char.Name != "" && s.char.Name != s.suggested {
+		return
+	}
+	a, _ := avatars.Get(s.char.AvatarID)
+	name := []rune(a.DisplayName())
+	s.suggested = string(name[:min(len(name), maxNameLength)])
+	s.char.Name = s.suggested
+}
+
+// changeStat spends or refunds a point on the selected stat
+func (s *charCreationScene) changeStat(delta int) {
+	stat := s.char.Stats.get(s.statSelected)
+	if delta > 0 && s.pointsLeft > 0 && *stat < maxStat {
+		*stat++
+		s.pointsLeft--
+	}
+	if delta < 0 && *stat > minStat {
+		*stat--
+		s.pointsLeft++
+	}
+}
+
+func (s *charCreationScene) Update() (scene.Transition, error) {
+
+	backButton, nextButton := s.buttons()
+
+	back := input.JustPressed(input.Back) || backButton.clicked()
+	if s.step == enterName {
+		// the name is typed so the keyboard only does enter, escape and
+		// backspace on the name step, in case the player has bound actions
+		// to letters. Gamepads work as usual
+		back = inpututil.IsKeyJustPressed(ebiten.KeyEscape) || input.GamepadJustPressed(input.Back) || backButton.clicked()
+	}
+
+	if back {
+		playBack()
+		if s.step == pickAvatar {
+			return scene.Pop(), nil
+		}
+		s.step--
+		return scene.None(), nil
+	}
+
+	next := input.JustPressed(input.Confirm) || nextButton.clicked()
+
+	switch s.step {
+	case pickAvatar:
+		groupDef := menuDefs.imageMenu("charGroup")
+		avatarDef := menuDefs.imageMenu(s.char.Group)
+
+		if input.JustPressed(input.MenuUp) {
+			s.focus = focusGroup
+		}
+		if input.JustPressed(input.MenuDown) {
+			s.focus = focusAvatar
+		}
+		if input.CursorMoved() && input.Hovering(groupDef.rowRect()) {
+			s.focus = focusGroup
+		}
+		if input.CursorMoved() && input.Hovering(avatarDef.rowRect()) {
+			s.focus = focusAvatar
+		}
+
+		menu, def := selector(&charGroupMenu), groupDef
+		if s.focus == focusAvatar {
+			menu, def = s.avatarMenu(), avatarDef
+		}
+		if input.JustPressed(input.MenuRight) || input.ClickedIn(def.rightArrowRect()) {
+			menuNext(menu)
+		}
+		if input.JustPressed(input.MenuLeft) || input.ClickedIn(def.leftArrowRect()) {
+			menuPrev(menu)
+		}
+		s.char.Group = charGroupMenu.GetSelectedItem()
+		s.preview.update(s.avatarMenu().GetSelectedItem())
+
+		if next || input.ClickedIn(avatarDef.imageRect()) {
+			s.char.AvatarID = s.avatarMenu().GetSelectedItem()
+			s.suggestName()
+			s.step = enterName
+			playConfirm()
+		}
+
+	case enterName:
+		name := []rune(s.char.Name)
+		for _, r := range ebiten.InputChars() {
+			if len(name) < maxNameLength && r >= ' ' && r <= '~' {
+				name = append(name, r)
+			}
+		}
+		if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) && len(name) > 0 {
+			name = name[:len(name)-1]
+		}
+		s.char.Name = string(name)
+		next = inpututil.IsKeyJustPressed(ebiten.KeyEnter) || input.GamepadJustPressed(input.Confirm) || nextButton.clicked()
+		if next && strings.TrimSpace(s.char.Name) != "" {
+			s.char.Name = strings.TrimSpace(s.char.Name)
+			s.step = allocateStats
+			playConfirm()
+		}
+
+	case allocateStats:
+		if input.JustPressed(input.MenuUp) && s.statSelected > 0 {
+			s.statSelected--
+		}
+		if input.JustPressed(input.MenuDown) && s.statSelected < len(statNames)-1 {
+			s.statSelected++
+		}
+		for i := range statNames {
+			if input.CursorMoved() && input.Hovering(statRect(i)) {
+				s.statSelected = i
+			}
+		}
+
+		if input.JustPressed(input.MenuRight) || input.ClickedIn(statPlusRect(s.statSelected)) {
+			s.changeStat(1)
+		}
+		if input.JustPressed(input.MenuLeft) || input.ClickedIn(statMinusRect(s.statSelected)) {
+			s.changeStat(-1)
+		}
+		if next && s.pointsLeft == 0 {
+			s.step = confirm
+			playConfirm()
+		}
+
+	case confirm:
+		if next {
+			playConfirm()
+			s.char.HP = s.char.MaxHP()
+			s.char.giveStartingItems()
+			return scene.Replace(&worldScene{slot: s.slot, player: s.char}), nil
+		}
+	}
+
+	return scene.None(), nil
+}
+
+func (s *charCreationScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	drawHeading(screen, locale.T("create.heading"))
+
+	switch s.step {
+	case pickAvatar:
+		groupDef := menuDefs.imageMenu("charGroup")
+		avatarDef := menuDefs.imageMenu(s.char.Group)
+
+		charGroupMenu.Draw(screen)
+		s.avatarMenu().Draw(screen)
+		groupDef.drawArrows(screen)
+		avatarDef.drawArrows(screen)
+
+		focused := groupDef.imageRect()
+		if s.focus == focusAvatar {
+			focused = avatarDef.imageRect()
+		}
+		drawFocus(screen, float64(focused.Min.X), float64(focused.Min.Y), float64(focused.Dx()), float64(focused.Dy()))
+		drawText(screen, locale.T("create.choose_avatar"), 8, 220)
+		s.preview.draw(screen)
+
+	case enterName:
+		drawText(screen, fmt.Sprintf("%s\n\n%s_", wrapText(locale.T("create.type_name"), mplusFont, screenWidth-16), s.char.Name), 8, 16)
+
+	case allocateStats:
+		drawText(screen, locale.N("create.points_left", s.pointsLeft), 8, 16)
+
+		for i, name := range statNames {
+			r := statRect(i)
+			if i == s.statSelected {
+				drawText(screen, ">", 8, r.Min.Y)
+			}
+			drawText(screen, locale.T(name), 24, r.Min.Y)
+			drawText(screen, "<", statMinusX, r.Min.Y)
+			drawText(screen, fmt.Sprintf("%2d", *s.char.Stats.get(i)), statMinusX+arrowWidth/2+4, r.Min.Y)
+			drawText(screen, ">", statPlusX, r.Min.Y)
+		}
+
+	case confirm:
+		s.avatarMenu().Draw(screen)
+		s.preview.draw(screen)
+		drawText(screen, locale.T("create.begin"), 8, 16)
+		ebitenutil.DebugPrint(screen, fmt.Sprintf("\n\n\n\n\n\n\n\n\n\n\n\n\n%s", s.char))
+	}
+
+	backButton, nextButton := s.buttons()
+	backButton.draw(screen)
+	nextButton.draw(screen)
+}
+
+// drawFocus draws a border around the area of the screen which has focus
+func drawFocus(screen *ebiten.Image, x, y, w, h float64) {
+	const border = 2
+	ebitenutil.DrawRect(screen, x-border, y-border, w+border*2, border, focusColour)
+	ebitenutil.DrawRect(screen, x-border, y+h, w+border*2, border, focusColour)
+	ebitenutil.DrawRect(screen, x-border, y, border, h, focusColour)
+	ebitenutil.DrawRect(screen, x+w, y, border, h, focusColour)
+}
@@ -0,0 +1,480 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/combat"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for DrawRect
+	"golang.org/x/image/font"
+)
+
+// layout of the combat screen, the enemy is in the middle with the player to
+// its left and the turn order to its right, the commands and the log are below
+const (
+	combatEnemyX    = 125
+	combatEnemyY    = 0
+	combatPlayerX   = 8
+	combatPlayerY   = 8
+	combatThumbSize = 40
+	combatOrderX    = 290
+	combatOrderY    = 8
+	hpBarWidth      = 110
+	hpBarHeight     = 6
+	combatLogFrame  = "page_large"
+	combatLogLines  = 6
+	combatItems     = 3 // usable items offered at once, the menu has room for these and BACK
+)
+
+// how combat feels
+const (
+	enemyDelay  = 0.6 // seconds an enemy waits before it acts
+	shakeTime   = 0.3 // seconds whoever was hit shakes for
+	shakeAmount = 4   // pixels
+	shakeSpeed  = 60  // radians a second
+)
+
+var combatLogRect = image.Rect(134, 186, 392, 294)
+
+// actions which scroll the combat log back to older lines and down to newer ones
+const (
+	actionLogUp   input.Action = "log_up"
+	actionLogDown input.Action = "log_down"
+)
+
+func init() {
+	input.Register(actionLogUp, input.KeyBinding(ebiten.KeyPageUp), input.ButtonBinding(ebiten.GamepadButton4))
+	input.Register(actionLogDown, input.KeyBinding(ebiten.KeyPageDown), input.ButtonBinding(ebiten.GamepadButton5))
+}
+
+// combat commands, the names of the items of the combat_commands menu
+const (
+	commandAttack = "attack"
+	commandSkill  = "skill"
+	commandItem   = "item"
+	commandFlee   = "flee"
+	commandBack   = "back"
+)
+
+type combatMode int
+
+const (
+	chooseCommand combatMode = iota
+	chooseCombatItem
+	enemyTurn
+	combatOver
+)
+
+// combatScene is a battle between the player and an enemy. The player picks a
+// command on their turn and enemies act after a short wait, what happens is
+// written to a log which the mouse wheel or the log actions scroll back through.
+// Once the battle is over confirm returns to the scene below, with the player's
+// XP and gold changed by how it ended
+type combatScene struct {
+	player *Character
+	world  *World
+	enemy  Enemy
+	avatar Avatar
+
+	battle *combat.Battle
+	hero   *combat.Combatant
+	foe    *combat.Combatant
+
+	mode      combatMode
+	menu      lm.ListMenu
+	itemMenu  lm.ListMenu
+	itemNames []string // names of the item menu's items, slot indexes then BACK
+	using     string   // name of the item the player is using
+	wait      float64  // seconds until the enemy acts
+	hit       *combat.Combatant
+	shake     float64 // seconds left of the shake of whoever was hit
+
+	log    []string // lines of the log, oldest first
+	scroll int      // lines the log is scrolled back
+}
+
+// newCombatScene returns a battle against an enemy, it fails if the enemy isn't
+// in the catalogue as a battle against nothing would be won for free
+func newCombatScene(player *Character, world *World, enemyID string) (*combatScene, error) {
+	e, ok := enemies.Get(enemyID)
+	if !ok {
+		return nil, fmt.Errorf("unknown enemy %q", enemyID)
+	}
+	return &combatScene{player: player, world: world, enemy: e}, nil
+}
+
+func (s *combatScene) Enter() {
+	e := s.enemy
+	s.avatar, _ = avatars.Get(e.ID)
+
+	s.hero = &combat.Combatant{Name: s.player.Name, Side: combat.Players, Stats: s.player.combatStats(), HP: s.player.HP}
+	s.foe = &combat.Combatant{Name: s.avatar.DisplayName(), Side: combat.Enemies, Stats: e.Stats(), HP: e.HP}
+	s.battle = combat.New(rand.New(rand.NewSource(time.Now().UnixNano())), s.hero, s.foe)
+
+	menu, err := menuDefs.listMenu("combat_commands").build()
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.menu = menu
+
+	s.log, s.scroll = nil, 0
+	s.hit, s.shake = nil, 0
+	s.write(locale.T("combat.log.appears", s.foe.Name))
+	s.nextTurn()
+}
+
+func (s *combatScene) Exit() {}
+
+func (s *combatScene) music() string { return "world" }
+
+// combatStats returns the stats a character fights with, including the
+// bonuses of what they are wearing
+func (c Character) combatStats() combat.Stats {
+	inv := c.inventory()
+	return combat.Stats{
+		MaxHP:   c.MaxHP(),
+		Attack:  c.Stats.Strength + attackPerLevel*(c.Level()-1) + inv.Bonus(bonusAttack),
+		Defence: c.Stats.Vitality/2 + inv.Bonus(bonusDefence),
+		Magic:   c.Stats.Intellect,
+		Speed:   c.Stats.Agility,
+	}
+}
+
+// skillName returns the name of a combatant's skill
+func (s *combatScene) skillName(c *combat.Combatant) string {
+	if c == s.hero {
+		return locale.T("combat.skill.player")
+	}
+	return locale.T(s.enemy.Skill)
+}
+
+// write adds a message to the bottom of the log and scrolls down to it
+func (s *combatScene) write(msg string) {
+	pad := framePadding(combatLogFrame)
+	wrapped := wrapText(msg, smallFont, combatLogRect.Dx()-pad*2)
+	s.log = append(s.log, strings.Split(wrapped, "\n")...)
+	s.scroll = 0
+}
+
+// nextTurn waits for whoever's turn it is, or ends the battle once it is over
+func (s *combatScene) nextTurn() {
+	switch {
+	case s.battle.Outcome() != combat.Ongoing:
+		s.finish()
+	case s.battle.Current() == s.hero:
+		s.mode = chooseCommand
+	default:
+		s.mode, s.wait = enemyTurn, enemyDelay
+	}
+}
+
+// act makes whoever's turn it is do something, then moves on to the next turn
+func (s *combatScene) act(action combat.Action, target *combat.Combatant, amount int) {
+	e := s.battle.Act(action, target, amount)
+	s.player.HP = s.hero.HP
+
+	switch e.Action {
+	case combat.Attack:
+		s.write(locale.T("combat.log.attack", e.Actor.Name, e.Target.Name, e.Amount))
+	case combat.Skill:
+		s.write(locale.T("combat.log.skill", e.Actor.Name, s.skillName(e.Actor), e.Target.Name, e.Amount))
+	case combat.Item:
+		s.write(locale.T("combat.log.item", e.Actor.Name, locale.T(s.using), e.Amount))
+	case combat.Flee:
+		if e.Fled {
+			s.write(locale.T("combat.log.flee", e.Actor.Name))
+		} else {
+			s.write(locale.T("combat.log.flee_failed", e.Actor.Name))
+		}
+	}
+	if e.Action == combat.Attack || e.Action == combat.Skill {
+		s.hit, s.shake = e.Target, shakeTime
+		if e.Critical {
+			s.write(locale.T("combat.log.critical"))
+		}
+		if e.Defeated {
+			s.write(locale.T("combat.log.defeated", e.Target.Name))
+		}
+	}
+	s.nextTurn()
+}
+
+// finish rewards the player for winning, or takes half their gold if they
+// lost, fleeing gets them nothing
+func (s *combatScene) finish() {
+	s.mode = combatOver
+	switch s.battle.Outcome() {
+	case combat.Won:
+		level, maxHP := s.player.Level(), s.player.MaxHP()
+		s.player.XP += s.enemy.XP
+		s.world.Gold += s.enemy.Gold
+		s.write(locale.T("combat.log.won", s.enemy.XP, s.enemy.Gold))
+		if s.player.Level() > level {
+			// the hit points a level adds come unhurt
+			s.player.HP += s.player.MaxHP() - maxHP
+			s.write(locale.T("combat.log.level_up", s.player.Name, s.player.Level()))
+		}
+	case combat.Lost:
+		lost := s.world.Gold / 2
+		s.world.Gold -= lost
+		s.player.HP = 1
+		s.write(locale.T("combat.log.lost", lost))
+	}
+}
+
+// command does what the player picked from the command menu
+func (s *combatScene) command(name string) {
+	switch name {
+	case commandAttack:
+		s.act(combat.Attack, s.foe, 0)
+	case commandSkill:
+		if n := s.hero.Cooldown(); n > 0 {
+			s.write(locale.T("combat.log.skill_not_ready", s.skillName(s.hero), n))
+			return
+		}
+		s.act(combat.Skill, s.foe, 0)
+	case commandItem:
+		s.offerItems()
+	case commandFlee:
+		s.act(combat.Flee, nil, 0)
+	}
+}
+
+// offerItems builds the menu of the first few items which heal, the player
+// can use one of these on their turn
+func (s *combatScene) offerItems() {
+	inv := s.player.inventory()
+	menuItems := []lm.Item{}
+	s.itemNames = nil
+	for i, st := range inv.Slots {
+		d, ok := items.Get(st.ID)
+		if !ok || st.Empty() || d.Use[useHeal] <= 0 {
+			continue
+		}
+		s.itemNames = append(s.itemNames, strconv.Itoa(i))
+		menuItems = append(menuItems, lm.Item{Name: strconv.Itoa(i), Text: locale.T("combat.item_count", locale.T(d.Name), st.Count), TxtX: 8, TxtY: 17})
+		if len(s.itemNames) == combatItems {
+			break
+		}
+	}
+	if len(s.itemNames) == 0 {
+		s.write(locale.T("combat.log.no_items"))
+		return
+	}
+	s.itemNames = append(s.itemNames, commandBack)
+	menuItems = append(menuItems, lm.Item{Name: commandBack, Text: locale.T("combat.back"), TxtX: 8, TxtY: 17})
+
+	menu, err := menuDefs.listMenu("combat_items").build(menuItems...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.itemMenu = menu
+	s.mode = chooseCombatItem
+}
+
+// useItem uses up one of the item in an inventory slot on the player, it
+// takes their turn unless they are already at full health
+func (s *combatScene) useItem(slot int) {
+	inv := s.player.inventory()
+	d, ok := items.Get(inv.Slots[slot].ID)
+	if !ok {
+		return
+	}
+	if s.hero.HP >= s.hero.Stats.MaxHP {
+		s.write(locale.T("inventory.full_health"))
+		return
+	}
+	healed := min(s.hero.HP+d.Use[useHeal], s.hero.Stats.MaxHP) - s.hero.HP
+	s.hero.HP += healed
+	inv.Remove(slot, 1)
+	s.player.Inventory = inv.Save()
+	s.using = d.Name
+	s.act(combat.Item, s.hero, healed)
+}
+
+func (s *combatScene) Update() (scene.Transition, error) {
+	dt := 1 / float64(ebiten.MaxTPS())
+	s.shake = math.Max(s.shake-dt, 0)
+	scroll := 0
+	if wheel := input.Scrolled(); wheel != 0 {
+		scroll = int(math.Copysign(1, wheel))
+	}
+	if input.JustPressed(actionLogUp) {
+		scroll++
+	}
+	if input.JustPressed(actionLogDown) {
+		scroll--
+	}
+	s.scroll = min(max(s.scroll+scroll, 0), max(len(s.log)-combatLogLines, 0))
+
+	switch s.mode {
+	case chooseCommand:
+		def := menuDefs.listMenu("combat_commands")
+		if s.choose(&s.menu, def, def.names()) {
+			playConfirm()
+			s.command(s.menu.GetSelectedItem())
+		}
+
+	case chooseCombatItem:
+		if input.JustPressed(input.Back) {
+			playBack()
+			s.mode = chooseCommand
+			return scene.None(), nil
+		}
+		if s.choose(&s.itemMenu, menuDefs.listMenu("combat_items"), s.itemNames) {
+			name := s.itemMenu.GetSelectedItem()
+			if name == commandBack {
+				playBack()
+				s.mode = chooseCommand
+				return scene.None(), nil
+			}
+			playConfirm()
+			slot, _ := strconv.Atoi(name)
+			s.useItem(slot)
+		}
+
+	case enemyTurn:
+		if s.wait -= dt; s.wait <= 0 {
+			action, target := s.battle.Choose(s.battle.Current())
+			s.act(action, target, 0)
+		}
+
+	case combatOver:
+		if input.JustPressed(input.Confirm) || input.Clicked() {
+			playConfirm()
+			return scene.Pop(), nil
+		}
+	}
+	return scene.None(), nil
+}
+
+// choose moves through a menu and returns true when an item is confirmed or
+// clicked
+func (s *combatScene) choose(m *lm.ListMenu, def *listMenuDef, names []string) bool {
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(m)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(m)
+	}
+	clicked := pointListMenu(m, def, names)
+	return input.JustPressed(input.Confirm) || clicked
+}
+
+// shakeOffset returns how far a combatant is drawn from where it stands, it
+// shakes for a moment after being hit
+func (s *combatScene) shakeOffset(c *combat.Combatant) float64 {
+	if c != s.hit || s.shake == 0 {
+		return 0
+	}
+	return shakeAmount * math.Sin(s.shake*shakeSpeed)
+}
+
+func (s *combatScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	s.drawPlayer(screen)
+	s.drawEnemy(screen)
+	s.drawOrder(screen)
+
+	if s.mode == chooseCombatItem {
+		drawListMenu(screen, &s.itemMenu, menuDefs.listMenu("combat_items"), len(s.itemNames))
+	} else {
+		drawListMenu(screen, &s.menu, menuDefs.listMenu("combat_commands"), 0)
+	}
+	s.drawLog(screen)
+}
+
+// drawPlayer draws the player's thumbnail with their level and hit points
+func (s *combatScene) drawPlayer(screen *ebiten.Image) {
+	a, _ := avatars.Get(s.player.AvatarID)
+	x := combatPlayerX + s.shakeOffset(s.hero)
+	drawSprite(screen, a.Thumbnail(), x, combatPlayerY, combatThumbSize, combatThumbSize)
+
+	textX := combatPlayerX + combatThumbSize + 6
+	drawSmallText(screen, s.player.Name, textX, combatPlayerY, white)
+	drawSmallText(screen, locale.T("combat.level", s.player.Level(), s.player.XP, s.player.NextLevelXP()), textX, combatPlayerY+smallLineHeight, white)
+
+	y := combatPlayerY + combatThumbSize + 4
+	drawHPBar(screen, combatPlayerX, y, s.hero.HP, s.hero.Stats.MaxHP)
+	drawSmallText(screen, locale.T("combat.hp", s.hero.HP, s.hero.Stats.MaxHP), combatPlayerX, y+hpBarHeight+2, white)
+}
+
+// drawEnemy draws the enemy's portrait in a frame with its name and hit points
+// below
+func (s *combatScene) drawEnemy(screen *ebiten.Image) {
+	x := combatEnemyX + s.shakeOffset(s.foe)
+	portrait, err := assets.Image(s.avatar.FullSize())
+	if err != nil {
+		log.Printf("unable to draw enemy: %+v\n", err)
+		return
+	}
+	w, h := portrait.Size()
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Scale(float64(portraitSize)/float64(w), float64(portraitSize)/float64(h))
+	opts.GeoM.Translate(x+(previewWidth-portraitSize)/2, combatEnemyY+(previewFrameSize-portraitSize)/2)
+	if !s.foe.Alive() {
+		opts.ColorM.ChangeHSV(0, 0, 0.5)
+	}
+	opts.Filter = ebiten.FilterLinear
+	screen.DrawImage(portrait, opts)
+	drawSprite(screen, previewFrame, x, combatEnemyY, previewWidth, previewFrameSize)
+
+	name := s.foe.Name
+	nameW := font.MeasureString(smallFont, name).Ceil()
+	y := combatEnemyY + previewFrameSize
+	drawSmallText(screen, name, combatEnemyX+(previewWidth-nameW)/2, y, white)
+	drawHPBar(screen, combatEnemyX+(previewWidth-hpBarWidth)/2, y+smallLineHeight+2, s.foe.HP, s.foe.Stats.MaxHP)
+}
+
+// drawHPBar draws a bar filled by how many hit points are left
+func drawHPBar(screen *ebiten.Image, x, y, hp, maxHP int) {
+	ebitenutil.DrawRect(screen, float64(x), float64(y), hpBarWidth, hpBarHeight, purple2)
+	clr := green5
+	if hp*4 <= maxHP {
+		clr = pink
+	}
+	if maxHP > 0 {
+		ebitenutil.DrawRect(screen, float64(x), float64(y), float64(hpBarWidth*hp/maxHP), hpBarHeight, clr)
+	}
+}
+
+// drawOrder lists who acts this round in order, whoever's turn it is is
+// highlighted and anyone defeated is faded
+func (s *combatScene) drawOrder(screen *ebiten.Image) {
+	drawSmallText(screen, locale.T("combat.turn_order"), combatOrderX, combatOrderY, orange1)
+	current := s.battle.Current()
+	for i, c := range s.battle.Order() {
+		var clr color.Color = white
+		switch {
+		case !c.Alive():
+			clr = purple4
+		case c == current:
+			clr = pink
+		}
+		drawSmallText(screen, c.Name, combatOrderX, combatOrderY+smallLineHeight*(i+1), clr)
+	}
+}
+
+// drawLog draws the newest lines of the log, or older ones when it has been
+// scrolled back
+func (s *combatScene) drawLog(screen *ebiten.Image) {
+	drawFrame(screen, combatLogFrame, combatLogRect)
+	pad := framePadding(combatLogFrame)
+	end := len(s.log) - s.scroll
+	start := max(end-combatLogLines, 0)
+	text := strings.Join(s.log[start:end], "\n")
+	drawSmallText(screen, text, combatLogRect.Min.X+pad, combatLogRect.Min.Y+pad, frameText(combatLogFrame, white))
+}
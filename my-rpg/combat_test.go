@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestNewCombatSceneRefusesUnknownEnemies(t *testing.T) {
+	var err error
+	if avatars, err = loadAvatarFile(dataFiles(), "avatars.json"); err != nil {
+		t.Fatal(err)
+	}
+	if enemies, err = loadEnemyFile(dataFiles(), "enemies.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := newCombatScene(&Character{}, &World{}, "no_such_enemy"); err == nil {
+		t.Error("a battle against an unknown enemy was started")
+	}
+	s, err := newCombatScene(&Character{}, &World{}, enemies.Enemies[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.enemy.HP <= 0 {
+		t.Errorf("enemy %q has %d hp", s.enemy.ID, s.enemy.HP)
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil" // required for isKeyJustPressed
+)
+
+const resetControls = "reset"
+
+// controlsScene lists every action with its bindings, confirming an action
+// waits for the next input pressed and binds it to that action
+type controlsScene struct {
+	actions   []input.Action
+	selected  int
+	listening bool   // true while waiting for an input to bind
+	refused   string // input which could not be bound, if any
+	menu      lm.ListMenu
+}
+
+func (s *controlsScene) Enter() {
+	s.actions = input.Actions()
+	s.buildMenu()
+}
+
+func (s *controlsScene) Exit() {}
+
+// buildMenu rebuilds the menu so it shows the current bindings
+func (s *controlsScene) buildMenu() {
+	items := []lm.Item{}
+	for _, a := range s.actions {
+		items = append(items, lm.Item{
+			Name: string(a),
+			Text: fmt.Sprintf("%-8s %s", locale.T("action."+string(a)), input.Describe(a)),
+			TxtX: 8,
+			TxtY: 17,
+		})
+	}
+	items = append(items, lm.Item{
+		Name: resetControls,
+		Text: locale.T("controls.reset"),
+		TxtX: 8,
+		TxtY: 17,
+	})
+
+	menu, err := menuDefs.listMenu("controls").build(items...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+
+	for i := 0; i < s.selected; i++ {
+		menu.IncrementSelected()
+	}
+	s.menu = menu
+}
+
+// saveBindings copies the bindings into the settings and saves them
+func saveBindings() {
+	settings.Bindings = input.Export()
+	if err := settings.save(); err != nil {
+		log.Printf("unable to save settings: %+v\n", err)
+	}
+}
+
+func (s *controlsScene) Update() (scene.Transition, error) {
+
+	if s.listening {
+		// escape always cancels, so it can not be bound by mistake
+		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+			s.listening = false
+			return scene.None(), nil
+		}
+		if b, ok := input.JustPressedBinding(); ok {
+			if !input.Bind(s.actions[s.selected], b) {
+				playBack()
+				s.refused = b.String()
+				s.listening = false
+				return scene.None(), nil
+			}
+			playConfirm()
+			saveBindings()
+			s.listening = false
+			s.buildMenu()
+		}
+		return scene.None(), nil
+	}
+
+	if input.JustPressed(input.MenuUp) && s.selected > 0 {
+		s.selected--
+		menuPrev(&s.menu)
+	}
+	if input.JustPressed(input.MenuDown) && s.selected < len(s.actions) {
+		s.selected++
+		menuNext(&s.menu)
+	}
+
+	names := []string{}
+	for _, a := range s.actions {
+		names = append(names, string(a))
+	}
+	names = append(names, resetControls)
+	clicked := pointListMenu(&s.menu, menuDefs.listMenu("controls"), names)
+	s.selected = indexOf(names, s.menu.GetSelectedItem())
+
+	if input.JustPressed(input.Confirm) || clicked {
+		playConfirm()
+		if s.menu.GetSelectedItem() == resetControls {
+			input.ResetBindings()
+			saveBindings()
+			s.buildMenu()
+		} else {
+			s.listening, s.refused = true, ""
+		}
+	}
+
+	if input.JustPressed(input.Back) {
+		playBack()
+		return scene.Pop(), nil
+	}
+
+	return scene.None(), nil
+}
+
+func (s *controlsScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	drawHeading(screen, locale.T("controls.heading"))
+	drawListMenu(screen, &s.menu, menuDefs.listMenu("controls"), len(s.actions)+1)
+
+	if s.listening {
+		name := locale.T("action." + string(s.actions[s.selected]))
+		drawText(screen, locale.T("controls.press", name), 8, 272)
+	} else if s.refused != "" {
+		drawText(screen, locale.T("controls.needed", s.refused), 8, 272)
+	}
+}
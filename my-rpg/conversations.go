@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+	"github.com/Rosalita/my-ebiten-examples/dialogue"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+)
+
+// maxChoices is how many choices fit on screen above the dialogue box
+const maxChoices = 4
+
+// dialogues holds every dialogue file by name once they have been loaded
+var dialogues map[string]*dialogue.Tree
+
+// initDialogue loads every dialogue file in the dialogue directory of the data
+// directory, a file's name without .json is its dialogue name. It must be
+// called after the avatar catalogue is loaded
+func initDialogue() error {
+	fsys := dataFiles()
+	files, err := fs.Glob(fsys, "dialogue/*.json")
+	if err != nil {
+		return err
+	}
+
+	dialogues = map[string]*dialogue.Tree{}
+	for _, file := range files {
+		t, err := dialogue.Load(fsys, file)
+		if err != nil {
+			return err
+		}
+		if err := datafile.Invalid(file, checkDialogue(t)); err != nil {
+			return err
+		}
+		dialogues[strings.TrimSuffix(path.Base(file), ".json")] = t
+	}
+	return nil
+}
+
+// checkDialogue returns a description of every speaker, message or enemy in a
+// tree which the game doesn't have
+func checkDialogue(t *dialogue.Tree) []string {
+	errs := []string{}
+	checkEffects := func(where string, effects []dialogue.Effect) {
+		for _, e := range effects {
+			if e.Combat == "" {
+				continue
+			}
+			if _, ok := enemies.Get(e.Combat); !ok {
+				errs = append(errs, fmt.Sprintf("%s starts combat with %q which is not an enemy", where, e.Combat))
+			}
+		}
+	}
+
+	for _, id := range t.NodeIDs() {
+		n := t.Nodes[id]
+		where := fmt.Sprintf("node %q", id)
+		if n.Speaker != "" {
+			if _, ok := avatars.Get(n.Speaker); !ok {
+				errs = append(errs, fmt.Sprintf("%s has unknown speaker %q", where, n.Speaker))
+			}
+		}
+		if n.Text != "" && !locale.Has(n.Text) {
+			errs = append(errs, fmt.Sprintf("%s has unknown text %q", where, n.Text))
+		}
+		if len(n.Choices) > maxChoices {
+			errs = append(errs, fmt.Sprintf("%s has %d choices, only %d fit on screen", where, len(n.Choices), maxChoices))
+		}
+		checkEffects(where, n.Effects)
+
+		for i, c := range n.Choices {
+			where := fmt.Sprintf("node %q choice %d", id, i+1)
+			if !locale.Has(c.Text) {
+				errs = append(errs, fmt.Sprintf("%s has unknown text %q", where, c.Text))
+			}
+			checkEffects(where, c.Effects)
+		}
+	}
+	return errs
+}
+
+// treeConversation shows a dialogue tree in a dialogue scene
+type treeConversation struct {
+	run *dialogue.Runner
+}
+
+func (c *treeConversation) line() (dialogueLine, bool) {
+	l, ok := c.run.Line()
+	if !ok {
+		return dialogueLine{}, false
+	}
+	choices := []string{}
+	for _, ch := range l.Choices {
+		choices = append(choices, locale.T(ch.Text))
+	}
+	return dialogueLine{Speaker: l.Speaker, Text: locale.T(l.Text), Choices: choices}, true
+}
+
+func (c *treeConversation) next(choice int) {
+	c.run.Next(choice)
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"log"
+	"strconv"
+	"strings"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"golang.org/x/image/font"
+)
+
+// layout of the dialogue box, the text sits on a banner along the bottom of
+// the screen with the speaker's portrait and name above its left end. The
+// dialogue panel's frame is drawn instead of the banner if it has one
+const (
+	dialogueBanner    = "ui/text_scroll_2"
+	dialogueNamePlate = "ui/text_scroll_1_300"
+	dialogueFrame     = "ui/frame_square_150"
+
+	bannerY       = 215 // the banner is as wide as the screen and reaches the bottom
+	dialogueTextX = 50
+	dialogueTextY = 224
+	dialogueWidth = 300 // text is wrapped to this width
+	dialogueLines = 3   // lines of text on a page
+
+	frameX, frameY, frameSize = 4, 142, 75
+	speakerX, speakerY        = 12, 150 // the portrait is drawn behind the opening of the frame
+	speakerSize               = 59
+	plateX, plateY            = 80, 186
+	plateWidth, plateHeight   = 150, 33
+
+	typewriterSpeed = 40 // runes revealed each second
+	blinkFrames     = 30 // frames the more indicator is shown then hidden for
+)
+
+// dialogueImages are the images the dialogue box needs in the sprite atlas
+var dialogueImages = []string{dialogueBanner, dialogueNamePlate, dialogueFrame}
+
+// dialogueLine is something said in a conversation
+type dialogueLine struct {
+	Speaker string   // avatar ID of who is speaking, empty for narration
+	Text    string   // in the current language
+	Choices []string // in the current language, offered once the text is read
+}
+
+// conversation gives a dialogue scene its lines one at a time
+type conversation interface {
+	// line returns what is being said, ok is false once the conversation is over
+	line() (l dialogueLine, ok bool)
+	// next moves on once the line is read, choice is the index of the choice
+	// picked or -1 if the line has no choices
+	next(choice int)
+}
+
+// dialogueBox shows one line of dialogue a page at a time, each page is
+// revealed a few letters at a time
+type dialogueBox struct {
+	speaker Avatar // zero for narration
+	pages   []string
+	page    int
+	shown   float64 // runes of the page revealed so far
+	tick    int
+}
+
+// say shows a new line, text is wrapped to the width of the box and split into
+// pages
+func (b *dialogueBox) say(speakerID, text string) {
+	b.speaker = Avatar{}
+	if speakerID != "" {
+		a, ok := avatars.Get(speakerID)
+		if !ok {
+			log.Printf("unknown speaker %q\n", speakerID)
+		}
+		b.speaker = a
+	}
+
+	lines := strings.Split(wrapText(text, smallFont, dialogueWidth), "\n")
+	b.pages = nil
+	for len(lines) > dialogueLines {
+		b.pages = append(b.pages, strings.Join(lines[:dialogueLines], "\n"))
+		lines = lines[dialogueLines:]
+	}
+	b.pages = append(b.pages, strings.Join(lines, "\n"))
+	b.page, b.shown = 0, 0
+}
+
+func (b *dialogueBox) pageRunes() []rune {
+	return []rune(b.pages[b.page])
+}
+
+// update reveals more of the page, dt is the time since the last update
+func (b *dialogueBox) update(dt float64) {
+	b.tick++
+	b.shown = min(b.shown+typewriterSpeed*dt, float64(len(b.pageRunes())))
+}
+
+// revealed returns true once the whole page is shown
+func (b *dialogueBox) revealed() bool {
+	return int(b.shown) >= len(b.pageRunes())
+}
+
+// skip shows the rest of the page straight away
+func (b *dialogueBox) skip() {
+	b.shown = float64(len(b.pageRunes()))
+}
+
+// nextPage turns to the next page, it returns false if this is the last page
+func (b *dialogueBox) nextPage() bool {
+	if b.page >= len(b.pages)-1 {
+		return false
+	}
+	b.page++
+	b.shown = 0
+	return true
+}
+
+func (b *dialogueBox) draw(screen *ebiten.Image, more bool) {
+	textClr := color.Color(purple1)
+	if frame, ok := panelFrame(panelDialogue); ok {
+		drawFrame(screen, frame, image.Rect(0, bannerY, screenWidth, screenHeight))
+		textClr = frameText(frame, purple1)
+	} else {
+		drawSprite(screen, dialogueBanner, 0, bannerY, screenWidth, screenHeight-bannerY)
+	}
+
+	if b.speaker.ID != "" {
+		drawSprite(screen, b.speaker.Thumbnail(), speakerX, speakerY, speakerSize, speakerSize)
+		drawSprite(screen, dialogueFrame, frameX, frameY, frameSize, frameSize)
+		drawSprite(screen, dialogueNamePlate, plateX, plateY, plateWidth, plateHeight)
+
+		name := b.speaker.DisplayName()
+		x := plateX + (plateWidth-font.MeasureString(smallFont, name).Ceil())/2
+		drawSmallText(screen, name, x, plateY+1, purple1)
+	}
+
+	text := string(b.pageRunes()[:int(b.shown)])
+	drawSmallText(screen, text, dialogueTextX, dialogueTextY, textClr)
+
+	if more && b.revealed() && (b.tick/blinkFrames)%2 == 0 {
+		drawSmallText(screen, "▼", dialogueTextX+dialogueWidth, dialogueTextY+(dialogueLines-1)*smallLineHeight, textClr)
+	}
+}
+
+// drawSprite draws an image from the sprite atlas scaled to w x h
+func drawSprite(screen *ebiten.Image, id string, x, y, w, h float64) {
+	img, err := sprites.Image(id)
+	if err != nil {
+		log.Printf("unable to draw sprite: %+v\n", err)
+		return
+	}
+	iw, ih := img.Size()
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Scale(w/float64(iw), h/float64(ih))
+	opts.GeoM.Translate(x, y)
+	opts.Filter = ebiten.FilterLinear
+	screen.DrawImage(img, opts)
+}
+
+// dialogueScene is drawn over the scene below while a conversation is shown,
+// confirm reveals the rest of a page or turns to the next one
+type dialogueScene struct {
+	talk     conversation
+	current  dialogueLine
+	box      dialogueBox
+	choosing bool // true while the choices are shown
+	menu     lm.ListMenu
+	over     bool
+}
+
+func newDialogueScene(talk conversation) *dialogueScene {
+	return &dialogueScene{talk: talk}
+}
+
+func (s *dialogueScene) Enter() {
+	s.show()
+}
+
+func (s *dialogueScene) Exit() {}
+
+func (s *dialogueScene) Overlay() bool { return true }
+
+// show shows the conversation's current line
+func (s *dialogueScene) show() {
+	l, ok := s.talk.line()
+	if !ok {
+		s.over = true
+		return
+	}
+	s.current = l
+	s.choosing = false
+	s.box.say(l.Speaker, l.Text)
+}
+
+// choiceNames names the items of the choice menu
+func (s *dialogueScene) choiceNames() []string {
+	names := []string{}
+	for i := range s.current.Choices {
+		names = append(names, strconv.Itoa(i))
+	}
+	return names
+}
+
+// offerChoices builds the menu of choices for the current line
+func (s *dialogueScene) offerChoices() {
+	items := []lm.Item{}
+	for i, c := range s.current.Choices {
+		items = append(items, lm.Item{Name: strconv.Itoa(i), Text: c, TxtX: 8, TxtY: 19})
+	}
+	menu, err := menuDefs.listMenu("dialogue_choices").build(items...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.menu = menu
+	s.choosing = true
+}
+
+func (s *dialogueScene) Update() (scene.Transition, error) {
+	if s.over {
+		return scene.Pop(), nil
+	}
+
+	if s.choosing {
+		if input.JustPressed(input.MenuUp) {
+			menuPrev(&s.menu)
+		}
+		if input.JustPressed(input.MenuDown) {
+			menuNext(&s.menu)
+		}
+		names := s.choiceNames()
+		clicked := pointListMenu(&s.menu, menuDefs.listMenu("dialogue_choices"), names)
+		if input.JustPressed(input.Confirm) || clicked {
+			playConfirm()
+			s.talk.next(indexOf(names, s.menu.GetSelectedItem()))
+			s.show()
+		}
+		return scene.None(), nil
+	}
+
+	s.box.update(1 / float64(ebiten.MaxTPS()))
+
+	if input.JustPressed(input.Confirm) || input.Clicked() {
+		switch {
+		case !s.box.revealed():
+			s.box.skip()
+		case s.box.nextPage():
+		case len(s.current.Choices) > 0:
+			s.offerChoices()
+		default:
+			s.talk.next(-1)
+			s.show()
+		}
+	}
+	return scene.None(), nil
+}
+
+func (s *dialogueScene) Draw(screen *ebiten.Image) {
+	if s.over {
+		return
+	}
+	s.box.draw(screen, !s.choosing)
+	if s.choosing {
+		drawListMenu(screen, &s.menu, menuDefs.listMenu("dialogue_choices"), len(s.current.Choices))
+	}
+}
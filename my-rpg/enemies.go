@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/Rosalita/my-ebiten-examples/combat"
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+)
+
+// Enemy is what a creature avatar fights with, skill is a locale message ID
+// and only enemies with magic have one
+type Enemy struct {
+	ID      string `json:"id"` // ID of the creature avatar
+	HP      int    `json:"hp"`
+	Attack  int    `json:"attack"`
+	Defence int    `json:"defence"`
+	Magic   int    `json:"magic"`
+	Speed   int    `json:"speed"`
+	XP      int    `json:"xp"`   // given to the player for defeating it
+	Gold    int    `json:"gold"` // given to the player for defeating it
+	Skill   string `json:"skill,omitempty"`
+}
+
+// Stats returns the stats the enemy fights with
+func (e Enemy) Stats() combat.Stats {
+	return combat.Stats{MaxHP: e.HP, Attack: e.Attack, Defence: e.Defence, Magic: e.Magic, Speed: e.Speed}
+}
+
+// enemyCatalogue is the layout of data/enemies.json
+type enemyCatalogue struct {
+	Enemies []Enemy `json:"enemies"`
+
+	byID map[string]int
+}
+
+// enemies holds the catalogue once it has been loaded
+var enemies *enemyCatalogue
+
+// loadEnemyFile reads and validates an enemy catalogue, the avatar catalogue
+// must be loaded first
+func loadEnemyFile(fsys fs.FS, path string) (*enemyCatalogue, error) {
+	c := &enemyCatalogue{}
+	if err := datafile.Decode(fsys, path, c); err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, c.validate()); err != nil {
+		return nil, err
+	}
+
+	c.byID = map[string]int{}
+	for i, e := range c.Enemies {
+		c.byID[e.ID] = i
+	}
+	return c, nil
+}
+
+// validate checks every enemy is a creature avatar with stats it can fight
+// with, and that no creature avatar is left out
+func (c *enemyCatalogue) validate() []string {
+	errs := []string{}
+	seen := map[string]bool{}
+	for i, e := range c.Enemies {
+		where := fmt.Sprintf("enemy %d (%q)", i+1, e.ID)
+		if a, ok := avatars.Get(e.ID); !ok || a.Group != avatarCreature {
+			errs = append(errs, where+" is not a creature avatar")
+		}
+		if seen[e.ID] {
+			errs = append(errs, where+" has the same id as an earlier enemy")
+		}
+		seen[e.ID] = true
+
+		if e.HP <= 0 || e.Attack <= 0 || e.Speed <= 0 {
+			errs = append(errs, where+" must have hp, attack and speed above 0")
+		}
+		if e.Defence < 0 || e.Magic < 0 || e.XP < 0 || e.Gold < 0 {
+			errs = append(errs, where+" must not have negative defence, magic, xp or gold")
+		}
+		switch {
+		case e.Magic > 0 && e.Skill == "":
+			errs = append(errs, where+" has magic but no skill")
+		case e.Magic == 0 && e.Skill != "":
+			errs = append(errs, where+" has a skill but no magic to use it with")
+		case e.Skill != "" && !locale.Has(e.Skill):
+			errs = append(errs, fmt.Sprintf("%s has unknown skill %q", where, e.Skill))
+		}
+	}
+
+	for _, a := range avatars.Filter(avatarFilter{Groups: []string{avatarCreature}}) {
+		if !seen[a.ID] {
+			errs = append(errs, fmt.Sprintf("creature %q is not in the catalogue", a.ID))
+		}
+	}
+	return errs
+}
+
+// Get returns the enemy a creature avatar fights as
+func (c *enemyCatalogue) Get(id string) (Enemy, bool) {
+	i, ok := c.byID[id]
+	if !ok {
+		return Enemy{}, false
+	}
+	return c.Enemies[i], true
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/nineslice"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for DrawRect
+)
+
+// panels which can have a frame drawn behind them, set in the panels of the
+// menu definitions
+const (
+	panelDialogue = "dialogue"
+	panelTooltip  = "tooltip"
+)
+
+var panels = []string{panelDialogue, panelTooltip}
+
+// sliceModes maps the names of nine-slice modes in the menu definitions to
+// the modes
+var sliceModes = map[string]nineslice.Mode{
+	"stretch": nineslice.Stretch,
+	"tile":    nineslice.Tile,
+}
+
+// frames holds every frame by name once the sprite atlas is built
+var frames map[string]*nineslice.Slice
+
+// initFrames cuts the image of every frame in the menu definitions into nine
+// pieces, the images must be in the sprite atlas
+func initFrames() error {
+	frames = map[string]*nineslice.Slice{}
+	for _, name := range sortedKeys(menuDefs.Frames) {
+		d := menuDefs.Frames[name]
+		img, err := sprites.Image(d.Image)
+		if err != nil {
+			return err
+		}
+		s, err := nineslice.New(img, d.Insets, sliceModes[d.Edges], sliceModes[d.Centre])
+		if err != nil {
+			return fmt.Errorf("frame %q: %v", name, err)
+		}
+		frames[name] = s
+	}
+	return nil
+}
+
+// drawFrame draws a frame filling r, with its fill colour behind if it has one
+func drawFrame(screen *ebiten.Image, name string, r image.Rectangle) {
+	d, ok := menuDefs.Frames[name]
+	if !ok {
+		return
+	}
+	if d.Fill != "" {
+		in := r.Inset(d.Padding / 2)
+		ebitenutil.DrawRect(screen, float64(in.Min.X), float64(in.Min.Y), float64(in.Dx()), float64(in.Dy()), colour(d.Fill))
+	}
+	frames[name].Draw(screen, float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()), nil)
+}
+
+// framePadding returns the gap between the edge of a frame and what is drawn
+// on it
+func framePadding(name string) int {
+	if d, ok := menuDefs.Frames[name]; ok {
+		return d.Padding
+	}
+	return 0
+}
+
+// frameText returns the colour of text drawn on a frame, or def if the frame
+// doesn't say
+func frameText(name string, def color.Color) color.Color {
+	if d, ok := menuDefs.Frames[name]; ok && d.Text != "" {
+		return colour(d.Text)
+	}
+	return def
+}
+
+// panelFrame returns the frame drawn behind a panel, if it has one
+func panelFrame(panel string) (string, bool) {
+	name, ok := menuDefs.Panels[panel]
+	return name, ok
+}
+
+// frameRect returns the area a list menu's frame covers, around its first n
+// items
+func (d *listMenuDef) frameRect(n int) image.Rectangle {
+	r := d.itemRect(0)
+	for i := 1; i < n; i++ {
+		r = r.Union(d.itemRect(i))
+	}
+	return r.Inset(-framePadding(d.Frame))
+}
+
+// drawListMenu draws a list menu inside its frame if it has one, n is how many
+// items a dynamic menu was built with
+func drawListMenu(screen *ebiten.Image, m *lm.ListMenu, d *listMenuDef, n int) {
+	if !d.Dynamic {
+		n = len(d.Items)
+	}
+	if d.Frame != "" {
+		drawFrame(screen, d.Frame, d.frameRect(n))
+	}
+	m.Draw(screen)
+}
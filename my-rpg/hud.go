@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"log"
+	"math"
+
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"golang.org/x/image/font"
+)
+
+// layout of the HUD, hearts are in the top left corner and gold in the top
+// right
+const (
+	hudHeart     = "ui/heart_50"
+	hudGold      = "ui/gold_50"
+	hudMargin    = 6  // gap between the HUD and the edge of the screen
+	hudIconSize  = 16 // icons are drawn this big
+	hudIconGap   = 2
+	heartsPerRow = 10
+	hpPerHeart   = 2 // a heart with one hit point is drawn half full
+
+	goldCountRate = 50 // gold counted each second, at least
+	goldCatchUp   = 4  // the counter also covers this many times the gold left to count each second
+)
+
+// hudImages are the images the HUD needs in the sprite atlas
+var hudImages = []string{hudHeart, hudGold}
+
+// anchor is the corner of the screen something is placed relative to
+type anchor int
+
+const (
+	topLeft anchor = iota
+	topRight
+	bottomLeft
+	bottomRight
+)
+
+// place returns the top left of a w x h box placed margin pixels from the
+// anchor's corner of the screen
+func (a anchor) place(screen *ebiten.Image, w, h, margin int) image.Point {
+	sw, sh := screen.Size()
+	p := image.Pt(margin, margin)
+	if a == topRight || a == bottomRight {
+		p.X = sw - margin - w
+	}
+	if a == bottomLeft || a == bottomRight {
+		p.Y = sh - margin - h
+	}
+	return p
+}
+
+// hudScene is a gameplay scene, the HUD is drawn while one is on top of the
+// stack so it is hidden under dialogue and menus
+type hudScene interface {
+	hud() *hud
+}
+
+// hud shows the player's health and gold over gameplay
+type hud struct {
+	hp, maxHP int
+	gold      int
+	shownGold float64 // the gold counter counts up or down to gold
+}
+
+// reset shows values straight away, without counting up to them
+func (h *hud) reset(hp, maxHP, gold int) {
+	h.hp, h.maxHP, h.gold = hp, maxHP, gold
+	h.shownGold = float64(gold)
+}
+
+// update changes the values shown, dt is the time since the last update
+func (h *hud) update(hp, maxHP, gold int, dt float64) {
+	h.hp, h.maxHP, h.gold = hp, maxHP, gold
+
+	diff := float64(gold) - h.shownGold
+	step := math.Max(goldCountRate, goldCatchUp*math.Abs(diff)) * dt
+	if math.Abs(diff) <= step {
+		h.shownGold = float64(gold)
+	} else {
+		h.shownGold += math.Copysign(step, diff)
+	}
+}
+
+// drawHUD draws the HUD of the top scene, if it has one, the scene manager
+// calls it once the scenes have been drawn
+func drawHUD(top scene.Scene, screen *ebiten.Image) {
+	if s, ok := top.(hudScene); ok {
+		s.hud().draw(screen)
+	}
+}
+
+func (h *hud) draw(screen *ebiten.Image) {
+	h.drawHearts(screen)
+	h.drawGold(screen)
+}
+
+// drawHearts draws a heart for every two hit points, full hearts first then a
+// half heart for an odd hit point, then empty hearts up to the maximum
+func (h *hud) drawHearts(screen *ebiten.Image) {
+	heart, err := sprites.Image(hudHeart)
+	if err != nil {
+		log.Printf("unable to draw HUD: %+v\n", err)
+		return
+	}
+
+	hearts := (h.maxHP + hpPerHeart - 1) / hpPerHeart
+	cols := min(hearts, heartsPerRow)
+	rows := (hearts + heartsPerRow - 1) / heartsPerRow
+	step := hudIconSize + hudIconGap
+	origin := topLeft.place(screen, cols*step-hudIconGap, rows*step-hudIconGap, hudMargin)
+
+	w, hgt := heart.Size()
+	for i := 0; i < hearts; i++ {
+		x := float64(origin.X + (i%heartsPerRow)*step)
+		y := float64(origin.Y + (i/heartsPerRow)*step)
+		filled := min(max(h.hp-i*hpPerHeart, 0), hpPerHeart) // hit points in this heart
+
+		// an empty heart is drawn faded behind, so the half heart shows
+		// its empty half
+		if filled < hpPerHeart {
+			opts := &ebiten.DrawImageOptions{}
+			opts.GeoM.Scale(hudIconSize/float64(w), hudIconSize/float64(hgt))
+			opts.GeoM.Translate(x, y)
+			opts.ColorM.ChangeHSV(0, 0, 0.5)
+			opts.ColorM.Scale(1, 1, 1, 0.5)
+			opts.Filter = ebiten.FilterLinear
+			screen.DrawImage(heart, opts)
+		}
+		if filled == 0 {
+			continue
+		}
+
+		img := heart
+		if filled < hpPerHeart {
+			b := heart.Bounds()
+			img = heart.SubImage(image.Rect(b.Min.X, b.Min.Y, b.Min.X+w*filled/hpPerHeart, b.Max.Y)).(*ebiten.Image)
+		}
+		opts := &ebiten.DrawImageOptions{}
+		opts.GeoM.Scale(hudIconSize/float64(w), hudIconSize/float64(hgt))
+		opts.GeoM.Translate(x, y)
+		opts.Filter = ebiten.FilterLinear
+		screen.DrawImage(img, opts)
+	}
+}
+
+// drawGold draws the gold counter with its icon to the left
+func (h *hud) drawGold(screen *ebiten.Image) {
+	label := fmt.Sprint(int(math.Round(h.shownGold)))
+	textW := font.MeasureString(mplusFont, label).Ceil()
+	p := topRight.place(screen, hudIconSize+hudIconGap*2+textW, hudIconSize, hudMargin)
+
+	drawSprite(screen, hudGold, float64(p.X), float64(p.Y), hudIconSize, hudIconSize)
+	drawText(screen, label, p.X+hudIconSize+hudIconGap*2, p.Y-2)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+)
+
+// imageMenu shows one image at a time from a list, the selection is changed
+// with the arrows either side of it. Its images are cut from the sprite atlas
+// so every image menu draws from the same texture
+type imageMenu struct {
+	x, y     float64
+	w, h     int // size the images are drawn at
+	names    []string
+	images   []*ebiten.Image
+	selected int
+}
+
+func (m *imageMenu) IncrementSelected() {
+	if m.selected < len(m.names)-1 {
+		m.selected++
+	}
+}
+
+func (m *imageMenu) DecrementSelected() {
+	if m.selected > 0 {
+		m.selected--
+	}
+}
+
+func (m *imageMenu) GetSelectedItem() string {
+	if len(m.names) == 0 {
+		return ""
+	}
+	return m.names[m.selected]
+}
+
+// Draw draws the selected image scaled to the size of the menu
+func (m *imageMenu) Draw(screen *ebiten.Image) {
+	if len(m.images) == 0 {
+		return
+	}
+	img := m.images[m.selected]
+	iw, ih := img.Size()
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Scale(float64(m.w)/float64(iw), float64(m.h)/float64(ih))
+	opts.GeoM.Translate(m.x, m.y)
+	screen.DrawImage(img, opts)
+}
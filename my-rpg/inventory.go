@@ -0,0 +1,492 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"strings"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/inventory"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+)
+
+// actionInventory opens the inventory from the world
+const actionInventory input.Action = "inventory"
+
+func init() {
+	input.Register(actionInventory, input.KeyBinding(ebiten.KeyI), input.KeyBinding(ebiten.KeyTab), input.ButtonBinding(ebiten.GamepadButton3))
+}
+
+// layout of the inventory screen, the slot grid is on the left with the
+// equipment slots to its right
+const (
+	inventorySlotFrame = "ui/frame_square_150"
+	inventoryCols      = 5
+	slotSize           = 40
+	slotIconInset      = 6 // gap between a slot's frame and its icon
+	gridX              = 20
+	gridY              = 40
+	equipX             = 260
+	equipY             = 40
+	equipGap           = 46 // distance from the top of one equipment slot to the next
+	tooltipWidth       = 150
+	tooltipPadding     = 6
+	dragDistance       = 4 // pixels the cursor moves with the button held before a press becomes a drag
+)
+
+// item actions, offered in a menu when a slot is picked
+const (
+	itemUse     = "use"
+	itemEquip   = "equip"
+	itemUnequip = "unequip"
+	itemDrop    = "drop"
+	itemMove    = "move"
+	itemCancel  = "cancel"
+)
+
+// noSlot is a slot index which isn't a slot
+const noSlot = -1
+
+// inventoryScene shows the player's items as a grid of slots. Slots are picked
+// with the keyboard or mouse and picking one offers what can be done with its
+// item, items are rearranged by moving them or dragging them with the mouse.
+//
+// Slots are numbered with the grid first, then the equipment slots
+type inventoryScene struct {
+	player *Character
+	inv    *inventory.Inventory
+
+	selected int
+	moving   int // slot whose item is being moved with the keyboard, or noSlot
+	choosing bool
+	menu     lm.ListMenu
+	actions  []string // names of the menu's items
+
+	pressed  int // slot the mouse button was pressed on, or noSlot
+	pressAt  image.Point
+	dragging bool
+
+	message string // what happened to the last item acted on
+}
+
+func newInventoryScene(player *Character) *inventoryScene {
+	return &inventoryScene{player: player}
+}
+
+func (s *inventoryScene) Enter() {
+	s.inv = s.player.inventory()
+	s.selected, s.moving, s.pressed = 0, noSlot, noSlot
+	s.choosing, s.dragging, s.message = false, false, ""
+}
+
+func (s *inventoryScene) Exit() {
+	s.keep()
+}
+
+// keep saves the inventory back to the player
+func (s *inventoryScene) keep() {
+	s.player.Inventory = s.inv.Save()
+}
+
+// slotCount returns how many slots there are, including equipment slots
+func (s *inventoryScene) slotCount() int {
+	return len(s.inv.Slots) + len(items.Slots)
+}
+
+// equipSlot returns the name of the equipment slot a slot is, if it is one
+func (s *inventoryScene) equipSlot(i int) (string, bool) {
+	if i < len(s.inv.Slots) {
+		return "", false
+	}
+	return items.Slots[i-len(s.inv.Slots)], true
+}
+
+// slotRect returns the area of the screen a slot covers
+func (s *inventoryScene) slotRect(i int) image.Rectangle {
+	var p image.Point
+	if i < len(s.inv.Slots) {
+		p = image.Pt(gridX+(i%inventoryCols)*slotSize, gridY+(i/inventoryCols)*slotSize)
+	} else {
+		p = image.Pt(equipX, equipY+(i-len(s.inv.Slots))*equipGap)
+	}
+	return image.Rectangle{p, p.Add(image.Pt(slotSize, slotSize))}
+}
+
+// slotAt returns the slot under a point, or noSlot
+func (s *inventoryScene) slotAt(p image.Point) int {
+	for i := 0; i < s.slotCount(); i++ {
+		if p.In(s.slotRect(i)) {
+			return i
+		}
+	}
+	return noSlot
+}
+
+// item returns the definition of the item in a slot
+func (s *inventoryScene) item(i int) (*inventory.Def, int, bool) {
+	if slot, ok := s.equipSlot(i); ok {
+		d, ok := items.Get(s.inv.Equipped[slot])
+		return d, 1, ok
+	}
+	st := s.inv.Slots[i]
+	d, ok := items.Get(st.ID)
+	return d, st.Count, ok && !st.Empty()
+}
+
+// navigate moves the selection one slot in a direction, moving right off the
+// grid goes to the equipment slots and moving left off them goes back
+func (s *inventoryScene) navigate(dx, dy int) {
+	grid := len(s.inv.Slots)
+	rows := grid / inventoryCols
+	i := s.selected
+	if i < grid {
+		col, row := i%inventoryCols, i/inventoryCols
+		switch {
+		case dx > 0 && col == inventoryCols-1:
+			i = grid + min(row, len(items.Slots)-1)
+		case dx != 0:
+			i = row*inventoryCols + min(max(col+dx, 0), inventoryCols-1)
+		case dy != 0:
+			i = min(max(row+dy, 0), rows-1)*inventoryCols + col
+		}
+	} else {
+		k := i - grid
+		switch {
+		case dx < 0:
+			i = min(k, rows-1)*inventoryCols + inventoryCols - 1
+		case dy != 0:
+			i = grid + min(max(k+dy, 0), len(items.Slots)-1)
+		}
+	}
+	if i != s.selected {
+		soundManager.PlayEffect("nav")
+	}
+	s.selected = i
+}
+
+// offerActions builds the menu of what can be done with the selected item
+func (s *inventoryScene) offerActions() {
+	d, _, ok := s.item(s.selected)
+	if !ok {
+		return
+	}
+	s.actions = nil
+	if _, worn := s.equipSlot(s.selected); worn {
+		s.actions = append(s.actions, itemUnequip)
+	} else {
+		if len(d.Use) > 0 {
+			s.actions = append(s.actions, itemUse)
+		}
+		if d.Slot != "" {
+			s.actions = append(s.actions, itemEquip)
+		}
+		s.actions = append(s.actions, itemDrop, itemMove)
+	}
+	s.actions = append(s.actions, itemCancel)
+
+	menuItems := []lm.Item{}
+	for _, a := range s.actions {
+		menuItems = append(menuItems, lm.Item{Name: a, Text: locale.T("inventory." + a), TxtX: 8, TxtY: 17})
+	}
+	menu, err := menuDefs.listMenu("item_actions").build(menuItems...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.menu = menu
+	s.choosing = true
+}
+
+// act does an action to the item in the selected slot
+func (s *inventoryScene) act(action string) {
+	s.choosing = false
+	d, _, ok := s.item(s.selected)
+	if !ok {
+		return
+	}
+	name := locale.T(d.Name)
+
+	switch action {
+	case itemUse:
+		s.use(d)
+	case itemEquip:
+		s.equip(s.selected)
+	case itemUnequip:
+		slot, _ := s.equipSlot(s.selected)
+		s.unequip(slot, noSlot)
+	case itemDrop:
+		s.inv.Remove(s.selected, 1)
+		s.message = locale.T("inventory.dropped", name)
+	case itemMove:
+		s.moving = s.selected
+		s.message = locale.T("inventory.moving", name)
+	}
+	s.keep()
+}
+
+// use uses up one of the item in the selected slot
+func (s *inventoryScene) use(d *inventory.Def) {
+	heal := d.Use[useHeal]
+	if heal > 0 && s.player.HP >= s.player.MaxHP() {
+		s.message = locale.T("inventory.full_health")
+		return
+	}
+	s.player.HP = min(s.player.HP+heal, s.player.MaxHP())
+	s.inv.Remove(s.selected, 1)
+	s.message = locale.T("inventory.used", locale.T(d.Name))
+}
+
+// equip wears the item in a slot
+func (s *inventoryScene) equip(i int) {
+	d, _, _ := s.item(i)
+	if err := s.inv.Equip(i); err != nil {
+		log.Printf("unable to equip item: %+v\n", err)
+		return
+	}
+	s.message = locale.T("inventory.equipped", locale.T(d.Name))
+}
+
+// unequip takes off the item in an equipment slot and puts it in slot to, or
+// the first empty slot
+func (s *inventoryScene) unequip(slot string, to int) {
+	if err := s.inv.Unequip(slot, to); err != nil {
+		s.message = locale.T("inventory.full")
+		return
+	}
+	s.message = ""
+}
+
+// place moves the item in one slot to another, putting an item in an
+// equipment slot equips it and taking it out unequips it
+func (s *inventoryScene) place(from, to int) {
+	defer s.keep()
+	fromSlot, fromWorn := s.equipSlot(from)
+	toSlot, toWorn := s.equipSlot(to)
+	switch {
+	case fromWorn && !toWorn:
+		s.unequip(fromSlot, to)
+	case !fromWorn && toWorn:
+		d, _, ok := s.item(from)
+		if !ok || d.Slot != toSlot {
+			s.message = locale.T("inventory.wrong_slot")
+			return
+		}
+		s.equip(from)
+	case !fromWorn && !toWorn:
+		s.inv.Move(from, to)
+		s.message = ""
+	}
+	s.selected = to
+}
+
+func (s *inventoryScene) Update() (scene.Transition, error) {
+	if s.choosing {
+		return s.updateActions(), nil
+	}
+
+	if input.JustPressed(input.Back) || input.JustPressed(actionInventory) {
+		playBack()
+		if s.moving != noSlot {
+			s.moving, s.message = noSlot, ""
+			return scene.None(), nil
+		}
+		return scene.Pop(), nil
+	}
+
+	if input.JustPressed(input.MenuUp) {
+		s.navigate(0, -1)
+	}
+	if input.JustPressed(input.MenuDown) {
+		s.navigate(0, 1)
+	}
+	if input.JustPressed(input.MenuLeft) {
+		s.navigate(-1, 0)
+	}
+	if input.JustPressed(input.MenuRight) {
+		s.navigate(1, 0)
+	}
+	if input.JustPressed(input.Confirm) {
+		s.pick()
+	}
+
+	s.updateMouse()
+	return scene.None(), nil
+}
+
+// pick is confirming the selected slot, it puts down an item being moved or
+// offers what can be done with the item in it
+func (s *inventoryScene) pick() {
+	if s.moving != noSlot {
+		from := s.moving
+		s.moving = noSlot
+		playConfirm()
+		s.place(from, s.selected)
+		return
+	}
+	if _, _, ok := s.item(s.selected); ok {
+		playConfirm()
+		s.offerActions()
+	}
+}
+
+// updateActions handles the menu of item actions
+func (s *inventoryScene) updateActions() scene.Transition {
+	if input.JustPressed(input.Back) {
+		playBack()
+		s.choosing = false
+		return scene.None()
+	}
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(&s.menu)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(&s.menu)
+	}
+	clicked := pointListMenu(&s.menu, menuDefs.listMenu("item_actions"), s.actions)
+	if input.JustPressed(input.Confirm) || clicked {
+		playConfirm()
+		s.act(s.menu.GetSelectedItem())
+	}
+	return scene.None()
+}
+
+// updateMouse selects the slot under the cursor, clicking a slot offers what
+// can be done with its item and dragging an item moves it to another slot
+func (s *inventoryScene) updateMouse() {
+	c := input.Cursor()
+	under := s.slotAt(c)
+	if under != noSlot && input.CursorMoved() && !s.dragging {
+		s.selected = under
+	}
+
+	if input.Clicked() && under != noSlot {
+		s.pressed, s.pressAt = under, c
+		s.selected = under
+	}
+	if s.pressed == noSlot {
+		return
+	}
+
+	d := c.Sub(s.pressAt)
+	if input.MouseDown() && d.X*d.X+d.Y*d.Y >= dragDistance*dragDistance {
+		if _, _, ok := s.item(s.pressed); ok {
+			s.dragging = true
+		}
+	}
+	if !input.Released() {
+		return
+	}
+
+	from, dragged := s.pressed, s.dragging
+	s.pressed, s.dragging = noSlot, false
+	switch {
+	case dragged && under != noSlot && under != from:
+		playConfirm()
+		s.place(from, under)
+	case !dragged && under == from:
+		s.pick()
+	}
+}
+
+func (s *inventoryScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+	drawText(screen, locale.T("inventory.title"), gridX, 8)
+
+	for i := 0; i < s.slotCount(); i++ {
+		s.drawSlot(screen, i)
+	}
+	for i, slot := range items.Slots {
+		r := s.slotRect(len(s.inv.Slots) + i)
+		drawSmallText(screen, locale.T("inventory.slot."+slot), r.Max.X+6, r.Min.Y+12, white)
+	}
+
+	stats := locale.T("inventory.weight", fmt.Sprintf("%g", s.inv.Weight()), fmt.Sprintf("%g", s.inv.MaxWeight))
+	stats += "\n" + locale.T("inventory.hp", s.player.HP, s.player.MaxHP())
+	stats += "\n" + locale.T("inventory.bonuses", s.inv.Bonus(bonusAttack), s.inv.Bonus(bonusDefence))
+	drawSmallText(screen, stats, gridX, gridY+slotSize*len(s.inv.Slots)/inventoryCols+8, white)
+	if s.message != "" {
+		drawSmallText(screen, s.message, gridX, 276, pink)
+	}
+
+	if s.choosing {
+		drawListMenu(screen, &s.menu, menuDefs.listMenu("item_actions"), len(s.actions))
+		return
+	}
+	if s.dragging {
+		if d, _, ok := s.item(s.pressed); ok {
+			c := input.Cursor()
+			size := float64(slotSize - slotIconInset*2)
+			drawSprite(screen, d.Icon, float64(c.X)-size/2, float64(c.Y)-size/2, size, size)
+		}
+		return
+	}
+	s.drawTooltip(screen)
+}
+
+// drawSlot draws a slot's frame and the icon of its item with how many there
+// are, the selected slot is highlighted
+func (s *inventoryScene) drawSlot(screen *ebiten.Image, i int) {
+	r := s.slotRect(i)
+	if i == s.selected || i == s.moving {
+		drawFocus(screen, float64(r.Min.X), float64(r.Min.Y), slotSize, slotSize)
+	}
+	drawSprite(screen, inventorySlotFrame, float64(r.Min.X), float64(r.Min.Y), slotSize, slotSize)
+
+	d, count, ok := s.item(i)
+	if !ok || (s.dragging && i == s.pressed) {
+		return
+	}
+	size := float64(slotSize - slotIconInset*2)
+	drawSprite(screen, d.Icon, float64(r.Min.X+slotIconInset), float64(r.Min.Y+slotIconInset), size, size)
+	if count > 1 {
+		drawSmallText(screen, fmt.Sprint(count), r.Min.X+slotIconInset, r.Max.Y-18, white)
+	}
+}
+
+// drawTooltip describes the item in the selected slot in a box beside it, on
+// the tooltip's frame if it has one
+func (s *inventoryScene) drawTooltip(screen *ebiten.Image) {
+	d, _, ok := s.item(s.selected)
+	if !ok {
+		return
+	}
+	frame, framed := panelFrame(panelTooltip)
+	pad := tooltipPadding
+	if framed {
+		pad = framePadding(frame)
+	}
+
+	lines := []string{wrapText(locale.T(d.Description), smallFont, tooltipWidth-pad*2)}
+	for _, use := range sortedKeys(d.Use) {
+		lines = append(lines, locale.T("inventory.use."+use, d.Use[use]))
+	}
+	for _, bonus := range sortedKeys(d.Bonuses) {
+		lines = append(lines, locale.T("inventory.bonus."+bonus, d.Bonuses[bonus]))
+	}
+	lines = append(lines, locale.T("inventory.item_weight", fmt.Sprintf("%g", d.Weight)))
+	body := strings.Join(lines, "\n")
+
+	h := pad*2 + smallLineHeight*(1+strings.Count(body, "\n")+1)
+	r := s.slotRect(s.selected)
+	x, y := r.Max.X+4, r.Min.Y
+	if x+tooltipWidth > screenWidth {
+		x = r.Min.X - 4 - tooltipWidth
+	}
+	y = min(y, screenHeight-h)
+
+	var nameClr, textClr color.Color = pink, white
+	if framed {
+		drawFrame(screen, frame, image.Rect(x, y, x+tooltipWidth, y+h))
+		textClr = frameText(frame, white)
+		nameClr = textClr
+	} else {
+		ebitenutil.DrawRect(screen, float64(x), float64(y), tooltipWidth, float64(h), purple1)
+	}
+	drawSmallText(screen, locale.T(d.Name), x+pad, y+pad, nameClr)
+	drawSmallText(screen, body, x+pad, y+pad+smallLineHeight, textClr)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+	"github.com/Rosalita/my-ebiten-examples/inventory"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+)
+
+// how much a character can carry
+const (
+	inventorySlots   = 20
+	carryBase        = 12 // weight a character with no strength can carry
+	carryPerStrength = 3
+)
+
+// what items do, the game knows these names for an item's use and bonuses
+const (
+	useHeal      = "heal"
+	bonusAttack  = "attack"
+	bonusDefence = "defence"
+)
+
+var (
+	itemUses    = []string{useHeal}
+	itemBonuses = []string{bonusAttack, bonusDefence}
+)
+
+// startingItems are given to every new character
+var startingItems = []inventory.Stack{
+	{ID: "potion", Count: 3},
+	{ID: "bread", Count: 2},
+	{ID: "dagger", Count: 1},
+}
+
+// items holds the item catalogue once it has been loaded
+var items *inventory.Catalogue
+
+// loadItemFile reads an item catalogue and checks the game has everything its
+// items need
+func loadItemFile(fsys fs.FS, path string) (*inventory.Catalogue, error) {
+	c, err := inventory.Load(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, checkItems(c)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// checkItems returns a description of every icon, message, use or bonus in a
+// catalogue which the game doesn't have, along with starting items which aren't
+// in it
+func checkItems(c *inventory.Catalogue) []string {
+	errs := []string{}
+	for _, d := range c.Items {
+		where := fmt.Sprintf("item %q", d.ID)
+		if !assets.Has(d.Icon) {
+			errs = append(errs, fmt.Sprintf("%s has no icon image %q", where, d.Icon))
+		}
+		for _, id := range []string{d.Name, d.Description} {
+			if id != "" && !locale.Has(id) {
+				errs = append(errs, fmt.Sprintf("%s has unknown text %q", where, id))
+			}
+		}
+		for _, use := range sortedKeys(d.Use) {
+			if !slices.Contains(itemUses, use) {
+				errs = append(errs, fmt.Sprintf("%s has unknown use %q, expected one of %s", where, use, strings.Join(itemUses, ", ")))
+			}
+		}
+		for _, bonus := range sortedKeys(d.Bonuses) {
+			if !slices.Contains(itemBonuses, bonus) {
+				errs = append(errs, fmt.Sprintf("%s has unknown bonus %q, expected one of %s", where, bonus, strings.Join(itemBonuses, ", ")))
+			}
+		}
+	}
+	for _, s := range startingItems {
+		if _, ok := c.Get(s.ID); !ok {
+			errs = append(errs, fmt.Sprintf("starting item %q is not in the catalogue", s.ID))
+		}
+	}
+	return errs
+}
+
+// carryWeight returns the most weight a character can carry
+func (c Character) carryWeight() float64 {
+	return carryBase + carryPerStrength*float64(c.Stats.Strength)
+}
+
+// inventory returns the character's items, changes are kept by saving them back
+// to the character with Save
+func (c Character) inventory() *inventory.Inventory {
+	return inventory.New(items, inventorySlots, c.carryWeight(), c.Inventory)
+}
+
+// giveStartingItems puts the starting items in a new character's inventory
+func (c *Character) giveStartingItems() {
+	inv := c.inventory()
+	for _, s := range startingItems {
+		if _, err := inv.Add(s.ID, s.Count); err != nil {
+			// the catalogue is checked so only the weight can stop them
+			// fitting, a weak character carries what they can
+			break
+		}
+	}
+	c.Inventory = inv.Save()
+}
@@ -1,27 +1,23 @@
 package main
 
 import (
-	"bytes"
-	"image"
+	"embed"
+	"flag"
 	"image/color"
+	"io/fs"
 	"log"
 	"os"
+	"strings"
 
-	im "github.com/Rosalita/ebiten-pkgs/imagemenu"
 	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
-	"github.com/Rosalita/my-ebiten-examples/resources/my_img"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten"
-	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
-	"github.com/hajimehoshi/ebiten/inpututil"  // required for isKeyJustPressed
-)
-
-type gameState int
-
-const (
-	titleScreen gameState = iota
-	options
-	charCreation
-	quit
+	"github.com/hajimehoshi/ebiten/examples/resources/fonts"
+	"github.com/hajimehoshi/ebiten/text"
+	"golang.org/x/image/font"
 )
 
 // define some kind of palette
@@ -42,121 +38,204 @@ var (
 	purple5 = &color.NRGBA{0x99, 0x69, 0xa6, 0xff}
 )
 
+// palette maps the colour names used in data files to colours
+var palette = map[string]*color.NRGBA{
+	"white":   white,
+	"pink":    pink,
+	"orange1": orange1,
+	"blue1":   blue1,
+	"green1":  green1,
+	"green2":  green2,
+	"green3":  green3,
+	"green4":  green4,
+	"green5":  green5,
+	"purple1": purple1,
+	"purple2": purple2,
+	"purple3": purple3,
+	"purple4": purple4,
+	"purple5": purple5,
+}
+
+// data files such as menus.json are built into the game, so it runs from
+// any directory
+//
+//go:embed data
+var embeddedData embed.FS
+
+// dataDir is a directory of modded data files to read instead of the built in
+// ones, empty unless the -data flag is given
+var dataDir string
+
+// dataFiles returns the data files, from dataDir when it is set
+func dataFiles() fs.FS {
+	if dataDir != "" {
+		return os.DirFS(dataDir)
+	}
+	files, err := fs.Sub(embeddedData, "data")
+	if err != nil {
+		panic(err) // only if the embed pattern is wrong
+	}
+	return files
+}
+
+// size of the screen before it is scaled
+const (
+	screenWidth  = 400
+	screenHeight = 300
+)
+
 var (
-	state       gameState
 	mainImage   *ebiten.Image
 	charImage   *ebiten.Image
 	rightArrow  *ebiten.Image
 	leftArrow   *ebiten.Image
 	mainMenu    lm.ListMenu
 	optionsMenu lm.ListMenu
-	charGroupMenu    im.ImageMenu
-	humanMenu    im.ImageMenu
-	creatureMenu im.ImageMenu
+	charGroupMenu    imageMenu
+	humanMenu    imageMenu
+	creatureMenu imageMenu
+	mplusFont    font.Face
+	smallFont    font.Face
 )
 
 func init() {
 
-	img, _, err := image.Decode(bytes.NewReader(my_img.BirdSkull))
+	var err error
+	mainImage, err = assets.Image("my_img/bird_skull")
 	if err != nil {
 		log.Fatal(err)
 	}
-	mainImage, _ = ebiten.NewImageFromImage(img, ebiten.FilterDefault)
 
 	rightArrow, _ = ebiten.NewImage(36, 36, ebiten.FilterDefault)
 	leftArrow, _ = ebiten.NewImage(36, 36, ebiten.FilterDefault)
 
-}
-
-func update(screen *ebiten.Image) error {
+	// mplus has japanese glyphs, so it is used for any translated text
+	// which is not drawn by a menu
+	tt, err := truetype.Parse(fonts.MPlus1pRegular_ttf)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+	const dpi = 72
+	mplusFont = truetype.NewFace(tt, &truetype.Options{
+		Size:    16,
+		DPI:     dpi,
+		Hinting: font.HintingFull,
+	})
+	smallFont = truetype.NewFace(tt, &truetype.Options{
+		Size:    12,
+		DPI:     dpi,
+		Hinting: font.HintingFull,
+	})
 
-	if state == titleScreen {
+}
 
-		ebitenutil.DebugPrint(screen, "Title screen")
-		mainMenu.Draw(screen)
+func main() {
 
-		opts := &ebiten.DrawImageOptions{}
-		opts.GeoM.Translate(200, 24)
-		screen.DrawImage(mainImage, opts)
+	flag.StringVar(&dataDir, "data", "", "directory to read modded data files from instead of the built in ones")
+	flag.Parse()
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
-			mainMenu.DecrementSelected()
-		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
-			mainMenu.IncrementSelected()
-		}
+	var err error
+	if settings, err = loadSettings(); err != nil {
+		log.Printf("unable to load settings: %+v\n", err)
+	}
+	ebiten.SetFullscreen(settings.Fullscreen)
+	if err := input.Import(settings.Bindings); err != nil {
+		log.Printf("unable to load key bindings: %+v\n", err)
+	}
+	if err := locale.SetLanguage(settings.Language); err != nil {
+		log.Printf("unable to set language: %+v\n", err)
+	}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
-			switch mainMenu.GetSelectedItem() {
-			case "playButton":
-				state = charCreation
-			case "optionButton":
-				state = options
-			case "quitButton":
-				os.Exit(0)
-			}
-			return nil
-		}
+	initSounds()
+	settings.applyVolume()
 
+	if err := initMenus(); err != nil {
+		log.Fatal(err)
+	}
+	if err := initDialogue(); err != nil {
+		log.Fatal(err)
 	}
+	locale.OnChange(func() {
+		if err := relabelMenus(); err != nil {
+			log.Printf("unable to rebuild menus: %+v\n", err)
+		}
+	})
 
-	if state == charCreation {
-		ebitenutil.DebugPrint(screen, "Character Creation")
+	scenes := scene.NewManager(&titleScene{})
+	scenes.DrawOver = drawHUD
 
-		charGroupMenu.Draw(screen)
+	update := func(screen *ebiten.Image) error {
+		input.Update()
+		err := scenes.Update(screen)
+		updateSound(scenes.Top())
+		return err
+	}
 
-		humanMenu.Draw(screen)
+	err = ebiten.Run(update, screenWidth, screenHeight, settings.Scale, "State!")
+	scenes.Close() // lets scenes save when the window is closed
+	if err != nil && err != scene.ErrQuit {
+		panic(err)
+	}
+}
 
-		creatureMenu.Draw(screen)
+// drawText draws lines of text in white with the top of the first line at y
+func drawText(screen *ebiten.Image, s string, x, y int) {
+	const lineHeight = 20
+	for i, line := range strings.Split(s, "\n") {
+		text.Draw(screen, line, mplusFont, x, y+lineHeight*(i+1)-4, white)
+	}
+}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyRight) {
-			charGroupMenu.IncrementSelected()
-			humanMenu.IncrementSelected()
-			creatureMenu.IncrementSelected()
-		}
+// drawHeading draws the heading of a screen in its top left corner
+func drawHeading(screen *ebiten.Image, s string) {
+	drawSmallText(screen, s, 4, 2, white)
+}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyLeft) {
-			charGroupMenu.DecrementSelected()
-			humanMenu.DecrementSelected()
-			creatureMenu.DecrementSelected()
-		}
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-			state = titleScreen
-			return nil
-		}
+// smallLineHeight is the height of a line of text drawn by drawSmallText
+const smallLineHeight = 14
 
+// drawSmallText draws lines of text in the small font with the top of the
+// first line at y
+func drawSmallText(screen *ebiten.Image, s string, x, y int, clr color.Color) {
+	for i, line := range strings.Split(s, "\n") {
+		text.Draw(screen, line, smallFont, x, y+smallLineHeight*(i+1)-3, clr)
 	}
+}
 
-	if state == options {
-		ebitenutil.DebugPrint(screen, "Options screen")
-		optionsMenu.Draw(screen)
+// wrapText breaks text into lines no wider than width. Lines are broken
+// between words where possible, text without spaces such as japanese is
+// broken between characters
+func wrapText(s string, face font.Face, width int) string {
+	lines := []string{}
+	line := ""
+	fits := func(s string) bool {
+		return font.MeasureString(face, s).Ceil() <= width
+	}
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
-			optionsMenu.DecrementSelected()
+	for _, word := range strings.Fields(s) {
+		next := word
+		if line != "" {
+			next = line + " " + word
 		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
-			optionsMenu.IncrementSelected()
+		if fits(next) {
+			line = next
+			continue
 		}
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-			state = titleScreen
-			return nil
+		if line != "" {
+			lines = append(lines, line)
+			line = ""
+		}
+		for _, r := range word {
+			if line != "" && !fits(line+string(r)) {
+				lines = append(lines, line)
+				line = ""
+			}
+			line += string(r)
 		}
 	}
-
-	return nil
-}
-
-func main() {
-
-	initMenus()
-
-	state = titleScreen
-
-	if err := ebiten.Run(update, 400, 300, 2, "State!"); err != nil {
-		panic(err)
+	if line != "" {
+		lines = append(lines, line)
 	}
+	return strings.Join(lines, "\n")
 }
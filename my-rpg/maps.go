@@ -0,0 +1,28 @@
+package main
+
+import (
+	"path"
+
+	"github.com/Rosalita/my-ebiten-examples/tilemap"
+)
+
+// startMap is the map a new game starts on
+const startMap = "overworld"
+
+// loadedMaps holds every map loaded so far by name, maps don't change while
+// the game runs so each is only read once
+var loadedMaps = map[string]*tilemap.Map{}
+
+// loadMap returns the map with a name, maps are Tiled JSON files in the maps
+// directory of the data directory
+func loadMap(name string) (*tilemap.Map, error) {
+	if m, ok := loadedMaps[name]; ok {
+		return m, nil
+	}
+	m, err := tilemap.Load(dataFiles(), path.Join("maps", name+".tmj"))
+	if err != nil {
+		return nil, err
+	}
+	loadedMaps[name] = m
+	return m, nil
+}
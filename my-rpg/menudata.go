@@ -0,0 +1,366 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io/fs"
+	"slices"
+	"sort"
+	"strings"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/datafile"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/nineslice"
+)
+
+// menuFile is the layout of data/menus.json
+type menuFile struct {
+	ListMenus  map[string]*listMenuDef  `json:"list_menus"`
+	ImageMenus map[string]*imageMenuDef `json:"image_menus"`
+	Frames     map[string]*frameDef     `json:"frames"`
+	Panels     map[string]string        `json:"panels"` // frame drawn behind each panel, panels without one keep their own look
+}
+
+// frameDef describes an image drawn as a nine-slice frame around menus and
+// panels, fill and text are names from the palette
+type frameDef struct {
+	Image   string           `json:"image"`
+	Insets  nineslice.Insets `json:"insets"`  // widths of the image's border
+	Edges   string           `json:"edges"`   // "stretch" or "tile"
+	Centre  string           `json:"centre"`  // "stretch" or "tile"
+	Padding int              `json:"padding"` // gap between the edge of the frame and what is drawn on it
+	Fill    string           `json:"fill"`    // drawn behind frames with an open centre
+	Text    string           `json:"text"`    // colour of text drawn on the frame
+}
+
+// listMenuLayout is where a list menu is drawn and how big its items are
+type listMenuLayout struct {
+	X          float64 `json:"x"`
+	Y          float64 `json:"y"`
+	Width      int     `json:"width"`
+	ItemHeight int     `json:"item_height"`
+	OffsetX    float64 `json:"offset_x"`
+	OffsetY    float64 `json:"offset_y"`
+}
+
+// listMenuDef describes a list menu, colours are names from the palette
+type listMenuDef struct {
+	Layout        listMenuLayout `json:"layout"`
+	DefaultBg     string         `json:"default_bg"`
+	DefaultTxt    string         `json:"default_txt"`
+	DefaultSelBg  string         `json:"default_sel_bg"`
+	DefaultSelTxt string         `json:"default_sel_txt"`
+	Dynamic       bool           `json:"dynamic"` // items are made by the game rather than listed
+	Frame         string         `json:"frame"`   // frame drawn around the items, if any
+	Items         []listItemDef  `json:"items"`
+}
+
+// listItemDef describes an item of a list menu, text is a locale message ID
+type listItemDef struct {
+	Name   string `json:"name"`
+	Text   string `json:"text"`
+	TextX  int    `json:"text_x"`
+	TextY  int    `json:"text_y"`
+	Bg     string `json:"bg"`
+	Txt    string `json:"txt"`
+	SelBg  string `json:"sel_bg"`
+	SelTxt string `json:"sel_txt"`
+}
+
+// imageMenuLayout is where an image menu is drawn and how big its images are
+type imageMenuLayout struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  int     `json:"width"`
+	Height int     `json:"height"`
+}
+
+// imageMenuDef describes an image menu
+type imageMenuDef struct {
+	Layout  imageMenuLayout `json:"layout"`
+	Dynamic bool            `json:"dynamic"` // items are made by the game rather than listed
+	Items   []imageItemDef  `json:"items"`
+}
+
+// imageItemDef describes an item of an image menu, image is an asset ID
+type imageItemDef struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+// menuDefs holds every menu definition once they have been loaded
+var menuDefs *menuFile
+
+// loadMenuFile reads and validates a menu definition file
+func loadMenuFile(fsys fs.FS, path string) (*menuFile, error) {
+	f := &menuFile{}
+	if err := datafile.Decode(fsys, path, f); err != nil {
+		return nil, err
+	}
+	if err := datafile.Invalid(path, f.validate()); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// validate returns a description of every problem with the definitions
+func (f *menuFile) validate() []string {
+	errs := []string{}
+
+	for _, name := range sortedKeys(f.ListMenus) {
+		d := f.ListMenus[name]
+		where := fmt.Sprintf("list menu %q", name)
+		if d == nil {
+			errs = append(errs, where+" is empty")
+			continue
+		}
+
+		if d.Layout.Width <= 0 {
+			errs = append(errs, where+": layout width must be more than 0")
+		}
+		if d.Layout.ItemHeight <= 0 {
+			errs = append(errs, where+": layout item_height must be more than 0")
+		}
+		errs = append(errs, checkColours(where, d.DefaultBg, d.DefaultTxt, d.DefaultSelBg, d.DefaultSelTxt)...)
+		if _, ok := f.Frames[d.Frame]; d.Frame != "" && !ok {
+			errs = append(errs, fmt.Sprintf("%s has unknown frame %q", where, d.Frame))
+		}
+
+		if !d.Dynamic && len(d.Items) == 0 {
+			errs = append(errs, where+" has no items, set dynamic if the game makes them")
+		}
+		if d.Dynamic && len(d.Items) > 0 {
+			errs = append(errs, where+" is dynamic so must not list items")
+		}
+
+		seen := map[string]bool{}
+		for i, item := range d.Items {
+			where := fmt.Sprintf("list menu %q item %d (%q)", name, i+1, item.Name)
+			if item.Name == "" {
+				errs = append(errs, where+" has no name")
+			}
+			if seen[item.Name] {
+				errs = append(errs, where+" has the same name as an earlier item")
+			}
+			seen[item.Name] = true
+			if item.Text == "" {
+				errs = append(errs, where+" has no text")
+			}
+			errs = append(errs, checkColours(where, item.Bg, item.Txt, item.SelBg, item.SelTxt)...)
+		}
+	}
+
+	for _, name := range sortedKeys(f.ImageMenus) {
+		d := f.ImageMenus[name]
+		where := fmt.Sprintf("image menu %q", name)
+		if d == nil {
+			errs = append(errs, where+" is empty")
+			continue
+		}
+
+		if d.Layout.Width <= 0 || d.Layout.Height <= 0 {
+			errs = append(errs, where+": layout width and height must be more than 0")
+		}
+		if !d.Dynamic && len(d.Items) == 0 {
+			errs = append(errs, where+" has no items, set dynamic if the game makes them")
+		}
+		if d.Dynamic && len(d.Items) > 0 {
+			errs = append(errs, where+" is dynamic so must not list items")
+		}
+
+		seen := map[string]bool{}
+		for i, item := range d.Items {
+			where := fmt.Sprintf("image menu %q item %d (%q)", name, i+1, item.Name)
+			if item.Name == "" {
+				errs = append(errs, where+" has no name")
+			}
+			if seen[item.Name] {
+				errs = append(errs, where+" has the same name as an earlier item")
+			}
+			seen[item.Name] = true
+			if !assets.Has(item.Image) {
+				errs = append(errs, fmt.Sprintf("%s has unknown image %q", where, item.Image))
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(f.Frames) {
+		d := f.Frames[name]
+		where := fmt.Sprintf("frame %q", name)
+		if d == nil {
+			errs = append(errs, where+" is empty")
+			continue
+		}
+
+		if !assets.Has(d.Image) {
+			errs = append(errs, fmt.Sprintf("%s has unknown image %q", where, d.Image))
+		}
+		for _, mode := range []string{d.Edges, d.Centre} {
+			if _, ok := sliceModes[mode]; !ok {
+				errs = append(errs, fmt.Sprintf("%s has unknown mode %q, expected stretch or tile", where, mode))
+			}
+		}
+		if d.Padding < 0 {
+			errs = append(errs, where+": padding must not be negative")
+		}
+		errs = append(errs, checkColours(where, d.Fill, d.Text)...)
+	}
+
+	for _, panel := range sortedKeys(f.Panels) {
+		if !slices.Contains(panels, panel) {
+			errs = append(errs, fmt.Sprintf("unknown panel %q, expected one of %s", panel, strings.Join(panels, ", ")))
+		}
+		if _, ok := f.Frames[f.Panels[panel]]; !ok {
+			errs = append(errs, fmt.Sprintf("panel %q has unknown frame %q", panel, f.Panels[panel]))
+		}
+	}
+
+	return errs
+}
+
+// checkColours returns an error for each colour name which is not in the palette
+func checkColours(where string, names ...string) []string {
+	errs := []string{}
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if _, ok := palette[name]; !ok {
+			errs = append(errs, fmt.Sprintf("%s has unknown colour %q", where, name))
+		}
+	}
+	return errs
+}
+
+// colour looks up a palette colour by name, an empty name gives nil so the
+// menu uses its default colour
+func colour(name string) *color.NRGBA {
+	if name == "" {
+		return nil
+	}
+	return palette[name]
+}
+
+// listMenu returns the definition of a list menu, it panics if there is no
+// such menu as that can only be a mistake in the code
+func (f *menuFile) listMenu(name string) *listMenuDef {
+	d, ok := f.ListMenus[name]
+	if !ok {
+		panic(fmt.Sprintf("no list menu named %q in menu definitions", name))
+	}
+	return d
+}
+
+// imageMenu returns the definition of an image menu, it panics if there is no
+// such menu as that can only be a mistake in the code
+func (f *menuFile) imageMenu(name string) *imageMenuDef {
+	d, ok := f.ImageMenus[name]
+	if !ok {
+		panic(fmt.Sprintf("no image menu named %q in menu definitions", name))
+	}
+	return d
+}
+
+// items returns the items of a list menu with their text in the current language
+func (d *listMenuDef) items() []lm.Item {
+	items := []lm.Item{}
+	for _, item := range d.Items {
+		items = append(items, lm.Item{
+			Name:         item.Name,
+			Text:         locale.T(item.Text),
+			TxtX:         item.TextX,
+			TxtY:         item.TextY,
+			BgColour:     colour(item.Bg),
+			TxtColour:    colour(item.Txt),
+			SelBgColour:  colour(item.SelBg),
+			SelTxtColour: colour(item.SelTxt),
+		})
+	}
+	return items
+}
+
+// build makes a list menu, items are taken from the definition unless the
+// menu is dynamic in which case they must be given
+func (d *listMenuDef) build(items ...lm.Item) (lm.ListMenu, error) {
+	if !d.Dynamic {
+		items = d.items()
+	}
+	if len(items) == 0 {
+		return lm.ListMenu{}, errors.New("list menu has no items")
+	}
+
+	return lm.NewMenu(lm.Input{
+		Tx:                  d.Layout.X,
+		Ty:                  d.Layout.Y,
+		Width:               d.Layout.Width,
+		ItemHeight:          d.Layout.ItemHeight,
+		Offx:                d.Layout.OffsetX,
+		Offy:                d.Layout.OffsetY,
+		DefaultBgColour:     colour(d.DefaultBg),
+		DefaultTxtColour:    colour(d.DefaultTxt),
+		DefaultSelBgColour:  colour(d.DefaultSelBg),
+		DefaultSelTxtColour: colour(d.DefaultSelTxt),
+		Items:               items,
+	})
+}
+
+// build makes an image menu, items are taken from the definition unless the
+// menu is dynamic in which case they must be given. Images must be in the
+// sprite atlas
+func (d *imageMenuDef) build(items ...imageItemDef) (imageMenu, error) {
+	if !d.Dynamic {
+		items = d.Items
+	}
+	if len(items) == 0 {
+		return imageMenu{}, errors.New("image menu has no items")
+	}
+
+	m := imageMenu{
+		x: d.Layout.X,
+		y: d.Layout.Y,
+		w: d.Layout.Width,
+		h: d.Layout.Height,
+	}
+	for _, item := range items {
+		img, err := sprites.Image(item.Image)
+		if err != nil {
+			return imageMenu{}, err
+		}
+		m.names = append(m.names, item.Name)
+		m.images = append(m.images, img)
+	}
+	return m, nil
+}
+
+// imageIDs returns every image used by the image menus
+func (f *menuFile) imageIDs() []string {
+	ids := map[string]bool{}
+	for _, d := range f.ImageMenus {
+		for _, item := range d.Items {
+			ids[item.Image] = true
+		}
+	}
+	return sortedKeys(ids)
+}
+
+// frameImageIDs returns the image of every frame
+func (f *menuFile) frameImageIDs() []string {
+	ids := map[string]bool{}
+	for _, d := range f.Frames {
+		ids[d.Image] = true
+	}
+	return sortedKeys(ids)
+}
+
+// sortedKeys returns the keys of a map in order, so errors are reported in the
+// same order every time
+func sortedKeys[V any](m map[string]V) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
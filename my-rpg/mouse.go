@@ -0,0 +1,133 @@
+package main
+
+import (
+	"image"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+	"golang.org/x/image/font"
+)
+
+// arrowWidth is the width of the clickable arrows either side of an image menu
+const arrowWidth = 36
+
+// selector is a menu whose selection moves one item at a time, list menus and
+// image menus are both selectors
+type selector interface {
+	IncrementSelected()
+	DecrementSelected()
+	GetSelectedItem() string
+}
+
+// selectIndex moves the selection of a menu to the item at index i, names are
+// the names of the menu's items in order
+func selectIndex(m selector, names []string, i int) {
+	current := indexOf(names, m.GetSelectedItem())
+	if current != i {
+		soundManager.PlayEffect("nav")
+	}
+	for ; current < i; current++ {
+		m.IncrementSelected()
+	}
+	for ; current > i; current-- {
+		m.DecrementSelected()
+	}
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return 0
+}
+
+// names returns the names of the items listed in a menu definition
+func (d *listMenuDef) names() []string {
+	names := []string{}
+	for _, item := range d.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+// itemRect returns the area of the screen covered by item i of a list menu
+func (d *listMenuDef) itemRect(i int) image.Rectangle {
+	x := int(d.Layout.X + d.Layout.OffsetX*float64(i))
+	y := int(d.Layout.Y + d.Layout.OffsetY*float64(i))
+	return image.Rect(x, y, x+d.Layout.Width, y+d.Layout.ItemHeight)
+}
+
+// pointListMenu selects the item of a list menu under the mouse cursor when
+// the cursor moves, it returns true if the item was clicked
+func pointListMenu(m *lm.ListMenu, d *listMenuDef, names []string) bool {
+	for i := range names {
+		if !input.Hovering(d.itemRect(i)) {
+			continue
+		}
+		if input.CursorMoved() || input.Clicked() {
+			selectIndex(m, names, i)
+		}
+		return input.Clicked()
+	}
+	return false
+}
+
+// imageRect returns the area of the screen covered by an image menu's image
+func (d *imageMenuDef) imageRect() image.Rectangle {
+	x, y := int(d.Layout.X), int(d.Layout.Y)
+	return image.Rect(x, y, x+d.Layout.Width, y+d.Layout.Height)
+}
+
+// leftArrowRect returns the clickable area to the left of an image menu
+func (d *imageMenuDef) leftArrowRect() image.Rectangle {
+	r := d.imageRect()
+	return image.Rect(r.Min.X-arrowWidth, r.Min.Y, r.Min.X, r.Max.Y)
+}
+
+// rightArrowRect returns the clickable area to the right of an image menu
+func (d *imageMenuDef) rightArrowRect() image.Rectangle {
+	r := d.imageRect()
+	return image.Rect(r.Max.X, r.Min.Y, r.Max.X+arrowWidth, r.Max.Y)
+}
+
+// rowRect returns the area covered by an image menu and its arrows
+func (d *imageMenuDef) rowRect() image.Rectangle {
+	return d.leftArrowRect().Union(d.rightArrowRect())
+}
+
+// drawArrows draws the clickable arrows either side of an image menu
+func (d *imageMenuDef) drawArrows(screen *ebiten.Image) {
+	l, r := d.leftArrowRect(), d.rightArrowRect()
+	mid := l.Min.Y + l.Dy()/2
+	drawText(screen, "<", l.Min.X+arrowWidth/2-4, mid-10)
+	drawText(screen, ">", r.Min.X+arrowWidth/2-4, mid-10)
+}
+
+// textButton is text which can be clicked with the mouse
+type textButton struct {
+	text string
+	x, y int // top left of the button
+}
+
+func (b textButton) rect() image.Rectangle {
+	w := font.MeasureString(mplusFont, b.text).Ceil()
+	return image.Rect(b.x, b.y, b.x+w+8, b.y+20)
+}
+
+// clicked returns true on the frame the button is clicked
+func (b textButton) clicked() bool {
+	return input.ClickedIn(b.rect())
+}
+
+// draw draws the button, highlighted when the cursor is over it
+func (b textButton) draw(screen *ebiten.Image) {
+	r := b.rect()
+	if input.Hovering(r) {
+		ebitenutil.DrawRect(screen, float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()), pink)
+	}
+	drawText(screen, b.text, b.x+4, b.y)
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"strings"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+)
+
+// optionsScene is opened over the title screen and closed with escape
+type optionsScene struct{}
+
+func (s *optionsScene) Enter() {}
+
+func (s *optionsScene) Exit() {}
+
+func (s *optionsScene) Update() (scene.Transition, error) {
+
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(&optionsMenu)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(&optionsMenu)
+	}
+
+	def := menuDefs.listMenu("options")
+	clicked := pointListMenu(&optionsMenu, def, def.names())
+
+	if input.JustPressed(input.Confirm) || clicked {
+		playConfirm()
+		switch optionsMenu.GetSelectedItem() {
+		case "screen":
+			return scene.Push(newScreenOptions()), nil
+		case "sound":
+			return scene.Push(newSoundOptions()), nil
+		case "language":
+			return scene.Push(newLanguageOptions()), nil
+		case "gameplay":
+			return scene.Push(newGameplayOptions()), nil
+		case "controls":
+			return scene.Push(&controlsScene{}), nil
+		}
+	}
+
+	if input.JustPressed(input.Back) {
+		playBack()
+		return scene.Pop(), nil
+	}
+
+	return scene.None(), nil
+}
+
+func (s *optionsScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	drawHeading(screen, locale.T("options.heading"))
+	drawListMenu(screen, &optionsMenu, menuDefs.listMenu("options"), 0)
+
+	pads := input.Gamepads()
+	if len(pads) == 0 {
+		drawText(screen, locale.T("options.no_controller"), 8, 256)
+	} else {
+		drawText(screen, locale.T("options.controller", strings.Join(pads, ", ")), 8, 256)
+	}
+}
+
+// setting is a row of a settings submenu, left and right change its value
+type setting struct {
+	label  string          // message ID of the setting's name
+	value  func() string   // returns the current value as text
+	change func(delta int) // changes the value by delta steps
+}
+
+// settingsScene is a submenu of the options screen, changes apply immediately
+// and are written to the settings file
+type settingsScene struct {
+	title    string // locale message ID of the heading
+	settings []setting
+	selected int
+	menu     lm.ListMenu
+}
+
+func newScreenOptions() *settingsScene {
+	return &settingsScene{
+		title: "settings.heading.screen",
+		settings: []setting{
+			{
+				label: "settings.scale",
+				value: func() string { return fmt.Sprintf("%gx", settings.Scale) },
+				change: func(delta int) {
+					settings.Scale = clampFloat(settings.Scale+float64(delta), minScale, maxScale)
+				},
+			},
+			{
+				label: "settings.fullscreen",
+				value: func() string { return onOff(settings.Fullscreen) },
+				change: func(delta int) {
+					settings.Fullscreen = !settings.Fullscreen
+				},
+			},
+		},
+	}
+}
+
+func newSoundOptions() *settingsScene {
+	return &settingsScene{
+		title: "settings.heading.sound",
+		settings: []setting{
+			volumeSetting("settings.master", &settings.MasterVolume),
+			volumeSetting("settings.music", &settings.MusicVolume),
+			volumeSetting("settings.sfx", &settings.SFXVolume),
+		},
+	}
+}
+
+func newLanguageOptions() *settingsScene {
+	return &settingsScene{
+		title: "settings.heading.language",
+		settings: []setting{
+			{
+				label: "settings.language",
+				value: func() string { return locale.Name(settings.Language) },
+				change: func(delta int) {
+					languages := locale.Languages()
+					i := languageIndex(settings.Language) + delta
+					i = (i + len(languages)) % len(languages)
+					settings.Language = languages[i]
+				},
+			},
+		},
+	}
+}
+
+func newGameplayOptions() *settingsScene {
+	return &settingsScene{
+		title: "settings.heading.gameplay",
+		settings: []setting{
+			{
+				label: "settings.movement",
+				value: func() string { return locale.T("settings." + settings.Movement) },
+				change: func(delta int) {
+					if settings.Movement == movementGrid {
+						settings.Movement = movementFree
+					} else {
+						settings.Movement = movementGrid
+					}
+				},
+			},
+		},
+	}
+}
+
+// volumeSetting is a slider for one of the volume settings
+func volumeSetting(label string, volume *int) setting {
+	return setting{
+		label: label,
+		value: func() string {
+			filled := *volume / volumeStep
+			return "[" + strings.Repeat("#", filled) + strings.Repeat("-", 100/volumeStep-filled) + "]"
+		},
+		change: func(delta int) {
+			*volume = clampInt(*volume+delta*volumeStep, 0, 100)
+		},
+	}
+}
+
+func onOff(b bool) string {
+	if b {
+		return locale.T("settings.on")
+	}
+	return locale.T("settings.off")
+}
+
+func (s *settingsScene) Enter() {
+	s.buildMenu()
+}
+
+func (s *settingsScene) Exit() {}
+
+// buildMenu rebuilds the menu so it shows the current value of each setting
+func (s *settingsScene) buildMenu() {
+	items := []lm.Item{}
+	for _, st := range s.settings {
+		items = append(items, lm.Item{
+			Name: st.label,
+			Text: fmt.Sprintf("%-10s %s", locale.T(st.label), st.value()),
+			TxtX: 8,
+			TxtY: 25,
+		})
+	}
+
+	menu, err := menuDefs.listMenu("settings").build(items...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+
+	for i := 0; i < s.selected; i++ {
+		menu.IncrementSelected()
+	}
+	s.menu = menu
+}
+
+// settingMinusRect returns the area at the right of row i of the settings menu
+// which lowers its setting when clicked
+func settingMinusRect(i int) image.Rectangle {
+	r := settingPlusRect(i)
+	return r.Sub(image.Pt(arrowWidth/2, 0))
+}
+
+// settingPlusRect returns the area at the right end of row i of the settings
+// menu which raises its setting when clicked
+func settingPlusRect(i int) image.Rectangle {
+	r := menuDefs.listMenu("settings").itemRect(i)
+	return image.Rect(r.Max.X-arrowWidth/2, r.Min.Y, r.Max.X, r.Max.Y)
+}
+
+// change changes the selected setting then applies and saves the settings
+func (s *settingsScene) change(delta int) {
+	s.settings[s.selected].change(delta)
+	settings.apply()
+	soundManager.PlayEffect("nav") // played after apply so it is heard at the new volume
+	if err := settings.save(); err != nil {
+		log.Printf("unable to save settings: %+v\n", err)
+	}
+	s.buildMenu()
+}
+
+func (s *settingsScene) Update() (scene.Transition, error) {
+
+	if input.JustPressed(input.MenuUp) && s.selected > 0 {
+		s.selected--
+		menuPrev(&s.menu)
+	}
+	if input.JustPressed(input.MenuDown) && s.selected < len(s.settings)-1 {
+		s.selected++
+		menuNext(&s.menu)
+	}
+
+	names := []string{}
+	for _, st := range s.settings {
+		names = append(names, st.label)
+	}
+	clicked := pointListMenu(&s.menu, menuDefs.listMenu("settings"), names)
+	s.selected = indexOf(names, s.menu.GetSelectedItem())
+
+	// clicking the arrows of a row changes it either way, clicking the rest
+	// of it moves it on like confirm
+	minus := clicked && input.ClickedIn(settingMinusRect(s.selected))
+	if input.JustPressed(input.MenuLeft) || minus {
+		s.change(-1)
+	}
+	if input.JustPressed(input.MenuRight) || input.JustPressed(input.Confirm) || (clicked && !minus) {
+		s.change(1)
+	}
+
+	if input.JustPressed(input.Back) {
+		playBack()
+		return scene.Pop(), nil
+	}
+
+	return scene.None(), nil
+}
+
+func (s *settingsScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	drawHeading(screen, locale.T(s.title))
+	drawListMenu(screen, &s.menu, menuDefs.listMenu("settings"), len(s.settings))
+	for i := range s.settings {
+		minus, plus := settingMinusRect(i), settingPlusRect(i)
+		// drawn in the dark purple so they show on the menu's light rows
+		drawSmallText(screen, "<", minus.Min.X+5, minus.Min.Y+11, purple1)
+		drawSmallText(screen, ">", plus.Min.X+5, plus.Min.Y+11, purple1)
+	}
+}
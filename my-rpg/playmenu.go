@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"log"
+	"strconv"
+
+	lm "github.com/Rosalita/ebiten-pkgs/listmenu"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+)
+
+type playMenuMode int
+
+const (
+	choosePlay       playMenuMode = iota // choosing between continue and new game
+	chooseLoad                           // choosing a slot to continue from
+	chooseNew                            // choosing a slot to start a new game in
+	confirmOverwrite                     // asking before a new game replaces a save
+)
+
+// playMenuScene is shown when PLAY is chosen and a save exists, it offers to
+// continue from a save slot or to start a new game in a save slot
+type playMenuScene struct {
+	mode     playMenuMode
+	saves    []saveSlot
+	menu     lm.ListMenu
+	slotMenu lm.ListMenu
+	err      error // error from the last slot chosen, if any
+}
+
+// slotNames names the items of the slot menu
+func (s *playMenuScene) slotNames() []string {
+	names := []string{}
+	for i := range s.saves {
+		names = append(names, strconv.Itoa(i+1))
+	}
+	return names
+}
+
+func (s *playMenuScene) Enter() {
+	s.mode = choosePlay
+	s.saves = listSaves()
+
+	menu, err := menuDefs.listMenu("play").build()
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.menu = menu
+
+	slotItems := []lm.Item{}
+	for i, save := range s.saves {
+		text := locale.T("play.slot_empty", i+1)
+		switch {
+		case save.save != nil:
+			text = locale.T("play.slot_saved", i+1, save.save.Character.Name)
+		case save.err != nil:
+			text = locale.T("play.slot_damaged", i+1)
+		}
+		slotItems = append(slotItems, lm.Item{
+			Name: strconv.Itoa(i + 1),
+			Text: text,
+			TxtX: 8,
+			TxtY: 25,
+		})
+	}
+
+	slotMenu, err := menuDefs.listMenu("slots").build(slotItems...)
+	if err != nil {
+		log.Printf("unable to create menu: %+v\n", err)
+	}
+	s.slotMenu = slotMenu
+}
+
+func (s *playMenuScene) Exit() {}
+
+func (s *playMenuScene) music() string { return "title" }
+
+func (s *playMenuScene) Update() (scene.Transition, error) {
+	if s.mode == confirmOverwrite {
+		return s.updateOverwrite(), nil
+	}
+
+	menu, def, names := &s.menu, menuDefs.listMenu("play"), menuDefs.listMenu("play").names()
+	if s.mode != choosePlay {
+		menu, def, names = &s.slotMenu, menuDefs.listMenu("slots"), s.slotNames()
+	}
+
+	if input.JustPressed(input.Back) {
+		playBack()
+		if s.mode == choosePlay {
+			return scene.Pop(), nil
+		}
+		s.mode = choosePlay
+		s.err = nil
+		return scene.None(), nil
+	}
+
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(menu)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(menu)
+	}
+
+	clicked := pointListMenu(menu, def, names)
+
+	if !input.JustPressed(input.Confirm) && !clicked {
+		return scene.None(), nil
+	}
+	playConfirm()
+
+	switch s.mode {
+	case choosePlay:
+		if menu.GetSelectedItem() == "continue" {
+			s.mode = chooseLoad
+		} else {
+			s.mode = chooseNew
+		}
+
+	case chooseLoad:
+		slot, _ := strconv.Atoi(menu.GetSelectedItem())
+		data, err := readSave(slot)
+		if err != nil {
+			s.err = err
+			return scene.None(), nil
+		}
+		next, err := sceneFromSave(data)
+		if err != nil {
+			s.err = err
+			return scene.None(), nil
+		}
+		return scene.Replace(next), nil
+
+	case chooseNew:
+		slot, _ := strconv.Atoi(menu.GetSelectedItem())
+		if !s.saves[slot-1].empty() {
+			s.mode = confirmOverwrite
+			return scene.None(), nil
+		}
+		return scene.Replace(&charCreationScene{slot: slot}), nil
+	}
+
+	return scene.None(), nil
+}
+
+// updateOverwrite waits for a second confirm before a new game replaces the
+// save in the selected slot, back or choosing another slot changes the
+// player's mind
+func (s *playMenuScene) updateOverwrite() scene.Transition {
+	if input.JustPressed(input.Back) {
+		playBack()
+		s.mode = chooseNew
+		return scene.None()
+	}
+
+	selected := s.slotMenu.GetSelectedItem()
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(&s.slotMenu)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(&s.slotMenu)
+	}
+	clicked := pointListMenu(&s.slotMenu, menuDefs.listMenu("slots"), s.slotNames())
+	if s.slotMenu.GetSelectedItem() != selected {
+		s.mode = chooseNew
+		return scene.None()
+	}
+
+	if input.JustPressed(input.Confirm) || clicked {
+		playConfirm()
+		slot, _ := strconv.Atoi(selected)
+		return scene.Replace(&charCreationScene{slot: slot})
+	}
+	return scene.None()
+}
+
+func (s *playMenuScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	switch s.mode {
+	case choosePlay:
+		drawHeading(screen, locale.T("play.heading"))
+		drawListMenu(screen, &s.menu, menuDefs.listMenu("play"), 0)
+	case chooseLoad:
+		drawHeading(screen, locale.T("play.heading_continue"))
+		drawListMenu(screen, &s.slotMenu, menuDefs.listMenu("slots"), len(s.saves))
+	case chooseNew:
+		drawHeading(screen, locale.T("play.heading_new"))
+		drawListMenu(screen, &s.slotMenu, menuDefs.listMenu("slots"), len(s.saves))
+	case confirmOverwrite:
+		slot, _ := strconv.Atoi(s.slotMenu.GetSelectedItem())
+		drawHeading(screen, locale.T("play.overwrite", slot))
+		drawListMenu(screen, &s.slotMenu, menuDefs.listMenu("slots"), len(s.saves))
+		// the save about to be lost
+		lost := wrapText(locale.T("play.damaged"), smallFont, screenWidth-48)
+		if save := s.saves[slot-1].save; save != nil {
+			lost = save.Character.String()
+		}
+		drawSmallText(screen, lost, 24, 150, white)
+	}
+
+	if s.err != nil {
+		ebitenutil.DebugPrint(screen, fmt.Sprintf("\n\n\n\n\n\n\n\n\n\n\n\n\n\n%v", s.err))
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"image/color"
+	"log"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// layout of the avatar preview, it sits to the right of the avatar menus
+const (
+	previewX          = 246
+	previewY          = 0
+	previewWidth      = 150
+	previewFrame      = "ui/frame_round_150"
+	previewFrameSize  = 156 // height of the frame image
+	portraitSize      = 100 // the portrait is drawn behind the opening of the frame
+	previewFadeFrames = 20
+)
+
+// avatarPreview shows the full size image of the highlighted avatar in a frame
+// with its name and description below, it fades in whenever the highlighted
+// avatar changes
+type avatarPreview struct {
+	avatar Avatar
+	fade   int // frames since the avatar changed
+}
+
+// update shows the avatar with the given ID, it must be called every frame
+// for the fade to progress
+func (p *avatarPreview) update(id string) {
+	if id != p.avatar.ID {
+		a, ok := avatars.Get(id)
+		if !ok {
+			log.Printf("unknown avatar %q\n", id)
+		}
+		p.avatar = a
+		p.fade = 0
+	}
+	if p.fade < previewFadeFrames {
+		p.fade++
+	}
+}
+
+func (p *avatarPreview) draw(screen *ebiten.Image) {
+	if p.avatar.ID == "" {
+		return
+	}
+	alpha := float64(p.fade) / previewFadeFrames
+
+	portrait, err := assets.Image(p.avatar.FullSize())
+	if err != nil {
+		log.Printf("unable to draw avatar preview: %+v\n", err)
+		return
+	}
+	w, h := portrait.Size()
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Scale(float64(portraitSize)/float64(w), float64(portraitSize)/float64(h))
+	opts.GeoM.Translate(previewX+(previewWidth-portraitSize)/2, previewY+(previewFrameSize-portraitSize)/2)
+	opts.ColorM.Scale(1, 1, 1, alpha)
+	opts.Filter = ebiten.FilterLinear
+	screen.DrawImage(portrait, opts)
+
+	frame, err := sprites.Image(previewFrame)
+	if err != nil {
+		log.Printf("unable to draw avatar preview: %+v\n", err)
+		return
+	}
+	opts = &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(previewX, previewY)
+	screen.DrawImage(frame, opts)
+
+	clr := color.NRGBA{0xff, 0xff, 0xff, uint8(0xff * alpha)}
+	y := previewY + previewFrameSize + 2
+	drawSmallText(screen, wrapText(p.avatar.DisplayName(), smallFont, previewWidth), previewX, y, clr)
+	drawSmallText(screen, wrapText(p.avatar.DisplayDescription(), smallFont, previewWidth), previewX, y+18, clr)
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Rosalita/my-ebiten-examples/scene"
+)
+
+// saveVersion is the schema version written to new save files, bump it and add
+// a migration whenever the layout of SaveData changes
+const saveVersion = 4
+
+// saveSlots is the number of save files the player can choose between
+const saveSlots = 3
+
+// World is the state of the game world that is saved alongside the character
+type World struct {
+	Flags map[string]bool `json:"flags"`
+	Gold  int             `json:"gold"`
+
+	// where the player is, an empty map means the player has not moved yet
+	// and starts at the player_start object of the start map
+	Map string  `json:"map,omitempty"`
+	X   float64 `json:"x"` // middle of the player in pixels
+	Y   float64 `json:"y"`
+}
+
+// SaveData is the contents of a save file
+type SaveData struct {
+	Version   int       `json:"version"`
+	Slot      int       `json:"slot"`
+	SavedAt   time.Time `json:"saved_at"`
+	Scene     string    `json:"scene"` // name of the scene the game was saved in
+	Character Character `json:"character"`
+	World     World     `json:"world"`
+}
+
+// migration upgrades a raw save file from one version to the next
+type migration func(raw map[string]json.RawMessage) error
+
+// migrations maps a save version to the function which upgrades it to the
+// following version
+var migrations = map[int]migration{
+	1: migrateAvatarIDs,
+	2: migrateHP,
+	3: migrateInventory,
+}
+
+// migrateAvatarIDs changes avatar IDs from menu item names such as "f3" to
+// catalogue IDs such as "f_03"
+func migrateAvatarIDs(raw map[string]json.RawMessage) error {
+	character := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw["character"], &character); err != nil {
+		return err
+	}
+
+	var id string
+	if err := json.Unmarshal(character["avatar_id"], &id); err != nil {
+		return err
+	}
+	var group string
+	var n int
+	if _, err := fmt.Sscanf(id, "%1s%d", &group, &n); err != nil {
+		return fmt.Errorf("unknown avatar %q", id)
+	}
+
+	var err error
+	if character["avatar_id"], err = json.Marshal(fmt.Sprintf("%s_%02d", group, n)); err != nil {
+		return err
+	}
+	raw["character"], err = json.Marshal(character)
+	return err
+}
+
+// migrateHP gives characters saved before they had hit points full health
+func migrateHP(raw map[string]json.RawMessage) error {
+	var c Character
+	if err := json.Unmarshal(raw["character"], &c); err != nil {
+		return err
+	}
+	c.HP = c.MaxHP()
+
+	var err error
+	raw["character"], err = json.Marshal(c)
+	return err
+}
+
+// migrateInventory gives characters saved before they had an inventory the
+// starting items. Earlier migrations write the character back with an empty
+// inventory, so an empty one is treated the same as a missing one
+func migrateInventory(raw map[string]json.RawMessage) error {
+	var c Character
+	if err := json.Unmarshal(raw["character"], &c); err != nil {
+		return err
+	}
+	if len(c.Inventory.Slots) > 0 || len(c.Inventory.Equipped) > 0 {
+		return nil
+	}
+	c.giveStartingItems()
+
+	var err error
+	raw["character"], err = json.Marshal(c)
+	return err
+}
+
+// saveDir returns the directory save files are kept in
+func saveDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "my-rpg", "saves"), nil
+}
+
+// savePath returns the path of the save file for a slot
+func savePath(slot int) (string, error) {
+	dir, err := saveDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("slot%d.json", slot)), nil
+}
+
+// writeSave writes save data to its slot
+func writeSave(data SaveData) error {
+	if data.Slot < 1 || data.Slot > saveSlots {
+		return fmt.Errorf("save slot %d out of range 1 to %d", data.Slot, saveSlots)
+	}
+
+	data.Version = saveVersion
+	data.SavedAt = time.Now()
+
+	b, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	path, err := savePath(data.Slot)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, b)
+}
+
+// readSave reads the save data in a slot, upgrading it to the current version
+// if it was written by an older version of the game. If the slot is empty the
+// error returned satisfies os.IsNotExist
+func readSave(slot int) (SaveData, error) {
+	path, err := savePath(slot)
+	if err != nil {
+		return SaveData{}, err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return SaveData{}, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return SaveData{}, fmt.Errorf("save slot %d is corrupt: %v", slot, err)
+	}
+
+	var version int
+	if err := json.Unmarshal(raw["version"], &version); err != nil {
+		return SaveData{}, fmt.Errorf("save slot %d has no version: %v", slot, err)
+	}
+	if version > saveVersion {
+		return SaveData{}, fmt.Errorf("save slot %d is version %d, newer than supported version %d", slot, version, saveVersion)
+	}
+
+	for ; version < saveVersion; version++ {
+		migrate, ok := migrations[version]
+		if !ok {
+			return SaveData{}, fmt.Errorf("save slot %d: no migration from version %d", slot, version)
+		}
+		if err := migrate(raw); err != nil {
+			return SaveData{}, fmt.Errorf("save slot %d: migrating from version %d: %v", slot, version, err)
+		}
+		raw["version"], _ = json.Marshal(version + 1)
+	}
+
+	if b, err = json.Marshal(raw); err != nil {
+		return SaveData{}, err
+	}
+
+	var data SaveData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return SaveData{}, fmt.Errorf("save slot %d is corrupt: %v", slot, err)
+	}
+	data.Slot = slot
+	return data, nil
+}
+
+// saveSlot is what a save slot holds, a slot with a save which can't be read,
+// such as a corrupt one or one from a newer version, is damaged rather than
+// empty so it is never replaced without asking
+type saveSlot struct {
+	save *SaveData // nil if the slot is empty or damaged
+	err  error     // why the save can't be read if the slot is damaged
+}
+
+// empty reports whether the slot has no save file at all
+func (s saveSlot) empty() bool {
+	return s.save == nil && s.err == nil
+}
+
+// listSaves reads every save slot, slot n is at index n-1
+func listSaves() []saveSlot {
+	slots := make([]saveSlot, saveSlots)
+	for i := range slots {
+		data, err := readSave(i + 1)
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			slots[i].err = err
+		default:
+			slots[i].save = &data
+		}
+	}
+	return slots
+}
+
+// hasSave returns true if any slot holds a save, even one which can't be read
+func hasSave() bool {
+	for _, s := range listSaves() {
+		if !s.empty() {
+			return true
+		}
+	}
+	return false
+}
+
+// sceneFromSave builds the scene a save was made in
+func sceneFromSave(data SaveData) (scene.Scene, error) {
+	switch data.Scene {
+	case worldSceneName:
+		return &worldScene{slot: data.Slot, player: data.Character, world: data.World}, nil
+	}
+	return nil, fmt.Errorf("save slot %d has unknown scene %q", data.Slot, data.Scene)
+}
+
+// writeFileAtomic writes to a temporary file in the same directory then renames
+// it over path, so a crash part way through never leaves a half written file
+func writeFileAtomic(path string, b []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // does nothing once the rename has happened
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
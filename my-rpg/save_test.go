@@ -0,0 +1,152 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// useTempSaves points the save directory at a new temporary directory
+func useTempSaves(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir) // linux
+	t.Setenv("HOME", dir)            // macOS
+	t.Setenv("AppData", dir)         // windows
+}
+
+// writeRawSave writes a save file to a slot as it is, without changing its
+// version
+func writeRawSave(t *testing.T, slot int, body string) {
+	path, err := savePath(slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// useItems loads the item catalogue, which migrations need to give out the
+// starting items
+func useItems(t *testing.T) {
+	c, err := loadItemFile(dataFiles(), "items.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items = c
+}
+
+func TestReadSaveMigrates(t *testing.T) {
+	useItems(t)
+	tests := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "version 1",
+			body: `{"version": 1, "slot": 1, "scene": "world", "character": {
+				"avatar_id": "f3", "group": "human", "name": "Ann",
+				"stats": {"strength": 3, "agility": 3, "intellect": 3, "vitality": 5}}}`,
+		},
+		{
+			name: "version 2",
+			body: `{"version": 2, "slot": 1, "scene": "world", "character": {
+				"avatar_id": "f_03", "group": "human", "name": "Ann",
+				"stats": {"strength": 3, "agility": 3, "intellect": 3, "vitality": 5}}}`,
+		},
+		{
+			name: "version 3",
+			body: `{"version": 3, "slot": 1, "scene": "world", "character": {
+				"avatar_id": "f_03", "group": "human", "name": "Ann", "hp": 14,
+				"stats": {"strength": 3, "agility": 3, "intellect": 3, "vitality": 5}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTempSaves(t)
+			writeRawSave(t, 2, tt.body)
+
+			data, err := readSave(2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data.Version != saveVersion {
+				t.Errorf("version is %d, want %d", data.Version, saveVersion)
+			}
+			if data.Slot != 2 {
+				t.Errorf("slot is %d, want 2", data.Slot)
+			}
+			c := data.Character
+			if c.AvatarID != "f_03" {
+				t.Errorf("avatar_id is %q, want %q", c.AvatarID, "f_03")
+			}
+			if want := baseHP + hpPerVitality*5; c.HP != want {
+				t.Errorf("hp is %d, want %d", c.HP, want)
+			}
+			inv := c.inventory()
+			for _, item := range startingItems {
+				if n := inv.Count(item.ID); n != item.Count {
+					t.Errorf("has %d of starting item %q, want %d", n, item.ID, item.Count)
+				}
+			}
+		})
+	}
+}
+
+func TestReadSaveKeepsInventory(t *testing.T) {
+	useItems(t)
+	useTempSaves(t)
+	writeRawSave(t, 1, `{"version": 3, "slot": 1, "scene": "world", "character": {
+		"avatar_id": "f_03", "group": "human", "name": "Ann", "hp": 14,
+		"stats": {"strength": 3, "agility": 3, "intellect": 3, "vitality": 5},
+		"inventory": {"slots": [{"id": "bread", "count": 1}]}}}`)
+
+	data, err := readSave(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inv := data.Character.inventory()
+	if inv.Count("bread") != 1 || inv.Count("potion") != 0 {
+		t.Errorf("inventory is %+v, want the one bread it was saved with", inv.Slots)
+	}
+}
+
+func TestReadSaveRefusesNewerVersions(t *testing.T) {
+	useTempSaves(t)
+	writeRawSave(t, 1, `{"version": 999, "character": {}}`)
+	if _, err := readSave(1); err == nil {
+		t.Error("a save from a newer version was read")
+	}
+}
+
+func TestReadSaveEmptySlot(t *testing.T) {
+	useTempSaves(t)
+	if _, err := readSave(3); !os.IsNotExist(err) {
+		t.Errorf("reading an empty slot returned %v, want a not exist error", err)
+	}
+}
+
+func TestListSavesMarksUnreadableSlotsDamaged(t *testing.T) {
+	useTempSaves(t)
+	writeRawSave(t, 1, `{"version": 999, "character": {}}`)
+	writeRawSave(t, 2, `not json`)
+
+	slots := listSaves()
+	for i, s := range slots[:2] {
+		if s.empty() || s.err == nil || s.save != nil {
+			t.Errorf("slot %d is %+v, want damaged", i+1, s)
+		}
+	}
+	for i, s := range slots[2:] {
+		if !s.empty() {
+			t.Errorf("slot %d is %+v, want empty", i+3, s)
+		}
+	}
+	if !hasSave() {
+		t.Error("hasSave is false with two damaged slots")
+	}
+}
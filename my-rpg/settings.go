@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/hajimehoshi/ebiten"
+)
+
+// Settings are the player's options, they are kept in a settings file in the
+// user config directory and loaded on startup
+type Settings struct {
+	Scale        float64 `json:"scale"` // window scale, from minScale to maxScale
+	Fullscreen   bool    `json:"fullscreen"`
+	MasterVolume int     `json:"master_volume"` // percentage, from 0 to 100
+	MusicVolume  int     `json:"music_volume"`  // percentage, from 0 to 100
+	SFXVolume    int     `json:"sfx_volume"`    // percentage, from 0 to 100
+	Language     string  `json:"language"`      // language code, see locale.Languages
+	Movement     string  `json:"movement"`      // how the player moves, either movementGrid or movementFree
+
+	// Bindings maps action names to the names of the inputs bound to them,
+	// actions missing from the map keep their default bindings
+	Bindings map[string][]string `json:"bindings,omitempty"`
+}
+
+// movement settings, grid steps a tile at a time and free moves a pixel at a time
+const (
+	movementGrid = "grid"
+	movementFree = "free"
+)
+
+const (
+	minScale   = 1
+	maxScale   = 4
+	volumeStep = 10
+)
+
+var settings = defaultSettings()
+
+func defaultSettings() Settings {
+	return Settings{
+		Scale:        2,
+		MasterVolume: 100,
+		MusicVolume:  70,
+		SFXVolume:    70,
+		Language:     "en",
+		Movement:     movementGrid,
+	}
+}
+
+// settingsPath returns the path of the settings file
+func settingsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "my-rpg", "settings.json"), nil
+}
+
+// loadSettings reads the settings file, any setting missing from the file keeps
+// its default value. If there is no settings file the defaults are returned
+func loadSettings() (Settings, error) {
+	s := defaultSettings()
+
+	path, err := settingsPath()
+	if err != nil {
+		return s, err
+	}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return defaultSettings(), err
+	}
+	s.clamp()
+	return s, nil
+}
+
+// save writes the settings file
+func (s Settings) save() error {
+	path, err := settingsPath()
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, b)
+}
+
+// apply makes the settings take effect
+func (s Settings) apply() {
+	ebiten.SetScreenScale(s.Scale)
+	ebiten.SetFullscreen(s.Fullscreen)
+	s.applyVolume()
+	if err := locale.SetLanguage(s.Language); err != nil {
+		log.Printf("unable to set language: %+v\n", err)
+	}
+}
+
+// applyVolume passes the volume settings, which are percentages, to the
+// sound manager
+func (s Settings) applyVolume() {
+	soundManager.SetVolume(
+		float64(s.MasterVolume)/100,
+		float64(s.MusicVolume)/100,
+		float64(s.SFXVolume)/100,
+	)
+}
+
+// clamp keeps every setting within its allowed range
+func (s *Settings) clamp() {
+	s.Scale = clampFloat(s.Scale, minScale, maxScale)
+	s.MasterVolume = clampInt(s.MasterVolume, 0, 100)
+	s.MusicVolume = clampInt(s.MusicVolume, 0, 100)
+	s.SFXVolume = clampInt(s.SFXVolume, 0, 100)
+	if languageIndex(s.Language) < 0 {
+		s.Language = locale.Fallback
+	}
+	if s.Movement != movementGrid && s.Movement != movementFree {
+		s.Movement = movementGrid
+	}
+}
+
+// languageIndex returns the index of a language code in locale.Languages, or
+// -1 if there is no locale file for it
+func languageIndex(code string) int {
+	for i, l := range locale.Languages() {
+		if l == code {
+			return i
+		}
+	}
+	return -1
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func clampFloat(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+)
+
+// titleScene is the first screen shown, it leads to every other scene
+type titleScene struct{}
+
+func (s *titleScene) Enter() {}
+
+func (s *titleScene) Exit() {}
+
+func (s *titleScene) music() string { return "title" }
+
+func (s *titleScene) Update() (scene.Transition, error) {
+
+	if input.JustPressed(input.MenuUp) {
+		menuPrev(&mainMenu)
+	}
+	if input.JustPressed(input.MenuDown) {
+		menuNext(&mainMenu)
+	}
+
+	def := menuDefs.listMenu("main")
+	clicked := pointListMenu(&mainMenu, def, def.names())
+
+	if input.JustPressed(input.Confirm) || clicked {
+		playConfirm()
+		switch mainMenu.GetSelectedItem() {
+		case "playButton":
+			if hasSave() {
+				return scene.Push(&playMenuScene{}), nil
+			}
+			return scene.Push(&charCreationScene{slot: 1}), nil
+		case "optionButton":
+			return scene.Push(&optionsScene{}), nil
+		case "quitButton":
+			return scene.Quit(), nil
+		}
+	}
+
+	return scene.None(), nil
+}
+
+func (s *titleScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	drawHeading(screen, locale.T("title.heading"))
+	drawListMenu(screen, &mainMenu, menuDefs.listMenu("main"), 0)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(200, 24)
+	screen.DrawImage(mainImage, opts)
+}
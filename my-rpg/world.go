@@ -0,0 +1,371 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"math"
+	"sort"
+
+	"github.com/Rosalita/my-ebiten-examples/camera"
+	"github.com/Rosalita/my-ebiten-examples/dialogue"
+	"github.com/Rosalita/my-ebiten-examples/entity"
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/Rosalita/my-ebiten-examples/tilemap"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+)
+
+const worldSceneName = "world"
+
+const (
+	playerSpeed = 128 // pixels per second
+	hitboxSize  = 20  // width and height of an actor's hitbox, smaller than a tile so free movement fits through gaps
+)
+
+// how the camera follows the hero, see camera.Camera
+const (
+	cameraDeadzone  = 24 // screen pixels
+	cameraSmoothing = 8
+	maxZoom         = 2
+	zoomStep        = 0.25
+)
+
+// actor is an entity drawn with an avatar's image
+type actor struct {
+	*entity.Entity
+	image    *ebiten.Image
+	dialogue string // name of what the actor says when talked to, if anything
+}
+
+// newActor makes a solid actor drawn with the thumbnail of an avatar
+func newActor(name, avatarID string) (actor, error) {
+	a, ok := avatars.Get(avatarID)
+	if !ok {
+		return actor{}, fmt.Errorf("%s has unknown avatar %q", name, avatarID)
+	}
+	img, err := sprites.Image(a.Thumbnail())
+	if err != nil {
+		return actor{}, err
+	}
+	return actor{
+		Entity: &entity.Entity{Name: name, W: hitboxSize, H: hitboxSize, Speed: playerSpeed, Solid: true},
+		image:  img,
+	}, nil
+}
+
+// worldScene is where the game is played once a character has been created,
+// the game is saved whenever the scene is left
+type worldScene struct {
+	slot   int
+	player Character
+	world  World
+
+	level  *tilemap.Map
+	space  *entity.Space
+	hero   actor   // the player's character
+	actors []actor // everything drawn on the map, including the hero
+	cam    *camera.Camera
+	status hud
+	fight  string // avatar ID of an enemy a conversation started combat with
+	err    error  // error loading the map, if any
+}
+
+func (s *worldScene) Enter() {
+	if s.world.Flags == nil {
+		s.world.Flags = map[string]bool{}
+	}
+	if s.err = s.loadLevel(); s.err != nil {
+		log.Printf("unable to load map: %+v\n", s.err)
+	}
+	s.status.reset(s.player.HP, s.player.MaxHP(), s.world.Gold)
+}
+
+func (s *worldScene) hud() *hud {
+	return &s.status
+}
+
+// loadLevel loads the map the player is on and places the actors on it
+func (s *worldScene) loadLevel() error {
+	name := s.world.Map
+	if name == "" {
+		name = startMap
+	}
+
+	level, err := loadMap(name)
+	if err != nil {
+		return err
+	}
+
+	s.space = &entity.Space{Tiles: level, TileWidth: level.TileWidth, TileHeight: level.TileHeight}
+	if settings.Movement == movementFree {
+		s.space.Mode = entity.FreeMode
+	}
+
+	hero, err := newActor("player", s.player.AvatarID)
+	if err != nil {
+		return err
+	}
+	if s.world.Map == "" {
+		start, ok := level.Object("player_start")
+		if !ok {
+			return fmt.Errorf("map %q has no player_start object", name)
+		}
+		s.space.PlaceOnTile(hero.Entity, int(start.X)/level.TileWidth, int(start.Y)/level.TileHeight)
+	} else {
+		hero.SetCentre(s.world.X, s.world.Y)
+		if s.space.Mode == entity.GridMode {
+			// a game saved in free movement may be between tiles
+			tx, ty := s.space.Tile(hero.Entity)
+			s.space.PlaceOnTile(hero.Entity, tx, ty)
+		}
+	}
+	s.space.Add(hero.Entity)
+	s.actors = []actor{hero}
+
+	for _, o := range level.ObjectsOfType("npc") {
+		a, err := newActor(o.Name, o.Properties.String("avatar"))
+		if err != nil {
+			return fmt.Errorf("map %q: %v", name, err)
+		}
+		a.dialogue = o.Properties.String("dialogue")
+		if _, ok := dialogues[a.dialogue]; a.dialogue != "" && !ok {
+			return fmt.Errorf("map %q: %s has unknown dialogue %q", name, a.Name, a.dialogue)
+		}
+		s.space.PlaceOnTile(a.Entity, int(o.X)/level.TileWidth, int(o.Y)/level.TileHeight)
+		s.space.Add(a.Entity)
+		s.actors = append(s.actors, a)
+	}
+
+	w, h := level.PixelSize()
+	s.cam = camera.New(screenWidth, screenHeight)
+	s.cam.Bounds = image.Rect(0, 0, w, h)
+	s.cam.DeadzoneX, s.cam.DeadzoneY = cameraDeadzone, cameraDeadzone
+	s.cam.Smoothing = cameraSmoothing
+	s.cam.MaxZoom = maxZoom
+	s.cam.LookAt(hero.Centre())
+
+	s.level, s.hero, s.world.Map = level, hero, name
+	return nil
+}
+
+func (s *worldScene) Exit() {
+	if err := s.save(); err != nil {
+		log.Printf("unable to save game: %+v\n", err)
+	}
+}
+
+// music plays the music named by the map's music property
+func (s *worldScene) music() string {
+	if s.level != nil {
+		if m := s.level.Properties.String("music"); m != "" {
+			return m
+		}
+	}
+	return "world"
+}
+
+// save writes the scene to its save slot
+func (s *worldScene) save() error {
+	if s.hero.Entity != nil {
+		s.world.X, s.world.Y = s.hero.Centre()
+	}
+	return writeSave(SaveData{
+		Slot:      s.slot,
+		Scene:     worldSceneName,
+		Character: s.player,
+		World:     s.world,
+	})
+}
+
+func (s *worldScene) Update() (scene.Transition, error) {
+	if input.JustPressed(input.Back) {
+		playBack()
+		return scene.Pop(), nil
+	}
+	if s.level == nil {
+		return scene.None(), nil
+	}
+
+	if s.fight != "" {
+		// combat starts once the conversation which started it is over
+		enemy := s.fight
+		s.fight = ""
+		fight, err := newCombatScene(&s.player, &s.world, enemy)
+		if err != nil {
+			log.Printf("unable to start combat: %+v\n", err)
+			return scene.None(), nil
+		}
+		return scene.Push(fight), nil
+	}
+
+	if input.JustPressed(input.Confirm) {
+		if a, ok := s.actorInFront(); ok && a.dialogue != "" {
+			playConfirm()
+			talk := &treeConversation{run: dialogues[a.dialogue].Run(s)}
+			return scene.Push(newDialogueScene(talk)), nil
+		}
+	}
+
+	if input.JustPressed(actionInventory) {
+		playConfirm()
+		return scene.Push(newInventoryScene(&s.player)), nil
+	}
+
+	dx, dy := 0, 0
+	if input.Pressed(input.MenuUp) {
+		dy--
+	}
+	if input.Pressed(input.MenuDown) {
+		dy++
+	}
+	if input.Pressed(input.MenuLeft) {
+		dx--
+	}
+	if input.Pressed(input.MenuRight) {
+		dx++
+	}
+
+	// Update is called a fixed number of times a second, ebiten calls it
+	// more than once a frame when drawing falls behind, so moving by the
+	// length of a tick keeps the speed the same whatever the frame rate
+	dt := 1 / float64(ebiten.MaxTPS())
+	s.space.Move(s.hero.Entity, dx, dy, dt)
+	s.status.update(s.player.HP, s.player.MaxHP(), s.world.Gold, dt)
+
+	if scroll := input.Scrolled(); scroll != 0 {
+		s.cam.SetZoom(s.cam.Zoom() + math.Copysign(zoomStep, scroll))
+	}
+	x, y := s.hero.Centre()
+	s.cam.Follow(x, y, dt)
+
+	return scene.None(), nil
+}
+
+func (s *worldScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	if s.err != nil {
+		ebitenutil.DebugPrint(screen, fmt.Sprintf("World - slot %d\n\n%v", s.slot, s.err))
+		return
+	}
+
+	s.level.Draw(screen, s.cam)
+	s.drawActors(screen)
+
+	// describe what is under the mouse cursor
+	tx, ty := s.tileAtCursor()
+	s.outlineTile(screen, tx, ty)
+	under := ""
+	if a, ok := s.actorAtCursor(); ok {
+		under = " " + a.Name
+	}
+	// printed below the hearts of the HUD
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("World - slot %d\n%s\nTile %d,%d walkable:%v zone:%q door:%q%s",
+		s.slot, s.player, tx, ty, s.level.Walkable(tx, ty), s.level.Encounter(tx, ty), s.level.Door(tx, ty), under), 0, 44)
+}
+
+// Flag reports whether a world flag is set, conversations check flags
+func (s *worldScene) Flag(name string) bool {
+	return s.world.Flags[name]
+}
+
+// Apply makes the effect of a conversation happen
+func (s *worldScene) Apply(e dialogue.Effect) {
+	switch {
+	case e.Set != "":
+		s.world.Flags[e.Set] = true
+	case e.Clear != "":
+		delete(s.world.Flags, e.Clear)
+	case e.Gold != 0:
+		s.world.Gold = max(s.world.Gold+e.Gold, 0)
+	case e.Combat != "":
+		s.fight = e.Combat
+	}
+}
+
+// actorInFront returns the actor on the tile the hero is facing
+func (s *worldScene) actorInFront() (actor, bool) {
+	tx, ty := s.space.Tile(s.hero.Entity)
+	switch s.hero.Facing {
+	case entity.Up:
+		ty--
+	case entity.Down:
+		ty++
+	case entity.Left:
+		tx--
+	case entity.Right:
+		tx++
+	}
+	for _, a := range s.actors {
+		if ax, ay := s.space.Tile(a.Entity); ax == tx && ay == ty {
+			return a, true
+		}
+	}
+	return actor{}, false
+}
+
+// cursorInWorld returns the point in the world under the mouse cursor
+func (s *worldScene) cursorInWorld() (x, y float64) {
+	c := input.Cursor()
+	return s.cam.ScreenToWorld(float64(c.X), float64(c.Y))
+}
+
+// tileAtCursor returns the tile under the mouse cursor
+func (s *worldScene) tileAtCursor() (tx, ty int) {
+	x, y := s.cursorInWorld()
+	return int(math.Floor(x / float64(s.level.TileWidth))), int(math.Floor(y / float64(s.level.TileHeight)))
+}
+
+// actorAtCursor returns the actor drawn under the mouse cursor, actors are
+// drawn a tile in size around their hitbox
+func (s *worldScene) actorAtCursor() (actor, bool) {
+	x, y := s.cursorInWorld()
+	tw, th := float64(s.level.TileWidth), float64(s.level.TileHeight)
+	for _, a := range s.actors {
+		ax, ay := a.Centre()
+		if math.Abs(x-ax) < tw/2 && math.Abs(y-ay) < th/2 {
+			return a, true
+		}
+	}
+	return actor{}, false
+}
+
+// outlineTile draws a box around a tile
+func (s *worldScene) outlineTile(screen *ebiten.Image, tx, ty int) {
+	if !s.level.InBounds(tx, ty) {
+		return
+	}
+	tw, th := float64(s.level.TileWidth), float64(s.level.TileHeight)
+	x0, y0 := s.cam.WorldToScreen(float64(tx)*tw, float64(ty)*th)
+	x1, y1 := s.cam.WorldToScreen(float64(tx+1)*tw, float64(ty+1)*th)
+	clr := color.NRGBA{0xff, 0xff, 0xff, 0x80}
+	ebitenutil.DrawLine(screen, x0, y0, x1, y0, clr)
+	ebitenutil.DrawLine(screen, x1, y0, x1, y1, clr)
+	ebitenutil.DrawLine(screen, x1, y1, x0, y1, clr)
+	ebitenutil.DrawLine(screen, x0, y1, x0, y0, clr)
+}
+
+// drawActors draws each actor's image a tile in size centred on its hitbox,
+// actors lower down the screen are drawn last so they overlap those behind
+func (s *worldScene) drawActors(screen *ebiten.Image) {
+	sorted := append([]actor{}, s.actors...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Y < sorted[j].Y
+	})
+
+	tw, th := float64(s.level.TileWidth), float64(s.level.TileHeight)
+	for _, a := range sorted {
+		w, h := a.image.Size()
+		x, y := a.Centre()
+
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(tw/float64(w), th/float64(h))
+		op.GeoM.Translate(math.Round(x-tw/2), math.Round(y-th/2))
+		op.GeoM.Concat(s.cam.GeoM())
+		op.Filter = ebiten.FilterLinear
+		screen.DrawImage(a.image, op)
+	}
+}
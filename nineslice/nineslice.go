@@ -0,0 +1,141 @@
+// Package nineslice draws an image as a panel of any size. The image is cut
+// into nine pieces by the widths of its border, the corners are drawn as they
+// are, the edges are stretched or tiled along their length and the centre fills
+// the space between them, so a frame keeps the same border whatever size it is
+// drawn.
+package nineslice
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// Insets are the widths of an image's border in pixels
+type Insets struct {
+	Left, Top, Right, Bottom int
+}
+
+// Mode is how the edges and centre fill the space between the corners
+type Mode int
+
+const (
+	Stretch Mode = iota // scaled to fit
+	Tile                // repeated at their own size, the last copy cut short
+)
+
+// Slice is an image cut into nine pieces
+type Slice struct {
+	image  *ebiten.Image
+	insets Insets
+	Edges  Mode
+	Centre Mode
+}
+
+// New cuts an image into nine pieces, the insets must leave at least a pixel
+// between opposite edges. The image may be a sub-image, such as one from an
+// atlas
+func New(img *ebiten.Image, insets Insets, edges, centre Mode) (*Slice, error) {
+	size := img.Bounds().Size()
+	if insets.Left < 0 || insets.Top < 0 || insets.Right < 0 || insets.Bottom < 0 {
+		return nil, fmt.Errorf("insets %+v must not be negative", insets)
+	}
+	if insets.Left+insets.Right >= size.X || insets.Top+insets.Bottom >= size.Y {
+		return nil, fmt.Errorf("insets %+v leave nothing between the edges of a %dx%d image", insets, size.X, size.Y)
+	}
+	return &Slice{image: img, insets: insets, Edges: edges, Centre: centre}, nil
+}
+
+// Insets returns the widths of the border the slice was cut with
+func (s *Slice) Insets() Insets {
+	return s.insets
+}
+
+// Draw draws the slice w x h with its top left at x, y. A panel smaller than
+// its corners has its corners scaled down to fit. opts may be nil, otherwise
+// its GeoM is applied once the panel is placed and its ColorM and Filter are
+// used for every piece
+func (s *Slice) Draw(dst *ebiten.Image, x, y, w, h float64, opts *ebiten.DrawImageOptions) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	b := s.image.Bounds()
+	in := s.insets
+
+	// the edges of the nine pieces in the image and on the panel
+	srcX := [4]int{b.Min.X, b.Min.X + in.Left, b.Max.X - in.Right, b.Max.X}
+	srcY := [4]int{b.Min.Y, b.Min.Y + in.Top, b.Max.Y - in.Bottom, b.Max.Y}
+	l, r := fit(float64(in.Left), float64(in.Right), w)
+	t, bt := fit(float64(in.Top), float64(in.Bottom), h)
+	dstX := [4]float64{x, x + l, x + w - r, x + w}
+	dstY := [4]float64{y, y + t, y + h - bt, y + h}
+
+	for j := 0; j < 3; j++ {
+		for i := 0; i < 3; i++ {
+			src := image.Rect(srcX[i], srcY[j], srcX[i+1], srcY[j+1])
+			// corners are never tiled, edges are tiled along their length
+			// and the centre both ways
+			mode := s.Edges
+			if i == 1 && j == 1 {
+				mode = s.Centre
+			}
+			tileX := mode == Tile && i == 1
+			tileY := mode == Tile && j == 1
+			s.drawPiece(dst, src, dstX[i], dstY[j], dstX[i+1], dstY[j+1], tileX, tileY, opts)
+		}
+	}
+}
+
+// fit returns the widths of two opposite borders on a panel, they are scaled
+// down when they don't both fit
+func fit(a, b, size float64) (float64, float64) {
+	if a+b <= size {
+		return a, b
+	}
+	k := size / (a + b)
+	return a * k, b * k
+}
+
+// drawPiece fills x0, y0 to x1, y1 with the src part of the image, stretching
+// it or tiling it in each direction
+func (s *Slice) drawPiece(dst *ebiten.Image, src image.Rectangle, x0, y0, x1, y1 float64, tileX, tileY bool, opts *ebiten.DrawImageOptions) {
+	if src.Empty() || x1 <= x0 || y1 <= y0 {
+		return
+	}
+	sw, sh := float64(src.Dx()), float64(src.Dy())
+	stepX, stepY := x1-x0, y1-y0
+	if tileX {
+		stepX = sw
+	}
+	if tileY {
+		stepY = sh
+	}
+
+	for ty := y0; ty < y1; ty += stepY {
+		ph := math.Min(stepY, y1-ty)
+		for tx := x0; tx < x1; tx += stepX {
+			pw := math.Min(stepX, x1-tx)
+
+			// the last tile is cut short rather than squashed
+			part := src
+			if tileX {
+				part.Max.X = part.Min.X + int(math.Ceil(pw))
+			}
+			if tileY {
+				part.Max.Y = part.Min.Y + int(math.Ceil(ph))
+			}
+
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(pw/float64(part.Dx()), ph/float64(part.Dy()))
+			op.GeoM.Translate(tx, ty)
+			if opts != nil {
+				op.GeoM.Concat(opts.GeoM)
+				op.ColorM = opts.ColorM
+				op.Filter = opts.Filter
+			}
+			dst.DrawImage(s.image.SubImage(part).(*ebiten.Image), op)
+		}
+	}
+}
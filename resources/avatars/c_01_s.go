@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_01_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1a#/\x83r\x1e\x00\x00 \x00IDATx\xda\xec\xbdy|]\xf5y\xe7\xff>\xfb\xb9\xfb\xaa]\x96,[\x96\xf7\x05\x036f5\x06\x13 \x90\xb0\x05B \x10\xb27\x99\xa6M\xa6M\x97\xe9L\xdbL3Ӧm\x9a\xfe\xd2i\x93i\x9b\xa6d\x9f\x06\x02a\xc7ؐ\xb0\x05c\xe3\xdd\xf2\"ɖ\xb5]\xe9\xea^\xdd}9\xeb\xef\x8fs-,ll\x936\xd3e\xf2}\xbd\xf4\x12XGG\xe7|\x9f\xef\xb3}\x9e\xcf\xf3\\\xf8\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xe5\xfa\xf7\xb0z\xe6u\xffr\x13\xfeo\xae\x1bn\xb8q\xce\xff7\xc7\xe2\xa1\xf6\xa6\x96\xc4[\xaf\xbb\xf5\xd6;\xfe]\xbc\x8f\xf0\xefQ\b\x1f\xf8\xc0=|\xf7\xbb\xdf\x01\xa0=٢,]\xbd\xf2\xaa\xe9ɩ{\x05A\\o\xdb\xf6\x12Ǳ\x91e9\xed8Α`8\xbc\xc5\xef\xf3?26zb\xff\xa1\xa3Gl\x80\x0f?\xf0a\xbe\xf1\x0f\xdf\xf8\xa5@\xde\xc9\xfa\xe8G>\xc6\xdf\xfd\xfd\xdf\xce~\xf7\x04q/\xdf\xfd\xee\xb7g\xafY\xb5b\xd5]\x8a\xa2\xfc\xba\xee\x0f\\\x92hn\xc6\x1f\f\xe2\xba.\xa5b\x01\xb3VǴLj\x95*F\xbd\x86e\x9a_\xfe\x8d\xdf\xf9\xfco\u007f\xf0\x03\xf7\x98\xbfԐw\xb0\xee\xbd\xe7\x1e\xbe\xfd\x9d\xef\x9c\xf6\xef7\xddx\x13\x8f?\xf98\x00\x1b\xaf\xdcx\xc1\xf8\xe8\xe8\xef4\xb5\xb5\xdd1o\xc1B!\x1c\x8d\x82 \x80\xebz/%\b\xa7\xbc\x9eK\xb5R\xe1\x95\xe7\xb6\x18F\xbd\xfe\x9a \bŖ\xf6\xf6\x1dW^\xb3\xf9\xab\u007f\xf6'_\x9c\xbe彷\xf1ȣ\x0f\xff\x9by\u007f\xe9ߒ0\xdew\xfb\xfb\xf8\xfe\x0f\xbe\xcfW\xff\xd7W\x83\xf9l\xee\xb7}\xaa\xef\xa9\xf9\xdd\xf37,]\xbcx\xeb3\xcf=[\xbe\xe1\xfa\x1b竢\xf4h\xad^\xff\xc2\xf2\v֮\xecZ\xd8+h\xba\xee\xfdrC\x18\xde\u007f\xbas\xbe\x1cۦ^\xadJ\x1f\xf9O\x9f\xed\xbel\xe3\xa6EC\x03G\xae|\xf5'/|\xf8\xe2u\x97d\x9fx\xea\xf1]\x00\xef\xbe\xe1&\x8e\x0e\x1c\xf9\xa5\x86\xdcv\xeb\xed<\xfc\xa3\x87\x00x\xf7\xf5\uf3a4\xd3S\xeful\xe7+KV\xae\x8a]s\xc3M\xbc\xf0\xecS\xec}c\xc7\xd7$Y\xae\xd4j\xb5\xcf\x05B!V^t1\xa2(\xe2\x9e\"\x84\xb7}AQd:\x95b\xf9\xf2U\xac\xba\xf0\"l\xcbB\x92eN\x1c\x1b\xe4\xf9g\x9e\xa6\x90\xcb\r\x83\xf3{\xb9\\\xee\x99=\xfb\xf6L\v\x82\xe0\x02\xdct\xd3\xcd<\xfe\xf8c\xffo\t\xe4\xde{\xee\xe5\xdb\xdf\xf1|\xc2\xda\xd5\x17\xdcP\xadT>\xb7h\xe9\xf2k\xaf\xd8t-\xf3{\x17a[\x16\x8em\xf3\xf0\xf7\xbeM\xa5V%\x9aHP-\x97ijo\xc7u\x9c\xf3\xfa\x1b\xae\xeb220\xc0\xc7>\xf39L\xc3x\xd34H\x12\xb2\xacp\xe2\xf8\x10\xfbw\xefbld\xb8\u007f\xf0\xc8\xe1\xe7\xa2\xd1菶\xef|\xfdy\x80k7m\xe6\xb9m[\xfe\xdf0Y\x9b\xaf\xd9\xcc#\x8f>\xc2\x1f~\xe1\v\xc1\xa9\xf1\xd4C\xe1H\xf4\xb7n\xbd\xfb\x9eŗ^y5\x89\xa6&l\xdb\x06@\x14E\xba\x17\xf4r\xe8\xc0>\xa2\xf18\x85\xdc\f\xa1p\xe4\xfcN\x9b(25>\xce\x05\x17\xaf\xa7\xa5\xb5\r\xe7\x14!\xba\xae\x8bm[\x84\xc2azz\x17\xb1\xb0oIӒ\x15+\xd7\xcfd2\xef5k\xb5\xdbW,_Y\u007f\xe1\xa7\xcf\xef\x01\x98\xd7\xde\xc5\x1f|\xf1\x8fx橧\xfeck\xc8u\xd7^\xb7y2\x95zv\xfd\xe5Wq\xd5\xe6w!\x8a\xe2\x19\xafS\x14\x85\x9f<\xf7,\x93\xe9I\x8cz\x8dP$\x8a\xee\xf3\x9d\xf3\xfe\xa2(qh\xdf\x1e~\xed7\xff\v\xf5z\xed\xbc\x9eI\x14ER\x13\x13<\xfa\x83\xefP)\x95\x06\"\xb1د\xf5\xf4\xce\u007f\xfak\u007f\xfd7\x0e\xc0\x03\x0f|\x84\u007f\xf8\x87\xbf\xff\x8f%\x90k6^뛙\xc9\xfc^(\x1c\xf9ݛ\xdf\xf7~\x16\xf6-\xa6V\xad\x9e\xf5w\x14U寿\xfc%b\xc9\x04\x9a\xee#\x10\n\x9d\xfd\xc5\x04\x81\xfc\xcc\f\xadͭ\\\xb6qӬƝ\xafP\x14U\xe1\xd8\xc0\x00۞z\x82b!\xffR\xb9T\xfc\x83\x1d\xbbvn\x15\x04\x81U\xcbWrզk\xf8\xeaW\xbf\xf2\x1f\xc3dEC\xa1%>\xbf\xff\x1f\xef\xfd\xd8'\xa5\xd6\xf6\x8e9\xb6\xfd\xcc~\xc0\x01A\xc06M\xb2\xd9,\xb5j\x85\xe09̖ \bLM\x8cs\xf1\x86\xcb\xf0\a\x02\xef\xe8\xf9\\\xd7Ŷl\"\xd1\x18\xab.\xbc\x88dSsW\xa1P\xb8\xf3\xf7\xff\xcb﮸x\xdd%\a^~\xf5\xe5\xe9\xed\xdb\u007f\xf6\v\xd9\x1b\xf1_C -\xed\xed\xc3\xc5Ba\xebȉ\xe3\xd4k5dY>%w8\xd3\xe6\x8a8\xb6͢%\xcb\x10\x05\xa8V*g\xbd\xfe\xe4\xf2\xfb\x03\x04\x83\xa1\x9f\xfb9\x1d\xc7\xc1u]\xe6\xf7.\xe2\x8e{\xef\xd7\x1f\xf8\xf4\xaf\xbd?5>\xf1\xe2\xc6+7^\x03\xf0\xa1\xfb\x1f\xf8\xf7\xa5!w\xbd\xef.\x0e\x1c<\x00\xc0w\xbe\xff}q\xfc\xc4H0\x1c\b\x05K\xa5\xe2RQ\x92?\xa3\a\x03\xa1\xe1\x13\xc7\xc9f3\xf8\xfc~\x14EE\x12%\x04A\x98\xf3\xe58\x0e\xb6m#\x88\x02\x99t\x9a\xdc\xcc\f\xe5R\x89h\"\U0007686f\xe38(\xb2\u0082\x85\x8b\x90\x15\xe5\x9c\xdad\xdb6\xf5z\r\xd30\xa8\x1bu\xea\xf5:\x86\xe1}\t\x82w(\x12\xc9&\x96\xaf\xbe\xc0\u007f`\xef\xae\xfb\x96.Y\xfa\xf8\x0f\x1f\xfa\xa7\x89[o\xb9\x8dC\x87\xfa\xffm\xfb\x90\xf7\xdd~\a\xff\xf4\xd0\x0fO\xaa\xbf\xbcv\xf5\x05\xef2\rscSk\xeb*]\xd7\x13\x96e\xf5\x1a\xa6\x19i\xeb\xee&\x1c\x894\"\x1e\x9bD\"I2ل\xaaiH\xa2\x84\xed8\x98\x86A\xa5Rff&K\xadV\x05\x04Rcc\x8c\x1e\x1bb\xc5ڵ\xe8~\x1f\xb6\xed\x80\x00\x02\x02\x82(b\xd4\xebtt\xcc\x03\xdbf\xf9\xaa5H\x8a\xe2%\x89\xa7DY\xa2(\xce\x06\x11SS)\xf6\xed\xdd\xcddj\xc2\xd3\n\xc7\xc1q]\xc0\x05\x04\x02\x81\x00\xb1x\x82`0D,\x9e@t\xe1\a\x0f\xfe}\xee\xd7>\xff[\x9d\x0f\xdc\xfb\x81\xf2\xbf\x1b\xa7~\xf5ƍ\xef:60\xf8\x85\x05\x8b\x16/\xbbj\U000fb089\xa6f\x14YA\x10\x05L\xc3`\xf0\xe8\x11\xfa\x0f\xec\xc5v]Z\xdb;\xf0\xf9\xfd\xb3\x8f%\b\r\x93\x01d\xa6&)\xe4\nȒH0\xe0CW%\x14Ǥl\xda\xc4\x13I|\xe1\x18u\v,\xdbdt\xe0\bAMB\xd2ÄcI\xe6/\\\x88(\x8a\x84\xa3qZZ[\xb1m\v\xd7q\x98\x9a\x9adjr\x82\xa9\xc9I2\x994\xf5z\xfdm\xcd\xe0Ɍ\x1f\\\x04A\xa4\xa9\xa5\x95\xf4\xc8(\x13'\x8e\u007f\xee\xf5\xddo\xfc\xc5=w\u007f\x80\xef|\xef\xbb\xff\xb6\x04rӍ7\xf3\xf8\x93^f{\xe5\xe5WJ\xa6i\xfc\u007f\xd5J\xedS7\xdd~'\xab\xd6^\xf8\xb6Q\x8e(\x8aLNLp`\xcf.\xfa\x0f\xee\xc3u\\\xa2\x898\xba\xcfG0\x1cA\x94$&\x8e\x0f\xb1n\xd5\"\xba\xda\x12\b\xb8\xe0\xbaX\xb6M\xdd0y\xed\x8d~V\xaeY\x8d\xaa\xc8\xec~c7m\xcdQ¡\x00\xae\xebR(\x96\xa8Tj Hdsy\x8aU\x87\x8e\x9eE\x14\x8a\x05\xc6S\x13\x84\xc2\xe1\x9fs\xd7\x04\x8cj\x95\xa1\x03\av\x1d\x1a<\xbaN\x10\x04\xeb߬\x86\xf4-X\xb8\xa4\xb9\xb5\xfd[\xf3\x17\xf6^t\xc3-\xb7\xa3\xaa*\x96e\x9d\xd5~\xd7jU\x0e\x1d:H>\x97#3\x95®\x14I\xc6C\x94K\x15\xca\xd5:\x17\xad쥫\xab\rQ\x90ppq,\v۲\x10\\\x97Z\xdd\xe0\x95\xd7\xf7b\xd9\x16\xad\xcdIZ\x9b\x13\xb3\t`8\x14$\x14\nz\x00c\xadN\xa5\\\xf6\x84d\xc0\xf6}\x83\x84\x13\x89\xb9~Ĳ\xa8\xd7븶=\xc77\x89\x92\x84\xa2\xaa(\xaa:\xfb\xef\x82 ph\xf7\ue0a6iw\xecٷg˿\x19\xa7~\xcf\a\xeea\u07fe}\x00\xac\\\xb6\xe2\xbf.Z\xb2\xfc\x1f\xae\xb8溞+\xae\xd9\f\xae;';>\xed\x8fK\x12\x95J\x85=\xbbwR(\x16\x18\x1e\x1c\xa4%\xa8pم\x8b\xe9\xeehfq\xef|V.[@<\x1eC\x94$DQj\xd8}\x01\xc7\x01Ǳ\x91%\x89\x05=\xf3\xe8hk\xc6\xefӱ\x1b\x9b\x19\x8d\x84I$b蚎\xdf\xef'\x14\n\x12\n\x85P5\x95|.G\xbeTA\xd6=M\x12\x04\x01\xa3V#\x9f\xcd\"J\x12\x92,#\xc92\xa2(\"\x88^\x84W)\x95\xb0L\x13_ 0+\x14\xd7q\xb4\xe2̌s\xdf\a?\xf4cױݱ\xf1\xb1\u007f]\x81\xdcy\x87\x87\xce\x0e\x8d\x8f\xaa?ݲm\xeb\x05\x17_\xf2\xc0\r\xb7ܦ\xb5\xb4\xb5\x9f5\x11\x13\x04\x01EQ\x98\x9aJ\xb1\xeb\x8dש\xd7k\x14\xf3y4\xb7Ε\x17/G\x12e4\xbf\x1fQ\x96\x11\\\x01\x17\x10\x05\xef\xbb,ɴ\xb6\xb5\xd2\xdeюiZT\xaa\x15\x04QDSU\x14E\xa1Z\xab!+2\xdd]\x9d\x84\x82A4]\xc3\xe7\xf7\x11\b\x06\xf0\a\xfc\xe8\xaaB\xa1Pbdd\x9c\xa9t\x16IQ\x10%\x89\\&C$\x91@\xf7\xf9\x90\x15\x05Y\x96\xbd\uf282\xaai\xe8~?\x86a035E \x18\xf4\xdeAU\xc9e\xb3k\xc6\xc6F\x86v\xbc\xb1s\xcf%\xeb70:6\xfa\xafc\xb2\xee\xfb\xe0}<\xf8\xad\a\xb9\xff\xbe\xfb\x9aw\xbf\xb1{Ϧ\x1bnj\xbd\xfc\xeak0\r\x03\xd7qq\\\xfb\xad\xa8\xf8l4U*\x168q\xe28\x99\xe9t\xe3\xe4\x8b\f\x1e9\xc2\x1d\u05ecE\x92D4݇\xac\xa8\x88\x92\x88(\x00\xa2\x80(H4\xb74\xd3\xd1\xde\x0e\x80\xed8\x14\xf2\x05\xa6ӓTJ%\x00t]\xe3\xd0\xe1AZZ\x924%\x13H\x92D\xbc\xa9\x85P(\x04\x82\b\xb8\x18\xf5\x1a\xa9\xb1Q\xa6\xa73\f\f\x9d\xe0\xc9\x17\xf7`\xd4\xebD\x93\xc9S\x82\x8a\xb7?H\xf5Z\x8d\x99t\x9adk+\xb2\xaaR\xcc\xe5\x18\x1d\x1a\"\x1c\x0e_\xf9\xfa\xce\xd7_\x04\xd8|\xeduly\xee\xd9\u007f\x1d\x1f\xb2\xa8g\xe1\xd7\xee\xba\xefß\xb8på\x94K%ff\xb2\x14\v\x05J\xe5\"\xb5j\x15˶\xc0\x05۶\xb1l\x13˴\xb0m\x1b\xa9!\bA\x10p\\\x97\xec\xc81\xae\xbb|\x15\xba/\x80\xac(\x04\x82~\x16.\xea#\x96lE\x92\x15\xecz\x19\xc761\x8c:\x8em#\x88\x12\xb9|\x81\xd7^{\x83HP\xa3\xab\xb3\r˲8><BgG;\xa2(\x10\x89ŉ\xc6\x13\x8d\xc4\xd3\xcb%\x04A\xa4R.P\xc8\xe5(\x97\x8a\xec=8ď\x9ey\x91J\xa5F\xb2\xb5\x15\xdd\xe7;+\xac/\b\x02\x96i\x92\x9d\x9a\"\x10\x0e\x13\x8aD\xc8g\xb3L\f\x0f\x1b\xb2\xa2\xbcg\xef\xfe\xbd\xcf\xfc\xab\x99\xac_\xf9\x95OE\xd3S\xe9_\u007f\xf7m\xef\xeb*\x97J\xec\xdd\xf3\x06\xe3c#\xa4&\xc69rp?\xa3\xc3\xc7IO\xa4\x98\x9a\x98\xa0Z)!\x88\x12>\xbf\x1f\xb9a\x9f%I\xa2\x98ϓM\xa7Y\xd4\x11\xa7\xb5\xb5\tYQ\x11D\x81\xa5˖ҷj=\xd1D+\xe1h\x13\x82kS\xc8Mc\x9c\x12\x9e\x06\xfc>::Zy\xe2\xe9\x17\x98\xd7ނ\xa2\xc8Ȣ\x84\xa2HH\x92D0\x1cƧ\xfb\x11E\x11U\xf3\x93l_@8\xd6J\xbdV\xc60j\x98u\x83\xa6D\x84x$\xc4\xfe\x83G\x9881B4\x1e?g\")\x8a\"\xfe`\x90J\xb1\x88cۄ\xa2Q|\xc1\xa0\x94\xcbd6w\xb6w<\x9f\x9aLM\xdcp\xfd\r\f\f\f\xfc\xdf\x15\x88\x8c\xd8\xd2\xd2\xde\xfe\xd1Ξ\x9e\xa6\x1d;^\xc34M\xb2\xd3i\xa6S\x13\\\xb1i3\xab/\xb8\x88\x9eE}\xf4,\xeaC\x94d\xa6S)\x86\a\a\xc8\xcf\xccP.\x15\x19\x1b>\xce\xf0ѣ\xac[\xd9Ò\xben\x14Y\x05Q \x9e\x88\xd3\xda\xdcD8َ(\x8a\x1c\xda\xff\x06\xdf\xf8ۿç\x82c۸\xae\x80\xe3:\rs'\xb0\xb4\xaf\x87?\xfd˿\xe7\x8aK\xd7b\xdb6\xb6\xe3\xa2(\n>\x9f\x1f\xcd\xe7C\x10\x04|\xc1\x18\x91D\x1b\x92$39:H\xbdZ\xa1^\xaf\xe1\xba.-\xc9\x18m-q\x0e\x1f\x1bebd\x94H<\x8e$\xcb\xe74_\x8a\xaaz\xbeO\xd7Q5\r\u007f0\x18\xccg2w\xaeZ\xb5\xfa\a\xcfny6\xb7\xfe\xe2\xf5\xbcSG\xff\xcf2Y\xcb\xfa\x96\xac\xeb[\xb6\xfc\x99Xk[T\x96%\n\xb9\x19\xfc\xbe\x007\xdf\xf6>,\xd3\xc4q]\xc4\xc6i\x96$\tA\x10\xb1\x1d\x9bj\xa5Lnf\x86d\"\xce\xf7\xbe\xfeen\xbc\xeert\xcds\xe2\xa2(\xb0pQ/\x1d\x1d\x1d\x14\v\x05\x9e\xd9\xf2\x02\x0f?\xf2\x14\v\xe6wR*U\x11%\x91U+\x96q\xe9%\x17\xd0ܔ\x04\x01L\xd3\u0e6d/R,U\xb9l\xfd*DIB\x96D\"\xb1\x04\xd1h\x1cQ\xf22\xf2\\\xb1ƶm?\xe1ɧ\xb7\x12\t\a\x11\x05\x81\x1b7_N[k\x13\xa2\x00Ͽ\xb2\x93Ǟ~\x91H<A\xeb\xbcy\xe7\xacH\n\x82@\xb9X\xa4^\xad\x12onF\x10\x04r\x99\f\x93##H\x92t\xd3\a\u007f\xfdcO~\xfe\xe3\xbf\xe6^\xbd\xe9Z\x9e\xdf\xf6\xdc/^C:\xdaڗh\xfe\xc0Ǣ\x89\x84`Y\x16\x8a(\xb1\xf9\x86\x9b\x1a\xa7\xd8K\xe0Nf\xb9\xb6mc\xdb\x16\x8e\xe3 \xcb2\xa1H\x84bn\x9acC\x834'\xa2\x04\x82\x9ei\x89\xc5\xe3\xf4\xf5-btt\x8c\xbf\xf9\xfa\x83\x1c<x\x98e\x8b\x17\xd0\xdb\xd3I[K\x02Y\x12\x99\x9aJ\xf3\xf2\xcfv\x93\x8c\x87)\x16\x8b\b\xaeK8\x14`\xff\xc1\x01\xfa\x16v#7\x84/\xcb\x12\xba\u07fb\xefK/og\xe7\xce=d\xb23\xdcx\xfd&.X\xb5\fIp9t\xf48\xe5J\x95\x8e\xb6\x16*\xa5\"\xc1\x80\x8f\xc3\x03\xc3D\x12\x89\xb7\xadϜ\xba4]Ǩը\x94J\x04B!t\x9f\x0f_0H\xa9P\xb8k\xfb\xf3/'.Z\u007f\xc9\xcb[\xb7<c\\{\xedf\x86\x86\x86~\xb1\x02\x89\x84\xc2K\x93\xad\xad\xf7D\xe3qR#'\xd8|\xfdM\xe7\x8cTf\xed\xb0 \x90NO\xe2\xb8\x0e\xf9\xf4\x14\xedm\xcd8\x0e,\\\xb4\x80\xfe\xfe\x01\xfe\xf1\xc1\x1f\xd0\xd6\x1c\xa3\xb7\xa7\x93D,L\xff\xc00\x93S3\x04|\x1a\xe9L\x0e\x9f\xaer\xec\xf8(\xbb\xf7\xf6\xd35\xaf\r]Ә\xc9\x17\xb9h\xddZ\xfc\xc1 ә\f\xa9\x89\x14~]\xe5\xb9m/\xe2\xb8.\xef\xba\xeej֬Z\xc6\xe2E\vP$\x97\xb6\x96\x04\x89D\x14Ӱ\x18\x18\x1cF\x14\xc0\xafk\x1c;1\x8e\x16\b\xa2\x9c×\x9c\x8c\x1cu\xbf\x9fz\xadF\xa9P\xc0\x17\xf0\x82\x92P4*V\xcb\xe5\xf5ө\xd4\xcd\xef\xb9\xfd\xb6\xff\xf3\xf0\x0f\u007fX\xbd\xec\xd2\xcb\x19\x199\xf1\x8b\x11ȣ\x8f<\xcc\vϿpI\xbc\xa5\xe5VA\x14\x91\x04\x99u\x97^~֬|nR(\x93JMp\xf4\xd0!\">\x99\x96d\x1cA\x10\x90$\x99'\x9e\xdcBOW+\x01\xbf\x8ea\x98l{q'\xe3\xe3SLLNs|4Ŋ%\v\x91$\x91\x89\xc9i\xfc~\x9d\xed;\xf6ӷ\xa8\x1bˁu\x97\xac#\x9ah\xe2董\f\x1c\x1d \x93\xcd1<2\xc1捗 J\x12\xa5R\x91g\x9e\xdeʖm/\U000c31de\xe1ĉq\xa2\x91\x00ǎ\x8f\x12\t\x05\x11D\x18\x1a\x9e\xa0P\xad\x10\x8d\xc5\xcf{?t\x9f\x0f\xd30p,\vEU\x11%\x89P,F1\x9fo\x1e\x1e\x1c\xfaԊ\x15+\x1f~\xf1\xa5\x9ff~a\x1aҔL\nS\x93S\xd7F\x13MץS\x13\\x\xc9\x06\x9a[ZΚ\x99\xbf5K\u07ffw7\xe3\xa3#\xf4\xf5\xb4\x13\x0e\x05\xb0-\x9bc\xc7N`\x1au¡\x00\xc7N\x8c\xb3\xed\xa57\xe8\xf0\a\xf8\xfcM\xd7\xf3\xfe\r\xeb96\x99楽\xfd\x18u\x03$\x17U\x95Y\xb9t!;w\xf7s\xed5W\xd0\xdcڊ(\x89\xf4\xef\xdfO\xbdVe\xff\xc1A.Y\xbf\x8aW\xb7\xef\xe2G\x8f<\xcd\xdf\xfc\xdd\xf70\xd3y\xa2H\xe8\x92\xc4\xf6CG9|d\x98H$\x80(\xb9\x84\x02A\xc6ƧȖ\x8aȲ\x8c\xdf\x1f8/v\v\x80$˔\x1bZ\x82  I\x12\xb1\xa6&*\xa5\x92\x9aM\xa7\xefX\xb0\xb0w\xdb\xf8\xf8X\xea\xfa\xebod`\xe0\xe8\xdb\x04J?\xe7:rdP\x94Um\x99\xa2\xaa\x18\xb5:\xa1p\x18\xc79\xbf\a\x17\x04\x91B!ωcC\xe0:\xcc\xebhA\xc0Ck\x8b\xc5\"\x89x\x94#\x83#\xec\xd8}\x88\xa5-\xcd|\xe3\x13\x0f\xe0\xd75@\xe0C\x97_\xca\x1b\x83\xc7\x11$(\x14J\xb8؈\xa2\xc0\xf5\xd7\\\x8a߯c\xdb\x0e\xb2\x02\x92(\xe1\xd8\x0e\xf3\xbb\xdax\xe9\x95ט\xca\xe4ٳ\u007f\x88\xf7\xae]×\xef\xbd\vIQ\xa8\xd5j|u\xcb\xf3\xfc\xafg\xb718<J\xb1V\xf4\xd8(\x92\x88\x80\xc0L.\x8b\xa6\xeb\xf8}\xe7'\x14EU\xf1\x05\x02L\xa7R\xb4tv\u03a2\x15\xf3z{\x99\x18\x1en\xcde2?\xbc\xe5\x96\xdb.y䑇ӛ\xae\xd9̶\xad[\xfe\xe5*\x86\x93\xe3\xe3\xa2mY\x8bUM\xc3q\x1db\xb18\x8es~uk\xcb29\xb0w\x0f\xa3\xc3\xc3\\q\xc9\x1a4U\xc1u\x1d\xaa\x95\x1a\x8a*\xb3c\xcfAJ\x95\x12\xa5R\x99+\xfa\x16\xe1\xf7\xfb\xc0v\xc0q\xe8\x88Gi\x8dEA\x04\xdbq\x11E\x11\xc7uI$\xe3\xa439\x14E\xc6q\x1c\xfc\xc1\x00\x8e뢫\nպ\x01\xb8(\xb2\xcc\x17\xef\xbc\rI\x10\xc0\xb2\xd0e\x99_\xdd|5\xc9H\x10\xdbr\xa8WMr\x85\">\x9f\x86mZ\xb8\xaeK&3}\xdeZ\xef\xba.\xfeP\bEU\x99\x99\x9eFh\x04\x05\x82 \xd0\xd6\xddM(\x12Yp\xe8\xc0\x81W]\xd7\x15\xb6m\xdd\xc2UW_\xf3/#\x90\x8f}\xfcS\xb8\xae+\xaa\x9a\xb6Z\xd35p9/\x16\xc8ɇKO\xa5\xd8\xf1\xda+\xac\xdfp!\v\xda\xe3\b\x82\x84\xa2\xa8X\xb6\xcd\xf1\xe11&3i\xa6\xb2\x19\x14U\xf6\x02sA\xa4\x91j#\t\">]%\x9d\xce\x11\n\xe9X\xa6C\xc0\xef'\x93ɑL\xc4A\x10\xf1\x05\xe3D\xa2q\xaf %\x8aX\xb6E\xb5V'\xa4\xab`\x19\u07bdN\x9a\x19Q\xc4/\xc9\x04B:\x95j\x9d\xbaax\xf5\x98\xc6\xcf\r\xa3N\xa9\\zG\xf5\xf8hS\x13\xa6aP\xca\xe5\xe6\xbcww_\x1f\xbe@`\xe1\xd2\xc5K_\xba\xe8\xe2\xf5\xf1\x9f<\xbf\x95뮿\xf1\x9f/\x90\xbf\xfd\xdf\u007f\xcd¾\xbe6\xdbq\x82\x8a\xaa\xa1\xf9t\xc4\xf3Hi\xbcR\xa9\xc5\xce\x1d\xdb\xd1U\x99U\v\xda@\x94f\x9d\xbc\xeb:8\xae\xb7\x1d\xb2,\xa1\xaa2\xcf\xf7\x1f\xe6\xb5\xfe\xc3L\xcc\xe4\x98\xc8\xce\xf0\xcc\xfe\x03\x1cO\xa7\t\x06u\xaaU\x83D4J\u05fc\x0e2\xd9<\xa1P\x10U\xf7\xe3\x0f\xc6蜿\bA\x14\x10\\\x17\\\x90$\x91\\\xb5\xc6\xf7\xb6n\x05U\x01I\x02U\xa1T\xaf3\x91+\xa0\xc8\x12\xb1X\x10\xb3\x11\x94\xf84\xdflM\xbdPȝ\xb7\x1f\x01/y\x9d׳\x00Q\x14\xc9NNb\x1a\x86\x87\x1a;\x0e\xf3\x17/&\x96L^Z\xca\xe7\xffi\xdd\xfa\r\xc1g\x9f~r\x8e\xa6\xfc\xdc>D״N\xd7u\x91$\x0f\xa6\x98\xc9fH6\xb7\x9c\xe5\xc1\x05DQdǎ\x9d\x1c?z\x84\xae\xa60\xba\xa6x\xa5R\x17\x92\xc9\x04\xe3\xe3\xe3ȲL\xc0\xef'_,\xd3\xd2\x1aa\xa2\x9c\xe7?}\xeb\xbb$\x83!\xca\xd5\x1a5,BQ\x1fժA$⧧\xbb\x83h$\xcc\xd8D\x9ar\xb1@\xbdZ#ޤ\xd1\xd6\xd9MK['\xe9\xc9\t\xe6wv\xb0\xe7\xd0\x11\x12\xd1 \u007f\xf1\xe2\x0e\x9cP\x94K\xfb\xfa\x18LM\xf2\xf5\xe7\u007f\x8a \v\xf8|\n\xa2( \"\xe0\nBCG<h\xbeZ\xadP7\xea\xe8\x9a~~\b\x86,\x13\f\x85\b\x04\x83\x14\xf29F\x8e\x1d\xc3\xe7\xf7\x13\x89\xc7q\x1c\x87\xe6\x8e\x0e$I\xda4\x9dJ=\xfbԾ\x03Wܰr\xb9}\xd9eW\xf0\xf2\xcb/\xfe\xfc>$;\x9d>\x00P\xc8\xe5\xc8Mghz\v3\xf0t\xbfa\xb0s\xe7k\x1c\u07b7\x97J.\xcb\xda5Kf3h\xd7uhko\xc3\xe7\xf3\xe1\xd8\x0e>]\xc34M\\\xd7%\x14\xf6\x91h\vC\bԘ\x82\xa4\x8a\x94\xcbu\x9a\x9a\xc2\xf8}\x1a\xf3\xbb;\x99\xc9\x150\f\x13\xb3Va\xec\xd8\x10?ٶ\x05Q\x14\xf0\xf9|\x88\xa2HsS\x13\xbd]\xf3PU\x89XS\x88?\u007fj\v7}\xe9+\xfcڃ\xdfc,\x97\xa3wAk\x03\xe8\x84\\\xbe\x8c\xa6\xabԪU\\\xe7\xcdb\xd4\xccL\xf6\xbc\x12E\xd7u\tG\xa2\xb3\xbf\x17\x89\xc6X\xb6j\r\x82 \x90I\xa5f\x0flS{;\xc9\xd6\xd6\r\x9f\xbd\xed\xb6\xfd\x9b\xaeٜ|\xf9\xe5\x17\u007f~\x93\xf5\xf1\x8f|\x84\xe3\xc3#\xf9z\xb5\xfaW/?\xb7\x85\xf7\xdcq\xd7\x19\xb9\xb6\xa2(a\xdb6\xd3\xd3S\xbc\xfaʋ\xf4\xef\u074bQ\xad\xb2j\xe52p]l\xc7\x01\\\u0091\b\xd1x\fݧ\x13\xf0\xfb\xd0\x14\x8d\xf6\xf6\x04ՊI\xadfb\x1a\x16\xe5\xb2A.W\xf1^\xa6)\x8c\x00,_\xdcGks\v\xaf\xed\xdc˺\vW`\x98\x16_\xfc\xe3\xbf\xe0\x8e\xdb?\xc0\x8e\x9f\xfd\x84\x81\xe3\xc3|럞ba\xdfb\x12\xb1\x18\xaa\xa2b;\x0e\v\x164\xd3\xd7\xd7\xc6\xc2\xde\x16:\xe6\xc5p\\g\xb6T\xd0\xd9ތ(\x89ԫ\x95YW#\b\x02\xe5r\x91j\xf5\xdc\xf4\xa3\x93\xb5\x9e9\xfb \x89,Z\xba\x8cDs+\xf9L\x86Z\xa5\xe2iJg'ɶ\xb6%\xe3##\xfb\x97/[q\xf5\xcf-\x90\xe3\xc3#\x1c:zȱ-\xab\xd2\xda\xdeAϢ\xbe9\xda!\x8a\"\x92(111ƾ\xbd\xbbٳ\xfb\r\x0e\xef\xdb\xc7\xe2%˸\xe7#\x1fg\xe1\xc2.&\xa72Ш\xd6\xc5bQTE%\x18\xf0\x13\x8f\x86H\xc6c\xb475\xa1\xea\x12\x95\x8aA6S\xa4Z5\x88\xc7\x03\x84#>tMa\xfd\xda\vXw\xe1Z\x0e\x1c\x1ebтnDA\xe0\xd5\xed{ؾk\x0f\xd1h\x84\x9e\xf9\xf3\xc8\xce\xe4IO\xa7\x19<6F\xef\xa2\x05\xcc\xebhA\x12$\xf2\xf9\n\xb5\x9a\x81\"K\xa7\x94d\xa1Z5hiN\x12\x8d\x84\xd14u\x0e\xe2'\x8a\"\xd3\xd9\xf49\xb5\xc4u]\xaa\xd5\xeai\x82\xb3m\x9b\xe6\xb6V\xba\x17\xf6\"\x89\"٩),\xd3$\xd9\xd6FGOO\x8b\x00\x0f-\xed[\xf2[\xefX \xef\xbd\xf9\xbd<\xfbܳl\xbef\xf3Ֆe\u007f\xfe\xc6[n\x9f=\x11\x82  \xcb2\xe9\xf4\x14\xaf\xbc\xf2S\xfa\x0f\xeegl\xec\x04\x87\xf7\xee傋\xd6q\xd1%\x97\xa2\xe9:\xa3\xa3\x13D\xc2\xc19ar\xb9R\xc5r@\xd3\x14TU\xa6\xbd\xb9\x85e\x8bzio\x8d\x13\x8b\x05\xd15\x19ׁx8\xca\xc6\r\x97\xb2j\xc5r&&3D\xa3QV_\xb0\x12A\x96ym\xc7nj5\x93O|\xfc>/:\xb3L\x82\x81\x10\x8f>\xfe$\x8b\x97,a^G\x1b\x97\xac[C[S\x13=]\x9d\x04\x03\xc1YM5M\x9b\xe6d\x9c\xce\xce\x0e\\\xd7ŧk8\xb6\r\x8e\x83U30\xabu*\xb9\x02\xa5bq6\x9c};\r)\x15\vo\xcb\x15\xd3t\x9d\x8e\xee\xf9ttu\x93\x1a\x1dŬ\xd5\bE\"\xcc_\xb2$\xe6\v\x04\xfe\xfb;v\xea\x8f>\xf6(\x1b\xaf\xb8jm>_\xd8v\xf7\x03\x1fe\xc9\xf2\x95\xd4\xeb5l\xdb&\x9b\xcd08x\x84B#\xdc+\xe6\xf3$cI>\xf4\x89O3r\xfc\x18\xa2(\xb2\xed\xe9'\x91E\x97\x96\xa68\x8e\xed\xccj\x94\xae)dsEL\xd3$\x18\xf01=\x93'\x1a\n\x11\xf2\a\x98\xce\xe4\xb0l\x9b\xa6d\x8c\xb5\xab\x97\xa3\aC\x94\x8ae\xa2\x89(\x8a\xa2\x10\b\x06\xa9VD\\d>t\xdf\xdd|\xf0\xbe;\xd9\xf3\xfa\xeb\xcc\xefjeqo\x17\x8a\xeeg\xa6P\xa0\xb99\xce\xc8X\x9a\x9e\x9e.\\ǥY\x00\xd34Ap\x89\x84C$\x93I\f\xcb\"\x12\x8d\xd0\xd1\xd1\u0091\xe11$EARd\x1a\xbc$\x86\x8f\r\xa0\xab:m\x9d\xf3P4\rQ\x92\xe6\x96E\x1bZR.\x95\b4Z\xecδ\xc2\xd1(k.^\xc7у\a\xa0T\"\x10\x0e\xd3\xdd\xd7g\x9d7\xfc~\xe3\r\xef\xe6ɧ\x9e`\xf9\xe2%\x9b\x9b\xda\xda\x1f\xbe\xe3\x03\xf7\a\x9b[[\x99\x18\x1f#;\x93ajj\x92\xe9\xc9Il\xd3$\x12\x89\x12\x0eG\xe8]\xbc\x84\xb6\xcey\f\x1d9\x8cm۴vt\xf2\xb5?\xffc\xee\xbe\xedZR\xa9)Z\x9a\xe3$\x12\t\x96,]BSs3\x8e\xe3\xf0\xb7\xff\xfb\xefg\x89\a'\xc3N]\xd7\xe9\xe9\xee\xc4\xc5C\x83\xfd\xe1\x10\xae\xe3\x92JM\xd1\xd5\xd5AKs\x02A\xf0ʽɖ6\\A\xa2R*\xf2\xe2O\x9e\xa7R\xa9\x12O&HM\xa4\xa0Q'920L,\x1e!\x1e\v31\x9e\x06ץ\xa5\xb5\tY\x91\x99\x9c\x9a\xa6)\x19c\xef\xbe\xc3<\xf7\xc2v\xfc\x89(\xb6e\x83\xebx\xbc\xb9\x93\x14S\xdb&\x14\x89\x91H&\xf1\a\x82\xa7\x054\xa2(\x12O$\xcfi\xe2$Y&\x97ɐM\xa7\xb1,\xd38/,\xeb\xbd﹅ǟx\x8cK/\xb9\xf4\x8aDS\xf3\xa3w\xdd\xf7ᐃ˾\xbd\xbb\x19\x199\xce@\u007f?C\x87\x0e\xb1x\xe92.\xb8x=\x8b\x16/ea\xdfb\xfc\x81 \xb6\xed\xa0(2\xba\xcfO,\x16c\xe7\xab?\xa5\xad9\x86,I\xec\xda{\x98֖8}K\x16!I^\x151\x12\na\x1a\x06\xa1P\x90\x96\x96f\xba\xe6u\xd2\xdb\xd3\xc5\xee}GX\xbcd\x11m\x9d\xed\xc4\x13q\x8e\x1f;Akk\x13\xa1P\xc0\x83\xee\x05\tU\xd7\tFZ\xf0\x05\xa2\x04\xc2I&'F\xc9f3\x94\xcb\xe5\x86_\xf3\x88\x12\xa1P\x00\xc30Q\x14\x99x<B \xe0G\x94\xbc(K\xd74TUŧ\xebl\u007f}/\xaa_Gh\xb0O\x10\x05/\xb7i\xf8\x87Z\xa5B\xa9P\xc0\xc5%\x14\n\xcf\xd1\x06\xd7uq\\\a\xed\x1c\xa1\xb2\xeb8\xe8>\x9fWu\xf4\xfb\xad\xf3\x12\xc8\xe1Ç\xb8\xed\xd6\xdbW\x16\v\xc5\xedw}\xe8#Z:=Ů\x1d\xdb\xd9\xfd\xdak\xee\xd1\x03\a\xadr\xa1\x90[\xb8\xa8\xcf\u007f\xd3\xedw\xa2\xeb:J\x83\xbay*ƣ\xeb:O>\xf20\x97]\xd8G4\x12d:3\x83\xa6)\xa4\xa7s$\x93I\x12\xf1\x18\b\x02\x89d\x82\xbe\xc5},\xec]@GG\a\xb6c\xf3\xea\xf6=\\\xf7\xaeMt\xce\xefF\x96eR\x13\x93\x84\xc3\x01*\xd5:\xdd]\x9d\xb8.H\xb2B$ц\xa6\aP4\x1f\x82(q|\xf0(3\xd9\xf4\x9b\x10\x86(\"\x89\"c\xe3S\x98\xa6E0\x14@\x92\xc4\xd9ھ\x876{\xadrᐟ\x9d\xbb\xfa\xb1\x1c\x17Q\xf6\xb6\xa9g\xfe\"\xa2\x91\x18\x96eb96\x92$b\x9b\x16\xa5\\\x8e\x9aQ#\x1a\x8b\xcfyo\xd34\x91%\xe9\x9c%\xe1S*\x90g\x17\xc8\xe6FQ\xe5\xdd7\xdc\xd86:2\xba\xf7ޏ~R\xc9\xe5s\xbc\xf6\xd2O\xd9\xf7\xfa\xf6C\xc1`蓻\xf7\xee\xfa@s2y\xe95\xef\xbeyI\xec-\x0ftj\x982=5\x89QJ\xd3\xde\x14&\x18\b\x80(2>\x91\xa6^\xb7\b\att\x9f\x86,\x89\x98\xa6A\xb5Ra25\xc9\xf1\xe3'8vb\x9cw\xddx-\xb1x\x1cp\xa8\x94+d\xb33\xf8}~\x16.\xe8\xc2u\xc1\xe7\x0f\x91l\x9b\x8f\xae\aQT\x1dEѨת\xec\xdb\xfd:\x95ji\xf6D\x9f4\x1f\xba\xaeq\xe0\xe0Q&\xc6\xc6)\xe4\xbd,]\x94<.\xb1\xebx\x85\xb5J\xa5\xca\xc0\xc00庁\xac*\xf8\xfd\x01B\xa10\x92$\x13\x8d\xc6\b\xf8\x03خ\x83\x8d\x83k;\xd4+U$\xc5C\x87\xe7\x92\x00k(\rZ\xd1\xf9\xc0|g\xbdj\xcbs[\xf8\xc2\xff\xf8\x82\xfa\xbdo~\xf7\xaf\xef\xfd\xe8'\xfd\xa5r\x89\xed/\xbf\xc8\xe8\xd0\xe0\x91\xae\x05\v\xaeۺu\xcb\xc8\u007f\xfe\x8dߌm}v\xcb5}K\x97c\x99\xe6\xdb1/\xa9V+\xd8\xf5*\xa5R\x85P0H[K\x13~\x9f\xce\xe1\x81a^\xfe\xd9\x1b\x14\nE\x9a\x9a\x12\xb8\x8eC\xdd0ino#\x9cL\xb2z݅\xb8\xb6\x83mYTKE&'\xd3̟\xdfE8\x1cB\x92d$IF\xd3}\xf8\xfc>\xccz\x8dcG\x8f\x92/\xd6\x18\x1f\x1d\xe5\xc8\xc1\x83\xc4\x12\x11T\xbd\xc1\x9et]\x10\x04t]#\x99\x8c\x91\x1aOQ,\x968p\xf0\b\xba\xeeChd\xea\x8e\xeb\xe28\x16\x96m5\xf2u\xb7\xb1\xd1\x1e\xbf\u05f6m4M\xa7\xad\xb5\x83R\xa9Hjr\x1c\xb3Zcj|\x8c@0\x88\xaajs\x84\x92\xcf\xe5\b\x85\xc2\xf8\x03\xe7F\x8d\xcf)\xb6o\xfd\xed?\xbe\xeb\x8aM\x9b\xdfcX\x16C\x83G\x18\x1f>^T\x14厭[\xb7\x8c\x00<\xfd\xd8\x13\x1f\xbft\xe3&\x1f\xe7\xf8C\xd5r\x99\x99\xcc4ͱ\x00\xe5j\r\x9f\xae\x11\x89\x84\xf9\x95O=\xc0\xa3\x8f<M\xb5V\xa7\xa7\xaf\x17\xc1u\bG\xa2\xf8\x83A\x04QĶll\xcb ?\x95FP\x14|~?ɦ\xa6\x06d#\xa3j*\xa9\xb1q\xbe\xf3\xed\xef\xf3\xc6\xce=8.h\x8a\x88,I\xd4\xea\x06Ž\xfd\x88\x92\xc4\xfau\xab\b\x06\xfd\b\x82g\x14Z\x9a\x13\x8c\x8fM\x10\x8d\x04g\x83\x877\xed\xbf\x80\xa2\xf8\xf0\xfb4fJU\x04@Q\xe4\x06\x17\xfeM\x1f\x01\x10\b\x04\x99\xd79\x9f\x89\xd4(\xa5\xcc\fScc\xcc[\xb0\xf0\xb4\x8d/\x16\v \x80\xcfw\xf6\x8a\xaaxfF\xe2\x9d\x1e{\xfdʍ\xa1\xee\x9e\x05_\x88$\x13\xe2\xd8\xd8\tF\x8f\x1dsl\xc3\xf8\xe2\x8e];\xf7\x01\\q\xd9\xe5˂\xe1\xd0'.۸I:[\xa5\xd0u]\xd2SSLLL\xe2\xf7댏O6l\xb5\xc7\x1c\xb9\xf3\xfd\xb7\xb0\xb0\xb7\x87\x99\\\x91\xee\x05\v\b\x84<f\xa0c\xdbX\x96A65\x81(\x8bd\xf2E\x96,\xedC\x96\x15\x1c\xc7\xe5P\xffa~\xe77\u007f\x9f/\xfd\xe9_S\xc8\xe5imk\xe3\xc1\a\xff\x06\x1b\x89\xff\xfe?\xff\x1bw\xdey\vW]\xb9\x81\xcf\xfc\xea\xc7x\xf5\x95]\x8c\x8dN\xce>OKs\x12\xc7\x15f\x93ӓ\xed\t\x1e=\xc9\x03\x02M\xdb±l\\\x17DA\x9e\x8d\xb2\u07baTU\xa5g~/m]\xdd\xe42Y\xa6&'Θ\xd1\x17\xf2y\xaa\xe7h\xdd;MC\xde\u007f\xd7\xdd|\xff\a\xdf\xf3\xa4Z*\xfeVO\xef\xe25\xb6\xeb01r\x82\xe9\xd4\xf8#\xfb\x0e\x1e\xf8\x13\x80\x0f\xdew\u007f\xf8\xe8\xa1\xc3\u007fu\xc3-w\xf4\xcc6\uf7cd\xf1W\xf7Hs\xe5r\x19EQ\xb0,\x1bUU\xa9\x94\xcbDb16n\xdc@\xbdnP.Wp]\x17Y\x96p](L\xa7=\xb4T\xd5Y\xb3f%\xaa\xaa\x92ϗx\xe4\xa1Gػ\xff(\x9d\x1d-|\xee7~\x95\\.\xcf\xdf\xfe\xef\a\x11D\x81J\xa5F$\x12\xa5R\xad\x13kne\xc9\xf2e\\x\xf1Z\xc6\xc6\xc6in\xa9\xa3j:\b\x02\xbd\xbd\xf3)\x17r\x80K\xdd4Qd\x19\xb7\xc1\xac\xcf\xe4\xf2\x14\x8b\x15$\xa5\xd1@tJt\xf5vI_\xb2\xa9\x19I\x14\x19\x19\x18\xc4u\xa1\xa9\xa5\xe5\x14 \xdfۇBn\x06۶\t\x04\x02g\xbc\xdfi\x1arR\x18\xcb\x16/\xfdZ\xa2\xa9\xe5w\xbb\x17-\"56\xc6\xf8\xf0pf\xe3\xe6\xeb\xee?y\xdd\xd1\xfeÿ\xdd\xdd\xd3{\xf5ҕ\xab\xceI*\x9bN\xa79>4H\xbeP\xa1Z\xa9\x12\f\xe8\x94\xcaUdE\xa6\x98/\xe2\xda.\xb5\x9a\x81e\xd9T\xab\xb5\xc6+\b\xd4*e\xaa\xe52\xfep\x98\xee\xf9\xddh\x9a\x86eZ|\xff\xdb\xff\x87\x17_\xden\xa2{\xb6\x00\x00 \x00IDAT\xc1\xe7~\xe3\xd3t\xcd\xef\xa6V\xab\x93\xcd\xce`\x98\x16?z\xf8I*\x95\n\xaa\xdfG\xb9RAUd\xeau\x03\xcd\xef\xe7\xa2u\x17\x91\x1aO#\x8a^\xc7TgG\v\xba?\x88\xed8\xcc\xe4\vL\xa4\xa7IMg\x98\xccd\xc9\x17˔J5dU\xf5<GC\x93\xcef\x05\x1c\xc7!\x9aH\xd2\xd5\xdbKzt\x94\xf13\x10\x1a\x04Q\xa4R.\x91\xcf͜\x11\x8c\x15\xcft\xe3\x15K\x96\xfe\xcf\xe6ֶ\x8f\xdfx\xeb\x1dBjb\x9cc\x87\x0e\xd1\xd5ս\xf9\xab\u007f\xf9\x17\xa57\xafs>\xbed\xc5\xcasTA\x04,\xcbd\xf7\xee\x1dضM\xcdr924J\xb5\x01\xae\x89\x82@\xa5R\xc1\xb2-\xec\x86i\xd0\x1b\xa5Z\x04\xc8O\xa7\x91d\x99h,\x8e\xa2zf\xea\xb1G\x9f\xe4\xfb?|\x8c\x0f\u007f\xf8\x1e|\xbaF\xb9Tfp\xf0\x18\x9f\xfaO\xbf\xcd\xff\xf9\xe1\xc3|泟\xe7\xe0\xa1\xc3\xdc\u007f\xcf'\xf8\xda\xd7\x1fdtd\x8c|.Ǟ\xdd\a\x88ƢL\xa53\xe4s\xf9\xd9hKVddY\xc6q]L\xcb´\xac\xc68\x8e\x06\xc6%6ܺ\xeb\xbc-\x8b\xcdu]\x82\xa1\xf0l^\x11\x8e\xc5h\xef\x9eO1\x97c\xf0p\xbf\xf7\xbboY\x86a\x90\x99NcY\xd6\x1cA\x9f&\x90K.Z\xb7:\x96h\xfe\xe4\a\x1e\xf8\x98\xb0\u007f\xff\x1eN\f\x0eb[\xe6\x97\x1f}\xec\x91]w\xbf\xff\x9eS퐜ly\xfb\xfa\xc7IL畗_$\x9d\x9a\xa0\xa9\xb5\x15M\xf7\xb1\xef\xe0 F\xdd\xf0L\x83㵙\xd5j\xb5\xc6˺\xd4j5*\xd5\x1a\xb5r\x19\xdb4\x91$\xaf\x9a(\xcb*\x87\xfa\x0f\xf3\x97\u007f\xf5\r\xf2\xf9\x12\xeb\xd6_H&3ã\x8f>\xcd\xedw|\x88\x13#CXv\rӪS*\xe7x\xfc\xe9'\x18<~\x84?\xf9\xb3\xaf\xf0\xee\x9b\xef\xe6\xf5\x9d\xbb\xf8\xfa\u05fe\x89,I\x94\xcb\xd5F\xb6\xed\xa0*\n\xea[\xf2\x84\x93@\xa3 \x8aH\xba\xea\x91\xc7\x1d\xe7m}\x88 xE\xa9d\xb2i\x16\u05cb%\x934\xb7\xb6\xe38.G\xfb\xfbg\t\xe1o\x15df:M\xb1X\x98\xdd\xc7\xd3\x04\xa2\xf9|w]\xbe\xe9\x9ah:\x93&\x9bN\x93\x9e\x18\u007fc\xfe\x82\x05\u007f\x04\xf0\xbd\xef\u007f\x87\xdf\xfc\xfco7ξ\x80\xaa\xa8\xa7\t\xe4d\xac?8x\x84\xdd{v\x92\x1a\x1b\xc54Lt\xbf\x9fH<F\xb5Vgjz\x06\x9f\xaeR(\x14\x91d\xb9ѣ.\xe0\xba\x10\b\x04\b\x04\xfcT\n\x05oCd\x19MשU\xab|\xfb\x1f\xbfGS\"\x8e\xa6i<\xfa\xa3\xc7\xf8\x93/\xfd%\xfdG\x0e\")v\x03*\x17\xdeL\xb2d\xc5\xeb'\x91\\\xaa\xf5\"ٙ\x14\xfb\xf6\xf7\x83\x00\x8e\xedP*U0,\v\xdbqN\x837\\\x17jU\x03IU\xbc\xbeEA\xc02\xad\xb3\x98,\x01ì#\xc9\n\xe1Ht\xb6\xb1'\xd1\xdcLKK+\x8a\xa2\x90J\x8d\x91\x1a\x1d\xc1\xb6\xed9\u007fσ\xf6K\xe4r3ضu\xa6(K\x98\xa7i:\x99\xe94G\x0f\x1e\xa8%\x12\xc9\xdf|\xf8G\x0f͜\xfc\xe9\x9f~\xe9\x8f=\x953̀\xa2i\xa7Q{r\xb9\x19^y\xe5EN4\x88\xd6\xf9\x99,\x9d\xf3\xe7S*\x14h\x9bׅ\xa6\xeb\f\r\x8fQ*\x96HĢ\x94JelӚ\x03\xd09\xb6\x85i\xd4gO\x9f\xe6\xd3\xf8\xc9\xd6m\xf8t\x95\xeey\xadH\xb2\xc0\x97\xfe\xfc\u007f\xf1\xeak\xaf\"J\xc29\x18\xb1\x02\x82\xe05\xf9\x84\x82\xbeY(\xbcZ\xa9\xe18\x0e\x01]\xc3v\x9d9\x9bm\x18\x16\xb5\x9a\x81(K^\xa8+\b\xd4뵳;u\xdb\xc14\r\x0f\xfe\x89D\xd1t\xaf\x04\x1c\x8e\xc7i\x9fׅ*)Ԍ\x1aCG\x0e\x91I\xa5\xbc{\t\xc2\xec\xe16\r\x83\x99l\xf6t\x81X\xa69\x9cI\xa71L\x93R\xa1`<\xb4\xf5\xa9\x17\x01\x1ex\xe0ó\xd7|\xee7>\xdf)˒\x1c\x8b\xcf\xcd\xcc\xc7\xc7Gٹ\xe35\xaa\xe5\x12\xb9L\x86b>GO\xdfb,ӤZ)\xe3\x0f\x04\x98\xdf\xd7\xc7\xf0\xc8\x04Ǐ\x8f\"H\xdeV\x1a\x861K\xb0\x13\x04\xb0\fc\xd6\xe1I\xa2L:\x95f|l\x8cD,\x82\xa2\xc8\x14\xcb9\xca\xd5\xe2y\xf5\xaa\xcf\xd1~M%;S\xf0j\xfb\x8e\xcd\xf4T\x96P8\xe8\xb57\x9cr]\xb9\\\xc3i\xc0)'Oq\xb5V9g\x1d\xc40\x8cY\b&\x12\x89\x10\n\x85\x10\x04\xc1\x83\xdc{\x16\x10\x0eD\x90%\x99\xf4\xcc4G\x0f\x1e\xa0Z.\xcf\xd9?\xc7qN\x17\xc8tz\xf2\x9f\xd2S)S\x14\x04\xda\xe6\xcd\v\xbck\xdde\x9f\x05\xf8\x87SF\xe2\xbd\xf2ӟܷt\xe5j\xefE\x1aM\xfdCCGٷw7\xc5|\x8e\xd4\xd8\x18\x95r\x99Ξ\x1e\x04Q$\x97\xcd\xe2\xf3\xfb\x11%\x89y== HLL\xa6\x99JM\x13\x0e\a\xa9V\xabo\x8e\xd6p\x05\x1c\xc7\xc6m$k\x92(q|h\x88\x91\xd1\t$\xd9Ù\x84\x9f\x83#\xee\xba.\xa1P\x00Yj\xa0E.LLM#K\x12\x96eϺ\a\xd7q)\xe4*H\xaa\xf2\x96\x12\xb4uN:\x90e\x1as6\xd8\x1f\b6\x02\x12\x15\\\x97d[+]=\vID⨺Ʊ\xa3\x87\x19\x19\x1a\xa4\x94\xcf{\xc2\x10Ź\x02\xf9\xf0\x87>DW\xf7\xfc\xd1ᡁ\xd7c\xd18\xf3\x17\xf5I\x82$}\xe2\xc6\xebo\\~\xf2\x9a;\ueebb\xaf\\*\u007f\xf4\xcak\xafò,\xea\xf5\x1a;wng\xdb\xd3O2zl\x88Z\xb5F,\x91\xa0\xbd\xab\xeb\xcdq\x18\xe94ɖV\\\xc7A\x10$Z\xbb\xe63\x9d\xcds\xf8\xd0\x00..\x9a\xa6R\xa9\x9cL\x98\xdc7\xc9\xda\x00\x82Kj*͏\x9f\xd9J._\xf2\x06\xc7\xe1\xf2\xf3.E\x91gO\xa3\x97x\x9a8\xb63\x1b֖+uJ\xe5:\x92\xaa4\x84\xff\xa6o<\x17\xf1Ĳ\xec\xd3&\x0eɲB$\x12\x9b%\x12J\x8aL\xb2\xad\x8dή\xf9\xf4,\xea\xc3\x1f\n151\xc1\xe0\xc1\x03L\x8c\x8c\x88s\x12\xc3o|\xf3\x9b\x00\x99E=\v_.\x17\xf2\x976\xb7\xb6b,[\xbe\xe0\xc0\xae\x9d;\x97,Zl9\x8e#\xbc\xfe\xd2K\xf2M\xb7\xbdO\t\x04\x82X\x96šC\xfby\xf5\x85m,_{\xe1\xacz\x9f|aI\x92\x18:z\x94\xf9}\x8bN99.\xcdmm\x1c\xde5F\xb1T\xe2P\xff\x11V\xad^\x89\xed\xd8\xd4\xebut]o4\xed76P\xd39|t\x80Z\xbd\xfe\x8e\xa88g\xac=\x88\x02\xa6eaY\x16\x9a\xaeQ\xaf\x9b8\x8e\xed\x85ݎ\r\x02LN\xcc )^)@\x94\xde\x1c\x92\xe6\xba.\xa6e\xa2)\xea\xdb\x1e\b۶Ns\xda\xe0\"\x8a\x02~\u007f\x00]\xf7Q,\xe4\xa9\xd5j\x88\x92\x84\xcf\x1f\xc0\xe7\x0f\x90ln\xa1Z\xad\x90IO\tgĲ\xe6uw}\xeb\xf9g\x9e\xfeȯ\xff\x97\xff\x16w]\x88\xc6\x13Zz2\xa5)\x8a\x82,I\\t\xe9e\x1e\x1c\x92\x9e\xe4\xd5\x17\x9e\xa7\xa7o\xf1\x19'\xbcy\xd4R\xd7sp\xa74\x81\xfa\xfc~P4J\xe5\x1aC\x83\xc3,[\xb6\x04IQ\xa8\x14\x8bh\x9a\x06\x8e\r\xae\x8b/\x18\xa4m\x9eW\x1b\u007f\xf3\xe4\xfd|--.\x10\x8d\x84\xa9\xd7Mju\x13\xa5\xa1\x0133y,\xdb\xc1\xb6\x1d\xaaU\x83j\xcd\xc4\x17\r\x81(\"\xca2\xb6aΚ\xafj\xa5\x84\x1eK̲QΔ\xad\xd7\xeb\xf59$\x8799\x86(\x12\x8b'<\xa6|\xa9\x84eY\xb39\x8a\xcf移g\xa1}F,k\xdb\v\xcf\xef\xab\xd5\xca\x0fny\xfcQ\x96,^\x8acY\x8c\x1d?N\xea\xc4\bWm\xba\x0e\xbf\xdfK\xfb\x0f\xf7\x1f$\x97͞q\x9a\x8f\x97\x87\xe4\t\x84ç1RdU\xa5\xb9\xb5\x9d\xa1\xe1ql\xcb\xe2\xf0\xa1\x01DA\xc4\x15\x04o\xb0\x8c(!\x88\"\x91h\x9cZ\xb5F\xbdV\x9b#\x88\x9f\xbf\xcbȥR\xad5\x12=\x8f\x86Z(\x14\xa9\xd6j\x88\xa2@6SD\x92%$U\x06\u05eb\x83\x9cz\x90\xaa\xd5ʹ!\xa2s\\\xe38\x0e\xaa\xa6\x11O$\x88\xc6b\xf8\x03\x01\xa4\x93\x90\x8du\x86\xb0\xf7\x83\xf7\xde\v\xc0\xba\x8b\xd7}~\xe0p\xff\u007f\xfe\xfe7\xff\xee\x85\x13\x83\x03_\xb1\x8c\xdaS\xcbV\xaf&\xd9`\xb8W\xabU\x06\x8f\x1c\xa2\x98\xcbq\xe2ؐW[~\xcb2\r\x13YV\xceX%k\x9d\xd7I6_b:\x9b'51\x89\xd1\x10\xaa\xd1`\xf9\t\r\n\xa8a\x18tvv I\"\xf0\xcf3Y\x1er`c[\x16\xb5\xaa\x81i\x1aضM\xb9V\xa3V7)\x16\xabH\x9a\xd2\b\x93ORx$\x1c˞}6\xe3\x1c\xa3\xa4l\xc79簴\x93ML\x8a\xa2x\xf3Sbq\xe2\x89$\x81`\xf0t\x81|\xeb\xdb\xde\f\xc4\x13cc\xe6+?{\xe5\xcb/\xfc\xe4\xf9\xab\xb7<\xf7\xccg\x1d\xc7\t\xf5\xf6-\x99\xb5\x91S\x93)l\xcbr\"\xb1\xd8Wr\xe9\xf4t1\x97\x9b\xa3\x9e\x1e\x9c\x10\xa2\x94\xcf\xcf\xe1Ҟ\xdaO\xd1\xd1\xd5͑\xc1\x112\xd9\x19r3y\x8f\x01bY8\xae\xa7\xdeF\xad\x86\xcf\xe7\xa3)ь(z\x1dQ\xa7\xc6\xef?\x8f\x86\xa8\xaa\x82a\x98\x94\x8a%\x04\\J\x95*\xb6m\x93\x9e\xca\xe3\" *r\x03.9E \r-\xb1\x1d{N\xc1\xeb\xed\x11\x8a7\xaf9\x1f\xbf'\x8a\xe2\xacp\u07b6\x02\xbf\xf5\x94\x9e\xb8O\u007f\xea\xd3\xed\bↅ\x8b\x97\xe24Z\x9aGF\x86\xb1-\xbb\x90\xcf\xe5\xbe\xd9\xd2\xde~{\xff\x9eݧ\r\vPu\x1dE\xd3ȤR\xa7i\x90c\xdb\xcc\xef\xebcz\xa6\xc0\xfe\xfeA\x06\a\x87\xe6\x9c2YQ1\xea5\x8cz\x9d\xd5kVp\xd1\xea\x154'\xe3H\xa28۷\xf8\xce\xf5C\xc0\xa7i\x18\x86Eݨ\xe3\xf7\xebdr9LӦR\xae\x83$\x9eVn\x15$\x11\\f#\xbfj\xb5z\xce\xf0\u05f6-j\xd5\xca;ΓΛ(\xf7\xf2\x8b/^\xb5\xb0o\xb1\xeb6xT\x96eR\xa9\x94(\xe6sS\x9d\xf3\xe6\x15\u007f\xfa\xe2O~j\x9b\xe6\xf3Ã\x03s\x1e±m:\xe7ϧZ\xa9\x90\x99\x9c\x9c#\x14\xd7uQu\x9d\x05}\x8b9~b\x9c\x9do\xec\xf3B_Q\xc4u\\$\xd9s\xba\xe5b\x91x<\xca\x1d\xef\xbd\x01˲\x91\x15i\x96\x82\xfaN\xbd\xba\xa6\xa945\xc50m\x8b\x9aQ\xa7\\\xadbZ\x16\xc5b\x15ð\x91d\tQ9=Αu\x15\xabV\x9f\xf5#\x96e\x9eӗT*\x959\x11ڿ\x88@>\xf6\x91\x8f4|\x81\xb1aɊU\x92\xa7\x01\x1etm\x99\x16\x85l6\xbd\xf1\xdaM\xa9\x06\xc1\xf83\xa9\x91\x91\xd3@4\xc7qh\xeb\xea\xa2R.196:kr\x04A\xc0u\x1c\xba{{\tD\"\x1c;>\xce\xce\xd7\xdf@S\x14\xcf\xe9\x8a\".`\xd4k\xb8\x8e\xc3\v/\xbe\x81$\x89\x04\xfd\xfe\x06\x86\xf6ΒB/ۮ\xb3f\xcd\n\xca岇\x14W+\b\x82Hf\xba\x84 \tȚ\xc6\xdb\xddX\xf1\xe9\x18%\x0f\xa5.\x96\n\xe7\xd1\x03c\xcd)F\x9d\r\x84\x9dCH?k\xdb\xc1\xdf{\xd37+\xd5\xda\u009e\x85\xbd\x82\xed8\xb3 ^\xa5\\\xc6v\x9c\xec\x1f\xfe\xfe\xefW\x00ڻ:\x8f\x18\xf5\xda\xd7Ɔ\x87OSUQ\x14\xe9Z\xb0\x10\xc7v8>p\x94|&\xc3\xc4\xc8\bc'\x86\x19\x1e\x1c \x1aK\x90Jg9\xb0\xff\x10SS\xd3\xde\xe0\x17IB\x94d\xaa\x95\nMM\tZ\xdb\xdb\x18<6\x8a\xae\xa9\x84\x831\xee\xb9\xfdV\x04A:-\xe2rqOyy\x0f\xc7Z\xb1d9\x89X\v\xba\xe6\xf3\xc0JE\xc6vm\x04\x11\xa6\xd3y\f\xcbB\x90ĳj\x9e \x8a\x88\x8a\x8cm\x9a\xe4\xf3\xb9\xf3\x1a\xaaY\xad\x94\xe7@\xefg\x12\x8aW\xa1\x94\x11E\xd9\x03C\xcf\xe7t\xe18~M\xf75ڜ\x1bl\x8aj\x05Q\x92f붏\xfd\xf8\xc7Fsk\xdb\x17\xc7O\f\xefJ\xa7R\xa7\xd9b۶i\xebꢽ\xab\xdbkj\x89\xc7I6\xb7\xd0ӷ\x98\xce\xf9\xf3\t\x86\xc3\x1c82\xcc\xf6W\xb77\xf0 \x11EөV\xca\xccd\xb3|\xf0\x9e\xdbI\xcfx\x99z\xf7\xbc6\xee\xb9\xffn.X\xb9\x9aU+V\x12\xf4\x87\x90%\x05\xdbv\b\xf8\x82\x84C\x11\xa2\x91\x18\xb7\xbf\xe7f\x16t/\xe0Ͼ\xf4\xfb\xdcz\xf3u\\t\xd1\x1a\xc6\xc7\xc7\xf1\at\xb2\xb9<\xe5R\x9d\xc9\xc9\x1c\xa2,!\xca\n\xf69\x1aVeM\xc51\xbd(-\x93M#\n\xe29\xb5\xa4T*\x9d\x16\xec\xbcu_\x84w\xe2C\x04A \x18\n\xee\xf9\xd9O_@\xd54\xc0\xf5\xea\xe1\xf6\\\x84\xb4\xad\xb9\x8d\x97^~q\xb4\xa5\xa5\xf9\xc6c\x87\x0fM\x1c\u07b7\x17\xd7q\x91eyv\xae\x89c\xdb(\x8aB,\x99D\xf7\xfb\x1b\xf7\x83p<Ί\xb5\x17\x92/\x94ؾs\x1f\xa9\xb1\toЀ,#\xca\n\x85\x99\x19$I\xe4\xa3\x1f\xb9\x17ô\x11pI67\xb1\xf6\xc2\xd5\xfc\xca'?ʵ\x9b6\xf2\xe07\xbfΥ\xeb\xd7\xf3\xc7\xff\xe3\x0f\xf8\xc7o\xfc5+\x96.\xe3\xbf\xfe\xfeo\xb1|\xf9Rz\x17/\xe6\xfa\x1b6\xd1\xd1\xd1B6\x93f\xa6X$\x97+322\x8d I\r\x98_:/',i*\xb6aR.\x97(U\x8a猸j\xd5*\x85B~N(}Z\x02\x8d;\x9b\\\x9d\x17Qn2=\xf5\xcco~\xf6\xd7?\xb4pђh$\x16C\x92$\x0e\xec\xdfKjtdh&\x9f\xfb.@\xa9\\\xe2\xde{?\xc8cO<^\xfa\xe8'?\xf1\x95\x03{\xf6D\x06\xfb\xfbפ\xc6Ǥb>\x87eZh\xba.\x9c)_\xc1u\t\x84äF\x86=Ε\xae\xd0ۻ`\xb6\x19\xa8R, J\"M\xc9$\x8b\x16/\xa29\x19a&\x9bcb<E\xcf\xc2\x1e\x10D6]}9 \xb0r\xf5\n\x16,\x98Ͼ\xbd\a\xb9\xee\xbaMt\xb67\xa3\xe9:SSi^\xff\xd9\xcf\x18\x9d\x98\xa4\\\xab1\x93-R\xae\xd4\x11e\x11A\x92\x905\xa5\x91\f\x9ec\xa4\x86(\xe0:.\xb6a`\xbb6\xc1@蜂\xb4,\x0f\x9a\xd1\xdeR\xaexk\x16\x8f˹\x99\x8b\xf7\xdc\xfd\x01n\xbf\xe3\x0e.\xbd\xf4\xb2\xc3\xc7\x06\x06\xeeY\xbb~\x03\xb8.\xa5R\x89\xc3\a\xf6\x9b\x17^\xbc\xee\xc7CC\x83\x05\x80\xbd{\xf7\xf2+\x9f\xfa4_\xfe\xb3?u\xc7'&\x9eNg\xa6\xbf\xf8\xd0C\x0fo\x9d\x18\x1d}~z2u\xfc\xc4\xc0\x80`\xdbvg \x18:\xad\x87B\x10E2\x93)f\xb29ffrtv\xb4\xd09\xaf\x03I\x96\x11\\\x97J\xb1\x88\xac\xc8\x04\x83\x01z\xfb\x16s\xfc\xf8\t\x86O\x8c\xb1\u007f\xdfA\x16.\x98G4\x16cjj\x8a\xa6\xa6$\xb1x\x14\xd72Pu\x1f[\x9e\xde\xc23Ͼ\xc0\xc1\x03\a\x18\x1a9A\xb5^\xc74m&'\xf3 K\xe0\x82\xacJ(\xba\x8a]\xb7\x90NmCh\xf4\xb8\x9cL\xe4Nn\xa0\xa4\xc88\xb6\x83Q\xab!7f\xaa\x9ck\x99\x96\xd9H\x06\xd53\xf2}=\"\x85xn\x81\xec\xdb\xefM\x8b\xbb\xe2\xaa+\x95ё\xd1Oo\xb8r#\x96i\xa2\xfb|\f\r\x1e\rN\x9c8\xf1Tf&;|\xf2\xfa\x1d\xaf\xbf\x0ex\x9fz\xf0\xfe\xf7\xdf\xc5\xd0\xd0\xe0\x89\xe9\xcc\xf4\xde\xe9l\xe6ُ\xfd\xeag\xbe\xbd\xf3\xe5\x97G\xf23\xb9+\x82\xa1\x90\xae\x9d\xd2(*\n\x02\xf9\xec\f\x85\\\x86\x9aaQ.\x14\xe8\xeb륹\xb5\x05E\xd3)\x17\xf2\x1es\xc54\xb1\f\x83\xee\xf9\xdd\\\xb0f%\xb2,\xf3\xeako\xf0\xf4\xd3\xdbسk/\xfd\xfd\x03\xbc\xf0\xc2+<\xb7\xe5\x05f\xb29DI\xc40+\x8cO\x8cQk\xd4+\xa6\xd3\x05\nŪ\xa7\r\xae\x8b\xa4\xaaȪ\x17\xda*\xfa܃\xd2\xd6։\xde(6\x9d\xacw\x00\x1e\xbd\xd4u\xa9\x94\xca\xe8>?\x9a\xa6\x9d\xf5\x13\x1bN\x16\xa1,\xd3D\x92edI>\xcdJ\x9c\x97@N\xae5k.h\x9e\x9a\x9c\xfa\xf4\x86+7b\xdb6>\x9f\x9f\xc9TJɤ'\x8b˗\xaf\xd8\xf2\xae\xeb\xaewv\xee\xdc1{}\xff[f\xd9^u\xe5F\xbe\xf3\xe07\xedtfzgK\xb2\xe9\xd8Tj\xe2}\xcdm\xedH\r\x13&\b\"\x95J\x99\xe9\xd4\x04\xb2*219\x83Q)\xb1\xe6\xc25\xa8\xaa\x82\xaa\xe9\x14s3\xb3\xc5.\xa3VG\x92D\xba:۹p\xedJ.\xd9p1\xf3\xbb\xbb\b\x05\xfc,]\xdaˍ7]\xc7Lf\x8a\xfd\xfd\xfb\x99\x98Ha\xd8\x0e\x02P\xaf\x9b\x8c\x8ef\xbcS*\x89\x9e@\x14\xb9\x11\x84\xb88\x8e;\x1bm\x89\xa2HKs\x1b\x8a\xa2\x12\n\x85\xd0}\xfe9\x1d\xb9\xa2\xe4\xf9\x9d\\:M\xb9^\x99ݗ\xb3\xf9\x14\xc7q<lN\x10PU\xf5\xad\x90\xd2\xf9\v\xe4ӟ\xfcda\u05ee=\xbfw\xc1\xc5\xebg\x13<\x9f\xdf\xc7\xd1C\xfd\x17\xd7*\xd5\a\x1f{ⱙ\xb3\xfd\xfe\xf0\xf0q\x00n\xbd\xf5V:\xbb\xba\x8f\x0e\x0f\ru䲙\vZ::\xbd\x90R\x14(\xe7\vL\x8e\x8f\xe1\v(\x98u\x9bR\xb9\x8a.\xc3\xe2\xc5}H\x8a\x871\x9d\x04\xef,\xdb\xfb(\v\x17\x90d\tU\x96\xe9\xea\xead\xc9\xd2>\x92\xc98O\xfc\xf81\x0e\x1e\xea\xa7\xd8H\xd0\\\xc7A\x10\x05N\fO{-l\x02\x9e@\x1c\aYW\xbdID\x92\x84Y\xad!7̖\xeb\xba\x04\x03A\xc4F\x95BQT\x82\x81\x10\xd5je\xb6\xd9H\x10\x05$U\xa1\x9a+R\xadU)W\xcb躿1\xfd\xe8\xed}\x8bQ\xafcY\x16\xaa\xaa\x9ez\xdd\xf9\t\xe4\xc3\xf7\u007f\x88?\xfc\xa3?\xb2\x17.X\xf8\xe1\xf9\xbd\x8b\"')/\x81`\x88B\xb1 LN\x8c\xdf\x12\xf6\a\x06\xd6_\xb2\xa1vt\xe0h\x1e\xe0\xfe\xfb\xeegϞ=\xa7\xdd\xebСC\xecݻ\xc7\xfe\xcc\xef\xfe\xdeӻ^yY\xca\xcf\xe4.\x0fǢ\x82\xa6똆\xc1\xe8\xf0q4MFQEr\xb92\xd9l\x8e\x05\v\xbaI$\x13(\xba\x86U7\xc0u\xd1}\xfe\x86\x89pp\x1a5\x8ez\xbd\x0e\x82\xc0\xb6\xad\xdb8r\xe4\b\xe5Z\xdd+\x1a5\xec\u007f\xa1P%\x93)\xa0\xfb$L\xabA\fu]d\x9f>{ȼ\"\x9a0\x8bg9\xae\xe3uZ\x9d\f}e\x99`0\x84;[E\xf4\xc8\x0f\xb2\xae\xe1\xda\x0ef\xadN.\x97\xc50\x8dF=^<\xe3(uA\x10\xbc\xe4\xb1V\x99\x9d\xf1\"\x9e\xaf\xc9ڵg7\x00\xed\xad\xad\x05\x10\u07bb\xb0o1'[\xa2;\xbb\xe7S\xadV\xa3\x86i\xdc1t\xe4ȭ\v\x17,\\3\x91\x9axtϞ=l\xbe\xe6:\x86\x8e\r\x9ev\xbfK7\\Ʒ\xbe\xf1w\xf6t6\xb3\xad)\x9e\xd8?\x95JݙlnF\x10D\x86\a\x8f\xa2j\xde`2Y\x11HO\x17H\x8dOp\xf1\xba\v\xd05\rYU\x11\xdc77GR\x1a\x84k\x9f\x0fE\x91\xb1,\xaf\x05```\x90|\xa18\x8b\x0f;\xaeK*\x95\x03\xd1A\x92EL\xd3m\xcca\x04ESf\x1d\xad(\x88^\r\xa4\x01\xa1ؖ\x85\xcf\xe7G:\xc5\xe6\v\x82@\xc0\x1f\xc0\xef\xf7\xa0s\xd30\xb0,\x13Q\xf1\xb4L\x00\xaa\xa52\xc5B\x81\x9aQ\xa5V\xafyZ\xacjs|\xccI!\x99\x86\x81Q\xaf\xe3:\xae\xf5\x8e\x86Ϭ\\\xb1|\xe8\xe8\xa1\xfe\xb5\xd1xbask\xdb,\x84\xbcpQ\x1f\x92\xaaJ\xa1h4>\x93\xc9\\\x10\x8f\xc6>\xb9a\xc3\xe5۞|\xfa\x89ԙ\xee32:\xc2\xcd7\xbf\x87#G\x0e\xb3\xf9\x86\x1b\x0fO\x8e\x8f\xa5өԍ\xe1h\x94\xb1\xe1㨪7\xc8L\x10\x04DIdtt\x8a\xd1\xe3\xc3\\v\xc5%\x1e\xfd\xc8\xe7\xc36\xcdY\x94T\x92e\xfc\x81\x00\xaa\xaaQ.\x14(\x15r\x1c;1B6\x97\x9f\x9d\xe9X\xab\x99d\xb3E4\xdds\xbc\xa6ɬ\x16H\x8a2\xdb\x03\"\x88\x02\xaee\x83(\"\x88'\xbb\xb8\x98\xad\x01\xbd\x95e\xe3\xd3\xfdD\xa31\x82\x81\x90ǖ\xb1L\x04IDR\x15\xaf\x9b\xb7T\xa5V.S\xaa\x94)W\xca\xf8\xfds\x85{jnb\x9a\xc6;\x13\xc8\xc0\xe0`}㦫\x9f\xdd\xf2\xc4c\xcb*\xe5J\x93\xedؾ\xa3\xfd\a96p\x94\x91\xa1\x81\xea\x89cC5EU\xb5h2\x19LOM~b~W\xb7\xb8n\xfd\xfa\x1d\xfd\xfd\xfd\xc6\xd5W^\xc5\xf1\xe1\xd9`\x8c#G\x0e\x03\xb0w\xcfnwrj\xf2\xf5x4\xa6\x8f\r\x0fo\x10\x04DY\x16\x11%\xa1qj\x1ddE\xe4\xd8p\x8aɱq\xd6^\xb8\x1aE\x96Q4\rǲ\xa01\xf5AQ5\xafqG\x91(\x17\x8b\x1c\x1d<\xc6L\xa3\xf9\xd2q\\*\x15\x83r\xa9\x8a\xacx\xfc/\xcb\x04$\xc1\x9b\xf2 KsH\r\x82$b\xd7\xeaޘZ\xd1\xe3%K\x92\xe4\xb5,\xbcMyLQ\x14B\xa1(\xd1H\x14EQA\xf0\n\xbd\xb2\xa6\"\xca\x12f\xcd\xc0\xac\xd5\xc8\xe5g0Ms6*{K\b\xfc\xce\x04r\xcd՛\xf8\xf1c\x8f\x95333ߵ\xaa\x95\xed\xfb\xdfعs\xf0\xe8\x91gƆ\x87\x1f/\x15\xf2\x0fJ\xa2\xf8\x1d\xa3Z=\x9eM\xa7\xaf\xec\xe8\xe9A\xd1\xf4\xabN\f\r\xad\xdep\xc9\xfaן\xde\xf2l\xf6\xfdw\xdf\xcd\xfe\xfd\xfb\xb9v\xf35\f\r\x1d\x9bs\xef\xf4tzkGk\xcb\x06Ӵz] \x99\x8c\x93Hƽn%\xcbB\x92D\x86\x87'1\xeb5\x96-\xeb\x9b\x1d/\x8b\xe3\xbci\xa7E\x11\xc7\xf2\xfa\xe2\xf7\xf7\x1f\xa6\\\xad\xcdN\x8a\xc8L\x17\xb1]\x13\xa9!\x04\xcbt\xbd\xf1\x1a\x8e\x8b \x89\xc8\xeaܲ\xab I\xd8u\x8fg%H^\x04h\xdb6\xba\xee{۾A\xb7\xd1\u007f\xa2i:\xa1P\x84` \x84\xcf\xe7GU5DY\xc2v<\b\xbfV\xa9\x92\x9b\xc9zͲ\r+#\xcb\n\xae\x8b\xf5\x8e\x01\xfb;n\u007f\x1f?|\xe8\x9f\xcez\xcd\xc6+\xae\xbak*\x9d\xfe\xfe\xea\xf5\xeb\x99\x1a\x1bc\xe2\xc4\xf0\x9e\x83G\x0e\xaf9\xf5\x9a\xfd\x93\xfd\xf2G\xdf\xfb\xc0-\xa5Bq\xad \xd0#\nt\x95\x8a\xe5\x15\x82@XQ=f\xba\xeeөUk\x8c\x8e\x8ey\xb3\xde\xeb\x0e\xaa\xa4r\xed\xd5\xeb\xb9\xff\xfe\xbb\x91$\tײ\x10$y6\x84\xacU\xca\xec۳\x97\x9f\xbe\xba\x9dl>O\xdd4\xc1\x118xh\x18\u007f@n4ӺT+\x0e\xc8\xf2l\x94\xa5hڬٚcF*5/\nk8}EQIē\x04\x83\xa1\xb7\xad\x8b\b\x82p\x06~\x81C\xa5Z&=\x95\x9a=$\xb6e\xe2\x18\x16\xb2\xaa\x12\x8eǉǓ\xb5w<\xc0\xec`\xff\xc1\xb3\xfe\xfc\xe6w\xdf̳\xcf={`^{ǂ\\vf\xf5\xd25\x17\x90\xcf\xce\xc4:\xda\xdb\xc7\xc6\xc6\xc7v_\xbc\xee\xc2xsS\xd3_}\xf7\xeb\xdf\xfeQ\xa5\\\xbe3\xd9\x12\xbfB\xf5i+\xb2ӹy\x8e\xe3h\xa2( \xcb\x02\xf3:۹\xe3=7\xd0\xd5\xd9A\xb9Ve&\x9bC\x10=\xd339\x99arb\x9c%}\xbdȲ\x84\xa6\xaa^\xc9\xd74\x98\x9c\x98``\xe88\xd5Zͫw\x986\x03C\x13\x88\x12(\x8a0K\x15\xb5\f\xd73Y\x8d\x19,\xa2|z\x9dE\x10\x04$EƬzћ(\x8a8\x8eM\xa9\xec\xd5\xe1\x03~\xff\x1c\x8cj\x8e\x86\t\x02\xaa\xaayfN8\xd9G\xa2q\xb2\xedϲMo\xac\xb9\xaa\xe0:\x0e\xa5\\\x8e\\~\xc6\xfe\x17\xff@\x97#G\xbd\x0fg\\\xb8\xb0w\xc7\xcc\xf4\xf4\xedm\xf3\xe6E\x13--rjt\xa4'\x99\x88\xfb\xaa\xd5\xda\xe3\xc1Xx]\xef\xda>>\xf0\xf1;\xb8\xfa\xe6+Iv4\x93\x9d)`\x9b6\xf5j\rU\x93X\xb9b1\x9d\xed\xed\xf8t\x8dy\xed^\x02Y(\x95@\xb0\xc9\xe5\xcbLNf\x19>~\x1cQ\xf0\b\xda3\xd9,C\x03C\xbc\xb1{\x1f\xf9B\x89j\xc5`*\x9dc`p\x1c\x04\x17U\x13\t\x87\x82\xe8>\x1f\xd5j\x15\xd3pg;kE\xa1\xd1\xf4)\x89s>H\xe6$\x95RRU\xef\x03\x00L\xb3\x11\x12KضI\xa1\x98\xf7\xa0\x17Y>-\n\xf3\"4/+\x0fG\xa2\x04\x02Ad\xd9\xeb\xc2\n\x06BD#1\xcfL9\x0e\xae\x00\x82\"\x81\xed\xb82\xbf\xa0\xf5\xd2+/\x8d.\xe9\xed{\xa8R(~v\xc9\xea\xd5\xc2\xfe\x9d;VY\xb6\xfd\xe5e\x97\xac$\xd9\xd1\xe4\x9d M\xa5Z\xa9Q/W\xf1\xf95d\xcdk9p\x1c\x17\x9f\xcfߘf\xea\xe0\xf7\xfb\xb8\xe4ⵄ\xc3!\xf6\x1e\xec'\x12)31\x9ef\xfb\xae\x83\xec?4\x84\"\xcbh\xaa\xd2h)\xb01M\x8bZݣ\xa7\xaa\x9a\x88\xa2J(\x8aLsK3\xa2(\x90\x99\xce6&=ؠz\x03\xcf\x14E\xc6(\xd7\xd0B\x813\x96\x1be\xcd#Pۆ\x89m\x98Ⱥ\x86(H\xcc䲔\xcaEo\x93\xa3\xf1\xb9h\xae P\xabT\xb0L\x93p8\x8a\xa6\xe9hZ\xe3\x03\x03\x1c\x87P8\x82Y\xafS(\xe6)\x14\xf3\xe4r9\xfb\x17\xf2\x91GW_{%Ǉ\x86\xb9\xf4\xb2+\xa6\a\x0e\xf7\xdf=9>\xa6U\xeb%\xd6^\xbb\x9ep\"Ҩ\x03x\xd4\xc9H8\xc8\xe0\xc1c\xacY\xbf\x92\x03;\xfb\xa9\x96\xaaȊHwW;\xf3:\xdag_P\x14EZ\x9a\x92\xf8t\x9dr\xa5F2\x19\xc50jT\xeb5\x8f\x87\\\xaeR\xa9֨\xd7\rl\xc7FR\\4]B\x92\xbd\x0f\x87I&\x12\x84CA\\\xc7%=\x9d\xc1\x9e\xed\x01\x15p\x05\x90e\tYW1\x8a\x15d]9#\xd9H\x10\x84F\xedDªְ\f\x03\xa9\xd1[R\xafר\xd7\xeb\xa7wF5\xe0\x12è\xa3\xa9ڬ\xf6\x9d\x9c쭨*\xc1P\x98p8\x8a\xdf\x1f8{\x94\xb5\xe1\xb2\xf5\x8c\x8e\xbc\xb3\xc9\xcc\xf7~\xf4.\xf5\xc4\xd1\xd1xSSre.\x97\xf9\x1d\xc30V\xaaAU\xbc\xf0\xda\xf5(\xa7D2\xa2(\x90/\x94(N\xe7i\xe9hf\xf0\xe0\x10\x81\x90\x9f\x91\xc1Q$Yd^\xc7\xff\xdfٙ\xfc\xc6q\xe5w\xfcS{UW\xf5\xde\xcd&\x9b;\xb5;Z\xbdȒ\x95 \x03ę\xc0qf\x0e\x0e\x90\x04\xc8!\x98S\x90[\xfe\x89\xe4\x96[\xaes\xcb-\b|\xc8b\x03q\x06\xe3\xf1\xc4\x13؊\xe5Hv$J\xd4\xcaE$\x9bd\xb3\x97\xaa\xae\xe5UU\x0eU\xec\x11EY\x8a\xa7\x81>5\xba\xd0\xd5\xef\xd5\xfbm\xdfe\x92\xc5\xf9\xb9#\xc1\xb1\xd5\xcc8탡K\xb9RDUdD\x1c\xa1\xa8\xa0j\x12\xba!\xa3hR^\xc7\xe4\xb2{V\x81\xe9\xf6\x14\xba\xaee\xe8\xc4\xed\x1dD\x94\x8e\xfd\x8ceU!M\x124\xd3@\xd54\xa2Q\x90\x05\xf1\xefh}H\x92\x84b\xe8(\x8aB8\n\xb2\x8b\xa4\x10\x8a\x10o\xe4ff\x02\xcf\xd5\x1ai\x9a\x10\x86\x11z\x9e\xee\x1e\x99\xb3(\n\xb6\xed\xbc:\xcb\xfaӟ\xfc\x89\xbe|c\xf9j\x14\x04\xc7\xf2\x86\x9b\"ɲ&\xcb2\xb1\x10\x9d\x14\\\x92D\x96dy:\xf0\xc3Z\x1c\x89\x13\x92\"\x9f/VKo\x94\x1a\x15\xdaK\xd3T\x9a\xd5##OI\x92p{.r\x9a\xf2\x83\x1f^\xe5\xeeͻ(\xaa\xca?\xfe\xf4CD\x18p\xf9\xf2y>\xf8\xd1{yʛ\xfd9\xd2\xc1\x84M\x92HI\xd9\xda\xdeauc\x83'\xab\xeb\xecu\xf7\x19\x0e]\xdc\x1cQ~\xb0K\xcb\xe5\x12\x8b\xf3s\xb4'\x1a\xd4J\x0e\x9e\x1f\xf2\xe1ǟ\xe0\x0e\"TU\xcab\x89\"gG\x90\"c8\x85l*\x18F\xa4\x12h\xa6\xf1r\x90\x82$\x91\x84Q\x86ݒ\x00YF3t*\x95*\xa5b%\x8b\x1bπ\x1d\xd4\\\x93\xde0\xcc\x17]\xd7\u007fi\f\xf9\xb3?\xff@\xfe\xf6\x8bo\xfe\xca\xf7ÿm\xcfNj\x00\x9e\x1fH\x81\xe7ˣ\xa1GJ\xea+\x9a\x1a\xa7)8E\xcb*6*j\xb9Q\xa1:QC\xb7\ftS\x1f;\xa0\xbd(g7m\x13EU\xb8w\xfb\x01\xc7^[B\xd35.\xbe}\x8e_|\xfc9\u06dd\xdd\\nQ\xa6֨\x93\xa6\x99\x98\xcd\x01\x97/MSZ\xcd\x06\xcdz\x8d\xd9\xf6\x14w\xee\xddg\xfd\xe9&\x92\xd4\xc4sG\x8cF#\x8a%\x87\x13\v\xf3L\xd4*cBg\xc148w\xf2\x04\x9f\u007fy\vI\xd2\xd0\r\x890\xc8\x14\u007f4\xd3 \xf2|4\xcbDR\x14H\x12B\xd7C+X/\x9bqg-\x13M\xcd\xcc\xc4D\x8c\xdf\x1f\xb2\x1bf\n\xab\x95J\x8dj\xb9J\x9c\xc49\r\"\xa1\xdf\xebQ\xb0\x05\xb6}T\x9c\xe6\xa5\vr\xf7\xf6\x03=\xf0\xc3?\xfa\xe0/~T8\xfb\xd6o\xa1\xc8\x12\xae\xebs\xeb\x9b{\x84QD\x9a\xa4\x851 \x8c\xec\xb1M\x0f\xf2\xcaW\xa0-\xb2\xf6u\xd6\xc6\x10\x12\xe8F6\xb8\xf9\xe1\x1f\xbf\xcb\xde\xce>\xf7o\xaf\xe0z^^\xd1*\x94*%ʕ\x12\xfd\xfd\x1e\xbd\xfd\x1e\"\x97N\x92$\x89\x89F\x83V\xb3\xc9\xd3\xcd-\x96W\x1e\xb0\xdf\xefS\xb4\xa7\x99\x9fj\xe5\x9aX\x1cڥ\xc7\x16f\xb9\xf9\xbf+\xf8A\x84aɤ\x89B\x18\nL\xc5\xc2\x1de\x15\xba\xac*H\x8a\x82].3\xea\x0fPM\x9d\xf4U\xe2e\xb2\x8c\xa2\xcb(\x86\x86\xf0C\xdc\xee>\xbe\xe7\xe2\xba\x03&'\xda\xe3VM\x9a\xa6\f\a\x03D$(\x95\x0f\x1bd~g\fy\xe7\xda\xdb\xf4\xf6z\xbaa\xea\u007fw\xe9\x9d\vf\xa5^\xc6\x1d\xb8t6:\x9c:\xb3\x94\x19\xb3\fG\xe3\xdd\x1f\xb8~V\xed\xfe\x06\bu\xdf\x0f0M\x03\xc7)\xa0\xeb\x1a\xb3K\xd3ܹ\xb5\x82;\x18\xb0\xb88\xc7d{2G\xa3g\x88q\xdb\xc9u\xae\xe2\xc3\xf0\xffR\xa9\xc8\xc9㋴'\x9a\xd8\xc6w\xeb\x8b(\xaa\x82\xef\alw\xba躌\xa9\x1b\x90ʄa\x88\"ID~\x90!\x18\x95\f\xb2T\xa97\xb0T\x934\xce,\xf52[%\x0e!\x1c\x8fl6ME\xd55\xd28f4\x18\xd2\x1f\xf4\xd0u\x9d\x03\ny\xd6\xed\x8d\b\xa30\xaf\xa3\x94\x97\xb7NVW\xd79~b\xe9\xb7E\x14\xff\xe5\xa5w.P,;Ȳ\xcc\xc6\xe3\r\xca\xd5\x12\xad\xc9\x06\x96e\xd0\xed\x0e\x00\xd8^\xdd°\xcd\xf1\xc0\xe9\xfb\xbe\xf6{C\xa6\xa6\x9aȒD\xb1Rd\xe1\xe4<\xff\xf2\xe1'\xac\xac<`~a\x96\xd9ٙ1\xcbJQ\x14,\xcbB\xd32\xa4\xc8\x01\x93\xf5@\xf4_7\f\f\xd3$\xf0\xfdq\xccy\x1ezcZ\x06k\x9b\x9b\xa4i\x82\xe3\x14(9\x0e\x83\x81\x87\xa4*\xb9mxr0\xc5ò,\xea\xcd\x16N\xd1\xc12-L\xd3DJ!\x1c\xf9D~0&.Ɋ|$\x11\x90\x15\x05\xc5ȋ\xbf~\x9f\xd1p\x88e\xdb\xe3\x11\xf6A\x06\xa6i\x1a\xb2\xa2\xbexA\xae\\\xbd\xcc\xda\xda:\xb5j姊\"/\xbe~\xed\x02\xb6\x93\r]\xf6:ݬ\xfd\\,`\xdb\x16\xf5Z\x89ͭ]T]cg\xadC\xb9^\xfeދq0Is\x87\x1e33-D$(W\x8a̟Z\xe0\xe7\x1f\xfd\x92\xcf~\xf99\xde\xc8\xe3\xf2[\xaf\x8f\xe3\x87$K\xa8\xba\x86iX A\xf0\x9c\xac\xdeA\a\xf8`\x10\xf4\xfc\xa2\x14m\x9b'\x1b\x1b\x8c\xfc\x804\x81\xa9\x89&\xa38ar\xa1M\xb9Yaoc\x87$\x8eI\x84 Jc4]\xc34s\\Wn\x8e\\\xa9֨7'\xd0t\x1do8$p=\xe2H I\xe4Y\xda\xe1\xe3LR2\xbb\x8e\xeev\a]\xd39\x18a\xa7iV\xdc\xea\x9a\xf6\xe2\x05Y[[\xe7\xf2\xe57\u007f\xd2\xedt\xff\xda.\xda\\\xbcr\x9e\x82\x9d}\xb9Ѫ\xf3\xab\xff\xf8\x82\xa5\xd3\v\x00\x18\x86A{\xaaI\xaf?\xa4\xd7\xede\xfaO\x05\xf37Z\x14\xd7\xf31M\x9db\xd1\xceH\xf8\xd5\x12v\xa5\xc8\xd3Ǜܼu\x9bO\u007f\xfe\v\xe6\xe6f\xa8V+cu\x9d4M1rӮ\xc0\xf7\x0f\xdb\xdd\xc92\xc5r\x19\t2\xea\xf5\xb3\xc1SQpFpS\xbd\x00\x00\x05\xadIDAT=\x8f\xad\xce.I\x92\xb2t\xee\x14~\x142{j\x9eB\xc1\xa2:Qc\xd8\xed\xe3\r\\\xd28\xc1\v2\b\xa9\xae\x9b\xbf\x96s\xcaQ\xfa\x99\xfe\xca\x04\x85R\x11Y\x95\x89CA\xe4\xfb\xc4a4\x1eO\x8f+xIB\xd6\x14z\xdd.R\x92\x8e\rg2\x98\xea\xe8\xf0\x82\xfc\xe1\xfb\xbfϽ{\x0f\xb8t\xe9\xfc\x8f\xf7\xb6\xf7\xfe\xc1.;\xb2U0\xb8x\xe5\x02\xa6e\xe4ǅ\x8ca\x19,\u07fc\xcb\xe2Ʌ\x8c\xd3 K\xb4\xdbMt\xcb\xe0\xd1\xf2\x13\x82\x91\x8fS-~oƓ,K\xf4{Cj\xb5\x12zN\xa8\x99\x9cn\xe2\x05\x02EU\t\x93\x84\u007f\xfb\xe7\x8f\xe9t:4\x1bu&[\x13Da4\xf6$)\xd8\xf6\x18\x8c\xf0\xec\xeb\xe0\xdc\x0e\x83\xe00\x18\\Ӹs\xff!\xa6n\xd0Z\x98\xc1\xf3\x03.\xbe\xf1\x1a\x96\xa6\xf1\xee\x8f\u007f@\xa1h\xb3\xfah\x9d\xc8ˎ&\u007f4b0\xe8\x11'1\xb2\xa2\xa0\xab\xda\xf8\xa8L\xd3\x14]7(\x97\xca\x14Ke,\xc7\xc1(\x14 M\xf2\x8d\x92@\x92\xa3Wd\x19YS\xf0\\\x17\x11\x84\x18\x86\x89\x9a\r\xc4~\xbd \xef\xbd\xff.\x1f\xfd\xeb'\\{\xe7\xca\xd9\xdd\xed\xbd\u007fr*\xc5\xe2\xb9߹Hok\x8f\u05ef]@\u007ff\xce\\mTy|\xf7\t\xbai`\x17\vc\xdaF\xa3Qevq\x9a\xb5'\x9b\xac\xaf\xacRmվ\xf7\x93\x92\x99\x04G\xd4\x1b\x95\xac\x00\x93\x15\xdas\x93<\\YŮ\x14\x99;\xb9\xc4\xf5Ͽ\xe2\xcb/\xaf\xb3\xbb\xb7\xc7\xec\xcc4\xe5R\x11!\xb2Xa\x16\n\xe8\xba\xce\xc8\xf3\x0e=}\xbaa \xe2xL.JӔ\xa2m\xb3|\xff!H2\x8d\xf96\x86\xae1=\xdd\xc2\x1f\x05\xd4'j\xb4\xe7&iN\xb7\xd8\xde\xdc\xc12L\xfa\xfb}\xe2 `\xe4z\xf8A@\x10\xf9ٴ2\x97c:X\x18Y\x961\f\x13\xcb*\xe0\x94\xca8\x8eC\x14E\xc4I\x9c\xfd\xf1qJ\x12'Ț\x8a\x88\x05\"/\x18\x15E\x11ʵ\xab\x97Y][g\xe5\xde\x03\xde{\xff\xdd\xe6\xda\xe3\x8d\xff\xb6\x1c\xab\xf1\xe6\x1f\\\xc1\x1bx\xf4;\xfb\\\xbcz\x01\xfd\x99\xac%I\x12\xa6\xe6\xa7\xf8\xe2\xd3\xeb,\x9dZ<\xf4$\x98\xa6Ή\xd3\v\x88$e\xf9\xc62\xe5F\xe5\xff%@|\x88_1\x1ca\xe5YW\xd6%\xcd2\xafo\xae\xdf\xc1r,N_>Go\xb7\xcf\xf5\xff\xfa\x8aO~\xf63\x1e\xaf\xae2\xd3nS.\x97\xc6SL\xdbq\b\x82`\x9c\x85I\x92\x84iYY\xa0ϓ\x03Y\x96\xf0\x82\x80\xcdN\x97\xd6\xe24\xaa$39\xd5$\x8a\"ʵR\xa6\x9bب\xa0\x97l\xeeݼ\xcbD\xab\x81\x1fF$\"!p=|\xd7c\x14x\x8cF.\xaa\xa6\xa3k\xfa\v\xefG\xd3t\xca\xf9\xe0*\b|\x903?F\x11f\xd3E\x11G$QL\xc1\xb6\x85\xb2\xba\x96\xb5F\xdez\xf3\xd2\xef>]\xdb\xfeT\xb7\x8c\xfa\xeb\xbfw\x19˱\xe8\xef\xf6\x18t\xf6\xb9p\xe5\x1c\x86\xa9\x1f9^2\n\xf1\x80r\xadt\xe4G\xcc-N\xd3jOp\xf7\xc62Q\x14!\xc9ҡ\xd6ɫ\x8e\xae\xceN\x97f\xa3\x86if\rǂe01=\xc1\xf2\xffd\xdd䅳\xc7hN\xb7\xf0\xfa\x01\x0fW\x1e\xf1\xd1\xc7\xff\xce\xed;\xcbc\xcd\xf8\x82U\xa0R\xad\x92$\xf185\x97$\t\xdbv\xf0G\xa3\x1cS+\x11\x84!\x8f\xd67\x99>>Go\xb7\xcfŷϱ\xd7٣>\x91yWɲL\xbdQ!\xd1\x14\xb66:8%;we\xc8䣂\xa1G0\xf2\x19\xba}\xfc \xc00\x8c\x9cXtt\x13\x9a\xa6I\xb3\xd9B7L\xbc\x9c\xf7\x9e\xc6Y\xb77\x8ecHɚ\x8b\xe7ϼ\xf67\xdd\xdd\xde\xdf;\x95b\xf9̕\xb3\x94j\x99\xa5\xb6\xdb\x1b\xb2\xbb\xd1\xe1\xfc\xdbg\xb1\xec\xa3\xd5j\xc1\xb6\xb8{k\x85\xe9\x85\xf6\x91,&I\x12ʕ\"\xa7\xce\x1dGJa\xbf\xb3\xcf\xd3'\x9b\x14J6\x9a\xae\xbe\xdap\v\x89nw\x9fF\xa3\x86\xaa*$I\xc63/\xd5\xcb<\xb8\xfd\x90\xe1\xfe\x80\x89\xb9)\x9a\xb3\x13T[\x19Vx\xed\xc9:\x9f}\xfa+\xbe\xfa\xfa&\x0f\x1f?\xc2u=fff\xa8\xd7j\x19\xc12\x0f\xf4\x86e\xe5<\x8f\x98\xfep\xc8\xda\xd6\x16S\v\xb3\x94\xaaE\xe6\x17\xdalot\x98\x9a\x9d<\x844\xa97k\xec\x0f]\x12\x11So7\x88\u0098\x84\xbc\xb5\x92$D\xae\xcfh8d0\xe8\x13\xa71\xaan\xa0?\xa7=y\x00+-\x16KԪuRR\xbc\x91\x8b\xa2d\xa3\xe24N$\xe5\xfc\xe9\xd3_\a\xb1\xf8\xe0\xd4\x1bgғo\x9e\x11\x85\xa2-\xd24\x15\x92$\t\xb7\xe7&[O6\xe5so\x9d\r\x8beG\x00\x87\u07ba\xa1\vIB<YYM\xdbsSQ\x9a\xa6\x87>?\xb8N\xa3U\x17\xf3K3b~qZ<\xfa\xf6a\xb2\xb7\xd3ũ\x14\xc3\xe7\xaf\xf7\xec[\x92\x10q\x9c\x88\xe1\xd0\x13\xf5ZYȲ,\x00Q\xa9\x96D\xb1V\x16\x9d\xed\xaeظ\xbf&U\x9a\xd5\xd0,X\xa2Ҭ\x89\xf6\xf19њm\x8b\x91牍͝\xe4ƍ[\xd2g\x9f\xfd\xa7\xf8\xfa\x9bo#UUEɱ\x85i\x9aB7\faY\x05\x11\x8cF\xe2\xce\xca\x03\xe1\x06\x81\x98:6'\x9c\x92#\x1a\xf5J\xdc\xd9\xdc9t?i\x9a\nC\xd7D\xa9\\\x14[[;\"\x8e\x04\x8bg\x97BU\x91co\xe8J\xaaaF\xbam\tI\x92\x85\b\x02\xdc\xfd\x9e\xdc\xdf\xdd#MSa\xd9v\x98\xbbI\xe7\xf7%\t\x91\t\xa7\x88j\xb5.\x1c\xdb\x11\xfd\xc1\xbe\x90eEȊ\xe2\xff\x1fU\x90\xd8\xf5\\\xa9\xee\xa5\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_02_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1b\x12gFCe\x00\x00 \x00IDATx\xda\xed\xbdy\xb0d\xd9]\xdf\xf99\xe7\xdc53o\xe6{\xf9\xf6\xaaWU\xad\xae\u07bbխF+\xab\xa4\x01c\xc30,\xe3\xa1\x1d\x0e\x1bOh\x18! \xec \x06C\x043a\x8f\"p\x8cMx\x19\x8fm\x10#c#\xc0@@\x0f`\xa4\x01\x06\x8bE\x18$@\xb2@[K\xdd굺\xf6Wo\xc9=\xef~\xce\xfcqn\xde̬MU\xa5n\x19\xb0\xb3\xe2ū|/_\xe6\xbd\xe7w~\xdb\xf7\xf7\xfd\xfd\x0e\xfc\xd7\xc7_\xecG\x14u\xfe\xeb\"|\x11\x0fu\xa7\x8b\x9ee)Q\xd49\xe5\xfb\xc1\xdf\xf0\xfd\xe0\x11\xdf\x0fD\x96\xa5\x97\xb3,\xbd\xe6u\xff%n\xca;\xbdoq'\x1f6\x1a\r\x88\xa2\xce\u007f\x0f\xfc2`\xaa_\x19`\x04|\x18\xf8\xd1\xd1h\xf0\x9b\xff%Z\x86\xd1h0{\xbe\x02|=\xf0\x97\x80G\xab\xcd\xffn\xe07\x003{\xdd\x17%\x90\x05a|5\xf0\xfba\x10ҎZ\x94EA\x96e\xa4yN^\xe4h\xad\x01\xb1\a\xfc\xe3\xea\x02\x9e\x1f\x8d\x06\xc5\xe2{\xfcE\x12Ă\x10\x8e\x01_\a|\x971\xe6+=\xd7\xc5q=\x1c堍f:\x9d`\x8cy\xf3h4\xf8؍\xd6\xe1N4D\x18c>\xde\b\x1b_\xf6ȃ\x8f\"\x84D)E\x9eg\xe4y\xc6t:\xe1\xe0h\x9f\xc1\xb0\x8f6\x06\x10\xfb\xc0\xaf\x01\xffz4\x1a\xfc\xf1_P\xa1(\xe0\xfb\x8d1\xff\x93R\xea\x01)\fۛ\xc7\xd9X\xdf$\f\x1bx\xaeOQ\x16|\xfa\xb3\x9fd8\x1a|d4\x1a|\xd5+\xe6C|?\xf8\x16\xad\xf5w\xdd{\xcf\xfd^'ZA\b0\xc6T\x82q\b\x82\x90v\xab\x83\x94\x8a\"\xcf\xc0\x94M\x90\x8fk\xcc\x13\xbe\x1f\x04Y\x96\xfe^\xe5\u007f\xfe\\\xfb\x97v{\x854M\x88\xa2\xce\xd7\x00\x1f0\xc6\xfc\xf5F\x18n\xae\xb4#\xee;\xfd\x10\x9b\x9b\xdb\x04A\x03)%\x06\x83R\x8a$\x8d\x19\x8e\x06'=\xcf\xff\xd1,K\xe3뽯s\xbb\x17b\x8cy\xc4QNss}\x8b\xb2,1F\x02\xe5\xe2\xef\x91J\xb1\xb5\xb1\x83\xe3\xb8\xf4\aG\xa4Ɉ\xd28\xed\xbc(\xde\x1dE\x9d\xb7\x02\xdf:\x1a\r\xfeܩH\xa7\xb3\xca`Л=\xf5\xa2\xa8\xf3\xdd\xc0\xbfp]\x97\xd5\xd5U\x1a\x9e\xcf\xf1\xe3\xafAJY\xaf\xc5\xe2\xc3u\xdcZ\x9e\xc0\xe1\xf5>C\u07b6\xb9\xc2l\xfaA`\xed\x9d\x10\ba\xaa\xef\xf3\xaf\x991\\[]g\xbd\xbbI\xb3\xd5\xc5q$\x9e\xab\x90R\xbc\r\xf8X\x14u^\xfb\xe7-L\x9e\tce\xa5\xfb\xa01\xe6\x17\x84\x90\xff\xa2\xd5j\xb2\xb9\xbeF\x146\xd9\xdd==\xbf\xff\xeb<\xa6\xf1\x04c\xcc\x048s\xa3\xd7\xc8ۼ\xa6\xc0\x18\xb3\xd9jFˎ\xa8\xba\x88َ\x98=\xd7\xc6\x10Em6\u05f7iGk\xb8\x8e\x8f\xab\x04J\xa9\xfb\x8c1\xbf\x16E\x9d\x87\xaa \xe1υ\x89\xaa\xb4䛋\xa2\xf8\xa0\xeb8߶\xb6\xb6B\xa7\xd5f\xbd\xbb\xc5\xd6\xd6\t\xb4.o\xf8\xf7y\x9e3\x8d\xa7\x00\u007f0\x1a\r̍\xee\xf9\xb6\x05\x82a\xa7\xd9h\xd6\xc1\xeel\xf1\x85\x10H)\x97\x9e\xcf\xfc\x8b\xeb\xbal\xacm\xb2\xbe\xb6\x8d\xeb\x868\x12\x94\xe3\x9c\x04>\x11E\x9d\xaf\xf8\xb3.\x94(\xea0\x1c\xf6i\xb7W\xbeCk\xfd\xfef\xb3\xb9\xdb]]\xc1W.\xc7vN\x11\x86-\x8c\xd17}\x8f8\x992\x9e\x8c\x01\xdes\xb3\xd7ݮ@B\x839\x16,\x98\xac\xeb\xc6ҋ&\xac\xfa\a\x82v\xab\xcd\xd6\xe6q\\\xd7\xc7s$RJ\x0f\xf8P\x14u\xde\xf8g<\xeaRQ\xd4\xf9\aƘ\x9fi\xb5Z\xb4\x1a\x01Qk\x85\xdd\xdd{\xec\x1a\x98/\xfc\x06\a\x87\adY\xfa2\xf0;7\x8b2\xe5\x1d_\xa2\xb8j\xf1\x11\xd7\bI\x88깰\xff\xd7\xc6\x10\xf8\x01\x9b\xeb\xc7PR\xe1{\xdeL(\xbf\x10E\x9d{\xff,\xf9\x94\xd9uDQ'\x04~\x1c\xf8{\xddn\x97f\x18\xd0]\xd9d\xa5\xbdFY\x16\xb7\xf4^\xba,\xb9p\xe9<B\xc8\xf7\x00\xc9+\xa9!\x85\x80q\x91\xe7\xd7\x15в\xb9\x12\xd5\x0f\xadd\x16M\x98\xef\a\xacw\xb71\xba\xc0\xf7}\xa4\x14w\x03\xbf\x12E\x9dƟ\x05\xf3\xb5\x90\x00+\xe0ׁwnoo#L\xc9J{ݚ\xa8[Q\v@\n\xc9\xde\xfee\xb2,\xbd(\xa5\xfc\xe0h4\xd07\xb3\x06\xb7%\x10!D\nb?+\xf2\xe5\x88\xea&9\xa6՞\xd9\xef\xe7\xda\xd4h\xb4\xe8\xaelbt\x81\xe7\x05\b!\x1e\x01>\x13E\x1d\xf7?\xb7P\xaa\xcf_\x01\x9e\x97R\xbe}{{\x87<\x99\xb2\xb1v\x8c h\u0381\xa2[Y3)x\xe9\xdcKH)?=\x1c\xf6?\xf9\x05\x05x\x9b9H\x01L\x165D,\xec\xfe\xab\xc3\xdf%\x8d\x99\x99\xb0J6\xc6\x18:\xed.\r\xbf\x81@\xe3y\x1eBpwe\xbe\xe4\u007f\x0e\x9f\xb2`\xa6\xee5\xc6|\xd4q\x9c\xbb677\x11X\xcd\xf0\xbc\xe0ZI\xdcD0RJ\xf6\x0f\xae\x90gi\xe98\xce/ފI\xbe]\rɅ\x10\xfdi2\xbd\xb1C\xaf4\xe1ꐸ\xf633G/\x04\xdah\xba\xab[H!PJ\xe1y>\xc0\xb7\x01?\xd2\xe9\xac~ɴ$\x8a:DQGT\x9a\xf1&c\xcc\a<ϻo{{\x1b\xdfs\x918\x84atM\xa2\xf7\x85\xc0'!\x04\x97\xf6.\"\x84\x8c[\xad\xd6\xcf-\x82\x8f\xaf\x88@\x86\xc3~&\xa5ܛN'7\x8c\xacf\x0e\xfcj\rY\xf23b.<\xa9\x14\xed\xd6\nF\x97\x95P<\x01\xfc\xa0\xd6\xfa\x1f\xcdL\u05eb)\x98\x99\xbf\xa8r\x83\xef\x00\xfe\xc8\xf7\xfd\a\xb6\xb7\xb7q]\x8f\xc9hD\xd4Z\xbdF\x11\x8cX\xd0\x10q\xfd\x8d9\x8d\xa7\x8c'#\x80_8\u007f\xfel~K>\xe7vo@Jy\x90e\xd9\xd2n\xb9Yv:s\xeeB^'\x14\x16\xf6\xc2ð\x89\xebX\x14\xc7q\x1c\\\xd75\xc0\x0fEQ\xe7\xc7\x01o\x01M}\xc5\xcc\xd2U\xfeb3\x8a:\xff\x12\xf8\x99F\xa3!\x8f\x1d;\x86\xeb\xba\f\xfb\x87\xacv\xb6l~u\xf5}\x99\x05\r1\u05cfB\x8fz\x87dY\x86\x10\xe2\x1f\xdf\xf2\xfa\xde\xc1=\xf5\xb5\xd6y\x96g\xb5\x93\xbe։/;\xf0\xfa%\xc2.\xb8\xe3\xbaK\xda\xe2:>\r\xbf\x01\x95\x90]\xd7\x15\x9e\xe7\x1aແ\x0fFQ\xe7[\xa3\xa8\xa3\x16\xd5\xfdf\xc2\xd9\xe8\xae\xdc\xd4a/\xbc\xc7\xe9(\xea|\x8f1\xe6\xf7\x1d\xc7\xf9;\xab\xab\xabloo#\xa5d2\x19\x12\x06m{\xad_ȷ^\xe7geYr\xd4;\xc4\x18\xf3\x1f\x87\xc3\xfes\xb3L\xff\v=n\x1b\\\x14B\\\x04\xe2\xe9d⮬x\xb35\\\x86O\x04H!(Kj-@\b\x84\x11\xe8\xb2$jw8<Hk\xa1i\xa3\x89\xa25\x86\xe3\x1e(\x1f08\x8e+\xa4\x90di\xf2V\x8dx#\xf0L\x14u\x9e\x04~v4\x1a\\\xb8\x99-\xde?\xea/.\xfaۀ\xb7\x01\x11\xb0\t4\x81\x861&2\xc6\x1c\aN\xb4\xdbm\xd9\xe9tp]\x17c\fy\x9e!\xb4\xa4\xd1hUH\xb6\xb8\x95\x94\xec\x1a\xa8\xa4?\xe8a0\xff\xd7\xf5\x80\xc6WR C!(\xb2\"C\bQ_\xb01\x06\x83\xa9M\x91\x94\n)U\r\xb1K\x04\x1a\x81Ɛ\xa6\x19\xcdV\x8b\xc9x\\\x99`\xfb7\xed\xa8K\u007f\xdcGJ{YR)\xfc\xb0AY\x14\x8d\xbc(\xbe\xcc\x18\xf3e\xc0\x8fDQg\x00\xfc\x1e\xf0Y%H5\xe2~!\xc4N\xb5YW\xc0\x9cĘ\x15cp\xccuL\xa8R\n\xa5\x14\x8dF\x83(\x8aPJ-\xa1\xd5E\x9a\x12\xfa\x9d\xb992\xb7oK\x8e\xfa\adYvA)\xf5'\xb7S\xff\xb9\x13\xf8}b\xa0\xd0Ey]Gf\x84\x01c\xb3\xd3(j32\x86,ˬѭ\x90\xfa\"\xcfh\xb5Z\xc4\xd3)\xa64\x18a0\xc6\x10E]\xfa\xc3C\x90\xceU\xb1\xbc\xacw\xaf1\x06\xadu\xc7\x18\xf3-\xc0\xb7\x94\xc6\x1a\x8dk}\x9a\xa8\xf3R\xc7q\xf0<\x8f0\fi\xb5Z8\x8e\xad\xe0a\xaeݹ\xba,\x00\x85\x9aA\xe5b\x16\xa6\xc3\x17J\xbbl\xa5\x14VVVx\xf9|\x0e\xf0\xe10\f\x0f\xae\\\xb9|\xcb\xeb{'\x02\x99\n(K\xado\x88\xf8\xce\xcc\xd0d:fks\x8b\xf3\x17\xce\xd9\x1bB \x84\xa4(\v\x84\x94\x84a\xc8x2F\x18(\x8a\x02\xc7qh\x86m\xa6\xe9\x14!\x97w\xed\xd5\x18\xd9\xe2ύ\xb1\x05 +0\x8d\xd6\xf6\x1a\x82 \xa8\xbfl\x9ec5Y\n\x85\xa3\\\x8a2\xa7,˥{\x88'\x13\x9a\x8d\uec83\xb8Z\x18\x8b\xd1U\xf5\xdd`\x98N'\xec\xec\x1c'I\x12^:\xf3\x02\xbe\xe7~\xe6ʕ\xcb\xf1m\x05M\xb7+\x10\xad\xf5\x00D\x96\xe5\xe95\xdbd\xd1\xde\n!(\x8a\x82\xfd\xfd+\xdc}\xf7=\xf5\ue645\xc4GG\x87\x1c?\xbe\x8b\xd6\x1a!$\xa5.ȋ\x9c\xb0\x11\xa1\xcb\xf2\xba¾\x9e-\xd6Z\xe3z\x1e\x8f?\xf6FN\xec\x9eB\n\xc5\xd6\xd66\xa7O\xdf\xcb\xf1\xe3\xbbt:\x1d<ϫ\x17\xb1\xd9h\xe1\xfb\x01\xae\xeb\x11\x06ͅ\xf7\x16\xe4Y\x82D\xa1\x94\xb3\xac\t\xe2&\x8eC\xcc\xc0\xc3+\x9c8q\x8a4Mx\xe1\xa5\xe7\x91RĎR\xcf\xddv\x14{\aQ\xd6\x14A\x99\xa6)\xf2:\x11\xd6\xd5\xcf\xf3\"g\xff\xca\x1eǏ\x9f\xbc&/\xd9ۻ\xccɓw\xa1\x8d\xa6\x114\x98LƸ\x8e\x87T\xb2\x8e]n\xe4P\x95\xa38~\xfc\x04\xf7\x9e\xbe\x1fG*\xf2\"\xc7\xf3}\xee\u007f\xe0!67\xb7\xf0<\x0f\xcf\xf3i\x84\xad\xfa=\x82\xb0\x81\x10\xf2\xba1\x92\x100\x1a\x0eh\xb6\xbas(\xdd,|\xddx\x83r\xd8?\xe0\xe1\a\x1e\xe5\xf0\xf0\x00c\f{\xfb\x97\x90B\x8e<\xc7<\xfb\xaa\vd4\x1a\x8c\x04\xe2\xd2p<\xbcf\xe7,\xe5\x19\v\x8b?\x89\xa7H)h6[\xf5\x96\x93R2\x18\xf6\xf1<\x9ff\xa3\x85\x10\x12)%Zk\x94t\x97\xcc\x11\xc0\xeaj\x97\xd5\xd5.\xc6\x18\xba\xabk<\xfc\xe0c\xec\x1e;E\x10\x86\xacv\xd7(\x8a\x8c\xa8\x15\xe1\xb9\x1e\x8erq*\x1f \x04\xb8\x8eg/uA\xbbJ]\x92\x17Y- c\f\x18AY\x05+\xb7\x1a\xeb\xa6Y©ݻ\xb8\xbcw\t\xa5\x14q\x1c\x93\xa6)R\x92\x84\xcdh\xefU\x15\xc8,\xf6\x17B|4M\x13\x924\xb9Q\x9az\xb5\xe3\xe1\xe0\xe0\n\xbb\xc7NԸ\x17\x80R\x0e\x17/\x9egk{\x1bm\f\xedV\x9b\xf1t\x88\xef\xf90w\xe0lm\x1d\xe3\xf4k\xeeCk\xcd\xf6\xd6\x0e'OںuQ渮\xc7\xf1c\xbb\x84a\x13)%R*\x84\x00%U\xbdA\x8c\xd1hcHҘ8\x99\x10\xc7\x13\x92d\x8a\xa3\\\\\u05ef\x85\x1eu:L\xd3\xd1\x1cV_0K\xe6ji\bp\\\a\x10\x8c\xc7\xe3\xba87\xb2\x999\x02\x11?\xff\xe2\x99K\xaf\xaa@\x16B\xb7?5\xc6\x10\xc7Sn\xb4\x99fZ2\xfb\xca\xf3\x9c\xf3\x17\xce\xf1\xe0\x03\x0f\xa3˲\x02\x1b\xedϓ$&jGH\xa5P\xd2AJ\xa76\x1bRJ\xc2 \xe0\xd9\xe7\x9ff:\x9d\xb2\xbd\xb3[-\xbc\xac\x84\xaa\xc0\b\xa4P(\xe9\xe2\xb9>Jy\xe4\x85E\x13\xb41\xb8\x9e\x8b\xe38\x18c\x13\xb6R\x97\x18c\x88\x93\tY\x96\xd4\x1a\xe1\xb8\x1e~\x18\x90eɵu\x9f\xa5\xfd%8\xb1{\x8a\xf3\xe7\xcf\xd2\b\x9bK\xf7\x1cO\xc6V\xc0R\x8e\xee\x04I\xb8\xd3\x02\xd5'mY2\xbe\xaez\v!\b\u0090 \b\xab\x850U\xf6;\xa6?\xe8q\xe2\xe4],\"\x0fi\x92\x12\xfa!B\b\x9a\x8d\x16Y\x9e-\x99\x97\x97μ\xc0h4bg\xfb8\xdeB\xe6,\x84@I\a\xa5\x9cz\x87\x1a\xa3+a˅\xd7I</ \f\x83ʡ\xbbH\xa9\xea0z\xc97)E\xa9\xf3\xa5Ͽ\x1a\xc1\xbd\xfb5w\xf3я\u007f\x84\x9d\x9d]\x8c\xd6K\xd2J\xe6yW\xed\xd0\xdf\xf7\xde'W\xde\xf7\xde'\x9b\xaf\x9a@F\xa3\xc1g\x8d1\xf9p8\xa8+\x85W;\xf6\xa2\xc8\xf1\\\x8fՕ.\xab+]\xca҂\x87\x97.]\xc0u\x1cVW\xbauB8\x1a\x8fX]\xe9\"\xa5D9\xaa*\x8b\xce#\xb60\bi6\x1a\xc4\xf1\xb4^@)%J:\xd7l\b\xad\xb5-\x1e\x19Scff!W\xb1\x89\xa1S9}\xaf\x16\xce|\xc1\x15BA^dˎ\xbdz\xec\x1e?\xc9\xe7\x9f\xfd\x1c[\x1b;Ur2\xff\xbd6\x9a4K\xad\xf6ţ\x8f\x9f}v\xb4\xfe\xbe\xf7>\xf9\x8d\xc0\x1b*\x84\xe0\x95\x17H\x10\x84\xb3\xff\xfe\xfb\xd1xD\x9a_\x9f\xecV\x96%\xa3\xf1\x90\xa3\xde!\x8e밳}\f\x83\xe5l]\xba|\x91\xf5\xf5\r\x1c\xd7.hY\x16\xf4\x87G\x80\xc6Q.\xbe\x1f\xd4\x1b4\b\x02\xee\xba\xeb4\xa7N\x9dfcc\xd3\xe6\x1c\xd2A\nu\x9d\x1c\xc9\nc\x96\x8f\\\x83D#j\xad\xb0\x82\x15H\xa9\x962u\x1b\x8d5\x19\x8d\x0e\xf0\xfd\xb0Vc\xad5;\xdb\xc7\xe8\x0f\x8f\xc8\xf2\x1cUi\xd8\"\xda[\x16\x05q<\xc5\xf3|\xbe绾\xff\r\x1f\xfd؇_\a\xfc'\xe0\x0f\xde\xf1\xae'\xae\xbc\xef\xbdO\xdeje\xfcNP\xd3\xf678\x8e\xfb\x1b\x0f?\xf0(\xed\xa8}\xdd\\Ak]\xdd<lnl\x11'Sz\xbd#t\xa9Y[\xdb\xc0\xf3=^z\xe9y\xce_|\x91\xa2\xd4|\xd5W\xbc\x9d\x8f|\xf8wY[\xdb\xe6\xb0w\x05\xa4 \x8a:\xec\x1e?U/\xaau\xd6\xf2\x06\x9b\xa0\xc0`\xb1\xa8\xa2\xc8\xe7\x91\x1a\xba\x0e\x10n\x84)\x95eIQ\x14\xf5v\u05fa\xa4\xcc\n\x04\x12c\xe0\xf8\U0005373e\xfb^~\xf3\xb7~\x8dS'^\x83\x14\x12!gL\x1b\x89\xac\xd0l\xe5:H!x\uaa4f}\xfb\x99s\x17~\xe9K\xe1C\x04p|2\x19\xbf\x90\xa4\xc9\xe4\xb0\xe2\xf1\x8e&#&\x931EQ\xd8E\xab\x9c\xba\x94\x924\x8dٻr\x89V+bks\x1b$\\ٿ\x8c\xefy\bQR\xe8\x12\xa9\x1c\xa6\xf1\x94Ս\r\x0e\x8e.1\x1e\x0f\xe9v\xd7i\xb7W\x88\xab\x82\x98\x147\x16\xc6\xe2N\xbe1%\xe7\xc6\tEU\x8b\xa93\xfe\xf5\xb5-\x94\xe7\xa0EI\xaeS\xa2\xa8\xc9\xcf\xfc\xfc\xbfFIC\x96%Hi\xf9\xcceQ\x10\x06!\xdb[;\xdcu\xean\x9e\u007f\xfe\xf3\xfc\xf6\xef\xfe\u007f\xf4\xfb\xbd\xf1\x9dltu\x87\n2\xda\xd8\xd8\xe9\x15y\xfe\xfa \b\x1f\xdc\xdeܩm\xe8x2\xa678\x02\x01a\xd0\xe0\xec\xd9\xe7\xd8?\xdc#\xcf\x12\xb4\xb6\xfe`ss\x9b\xe7\x9e\xfb,O?\xfb\x19\xee\xbb\xefa\xf2J\xd5\xfb\xfdC\x1ey\xf8q.\xed]D9n\x05\x00\xb6h5Z`@J\xb1\x00\xf9_\x8b\x12\xe4EFY\xe6h=7\xfcքUz\xa2\xf5M\xf7\x99\xdd@\x16\xc7\xf2}\x9f$\x89q\\\x97\xd7=\xfa\x06>\xf3\xd9O\xa1\\\x87\xb0\x11\xd2\xef\x1d2\x19\xf7\xd9?\xb8\xc4\xe9\xd3\xf7\xb2\xb3\xbd\xcbx<\xe2\xe5\xb3/r\xe6\xec\v\b!\xf0}\xf7\x1fN\xa6\xc9\xc1\x97J \xb3\xb03L\xb2\xe4[O\xedޅ\xe7\xda\xcc8\f\x1bD\xad6\x93\xe9\x84\x17_z\x96\xc1\xb8\x8f\xe3zL\xe31\xf1tL\x9a\xa5\\ٿ\xc4\xfa\xe6\x16G\xfd#\x0e\x8e\xaep\xdf=\x0frxt@\x96\xe7t\xbb]<\xcf\xc3\xf7=&\x931\xdd\xee:\xc6\xe8\xca\xd6[H\xc3bP\x855\xdfR \x90\xe4EJ\x9eg\xcb>b!d\xb5&\xcb\xdc\x00\x9f\xd3\xe8*\x146F\xd3l4\xac\xc9*K:\xed\x15VV\xd6y\xf9\xec\x19\x1e\u007f\xf4ML&C\x1c\xcf%\xc9R\xde\xf65\u007f\x99\xcf?\xff4\x9f\xff\xfc\xa7i4\">\xfb\xf4'\x98\xc41RJN\x1e_\xfb\xbb\x97\xf7{\xe5\x97L Y\x96\xe2\xfb\x81\x9b\xe7\xf9\x13\x1b\xeb\x9bA\xe0\aK\x0e\xd4\xf7\x03F\xa3\x1ei\x9e3\x9dNP\xd2!+s\xfa\xfdC&ɔ\x95N\x97N{\x95\xfe\x8c/\xdbYeTe\xff[\x1b;L\xe31Y\x96\x11\x04!\x81\xdf\xc4\xf7B\x84\xb0\xf6\xdaq\\\x8a\"'I\xa7\x14U?J\x9egs\xad\xb8\x8e\x9f\xd0F/e\xff\xb30\xb9,\vN\x1c\xbb\x87\xd0o2\x18\x1eщ\xd6x\xe3\xeb\xde\xc6\xcb\xe7_ \xcbR^s\xd7=<\xf3\xf9ϱ\xde\xdd\xc4\xf3|\xc6\xd3\x01\xebk\x1b|\xf9\x9b\xbe\x9a\x8f\xfe\xc9\x1frxx\xc0x:\xe6\xb9\xe7>\xcbp4F9.\x02\xf4\xd9\v{O\x02\x8f\x01\xaf\x05\x9e\xbbU\x9eʝ\x13\xe5\xec\xe3\xa2\x10\xe2L\xafw\xb4\x14\xfe\n\x04\xbd\xfe\x01\xa5֬\xaetY_\xdb\xc0TE\x9bB\xdb\xc8\xe4\xe5s/\xe2\xf9>\x8f\xbd\xf6q\xb2<\xe5\xf8\xb1]\xd6\xd7\xd7\xeb\x1d\xad\x94C\xbb\xdd&K3\xf2\"]*^\x1bc\b\xc3&J9\x950R\xb4.\xd1Z_\xd7,\x19c(\xcb\x12\xadK\x8a\"'\xcfS\x9aa\x9b͵c\x14E\x81.K\xa6\xf1\x04!\xc0q]^:\xf7\fY\x96\xb2\xbdy\x8c\xc9d\xcc\xc5K\xe7\x98&#.\\>\xc3\xc9\x13w\xd3i\xaf\xf2\x91?\xfe=\x94\x924\x1a!B(\xbc\xa0i#d\xa31p\x04\x9c\a~\x1bx?\xa0_u\r\x01\xf0\xfd`\n|\xa36\xfa\xbe\x13\xc7O\xd6\xc8m\x9a'<\xff\xe2\xd3P\x85\x86Ib\xd5\xd8\xf3|\x94\x92L\xa7\x93\xca\xe4\x19\x8a\xa2\xe0ر\x13\\\xbct\x8e\xfb\xef}\x883g_\xa4\xdd^!\xa9\x1cy\x99k\f%yQ\xe0T\x0e\xb7\xd4%I2!/2[\xd7Xb\xbcP\xfb\x8e\xba\xe0T\x16\xb5&͢\xbe8\x990\x89mV\x9d\x97\x19;[\xa7\x18O\x87tZ]\x8em\x9dd\xb3{\x9c\x87\x1e|\x8c^oD^$\x14eƣ\x8f\xbc\x9e\x8b\x97\xce\x03\x8a\x95\xce\x1a/\x9cy\x96\xf1x\x8cR\x12\xd7\xf5к\x9ci\xf03i\x9a\xfc\xcb/\xa5S\x9f\xb15L\x10\x84\x8f\x17E\xf1\x15\xed\xa8-\x10\x90$S\x9e~\xfa\x13\b呥)q<\xa5(\n\xfb\x95e\xb8\xaeG\x104HӔ~\xbf\x87\xe7\xb9dYJ;\xea\x90e\t\xeb\xddM\xc2 \xe2\x81{\x1eckm\x97\xa2(\xe9\xaen\x12\x06M\xd2,a4>\"\xcdl\x82\xb8\xd2Y\xc7w\x03\xd2,Y\xf2\x19Kտ\"\xc7\x18\xbdT\xf7h6\"\xde\xfc\xf8\u007f\xc3$\x1e3\x8dG\xf8^\xc8zw\x87c\x9b'\xd9\xd9:\x85\xa3\x1cVV\xbaL&\x13F\xc3!\xbb;w\xf1Ѓ\x8f\xf0\xb1\x8f\u007f\x988\x99\x92Lc\xf6\x0e.\x13\xf8~\x95\xdcڰ7KS\xb4\x01\xa5Գi\x9a\xfcԝ\xac\xabs\xa7\x02Y\xc0\xb5ΖeY~\xf23\u007f\xe2\x04\xbeG\x9e\xa5H\xc7C\x14\x16#\x8a\xa2\x88V+b0\xe83\x9dN\x99N\xa7\x84aH\xbb\xddFkÕ+W\x18\x85#\x94r\xe8\xaen\xf0\xe8Cod4\x9a\x10E-\xfa倍\xb5\x1d\xa4\x14\x84a\x83$M\x98\xc4\x03V\xda\x1b<\xfdܟ\xda\\@I\u00a0\xc9x2\xacp\xb59?Gkk\xaa\x16\x85\xa1\xb5枻\x1e\xa6\x11\xb6\xe8\xb4V9:ڣ\xd9l\xe3\xba.\x8d0\x02\fe\xa9q\x1cI\x9afL\xe2!\xa7O\xdf\xcbKg\x9e'Nc&\xe31\xcdf\x93f\xb3I\x9a\xa6U\xf6_\xd5S\x8a|\x16xdw\xba\xae\xce\x17c\xb2\xa2\xa8#\x8d1o\xf7<\xcf\xe9v\xbb\xf4\xfb=\x84r-\xdfJ*\xba\xdd.\xadV\x8b4M\xc9+\xb6\xa3뺸\x8e\xcdƕ\x92lmn3\x18\xf6\xb8|\xf9\x12ǷOS\x14\x16ވ\xe3\x04\xb7\xce\xe4K[g\x17ַ\fF\x87dYB\x9egx\x9e\x8f\xe3X-\xbb^\xadc\x86\xdc\xce`\x13!\x04g/<\xcf`\xd4\xe3\xd2\xde\xcblo\x9e`uu\x13\xdf\v1X\x01:\x8e\xc3t\x9aP\x96\x05R:\\\xb8x\x9e\xcbW.\x90g9\x9e\x17\x90\xa6)q\x1cW\xa6/\xae\"2M\x96e\xf8\xbe\xc2\x18s\xd7\x1dG\xaf_\xa4S\x0f\x81\x15\xdf\xf7\x19\x8d\x86\xe4y\x81\xac\x18&kk]\xa2(BJ\xc9p8\xa0(\x8a\xbaWDHQ\xddD\xc1kN>Hwe\x83\x8d\x8d-\xf2\xbc\xe0O?\xf5\xc7\xe4YV\xd5\x14\x14\xcaq\xac?\x10\x86\xb2((ʜ8\x99`\x04\xec\x1f^b0<\xa2\xd7;\xa8r\x93yE\xc9:\xf2\x02)\x05Q\xab\x8d\xef\xfb\xf5E\xb7Zm\xfa\xc3\x03\xb2<ag\xeb$\xdd\xceFM]\xb2\xac\x93\x9c,\xcb\x18OF\xac\xafm\xa1\x8d\xe6\xb0w\x99\xee\xea:\xaf}\xe8\xf5\xbc\xf6\xe17\xd0l\xb6j\xcc-\x89\xa74\x1a\x8dJ+K\xb2,=]Q\x98\xb6\xbf\xa4\x1a\"\x84\xd80\xc6l\x97eA\x1c\xc78\xca\xc1\x00\xeb\xdd5\xa2\xa8\xcd\f\xa2\x9fN\xa7\xcb\xd9sE\x82p݀O\u007f\ue3f8\xfb\xd4C\x8c\xc6=6ַ\xd8\xda8\xc64\x9e\xd0j\xb5\xc9s\vP&I\xcc\xe1\xd1\x15\xb4.h\xb7V\x99\xc8a\x95[\xc0x2\xac\x1d\xbar\x1c\x94\x94\x14EA\x96'\x18\x03\x81o\xeb$\x9e\xeb\x93\xe7\x05\xcaQUN\xe3\xe28\x1e\xed\xd6*\xa5\u058c'\x03\f\x86\xc0\xb39H^\xe4\f\x06\x87L\x92\x11\xbd\xc1>\xae\xe7R\x14\x19y\x9e\xd2\x1f\x1c\xb1\xbfw\x91\xc9dB\x92Y\xcd\xcf\xf6\xf7\xea\xb0\xdc\xf3<\xe9\xba\xdeo\x8c\xc7\xc37T\x9b\xfeO\x80K\xb7\x12m}Q\x021\xc6\xdc%\x84\xb8'M3\xdbmj\f\x9d\xce\n\xedv\xbbv\xa8\x83\xc1\x00\xc7qPJ\xd56\x17\xc0hS\x91\x13\x1cN\xedދ\xd6%\x17\xf6^\xc4u\x02\\ǣ?\xc8\x11R\x92\xe5)G\xbd=\xa4\x12\x9c8v\x9aVs\x05c\f[\x1b\xbb\xd6\xc1&S\x92$\xc6`p\x94\xcd\xee\xa5T\x94\xb3\x10\xb7ȑR\xd8(\xcdu\x11B\xb2wp\x91\xad\xf5c\xc4ɘ\xa3\xfe\x15\f\xb0\u007ft\x99a\xff\x80\xfd\xa3}.\\\xdeg\xad\x95\xb3\xb9\xaai\xb7B\xe2\xc9*^U\u007f?w\xe9E\x0e\xaf\xecq\xd8\xeb#\x84 \fC\x1aA\x80\x94\x92i\x1c\x13')\xe0!\xa5\xfc\xab\xc0?\xaf\x04\xf2\b0\x01\xfa\xaf\x9a@\xa2\xa8#\x807\n!<c4B\b\\ϥ\xddi\u05cbn\xed\xac\x15\x92\x946܍\xe3\xd8\xc2ޞ\x8b\x94\x92\a\xef}\x9c\x8f\u007f\xfa\xf7q\x94K\xbb\xb5¹\v\xcf\x10'\tE\x96U9E\x89A\xd0nw\x00\xc9j{\x8d\xac\xc8p\x94K\xd4\xec \xabDqV\x81\xac+\x8dFS\x96\xb9ś\xca\x02\xc7\xf1\x90BR\x1a\xcd\xfdw\xbd\x96\x8d\xb5c\xb8\x8e\xcf\xe7\x9e\xfb\x04Z\x17\x1c\x1e\\\xe6p0a\xd5+\xf9ѿ\xd3\xe2\xab_\xbf³\xcf]\"\xcd{|\xf8\xa9\x11\xbf\xfc\x91\x0e\xadΦ%h\xf4\xfbș0\xc2p\xa9L\x90\xcei\xb6\u007f\xa5\x12\x88\x06>\xfd\xaa\x9a\xac\xaa\x94\xab\x80\xaf\xa8\xb3cmh4\x9a\xb8\x8eki@ZS\x96\x9aF\xa3Yg\xc5J9\x94e\xc9d2\xc1\xf5<\x90\x92\xa2șL\x06\b!\xb9t\xf1\fE\xdag{U\xb3\xb1\xa5H\xb2\x94ý\x82BI\x06\xfbG\x8cGC\xb66\x8f\xb1\xbe\xb63'.\xf8a\xed\xb0\x1dǩ\xe1\x13G\xb9H\xa1\xd0FW\x9aa\xeb\xb0\u0080\xeb\xdapu2\x1d\xf2\xc6\xc7\xdeʇ\xff\xe878\xe8\ry,*\xe8\x8cc\xf4˂{\xbe1d+Z\xe7\x13\xcf\\\xe1\xcb\x1f*\xf8\x95ߏ9\xf3\xe2s\x94@Q\x94\x04A@\xb3Ѹ\n\xa0\x94D\xad\x16i\x96\x03\xe2\xe4\x97,\x0f\xa9`\x13\x1f\xf8\x11\xa0-\x84%\xb3\xad\xaf\xaf\xe3.T\xf4\x94\x945\xa1ɖk\xad\xb3\xb6xTA\x9a$\x9c\xbb\xf0\x12Y\x9a\x92M\x0e\xd8Y\xe9\xf1\xd8邷\xbd\xedk\xf8\xfao\xff_y\xf4\x817\xf1\xf1\xff\xe7\xd7y\xc3݆\xaf}k\x8b\xa3ސ\v\a\x05\xab+\x1b5\xae\xa5\x94SaQ\xdaf\xeeFcDE\x86\x96\x02\xa5ܥڸ\xdd\xe1{\\9\xba\xc0\xc1\xe1e\xc6\xe3\x01O\u007f\xfe)~\xe8\xaf\v~\xf8\x9f\xfd8\u007f\xf8r\xc9\a?\xf0i\xde\xf6\x96\x06;;>\xfb\xbd\x98\xd0\xcb\xf8\xf0S\x0e\xe7\x0fr\x04\x02\xdf\xf3\x88Z\xad\xebVJ\x1d\xd7%\xb7$\xc23Y\x96\xfeī\x1ee-\x90\x9c\x1f\x01\x8e\xcf\"\x1a\xcfu\x99\xe1Y\xb30\xd3q-j:\xa3\v\xcd\xc0\xbdEL\xa9,\v\x8e\x8ez<\xf6\x9a)\u007f\xed\xad)\xef\xfa\xd6\x0e?\xf8\xf7\xfe>\xdf\xfc\xad\u007f\x8d'\xde\xf9}\xb8\x9bǹ\xabS\xf2C\xdf\xd1\xe0\xc7\xfen\x9b7\x9e>\xe2\xfc\xc53\xf5\xdfk\xadi\xb7\xbbltw*\xff!q\xa4\x8b\xeb\xf9x\xae_}\xfe\xfc65\x86\x9d\xadS<r\xff\x9b\xe9\xb4\xd7x\xf6\xd9O\xf2\xfa\a|\xbe\xef\xdb;\x9cz\xc3\xff\xc0?\xfaW\xefa\xaf\x14\xbc\xffWz\bW\xb0\xd6\t\xc9K8\xb6^\xa2\x94K\xabպJ3\xe6Q]\x96瘊Q\x03\xec\xb6\xdb+\xf7~)\xc3\xde\xff}\xb6+\x8c1D\xed\xf6U\xbc+Q\u05fa\xa9^\x13\x06\x01\xedv\xa7\u009f\xf2\xbadj\x8c\xe4\xf4\xb1\x92S\xdb%[\x9d\x12\xa6\x96z\xf9\xf4'\xfe\x84s\xe7γ\xb1\x1eP\xe6\x86{v$\xdf\xf3\xcd\x19n\xf9\x02\xfb\x87\x97+\xfe\xb0d%Zgue\x83v\xab\x8b\x92\n)m!˭\x9c\xf8LCf\xec\xc9\"\xcfi\x86M\x94\x94\xe4E\xc6_~\x93K\xb4\xea\xc1'\xbe\x8e\xdf\xfc\xe1o\xa0a\f\xbf\xf5{S\x92\xb1FV\u007f\xdb\x1b\x19\xa2V\v\xa5\xe6\xad\xdfR98^\x80\xe3\x06\xb8^H\xa9\rGG\x87\x94e\x89\x10\xe2pm%:\xf3\xaa\bd\x91\xfa_\xf5S\xbc\x13\xf8&\xb1@fk5-S|\x96?̘!E^ԯ\x91\xd5ʹZ-\x8a\xa2\x98g\xd1\x02.\x1e\xda\xe2S\xc3\a\xce\xfe\x10\xff\U00047fdco\xfa\xb27\xb0\x16\xc0\x03\x0f\x86(ik\xe3-_\xf3\xf5\xaf\x1brԳ\xa5\x86\xa8\xb5R\x97`\x1d\xe5\"\xa86@U\a\xa9&\x13-\x95G/\xed\x9f\xe5w\xff\xf0\xfd\\\xba\xf42\x9e#x`\xa7Ǥ\x97\xc3\xf82O\xfc\xd5#\xbe\xe9\xf5.\x12HRM\x9a\x17\x8c\xa6pf\xcfA`*Z\xa9@\xb9\x1e\x8e룔\x83\x90\x12\xa9$Q\xd4!\xacX(Ƙ\x1fx\xe9\xec\xb9|}m\xfd\x95\xcbC\xae3\x03\xea-\xc0\xdf\x02\xbe\xdbq\x14\xa6\xcc)\x8d\x985\xd9\x10'Im\xb6\x1cǩ\t\as.\xaeu|Rx\x04A\xc8h4\xacʲ\x82Ͻ쀉\xc92\r\x14\x1c\x9c{\n\xe9\xc1\xc3ے\xd7\xdc\x1b\x82\x14\xf4\a\t\x938'\xc9\x14\xd3ɐ\xa2\x8a\xb6,\xc1\xce \xa4`\xbd\xbbM\x9a%\f\xc7=JY\xe0*\x170\xe4\x05\x15EՒ\x1f\xb4\xb6\x14\xa1\x86o\b\xfd\x8c\xcf<\u007f\xc0\xbd'WY[k\xf0O\xffϓ\\8\x9b\xe0\xf9\x86\xfe(\xe63/*\xd2\xc2&\xb4R\b\x1cϯ\x88\x11\x16\x81\xce\xf3\x9c\xa2(\bÐf\xb3\xc9p8\x04\xb8\x1f\xf8\xcd4\xcb_9\xa7nk\x1a\x03\xa2\xa8\xf3\x98\xef\a\xff\xd6\x18\xf3\x03J\xa9\xb7\x06A 0\x05ei/\xa8\x116\xf0\x83\x90<\xcfi4\x1a5g\ncq\xa1\x99\x8du]\x87v{\x05#,! I-\xac\xae\x94\xe4h(x\xc3}%+\xad\x82ݭ6\xa3\x9e\xe6s\x1f\x9a\xf0\x9d߹\xca\xe3on\x811\xbc|q\xc8\xf9\x83\x98\xf7\u007f\xc4\xe3\xf2\x11\x04\x8d\x06\x9e\x17R\x14\x19\x93xdC\xe7h\x850hR\x969F[\xa4wv=\xe5l\xf4E\xe5{\x8a\"c\xd0\x1f3I\x05\xf7\x1cO\xd9?\x8a\xc9\xf3\x12\xad\r\x89Iy\xe1\xdc\x11\x9fz\xa1\xe4'\xffC\xc88\xf1\x10\x18\\?\xb4ZQ\x93\xf0\f\x8e\xe3\x00\x86\xe1pPGy \x1e\xf0\xfd\xe0\x03\xa3Ѡ\xff\x8a\t\xa4\x1a\xa3t/\xf0\xabB\x88\xb7\x04A\xd0\f\x82\x10\x81m1\xd0F \xa5\xa0Պ(\n\xdbs\xbe\xe4\xd8\xe7\xf5\x01\x841\xb4ZQ\xed\xe8\xcbR\x13Ǔ\n\xa7\x12\x04\xae\xe4\xf7?-y\xf8TF\xe4\x17<\xfaX\x87\xff\xf6\xdb\xda<\xfcx\x93\xb20\xfc\xf1g.q~ȯ>\xe5\xf1\xdb\u007f\xea\xd1\xe9nrl\xe7$\x9e뒤SJ\x9d\xd3\b\xa3:'\x91R\x11\xa7\x13;˫̑RճHL\x05\xfb\xbb\x9eG^\xe4<}F#1ܻ\x9bs8H\xb8\xb0?\xe6p\x10\xf3\xf3\xbf\xe3\xf1\xaf\xfe\xbd\xcf4UHap\x83\x86\x8dڠJ~\xa9\x88\x11T='\x0e\xd3i<\x13\xd6\n |?\xf8\xad,K\xcd\x17-\x90\xd9<+\xdf\x0f\x8e\x1bc\xbe\xb3\xd1hD\xae\xeb\x01\x02]d\x14\x15\x82\xea8\x0e\x9eo3\xd5f\xb3\xb98\x82\xa8\xd6\f]\x968\x8eK\x10\x04Ux:\xabkh\xe2x\x8a\xab\x14\x8e\xa3\xd0%\xfc\xee'\x14\a\x83\x94\xc0-q=\xc5\v\xe7G|\xf8\x13{\x9c\xdf/\xf8\xb5\x8f\x06\xfc\xe2\xef\x05\xac\xae\xad\xb1\xb3s\x92\x87\xef{\x13\xae\xe3\x93\xe5I\x1d\xc5y\xae\xed\xc0J\xf3\x94,K\xecBIU\x87ƥ.\x91\x15\\\xee\xfb>\xdd\xee\x06E\x9e\xf1\xd1ϕ|\xf8)\x87\x97\xf7\x1c>{\xc6\xe1=\xefo\xf0\xa9\x97<<W\xa1\\\x0f\xd7k\xe08.\x9e\xe7\xd2l\xb6\x90U\xcfʌY?k\x89\x90RP\x1433%\x1e\x05>\x98e\xe9\x85[\x9d\x0f&nR\xeb \x8a:\x0f\x01\xef2\xc6\xfc\xcd0\f\xbb\xbe\xe7c\x80\xb2\xccI\xa6c4\x8aV+\xc2\xf7}\x94RtW\xbbss5#\x1e\xe4\xb6\xfe\xad\xa4\xa2Y\xc5\xeeB\b\x92$f<\x193\x1c\x8ep\x94\xc0\xf3<\xc6\xc3\x1ei\x9a\x92\x15\x12\xcf)Ymi\x1a\xbe-z]\xe9+\xa6\xa9dm\xad\xc3\xe6\xd6.ǶO\xd0n\xadՔQ\x81`\x9aL*\xf2\x9cݹ\xab+댧C\xd2,!\xcb\x12\xd2,a\x12\x8f\xa1*\xe1:U0P\x14\x05\x17.\x9c!\x9eƔ\xa5\xc6\x18\x81\xe7Y`S\b\xfb\xddu]:\xed\x0e\xcdf\x938\x89m\x13\xd2B\xfeQ\x96e\rJN\xa7Sb;\xf9\acx\xba,\xcb\xd7O\xa7\xe3\xf8\x8e\x9c\xfa\x820\\\xe0w\x04b\xdbq\xed\x05\xcd:\x92*b`m~\xe6*\xab\x96\xebٕ\xb8e\x95\x14\xc6qL\xa3Ѩ\xa31c\f\xedv\x9bV\xcbN\x17\xf2\xfd\x80Ѱ\xcfd\xd4\a\xa1\x18\xc6.\x83\xa9\xfd0\xcf\xf7\xd9Y_eue\x9d\x8d5\v\xa2&i\\-\xac\x87\xc1؈KJʲ`0\xea3\x99\x8e0Z#\x10\xb6\x0e_\x16\xb6Q\xa7\xc8\xe6\x93;+\u007f\"\xa5Cgu\r\xa3\xcb\xea\xba%\x8dF\x930\b)\xb5\x9d)\xd9jE6 0\xf3^\x17;\xc4\r<\xcf\xc3q\x1c|\xdf\xc7q\x1cʲ M3\x80\a\xc30x\xcbt:\xfe\xd0m\vd&\x8cv{\x05c̯\v!\xb6g\x10\xfab\x17\x93.3J#h\x06sH[V\xbd \x8er\xae\x81\x13\n\xa3\x11R\x12\xc7Ӫ\x9c\xab(u\x81\x94\x8aV\xa3ia\x14\x03\xae\xeb\xe1\xb8.\xd1\xca*E\x96\x92\xa6)J)|?\xc4\xf3\x03V;]\xda\xd1J\x85\x02؎(\xcb`\xf7*\xde\x16h\x03\x81\x17\xdaivã\xbal+\x85\x05\x1d]ǥ(R\x8c֔B\xa0*\xd3\x13\x84M\xdb\xd2P\xe5I\x9e\xe7Ѩ\xc6.\t\x11P\x96C\x86\xa3A\xad\r\x00yn\x05uxx\x80\x10\xd0l\xb6h6\x9b\x95\x19\x13\x18cR)\xe5w\x1f\x1e\x1e|hmm\x9d\xc3Ã;Ґ\x861\xe6ǀ\xbf\x14\x86\xa1m\x9e1\xcc\xeb\r\x96{\x83\x92\x06\xcf\xf3\xeb(CW\xbb\xdei8KI\xac\x94\nו@V\xfb\x0f\xcf\xf3HS\xfbwf\x81\xbf\xe68\xb6t\xaa\xb5\xa6,\nt\x85Q\xb9ʡ\xd1h\xe1\xb9\x1e\x18\x83R\x1eJ9(\xa9p\x1c\x17)\x85\xad\x1e\n\x89\xaa\xc8y\xa1j\xa1\xa4\xc38\x1e\x91$\xd3J(\x12\xe58\xb5\x83/˂\xb2\xb4\x95\x90\xd5\xd5.i\x9aT\xf6_`\xb4\xadm\xcc7\xa3\xbdH߳\x85\xb5j\x18\x19R\n\xda\xed6\xc3\xe1\x90\xc3\xc3\x03z\xbd#\x82 $I2\x84\x10\xef\x19\x0e\xfb?\xd5鬆\xa3\x1a\xff\x00\x00\x19\x06IDATޒ0\xaeq\xea\x95\x13\xffv!\xc4?\xe8v\xbb\x95\x93v\xaaڃM\xb0\x84\xb4\x91|\x91%\x04M\xeb\x13\xc20\xb0\x8cE\xe5\xd4U\xbe\xe5\xf0\xd9V\xf5,D\xee\xd0l6\xed\x8d\v\x1b\xa5ɪ\x97C)U\xb3\x1d\x1d\xc7V\x16]\u05ed0\xaa\x12\xa3\xb5%\xd09\x0eJ:\xb8\x8em̱°S!l(Z\xe1J\x8e\x8b\xe7xv\xe1+\x01[!\u4db9S\xccm\xeb\xac\x14\xe08NUװ\x1a\x9f\xe79i\x96P\x96%\xadf\xab\xd6F\xd7\xf5HӴ\xee\x95l4\x9a\xd5\x1aZ\xfe@\xb5\x06\xcf\xfb~\xf0\x1b\xc3a\xbf\xbc\xedL}\x96\x04\x1ac\xde\xd9l6i\xb7\xdbU\xb3\xa4?\xcb\xc7\x17zA4\xda@\x91\x175\xc5\xc6\x18\xaa\x8a^\xb90Zc\x01f\x90\x92f\xa3\t\x82\xba\x82(\xb0\xa0\xe4b\xbd{\xa6-\x06Ss\xa9\x16\xbfc\xe6\xec\x129\xe3\xf9V\r5\xc2\xcc髳\xf7\x93R\xd1i\xaf\xe1\xb8V\xa3\xacyU躡\xcaT\b\xb9\xfd\x9a\xa1\xc6R\xd9BW\x9egx\xae\xddP\x8e\xe3.\xb1\xefg\x1b`\xf67\xeb\xeb\x1b4\x1a!J\xd5\xd7p/\xb6?\xfe֡\x93\xc5l<\x8a:\x91\x10\xe2mA\x10\xd4\v\x99\xa4\xa9\xadK\x8b\x1a.\x05]ڞ\xf3\xb2$\xcf3\x0e\x0e\xf6\x91R\x92\xe5\xb6\xfe\x90\xe5y\x1d\x16_M\\k\x04\x8d\x1a\xdaX\\\xbc\x99s\x9d\t\xc5T\xfc\xad\xe5\n\xb9\xa9\x84l\xb3d\xeb7lˁ\xac1\xabk\a\xdfH!\b\xfd\x96-\a\x1b\x83\x90\xb2\x16\xaa\x85W\fyQT\xec\xc5*\x9b\x17v绮_A>\x90\xa6I͊\xb4\x9bP3\x99L\xd8ۻ\xccx<f:\x9dV8\x96D)IE\x94\xbb\xady \xce\x02,r\x17\xf0\xdd։\xfa\x15\xd1 \xa6\xc8s\x1cǝS7\x01\xe9\xb8H\xa6dyJQ\x14\xb4\xa3\xce\fP\xabX\x82\x06S\xe6u3\x8b\xa3\x9cz\x9d\xb4фA\xc0h\x9cۨǸK\xc1\xb75\x8dj\xae\x89z\xd66`}Y\xa1\v\\\fZ[\xb6\xbb\xebZ4Y\bY\xf7\x16\xe6\xb5ɨ\x88\xd7F\xd8ICӹ&Z\x13+mkM\xd5\xf8S\x96E\xe5\xe7D\x05\xefK\x1cGQ\xe4\x16wˋ\x1c'\xb7\xe1\xae.5\x1a]\xb1O\x12\x0e\x0f\x0f\x90R\xa05\xd5w=\xe3\x1c\xb8\xb7%\x90(\xea|%\xf0}Ƙ\xaf4\xc6l\xb4Z-<ϫ\xd0XA\xb3\xd9d:\x8d\xab\v\xb7\xceMV\xb1~\x92\xa4\x80&\fC\xf2\xb2\xa8\x1b\xf1\x8d.m\x96.\xec\x02\xc7F\xd3\b\x9b\x96\x11/\x05q2\x051o\x01P\x9e\xba\xaa馬\tmB\xd8\xef3-˲\x04\xcf\xf1q\x1c\x8f\xa2\x9c\x01\x97\xaa\xae\x8d8\x8e\xc7\xde\xfe\x05\x0ez\x97\x99\xc6ctY \xa4\xa2\xd9hյ\x13\xa35Z\x17̕XT`\xa8\xc0u\x1d\x8a\xa2\xa4(l\x84\xe78\x0eʑ\xf82 \xcb\xd2\nY\x90x\xbe_\x99\xe8\x82\xd5\xd5.J)\x06\x83\x815 ڠ-,\xf0\x03B\x88\x17nk\x92\x83\x10\xe2\xc33\xfeT\xa7ө[\xd0\x1c\xc7:\xb8$Ii4B\x8a\xa2$I\xac`\x10\xb3\xfe?\xeb\xe8\x1dǡ\x9c\xb5\x01\x98\xe5$Ć\xc9\xda\x16\xa6\x84\r\x1c\x02?\xa4و\x98NGdY\x8aW\xc1\xe4\x8b=\x1c\xf5\xb8\x8eYwR5\f\x00e(ʬB\x014\xc3\xf1\x11\xcdF\x87\xc0\r\xb9t\xe5,Ͽ\xf8\x14\x83\xe1!&\xcf@k\xa4\xce0\xca\a)q\x82\x90 l\xa0\xb5\x8d\xb8\xb4)\x17\xe8B\xba\x16\x90S\x11!f\x04?)e\x9dg\x95eI\x184\xec=;\xe5l\xfc\x12\xedv\x878\x8eI\x92t\xa6\x1d\xdf5\x1a\r~\xf2vǪ;kkkE\xab\xd5rf\xe1ݢ\xbd\xd7Z\xe3\xfb\x01A\xe0\xd3\xeb\x1d\xd5\x11\x8c\x14\x02\x8dBb\xb5a:\x9d\xe0\xf9\x01YV\xa2\x8dF\xa1jT\x15!1F\xdb!\xfdei#\xad\n\x8c\xf3\xfc\x80\xa2,)\xca\x12יW\x17\xafK\x96\xd6\x1ac2\x8c֤YB^\x14\xe4y\x8e\xef\x05\x16\\\x9c\x8c\x91GgyX\x8c8喼\xf9\x1b\xbe\x99\xa6М\xff\xd0\a(\x81\xc3Rpn*yf\xd2\xe4B\xb0\x89\x13\xde\xd8\xd7\x16EYk\xcd̯\xcd\a\x1f\b\xa6\xf1\x04G9\v\x1a*\xeaq\xeb\xb3\xc0g4\x1a\xfc\xe4U\x84\xc2[\x13H\xbb\xdd.\x8c1\xce\xf5\x16\xc1B\"N\x15\xda6\x18\x8d\x86()I\xb3\x14\xeb\x8b\x05B*z\x87\xfbt\xba\x1b(\xa5f\xeaj\xed\xbd\xb1ݶ\xcaQUDeyLq\x1c\xe3\xb8\x0ey\xd5\xe5\x94e\xa9\xcd\x11\x94Zʠ\xaf\xa6\x85\xba\xae\x87r]\x1c\xa1P\xcaFi\xa3qߚ\xbe\xe9\x90o\xf7z\xac\xb90\xd1\x02\xb3\u007f\x9e\xbd8aR\xbdM$\r\x8f7J^/\x86\xfc\xec\b.\x04\x11n53\xc3\xd6SDe*u\xfd\xff8N*>\xb2\x9a\xcfF\xc1`\xb4\xa9K\a\xf6\xb5ڶ\\\xc7SRK\xcex\xe1\xea\xb5|\xdf{\x9f\xe4\x1d\xefz\xe2\v\vD\x97\xe5R\xe89\x83\x04\\\xd7_\x9a\xf6\x93\xa4\x96\x1aZT\x1c,\xcb\x12\x9c\xcd\xff)\x99\x8e\aU\x8br\x84R\xcd\xda\xcc\xd8R\xae\xed綍\x9c6K\xcf㬆\xf8\x1d\xe5TM\xfc\xa2&a\x1bCݾ\xec8\x0e\xcdfT\x97\x82\x85\x94\xb8\xd2\xc5u<°\xc9h4`\\\x96\xfc\xec~\x9b8/\x10\x8eǛ\xff\xf83|c4\a\xf3&Z\xf0[S\x8fM\xa7dh\x14\x8e\x92\xb8U\x9fz\xe0\x05\x18\fI\x16\x93\xa5\tY\x9e\xd5a\xfb\xfe\xfe\x15\xd6\xd77\xd9\xde\xda\xc1\xf7}\u00a0\xc1\fӛL\xc6\xf35\x82*R3\xe9\xd7|\xe5\xdb\xff\xf9\x89\xe3\xa7\xfe\xbbR\x97\a\x15\v~\xef\x1d\xefz\"\xbb\x15\xe18I\x9a\xd0h4\x97v\xa4R\xeeRr\x97e\xb6u\v`:\x9dTXά\x8bɠ\x85\xc2\b\x8b\xfa\xe6Y\x8ah\xb5\xc8\xf3\x02\u05f5\x14O\xa3\rB\xceI\x06R\xc8\xdaWX\xfcK\xdbP\xb9(\xaa$\x0fK\x140\x9af\xb3E\xe8\x856bs<\x02?ĩh\xa5ei_\xdfn\xad\xd0o\x1c1\xed\xac2\x18\xf4\x19\f\xfa|\xbe0\xbc]\xa7(\x01\x89\x16\xbcg\xb0B_4\x88\x82\x88\xbb\xee:\xc9\xc9\xd5-\x82\xa0\x89\xeb\xf8h] \xb0\xc9^\xa1\v\xb2<\xa6(R\xdb\x1e\r\x1c\x1e\x1e0\x18\fPJb\xb0\x94Ѳ\xd4\x1c\xf5\x0eH\x93\xb4\x0eɳ4\xa7(\xf2\x9fx\xfd\xeb\xde\xf4\xbe\xa2,|!D\aX\x03\xb6\xdf\xf7\xde'Ê\xf3\xfb\xf4;\xde\xf5\xc4\xe0&\xd0\xc9r\xb3\xe6\x8cԶ\xf8\xb3x:\xad\xa8=e\x1d\xd7\xcf\t\xff\xa2j\x9a\xcc\xc83\xf0\x82fM\x1b\x9d\xb5\xa0\x95\xbaD\tUi\x9b\xfd;)%eY2\x18\xf4,\x00X\xf5\x98cl\x03\xbf\x85\xeb\x9b(\x14I\x12\xd3j\xad\x12\xba\r\x94p\xd8\xd9٥\xcc\xcd<\xf14\xb0\xb5~\x8aѤ\xcf\xe5\xfd\x97\x91R0\xba\x12s\xb9P\xdc\xe7\x97\xfc\xca0\xa4/\x1b\xdc}\xec\x04\xf7\x9c~\x98F\x10U0\x88OP\x91ܨLj\x96\xa5\x8cF\x86,\xcd\xe8\xf5\xfa\x1c\x1c]&\x8e'L&c&\xd3\t\x81\x1f\x10\x06!~\x18\xd0nE\x8c\x8c!\xcf\xedt#\xa3AI\xf9u\xff\xf0\x9f\xfc\xfd\x04\x1bd\xf7\xae\xb7\xe87Ր9\x01@\x93\xe7\xc5b۳U\xf5\xf1\bmJ\xf2\xbc \x8e'\xccHq3\xaa\x8d\xa5\xb7\n\xa8^\xe3x6\xa2r]\x97\xa2(\xf1<\xb7\x8aTT\x15)U\xdc\xd9$\xae\xc6Sxv6U5r#jul.\x91\xa5xn\x80\xef\x87tW7\b\x83\x96\xa5\x80J\x17\xdf\rIʴ\x82X\x1c\xdb\n\x90\xe5t\xa2.\xa1ߠ(\n&\xd3\t\x1f\x1c\xc7\xf4ʔO\xe6Mvw\xb6\xb8\xff\xde\xc7h6\xda\x04~H\x145-Y:N(\x8ar!\xb4\x16\xf8~\x03\xc7\xf1\b\x82&\xadh\x85K\x97\xcf\x106\x9aȣC\x92$a\x9a$\x18\x04Q\xbb\xcd\xea\xea\x1aGG\a\x15\xf2`0ƴ\xb666^\xb7\xb7\xbf\xff\xa7\xb35<~\xec\x18\x17.^\xbc%?\"\xee\xbb\xef\x818\b\x82\xa0(J\xc20\x9c\xef\x16!\xe8\x0f\xfaUȚX\xba\xa6YLިm\xbdu\x18\x05Fظ\xbd\xd1h\xd6\x03\xc7l#\x8b\xae\xaa\x849i<E9\x0e~5\xf8\xd2f\xbc\xf5\xacE\x1ce\xb1\xa4(Z\xc1\xf7|\x8a\xbc\xa0\xd5\xe8\xd0\xedn\xd0hD\xb6'C\x18\x92$\xc1q\xbc\xaa3j\xd6!e7\xcb\xe5\xfd\xb3\xbcp\xe6s\xf4z=\xc6\xe3!++]\x1e{\xe4Ml\xad\xed\xe2z>\xbe\xef\x11Ǳ\xf5q5qa\x1e-\x1d\xf5\xf6HӘ\xb2,Ȋ\x94$\x9d\x92\x17\x19\xbd\xde\x11\xfb\a\xfb\xb59\xf7<\x8f \b\x98L\xc6\x16\xf3J30\xbc\x18x\xe2\xa7\x11\xe2\xeb\x05◄R?\x95%ɠ\x11E\xe6\xfc\xf9\v\xb5pnl\xb2*\r\xf1*j'XLj\x12O\xd1eI\x92$\x15\xd5\u007f6,Y\xa2\xb55/\xf5\x945c0R!LI\x91\x15L\xb4&\xa8X\x8cEakۓܲQ\xc2f\xdbV\ag\xadgB\xa0\xab\x02\x96\xefzDm[\x13\x97B\xe0{>\xeb\xeb;6\xeeW.I\x92p\xe9\xd2y\x8a\xb2d\xad\xbbI\x96\xc5\xd5\x1c\xe0\x85\xd9*\v\x9dTR)\\\xa5议\xb3\xb9\xb6\x8bT\x8e%bgi\xdd\xd3n\x13Y=\xf7i\x8eK\xa7\xb3\x81\x14\xb6\x91t<\x1d\xf1\xf2\xb9\xcf\xd3\xeb]\x01\x03;[[h\x83\x9d*Q9\xf6\xb2,I\x92\xd4\x00\x1fs\x1c\xe7\xfb\xaf\x1c\x1e\xfe!\xf0\xc3ۛ\x1b\x8f\x15Y\xf6n!\xe5\xcbY\x92\xfe\x12p\xfef°\x89!b\xb1\xab\x96\xa2(\x88\xa7V\x18v\x17\xcdڴ,\xd4\xed(\x87\xc9tLYεÆ\xb3\x1a\x84\x8b\x10\x06]dL\xc7eU\xde\r+\xb4\xd7GJA\x92$\x04\x81\x87\xac\x86\xf17\x1bQ\xd5_n\xf0<\x8f,I\x18\x8f\x86(\xe5pr\xf7n\xf2\xb4\xa0\xdf;\xcf\xe1\xe1>\xedv\x97f\xb3M\x145i4\x1aL&\xd39\xe1\x8aY\xd3LF\x9cNH\xb3\x944I,\xa4\xdfY\xc3\U000c2eb4j\xefE\xd4yCY\x16\x15-I3\x8d'\xec\xef_\xac\xfb\x16\x1d\xcf\xc3\x18\x8d\x1f488\xb8B\xde\xcf\xf0\\\x8f\xa8e\x9b|6\xd67\xe8\xf7\a\f\x87g\x86q<\xf9+\x8b\x84\xea\xcbW\xf6?\x05\xfc/\x9b\x1b\x9b]\xa3\xcb\xd5\xdb*PY.\xaeŦ\fv\x94Q\xdd`\xe3z\xb5\x03\xb5\x8e\xdd\xc2\xe8^ï8X\x90\xa6)\xd3\xca\xf9\v\xa9,\x91 O\xd1EN\x96\x05\xac\xac\xac\xd4\x1d\xb4\xc7wN\xe1\xb9V\xb0G\xfd\xa39\xcd4\x99\xe2\xf9!\xbb\xc7N\x12Em\x0e\x8e\xf698\xdc\xc7h\xcd\xca\xca\x06\xc7wO\xd0\xef\xf7\x18\x8ez\xc4\xc9\x04\xdf\v\xab<I\xd4e\xcc\xfe\xf0\x80+\xfb\x17\xadp҄F\xb3IY\x16K\xfd\x87ڀ@-\xa0\xbd\xb6\"\xdal\xfa\xac\xae\xae\x828NQ\x14\xf4\xfaG\xfc\xd1G\u007f\x87\x83\xc3\xcb\x16\xe6W\xb6\x14PjMoЫ\x1aM!j\xb5\xf9\x8a\xb7|\xb5\t<\xf7\xfb\u007f\xfd?\xfc\xbf\xff\a\x90\xfe\x8f\u007f\xf3\x9d\xfc\xf4\xcf\xfe\x04\x1b\xeb\xeb\\ٿr\x04\x1cu;\x1d\xc2f\xf3\xa6&K<\xf0\xc0C\xb1g\x87\x9a\x03va\x93Ē\x06\x9a\xcd撍7fy\u009b^\x80J\x8cьFC\xca<\xc3f\x8db\x0eU\xa3\t\x1b\x11\x0f=\xf4(\x8eR<\xf3\xf9\xa7\x10\x15\xb3\xd0:\xffܲ\t[\x11Ƕw\x99\xc6S&\x93\x11\xd3ɘ\x95\x95u\x8e\xef\xbc\x06\xea$\xceA\t\x85\xe3x\xb6\x13\xcbk\x00\x9a,K\xd9?\xba\xc4\xf9K/1\x18\xf6\x18\x0e\x8eH\x93\x04\xcf\x0f\xd8\xdc\xda\xe1ч\xdeB'\xeaZ\xe7\xbd\x10\x1f\xce|H\xa9K\xe2xB^f\x14E\xc1\xde\xde9\xe2t\xcc\xce\xf6q\x8c1\xbc|\xf6\x05\x06\xfdC\xf2\xa2\xa4Ԇ\xb2\xc8I\xb3\x02!Dl\x8c\xc9\xc6\xe3\xe1\x03\xdf\xfb\xce\xef\xbb\u007f\xff`\xefݮ\xe7\xff\xfcޥ\x8b\xbf\xf8\xb7\xff\xf6\xff6\xfe\xb6'\xden\xfe\xd6\xdf\xf8\x9f\xf9\x99\x9f\xfb7\xb7\xd6s\xf3\xc0\x03\x0fžo\xb9;Y\x96\x91\xa6I\xcd\x10\xa1je^\xaa\x01T\x03\u0092d\xc6P\x14\xa4i\x82\xd6\x05\xd3\xc9\x14Se\xaeF\x974\x9a-:\xed\x15\x82 $\xcfbΝ{\x19\x03\xb4;\xabu\xed\xd9h\x8d\x92\x92\x95\xee:Q\xb3\xc5\xfe\xe1\x15z\xbdC\xf2\n\xf3r\\\x8f\xd5\xd5\r{\xf2[\xc5\xdd\x15T\xac\xc8¢\xb3q2e8\xea1\x1c\x1e1\x1a\r\xc9\xd2\x04\xe9\xb86\t-R\x82 \xa4\xd3Ye{\xeb\x14k\xdd\r\x1aA\x13ml\x9b\x9c\x94\xcavA\xa51y\x911\x1c\x1cq\xee\xfc\x8b\xf4\x86Gh K\x13<\xd7\xe7\xd8\xce.Q\xab\rF\x13OG\x8c&\x13._\xb9b\xd24y\xb7\xeb\xba\xfft2\x99\x93\x18\xde\xfeUo\xff\x16\xc7\xf5\xbe\xb2(\xf2ϝ>\xfd\xc0\xaf\xfe\x9b\x9fzO\u007f\xf7\xf81\xce_\xb8xk\x02\xf1</HӔR\x97\x04\xbe_W\xf0\\\u05eb\xed\xeb\xd5U\xc0$I\x89\xa2\x884\x8d\x89\xa7\tya\a\x91͜\xf5=w\xdfg\xd5~p\xc4h4 \xcbRT\x15\xc5P\x99?[\\\x12\x1c\xdb\xd9E9\xca\xce`\x1f\xf6)\n\xcb\xe5\xf2\\\x8f<O\x11\x95\xe9\x14\v\x83g\xb4\xd6\xe4E\x8a\xd1%\xba\xb4\x9f\xe9\xb8\x1e\xcdfD\xab\xbd\x82\x12\x92i\x95?8J\x91\xe7)YZ\tgu\x83\xad\x8dm\x84\x94$Il\xef!\x1e3\x19\x0fm\x18\\\xf9͚ui\f\xba\xc8)\xb5&\b\x1a4[\x11Q\xab\xcd\xc6\xfa\x16\xbb\xc7O\xfd\xf0O\xff\xec{\xdf}\x9d\xb5\xf5^\xf7\xda\u05fd\xb1\xd7?|{\xa9˟;\u007f\xe1\xe2K'O؉z/\x9f={s\x81\b!\x82\x19\xa29\vyg\xa5J\xad\v\xf2\xbc\xb8\xeeh\x8d\"\xcf\xf0\xfc\x80\xf1x\xccp8\xa4,K\xb6\xb7v\xd8=v\x82\xa7\x9f\xfd,\xe3\xf1\x18\x8c\xb6E\x9f\x85qJ\xa6J0\x8b\"\xe3\x9e\xd3\x0fpp\xb0\xcfp4\xb0\x89dU|r]\x9fn\xb7K\x92$Ք\x060\xba\xa8p2\x83\x94\xf64\x05\xc7\xf3\xabD\xd2!\f\x1aD\xcd\x0eB\nʢ`<\x1d1\x18\x1c-\x84\xebƎ\xf7H&V\x885+F\xa1\\\x97\xb2f\xe7k\xa8g1\xea\xfa3\xe7ɲ\x98\xfd,=\xfd\x9a\xbb\u007f\xf5{\xdf\xf5\x03O\xb9\xaew\x16x\xf2\x1d\xefz\xa2\x1eG\xd7\f\x1bL\xe2)'ww\xbf\x16!\x06gϝ\xfb\xf8-k\xc8\xe2$\xaeš^3\r\xb9\x1a\xe8\x93R\xe2\xfb\x81\x1d\xa9\xdd?\xc2Q.\x1b\x1b\xdb\xc4\xf1\x94\xb3\xe7\xceX\x0eo\xc5B/ʪO\xbc\xaaE m\xbd{sc\x9b\xbd+\x97ɳ\xb8\x9a\xd3+j\x81EQ\x9b0l \x95\xaaǕ/\x9ej\x13\x06M\xbc*\xd8p\x94\x8b\x90\xb2z>;\xa1\xc1n\x98K{\xe7\x99\xc6S;\x83\xabF\x04l\x19\xa0(2\\\xd7G9\x8ax:\xa1,\n\xa4\x04?h\xe2{>\xbd~\x8f4M\xec\xfb\x99\n\xe4\xab|cE\xe2\xfeN\x93Ǘ\x93,\xbd\xfb\x03\xbf\xfc\aO\xee]\xb9\xf4-\xc0\x85\xaac\xea\xf2;\xde\xf5D\xf1ȃ\x0f\xf3\xd4ӟ\xe5\xc4\xf1\xdd\xd0\x18-\x05fr\xee\xe2\x8dG1\x8a\a\x1f|8v]\xb7v\xea\x96\xc5!\x17\xa0\xe8b\xe988\v<z\b\xa1\xeaq\x16\xcdF\x8b$\x8e\xe9\xf5\x0f\x19O&\xb6\x15A\xdaagN\x95\x18\x0e\a=\x1b\xedH;ĸ\xdd\xeep\xd4?D\xe7\x19ff\xbe\x96\xf1v\\\xcf2\x05\x1b\r;\x8fq>\xa1A\xd0i\xafV\xd4!\xb76\xb133(\xa5\xacI\xd6\xfdQ\xaf\xce=\xd24\xa9\xdf\xc3Φ\xd7\ba+|\xa3\xe1\x00\xd7\xf5\xea\\%\x8ec\xf2,\xaf\x14ł\xa8\x98e.\x17\xf0\xd0h4xzs\xbd\xfb\x83\xba,_<\xe8\r~\xf9}\xef}\xf2\x18pw\x85[\xbd\xfc\x8ew=QO&=y\xe28F\x1b\xce]\xb8x+4 c\xfb\xf0\xaeb\x1e^}6\x9f\x05\x1ee55\xa7I\xd4\xeapi\xef\x02{{\x97Ѻ\xb4eQ\xa3i\xb6\xa2\xb9\xbd/-$#\x95C\x96\xa5\xacw7\x98\xc4\x13\\)\tV-\xed'\x8e\xe3eM\x94\x92</H\xb3\x8c\"\xcf-\xfb\xc4\xf1h4\xa3\xaa\x05\x99\xbaa\xdf\"\a3\x13SZ\x92wU\xdfQJU}\xf3\x02GI[\x86ul(_\xe49\xe3\xf1\x80\xe9\xc8\xc2\xe6q\x92-#\x11\xb3\xa506_\x99홅q*\x11\xc0\x95\x83\xa3\u007f\xb2\xb5\xb1\xf6\v\xc0/\xbf\xe3]O\\\x04.\xbe\xef\xbdO6X8\x02\xf5\xc4\xf1c\x9c=w\xe1\v;\xf5\x87\x1ez$v\x1c'\x90RU\x85\xa3\xc5BM\xbe4\xcc\xc5\xfa\x16\xabA\x9d\xf6*a\xd8\xe0\xb9矩\xe6\x99$\xe4y\x8e@\xd3hFu\x10\x90W3\b]/\xa8\xa1\x94\xa2\xc8\xc8\x12\x1b\xcdQ-\xdch4\xb8\xc64\xce\xc3kC\xab\x19\x11\xf8\x0e\xc9tJ\xd4\xee\xd0YY\xabs#\v\x8a\xba\xf5s)%\xb2\x1ai>g\xa3\x9bZC\xec\xd0\xe4\x1e\xe3јRk\xf2ҚR!\xaf\x9e\xc15\xb7\n\xcdfkv\xdas\xd5a\\\"\x84x\xd3p\xd8\xffO\x00\xbb\xc7v\x1e)\x8a\xfc\xad\x97\xaf\x1c\xfc\u061d\xd4A\xea}8\xcb-\xd4\xc24\xe9\xab\xd4rA v\xdc]\xa7\xddE\n\xc93\x9f\u007fj\xa6̖T&\xa0\xd1l\xd5\x03&gG\x19\xf9AX\x13V\xf2<#\xcf2˛\xad\x83\x88\x1bҌ\xebZ\xc3t:\xc6 \xd1B\x91\xa4\x19\xe7Ͻ\xc8x<\xacIp\xb6\xf9'\xaf}^V\x91\xadk\xe04\xcbPR\x91%SzG\a\x8c\xa71I\x91\x93\xcfzF\xa4Xb\xc0\xcezA\x84\xb0\x98\xd5l\xbcǲ\xa0\xec\xe4\xd1\xddc;\x98\xa2x\xdaQn\xff\xe4\ue24d\xc5\xeb\xbf\x1daX\x1f\xf2\xd0k\xe30\xf0\x83\xabm\xf8\xe2\xcc\xc2\xd9c\x16y\tl\xc1\xaa,\xf3\x9a\x92\x1fO\xc74\x1aM\x82F\x93\xc0\xf7ɪ\xe6\xce\x19\xa3qv\xabY\x1a\xa3*?\xb5\x18\xfdX\xaeV\x8e\xeby\x95sU\v,rjj\x8e\xd56;S\x05\xa3\xf1\x1c\x87\x93w\xdd[\xfb\xbe<ϫ\x86\xd2\x18?\b\x89\xa7#ж\xffo4\x1a\xd2lE\x18`<\x1e\xddp\u009c1\x86 \b\xc8s\xeb?}߯\xe9\xa1i5P\xa7,5\xa3\xd1`i\x17\x9d:y\xb2\x851]!Ĺ3g\xcf\x1a\xee\xe0!\x1d%k\xd6\xc6챘\x9d_\xdde\nTս9\xdb#Mb<? \xacP\xde4I(\x8b\x12\xcf\x0fj\xba\xbe\x10\x82<\x8bq\xab\x92\xe8\x1c\xac\x9cg\xfaB\b\xa2fD+\x8a\bð֞\x19\xa89{\x9f\"ϭy\x92\x0eYi\xb8|\xf1,\x83\xfe!/\xbc\xf0,/\xbc\xf0\fg^|\x86K\x17_\xe6\xc5\xe7\x9fF \x89:]Ɠ1e5\xdai:\x9d,\bC,\xb5\xbc\x89\xaa\xdec\x8f\"\x0f\xea\x88r\xf6\x1a\xdf\x0f\xea\x1e\x94(\xea|\xef\"\xc9\xf0\x81\xfb\xbf|,\x848\xc7-\x1f\xaaw\x1d\ry\xfc\xf1\xd7\xc7R\xca`^h*oxȮ\xe3\xb8U\xc1\xbf\xacK\xb4\xf1tJ\x9aN\xe9\xaem\xd6(n\x9eg4\x9bQmo\xc7\xe3\x11E5QAJ\xb5\xc4\xe7\x9d\xed\xfe\xbcbA:\xae\xedT*˒^\xef\xa8\x16\xc2\xccW,R\xeb\x85\x00\xa35\xad\xa8M\x91N\x89\xe3\x18\xa9\x1c\x8ct\x10\xc62\x12\x95\xe3UѠ\xc1\xf7\xfd\x85\xa8Q\\u\x9e\x97\x85PLE\xbe\xb3!\xb7$\x9eN\xaa\xba\xbaSo\x8e\xa2(\xe9\xf7\xfb\x18c.\x00o\x1c\x8d\x06\x97x\x85\x1e\x8e1baHdy\xd3A\x91\xcb&\x84j\xd0JB\xd4^\xa9\x17\xac,\n\x1a\x8dVU\x9b\xa0f\x18\n\xa8Oα.\xa3\xea\xf5\xab\x8aB\xb3\xfe\xf6\x19\xf5G)[WY\x1c\xaf\xa4\x94\xaaL\xe9\xac0f餓\x89\x9d\x06\xa7\xbc\xc0\xd6\xf8M\x85@W\xec\xcaY\x852ϳ\n\x12\xb3\xa6O\xce/\xc6R|\n]\x9d\x0e4\xafD\x86a\xa3:\xa2|\x86\xf5eL&\x13\x8c1\x87\xc0\x93\xc0\x98W\xf0\xe1\xd8\x05Xd\\\\w\xc8\fy\x9e_\x13\x85\xe5Y\x8a\x1f\x04\x15A\xd9\u07bc\xe7\aK`\xa4֖*\xe4\xb8\xdeRQk\x16\x11\x89j\x03,\x1f\xdab\x9f\xdb^\x12\xea!6\xae\xeb\x11\x86\x8dj(Z^\x95\x88\x97Á\xe5\xf8`~\xca\xce\xe2\xf3e\x9e\xf2\xac\x1e䓚\xa4\x0e\x95\x95Z< DV\x048\xcdx<\xc6\x18\xf3;\xc0w\x02\x97G\xa3Az\xbbܫ\x9b\xfa\x10\xfbA7\x16\x86ц\xd1pt\xcd\xf4g\xdb\x1ef\xb1\x9d\xd9A\xf73\xc1,\n2K\x13\\\xcf_\xc8oD\xcdt\xbf\xfa\x98\xa2\xc5EZ<AԎ\x8bmVLxE\x14E\x84a\xa3F\x9f\xe7\u007f+\x17\xa6\x97\xceF\xca\xca\xf9\x17s\x02\xf8\xfc\xbbX\x98\xe2\xe3W\xc4i\xc5\xd2Q\xb1b\x1eeVD¯u\x1cgw4\x1a\xa4w½\xba\xa9@f\xa4\xe1\xebE\x1aY\x96\xd1\x1f\x0e\xf0\x02\xff\x1ap1\xcfl\xadA.Lw\x16r9t\xb6$mq\x83\xb3\xd6\xc5u\x02]\xb1t\xf0\xe4\f3\xb3LB\xb1\x10\x00̜\xab\xb3\xe8\x8aq]\xdb\xea\xd0l\xb5\x88\xa2ȶSTsN\x84`\xf9ܢ\x9a<>\x13\x98\xed\x12\x96U\xa6?\x0f8\x04\xf33O\x04\xedv4#h\xbc\x8dW\xe1!\xaf\x0e\bf\xd4\xcfz\x1c_\x10\\s\"A\x9eYP\xb1\xee˨PXS\xb5\xba\xcd\x12\xb1Y\xc3K=8@\xce\xfb?fGI\x88\x05\x81\xd5\xf1\xfd°\xe4E3Y\xb3ݫŲ\x82\xa2\xce\x15\x820ĭ\x9az\xec\x19\"\xb2n\xab\x98-\xe8l$\xf8\xec}\\ǝ\x93\xfe\x84\xc0\x0ff=\xe8b)\x12\x9c\t'M3\xb4\xd6#c̿{5\x04\xe2\\\xdb\n\x9d\x11'\tF\xdb\x06\x960\f\x97LKY\x14թ\x9dri\xb7_K\xfd\\<5\x8b\xba\xdb\xc8\x18YS\x80°\xb1$\xb0\xd9 \xb4\xd9p2\xcfs볬\xeaw\x12,\x9d\xddn\x8c=\xfc\xcbu\xbdj:\xa8Se\xfc\x02\xe5X\x13\xe3{^5\"\xb6\xa8K\xb8eE\x10\xf4<\x974-+j\x93\xa8\x99\x9aBpmX\\k\xa8)\x84\x10\x17^u\x81\xa4iZ\x91\xa2\xed\xceh\xb5\x9a\xd7\xe4#R)$jɤ\xb0t\x1a\b\v\xd1ҵ\xe1\xb8R\xb2\x8a\x8e\x9c\xda4,\xe2d\x8ecONs\xae\xeeĪM\x9c\xa9\x11W)g=\x1cn\xdd>W\a\x05U\x8b\x1cP\xf5\xa3/\x8c\x18\x14Ҷ\xdfU\x14י\n,\v\x81k\x04A\xd5<*\xa5\\\xad\x0e\xa5<\xfb\xaa\bDk˥\x9a\x81\x83\xda\x18\x82 \xbc\xa5\xb3\x98\xb4^Ti\xbd\xe4'n|\xba\xa5Yhԙ\xad\xb7\xa9\xea \xee\x02\x19\xef:6V*\xc2F\x83\xe9dR;\xe1\x19\xd1\xc2:\xe3j\xe1Լ\xbec{ʽ\xa5\xe6\xa0\x19&W5#,̓\x17\xd7ѐ9\xa6%*\xa8\xc8\xc0[^\r\x81Ȳ\xd4diV\x87\xa2\xf6f\x9c\xa5\xb9W7\n\x85\x17wxQ\xd8ɜ\xb3Z\u038dO(Xp\xdeW\x01yƈ\xfa\x84\xd0\x1b}\x9e\x9d$!\xea\x11\x1eb\xe1\xf0\xc8e\x98ǽ*\xacu\xab\xc8NT\x1dN\xaa\xc6\xd7f\x01\xc7\xe2\xb9\xf0\xf3(\x8b\x1a\xa2\xd7Z3\x99N\xaa\xdc\xe9\x8b\x1bB}C\r)\x8b²\x10\x11K$\x86ű\xaeK\x0e_\xdb\xc6ɼ(\x90b~\xc0\x8b\x85>\xd4B\xfeq\xe3>\xc7\xf9\xac\xdbe\x82\xf7<G\xb9\xe1\x89C\v>g>\x03~v\x8eǜ\xb5\xef]\xf7\b?\xdf\xf7q]\xcb͵\xcd]v\xafۑK\xd7\x12\xce\x17\xb5$\xcfsF\xa3\x11\x99\x1d&\xf3\x99*)|\x15|\x88\xb0\xe7Ԛ\x85\xa3+\x8d\x99\x93\x1b\xec\xb8%\xb90\xb6\xaf\xc4h{RN\xb3\xd9 \x8e\xe3\xcaA\x87U\xb9\x93\xba*wc\xedb\xa9+j&\xe89\x1f\xf8\xaa\xd7K\tU\xdd\xdc\xceյ;}\xe6\xbb\xd4U\xbd\xf1R\xdex\xea\xd4\xcc\xef̛\x83\x96\x89\x1c\xcb\xe1\xae\x05NG\xa31ڔ\x94y\x816梀'F\xa3A\xf9J&\x84\x8b\x1dTW-\xd2\xfc<Q!L\xa5\x9e\xf3\x8b\xb7\x03\xc9l\rm2\x9ePj\x8d\xe7\xb9\x15{϶\x0f7\x9b͛.J\x8d\x1d-q\xc2\xca:I\xbb\xda\xf7\xd83vE\x15\x05\xa6h\xedT\xc2\xd1u+\xda\xed<\x16Y4\xf6}D\xcd\xeb\xbdZ\xab,LR\xda\xc1ge\xa9\x05\xbcw4\x1a<\xf3J'\x84\x8b\x02I\xadV\x88ʱ.\x16g\xect\x84\xf9\xef\xccB\x11\xd5j\xd5lH\x99%BTc\x8fr{\xea\xe6̓\x01}M4f\v?\xfa\xba\x1a\xb5x]3҅\xc0\\\xd7\xdf\xdc\xee\xe3ja\xccs\x0eK9\x15B\x92e\x99/\x04\xfbZ\xf3\u007f\xcf\x10\xdeWC \xff?R3(\x95\xca\xd7v\xce\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_03_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1b/?.\x0ft\x00\x00 \x00IDATxڤ\xbdy\x90d\xd7u\xde\xf9\xbb\xcb[rϬ\xac}\xeb}\a\x1a\xfb\xca\x05\x04)\xee\fqD\r\xb5p$Y\xb2\xe5\xb0<\x1e\x8d\xc3\x11c\x8f%\xd2a\x87=3\x0e\xc7X3\nI\x96Fc\x8fe\xc9\nY\x92\xad\x05\xa4D\xd2\xdc\x01\x81 @6\xd0X\x1bh\x00\xdd\xe8\xee\xea\xeaګ\xb2\xb2r}˽\xf3\xc7}\x99U\xd5\x00IPΈ\x8a\xac\xae\xaa\xcc|\xef\x9e{\xbes\xcew\xbes[\xf0\x03<\xe6j\xeaǭ\xe5\x0fk\xe36\x9e:h\xd2{o\xf38zPc\xcc\xee\xdf(\t\x16x\xf5\x8dX\x9c;g\xe4\xc6\x1a\xb2>cb\xfe\x1b\x1eR\xc2\x1b\x97!I\xf6\xff\xdcZ\x10\x89\xd0\x1f\xf8\x88MG\xeb\xca\xdeq\"G\xad\xea\x11x\x02\x80+7\xfa<\xf7Z\x87/}Vx\xf5i\x13I\x85\xd8\xf7\x06\x02\x1akBloh\xefؑZT)\xe6\xb0ֲ\xb2\xb6\xa3\x8a\xf9\xd0\x14\x8b\xbe\xb5\x16\x8c\xb5\xee\xc3p\xf7\xf6\x83>\xac\x85յ\x1dU)\xe7L\x18j[\xca\al7\xfa\xfa\xdaƍ\xb42\x9a\xee}˾\xfa\x01\x8c\x01\xf0\xaa\x10\xccvv\xc4]^(t\xab\x97\xearQ\xe9rIh`\xf8%\x04ztDi\xedYu횕i\x8a\x0e\xf2h,\xfb\xfe\xee\xed~\t\x81\x16\xa0[-\xf7\xfdޟ'\xb1\x90\xa3cB\x17KV\x8f\xd5<\x1d\x06B\xfb\x9e\xd4X\xb4\xefK\xbd\xb0\x1c\xe9(\xb6r}Mx\xb9\xc2\xfe\xd76פ^Y\x92\xfa\xe0\xc1\x92\x18\xab\x96tj\xacFX\x9d\v|uc\xb9\xa9+\x95P\x1bk\xdf\xd65ڷ\xba\xee=\xdf\xef\xecD\xaa\\\xf6\xb5\x94R\xf7\xa3D+O\xcaNS\xeav\xb7\xab\xf3E\xb4uk\xc3\xdb6H\xb3gi\xf6\xac\xa9\xe4\xe47\x85\xe4c\x9d\x1d1\xe6\xe7`\xad\x9106\xa2)\x17\xc5`\x13\r6\x1fc\xa3\x8aʨayٲ\xbd)\xc8\x15\xffz\x1eb-\x04!lm\x82\x107y\x8f\x12\x10\vf\x0fZJyE\xb9\xa8QJ \xa5@\bh\xb6\r\xbd4\xe1\xf2EA\xa9\x96]\x9b\x80Ύ`uE \x0388UC\b\x81\xb5\x16,(%\xd9\xdc\xec\x92\xcb{(%\xbf\xe7\xb5%\xa9!5\x06O)\xa4\x14H\x04B\xb8\xcf\xc7B\x9c\xa4ĉag\xa7O\xb5\x92C\b\xf7{\x80r9\xa0\xb9i\xe9'}\xc2<`I\xf4\x0f\xba8\v[\xe9\xfa\\M=dR\xae\xae^\x17\xf90o\xf9\xca\xe3=~\xf8\x03!\xa5\xbc\xc0\x18\xb7\x80\xa9\x05)\xe0\xc4A\x1f\xa5\"\x9ezʰ\xb3%(V\xed_\x1b\xb6\nEh\xb7\xf6\x1b\xc5Z\xcbƚ[\xb4V\xc7`\xad%\x8a-\x9e\x12\x18c96\x1f\xf0\xda\xd5>c\x13\xbbx\x93&\xb0\xb5.H1L\xd6Jh\xa9\xf6A\x92\xb1\x86\x89\x89<\xcb\xcb-\x0e\x1e\xacb\xccw\xbf\xe6\xb3Ǧ9s|\x16_+\x84\xb0\x80\xc0\x18C\xaf\x1fcR\x831\x86\xf5F\x87?\xfe\x8b\xf3CC\xec\xbd\xf6\xf9\xb9*\x17_\xef\x91/E(\xf5\x03x\xc8^\xe8Z\xd8J;\x95\x9c|,\x8e\xc4\xcfI\x89(\x94\xe1\xf2Ք\U0007a910\xdf\xfd\xd0\xc1m\xd4*\x8aʨee\xc9\xd2\xda\x11h\x0f\x84\xfa\xc1\xbdD)h6o\xf2\x12\x01QO03\vŢ`\xa2\xee!\x04h%0\xd9n_o$\xec\xec\x18\xaa\x15Aj\xa0\xb1!\xd8܀|A1?YC\xeb7{A\x10x,\xdeh01Vr\x9e\xf3\xa6\xeb\xb1\x1c\x9a\x1d\xe5\x1dw\x1eE\n\xe7\x8d\x02\x89\x14\x12\xa9\x04\x9eV()\x91RP̅\\]\xdc\xe0\xc7?r\xa7C\x9bv\x8f$I!{]\xe0\xfb4\xb6;\x84\x05\x92\x1f\xd8 ͞\x1d\x18\xe5Z%\x94q\xa7%ޗ+\x82\x0e,7V\xcc\xd0(\x83{\x18\xdcJ\xa5\xa0\x18\x9f\xb1\xb4Z\x96\xf5e\t\xc2\xe2\xf9?\xb8\x97\xec\xec\xf0&hT\n\xf29\x18\x9f\x84\xf1\x11\x0fO\x8b\xe1\xdf'\tt\xfa\x86\xa5Մn[01\x05ϟSX\xdfP-娖r(\xf9f\x83\b!\xd8nD\x94\xab\x01\x9eV.\xb0g\x0fc,\xc5Bȇ\xdf}\vZ)\xa4\x94(%\x10Rd\x90\xe4\xaeQJ\x81R\x92f\xbb\xc7\xe5k\x1b\x9c=9\xcd\xf8H\x89\xf1\x91\"\xd6@\xa7\x17я\x12\x82@\xd3\xdc2\xe80\xda5\xc8\\M\xd1\xecٷm\x14\x80JN>\x8f\xe5]\xad\x86\x98/U\x01iyc!ebTQ\xba9\xa6\b(\x84\x8a\xd9\x19\t~\xca\xe2\x15\x89\xb5\xe0\x85o?u\x11\x02\xfa}\x88\xa2\xfd^\"\x04xV2\u007f\xd82Z\xf5\b\x03\xb7\xc0J9\b]ۊ\xe9%)\vW\x04\x1b+\x92\xed\x96\x05\x01\xa5B@\xb5\x98{\x13\x94\x90\xc1V\x9c\xa4xJ\x11\x86\x1e\xe9\x9eT2M-?\xfe\x91{(\x15\xc2a\xbcp\xde QJf\xf1\xcb\xc5$\xed)\x9eya\x91\x89\xd1\x12\xe3\xf5\"I\x9a\x12\xf8\x9az%\xc7d\xbdB\xa5\x14\xd2hvўb\xf9F\xd7ʹ\x11\xf5S\xb3U5\x01\x84s\xb5\xfd\x11,ˬ\xde\xf2\xf1\x8f\u007fv\x9c\x85\xadt\x1b\xc1#Ɛ.^\x96\x98\x14\x8c\x81/>\xdags\xcb \xc5~\xc8\x01\xc8\x05\x92\xbbo\tx\xf8\x03\x16i\x04\x9bK\x12k\u07beAr\xf97\a\xf6\xd4@\xab\rqb\x89b\x93\xedb\xb0\xc6\xc1\xd6HY\xe3\a\x82J\x15\x16\xae\t\x8cr\x06\x91B\xa0\xd4[\x19\xc32=Z\xe5\x87\xdfw\x86ͭ.\xa9I\xf7\x05\xf1\xdbO\xcdQ*\x84\xa4\xa9\x8bY\xd6Z\x8c\xb5X\xb2\xf7\x95\x12\xcfSx\x9e&\xe7\a<\xf5\xdc\x15\xee>;O\xe0{HႽ\xa75\x81\xaf\x98\x1e+\xf3\xd0\xddG\x98\x9f\xaeP̕\xb4\x9a\x1d\x97\xcf\xf5#\xfe\x17\x93\xf0>`\xb4\x9a\x97a%'\xe3f\xcf6\xf7z\xcc\xcd\x1e\xb4\xb4ԗ\xa3e\xf9\x13\xc6\xf0\xb3&e\xb62b\xc5N\xc3\x05m)aq\xd905\xa1\b}\xf1\xa6EUJP+i\xa6\xe6-\x891\xac.J,\x16\xed\xbf=\xd8j\xb7\xf6Ö\xb3\x8a`\xf6\x90\xa5^\xd5TJn#\t\xe9\x16\xd7ӂ\xa5\xb5\x18\xa4e\xe1\x8a\xc4\b\xf7\xe2B>\xa0Z\f\xdf\xf4\x19\x9e\xd6<p\xdb!\xea\xb5\x12\xdfx\xf2U\xea\xb5\xc2Љ\xb5\x96<x\xc7Qr\xa1O\xb6\xb6or\xf0\xc1\xbf\x03\xdf\xe3\xfcK\vXc\xb9綃X,RH\xd24\xc5\xd3\n!\x04Ij\x90B0V-\xe0\xfb\xb2\xaf>\xfd\x0f\xc3_\x1a\x9d\xb6\xda\xc8t.\xea\xf1C\x9d\x8e\xf81k\xf9h5/\x1f\xae\xe4\xe4H%'\x17\x9a=\xdb\x1e\x18c`\x98R d\x92\xf0\x0f\xb5\xe6#~و(uP\x12\x06\x02?\x848\x81\xd5\xf5\x94\xc3\a\xf4>O\x19\x06h\x01\x85@Q\x1b\x01\x9dOin\b6\xd7%Ŋ\xc5~\x0f\bS\nZ-H\xd3\xfdF\xd6\x12FF\\\xaa=>\xe2\x92Gk\x9c\x01\xd3\xd4e`\xb1M\x89\xfb\x82\xb5U\x90\xcaE\xa0Z9\xefv\xedp\xc1\x15#\x95<\x87\xe7F\xa9WK<\xf9\xec\x15\nE\u007fx\xdd\x13\xf52\xa7\x8fNeqG\x80\xb0Cغ9\xb6%\xc6\xf0G\x9f;\xcf\xcf~\xf2~L\x06yZk\xfc\xc0\xc3\xd3\x1eA\xe0!\x84$\x8a\"\x84\x10\x14B/Q?\xffS\xe1\xa7\x0f\xcdk}\xe2\xb8f\xf2`\xca\xd4\xc1T\a\xbe\x18\xebt\xc4\xe9\xa8χ\x81\xbf_\xc9\xc9\xf7UrRWr\xf2\xe2\xc2V\x1a\x03lw\xad\xad\xe4d#M\xf8\x89\xb9#V\xef\xb4-B\xc1Φ\xa26f\x11\x02z}\xb8r\xddp\xec\xe0[\x18%{\xce\xf9\x92rI\x11V\x13\xb4\x82\xcb\x17$\x85\x8a\xe5-`}_\xa0\xeev\xdf\xfc\xbbRA0>\t\xd3c\xbb\xaefR0\x19\xee\xafn$\xd4G-\xaf_PX\xe9\xaa\xef\xf1\x91\xd2>\x83xJ1=^\xe5\xd4\xe1i|_\xf1\xfc+\x8b\x18\fJJ\x84\x80\xf9\xe9\x11\x8e̍c\xac\xa1\x1fEt\xbb1\xfd~D\x92\x98\x8c\xa9\xc8◖|\xe3\x89\xd78yd\x82\x99\xa9j\x16S\\\xa0\x97\xd9{e\bG\xa7\xd7G\nI\xb7\x17%\xea\xe3\x1fџ\x91\b\x9d\x0f\x05s\x13>\xd3\x13\x9a\x89\x19\xcb\xd13)S\xb3V*\x0f\x1dG⠵\xfcp\x9a\xf2\xe9R g+9\xb9Z\xc9\xc9.\xb0\x84\xe5~\x93rxrβ\xd3\x04\x84\xa5\xbf#)\xd7ݒG\xb1\xe5\xf2\xb5\x94\x03\xb3\n\xdf{\xebU\xce\xf9\x82RN\xe1\xe5SF'\xe0\xea\xeb\n\x04h\xef\xad\xd3_߃\xad\xad\xfd\xb1$M\xa1R\x14LL\xc3\xecx\xc0 q\xb2\xd6y\x87\xa7%k\x9b\t\x06\xcbζ`\xa7-0\xd60V-\xecK{}Os\xe6\xe8\x14\x93cU\x8c\xb5<\xf7\xf2\x12\xfd$Bk\x85R\x92ێ\xcfQ\xc8{\xb4Z=\xe2x\x10C\xc0\x18C\x1c\xa7\x90-\xfa\xc6V\x9b\x17/\xde\xe0\x9d\xf7\x1cq\x19X\x96}\xed}\xc4qB\xa7\xdb'\x8a\xe2l\xb3\xc9D=\xf8 \x9f\xe9EV\xa7Y`-\x04\x8azE3RҌ\xd5%\a\x0fÁ#\x86rݒ/Z\x80;;-\xf1\xf3iʻ\xa4dZ\b\xca\xfd\x8e812\x81\b\xf3\xd0\xe9B\xdc\x13\xf8Z\x10\xe4\x9dQ\xe2\x04\x96V\r\xe3uE>'\xde\x04I\x16\xf0<\xc1HYS.\vj\xa3\x86\x8d5\xd8Z\x97\x049\x9b\xc1\v{`\x05vZn\xf7\xef5T!'\xa9O\x18\xe6&|\xfc,v)%\xd8l&\xd4J\x8aխ\x98^\xdf\x12\x84\xb0\xf0\x86\x04e)\x16\x02\xf2\xc1\xae勹\x80\xbb\xce\x1c\xc0\xf74ij\xb8tu\x85\xadf\x87\xc0\xd7h%\xb9\xe5ش\xab!\xb2\xd4x?\x9c*\x84t\x86~\xe4\x8b\xcf\xf3\x8e{\x8e1>Z\x1c\xde\xef\xc0K\x06\x89C\xbf\x9f\x10'\tq\x9c \x84@)\x99\xa8\x0f\xbcO}&5Vw#C\xabk\xe8\xf4-JAΓ\x0eN\xf2\x9azU1?\xa3\x99\x98\x86\xea\xb8ajΠ\xb5\x98[_\x11\xef6)\xc7\x10\xa8VC@*\x1c4i\xe8\xb7\x05Œ\v\xf0\x0e\xbe,W\x17\x13\xc6\xeb\x9aJY\xec#$\xf7>BOR\xafi&&\xc1H\xc3\xe2\x1b\x12\x04x\x017\xf3\x82\xb4\xf6T\xedB8\xcf\x19\x9b\x80\x89\xba\xa6TT\f8\xc1\\\x96\x067[\x86\x9dv\x8aR\xb0\xb9*\x89\x12\b|M\xb9\x10\x0e\x8d:9Z\xe2\xb6Ssĉ\xdb\xed\xcf_\xbcA\xbb\xdb\xc7\xf7\x15\xb5R\x9e3G\xa7\x10B\x0e!\f\x1c\x04Y\x93Q(\x9e\xe4\xdf\xfc\xee\xe3\xdc\u007f\xd7\x01N\x1c\x1eϮt\xff\x85\x0f\xe2\tR \x85$\xf0\xbd\xcc\xdbH\xd4C\xefV\x9f\x91\x12\x8du\xe9c\x9cX\xb6v\f;\x9d\x14-%\x9e'\x90\x12|-\xa8\x95\x14\a&<FG\x15\xf5)\xc3ܱ\x94\xb1Id\xb9\nڇ\xf6\xb6\xa0\xbb#H:\x02\x9b\n\xac\x15\xe4\n\x0e(\x85p\xa9\xe8ko$\x84\x81d\xb4&\xbfk\xf9!\x80RA21&\xc9\xd5R\x96\xae\tz=g\x94\x81\x01\xfc\xe0&nK@\xe0I&\xa7-a(\x98\x1e\xf7\x87F\x17\xc2U\xe8\xd6XV\xb7\x12\x94\x86n\x1b6\xb7\\\xcdP\xaf\x14ܮ\xc5r\xf2\xd0$\xd3\x13\x19]\"\x04\x8f=u\t\x94\xa5\x1f%\xb4Z\x11\xaf\xbd\xb1\xc1\xf9\x17\xafs\xe9\xda\x06\xeb\x9b;\x18\f\xadV\x8fV\xa7\x83R\x92?|\xe4Y\xde}\xff\x11\x8e\x1d\x1cw\x06ʲ\xa9\xdd{\x13\xc3\xca_+\x89\xef\xe9!_\x16\xc7I\xa2\xa7\xea\x9an\xcfaa/f\x18\x9cz1\\߈\b=I\xb9 ɇ\x12O9\x0f\x18\xab(ƫ\x9a\x9d锵\xf9\x84N\xcfЏ\rݞas\x1d\x96\x16\x04\xeb+\x82\xe6\x96`\xfd\x86\"_\xb2T\xea\x960\x0f~\xce\xf2\xd4s\x11Ͷ\xe6\xecI\x0f\xadxˬ\xca\x18ȇ\x92S\x87|\xca\xe5\x88\x17\x9e\x81\xb5U\x89\n,a\xc1y^\x98\x83^o\xd7(Qd\xb1\x066\x9bɾ\\t\x90\x9e\xd6k\x1eB\xf4\x00\xcb\u0604\xabI\xfaQ\x8c1\xc6-\x9a\x05\xdf\xf7\\\xc1\xea)V\xd7Z\\YXg\xa7\x15\xd1n\xa5M\v7\xac\xb1- \xb1\x10`\xa9!986\x12r\xea\xd8(\xd6\x18N\x1f\x9f\xe2\xe8\xc11\x8c5\x90J\x92\xd4\x19e/@\v!\xb0\x83gk\x91\xc2\xc1\x1c\x80\xf6\xb5 (*\xe2\xd4\x12$\x96N_\x10ņ(\xb6X\xa0\x1f\x1bַ\raW\xa2\xa5\xc0ӂ| ȇ\x92B()\x84>I\nݾ\x83\xbcZ)\xa5>\x9e\x10\xf5-\xad\x1dhn\tV\x16\x057\xaeI\xfa\x11䋖\\\x01\xb6\xd7\x13\xb66-\x0f\xde\xe3Q-\vz\xfd\xb7\x0e\xe0\xa1/\x99\x1b\rPw\xc5,\xad\xa6\xac\\\x17,\\\x95\xd4&,\xa5\x92\xa5\xdb\xdd5H\x1c\x831\x82N\xcf]\xff^FD\n\x10R01\xa2Y\\\x8b\x19\x9d\xb4\xe4C\xc3N_ҏSr\x81\xcb\x04W7\x9b\x84\xbe\xe6+\x8f_\xe4s_\xbe\xc0\xeaj7\x12B\xfekϓ\xff\xd5\U000f89b5hF\x91M\x94\x92!\xc2ԕ\x14\xf7t\xfb\xd1\xff\xfa\xf8S\x8b\xa7\xf3yeG\xeb%\x91\xc4)J\xbb\xda*M\xd3!\xa7\xb5w\x83\x18\xb3\x8bf\x89\xb1$\xb1\x8bK\xea\x93?\xec}\xc6X\xb4\xcc\x16;\xf4%Jf\x15\xac\x10\xf4\x13\x9b1\xa4\x96(\xb1t#\xc3N\xd7\xd0\xecX\x8c\x05_K\x94\x04\xad\x9d\x91FʚɚG\xa5\xa8о%\xc8\x1b&g-\xc7N[&f\xa0\xd7\x11l\xac\b\xb67$\xd7ހ\xe7\x9e7\xf4\xfa\x96\xf9y\x89\xd6o\xed-J\n\xaaEE\x18\nT\x9829eYx]\xd2l@*vQZ\t\xc1\xdc\x01\xf0C\x98\x18\xf1ȅr\x1f\x10v\xfa\x86zEsy!Bk(W\xe0\xcaEE\xa1\xac\xb3\xc0\xee\x82n\xab\x15\xf3\xef\xfe\xe0)\xb6\x1a\xbdsB\x88w\xe4r\xfc\xf9\xe5\xd5\xf4\xeaf\xcb6֛\xa6\xbbն\xfd͖io\xee\xd8\xf5\xdf\xfeU\xff\xb9\xaf|-\xfd\xb7\xe5\xb2J\xbb]\xfb\xf0+\xaf\xaf3Z\xf7\x99\x9f\xa9\x0f\xa1T\b\x81\xdeC\x82\b!\xb2\xa6\x97\x8b'\xd6X\xe2$%I\xd2D\xcd\x1f\xe03\xa35\xa9]j\xe6n.\xf0\\\x96\xa4\xa5 \xf0\xddEƉK\xef\x06x\x98\x1aK\xbbg\xd8n\xa7\xc4iV\x81K\x87\x91R\nr\xa1db\xc4cj\xc4CkAj-\x85\"\xcc\x1f\xb1\x9c\xba\xdd23oў`\xa7!\xb8\xf8\x8a\xe5\x1b_O\xd9ٱ\x8c\x8e\t\xb4\x16h\xfdf\x8a\xa4\x90\x93TK\x9a\x14\xcb\xec!C\x10\b\xb6V$Qj\x11\xd2\x19n\xfe\x80\x83\xb2З\x8c\x8fx\f\x98s)\x1c\xa4\xf9\x1e4v\fݾ\xa1X\x81\xe6\x16\xa4qH1\xefg\x14~\xc47\x1e\xbfDs'\xfa\xfa\xf5\x86yg\xb3g\x9by-\xbf+\xcf\xf7Ǐ$\x1c\x98V\xe6\xf9Kɣ3\xe3r1M\xc4G\x9f{iY\x9e<Zg\xb4V\xc2X\x83β\xaf\xbd\xb1\xc4\x0eY\v\x89R\x8a4M\x89\xa2$Qa\xd1|\xe6\xeab\xaa\xa3\x18r\xa1\xa0T\x94\x18\xe3\x16\xde\xcf\f\x13\xfa\x12ߓC\x18\x19\xf4;\x06\x8f^di\xb6\x1ddE\x89\xc1\xa5\xd0.\xde(\x05\xe5\x82b\xa2\xeeQ\xcc+\xb4t=^\x1dXW\uf732\x8cOB\x98\xb7\\\xbdjy\xec\x1b\x86\xc5\xeb\x16\x93B\xa1\x04\xa5\xe2\xfe\x8c\xccӂzٽO\xaed\x18\x19\xb3\xd8X\xb2\xbd\x05\x9e\a\a\x0e\xba\x80\x9f\x1a\x98\x19\xf7\xf6\xc1Dj,\xfdؒ\x0f%K\xeb1X\x18\x9d\xb0\\\xbe((\x97C\x94\x14\xdcXj\xb1p\xbd\xb5\xa8\x94\xfc\xd1f\xcfnf$\xea\xf7$^\x977\xdc\x05\xaem\xdbg&kj\"I\xc4=\xd7\x16\x1b\xdc}v\x06\xa5\xd4\x1eFX\xee\x0f\xf0{\xbe7\xa9\xa1\x1f%\x89\n\x8c\xfc\x17\x89\xb5bc\xdbpc9es\xdb2Z\x93\xe4sn!\x04\x02%\x9da\n9E>p\x99\x97\xca011\xeeF\a9v?\xb6tz\x86v\xd7\x19(5\xe0{\x12)\xc0\xf7\x04\x95\x82\xa2VR\x8e\xf0\xf3$\xed\x8e!\xc8Y&g`\xf6\xa0e\xf6\x00l7-\xdf~\xd2\xf2\xe2\v\x96\xc6\x16LMIr9\x86\x86\x19\xa4\xb2\xa5\x9c\xc2\v\xa0PK\x19\xad\v֗\x05\x933.y\xb0\xd62Z\xd1C\xe6wP\xe5\xf7\"\x8b\xe7\xc1\xf6NJ\x14[\x94\x868J\xd9i\xf8\xe4\xf3\x1e\xaf\xbd\xbe\x85I\xf8\xfd\xeb\x8d\xf4\xf7\xe7j\xea\xc7+9\xa9\x16\xb6ҕ\xefǱ\xdduB\xb3\xb4a\xf8֟\xcf|\xe1\x8f?\xdb\xfa\x99\x9dVR\x1b\x1f\xcbs`f\x04!w\x19a\x10o\xaa_L\x962wz\xfdT\x15\x94\xfcL{[\xca$\xcdvz\xcbp\xe1\xf5\x84F\xd3R\xafJ\xc2@dP\xb5K\f\x06\x9e\v\xe8\xb9P\x91\xf3\x04\xbe\xe7\xbc*N-X1t\xc9\xd4X\xba}\xc3\xd6NJ\x1c;(T\x99\xebz\xcaU\xe7\xd3c\x1e\x81/i\xf7\x1c[\x9a+\xc0Ԝ\xe5\xd8\x19\x8b\xf6,/\xbd`y\xea[\x06\xad\x04S\xd3\xfb\xab])\x05\x95\xa2\"\xf4$\xb1H\x99\x9e\xb1<\u007fN1>\xe9\x8a\xc9jIS.\xaa}\xb1(\x8e\xdd\xe6ISh\xb4R\x04\x90+X\x96\x17\rZ{\\\xbd\xd2\xeaK%~\xab\x92\x93\xaf\x00\xcf\x01\xa5f\xcf\xfe\xd9\xf7kO,e^\xf2\xeb\xbf\xd7\xe4\xf0\xac\\\xde\xde6\xff}\xa5\x14p\xfc\xe8\x18:\xf3\x92\x01\xe7%\xf6\x18bP\xe5\xafo\xb6\xf8\xf7\u007f\xf4\x94Tc#\xf2/\x92\x88\xfb\xfb-1i\x85\xa0\x1f[\x8c\x81͆\xe1\x85W\x13\xd6\x1b\x06\xdf\xcb,\xacv\xb1\xddZ\x173\x02_\x90\x0f$弢\x94Sh)0V \xb1\xfb\xa8\xed~l\xd9j\xa5t\xfbvxQJ\xba\xa0U\xccI\xa6G=JyE\x9c8\xc3Z\v\xf5q8~ƒ/\xc23\xcf\x18.^\xb0\xe4\xf3\x92J\xc5]\xc7\xc0[\xf2\xa1\xa4V\xd2 -#\x13\x86sOH\xf2EK\xad&\x18\xadzo2b\x9cX\xc2@\xb2\xdeHI\x8d%\f\xa1\xddJh75\x1b\x1bQ,\x84\xf8\x03\xa9x\x8f\xb5|\x14\xb8\xd0\xec\xd9?y\xbb\xbd\xa2ێ*ο\x9a\xbe41\"~J\b5r\xfa\xe88Z9:~\xb0\x19\xc9\xee\u007fP >\xff\xca\x12_\xfb\xe6E>\xf9\x91\xdbzjs\xc7.\x8f\xd7\xe4\x1f\x1b\x83\x17u\xc4\x03&\x11\be\x89\x13\a\x11\x8dm\xcb\xd5Ŕŕ\x94\xe55\xc3ֶ\xc5ZA\xb1 \b\x03\xb1\xafס\xb3`^.H\x8ayE.\x90\x84\xbe\xfb\xf2\x94\xf3\x0ec\x1d\xa4u\xfa\x86nd\x86I\xc0 \x99\xa8W\x9ca=-hw\rq\x02\xb5:\x1c8b\xb1\xc2\xf2\xe2\v\x86K\xaf\tFG\x05\x95\xaa\xc0f=|\x9dyK\xb9(\x19\x9d\xb2\xbc\xf0\xac\x8bs'\x8exCx\x1d\\c\x14[\x02_\xb0\xddJ\xb3\x1a\f\xaaux兄^G$\b\xfe\x1c\xf8\x94\xb5\x1c\x15\x82\xadJN\xfe\x87\xb7k\x90\xe3\xb3\x01\xd7V\x12\xea%\xd9\xe8\xf6\x92\x1f\xb9\xf7\xf6y\x02_#\xa5t|\x98\x94\x80\xc5\xf7\x15\x1b\x8d\x0e\u007f\xfa\xf9g\xe9G1\xef{\xc71r9/U\x00\x9b;\xb6;R\x92_\x91\x8a\xc7\xe2\xbe\xf8\xa9\xb8'\xa4\x9fw\xbb4I\\\x97\xaeӅnϲ\xd10\\YLx\xf9\xf5\x84\x1b\xab\x86N\xd7e.\x85\xfcn\xda:\x808\x95\xa5ҁ'\b\x03W\\\x86\xbe$\xf0v\xab\xe7(v\x81\xb6\xdbKI\xad\xe3\xa9|OR\xca+\xc6k\x1aOKZ\x9d\x14k\xa1R\x83\xe9y\x8b\xc1\xf0\xd5/A\xbf\v\xf3\xf3r\x9f\axZP++\xc6\xc6ᩧRΞ\xf6\xc9\xe7\xdeܓ\x89\x13(\x84\x92\xc5\xd58\x83b'\xa2x\xfde\xa1\xb5&o\r\xb7\x849*I\x8c\x15\x82/6{v\xfd\xed\x18\xe4ڊ\x13\x8f\x95\x02i\xfbQ\xfcw\x1f\xb8\xfb\x10\xc5B\xe0Ⱗ]\x90\u05cao|\xeb5\xbe\xf8\xe8\x05\xde\xf7\x8e\x93\x9c96\x89\x90\x02km\xa2\x1e8\xab\xbd\xeb+\xc6\xe4}a\x176\xcc\x1bռ\xfcC\x93\x8a\x8fEmY\v\n.\x9d\x1cxA\xb7\xebz\x1eR\xba,\xa6\xd92,,\xa5\xbc\xf8j\xc2\v\x17\x13\x16\x97\rI\xe28%\x95\xf5\x1b\x06\xb1g\u061c\x92.\xe6\xe4\x02I!\x14x\xca\xd51B8(iw\r\xfd\xd8U\xaf\x9e\x16\x94\U00092a7a\x87TЏ\\\xb0.U\xe1\xd0\t\xc3ŗ-\xaf]\x84\xb99I\x10\xec\xa7^\xaae\xb7\x01^{\xcdp\xea\xe4~q\x8d\x90\x82$\xb5\xe4\x03\xc9\xcaFB\x9c\xd5Z\xe5\x1alm\b\xd1\xdc\x12',T\x0e\x9d1l.\x8b\x82\x10<\xd6\xecٗ\xbf\x9f\xf8co\xcf\xc8Z\xcaIj\u007f\xe1\xc1\xbb\x0f\xa8J)t\x81](.-\xac\xf1G\x9f{\x1a\x04\xfc\xf4\x8f\xdcC.\xa7\\\xc7\xd1u\x1f\x13U\xf0į\x1e\x9a\x91/^\\0ͬϱQ\xc9ɯYû\xa3\x8e\x1c\u05fe\xa3\xc1\xc5\x1e>\xaa\xd7\x1bT\xc5\xce8\x03f`\xa7m\xb9r=\xe5\xc2\xeb\t\xd7n\xa4l\xed8\x0fJ\x8dk\byZ\f\xff\xd6Q\x1b..\xf9\x9e \xd0.Y\xf0\xb4K\x97\xa3\x04z}Cb\xc0X\xa8\x16\x15Su\x0f\xdfw\tD?\xb6\xcc\x1c\xb0$)\\x\x1ez]\x98\x98\x14\x8e\x19μtzZp\xf1\xa2c\x8e\x8f\x1fWæ\xd6 \x06\x1a\xeb\xaeie3AJ\xd7Ъ\xd4\xe0\x8dW\x05\x9e\x0f\x13s\x86\xe6\x86TI\xc2+ռ|l\xbb\xeb\xc0\xf9\xa6\xc5/Trrta+m\r~\xde\xecYʡ\xac$\x89\xfd\xb9;n\x99\xc8u{\t\xaf\xbc\xbe\xc2s\x17\xae\xd3\xee\xc4|佧y\xf0\xce\xc3Dq\x82I\xdd\xe6\x93Jb\x8cMTN\xca\xcf\xf7\xfb|tnB\x89Ն\xf9v&bX\xad\xe4\xe4g\xad\xe5t\xd4\x15G\x95\x06\xe5\xed\xde\xcc`\xc7'\x893L\x9a\xeeB\x95\xd6\xe0i\xb7\x9b76-\x8b+\x86k7R\xae.\xa6\\_N\xd9\xdaI\x91\xcayQ\xe0\v\x94\x10\xae\rz\x13\xcc\xf9\xda\x19J\nA\x9c\xbal-\x8a-Ŝb\xa4\xa2)\xe7\x15\x9d\xbe\xc1\xcf\x1b\xaa\xa3\xd0ۑ\x9c\u007f\xda2;/\t\xfc\xdd\x1eɡC\x92\x97.\x18\xdam8p@\xee\xabi\x92\x14\xf2\xa1be#&5γ\xfc\x00\xbamA\x94@yĒƂvS\x94\xa4\xe4?\x95Cٯ\xe4$\v[)s5\x15Vr\xf2\xd3\xc0\xff\x01\xfc\xbdf\xcf\xfe\xda\xde8S\xc9Ɋ\x80\xbfُ\xa3\xa2\xa7%\xd3\x13U\xee\xbd\xfd\x10w\xde:G\x18xDI\x82\xb1\x16c,a\xe8\x13\x86\x01B\x90\xa8\x87\x1e\xe6\x97Wo\x88\xf1v\x9b\x0f\x8f\xd7\xe4/N\x8f\xca\xe8\xae[\xbd\x97\x9e\xbe\x98\xac\x97C\xf9_\x80\xf9\xa8#n\x13\"S\x88܄\xc5ֺ\x1bO\x12\xf7l\x8c\xfb\xdeu\xc7\\\x85l\x8c\x83\xbaV۲\xban\xb9t5嵫\t\xd7W\x13zI\xea\x9aQJ\xa0\xd5~\xbeG\x88\xdd\x1a(\xf0\x1c\r\x9f$\x8e=\x0e\xb4d\xb4\xaa\xd1J\xd0\xea'\xe4J)\xb33\x92/}\xc10;+\xc8\xe5w\x13\x8e\xf9\x03\x92\xf3\xe7\r##\x92b1\x93\b\t\a[\x03\"s\xb3\x99:\xbeK\xc0ȘemE\x90+\xb9\xceekSLI\xc1o\\\xdbLw2\x19\xd4\xdf\x05\xbe\x02|\x00\x98\x06\xfeA\xb3g\x9f\xbbI\x1cR\x89b\xf3\xf3?\xf2\xe1\xd3\xc5{o?H\xbd\x96\xa7\xb4G\xe1b\xb3\x16\xb3\x15\x16\xdf\xf3\x06\x9d\xc4D\xbd\xe7\xbd|\xfa\xd0qנj5E\xbe\xdb\xe5C\x1b\x1b\xfcR\xbd(\xee\x94B\x14\x8d\xe5\xb3\xc0\\\xd4\x15G\xd2H\b\x1d\xb8\xb8\xb2G\u007f\xfc&\xe3\xb8\xdc\xda\x19f`\x9c\xc1\xdf\fwg\xe2Do\v\x8b\x96kK)+[\t\xcdvJ\x9c\xbaE\x90Jd\x19X\xc6U\t\xf0\x94\xf3\x1e2\xa6 1P\b$#%M\xbbk\xe8\xa6)\xb7߮\xf8\xea\x97]B22\x92\xb5L\x05T*\x92K\x97,\x87\x0f)w\x1dbW\xb1\xe2y\x82\xf5F2\xf4\x9e \ai\fk+\x82\xb0`\xd9ޔH\xc1\xb9\x82'wʡ\xfcC\xa9\xf8\xfb\xe3\xe3\xf8\x9d\x0e\x00\xbf\x01\xfc\xabJNځ\x87d\x905\xa7\x95\xfdG\x0f\xddw\x98Z%\x8f\x92\x12\xdf\xf7\xf6i\xc0lv\x19r\xa8璉*\x97\xf8\xcc\xf66:Wt.\x9a+\xb8ކ\x81\x13\xc6\xf01c\xf8[i\x8a\x06*i,Dܓ\xc3\xfe\x86\x907\xc9:\aLff\x90\xbd\xde3\xf0\xa0\x81!\a\x10%\xa5\x93v\xee4\x1d\xc4-\xad\x19\xae/\xa7\xacn\xa4\xb4\xfb\x16!,^F\xe1\fy))2\xb5\xe0n\x9fz\xac\xe2:z+[\tGO@cMp\xe5\xaaefέ~\xad*\xb8|\xc9P\xc8Kj\xb5\xdd\xd7\x19k\t|\xc9N\xdb\xd0陡8bt\x02\x16.I\xa4\xb6\xf4;\x82~_\xe4\x80_\xf4\x02\x1ex\xe0] \x8cdy\xc9^\x95\x92\xbf\xb3\xb0\x95nݜ\x16\x97\x02\xf1P\xa5\x12|\xf2\xbe\xdb\xe7(\x15C\xe4\xc0 J\xed[4!\x9c\xe4U;\"0\xd1\x03\x83EQ\xd6\xdf.ZrE\x9b\xe9\x9a M\x84\x8cz\x1c\x8az\xd0\xeb\n\xfa\x1dA{\xc3\x19E*P>(\xcfU\xd5\xd2\x03\xa5A*\xd7ut\x9cXf\x98\xccc\x94r_Z\xef\xfe~o\x0f$\x8a\xa0ӆF\xc3r\xfdF\x82\xef\xb9`}hV13\xea\xe1{.]\x16\xc2\xc9˭p\x14\x8f\xb1\x96\x91\xb2\xa3e\x16\xd6b\xc6\xe6\x12:[\x1e\x9f\xff\\\xca\xc7?\xa1\xe8\xf5\xe1\xfe\a\x15W\xdfH\x99\x9f\x97\xc3l\xcc\xd7\x12c-\x93\xa3\x9a\xf5\xadx\xd8FIS\xb8\xed\x1e\xc3\x13\x8fI\x82<\xb4\x9b|Xy\xc8\xe3\xb7\x1a\x0e\xcek\xbe\xf5\xa8Ai\xfe\xe3\xc2Vz\xe5-\xa5\xafp\xa8R\n\xf1<\x95\xf5[\xd4>1\x85\xfbt\xc7\x1a$I\x8a\xcdJ\x01\xfd\xbd\xf4OHP\xda⇃\x85s\xf8m\rD}A\xaf\rݖ\xa0ׅn/\x13\xbc\xd9\xccs\x14(\xe5\xb8\"/t\x9a+\xa9\x1di\x18\x8b\xfd\x86\x19\xb4y\xf7B\xa0\x94YFׇ7ް\\\xbb\x960:\x9ap\xf2\x88\xc7܄\x1eƛA\r!\x11\xa4YL82\xed\xb3\xdd2P\xb7x\xbe\xe4\x0f~?\xe5\xc7~\\#S\xcbmg5\xfd\xc8\x0e\x05\x17R\x82I\x05ӣ\x1e\xaf]\xeb\xd3\xeb\x9b]\x06\xa0\bcc\xb0\xb9iA\b96c\x99\x9e\x15\xbcqiȫ\xfd\xfaw\xd1>#\xe0\xbd\xf9\x9c\x87R\x8245o\xa9\x8e\x14{\xd47\xadv\xd7)\x1dGG\xf7\a䛟\a\xd0ss0\xf7\x03g\xa8\xea\xa8\x1df,Q\x04Q$H\xa3\f\xa6\"\xf7\xfa~\a:M\x01H\x84\xb4h\x0f\xb4g3\xb1\xb1㝴g\xf1\x02\xc7\xd8\x0e\f4xVY\xaa\xbc\xba\n\xcb+1\xf5Z\u0099c\x1e3\x93\x8aBn\x97\xdf\xd2Z8ُ\x85jI\xba]~\x8b \xf0R\xbe\xfc\xa5\x94\x87\x1fV\xecx)\xa5\x82\"Mw\xa9\x9d\x01J\x9c<\x14r\xee\xa5\x0e{\x1b|s\a\f\xad\x1d\xc1\xf8\xacadܒ\v\x14\xaf/Z\x80\xe7\x16\xb6\xd2Ձ\x01\xf6L\a\f\xbe\xbd\xbbT\xf4\xd1Zb\x8c\x13\xc3\xedBm\x86\x1a\b\x84\x1d$/\xceS\xf5\xec\xdcn\x10\xb6\x96\xe1\r\xbd\x95q\xe2\xd8\xe1\xfd\xc0\x80\x83\xe78v\xd6\xf64x\xda\"\n\f\xf1\xde\x1a\aWib\xdd\xdf\x1b\xf7\x19\x03\xca\xc3f\xd0\xd8k\v\xdaێ\n\x91\xca\xd1\xf1A\x01<\xcf\x0e=I)\xf0\xb5\xa3s\xbey.b\xac.\x98\x1eӴ\x1b\x8aN\xc7 %LNJn\xb9E\x0e\xb3<,\x9c<\xa1\x88c\xf8\xf6\xb7S\x1e~\xd8Q'\xfe ap\xd4\x12\xa9\x11L\x8eh\xea\x15\xcd\xd6N2ԑ\x95\xaaP\xa9\nD`\x9c\x86X\n\xba.\x98?\xf2=\x8a\xc4\n\xd8z\xad\x92C+\x99A\xac@ɬ\xbf.\x06(\xe0vB \xbd\xe1ȃ\xde\x15\x02\xec\xee\xca\xef7\x16p\xb3\xd0y`Ш\xef fP\xd1w:\xeegʂ\f\xf6\xb7/\xddk,q\xec\xbcˍ\xabٌt\x13\xc4]\xe8,\vL,\tK\x86|\xd9y\x92R\x10d\x9e\xb4\xb6ay\xfe\\\xca\xecᄏ\xbc7D\t\xc1\xeb\xaf\x1b~\xf37c~\xe6g<\n\x05\xd7\x1e\xd5Jp\xfbm\x8a\x85\xeb\x86\xe7\x9e5\xdcs\xb7\xc0\xf3$IʮR>\xbb\xae\x93\a\x03\xbe\xf9\\2\x94\x1e\x05!\x04\x81\x8b\x8fq֎\xe8\xba\x00\xfe\xed\x9b<b\xef\xe3A\xdfS\x8cם\x04U+\x81\x1f\xb8\xd4\x16\xc1[*\xed\xdd:ZtcS\xc8b\xd9\xf5\b\xec\xdb\xe0\xcf\xc4[\xc8B\an\x1f\xe6\x9c\x18zdd\xd7\xc0i\n\x1b\x1b\xb0x}\u007fv50\xbe\xe7\xedfhJ\xec\x17\x94%\x89\xa5\u05f7\xb4\x9a\xd0Xq\xbb>W\xb4\xf4B\x978\xe4\v02e\xb8\xf2\x9a\xe4?\xae\xf4\xf9؇|\x8e\x1fw4\xca\xf9\xf3)\x0f=\xa4\x86^)%\xfc\xc8\xc7=~\xeb\xff\x89\x98\x9c\x12\x1c=\xe2\xdc'5\"\xa3n\xdc\xc2\x14\v\x92\xb1\x9afs;\x19n\xb6ك\x96\v\xcfKʣ\x86n\a\xba\x1d\v\xf0\xec\xf7X\xa6\xbf\x1d\x86\x9a\x89\xd1\x02\xa5B\x0e\xad\x1d\u007f\xb57v\xbcy^ѩ\x84\xf5\xd7\xfeR\xfa\x13Ӯ\xa5:s\xc0R(9H\x19\xa4\xa8\u007f\x9d\xf1\xb3\x9b_7Zw\vr}\x81\xb7\xd6cY8s\xdc\xe3\xc4!\xcdHM\x12\xf8\xae\xd6\xd8\xdeqd\xe6Fð\xb1iXZ5\xac\xad@\xb7\xe3\xc8\xc1V\x03\x8ae\x98:d\xe8\xb5\xe1\x91\xcf\xf7\x99\x99P\x1c;\xacy\xe0\x015\x1c\x125ƒ\xa4\x0e\xc3\u007f\xfa\u007f\xf0\xf9/\u007f\x1a3=%)\x15%Ib\x90\x9e\x1cn\x10-$3c\x1e\x8d\x9ddx/\xe5\x1a$1\x14\v\x02\x93\x8a\xc1\xfb\xae\u007fw\xb8\xe2\x03\xf5Z\xc0\xect\x1d\xa5\x1cW%2\xf1\xf7\xae\x18\bd&\x98\x1b\xb6(\xfa\t\xaa\xe4\xcbǚ\r1\xb9rC\x1c]\xb8,Y[\x85(u\x17\x17\x04\xe0\xfb\xfc7?,\x90\xcbA\xbb\xed l\xefV\xe9\xb5\x05Ţ\xe0=\xf7\a\x8c\xd7\xd5P\xb4lq\xd4\xca\xf8\xa8\xe2\xc8\x01\x8f\x13G<n;\xe5q\xeb\x19\xcdȨ@*K\x98\x13\xb4\x1a\xb0\xbe,(\x94]\x1d\x85g\x98\x9b\x15\xccM\xe9}ܕ1.\x13\xf3\x03\x81\xef\xc1\xd5k\x86\xd9\x19\xe9RQ\xc1>UH.k\xf1\x0e*y\xac\xf3䝆\xa0^\x93\\|\xd9\xf6\x80\u007f\xb1\xb7\x18\x1c\xf0X\x95\x9c\xfc\x85\xd4؏\x1d\x98-\x8a$\x15\x1c\x9a\xadc\x8c%\x9f\v\x90J!2c\f j/\xf2$I\x9a\xa8JN&B\xf0{\xd6\xf2\xe7q\xc4\xdc\xf6\xa68\xba\xbc Y\xb8\n\u05ee\vV\xd7\\<\xf0<\xf65\xa7\xbe\x9b\x18\xfa{A]>\a\xeb\xeb\xfbSۍ%ɇ\xdf\x1f0?\xad\xd8;\xcag\xad\x1b\x85\x1b\xad{\x14\x8b\x92BNR\xab*\x0e\xce\a\xdc~K\xc0\x83w\x87Ԫ\x92\x91\xba\xa0R\x83\x85\xd7\x1d\xc1\xa8\x03\xb8\xb1f\x18\xad)*%g\xdcf\xd3\xc5\x1ec-Z\xbb^\xceҲ\xa5X\x82B\xc1\xb5U\x1d3\x90\r\xffhI/\xb6l5\x93ᵔ*\xf0\xea\xf3\x82\xb9\x03\x82W.X\r\xfc˅\xad]\r~F\xa9\x94\x8c\xb1\xffj|ԟ\xfb\x9b?v\x1f\x17/\xafr\xed\xc6&G\xe6\xc7(\x14rC\xe3\x0f0\xcb\xee\x9dk4\x16\xa9d\xa2FJr[\n~Y\b~\xdeZ\x02\x01\x1e\x16/\xe9\xbb\"\xb0Ӓ\xac\xaf\t\xae\\\x86kW\x05\xeb\xeb\xd0\xee8#\r\n;\xcf\xdbMM\xbf\xd7\xc3\x0f\\\x966\x90\x80Z\xebԈ9\xa19rD\xbe\xa9\x0e\x99\x99\x0e2\x05\x8a\x83\xb1 \xd8\xed}\xf8\xbe\xe0\xe0\xbcǡy\x8fњ\xe2\xc8QAԃkW\xdc\xe7,\xae%ԫ\x12%$\xbf\xf6k\x11\xed\xb6e}\xddR*\t\xaa5\xc9\xe4\x84+n-\xae\u05ff7v\x19\xa0ZԼq#\xda3\xed\v\x8bW\x04S3\x82K\xafA\x9a\xf2\xff5{\xb6q\x93w|4I\xcd\xff\xfc\xf1\x0f\x1eWG\x0eLp\xc7\xe9y\xae\xdd\xd8\xe2;\xcf_\xc3\u05caz\xb2\xb0F\x1c\x00\x00 \x00IDAT\xb5H\x10\xe87\xcd,\xa6\xe9\x80!\xb0\x89\xaa\x8f\xf3\xcbҳJzV(\xdfVT\x80'\xbdA\xca(\x90\xda:\x81B\xe8bK{ۉ\tV\x96\x05+\xab\xd0h\xc0v\xd3\xe1\xba\xe79h\xba\xb9\xc8ۻ\xebK%\xb7c\aciR\xc1\x95\xab\x86\x891\xc5\xe8\x88\x18\x06:\vTJ\n\xad\xc50;\tC\xd7IT*\x93&Ŏ5\x9e\x9aԌT%\x93\x93\x92\xb1Q\xc1\v\xcf;qE\xa3\x9dr\xf6\x94\xc7\xe5˖\x9f\xfcI\x8d\xb5\x82/}9\xe5ֳ\x03\x9a\xdf\x11\x8cN\x80\xb0\v[bP\xd3XXͨy)\x1c\xb755%\xb8\ue92dך=\xfb\xe4`ʬ\x92\x93A\x92\x98\xffph\xae<\xfb\xde\a\x8fR(\xe40X\x8e\x1d\x1a\xe7\xf0|\x9d\xeb\xcbM\xbe\xf6\xc4Eڝ\x98\xa3\a\xc7\xd0J\x92\x18\x03\x16\xfa\xfd\x988\x8e\xe9v\xa3D\xd5j|ڂ&\xab\xaeQ\x16\xa1-x\x16\x15X\x84\xe7\x04qIG\x12\xe4-AѢ\x03ǖF\x1dAs]\xb9\xa9\xd6MX^\x81\x1bK.!(\x16\xf9\xae\x99[\xbe\x00ۍ=|\x96\xb2\x9c\u007f\xdap挢R\xdeݩ\x9d\xae\x93\xf7\xb5\xbb\xae\xaf\x1fE\x86\x9d\x96Sh\x14\n\x1ap\vj-\x84\x81\xa4\\\x94ԫ\x82|\xd9r\xee\x9c\xc1\n\xd8\xda1\xdcy\x8b\xc7\xd3\xcf\x18\xee\xbbO\xd2n\xc3ʊev\xd6Q\xff\x89q;E\xaa\f\xb6\x06^b\x9d\x97\\Z\xec\x0f\xb3\xc2f\x03&\xa7$k+\xd0h0\xd7\xec\xd9\xdf\x1c\xd0\xf1\x95\x9c\xfcGa\xa8~\xfa\xbd\x0f\x1e\xe4\xe8\xe1I\xb4\x92\xc31\x87R1\xcf\xd1\x03c\x9c=5\xc7\xd5\xc5\r~\xeb\xf7\x1eck\xbb\xcbу\xe3\xf8Zҋ\x12\xe2$%\x8a\xe3D|\xf0\x83t㘰\xd3\u0382nD&L~\v\xaa\xbd/\xa9\x8cX\x8a\x15\xd7s\x8fcw\xe1i\xe4\xe0\"\xead#\x00\x81\xc5\v,S\xd30;\xe7\xbcBJ\ak\x03#4\xb6`aa\x17\xf6\xe2\bn\\\x15\x94\x8a\x82B\xce\xd10ݞ\xa5\x1f9|\x8d\x12h7\xdd\xfc\xc6\xe9[|\xde\xfd \xdc{\xb7\xc7\xe4\x84\x1e\xd6A\x00˫1\x8dF\xccg\xbf\xdc\xe3\u008b\x96ʸ\xe1\xb6S\x9aΖbn^P.K\x1e{,\xe1'\u007fbW\xa9\x18'\x06\xdfw]L\xef\xa6\x19\x96\x17/\xf5\xb8z\xa3\x8fR\xb0xMp\xe0\x80`\xe1\x92\xe4\xf1G-\xc0\xf8\xc2V\xba6WSǁ\x8bg\x8e\xd7\xf9\xf8\aO1V\xaf\xa0\xb5\x9b1\x04A\xb1\x10\x0e\xd3^\xad\x14\x9e\x92\xfcչ\xd7\xf9\xc2\xd7^\xe4\xf0\xfc8\xc7\x0e\x8d06R&N\x92\x9e\xaa\xe6\xe4?\xcb\x15\xac,\x96\x9c\xb4\xb2\\\x810\xdc]\xbc}\xe7\x8b(K\xaf-0\x91\xa4Z\xb3\x94\xca.\xd0k\x0f\xfc\x1c\x04\x05\x8b\x0e,\x02A\xd2\x1746\x04W\xafH\x96\x97 \xca:\x8ca\xe8^\x93\xcb;\xd5J\xab\xe5~\xae\x14\xd4F!,XT\xce\x10\x96\f\xc5\x11K\xbel)\x8fXj\xa3\x96\xedu\xc1\x1d\xb7\x1d\xe4\x13\x1f\u007f\x98\xe5\xa5*\xdfz\xb2\xcb\xca\xea\x16\x87\x0f\xeb\xe1\xc9\r\xae\xd5l9|@\xb3\xb6nXY\xb1tb\xc3ܬbq\x01\xae\\1<\xfc\xb0B{\x0e\x86\x86\x02\x88\x8c\xfd\xd5j\xbfԨX\x90\xacn&$\x89\xa5Xq\x03I3\x93\x9a\xf3O[\x80F5/\x9f\xb0\x96\xaf\x95K\xdeć\xdes\x84\xc9\xf1\x1aZk\xf7\x95\xc9G\xc3\xd0w1*\x83\xed$5\x1c\x98\xa9\xf1\xfew\x9f\xa1Z\xc9q\xf9\xea&\xaf\xbc\xbeĵ\xc5\r\xabt,?\xddږ\xda\xf3]\x86\xe2\x02\xa6[\xb0B\x11\x8a\x05g\x94\x01\xe6\v\xe5\x84\t\x9d\x86\xc4\xf3!Wp\xd0\x14\xf8.M\x0eC\xd7]\x14Y\x97\xd1\xd3.\xd5]]\x94\xac\xaf\xc2f#\v\x9a\x99\xe1\xc3\x1c$\xb1\xa0\xdbu\x15\xfb`\xcc\xcbf\x14\v\x99Guۂ\x8de\xc1\xd8X\x89\xf7\xbf\xef,'\x8e\x8ds\xe8\xd0<\x17^\xb6<\xf1\xe4U\x1e\xbc?\xc8\xdaʮ\x98\xb4\xd626.\xb9\xf0rJ\xa7\r^\xde\xf2\xc0=\x1eǏ+\x8a\xd9ȶ\xb1\xae\x8aF\xb8\xd1\t)]\vxo\n\xec\xfa/\x82\x8d\xedd\xc8\x02\x8f\x8f\t\xae\xbd!h4\x18\x17\x82\xd3q\x9a~\xe4\x13\x1f:.\xa6\xc6˔\xcb\x05\x94\x14n~0k\x15\x84\xa1\xbf\xaf \x1c\x8cV\xc7IB.\xd0L\x8c\x159qd\x82bދ\xd5HQ\xfe\xb38Bn\xad\t\xa4\x15\xe4\xcb\xfb3\x1d\xedA\xa5\xeaR\xd6n7\x83\x18\tFX\xbaMI\xae`\x87\x19ְ\xb8ҙa\xb4[|\xe59\xefI\x13\xc1֊bc]\xd0\xeeYFG!\x9f\x87\x13G=Ξ\xf19{2`lD\xd1h\x1a\xfa\xfd\xdd\xe0\xe3\x867\x05\x9b\xab\x82$I\xf8ԧ>\xc2ѣG\xa8\xd7k\x9c9}\x80F#\xe4\xb7\xff\xed\x05\xee\xbb\xd7#\x8a\\/\xde\x18\b}\xc1\xc8(\x9c;gܨ\xf3\xa4dtD\xbey*8K\x1a\x94\x12o\x1a\x95\xb6\x16\xaae\xc5\xe2jL\x92\xb8\xe6Y\xa7g\xb9\xe7\x0e\x8fo=a&\x8c\xb5\xf7\xdevjT\xdcvz\x92|.\xa0T\xcc\xe3\xfbz؈\xd2Z\xe1\xfb\xfa&\x83\xec\x06\xaa~\x1c\x93\xc4)\xc6X\xfaQ\x94\xa8\xff\xed\x9f\xe4~9I\xd0&\xc5\x05\xabUA\x90\a\xcfg\xd8Y\xb3\xd6\xfd\xbbRe\x98\xdd\x18\xe3x\"\x12\x89\xe7[⾠\xb9\xedF\x0fZے^w@\xc1\xef\xf6A\x06\xb0\x96F\xb0\xb1\"YZv\xb0\xa7\xb4S\xd6\x17\np\xec\xb0Ͻ\xb7\x87\xccO{n\x1c\xa2o\x89\"g\xf4^[\xb0\xba\xdcgn\xb6\u009dw\x9c\xa0X=\xc4\xec\xb1\x1f\xe2\xde{\xee\xa0R\xf0\xf9\xed\u007f\xf7<\xe3\xe3\x11J\xef\xa9)<\x89\xf2\f/\xbfdA\x1b\x8e\x1f\xd6\xfbk\xa8\xccK\xdc\x04\x96\x1c\x8e\xc2\xed3X\xf6\xbbŵ\x18\x99I\x88\x8aE\xc1̔\xa2\xb9\x19r\xfah\x9d\\\xceczr\x04\xcfS\xf8ޮ\xa6Xe\xb1D\x8a줇=f\x91\xca\x1dŁ\x10DQL\xbf\x17'\xea\u007f\xfc\xf9\xe0\xd3wީ\xf4\xad\xb7*\x0e\x1e\x92\x84\xa1 j\vv\xb6\x05\xed\x1dA\xbf\xeb\x06\xf0\xa5\x14`\\\v5\x8d\x05\x9d\x96 Tn\x12\xea\xf41\x8f\xd7.\xa5l\xaf\v\xee\xbf\xdb\xe7c\x1f\xf6\xa9\xd5,/\xbeh\xc8k\x8f\xc9\xda\bc\xa5\x1a\x8d\xcd\x1e\xdbMC\xa1\nڷt\xb6$\x1b\r\xa7J\xc9\x17-[͔\x1b\xab1\xbd\xc8px\xde\xe3\xec\xe9\x80jYq\xf9jL\x921ν\x8e䅗\xde\xe0\xc3\x1f\xbc\x8b\xe9\xf9\xdb\x11*\x87\xf4*\x1c<4K\xbb\xd1\xe4\xab߸\xc0-g\xa4+2\x05ı\xe3\xe9V\xd7\rK˖\x03\xf3\x8ajy\xff\xd1\x1f2S\xed\x1bc\xf1\xb4do\xfd\xe6\xbe\x17\x94\v\x92V\xc7\xd0h\x19\xb4\x82v\xd7p쐦\xd9\xd4\xf8\xaa@\x1c\xa7\xccL\x8dPȇ\x88\x8cF\xb6@.\xf0\xd1Z\x0fO\x01\x12\x99a\x06m[\xa5$a\xe0\xd3\xebEt\xbaQ\xa2ӌ\xb7\xaaT\x04wޡ8{\xab\x93s\xa6\x89\xa5߇\x95U\xcbƆ\xe5\xfa\xf5\xd4M!\x8dH\xeaw;\xe5\xe0h]\x90\v\x05O=\x1b\xd37\x96\a\xee\xf3\xf9\xa9Oy\xfcɟ$\xecl\xcf\xf3{\xbf\xf2!N\x1d\x9f&\x1f\xf8\xa4\xc6\x1dS\xf4\x85o<\xcf?\xff\x95?C\xe4\"\nuCw[r\xf5\x9aK\x91\x8e\x1e\x85$\xb5\\_\x89X^\x8f\xb9\xe7\x96\x02gNx\\\xbe\xea\xf3\xf4\xf3}\xf2%\x8b\xf6$\x9dn\x9f_\xf8{\xbf\xce_~\xfe,\xe5\xa9iH\x13\xa4\fx\xe0\x81S\xfc\xce\xefU\xf9\xcaW\x1b|\xf0\x03\x9a~\xcf\xf1O\xfe\xb6\xe4\xcc\x19\xc5W\xbf\x9c\xf2\xe43\x11\x87?\x9e\xa3\x1f\xd9=\xea\x13\x8b\xaf\xdd\x02\xf6\xa2\x14ߗþ\x85\xcc\xd8\xda8\xb1\x9c>\x9cc\xb3\x99ҏ\r\x02\xd8h%L\xcd\x18֮u\xf1t\x9e\x97_[\xe4\xde;\x8f\x0e\x19s)%\xdaS\xfb;Q\xbc\x95Pdw6_}\xeaG\xfd\xcf\xec\xed\x1c\x0eb\x80\xef\vr9\xc1ĸ\xe0\xe0A\xc9mg\x15\xb7\x9dU\x1c=*\x99\x9e\x96T+\x02\xcf\x13\x04\x81\xe0\xf7\xff\xa4\x8b\xb0\x92\a\xeer\x1d\xb2\xdf\xf9݄\xc7\x1f\xfd\xe7\xd4C\x9f?z\xe4;\xfcʿ\xf9\x1c͝&\xa7\x8e\xcdp\xf7m\x87\xf8ԏ<\xc0\xf9\xf3K\xac\xaem\xe3\x15R\x84p\x94J>\x0f\x95J&'R\x8e殕\x15\xb7\x9c\fx\xf1\x95\x98^\xe44L\xfd\xae\xa4\xd9l\xf3\x9f\xff\xf8\x8b\xf8b\x1b\x1bm\xf0\xda+\xdf\xe6\xe5W\x16\xf9ι\x8b<\xf5T\x8b\xd11\xc1\xf4\xb4k\xf7\x16BA\x14\xb9l\xeb\xd5\vp\xf8\xa8\xa0Z\xdee\x06\x06'\ri\xbd\v(\x03\xf6w\xb0&\x83q\fߓ\xacn\xc6\xd9Ėe~^\xf3\xcc3\x1dFJUڝ\x1e\x9eV\x8c\xd4Jٌ\x8c\x8f\xe7顧\xed+\x92\x8d%\xea't{1I\x92\xd2h\xb4H\xd24Q'\x8f\xca\u007f\x1a\xe6\xad\x1aHqn\xee\x87\f\x18Ͻ\xddý*\x92\x9d\x96\xe5\xd1'#\n\xa1\xe4\xccq͓\xe7b\x92~\x99\xbf\xf5\v\x1f\xe0\xff\xfc\x95\xcf\xf1\x1b\xff\xfa\x8b$\xeb\xdb<\xf6\xf8e\x9ez\xee\x12\x93S#\x9c83\xc7'>z'\xd3\xd5:\xe7\x9f_dc\xbbK>\x0f++\xae\xd2/\x97]-\x14z\x8eg\x9a\xa8k\xe6g4\x8b\xcb)\xbd4%\b!I\x04\x8d\xad._\xf8\xc2S\xfc\xce\xef\xfc%\u007f\xf4Ǐ\U000d5bde\xa7\xd3\xed\xa2\x94\xe0\xc2\x05\xc3Ĥ`|,k\x02\x05\x82Nd\xd8n\x1a\x16\xae\xc2mg\xd5\xfe9Dܠа\u007f4\xa4R\xdc<\xa0\x13\x9aK\x02O\xb0\xb1\x9dҋ\f\x06\xe8G\x86C\a%\xe7\xcf\xf7\x19\x1b)\xb2\xba\xbeM>\xe73=Y\xc3\xcf2\xad\xb7r\x10\x01t{}\xba\xdd>\x9dn\x9f^/r\xcaū\xaf\xda\u007f\xfe\xd2\xcb)덄\xd4K\xe8Gn\xf6\xc2\xd3\xe2\xfb\x1e\xd23\x18O\xf8\xe6\xb7#\x8a\x05ɡ9\xcd\xe5k\t͆\xcf\xdf\xfe\xb9\xf7r\xeb\x811Vwvx\xfa\x99%\xa6|\xd8Zl\xf2\x8d\xc7_\xa6\xd9\xefs\xff]G8qb\x9aw\xdcq\x84\xe5\xc5&/\xbd\xbaF\xbe\xe8<\xc5\xf3\\1\xa92UI\xa9\xa8\xa9U\x14㣊\xe7_\x8e\xf0\x03ȗ,JK\x8c\x91\x04\xbe$\f$ړà\x99&\xf0ګ\x96\x93\xa7\x14\x95\x8a˜\xe2\b֚\t\x8d\r(\xe6\x04\x93\x13{\xf83\xb1k\x90\xbd\xe3\vvO\xf0\x1f\xa8_\xd2\x04\x96\xb7bw|F\xe2\xb2\xc8\xe9IÅ\x97,c#9\x96W\xb7)\xe4|f&k\xa4\xdf\xe5\xf03k!JR\xe7)QB\xdf\x1d\x1e\x90\xa8R ϵ\x9a\xdcu\xe5\x92\x18\xbdv\x05rՄ\xa5\x8d\x88\x9d\x8e!\x1f8\x91\xf4\xf7\xb2L!/8\xffRJ\xa7gx\xffC\x01\x13㒯>\xda\xe5\u007f\xfa\xc9w\x92\x0f=>\xf0\u07b3\x1c\xbbu\x8aG\xbe\xfa\"\x05c\x91\xfd\x98\xf3O_f\xad\xd3\xe5\x81;\x8fP\xaf\xe4x\xcf\x03Ǒ\x89\xe4\x89'\x17\xd09\xd7\xd0*\x95\xc0\x0f-\x81\x96\xb4;)\x13\xa3\x1e\x85\x9c J,\xd7n\xa4\xae!\x96\xb7\x94G\x1c܄\xf9\xac\xdd:j\xa9OZj\xe3\x96N[\xf0\xado\x1a\x8e\x1d\x93\x94+\x90\xcf\t^\xb9\x94 \xa4\xa5\xb1)8~T\xedI\xd9ŰP\x1c\xb0\xb2ư\xab\x14\x11\x02/\x83\xb5bA\xb1\xd3N\xd9n\xb9\xb6q\x92Z\xa4o\xc8y)\x9b\xeb!\xf9\x9cfy\xa5A>\x1f2R+~\x97\xd1oW\x9f\xe4\xf3!JIvZ]g\x90fϾ\xd6\xec\xd9ߨ\xe4\xe4\xf5\xd6\x0e\xa7._\x145?@\x18aXi$\xacl$\xa4\x06\x063\x88*\x13\x9e\xed\xadr\ny\xc9S\xe7cF\xaa\x82{\xef\xf0X^Ky\xf6\xb9\x16\xef{\xe7\t\xb0p\xec\xf0$w\xdfw\x8c\xa7_\xbbAc\xb5Ih\xe0\xe9\xef\\\xe5\xd1s/s\xf2\xe4\x1c\xa3\xd5\"\xef\xb8\xf7(\xf7\xddv\x80gϯ\xb0\xdd\xea\xb0p\xc3R*9\xdeˤЍ`\xbc\xae9v\xc8#\f\x05;\xed]\x123\xc8[¼\xa3k\xb4\xe7\bK\xedCm\xdc⇖'\x9f0LLHJe\xa8\x94%חSVV-\xe5\x82bzj7\xe3rJ\x16\x89\xdc#\x9d\xb2Cc9\xb2q\x90\x1a\x8fU=Z\xed\x94\xed\xb6\x19\xb6\u007f\ve\xcbʍ\x84\xc0+ \xa5\xe0\xda\xe2\x06\xd3\x135\x8a\x85\xf0\xad\x0fz\x19\xb0\xe0Y\x9d\xd2\xed\xf5\x13UB\x89\x89\x9a\xac\x03+B\xb0\x9c\xa6\x9cY\xbc\"jk\xcb\x12\x93\n\xbc\xc0ЎR\x96Vc6wRw\xa8d\xec\xb2\x02O\xbbqߙI\xc9N\xdb\xf2\xd4\xf9\x98SG5wޮ\xf8\xf7\xff\xe9*k+=\xee<;\x87\xef{\xcc\xcc\xd4y\xffCg\xb0\xe5\x90g_^@wSZ+;\xfc\xf9\x17α\xb6\xd3\xe1\xe0\x81qN\x1d\x9f\xe1}\xef<N),r\xe5\xca&/\xbdҥX\xb1Ԫ\xd0\xefYz}\xc3HU3?\xad\xb9\xf5\x94\xcf\xf1\xc3\x1e\xf33\x9a\xa3\a}\x0e\x1f\xf080\xebb\xcd̔\xc2\xf7]<\xf4B@\x19.\xbch0\xc221\xe14\xc3;]\xc3K/\x1a\x0e\xcd+\xaaU\xa7\xedҞt̮\xdcU\xed\xdbL\xfd7\xa8\xba\x95r\xa4\xa4\x1f\b\xf2\x81bic\xb7`\x94\xca26fy\xf1ň\x91J\x11\xb0,.or\xfc\xf0Ծ\xf3T\\\x03\xce\xee\x93\x06\xe5r>[\x8dv\xa2&F\xe4\x1b\xd6\xf2\x8b\x02\xfe\x0e\xf0!!\x18\xf1<T\x92X\xd67`e\xd1Uȕ\xbaEy\x96\x9d\xb6a\xab\x99\xb2\xba\x99\xb2\xb8\x1e\xb3\xbc\x91\xd0l\xa5\x1c?\xa2Y[\xb3<\xfe\x9d\x98\a\xee\xf6\xc9\x15\f\u007f\xf0\xd978w\xee\x1a\xef\xbc\xeb \xc5R\x9e|\xa0\xb9\xef\xf6\x83\xbc\xe7\x87\xcer\xee\xf5\x1b\xac\xdchPM-\xaf^\xb8\xce\xe7\xff\xea\x056Z]\xdeu\xefqn=9\xcb\xfb\x1e<A\xa8=\xbe\xf2\xe5%d\x90R\xab\xbbq\xb4\xb5̈́|.\x1b\bʹ\x8e\xe2̤bzJs`Fsp\xce\xe3\xf0\xbcǩc>\xb7\x9f\x0e\xb8㖀\xb3\xa7}\x84\x97\xf2\xed\xa7\f\xcb+\x963g$\xdd\x1e\x18e\xf8Γ\x963'\x15A\xe8x,\xa9\x1cǵ\x97>\xb1\x96=\x9d>\x17k<\xedN1\xc2\xc2\xcaF<\xa4Ur98{+|\xe3\x1b]\xc6\xeb%\x92$aiy\x8b\xb3\xa7\x0f\x90\xec\xf6=\x88\xe3d8\x04*\x84\xa0\xd3\xed\xd1l\xb6\x1359&\xfe/\x1dP\x96\xa1\xcd\xeb\x82\rt\xce*\x11\x18\xf0\x1c\r\x1f㸡\xd7/(6\xd7\x04\xe5\x9a\x1b\xd01\x03\xa5al\xd9n\x19\x96\xd6\x13\xcaU\xc3N\xcb\xf2\xad\xef$|\xf0\xa1\x80\xed\xb6\xe1\x85\xd76\xf8Ϗ\xbc\xc0\xa1\xe9*S\x13\xb5\xacQS\xe0\xc7>\xf9\x00q y\xe2\xc5딌\x81\xad>\xcf?w\x85\xdf\xfd췈\x92\x84\x13G\xa6\xb9\xff\xae\xc3\xfc\xe8\x87\xef䛏5\xb8\xf8\xfa6\xa5j\xea\xc6\a6bZ\xed\x94~v\xb8A\x14;\x01^\x94\r\x9c\xf6#\xd7\xe3\xf0\xb4\xab\xd4K\x05ɩc>wީ\xe8\xb4\x04\x8f}\xdd06\x06ݾ\xc5/X.<'8q\xc2\xcd\xc1\x0f\xb4\xc4B\xecf\\b\xb7B\x1czJ>\xaf\b|\xc1Ԙǵ\xa5\x98v\xd7\f\xcft)\x97\x04G\x8f[\x9e=\x9fR-\xe7\xb2\x13J-S\x935\xacq\x8a\xf78Iw\xa1\xaa\xdb\xe7\xfa\xf5u\x8c\xb5\x89*\x8f\xd8\u007f\x8c\xb2\x1e\xf2\xbb\xabKR\xe3(\xf58\x82k\x97\x04[\x1b\x92^'\xcbL\xbc]͔\x1001\x01՚\v\xbc\xb7\x9f\xf2Y\xdb4l\xb4{<\xf2_\x9f\xe7\xe2\xabK(%\x19\x1d)\x92Ӓ\xfb\x1e<\xc9'>z'\x1b\x16\xb6\xda\x1d\xfakm\xcaݔ\x17\x9e\xbeʟ}\xe9iV\xb7\xbaHO\xf3\xa1\x87Orb\xee O\x9f뱰\xd4D\xa9\x04\xe1A\xa3\x91\xb2\xb2\x1e\xb3\xbc\x16\xb3\xb8\x1a\xb3\xbc\x1a\xb3\xbc\x1e\xb3\x92}\xado%l\xef\xa4\xect\f\xadv\x8aI\xe1\xc0A\xc9\xd1\xe3\x82W_5\xf4ڒ8\x86~jX]\x12\x1c9,\xc9g\ax\x0e\x82\xf9\xaeQ\xb2\xe1W\xe9f\\\xb4\x12\x84\xa1$\f%\x93\xa3\x1e\xaf\\\xee\r\xd5-\xdb-\xc3̤\xc2\xd8>/\xbe\x14\x91\xcfy\xec\xec\xb4)\xe4Cj\xd5\"q\x9c\x90$\ued07(NY_\xdfvY\x96%Q\xd5\n\x9f\xb1\x16\xfdVj\x91\xbd\x93O\x03}\xae\xf4\xdc\xce\xda\xda\x14\xac-\v\x96\x17\x05\x9dN&\xa1\x10\x99X\xcesx\x1a\xa5\x86\xbbn\xf1\xb9|\xcd\xe1\xf7\x1b7\xd6y\xec\xdb\x17y\xec\x89K\xaco\xb4\x98\x1f+R+\xe7x\xefCgx\xf0\x1d\xa7\x18=<\xc6\x1b\x9bMn,\xec0a\r7.,\xf2\xe8\xa3\x17x\xe2\xdce\xae\xac\xed\xf0\xae{\x8fq\xef\xe9[\xb8\xf2\x86\xe1\x89\xef\xacR\xac\x19'\x86\x8e,qb\xe9'vx$\xc8`\xe6\xa4\xd77\xb4;n\x96\xb0\x1f\x19\x9a-\xe7Y\a\x0f\tΞ\xd6\xccNh\x9a\x1d\xcbN\xd7p\xf1\x82c\"&&\x04\xc6\xec\x11\xeb\f\x84\x10قh\xed\xb2O\xa5%Z\t\xca%E>P\xbc\xfcF\x1f\x9dm\xccN\xcfr\xea\x84\xe6\xeaB\x9f\x1bK\x86N/\xa6\xd5j\x0fOx\x10B\xd0\xeb\xc74\x1a;\xb4:=\x84\x10Dq\x92\x88w\xbd\x93\xae\x94\x84\x83\xe0\x1fǻ\x82\xb7\xa8\xef\xf2\xec\xbdC:7\xe7Ҟ\aD\x12\x8c\xa06\xee䖕\x8a\xd3f\x05\x81\xc3c_+\xbe\xf2\xf5\x94~\u007f\u05f8IO\"㐟\xfb\xe4\xfd|\xe8\xe1\xd3\xd4*E*\xe5\x02\xda\xf3x\xfe\xe2u\xfe\xdf\xdf}\x8cǿ\xfe\x02'}(Z\xcbV\x02\xabJ\xa1\xc7\n|\xf4\x87n嶓\xb3\xfc\xe6\xef\u007f\x1d\n\xab\x1c?\xbdK\x82\x06\x9e\x1b\x93\x13\xd9YY\xb9\xc0ņ\xc0s\x03\xa9R\x80\xe7\v\a\bټI\xaf\xe76\x9bV\x82\xbfz,\xa5Ղ\x8f\xff\xb0GmdW\xd11\x18\xbb\x13\xc2\x19\xa4^Մ\xa1t\xef)\x1d\x9d\xfeٯ6\xb8\xbc\x1818Ec\xb2\xee1^W\xfc֯\x19f'\xea\xacnv\xb8\xe7\xd6Y>\xf6\xfe\xdbA@\xbbݣ\xdb\xef\xd3\xe9D()h4[=u\xe4\xb0\xf8'R\xb8\x03\x16\x94rJ\xbdB\x11\xaaU\xa8\x8d\xb8z@k\xd7;\x107U\xef\x03y\x8d\x11\x16\xab,\xad\xa6`cٝA\xd2؆\xcd-\xa7^\xecǖ\x99\x99\xdd\xd7)\x85k\x13\xfb1\x8f\u007f\xe7\r\x1e\xfd\xe6딋>\xdb\xdb-\xe28br\xb4\xccO|\xe2>\xfe\xc6O?D\xbf^\xe5խ\x0e\x05RƢ>l\xf6y\xf6\xfc\x02_~\xea\x15\xee\xbb\xfd\bEo\x9co\u007f\xbb\x85\x10)~\xe0\xa4C^V\xe0\r4!\x81\xe7\x82\xf5\xf0\\\x96,\x85\x97bw\xb7\x17\xf2\x0e~o\xbfMs\xe0\xa0b}-\xa5Z\x190\x17\xbb\xb2\xd3\xc1h\xb3\xef\xbb\x13\xf2\xb4\xca\xfa\xf1JP\xafz\\Y\x88\x88\xb2\x99\xc5~l\xa9\x14\x14\xc7O\b\x9e}F2?U\xe6\xfc\x85%<O09^%\x8ecz\xddx\xb8\xb0;;\x9dD\x1c\x9ePv\xf0aB:\x16VJ\xd7\xcb\xd0\xda\xf5\xcf=?\xf3\x04iIS\xd7\xfd\xeb\xf5\x9c\xecf\xb0\xeb\xf7R.6\x15\b#\xc8\xe52)f\xceR(;\xd9M\xe0g'\x8b\xb63\x81Ķ{\x8fn\v\xce\x1c\x9a\xe2\xceS\x87\xb8\xf7\xf69f\xa7\xab\x84\x81O\xbd^\xc6\xf7=^\xbe\xbc\xc6\xf9\x97\x16\xb8\xf6\xd2\x15^z\xfau\xd6\x16:\xa0@\x85\x82\xd2x\x91\x1b\x8d>\xb1\x97R\x1b\x83\xf1q\x98?\x00#u\xe7%:3B!\xa7ȇټ\xbd\xda{\xf0\x99\x18jn\a\x9a`kw\a\x85䞁\u007f\xa5\\\xc5\x1ex\x8aZU\xe1e\x13\xc6\x03\x14y\xf2|\x9b\xbfzfg8'S)H\x0e\xcd\xf8<\xf6\r\xe87&(\x155ז\x9aL\x8cU\x98\xa8\x179}|\x02\xdfS\xf4\xfa1[\x8dVO\xccV\xd4]\b\xa6\x80r\xf65\aL\x02\xb7\b\xc1\x01!\x99\x18*\xd1\xe5nU\xac=\x8b\x97s\x85W\xb7\x0f\x9d\x96\xeb*\x0e<`\xdf`\xa7\x05\x8c\xc0\x0f\\\xf1T(C.o3\x11v\xe6E}\xf7,\xac\xc0\xb7y\x1e\xbe\xe7$\u007f\xe3\xc7\xee\xa3ߏ\x91B\xe0\xfb\x9a\xf1\xf1*\xf9|\x9ef+\xe2\xdc\xf9W\xf9\xd3G\xce\xf1\xd47_gBA\x00$\x02b)\xe8iA\x9a\x87¸\xe0\xc0A8rT\f;\x9f\xc5PQ.(<\xed\xeeG\n\xf0\x83\xdd>\xc5`\xaa)\xcd\x0e\xd4\xd1\xca\xf5-\xf6\x9e\xa2\xad\x95 \x97S\xe4s\x92|\xce\x05v)vO@\xfd\x93/nqi\xd1\xc5\x13c\xe1\xe0\xb4O܅o~\xbd\xc8T}\x04)\x05\xe3c\x15z\xfd\x84\xef<\xbf\xc0\xf1Cc\xdc}\xeb,Q\x1c\xf7\xbe\xaf\xdc-\x93\xb9\xcc\x01w\x01\xf7\x03\xb7\x02g\xb1\x8ceɈ*\x96\xad\x1c\x9d\x02\xbfയ\xcdf\xa6P\xbci$Z\n7\xaa\x90F\xae\xdaϗ\x9cq*U7k\xd7\xdaq\a\"\x83\xf3\x98\xd0\x16\xf9\xa7\xff\xe0C\xccMՆ\a\x16k\xad80;\xc1H\xad\x04\x9ef\xf1\xc6:\xff\xfb\xaf\xfe\x05\xdfy\xf4\x02\xf58E\x98\xecDS\xe3\x12\x8d\xa6\x90\xb4\x14\x8c\x1c\x12\xdcq\xb7\xa0\\\xb5\xe4B\x99\x9d\x83\"\xb2v+o\x1a\xa61\xc6%\a\x9e\xce~7\x8c\x1f\xe0)I\xe0I\u009c\xa3Qr\xa1\x1c\"\x8c\x94\xd0l\xa6\xfc\xfeg7\xd9\xe9\xa4\xc3s\xe8o=\x1a\xf2\xc8#1U\xef\x00\x9e\a\xf5Z\x91\xe3G&\xd0Z\xf1\xec\x85E\xbey\xee\n\xb3\x93\xe5\xf8\xfb\xca۲1\xdff\xb3g_i\xf6\xecW\x9a=\xfb\a͞\xfd\xbf+9\xf9/\x85\xe0/\x81\x95~O\x84[kb\xb6\xb7\xe3\x04mł\xc0W\x02\x1b\vzmA\x1a\tL$\x88\xfb\xee\xe8\xbfA\x1c\xeau$\x1bK\x82\xa5\xab\x82\xb5\x1b\x92\x03s\x8a\x93\xa7%\x8dm\x83P\x90Ȉ\xbf\xfc\xf2\x05\xda;1ӓU\xea\xd5\x02q\x92\xb2\xb6\xb1M\xab\xdd\xc5\u05ca\xb1\x91\x12\x1f\xfd\xef\xee\xe7=\xef:\xc1\x8d8a\xad\xd1b{\xb3\x8f\x9f\x15x!\x96rb\xb1\x1b\xf0\xf2\v\x8eQ\x8e\x04\xa4\u0090\xcb\x0e\xe4\xdc\xfd\xef-2Nh\xcf\xd3`\xae]d\x81\xdd\xf3\xdc9-J\x81\xef)\x82@\x0ecҀ\xd5\rCE\xa5\xa0\xb8\xb4\xd0'\xb5\xbb08:\"y\xe9%C\xbdV\xa0\u05cf\xa9\x8f\x94PJ2=^\xe1\xae[fQJ\xa4o\xeb0\xfe\x9b\x0f^\x99\xab\xa9:\xf0!ky\x97\xb5\x9c0\x86r\x9a2\xdb\xe9 6V\x05\x8duAk[ \xac\xa4\x90s'\xd0\xd5k\x82J\xd15\xb4r\xa1\xa0\x90w\xcci.'\x18\x1d\x17\x1c8(\xb8\xeb.\xc5\x1dg\x15Պ`}ø\xe0\xef\xc1\xcbWVy\xf6\xc5E4\x1e\xa7\x8fM`2q\xd9V\xa3E\xb7\x1b\x11X\x98\x9a\x19\xe5\xfd\xef>\xc3]\xf7\x1e\xa3<]\xe1\x85\xcb\xcbloE\xe4%X\xe9ڰ%,4-+\x97\xe1\xfa\x12\xac\xb7,\xd5zV\x99\x8b\xbd\xad\a\xf7\xff\x80 \x1d\u007f%\x91Y\\u\x86\xf3\x94\xa0XRT*\nϓ{\x8c\xe9\x8c\aP.Jv\x9a\x86խx\x18\xe0\x0f\xcek\x9e9\xdfg\xb4\\%IS\x8a\x85\x80b! IS\xa28\xa1\\\bc\xf16\xfek\x8a\xbd\xff~\x10\xf8%cx\x971\x84R\xe1U\xabBNN\n\x0e\x1dr]\xc4jUP,\xb9\x8c\x05\xeb\x88>Wh\xfd\xff՝y\x90]W}\xe7?\xf7\xdc\xe5\xbd\xfb\xf6\xbd\xf7\xbd\xa5\x96Z\x92%\xabeI\xb6,Y\xb6l\x8cm\f\xa4\xa0\x1826f\t\x19\x18\xc8BRd(f\x03jR\x81J&30\x05x&\x10\xc6\x01j\x06\x1bL\x8c\xc168^\xb1,\vY\xb6\xb0dk\xed\xd6\xd6R\xb7\xa4^\xdf\xd2o\xb9ۙ?\xee\xed\xd7\xdd\xc6a&U\x19\xb0_\xd5-\x95\x9e\xbaZ\xf5\xeey\xf7w\xce\xf9\x9d\xef\xf7\xf3]ұ\xba\xae\xaf01\xb4\xa5Á\xc5U\xde\xe2\xabX\xf6x\xeci\x1bב\x94\xcb\xfe\x1c\xe3\xd6U\xae\xea\xeb\xe3\x0f?\xbc\v]_j\x9d+@\"\x19\xa3\xb7\xbb\x80\xae\xebx\x8eKq\xa1\xc1\xff\xf8\xf6S|\xf7;?\xa7ݓ\xe8K\xa0\xa2&V\xaa\xaa+T\xe3\x82koT\xd8t\x95F\xdcT\t\x85\x96o\b\xfd\xef\xbcP\x84?w\x86}2\\.\xab\x91Lho`͐K\xcav\x15\xc6/4x\xf4\xb9\"Ŋ\u007f\x0f\xfb;\r\xc6NHƎ\xe6\xe8j\x8d\x92NEY\xb3\xaa\r\xc7qq\\\x97Fé\xff\xdf\x06$%%=\xc0\xef\x02\xf7\x18\x06m\xf1\xb8\xc2\xe0*\x85u\xebTz\xfb\x15\"\xe62W\x94\x94x\xac\xdc`JoI,\xb1|\xd9L\x13\x82&\x9bN*ە\xbe\x920\x10\xbdU\xaa\x1e/\x1ctp\x02=p\xb5\n\ve\b\x13\xe1ӿ\xbf\x87\xa1\xfe\x96@-/\x9bz\xd9\xee\x8e\x02\xd9\\\xd2\xefܚaf&g\xf8w_\xfc!\xc7_8I\xa8j\xb1((Q\x80\x06\n\vBPu%k\xaf\x17ܸ['\x95\\V\x82\x94ž\x96\bVV\nɤJK\xde@\b\xffİ\\\x96\x98\xa6\u007f\xc2\xea뮔\x80n\xa7\xe1I\x95\x03/\xcf\xf2\xec\xcb%$К\xd5(d4\xee\xfd\x8a\xca\xf6M\x1d(\nl\xdb\u070f\xe3\xfaT\xa2Riai@\x96?\r\xdd\x19\xf5*\xcf\xe5\x0eO\xf2.]g[\xb6\x00\xadm\xd0\xd5\xed\x97\x16M\xf7U\x85\xb6\xedo$mGb\xbb\x81\xa1\xd3f\xc5Ѩ\x12@\x92U\xe1\x97\x04\x89l\x02 =\xb9\x84\xb2\xf7\xa4l\xb6\x1e\x94e7M\b\xbf\xee\x97\xcb\xfe*\xa9\\\xf1uĕ9\xb8c\xd7U\xbcs\xcfzZ\v\t\x1a\x96\xd3\x14.'\x12\x11\n\xf94\x99T\fE\x15\x10\xd2y\xf2\xc9\xc3|\xf3\x1b\x8fs\xe5\xf0\x052\x1aT\\\xb8\xe2\xc1\xf5\xef\xdd\xc6/\x1ey\t\xbb\xe2\x90[\xa3\xb2m\xb7\xce\xf0\x1a\x15]\xf5\xfd\\Z\xd0\xfd]\xe4\xb3d\xb3\x1a\xb9\xac\x86\xeb\xc1_\xfe\xe72\a^\xb4\x18Z\xad\xb1i\xa3\xc6\x1d\xb7\x85\x89D\x04R*\x84#=\x84\xc3aΞ\x99\xe0\xa1\u007f\x18c\xba\xe8\x121\x05C=\x06\xf7\xfd-\xac\xe9\xe9\xc2\xf5\\\xae\xdd2\xe0۳]\x8f\xe9\xd9\xf2ҀH\xa9\xa2(.\xdd\x19\xf5ˮ˿\x8cFe\xbe\xadG\x12K\xcb\xe6a\x8de\xf9\xa5#\x00;\xaf\xf0\x9c/\xfe\xdd\xf3V\xf6\xfb\xe5\xebϓ\x03\xef\xc7\"5AQ\x96\xce\xf2\x97+\xe9\x17K\x98a,z'\x96\xacӕ\x8a\xbf\x8fq,\x88\xa9qnڶ\x96\xbb~g\xc4\aI\xba^Ӄ\x1e\x8d\x84\xe8\xebi%d\xfa\n\xc0\xf9R\x8d\xaf\u007f\xe3q\x1e\xbbo/\x1b\xe3\xf0\xf3\x12|\xfd\x81\u007f\xc3\u05fe\xfa\xf7\xbc\xfc\xe2(\xb1\x86$\x14\x15\xe4ש\xdcr\xab \x95P\xd1\x02XA\xc8𗸙\xb4FKޗ\xf9\xdcr\xdb\x14\x97\xaex\xe8\x9a\u007fD|\xe3\xee\x10\xff\xf6\xcf\xe2\x84M\x85h|\bM\v\xa3\b\xc9\x0f\u007f\xf4<\a\x8f/\xa0\xa9\xb0\xb6/\xc43O*\x88F\v\x91\x88`\xe4\xaa^̰A\xc3\xf2\xf7!ͪ\xfd\x85/H\x06\n\ua8de\xc7\xddfTF\xcd$\xcc\xce\xf8J\xf7\x99\xcb\n\xb3S\n\xf3\x97\x15J\xb3\x82ʬ\xa0<+\xa8\xcc\t\x16\xe6\x04\v\xf3\xfeU\x9d\x17Ԋ\x82Zi\xe9j\x94\x05\x8d\x05_\x98m7\x14\x1c\xdb_\xf6\xba\xae\x82\xe7\xf8\x93\xa6\xb7\f0`Y\xfe\xa6\xb3V\xf3KT\xb9\xec_\xf5\xfaҠ\x84\xc3\xfe\xc0\xd9\x0ex\xaaūc\x17y\xf8\xd1\xe3\xac\xee/\x90\xcf.\x9d\xd0Y\xb6å\xcbsh\xaa \x16\t\x11\xd65v\xee\x18&;\x90cﾣHE\xf2\xe1?x?\xa7\xc6\xc6xۭ\xdb97?\x87\xbcXf\xe1\xa2\xc7ރ\x12[x\x84c\xbe\xfa_!\x00\x82zJ\xf3\x90\xea\x1dw\x98D#\x82\xd9Y\x8fRYr\xf2\x94\xcb\xc9Q\x87];\f\xc2f\x14\xdd\xc8a\x18&\xaeS\xe1\xf8\xe9Y\x1c\x0f\xd2q\xd5Oh\xb8\x14&\x1a\xd1H\xa7\xa2DL\x83Zݢa\xd9+#\x8frIq\x8bmSw,e\xbaZV\xa6\\K\x99t-\xe5\xa2c)\x93\x8e\xa5L\xba\x8e2)\x1d&\xa5Ǥ\xf4\x98\x90\x1e\xe7\x82?/\a\xefM\x05\u05ec\xf4\x98\x97\x1e%\xcfc\u07b3\x95\x86c)\r\xbb\xaeXVU\xb1\xad\x05\xc5m\x94\x15Q/+\xa2V\x12\xd4K\x82Ƃ\xc0\xae\xfb\x9a/\xd7^\f\x17[\x02\x94y\x9e?\x00\xf5\xfa\x92(|qG\xad\x19 u\x8bǞ>\x81mAgk\x8ad<\xdcdAV\x16|p~\xd84\xd0\x14\x855C\x9d\x14\x86\xbax\xec\x17'(\xcf\xces\xee\xcc$\xd7l[\xc7\xd0\xea\x02?\xde{\x8c\x96\xd6$\xa1\x99\x1a\xe3c\x92ѳ\xa0G%\u0090DL\u007f\xffT\xab\xbbX\xb6\x8f\x80\xba~G\x88\x0f\xdc\x15\xe5\xa6\xdd!\xeau\xc9\xe1#6\x0f<Xcxm\x98U\xab\a\x91J\x84\x88\xa9r\xe4\xb51\x16ꐌ\xa98\xb6\xc2\xc4y\x93D\\#\x93\xf4\ad\xa1Z\xc7q\xbd\xa5\x94\xb6U\xed\xaarj\xc2\xfdЪ\x98\xaa\xd4u\x12\x814\xc8\x01\xec_\xe3T\xab\a}\xdeEZ\x95\xe1\x93\xdfу\xf7\x16E\xad\t\x05\xc2\xc1\xbf\x19\xf8\xbf;\x1a\\9)\xe9t-r\x8e\xa5t\x050\x97NP\u008b\xdd\x01\xa1\xfa|\x15\xa1\xf9*G\xa1\xfa\xf4\bU\xa7\xe9\x96U\x04$\xf3\xf0\xf0s/s\xf8\xc48\xef\xbai\x13;\xb7\xf5\xe1\xb82`\x1a\x16\x11\x02\n\xf9\x14\x86\x027\xee\x1a\xe6\xbf}\xf1\x1e\xfe\xe4\x8f\xff\x86\r\x1dY2\xd94\xa9\x84\xa0\xa33χ>\xfe\x0e\xee\xfd\xdc}$\xeb.\xf6\x05\x97\xe7\xbf\xefqtPe\xcbu\x82\x9d\xdbU\x14\x01ժ\x8b\xebJ\x12u\x9f\x9dҒS\xf8̧\xa3\x1c;f0~\xc1c\xa1\"\xf0\\\v)B\xc4\xe3\x19Z\xf31\xae\xccW\x82\xa0\x97%ޢ\x0f\xfd\x97\xd8\x01Q\\\xf9u\xcb\xdc\xdf\xc4+\xe8\x04\xbc>\x94Q\x0f\xda7\xfd\xc0*\xe0j`+0\xb8\x04'\x0e\xe6\x1e\r\xff,\xdd\x00Ր\xe8\x86\xefgq\x1b\x1a\x1bz{\xf9\xd3\xdf\xdf\xddܰ)(\xb4\xb5fhmI\xfb\xbbo\xa1pl\xf42\x1f\xf8ؽ\xdc|\xdb6\xd2\x19\x9d\xe3\xc7.\xf3\x1f\xff\xfd=|\xe2\xc3\u007fA\xf1\xe2,\x86\xed\x1b6\x1d\x17\x88\vB\xed\x1a7\xee\xd1\xd9z\x8dF<\xaa\x92Jh\xc1\xfc\xa7 \x84\x8aeI\x12\x89\b\x12\x033\xd6O8\\@\xa8\n{\x9f\u007f\x86\x87\x9f<\xcd@\x97A\xa3\"x\xea\xa7Iz;\xa3\x8cl\xec\x05$\xf3\xf3\x15$\xd4W\xa05~Ӄ\xb1\xec\xfft\x82\xab\x99{5>\xe7N\x01G^7x\n0\x82\xbf!\xdd\x02lq-\xb2nC1$\x84@1P@7$\xba\xe9\U0004b8e3|쳗\xf9\xfc\x9f\xdcN{K\x02\xa4\xe4\xc2\xc44\x96m\xd3ՑGE\xb0v\xa0\x85\x1f\xfc\xdd\x1f\xf1\xc1O~\x83\xf4\xdc\x1cC\xef\xdaM\"\x16%֚\xc2\x0e\x19(\r\a\xe7̔\xbf\xeaZ\xf0pNZ<t\xd2\xe6\a\xdf\x16lآq\xe7;\"\xf4\xf5iD\xc2\x1a\xe1\x88F,\x1e\xc3\t \xfcx\x0e\x12\x0fMKQȧ\xd0T߂aY\xfeFTSU?\xa1\xadTœ~yUy\x93\xbd\x16\x89l\xff\xd8&\xb5T\x97\x13\xa5\xba\xdc_\xaa\xcb\x1f\x06j\x99o\xa2\xf0\x98\xa2p@Q8\xa3\xc0\x84\xe7(E\xbb\xae\xa8nC\xc4-\xaf\xceS\xfbO\x92\x8aFh+$0\f\x95\xcaB\x9dj\xb5N<\xe6{\xc8S\xd9\x18\xd7m]ž\x97Nѿn\x80\xab7\x0e\xf0\xe4S\a\xb9fd\x1dۮ[ř\x03G\x03I\x90_\x1a#\x02\xe2\r\xc9ܸ\xcbS?k\xf0¾\x06\xa3c\x0e\x95Z\x98X2MkK\f\x05\rE\x84\t\x85\xf3hz\x84Fc\x8e\xc3GϐKi\xccL+\\\x99\x881*\x98d\x00\x00\x10xIDAT\xd0\xd6\x12%\x9f\x8dS\xae\xd4\x16W\xaaΛn@~\xdd@\xfd#\xef\xd7Juy\xa1T\x97\x87Ju\xf9\xd3R]>\x904\xc5S\n\xdc#T\x92\x89\x1c(\x86˾\x03\xe3\x9c\x1b\x9f\xe7ڑ^\f]\xa3V\xb7(\x96\xaa\xc4\xe3&\xbaP\xc9\xe5\x13l\xd84\xc0w\xef\xdf\xcb\x0f\x1f\xda\xcbk\xaf\x9e\xe7w\xef\xdaC*i2z\xe24\xb3\x9a\x81]k\x90\x0e\xbaʾ\xa7\x1db\x02D\xc5c\xea\x8c͑\xe7+\xbcx\xa0\xc8+\xa7j\xf4\xf5\x17\xe8\xeeʃ\x92@\xd3M\x1ck\x81c'O\x11\x8f\xa8\x8c\x9f\x87\xf2\xacI_w\x9apX\xa3Z\xb5\x16K\xf0[g@\xfe_\xfbm\x81\xbd\xecY\xa1ѕl\xf1\xd0B\x92zI\xa1\xb7%C*\xae\xf1\xb7\xf7\x1fddC\x17\x99t\x04\xdbv\x99\x9d)\x91LD\xd1U\x95l2\u009e]k\xd9\xff\xe4!\xb4\xe2\x1c\x1f\xf9\xd4\xddH\xcf\xe6\xe9\xfd\xc7\x19ٺ\x81\x8a\xdb`\xa8ZF\x05\xaa\xde\xd2\x1ekѲ\xa0+\x12o\xde\xe2\xe2\x94ǡ\x13\x15\x1a\r\x8d\xab6\xf6\xa3\xe91<\xaf\xcaؙ3h\xc2a\xff>HE\x13tw\xa6\xfd\xa8$\xc7\r\x0e\xfb\xe4[{@\x06\xdbT\xf2\x19\xc1LQ\x92\x0e\v\x910\xc5\xfb\xa4䑰I\xaek\xb5\x8b\x19\x01\xbb\x14f\xeb\xba~z\xda\x13(@Gk\x94\xef?r\x84dܤ\xa73\x83'%\xe5J\x8dd\"\x82\xa6\xaa\x98f\x88-ۆ8t\xb1\xcc\xf8\xf84\x86\xa1\xf1\xf3\xbdGٸq\x90\x8dWu\xf3гǨ\xe7\xe3lP,\xc2\x01\x9d\xd5]\xec\xf6H\u007f9Ҳy\r\xc3\xc3\xc3<\xf3\xec\x18\xb6c\xb0is'\xa5R\x85\xe3'\x8eШ;\xec{\xd6`\xed@\x9e\\:Fò\xb1,\x87\xcaB\x83\xa9\x99\xca[w@\x06ZU\xc6.\xb9\xcc\x14%\xeb\xfa\xd5\xd5\r\x8b\xbf\x90\x1e\u007f\x9ei\x91\xe1\xf6~\x8fJ\x11\xb2\xe1\x16n\xbfa\x98\xf5C-Ģ!\x8a\xe5\x1a\xae\xe3R\xc8F8x\xf8\x02\xe5\x8a\xcd\xe6\r\x9dTk\x16\x95j\x8dT`G\x8b'\"ܼc\x88\a\x1f\xda\xc7\xfd_\u007f\x94\xb6\xc6\x02w~\xf8NR)\x93\xbd\x87O\xf3\x91\u007f\xfd^~\xbc\xef\b\xd7\x1a\x92\xcb.d5\x88\x06\xed~Ł+\xd4\xf9җ>ž\xfd\x87y\xe9\xc0YBa\xe8\xed7\x18\x1d}\x85\xc7\u007f\xeaВj%\x95\b\x91H\x84\x99\x9a.33We\xa1j\xbfuKVoN\xe5\xcc\x15\x17)U\xbe\xfd\xdf\xc5\u007f\xaa-\xf0U\x05v\xb5\xf7{\"\x91\x95\\>\xab\xb1\xe7\x9aav\x8c\xf4\x115\r\\\x0f\xd2\xc9(\xb1X\x98\x8b\x97\xe6P\x85 \x990\x18=7\xc5\xd3\xfbN\xb3\xfb\xba\x01l\xcbev\xbeBK!\x8d\xe2\xf9\aa{v\x0ec\xe1r\xe4\x97gI\xf7\xb6\xd2\xd7\xd7Ɖ\x13\x17yϻwrd\xec\"\xed\xc5\x19\xf4\x1d\x1by\xb5Tc\xbdka\x19\n]a\x1f)\xfe\xea\x95YTQgMo\vccӸ\xf2\"\x13\x93\x97y\xe5`\x98\xae\xb6$\x86\xa1S,\xd7(\x16k\xc1\n\xeb-4\x87\xbc\x8e\x93\xabx\x1eF:*\xae\xff\xf2_\xf2\xa8\xeb\xf0\x9eXRƺ\x06}\xa4\xab\xd6ȐK\xc7x\xfb\xee5\xb8\xae\x87\xaa\xaa$⦟5\x95\x8a23W\xa3Z\xad\x83\x02ɘ\x81\x94\x0eO\xec\x1de\xcb\xc6\x1e_\xf91_!\x9bI\xfa\x02\bU\xb0}\xeb\x10\x13\xb6\xcb\xfd\xdf\xfd\x19\x8f\xfe\xe89:z{\xb8\xfd\xb6mL\xcf^\xe1\xe8\xbeS\xac\xbfq3k\xb7\xafa\xefޣ\xccw\x14\x98\xcb\xc4鏅yu\xba\x82\xa9\xcfp\xf9\xca\x1c_\xfd¿\xe23\x9f\xff\t\xfb\xf6\xd5\x18\xeek\v\xf8\xf3.\xf5\xba\xfdz\x15\x8f#\xde\xec\x03\xb1|\u007fԕV\xd7H\xc9Ǥ\xe49)y&\x92\x90Ý\xab<\xd2-\x1e\xc2Jp\xed\x9au|\xe2\xee\xedH)\xb92]!\x12\t\x91JF\b\x85t4M%\x142\xb8y\xe7ZL3\xec+\b\xa5$\x16\xd5\xd1U\x8fo}\xefy\x1a\x96C\xadnq\xf6\xfc\xa5%\xfc\x85\xe7\xf1\x99O\xdd\xc9\a?\xf2vn\xc8Fi\x8b+\xa8\xaaF>\x97d\xde\xf1w\xa6{vm\xa6\xd1\xdbBw,\xcc]\x1f\xbe\x95\x17g*t\xb4\xa5\x18\x19\xb9\x89\x13\xa3\xd3x8|\xe2\x9e;h\xcbd0\f?KD\xbe!jI\xa2\xbe\x99\ac\xd9@\xecJ\x9a\xe2\xaf=\x97?\xd34\xeeδȎ\xf6>I,%\xb1*\x06[\x86\x86ر\xb9\x9f\xde\xee\f\xf5\x86M\u007fW\x8e\xa7_8ŵ#\xfd\xa4\x12>\xb3ʌ\x18MWS{k\x86\xb1\xb3W\x82\xd0a\x053\xacQ,U\xd9\u007f\xe8\x1c\xbb\xb6\xae\xa2ް\x88\xc5\"\x18\xba\xef\r\xc4q\xb9fd\x80H\u007f\a\x0f\xfc`\x1f\xcf\xed}\x85\x97\x0e\x9ff\xab]\xc6ܾ\x995C=Tk\x15\xf6\xbf:\xc9\xdbo\xd9D\xd9rx\xf5\xc8\x04\xef~\xe7v\xfe\xfeG/r\xed5=\x98\xa1\x10\a^>I\xd44\xde\x10=\x12\xd8\xda\u07bcOH@\x8f\x1e\xeeJ\xab\x8fKɓB\xf0\x9e\x8e~\xd9;4\xe2\xd29\xe0Q\xafI\x9c\xf9\x14wݾ\x8d\xad\x1b\xbbh\xcd'0C\x06\xb9t\x9c\xc1\xbe\x02\xe9D\x84\x8b\x93\xf3\x18\x86\x8e\xa6\xab~:\xb3\xeecZ[\xf2\tn\xb8n\x18U\xa8M,y&e\x12\xd2\xe1s\xff\xf5\x11\xa4\a\x17'\xa6Q\x96\u007f\x93m\x87\xebG\x06\xf8\xeb/}\x90\xf9K\xd3|\xb44E\x97)\x90\x9aF,\x96 \x16\r\x11\x0eG\x88\xc5b\xec\xda1L\xa9\\'\x97K\x10\nG87>KG[\x02#\x14j\xd2#\x96?!\xcb=\x86\xe2\xcdR\x96\x16燮\xb4\xdaޕV\xdfٙR\xf7\x01\xaf\x19!ni\xed\xf6\xb4\xf5\xdb]\n\x9d\x1ev\x03\xa6΅\xd9Ի\x96O\xdc}-\x89x\xb8\x99\x05h\x9a!r\xd9$\xad-ivn[ŉ3SH\xe95\xb3\xb3\x84\xba\x94)8\xbc\xba\x8d뷯i\x9e\x81K)\x89\x9a\x1a\x91\x90\xc2\u05fe\xf3s\xa6fJL^\x9aE\x11\xa2\x99\x80\xe3y\x1ek\x87\xda\xf9\xdf\xdf\xfdS\xfeW\xbe\x9dQKp葟\xf3\x8b\x17\x0e3:v\x99l*J{{;\xa9T\x14E\x80\x191\x89Dt\xa6\xa6*d\x92q\xda[R\xcc\x16k\xd4\x1b6n\xd0\\|\xbd\x84W\xfdmO\xd4\xcb&\xeb\x1d\xc0\x1fy\x1e_\xf2<>\x19MȮ\xf6\x1eɪ\xf5\x1e\xdd\x03P-C\xad\xa8ӓ\xe9嶝ì\x1e\xc8a;\x1e\xba\xae\xa1\xeb*\xb1X\x84\x8e\xd6,Q3\x8c\xa1\xab\x84\xc3\x06\x87^=O\u007fw\x9ePP\xb7W\xe6\xbbKz:s\x84\f\x8d\x93c\x93>\xe0\x18\b\xeb*\xd33e&.\x97Y3\xe0\x87\xb1D\xa3a\x1a\r\x9b\x89\xc9\x19̰A$\x12\xe2}wneL\xd19\xf3\xc2\x11.=\xfa<\xb1\x8b\x13ر\b\x99\xc1\x1e\xfe\xe1\xe9_R>y\x96s\xe5:\xea\xd9\xd3\xf4\f\xf7\xb3~\xa8\x9dB&ʏ\x9f<J,\xa2\xa1\xabo\xe8\xce\xfd\xed\xad\xb2\x96\r\xc4;\x92\xa6\xf8\x9a\xe7\xf1i\xd7\xe1\xa6|\xab\xccl\xda.Y\xb7I\xd2\xd3\xef\xa37^;\x04\xbd\xb9>v\x8fl`u\u007f\x8ePXG(\xbe\xbf\xdb0|\xaaZ[!\x83a\xe8M;r\xd44\x98\xb8T\xa4\xba`\xd1ӕm*\xe0\x14\xa1\xa0\xab~\xc0\x8a븴\x16Ҕ+5.Mͣ\x06\f\xbeh\xd4\xe0\xf4\xf9i\xe6\xcb\r֭n%\x1211t\x8d\xb9b\x99\xc9\xc9Y\xe2\xb1\b\xba\xa1\xb1y}7\x83#\xaby\xf8\xc5Q\x86\xdd:\xd7xu\xf6=\xff\n\xa93gx\x87\xe9\x91=?\x8e\xbb\xd0`\xf0\x96\xab\x19\xe8)P]\xa8\xd3ם\xe1\xa7O\x1f\xa35\x17\xfbUn\xd6oc\xa7ޕVդ)\x12IS|,i\x8aGP\xf8=!\xe8o\xeb\x94\xe1\x9do\x93\xac\xdb\xec\xd3\x1dt\x1d\xaeL\bF_I\xf0;{\xb62\xbc\xaa\x8dP\xc8O\xa8\t\x85tB\x86\x1f\x8e\xa2\x049؆\xa1\x132t,ˡZo`\x9a\x06k\x06[\xf9\xf2\xb7\x9e\xe0\xed\xbb\xd6\xe3I\x0f\xa1\x88 \v*@2\t?\x8a\xa8%\x9f\xe4\xf4\xb9+X\xc1\xb9\xbcğS^>2\x8e\xa1\xe9\xac]\xddF8l`\x18:\x97\xae\xcc155\x0fH\x121\x93B.\xce\xfb\xde\u007f\x03\x0f_,\xb1\xff\xb5\xf3\xdc\x11v\xe9\xd1\xc0P \xaf*<>\xef\xf2\x81O\u070e&\x04\u007f\xfc\xf9\xef\xf1\xe0c/\xb3\xba7\x8ba\xa8\xbf\x021\xf3\xe4\xff\xc7'\xe4\r\xb4\\\xb1\xa4)\xaeC\xf2)O\xf2w\x9aƻS\x19\"\xdd\xfd\x1e#;<\xd6l\x90\x84L\x1f69u\t.\x8e\xc6Ʌ\ax\xef\xed\x1b\x88\x9az\x00\x15ӈ\x98!r\xe9\x04\x91H\x98\x86\xe5\xf8ѥ\xaa\xc0\xb2lJ\xe5*\xe5r\x95PH'\x165\xd15\x95h8\xc4O\x9f}\x8d\xeb\xb6\f\"\x83\xfa\xe0\x9f\xb9\xfb1v~\xcat\b]W9}\xee\U0008a496KEx\xe1\xd0Y\x06\xfb\n\xf4v\xe5Q\x85϶\xaa7\xfc\xc6\xe4\\\xb1\x82\x19\x0e\x11\xd2T\xf6\xec\xd9H\xf7\xa6U<8\xb1\xc0\xe5\xaa\xc5\xfc\xfc\x02\x97*6\xe7\xda\xf2\xfc\xdeGo\a\xd7\xe1\x99\xfd\xc7\xe8.\xa4\b\x87\xc5\n\x92\x84\xe7y\x81\xf1\xc8\xf5\x94\xdf\xc0\x13\xb1\nx?\x92\x9b\x1c\x97\xedB%\xdc; \xe9\x1e\x90dr\x123\x12\xe8\x815\x98\xbc\x00\x17\xcf\xe8\xac\xeb\x1dd\xa8\xbf\x85L*\x12l\xee\x04\xba\xa6\"TA:\x11\xa3\x90K\xa1\x00\xd3se\x8a\xe5\n\x85\\\x1a3dPk4\x98\x99-\x91\xcb%I%\xfc\x92\xa0k*\xff\xf3\x81}\xe42qn\xbda-\x96\xe5\xac\x10\xb6-\xa9[\x04O?\xff\x1a\xfb_:\x85\xae/a\xf8ju\x1b\xcbQ\xf8\xe6\u007f\xf9(\x89h\x98\xb9\xf92g\xc7/c\x18:\xba\xaa\"\x91\x14\xf2)\xb2\xe9\x84/\x04\xf6<\x8e\x8d]\xe6Թ+\x94fJ\\s\xf5\x00C\x83m\xcc\xcc\x14\xf9\x0f\u007f\xf5 \x8dj\xad\xe97\xf4\x89D^\x10\xb0&\xf8\xe5\xf1+\x9e\xfa\xcf\xf9D,\x0f`\xecJ\xabۓ\xa6\xf8\x0e\xf0\x05)\xb9YU\xe9\x1fZ/\xb5kw{t\x0fH\xe2I\u007f\x10\b\xbc\xde\a\xf6B\x9c.\xee\xdc=Bw{\x1a3샇uC%\x14\xac\xa2|\u007f\x9fJ,j\xa2\b\x85j\xadA*\x11#\x95\x88\xa2i*\xe1\x90\x11tn\xf5\xa6G\xd0\xf3$C\x03\xad<\xbb\xef$\xe9d\x84t*\xfa+\x1cv)%\x8e\xe7\xd1ە\xe7\xf0\xb1q\x1c\xdbi\x1eI\x1a\x9a\xcal\xb1\xca\xe8\x99+\xdcr\xf3&\x84\xe7\xe7\xa0\u007f\xfb\xc1}|\xe3{\xcfq\xe6\xfc\f٤IK.\x89\x1e\x84p\xe53Q\x86\xfaZذ\xb6\x8b\\&N\xa9\\\xe5\xfc\xf8\x15N\x9e\xbeL\xb1\\C\x0f\x02\x00\x16i\xd6\x02\x85\x89K\v4\xd4bC\xfd\xe7*IISh@:i\x8a[\x12a\xf1\xa0\xa2\xf0YM\xa3\xd7\bat\xf5J\xb1\xf3m\x1e]}>\x9c\u007fQ\x9c\xd0h\xc0\xa5\xf3:\xe3\xc7\xd3\\\u007f\xf5\x1al\xd7eM\u007f\xc1\x9fxu\rC_\\\x8d,\xdd@\xcbrX\xa8թ\xd5-$\x101C\x18\xfa\xd2\xc1\xa7hz\x03\x97\xc4]\xba&\xc8g\x13<\xfe\xcck\f\xafj\vHoK\xe5\xcb\xf5$\x9e\xe7R*.\x90\xcd\xc489vi\x85\xe93\x19\v\xf1¡\xb3\x18Be˖~^=:\xce\xdf|\xf79z\xda\x12\\\x9a.\xf2\xc0O^b\xdfKc\f\xafn'\x9d\x8a\"\x82\x14\xe8ň\xbc\xc9\xc9\x19\x1a\r\x8b_\x1e\x9b\xa0R\xa96\xd3\xea\x16kV\xddr\xb90=G\xbc`\xff\xd3\xe7\x907X\xaev'M\xf16)\xf9\xb8\xeb\xf2E!\xf8d*M\xa1\xb3W2\xb4A\xb2aD\xd2?$\x97\x14\"\x1a\xccL\xc1\xe4y\x1d{\xae\x8d\xc1\xb6Anٹ\x8a\x8e\xd64\x0f?\xf1\x1a\xbb\xb7\xaf\x0e\xf4\xb3js\x8f\xb0x\xe3\xc2A\vD\xd3\xd5&\x8fJ\x04\xe9gB\xac\f\xff]Q\x8f\x80B.\x8e\xe3z<\xf4\xb3Wش\xae\v]\xf7\x01\xc7\xd2\xf3h4l\xaa\xb5\x06\xae\x94\x18\x9aJ\xb9\xd2`\xbeXm\xfa\n\xa5\x94\xb4\xe6\xe2<\xbb\xff$\xae\xadpv|\x8e\xd9\xf99ߙe\xa8\xb4f\xe3,T\xeb\xfc\xe0'/11U\"\x95\x8c\xd2\xda[\x00\xc7Az\x92T2J\xadn\xf1\xe2/\xcfQ*U|\xd2\\\xf0{\x85\xa2pv\xa2\x84\x12\xab\x10\n\xe3h\xff\xd4VF\xb0\x83V\x80\xf7\x00\xf7x\x1e\x1b<\x9763J\xa8{@\xd2\xd5'\x89\xc6\xfc\xb9a1\x89Ƕ}\xd1[\xbd\x06\x87\x0e@O\xa1\x83\x1dý\xe4ґ\x00\x87\xa4\xf8Жd\x8cb\xb9J2\x1eY\xb9\x93\x05Z\xf2i\xe2\xb10\x9e'\x99+V\xfc\x95UP\xca\xea\r\x1bMsQU\x15ϓ\x81\xb6V4\r\xff\x00\r\xcb\xe1ڑ~\x12q\x93\xbf\xba\xf7q>\xfb\a\xb7\"\x91,,Ա]ח\xc1z\x92zæ\xad\x90`\xf2\xf2<\x8e\xe3.[\x92zt\xb5\xc5x\xecɃTk.\xb1\xc8\xd2\xc4\xecJ\x8fHX\xc7\fi\x1c8x\x9c\x83/\x8f\xb2j\xb0\x83\xcf|\xf2\x0e\xf2\x1d\x19\xa85ho\xcd\xe2x>d`\xf1\xb3\xa9Bar\xaaJ\xc9)\x92O\xf8\x1fT}\xa3\x1b\u007f\xefW\"\xfc\xf0'~'\xb23\xa5\x92\x8a\xf8솤)V%M\xf19\xe0~\x14\xeeV5\x86:\xbae\xea\xaa-R\xbbf\xa7G[\x97\xcfco\xf2\xbb\x96%$\x9c\x19U8w4ɻn\x1ca\xe3\x9a.L\xd3/GZpSuM\xc50TN\x8cN\xb3\xa6?\xdf\xd4T\xf9J\xf2\b\xf9L\x025\bgT\x02\xfc\x85\xffd\xa8\x01w*\xf8\xc6/\x96.!\x02Rܲ\x00`O\x92\xcbD\xc9e\xe3\xdcw\xff\v\xf4wg\x9b!\xf3\x8e\xe30_\xac\xb0Pk\x101\r\xe6\x8b\v,Ԭי`}\x91\\\xd4T\x9b\xa6\xd2\u05ff̰N$\xa41;W\xe2k\xf7=\xc1\xf1\xe3\x93\xf4tgi\xed\xcc\xf3\xb3'\x0eS\xaf֚`\x82b\xc5\xe6\xe4\x85)\xda\xfb\xbd\xc5{\xe5(\x007l\x0e\xf1\xec\xcb+\x13\xe6w^\xad\x9b\xe7\xcf{\xed\x9eK\x9f\xe7\xab<\xfe\x85\x11bȌ@&'\xe9\x19\xf0Ӟ5=\x88\xb8x=\xc5T\xf8\x1a\xdc\xe2\xac\xc2\xdcD\x92\x81\xf6N\xb6n\xeah\x1e{.\xa6^\xfa\xcbO\xff\xc6\x19\x9a\xc6W\xbe\xf54\u007f\xf8\xa1\x1b\x9ag\x04B\xa8\x14r)\xe2\x8bx\nE\xe0y.\xf5\x86\xd54\u074b\x00\x04\xe6\x05FHM\xf5#`\xe5\x1by\xbc\x83\x1b\xfb\xcc\v'8p\xe8\f\xb7߸\x9ed\"\xc4\xccl\x89\x86e\xfb\x83\xab(L\xcfU8r\xfcb\xf3)Y\x92\xcb\xca\xe6\xe0\xdav\x10\xed\xc6R/J\xd3D\xa0(\xf1?\xdb\xd4\\\x85\xb3\x17\x8ad3qB\x9aF>\xeb\x87L\x96\x17lN\x8dO\xd1ڿ\xa2\x05\xbfR\xfd~\xf3\x0e}\xd5\xf8Yo\xa3\xeb\xb0Ѳ\xb9ڶY\xabi\xf4g\xf3\x92\\+\xe4[%\xc94\xc4\xe2r\x05\x9au\xf9K\xa8\xfe\x13q\xea8hv\x9aޖN\x06\xfb\xb2$\xe3a\xbff\n50\xc8\bT\xb1<\xa5\xd3\u007f\xef\xf9\x83\xa7ɦ\xa3\xac_݊\xaa\xa9\xa8B%\x1e3I\xc6#(\xc2w\u05fa\x9e\xe7\u07fce7x\xd1!\xab\xaajsb_..\x16B\xe05ٷ\xfe\xd3w\xe0\xd0\x19\x1ey\xf2\x156\xad뤻-ռ\xf9\xae'\xb1l\x9bc\xa7&)\x95\xebHo\xc9J\xd1ăK\x9a\xfb _\xb6ꡪ~\xe9U\x82\x9f\xf3WR4\x15\xfa\x9a\xe6\x0f\xd2\xcc\\\x95K\xb3e\xd2\x1d\x16\xfaJ\xb6~]۴Z\xfdx\xa3\xa6\xbc۲\xe4Uc'=SJBB\x10\xca\x16\xa4\xec\xea\x93N{\x97\xac\xeb\x06+VG\uebd1o]8\x8b>>\x1a\xe6\xfa\xabW[\xfd\xddY\xc5\xd0Ut\xdd\xef%\xf9.V\x11\x04c\xadT\xe9\xf9\xab\x1a\xc9꾂r\xf8\xd8E\xb1i]\xa7\xabkj\xd0\x02\xf7ѪF\xd0}U\xf0mî\xe75\xbf\xb5B\b\xb4ey\xe5\xcbM\x9b\xb6\xe3R.\x97}\x85I\xc0\xd2\xf5<\xb8z}\x0f\x86&\xf8\xfe#/\xa9\xf3\xc5\x05e\xed`\x8b\xe3\xba\x1e\x96\xed\xa0\xa0\x90IŰ,\x87Z\xed\x8dś~괤V\xb7\xb9<\xed\xcfk\x9a*\x02N\xaf\x82\xa1\xa9\x01B\xca\xff\xd9r\xa5\xc1\xf4\xec\x02Ūm\x14z\x1dK\xff\u0560\x83\xc6\xff\x01\"A\x1dS*8\x8d\xe1\x00\x00\x00\x00IEND\xaeB`\x82")
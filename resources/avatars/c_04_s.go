@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_04_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1c\x0e<\x06\x89\xed\x00\x00 \x00IDATx\xda\xec\xbdy\x94e\xd7u\xde\xf7\xdb\xe7\xdc\xf9\r\xf5j\x1ez\xee\x06\xd0\x18\xba\t\x10 )R$%\x93\x8aHQ\x94(\x89\x9a\xb5\x12\xca\x11e\xc7\xf6\x8a#Jv\x1c%\xa1\xccXJ\xacxɚ\x12\xdbr\x1cٲ\xbcLE\x83e+\x92\"\x89\"EJ\xd4\x00\x89\x13H\xa0\t\x80\x00\b\xf4P]\xddU]ӛ\xefxN\xfe8\xf7\xbd\xaa\x06@\xa0A\xd22W\u008b\xf5V5\xaa^\xbd\xf7\xee\xd9g\xef\xfd\xedo\u007f\xfb\x14|\xf9\xfa\xf2\xf5\xe5\xeb\xcbח\xaf/__\xbe\xbe|}\xf9\xfa\xff\xe1\xb5vNs\xeeM\xfa\x96\x9e\xf7\xff\x85K\xbe\x94\x16~\xe3B5\xfd\xff#\xe7\xb52\x06\x1f\xf0\xb0\xe8 \xe1\x985\xbc\xd1ZNbi\x1a\x83 \\\xc4\xf0G\xa6\xe2I\xa5\x18[(DȮ>R\xd9\xe7{\xcd/\x85\xfb\xfa\x927ȳ?\xf0\xea]\xfa\xa4\x85\xb3^\xc0\xab\xb4ϽJq\x17p\xb7\xf2@{\xa05(\x0fD\xc0ZK\x95\vE\n阽\xaa\xe4ϕ\xe2\x0f\x80\a\x81\x8fl\\\xa8\xca\xffT\xf7u\xfbk4O>xp_k\xf7镻\xceY\xbb\xb0\xcc֯\xfc\xa4\xb1_\x92\x069l\x8c\xb5s\xfam\xd6\xf2\x0e?\xb2\xf7\xf8\x01g\xc2\x04\xdf\v\xc1\xab\r\xa1\xb4 \x02J9\xa3\x803\x8ah0%d#ع\x06\xc3.(\xcd\x1e\xf0g\xc0\x8fm\\\xa8\xfe\xe2/\xdb[\x0e\xbfW\xb4\xa6\xbfki\x89\xef\xb9\xeb.{jw\x9fG\xad\xe5{?\xf6{&\xfd\x922ȳ\f\xf1\x1a,\xff\x1c\xe1\\{\xde(\x1dB\x14+<ߢ\xc4\x19\xc2\x0f\xc0\v\x9d!\xac\x85\xaa\x04[\u007fxc\xc0\x1cZ\xe7\xde.l]r\xcf\x03\f\xf03\x1b\x17\xaa\xbf\xf3\x97e\x94\xc9{,ܡ\xef\xectx\xef\xb7\u007f\x9b\xb9\xefk\xdfdՓO\t\xef\xfd%\xf9\xb9\x95U~\xe0W\xff\xa9)\xbe$\frxAV\xef\xd6GE\xf1\x8f\xb4\xc7\xf7D\r\x88\xdb\x16?\x84FS\b\x13\bb\xf7;J\xd7\x1e!\xce#<\xdfy\x891Pd\xb5Q,\xe4c\xc83\xf7\xb3\"\x87kOC\u007f\x0fg9\xb8\b\xbc}\xe3B\xf5\xd0\u007f\xec{\x9b=\xa1\xfd\xb0\xc1\xdf8s;\xffۻ~\xc0\xb0\xbab\x19\x8d\xe0\x0f\xffX\xf8W?\xaf\xbe\xef\xfa\xa7\xab_x\xa1\xd7\xf1\xfe\x13\x80\b\xbbz\x8f\xfeϵ\xc7?\x8c\x9a\xf6X\xa3\x03\xbe\x0fA,\xb4f n\x81\x1f\xb90\x85\x805\xee\x97D@\x14S\xd7\xf0\xc4=\xc7VP\x96\xcepQ\xd3yOP\xc0\x91\xdb`\xfb*\xecmBYpR\x84\xf7\xaf\x9d\xd3\u07fdq\xa1z\xff\x91\U000daacf|q=e\xe3BE\xfb\x88Nt\xc8ϼ\xe1\xcd\xf6\xaf}˷\x1bn\\\x13\xde\xfbKBo \x98\x90},O\xbc\xd8\xeb\xfc\xa5\x19\xa4^\x04\xbbz\x8f~\x8f\x17\xf0\xeeƌ\xf5\xe26\xf8\x1e\xc4Man\xc5-\xa8\xaa\xf3\x83\xb5.\xe0X5\xc9!n\xf7O\xb2\xa15.\u007f\b\xe0\x1b\xe7\x15\xf9\xd8=W\x80\xa4\r\vb\x89\x1a\xb0uI\xc8R\xe6E\xf8\xf5#\xe7\xf5\xeb\xae>R=\xfc\xc5\f_k\xe7\xb4l\\\xa8l\xd2\xe6g\xee\u007f\x8b\xf9k\xdf\xfc\x9d\x96\xadk\xc2\xcf\xfe\x14d\xb9\xc5\x1a\x8b\xb2z[{l\xbd$\x94u\xf4e\x9a\xf5\x87+\x1aG5\xc3\xf5\xea?\x86[\xbf\xc3\v\xf8\xc5\xe6\xac!L\x840\x82FG\x98_u!Jp\x1e`qޠ<\x97\xd4'\xe1jb\xa8:?`*\x97\xd0'V*r(Rg\xac\xaat\x9e3\xeaY\xc6\x03X\u007fB\xa81W\x0eܶq\xa1\xba\xf2E\xdad\x93\xb5\xfb\xf9\xbb\xbeҾ\xf3\x81\xaf7\x14}\xf8\xad\u007f\xa2\xc8J\x83\xa8\xfa3\x17\xfa\xb1<\xe3-\xd7\x1f\xad.\xbd\xd0k\xaa\xc3/\xbe\xfep\xc5\xc2\x1d\xfa\x81\xf9\x05^m-|\xd7\u007f\xa3\xbeh\xc6X\xb9G\u007f\x85\xf6\xf9\xd9Ƭ!j\bq\x13:K\xc2\xe2\x1a\x84\xb51D\x81\x0e!h8\x03y\xa13\x8a\xf2\xeb\xaf5\xe2\xf2|\xf7\bbK\xd8\x00\xed;\xa3\x05\x11\x84I\xedM\xf5\U000d2d90\xb4`夝\x80\x82\xa0\x0e_K_\xe8=M\x8c\xb1tV\xff\xf8\xf2)\xfb\xceW}\x83\xb1E\n\xef\xfbEŸp\xc6x\xa9\x97w\xf8\xc5W\xef֝\xa3\xc7x\xff\u0082\xed\xbe\xec\x8d\xfa\xfeG>\xa4\xf6\x1cP\xf9\u0092\x9d\xb5\x84J\xf1#q\xd3t\xc2X\bch\xcf\t\x8d\x19\xb7%\xac\x01/r\v+\xe2\x16W\x89\xad\x8d hϺ\x1c\"\x13\xb8k\x11U{A^\x87\xaeT(\xf3\x1a\x04\xf8.\xe9K\x9dg▀X\x86}\xd8\xdf\x04k9\v\xfc/k\xe7\xf4\xdf\x02\x8a\xcf'tMB\xde\xdc)\xfd]Gn\xe7\x87ν\xde\x02ȧ>\xa0\xe8\xed\x9a)4?tUX^\xf4\x8d\xd4a\xdaA4\u007f\xf3\xedo7\xb3o~\xb3=)\x96wC\xf1\x05S\x12\x1b\x17*D\xb8\xcf\v\xec[Æ\vAQ\"4\xda5B*\xc1O\xc0\v\xea0\xa5\xc1\x0f-Q\v\x92\x19Hf*\x92\xb6\xa11cHچv\xc7\xd0l\xb9\xe7\xfb\x91\xf3\x12\xa5@\x87v\x1a\u007f\xbd\xdacD\xb9X\xa6\xea\xf7\\<j\t\xa2i\xc8\xfb~\xe0\r\x1b\x17\xaa\xcf\xeb\x1eE`\xe16}|\xf9\xa4\xfd_\x8f\xdfc\x82\xd6\x1cl^\x14.~\xdaN\xf3\xe0\xf4r@\xc4\x1e\x04\xd7\x171\xc8d\x87\x88\xf0\xce\xfb_\x0eo|\x83ei\x91\x1fZ\xb8C?\xf0\xf9~`\x80#/ӓ\xd7\xfd\xbbaÅ\x8c0v\x9ea\x8d[\x98F\xe7\xa0\xf2\xf6|K\x10[\x1a\x1dKԴD\xb1e\xbe\t\xabmXm\xc1J\x13f\"\b|K\xe0O`\xb1u\xa1M\xc0\v\xedԋ<\xcfݾ\xd2.)i\x0f↰v\xbbK\xf4\xf5\xf5\u07b5s:ٸPq\xe4\xfc\xad\xdd\xe3Z\xfd<k\b\x9b\x1d~n\xe54'\xe2\x04\x92\x19\xcbc\u007f\ny\xf6\xdc5\x0f\x120%#\v\x83[2\b@\xe7\xb8>5\xb3ș\u007f\xff\xdb\xf0\xd0C\xc2;\xdfi\xf0<~m\xe9N=\xfb\xf9\x1a\xe5\xeaõ\xa1\xb5}\xbb\x17\x80\xf6\x85\xe6\f\xf8\x81CL\x8d9Ё\xab3\x82\x86ŏ lX\xb4\a\xcd\xc8r\xbcc93\a\xb7\xcd\xc1\xd9\x05\x98O\xa0\xe1C\xa8!\xf4\xeb\x9dhA\xeb\xdaK\x82\x03\x946\x81\xcdS\xac]{N\xdc\x14\x96OX\xa2&\x00\v\xc0/\x9f\xbc_\aW\x1fy\xf1{\\;\xaf\xd9x\xa4b\xe9\xbc\x0e\x80\xffc\xf1\xb8\xfd\xfa\xce\x12\xcc\x1d\x85\xbd\xebp\xf9q\x9e\x937\xc2\x06\x84\r\xa1*Ȕbt\xcb\x06\x89\x9a\xdc9\xeaa\u007f\xf7w\xe1\x8f\xfe\x18N\x9d\xb6|\xc3\xdb\xec\xa9р\xf7\x1c\xf6\xa2\x97z\x1d\xbf_\xbfN{(\xa5\xc1\x0f\x84(q\xde\x106 \xa8wv\x10[<ߢ}\x8b\x1fXZ\x91\xa5\xe1\xc3\xd16\x9c\xec\xc0b\x03:\xb1\xf3\x8eȃ\xa0\xf6(%\x16cܪKM\xa9\xe8\xa0\xceA\xba\x86\xc0\x02\xa2]8S\x1e\x84\xb1\xf3\x10\x17\xbe,\xc07\xe69\xbf\xb6v\x8fno\\\xa88z\xdf\xe76\xcaF\x9d\xc4%\xe7\xe7\x16\x8f\xdb\xef]>\tq\xcb2\xb3h\xf9\xe8\xef(\xd0\a\x1e\n\x0e\x98\xd4ށ\x85\xedk\x9f\xae\x8a[6\x88\b\xb3U\xe1\x16\xe7\x99\xcbpu\a\xbe\xee[\f\xa7\xcfۿ:\u007fF\xbf\x06\xe0\xf8\xcb_\xba\x97؊o\xd0>x\xbe\x10\xc5\ahɋ\xea\x9c\xe1\x83\xf2\xec4\xf6+\x05\xber\x86\xdaM\xc1\bT\x16vF\xee\x91U\x90\x97\xae:\xb7\x87\x1f\x1cT\xf6Ӱ\xe5\xbb\u007f(q@\x00\xeb<)\x8c J\xa0\xb3\f~`Q\x9a\xb7\xa1\xf8ȑ{\xf5+\xd6?Yq\xeaU\xfa&J\u007f\xf2u\xf9N\xadV\xef\xd1\xefZ8n\xbfo\xe1(\x04\x91en\xc5\xf2\xd9O(v\xafۛ\x12\xb9\xf6!l\xba\xcdQ\x95b\x80Go\xa5M\xa0\x0e%\x9e\xa6\x17\xba\x85\xbat\x11\xaeoC\xb7\x80\a\xdelgf\x97\xf9\xf1\xb5{tr\xf9\xa1j\x9a\x17^\xec:Z?\xcf\no\xf0\x03\xb7[\xfd\xe8\x80\xfe\xd0\xf5\xc2y\x81\x9dV\xe2\xd6BY@V/x7\x85O_\x87\xc7n\xc0\xe5=\xe8g\xb0\x9fBZBQ@\x91\t\xb6\x82\xaa\x90\xa9E\x8cq\x06\x9f,ʄv\x99<\xb4\xe7\xe0\xb4(h\xcd\xd5\xcf\xf1,~`\xcfb\xf9\xe8\x91\xf3\xfa\x8f\xf31\xaf?z^\xfb\x93\xa8\xb0q\xa1b\xf5\x9c\x0e\xb5\xe6\xfb\x1a3\xf6\xa7g\x97\x1cT\x8f\x9a\xce\x03\x9e\xfa\x04P\x03\x88I\xbejt\x0e\xd6\xc2\x14\x18ky\xe8V\"\xcdtu[\x8b\xea[\x83ľ.lX\x19v\xa1\x9b+\xa2\xa3\x96\xa4\x03q\x83\x93W\x1e\x93c\xfd-\xfb\x1b\xfdM\xcb\xd1{5\xbd\xcd\x17\x06\f\x93\x9f\xb7\x97\xd4\xff\x14\xb7hG\t\xb4:\x82\x1f2%\v'\xbbYՋf+\xb7\xae\x95=\xd8\xe5\xe3\x12\x069\xa4\x953D^\xb9\x020̈́\xb2\xa8\xc9\xc5R\xa6\x9eb\n\agLM\xbc+%\x14\x85\xb3\x8655\xfaB\xb0V\xea\xe4l\x19\r\xe40\x8b|\xdcZ\xf9Nk\xb9\xb7\xb5\xa4\x8eԏs\x02\xef\xf1\x13\xfb\xdf\xcd,Z\x9asB\xa3c\x99]\x86\u07b6\xf0\xe4'\x0e\xedp\x1f\x92Ρ\x92[`\xbc\xaf\xaalĻF\xbbv\xf8\xa2u\xc8!\n\xe1\xa8\x0e\x10\x11W\\=\xfa \xdc\xfd\xd5n\xf1N\xddgQ\x9ey\xc7\aƺ\xad\xda\xf6\xed럨\xec\xadx\xc9\xc9\a\xbc5cM,\x13\n\xbd\x86\xb5\x96\x830c\xea\x1d\xae\xfd\xfa\xfb\xa5 b\xc9JKi\\\xb8\xb1\xe2\xee\xaf(!\xcb]\xc5]\x19g\x8c2\x13\xcc\x04PZ\x99V\xf1\xd3P\xacj\ufadf\xa2\xea\xef\x99\n\xf2\xb4\x86\xd9\xc1A\xe5\xaf=\x10e\x93\xaa\x90o\xb5\x96o\x02\n@\xb4o\xa3\xa8a\tc\x17z\x83\xd8\xfd\xee\xd5'\x85rR\x04\nD\rn\xca#X\xa1H\xd9\xdc~\xaaں\x955S\x87\\\xa8\xa5&n\xed\x03\xca\xf0\xa1\u007f\xa30\x95[\xbc\x13\xf7X\xfe\xb3\xff\xd2|s`\xe5\x03\xcbw\xea\xa3/\xd4:\x9d|/\xcb\xec}^@\xa4'\xe1(?(\xfc\xec!\xda|Bu8\xde\xc4R\x160\x1a\t\xa3\xb10\x1c\vá\xd0\xed\t\xfd\x9e\x90\x8e\xdcB\xe6c(FB\x91\nU&T\xb5\xa1\xacu\xaf\x87:0\x86\xaa\x1f\xba6\xc4ֺ\xa5\xbbm\x19\xf5-Y\n~l\xf1\"\xeb`\xb3v\xf0\xdb\v,\"x\b1\x96h\x92\xfb\xfc\b\a>Bw?\x9b\x97\xec\xd4\x1b<\xbf^\xbbC\xc4T\x95\v\xc6\xf0\xefn\xb5\xcd|\x18\xa4EUY\x93uu8پn\xf9\xe3_U\xe4#\xc1\x18g\x94\u05feݼ\xb1\xbd\xc0\x87\xe6O\xeb\xb7Mb\xe2\xb3\xdf\xc8\x19y\t\x11\xbeY\x94M\xf2TH\xfb\a\x18T&䠅\xaa\x14\xaa\nL\xe1\x8cb\xaa\x9aZ/ \x1d\xc3h\b\xe3!dcG\xb7\xe7#a\xdc\x13F{\x8aᾐ\xd5?\xcbS\xf7\xa8\ng\x94I\x8e\x9a\xec\\\x04*#l]\x81\"\xb3\x14\xb9%\x1d\xb9\xd7\xd4\n\x82@\x88\x9bB\xa3\xe3\xf2\xa8\x17Z\xfcCŦ\xd2u1Z\xd36\x9e\a\xe3\x01t\xb7\x0e\x81\x88\xf0Y+\n\x14c\bB>|\xabH\xd5;d\xcdl\xb2c\xb5\xefb\xa1\xb5\x96\x8d\xcfZ>\xfc\xab\x8aW\xbdU\x98Y2\x1c\xbf\xc7Ҝ3\xb7}\xf2\xfd\xf2˦\xd2\xff\xdaZ\u07b5q\xa1ʟӊ\xbdg\xe7N,\xdfl\xad\xab\xbcg\x16\xc1\x0f\xc5\xc5\xf6\x9aڰ\xb6\xce\x1b\x16\x8c\x02\xa9dJ\xc6Mb\x8c\xb5uGp캂\xf9X(2\x17\xfbEIm`W\x93(\xedr\x94\xae\x17m\xf2:\xb6\xb2\x98J\x18\xf5-\"\x96\"sy\xa89#\xb4\xe7\x84 Tx\x81\x05+\x98\n\xc6#\xcbֺ!\x1b\xbbݟ\xa7\x82R\x16O\v\xba\x86\xefAly\xf4A\x01m\xa6\x95\xbb\x1f=\xb7\x16/3A\x14\u007f\xfa\x92\xb9,`\\\xd5\t\x11\\\x1e\x19en\x87m_5\xbc\xef\xe7\x15\xaf}\xbb\xb0z\xbb\xc3ݯ\xfb6\xe2\xb95\xfe\xe6c\u007f&\xaf\x8b\x9b\xfa\x9b6.TϜ~\x95&\x1d\xb9\x0f%\x8a\x9f\f\x12\xbb\x18D\x0erz\xber\xf4y\x9d\xb0\xadql\xac\xebi\b\xd4]@g KYB6\x84q\x1fҡ3\xa03\x90\xc5\xd6t{Y\xd4\xf5\x85\x0fQ\f\x9e\xe7\f\xe3G2\x81\xb3 \xeewG=Kw\xc7R\xa4\xce`Go\xd7$3И\xb1\xf8\xb1\xc1\x0f-\xa3}!\x1b\nq\x06\x8d\xb6\xe6\xf2g*F\xd6R\u0560A\xd5\\\x9b\x1f[\xb2!l<\xe9\xbciB\xcfH\xcd\xcb\x1d\xa6L\xaa\ns\xf9㷖?nBY\xede\xf5*c\xf8\xcax\xc6\xc1\x0f\xed9\bj\xab:\tZ˥G\x05%Bs\x16\xb4\x16\x96\x8e\xc3\xcai\xbb<\xea\xc9\x0f4\xe6U2\xecr\xf1ڣ\xd5nkY\xfdTذ\xef\bb[C[\xd7\x05\f#\xd7\x17\x0f\xa2\x83\xd05\x81\xaa\xd9\x10\xd2\x01\x8c{н\x01\xbdm\x18\xf5 K]\xac.sHGux\xca\xc0\x94u\x11&BY8\x8a}\xd8w\x00\xa1\xc8jϪ\\\b3\x15l]\xb1\xa4\x03\xf7\xbeg\xefל\xb8\xd3p\xee\x9cᾳ\x86\xe3\xab\x16<\x8b\xc4n\x97\xc7m\x8b 4ڊ<u\x8b\xaf=\x98\x99\x17\xa2\x06t\x96\x85ˏ\t\xbb\x9bf\xca\x16\x84\x893\xd8\xe1˔B6\x94\u007f\u05fbf\u007fm휦\xbfe_\x92\x87<b\r5\xa4\xb1X\vq\x1bF\xfb.d\xb8\x9b\xb7|\xea\xc3\xc2\xd5'\x15\xa7_\x06˧af\x01^\xfd6\xcb\xe5\xc7\xe4\xefm]\xe2\xfb\x9bs\xfa}\x83=\xbeۏ\xed\x14\xf1h\xedbuU8fv\xd2H2u\xc3)O!\x1f\xd9z\xd7CU\x81V5\x9a*ݎ\x8c\x9a\x96\xa8)$-K\x10\n\xa6T\xd3]h\x8c\x90\x8daԅ\xcd˖\xe1\x8eeЃ \x14<\x1fLe\xdd\xcf{\xb0rJ8v\xae\xe2\xec\x9a\xe5H\v\xca\n\n\x03s\r\xe8\x8e-\xdasp\xba1gH\a\x9a\xb5S\x9aqߐe\xd6\xe5\x90\x00\xb2\xa1p屚\x9e\xa9\xa3\x94\xf6\x9f\x1b\xae\x8a1`\xf8\xb7/\x85\xe98l\x90\x0f\x02b\xca\x03K\x8b\x82x\x06\xd2\xde\x04\x059\xdehw\xd3\xd2\xfd\x03h~\\s\xea<,\x9f\x84\xc5#\x904\x99[:*\xdf=\xeaÕ'\x84\xb2th\xa4\xa8C_\x96\x1c\xea\xf6՟\xbf\xaa\x98\"\xb9tT\xef\xeaJ\xc8G\x96\xb8\rw|\xa5eq\xc5҉\x1d\xa9\xd8\xcf`\\Z\a[ǂ1N\x06\x14$nsx\x01\\\xf8\x13!n[\x8a\xccN\xdf\xc8X\x97\x83\x82\xd0}\xa3\x97\xc1l\fݱ\xab\xfew\x06\x8e\x150\x95CF\xa2\x1dka*\xa1\xd9\x11\xb2kR\xa36\xe1\xcacB\x9e\x977\xf1V\xf2<\foU\xc8\xf5\"㓟W?d\xe3B\xb5\xb1vN?^drg\x14\x1c\xecn\xa5!\x99u\x9e2abD\xeaJz\xb7\xe2\x13\x1f\x80\xb8\xa59q\xa7cq\xfd\x10f\"\xa1\xb3\xa8\xb9\xf6\xb4e\xeb\xb2!\xb3\x16\x94\xb0{\xc3ҙ\x17B\xebj\x92\xb2t!\xd1X0\x95+\x16\U000b10ddw\xbe\x06\x8e\xdfn9\xbdd9\xd3qH(+\xdd\x02\xa6%|f\xc7\xe5\x13\x8c۵e\x0e\xe9@X8\x0e/\xfb*\xf8\xd8\xef\v\xcdY{\xa0\u1a8d~\xe51\xcb\xfc\xaafg\xc9\xf20P\xe5u\xb3\xa2\x10ʱK\xe0A\x03\xc2Đ\x8d&9\xc1b\xad`\x8d\xc5\n\xecmڛ\x8dQ˓\x0e\xd7@U)\x14\x99\xfc\x16p\xed%\x1b\xe4\x10B\xfa\aE*\xffW\xd8z\xee\x13\x93\x8e\x8b\xf3\xc5\xf8\xe0\x8dE\x1c\x99\x97\xa5\x15\x9f\xf9\x98М\x15\x96\x8f+:\x8b\x0e\xfe\xad\x9e\x11\x16\x8ej\xae=m\xe8\xed\xb9\x04\xdc\xefZD\xc4\x15\x885\x8aRu}\x90\x8d,A\fg_m\x99Y\xb2\xcc6\xa0\xe5Ci\xeb<S'~\xad \xf4`\\\xb80\xaa\x14h+h\xdfR\x8d\x85\xf6\xa2\xab;Lu\xc0\x02\xa8\xba\xbe\x18t-\x1f\xfd=\x17\xba\xf2\xd4U\xa8V\x84t\\1\xe8\xb9\x05\xeft\x14J\x04\x8b\xa5=g\xe9\xed9$b\r\f\xf7!\xcb^\xbciW\x8c\xa4\x97\r\xf8\xb7\x9b\x9fyqB\xf1s\xf6\xd4\xd7\xceiO{\\h.\x9a\xb3^\xe4\x16\xf0ٕoU\xb8\xc4k\x9f\xe73Y㨊VG\xb1rBњ\ac\xdc\xebl^\xb2l^\xb14f-3\x1d\xe5\x9aH\x1c\xf4\xc9\xcb\xc2R\xe6\xb0rJ\xb1t\xda\xd0Z04\x13H|\xc1\u05f5Ar\xe7Uy\xe1\x10\xa01nW\x88X\x8aT\xc8FB>\x82\xf1@x\xf07\r\x8dY븪\x1a\x1e+\x05\xe9в{\xad\x86yu\xbeT!\xbc\xe2\xcc\x1ao\xb9\xef6\xd4\xf8\x1a\xbf\xf2ɫ<\xbd?«\x85\x15U)x\x81\xb0pą\xee\xadK\xd6\x15Q\x87\xc2S\xebPC\xd8T\xb0\u007fU\xff\xd6Շ\xab\xb7}\xde-\xdc\xdaKʵs\xfa\xdbF;\xea\x17\x10^\x114-Q\xdbܔ\xac\xb4\x0f\x8dY\x18\xec>7\x89\x89\x02\x8b\xa5\xb7_\xd1߷,\x1fS\xac\x9er\xf7\xbdrR\xc8F\xceC\x92\xc4N\x99\xd8)^/k4W\xc0\xd5\xc7\x15Z+ґe\xb0\aݝZ\xbc\x00\x18cP\n<OQ\xe6N>\x14&u\x97\xd0XP\xb0y\xb1rڮ\x10\x82H\xa6\xaa\x15\xed\xb9\xba\xa3\xca-\xdd\x1b\x8ee\x16\x81\xaf\xbb\xf7\x14\xff\xe2\xef\xfd :\xee`\xb2.o\xba\xeb\xbd\xfc\x17\xff\xe6a6\x86#\x17\x8a*g?W\a\x1d@\xf7\xc3\r\xc1\xa9rO`\xb4\xa31\x15\u007f\x1b\xe0\xd8\xcb5W\x1e\xba\xf5\xd6\xc54\x15\xf5\xb7\xec\xc4([\xddk\xf6\xff|\xe5[\xd5\xff\xbe}E\xee2\xa5\x9c\xf1#\xab\x9eݾ\f\"\x17\xb7\xed\xe7Dr\x96\xfdm\xb7s\xdb\xf3.!\xb6\x17\x84\xc1\xbe\xbb\xb90\x96\x03%\x89qp6\x1dX֟\xb1l]\xb6\\{Ʋ\xb9n\xe9\xef\x19\x8a\u0092\x8e-_u\xf2\b\u007f\xfd-_ǝ\xc7\xce2\x1e)FÂ\xad\xebCv\xb7\f\xfb\x9b\x86\xbd\x1bнai\xcc:}W\xdcr\xad\xe2\xf6<4;\xae\xe8\xabJ\x10%\xf4\xb6\xddg\x0fb\xc5{\xbe\xe5՜\xb9\xe7Ո\xb1\x88\n\x98\vF\xac\xaf_\xe5\x13\xd7\xfbS\x8el\x02t\x1cka\x9f#1\f\x12\xb7\xa1\xf2\x81\"\xedˏn\\\xa8~\xe3轚\xf5O\xbe\xb4>\xd2M\xd8`\x82\x93_\xf6f̓\xbfV\x8d\xf77\xec/\xb7\x96\xd4\x11\x1d\xf0\x8ag\xf7\x89E\xeadZ<\u007f\xf8\x9a\x00\x82a\xcf2\uee5d\xe9\x05n\xc7N\x92\xa0\x80[\xec\x11\x8c\x06N\xe1g\xad3\xb6\x1f\xba\xfc0e}\xc7\xf0g?\xf1=\x9c{\xd9\x1bx\xcd\x03/\xe7;\xde\xfa5|\xcb\x03w\xb0\xa6\xb7\xb8pm\x9fQQ\xa15\xcc,\xbaZ\xa11#\xcc,\xc2\xd2\th\xcd;\x03%3\x0e%\x15\x99\xa3=\x8a\xd4\xe2G\x96Ӌ3\xbc\xfa\xfe\xfbAE\x88X\xca\xdd\xcfp}{\x8b\xdf\u007fr\x17\xad\x00#\xe8\xe0\x80\xcct\x95\xef\xb3:\x83\x89c\x9a\x87{\xeaC\xc3\x1e\xdf\u007f\xe46e/}\xe2\xa57\xf5\x9e\x97\xedұbfMѻni-\xaa{\x83ؾQ\xfbϓ\x80T\xed)\xe9\xe7\xf6\x14\xa5 K-\xfd]\xa1=먍2\x9bT\xea\x96\xd1\x00\xc6\x03K\xd4\x10\xe6W\x14\x8bG\x14\xed9E\xd2\x14\x92\x96:T\x95\xc3\xf7~\xc5\xed4\xe6\xce8~>\xcfi\xc4\t\xf7\xceu\xf1\xab!\xbf\xfb\xe9=\x92\xda\x10qSX<\xe6\xfa\x1dQ\xe2\x16+\x88\x1c$NZ\xee\xb5\xf2\xb10꺜\xf8\xf4\xee\x1e\xf7%\xdb\x1cm\xa6\xb0\xf7\b\xf4\xaf\xf2\x93\x1f\xbc̕ި\xde\x10\x0e\x84\x88r\xac\x8217w\x06\xc1\xbd\xfehOm\x14#yǍ'\xaak\U0005cea5B\U0001650b\x93\"\xe6\xe8\xbd\xfa\x87\xfd\xd0\xfe\x98\x17\xda\x17\x84\x05\x8d9\xe8\xef|nM\x85\b\f{\x86\xedk\x8a\xe5\x13\x82\xf2\x84bl(2\u05fd[>\xa6i\xb4\x0e\xc1\xc7\xca\xd2\xdd\x13f\xe7-\xad\x19\xc5ӏ\x19\xbc\xc2\xf2/\xdf\xffq\xfe\xfbS\x0f@\xb0\x00\"T\xe9.6\x1f\xf0\xfb\x8f\xef\x12\x1c\xd2lͮ8\xe5b\xdcr\xed\xda\tD\xf5\x14\x18-̯\xb9Е\x0e\x15\xfb\x9b\x15\x9b{)\xdf\xfc\xcf\xff\x88\xe3폠\xb5pq7\xc5\xf3\x8d\xd3~\x05B\x9e\n\xa6thN\x14P\xc9snv\xb4\xaf\xb2\xb4/\xff\xc3\xf5ǪO}!\xaa\xc8\x17\xe4\x83\xdb+\xea\xf7f\xd6L \xf2\xe2XM{n\xe7\u007fΧ(G\x89\x84\x91\xa25[\x93{\x1daf^\xd1hY<\x0f\x82\x00\xa2\xc8}\x8d\x13\x88\"\x87\xff\xfb=K\x95\xc1G.\xeebG]\x16cC\u007fo\x9dG\x1f\xfd\x04\xef\xf9\x0f\x1f\xe1\x0f\x9f\xdcE\v\x84\x890\xbb,̭\xb9\x1d\x1b5\\\xe8\v\x93:\xb4ZPRW\xefơ\xaf\xfd-\xc1V\x96\xa4\x05\xbd\xa2\xa4_\x14\xf8\xbek\xc7\xfa\xa1k\\Y\xe3\xf2\x8f\x0e\x0e(\x119\x84\xb2l%\xe4#\xf5\x13\xd7\x1f\xad~j\xc2|\u007f>\xde\xf19\r2\xe1]Z˪\xd4\x1e_;\xf9 /\xc8\xe3\xeb\xba\xc7\xf1\x02#2J9O\x9a]q\xadפ%.\x94x\x8e\xd6N\x12'\xef\xf1<g\x18\xaf\xf6\xdf\xf1H\xe8\xee\xbab\xecO\x9f\xb8\xca\a?\xf9(\xbf\xfd\xf1\v\xbc\xf7\xcf\x1f\xe3ѭ\x11\xca\n\x82\vW\xf3kNH\x117\x1dK\x10\x84\xae\u007fa\xeb\xe7L\x04t\x9e\xa7\x18\xf7\xddb\xf7\xb6\x1dI\xe8\a\a\f\xf3ĳ\xa5\xd6w\x15\xa9\x9aj\xc7&\x06B\x01\x95\xa2\xca\xd5?ٸP\xfd]\x80֒\xfa\x824\xc3\xcfk\x90\x89u\x9b\x8b\xeai\x84\xbf\x136n\xcdڢ^\xd8K\x10\a\x8b=%\xc4-\xa7\xedm6-ZA\x1c[|\xef l\x1d\xae~\xc5s$_:p\xbcX\xbf(\xd8\x1f\xe5\x14\x95\xd0H4eḘ\xd9eE{\xde动\xe9\xbc\xc3\x0f\xeb\x02\xd6w߯rW\xe0i\re.\x14\xb9\x1b\xf2)3\xd7\x05\fcW\xb3L\xe8\x1c\xa9?SUʴǢ44ZB1RT\xa5\xfc\xb61\xfc׃-\x9b}1\x04\xdcꅤ\x92\x18\xb6\xabL~\xb1H\xe5\x96\r\"\xb7\xf0\xd4\xf1\xd0\xd5\x06ql\xa7\xe4\xe3a\xc5\xc6a\x80\x904\xa0\x99\x18FCh\xcf\vQ\"hQ\xcc-h^\xf1U\x8a3\xe7]\xed\xa1\xb4[P\xed\xd5\xfd\x1cuН\x9c\xd2\x1c\xe2\x98\\\u05ec\x82ւ\xf3\x8a\x85#N\x86:\xe9\xd1\xfb\x81+\x04\x91Z\xc2c\x9c\x18#Oe\xea5EnY;-d#\x1e\xbcv\xa1\xea~\xb1\xd4\xf4\xde\vI@\x81b\xf9N\xfd\xcf\xfc\x81|\x93\x1f\xd9έ\x18\x04\xc5\vʁ\xad\x81\u03a2\xbb\xc1\x89\xc8\xc1\xd3ϝ\x1c:\xac@l4,w\xdd+\x84!\x8c\xfb\xc2\xc2*\xac\x9dp0\xf9O\xdeg\xc0\x80\x0ee*\x8e\x9bhz\xa7\x86\x95\x032s\xa2\x97\x1a\xf5\x9c\x8c\xa79\xeb\x98\xe2ƌ\x90\x8d\x9c>\xac\xb2p$n\xd3+3\xfaE\xe6>\x8bu\xbc\xd7D(a-\xf4\xf6,\xda'9\xdc9\xfdB\x8d\xf2\xa2M\xdeᶽ\x1a\xb5Չ \xe6\x95\x13\xe8k\ny.\xbbY/\xe2\x04\xd2>\xaf1,\x84\xbe\xe6\xec\xfd.g\xf8\xb5L'\f\xedtGk}\xb0\xbbE \f\x9d\x97,\xaf\xc0\xec<̯\xc0\xc9\xd3\x16\xad-W/\xc2#\x1f\xa9\xc5\t\x1a\x9asNU\xef\x87\xf5\xac\x89\x02?\xb2\a\xbduu\xd0\xf7vE\xad\xe0\xf92E\x88\x83=\xf7\xb3\xbc\x82\xd7߱\xc6\xf7\xbc\xea4\u007f\xf6\xc4\r\x8c\xae\xa6\xb3)\xd6\xcaT\x1d9\xea\x82\x19\xcb\xcb\xdak\xea\x8e\xfe\x96\xfd\xcd\xe7K\xe4\xb7\xda\ayQ\x0f9|\xa59?4\xdcV\xdf\xda\\2K\xc3\x1deDY\xd5Z\xb1\xcf\x0fs_ dU\xb9p\xf7W:rp\x12,'\x05\xe2\xe4\xda\xd9\x146/)\xce\xdc[\x11\xd5ݸ p\xc6\xf4<h\xb5,\x8dĲ߅?\xff\xa0u=\x16\xe584\x8c\xab\x11<\xbf\xde\x14\xb6^@e\xf154C\xf7zY\x05Y\\\xd3\xf1\x91E\xfb\x8a\xa4\x03r\x05\xaa\xd2\x11\x8c3smn[\x9dᝯ\xbb\x83\x9f\xfd\xc3\v\x84\xbe\xf3\xea2\x93i\v:\xbb\n\xa6g:\u07b2\xfa\xbe\xb5s\xfa|={2\x12\xe1\x82\x1f\xf1\xa1q\x9f?P\xda\xc9G\x8fާ1\xe5\x8b\xf7E^t\x82a\xed\xbcf\xef\xa9*-K\u07b4{Y\xfd\xfdlďE-[<\xaf1^@\xdfm\xad\xeba'\xad\x9b\x9fS\x14Nڣ\x14\f\x06\x86\xf9\x1b'\xf9\xfa#w\x93\x0e\xd4Ͳ\xcc\x00:\xb30;\xebڻ\xbf\xf1K\x8e\xe3\x9az^\xe2\bJk\x9c\xf7ښ[\xaae\x9c\xf8\x1aB\x1f\x1a!\xb4#h7]\x95\x8eԜW\xe8`\xf2\x843\xeb\xf6\xc6\xdc}\xf6\x18\xc7\xe7\x1a\x9c\x98u\x12Dc\x9c,\xb5Ȅ\xe1\xba`\xfbN\xa5R^3\xe8\x82W\x9au\xf3Z\xae\x99\xaf5[\xf6\a\xb3\xeb\xf67=\xc3\x1e\x15\xff\xf3\x91\x97\xeb#러\xb8\x15\x8d\xf4\x8b\x1ad\xe3\x11\xa7\f߸P}\xea\xfa\xe3Տi\x8d\xb5\xe0?_\xf2\xb6/R\xac\x88\b[뵲P\xea\xe6\x94q_\x8bʲ\xf5x\x8b\x1f\xfe\x8e\xd7pj!at#pL\x80\xef\x1e\x9e\x0fq\f\xfd}\xf8\x95_\x80g\x9e8H\xd6Z)ڋu{w\xec\bʉ\xba^\x1c\xa1{\x80\x90\xc4Q\xf7\v303\xe7\xf2X\x94\xb8\xcf\xd1Y\x16\xaaB\xf0\x04>se\x9b\xc6L\x93\x95\xb9\x06\x8b\x8d\x98\xb2pM1\x11K9\x04\xb3m\xa7\xab'>\xe47\f\x12\vU\x06fh1\xbb\x96\xf2\x9a\t\xca\x1b\xf6\u007fd\xcc\ufb3dL\u007f\xe3\xe1\xa2\xfbs\x19\xe6\x96f|\x0e\x0fH\xfa\x01?=\xdcQ\u007f\x94\xf6\xd5\xf3\"*/\xf8\x9c\\#J\xc1\xde\x16\\\xfe\x8c\xab\x94\xb5w -\xbd\xban\xf9\xeeW\xbf\x82\x85\xb9\x06\xab\xf3M\xaa\x81\x8f\xa9`4\x86\xee@P\x1e<\xfd\x19\xf8\xb9\u007f\fO>Zϫ{\x0e\xba\xde\xf9j\xb8\xf7\rp\xfc.K6rE\xa7\xadEs\x16[\xb3\xc45\xcdo@\v\x04\n\xe2z\n˯CYc\xa6\xa6I\x80O]\xdc\xc5\x14%\xf33\t\xcd \x98\xd6/\xd6:\x03<\xe7*'*\xbc\xfa1\xe90\x8f,\xe5\x86y\x99\x94\xfc\xeb\xb5\xf3\xfaGV\xcf\xe9\a^\xc80/y\xe8\xf3\xe2'\xaa\x1e\xf0W\x8e\x9c\xd7?\x9c\xf5\xf5\x8fGm\xa7ڐ:\x17\xf8\x91۩\xcfAZb\xc9\xc6N\xf9\xd7\xdbs\xf3\xe4At\xa0\xf3\xdd\xd9V|\xc7;\xee\xc4V\x15\xedVLB\xc4(\xed3\x1a\v\x9d\x19\xc3\xef\xfc*<\xfe)\xd7\x01\x9c\xd4\x14T\xae\x10<y\x8f%\x8ea\xe5\f\f\xf7\x9ḑ\xca\xdds\xac\xa9y\xb3\xcaA]\xdf;\xe8\xf37\x027\xd7\xe1Gv\x9a\xb4\xa3\x86k9\xa3\xe0\xa1\xcfn2\x1f\xf9S\xedq\xd2\x12\x94\xb8\x16\x02\x1e<g\x1eʸ\xbaŖ\xcf\u074cf\xcfΩ\x16?\xaa<\xf9ѵ\xbbu\x86\xf0+\xa2\xf8\x97Vxx\xf5\xbc\x1e^{\xc45\xb2n\xc9 7\r\xfa\xbfB/\x98\x923J\xd33\x03\xc6\xfdu\x89\xbd\x19E\xbc\x02~\xdbY!n8\xf9γ\x11X\x9e[ҁ\x10\xb7\xdcN+r\xa7 )+é\x99E\xac\xb1\xf8Z\xd3hF\x1cK:|r\xfd\x06Uf\xf9\xe8\xfb-\xbd}\x88\x9bL'\xa0\xb4\xe7\xc8\xc1\xf6\x12\xdc~\xdai\xa6\x9eZ\x87\xe5S\x96\xfdM\xc7\xe8\xfa\xf1\x81g\x80SՇ\xde\x01\x90\b\x14$\xa1S\xa9K\x1dB\x1b3B\xb9\x1fb\xbd\x8c\x8b7\xfatV\x9a\\\xdfIi\xcd\n\xb7\x9d\xd7\f\xba\x96\xfc\tC\xea\v\xb6<\xc4\xfa\xda\x170\b.ݛ}(\xfb\x06\x1d\x11J$\xef\xb0\x11\xef\x10_\x9e\xd1\x11\xbf\xbdv\xaf\xfe\xb0\xa9\xf8\x90\xf7R\x8cq\xe4^\xfd\xb3ވ7\xa9L\xe6%gQ\x14d\x95!߰\x14;Bt\\\x91\xac\x1at\xe0\xfa\x03\xf9\xb3\xc6S\x94g\x19\x8e\f\xc6(\x1a\x9d\x03\x95\xa1\x1fYN\x1ci!\xd6\t\xba\xe2(\xe0\xccZ\x8b\u007f\xffaK9r\v5\xbb,SEe\x99\xd7*G\xe3\x12\xb9BhĖNS(\x16 \x1bZ*#\x98r\"/u\xa7B\xd4\xc4m=\x9e\xe0\x12}\xe2Cs֠\xb4\x86\xd2\xd2hj\xde\xfe\xc0Y\x9e\xd9\xdda\xa1\x15\x92f\x156.h\x86\xca͠x2U\xd7?\x1f\xc0\xb4\x1c\xd0\xf3\x81\x12J\xe3$T&\xb3\xf8\xf3\x82I\x9d\xc1L\xdfB\x1fĳ\xa7*\x8f\xbf-\x89\xfcu\xaf#\x9fyQ\x83\x1c\x8au\xbf\x16\x0f\xe4ۚ\xa9\"\xf0a?7\x98\x04b_\x91\x1bKV\x18FOA5T4N\xdaZ\xb1\xf1\\*E\x94%\xcd\fv\xdf\x05\xd9\x13w\xb9S\x00\x04\x8dh7\x18\xa2=ͽ'f)FB\x94X\xbc\x9a䃛\xb9&S\xc0`[x\xea\x12\x9c9\t\x83\xd4U\xde\xc6\x1e\xd2\v[KU:\xa6W\xa9\x83\xa4)\xd6\xc1o_\xbb\xbaE\a\x162\x88\x13\xe1\xf4\xea,\x8f\\\xde\xe6\xc2S[|v{\x87j\xb9\xcf|\xe4\xd0L:vM7\xca\xe7\xf6DL\xe5@B3\xd2\xd30\a0.\fyaݨD\x9dc<%\xe8\xfa\x01P\rmX\xf6\xec\xd9[\x1a\xf6X=\xaf\xdf\x15V\xfcP\xdcլ\xccj<\x05\xfd\xd4R\xf9\xa0\v\b\xb4\x13Еƒ\xefA5R\xe8\xa6\x1bo6\xcfj`Y\xe3\x14\x1e~ \x9c}\x85#\x02?\xfd \x84\x85\xc7b\bW\xafn\xb3\xbb\xdbC\x80G\xae\xec1\xf6Rl%7\r\xe2\x98ZT]\x8c\x1d\xddQ\x16\x9a\x9d\xaecn\xb7.\n\xbe_\xefb[\x8f\xc8ihD\x0e\xf6\x06\xf5\xc1\x02J9\x83\xb8{\x11n\xac+\xa7xQ\x9a\xbb\x16\x8fp}o\xc8{\xff\xecQ\xc6s\xbb\xcc-x5\xf7\x05\xdb\x1b\x86ޖP\xed\xdc\x1c\x8e'4\x8d\x9f(\x82Jn2\x96\xaf\x85\xb2r\xf2\xd7pF\x91X\x85\xaf\x05\xa5\x0e\xa8\x18\xad\x84@K\xa9_(T\xf5\xb7,\xab\xe7\xf5k\x95\xf0s\xe1\x8e$'\x16#\xc4\x1a\x86\xb9a\x98[$\x84\x8e\xa7h\x87\x8af$4BEj,\xf9\xc0R\xf5\x04\x89\x84\xa8\xe3\nHS֏\xc2\x19\xef\xb6\xfb\x14q\xd3m\xf9͋\x82\x9e\xc9x|{\x9bO_\xddc{\xb7`\xa1\x151\x9f\xc4<s-\xe7-w\x9d摍\xed\x03\xbdn\x8d\x9a\xb4\xefd\xa6\xbd-\x977n\\\x81\xeb\xcf\xc0\xceU\bcE{\xd1\x15\x89~\x04a\xe0\x8c\x11x\xce\x10\xa2\xc0\xaf\xc3We\x84\xcb\x17\x9dp\xbb2\x96'\x1eO\xb9\xd8\xdba\xf1\xf6\x8aVK\xa1='\b\u07fef\xb9\xb1n\xc9\xd7\x05{HK\x92\x04\n\xad\x84\xb2\xb2\xf8\xb1\xa0Ky^\x16#\x1f[\xfc9A\x8f\xe4s\x15Х~\xa1\xbc\xb1z^\xaf(\xcb\xfb\xfd\x11˫Q\xc8R\xdbg\x94\x96tǆ\xb2r\xfd\x87N\xa0\xf0j\x17\x8c<\xa1\x19+z\xb9\xa5H-\xe5\x9e`\x10\xaa\xb1Pl\v\xa6P\xe4c\xcb\xc21\xc5\xea\x19\xc1\v\\h\xb3F8u\xbf\x81fN\xd6\x1e\xb1\xc9>\x1f\xfc\xf4&\xc5@\xb36?\xcb\x0f~\xe39\xde\xff\x91\r\xf6\xcb1~ \x87\xe6\xd5\x1d/5\x1eX\xfa\xbb\x96t\xe0\xc4q\xd9Ȳs\xdd\x15\xa2\xad\x05\x88k/\t\xb4K\xe2A͝\x05\x9eK\xf4\xb9\x81˗\x14\xa3\x9eCb\x8f<\xdc#\x98)iϋ\x13\x88W\xb0\xbdaټT1\xbe\xaa0\xbd\x83p\x15z\x8a\xc0s\xee\x91\x17\x16\xaf\x01\xde\xf3\x18D\x89\x9bc1\x1a<-\x889D\xef\x1f<\xca\xcfI\xbf\xaf\x9d\xd3w)\xcb\xc3a&\v2\x16\xee^mR\x96\x15\xa3\xbcd\x90\x1a*\xeb\b\xbdX\xc9t\x9a\v\xc0\x13!n*T\x05\xe3\xccR\xeeA5\x16\xc8\xea\xac7\x82\xe3\x0f8\xef\x88\x1aN\x9eٚ\x83$\x81\xd3'-\xcb\xcbBҴ\xc4s\x86-\xdbe}{ȸo\x98\x8bB>\xf0\xe0\x0e\xbe\xa7\\̯\x95$\x82\x9br\xb5V\xa6\xa1̅5\xcb\xd6e\xd7ʍ's-W\xa6\x1b\x00\x00 \x00IDAT\xed\xda\r\x8dƁL;\x88^\xad\xdbz\xfa\x19Š\x96\xcd\xee^sC\xa9\xcd\x19\xe7\xcd76,\xd7.V\x14c\xa1\xda>\x18\x9f\xd3Z\x88\x83\x83R.+,~K\xf0\xf2\xe73\x88\xeb\xe7\x9b\xdc\"MPY\x1d\xae&eKm\x10\xefy=\xe3\x9c\xfeZ\x11\xfe\xef\xb0O\x8c\x85v\xe0\xd1\b5;Y\xee\x9aP5\x92\xa8\n\x8b\xf1\xedM\x04\x96\xd4~w\xa4\xe31\x93\x18ƹ\xdbE\xcdXsq\x9432\xd5tr\xc9\v\x1c?u\xe6\x94eei2{n)J\x87\xacF#\xe1ڵ\x94\x0f\\x\x98j/\xe6\x9e;f\xb0\x99\xcfSOl\xd3^pD\xa2\xaa\r3\xbb\xear\xd3`\xcf\t\xee\x8a\x14\xaa\xc2\xf0\xe0\u007fP\xbc\xfe;\x85\xe8\xac\xc5X\x18\x15Ў\x9d1D\\\x91\x18\xfbnC\x88\xd4\xfa\xe3\x9a-\xbe\xb1\xee\xce\x11ܹn\xa0rr \x0e\r\x8fž\xba)$iqy\xe1&Z\xef\xd0h\xb6\xe79\xfd\x01u~\xd3uT\x89\x02E\xe4\v\x82}\x8e.\x8b\xd5s\xfa\x1e\x11~1\xecJ\xac\xc7P6-K\x8d\x00\xad\xe59\xe4aej\xc1ty\xe8p\x18\xa0Q\t\xaa\xe5qW\x14\xa1\xb5\xaa\xe7*\x14\x9b\x8f\xed2\x8e\f[OZVN\n\xbaN\xac\xf3\xb3\xae`\x9b\xec\xee\x89@\xad\xddvD\xe2\xf2\x8a\xd0\xef\xa7l\xad\xe7ܸ\xe2\xb1Wi\xae_2\xe8\xc0\x12\x84ΰ\xdas\x1a\xad\xa4\xe5fы\xb4.\x12s\xc3\xc7\xfe\x1f!i\v\x8dӖ\xa0\x82Q\xe1\xb4±\x0fa]\xf1\a\xa1\xbb\xb7,u\xf4Ho\xc7!Ĳ\xb0\xd36@\x10\ty\xe5(\x13\xad\xc4\xf5\xe8\xed\xc1\xb2hu\xa0\xf1=|\x14\xc8\xe4\xe7\xcaB\x18(l\xdf5\u0096\x9b>\x91\xef\x8aM\v\x14\xa5\xb9YJ\xbavN\x1f\x01\xfe8\x18Ȭ\x1e\x83m\x80_\n\xb3M\x0f\xaf\xaed'hb\x92X\xab\nډOZT\x14\xa5\x83S\x9e\x85\x1cK\x14z7\xb9l\xdb\xf7\xd8\xd3%\x83k\x86ޮbn\xb9\xbe\xa1\xe7Ip\x93\x0f\xa9|\x98\x9d\xb1\xccv`e\xb9b|\xb7\xe1\xfe\xaf\x86\xbd\x1d\xe1\xf1\x87\x84'\x1f\x81\xfdZc5ܷ7\xb5_\x11\a\x97\x87]\xf8\xc4\xef+\x82o1DG,\xfd\x14Z\xe1\x01}\xe2YG}\b5-o\x0e$\xb3\x82;\xb1hv\r\xb6\x9f:\xd84\x81'<\xfb\xe4DO\v\uefc33\xbe\x14\a\v^\xd5j\x15\xa9 \x89\x14I\xa0\x0e\x1a5\xf5\v{ӱ\xdf\xf3\xfa\x98X.xc\xda\xfedV4\x84\x99\xb1\xa6\x11jD\t\xa1\xe7hTu\x88\xbf\tb\x9f\xa3\xf3-nt\xc7t\x87\x19\xc6X*c\xb1c\xc3~R0\x1b8r\xcbXX\x99\r\xb8\xb8\x9ebE\xb8\xf6Y8z\xc6!\xa0\xdd=\x88\x96\x0f\x06A\xb3܍\x04L\x8c\xaf\x95\x1b\xc6\x11\x818\xb2$1\xcc\xcc\xc0\xca\x11\xe1\xbe\xd7\n\xdb7&\b\xce\xed\xf0\xf1\x10֟\x81\xe1\xc0Q3\x83}\xd8x\xd2\xf0\xf0\a\x84𭖣ˮfi\x05@PW\xec\xda\t\u008bq\r_C\x88\xdb\xe2NbH\xa6\xf4\xe8\x94B\xf5\xd4sw\x917Y\xf9\xfa3\xab\xdaE\xb4V\x84\xdab\x11\xba#\xd7n\x0e\xeb\x01&%\x8a\xc0W4c\x9f\xedn\xea\f\xb2r^\x1fS\xf0\x1b\xc1\x80\xb67<\xc84\xb1',\xb6=<\xadP(ڍ\x80\x1b\xdd\x11\x9e\xaas\xb4\x85\xfdaFީ\x98i\x06āGe\f\x9b{#\xcaҰ=\xceXk50\xd6P\x14\x15k\xf3!\xeai0mؽlٹ\xaeX<jظ&\x8cS\x97\x03\xd2T\xc8RW\xd4U\xf5\xa4l\x91\xd9\xe9 \xced쮜T\xea\xa5+\xfc\x82\xba\x91\xe5\x14%\x96\xf6\x82rGwxМ\x83llx\xf2c\xe0\x05\n\xff\xeb-\x81\xb6\xccĮGR\x96Ы\xa9\x9et\xe4\x80Fg\xc5\x19c2D*5T\xb6\xd6\xd5\x15Jnf\xb7\xa5\x16z8F\xd8m\xc0\xc0S\xb4\x13M'\xd1\x14E\xc9\xf6\xa0rbq\x11\x1a\x81\xc2ӊ\xf9v<\xadG\x8c1x+\xe7u˳\xfcjЕ\xfbuvО\f\x1aбj\x8ad\x94\x82v#\xc4XK\x14\b\x83\xdc\"\x15dXFi\xc9\\+\xc2\xda\x12UA+\t(\xfa)\xfb\xa3\x9cAZpt\xa1\xc1\x8d\xfd1\n\xcbr;dc\x9cRZx\xf2!K\xd2V\xf4+\xd8\xddv\xe1\xe2ƺe\u007f\xcb2\xee\vyj\xa9\ns0\x8fhn>\xc0\xec\xc0\xdd\x0f\x14\xee\xee\x8cFA\x94q\a\xf0\xd4=\xf5\xaat\xea\x93'>b)\v\xc5\xeb\xbf\t\x8et,Z\xa0\x97Bw01>D-W\xe3LڵӜ\x999/\r\xf4\xc1\xe8\x9fԡ\xcaXw\xfe\x92ԍ\xb1ن\xc7\xeal0\xad\xc4GcCe\x0e\xf4aq\xa0\x88\x02ozܭ\x88PY\x8b\xa7\r\x1f\v\x87r\x87J\x0f\u07bc\x19\nq\xa4\bm}\xa6P\xddXhE>J\x84\x86/\xec\x89Ӌ\x95\x15\xec\xf6S^vj\x91\xab;\x03\x06\xa3\x9cf\xec\xb3\xd7\xcfH*xl\xaf\xcb\x1dG:4\xa3\x92\xfe8\xe7\xdc\xf1&\x97>5&\x88\x14;W\f\x97>\xadit\x9c!n\\q\xc8\xc8\xcdc\x1c\xea\x87Oz\xe2\xf5\xe2N\n\xbb\t\x05\x1f6dz֖)\x1di9Q\x8d\xd8z hb0k\xe0\xd2\x05\x83\x17(\xa2\x00\xaac\x96KW\x84\x9d\x1d\xa1w\xc3M\xdf\x06\x91\x13:,\x1dU\xecn\x19\x8a\xdc\xd1@հ~\xdfC\xf8F\xd5\xf4\x87\xa9\\\xa7\x11\x03\x8b-\xcd|\xcb\xc3?\x94g<\xad\xb0TX\v3\x89Fk!\xf4\xd5sXa/\x1e\xca\x1djp\xc0\xdf'\x81b\xb5\xe3\x91cQ\xb5\x98L\xc4E\xcf \xd0D\x81GVT\xcc7\x14[}\x03\xa9\xb0\xeb\x15\xf8Z\xd1i\x86\x8cS\x17\xfc\x9b\xb1\xcfpT\xb0W\x14<u\xad\xc7]G;\x8c\xae\x97\xb4c\xc5B3`?/\x10\xdf\xf2\xd9O:7\xb6\xc6\xe2G\nS\x9fG\xa2\xf5\xa1c\xfe|'Z\vb\x87\xa8\x8cy\x16Q\xa8\x0e:\x876p,/\xd6\xe9\xa7\x0e{V\x99èo\xa9rx\xeacnx({\x00\x9e~\xdc\xc1\xe5\xfd-C\x90X\xe2\x960\xbf\xaa\x0e\xea\x84Z\x06Dyp\x12\x84\xfb\xbe\xe0k\x99&\u007f\x04t%x!\xf8\x9ek\x8e\xf8Z\b}M\x1c\xf8\xac\xefw\xb1\x16\xe6\x9b>\x81\xaf\xf1\xb4\xbe\t2[#xzDj5\x91\x8b\x8dpr)d\xa6\x11\xb07\xcc)\x8b\x8aN#\xac\x93\x8f\xb0\xd0\xf2\xb9m\xadţ\x97\xf7\x89|\xa1\x19)\xfa\xa9a0c\xd8\xda\x1b\xb38\x17\xb3\xd7K\x19\xa69\xad\xc4g\x98\x16\xcc䚇\xf7\xf78\xbbڦ\x19\xf9\xec\r*ή$<x\xa9K\x80P\x05n\x81\x94\x86\xaa4\xb4f\x15q\xcbN\xeb\v\x91\x83\xf3\xb4&Ģ\xae\x11ޤ'\xaf<q\a.\x1f:\x01Hi\x99\x9e\b45\xae\x82\xee\xb6e\xfdICڷ|\xfc\xfd\xc2g\x1fv\xa2\xbdQ\xdfY-n\n3\v\x8a0v\xb9k\x02\x80\xf2\x14lz\xf3\xe4\x94W\x1bc\xb1\xed3\x1c\xe7l\xa7\x10\xd6\x1e\x1dx\x9a(\xd0.\xff\n\xaco\x8f\xc9K\xd7&hF\x1eI\x1d\xae\x00\x9a\xb1\vmI\xe4\xe11\xdd\x05\xc2RK1ߎ\x88|\xc50-\xf1\x11\xc2@9\xd4\x04ā\xe6\xb6\xd56O\xac\xf7(*C+\x10\xb2L\x18W\xf0Ե=\x8e\xaf\xb4\b|M^\xba\xf27\x0e=\xc6YI5\xaaxh}\x87{\x8fͳ?H\x99o\xf9\xb4D3.,Q\xa7b\\\x1d\x16%8\xc5\xc8M\xe2\xedɼ\xbaq\xf5@\xdcp\x82\xea \x16|\xff\xe6\xe2k\x12ڦ\x87i>\x8b\x1f_:\xe6\xc2\xf0\xb5\xa7\r\xe3\xbea\u007f\xab\xfe=\r\xb3\xab\x8e\xec\xec,\bU\xe9z\xf7\xd3\xcfU:}\xaf\x1fJ\xdd\xe5t\x94\xd1ɥ\x988\x10.宗\xa0\xc5%\xfd\xc8\xd7\x04Z\xb96\x89\x85\x9d^\x86\xb1\x96نG3\xf6\xeb\xd3,,\x8d8 \x89<\x8c\xb1$\x91>(\f\xe7\x1bB+\tH\x02M\xe0+:\x8d\x80\xfe\xb8\x98.HQU\xf4F\x05\xc7\x17\x1b\xb4\x13\x9f\x9d~\x86\xefA\x12A9\x84\x8d \xa5?\xcah\xc4\x01\xe3\xac\xc4(K\xa7\x11\x90\xe5\x15I\t\x9f\xd9\xeer\xc7R\x87V\x12bm\xc6\xd1\xf9\x90'vƔ}E\xd86\xa4=\xf7>\xe9В\x8der\x96Ձ\x88!\x16V\x8e)D\x1fPؓ\x9e\xb9\x17N\xcek\xb4(%\a\x87٘\x83S\x1d\xb4g\xb1\xa50\x1e\xc0\xf2Q\xb7\xcdw\xaeYƽ\xfa\xc0\xb49\xa7\xebZXsp\xb4\xacCܤ\xd6\x1a\\\xb6\xee()q\x9eю4gVb|-\f\xc7%\x95q\x85\x9f\xaf \n<\xb4\x16|߅\xa4\x1b\xfb)\xa3\xdc\xf1\x1b\xab\x9dp\xaa\x13\xf3<E\xbb\xe1\x0eyQ\"\f\xd3\xc2e\x8e(\x10\x9a\x91&\t\x14\x16!\x0e4\xad\xd8\xc3SjzjNY\x1a\xb6{\x19\x8d\xc8\xe7\x8e#mL\xbd\x85\x9b\x91\xc2\xcfa\xcfT\\\xba\xdecy6\xae5PB#\xf6i\xc6~}L\xaa\xe5ϟ\xbc\xce\xea|\x938\xf4\xb8m\xb5AX\tf\xe4Np\x98\xf4\xe2\xab\xc22\xeaެ\x98\x980\xa5E\xe1d?i\r\x8b\x95\x9a\xc8E-\xbeo\xf1\x03\xf7o\xe59\xc1\xdbDJ\xaa}\x97\x97\x82\xd0ҙ\xb7\xb4ga\xe9\x88\xd0Y\x14\xe6քΊ3\xe2̼\xa2\xd1\x16\xf2\xcc\xe5\x9f<s\xb2\xa0юP\xed\xbb\x0f\x14\xfa\xc2\xd1\xf9\x90\xbb\x8e4\xf0\xd4A\xfe\xc8+\xe7\x1dZ \x0e<\xa2@;\x1d\x98\x12\xba\xa3\x9c\xacr\x9a\xb4\xf9\x96\xbb\xd18\xf4\x98\x9b\x89]~V\xc2(-\xe8\x8f\n\xb7ٚ\x81\xaay\x18\x17\x9e\xe2\xc0\xc3\xd7j\xeaJ\xd4Hk\x98\x95\fҒ\xfb\xce\xcc\xe1i5\xad\xa8盚\xb2\x0fO\xee\r\xc8\U000ca579ƴI\xb40\x13;-\xafUl\x8c\xc7<\xf2\xf4\rN\xaf\xce2\xdb\xf0\xb9\xfdHB\xd15TC7\xf9:\x89ϣ}K6\x92\xe7\xb4㶯\x19\xf7\xba5r\xf2|\x17\xcbd2\xbfQ\xe7\n\xaf\x06\x02Ah\t#\x83\x1f\xd8ZW줁I˲\xbc\x06\xb7\xdf#4g\x84\xaa\x80\xf6\xacbv\xd1i}\x8dqF/sK\x99\xc1\xf0r\x8d\x80\x94\xb0\xd8\xf4X\xe9\x045k\xe1\xc8Ǣ2n\xb6^\v\x81/4\x13\xb7~J\x84\xac\xac\xd8\x1f\x15\x80\xb0\xd8v\x14T\x12x\xb4\x92\x00_\v\xba\x16\xf0mwS*cQ\x81/\xb4b\x8dźa\xfdʐ\x15\x153M\x1f_3\x1dn\xb4\x16Fi\xc9\xfe\xa0\xa0\x19\x05\xbc\xfa\xceE\xca\xca\x05jOì\xa7\xd9Ls\x9e\xbe\xdeei\xb6A\x1c\xea)\xb7\xb52\xd7@U\x16\xad\x14\x8fov\xd9\xdc\x1b\xb0:\xdf\xe2\xde\x13mfb\x8fb\xdbbFB<S{Ieٻ~\xf3!\xfb\xe0j\x84\xee\xb69\x90\x10U\xee\xbd\xcb\xc2\xc1o\xa7jw\x85\x9dW{\xc5$\xb1\xfb\xa1;\xf1Gy\x16\xf1,*\xb04\xdbp\xfby\xe1\x8e\xfb4\xf3\xab.7LN\xaf\xc8ǆ2\xb7t\xaf\be\xcfֻZ\xb1\xdc\xf1\x1d\xf2<\x04{\a\x99C\x8aJ\x81\xa75\x8dȯ\xa7\x85\x85\xednJ\x9aC\xe4\v\xf3M\x9f\xc8״\x9b\x01\x81\xa7h\x84\x8a$t\x84\xd8nߵVU#8\x18\xb0V5[y\xa3\x971\xdb\bhD\xbe\xa3\x8c\xedD\xa0l\xb8\xbc3\"++^y\xfb\x02\xa7V\x0e\xe6\xa7\x1bڅ\xa5\xa7w\x86lwG\x9cX\x9eq\xa3\xc4\xd6\x12\xfa\x8af#\xa0\x9d\v\x03\xdf\xf0ɧ\xb7i\xc6\x01\x9dV\xcc_\xb9g\x8e\xc0(\xb2M\xb0\x853\x8a((2\xc3κLk\x88\xa9\xb4\xb5kIGu{\xb8\x98\x14Z\x16S\b\x9egk\xa1\xf5\xc1\x04\xf1D\x9e\xeay\a䟓\xab\nR\xcbY\x9bMKP\xc3i\xed\xb9\r\x91\xe7л!\xa4W\x0e\xe4?\x8bMM\xb3\xae\xc5\x0e\u007f\xa6q\xe6v\x8e\x16\xe84\xfc)㛗\x86\xfdAF\x12i\x16\xdb!\xf33\x01\x9dV\x88V\xae\x99\xa7\x95\xd0I<\x8a\xb2\xa27r\x06\xd1w,y\xef\x16\xc4s\xb0\xcb\xc7\xf3\x14\xc6X\x8a\xcarf\xa5\xc1N?\xa7\xa8L]\xde\v\xa3\xac\xa4\xdd\b\xe9$\x1ea\xe4\xb1~cDQS\xd2>\xc2&\x05\xcdJ\xb16\xdf\xc0XW\xc5;\xa3h\x86i\x81_\n;\x14x\x05\xdcub\x1e-\x82\x16\xcbխ\x8c*\x05\x95\xb8y\xbe\xaa\x84*w*\xc1\x89\xaa}\x12\xba\xf2\xcc\x1d\xaf\x81\xb8?e\xa1\xb53D\x98\xd4gcy\a\x1a\xe1IM3\x11\xdc\xf9\x9e{8ُ\x1bM\x90\xbaWQ\xe4ΐ\x83\x9ee\xb0o\xd9{\xcc\x1d_f\x81F\xa88\xbe\x10\x10\x87\x1e\x9er\xa1J\x14\x94\x95\xe5z7\xc3\x18\xf7\x9c\xd3+M\x02\xcf\xd50\xdda\xc1\xb0f1\xe6[\x01\xabs\t\x9e'\xcc\xc4\xee5:\x89G\xe8)>\xfc\xe9-\xc6Y\x89\x88\x94\xfaԂ\xf7nO\x8b'\xa2\xd0Z\x88B\x0f%\xc2(\xabh\xc5\x1e\xb3M\x9f\xbdaAY\x19\xb4R\x18\x03\xfb\x83\x9c\xa3\vM\xe2HSX\xd8\xda\x1ba\xea\xfa\xc0V\xb0Cɬ\xf28\xb1\xdcb\xbb;\xa2\xaa\xe3\xaf֊q\xea42\x9b\x831\x9d\xd0\xe7\xd4\xea\fq\xa0\x10\f\x97\xae\xa5H\xe6\x92\\\xd0v(\xa7H\xddܹ\x127\xfe\xccM'\t9\xca!\b\x0f\xfd)\v\xef\x90JQ9у_\xf7ң\xd0\xf5յW\x1fh\xa6\x0f\x9aZe]ݏ\xc7N\xd5\xde\xdbVd\xeb.2\x18\v\xc7f5\xb3M\x9f\xd0\xd7hO\xd5s\xef\x8a\xee\xb0`wP \x02\xabs\x11\xabs\x8e\x9b*\x8de\xbb\x9b1\xd7\fi%\x1e\xab\xf3\t\x81\xe71\x9b8\xb1\xc4lCӎ5\x9f\xba4\xe4\xb1\xcb\xfb\x13\x8a\xc5\x19D)\xf1\x1c%\xa1hD\xfe\x14\xd9\xec\x8f\n\x8e/&\x94\x95e\x94U.\xe9h犃\xb4\xe4\xccr\x93(\xf6\xc83\xc3n?s\xc4[%\xf4=\x83-,k\x9d\x84v#b\xb7\x9fb\xad%\t}\xb2\xa2\xa2**\xc6\xca\xd2\xedf\xcc5#\x8e,\xb6h\x85\x9aNCse3\xa5(\x05;\x10\u008e\xeb=\x949\x8c\xfb\x96\xf1@\xe1\xf9\x8e&1\x95\v;\xc6N\xbc\xa4n\xfa\x04\a\xc7\t\xfa\x81\xcb1\x9e\a\x91\x0fq\xe8Z\xb9\x13\x10\xe0\xd5C;U=\x96]䰻\xe5N\x99\xeb]tT\x89\xc5\xc5\xff\xa5\xb6\xa6\xd5\b\xf1=5姬\x85\xad\xfd1\xe3\xccm\xd6S\xcb\rZ\x89\xcb\x1fiV\x91\x15\x15\xad$`\xbe\x133\xae\f\xeb\xe3-\x06\x15\xbc\xf6\xcc\x1c\xb1/\\\xdc.\xf8\x8bǷ(J39\x86\xd0\x19D\x8bx\xaa\xa6/\x1b\xb1\u007f\xd3_\"\xa8*8\xb1\x143\x18\x97\x8c\xf2\xaa\x8eيAZ`\x8c\xb0҉h\xb6}\x06\x83\x92ި\xc0\x00q\xa9\x18\x87\x96\xd0\b\xa7V\\.\xe9\x0es\x8c5\xb4\x12\x9f4\xaf\xa8*K\xd7V\x94\x83\x82\xd9V\xe4య]\u07fe_\xd2M+ʡ+\xc0\xb4vJ\xc12\xb3\x8c{P\xa62=YTk(J!\x8a\x98J\x85\xfch\x92`\xdd\xd7\xd0\x03߳$!,\xb4,\xa3\xfc\xe0 \x86\xbc\x80,sCD;\x9bnD{<\x84\xd1\x15\xb0\xf59(.whf\x12\xdfU\xdeuR/JC\u007fTP\x1aK\x1c(n[k\xbb\u07b9\xb1\xf4G\x05\xa1\xafXhG\xec\xe7\x05\x8b+\x9a\u007f\xf8Uc\x8e4\a|\xecz\xc5\xce\xc8\xe3\x99kC.m\xf6\xd05\x1a\x8b\x96\x13\x9cA\x943\x88\xa9w\xb1\xa7\xe5P\x9f\xd8\xd0L<VgC\xfaiɨN`\x81\xa7\xd9\x1d\xe4\xcc6\x03V;\x11\x04\x8at\\\x92\x15\x15\xa5\xb1\x84\x06\x02_\x93\x15\x15g\xd6:\xb5W\xb9j6\x89|\xd2qI%\x96nY\xa0sK\xbb\x11\xb2\xd0iЌ<\x9a\x910\x17y\xecv\vz\xbd\n\xad\x14\xaa\x124 \x81\x131d\x03q\xf4\x88g\xd1ڝ<\x1aFu\x0e\xa9\xdbÓ\xbf9\xe2yn\xa8t\xbe\x01'\x17ܮ\xdf\x1b:iN\x9eCwW\xe8\xef\v\x83\xae\xa3\xdf\xd3.\x8c7\x9c\xc7\xf9ZXik\x1a\x91G\x12z\xf5ڸ\xf5I\xb3\x92\xbc4xbY\x9c\x89X\x9aM(\x8d!\xcb\r\xd6ZZI\xc0\xa0\xacX:9\xc7\u007f\xfb\xca1\x9e\x1d\xb3Ҁ\xd33c\xfe\xf0\xf2\x90\x8f?1FYK^\x1aZG[\xfc\xe3\xbf\xf15\xc5AȒ\xba鮄(8\xe8\xf4\x19c\x19\x17\x96\x85V\xc0b;`\u007fX\x90\x15\x86\xd2X\x02Oq}/\xe5\xe4R\x93ۗ\x1b\x94\x02\xdb{)\x82\x90奣:\x94\xdb1ǖ\xda\xf4\x86\x19Ei\xdc$\xac'\x94\xa3\x92\x91\x86\"/\t\x95\"\x8e<f\x9b\x11I\xe8a\xac\xe1\xccrL\xd3\xd7ܸQP\x14\x16\xf1\x04\x9591\xb41n7\xab\xbaB\xf7\x82\xda(5\xb1\xe8{.\xf1O\xea\x85(\x80$p'\x9dV\x06vz\xaeﲷ-\f\xfb\xc2\xfe\x8ee\xd8w\xbd\x95\xc15\xa1ܯ\xbd\xa3\xa5iG\x8aF\xe4\x11\x06\u07b4\xfe2\xc62\xceK\xc6i\x89\x01\xee<6\xeb`si)\x8a\n\xad\x85F\x1c\xb0\x1b\xfb\xfc\xcc[[\x98\xee3\xd3\xd6A\xec\t\xaf9b\xf8\xf0\x8eegǒ\x1cm\xf0O\xff\xab\xd7s:\xfd\xa8\vYR\x87,\xd7$q^2\x81n\"\x0eI\xa0<:\r\x8fv\xe2\xb37pI\xdeXG\xb6]\xb91\xe2Σmά6\xc9+\xc3v\xd7\xe5\x93AZ\xd4\x14\x87e\xae\x15\xb20\x93\xb07\xc8ȋ\x8a8\xf40\x80\x1dU\xec\xab\n\xafrIr\xbf,Yj\xc7\xcc6CGN&\x1e\xb7\xad$D\xa2H{\xc6\x1dRS\x812\nU\xb8\x93I\xbd\xa4\x96\x8b*\xa1ȅ$q\xea\xf7\xe9\xd1\xe3u\xad$\xe2\x8ev\xbarC\x18\xf4agK\x18\x8f\xe0\xc657\xe609}\xae\u007f\xd9I>#_qb\xde\xe5\x84f\xec\xbb.\x9fL\"G\xc5`\xe4<de.a\xb1\x13c\xad%/**c\x98k\xc7<\xdd\x1b\xf2\x0f\xbe\xf7\xabi\x8f\x9e\xc4\xe6\xc3i!\x8d\x80/\x86o\xbf\xb7\x83YZ\xe0G\xbe\xfe4+\x83OP\x8d\xf7j\x83\x807IT\x02S\x96\xf2\x80Kr\xedG\x11E'q\xe4XwX\x90\x15\x15R\xf7\x8a\xb7\xba9\xb7\xaf69w\xa2\x8d\x1f(6wS\x8a\xca2\x18\xe5\xae{\xe6kf\x9a!3I躌EE3\x0ePJ\x18\xa7%CchjE'\t\xe8\x95\x15\xc3\xcap\xa4Ӡ\xaa,Ei\x98o\xfb\x1c_\x8cXj\x054\xb4\"\x1b\x94\x14cKуl$x3\x93z\xe1\xc0S&\x03\x9b\xbe\xef\x1aK\xfd\x91pcG\xd1\xdd\x13z\xbb\x8e\x1a\xd9ڀ\xfdm;U\x9b\x8c\xf6\x84\U0007a0ff\xa7\x16<7\xba\xe0+\x92\xd0\xc3\u05fa\x16e[\xb2\xa2\xa27\xca\t}\xcdmGg\xa6\xba\xab4/i%!q\xa8Y\xecx\xdc~b\x91\x95\xf99ʝ\xc7\xf1\xb4Ǖ=\xf8g\x0fY:\ra\xd9\x1f\xf3\x8a\xb91zp\x85\xb2,\xd0J9\x83 xJԴ\x00\n}M\xe8\xebC\\\x92P\x94\x15J)\fB3\xd4,\xb4\x03\xb2\xd20\xac\xab\xd4aV\xb2\xb9\x97s\xd7\xd1\x16w\xae\xb5h\xb5|.m\x8e0\xc62\xa8I\xcaN3$\xf05\xcd$\xa0?*(*C\xe8{\x84J\xb1]\xe6\xa8\xd2\x12i\xc5\xcaLB+\xf6yt\xbbG\xdfT4}\x8dX\a\x87}\r\x8b\xed\x10lI3\x14\xb2ʒ\r,\xc5P\xf0;v\xfa\x97z\xf2\xd4\x1d\x10P\x19w\x8c\xc6x,\x8c\aBw\x17F\x03\xa7\xbaߺ\n{[v\xca\bT\x06\xf6\x1e\x17\xaa\x11\xac\xcch\x9a\x91\xc2Zh5\\{z\xa2\xbc1Ʋ\xdbs\xb5Ǒ\xc5\x06\x9df\xe0\x8c\x99\x96D\xa1\x87\xf64\xaf\xbem\x867\x9f[\xe4_\xfc\xe9S\xbc\xe95\xf7b{W\t\x18\xf1\xae\xf7\x19~\xeb\xc1&\x8f\xee\x19\xder\xa7ŝ\xca%D\x81\xb0ݫQ\x96kh\x1d\f\xe0h\xad\x88\x0f\xf1\xf5\xd3\x04\x9f;\xa3 B\x14\xb8\xeaSD1\xcaJ\x8c\xb1\xf4\xc6\x05Oo\x8e\xb9\xe7X\x8b\xbb\x8f\xb4Y\x9c\r\xb9x#\xc5Z\xd8\x1fdxZ\x98mE\x04\x9e&\xf05\xfdq\xe16@\xa0\t\n\xb8Ret\xb4GYV,\xb4cNϵ\x98\x8d|.t\xbb\\\x1d\x8eih5=\x81\xae\x95\xf8t\a\x19\x9dX\xd3O-\xc5\xc8R\x94\x82\xdft\xa4\xbb\xa9`4pC\x9de\xe9\xce\xd2\x1a\x8f\x9c!\xfa]a\xe7\xba\xd0߷Ӿ\x8a(\xd8[W\xe4\xeb\x96f\",\xb6t=J\xa7\x99mEh-\xf5\x9f볤\x85a0*\bC\x8f\x93+m<\xad\xc8KCYY\xa2\xd0\xe7\xfe\x93-^{\xe7,X!2\x86\u007f\xf5\xf1\x8b\xbc\xf9\xfe\xb3\xd8\xeeE\xa2\\\xf1\xbe͒\xcdK\r~\xfdr\xc9\xdb\xef\x81X\xc3\a\x1f\xb3\xbc\xfb\xf7\fS\x83L\x88\xb2\x89B\xa4\x19\xfb\xd3>\xefv\x963\xae\xack\xe5V\x16]\x97͞V\xactB\xda\r\x8f47d\x85\xa1?.x\xf8R\x8f\x93\xcb\r^u\xdb,g\x8f4\xe9\xa5\x15\xfdq\xc9\xd6ޘ8\xf4\x98i\x04D\x81\x0f\xc62L\v\xf7W\xd5\"\x0f]\xc0\xf5\"\xa7\xa94i^2\xdb\fi\x04\x1egggh{\x1e\x9f\xdd\x1f\xb0\x95\xa7\x14Xʺ`\xabr\xc3l\xa2\x18d\x86qׂ\x16t\xec\x8cRU\x90\x8e\x9cq\xca\xd2\xd1\xfa\xdd=\xa1_\x8b\xe9\xaaC\xb4\xccpG\x18>\xe5\xfa\xe5\xab3z\x8a4;\x8d\x90$\xf2n\x92\xf2\xf4F9ei8\xbe\xdcb\xb1\x13\x91\xe5\x15ô\xa4\x15\a\xdc\u007f\xaa\xcd\x1b\xce\xcdS\x94\x96+;#\xb6\xf6\n\x1e\xfa\xec.\xf1|\x87\xdb[\x05\v\xf1\x88\xbf\xf8\xb4aW`p-\xe6\x03\x9b\x05\n\xc3?\xfa\xb0\xe2\xf2n\\\xe8ӵA\xa8\xc90\xc0\xe1\xea\xd05X\xb6\x94\xe5;\xdep\x8c\xfbOzd\xbe\xe1\xe1\x1bC\xba\x83\x9c\xf90Bi!\xf4\x84F\xa8YlGD\x81b\x90V\xa4Eɓ\x1bC\xe2@s\xef\xc96/?\xd9!\xf0\x14\xeb\xbb\x19\xddaF\xa7\x11\xb9\xd0\x15;\\\xdf\x1be\x88\b\x89\xefa\x8del\fںX\xddJ\\\x19\xdei\x04\x1cm5\x98\xd1\x1e\xc3qQk\xb6\x84\x9e\x18R]\x11ǚ4\xb3\x8cw,%\x82\x12\x8b\x04N薧n\xe2v4t\xfd\x96\"w={\xe5A\x96\n\xbd\xab\xc2\xf0i\v\x05\xacv<B\xcf\xcduā\xc7|;\xac\xdbخ\x05QT\x86ި`\xbe\x1ds\xf7\xc99Ҽ\xa2?ʉ\x02\x8fW\xdd\xd6\xe1k\xce/\xa0\x94\xf0\xf4\xe6\x80K7R\x9e\xda\xca 7<|\xbd\xc7W\xdcw\x8eNy\x91\x95&|t\xbdd\x9c\atw|\xfe\xe4\xa2G\xde\x0f\xf1J]\xea\xe5\x05\xfd\x9e\x00Ѷ\x1e\x9e\x9c(\xee\xc6iō\xa0\xe4\xef\xbf\xfd\x14o:\x1dr\xdbb\xcc\xeb\xce\xcc\xf0\xed\x0f, \t\xfc\xfaS\xd7\xf1+\x9f\xb9\xc8\xc7\xf7\x14\x81\x16\x96\xda!\xab\xb3\x11\xfd\xb4b\x90U<\xb11\xa0\xa8\x84\x97\x9fjsv\xad\xc5\xed\xabM\x1e\xbf:\xa4?\xcei\xd7j\xc8$\nP\"\xf4G9(!\xf1<Rc\b\xb4\"\xcb+\xb4\x12\x9aq\xc0\\\xd3g\xbe\xe5\x13G!\v͘\xf10'@\xb1\x1cG\xe8\\(\xb2\x92\xa4Y\xff\xc9\xe8}K\x9a\n\xf9^}\xe6\xa2v\xf9a2\xe4S\xe4\x90\xf6\x85\xfd\xcb\xc2\xe0i(\xb6,\xca\xc2\xf2\x8cG\xe49W\b<\xc5\xca\\D\xe0ɔ\x05\xb7@\x9aW(\x11\x1e8\xbb\b\xd62\x18\xe6T\b\xaf\xbds\x96o|\xc5\nJ\xe0¥.7\xba9\x17\xae\x8e\xb8\xbe7\xa2(\r{\xfd\x94㷟\xe4x4f5\xe9\xe3U\xc2G\xbb\x05v\x10\xa2\x8c\xaa\xbb\xf4\x94\xfao}\xcf\xf1w_x\xba\xa7\xbd:i*\x11\nc\xc9[\x86\x9f\xfe\x8e5\xee_,ɍ\xc2\xf7}G\x04*\xe1\u07b5\x06\u007f\xf55\xab\xa4*\xe3O\xaf\xf4P\x95b\xbe\xe1\xe3{B\xe4+\x8e/8\xd2i\x9c\x19>\xbb9\xe2\xean\xc1\x99\x95\x84\x93K\toz\xf9\x12\xa3̲\xd9ͦ\xfd\xe6V\x12 \xa2H\xf3\x12k,\xb1\xe714\x86P)\xc6Y\x89\xa7\x15\xb3͐N\xec3\xdf\xd2(\xa5X\x9em\xba\x96@V҈5\xb1\xefS\x8eK\xe6\x02\x85)!\xed\x19\x8a\x02\x86\xbb\xc2`C\x18\xdep\x9eл(\fօt\xddRu\xc1\xb7N\x1b\xb0\xd4v\x9e\xe1+'b[ꄮ\xc9T\x87\xedZ\vC\x9a\x1b\xee>9G\x14x\xa4\xb9\xa3G\xde\xf6\x8a\x15\xde\xfa\xcae\xb2\xbc\xe2\xb1\xf5\x1e۽\x9c\x8f>\xd3gsw|\xd0:(\r*ּ\xea\xce\xd3\xc8\xfe\x93ܾ\xac\xb9\xb2Q\xf1\xb41\x98\xb1?\x89\x9c\xa5\xfe\xc0O\xfc\xbfu\x9dk\x8c]\xd7U\xc7\u007fg?\xce\xf3\x9e\xb9w\xee<<~\x8c\x1d\xdbq\xec\xc4y;(NhR塆\x90B\xa4\x86BhQ\xf9R\x04JT\x81\xe0\x03B.\rB\x02)@\x85Z\x05\t\xfa\xf8\x02\x11J\xd3\x16\x15\n$\x81\x82\x9a*q\x83\xb1\x9b8qL\xec\xf8\x11όg\xc63sg\xee\xbds\x1f\xe7\xbd\xf9pΌ\xe3\x06\xee\xf7+\x9ds\xd6\xde{\xad\xbd\xfe\x8f\xf5\xcbG\x96\xb2H\x9d:\xb7\x8c6\x16\xa91L\xee\xd4\xfc\xc5\x13S\xdc4\x9a\xd2\xea+\xfe\xfa\xc7\x1dN\xcc\rH\xac\x12\x86\f\xb4\xc4䆃[k<x`\x84o\xfdx\x86\xf3\xeb\t74j\x15k\xcfbr\xc4f,\xb4\xb1\x80\xf9\xb5\x98\x0f\x96#\x84\xb0\xd8Zw\xb8eWH͑\xac\xf6R֣b3(\xa1\xa7\x91R\x90f\x05ڲJM\xa5)/`IjPJ\xd2\f5\x93#6\xbe-h\xd6=<\xc7.\xe1\xd64'\xf0ʶ\x8c\xaf\xa1\xee\tTa!\xb3R\xdfg\x06`\xc5 sp\x00\xdf\x11\xd4<\xc1d(\xa9\xbb\x02%+\xb7S-\x18\xab;%\f\xbb\xe9\xdePJ⢤`\xdbX\xc0D\xc3#N\v\xf2,\xe3\xc9\xfb\xa6\xb9\xf7\xc6&qRpf\xb6\xcb\xccʐc\xe7;\\iG\xd7\x10\xaf\xb5%8\xdb\xee\xf0\xf8\xcf\xde\n+o\x13\xfa\x92<\xb6\xf8`9c!\x13\xa8Db0\x99|\xe6\xd3ۿ\xf8\xb1\xdb\x0e\xaa\xb6\xc8y\xfd\xddY\xa6v\x05|嗦\xd8\x1d\f\x98i\xdb\xfc\xc1\xb7\x17\xf8\xc7c\t\xc7N\xa6\xfc\xe0\xcd>\xa7W\xfa<pc\x88\xabʞΛ\xef\xb59qj\x95U\xa9\x99r]\x94\xb4\xf0+\r\x87\xef\b\xc6CM#\xb0\xe9ņ\x99V\xcc\xc5\xe5!\xbb\xc6]vo\xf5\xd9?壴\xe0\xd2JTRj\x94\xa0\xe6\xdbe\x056H*j\x8dE\x96\x17\xac\x0f\x13\xd2\xdc0L\fZYL\xd65uO\xb2\xad\xe9\xd2\f]\x10\x8av/\xa6V\x05%\xcb\v\x02W\x10:\x82\xba/\x19\xad\t\x1a\x81\xa0\xee\t\xea^Y\xd2\x06\xb6@\xab\x12\x03\x12Ua1Qw˲_\t\xb4\x12\xa4\x99)\x91\xbf\xa4`j4`\xdbx\x8dA\x923\x1e*~\xe3\x91\xeb\xd8;\x15\xd0\x1f\xa4\x9c8\xb7\xcaB;\xe2ع\x0e\xad^\xbaY m\xfcV\xe2\x84_|\xf8vnU\xefC\xb4FVX\xfc\xfb\xdb\xf0\xc3U\x8bb\xd5\xc7`\xa8y^&\u007f\xef\xae\xf3_\x14\x9d\xf3\xea\xfe[\xafgz\xcfv>\u007f[\xce6{\x9dS\xb39\xbf\U000f72fc\xd9R\xa8\xbe\x83\xc0\"\xce\f\x8f\x1e\xae\xf1\xe0\r\xf5\n\xbc\x8fy\xfe\xe5\x19\x8e\x0e\x12nvC\xa4Rd\x85A+\x81\xa3J\xba\xa5\xef*F\\I\xe8\b\x92\x1c:Ü\x933}l)\xd86\xeap݄\xc3\xce1\x97\x0f\x96#Ҋ\xda\xe7;\x8aF\xe8\u0eda\xd0\xd3\xd4\\\x1b\xdfQ\x9b\x98L{X\xb0\xd2\xcd\b=A`K\xc6k\x8a\xdd[|<\xd7\xe6J'\xa2\xe6iҼ J\xf2\x0f\xcd\x0f\xb9\xaa\xe9\xdb\xc0ITEz\x13¢Qsh\x8e8\ba\xa1\x94\xa0\x1e\xd8\x14\xc6\"\xca\n\xbaEΎf\xc0\xd6\n\xe39\xbc\xaf\xc1g\xee\x9fƷ\x05+ݘ\xe3\xe7V\xe9\x0e2^?\xd3f\xad\x9f\xf1Ӵ\xdfa\x91s\xdd-[\xf9\xc2]\x0eE\xeb\x1d\f\x16\xfft\xdc\xf0\xf7\xe7\nZ\x8b!\xd2X\x8c\x04>\x8dZ-\x93k\xb9z\xe6\x8e\xc9Dz\x83\xb3\x1cp\x96\xa9Y=~\xf2\x81\xe1\x99\xefwy\xaf\xe7\xa0;^9\x1c\x05\x8b\xeb\xf6)\x8e\xfc\xc2\x16F\xaa\xb6\xc7\xf7~\xb4\xc0\xf7Ϸ\xb9>l\xa0\x84E\x9e\x97\xc7J\x94\x96\xa3$<[01\xe2\xd0\fmB_\xd1\xf0ʜ\x11\xa7\x86\xb3\v\x03Vz\x19\xa3\x81f\xfb\x98\xcb\xc1\x1d\x01ô\xa07,H\xb2\xf2\x18s\xb4\xaa\x1c @\xebr5gyA\x9e\x1b2cXl\xa7\xacGe\xdf\xc8w\x04\xfb\xa6|\xee\xdc;J͑\xf8\xb6F+\xc9 Ɉ\x92\xbc\xa4\xa3r\x95\xe8&Eu\xdfҒ\xb1\x11\x97\x9a[\xf2\x89\xb4\x10hG2\xc4\xe04=\x0e\x1e\xac\xa3G\x04\r\xcba\xe7\xb8\xc7cwNph\u007f\x934Ι[\x19\xf0\xce\xc56s\xab1\xaf\xbdצ\x1f盕\xea\x06\xd6\xde)2\xa6n\x98\xe0\xd9G'\xc8\xe7\xff\x9b\xa2\x80\x97N\x1a\xbev\xbc`v\xcdC\xa76\xb5\xc0\xa7\x1e\xf88Jg\xd6\xde\x1da|\xfbtd\xff\xd6!\x8b\x87o\xb183oq\xe4\xdf\xe0\xed\xcb\x1a9\xb0\xabq\x9c\x90z\xf0է\xc6\xf9\xf9\x03c\xa5s\xff\xf9.\u007f\xfa\xdds\f\x94\xcbޑ\x1a\x9e-\xb0u\tnyn\x89\x88\xf9\xb6`\xba\xa9\xd9\xda(\xf3\x83\x92\xe5C\xaeG9K\x9d\x94\xcbk\t²8\xb0=\xe0\xae=!JK.\xb7\"ޞ\xe9\xf1\xeel\xafZՐW\xb4xs\x8dInY\x15\n!pmI3\xb0\x99\x1cQ\xec\x18si\xd6\x14qZ\xb2d\x96\xbb1s\xcb}\xe6V\x87\xactc:\xfd\x8c\xce \xddD9U%Z7\xa6T\xcf.\x93rߡ\xdd\xfc̾qnn\xa6l\xa9\x19./w\xf9\xe3\x97\x16x\xeeӷ\xa3\x1d\xc1\xe2Ҁ\xd9\xe5\x01s\xad!\xa7\xe7z̵\xa2\xcd `A\x9a\x14,\xf5\x87\xb8\x93\x1e\x9f{\xf4\x10\x0f5\xe7\xb0VϠ5\xfcݫ\xf0\xf5w\v\x96\xda\x1e:\xf1\x18\xf1}\x02\xd7\xc5ul,\xac\xc8\xda?>6,\xdc\xcc\xdd2>\xe0\xe3Mx\xbf\x0f?Yp\xd1C\xa7JЂa\x92\xf3\xc0Â\xaf\xff\xea\r\xe4YN\x9c\x1a\x9e}\xfe=~\xb4\x96\xb2\xdf\v\xcbZ]\nl%\xb1,\vU\x11\xc56:\xaduW\xb0sL1\x16:\x8c\xf8\x1aU9U\xe7\x06\xda\xfd\x9c\xe5nB\x92\x17ܽ\xaf\xc1\xe4\x88M^\x18Z\xfd\x94W\xdej\xb1\xb0\x16ak\xb1y&\x9b\x8d\x19\"?\xc5<W\xb2l\xf7Hˢ9bs\xfd\x16\x97\xa9\xba\x8d\x92\x16yQ\xee*0U\xd5T\x90d\x86\xde0#J\n\x92\xbc \xcd\r\u007f\xf3\xce<G\x1e\xbf\x83\xbdn\aarL\xd5S\x11\x96ae\x90\xf1G\xaf,\xf2\xf4\x9d\xbbXh\x0f\xb9xe\xc0\xc9K\xebe\x83\x95j\xa1\r\x12z\xfd\x94\xb9$\xe2\x13\x0f\xef\xe3\x0f\x1f?\x8cs\xe5(y\xe7\x12J\xc0?\x1c3\xfc\xe5;\x05\xad\xf9\x10W\xd8\xd4<\x8f\x9a\xe7\xe1;\x0e\xd2\x128\xb6\x8e\xac{\xf6\xec\x1a\xb6\xbb}7\x959\xa2\x19QD\x12\xd5u\xb1\xa4A\n\x891\x16\xeeԀW\u007f\xff\xa62/\b\x8bo\xff`\x96o\x9cj1\xadC\x1ca1Z+\x03\xe2hY*\x8b\x94@T\x90\xf0\x06A\xddQ\x82-\xb5r\u05cc\x86\x9a\xd1\xc0\xc6\xd1%%_ʍ\xc6\\N#\xd0\xd4\xfd\x92ف\xb2X\xed$\xbcu\xb1˕N\\\xd1mJiZQ\x94\x865iQ\xb2\xca\r%\xa1BK\x89\xae\x16\x86\x96\x82fM31\xa2\x19\xf5\x05\xa1'Ѣ\xec0\x94\xcfi\xa1\x84\x85V\x16\x17\x96{\\\xb2j<r`\x94l\xf5\xd2G\xbc1\x84\x05\x17\xd6b\xbe\xfc\xca\x15\xf2V\xc6\xfb\xf3\xebL\x8d\xf9%\x89n\x98\x12\xa7\x19\xb9\xb6\x18\xdd\x15r\xe4W\xee\xe1\xae\xddM\xd2\v\xafQt/\x91\x16\x82\xff<Ǹ\xf3\xa6\x00\x00\x05\xaeIDATm\xf8\x93\xd7\rk+!\xbe\xb0\t<\x8fz\x10\xe0\xda\x1a\xad\x14Z)\nc\"ըՐB\xd2\xe9\xf7\x89\x16\x05\xc22Hm\xe19.BH\x06\xa2óO\xee\xc0Q\xe5\xc7=u\xbe˿\\\xecҔ>\x9e,\xc9\xd1\xebôL\x82E\xb9\x027\u0090\x17%\x87\xbbT\x16\x19\x16\xd7\r\x81]0Hs\xd6z\t\x9e\x96\xf8\xae·%\x8e-q\xb5 \xcf˕\xeb\xda\x02]\b\x9a\xa1̓wL\x90\xc79˝\x98~\x94U\x18M\xc1\xea \xadhC\x90\xa4\x05ia\xaa\xe0\x94;\xb3\xdcT9˝\x9c\x95\xaeU\xd9\b\x1a\xb4\x14\xb8\xb6`2\xd4L\x846\x8d@\xb1\xb5\xe1\xf2\xf2\xe9\x16\x8fܼ\x9dk\xfd\xe7\xd8l'\xed\x1eu\xb8\xff@\xc0sߛ\xc3\x15\x16\x8b\xab\x03(\xa0c2\xf6\xed\x1d\xe3\xd1\xfbn\xe0\xd7\xef\xbf\x19\xab{\x91\xf8\xf4?\xa3\xb35Z}ŋ'\fϟ\x82v\xb7F \x1d\xc2\xc0'\xf4||\xd7\xc1V\n)%\xdd~\x9f\xa5\xb56JJI\xbd\x16\xe0\xb9\x0eiVһ=\xdb&\xcb3f\x96\x97y\xec\x13\x1e\x0f\xec\xabo&\xc5Kk\x11+}\xc3\x1eW\x97g;\xa5WI\xb7\x9fҨY\b)(L\x81\xb4\x04\xb6\x04WY\x9b\xb9\xa0\x1a\xfc@?*q\xf9Ԇ8+X\xadD,\x1b\xab\xd6Q\x02ǖ4|M3\xb0\x89\xf3\x9c\xc4\x18\x16{1\x17V\a\\Z\x1b\xa2]\x8f\xb9\xa5>\u05cfj>w\xf7t\xb9\x10,\x8b\x82\xff{\x88@٫\xbbJA5lTYVe\x15\xee\xf3\xee\xec\x19p\xef\xc6\xd2>&\xedm6\xba\x84uu\x9e\xd5c7\x8f\xf1\x9d7V\xe8\xcc\xc6\f\xb2\fg\xca\xe3KO\xdc\xcb\xe1\xfd\xd3L\xf9\x86l\xe6\x87d\x9d\x19$9g\x16\x15_>\x9a\xf3ڌFG!c\x8eO\xcdu\xf1\x1c\x87\xc0uPRR`\x98\xbd\xb2\xc4r\xa7M\xb6\xa11\x14V\x19\x04\xaf\x92\x9f\x19`\xbe\xd5*G9\x98\x8aLP\xe9\xe3\x1e\xba\xa9\xc9\v\xa7[tWRB_m\xea\x1f\xd2</[\"\xc2B\tE\xe8X\x155uC\x13^\xd2.G|M=P\xf4\xa3\x82\xb5^B?\x85\x11WT\xbc/C\x92\xc1\x80\x1c)R\u07b8\xb0ʉV\xc4\xfe]\x934|\x87ݓ\r\xee\xbds7\x9f\n\x15V\xd2\xe5?\xde8\xcbwN\xac\xf0\xd9\xc3ӸZ\\ciw\x8d\xd6\xf0\xffqw\x1eD\x19\xebÌ\xf5aJw\x98\xb3]\xdb\\\x98k\xb1'l\x92u\xfa\x9b\xff?\xbb\x12\xb1\xa5\xa6\t\x1dɈ#y\xe8\xceq\xbeٙ\xe3\x93\x1f\xbb\x91\xdf\xfd\xb9C\xd4UA\xb6\xfc\x16\xd1\ag6\x93\xfb;\xf3\x16\xbf\xfdR\xc1\xe2\xb2OS4\xf0|\a\xdfsp\xb4&\xa8\xac\xf2\x06I\xcc\xc5\xf9E\x06Q\xb4\xb9\xa3\x95\x96\x92,\xcf?b\xff\x16z\x1ei\x9a\xf2\xea\xf1!G\x0f\xads\xf7t٪\xf0|\xc5\xd7>\xb3\x9fO~\xf5m\xc6\xda6\x13u\xf7CV\x809\x96\x950\xea+\\\xbb<ӥ\xb4\xaeJ\xc0*R\xb1\xb4\x04\xdb\xc74\xd7Mzt\a)k\xbd\xb4\x92)\x9b2\xf0\x06\xdeY\xeaq\xd3m{\xf9\xcd۷\x97\xaedY\fq\x9f\"\x99\xa5X-\xd9\a\xe3\x81E\xab\x97\x10\xa7\x05\xbe\x92\x1f\x12{~(\x10\x15l\x9a\xe6\x05qV\x10'9\xebQF\xa7\x97\xd2\x1d\xa4\xac\xc7\x19\xab\xbd\x8c\xb5~Nk)\xe1\xe5S3<\xf5\xe0M\xd0Ӑ\xa7\x1c\x9b\xe9\xf1\xc4s\x178\xf2\xa9I\x9e\xbeg\x8a4\xcby\xeap\x93\xa7\x1e9\bF\x92ͿFԹT\xfa\x97HE7\x82\x17O\x1a\xfe\xec5\x81\xeaי\xf0kh-\b\x832yW\xb9\x82\xd5n\x97٥e\xf2\xea\xdb+)\xa9y\x1e\xcaw]\x924%J\x92r\xccg\xf5V\xf50@\b\x8b\xc5\xd5\x0eG\xbe5\xc7\vO]τ\xaf\xc9\vC\xa0\x04\u007f\xf5k\xfb\xf9\xfc7\xfe\x87x%g\xc7xpMPlU\xd2\xf9\x03G\\\x95SoX\x8eG\x19\xc6\x14\x14\x85b,\xb4\x99\x1e\xf3\xd89\xee\x91d\x1b\t\xbb\f\xca\x1bK\x1d\x1e\xd8\xe3\x93/\xbc\x87\xd90K\xd9|\xber7\x8c\xd7m,\n\x06Q\x86#,\xfa\xd5\xc4\x17!ʪ\xa7\xbcoX\xc4i\xcer7\xa6\xdd+A\xb1(-\x88\xd3r\x9ah/\xceYh\xc7t\xfb)yl8\xfe\xfe<<z\x17\x96\xf21y\xa7\x9c6ڳ\xf9\xee\xb16O\u007f|\x1bV\x94\x93e\x06\x96/Q\xc4\xeb\x14\xbd\xcbH\xa9\xd0\n\x8e\xce\x16<\xf7\xba\xe0\xe8Y\x87\x11\x13\x10\xd6\\\xb4\x92\x84~\xc0\x88\xef#\x84 \xcd2\xe6WZ\xact:dy\x8e\x92\x92\xa9\xe6(\xa3aH\xe0\xba( \xb6\xb5FJ\xc9 \x8a6\xf5n\x02\x8b\x9a\xe7\xb1\x15\x8b\xf9\xf3\x1d\x9e\xfc\xfaY\xf5\xaf_\xb81\xb7\x85e\xb2°\u007f\xdc\xe3\xcf?\xbb\xcf\xfaҋ\x17䅅n\xb6s\xb2Vʶ*\x8d\x84\xef\\\xad\xb26\ag\t\xab*\x0e\f\xbd(#\x8asV:1\xa3\xa1\xa6\x1eh\x02W\xa2\x94\xc0Q\x82\x1d\xa3\xbe8\xbf\xb8\xc2\xfe\x11\x8a\xfc#\xc6w\xe5y41\xea\xb0{\xc2\x11\xadA\x82\xb6Lqa\xb1GV\xe16I^\x96ե\xd1A\x99熉!\xceMy\xb1,\f\x83$g\x10笭'\xa4Yy\xab\xef\xb7\"1\xb7\xd82;\xfc\xa6\xc9\xe2\x0eӣ\xda:\xb0K[g\xcff\xe6\x85\xe3W䓷MdYV\x9a\xf1Y\x95s\xc5ɖ\x91_\xf9/#O\x9cq\x93l]1f\xfb\x04\x9e\x8bk\xdb4\xc2\x1a\xb6Rdy\xceZ\xaf\xc7\xe5\xe5\x15Ҵ\x84\x0f\xb6\x8c6\xd8>1\x81\x94\xa5{PaL\xfc\xbf\r-D$&\xdb/\x90\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_05_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1d\x1e8\xaa\xa8\xc8\x00\x00 \x00IDATx\xda\xed\xbdy\x90$\xf9u\xdf\xf7\xf9\xe5QY\xf7\xd9\xd5\xf71\xdd\xd3\xd3s\xed={`O`\x01\x01 \x00\x92\"\bR\xa4l1hZ\x96d\x87H\x1fAӲE\xff\xa1\xa0m\x9a\n\x85\"\xac0i\xd9\x16\x1d\xb4\xac\xa0(\x91\x10\t\xc0\x04A\xc0\xc4\x02\x8bc\x81\xbdfvfw\xee\xbe\xef\uee8f\xac\xcc\xca\xd3\u007fdVMuO\xcf\xec`w\xb1\x04\x15\x9b\x1b\x153\xdb]S\x95\xf9{\xef\xf7\x8e\xef\xfb\xbe\xf7\x83\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x0f\xae\x1f\xd6\x15\x9f[\xb8\xed\xff\x0f\xff콸&\x1e}\xf2\x1d\xff\xdb\xc4\xfc\xa9\xf7}]\xc4\xfb-\x84\xce\xf2\x8d\x03?\x93Ƨ\xd3\xe0;\xde\xf6F\xe7n\xef{'\xdf\xe5\xfb>\xc6\xcaM\x00\xc6\x1ey\"\x15\x8b'\xb2\x92$i\x92$\xd2 b@2\\\x03\xc7\xf7}\x1d߷\xf1}\xd3\xf1<}\xe5\xbb\xdfX\x03\xbc٧>\xc2\xcaK\xdf\xfc\xf7K =\xcd\xef-\xb2:y\xec1\xd7\xf3>\xa9\xc8\xf2\x03\xd1xl\xdas\u070ei\x18\u007f(\xab\xca\x17\xbbk\xcb;\xef\xa5\xf0\xc5\xd8\xe4\xe3Sӳ\xffy<\x91\x98\a\x92\xbe\xefkB\x90\xf0}\x11\x15\x82\xb8\xeb\xba\xc2u\x1cW\x92eCH\xc2\xf6=\xdf\xf2<א$i\xd1\xeaZ\xbf\xb6\xfe\xf2\xb7\xaf\xcc?\xfb1\x16\xbf\xfd\xf5\u007f/w\xc8\xdf\xf0\\\xf77\xd3\xd9\xec\xcc\xc8ȸ\x94\xced%5\xa2\xe2y\x1e{\xbb;\xee\xf6\xe6\x86k\x9a\x9d\xbfP\x14\xf5\xbf3Vn^\xba\x93@\xefU\x18\xda\xcc\xdcǒ\xc9\xf4\x1f\x16\x8aż$ɷ\xbd\xcfq\x1c\xa6gf9q\xea4\xa9T\x1a\xc7q\xf0<\x97J\xa9\xc4[\x97\xde\xc00:\x86k\xdb\u007fm\xe9\xbb\xdfx\xe9ؓ\x1ff\xf5{/\xfe\xd5\x17Hv\xe1~\xea͊\x14\x8b\xc5\u007f9\x9d\xc9\xfd\xb3\xd9\xe3'\x88\xc7\x13ȲL*\x93a\xb88J,\x11GQ\x14|\xdfge\xe9&o^\xbc@\xadR^i\xb7\x9a\xbfo;\xf6\x9fʲ\xbc\xd2][\xde\u007f\xbb\xef\x8a͞蛨\xd8\xec\x89O)\x8a\xf2\a\xe3\x93\xd3\x19!n=\xa6\xef\xfb\xfd\xbf?\xf4\xc8c̝X`\u007fw\x87z\xad\x86mY\xf8\x80\xa6E\xc9\xe6rT\xca%6\xd7W\xf7\x1d\xc7\xf9̅/\xff\xf1k\xff\xe0w~\x8f\u007f\xfc\xf7\u007f鯮@\x12\xc7O\xa2/]'q\xfc\xd4\xdfMg\xb2\xffǱ\xb9y\x12\x89$\xe3\x13\x93\x14\x8aE\xe2\xf1\x04\x9e\xe7\xe2\xba.\x9e\xef#\x80H$\x82,+\xec\xeclq\xfd\xf2\x9b\xac,/\xd1l\xd4\xdfl\xb5\x9a_\xf1\x1c\xe7\xcbx\uead4\xf7\x8c\xbb\xf9\x99\xd8쉿!\x84\xf8= >61\x85\x1a\x89@(\x88x\"I,\x16chx\x84S\xa7ϲ\xbe\xbaB\xbb\xddF\x96\x0f\xee \xcf\xf3PU\x95X<\x8e\xef\xfbW\xc6'&\u007f\xe9\x9f\xfc\xca\xdf~\xe5\x87-\x14\xf1\xc3\xf4\x1b\x9d\xe5\x1b\xc4\xe7\x16>\x12Ѵ\xbf8y\xea>ylb\x82cs\xf3\xc4b1:\xbaN\xa3Q\xc7\xe8tp=\xaf\xaf\xb9\xb2$\x93\xcb\xe7\xc8\xe5\v\xf8\x9e\xcf\xde\xfe.;\x9b\x9b\xe8z\x8b\xbdݝ\xda\xde\xceV\xd5\xeav\xff'sm\xe9\xf7\x06w\x04\xc0\xec3\xcfgۍƯ\xeaz\xfb\xbfr]7!\x00U\x8b2::N`\x1a]N\x9c<\x8b,I\xe4\n\x05\nCEn\\\xbd\x82$\xcbw_$!\x18\x19\x1dk\r\x15\x8bO\xfe\x8f\u007f\xf7o]\xfe\xd5\u007f\xf6/\xf8\xa7\xff\xc5\xdf\xf9\xab\xb7C\xa2\xc7\xe6\xa7eY\xbep\xf2\xd4}\xf9\xd9\x13'\x98?q\x92n\xb7\xcb\xd6\xe6:\xa6a\"Iґ\xff\xces]\xc6''\xc9ds8\x8e\xc3\xe6\xc6\x1aV\xd7B\x92e,\xd3\xe4嗾\x85\xae\xb7\x1f\xf6|\xefM\xdfu\x8f\x15\x87\xc7>;4<\xf2s\x92$=*I2\xbe\xef\xe18\x0e\xadf\x83Z\xbdJ\xd7\xe8\x10O\xa48s\xff\x83\xe4\xf3C\x10\x9a\xa5\xe9c\xc7X_]\xa1\xdb\xed\xbe\xed\xb3\xf8\x9e\xc7\xc2\xe93K7\xae^9\xf9\xc5\xffퟺ?\xac5\x93\u007fh\x1f<>\xad\xaa\x8a\xf2ϧg\xe6\x1e\x1b\x1d\x9f\xe0\xec}\x0f\xd0l5Y[Y\xc6u\xdd;\n\x03@H\x12\xadV\x8bt&\x8b\xa6iX\xb6\x8di\x18\xf8\xbe\x8f\xaaF\xb0\xec.\x95r\xe9\t\x81\xf8\xa5T:\xf3\x8f\xc7&\xa6>!Ib<\xa2i\xc4b1b\x89\x04\xa9T\x9a\xa1\xe20\x93S3\x14\x86\x86q=\x87\xb5\xe5%\x9a\xcd\x06\xb2,\xe1:.B\b\x86GFp]\xb7\xef\xe4\a\xfd\x8d\xa6ix\x9e\xd7\xf7=\x9a\xa6\xe5\\י~\xeb\xc6\xf5\xbf\xa0\xa3\xdb3O>Gcs\xedG[ \xf1\xb9\x13h\xf9a|\xc1\xc7\vC\xc5\xff!\x95\xceȏ\u007f\xe8)\\\xd7cks\xa3o\x02z\u007f\x0e\xbe\x0e_V\xb7K\xbeP@Qd\x9a\x8d\x06\xbe\xef#\x84\xc0\xb2mv\xb77ǀ\x89d:C\"\x91D\x91e\xc6ƧH\xa4RD\xa314-J$\xa2\xa1j\x1a\xe9L\x86\xb1\xf1)F\xc7'\xb1\xba&;[\x9bl\xae\xaf\xb2\xb5\xb1NG\xd7Ig\xb2\xa4\xd2i\xb2\xd9\x1c\x1d]\xc7\xf3<\x84\x10\xb8\xae{\xe0^\x11BH\x92xش\xac\a\x9cX\xecO\xf6.\xbc\xeaL\u007f\xe8Y\x1a\x9b\xeb\xef\xd9\xfa)\xef\xb9#O\xa4(\xbdy\x9e̩\xfb~;\x93ͩ##c\xc4\x13)ʥ\xfd\xbeV\xf6\x1ep0\xe2\xb9\xcdly.\xc9T\x1a\xcf\xf3Т1$I\xc2\xf3<<\xcf\xc7s\x9c[\x0f +\b!\x90d\x19\xd7spL;\xb0\xc4\"03\xa6i\xa0\xb7\xdb\x18F\x87v\xbbE\xbb\xd9\xc04\r\x1c\xc7A\xd7[T\xca\xfbܼq\x85\xb3\xf7?\xc2\xe8\xf8$3s\xb3\xac\xaf\xae\xe2\x84\xdfѻG\xd7uI\xa5\x92x\x9eGD\xd3~|tt\xe2\xf5\xc6շή\u007f\xffی\x9f\xfb\x10ۯ\u007f\xffGS \xa57ϓ=u߯Ǔ\xa9\xf9h4F:\x9b\x05\xfc\xdbv\xc0\xed\xc2\xf0\x11BB\x96e\xe2\xf1\x04C\xc5a\xa2\xb1(\xbe\xef\xe3\xb9.\xbe\xef\xe3\xba.F\xa7C\xb9\xb4\xdf\x17\xaa\xa2ȸ\xae\x8bm[,\u07b8F\xb7k\xd2\xd1u\xba\xa6\x81i\x1a\xb8\xae\x87\b͠,\xcbH\x92\x8c$\tTUE\x96\x15\xc0\xc74L\xbe\xf7\xed\x17\xc8\xe4\n<|\xee1\xe6\x17N\xb1\xbb\xb3\x83eu\xf1}\x1f\xdf\xf7\x19\x1b\x9f \x9b\xcbS\xadT\x18\x9f\x98bs}\xfd\xcc\xc9\xe7?\xf9\xef\xae\u007f㫟{\xaf\x84\xf1\x9e:\xf5\xe4\xfc)ڋ\xd7\xc8\xdf\xf7\xd0sv\xb7\xfb\xe2\xf1\x13\xa7\x90$\x99\x91\xb1q\x1e|\xf8\x11Z\xcd&\x1b\xebk\x03\x02\xf1\x10\b\x14U%\x12\x89\x10\x8b\xc7I\xa53$SI\\\xc7\r\xb4\xb9ݢY\xafQ.\x95\xa9TJ\xb4\x9b\r\x1a\x8d:]Ӡ\x97\xe8ɲ\x1c\b\xcd\xf3\xf0<\x17\xcf\xf5\x90$\t-\x1aEӢ\xc4\xe2\xf1\xc0tE\"h\x9aF\"\x99F\x8d\xa8D\xb5(\xaa\xa6\xf5n\x86v\xab\xc5\xf6\xd6\x06k+K\xdc\xff\xd09\x9e~\xeey\x8c\x8eN\xa7\xd3AQ\x14\x86GF\xb1,\x8b\xcd\xf55lۡ\xdb5X\xbc~uM7\xf4g\xb7^yi\xe3G\xced\xb5\x17\xaf\xb1}c\x9dُ?\xff\x1b\xb3s'¸^\xe0:6\xadv\x8bD2\xc9\xc4\xd4\x14\xedv\x1b\xdf\xf3\x88'\x12\xc4cqb\xf18\xae\xe7Q\xda\xdb\xe5ʥ7\xd8\xdeޠ\xd9h`Y],\xcb\n\xc2b\xd7AU#d\xb29\xf2\xb9\x02\x86ѡި\xe396\xbe\xa7 \xc2\x00A\x92d&\xa7\x8e17\u007f\x02\x84@\x12\x12\x8a\xa2 \xc9r\xdfO\xddڙ\x02\b\xb4_\x96\x15\x86\x8aÌON\xf1\xe0\xc3\xe7\xf8ڟ}\t\xd30x\xfa\xc3\xcfS\x1c\x1eAQ\x14\x1a\x8d:\xe5\xfd},\xcbB\bA4\x1aö-\u007fgsC}/-\x8c\xf2\xde8\xf2 \xe78\xf6\xf1\xe7\u007fahh\xe8C\x89d2\xdc\xea.\x8e㰽\xb1\xc1\xa9\xd3gH\xa5ҤR\x19$\tl\xdbae\xe9&W/_b}u\x05\xc7qn%g\xbeO4\x9e`dd\x94bq\x94L.ODUAH\xc0-SW\xda\xdfguu\x91j\xa5\x04\x9e\x0fB\x04!\xb2e1\u007f\xf2\x14\xa9T\xba/\x80\x9e\xe9\x11B\xe0y\x1ez\xbb\x8d\x10\x82T*M,\x16#\x99J\x91\xc9\xe6\x90$\x89\xcf\xfc\xd4\xcf\xf0\xc5\xcf\xff[\xf4v\x8b\a\xcf=\x8e\xe7\xba\ar\x92^\xe2\x18\x8f's\x9e\xe3\xce\x03\xcb?2\x02\xe9\t#6{✦E\xff\xc9PqD\xeb-\x82\x10\x12\x8dz\r\x1fX^Z\"\x9b\xcfa\x1a\x1d\u07ba\xf4\x06Wߺ\x84a\x18A6\x1c\x8b\x93J\xa5\x89'\x92\f\r\x15)\x0e\x8f\x91/\x0e\xa1i1\xe2\x89\x04\xe9|\x81l\xbe@4\x168w\xa3\xa3\xb3\xbe\xbcH2\x9dabr\x12\xa3c\xb0\xbd\xbd\xc1\xf6\xd6\x06\xadV\x93\xbd\xddmv\xb77\x99\x9c9Ʊ\xd9\xf9 \xf1\xf3}\x1c\xd7\xc14\f,\xd3\xc4\xf3}\"\x91\b]\xd3D\xa9)\xa8\xaa\x8a\x1aш'\xe2$S)\x1e>\xf78\xdf\u007f\xe9[8\x8e\xcd\xfc\xc2\x19\x14EAV\x14TUA\b\t\xc7q\x90d)#)\xf2\x82\a_\xfb\x91\xf2!\U00079154\xef\xfbo\xa5ә\xe9\xf1\xc9\xe9#C\xd8\x00@ܦQ\xaf\xd1효j\x84\x91\x911\n\xc5\"\xc9d\x8aT*\xc3\xc8\xf8\x04\xb9\xe20\xb9\xc2\x10\x89T\x1a\x81\x8f\xeb88\x96\x85m[ض\x8d\x1f\x86\xa4\xbd\xa8\xcb0\fn\\~\xb3\xff\xff\xb5Z\x95\xf5\xd5%67֑$\x89t:\xcb\xd4\xcc\f\x9e\xeb#\x04\x10\x9a.\xcf\xf3\xc8\xe6\xf2\xe4\xf3\x85^LA\xc7\xd0Qd\x05۱\xe9\x1a&\xcdV\x83f\xbdF\xbb\xd5\xc2ql$IF\x8d\xa8(j\x04Ǳ\xa9V\xca\b!\xfeP\b\xf1\x8b\xbe\xef\x9b\xef\xb6d\xf0\xaew\xc8\x00\x9e\xf4\u007f\x02\xd3B\x92\x8e\x94\xb0\xefy\xac./\xd2횸\xae\xc3\xdc\xf1\x13\x1c?q\nY\x96Q#\x11fO\x9cbz\xee8\xb1D\x12|\x9fZ\xb9Dy{\x03\u05f6\xf1B\x13vd\xf6\xec\xfbdry\xce=\xf5\f\x97^{\x05\xb7\xdb%\x9f/\x90\xcd\xe6H\xa53ܸz\x99V\xb3\x8e\xde.\x10\xd14T5r\xab\x0e#I$\x12\t\xbc\x10\xb6\x11\x92\xc4G?\xf3י:>\x8fe\x98t\x8d\x0eB\x96\x10B\xc2\xect\xd8\xdd\xda\xe0\xea\xa57X_^\xa2\\ޣ\xddl\xe0\xba\x0e\xb2\xac|\x04\x18\xeb,\xdfXy/\xea8\xe2=\xd8\x1dE`\xd9\xf7\xfdd&\x9bc||\x12\x06v\x88$I\xac\xad.\xd3j\xd6I\xa52<\xfc\xe8\x13d\xb39\x10\x82مS\x1c?}\x06YV\xf0=\x8fz\xb5\xcc\xee\xfaZ?L\xbd\xd7k\xee\xcc\xfd\xa8\x91\b\u007f\xfc/\u007f\x175\xa2\xf5\x93\xcaW_\xfe.\xadV\x93ѱq\x92\xc9\f\x91\xa8\xd6\x17n4\x1ac\xa88\x8c$I(\xaa\xca\xc7~\xf2\xa7I\xa73\\\xbfx\x1e\xbd\xd5\b\\\x95\x00YQ\xd1b1ҹ<\xe9l\x1e\xd7u\xd8^_cu\xf1&\xbb[\x9bܸv\x99ry\x1f\xe0W:\xcb7~\xfb\xdd\x16\xd8\xde\v\xa7~\xaa\xf79\xbe\xdf\u007f\x8e\xbe0\x1a\x8d:\xadF\x9d\xe1\xd1q\x1ez\xf8Q\x8a\xa3\xe3\x8cMM39;\x87\x1a\x89\xe0:\x0e\xbe\xe7cY\x16{\xa1\x99\xf9A.\xd7uq\x1d\x87L.O~h\x98f\xa3\xde˪\x91\x15%\x84D\\$\xe9\xa0\xeeE\"Z\xff\xbb&ff\x19\x1a\x1e\xe5\xfcw\xbe\x81c\xdb\xc8\xca\xc1\xc0\xc9\xect0t=T\x16A<\x95\xe4\xcc\xfd\x0f\x12\x8fňF\xa3|\xef\xbb/b\x18\x9d\xff5>\xb70\v\xfc\xf7\x9d\xe5\x1b\xc6_f\x94u\x110\x81\xa8\xef\xb9\xfdH\xa6gRJ{;(\x11\x8dS\xa7\xcfr\xe6\xa1G\x989q\x92h,\x86\x13\xfa\x03\xcfu\x89'Sl.\xdf<\xd2\xf7\xdc\x03\xea\x87$\x04\xb2\"\x93\xcefi\xd6ka\xc8+PB\x81\xb8\xae\x13$\x96\x8e\x8d,+ȲL,\x16\xeb\x1b\x89\xf93\xf7ѬU\xf0\x06\xa0\x92\xc3h\xaf\x10\x02B\x01\x9a\x86\x81it\xc8\x15\n(\xaaJ\xadV\xe1\xb5W^B\x96\x95_\x01\xbe\x04\xbc\xe3J\x96\xf4n\xa5\xd1Y\xbe\xd1\x04^\xe8a?\xbe\xef\xf5\x1f\xa2\xd5l`\xdb6C\x85!\x8a#\xa3\x8cNMӮ\u05f8\xf9\xe6\x1b\x81V\xe7\v\xe4\x86G\xd8Y_\xa5\xddl\xbc\xe3{\xf0\xc3}\x19O$\xfa\xa6\xce\xf3}l\xdb\x0e\xb4NU\xb1\x1d\x1b\xd34\xf1\xc3$P\x8bF\x83\x05\x10065\x85\xdel\xfe`\v'\a\x05\xb5L6˱\xb9\x13\xbdDU\x05r\xeff=%ޛ\xeb<\x80eu\xf1\\\uf5b0t\x1d\xd7u\xc9\x17\x86\xf0\xbc\xc0v\x97\xb67\xb1-\x8b\x9d\xf5\xd5\x00\x12q]Jۛ?\xb0\xa9\x1at\x83\x9e\x17hv<\x99:\xa0ղ,!\x84@o\xb7q,\x8b\xed\xcd\r\xae]\xb9DGo\xf5w\x8f\xac(\xa8J\x04;\x84I\xee\xf5\xea\xe5&\xbe\xef32>F\"\x91\xc4\xf7\xfd*\xb0\xf9\xa3 \x90W\x01lۦc聙p\x1c\f\xb3\xd3_\x9c\x9eِ\x155X\xa4f\x03\xa3\xa3\a\xfa\xedy\xef<*\x11\x02S\xef \xc9r\xa8\xf5\xb7\x12A7T\x0e\xa3\xa3\xb3\xbb\xbb=\xb0\x93R}O\xe78\x0eF\x18Q\xbd\x93\xcb\xf3<\xb2\xf9!,\xab\v\xb0\x02\xac\xff(\bd\xab\xb78\xe5\xfd}$)H\x9cz\x85\x1f\xc7q\xb0\xbb]\xccN\x87T.\x1fh\xa2\x10쬭\x06\x0eT\xbc\xf3`OH\x12\x86\xdeF\x12\x12B\x92\xfb\x89\xbc\xe38\xb8\xb6\x1dDla\xde\xd1\x03)3\x99lߴ\xe2\xfb\xd4+eF&\xa6\x82l\xdes\u007f`\x85\xe8\xb4ۘ\x86\x81\x10\xe2\x8d\xce\xf2\x8d\xfd\x1f\x05\x81l\xf4\xb5\xd540\f\x03\xb3k\xe2\xd86B\bj\xb5*B\x92(\xed\xee\x90\xcad\xf1\xbc\xa0@U\xd9\xdf\xc1u]\"\x11펹\xc6\xdb\xfa\x0f\xdf'\x9aH\xe0\xba\x0e\xad\xb0f\x02`\x18:^(\xf8A\xc8\x038\xb8\x1b\x84`ok\x93D*\u0379g\x9egb\xf6\x04\x8a\x1a\xb9g%\x89D4V\x97\x17\xf1\xc1\x92$\xe9뽰\xf7/[ \x9d\xc1\xbccow\x8bV\xb3\xde_\x84V\xab\x81\xe38\x94v\xb7\x89\xc6\x13\xc8=J\x8e\x0f[+K\xc4\x12\t\xfc\xb7Y\xf4\xde+@uo\xbd\xf0}\x8a\xa3c\x98\xbaNi{30JB\x04\xfe+\xd4\xf6\xc1\x1aLp\u007f;}\xf9\xfb\xbe\xcf\xe2\xd5ˬ//\xe2z.sg\xcer\xee\xb9癙?\x89\x16\x8d\xf6\x13ǣ\x14\xc6\xf7}\xf2\xc3\xc3\\\xbd\xfc&\xaa\xaa\x1a\x9a\xa6}%\ft\xfer\xc1\xc5\xce\xf2\r'>\xb7\xe0\x01Ro1z\x8b\x10\x8d\xc5\xe8\x9a&\xbb;[\xe0\xfbt\xbb&\xf1T\x1a\xbd\xd5@\x92eJ\xdb[\xe4GF\xf0+\x1eH\xd2-\x100\x14\xae\xac(\xa8\x11\rYUQ#*\x92\xa4\xa0F\"(\xaa\x8a\x14\xd6N\x1c\xcbf\xe9\xca[T+\xe5\x10B\xf1\xe9Z\xdd \xc7\xf1\x03\x88\u07b6-$)p\xf2\xd5r\t\xbd\xdd$\x91L\xe1y\x1e\xcdz\x95\x17\xbf\xf2\xa7\xa43YF&&9~\xea,\x93s\xf3\f\x8d\x8eS\xde٢Z\xde\xc7\xect\xb0\xbbf  \x02vL\xae8\x82av\xd9\xde\\C\b\xf1B\xe5\xf2\xc5\xfa\x8fR\x81j\r\x98\x1d\xd4\x1e\xcf\xf3(\x16\x87Y_[eg{\x93\xe2\xf0\b\xd7.\x9e\xe7\xecÏ\xb2|\xf5M\x00b\x89\x04\xaa\xaa\x92\xca\xe5P\x94\x00'R#\xc1\xcbu]\xbaF\a\xab\xdb\r\xc0A\xdb\xc6sM\xba\x9d \xf1s]'\xa0\x109.\x9b\xebA\x95/\xf0_\x16\x95\xd2>\x96\xd5ehx\x94'>\xf4\fz\xbb\xc5\x1b\xe7_E\xd7\xdbضō\x1b\xd78u\xfal/:\xc2s]j\x952\xb5J\x99\xeb\x97.\xa2F\"\f\x8d\x8cpl\xe1\x14\xc3\x13SA\x99\xc0u\x83\x88\x8e \xe94t\x9d?\xf9\x83\xff\x87\x8e\xae#+\xca\xef\x00\f?x\x8e\xfd\x8b\xaf\xff𡓷\x83\x03\xe2s\v/\x02\xcf\x1d\x90\xb6\xa2r\xe2\xe4i\x16o^\xc3u\x1c\x1e{\xe2iR\xa94\x8f=\xfb\x11\x8a\xa3c\bI\nʬ\x1d\x9dv\xabI\xbbQ\x0f\xb2b\xa3\x83aYX\x8eC\xa7kQk7\xe9\xb4Z\x94\xb7\xb7p\x8d\x0e\xaa$\xf1\xc0#\x8f\x92L\xa6\x90\x15\x99V\xa3\xd9_\xacv\xbb\xc5\xe2\x8dk\x94\xf6\xf7\xc8\x17\x86x\xe8\xe1\xc7(\x95\xf6Ѵ\xc0O]\xbax\xfeV\xa2\xe8:$SAM>\x97\xcf322\x86,IH\xb2\x8c\x12\x06\x1b\xbd\xf06\x96H265\x85\x1aшh\x1aV\xb7\xcb\xfa\xd2M^\xfb\xfew\xd9\xdb\xdb1:\xcb7\xe2\xef\x1b\xda{@\x18\x99\xfc\xf3\xc8ʓ\"\xa2\xb6c\xf1\x84۩Vj(\x8a\xab%\x12\xffP\x92\xe4\a\x06\x9dgah\x98\xe2\xf0\b\xcdF\x9d\xb5\xd5e\xf2\x85!\x1e{\xe2i\x12\xc9\x14\xd3s\xf3\x18\x86\xceҵ\xab\xb4[\rZ\xad&-Ӥ\xa5봆'覲x\xe9\x1c<\xfe\x1c$\xd2\xd05\x90\xaa\x15\xe4\xab瑾\xff\r\x9c\xca>\xc2\xf7QT\x95T:C.\x9f\xa7\xd5l\xd0\xd1;$\x12\t\x10\x82\xd3g\xee\xa7\xddnaY\x16\xbe\xef3>1\xc9\xda\xca\x12\x95r\t\xd34\xb1m\xeb\x96orl\xa4\x91\tb\x99\x1c\x9a\xe7\x12O$\x89\xb7\x1bh\x8aBjh\x98X\"\x81,\x04\xd2@\x00\xd2l5y\xed\xe5\x97\xf0<\xef\xb7\xf5\xa5k\xbf2t\xffÔ\u07fc\xf0\xc3\x15\xc8\x00\xe1m\xd2u\xdd\xff\xfb\xa1G\x9fx\xec\xf8\xc9\xd3i\xcfu\xfd\x88\xa6\xf9\xb2\"[W/^dog3\xd2n\xb7\xa4v\xab\xd5'\x1dLM\xcd\x10\x8b'\x00\xd8\xdcX\xa3Q\xaf\x91\xc9\xe4Hg2t::\x86\xa1cu\xad\xc0\xd6'\xd30<\n\x91(\u07b9g@H\xf8\xe3Ӹ\x8f=\x8b_,\x06\xbc\x85R\x19\xe9\xfcK\xc8\x17_F\xbar\x01i\xf5f\x1f\xce\xf0<\x8f\xb1\xf1\tҙ\\\x1fɍD4\xea\xf5\x1a\xb2,\x13\x8d\xc6\xc8\xe5\xf3lom\xe2\xbaA\xe1l}u\xa9Of\xc0up~\xecg\xf1\xd3Y\xdcS\x0f❸\x0fio\x13\xe5\xf2\x05\xb4\x17\xbe\x84&\t\xd4\xe2\b\xb1\xca>\xd9X\x8cd2ť\x8b\xe71\x83r\xf2\x83\xfaҵK\xef\v\rȮU\x88͞8%Iҍ\x8f\xfe؏\x1f\u007f\xf0\xdc\xe3\xda\xfe\xf6\x16v\xb7+\xccNGȒ\xac<\xfd\xb1\x8f+\xe9tF\xe8\xad&\xcdF=\xa4aF(\f\x15\x91$\tUU)\x0e\x8f\xd0j6\xd0\xf56\xadV\x13۱\x89\xc7\x13h\xd1\x18B\x84\xa4\x87D\x1a\xd1n\"\x1c\x1b?_@tt\xa4\xa5\xabH\xd7\xdfB\xbar\x11\xe9\xea\x1bH\xcd:\xc2\xe8 \xedn\"\x9a\x01n\xe5y\x1e\xc7\xe7\x17Hgr}\xdc\xc9\xf3|2ـ\x01\x99H\xa6@\b*\xe5\x12\xae\x17\x90\x1e\x02\x92\x83L\xbb\xd9\f\xab\xb9>tt\xbcS\x0f!:m\x88\xc6q\xef;\x87{\xdf9\xecG\x9e\xa2\x9b-\xd0\xc9\x14ho\xaeQ\xbe\xfa&\x9b\x9bk=\xba\xd0u\xf0\xff\xb9]\xab\xb4\u07b7\x8a\xa1\xeb\xb9\xe9D\"\xe9-\x9c9+\xefll\x1c\x80Ǜ\xb5\x1a\xedV\x93\xa9\xb9y*\xfb{\xeclo\xd1n5\x89Ƣ(\x8a\x8a\x1fbJj$\xc2ɳ\xf7Q\xde\xdf\xc7\xf7=\x86\x8aèj\xa4\x9f7x\xaeK\xad\\\xa2\xd6\xd5\xe9^\xbb\x80Y\xd9\xc5\x1a\x9e\x80T\x06I\xd5 \x12Ax\x1e4jHo\xbd\n\x95\x12~胂\xc8'\x10\x8c\x14Fj\x8ec\xd3l6\x02\xca\xe8\xf00\xd9\\\x01۶\xa8U+\x94\xf7v\xa9U+dsy\xb4h\x94\xdd\xed\xcd \xb1\xab\x95P\xbe\xf7u\x9c\x87?\x84t\xf9<bc\x19\x86F\xa1^A*\xefAe\x0f\xa9\xb2\x17\xf8\xbe0p\x11B\x9c\x04^\x1a{\xf8\xb1\x85\x9d\v\xafZ\xef\x8b@dE]\xedv\r\xaf\xbc\xb7O&\x97\xc7\b\xeb\xd1\x01\x14\xa2\xf4a\xeeh,Ή\x93g8\xff\xea\xf7H$R\xfd\xf7\xf4\xfc\x8a$$F\xc7\xc6o\xbd\xf7\xf4Y$I\xa6Y\xafQ\xad\x96\x82\xbaC\xbe\x80mYx\xb6\x85\xbe\xb7Fc\xadKC\xd7\xf1$\t\xe18\xf8z\x1b!|\xd4h\x14U\x8d\xa0E\xa3D4\rE\x91\x03\xc7-\xc0\xb1\x1d\n\xc5a\x9e|\xf6ydY¶\xba8\x8eC<\x1e\xa704ĉ\x93\xa7\xd9\xdcX\xe7\xe2k\xaf\x10\x8dƘ\x9c\x9eEo\xb7\xa87j\xb47\x97Q\f\x1d\u007fz\x1e<\a\xd1l@\xd7@\xb4\x1aP-\x81\xdd\xc5\xf5A\x92eR\xc9\x14\x9d\x8e\x8e\xe7y3\xa6i\x9e\x00.\xbf/\x02\xf9\xc3/\xbeX\xfe\xb9\x9f|\x8e\xf5\xe5\x9b<\xfd\xb1O\xd05\f*\xa5}TUe\xee\xe4)4-J\xbdZ\xa1k\x18\x8c\x8c\x8e!I\x12\xadf\x93l.?\x98E\xf5͋\xe7\xbaL\x9f=α\xe3'\xb0L\x93t&\xcd\xf1\x85S\xc4\x12\tl\xc7ace\x89\xcb\x17\xcf\x13w\xd2\x14\t\"\x9d\xfd\xdd\x1d\fӠ83\xc3\xc4\xf4\f\xc3#c$R\xe9 \xf1l\xd4\xd9\xde\xdc \x122S\xd6V\x97x\xf0\xdcc\xf8\x9eK\xab}\x10ŵ\xad.B\bf\x8fϳ\xb5\xbe\xca\xfe\xee\x0e\x9a\xa6\x91NgH\xa6ҴZ\r\xf6\xf76pw7\xc0\xf7\x02\xf2D\xe8\xf8%I\"\x9eʐ\xc9\xe6H&SH\x92D\xb3Qgg{\x13YV\xaa\xef\x9b\xc9\xfa\x89\xfbF\xbd\xc4\xf1\x93k\xf5Zu\xe1\xad\xd7_\xe5\xc1ǟd\xfa\xf8\td%(q\xd6\xca%\x96B\x16\xb9\xa2(d2Yt]\xef3I|\xdf\xc7\xf3\xfd[\x0eK\x10\x90\v\"\x11,\xd3\xc4h\xb7h\xd7\xeb\xa8Z\x94x<\xc1\xcc\xdc<\vg\xee\xe3\xc2+\xdfckm\x15۶\x98\x9c9\xc6\x03\x8f<\xc6\xe8\xc4\x14\xbe\xe7a\xe8\xed>\xbc\x9e\xcb\xe59\xf3\xc0\xc3T\xf7\xf7p]\a\x84O2\x95Ao6\x0e \xb8\xb7\xea4\x01\x19{zv\x8e\xbd\xed-\xee\u007f\xe8\x11&\xa7fX]Y\xe4\xfa\x95ˤRY\x8cN\x1b\xd7u\x91\x15\x15Y\x96\x88D4\x12\xc9\x14\xb2$\x05Џ,#\t\x99Z-\x90C\xf9\xad\v;\xef\x8b@\xa6\x9fx\x86\xf5\x97\xbf\x83\xa2(k\x9dNga\u007fw\x87+o\x9cgb\xfa\x18\xb1d\x82Z\xb9\xcc\xee\xe6F\x9f\xd2\xefy\x1e\xb9\xa1\"\xb5Z\x05\xd34H$\x92\xfd\x9f\xf7\x9ar\x84\x90\xd8\\]f\xfe\xd4Yҙ\f\xa6a\xd0jT\xb1\xad.\x8d\xaeI\xa3^\rj\xe5O<\xcd\xe8\xc8(\xab\xcbKH\x8a\xcc\xf8\xe4\f;\x9bk\x81\xb6\nч[LC\x0f\x8aR\x8a\x8c\xe3\xd8\xe8\xed6\xb2\"\xf7\x01D\xff\x16N\x82\x90$\x14UC\x92d2\xe9,>>\x89D\x12\xc30\x18\x9f\x98\"\x9d\xc9r\xfe\x95\x979}\xf6~2\xd9\fZ4\x86\xa6i\xb78]aIT\x92d\xba]\x93\xf5\xf5e|\xdf?\x0f\x90?\xfb \xd5\xcb\x17\u007f\xb8\x02Y\u007f\xf9;=\xed\xba\xd9n5?\x9e\xc9\x04U9\xdf\xf3\x90d\x19Ƕ\x0f\xd42\\\xd7E\x8bh\bI\xa6^\xad\x049\x01\x02\xd7q\xf0\x14\xa5\x1f\x05YV\x97\x17\xfe\xec\x8b|\xea\xa7\u007f\x8e\xc2\xc8(\x92$\xd1n7\xf1\x1c\x97D*E*\x9bCV\x15l\xc7A\x8dD\xa8ժ!\xf4!\x01.\bAT\x8b\xa2\x84\xacG\xabk\xd25\f$I\nv\x9f\x12\xe9\x93\xf5\x02\xd8%h\x04\x12\x92\xc0\xb5\x1d\f\xbd\x8d\xa2*h\xd1\x18\x96\xd5\xedg\xe2\xbe\xef326\xc6\xd4\xcc\xcc\x01\xfc\xecPE\f\xcf\xf3h\xb7\x1a\xd4k5$I\xfe\n\xf0\xae\x85\xf1\x03A'\xaa\xaa\xfe\xba\xd1\xd1\xff3\xd7uEi\u007f\xaf\xefP\x0fC\xe1\xadz\r\x80\xe2\xd00;;[\x14\x8a#D\xa3\xd1 \xf6\x0f\xa3\xad\x1eg\xcbu]\xbe\xf2\xc7\xff\x86\x8f\xff\xc4\xe7H\xe7rh\xb1\x18B\bTMñ,\x16\xdfz\x13),25jUt\xbd\xc5\xd8\xe4\x14\x96e!+\nB\x80\xd1\xe9P+\x97\xfaLu\xbd\xdd\"\x99Jau;$sy|\xd7õm쮉\xe98\xfdփ\xf0\x99\u0088\xcc\xe9Gg\xb2$\x93H\xa4\x06\x88u\x019\xaf\a2\xf6L\xb0\x10\x82\xb5\x95\x15L\xa3\x83\xaa\xaa\xaf\xbc\xaf\xbc\xac\xfc\x99\a\xa8^\xb9D|n\xe1\x1f\x14\x86\x86\u007fk\xa88\x8c\x10\x90\xcb\x0f\x11\x8d\xc5\xfb\x0f\xd35M\xaa\x952\xbe\xefaY]\xd6V\x96\x11B0\xbfp\x12!\xa4\xbe\xd9\n\xa2!\x81\x1f\x02u\xbe\xefs\xdfC\xe7\x98>>\x1f\xb0O*eZ\xf5:\xae\xebШרU+ض\x8d\x16\x8d\x92/\x14\x03P\xd0up\x1d\a!\x05\xf6]\x96\x83{\xd8X[\r\xbf\xf3t`\xb2B\x16|\xef{\\\xc7\xc5\xf7\\\x84$Q\xad\x94Y\xbcq\x9dD\"\x19,tHY\xf2|\x9f\xe2\xf00\xb2,S\xad\x94\xe9\x9a&\xa6i\x82\xef\x93\xcd\x17\xc8\xe5\xf38\xb6õ\xabo\xb1\xb1\xb6\x02p\xbf\xb1r\xf3\xad\xf7\x95(\x17=6\x8fi\x18j\"\x99\xfc\xcdt:\xf3_OL\xcd\x04NO\x96\x91e\x19\xcf\xf3Æ\x97\x00\xa5\xadU+\xec\xeel\xe1\xba.\x99L\x96ɩ\x19$E\x81\x10\xee \xd4̞\x8d\x0f\xd8\x1eaYU\x96\x03\xf0\xd0q\xfaэ,\xcb!^\xe5\xe1\xe3#\x18\xe81\x91\x04\x92$\x1f\xa8<*\x03\xe1\xb8븷\xc8\xd8\x03\xeds\xbdϾ\x9d\x99\x1fV\xea\a~\x1f\x04&A0\xd05\fZ\xad\x16V\xd7D\xd7\xdb\b!>\xd2Y\xbe\xf1\xe2\xfb\x16e\xf5\xea\x1c\xecm\xd9\xfa\x1e\xbf\xe6\x1e;\xee\vI\xfe\xb5\x89\xc9i\\\xd7\xe9\xdbX!@\x96\x15\xf6v\xb7\xfa-\x03\x92$\xd1j5Y\\\xbc\x1e\xd8\xf0\xb0Ȫ(2\xaa\x1aA\b\tM\x8b\xa0EcD\x04\xa1p\xbd\x83l\x8f\xd07\xf5\xef\xe3\xf0\xc2y\xe0z\xb7\b\x16\xbd\x8a\xa1\xe7\xb9\xfd\nb/\xd2뇿\xa1\x19\v*\x9b&\xb6m\xa3\x84>\xae\xe77|\xcf\v\x9e\xcf'\x14\xa6߯[ݢ\xcb\n\x80\xe2{e\xb2\xeeY \x9d\xe5\x1b\x1c{\xf29V/^\xc0\\]\xfaojBz4\x16\x8f?\x9fN\a\xe5P۶p]\x97ri\x1f\xbd\xdd\fmop㊢\xd0\xeb\xfd\x13\x92L,\x1a8\xe3\xfe\xe28.V\xbb\xd5O\x1eﵢ\x1b\x8d\xc5Ig\xb3\b_ )\n\xa3\x13\x13H\"ȢUU%\x9d\xc9\xf6*\xec}\xe1\t\x11h|6\x9b'\x9d\xcb!\xcb2\x99|\x9eT:C&\x9bGQd\x84$#\x00\xcf\xf7\xd8\xdb\xdc\xe0\xca\xc5\xf3l\xae,S-ﳷ\xb7\x8bi\x9aTJ\xfbt:z\xefV\x8e\xbf\xaf>\xe4\x0e`c\x0e8\xaf(ʱ\x1eX\xd7\xd3\xe0\x9e\x96ٶ\xc5©\xb3|\xf2\xa7~\x96Ǟ~\x0e\x84 \x1eO\x90H\x05Y<\xbe\x8f\x12т\xb8^H\a\xe0\xee\xa0\tJ\x1cq\xa7a\xf8\xe9y\bI \x10x\xbe\a~\x90_\xf4\x88\f\xbe\xe7\xf5M^\xef\x15\xbc\x89~.\xe2\xfb\xe0\xd8\x16\x9e\xe7\x05;3|O\xd08\x14\x98<%\x12A\nk/{\x9b\x9b\xac/\xddd}\xf9&\xcdF\x9d\xb7.^`y\xe9&\x92$}W_\xba\xfe\xcc\xe1\xae\xe0\xf7E \x87\x84\xf29\xe0\xdf\x0e\x82\x94\x92\x14DO\x9e\xeb\xf2\xf1O\xff$\xff\xc1\xdf\xfbeF&\xa6\x82$.\\\x98Fy\x9f\xd2\xc6\x1a\bAnd\x8c\xe2\xc44\xf5\xfd\x1d\x1cǦ0:\xd1'Aئ\x81m[!\xb5\xe8V.\xd13'\xbe\x1f,?\xfd\x05\xa7O\xa6\xeeEG\xc1\xfb\xc3^\xf8\x90\x85\"\xc9\x12\xae\xed\xb0\xb7\xb5\x81i\x18  \xa2E\x19\x9d\x98\"\x1a\x8f\xf7{惮+\x85X2\x85\x1a\x89\x04\xec\x19ǡZ\xda\xe7\xb5\xef|\x93\x8d\xd5e\x96n^\xe7\xfa\xb5ˆ$\xa4Bg\xf9\x86\xf1n\xf9\xbd\xe2\xed\x16\xfen5\xe2\xf8\xdcB\x12\xf8S\xe0Ã?\xcfds\xfc\xdc/\xfem>\xf9ٟ\x03\x11\xd8\xff\x80\x15\x12\xec\x9e\xeb\xaf}\x0f\xc7\np\xb8x:\xc3\xec}\x0f\xb1t\xe9<x\x1eӧ\xef\xc3w=Z\x8d*\xae\xed\x1cp\xe0\xfe@r\x178s\xe9@\x16\xee\xfb~P\xe0\xea\xb4i\xd6k\xd8]+\xa82\x86\xce\xfcp\xc6~T\x8d|b\xe6\x18x\x1e\xf1d\x1a5L\b\x11\x82X\"I\"\x93;\u09fe\xf5g_b\xe5\xe65J\xe5}\xbe\xfb\xe27\xb6dY~\xbe\xb3|\xe3\xe6\xbb\x11\xcamw\xd6\xdbv\xda\xcc\xf1D\xb7\xa3\x0f!\xa4\xb4\xa2\xaa9-\x1a\x9dPTuB\x12\"\xef\xfb\x8ct\xbbfF\b1\x02<\b\xa4\x02Gj3>9\xcd\u007f\xf9\xeb\xbf\xc1}\xe7\x9e\xe0\xca\xc5\xf3\\\xbft\x9eX,\xc1#O=Cv\xa8\b\b\xdez\xe9\xc5~\xd44{\xe6Ab\xc9$\x9dv\x13EQ\xd9\xdfX%\x12\x8b\xa3\xaa\x11:z\x8b\xae\xd9ű,$E&[(\"\t\x81m[\xb4\x9b\r\x1c\xc7ex|\x82\xd2\xce\x16\xcdZ\x15\xd30\x02\x93\x17\x92\x19D/\xd2\xf8AX\x1f\x92@Q\xd4~\x04\x99\x0e9ò\xa2\x10M\xa6H\xa43\aH\x13\xaf\xbc\xf8u\xae\xbf\xf9\x06\xab+\xcb\\y\xf3\xe2n\xa7\xa3\u007f\xd6Z_\xfe~r\xfe4\xedū\xefީ\x1b+7\x91\xc6&s\xb2,\xfdΉ3\xf7?\xae\xc8ʴ\xa2\xaajDӈ\xa8\x91~H\xfa\xfa+/\x1d\xd4.\xcfclb\x8a_\xfdG\xbfű\xf9\x05\xfe\xfc\xdf\xfd\x01\xcb\xd7\x02|\xcbs]\xf4v\x93\x8f\xfd\xe4O\x13O\xa4\x88D\"8\xa1\t\x93\x15\x19CoQ\xddݦU\xad\x90\xcc\xe6\xd1b\tZ\xd5\x12\x9bk+ē)\xb2\x85\"\x11-B\xa3Z\xa1\xddl\xd0i\xb7p\x1d\aEU1\xf46\xedF\xbdo\xaaz\xa1\xeea\xa4\xf9\xa8\xddp4\xf1- ~\xf7\xe0\x9e\xf2\xee\x0ez\xab\xc9\xf8\xf4,B\x92pm\x9bd6\xd7'd?\xf6\xdcG\x89\xc6\x13X\x96E2\x99\x1c}\xe3\xfc\xab\u007f؉\x9c\xfe\\{\xf1\xea\xab#\x0f>\xca\xde\xc5\xd7\xde\xf9\x0e\x19~\xe0\x1c\x95JIS\x94ȿ8\xf7ē\xbfP(\x14\xfbNz\xf0\xc1TU\xe5Ͽ\xfcE\x1c\xab\xdb\xd7\xc0h,\xc6o\xfe\xf6\xff\xc5\xc4\xf4\f_\xf8W\xbf\xc7\xca\xd2M\x12\xc9`\xaeH*\x9d!\x96Lr\xf2\xfe\x87\xb8r\xfeU>\xf1S?\xcb\xee\xda2\xae\xeb0u\xf2>\x16Ͽ\xdcO\x14G\xa6gq]\x97F\xb5D\"\x9d%\xa2E\xa9\x96\xf6\xa8\xec\xed\x1eȲ{\xf9\x8a\xeb8\x03\x14\"\x9f\xf7t8\xc5\x00\xafK\x96eF'\xa7\xc8䇂V\xb8\xc2\x10\x11-\xdao Z\xbc\xf2\x16/\xfc\xe9\x1fc\xdb\x0e\xdf\xfc\xfaWk\xa3\xa3\xe3\xf3\x8b\xdfy\xe1\aF\x80\x0f\xf0\xb2\xf6/\xbdN,\x16\xff\x9b\xb3\xc7O\xfcB\xb18\x82\x1c6K\x1e\xe6F\xf9>\a\xc0=\xd7u\xf9ا\xff:s\v\xa7\xf8\xe2\xef\xffK\x96n\\\xc34M4Mö\xba<\xf9\xd1O\xf0ȓ\xcf\x10O$(\xed\xed\xb2\xb7\xb3\xcd\xdc\x03\x0fs\xfc\x81GiVJ\xfdY'\xb1t\x06\x11&\x9a\xf9\x91q\xb4h\x94\xd2\xee6\xdb\xebkض} ?\x91e\x19g@\x18B\b\xb4h\x9c\xf7\xf4\x1aPB\xd7u\xd9Z]\xa1\xb4\xbb\x8d\xe7\xba4\xcb%,\xd3\xe8\xefĹ\xd3g\x99?s?\xe9L\x86\\>\x9f+Wʿ\b0\xf9\xd8S\xef\x92(\xe7s\" )\xdfyS\xb9\xaes`\f\x85\xef{\xfc\x87\u007f\xe7\xef\xf3\xb5/|\x9e\x1bW\xdfB\xd7uR\xa9T?\xff\xd8Z_esu\x05\xbd\xd5b\xfe\xf4Y\xae\xbf\xf9\x06\x02\x81$K\xd4\xf7w\x91\x84\xe8\x17\xa7|\xcf\vI\xdb.\x8dj\x95\xdd͍\x03ݳ=\xa18\x03\xc3\x03z\n\xd35:\xef\x98\xfdx\xaf\x02\xda\xdf\xdeBo5\xf0=\x8fV\xb5L\xbbQǶ,\x04\xf0\xd0\x13O\xa1jQN,\x9c\xa1\xa3\xb7\xfe\x1a\xc0\xe6\xab/\xbd˚z*\xbd\xeb\xb9\xee\xe7\xe2\xc9t2\x91H\"$q\x80\xb5\xa7\xc9o\xf6\f\x00\x00\x19\x90IDAT\xa8*\x97ߺH\xadZF\bA\xd74\xf9\xc4O|\x96\xb9\x13\xa7\xf8\xda\x17?\x1f\x90\xe4$\x89ᑑ~\x18\xbc\xbf\xb3\xc5\xfe\xce\x16\x95\xbd]\x9e\xff\xf1ϒ\xcd\x17\x88'SHB\xa2U\xab\"\x80t\xa1\x88\x10Au\xaf\xb2\xb7K4\x16c\xe5\xc6\xf5\xdb|\xc0]{H\xee\xf2\xbb\xa3\xfc\xc9Q\xb5\x92{*i\xbb\x0e\x99\xdc\x10V\xd7\x04\x1f\xac\xae\x81\xeb8\x14\x86\xc7\xd8\xdf\xde±\xbb\xac,/\xa9\xb1\xe2ȿ6\xcb\xfb\x9dw,\x90\xf8\xdc\x02\xe6\xeabɊD\xc7UU}Ҷ\xbaȒԏ\xc1}\xdfg}e\x89\x95ś\xfd\xbc\xc0\xeav\xf9\x87\xbf\xf5\xbf\xf0\xe7\x9f\xff\x03J\xfb\xfbx\x9eG:\x95\"\x95\xbeՒ,\xc2\xff,\xab\xcb\xea\xe2\r\xee{\xe4\xb1>\xea\x9b.\x14\x89\xa7\xd2\xd8\xdd. \xb0\xba]\\ϣ\xddl`\xe8\xedۄq/\vכ\xfa0\xf8\xfe\xb7\x9f$qoB\x11B`\x1a\x06\x99\\\x0e\xa3\xdd\xc22;D4\r_\b\x92\xe9\f\xf5\xf2>{ۛ8\xb6\xa3\xae\xad,\xbd\x8e\u07ba\xf6\x8e\x05b\xd7*\x81\xd3\xce旻]\xf3\xe7\xf3\xf9\xa1D\xabդQ\xaf\xd1h\xd4)\xedn\xb3\xb4t\xfd\x80\xd9x\xfcُ y\x1eK\u05ef\xd1\xedv\x91$\x89\x91\xb11n\x9b\xe2\x16\xccn\xc12M\xb6\xd6W\x98[8\x1d\x90\x9a=\x8fV=\xd8%\xb5\xf2>\xb1D\x12EQ)\xedl\x1fp\xe2\xefT\x18w\xea\b\xbeS\xa7ԝ\xcc\xd9\x01\xb2\xb6\x10\x98\x1d\x9d\xe1\xf1\t\xda\xf5:\xb1D\n|\x9fx:C\xabYcm\xf1&\x8a\xa2F67\xd6\xf6\x95L\xee\xabn\xa3\xe6\xbfs\x1f\x02tח\x17\xcdN\xe7\xeb\xcdF\x03EQP\x14\x85\x8e\xde\x0e8M\x8e{\x00p\x9c=~\x82\xa5\xebWh\xb5Z\xc4\xe2q&\xa6\xa6\x88\x0e8\xd7\x00\x81\rڛ{\x0fS\xdb\xdf\xe7\xcd\xd7_\xe9\xb3\a]'(\xc5VK\xfb}ƈ\x13\xfe\xecN\v{\x10\x129\xb8\xa8\x8a\xa2\x1c\bH\x8e\x02J\x0f\u007f\ue746\xe1\x1c\xf5s!\x04F\xa7C\xb7ۥ73\x12\xdf\xc74:\xcc.\x9cA \x88\xc5c\f\r\x0f\u007fH\xc8r>\u007f\xe6\xc1w\xee\xd4{ٹ$I\xbf\xba\xbb\xb3\x85i\x9a\xec\xedn\xb3\xb5\xb1\x8ai\x1a\a\xb4fxt\f<\x97Z\xb5\xc6\xd8\xf88S\xd33\xc4\xe3I\x1c\xc7:P\xb42\xcd\xee\x01\\JH\x12\xebK7ðY\n\xec\xb0i\xa0HR\xd0\xce\x1009\xee\xba3\xee\xb6\x03\xee$\xb8\xa3\x048\xa8\xfdw\xfa\xac;}\u007f\xb5\\B\x8b%\xfbټm\x1ah\xd1XP\xa1TTR\xa9̣\x8em\rU\xaf\\\xec\xaf\xed۵*HG\xa1\xba\xf1\xb9\x05\x8c\xd5\xc5\x1dǱ\x1fY\xbay\xcd.\x97\xf6\x83\xa9:BP\x18\x1a&\x1e\xb2\x11\xf3CEb\xd1\x18S3\xc7\xc8\xe5\v\bI\xe08\xd6mβ\xd3\xd1\xfb\t[\xefg\x95\xbd]\xca\xfb{ȲB$\x16\vp\xad\xa1a\\\xcb:P\xb3\xf8A\xa3\xa5^\x9b\x82\xeb\xba\xfd\xfa\xc9\xe1\xd7\xe0\xef\xee$\xa4{\xb9\x8cv\v_\x04\xe6\xd8\x0f\x03\x1e?\xc4\xd9dE!\x97/\x88x<\xf1t|n\xe1\xf9\xf8\xdc\xc24A\x0f\xe2]\x85#\x1d\xde\x19\x83\xd8Ug\xf9\xc6\x05!I\x9f\x06J\xbe\xef\x93/\f1:6\xce\xccl\x806\xc7bql\xdbF\x92\x83\x9e\x8b\xd2\xfe\xfe\xa1\x96\xe2\xc0I\xf7H\t\a\x89\xd8\n\xdf\xf9ꗑd\x89D:\x8b\xa2\xa8\xc4SiR\x99\xe0\xef\xf7\xa2\xf9\x83\v\xfaN\x16xP8\xbd\u05fdDg\x83\xb9\x89k;\xc8!\\/)J0\x12\xeaVH\ueeee\xfb\xbb\xc0\v\x04\xdd\x01\xdf\x04~!>\xb7P\xec,\xdf\xe8+\xff\x01\xa7\x1e\x9f[@\xcd\x15\xfaB\x88\xcf-\xfc\x8c\x9a+\x1cSs\x85\xa8\x9a+\xd8@\xc4\xf3\xbc\xff(_\x18\x8a\x8d\x8eM\xe2\xfb\x1e\xb2\xacШUPU\x95\xc2\xd0\x10\x9bkk\xd4\xeb5\"\x11\x8dT*\xd577\x01q!\xe0\xfa\x86\xd3=\x0f\xee\x1c\xbd\x8d$K̜8\x89\x12\x89\xa0\xb7\xea\b!!\xc9\x12퐒z\xd8d\x1c\xceGލ\x86\x1ftԁ\x02ݩ\xf9\xf4NB\x89D\"\xc4\xc2rB,\xa4\t]|\xf5\xfbx\x9e\xc7\xfaڊ\bJ\xda~\xaf1uJ\b\xf1Y\xe0Y5W\xf0\xedZ\xe5\x82]\xab\x10\x9f[\x10\xbd\x80J\xe9\t\"6{\xe29!\xc4\xef\x03À\x17\xbe\x1c\xc0Q\x14%?2:>\x80t\xfa\xa8\x11\x8df\xbdN\xadR\r\bǲ\x8c\xd95\x83\xda\xc4\xc0\xe2\x99f\x97\xc4!a\f\xe64\x97^}\x99\xb9\x93g\xc8\xe4\v\fO\xcdR\xdd\xd9B\x8b\xc6\xc8\xe6\xf2\x94\xf6\xf7\xee\xb83\xee5L=*J:\xeaww\xf3\x1fw\x12\xb8胘\x83\xb8\x99\xe8\xe7*\xed\xb0\xe86<2F*\x95\xa2Tڧ٨#I҇\x80G\xe3s\v\xbf\x01\xfcrg\xf9\xc6\x17zVJ\xd2f\x8e'\xe3s\v\xffm2\x95~1\x16\x8bO\xfa\xbe\x1f\x01\xa2@\x1cH\xfb\xbe\x9f/\x8e\x8c\xdevò,c\x18Ɓ\xe6I۲\xe8\x9a\xdd>,\xee8\x0e\x9e\xeb\x10\xe9\r\n;j\xdb;6\xdf\xfd\xff\xfe\fSבe\x99\xdc\xc8\x18\b\xc1\xf0\xe44\xc90\x97\x19\\\x90^}\xbd\xb7\x18\xf7\x9a\x81\x1f^Ի\t\xea^w\x87,I$ә`{\x85\x8c\u007f\xcb2\xfb\x15\xd4z\xb5\xc2\xf8\xe4\x14\xc3#\xa3h\xd1\x18\xd33\xb3\x1c\x9f_ \x16\x8b#\x84P\x80\t\xe0O\xe2s\v\xff{|na\xb8\xb3|\x03IU\xd5\u007fU\x1c\x1e\xf9\x9f\xa7gf\x19\x9f\x98\xba\xedKc\xb18\xe9t\xf66\xcc-\x10H0\\,\x96H\xf4\x91\xcaz\xad\x8a\"+\xb8\xae\x8b\xae\xb7\xfb\x14\x9b^\x8f\xc6Q\x8b\xb8\xb3\xb1\xce\xf7^\xf8\x1a\x86\xae\x13\x89FI\xe7\v\b`lr\xa6O\xbb9\xbc@=r\xc5\xc1\x1a\xbb\u007f\xa0'P\xdcA@\xef\xd4\xc4\x1d\xbe\x12\xa94\xb2\x1a\xe9\xd7h$Y\xa6V)#$A\xa5T\n\xc8x\x99\x1c\x9e\xe7\x91H&ѢQ\x145\xc2\xf4\xb1Y\xa6\xa6\x83@HU#x\x9e\xf7\xf7|\xdf\xffv|n\xe1\xb3\xf2\xc9G\x9f\xfc7ɐ#\xab(\xc1B\xde\xea\x11\x14\xe4ryz\xbf?\x10\x87\x9b\x06\xadf\x9dD<\xc9\xe4\xf4\f\xd5J9\xe8\xe5s,\x12\xc9\x14\xe5R\xa9?8 \x97\xcbc\xdbv\xd0\x0e\xe0:G\xfa\x93Z\xa5Du\u007f\x97\xdcP\x91\xec\xd00J$\x18\x81\xe4{.\xadp\xca\xc3 qm0\xcf8Ld\x93$\xa9\xaf\xb5B\x88;⿇\x93\xbd{\xcd\xd8} \x1a\x890:5\x8d\xdd\r(\xb1\x92$\x91\xc8d\xd9\xdf\xd9bke\x89\xf3\xaf\xbdL4\x1a#\x99J#\xcb2\x8f?\xf5,\x13\x93S\x14GF\x88D4\x1c'\x9c\x92\x97\xc9\x12\x8b\xc71\r\xa3\xe0\xba\xeeg\xe4c\xf7?\xf2\x8f\x06\x1fH\x96e\x1a\xf5Z߮\x0e\x8f\x8e\xf7\xa7\x1e\x1cv\xc8\x1d]\xa7Z)3:>\x89,\xcb\x01\x81MV\xe8\x9a]b\x89\x04F\xa7\x13\x8e\xe6\xcb\x12\x89D\xfa\xf3\xacL\xc3 \x1eOܶ\b\xedf\x93\xfd\xad\r\xb2\xf9\x02\xa9\\>\x14\x9cG\xa7\xd5\f\xea'\x03\x9a~8\xf1;l\x82\x0e,\xea\xdbd\xe5\x87\xff~7\x81H\x92DT\xd3\xc8\x17\x87\x89'\xd3\xe8\x8d:\xd1d\x12I\x92I\xa42\xd4+e\x16\xaf]\xe6\xe2\xf9W\xc9\xe4\nD\xa31\xa6g\xe7\x18\x9f\x9c\n\x1c\u007f,\xcePq\x98ٹy2\xd9l\xd8?)\xc8\x0f\r\x83\xefGn\xb3\x1fJ8\x9c\xa5W\xf7\x88\xc7\x13G\x98\f\t\xd34\xfb\t\xd1K\xdf\xfe\x06\xb1\x90u\b\xd0횈\xd0\xc9iZ\xa4\xafřl\x8eL6\x8ba\x18loo\xdd\xfa\x8c\x81\xabZ)\xf3\xe2W\xbe\xc4\xf6\xda\n\xb2\x1a\xa10:\xce\xe8\xd4L\x9f\xc5r\x94O\x90$\xe96Sx\x94\x99;\xbc\xceG-\xfe݄\xa1(\n\xb1h\x94X<A\xae8J\xabZ\xe9\xef@I\x0e\xa2C\xd7q\xd8\xdf\xddA\b\x89D\xb8v\x91H$@,\x06\x93Y\x01\xa3\xe3\x13<\xf9\xec\x87y\xfa\xc3\x1farz\x9aǟz\xf6\b\x1a\xd0\xe0M\x8a\xa0\xa4\xe9\xfbҁ\x88\xa3Q\xaf\xd2n5\x0f\x98\x8c\xf3\xaf\xbf\xcc\xf1\xf9\x93}vb\xc7\xe8\x84\x1c^{ \xeew\xc9\xe5\x82.\xa7F\xbdNi\u007f\x8fѱ\xf1\xe0\x86\a4\xbc\xd5l\xf2\x8d?\xfd\x02\xcf|\xe2S,\xdc\xf7\x10#\x13S\xe8\xcd\x06\xbb[\x9b\xfd\xc5?<\x1d\xbb\a\x87\x1c&\xc2\x1d\\`qۘ\xa6{\x15F\xa0\\\x1a\xb2,34:F\xa7Y\xc7贈'\xd3\xc1((Y\x01\x1fJ\xbb\xdb\\\xbfz\x195l\xdb\xee\x11\x05{8^\xa7ӡ\xa3\xb7\xb1\xba],\xdb\xc24\x82\x19\u008ecӬ\u05cf\x10\xc8@Xi[6\xab\xcbK\xa1\xd3\xf6\xe9\xb4\xdbt\xbb\xdd\xc0\xb6\x1f\xf2\x01z\xbbM\xb9\xb4Gah8\xa0\x00\x85e\xd0^\xd7Ra\xa8\x18\x92\x99!\x93\t\x82\x84@(\xfbLLN\xdef\x16l\xab˷\xbe\xfael\xcb\xe2ԃ\x8f0s\xf2\f\x9dv\x9bV\xb3qĄуξG\x9a\x1e\xac\xfa\x1d\xde\r\xf7\xea\xd8{\xff.\x1a\x8d\"\x84 \x91\u0380\x0f\xadz\x95x\"E2[\x00\x1f\xe4H\x84F\xbd\xca\xea\xe2u\x9a\x8d:\x9a\x16Eo\xb7\xc8\xe6\xf2\\\xbfz\x99\xb7.^\xc0\xb6\x1dz3\x8c}\xdf\xc70\r:\xed\x16\xadf3\x84\xa5\x04J\x0f\xc4\v\b\xc5>\x95r)Lb\x02\xael\xb3Y\xa7Ѩ\xddv\x83\x83\x1a\xd9CO\xf7\xf7v\xc9\xe5\x87\xf0Br\\_\xe3[-\x14E\xedw\xc7\x06D\xb5\x1cz[\xc7u\x1d:\xbaN<q\xc84\x86\x94\x9b\xef\xfe\xc5W\xa8\xed\xefq\xee\xd9癿\xef\x01.\xbe\xfcR\x9f2z\xa7\x19\xf2=\xf6\xa3\x17\x9a\x89\xa3\xde\xf3v\x93\xb5{\xc2\xed\xf5H\n!\x88h\x1a\xa9l\x9eN\xabA~t<(\xe1\xfa>\x91h\x8cD:\xc3\xe2\x957\xb9q\xf52\x11-\xca\xfc\xc2\x19j\xd52\x9e\xe7\xd1j6\x03\x9e\x98\xe7\xd2\xd1;4\x1aU:\xba\x8e\xef\xfb\xc4\x13Ir\xb9\x1cCœ\x1c;6\x87\"I\x81?h6j\xd4\xeb5\xacn\x17)\\\xe8\xe0\x81\x05#\xa3c$\x93i2\x99\f\xa9T\x9aL.K:\x95!\x1a\x8bQ\xda\xdf\xe7\x8d\v\xaf\xb3x\xf3:\xaa\xaa\xb2\xba\xbc\x18$B\xe9\f\x92,\xf5MH\xb9\\\xa2ި\x13\x8b\xc5\xd1\"\x11\"Z\x84X,J\xab\xd5B\xd7ub\xf1\xf8\x1dI\ao^x\x8dv\xabɹ\xa7?\xcc\xf8\xcc,\x9bK7o\xcd\xc5:\x94$\x0e\xfe)\xdf\xe5\x18\n\xff.s\x1c5MC\xd34$)\xe0w)a;C*\x9b\x0f\x1a\x92\n\xc5 \xb0P\x03\xb8'\x1aOp\xed\xe2y^\u007f\xe9[\xec\xeel\x13\x89h\b\x11\x8c\xabj\xd4k\xf8\xbeG\xab\xd5\xc4\xea\x06\xdd[\xf1D\x92Sg\xeegn\xee8'N\x9e\xe2\xfe\a\x1e \x97/\x04\xc4\xc1\xad\x8d5t]\xef\xf7l\xbb\xae\x8b\v\x8c\x8c\x8cq\xea\xecY\x9e\xfb\xf0\U000cc30c\xa1E5b\xd1X\xc04W\x82\b\xc7\v' |\xf2ӟ\xe1{\xdf\xfd\x0e_\xfa\xc2\xe7\xd9\xdf\xd9\xc10t\xe2\xf1$\xb1D\x82L&\x1b\x80\x91\xe1g\xb7[Mt\x11\x04\x06=\xcd5\x8c\x0e\xb6m\x1f\xf0%\x87w\xe4\xca\xe2u\xaa\xe5\x12O|\xf8\xa3\xc4S):a\x9f\xe3Q9\xc6Q;\xe2\xedLT\x10R{$\x13Ib\xf18\x8a\x1aA\x8b\xc5\xd1bQd%\bk\x85$\xf0\xfd\x80T\x17O\xa5\x89\xc5\x13T\xca{|\xfb\xab_f}y\x11\xab\xdb\xc5\xec\xe8ؖ\x85mYT+\xc1\x0e\x89\xc5\xe3\xe4\vE\nCEN\x9c<ͩS\xa7\x98\x9c\x9c\"\x9d\xce\x04'5\x84\xa9\x06\x80\x88\xcf-\xf8\xbd\xed=:6·\x9e|\x86\x0f=\xfd4ǎͽ\xed\xb6>\xaa\f\xfa\xca\xcb\xdf\xe3\xff\xfd\u009f\xb0\xba|3(\x14I\x12\xb1x\x82\xe1\x91\xd1~7\x95\x14\x9a\x82\x03L\x96H\x84B\xbe\x80\x16\x8d\xf6\tqG}W\"\x91䙏\u007f\x8a\xe5\x1bWqL\xf3\xae\xc32\xef\xe5\xfe\a1\xa9l.O2\x93%\xdakO\xe8\xff[\x1fIV\x89\xc6\xe3Ēi$Ybc\xf9&\x97^\xfd>{[\xb7\xe6\x955\xea5^~\xe9\xdbt\xbb]4-F\xbe0\xc4\xd0\xd0\x10\xc7\x17N1=s\x8c\xd9\xd9Y\x86\x87\x87\xef\xee\xb3\x1e\xf9\xccg\xfd\xd3g\xce\xf2c\x9f\xfe\x89\xf0,\x8d\x90d0`\nz=\x13\x87\x13\xb0\xa3\x8a@\xbd&\x98\xa5\xa5%Ο\u007f\x8dkW\xdebog\x9bz\xbd\n>d\xb3y\xe2\xc9\x14\x11UEQ\u0560\x8bw\xa0\x1dNQ\x14\xf2\xf9B0\xae\xef\x88\x05\xf5<\x97D\"ɏ}\xee\xe7Y\xbcz\x99v\xadzd\x9e\xe1\xba\u0381]x\x14\xd2\xdb#l\xc7b1F&&\xd1≠\xc9S\x12\xe1\xe0\x9b`\"\xa9$\xc9t\xad.\xa5\x9dm֗n\xb0\xb6t\x13S\xd7\xfb\x84qǶ1L\x83\xb5\xe5%\xf6\xf6v(\x0e\x8f244\xcc\xc8\xe8(\x0f<|\x8e\xe9\xa9)\xf2\x85|\xbf\xf3\xea\xae\x02\xf9\x93\u05ee\xfa\x9a\xa6\x1d\x10B/\xd2q\\\x17]׃iж\x1dD/\x9e\x87\x90$\xf2\xb9\x1c\xf1x\xfc\x8e\x89T/Q\xacT\xab,/\xaf\xb0\xb5\xb9\xc6\xe6\xfa:{\xbb\xdb\xec\xedncu-\xe2\x89\x04\xc9d\x8al.\x1fԥ\a\xccM\"\x99$\x95J\xa3\x85??\f{\x17\n\x05\x9e|\xfe\x13\xecl\xaeS\x0f\x06\x1a\xdf\x19fw]\x1c\xd7\r\xe7\xc0K@࠳\xf9\x02\x85ᑰ_%\xe8\xdcR#\x1a\x91h\x94h<N\xa7\xddfce\x89\xb5\xc5\xeb\xecmm\xa0\xb7Z\x88p8\x9b\xdenѨשժt\xf4v\xbf|}b\xe14\xf3\v\v,\x9c<\xcḏc$B\xdfx\xa4 z\x04p\x04BV\x10\xaa\x8a\xf8\xa3\xef_\xf2\x0f;?I\x92\xa8\xd5j4\x9a\xcdۊE=\xa7\x97\x8cǉh\x1aZ$\x124\xcc\b\x19$\x81\x1fR9\x0f\x14\xbd:\x1dn\xdc\\\xec\xf5tc\xdb\x16{;ۼy\xf1\x02+\x8b7\x82\x9e\xeft\x86\x91\xd1q\xa2\xb18\xb2\x12\x8c\x06\xef\r\xbd\x1f*\x1e\xdd~122\xc6c\xcf}\x84ūW\xe8\xb4\x1a\xc8᠂\xde\x00\x01Y\x96QB\xcc+\x96H\x92\x1f\x19\r\x86\x15\x84ɻ$\xc9ȊB,\x91B\x8b\xc5\xfa\\\xde\xdd\xcd\r.\xbf\xfe\n\xdb\xeb\xabt\x8c\x0e\xedf\x13\xc7q\xa8\xd7*T*\x15\x1a\xf5\x1aV\xd7D\x8dh$\x12I\nCEΜ=˹G\x1fg|b\x02U\x8d\x04-wG\xf9\xae\x1e\xc3^V\x90\xb5\x18\"\xa2!d\x15!\a5\x95\xdb\x04\xe2\xb8.{{{tÈ\xe0@\xd2\x14\xd6\f\x82\xa8#J\"\x9d&\x99-\x10\x8d'\x82\xf1\x9eB\x80\xeba5\xab\xf8\xb6\x15 \xc1\x037\xb4\xb4\xbcL\xa5R\xe9g\xad\x8a\xa2\xa0\xeb:o]<\xcf\xe2\xcd\xeb\xd4\xc3\xc6\xceh4N:\x93&\x93\xcd\xf7\xcd\xe0P\xb1\x18L\x9e;L\x8e\x9e\x9c\xe4\x99O\xfe8\xd5R\x89\xed\xb5eb\xb1\x04\xe9l\x0eCo\"K2\xb1d\x8aX\"\x89\x90\xe5\x90e\bB\xc8Db1b\x89d\x90\xbc9\x0e\xb5r\x89\xab\x97.p\xf9\xc2k\xec\xedl\x87\xa73\xd8t\xdam\x9a\xcd\x06\xa6\xd1AV\x82\x99)S\xd33\x9c>{?\xe7\x1e}\x8c\xe1\x911ҙ4\x82\xa0\t\xe8H\x9f\x15f\xf3BQ\x91\xa2q\x14-\x06\xe1\xd9%\x87}\xa5\xf8\xa3\xef_\xf2\x15%\x98,\xddj\xb7)\x95K\xf8~\xe0d\x95\x88F$<gC\x8b%\x88D\xa3h\xb1x_\x93\x82\x19\x85G\x94[%)H0\xad.\x9ee\xe2\xd96\xbek#\x03\xb5z\x9dR\xb9L\xab\xd5\xeaW\xe9dY\xc64MV\x96nr\xf3\xfa5\xb66\xd7i\xd6kh\xd1(\xd9\\\x81t&C,\x1e\x0f\xa0\x97L&\xec\xbc\x12\xfd\xa8pbb\x92'?\xfe)\xb2\xb9\x02\xadz\x8dn\xc8(\x1c\x8c\xba\x94H\x84H4\x16(\x8f\xef\xd3j6\xd8\xd9Xg\xe9\xc6\x15\xae\xbcq\x81\xf5\x95E:\x9dN0\xd4\xd9\x0eX\xf3Z4\xcaPq\x98\xb1\xb1\x80/0sl\x96\xe9\xe9\x19\x8a##Ȓ|\x9b\x99\xef\xcd\xddr=/\xd8\x01\xaa\x8a\xacj(\xd1(\x92\x1aE\x84}\x90w\x1bg(\xfe\xe8\xfb\x97|\xdf\xf3(W*\xb8>$\xb29\x12\xe9,\xaa\xa6\xa1\xa8jذ\"\xddއq\xaf\xd4\xe1p\xb0\x8b\xef8x\xb6\x85k\xb4\xb1L\x83V\xbbM\xadV\xebO\xe6i\xb7\xdb\xfd\x1aJ\xbdV\xa5Z)s\xf3\xfaUn\\\xbd\x8c\xac(dr92\x99\x1c\x99l\x9ex\"N$\x12!\x99L\xa1(*\x8ec\x93H&y\xf8\xc9g9y\xff\xc3\x18z\x1b\xd7\x0eNu\x8b\xc5\x13(Z\x84N[go{\x93\xbd\xaduVn\xde`gs\x93\xc5\xebW\xa9V\xf6\x83\xae*I\"\x1a\x8d1>1\xc9\xf8\xc4$\xa7N\x9ffbb\x8ad*E*\x95\n\xa2\xbf^\xb29\x10\xect\xad\x00\xfe0L\x03$\x99t~\x88X*\x83\xa4\x04\x02\x91BH\x05\xee\x11\x19\xf8\xfc+o\xf9~$\x8a\x96H\xa3hZ\x9f<\xfcü\x9cv\x03\xb7\xd3\xc6v\x1c\xf6\xf6\xf60C\x87X\xadV\xfb\xd3\x19\xfa\x15G\xc3\xe0\x9b/|\x8d\xd5\xe5Ed)\x98\x14Q\x1c\x1e!W\x18\xc2u]\x12\x89\x04\x85\xa1b\u007f\xc7<\xf6\xdcG\xb9\xef\x91\xe0̏\xf2\xde6k\x8b7\xd8XY\xa2\xddjbt:\xec\xef\x06\x83gv\xb67P\x14\x95\xa1\xe1\x11\xe6\xe6\xe6y\xea\x99g\x99\x9d;\x1e\xe6L^\u007fv\xe3m\x90\x8em\xa3\xeb:\xedv0I\xc2\xf3=\x92\x99\x1c\xf9\xb1\t\xe2\xa9\xcc;_;!\xf0\x1c\a\U00055366\u007f\x8b?\xf5>]B\xe0\xbb\x0eN\xb3\x8e\xef\xdaԪ\xd5~\x00Q\xa9T\xfa\xa7\xd8\ff\xdc+ˋ\\y\xf3\";[\x9b\x18\x86\xc1\xd0\xf0\b\xb9|\xa1ϬL\xa53\xc4C\xb8^Q\x14<\xcf\x0f\xe6\x9a p<\x0f\xcb4)\x97K\xec\xefnӵ\xba<r\xee\t\x1ez\xe4\x11\x1ex\xe0a\x86\x86\x87\xb0-;l\x14\xf5\x0e\x84\xc6A\x10bc\x84\xc0\xa0\xe38H\x8aBD\x8b\x92)\x14ɏ\x8e\xa1\xa8\x91\xfe\b\xda{\xb1\x1a\xbdqT\xbd\xa6Rǲ\xe8\x1a\x1d:\xad&\xe2\xcf\xd6\xea\xfe\xbd\xaf\xe3\xadv1\x1f?8\xd7\xc3\xea\x06\xbdxj\x04I\x0e\x9a\xf9{-ho{\x83B\xe0\x9a\x1d\xfc\xae\x89\xd1n\xd0n\xb5i\xe9:\xdb[[\xb7!\xb5=\\i{s\x93o\u007f\xf3/\xd8\xdd\xdd\x0e\xa8ȩtPDKg\xc2\x03\xc5\xe2$\x93)\xccn0\xae\xb6k\x06\xc1I\xbdZ\xa5\xb4\xbf\xcb\xfc\xc2I~\xea\xa7\u007f\x96\xfb\xee\u007f\x00!D\x88\xb4:Ax\xdf\xed\x86e\xe7`q\x1d\xd7\xed\xcfu\x8chQ\x12\xe9,\xc9L\x96x:\x13\x1c\xad1\xd8\x13\xf96B\x90$\t\xcf\xf7\xe8vt\xba\x1d=\x18\xaai\x99XF0\xe8\xdf\xeav\xb1m\xfb\xed\x05\x12@\x06\xc1A\x8c\x96i`\xb4\x1a\xb4\x1b\xf5\x80`l;\xfd\x88I\x92\x83\x19\"Z,F2\x93C\x8djD\xa21\x94p&֝o\\\x00\x1e\xbe\xe3\xe0\xe8-\xecN\x8bv[gum\x8df\xabu\x87\xe2\x98\xce\xfe\xde\x0e\u05ee^f\xf1\xfa\xb5\xa0\xc5:\x12a\xa8\x18\xec\x9a\x1e<\x8f\xef#\xc9\n;[\xeb\xec\xed\xee\xf0ٟ\xf9y>\xfd\xe3?I2\x99\xec\x03\x93\xba\xaeS*\x97\xfb\b\xf1`0\xe0\xb9.\x89L\x96\xc2\xd8\x04\xb1d\x1aY\x91\x83\xa35\xee\xd1'\xf4\x184v\xb7Km\u007f\x87f\xb9\x14\x1eJ\xe3\x06\xf0J\xd8b\xd1\xeb\xc9\xf4}\xff\xee\x02\xb1\xbb&\x8dJ\x99V\xb5\x8c\xa1\xb7\xfb7\xfcvvrp\xdb\a[{\x88Lq\x04-\x16\u007f[M\xf2]\x1b\xa7Y\aϡZ\xad\xb2\xb8\xb4t\xc0\xaf\f\xbeW\x96%V\x97\x97\xf8η^\xa0\xd5h`\xdb\x16\x8a\xa221=Ӈ\xf8\xeb\xb5*\xabˋ|\xf4\xe3?\xc6\u007f\xfc\x9f\xfc\xa7\xfd\x14) \xf0u\xd8\xd9ݽ\xbdH\x05$29\x86g\x8e\x11K\xa4\xf1\\\xe7\ae\xec\xe1\x03z\xa3Fug\x9bv\xa3\xd6G\xb9\x1d\xc7\xc1\xb2\xac \x1a\v\x13\xed\x03\xf0\xd3W֛\xbe\xeb\xd88\xb6\x85\xeb\xd8X\xa6\x89\xdelЮW\xb1\u008a\x9e8<\xed\xa0\xd7\xfdzO5\xe8\x00\xd6\xf7=\x8fx:Cal\x82T6\x8f\x1c\xb2\xfc\x8e\x8e\xcc\x04\xae\xa1\xe3\x99:N\xd7`sk\x9bz\xbdN\xa7ӹ\x8d(\x1d4\xfbK\\\xbd|\x89\x9b\u05ef\x06\xa7wvڤ\xd2Y\x92\xc9\x14\xdb[\x1b<\xf5\xecG\xf8\x9b\u007f\xeb\x17\x19\x19.\xf6\xe7\xc1\xbb\x9e\xc7\xd6\xd6V\x9f\x1c\xe7\xfb\x1ejD#\x99\xcd341M4\x9e\xc0\v\xa7\x97ޛ9\x0f\xc66\x99\x1d\x9dV\xb5Lmo\x17\xc7\n\x188\x9e\x1fp\x9b{\xcc~\xef\xd0n\x1c$h\x88\xdf\xfd\xc6+\xbeevq젹\xd2u\x9d\xa0y\xe0.\a\a\xbb\x87\x1c\x98\x14f\xc3\xf7\xc26\xc4\xf7\x89\xa7\xd2d\x8b#}\xcaϑ\x82\x11A\xd6\xef\x19m<C\xa7kY\xb4Z-\xb6wvh4\x1a\xb7aT\xb2\xa2\xe0\xb9.;\xdb[|\xe1\x8f\xfe5\x8emc\xdb6\x0f?\xfa\x04\x1f\xff\xd4O0=5\xc9\xc8\xc8H\u007f\xf76\x9aM\xaa\xd5j_\x18\xd1X\x82\xb1\xe3\v\xc4S\xe9\x1f(\xbc\xef\r\xfcl\x94\xf7\xa9\xed\xed\xd2i5\xfa3X\x1c\xdbƲ\xed`]C\x8a\xe9\x01\u007f\f\xb8\x87r9\xa5^\xda?\xb0\x90\xbd\x03\x1b\x8f$,\x87\x11\x877\x90\xdc\xf4\xce\r\xf1\x14\x05E\x96\x91B\x94\xf40\n|\xab\x96,0\xf46\x86ަ\xbc\xb5\xc1\xf0\xf4,\xd9\xe1\x91\xdb}LH\xad\x91\x13i\x90\x15\x94f\x8d\\.G6\x9be{g\x87\xd5\xd5\xd5\x03\xf5\x8e\x1e}sjz\x86Ǟx\x8ao}\xf3븞\xc7Ա\xd9ନp\xceJ\xefy\xba\xe1Y\"B\b\x14Ucb\xfe$Z<\xf1\x03E\x9b\x92\x144\xac\xee\xac,b\xb6[\xfdP\xd9u]L]\xef\x1fbv'\xb3ޛ\x92=X\xa9U\xecp`\xbe8\xdcJ< \xa4A\xce\xec\xe1L\xb3\xd7\xe4ߛ\xf6\xe9\x84\xd3\x13\xfa\xf4\x9b^Ӥ\xa2ܦնm\xb1q\xe3\n\x95\xdd-\xc6g\xe7\x89Dc}\x81\x1e\xd0\xfeX\x12!+X\xf52\x02\x98\x99\x9a\"\x11\x8fs\xfdƍ\xdb\xde\xeb8\x0eg\xee\u007f\x88o\xbf\xf8\x02\x02\x1f\xb9w\xe8\xe4@O\xa4\xef\xfbء\x00eIf\xe2\xf8\tb\xc9\xd4\xdb\"\xb1\a\xbe\xc7겵|\x93V\xad\x1aL\"\"\xc8#\f\xc3\b\xbax\xef\xb2\xc3\x0e[\x98An\xb2r\xc0a\x0eԟ\xa5C\xf6\xed\xedHf\xc2\xf3p|\xff\xc0v\x1f\xec\xc3\xe8\x01~\xb7i\x99,c\xb4\x9a,\xbdy\x81\xec\xd00\xf9\xd1\t\xe2\xa9C\x8b\xe3{H\x11\r\xad0\x82ݬ\xe1tM\xf2\xf9<'N\x9c\xe0\xe6͛\xb7=\\2\x95fdt\x8c\x8d\xf5\xd5[\xa6` Zs]7\x1c1\xa819\u007f\x92T\xae\x10\x8c\x06|;\xf3$݊\x98*;[xN\xb0\xf3\x02Ʀ\x89mY\xfd\xa8\xf3n\xe4\xf0A~\xd7\xe01\x1a\x00ʁ-\x15\xce\x1f\xc1\xf7\xf1\x0eu\u07fe]\xf5\xcd;\xaa8\x14j\xe6`䥄}\xee\x87\x1f\x14\xa0^ާݨ\x91\xce\x0f1:;\u007fP\xcb|\x1f!\xc9D\xb2\x05\u070e\x8eݪQ\xc8\xe7i\x14\x8b\xec\xed\xef\xdfV3\x99\x9b_`ce\t\xab\x1b$\x99\xea\xc0\xb0\x1b\xd34Q\xb4(3'\xcf\x10M$\xefI\x18\xb2\xa2\xd2,ﳳ\xb6\x8c\xdd5\xfa\x03،V\xabo\x15\x8e\xe2\x80\x1d%\x88A\x93uxW*\x87\xbbY\x0fץ\x0f\x1faw\xafή7 ƗnQ\x88zI\xd8Q4о?r\x1c\xaa{;\xb4\xaa\x15\x8e\x9d}\x90H4z\xe8;\x05r<\x85PT\xacZ\x89\x89\x89\x89\x00\x87\x1b\xf0A\xbe\xef3}l\x16$\t\xb3w\xca\xcf\x00-Ȳ\x1dfN\x9d%z\x04\xe7\xecN\xd7\xda\xd57iTJA\x8e㸴ۍ\xc1\xb6\x83#\x9f\u07fdC\xeeu\xa7\xd6\a\xb8\xcbx\xa6\x03;\xe3\b\xb6\xf9\xbdR/{\x1a0\bʹaK\xb5$˨=\xdfr\xa8\xbd\xccq,n^x\x95\xa9\x93gH\r\x8e\x9b\xed\x95T#Q\xd4L\x1e\xd5h\x93N\xa7\xa9V\xab\a\xee#\x99L\x93\xc9\xe6\xe8t\xf4\xdb\xda\xd6r\xe3S\xc4\x12\xa9`\x9e\xd6]P\x04\x01t\x9a\rvV\x171\xf46\x9e\xeb\xd1\xd1\xf5\xfe\xa4\x87;\x06?w\xb0*=!\xddM\t\x94\xbe\xa4\x06&\xeb\xf4\xec\x9b\x14.\xda`2x\x94/\x11\x87(\x9b\x83\xef=2\u007f\x19\x18Jf\x87\xb8U\xefT\xe7Av\xbb\x90`\xeb\xe65\x8aS3\x14'\xa6\x0f-\xa0\x8f\x1c\x8d\xe3K\x12\xc3\xc5\"\x95J\xe5\xc0w\xc5b1F\xc6\xc6)\xed\xef\xf5#G\xdf\xf7P\xe2)\xd4T\xee\xae\xc2\bxa\x16\xa5\xcd5꽳Cl;8\xea\xef\b\xf8\xfcNf\xe9pc\xd0\xdb\xedF!\x04\x8ai\x18\a\x9c\fw\xe8\xdd\xeeEar\x18\xdaJ=\n~X\xfb\xb8[\x1b\xc0\x81\xcf\x19\b\x1e\x06o\xd8q\x1cd\xdf\xc7\r\x03\x80\x1e\x17\xca\xc7go}\x05So3\xb5p\xfavb\xb5\x16\xa30=\x87\u007f\xe5J\u007f0\u007f@Z\xd0\xc8凨\x94\xf6\x83\x9c&|@9\x9680\xd1稝\xd1i5\xd9\\\xbcN\xa7ݢk\x9aGf\xd4\xf7\"\x88\xc1\x16\xbb{\x11\x86i\x1a\xfc\xff\x12\x99i\xb6\xc1۬\xda\x00\x00\x00\x00IEND\xaeB`\x82")
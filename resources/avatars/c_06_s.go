@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_06_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1d6\r\x1f\x002\x00\x00 \x00IDATx\xda\xec\xbdy\xace\xd7u\xe6\xf7\xdb{\x9f\xf9\xdc\xf9\xde7\x0f\xf5j`\x15k %\x92\x1am\x89\x92,\v\x92-\xab=#\xb2\x1c;\t:\xb0;6`4\x1aI\x1c\xa4\x99\x04\xe8N\x90\x00\t\x82\xb6\xe1 qz\x88;\x8d\x96\xbb\xa5ȑ%\xb5lٲ,\x93\xb2&\xca$š\x06\xb2\xe6\xaaW\xf5\xe6\xfb\xee|ƽ\xf3ǹ\xef\xd6{\xac\xa2\xa8\x81j\x8b\x81\x0e\xf8@V\xf1\xbe;\xecu\xd6Z\xdf\xfaַօ\x1f^?\xbc~x\xfd\xf0\xfa\xe1\xf5\xc3\xebuz\x9d\x9c\x9e\xfd\xbe>\xfe\xf5v\x89\x1f\xa47szv>\xcc\xf3|\xca\x183c\x8c\x99\xd5\xc6Li\xad\xebRʛ\x96RO\x9e\xdf\\\xbf\xf8r\xe3\x9c\xdbX\xfb\xa1A\xbe[O\xd8\u007fx'\xa7g\u007fT\x1b\xfd\x1e\fGs\xa3\x0f\x1bm\xe6\x85 PRٶeٞ\xeb9\x95 \xb0=ǵ\xda\xfdn\xbc\xdd\xe9\xf4\xf3<\xdfRJ=\xae\xa4\xfcĹ\x8d\xb5?\xff\xa1\x87|\x97F85='r\xa3]\x8cY\x96R\xfe\xc7B\x88\xbfk)5]\x0e\xca\x04\x9eO\xc9\x0f\b\xfd\x00\xdf\xf1pl\a%\x15RB\xd9U\x18@\bI\x92el\xee\xeepcc\x9d\xcd\xdd6I\x96f\xc0\x1fi\xad\xff 7\xfa+JȑR*ya\xed\x96y={\x91\xf8~\x19\x02\xe0\xdc\xc6\x1a\x0f\xce-\xd9Q\x1a\xfd\xaamٿ\x19\xba\xfeC\xe5\xb0\xccT\xadI\xab\xda\xc0\xb2,,\x99\xa1d\x8e\x92\x19`ȴ$\xcf\x05Qj!\x04\x94\\\x85\x10\xc5۔R\xa2\xa4$ך\xedN\x87\xb5\xf66\xedn\x87A\x14\r\xfb\xd1\xf0\vq\x92~A\n\xf1\x82R\xea\xaac\xd9מ_[\x8d_o\xc6\xf9\xbez\xc8陹\xc3q\x9a\xfcn\xbdR\xfb\xa9\xe3KG\b\xbd\x10\xd7q\xc0\x18r\x03\xb5`\x84\xef\xa4X\xd2\x1cx'\xc6@/r\xe9\x0e],%\xf0\x1du\xd7\x1b\x15B \x85 \xcbsFqDo4\xa2\xdd\xeb\xb2\xd5\xedD\u06dd\xdd\xd5a\x14\xad*%_PR\xfd\xb5\x92\xf2\x89s\x1bk\xd7_\x0fF\x11\xafUX\xba\xc7\u07ff7\xd3\xf9G\x0fϭL\x1f_<\xc4\xfe8b\x80\xb2\x97P\r\xa2W|\xde\\Kn햑\x18\x02Wa\xc9W\u007f\xab\x06\x83@`\x8c\xa13\xe8skkӬno\xe6\xbd\xc1 G\x88?WR\xfe\xea\xd9\xf5\xdb\xed\x1fd\xa3\xbc\x06\x06\x99\vs\x9d}X\nyX\x1b\xbd*\x85\\\xb6,\xeb}\xb6\xb2\x1e9}x\x99\xfb\x97*(\x912\x88m\xda\x03\u007f\xf2{\xd5 \xa2\xec%\a\fu\xe7\xee7\fc\x87\xed\xbe\x8f\x00\\[\xe2Z\x92q\xe4\xc2\x18\xf3\xaao]\b\x81\x1a\x87\xb8a\x1c\xf1\xcc\xc5\x17Y\xdd\xda\xdcH\xb3\xf4?\xb2\x95\xfd\xc5\x17\xd6oE\xff\xbf0\xc8\xfe\xbb\xebxk\xfa\xcd\xc6\xe8\u007f<S\x9fz\u007f\xad\\!\xc92,\xa5(\xf9!\x8dr\x89\xe5\xa9\fA\x8e\x01\xd2\\\xb1\xd5\rЦxIKj\xaaa\x8cg\xa7H1\x0eY\x06\xb4\x11D\x89\xcd\xee\xd0#\xd7\xc5c\x1d%\xf1\x1c\tB 0\x18\xf3\x1d~H!\x10B\xb0\xba\xb5\xc1\x85\xeb\xd7X\xdf\xd9\xfa\xa8c\xbb\xff\xc3\xf9͵\xb3?h\xf9E|\xb7Ƹ\u007fj\xe6\xbf\x13B\xfc\x83\x13\x87\x8eV\xe6\x1a\xd3(ea\x8c\x99$`[\xe5L\x95\xfb\bY\xdc\xf1\xbb\x03\x8f\xee\xd0er\xf2\x02\xa40(i&9D\xeb\"T\xe5Z\xb0\xdf\a\\K\xe2\xda\xf2{\xff\xb0B\x90\xa6)W\xd6o\xf1ܥ\x8b\xeb\x06\xfewK\xa9\x8f\x9e]\xbf\xfdҽ\x00\xc9\xeb\xc2C\ue7da]F\x98\x8f\xfb\x8e\xf7\x96\x87\x8e?@\xe8\x05h\xa3\xc7(\xc8 0\xe4\xba8<\xd7\xcep\xed\x9cal\x93\xe5\x12\xcf\xc9\xf0\xec\f\x80a\xe4\x90j\x89\x10\x06%5\x96\xd0X\x96F\tCn\x04Y.I3E\xa6%\xbe\xa3\xb0\xd5k\x87?\x84\x10\xec\xf6{|\xf3\xd2K\xb4{]\x8c1\xb7\x04\xfc[m\xcc\x1f\x1ac\xbeiYV\xfar\xf8\xfc\xb7j\x90\xfd\x9e\xf0\xa3\xcb\xc7\xc4\xf6\xa8\xd7ʵ^\xd2F?\xe2\xbb\xde\xff8ߚi\x9eX>6\x0e1z\x92\x13*~\x8c\x10\xb0\xd9\r\x18&\xf68\xde\v<;\xa3\x1e\x8ep\xec\x1cc\n\x0f1F\xb0\xd1\rQ\xc2`[9\xb6\xd28V\x86\x14\x06\xad\xc1\xb2\x04\x95\x8a\x83\xb2m\xd2̦ߗt:\x1a\xa4xM\xa0\xa1\x14\x02˲\x88\x92\x84\xf5\xf66k;۴\xfb=\x06\xd1h=\x8a\xe2ߺ\xb8\xb3\xf5\xb1\xbf\ro\x11\xdf\xca\x18'\xa6f\xfe\x9b$K\xdfbI5]\x0eJG\x1a\x95jk\xb61M\xbd\\\x9b\x18\xc2\x18A%\x88\xa9\x06#\x8c\x11\ba\xe8\x8d<v\a\x1eB\x18\xa40\xcc\xd6\xfaHa&\t\\\x8c\u007fo\xa3\x1b`)\x8d0\x19\xbe\x93\xe1\xb9E~(\x95\x1dN\x9cl\xd0hzض\x04c\x18\x0esn\xdcLx\xee\xd9\x01\xb9\xb6'\t\xfe\xb5\xb8\xa4\x94\b!\x18%1[\xbd\x0eϽ\xf8\xe2\xa87\x1a\xfe\xd7/mo\xfe\x93\u007f\xdfF\x11\xaf\x1c\x9af>\xee9\xeeϟ>|B\x04\xbe\x8f\xad\xac\xe2\xe1\xa2\bG\xd6\xf8\x80\xd3\\2[\xeb\xe3X\xd9\x04Ү\xb5+\x93\xc4+\x04,4:\x93d.\xa5!I\x15\x9d\xa1Gn\x04\x81\x93\xf3ַV\xd0Y\xc6\xd9\xe7wPJp\xea\x81&K\xcb\x15\xb4\xbe;j\\\xbf\xda\xe3\xb9g\xbbę\x8f\xd6\xea\xfbr(\xbdѐ/=\xfb\fq\x9a\xfcι\x8d\xb5\xbf\xff\xef3\xe9\xab\xfd\x9e\xb15\xe8s\xa25\x1d\xb6\xc2\xd2?-\a\xe1G~\xe4\x817\v\xdf\xf5PRa)\xf0\xbd\x9cF\x18\xd1(ET\x83\x98J\x18\xa3\x8d`\x14\xdbxN\x866\x92\xadn\x88\xd6\a\xed<J\xec\xe2\x0eL-ڃ\x80\xde\xc8A\bp\xad\x98\xf7\xbd\xaf\xc1ԔK\xa3\xe91\x18d\xf4{)\xf3\xf3%\xca\x15\xe7\x9eh\xaa\\q\xd8Z\xef`\xb2\x18\x8dD\x9b\xd7\xde(\xbe\xeb\xb2<3\xc3\xea\xd6\xe6۪\x8e{\xb8\x15\x96\xfe\xf4\xfc\xe6z\xb6wF\xdfw\x83L8\xa7\x99\xb9\x921\xe6\xff\x9c\xaa7\u007f\xe5\xa1\xfb\x1e\x98 \xa6\x92\x9f\xd2,\x8f(\xb9\tJ\x9a\xb1'\b@\x90\xe7\x82A\xec\xd0\x1f\xb9\f\x13g\x9c#\x0e^\xdaHF\xa9M\x9cY(\xa9\x99nj\x8e\xadH\xd2h\x04\x06,K\x91$9\xab7\xfaD\xa3\x8c(ʩT]\xc2ОT\xee{\xde&\x84\xe0Ƶ\x1eY\x96#\xa5&\xcb\x14 _\xd3C1\xc6`[6\x87\xa6g\xe9\f\xfbo\xec\f\x06\xf7O\x97\xca_?\xbf\xb9\xde\xd9\u007f\xf3~_\f\xb2g\x8c\a\xe6\x16\xeai\x9a~n\xb69\xf3\x13\xa7\x8f\u070f\x94\nc\x04\x8e\xadi\x84\x11R\x9a\xb1\x11^Fq\x8c\\2-\x91\xd2\xd0,\x8f\b\xbd\x84a\xec\xdc\xf58!\f\xa13\xa2\xecE,-8,-\x87\xf4\xbb\x19\x83a\xc6\xed\xd5\x01\u05ef\xf5H\xa2\x1c!\x05\xa3a\xc6\xc6\xfa\x80\xdd\xdd\x18?\xb0\xf1}\v\xcbVd\xb9\xe1\xe2\x856\x1b\xebC\xde\xf4\xd69\x0e\x1f)\x13G#\xfa}\xd0F\xdd;\xaf\x18І\xef8\xe7\x18cPR2\xd7ha\x8c9\xb5\xd1\xd9\xfd\xb9\x99r\xa5\xb75\xe8?\xbd5\xe8\u007fߌ\"\xc6\x16\x9f\xcfu\xfe\xd9\xf9\xd6\xec\x1b\x1e<z\nǊ\xa9\x97\"\xd2L\xb1\xdd\xf5\x99\xae\x0e\xb1\xac\xfc\xae_\x8eS\xc5v7@#P\xd20]\xed\x15\xbf\xd3\v\x0ed\xa8\xd0KY\x98ќ:U\xc3\xf5\x15O}}\x93<\u05fc\xf9ms\x94+\x0e:\x87^/\xe6\xcbO\xdcB\b\xf0\x03\x8b\xd10#\xcf5ƀ\xb2$\x8e\xa3\x88\xe3\f\x9d\x1b\\\xcf\xe2\xc7\xdf\u007f\xa8\xc8)\u05fa\x9c{~\x8b$s\x88\x92\xf0\x80\x87\x1a\x03\x9e/\xa8\xd7\x05\x9b\x9b\x86<\xfb\ue42c\x92\x92\x8dv\x9b\xaf\xbc\xf0,i\x9e\xfd\x91\x10\xe2?\x05\xda\xe76\xd6^\xf3ܢ\ue7da9&\x84\xf8\xd8\xf2\xcc\xe2#\x0f\x1c\xb9\x1f!R\xa6*#\xa40XJӋ\x1d4\x02Gi\x8c\x11dZ\x12g\x16\x83\xc8f\x10;T\x83\x98Z\x18\x91\xe6\x92\xce\xc0g\x98\xd8\x18@\t\x83\xe7fLUF̴\xe0\xcdo\x9f\xc1\xf3-\xa4\x10\x8c\x06\x19+G\xaa\xd4\x1b\x1e\xba\x00k8\xae\xe2\xfa\xb5.\x96-y\u05cf-q\xecx\x9dz\xc3C)\x89\xce4\xa3a6\xb9\x87\x92$\x9f\x84\xd3\x1b\xd7{\f\a\x19B\xe8\xa2ޔ\x8a\xf9y\x8bFS1\x1c\x18\x1aM\xc1\U0010c8bd\xa3ɒ\xc2S\x8c\xb9\xf3\xef\xbb=\xf9\xde\xdeR\n\x02\x8e-,1\x8c\xa3\x93\xfd\xd1\xe8\x97\xd3,\xbb8_\xad]?\xbb~\xfb5\xcd-\xaa\x19\x84\u007fvd\xfeЛ\x8f-\xae\xa0\x8dAȢ\xa0\x93\x02\xba\x91K\x9cX$\x99\"\xc9\x14\xb9\x96dZ\x92e\x92ab\x13\xb8\x19\xd50F\x8e\xab\xee\xfe\xc8\x05a\bݔj\x18S\xf2\x12@S\xady\xcc/\x940\xe3\xf0\xd1l\x06\xd4\xea.{ \xca\x18p]E\xa7\x1d\xa3\xb5\xe1\xf0\x91*:\x87\xb0l3?_\xa2\xd9\xf2\xb9~\xb5\x87\x10\x820\xb41\x1an\xad\xf6\xb8\xb5:`4H\vj\x041~-\xc5\xf2a\x9f0\x14\xe49ܺ\xa9IS\bCA\xb3%\xa97$\xae+\xe9\xf7\rժ`jZQ\xadI\xaaUI\x10\b\xfa}\xf3\x8aF\x91B\xb0К\xa6Z*W\x06\xa3\xd1/\xf5Gù\xd9J\xf5/\xcen\xac\xa5\xaf\x95\x87X'\x0e\xddwfyf~\x9c\xa6\rF\xc3v\xcf\a\xc48\xf6\x1ajAL\xe8dE\b\x12\xc5)V\xb4`\xbb\xef\x93f\nc`\xbb\xef\xe1\xbb)\xf5R\x8c\x149`h\xb6|\x8e\x1c\xadQ\xad\xbb(%\xb1-\x81\x94\x05\xaf\xb4W\xb3\xe4&\xc7\xe4\xba \f%\xcc΅8\xaeB\bM\x96\x19\xb2LS\xa9:\x94+6\x83A\xca\x03o\x9c\"\bm6և\xbct~\x87\xe10\x1bCE\x83\x94\x86Qo\xc8ͫ\x06!]67\xa0ސ\xac\x1cV\a\xee\xfer\x05<\x1fj5\x89R\xfb+\xf8\x82\xbeY_\xd7Hy\xcft\x841\x86\x99z\x83\xe9Z\x83\xcf=\xf9\xe5\x9f\x1b\x8cF\xff7\xf0\xf8kf\x90C\xb3\xf3\x85\x15\x0e\xdc\r\xc5;\xf7\xec\x8cF9.\x8a:\xf3\xf2\xb8Z\xf0P\xb7\xdb!\xb6\xd24K\x11\x81\x97\xa3,\xc5\xdc|\x85\xa3Ǫx\xbe]<\x97\xd9#\xc7\v\xf2P\"\xc6\xcf'\x91\x02\x8c(N\xab\xdbN8\xf3@\x8b4\xd1\x05,v%YV\xfcz\xad\xe6\x11G\x9aZ\xdd\xc5\x18X\\*\xb1\xb4\\\xe6¹\x1d\xae^\ue835A\n\r\b\x86\xbd\x11i\x1e\x81vY:T\x9a\x1c\xf6\x81Ϩ\xf7P\xdb\xc1Х,\xbe-\xf2RJ\xc1l\xa3U?\u007f\xfd\xea\xa9\xd7\xd4 \xf5p\x88\x92\x86~d\x13%֘V0TÄ\xd0M\xd0F\xbc\xe2\x1btT\x8e\x1fƔ\x02M\xa3\xe11=[+\xeepG\x92e\x9a8\xce0\xc6샭\xa28\x84\xc9\t\x15M&!aw'\xa2\\\xb5\xf1<\x8b<\xd7H)\xc8\U000e2fe1\xb5\xc6\x0f,\xca\x15\x1b\xdb. \xb21\xc5\xddz\xe6\xc1\x16\xd1(\xe3\xe6\x8d\x1eR\nl[\xf2\x8ew-\xd0\xd9Mx\xe6\xa9\r\xa2\xa1C\xb9\xe6M\xf0a\x9a\x1a\xdam\xcd\xcd\xeb9\xa3\x91\xa1^\x17Xv\xf1\x19\xa3\x11lm\x15ޱ\x1fj\xbf\x12\n\x9b\xaaոp\x9d7\x9e\x9a\x99\xb3Ϯ\xdf~M\u0096\x15\xb8٘\x9dլ%\x16\bC5\x8c\t\x9clR]\xdf\xfb\rA\xe8&,-\x85,.W\bC\aeK\xf2,'N\xf2\x03\xb4\xb7\x94\a\u007f\x04\x86,\xd7d\x99&\xd3\x06\x89\xc4\x18\xc3}'\xea\f\x06)J\xed\xb5k\x8b\xb0f\fx\x9e\xc5\xdc|\b\x18l\xdb\"\xcb2\x8c\x81,\xd34\xa7|:\xbb1ӳ!\xb7n\xf6\bC\x1b\xd7U\xcc\xce\x05\xdcZ\xed\xd1iGH)\x19\xc5\x0eá*\xbc\xce\xc0\xed[\x9a\xcd\r\xb0\xed\xe2\xe0\xd3\x14\xf2\x1c\xfc@\u0e90\xa50\x1c\x9aW4L9\b\xf1=\xef\x91,\xcbJ'\xa7gۯ\x05ڲ\xf6\xee\x04)\fF\x80gk\x027\xbdg\x81\xb7\xdf\x18\xe5\xb2\xcd\xe9\aZT\xaa\x0eZk\x8c)\x0e\x18@\xa9\xe2\xeeo\xb7\x87\xec\xb6Gt:\x11\xddnL\xb7\x13\xd3\xe9g\b\xe9К\xaepd\xa5\xcc촋\xef\x17P\xb7\x88\xe1\x86,\x03!rR\x04J\t\x94\x92,,\x95\xb1,\x89\xd6\x1a\xa5\x04\x96\xe5\x90\xc4)R\n\xb67G\xdc\u007f\xbaI\xa3鱽5\xe4¹\x1d\x96\x0f\x95\x89F9F\xe7\f\xfb\x05B\xac5\x04K\x87\\l\xbb\xf8\f\xfd\xbe&M\xa0\xd73\xb4w4Z\xc3\xd1c\x8afKN<$\x8a\xe0\xd2K\x19q|w\x98pm\x87\x8a\x1f\xbea\xa3\xb3S\xbe\xb4\xb5\xd1~M<d\xaf\\\x883\x05F\xe0X\xf9^\xc7\xe2.#H)(\x97mV\x8eT\x99\x9b\x0fɵ\xc6`H\xb3\x9ch\x941\x18$ܺ5\xe0ŗv\xb8|\xa9\xcd\xcenF\x8e\x8f\xb0<\xa4\xe5\"\x95\x8d\x14\x0e\bA~vH\x9e\xf7\xb1-\b\x02A\xb5\x02\xd2ͩ\xd6`e\xa9\xc2\xc2LH\xbd\xecP\xaf8\xd8vA\xfe\x99q{6\xcb5&\xd7hc\x88\x869\x96%\x99\x9d\x0fљ\xe1mo\x9f\xe7\xf1/\xde\xe0\x85緱m\x89\x94E\x1d\xb3r\xbcU<\x87\xbes\xc7W*E枞\x81ή\xe4\xc2\xf9\x8cjM\x8e\xc3a\xf1\xb9=\x0f\x8e\x1d\xb78\u007f6%\xcfAJ\xee@u\xcb\"\xf4=[u\xe5\xc3\xc0\xf5\xd7\xcc \x1a菜\xa2u\x9a\xd8(\xa9\xf1켨\xce\r\xe8\xdc\xd0hz\xcc/\x86L\xcf\x048\x8e\x04i\xe8u\".\x9c[\xe7ʕ]\xd665\xabk\x19\xc3H\x12\x84%\xa6f\x8e\xf2\x86\xe3!\xb5\x8a\x83\xe7*ĘV\xd7\xda`\xc6h\xaeӍy\xf6\xec6q,\xd8\u0602\xadސ\xf5^\x9f\xb5\xdee\xa4\xcc9<W\xe1\xcc}5\x1e}d\x86w\xbfy\x8e\x92k\xd1O\f\x86\xbc\xa89\x84@\xe7\x9a\xd3\x0f6ѹ\xc1\x18\x83\xe3*\x0e\x1f\xa9\xf2ܳ[X\x96\x18{\xac,r\xc8˒\xe1\xde\x1f\xb3\fj\r\x89\xeb\t._\xccY\\\x96\x84\xa1\x98\x84)gL<\xd4\xeb\x82fK1\x8a\f\xab7r\x94RT\xc2\x12\x96e}\x10\xf8\xe4kQ$Z\x02\xe8\x8d\x1c\xe21|\x15\x80\xa5\nV6\xcf5A`s\xfcd\x9dZ\xddŲ$\x96%Y_\xef\xf1\xe4\xd7op\xe1\xfc6[\x1d\x97D\x87\xac\xac4\xf8\xe0\a\xa7\x98\x9f+\xd1j\xf8x\x9eBJ\x81\xa5Ĥ\x88SR\xe0\xd8\x05Ό\x93\x9c(\xcdx\xcb\xc33|\xfe\xafnr\xe5Z\x8f\xb9Z\x85\x99Z\x85S\xf9\f\xed\xe1\x80K\x9b\x9b|r\xf5\x1a\x9f\xff\xdamZ\xb5s\xfc컗\xf9\x85\xf7-Q\xa9x\xe4\xbax\xafAh!ƨ'\x1f\x87<\xc7U\xd4\x1b\x1e\xf7\x9fj\xf2\xcc߬\x93\xa59k7;L\xcfW\x0f$\xec;\x88\t\x06}M\x9a\x18\xa2\x91!\xb9d\b|A\x96\x175I\x12\x17\xf9\xa5R\x95\xd4\x1b\x82\xba\x90\xdc^\xcd1\xc6\xd0(WPR~\xf05\xa3N~\xfbg~z4J\x95\xa7\x94\xa1\x16&\x04N\nBR\xa9:,\xad\x94i\xb5\naB\x9e\x1b\x06\x83\x98/~\xe12\xcf={\x9b(\xf5q\xab\xf3\xfcأ+\xbc\xf9\xe1i\xca%\x9b,-\xc2H\xf1\x81ŁD(\x85\xa0\x14\xd8T|w\x92c\xd2,g\x18g$YƓOo\xf0\xc9?\xb9B\x14e\x93\x03SRr\xa3\xbd\xc3K\x9b\xeb\xe4\xb9&\xcd\rS\x15\x87\xdf\xfc\xf0\t~\xe4\xa1Y\xea\xd5«-%1c\xef\xb3l\xc9_?\xbe\xcaCo\x9a\xc1\xf7\x15q\x9c\xf3ԓ\x1btv#,ۢ1\x1d\x12\x96\xdcIL6\xc0\xb0\x9fp\xf5\xaa\xa1Z\xb3X\\TX\xe3\x1c\x93\xa4\xb0\xbd\xa9Y_\xd7d\xa9AJ\x98[P\x8c\x86\x86\x9d\xed\"neyΟ}\xe3\xab$i\xfaй\x8d\xb5g\xbeW/\x11\xff\xc5\xcf\xfc\xcc(p3ϳ\x13\xa4\x144\x9a\x1e\x87\x8fVi4=\x8c\x81\xd1(ek\xab\xcfŗv\xf8\xd2\xe3W\xe8tFLM7\xa9L\xcdQ\xab\xda,-\x04\xe4\xba0\x82\x92\x02۶\xf0]\x87J٧V\v(\x97},\xcbBH\xa8\x86.\xe5\xc0a\xafa.\x84\x18'qM\xaasv:\x11\x9f\xf9\xb3k|\xe3\xe9uFQ^$t)\xd9\x1d\ryq}\x8d\xf6p\b\x062\x93\xd3,\x85\xbc\xf1\xbe\x06o\xbc\xbf\xc1\xcaB\x89\x85\x19\x8f\xa5\xb9\x00\xc7\x16\x88\xb1\x1bdyqꖒ\xac\xde\xecs\xfdZ\x97\x9d\xad\x11BJ\xacq\x8f>Ks\xd24gf\xa1Jc*@\xe7w{O\x9e\xc3\xcd\x1b9\x1b\xeb\x9aRY\xb0|H\x91\xc6p\xe3FN\x9eI\xce_\xbf³\x97_zѵ\x9cG\x9e[[\xed\u007f/FQ?\xf9\xd0\xf2c咰\x16\x96\xea\xac\x1c\xa9\xb2\xbcR\xa6Zu\xb8v\xad\xcf\x13\u007fu\x95/=q\x85o>\xb3\xc1\xd5+\x03\xba\xdd>\x95r\xc0\xe9\a\x0f\xd3h\xda8\xb6a0L\x88\xa2\x848\xce\x18E\t\x83AD\xbb3`{\xbbGggH\xaf;B\x1bP\x96B\x8c\xa1\xace\xa91\xfc-<G\x8e\xe1\xb1k[\x1c;R\xe1\xd0r\x85n7as;\x02\x01\xbem3]\xae\x80\x81\x9d\xd1\x00[)\xa24\xe3\xc6F\x9fs\x97wy\xe6|\x9b\x8bW\xfbl\xb73\xea5\x97\xc59\u007fR?\x19c\xd0\xdaP.;\xb4\xa6|\x9a-\x1f\xad5\xed\x9dh\x82\n-K\xd2h\x95PJ\xde\x13Q\n\t\xf5\xba$\x8e\n\x0497/\tK\x05\xcd\xd2\xefkf\xeauv\xfb\x83\xe6fg7hG\xa3\xcf}/\xbc\x96\xfa\x87\xbf\xf1\xdeǎ\x9f\x9a\xb2\xa6gB|O\xb0\xb1\x16\xf1\xc4\xe3k\x9c{a\x97\x9d\x9d\x18K\xf9T+U\x924e0\x18\"\x04\f\xfb1\xc3a\x8c\xa0\xb8ӊd\x9d\xa3\xc7\a D\xc1Y\r\xe3\x84\xd1(as\xbd˭\xeb;\f\x87\tn\xe8\x12e9\xb6%\xb1\xc7\aP\xa8\x10\v$\xe5\xb9\x16A`\xf1\xf0\x1b\xa7x\xfa\xd9\r\x06\xc3l\xacP\x11Ԃ\x80a\x920\x88c\xe48\x1e&\x99\xa6;L\xb8\xbe\xde\xe3\xf9K;\xbcp\xa1K44\x9c:V-P\x96\x10\xa4\x99\x99(b<_15\x150;\x17\xd2\xe9$\xe3\x10i\x90J\x10\x96\xddo\t\xf5\x1dGp\xfdZΠ\x0f[[\x05T.\x9c\xd10\xdbhrsc큪\xeb=\xb1=\x1c|\u05c8K}\xe4\xa7?\xf0حvݺ\xba5\xcb3ϥ<\xf5\xa5g'\x98\xdbq,\x94RH\xa5\xd8\xda\xda!I\x12\x8c1DQB\xb7\xd3gc\xad\xcd\xd6\xfa.Y\x9a\xe3\x85.R\x8a1=bƹĐ\xe4\x19\xb9.\x98\u061d\xed>\x1b\xeb\x1d\xaa\xf5\x90\xcc\x18J\xbeS \xa0q\x05o)\x89\xeb(\xeae\x9f8ɩ\xd4m\x9eyv\r\x90\x93\"s\x90Ĵ\x87\xc3\tP\xd8\u007f%\x99\xe6\xc6f\x9f\xaf?\xbf\xc5\xdf<\xd7faʧ^s(\x97lҴ\xa0\xf2\x19sZ\xb6\xa3X9\\\xc3\xf5\x14\xbdnBw7\"\xcf\f^`O\xf8\xb6=je\xef\xa5:\x1dC\xa7c\x90\n\x94\x10\xf8\xbe\xc0q\xc6,\xa0\x11\x04\x8e\xeb\xac\xedl\x9fj\x05\xe1\x1fn\r\a\xe9w\xc3\x02\xab\x93g~\xe5\xb1Ψdũ\x8d\xf2\xcbD\xeb\xe7\xee\xfa\xb0RJ\xda\xed]Fq\x8a\xe7\xba\xcc̶\xa8\xd7\xeb\xd4\xebU\xc2RH\x9e\x1a\x92(C \x10r\xfc#\xf6\xc8rQ\xd4*:GHHҔ\xed\xcd\x1e\xaekc{\x0e%\xff\xce\x01\xecy\x8bmI\xaa\xa1KXv\xe9\x8eF\\\xbb\xd6A\x88B\xdfۏcv\x06\x83{V\xceb\x8c\xe4rm\xb8\xb2\xde\xe53_\xbc\xc9\xf9+\x1d\\Wq\xfaX\x13m \xcb\f\x14\xff\xa0\xb5\xa1Vs\x99\x9e\t\xc1\b6\xd6\xfat;#\xb2T\x93\xe7\x9a8ʈF9\xfd\x01\xb4w\f\xebk\x9a\x95#\x16ˇ\x14\xadiI\xb3%iMI\xea\xf5\"<\x8a<\xa03\xe8/\xf6\xa3Q\xb05\xe8\u007fn*,}\xe7\x06\xf9\xc0{>\xf4\x98\x14XB\x80P\x16ٰC:h#\x84<`\x90\x9dN\x87\xce0\xa5լS.\x97\nZC\n\xa4\x94؎\x8dR\x8a$ʈ\x86i\x81z\xf2\"\f\bĤQUx\x8d&\xc9r\xb67{t\xfb\x03j\xf5\x12%\xcfE\xee\xbb\x13\r`[\x8aZ\xe82\xd5*\xf1\xec\xb9\xdb\f\x06\x1a!$\xa3$\xfd\x96\x1f\xd2\xe8\x9c\xdc$XB\xa1\x1c\x87\x97nl\xf0ē[\\\xb8\xd6\xe1G\x1f\x9a\xc6u%y\xb6׀.\x8c\xa2\x94\xa0\xd6\xf0ḯl\xdc.\xda\xc8Y\x9a3\xd5ri6\x1ct\xa6\xb9r%\xa7\\V\x1cZ)B\xf4^\xf1h\fH%(\x97%\x02A`\u05f8|\xfb\xe6\xdbZA\xe9ҹ\x8d\xb5g\xbfS/Q\xef{ׇ\x1e\xdb+\x101\x06\xe5\xf8\f7.\x1f\xf0\x12%%\xeb;\x1d\xd6\a\t\xe5 @\xba\x01\xb9\x91X\xf2`S\xb7\xa0\xd5\xc1䐧\x864α\x1c\x89\xd8'\x94\xde\viI\x9e\xb1\xdd\x1epsu\x87\xd9\xd9:\x81oO\xf2\xc2$f[\x8a\x99f@\x9ci\x9e;\xbb\rF\x90k\xcdf\xbf\x87~\x05Ƴ\xbax\x94\x13\x1f\xf8\b'>\xf8\x1f\xb2\xf0\xa6\xf70u\xecAvn\\\xe2\xf9\xb3\xab|\xe2/npb\xa5\xc6l\xcbE\x8a;\xf9kO\xa0mY\x16\xd33%:\x9d\x84\xa3\xf7U\x99\x9e\xf6q\\E\xb3\xe5P\nRn\xdfNiMۯ \xbe\x03\xa9`طh\x94\xaa\\\xbe}\xf3GfʕO\x9d\xdbX\xdb\xf9N\x8cr\xd0 \x80t<t2\x1a{I\x81\x80\x94\x94\xf4\x06#nv\x86\x84\x95\x1a\xd2\U00049324\x10\x13J@\xa0\x84\x99\b\x9c\xf7\x8eU\xe7\x06۱(\xd7}\xea\xad\x12\x8d\xe9\x12^\xe0L\f\x94\xa59Q\x9cp\xf3V\x9b\x99\xe9\x1a\xa1\xef\xec\v_\x06)%R\n\x1e83G\xaf7\xe0\xf9\v\xbb8\x96b\xbd\xdb!\xcd\xefn)7\x8f\x9d\xe1\xcc/\xfc\x1as\x0f\xbd\x83\xea\xc2\n\xd5\xc5c\x04\xcd\x19ʳK\x8c6o\xb1\xb3\xb6Ɵ<\xb1J\x92k\x96\xe7*\x84\xbe5\x89u\xc5\xebj\x84T\xb8\x9eE\x1ce\xf8\xbe5\xa9\x99\x82\x92͍+\x1bD#A\x10\xda\x13\x86x\xbfA\xfa=C{'\xa7\x1c\x84DiV\xda\xea\xb4\xe7fʕO\x01\xf9wm\x10!\x14nm\x06\xad#\xba\xbb\x9blv\xfblv\xfb\xb4\xa3\x8cN\u007f\x84\xebz\x94°pw#\xb0\x84F\x8a\x82&\x97\xe2\xa0\xd0\xcb\x18\xa86J\xbc\xebC\xa7X>:\xc5\xe2J\x93\x85\x95&s\xcb\rf\x17\x1b\x04%\x8f\xf5\xd56q\x92r{\xadC\xb3U%\xf0\xecIuo[\n%\x05&Ӽ\xf9\x8d\xf3\\\xb8\xda\xe6\xe6\x8d>\x8em\xb1\xd6\xeb\x1c\xf0(!%3g\xde\xcc\xc2Ïby\x1e\x95\x85%\x96O\x97\t\xa6\xe7IF9ɠG\xef\xfaK\xe8<\xe7\xdc\xe5\x0e\x97ov94Wb\xba\xee\x8dy\xb2=\xc5|ѽ\x8c\x13M{7a8\xc8\x19\f2\xba\x9d\x14\x83`Ѝh\xb73\xb2\xdc\xc6\xf5%\xf6\xd8a\xb6\xb647o\xe6\xe49\x18mpJ\r\xba\xa3\xe1\xa9\xe1\xa0\u007f\xfb\xc2\xd6Ɠ߮\x97\xdc\xc3 \x82\x9d\x9d[|\xe5\xeb_\xe4\xd2\xea:[\xbd\x01\xbb\x83\x88(+F\a2\r\xf5J\xb9(\xea\x10`\x04\xae\xd4\x05!)\xc4\xcb\x049\x85L職/\x15\xea\x8fq\x019\x8cR\xe6\xe7+ԧK\x98ܰ\xb1\xda!N3.^^\xa3\\\r)\x85\x1eՒ\xb7\x0f\x16\x17\xb1\xee\xf8\xd1\x06_y\xfa666\xfd8\xa6\xb7\x0f\xfe\n\x01ե\xfb\x989\xf3\x16\xa4\xed\xd0Z\xaes\xf4AM\xad\x95\xb3\xb3\xddb\xfb\xc5oҾ\xf6\"y<\xc2\x18X\xdd\x18\xf2\x85'o\xe3ؒC\xf3EN\x94cd\xa5\x94\xc0\xf3\x14Z\v\x86QF\xb9l\xd3l\xb8\xcc\xcc\x04,\x1d*a\xd9p\xfdꈵے\xcd\r\xcdښ\xa6\xd73\be\xb0l\x81\x1d@\xa5\xa4hT|n\xacm~\xb0\xe5\x87\xff\xe6\xdc\xc6\xda\xf6\xb7c\x14\xf5\xbew\xfd\x9d\x03\x06\xd9\xde\\\xe5O?\xfd\adiD\xa3\xe92\xbf\x182\xb7\xe0\x93\xe7\x86\xe1(\xa7?LhV\xabXV\xc1Ie\b\x94\x10\xd8\xe2\x0e\x8b*\xf6\xb9q\x12e\x1c\xbao\n?t(\x85\x0e\x1f\xfe\xb5O\xf0{\xff\xf2I>\xff\xf8Ut\xae9uz\x8e\xfe\xee\x888Jɵ\xe6\xea\xf5M,\xc7f\xa6U\xc5w\xac\x83\xf9\xa1\xec\xe0\x87\x0eO=\xbb\xceTX\xa6=\x18\x10e٘8\xdc\xf3\x92\xb7`\a%\x94\xa5\x98>\x1c\x10G\x92\xebϮ\xb3\xfe\xc27غ\xf0\f:\xbb\xd3GJ\x12͗\xbe\xb9\xc1\xd3\xe7wX\x98\x0e\x98\x9b\xf2\xd9S\x0eK\x01\xaeS\x14\xb1\xae[\xb4\x01\xf6ta\xf5\xba\xcb\xf2\xb2\xc7`\xa8\x19E`\xd9\x10T\xc0\v\x04ʞ4{\xa9V\x02\xb2,\xe7\xf6V\xfb'\xb7\x87\x83\xdf\xfd\xb6<\xe4ѷ\xbd\xeb1\xd7-[BH\xa4\xb2\xf8\xeb\xc7?I\x96vX9Z\xa5\\\xb1\x11c2\xaeR-\xb8\xaa\x9d\xdd\x18\xa9$\xb5ry22\x90\x98B\x06d\x8f\xe7<\xc4\xcbz\xa5\x96k\xb3p\xb8\x81\x92\x82\u007f\xf6ѧ\x91\b*e\x8fO\xff\xc5K|\xee\v\x97\x98\x9e\xabR\xf7\x14y\xae\xd1Zs{}\x97~\x942=U!ܣZƟ\xf3\xd0B\x99\\\x18\xae\xdd\xe8Q\xb5ClK\x11g)\xa34%no!\x95E\xe3\xc8)\xf2T\xb0}+\xe7\xfa\xf3\xbb\xdcz\xeaK\xdc\xfc\xfa\x17\xe8\xae^F\xca\xe2Fʵ&\xf0l\xdep\xb8E\xd9wx\xfc\xa9\r\xe6\xa7J,\xcf\x05\x13O6Ơ,A\x14\x1b\xa4\xd8\xeb\xf3\x14硔\xa0VWX\xae!(\v\x1cK\xe0\xd8ŏe\x15\xdd\xce,\xd3̴\xea<{\xfeje\xbaT\xfe\xf8֠\xbf\xf5\xaa\x06Yl\f\x1e\xeb\x0f֭a\u007f\x8d\xb5[Oq\xe1\xec9\xa6f\x02\x82@\x1dHZ\xc6@\x10X\xc4#\xcd\xe6N\x8f\xe9f\xf3@\fO\x8d\xc0\x16\xa08h\x14!\x05\xc3A\xcaʉiJ%\x87o<s\v߷\xf9\xbd\xff\xe9\x03\xfcț\x16\xf9\xc4g\xcfs\xfe\xe2\x0e\xbdQ\xca\xf1\xf92\xb9\x01\x9dk66;\xdc\\\xdben\xa6F\xa5\xecM\x8c\xa2\xac\x82ɝ\x9bu\xb9z\xbd\a\xa9\xc5|\xbdF\xc9qi\x8f\x86l\\>\xcb\xe6\vO\xb2}\xe99\xae~\xe9\xf3\\~\xfc3\xdc\xf8\xeb\xcfѹ~\x11D\x81\xa6\xb41\xccUk\x9c^\x98\xa3Q\n\bK\x92z\xc9\xe1\xfa\xad\x11\x8eT,LUH\xf3l\xe2\xe5\xc6\xc0p\xa4ɲ\x82\xdb\x12\xb2\xa8\xad\xa2Ȑ\xe7EQ\xa3,\x81m\x159H\x8a\xf1c4ض\xc5\xea\xfa\x8e\xe9\x0e\x86\x97vFï\xbdZ\xd8R'\x0f\xd7\x1f\x1b\r\xb7\xac~\xef\x16q\xb4\xcb\xf6VL\x18\xdax\xc1ݚ\xd9=\xb2\xaf\xbd\x1b\xd3\x1f\x8e\x98i6&\xf0\xd3 ȍ\xc0\x19C\xe1\xfd\x85[\x9ej\xfc\xb2K\xb9\x19pߑ\x06O=\xbf\xc6/\xfd\xec\x19\xa6\x9a%\xde\xfb\x8e\xc3|\xeaO.\xb0\xba5dw\x90pj\xb9:\x91\au{#\xce_Zcf\xaaF\xa3\x1aNz\x1ay\x9e\x83\x84\a\xcf4\x89\x93\x9c\xf5\x8d\x11\x15\xcfc\xb9\xd1D!\xd8\xdd^g\xe7\xdaE:7.\xd3_\xbbI2\xec\xa3M\x81\xda\xeaaș\x85EV\x1aM\x14\n\xe5f\bKO\xa0\xef\xf5\xdbC\xca~@\xab\xe9\x8c;E`)\x81\xe7J\xe2\xc4\x10Ņ!\x06#C\x92B\x9a\xe9\x89\xe8AYb\xa2\\\xd9\xdcN\xd8ng\xf8\xbe\xc4\x18-\xaf\\_\x1b\xec\xc6\xd1\xc7^5\x87\x9c963\xc9!B\b\xba\x9d\x04ۑ\x04\x81\x85\x94r\x9f\xa6\xb6HҶ-\x18\xf6s\x84\xc9\x10\xd2\xc2w\xbd;\a_\xd4\xeaE\x92\x17\a\x05\x01I\x9c3=_cn\xb6\x8c\xe7X\xccϖ\v\xa8Z\xf7\xb9\u007f\xa5\xc9W\xfe\xe6&76\x86\xdc\xdc\x1a2]\xf5\b}{\xdcBM\xf9ƳWH\xb5\xa6Y/\x91hM\x92\xe6\xc4Y\x01{\x1f8\xdddi\xa1\xc4\xcenD4ʩ\xb8\x01\x87\x1aM\x96\x9bM\x0e5[,\xd7\x1b\xccWk\x1cj49֚f\xbeRǒ\x12mr\x94\x9f\xe2\xf89\xbek\xe3\xdaE\v9׆+\xb7:4\xaa6\xad\xfaAn˶\nG\xb5l\xc9\xf6Nʋ\x97G\xbcty\x88\xe7Hfg\xec\xc2;\xa4\xe0\x85s\x03\xfe\xec\xaf6X߈\x11F\xb0\xb4P態7\xf3\xa9R\xf9\x93\xdb\xc3om\x91\x97\x19\x04F\xa3\x82w\xaa\xd6\x1c\xf2\\3\x1c\xa4\f\xfa)\xc3A\u00a0\x97\xd1\xed$XJ\x16\x02\x80h\x84\xe7\xed\x8d*\x14a*5E\xabu\x0fy\xed]\xd1 \xc1\xf6\\*\x8d\xc2K\x8aQ\xb7\x02\xcb/-\xd58q\xa4\xc9S\u07fcō\xf5>\x97\xd7\x06\x18\x03\x87f\xc21\xad/\xb9tu\x83\x97\xaen0\x18\xc4\xe4\xda -\v)!K5\x8d\xba\xcbC\x0f\xb4XZ,S\xaf\xba\xb8\x9eB)A\xa5\xe4R-\xbb\xd4+>\xb5\x8a\xc7\xdcl\xc0\xc9\xfb\xeb\x1c=Z\xe2ر\x12\xa7O4x\xf0\xc4,\x0f\x9f\x9e\xe7\xcc\xf1\x19N\x1e\x9b\xe6\xc4\xe1)\x1c\xcb\u2bde\\\xc5\xf7,\x96\xe7\x8a\xf6\xc2\xde\r\xeb{\x92\xb3\xe7\x87|\xf2O\xb7\xf9\x93\xaf]\xe1\xe9\x8b\xeb\\\xbf\x96\xf2\xb6\x87\x1b(\th\xc1\xbf\xfe\xf4U\xceo\xac2\xccb<\x11px\xc9\xe5\xd2\xf5u\x924\xff\xcc\xcehp\xebU[\xb8\xfb!\xafe\v\xb66\"\xda\xed!RZL\xb5j\x1c;\xb6@\xa3\x11\xa2,\xc1\xb9s7\xb8\xf8\xd2\xedBg\xa55Wn\xdd\xe0ġ#(q'\xc4\r\xb5DiII\xeaI\xe2\xd7\xda\xf0\xd237i\xcdW\x913\x01\x8e+\x11\xf6\xb8ǭ4ozd\x9e\u007f\xf9;?\xc3o\xfd\xb7\u007f΅K\xeb|\xe5\xdc\x16\xb7\xb6G\xfc\xfc;\x16\xc9r\x83c[\xb4w\a|\xed\xe9\xcb(%i5+\x9c\xba\u007f\x81V\xb3\x82\xd6\x05\xebzt\xa5\xc2\xe1\xe5J\xd1s7c\xce\xeae\x02\x01\xb5o4N\xca\"\xe6\x17\xe4\xe5\x9d(p\xf2\xe8\x14\xf33\x15>\xf1\xb9\xe79\xb6\x14R.Y\x93n\xea\xf6Nʗ\xbf\xde\xe3\xebW\xae0L\x120\x86\v\xeb\x1b\x8c\x06G\tK\x92\xc1\xa8x\xed(\xcb\xf03M\xe8C\x9a\x8cȴN\x04\xbc\xea\xe4\xaf:sl\xe61!\x84\xe5\xba.\xfd~\xcc\xea\xf5!'N,\xf1\xcb\x1fy??\xffs\xef\xe1\x9d\xef|\x03\x87\x0f\xcd\x11\x94<09\xb5z\x89+\x97\xd7\xc9\xf3\x1c%\x05I\xa4\xd9\x1d\xedЬ6\xd8?l\x96\x19\x89-4j\xac\xbf\x12B\x90\xc4\x19\xbdNDk\xae\x86T\xe2NH\x1cW\uebaf\xf8\xd9\x0f=\xc8ūmn\xdcܥ3H\xb9\xb2>\xe0\xf0L\tg\xdfЧֆ^?\xe2\xc6\xcd-FQB\xbd\x1abYj\"9\xb2\x94\x9c\xb4\x9b\x95\x1a\xffy\xfc#\xa4@I1ɇ{\xac\xee\xc1\xf1\t8\xb4Pe\xbaY\xe2\xcbO\xafr\xff\xe1\xf2$t_\xbf\x99\xf0\xe4\xb3=\xaelnL\xa8 \xdfv\xf8\x89w.\xb1\xdb\xcd\x18Ś#\v\x15\xea\xaa\xc2\xf1\x85:GW\x04Q\x92q\xee\xca\xda-!\xc5?\xdf\x1e\x0e:\xdf\xd2 o81\xff\x98\xe7y\x96\xe3ج\xde\xec\xb2rh\x91_\xff\xb5\x9f\xa6V-\x8f[\xb7\x85\xc4\xcfw=ff\xa6Y]m\xf3\xfc\xf3WXX.\x93\xc4\x05\xcb\xd6\xe9\xe5\f\xd2\x01\xb5re\xe2)\x1aȍ\xc4\x15\xf9\xf8\x03K\xa4T\f\xbb#F\xc3\f7tq=\vے\x13\x99g\xc1+\xe5\xbc\xf5M\x87\xb8y\xabǥ+\xdb\f\xe2\x9c\xcb\xeb}|עQqQ\x93\xaa\xba0\xcc\xd6v\x8f\x8d\xad\x0e\x96%\xf1=\aǱ\xef\x1a\xf0\xdc\xff#\xf7\xfd\xf7\xb7\xba\xf2\xdc0;Uf\x18i6w\xba\xcc4\xbd1\xd0ȹx\xb1\xe8\x05%Y\x86\xa3\x1c>\xf0\xd6\x15Z\r\x8f8\xcaH\xd3\fL\xc6t\v\x1ad\xe5\x92\x00\x00\x1c\xb0IDAT*\xa5\x1ca\xa0\x1f\xa5\\\xbc\xbey]I\xf9/\xb6\x86\xfdѷ4\xc8;\xdft\xf21%\x95\x15x\x1e\x9b\x1b\xbb\xfc\xf8{\xdf\xce\xccL\x83<\xd7\b)\x91RR\xadT\x98\x9f_\xa0R\xa9\xf0\x87\u007f\xf8Y\x82\x12\xcc.\x96\x89\x86)\x83~\x02\b\x06\x83\x94$K\xa8\x97+\x93|\x92#\xc8\x118B#0\b)\x11\xc2b\xd0\x19\x12\r2\x8c\xb0\tJ\x16\xb6\xa3&\x8d-\x04x\xae\xe0\xedoZfm}\xc4\xd9Kk\xe8\\py\xad\xcf\xfaN\xc4\xd2t\x80g\xdf\xd1\xeaJ)\x88\xa2\x84\xb5\xf5]\xd6\xd6w\xe9\xf5#,KQ\n=l\xbb\xf0\x1a\xbdO\xd6\xf3\xedL\x8c\xee=/\bV\xd7z|\xf9\xe9U\x0e\xcd\ad\x19\x94\xcb\x16\xedݜ^Ǣ\xe1\x97x\xf8D\x933\xc7KdY\x866\x9a$I\x19\x0ecҤ\x88 \xb7\xba#\x9e\xbe\xbeM6\x18^\x15R\xfc\x8b\xadA?}\xd5\x1cb)\x8b\xb7\xbf\xed-\xec\xb4\xfblou&ȨQ\xad\xd2l6\xb0m\x1bc\f\xcf=\u007f\x81\x8d\xad\r\xee\xbb\u007f\x1a4\xf8\xa1\r\bB_\x10\xa7\x9a\xed\x9d.:\xbf\xc9\xf1\xe5\xa5BI\x0f\xc4Z\xb2cl**\xc3\xd6yA\xe0\x19\xc9\xfa\xb5m\x92(#\xcbfY9V\xa5T\xb1\xc93\x83\xb2\v\xd8\xe8\xba\xf0\xd8?x;y\x9a\xf1\x85/_¶\x15W7\x06|쯮\xf3\xd3o_\xa4^\xb6\x0fHT\x8d1t\xbaC:\xdd!/\xbe\xb4\x8a\x92\x16\x95\xb2\xcf\xcct\x85Ņ&\xd5J\x80Tr\"m=\xe0 \xfb\xf2\x87\x14\x10E\x19;\xbb\x03\x06\x83\x88\x17/\\c\xaejs\xf5j\x8fj\xb5\xf0\xc0\xe3\xc7\x14A\xe0\"\x84K\xe0K\xb4\xceHSM\x1c%\xe4y!\xe4\xdb\xea\xc6|\xf1\xf9u6\xfb1\r\xdf\xe0H\xb1yvcm\xf8\xaa7\xc3o\xfd\xca\xfbF\xf7\x9f\xb8ϻ\xef\xe8an\u07baͿ\xfbw_\xe67\xfe\xde/\x12\xc7\t\xa7O\x9d<\xf0\xe0\xff\xe4\xef>FP\x16\xcc-T1ڰ\xdb\x1eq\xe9\xc2\x0e`\xd0\x06zÌ8ͩ\x84!ǖ\x16'\x86ܻ\x02\x99\xe3\xcbb\x16\x1da!\xb4\xc6\v\x1d\x96O\xceq\xdf\xe9\x16\xb5\xa6\xc7\x1eS\xaf\x94(\xfa)B\xf0O\xff\xd53|\xfas\x17\xd8\xde\x19\x8e\x0f\x15\xdeyf\x8aS\x87\xaa\x84\xae\x9a\xb4\x8c勵պ\x903\xb9\x8eM\xb5\x1aP\n=\xc2\xd0\xc3\xf3\xecI\xfeH\xd3b\xded8\x8a\xd9\xed\f\xe8vG\xf4\xfb\x11B\b\x1cGM\x8c\xae$d\xf9\x18\f\x8c\x8d[\xa86\v\x001\x8cs6;\x11_=\xbf\xcd\xe5\xb5>\x9e\xad\x90R\xb2X\x89r%\xf8\x85\xb3\x1bk\xaf\xaa\xddR\x1f\xfeл\x1f;q\xfc\xa8e[6\xcdf\x9d\v/\xbeD\xa3\xde \f=\xb41T+\x15l\xdb\xe2\xf1'\x9e\xe23\x9f}\x9c\x95#-V\x96\xe7\xa9W+\f\x861\xb7Ww\xf7\xe9\xae\f\xc2@\x9c\xa4t\xfa\x03j\xe52\x8eu\xa7\xe2O\x8c$6\x12m\x8a>b1\x8cc\xe8l\xf4\xd8ٌ\x10\xd2\xc2\v\x8a\x10\xa6\x8d!I4\x96%x\xc7[\x979sb\x1ae\t\xae\\\xdf%\x8aS\xaeo\x8c\xb8\xb61\xa0;\xcc\xc6\x1dF\ag̯\xb1\xcfs\xf6\x0e\x0f`\x14%\xecv\x06llv\xb8\xb5\xd6\xe6\xd6\xed\x1dVo\xed\xb0\xbeQ\x84\xbb\x9d\x9d\x1e\xc3a\x82\xd6\x1a\xcbRw\x89\x1e\xf4\x18i\x15\xf30\xc5O\x92iV7G<s\xb9\xcd\xd7\xceo\xf3\x8d\x17\xdb\xf4G\x19\x8e]0\xd5\x15/'\xb0\xf5\x95\xb3\x1bk\xbf\x01\xbc*\xb9h\x1d\xbb\xef\b\x9e\xe7M\x0e\xf5}?\xfe.\xbe\xf9\xcc9\xa6\xa6\x1e\xa1\xbdۡZ\xad0\xddj\xf0\x89?\xfa<\xe5\x92\xcf\xe2\xe2\x14\xc7\x0e\x1d²\x14\x1bk}\xb2,'\f\x1d\xe28G\nQ\xec$I2\xe2d\xc4\v\x17/33=\xcb\xf2t\x9d,\xcf'\xbbL\x86F\x11i\xb0\xb5\xc1\x93\x06\xc7\b\xb6o\r\xd8ݼ\xc9s\xbeOX\xf6\t\xaa\x0e\xae\xafh\xcd:\x1c>\x1e\xf2\xc0\x99\x16\xf7\x1d\xad\xf3K?w\x86?\xfe\xec\x05>\xf6\xe9s\xac\xee\f\xd8\xe9&\xbcpm\x97\xc0\xb5X\x9e\nY\x99\r94\x1d\xe0\xbbV\xe1\x1d\xdaL\xb4bB\x88\x03\xb0\xf7\xd5\xf2\x88@\x14R\xd4q\x8f'׆a\x9c\xb1֎\xb8\xb2\xd6g}7\xa67LI2M\x92\xea\t\xe1&1\xd4<\b\xac\x1c\xa5\f\xc6\xf0\x8f\xbe\xdd\xd1j\xf1\xf8\x1f\xfd\xeeHJ\xe9\x01LM\xb5\xb0-\x8b\xdf\xffg\xff\x96\x87\xdfx\x9cj\xb5\x8c\x94\x12c\x04\xff\xe5o\xff\x1es\x8be~\xee＃\x95\xa5E\xea\xb5*\xff\xd5?\xfc}\x94eѨ\x05\\\xbc|\x9b$I\v\xe9\xa96dYN\xa6\x05\xa3\xd4\x10\xe1\xf3\xc6#s\xd4J\xfe+\xbb\xaa\x00O\xfa\x04\x96\x8f\x14\x0eB(\xbc@b{\x85>w\xe9\xb0σo\xa9\xe2x\x12Ǳ\xc0h\x1e\xff\xea\r>\xf9\xd9\xf3\x9c\u007fi\x9b\xc10%\xcd4Y^\xd4$\x8d\xb2\xcbb3\xa0Usi\x95]\xaa\x81\x8dc\x17\xde\"\x05w\r\xb0\x8a\xf1\xfc\x8a\xd1\x10g\x9aA\x94\xd1\x1d\xa6l\xeeF\xdcމ\xd8\xee\xc6ĩ&\xca\xf2I\xef~\x0f\xe6\x8b1w\xe5\xdb6'WZ\xcc\xd4}\xd666\xd8iw\xc8u:<\xb7\xb1\x16~\xdbRR)%\xb6mS\xabV\b|\x1f)%\xef}\xcf[\xf9\xe3O\xfd%\x8f\xbe\xf3a\xe6\xe6\x9a|\xea\xd3_\x02\x01G\x0eOqhq\x81Z\xb5\xcc`\x10qsu\x93_\xfc\x85\x1f\xe3\x917\xde\xc7\xef\xfc\xde\xc7\xd9M\xb3\xe2cM\u0084\xa6\xe4\bd\x16\xf1\xd7/\\c\xa1Uce\xb6F\xbd\xe4!\xc7\"\xb9\xbdП\x1b\xe8\xe7C\xfa\xf9\x10)\x14\x96\xb0P\xb1±-\x82Х\xf7\xfc\x88\x97\xcew8v\xaa\xc4̢K\xad\xee\xf0\x9ew\x1c\xe2\xa7\xde\u007f\x1f\xddn\xcc_~\xe9*O|\xe5:\xd7W;ln\x0f\xd9\xed\xc4\xfc\xcdK;\xe4\xe4EG\x93\xa2\x1e)\xf9\x16JJʁ\x857\x96\xb5f\xda\xd0\x1d\xa4\xe4\xda\x10\xa79\xa3$/H\xc3=\xc45n\xc8\xec-2\xb0-I\xe8۴\x9a\x01\xb5\x8a\x0f\x194\xcae\x8e,\xb6\xb0-\x9b$\xcd\x10\xd8ؖ\xc7\xe6\xce\xc6G\xbf\x93\xc5\x03\x96\x94\x92 \xf0q\x1c\x173&\xeeΜ>\x81\xef\xfb\xfc\xf1\xa7\xfe\x82+\x97\u05f8\xb9\xba\xc5\xd4T\xc0\xca\xe2,\xf5j\x05\xa5,vv\xb6p=\x873g\x8e\xb20?\xc5\xfb\xdf\xf3 \u007f\xf4\x99\xaf\x93\xa6\x19:\xcf\xf7!\x19Ch\x83S\xd6l\xef\xb6Yk\xf7\x99\xaa\xfa\xcc\xd6Cf\x1b\x15|\xc7.B\xcb81\x16\xc9X\x93\x9a\x84\x04\xc3(\x82N\xc4x\xb0Gr\xfd/ױlI\xa9b13\x132;\x17r\xf4X\x9d\x1f\u007f\xf40\x1fx\xefQ6\xb6\x86lo\x0f\xd9\xedƬm\xf4\xd9\xdc\x1a\xb2\xba\xd6c}\xa3O{7\xa2Ӌ\xe9\xf5c\xd6\xdb\x11q\x92\x93\x9b;l\x82\x1a\xb7\xa0\x8d6\x84\x81\x85\xe3(\x1aU\x9fR\xc9a\xa6\x15\xb00_\xa6Q\xf3\t\\\a\xb4Bh\x89ђa\xdf\x14\x8aGQL\x9f\xed\xb4w\x8as09R\x88\u007f\xf3\x9d\xac\xe6\xb0<\xcfö\x9c\x0300\xcbr\x0e-/\xf0\x9f\xfd\xfaG\xf8\xdf~\xff\xffb\x10o3;[aeq\x01\xcb.\xf6\x8clnw\xa8UB\xea\xb5\x12ҶX94͛\xdep\x88\xaf>uyrw\xed\xa7\xafme\x98+\x19\x12\x9d\xb0\xd9\xc9Yo\x0f8w}\x83j\xe8Ѭ\x84\xcc\xd4J\x94\x82=\xcf\xd9;\xa4;<~\x11N\n\x85u\x9ai\xda;\x19흈\v\xe7w\xf8\xe2\x17\xae\x17\x03:\xf3%\x0e\x1f\xaer\xe8p\x8dc+uf\x9b\xa5I\xefB*\x89\xef+\x84\x90DqF\x1cg\xf4\a\t\x99\xd6\x13\x05J\x10\x14\x13ZR\x80\xebZH\x04Yf\xe8\x0f2\xda[\t\xeb\x1b\x11\xed\xed\x98\xcen\x8e\x10rr\xc3\xdd\t{\x82v\xbbC\x1c\xc5dYF\u007fп\xd1\x1f\xf6_\xfc\x8e\xd4\uf592\xb8\xae=\xa11^\x9e\xd8N\x1c?̭\xb5U\x96fg\xa8\u05eb\x13\x15\xca\xceN\x97\xb0\x14\x10\x06\x1eF\x1bj\xf5\x06gN\xccs\xf9\xda&\xd7n\xee\x1cx\x0eD\x11\x9b\r`K\xc3B9\xa3\x13Kz\x89d\xb33b}w\xc8\vW7pmI\xa3\x1cЬ\x86\x94|\aϱ\xf1\x1c\x85\xda_m\x8f\x8b\x86\x97\xab]\x92Ds\xf5r\x87K/\xb6\xc9\xf2B\x18-坆\x92\x18\xb3\xb4aɡT\xb2\xa97<\\\xc7*\xc4}ZaD\xa1r\x14\b\xa2X\x13\x8dr\xb2\xdc0n\x8b\x8cњ\x18\x17\x8d\xea\x9e\x10\xbb\xd3\xed\xd3\xed\xf6\xc9sMoХ\xd3\xdd\xfd\x92mۛߑA\x92$\xc1\xb2\xac\x03\xf5\xc2\xde\v\f\aC\x9e\xfe\xe6s\x84a\xc8|\xb3A\xb5Z\x1d\xeb\x90$\x9b\x9b\xbb\x04\xbe\x8b\xe3\x14\xb5F\xb9Z\xc5w\x1d\x1e}\xebq\xfe\xf5\xad\xafM\xe2\xad\xd6\xc5|F>\x9e#\u07fb\xa7ʮ&\xb4\rQ&\x18f\x82Q\xa6\x88s\xb8\xd5\x1e\xb2\xba=@\b\x83\xad\x04\x95\xc0\xc6w,\x1cK\xa1,\x85\x92\x16\x96Rc\x1e\n,ug\x83\x83cY\xa8}\x92#ے\xd8V\xf1\xff\x8d\x10hc\x18\xf6\x13F\x83\x94\xb5ۃqg\xb0(\xe3Ÿ2\x97\xe3\xe7\xb5mE\x10x\xf8\xbe\x87\xe79w\xa9\xf9\xf7P\xa91\x86$\xc9\xe8tz\xf4\xfb\x85\xa2r\x18\rX\xdfZˤ\x94\xff\xef\xb9\xf5\xb5\xefh\x95\xa0e\xdb\xce=\xf7QXJ\xf1\xf5\xa7\x9ef\x18\x8d\x98\xae\xd7i\xd4j8\xaeC\x9e\x17\xee\xda\x1f\x8c\xc62\xd0\xe2\x0e\xb4\x1c\x1b\xbf\\f\x16\xc3{~\xf4\x04\x9f\xfd\xfcs\xb8\xae5\x96\x03鱲\xe3\x8e\xee\xb7\x18\x8b\x06\xcf6\xb8\x96\xa1\xa45Q&\x18\xa5\x92D\xcbBeh`g\x90C??\x18\x1a\xf6\t\x1b\xf6\x875\xf5r\x8ej\x92\x90\xc7ީ\xe4\xa6\xefX\x99mɹj\xe8\xd1(\x05TC\x17\u05f6Hs\xcd\xfe\"&M3z\xbd!Q\x94R*\xf9x^!{-Pga\x84(J\x18\x0eGdY\xb1uB\b\xc1nw\x97\xf5\xcd\xdbh\xad\xff\xd7\xf3\x9b\xeb\x1f\xff\x8e\av\xf2q\f5/\x93\xef\xc4q\xc4S\xcf|\x13\x81d\xaaZ\xa3ѪO\xba\x83R\xc2p\x18\x8dc\xd1\x1duc\xa5V\xa3\xdbn\xf3\xd0\xe9%.\\Z\xe7ڍ\xed\x89\xdew\xaf:4\x05\bC\xefmT\xd8\xd3\xdaJ\x10\x96\xc1\xb3r\xd2\\\x10\xe5\x928\x13\xe4zL\xa1߃\x84*p\xc0\x1d0\xa0\xb9\xb7xN\n>/\x05\xffKot\xe3scߑ\x96h\x1e\xce\r\xa7\\\xdb\xfeՅf\xe5'N.O\xb9\x8e\xa5\xd4\xfe\x17\xd2Z\x13\xc71q\x1cO\xc4\xda{7\xe0\xcb\xe9\x17\x9dkַ\xd7hww\x10\x88Ϟ\xdf\\\xff\xed\xefFl-\xfb\xbd^\xc1\xe8\x1eH,\x16\xdf|\xfe,q\x92\xe0\xb96\xcdJ\x89R\xa5\\$\xd51\x03<ժ\x91$I!\xa4\x1eS\xe8\xe5j\x05\xdbq\xd0\xda\xf0\xde\x1f\xbd\x9f0t^QI.)\xe6\xdc\xf7\x14\xabB\x80\x92\xe3z\xc46T\x9c\x9c\xb2\x93\x138\x06K~\xd7\xebSS%\xf9{Qv\xe3}ô0F\xc9]\x04R\x9d\x99\xb5K\x86\xb5OG\xe9\x8d_\xbc\xb4\xf6B\xfd\xabg\xaf\xfd\xe2\xfan\xef\x0fFqz!\xcb3\xb4\xd6\aX\xe1;!\xea\x0e\x03\x90\xe79\xc3h\xc0\xe6\xf6\x06/]{\x91\x9d\xddm#\x10\xff\n\xf8\xa9=\xa8\xfb\x1d{H\xa7\xd7ecs\x93驩\xa21o\fY\x96\xf2\xec\xf3gɵ\xa6^\xa9P\xafT\xb0m\xfb\x00\n[Y\x99\xe5\xd2\xd5U\x8c\xd1Hi\xa1,E\xa5^',\x95\xe8\xb4۴\x1a%\xde\xf6\xf0\x11\xbe\xf8\xe5\v\xf7\x9cs\xdfK\xb4J\x18\x84ug&݈\xbd\x01S\b\x1d\x83\x9b\xe7\xd8R0L\x8b\xe5\x98\xda\xc0\xb7\xb3BF@\xac\xa4yl\x98\xde\xfc}\xdf^b\x94\xde\x00\xa0\x1f\u07fc\xa7\xe1\xb6\xfaW>\xb9u\xf6\xca'\x1b\xfeܡӇ\x9a\xefH\xd3\xecW\x84\x10\xef\x0f\xbc\x80\xc0\x0f\xb0-\x1b\xa4$\xcf3F\xa3!\xbdA\x97(\x8e\x88\x93\x98\\\xe7H!oI)\xff1\xf0\xcf\xcfm\xac\x99\xefvh\xc7\xfa\xe2\xe3_\xe6moy\x13B\n\xa6Z-\xa4\x94\\\xbar\x95v\xbb\x8d\x14\x82\x99Z\x9dR\xa5\xcc\x1e\xeb\xb77\xf5\xb4\xb80\x8d\x18O\xe0:N\x91\f\x95\xb2\xa85\x1bt\xdam\x8c1<tz\x89\x17\xafnp\xf3\xe6\xce=\xfb\x0fw\\\xbf\x10<\v],\xc0\xdc\v\xa1\x02p,p\x94\xc1U\x9a^\"H\xb5$Ϳ\x8d\xf5Jp~>\xcc\u007f\xf7\xe2.\x13c|\xabk*\x98gsx\x8b\x9d\xd1\xedkO\x9c\xbf}\xedp\xb5\xfaq\xcf\xf5W:\xbd\xdd\u007f\x04\xfc\a\bP\xa8\x9eF?c\x8c\xf9\x9a1\xe6\x9c1fC\b\xb1)\x85\xbc\x05\xb4\x81ṍ5\xfd\xbdLPY\xa38\xe2\x89/\u007f\x95n\xb7\xcb\xec\xec\f\x87\x0f-\xf3¹s\x8c\xa2\x18\u05f6Y\x9e\x9d\xc6\xf1\x9c\x03\aj\x8c\xa1V+\xe3\xfa\x0ez<\x03\xe6\xda\n%azn\x96k\x17/\xedaj\xde\xfd\xb6\xe3|\xecS\xdf\x18o\xf0y\xe5a\x98|<`\xa9\xc6\xf3\xf2f\xbc*v\xefv\xf7\xac\"\xf9\x0fR\x88RA\x94\x17\xf9\xe5\xe50}2\x9f(\xf8\u007f.\xee\xdeN\xaa\xee\"\x9d{{Łksx\xb0\xd5\xed\xb9~rnc\xedE\xe0çf\xe6~\x13\x8d~n\xe3\xe6+\u03a2\xef7\xc2\xf72c(\xdf\xfc\xf0C\xe4y\xceW\xbe\xfe7|\xe3\xa9g\xf8\xd2W\xbe\xc6ի\xc5\x1du\xff\xf22\xaemc\x8d\xc3\xd5~\xa3x\x9eM\x188$I\xb1\b\xd9UE^\xa8\x96\x03\xeaSSh]\xccX,\xcd\xd5y\xf0\xf4\xc2\xc1\x01P%^q|l/}\vqgd`\xff\x15ښ\x8a\xab\xa9y9\x81]\x18Ѽ\xec\xf7\x8b\xcd\x14|\x14\xf8\xb6\x8cq\xafko\x17\x16\xc0\xd9\xf5\xdb\xdbg7n\xb7\xbfU^x\xadvf\xc9\xf7\xbe\xfbQ~\xf9ÿ\xc0\xdc\xec\f\xab\xb7n\xf3\xec\xf3g\xe9\x0f\xfa\xd4J!\x8bS-\x10\x02\xa5\xd4\xddw\xa2\xd6(K\x10\xa7\xe9\xb8\xe6\x10\x93\xf1\x9c\xa3G\x0fM\xea\x9a,\xcby\xe8\xd42\x95\x8a\xb7\x0f\xf5H\xaa5o\\\xe8\xdd;\x8c16\x8a\x14f\xb2\x99GL\x0e\xbb\xb8\x01*\xae\xa6\xe1\xe5\xb8\xca\xdc\xd5\x0f\xa9\xd8\xe9\xcd\xef\xf5p\xeeu\xc8\xdf\xefE\x98\x12`\xba\xd5\xe2\xc7\xde\xf5\x0e\x1ey\xe8\r\xe4y1+x|iq\xa2\x14\x94J\xbd,d\x81\xb2,ju\x9f$.d9I^d\xe9\xdc\x18f\xeb>\xb5fkҝ+\x85.+\xcb-\xecq\xb3Gk\x8d\xe7\xd9\xcc̖\x8b\xf5\xf9ߢ\xbfm\xc6\xfd\aK\x8d\xab\xef\xb1?X\xd2\x14\x88LA\xcb\xd34\xfdB\x85\xbf\xa7:\xba\xd9_Kx\x1d^\x12\xa0\xd7\xef\x93$1\xf5j\x8d<Ϩ\x85\x01%\xdf\x1f\xc3;\x89\xb4\xe4\xbd8\x17ʕ\x80\xfe`8^\x04\x90ݙ\x80\x92\x16\xc7Vf'\xf7\xb4\x92\x92\xc3K-Z\xcd\x12R\x16\xa0 \x893\xc2\xd0an\xbe2\xa18^\xe9ҺP\x1dZ\xb2@_\x93\xae\xa24Eh\x93E\x8e\x99\n4\xae2\b\x18\xf2:\xbdd\x9e\xe7lo\xef`[6Ͻp\x16)\x15S\xb5ژ\x92\x10\x85 m/d\xed%\xcd\xf1@\xa7цn\xbf_T\xaei\x86\xd6\x02a Ғ\xc5\xe9*\xa5je\xf2B\xf3SU굀F\xa3\x84ֆ8\xce\xc9s\x8d\xef[\xcc\xceU\xb0_\xa6t\u007f\xf9\x95ez\x1c>\xe5dg\xbc\x14fL\x8f\x8f\xff,\xa1\xeak\xa4\xa4\r\xd0\f\x16^\u007f\x06\xd9\xda\xde&MS\xb2<g\xbb\xddƵ-\x9a\xe52j\x1c\xb8-\xfb\x8e\xa4\x94\x97U\xa7R\tz\xfd\xfed\xd3Z\x92ew\x02\xbd\xe31U\xf3'\t\xb7R\U000987ee\x12\x866ժ\xcfp\x98L\xf2\x85\xe3(\xe6\xe7\x8bV\xf1\xb7Z\x1e\xb6\xb7Gk\xaf\xc9$E\x91O\xf6F ,a\xb0%L\x97\xb2)\x80\xed\xe1\xea\xeb\xcf \xed\xf6.\xbe\xefs\xf9\xdau\x10\xe0:\x0e\xe50,v\xac\v\x81\xed\xb9w\xac0Vw\xecQ\xcdBH\x06\xc3\xd1xu\xb8!I\xf3\xc9\xe8q\x8e\xa2V\xf6'\xbf\x9ak\xc3\xfd\x87g\xb0-I\xadV\xfc}\x9a\xe4\aT\x84\x8bK\xd5\t\xff\xf5J\xf08M\xf3\xb1\x00\xee\xce\xf7\x89X\xf2\x0e\xda\x12\x18\x02\xdbTNL\xcf\x1e\xfbn\xab\xe5\xbf\xf5\x90%\x84\xe0\xf6\xedu\xf2L3\xdfl\x16\n\xbf1pr=wb\x8c\xfd\xc9W\b\xc8Ҕ\xc1p0\xa1T\x8a\xb6ma\xb0$\x17,\xcdO\xa3u>\x91鄡ϑ\xe5f1[Q\v\xe8tFw\xe5\x8f\xf9\xf9\xca]Fy\xf9X\xc4h\x94\x15ӿ\xf6\x1d\xb0aI3n\xcd\x16䥄\x8f\xbc.sH\xb9T\x02\f\xfd~\x1f\xdbR\xcc5\x1b\xec5V\x95R\xafX\xcci\xad\x8b\xed\xd2\x06ұ\x12]kM\x9a\x17\x14_n\fvP\xe6\xfe\xc3\xf3Dq\xa1\r\xcbҌ\x13G\xe6\t|E\xb9\xec\x16\x9d\xc2\xec`u'\xa4`j\xbat\xc0P\xaekMXֽ\x9b!\x8e\xb3\xc9(\xc0^\xe8SҠ\x80<\x17\x00?urzV\xbe\u07be\xe7P.//\xb1\xdb\xe9\xd2\xe9\xf7\x99\xa9\xef%sY\xac\x00w\xec\xbb \xa9\x18w\xf4\xe28Ʒm\xaaA\x80ٷ\xb1%\x893\xc6\b\x98v,x\xc7#\xc7\xf0'Ӯ\x02[)N\x1e\x99AI\x98\x99\xa9\xb0{\x0f/q\xdd\x02R\xef\x1d~\xad泸X\xa3Tr\x0f\xf4mtn\x0e.\xec7\xa0ԄU^\x06\x1e~\xddyH\x9a\xa6\\\xb9z\x9d<ϙm\xd4'ձ\x90E\xdbS\xec\xeb$\x8aq͠\xb5f4\x8ap,\x8bz\xb9\x8cN\xb3I\x9e\xc9\xf3\x8c\xb1\xc3`0\xf4RŇ\x1e=C\x9a\xe5\x93Ü\x9bn2\xd3\xf4QJ\xe0:\xf6d\t\xcc~\x98[\xaf\x878\x8e5aW\vUL\x99\xa9\xa9\xf2=\xab\xfc},\v\x964\x18\x98\x06\xde\xfc\xba3H\x96f\\\xbev\x1d\xdfu\bǵ\x87\x00\x84\x928^ў\x15\xe3N\x1a\x93Q-\x83I3Z\xe5*3\xf5\x06Q\u007fߪ\v\x03i\x96\x92#\x91@;\xb5h\xd5J\x9c<2;\xa1\xea\x1d\xdbfe\xb1E%\xb4\xf0=\x9b$\xce\xee\x19\x12gfK\x93D\xbeg\xa80t\x99\x99)\xe3\xfb\xf6=\xbf\xceb\x1f\xfd\"\x80GON\xcf\xfa\xaf+\x83l\xef\xec\xd0\xedti\x94\xcbت\x90>\x8e\xa2B@\x1dV\xcaHK\x1d \x94D!B\xc2d9\xf5r\x19\xd7q\x88\x87\xa3\x03b\xe6,\xcd\xc64y1:=\xca-\x1e\xb8o\x810\xf4'=\x86V\xa3\xc1\xfcL@\x18(\x94R\xf7<\\\xcbRTk\x1e\xa3Q2y\xfebア\xd5*\x8d\xe9\x98W6\n\xf0\x1e\xa0\xfczBZ\xf2\x9bϿ\x80\xb4$\x8dry\xf2M3\xc3(\xa6Ҭ\xa1\x1c{R\x14\x9aq1\b\x85\xe6vԿ\xb3\x91GkM\x16\xc5wv\x9ah]P0c)Y7\xb7h5\x02\x1e\xba\u007fi\xf2\x18[Y,\xce\xce27\xe5\xe0\xfb\xf2\x15\xe9\x93J\xc5#M\xf3\xbbz\xfeB\bj5\x9fJſ7\xb5\xaf\x01\xc3\f\xf0\xd6\xfdD\xe1\x0f\xbcAΞ\xbf\x80\xe78\x94\x83`r\xf0\xa5rHP)\xdfsų\xc1\xdc-\x8a0\x90\xc4\xf1x[\xf4\xb8ɕ\xa6㽿E\x0f#K\r\x0f\x1c\x9f\xe5\xd0\\\xb3\xf0\x06\x01\x95\xb0\xc4\xfc\xcc4\xf3S\xfe\xf8\xeb\xf0\xee~=\xc7Q\x84\xa1C\xb7\x1b\xddsKQ\xa5\xe2\xd3h\x06ضz%O\xf9\xcf_W!+N\x12\\\xdbƵ\v)\x90֚r\xad\xf2\x8a\x89\xd3\x18\x83\xb2-,\xdb:\x00U\xd38\x9e\x84\x0f)%Y\x96\x17\x1a*-\xd0\xc0P[\x90\xe5\xbc\xfb\x91\xa3T+\xa5\t\xac^\x98\x9d\xe3\xfe\xa3\xcb,L{\xc4Q~W\b2\x06ꍀ(J\x89\xe3\xbbG+\xa4\x14\x94B\x97V\xabt\xf7:\xf1\xe2\xa9\x1e=9={\xea\xf5\xe2%\x12 \xf4\\\\\xa7\xd8Ɠk\x8d\x16w\xc6\xcd\xcc~>|\xec\rF\x1b\x9a\xf33h}\a\x1d%\xa3x\xc2qMB\xdcX\xf0\x16kE\xaa\v\x8d\xb0cI~\xf4\xa1#\x94KE\xfeq,\x8b\xf9\xe9\x19\x1ey\xe0\x04\x87\x97|vw\x93{B\xed\xa9\xe9\x90\xe1(}\xc5vp\xa1\b\x11\xf7\xa6\x8b\xe1\u007f~\xddx\x880P\x0e\x02\x9cq\x13\xaa7\x8c\x91\xfb\xfa\xe7{U\xf8\xfe\x86\xbf1\x06\xbf\x14N\x1aW\xda\x18\xb2$!O\xb3\xc9\xe3\x11\x90\x8f\xeb\x13\x03\x8c\xf4\x9dm\xd1\xf3̀\x95\x85\x06\xb6팋OA\xab\xde\xe0\xed\x0f=\xc0\xf1\xc3\xe5{z\x82\xebZT+ށ\x9b`?k\x90\xa6\xf9\x04>\xbb\xae5\xf1\xf01Vx\xdf\xc9\xe9\xd9w\xbf\x1e\x8aD)e!\xf3ٛ\xbd\xdb\xdc\xe9R)\x87w\xa6X\xf7\xbewVp`\xef\x95\x10\x82\xa0\x1c\x16\xea\f\x04Q\x9c\x92F\xd1$\xa9\v\x04f\x9f\xa44֊l\x1f\x1d\xff\xf0\xf1if[\xa5\xa2\xce\x11\xc5\xebԫU\x1e}\xcb\x03\x1cY\xaa\"\x8c)\x06\x15\xf7}ǈe\x17\x83\x9c/7\x86ֆ\xad\xad~\xd1{)yTk\x1e\xb5z1\x94Sx46\xf0\xfb'\xa7g\x8f\xfc\xa0\xf3[2\xf0\xdcIBWJ\xb2\xb6\xbdK\xb9\x1cL\x12\xf8\xc4\x18\xdc=,\x19T\xca\xe3\xe6U\xb1\x043\x8e\xe2\x03-\xbf\x03!\xcd\b\xd6\xe21L5\x06\xdbR\xbc\xe9\xe4|\xb1\x80R\xaa\t\x18\xa8U*\x9c>\xb6\x82\xa5\xe4Ao\x18\xd3\xfe\aՃ\xc5ʾ\xf5\xf5\xe2K\xc2J%\x97z\xdd\xc7u\x1c\x02ߥ\xd5\n\xb1\xc7\xf3\x88\xc6p\x1c\xf8\x83\x1fx\x0f\x99\xae\xd7\n\x81\xb1\x94ln\xef\xe2\a\xc1dӏ\x10E\xb5^\xb8\xbe&\xcf\x0e\xc2O\xc7sq\xdcB{e)\x8b^\xa7O\x9ee\x93P\xb7\xd73٫\xa0\aZq-\n'\xc29i9\xcc-/c\xc6\x1a(\x80\xdd\xee\x90'\xbe\xf1\x12a\x18p\xf4Pmo\xb1\xce\xc4U\xf6&\x98\xa0\xe0\xb3n\xdf\ue426\x19\xd5j\xc0\xd4Ty\xdf\xf2ʢ\xf5\xec\x8f7B\x8ca\xf0;ON\xcf\xfe\x1f{\xa1\xeb\a\xd1Sd\xabZö\n\xed\xecW\x9f~\x91\x87\x1e86!\v'\x85 \xdc[\fu\xa0\x01n\xb8v\xe36i\x94L\xf2\x8c\xd6z\xf2\x8d\xa0\x00y\x96\xb1\xddO\xb8б\xd9Ll\xae\r\x1d\xaa\xcd&\x95z\x1dc\xe0\xf2\x8d\r>\xf6ٯ\x12\xfa.\x1fz\xcfü\xf7G\x1e\xe1-oX\xc6s\x8b\xef\x14\xe1\xc0\xd7\xe7\x15[S\x17\x17k,-5h4\x82{\xc2f5\x9eI\x14\xc5RG\x03\xfc\xfa\xc9\xe9ٿ\x0f\xfc@~\xa7\xba\xac\x94\x02\f\x90d9Wn\xac\x13Z\x92<\xcd\xc6\x159\x93\rԓv\xeexm\xacT\xaa\xf8\x96\x83\\O l\x9aftv;w \xa7a\xe2Uq\x14\x11\x8d\"t\x9e1\x8c5k\x91\xcd\xcev\x87ݵ5n\xaf\xed\xf0\x17_~\x8e?\xf9\xe2Ӝ\xb9o\x91\x0f<\xfaF\\\xa7\x18I{\xe0\xc4Q\xde\xff\x8e\a\xb9oe\x8e4\xcb'\x15\xfd\xfe\xa5\x99{\xb4ʽ\x92\xbd\xd6E\x98s\x1dE\xb9\xec\n\xa5\x14\x18\xfe\xfb\x93ӳ?\xf9\x83\xe8%\x96\xef\xba\b५\xb7\xa8WB\xf8\xff\x9a;\xbb\xde8\xae2\x8e\xffΙ\xb7\xdd\xd9\x17\xdbk\xefnb\x1c\xc7u]\xdbmC\xa1\r\xa4\xbc\x94V *\x11*!\x04\x12\x17\b.\xf8\x10\x157\xdc\"q\xdfk\x84\xc4\a\xe0\n\x89\\!\x10 \xa1\x92\xa4\xaaH\xec6q\x1d\xdbq\xec\xdd\xc5I\xd6\xf6\xee\xec\xbc<s\x86\x8b\x19;1q\xb8Lz\xa4ьf4\x9a\x19=\xf3\x9c\xf3\xbc\xfe\xffư\xbbq\x97\xf1\xa9I\xdc\"\x17\x92\xc41\xa9\xa4\xa4\x89\x90\xc41\x12\xc6d\x18\xb4ʑ\xaa-\xdb\x06\x05\xae\xeb\xd0\xed\xecњn\xa3,\x8b4\x15R\x93\x92\x14x\xbfG#I\"\xfa\x9d.\x0f\xee?\xe4\xca_\xaf\xb3\xb9ݣV\xf5\xf9\xc9{ߠ=5\x96\xb3'<\x16T\x9c\x9c\xa8\xf3\xd6\xc5%\x96\xe7\xcf\U0008faf7\xb8\xbf?\xc0.\xb2\x86\x86\xffOQ4\x1a%\x055E\x8eh]\xafY\f\x06a%I\xd2\x0f^n\x9d\xf9\b\xd8\xfd\\\t$\x95\x14ױY\xdf갴0C\x10\x84\f\x82\x90O?\xdd|T\xf3[h\tYF\u007f\x10\x10\xc5B\x14'\f\x86\xa3b\xb1/\xb8\x06m\x87\xfd\xc3!\v/\xcd\xe1\x8f\u05cf}\x18s\x9c\x82M\tÈ\xeeN\x97\u007f~x\x83\x1b\xb76\xa9W|\xde\xfd\xd6k|\xff\xbb\x97\xb0]\x8fOVn\x93\xa4O\xa2\x9dڶE{j\x9c\x1f}\xef\x12\xb77:|\xbc\xba\xc10\x8c\vB\xb1\xd3?n0\x88\x88\xe3\x94F\xa3\x82\xef\xe7`:q,\xd4j%\x0e\x0f\xc3\xf9D\xd2\xdf\x02?\x04\xe2\xe7ͣ~,\x90<\xbdj\x18\x06!\xa3(\xe6\xea\xc7kE\x91\xb5\x8b\xe7:'\x16\xd3<\x94a\xe386\x8emQ-\x97\nt\x06\xb0\xb4E,Bw\xaf\xcf\xd5k7y\xf3\xd2\x05\x9cR\t\xdbq\x18\x85!\xdd\xde\x03\xd6\xef\xdcceu\x9d\xbb\xdb]\x9aS\xe3\\~\xfbK\xbc\xf7\xce\x05\x96\xe6[\x1c\x11\x93\xb5\xfd9>\xfa\xa4\xc3\xde\xc3\xe1\tGO\x15\x9d?\xc6\x18\x96\xe7\xa7Y8\xdf\xe6\xb3\xcd.\xeb\xdb=\xeeu\x1f bN`\r\x8b\x18\x82 \xa1\xd1\xf0\xa9\xd5J\xc5ԥ(\x95\x1c\x121\xd4\x14\xec\xef\x8f.\x8b\xa4\xbf{\xa5}\xe6\xfd\x95ng\xe7s\xa1!\x00\xfb\x87\x01\x87A\xc8`\x18p\xf9\xdb_\xa5uf*o\xc6\xf1\xbc\xffI\xdb\xe6V\x97.\x00#\xf3^n\x8bp\x14\xd1\xef\xed\x81R\xf8e\x8f\xbf\xfd\xeb\x06\xb3\xd3S<8\x1cqg\xbb\xc7\xdd{]\xfa\xfb\x030\x86W\x17g\xf9\xe6\xebK\xbcp\xfe,o\xbez\x96R\xc9&J\f\x1a\x85\xad2&\xaa\x1eo}\xf9\x1cWWv\xd8\xd8\xed?\xea=\xe7\x11\xe4Ej\fZk\x16秙\x9bi\xd2?\f\xb8y{\x9b\xdb\x1b\xbb9t\x93\xce\xe1\x99\x1a\r?o\x87xL\x85\xb2,\x874\xb7\xcb\x1e\x96\xb68\x1c\x8c~\x1a\x04\xf1\x85\x97[g~\xb1\xda\xeb\\\u007fV\xac\xd0O\xcd\xe7\xfc\xf1\x83_\x8e\xeeluJW\xfer\x8d\x9f\xff\xf8;LNMP\x19\xabc\xd9\x16N\xc9\xcb5ౘ\xd5ia\x8dp\x14\xb2\xb7\xdd!\nF\x1c\f\x02~\xff\x87?ciMjrn\x8f\xd9/4\xb9\xb08\xc7\xf2\xe2\x1c\xf5\xc6\x18\xf5\xc685OS\xd6\xc9q\xe1[V\xc4q\xca:\xc5\xd3\x06,\xcd\xda\xd6}\xae\xaf\xee\x9eД,;\xdd\xe4\xd3Z\x13\x861\xff\xbe\xb5\xc5\xca\xda6\x89\xa4ǅ\xe1\xa78\xf7'\x8c\x81\xe10b\xff H\x80\x1f\xac\xf6:W\x8e\x16\xfb\xe7!\x18;\x89\x85;[]\x96_\x9c\xa1V\xf5sG\xef\x04\xeewv\x12\x03\\=\x19lt=\x97\xcaX\x9d(\x1819^\xc7/\x97\xb0\xb5\xe2\xddw.\xb2\xb8\xf8\x02\x8d\xa9\t\x9cR\x19\xbb\xf0Y,\xf2b\x88\x04\x8dEF\x82\"\xc94\x9e29\xd8d\x96a\xa7\x86\x85\xd9I\xaa\xbeˇ7\xee\x11\x15\xc5\xda\xea\xa9\xcd;\x19\x9e\xe7\xf0\xf57\x96\xf8\xcak/rk}\x87\xb5\xcd]\x1e\x1e\f\x19\x85Iξ\xa0\x15\xda\x02I\r&\xcdgI\xad\x15\xf5z\x19Ǳ\x9c\x87\xfd៖\x9b\xed_k\xad~\xb3\xd2\xed\f\x9e\x87P\xac\x8bKs\xbf\xba~\xf33\xfbk\xaf/\xd3l7(W+ź\xa0\xb0m\xfbQ\xb5\xc9c\xde\xfai#\b\x02\xa2(Bg\xf9ߺ\xb6\xb1\xc3+/\xcd2sn\x1aC\x8e\x00\x97\x15S\xcd\xd1\xf4\x13\x1b\x8b\b}\x14\xe3$Ea2\x85o\x15x(YF\xd5\xf7hNT\bb8\x18\x84\x85\xb9\xfb\xf4Jǣ\x8e\xb0\xd6\xd48\xf3\xe7ڴ&\xc7\xf0\xcb.A\x18\x11I\x82m)\xfc\x8aKٷ1&%3\xb9\xe9\xee\xba6\xe5\xb2Kj\xb2\xb7\xa3H.\xb5\xaa\xb5\xbf\xaf\xf6:\xfdg\xc1\xa1~B u\xcf}\u007f\xa2^\xe5\x8d/.\x88_\xad\x88\xe3:\xa2@\xb4c\x8be\xdb\x02\x88RJP\x88B\xe5\xc7p\xbc)\x95\x9f\xabT*RrKr\xb0\xbf/\xad\xc91Y]\xbb+\xf7\xfb\x039\xd7nH\xc9/\x8b\xb6,\xb1,\xab\xb8GK\x9a)1(1\x99\x92\fD\x81\x18\x94\xc4FKd,\xa9Z\xa9(\x95?\xa3\xec\xd92ӬJc\xa2*\xff\xe9\x87\"i*\x19\bY\xf6\xe4\xfb\x14\xfb\xac\xb8V\xaf\xfar\xb69!\x8bs\xd3\xd2l\xd4$NbA\xa7\xe2\x95l\xa9\xd6\\\xf1\xcaZ\\ϕ$\x12QJ\x8b\xef{\xa2\xb5>\x1f\xc5\xc9Ϛ\x95\xea\xb5\xd5^g\xe3Yj\xc8\u007f\x01\x97\x0e\x8d\x97\n\x1a\x87\x11\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_07_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1e#K\xef\xb7\x1a\x00\x00 \x00IDATx\xda\xec\xbdw\x98\xa5W}\xe7\xf99\xe1M7W\xae\xea\xea\xa8\xeeV\xb7Z\xad\x8c\x10B\xb2A\x02\x81M\x12\x06\x1b\f\xc6\xf68a\xd8Y{\xd6\xcf\uecf33^\x8f\xd7~v\xbc\xeb\xf5\xae\U0004cf4e\xd8`\xe3\x00\xd8c\xb21 \x82\x02\x02\x14Z\xb1\xa5\xce9T\xae{\xabnz\xd39\xfb\xc7y\xebV\xb7\xd4\x02$4\xd8\xeb\xe1\xed\xa7\x9f\xae\xae\xbau\xc3\xf9\x9d\xf3\v\xdf\xef\xf7\xf7{\xe1{\xd7\xf7\xae\xef]\u07fb\xbew\xfd\u05fc\x1a\x13\xc1\x8b\xf2\x98\u007f\x8e\x97\xf8\xe7\xbe\xf0\xcd\xd9\xf8\x99ߛ\x00\x86\x8d\xb1\rk\xed\xe5\x166\x80݀E\x01\tB\x1c\x91B<$\xa58\x0f\xcc6g\xe3\xfe\x85\x06z\xe6\xf3}\xcf \xcf\xe3\x04\xac-^c\"\xa8\x03\xb7Xk_\x96\x1b\xf3\xba,\xcbwF\x81\xaa5\xaa\x15\xca\xe5\x12\x8dj\x05\xa5\x15\xb9\xc9YZnrvv\x91,\x97Ǆ\x10\xfb\xb4\x12\x9f\a>ќ\x8d\xe7\xbewB\xbe\xc3Sј\bn\x00~\xc9Z^i\xac\xad\x87\x9e,o\xde0.^~\xfd\xd5\xdc|ݵxJ\xa3\xb4Dk\x8d\xa7%a\xa4\xf0=\xe8\xf6{\xfco\xef\xfbC\x16\x9b-NϤ\x89\x14,(%>/\xe0W\x97g\xe3S\x00[w\x969q\xb8\xf3=\x83|\xab\x13ј\b\x14p\v\xf0\aB\xb0G+\xc1h]35\xac\xa95\xb6\xf0ï{\x1d\x93cc\bY\xfc\xb2-\xfe\"PBr\xf2\xdcY\xfe\xec\xc3\xef\xe7\xc7\xef\x1ce|4\xe0Ĺ\x98O߳\xc4\xe3\a;$\x89\xc1\xf3\xe4\xef\xfb\xbe\xf8\xdf\xeb\x155\xf7\xe4c\xab9\xc0\xf5\xd7\xd7طo\xe5{\x06\xb9ĉ\xd8j-\xbf$\x04\xbfب*6\x8e\x06l\x1c\xf3\x18\xaf+*%\x89\xc1p\xec|\xc25W\xbf\x9a\xdb^~\vX0֒\xe7\x16\x89\xa4\x1f\xc7\xfc\xd9G\xdeϞ\xe9.\b\xc1\xe8X\xc0АG\xb9\xacXhf|\xe1\xfe&\x0f?\xd5ff!!\xf0\xc4\x1f\xd4*ꯏ\x1c\xe8\xdc\a0\xb59\xe2\xfc\xa9\xde\xf7\fr\x81a\xde`-\xff\x97V\xe2\x8a=[C\xb6N\x05\xd4K\x8a\xc8\x17D\x91\xc0\x0f$\xbe'\xd0\x1a\x1e;V\xe5\xdf\xfc\xd4{\xb0X\x84\x94()PJq\xec\xf4y\x1ez\xf8\xa3l\x9eH\x11@\x96Y\x10\x82R\xa4\x88J\n\xa5%\xb3\v\t\x8f\x1f\xee\xf2\xb9\xfb\x96\x99[J\x96K\x81\xfcJ\xe0\xcb\u007fs\xeaX\xf7\xf4?\x87uP\xffԧ\xa3\xdf\xc9iL\x04\xef0\x96\x0f\xd5\"\xb9\xe1\xa6++l\x9b\xf4\xa8\x86\x92FUS\xa9)\x82@\xe3\xfb\x02ߓh\x05\x8b\xabUn\xba\xee\x06\x94\x92\x04\x81&\xf0=\x82@12T\xe1\x9e\a\x1f\xe1\xf2\xad\x16\xed)\x82@\xe0y\x92\xdcX\xb2\xcc \x85\xa0\\Rl\x9b\x0e\xf9\xfe\x1b\xea\x04\xbe\x8c\x9e:ڽ\"\xcd쏍O\x04O\xb6\x96\xd3##\x1bBz\xab\xd9\u007f[\x06Y3DX\xd12\xac\xe8w[k?01\xe4{/\xd9UfbH\x13\x85\x8a\x91!\x8f \x94xZ\xa25xJ\xa2\x95\xe4\xa9\xe31\xafx\xf9\xeb\xd805F\x14y\xf8\xbeF+\x81Ғ(\f\xa8\x96F\xf9\xfc}\x8f\xb3a\xcc\x12\x04\x1a!\x05R\x82\x10\x82,\xb3\b@*\x81\xa7\x04\xd7\xec*s\xf9\u0588'\x8f\x98r\xbb\x9b\xbe+(+\xcf\x18\xf6\xf5;y\u007f\xed=\xfe7a\x90~'gh2\x90 \xfe\xa31\xe6\xffܱu\x8a-c\x86\xc9\x11Mɗ\f\xd55\x9e/\xd0J\"\x04(%(\x85\x92\x13\xe7\xfaLl\xb8\x85W\xddz3a\xa0QR\xe1i\x85\xe7)<O\xa3\xa4`\xfb\x96\r\f\x0fo\xe2\xfe}ǁ&#\r\x970\x18c\x11\x02\xb2\xcc`\x01%%\x9e\x16<~\xa8\xcb\xff\xf4\x9e\xf7\xb2a\xa2\xc1cO\x1d\xfd\xfe^?{E\xb9\xee\u007f\xb59\x1b/\xfcS\x18E\xfd\x93\x9d\x8e\xb2\xfewq\x92\xfc\xca\x1b\xefx\x85\xd8<5D\xc8\x1c\xf5\x92G\xad\xa2\xf0C\x89R\xc2\xc5\x06\tղ\xe6\xb1C-t\xe9\x1a~\xf2\x87߄\xef+\x84\x94\x94B\x1f?P\x94\x82\x90r\xa9\xa8̅e\xe3\xe4(W\xec\xdc\xc3\x03O.\xf0\xf0\x93\xc7\x18\xae*\x1aU\x8d\xc5\"\x85D`\x91\x02\x1exr\x918\xdf\xc6O\xbc\xf5N\xae\u07fb\x83W\xdfz5_\xfc\xeaÛVV\xfb\xb7\x96\xaa\xdeǀοx\x83\x141\xe3\xb5Y\x9e\xff\xe9M\xd7\xed\x95?\xfeַ2\xbf0G\xdc>E\xa3\xa2(U5ւ\x14\x02\x8b%\xce\xe0\x9e}m\xae\xba\xf2\xb5\xbc\xfb\xc7\xde\xe2\x16UJ*\xa5\x00ߗ\x94J!\u007f\xf9\xf1\x8f\xf3\xf1\xcf\u007f\x99\x1f|\xd5\xf7cL\x86\x10\x82j%\u2d9bob\xf7\xcek\xd9\xf7t\xcag\xef=\xc1\xd9٘3s)\aO\xc4<|@p\xdb-o\xe1=\xef\xfa\x11\xd24EI\xc1\xe8\xf00\x9b&k|\xf2\xaeoLJ\xa9n\x90\x92\xbf\xec\xb5s\xfb\xdd<)\xe2\xbby2\x8a:\xe3J\xe0\x91\xc9a\xe5\xed\xbcl+?\xf3c?KI\xe7|\xe5\xeb\x0f\xb0\xbc|\x02k\xfa(\xe5!U@\x96+6n\xd8\xcckn\xbd\x89ɉ!\xb0\x06!%a\xa8\t<\xe7\xaa\x0e\x1e=\xcd\xc3\a?ʾ'\r\xbf\xfb\xbb\xef\xc7,\x9c\xc1$=\x04\x02\x84@I\tZ\x83\x15,/5Yi\xf7\xf0\xb4\xc7p\xadF\x9c\xa4dy\x8a\xf6$\b\xf0\xb5\xe67\xff\xe0\x8f\xc0\x1c\xe4\x8f?:G?6\xbf\xbb<\x13\xff\xe2ws\xc3\xea\xef\xb21$\xf0\xb7\xa5Pz\xbb\xb7\x94\x98_:\x8b\x16\t\xa50\xe2\xce;nÒ\xd3\xe9v\x89\u0090R)$\n<$ $\ba\x90J\xe2iE\xe8k\x94\x124[m\x1e9r/\xef\xfa\x99k\xe9\xbc\xff$KM\x18\x1e\xbd\x02\xd9|\x1a\xb2\xccU\x8cV@\x9ec\x8d\xa5V)Q\x8a\"\xd2,\xa7\x9f$Xc\x00\x81\xc9\xc1\xf7%K\xcd&g\xce\xef\xe7\xc7\xef\x9c`f!\xe7\xaf?=\xf7\v\xc3S\xc1W\x96\xce\xc7\u007f?\xb9)b\xe6t\xef_\x86\xcbZ;\xeeaE\xff'ky\xfd\xb5;#J\x91b\xbc\xa1\x98Y\xe8q\xc3\xd5W\x93f\x19Z\v\xc20\xc0\xf7\x14Z\n$ \xa5\xc0\xf7\x94\xfb\x9eR\x04\xbe\xc6\xf3\x15J)\x1ezr\x1fS;\x17ټ\xa9\xce\xe8H\xc0c\x8f\xf9\xec\xdcq\r\xe8\f\xf26H\x05R\x82\x92.\xdb\x02\x04\x02c\v\xf7 \\\xf6e-Tke\xfe\xd7\xdf\xfa#n\xbb1\x03\v\xd7\xec*s\xef\xc3-V\xdaf\xeb\xa6\xe9\xe8oN\x1d\xef\xa6ߍ\xb5\x92\xdfE\x97u\x8b1\xbcs۔\xcfؐ\x06k\x99\x1a\xf2\xc9\xfbO\xf1\u08cf\xa2\x95@\b\x97\x8ezJ:\x03\xf8\x12\xed\xb9ZB\x17i\xaf\xf6\x14\xbe\xef\xb1\xd2n\xf3\xa5\xaf}\x8a\x9b^2\x89\xb1\x96\xe1!\xcd\xd3\a\x1fd\xb99\x0f\xba\x02\x9ev\xaeJ\x17F\xd1\n\xb4Bj\x97JK)Q\xd2\x19\x16!\xf8\xc0G>\xc5H\xe5\x04ղ\x871\x90\xe5\xf0\xaf\xdf9M\x9e\xdb\x1b\x17Wҷ\u007f\xb7 }\xf5\xddpWaE{\xc6\xf0\x1b\xb5\xb2|\xe95;\xcb`\x05\xe5@R+I\xc6G5\xc7N\x1d%,M\xb2yj\n!,Z\xb9\xf8\xa0\x95D)\x85\xefk<\xcf-^\xe0\xbb\x05\xfe\xe5\xdf\xfc-^\u007f[Ȇ\xcb&\xf1\xb4\"\n\x15\xe5\xf29>\xf5\x99\xbb0\xf9(\x9b\xb6\r\x83q\x95:R8\xa3 \xdc\x1fc\xc9RK\x108þ\xffo>\xc2̹{\xb9\xee\x8a2\x16wj\x00&\xc6|\x16\x96Rq\xf4T\u007fg}\xc8\xfbˡ\xaa\x17//%\xff\xff6HQ\x00^#\x04\xbf\xb7w[\x89FI\xa2\x95\xa0\x1cJB_\x12D\x92j\x94\xb1\xff\xd0A\xce/\xa4\\\xbd\xebr\xc2\xd0G*\xd0J\xa1\xb4\xc2\xd3\x12_\xbb\xaf\x83R\x89\xdf\xfe\xa3\x0fp\xf5\xf6\x19\xaa%\xcd\xe4\xe6aN\x9eo\U000f5bdf\xe6\xfbn\xb9\x8c+\xaf\xf4\xf9\xecg\xbf@\xbd\xb2\x89ѩQ\x17G\\\x10r \xa4\x00\x8c\xc5S\x1e\x9f\xf9\xf2\xd7\xf9\x93\xbf\xfcS&\xea\xa7\xd9:\x1d\x14?\x16H\x05J\xb9@?>\xea\xf3\xe0\x13\xabcqj\x0f\x9e;\xd9}\xec_D\f\t*\xfa\x0f\x87+z\xf7e\xd3\x01\x81/\x91@\x14H\xb4\x840t\xeec\xa4\x06ڞ\xe5ß\xb9\x1bc5\x97mވ\xef+\xa4r\xb1\xc3\x0f\x8a\x8a\xdc\xf79x\xf8\x04'\xcf\x1cf\xe3\xb8\xc7\xc7\xff\xe18'N\xdfȮ\xdd?\xca\xef\xff\xe1'ٻ\xa7D\xe7\xfc9\x16\xbb\x1bؽk\x873\x88\x14\f\xe0ac\xc9Ӝ\xdf\xf9\xe3\xbf\xe2\xd1G>\xceM{\x15\xa5H\x17\xa7B\x14E$x\x9e\xc3\xc8\xea5\xc5\xd1S}\xce\xce&{\xfa\xed\xec\xf7\xfeY\x19drSH{%{V\x91\xf7-p\xaa]R\xf0{['\x03Ƈ<\x94\x02\xad\x05%_ \x85@{\x02\xad]\xcd1\xdc\xf0(G\x19GO\xb7\xb8f\xcfUTJ\x11J9\x83y\x9eBk\x05\xd6rݭ7\"\x18\xe3\x9e}˼\xf4\xe6;\xf9\xd1\x1f\xfd!\xa6\xa7\xb6\xf1}\xb7\xbe\x96\xff\xf2w\xfb9xx\x89\xe1\x91q\xf6\xee\xbd\x02Dᶬu.\xcc@\x9a\xa6|\xfd\xc1\x87(\xe9Y\xa2P\xa2\xb5C\x04\xd6.[|\xad\x94\xc4\xf7$Ijٷ\xbf=26\xe6\u007f\xa3\xb5\x9c\x1ey>u\xc9\xf3\xada\x9ew\x1d2\xb5%\xda\x12'\xe6\xb5K\xe7\xe3?~&|\xfe\x1c\xa9\xeeG|-\xde\xf6}WW)\x05\x12\xcfs\x15x=\x92(\t\x9e/\xa9\xd5\x1dh\xf8ԉ\x1e\xd7]}\a\xb7\xbd\xfc\xfb\x19\x1b\xad\"\x85p\x06A\x11D.\xd3Bi\xdc\xd1\n \xf0\xdd'\xb0y\xf1I\x14\x10\x02)Y\xd6Gk\x8f\x02\xa3\x87<\x87\xdc@\x92Cf8ub\x8e_\xfd\xad_\xe3\x15\xd7\v\x87\x18+1Ⱥ\xa4\x04!\x05\xa5\x92\xc6\xf3%\x16˿\xfe\xb5#t\xbb\xf9=\xb3g\xfa\xafؾ=\xe2\xe8\xd1\xde\xf3\x8d\xa5;\x8c\xb13+\xf3I\xfbE˲\x86&\x83r?6\xbf\x99e\xfcQc\"\xf8\xf8\x05\xa4ҳ\x1e[|\u007f\xc2Zn\x1f\xad{D\xbe\xf3\xc9B\xac}p\xf7\xb8,3\b\x01\x0f>\xb5\xca\x1b_\xfbӼ\xfe\xf6;\xa8U*\xee\xcd)\xe1\xb2!O\xba\xb7*%(\xe1\xd2\xd9<\x878\x814\x85\xdcB\xbe\xc6V\xf5\x81|\xdd\x18\x83OZ\xc4\x11\x01&\xb7l\x9e\x1e\xe7uw\xbc\x95\xc7\x0f\xb5\x11\x17<\xceZKn,yf\xe8u3rc\xa9\x96\x15/\xd9[\xa1\x17\x9b\xab7m\x89v\x1d=\xda{Ό\xeb\xc2\xef7&\x82m\x8d\x89\xe0\xb7k\xe3\xc1\x99<\xb7\xfb\x94\x92_\x1c\xd9\x10F\xdf,[{^\x06\xb1\x96\xc9<\xb7?\xf8\x92\xbd\x15|O\xdcY\x1b\x0f\xeejL\x04\x1b. \x98\x9e\xf9F\xdfd\xad\xadm\x99\f\xb0\xd6^t\x1c\a.\xc2B\x9e\x1ar\xeb\xb3m\xf3\x16\x94R\xeegV\xa0\x84@\xa9\xb5 [XQ8\xc3\"\xa5{\xf7\xa2Ƞ\xa4\xb8ā\x17\x17\xbc\x98X\xb7OjIӌ\x1f\xf9\xa1\x1f\xe0\xa9c\x19\xc6X\x8c\xb1\xd8\xe2\xe7\xd6X,\x90疴\x9f\x93f\x96w\xbea\x1c!D}\xa5g~jm\xc3]\xca\b\x80ߘ\b\xae\xac\x8f\a\x9fRR\x1c\x8bB\xf9KW\xed,M\xbf\xf6֡\xaa\xef\xf1\xd2<\xb7\x13\xdfLh\xf1\xbc\fb,\x1b|O\xd6^\xba\xb7\xc2\x1bo\x1ba\xcbT\xf0\xaa$\xb5\xf7\xd4ǃ\x9foL\x04\x13\xcd\xd9\xf8\xa27j-/\x8bB\xe5WJ\xd2}XqI#\x13'0=\x9a\xf3w\x9f\xfd\x02\x95r\t\xdf\xf7\xf0<\x9f \xf4]\xadP\x90P\xb0\x96º\x9d>HiEQ\xce#.\xfdWH\xf6?u\x84\xf9\xb9%0\x1a\xe9i\xbc(\xe4ȡ\x134\xaa\x82,s\xa7\xc6Z\x87\n\xafm\x94\xb5\xd3b\f\x8c\x8dx\xbc\xf2\xa6\xbaHR\xf3\xd2Btq)\xc6\xf3\x8e4\xb3\x1f\xc8s\xfb䮭\xd1\x1b~\xec\rc\xfc\xd6\xff\xb8\x95w\xbdq\x8cњ*\x9e\x93\xa1\x17\r:\x11\x82m\xc6©'\x9aT뚛\xaf\xaf\xb1wGi\xfb\xa7\xbe\xb2\xf4\x87\x16\xfe\xfb\xc6D\xf0\xc1\xe6l\xfc\xff\x14on47v\xf7PEa\xad\xc0\n\x8b5\xeb\xebx\xe1\x15ǖ\xa9\x91\x88\x83\xa7\xbf\xce\xfb\xfe\xccg\xfb\x96i\x96\x9a˔\xa2\x807\xbd\xfaeLN\x8c\x16\xdb\xe7\x82]^T\xe0H\x8f\xc2_\xb1\x9e\xd7*\xc0\x14\xff\xf7\xf8\x93?\xfe g\x0e\u007f\x85V;d\xef\x15\xb7ҨVYn\xad\xf2\xb5\a\xbe̍WD\xf4\xfb9J\t\xca\x151xo\xd6\xdaA\x15o\xad%\xcd\x047]S\xe3\x1f\xbe\xb2\xb4'\xf0\x99\x06Z\xcf0\xc6o\xa7\x99\xfd\xf9][\xa3\xd2\x1b_9̕;Jd\x99\xe1\x93_\\\xa4\x9aft\xbb\x19\xda\xed\x8f\xcd\xc0#/J\x96\x15\x94\xf5\xdb6\xd4\xd5\xf7ݸ٣\xe6\xc1\xd2B\xc25\xd75x\xcb\x1d#,4\xd3\xf1\xb3s\xc9kJU\xfd\xaf\u008a\xbe\a\x10\xd6\xf0?L\x8f\a\xb5z\xd9eJ\xbe\a\x9e\xef\xd6\xd1\u05ee\x1eYK3\x11\x82\xa9Q\x85MO\xd1n\x1d\xa0\xe2\x9f!\xed\x1d\xe3\xa3\xff\xf05\xf6\\~\x05Íza\x009(\xf2Z+m\x1ez\xf81\xa66\x8c\xbb\x8a\xbb0ƓO>\x89\xaf\x15aT\x02\x02~\xe3\xd7~\x85\x97\\\xae\xa8\x05=V\x9b\a9w\xeeq\xb2\xde!6\x8d;\xb8\xc6ZG\xf7j\xbd\x1e\xdc)\nDU\xa8Y\x00ʑ\xe2\xcb\xdfhU\x92\xc4\xfcc\xa9\xaa\x0f\x15q\xf2%aE\u007fI\b\xde\xf0\xe6\xdbG\xbc\xf7\xbcm\x8a\xe91\x9f\x03Ǻ\xfc\xfd\xa7\xe7\xd8]\xb5\f\x05\xee9\x0f\xceg\xc4\x19\x1f\x8b;\xf9\xfe\x17\v:\t\xd7 \x0e\x01l\xae\xc2W\xef^@\n\xf8\xe5\x9f\xdbĿ\xfb\xd9Ml\xdb\x18n\x05\xf6\x01\xbfja\xa8\x12I, \x95\x83\xcdA\xe0\xfb\x92jUQ\xa9J*U\x85҂~א$\x10\xfa\x820\xb0he\xa8D\x96\xab\xb6u\xf9\x9d?\xfe]\x8e\x9d:\xf3,\x9f7;;\xcfo\xff\xdf\u007f@\xa7ݻ\xe0\x84\x84\xfc\xe7\xff\xf4\x17<\xf4\xf0\xe3\x80\xe6\v\x9f\xf9,\x13\xf5\x8c$q\x04U\xad\xa2\xd80\xe69\x12\xccs\x9fE)\x97\xcd\xf5{9ֺmlm!h\xb1v\x80}\x85\x81d\xebt\x88\xb1\xbc\f(\xd7ƃ\u007f/%\x0fN\x8f\xfb\xbb~\xe1\x9dS\xfc\xe8\x0f\x8e\xd1l%|\xee\xeeE\xbe\xf2\xa59\xbe\u007f\xb3\"\xd2\xee=+\xe1b\"P}q\xb1\xac\x8b\x12\x17\xc1\xeea\xc1G>>˱\x13]\xb6O\xfb\xfcܛǹ\xfe\xf2\x12\xb9\xe1ǅ\xa0\xe4k\x81)\\\x95\xefA%\x92\f\xd75~ P\xda\x05\xec(\x92H\x05한8\xb6d\x99e\xb5\x93\x93\xa4\x96r\xe4\xb1gK\x87_\xf9\xcd߆(rٕYKe\x13L\xb6\x84\xb0\xc6\x1d\xb3\xdc\x00\x86\xce\xca\x19\x16g\x97\x00\xcd'?s\x17{wT\x1d\xbc\x1e(\x87\x8biW\xfb\xf8\xbe\xc4\xf7%\x9e'\t\x02\xf7o\x1c\xe7\x18\x03\xb6@\x81\x85Xw\x93\x9e'ز!\x00x\x05\xf0\xe7\xbe\x16\xff\xf1M\xaf\x1c\xe6\x97\xdf=ͮ\xcd!\x8f=\xb1\xc2'?\xbf@\xf3L\x87[/\xf3\xc9\xcc\xfar\xad\xbd\xe5\"\r|\xd1\xe0\xf7nn-\xb6\x88\xd0\xd6B\xe0\t\xae\x1a\xb6\xfcէ\xe6y\xe5\xb5U\xf2\xdcrŦ\x90\x85V\xceم\x04\xad\xc4 \xa0G\x81\xa0V\x91\x04\xbe\x18\xb8\x06\xaf(\x10\xcb\x15I\xb7kH\xfa\x86,\x03?\xb0\xc4qF\xa5$\x89B\xcd\xe4\xd0\n\x1f\xf8\x93\xbf\xe5\xa7~\xfa\x87!\ue0f0\xe4qN\xb7\xdb#\xeb\xf4 \xac\x805PN\x116fi~\x1e\xbb\xb4H\xd6=\x87\xa7]\xea,\xa5˼l\x91QY\v\x8d\x86O\x10\xac\xff,\xcf\r\xbd\xbe!\x8e\rB\x82\x92bp.\xb5\x12l\x18\xf7\x91\x82\x9bs\x83z\xf7[ƸzW\x89Օ\x8c\x93'\xfa\x1c<\xd5c\x88\x9c\x8d\xa3\x8a\xcc\\j'[\x8aB\xe9E3Hj\xed\xb3\xb3\xa4\x92'\xb8b\b\x9e:\xd9c\xd7t@7\x81\xcd\xe3\xfeE\xa7\t+\b\x03\x89T\xe2\x02\xe1A\xc1\x97G\xba@u3\xf2\xaaa\xa5\x99\xd3\xed\xe4x\xbe\xa0\xd3u\xc8뎍\x11\x0f<\xf81\xc6G\x1a\xbc\xeeU\xb7\x802\x04:b\xf3\xf48\x0f>\xb8\x8f\x1fx\xcdk 3\x10'\xe4i\xce\xdc\xcc\x02G\x8f\x9e\xa1Q1\xf8\xa1Z\u007f/\x02\xb4\xef*\xf0\x91\x91\x00\xcf[O\x8f]j\xae\x18\x12\x82f+\xa5\xd5J\x91\x17\xfe\xaa\x80ZU\x91[\xd4Ͻq\x94\xad\x93>\xcb\xcb)s\xb3)\xe7\x16\x12l7e\xf3\x94&\xb7\xcf^\xb8N\xe2\xfe\n8\xfeb\xba,k/\xf6Z\x03ۏ\x94\x04Ć8\xb3t\xe3\xdc\x01\x88\x91\x1c\xf8\xdf,\xb7P\x18\xe1\xc2\x17\x95BR\xaf\xf94\xea>S\x13!\xe5PS\xab9\xdc*\xee[\xe2Đ&\x86NϰcZq\xf7\xfd\xff\xc8\xfcB\x93\xac\x97\x90\xc79\xe3\xc3%\xee\xfd\xea#\x10\x1bL?c\xee\xe8Y\x02\xdf\xd2Z]d\xff\xa1\xa3L\x8eI\x87/\n\xe7r\xa2HQ\xafy\x8c\x8f\a\xeed\b\xe1J\x18@+\x89T\xee{C\r\x8f\x89\xf1\xa0(d\xd7cW%R\xf8\x9e\xa0VV.\xfbJ\f\xa7\xe7\x13f\xcf\xf7\xb8~ڻ\xa41\x00\x96\xbanm\x80\x03/\xa6AVr\x03\xf6\x12/\xeaI(˜f\xdb\x15R\xc6B\x9aY:=C\x9a[\xd2\f\x92\xb4@2p\xb9\xbd][(\xdf-B\x18i&\xa7\"\xc6\xc7\x036L\x87\xd4\x1b\x8aV'\xa7\xd9\xca\xe9u\fy\x0e\xfd\xf6i\xf6=\xf1\x04\x06H\x93\x14_e<\xb6\xff1\x10n1?\xfe\xb9\xbbٽ\xb5\x04\xf9\x12\x9f\xfa\xdcg\xf0u\x8e\x10\xce\xdd\xec?֣V\xf3\xa8\x16\x06\x170Xp)\xd7K\xc95\b%\f\x15Q \xd7?\xb0\x85\x89Q\x9f\xe1\x9a\xe6\xd1\xc3]\xb4\x14\x9c\x9f\xcfX\x9a\x8b\xb9q\x93G\xf2\x1cְ\x16\xe6\xdaf\xad4\xed\xbc\x98\x06\xe9'\xd9z\xd6\xf1\xccS2^\x91\xcc,\xa4\xd8\xc2\x18\xb9\x81$s\n\x8f\xdcX\xfa\xb1!\xcd\x18\xf8o\xf7{\x16!\xc1\v\\\xa0\xf5<\xc9\xd0P\xc0\xf0\x88\xc7\xf0\xb0\xc7\xe8\xb0Ǳ٘\xdcX\xe2\x9eaz\xd4\xe3\x83\x1f\xfd0\x16A?\x8d\xc9\xf2\x94\xc8\xebq\xfe\xec9Z+\x1d\x0e\x1dy\x98\xe1\x9ab\xf7\x16˵;W\x89|In\x05_x`\x85]\x97\x95\x1c\xba\xbc\xc6y\x88ud\x1e\xeb\xdeˠ\xb8g\xedT)\x87\x12\xacy\x82\x86\xc7\xf6\x8d!Q\xe0\x98Ǔ31;\x87ŷ̃\x16:\x06!\xd8\x0f\xa4\xdf\f:y\xbe1\xe4d\xafX\xe8K]\xb5@\xd0\xeed\f\x85\x8a$3d\xb9%,v\xa71\xd0\xe9\x19\x86\xaa\x16k\x04V\xbaHo\x8c\xa1\xdf\xcb\xf1|\x0f\xac#\xf9\xa4\x00Y\xf5\x90\x02<-0X\x9e>\xd6g\xe7d@\x9eI\xb6\x8ct\xf87\xbf\xf2\xeb\xbc\xfdMo\xc4\x1a\x98\x1c\xf1\xf9\xeaC\x8fS)\x97\xa8\x87\vhO!\x11\x84\x81`n)\xe3\xa1\x03\x1d\xde\xf5\xa6q*%\x17\x10\xa4.\x8c\x01\x83\xc2\xcf\x14\xbeX\b\x8b\x14b\xb0\xb3\xa5\xe0\xa2\rX\xaf)vn\x8b\x18)Kz\xb1!\xb0\x06O}K\x84\xc3\x19\x04>\x05\xc4\xdf\f:y^\x06\x91\x82\xb3\x9d\xd8\xd2\xcf,\xb5K\xe0 \x16\xa8jKj\x8a\xa2J\n\x02-\x06>|\xa5\x9d\xb3\x1cI\xc2P \x11\xd8\xd4\"\xa5\xa0\xd7˩ּ\x01$%\xa5 \xf4\x04\xbe\xe7\xe3\xfb\n\xad\x04\xad\x95\x9c#\xe7b\xb6O\x06\f\xd7C\xc2\xfeY\xfe\xe2#\u007f\xce\xc61\x18\xaej\xce\xcd.\x91\xc5OS\x8e2\xb0\x1a!\xe1ؙ>\x8b\xab9?\xf5\x96\t\xc7\xc3H\xf7\xdcR\xca\xc1\xc9P\x05\xf1\xb5\xda\xec\x91\x16\xa7\xd9\xf7\xd5\xc0\xcd\xe5\xd6\"\xf2\xf5\xb8\x99\xe5p\xfb\xcd\rΝ\xe9\xb3\xdcL\xb0i\x8e_\x92\xdfl\xcd8\xbfj\xe8%\xa6\xe3+qWs6\xce_\x94,\xab8f]cm\xb7\x93\xd8ҥ\x1e\x93[\xd89\xaa\xb9o&e\xb8\x10\xa6E\xbe\v\xec\xd6ZV\xbb\xa68\xf6\x8ar(\xe8\xc5\xce]u:\x19I\x9c\x13\x84\n\x8b@(\xc9\xe8T\x9d\xa4\x97\xa2T\x970\x90\xdcZ\xf1\xf8\xec=\xcb,\xaff\x18k\xa9T4\xbb7%\x05\x02\xe0\xf3\xf8SOSҧ\xb8vW\x88\x10\x96f۰\xd46\xfc\xe0+\x86\t|\x89\xd2N\x12$\x84C\x91\xc3j\xc4\xe8\xd4\x10\xd5F\x95,\xcd8\xf0\xd0Q\xb2,E*wt:\x9d\x9c\x95\x95\x84\xd9ń\xcc\xc0\x95\xbb+\x8eE\x04\x86\xeb>q7gf&\xc63\x0e4}\x8eX\x8e\xa7\x04\x0f\x9cJPB<\t<\xf8\xa2\x89\x1c\x8ac\x16\v\x98o\xf6\xcd%\x89\x14ka\xa4\"\x88{9ݾA\xe0\xe2\xc2Z\x11\xe9y\x92Cgb\xfe\xee\xcb-\x1e9\xd4s\xb1%\x83^?\xa7\xd9r\xb1'\xcf-#\xe35\xb6\\\xb1\x99\x8d;6P\xae\x06De\xcd\xe8X\xc0\x9b\xee\x18c5\xb6\xa4\xa9eu5GI\x81\xef\t<\xad\xe8u\x0e\xb1wG0\u088e\x9c\xe9s\xfd\x95\x15\xa2@!\xa4\x93\xa5J\xe9\xd2l\xcf\xd74F\x1b\fM\f\x13\x94|:\xab=\xb2,Gj\xc9\xec\\\xcc}\xf7\xcf\xf3\xb1\u007f\x9c\xe1\xe3_Z\xa2\x97\xc1UWT\a\xc6\x18\xe0\x01\xa1\xa4\xdb\xcf\xf0\xd57\xf5(\x9cm\x19N-\xe6FI>ќ\x8dW\xbf\x95P\xe2\xf9Ɛ\xb6\x85c\xbd\x84-ϵ-\xac\x05_\xc1j/_o\xaa)>E\xa0\x05#u\x8f~jy\xe8@\x8f\xfb\x1eksŖ\x90\x9d\x9b\x02\xd2\xd4\x12\x04\x92jգ\xb9\xd4cyv\x85j\xa3Du\xa8J\xda_\xc4jI\xbd&\xb8\xe9\xc6:\xa7\x8fuI\x13\xc3\xcaJN\xbd.\x10\xc2p\xc7M\x8d\x82o\x813s\t\xe5\xb2b疈4\xb7\xac\xc1\\\xaa`\x06K\xb52\xc6\xe4,\xcf,\xb38\xdb\xe4\xdc\xe9&\x87\x8f\xacr\xe8x\x97S\xe7\x13\x84\x10\xbc\xe6\x15ü\xf4\xda:\xb9\x8181$\x89\x19`oJ\x82\xd6\xf2\x92\xd9\xe63\xaf{\x8f\xc5(\xc9\x1c\xf0\x9f\x9f\tۿ(\x95\xba\x14\xe2\xe4\xecJ\x8e\xc0\xbb\xe41\xb5\b:\xa9E)\x8b\xa7\xc4E\xbbJ\x02\xa1'H2\xcbX\xc3g\xb5\x9b\xf1\xc0S\x1d\xf6\x1d\xec2=\xe6q\xe3\x9e>\xb7\\W#\xcf,\xfd\xce1j\x8d\x12\xdas\xe9\x8e\x14\xa0<\xc9Ʃ\x88\x92/\xd9\xff\xf8\nIbi\xb52\x86\x86=\xe7\x16\v\x80\xf7\xe9\xe3}~\xe2\x87&\xc9\xcdzZ\xbb\x06\x04\x87\xb5\x12\xc6Zf\x8eϱ\xb8\xd0gq1\xe6\x8b_or\xe8t\xcaJ\xd7R\x8d$\xefy\xc78{\xaf\xa8\x90\x19\a:\xae\xa5\xe7\x03\x06APо\xe2\x92\x19\xe7\xda\xe98\xdd4̮\x18\x80_o\xceƝ\xe7bW_0\xda\xdb\xef\xe4yP\xd67\v%o\xbdnZ?\xcb J\xc2\x13\xe73\x84\xefщ\rJ\tj%\xb5F\xd4\r\xe0\x87\xcc@f,\xbe'\x91R\xd0jg\xacv\r\aOƜ\x9a\x89\xd94\xa6\xa9T=\xd24#Ϝk\x92E\xa1\x10\x86\x92zգRќ?\xdfwЖ\x81Jũߕ/\xb9\xfc\xb2\x12ՊB*\x811\xb6\x00\x89ݎ\xce\xe2\x9c\xf9\xd9U\x0e\x1dZ\xe1\xe8\x89\x1e\x1f\xfc\xd4\"'f\xf2A\xe3ϮM>?\xf8\xca\x11D\xb1\x99\xf2ܒ\xe7\xa6@\x80\xd7\xd9θo8{\xa6\xc7\xf2b\xc2\xf0%\x82zn\xe1\xa1\xd3)\xe7Z\xf9Y!xg\xbf\x93\u007f[\xdc\xfa\xf3V\x9dD\x15}e\x9c\xf1ڛ\xb6x\x97L\xbe\x9f\x9c7T*\x9af۽x\xa3\xaa\x91v]< \x808\xb3ę\x8b\x05\xcb+\x99\xebt\xc2\x12\x05\x1e\xcb+\x96\x85\xa5\x98\xb2/\x18\xaa{\xe4\x99%͜;\x13\xc2\xe1^Yf\xa9T\x14YnYie,\xb72\xc2@P\x1d\xf2\x99\x9a\b\xa8\x945\xb2\xa8\xe7\xd2\xcc`\x8c\x8baI\x9237\xdf\xe3\xb1\xc7Z<r\xa0\xc3G\xefj\"\x95\xc6\xf75ָ\xd4w\xefր\xa5՜JY\x13h\xf7ZIl\x8a\n~\x9d\xf32\x99\xe1\xe8\xf1\x1e\xab+)Ñx\x16O\xb9\xd81\xdc{,\xc1\x18\xdeۚ\x8b\x9f\xf8v\xc5\x0e\xcf[\xdb+\x04ǒ\xdctZ}[iDb\xe0G\x05\xb0\xd06\x8c6<\xe2\xa2Nɍ[\x90\xc0\xbb\xd8\xdf\xe6\xb6\x10\x13\bK\xad\xac\xe9\xf4R\xdez\xc7\r\xbc\xe35\xd7\xd3\xe9'<\xb0\xff$\x0f=}\x8ccgfx\xd5ͣ\x0e\x9e\xef\xe7l\x98.a\a}\x1e\x80\x96\x04\xa1\xe4\x91\xc7V\xd9yY\x89G\x0fv\xf8\xbe\x8af~9\xe5\xf2m%\x924\xa7\xd3N\xf1\x03E?6,-\xc4<\xf2\xd4\n\xf7?ѡZ\x99\xe4=o\xbb\x91\xeb/\x9fFH\xc9o}\xe8.\x9e:|\x86sK\x1e\x87\xcf'\x90\x1b\xb6o\r\xf1}E\x10^|\x02L\x0e\x06\x81\xaf\x05\x99\x90$\xb9\xc5/N\x94\x14\xb0ҷ|\xe1pL/\xb5\x8fy\x92\xcf~;\xae\xea;\x11[\x9f\x10\xd09\xb9\x9cWFJ\x9a삅n'0\xdc\xd0,\xac\x18D!\xbf\x99[\xca\xd8\\p\xeak\xb1\xc4X\x87\xb8\x96\x02\xc5\xe4\x90\xcf\xeem>+\xedU\xb6o\x9e`\xdb\xde-\xdcy\xc7\r,εx\xdf_\u007f\x9e?\xff\xc47x\xdd-c\x18\v\xab\xab\x19[\xb6\x94\x91\x12\xf2\xdcp\xe8T\x8f\xc8ZF\xeb\x9a\x04\xc1Ʃ\x90\x95N\xce\xfe\xa3]vn\x8d\xe8us:ݜ\xe5fJ\xaf\x9b\xf3\xc5\a[|\xe9\xa1%~\xf5\xddw\xf2\xf3o\xb9\x95\xf2H\x15|\x8f\xfb\xeey\x12_\xa5\xfc\xf8\x1b\xc6ȭ\xe0Ĺ\x04\x89\xa5\xdf\xcb\xd1\xda\xf5/Zk/R5Z\xeb\xc4/\t\x92\xdc\xe6\x17\x15\x81\x9f\xde\x1f3\xbbj\x12-\xf9\u007f\x97g\xe3\xe5\xff\xaaB\xb9~'\x9f\x8b*\xfa'R\xc3\xc4\xd5\x1b\xbcA\xd5.\x80Ş!\xacz\xe4F\xb0P跌u\xf0I\xe5\x82]\x96\x1b\n\x10\x12*\x81\xa0V\xd1L\x8d\xc7\xfcϿs\x17\x9f\xfc\xc7}Xcپa\x84W\xbfl\x0f\xb9\xa9\xf0\x89\xbb\x0f21\xac\xe9t3Μ\xed\x0e\xb4Zw}\xb5\t\x16N\xcd\xc5<}\xaaO\x9a\xc1\xd6\xe9\x90/|u\x99\xbd\x97\x85\x9c<ե\xd5J\x99YH\xf8\xcc}M\x0e\x9d\xca\xf9\xd8\xfb\xde\xcb\x0f\xbd\xf2\x1a\xce.\xb6\xf8\xd0'\xbe\xc6/\xfe\xfa\x87\xf8\xdc\xfd_\xe7-\xb7U8\xbf\x98\xf3䱘<7\xec\xd8\xe432\xe4S*k|O\x0e\x98M\xa9]\\ʍev&\xa6\xdb7Ľ\x8cz\xe0\x9a\x8b\xbez<\xe5\xc8|\x86\x10<\r\xbc\xb7\xdfy.\\\xe3E\xd0e]\xa0\xb5\xfa}%\xc5{\xdf}s\t_\xadg\x1fǛ\x86\xeaXD\xbbo8~>ai5w-\xcbEp\xaf\x97]՝\x1b\xcbJ߲\xb4\xe2\x14\x1fcu\x8f\x8d\x13\x1e;\xb7\xfa\x9c8\xdb\xe7\xc4\xf9\x1e\xab\x9d\x14σ\xb1F\xc0\xcc\"\xcc,\xa6\xec\xde\x1cP\xaf*\xa4\x94\x9c\x9aKXZJ\xb9fg\x89\x9f\xfc\x91)vl-s\xf4t\x8f{\x1fl\xf1\xf8\x81U\x16\x16\x13Ƈ=\x96V2\x0e\x9d\x8e\t<\xc9\xe4\x88G\x96'\x18c\xa8\x96\x14\x93c\x01ۦK\xd4˚cgS\x96Wr\xce-\xc4\\\xbb\xbb\xc2\xdb_?B\x10\f\x84\t\x98\xdc\xd0\xeeZ\x92\xdc0T\xf3HӜo|c\x99\xf3\xb3)Ks]\xae\x1cS4{\x96\x8f=ѧ\xd53\x16\xb8\xbd9\x1b\u007f\xe5\xf9\xb8\xab\xe7\xed\xb2.x\xe2\x0f\x1bk\xdf{h!\xe3\x9a)= c\xac\xb1\xce\x15\xf9\x92FU\xb1\xdc\xce\xe9%\xeeïvs\xfa\x89qؔkˠVV$)4;9\xe99Xl\xe6\f5$[7T\x11X\xfa\x89af1\xa5\x17g\xe49|\xfd\xc9\xce@\xe7\xa0\x14\xbc\xed\xb6!n{\xd9\x10\xa3C\x1e\x9d^Ά\xf1\x80w\xdd9\xce\u00adC\xbc\xfe=Opl&\xa6\x1a)vo\t\x9d\xfb\\̨\x94\x026M\xfaT\"I\x92Y\x0e\x1c\xcfhv\xfa(%H\x13\xc3\x1d/\xab\xf3\xdaW\x8c\x10E\x0e[\xc9҂\x90\x13\x12%s\x8e\x9f\xec3\xbc\xd7\x03!\xe9&\x86\x92/\x98-<\xd6\xccjN\xb3g\x10\xf0\xcb/\xc4\x18/4\x86М\x8d\xefiL\x04O<5\x93]u\xf9\xa8\x1e\x80k\xf5P\xb0\xd0\xca\xd80\xe1\x13\xa7\xb6\xe0\xc8\xec\xba(.\xb7\x8e\x17Y;\x9e\xa9q\xf4\xa9\xef\x16\xe7\xd4l\u0091\xb3\x8645$\xb9+Ć\xaa>\x81\xa7\x99\x1a\xd5\xf8^\xccJ;\x1b\xa4\x9e\x93\xa3\x1eՆ\x87\xd2kb\tK\x1c\xc3j\xd7A\xee\x9b\xc6|~\xe0\xe6\x06\xdb6G\xf8\xbe\xa4\xd33|\xec\xae%\x1exb\x15\xa5 \xf0\x15JB\x18(\x86\x1b\x9a(\x94\xbc\xee\xb6a<O\x0e\x9c\x87\x94\xeb\x8c'\xc2\xd2\xed\xe5kt\x16\xad~NM;h(\xb7\xf0\xf8\xb9\fk\xf9\x92\x10\xbc\xef\x85j{\x9f\xb7A.\xb0\xfaO\x9do\xe5\x0f\x1d\x98ϸjR\x0f\xe0\xf7\x03\xc7cF\x87=\x92t\x1dM\xbdP\x1c\xe7@;\ac$\xa9KI\x83\xc0eM咢\x8c\x1a裔\x12\x05,\xee\x9e\xc7\xf3$Q$\xc9s\xa8\x97$C\r\x9fJU?\v\u0588\x02ɦɐ\xcd\xe3>\x9b\xa6\x02\xc2@Ҩk\xc6G\x05\xff\xf6\xe76p\xcf7V\xf8\xea\xa3mr\xeb\b\xa7\xccX\xda=C\xbd\xe6!\x95,6Qa\x10\xe5<\xbb*`\x804\xb5H\xe9bc/\xb6\x04\xca\xe0+8\xb5\x9cs|1\xeb\x06Z\xfc\x87\xb5\tD/d\xf2\xd0\v\t\xeakF9\xef\x97u\xf9l\xd3\xdc2R\x91\x8c\x95%\xb9\x85F(\xb9\xe7p\xdfq\xd1B\xd0\xed\xe7\x84\x05e:\\\xd5\xd4+\x9aZYR\x89\x14%_Q\x8e\x94\xebٸ@^*$xZ\x82\xb4\b\x04\x9d~N\xbb\x97R\xf2]\xbfH\xbd,y\xfb\xab\x87\xa8\x94\x14\xd3S%<-\b\xca%\xc7\xfe)I\xe0\xc1MWW8|\xbc\xc7\xf8\x90G\xb3\x9bc\x81\xe1!\x0f\xcfW\xec\xd8\x1ar\xd35\x15vo\x8f\xa8\x94\x15咢RVt\xba\x86kw\x95\b\x83u\xd9\xeb\x85W\x92\x18N\x9dOع%\"\xcb,\x9f\xbd{\x11_\tF\xb4\xe5ˇ\x13\xfa\xa9\xbdO\b\xde\xd7\xef\xe4/\xb8\xdb\xea\x05\xb5#\xac\x158\xa5\x8a\xfeZf\xb8\xf2\xc4R\xbe;\xf2\x05\x1bj\x8aȃC\x8b\x0e\xa2/\x87\x92՞!\xf4$\xb5\xb2\xa2\x14\xba\xe2ʙ\x80A\x80ύ\xcb\xc2\xc6j\x8a\xa9a\xc5xݝ\x92v߲\xbc\x92R\xf2\xe1\x86\x1d%\x9a](\x05\x96W\xbf\xb4B%r\x8f\x19\x1f\v\x18\x1ao\xb0a\xd7\x1ejc\xd3\ba\xc8\xfa\t\xc2$\xd4*\x8a\xaf<\xd0\xe2\xc6=\x15\xfcH\xf1\xc8Sm&F}\xa2\xd0ŇjY\xb1yC\xc0\xb6M!\x9b\xa6\x02\xe6\x16R,\xb0uCHn\x1c5p\x91\xa0 \xb3\x9c\x99Iض)\xc4\x18\xb8\xff\xa1\x16\xa1\x12\xb4\xdb\x19\x8f\x9dIQ\x92\b\xf8t\xbf\x93\xbf\xe0QP\xdfQK\xdb\xf2l\xdc^\x99\x8bߜ\xe6\xf6\xbe\xbb\x8f$\x1c\x98\xcb\xd0B\xd0M\x1c\xdd\xea{\xae\xe1\xdfZQP\xa1\xa0\x11\x036ni%gy5c\xb4\xae\xd8<\xae\x18mH*%A\xb3c\x98Y\xceYh&\x8c\xd5$\xaf{I\x95\x99\x96%\xcd\f\xd7^\x1eP\x0e\x15&\x83zU\xd3n'T\x86F\xa9\x8do\xa3>q\x19\x13\x97\xed\xa2\xdbK\xe8\xf7r\xb6Livn\t\xb8\xeb\xbe&\x1b\xc6|n\xbfy\x88\xcf\u07fb\xcc\xe3\a\xbb\xf8\x9e\xa3m\xb5\x96\x94#\xc5\xf4\xb8\xcf\xdb_?\xc2\xd3G\xbb|\xfd\xb1UGLe\xf6\x19\x06q\xfa*\x8a\xe2\x14\ti?\xe3\x1b'\x13\x02O\xe0)1iᵍ\x89@\xbc\xd0\xf6\xb7\xef\xa8ag\r\x0e\xe8w\xf2\x0fx\x91z﹕\xbc\xb2mD\xb3\u007f&'\xf0%\x95@\x81\xb0\xf4b\xc3H\xcd\x1b\xb8\xa6~bX\\ɨ\x95%;\xa7\x03\x86\n\tg\x9a\xc3\xf1\x99\x8cc\xe7R\xba\xfd\x8c\xbd\x9b\x03n\xb9\xb2\xcc\xe9Ŝ\xd3\xf3\x19Ƹ\xfa\xe0k\xfb\xfbt\xfb9;\xb7\x86xZ\x90\xf7Wp\xd9LN\x00\x00 \x00IDAT\x88J]\xb2,\xe0\xe9\xaf}\x8d\x03O\xcf\xf1\xf5\xc7:\x1c;\x13\xb3q\xc2\xe7\xa9\xc3=\xae\xda]\xc1\x0f\x04\xd7\xed\xa9r\xfcl\x9fC'z.\x90\aj\x90\xfck%\xb8nO\x99\xb3s\t'\xce\xc6TʚR\xa1Z\x94Rp\xf8D\x8fR(\x19\x19v}\x88\x9f\xbbg\x99\xfd'\xfa\xb4c[\xe8\xae,\x02q+\xf0\xf9\xe6l|\xee\x85\xf4\x87|G\x06\t+z-\xa6\xfc\x82\x10\xfcpj\x10\xf3\x1dC7u\xc12\n%a Yhe\x8c5\x1c%\xdbj\xe7X\x03\x1bF<\xa6\xc75\xbaH+Z\x1d\xcb\xd1\xf39g\xe6R\xeae\xb8\xf5\xca2\x97M8\\\xe9\xe93)Y\xee>\xf0\x86Qͣ\a{<q\xb4ǡ\x93}F\x1b\x1e\xf5\xb2\xe0\xdc\xf1%N\x1d:\xc4\x17\xee>\xcf\a?\xb5\xc8\xe7\xeeo\x91f\x96+\xb6\x85\x1c9\xd1\xe7\x8a\xed%\xa2Pa,l\x9c\f\xd0Z\xf0\u0601\x0e\xfd\xd809\xea\x0f\x1a\xad\x10\x82-\xd3!Պ\xe6\xecL\xc2\xf2j\xc6\xf8\x88\x87\x14\x82o<\xb2\xc2U\xbb*`\xa1\xdf7|\xf2\xcb\v,\xad:\xfd\x96\x90\x03\xb1\x90\a\xfcHX\xd1&\xac语\xb5k|\xbbFQ\xdf\xc9\xe9(^\xec6ky\u007f\xb5\xac\x82\x91z\xc0L3C\t\xa8\x95\\\xcc\xf0\xb4\xa0\xd9\xce\x18\xae{ĉ\xc1\x1a\xcbH\xc3c\xb4!\a\xe2\xf5\x99e\xc3\U00059719\xa5\x84m\x13\x1e/\xbf\xa2D)\x90d\x06N̥̯8\xb457\xd0\xed\xe5\xbc\xfc\x9a2\xad\x8e\xe1\xd4l\xc2#OwH3\xc9R3\xe5k\x8f\xad\xf2ɻ\x97i\xae\xe6\xbcdW\xc45\xdb#\xeeٷʎ\xa9\x80\x89\x89\x80ZM\xa3\n-o\xad\xa2\xd88\x19p\xfct\xcc\xf13}\xb6N\x87\x83\xac\xc2\x18\b|\xc9Ը\xcf\xdcR\x8a\xaf%ղ澇Z\xdcrC\x9d^l\xe9\xc79\x9f\xf8\xe2\"ij.\x16\x8f\v0V\x84\xc0\xedBpsX\xd1\xf74g\xe3\x95o\xf7\xb4\xa8\xef\xd0\x18{\x80\x8f\x04\xbe\x9c\x98\x1c-\xa1\x14\xb4\xbb\x19\x9e\x96T\"M)T\x03\xeeZJh\xb5\r\x8d\xaa\xc7x\xc3\xc99\x85\x80s\x8b9G\xcfe\xb4:)7\xec\b\xb9v{\xb8\x9e*\vX\xee\x18\x96V\x8dK\x06\xa4\xa0\x1b\xc3\xe3G\xfal\x99\xf0\xd88\xee3\xd6\xf0x\xe2p\x8fS\xb3\x19GN\xf7\x19kx\\\xb35\xc2\x1a\x17\xa3\xae\xdd^br\xc2gϕբ\xbe\xb8\x90.\x10l\xda\x10\xb0\xb0\x9cr~>a|X\x170\xbd]\x17\xa1\t\xc1b3\xc5Z\x8bV\x82\xa9\xf1\x00\xa9$\xddn\xc6_}j\xce1\xa2\x85\x0e`\xf3d\x99\x91z\xe8\xe2&V\x9a\x9c\x1d\xd6\xf2K\xc5ԣ\x87\x9b\xb3q\xf2\xa2\xd6!\xcf\x18\xc5w5\xf0\xd1\xc0\x97\xdb&FB\xc0\xa9QLq\xf4\xad\xb0\x03:\xb5Q\xd1$\xa9\xfb\u007f%\x14\x84\xbe \xcb\xe1\xecRΉ\x19Ǒߴ+b\xeb\xb8GzA \x15@%x\xf6\"\x8e6|f\x97-i\xe6R\xeaF\xcdCK\xd80\x1c\xb1}\xcaC(ض1dj\"\xa0Z\xd7\xd4\xeb\x1e\xc6\xdaK2|\xd6\xc2K\xae\xaa\xf2\xc4\xc1\x0eGO\xf5پ9\x1cdW\xd6Z\x02O\xd0Z\xc9\x18\x1bR\\\xb3\xa7L\x9e[\x94p'5\xcb-\x81p\xfdW#5\x1f\x80\xd5nB/6\xc4I\x8e\x15\x83\x9d\xf5\x1f\x80\x9flL\x04\xff\x1e\xf8Hs6\xce\x1b\x13A\xf5\x82\xa4*\x13\xd0_\x9e\x8d\xf3\x17\x04\x9d4&\x82[\x80\x0f\a\xbe\xdc8>\x1c\x16\xcd4\xcf\x06\xc9\xc4\x05\x1a\xa7<wip9r\xc68z>c~\xc5`\xad\xe5\x9am\x01\x9bG\xf5%\xe5E\x17\xd6'\x17.\xa2Vn\xc2\xc3\x1a\xe4m\x80(\x94\\\u007fM\x85M\x9b\"\x82\xa2\x96\xb0\xd6i\xc2.\xf5\xbc\\ \x03\xbazW\x99\xf9\xe5\x8433\t\x9b\xa6\x82\xc1\xcfˑ\xa4\xb5\x9a!E\x88(\xb4[J\nVW\xb3\x81\\\xc8S\x82n\x9c\xb3\xbc\x9a\x92d\xb9+b\x8b\xb6j\x83-(\x14\xb1\x05\xf8\x10\xf0\xba\xc6D\xd0\xcd\r\xd7\x18k(\x8ec.\x04qc<8\xab_\x80\x9bz\x87\xb5\xfc~\xe0\xcb\xc6\xc4H\x84\x92\xcfś\x88\xc1\tY\xa3:\xab\x81$\xf4\x05\a\xcfd\xce\r)ؽ\xd1g\xeb\xb8&\u007f\x0e\xd7:T\x91\xd4\"\xc1j\xdf>\v\t]C]Cߵ<t\x13C\xb9\xea\xe1\x87\n\xe3\xf8\xdbK\x1a\xe1\x99Ɲ\x9dOh\xd45C5\x8f\xa1\xea\xb37VX\xf4G\x16\x12s\x04\x82̬\x8b\xea\xb2ܒt3\x02O2\xd6\b\xa9Dj\xc0p\xc6IN\xab\x93\xd0\xe9\xe5`\x85\x04\xde)\x05l\x9e\xf4D\xbdR'\b\xab\xf4\xe3\x8c~\x920\xdf\\\xb5߶A\x84`\xa21\x11\xfc\xb2\x10\xfcB9Ҍ\x0f\x05\xcf\u008a/\xec\xd3\xcbs\xc7\xf4i\xe5f\x8c(\ta(x\xf2DB\xb3m\xf0}\xc9HU\xd1(\t\xce/\x1a&\x1a\xf2\x92\x1c}\xc9\x17ܰ=\xe0й\x8c\xa5N^\x18\xce\t\x17\x04\x82$\xb1\x83\x85KRK\x10\xf9h\xadH/8\x15B|3\xb1\xb2\xe5ßN\xb8\xf9:\x9f\x1b\xae\xb6\x83\x8dt!\x14S.9\xa9+\x96\x81X|@\xcc\tA\xe8IF\x1a!\xa1/\x9f\xe5\x1a\x03_1\x19\x96HS\xc3\xecr\x8f81\xc2XA\x9e[\xb6N\x95\xd90\xb5\x8d\xcd\x13C\xc5\xd0\t\xd1\xff\x96\x06\xd9zYy\xd7J7{sn\xf8W\x9e\x16\xbb\x1bU\x9fRI\x0f\xe8\xd8\xe7\x02\xf5sc\xe9\xf6\r\xf5\xb2rJw\xe0ع\x84$u\x18U\xe8\t\xb6O(NΗxٞ\xcb8?\xf78\xb5\xb2wI\xd5_\xe8\t\xae\xbḅ\x9fh\xda}K\x9a;\xdf\x1e\x05\x82Ǐ\xf5i\xf5\xdckzJ\xe0\x15CͲ\xccM\x00\xfa\x16\x9b\x8c\xc5f\xc6\x1d7\xbd\x82\xe5\x95sd\xe99|\xffBW\x06RZ\xeaU\x8d\x96b0\xe9\xc1ɊܧoT<\x86j\xfes\xbaƵ\x8d\xaa\xb5`r8bn\xa9O/\xce8\xbd\x90r\xe2\x9e\xe3\xd4+\xe7x\xf7\x0f\xddN\xad\x12a\xad\x15\xba1\x11\xdc\b\xbc\x14\xb8\f\xf0\x80\xa6\x10\x1c\xf1=5+0\xff\xddr;{\x99\x94\x8c5\xaaZ\x94K>\xf5\xb2\xa4\x12:\x17ҋ-R<7\xcd\xd2\xed\xbb\x8a\xddӂv\x11\x04\xa5\x14TJ\x9aR YZ\x8dy\xfd\xedo\xe4\x15{&\xf9?\xde\u007f\x80Z\xd9>\xa766\xcb]\xdc\x18\xaa\x14~\xdbwCj\x86\xea\x9aV/CX\b|\xc1ə\x8c\xab\xaf\x9f\xe2\xf4\xc1s\xa4i6Ș.)bӒ\xfb\xf7\xad\xf23o\xbe\x81\xbb\xee\xe9\xb0\xd2>\xcd谾蕍\x81\xa91o \xc6Ɓ\xbeĉ\x8b%\xa5H\u007f[r g\\\xc1\xf8p\xc8\xc9\xd9\x0e\xca:}\x801\xb0\xd8jӨ\x96ȭE\v!\x1e\xb8\x90\xa9Zk\xe3J\x92\x1c!\x04\xc35\x8fJ٧\x1c\n6\x8d*6\x8e(\fЏ\xe1\xe8L\xcaR۠$\x03\x19\xbe`\xbd\x9357\xae5\x81x\xfdxW*\x1a_\xbb\t\xa3\xadՌ\xeb\xf6\xeefh\xc8\xe7\xf6\xabv\xf1\x99G\x1eg\xd7F\x9fo\xb6\xb1\xad\x05\xed\xb9\ue9b0\xaa\xb8\xe9\xda*\xf3_i\x82u\xd3!\xb6m\xa912٠\xd7\xee3wf\x91,͞\x93\x99\x8b\x93\x94vw\x1b\x13c\xa3l\x1e\xaf\xf2\xd4\xd1>\xaf\x1c\xad>k\xa7˂\x84Yks\x13\x12N\xcf\xc4nԇ\x14ߒ\x01\x14\x12|-܀\")IR\x9f\xd9\xe5\x04\x9b\v\xe2$\xa5R\x0e\a\x1bG\xbf\xe2\xba\xcbә\x85e\xaf\x1a8\x19\xcdR7fa\xa5\x85\xa7\x14\xa5HQ\x0e%\x93\rŶI\x8d\x96\x85\xf6\t\x88<\xb8lʃ\x99\xach\x1f\x86\x96\xb5E\x01wiye\x18*\xa7\xf5\x15\x02\xedPF\xda\xfd\x8cL\x8f\xf0}7\xeca\xff\xc9\x19\x8e\xcf̳y\\#\xa5\xeb\xb2\xf2<\x81\xe7\xb9zFkA\xa5\xaa\xa9״\v\xe6\x81\xc4\x18\xcb\xeeM>Y.ع\xc5g\xf7e\x11Y?at\xac\xc6\xcab\x8b\x84\xbc\x90\xef\x14-\x10kP\xbe\x16|\xfc\vs\xbc\xed\xf5?I/\xee\xb3e\xb4\xcc'\xee\xefs\xfb\xcdU\x06\xf9@ᗝ\xebs\xb1p-\xc3{\xfc@\aO?\xdb k\xfad\x81\xc0\xf3\xa0Q\x92\x94B\xe1\xa6D\x15\x06\x8a\xbc\x80\xe5Ռn\x96S/\x95\x98\x18\xaab\xacu3öo\x9e̛\xad\x15\x0f,+݄4˨\x95=\x86k\x8aɆb\xa2\xae\b}w\xb4\x8c\xb9؏\xd4\"\xc9PY\xb2\xdc\xce\xd0ҍ\\r\xad\xc4\xcf\xde\xe2Z\t\xc2Pb\x9d\x1d\x90ҁ\x8f\xb3\x8b-.ߺ\x11o|\x0f?\xfc\xf2\x93|\xe2\x01\xc1\xc9\xf99\xae\xdf\x1d\xd1\xee[\xce,\xa6\xcc7{t\xfav\xb0[\x85\x84r\xa8\xb8lcH\xe8\t*EKZ\xe8+\xbe\xfc\xf9\x13\x1c>\xd5\xe3\xdc|B\xa7\x9f\xe1iA\xa3\xae\t<\xc9Ȑ\xa6QQl\x9e\xf0\x99Y\xe8P.\xbd\x94\x1d[7\x91'm\xfa\x9d\x16\xfd\x9e}V\xd5=\xf8\xf7\x82\xa1gyn9r\xaa7\xc0\xb9.4\x86VN,\x18i\xc1H]\xe2k\xe7\xe20.5\a\xa8\x96\x14\x8d\x8af\xa5\x93p\xe3\xdem\x83\x1793\xbb\xa4\xf4\xccBS\xf8ʉ\x98\x96\xdb=\xb6m\x18\xa2Z]e\xb8\xbcVe\x8b\x8b\r\xf1\fu\xdePE\xb2\xd2sY\x83\x96\x02O\v\xe2䂁/k\xa7\xc3wS⬵\b)0ֲq4\xe0\x91\xfd\a\xb9\xede7\xd0\xcf\fӻn\xe6\x8di\xcc'\xf6Y>\xf8\x0f\xa7A\xd8b:\xa9[\x89\x8bN\x9dMy\xeahwPpZ@\x89\x16\xc6:\xf7\x10\xf8\xeb\x1d8\xf2\x82\x9a#\xcd-&7\f\xd5\xca\xfcƿ\xbd\x8d,\xcbQq\x9b\xb4\xbb\f2/z'/Q\xb7\xc8\xf5\xc2\xf4\xf0\x89\x1e+\x9d\x9c\x91\xba\u007f\x91\xf1\xa4\xb2X$\xf5\x92`\xb4*\v\xba\xd9\xf5\xe8\xafՈJI\xba\xa9an9A\b\xc9K\xae\xd8F\x9a\xe5xZq\xe2܂\xd2O\x1e=\xa37\xd4K\xe4y\x8e)\n\x9a4\x15\xf4\x93\x02\xf2\b\x8c+r\x9e\xe3\xf2=7\x037\xcf\x01a\x88BE\xb7\x9f\x13\xa7f %\x95\xc2\x05\\Y\xb8\x8d\xb5թ\x84>\x8f\uefcf\xc5֝\x94B\x9f\xb8\xb2\x99m;\xaf\xe1-ZQ\x0e<\xfe\xee\x1bǑ\xd2\x19P<S\x95!\\\xd1V\x8e\xd6w\xee\xa0\t\xe89b\x90R\xae\x1f\xa5Zo\xf0\xb3\xefx\x17\x93c#\xd8,\xa6\xbfp\x84C\xa7Ρ\xada~.\xa6Z\xd7\xcf \xa7\xd6Sa)\xe1Ĺ\xde \xa5]\xdb$RZ\xb4\x10\f\xd7$\x8d\x92\x18\xb0\xa5k#i\x85\x92\x83\xae\xdf#\x87;t\xfa\x197\xecނ\xefi\x17\xb3ӌť\x95L\xbd\xf2\xc6\xed\xbf<\xbf\xbc\xa2<\xad\\q\x15\xa7\x94K\x1ak2Ҽh\xea\f\xe4s\xe6\xf2B\b\xe6\x9af\xd0\xf6k\f\xb4\xda)R:*um\xf1\xa2\xc0I5mQy\a\x9e`\xa8\xac(\a\x96\xfb\x1e;\xc7\xcbo\xb8\x16)\x04y8\xccx\x90\xa3M\x9b]S5\xba}\xc3r'!\xc9\f\xaa(0\xb3\xdc\x0e\\\xe8Zk\x9ce\xbd\x0fE\xc9\xf5Vl)\x85\x9b\xe9`,\x16\x8f]\xdbw\xf3\xf3\xefx;7\xec\xda\xccܹ\xa3\x9c;\xf20'\x8f>͗\x9e\x9a\xa1\x17\xf7\x89\x02\xc1Ȉ?\xe8\x9aZ3\x8c\x03B\x05\x81\xaf\xf8½K<u\xb4\xcbH\xc3g\xad\xf3G+\x98\x1c\xd2\xd4\"\xf1\xac\xd6q?t\xa3҅\x84\xc0\xd7\xdc\xffD\x8b43\xbc\xfa\xa5W0\\\xaf\xa0\x94\xe2\xe4\xf9\x05:\x9dN\xaa\xeb\x95\xc8\x1c\x8aSJ\x81\xa6^\nX\xee\xf4Y\xe9X\xeae\xa7\xecHr\xa7\xa1\n=qɺc\xad\x1dL\x14q\xc5Ӓ(P\xf4bC\xadt\xf1\x90\x19I\xe1G\xad%\xf2\x15\xbe\x86\x93\xb3\tC\xf5\xc7\xf9\xfc=\xf7\xf2\xe6\xd7ށ\x10\x11K+\x93$ٓ\x18\x03\x93\xf5\x88\xd5^\xcaS\xe7\x9ah\x05\x95\x92b\xa8\xa1\x1d\\\x92[\xaa%\x87\x8d\xa9\x82\xa7_\xed\f\x9a+\x89\x13\xcbj\xc7\x142Q\x8b\xaf\f\xf5P\x11\xb6\x8f\xf2\xf4\x03\x8f\x10\xf7ڤI̾\x13M\xbaY\x87цf\xdf\xc1\x0e\xbb./\xbb\xc2S\xac\x8f)\x1fp9\xb1\xe1\xe9\xe3\xddb\xb3\xad\xaf\xc8Ԑ\xa6\x12\\l\f?p\x02\xbb5l\xcc\xf3$\x8b\xab)͕\x94\xe9\xf1:S\xa3C\xeb\xc2\xec\x99E\xa2\xc0C\xfb\x9e\"\xf4\xbdb\x14\xb7\xa0\xec{4\xdb1\xe5\xd0\xe9\xa92cI2\xa7dח\xf0\\®Cd\xaa\x98\xfcըz\x9c_\xe8\x13\xa7\xc6\xc1\x0e\x85!\xa4\x14\xa8\xdcYp\xb5gX\xe9KV\xfb\x86;_\xd5\xe0\xe1'\xff\x8e\xa7\x8f\x1c\xa3\xae\x13Ν;I+\xedQ\xafYvm\v\xb8\xf1\xe5%JQűu\xc5g\xf6=\xcd\xc8x\x85J\xa3\x84\x17\xf8$q\x8a\xb1\x96\xa4\x1b\xd3Zl\xd3\xef%\x05|\xe2j\x98\xd6jN\x9eY\x96[g\xf9\xc3\xcf\x1eF\xe4\xbeke\x13\tS#\x8a\x9d\xd3\x1eB\xc0\xd9\x13)&\xb7\xe4\x99@\xe9\v\xf04\xe9N\xc8\xd9٘\xb9ŔR\xa8\x11\u009d\xd0\rÊ\xb2\u007f\xf1v\x8dJ\x1eY\x9e\xb9\xec\xach\x12\n|\xc1\xbe\x87V\xf0\xb4`\xdb\xd4\x18\x95R\x88V\x8a\xd5N\x9fn\xb7G=\xf2ѕrD\x18\xfa\xa4i\x8a\xaf\x8b!a\x89\xa4\xd9I\x19U\x02\xac \xce,\xbe\xb2(O<\xcbu\xa9\xa2M,7\x16\xe9\x06|\x12\x06\n\xdfs=x\xa1/\a\x8d8B8\xc8\xc3\xe24\xbf\xa7\xe6S\x92\xd4\xd0\xee\xe6\xdctU\x9d$=@76l\xd9\xe2Q)U]Ǯ\x810pi\xa31k\xbd\xe2>CcU\xea\xe3C\x8cm\x18!\xaa\x97\x8b\xa4\xd4`\x8d\xe1\xf8\x13Ǚ;=O\xab\xd9'Mr\x94\x84R(i\xae\xe44\xaa\x9a\xb7\xde^'/4d\x10\xb2\xd26\xccͦtc\x83\xf6$qj\t\xc2\xf5\x1ep!\x06\xed\xa9<}\xb4\xc3\xc2R\xca\xc6\xc9\x12\xd6B\xbd\"\xa8DE\xe6P\xa4\xfda\xb4n\f\xcfw\xa3C\xb4\x86\x99ń\x99ŔR\xe8\xb3w\xfbt\xd1p\xa497\xbf\x8c\xcd\v\x95\xbfV\x8a鉑\x8b\x02a\xd9\xf7X\xed\n\xda=G\xbe\xb4\xfb\x96\xccZ\a\xa8=\xb3\xe8\x01\x02\xfdld\xb6^q\u2d75\"+\xcd,Y\x0eqjH\x8b\xa1eif1(>\xf6\xe5\x15>{\xdf\n\aO\x1aj\xe5\x80\xc8\xd3\xd8|->X:]K\x92X,\xb6\x18\x8d!\a\xad\xd8A\xc9[\x9b\x94肯t\xb7\xb0XC\x83\x8d\x81^\xdf\xd2\xed\x19T\xd17\xb8&&0\x06\x16\x9a\x86C'\xfb|\xe3@\x97c\xf3)\xd7祖g\xee\xf6\x16yf/\xca\"\xe2\xc4\xf0\xc0\xe3\xab\xf8\x9eB\n\u05fd5T\x91n\xc6p\xe1\x9a\xfc@\x91f.[s7\t\x90\x05t$8z\xbaG\x9e\x1bƇjl\x9c\x18\xc6\xf3\\\x8fͣO\x1fg\xa8\x1aa\xd7\xf8\x90\x8d\x13#\x9c\x9dY\\\xf3\xf0D\x81\xa6ٕtbC\xe8\x1b<\x1fZ=\x8b_\xd5<s\xec\x95\x01\xaa\x91`\xa5\xe7x\x02\xa5\x04if\x89\x02\x85\xf6$\xab]\xc3pթ\xcfs\\W\x95\x12\x82~\xe2\f\x94[A;\x86C\xa7S\x1e?\x12\xf3\xc5\aa\xe3\xb8\xc7ؐfr\xd4\xd5C\x8d\xaa\"\x8e\x05\xa5P\xd1\xed\x1a\x10\x8a\xb3sM\x16\x1f\\&,\x9fg\xd7\xd5\x1bQ\x9e\xe2ĉ\x16\xa7O,\x13\xd9\x0eCUI\xd2OQE\xdb\xdcj'\xa3\xdd3\xcc,\xe64W2\xce/\xa6\xcc73\xba]\x83\x94\x82\xeb\xaf(\xf3ҽej\x15\x851\x0e\xa9\x90\xd2\x19T)\xb71N\x9d\xebs\xefC+L\x8e\x04h鲼@\xbb\xe1\xfe\xa2\x98\xa5\x92\x9b\xbcpSN\xfa\xb4\x96\xef\xaev3f\x16S,\x96[\xaf\xddA\xa3Q#\xf4<>\xf4\xe9{\x99h\x94\x8bY\xf7\x85A&\x86\xabl\xd94\xc5\xf1\x13\xa7\xf0\x94\xc2\x1aK\xa3\xe43\xdfj\xe3)ŰVt{\x96U\x9d\xd3(\xe9A,Y\xabz\x87˂\xb3KEPW\x82>\xae\xaa\xaeF\x9aŕ\x84F\xc5\x15Pij\xb0\x85kIS[tƺ:#\xf0\x14\x95\xc8q\xde\xf3˖\xd9ń\x87\x0f\xf4\xc9RȌ\xc3Ĳܐ\x14\xd2\xce0\x90\x05\xfca\x10b\u007f!)Z\x9bs\xb5>\xf3R\bQ\xdc\u007f\xc45\x0ey\xda\r\xde,\x05\x92J\xa8\x19oH\xae\xde\x11\xb1k[\x88\xefI<_p\xf2|\xc2ذF\xb4\r\x95\xaaeÄ\x87\xc9\xe0#\x9fY\xc0ӂRI\xe1{\x16),Z\xae\x0f?\xc8M^\xa4\xba\x10F\xeb\xc6X\x031\xe7\x9b1\xdb&\x87\xf8\x91;_\x89\xf6\x02\xee\xbb\xffq\xba\xed6Cc\xf5\x01ܤ\xa5\x10\xf4\x93\x94\u05fc\xfc*~s\xff\x11&\xea\xae\xe5+\xf2=\x94д\xfb\x86(\xb0\x94\x02hu\f\x81\xce)\a\xea\"P\xd1\xf7$\xe5\xc0\xd0M,\x9e^\x97\xeb\xd7*\x9a\xd5n\xc6|+ej$pl\x9b\x86no\x1d\x94\x14\x02vM{L\x8fh\x94\x10\xa4\xc6\xd2O,F\xb8\x1b\x13\xe6V\x90\xa4\x96\x95n\xcer۲\xb8\x9a\x93\x15\xed˞\xe7\xdcF\xb5\xa4\n%\xa4\xfb~?1\x03\x81t\x18(*%E\xa5$\xf0\x95\xeb\xe9\b\x03I%\x92\x8c64\x95\xb2*hX\x81R\x05nU\xec\xf8\xc3'\xfb\xdc\xf6\xb2\x06&\x87\xa3\xa7\xfa\xdc\xfbP\x8b\xa9\xf1\x80\xd0s\xa9t)p\xf5\x87R\x12+܀f!\xc0\x0f\xd5z\xdd\"\x04R\t\x1e;\xdc\xc1\xe6\x86\xf7\xbc\xf3\xb5LNOq\xe6\xe4y\xee}h?S#\xb5\xf5)v\x80\xa6\xe8\xc1\xabUJ\\{\xe5\x0e\x0e\x1c<F\xad\x1c`\xace\xacZb\xa6\xb5\x82\xa7p7NQ\xd0\xec\x18\xa2\xa2 Z\xab\x90\x8d\x85\xe12\xb4\xfb\fv\xcc\x1a\xba9\xd2\xf09?ߣ\xd5ɩW\xbc\x01\aa\x8aѓ\x8d\xb2dۄ\xa3ns\xeb\xda\xcfʁ\xdb\xedBB/\x86\xa5vN\xb3m\x98]J1X\xb6M\xfa\x8c\xd45\xa5PШ91\x85K\x1e\x9c\x1c'N,\xcb͌\xe6J\xceJ\xdf05\xa6\xb8\xf6\xf2R1CٝH!\x19\xc8\xf6\xd7\vO\x97\xe6Ʃ\xc5ZAk5#\b\\\x17՟\xfe\xdd,a(\xa9U\x9c\xdb\x0e\xb4\xa0\x1e\x15Ƽ\x00\x9a\xf7\x8a\xbe\xfa\xb5\xcdV))\xee\xde\xd7bf\xa1\xcf\xed7]\xc1\xab_}\v\xd5Z\x99G\xf6\x1fE\x9a\xac\x00\xd8/\x80\x98\x84\x10TJ\x11\xc6\x18\xee\xbc\xed\x06\xbe\xf1\xe8\x01jeǑ+)\x89<\x9f4K\x99o\xe5l\x18Vt\x13\xcbj?\xa7\x16\xaaA5j\xac%\f%\x9er\x12\x1fO\xb9\xc6Nk\xa1\x14*\x1a5\x9fV;\xa5\x14\x16S\xaa=I\x9c\x18\xf2\xdc\xd2O\x19\x14q\x83\xe0$\xddX\xac\xb9\xa5\x9c\xe3\xb3\x19\v\xcbnHʮ-!\x9b'|\xeaU\a\x8d\x04\x9e\xeb?\xafW\xdc\xfd\xa6\xacuP\x8b\x00\xe2\xd8#\x8e\xdd\xd8\xf2\xb3\xf3\xae?d\xcfe\x11\x97M\x87\x03jw\r\n\x19|](RB_\xf2\xc4\xe1\x0eW_^&\xcb,\x9f\xfe\xf2\x12\a\x8ew\x19\x1b\xf2\xf1\xb5 \xcfa\xbc\xa1\xd0\xca\x19s\r|P\xbe\xc2\xf3֍\x11\xfa\x8a\xb3\v\t\x8f\x1f\xe9P-y\xfc\xf4;_\xc7\xc6-S,,\xb6x\xe0\xd1\x03\x94\x82g\xf3?\xfa\xf2\xad\xd3X`e\xb5K\xad\x12q\xfb\xcdW\xf3\xf0\xa3\a\xa8\x96\x1c\xaf\x1c\xf9>\xabI\x8aR0\xd72\x8c\xd6%\xcdnN5\x10\x18\x1c\x06f\xad\x9b\xdeZ\x8d\x04Ͷ%\xf0\x05qz\xa1\xc8\xc1g\xb5\x93\xb1\xda\xc9\x18\xae\xfb.\xbd+\xdc\xc3J7狏w\xd92\xe6Q\n\xdd\xef\x9d_\xceY\\\xc9HSKn\f\x9b'}\xf6l\x8b\x9c@\"\x10\x94ˊ0\x90\xee\xeb\x92\xc2\xd3bp\xbf\x10Q\x88+\xa2h}\x9e\xc9АǞ\x1d%\x9e8\xdc\xe5\xee}\xabl\xdf\x18P++\xea\x15=\x18\x13\xa5\x95`\xb5\x9b\x91\xe50\xda\xf08q.f\xd3T\xc0\x81#\x1d\xfe\xfe\xf3\v\x94#E\xb5\xec\x91\x1bK\xbd$\xa9\x84n\x1aDn\x1c\xb4\xa3\xb5,\xa6vۂkQ\xe49\xdc\xf3p\v-\xe1U\xb7^\xc5\xed\xaf|\t^\xe0\xf1\x17\u007f\xf3\x8f\xf4\xdbmʡ\xffl\x83\b)x\xfa\xf0I\x94\x92\\\xb3g\a\xd7\xef\xdeʣ\xfb\x8f\f\x86@\x96\x02M\xab\xeb\xa8\xc9$\xb5,\xad\x18\x86*\x92Nb\x89<\x97\x8a\xe2\x14{N\xba\x8f\xc5S\x17ӦR\xc2P\xcdc\xb1\x99\x10\x05\x0e\xd6\u05f9 I\r\xbe'Y^\xc9Xle\x83\xd8#\x84\v\xf8C5\xc9e\xd3\x11\x1bF5Q \xa8\x94\x9d\x8a\xbd\x1ci\xaaU\x8d\xef\xbb\x147\f\x95S\xc0[\x06\xcauc,I\x9c\xbb\xec.\xb3d\xb9\xe1\xa6kk\\\xbfǥ\xb9g\x17\x12\x9e>\xd1#\xf0\xdcM\xc7\xd6\x06\x1bl\xdf\x14r\xe4t\x8f\x1b\xf6Thw2\xfe\xe4og\xe9Ɩ-\x93\x81å$\x8c5\x94\xab\xbdr\x83\xd0rp\xf7\x865c\xf8\xbeC\x12\x1e>\xb0\xcaR+\xa5V\xd2\xfc/\xbf\xf0\x0e\x86\xc7G\xf8/\x9f\xb8\x9b\x87\xbe\xfe(\x95п$e\xad\x97[\xab\xe4\xc6`\x8ca~\xb1\xc9\xc4h\x83-\x1b&8737\x00\a\xc7\xeb\x15N-,16\xe2\xd1O,\v-C\xa0\x05\x13\x8d\xb5v\x01Af-qb\x11\x98Bu!H/\xe8\x05\xa9\x95]]2\xbf\x143=\x11\x11\xfa\x8a85d\x99\xa1RҘ5\x82\xa6\x80\xe5Ǉ\x1dL>\\W(\x05\xc3u\x8fZMQ*)\xea5\x9f\xc0WhO#U1*\xbc \x91\x84\x94\x18cP\x80\xf2\xc1\vr\xb2,'\x89s\xe2~\x8a\xf5$\xbb\xb6E\xec\xd8\x1a\xd2\xe9\x1aln\x8b\nƩV\x8e\x9f\xe91=\xee3RS\xfc\xe1Gg8q>f\xe3D4\xc0\xb6\xaa%I\x14Hrk\x10\xca\x19Q\r:t\xdd=\x13uA\xbe=v\xb8M\xa7\x9b\xf0{\xbf\xfe\xb3\\}\xdd^N\x9e\x9a\xe1C\x1f\xfa\x14\x95@?\xe7(\x0e\xa9\xa4.v\xb1dfn\t)$\xaf\xbcio1@f\x8dː\f\x97+\xb4\x8aV\xe74\xb7\x9c^și\xe5Ĺ%\xc9-\xab]K?\xcd\a/\xe4\xe6\xb9_|\x8d\r\x05\x18,\x8b\xadd\x90\x9d\x85\x81\x1a\x00\x82a1\x18l\xf3\xb8fzħV\x96\xf8\xbe`\xa4\xe1Q\xaf+*\x15\xcd\xc8p@\x18h\xb4\xef\xa1<\x85\xf24^\xe0\xe1\xfb>Q5\"(\xf9\xf8Q@X\n\tB\x1f/\xd0hOQ\xa9\x06T롻\x1f\xa2/\xf1\xb5\xeb\xf2\xaa\xd74\xf5\x8a\xa2^u1\xe1\xf2-\x11\x93#\x1e\x9f\xbeg\x91\xaf?\xd9f\xa4\xeeS*\xfa#\x95\x14\x8c54\xb6\x88\x1a\xbe\xefzS\x04 \x8bS\xed\a\xce8\x1f\xff\xf2<\x9dnʏ\xfc\xe0\r\xbc\xe3\x9do$N\x12~\xf7\x0f\xfe\x16Ϥ|3\xc6W\x8e\x0eW\xf1\xb4\xab\x13\x924e\xb9\xd5f\xdb\xf4\x18{w_F/N\a\x84\xd3P%$\xed\vV:逞==\x9fsz\xc10\xd74\xb4\xfb\x16\xbf\x18\xb3jE\x01\xa9\x14\xec\xe1Z\xb5\xeek\xc9pͧ\xd3\xcb\xe8]0\x14\xc7\x02\x9e\x82FU2\\u\x8bS\x89\xdc\xce+\x97\x14\xa5H\xe0\xfb\xea\xffk\xec\xdcc\xe4\xbc\xce2\xfe;\xe7\xbb\xcc7םY\xefzױ\x13\xdb8vb˹ߥ\x90\xa6J\x10\xcdE\xa9\x8a(\x84Ҋ\x90\x02jT\xa9\x84KBU\x8a\nB B\xa0\\\x8aT!\x95\n\x82\xaaR\x89\xa2B)i\x1bY\r\xb4\xa1q\x9a\x944\x8e\x15ǎ\xaf\xd9]\xdb;{\x99ݙ\xf9\xae\xe7\xc2\x1f\xe7\x9bY'\xa4\x0e#\xed?+\xcd\xfc\xf1\x9ds\xbe\xf3\xbe\xcf\xf3\xbc\xcfC{\"t\xb9\x86\x81s\xf0\xf1<\x971\"\xa4 \xa8\x06\xf8\xa1O\xb5Q\xa5=ݢ5դѩQmT\\\xfc\x85/\xa9\xd6*\xd4[Ѹ\xb0\x18Y\r\x8e\xc0?'Wr\xe4\xd37\x9f[\xa3\xdd\xf0\x99\x9c\b\xc6\x15e\xa3\xe6\xcaek\x9dc\x90\xf4\xc5\x18\xb1\b|I\x14y\xe4\xb9\xe1k\xdf鲲V\xb0eS\x93\xcf|\xf2W\x10\x9e\xe4[\xcf<\xcf\xe1\x1f\xbd\xe62\xb1.\xb6 纫\\23\x85R\x1a\xad\r\x8bK\xab\xa4\xb9⾟\xbc\x0e\x11VƋe\xace\xb6\xd3@\xe8Q3\xe6\x1e\xf6\xca@\xd1\x1b:\xf8\xdd+\x018_\n*\x81{\x8fg\xb9\x1e\vʌ\xb5\xb4\x1a\x01\x95У\xd7/\xc6Ս\x14P\x8d\x04\xa1/\xa9F\x82f\xd5C\xfa\x0e\xb9\x8c\x0272\xd0j\x05\xae\xa21\u009d\f\x8fq\x98\x97\xb1\x02!\xa4\xfb\u007f\x99\xa0\x80\xb5\xf8\x81O\x10\xf8\xf8a9\x14$!\xaa\x86\xf8\x81\xbb\x90}oC:3R\x99ĉ\xe6\xd9\x1f\xac\x91\x17\x96v+\x1cKa\xb5\x81\xe9\t\xe9\xc4|eH\xc0h\xab\xbb\xf0\x1e\xc1J/\xe7\xc0\xc1UN\x9f͈B\xc9#\xbft?{\xf6\ue8bb\xb8\xca_\u007f\ue7e8W..\xf2\xd1\xc6\"\xe7\xcf/ѨW\xb9f\xdf.\xae\xda\xfb\x13\xec\xdaq\t\xc68Ӑ\x0f\xdd{;\v\xcb\xeb\xe3\x1d\x14\x85>\xf5\xa0\x824\xfa-UT\x92\x1b\xbak\x8aA\xba!<\x1e\f\x15Y\xae\xa9\x96\xa8腟ɉ\x908դ\x99.w\x9e\xa0\x12\xb8\xa3^\xf1]\x9f\xe0f\xff܉\xf3\x03YVR\xee\x8e\x10\x17\x1c-kK\x87S\xe9\xfc\x1b/\x14C8\xbaս{\xa5\x14\xa5\x17\x8aEx\xee$\x18m\xc6\xcaEp\xf8\xd5鹌\x17^\x1d29\x11\xba˾\xfc\xadfU\x12\xf9c\xbaj\f<\x06\xd2\x15!\vK9\x87\x8f\x0f9>\x97 \x04\\}\xc5e|\xfcc\x1fDVk\xfc\xe2ÿ\xcfd=\xc4\xf7\xbc\x8bH\x92\x04\xebq\x8a\xd4\xc6p\xe4\xf8\x19\xce.\xaep\xe2\xcc\x02\xc7N͓\xe7N\\\xbce\xba\xc3UW\xee$\xc96\x94\x1b\xadj\x95ں!XN\x1c\x8cq\xc1\x03X\x8f\r\xbd\xa1fq5\aa\xd9\xdc\t\xdfQ&T\t$\x8d\x9aO\xb7\x97\xa1\xf5\xc6D\xd5\xc8\xe5\xc1u\xe1\x92jec\xf0\xd2\xd1\x03\xf2-=\x841\xc6EU\x94\xabc\xc7\x16\xb6esj,E\xae0\xda8%a\xb9\b\xbe\xefBb\x8cu?f\x8c\xc5hK\x9a\x1a\xbe\xf6\x9d\x15\xfc\x92ӹ\xd0\xdaovҍ\xec\x99\x12\x18\xb5\xda`\x8c\xf3\x17\x9e\xef\x16\x9c_.8|\"Fi˦V\xc4\xdf\u007f\xee1jS\x9bx\xec\xb1?\xa7&4Ap\xf1ӑ\xe6\x05IZH\x89\x85\xbcP\x9c]\\\xa6?\x88Y魳\xd6\x1f\x8e\x19\xb2ۯߋ\xb8`e\xfd\x8aO\xd1iъ\x15\x9b\x17\x06\xa4\x89ƌd\x96\xc2\xc1\xeaa\xe8^\x1d\x992\xe5\xd0\xe4\xff%\xb5:\xad\xc0\xc98WR\xd2\u0096$\x97\xab\xd4re]\tY>|k I\ryaJU\xa4q\xe6\xf9\xc6`\r\x14YA\x1agdqF\x96d\xe4iN\x9e\xe6\xa8\\\x95.\f\xee;\x16W\xfej\xed\xf2\x10\xfd@:\xffee1\x1a\x9e{i\x9d\xe3\v9\xedf@\x10nа\xad\xea\xc8k\xb8<a\u0590\xa6\x86ť\x8c\xee\xaaba1\xe7\x957\x06$\xb9\xa1.-\x9f\xff\xd3O\xb0}\xff>\xfe埿͡\x17_\xa5Q\x8b.\xaa\x0f3\xd6\x12\xa7\x05K뱔\xa6\x94쌬\xef|\xcfA\x10#\xf4q\xdb\xcc&\xaeٷ\x8bA\x92\x97\x84\x8ce\xa2\x1e\xb1<\xd3\xc1h\xd8~v@\xdaM\xf1-\xe8\x12\x86\xb0¹7\xe4\xfa\xc7\xfb3\x86\x81d\xbaS!I\x15\v\u074c\xac\xec\xec3\xe5 \xf1\xc1\xd0P\x94\x88pQh\x92X\xd1\xeb\xe5\f\xfa9q\\\x90\xe7Δ\xc0Z\x83Q\n\x9dk\xf2$#\xe9\xa7\xc4\xeb\t\xc9 %\xee'\xa8\\\x91\xa7\x05J)\xb4\xb6\xa8\u0094\xf9\x85\xeeTd\x99\xfb\x9dc'c\x9e~\xbeG\xa3\xea\xd1l\xf8\xe3\x93-\xa5\xbb;F\x12(\xe7\x9f\x05\xcb}E\x92[N.$\xbc~&f\x90\x19:\xbe\xe1w>\xfa\x01\xee\xfe\xa9;\x98?9\xc7\xdf}\u1af4\xaa\xe1E\x17\x03 I\v\x96\xfbC\x94\xd2\xca7\xc6 \xa5\x03\xe7\x96zC\x8e\x9e9O\xa5Z\x1b\xbf\x16&;M\xee\xbb\xe3z^:|\x02m\\\x04\xb6\xb5\x96M\x13u\xe6\x86\x197\xf5\a4V\x13\x8e\r\vZS\x11\x83Z\xb0\x81\x04\xbf\x8b\x92\xaf^\xf5\x98\xeaDtW3\xbc\xf3\x82˷FԤf\x98I\x96\xd7]\x98\x8e\xe7A\xa5\"\xb1\xd6\xe0\x1bK\xdf\x18\xfcԣӑc\x97\b)\x04dyi\x9e/\xf0}o\xbcy\x94r\x10\r\xe3W\x99#\x9a\xb46ıs\"\x9a[\xc8\xf8\xca3ˤ\xb9\xe5\xd2-\x91\xc3\xe3Fb\xef\xa6C\x05\xfa\x89EX\xa7\x1eYO\r\xfd\xd8pb\xc1at\xbe\x14ܶ%d\xaa2\xc5/|\xf8~\xa4\xef\xf1\xeb\x8f\xff\x05\xa1\xd1H?\xb8\xf8bd\x8a~\x9c2L\n\x92\xac\xc0\xbba\xff\xb6O\a\xbe\xe7\xbf\xd9]\xe3\xf4b\x8f\a\uef01C\xc7\xded\x18\xc7`5\x83aF\x14\x86\xec۵\x8d\x03\xcf\x1f\xa2Q\xad\x8c\x89)\x19\xfa\xcc\x0f2vhŌ\xb6$q\xc1%\x9e\xa5\x1eJR\x04\xa9\x15\bsq\xff\x8eJ\xe8\xc4\x0f˽\xdc!ˑO\x148\x8f\xdf4\xb1\xe4\xa5\xfbC\x10HǍ\x1b\xd7y\x1b\xe3\xcc\xfd߮\xb8tV\xe2\xa6443\x18\xed\xd8J\xa3\xddo)\xe5NI\x92(\xd6\xd7\x14\xc7N\xc6|\xf5\xc0*\xf3ˊ-\x9b+T\xc2Q\x9e\"\xb4j\x92\x89\xaat\x04\x9d\x8683\x9c\xeb)\xe6\xba9GߌA\xc0\xee\xba\xcf\r5\xc1\xfa9\xc5g\xfe\xf0c\xec\xb9\xfd:\x1e\xfd\xed\xcfr\xe6\xc8\t\xda\xcd\xdaE7e\x92\x17\f\x92\x8c~\x92\xd3\xeb'\x18k\xad\xb7o\xf7ԧse\xfc\xd5~¯\xfd\xec]\xccL\xb5\xd963\xc9\xf7_>\xcat\xbbI\x9c\xa4\f\xe2\x94\xdd;\xb7\x92d9o\x9c97\x06\xc5*\xbeG?\xf093̹\xc2j\xeaX\xda!He\x98ư'\x80E+\x89\xdfe2(\n\x1d廲\x96;\xff\x13\xeb\xc0C[\xaaۗ{\x9a$1\x88\xd2\xff*/\x9c\xa3\xf4\xe82\xd6z\xe3$h\xad]\x01`\x9dI\x9aV\x86\xa2p|z\x96j\xd6\xd7\n\x06\x03E\xf7|\xc6w\u007f\xb0\xc6\xd3ϯ\xd1]w\xb6\x1cQ\xc5\xf13\xa2l\x02\xab\x15\x876\x14ڲ\xd2/xc>ea9gi\xa0\xd9{Y\x95\xdb*\xd0\\\xcd8\u007f.\xe7\xf1\xdf}\x88;\x1f~\x80'?\xfb\x8f\x1c\xfc\xaf\x17\x99\x9el\x8eчw\xfad\x85b\x98\xe6d\x85\xe6\xecr\x1f\xac\xa5\xd0\xd6\xf8iV\xb0>L\t\x83\bϓh\xa5\xa9\x84\x01\xfd8A[W\x9d\xc4I\xc2\xd1\xe3s\xdcu\xeb\xd5\x1c=\xb9@^䄥lhK\xab\xc6\xf1$\xe7\xe8\xf2\x1a\x97[ä\xd6\\\xd5\xf68\xa34\x87V\r\x0fur^#\xe0Y\x15`.\xb20\xf5\xaaϥ\xb3\x1e\x8b+)'\xe6\x13\xce.\xe7tZ\x01\x9b'B:MA^\x14,.+\x9a\r\x8f͓\x1e\xf5\xaaam\xdd\x05\v7\x1b\x01\xb5\x9aG\x14\xf9\xa5\xfc\xd4\re\xdaRg\xacr\xcd ֬\xad\x16`,'\xe6R\xbe}\xb0\xc7\\7'\xf4%\x97n\x896*\xaartm\xa4\xd6\xd4\xc6\xd2\xed\x15\x9c]\xce\x19\xa6\x86\xe9\x86\xc7\a\xb7J\xe4ܐ83,\v\x8f'\x9e\xfa=\xae\xb9\xfb\x16\xbe\xf1\xf4s\x1c\xf8\xd6\xf7\x98l\xd5\xde\xc2q\xbc\xbd\xa0\x893\xc50\xcd0\xc62\xb7\xd4GXKa,\x81'\xb4\xaf\xb4\x16Q\xe0\xf1\xf2\xf13\xfc\xe8\xf5\xd3\xec\xde>˿?\xfb\x12U_2\x8c3\x1a\xf5*\x02讬\xb2s\xfb\x16~\xfa\xf6\xeb\xf8\xf2\u007f|\x97\xe9fu\\!\xec\x9ais$\xcd\xe9\fc:\n\x96V\v\xb6\xb7<\xf6Lz|\xa3g\x99\xf2\v~\xb5Vp\x84\x80\u05cc\xcf\x10(\xac@oP\x12c#\x81\xad\xd3U\xfa\xb1\xa2\xd7\xcf9\xdbM9\xbf\x9cѨ\xfaLM\xb8\xbf^_1\u007f\xde5\x9e\x95\xd0\xed\xe2j\xe4\x11\x06\xd0l\x04L\xb4]\xe3)\xa5@Pa)4\x00\x00\x05\xcfIDATiC\x1c\x1b\x06C\xcd0ќYHy\xe1\xf0\x80\xc5ՂjE2ծ\xd0h\xfa\xe3\xdbΡےF\xc5)1W\a\x8a\x85\xa5\x82d\xa8\x98\f\x04\xef\xdb$ثrΟЬZ8\x15E<\xfe\x9b\x1f\xe6ڻo\xe6\xf5c\xa7y\xe2\x89/2ۮ\xfd\xf8j\xcaX\x06iNV(\xb4\xb2\x9c\xef\r\xc0\x18\x94q\x93d\xa1\x14\x88[o\xdaV\xec\x98\xe9\xf8\x85Ҝ:\xb7\xc2d\xab\x81Ҋ\xcd\xed\x06\x97n\x9d\xa1^\xad \x85D\x1b\xc3\xcd\xd7\xeeEz\x92/\u007f\xfdY^9r\x9a\x99N}\xbc\x13\x92Bӝ[\xe2.\x95\x92D\x92\b\xcbޚ`S\xdd'\v$'\\k\x82'`Q\vd\xc5Cz\x92U+8\xa7\x05=#\x18\x1a\xa7\xfb\rJ{\xbe4\xd5$\x99k \xf3\u00a0MI\xc1\xfa\xae\xd1\xf3ʙ\x10o\xa4v\t%\x81\xe7L\nʐh\xd2\xc2\xd0\x1fj\xd6\x06\nm\xdcIl\xd4<j\x91W\xf2\x1f\xae\xbc\x0e\xcb\xef\x19mX\x1e(\xbak\n?\xd5\xec\x0e-{<\xcbn\xa1Qʰ\x92\xc0i%\x987\x82G?\xf1 \xf7~\xfc\xe7x\xf1\xe5\xa3\xfc\xc1\x1f\u007f\x81\x89\x8a$\xf4\xdf\n\x1c\x8e\x9a\xd3LiҼ@)'\xf2\x18\xa6\x05\xcb\xebC\xd2̩}\x8cִ<\x91\x8a{\u07bb;I\xb2\"ڲ\xa9\x89'=\xb2<'\xaa\x84l\x9alS\t\x03\xa4\x14\\\xbe}+\x8dZ\x95f\xa3J\xbd^\xa3\xbb\xda\xe7o\x9e\xfaW\xe28e\xe2\x02)\xfd\xea0%>\xbb\xc2f\fWD\x96%\r\xb7D\x96\xd9v@\xbd\xe2\"\xef\xd64$\xda2\x97AO\xc1\xc0\n&C\xc1\xbe\x86DI\xc1\xeb\x85\xe4\xb5\xdc-\x92*\xbdO\xad\x195o\xae\x19\x1c;\n\x95C2\xa31\x01\xa5\rZ\x81.\xf5\xaa.i\xda\xf1\x14a\xe0B\x89\xdd\xf4\xaf\x1d+dF\xd5`\x92k\x92D3H-;\x03\xc3{\x9b\x82m\xbeE\xc4\n\xa3\\o\xb444\x1c\xcf\x05\x8b\x89\xe5\xd1O}\x84\xf7\xfe\xf2\x03\x1c:|\x9c?z\xf2\x1fh\xf8n^\xe5\x82\x01b7\xb6\x91\x17\xe4\x85C\x9c\xad\xb5Nu\xa35\xddސ8\xcb\U000454f7Nxp\xbbT\xa9_\x8f\x02\xfaqF\x96+&\x9a\x15\xa66M\xb8\fڲ\x194\xc60wn\x91];\xb6\xf1\xdcK\x87\x99\x9d\xeap\xdd\xd5Wr\xef{n\xe4+O?G\xa14\xfe\xc8\xf1\xb9Y%N\x9b\xccvWhgn\xc8\xf3\x9b\x89\xc7\xcf\xd4,\bC\xe8\vZ\x9e`\xb2\"\xd8V\x11cS\xc9\xf3\x05\xbc\xb2\xae9\x9f[\x02\x01{\x02\xb8\xa7\xea!\x03\xc11%\xe9*A\xcf\nb+\x88\x8d\xeb\x98\x05\x1b\x01m\xff\x8fy\x99\vtKv\x8c\b\x18\v\xe4\x1aS\x18\xb6\x1a\xc3\xfe\bn\xdc\f\xed\xc0\x1b;\xa7\x1e\x8e%\v\xca\xf0f\"0\x05\xc4\b~\xe3\x93\x1f\xe2\xd6\a\xdf\xc7ɓ\v<\xf9\x97_\xa2\xee9D|#jג\x14\x8a\xbcp\xf8\xa0.\xe7 r\xa51ֲ\xb2\x9e`\xac\x93{X\xad\xd9\x19\u009dA\xc1\xa5\x91E<x\xdf\xfede\x90D\x02\xc1D\xbdB{\xa2E\xbdZqhji\xcd\xed\xb8j[\x8a\xaa\r\xf5Z\xc4-\xd7\xef\xe7\x99\xef\xfd\x90\xff|\xe1\x10\xedZ4ƫ<Orvn\x99}\xbd5vT\\\xa6\xd4A\xebs]C0\x1b\t\xa6K8\xc4\xf7\x04Ñ\xd2уF(\b\xca'\x9c\x1884\xb0̗\xee\xd8\r\x0f\xa6\xaa\x1e\xd3U\xf7T\x87HV5t\vAn-\v\xb9`IA\xac!\xb7\x96\u008a\x92㰄B q\x12\xa5HX\x02m\x88\xaceZZf=˶\n\\\x12\n:\xbe\xa5\xa7\x04G\x13KnaMYV\nK\xb50\f\x87\x9a\xc5\xc4\xd2\vB~\xeb\xe1\xf7s\xebC\xf7s\xea\xe4\x02\u007f\xf6W_\"\x19\x0ei7jh\xeb\x10\x80\xac\xd0\x14J\xb9\x93W\"\xdd\xd6Zr\xe5\xee\x89\xf58#\xcer\x92\xb4\xc0\xb3\x86\x9b*\x96{ڊ\x9dmA\xa7)R\xf1\xf3\xf7\xedO\x8c\xb5ѩs\xab\xec\xda2\x89\x90\x92\xc9v\x93 \xf0Kx\xdb{\x87\xb1dK5\n\xb9\xf2\xf2\x1d\x1c\xf8\xfe+\x1c|\xf9u\xb6L6\xc60\xbb\xc0\x92\x9c\xe9r\xd9 f&\x80V\x04=\xcfc\xc5\xf7\xd8\x19\tvT\x05\xff\x13\xc3H}Y\x1a\x8cR \xb8\xa1.\x98\xad8~\xdb/\xb9\x86ԀW\x91\xac\x14\x16U\x8a#Fq\x86\x95\xf2.1\x16r\v\xa9\xb1\f\xf5\x88\xe7\xb64=\x81\x87%\x10\x82\xaa\x04_i\xa2R(\xa7\xcb\x00\xc9~a9:4\x9cJ\xe1\xb2H\xd0\xf4a2\x90\xf8\x85\xe6\xd0|\xc6\v\x03\xf0\x95\xe5\x91O=̵\x1f\xb8\x93\x83\a\x0f\xf1\xf9/\xfe\x1b\xd2\x14\xb4\xea\x11i\xae(\x94\xa6\xd0z,\x8d2\xc6\xf9\xfd\x9a\x12+\x13\x02\x86iA\x9c\x15\xf4㔖5\xdc(\x15\x1f\xd9\x05\xb3m\xa7\b5\x96\xd4\a2OJ\xa6&\xea\xcc/\xaf\xfbۦ&T\u007f\x10\xd3j6\xc6\xcaA\xbf4\x9d\xbc\x10\x99\xccr\xc5\xdc\xd9E\xde\u007f\xf7m\x14J\x89\x1f\xbezܟ\xed4\nc]'ܘ\xe90_(/\xccs\x93\x0f\xb1͊f\x971,X\x9f\xd7b!nn\b\xbb)t\x863\xc3\xcc\xe0\vA\x14J\x9e\xedY\x99h\xeb_]E\xcfH\xab\x93\x0265=\xb6G!\x9dZy\x0fx\x17\xe4I\xbe\x8b\x91\xe4\xe8\x01Y\x01\xda\b\xd6bC\x92[T\xe9z}d`\xfc\xff^5vo\r}Ǆ[\xb4TCw%㍮\xe6\xe5\x82`&\f\x8b\x8f\xfe\xc9#\\r\xf5\xe5\x1c8\xf0\x82\xf8ۧ\xbe.7\xb7\xaa:+<V\xfa1Ƙ\r\xc0\xb3\xc4\xcd\xde>\x16\x97\x17Z\xa6\xb9\"Is[3:\xb8\xddS\xc5{\xa6\xad\x9d\xedx\x17\xe6\xa9d\xff\v\x18e\x96\xfbCo+\xae\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_08_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1f\x06\x19\xf0R\x1c\x00\x00 \x00IDATx\xda\xec\xbdy\x94e\xd7]\xdf\xfb\xd9{\x9f\xe9\x8euk\x1ez\xeeV\xab\xa5\x96Z\x93%\x8f\xd8\xd8\x18\xdb\u0086\x80\xe3`\b^f4\x8f\x10 \x84\x10\xc8#\x11y\t\x84\xf0\x02/q^H\x1e\x84\x04H\x1e8\x8f!\x0f\x83\xf1 c\x8c\ay\xd2`\xb7\xa4\x96Z=wuuu\xcdu\xe7{Ϲg\xd8\xfb\xfd\xb1Ͻ]-\xb5&C֓\xb2\xb4ת\xae\xea[\xa7\xee\xb0\u007f\xe77\u007f\u007f\xdf\r\xaf\xacW\xd6+\xeb\x95\xf5\xcaze\xbd\xb2^Y\xaf\xacW\xd6+\xeb\x95\xf5\xcaze\xbd\xb2^Y\xaf\xacW\xd6+\xeb\x95\xf5\xcaz\xf9/\xf1\xd7\xf9d7M\xfb\x9c\xda\x1c\x00pt6\xe0\xe4zt\xdd\xeb\xdeq\xf7!>\xf9\xc8y\x00\xee\xdc?%\x924\x1dw]gO\x9a\xe9}Y\x96\xcdfڌ\xefx\x83\xa9\x92\xaa\x834m%e[\ny\xb6ۏV\xd24\xcb\x1cGjG)\xe3\xba\xca()\xcd\xecd\x95j\xb9\x04\x02|\xc7\x13H\x10\x06\x06iJ\xb3\xdd\x13\xda\x18\x92$E\x80H3C\xbb\xdb\x13\x83$\x15\xaeRB\n\x89\x94v;\xb41\x18\xadI3m\f\xc6L\x8eU\xf4\xecd\xd5\xec\xdf5\x99\xfd\x9b?\xfc\xc2\xe8s\x1cY\x18\xe7\x1bn;\xc0o\xdd\xff\xb5\x97\xa6@fK.\xeb\xbd\x04\x80\x85\xb2\xcblEq|5\xe2\xd8\xdeqN,5\x9eq\xfd\x9f\xfd\x87\x1f\x93\xff\xfc\x83\xff\xfd\x96\xadz\xfbqc\xc0\xf7]\xa4\x14H)\x10\xf9[3\x18\xb46\x18c0\x06\x8c1vӴYϲ\xec\xb1L\xeb-\xadu/\xcb\xf4\xba\xe7:\xb1\xeb:\x12cL\xe0{5!\x84\x90R\xf8\xda\xe0%IZ\x10\x02e\f\xcasU\x19\x84\x97fYU)5\xeb\xbb΄\xeb8E)\x850\xc6`\x00\x81a\x10\xa7\xddp\x10_0\x86\xcbR\xb0\xd2\v\xa3\aJ\x85\xe0\xdc\xfe\x85\xe9+\xbf\xf4\x93\xef]\xb9\xf3\xfd\xff2\xdd\xf9y~\xe0\xdeW\xf1;\xf7\u007f\xf5\xff_\x81\x1c\x99\xf29\xbd5\x18}\xdf;\xe6\xbd\rx\xd5R+\xfe\xdf\xf7\xd5<q\xa9\x19\x1b\x80\xdb\xf7ŌQ\xfc.c0Y\xa6\xc7\xd3,\xbb1I\xb2\xddB\x88\x05c̭ \xbc\xbbn\xbf\x01ב;\x84a\xff\xd5ڀ\x10\xb8R\"\xa4\xc2h\x83\x90\x82$\xc90\xc60Q)b\x8cA\x1b\x03\xf6R<\xd7A)\x99\vX\xe2*\x85T\x02W9\xb8\x8eB)\x85\xeb(\n\x81\x8b\xef:\xf8\xaeBI\x81\x11\x02)\xecs\xc4IF?\x8ci\xf5\"\xb6\x9a]\x96V6YY\xdf\xee/\xafm_\xc82\xbd4>V>9=^}\xe0\xe6C\xbbN\xfd\xa7\x8f>x\xe6\xf9\xf6\xea\u007fy\xd7\xdd\xfc\xe6\xc7\x1e\xf9\x1f\xaf!\xb7\xcc\x04\x93OnD\xdb\x00\xfbk\xeew\xa5Z|\xc8Q\xe6\x87\x17\x1b\xc9\xef\f\xaf\xb9\xf3\xe0\xd4l\xa7\x1b\xfea?\x8c_[\xad\x14e\xb1\x188\x95R\x81R1\xc0`HҌ\xc0w\x91B\xd8;\xd4\x18\x94R\xf8\xbe\x8f\xefz8\x8eDJ\x89\xe3(\x1c%\xf1\\\x87\xf1j\x91\xbd\xb3\x93x\x8e\x83\x10\xe0:\x92\xa2\xa7\xe8\xc7\x19\x996#\x13$\x10\b)PB\x10x\x92\x82\xab\xf0\\\x81#\x05R\xda\xcd\x17\x88\xd1n\x88\xe1\x8f\xc2\xde\x1a\x06\xc82M/\xd24\xba\t\x9b\x8d.\x17\x96\xd7\xf8ʣg͙ŕ\xb8ߏ\xd2Z\xa5\xd4\xf5}\xf7D)\xf0\xbe:^\xab\\\x92Rn\x97\vޥ?\xfc\xdc\x13\x0f>۾}\xdbkn\xe4Ϟ&ǿ\x16\x81\xec\x19s\xff\\ \xfaJ\x8a\x9fu=\xe7\xa86\xe2Å\x82o\xfa\xfd\xe8Þ\xeb|\\\x1b:ƘC\x02fza\xf4\x93\x87\x0f\xee\x12\xb5\xb1\x12R\b|\xcfe\xd7\xcc\x04\xbb\xa6ǹ\xbcQge\xa3\x81\xe7z\x14\v\x01\x81\xe7P)\x06L\x8fW\x18\xaf\x96\x98\x1a+\xa1\x1c\x85\xcawL\xe4&\xcds\x04\xae#\x90\xb9\xfd\x17\x02:}M\x96k\x92+\xc1w\x05\x9e#\xacS\xd1\xf9f\x1b@\x82D \xc4\xd5\x1d\x19\xfd(\xae^g\x80L\x1bz\x03M\x14\xdb\xeb\x1c\xa5\xd0ư\xbc^\xe7\xa9\xf3˜_Zge\xbdN7\x8ah6{d:\xa3V)\x8d\x9eSJ\xd9N\xb2졂\xe7=\xa2\x8d\xfe\x13\xcfs\x9f\xfa\xf2\xa9\x95\xf6݇fx\xe4\xfc\xc6_] \xaf\xb9q\x9e\aϬrcM\x8dE8_)\x14\xfc\x9b\n\x05\u007f\xad\xdb\r\xe7\x0e\x1f\xdc\xc5V\xa3\xcd \x8aɴF\b\x81\xeb8\x94K\x01\xe3\xb52JJn>\xb8\x9bݳ\x13 \x04Q\x94\xa0\x11DQL\xb5\x140V)R\xab\x14)\x15<0f\xe4K\x86\x1b\xe5)\x89\xeb\x80\x14\xa0\xb5@c\xd0\xda\n$3\xe0)\x81\xab\xc0U\x02!\xc4\xc8\xf7\x88\x1d\x9b.\x86\xff\xc1 \x87\x12\xd9\xf1;! \xcd J\xac \xb4\xb6\x82u\x95@IF7Ff\x00$Q\x02\xcb\x1b\r\x16/\xafr\xea\xccE\xd0)\x8e\x94\xf9\xebk\xbaaL\x18gt\xfa\x03Z\xfd\x98Vo\xf0幩\xb1\u007f\xf2\xa5S+\x9f\xb9\xf7\xeeC\xdc\xff\xc8\xf9\x17/\x90;\xf7Op|\xb1\xfe\xb4\xa8i\u007fm\xf1J\xfdo'I\xfa\xabs\xb3\x13\xa5\xb1j\x11G)\xa4\x94`\xec&\x81\xb5\xf1\x9e\xe3P*\x16\xa8\x96\x8a\x8cUJ()q\x1c\x87ى\n\xb5J\x01\xdfu\xf1r\xf3ĎM\x1bn\xd0p\v\xa5\xccM\x91\x00)\x05\xf9~\x93f\xf6u\x1ce7Α\xe0(k\x9a\xe4\xce;ߌ\x9e|d\xa2Ď[4L\fal\x883\x8d\xd1P\x0e\x14\xe5@\xa0r3\x97;\xb8\xd1\nc\xc3\x17O,q\xfcѓ\x14\x1d(\x17\xbc\xabZ\aT+en\xba\xf9\x10˗WY\xbe\xb2N\x9cjά4y\xfc\xfcZ\xfd\xfd\u007f\xe3\rG\u007f\xf9w\xffr\x1d\xc0y1¸m\xff$\xcdn(n\x9c)\xca \b\xbe\xb9ӏ\xbe\v\xf8\xf6K+͉j\xa5\x88\xef{\x14\x02\x0fױOk##\x83\xa3$\x93\xb51\xe6\xa7')\x17\x03\xeb,\a6\x1a+\x16|n\xd83\x85R\n\t(\xf5\xb4\x9dG $\xa0\xc1\b\x81\xc0\xe4\xe6CP\x0e\xac\x03\x1e\xee\xa2\x15\x9a\x15N\x9c\x1a\x06\x89\xa6\xaf\xaf\xf5\t\x8e\xb4Z\xe5H\x81\x92ƾ\x9e\x01!Ak\xfbwQ\xa2\xd1\xf6\x1e\xa2\x14H*\x05\x85ڱ\xb9F_k[\x04\x10g\x86n/B\xe8\x94r\xa1\x98\u007f\xfe\xe1\r \xd8\u007fp\x0f\xfb\x0f\xecfa\xd7,\xeb\x1f\xf94\x90q\xe7\xc1i.o\xb6\xc7\xff\xf43\xc7\xef\x03~\xe2E\v$I\xd2\xdb1旑η8\xaeˡ}\xe3\x94\xcb\x05\x84\xb8\xba\xf9 PR\x12\xf8.\xb5J\x89\xb9\xc9q&j\x15\n\xbe;z\x83W6[`\fB\bz\xe1\x80\x13gW(\x17\x03\xca\x05\x1f\xd7U\xb8\xcajH\xa6\xf5\xe8\x03\x05\x9e\x83\x10\x82A\x9c\x10\xc7\tq\x9a\x11x\x8aZɧT\xf0\xa8U\x8aH\x89\x8d\xc0\x04\x04\x9e\xa0\xe4; \x04\xf5N\x8c6\x02\x8c!\xc9\xec{\x88\x85\xb1\x1a\x86\xbd>\xd5\x06mra)A\xc9\x13\x94|\x89#\x05z$\x89g\xf7\xbc\x028\xb0{\x963\xe7.b\x8c\xb9Fk\xc1\xb0re\x9d\x99\xe9q\x1a\xf5\x96ͅ\x84@k\xc3=7.\x88\xfb\x1f>\xfb\xb6\xef\xfa\xc6[g\xff\xe0sO\xac?\xa7@^\u007ft7\xeb\xebu\xceo\xf7\x01PBt2\xad\vR\x1b#%\xa2T\n\xd0Z\xe7Z\xa0\xf0=\x8fB\xc1cz|\x8cɱ\n\x05\xdf\xc3Q\x12\x03\f\x92\f%\x05\x1b\xf5.a\x14\xe3\xb9\x0e\x81\uf8a4\xa0R\xf4)\xf8.\xbe\xe7\xe0{.\xaec\x8dK\xbb7\xa0ۋ\xf0<\x87\x13g.\xd3\xed\xf5\xa8\x14\v\x94\x8b\x01\x9e\xeb\x10\x0f\x04\xadv\x9fh\x10\x13E\x03\xf6\xef\x9e\xe6\xe8\xa1\x05\x94\xb47\xc5\xfav\x8b\xfb\xbf\xf0\x18o\xb8\xebf\xc6Ǫ\xb9\xe9\x84\xc0\xb5\x9b-ss\x97\xa4\x86v\xa8\xf1\x1cA\xe0\tk\xe2r\xff\x91f\xb9\x8a\x19{\xad\x14V\x9bd\xaeU\x82\u070f\x19\xc3\xccD\x95\xa9\xa9iN\x9f9\xcd\xfe\xb9q\\\xc7\xc1\x18C\x9c\xa6t\x96Vy\xe8\x84\x15\xd6\xfe\xb9\x1aZg$Z LB\xadR\xdcszq\xf5n\xe0cϐ\xf5\xae\xaaϕ\xf6\xe0\x9a\xc7^wdᆕ\x8d\xfa{\x10\xe2\x03S\x13c\vS\x93\x95\xa2\xa3\x14RI\x1c))\x97\nx\x8eC\xa5\\dfb\f\xcfqPJ!\xf2\x98^\xe5\x81}\xa3\xdd'\x8a\x13|ϵ\x1a\x97f\x18m(\x04.\xc5\xc0\xa5V.\x8e\x1eO2M\xc1w\x18\fb\xbe\xf4\xb5\xd3\xdc~\xd3~\x0e\xec\x9eF\t\x89\xe3J\x1c\xe9\xa0r\x87\x9df\x19\xbdp\xc0\xd9\xc5U.\xadl\x90\xa6\x9a\xc1 A9\x92\xbb\x8e\x1e\xe4\xe0\xde9\x1c%IS\r\xc2 \x91(e7\xd2 l4\x06ֱ\v32W\xd7h\x82\x80^?\x1e\xe5.\x02\x81\x1ei\xb0\x8d\xc0\x84\x10\x9c\xbd\xb4\xce\x1f\xdd\xff%\xd2~\x93J\xc1\x01c\xd0F\xb0\xd5\xcbX\xdd\xea\"\xa5\xa4\\p\xd9?S\x04\x93a\fl\xf6\fQb~\xe8\xc9\xe5\xc6o;\x00\x87'<\xce\xd6ckN\xda\x03\x0eM\x95~VH\xea\x85 x\xb0\xd3\xeb\xffI\xa3\xd3?\xb80?\xc5\xectmd\x9a\x84\x10x\x8eC\xb9\xe8\x13\x04>3\x13\x13\x14\v\xfe(D\x19e\xd4\x02\xc20\xa1\u074b\xd0\xda\x10\xf8\x0ec\xa5\x80r\xd1GHA4HXZm \x90\b\x11!\x05\x94\n>\x93c%.\xafn\xf2Թe\xfe\xd6;^;\xd24\xad\r\xd1 ems\x93\xe5\x8dm6\x9bm\x94\x0fE\xd7\xe5\xfc\xf9\r\xea\x9d>ǎ\xecg\xcf\xcc\x04G\xf6\xcf!\x11\\X\xde`}\xab\xc5\x1dG\xf6\x8dޟ\x10\xe0H\x85\xe3H\x9b\x10\x02Y\x9eX\xf24\x13%\xa5`e\xa3\xc5\xe3\xe7WQ\x12\x8a\xbeG!p\x99\x19\xaf0Y+\xe7&V\x90\xa4\x19\x1b\xdbmn\xd87ϙ\x8b\x9anԦ\xe0\xda\b`\xa3\x19\xe5\xbeO\xd3\xec\rX\xde\x16,\x8c\xdb\bR\x00Yf\xd4ȇ\x9c\xad\xc7\x1c\x99\xf4jaj\u07b4\xd4J>\x92\xa4\xa9S,\x04\xff)I\xd3˅ \xd8sp\xdf,\xae\xabFw\x84\x92\x12\xdf\xf3\xa8UJ\x8c\x8fU\xa8\x16\x8b\xa3͒R\x90fچ\x9fY\xc6 NI\xb5FII\xa5\xe43Q-\xa2\xa4$\x1a$t\xfa\x03\xea\xed>\xa5\x82\xc7X%\xa0\\\xf0(\x17}\x8a\x81\xc7\x03\x8f<E\xb7\x17\xf2\x1do{\r\x838!\x8aS.\xafnq\xea\xe2\x15.,\xaerþi\xfe\xceϼ\x8d[\xef\xda\x0f\x9e\x03\x83\x8c\xf4L\x8f\xbf{\xdf\xef\"\x94 I\x13\xd24\xe3\xfc\xd2:ۍ\x0eoy\xdd1\xbb\xbfƆ\u009e\xeb\x8c\x04\xf1l9s8\x88\x89\xe2\x94A\x9c\x12\xa7\x19\x05\xdfEk\xcd \xc9\x18$)ۭ>BlP+\aL\x8c\x95\xe8\x87\x03.\xafo\xa1\xb3\x8c\x03{f\xb9\xbc*\xe9v\x9a\x94=\x89\x14f\xa4t\x02h\xf5b\xa6\xca\x0e\x9e#\x18$\x9a$M{\xd78\xf5;f\x83\xce\x17\x97\xc3\xdf\xdeS\xf3>\x83\xe0\xf4\xc4x\xa5_\xa9\x14\xf6\xe8\\\x15\x87ߥ\x94(e\xbf\x1c\xc7A H\xb5\xc6Q\x8a$\xcdH\xd3\fm\xf4(g0\xc6\xe0*\xc5x\xb5H\xe0\xb9h\r\x8dN\x97~\x980HRƫ\x05vM\x8fS\f\\\x1c)it\xba<\xfc\xf89\xb41\x1c\xdc;ǣ\xa7/\x91e\x86\xe3'/P\xf0=\xea\xad\x0e\xdf\xfa\xea\xdb\xf8\xce\x1f|5\xb3\xb7\xcd\xc0 \xb5_\x06LAp\xfb\x91=<t\xf22\xaeR\x9c\xbcp\x05O)\xde\xfc\xda[\xedg0\x86R\xee\xd7\x10<\xab0\x84\x10\x9cZ\\\xa7\xd9\tI\xb3\xcc\xde`;3\xff<\xb2s\xf2Ы\xdd\x1b\xd0\xeaF\xe8<\xdf\x1a>\xef\xee\xb9iZ\xc5\x02+k\x1b\xd4J\x1e\xeb\x8dpd\x06\x93T\x93j\x83+=\x1a\x9d\xe6\x9a+\xcdS\xd7\b\xe4\x0fN\xb6\xb3\x83\x13\xfe\xf7\x19\xa1>Z*\x16L\xbd\xd9\x15\xd3Sc#\xd33z\xb3\x1a\x8c4Dq\xcc\xca\xe66\x97\xd760\xc6P,\x04T\xcb%&\xaa\xd5\xd1\x1b7\xc6P\b|\xc6\xca>\x02A\xbb\x17\xd2\xee\xd9\n\xb0\xe3(\x0e\ue6a4R\fH\xb5\xa6Ս\xc82\xcdG?\xf3\x107\x1fڍ1\xb0\xba\xd9\x04\x04\x8dv\x97\xb9\xe9q|\xdf\xc1\x1107QE\xb8\xd2zݑu1\x90\x1a.\xaf\xd7GQ\xcc\xe2\xf2\x06\xdf\xfc\xba\xdb\xe9\xf4B\x8e\x9f\xbc\xc0\xdfx\xcb=\xf0\x1c:1\fU\xfb\xe1\x80F;d\x90$\xa3Ǉ\x9f\xe9\xfa\x02\xb4BTʡ\x10\xf8t\xba\xe9\xe8\xf1\xdaX\x99j\xa5ĩ\xf3\x97)\x15R\xfaQ2\xfa\xddv/\xa3\xbf\xddA\x98\xecS\x17\x1b\xe9q\x00\xb5\xaf\xe6\xfd\xfeD\xc9\xfb\xe7\x13Eo,\xc98\xe5\xb9\xea\r\x13\xe3\x95\xe9\x03{gG&jghg\x84\xad\xebh\xad\xaf\xf9}\x92\xa6\xb4\xbb=\xb6Z-0\xe08\x0e\xa5\xa2\x8f\xef9\x84QL\xb3\x13\x12\xc5\tB\x88Q\x18\xdb\xeaF\x84qJ7\x1c\x10\xc5)i\x96!\xa5\xa2\xde\xear\xfc\xd4\x05ַ\x9a\x04\x81\xc7x\xb5D\xe0[G\xba\xb8\xbaI\xb5\xe03\xe1\x17\x98;<\x81P6\xf9\x8c[)O>t\x85\x0f\xff\xc5אJ\xd9H\xceq\xb8\xf5ƽ\\Z\xde\xe0\xf5w\x1e\xc1s\xd5\xf3\n\xa3\xdb\x1fpny\x93n8@\x88g\n!M\xb3Q\xd2z\xbd\xe5\xfb>\xfd0\xbc\xe6F\x16Bؚ\x9d\xce\xd0\x06\x02\xcf\xc5\x00\xbd0\xc6\x18\xfdȑ\xb9\xe0;.n\xc7ٱY\x1f\xb1Pq6\x17f\xa7\xa6\x1cGb\f\x04\x81K\xa5\\\xb4%\xf0\xdc\xf9e\xda\xd0\xeb\x0f\x18\xc4\t\x838A\x00\xbe砄}c\x9e\xab\xe8\x84\x032c\x1fw\x1dE\xb9X\xe0Оy\x8aA\x801\xf0\xf4\xcff\x8c\xc1\xf3\\\x8a\x81w5s6\xc6\x16\x0f\x1d\x87\xb5\xcdmN/.\xb3\xbeդZ,r\xc7M\a\xd0\xc6\xd0hw9\xfe\xe4y\xdex\xcb\r|\xdb;\xee`\xd7\xc1I66Z\x9c{j\x9d/>~\x81\xb3\x97\u05f9\xe5ƽ\x1c?y\x81{\xdfx\x177\x1f\xdc\xc5x\xa5H\x14'y\xd8z\xfd;=\xcd4\xf5V\x9fKku\xba\xfd\xc134B\bA\xa7ۧ\x17\x0e\x98\x9b\x1e\u007f\x86\xe5\xd8y\xdd`\x10\xb3\xb9\xbd}\x8d@\x87ѠR\x8a\x95\xf5:\xe7/\xad\x92$ɯ\x17\x1c\xf1\x8fNo\r:\x87&<\xce\xd7c\xc4;_{S,\x95p\x95\x92\xa3\x8d\x1a\n\xa2\xd9\xee\xd3h\xf6\xd85Y\xe6\xb57\xeda\xd7\xd4\x18\xc7\x0e\xccR\xf4\xddk^L\n\x1b:6{\x11O.\xaese\xab\xc5S\x977Yi\x86\xdc~\xd3\x01\xc6\xc7*\xcf\xf8\x00C3\xe7(i\xb3\xef\xdc\xe3*\xa5\xact\f\xd4[\x1d\x9e8w\x89A\x9c\xa0\x93\x8c7\xbc\xeah\x1e\xe2F|\xf5\x89\xf3\x94\x03\x9f\x89Z\x19G)R\xadY\xdfnq\xe4\xc0\x02'\xce\\bjr\x8c\xb7\xbf\xe1\x0evMՈ\xe2\x94B\xe0\xe29\xd7אA\x92\xa2\xa4\xe4Ĺ\x15Z\xdd\xf0\xba\x9a!\xa4\xe4\xe1GOq\xf7\xedG\xc0\x98\xe7M\xa2;\xdd.\xedN\xf7\x19Bi\xb6{<\xf4\xd8\x19],\xf8\xfft\xffD\xe9\x97?\xf5Ժ>0\xeeq\xb1a\xa3\\\xf1\x1d\xdfx,4F\a\xc3\r3\xc6\x10E1i\x1c\xf3\x9do\xb8\x85oy\xcd\xcdLO\x8c\x91\x19\x18D!Y\xa6\x9f\xf3\x8d\f\xefB\xdfq\xf8ȗO\xf2\x87_>\xcd-7\x1e|\x86\x86\b!(\x05\x1e\b\x89ͅ\xf3P\xd4Q\xa4iF\xb7\x1fr\xfc\xd4y\x9c\\@\x838\xa5^\xef\xf0\xaa[\x0eQ\xab\x96\x90Rr\xe1\xf2\x1a\x9b\xdb-2m\x98\x1e\xaf\xb0o\xf7,'N/\xa2\x05왛\xe4\xed\xaf\xbf\x838I\x19+\x05\x14|\x87<θFc{aL\xbd\xddgm\xbbM?\x8a\xaf\xfb\x99\x94\x92<\xf4\xe8)\x8e\xddt\x00\xdf\xf3\xae\xab\x1di\x9a\xe5~D\xe6\r4\xcdv\xbdA\x92^\xeda9J\xf2\x85GN\x91\xa4韜\xdf\xec\xbd\xfb\xba\xafu\xf3\xfe\xd9\xfb\f\xc6\x11BЏbj\xbe\xc3\xfb\xbe\xf1\x18\xf7}\xcf[\xb8\xeb\xe6C\x8cO\xcf\x11T\xc7\xf1\xcbc\xb6\xf40\x88FZ\xf4l*\x9bi\xc3G\xbe|\x92O?\xb9\xc2\r\a\xf6p\xbdKm_C!\x84\x19\x95X\x95\x94hm\xd0Fs\xea\xe22Yfm\xae\xca#\xbbr)\xe0\xe2\xf2:q\x9c\"\xa5`z\xbc\xca\xfc\xcc\x04\x93\xe3\x15\x84\x14\\\xb8\xbcN\xaa5\xbe\xe7p\xec\xc6}\xb6Z\xe0\xb9\x14\v\xde\xd5\xe2c\xaass\fKk\rN/m\xb0Q\xef\x90f\xd9\xf55\x03\xe8\x866\x87\x9a\x9d\xb4\x9d\xe5\xe5\xf5\xed<\xf4\xb7\tn\xa65\x8f>y\x8e\xd9\xe9qT\xee_\xa4\x14H\xa5\x88\x06\xd6\x17))\xd8jvY^\xdd\xd2\u007f\xeb\xde\xd7\xde\xf3\xa5\x13\x8b\xc9\xeb\x8f\xccsy\xbb{\xed\xbe\x18\xdb\x17%\x8cS\xc6}\x87\u007f\xf6\xfe\xb723^\xc1)\x8fS,\x96mY\xd5\x18@S\xaaMP(\x96\t{\x1d\xe2($KS\xb4\xceF\xce\xddQ\x92\xcdf\x97\xff\xfc\xc9\xe3\xb42\xc5\xdc\xecԳj\x92\x12\xd2FFF\xd8\xf2\xbc\x146V7\x86\xcdz\x8b~4 ӆr1\xe0\xd0\xdey\xce_Z\xa5?\x1805Q\xa5\xdd\x0fi\xf5\xfa\b\x83m<)I\x9afVȮd\xef\xfc\f\aw\xcf\xe2*[S\xb3!\xeb\xb0|\xa3\x90Bpae\x8bŕ:\x06[\xfc|VG\x8f\xed>\xee]\x98!\xcb\xf3\xa9N\xb7O\xa5\x18\x8cr\xb2\xf3\x97V\xb8\xe9\xd0^|\xd7\x1d\x95g\x8c\xb1\xc1\x8f\x14\x82z\xab\xcbv\xb3G\xab\xd3#\xf0\xbd\xdf\xff\xd5\x0f}\xa6\xf7\x9a\x1b\xe7\xf8\xd2\xe9\xd5gި\xda\xd8\xcdl\xb7:\xfc\xfa?\xfa.\x02\xcfů\x8cS,U\xad^\xeb\x8c0\f\xe9\xf6\xfb\xb4:}\x06IJ\xb1\x10P(\xf88n\x91\x82Є\xdd\x16\xc6h\xea\x9d>?\xf3\x9b\x9f@\vI\xad\xe8a\x92\x98ٹ\xe9g\xd4⤰}s\f\xc4YJ\xa65E\xdfG\x1bH┭F\x9b4\xcb\xd853ɑ\x03{H\x92\x8c$\xbb\xaa\xfa\x85\xc0\x1b\x99ס\xa9s]\x874\xcb8\xb4g\x9e;o>\x907\xbf\x1c\x840\x18#\x18\xc4\x19I\xaa\xf1\n\x8a\xf5z\x87\xa5\xf5&3\x13\x15\x1c%Y\xaf\xb7\x9f\xd3-X\xb3\xc9H\x1b\x8e\x1c\xdc=\xea\xb1dưga\x06\xcfuF\xc2\x18j\x96\xeb(\xb6\x9b\x1d\xa6\xabe\xfe\xcd}?\xca?\xfe7\xbf\xc7\xe3O\x9e~\n\xe0\xc13k\xd7\u007f-\x80\xadF\x87\x1fy\xe7\xab\xf1\x1cE\xa63Z\x8d\x06\xadV\x87F\xa7Ǖ\xf5-\xba\xe1\x00=\xdc\xc8<\xc1ʴ\xc6\x18\xab\x15\xe3\x95\x023\xd5\"\x95\x82χ\xfe\xf1w\xe3H\xc1Z\xbd\xc3o|\xf21\xba\xdd>\x95\xbcF\xb5S \xc6@\x94&d\x99\xa6\xe0{\x18\f\xc2@/\x8a\xe8\x86\x11\x87\xf7\xed\xe6\xc0\xc2\fI\xeeO\xd2\xdcw\x15\x02k\xc3\xd34\x0f\xbb\xf3\xf7\xe4\xb9\x0e\xfb\x16\xa69\xbcw\x01%\xa4\xad\x0e#r`\x84f\xb3\xd1e|\xacH\x92h\x10p\xfb\xe1]l\xd4;\\\xd9h>g\x8e\xf1lfy\xe7\xf2\\gX\rG9*\xaf\x89\xd9Jv\xd5\xf7\xf9\xe9\xef}'\x95\x8a`~\xb2\xc8Y\xdfm>\xd7s;\xc6\x18*\xbe\xcb\r\v\x93\fҔv?\xa6\xd1m\x10%\xf6\xce\xddh\xf6iv\xfb$ifۣ\b\xaa\xe5\x80\xfds\xe3(!I3M\xb3\x1bQ\xefD\xb8JR\n\\ʁ\xc7x)\xe0\xde;\xf6\xf1k\x1f\xfe\"G\x8f\xdd|\x8d\x96\x18 N\x92\xbc\xdd*F\x1b\x92fv\xf3oܷ\x9b\xa9\x89*qjA\f\xf5v\x874\xcd\xd837ŝ7\x1fđ\x82f\xa7\xc7Z\xbd\x8d1\x86r!\xb0EM\xcf\xc5h\xcdX\xb9`\xc1\x12\x02\xe2T\xd3\r#\x8aE\x0f%$\x9d0\"NR\x96\xd6\xeat\x9e%\xbc\xb5\x95\t\xfd\xa2\x84d\x8c\xa1\u05cf(\x95\x02\xa4c\xb5\xb5\xd7\xe9\xf1=o{5\xa5)\x1be.\xccM\x10\x04~\xf6\x9c\x02I3\xcd|\xadD\xa5\x18\xb0\xb8\xd1\"\x8cS\xb4\x81/\x9e\xb8ș\xc5\rJJ\x118\x0e\n\x93G\x10\x82F\x18\xf1\xe9,\xa3\\+qۡyn\xdd?G\x9ai2\xadi\xf7c\xda\xfd\x98\xcdv\x9f\xa9j\x117\brg\xad\xafQ\xfb\x9d\x91\a\xa3\x90W27=i{\xd5y\xc95\x8c\x12ַ\x1a욝\xe0uw\x1cAIA\xd1\xf7\xc82\x83\xc1\xb6G\v98\"IR&\xc6J8y/\xa2\x13\x0el\xe4\x94\xefy7\x8c\xd8ltY\xabwl9\xfd:N\xbc\xd7\x0fY\xdbl\xb0o\xf7\xec\xc8A\xbfP\xad\xa9\x8d\x95G~\xe5Թ%\xee>\xb8\xc0\x9e\xfd\xb3(G!r\r\x92\xcf\xf3\x9cN\x12'L/L\xb0\xd2\xec\x11')\x9d~ğ~\xee\t*H\xf6\x97Jh\xf4\xc8V\x0fS\xfeI\xdfc0\x88ɚ}>\xf7\xc0\x93|\xf4\x81'x\xc3\x1d\a9v`\x81\xc0U\xb6Ϭ5g\xafl#]\xf79\xcb\x15RJ\xb41#\xa0\x81\x12r\x84\xc1\xc2@\xb3\xd3EI\xc9\xebn\xbf\xd1\nM*\xea\x9d>Q\x92\xa2\x94\x85\xf7\x18\x03\x1ac\xfb*\x9e\ro\x9b\xed\x1eq\x9a\"\xa4D\xe6%}%\x05\x8e\xa3\x98\x9f\xac\xb0V\xef^7\x9f(\x16\x02\x0e\xed[x\xd6\xc4\xef\xf9\x96\x94\x82\xa5\x95M\xfa\x9d\x0eoy\xd5M\x94j\xa5<*\x95\xaco\xb6\xe8\xf4\xc2\xe7\x16\xc8\xd6vK\xdd\xfc\xe6\xdb\xc82M\xb3\x1b\xf2\x89\aN\xb2\xbbP\xc2Q\xb6\x00v\xcd\xd2\xf6\x83\v\x04ʱ\x1b1W)2g4O=~\x91\x87\xbez\x8ej\xb5\xc8\xc2L\x8d\xb9\xc9*\x9916>\x1f\x81i\x9e\xe9\xdc\xc5\x0e\x95G_E\u007f\x18cc\xf9N\xb7\xc7]G\x0f\"\x85D\tE\x14\xa7t\xfb6\x94t\xf2\xe6\xb66\x86\x82\xe7Q)\aDIJ\xab\x13Z!K\x810\x06\x93\xbf~\xa6\xa1Z\nX\xddja\xf2B\u0cd9\x9f\xaf\v\xe4&\x04\xab\x1bu\x1e~\xec)\xfe\xe5\x0f\xbf\x1b\xaf\xe8\xa1r\xff\x12'\t\x9b\xf5\x16q\x9cn<\xa7@\x1c)\xdd\xdd\xd3\xe3\f\x92\x84?\xfe\xcbǸez\x1aG\t\x06I\x8a\xebڻUkM\x9a\xa6W\xb7T\xd8;[9CT\xa1`\xa2\x180)\xec\x9dx\xe1\xcc\x12'c\xeb\xe0Z\x19\xdctx\xdf\xe8\r;J\x92\xe4EA\xa5\xe4(C\xbf\x9a\xac\xe40\x1d\tQ\x1c\xb3g~\x8a\xb9\xe9\x1a\x12\xabI\xcdNx\xb5\x9c(\x04F\x1b|\xcfe\xbcZ\xa0ۏ\xe9\xf4\xa2Q\xb1\xcf&\xfc&o1\v\x94\x12l\xd4;\xd4[\xfd\x17\xedȟ[\x10 \x85\xe4\xc93\x8b\x9c\xbf\xb4\u008f\xbd\xfb\x9b\x98\xac\x96q\v>27\xc9\xeb\x9bM\x1cǥ6V~\x98\xe5\xfa\xb3k\xd8\xed\x87\x16\x90\x02>\xfb\xd8yn\x18\x1f'p\x9dQ\xe4bK\xed\n\xd7u\xf1=\v^\x18\xdeUJId\x9e\xf0\b)AH\x9b\xc4)\xc5\xf4X\x95Zѧ\xe4H\xa6]8~\xf2\x02\xbe\xe7\xd2\xebG|\xfaK\x8f\xe2\xe46u\x88\xab\x1a\x9a'c\fYf\x18$)\xc6\x18*\xc5\"{\xe7\xa6r\xe4\x8af\xb3ٵ\xd1]\xfe7:\xaf}M\x8e\x15\xe9\xf6c\x9a\x9d\xfe5\x90\xd3\xe1\xcf\xda\x06Vtz\xb6\xff\xf2\xd7)\f%%\x838\xe1ˏ<\xc1\xed\xfbf\xb9\xf3\x86=ܸg\x86\fCP\xf4G\x16`\xb3ѡ\\\n8\xb8g\xf69\xa3\x05\xe7ன,L\x12\xb5\xbc\xd2\xe0\xe8\xe4\xd4Ȟ;\x8e\xedo\xecl\xaa\f\xfb i\x96\x91\xe9\f\xa9$:\xd3H\x01\x19 \x90\x18cMA\xb1P\xa0\xdb\xeb\xe1IIcm\x83\xafI\xc1\xb9\xf3\x97y\xe3\xeb\xef$\xcbl\"8\xc4\xd0ڴ\xd3f\xd1i\x96\xe1\xbb\x0e\x18A\xb5\xe8\x8dp\xbd\xf5NxU\x91l>\x89#%\x95R@\xa3\x1b\x11\x86\x03\xa4\x94\x18\xc1\xe8\x02\x9d\xf7\xbb1\xd6\\\xd5[\xbd\xaf\xdb\x1c=\xdd4i\xad\x89\x93\x94\x8d\xcd:\x8b\x97W\xf8\xa5\x0f\xfcM\x1e9u\xd1j$\xf6f\xf6\x8b\xc1\x0e7%hu{\xb4:\xfd\xces\ndj\xac\x94]\xdeh\xa9\x89 \x18m\xbe6\x06_\xb9\x04\xaeK\xaa5:\xcbH\xf2\xaa/\xc6\xe0:\x0eJK\x92,#\xd1I\x8e\xf6\xc8\x01\x81\xd8\xffXЃ\xcb N\xa8\xb9\x92\xc1\xca*\xd3ce&ǫWK/\xb9}7F\x93e\x1a\xb3#\xd7\xf1=5\xaa\x90v\xfa\x16lg\x93I\v\x8a\x93\xd8^}\xb3\x13\xdaL<\x97\x94ն\x1c:\x84\xb1\x90\x1d)H\xd2td*\xbf\x1e\x01\f-C\x18El\xd5[t{!\xedv\x97o\xbe\xf3&N\x9eY$\xcb2\x1e\xbf\xb8b\xad\x05\xe0x\n\xcfw\xf26\x05<\xf6\xd4Ef'\xc6\u0093\xa7\x97\xccB\xc5e\xa5\x93\\_ \xa5\x82g.\xad5((\xc7&lB0Y*R\x0e<\x1c\xa50F\x13%\x19\xfdAD\xb3\x17\x8e\xca\xefB\xc9Q\xb6\x9a$\x895]ڢ\xf8\x94\xb4\xf5\xac\xc0\x0f\x88s\xf3\x138\x8a\xfe\xa8\xf3\x98\xdfe\x80\xc9۽C\xbf?\xecJz\xae\xad\xe0v\xfa\xf1\xd5N\x9c6\xf9F\xdb\xe44\x8cS\x1b\x18\b\xd0\b\x8b\x04\x01\x8c\x18V\x8fs許>OJ\t\x99~\xc1BPy\x04\x18\r\x06\xac\xado\xb3\xb2QGJA\xb5\x14ช\xe9\xa91\x1e9w\x89\x85\xf9I~\xf5\xf7?\x89\xf4\\\xf6N\xd60\x18*\xb5\xf2(\xdc6\xc6Pov)\x14\xbc\xf3\x8b\xcb\x1bi=~\x0e\r)\x17|\x93\xe5F\xb6\xe0{LU-\xccSI\v\x93\x118\x04.Ԋ\x05\xe6\xc7k4{}֛m\xe2,C\x18[\xb2hw5Y\x96\x8d\x8avi&F\x9b\xee{\x1e\xd1`\x00B\x90\x84\xe1\b\xcea\x83\x85\xab\xf0N\x81\xc0\xe4\b\x15%\xed\uf6dd\b0#\xf0\x9b\xce#\xbd!@\x1ac\x1d;\xe4\x90]\x01\x12\x83\x18\x99\xabܶ\x01\x9d^\xf8\x824\xc4\xe4\x95\xdan\xb7\xcf\xda\xe66k\x1b\r\xb2,c\xbcVf\xef\xfc\xe4\x10\x114\xfal\xc1\xd4$\xae\xeb\x82\x10\\YߤR\f\x10\x06Ʀ\xc7\xd0Z\xe3:\x8aO=p\x9ccG\xf6\xf2\xe0\xa3g\x9f\u0090>\xa7\xc9\x1a$\x990@9\b\x98\x9f\x18\xc3\x18\x8bY\x95y\xe4 \xa5\xc0Q*Ǩ\xc2x\xa9\xc8\xdc\xf8\x18k\x8d\x16[\xad\x0ea\x9aR)\x16hvz\xa3\xcds\x95\"\xc922\xad)\x04\x01\x99\xd6$IB\x11\xc3\xe2\xf2:\x87\xf7\xef\"ͮn\xce0\x12\x19\xfa\x9f$\xcdhu3d\x0e|\x12\x98\xfc\xbbD\xa9a11\xf7\xd4y\xf5\xc0\b+\b\xbbY&\xaf\xecڲ}\xabgK/\xf2i\xbd\t!\x04Y\x96\xd1\x0f\a$iJ\xb7\x17\xb2\xddh\xd1\xee\xf6PR2V)r\xf8\xc0|^\x1a\xb1\u05fb\x9eK\xe0\xfb8\xb9\xf6\xa4YF\x1c\xc7l\xb7ڜ:w\x89c\xdfp\x17\x85J\x01/p\xc9R\xebY?\xfb\xe0I~\xfa\x03\xdf\xc6\xe7\xber\xf2\x14Bdϕ\x979q\x92\x12x\x0e\xb2h\xa1\x9c\xc3\xd0\xd4U\xca:q!\xae\x01\xedeZ\xe3;\x8a]\x13\xe3\x14<\x8f\xe5\xed\x06\xfd\xc1\x80b\x10Ћ\xc2\x11>\xd6u\x14\xa4\xf6\xfab\x10\xd0՚\x8a\x0f+\x17.Q,\x16X\x98\x19\x1fUC\xa5\xb0@\x89k\x10\xe7y\x046\xacGI\x01墋\xe78H%\xa8\xb7\xfa\xa38m\x88\xec\x1c\xc1J\x8d\xa0\x17\xc6\xf4\xa2\x01Iާ\x18\nÚ.A\xb3\xd5euc\x9bh\x10[\x93\xa75\x8e\x92Ԫ\x05\xe6gj\xb8\x8e\x8b\xe38\xa3\x1bR)5\ntz\xfd\x90n\x18\x12')i\x9aa\x8cfc\xab\xc5v\xa3C\xb9\xe039?\x8e\xce\xec\xf3}\xf9kgx\xf3k\x8fRouY\\m\x9c\xad\xc7\xe6\xb9K'+[-5;Q\xe1\xd4\xfa\x1a\x87\xa6\xa7ps\xdf \x9e\x86\x9e|z\xefYIA\xadT \xcb4K[\xdb\xe0C\x9aY\xc7ir\xf0\xab\xe38\xe88\x06!\xf0\\\x970˨)\xc1\xd2\xe9\xf3x\xee\x11&\xc6Jhc\xd0yͪ\x10x\xa3\x9e\xc85I\x9a\x10\x94\x8a\xdeȔEq\x96W[\xcc(\xdf\x18\xbeW)\xa1\xde\xe9\x11\r\xd2k4aXм\xb4\xbcʥ+\x1b\xf8\xae\xc3\xd4x\x85j\xd9\xc7Q\x8aB\xe0\xe3z\x1e\xae뢤\xcc\xe1[\xf6\xef\xb2L\xd3\xed\xf7iv\xfa\x84QdG\xde\xf2\xf0I)\xc5\xd2\xf2\x06\x1b[M\xbdw~\xeaC\xa5\x82\xff\xfeҘ\xcd\xce}\xcf\xe3\xd3_:\xc1\xcf\xfd\xe8\xbb\xf9\xdc\xc3g\xb7\xbf\xfcՓg\x9f\xcfd:疷\xd4\x1d\x87w\xf1\x85\xe8\x02\xbeR/j@\xc1Q\x8a\xf1r\x89f?\xa4\xd9\xebS\f|\xba\xfd\x90L˫%h\xd7%N\xe2\xd1ݯ5\x90$ln\xd6\x19\xaf\x96vTL]\n\xbe\xcb \xd6\xd6\xe4\x181\xca7\x8a9R0\xd3\x06#\r\xfd(\x19\t\xcaVul\xfe\xe28\x92f;\"\x8aӑ0\xe3Ė\xf376\xeb\xb4:]\xa6'\xaa\x1c\xde7\x87T\x12ױ\xe6\xc7u\x9dk2t\x91\x83\xe6\xba\xdd>\xf5v\x9bh\x10\xe7\x85U\xae\xc9q\xfaр\xf5\x8d&\xbd~\xb4\xb6kn\xf2\xad{\xa6\xc6\xff\xe6\xc4\xd4\xd8\b\x8fpqy\x93\x03{f\xd0&\xe3\xd3_|\xf4D+1ǟwOO/o\xa6\x8e\x12\xee\xe4D\x89+\x8d\x16{&k\xd7\xd4\xf5\x9fo\xfc\xcaU\x92\xa9j\x990\xb6\xaa_\b\f\xfd0\x1a=\x87\xa3$Yf\x8b\x8b\xc2\x1862\xc1\x81\xc3\a\x98\x9b\x1c\x1b=\x8f\xeb(\x8a\x81\xed\xea\x89<ٰ%~\x83\xebH\xa4\x126\xe9\x04\xe28\x1d\xb5K\x87\x89I\xaf\x1fq\xfa\xe2\x15n:\xb8\x87h\x90\x80\x80\xcd\xed\x16ݾ\xf5\tJ\xc2\xccd\x8d\x03{fq]\a\xcf\xf5p\xf2\x04\xd8\xee\xb0\x1emt\x96i\xa28&IS\xb6\x1a-Zm[\xe3\x1b\fb\x06q:\n\x9f\x93$M\xa2Ar\xdaw\x9dOްg\xf6\x17\xff\xf2\xf1K\x9d\x833\x13\xb7\xcf\xceM\x8c\xcaBǟ\xbc\xc8\xeb\xef:\xc2\xdaf[\xff\xc9'\xbf\xf2Q`\xf0\x9a#\v<xz\xe5\xd9\x05\x12\xa5:\xbb\xb8\xdap\xdfp\xeb\x01~\xe7#\x0f\xf1\x13oy\x1da\x92\xbe\xa8b\xdaX1`\xdb\xf7w\xd8jC?\x8aGw\x9c\xe7zh\xdd\xc3 (\x98\x8c(\x1aP)\x15m\xdbTJ\n\xbe\x87\x94\x90ev\xe2\t\x03\x99\xce\x00\x83븣\x89Cm, aX\x12\xc1\b\\O\xf1\xf0\x89\xb3ܰ\u007f\x17\x83$\xc5q\x15'\xcf.\xa1\xb3\x8cb\xc1c\xff\xeei\n\x85\x02\xae\xeb\xe2:\x0eRJ\x9a\xad\x1e\xed\xcd:a\x1c\x93\f2\x06IL\x9c\xa4dYF\xa3\xd5\xc1u\x1c\xe2$\xa1\x17\x0el$g\xccV\x96釕RW\x8c\xd1g\xb4\xe6\xa4\xe3\xc8\xcdj\xa5\xb8rf\xb5\xbd|\xa9q\x89ٲS(\x17\v\xbb''*\xb6\xb2\u070f\x18\xc4\t{\x17&\xf8\xe5_\xff\x88\xd9\f\xb3\u007f\x0f<\xa70\x00\x1c\xa9\x14'\x17WY\x98:\xc2[^}\x98\x8f?v\x9a\xb7\x1f\xbb\x91${\xe1I\x94\xa7\x14\xe3\xe5\x02\x83f\x9ak\x85C\xa9 \b\xa3A\x9ec\xd8\x10q\x10'\x98r\x85c7\xee'\xcd,и\x12\xb8\f!H\xe4#\x02q\x9a\xa2\xb5\xa6\xe0\xfb68\xc8ME\xaa3\xd2ԌP\xe7\bX\xdbj\x12\xf8\x1e\xe5b\x81,\xcb8\xb7x\x85\xf1j\x19!4\x95R\x11ϵ\x18(m\fg\x17\xaf\xb0\xb5\xddbr\xbc\xcad\xad\x82\xeb*Z\xa6\x8f\x96\nד4۶+\xaa\xb5\xc6\xf3\xdc\xfbk\xd5\xf2\uf54b\xde'>\u007f\xe2r\xe3\xd0t\x89\xc0\xf7\x90\x02\xc6\xcb\x05\xf3\xf9\xa7\xaen\xecm{'\xc8\f\x8e\xe7\xbbG\x83\xbc\x9b\xd9\xea\xf6\xf1}\x8fO}\xe1\x04\u007f\xfe\xf9G\xff\t0xAn\xc0 8qa\x83\xdb\x0e\xee\xe2\xe0\xfc\x04\x8b+ۜ]\xdf\xe2\xc0\xf4\xc4\v.3\x18l8\\\xef\xf6\x89\x93\xab\xc3z\x9e\xe7\x8e&e\v\x81O?\x8c\b{}6\xeb-&j\x15\x94\x04\xdf\xf70\xda\x16O\x94\x10Dy\xe4\xe2\xb9\x0e\x9e\x97\x97\xe2\xb1\xfd\xf68\xd1 \xc1\x92}\xad\xb7\x00\x00\x1b/IDATs\x14B\xd8\x1e\xf9\xf9K+\xec\xdb5K?\x8cX\xdbj0=9\x86\xa3$\xa5b\x11m\xa0\xdf\xef\xd3\xe9\xf6Y\xdbl\xb0ga\x86\x03{\xe6h\xb4\xbbl5\x9a$i>\xa7\x91iV\xd7\xebl\xd5\xdb\x1d\x01_\x9e\x9e\x1c\xfbɯ\x9d\xdf8ņ\x05 \xbc\xe9\xd8^>\u007fb\t\xe8\xe5\x9f\xed\xda\x11\xefǗ\xea|\xcb݇\xcb\x13c\xa5\xaa\xc8\xebd\u007f\xfc\x89\aiu\xfaW\xfe\xfc\xf3\x8f\xffog\xd6[\xbfu\xeb\x9eI\xf1\xc4\xe5\xed\xe7\xddPG\n\xc1vo\xc0\xff\xfb\xf9\x13|\xe0[_\xc3\xeb\x8e\xed\xe7\v\xc7/\xa2\xb5\xe6\xa6\xf9\x19\xe2\x17\xa8)\x8e\x94T\v\x01\xfd|\xa6Pk\x8d\xc0\x0eu\x8a<?َ\xb7\xd9=7C\xb7\x1f2>V\xa6X\b\xecl\x85\xb0\xb8\xa70J\x88\xe2\x04%\xe5(\xf6\x1f\xce\xfc%\xa9\xed\xcb\xe4Ó\b\xecĒ\xe3X`^\xa7\x1b\xb2{v*\xc7\x1c+\x9a\xad.\xeb\xdbM\\\xc7\xc1s\x15\a\xf6\xcd\xd3\xea\xf4ب7\xf2\n\x9a-\xef\xb4:}\xae\xacn\x11F\xf1\xe7}\xcf\xf9Wg\u05fb\x1f\xbfԸ\x96\xf0\xc0\n\xe3\xb9W\xa3\xd3{\xe7\x81\xf9i|\xdf\xe5+\x8f\x9d\xe3\xb7\xfe\xe0ӿ\x11\xa7ٯ\xd4\a\xe6\xe2\xb1=\x93\x9cx\x01°\x93\x8a\x80\ufe6c\xb6\xfa\xfc_\x1f\xfe\x02\x95B\xc0\x9b_u\x88S\xadm\x1e<\xbfD\xe08/З\xe4\xd3FF\x8f\xa2\x10\x9d\u05fe.m\xb7xxe\x83\xb1\x859\x0e\x1f9\xc0\xbe\x85\x19\x8a\x817\xea\xf4))\x19\f\x12\xc2(F\x00\x9eg\xc7\x04FuBl\x0f\u007f\x98K\f\xc1\x05\xfdh\x80\xeb\xda\nt\xb5R\xc4\xf3\\\xd2,\xe3\xa1GO\xb1\xb6\xd9`\xd7\xcc$\x85\xa2G/\x8a\xd8n\xb5)\x16\x02\xc6kU\x84\xb0\xce\xfb\xfc\xa55\xce]\\Ag\xfa\xe7&\xc6\xca\xdfrv\xbd\xfbq\x80\xbbo\x98y\xc1\xe6\xfaM\xc7\xf6\x030V,\xfc\xd8=G\xf7s\xf6\xd2:\xbf\xf6_?\xf1յ^\xfa\xa3\xf5\x81\xb9\bp\xe2\xf2\xf6\v\xaf\x9b\xbd\xee\x96}!\x10\f\xc1^\xf3\xb5\x80\x1fz\xe7k\xf0=\x87O>t\x9aI\nܹ\u007f\xe1y\x9fh\xbd\xd5\xe6\xcaV\x13\x03\xb4\xfb}\xe2$\xa5=\x88yr}\x9b\xdd{\xe7\xb9\xf5\xc6\xfd\xe8|Sgs\xb4\xc7\x10(\x11\xc6\t\x8dv\x88\x10\x06)$c\x95\x02J\xca\x1c\xd0f+\xb5Z\xebQv\x1fx.q\x92\xd1\ue15c<{\x89\xf9\x99\t\x8a\x85\x02\x97W7hwz\xdcv\xf3A\xa4\x94l6Zx\xae\xc3X\xa9D\xaa5W\xd66\xe8\xf6\xfb\f\xe2\x94\xf3\x17WI\xd2duz\xb2\xf6\xed_=\xb7\xfe\xf0B\xc5\xe3\x96\x1b\x16\xf8\xd4\xf1\xc5\x17]||ϻޤ\xd6Νۜ\xacU\xbag\x97\xd6\u007f\xfb\xa9\xd5\xe6?\xbb\xe7\xf0<\x0f\x9f]}\xf1\x85̝\x02!\x0f+\x8f\xee\x1e\xe7\xdb\xdep\v\xa5\x82\xc7W\x9e\\\"\xed\xa6̕+\xec\x9f\x1a\xa7\xe4{\xd6$\xe5IY'\x8cX\xd9n\xd2\nC\xa2$\xa3\x1dFt\x06\x03\x167\x1b\\nvx\xf3\xebog\xf7\xdc\x14I\x9aQ\xf4]f'\xaa9\x98Nc\x8c\xad\x84\xf6\a1\xbd0\xb6\x95\xe1r\x91 pG\xac\f\xc3\xea\x80\xc95\xc5U\x968\xa0\u074b\x88\x06\t\x068\xb7x\x85$͘\x9b\x1eg\xa2V%˲\xbc]\xa0\x18$\t\x8dV\x87\x8d\xed\x06\xa9Ψ7\xba,_ٌ\xa5\x14\xbfq\xef\xeb\xee\xfc\x87\xbf\xf1\xb1/%\xb7\xed\x9b\xe4\xf1K\xdb|=\xebM\xc7\xf6\xf3\xf9\x13\xcf\x14\xe2sUt_\x94@l\xbb1e\xaa\xe4\xf2}\xf7\xde\xc3x\xb5ȕ\xcd\x16[\x8d.\x97W\x1at\xbb\x03\xf6\x8c\x8f1W\xabra}\x9b\x95F\x9b\x04M\xb5\xec3?5Fb4\x9f}\xec\x1c\xfd\xc4px\xff\x02\xfbv\xcdP\xf4=JE\x9f\xf9ɱ<94#*\f\x9d\xc7\xffZ\x1b\xfa\xe1`\x04\t\x1a\x1a\\\x8bd4\xa3\x113\x95g\xde\xdd\xd0V\x813K\x123*\xbd\x8f\x02\x11\x01\xedn\x9fz\xabC\xafo#\xa7\xa5\xe5M\x1a\xcdnX\b\xbco?\xb5\xfa\xe1\xbf\x10\xe2\xad\xe6\x8dGw\xf3\xc0\xc9\xe5\xbfR\u007fd\xe7\xe6\x1f\x98,r1\x9f\xc9\xfc\xbaJ\xfd\xd7\x13\xc8\xd0N\x0f\u0088\xef~\xcbm\xdczh\xc1\u0080\xb4F*\xc9\xe5\x8d&+\x9b-f\xc7+L\xd5J\x14\x03\x1f\xa35\u007f\xf6\xa5'y\xec\xc2:A\xe0s\xeb\x91\xfdL\xd4*h\xad\xf1\x1d\x97\x1b\xf7NC>\x1c\xa95#\xed0Ơ1\x98|\xe3moݠ\xb1I\x9bޑA\xdb\xe9V\x9bdFq:\x9a\x81\x0f\a\xf13\xe0\xff\x9b\x8d\x16\x8dV\x9b$\x87\x1b-\xafl\xb3\xb9\xdd\f\xc7*\xc5{\x9e\xb8\xdcx\x12\xe0ֽ\x13<\xb1T祴\x9eU WQ\xdc}n\xdc=\xc17\xddy\x98Z\xb9@\xc1w\b\\\x17!-9K\xa7\x1f\xf1\xd53\xcb|\xfak\xe7Q\x8e\xcb\xf4d\x95;o:h\x91\x84Z#\x80\x9b\xf6\xcdZd_\x8e\xdb\x1d\xb6_\xf5\xb0\x17\xb2\x03Ԡ\x87N<\xcdH\xb5\x85y\xea\x1d\xe3\xc5C!\f\xf2pZg\xda\nd\xa8Qư\xbe٠\xdd\xeb\x92f\xf6&Z[opyu\xeb\xe4\xdc\xd4ػ\x1f]\xdc>\xf3\xaa\x83\xd3|\xf5\xc2&/\xc5\xf5\xbc\x02\x11B\x10')\xbd0b\xcfT\x95\x85\xc9\n\xd3c%\x84\x80\x8df\x8f3W\xb6\xe9\r2\n\xbeǞ\xf9In\xd8;?\x9a\xd1\xd6\xdap`\xd7$\xe3\x95\xe2\xa8#8\xecs\xc7i\xcav\xab\xc7x\xa58BC\xea\xfcw\xd1 \xa1\xde\xee13^\x1e\x99+\x9b\xdc1B\x96\xa7\x99\x1e];H\x12\xc8K\xe9\xdb\xcd\x16\xcdV\x978M\xd0Z\x9bť\r\xd1hu\x1f\x9c\x99\x18{\xff\xf1\xc5ͳ\xbcės\xbda\x9a\xa7\x9b.\xd7Q\xd4*%ZQJci\x1b!\xb6Gs\x87\xcaQ\xb8\x8e\xe1\xf0\xfey\xf6\xccM]\xbdۍa\xb2V\xb6\xd4IB\x8cJ\"ñ\xe7K\xabuf&*x\xae\xb2\xcc=&o2iC\xa7\x1f\x11xnN͑O\xfd\x9a! ¶k\x95\x92d\x99\xceK,\xe4 \xed&\x9dn\xcf\n\xc3\x18\xce/\xae\x89(J>51V~\xef\xf1\xc5\xcd摹*\xa7\xd7\xda/i\x81\xc8J\xc9{N\x81<\x1dG\xe5\xe4\\SC\xaa$\xad5w\xde|\x88\xfd\v\xd7\xc6\xee\xbe\xeb07Y\xb1\xb0\xfc\x1c\x9d\xa1\xa4 \x8a\x13N_ZC)I\xb5\x18 \xb1\x1d\xc2\xe1̹\x01\x06qJ\xb9\xe8ۍ\x17\x02)䨙\x14'\xb6\xfe5,\xa7\xa7\xda\x06\x00\x1b\xdb\rz\xa1\x9d\v4\x06Ξ_\xa1ߋ\xee\x1f\xab\x04ߑ\xa4Y\x13x\xc9\v\x03\xc0\x99\x18\xabR.\xa6\xb4\xbb6>\x1f\x9a\x96\xeb\ti\x88\xa1\x1a\xe2q\xa5\x94\x1cٳ\x8b\xd9ɱk\xe0\xa1B\bj\x95\x02\xd5b\x90\x83\x13 \x1c\xa4l\xd4;\xac\xd5\xdbH!\x98\x1a+\xe5\xf4F\x1ai,nJ(C7\x8c\x91R\x10\xb8\x96\x03+3\xa0\x8c\xcd\xd4\xeb\xad>\xb5J\x11%\xec\x18B//\x8b\xf7\xa3\x01\xdd~D\x18\r\x88\x061\x17/\xad\x13F\xf1\x87n?\xba\xef\x03\x1f\xfb\U00099217\xd1rD\x8e\xde\x1e\xaf\x96\x18\xc4\tI\xa6G\x9d\xb0,\xc7\xd7\xdal\xdafϮ\xe3ؤΤ잝fz\xa26⨒R\x12\rl;\xf3\x8eûl\x11\xd0Xn\x93\xedV\x8fh\x90 \x85\xc0u\x14\xd5R!\xff\xbdDh\x93\x03\x13\x04\x1b\xf56c\xa5B\xae}\x06%\xaco\xd9j\xf51\x16ه\x94\x82n\u007f@\x14't\xba=\xb6\xeaM\x06qL\xbd\xd9\xe5\xca\xca\x16\xc6\xe8\x0f\xbe\xf9\xae#\xff\xe8\xf7>\xf3xr\xf3\xc2\x18O\xad\xb4^>\x02\x19\x11\x81\xe5\xac=\x8e\xa3\b<\xf7\x1a4\xa1\x14\"G\xa0؇\x924a\xa2<\xc6\xcc\xe4\xb8\r\x85sA\xacmn\xd1\xea\xf68\xb8{\x96j\xa5\xc0\xe2\xca6W6[dC|W\xfe|\xa5\xc0\xa3\x18\xb8\xa4\x99\x8d\u008c\xb20\xa2sW6\xc1@\xb9\xe8\xa3\x1c\x81ȬVn7\xed\\\x8am\xa7J\x92\xcc\u03a2\xac\xacm\xd2\xe9\xf50\xc0\xf2\xca\x16\x9b\xdb-|\xcf\xfd\xf1b\xe0\xff\xc7\xdf\xfb\xcc\xe3\xe9х1N\xbe\x8c\x84a\xf7\x1ak\xa3\x1d%q]\a\xc7Ux\xae\xc2͙\x0e\xa4\x14#\xc4\xe2\xb0\x13\xa6\x94dav\x9a$I\xe9t\xfb\x9c\xb9x\x99\xd3\x17.\xd1\xee\x85\xe8\xcc\x108.\xff\xcf'\xbe̹\xcb\x1b\xb6\xe7\xfc\xb4\x17\x1d\xab\x14\xae\xb6V\xa5m\x91\x9e[ޢ?\xb0\xe0\xe8b\xe0\"\x8d\xf5-a\x9c\xd2\xe9\x86 ,Sh\x9c\xa4\x9c\xbcp\x99S\xe7\x16i\xb6;\xb4\xdb!'\x9e\\dm\xa3q\xa5T\f\xbe\xf3\xdcF\xf7?<\xbe\xd4H\x81\x97\x9c0>\xf5\xb3\xdf\u007f\xcd\xf7\xebF\xb5?\xfc\xedo\n\x81\xa0\x1f\x8569\xb3\xc1i\x0e`\xb3\xb8\x9br\xb1h\xc1\x06\xdaЏ\ax\xaem\xfe\xf7z!q\x92\x8cz\xf0C\xce\x16\x9de\xdctp\x9f\x9d\xd7ؑ\xb0\xc9\x1cv\xf9\xea[\xf6\xa1\xa4\xca\xe9\x99\"\x16W\xebt\xc3\xc1\x88\xf2\xefƽ\xd3\b\x04\xcdnȕ\xcd&i\xa6\xe9\x85\x11\xedn\x8f^\xbf\xcf N\xe8\xf6B66[4۽\x86R\xf2\x8fj\xd5\xe2\xaf<\xb6\xb8}\xbe\xec\xc0-\xfbgx\xf0\xdc\xc6KJ\x18\x0f\xdc\xf7\x01\xde\xf8/\xfe\xf3\xe8\xff?\u007f\xef\xdd\xfc\xe2\xfd\x8f\\/p\x12yrfm\x97E\xa4[\b\xbf\xe5\x8erq\xa4B\xc9<\xba\x12ЏB\xba\xbd~\x8e\xadu\xec\xfc\x83\x949C\x8ea߮\xb9k\x841\x84\xa1\xb6:]Z\x9d6\x85\xc0v\b;\xbd\x903K\x9b\xf4\xc2\xc1H\xa0\x9e\xab\xf0]\x97\x8dF\x87K\xabu\xc2AL\xa3\xd5\xe1\xf2\xea:\x8dV\x9bn?\xe2\xdc\xc5U.\\Z\xa7\x17F\xff>\xf0\xbd7\xfe\xdd\xf7}\xf3\xdfylq\xfb\xfc\x91\xf9*ݔ\x97\x9c0\x00Z-\x1b[|\xed\x97~\xfcO\u007f\xe8\xee\x03\xbb\u007f\xf1\xfeG\xb8\xef\x1d\xf7\\ǩ\x0f\x81\xd5BX\xadxZ\xcf\xdcj\x83\x05-\xa4ڐdW\xf1R\xb6\x8b\x9a\x97;\xa4\x05%\xcc\xd4j\x8cU\xcaW\x85!`0H\xd8l\xb4ب7y\xc7\xebo\x03m\xd8ltX\\\xad\xe7~ɖI\xe24\xc5s\x15\x17V\xb6\xec\xc8@\xfe\xd8fÎ\x17_Y\xddfs\xab\x89纟\x9a\x19\xaf\xfe\xd0C\xe7\xd6.\x03\xfc\xc1Ǿ\x02\xc0\xe9\u0557nX\xfb\xad\xbf\xf6{|\xe6\u007f\xfd\xc1{\xa2A\xfc\xf6bP\xfc]\xe0-\xff\xe2\x93\x0f?SC\x1cW\"\xa4@(\x8bb\xb7\xe3\xbcֶ\xab\xbc\xdf-dN\xf4\x95\xc4Hr^\xdd\x11\t\xa2@\xe4yB1\U0001966c\x8d\x80\x00\x9d^\xc8\xe2\x95\r\x96V7\t\xa3\x18)`\xef\xec$g\x97\xd6\xf8\xd2cg\xc923\n\x93\xbb\xfd\bG*6\xea]\xd6\xebm\x94R\xa4iʥ\xe5U._\xd9\xe0\xd1\x13\xe7\xd9\xd8l.Vʅo\xbd\xb0\xd5{\xfbC\xe7\xd6.\xbf\xea\xa0\xcd}\xbe\xf6\x12-\x83<}}\xe5\xe4\xe97e\xda8I\x9a\x1e\xfd\xfb\xdfxl\xfa\xfaQ\x16\x96\xe7P\n\x89\x91y\x9d5o\x9b\x16\x02\x1f\x95\xf7\xbbMN\xd3m\xb1Q\x16\x1fer\xe8,9\x11X\xe0\xfb\xd4[m\xd2̠\xa4$IR|\xd7\xc3s\\\xbaa\x1f\xdf\xf3x\xe8\xc9\xf3\x9c<\u007f\x99\xb9\xa9\xc9\x11=R\x9aft\xfa}ʥB\x8e\xf9r\b\xe3\x01\x97W\xd7\b\xa3\x90~\x18\xa3\x94z\xa2\\\n\xbe\xeb\xc9ˍ\x93\xc37\xff\xd5\v\x1b\xbc\x9cV\xa3\xdb\xf3\x8a\x81/\x1cGfi\x92\x99\xeb\xe7!;ȃ\xc9\x01ʖ\x8d!\x9f\xf1\xceQ\x83Q\uef07E\xbe\xe1\xcf\xd9\x0e\xf3\xd6\xed\x87H)\x99\xacU\xc1\x88\x11\x8b[\x9af\xa4I\x02\bN/\xae\xe1\xb8\x1e\x85B02\x8f\xdb\xcd\xd65\xd4GY\x96\xb1\xbc\xbaaqXF\xd2\xef\x0fV=W\xbd\xfd\xc9ˍՃ\xd3E\x0e\xed\x9e\xe5S\xc7/\xf2r[q\x9a\xf2\xf8\x85%\x1a\xed\x1eb\x88w}f\xe7բ\u0087l\t\xaec\x87tJE\xff\x1ahg\x1c\xa7\xa3\xf9\x10\x99GH\x90〹\xca!U-[\U0001b420\x1c\x89비\xf1\xc0B\n\xa5\xf5\x15JH<\xd7\xcd\xe9\xbc\x13ַ\xeb\x04\xbe?\xaa\xd6.\xaf\xaf[\xb8f/\xa2\xd9\xea\x12\x86\xe1/\x1d\xdd;\xbe\x06pa\xb3\xff\xb2\x14\x06\xc0\xc5\xd5\xf5s\x17\xd6ֳo8vs\x80\xe1\xe0u5\xc4Q6\x82\"\a\xa6\x19c\xf0\\\a\xd7uF\xe8\xc08Np\x95D#0\x9a\x11\x1d\xc6\xd5)%\xfb\x15\x04;`;9\xe0V\x18C\x92\xa5(e\xc3f#\x84e\x1eulQ\xf1\xf2\xeaf>\xa1e\tmV\xd66\xe8\xf4B\xb6\xebm\xb2,cc\xb3\xb5V,\x06_\xfcӇ/\x1b^\xeeK\x88֛\x8fݬ]ǭ}\xed\xec\x85\xef\xf9\xe97\xdd\xf1յf]\u007f\xe8\xf1\xa5k\xc3^!,\x02\xddq,\u007f\x87\xc5\x1f\x89\xd1X\x02\xc6 \xf3\x116\xeb\xf0\xe5\xd5/e\x83\x82\xa0\x10P*\x16mD&\xc8\xe72\x04\xfd\xc1 '<\xbezm\xadRF\bA\xa3e\xe7\xcf]G\x11x\x1e\xcb\xeb\x9b\xd4\xdb\x1d[\xbe\xcf[\xb0ژ\xb58\xc9V\xf8\x9f`m\xb7\xdaK˛ۺ\\\xf0ŝ7\x1e\xfc\xc0F\xabq\xf7\x87\x1e_\xe2{\xef\xdc\u007fU JJ<\xc7\xc1u]<ץ\\\fp\x1deqQ\xaecQ\xf0\x8e\xc2q\xed5\x8e\xb2#\x01\"\x87\xe7\v\x04\x05ߧV)\xd9ҋR\xb8\xcaͅ\x05q\x9cر\x86\x9cSoj\xb2f\xc7\xdd\xfa! \b\x02\vvnu{4\xdb\x1d\x8b,1\x96\x9c\xa6\xd5\tQJ\x1e\x8d\xe3\xf4\xee\xff\x19\x04\xf2\x85\xf5\xe8\xd4c\x17\x16\xdb\xf99&\xe5~\x1c\u007f\xf2\xfb\xef:0\xf7\u007f\x1f_\xe4{nۓ\x87\xbd9\xa3\xa6\x12P\xc8a4\xe9\x8e\xc1\x16;\xf0or\xed\xb0<\x8b\x9e\xeb\xe2\xfb֬\x95\n\x01c\x95\x12;Q\xda\"'\xaa\x1f\xd2Z\fg,K\x85\x02\xee\x90ni`\x05\x95$1\x18\xc1V\xbdy\xb5U\v\xb8\x8eC\xa7\xd3G\b\xe1I)\u007fq\xa1\xe2\x1e\x1a\xf6\xaf_\x8e\xeb\a^e]ƹ+k'\xff˟\u007f\x0e\xc7q\xf9\xdew\xbc\xb9&\xa5X~\xf7\x91ٷ\xff\xb7\xc7/[\x9f\xfc\xc6;o\xbc\x0fp\\ǡ\xdb\x0fYݨ\xe7\xf0|\v\x89̌\x9d\x8e\x12B\x8e\x80\xd0\xc3:T)\b(\x17-S\xc3Ȅ\rǸ\x04\xa4Ij\xc1\xcfX@u%w\xf8\xa9\xb6\xb3\xebIjG\xe5R\x9d\x91\xc4i\x1eC3\"\xac\\\xdflؑ\x06\xad\xe7\x81{+\xbe\xfa\xc2J'Y[\xa8\xb8tb\xfd\xb2\x12ȣ\xab\x16\xedx\xe7¸\xaa\x14\x82o\xff\xc0\xbb\xde\xca\xfe\xb9\x19\xcał|\xf4\xdc\xe2{o\x9d\xa9\x94o\x9f\xaf-\xcb\xe1\x06l7Z\\Y\xdb$\xcb2\x8a\x85\xabӣY\xa6GQ\x95̙\xfe\x11\x82r\xb1\x80\x9fs\a\x0e\xfb'*\x1fn\xb1\xa6\xce!\xcd\xd2\xd1i9\xc5Ba\xc4Tg}\x92`\x90\f\xaeR\tJ\x91\x13\x1c۠\xc0\x1b\x8d\b\x8c6\xfeF\xe0\xcf\x16*\xee\x1bW:\xc9\xcbVS\xfeݏ\xfc\xe0\u007f\xddjw\x92'\x17\x97)\x06>\xaf=z#\xaf\xbb\xe5&\xdf ~&I\xf5\x03\x12\xa0\xd1\xee\xb0\xd1h`\x84\xa1X\xf0G\xc4\\\xda\\\xbdc\xedp\xa5e\xb2\xae\x14\x82\xe7\xe5\x01Ɍ\xc6\x18+\xc4 \xf0\xf2\x19\x8c\x1d\xe4\xe9&ga\xcb\xf9J\xa4\x12(\xe5\xe28\x8a0\x1cpeek4[h\xe3s\x01\xb0\x1b\xf8̮\x8a\xfb\xd3Y\xa6\v/7a\xfc\xbdo\xb8\x85\x99\x9f\xf8E\x9d\xa6\xd9?\xf9\x8bG\x1e\xa5\xd1\xee\x92\x19\xc3ŵu\xaa\xa5\x92\f<oJ\xddu\xd3\xde\xfbֶ\xb6\x9da\xfbt>'\u007f\xb1\xa6\xe5*\x19>\x80\x92\xb6\xf0'^@\xcf7Ն\xc1 \xc6q\x14\x95\xb2\x059\f\x11\xeb\xc3\x11\xb6xpu\xf0FI\x89#\xad\xdfI\xb2\x94\xf5\xad\x16iN֒e\x86j\xa5\x80빸\x8e#=\xcfy\xbb\xe7:\xef,(\x9a\xae\xce\x16CM\xf2r0c\x0f.\xd9\x12\xcf={\xa6N\xael\xd5ߖj\xbd\xf0'_|\x84\xd9\xf1\x1ao\xbf\xfb6\x16\u05f7P\a\x16\xc6\xef3\xc68\x00\x85\xc0gz|\x9c\xe1\xc1X\xf9\xd1O\b\xac)\x1aR\x1e\xbd\xa0e\f\x83$\xa6X\x1c\xc2B5\xe4\x1a#\xa4 N\x12\xd2\xe1\x1c\x8a\x81 \xb0\xc4\xfeY\x96\xa1\x94\xa4\xdb\v\xe9\xf7\a\x96\xe02\xcbp\x1c\x87\x82\xef\x11\xf8.\x85\xc0\xa7P\xf0\xe6]\xd7yw\x92fo\xadx\xf2\xc2J'Y\\\xa8\xb8T|\xf5\x92\x16\xccϿ\xe3\x1e\xfe\xdd\x17\x9e\x8cn\x9f\xab\x9d9\xb5t\xe5=Z\x1b\xff\xef\xbd\xe7]\xdcs\xf40\xfbf\xa6P\xb7\x1c\x9c\xbf\x0f!\x1c\xad\r\xbbf\xa6rB\x17F\xdcXã}\xa4xqt\x14\xda\x18\x84\x94;\xd8\xd8\xc4\b\x8f(\x84 \xc9yz\xc9A\x0e\x85B\x00\xda\x124\x93\x13\x06DQB\x96ϑ\xa4IF\xb5R\x18U\x15\xf2\xc0C\"Ğ(\x8a\xbf\xaf\xe2+\xb9\xd2I>ۉ5/em\xf9\xfcy\x9bR=\xb9\xd9Y\xbcc\xae&\xc3\xc1\xe0\x9bN/]\xe1\xae\xc3\a\xd8=5\x81:v\xc3\xc2}\xc6\xe0T\x8a\x05&ƪ\xecĤ\x89\x9c\x98\xf2\xeba\x06\x91\xd2\xf6߇\x8c\xe7#3\x97G\x00qN\x90\x06\xe0\xba\x1e\xbe\xeb\x91\x19M\x9c\xa6`\f\xfd~\xc4\xdc\xec8\xdb\xcd\xce\bV\x84\x81RɷQ\x9d\x92\xa3\xd1\xe5(J\x18\xc4\xc97V}U\xad\xf8ꁕN\x92\xbe\xd4M\xd8ߺy^\xfc\xd1S\xab\x9f\xbfk\xd7d\u007fm\xbb\xfe\xb6\xcf\x1e\u007f\x82\x89\xb1*\xeaء\x85\xfb\x94\x90\xce\xf4\xc4\xf85d\x90;\xe9$\x9e\xdf:\x99ge\xf4\xb4\xbe\xc9\xf2E)\xa5F>#ɧ\xa4\x84\x14\x14\x83\x00!vL\\\x01\xc6dt{\x11\x13\xb5\n\xed\x8e\xc5\xca\xc6q\x82\xa3\x14\xa5R0\xaa\xbdI)\xa9V\x8al\xd6\xdb\b\xd8\x05\f*\xbezd\xa5\x93\xbc\xa4K-\xdfph\x9ei\x0f>|z\xedKw\xee\x9a؎\xe2\xe4՟{\U00109894BR*\x06\x04\xfe\x8b\x0f#\x85\x104;]\x9ektw\x88X\x196\xc1\x9c<\x9cet\xe4\x85;\xf21:\xcb\xc8Ҍ\xb5\x8d:\xbe\xef\xd1\v#&\xc6\xcbTJ\x85\x11\x1fp\xbd١\xdb\rG3\xe4RJ\n\x81ǁ=\xb3d\x99v\x80\x13;\x8a\xd0\xff\xc3\xd6'\xff\xe1\xfb\xffJ\u007f\xff\x9b\x0f\x9e\xe5\xf6\x83\x96\xb6\xea[^u\xec?\x14|\xf7\xaeZ\xb9\xf4\xdb\xe2\xfd\xefzu8;1\x1ex\xee\xf5\x04b\x88\x93,\a9\\\xa7-\xd9푦\x99-\xb7?\xcf\xday6G\x14'\x16\x91\x9e\xd90{8\xbe\xdc\x0f#\xd24cqy\x1dב\xf4\a1\xfbvMӏ\x06\x9c<\xbd4b\xe1IS\xcd\xcc\xd4\x18\xf3s\x93Dрz\xb3K\xa7\x1b\x92X^\x95\x06\xb0o\xa5\x93t\xbeޑ\x80\xa7\xaf\x8f\xfd\xd4\xfbx\xd7\a?4\xfa\xffG\u007f\xea}\x12pڽPe:+MT\xcaw\x01%\xa0n\xe0q\x03\xfd\x9c\xb8P\x03ٻ>\xf8\xa1\x91\xb6\xde\xff\xd3\xef\xe7\xde\u007f\xfd\xbb\xd7<\xff\x8f\xbc\xe60\xff\xf1A\x8br\x15?\xfe\u07b7\x84\xb5J9\xb8\x86\xe2T@4\x88\xa9\xb7\xba\x14\x03\x9fZ\xb5\xfc\fty\xbbg\xb9@v\xcdNQ\f\x82\x17\x10\x06\xeb\x11\xb9L\x9aZ`\x9e\xb7c49\x8cb\xe24\xc1d\x9aF\xbbG\xa3ݦ\xd3\xe9s`\xdf<J\tڝ\x90\xa5+\x9b\f\x06\xc9h\f!\xf0]\xfa9\xbb\x9c\xeb*\xa6&\xaall\xb5\x00>\xbb\xd2I\xde2,\xb5|\xbdB\xf9\xd8O\xbd\x8f$I\xf8\x8e\u007f\xff\x87\x00\xfc\xe9O|wMJq\xbbR\xea]R\x88{\xc3A|x\xad\xd9\n\xf6\xcfL\x8dLv\xbeKu\xad\xf5\xaa\x81\xd3\x02\x1e\xd8nw\xbe\xfc\xb9\x13\xa7O\xfd\xd6C\xa7[\xcf&\xe4\u007fz\xef=\xfc\xc2\xfd\x0f#~\xee\a\xbf5\x14;\xc0\xd6\x02;\x01\xd5hu\xf0\\\x97\x85\xe9\xc9k\xf9h\x85\xa0\xd9\xee\xb0\xd9l\xe39\x0e\xbbf&G\xd9\xf5sE\\\x8dv\x87\xb1\xbcʫ\xf3\x1c\xc5\xe4\xf4IZk\xc2h\x90O\xe6\x1a6\xb7\x9b\xb8\x9e\"\x8ab\x8a\xa3\xbe\x8c M3\xae\xacm\xd3hv\x89\xe3\x04\xa5$S\x935\xb2,cs\xab\xc5\xc1}\xb39_}\a!\xc4Ϭt\x92\xff\xe3\xaf\xc3<\xfd\xebw\xbf\xd1;\xb2o\xcf\xcf{\xae\xf3MJ\xa9\xbb\x94\x14\xc1\xb0\xf4\xf3\xd4\xe5+ܺ\u007fO\x8ee\xbbJe\x18')Q\x1cc\xb4a\xa5\xdel\xaf7ۏ\xdeyhߧ\xd2,;\xf3\xad\xff\xf6\xbf\xfd\xe1\xb3\t\xc6\x11O\xf3\t\xf5f\x8bnd\x11\x12\xb3\x93\xe3\xcf \x11h\xb6;4Z\x9d\xfc\x18S\x0f'?\xf8\xea\xba>\x06˱\xb5\xb8\xb2\xcad\xad\x96\xfb\n\x83\x92\x82\xc0\xb7\\ZZ\xee8yS\\\xd5&\a9\x12\xc60pPJ2;]\xa3\xd3\t\xc9dfO\t\x9d\x9b\xc0q,7W\xab\xdd\xe7\xf0\xa1\x05\x9e8y\x89~\x18\xfd\xf4B\xc5\xfd\xdcJ'y\xf8\xc5\n\xe0\xf7\xff\xce{\xd8;3\xc9\xeb\u007f\xe17\xf9\xe8\xdf\u007f\xdfw\x14\x03\xff?\x16<w*3ZJ)(x>E\xdfCJœK\xcb욞\xa4\xe0\xb9d\xb9\xe6\xdar\x93\xe5\x83̴&LӪ\x817\x95\x82\xe0\r\xbd(\xca>\xfa\xf7\xbf\xe7\xff\x14B\xfc\x01\xf0\x8b\xef\xfa\xe0\x87\xb6͉\x8f\xf0\xc1_\xf8\xb7\xfc\x83?\xfa˫\xc5E\x80\x8dz\x8bp`\xcf\x15\xad\x94\v\x94\xfc`G\x18lh\xf5\xecDR^|\xa7\\.\x10\xf8\u07b3:\xfc(NX\\YEI\xc5\xfc\xf4\xc4\b\xbd8<M!\xcbOx\xb3X.=\"\"k\xf7z\xa3\xf2\xcdu\xf2M\xb6\xb6[\x98\x9c\x1ajf\xba\x06@\xa5\\`s\xbbE\x1c\xa7\xec\xd9=\xc5\xcaZ\xbd,\xa5\b*\xbe\xfax\xc5Wً\t\x81\xff\xfb#Oq\xe1\xe2\xf9\xf2o\xfd\xe8w\xff\xa7R!\xf8\xe5\x82\xef\x95\x1cG\x89\x89J\x99\x85\xa9If'jԪe\xc6\xcbE\x9e\xbc\xb4\xccX\xd9\x1e\x0f8dO\x12R\x8eZ\x13\xae\xe3pi}\x8b\xbd3\x93,L\xd5d1\b\x94\xeb8em\xf4k\xb56?\xf9\x9dw\x1f\xad\xfc\xfao\xff\xfe\xf1_\xf8ă\xe1\b\xe4\x90f\x19\xcdN\x8f$M\xf3\x91c\x18+\x95-\x03in\xf3\x9b\xdd\x1e\x9d~\xff\x9a\xe3$\x82g9)@\bA\xabc\x0fw1\xc601VA\x88Q1wG\xc8\xc0\x8ea\x1cp\\\x85\xd16\xbc-\x97\xfc\xeb\xd2\u007f\xef\x1c\xf2y\xfaڽ0ͩ\xb3KD\x83xX\xfb\xfa\xdb\xc0\xaf\xact\x92'\x9eO\b\x9f\xfa\xd9\xef\xe7m\xbf\xf2_\x00\xf8\xcc\xcf\xfd\xe07\u007f\xfc\xa1G\xffU8H\ue6ae\x8dQ\f|\xc6\xcaE*\x05[uhv{l\xb7:\xf4\a\x03\xc2A̗\x9e8ť\xb5MKx\x90\x8fn\xdbN\xac\xa4\x14\xf8\x9c_]g\xef\xcc$\x1a\xa8\x96\xec\xb1\u007fը@\x9ae\xfe\x03O\x9c\xfa٣\xfbv\xbd\xfb\x93\xff\xf0\x8e\xdfL3\xfdߜ4\xcdh\xb4;dڌ\xd8v\x02\xdf\xcf\xc9\xf1-\xf5w\xab\xd3'\xc9R[k\x1a\x11\x901j\xd7\xee\fq\x934c\xabѤ\x1f\rr\xca>E\xb5T\x1c\xdd\xfdIf\xa36%\xcd5\x1b.%\xb8\x8eK\xb3\xd3}ڡ\x8cO\x03\n\xe4s\xecI\x1ccp\xaf9ݮR\n\x98\x9e\xac\xb1\xbe\xd9\x18:Y\a\xf8Q\xe0Ǟ˹\xff\xf9\xcf|\xdfH\x18\x0f\xdc\xf7ÿ\xe2:\xea\xefNT˥'/-s\xefk\xee$\xc92<Gq\xee\xca\x1a\x0f\x9f:\xcf\xcav\x9dF\xa7\xc7 I\xf2\xb67\x9c]Y\xbf\x86:i\b>WB\x90\x19\xc3\xfd\x0f?F\xb5X`\xcf\xcc$\xb7\xeeߋ\xef9\x90\xc0\x85\xd5\r~\xf0\xde7\x1f^\xdbn\xfcj\x92f?\"~\xe4=o\n\xb3L\a\xec`\a\xad\x94\x8b\x94\n\x01\xcdn\x8fn\xb7\u007f\xf5\xa0\xab\x9c\x8a\xcf\xe4h\xf7ݳ3\xd7\xcc\xff5\xdb=\xda\xdd\xde5\x8f\x15\v>\xf33Sh\xad\xd9j\xb4H\xf3\xf2~\xb5lO5Ks\x92}\xad\xeda.g.,\xe1\xba\xea\xba̡B\xc0V\xbdÕ\x95-\x06qB\xb1\xe0s\xe4\xf0\xeek\xc8\xf2\xb5\xd6\x1c?q~\xa7@\x93\x95N\xe2\xbd\x10S\xf5\xc0\xcf\xff\xf0\xaf\xb9J\xfdx\xad\\\"3\x9a\xdf\xfe\xc4g\xf9\xc0;\xdfJ\xa5\x18\xf0\xc7\x0f<ȩ\xa5+#\xd2\xe6,ˈ\x06\tI\x9a\xe4\xc7j\xec<\xfcX\x8c\xa6\x80\xed\xa9\xa7\x9e5_n~*\x91\x80\xddS\x13T\x8bER\xad\xf9\x1b\xaf\u007f\x15i\xa6Y\xdbj\xe0\xe8\xecj5\xd7r)Z\x1b\xbf\xd5h\x13'\xf1Uj\xec!:qXR\xc97!N\x12\xbaaD\xb7\x1fZr✨\x92|<\xcdu\x1d\xfaaD\xbd\xd5!\x8c\x06\x90s\xfb\xfa\xf9i\x06Y\xce\xf80<\x8c\xbe\x1f\x0e\x98\xaf\x8cۚ\xd63\xd3\"\x92$#˓\xca4\xd3$Iv\x8d@\\\xd7a\xd7\xc24K\x97ׇ\xef\xdd]\xa8\xb8?\xb0\xd2I~\xe7ٴ\xe4\x8f\u007f콅b\xe0\xff\x97\xc0u\xdf;V.1^)359ξ\xb9'\xf8\xcf\x1f\xff\xb4\x8d\x02\a1`km\xfd(\xcaIpLK\b\xd1\a\xb6x&\xb5\x98I\xb2,\xc0\x98\x89^\x18NX\x80\x8e@)\x8b\x1f\b\xa3\x01\x8e\xa3\xd87;ͩ\xa5\x15\xe6&j읛\xc2Q\xea*\xf0\raϒ\xed\xf4\xfbyT\x93\x97:\x8c=\x18xx\xcd\xd0\x17ԛ\x1d\xc2x0\"\x04@)F<\xd2\xda\x1e\xfe\xdb\xed\xf6iw\xfa\x18,x\x0ea9Яll\xe5#\xd1\x1a\xa5\x14S\xb51\xe28anf\x12\xfd,\xa4k\xda\xd8pY\xe7D\x966I\xbc\xf6\xda,\xd3\xccM\u05f8\xbc|\r\x9a\xf1\x1f\x00\xbf\xf3ta|\xec\xa7\xde'\xde\xf5\xc1\x0f\x19\xcfu\xfeq\xa5Xx\xeft\xad\x8a\xef\xba\x14|\x8fO?t\x9c~8\xa0?\x88sROM\xbb\xd7c`o\x94?\x11\xf0g\b\xb1(\x84h\x19c\x16\xf3$\xf0\xe9&\xbc\f\xec6\xb6\x8f\x13\x00\a\xb4֯\xee\x85\xe1[\xfbQ\x14\x14\x03\x9f\xf3+\x1b\\\xd9jpx\xf7\x1co\xbe\xfd(\xe2G\xdf\xf3\xe6\xa6\xc1\x04#\x86鑣5#\xe7m\xb8:xivL͎~\x1e2{qu\xca\xca<\xfdou\xfe\xf8\x90\xfd-g\v5\xf9Ab\x93\xe3\xb5|C3\x16\x97WwL\xaa\xefH.3-.,\xae9\xedN/\x05\x8c\x10\x82C\xfb積\x95\x9ea\xda.\xfc\u007f{\xf8\x82\xe1\xf5\x9bO\xb0\xa2\xef\a\x03\x03C\xe0\xb3Ͽ\xf7c\xe9\xfc\xd5\xf2qs5Ɋ\x8b0\xb0\xb003\xf0pp0\xec?\u007f\x85\xe1ܭ{\f\x9f\xbe\xff`\xf8\xfb\xef\x1f×o\xdf\x19>}\xfd\xfa\xff\xff\xff\xff\xeb\x18\x18\x18\xda\x18\x19\x18\xae\xad\xbb\xf9\x92\xe4\x9dY\xc1\x9a\x92L\xff\xfe\xfd\xe3\xf8\xff\xff?\x0f##\xa3.\x03\x03C03\x13S\x18/\x0f\x8f0\x13##\x83\xb8\x00?\x03\x00Zь\xe2;\xe9\xd7F\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_09_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x1f1\xa1M\xf7\x13\x00\x00 \x00IDATx\xda\xec\xbdw\xb4e\xd9U\xde\xfb[k\xedpr\xba9W\x0e]U\xdd\xea\x1c\xd4A\xa1\x91@\xa8%\xa1\x16\b\x04\x12A\xb6d\x0f\x1eB\xc2\x18'\x82\xdeC\xb61`\x9cx\xb2\xb1\x01[\xc8πDˀ\xac\x882\xadn\xb5:VuW\x8e7\xe7\x93\xe3\x0ek\xbd?\xf69\xe7\xde\xdbU\xdd-\x14\xb0\xcc`\x8fqFݺuϹ{\xaf\xb9\xe6\x9c\xdf\xfc\xe67W\xc1\xdf^\u007f{\xbd\xd45S(0\x99\xcf\x030\x95\xcf3S(\xfc\xed\xa2\xfc\xef\xbc\x06\x12)\x00\xc63\xb9\x9b\x01&s\xf9\xef\xfa\r\xf4b\u007f\xff\x1bq\xe5\xdc\xe4\xe8\xfe\xd1!=\x96\xce\xdd\a0\xf5]j\x94\xe9\xae'\xcf\x14\n\xaf\x98)\x14\xfep\xa6\xf07םco\xba\xf3\x90yձ]\x9f\x05\xacﶛ\x9b\xca\xf77H\xec\xb6}\x13\xbfz\xc3\xf4\x98\x99\xcc\xe6\xff\xe1\xdf\xe8\xd0\xf5ʣ\xbb\xcc\xef\xff\xfckK \xef\xf8n\xba\xaf\xd1t\xb6\xf7e\xf6\x9e\xc3\xd3\x1f\xfb\xfd\x9f\u007f\xad\xb9ﺙ3ߎ\x90%\xbf\x9b\r\xb2Qn\x97\xf6Mdr7\xef\x1e}5 \xfew\x87\xad\xf1L\x0e\x80\x95Z\x05\v\xf7\x8e[\xf6L<\xf1\x1b?}\xd7\x0f|\xf5\xc4\nO\x9d[\xff\xd1\xde\xcf\xcc\x16\x8b\u007f3\r\xe2\x87\xe1\xc2\xfe\xa9\x1c\xb7\x1e\x1a\xfe\xc0T.\u007ft\xbe\\b<\x9d\xfbk\xbf\x8f\xb1L\xe4\x11K\xd52#\xa9laf\xa0\xf0\xc1w\xbe\xee\xf0\xa3\x0f\xfd\x8b\xef\xdbw~\xa1\xc2c\xa7V\xff\x9fZ\xd0|r<\x93c\xa9Z\xfe\x96~\x97\xc5w\xf7\xb5Qi\xf8\xfc\xec[o`n\xad\xfe\xa5\xc5'\x1b7,\xd5\xca\vS\xb9<\xf3\xe5\xd2_C\xc2.0W*\xb2\\\xad\x00\xa8\xa1d\xe6G\xee\xbfu\xf2_>xߞɻ\xaf\x1fgn\xad\xc6\x1f\u007f\xee\xfc#\xcf-\xac\xfc\xdad.\xcf·ើ\xab=$\b̗N]\xde$\x9bt\xf8\xe7ﾽ0UH\xfd>\xf0\xd7b\f\x80\xb9R/\xf4ț\x0f\x8c\f\xfd\xf9\xbfx\xf7\xed\u007f\xf0\x8b?~\xf3䭇G\b\xb4\xe6\xb7\x1fz\xb6\xf4\xc9'\xe6~\x06h))\xbe-\xbf\xf3\xbb\xda \xa16\xab\xcdNH\xa8\r\x83\xd98o\xb8{\xf7\x8d\xc0\x81硜o?\x82ږ\xab\x06\xe2\xe9\xf7\xfe\xe4\xf7\x1c\xfdʟ\xfd\xda\xeb^\xf7\xea[\xa6Ḏpm\xc5o\xffɳ<\xf4\x85\x8b?\xad\xf1\x9e\x02\xbe\xa5\xbc\xf1\xd7f\x90\xedh\xe3[-\x96\x82P\xf3c\xaf\xd9?\xf8\xe0]\a\x9f\xde;4\xf8#\xf3\xa5o\xbf\x97\x8cu\x93\xf6|\xb9$\x922q\xe0\xce\x03\x93\x0f\xff\xf2O\xde\xf2o\xfe\xf9\xbbnO\xc4\\\x85\x00\xa4\x14|\xe2\x91Y\xfe\xf3\x9f\x9f\xfa\xbdZ\xd8\xfcÉL\xf6\xdbz\x0f\xdf\xd1\x1cb\x8c\xe9\u007f=[,2\x96ɱ\xfcWKz\x96\x00t\xf7s&G\xd2\xfc\xf6\xcfݛ\xf8퇞\xfd\x1f\u007f\xf0\xe9p\x8f\x12\xf2\xa4\x17\x86\xbb\x8c\xc1\x11\x10\x1a@\b\xb4%EC\bq\xb9\xd9\x0e\x9eYoV\xd7{\x1f6\x92\xca\x12s\xd4U\xbby\xbaP\xc0\xf3\xc2\u07bd\r\xed\x1b\x19\xfc\xd9\a\xee\xda\xf5\x9e\x1f|\xe5\xde\xf4\xde\xc9,\x8dV\x801\x06)\x04\x9f}l\x8e\xdf\xfa\xe3\xe3\x1f\xdchV\u007fz \x99Ʋ\xd4\xff9\x06\x99+\x95\x98\xce\xe7\x1fІ\x1f^(\x97~t\xb9Zf,\x95e\xb9^\xf9\x86\xdeo[r\xffp>\x8e\x14pe\xa5\xc6\xe3g\xd6x\xea\xec\x06WV\xaa\x18\xc3\a<\x1d6b\xb6J\xdaJ\x12\x1a\x83\x14\xd0\t\f\x9e\x1f\x04R\x88F\xccU\xd5i\xb7\xf0\xa4\x80\xcf6\xda\xc1\x9f\xac\xd6+\xeb=o\xed\x19e,\x93e\xae\xfb\xb5K\xecݯ\xbci\xe2\x97~\xe6-\u05cf\x1f\x9a\xce\tKIjM\x9f \xd4XJ\xf2\xf0\xb3\xcb|\xe0\x0f\x9e\xf8w\xb3\x9b\xa5_\x00H:\xf6\xb7-T\xf5.\xf1\x9d\xce\x03q\x95\x18\xbfawa\xd1\x18\x1e:~q\xfd'\xdat\xea\x93\xd9\x1c\v\x95\xf25C\xdc\xf6\a\xdc?2x\xeeg\xde|l\xff\x1f~\xee\x02K\x1b\r\x10`IA!\xed2\x91\x89q`8M6\xe6\x10s,⮍\x14\x02\x03\x94\xdb>\xa7\x97ʜ]\xad\xb0Y\xeb\x10j\x83\xc1\xe8 4\x1f\x12B\xfcB\xa8uq\xa9R\xd6[y\"\x95\xb0-\xeb\xb7\u007f\xee\xad7\xfc\xe4O\xbc\xee \x8dv\x00\x02:~\x88\xe7i\x94\x12̮\xd4\xf8\xc9\u007f\xfe\x85ϯ\xd6+\xf7_\xeb^\xff\x8f0H/Dݼ{\xfc\xb1\xf7<x\xec\xb6\xdf\xf9\xf8\xa9\x87\x9e8\xbb\xf6\xd3\x1e\x9d\xd5\xe9\\\x9e\xb9mhi*\x9f\xa7\x97\x17\xa6\xf3\x85\xdb\r\xfc\x921\xe6\xfbm)\x19\xcb\xc7($\x1d&\xb2\tf\nI\x06\xd3\t\xf2\xe9\x04\xf1\x98K\x84n\x04B\b\x10 \x10H\x01R@\x18\x84\x14\x1bmί\x96yjv\x93\xcb\xeb\x15*M\x9f\x8e\x1f\xfe\x96\x94\xe2\x83\v\xe5\xd2ř\\aϞ\xc9\xcc\u007f\xfc\xfbo>\xfa\x9a\xbb\x8f\x8d\xd2\xf15~\xa0iv\x82\xaeg\b6\xabm\xfe\xf1\a\xbfv\xe6\xf1\x8b\x8b\xd7\x01f\xfb\xbd\xfe\x1fe\x90\xdeux|\xf8\r\xefz\xe0\xba?\xdb3\x96\xe1_\u007f\xe4\xf8\x93\u007fyj\xf1]\x86\u0a49l\x9e\xc5J\x89\x89\\\x9eŮq\xa6\xf2\xf9\xdfԆw&\x1c\x95;8\x9cb$\xed\xb2\u007f\xb4\xc0\xc4@\x1a\u05f6\x18Ȧq\\\x17\xa1\x14BJ\x84\x10\b!AFF1\x06t\x18\x12\x86\x01&\b\xd0A\x80\t\x03Bm\x98ݨ\xf0عEN.\x95\x99+\xb5.#\xcc\u007f\xcf$\x9c\x1f\xfc\x0f\xef\xbb\xf7\xd0\xcch\x9a\x8e\x1f\x12\x04\x9a\x96\x17\xd0K\u007f\xadN\xc0\xef~\xfc\xd4\xf2\x17\x9e\\|\xd5J\xadr\xe6\xdbQ\xfc\xbdإ\xfe:\f\xd2i\xb1:\x90\x89=\xf0ʛ&\x86\xef<2:\xbe\xb4\xdez\xfd\xdcj\xf3\xab\x95N}q<\x93c\xa9Rf0\x9d\xcd\f\xa5\x92\x0f#\xc4[v\x0f$b/\x9f\xc9sd\xa2\xc0\xfe\x89a\xae\x14\x1bL\x8e\x14\x98\x9a\x18#\x99Na\xbb\x0e\xb6\xed`\xd96\x96e\xf5\xffn;\xd1\xcbq]ܘ\x8b\xe5\xb8؎\x83\xb0\x1d\x02\xcf#\x97\x88qtz\x98|\\\"t\x98_\xab{\xf7\xd4\xda\xfeй\xc5\x12\x87\xa6\xf3hch\xb6\x03\x82\xd0\x10j\x83ֆ/?\xbd\xd4\xfeЧ\xce\xfe\xdd\xcdV\xed/ǳ\xdfYc\\\xd3 3\x85\x02\x95V\xeby\xccf\x81j\xbb\xf5M\xff\x12O\xfb~\xc2vGo94|/\x12n?2\x92i\xb5\xc3w\x98P6\xd6J\xadS\xa3\xd9Ԅk\xc9/\b\xc1\r\xfb\x87\x92\xbcb\xdf\x10\x13\xc3\x05\x96\x1b\x01--x\xd3ˏ16X@\xa9\xc8#\xa4TH\xa5\x90\xcaBY\x16Ҳ\x10r\xcbc\x00\x84\x10(\xa5\xb0l\x1be)\x84\x10\x04^\a!`| K\xceUd\x1cD\xb9\x15p~\xa1\xca\xff\xf7\xb9\xb3\xa4\xe26\x93\xc3i\x84\x00c`~\xbd\xceo\xfc\xe13\x1f]oT?\x00P봯\x99\xf7\x82\x96\xc1'x\xd15\xfc\xa6\rRi\xb5\x98)\x14\xac\\<\xfe\xee\xa4\x13\x93\xb5N{\xf1[1F\x0f\x01\xcfo6嫮\x9f\xfc\x81T\xc2r\x8d\x16\xdctpP\r\xe5\xe2\xafmu\xfcW\x97kރ\xb6%_vd$\xc3\x1d\xbb\a\U00064356\x16\xaf\xb8a/w^\xb7+\x8a\xacR\xa2l\x1bˉa\xc7\xe3ر\x04v<\x81\x1d\x8b\xa3l'2\x8a腰\b\xff\x8a\xeeKJ\x89\xb2,\x8c\x0e\b\x83\x10c\f\x83\xb94I\xc7\"\xa54\x1ahx\x9a/<\xb3\xc0\xe5\xa5*S\xc3)Z\x9d\x80\u007f\xf7\x91\x13\xc7\xcf.\xaf\xbf\t\xf0'\xb3y\xaa\xdb\f\xd2[\xf4J\xab\xd57\xc6T.\x9f\xac\xb6\xdb\xfe7k\x8c\x17\fY\x95VKg\xe2\U0007f7c9ٿ\x91\x8d\xc5\xf6\x95\x9a\xc13\xa0\xfbXu2\x97\xa7\xdan\xff\x15\u007f\x95\x16\xfb\xc6\xf2o\xben&_\xa8\xb5<B\r\xbb\xc7\xd3<|beb\xb3ڞ\xbcq\"\xcf\xe1\x91\f\x1b\x1dýGvq\xeb\xe1ݤ\xd2i\xb4P؉$v\"\x89\x93Ha\xc7\xe3(\xc7Ų\x1d\x94e#,\x1bi\xd9`@\x87>\x020hЦ\xeb-\x02!\x05\xc6\x18,ea\xd9\x16\xa1磵!\x9fJ0\x90I\x12v\xda\x04\x1aZ\x81f~\xbd\xc6\x13\xe7\xd6y\xfc\xf4zmn\xb9~g;\xf4\x8a\xe3\x99\x1c\x8b\xd52\x93\xb9\\\xff\xb9\xb7\x16ݾ?!c\xbfx\xef\xb1\xc9_٬vnI8\xee\xff\xaaw\xda\xfa\xdbj\x90\xe9B\x81\xf9b\xf1\xcf3\xb1\xd8\xf8\xfd\xfb\x86ߵ\xab\x90z\xaf\xa7\xe5\x9b\xd2n<\xc8%\xe2\x177\x9a~'\b=\x00&ryjߘqJK\xab\xed\xd7\xfc\xdd7\x1c>\xb0\xb4\xd9\xc0\xb2$_|r\x89\x87\x8f\xafp\xfdx\x8e\x1b\xa7\xf3ܴ\u007f\x8a;\x0eM2<<\x88\xe5ưbqb\x99\x1cV,\x86\xb2]\x84e!-\x1be9\b%\x11RF\xdecB\x10\x06\x13\x86\x18\xad\xa3$/\xc0h\x83\xd1:\xfa\x9eR}\x0f\xb2\x1d\a\xed\xfbh\xa3\x89\xbb6\x85L\x12+\xec\xb0\xd1\xf0i\x87\x06\xcfכm_߷ި^腪B<\x8d6B\x8cf\x93\xf1\x84\xed\xbef \x9d\xfc\xd9\xfdc\x85?yσ\xd7\xff\xd4\xff\xfb\x0f\xee\xb9\xf1\xdc\\9\xf7\xf0\x89\xe5\u007fV\xe9\xd4\xcfO\u007f;CV\xcf\xfaS\xf9\xbc\x98-\x16?Yl\x1b\xf1\x9a\xfd\xc3\xf7\xbc\xf3\xb6]\xe3c\xd9\xd8\x1bBm\xfe\x91-e\xaa\xd26\x8b\x81\xf67k\xed\xb6\xf9FX\xd3J\xbbE\xa5\xed\x95\xf7\x8e\xe6\u007ft\xac\x10g\xb5\xdc\xe2\xbf\u007f\xe6<\xd7\r\xa7\xb9mW\x81#\xbbF\x99\x1c\xca\x13K\xa6\xb1\x13\t\x9cd\n7\x95AZ\xaa\xbb\xc0\x02˲\xa3\xaf\xd9ZhL\u0605\x8b\x02\xa9,\x8c\xd1\x18c0ڀ1H\xa90Q\xb9\x8f\xb2\xed\xc8k\f(\xa5\xd0A\x806\x86t\xdc\xc5u,\\\x02Vk\x1eM?\xbc\xa8u\xf8;\x8d\x8eW\x8f\x8ag\xb1w\xdfX\xfe\x81\xc33\xf9\xf7\x1f\x9c\xc9\xffރ\xaf\xd8\xfb\x8ew=p\xddm\xff\xe4\x1d7\xdb\xfb'\xb3\xfc\xfe\xff:\x1d\xfe\xbb\x87\x9e}\xafG\xfb\x8f\xbfUH\xfc\x8d\xc0^1\x90\xcc\xfc\xd0=\xbb\a>\xf8w\xee\xd8S\xb0\x94\xe0\xf1\xb9\"\xe77\xeakO-\x96?\xfe\xdcR\xe9߃\u007fb+\x9c\xe5X(\xbf0\x12\xb9c\xff\xe4ƿ\xfa\xfbw\f\xfc\xc7??\xc9\xd2|\x8dW\x1e\x18&\x9d\x88q\xeb\xfe)\xe2\x994N*\x1dy\x85\x1bC\xc8h\xf1\xe8&r\x00\xadCL\x18D\vޅ\xba;\x92\x95\xd6\xe8\xc0'\f\x02B\xdf\xc3\xe8\xb0k.\x811\x1a\xa1\xac\xe8\u07fd\x0e~\xabI\xa7\xd9\"\bC\x94\x92\x9c\xba\xb2\xccS\x17\x96\xf8\xca\xe52Վ\xffc\xb6\x14f\xcfD\xf6\xc1{n\x18\xbbm\xefDv\xf2\xd0L\x8e=cY:~\xc0z\xb9\xc5z\xa5\xcdg\xbe6\xc7\x1f~\xee\xfc\xdf[\xaeV~g<\x9dc\xa9V\xfe\xce\xc1\xde^\xaeh\xf9\x9d\x93\xebM\xfd\xb1\xf9R\xf3g\xee\xda;$\xc6\xf2q\x06\x12N\xf2\xd8d\xfe\xa6T\xcc~{\xa5\xa9_\xef(\x87\x96\xdfy\xae\xdan\xeb\x89l\xfe*D2\x96\xc9Q\xef\xb4\x11F\xed\x1a\x1dH\xde\xfa\xc8\xf1en\x9d\xca\x13\xb3-v\x8d\x14\x18\x1d\x19@\xc5b$\n\x83X\xb1X?\x1c\t%\xb7\x19#\x88\xc2\x0f\xa2k$\x89P\x11\xe2\xc2\xec\xdcaBF\xef\x13Ja\f\x911d\xe4]Q\r\x13!/\x13\x04\x18\x1d1\xcaù4\x1dϣ\xd2hSj\xfa\xafm\x06\xc1\x9b\xde\xfd\xc6#\xc7\xde\xfa\xea\xfd\x99\xddci\x02m87_\xe2\xf4\\\x99\xb5r\x8b\xaf>\xbb\xccG\xbfp\xe9\xfd\x8b\x95ڿ\x01c\n\xa9\x04\xdfJB\u007fI\x83\xf4\x12\xd8d>\xcfj\xb5R,\xb5\xf4\xa7Z\xbe\xfe\xa1\x1bf\n\xb1|\xca%К\xeb&\xb2\xce\xf7\x1c\x1b\x9b\x1a\xca\xc4ި\xb5zg\xc2v\xe7\xcaM\u007fŕ\x8eꄞ\xdf\xfb\xacz\xd7@q\xcbU\x81\xd6?\xe27\x03\xf6\x0fgpl\xc9M\x87f\xb0\xe3\t\x12\xf9A\x9cxb\v-\xc9(\\E!H\x831\xd1B[\x16ʉ\xa1,\x1bz\xc5a\xcf0=\xa3t=Kt\x8d\x13\x15\x8d\x9a\x1e\xa6\x15\xca\x02\x13\xb1\xb7Q>1\x18c(\xa4\x13\xac\x17+\xac\xd4:N\xa3\xe3[\xe7\x16\xca\x1c\xdd]\xe0\xd2r\x95+\xcbU\x9a\x9d\x00\xad\rO\x9f\xdd\b~\xef\xe3g\xfe\xfdz\xa3\xfaO薑]\x84\xfa|\xa3șB!\x9d\x8b\xc7M\xa5\xd5\n\xbf-\x85a\xb5\xddf\"\x9bc\xad^]<\xb9\xdcxZI\xf1}7\xee\x1eHdS.\xe5\xa6O\x88ar0ɍ{\n\xe9D\xcc\xfa\xa1\xe1\\\xec\xa7F\n\x89\xef\x91F\x8d\xd4\x1az#$\xd8\x04r1b\xc7b\xae\x95)U:?|l\"\xc7@\xc2f\xdf\xcc8S\xa3C\xd8\xe9,n:\x13-\x9e\x94 \xb6j\x8a\xe8y\r\b\x89T\na\xd9Q\xb8\xea\x19#\xca*}\xcf\xe8\xbdM\x10\xfd\f\xc6t\x8d#\xd0a\xd8\xff\x9e\xb4T\xf4oFGU\xbd1H)1\xa1fn\xb3F\xa5\xed\xb3Rnrn\xbe\xcc\xe1\x99<qע\xe3\x85|\xf1\xa9\xc5\xdaG\xbfp\xe9\x97+~\xe3Wz\xb4O\xc2r\xa9{\x9d\xedƈ\x81\xbc\xf3\x8e\xfd\x93o\xaf\xb7\xfc_5\x86G+\xed\xd6\xeaw\x84:\x89[\x89\xbb^}d\xf4+\xff\xf0\xfb\x8f\xa8\xd0\x18f\xd7\xeb\bET+X\x02\x8d\xa1\ue154\x1a\x1e\x8f\x9fݸ\xf2\xf5\xb3맏\xee\xceO\xec\x9d̎\xcc_\xdet\xceo\xb4\xf3\xaf;2J\xd2Q|\xff\xbd\xb7\x90Lg\x88\xe7\v(\xdb\xe9\xee\xf8\x9e1\xbaе\x9b\x88\r\xbd|\x12%p\xa4\x04m\"\xbaDG\xa1\a\x13\x19\xb0\x17\xde0\x06\x1d\x04\x84^\ac\f:\f\xd1:\xa4\xfb\xf1\x18\xad\tZM\xda\xf5\x1a\x9e\xe7c\x8cf\xb5X\xe5K\xcf\xce\xf2\xc9\xd3+\xd4;\x1e\x81\xd6L\x0e\xa5\xf8\x89\xef;ģ'V7\x1e;\xb5\xf6\x8e\xa5j\xf9S\x91\xa8!\xcbRu;{\xad\xee\x8bK\xe7m\xaf\xbci\xe2淼r\xef\x9eO>2\x9b\u007f\xe4ٕ\xbf\xbbT-\xff\ueddd˚\xc8\xe6X체\x93\xb9\xfc\xc1\x83\xe3\x99G\xfeɛ\x8e\x15\x94\x94\\^\xab\"\x95\x00\x15-\xa0\x91D\f_w\x015\x86X\xcc\xe6\u007f|\xfc4k\xa5\x0e\xaf;:F<\x11\xe7{\xef\xbe\t\x95L\xe3&S\xd1\xce'\xca\rQ\x9a\x10\xdbn\xd1\xf4\xbf\x16B\"m\xbb\x0fm#'Ҡ#\xd8k\x8c\x01m\b\xc3 z\x9f\xd6\xe8  \xf0=0\x86\xd0\xf7\xa2{\xd4\x1a!\x04\xa1\xe7\xe15jtZ-|?\xa0\xd9\xf1x\xea\xec\x1c\u007f\xf4\xe4<\xeb\x8d\x16\x81\x8eʊ\xb8m7-)\x1f\x9c+\x95>\xbd}]\x06\x13\xe9ݮ\xad~~b(\xf5\x83\x0f\xbc|\xd7\xd0\x1b\xef\xddM:n\xf3\xa1O\x9d\xe1ß>\xf7\xd3\x17\xd77>\xd8\xebD\xbeT\xfb\xf9\xaf\xd4\x0f\xd9f\f\x16ʥ\xb3\v\xe5\xea\x1dRʏ\xfc\xcc\xeb\x0e\xbfl\xefD\x96˫\xb5k\x9aY\x1b\xd3-\xb6\x05K\xe56{\x06R\x18\x01\xa3\x85\f\xc2v\xa2\x1a\xa3\x9bd齮\xfa \x11\xf57\x8d\xec\x1a\xc7`\xc2.\xf4\x85(y\v\x01BFPX\x82\xd0\xddh\xd7\xcb1:BXҲ1:D(\xab_\xb7('\x86\xf2<\x82 $\x19s\xb1,ŮB\x82\x8dV\a\xb4Ƶ\xac\xa6\x81\xb7ϕJ\x9fλ\xa9i%\xc5\xe8u\xbb\v\xaful\xf9\xd6\xc33\x85#\xaf\u007f\xf9\f\xb7\x1c\x1c&\b\r\xa5z\x9b?\xf8\xf4\xd9\xda\a>\xfc\xe4/\x04t\xfeS\x8f\xf5\xfeF\xb4\x00\xdfT\x83jK]\x11\x9e\xff\x8b\xe7.\xbf\xa1\xdc\xf0~\xed\xad\xf7\xec~\xdbm\a\x86\xb8\xb2V#\x14\xa6\xbb\xb0\xf4_B\n\x9a\x1d\x9fb\xdd㮙\x18ah\x18\x1c\xc8#m'2\x84TQ\xcc\x17ݮ\xf2\xf3\xe0\xacTQ^\x01P\xb6\x8b\x0e<\xd0Q\x926\x80\x14\x12\x83!\xf2Ez\xbf42\x8e\xe9\xd6$Juk\x98\x900\f\xfb^\xd8\xcb[\x96\xe3\xe2y\x1eR\b\xb2\xc98ci\x17\x89\xc0\xb1,\xa4\x10\x1f\x96B\xec\x19Ne\x1e\xba\xeb\xd8\xe8\xb1\xfbo\x9e\xda?9\x94\xe4\xe0L\x9eBƥ\xe5\x05\xac\x96\x9b\\Y\xae\xf1\x91\xcf_\xac\xff\xb7ϝ\xfc1\b\xff\x1c\xf8+uI\xbf\xe9\x8e\xe1L\xa1@\x10\x1a\x16+\xa5\xf9\xc7/\xaf\xfdT\xad\xe5\x9d85_\xfe\xb5\xbb\x8f\x8c`;\x92\xc0tCL\xcf B\xb0\xb4\xd9\x00`(\x13#Кl.\x8b\x11\x11\xb4\x15\xa2\x17\xe2\xae\xd1\xf8\uf447J\x91ݵ\x1f+\x9e@\x87\x01\x8d\xa59\xdaō\xc8X]B\xd1</\x1e\xef\xa8ZE\x94{4\x02!C\xb4\xd6\xd1Ot߯\x1c\aK)\xfc d4\x9f!\x13_ǒ\x12c\xb4\xf1\xb5\xfe\xe1@\xeb,\xc6\xf0\xe8\xc9e\x84\x84\x9f}\xcb\r4\xda>\x97\x97+\xd4Z>\xf3\xabu\x1e\xfa\xe2\xa53\xe7\xe6\xcbo\x80\xf0<\xc0D\x97v\xf9\x8e\xd3\xef\x95V\xab_k\x8cg\xd2\xe1\xe5\xcd\xe2W/-7?\xf6\x17O/\x8ee\x12\u0381]\xa3i\xa1{\xab\"\x04ʒ<qb\x85\xd5\xcd\x16/\x9bΣ\x94\xe2\xd0\xfe=XJa\xb9\xb1\x88\xbd\xedV\xe4ۭ\"-\xab\xbf\xb3s{\x0f\xe1dr\xfd\x85\xb5\x93i\xbcF\rBݷ\xbd\xc1\xd0of\xf4\xfe\xd4f\xcb4=Tfz\xe1Nt\u007f\x9b\xc1\x84\x06!\f\x9dv\x87d<ƥ\xe5\rά\xd5i\xf9\x81\b\xb4\x8emS\xc3py\xb9\u0087?s\x86\x93W\x8a\xd4\xdb>պǇ>y\xee\v\xa7犯\xa9\aͥ\x1e\xfc\xbd\x16C\xfc\x1d\xef\xa9\xf7PF\xa5S\u007f\x16\xf8\x81?}d\xeewǆ\x92\xef\xdc3\x9e&\xec\xae\xc7\u05ce/\xf3\xe8s\xabd\xe2\x0e\x00\xb9t\x12\xd5-Дew\x8b\xbd\xe7!\x8en(1F\x13\x1f\x1c\xc3\xcd\xe4\xd1\xe1\x16\xcd-m\a;\x9e\xa0\xe3u\xa2E\xee\x19\xa5ǟ\xf7\xe0\x80\x88 Z\xdfcD\xafv\xdf\x12bH\xa5\xd0ҏ\xf2\x99h \xa5 \x93\x88:\x95\xed D\x1b\xd3\x17[\xf4\xbcѱ\x15O\x9eY\xe3\xf1\xd3k(!\xff\xb0\xea5\u007f\x12\xe8L\xe5\xf3\xdft{\xf7\x9b\x96\x01\r\xa5^X\xfe\xa2\xa4y\uf5dfY\xbe\xd4\xf2B<_\xf3ǟ\xbb\xc0WNl\xa2\xa5\xcd\xf4@\x1a\x10$\\\x1b\xa5T\xc4/)ٽ\x93\xe7\xe7\r\xab_38\x99,Z\x87\xd7P\xb5\xf4\xa0\xb1\xec'w\xb1\xeds\x8c\xd1\xfd\"\xb1\xe7\x1d;\x141\xbd\xcf\xe8\x17\x98\x12\xcbq\b\xb5f| \xcbt6F!\x97c(\x97Â\xab\xa8\x9a\x84k\x93K\xba?\xeb\x13\xbc\x03茤2\xdf\x12\x97\xf5M\x87\xac\xa6\xd7a*\x97\xcf\xc7,\xe7\x15\t'\xf6\xaa\xa4\x13\xbb5\xae\x9c\x83\xaer\xd2\x1dO\x17\a\xb3qBm\xee\xff\x93/]\xa2ٖ\x1c\xdc5\xc1z\xb9¡\xd1\f\xb9\x84\xcd@:\xc1\xc8\xd8\b\x96\x13u\xf6\xfa}\xf1\xde\x0eT\niY\xfdd \x84 \x96\x1bܱ\xf3\xc3V\x93\xf6\xc6jD\x8bl۹RY\x11w\x85\xe9\xd6)\xfa*C\xea \x88*v!\xfa\xe8N\x87QN\xd1a\x88\xefu\xf0\xfd\x90K\xcbE\xe6\xab\x1e\xef\xfdٿ\x83\xd7\xf1X^\\\xc1t\xe9\xfc\xb8m7\xa4\x10o\xab\xb7\xdb\x1fjx\x9e\x1eN\xa7Y\xab\xd7\xfe\xf7Ȁ&\xb3y\xa1\r\x0f\u07b6\u007f\xe8\xbf\x1cەGJ\x81\xe3ZT\x9a>WV\xaa\x9c\x9e+{\x9fzl\x91=\x13#\x8c\r橷Zt\xfc\x80B\xd2EI\x89cY\x88n\x01\xa7\xafQ\x10E\xbc\x13\xfd\xba#J\xde6\xb1\x81\xa1\xa8\xfb\xd7n\xd3X\x9e#\xf4\xbd>\xb7\xb5\x15\x93z\xc4c\xc4u\t\x1d\xeeЈ\x81\xd9\xf1w#\x04\xa2[\xcdCϠ\x12\x81\xc0\xb5\x15\xc6\xef\xf0O\xdf\xff\x1b\xfc\xe2?z\x0f/\xbb\xe1\b\x1f\xfb\xd8'(V\xaa\x04a\xf8#\xab\xb5\xda\xc7\xf7\x0e\fP\xedtX\xabվ\xe5\xf0\xaf\xbeQD\xf5|Ҭ\xdaiS\xeb\xb4O&\x1c\xf7\xc0=GG\x8eL\x8f\xa4\x18\xce\xc7\xd93\x9ef\xa5\xd4ba\xbd\xad\x0e\xef\x9ab0\x97!ԚŵM*\x8d\x16\xb7\xcc\f\x10\xb3-\x862\t\xf2\xc3C(\xa9\"2\xf1y\x1e\x12\x85+ѯ\t\x85\x10\xf8\xf5\x1a\xed\xcd5ڥu\xda\xc5uL\x18\xf6ܧ[$\xea\xc8/\xba0\xd7\xe8\xb0\xfb\xda\xe9!:\fѾ߯\xfcE\xf7O\x13\x04\x18\xa3#\x0f\xe9th\xb4=\x16\xd6K\xac7\x02:\x81\xe6\xf3_~\x84\xeb\xae;\xc8\xeb^w?\xe7\xcf]\xa2T*\x9b\\*\xf9ŹRiG\xe6\x1eH&i\xf9\xfeU\xeb\xf8B\xdf\xff\x86\r2S(\x90\x8aǙ\xef&\xa8\xa9B\xe1\xc1\\<~_&\x16\x1b\xcd\xc4\x13Tۭ\xb5\x95J\xfd\u007f\xae\x16\xbdw\xdcvh8\x87\x12\xfc\xd1\x17/\xf2ę\"G\xf7\xee\"\x19\x8fa\x80\xe5\xcd\"~\x10\xd0lw\xb8q\xb2\x80\xe3X\f\xa4\xe3\xe4\a\x06\xa2<\xe2l+\f\xfb\tۺ*^o\xa7Q\x84\x14ת@w\x00]\x1d\x86\xe8\xd0\xef\xff\x9c\xe9\xf2Va\x10\xf4\xabt\xb1\r\x12\xeb\x9eAt\x88\xd7\xee\xd0\xf6=f\xd7J,\xd7<\xca-\x0f\u05f6y\xec\xa9\xe3`\f?\xf7\xdew\xf3\xcc\xf1玕\x8a%'\uee9fO:\x8e\xbe\xe5e/cvq\xb1\xbf\xe8\x85d\xf2p\xd2uoN8\xceT\xd3\xf3\xae\xb4|\x9f\xfb\x0e\x1dbvc\xe3\xaf\x16\xb2z\xb2\x9cV(p\x84VS\x85\u009b%\xfc\xbe\x12\"%\x00\xdd\r\r3\x85B\b|v\xad\xdc\xf9\xbf\xff\xe3\xff:\xfd_w\x0f&9u\xb9\u00adG\x0e\x90J&\t\u0090b\xb9B\xa5\xde좥\x88Y\xc1DT`\xab\xd1\x04ea\xf9~7\xa1Z;\xe1*\xcf/$x\xe1\uf6ed\xea\xc3t\xa9\xc6(O\xec\xfc<ݫ\xeeŵ\n\x1e\x81\xf65\x9e\x17Q.\x8emGL\xb31\x84]/s-\x8bO~\xf6KX\x96\xc5Ͽ\xef\xef\xf1\x8b\xbf\xf2\xeb\xef\xab\xd6\xeb\x9fPJ}\xe9\xec\xc9S\xf6`*u\xa3\xa3\xd4\xfb\x94\x94?\xa8\x94B\n\xd0\xda\xe0Z\xd6\\\xa8\xf5\xdbN.,|\r\bw\x0f\fPi\xb7)6\x1a/\xee!S\xf9h\xcea0\x99\xb3]˼\x0e!\xfe[\xc6V\xef+\xd8\xcaٟt\x99\x8e\xdb\x14lɈc\x91\xb1\xa4\x94\xb0_\b\xf1\xa6r\xdd\v\xe77Z\xf2\xd8\xdei\n\xb9,H\x89֚s\xb3\v\xb8\xaeC\xdb\xf3iw|n\x98\xc8a[\x8a\\2N\xc7\xf7\xf9\x93\x87Orױ=\x18\xb9E\x9f\xf7\x05\n\xf2\x1b\a\x81\xcfG`\x84\x01\xfa\xf9\xe1!\f\xfb\xe4\xe2լ\x9e@\x98\x90O=~\x16\xcf\xeb\x90s\x15\x9d\x8e\xcf\xf9\xc5Mf\xcbm\xaa\xed\x0e\xda\x18,)\xc9岜9w\x91x2\xc1\xcd/;\xca\xc9S\xe7\xde \xe0\xeet*\xf9_&GGߵo\xef\xae#\xfb\xf6\xee\xe6\xf0\xa1\xfd\xecۻ\x1b۶\b\x830+\x04?\xe5y~.\x1d\x8f\x9f](\x95\xca\xd7\n_\xeaZT\xfbT>W\x90R\xfc\xae\xa3\xd4\a\x1e\x98\x1e\x9e\xb2\x8d!o+\x94\xa0_\x81\xdbR\x90\xb3$y[\x92\xb3$Mcd!\x9fczt\x18\xdbq@\xc0\xca\xc6&\xd5f\v/\b\x11@\xb3\xdd\xe1\xc0H\x86D\xccƱ-\x0eL\f\xf1\xf4\x85E\xf2\t\x87\x81|\x16i;;\x90P\x1fe\xbd\xa4\xa6E\x13v\x1fN\x00\xa6\xbb\xf0\xa6K\x11\x1b\xcc\xd6\xf7z9\xa5kp)d\x9f\x156a\xc0\x1f}\xf9\x04o\xbeu/\xadv\x87Z\xabù\xc5\"\x17\x8b\rZ~\x10Q4R\xd2\xf1\xbch\xb3]\xb8̫_y7\xa5\xcdR\xac\\\xaa\xec\u007f\xf9\xcboW\xa3\xe3#(\xdbf\xb3\\\xe6\xf8\xb3\xa7\xb9p\xf9\n\xcdV\x87\xbd{g\xb8\xe7\xae\xdbPR\xdd>7;\xff\xda|:}\xb2\xd6n_\x19\xc9dht:\xd76\xc8T\xa1@.\x16\x1fԆ\x8f\x0fŜ\xef\xfb\x95[\x0e\be\f\x97+uFc\x161)p\xa4\xc0\xe9\xf2|\xa1\x89\xdcQc\x98\xf7a\xff\xc4(\x99t\x1a\xa4$Ԛ٥\x15:AЇ\x96\x1d\xdfg8\xe52\x92\x89!\x85d \x97\"\x9bt9qy\x99\xc3\xd3#؉\xe4\x0ev7Z\x00\xeb%\xf9\xea\xd0\xf7\xfb|U\x0f=\xf5\xe8{\xed{\xe8 \xec\x86/\xddg\x83\x83f\x93N\xadB\xbbZ\xa6U.\x82\xdf\xe6\xf1sKĕ`\xf7@\x12?\xd0ԛm\xce-mrv\xbd\xde\x0fY\x00\x96\x8c\xc4ݾ\x17\xd0h\xb5\xf8᷼\x91\xcf}\xe1+\x9c\xbf2Ǚs\x179\u007f\xe12\x8bK+ԛMZ\xed\x0e\xf5F\x93\xb9\x85e\xe6\xe6\x16\xf8G\xbf\xf0\u007f\x91I\xa7\x06O\x9c8\xf9\xe6L<\xfe\xc4J\xb5zi\xbbQ\xfa1a<\x9fg\xbeXD\xc3o\xec\xcd&\xee|\xff-\aȻ\x16\x8f\xaf\x16\x19r\xa3\xeeZH\xd7\x00\xa6Ϸ\"\x04,uBR\xf1\x18\xb9L\n\xa4\xc4\x00\x8df\x8bz\xab\xb3C\xb8f)Ņ\x8d\x06\nC\xa3ݡ\xd9j3\x94\x8e\xb3\xb0V\xa1\xb5\xb9A\xd0\xe9t\x11Q䅦\x9f\x94_\xd05\"\xb4\xa4\xafV\xdd\xe8 \xa0S\xaf\x11t:\x04\xbeG\xe8\xfb\xb4\xab\x15j\xab˔\xe7/S__\xa6S\xab\x12v\x17Bw:\\ZZef0\x8d\xdf\xf5h/ДڑƷ\x97\x85\xc2n\x13\v@)\xc9\xe3O\x1e'\x91\x8as\xe7\xed7c\x82\x1e?\xd6c\x8c\xb6\x90\xa31\x86\xd5\xcd\"\xef\xfb\a\xbf\xcc\xf57\x1e\xe5\xc7\xdf\xfeC\x99\x98\xeb\xfc\xc5X6{\xdfj\xb5zu\xa5\xbeT*1\x9e\xcf\xe7R\xb6\xfa\x89\xb7\xec\x1e#\xefڜڬQ\xf3|\x12*bRM\xa4\"\xefw&\xa4\x81\xc0\xc0\x9a\x17\xb2{d0⣺7[o\xb6\xf0\xc3pG\br\x1d\x8b\x95r\x93 4\x04~\xc0\x85\xa5\rj\x8d\x16٘B\xeb\x80\xfa\xda\n\xb5\xd5%\x1a\x1bk46\xd6i\xac\xaf\xe25\x1a\xfd0\x13)J\xba/\x1d\x10\xfa>:\xbc:\x1f\x04\xed\x16\xedZ5\xf2\x84N\x87Vq\x93\xea\xe2,\xb5\x95%t\x10\x10\xcf\xe4ȌN\x90\x9b\xda\xc5\xc0\xde\x03\f\xee=Hjt\x82\xa1t\x92\xd5J\x83z\xdb\xc7\b(ך,U;W\x11\x96A\x18bI\x89%%R\x1bΜ>ϭ\xb7ވ\u0090r\x1cR\x8eC\xc2q\x88\xdb6\xaeR}U~\x18\x86\xec߷\x87_\xff\xcd\x0f24:\xcc\x03\xaf\u007f\r\x12>=\x9e\xc9\x1c\x01\x18\xc9dv\xa2,\x01\x0ffl\x8b\xe9T\x1c_\x1b>qe\x99\x83i\x97@G<\x8e\xe9\"\xcb\r/\xa0\xe8G\x8a?Ok\x8c\x10d\xd3)l\xdbA*I\xe8\a\xd4\xea\x11\x1f\xb4\xfdrl\x9bF\xa3\xc5R\xb5C\xca\x11\x94\x97\x8b\x14=\xc1\xdd\xf7\xdc\xc1\xd0\xc1=\x11y\x1e\x86]d\xa3\xe9T+\x94\xae\\@\xd96\xd9\xc9\x19\x9cD\xaa\xdbt\x8a\xf2\xc6\xf3{&Fk\xbcF\x1d\x1dD^\xe5ժ\xb4+e\xfcv\x13'\x9e\xa4\xb0{?N2\x8d\xb4\x14Ҳw\x80f\x93\xcds\xe7\x91M\xfe\xf5\x9f>J6f\x93\x8b۬\x96\xeb\xac\xd4:;*\xfd^\xed\x12\xb7\xac\x8845\xb0\xb2\xbc\xca\x1dw\xde\xda\xed\x14G\x9f\xaa\x84\x88\xf2\x81R\xd8\xc6\xe0\x05\x01\xadf\x8b\xc3G\x0e\x92H\xc4\xf9\xd0\u007f\xff(\xbf\xf9/\xfe\x19\x8f<\xf2Dliy\xe5׀\aV\xab՝\\\x96%\xc4\x0f\x8f%b\x8c$\\>ye\x99\x99x\x14\xaa\x8c\x89\xc2T'Ԝj\xf8l\x06\x02۲\x90Ң\x15B*\x11G\n\x81eG\x1d??\b\xa8\xb7\xdbXJ]UGX\x96\xc5b\xb9\xc1\xe2f\x83\xeb\x8f\x1d\xe4\xeb\x17\u05f8\xfb\xb6\x1b\x10\xb6\x83\xb4m\xacX$\x15u\x12Iң\xe3\x8c\x1e\xb9\x81xn\x80\x95\xe7\x9ef\xed\xfc)L\x18\xec\x84\xc5}8\x1bЩ\x96\xd1A\x14¼z\x95fq\x83\xc0\xf7\x18\xdc{\x88\xf1\x97\xdd\x16)Z\\7j\xffv\xf3M\xef%,\x8b\x81\xd11\n\t\x97ٍ*'\xae\xacri\xb3\x81\xd6z\a\xa9\xe8Z\x16\x89\xae\xbeKt\x93\xfcf\xb1L2\x11C\xbd\xc04\x95\x14\x82\x98m\xa3\xa4d\xa0\x90gxd\x88\x8b\x17/s\xe1\xd2\x1cox\xc3k\t\xfc\u0e91Lf\xdfU\xe4\xa26\xdcs]>E\xa05g\x8aU\x06\x1c\x8bp\x1b\xcb9\xdb\x0e\x88Y\x161K\xa2\r\xf8:$4\x9al2\x11\xc9uTT\xdc\xf9\x9e\x87\xe7\a\xfd\xf0\xb53l\xd9\xcc\x17\x9b\xc4]\x9b\xc7\xce\xcc\xf3\xe3?\xf0j\xbc`\x8b\xc1\x9d]\\\xe3\xca\xe2\x1a\x8df\x1b۲\x00Cjd\x8c\xa9\xdb\xee\xc6M$ټx\x16\xbfQ\xa3RkRo\xb4\x11\x18\x02\xafM\xbbRFkM\x18\x064\xeb5\xe6g\x17H\x15\x06\x99\xba\xe9\x0eR\xc3c4\x1a\r\x9a\xad6Z\x1b,K\xd1hu\x98]Z\xe7\xd2\xfcJ?\xee\xabD\x9aW\x1d\x1a\xe5\xf4\xfc\x06\xa7\x97\x8b\x14\x9b^?2D\xa4\xa9\x8c<c'Z\xee\xbe_\xbcpʹ͘\xe9T\x82Z\xad\x81\x14\x92O~\xe6\xf3\xdc{\xef\x1dH)\a$L\xef(\f\xc7s\x85ۅ0ΑB\x1ac\x04\xb6\x92ttw\a\x11\xc1\xddZh\x18\xb0\x04\x9e\xd6h\x03^7\xc1\xa7\xe2\x91$\xa7\xe7\xaea\x18\x12j\x8d0W\xd7\x11JI֪\r\xf2\x99\t\xbc\xa0M\xb9ю\xfa \x84H)\xa9\xd4[\xac\x97\xaa<\xfe\xdcy\xca\xd5\x06?p\xff\x1d\xe4\xb3)\x94\x94d'gH{\xa3\xac.\xcc\xf3\xdf>\xf5u\xde\xfd\xd6\xd7\xe05C\xbcvkK\xcc\xd0i\xd3\xd8\xdc\xe0\x91K\x1b\f\x1c<J\xc1\xb2\xa2\xca;\bi4\xdal\x94W8}y\x11KI\xf2\xe98\x03\xf9,n\xcc%\fB*\v\xb3T\xbd\x90}\xa39ί\x94X\xae\xb6#\xefٶ\xd3\x05/\xb9\xee/xٖE<\x16\xa3\xd1l\xe28\x0e\x8f|\xedI\xc2 dtl8\xbe\xb4\xb4\x92\x05\xb0\xa6\v\x05\xe6\x8aE\x940\xf7\xa7l\x8b\xa9d\f\x8da(\xe6\xd2싗\xa1\x19j\x94\x14]\xfd\x12h\f\x81\x8enֱmTw\xe7h\xad\xbb;Uc\xd9\xd7p߮\xd7d\x131^~p\x9c\xf3\xeb\xf3\x18}\x14\xa5\x14\x8b\xf3\x8bX~\x83\x89\xac\xc3-\xd7݀\xed\xba\xd8R {HEk\x9cX\x8c\xff\xfc\x89\xaf\xf3c\xf7^\x87\xa8\x97\b\x92\x99(\xa4z\x1e:\f\xf0\x9a\rl\t\xafy՝<\xfc\xd4i\x1e\x1c\xcca[\x16\x03\xb9\f\x99T\x9c+\x97.\xd2^\x99%iK\xca\xeb\xb0\x16\xc2\xf2\xf93\xec\x1bΒP\x86\x94\xeb\xf0\xc6[\xf6\xf1\xd9\x13W8\xbeP\xc2l[~\xd9E\x90\xd7\"\r\x8cyi3E)\xb5W\xf4\nj\xad\x16g\xcf]$\x97\xcb8\v\v\xcb1\x00\xab7\xf0\xa8\xe1\x9e\x01[\x89\x96\xe7\xe3:6Cq\x97K\xa56\x19[a\xb4\x89F\\{з\xeb\x1d\xaa\x8b\x1e,%\xa3Y\f\xcb\"\b\xa2\xe9\xa3м\xb0\x00<\x1dw\xd8=\x92E\b\xc1\xbe\xa1\x14\x9f\xff\xd3?\xe3\xb9\xcb\xcb,l\xd6X\xabwhz\x01\x8e\x12\xdcv\xe3Q\xde\xfa\xc6\xfb\x19\xc8e\xfa\u07b7\xb0\xb2\xc1\xeb^~=\xb9\xa4M}c\x83D\x8f\x944\x06ӓ\xfbh\xcdޙ\t\x8a\xb5\x16\xed\x8e\a\xdap\xea\xdci\x1ey\xf8kT\x1bMVk\x1d*m\x9f\x96\x17\x12w\x14é\x18\xa7\xe3\x16w]\xb7\x9b=\xc3Y\xb41|\xcf\xf53\xfc\xc1W\xcf^%ѹ\xa6AB\xbd\xc3p\xd7\x04\xe8\x06\\\xd7%\x91\x88Q\xa9\u0590B\xe0(ŉ\x93gȦ3\x84:L\xf4C\xd6X.7(`\".\xa3^\xb8e\fө\x18\x8f\xafm\x92\xb1dW6`\xd0\xdd\x0f6\x18\xdaa\x88+#\x8aZʭY\x8c0\f\xaf\xeamo\u007f m\xa0\x1d\xf8\xb8\x96B\bx\xe2\xcc\x1c\xf3k%\xe6+\x1d\xfe\xf2r\x89zg\xabvyt\xfe/y\xe8\xea\x8fǷ\x00\x00 \x00IDAT\xe1\xe3\xfc\xea\xbb\xde\xcc\xf5\aw\x13\x8f\xc7\x18J\xc7\xc8O\x0f\xb0\xb9\xb0@\xe8uh\xac.#\xe2I\x8cP\x04\x9d\x16*\x88\x8a\xc4N\xb9Ⱦ\xf1\x01N\x9d8\xc9ɓ\xa7\xa9\x94\xca\x14;\x9a/]Xg\xbd\xd1\xe9*\x94\xa2ߓv\x1d\xee\xdb3\xc8\xd7N_!\xe9\xee'\x9fN\x90\x8dG\xe2\x8bP\xbf\xf4d\x81T\x92 \b_\xc2 \x86\xa1\xe1A\x1c\xdbfqi\x05D\x04\x8cΜ\xbd\xc8\xe8@\x01\xa3#[X]\x88fkC<\xe7(B\xad\tB\xcdT*N-\xd0[\xd6\x15\x02\xbf\v\u007fu\x17\xd6\xf5\x88\xbc^\xd27\xc6\xf4\xd5\x1c\x98\xbej\xb3\x8f\xb0\x820d\xb3Rc a\x93\x8e9,mT\x98\xdf(\xe3\x1b8\xbf\xd1\xc0\x92\x02K\xc9~̶\x1c\x9b\xd5j\x83_\xfa\xcf\x0f\xf1C\xb7\xecc \x93\xc0\xb1\x14W6k<p\xc3.\\\xc7\"f+>\xf8\xa9\xc7\xf8\x9ec\xd3<3\xbb\xc1\x1bo\xddG\xdb\x0f\xf8ħ>\xc73\xb3\x9b\xe4\xac\x10Ƕh\x1b\xc9W.\xaeRi\xfb\xb8\xd6\xce\xdc懚\x93\xabuƲ1Nέr\xdf\xf5{\t\xb5f\xd7`\x9a\xa7\xe6\x9a\xd8J\xa1\x8d\xe9>\xf3\xf394M:\x95\xa4\xddnw92\xfb\x05\r\x92ͤiu:Ԫ\xd5>\r\xb3\xb0\xb8̮\x89Q\x84\x88\xa6~\xe4t\xa1\xb0 \x85x\x1a\xd8\xe5*E\x10j\x02m\xb0\x95\"cYt\xc2\b\xf6I@u}E\x1b\x83#e\x1f2\x06=q\x1a\x91\xcc\x1fc\xae\xf2o\xcf\x0fX\xd9,\xd1\xeat\xc8%\\\x06\xd21V\xca5\x94\x90\x94[\x01ն\x8f\x92r\a\xec\x13]\x9ab\xbd\xde\xe6w\xff\xf2\x14\xa7g\x97Y\\Yë\xd7\xf9\xe5\x8f>\xc2\xdcz\x85\x8f=v\x8e\x91\xa9I\x0e_\u007f\x1dBY\xfcϯ\x9f\xe3\x13\x8f\x9d\xe2\xe3O^\"!C\\Ǣ\xd6\t\xf8\xf8\xc9%\xcam\xff\xaa\xda(BO\x82͆G\xb5\x15Po\xb5\xe9xQu~\xff\xd1)\xfc0z6!\xb6r\xd9U\xef\xb7,\xc2@\xf3biDk\xcd\xc8\xf0\x10\xc5b\x19\xdf\xf3\xfb\x9b\xb8\xdd\xe9\x80\x11\xc4b\xb1ߞ)\x14\xceʑ\x983\xb1?\x15\x1fIXR\xd5\xfd\x80f\xe0\xd3\xf1}\x9a\x9e\xcfLҥ\x16\x84\x91\x110\xa4U\x84\xb0\xba\xb4Q\xb7b\x17\x04\xdb(\x03\xc7q\xb0\xba\xdd\xc0>\xdd\x10\x86l\x94+\xf8\xdd\xfc\xf2\xf2\xfdc\x18\x03\x9e\x1f\xb9y\xab\x13\xf4\x15\x88\t\xc7٢\x1b\xb6\xe9\x15\xbdP\xf3\xa7\xcf.pa\xa3\x8e\x10p\xf3T\x96\xaf\x9f\x9d\xe3\xc0\xc1\xfd\xbc\xfbm\x0f\x90\x9d\xda\xc3\x0f\xbe\xe1\xd5lV\x9b\x84Zsl<\x8bkI\xe6JM\x1e:\xbe@\xad\xe3\xef\x105l\xaf\x11\x1ce\x91P\xb0R\x8bPUˋ\x0e\vx\xc5\xe1\tT\x0f\xba\x1b\x83w\rV \f5\xa3#C\x94J\xe5\x17M\xecR\n&'\xc7x\xfa\xf8sX]\x00$\x84\xc0\xf7}*\xb5*R\x8a\x98\x14‥\x04\xecI\xc5\b\x8c\xe1R\xa3\xc3z\xa3\xc3T\xd6&4\x9aC\xd9\x04\u007fVi\x90\x8cG\xbd\x8c\xac\x92\xd4|\x8d%\xe4\x96\x1cM@\xc7\x0f\xd0݆\x8f\x94r\a\x85b\x8ca\xb3R\xa3\xd3\xedyH!xͱ\x19\xfcP\xe3\xda\x16\x06\x83\xe7\a\xe4-\xc1F\x18a\xfd\xa4\xe3\xe0\x87!^\xd85t\x17n\xfa\xa1\xe6\xf3\xe7W9\x94O\x91\xb0$\x03\x839~\xf2\xd8\xc1\xadƓ\x94\xcc\f\xa6\xf8\xea\xb9e:\r\x8f\xaa\xd6\\(\xd61&Z\xf8\xed\xd9@\t\x81\xad\x14\x8e\x8arـ%i\xf9a\xf7\x19\xb6:\x95c\xb9$\xeb\xd5&R\x88\x88(\x05\x9cm\xb5\x881\x10O\xc4i6_|\fA)E,\xe6r\xf6\xec\xc5m\xa2X\xf0\xfd\x80K\x97fq\\\x87\xa9\x81<2iY\\n\xb4\x19\x8d\xd9T\x82\x90\x93\x95f\u007f\n)\x17sq\x84\xe8S'\x8e\x88\x92\x8e6[\xb4\xb6\x12\x82V\xa7\x13\xe5\x8en\\\x94]\"Q\bA\xadѤ\xe9y\xfdDoK\xc1@*F\x10\x86\f\xa4\x13\x18cp\x1c\x8b\xb4\x14\x14\x94 \xec.\x9ekY\xa4\x1d\x87\x94\xeb\x12\xb3\xach\xf1,\vǲ\xd1^H\xb1\xe1\x11O\xa5\xc9\xe6s\xdd\xd0\t\x8ee#\xa4\xc4Q\x92͆G\xa7\x13\xa0\xa4\xc4V\x12[)\\\xcb\"nۤ\x1d\x87\xa4\xe3\xe0ZQW2+\x05\x16\x86\x8ck!\x85 \xee\xd8}\xefN\xbb\xf6\x8eP\xd4\xe9\xaa\xe4\xb7\x17\x86\x8em37\xbf\xd8\xdf\xf9/d\x90Z\xbdAq\xb3D\xb8\xed\xfd\x96R\xd4\xebM\x06S\t\xfc0D\xd6CM\xdcR\xb4CMڲ8[kRn\xfb\xdd\xcaR1\x1a\xb7i\xea\xc8\x1f\x94\x80\xa40\x84\xdbx-!\x04\xcdV\xbb\x8f\xaez\xe3\xc81\xc7\"\fB\xaa\xcd\x16ە\xba\xad d~\xa3\x86\x01\xc6\a\xb3\x80`<\x17'\x8c9\x8c\u0602IGD]\xc5\xee\xd3*)\xfb\v\xd9#\xeb\x1a\xa1a\xdfD\x8e\a\xef\xbd!R\xcc\xf7\xf4\xff1\a\xcbR\x1c\x1cIqӞ\x01,\x03y7Z\xfc\xb8m\x13\xb3,\x9c\xae\xf7\xf6\xa8\x8f\xb4\x82\x11G b.{\x06\x93\x142)\xec.\x05b\x80\xc5r\x9d\xedi\xc7\xc0U\xa1K)\xc5\xec\xec|\x94?_\xa85kY,,,\x13lc%\f\xe0*E\xbb\xd3A\a\x01\x96RȘcc\x84\xa0\xec\x87L\xc4m\x96[>\xe7*\r\xb4\x8e\x12\xf9d2\x8e\x1fn\x19 \xa5D\x17im\xf5\x1d\x9am\x0f\xad\xc3\x1da+\x11\x8bQm6\xfb\xb9e+Q\v\x8eϯ\xa3\x84\xc0\xb5\x14{\xc6\ap%ܲ\xab@\x90\x88\xe1Hɤ%\x18\xb6\x04Y%HKA\xb2\xfbJKAJ@\xccU\xbc\xe9\xd5wp\xfb]\xb7\xe3\xfb>\x96R8\x8eM\xc2u\xb9nf\x1cc \x15\xb7ɧ]\nJPP\x90Q\x82\x94\x8c^=o\x1c\xb1\xa2P%Sqnڝ\xc7R\x82CS\xc3\x04\xa1F\n\xc1\xe5\xf5\x1aՖwUo?\b\xc3\x1dĤR\x92\xd5\xd5\xf5k\x02\x86\xad\xcdⲹY\xa4\xbd\xadKhw7\x86%\x05\x99T\x92\x89\x91A\xe4h&Aدz\fIK\xf2t\xb1F\xab\x8b\xab'R1\x8c\xe8V\xe8\x18\\!H\xc8HN\xd9#\xc3:\xbeO\xb3\xb5\x15\xb6,\xcb±,\x9a\x1d\xef\xaazD\b\xf8\xfc\xa9\x05\x84\x88r\xc2uӣX\xb6\x8d\xab\x047O\xe7\xd83\x95'[Hbۊ\xb8\x92\xc4-IBE\xaf\xa4#\xd95\x90\xe0=o\xfb^\xee}\xc5\xcbiw:\xb8\x8e\xcdW\x9f>\xcd\xef=\xf4\x17<z\xf2\"\xd7\xdfy;\xb7\x1cڅ-\xe1\xd0D\x96\x91\x81$q\xc7\"a\t\x92\x96$eK\x92\x96$\xe9Z\f\xe5\x93\xec\x9d.p\xfdd\x96\x94\xad@\xd9d\x931\x8c1\xc4\x1c\x8b\x8f~\xfd\x1c\xd65\xdaȺ\x8b,{\xcf\xea\xfb>\x8dF\xf3*\xc3=\u007f\xf6qc\xb3H\xd0]#\xd3\rW\x1d\xdf'i)vON\xb0\xba^Ĳ\x95\"\x97J\xa0j\r\xe6\xebm\x06\x1d\x9b\x8b\x8d6\xf3\xb5&\a\a\xd2\x14\\\x87\xa4c\x11\xf8\x11J\t1\fYP\n\fN7I\v\xa0\\\xab3\x1e\x86\x18\xa5\xa2\xaa]ɾ\x81\x9e\u007f\xcdm\xd68\xb7\\f\xf7p\x06[I\x86\vy>{\xe2\x12GG3\f\xa7\x1c\x86R\x0ez$}M\x92\xa20<\xc2M7\x1e\x8b<X\n\xfe\xd3G>͇?\xf10a\x18\xd15?\xf7c\xaf\xe7\xfb\xef\xba\vۄ|\xf5\xcc<S\x85\x04\aG3\xe8k\x94\xaa\x92萁@\x1b>\u007fq\x9d7\xdf~\x10\xad#\xefX\xaf4y\xf4\xc22\xb6\x92\u05ec)z\x8b\x9fJ%)\x95*\x11U\xf4\"\xcdM\xcf\xf3i\a\xfe\xce\xc1\x9c.OV\xc8f\b}\x9fZT\xc1\v\n\xc9\x04\x81\x90\xa4mE'\xd4$\x94⋫%|?D)\xc5\xe1L\x82r\xd0M\xe4&jL\x8d\xd8\x11\x04\xee!\x96r\xbdѷ\xbe\x94\x12ǎBȵ\xae\xf5z\x8b\xa7\xae\xac\xf5딗\x1f\x9a\xe0'^q=\x17K\x1eBJbvt\x8c\x9e\xd5M&-?\xa4\xdc\x0e\xb8R74T\x02\xbf\xeb\xbdg//\xf2\x91\xcf|\r\xaf\xab\xbdE\n\xfe\xe5\u007f\xfd\x18e\xcf059\xc6\xdd\xc7\xf6\x12*\x87S+uJ\r\x9f\xb6\x17F\xe27K\x11\xb7\xa3#\x9d\x121\x97\x8b\xa56\xef\xf9\xbe[\xb8\xe7\xe0$\xa16\xd8J\xf2\xa7O^ؑ|\x9f\xcf\xc7I\x11\xa9\x17s\xb9\f\x8bK\xab/y\x90Y\xa0C\x9a\xadv\u007fB\xc3V\nOk2J0:2L\xa9Ra \xeeb\x19` \x95\xe0\xd4\xca\x06C\xc98\x17K5r\xb6\xe4r\xd3\xe7\xe9\xb5\x12\xb7\x8f\x0fr\xdd@\x86/\xafU\xa3j\xa4\x9b\xd0s\x12*R\x10\x18\x83-%\xc5j\x8d\x95\xf5M\xa6'\xc6PJ\xe1:6qסގn\xc2R\x11\v\xd0{\xc8/\x9eY\xe0{\xaf\xdfEµ\xf0\x82\x90\xe9\xc14\xff\xf8\x8d\xb7\xd2\xe8xt\xfc\x90\x96\x17\xd0\x0eBV*-6\x9b\x01\x83\xf94w\xbf\xec \xc3#Ch\xcbE\b\xc1\xc3O\x9df\xbdR#\xee:\xfd]'\xa5\xe2\xbf|\xf4\xb3\xfc\xd2\xdb_C\xa7Q睯\xba\x9eR\xadɳ\vE6j-\xf2\xae\xc5\xfe\x89<\x96R\xa4b\x0e\xaem\U0005a6e2P\x1aj\x8d\x10\xb0^k\xf1\x97\xe7\x97^p\xbcLm\x83\xf4\xd9l\x86\xd9\xd9\xf9\xabZ\r\xcf\xef\x03y\xbdb\xb0\xd7G\xe9\x0e\xa1Z\x96\xc5\xc4\xd80\xe7O\x9e\x89\"KTi*\xa6\nY\xe6\x8bUҮ\x83\x0e\x022\xb6\xe4\x8b\x1bu\x8e\rdI\xb8\x0e\xb7\x0efxru\x93\x94%\xfb\xf3~Y\t\xeba\xe4!ZkV7\x8b\x8c\x8d\f\xe1\xd861\xc7\xc1\xb1-\f\xe0(E\xbc\xabq\xf2\xb5\xa6\xe9\xfb\x9c])\xf1\xb5\v\xcb\xdc\u007ft\x1am\xa2\xd3wB\x1dF\xf0\xd4q\xc8\xe7,\xa4T\xec\xdd\x15!'%\x15BB\xadV\xc7MB\xa9\xd6\xe4C\x1f\xff\n1\xc7~^W\xd2\xe2s\x8f\x9f\xe4\xc1W\xdf\xc6\xd8\xd0(\xd5\xf5U\x921\x87\xbb\x0e\x8cE=\x9c ıT\x9f\xa1\xedC\xd8m\xce\U00015ccb,\x96\x1a;\x06\xe9̶P\xb3\x9dB\x89\xb9\x0e\x8b\x8b\xcb/\x9e?\xb6\xe5\x9c\x1e*\v\x8c!f\f\xe3\xe3\xe3\xb4\xeb\x91\xce 4\x06\x19v\xb9\xa9ݣCT;\x1dr\xc9\x18\xadP\x93Q\x92Vhxl\xa3B`4\xb7\fei\"v`\xf2\xa4\x02[l%\xad\xde\xee1\xc6`;\x0eq\u05c9\xa6\xd0\xfac\xb1Q1\x16\xb7ml%\xf9\xdd/?ǵ\x8eW\xd5Z\x13\x06\x9a \b#qB\xbb\x13A\xc3.\x8a\xb3м\xe7\xd7~\x1f?\xd4[-\xd3m\xb1>Ԛ\u007f\xf5\xe1O\xa0\xe3i\xa4\x15m\x8aP\x1bt\xf7\xe7\xc3mT\xcf\xf3\xafZ\xcb\xe7\v\xa7\xe6\xfb\x86\x8a[\x16\xd9X\x8c\x8c\xeb\xf67U\xcf RJ\xaa\xb5\x06\xfeK\xc8C\xa3\x90\xa5\xfb̀%D4R'$\xb7\x1c9\xc0\xb9\x8bW\xc8t\xeb\x1dki\xb3¾\x91\x01\x92\x898Ӄy6\xaa\x8d\xae\x055)%y\xa6\xd4\xe0X>M.\xeep\xe7P\x8e\xa7\xd6Jd\xba\xe4\x9c\x05\f[\xb0\xe8i\x1c\xa5\x889v\x1f\xfaY\x96E\xacK\x83\xe80d{\xc6s\xa4\xc4S\x8a\xf5z\x8b/\x9f^\xe0\xce\xfdc8\xb6E\xa85\xd5F\x9b\xd5J\x93\xa5b\x8d\x85\xcd\x1a\x8b\x9bU\xa4\x80\x99B\x82\x1b\x0e\xee\xe2\xbe{\xef\xe2\x91g\xceqi\xa5\x88\xd55\x82\xe7\a\xfcӷ=\xc0\xaf\u007f\xf8\x13\xfdi\xaa\xc5\xd5M\x9e\x9b[g\x9f+\t\x82\x80\xe5b\x8dG\xcf-\xd1\t5\x85d\x8c\xa9\xc1,\xf9\x94ˮ\xc1Lw\x1cZ\xd0\xf1Cέ\x948\xb5T\xc4Q2\xf2r\xa5\xfaI\xdcV\x8a\xf66%\x89\x94\x92Օ5|?xQc\x84\xdb\xe4I\x8eRt\x82\x00\v\x98\x18\x19B\xfb\xd10\xaa\xad\x12\x84a\x80\xb5\xd1j\xb3Z\xaa2\x95Hrd\xd7\x14\x9f~\xf29lǦ\xd3j\x03\x86\x8ao8]\xaesG\xac\xc0\x81\\\x8ag7\xab\xddY\xbe\ue234\x80\x9c%(\x05\x86\x8e\xe7\xe3\xf9A\xd4o\x17\x82\\2\x81\x94\"ڝ\xdb\xe5\b\xdd^\x80\xb6-\xfe\xc3\xe7\x8e\xf3\xb9S\xf3ܶg\x14ۄ\x9cX\xd8`\xbd\xdaf,\x13#\xe5ZL\xe6\x13]\x03+.ͯpC\xa9D:\xee\xf6\x1fP\x1bC\xb3\xe3Q\xc8ELj<\x1eCv\xe9\x8fX\xcc\x05\xd3\xc2\x0fB\xd6J5\xfcN\x94ϖ7Z̯\x159\xb7\xd1\xe0\xb6}cܺ{\x84SKE\x9e\x9e[\xe7\xecR\t[)\x12\x8e\xdd\xf7\xf6磫\x9eA\xb4ִ^\xe0\xe0\x1d\xc3\xd6\xc8K\x18F\xde\xd1c\x8d\x85\x10\xd8\bnؿ\x87G\x8f?\xc7T6ݧ\xf9\xd5\xeeL\xfa\xfd\xadP3\x92K\x93\xc9f\xd8,U\xf0\u0090\x86\xe7#\xba\xa8j\xb1\xd5\xe1\xf6\xa1,\xaeRlx>\x95\x8e\xb7#\x8e\xc6%\x94\xc3(lL\x8c\x8d\x10sݮ4S\xb3\xbcQ\xa2\xed\xfb\x91df\xdb{\x94\x94\xf8aH'\bY,7xjv\x8dǯ\xacsy\xb3\xc1p*ơ\xe1\x14\tG\xe1X\x11\xf5\x11\xc9o\"b\xf3\xa6\xa3\xfb\xb90\xb7ʥ\x95\r\xf2\xa9\x04?\xf5\x86W\xf0\xfaW\xdcB&\x11\xe3\xfc\xec2m\xcf\xe7\xe6\xc3{x\xcb\xddGi\x14\xd7i\xb6}\x9e\xbb\xbc\x84\xad$\x8e%I8\x16\x03I\x97\x13\x8b%\x1e\xb9\xb8\xca\xe7O\xcd\xf3ĕU\xe6\x8au\xfcP\x93\x8e\xb9\xd7dvM\xd7\b\xceK(*5\x90\x910d\t\xaa\xe1V\xb8RJ\x11h\x8d\x1209\x90g \x9b\xa2R.\x93\xefn\xb0\xb5F\a\xcb\x12\x82V\xab\xcdZ\xa5F&\x9fg|x\x90\xfa\xfc2\x96m\xa3=\x0f!\xa0\xe8k\x9e\xdc(s\xcf\xe8\x00\xfb3I\x16j\xcd\x1dYP\x00\x05KP\xed\x9eUh\x8c\xc1\xb6ml\xc7ajx\x80\xd2\xe5\x06\xa1\xd6;\x8a\xac\x1eI\xd7\xee\xd57\xbdys`\xd7H\x96\xbb\x8f\xee\xa6XkqqicG\x93\xe8ԹK\x8c\x0f\xe6x\xff\xdb_\x8d\xe3\xbe\x1e'\x9d\xa1^\xdcd\xf1\xc9Gy\xed\xde<?\xfa\x9b?\x83@\xd0(\x97ظ|\x9e0\fy\xec\xcc\x15\xc2n}\xa1T\xb41\xa4\x10\x8cf\xe2,TZ\xe8\xfe\x89B\x10\xb3\xed\x17\x1d\xea\xb7^Bo,\x81=\x8e`ʆ\xa7Z\x91\ao\xa1\xaa\xe8k\x85`\xcf\xf4$K\x1be\xc6\xd3I0\xd0\xf2\x03J\xed6\x96#\xc1\x0fC\x16\x8bevM\x8e39:©\xd9E\x86\xb2ifWְ\x85 .\xe1s+\x15n\x1fγ/\x13\xe7kk6\xa1\xe7mqN\xdd\x1dQl\xb7#\x11Yw\aY\x96\xc5\xc4`\x81\x93s\vQ\"}\xde1\x00\xaeR;\xa8\x04\xba\xe4\xe3\xd4@\x86|:I>\x95\xc0\xb1$'gW\xfap'\bC\x9ey\xf6\f)\xb9\x9f\xb0;\xe7ћEl֪\\9\xfeT\u007fȧ凜\xb8\xb4H\xb3\xeda)\x19qE\"2~h\f\xc3)\xb7\x1b~E\x1f\r\xda/\xd07߮\xbe\xbcf\x9e\x00\x06\x14\x1ct#\xaaf·ZW$\"\xa4\xecn\xbah\xd2+\x1bsH'\xe2TKE,\xcb\xc2h\xc3Z\xa3\x85\nC\xa4\x02RJ2\xbfZ\xa4\xd2h\x92N%\xc9g2h\x03\xb1x<ڹ\x06:\x81\xe6++%\\\xa5\xb8{0\xcdj\xa0w\b\x83\x95\x80\xb4\xd0\xcc..\xf5\xe3m\"\x91@Y\x16S\x83\x03xApMd\x13\xb3\xed\x9d\xca@!\xb0\xa5Dɨ\x87pb\xa1\xccz#\xa0\xe9\x1b\xd6k\x1d\\K\xb1Z\xa9\xf3Թ9l)\xb1\x1c{K%/\x04ʲpl\x97\xe5R\x9d\xaf\x9d\xbeB\xb1ڠ\xd6\x0e(\xb7\x02.m\xd4yn\xb9\x86\x90Q\x8eQ\xcf\xf3\xd8X\x17\x91\xbd\xb8PA\\\xd3\x18\a]\xc1\xad1AA\x82g`\xc1ߊ!\xb2'\x9c\x93\x92\x84\x94$\xe3\xf1\xe8٣\xe9FZaH\xa3Վf\xe9\xa3]\t1\x01\xcf\\\x9aǶ,&\x86\xf2\x14\x1b\r\x86\xb3i\xfcn!h\tx\xbaԠ\xee\a\xecΧ\x99r$Umv\x14OYK1;\xb7\x00\xc6`Y\x16\xa9T\x8aX,\xc6މQR\x89x\xbf\x92\xdf\x1e\x93m\xa5v|\x86\xad$٤\x8b%$\xe7\x97\xcb|\xf4\xf1\x8b,U;\x9cZ\xab\xd1\n\x04\xc7\x17#\x1d\xec\xecj\x91\xcf<y\x86\xf5r\xbd{\xe2O\xb4ȍ\xb6ϗN\x9c\xe7\xb13Wh\xb5=\x1e\x9f-\xb3\xd1Ҭ\xb7B\x94\xe5\xf0\x95Kk\\X\xafcKI&f\xef\xd8\x18/VK\xbcX\x18{YL\xb0Ϧ\x1f1\xeaaD-m\x97\x0f\xf5\xba\xae\x16p`f\x8aF\xa3A\xac\x9b\x1b+\xad\x0ea\x17\xa9YB\x18,!\x89Y\x8a\xb9\xe55\xaa\x8d:C\x03\x03\xb8\xd6\x15\x02\xadq]\aߋ\xe2|\xdd\xf39]irC!ͽc\x03\xfc\xd1\xec\x1aI\xb9\xe5'q\x05\xb4}6\xca\x15\xa6\xc6G1\x06FGGi4\x1a\xec\x1a\x19\xe6\xf4\xdc\xc2U\tQtCE\x8f\xd2\x16B`[\n\xcbR\x9c^,\xd2\x0e\"|vl\xa2\xc0\xc1\x91\f\x0f\x9f_\xe5\xe2f\x9b\xe1\x94C\xb9Ug\xa9x\x96\\\xc2%\x15w\xf1C\xcdZ\xa5Ah\"~j\xb3\xd1b\xd7H\x9e\x1f\xbf\xef0\xcf\xcd\x17\xf9\xe8c\xe7PB0_jrt,K\xcfA\xa4\x10/\x99\x1b\xae\xc9\xe0\xba.YB\x86T\xb8C\xd4zekh\xab\xefQ\x02p\xba:\x81=\xd3\x13<\xfa\xb5'\x98̦\b\x8da\xbd\xd6\xc0\x12\xe0\x01\xd2\x18\xb0\x84A\t\x88I\xc1\xd7O_`d\xa8\xc0`&M\xb1\xd6$\x9d\x88\xf7]\xcf\x0f\r'Ku:a\xc8H&\xc5\xee\x84KS\x9b\x1dݳAG1\xb7\xb4\x8cmEc\xcf\xf1D\x82\\>O\xa5^Ǳ\xad\x1d\x02\xec-\xafP\xdbv\x138J\xe2Z\x8aSK%\x94\x14<1\xbb\u0381\x89A\xf6N\f\x92I\xba\xdc}h\x82\xf1\xa1\x1c+\x8d\x00\xa3\x1cN\xaf\xd6yj\xae\xc8\xe9\x95*\xcaq9\xb1T\xe3\xe8\xae1\xdex\xdb\x01\xd6\xeb-\x12n4k~f\xa5B̲\xd8lxh\x13y\xa3\xe9*\n\xe57\xe8\x1d\xd1a\x9a\x8a\xa1l\x96|\"A\x86\x10{ۈ}M\xc3z`\xfa\xda\x00!\x04\xa6ׅ\xd4!/;|\x80\x8b\xb3\xf3\xa4]\v\x04\x14[m\x82\xadcź\xef3\x90\x94\x11\xfb\xb9\xb8\xbaN\xbd\xd5f|x\x88\xe8`\x05ٯ\x82\xa5\x80S\xe5\x06\x9b\xed\xc8c\x8e\x0ed\xd1ϛ>\xcbY\x92\xf9\x85\xa5-Q\x9c1\x8c\x8e\x8c2\\\xc8\x13\x84\xe15\x87\x1ee\xb7\x87҃\xc3\xe9\x98Å\x95\x12+\xe5\x063\x85H\xb5xv\xb9\xc8\xf9\x95\n\xcb\xe5\x16\a\xc7\v\xdcsp\x92#\x13\x83\xbc\xfa\xd8.fF\xf2<\xb9P\"\x97N\xf2\xb6{\xae#\x9ft\xb9\xef\xbaI\xf6\fgi\xfb!\u007f\xf4\xc8\x19\x8a\xf5v\x14z\xa5`\xf7P\x9a\xa6\xe7G\xb0ZE\xdd\xc8o\xf4rm\x9b\xb1B\x81d,F\xa7\xddbPn\xe9q\x05;s\xc7\xf6E\xee\rj\xef\x9d\x1e\xe7\u0095y2\xb1\x18\x02\x98+Vq\xe4\xb6|\xd3k\xfc\xdb\x02\\\x19\xcd\xfd]Y\\azr\x9c\xb8\xed\x10h\x8d\xb5M\x82\xa8\r|e\xa5\x84%%\x93\xa9\x18IǺ\xca(\x19B\xce]\x9e\xedw\xd0,Kq\xfd\xa1\x03\x1c\x99\x99$\x93\x8c\xd3\xeaB\xdd\x1d\x06\xe9\xeePǒ\fg\xe2|\xe6\xd99\xbe\xef\x86\x19\xd2q\x1bǒ|\xfc\xe9Y>\xf2\xf8\x05f7\xeb<qy\x8dN\x10\xf2\xd4\xec:\xe3\x85T\x97K\x8b\xfew\x04\xd7VTZ\x1eg\x16\x8b\xfc\xf9S\x97\x91\b\x1ez\xe2\x12\x9f8>\x8b\x92\x11\xb1\xf7\xa6\x9bvsy\xa3Aܶ\x18Jľai\xa8k[\x8c\x15\xf2]\x05\xa7F\xf9mR\xdblY\xd5\x11\xb2R\xd7p6a\f\x93#C\xac\xaem\x92qm\x04\x86\xd5z\v\x11\xee\xac\xf2\xe5P<\x92\x8e\x86@\xd6\x12\x84\xa1\xe6\xf2\xe22\x99t\x8a\xa9\xb1a\x820\xc4\xdd\xd6c\xb6\x05<Y\xac\xb3\xda\xea\x90rl\x0ee\x12\xd4\xf5N8\x9bW\x82S\x17.\xe2u{\xe9B\br\xb9\x1c{&ƹq\xdf\x1e\x82m\xaco?\xb9w=$a[\x14Rqjm\x9f\x89|\x02K@!\xe1\xe0kͥ\xd5*\xeb\xf56\xff\xe3\x91s|\xf2\xf8\x15.\xaeWyfv\x9dg\x17\x8a \x04\x1b\xf5\x0e_:\xb9\xc0r\xa5\xc5\xff|\xf2\x12_<\xbd\xc0\xf1\xc5M\x02mX\xad4q\x95\xe4\xe6\xe9\x02\x1b\x95V\xd4\xd5t\x1dr\t\xfb%e\xa0\xc6\x18\xe2\xb1\x18c\x85B_\xa8\xb1Q\xa91\xa0D_\x85\xa5\x81\xb2\x06\x8f\x9db\x8a\xfe\xf0\x8e1\x1cܻ\x9b3W\xe6\x18ˤ\xd0\xc0F\xbd\x81\xbduv\x11\x96\x00\x99s-\xc6\x12\xf1n\xcb\u0590\xb1%\x17\xe7\x97(V\xab\xdcx\xdd!\x841\xb8\xb6\x8d\xde\x16c]!\xf8\xc4\xfc\x06\xb6\x94\x1c\xcdG\xe7\x99\x18\xb1\xd3E\xfdJ\x95\x85啭\xf7\xb8.\xb9|t\xe8\xe5\xee\xb1\xe1\xab\xea\x8f\x1eMq\xfb\x9eQ\x94\x14\x8c\xe6\x12<~a\x85W\xec\x1fa\"\x1b'i[\x91XM\nځ\xe6cO^\xc2\v4\xbf\xf3œ\\ވ\xa4\x99\xcf-\x14\xf9\x9d/\x9eB\x03\x8f]\\c\xa3\xde\xee'kGI\xf6\x0f\xa5y\xcb\xcd3|\xf1\xf4\x12#\xb9\x14S\xc39\xee\xde?\x8a\x1f\xea\x17\xac;\xc0PȤ\x19\xcen\x1d%\xd2\xf6}B\xbfͰ%\xb6\xf1U\xb0\x16l\x9b\xce~\x1e\x9a̧\x12(\xdb\xc1&ҼU\xda>f\xdb\x1a82j\xe5ʪ\x170\x95\x8ewǕ!e\t\b\x03\x9e<y\x96\xc1\x81<c\xc3C\x11F\xdf\xd6lR\x02\xae4:\x91\x97\xc4\\n-$Y\xf7wB`\xd7\x04\x9c\xbep\xa9\xdf5\xd4Z3<<L<\x91`rp\x00\xd7q\xae:\xcc\xc5\x1882Y\xa0\x13\x84ܶ{\x84/_Xc\xdfh\x96|2\xc6\a~\xf0\x0e\x8eN\x14\x88Y\nGE\xd3KB@\xb5\xe5\xf5\xa9\x89\x96\x1f\xe0u\x9bWB\n\xb21\a[J\x86\xd3q\xde\xfb\xbd7p\xfdd\x9e\\\xc2a\xad\xd6d,\x97DJɑ\xa9\x81\x17\x95\xb3\x8f\x0f\x0e060\x80ئ!\xa84[\xe4\xa4 %\xb7\xa6\xc9V\xc3\xc8K\x02s\xed\xc3\x00\x86\a\nT\xaaUFRqB\fM\xafӟ\xfe\x92\"2\x88-\x0420\x06G\xaa\x88\xfe\xed\xe2\xe4\x8cmq\xfc\xcc\x05|?\xe0\xf0\xbeݴ}\x9fD\xccݱ\x80Rk\x9e\xed\xf6\fn\x18\xccFq|\xfb\xa1,R\xb0\xbc\xbc\xc2\xc2\xd2Ҏ9\xbb={\xf6\x90I%\x19\x1f\xcc\xd3\xe9j\xaf\xfa0ұ\xb9~z\x18\xcf\x0f90\x9e\xe7\xfe\xa3Ӝ[\xad2]HP\uf13c\xfd\xeeC\xbc\xf6\xd84\xff\xf6\xed\xf70\x9eK\xb0k0Ϳ}\xdb\xdd\f$\x9cHŒ\x8d\xf3[o\xbd\x83\xf1L\x9c\xb1l\x82_\xff\x91\x97s\xf7\x811\xdeq\xf7A\x84\x81\xe1\x94˥\x8d:\xf9T\x82\xbd#юO\xba6\xa3\xd9Ďg3&\xf2\xd8]c#\xec\x1a\x1f\x89ԅ}\x05\xa6O\xe0yL\xda \xccVa8\xefG\xcf\xec\xe9\xab-\x92V\x82L>\x87\xd7\xf1\xb0\x84$КZ\xb3\xddW\xb3\xd8B\xe0t\xaby\x19jM;\f\x19K\xc4\t\xbbK\x9aT`c\xf8\xea\xd3'\xd851\x86m\xdb$]\x17\xb3\xad\xe60\xc0\xb9j\x93V\x10\x10s\x1cn\x1fJS\xf2\xb7\x0e\x81\xb1\xa5\xc0\x15\x82'\x8e?G\xadVEu\xe57\x8e\xe3033î\xd1\x11l%iy^_\xeb4=\x90!\x9fp1@'\x84\x1f}\xf9Aί78\xbf\xde$\x19\xb3\x98\x1eL\xf3\x8a\xc3\x13\fe\xe2\xbc\xef\xb5/#\x1b\xb39<9\xc0]\xfbF1aȱ\x89\x1c\xa3i\x9b\xe9B\x82\x1f\xbd\xf3\x00#\xd9\x04\xf7\x1f\x9d\xe2\xce\xfd\xe3\x14R1>\u007fn\x95\xbf8\xbd\xca;\xee>\xd0o\x0fK\x01{\x873;\u008cR\x92}\x93cL\x0e\x17\xa8՛}/7@\xa7\xd3!\x86\xa6\xa0\"\x8f\x90\xc0b\x10\x95\f\x1d\x03\xdb\a\xedD\xd7;,!\x18\x1a\xc8\x13\xb6[H\x11\x9d\xac\x17u\x10{\xf5[OB+\x90 (w<\xf6d\x93`\"\x02̕\x82\x98\xa58q\xf6\x02\xc9D\x9c\xa1B\x9e@\x87$\xe3;\xbdd\xb3\xed\xb1\xd1\xf11ư/\x9b\"f[\xd1\xd8B\xd7\xd3\xe2JP\xad5x\xfc\xa9\xe3\xfd\x04\x1f\t\x03R\xec\xd9=\xc3M\xfb\xf7 \x85\xa4\xd3\xd5*\xcd\x17k\\X\xab\xe08\x0en*Mat\x9cw\xbe\xfe.vO\f\xf2\x953K\b\x04S\x83iBm\x18/\xa4H86s\x1b5\xdep\xcb>n\xdf=\xcc\xc1\xe14\xb5\xa6\xc7R\xa9\xc9m\xfb\xa2\xdc0=\x98\xe6\x8f\x1e=˟>q\x81\xbb\x0f\x8e\xf3\x03\xb7\xec&\x9ft\t\xbb\x87\xf5k\xe0\x99\xf9\xcd>{-\x84`zd\x88\xc1\\\x86f\xbbC\xad\xd1\xdaA\xb7\xb7;\x1d\x86,џt\n\x10,\xfbPP\xf4k\xb2\xed\x00'%\rv:E6\x91\xc0\xeftPR\xb0X\xae\xe1v\a\x18,)p\xc4\xd6!j\xeap!\xf3\xfe\xff\xbf\xb03y\xb2\xeb\xba\xeb\xf8\xe7\xdc\xf1\xbd{\xef\x9b\xfbu\xab'M\x96\xacȲd\x129\xb1M\x02N\bC\x15\x1b\n\x8a*6@e\xc1\x1f\xa2*\x16lXP\xc5\x1aX\x05\x16L\xe5P\x01\x92\x10B(Ȁ\x83\x1dY\xb1-[Vk\xeaV\xb7\xba\xdf\xfc\xee<\x1d\x16\xe7\xbe\xd7ݶ\x8bhۥ~}߹\xbf\xe9\xfb\xfb~\xbf\xc7\xd35\x1a\xb6I)\xe10J\x96\xb4\xcb +\xe8\xf7{l\xad\xad\xf2\xb3{\xf7i8u\xe6A\xb8lQ\xe3\xa2d\xdbU\x9aDKט\xa49\xe38\xc1X<\x00\x10\xa31\xf6\x03\x1cˤ\xdb\xedT\xb5BR\xaf\xd7\xf0\\\x8f\xba\x0e\xfb\xc3\xf1\x12\x15\xfd\xf1\xce3^}~\x93\xfe\x99u\x8c\x9aM\xddq\xb8y\xe5,\xbd\xb6\xc7\xd7\xff\xfb\x03~\xf6d\xa0\xe8J\xb6\xc9f\xc7卟\xdc'\xcd\n\xce\xf5[\xdcy2\xe0\xceޘ\xaf\u07b8\xc0Z\xbb\xce\x0f?\xdc\xe7o\u007f\xf4!\x9f\xbf\xb0\xc2\xebW7\xe9\xba\xf6\xb2\xc8\v \xceJ\xfe\xe4\x9b?ŏ\xb3\xe53\xf5ZM.m\x9d\xc1\x8fbF\xe3\xf9)\x9e\xf1p:\xa5ah4eA[W\xbfc\\@ U\x84\x1c埌\x10\xbb,x\xfd\x97\xbf\xc4\xe0\xf0\x10\x91&\xa4E\xc9`2\x03\xa9D\xb3\x9e\xa6aV\xb9+\x93\xa0o{έ\xb5\x9a\xc5$\xc9x\xa1\xd7\xe4\x9dᴢ\xf6\b\xfc\xbcD\xd3u~틯\xf0÷\xee`\x99\x06Iz\xac\v/\x11\xe8\xa2䅶\x9a\x05\xa2R\xb2\xebG\x18'\x8c\x8c'\x85\n\xd9\xf1|N\xdbuq]\x17\xc30\xd04\r\xd7up\x9d:&\x92\xa7#u(~\x9c\xf2փ#.o\xadq\xeeL\x97B\x82\x14\x1a+\x9d6_\xbd\xf9<\x176V\x19\x06)\xff{\xff\x80\xbd\xe1\x8c\xf5\x96CR\x94|\xe93\x9b\\Z\xefr\xae\xdf\xe1\xc2j\x83o\xbe\xfd\x10)\v~\xf3\x17\xce\xe1\xda&\xf9\x89NJ\xd7\x04;Gs\xfe\xfc\xbb\xef2\x0e\x92\xe5axN\x9dϜ\xdb\xc4\x0fc\xa6\xf3\x13<+\x01\xc3\xe9\fQ\x14X\xb2\xa4o\xa8Ŝ\xa8ҕ\xa5\xa9\x92?\xcc\xe5)D\xbb.\xd4\x05\x00\xbf\xff;\xbf\xc5w\xbe\xf7\x9flu\x9a쌦\x18iBRe\x9a\xa6\xa9,\xd55Q\x1dȖ\xeb\xdc\xeaX:\x85\x94\xb4l\x8bY\x92\xe2g\xca}!\x93*\n^\xbe~\x8d$My\xf4\xf4\x00C\xd7H+l\v\xa1\xa2\xe8s+MLM\xc3\x14\x82\xf7\xa7ǽ\xb5.`V\xe50\xb5MLq\xeb5:\x9dΒ\xe1\xe8\xba\x1e\x9e\xeb@\x9e\xf1\xe4p\xa0֤iΛ\x1f<f\x1eż\xfc\xfcY\x84P\xbe)y!qj6g\xd7z\xdc|\xe1\x02/]\xb9\x80\xd7\xed2\x0e\x12\x9e\x1e\x8e\xb8\xbe\xdd\xc3\xd0\x04C?\xa0fh\\Yo\x93\x97\xc7,|Q\r\x8e\xffzg\x97\u007f\xf8߇̢t\xb9\xa7Xi7\xd9^]!\x8aS\x85\xbc\x9e\x98\"fa\x84\xef\a\x88\xb2\xa4\xa6ö\xa9jC.\xe1\xb0T\xf52\x93\x92i\xa1\xa0\xfc\xc5\xe4n\x94\x05_\xf8\xfcM\xc82\x9e<yBө\xf3\xe8\xd9\x00\xad,\xc8%ؚ\xa0\xae\x1d\xa3 \xa9\x14\xe8g=\xe7V]S\x03Y\x98\x15\x9cm\xbaܛ\xf8*\x8c\xa4\xe0`\x1ep\xf5\xd2\x05n\\\xb9\xc4w\u007f\xf4&\r\xc7!\x8c\x8f\xf9E\xa9\x94\\pmzu\x1b\xc7и=\n\xd0\xe5\t7\x03\x04\xf3R\x99\xb6\f\xa7s\x1c\xdbĶ-\x1a\x8d\x86\xe27i\x1a\x9e\xe7\xd1m6\xc9\xd3X\x1d\x8aa\x90\xe5\x05\xef><\xe0'w\x1f\xf1\xf9\xe7\xb7q\xea\xf6\xf1\xb7\xaaiH\xa1\xa1\xe9\x06++\x1dn\\\u007f\x81\xb3\x17ϱ\xf7lL\x12\xce\xd1\x10\xb85\xf3T\xa1\x16B\xa5\xa8?\xfb\xf6\x1d\xde|pt\x8a qqc\x9d^\xb3A\x14\xa7\x9fPBEq\xc2x:\x03)\xa9\xeb\x1a]CЯz\x9b@¨PL\xcey\tQ)\xab¬\xb0\xac\xb2(\xf8\xda\xef\xfd.o|\xeb;\x9cm7\x19G)\xa9?'\xaa\xa2\xd5ӵc\u0084P\xb0\xbd&\x91\x84yA.%aQ`j\x02\xc74X\xc0\xf2\xba,\xb9{\xff!\xddN\x87k\x97/bY\xe6R\x8f\xad\x98\x8c\x82\x8f\xa6>F\xb5_8\xeb\xd8L+X^\xa2\x8a]M\x17X\xa6\x81\xad\xeb\xdc\xfe\xe0>;\x0f\x1f1\xae|\tEe\xb0\xdc_[\xe5\xf5\xd7^\xe1\xf5\xcf^G\u05f5\xa59\xfe;;O\xf9\xa3?\xfdk\xde|o\xe7\x14cp釒\x17\xc8<\xc5q\x1cfi\xf1\t\xe7&!\x04u\xdb\xe4\xf1(⏿\xf16\x0f\x06\xf3S\xb8\xd4\xd5\xf3\xdb4\x9c\xbaJ\xc5'\xc8\bRJfA\xc8\xe1h\x8c\xa8\xb6\x8df\xc5\x13^\xfc\x05a\xa5KҀx\xd1\xcdpL\xb1={v\x9b<\x89\x99\xf9\x01\x8em2\tC\x8a\xb2X\xeeۏ\x1b\x89\x13\x1d\xdeYϹe\x00\x8e\xa1/ɿ\x85\x94̒L\x99zI\x85H~\xe5\u0557\xd14\x8d\x9dǻdyA\x9e\x1e+t)K>\xd7o\xab\xfe\x1d\xc9[#\x9f\x96~\xac3L\x10\xac\xf6W\xf0\xc3\b!%\x87\xe3)\x82\x92\x86\xe7\xe0\xba\uea45֙\xd5>\x1d\xd7!\fC\x924\xa3,K\x92,\xe7{o\u007f\x84I\xc1\xb5\xf3g\xa8\xd5\x1d\xa8nC\x10\x9a\x86n\x98\x98\xa6IS˙\f\a\xcbK[L]\xa3\xd7p\xf9\xfe\a\a\xfc\xc5\xf7\xdf#H2\x04\x12\xd308\xb3\xd2\xe1\xd2\xd6\x06u\xcb$NR\xf2R.\xdb\xdb</\x18N\xa7\xcc\xc3p\xb9\x84\xb3t\x1dC\x83\xe7\xcc\xe3\x82\xfd8WiG\"\x19\x17\n~Z\x1cP)%_\xfc\xc2M\xc6\xd3\x19F\xaaL\x14\x06\x93)a\x9c,\xf6\x1e\xd4+\xbfbm\x99m\xaa\x03\xb1\x84\xfa\xc0\x9a\xa1cjj7\xa0\xba-U\xb8wG\x13\xbe\xfc\xca˴\x9a\r\u07bf\xff\x80\x9ai1\x9a\xcd\xd4t/ԇ_\xef6\xb0t\x9d\xa6e\xf2\xe3\xc3)u\xfdحM\x03\x84\xe3\xd0\xeb\xb6\x19\x8e\xa6 K\x0eG\xcau\xc1\xb6L\x1a\x8dƲ\xad4\f\x83~\u007f\x85\xa6S\xc7\x14\xcaD,\x88\x94\xb2靇\xcf\xf8\xe9\xbd\xc74t\xb8|n\x1d\xdd01L\x13\xcbq0M\x93<\x8e\U00047285nj\x1a\xf7\x0e\xe7\xfc\xd5\u007f\xdd\xe5{\xef\xed\x92\xe6j\xa7\xbf\xbd\xd6\xe7\xe2\xe6:ݦ\xa7\xf6\xeb\xba\xc0\x0f\x13e8#4\nYr4\x1a\x91f9\xba<\xe9d\a\xab\x86`M?&\xcf\xdd\xcf\xc0\xd3\x04Q!\t\xe4\xb1\xf9\xa9ViF~勯q\xe7\xee\al4\\\xc24\xe3h4^R\x86\fMPӴ\xa5w\xf4\xe2@\x8c\xc5/\x8f\xf3\x02lS1&,CI\x9e\xa5Ĭ\x1e\xee\u007f\xde\xf9\x19\xbf\xfeK\xbf\xa8f\x92,\xe5\xfe\xee\x1e 1\x80D\xc2$IiXJ\xbf\xf1\\\xa3\xc6A\x10Ӭ\xa2\xc4\x02\xc20bm\xa5\xc7\xf9\xedu\x1e<ރ\xb2\xe4\xed\xf7\xee\u007f\xab\xfb0\x00\x00\b\xd7IDAT1\r\x02^\x8a\x13\xb6\xb7\xb7\xd1+y\xb5\xae\xeb\\\xbcx\x81V\xab\xc9ӧ\xfb\x9c\xe9u\xb8\xfbh\x97 \x8cx\xe7\xd1!o\xed|\x8b\xcd\u007f\xfa\x01\xbf\xfa\xd9\xcb4\xf4\x92\xa6\xe7\x10i6v\x9e0\x9cL\x19\xccC~\xf2\xe0\x88\xbd\xb1\xf2[ф\xa0\xd5p\xb8\xbc\xb5A\xcd:\xbd2\x1eN\x03\xe2\xcau\"\x8ac\x8e\x06C\xa4&Ԣ\xe8$\xd1Mºq|\x18\x91\xac\xac\xe8\xa5$\x93K\xbf\xe8e\x84x\xae\x87S\xaf\xe1\x1a:\x96mQ\x86\x11a\x1c\x9f\x92\xe9}\xba\xc5_\xf5\xb9a\xa6\xee\u007f͊\x92\xa6e`\b\xf5G\x98B\xed\x9a\u007f|\xfb]~\xfb7\xbeJ\xb7\xd5\"\x8aBܚM\x16'\x98\x02B)\x19D\t\xe7\x9b\x1eY\xa9\xda\xe0\x8f\xe6\x11M\xfd8G\x16IL\x92$\xact\x95\xe2\xe9\xd1\xee>\x06\x92\a\x0f\xf6\x98\xcd|^\x9cL\xb9|\xe9\"\x9dNG\x99\x97i\x1a\xab\xab\xab\xb4Zm\x9e=;\xa0\xd3nrp4\xe4\xf1\xc1\x11A\x14\xb3?\x9a\xf3\x97\xdf~\xb3R\x02/\xf4\xc0b)\xc41t\x81m\x9a\xb8N\x8d\x8d^\x97^\xab\xb1\xacKB(}\xe3x\x16\x92W\xb7\x82N\xa6\xc1r\xc6\xd2>\x06\u007fH)\xb1tA\xb3ҳj\xc0\xa0PPIY\xa9\x90\xb5\xaa\x9ej\x15#}kk\x834\xcbh\xd6lt\xc3 \x8c\x13\x8a\xb2$\x13j)f\xa2\xf1)\xae\x91*B\x16캼\x90h\xbaz\xb0y)\x90\xa5\xc4\x16\n\xd7y\xb2\u007f\xc0x2ck}\x95\xdb\xef\u007f\xc8\xf9\x8d3\xbc\xff\xd1\x03,]i\xd5\xf7C\x95\xe2\xb2Rr\xa1\xe9,'\xf6e\xcaJ3\x928\xc10M\xeaN\x1d\xa3f\x93\xfa!\xba&\x18\x8d\xa6\xfc\xf0\xad\xdb<=<䵛\x9fc\xa5\xbf\xb2\\X\xd5j6\xe7Νe}}\x9d\vA\xc0\xf9\xfd}\xee\xed<d:\x0f\b\x92\x94y\x18-)>\x8b\xa2\xdfp\xea\xb4\x1b\x1e-\xcf\xc1\xab\xd7\xd0*C\xb5\x85\xac\xce\x0fb\x82(\xa1\x940\x9fϙ\x87\n\x1e\xd1*\x98\xbc\xa84\xdd\x1a\xca>\xb6\x14\x82\xbe!N\xba\x953)P:\x99\xca\xd5\xc2\x042D\xd5\x0eK^\xb9\xf1\"\x83\xd1Ha|E\xc9\xe1d\x82V\x8d\x12\x8e\x80\x8cO\xb7}4N\xf6\xdb*\xbc\x15\x92kQ2*\xc1֏\rV\x06\xa31\x9df\x83\xa9\xef\xd3nxK\x06\xa3.`\x98\xe4\xa4'\x18z+5S=\xa48\xb6\xa6L\xfd9n\xc3c0\x9er\xed\xb9s\f'3v\x9e\xec\xa3I(Ҝ\x9d\x87\xbb\xec=\x1b\U000855eeq\xfd\xdaUl\xdb^2\x9dMӤ\xd7뱺\xb6\xcak\xaf\xbd\xca\xde\xdeS~\xf0\x83\x1f\x01\x92\xfdє\xfd\xa3!\x1b\xab+l\xf5{J\xb2\xa6\x9dv\xb1\x16\xc0t\x1e\x12\xc6\xe9\x92h=\x1a\x8d\x94QA%\xb1\x00\x90\x9a\xa0\xdfi\x93D1\xd3(Ƭ\xde\xfc\xbeq\x8c]\t\xc0/\xa1\xa5C^\x1e\xab\xc0u)\xb1\x85\xa4\x14\x1a\x9e[\xe7h0\xc0\xb6,\xe28\"N\x95\xe8\xd5F\xed\xce\x1b\x94H\xf4OD\x88\xbe\xed9\xb7l\r\xbau\v\xc7бu\x1dG\xd7y\u007f\x1ecV\x05j\x96K\\\xd7as\xb5\xc3\xd4\x0f\x18M\xa6\x84a\xc0\xd1h\x82Y\xdd\x19h\b\xc1\x99\xbaE\xc34I\x8b\x92ۃ\xc9r8\\ \xa2Y\x96a7[\x84IB\xb7\xd9\xc0sjtZ\rF~\xa0\xae\"\x92\x90g\x19\xf7\x1e>\xe1\xf1\x93=<ס\xd7\xedV\xda\vQݐ\xa3\x1c\u007fnܸ\xc1\x1f~\xed\x0f\xb8r\xe5\ni\xe8ӨY\xf4Z\x8d\xe5Bl\xb1\t\x95@\x96\x97\x8cg\x01A\xac\xdc}\x82 \xe0p0$\xc9\v\xf4\x13\xe62\x18:\x9b\xab+l\xf4{\xac\xaet\x90\xa5d\x16Dh\x9a\xe0\xb2%0Ģ\xd5W\x13zC\x17\xa4T&<@)\xd4\xc2*\x93\x92\xe7.\x9e#\xcb\n(\v\xc6\xd3\tiY0\xf5\x03\xcc*\n\f!\x96\xac\x13NvY[\x9es\xabmj\xf4+\x94\xf5\x8c[\xe7\xd1< \xcd2l!\x89J\xf0\xa5\xe0\x95\x17\xaf\x10\xc5\x11w>ܡ\xcc2\x82(f:\xf3)\x85\xa0@P\xd3`\x1a\xc5̒\x8c\xf7\x87S&\x95\xc4\xd8<\x01\xa3DEI\x90f\xf4Wz\x84Q\xc2\xd1xB\xcbsiy.\xa3\xe9\x1cYJ\xc5.\x14\x82\xb9\x1f\xf0\xee\a\x1f\xb1\xbb\u007f\x80@\xe08uVz]4\xa1\x90\x82\xc3\xc3#\x1e\xec<`sk\x83/\u007f\xe5u\xfe\xfd\xbb\xff\xa1X\xf3\xa5\xba~;I3\x828e2\x8f\x18O\xe7\x84QH\x18FLf3\x82(Z\x16ߥ\xe4\xc0\xd0\xd9\\\xed\xd1n\xb8\f\xa6\n\xc3\xea\xf7\xda<=\x1aQ\xd7`\xc3T\a\xa2U\xd11.\xc1\xd5\x04Y\xa9\x0eDVP\x92Q\ry\xd38\x81\"\xe3\xc1\xde>N\xcd\xc24M\xa6~X\x99?\xabo\xa4\xa6\x1d\xbf<\xcb.K\bX\xa9\xd7@\b:\xb6j\xb2\x1f\xcdUqKK\x98f%g\xd7ר\xd7,\x92,#\xcbs<\xcfag\xef\xd9r\xb0)\xabSϊ\x92\xfbS_1\xfc\xaa\x19f\xf1\n\xa8\x14,\b\xa2\x18\xdb2y\xf0\xe4)\xb3\x99\xcfh:\xe7\xdas\xe7\xf0<\x87\xc9̧\xa6\xeblm\xae\xf1\xecp\xc8t\x16\xf0\xe4\xf1.O\xf6\xf6i5<\xfa\xdd\x0e\xd7_\xbc\xca\xf5kW\xa9\xd96y\x91\x13\xc7\t\xa6i\"\x04\xf8aJ\x94\xa4\x94\xb2$\nc\xfc0 ϔ-l\xbe0ǔ\xf2\x93V\xacB\xb0\xdam\xd3nx<\xdc\u007f\x86&a\x1eF|\xc6ݢ\xd9p!\xf0OmL\xfdR\xe1W\x8bW[|l;($Lgs.\xac\xf7\x99\x851\xab-\x8f\xbam\xe15<\xfc,E.,J\x94\xe8\xebT\xd7e\xb4-\x13\xa7\x02\xb8.\xb5\x1b|\xfd\xee#t!\xc8K\x95;\xa5i\xb1\xb9ګ|\x14\x13\fMc2\xf3\x89\xd3\fs1iJ\xc9a\xae\xf0\x9d\xc5\xfc\xf1\xb1\x1b#\xd4ZWJL\xbbFV\xe4\x04a\x8c&\x04q\x183\x9c\xf9\xacu\xdaL|\xd5\xf5\xe8B\xe3\xfa\xd5K\xcc\xe6\xca\xcd\xd3\x0fB\xe6s\x9f\x99\xefs\xef\xe1#\xfe\xf1\x8d\u007ff\xa5\xd7\xe3\xb5Wob\xd7\x1d\xbe\xf5\xed\u007fc:\xf3\x19\xcc\x02\xfc $\x8a\"\xa8d\xdaeE\xc3\xd1?n\xd0|\x92_U\xb7i7\\\x828\xa1\xccKl\xcbd\x9e$\x14\xa5\xa4^\xb3I\xc3`\xf9\xe2\x89\n2\xa9\x89\x13\r\xcb\xc7\x1eV\bA\x91\xa9\b\xdd>\xb3\xc2\xe3\xa31\x17\xd6V\xd8^\xed\xf2\xd3\xc9\f\xbbT6癔h\x15\xf6\xb5\xf8߆m(\x93\xc6s-\x8f7\xee\xef\x91W\x9d\x8a\x04\xc6y\xc9\xc6z\x9f\xa6W'\xcb\v\xf6\x0e\x87l\xf4\xda\xdc}\xb8\xbb\xec\x97剝\xf2nZ\xd254\xfa\xe6'\x8d\xbe\x16o\x8ee[\xe8Bö-\xe5l]\xfd\xebuZ\x98\xbb\ade\xc1d6\xa7\xd5\xf4\xf0\xdc:\u05ef^\"\x88\"\x06\x83\tS? \xc92R\xad\xe4p4\xe2\xef\xbf\xf1/\xfc\xcd\u07fd\x81e٘\xa6\x81m\xe8\x94EI\xa9i\x88\x8as%\xa4\xdan\xfe\u007fr\xb3\x86\xeb`\x1a\x06I\x96W脚\xe6uM(wk\xa1\xf6\xe4\v\xee@X*}\xfbB\xbb\xaf\t(\xa58-\x95.\n&~\xc0f\xbf\xcb\xd3\xc1\x98(Mql\x8bK\xdb\xeb\xdc\u007f\xb8\x8b)K\xd2Rb\b\xa9\xee=\xa9\xd0Z\xfdj\xa7q\xebj\xdb\xe3\xf6`\xc2a\x94,SͰ\x90X\xae\xcbK\x97\xcfc\xe8\x06\x1f\xed\x1e\xd0rj\xcc\xc6\x13F~\xb0\xec6\x96_x\xf5\x16ƥ\xa4k\xaa\xec\x9c\x01\xf6\xa9ITb5\x1at\x9a\x1e\xf5\x9a\x8d\xd4\x04\x9e\xeb\xb2ޯ\n\xb7\x94\f}\x1fM\xaa\x03\xd2\x16j,Ӡ\xd3j\xb2\xd2Si\xa5\xe5\xba8\xf5\x1au\xdbV\xee\r˫\xf2*\x9d\xbc\x94\b\xa9\x0eC\xfc\x1c6\x89f\x99l\xae\xae\xa8݅e\x12\xa7\x19\x9a\xae\xb1\xde\xefR\xb3,\xb2,'\xf7g\xb4t\r\xbdJ/\x87\xb9\xaa\x1f\vNb!ճI\x8e\x17We\x9e\xa3\xeb\x1a\x8df\x93N\xd3c\xefpHǫ\xe3\xd6l4Cg2\x0f\x97ߡ.T\x94\xc4\x12\x8cT\n\xeeL\x02>\x9cE\xea\xe2\xc5R\xfd\xc0\xcfK^\\\xed1\x98ΑRr4\x9e\xd1o:\x1c\x8c\xa6\xca=T\x9c\xd6C\x00\x14\x15Qa\x9cK\x8cj?\"\xe4q\xd1\xca\x11\xe4Q\xccp\xa2\xec\x89ZM\x0f\x80\xa9\x1f\x80\x00\xddT\bA\x94e\f&3,\xcb\xfcTKp\f\rǭSwj\xb4;M\x8a\xa2$\xcfU\x1a\x9c\xce\xfd\xa5\x91\xcd\xcf'\xe6\ntC'\xceR\xf2\xca\bǶ\f@\x90f9㙏\x1fF\xa4R\xf9\xb0\x18\xd5\x10\x9aHɼ8\x8e\xfcD\xaa\xf4\x9e\x9dp%E³\xc3\x11\xbai\xe1x\x0eY)\xd9\x1bN1+\f\xcer\x1d\"?$+%\x91T\x10~RJ\xfe\x0f\xb4\xc3\xeb\x93a\xe7\x18k\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_10_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14 \x10\xb5\xfa\xcdq\x00\x00 \x00IDATx\xda\xec\xbdy\xb4g\xd7U\xdf\xf99\xe7\xce\xf7\xfe\xe67\x0f\xf5j\x9e\xab4Z\x16\x96-\vO\xb2\r6\x84\x00\x01\x12 \x81\x00iB73\x81\xac\xd0\r\xac$t\x03\xe9\xb0\x1azљ\b\x1d\x924\xe0\x00v\x8c\x1dY\x9e\xf0 K\x96m\xcd*\x95j\xae7O\xbfy\xbc\xe39\xfd\xc7\xfd\xbd'\x19\x92?\xb2V\xcav\t\xdfU\xb5\xdeP\xaf\xde\xef\xfe\xee\xf7\xec\xb3\xf7\xfe\xee\xef\xde\a\xbe~}\xfd\xfa\xfa\xf5\xf5\xeb\xeb\xd7\xff\xa8k\xa1Z\xfe+\xf5~\xc5\xd7\xda\xc3_ou\xf6\xbf\x9e\xaf\x94*JeSZ\xabI!\xe4\x1d\x02\x8e \xc4!)\xe5\x04\bOkU\xd5Zw\x85\x10C\x10\xabB\xf0\x12\x88/\x007\x81\xd5\xf5V'\xfd\xaf\xfdޯ\x03\xf2\xdf\x01\xc4\\\xb9(A\xbdS)\xf5.\xe0\xac\x1fT\xce{~iڶ=l'\xc02\x1d\f\xd3\x02\x04Y\x96\x10\x85=\xa2hD8\xec2\x1c\xb6\xc9\xd2\x04!\xe5M)\x8dO\x02\x1f\x02>\xba\xde\xea\fn\x17`\xbe\xea\x80\x1c\x98\xa8\xb2\xdah\xedYďi\x95\xfd\xb8\xeb\x15\x17\xa6f\x8e\x06\xb5\x89\x03\xf8A\x19)%B\x1aHi\xe4\x9f\v\xf1\xca\xddk\x8dR\n!4i\x12\xd1iﰱq\x99\xc6\xce\n\xa0\x87R\x1am\xe0\xf7\x81_Zou\xe2\xaf[\xc8\u007f\xc3\"\\7\xe0\xda\xe6\x06\a\xa7\xa6\xcc4\x8d\xef\x14B\xfc\x81\xe3\x15\x8f\x1f>r/S3G\x10B \xa5\xc8?\x1a\x024H\xc3\xd8\xff\x9eV\x8a,\xcb\xf2\xb7 \x05\x02\x10\b\xf2?\x920\x1cp\xe3\xea\xd3l\xac_\"K\x13\x94\xcaZ\xa0\xff\xbe\xd6|p\xa3\xdd\x1d~\xadZ\xccW\xd5B\x0eNM\x1eI\x92\xe8'\xfd\xa0\xfa\xbf\xcc-\x9cbq\xe9<\x86a`\x18\x02!\x05Ґ\x98\x86\xcc?\x97\x02\xb4\x00t\xfe\xe8\x05H)\xf7߁\x1a\x03\xa4\x95B)PJ\x03\x06Q8\xa2\xd5Xgk\xeb\x1a\x9d\xd6&\xe1\xa8\xfbD\x9a$\xbf615\xf7g/ݸ\xae\xbe\xd6@\x11_\r\xebXou\x98\xaf\x14\xbf[+\xf5+\x8b\x87\xee<\xb1x\xe0\x1c\x9e_Ĵ\f\n\x81\x8fa\x1ah\xa5\x11\x86D\xab\f\xa54\x86i`\x1a\xe6\x18\x04\x81i\x1aض\x85\x1c\x03h\x18\x06\x00Y\x9a\x10\xc5\x11\xe1(d\xd0\x0f\tG1\x99R\xf4:\r\x94\xca\xc8T\xc4\xcdk_Lv\xb6\xd6~'\x8a\xa3\x9f\xea&\xe8\xaf%P\xc4W\t\x8c\u007f\xe88\xc1\xaf\x9e:\xf7VJ\xe5),\xcb\xc2\x0f\\<\xcfCJ\x81m[ \xc0\x90\x06H\t\b\fi`\xd9\x16\xb6m\xe3:6\x86i\x82\xd6\xe8\xfd\xbf\n\xad5\x00Zk\xd24&\x8a#\x06\xfd>\xadf\x8f\xfa\xce6\xdb[\u05c8\xa3\x11K\x87\xcer\xf5\xf2\x93ԷW\x1f[k\xb5\x1f\x048\xbe\xb0ȕ\xf5\xb5\xbf\x1a\x80\xbcz\x05\xceWJ?\xe7\a\x95_?{\xc7;(\x14\xab\x98\x96\x81ﻸ\xae\x83\xe3\xd8x\xbe\xb7\u007fk\xa6i\xe18\x0e\x8e\xe3`;N\x0e\x10\xb9\x85\xe4\x8e]\xa3\xb2\x8c,KI\xb3\f\xad\xf3-KkE\xa6\x14Y\x96\x82\xd6\xc4Ɉn\xb7O\xb3\xdeg\xd0\x1f\xb0|\xfd\x19J\x95i\xd6V.\xd0im\xbd\xe0y\xfe;.\xad\xael\u007f-X\x88\xf1\x95x\x91\x92\xe7\xd2\v#\xe6\xca\xc1\x0fy~\xf9\xb7\xcf\xde\xf10A\xa1\x82\xedX\x04\x81G\x10\xf8\xf8\xbe\x87\x1f\xf8X\x96\x85\xebx\x14\nE\n\x85\"\x9e\xef\xe1:\x1e\xb6\xeda\x99\x0e\xa6ia\x1a&\x86i\"\x851\xf69\x06\x96ia\x996\x86abH\x03!$\xc68*\xb3\xad\xbdװA(\\\xb7\xc6p\xd0Ʋ=\xa4\x943\xfd^\xf3\xddsӳ\x8f\xd6ۭ\xd6_\t@za\xc4|\xa5tB\xa3\xff՝\xf7\xbe\xa7\"\xa5Ĵ\x04\x85R\t\xcfs\xf1}\x17\xcf\xf7\xb1m\v\xdbv\xb1\x1d\a˲0\f\v\xd3t0-\x1bӴ\xc6Q\x96|U\xe8\xab\xf3\x8fB\"ȃ\x00\xb9\a\x964@\xe7~H\x1a\x12!\flۥP\f\xd0:%IMl\xbb@\x1c\xf6QZM\x87\x83\xee\xb9\xd9\xf2\xc4\u007f\x98\xacM\xe8z\xa7\xf5\xda\x06d\xa1Zv\x94J\u007f\xe3\xe8\x897<\xd4\xef\xeeP\xaeLR\x9b\x9c\xc1u\x1c\xfc\xc0\xa3T*\xe38\x0e\x96ecY&\xb6\xed`;^\xfep\r\x03\xd34\x11b\xbc\xea\x8d<\x1f1\f\x89\x14F\x0e\xd2ء\v\xf4~\xf8+\xa5@H\x99\xfb\x16\x95\x03\x97\x87\xd2\x06\xae\xeb\xe0\xfb&Y\xa6q\xbd\t\x06\x83&\xc3A밴\xac\xf0\xd2\xca\xcd\xc7\xce\x1e=\xc5n\xab\xfeU\x01\xc4\xfcJ\xbc\x88RٙJu\xfe\xfb\xb5\xd6\x14\x8a5\xaa\x133H)p]\x87R\xb1\xb4\xef\xcc\r#\xb7\n\xc34ǡ\xad\xc0\xf1\x02,\xd3~\x95\xd3\xde{\xb8\x06Bdh\x9d\u007fm\x1a&J\xa9<\x92J\x13\x94\x12\x90$\xb4[-\x928\xc9\u007fN\n,\xc3İ$A\xa1\xc0\xec\x9c\xe6j/dn\xfe\x14\xcd\xfa\x1aQ4\xfc\xa5?\xfa\xc0\xe8\u05ff\xeb\xafy\xea5i!\v\xd52\xbd0\xa2R\b~\xbbR\x9d?\xa3T\xca\xd2\xe1\xb3ض\x8b\xe3:Tk5\x82\xc0\x1f\xfb\x01\x1b\xcbr\x90R\xe6\xf9\x84V8\x9e\x8f\x1f\x94p]\x1f\xa52\x94\xd6H!\xb1,\a\xcbr\x10\x02\xb2,E\x88\xdc*\x84\x108n\x80\xeb\x06(\x9d?\xd3$I\x88\u0090^\xafG\xbf\xdfc8\x1a\x12\x85\x11\x02\x89\xed\x9a\xf4\xfb#\xc0$\x8e\x86\xb4[[\xe6c\x9f\xf87\xd7\x1b\xdd\xces\xafI@za\xc4B\xb5\\6\xa4\xf1\xfb\xe5\xca<\x13S\vLN\xcdc\xdb\x16\xd5Z\x85J\xb9\x8ci\x9a\x98\xa6\x8di\x9a\xa4iJ\x18\x8e\x00A\xb56C\x10\x941\r\x13!$i\x1ac\x1a&\x96ec\x1a&\x8c\xfdH\xfe\xef\x02\xad5\x96\xedR*O\xe0z\x01Q8DJ\x83B\xa1\x84\xe7\xf9H!\x88\u0090\xc1`@\x9cĘ\x96M\xa1P@\bE\xab\xd9\xc7\xf3\xcblm\\B\bQh\xf5{\xff\xfe\xdes\xf7\xb2\xb9\xb3\xf9\x15\aD\xdez\xda\\\xff\xb0\xeb\x95H\x92\x90jm\x1a˶p\x1c\x9bb!@\x1a\xb9O\xd0Z3\x18\xf4\x19\x0e\xfbX\x96\xc3\xec\xdcA\x82\xa0\x84\x14\x82,M\xd0Ja[.\xae_ȉ\xc51\x18\xb6\xe3Q(Uq\xdc\x02\xb6냀$\x0e\x19\x0e\xbb\x80FJ\x03!\r\x8a\xa5\n\a\x96\x0es\xf6\xfc]\x1c=v\x02\xdbv\xa8\xef찹\xb6\x85\xef\aض\x89_\xa8\xe0\ae\xe2hx\xe6G\xff\xfe?\xb5\x9fz\xf1\xa9ז\x85\xf4\xc2\b\x80\x82c\xfd\xce\xf4\xec\xb1\xd9ry\x8a\xb9\x85\xa3X\xa6\xa4T.Q(\x14\xb0L\x9b\xd1(\xa2\xd3\xe9\xa05\xbaZ\x9dT\x93S\xf3\xca0\f\xa9t\x06\x80e\xbb\xb9%X6\x8e\xe3\x82\x06\xad4\xd20\xb1L\x13Ӳ\xc9\xd2d\xcfW\xa1\x95\"M\x92|+\x83|;\x13ydf\x18\x16\xa5r\x89\x89\x89I\xd2,\xa5\xd3\xee0\xe8\x0f0L\x838J\x10B\xb0\xbbs#\xdd\xde\\~t\xb7U\xdf\xfcj\x00\"o\xa5u\xccWJ\xe7\f\xd3:\f\x9a\xb9\x85Sؖ\x81iY\xb8\xae\x83R\x9aF\xa3I\xa3Q'\b\nz\xf1\xc0!U\xadMi\xad\xb5̲\f48\x8e\x87a\x8e)\x91,\xc5\xf5\x03\n\xa52թ\x19f\x17\x16)OL\x8d\x1d\xbf\x8f@cY6~\xa1L\xa1T%M\x12\x92$&K\xb3\x9c\xff\x12y\xd4%\x85\x81\xeb\x05,,\x1e\xe0\xe0\x91%<\xcfG+\x85i\n&\xa7\x0ea\x18\xa67\x1a\xb4\x8e\u007f\xb5|\xc8-\x89\xb2^\xe1\x85\xf4\xb7\x19\x86U\x98\x9e9\x86i\x99\x18\x96\x89\xe38h\xadi\xb7\xdb(\xa5\x98\x9b_dn\xfe\xa0\xf0\xdc\xc0ȝp\x9c;t\xc7\xc7q\xfdq\xd8\x1a\x92e\x19R\x1ax\x85\x12B\xe6\xebH\x1a\x1a\xb4f8\xe8a\xbb>\xd1h\xc0p\xd0%KS\x94\xd2x~\x01\xc7u\x19\xf4\xda\xfb\x11\x1ac\xa6\xd86]\x8a\xc52\xb6e\xd3h4\xd9\xd9\xdaEkMP\x98\xf0\x06\x83֙\xaf\x16 \xb7҇\x18\xc0yӰd\xa9<\x8d1\xf6\x17\xa6e\x10\x86\x11Z\v\xe6\x17\x0e111\xfd*NJ\x01\ni\x18؎;\xb6\x8c\f\xa5\x14\xa6i1\x1a\x0e\b\xc3\x11Y\x92\xd0\xdcݦ\xd3l`X\xd68?1\xb1\xdd|\xb5\xa7i\x8c4$\x85R\x99\xa0XA\x1a&Q8\"\xcbR\xb2$\xc9i{\xa1\xb1-\x17Ӵ\xa8MT)\x14|\xd24\xa5P\x9aD\xa9\xec\xfc\xddg\xef\xf3^kyȬV\xeax\xb96\x8f\x90\x02\xc7ɓ>\xad\xf3z\xc5\xf4\xcc,\xe5r\x05\x01\xa4YB\x14\r\xc7F\x05\xb6k\x91$1\"e\xbf\xe6\xe1\x17\x8a\xf8\x85\x12\xbdN\x8bPJT\x96\xa1\xb2\x8cn\xab\x81iZ\x14Je\x86\x83>R\x1a$\x8d\xed1\xb5\"\x18t[\f\xfa\x1d\xb2t/g\x91\xe8\xb1\x1f\xd2Zc\x99\x0e\x99J\t\x8a\x05\xd4\xc66\xbe_Ee\xd9B\xbb\xb9^\x04F\xaf\x19\v\xd1J\x95M\u06dd\xf5\x83J\x1e\x11\xd9\xf6x\xbb\xd0T*\x15ʥ\nB輺\xa1\x19'u\nӲ\t\n\x95\x9c \xccҜ,T\x19\xe1h\xc0h\xd8'\xcbR\x82b\x19!\x04i\x9a\x90\xa5\x19\xae\x1f\xe0\xfaA\xces\x99y&\x9f%\t\xedV\x9d4\xcb\xf6\U000da704LH\x93\x88Le\xf9\xe2\x90\xf9k\x17K>\x9e\xefb\xd9\x1e\x96\xe5\x1cK\xe3\xb0\xfaZ\xb3\x90@\nQ\xb1-\x1f)\xf3\xfa\x85V\x1a\xbf\xe4S\xaeT\xf3\xc4M\xe7\x15\xbe4K\xd1I\x8ciڄ\xd9\x00\xad\x15\x96\xe3\xa1\xc7\x0fRJ\x83x\x14\x12\x85!\x86i\xd2\ufd49\xa2\x904MP\xe9p\x9c\x83\xd8D\xe1\x88ѰO\x14\xe5\xb9L\x14\x8e\x18\xf6{\xfb\xfe#K32\xad\xc6\x15\xc7\ft^\xd4RY\x8a\x14\x82r\xb5\xc8ƺIP\xa8ֺ\x9d\x9d\xf2k\n\x10\xa5\x95eٮ+\xe5+\xc4\x1f\x02|\xdf\x1d\x87\xa7\x06J+\xa4\x12\xe3\xc2Q\x9a\xb3\xb7f\xbe\xdf\xc7q\x94\x93\x83\"\xa7\u137d\x04P):\xcd\xfa\xb8\x18\x952\x1a\xf6\xe9w\x9bH)Q\xe3\xfa\xfa\x1e͢TF\x12\xc7\xf4zm\xfa\xfd>\xfd~\x17\xcf\U000d9799%M\x13\x84\x90\xc4\xf1ho\xa7\xc4\xf3]L\xcbƲ<\xa44\xee\x06\xbe\xf0\x9a\x01D\x80VJ\xe5.C\xec}C\x93$q\x0e\b\xa0\xb2\f!\xf3\x92L\x12\xc54\xebu\xfa\xfd>q\x1c\x91\xa6{\xfc\x93ım|?\xc0q}*\x95\x1a\x9e\x1f`\bI\x9a%\xa8,Ek\xf2\x12\xaf\x10\b!\x19\r\a4\xea\xdbt{]\xc2p\x84i\x9a\xb8\x9eOmb\x12\xdf\xf7\xc7E,E\x96%\xfb\xaf\x93\xa9\f\xdb6\xb1-\a/\xa8\xd0io\xbe\x17\xf8\x97\xaf\x19@,\xdb\x19\x84\xa3^[+]\x95B\x8c#NA\xab\xd5F)M\xb5:I\x96\xe55\x8dՕ\x9bt\xbbm\x84\xc8K\xb1\xae\x97\xd7/\xd2,#\x1c\x8d\xe8tZ4\x9b\xf5\xf1\xaa\xd7\xd8v\x9e$ڶ\x83\xebz\x04A\x814M\xe8v\xea\xec\xeen\x810\x98\x98\x98\xa1T*3;;?&+\xf7\x88N\xc5`\xd0C\xeb\fP\xa8\xb15eY\x92G\x82\x96\x81\xe3\x04\x18\xa6\xf5M\x00\xa7\x0e\x1e\xe5\xe5\xe5k\xb7? A\xa1\"z݆`츳La\x9ay]b\xd0\x1f\x82nR*\x95A\b\xa6g\xa6\x99_X\xf8\xb2\x87\xf6e\xdb_\xa6H\xb3\f\x95%$i\x8a\xca\x14Jgh\xa5A(Fa\x1f˲\xa9N\xcc05\xbd\x80\xed\xd8\xe32nB\x92$\f\x86\x03\xe28b4\x1a\xd1\xebt\xc8T\x9a\x93\x91Rb\xd9&\x9e\xe7b\x98`ȼ\xa8e\xbb\x01 \xc4ɃG\x8e\xbe\xbc|\xed\xdak\xc2B@\xf4\xb5\xd6\r\xad\xa9(\x95\x8d\xf7l\v!\x04\x99\xd6dY\xc6h4ʩ\r)\xc7N:%\x89\x13\xc2pD\x9a\xa6h\xad1L\x13\xd7qp=?\xdfz\x1cw\x9c$J\xcc1M\xaf\xc9#\xb44I\x19D}\x9a\xad(g\x873M\x14EX\xa6\x8de۸n\x01?(c[\x1eI\x9c0\x1c\xf6\x19\x0e\xda4\x9b-|\xdf\xc3qlL\xd3\xc04\x1d\f\xc3\"\x1c\xf5\xbe\x05\xf8\xcd\xd7Ɩ\xe5x}\xa0)\x04G\xb3\xf1\x83\x16\xe3\xb0\x17\x01iҦgt\xb1m\x1b)\x05q\x1c3\xe8\x0fH\x92$ϴ\xb5\xc2u}ʕI\x94\xb6\xb9q\xfdj\xbe\x9d\xb9\xdex\xebR\xe3\xc2U\xee\x83\xd24ͣ\xae,C\x1a&\xe7\xce\u070f4l\x16\x96\x8eP\xaeU1\f\x89\x06\xb4\x06\x94 \x1c$\f\xdaC\x86\xa3>7n>\xcb\xda\xc6E\xa4Ls\xf2Rk,\xdb'\f\xbb\xef\x02~\xf3+\xa9J\xb9e\x80\xf4ڻ*K\xd3TJ\x83,\xd5\f\x06\xc3\x1c\x10K3\x1c\f\xb1L\x13\xd7s\tG#\xe28\x17\x14\x9a\xa6\xc5\xd2\xd2Q\x8e\x9f>\xcd\xdc\xfca\x82\xe2\x04\xa6i\xa0RX\xbev\x83G\x1f\xf9w\xf4z\xddq\xe5O\x12\x86c:\x04\xb1\xaf8\x018y\xf4\x0e\x82\xa0\xc2\xe2\xc1\n\x0f>\xb4\xc0֎`s+C\xab\x8c,Sd:\x8fĄ\x90xn\x81\x93'ހ\uf5f8\xb1\xfcT\xfezJ!\r\v4\xaf\xffr*\xe86\x06$\x8aF\xb6F\xf9\x9e[D\xa3\xe9u\xba$q\x8c\xe38\f\x06#\x1c\xc7&MS\xa4!\x98\x99Ybn\xfe\x10oz\xe8u\x9c=[cO)z\xf12\xa4)h4\x93\x93\x8b\xbc\xeb\xe1\x1f\xe5\xf9\x17>\xc1\xce\xeeM\xc2h\xf8\x8a\xa4\x94W\xe4?SS\xf3LN\xccc;6\xa7\xce\x1c@H\xc9\xd6\xd6\r>\xf9\xf1g\xd9\\\xbfA\xb7\x9b߇\xce4\x96\xe50?\u007f\x84Ç\xcerp\xe9\x0e\xba\xbd:\xcdf\x934\xcb\xd9b\xadU\xf1\xbdo{\x0f\u007f\xf6\x89\x0f\xbd\x06\xc2^!|\x10\x15\xc6D`\x18\xf6\xd0Z\x91\xa6\x1aǱq]\x9b$I9\xbcx\x9aw|\xf3w\xe0\xfb\x05\x8e\x1dg\x1f\x8cV+a\xe5\xda6\xc3\xfe\x90Zu\x01\x94\x83c\x17x\xdd=\xef\xa1\xd1Z\xe7\x89'\xff\x848\x0e_\x05\nض\xc3\xfc\xecalۡX\xa9\xd1l\x1b<\xfa\x91\x0f\xf3\xe1\x0f=J\xbb\xd9d8l\x11\x0e\xbb\xc4\xf1p\\],\xb2\xbav\x99K\x97\x9f\xe6\xce\xf3\x0f\xb2\xb8p\x9a\xe5\x95\x17s?\x97\xc6\b\x84x\xfa\xe9Ϟ\x05.\xbc\x162u\x0fD\x91q\x82\x96\xa61\x85\xe2\x04\x86\x94ضE\x92(\f\xd3\xe6\xc4\xe9\xbb\xf0<\x0f\xa53\xae_\x97l\xac\xbf\xccG\x1f\xf9/\\y\xf9E\xc2h\x84\xd6\x1a\xd30\x98\x9eY\xe2\xa1\a\xbf\x8b\xb9٣LT\x17x\xe0\xfe\xef䳏\xff\xc1\xb8\x84\x9boY\x13\xb59&'\xe6q\xbd\x00\xa1\xe1\xe3\x8f~\x9c\x8f}\xf4\xfd\x8c\x86}Vo<C\xbf\xbb\xfb\n\xeb\x9b+\xb5\xd9ݺ\u0081\xc3w\xf3X\xaf\xc5\xf9\xb3\xf7\x93\xa5q\xbex\x00a\x98B\xa7\xf1\x03\xaf\x11@\xb4%\xa4p\xf7\x96\xbc\x94\"\xd7\\y\xee\xb8F\x01K\x8bǘ\x99Y\"\x1c\x85\xec\xd6\xd7\xf9\xc8\a\xff\x80\x17\x9e{\x928ꓤ!Z+Li\xe1x\x01\xc3A\x8f\xf5\xd5\u007f\xca\xc3\x0f\xffmΞy\x90Zu\x8e\xb3\xa7\x1f\xe2\xe5ˏ\xe1\xb9\x05&&\xe69r\xf8,\x8e\xe7\x93F1\xbd~\x87\xcf?\xf1\tF\xc3\x01\xd7/}\x8eA\xbfM1p\xb9\xe3\xfc,\xfdQD\xa7\x9b\xd0l)\x06\xfd\x01\xd7/=\xc1\xd2\xd1{y\xfe\xc2\x13LN\x04lm\\˙\x01i\x02\xf1ѽ\x1a\xcfW\u0097\xdc2@\xb4ֆiڦe9\b\xc8\xf5V\xb6\x85\xca\x14\xa0)\x14\xaa\x1c=r'\xbdv\x8f\xcf\u007f\xee#<\xf1\xf8G\xd8ܸF\xb7\xb3E\xafSg0\b\x91c\xe5\xbb\xeb\xdax~\x99\xd9\xf9\x93|\xeaS\xef\xc32mΜy\x13\xf3s'h4V8q\xe2<\x96\xe9\xe0\x17\x8b8\x9eKk\xa7\xc1\xd6\xe6M\xea\xf5-\x9a\xbb\xd7\x19\x0eZd\xca\xe4\xef~\xcf\xeb\xf8\xf9_z\x1d;m\x87ԛ\xe3s\u007f\xf2i~\xf9ן\xa4ө\xd3inP*Ms\xe9\xe5\x97\xc7\xfa/\x13\xd3tE\x1a\x0f\xee\xfeJ:\xf6[\xb9e\xe9\xbd\xdd]H\x03\xdf/\x01r\x9f,<t\xf0\x14\x85\xa0\xcc3O\u007f\x8a\xc7\x1e\xfb\x00\x9b\xeb\x97趷\x89\xe3\x84\xf9\xf9\"\xbf\xf2+\xef\xa5<Q\xe5\xc3\xef\u007f\x9a\x0f|\xf02\xdd^\x9b\x8d\xb5\vH)y\xf4c\xbfO\xb56ő\x13g8\x11\xdf\v$(\xad(OT\xe9wzH!\xb9v\xf3\x19\xd0\x10\x86!~q\x8e8N9sr\x1a\x94bj2\x00\xdf\xe5\xedo8ȟ\x1e\xbb\xc8\x17/\xbbh!\xf2mJȜU\x90\x12i:\x00\x87\x16\xaa\xe5\x03\xeb\xad\xce\xeamM\xbf\v!D\x92\xc4\xfb\xc5\"\xcf/\xecGC\x9eW`z\xf2\x00\x83Q\x87/~\xf1c\xb4\x1a\x1b\xb4[\x9b(\x95\xf1\xfa;\x16\xf9\xc3\u007f\xfb\xd7x\xf7w\xbe\x87\xfb\xdf\xfen~\xed\xb7\xbe\x9b\xf7\xff\xeewr\xf2\xd0\x14\xe1h@cw\x99$\x0ey\xff\xfb\u007f\x9b$\xeds\xe0\xe8\xc1\\\x00\xe1\xf9X\xb6E\x1cF\xb4{[4\xea\x9bdY\x82W\x9c\xa1T=\x884M\x9e\xfa\xc26\x9d\xdd\x14\x117\bW_`\xe7f\x9fF'FJ\x81֒8\x1a\x8e\xef\xf7\x95\xe6  \x10BL\xbc\x162u\v\xadm\xa5\x14RHl\xdb!\x8ar\xe1C\xe0\x17\xf1\x03\x97'\xbf\xf0\x11\xc2p\xc8(\x1c!\r\x87\xa2o\xf0\xcf\xff\xc1\xbb\x99\x9f\xb3AE\b\x86\xe88\xa2R\xf0\xf9\xe9\xef\u007f\x13\u007f\xef\x9f~\x9c$IH\xb3\x04i\x98\xac\\{\x99s\xaf\xbb\x9f\x89ٙ\x9cٍ\x12\xb2D\xb1]\xbf\xc6`\x10bY\x0e*\xee2\x1c\r\xa9\xd4\x16\xf9\xbd\x0f_fmg\xc8\x1b\xef\x9eAjx\xfc\xf9\r\xae\xac\x0e\xf0\v\x15T\x16\x93\xa6\x11ѨK\xb56\x8f\x10\x82f}\x1d\x81\x98\x95\xd2<\x05<{\xdb\x02\x92\x8b\x1c\xb4#\xa5Đ\xe6\x98\xe2`_eX\x9b\x98F\x1a\x82\x8d\xcd\xe5<㶋\xd4fjt\xea7\xf8\xa3G.\xf0=\xeeY\x0e\x15n m\x93\xd6J\x8f\xa4-\xf8\xa3\x8f^\xc6\xf7\x8b\x04\xd5y\x840Q\n\\\xb7D\xbfӣ<QA)E\xb7\xd9f8\xea0\x1au\xa9֪\xac\xadlr`\xe9\x1c\x17_\xfcs\xe2\x91Im\xea\b\x8f_\x1a\xf1\x99\x17\xafc\b\x8d\x16\x06\xe5\xdaB\x1e\xe2\xea\x04\xa54\x96m2?\u007f\x988N\xe9vv\xd1h\x03x뉥#\u007fzy\xe5z|\xdbZ\x88R\n\xcf/\x8dE\xd22\xafo\x8c\x89\xf9\xa9\xa9\x05\xc2p\x98\x97iQ\xa4q\x1f\xb70Ce\xf2\b\xff\xfa\x8f\xbfģ\x9f\xbb\xc27\xbd\xf9\x14\x87\x17kt\xba#>\xfc\xd9+\xbcx\xb5AP=\x88\x94\xd6\x18X\xc5\xfa\xda%*\x135J\xb5\xbc\x0fQ)E\u007f\xd0$S)\x13\x13\x13\xac\xael \r\x93\x93g\x1fbc\xed\"\xed\xe6u\x1co\x82Bib\xbc{\xe6e\\\xd3\xcc8t\xec~6V_brr\x91\xa0P\x81~\x9fB\xa1BkW\x01\xbc)\x89G\x1ep;\x03\x92U\x8b\xa5\xa9\xfd\xbdXk\x81\xce\x12,ˤ\\\xac\x92$!\x02\x81R\x8a\xc0\x0f\x88F\r\xa4]\xa2:w\x9e\xd5\xfa\r~\xfb\x0f\x9fb\x8f\xf4\r\n\x15JS'\x01A\x12u\x10E\x9f0\xec#\xa4\xc4\v\xbcW5\x81\xe6\x8d:\x02p\\\x8fJ\xa5F\xbfߢR\x99\xe5λ\xdfB\xaf\xdf\xe1\xf2KO0\xec,c;e\xfc\xe2\x14\xb5\xda$\a\x97\x8e\xd3\xdc]'\x17c\xe8='\x88iڹ\x0e\f}Z)\xed\xdc\xce>D\x00\v\xbe_AH\x03c\xac\xd7\x05\xc6\nw{,!5\t\x8a\x13t\xdb[Tk3\xa4i\xc20\f)\x94\x17@\xab\xfd\x82\x93\x94&Ѩ\x8b\xeb\xd8,.\x1c\xa6P\xac\xd1m\xaf39\xbd\x80\xb4^!\x18\x85\xcc\xeb)\x1a\xb0,\x8b\xc9\xc9I\fiӨ/S\xad\xdeý\xaf;\xcf=\xf7\xbe\x89v\xa7I\x12G\xb9\xf0NHVn\\fk\xeb&ՉE\xb4\xea\xef\xdf+\xe4\x052\xad\x14\x96c/\x00;\xb7% \xf9C4&-\xdbE\n\x81iYX\x96\xb5\xcf\xc8\xee\xa9\xd7-S`\x9a\x0e\xc5\xd2\xd4x\x1b\x82#G\xee \x8ez\xec\xee\xae\xd2n\xd5\xd1\b\f)8|\xe4\x1c3\xb3K\x14\x8aU\xd6W\xafq\xe4\xf0Y2\x9d\x10\x14\nD\xa3\b\xcbr@k\x06\xa3F\xee\xccUF\xa9\\$\x1c\xa5\xc4\xc5\n7\xaf\xbf@\x9a\xa5LLNR*U\xc8\\\x9ff\xa3\xc1\xb5+\xcf\xd2\xeb֙\x9d?\xc5\xfa\xea\x05\xee\xba\xf7~vvZ \x18\xf7\xa6\xf8c\xca'y'\xf0\xccm\n\bX\x96s|4\xec\xa0T^\"\xf5</\x17:\xc7\x11\xbd^\x8bb\xb1\xca\xd2\xd2\tV\x1f\xff\x04Չ\x03\xecl]!(LP\u07feʽ\xf7\xbf\x93\xf3w\xbd\x81v\xabE\x1c\x85\x04\x85\x02\xc5R\x19\x95e4\xea\rڭ-\xbe\xe5\xbd\xdf\xcf\xd4\xec,\x8ec\xf0\x89G\xfe\x13\x9f\xfa\xc4\x1f\xf1\x13?\xf7[ܼy\x91\x0f\xbc\xef_\xf2\xb6w~\a\vKǰ\xed\x01\xaeS@\x8a\x8c\xdd\xedU\xfa\xbd\xee\x989\x90\fzML\xdbcn\xf1\f\xbd\xce.\x93SE\x84\xb0H\x924\x0fF,\x17!䘚Q\xa5\xdb2\x0fY\xa8\x96QJ\xa3\x94Z2\f\x1b\xcbv\xf6U\xe7{\x9d\xb27\x97/\x81\x80s\xe7\xdfH\x1c֑\x86\xc9\xd4\xec1@\x10\x14&y\xf1\xb9\xc7\xe8\xb4\xeb\x94+%\xe6\x16\x16)\x14K\x84ር\xcdM67\x97\xb9\xf7\xee7\xe3\x06\x1e\x8b\a\x8f2\x1c$<\xf2\xa1\xdfc\xf9\xc6E6\xd6n\xa2\xb2\x8c4\x89\xf9\xcc'?H\xb7S\xc7/8ض\xc7\xd4\xf4A\n\x05\x9b\xc1\xa0A\x1c\xe7JȠ8A\xa1P\xc1\x90)\x96\x1dr\xee\xae7\xd0\x1f\f^\xd5g\x12\x82\x90H\xc3B\xa9l\xf1\xb6\xf4!\xeb\xad\x0e\x8b\xb5\x8aa\x18\xe6=\x95\xea, \xf7{\xc8\v\xc5\x02\xedV\x9b\xed\x9d5Ne\x11~P\xe6\r\x0f\xbe\x9b/=\xf98\x13S\x87\xa9M\x1e@뼺\xb8\xba|\x1d\xd7\vp\xf2r*i\x92\v\x12LCq\xfa\xf4}\x18\x96\x81\x14\xf0ħ?Lcw\x9d,\xcd\xe86\xdb\x1c9z'\x00\xc3A\x97\xa7\x9e\xfc\x14\x0f<\xf4\x1e:m\xc9p8\xe2\xe4\xd9{\x89F=\x1a\xcd\x1dJ\xa5I@cZ\x92J\xb9\x8a\xed\xdeO\xb3\xbe\xcdp8B\bA\x14\x0e@\xe4m\xd9´\x11\xf0&\x80sGO\xf3ⵋ\xb7W\xa6\xae\xb5\xfe\x06!\xa5;\x1c\xb6\xf7U\x1d\xa6e1?\xbf\x80\x10\x82\xe1p@\xafצX*s\xecĝԦJ\f\a{\xfa\xdb<\xbaq\xbd2Z\x1bDa\xae\xc7ʔ\"M#Μ\xba\x9b\xb5\xcde\x8a\xa52q\x94\xf2\xdc3\x9f!Ib\xa4\x94\x1c:|\x86\x93'\xbe\x81\xd9\xf9Ch\xad\xb9q\xf5%\x92hD\xa9죕\xe0\xe2\x85\vTj\x93\xbc\xee\xbeo\xe4\xae{\xde\xc0\x9dw\xdfϝw=\xc0\x91\xe3w\xe2{\x01\xdd^?o摒L\xc5X\xa6\x83F\xa3U\x86\xd6\xd9a\xe0\x96\x82\xf1?\x1c\x907\xde\xfb\xd0\xde\xfe\xfc?\x19\x86\x89e\xbb\xafT\xf2\xb4\xa6R\xaeP,\x16\x89\xa2\x90v\xbb\x8ei\x1b\x1c=v\x17\xaf\xff\x86\xb7\x93&u\xc2a+\x97\b\x8d\xffO\x1e=\xe5\x11\x94J#l+\xe4̹\a\xb8y\xe3\x02S\xd3st\xdb\r\xb66n\x92e)\x13\x13s\xd4&fq=\x9f\xfb\x1fxw^`\x022\xa5(\x96\\\\\xc7B`\xf0\xa5'\xbf@\xaf\x9bKN]\xaf\x88e;\x80\xa6\xde\xd8acm+\xbf\u007f!I\xa2\x11\xd20\x11\b\xb2,F)\xc5Cox\xc7\x1d\xb7Ֆ\xf5\xb9\xa7>\xcdg>\xaf\x8d\xef{\xef\xd4w\xba^\t)\xcd\xfd\xe4+\x0fi\a\xcc\xce\xce\xd1l6\xb9y\xe32w\xdc}?\x85b\x85\u05fd\xfe\x9d\x9c<\xf9z>\xf2g\xff\x8en\xafO\x1cgĩ\x1aw\xe1Zؖ\xc9́\x03\xdcw\xef;y\xe1\x85'\x99\x9a\x99ôl\xda\xed:\xf5\xfa&\xa3Q\x9fٹ\xa3؞G\x94\x0e\xf9Ʒ\xfd\rn^\xbb\xc8\xcc\xdc\x01\n\xa5\x12\x02\x93R\xd5'\xad+\xe2x\xc4\xe7\x1e\xfb$\xd33\xf3LLLR(\x15\x89\xc2\x11\x97.^ \x8e\x13\f\xc3\xd8\xefز\xecB\xbe\x96T\x9a/\x8a,un;\x1f\xf2\v\u007f\xff\xc1ci\x9aؕ\xca<q4B\x8dE\xcdY\xa6h6\x1b,.,\xe1\xd8\x0e\xf5\xfa\x16W^~\x91\xd7?\xf0\x10\x86Y\xe3\xfa\xd5\x17\xf9\xae\xef\xfb\x196֮\xb3\xb5\xb1L\xaf\xdf\xc6u\x8a\x94\nӸ\xaeO\xb5:C\xa7\xbb\xc9͕\xcb|\xf3\xb7|\x1fJ)\xda\xcd]v\xb6o2?\u007f\x8c\xef\xfd;\xff\x1b\b\x85\xedڔ\xca5\xbe\xf7\a~\x91\xe1\xa0G\xb7\xb7\xcdh\xd4E+Š\x17\xa1T\x81\xf5\xb5\xcbؖ\xcd\xd6\xe6\x1aY\x96\xed\x0f\xba)\x14\x02\xb4\xd6\xc4q\xba\xafՒ\xd2@\x1a.q\xd8am\xe5\xe2-\xefZ\xfe\x1f\xfe\x02:\xea*\x10??3w\x82,\x8b\xd1:\x1bS\xef\x02CB\xa9\\&IR\x06\x83\x01\x9b\x9b\xab\x1c;~\x86B\xa1D\xb56\xcd\xe7>\xfdA\xbe\xe1\x8d\xefb\xe1\xc01\x0e\x1f=\xcb\xc1ç\x98\x9c\x9e\xa1Z\x9b\"\x8e\x874[\x9b4\x1bm\xee\xb9\xefA\x82 \xc0\x90\x0e3\xb3K|\xf7\xf7\xfd,\x93S\xf3\xc4рvs'o\x1cM\x13\xc2\xe1\x10߯\xe0{\x05\xa28Ĕ\x0e\xfd~?O\xf4,\xb8\xef\xf5\x0fR\xab\x95\xf1\v\x0e\xae\xe3\xe28\x0eJA\x1c\xc7\f\a-\x840h\xb56\xb0,\x9f,\tɲ\xf8\xd3\xddQ\xf8\xfcm\x05Hw\x14\x86e\xdf{\xabF\x1f\x9c\x9a>B\xb7\xbdE\xbb\xb9Imr\x1e\xc3\xcc\xc7+\x15\x8be\x1a\x8d]\x94R\xecnor\xec\xe49<\xdfg\xe1\xc0Q>\xff\xf8#\xcc\xcc-a\xbb\x0e\xa6a`:&\xae\xefbZ\x92\xa7\xbe\xf8\x05J\xa5\n\xa7N\xdd9\x0eK\x15\a\x0f\x9f\xa5T\xad`\x9a\x06;\xdbklo\xae\xe1\a\x01*S\x8cF#\xd0\x1aC\xdaT\xab3Hi0\x18\xf6Q\x99bgg\x9d\x85\x85%*\xd5)\x928d4\xca\xeb\xf3Q\x14\x11'\xe9> \xed\xd6\x16\x86a\x91\x8f\xf1\x88莢\xf7\xdd6N\xfd\x95FO\xf5wۭ\x8dlk\xe3\x12\vK\xe7\x18\f\x9a\xec\xee\xdc IR\x86\xc3>\xb6\x93KA\xa5\x94ln\xae\xf2\xa5'?M\x96ex~\x81\xfb\x1fx\x17\xddn\x13\xc6\xcd\xfe\x860@\xc3\xc5\v/\xb2\xb2|\x99\xf9\x85\x83\x98\xa6Esw\x87\xe5\xeb/q\xed\xf2sԷױ\x1c\x81\xca\xf2\x16\x83~\xaf\x8baZ\xfb\x94\x8aR\x19*͘\x99]\xe4\xe8\xd1Sx^\x80\xe3\x04\\\xbf\xferޕ\xe5\x95\xc6\xd3\x1er\x85徖e\xdcZ\xad\x01\xcb)\xa0\xb5~\xf3\x81\x89\t\xef\xb6\x01d\x9c\x83\xb0\xd6\xec\\Ͳ\xe4\a7\xd7/\xd2nmr\xf8\xe8\xeb\xd9ڸB4\x1a\xa0\xb2\x8cѨOP(\xeeky_x\ue2ecܼ6n+p\x98\x99Y\xc2\x18\x0f\x02H\x92\x98O\u007f\xf2\x11>\xfd\xe7\x8f \x10\x14\v%\xb4V4\x9b\xdbc\xa5\xbbfw{\v\xc3\x00?p\xc6\n\x97pܭe\xed\xdf[\x96e\x84\xc3!\x95\xf2\x04Ǐ\x9fen\xfe\x10W.\xbdH\x96\xa5\xf8~\x80\xe7\xbayD5VL\xeeEwZe\xb9\xec\u0530\x91\xc2\xf4\xb5N\xdf\xf2\xe5\x8b\xefk\u007f\xcb\x02\xa07\n\x9f+y\xaeѬ/\xbf\xa1R\x9b7\\\xb7\x88\xe3\x9ax~\x80iXH!\x19\x0e\x87\x14\nE\xa2(\xa2\xd5\xd8\xe5\xd8ɳXV\xae\xcb\x1d\rF\xb4\x9a\r>\xfa_\xde\xcfK\x17\x9e\xc1\xb6\x1d\x94V\x1c?y\a\x8e\xeb\xd0j\xbc\xc2\xf3y\xbeϑ\xe3\x8b(\xa5i6ZH)\xa9\xd4&Ȳ\xbc\x1d\xe1/$I\x14\x82\"\x95j\x95\x8d\x8de\xb2,cn\xee\x00\xddn\x8b8\x89\xe9v{\x80`4\xe8 \xa5Icw\x19\xdb-\"\x90h\x94\x95\xa5\xe1v/\x8c>\xba\xd7e\xfc5\x0f\xc8\xde\xea\xe9\x85\x11\xbd0\xfa\xf3\xe9\xdaTak\xe3қ\x0e\x1c\xbe\x8bA\xafN\xa9<\x91\xb7\xb7\x996\x83\xe1\x10۲8p\xe00\x9b\x9b9\xcf411C\x92$\\\xbe\xf8\"\x1f\xfb\xe8\xfbI\x93\x84\xa3GO\xd3j\xd5\x11B\xf0\xba\xd7?D\x14\r\xe9\xf7\xba\xfb\xf9ʩs\xa7(\x97\x03\x82\x82O\xb5Zabf\x91b\xb9\x96\xb7\xc1\x05A.|K\x93|8\x8d0\xf2.\xac\xa0D\xa62\x9e\u007f\xee\xf3\x9c\xbb\xe3>\xa2(d0\xe8\xd1\xeb\r\x10B\xd0mo!\x84I\xb7\xbd\x8di\xfbcBT\x12G\xfd^\xadT\xfd@gп%\xb5\x91[օ\xbb'\x9b9w\xe7[\xff刺\xf0\xa1\x9fo5\xd6\xf0\xbc\"\x83~\a\xdf\xf7q\xcb\x01\x86\x94\xc4I\xc2\xd4\xf4,\xe5\xca$/\xbd\xf4,+\xcb\xd7q\x1c\x97͍\x15\x8e\x1c9\xcd\xf4\xf4<i\x9a7~NN\xcdP.UX[\xbd\x9a\v\xb1\r\x83\xd3\xe7ϳr\xf3y\x06\x83Y\xba\xed&\x17^x\x8a\xf5\xb55F\x836\x9ec\xd0\xeb\x0fȔbk{\x17\xd7\xf5y\xf7{~\x80s\xe7\xef#IbFр\xe7\x9e\xff\x02\x8d\xc6\x0e\xb6\xe5\xe4\x02\x87q\xa7n\x92D$\xe9^\xfe\x11\xe3\xb8\x01\xa96\x91\x86y&MF\x8b\xc0˷\x8d\x85\xc0+\x83\x03^\xbe\xf2lwa\xf6\xc0\x0f\x86\xa3~yn\xf1\f;;\xabLLNc[\x16`\x10\x86!\x9e\x1fp\xf2ԝT*5\x86\xc3>\xebk\xcb\x1c?~\x9e\xc9\xc99@\x10\x86#67\x97y\xc7\xc3ߎa\x9a\xf4zm\f\xc3\xe4\xf4\xf9;y\xfa\xa9\x8f\xf1ُ\xfd{\xae\xbd\xfc\x05J%\x8b\a\x1f\xbc\x97\x87\xdf\xf9F\xfe\xf3\xfb~\x8f\xefy\xcf]\xfc\xado\xfd\x06\x1e~\xe0\x04\xa7\x0fO2?i\xb3\xbb\xf9\"\u007f\xf4\xc7\u007f\xc8\xeb\x1fx;\x9e_\xa2\xd3\xdaa0\x1cP\xa9\xd6\xe8tZ\xf4z}\x84\x90\f\xfb\r\xba\xdd]\x90\x06\xa8\f\xcb\x0e\x10B\x90$CO\xab\xe4?\xf6\xc2h\xfd\xb6R\x9d\xbc\xfa\xaa\xd6\xe6>\xd5j\xae?\x16\x8e\xba\xa4\x89\xa2\xd5l\x13\x8eF\xb8\xaeE\x96\xa5\xb4\x1au\xe28\xa2V\x9b\xe2\x9e{\x1f\xe0\xf0\xe1\x93y\xa7\x94\xe7#\x84\xa4\xd3ip\xf4\xd8Y\x16\x16\x8e\x00\x9a\x85ţ\x9c\xbd\xf3>.\xbd\xf4E.~\xfe}|\u05f7<\xc8O\xfd\xc4\x0f\x12\xd8\t++7\xc92\xc5[\xde\xf5\x1e\x1e\xf9\xf8\xe7\t|\x8f\u007f\xf7\xbe\x8f\xf0\xf8\x17\x9f\xa3Xp\xf8\xe6\xb7ޅ\xa9\x1a\xbc\xf4\xc2\x17\xf1\\\x9f\xa5\xa5\x13\xb9\xd2D\x88\\\x19?V4Z\x96à\xbbK\x16\x87h\xc0u}\x0e\x1f\xb9\x13C\x9a\x96ֺx[\x91\x8b\u007f\xf1r\x1c\xff\xffI\x93\xe8}\xab7\x9f\xa5:1\xcb\xf6\xd6\x06\xbd\xfe\x10\xc8C\xccյ\x1b\xf4\xfb]\xb24\xe7\x8c|\xbf\x80\xeb\xfa\x18\xd2`4\xcaW\xec}\xf7\xbd\x05\x95)\x1c\xbb@P\xa8\xf1\xec\x97\x1e\xa7y\xfd\xcf\xe8\xf7\x1a\xecv\xe3\x9c\x11P\tY\x92`Y\x16\a\x0e\x1c\xe0\xda\xca\x06\x97\xae\xaep\xf1\xca2Wn\xac\xb3\xbc\xb6\xc5o\xfd\x9b?fy\xe5&O|\xee\xc3\xc4qH\xb1P\xc52m\xa2$F\b^\xe9\xfe\x05\xb4\xce)x\xcbr\t\x82\x12\x85B\x95re\x1a\x95e\xee\\\xf9\xd6`r\xcb\xe7e\xbd\xeb\xad\xdf\xc1G>\xf9ǟ\x9f\xaf\x14e\xbb\xb9Qw\x9d\xc2d\xab\xb1E\xa7ݡT*\x10\x14<66\xd7Y]\xb9\xce\xd9s\xf7Юo\xe1\xba>\x8e\xe3\xd1n7Hӌ\xb5\xe5\v\xfc\x93G\xff5\xbf\xfa\x1b\x8f\xe2\xfbe\x9e\xfc\xec'\xd9]\xfeO\xbc\xeb\xa1Ӭ\xae\xad\xf0\a\u007f\xf8'\x98\xa6\xc9SO?\xc7s\xcf_b\x14\x86<\xfa\xc8#<\xf3\xc2\x15~\xe4g\u007f\x8d\xfe`D\xa6\x14O>\xfd\x12\xfdq[D\xfb\xf1\xff\xc27\xbe\xf5;\xf1\xdc*\xae\x1b\x90&\xb9\xbfH\x92\x9c\xcfRY\x9c'\x83*\xc54\xcd\xfd!8\x00~P\xf4N\x9e\xbb\x8f͏\u007f\xe8\xf6\x03\xe4\x85g>6v\x8c\xd9\xdd\x133\a'\xb5֟\x16\x83\xfb\x00\x00\x1b\xd6IDAT4\x1b\xab\x04\xa5\t*\xdd\nA!\x8f`._~\x913g\xef!\x0e\x87\xb9RE\b\xb24c{\xf3:\x9f\xfe\xf3\xffȝw\u007f#\xaeW`s\xfd:\xff\xf1\xf7\u007f\x91r\x10Ҩ\xaf\xf1\xd2\xe5e^\xbe\xba̗\x9ezn\u007fj\xdc\xcf\xfd\xd4O\xed\x8f\x02l\xb4\xba\xfb\xf7\x92\xa6ٞ\xf8\x8d(\n\xf9\xd4'\xfe\x13\xdf\xf1\xdd?\x83\xef\x15P*F\xeb|\xa6\x8ae\xd9D\xe1\x00)-\xd24\xee\xc4a\xcf)\x95\xeft\x95\xcey\xaf$\x8d{\xa5b\xe5\xf6\xb4\x90\xf5V\x87\xb9raʲ\x9c\x9f\\:|7[\xeb\x97\xe8\xb67\xe9w\x0f\xb2\xb3\x13p\xb4t\x98b\xb1\xc0\xc6\xfa2\xcb\xcbW\xa9Vkd\x9d>\xc5b\x15\xcb(\xf0\xaf~\xe7'\x88\xa2!\xefx\xd7\xdf!Kc\xde\xff'\xbfɍ\x1bϡ\x91<\xf5\xfc\xa5||\xaci\xe0H3\x1fϤ2\x18W&\xf5_P]|\xd9^-\r\xae]}\x0e\xd30\xf0\xfc\x02\x83A3o\x8bKS\xa4\x14\x84a\x1f\xd3\xf2ɲ\xe4R\xaf\xbb\xd3WZ\xbd\xd50$\x96-\tG\xc3\xfe\x1f\xbd\xff?\xdc~>d/\x9b\x95\xd2\xf8\xf1\xda\xe4\xc1c\xa5\xf24[\x9b\x97QY\xf2r\x14vh\xb5\x9bt:]\xca\xe5\"a4\xe2\xd2\xcbϣU^ųL\x87\xa7\x9e\xfa\b\xf5\xfa\x1aG\x8f\xdf͉S\xf7\xb1\xb1~\x95'>\xf7\x9fs!\xb4a`[\x16\x05\xcbf\xc6\xf2\x99\xb1}f,\x9fIˣl\xdaTL\x9b\x8a\xe9\xe0K\x13_\x9a\x98B~\x19@Y\x96r\xf0\xe0\xe9q\x0fb\x9et\xa6I\xb2/\xe6KӘT\x9b\xa0u\xa6\x95\xda\x1e\xf4[x\xbeˠ\xd7\xdc\x06Ѻ-\x9d\xfa\x9eb\xdc0\xac\x9f\x9c\x9d;N\xbf\xd7d4l\xeb,\x8d\u007f2\x89\xfbh\xadX[[\xc3u=l\xdbae\xe5*\xdb;\x1b\xf8\xbe\x8f\"\xe3\xd1G\xfe\r\x8e\xe3\xf1\x96\xb7~\x0f\xa5R\x95\x0f~\xe0w\xe8\xf7[\xfb\x1c\x95)$U\xcbő\x06\x86\x10\x18B\x10\x18\x16\x93\xb6G\xd5t\xa9\x98\x0eS\xb6G\xd5r\x99\xb2<ʹxz<\x89Nb\xdb\x1eq\x14b\x98\xc6>\xb1\x98wp\xeb|\xa4\a&\baK\xc3\xec\x0e\xfaM\x95&Cvw6^\xb4,{\xed\xb6\x03d\xcf:\x16\xaa\xa5\xb7\x98\x96S\xa8\xd4\x16\xd8X\xbb\x80\xd6\xfa?\xa7iz!\x89\a\xbdb!`0\xe8\xd3\xef\xf7)\x04\x05\xba\xdd.\x1b\x1b\xcb\x18\x86I\x96Fض\xc7\xe93o\xe4\x1b\xde\xf8^\xae\\~\x96O~\xfc\xff\x1b3\xaf\xf9eI\x89-\xe4\xfeCv-\x8b\x92\xebRp<ʞ\x8fo\xd9\x18\xe4?#\x85\xc0\x93\x06*S\xfc\xd8\x0f\xfcu~\xf77\xff!\x81\xe7\xecW\b\x85\x16Dq\x1e\xe5ei\x02Z\xa1\x85\x01\x02O\bٌ\xc3A\xb2\xbdy\x1d\xad\xf53\x1b\xed\xee\xbec\x9a)\x06\xe2\xc0D\xb5\xf6=\xdf\xf1\xfd\xc6\xd74 {֡\x94\xfa\xb1\x89\xc9%°O\xab\xb1\x06\xf0\xf3\x96e\x1b\xfdn\xabm\x9a`\x18&;;;\xb8^\xde۳|\xf3\x1a\xe1h\x04\xc2\xe0\x17\u007f\xf9}\xfc\xec/\xfc\x1eS\xd3\xf3\xfc\xfe\xef\xfe#\x8e,-\xec;e\x01\xb8\xc2\xd8\a#p\\<\xcb\xc6\x18[\x8b\x14\x02϶\xb1\xf6G\xc8B;\n\x99\x9c(S.\x15XZ\x9cE\xab\x10=\x1e\xf0\x1f'\xe1\xbe\xdc5\x89\xa3q\xaf\xbc\x04\x84\xa5\xb5\x9as\\\xdfX\xb9\xf9\xd2PJ\xe3\xd1W/8\xc30ި\x94\xfa\xd4h4<z;\xf8\x90i\xadՃ3\xf3\xc7i77\x88\xa2\xc1\x87\xb4\xd6W\xb4ֶ\xe3\xfa\xf6\xce\xd6u&'\xa7\xe8\xf5z\xe3\xbe\x11I\xbb\xdddu\xed\xe6\xf8AC\xb5V\xe1\xd2˟\xe7\xed\x0f\x1c\xe27~\xe9\xc78ul\x89L)liR0m\x00l\xc3\xc06\xfe+\vt\xec4R\xadX\xefv8px\x8e\u007f\xf1\xeb?\xc7\xe4D\x85\x1f\xfb\x85\u007fΕkW\xf2~\xc2,c4\x1c\x12\x86\xb9\xe2$\x8er\xbaeLf\x94\x81\xfb\xb4N\xcd^\xb7\xd9\xda\xee\r?\xbe\xb7\xe0f\xca\x05[\x1a\xc6/j\xadϯ\xaf\\\xb3\xbef\x01\xd9[=Z\xab\x87,\xdb-\xfa~\x85\xc6\xee\r\xd24\xfe\xbf\x84\x10\x1a\x81\xa12e\xcf/\x9e\xa2\xd5X\xa1\x10\x94\xa8\xd7\x1b\x04A@\x9a&\xbct\xf1\x19\xfa\x83\xf6x\xf0\x8c&N:\x18\xa6\x8d!%\xcdv\x17)\x04\x89Έǒ\xcf8ˈ\x92\x84lL\xc7+\xadI\xb5b\xa4S\x1aɈ\x8e\xcc\xf8\xa9\x1f\xff\x9b\x9c;}\x94\x85\xb9i\xdet\xdfy\x8e\x1fYdss\x05! IBZ\xad\x06I\x92\xab*ðG\x92\xe6j}\xa45%\xa5<Ӭ\xaf!\x84\xf8\xb7{\xef\xf1m\x0f\xbe\xbdT\xadL\xfeYAZ\xef\fl\x97͍\xe5w\xbc\xfa\xbdϔ\x02\x16\xaa\xe5\xffn\x9a^\xde\xda\xed*\xbb\xab\\\x99w\xc3Q\x8fF}\xf5\x19C\x9a/\xae\xb7:h\xad]\xcb\xf6*BX\\\xbe\xf8\x04\x9ek\xa3\x94\xce\x15\x83\x86\xc1\xc6\xfa2W.?O\xa6\x124\x11'N\x1e\xe7\xdf\xfe\xe1G\xf8\x99_\xfem\xbe\xef;\xdf\xc5\xd4d\x95$Mي\xfa\xec\x86C\x06iL}4`'\x1cД\t\x97\xfauv̘\u007f\xfc\xcf~\x9c\u007f\xf0\xf3?\x80\xeb\xbb\xfc\xf0\xf7}+?\xfc\xb7\xde\xcb\xff\xf9/\xfe\x80R1\xe0γǘ\x9f?H\x96e4\x1b[\f\x06\xfd<\xc2\x02Ⰻ\x12{\v\xde\x10\x80H\x92h`\x9a\xf6o\x1d\x99\x9f?p\xe6ر\xef\xbd~\xe5ō\xb8\xd7}x\xc6\xf4\xa8\b\x13\xadՏ\xbe\xfa\xbdۖ}\x04x\v`\u007fM\x90\x8b\vղ\x9be\xc9\xff|\xec\xc4\x03'w\xb6\xae\xaaNk\xe3\xff\xdd\xea\r?\bP\xb0\xcdc\x95\xda\xfc\x0fJi\xb1\xb9~\t!aj\xe6\x10\xbd^\x17\xc30\xd1Z\xb3\xbd\xb3\xc1\xe1C'\b\x82\"\x87\x8f\x1d\xa6\xd5\xdc\xc1\xd6]~\xf1'\xbf\x9f\xe9\xc9*\xc30\xa1T\xf4y\xe3\x03w\xf1\xf2\xca:ҷ\xf8\x91\x1f\xfav\xbe\xf5\xbd\xdfH\x9c\xa6\xdcX\xde\xe4o\xff\x8dw\xf3\xd0\x1b\xee\xe1\xe6\xca:\xe5R\x01\xd34\xf9\xd3G\x1e\xa3\xd1\xecp\xf1\xea&GO\xbd\x8dJu\x96\x9b7/\xd0\xe9\xf6\x89\xa2\x10\xc3\x10\xecl_%Q\x0e:\xd7\xe7\xa3\xd5\b\x84\xa8gJ\xddo\x9b\xd6O\x8b0\xfa\xa1\n\xa6=m\xfb\xd82ρ\xfaY\\\xb3\x1ds\xb1\xea\xfb\x14=\xf7G\xb5ֿ\f\x1c\x06>\xd0\v\xa3\xf4\xab\x0eH`\x9b\a=\xaf\xf83\a\x8f\xdc[\xbdt\xf13}\x81\xf8\x87\xbd(\xde\x00(8\xd6\xdd\x13\x93K\xdf\x1d\x8ez\xf4\xba\xbb_\x1a\xf6;\xfe\xe4\xe4\xbck\xbb\x01Q\x14\xeeO\x8b\x1b\x85C\x0e-\x9d\xc2vL\x1e|\xe8a\x9e|\xe23\xdcqr\x8aQ\x94p\xe5\xfa\x1a\xbf\xfe\xbf\xfe(\x0f\u007f\xe3뙞\xaa\xd2\xe9\r\xf8\x89\x1f\xfe\x1bT\xca\x05\xce\x1c?L\xa7\xdb\xe3\xb9\vW\xf9ا\x9f\xe4\xd1O}\x81\x17\xae\xf5\xb9\xb6))\x94\x0fpe\xa5Ł#o\xe4؉{\x19\x8e\xbaln\xad\xd0n\xb7H\x92\x14!B6\xd6n \xcd\xe2\xd8\x05\tJR3i\xba\xc5i\xdb?QEN\x94\r\a\xdf\xc8G\b\xee\x05\f%\xc3\x11\xc0])\xfa\xdb*\x86\xf3 0\x19\xeb\xecw7Z\xdd\xcf|\xd53\xf5\x85j\x994\x89\xe6K\x95\xd9#\xcd\xfa**K67;\x83/\xed\xaf\x02Ü0L\x8bvc\x1d!\xc4si\x1a뫗\x9f\xbc\xef\xbe7|\xdbx(M\xce7\xad,_ey\xf92\x86))\x96<~\xe4'\xfew\xfe\xf4\x03\xff\x92\v\x17\xbe\xc0\xbb\x1e\xba\x970J\x18\x8e\"\x96槹tu\x85\xc7>\xff\x1cO\xbfp\x95/=\u007f\x99zkāC'9v\xe2N\xfe\xd1?\xf9\x19&&\xc6moIL\xb3Ѧ\xdf\xeb3\x18\xb4\xb9|\xf99\x86\xc3|\xbc\x87eYl\xae<\x0f\xa6\x8f\xda\x13\xf8\t\xc9 \xd3ؤ\x94L{\x1f\x84\xbf\xb4\xb2\x85`\xde.H\x01\x9e\x06\x1aɐ\x81J\x1e\x98\xaf\x96\u007fw\xa3\xd5i\xef\xfd\xdc\xdd\a\x0e:\xcdQ\xaf\xa4\xb4>h\x19\xe6\vQ\x12G\xaf\xee\xf0\xbde\xfd!B\xc8\xfb\x83\xa0\xc6\xe6\xdaK\ba\xfc\xf1\x1eP\xeb\xad\x0e\xae[8*\xa5A\x9aŀX\x17\x82K\x9d\xf6\xee}/\xbf\xf4YΜ{\v\xbb\xbb\xdb\fG\x03\xe2$\xe1\x99\xe7\x1e\xa7V\x9ba\xf5\x86\xcb\xdcR\x85\a\xbf\xe9{)ϝ\xa0\x11E|\xfc\xe9\x04\xa9\xb7\xe8\rb\xee\xb8\xf7!.l\x158t\u05f7\xf1\xb6\xef8\xc9\xdc\xfc\x12ZA\xab\x95\xcf\xda\xdaظN\xa3Ѥ\xbe[\xa7\xd3iS\xaf\xef\xd2\xed\xb6\x89\xe3\b!D\xeeâV^\x99tf\xf7W\xbe\xd6\x1a%$\xbd,&\x8b\xfaL\xd9\x01\xaea\x92\x8e\x03\x8aWO\x92\xd0\xe8}6\xa0b\xb9\xf4U\xf2\u05fbi\xac\x17j\xe5\xf7\x83P\x12Qi\x8e\xfa\x0fh\xcdCZ\xeb\x998I\xee\x00.}E\xb8,i\x18\xf7h4Q>\x93\xe43\xafvxB\x88\x91\xca2<\xbf̠\xdf:-\x84\xf8Y\xc30\u007fjs\xed\xf2\x9ce\xd8\x1c?\xf3Fvv\xb6\x18\x0e\alm\xad\xb3\xb2z\x994K\xd8\xdc\\\xa7\xd5^Ci\x83ə%N\x9c<\xcb\xc4\xe4\x14\xb6m\xa0t\x82aXl\xacmp\xf5\xeaU>\xfd\xa9ϰ\xb9\xb1A\xbb\xddb8\x0ei\xf7\x1aq\xb4֔\xcbU\xa6\xa6gH\xe2\x88\xed\xed-\x94J\xd9\\\xbb\x88\xb0kc\xba'\xaf\x1e\xea,?\\,1<Fِ\x81N861\x8b\u0530\xd1i1\x8c\xa2\xfc<\x93\xbf\xb8\"5\xcc\xdb\x05\x8aF\xfc\xed\xed4\xfevS\n,!\xb1\x90x\x86E3\x19\xd1ɢ\x9f\xdehu\xff\xdeB\xb5,\xd6[\x1d}\xab\xc9\xc5 M\xa2\xbdd\xe0ˢ\xb9\xe1\xb0\xf3\xf1~\xbf\xfe\x8f\x97\x0e\xddM}\xe7\xfa7\x83\xf1\xab\xc0\xe3 \xbf}e\xe5\x02\x8e\x17\xb0t\xe8n\xb6\xb6\xd7\x19\x0e\a\\\xb9\xfa\x12\x9e\xe7\"\r\x8bF\xb3N\xbf\xd7\xe1\xe2K/\xf1\xc4\x13\x8f\x13\x04>\xd5\xea\x04i\x92q\xf3\xe6\r66V\x19\x8dF\u007fi\bھ \xc2\xf39\u007f\xfeu\xcc\xcf/\x12\xc5!_\xfa\xe2c\xa4YJ\xaf\xb3A\x94d \v㡝\x16\xe8\f-4ib\xa1uJ,]\x1aQȕ\xf6.\xef8z\x86\xe3\xd3s\xac\xb6\x1bܨ\xef0\x8a\xe3}P\f)\xf1m\x878M\t,\x1b;\x8d(\x196\x12Hǡ\xb9m\a\x84a\xf6#\v\xd5\xf2\x95\xf5V\xe7\x9f\xed\xed\x1e\xb7ĩ\x97<Wh\xad\xde\x1e\x14\xaaw\x87a\x0f\x95\xa5\xbb\xbd0zt\xa1Z\xa6\xe4\xb9(\xa5t\x92\x84\xef^\\:?eZ\xae\xd5j\xae\x1f\x12B|\tě\x91\x9el\xee.S,V\x99\x98\x9c'\fG4\x1a\xdb\x14\x8b\x15J\xa5\x12Je\xc4IH\xe0\a\xf9(rå\xddj2\x18\x0e\xd8\xdeڢ\xdb\xed\x8c\x1b9\xf3)څB\x91b\xa9\xca\xd4\xd4\x1c'N\x9e\xe5\x81\a\xde\xc6\xc4\xc4$q\x1c\xb1\xbc|\x85յ\x15F\xfd\x16\xf5\xdd\x152Qȳ\xfe\xa0\x88\xe7\x15p\x1d\v\xcbvH\xd3\x18\x95\x85h\xa3\x84\xd2\x19\xc3$b\xbb\xdf·m\x0eצ9P\x9d\xc04\fFIL\x94\xa5\xa0\x05S\x85\"S\xa5\nU\xbf\xc8l\xa1L\xd5\x0f(\xfb\x01%\xd7ǵlLi\xe0 u'\x8d\x1e\xf6\x1d{dH\xe3\x89\xee(Է\xd2B\xae\x86\xa3\x1e\xa5\xf2\f\xf5\x9d\x1bo~\xf5\x96\x05\xacO\x17\xb2\x0f\xad\xdc|\xe6\xf4\x893o&\x8e\x86\xef\xdaڸt\x1a\x94\xd4f\x11\x9dJ^\xbe\xf0\x18w\xbd\xee\x9dLMͰ\xbb\xb3ų\xcf>\x89\x1007\xbbH\xbbSǲ\x1d\xa6\xa6\x161\xa4A\x9a&\x1c?q'Q\x12\xb1\xb5u\t)5\xe5R\x91r\xa5L\xa1XA\xeb\n\xbe_D)\xc5\xe6\xfaMvw6\x19\x85CZ\xed&\xbd\xf6\x06\xf5\x9deR\x9dwK\x95\xcbU\x0e\x1d<\x81\xe3x4\xeb\xab\bi\xa3U\xc2h<\xf5N\x19>\xa1NY\x1ddԯ]\xe0\xc4\xe4,\xef8v\x9e\x933\xf3L\x17\xcbl\xf6\xda\xdclչޮ\xb3P\xaa2_\xaaPp=\x06Q\xc40\n\xb1\f\x03\xdb\xf4)\xba\x1e5\xbf JC\x8f\x17\xda\xdb\xffG?\x89Z\xc0\xbf\xbeU\x16\x82\x10BF\xd1\xe0oON\x1d\x12\xed֦]\xf6\xbd\xdf\xed\x85\xd1\xfe\xa4\xe8\xb2\xef_\xee\xf7\x1a\xdfo۾\xb7t\xf8n\x86\xa3ae8h\t!=0|\xb2,\xa6\xb1}\x95\xda\xe4,\x93S\a\xe8\xf7;\xec\xeclQ,\x94(\x97k\x94\xca5\xb4&\x1f\xa6\x9c%\x14K\x15\x0e\x1d9Źs\xc79}\xfa\x04\a\x0f\x1dfvn\x9eJ\xa5B\xb1蒦\x82(LX_\xbbN\xa3\xb1\xcd\xd6\xd6\n+7\x9e\xe7\xe6\xb5g\xd1f\raX\x14\n%\x0e\x1d<A\xb9T\xc54,\xba\xed-ff\x0f\xa3\xd2\x10ax\xc4a\x13%|P!J\xba\xa4¡ٯ\xb3\xdan\xf0\xadw\xbe\x9e\xc0v0\x10,\x94\xab\xf8\xae˕\xe66\xab\xad\x06Y\x9aqtj\x8e\xa9b\x99\xeehH\xa6\xf2\xd9\xc1\x86\x94\x94\x1c\x8f)\xb7 \x06I\xfc\xb0aʗo\t \xbd0\xa2\xe4\xb9B\xa9\xec\x9b\xfcBe2\x1e\xf5\xc9T\xfax/\x8c\xaf\xecm[\x1b\xedn\xa7\xe4\xb9\xf5fc\xed[\xfd\xa0L\xb9\xb6\xc4\xee\xf6U\x90.\xa0\x11F@\x96)6\xd7^dbb\x92\x85\xf9\xa3\xf4\x87=\xb6\xb6\xd61M\x83r\xa5\x86\xebx\xc4q\xb4\x1f\xceNN-0\x1c\xc6X\xb65\xaeQi\xd2\x14\xba\x1dE\xb7;bu\xe52\x1bk7X]\xbeJ\xa3\xbe\u038b\xcf}\x0emN\"\x84I\xa1Pdq\xe10\xb5\xea\xd4\xf8l]I\xb7\xb3M\xb92C\x14\xf6YX8\xc2h4\xc40$\x99\xb6\xd0I\x1b%=\xb4\xb4\x19\f[\xbc\xb0\xb9\xccw\xdd\xf7&f\xcbU\xba\xc3!E\xcb\xe5\xd8\xc4\f\xbe\xebp\xadUg\xbb\xdb\xe2\xd8\xd4,S\xc52\xf5A\x97Tey\xff\"\xe0\x996\xb3A\xc9\xecD\xe1\xb7߲İ\xe4\xb9\x1d\x818\x1d\x8e\xba\xf7\x95*\xb3Ơߺя\x92?\x1f\v\xe8\xf6B\xe0gJ\x9e=\xdd\xed\xec\xdc'\xa4A\xaf\xd7\x03\x91\v\x9b\x05\x02a\xfa\x18V\xc0\xea\xcd\x17\x88\xa3\x0eK\a\x8f`\xd9>[\x9b\x1b4\x1b;\x04\x85\"\xb6\xe5\x82·`\xe6'\xe7x\xf4{\tI\x9c\xd0n\r\xd8Xk\xb1\xbd\xb5ō\xeb\x17X[\xbdF\xbd\xbe\xcd\xe6\xe6u.\xbc\xf0$\x91r\xb1,\x87b\xb1\xc4\xe2\xfc!&j\xd3؎I\x9ad\xa05\xbd\xee.\xa5\xf24\x83~\v\xcf+Q\xad\xcd\xd0j\xac\x11\x14k\x18f\x81,\xe9\x92b\x83\xb4\xe9\r\xdb<\xbdz\x95\xd7\x1d<\xc6\x1b\x8f\x9db\x14Gt\xc3\x11\x13~\x81C\xd5IFi\xccf\xa7\xc5be\x82\x99r\x99A\x14\xe5g/\x8e\xc3eKJ悊\xbce\xca\xc5\xf5V\x87\x92\xe7>\xa3T\xf67\xa5\x90\xa5,\x8b;E\xd7y\u007f/\x8c\xb2\xbdm\xad\x17F\x14=\xf7\xf3B\xa9\x9fc\xd0\x16\x91N\xd1F\x01tF\xa1P¶]L\xcb\xc5\xf5\xaa\f\x06=n^{\x06T\xc4\xdc\xfcA2\r[\x9b\xeb\xa4i\x9eG\xb8n> \xb9\xbe\xb3\xce\xee\xf6\x1a뫫\xacܼ\xc9\xfa\xea5VW\xae\xb2\xbd\xbdA\xb7\xdb\xe2\xda\xe5\xa7x\xe9\xc5/\x92\xe2\xe3\xfb%\x1c\xc7eq\xe1\x10ŠL\xb5V\xc40$\xa3QL\x14\xf5Pi\x86\x17\x94\x19\x0e۸^\t\xc30\t\x822q\x12#\xa4\x81\xe3\x96P\x99\"\xc9@K\x9bno\x97ǯ_²,\xde}\xf6\x1e\x94\xd6L\x96JT\x83\x00[\x18\xf4F#^\xdeZ\xe7`m\x8a\xe9b\x99ְ?&D!\xcdE\xde\xd9-۲\xc6\x1f\xfb%\xcf\xfdt\x92D?\x02\xc2\x16B|\xb0\x17F\xcdW\xffL\xc9s\xff\xb8d\xda'\x16m\x9fz\x9a\xa0e.Hs\x1dk|t\xb7\x81i\xd9\xd8n\x11ǭ\xd1\xe9\xd4Y\xbd\xf1,\xbe\x1f0;\xb7D\x9a\xa5\fG}Z\xad]vw\xd6h\xb5vi\xb5\xea4\x1a[4\x9b;\xb4\xda\rZ\xcd\x1dn\\{\x96g\xbf\xf4\tv\xeaM\x9c`:?\x98\xccq8\xb4t\x1c\xdb\xf6\xf0|\x9b\xa9\xe92\xcdf\x0f\xa5\xa0\xdd\xda\xe0ę3\x84\xa3\x844\x8dq\x9c\\#f\xd9.\xe5\xf2$\x83a\x17\xa52\\7@\b\x88\x92\f)\x04i:\xe0\x8b\xab78P\x9b\xe4pu\x92\xab[\x1bx\xb6á\xa9\x19\xa2$\xa1=\x1a\xb0Ҭslf.?\xa3wL\xf9gZ\x11gi|\xcbOi[ou6J\x9e\xfb\x02\xf0C\xc0\v%\xcf}f\x0f\x8c\xf9j\xe9_\x04\xa6\xfb=\a\xac\x80\x91\xce\xe8h\v-,L\xd3F\x92`\xbb%\xe6f\x17\x89\xa3\xbcUYJ\x03\xdb-\xe3\xf8S\xd4w\xd7Y]~\x89p\xd4\xcd{\x00\xb3\x948\x0e\tG9%\xd2\xe9\xd4Y_\xbdą\xe7>\xc3K/>\xcen}\x1b\xb78\x8f\xe3V0\f\x83R\xb1\u0081\x85#\xe3\xb3G$3\xb3\x15\x10\x82\xfan7\xf7\x1f\xddu\xce\xdeq'\xe5j\x81b\xa9B\x18\xa6\xf9\x88\xdb\xf1U,\x94i\xb6v\xf3ٍ\x8e\x87i\x9aDq\x8c\xa1\"\x84V\xd4\a=\xee_<F\xbd\xd7!\xcb2N\xcc\x1f\xa05\xe8\xe3Y6+\xcd]\f!9<9Cs\xd8'\xce2\xa4\x14dY\x96\xdeR\xd5ɫ4\xbe\u007f\xbaX\xab<\xac\xb5>\xbc\x17\xfa\xceV\x8a\u007f\xcb5\xed\x1fZ\xb2\x03$\x82n\x9a\xa1E\x90\xd3\a\x96\x85 \xa3X(Q\f\x8a\x04\xfeq\xd6W_ư\vd*%\x8ec\x82\xd2l~ROw\xc8\xce\xeeE\xd0\x19\xaf\f)դi\x94\x1fed\aT\xa6Na\x98\x0eh\x85\x1f\x14(\x97\xaa\x14\x83ҾX\xbbT\x0e\x98\x9e\x9df\xe5\xe6z\xbe\xa3\xab\f\xdf\xf3\x10\"\x9f\x0f91\x95O\xc2\xdb\xdal\xa2U\x861>\x1c\xa0T\xac\xd2鵐\x80㸨b\x8d\xb0\xd5Cjŵ\xfa6+\xbd\x16'\xe6\x16\xf1\x1d'\xef5Q\x1aSJ\x96jS4\x87}2\xad\x98)\x95\xb9\xb6\xbb\x8d!\xf3ѷ_\x91\x83%\xc7t\xc5gA7\xe6*\xc5\x1f\x05\ue5c6\xf9\xcds\x86oH\x04\x89V\xc4h\xf4\xf8\x89:\xb6\x03\nʥ\x12\xa3Q\x97 \xa8Q\xaeLb\x9a.~\x90\xaf\xcc\xdd\xfa\xf6\xb8\a\xc4ô\xfc\xbf \xfa\xf9\xf2K)\x85\xeb\xbaLM\xcea[\xce\xfe\xc9pZ\x83e\x9b\x1c<\xb4\x80\xeby\f\a9\xd3\x1c\xc7!\x93\x93\xb3\xf8^\x11\xd7s\xc7-\xdc>\x1b\xebuT\x96`\x98\xf9D\xa2je\x82^\xff\x15bP\x9a\x16\xdap\x11iD?\nyac\x857\xbf\xe9\x1dDY\xc2s7\xaf\xd3\x0fG\x98Ҡ\x16\x14Y\xef4\x88Ҙ\xaa_\xc01\x1b$Y\x86e\x18_\x19]\xd6B\xb5\xfc\x16\xe0Q)\f+p]|\f\x8a\xc2\xc07,,i\xd0\f\xbb\xf9\x14\x1f\xab\x98\x1fwd;\x04~>\x94\xa6\\\x9d'\f\xfbTk\x8b\xac-?O\xb18\xc1\xdc\xec\"\xae볳\xbb\x99\xcfN\xf9Kp읓+\t\xfc\x02\x95\xca$\xbe\x17\xbc\xd2\x115\xb6\f\xd348rt\x8e\x89\xa9I\xb66\xb6\x88\xe3\x04\x10\f\am\x8e\x1f?\x8fi9\x14\n%F\xa3>\x8ek\xe1\xb8\x16\xfd\xde\xf0\xcb\xf4\xbf\x85\xa0H\u007f\xd0\x1b\x8b%\x04\x99t1E\x0f\x81\xe2\x85\xcd\x15\x86I\x8c\x81\xc03\x1d\x04\x02K\n\x8a\x8e\x83)$\xed\xc1\x80\xb9J\x8d\x8a\x17\xb0\xd3\xefb\bi|E,DC\xe6\x18\xa6\xb5d\x17p\xb4\xc00L\xe6*5&\x8a%^\xaa\xaf\xd3\xea\x0eц\x9b\x0f\x9a1\xf23\xa5j\xd5\x19v\xb7.r\xe4\xd8Y\x1a\xf5\x1d\x94ʨM\x1d\xa4\xd9\\\xe5\xe8\x893\xf8\x05\x97r\xa9J\x18\x85D\xe1\x880\x1a\x91\xa9ܱZ\xd6^\x13\xa7\x97\xcbB\xc7g\x8a\xbcb\xad\x10\x14\x1c\xe6\x17'\x99\x9e\x99%IR\x1a\x8d&Y\x96\x03\x95\xa51\x95\xca$\x8e\xed`Z\xc6\xf8\x84\x1eM\xe0;t\xdb\xd9\xf8荜\x80\xacU\xa7\x18\x8e\x06c\xeeL\xa0\x85\x89\x126\x86J\xd9\xeeu\xe8\x86C&\xfc\"\x87gf\xe9\x86\x03:\xc3!\x81k1S\xae\xb2\xdb\xef\xe6\x80\x04\x01\x8dA\x0f\xa5\xb5u\xcb\x019P\xab\xd0O\x93\xcfK!\x9e\x8eTvO\xc1\xf6\xb9\xf7\xf01\x14\x9aǖ/\xb3\xd2i \x85 \x96. \xf6{\x0fm\xc7\xc5q\x02j\x93E:\xed-\xb2,\xc5q\x02\x06\xbd\x06\xcdz\x93\xf9\xc5\x19l۠\xbe\xa3\xb0L\x8bb\xb1\xfce\x0f|\xaf?\xfe\x95QK\xb9eh\xa5\x99\x98*1=S\xa5Z\x9b\xa0\\\xaep\xe3\xe6\r\x06\xbd\xe1x\x1bӸ^~\xb0\x8b\xe7\xf90\x9e\xe9\x05bL\xb9\xe7\xbf\xc3\xf3l\\ߦ\xdd\x14LOα\xb9\xb3\x96\x1f\x88, \x95\x1e\x8e\x1a\xd2\x18\xf6\xc8\xf2\xb9/H!\xf8\xffk;\x93\x1e;\xae2\f?\xa7Ω\xe1֝\xbbo\x0f\xee\xe1v\xba\xedv{\xe8\xce`b\x1c\x04V\x1c\b\xa3\x84@\"b\xc1\x86\x05\xe47\xb0f\xcd/`\v+\x16(;XD\xf2\"\b!\v\xb0\x8d\xad\xc8A\x89<\xb5{\xbc}籦S,N\xf5\x8d\r+\x13e[\xcb:\xe3w\xbe\xf7}\x9f\x8b\xcbu\x86A\x80 \xe5\xa0\xdbf\x1c\x86\x8c\xc2\x10\xdfvp\x94b\x12E_\xae\xea\xe4닫\xec\xb6:\x14\x95\xfd\xd3\x19\xe9^Y\xf4K\xfc\xf0\x8d\xafbI\xc9\a\x0f\xfeɳn\x13G*R,4*K\r\xf2Q\xcaA\b\x98\x99[\xa5\xdb>\xe2\xe2\xce\xeb\x1c\x1f\xfc\x1b\xa5lJ\xe5y\x8e\x0e\x1e\xd3ht)\x14}V\xd6jHe\x11\xc7\tq\x9cL\xa9n\xcfg\xc1\x9f\x86\xf7\xfby\x8f\xb3\x9bK\x9c\xdd\\cy\xa5N\xad\xb6`<!\xdd.a\x98\x99st\x9c\x89\xe8\\\x83\xbd\xd0\xda\x18A\x05\x04\x13\xa3\xffUʢ\xbe\xbe@\xb1裵\xa6\\\x9e\xc5u\xbci<\xb3\x16\x92T\b\x86a@\u007f<FZ\x16́ɬ\x9f+\x959궑B\xa0\xa4\xa45\xec\xe3(\x1b\xcf6ͯ/e\x85|{y\x83\x0f\xf7\x1e\xf2\xd7\xc3]ޫ_\xf0>\x9dt~\xbbY\x99\xe7G\xaf^e\xaf\xdf\xe6\xf7\xb7?B)żW\xa0\x1f\a\x84\x19x\xdb\xf3|\xa4\xb4\xb0\x95\x82\x14\xaa3\xb3t;O\xb8\xb8}\x8d7\xdfz\x9b;\xff\xb8\xc5\xe2\xd2y\x1cקq\xb8\x8b\x92\x8a\xa5\x95\x196\xb7V\x88\xe3\x84(ԄAH\x10F\xc4&4\xde\b\x0e\\E\xb1\x94\xa7X*P\xab-P*\x96\x89\xb5&\x98\x8ci4\x8e\t\x82\x980JNE\xe1H)\xb1\x1d\x0f\xa5,ƓL\xf5.`21Eh\xa1\xe8g\x98\r1\xb50TJ3\f\x87ƟHj\xe2\x9dRB\xba\xa3\x01yo\x8d0\x8e8\xec\x18\x15\xfe\xd3\xc61\xbe㒳\x1d:\xc3\x01\xf5\x999\\ec\t닿\xf6\ueb3e\xc2\xfd\xdd\xc7/T\xe8\x1f\xee=\x04\xe0\x97\xe7^\xcb=\xe8\xb7~\xb3T\xac\xf8\xef]y\x8b\xe6d\xc8\xefn\xff\x85b\xce\xe7\xfa\xfa\x05\x1e\xee\xeds\x12\x8e@H\xc0\xf8ד\xc4p\fOsN\xbc\\\x9e\xf1x\xc8\xfa\xc6Y\xfa\xbd\x0eO\x1e?\xa56W\xa7q\xfc\x88\xc6\xd1>\xb6-\x99_\xac\xe086\xab\xf5e\x94\x94\xa4\xa4\x04\x931A`hl\x8e\xed\xe2z9ʥ*\xae\xe7\x19w\xefx\xc4\xf1\xd1>q\x1c3\x1c\x8c3\xe6:$I\x84\xef\xbb(\xa5\f\x82/4=\x9d$I\t\x831J9\xb8\x9e\xfd\x82\x80;MSJ\xa5\n\xcf\x0e\x9ed\xdbb\x82\x9b\x895\n9\x1f\xdf\xf5H\xb5\xa65\xe8Sps&)\x0f(y9\x0ez\x1db\x9d\x90w\\Zb \xbeЖuy\xb5\xce\xfd\xdd\xc7\\;wi\xed\xbf\x9e\u05f9\xb2T\xffڽ~\xf3O\xb6c\xff\xe2g_\xf9\x06\xf9\x9c\xcf\x1f\xee\xfe\r\x9d\xa6\xfc\xea[?\xe6ݭW\x89tL\x92Y\x90-\xa9\xa6\xaaD\x99\xe54FaB\xbeP\xa2\xdd<&N\x126/\\bf\xb6L\xbf\xd7dv\xb6\x8e\x94\x92v\xb3C\xf3\xa4K\x92\xc4t;-\x1cףR\xa9\xb1\xb4\xb4F}\xed\x1c\xeb\xeb\xe7Y\xado0W[\xc0v]\xe28Fk\xcdI\xe3\x90\xc9d\xcch8ɮ\xbb\xa6\x1d\xdb\xeb\x1e3\xb7\xb0j\xa8riJ\x10\x84 \xcc9\x12\x85\x01\xf2\xf9\xbe\xba8\x05l\x99\x1e\x9c\xb2d&%J\b\x92\x88\x9c\xe3\xe2*#\f\x0f\xe3\x980\x8a\x91R\x18\x90&P\xf1\xf3\x90\xa6L\xa2\x10\u05f6\xb1,\xf1\xff\x9f!\xcb\xd52\x1f\xef>\xe5\xea\xe6\x85\xe5\xbd\xe6ރ\xe5j\xf9\xfer\xb5\xfc\xcer\xb5|{\xb9Z\x1e\x85Q\xf0GW\xc9\x1b?\xbf\xf6\xb6wqi\x95\x0f\xeeޢ1\xe8\xf1\xfd\xcd\x1dJ\xd2a\x10\x04\x8c2\xa8p\x8a0@AĴ}z\xaa(\xb4\xa4\xcdp\u061d\x82\x87w\xdex\x930h\x13\x84#\xbc\\\x19K*Z'=\x8e\x8f\xba\f\x06}\x1e~\xf6\t\xadf\x83\xd4\xc8<?\xa7\xbbe\x12Q!\xa0\xdd>\xc9\xcc@\x9aN{\x80\x9eV\xe0\x82`\xd2c~~\x19\xa5T\x96{\x12f\x00\x9a\x8c$g\xa9i\rcY\x16\x9fOi\xf3Mk\x8dEJ\xac\x13\xe6\xfc\"\xb6%\t\xa3\x98I\x18d7=\vW٤\x80\x9bM\xc2 \x8a\xf0\x94\x8d\xf3\xb2uȩ\no\xafݝ\xae\x86g\x8d\xfd\xf7\xcb\xcau\xf2\xd2\xde\xeeē\x9b\x13\x9d\xb0\xe6\x96p\xa5\xca\xdd\xd8\xda\xe6\u0099\x15n>\xb8ǝ\xfdG|\xe7\xece\xceϞa\x14\x05t\x83\x11c\x1dM\u007f\x841\u0600\xd6d\xb1zY\xce/\x920\x1c\xd0\xef\xb7\xc9\xe7KH4\u05ff\xf9=>\xba\xf9gb\xb7\x8a\x9f\xaf\x00\x82f\xa3G\x12%\xccԊ\xec>}\xc8\xc9\xc9\x11\xf9|\x01\xc75\xb9\x8fQ\x1c\x91\xf7\x8b\x04\xc1\x98\xfd\x83]\x04\xd0\xeb\x0e\x19\x8f\xc2\xe9\xf6\x18G\x13\xbc\\\x81J\xb9\x82\x10\x10\x86\x91\x91\xb8\n\x8b(\x8a\xa7rWS\xaf\xa4\x19\x8d\xda\xca\xe00\x18\xc0\x18 \b\xd1\xc0z\xb5\xc6֙\x15\x93\xa4\x97\nl\xa5荇\xccW\xaa\xec\x9e\x1c\xa3-\v[Jb\xadq\x94\xc2S\xce\xcb\r\xc8\xe9 ,U\xcb\xdb)\xe9\x0f\xd24]\x02~2g\xfbҳ$\xb6\xb0\x18&\x11\xbeT\xac/\x9cag\xa9\xce'{\xbb\xdcz\xf2)\xb5\\\x91s3\xf3Ƈ\xa15\xe3 @gJA0\xa1\x98\xffc\xae\x11`I\x8f\xf1p\x8f0\x9c`ێyn\xb7c\xae\xdf\xf8.\xff\xba\xf3w\xba\xed#*3\x8b@J\xa73d4\x0e(\x95r\x14\x8aF\xd7u\x8aX\x95RR(\f\r\xa1M\xa7DQB\xa7=x\xce\xc0cq\xd8x\xcc\xc6\xc6e\U00085f39$D\xe1t\x85M\xc6!J\xda\b\x01\xfdޘ\xb9\x85\xca)F&\xf3\x9bh\xba\xdd\x16\x96\x00+\xd5(!ywk\x87\xd9B\x11,\v\x9dj|\xc7\xe5\xb8\xd7ac\xe1\f\bA\xa25R\x18\xfe\xa2%,\xaa~\xe1\xa5W\x88\a\xfc\xda\x12\xe2}_\xd8\x05_\xdaZ\tKv\x93I2\xd62r-%\xe6m_I\xdb\x16ۋ+\xa25艻\xfbO\xd2V\xbf/^\xa9\xd6b\xdfq\xf5$\x8e\x9d8Ih\r\aQ6ɔQ\x13\xa4\xd1\xd4L\x93ꌙ\va\x10#,\xcbJ\x928\r\xc3I*\x849_\x94-y\xfd\xcaU>\xbe\u007f\x8f\xe6\xc9393\xbb\x92@J\x14\xc64\x9b}:\xed!\x9e\xe7P[([\x9e\xe7\x00\xe8\xc1`\xea\"\x10\xed\xf6\x800\x88^(\xf0'\xa3\xbe\xbc\xb4\xfdZ\xa2ub\xc2m\x92\x04+\x9b(\x83\xfe\b/\x97\x17iJ\x9a$\xdap\x133\x1d\xb1\x10\x16\x87GO\b\xc2\x10\u05ca\x84HS\xaa~\x9e\xad\xea|\x1a\xc41\x9f\x1d<c\x14\x04\x14\xfd\xbc(\xe7\v\ue8e3C\xe3\xfa\xcdF\xd3\xf0NS*\xbe\xcf\u007f\x00t%>\x9b\xa4 ?$\x00\x00\x00\x00IEND\xaeB`\x82")
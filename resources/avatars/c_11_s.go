@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_11_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14 -풁`\x00\x00 \x00IDATx\xda\xec\xbdw\x9cd\xc9U\xe7\xfb\x8d\x88\xeb\xd3g\x967\xed\xcdtOOO\x8f\x97\x99\x91\xc40\x92\x90\x90\x90\x05\xf1\x04\v\xbbˇ\x85\xe5\x81\xf0\xe2\x01³\xf0\xc4\xdb]\xa4\a,\x1e!\x16#\x90VHB\x06yi\xbc\xeb\x99\xd1\xd8\xf6\xae\xaa\xab\xba|U\xfa\xbc&\xe2\xfdqoeWu\x8f\x15\xf0$\xd0\xdeO移+\xb3nƍ\x13q\xcc\xef\xfc\xce\t11X\xfb\xb9=y\xef\xbf|\xd7d\xb5\xeb)\xc9\xd3]\x8f\xac\xb6\xac\u007f\x98]\x93\x8dD\xff\xae6\xe6\xa7g\x17\x97\xc3\xc9\xc1\x1aS\vK\x97}v\xff\xc8\xe0\x9ft\xb4\xfe\xbeo\x1e,\xf4\xae\xab\xe4L\xc1\xb6\xa8\xfa\x0e\x15\xd7\xc1\xb7,\xbe\xb2ڴ~\xf1+g\xbb\x91\xd6\xef\x98Y\\~\xdf3\xdd\xe7\x1b\xf5R\xc5 x`.\x8cn6\xb0늂g\x19\xb0\xb8\xe4\xb5%p\xe5\xee\x82'\x16{\xf1M\xcba|S>\xf0\xef9\xbf\xb8\xbc29X\xa3\xde\xee\x00\xf0\xe2-#L\xaf5Yh\xb6?6\x98\xcf\u007f\xe7|/\x1e\x19vm\xab\xeaX\x96\x10\xc2*{\x8e\xe5(im\xcf{r\xc4w\x9d\a\x96\x1a\xb7\xb8\x9e\xf7ٙ\xc5\xe5\v\x1b\xef\xf3\r/\x90z\xbb\x13\x17\x03\xff\xf0\xb9v\xf4f_\xc9\xfc\x9e\xbcGl̦\x0f%\x06\x8a\xb6\xe2\xcab\x80\x81\x9d\xe7:\xe1\xebK\xb9\xe0\uea45\xa5\x99m\x835\n\xb9\x80'f\x17\xb8e\xeb(\xe7֚l-\x17>W\x8f\xf5\x0f\v oIʶ\xc2U\x16\x05\xc7\x06\x04\xbb\v>\xb6\x12\xfe#\xcb\xcdWV\xf2\xc1\xc7\xcf-,\xad\xfco\xa1d\x02\x01h\xb4;\xf3\xe5\\0\u007f\xac\xd9}\xe3\x84o\x8b!\xd7F_\xf2A\x03XB\xb0\xaf\xe0c\t*Ǜ\xbd\u007f_\xce\x05Kg\x17\x96\x1eX\x9f\xc8skM\x00vV\v˱\xa1>Ս^=\xe6\xdb\b#(ؒ\x92g\xe3H\t\x02\xae\xad\x16H\x8c\xa9<\xb8\xd8|{%\x1f|`ja\xa9\xfe\xbf\x85\x92\t$\xd3㏖r\xc1\x8eS\xad\xde\xd5\xdb\x02\x87\xbc\xad\x90\x80\x10\x9b\u007fA\x03W\x14<v\xe4=u\xac\xd9\xfdV\xc7s\x97n\x1e\xad\x1c>\xbe\xd207L\f3So1\xb5\xd6dw\xb5x\xac\x1d뛧;\xe1\xe4\xf6\x9cC\x94hj\x9eCα\xd2\xfb\x18\xc3K\x87J\xb4\x13\x9d{b\xb5\xf5*\xd7\xf7\xee\x9e]\\\xbe\xb0>\x9eoT\xc1(\x80R.\xa0\xde\xeeP\xce\a\x1fm\xc7f\xc7\\/\xbczص\xc9Y\x8a\x82m\xa31l\xd4b\xb1\x81\xaa\xa3ؙ\xf38\xd3\x0e_s\xb6\x15\x8d\xbcm\xdb\xd0\x17?v\xf2|\x04p\xc5\xe8\x10\x0f\x9f\x9f\xeb\xec(\x17\x8e\xadD\xc9w\xb7b\xad\x86]E;J\xd8Z\f\x90\x99\x94#m\xb8a\xa0\x88\xa3\xc4Г\xab\xed\xd7\a\xbe\xbf\xd0hw\x1e\xad\xb7;߰BQ\x00\xeb\x130\xb5\xb0D1\b\xbe\xb8\x1a\xe9\x83\xcdD\xef\xae\xda\nKJJ\xae\x83\x00\x92\rR1@\xd1R\x1c(\x06\xe2x\xab{\xfd\xe1\xb5\xf6[\xae\x1c,=:\xbd\xd6<\xbb\xf0\xef\xae\xe5W\x1e<\xc7l\xa3un{\xa5؛\xedF\xaf\xf4-\x89-\xa0\x1b%\xec,\xe7\xfa\xf72\xc0U\x95\x1c\x15\xd7.\u07bdP\u007fM9\x17\x1c,\xe7\x82ON-,E߰;d](\x99=\xe9Vr\xc1\x17f{\xd1\x1b\x13M\u0557\xe0HAŷQR\xa0\xcd\xe6\xdd\xe2H\xc1Kky\x1a\xb1\x19x\xaa\xde\xfd\x0f\xe3\xa5\xfc\x8e\xdf=\xb2\xf8\xf9\x0f\xbfhw\xf8\xbeSs\xcc5Zwo)\x15\xb6Mw\xa3C\xe3\xbeM3\x8a\x11\xc0h\xcec\xa3\xebp\xa0\x1cp\xd3@\xc1\xfa\xfc\xecڕ\xa11\xb7\x16s\xc1\xa7K\xb9\xa0\xfe\x8d\xb6[ԥ?\xc8vJ\xb3\x9a\v>9ۍ^\xd23\x8cٙ\xca*\xba69\xc7B\n\x81\xd6\x06\r\x88̮\\Y\xf4\xa9:\x16\xe7:\xe1\xd5\xcd8\xf9\x81\xcf,\xb6f^1Qz\xea\xc9ņ\xbemr\xfcc\xe7ڝ\x17-\x86\xf1\xaem\x81\xc3b7\xc4U\x8aA\xdf\xe9\v%10\xec9\xdc:R\xe6L\xab79\xdd\xee\xbdM\b\xb1X\xce\x05G\xa7\x16\x96\xe2o\x14\xa1\\&\x90\r\xeak\xa5\x9c\v>\xb6\x14\xc6W,\xc7ɞ\x9a%Y\tS-2\xec\xbbXR\xa21\xc4\xda 2\xd53\xee;\xec/\xf8\b\x84\xffT\xa3\xf3\xa6Ş\xde\xfd֭ßz\xffS'\xa3\xdd\xd5\xe2\x1d\va\xf2\xfa\xa5(\xae\xee\xce9L\xb7\xba\xd8R2\x9ewI\xccE\x87\xa1\xe8X\xdc2T\"g\xa9\xe2=\v\xf57I\xc1\xeej>\xf7\xe9s\vK\xe17\x82P\xc43\xbd\xb1e\xb0ƹ\x85%^\xbce$7\u05cb?\x9cW\xf2U\xdf6Z\x02`\xd0s84P\xa4\x17't\xe2\x84f\x18_\xe6\"OwB>0\xb5\xccZ\x94\xd4\xc7<\xeb\x9b\xef:w\xe1\xc1\x9b\xb7\x8e\xee\x9a\xe9FG\xf6\x15<uC9H\x8d\xfaP\x99C\x83E\"m.\x1b\xd8\xe3\xab-\xdey\xf8\x14\xad8yB\n\xf1\xfa\xa9\x85\xa5ӓ\x835\x80\u007f\xb3ѽ\xd82X{}lX\x03\x9a@\x03A$\xa0\x9b\xbd:S\vK=s\xc7;Ů\xb7\xfeٽEK\xdd\xf8\xaa\xa1\x02\x96\x00G)n\x1c,\x91\xb3\x15\xed8a\xb5\x1b\x91\xe8\x8bы\x14\xa9\xbd\xf9ą5\x1eZi\x11\x1b\xf3\xde\xc4\xf0\x9bC\xae5\xb4\x18\xc6\xf7\xdeX\t\x82\xdd9\x97\x18\xb8i\xb0̾Z\xe1\xb2\xd5!\x85`\xaa\xd5\xe57\x1f\x9b⩵\x16\x891?\xaf\xa4\xf8\xbds\xf3Kk\x1b\xd4\xeb\xbf-\x81\x1c\x1a\xa9\x99\x04\xd0\x064\"\x8e\rk1f.\xd4\xcci\x98\x11p\xc6\xc0\xa3E[Ά\x89\xf9\xadAW\xbd\xe8\x9b\x06\n82\x9d\xbe=\xe5<{\xca9\x94\x10\\huY놛V\xb9#%\xa7Z]\xee\\j\xf2\xe8Z\xe7\x941\xe67\n\xb6:\xd7K\xf4_\xbfb ?0\xe6\xd9\x18!\xb8f\xa0ĕ\xd5\xc2eq\x8f\x12\x82v\x9c\xf0\xe1s\x8b|\xe8\xec\x02\xe7;\xe1\xfd\xbe\x92\xef\x9c^X\xfa\xf2\xbfE\xa1\x88\xd7M\x0et\fx\x060&\xd5\xe3\x06H\x80\xae\x81\xae\x86\x966ݎf-\x06\x89ap2\xb0\xf9\x96\xa1B_\xf7\xfb\x96\xe2ځ\x12\xdbJ\x01K\x9d\x90\xe9z\x9b(I\x10\xd9\xec\x8a\xcch\x9flu\xf9\xabsKQW\xeb\xdb-!?+\x85xכƊ\xf9@J\x8c\x80\xbd\xe5\x02\xd7\x0f\x96\x91\x12dfS֭\xbe\xc1p\xae\xd9\xe3ON\\\xe0Ks\xab\r[\x88?\x9f^\\zǿ5\xa1\x88\xd7M\x0et\x00\xef\xf9\x18\x99\x86\x86\xa9\xc8\x10\x1a\x18r-^;\\\xdc\x14\xc1\xef*\x06\\;XB\n\xc1ٵ6\xab\xbdp\xd3\xef\xaf\v毧\x96x\xaa\xd1]ИVٲ\xb6\xbda\xb4\x98E\xef0\x9as\xb9q\xa8Bζp\xa4\xc8 \x1c\x836\xa9p,)\xb8k~\x8d\xdf>2\xcdJ/>\xae\xe1m\xc0#\xd3\vK\xe6߂}Q{K\xc1\xbbD\x8a\xea>\xe7%\x815\x9dN~3\xd6,\x87\t\x93\x81\x8d\x14\x02\x01\xccwB\xe6:=j\xbe\xcbx>\x8d3:q\xb2)\xde\x10\x02\x0e\x95\x03\x8a\x96\xca-t\xe3\xf2|\x18\xd1J\f\x93\x81\x83\x14\xd0\bcf\xdb=\x94\x148JaKAߍ\xcb\x04\xba-\xef\xf1\xa6\xc9A\x84\x10\xb5\x99v\xef?\xae\x84qT\xcd\xe5NO/.5\xea\xed\x0e;\x87\aYi\xb5\xffu\n$\xc8\xf9\xbf.\x11\xb8\"\x9dp\xf3l\x02\x11\xe0\b\xc1Z\x92\xfe\u007f-NX\x8b\x13\xb6\x05.23\xc2\xed8a\xba\xd9\xc5V\x92\xed\xa5\x1c\x96\x94\xc4@\xac\r\x86\x8bs;\x198\xec-xXR\xf0x\xbdC#\xd6l\xcb9\b\x04\xddDs\xa1\xd5e\xa5\x97\xba\xd9\x05\xc7Fm\x18\x9b6\xe9}\xae\xa9湮VP\x1an{l\xadyk)\bV\x9b\x9d\xce\x13+\xad6\xbbG\x06Yn\xfe\xeb\x13\x8a\xb2\xfd \xbf\x9ap\xd5b\x82\x1b#\xc8g\xfa\xfb\x99.O\x80+\x04\xab:\x9d\x94\xb5Hs\xb6\x1d\xb2\xa7\xe0e`\xa4 1\x86\xd9V\x97V\x940\x99\xf7q\xa4\xc4R\n!E\n\xed\xa7\u007f\xf0\x95dW\xcecg\xde\xe5\xa1\xd5\x16O5{\xec\b\\l)0@+\x8a9\xdf\xec\xb2\xd4\r\x19\xf0\x1d<KmZ0\x1a(;\x167\r\x14\xb8\xaeV\x18yh\xb9\xf9f\xdf\xf3\xae\xdbS\xab\xfc\xddc3sf\xe7\xf0\xc0\xbf\xba\x9d\xa2\xea\xed\xceg\xd7ڝ\xdf,\x04\xc1]\rͶ\xf9\x98rl\xf0\\)X\xd7\x16\x97^9\t\x89\x1043/\xb7\xa7\r\xa7\xdb\x11[\x02\xbb\xef}\x01,tC\xce7\xbbl-\xfa\xf8\xb6B\x00\x96\x92hcH\xb2\xb8C\b\xa896\aK>'[=\x1eXi3\xe0X䭋ˢ\x1eF\x9c\xa8\xb7Q\"E\v\xacK\\1!\x04\xe3\x81\xcbk\xc7k\xe2l\xab\xbbw\xaa\xd3\xfb\xbf\\\xd7}\xd2U\xf2\xe4R\xb3\x9d\xec\x19\x19d\xe9_\xc9nQ\xeb\xd1o\xbd\xdd9\xd5hw\xfe\xbc\x98\v>\xd7дV4C=#\xaa\x06pdjd\xccF`QB\x04\xb4\xfbB\xd1\xcct#\x06\\\x9bB\xb6\x92\xa5\x10\x84\x89\xe6t\xa3MѶ\x18\b\xdc\xec\xe7\x12) Nt\xff~\xbe\x92\x1c*\x05h\xe0\xee\xe5\x16=m\x18rmR\xb9\xa4\x02\x98nv\x99m\xf7@\bʮ\x8d-\xe5\xa61\xb9Jp\xdbh\x85a϶\x96{\xc9\xdbηë\x86\n\xb9#\xc7\xe7\x16\xe7\xfe\xb5\xc0\xfaj}\x80\x1b\x043\xb3\xab\x14|\xba\xa5\xf9p\xc7p\xa2\xa19\xb0\x96P\xd6\"Ug\x1b\xd7f^\nZ\x1a\xa2lV:\x89\xe1\\7\xa4bIj\xaeEb\xd2\x1d`\x80\xd9v\x97\xc0\xb6\xa8yN\xe6\xc4\n\x1cK\x11k\x83\xd6\x1a\x83@\n\xc1\xb6\x9cˤ\xef\xf0\xc0J\x9bc\xad\x1e㞍\xafd_\xc0\xddXs\xa1\xdde\xa6\xdd#\xb0,*\xce\xc5d\x9a\xc9\xec\xcb\xf6\x9cǋ\x06\x8bx\x96\xbc\xe2\xf0R\xf3-\xc3\xc5|\xbc\xdclߛ\x1a\xfc\xafo5v\x19\xda\vp\xa1١\x94\v\xea\xd3\vK\x0f\x16s\xc1\xef%0\xd7мd1\xc1\xb3e\xea\x00\x88\xec\x97]!XI.zP\x1a8\xd1\nI\x12͈\xefl\xfa\xb2\xf3\xad\x0e%g=I\x95\x9ax\xd7R()7\x19\xfd\x9acqu9`\xaa\x15r\xf7r\x8b\x01\xf7\xa2\n[\xd7V\xdd8\xe1t\xa3\xcdZ\x183\x1axi\x8eE\xa4\xa26\x06\\)\xb9\xb6\x9a\xe7\x9aj>w\xe7B\xfdծ\xe7]3\\\xcc}\xea\xc4\xdcb\xef\xeby\xb7\xa8gzc\x03Ȩ\xeb\xed\xce\x03\x03\xb9\xe0\xdd\x11t\x96c\x86ZF\fX -\x99ٓ,FY\xf7\xa0\xa4\x80\x99nL\x98hF=\x1b\x95͢\x14\x82\xf3\xadN\x8a\x1a[V6\x81\xa9]q,\t&\x85[4\xe0(\xc1\x81\x92\x8f\xd1p\xc7R\x13O\tʶ\xc5\x06\x13\x85\x00\x96\xba!g\x1a-|K\x91\xb3$j\xc3\x1e\xd6\x06F}\x877LԘ\xe9\x84W\x9ci\xf5^[\b\xfc\xfb\xea\xed΅\xafWX_=ۛ\x1b\a\xbb\x92\xaa\xb3\xbb\x8cg\xff1\xc8\xfbV5~G\xb3\xcf\x12B\f)A\xd7@\xdb\\\x14\x8a\x10\x82\xc50\xa6\x1e'l\t\x1cT\xe69\t!X\xe8\x848JRt\xed\xbeǥ\x84\xc0\xb6\x15V\xe6I$\x19\xb6\xbf5\xe7R\xb0$\xf7,\xb7\x98\xebŌy\x9b\x8d\xba\x14\x82\xc8\x18\xce6\xda,\xf7\"\xaa\xaeC\xb0\xc1\x1b\xd3ٽ_:T\xa2d[Ç\x97\x9bo\xc9\a\xfeL\xbd\xddy\xec\xebq\x87\x88۶\x8f\xf1\xb9\xd33\x9b~\xf8\x03\a\xb6\x95϶\xa3C+Q\xbc\xad\xadM1Ҧ\x92\x18\xecH\x9b\xbc1H\x83\t\x12c\\\x107\t\xc1\uea82\x9c\x14\xcc\xc4YD}It^\xb3%\xaf\x1e.\xe2l0\xc2\x00\xbbJ9\xb6\x16\x83Ԏl\x80H\xe2D\x13\x1bC/Nw\x99цS\xad.\x9f\x9ek\xd0I4\xaf\x1a.\xe0\x88\xcb\xfd?\x03\xd8R\xf2\xe2\x91*[rަ\f\xe7\xfaX\x8e\xd4\xdb\xfc\xc4\xe1S\xadH\xeb\x9f>\xbf\xb8\xfc\xfb/\xd92\xca\xdd\xe7f\xbf~\x04r`t0\x0f\f()_\xd7M\xf4\x8bl\xc9\x1bCM\xced\x91\xb9%\x04\x9e\x12\xb8RbK\x10\b\x1c\x99\x1a`c҉l'\t\x9dD\xd3I\f\xb11$\x06\x12L\x1f\xeb\x92@\xd5V\xbcb0O\xc1\xba\xb8)cc\xd8Qȱ\xb3\x14\xa4\xf8\xd9z^\xc4h\x8c\x81\xd8hbm\xe8\x861\x1a\xc3\\'\xe2c\xb3\xab,G1\xaf\x1e,\xe2+\xf1\xb4\x81lb\f7\x8dT\xd9Q\b.\x8b\xa9\xa4\x80\x93\x8d.?\xf7\xc8i\xba\xb1~\xdb\xfd\xaf\xdc\xf7\xc1\xf2_\xden\xbeu\xd7\x04\x9f81\xfd\xb5\x17\xc8\x15#\x83w\xd4<\xfb梥\xc8+\xc1\x84\xef0\xe8\xd9\x14\x95$\xb0\x14\x9e\x14}Lɘ\xf4ac\xb3>\xf1\xd9\xe4\x1b\x836\x86Nb\xa8G1kQ\xc2B/f1\x8ciĚN\xa2i\xc6\x1a%\xe1\x9aR\xc0\x16\xdf&o\xa94\x1e1\x86\xa1\xc0cW)\x87\xa7d&\x94\xd4\v\xd3F\x93\xe84f\xe9\xc61\xb1֬\x851\xf7.5\x99\xe9E\x1c*\xfa\x04J>#\xba\xb0\xab\x9c\xe7\xcaJ\x81\xc0\x92\x9b\xd2\xceJ\b\x1eZn\xf2\xee'\xa6\x88u\xf2\xa3O\xcc.\xfc\xbf\x00ߺk\x92O\x9c\x98\xfa\xda\n\xe4=/;\x98\\?P\x94Uۢ\x1b'\xccw\xba\xb4\xe2$Mњtj\xb4!{ \xb3\x19t\x14\x1btE\xa6\xaf\u05cd\xb2ц\x9e6\xac\xc51\xf5(a-L\x98\xebŜ\xe9\xf4hƚ-\xbe\xc3\xfe\x82G\xd9V\x18\f\x8eR\xec\xaf\x15);\x16\xb1N\x05%\xd6\xefg њ^\x92\x10&\x9af\x98p\xac\xd1\xe1D\xabǁ\xa2\xf7\x8cY6\x03\x94]\x9b[\xc6\x06(f\v`\xa3P\x1e]m\xf1\x93\x87O\x9a\xb2\xad~\xf5\xb1\x99\xf9_\xfe\xbaPYۇ\x06\xb4\xab\xa4\xd8]\xf49T\xceqC-Oٶ\x88\xb4&҆^\x92d\x93bH4\x19\xc9\xc1\\\fȌy\xee/ɼ\xa9$\x13R=JX\xecE\x9ck\x87\\\xe8E\xe4-Ů\x9cC\xa0${\xcay&\xf3>B\n\xc2\f\x98\\\u007fE\xb1\xa6\xab\x13\xa28\xa1\x1dk\x1e^iю\x13&|\xfbY\xbf\xdfQ\x92[\xc6\x06\x18\xf2\xecM;Ŗ\x82\xbf;\xbb\xc0\xef\x1e\x9d\t'|\xe7\xed\x0f<\xf2\xd3\xffK\f\xfc\xe4\xd7V c\x03Ղ%ĵ]\xado4\x86\xdb\x12ö!\xdf.^Q\b\x06\x0e\x96\x03kO\xc1\xa7h+rJ\x12\x1bM\xa8MFpH\x85d\xf4z\x0e%\xf5\xff\xd3Ux\x11\xafz:8_\x00R\xa6\bq\xa4\r\v\xbd\x88\xc7\xeb\x1d\xd6™\x92\xec,\x04\xec,\xe5\x18\x0f\x1cbm\xe8e\x99\xc8DkB\xad\xe9\xc5\x1acR\xf5\xf8\xe0R\x93\x9a\xa3.\x83S.\xdd)9K\xf1\xd2\xd1\x01\xaa\xae\xb59% \x04\xbf\xf6\xe8Y\x1e\\\xaa\xb7j\xae\xfd\xa2{\xcf\xcd>\xfe\xb54\xf4\x97=\xc5\xf6\xe1\x81J/\xd1\xdbcm\xf6G\xc6\\?\xe4ڷn\xcdyW\xed(x\xec)\xf8\\Q\xf0\xc9Y\x92n\x92\xa4ypsQ\x18f]0\xebQ\xb36}\x95\x97\n\xef\xe2{\xe2\x12C\xab\x84 ц\x99n\xc4\\/\xa2\x9b\x18<\xdb\xe2\x9aZ\x81\x1d9\x8fV\x1c\xd3Ն(I\xe8D\t\x1aM\x9chZ\x91\xa6\x9dhV\xc2\xe8\x99\t\x02\x99\xea+\xbb\x0e/\x1e\xa9Rq6g\x1b\x9aq»\x1e9\xc3\xe9fgfO\xde{\xd5'ON?q\xf3\xd6Q\xee<;\xfb\xb5\x13\xc8\xd3eݶ\x0e\xd7T\x9cP\x8a\x8c\xdeZ\xb0\xd4[-)\xbeݓr\xf7\xa1j\x9e7N\xd4\xd8U\xf0Y\vS\xd746\xba\xaf\xef\xf5\x06\x12\x1c\xc6`\x8c \xc9<\xa7\xd4\vҙ\xabk\x9eq@\xa16t\x13\xcd\xd9N\xc4r\xac\xf9\xe6\xd12;r\x1es\xdd\x1e\x8d^D/\xc9ԙ\x06#\xa0\x13\xc7\xccv\x9e](\xc6@\xceV\xdc:1H~\x83\xb7'$,t#~\xfc\xc1\x93\xac\x86\xf1\xb1kJ\xfe7\xff\xafcS\xd3_\x17;\xe4\xd2k\xebP\x8d\xb3\xf3\x17\x05\xb5gd\xe0\xcanb~9\xd4\xe65W\x95\x03\xef-\x93\x03j[\xde÷R.\xf0\xbaPLf\xdcYWa\x19\x97+Қd\xc3\xfbq\x92\xa4^\x9a6O\xab\xe2\xa4H\x83\xbf\x87V;\xacƚo\x19\xabPq\x14\xf3\x9d\x1eK\xed\x10K\n\x04\x02)\f\xcd\xcc6=\x97U\x1b\xf4]n\x19\xad\xe1(\xd1_8\x02C'\xd6|\xcf]GH\f\x9f\xdc_\xf4\xbf\xf3\uf3ddk\xbce\xef\x16\xfe\xd7\xd1s_?\x02y\xa6k\xd7\xc8\xc0D=L~\xc0\x92\xe2;w\x06ή+\x8a>[}\a\xd7R\x04V\x9a\xe9s\x95ĕ\nG\xa5\xffv\x94\xc0\x96\x8al\x1eHt\xca늴!֚Ĥ\x8eD\xa2M\x16\xe3l\xf6\x8a4\xf0h\xbdCζ\x18\xcfy\xfc\xc3\xf4\"[2\xf0ѓ\x02%\x04\x8e\x84v\x94lb\xefk`%\x8c\xa9e\xaaJ\b\xc1\xb5\x83ev\x96\x02\xb8\xe4;\x8e\xd5\xdb\xfc\xdc\xc3g\x10\x82\xdf{bf\xfe\x87\xbf\xae\xa0\x93g\xbav\f\x0fprn\xb1\xde\xeat\xbf\x98\x0f\xfc\x0f-\x84ɓ\x17z\xf1\xa1F\x9c\x94K\x96$Ԛ\x95^\xc4b7d\xa1\x1b\xb2\xd0I_\x8b\x9d\x90\x950\xa2\x1e\xc6tb\xddGp\x03[\xe2\xdb\nW)<+\xfd\xdbR\xb2\xef\x9d\tD_8c\x9e\r\xc6p\xa1\x1d\xd2L4\x83\x8e\xe2\ua48f%\x05\x1a\xc3\xf1V\xc8j\x94Pu\xac\r*\xd2\xf0T3u\xb7K\x96D\tA/ь\xe7Ҍ\xe5F\xe3_um\xaa\x8e\xc5=\x8b\xf5\x1b\xf7VK\xcd\xe9\xb5\xe6=\xfbǆXh\xb4\xbe\xfe\x0429X\xa3\x94\v\xfa*lr\xb0&\xa5\x107+!^\x1e\x1b3\xb4\x10\xc6#O5z\xf4☲\xa5\xf0\x95\xea?hlRo\xa9\x19%\xac\x86\xa9\xb0\x16;!K\xbd\x88\xe5nD;J!cO)<Kb+\x85o+<\xa5P\"\xc5\xfd\xa3,\x94\xf7\x94\xa4\xe2(F]\x9b\xcf\xcdש9\x16{\x8b\x1e\x03\xae\xcd\xfe\x82\x87@p\xfbb\x93\x9aca\t\x81\x10\x02GJ^<X\xe4H+\xa2\x13ŸRPtlJ\xae}\x99G6\xe6;(\xa3\xb9c\xa1q\xeb\xf6j\xf1\v\x8f\x9f\x9f\x9f\xda:Tc\xad\xd5\xf9ڪ\xacKY\x1c\x13\x835\xcb\xc0\x00p\xad\x82\xb7*\xc1w[\x02;\x10\xe0\vA^\x81/\xa1\x99\xc0J\xa2\x19\xf7lv\x17\x03\n\x96B\x88\xa7\x879\xd6\xe9G\x06\x83\x12\x12OI\x8a\x8eŰ\xefRt,\\%\x91Bd|\xe2t\xe7u\xe3$ˡ\xa40\xfb\xc7/\xacrS5ǎ\x9cKbL\xca\xe5J4\x1f9\xbfB!\xab\xe0Z\x0ec\x06]\x9b7o\x1d⎕6\x0fϯ\xb2շ\xf9\xa6\xf1\x1a\x15\u05fe\x18\xef\x18\x81Ac\v\xc9\a\xcf-\xf2\xa7'f\x8f\xc7F\xbf|vqe\xf6kjC6z]\x93\x83\xb5\x9c\x86o5\x86\xdb\x1c\xc97\xe5\x05\xbbrJ\x90\x17\xa9\x00\xe4\xc6\xc9\xdd@dXK\f10\xe2;\xec-\x06\xb8js\xb4\xbc\xfe\xaf\x9c%\xf1\x95DI\x91A\xf0\x9aؤ\xc4\bKIj\xaè\xeb\x10ةJK\ft\xa2\x98V\x14ӎbl!\xf8\xf2R\x83\x03E\xbf/\x14)\xd2\u07ffw\xb9\xc5\xe9f\x8f1\xdf\xe2\\;\xe2\xed[jl)\xe6\x10\x8e\xc3G\xcf̱\xd8\xea\xf2\x03\xfb&\xfa\xd0\xd0\xc6q)!\xf8\x9d\xa3\xe7\xf9\xf0\xb9\xc5\xf7\x18c~\xa2`[\xe6\xcc\xfc\xe2\xff\xbf*kC\xe6\x90\xc9\xc1\x9aU\xcc\x05?\xab\r\xbf\x93\x97\xfc\xfbQ[\xdc4f\x89j\xc5\x12\x14$Xb\xb3 .\xbd|)R\x96J\x9c\x12!\x06\x1c\vϒ\xfd\xcf{R\xb2-\xefR\xb0U*\x80\xc4\xf4W}\xd9U\x94\x1cE+J\x98i\x87\xccw{t\xa2\x04\xa3\xd3\xc9.\xb9\x0e\xbem\xe1(I7\xd1\f9\x16O\xd4;\x94m\xd5\xcf0\n!ؒs\x18\xf1\x1c\x1e\xabw\xf1-\x89\x92\x10ȴ\xf6\xf15[G0J\xf2\x89\xe9E\x0e\x94\xf3\x17=\xbb\r\x8bj\"pyt\xb5yC;6_\x98ZX:\xf7\x83\x87v\xf3\xe0\x85\xe5\u007fy\x81\xac\v\xa2\x9c\v(悠\x94\v~J\xc0\xe7}\xc9m\xdb\x1d18f\vۗ\xa0\xc4\vsͤ\xa0\x9fa|\xa2ѣlI\xf2N\xaa\"v\x14\\V\xa3\x98\xa9V\x8fV\xac\xe9&)\x10Y\x8f\x13\x16\xba\x11\xaeT\x94]\x8bF\x98\x10\x1bC=NX\fS\x95\xd5\nc\x14\x02/S\x87\x91֔,\xc5W\xd6:\xecȻ\x9bƘ\xb3${\n\x1eO5\xba,\x87\tW\x95|\x96\xbb!\xed(\xe2ec\x03\xe4]\x9b\xfb\x16Vْ\xf3\xd2\t\xc9\xe0\x04!\xa0d[L\xb7By\xae\xdd{\xf3O_\xbd\xe3\x8f\xde\xfd\xc0\x91\xee\xb5\x13#X\xae\xfb/\x92\xdcR\xeb\x86zja\x89\xc9\xc1Z\xa0\xe1\xbb$|0'y˄-\xe4\xa4-\xb0\x05Ϩ\xffE\xb6\xb5-\x99z/RpYN\x04\xc0\x16PP\x82\xa3\xad\x1e\xcd0&gI\xc6\x02\x97\xd9v\xb4)r_\x87V\x84\x104KcS\x8f\xe2>\x17\xcb\x18X\x8bb\x96\u0098F\x98:\x03Z\x1b\x04\x06_I.t#\x1c)(;\x9b)C\x96\x10\x1c*\al˥\u0092BЌb\x96\xbb=^<\\\xe5T\xab\xc7\\\xbbǖ\x9cK\xb2iA\t\x94\x80/έzO\xad\xb5_|`\xa0\xfcw\xf7\x9c\x9b\x8d\xfe\xa5\x92[j\x83zڧ\xe1\xcf\\\xc1O\x8d٢<j\v\xfc,G\xbeI\xe7\x1b\x83\x94\x12[\xa6\x06س\x14\xaee\xe1[\xeb.k\xfa^\xbc\xa1\xa0g\xa3\xc1*(A=N8\u05c9\b\xe3\x84+*9\xc2$\x05\x1d\xd7\x11d\xd3\xf7vl\xc2İ\x16%\x97@-)\x0e\xd6Նz\x1cӊ\xd3`\xd3S\x921\xdf\xe6\xb3su\xae\xaf\xe4\xfa\xf9\x95\x8d)]\xfb\x92l\xe3\xfan{\xf9x\x8d\x87WZL\xb7\xbal\xcf\xfb}\xb4\x99\xcc\xebږ\xf39\xd1\xe8l=\xde\xe8\xdc8Y\xce\xff\xe3B\xa3\xdd\xfe\x97H\x01\xafW\xe1\xbeU\x1b><d\x89\x83;\x1cAp\t\xbbd=\xc5\xea)Eζ\xd2\xc0OI,uqW\x80@\x88teK!R\x03\x0e\x97e\xed\x00܌(q\xa4\x1d\xd1\fc\xae\xac\xe4R\xd0O\nl)\xa8\xba6\xe3\x81Cb\f\xf3\xddg\x8e\xbc\xd7\xc7\x18iC+NX\tc\\)\x99\xeaD\x1cȒ^\xcfǫ\xe9\xc6\t\x15\xdf\xe3E\x83%\xee[j`0\x94/\xc1\xbb&\x02\x97[\x86\xcb\xc4\xda\xec|l\xb5\xfd\x9aR.\xf8\xc0\xd4\xc2R\xf7\x9f[(jb\xb0\xf6\x9f-\xc1\xfb\xb7\xd9\"\x18\xb26C\xe6*\x9b\xd8|&\x04K\xca~\x05\xed\xfaj\x16\xcf\xe0\xb0\t\x91\x92\x17\xc2\xf5H\x9c\x8b\xccz#R[T\xb1\x04\xa7\xbb\xe9\nw\xa5\"oK\x8a\xb6\"Ԇ\x99v\xc8J\x94\xa4.o\x16\x85\vD\x9fQ\xbf\xf1\x8at\x9a(\xd3\x18֢\x98s\xed\x90\x03E?\xe5\x05oP\xad\xeb\xf7\x92ٽ\xd6]\xee\xd8\x18,)\xa9\x04\x1e{\x8b9>;\xb3\xcc\xd6\xc0\xed?\xeb\xfa\xf3\xd8Rp\xa8Z\xa0\x1e&C\xa7\x9a\xdd\x1f\xc9\xf9\xde_N/.\xaf\x1d\x1c\x1df\xae\xf9\xcf\x138\x8a+\x86kf̺\xb8+,)\xb1\xa4\xc0\xce\xfe~&\x18\x9d\xcb\x04\x92\xed\x12Ҳ\xe9\x85(!\xcc\x10a\xb1\x81/m\x8cI'\x16\xf0$\x04\x02\x96\xb5\xe0\xcaJ\x9e\x01\xd7\xea\xbb\xc5Eۢ\xe4\xa8>\x13\xd2\x18\x882\x9cL\nhŚF\xa6ʾ\xb4\xd8\xe4\xdaJ@\x98h\xb4\x81\xc0\x92|\xf3P\x91\xd8\x18l)\xa9x.yǦ\xe0\xda)\xe6&\x04\xb1\xd6\xd4{\x11k\xbd\x90\x95n\x8fN\x14\xf3\x92\xc9\x11\x8a\xae\xc3\xe7g\x96\xe8F\x11\xbb\n~\x1fj\xd9\xe8\x0f\xfbJ\xf1G\xc7gyߩ\xd9\xfb\x02\xa9^sfaq\xe5\x9fm\x87\\W\xc9\xfdl\xce\x12\x96\xaf\x14\xbee\xe1*\x85\xbda'<\x9f(f\xa3A\xee\x198ىp\x8d\xc6\x13)M\xc8\x17)'\xd8\x17\x99+\x9cykm\r\xab\xdaP\x96p\xbe\x1bSumr\x96\xa4`YL\xe4\x1c:\x89f\xa9\x97\xa6\x84\xebQB3K\a\x87\x89a\xc0\xb3\xa8\xba\x16\x916\x1c^m\xe3*\xc9\x1bǫL\xe6\x1c\xf6\x15}40Vȱ\u007f\xa8\xcaX!\xc7X!\xa0\xe4\xb9<\xb4\xd4\xe4\xc1\xe5&\xcba\xc2\xdeJ\x9e\x1d\x95\"\x15ϥ\x13%,vzl)\xe5\x19\v\\\xfe\xee\xcc<\x87*9L_#d;+\xab\f\xbb\xaa\x92g\xbaݛ8\xdd\xec\xf4\xbe\u007fϖ;pl3\x9du\xb2\xf8'퐷\xef\x18\xed()\xbc\u007fJX\xb9Q G\xda\x11C\xd2</\xfd\r)\xa7k1\x81QK\xb2\xa0\xe1\xear\x8e\xab\xca\x01\xc6\x18.t\x9f\x1dN\xdf[\xf2iG\x9a\x93\xcd.\u007f?\xbbʾ\x82\xcf\xf7n\xad\xa5ƽ\x90c\xff`%\xcd\xc3 \xb8\xfd\xc22?{\xf8\x04\xf3\xdd\bK\\\xdcq\u07fbk\x94w^\xb5\x95\xa2m\xf3\xc0\xcc\x02\xfb\x87\xca\x04\xca\xe2H\xbd\xcd\xc7\xcf\xce\xf1\xe6Ɂl\x97_>\x92\x13\x8d\x0e\xef|\xe8$\x02v>5\xbbp\xea\x9fe\x87\x1c\xaa\x15\xdf\xc5\xf3\xac\x0f\xd9\xe4\x96n\xb0#\x891D\x06\x96#\rZ\xe3\x88\x17\xe6U\xe4\x94`)\x81!K0ۋ\xb1\x95dг\xa9\x87\xc9e%n\xebl\x98\x9c\xa5(;\x8aV\x92\xd0Ӛ\xbdy\x9f'\x1a\x1d\xbe\xbc\xd0\xc0R\x8ak\x86\xab\x18c\xe8j\xc3/\x1d>\xc1\u007fy\xe4\x14\xd2\xc0\x15\x9e`\xdc\x16T\f\\\xfb\x92\x1a\v\xf9\x84\xffq\xef\x14{\xf2\x01/\x1e\xabщ\x12,)\x19\xcd\xfb|jz\x91\x9d\xb9\xb4\xe2X\xc8\xcb\x1f*g)\x8e\xd6;\xac\x85\x11s\x8d\xd6?\xfe\xb3\b\xe4\xeaj\xe1i\x05\xd2O\xb5\xf63z\xeb\x89#M3ѬŚ\xe5X\xb3\x12%4bM3N\x88\xb5\xc6\xef{]/`\x10B\x90S\x82\xb9\xd8PU\xa9g\x15g<ߵH\xf73\x91 \xc8Y\x92!\xdffطiƚ\xf9ND\x94\xb1&\xb7\x06\x0e\x81R|e\xb5͇\xcf.\xf0\xf9\xd9\x15\xfe\xea\xd4\x05N\xd7\xdb\\[t\xe9Ś\x9cL\x85\xba\xd0J\xf8\x85\xdf<\xc0;~d\x17V \xf8\xf5\x8f\x1d\xe5`\xb1\xc0\xaeR\xdae\xc2\x18\xcd\xeeb\x8e\x8fM-pe9\x87\xcel\xdf&oQI\x96{1\x8f\xaf\xb6\xad\xf9F\xeb\x8f\xff9<\xae>\xb9|#\x81$2\xa9\xe7\x12\x9au戡\xa1\r\x9e0(\xc0\xd9`\x13\xd6=\x90\xd4p\u007fu\xe9\x95\x14\x10\x841[0\x1dj\xb68\x86\xe3+MZ\x89ᶡ\x02\xaba\x8c\x92\x12W\xa6\x93\x10j\xc3\xe9FH+N\xf0\x95d\xd0S\x04J!\x04\x8c\xfa\t\xdb\x02\x87f\x9c\xd0ӆV\"\x18-\xfb\xb4\xa2\x98\x99NHϤ\xe3\xd6\x06\xaa\x15\x1bz\x9a\xef\xfd\xdeW\x11\x9a7\xf0}\xef\xfe\xef|\xf1\xd5\xd7Rsm\x12c\x18\t\x1c\x94\x92\xb4\x93$c\xda\x1b\xe4\x86g4\xc6pC\xad\xc0\x87\xce.\\\x0fBL-,\x99\u007f\xea\x0e\xb1\x1eo\xc7\xedؘN/\xcd\x15\xe9ؘ(6f)1\xacJ\xc1\xcb}\x91\xaa\x92\xed\xd6\xe5\xaa㟖\xe2\xbaD(Y(^P\x82\x966\x14\xa5`j\xad\xc9\x1dR\xf0\x92Z\xae\xef\xbd]hG,\xf7b\x8a\x8ebg\xd1Ø4'^\x8fc$\x02ϒ\xec(X\x84\x89a\xa6\x13R\xc92\x912\xbb\xf7bl\bT\xa6j\x93\xec\x01\xe4>\xbe\xffG\xbe\x83;n\xbf\x87\xb7}\xfe>>\xf2\xaak\t\xb2\xeez\xd7\x0e\x149^\xefpe9\x97\xf2\x046\b\xc5\x00\xe39\a)a\xcf\xe8\xc0u\xc7f\x17\x1e\xfc\xa7\u0383\xd4p\xab\xab\xc4U{s\xd6䱹\x85\x91S\U000cb4c9\xe1w\xab\x16\xfb\xf68\x82+<AE\xb1\x89\x8ec\x9e\xa7\x9d\t\r4u\xfaje\xaf\xa6N\xab{\x9fN\x96\x891\xd8\x18\xb4\x90iu\x94\x12\x1c[\xae\xf37g\x16yd\xb9͙f\x8f\xc5^Dٵ\x18\xf2m\xa6\x9a=N6:\xccw\"VÄ\xe5^\xcct+\xe4\xe8Z\x87\x8e\xd6\fm\xa0\a9J\x12(\x99\x12\xfc\xb2\xe0\xf4\xe1G\xd62}<\n\xc0\x9f\xfd\xd5\a\xc8\xed\xd8\xcd/\xdc\xf7T\x1f\xa1\x18\x0f<.t\xa2\xcb\x02\\\x95q\x90\xf3\x96\xa2\xe6X\x84\x89\xbe\xea\x85N\xfe\xa1\xf1\xe1\xfe\xbfoޚ\x8eA><3\xff\x95\xfb\xa7\xe7\xcf\u007f\xf4\xe4l/\xcby\xfc\xf9\xa0\xc5\x1fO\xdab(\x90dd\xb9\x17f\xa4[\x06.Ć\xaeI\x11\xe1K_\x91\x81\xf9\x18\x96\x92\x8b\xc8ꦬ\x9d\xe7R\xf6\x1cbc\xa8X\x12\x99\xc4ܿ\xdc\xe0X\xbd\x8d%\x04%[\xd1\b\x13\xbaZ\xf7\x91\x02\x91\xa9\xce,tBf\xb6i\xdd)p3\x98gP\t\xe6b\xa8\xfa\x92\xbfx\xdf\x19(\xd9\x10\xa7|\x06\xcbq\xf9\x9d?\xfa\x13\x1e\xc4\xe7\xd3S\vXB\xb05\xefQ\x8f\xd3\f\xe7\xc6 \xd1Q\xb2\x9f\xa5\xdc]\n\xe8is\xf3\xc6\x1c\xd2s]W\x8d\r\xf1\xc8\xf99^\xb2et۫w\x8eW\xee<;\xcb\xe4`m3\xfc>9X\xfb\x8b\xa2\xe4{\xb68\xe2Y\xeb\f\x9f\x89\xfb\xa4\r\xccĩ\xf1\x1b\xb0Rx\xe4\xe9\x04\xe2f\xf1\x89\x100\x1b\xa7\t%W\\ě|\xc7bG!\xc0\xb3\x14\x8d0\xc6\xca>?ߋ9\xd9\xee1\xe6;\x8c\x05\x0e\xcd8%e\xf7\x19\xf7\bʎŖ\xbcK`)\xe6:\x11=}q;J\x04\xf5^\xdaؠ\x05\x98z\xcc\x03\x8f\xd7y\xed\x1b\x8a\xa0n\x00\xe1Q\xa9\xd6\x18\x9f\x9c\xe0G\xff\xf4\xaf\xb9m\xb4Ę\xef\x12\xd8\x16\xb7ϭpE1\xad\xf0\x92Y\xb1\x91\x12\x92Hk\x04\x82\xcf̬\x8c4;\x9d\xff\xf6|\x8c\xfa\xc1\xb1!\x1e\x9b\x99\xe7Ɖ\x91m\xb3\xbd\xe8hbL\xf5B\xa3\xf5\x89z\xbbsQ \xe3\x03\xb5_\r\x14?\xb2\xd3ynӼ1\x81\xe3(E\xc9s\x18\x0e|f\xa3\x84\x920䟧4m\x01%%hh\x88I\x05\xa5\x85\xc0\xb1mBcR7Z)|)\b\xb5&\x90\x02O\xc0\x03\xabm\x84\x10\x8c\xf9.\x96\x14\x94mE͵\x19\xf6\xd3\u009enb\x98넄:\x8dԕ\x14\x18\x03\x9e\xa5hF1Zk\x12\x04\x8e\x12\xcc\x1fo\xf27\x1fx\x94\xb5\xe5\xe3\xb4;\x1e\x8f?|\x98/}\xf2\x1fX>s\x8a?82;r\x9e\x97\x8dTx`\xa9\x89\xc2Psm\x10)\x92!%DZ3\xea\xbb|\xe9\u009a\x1f[V\xa3\xd5\xe9\xde\xfblI\xbf\xd1R\x9e'g\x17\xd83X\xdb\xd94\xe6s;\xc6*\x03\xe7WZ͵v\xe7\xfd}5>:P\xfb\xb6\x82\xe2o\xb7\xdb\xc2s\xc43\xab(\x93\xd1|\n\xb6E\xd9s\b,\x8b\x9c\x9d\x02\x8dS\xad\x90c\xab\r\xca\xcf\xc0H\u007f.\x9b\xb3\x94\xa4\xde۪\x86\xc5\xd8\xe0)\xc1\xb0k\xb3\xaf\xe0qe\xd1#\x8e\x13N\xd4[\xccuBl!X\xcd\xea\xe5\x06}\x87\xbcm\xa5YE\xc7\xc2\xcfR\xbe\xebt\xa3\xbe\xf0\xa5`5#W\x9cZk\xa6M\rD\xaa\t\x92\xc4\xd0\xecE\xf4\xe2^\x9a\u007f\xb7m\xa4\xed\xb0b\x04]\r7\f\x95\xb9\xb6V\xe0K\xb3\xcb|\xff\xeeQv\x17|<\xdb\xc2\x12Ў\x13\x12mxt\xa5\xc5/>rz9o\xa9\x1f<<}\xe1\x83\xd7O\f\x8b\a\xa7\xe7\xfa#806\xc4\xe33\xf3)\xb5j\xa0\xfa\xd28ѿ\xff\xc3o{\xc9UW\xed\x1c\xe6\xbb\u007f\xe9\x83\x0f\xe5s\xee˦\x17\x96Zj|\xa0V\xf1$\x1f\xdb√'\x9e\x9ddf+\xc1\xf6r\x9e\xd1\xc0\xa7\xe0\xd8i\xbe[J\x8c1\x9ch\xf5\xb0u\xfc\xb4\x11\xeds\t#&\xb59\xb3\x91\xc1\xb5\x147\x94\x03n\x1d*\xf0-\xc3%v\x17\\,!\x89\x11xV\xca$\xa9\xc7\tn\xb6[\xc28\xa1\x11\x86\x1c\xa4\x91\xd1\x00\x00\x1ftIDAT\x9c\xed&\xdcT\xcd\x13j\xcdb/\xa2\x95\xf1\u007f;Y\xd2\v\x91\xc2\xe8\xf5(\xa1\xe0\xd8t\x13M7\xce\xda\u007fH\x81k+r\xaeK\xe0:\xd8\xca\u0096\x82\xb2\x92l\xf3-l\x01G\xd7Z\xf4\x12ÁJ\x8e\x89\xbc\x8bBfɺ,9\xe6Z\x94\x1c\xe5\xdf>_\u007f\xe3\xeeJ!\xf7\xe0\xf4\xdc\xe7n\x9a\x1cAf\x89\xac\xf9F\x8b\xd7\xec\x9a\xc8ǖ\xf3'\x89\xe1\xd7\u007f\xf9?\xbdr\xf2\x1d\xdf\xfbZ\xee{|\x8a\xcf\xdc\xfdĉPX\u007f\xda\xedv\x8c\xa5\x04\xbf^Ul\xc9=\xcb\xcePB0\x94w\x19\xcb\x05H\x91&{\xa4HkF\x840D:\xf5Z\x9a_\x05\xafh9I\xed\u0380k\xf3\x8ar\xc0\xd6\xc0a<pP\xc0\xe9V\x8fV6i\xeb\x9e\xdbPΣ\xe4:\xd4Ð\xc5n\x88І\xb5\x04J\xaeBI\x98\xefE<\x1d\xff\xbb\x19%$n\x8a\u007f-t#vW\n̷\xbb,vz\xf8\x96\xea\xf3\x8c\xb51H)(\xd8\x16{K\x01\xc3\x19껎\x18\x0f;VfE\xb2r\xbc\x8c\xa8'\x8c\xe1[\xc7j,vc\xfb\x83g\x17~\xe6E\x93#'\ue77a\xf0'ۇ\a\xbc\xad\x035ߒ⻎6\xba\xffeװ_\xfc\xe3\x9f\u007f3\xdb\x0e\\\r\x96\xc3\xe3\xc7fp,ujve)\x01\xb0|\xc9\x0f\f[\x97\xab\x19m\f\x81eQ\xf3]\x06\x02\x17/#\xbeyR\"\x84H\xeb;H\xa1uG\x18\xb6\xe7<\xeemu(\xab\xe7\xb7+\x12\x93\xf6M\x91\x96\xc57\r\xa6$\xbbaߡ`+.tB\x96zq\x9f\xf3{i\x92I)\xc9u\x83et\x92\xf0ɩ\x05l\x01QF-\x15O\xe3\x96o\x84\xd9\xf3\x96\xc4\x0eR\xa6\xfd\xbe\x92\x9f\x01\x86\x19\xf3Ť\xc1X\x82\xe9O\xb4\xde@\xd83\xc0r/\xa4\x12:8^\xdaub\x9d4.34\xe1;\xb6\x0er\xd7\xfc\x1a\xa7\x1b\xdd\xff6Z\xaa\xec\x8ec}\xf5X\xc5}\xf5\x96!\x9f7\xdc8\xc6\xdb_w\x13bp?$\n\x94\xe6c_\xfa\x8a\xb1m\xfbC\xeb6ƚ\xb4\x85\x96\xa0\xcc\x06Մ\x80\xf1|@\xcdsp,\x89\x9f嶝\x8c=\xe8d\x83U\x19/6\x11i7\xb9\x92\xe7\x12\x85!\xf6\xb3h-\x99\xc5'SQ\xfa\xd0\xdf2\\\xc0\x13\x82Q\xdfA\b8Z\xef\x90hӇj\x9e\xe9\x1e\xe7Z=\xae*\xf9\fx\x0e+\xbd\x88\xb5(\xe6T\xb3GٱX\xe8\xa6\xc2\\\xa7\x17\xf9JRv-\x8av\xaa\x8a\xb41tcM=\x8a\x11YC\x1b)ҿm%\x91\x86~m̥\vI\t\xc1r\xb7G\xa85%Ǧ\xe4\xa5][\x95\x10\xc4F\xf3\xdbONS\xad:\\\xbb\xafP\xac\x15\x9cwN\x0e:l\x1dʱg\xa2\xc2\xe8Ϋ\x11\x95\xad \x1dP\x92'\x1f?N\xa3\x1b\x99\xbc\xef\xfc\xe3:\xdd\xca\xf2\x04\x89\x01{}\x05\xe4\x1c\x8b\xed\xc5\x1cN\x16\xa9\x96,\x8b\x8ac!3O\xc5\xca\x125\xe9jM\xb7\xaa$\xcd\xf4\xed\xccy<\x15E\xcf\x18:\n\x01S\xa1a)I\x93U\xd7\xe4\x1c<)\xf0\x95\"g+\x8e\xd6ۘ,\xdf\xf1|\xe0\x16KJ&\xf2\x1e˽\x88\x9c\x80\xc3KM\xde4Y\xc3W\x92V\xa2\xf1U\x9a\xf0\xd2\x06Vz\x11\xf7,\xd49\xdb\x0eY\xe8E)\x82\xbba\xa25\x02K\bv\x17=\xae.\xe7\x18pU\x06\xb9oęd?Q֍\x12,\x91j\r\x93\xd5\xe3\u007f\xfa\xfc\n\x8b*\xe67\u07be\xb3\xdf4\xa7\x90ˑ\xcf\x05\x94\xb6\x1c\xc2.\x8f\x83P}\xd0\xea\x03\x9f\xb8\x17O\xc9{\x8eN_\xe8\xb7\xe4\xb36\xa6h\x87\x02\x8fɂ\x9f\xf2\xa1\xa4`\xd0u\xb2\x9a\xc0\xb4\x1eD\x8at\xd0¤Y\xbf\xf5,\x9c\xc1Њ\x12N\xd6[\xc4\xda\xe0ʧ\x9b@8\xdb3H\xd7\xf0\xf2ɘ\x99\x86 \nS\xd4?o+\x1aQ\x821/\xdc;\x1b\xf0\\\x94l\x826t\xa2\x88\x8fL/qE\xde\x03\x01\x8d(a\xa6\x13\xb2ЋI\xb2q\x95,ɨ\x02\xa1\xc4e\xf7\x92\x02\xc2^\x8f\xf3-\x85/}\xf2\xf6\xe6\xb0U\xca\xcd\n\xd1Y\xa7\x1b\x91~ׇ\xa6\x17\xf8\x99\xb7nų\x15\x96eS,Uȗj\xe4ǮDx\xa5\x8b\xc4s\x93М\x9b\xe7\xf6GNRv\xd4\x1fn²4\x90\xb7-&\v\x01y;-'+\xda\x16C\x9e\xbdi'(#2\xa9\xa7Şd)S\x91\x05d\x0f/\xd7Y\fc\x06\xac\xa7\xaf\x8e=\x1f\x1b\ny\xcd\xc1\xa1\x18!\f\x9ee\xb1\xd4N\xd2U\x98\xa1\xc8/\x14!v\x94@I\xc1H\xe03\xddhQT\x82N\x1cq\xffbH\f\x84:m\v\x92\x93\"\xad\x85\x17\xa9k\xbd\xb1\x9e~\xa5\x13\x91\xf3\x9c~\xeb\x10\xa1a\xb6\xd1\xc1\x13\xb0\xbd\xe8\xa7\xe5\xdaRf(D?\xe9\xd0_\x8c\x02\x81%$_Y\xa934V\xe4\xfa\xab\xf7\x91+\x14\xc9\x15*\xd8^\x01\xab0\x02R]\x14\x86N \xac\xf3\xa9/\xde\xc7\xfcB=~\xcdP\xe1o\x0eO\xcf]\x14Ȁ\xe70YH\xbbM'\xc60\xe8\xdaT]k\x931UB \xb3\n\xdc\xf5\aI\xb2ܮ\xa3$\xf7ͯr\xa4\xd9c\xcc\xde\x1cTjR\x96\xc7ZlHl\xc3\x15\x03i~c\xe7H\x91\xe5N\x8bf\x9c\xf4\x1b4\xbf\x10d ҆\xbd%\x9fX\xc3Z\x98Prml\x99\xe7d\xbd\xcd|dX\x8dS;\x95\xd2\u007f@ʴX(\xd2`\xcb\x14,-\n\xcd\\\xb3\xcbmo\xb8\x95\a>s\x17\x03Y\xb9\x9b\xc1\x10\x19ñ\xd5\x16\x9d8a_5\x8f\xb5\xa1\b\xc9`H\x8c\xc6\x12\x92N\x94\x10\xd8\x16\xb6\x84\x99v\x8f\xda\xe0\x10[\x0f\xbe\x1c\xa4\x9dZ:\xb1\xa1\xe1\x951\xa0#\xe8,Q_\x9d\xe7\xb7\xff\xe6.D\xac\xff\xf0\xbf?|\"\xbeu\xfb\x18_\xc8Jӭm\xc5\\\x86\xfd\xa4\x865o\xab\xcbt\xb8\xcc\xd8$\xeb\xde\x04\xac{e\x86\xf3\xad.w,6\x19\xb57\xc3-&\xdby\xfb\xabE>1\xbbʠ\x1f\x13؆kv\xd6\x10\b\xaaA\x87V\x92\x10\x1aC\xa8M\xca:\xe9>wm\x87\x01v\xe4=\x86=\x9b\xe9V\x8fz\x94\xa01<\xde\fy\xaa\x1d3\xe0\xc1\xeej\xc2@`\xf0,\xbd\xe9Y\xe2\x04\xce\xd7\x15\xab\x94\xb0\x87\x86\xf9\xd5\xef~-\x8f\x1f9\r:Ac\xf7\xbd\xbf\xaeI\xa1\x9a\xf3\xad\x1eU\xcffK\xc1O\xe7H\xa6\xfc\xb3u4&L\x12\x12\xad\x11\"cc\n\x05ʻ\x9c\xb9fbH\xba\xd0]\x81\xb8\xc9o\xbe\xefK\x9c\x9dm\xd4+\x81\xfd;\xb7l\x19\xed\vc\x1d\xed\xc5U\x92\xc9\\J\xe9\xc4pY\t\xf1\xfaFU2U\x11\xeb\xdbu9\x8c\xf9\xfc\xdc*9yy\x86\xcb\xcd\x1a\x98I\xa0m\f%W\xb3{\xac\x84c\xa5\xa5\x06åte\x9dm\x87\x84:5\xc0\xf6\xb3X\xf3\xf5q^Q\xf4\x19\t\x1cN6\xba\x9cjv9\xde\xea\xf1\xf7\xb3\xab,\x9b6/\xdb\x12s\xe3D\x8f\xf1|\x8cgi,\x95\xf6\xe9r,\v\u05f6\xc8y\x16\xbb\x06\f{\x8bu.L\x9d\xa3\x1b\xf6x\xe0\xf0S\xfc\xdc{~\x0e5<Dǲ\xd9\xf9\xf2\x1b\xd9z\xcd\xfe\x8c0a\x98jv\xe9f\xc0\xe2z\xa2n\x9do\xa0\xb2\xc0ԑ\x92\xedy\x8f\x93S\xf3\xcc-ճ*\xd7\x04\x92\x10\xa2\x16Dm\xe8\xd5\xc14\xf9ҽO\xf2\xa7\x9f:B)\xb0\u007f퉙\x85\xa3w\\R\xcbh\x95l\x8bA7\xedse2O(\xf5t2\x145\x8b\x05l!\xfb\xb5\x84J\n\x96\xba\x11wͭ\xb2ڋ\xa8^\x02\x97h`<\x17\xe0\xaa\x14F\xcf)\x89T6\x05\xdfʌ#\xd4\n.;*-\x1e[\xed\xb2;\xe7ҍ5[s.O\xd5;\x97\x15pj\x03%\xc7bw\x96\xffxr\xb5M+N8\xde\xeaq\xac\xdd\xe6\xaaᘲ\x97\xaa\xc3|\xe0Pι\xe4\\\v\xc7Jq\xacuu\viIC7J\xd86\x18\xf3\xfe\xf7\xbe\x97\u007f\xf7C\xef\u0d97\x1c\xe4\x8e;\xf6\xf1\xcd/\xbb\x8e\xeb\x0f]\xc1\x8f\xbd\xe37\xfb\x1d\xec\xdaq\xc2Z\x181d\xb9$\x1a\x8cZ\xd7\x0f\"su\r\xb6%\xb9\xa6\x96\xa7\xf5\xe4\x14\u007f\xff\xa9/\xf3\x83\xdf\xfeb\xd0\xf2\xa2\xed\b\xeb`\x1a\xdcu\xdfq~\xe2\xf7\xef\xa2\x12\xd8?\xffm#\xa5\xdf~jf\xe1\xf2\x04Ո\xe7\\\xd6\xdaO\nP\x19-\xc8\x16\x17Wn\x92Y\x91V\xa2\xb9\u007fa\x85#\x8d.\xa3\x97\x04\x1d\xc6@ų\xa9\x05NJ\x8c\x96P\xb6-B\x93\xf2\xb4\xd6?S+\xba\xec\x1elsd!a9\x8c\t,Ů\x82Ƕ\xc0\xe3l\xbb\xbbنI\xc1ޢG;\xd6\x1ckt\xd0\xc60ۍy\xa4\xde\xe2Ʊ\x84\x82\xab\x99\x18\xc83R\t\xb0\x95\xe0\xd9\xd0\x1bۂ\xc0U\f\x16\x1dV\x9b\t\xbb\xb6\x8d\xf1\x17\u007f\xfd\x8f\\\u007fp\x0f/\xbb\xf1\x00O\x9e8ˣ\x0f>ΖR\xaa\xcag;\x11\xac\xb4\xb9\xd5w\xfa\xe5z\xeb\xc1`Z+\x99B\x1c\xa39\x9f\x1f\xdb?\xc1o\xbc\xef3\x8cx\x11o\xfc\xb6\x1b!\x8e!i\xb3<\xb3\xc8{?\xf40\x1f\xb8\xfd\x14I\xa2\xff\x8f\x93s\x8b\x1fx\xf7\xdc\"\xd7M\f\xb3Ѡ\xafۼM\xdcZ)D\x1a(\x01\xb6\x92)/*\xab\a\x94&\xa5o\xde3\xb7\xca\x03\xcbm&\x1cyY.õ$\xbbJi/\x12;s\x1b\x87\\\x8b\xb3\xbd\x98(\x11\xac\x13\x02\r\x82\x9d#>\x83g\xba\x1co\x85\x14\xac\xb4\x86cԷA\xc0\x85n\xd8ߩ;\xf2\x1e\x89\x81\xc7WӮr\x911ܾ\xd4do\xcdP\r\f\a\xb6U\xc9{v\xbfK\xdd\xf3\xf6\xd4l\x9b\x0f|\xe0\xe3\x9c9\xf1\x04\xef\xfcٟb\xad\xdd\xe5\x0f\xff\xfc\xe3\xdc\xfa\xe6W\xf2ؗ\xeeǎ#\xae{\xf5\xcd\f\x05>\xad\x87\x1f!\xef\xa4j,\xc5\xeb\x04ڤ\xfc\xae^\x92Ptm^5VC\n\xc1/\xfe\xc1\x97\xf9\xafￓ\xe1J\xc0\xdcJ\x87\xb9z\x0f\x940m\xad\xdf4\xb3\xb8\xfc\xd1=#\x83\x1c\xbb\xb0p\x990\xd8HnXWE\x96\x12X\b\x02Kbg\x91yl҆\xbd]\xad\xb9o\xb1\xce}\xcbMF\x1dy\xd9β\xa5`G)\x8f\x9f\xd5{\xacC\x16\x93\xbe\xc3}\xb3-\x96\xda\t\xa3\xc540\xd2\xdaP+\xf8\\5\xd6\xe1\xbe\xd3\x11\xfb\v.g\x9a]\xac\x82\xcfD\xe0PsmB\xadY?\xa8\xecD\xa3\x93\xd6|\x18\xf8\xfcB\x8bj\x90\xb0\xbd\x92\xb0g\xbcD\xe0X/X\x18\x89\x86\xbd\xa3\x1e\xdd\xde\x11\x06\xb6\xc0\x1f\xfd\x8f\xdfgzE\xf2\xe7\u007f\xf8K\f\xee\x98\xe0\xbb\xde\xf4\xe3\xac,\xad\xf0\v\xef\xfc\x0f\xfcٟ\xfe=\xdd$\x83U\xb4\xa6ً\x18\xf4\xd3\xeex\xb1\x86V/\xa2\xe8\xda\x04\x8e\xcd7\x8dV8X\xc9\xf1\xf8J\x8bV\xa4\xa9\fT\x19\xf4m>2\xb5(\xfe\xfc\xd4\\\x11\xe0\u0605\x85gF!L?\nM\xe3\fWJJ\xb6\u0095\xa2\xcf8\xb4\xa4\xa0\x11%<\xb8\xd4\xe0΅:\xa5KZ\xfe\xf5s*\xf9\x80!ߥ\x9c\xf5ܕY\x06\xafb[\x94\xa5\xc5c\xe7\xa3\xcb\xf0\xb2\x03\x939\\\xa7ǹN\xfa\xde\xe9fj\xac\xd3\x00SP\x8fb\x8e7:}\x96\xe2}\xcbm\x12\xd5c\xdf@Ȟ\xf1\x12\xe5\xbc\xc3W\xcb,\xb0\x15\x14s6\x9e-\x99=w\x92\xb7\xbd\xf96\x06Ǉ8\u007f\xec\fg\x16W\xf9\xad\xff\xfa\x93\xe4\xca\x05\x1a\xad.\xdd8I;I\xc4\twh\xc5\x1ds\xab)qۤvf\x9d\x9d\x9f\xb3m<%\xb9n\xa0\xc0+F\xca\\U\xcd1\x90UhI\x84?\xf4\x1c\x19E\xb9.\fG\t\x8a\xb6bбp\x95\xe8'\xf2%0\xd7\tyd\xa5\xc9\xe7.\xac\x91\x17\xe62ޕ\x06\xc6\xf2>\xdb\n\x01\x03\xae\x85k\xa5d\x83@\xa5.\xb4\xa3\x04\xfbK\x1ew\x1c\xef\x12&\x97؛\x9cþ\x11\xc9S\x8dn\xbf\xa1\xcd\\7\xe2\xf1\xb5\x16\x8f\xac\xb48\xd1\xe8ҌR\xe0\xf0\x8e\xe5\x16\xb3q\x87+\a#\x0e\xed\xa8P)8/8\xba\u007f\xba\xb4\x821\x06+?\xca[\xde\xf2JN\x1f=\xc3\xf7\xff\xe8o\xf1[\xbf\xf2\x9f9\xb0k\v\xbd\x95:K\v\xcb<\xb8Xg-\x8c9\xb5\xd2\xe45\xdf\xf9\x1aΖ*\x18\xad\xf1lEų\xfb;Y\tA`YY]\xe5\xc5\xc2\xd6B\xfa\xb3\xb2\xfb\xecM_\x91\xbe%\xc9يAס\xeaة\xc1\x12\xb2\xbf;\xa6;!\x0f.7\xf9\xdc\\\x9d\xa24xOC\\\x1b\xf6]\xb6\x17s\fz\x16\x81\x95\x96)8B`˴\u007f\x9560\xe1\xd9\x14-\xc5]\xc7{\xd8j3\xbeuö\x1cy?\xe2\v\vM:\x89κ\f\x19:Iڬ\xf9\xf1z\x97\x0fͬ\xb2\xaa;ܺC\xf0\xa2=U*y\a\xad3/PB\x18\xc3\\=av5f\xae\xae\x9fC\b\xa6o\vlK!\xa4Õ\a\xf6\xf0_\u007f\xeb\xf7\xf9\xa5\x9f\xfai&\xddU\x06\xcbEp\x1dN\x9e\x9de|\xc7\x04?\xf4k?\xca̮ݜ\xe9F\x8cM\x8c\xf0=\xdf\xff\x16\uefb0Lٵq-\x8b\xd8\x18\xbe\xb4P\xa7\x11%x\xb6\u0093\xf2bm\x05<o\xae\x9a\x95\xb7\x15\x15\xc7\xca\xe8\x95&\x832Ҝ\xc0\x99F\x8f\a\x97\x1b<\xb8ܤ R\xae\xae\xb9du\xd5|\x97\xad\xc5\x1cÞM\u07b2\xfa\xa5\xcc\x1a\r\x06\xa46\xb4\x92\x84\v\xbd\x18-`װEr\xc9|U\n\x0e\xaf\xdc\xeb\xf0\xc9'{|j\xce\x10(\xd1'W\xc4\"\xc6V\x11W\x8fI\xae\xdd\xea3X\xf2\xb2ZDp,\xc1̪\xe6\xf4B\xc4Z\xbbG\x18FD\xb1F)ɖ\xc1\x02\x87\xb6؛\x8a\x87\x92$A*I\xb1\x90\xa3Tȑ\xf3],\xdbFk\xc3Sg\x16h7V\xd8uM\x85\xe9\xa5\x0e\u007f\xfc\xc7\xefgﾫx\xe2\xe8)\xfe\xfdw\xbd\x8e\xab\x0f\xee\xe1\xda\xfd;\xf8џ]dbb\x98q\xad\xf9|\x98\xf2ª\x81\xcb\x1f\x9c\x9e'\xb8\xe9\x1af\x9f<\u0096\xbc\x9b\x161e\xe8\x871)\xd6\x05f5\x81g]-֠c_\xcc!\b\x83\xd0\xe9\x16{d\xa5\xc5\xe9f\x87;\x17\x1bԄyZH=\xefX\x8c\x17|\xc6}\x87\x82-3\xc8<\xeb\xc7+%&\xebou\xbc\xd1\xe3Υ&\xdf~]\xc0dE]Vae\f\xec\x1a-\xf0\xd2\xf62g\x97:4\"\x8b\xaa\x17\xe3X\x86Z\xdef\xa2V\xa0Vp\xfaA\xa9\xa3\x04\xe7\x96\x12\xee9\xd5C\xea.e/\xc66\x06\xd71\xe0\x80\xa5\x12\x8e]h3^)2\x90O\xe1v\xa5\x14\x93cC\fVK\xa8\x8c\x01\xbf\x1es\x01\xdct`\v\xdd\xde\bO\x1c;\xc3h\xd9G\xac\x9d\xe6\xee\u007f|\x14\xa7\xb2\x9b\xfd\xbb\xb6\x801\x1c~\xf8(\xff\xe9{^\x87W-\xf1?\xdf\xf7\x11\xeen\xf4ػP'\xec-\xb1\xf7\xbb\xde\xc8H\xadD\xeb\xe8ь\xed\x92:H:I\x9b 4\xe2\x18!\x98\x9by\x8e\xbe\xf4R\x88ͤi\x8d\xe1\x89\xd5&\xc7\xeam>:\xbbF\x15ӯ\x11\xdcLyL=\xaa\x11\xdf!o\xa7USB\xa4\xea\x0e\xb1\xde\xeaBs\xf7r\x8b\xbbV\x1b|\xc7\xf59\x0e\x8c;\xcf\x1c\x89\x1b\xc35;ʌ\x97\x05\x8e\xd4l\x1b\xces\xdbU\x83ܸ\xbb\xcaH\xd9EIA\x94\xc0RK\xf3w\xf77\xf9ғ+\f\xfbu\x86\xf31\x05_\xb2c$\xcf\xf5\xbb\a\xb8q\xef\x10\x8b\xdd\x02\x87\xb6\xe5\x19)I\x94\xa5\xd826č\x87\xae`bd\x00۶\xfa\x91\xf6\xa5\xf6\xc7s\x1d\x0e\xed\xdfM.\xf0\xf1m\tZs\xe0\xeaCإ<'ON\xf1\xdf\xde\xf3g(\xe0\xf0\x9d\x0f\xf1\xd8\xe3'\xf9\xc8W>\xc8\xfc\x95{\xf9\x87N\xc2+\xbf\xf5\x16\xd6\xea-\x96\xdb]\xe6[\x1dbm8\xbc\xd2\xe6t\xb3\a\xc0L;j`\xc4\xdas\xaa\xac\xf5\xba=K\n\xe6{1\xc7\xeam\x1e^iq\xfbR\x8bq\xcb<mY\x9b-\x05\xbb\xca\x05\x8a\x8eE\xd5I\xcb\xd8\xc4%\r\xce\"\x03\x9f\x9d\xab3\xa3{|\xdfK\nl\xafY\xf4\xe2\xe7\xa6\xd8\x1d\xdcV\x01Vx\xf2|\x17!,\x0eL\xd8,5\r\xb3k13+=\x96\x1b=\x86r1;\xaa6\xc5 \xcf@ѥ\x94\xb3I4\x1c\x9b\x8bXhD\xbc\xf6`@9\xa7\xa8V\xab\fT\xab\xf8^ڐ\xd9\x00I\xa2\xfb}\xbf\x9evB,\xc9\x15\xbb\xb7\xa09\xcb\xfc\xa3M\xb6\xed\xd8Ƈ?\xf0I\xbe\xf0\xf1\x0f\xf3\xfa\x03\t\xbf\xf1\xab\xbf\xc4\xd4|\xc4_\xbc\xff\xbd\xb0\\'\xf1\x03~\xf4g\xfe\x03\xf4BNO_\xa0y\xe8 w\xde\xf1 \x03\x96D\xddt\r\xe6އ\xe9v\xba,\x87qKIQ\u007fN\x81\xac\xc7\x0f\xc7\x1b\x1d\x8e5:<\xb4\xda\xe6\xc1\xd56#Y\x1d\xfa\xa5\xc2X\xdf\x19\x81e1\xea;\xe4\xac\xf5^U\x9bOo[\x8b\x12\x8e7z\xbc\xee\x86TM=\xb70\xb2Uj+\xf6O\x96\xd0z\x95S\x17\xea\x1c\xbf\xe0\xe1\xaa\b\x1d\x87\x04\x8e\xe1\xe0\x84\xc7p\xb9@\u07b72n\x14\xac\xb55w\x9f\xecqp\xc2\xc1\xb3\x04w\x9f\xe81\xdb\xd0\b;\xa2\x1b\xcdbIȻ\x16\xe3U\x8f}\x93\x05\x0en-\xb2u(G'\x8c\x89/)D4\xc6`)\xc5\xc1\xbd\xdb\xf8\x96\x86\xe2\x89\xcf\xff-*ip\xc3V\x85\xd6\x0e;G|:=\xc5U\xfbwB\x18\xb1\xb6R\xe7\x9e\xfb\x8f\xf0\xb1\x8f~\x91R\xa9\xc0O\xfc\xca\xff\xc9Ϝ\x99\xe5\xe6\xd7\xdd\u0093gf\xb8OZ\xbc\xe8\xed\xaf\xe7\xcc\x0f\xbf{\xd9\xf1\xdc\xc5\xe7%\x90#\xf56G\xea\x1d\xee]i\xf2\xd0j\x87\x8a\x92\x94\xd5\xe55\x1eJ\b\xb6\x17s\xe4,\x8b\x89\xc0\xa1\xec*d\x96\xcf0\x88~\xef\xacP\x1bf\xdb!kQđ\v\x92݃\xfe\v\"\xdc\xe5}\x9b\xebv\xd7x\xf4\xcc\n\xadN\x93\xbco1Z)2Pt\xb0-\xb5\xa1\x8b\x9da\xa1\xa9\xb9\xffTȞ\x11\x9b\xbf\xbc\xaf\xc5j7\xc1\x95\"q\xa4\xb8O\x8a\xe8\x8b\x05\xa5\xce7\x13]o\xc4z聓\xab\xd7}\xe4\xc1\v\xb7\bĖ=#\x01\xff\xee哼x\u007f\x8d8֗\t\x05\x04\xb7ݰ\x95\xd9\v\x8b\x9c\x9d\xe9\xf41\xee\xc0\xb5\xf0r>\u0092\x9c\x9b^\xa4=\xfb\x04\x9dSs\x9c[lr\xf5-o\x05\xa3\xe9\xac\xd6ٽo'\xbf\xf0\u007f\xbf\x8f\xa3S\xf3\xe4\x06\xab ՚\x12\xe29+\xad\xac\x93\xcd\x0e\xf7/7\xb8o\xa5͉f\x0f[\b\x06\x14\x9b\x8e\x870&\x85Dv\x14\xf3\x94\\\x9b\x01/\xe5֢M\x96E\xcbl\x8cL\xc1\xbbPk\xee^l0\xee\b\xee:\xd1\xe3%;\\\xaa9y\x99w\xb5y\x026\xab.KJn\xdc5@/ָ\xb6\xcaz@\x9aM\x9f]\xeb\x18>\xf5X\x97^\xac9<ݣ\x1b\x9a\xe6\xa8o\xff\xc7\xfb\xa7.|\xf0\xd9\x1ezb\xb06\xf1\xd8t\xf3\x87\xde\xf1\xbe\xc7_;^v\xb7\xbf\xfd\xe5\x93\xc5\x17\xef\xad0^\xf5\xfb\x00\xea\xfa\x83\x8f\x8f\x0eR*\xe68=u\x81f\xab\x8bgI\xc6F\x87Y^k\xf1\xbe?\xfbK\xca,18\x9agj\xb1E\x1c\xc7 $\x8dN\x9bw\xbd\xf3\xd7y\xfb\x9bn\xe5\x0f\xfe\xf63\xe6\u007f~\xfc\x8e;<Ϲ\xf7\xdc\xfcb\xe39\xe1\x9c]\xe5\xfc\xbb\xeeYi[\xa7Z!R\br\x92M\x80\xa16\x86\xbcc\xb3\xb3\x94'\xefX\x94m\x8b\x91\xc0\xb9XD\xb9\x01\xc9K\xb2\x96\u007f\x87WZ\x9cXkR\xcd\x1a,?1\x1f\xb3g\xc8&\xe7\x8a\xcb\f\xa9\x10\x82\xf1\x91\xf4\xa1\x03?\xa5\xdcDqB\x92\xa4\xd1o\xff0˧\x19\xfc\\=\xe1\xf4R¾1\x9b݃\x0e_9\x1f~\xfat/\xfaU\xdd\xeb\xeaW\xef\x18\xe7\xe4J\xe32\xe6`V\x06^ov:\x9f\x1f\xa9\x14\xde_\xef\xc6\x0f\xdc\xfe\xe4R\xe3\x81\xe3\xabW,7Bw\xf7H\x9eBp\x11\x8a1\xc6\xe0:6\x83\xb52\x81\xefP\f,\x9e\x98\xd1\x1cy\xec+x\xf5#\x8c\xd6\x02\x84\x80\xb9\xd5.\x85\x81-Ԋe\x1e\xbb\xf3S\x1c\x1cKh\xa8\x11\x1e9zF\x1c\x9f\xbe\xb0\xad\x18\xf8_\xac\xb7;\xe6\xb9jH\xc4\xcd[F\xc33\x9d\xd0^w}\xf7fe\xd1\xfd>$\xb6ŎR\x1e[I\xaavz\x18\xa4o\xc9\f\t\xde\xec{u\xe3\xb4\xdf\xd5\u007f?:\xc3H\xc6c\xe9\x188\xd63\f\x17\x15\xdf\xfb\xa2<yW\\\xe6\xe6]s`7\xb6m\xa1\xb5N\x1b\x99\x19M\xb3\xddey\xa5\xce\xc2\xf2\x1aI\xa2\x91R^~P\xb2I\xcb\x18|G\xf2\xd1G\xda|\xf9D\xf7\xaf\xe6W\x96\xbf\xfb\xb9V\xe1\xd35\xd5\xe9Ez\x8bg\xc9\xf7\x8eV\xbd\xd7\xfd\xcc\x1bwqp[\xf12\xfb\"D\x8a\xc1\xad\xb6#\x9ak\r\xea\xcd\x06\xf5F\x1bc4alx\xa21\x8c\x92\x92\x03\xe5y\xb4p\xf9\xf4\xc9\x02_9q\xf6\x8b'g\xe6n]\xff\xde\xe7:\x8eIi\xc7}\x97\xc8h@5%\x18\xb2.\x1a\xf2\x9cm\xb1\xa7R@\nAձӢ̬咒\x9b3\x84q\x86\b\u007fvn\x8d0\f\xfb4\x1eG\xc0\xb2\x86\x95\x8e\xe1\xf4b̵[\x9c\xcd\x05\xa5BP)\x15p\x1d\xbbO$\x90R\xe2\xb9\x0e\xb5j\x89\x89\xd1\x01r\x81O\x1c\xc7\xfd\xe3-\xfa\x87\x8d\t\xfa-̍\x91<x\xae\xb7Z+\x04\x1f\xab\xb7;\xcf\xcax^o\x96\xb0~\x95r\x81\x9e]^^\xa9\xb7;\u007f\x03\xf6\x85۟\\z\xf5\xfe\x89\x82\x1a.\xbbOÜ\x11\xf8\x8e\xa2\\\xcc12Xe|t\x80\x81j\x89\x9c\xef\xe2\xd2a4h\xe1[\x82ٕ\x90\x0f?p\x81D'\xdf\xdehwf\u05ff\xf79UV)\x17\xfc<`\xd9\x02v9\xa2\xcf\xdf-{\x0e{*\xc5\xf4\xac\x10ۢ\xecZ\x14l\x95\x1d+\xc1\xa6$\x926\xd0\xd1)\xccq\xf7\xfc\x1a\xde%\n&6\x10(\xc1t3af5\xe1\xc0\xb8\x8d\xb5\xa1\x17|.\xe7S\xc8\x05\x97ْ\xf5\xff\xe7\x02\x9f\x91\xa1*\xa3C5*\xe5\x02\x81\xef\xe2\xda6\xb6e\xa5'\xf7\bI\xde\x13<6\x1dnmE\xfa\xd1f\xa7\xf3\xd8\v)/\xdbxd\xc7\xf4\xe2\xf2a\xa9\xdc\xe3w<\xb9\xf4\xea\x9b\xf7\xd5\xdcڳ\xe0e\xeb\xe3sl\x9br1\xc7\xce\xf1\n[G\a\x18\x1f\x19\xe0\xec\xb2\xe13\x8f.\xfc\xbdg\xab?,\xe5\x82\xf8\xf9\x8eE\x95r\xc1\xbb4XE%(g\x859\x15\xcfa[1\xdfO\xc5\x0e86y;-\x19\x93dd\xb2\xf5\x12a\x04\x9d$mpyx9\r\x8c\xd4%'\x18\x84FPR\x10\x02\xb3\r\xcdjǰo\xd4\xee\a\x9b\x96RT˅gǞ\xf4Ň/\xe4|*\xe5\x02\xb5J\x91j\xa5@\xad\\d\xb8VD#y\xe4L\xfd\xa6J>\xf8\x93\xa9\xaf\xe2\xa8\xd6\xf5϶\xbb\xdd\xc7m\xcbu\xce\\h\xbd\xe2m\xb7\x8c\xd3\r\x9f\x9b\x11c\xb2S\x83\xb46X\x96\xe0\x0f>u:\x9aZ\xee\xbegne\xe5\xfe\x172\x86\xbe\xd6)\xc9\xf5\xcaR\x8b\xed\xc5|\xbf\b\xa6b[xV\xe6ښ\x14\xc8\xdb\b\x94E:\x15F;\xd1<\xb6ܼ\fb1\xa4=\xb5\xb4\x81Q+M\xa7>v>\xe4\xe1sa\xdf&\xb4;]\xc2\xf0\xf9\x9dֽ.\x9cu\x01YJ\xe1\xba6A\xe0\xf1\x1d7Op\xed\xf6҄\x81\av\x8c\fȬ\xa1\xceW\x85\x02\x17\x1c\xf5\xf2\xe5Fı\xe9f\x06\xb8>O\xae\x98\x80V'\xe6\x9e㫋UϺ\xeb\x05\x97\xb4\x91\xd5e\x042\x8d3vW\xf2\xfd*\xa4\x92c\x91\xb3\xe4Ū$!6\x19\xf2ur\xb2%\x05_\x98\xabc\xe9\xe4ikK\\\x91\x1a\xf7\xb2JkBb\x03w\x9e\xec\xd1θ:\x9dnHg\xc3\t\xa1_\xed\xe59\x92\xf7|\xdf\x01\xae\xdfQ\xdeۋ\xf4\xe1\x91ju\xe8\xab\x11\xca\xc4`m\u007fh\x8c=S\xef>\xf4\x86w\xdf\u007f\xe2m\xff\xcf\x03\xdd'\xa7\x1a)\xa8\xf9\\q\x84\x92\xdcwt\x05\x83Y=1\xbf\xf8\xd4W%\x10_\x802\x86m\xd91w\x89\x81\x9cR\x94\xec\xf5\x16Gi\xe9\x96+/2Pt\u0590ߑ\x92'\xea\x1dN\xad6\xc8=\x03!\xd7l\xa0&\r[\xe9֛^\x8d9\xbf\x9a\xf1\xb2\x12\xcd\xd2J\x9d\u007f\xfa\x95\x8e\xee\xc7^=\xc2M\xdb\xdcCJ\x8a\x0f\x8f֪[^\x88P&\akL/,=9\xb5\xb0t\xcb\xf9\xc5\xe5\xebJ\x81}\xf5\xd4J\xf7\xc7\u007f\xe6/\x9e\xec-7\xa3\xe7\x84\xd1-%x\xf4\\\x9d\xa2\xa3f\x00\xf6\x8f\x0e\xbd0\x81\x88\xac\x92i\xd0w)9i9\xb0\xab\x04\x15G\xf59\xab\x891xY\xef\x13\x91\xe1\xebiZ\x17\x1a\xb1\xe6\xf3\xb3+\f\xd8\xf2\x99qeq\xf1\xdc\xf5\xb2\xba\bN>p\xa6\x87R\xa9g5\xb7\xb8B\x18\xc5_\xbd(\xb2\x9e\x8cGONq\xf6\xfc\x1c\xaf;\x18\xf0M{\xbc\x97*!\xbe\xbce\xb0v\xc5W\xab\xbe\xce\xce/\xb6\x97\xd6V\xff\xa0ލ\xef\xfa\xeb/O\xe39\xcfN\xefw,\xc9\xc3'\xd7@p'@#N^\x98@\xa4\x00O\b\x86\xb3\x13Ԕ\x80\x8as\x91\xb9\x98\x98\xb4\xf6\xc3W\xb2\x1f\f\xaeSH\x95\x94|\xee\xc2*y\x92gM\xa3\x8a\xec\xbe\x1apH\x99\xddB\xc0|\xfd\"\x91M\bx\xecȩ\xaf:\x1d\xbb\xb0\xb4\xcaC\x8f\x1fgay\r\x93\xf5\\|͕>\xaf\xd8\xedo3pxb\xa0v\xcd\xf39\x92\xf5\x99>c[\xf23w\x1eY~\xda\xdc\xfdz<$\x04\xfc\u007f͝[l\x1cW\x19\xc7\u007fg\xce\xcc\xec\xce\xecz\xd7\xdek|kl\x13;))\x17\x85\x8b\x14qQAj \x12R\x85\x10*RU.BU\x05\x12O\x95\x10\xe2\xf2\xc0\x03\x0f\b\x90\x10\x0f\x14\x89\x14塴 \xc4Ky\x80\xa2R\xa9 \x14\x05\xb5MK\xa9\x9c֕c;qlg\xbd\xbe\xadw\xbd\xbb3\xe7\x1c\x1efvmrq\xecm\x1f8\xd2>\xee<̜\xf3}\xdf\xf9\xbe\xffe\xa3\xd6f\xb9֢\x90t.\xec\xf7\xbc}\xbb\xbd\xf9\x84$\x11\xdb\x04\xf596)\x19q?:/3eK\x84ա\xa9\b\xa4e\xa1\x95f\xbe\xde\xe2\xfav\x03\xe7\x00\x81\x84\x18\xe2/c\xe2\xa7\x06\xaa\r\xc5BU1\x92\x93\x84\n\x82 \xe4\xf2\xcc\x02'\x8e\x8dƭ\xfc\x8e\x1c\x93\xd9EcjC\x18\x86\x04\xa1\xa2\xd9j\xb3U\xabSY۠\xd9j\xe3:\x0eɤ\x8b\xeb8\xf8\xc9\x04\xbe\x97\xe4{'}\xa6.\xac\xfa\xbf~a\xfew\xa5\x81\x81\xcf\xdeX_\xbfҋ\x99\xb1\x80\x99f\xa8X\xdbj\x93\xf6\xed.\"\xa6\xb2\xd5\xe2ً\xcbT\xb6\xda|\xf9S#\x04aTpܗ\xf2_\xbc\xd8\xc3Ʋ%\xbb'±,r\x8e\xdde\xb4\x1a\"'M7V\a\xeaVZ\x80\x16\xf0\xc2\xf2\x06(u[\x1d\x90\x9b\xa9\x03\xae\x94\xb1\xdah\xcc\xfd\x88\x9d\xa9\x9fy\xa9η\xee\xefõ\xa3\x12zk\xbb\xce\xf4\xcc\x02RZ\xdd_g\x1a\xa3\x94\"\f#\xbb\x8a \bh\xb6\x02\xb4\xd6d3)\x86\xca\x05R~\x92d\"A\u008d\xee'\x1d9\xa8\x87>9\xccV3\x98\xfa\xed?\x16ϕ\xf2\xb9\xcfx\x11\x8b\xee\xb0Kۖ \xe5\xd9]z\xdf|e\x87\x1f\xfe\xfeM\xa6\x17k?G\b%,\x1e\x9f\x1aLa\f\xd3ON\xcf6{9\xe9\xb6%\x04\x19'\xda\xe3\x1dtD\xa7\\\xeds$\x19'\x12\x99\xb4\x89h\a\x1aCRZ\xfc\xe9\xfa\x06\xd5\xc6\x0e\xd9}H\x9e\xdaDӽ\xc9l\x86\x9a\xd2\xf8\xd2B\xab\x90\xd9\xd5F\xe4\xacc\v\x82\xa6揯\xee\xf0\xc5S~7|\xd5\xee\xe23\xd8)}\xf3\xb9\f\xa3C%\xbc\x84{\v\xb7Q\xedA\xd3\x1b\x03\x8f\xdc?\xca\xccR\xfdӗ\xael\x9e{\xabR\xfd\xea\xd1R\x81\xf9\x03H\xbevO\x93 \xe7;\x16i\xcf&P\x86W\xde^\xe7\xbbOMS\x0f\xd4\xf7]\xc7\xfa\xb1\xd6\f-V\x9b\x8f_\xaf6\xc1\xf0\xe4A[%\xb7\xcd!)G\x92\xb6#\xe8O\xe7c\xf8Ң\x90\x8c.oN\x17\x12\x14uagjM\xfe\xb9\xb2\xbe/\xe3V\b(x.\xef/\xf4\x93v\"\xb9?\x04\x94|\xaf\x1b\x1e\xb3\x12\xc6\\\xc1\xcc\xf56\xaf\\m\x1fH\xa5CJ\x8bb\xbe\x9f\x8f|\xf08''\xc7\xf0\x93\x89\x03\x11M\x93\xae\xe4;\x9f\x9f$\x95\x90_\x19-\xe6\xcf\xce\xdfX\xe5\xe4H\xfe@9\xa5\x9c\xcdZF\xf1\xed\xb3\x1f*\xb3Y\x0f9\xff\xfc<\xdf<\xf7\xfaz3\xd4__\\]\xfb\xd1\xe2\xeaZ(-њ\xbbQgv\xa5N[\x9b\xa7{\xc9\x1f\xbbe\xaf-\xc9ġ\n\"1ɂ\x17\xc1\U000e523c\x01;\x18\xabZ\xa0\xf8\xc3B\x85!Gܶ\xaa\xd2\x06\\\xcbb,\x93f,\x86c\xee\xcd#&F\x93xR0\xd1\xe7a\v\xc1\x88\x03Ͻ\xbe\xc3ҖB\xde\x06$\xa3c\xbd\xaaRa\x80\xf7N\x8e151\x82\xeb\u0604\xea\xe0\x15\x8cֆ\xc1|\x92\xc7Ό\xd1h\xa9\x9f\x8e\x0f\xe6\xfb߸V\xbdk\xe2\x18/\x15\xb2\t\xd7~\"a[Ǖ2<\xf6ī\xcd_\xfeu\xeeYWZ\x0f,T\xaa\xbf\x19\x8b+7!\xf0끦\x11\xe8\xf3R\xb0\xd2\xeb\x85\xd42@\x9fmu\xa5\xf4l+Ҫ\xea\xe0\xb2\x1ckWQ\xcd\x00\xcf-o\xa0\x82\xe0\x8esqߑL\xe5\xfa\x18\xb8\xc9^\xa8\x13\x8e\x9a\xb1\xe5Q\xc1\xb59\xe2'\x98\x1c\xe8#\xe7J2\xc2\xf0\xd4\xc5:7\xf3v\xb4֤S\x1e\xf7\x1d\x1fg|\xf4\b)?\x89R\xba'<\xd6NKq\xf6T\x89\x8f\x1f\xcfݻ]W\x8f\xde5\\\x15\xf2(cN\x1bxXa^;\xf7\xb7\x85\x9f\xbc\xb9\xb4\xfd\x89\x84c}\xe9\xdaj\xf5\xe5\xd1b\x9e\xb9\xddS\xf0\x80\x80\x86\x14\xfcl\xa1Rս\x9c\x0e\x00\xfb=\x9e\x13\x13K\xa2U\xde\xe3\xcc\xe9\xc6\x1d]\x1d\x1f\xa5K\x1b\r^\\\xd9d\xe8\x0ergi\xd7\xe1X\u007f\x1a)n\x9d{t\x90\xf5k\xed\x90\xc0@9\x11\t\xe5۶d\xb2\xbf\x0fks\x9b\xd7\xea!\u007f~c\x87\a?\xe0\xd3\n\xa3\x19H\xb9\x98c\xe2\x9e\xc1}\x86Y\x87\xcc\xcc\x06\x1e=sT\\\x9a\xdd\xfcƉr\xf1\x99\xcb+\x95kw)\x81\xff\x02\xa4\xf7k\xdf\xc7\xeb\xef\xc0\x83\xf3\x95\xea\u007f>Z\xcc\xf3\xaf\x1e?\x88\xf5\xbe\xfe\x14\x9b1\x9c\xb0\xec\xb9\x11\xc3\xd6ĺX\xb1\x8c\xb5-\x04s\xf5&\xe7g\x97\xbb\x02`\xff\xfb\xb2M<7I\xdd\xd1\v\xaa\xe3O{\xa5\xd1&%\x05\xc5=^PI[2\x91I1\x91\xb0\xb8t\xad\xcd\xf2V\xc4M?:\\bjb\x94w{\x8d\xe4=N\x9f\x18\x18\x17\x98\xaf\x01\x9c\xbe\xe7ȡ\xfe\u007f\xf3\ue3d3\xf7嫕\xea\xf3\xa3\xc5<\xefD\xb5\xdf\x1a\xf6]j\xa1\"\x9f\xb4\xf1eTI!\xa2./&\"\xe8\xccm\xef𫷗\xc8\nnQ\xb9V\xc6P\xf0\x92\x1c\xebO\xdf\xe2\xe4\xb9wW\xea\xf8rx\xa5\xde\"mK\n\xeen\xce\xd2\xc6\xe096'\aҸ\xca\xf0\xef\xc5\x16c#eF\x06\x8b\xd1X\xf4]^^B\xf2\xb9\x0f\x1fA8\xd6\x0f\xceL\f\r_XX~G\xcf\xdb\xfb\x81\xaeV\xaa\xf4\x1a\xae:\x17Ög[2\xebت\xa3\t\xe5\xc6:\xb9\x06Xn\xb4yz\xee\x864\xa1־Ĭ+\x13\xc97\xc4ѣ\xe8%\"\x9b;!\xf6\xd1H1`\x90\xb3\x8d\xb6\xda\xd1\xc6\x1d\xf6l\x9d\xb6\xad0\xd8s\xeb\xd5ƐvlN\xf5\xa7\xc4lU9\t/\x1d\xa8\xc3B\xda\x0f\x91\xe0?voΌ\x97R\xde\xdcR\xfd\x17\xc0\x17\xf8?Y\xff\x05\x89p3\xa01\x01Ba\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_12_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14#&Qm\v+\x00\x00 \x00IDATx\xda\xec\xbdy\xb0e\xd9u\xd6\xf9\xdb\xfb\xccw\x9eޔo\xca9\xb3*+kP\xa9TU\x9a\f\x1e1\x83<`\xe4hcc7\x98\xa0\r\x81\x1b\x9a\x0e\xdcDT\xd3\x11\x1d\xd14\xd0t\x9b\x06c;\xa2\x1dt4\x10\x80\x1d\x06,#dI\x1e4 W\x95T\x83jȬ\xac\xac\x9c_曇;\xdfsϴw\xff\xb1Ͻ\xef\xa5T\xb6%[r\x95\tnDF\xbex\xc3\x1d\xf6:k\xado}\xeb[\xeb\bށ\xc7\xfb\x1f?\xc1\xb3/\xdd\xfa\xca\xefـ\x83֎\x069\xf9\xbe\x10b\xf2\xa5\x06\x12 ~\xf6\xa5[)\xff\x85>\xc4;i\x8c\xf7?~b\x16x\x04\xcdq\xa5\xb2\xf3Z\xebSRZK\bʹ\x01\xd0J\xa15 Ȥ\x94w\x84\x10W\x81\x1b\xc0M\xe0\xfa\xb3/\xddz\xebw3\xf4\u007f5\xc8\xd7f\x88\x1a\xf0w\xb4\xd6߭2u\\Z\xb2\\\xaaU\xa86k\xf8\xc5\x02\xf2\xd0+Ȳ\x8c,\xcd\x18\x0fG\x1c\xec\xec3\x1e\x85\b@J9\x12Rn\x03w\x80O\x02?\xfb\xecK\xb7\xfa\xff\xd5C\xbe~c<\x03\xfc\xcfZ)\xd7\v\x02f\x97\xe7i̵\xb0l\x1b\xad5Zk\x8eƨ\xfbޤ\x10\xa4q°ۧ\xbbߡ\xb7\xdf&M\x12\x84\x94\x1a\x18\x01?\r\xfc\xf4\xb3/\xdd:\xf8\xa3\xea1\xe2\x9bi\x04\x80g_\xba\xc5\xfb\x1f?\x11\x00\xdf\r\xfc\x13\xad\xf5\xa2_\f\x98[:\xc6\xcc\xe2\x1c\b\x90B\xe0X6\x05ߣ\xe8\xfb\x00\xa4Y\x86R\x9a4Kɔ&\x8ac\xa2$Ai\x8d\xd2\n!L\x9a\x19\xf5\x06\xb4w\x0f\x18v{DaD\x9a$(\xa5\xfe\xa9\x10\xe2_\n!n>\xfbҭ\xfd?J\xc6\x11\u007f\b\x1e\xf1\x14\xf0\xb7U\xa6\xbe\xcf\xf1\x1cf\x97\x16h\x1d\x9b\xc3\xf6\x1cJ\xaeK\xa5\x10\xe0y\x1e\x8e\xed\x82\x00\xa5\x14Jk4\x1a\x9di4&\x87\b\x01ZC\x92\xa5\xc4IJ\x18E\x84a\x84B#\xa4\x04\xad\t\aC\x06\xdd\x01\xfd\x83.\xfdn\x97x\x1c?oY\xd6\u007f\x90\xb6\xfc\xe7\xbf\xfd\xc2ͽ?\n\x86\x11\xdf\xe4P\xf5\x97\x81\xbf\x97eY\xab1\xd3d\xe9\xecq\\\xcfC\n\xc1J\xabJ1\xf0\x01i\xaez%\x10h\xa4V\xf8\xc2\xc0\xac\xb1\x86X\x83F\x90\x89I\x96\x9f\x843M\x9ad\xf4\x87!\xfdpD\xa22\x84\x00\x81 \xcb2T\xa6跻l\xdc\\ˢ\xd1xS\xda\xd6\xcf\xfe\xf8\xff\xf0\x13\xff\xfb_\xfa\xe1\x9fzW\x1b\xe5\x9b\xe5!\x02\xf8(\xf0o\x00q\xe6\xc2Y\xea\xf33$iJ\xd9w9ѪP\xb4\x1c,e\x8eV\t\x85\x90 \x15\x90i\x93ԅ@\x02)\x92a\xaa\x18iM&\x04Y\x9e\xf0\x85\xc8-$ \f#v\xbb]\xa2$\xb9\xff\xc3\t\x81\x10\x82\xee~\x9b\x8d\x9bw\x19\x8f\xc2=\xad\xf5\x0fJ\xcb\xfa\xcco\xbfpC?\xf1\xf0\x12/\xbcv\xef]e\x10\xeb\x1b\x1d\xaa\xeenvX>V\xffN\xad\xf5\xc7|\xdf\x13\x0f\xbf\xefQ\x8a\x8d*B+N\xb4*\x9cnT)`ai\x8d\x95{\x82\x85\xc0\xd2\x02\x89@\ni\x0e\x12\x01\x18\xa3\xf8\x96\xa4dI,\xad\xd0J\x83m#\xf30%\x00\xc7u(\x06>i\x9a\x11\xa7\xf7\x97(Zk\xfcb@ka\x0e۵\vY\x9a\xfe\xe8x0\xb2g\x1b\x85\x97^\xba\xbc\x11M\xde\xf3\u007fq\x06y\xf2\xd1\x15\x9e\xff\xf2\x1d>\xf8ĩ\xb3Z\xeb_s\x1c;8{\xf1\x01\xbcR\x80К\x87f\xeb\xb4|\x0f\xa9\x04\u0092\b\xdb\x06\xcb\x02)M\x82\xf8\x1a\x1e\x9e\x14\x14|\x8f\xa0X\xc0q]\xb0,\xd2\xdc\x00\x96\x94\x04\xbeG\x92fDIr\xb4\xa0\x9c\x86\xb9R\xb5L\xadU\xc7\v\xfc\x0fG\xe1\xf8\x03\x8d\x82\xfc\xec\v\x976:O?v\x9c{[\x9d\xff\xf2B\xd6S\x8f\xad\xce+\xa5~]J\xf9\xd0\xd2\xc9\x15\x16O.\x83\xd6<\xb10\x8b+\xa51\x84\xebN\r\xa0\x0f/c\xf3f\xd2\x14\x9d\xa6\xe6m\x89\xfb\u007f6\xfd\xdf\xf5\x10\x85\"\bA\x06\xf4\xe3\x84\xfe`0\xfd0Y\xa6\xd8\xed\xf5\xe8\x0e\x86\x87\u007f\xf36\x8f4N\xb8s\xf5f\xd4\xdd=\xf8\xee/\xbev\xf73O?\xba\x82\xb0\xacw<\xb7Xߨ0\xf5\xd4c\xab\xcd,\xcb~Qk\xfd\xe4\xcc\xc2,'\x1e<\x83\xad\xe1=\xf3-|\xdb\x02\xc7A\x1e1\x06B \xa4DJ\x89\xb4-l\xd7\xc1.\xf88\xc5\"v\xd1G\xfa>\xc2q\xc0q\xc0v\x10\xae\x83p\x1cc')AJ\xa4\x80\xc0\xb6\xb1\x1c\x87q\x1c\x9b\x94\"\x05\xbe\xe3`Y\x16Ҳ\xb0,\v\xad\xd4\xfd\xde\"@Z\x16\xb3K\vv\x9a&?Z\vd7S\xfa\xd5/\xbe\xb2\x96\xbe\xd3!\xec\x0fl\x90\x82\xa7\xd8\xefD\x1c\x9b\xad\xfc|\x96d\xdf;\xb38ϩ\v\xe7p\x04<4S\xa7\xe0HsU;6B\b\xb4\x10H\xcb\xc2\xf1]\\\xdf\xc5+x\xb8\x81\x87\xed\xdaخ\x8b\xe5\xdaX\x8e\x8d\xe3\xba8\x9e\x83\xb4m\xb4\xd2&wHa\x8c\x91e&\xdcy\x1eH\x89-$\xb6\xeb\x90\xc4)Ji\xa4\x148\x96\x05\b,\xdb\xc6u\x9ci\x82WG\xbcF)E\xb5YGe\xeaO\x8c\x87\xa3ǖ\x17j\x9f\u007f\xee\xe5۽w\xd2(\u007f \x83<\xf5\xe8*\xaf\xbe\xb9\xc3\xfb\x1eY\xfe\x13i\x96\xfe\x1f\xc5J\x89S\x0f\x9d\xc3s\x1d\x1e\x9dkQp$\xc2\xf3\xcd\xe1\x01BH\xfcb\x80_\x0ep}\x0f\xdbu\x90\xd2\xca\xe3\xbd@Jsh9~E \xb0m\v۵\x11R\xa23\x95\x1b\xccA\xd8\x12-%\xdavL\x92\x17\x12˒\xa4\x13\xa4\xa55J)s\x01H\x89m\xdb\x008\xb6\x8d\xcek\x9dɣҨ\x82\xd6g\x06\xdd\xfe\x9f]]l|\xecٗnu\xde)\xa3\xfc\xbe\r\xf2\xd8\x03\xf3\xbcxi\x1d\x80\xf9V\xe93\x96\xb4*+\xe7NRk\xd6ypa\x8e\x8a%\x11V\x1e\xaal\x89e[\x14\xaa%\xdc\xc0CJ\x83\xa4&4\x89\x00\x84%\xbf*\xb9M\xf3\xb2\x14HK\xe0\x06~\xfe\xb7`\xd9\x16\xaec\x9b\x83\x17浤m\x83\x864\xcd\x10\xd3jE\xa0`ʏi\xc0\xc9C_\x96e\xd3\u05eb\xb6ꌇam\xd4\x1f\xfe\xe0\x8a1\xca\xc1;a\x94߷A\xb6\xf6L\"}σ\v?\t\xfc@\xb5Y\x97\xa7.\x9ca\xa15Ì%\xb1\x84F\xb8.\x96\xefb{.~\xb1\x80\xed\xd8G\xf1(2\x0f#\"7\x90\x10\x02\x9d\xe7\a\x83\xbe$\x02\x81\x90\x02!-\x844\xe1NZrj0ǖ\b4\n\x89\x90\x12\xc7sљBe\n!%\x99V\xb9ׁ\x14rʗٖ\x85\x94rj\x14\xad4\xcd\xf9\x19\xa2Ѹ4\xea\x0f\x1e]Yl|\f\x18\xff\x911Hn\x8c\xe3\xc0\xcfJ\xdbj\x9e\u007f\xec!\x1a\xf5\x1a\xd5 \xa0\"\r\xd7!=\x17\xc7\xf7\xf0\x02\x0f˶L\"?\xf2\x8f\xfc\x9f\x9e|\x8d B\x92\"ш\xdcC\xf2\xf0%\x0e\v=-,cD0ս\x14H!ȴ\xf9\xb9㹤i\x8aJ3\\\xc7A+E\xa6\xcc\x05`I\x89\x02\x94\xd6X\x96\x85%\x04I\x0e\x9d\xb5Ҕ\xebU\x86\xbd\xfej4\x8e\xdc\xe7^\xbe\xfd\xa9?l/\xf9\x83\x84,\t\xfcwh\xfd\x03'\x1e8Cc\xb6E\xd1\xf3\tlI\xd12\x87\xe7\x04~\x9e\xb0\x9d\xafl6\x99\x03\x10\x02myha\xa3\x91(a\x91a\xe5\x14IN\xa7\b\x93\xcb\r\xb1\"\xd0ȩ1\xf5ĳ\xb4\xc6\x12\xe6\"\x98\x18\xc5vl\xd28Ak\x85\xefxh\xadȔ2\xa8NJ2\xa5\xd0G\xbc4MS\x84\x10HKb\xd96\xbdv\xf7\xe9\xe5\x85\xda/\xfcaS\xfa\xf2\x0fP\xc0\x14\x84\x10\u007f+(\x97h-\xcc\xe0\n+\xe7\x914\x89>\fKB\xbe\xfdKha\xa1\xa4\x8f\xc6BI\x1bm\xb9(a\x9b$\x9f\xbf-!@iA\xaa\x8d!@\x92!I\xb5D\xe7\x15\xbd\x96\x16Z\xda \xc0\x16\n;/alǡP.M\x8d\x17\xb8\x1e\x81\xe7bI\x89\xeb8\x04\x9e\x87e\x19O\xb3m\x1b+\a\x1eZk*\x8d\x1a\xae\xef\x01\xfc\xad\xa3\xcc\xf5\xbb\xd2 \x8f?tlru\xff\x05\x04\u0379\xe5\x05la\xa0\xa5\x94\x82\x04\x18+ЖE\x1agd\x99BiS\x1f\x1cZS\xa2\xa4\v\xc2BY\xf6!\xc1\x88@\xeb\xbc`\x14\x12\xa4\x8d\xb0]\x84\xe5\x93Y>\xca\x0ep\xfc\x02A\xa5\x8aW\x9b\xc1\xaf6q\v\x152!I\xb1Q\xda@b\x99\u007f0\xcf\xf7\xf1\v\x05\x03\n\xa4ılʅ\x02\xe5  \xc8IN;\xafU\x1c\xfb0\xbfَM\xa9Z\x02\xf8s\x93\x16»2d}\xf8ɳ<\xff\xe5\xdb<\xfd\xbeU7\x8b\xb3O\xfaA\xe0/\x9eX\xa6\x10\x048\x8e\x8dk\x1bx*\x1d\x1b\xdb\xf7\x91\x05\x1f\xe9: $\x896W\xaa-1\x87+m\x93\xc0s,\xa4\x01\xa53\x13\xd7m\x1b\x84\x85\xe5\xb8\bi#,\x1bi\xdb\xf8\xc5\"\xae\x1f`\xb9.\x8e\xe3\xe2\xf8\xbe)6\xa5\xc9O)\x82\t\xbe\x12RN\v\xc0$\x8a\x11h2\xa5\f0\x90\x16R\b\x92,#\x9b\x14\x8dB\x1cB\xe6\xdc\xc3\xf67wJ\xab\x8b\x8d\u007fuw\xa3\xdd~W\x1a\xe4\xce\xfa>\x00\xb3\xd5¿\x92R\xbe\xb7ڪ\xb3\xb0\xbcl⮔8\x8e\x8d%%\b\v\xdbw\xb1]\a!$RH\x12\xa5\x19+\x89\xb0\xcc\x15?9\x04\xa5\xf44<9\xaekHCӢ5\u007fkYXRR,\x97\xb0\x1d\a\xc7q(\x16KT\xcaU<\xcf\xc7\xf5\x0e\x8d\xe3\xfa\x01BJ\xe28\xce\x1b(&\xb8JK\x12G\t\xb6\x94\xa4Y\x86\xb4\xac\xbc\xaa\xb7\x88\x93\xf8\xb0]\xac\x14*7\x90W\xf0ٹ\xbb\x89\xd6\xfa\xe6\xdd\xcd\xce\x17ߵ!\xeb\xa9GW\xbf[\b\xf1g\xa4%Y={2\xef\xdc\tԄ\x92\x90\x06Z&IF\xaa4Q\xaa\xd0S\xb6C l\x0f\x9dcV\xa5\x95\xe9\xfe\xe5W\xad\xd6:7\x90\xc1O\x13o)\x94\x8a8\xaeK\xa9Tdff\x9ej\xb5IP(\x13\x04E\x02\xbfH\xb1X\xc6\xf5L\x8d\x12\x14\x8a\x94\xab\xd5C8m\t\\\xcf\xc3\xf3=4`\xe7\xadb)\x04R\x80\xefyS\xc4\xe6:\xceam$%\xe5FU(\xa5\xbe\xef]\t{\xbf\xe5\xe9sxnV\x90B\xfe\x03\xad\xf5CK\xa7Vi\xce\xccLѓ\xa1+l\\\xd7E\n\x93\v\xfc\xc0CZ\xb6)̤\x85\xe3\xb8\xd3p\xa1\xb56\x14\xbb\xe6\xbe\n]J\x91w\b\r\x1ar<\xd7P-\xaeC\x10\x94ql\x17˶i4\x1b\x94+5\x13\x92\x92\x04\xdbr\x88sT\xe5朙J3Sw(\x8d\x90\x828\x8a\xcd\xeb\xe6\xaf?\xe9NN\vD\xad\x89\xa7aKcI\x8b\xbd\xcdmu|\xb9\xf5\x89\xbb\x1b\xed\x83w\x95\x87|\uee6bTK\xe5\xf7*\xa5\xfe\x8ce\xdb,\xac,\x91%\x99\xf9p9:\x89\x93tʰ*\xadH\xd2,\x0f\x05\xdaxФ~>\"f8\xac5\x0e\xeb\x0e\xa5\xcd\xefL\xea\x16\xdb6D\xa19&\xe39\xbeSĒ6\x81W \x8e\"\xf3=\xcfG+S\xf5\x17\n\x05c\xcc\t\x81i\xc9)\x92\x9a2͓\xf7!\xc4a\x8d4\xf9\xb9Ҕ\xea\x15lۙQJ\x9dxW\x86\xac\xd1(\xfc\xbf\x040\xbf\xb2\x00J\xe7!Z\xe4\x06\x80(I\x19\xc7\xc9\xf4\x13G\xe3\x98,\xd3y\bKM\xb1\xa6\x15Y\x96b\x9c\xc3\\\xadJ\xa5h\xa5\x0e\xe9\x94#\x06\xd3*\xbf\xa2\x95F\xeb\fP\xc4qL\xa7w\x80R\n\xd7\rh\xb5\xe6PJc\x1dm\\I\x89e\xd9F2dI\x83\xa6\x9cChK^\x1c&ij~\xe7h\xc1:9\x1c\x13\xb6\n*\xcb\xea\u007fX\x06\xb1\xbf\xd6_<\xbdR\xfecq\x1c=\xee\xb9%f\x8eͣ2\x85\x95\xf3Ob\xca\x1a\t\x06\xc3!\xaec\xe386i\x9a\x12\x8d\xc7x\xbe\a\x96 \x1c\x87&YOk\x1315B\x9aFX\xca¶\x1d,\xcb\xe4!\x95)\x94ʈ\xa3\x18KJ\xe28\xc1\xb2\x12l˦\xdf\xef2\x1c\xf6\x8d\x11\x84\xc4u]\xd24\xc1\xb2l\x94\xca\x10\xc2\xe4&\x91\xab#,\xdb\xc6\xf3}\x92$%K\x8c\x11&\xf4\r\xc2\xf0]\x13#\xa8\t\x9db\xba\x8d\x96\xdaR\xb3\xef:\x0f\xe9\xf5\xfb\xdf\xe38\x0e\xb6\xe3 -I\x1e\xa7\xa6hiR?h\r\xa304\x8c\xaaR\x8c\xc31i\xee\x1d\x00Q\x1c\xa1\xb2\x8c8\x8e\t\xc7#\xe28\"M3\xa3(IR\xa2hL\x92Ĺ\xca$#\x8d\x13c\xd8(&\x8aF\x84\xa3!a8\"\x1a\x8fIӔ$\x8e\t\xc3\x11\xe3q\xc88\x1cMá\xca2\fz8|8\xae\xe9\x93LY\xcb#\x069ڇ\xbf\xaf\xbdP, \xa48\x99K]\xdf=\x1e\x02\x9cpl۰\xb5B\"\x90X\x8e=m\xf8\x00\xa4J\xe1HA\x92\xa4\xc4q\x82c\x1b\x83\x85ᘠ\xe0\x93\xa6)\xae\xeb\xa225=\xb8H\x8f\r\x10\xb0dN\xc5K\xd24%\xcb2SA\xdb@\x14#\x10\xf9߁\xab=dj1\x18\xf4H\xd3Ġ&)A\v\x942Ww\x9afFVt\x84f\x97B\xe28\x0eq\x1cOQ\x98\xccC\xe5\xc4\x18V\x0e\x12&Fr\x03\x1f\xc7\xf3jY\x92X@\xfan2\x88 \u007f\xc3\x00ґ9)x\xff/%J#3\x93\xe0M\xc2ϛKZ\xe3\xb8\x0ea\x16\xe2:.vް\xcaRE\xa4#,\xcbƲ2R!s\xe3\bS\x13\xc4\t\x8e\xe3\xa0\x01\xd7uɲ\x8c$N\xa6\xf0\x1a\xc4Ԁ\x964\x89;\xcdLN\xca\xd2\xf4>%\xa4\xd2\n˶\xb0\x84Eʄ\xbb\xb2\x90G\x14*\xb6m\xdf\xe71^\xe0јk\xfakWo\x89w\x9b\x87\x8c\xd1\xe6*\x9b4\x8f&W\xf9W\xbay\x9ce\x88q\x84m\x1b\xe3eY\x8cR\x8aLe\xd8֤g\x91\x9a:Òhmz\x13J)S\xcb$\x02˶\x10\xd2¶-\x92$2\x87\xee\xfb\xb8\xae7-\xde&]\xbf\xc9{\x10\"\x17\xd7\xe9<\xf7\x8c\xc7\x1c\xaa\xec\f@\x98P#\x99\xca\xf2\xe20\xef@N\xda\xcaB\xdc\u05eb\xb1\x1d\x87R\xa5\xbcR\x9fi\xda\\\xdb}W\xa1\xacm\xa5\xb2)LԿ\xc7\xf5\x12e\x19\xc3aH\xa6\x8c$t<\x8e\x18\fFDQL8\x1a\x13G1q\x143\x1e\xc7\x06}\xe5\x87lX]\x88\xe3\x84h<&\f\xc7$qJ\x96\xa5\x8c\xc31\xd1xL<\x1e\x93\xc41*3\xb0[\xe6]A0^\x95\xa6\x19\xa3\xe1\x804M\xa6yN\xa3\xa7\xafa\xbe\xce\xf2^{\xdeo\xe1w\xce#~\x10\x9c\x9e[\x9cw~\xf8G\xbe\xf3]\xe5!\xeb\x99R\xa4G\u00808\x02!\xef\x0fn\xe6\x03E\xa9b\xdc\x1db\xe5\x82\x04\x80a8\xa6\\*\x1ao\xb1-\x1c\xdb!KӼZ\x97yun<G)=\r=\x93\x9f\xa5I\x8c\xe7\xf9X\xb6M\x98Ʀ=\xeb\xb8ؖ=-\x12G\xc3\xee\x946\xd1B \xf4\xa1'i\xad\x89\xe3\x848I\x0f\xf3\x8b6\xa17K\xd3i\xf8\x9b\x18Ek\x8dW\xf4\xe7,\xc7Z\xfa\x85\x9f\xffX\xfb'\xfe\xc6G\xf9\xb9\u007f\xfcK\xef\n\x83\xbc\x9cf\x19I\x14\x93%\x19\xae{x5\xbf]\xd8\x12`t\xb7hR\x95!\xf2\x9e\xb9N3\x0e:=\\\xc7&\xf0<\\\xd7Ʊ\x1d,\xdbB\nH\xf2+VJ\xd3\xd1s\x1cS[(mP[\x96eĹ\xeeʚ\x14}Q\x94\xf3^\x92\xa9\x17k\x99S\x96\xc66Y^\xb5\xab\xcc\xd41J\x19ѝ\xb0@ke\xc4\xddZcI\x89\xe7z(\xad\xa6\xc8PH\x89\x13x?\x03|\xcb7\xd3\x18_\x17uR*\x88Ē\xf2oږE\xa5^\xa5T.\xe7\x01\x86\xc3\n\xf7\xa8Qr\xfc?\x01a2\xff\x9diQ\x96)\xe2$&\x8a\x12\x13ϕ6\"kmX\xd9,MI\x93\xf4\x90\xf0\xcb;\x86\bs\x80Z\xe5ԇ2\x14\xbf\xb9\xb2\xd3\xfc{:\xf7.\x03&\xb2,#M\xcc\xcf\xc2Q\xc8h\x14\x1ao\x11\xa0 /X5RH<ߣP(\xe0:.\x8e\xed\x90$\xc9İ\xabO<ua\xf0\xe2\xf3\x97\x9f\xfb\x89\xbf\xf1Q^|\xfe\xf27\xc5 _\x17rX\x9e\xf7\xd2f\xadf\xcd,\xcc\xf1\xd8\xfb\x1eg\x14EG\xb8\xac\xdf]\x81(\xa5\xc0u\x1cli\xa1\xb5\x02\xf4T\xb0 \x908\x8e\x857\xa9\x13\xa6\xa8\xe8\xfe\xbe\xbbmY8\x8e\x9d\x17\x8f⾚B\xe6ݾ\t\xe0\x98|4\xa5\x14I\x92\xa02\xe3\x05\x9dv\xc7x\x8a\xd6$J\xe1x\x1eBX\xc4\xf1\x98$I\x10y\x91\t\x90$\xa6\x06\xea\xf6\xba\x13\xbek\x138\xf9s\xff\xf8\x97\xc6\xefhȚoJ\xb6\xf6\x15Y\xa6\xb6\x94R\x8b\x9d\xbd6NNG\xa4YN\xe0\xfd\x1eֵ-\x1bKZh\x01\x8e\xedL!\xab9Ls@\xe386\xfa\xde\\\xc4 \xa55U\xa3\xe8,#\xc9\x0fײ\x13\xac\x89\xe0A\xcaiO\xdd|mM\x93\xf4$\xc4\xe9\xdcKz\xfd\xc1\xa1\x17j\x8d\xb4l\x1c\xc75y\xc2\xf3\xf1\xbd\xc0\b\xec&m\xde,c<\x1e\x93e\x19\xdd^\x17\xa0\x05\xfc\x18\xf0\xf3\xefh\xc8\x1a\x84z\x12\xb6\xfe\xa4m\xdb'њ\x85\xc5c\x94\x8b\xc5\xfb\x14\xe7\xbfS\xbbV\n\x81\xe7\xba\xd3q\x01\x03+\x05\bè:\xbe\x8f\xeb\xfa\xf9\x8c\x88\x95\x03#y\xd8=\xd4\x1c\xe6\x03\xad\xf3䛑&\tIjj\x0e\x95i\xd2,#KR\xe3\x11\x136W)\xe28a4\n\x8d\x98A\x83\x96\x12\xe9\xb8؎\x83纔J%\xca\xe52\xe5J\x05\xdf\xf7\xb1\x1d\a\xa5\xb4\xf1\x16\xc7\xc3\xf3}\xa4\x90\x8cFCK\b\x11?\xf1ԅ\x8f\xbd\xf8\xfc\xe5\xec\x9dN\xea(\xa5n$I\xf2m\x9e㰿\xb3\xcb\xec\xdc\f\x83(\x9a^\x85o\x97\xdc\xcd\xd5g\xfa\xd7Z+s\xe5\xe6!ó]\x82B\xd1T\xcc9\x1d\x93\xa8\x88TeȜt̢\xcc$\xf282\x14\xbfm\u0096e\x99f\x98\xb4\x8c7HG\"\xb4\x98\x00\xa6\xe9\x8cH\x14\xc5$i2EY\n\x81\xe3\xb8X\x96\xa4X*1??\x8fm\xdb8Ρho\xe2\x19q\x1c1\x1c\x8e\x18\rG\xf8s>B\n\xb6\xb7\xb7\x9e\xb4,\xeb\x04\xf0\xe6;n\x10\xe0\xcd4\xcd\x10B\xb2\xbb\xbd\xcbÏX\x14\\\x97A\x18\x1a\xb4\xa5\x14²\x0ee\x9bJa\xdb\xf6\xb4)\x84șX!\xf0\x1c\x87Bш\x10\x1cǝ\x86\x99\x99\x99\x19VVW)\x14\x03@\x10\x86!i\x92\xd0\xebvX\xbbs\x87\x8d\x8d\xbb\x84\xe3q\x9e\xb7,<\xcf\xc1\x92Q.T0\xeaţ\xfa\xab,g\x8f\x95ֆ\xdaq}\x1cסV\xadQ(\x04\x04\x81\xf9gY\x16\xb6c\x10_\x9a\x1e\x82\x898\x8a\xb8wo\x9d^\xb7G\xbdVcgg{\x11XxW\x18D\b\xf1Z\x9a%\x80\xa6\xd3\xee\xd0\xef\xf7)\x04>i\x961\xceC\xd7$\xc1O\nH\u05f6s#\x98\xbc\xa0\x85\xd1\xdb\xfaA\x01)%\xaek\x94\x8c\x8dF\x9d\x13'NP\xabU86?O1W)\xf6\xc7cF\xe3\x88h\x1cs\xf1\xd1\xc7\xd8\xdd\xd9\xe1\xe6\xcd\x1b\xec\xed\xed\xd0\xeft\x18\x8e\x86\xf4G\x03\x04`Yr*\xbasm\x1b/O\xce\xd9D\x97eٸ\xaeC\xabբѨS\xabըT\xaa\x94\x8bE\x86\xc3\x01\xa3(2\xf0\xdb2m\xe0$\x8a\x18\x0e\x86\x8cC\x93Óx:\xe6P\xf9f\xd5#_\xaf\x87\xdc\xcc2\xd5\xc9TV˲\x8c\xebo]\xe7\xcc\xd93\x94|߈ώ\xd4$RJ\xa3\x97\xb2\xed\xc3\x1aDklK\xe2\a\x05\xd3\aw\f\xd5^\xadV\xb9\xf8Ѓ\xd4\xebUf[MC\xaf\x18\xd9\x03e\xdf\xc3\x12\x12),\x18\x87\xb4fZ\x94\xcbe\xc6cS\xb5\xef\xefn\xb3\xb6v\x9b\xb5\xbbw\xe9\r\xfa\x06\\\xe4\x1ej\xe5T\u007f\xe0\a8\xb6\x83\xef8\xcc\xcc\xcc0;3\xc3\xfc\xb1\x05\x8aA@\xab^\xa7\x10\x04dY\x93\xcb\u05ee\xb3\xb5\xb5\xcd\x1b\xaf\xbd\xc1\xf6\xbduƣ1\xe3q\xc4\xdc\xd2<\xb5V\x83\x9dݝ\x89A\x86\xefxa8ߔ\x00]\xe0R\x1c'\x1ft\x1d\x97\x9d\xed]\x9a\xcd&\v\x8b\v\x94<\x97aj\xf8\xa8I\x1eqm\x1b\xebH\xd3G\bA\x10\x94p=\x1f\xc71\xe29\xd7\xf58\xbe\xbaL\xb3Y\xa7V\xad`[\x16G\a\xa25\x90\xe5\xfcSA\x16H\xe2\x84$1\xe4b\x10\x14XXZ\xa1Z\xabs\xea\xf4Y\x06Ð+W\xaf\xd0\xee\xec\x13Ǳ\xc9mY\x86\xe7z\b\x04\xb5Z\x8db\xb1H\xb5V\xa5X(\xb08;k \xaeָN\xc0훷\xf8\x85\x9f\xff\u007f\bG#\xe2(\xcey\xb8\x8c\xf7W>\x00\x8e\xa4\xdd\xee \x84\xfeT\xa9Ry\xfe\xff\xfe\a\xff\xf2\x9d5\xc8־b\xbe);\xc0\x97\xc3\xf1\xf8\x83\xa5b\x91\xd1p\xc4\xc1A\x9bj\xbdJ\xa1X\xc4\xd7\x10O \xa5\x948\x96u\x84\x820:)\xd7\xf7p]ǨA\xa4\xa4V\xab\xb1\xbc\xbcD!\xf0\xf1r#\xa5\x99b<\x8e\xd8\xd8\xda\xe1\xad7\xaf\xb1\xb3\xb5\xc3h4\xa2P,\xb2\xb0\xb4@\xad\xd10I8\a\x02^P$(\x96(Wbj\xb5\x06\x8e㲾~\x87\xb7\xae]ewo\x87(\x8a(\x15\x8a\x94\xcbe\xa3\xb9\xaaTprq\x9cҚ,\xcd\xf8\xb7\xbf\xf8\xaf\xf9\x99\u007f\xfa3\x8cF#\xb2,\x9b\x86\xdd,\xcbX߸\xcb \x1c0\x0e\xc3xwk\xf7\xdf\xff\xfa\xa7\xbe<\xf8\xab\u007f\xf3\a\xf9ٟ\xfe\xc5w6dm\xed+5ߔ_\x88\x93\xe4Ǔ4\r\xa4\x94\xecl\xef23\xdb2=윺\x8e\xd3\xcc\xd4\x12G`\xb0eK\\\xcf\xc3qܩ\xa2$(\x14XYY\xa2V-cۆ\x8e\xdf\xdek\xf3\xdc\xf3/r\xf9\xd5K쬭\x13Fc\x14\x86Ұ\x84\xc0u\\*\xb5\n\u0379Yf\x17\xe6Y>\xb9\x8a\xef\ad9\a\x95\xc41\xd1xL\xab9\xcb\xdc\xec\x02\x9f\xfd\xfco1\x1a\r\xb0m\a\xd7si\xb5ZXR2\x18\x8dX\xdf\xd9Ak\xcd\xcf\xfe\x93\u007fƯ}\xfc\x13S\tQ\xa3ѤZ\xad\xd3n\xef\x13\x86#\\\xd75\r\xb5Q\x94\f{\x83;\x00W^\xb9̻\x01e\x01\xfc'\x84\xd8\xec\xf5\xfb'\x9b\x8d\x06\x83~\x9fݝ=j\xb5\x9a\x91\xdaX\xa6ɔiuH}\x03RXx\x9e?\x8d\xef\xae\xe7R\xaf7X^Z0\"h!\xb9|\xed\x16\x9f\xfe\xf4o\xb1~\xf5:\xa30\xe4\xde\xde\x0e\xbdшT)\xa4\x14X\x96M\xe0\xbaTzm\x9a\a\aܻ\xb5\xc6\xf5+W9~\xe6$\xc7V\x96\xef\x83ކ\x04\x85c\vǸu\xeb\x06n>cbY6*3T\x8c\xed8\xfc\xa3\xbf\xff\x8f\xf8\xd4'>I\x9a&\\|\xf8\"\x1f\xfa\xf0\x87\xb9{g\x83\x8d\x8d-\xc30\x8fC<\xd7Ei\xc1\xa0\xdb\xf5\\\xcf=\v|\xea3\x9f\xb9\xf4\xce\v\xe5\xf2\x8a=)\x17D!˲ow\x1d\a)$a8\xa65ۤP( \xb4\x91\xdcp$o(\xad\xe9\rGT\xabU\x03/m\x9bJ\xa5\xca\xc2\xc2,\xb3\xad& \xb9r\xe36\xff\xee\xdf\xfc2;\xb7\xd7\xd8\xee\xb4yc\xed6\x83qh\x8a\xbd,#\x1c\r\xe9\xb6\x0f\xe8\xb4\xf7\xe9\x8eFl\xf5\xba\xf4GC\\$\xdb\xeb\x9bܸ\xf2\x16\xa5J\x85b\xb9xtx\x11\xc7q\xb8\xb3v\x9bR\xa9L\xbdQg4\x1a\xd1\xeb\xf5Ȳ\x94\u007f\xf1\xcf\xff?~\xed\xe3\x9f\xc0u]~\xfc\xaf\xfc8\u007f\xfdo\xfcuΞ;\x83\x94\x167\xae\xdfb0\xe83\x1e\x0fYYY\xc6\xf7\\67\xb6\xa4\x94\xf2\xfd'N\xcc~\xfeĉٻ'N\xccr\xfb\xf6\xce;g\x90I\xc5>\b\xf5\x17J\x05\xf1CJ\xa9\xa6﹦jNSf\xe7fL\xe8\x99\x14\x889\a\xa5\x95\xa2\xdd\xed\xd1j6\xb1m\x1b?\bh4\x1a\xccδPZ\xf3ڥ7\xf9Ŀ\xfb\x8ft\xf7\xf6\xb9\xbd\xbd\xc9\xda\xce攜\x8c\xc7!Q\xb7\x83L\x13C\xd7HA\xaf}@4\x1a\x90\b\xc1f\xb7\x8d\x14\x02\xdfq\xd9Z\xbbGg\xbfM\xa5V5\x9e\xa0\x15/\xbe\xf8\x02\xed\xf6>\x9e\xe7Q*\x95\xb0,\x8bN\xbbç?\xf1i~\xfdS\xbf\x8eeY|\xe4{?\xc2_\xf8\xb1\x1f\x99\xb6v\xfb\xfd>W\u07fc\xc6\xda\xda-<\xcfeye\x89B! \x1a+\xc6\xe3\x91\x0f\xbc\x0f\xf8\u007f?\xf3\x99K\xe9;\xea!\x13/\x19\x84\x9aRA<\xab\x94\xfa\x8bZk\xcbs\x1d\xfa\xbd\x01\xae\xe3МiN\x1bM\x13mm\x9a)\xb6\xb7w\t\n\x01\xd5J\x05۶Y\x98\x9f\xc5s\x1d.\xbd\xfe\x06_\xf8\xcc\u007f&\xec\xf5\xb9\xb6~\x97\x9dN\xdbT\xf5YJ:\x1c`\xa9\x8cťE>\xf4\xe1\x0f\xf1\xd8\xe3\x8fQo4\xb0m\x87n\xb7K\xbf}\x80\xed\xb8\f\xe2\x98\xeehD9\bH\xa3\x98\xed\xcd-\xb676\xf8\xf4o|\x9a_\xff\xf4\xa7x\xfa\xa9\xa7\x99\x9d\x9bewg\x8f\xc1`\xc0\xb57\xdf\xe2\v\x9f\xff\xcf(\xa5\xf0\xfd\x80\xbf\xf4\x97\xff\x12\xf5F}ZȾ\xf8\u0097y\xf9\xa5\x97i\xb7\xf7x\xf0\u0083\x04A@\x96\t\xca\xd5\x19\xd2,e4\x1c\xcc\t!~\xfb\xf6\xed\x9d\xeb\xef\xb8A\x06\xa1\x9e@\xe0m\xa0\x1a'\xc9Ӗ\x948\xb6\xcd\xfe\xde>\xc5R\x89R\xb9d$\xa6\"\xa7ۥ\xa0?\x1c\xb2\xbf\xbfO\xa1\x10\xb0\xba\xb2\x8a\xe7y\xb4\xdb\x1d>\xf7\xe9\xdf\"\x1a\x85\xdc\xdb\xdbe\xaf\xd3\x06)\r\x918\xe8\x81R<\xfc\xe8#\xfc\xf5\x9f\xfck<\xf5\xfe\xa7x\xe0\xc1\xf3<\xf8Ѓ\b\xcbanv\x96\xe1`\xc0\xce\xd6&\xd9x\x8c\xb6,\xda\xe3\x90\xddn\x87\xf1`\xc8\x1bW/\xb1\xbe\xb1\xce\xe2\xdc1~\xe4\x87\u007f\x98j\xa5B\xaf\xdf\x03\xa5y\xe5\xe5/\xb3\xbb\xbb\x8b֚\x93'O\xa3\x94d~a\x868\x8a\xf9\xd5_\xf95\xbe\xf0\xf9\xdffm\xed&\x0f=\xf4 \xf5z\r\xdbv\x98\x9f_fa\xe1\x18q\x1c\xb3\xb7\xbb\r\x90ܾ\xbd\xf3\xb1o\xb8A\xe6\x9b\xd2\x19\x84Z}\xbdF\x19\x84Z\x97\nb]\xc0\xf7\x8c\xe3\xb8Rȷ\xf8ܻ{/\x87\xb3U35%\x05v>\xc5\xd4\x1b\f\f\x04-\x97q\x1c\x977^\xbd\xc4\xc1\xee>\a\xfd\x1e\xf7v\xb7\xcd\xda\f\x01\xd9hH\x96Ĭ\x1c?\xceG\u007f\xf0\xcfq\ue073\xd3d\xed:\x0e\x1b\x1b\xdb\b)\xa9\xd6j\xf4;\x1dz\xbd\x1e\xe3A\x9f$\x8a\x18\x8fB\xf6\xdb\xdb8\x8eM\xa9P\xe0#\u007f\xeaO\xf3\xde'\xdfK\xb1P`\xd8\x1fbI\x8b\xd7_\u007f\x9dn\xb7\x9b7\xc0\x1c\xc6a\xc2\xe7>\xf3\x05>\xff\xd9\xdf\xe6\xc6\xf5\xebܻw\x8b\a\x1e<\xc7\xe2\xd2\x02\x96eq|\xf5\x04\xe7Ν\xa5\xd7\x1b\xb0\xb9\xb5A\xa7s\x80R\xaap\xfb\xf6\xce\xcf|3z\xea\xffp\xbe)\xbfm\xbei0j~\xf5\u007f\xad0\xf82\xf0\xf7\x05p\xd0\xedN\x0f\xed\x8dKW\xf8\xf2˯2\xe8\xf7\x91y\x82-\x14\nؖ\xc5p4\xe2\xf5K\xaf\xf3\xe5\x97_f\xed\xce\x1d:\x83\x01k\xdb[$yc\xc9\xcaR\xd2q\x88\x1f\x14(\x97+\x1c[\\ \xcb%\xa9RJ\xf6\xf6\xf6\x19\x85#\x1c\xd7Ŷm\x9a\xad\x16\x96e\x19\x9a$K\xb0ɨUkx\xae˙\xd5\x13<\xf9\x81\xa7\t\x82\x80\xd9\xd9\x16\xcdf\x13\xc7q\x0e\x05\xd7R2\x1e\x87ܸ\xf9&\xdb\xdb\xf7\xd8\xdf\xdfD\xa91\x0f?r\x81\xe5\xe5E\x84\x14\xac\xae\x9e\xe0\u0085\x87\fMq\xfb&\xe3q8i\xb2\x85\xdf\x14\x94\x15\xb8\xfa\x13B\xf0\x03B\x88\xf7\x97\n\u2e6d}\xd59\x9a+~\xaf\\2\b\xf5\x97J\x05\xf1\x98R\xea\xbc\xd6\xe0\xfb\x1eB\bz\xdd.;ۻ\xd4\xeb5\x8a\xa5\"\xb6\xe3\x90f\x19Ñ\x11:\x1ct\xda\xf4\x06}\xeemnҪ\xd6\xc1\xb6h4*\f\xf6ws\x85\xa2E\xb3\xd5B \xb9\xf0\xd0\x03ض\xcd\xde\xde\x01\xaf\xbev\x99q\x143\xe8\xf7Y\xbb}\x9b\x8d\x8d\r\xd28FH\x89\xef{\xd4\x1bu|\xd7e\xa15\xcb\xe9ӧ\xb9p\xf1\"\x8dF\x85R\xa9D\x9a\xa6\xf4z}677X[[CJə\xb3\xa7i5\xeb\xb4f\x1a,\xaf,\xb2\xb2\xb2L\xb1X\xc4\xf7}\x1e|\xe0AΜ>K!(\xf2\xfc\x97^`\xff\xa0\xcdx\x1c\xd2\xebv\x01\xfd/n\xdf\xde\xf9\x8do\xb8A\x1e}\xfc=\u007f'K\x93B\x1c\x85g\x80\xbfV*\x88\xa4T\x10\xcfn\xed+\xfd\xb5\xe4\x92<\xc1\xff\x12\xf0W\xe24)9\x96eƎ\x85 \x8ec667i4\x1b\x94\xcae\xaa\xe5\x92Q\x84\x84!\xbe\xed\xe2c\xb3<?O\xab\xd9\xe0\xd8\xd2\x1c\xa7N\xacp\xf6\xec\x19\xf6ww\xe9t\xbaX\x96E\xbb\xdd\xe1\xc6\xcd\xdb\xdc]\xdf\xe2\xf5Ko\xd0\xeetؼw\x8f\xf5{\xf7\x18\xf4\xfb\xb4\xf7\xf7M\xb5\xee\xbb4\x1au\x82 \xa0R.3[o\xb2\xbc\xbaʩ3g(\x95\n\x04\x81\x87\xef\alo\xef`Y6\u05ef_7\a\x1f\xf8\x9c>}\x92b\xb1\x90sk\x16\xf3\xf3\xf3,--\xb2\xb2\xba\x82\xe7y\\\xbfq\x8b\xabW\xdf\"MS\xfa\xbd\x1e\x83A\x0f!ď\u07be\xbd\xf3\r\x1f\xe4\xb1Ο?\xffLc\xe6\x98\xddh\x1d\x03\xade\x1c\x8d\xbf=\xcb\xd2\xef,\x05\xe2\xc5a\xc8\xf6\xef\xe6-\x13\xa3l\xed+]*\x88_\x16\xf0\xe7F\xe3qű\xcdX\x82\xc8\xc5\x05\xe30d~~\x0e\xe98T\xca%\xfa\x83!\xe38\"\b|\x1a\xb5:\x85B\xc0\xb1\xa5cԪ\x15\x00\x16\x16\x16\xe8u{\xec\xef\xed\x13G\x11\xed\x83\x036\xd7\xd7\xd9\\_\xe7\xe6[o\xb1~\xef\x1e\a\xfb{\f\a\x03\x93+*%\x1a\xcd:\xbe\xefQ*\x16\x99o\xb4@\xc3\xdc\xdc\x1c\xc7O\x9d\xa4T\np\x1c\x87J\xa5\u00993gQ:\xe3ʕ7\x19\x8dF\x8cF!\xe7Ν\xa3Z\xabR\b\x8a\x9c={\x9e\v\x0f>b\xbc\xdav\xe8u\x86\xbc\xf4\xf2\x97\xe9\x0f\x06\x8c\x86\x03v\xb67QJ\xff\xf4\xe7>w\xf9\xdf\xfe\xf1?\xfe\xd07\xbe\x0e9~|\xf9\x19)\xa4mY\x16\x95\xda\f\xe5J\x03\xd0\xcb\xe3p\xf0g}WG\xe7ϝ|\xe1\xda\xed\x03\xfd{y\xca־\xea\x96\n\xe29!\xc4\xf7\x8e\xa3\xa8`\xdb\xf6tn/\fC<ǡ\xd1l\x80\x94\x94\x8a\x05\xfa\x83\x01\xe38\xe2\xc4\xd2\x12'Ϝ\xe4\x91G/\xb2\xb8\xb4H\xb1X\x04\xa1\x99\x9d\x9d\xc1\xf5\\v\xb6\xb7\x19\x0e\at{=\x86\xc3\x01J\x1b\xf9PP\b\xa8\u05eb4[Mj5CJ..-1Ӝ!\x8b\x8c\xbc\xb4\xdelr\xfa\xcci\x8a\xc5\xc0H\x87,3\xd15\xbf\xb0\xc0շ\xae\xb0\xb6v\x0f!\xe0\xd1G\x1f\xe3\xa9'\x9ffqq\x89r\xb9B\x96\xa6\xb8\xae\xcf\xe6\xe66/\xbf\xfc\xaa\xc9[\xc3![\x9b\xeb\xa4i\xf6\xf1$V\u007f\xf5\u07bd\xbd\xe4\x1bm\f\x00\xfb\xde\xcd\xebخKP,\x12\x14\n\x14JU\x8e-\x9fafvy\xe6\xde\xddk\xff\xe7ڝ\x9b?\xbcв~ts/\xbb2魿\x1d\xf1\x98\xff\xff\xdc|S\xfey\xe0\x93\xddn\x17)\xc4T\xcb{\xfd\xdaMf\xe7f\xa94\x1bx\xae\xcbұ\x05n\xad\xdd%\xd1)\x8f>\xf1贪_Y]aqq\x91\xfd\x83}J\xa5\x12\xbe\xef1\x1a\x8d\x18\x8f\xc7h\xad)\x16\x8b\xd4\xea5\x1a\x8d&\xbeﱽ\xb5M\x1c\xc7T*\x15\xea\xf5:\x83N\x8f\xa8g6\x01\xe9\x1c(\x98a!\a\xf0\xe9v\xb7\xf9\xf8\xc7?\xcex<\xe6\xf8\x89\x15\xae_\xbbA\xbbݞ\x8e\xd2\t4\xc3Q̗^z\x99\xf5\xf5\r\xc2p\xcc8\f\xd9\xdd\xd9&K\xd5\xed$\xd5?\xfa\xdc\xf3o\x8e\xbei\xf4\xbb֊(\x1c1\xea\xb7\xd1(\xca\xf5\x16\xb3\xf3+8\x9e\xcf\xc93\x0f\xdb\x1bw\xaf?\xb1\xbf{\xef\xf2\\C<\xb9\xb5\xaf^8*z\xf8\x1d\xa8\x95O\xcd7\xe5\x9fVZ\u007f\xbc?\x18Ш\xd7\x11B0\x1c\fX\xbbs\x97\v\xe5\x12ZJ\nA\xc0\xe2\xc2<\xe1hH\xbfۣR\xabN%B\x96m177\xc7\xe2\x9f\xfcn\xbe\xed;\xbe\x9d8\x8a\xa6\xc3\xfdv>\x03\xd2>'\x91\rj\x00\x00 \x00IDAThs\xf3\xe6\r\x83\x98\x94>\x14\\\xdf'E\x828\x89\xe9\xf5\x06\xc4q\xc2o\xfc\xe6/\xf1\xfc\xf3_$\x89\x13|\xcfcf\xb6erԍ\x1blll\xf2\xd2\xcb_FZ\x16\xfb\xfb\a\x80&\xcb\x14q\x1c\xb1\xb3\xb3E\x12ǀ\xf8\xa9瞽r\xf0\xc1\x0f^\xe0\v_\xf8搋\xd6\xd3O\xbd\xef\x99ݝm[Z\x16Je\f\a\x1d\xfa\xbd\x03|\xbf\x80\xe7\a\x94+\r\xa4\x90\"M\x93\x1fwd<(\x15\xb8\xbc}\xa0\xa3\xb7\xcb+\xa5\x82\x98 \xafk\xa5\x82\xf8`\xa6\xd4\xc9B\x10\xe4\a\x05:S\xac\x9e<\x0ey\xab\xd7\xcf\xc7\xdf\xfa\xbd\x1e\x95Z\x85h\x1c\x99т0$\x1c\x85\f\aCF\xa3!ah\x10\xe6h4bwg\x877\u07b8½\xb55\xa3R\xcf\xf5Y\xb5Z\x8df\xb3I8\x18\xd1˥>\xad\x99\x16˫ǉ\xe3\x84O\xfd\xfa\xa7\xf9җ\xbe\xc4\xeb\x97.\xb3\xba\xbc\xcc`0\xe4\xb9\xe7^\xe6\xf1\xc7/r\xe7\xce\x1a\xaf\xbf~\x19a9\f\x06C4\x86v\x8f\xc6!;\xdb\xdbf\xcc\x01u\xefs\x9f\xbb\xfc\xe3\x00\xe3^\xc4p\x1c}s<dfv\x96͍\x06\x9d\xf6\x01\xb6환?\xecq\xe7\xe6e\x9a3\x8b\xcc\x1f;\xc1\xcc\xc2*\xb5\xe6\x9c8\xd8\xdd\xfcG;[w\xbeo\xae\xa1~hk_\xad}\xa5\xa7\xe4=\x13\x01\xfco\xc0i\xad5q\x14c\x17\x03\xa4\x96t\xbb=ӛn\xd4\xc9\xe2ذ\xc1Z\xb3\xbd\xb1E\xb7\xddͅ\x14ّ\x11\x87I/Ea\xd96\x96c\xe1\xf9\x01\xb6m\xe1y\x1ei\x96a\xbb.\xf5R\x89b\xb1\x88\x94\x92J\xbd\x9a\xff\xbd S\n\xc7vx\xfd\xd2k\xbc\xf6\xda+ܾ\xbdƝ[\x1b|\xf0\xa9\xa7\x18\f\x86ܺ\xb5F\xb9R\xe0\xe1\x87\x1f\xe4\xf5\xd7ހ\xadu\x82\xa0\x90\v\xea\xcc\x1cJ\x92$t\x0eF\xf4\xbb\xa3\xfeL\xb5\xfcw\x85\x10\xbf\xb0\xd3\xe9m\xbc\xdda\xce\xd6*\xectz\u007f0\x83\x94\n\x01\xb3sst\xda\ay\xd3\xc81\xd5r\x96\xb0\xb3\xb5F\x18\x0eXY=\x87m\xbb\xcc.\xac\xa0Q\x1f\xd8ݺ\xfb\x9b\v-qvs/\xd3G\x8d2ߔ\xdf\x05\xfc\x1c0\x0f\x04\x13Չ\xd0\x02i\x9b\x01\xfd\xabW\xae\xf2\xf0c\x8f\xe0\xfb\x1eZO\xc6\xd0̌\xb8\xca\x15\x89\x13\x1a}\x12\x86\x82B@\xb1R\xc6\xf5\xbc\\@\xa7\xb0,\x8b\x92\xebN\v\xbd\x89(\xcev\xac\xa9d\b\rۻ\xdb|\xfa7>E\x1cż\xfe\xda\x15\xaa\x95*\b\x9dw+m^{\xf5\n\x8f>\xf6\x10\x95J\x89\x83\x83\x03\xba\x9d\xf6t\xfe\x10\xa0\xbd\xd7gk\xbd\x8b\xd6\xfa\xbc\x94\xf2\u007f\x01\xfe\xfb\xd9Z\xe5\x15\xe0\xa1#b\xf5g\x81\xbf\xbb\xd3\xe9\xbd\xfa\a5\x8cmY\x92\x85\xf9\x05\xee\xad\xdd\xc9C\xc3\xd10\xa4\xe8uvyk4\xe0ę\x8bX\xb6\xc3\xc2\xe2)\xb2$9}\xb0\xbf\xf5Iໄ\x10r\xbe)O\x01\u007f\x0f\xf8\x81\xaf\x12FH3sa9\xc6 \xbd^\x9f+o\xbc\xc1\x99sg)\x15\x8bTjU\xa4%\xb0-\xdb\xe8\xa1\xf2i)۶q<s\xe0^\xe0\xe3:\x0e\x96m\x93e)I\x92N+\xf7\xa3+1\xa4\x94\xf8~\x80mۆ8,\x05\xfc\xda'\xff\x13RJnܸC\xfb`\xc8\xf2\xe21T\xa6p\xf3\x19\xfa,K\xb9v\xed\x163\xad\x06\xed<\xd4\x1d}\xecl\xf6p\x1c\x9b\x93'WE\xb7\xdb\x13;;\xfb\r\xad\xf5\xb7\x8a|Hղ,\xd24\xfb\x88R\xea#\xb3\xb5\xca'\xf2\xe8\xf0E \xfb\xfd\x18\xc6z\xfc\xb1\x8bϔKE{4\ni\xb7\xdbd*#\xcbb&\xb3\xe2R\n\xb2,\xe5`o\x93a\xbf\x83\xeb\x17h\xce.\xa2\xb2\xf4\x94P\xbd; \xfe\f\xf0/\x81G9\"r\b<\u007f*\x05\xadU\r\xaf5iN\xa5IB\xbf\xd7#\x1c\x8d\xd8\xdeڢ\xd3\xee0\x18\x9a\xd8m96\xb6\xe7R\xaeV\xa8\xd5\xebTk5#\xed\xb1,\\\u05c8\xdbܼ\x80\xb3\x8e\xb4\x88'j\xc3r\xa5\x82V\x9a\x83\xbd\x03\xa2,fwo\x8f\xd1h\xc4\x17\x9f{\x994\xd5<\xf5\xf4c\x94KE\xa4\xb4\xb8\xfa\xd6u\xa2hL!(p\xfa\xcc\t\xb66\xb7\xef;\x9c,\xd5lov\x98\x9bk\xb1\xb4\xb4@\xab\u0560\xd3\xe9\x10\x861ŢK\xb5Z\xe6̙S\xd4\xebU\x94҄\xe1\xf8\x8c\xd6\xfa\xbf\x15B\xf8E\xdf\xfb\xecN\xa7\xa7fk\x95\xaf+\xdfط\xd6\xeeq\xfe\xf4)Ξ;\xc7͛7\xf2q\xb5\xc3\tY\x9dK\a\xd3T1\x1a\x99ܲ\xb4z\x8eF\xeb\x18J\xe9\u007f\xbc\xbf\xbb^\x12BX\x13-\xae縜\\\\a\xa7\xbdG\xa6\x0e\x95\xea\x131\xb6\x99\xa8U\xf4\xbb=\x06\xfd\xfet\xacZ\xe4\xe8\xcav\x1c\\\xdf\xc5-x\xb8\x9e˱c\x8b<\xf1\xc4\xfb\xf0s\xf2R\xe4\n\xc9\tLͲl:\xfcc\xe7ҟcˋ\\\xb9\xf4\x06\xfb\a\aض\xcd\v_|\x85$QT*>3\xad\x96Q\xbf\xd86\xbe\xe7r\x90\x81\x1f\xf8x\x9e\x87\x102\x9f\xf45`-\x1aǠ!\b\x82\xe9kEQ\x8c\x94\x02\xdfwh\xb5\x9aT*e\xea\xf5\x1a\xadV\x83~\u007f\xc0\xe57\xae\xc98\x8a~\nxr\xb6V\xf9ov:\xbd\xad\xaf\xc7Sd\xb7\xd7cw\u007f\x9f \b8\xff\xc0\x03\xa8L!\xa55\x15&h\xad\xf0\xbc\x80\xf3\xe7\x1fF\n\x8b,M\xb8}\xfduF\xc3>\x8e\xe3U\x8fR\xf8\x81\xe7s\xe1\xd49f\x1aͩ\np\x1aV\x8e\x88\xa2\xef\xd7y\x9b\xfe\xbaW\xf4\xf1\xca\x01v\xc1A\tM8\x1a\xd1=\xe8\xf0\xe6\x95+\xfc\xea\xaf\xfe\a\x0e\x0e\xf6~\a\x11\xb7\xc4\xf7}\x82 0\"\x86\\v\x94\xa5)q\x1c\xd1\xeb\xf5\xd9\xd8\xd8GJ\xc1\xb9\xf3\xa7\xb1-9\xc9\xf9\xf8A\xc0\xc3\x0f=\xc0\x13O<\xc2p0\xccE\xe0\x87\xa1v\xd8\x0fq\x1cs\xf8\x13O\x9c\xectt]\xd3\xf0\x9aL[Y\xb6M\xb1T\xe4\xc1\vg'\x17\xcf\x1f\x03>5[\xab,\xeetz\xcc\xd6*_[\xc8Z\\\\x&˔]\xab\x94\x99\x99\x99emm\x8d(\x0e\x8f\\)\x82 (\xb0xl\x99z\xbdI\xaf\xd71\x9cNg\x8fѨw\x9fd\xb4\x10\x04̶f\xf0\x03\x9fn\xbfO\x18\x8d\x11RN\xe5=z\xea%\xe4\xe3\x05\x12!\x05\x8e\xef\xe0\x16\xbc\xe9\x18\xf3\xe1\x969\xb3Q.\x8ac.]\xbaD\xb7\xdb\xc5\xf3|\x8a\xc5b.\x926\x92#\xd7uM\xfb8\x9f\x11\xb4,3\xc4\xd3\xed\x19\x06\xbaѬ\xf2\xc0\x83\xa7YZ>\x86\xe7\xb8h\xccP\xe8\xe3\x8f<\xc4\x13\xef}\x84\xc1xD\xfb\xa0C\xbb}\xff\xa2\xb2\xad\xbb{f{\xe9\xec\f\xbe\xef\x93e\x19{{\xfb\xe69\x1bU\xea\xf5\xfa\x14P\b NR\xb4\xd2\xcc\xcd\xcf0\x1eG\x84\xe1x\x0e\xf8P\xd1\xf7~q\xa7Ӌ\xbe\x96\xf0e--\x1d{f\x1cEv\xa1P\xa0\x10\x98e.w\xefܚ\"\x15)-\x96\x97OP\b\f\xb7S*V\xe8\xf6\xdaff\xe3+\x12`\xa1P\xa2\xd50B\xb7Let\xba],KP)\x95\xef[\xcb'\xee\x17\xff\x9a\xf1d\xdbLN\x999vqh\x9c\xfck˲\xd8\xdf\xdf\xe3\xe6\xcd\x1blmm\x12E\x11sssS/\xb1m\x1b\xcfs)\xe5\x84a\xa9Rffv\x96^\xaf\x87\x94&\xec,,,`\t\x89k\xdb\xd4ke\n\xbe\x8bD\xe3\xbb.\xa5\x82\xcf\xed\xb5u\x93\x03\xa5\xa0\xdf\x19\xd1\xef\x0eq\xf2\"\xd5q\x1c\x86\xc3!\a\a\xed\xdc F\xf9xT˜$\xc9t\x84\xafR\xa90\x1a\x85\xc4Q\xbc\b<R\xf4\xbd\u007f3\xf1\x94\xdf\xcd(\xd6\xd2ұg\x84\x10vo0`an\x96BP\xa4\xd3i\xd3\x1ft\xa6W~\xb39G\xadZ\x9b\x8e5\x97KU\xf6\x0fv\xee\x03dB\b*\x95*\xf5f\x13˵H\x93\x94N\xa7C\xa64\x95r)O\xc0\xf7\x1bC烘Y\x9a\x11\x8f\"\xb2(5FɇE\x8f\x8cyL\x0f\x1e\xa0\xdfﳱ\xb1N\x18\x86\x1c?n\xb6^x\x9e\x87\xef\xfb\xb9\x10ۢ\\*1;;\xcb\xca\xca\ngΜ\xe5\x81\xf3癙\x99\xc1\x0f<l\x91\xe1\xe5\xbf7ɑ\xbe\xefQ\xa9\x94\xb8\xbd\xb6\x8emY\x1c\xecu\x89\xc7\t\xae\xeb277\x87\x10\x82\xc1`@\xaf\xd7G)űc\v\x86w;\x12!\xe2$!\xcbL\xd8K\xd3\f\xcbq\b\x8d\xce\xeb\x14 \x86\xe3\xe8\xb3E\xdf\xfb]\r\"\xb3,\xa3\xdf\xef1\x1a\x8d\xb8s\uf7a1\xa3ϜǶ\x9d\xe9\v\xed\xed\xeeb\xdb\x16\x85\xc0$\xd6 (p\xfaԃ\xf7AD!\x04A\xb1\x80\xb6\x05\xda\x16\xf8\xd5\"^ࣵf\x1c\x8d\xbfR#\x8c\x1f\xf8\xd4\x1a\x15f\x8f\xcdp\xe6\xdciN\x9f=M\xb3\xd5 \x1e\x8c\x19\xec\xf6\x88G\x91\x91\xeb\xbc\xed\b\xa3\x11rGQ4\x1d9\x98\f\x05\t\xcc\x1a\x0e۱\xf1\x03\x9f\xc5c\v\xcc\xcd\xcdQ\xa9Vi\xb5Z\x04\xbew\xdfE!sX\x0e\x82Ņyʥ\x12Y\x96\x92\xc6\xe9}\xde7\x9d\x12fb@\u007f\x1a\xae&\xfaa\xf4\xa1J3\xc9\xe7\x14\xe7\x16\xe6\xd1h\t\xfc\xd8l\xadr\xfe\xf7J\xee2\x8e\"\xa2\xc8lE\xd8\xd8\xda\xe1\xa0\xd3ayy\x85\xb9\xb9cSYh\xbb\xbb\xc7\xce\xee\x1e\xc5b\xc1\f\xb4\bA\xb1Pfqq\xf5\xbeC\x9a\xcc\x02j!\xf0\n>\xa5j\x05˲\xe8\xf6\xfa\xf7m\x97\xf6<\x97F\xabN\xa5Z\xa6R)Q,\x14\xa9U*,/-s\xf6\xfcY\xea\xad:I\x98\xd0\xddn\x13\xf6\x86\xc60o\xf3H\xf2]W\xd3\x11f\xcbBi\xb3W\xd1\xca\xffMД\xef\x1bQ\xf7\xc2\xd2\n\xa5j\xfd\xab\xc6&&s\xf1\x96\x94h\x95/\x1e\x80)z\x9b\xcc%N\x14\xf6A\x10`I\xeb>\x0f\x11\xe2\x10\xb4L<\xc5q\r˭\x94Z\x05~bR8\xfe\xce(\xab\xdb\xc9'S\xcdն\xb6\xbeA\x9a\xa6<\xf9\xe4\x87\x0e\xaf\x00\xad\xb8u\xeb\x06q\x92P,\x04\xd35J3\xcd9J\xa5ʴJ\x1e\x8dF(t^IK\xe6\x16\xe7̇ILSjr\bi\x9a\xa1U\x86%\x05*\xcbH\xb3\xc4H\xabs\xaacnf\x96\xf9c3\xb4f\x1aģ\x18\x95~\xb5A&F\x984\x95l۞NTMZ\xb4GG\xed&\x87\xe7\xb9.\xcb\xc7O\xe0z\xfe}\xcf\xe7\xba\x0e\xeb\xeb;\xf9\x92\x9c\xec\xbe9u\x99+\x1a\xcdX\x9bƱ\x1d\x8a\xc5\"B\x8a\xfb\x96\xe4\xe4\xe4@\xce2\xa8\xe9\xb9T\xeb5\x82B\x80\xd6\xfa'gk\x95?\xf5\xbb\xa1.\x99$\xb1IFYJ\x92\xc4\f\x87#\x0e\xda\x1d|\xdf\xe7駿\x854?\x8cq4\xe4ƍ\x9b\x14\nA^嚱\x82\xc5c\xabS\xe8\x17\x8d#3\x84\xa9\xcd:\xa6R\xa5lnk\xa45\x83\xd1hJg$IB\xaf3\x98\xce\xc8&\xc9x\xba\x12\x03\xcc\xc8[\xab\xdebff\x96\xe6L\xfd\xbe\x9fMV\xdd\x14\x8b%\xbe\xe3;\xbe\xcb\xecK\xcc'\xa1\xc0\xdc%A\xe4\xe8a\xb2\xb0Y}\xc5&k?\bp\\wz\x98RJ\xae^\xbd\xc5\xf6\xf6\x01\xae\xed\xd2\xef\f\xa6\a\xec\xe4U\u007f\x1c\xc7DQd\x16\xd2\x04\xbei\xc0MĀ\x98\x89\xe3\xe9\x84W\xbe\xfd\xf4\xe8\xa3\xd1jN\xbe\xf7+\xb3\xb5J%7\xca{gk\x95\xffi\xb6V\xf9_gk\x95\xbf6W\xaf~\xc0\x9e\x8c\x88)\xc7!\fC\xa4e\xb1wp@\xb5Rfy\xf9\x04\xa7N\x9d\xe1֭k@\xc6\xfe\xc1\x1e\xddn\x8fJ\xa5\xc4\xde~\x1b\x9di\xca\xe5*\x8d\xfa\f\xbb{[fƢ\x1f\xe2\x05\x1ei\x96bK\x9b\xf9\xc5y\xba\a\x1dz\xed.\xe5b\x81\xc07EV\xbf7 (\xfa4\xebU4\x8a$\x89q]\xffH(\x11\x14\x82\x02\xe5b\x85\xe1\xc1\xee}\xa1\xe5\xe1\x87\x1f\xe7C\x1f\xfa\x90!\x18\xf3\xb9\xf9\x89wL\x04\xd4v^\x9f\x94\x8a%:\x9d.ё<v_\xbd!\x04\xeb\xeb\x9b|\xees_\xe4\u0085\a\x18tC:\a\x87\x06)\x14\x8b\xd3\xd9\xf586\v\xd0j\xb5\xdat\x06q\xfa~s\x0e\x0e\x03\x1a\xb1,\xc9\xd1\xfb˸\x9eK\xa9\\\xa2\xdf\xeb\xdb\xc0?\x9b\xadU\xcaZ\xeb\xef\xf1}\x8f \b(\x15\vh\x04r2\x18i\xc6\xd2\x14\xd1x\xcc\xee~\x9b\xe1\xc8lֹp\xe1\x11\x8a\xc5\x12\xa0\t\xc3![\xdb۠\xa1R.\xe5a&cy\xf9D\x1eg#v\xb7\xb6љ\xb9J\xd2,\xc3q=\x16V\x17q<\x97\x8d\xad\x9di.\x11B\xb0\xb7}@8\x1ac\t\x99\xaf\xdf\xc8\xee\x83\xd2Zk\x1c\xdf\xc3vL\xcf\xc3u]Μ9\xcbSO=5\xe5\xab&\xdb|\xd2$ɽCbK\x8bj\xa5B\xb5R\xc1\xb2$+++\xf7sT\xf9\xda\")\x05\xc3\xc1\x88\xcf~\xf6\x8b\ba\x8c\xb9\xb1\xb1=\r\x91\x06a6\xcd\x14Wn\x14\xad5\xd5juZ\xb9Ow\bs\xb8\x98\xedw\x1a\x81-\x96\x8a\x13\x03\xfe0\xf0=Ǐ/\xf3\xf8{\x1e\xe1}O\xbc\x87\x8b\x17/\xf0\xe0\x83\xe7\xc6\xd2\xca]+\xceG\x9c\xd34!Mc\xae\xdf^CkE\xb5Zgy\xf9\x04 Ȳ\x84\xad\xad-\xa2(\xc2u\x1c|ϸ\xbd\xd1.\x9d!\xcb4\x9d\xf6\x01;\x1b\xdbȼ\"\xceTF\xb5^c~q\x1e\x10\xdc\xdbܚ\xae\x8c\xd5Zs\xf7\xde\x16\a\x9d^\xde\x10J\xf2;\xb0\x1d\xe2]-4\xc2\x16\x14KEZ\xad\x16gϞ\xc5\xf3\xbc\xe9a؎\x83c\xdbf\x8dF\x1e\x9e4\x1aiI\xc69\xbctl\a\xdf\xf3\xa7\x1bH-\xdb\xc2\xf3\rU\xf2⋯r\xb0ߡR\xa9\x10\x86!\xddN\u007f\x9a\x1bʕ\nA\x100\xceWAMPV\xa1P\x98\xa2\xae\xa3̴\xd2:\x17\x99\u007f\xf5z\x0e _(m\x9e\xbb٨s\xea\xe4\t\x82 \xa07\x18\xb0\xb1\xb3\xcb\xe6\xee\x1e\xf2\x03O=5\x154O\\2\x8aƌ\xc7c\u07baq\x1b\xa5\x14\x0f?\xfc8\xb6m\x866\xc3q\x9fk7n\"\x84\xa0P\b\xa6s\x14\x95j\x8dz\xbd\x8eҊ\xcd\xf5\r\x86\xbda\xbe\xa8ظ\xef\xcc\xc2,\x8d\x99\x06\xc30dwo\xff\x90\x8a\xc8\x14\xed\xfd\x9e\x19ĉ\xc7Ӎr\x87-U\xf3\xe1̈@\x95\xc5ťi\xc2\xde\xdd\xd9\xe1W~\xf9\xdf\xf3җ^$I367\xb7\xb8q\xe3&\xb7\xef\xacq\xe9\xf2\x15\xd6\xee\xde%\xcbG\xa9\xbf\xf0\xf9/\xf0\x1f\xffݯ\x98\xf1\xe8n\x8f/>\xf7\"\xcf\xfe\xf6\vx\x9e\xcf8J(\x14\x02\xf6\xf7:\x88\x9c\x9aϲ\x8c\xc5\xc5E\xe28&\x8e\x0f\xf7\xb1ضM\xb1X\xbco\x91\xff$\f\x9asT\xd3$\xff\x95̱1\xa8\xd9I\xdfh\x9a\xb3\xda\xdaۧ;\x18\x92L\x9e\u007f\xf1\xd81N\xac\xaergm\x8dh<\x9e\xd2\xd9\xe3qH\xa7g\xb1\xbe\xb5\xcd\xea\xd2\x12\x17/\xbe\x97/}\xe9Y\x84\x88\xd9\xd9\xd9\xe6N\xa9̉\x13\xabx\x9e\xcbx\x1c!\x85\xc5\xe2\xb1\x15\xc2\xd0,%\xbbw{\x8d\xb3\xe5s\xe6\xd6D\x02\xdc\xc0cau\x89\xf1h\xcc\xdeA\x1b\xdf3\n\x11\xf3Z\x11\xed\x83>\xad\xd9:q\x1c\"\xbd\xc2\x14\x15\t!(\x16\x8b\xb8\xae\xcb\xf2\xf2\n\x9e\xef1\xe8\xf5y\xe5\xa5Wx\xfe\vϒ\xa6\t\xaf\xbd\xfc*\xe1hD8\x1a\xf1\xcaK\xafP\xadW\t\x82\x02\xad\x99\x16\xdf\xf3g\xbf\x97\xcd\xcd-~\xe3\xd7>M\x12'x\x9e\xc7\xde\xee\x1eo\xbc~\x854\xcbx\xe4\xd1\v\x84aD\xb1X`s}{z!ضM\xadf\x8a\xe18\x8e\xf3\xa5\x02\x02\xdf\xf7\x99\x9dmr\xb0ߙ\xbe?c\x1cqߖ\"\xde&l\xc5Q\f\xda\xec\x90,\x16\v\xf4G\xe3\xe9\b\xde\xe4/l\x01<v\xf1\"\xbb\xfb{\x8cC\xe3\x19\x85B\x81q\xbe\x8eock\x9b\xc0\xf79{\xf6\x01\xba\xdd\x0eW\xaf\xbe\x81\x10!w\xefݣT.Ѩ\u05c8\xf2m\x9f\xc5b\x99ӧ\xces\xe5\xcd\xd7\xe8w{l\xde\xdbd\xe5\xe4\xea\U0010d5ebeV\xce\x1c\xe7\xfa\xe5\xb7\xd8\xd8\xdafuiq\xca\xe2\xee\xefw)W\x8b\xf8\xbe I\xe2ia*\xa5\xa4\\(#\x84\xe0\xe4\xc9S\\~\xed2_\xfc³\xec\xef\xed3\x8ebn\xdeZ灳\xc7\xf9\xa5\u007f\xfd\xcbt{#j\xd5\x12\x83\xc1\x10۶\xb8wg\x8d{kw\x19\x8dB\xa2(Fix\xeb\x8d7\xe9t\a\xbcv\xf9&I\x9c\xe4\x8a}\xb3\xf5\xb4\xd3\xe9MQY\xfdH\xe2\x9ex\x87֚z\xbdF\xb9X`kk\xf7\xbeݍG\xa1\xf8\xf4믈Z\xe3Q\x88\xc6\x14\xad\x8e\xeb\xdcw\x03\xb3\xe9*\u008f~\xffw?3Ӭ\xdbhɭ\xb5\xb5\xe9\x02J\xdbq\xf2\x05\xf5\x92\xfe`\xc0l\xab\xc9\xfc\xfc1\xba\xdd}z\xbd.RZ$qF\xab\xd9\xc0\xf7<\xc2|R\xd5u}J\xa52\xdb;\x9b$QL\xb1T (\x16\x8d\xfd\x05\xb8y\x85;\xe8\x0e\xd8=ا\\*M\xe5B\x83\xfe\x90f\xb3F\xa6\x14\x96\x10H\xcb\xc1\x12\x92\x82W\xc2\v|^}\xe1e\xbe\xf4\xec\x17I\x92\x98\x9d\xdd6\xaf\xbf~\x9da\u007f\xc4\xe2\xb1Y^\xfe\xf2[\x8cF#\xf6\xf6:\xac\xdd\xdd!\x8a\x12.\\<\xc5o\xfe\xe6\x97x\xed\xb57\xd9\xd99`}}\x9b\xf7\u007f\xe0=\xbc\xf8\xd2\x15\x0e\xda=\xd2\f\x16\x16\x1a\f\x06c\xe6\xe6f\xb8y\xf3\xee\xf4F0˫\xab\x04A\x90\x87\xf0h\xda\xd7?u\xf2\x04333\xec\xed\xb7\xef\xab\xd2\xd34#:\x9c\xd25c\xd5G\nZ\x95e\xf4\xbb}\xb2,#(\x04,/-2\f\xbfjCG*G\xa3\x11\xa5R\xc0c\x8f<ĹS\xa7\xc9r̝\xe4\xf9$\f\x87\xc4I\xc2\x1bo]\xc7q\x1d\x1e{\xecI\n\x85\"q<\xa4\xdb밹\xb5\x8d\xeb:S\x91\xdb\x04\b,\x1e[!\x8e\"n\xbcy\x83\xd1p8M\xe4R\nZ\xc7f\x98_\x9a\xa7\xe0\aܸ}\x87n\xbf7\xadn\xd7\xef\xed\xa0Q\x8c\xc6CPY\x0ee%Q?\xe2\xd6\xcd\xdb\fG!\xaf\xbez\x8d\x8d\x8d}\x8e\xaf.2;[?\xbc݄\x06\xa5\f\x81\x19'\tkk\x9b\xb4;]\x94\x86\xf18&\x8eS\xb6\xb6\xb6Y\xbb\xbbe\xaaj\t\x9dN\x8fB!`4\f\xf3\x11SË\x95\xcb\xe5\xe9\x95>\x81ֶmq\xea\xe4\xf1)es\x94\x93\x9b\b\xc1\xa58\x9c8\xbe邏t\xbaE\xbbXȧ\x96\xd5W\x17\xbc։եg\xea\xf5\xaa])\a4\x1a-v\xf7\xf6i\xb7\xdb9M`\xb0\xbd\xd9\xf2)\x89☕\xa5%|\xbf\xc0\xda\xda-\x84\xd0\f\x87c*\x95\n\xe5\x1c\x06'\x89\x11\xa9\x95\x8ae\xc6\xe3\x90Q8$\x1c\x8d\xa8\xd5k\xd3\x1b\xbaH))\x94\x8a$\xe3\x98$Jhw\x8c\xc0\xa1T,\x10Efh&(\xf8(\x95\x12\xf8%\x844\xcb\aʥ\n\xeb\x1b;\x1c?~\x9c\x0f\u007f\xe8)N\x9d\\\xc5s\xcdΕ\xb5\xb5M\\\xd7\xe1\xec\xd9\xe3\x1c\x1ctq\x1d\x9bz\xa3\xca\xe6\xe6\x1eZ\xc3\xe2\xb1\x19F\xa3\x90B\xc1\xe7\xee\xbd}Z\xcd2i\x92\xb2\xbc<G\xb3\xd1 \f#\xf6\xf6\xda9@\xa92;;;ep\xf7\xf7\xf7ɲ\x8cR\xa9\xccw|۷pw}\x8b0\x1cOw\xbcdYF\x96\xdfiT\xe7\xb31*\x87\xfd\x93\xc7h8d4\x18\xa1\x94\xe6\x81\xf3gȔ6|\x17\xf7!\xb2Ժp\xfe\xf43a\x18\xd9\xcdF\x9dJ\xa9@\xa1Pbs{\x9b0\f\xcd\x1eC\xebp\x05x\x9c\xa4H)X]Y!\b\x8a\xac\xad\xdd\xc2v\\ڝ.\xf3s\xb3\xf8\xbegְ搴Vk\xd0\xed\xb4\x19\x87&\x86ך\x87\x1c\x92mٔ\xebUz\xfbmT\xa6\xe8\xf5\xfb\xa4YJ\xadRa\xd0\x1f\xe1\a.\xae\xe7 \x11\xb8^\x01\xadu\x9e\xd8\x17\x99\x9f\x9f\xcd[\xb8v.\a\x1dr\xe1\xc29\xfe\xd6\xff\xf8\x13T*\x01\x8b\x8b\xb3\xec\xee\x1ep\xf2\xe41.]^㻾\xf3I\x1e}\xf4\x1c\x9b\x9b;T\xab\x05:\x9d!\xdf\xff\xfdߊ\xef\xdb\xfc\xf9\x1f\xfa(O\xbc\xf7=\xbc\xf8\xc2+\xec\xe7a\xa8R\xa9\xd0h6\xa7\xaa\xcb\xfd}3f\xfd]\xdf\xfe\xc7h6\x1b\xdcY[\x9fr]\xe4\x95\xf9t-\x94>\xa4O\xd2,\x9dzY{\xff\xc0x\x1ap\xf1\xe2\x05\xda\xfd\xfeW\xb5/4\"\xb5\x1e8{\xf2\x99q\x14ٶmQ\xad\x94\xa8\xd7*(%\xb8\xbb\xbe>eR\x1d\xc71;\xad\xb4f0\n\xa9W+,\xcc/\x10EI\xee\xea\x82\xfd\x836\xb3\xb33\x04\x81o\\:\u007f\xb1Vk\x8e\xad\xcdu\xc2\xe1\x00\xad\xa0Z\xaf\x99j6\xdf\x13ߘ\x9fa\xd8\x1b\xa2Ҍ\xfe`@\x96eTJEڝ>\xbe\xe7b;\x02\xc7\xf6\x90\xd2>L~G\x12\xa1\xf1H\xc5\xdf\xfe\xa9\x9f\xa4\xd5j\xd0l6\xd9\xde\xdefii\x16˒\\x\xf0\x04\xcf<\xf3\xb7i\xb5\x9a\\\xbat\x99z\xbd\xc8{\xdes\x91\xbf\xf8\xa3?\xc6h\xd4gqq\x11)$\x9f\xfb\xfcs\xf4{\x03\xa4\x94\xcc\xcc\xccP\xa9TL\xf1\xbag\xa6\xae\xea\xf5:?\xf4\xd1\xefeo\xbf\xcd\xfa\xe6\xce}\xe1\xc6,?Sӥh\x13\x88;\x11c\xa0a\u007fw?g\xca=N\x9cX\xa5\xdb\x1f\xbcݮ\x8c\xd4z\xe8\xfc\xe9g\x84\x94\xf6\xc1A\x87r\xa9H\xb5R\xa6Q\xaf\x11\x86\x11\xeb\x1b\x1b\x93O\x9eS\xd0\xe6\x05z\x83!\xb5J\x85\xa5\xc5%\x0e\x0e\x0e\xa6ޔ\xc41\x8dF\xcd\x18%N\xa6X\xbdV\xab\xb3\u007f\xb0G\xe7\xe0\x80,˨Սpa\xb2\xbc\xbfR\xaf\x12\x8d\xc6dIJo\xd0'U\x19\xa5b\x91~o\x88R\x1aϳ\xf1\xfd\xe2W\xc1\xc8 \xf09s\xf6$\u007f\xfa#ߙ\x87V#\x98;80C<\xa3Q\xc8\xf7}\xdfG(\x16\v\xb4\xdb\x1d\xba\xdd6\xbe\x1f\xf0\x1d\xdf\xfe\xedx\x9e\xcf\xee\xde._~\xe5enܸ\xcd\xd57o\x92$)\x8e\xe3p\xe2\xc4\tʕ\n\xae\xebr\xed\xda5\xa2(\xe6O~\u05f7\xb2\xb80\xcf\xc6\xe6\x0e;\xbb\xfb\xf7AUC\x96\x1aRub\xa6$\xaf\xec\xd1Fp\xdeiwȲ\x8c\xd3'O\xe0\x04\x01Q\x14\xbf\xadA\xe4d(SJɛ\xd7n\xd2\xeb\r\xa8\x94\v<\xf1\x9eGy\xec\xe1\x8b&\x19E\x11Q\xbe\xf0%\x8e#\xfa\x83>W\xdez\x8bA8\xe2\xf1\xf7\xbe\x97b\xb1\x84R\x8a\x9d\xdd=\xf6\x0fڦY\x957\xa5\x00|\xbf\xc0\xea\xcai,\xcbf\xeb\xee\x06o]zsz\x8b!\x04x\xbe\xc7\xf1\xf3\xa7(\xd7\xcc]\rڝ.w\xd7\xcd\xc5\xd0\xde\xefqw\xed\x1e\xa3Qwz\x0f\xf5\t-\xfe\xd8{.p\xee\xfcI\xdc#Da\x1cG<\xfe\xf8c\xb8\xae\xc3\xf2\xf2\x1233\xad|%\xb9\x93\x1b1`~v\x9e$IXZZ&I2\xf6\xf7ۄ\xa1!\x0e\xcb\xe52\xf5F\x83r\xb9\xcc\xce\xce\xceT@\xb1vw\x83\x83n\x9f\xad#Ƙ\x14\x90\x87\x15\xfbD\xa8\x95ߣ7ϙ\x93\xfb\x92\xc4q\xc2ŋ\xe7\x19\x8e·\xdfU\x99\xdfGh\x1a\xe7\x94Ҽ\xf9\xd6\r\x928fa\xae\xc1C\x0f>\xc8c\x0f_̟\xcc\f\xe4\x9b\x02g\xcc`8\xe4ڍ\xdb\xc4iʇ\xbf\xe5[\xf0s\x88x\xf5\xea5\xb3Dٱ)\x17\v\xd3\xe7\xaeVj\x9c\\=C\xa6\x14\xfb;{\xbc\xf9\xea\x1b\x87\xb0P\x98\x9d\xbeg\x1f>\x8f\xe7\x19\x16u\x14\x86\\\xbb}\x9b8M\xe8\xf7G\\}\xf3-°{\xa4\xdf`\xf2\x9b\xe7\xb9_\x81\xffM\xbf\xe5ԩ\x93\x1c?\xbe2]\xe1Q,\x96\xa6\xad\xd8p\x1c\x92\xa5\x19\xb7o\x9b;\xe7t\xbbC\xd24\x022ffg\xa8V\xabx\x9e\xc7ݻws:\xdf\xe2\x95\xd7.\xf1\xb1\x8f\u007f\x12\xdb>\x14<DqL\x14\xc5\xd3}\x8d\x877\x18\x10G\xee_b\n_\xad53\xad\x06\xa5Ji\x8a\n߶\x85\xfb\xd0\x03g\x9e\x11Bؓ'\x8b\xe2\x18\xa55\xf5Z\x95j\xb9\x88\xe3\xfa\x84aH\xa7\xdb!M\xb3\xa9\xfe)MS\xd2,c4\ni\xd4j\xac\xae\xac\xb0\xb1\xb1N\x96e\xec\xee\xee177c\xf8\"\x98\xde\x02\xc2\xf7\x03j\xd5\x06\a\a{\f{}\xf6w\xf6h\xb4\x9a\xe6Ƒy{\xb7\\\xab\xb2\xbb\xb93\xbd\xcdD\xb7\xd7\xc3\xf7\xcc6\xed~\xafO\xadQǲ\xec\xe9\a]^^x[\xdehyy\x89\x85\x85\xf9\xe9\rc\\\xd7aei\x95\x93'N1\x1e\x8f\xd9\xde\xd9\xe4ƍ\xabDq\xc2\xf5\xebw\x19\r\x87H)\xb8p\xe1\"\xb5Z\x8dn\xb7˵k\xd7p]\x97S\xa7N\xe55\xce\x1e333S\xba\xc8 $\x99\xef\xe6\xca\xee\xebM\xab\xfc\x06\x04hh\xef\x1d\x10\x8eC\x9ez\xe2Q\x84e\xd3\xeb\x0f\xdf\xd6C\x04\xa4\xd6\xc5\a\xcf>\x03\xd8G\x97\a\xf7z\x03J\xa5\"\xd5j\t\xc7q(\x95\xca\xf4\xfb\x03\x0er8<\xe93dYJ\xa64\xbd\xbeIz\v\xf3\xf3lnm\x90&)\xc3\xe1\x88F\xa3n\x96\x97II\x9c\xc3a38S#\x8cF\f\xfa}v6\xb6\xb0\x1d\x87\xf2\xff\xdf\u05f9<\xb7\x91^W\xfc\xd7\xef\a\xd0\xddx\x11\x10I=8\x92\xe8\x91U\x96\xa8\x1a\xcdd\x92ɤ\x9cة\xa4\x92]V\xf9g\xf2\x0f%\x95\xa57\u07b9\xec\x19\xaf<\x93\xa4\")\x96Dy$\x12 \b\xa0\xf1F\xbf\x1b\xe8,\xbe&Hi(\xb3\n\xc5\x02\x8aU\x04\xfa\xa2\xefw\xef\xb9\xe7\x9eS\xf61\x86i\xa0j\x1a\xb3\xb2\xe2Yo6,W\xe2bٶM\x9a&\xb8\xae\x8b,+\x84\xa1@\x13ڝ\xe65\xb8Qi\x0eS\b\x802Oץ[\xc3\x1aMSi4\x9b\xc4ID\xb7\xfb\x8eW/\x05f\xa7\x1b\x06\x9f}\xf69\x9a\xa6\xf1\xfc\xf9s\x96\xcb%\x9dN\x87\xa7O\x9f\xe2\xba.\x83\xc1\x808\x8e\xd9\xdd\xdd%M\x85D\xadmY\xacVK6W\x94\u007f\xd7\xe5ឥ\" \xe3\xb1OQ\x14\xfc\xe2\xef\xbef0\x9e\x11\xc5\xe9GR\x16\xf9\xf6\x0eኬ\x11\xc0ȟ\xd2l\xd6\xf1\xdc*\x05\x12\x9e[#\x89#&\xb3\xd96\xafʊ\xa0bJ\xb2\xc2b\xb9\xa2\xd9l\xb0\xbf\xb7\xc7\xc9\xc9;\x92D(\xc0y\x9e+\xecSU\xa54\\)PU\x9dz\xadI\x92ƬVKf\xe3\x19\xc1rE{\xb7\x8d$IT]\x87J\xb5\xc2\xe8|+\x87DP\xee\x88T+\x15\xd2,\xc5-W\xaf\xfdф<_\xb3\u007fs\xf7G\x80\x9f\xc8\xdfk\xa20%\x89\xd3R\xb6o\xbbE\x0f\x14|\xf3\xedo\xe9\xf7g\x14\x14ܽ{\x8f;\xb7\x0f\b\x82\x80\x97/_\x92e\x19_}\xf5\x95\xd0e\xb1,\xecJ\x85\xe37o0u\x9d\u038d\x1b\xe4\xb9(\x02&\x93\xc9\xd6\xdf\x04\x84\x06~\x9e\xadY\xe7\x82X7\x9bL\xf1\xdc*O?{L\xb7?zO\xfb\xf8ڀ\\\xdc!\xef\xe1*\xc0`8\xa6\xd3nR\xf3\xaa\xc4qJ\xadV'Mbf\x8bE\xe9j\xa3\x94\xa5g\x8a\xa2jL\xe73\xda;;ܻ{\x97n\xf7\x94\xf1d\x8a\xe7z\x98\xa6\x81\xa6\xaa\xa8\x9aZV\x17\x02\xadm5\xdbl\x8a\x82\xe5rN\x14\x04\xf4O\xcfh\xef\xde@Q\x14\xecj\x85\xf6~\x87\xc9`\xbc\xd5ύ\x93\x84\xe9|\x86\xa9\xeb\xe4\xeb5N\xb5\x8a,+\xf8\xa3\tg\xbd\x01\x9d\x1b-t]\xe5C\x96Q\x96fB\vX\xba\xd4\xf2\x15\xa2\xff\x1b~\xf5\xab_\x13F\t\x12\xf0\x17_\xfc%\xa6i\xd1\xeb\xf5x\xf7\xee\x1dGGGt:\x1d\x820\xe4\xed\xbb\x13\xc20BUU^\xbf~\xcd\xde\xde\x1e\xedv\x9b\xe5r\xc9*\f\xb6\x05\xc7EO\x12\x87)\xb2\"3\x1d\xfb\xc4Q\xc4\xe1\xfdO8\xf8\xe4\x0e\xfd\xa1O\x9af\x1f;B\xae\x0f\xc8U\xb8x\xb9X\xd1j֩\xd7\x1c\xc2(\xc1u\\\xa1\xa2P\xf6\fJ\xa9\xe2\x93e\x19\xaa\xaa1\xf4\xc5\xe6ӽ\xbb\xf7\x98L\xc6t\xbb=\xaa\xd5*\xb6e\xa1*b\x0e\x91e\x97`\x9d\xe7\xd5\xd14\x9d \fHӔ\xf3n\x1f\xbbjc\xd9\x16\xaa\xa6\xd1\xde됥\x19Q\x10nk\xfb\xf1x\"V\xa3U\r\xaf&\x86Pa\x98\xf0\xf6\x87n\x99\x12\x1dTU\x88hʲ\x84nh\x98\x86\x86n\xa9\xac\xf3Mi\xb3$\xf3\xec\xd9\v~\xf3\xdbo\x81\x82F\xbd\xc1ÇGl6\x1b\x9e={F\xb3\xd9\xe4ѣGDq\xc4Y\xff\xfc\x12\xed\xb5,\x14Y\xe6\xf8\xf8\xb5x\xbf\x83\x01\x86a]\x8e\x13\n\x11\x8c\v\f\xcc\x1f\xf9\x14\x9b\r\xbf\xfc\xc5\xdf \xab\nC\u007fB\x9eg[f\xe7\awʟ\x0f\x88$I\xc4I\xc2fS\xd0jֱ\f\x838ɩ\xb9\x0e\xb3Ŝ0\x8c\xb6\xb6\x12b\xc0\x94\xa3\xeb\x06\xd3\xf9\x1cU\xd38\xbc\u007f\x1f\xdf\x1f\xd1\xed\xf6PU\r\xcfu\x90\x10\xab\xd3\x17\x1a\xed\x02%\xae\xe2\xba\x1ei\x9a\xb2\\.\x99\x8cƄ\xab\x00ˮPq*\xd4\x1auTY%ZEdk\x91\xf6VA@\xb7\xd7c\xbeX\xb2\xd3ja\x9a\x82\xaas\xde\xf7\x99/\x96\xd4<\x8fJվ\xfc\xc0\x12\b\xd15\x9f`\x15\"I\x12\xff\xfe\x1f\xff\xc9p\xe4Sl\n>\xfd\xf4!7n\xecqrr\xc2d2\xe1\xe9\xd3\xcf\xc5x\xf7L\f\xe4\xde#\x04V*DQL\xaf\xd7\xc54L\xaa\x8e\xb3\x9d\x14f\xa9\xb0ꠀ\xb1\xef\x13\x85!\x9d\xce\x0e\xff\xf8\x0f?g<\x9d3\xf4\xa7\xc2<y\xeb\x83U\\z\xaf\\\rȇ]\xf0\u0560\xccfs\x1c\xa7J\xad\xe6\"\xcb\x05\xf9Z\xa2\xe1y\x8c\xfd!I&LU4]//r\x86a\x98\x82\xcd^\x14<~\xf4\b\xdf\x1f\xd1;;\xa3(\xa0Q\xafm\xa9@\x17\xb5\xb9\x98?h4\x1a-\f\xc3`6\x9b\x10\x06\x01\x83^\x9f4I\xa85\x1bT\xbd*\xb5Z\x8du\x9c\x13\xc5\xd1\xf6\x0e\x9eN'<\u007f\xf1\x82\xd5*\xa4\xb3S\xa7`C\xb0\x8a8=\xed3\x9d,\xa8:\x96(\xa5e\x99ӓ3^<?&\b\x02\x86C\x9f\xdf}\xf3{\xa2(B7L\x1e>|\x84\xa2\xa8|\xff\xfd\xf7\x1c\x1d\x1dQ\xaby\xbc=9%\xba\u0096\xb9:\xeaH\x93\x84u\xbe&\xcf\xd6\xec\xee\xdd\x10\xe7#\x10E)\xc5Fp\xd1\xc6#q\x98[\x96\xc5\xdeޮ\x80\x956\x1b*\xb6\x85[\xad\xe0\xd86\xaeS\xc1\xa9\xdaTm\x8b\xaam\xe5ҿ\xfe\xcb?E\x92$\x99\x1f\x9e\x1fץ\xaf/>{D\xad\xe62\x9e\xce\x19\x8d\xe6\x9cuO\xf8\xdf\x17/\x98.W\xac7\x05v\xb9\xc5$\xcb\n\x9eW\x13ke\xf5:\x87\xf7\xef\xf2?\xff\xfd_\x9c\x9c\x9c\xd0l6\xf8郟\x94R~\x90$\xa9\x108\xde\xce\xdae\xa28\xe0\x87\x1f^\x13E\x01\xeb\xf5\x06\xd32\xb9\xf7\xd3Cꭆ\xf8\xe6\xf5F\x9cu\xbb\x04a\xf0\xde\xfb\xb3,\x9b/\x9e>\xa6\xd5jbY\x16\xaaj\x02B\xd8\xffB\x9e\xf6\x82r\xfaǗ/\xf9\xe6\xdboɲ\x94v{\x97\xaf\xff\xfao\xf9\xc3\x1f\xbe\xa3\xd9l\xf2\xf0\xe1Cޝ\x9e2\x9bͯ\xbd\x1e\xabł\xa8T\x938=9\xe3\xe0\xe06\xcdV\x93$M\t\xa3\x844\xc9\xe8\xf7ζ\xec~E\x11#f\xa1\xd0\xfa\xbe\x0f\xd6U\xe2\xb6i\x18\xf1GS\xd6u\xcf\a\xc31\xb6e\xb1\xd3j`\x1a:\xb2j\xa0!F\xbeA\x14o\xb5\x10%Y\x1c\xf4\x86a\x12%\t\xf3Ŋ\xc3\xfb\xf7p\x1c\x87\x93w\xef\x18\x8d|\\\xd7ْ\xd7D\n+Jt\xb4@\xd7\fZ\xad6\x92$\x93e\x02%\x18\xf4\x86\x04\x8b%\xaa\xa6\xb2s\xabC\xab\xbd\x83\xae\xe9\xe4iN\\\xa2\by\x9e\xf3\xa7\x1fN\x99ΦH\xd2\x06\xdb\x16F\x94\xba\xae\xa1\x95&2I\x92p\xfc\xe6\r\xdf}\xff\x1dq\x1c\v5\x87\xfb\x0f\x88\xe3\x948\x8ey\xf2\xe4\t\x83\xe1\x90I\x898|\xf8\x93\xa6)\xc1J\\\xe8Fs\x87J\xc5\xe6\x8f/^\xb2\xb7\xb7\x8b\xa2\n\x95\xed,\xcb\x19\x9e\x0fɲ\x94\xc3û|\xf9\xe5S\x1c\xcf#N3a\xf7\xaajh\xfa\x85}\x94\xf0\xf1\x8d\xe3\x98\xc5b\xf9\xe7S\xd6u\xa2\xc8\xfex\x8a\xae\xa9\xb4ec\xe0\xe5\x00\x00\x03\xe3IDAT\x9au*\xb6\x89f\xdal\xd2\x14M*X\x86\x11I\xb9;\xa8\xa8jI\xed\x11\x94\xa0\xf1tF\xbd\xde\xe0'\xf7\xefqrrB\xbf\u007f\x8e,\ty؋\xc6M\xd74\xb2<ۢŎ\xe3Q\xaf5\x91$\x99 Z\x10\x05\x11S\u007f\xc2x0\xc2v*\xec\x1f\xec\xd3l\v\x95\xd2`\x19\x90\xc4\t\xaa*\x13\x86\x11\xfd\xf3\x11\x8b\xc5\x02ϳ靝\xf1\xf2\xd5k^\xbdzͳ\xe7/8~sL\x18\x86\xe5\xee\x87\xcd\xde\xee-F\xbe\xcf\xd1\xd1cf\xf3\x05\x83\xe1G\x8c[\x8a\x82\xf9lJ\x9e縞'vY\f]\x04\xe5\xff^q\xe7\xe0\x16\x8a,3\x9b-\xf0G#t]\xe7ɓ\xc7\x18\xa5\x17\xa3b\xe8\xd4\x1auj\x8d\x1a^\xbdF\xad^+\x9f\x8bG\xbdոl\f?\x16\x80\xeb^\x1b\f}\xf2|M{\xa7)r`\xadN\x12F\x98\x8a\xc4*\x8e\x05\xe98\xcfK?\x8f\x14\xd3\x14\xb0\xcat6#\xcb\xd7\x1c=~\xcct:\xa1?8\xc7\xf7\xc74\x1b\xf5\xed\x1a\x81\x90l]o{\nEQ\xf0\xbc:7:\xfbPl\bÀ4I\x18\x9e\x9ds\xde=Ǫڴvw\xd8\xff\xe4\x16^\xc3#\x0e#\xd28\xa5\xa0`>_r\xfc\xa7\x13\x06\x83\x11\xb3\xe9\f\u007f<&,\xab\xb5\x8bE\xa1\xbd\x1b\xb7Yo\nA\x15B\xa2\xdb\xeb}\xf4,]\xcc\xe7\xa4i\x82n\x18T]o\v\x9f\x9b\xa6\xc5l\xbe\xc0\x1f\xfa\x18\xa6\xc5\xf1\xcbc\x8a\xa2`\u007f\u007f\x97\x9b7\xf7\xb6k\x12\x9bM\xb1e\xc7H\xd7<dYʕ\x87\x9f\xde{\xaf1\xbc\x1a\x84\x0f\u007f_e\xa1O\xe7\vV\xab\x10שR\xf3\x1c\x1a;;\x14\xeb\r\x1a\x05a\x92\x10'ɖ\xc1\x97\xa6)\xba\xae\xa3(\nq\x920\x9e\xce98\xb8C\x9ef\xccfb\xeah\x18:\x96i \xab\n\xa6a\xa0*\x8ap\x9a\xbe\xc2\xe3\xaa\xd7[\xec\xect\xb6:\\i\x9a\xd2{{ƨ\u007f\xcef\xb3Ʃ\xb9\x1c\x1c~\xc2\xee\xed=1\x0f/\x95\xee\x92$!/\xa1p\xe9\u009fD\x92q\xab5TY\xc1q\\\x1a\xad\x16\xa7\xdd\xee\x8f\x18\x87\x17\xff;X-\xc5\xe4S\x92p\xbc\x1aʕ\xbf\v\x02Q\x18\xf4\xcf\xfatO{\xdb\x00<xp\x88\xeb:b\xa2(KTt\x153\x0f1\r\x1d]3\x84\xbd\xa0\xaa\xa2\xaa\xa5\x91\x8d,\xe7\xca\xdd;\xfb\xff&\xcbBZ\xe3=p\x8c\xebY\xe7W\t\x9da\x141\x99\xcd٬\v\\Ǧ\xb9#\xe6!r\x96\x91\xaf\xd7,Ðu\xd9\xd4m6k\x14E\xdd2\xc9WAH\xad^\xc7u\x1cV\x8b\x05c\u007fB\x14\x86\xa2)\xb4mTU\xc14\xf4\xad\xa7\xe1\x05IM\x92\x14<\xafA\xa3\xd1\xc2\xf5jTl\x9b,M\xe9\x9d\xf6\x19\xf4ΘO\xe6\xa4IB\xb3\xd3b\xef\xceM\x1c\xcfE\xd55Q\x01f\x17\x8en\n\xa6na\xa8:\x95\xaa\xc3\xe1\xa7\x0f8;\x1f\\\x8f/\x95\":\xab\xc5\xe2r\xed\xc1\xb2\xaf\x00\x8c\x19q\x9c\x96\x92\xe9b\xfd\x00\xa0Z\xad\xf2\xb3\x9f=\xd8Π6\x9b\rY\x9a\xa2\x18\x15T\xdd\xc0\xd0u\fC\xc74\f,\xd3\xc06Ll\xd3ȥ\u007f\xfe\xfb\xafg\xb2,\x1b\xa6in\xe1\xf2\x8fݲ?&;\x8b\xf9\xb1,+X\x96\xc1ͽ\x0e\xa6e1\xf6ǜ\xbey\xc3i\u007f\xc0\xdb\xc1\x88u\x9e\xa3꺠vV\x9dm\n\xbbHI\xba,1\x19\x9e\x97ݿ\x8a\xe7V\xb9up{\xeb\xe3!\b|9\xab \xbc\xb6\xcb\x15\rc\x8a\xef\x0f\x18\xf9\xe7[\xbcMQU\x1cϡ\xd9n\xa1\xa8\nQ\x10\xb2\x98-D\x93\x99o\xa89u\xfe\xea\xeb\x9f\xe3\x8fg,˃\xfaG\xads\x9e\xb1\x98\xcdK\xba\xaaL\xa5z)i\x9be9\x8b\xb9\xb0\xc0\x90$\x89\xf3~\u007f\xdb\xc0~\xf9\xe5\xe7\xec\xef_\xc29\xabU\xb0\xfd\xbc\x97i\xea\xf2\xda^\xc4\xf7\xff\x01C\xb0.z\x03\xf9\xb1\xb8\x00\x00\x00\x00IEND\xaeB`\x82")
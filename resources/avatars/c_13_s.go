@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_13_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14$\aRE\x8d\xb2\x00\x00 \x00IDATx\xda\xec\xbdy\x94d\xd7]\xe7\xf9\xb9\xf7\xbe=\xf6\xc8Ƚ\xb2v\x95\\\xa5\xcdZ-\xc9Z\x8c$\x83\xbc\x1b܀iO\xd3X,c\x8c\x81\x0343\xd3\r\xcd\xf44\x06\x1f\xa0\xe9i7\x1c3\xa61\x8d\x01\x9bՀ\x01\x19[\u07b4Y\xb2ek+I%U\xa9TKVeU\xee\x11\x19\xeb[\xef\x9d?^dd\x95K6\xd82}<\xd3z\xe7\xa4*NDT\xe5{\xf7{\u007f\xdb\xf7\xf7\xfd]\xc1\xcb\xd7\xcb\xd7\xcb\xd7\xcb\xd7\xcb\xd7\xcb\xd7\xcb\xd7\xcb\xd7\xffȫr\xf3\xbf\xf8\xba\x9f\x97\xbe\xeb]\xff\xbfxN\xf1\xed\x0e\x82.\xcd\xd2\xf9\xc4\xfbG\xef\x15_\xff\x93\xdf'\x84\xbc\x1c\x93\xed\x05\xd11\xcayFD\xddG:\xf7|\xf0\xa1\xcd\xef\x94\xef\xf8!ڟ\xf9\x83\x97\x01\xf9V\\\xd5W\xbf\x85\xd6\x17>\x0e@\xfd\xea\xdbE<s\xb9+\xd2\xf8v\xa4\xf5cF\xdaoF|\x8d[6Y[d\xc9{\xd1ɟ\x18+X\xed\xfe\xfd\u007f\x0e_\x06䥂\xf1\xaa;i}\xe9\x93\xf9.\xbf\xf3]\xbb\xb5\xe5\xbd\x19\xa1~\x1c!\xf6\x19\x03\x18\x83T\neY(e\x01\x90\xa5)i\x9a\xa0\xb5F\b\x81\xc0\x00|\x1ac>,\xe2\xc1g:\xf7\xfc\xce\x12@\xf9\xf6\x1f\xa2\xfd\xd9?x\x19\x90\u007f\xeaU\xfa\xce\x1f\xa3s\xcf\xef\xe6n\xe9u\xef\xf9\x8fX\xee\x0f \xe4^c\f\x00\xb5\xc6$\x95\xda\x18\xb6롔\x85\xb4\x14\x98\x1c\x90,K\xe8\xf7\xba\xf46Zt\xdbM\xd2$AH\x89\x80C\xe8\xe4#Ň\xdf\xff\x1b\x8b\xeb$\xc5\xef\xf9\xf7\xa8\xe5Cl<\xf8\xb1\x97\x01\xf9\x9aq\xe2\xd6\xefg\xe3\xbe?\xcbAy\xed\x8f\xdcn\xdc\xe2_\"\xedJ~o\x86\xa0Xf\xc7\xde\x03\xf8\x85\"~\xb9\x88\xed\xd8X\x8e\x8dT\n۶\x10J\x12\xf5C\xc2n\x9f^\xa7K4\x18\xd0^_aeq\x81\xb0\xd7\x05!4ƴE\xda\u007fg\xe7\x1f>\xf07/[\xc8\xd7rO7\xbc\x81\xdek\xfe\x03\xc9\xfb\xae\xa5\xf4ڻf\xb1\v\xbfn,\xef_\x02\b!\xb0l\x9b\xf1\xa99\xa6w\xecF\xd9\x16\xae\xef\"\xa4\xa4P.\xe1\x15|\x94\x92\x18!\x00\x83@ \xa4 \x1e$\xf4\xdb]\xdak\xebDQDke\x893\xf3ǈ\xc3\x01\x18\x03:\xf9\x1b\xe0\xe7\xbbw\xbf\xff(@\xe9\xf5?}^\xc2\xf0?- \xe7\xfa\xf3\xd2\xeb\xdf\xf3\xfdF\xb9\xbf\x88\x90\x97\x1a\xa3\xb1\x1d\x8f\xf1\x999\xc6Ƨ\xf1\n\x05\x84\x14x\x81OP.R(\x17\x90J\xb1\xe9\xc6.\x88\xebڐ\xa6\x19:\xcd\xe8\xaco\xd0\xdb\xe8\xd0\xefuX_:\xc3\xfa\xea\"\xd1`\x80\xc041\xd9o\xc98\xfe\xaf\xed{>\xb0Vz\xdd{\xe8\xfc\xc3o\xbfl!\x8d\x03Wz\xe1Λ>\x8a\xe5\xde\t\xc2\xd7YF\xa5>ζ\xdd\x17\xe1\xfa\x05,\xdbF\xd9\x16\x95F\x8d\xa0\\@)5\xbac9\f\xe8\x18\x83\xd1:\ah\b\x92\x19\x02\x93\xc4)a\xa7\xc7\xfa\xd2\n\xc6\x18\x928\xe2\xec\xfc1VΞFJ\tF?/\xb2\xf8\x87;\x9f\xf8\xad\a\xca\xdf\xf9\xbfҾ\xe7\x83\xffs\x01R\xb9\xe5\xfbظ\xffχ\x16\xf2\xce\x1d\xc6-\xfd\xbd\xb1\xdcK\x01\x94\xb2\x98\u07be\x8b\xf1\x99\xed\xb9\xbbr,\xfcb\x81\xfa\xf4$\xb6\xeb\x8c,B9\x0e\xb6\xe3\"\x84@\x1b\x93\xbf?L\x83M\x96\xa1\xd3\x04\x9d\xe4A>\xb7\x16M<\bY\x99_@k\x8dR\x8a\xd6\xea\nǏ<E\x96\xa6\x80A\x86\x9d\xb7\xb6\xef\xf9\xe0\xc7_ʳ\xd5o|\xab\xcc\x1c\x8f\x8d{\xffT\xbf\xd4uR\xffC\\\xd4\x1dw\xd1\xfe\xfc\x1fo\xba\xa8\x9b\x8c\xed\u007f\x16\xcb\xdbe\x8c\xa1X\xae\xb2k\xff\xe5\x8c\xcf̡,\x85\xe3\xb9T\x1a5\xea3\x93(\xc7AH\x89\xb4\x1d\n\x85\x80\xc9J\x89\xb1\x82G\xd9s\xf0\x1d\x1b\xa1\x14H\tJ!-\v\xe9xH\xcbBH\xb9\x05\x966\x14*%\xe2ހ4I\xf0\nE*\xf5\x06\xad\xd5%\xb4\xd6\xc6X\xce\x0f\xb8\xbb\xae\x18\xf3w\xec\xffBt\xfc`T\xbe\xe3.\xa2c\x8f\u007f\xdd\xe7\x998p\x13\xbd\x95y\x00\xc6.\xbd\xf9\x15Yy\xf2\x87\x8c\xed_\x15\x1d{\xfc\x91\xea\x8do&<u\xf8\xff\x1b.\xabx\xe7\xbb\u007f\x19\xdb\xffY\x84\f\x00&gw0\xbd}'^\xa1\x84\x90\x02\xc7\xf7\bJ\x05\xfcR\x01\xdb\xf3\x10R!\x94\x85\xeb\xd8L\x14=|Kqn\xf8\xd0\x06\xba\xa9\xa6\x9fi\xa2L\x93\xa5\t\xc9 \xc4\xf1}\x92($\xee\xf7I\xa3\x84,\x89\xc9\xe2\x94\xf5\xa5\x15\x92(\x02`}e\x91\xf9\xe7\x0fm\xc5#\x9dܫz+o\xdf\xf8\xfcG\x96Jw\xbe\x9b\xce'?\xf0\xa2\xcf0\xb6\xeb*֎?\xc6\xdc\xdeK\xc6:\xa5\x9d\xef3^\xf0\x06]\x9d\x9c1Ru\xbaw\xbf\xbf\xfcm\xef\xb2J\xaf\xfdad\xeb\x84\xc8\x1a\xfb\u007f\x05\xcb\xfb\xb7\b\x81\x90\x92\xb9\xdd\xfb\xa85&q\v\x05\x1cץܨ\xe3\x16\x02\xa4\x94\b\x95[\x8a\xb4l,%\x19\xf3\x1d\n\xb6u\xfe]\x0f\xd71҆n\x921\xc8\fi\x96\x11\xf5\xfb\xb9ed\x19i\x12\x93\xc61d&/ \xe3\x84Յ\xb3di\x8a\x10\x82S/\x1cf\xf9\xcc<b\xb3\xfa\xd7YSF\x1bW\xb4?\xfd\xa1S\xa5\u05ff\x87\xce'\xce\x0f\xf6\xb5ݷ\xd3<\xf6Y\x1as\x97\xbc9\xa9\xef\xfaCS\x1d/S\xae\t-\x14\xa0\xfb\xb2\xbbrs\xfbs\u007f\xf8\xd8KY/\xf9\xcf榾\xf3G\x00pN\xdd+\xb2\xb1\x8b\u007f\x0e\xcb\xfd\xb7\b\x81e\xd9\xec\xb8\xe8\x00\xf5\xf1i,\xdb\xc1/\x14\xa8\xcfN\x13T\xca؞\x87\xe588\x9e\x8b\xef8\x94=\x871\xdf\x1d\x81\xe1J\x98taփ\x8a\x9d\xaf\xbb\x12\x02)\xf2\xd7BJ\xbc @ \x00\x8d\x94\n\xa5,\x84\xa5\x90J!\x85\xa013\x85\x1c\xba\xb4\xed{\xf7S\xad\x8foY\x89T5\xedU\x9f)\xbd\xfe'\xaf\xe8|\xe2\xb7)\xdd\xf9\xe3y\x0223K0\xf3\xff 7>+\xeaۮ\xfa\x91Ԯ\xfe\xb5)7*\xc6/\t-77\x8a\xb4\xb4W\xdd\xf7R\xd7\xed\x9f\x05\x90ʭo\xa7}\xcf\xef\xe5;x\xf7\xeb~\x05\xdb\xff\r\x830\x9e\x1f\xb0c\xdf%T\xea〠P)S\x9d\x9a\xc0\xf1=\x94\xed\xe4ŞcS\xf2]\n\x8eE\xc1V\x04v\x1e攀\xaa\x03\r7\a\xa5n\xe77o\x8c!3\x90\xe9\xad,K\b\x83\xb2,\xc4&R\x9b&5|]\x9d\x18GH\x89\xd6\x19\xbb\xf6_F\xa9R\xc3\xe8a<\x16\xb2d\x94\xfb\xc9\xd2\xeb\xde\xfd=\x9dO\xfe\x0e\xa5\xef\xfcQa\xe4\x98\xe8\x9fy\x17ƽ\xea=\xdaX\xff\xcd\xf8Ui\\\x0fc;l\xf9Pc\x8btp\xe3\xb7% \x1b\xf7\xfd)׀(\xbd\xeeݿ\x8at\xff-B\xe2\x17\x8ab\xc7E\a\xf0\v%\x8c\xd6\xf8\xa5\x02\xd5\xe9Il?\xb7\n\xa9$ʲ(z.\x81m\x1386\xee\x90\x1eɋE\xd0:_Z) Ԑ\x1a\xe8g\x9aA\xa6\x87\xe9\xae&K\xd2s\xfc\xb0\x18\xfd]\x8c\x18\xf1\x92ʲ\xf0\v\x85\xe1g\x92\x99\x9d{\xb1\x1c\xe7\x1c\x97(\xa6\x8c\x1d|\xb4\xf4ڻ\xee\xea\xdc\xf3\xdf\xcc\xda\xe9\x83fl\xee\xc0{\f\xea\xfd\b\x05\x96\x8dq<\x10l\xb9;\x83\x10\xc6\x14\xbf\xed\x00\xa9]\xf7Z.\x02\x8e\xdc\xf1Λ\x8d\xb4\xffw\xa4DJ\xc9\xd4\xecN\\\xbf\x80R\x12\xd7\xf7)\x8f7\xf0\n\x01\xb6\x93[\x86\x90\x92\xc0\xb1)\xba\x0e\x9e\xad\xb0\xa4\x04\x03\x06\x93/6\x90\x0e\u007fVbX\x8c \xca\f\xedD\xa3\ryʛ&@^\x97\x18m\xd0Y\xbaU4\xe6\xb6C\x96\xa6D\xfd\xbcr\xdf\\\xccb\xa5Ful\xe2\xabëk\xbc\xfa\x87ʷ\xfd/\xdfѨL\u007f\xb7\x89\xe5o -\x81\x18.\x99\x94\x808\xbfH\xd5I\xf6R\xd7\xcf\xfaV\x03\xd2|\xe4ӈk\xbe\xa3\x82\x10\x1fF\xd9\xd2\x18C\xb9֠:>\x89\x10\x02\xc7\xf3\t\xca\x05\x8a\xd5J\x9e\xa2*\x95\xefc!(8\x16\x96\x14C\xbf\xb3\x05\x860\x90j\xe8\xa50\xdf\xcf\xff\x8c3\xcdZ\x98\x90\xa4\x19Fg\xb9\xf9\x00Y\x92\xe4\u007f/\x03=\xb4\x1c\xc8\x13\t\x83!\x89\"\xd2$!\xcdR\xa4\xb2\xc8\xd2\x04\x8car\xdb\x0e֗\x17\x11\x02\xbcB\x91A\xb7\x8b\xd1\t\x18\xeb\x93ڭt\x8dp\xbd\xdc\xed\r\x1d\xa36\x17ng\x9d\x9ao+@\x1a{v\xb1\xfa\xc2q2\xaf\xfa\x9bګ\xed\x04\x81\x94\x82\xb9=\x17#\x05؞\x8b\xedX8A\x80\xb2\xadQ\xa05\x80R\x121|\r\x90m\xee<\x03\x91\xd6$Z\xe7\x81\x1bC\x92jzqB\xa2ϩÌAg\x19Y\x9a\xa1\x8d\x01=\x04#\xcb\xd0Zc\xb2|\xf3f:\x1bU\xf6\x9b\x89\x80њ\xa0X\xc1\v\n\f\xbam\n\xc52Fk\x06\xbd.\xc6/9\xa6T\xafӋ\xb7\xe2\x85\x01a4篾Ad\xb1\xfe\xb6rY\xab/\x1cg\xdagʸջ\x909\xe7\x14\x14\xcbXv\x9e\xc2*5\x04Aʼ\xc6\x10y\xd0\x15B\"\x84$\xce4q\xa6I\xb5&JS\xc24c#\x8eY\xef\x0fh\xf6\a\xac\xb4:,,\xaf\xb3\xd6\xee\x12\xa7\xe9\x906јL\x93$)i\x9aa\xb4F\xa7)\xf1 Ĥ)\x06\xc8\xe2\x04\x9deH!\xb1,{\xcb\xcd\b1\xa2d\xb4Θٹ\x87,M\xe9l4\x99ޱ\a\xad3\x8c\xedc\xb6\xefG\x90\xe5 \x1a\x03&\x83l\xf8s\xae\x93K\xfa\xff|\x1621Se\xf9L\xeb\x1b\xfe\a\xe3\xed\a~\x04\xc7\x17\f\xa9\r/( \x84A*\x99\xf70\x00\xe5\xe4\xb4y\xdeP\xca\xddS\x96e\xf4\x8d&S2OZ\x8da\x90i\xb2,Cgy\xb0β\xbc~H\xe2\x18\xa5sp\xf5\xb0 ܴ\xb44NH\x06\x83\xa1KOr\xab\x1b\x02\xa0\x8d\xc1\xf1\\\x92(Bk\x81\x10\x06\x18Z\xa6\xd6Tj\xe3(ˢ\xbbѢ>>M\xb1R\xa3\xd7\xde@קP\x13\xb3\x98\xe5EP\x06\x92\x04\xe2\x10a;\xe7ZI&\xe2ީo9 s\x97\xec\xe3\xd43G.\x00\xe3u\xbf\xf6G\xbe\xd1Y\xeeA\x8d\xa1<\xbb'\xfd\x8b\u007f}K|\xeew\xde\xf0\xa3\xef\xf2\xbfph\xf1{\x8dp\x10\xd1\x00a\xbb\xc3\xc5ȭ@\xca\x1c\x14\xc7\xf5r\x82o\xb8X\x9b)g\x94\x19\xa2\xc4\f;\x81Y\xceOe\xd9衕\xa5\x10\"OW\xb3\xe1gi\x1c\x93F1ʲЙ\xce\vAc\xf2\x94wx\xaf\x9b1\t3̰\x8a\x05t\xa7\x9b\xf3Y\x06\x94R\xa4i\x8aT\x92\xa0\\\xa1\xbd\xbe\xc6\xf2\xc2\t.\xbf\xee\x16\xbe\xf0\xa9\xbfA\xa4\t\xd1\xf4.\xec\xd5e\xd0\x06\x11\x87\x88^\a\xe3\xf9`YCWfR\xd9]9\xf8-\a\xe4\xd43G\xd8~\xe9\xc57D\x1bk?otV4ZK\x1d\x87ޗ~\xed.w3\x8f\x14y\xa6\xa1\xc7\x1a^,-;\x12\xcaJu\x12y_\xfc؇\xaaz\xe6ꋩ\xefAt7@*z\x9d6i\x9a\xe0\xfa~\xde~U\ne۹\x15h=\xf2\xcbY\x1c\xa3\xb3l\x98\xb9\xe8\xa1;b\xc4\xe6\n)\xd0qJ\x9a\xc4\xe7\x11\fB\xe4I@\u070f\xd0i\xba\x05\x846\xe7}g\xf3}\x00\xdbu\t\fD\x83\x01I\x9c\xa0l{\x04p\xa5֠\xdd\\\xa3\xb9\xb2\xc4+\xae\xbc\x9eb\xa5J\xbf\xdbA\xf8\x01zb\x06\xb9\xb4\x00i\x8a\xec\xb4\xc8\xca5p\xfd\xfcwdQf\xad\x1e~\xea[\x0eȎ+\xaf\xa8\x0f\x96O\xfd\xea\xf6[\xdf\xf6\x9a\xca\xeeK\x11Rb\xfbE\xdc\xdaT\xbexB\x10\xae\x9d!K\x12\xc0\xa0\xd3\x04\xa3uN\xeeI\x8b8\x13<q\xf00\x19\x02\x19G\xa4qHse\x89r\xb5\x86\x17\xf8H)Q\x96\x1aY\x861\x06=\xa45\x8c\xd6dqL6\xf4\xfd&\xd5h\xad\xd1YF<̎\xa4\x94\x18\x9d\x03\xb4i\x15\x00ʶ\xb0\x94\x85I\x18\xbaǯ\x1f\x1e-\xdb\"\x89r\xf7h̖5\x15K\x95<A\xd0\x19O>\xfcyz\xed\r\xa4R\xa05zb\x16\xb9r&\xcf\xe8\xe2\bt6\x8aE\"\xee\x9dYZKO~\xcb\x01\xe9/\xcd\xef\xd5\xc5\xc9ל\xd5uֿ\xfc\x05\xb41\x04\xf5\t\xc6\xf6\x1c`r\xdfex\x951j{\xafȃg\x96\xff(%I\xb3\x14\x81@JIR\x9c\xe6\xe9\xcf}\n\xdd\xed\x90\xf9\x01\xebKg\x98ھ\x93\xb2\xaa!\x95\"K\xb3\xa1\xeb\xc9-!\x8d\x13\xd20\"\tc\xb2,\xc5\x18C\x1a\xc7\xc4QD<\bI\x93\xe4E\x9bR\x9b\x9dŜ\xa2פ\"\xc1q\\\xb4\x06\x1di\xa4\x9d\xbb\xc9\xcd\xc5\x16\xe7(V\xb24w{y\x06\xbb\xe5\x16\xa5e#\x84\xa4\xdf\xed\xd2\xddh\xe6`\x00\xa41\xc2\xf50^\x01\xc2\xfe\xd0\xff\xd9礼\xfa\xbeoEbt\x01 B\xa9W\xeb\xc2\x04\xbdC\xf7Ӝ\xbd\x0e-mD+\xe6\xc4\x03\x0f\xe1>p/\x93\xb3\xd3L\xef\xd8\xce̫ވt|\xd2(\"L\x13VN\xcf#\x95\x85\xe3y\x94*5v\\~\r'\xbet?\"\x1c\x10:.\xcd\xe5%\x1aӓ %Q\xa7\x8b\xf6\xf2J7KR\xd20$\x8dS\xb4\xd1$QĠ\xdb#\x0e\xc3ܥ\r\xd3L\xa9\xf2\xcc\xcc\xe8ܵlƟ$\x8e\xf3 ?,.\x13;\xa2X\xa9\x80\xc8\xeb4#\rҒH\x95\xd7!bX\xccei\x8a\xd1[u\xce\x16_9\xfc\xaf\xd1\xc8a\xdf\x05\x03\xb3\xbb_A}|\x8a\x93k\x8b\fN<\x9f{\v\xcb\x1a~\xdd \xc3\xf5O\xff\xb3\x00b\x84u\x83Hzd\xe3\xfb\x11\xcaF%q~\xb7\xe5)b\xe0T3ba\xfd(\xe5\x87~\x96=\xb7\xfd\x00sW\xdfB\x96$\xf4{\xfd\\\xa6\xd3\xe9\xe0\xb8\x1e\xf5\x99YZ\xd3sl\x9cx\x9e,(\xd2\\Y$M\x0e\xe4n&M\x88{)Z\x1b\xb2t\x18/0\xf46ڄ\xbd\xde\b\b\x01HKQ\xa9\xd7p\xfd\x00\xa1\xf2\x9a!\x89\"\xc2A\x88Ns\xe9O4蓦)B\xeb\xe1Bk\n\xd5\nF\x834y\xac\x92R\x0eA\x15C\xcb6[q\xe6\x1c\xbeK)k+\x0e\t\x89\xa5\x14\a\xae\xbb\x9e\xa9m\xbb\xc8\x12Mk\xdbN\xfaǞCf:\xef\xe9\v\x83\x88:\xb4\x1e\xfa\xfb?\xfb\x96\x02\xb2\x99\xe6\x1ae_\x93V\xb6\x93\x15&\xf3|\x1b3\xe2\x93r_\x00Z\xd8tZ6\a?\xfck\xac-\x9c\xe0\x92\u05fe\r?(0\xe8u\xd1Z\x13G\x11\xaeTTw\xec\xa2s\xf8 \xbaס\xdd\xf6\t\a=\\\xdf\xdbr!\x90W\xd9\xc0\xa0ӥ\xdf\xe9\x9cws~\xb9\xc4\xd8\xd48\xb6k#\xd4f\x05/\x10\x140\xc6\x10\xf7c\xc2^\x84\xeb\a\xf4\xbb\x1d\xe2p\x90\xbf\x1fE\x88N\a\xc7\xf3AZH)G\xeeuԙ\x93\xb9E1\"\x15\x87\x05\x9f\x949[l\f\x8e\xe7q\xddk\xefdb\xdbl^\x9f\xacvq*c\xc3<=\xcd5`q\x1f\xd9]\xfe\xd8K)\x15^\x14\x90\xad\u007fH\xee2ny\xc8\xe6m\x99\xf0\x90\x97@D\x03d\xaf\x8d\x88\x06\x18{\x8a\xf9\xbf\xff#\xfa\x8b\xf3\xec\u007f\xf3\x0f\x13\x0e\x06yl1\x9a8\x1aP\x1a\x9f\xc0\xaa\xd4\xd1\xedu\xa2\xa0Hsy\x89J}l\xb3\xb0Fo\xd6\x18YF\xd8\xef\x91e)R*,ۦT\xabP\x19\xaf\xa3\x86\xc1Y \x91\x8e\x8d5\xccj\xb28\x1c&\x1c6Q7\xca_\xbb.a\xafK\x9a$D\xfdA\x9ej{rS\x97\xf2Uu\xb59\xbf\xb52\x8cQq4@k\x8d\x17\x04\xbc\xea\xbb\xeedz\xdb\x1cY\x96!\x108\xbe\r\x9e\x8f\xb1l0\x19\"\xec`5\x8f \xa2\xce\xe7\xcf_\xc3oQ\xa5>\xeb\"\x11\x02cy#\xdf8b\xf6\xf2\xea\r\x928\xffSJ\x90\nY\x9cc\xfd\xc9G8\xfa\xb7\x1f\xa0X\x1d\xcb\x19\xd0aJk\xb4\xa6\xb2k/\xf4\xba\x884a\xe1\xf8\v\xa3\x9aC\xe4\x9b\x10)%\x9d\xd6:K\xa7N\xd2\xeb\xb4)U+Ll\x9b\xa2<V\x1d\xb1\xb3RZ(\xc7\xc1)\x94p\nE\x9c\xa0\x80W\xa9\xe3\x95\xeb(\xcb\xc2+y\x04e\x8fJ\xa3Jm|\x02a\xf2\xdf\x11\xf6\xfb$q\xf4\xa2\x0f\x9e\x8c\xd2\xec\xf3\x93\x84\xd6\xfa\nB\n\xf6\xbd\xf2J&g\xb7a[\x16\x9e\xeb\"\x048\xbe\x8bU*\x81\xe3\x00\x02\xd5:\x8bj\x1eC\x0e\xd6?\v\xd0ػ\xe7[\vH\xb8\xe3\xe2Y\xa4\x85\xb1\x03\xce%҄ш8DF!\xc2\x18\x84\x1e\x06\xb4\xcd\x15s\xc6X}\xf6\b\x9dg>\x87\xe5zyv<$\xfa\x8a3\xdbAJ\xe4\xa0\xcb\xda\xf2\x12\x9dVs\x94\xed\b!\x88\xc2\x01'\x0e\x1fbu\xe9\f\xdb\xf7\xee\xa16\xd9\xc0vs!\x83T2\xa7[l\x1b;(b\xb9^\xae4\xd9t;\x8e\x8bW\x19\xcbIK\xdf\xc1\r\x1c\xca\xe3%ܠ\x90gkYƠ\xd3!\x8d\x92\xf39@\xad\xc9\xd2\xec\xabz\xa6\xf9\x0e\xd9X]\xa5>9\xc5\xec\xee=H)\xa9\x14\v#+\x92J\xa0|\x1fc[\xb9\xcd6\x17\x10ix\xccX\xfe\xf6\xb1\xfd\x97\x16V\x8f\xbep\xde\xef\x19\u07fb\xf7%rYҮi\xb7\f\xca\x19},L\x1e;D\x1c晍\x94\x18\xb9ٜ\x90C\x1a\x1a2\xe3q\xf6+\x0fc:\xab#\x97 \x86id05\x8b\xe8\xb4!\xcb8\xfe\xdc3C\xca\xc3 \xa5\xe4ء\xa7Y>=ϞK/\xa3X\xab\x8e\x8a8e\xab\x11\x18N1\aC\n\xb0%\xd8bs\x1d\r\xcavp\x8ae\xcem\xb6\x97ƪdIB\x92\xc4h\xad\xe9\xf7:\xe7}n\xb4F\xb0\x99\x06o\xf2i\x10\x0e\xfadi̮\xfd\x97P\xacV)\a\x01\x13\xf5\n;f\xa7\xf0]\x17 \xa7\x82\xfca\xdb#NQ\xc2\xde톽O9ݵ\xee\xc4\xf6)3\xbe}ꑉ\x9d\xb3\xbf=\xb1m\xe2\xf5\f\x9a\xdb\x1a{v\x97\xbei\xd5I\xd0\x18߇\x1d\xbc3\xad\xee\xcc\xcd^\x88\xfcO\xads\xbayh5Bg\x88$\u07b2\x10\x9d\xa7\xa1Q\xa7\x8b\x88V(\xec~e\x0e\xa2\x90\xb9\xcbP\x8a\xfe\x89\xe7\xa1\\\xa3\xd7\xeb1\xb7w\x1f\xae\xe7\xb3p\xfc(\xcf=\xfeefw\xef\xe1\xe2+\xae²-\x109E\xa2,\x1b\xcbq\xb1\vE\xa4\xedR\xb1\x05\x17\x15\x15\xdb\x03ɤ')\u0602njH\xb4\xc1r\\\xe2^w\xf4\x1c\x8ec\xd3\\^\xcbi\x17\xa3G$\xa6e\xdbdi2\x12go\xb2\x0ebȥ5W\x97\xa9\x8e7\xb8\xf8\xaa\xab\xb1\x95b\xdbd\x03%\x15J\n,K\xd1lw\xe9\xb6\xda,\x1f}\x1a66\x90h&\v\xbb\x99\b.\xa3hM\xe2\n\x8fĶf\x13K]g\x8a\xe3\xef\x00\xde!\ak7\x16J\xfe\xf6\xa0Z-Z\a\xbe\xe3Tt\xfaH\x06\xd0\xd85G\xbf\xd5\xfe\xfa\x80\x14*\xa5\xc8XֿIk{F~8\xa7\x957\xb3\xada\x1b\xd4\xe8\x1c\x90!hl\xd2\xd9\xca!n.R\x9c\x9aB\x95\x1a\xe84\xc5\x18\rR\xd1?u\f\xa3,\xb4\xed\xe0z\x01B\n\x0e~\xf1A\xa4R\\}\xebm\x04ŭf\x9b\xb4\x14\xb6\xebc\x17\n\xb9\x05\b\xb8\xb2j1\xe3\t\x8a\xb6\xa0d\v*vn\x99k\x91\x19\x16\x99\t:I\xf2M$\x05a\xafO\xa7\xd5\xda\xf4D9\xd5.䐿ʭ7/,\x1d0\x90D!ڤ\\|\xd5U8\xaeK)\xf0\xa8Wʣ\xca\xc4s\x1d\xd6Z\x1dz\xfd\x1eK\x87\xbf\x8ciu\xb02\u0378;\x8d-l\x94p\xf0T\x95\xb2\xa8QR\r\x12=\xa0W\xaa\x17\x93\xc9+\xf6a\xfbw\xa8\xc1\xda[\xec\xf5\xe7\xff\xb5?>\xd9\xec\xb7\xda\a\xfb\xad6\xe3@\xff빬\xd5\x17\x8e-\x89$Bf\xe7\x04B\x91\a\xefQ$\x16\"oa\xda\xf6\xa8\x0f\x81T\x9b}RR*,\xdd\xfbgX\x96\"=\x87V\xf1\x1a\x93X\xdd6Hɱg\x9f\xe6\xb1\xfb?O\xaf\xddf\xfb\x9e}\x94k\xb5\xf3*q)s\xbeK\r\xa9\xf2\x82-(\rC֦˷\x05\x8c\xbb\x12_\xe5ܗe{\x9c;;\x12T\vl\xac\xad\xe4\x99\\\x9a\x90\x84Q\xae\xf1݊\xe0y\x1b@@\x9a\xc6hcر\u007f?\x85r\x05c4\xd5R\xe9\x02y\x8e\xa5$\xb6e!\xdc\x02\xd8)\x9a\x90\xd5\xf8$\xb1\xee1LIQ\xc2\xc17\x01;\xd8ˁn\x9d\xf1\x85#\x18\xafJ\xef\xb2w\x94\xc2ݷ\xef\x15Y\xf2G\x13s\xe3\xfd\xf1\xedS?\xca\xf6I\xdb{\xfd\xcf\xe5\xf1f\xe7\xcc\xf9\x1621S\xa5\xd7\t)TKo\xc8\n\x93\xb3\xc6.l\xf14\xe6\x1c\x97E\xde\xd4\x16\xda\f\xadd\xd3j6SGC\x9a(<\xbb\x87\x1a\xdf3\\\x04\x81\x19\xf4\bO\x9f \x98\xdbE\xaf\xd7#\x8eB\n\xa5\n\a\xaey\x15n\xe0\x9f\x97\xe9(\xd7F\xb9.\xcar\xf2\xe4\xc0\xc0\x98+)Z\xe7\xad9\xfd\fډa\xa0!Kbt\x12\x8f\xeeQ)\x8b\xf9\xa3\xcf\x13\xf6{\x04\x85\x12H\x91\xf3bZ\x8f\\\xafT\x16\xc6\x18\xc2^\x8fb\xadBur\x1c\xa9$\xb6\xa5\x18\xaf\x96\xb1,5z\xb4N?de\xadE\xb7\xd5a\xe1\xec2\xea\xc4\xfd\x88D\x117\xfb\xac\xf4O\x12\x8bΰ\xe1\xa6P\xc2\xc6`\x90¡j\xcdP\xe9\r`\xe3\x05\x06\xe5\t\xa2\xb9Wa\xfc\xba-\xc3֛d2x\xad\xbb\xf4ؚ\xb7}߱\xd5#G\xb3\xf3\x00\xe9u¡\xdb*l\xd3~\xf5V\x1d\x8c\x9dcG\x121j\x93\xe6\\\xb6\x91\x129\xe8\x8d\xdc\x1a\xe7\xe8k\x11\x92\xac\xdf\"\x98\x9eC\v;\xa7ɣ\x01\x83\xb3\xa7q+5\"!\xd1Yƾ˯dr\xfb܈\xb15\xda`9\x16b\xb8XBJ0\x868MY\vSb#H\x909\x10)\xac&\x82V\nq\x9a\x91\x86\x03\xb2d\xab\x1b\xa0\x94Eku\x95\x85cGs\xd5|\xa9<\xdcK\x12\xad\xcdh\xf3DC\xab\xa9L\x8c\xe3\x17\vHea+\x89КA\x9c0H\xe1\xe4\x89S\x1cz\xfc\x8b\x9c\xfc\xc2},>\xf6 \xfdx\x80ܘ\xc74\a\xe8\x14D*\x89Ä~\xd8$\xa6Oj\xf5\xf1d\x19\x89D\xa3\xb1\xa4O\x99\x12\xa5N\x8b\xa4w\x92Am\x8et\xe2\x00ƫn\x93a\xeb\xed\xd6Ʃ\x03\xfe\xc4\xec\x13\xfdfk\xedB.+\xe9}\\F\xed\u007f?\xec\xe0l\xb9*e\xe51#\xd5y\u007f#M\x86\x99\x96\xc08.\xd8\x1e\"\x1a\xc0\x10\xa4\xc1ƀ\xfe\xf2)\xe4\xf8n0\x86L(\x84\xed\x10\xad-Cc\x1a\xc7\xf5\x98ݵ+\xefE\xc4\ti\x92a\x8c \xcb4\x8egc\xb2\x0eI\xbf\xcbfa\x13KI\xb3)\xb1=\x0f\xdb\xf3\x11\x06\xa2~D4\xe8\x01\xe90&lyQ\x83!Mc\x8c1\xac..`Y6\x8d\xe9m\x9b\xdb\t\xad3\xe2(o\xe7*\xdb\xc2\v\xfc\xa1tH\x92&1\xeb\x9d>ǟ;ġ\xbb\u007f\x1fq\xf2qn\xf1+\x1c\x19\x14h\x1a0\x93s(+\x80$\x1c\xf9P\xa35I\xa8\xe9\xc6\x1b\xe8P\x10՟cL\xed\xc0\x16\x85\xbc\b\x15\x8a@\xd5ٓTY\x98\u007f\x9a\xe5\xb9\xfd\xa4\xf5ݤ\x959\x9c\xb3\x8f\xbd\xcd^~\xfa\r\xe3;\xa6\xbf\xf7\u008e\xa1\xe1\xb0\xea,\xfe\xb5\x18\xeb\u007f\x8fq\n[o\xdb6B\xa7y\x9ak\f\"\x1c\xe4`\x94*d\xe3\xb30\xa4\xa8\xd5\xe9\x17\x10\xdd6Y\x06\xfd\x95U\xbc\xf2,i\x92\xa2\x85D\xd8.Yk\x1d{z\a\xae\xefc9C!\xb5\x14$\x83\x1e\x87\xfe\xf2\xf7\x98\xba\xeaV\n\x8d)l\xdf\xc7v%B\xa7\xf4\x97\xe7IN\x1dC\rZȤ\x8fQ\x12\xec\x00c\a\x88\xf2$Nc\a\x14+\x18\xc7\xc3q]\n\xd5\x02B\xc9\x11Ub\x8cf}\xe5,\xd5\xf1I,\xc7\xd9\x14\a\x8d\xaau\xdbqp|?'$ӄ\x85\xa3\xcf\xf1\xfc'>\xca\xcc\xf2\x93|\xe0_\xbc\x83[\xb2[9|\xcf\xe7\xd8h\x9d\xe0>\xb7\x82\xb3~\x06,\a\x84\x06$\x96r\xb0\x94C\x94\f\xc8tF\xbb\xb3\x8a\x1b\x06$\xf5\xa3\x8c\a;\xf1d弘<\xcbN\xcc\xe9ìnۏq+\xc4s7\x90\x15'=w\xfe\xa1\xbf\xbb\x00\x90\xe53\xcd\xde\xe4T\xfc_\xad\xb5\xc3w&\xb3\xd7\x06#\xce\x1f0\xb6\x83\xd0:\xe7q\x92\x18\x94B\x17+爦\x04zl\n\xd5m\x03\x8ahy\x11o\xe7\xa5\xe8,E\xd8yM\x91\xf6;\x04\x96\x1c\xea\xa0\xc4(\xf5\xb4l\x87\xf2\xf6\x8bY|\xe2a\x92\xb0O:h\x93\x88\x04\x16\x9f\xc0\x1btxW\xe9\"\xbe\xfbuo`\xff\xdb\xde\x01\x17\xed \x8d#V\x1e~\x8c\x83\xf7\xde\xcb\xdd\x1f\xfeM\x8e\xd7\xc7Y\x99\xdb\xcbjm\x8a\xda\xeeK\xd9u\xf5\rX\xceVl\n\a}\x9a\xabK4\xea\r\x06\xeb˘L㖫x\xf5q\xc2~\x9f\xa3\xf7\xff\x03J)tg\x91\xb1\xc7\x1e\xe0?\xbf\xeaj\xde\xf2\xbf\xfd$\\}\x80\x8d\x8f\xfe\r\xf3\xebk\xe8\xa8\v\xc1\x18\xc6\bRS\xc2r\x80(\xa7\uf2c5:~\x9a\x11\xc6\x1d\xd24\"J\xfbd\xab6\xa7\x83CL\xd7\xf6Q\xb4\xc71l\xf2f\x8amz\x06q\xfa0\xcb3\xbb!\x98 \xab\xed!\xf4\xc7\xe2\x17U\xbf\xf7\xba\xc9ɒ\x9b\\\x92\x95\xe7.3\xb6w\x1e\xbaB\bD\x96\x0e;\x82\x12S\xaa\x81uN_\xc0q\x11\xdd\rD\x9a\xa0\xa3\x10gr\x1am9 \x04\xe9\xfa\nz\xd0C\x17+\xf8\xb51fw\xee\xca5Y\b,\xd7al\xf7\xc5\x14g\xf7 \xbc\x02\xabG\x9e!N\x04\xa6X%\xca\x06\\픱\x9b\x1d\xf6]}%r\xd76\xa46\x946\xfax+\x1b\xd4\x06\x19k\xcf<\x8d\xb7|\x92\x17\x96\x0f\xb2t\xe6\x04\xeb\xcf<H\xdci2\x10>FH\x841\xc4g\xe7Y?\xf8E\x92\xce:\x85J\x89\xb3\x8f=L\xeb\xc4a\x9a\x87\x1eb\xe1\xd1/\xb1\xfc\xdcs4\x9fy\x94W\xaf\xad\xf3\xc6]\xaf\xa0\\,\xb2v\xff\x17y\xf4o\xef\xe6\xe0\xe1C|\xa6P!\xb4|D\x96Bf\x90\xba\x0fa\x86\xb2\x1c\x02\xb7\x8a\x14\n\xc7\xf6q\x9c\x02J\xdaDI\x0f\x9d\xc0 mR\x0e\x1aHq\xce:\t\x8b2E\xcc\xc6\vt\x8be\x84r1\xcaI\xbe\xe68\u009b[\x9d\xbf:Q\xf6~&\xabl\xf7\xce#\x18\xa5\xcai\xf6^;\xaf\xdaK\xd5<\xbe\x9c\x93N\xa2\x14\xb2\xbd\x9e\xd3ߖD\xd6'\x11R\x90u\xdad\x9d\x16\xa6X\xa641\xcdԎ\x1d[\r\xa0a\x86e\xbb.\xfdn\x9f\xf5#\a1a\x17]\x9bC\x99\x84\xa7\x92\x0e\x97+\x87\xd6Ӈ\xa9w#8\xbb\xc2\xf23\xcfq执\xb1m\x8b\xe7Ϝ\xe1\x8cJ\x98w\x05Z\x94\x19\xb4\x14\x83\xa5%l\xdd&\x901\xba\xdd#=;ώko\xe05?\xf1\xf3\\\xf4\xaa\x1b\xa9\xed\xda\xcbs\xff\xf0q\xe2\xde\x00L.tȤK/Ѭ=\xfa%\x8e>\xfcE\x9e|\xf8\x8b\x1c<\xfe\x02\x9f\xf7\xe1D0\x85PV\xae<\xd1\x1aA\f\x83\b\f\xf8nyh\xf1\xb9~\xc0\xb6\\\x04\x90d\x11Yb\xc8Ԁ\x927\xbe\xa9\x12\xcbe\xb0BP\x96u\xe2\xf6\x11\x06\xa51\x90V\xfa\xa2\x804v\xef\xe0\x91\xe6\x06\x85r\xf0\x00ʾK{\xd5\xf3\xbf`ۈ$B$\x11\xa6\\\xcb\xe3\xc7W}.[\xab\xf9\x8d\v\x89=\xb3\x03\x81@\x0f\xba\xa4\xcdUL\xa1Byf\x96\xa9m\xdbG\x1d\xbds\x8bB\x1d\x87,\x1f:H\x12\xf6\x11\x99&\xab\xed!\x8b\xda<\xa54\xce\xfa\x1a'\x9f8\xc8\xe2\x93O\xb3|b\x9e\x85N\x8bG\x16\xe69d9<R\xac\x11\xc9\n\xb9\xa23\x83l\xc0k\xde\xfa\x06\xbe\xfbg~\x99ޙ\xd3؍\tnx\xe7\xbb\xd9;V`\xaeh36;\xcb\xe1'\x9e\xa0\xb3|6\xd7\t\b\x81\xf1\n\xac\xfa\x01\x8b\xb6\xc7\xca \xe4i)\xb9\xaf\xd1`>\x98Ex\x01\xa6>\x05q\x8cHb\xa4\x89\x11\x83.F\x83m\x05X\xd2>\x9fJW.q\xda\xc7\x18M\x14E\xb8\xae\x8bk\x17\bp\xa9\xe0\x93\x90\x91a(k\x9fA\xb8@Tl\xa4/*\x03Z=v\x92\xc6E\x17\xb1z\xe4\xf0\x17Ƅ\xf5+\xda\xf2\u007fA\x97\xa6\xce\x11\x8a\x19\xb2j\x03\xabׁ4\x06\xdb\xf9\xaarSa\x1c\x1f\x91\xb4Q\x13\xd3`4\x06\x81\xf4\x82\xe1\xa8@\x92\xeb\xa1^l\x18\xc2@yr\n\xafRc\xd0\\\x81\xb0\x8b讣\xfd9V\xbag\xf9\x88\xb4\x98\xd013q\a;Ԝ\x1e$\xac:%\x9a\xae\x8f\x8az`\xf2\x02\xd5d\t\a\xae\u007f%\xd7~\xffO\x00\x82A\x18r\xe0\xce\xef\xa6\xe2\xda\xec\xaey\xd8RR\xce\x04\u05ff\xf9\xad\xfc\xf9c_\xc2\xf5|Lm\x12ݘ\x02\xad\x99O\x13N\x0e\xa7\xde7\xdbW²\xf3~I\xbf\v\xfd\x0eFX\xa3\xcc.\x8c;\xb8vp\x1eg&\xa5\"\xf0*tz+\xe8̰\xd8<\x81\xefT\xb1\x94O\x01\x0f\x1b\x8b%6@\xfal\x1f\xc4\x1c;\xf3\xa4\xfa\x9a.\xab\xbf\xbe\x9e\xe7\xe7z\xe5~\x91\x0e\xe6t\xa1q\xa5q\x8a[šR9\xe3\x19\r0A\xf1\x82Q\x13\xd9Z\xc5*\x14\xf1v\x1f\xc8뉡J0YZ\x00ǥ\xb2}'\x13\xb3s\b)\xf1\xbd<\xdbڬ\xd6-\xd7\xc5d\x19\xcb\xcf>\x05F#u\x88\x91.\"\x15\xa4\x99b#\xb3YH\x1d\xe63\x9bu\x11\x10\t\a\x15\xf5\x11:\x06\xe9`\xb2\x94\x99}\xdbx\xf3\xbf\xfbO\x04\xe52G\xbf\xf2eN-\x9ce\xe7\r73[r\xd9Y\xf1\xb0\xa4@\tCe|\x92\xc7\x1e|\x90\xb0\xbd\x81\x99\xdc\x06\xae\x8f[,\xe6\x19\x99\xe7S\xac\xd4(7&(7\xc6Q\xb6C\x9cD\xb9\x14\xa8\xd3D\xa41\xa2\xdb\x05$FgxN\xf1\xbc\xbe=\x80%\x1d\xc2$o\xbc\xa5I\x02\"\xa3\xe4\x8fS\xc4\xc1\xc6B\"\xe8\x11bI\x9fB\x82\xfe\xba#m\x8d\xdd;Y>\xdb҃\xf5\xf5\x8fW\xf4\xca.\xed\xd7^i\x9c\xe2\xd6\\\x9f\xe3!\xba\xed\xdcB\xce\r\xecF#W\xce\xe2LLc\xd5\xc7G\xdf\x17B\x92\xac,\"\x84 \xf3\xcb8\xbeG\xb9V\xc7\xf3\\|\xcf\xcd'h\x87j\x94\xda\xf6]4O\xcf\xd3_>\x8bH#\b\x1c\x8c\xe5\xe7\xf5\xcf֞\xcd[\x03i2\xac\xbe\xf3\x11\xb8\xfa\xa5\x97\xf2\xb6_\xfa\xbf\xa9ի\xe8\xcc\xf0\xf1\x0f\xfd.\xb3\xd7\xddDmb\x92\x8b\x1b\x05\xcaN\xae'\x96R\xa0\xa5E\xec\x148\U000a51e0\xd2@\xb8\x1eձq\x1aӳ\xd4\xc6'\xa8OLR\xa9\x8fQ\xaa֨\x8dO\x90\xa5\x19\xbdN\v\xd9\\C$}D\xbf?ڌ\xb6\xed\xa1\xa4sA\x8fEkM\x92\x86H)\x19Dmj\xa5I*\xb2\x8cBb!\xe9\x12\xa11X\xc2K\xbe. \xfdf\x8b\xf1\xedӸ\xbb\xafd\xed\xb9\xa7\xff\xa6,\xdb}\x91\x86\xaf4vP0v!ϲ\xbc\x00\xd9ia\\?\xafQr\x1e\x1b\xd9iaU\xebX\x95\xfaV\xc5&\x15&\x1e\x90\xb5[\xd8\xe3\xd3l\xb4Z\xd4'\xa6\xf0\v\x05\xcaŀ \xf0HӔdHfN\xec\xdbO\xd4\xe9\xd0Y^B\xb7\xd6\xf3\xe4A\xa8\xa1\x9cSc\xb2\x14\x93\xa6\x80\xc0\xafԨ\xee\xdcÎ\xdb^\xc7Mo\xff!\xa6\xc7kH)x\xf0\x9e{x\xf6\xe8q.\xb9\xf1&*\xe5\x12\xb3\x15\x1f\xcfʇ|\x12\x03a&\x10\x951N>\xf5\x04\x9dA\x04~\x01?(P\xaa\x8fa;\xce\x05;~0\xe8\xd3]\x98G\xb6\xd7\x11Q7\x0f\xea\xc3\xef(a\xe1\xd8\xfe\x85\f\xae\xb4\b\xe3v^\xfbh\xb0lŤ?=\xb4\x0f\x88ɈH\x10\x90\xfe\xa3C\x9f\xfd\x8d.e\xd9&\xfc\xe1\xbb\xe9~\xf2\xb7\x1e*\xab\xf6'Ԡ9.\xe2\xee%\xc6+c\xdc\x028\x0e\"\x89sZ\xbe\xd7A\f5K\xca\x18\xec\xc6\xd49\x80\bL\x12\x93-/b5&\xc1qi7שML\xe2\xfb\x1e\x8ec\xe3\xb9\x0e:\xd3$I\x82\xb4\x1d\xc6/\xdaOm\xfbn\xdcr\x1dǲ\x11:#\vC\xa4\x10x\xb51&.>\xc0\xce\x1boa\xe7ͷ1\xf7\xaa[\x98ٻ\x8f\xf1z\x85Lk\x8e>\u007f\x8c\xfb>\xfdi\x8a\xb5:{.\xb9\x8cF\xad\x8ce\xd9  5\x82~\x96ϙh\xd7g\xe1\xf0s,\xbfp\x04]i\x10\xf6\xba\xbd\xac\xb5j\x00\x00 \x00IDAT؎C\xa9Z\x1b\xedr)\x15q\x14r\xe6\xe41\xcc\xe2)D8@\xc5M\bӭ\xe7\x13\xe0\xda\xc1\x05 \n$q\xd2˙\xef\xa1e\xce\x14\xe6\xb0\xc9S~\x8d\xa1G\x8c\x80\xf4\x9f\xa4~_>ӂ\xffr{\xfe\xfa\xf4\xfa3\xb0\xfe}\x8d\xbd\xc9%\xd6\xfa\xd1\x0fd\xd5]\xb7$\xe3\a0\xee\xb0\xcbhوA\x1f\x93%$\xeb+xI\xaeg\xdaL\x06\x84e\xe77\x96\xe6\xba\xdb~\xa7\xc3\xf3O\x1d\xc4\xf7_\x85c\xdbXJ15^GII\xbbۧ\xd5\xee`\x1f\xb8\x9c\xf1\x8b/%\xeau\xd1IL\xdc\xdd \xe9u(LLc\x17\x8aX\x8e;\x12o{\x9eC\x14',\x9e]\xe4\xc1\xcf~\x864˰l\x9bB\xb1@\xe0\xd8D\x1a\x96\u009c\xe6\xb6dN\\FQB\xea\x04\x88\xb0\x9fg\x8e\x96Ù\xe3G\x89\xfa}\xc6gf1Z\xd3n\xae\xb3rv\x81\xb4\xd7A\xf5\xf3ދH\a\x98Mm\xb01$iD\x92E8\x96\xffUn\v\xa4\xb0\xc8ȟ9Nb\xb4ѣ\xb0\xeb\f\xa1јon,\xba\xb1w/\xabG\x8f\xae\xf4\x9b\x1b\u007fP\xa6\xf9\x90\xb5v\xb4!\x93\xae\xc2\xf2,\x19\xf5\\l;\xe7\xb6z\x1dL\x12c7&G$e\xba\xbeL\xb6\xbe\x8a5\xd6@\x06\xc5!\xb1\xd9&MS\x82R\x85B\xe0S\b<2m\xa8\x96\x02J\xbe\x93\xb7\x8d\xa5D:.\x96\xe7\xe1\x15\n\x94\x1a\r\x8ac\x93\x14\x8bE\nA\x81b!\xa0T\b\xb0,\xc5م3<\xfc\xb9\xcf\xd0\xdeh\xa1,\x8b=\x97\\\xc6\xecΝ\xf8\x8eE\x92j\x8e?\xfd$\u007f\xf2\u007f\xfc\x14\x95\x99\x1d\xc4a\xc8=\xbf\xf7Aڽ\x88\xde\xd2i\x8c\xb20Î`\xbf\xd3fuq\x81ճgh\xb7\x9a\xb9V\xab\xb9\x86h\xb7\x10z\x80\xda\xe8P\xf2'(\xf8u\xb2,!\xcdr\xf7\xe5څ\vE\xe8I\x9fL\xe7\xf1O\t\xc5\\y'\xee\xb0P\x14@\x9f\x98\f\x9d~S\x80\xf4\xd7\xd7i\xec\xddC\u007f\xbdI\xaf\x1b\x1d뷻\x1f)\xa4\xf1\xc3\xf2\xec\xa9ר\x85\xe3\x13\xb2\xb9\x02Jc*c\xe8\x95%\xa4\xeb\xa1\xcay{6[_#k\xadbU\xea\xa8Ru\xe4\x126\xd6\xd7\xe8v\xbb\x94k5\xa6\xa7\x1a\xd8\x12&\x1c\x98-\xbbl\xab\x17h\x14=\x02\xcfA9.\x85R\x99J\xb5F\xa9\xe0S\b||\xcf\xc5ul\x84\x10\x1c}\xee0_~\xe0~\xfa\xbd.JY\xec\xbd\xf4\n.\xbe\xea\xda<\xd1\x10\x82\x8d8\xe3\xef~\xe9gY\x99_\xa029ɩ\xa7\x0f\xf2\xe8\xdf\xfd\x15R\b\x94\xe3\x90tۘjcK\x13<\xfa\x91\x88(D\x9e=\t\xc6`\xf5\x16p\xd32\x05\xbf\x86\x10\n\xdb\xf2\x88\x92.i\x16\xe3\xd9E\xa4<\u007fiä\x83\xd6y\xbc\xb3\x94bGi7ΰn\x91\bbR\"\x92\xf4\x9b\x1e\xd8Y=\xfa\x02uo'\xeb\xe1\t\xea\xde\xce\x1d\xba\xcf\u007f\xc7p\x00#!\xe9\xa2\xdaϡƫ\xc4;\xaf\xa1\x17\xa7d\x9dv>!\xeby\x98L\xa3\xf3sG\x86\x9d\xf7\xfc\xe1W\x16N\xf3\xe5p\xc0X\xad̮\x99\x06%\x1b\x14\x1a\f\xd4\x03\aߵ\x91\x9d\x98\xce9s1RJ\x92$aee\x8dg\x9fx\x9c\xb3\xa7O\x91&y\x116\xb3g\x1f\xfb\xae\xba&\x17\xd8\x01!\x92#\xf7~\x82\x8d\xb3K\b)\xa9\x8cOb\xcd\xce\xe5\xb3)\xdd6ս\a\xe8=\xf5e\x88\x06\xe0x\x17\x14H\xf2\xcc\t\xc82T\xb6\x06\x9d\x147(\x0e\xcb\x0e\x83\x92\x16\xbe[\xa1\x176\xe9\fV\xa9\x15gF\xe4\xa51\x864K\xb6F\xe0d~Hѹ\xcd/\x1f\x876\x83\x976A5\x04c\x17\xf0\x00\x86Y)$\x9e[$Lr\xc1\x9cXj\xe2\r\xee!\xdey-i\xef\x14i\xa9\x82IraD\xd6m\xe5\x19\x92u~u\xdb\\]\xe5\xde{>C\xf1\xado\xa2\xda(\xe0X\x12\xad\xf3\xa33\xc4椔\xceAJ\x92\x843g\x169=\u007f\x9a\xc5\xf9c\x84\x83>\xb6c\xe3\x06E\xfc `n\xefE\xd8\xc3aN#%)\x10u\xbbHǡ\\o\xb0\xeb\xaak\b*5&\xf6\xfd%\xb8\x01\xa5m\xdbYy\xe6+谟g\x8d\xa3BX#O\x1f\x87~\x17A\x8a\xe8\xae#\x8c\x83\xad\xdc\xf3tk\x81[!M#\xe2\xb4O/lQ\xf0\xaay\xc0\x8eZh\x9d\x8d\x82\xbd\xa5\x04\xf2\x9c\xa57\x807\x8c#\xdf\x14 \xe7XF\r\xf8]\x83\x99\xf5\xec\x80\xc9\xfaN\xca\xc5qN\xb6\x9f\xa4\xb3\xba\x8e\xd1\x16\"\x8c\xf0NރI\x14\xa6T\x80\xea.PE\xb2^\x17=\xe8#\xcb\xd5\xf3\xaa[!\x04\v\xf3\xf3\xdc\xf3\xc9O\xb3z\xe5\xe5T\x1d\blE\x96iV;\x03N.7\xe9\rB\x84P\xf4z\x03\x928b\xd0\xef\xe2\x06\x01^\x10\x10\xc7\x11R*\x1aS3\x14*\xd5\\a\xb2)\rM\x12.\xb9\xf3\xcd\xd4&\xa7\xa8lۉ5>M\xbb?`\xc7\x1b\xdf\xceƙS\xa4\xbdN\xae\xaeo\xaf\xe7I\x8a\xb2\xf2Y\x90ճ\x88\xceF.\xc0\x88\x971\xfd\f\xd7\xf2\x11B\x8e2\xa7\xcd\xca\xdcs\xcb\xc4iH?j!\x95 \xcbR¨=\n\xfcJZX\xd2F\xa2F,@\x1e\xd8\x156\xd6K\x9e1\xfcEc\xf4\x1dE\xbfʶ\xf1\x8b\b\xdc2\xab\xe6\x14\x9eq\xe8(@\x1b\f\x16YX\xc1\x846F\r\x10\xe6\x14\"؏\xc92\x92\xd5E\xbcJ\xed\x02e\xbbR\x8a\x13\xcf?ϩ\xe3\xc7s\xc2\xd1\xca\v\xb98\xc9\xf0\x8bE\x82R\t\xd7\xf5P\x96\x85e[\x14Je\xa4\x10\f\x06}\xa4\xb2\xf0\x83\x02ӻv\xe1xn\xae\xdf5\xf9\xaca\x9c$(e\xb1\xed\xba\x9bq\x1c\x8bN\xa2imt9\xf4\xf0}\x98S\xc7\x10\x83\x1e:\x8a\x11q\x8c\nC\x8cTy\x9b!\x1d\x8a'L\x84\xe8\xb6ѩ\x8d\xeb\x05\x17ܷ1&'\x15E\xde\xe7\xef\xf6\xd7FK\xae\xa4\x85-\x87\a\xe7hФH\xb15^g\x80\x12\x9e\xb4^\x82u\xdc!\x95\xfcYK\xf9l\x9fڏcy\xf4M\x1b5\x10\xc4N\x04IN\x1aJ\x0f\x82t\x82\xca\xe4$\x1d\x16imt\x11A\n\xd2\"Y9\x8b\xbb\xf3\"F\xa3\xc6\xe7R/\xc3aL\x03\x84i\x8aR\x8ajc\x82B\xa9\x94S1\x9b\xd3Q\xc3\xef\x02x~\xce%\x05\xa5\x12\xe5Fct\x0ec\x96\xa6\xf9\xeb\xcd\xca9K\xc9R\x90\xb6˳_\xfc\x02q\x14\xe1(I\x1a\r\xb6|H\x1cm\x91A\x96\x95ǎ\xc1\"f`!\x85\xc2R\xce\xf92\xdb\x11(\xfa\x9c\xb7\xf3(\xe9H\x97\xf1\xe2,Zg\xac\xf5\x17\xf1\x9d\"\x8et/\x90\xb6\x16\xf1l뛉\x1bñ\x85\xf7y\x85\x12\x13\x85\x19\\+W+\xc6f@)+\xb2\xa4\x8fa\x86\x83\xfa&\x11\xd4*\xb3H\xa5\b\xb21Z\x835D\x9a`,\x1b\x1dE\xa4+\x8bXS۶D\xcf_u\x15+\x15l\xc7\xc3\x0f|l\xd7\xfb\x9a\x87\x97m\xba;'\b\x98ڽ\x9b4\x8a0\x98\xbck(@Y\xf6pX(\x176\f\xfa}\x16\xe7\x0f\x93\x84\x11\xfb\xae\xb9\x9e\xb53\xa7\xe8\x1dz\x9cxq\x81\vN>\xd5\x1a\xd9o\"\xda\x11Z[X\xb63tWf\xf8խ\xef\xf7\xc36\x86\\\x93fI\x9b\x92[\xa5\xe6O`+\x9b\xb5\xfe\x12\x99I\x99\xf2g\xf0\x95O\xac\xa3\xaf\x92\x00\t\xf1MM\xe1\x96\xd5\xcc./(^S\b\xaa\x94\xfc\xbc\x9aMM\x86\xd0\x06\xcbv\x88\a\xfd\xe1\x8d\x1ad\xea\xe6'\x88\x1a\x90\xdaʕ\x1e\x9d\xf6P-(\x88\x17O}M0\xf2\xcdiS\xa9ׇ\x12կ?\xe4j\xbb.㳳(\xcb\"ˆSY\xc3\x11\x03)%\x96m\x8f,湯|\x85\xa7\x1e|\x808\x8e\xb1]\x17;(\xe0n۽u\xc4\xc6W\xd3\x1fq\x13t\xceP\x9f_\xf8\x9d\x03F\xd4\"L\xdaX\xcaf,\x98b\xae\xb2\x97\xc9\xe2\x1c\xae\xca\xef=NC\x02;\xe0\xb2\xfaUhL^\x1c\xbe\x94\xb1\xe8m\x93W\xe6c\x02\xa5\xea[\xbd\xa0H\xc1.\xa1\xa4\x95O\xbf\x9a\x18\xa53\\\xe1\r\x1f*\xa7\xae\v^mK\xf6\xa9\x1c\xa4P\x10ǹ\x8eI\bt\xbfG\xd6\\\xe5k\x9dǫ\xbf\xeaĸ\xafi\x1dRR\x9b\x9c\xc4/\x95F\xda]iY؎;\x8c5\xf6\xa8\x19\x96\xc41k\x8bg\x11R\x12\x87\x03\xe20DI\x89U\xad#l\xfb\x02W$t\x84 \x1dNCHl坓\x1f\xe5Ww\xb0F/l\xe2[\x05\xe6\xca{i\x04S\xb8C\xe0\f\x9a̤\xa4:\xe6\xd5S\xb7Rq\xab\xa4&\xc1\xbc\xd49\xf5\xd3K\xf9\xc1^A\xa9|\xbbT\x16\x81U\x18\xcdR\x18\f\x96T\x048X\xceV\x05\xeaX\xee\xb0\xd6\x10\xf9L\x86\x18\x06\xca8\x97\xec\x98,#>}\x02\x93\xc4/\xfa;\xb34\xfdG-\x03c(\xd7\xeb\x14\xab\x15t\x9a\xa2\xd3\x14\xd7\xf7\xf1\x82\xfc\xe8\x8es\xc1\xc89N\x99ώl\xaa\x17\x9d\xdc\x05)\xcb\xc6*\u05fe\n|1\xdc<\xf9&\xb3\xa4}ι\x8f\x86T'\xb4zg\t\xe3\x0eE\xa7\xccle\x0f\xae\x15\x9c\xe7|\f\x10&\x03.\xae]\xcc\xcd\xfbn%\x15\tI\x96\xb0uVE~E$\xd97\xec\xb2\xf6\xee\xbee\xc2r\xbdW\xb8\x96\xb7\x15\x98\x86rR\xcb\b\xa4eQs'\x90#\xed\xdc\xf0\x04\x86\xa1\xecж\x1cL\x9a \xc2-\x11e\xdan\x92.\x9f\xf9\xa6\x01q<\x8fb\xb5\x92O\x99\xd96\xb5\xb1:\xe5J\x85\xc0\xf7\xb1\x84$\x89\x93\xfcP\x81\xbc)\x83\x1b\x14\xd8{\xf9+\xa96\xc6)\x96\xabXVNhb\f\xde\xec\xf6\x17ߧ\"\x03#\x90\xcaB\"\x01M\xbb\xbfL\xab\xb3@\x92\f\b\xec\x12ӥ]\xd8r\x8b!6\xe7\x8c\xc7i\x9d\xf0\x1d\x17߆\xe3z,v\x17s}\xd89\xe7\xb8h\fk\xf4\x92o8\xa8+˾\\Hٰ\x8d3\x92v\xe6\x99e\x8aM.\x96\x9e\xb6w\xb1\xea\xac\"cE\x9cF\xa3\x05\x15\x06j\xe5i\xa2\xd5\x1eY\xbf\v\xc5r\x9ea\x19C4\xff\x02\xaaTAV\xea\xe7Ŕ,Kɲ\f\xcbz\xf1[\x8d\x06\xfd<h;\x0e\x85R\x91J\xa9\x84\x11\x82\xf9Cϲ\xf0\xd8C\xac\xcd\x1fG\xc7\x11\x96\xe7b\aEl\xbf\x94K\x84Ҍ\xa8\xd3!\xec\xb4i\xf6\xbbă.i\xd4Ŷ%\x96ꐙ\xe2p:\xd7`\x84\x97\x1fAtΕ\xea\x94d\xf8l\xbeSd\xba\xb4\x13[:\xe7\xedx\x81@\x9b\x8cN\xd4d\xb62\xc3\xfe\x99K\xb8\xef\xd9/\xe4\xe5\x80\xd8\x1a\"\xca\xd04\xe9\xd1'\xfa\xc6\xeb\x10)\xe5\x95HQ\x94\x99\x1c\xfdjc\f\xa9\x1e\x10\xd8\r,\xa9(\xc8\x12Uo\x9c\xf5x\x9d(\xee\x8e\xe4\xa6\x06p\x9d\x80Zy\x86\xd5֩<\xbf\xb7\xdd\xfc\xb3,\xa5\xff\xec\x93\x14\xae\xbc\x01\xe1\xb8#\xb7\x91\xa6\xf9l\xfa\x8b\x012\xe8vi./r\xf9M7S\x19\x1bò\x1d\x16\x17\xce\xf2\xe8G\u007f\x9f姿\x9c\xe3\xaa\xe3|\xc7\xd8\x01\xcaD\x14\x03\x97\xf5f\a\xcb\r\b\xbb\x1b؎\r\bl\xdbf\xbc\x1e\xb0\xd2\xea\xe1\x95ǉ\x9b\xf3$\xb2\x811\x12\x84\xc2\f\xe3\xc6\xe6\"&i>>g+\x87\xa9\xe2\x0ele\x9f\a\x86\xc1\x90\xe9\x94~ҡ\x1b\xb7\xb8j\xea-<u\xe2\x10\xad\xee\x1aR\b2\x86\x82C\f\x11\t\x1d\x06\xdf\xf8\xe13\x97\\r\xa7\xab\xb5\xb9\xd8\x18\xad\xac!\x97\xbf\x99\xf5\t\f\x9e\xb2\t,\x17\xad5\rk\x9a\xb6\xd8 NBR\x1dc)o\x14\x04\v~\xdeg\x8e\xda-Lc\xb3W/0IDx\xf8)\xfc\x03W\xe6\xf2\xd5\xe1\x91\x17I\x14\xe55\xc6y\xae,auq\x81]\xfb\x0f0\xbb\xf7\"\xb4\xd6\x1c\u007f\xf4\xcb<\xfe\xe1\xdfFwV\xf1\x1c\x1b\xbf`\xf3\xa67\xddD\xb5\xe0\xf2\xf7w\u007f\x91\x9bn\xb9\x9e\xe9\xe9\x1a\xcb\xcb\xeb|\xf4#\x9f\xe4\x17\u007f\xe9\xed\x1c80\xc7\x1f\xff\xf1g\x99\xddVe\xcfE\xbb\xf8\xfd\xff\xfeY\xaae\x9fbe/\x9f\xfb\x87ϐ\xaa1\x8cP\x18\xe9\x021R\xe6G\xd9%i\x1f)$c\x85i\\\xe5]\xd0\u008eӈv\xb4N?\xe9p\xdd\xc4\xf5\xc8\xcc\xe2\xe4FNLf\x18b2,\xf2q\xbb\r\x06C\x80\xbe\x81\xa0~\xc3\xebߍ\x90ʑR6\x8c1C?\xca\b\x14\x81\xc0\x91\x16\xae\xb4\xa9yE\xa6\x83)JN\x19c4\xbd\xb0u^\xd3F)\x9bjy\x1a\xd9\xeb\xe4\x03\xf8\xa3\xcf\x04\xe9\xc6:\xd1\xe9\xe3\xe7[B\xbfwށ1FkVϞŶ\x1d\xb6\xed{\x05\xc2v8\xf1\xc8}|\xf9\xb7\xffOJ\xa2\xcf\x1b\xdfp-\xff\xe6'o\xa7Q\x93\xfc\xf4{n\xe3g~\xea\xb5\\\u007f\xdd\x0eL\x16\xf3\x13w\xbd\x86\x1f|\xfb5\xdct\xc3n\xfe\xd5\x0f\xde\xc4UW\xef\xe0\xd4\xe9U\xae\xb8|\x86z\xb5\x80\xab\x06\xfcĻ\xef`\xbc\xee\xb2S\vJ\xc9\x1a\x98<\x00K\x91W\xdc:KI\xb3\x14\xdf.Rr\xaa\x174\xa4\xda\xd1:\xab\xfd3\xf4\xe2\r\xf6U\xf6qQ\xed\x00\xab\xd1*I\x16\xe55')-z,\xb2\xc1\x12\x1bt\t\x91\b\xe6\x9ci\xf5O\x02\xe4\x8e\x1f\xf8\x15Z\xcbO\x91f\xfd@X\xe2\xda<\x1el\xb1\xb4ƀ%\x1c2\x9d\"\x87\xc0\xec\xa8\xcc2\xe5M#\x90\xf4\x06\xads8\x9f|a\x8b~\x8dJ0\x86XY\x80(</\xed\x8dO\x1f'[]\x1a\xbd\x17\xf6z\xa3y@\x9d\xa6,/\x9c\xa6\xdf\xed0\xb3\xe7\"*SS\x9c}\xe6Q\x9e\xf9\xc0/\xb0\xbbե0X\x03!y\xf5\r\xfbX[\xd9\xe0\xc3\u007f\xf8\x10`x\xe4\xf3O\xf0\xe4\x17\x9f煅\x15\x94P<{x\x89^\xbb\xc7\xe3\x8fϳ\xba\xb8\x06\xb1\xe4\xf3\x0f<ˠ\xd9\xe4\xe4\x99.O\xde\xfd9\xa62\x8b\x98,W\x97\x90\x8f\x87+a\x91\xe8\b\x04Լ\x06JX\xe7YG+\\a\xbd\xbfD\x98\xf4\xd8V\xdc\xc6卫\xd9\xd0\x1d\xda\xd1ƈfoҥOLDB\xcfD4d\x85\xdb+7\xb2\xdf\xdbf\xff\xa3\xfd\x90\x9b\xde\xf4S\xdc\xfb\xb1\xf7\xb1zf\x9e\x1d\x97\xdc\xf8\x11\x12sm\x1c\xf6\t\xf0\x87\xbcM~\xb6\x95\x10\x19eiQ\xb4\xca\b)\xa895<\n<\xdf>L\xaa\x13|\xbf\x88-]\xf4\xe6D\x13\xe0{%\xd2x@\xb2\xb1\x82\xf1\x82\xf3\x04w\xc9\xda\x12Vm\f\xe9\x05C\x82P\xe2\x17\n\b!贚\xe84\xe5\xca\xdbnG\xd9\x16\a\xff\xcb\xcfq\xe5|\x931)\xa9u\x12\x9eZ9\xc1\x13O\x9d\xc4\u007fj\x9e\x83\xc7O\xb1\xd6K8\xf1\xf1/\x12\xf570\xf5\x06\x85\xc0\xe5\xef\xfe\xd3_P\x99\x1bG#y\xf4\x8f>\xc3\xe3Ϝf\xe5\xd1\xc3\xd4\x17\xfb<\xf0\xe9\xfb\xd9\xd6V\x1ct\xfa\xb4\x8b{\x10Y\x8c\x9d,`B\x17\xcf)\x13%],a1^\x98\xd9\x1a\xa3\x06\xfaI\x87\xd5\xfeYR\x9dPv\xcbܴ\xed6\"\xa9Y\x0fW\xf1\x8cM\x8af\x95\x0e\xfd\xbc\u007f\x8e\x87͕\x85Wpu\xe9\x12\x9e\xed~\x85\xbbW\xff\xf4\xebw\f\xef\xf8\x81_\xe1ޏ\xbdo\b\xccO\xff\xbam\x97\xef\xea\xaf-\x1a\xa1,\xa1\x12\xf0\x9c\x00\f\xa4F\x03)%iS\xb0\xca\b\x04\xaeryE\xe1R\x1e_{\x8c^\x927\x8b|\xaf\x9c\x0f\xc7\xe8\f5\xa4\xd1\n~\x998\xea\x91\xf47 (\x9e#\xba3\xa4\xeb\xab\xd8c\x13(\xd7#\n\a\x14+ռ\x9e\x900\xe8v\x98ٽ\x87x\xf9\x04a\xbfI\xeb\xa2]td\xcarg\x95\xd7|\xdf\xf5y o\x87\x8c\x95\x1c\x9e>\xba@}=\xa6\x1a\u0083\x8f\x1e\xe4\xf3\xbf\u007f\x0fW\x16\x1b\xdcs\xdf\xe3\xdc\u007f\xf7\xc3\x1c\x10E\xfc\x956v\xc9\xc5\r3J\t\x1c\xf1RVvބ\x11\n՝G\xc6]\x88\x02\\\xa7@\x18w\xa8\xf9\x13x\xb6\x8f\x142\xef\x99\xeb\x90\xe5\xee\x02a\xda'p|n\x99\xbb\x03m٬\x86+\x14\x8d\x8b\x1e\x82\xd13!\x81r\xd8\xe3n\xe3\xba\xf2eh\x1d\xf2\xb7\xcb\x1f\xe1\xd1\xf6\x83̸;\xbe> Ǟ\xfe\x1c\x00\xb7\xbe\xed\u07fd\xcbq˿\xaa\x93\x98AkYH\xa5\xd0aB\xd1-\xa31dF#I))\x9b\x82*焚\xb0\xd9\x1d\\\x04F\xf0D\xf3q\xa4\x90\x04^\x99Vg\x91\x8d\xce2J98v\x1e\f}\xb7H\x12vI\xfb\x1b\xe0\x17\xb6@\xd1\x19\xd9F\x13U\xa9!\x1d\x97,\xcb\b\x8a\x05\x1cǥ\xdf벶p\x9am\x97]͞\xdb\xdf\xc6\xf4\xb5\xdfI\xed\xa6;Q\a.%\xa6Șӣm\x0f\xb8\xe4\xb6k\xf9\xbf\xde\xfbC\xacښ\xc7VV\xf8\xa5\xf7\xff8\x87\x9e<N\xfb\xcc:o\xfc\x85\xef\xa5\xdd\x19\xb0\x12vY\xab:\xdc\xf1\xe3o\xe0\x81\x83\xcfqԃ\xb5\x9d\xd7c\x82:,\x9f\xc0\nO\xa2\xbb6\xd28ؖG\x9a\x854\x82)\x94\xb4\x10B\x91\x99\x94\xa5\xce<\xbdd\x03\xd7\xf6\xb8~\xfa5\x04n\x853\xd1YJ\xc6E\"X3]6h\xb3˟\xe1\xda\xc2+\xa9[%\xbe\xb0\xfe)>\xb9\xf61\xba\xd9\x06\xb7\x8d\xbd\x89KJ\xaf\xfe\xc7{\xea\xdf\xf1\xfd\xff\xe1R\xa5\x9c\xdf\x11BV\xb2$d\xd0\\\xc9g(☊S\xcdkM\x03Q\xb6\xc1\x84]\xc5S\xc1p\x1e\x02\xeaV\x9d\xfd\xe5\xcb8\xd2~\x96\xb3\xfd3\x14\xbc*\xad\xf6\x12i\x163\b\xdb\x04^\t\xa5l\xa4T\xf8^\x85\xa8\xd7$\xeb4\xc1\xf3G\x8d+\x93\xc4$\xeb+\xa8R\x15mYX\x96\x85\xe3\xbaض\xc3\xda\xd93\x9c~\xee\x19<[R\x9d\x9eEY6ՙ=\xb8\xdb/#\x9c\xbe\x9a\xa5\x956\x9f\xfe\xf0\x87xݝ7\xf1\xa6﹙\xfb\x1e9\xc4O\xff\xc4[\xd8s\xc5^>\xf8\xe7\x9f\xe7?\xfe\xfa]\\s\xeb\x15<\xbbp\x86Ko8\xc0Dc\x9cO}\xe9\x19\xfa\xbbo\x85\xa0\x8e\xe9m \x96\x8f\"\aMt\xe8\"\xa5\x8d\x14\x12Oy\x14\xdd\nRZ\b\f\xcb\xddSt\xa2\r,\xe9\xf0\x8a\xb1\xcb\xd9V\xd9AGw\xb03\xf0pX7]:\xb2\xc9\xf5嫸ܿ\x8c3\xe1I\xfe\xec\xec\ay\xbe\xff4\x93\xee6\xbeo\xfa\xc7\x10\xb2\xccS\xfd#/\x0e\xc8\x1do\u007f/Ǟ\xfe\x1c\xb7\u007f\xff/\xbbR\xa8_\x13\x88[\x84\x94D\xbd6Q{5?\xf36I(\xd9[C\x91\xda\f\xa8ZE<U\x18e\xe3%Ub\xcc\x1eg\xae\xb0\x83{\x97>\x8b\xad\\\f\x90$\x11`\xe8\x0f\xda\x14\x82\x1aR*\xa4\x90\x14\vu\x92\xa8O\xda^\xc5(;\x17\xe0\t\x01iB\xb2r\x16\xabP&\x95\n\xd7\xf7q\x1c\x87Lk\x06\xfd\x90SG\x0e\xd3<s\x8a\xda\xd4l~ʩeay\x01\x93W\xde̞\u05fd\x93\xdf\xff\xe0_\xf2\xbe_\xf8M^8r\x82\xc6\xf88O>y\x84\xf9\xf5\r^s\xebe,\xadlp\xe4\xf9\xd3<\xf3\xe4!\xfe\xf2SO\x92\xbc\xe2\xf5\x18\xe5 \xd2\x18\xe6\x9f\xc5\xee\x1f\xc1\flLj\xa1d~\xeaPŭ\xe1Z\x1eR(6\xc25\x9a\x83\x15\x8c\xd0̔v\xf0\xca\xf1\xab\x98t봢6\xca\xc0\x06]\x12\xa7\xc7w\x95\xef`BM\xf2P\xeb3\xfc\xd5҇@\xc0͵\xd7\xf1\xfa\x89w\xf0Bt\x86g\x06ϓ\xa1_\x1c\x90ݗ\xdeƱ\xa7?Ǟ\xcbn\xffn\xe0\xbd`\xf0Ju\u008dU\xc2\xf6\xda\xf0\xffN\xa0\xf12\x1bK\xe5:\xa7\fC\x92mP\xb7\x1bH!\x86?\x8aI{\x9a\x92]f)>\xcb\v\xad#T\x8a\x93\f\xc2\\4\xa6u\xc6 j\xe3\xfb\xe5\x91P\xb9\x18T\x90\x06\xe2\xd6\"&\n\xf3\x03\xf7\x87\a\x16'\xabK`\xdbh\xc7\xc3\xf6<\x1c\xd7#\x89\x06 \x15\xedf\x8b\x93\a\x9f\xa0\xb7t\x06\xdf\xf7\xf1\xcae\x84THe\xb3\xe3\xa6ײ\xf7\xce\x1f$\xa8T\xb8\xfb\xaf?\xc9\xe3O\x1e%\x03\xfe\xf8O>\xcbg\xbep\x94\xa6\x98\xc0\xec~\rr\xf7\r\f\xba\xed\\cv\xfa\x10\xaay\x18\x99\f\xc8\x06>\x18\x81\x926JJ*n\x1d%\x1d\xe2,d\xb9{\x9aDGT\xbc1n\x9cz5\x97W\xf6\xb3\x14\xaf\xb2\x16\xad\xd3\x15\x1d\xc6\xfd2w\x94\ue917\xb6\xf9ە\x8f\xf0`\xf3\x93l\xf7\xf7\xf2\x96\xa9\u007fŎ¥<\xd2}\x92\x13\xf1\x02\nI\xa7\xbf~! w\xbc\xfd\xbd|\xe6O\u007f\x91;\xde\xfe\xde\x06p7\xc6\x14전\xed\x15\xd0\xe9\x80\xceʙ\\\x81!\x15\xe9`@\xc1+\x0f\xebRE3ݠ\xa4\x1c\nV\x89n\xd6\xc6\x00S\xce\f\x81,Ps\xeb<\xb6\xf1%\xc2(\x1a\xd2\xe3ɰ{\x96\x11\xc5\x03|wS\xa9!\xf0\xdc\"\xaeS \xee5ɺ\xad\\\xa98l>\xa5\xad\xf5\xfcT\x85\xa0\x98\x9fL'\x04I\x9c\x9fu\x92\xa5)+\xcf\x1e\xe4\xf4\x97\x1ed\xfd\xe8s؞Gyv\x0e\x9d\xa4\b\xa5h쿆\x9d7\xbd\x91\xc9+n\xa5\xb8\xeb\x1a&\xae\xb8\x8d\xe9\xeb^\xcf\xd8%7S\x9e\xd9I\x1aǬ\x1f9\x84<\xfb\x14l4\x11\xa6\a\x03\x83\x89\xdd|HTH<ˣ\xe4ՑB\xb2\xd4=E?\xed\xe2Z\x01\xaf\x9e\xba\x91W\x8f\xbf\x8a\xa5d\x9d\xe7:/\x10Y\x1d\xae(^\xc1e\xfe\x95\x1c\xee=\xc9_,}\x88\x13\xe1an\xaa}\x17o\x9e\xfe\x97h\x1c\x1e\xea<J+m\xa3\x8c`\xa5u\x8a\xc5d\xfd\u0081\x9d\xcd@\xbe\xfb\xd2\xdb\xde\a\xdc&\x94E\xa1>\x85\xc8\"\xdcB\x85\xde\xea\x02Y\x1a\xe7\xb3\x0faHѩ\xe4\x025i\xff\xbf}\x9di\x90e\xe5}\xde\u007f\xef\xfb\x9e\xf5\x9e\xbb\xf7\xbd\xbd/\xc3\xec\v\xcc\bf\x04A\x80\f\x02Y Y\xb2*\x8em\x95\x15ɒ\\\x8e\x13۩ıKI\xe4T\x14U\\v\x12'\xa9\xb2\\e\x97>\xb8d\xc9*;\xb1@\x96J\x80-V1\b\x04\b\x86\x81Y`V\x9a\xee\x9e^n\xf7\xdd׳\xbe\xf9p\x9a\x06,)ߺ\xfaӹ\xe7=\xef\xf6\xff?\xcf\xef\x01\xe1\xb288\xcfV4\xa2\xe1_e\xd6\xddE/\xe92\xe7,Pu\xc6i\xc6m.t^F\xc7\xea]\xa5\xeb(\x0e\b\xa2tp\x85H\x1f\xc94l2n\x11?\xe8\x11\x0fR\x03\x90\xce\xe4A'\x84\x8d\x1aa\xa7\x8d\xcc\x15A\xa6\xf6k\x018\x9e\x87\x99-0\\}\x93\xc6\xe5\v\\y\xea1\xb6.\x9eg\xfa\xe8M\x98\xae\x8b\x8ec\xa4a\x12ǂ\xc0O\x90\x96\x9b\xfa=\x10\xf4;m\x16\x1f\xfe\x1a\xfa\xca\xd3$\x91M\xac\n\x98\xc1\x12\xc9\xc0\x06-w\xca!Ew\x8c\x8c\x99\xa55ܢ\xe5o!\xa5\xc1\x1d\x93\xef\xe7\x9e黨GmNv\u007f\xc4Hֹw\xec#,\xe4\x17xr\xeb!\xbeS\xfb\x1a\x9a\x84\x9f\x9f\xfa4\xf7L\u007f\x8cڰ\xc5\xc9\xf6\v\xf8I\xea\x81\\j\xbdAC\xc5h7\x13\xfe\xb4=d/\U0003f06c\xed\x15\xb02Y,\xdb\xc5v]F\x9d\x06\x83vc\xa7\x15jca(\x13CH2\xca&¦\x15.S4\x8bLٳt\xe3\x0e\x9e\x99cfb\x8e\xbd\xe6~^\xe9\x9fbk\xb8\x81x\x87\xce[ \b#\x9f\xfe(m\xee\x88\xed\u009bR&\x9e[$\x8eC\xc2A\v\xe1\x8f\xd2\xfb\x8a4\x88{m\xfc\x8d\xd5\xd4/o\xd9 %\xd9\\\x9eC'n\xa1z\xe0\b\x83f\x83\xa0Ӣ\xb1\xb4\xc8\xf9\xef\xfe_\x06[\xab\xe88\xc2\xef6H\"\x9fp\xd8eԪ\xd3x\xfdG,?\xf5\x00\x8b\x0f\xfd\x05A\xabMlM\xa3\x85\x89$@\x85k$\xc3\xccηcHŘ7I\x92\xc4\xd4\x06\xd7\x10\xc0\xfb&o\xe3C3\x1f\xa4\x93\xf4x\xa2\xfd,\xd2\xf0\xf9\xf4u\x9f\xa3\\,\xf2\xad7\xbe\xc1㵿c\xcc\x1c\xe7\x97w\xfd:\xf7\xec\xfe\b\xaf\xd5/\xf1d\xe3\x87$\xc4\x04q\xc8b\xefM\xba\xa6@\xa4\x86\xd3\xe8'-Y\x02\xf8\xb7\xc0\xbdR*\x91\xab\xce\xe1f\vTƊ\x14\x8by:\x8d-Z\x1b\xcb逐`$r\xa7\x11S5\xb2(i!d\x89 \xaa3iO\xa3\x84\xc10\x1e0nO07?C\xb6?Ʃ\xdes\xf8\xe1\b\xa1\xe5?R\x89G\xf4\x86-\x06\xa3\x16\xa3\xa0G\x18\xfb(\xa5\xc8ze\f\xc3 \b\xeb\xe07SQ\xb7\xe5\x91\x04~\xba\xaf\xf8C\xa4\x93!ܖ\xec\xcc\xec\xdd\xcf\xf4\r7\xe2\x14\n\x8cZ-F\xdd.[\x97/q\xf5\xe9G\xb9\xfc\u0603\\y\xea{\\;\xf5,+?|\x9c\xcd\xf3\xaf\xd2o\x8dH\x8c2(w\xbb\x98$\x90q\x1f\x157\xd3\x01\xd9.\x92ۆG\xd1\x19\xa39\xac\xd1\x0f;\xdc>y\a\xf7\xce}\x88\x8d\xd1\x16\x8fvN\xe2ڒ\xdf<\xfe[\xe4\xb3Y\xfe\xec\xd4\x1f\xf3\x83\xda߳\xd7;\xc2o\xdf\xf8\x05\xde3u3O\xbf\xf9\x1cO\xd7_$!a\x10\x8dX\xf27\x18*\x90B`\xb8\x1e\xd6\xd8\xf8\xbb\xf7\x90{>\xf1\a\x00\xe3\xc0\u007fB3g\x17\xc6ȍMS*f\x18\xaf\x14\xf0\xb2\x1e\xfdv\x83\x8d7/m\xa3X\xd3\xd3V\xc6Ȧ\xadMi0c\x16\xe8'#:Q\x9f\x9c\xb2\xa8X\xe3\x98\xc2@\xfb0\x96\xab2U\x9c\xe4\xda\xe6\x16ע+ġ\xfeGE9\xb1\x8d\xe0K\x88b\x9fQУ?j\xe3\a}\x1c+K\xd6\x19Kɤ\xfd:*\xe8\x93\xd8\xee\xf6l\xe9\x1266\xd1\xca$\x92\x06^>O6_$75\xc3\xe4\x91cxc\x15\xa2\xc1\x90\xc8\x0f\x91\xa6\x8b\x94\x16:\x96hL\x90\xf6\xdb\x10\x9d\\\x01Y\x9dE$\x1a9\xdcB\xc6]\x92\xa1\xb3\xe3\xcd\xcf;E\x944\xd8쯲7\xbf\x8f_\xdc\xf3\xcfX\xed\xae\xf2\x9d\xf6w\x99\xf0*|\xfe\xf6\xcf\x13\x06\x11_z\xfa\xf78\xdbz\x81\xa3\xb9\x9b\xf9\xc2]\u007f\xc8tn\x8e\a\xcf=\xc2\xf3\xcd\xd3h4\xbdx\xc0RP#\x14)\xc9\xcb\x1e\x9bĝ\x9aE\xb9ٷ\adfa\x96W\x9e\xf96\xbb\xaf\xbf\xeb0\x88\xcfk\xb4\xe5dr\xe4\xcac\x8c\x97\xb3X\xd6\xdbF\xfe\xa5\vg\x89\xa30\x8d\xaa\x8b|<\x95E\nI\xac59eS1\xb2lD\x1d:Q\x9d]\xee\x02\xb6t\x88\x88\xb0\x86\x0e\x93\xbb&\xa9\xe8\nW:+\xb4X!\x0e~Z9M\xbc\xe5('\x8a}\xfa\xa3\x16A\xe8\xe3\x98\x1e\n\x8bp\xd0O5\xb6\xa6L\xf9^qL\xdc\xd8$\x18\xf4\xa97\x9b8^\x16;\x93&\xf5\x94\xe6w3\u007f\xf3\xedL\xdf\xf8^r\x93\xd3\xe4'\xa7ɎOR\x9a[\xc0\x9bY\xa0z\xfd\x8d\xe4\xf6\x1d\xa1\x87B\x9af\xca\x1c\uef09L\x86\xe9\x1e\"4\x864\xc9ZE\xfcx\x80\xd6\x11\x9f\xd9\xff\x19\xb4\xd6<\xb0\xf6\x003\xc5\x05\xbe\xf4\x81\xffBk\xd0\xe4\x0fN\xfe{\xaev\xcfqS\xf16\xfe\xdd\xfb\xbeH%W\xe5o\x9f\xff\x0e/t^I%\xb3Q\x8fE\u007f\x03-\xd2\xcf\xcf\x19\x9fƩN\xbe\xc57\xd6\n`v\xcf\x02\xd7\xdeXfמ\x03&J~\xde\xcdN\xdc.\xa5\xa4<\xbd\x87lΣXHK\x04\xa0q\xb29\x96_?˰\xd7\xd96\x93*\xc2A\x0f\xcf.\x10\xea\x18G\x9a\xb8\xd2$o\xe4Y\xf4\xd7\xe8D[\x8c\x9bc\x98\xcaFh\x81ٳXؽ\xc0u\xeenVZ\x1bl\xb1B\x1c$\xdb6ȟ(%y\xbb\xe4\x9e\x04\xf8\xe1\x10)\x8dmҏ\x86^\x17\x11\ah\xc3\x02e\xa0{m\xc2f\x9d\xda\xca2\x9dV\x8b \b\x89\xe3T\x06d{Y\n3\xf3T\xf6\x1db\xfc\xe0Q\n\xbb\xf6 2Y\xec|\x810\x8e\xe9\xb5\xdb\xc8D\xa3;[\xa8\xee%\x18\tt\x90\x1e\xebMe\x93\xb3\vt\xfd\x16\xf7\xcd~\x88\x03\xc5\x03<\xb6\xfa\x18v\xd6\xe3K\x1f\xfa\"\xbdQ\x97\xff~\xf2?s\xb6\xf5\x02'\xf2\xef\xe7_\xbc\xe7w\x99\x9b\x9a察\xfb\x16/t^A\nE=lq-\xdcJ\xf7He`\x8fOa\x95+\b)Q\xb6\x83r3\xa8\xe9]\xd3\\{c\x85\xfd7\xddR춖\xbe\x99\xc9O}2[\\\xc0v3\xe4\xc6gp\\\x9b\xack\xef\xb4aM\xd3b4\x1c\xb0z\xe5\x02B\xa6\xa9\x03\x81\xf6\xb1b\x85\xa9l|\x1dR4<\x1ca\x90Qy\xae\x85-\xd6G\x8b\x94\x8c\x1c\x8er\xd3T\x82\xbe\xc1\xc2\xcc,\xfb\v\x87x\xb3\xbeFC\xae\x11\xf9\xf1O\x1f\x94w8\xa6\xd8\xd6\xc9\xeaw\x06\x8b\x8cFȸ\x83\xb2\x04\x890\x10IL\xd2k3\xa8\xad\xd3\\[\xa1\xbe\xbaJcc\x83\xc6f\x8d\xda\xf2\x12\xf5k+4\xd6Wi\xac\xad\xd2k5immѩ\xa78'1\xecµ\x17\x90~\x8b\xa4\x97\xd9v\xd5\n\x1c#\x83\xa9l\x0e\xe4\xf7p\xf7\xcc=\x9ck\x9e\xe3\x95\xee\x19\xfe\xf0\xe3\u007f\x84\x18)\xfe\xe8\xfb\xbf\xcf\xf3\xf5'yO\xeeV>u\xfd\xbf\xe4\xe8\xc1\xa3<\xf0\xa3\ayv\xf3%\x84\x90l\x06M6\xa2ֶ\x84\xcaĮNc\x95\xabH\xcbBZ6\xc201s\x85Hu[]fw\xcf\xcf\xf6۫\x8f:N\xf5\xf6\xea\xcc-\x18v\x86\\i\x1c+[¶L\xbc\x8c\xc5\xdb)\x12\x9a\xf2\xe4\fWϽL8\x1a\"Ҷ.\x83A\x93\x9cU$\"Ah\xc8\x1a69i\xd3\xd51#\fVF\x97Y\xb0\xe7HЌ\xc2\x01\xb2o2;?ͱ\xf1\xa3\\\xad]\xa3e\xad\x13\xfbɶi\xf3\xff\xab/\xf9\xf1\xff(\x894!\xae\xf7\x90\xbd^z\xcb&B\x88\b\x1dFD\x83>\xa3\xc6&í\x1a\xa3\xc1\x80\xc1(\xa0\xdf\xe9\xd0\xefv\x19\x0e\x86\xf8\xfe(\x8d\xadh\xad\xc0\x95Ǡ\xdf\"\xeez\xb0-9\x90Rb\x1b\x19\xae/\x1d\xe4\xa3\xf3?\xc7\xea`\x95\xaf_\xfa:\xff\xf1\xc3_`ڜ\xe1\u007f\x9c\xfc\"\xcf\xd6\x1f\xe5:\xf7\x00\xbfz䷸\xe3\xa6;x앓<\xf8\xc6c \xa0\x1e\xb6X\x8b\x1a\xe9\xe0*\x13wj\x1e\xabTAY6R\x99)\x19\xaf2E\xa6:\x19\xaa\xdd7\x1c\xb5G\xbd\xad/\xcf\x1e\xfc\xc0\a=k\x01-br\xd5\x19\xf2\xc5\x12\xcaɑ\u0379d,\xf5\x16}\tИ\xa6\x85W\x1cc\xf1\xccK;*B\x94 \xf1G8\xa6\xc7P\x87\xb8\xd2\xc2\x12\x8a\xact\xa8\xc7\x03F:a\x18\xd5ٗ9\xc0@\xf7\x89\x93\b\xdd\x12T\xabUn\xdb{+\xae\x1e\xa3%W\xe9\xfb\xddT\x90\xb0C \xfd\xc9Z\x19)S\x0e\xbc@\xa43&L-\x03iam\x84\xe8v\x11\x9d\x06*j!\xa2>$\x11\"\xf6!\x1e!H \x0e!\x1c\xa2\a\r\xd8x\r\xae>\x83^:E<tI\xfa\x19\xc4;\x10\x80\xa64\xb9u\xe2V><w\x1f\xab\x83U\xbe\xfa\xdaW\xf9\xf0я\xf0\xb1\x03\x1f\xe7/\x9e\xfds\xbe\xb7q?9\xa3\xc0g\x0e\xfck>x\xfc^^\xbct\x9a\xaf\x9d\xf9&Zj\xdaQ\x9f\xe5p3=\xae(\x83\xcc\xcc.\xacRe;;\xc5\xc0\xc8\xe6q'\xe6p\n%\x84\x10\x91\x11\a#W\xc3{\xab\xbbo\xa1\xbf\xb2Fc\xfd\x1c\x13\xeaX\xea\xff\x97i\xe8\xd6;\x97\xf3\xd43\x1f3\xb5\xb0\x97\xea\xdcul\xae,\xa6X\"\xc3`\x10\xf6\xc9\xc4yLeS\x8bz\x98f\x1eOYL[\x05V\x02\xb84Z\xe1x\xb8E\xce,Ҍ\xebH\xa1\x90\x8b\x12۵\xf9\xa5\xdd?\xcf]swp\xb6w\x9a\xd7\x1bgx}\xfdu.\xae]\xa4?\x1ab\n{G\x9f!\x84\xa4\x98\xa9\x90sJ\x1c\xcf\x1fcjl\x9c\xcb[\x97\xf8\xd1\U0008bb0d\xd6p\rg\x87 \xa75D\x8d\x00Ѭ!\x8c50\x01\x19\x93(\r\xd2\x00-\xd1a\fq\x84NL\xb4.B\x9c \b\xb7\xebs1Y#\xc7\xcf\xce~\x88;\xa6ng\xa9\xb7\xc47.~\x83\x99\xca\f\x9f\xba\xf1Wy\xf0\xc5o\xf3\xd0\xea\xdf\x10&>\xf7\xcd}\x96\xfbn\xfc(\xaf-_\xe4\xebg\x1e \x91\t\x83xĵ`k;\xabW\xe1L\xceb\x15\xc7R\x06\xa5ec\x97'\xb0Kc(\xc3\xdc\xd1\x10\x18\xcarC\x01+:\x89\x0fL\x1c\xba\x99\xf5K\xcfЩ/R(W\xd0I\xcc0H\xc8{o/\x14\xe9\x83j\f\xd3\xe0\xe0-?Css};jH\xa0m\x83Aئ\xa0\xc6\xe9'>ka\x979\xabȸ\x91\xa3\x1e\xf5I\xac\x19\x9ei\xff\x80_\x9a\xf8$ͨA\xa8\t\xa3\x00\x00\v\xebIDATN=\xda\xc4\xd7#ʺJ\xb0\x12`Z\x06'\xcc[\xb8i\xfc\x04\xd1|@\xcbl\xf1w\x17\xbeɓ\xe7\x9f\xc0\x93%\xd0\x06\x89\x86\xeb\v7\xf0\xa9\xfd\x9f`\xff\xa1\xbd\xe4\xcbyF\xa3!\x17_\xbd̓+\x8f\xf3\x97\xe7\xbfJ\x94D\x98۵\xb1T\xf9\xaeбJ1\xc4o\xc9_v\x9a\x97\x06`o\x8b5R+\u007f\x92$؆ͱ\xf2Q\ue73e\x8b\xeb\xf2\xd7q\xba~\x9a\xfb\xaf\xdcO.\x93\xe3wn\xff=\xae\\~\x83\xaf-~\x99v\xdc\xe0\x86\xec{\xf9\x95\x13\xbf\xc6f{\x8b\a\xce>D?\x1e\x10\xeb\x845\u007f3\xed\x95\v\x81]\x99\xc4.U\xb6\a\xc3!3\xbd\x80\xe5eߞ\xd5o)5\xe7\x8f~|p\xfe\x89?y\xec\xcd\xd3\u07fd\xfb\x03\x9f\xfe2\xc5\xf1]\xd4W/2>{\x18#3d0t\xf0=\x83\x8cc\xechY\xdfb\x96M^\xb7\x8f\xca\xecu\xd4\x16/n\xf7\xca\x1d:\xe1\x16n\x9c\xc7T\x0e\xeddH&2\x990\xb3\xccZE\xde\xf0\x1bԣ\x90a<@\tE\xa2\x13zq\x87n\xdc&#=*\xc9\x04\x99 \x9b\xf6\xeb[\x82\x82Q\xe67\x0f\xfd\x0e\x1f?\xf0\tJN\x91\xaaWŔ\x06\x19\xcfE\x98\xdb\xdd|\x01\x19\xcf\xe3ȍ\x87\x99\xccMrW\xf9n\xfe\xfa\x8do\xf0\xe8\xea#\xf0\xd3\xe4\\Z\xbc\xfb\xe6#\x04RH\x8av\x91\xfd\xf9\xfd\x1c*\x1fb>;\x8f\xab\\\x86ѐ\x87\x97\x1e\xe6\xf1\x95Ǚ)\xce\xf2\x85\xbb~\x1f\xd11\xf8\xd3K\xff\x95FX#\xa7\x8a||\xfeS\x04\xa3\x80\xef\x9f{\x96\xe5\xc1Z\xea\xc5\x1cl\xd0'B\x02f\xbe\x88U\xae\"\f\x13\xf9ֲ\xe5\xe5\xde>@\xbe\xe39\xd5♧(OM\xe5ڵ+\xff\xb4:{\xd8\xdcw\xecN.<\xf7 F\xa6D6W\x02\xc3!A\x91q\x8d4/\x10\xb1\x83 6M\x8bD\xb9\xac_=\xbfC\xa8\x96\x86Kw\xb8H\xd6\x18\xc3\x10\x06\x838@!)\x1b\x19\x12\xad\x19!1I\x98\xb7\xe7\xf0\xb5\xbf\xf3R4\x9aa2\xa4\x9ftPBa\b\x03\x9d$\xf8\x9d!\xd9\u0603\r\x8d\xe38\x98\x96A\xbf\xd9O\x15\xef\x12\xc2aHc\xb9I\xebZ\x93\xceV\aKZ\xdc6~;\xef\x1b\u007f\x1f\xf5h\x8bA\xdc'\xd61Q\x12\x11\xeb\x98D'(\xa1(\xd9%\xe6\xbc9\x0e\x96\x0er\xc7\xd4\x1d\xdc7\u007f\x1f\x1f\x9e\xbb\x8f\xc3\xe5#\xe4\xcc\x1cݰ\xcb\xf9\xe6y\xee\xbfz?/m\xbdȭ\xd3\xef\xe3\xf3\xb7\xfd\al\\\xfe\xf2\xf5\xafp\xa6\xfbC\x940\xb8\xad|/\x87\xbd\xf7P\xabm\xf1R\xf7\f\xad\xb8C\x10\xf9,\a\x9b)Xǲq\xa7\xe6Qn\x9at\xedN-`\xe7K;V9e\x98\x18FZ.\xb2\x9dLji\xb3\xb3\xe5'\x82a\xf7\xdc\v\x0f\xfe\xb7\x13?\xf7\xb9?en߭l-\xbd\x8aW\x9c\xa2lyt\xa4\x81R\x92J\xc1\xde\xd9\xdc\x134Jk\xc6\xe6\xf62q\xf0fVN?\x89TfJ\xa0v\x8aԆ\x17\x98\xca\x1cA\vh\xc4\x03\xa4\x10\xccXE@\xb0\x1c4y\xaf\xb0w\x88ko\xc9A\xb5H\x88t\n\xe5\x0f\bPB\"\xb4 \xe8\x06\x18\xd2dkqkG\a\xe6\xe4m\xa4R\x84\xa3\x90p\x14\xee\xe8{5\x9aA4`ҙ\xe2\xd7\xf7\xfd\x06'\x9bO\xb21ܠ\x1bt\xf0\xa3\x10\x1b\x9bYo\x96\xf9\xec<\x15\xbbB\xc6\xc8P\x0f\xea\xac\xf4\x969\xdf<Gm\xb8I}T\xa7>\xaaS\x1bn\xe29\x19\xee\x9c\u007f?\xff|\xf7\xaf\x911<\xfe\xe1\xf2\xf7x\xa9\xfd\x14RH*\xd64\xef-܁L$\x9d\xa4\xc7J\xb0\x8e\x12\x92ͨ\xb5\xd3`\xb3K\xe3\x18\x99\x94\xf0`\x15+8\xc5r\xfaaK\x89\x9bͥ6m\xc0\xb4ll7\x93\xea\xb2.\xbf\xfc\x92\xbf\xb0o\xf7\xff\x1c\xf6\xeb\xff\xe7ٿ\xff\x13n\xb9\xfb\xb7\xa9\u007f\xfb*\x1b\x8b/\xa2\x94Eaڤ\xd5\x01%\x05\x85\xac\xb53G\x12\xad\x91\"a\xf2ȭ\xb4\u05eeҫ-#\xa4B\xa9\f\xbeѧ\x1d\xacR\xb0f\btD+\x1a\xa6\xa5\x15+\xcf\xf9\xe1\x06\xf5\xa8\x87\xa7\xb2\xf4\xe2ζz/&Nbl\xe9\xbc\v\fފ\x9bD:$\xa3=\n\xaa\xbc-\xf5\x17\xf8\xbd\xe0]u\xb0\x1fs\xbd&\x01\x9e\xf4x\u007f\xe9.\x82|\xc0H\x0f\x19%Cb\x1dӋ{\f\x92\x01\xaftO\xf1\xf4\xdaSlu\xdbDI\xb4\x13[a*\x9bR\xa6\xcc?\x19\xbf\x1d\xa9\xfa\xdc[\xfd(\xf9L\x9e\xa5\xcd%\xbe_\xfb\a\x86I\x0f\x85\xe4\x86\xfc\xedT\xac*\x06\x8a\x8b\xa3\xab\xc4:&ԚN2J\xaf\x03\xb6\x8bY(!\x94B\xda\x0eneb\x1bG\x05^\xae\x90\x02\xa3\x11)\x0ew\xdbz\xa7\x00\x0e\x1c\xbb\x89+\xe7ϟ+U\xab\xb7u\xb6\x96\xf7\xcc\xef?J\xb9\xb2\x8b\x95\xf3\xaf\x90\xc8\bC:X^\x0e?N\xcf䦡\xd2{\x89\xd6t\x06\x11A\x90`{9\x9a+\x17w6(\xa9,z\xfe:\xae̡\xa4M\"R'\x93#M\x04\x9a^\x12r\xc8\xddO?\xee\xbcK\xf1'\x91\xe4T\x01%\f:I\x8bQ2@\xa3\tt@L\x94\xaa\xe7\xb7!\xc8\x1aM\xa8\x03||\"\"\xfcdD\xa2\x13\xa4P\x04\xda'H\x02B\x02\"\x1dю\xdaԂ\r\xae\xf9+\xac\a\xeb\xd4F\x9b<\xb6\xf4\x18[\xbd\x16\x96\xe5R\xceM\xb2\xa7\xb2\x8fCc\x87\xd9;\xb6\x9f\x83c\aHD\x8f]\xee\x1eN\x14n%\x161\xdf[}\x84\xe7[\x8f \xd1\xccd\x0eqO\xe5c\xe4U\x1a\f\xf0l\xe7%\"b\xb6\xa26\xbd$\xe5WZ\xc51\xacRz\x13\xb7KU\x9cR\x8ag\xcax9\\/\xed\xff\x98\xb6\x8de;(%QJ%\x06@s\xf3*\x00\xd3{n\xfe\x85\xa5\xd7N>\xff\xc4\x03\u007f|\xe8\x17~\xe3\u007f\x91\x04w\xf2\xcas\xdfK{\x14R\x92\x9bX\xa0\x9eh\xfc\xc0Ʊ\x14Q\xac\xe9\x0e\x024\x02+[\xa08\xbd\x9b\xe6\xf2\xa5mh\x83\x85a\x97Y\x1d\x9cc\xd2=H\xc6(3LB\xdaш\x82tY\r\xaeщ\xfb\x94\x8d\n\xb5p\x1d\xb9M\x99Ϋ\x02\xa60I\x88\x19%\x83\x9d\xb9\"\x10\xf4\xe3\x1e\x83\xa4\x87@b\t\v\x81`\x94\fS\xe5\x87\x104\xfc&q\x9cpC\xe1\x18I\x94\xb0\xe8_\xa6\x1b\xa7\xe0\x97v\xd4N\xff\xd6`\b\x83\xb5\xde\x1b\xf4F=\xca\xf9\x19\x0e\x8e\x1f\xa6bxi\xa7R'd\f\x9b8\x19\xd0\x0e\x9b|z\xf2_!\xa4\xe0b\xfb2\xcfl>\t\"\xc23*\x1c/\xdcI\xd5H\xfd\x91ݸO'\xe9\x91hM'\x1el_V\r\x8c\\a'~\xdc.\x94\xb7!\xcf\x0en6\x97&\xc4\x19\x06\x8e\x9b\xc1q,\fC\"\x84H\xa3\aj\xab-\x0e\xdcx\x9c\x17\x1e\xfdV\xd7\xc9\xe4?\x19\x87\xc1귾\xf2\xbbL\xee\x9d\xe1\xc8\xf1;\xa9\xbfq\x8e\xfa\xca9\xdak\x8b\x04\x83\x0e\xadV\x87Z\xa3O\xb3\x1b\x10\xc7۴ke\xe0M,`\xe5J;G\a\xd3\xc8a\xba\xe3,\xf5^\xa0\xed\xaf\x10\xe8\x98v<\xc2\xd71\x9e4x\xae\xfb2y\xa3\x84\xab2H\xa1\xa8\x9a\x93\xe4U\x89d;\xcf \x15\xa1\xb1㱀\x94\x18d\b\x93f\xd8⁍\xbfJ\x95\x80I@\xa0\x03\xd6\xc2%\xee_}\x80\xa6ߠ`\x16\x197'\xb1\x84\x95n\xe4(\f\f\x14\x8a^<\xe4R\xf3\n\x86\xb2yO\xf5z\xaa\x86\x97\x02btBB\x82\xa7\\\xceuO\xf1\xcb\x13\x9fÒ\x062\x91\x9cm\x9f\xa5\x19\xaf`\xe0\xb0\xcb;FŬ\xa2Dz9]\vj\xa9.+\x19\xe1'\xe9~&m\a\xc3K_\xbca\xbb\x98\xae\x87\x10\x92L>\x8d*\x17J\x91\xf1<\n\x05\x8fL&\rI3M\xf3\xedjo}==\xae\xb5\xea\xcd\xf5ɹ\xb9\x8dѠ{߅\xd3O\x98w|\xf4\xb3\xf4[}\xeaK\x17\t\xa2\x0eB\x98(\xd3Ao\xdb|\xe56\xa6)I4~\xbf\x95^\x10\xeb\xeb\uf40d:(å\x1e]%C\x16Se\buL^9,\xfak\xe4U\x8e}\xcenr\xaa\x84-\x1c\x86ɀ\xadp\x93\xbc\xcac\b\x93\x80яy\xf1l\x99\xf6\xb8\u007f\xd0\xf9!\xd3\xce\f\x99m!\xf4\x95\xc1\x15\xdeL\xb6xd\xf1a\xc6\xcc2\xf3\xde.\f\xa1\xd8\fkD:\"\x161\xb5\xb0\xc6\xd3kO\xd1\x1f\f81}\x9c1\xa7\xbc\xa3@\x0ft\x8cg84\x835v\xb9\x878\x91\xbb9U\xd8\xeb\x80o_\xfb\x16M\xbdBޘ`\xc1;¬=MNy\x18Bq~x\x89ͨI3\xea\xd1O\x86i\xedkl\x023W@\x9a6V\xae\x80U,\x93ɦ\x8d>!%nƥT*\xe08\x16\xb6mR,\xe4\x88\xe3(\xfe\xb1\x06ա\x1b\x8fq\xf1\xcc\xf93թ\xc9~\x10\f\u007ff\xf1\xe2\xf3\xe6-\xf7\xfe\n\xa3^\xc0\xd6\xe2\x05\x86\xfd\x1aBH\xecL!\r\xf4ږxJ\xa5\b\x06]\xe2`Ȱ\xb1\xf6\x0eO\x87\xc00]\xec\xf1)6\x9a/\x93\x13%l\x95R\xa3\xb3\xd2\xe6\xd5\xc1\x15\xe6\xaci\n*U\xb0\xac\x04\xeb<\xdcx\x88\xac\xca`J\x13S\xd88\xd2A\x89\xb4\xd5jJ\vOfY\nVy\xa1\xf7\nϴ\xbeϩ\xeeK,\xfa\xab,\xf9˘f\x89\x99\xcc\x14\u07fc\xfc7\x9cj\xfc\b-5\x83\xb8Ϻ\xbf\xc1\xe9\xc6)N\xaf\x9f&\x1e\tv\x97w\xb3\xabx]j\xab\xd31\x9d\xc4'\x14\tcʣ\x1b\xb7\xb8\xbbx\x1fY#\x87\x14\x8a\xd7\xfa\x17xb\xf3!\xb4\x16T\xdd\xdd̸\xf3\x14\x8d\x02\x960\xd0h^\xee\xbf\xc6 \x19R\v[\x84:BJ\x13{|\x1ae;H\xcb\xc4\x19\x9b\xc4\xde\xde7\x94\x91\x86\f\x14\x8bE\xb2\xd9t\xb9\x1a+\xe7\xc9\xe73\x14\x8b9\xa9~\xdcn\xe03\xbf\xf7\x10\x97Ͻ\xf6\xdc\xc4\xcc\xcck\xdd\xf6\xe6\xcf._z\xde\xdd{\xe2\x83\x14&\x0eS\xbb\xf8*\xc3\xf6:\xa3a\x03\xd3\xce\"\x95\x91&b\xc6!\xe1\xb0Ͱ\xb5\x99\x92\xe04\b\xa1\x88\xa2\x0e\xa3Q\r\xcb\xccQ\xb9\xf5>\xae\x9e}\x80\x8c1\x81-]<i\xa1\x89\xb9\x1a\xac2oO!М\xec\xbe\xc8b\xb0\xc6\xd9\xfe\xeb\\\xf5W\xb8\xea/3\xd2>U\xa3Dɬ\x12\xe9\x90\xe7\xda\xcf\xf0l\xefUƬ*7d\x8f\x915\xc6\x18\xc6=\xb4\xb4ɫ\x1c\xc7\xf3\a\xc8:\x1e\xe7\x9b\x178U{\x89\v\xcdK\\l\\\xa0\xdd\xef2fU\xd8W\xd9\xcfBy7\xa64\x19\x11\xb1\x11w\xe9逼rqDB\xc5\x1c\xe7X\xee\x04\x12A\xa4C\x1e\xd9|\x9c+\x83\xb3X\xa2@%\xb3\xc0\xb8=\x8e-,,aҊ:\\\x1a-\xe2뀍\xb0\x91f\x859\x19\xec\xcaDZ<4-\xdc\xea\x14n6\x87e\xa7'H\xc7u\xc9\xe52\x98\x96\xa2:VĶ\xcd\x1d\x0e\xf5O\xacݍO\x179x\xfc\x83\x9c\xfc\xee7Y8\xb0\xe7\x96`\xd8\xfd[\xa9\xcc\xf9=\xc7\u007f1)T\x0e\ao>\xff0I\x1c\x90\x18\xbe*N\x1d0\v\x13\xfb1LWwkKIg\xedJ\x14\xf4\xbb:\x8a\xfbj\xe4o)C\xb9\x81mW\x90Z\x90;|\x13\xbe3\x90\x1b\x8f\xdfo-\x14n\xf3Ma\xea\xact\x18$>\xa6\xb4Q\b41\xbb\xec2~\x123H|z\xb1O?\t\xb0\x84A\x12w\x18&C\x12\xe9\x91UY\x8e{\xbb\x89t\xc4 \x1e\xbe\x1d\x91\xb1S\x90Դ\x82\x1e\x9b\xa3\xc6Ώ\x94\xd2\xc053X*\xf5\x84t\xe2!\x9dxۭ\xab5\a\xdd){u\xf0\xba\xf8\xec\xf4\xbf\xa1h\x14\xd0hփ\x9a\xfe\xb3ů\xc4+\xe19\xe5\x04E=Qڛ\xec\xf1\xf6\xe8\x9c\xf2\xa8\x18%ٌ\xdb\xe2\xcc\xe0\xa2lE=\xae\x8eV\x91\bm\x16\xabafn\x97i\xb8\x1eF&\x9b\x14v\xed\x0f\x8bcU\xa4JY\xe9屲Ḷ,\x17\xf3rb\xa2\xfc\xaew\xff\xff\x00\xdfQI\xb8#\xad\xda%\x00\x00\x00\x00IEND\xaeB`\x82")
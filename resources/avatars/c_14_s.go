@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_14_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14$\x1e6.%r\x00\x00 \x00IDATx\xda\xec\xbdg\x98\xadYU\xef\xfb\x9bs\xbei\xe5U\xb9j\xe7\xdca\xd7\xee\xb4;\xd1\xddt\x80\x06\xe9\x86\x16P@Q\x04\f\xe7\x80\xe1\xe8U\x04\x1f\x1f\x8f^=\a\xf5\xa8\xc8=W|䊂\xe1\x1c\x90 \u0081&؊44\xa97\x1dv\x0e\xbds\xaa\xaa]y\xe5\xf5\xa69\xef\x87\xf9\xaeZ\xb5\x9bn\x92\x8d\xf8\xc1\xf5<\xb5wU\xad\xf4\xae1\xe6\x18\xe3?\xfe#\x94\xf8\xf9\x1b+\xad\xff\xf2\xca\xff\xa4\xdaݮ97\xbb@\x18Ehc(\xe6\x02\xa4\x12,\xd6\x1aL-.\x11')RJ\x04\x86O=\xb1?Xj\x85\xd7\x1fjF{\xf9\x8f۳ޮ̹?5Rv\xfe\xf4uw\xbf@\x18\xa3/\xbbO\n\x81\xeb*\x06\n\x05*\xc5\x029\xd7\x01\b\x9d\x81\xca\xe6\\\x18\xc5b\xef\x89\xd3hmV\x9e\xd0\rcF\x06J\xc4i\x8a\x92\x92\xd0$\xa05\x17\x17\x17iu\xe3c\x87\x9a\xd1\xdeɢ\xc7\xc1f\xf4\x1f\x92\u007f\x96\x9b\xeb\xc8\u007f\x98Y\n\u007f\xfd\xc2\xfc\xf4\xb6\xf5#\x13hm\x952>Pe\xb8R\xa4\x10\x04`@\x1b\rB`\x8cA\xde99\x99\xcc.\xd5HR\x8d\x10b\xe5+՚F\xa7C\xaa\rI\x9ab\xb4!՚\xd9Z\x830N\xde\xf7\x1f\xe2\xfe\xe6\xb7ɢǁF\xb8\xec8꣏\x9d<\x83#\x15\xc6\x18|\xcf\xc1\xf7\x1c\xe6\xea\rNL\xcfplj\x9a\x13\x97.qaa\x81(Ip\xb6\x8e\xafI\xeb\xed\x8e;\xb3\xb4D\x18%\b\x01\x02A!\xef\x92h\r\xc6`\xb4\xc1\b\x88\xe3\x98Z\xbbCj\xcc?\x03\xffa\x1d\xdf\xc6MI\xf1\x9e\xa9\xc5\xf6\xaf\xb5\xbaM|/Gj\f\x17\x17\x97\xbe\xe1q\r\xba\xcc\xd6\x1aH\x80\x9c\xe7\xb1qt\x84\x81r\x01Ǒ\x14\xf3>\x8eRH\x01\xae#\x01\x10\x06\xbaIJ\xad\xd5<$\x84\xbc8Y\xf4\xfeC\xda\xdf\xe4\xd6;\xac\a\x9b\xd1)c\xcc칹\x05\x94\x92\xdf\xf2y\x12\xe0\xe2\xc2\"\xa7gfYj\xb4HSC\xbdե\xde\xea\"\xa5\xc2q\x1c\x94R  \x8ac\x1a\xdd䨫D\xe3\u07fbu<\xfd\xc0|?\x0eP\xef=\r\xe6ᩥ%0`\x8c\xf9\x86ǉ,\xc8\xe7<\x17yqa\x91SӗH\xd2\x14\x81}\x82\x10`\f\b\x01RJ\\\xd7A\x00\xed\xa8K\xa2\xb9`\f\x9d\u007f\x8f\nx\xc3U\xf2\x1bN\xe83\xfd\xfco\xa5\x9c\xde{\x1a\xc3\a\xe7\xebm\xa28$M4I\x92\xa2S\x83@\x90\xf7=r\x81G\xe09\xe4\\\x17\xe7\xd2b\r\xf1t\xb3\x91\x92R! I\x130\x86\xc0\xf1h\x8a\x0e\x9d0B\t\x1a\a\x9b\x91\xf9~\t\xfd\xd9,s\xb8h\xe4c\xe7\x9c\xdc-\x83\xe6\x9e(\x16\x0f(\xc5\xf3S\xc3&@`\b\xb5\xe1\x906\xfccjx0\xd1\x1c\xd9U\xf4\xba\a\x9a\x91\xf9\xb7@\x8aƘG\xbbqB'\x8a\xc9\xfb\n\f\b)ȹ\x1e\x95\\\x80R\x12%\x15\x02p6\x8e\x0fs~V\x90\xa6\x1a\xdfs\xc8y\x1e\x03\xa5\"\x9d(\xe4\xcc\xec<i\x92\xe2\xb9\x0e\xa5\\\x0e\xdfsH\r\xfe\xf7K\t\xab\x05w\xf7\x98\x93\xaf\xf8\xa62\xdbT~#b|\xbe\xc9K\x94\xe4M%\x9f\xf15#)\x83yC50( LD\xb0\xd8\x11\xb7ͷ\xc4mK\x1d\xf1;K]q\xba\x1e\xf2Ν\x05\xef\xef\x0e6\xa3\x85\xef\xf5g0\x86\xa58I\xa3n\x14{y?@\bA>p\x19*\x17l>\xe28\xe4=\x0fG)\x9c\xa1r\x89\x81b\x81n\x14\xe3*\x85\x94\x82ss\v\xcc\xd5\xea$q\x821`\x808I\xf0\x1d\x17c(\xee*z\xea@3J\xff-\x14\xd1S\xc2\u03a2\xb76\xd5\xfc\x806\xdc`\f\xdb.\xd6).:\x94\xa2\x04\xcfu\x18\xdd6\xaa\a'\xd7\x196\x0e\xa6\x14<PҺܞD\x8c\x81\xb0\x03K5\x98\xad\x8b\xcdǪw\x9d\xa9ɟޑ\xf3\xbe\xa8\x04\x9fw$\x9f\xec}\xa6\x9eK{.,g\xb2衍I\xbbqx\xae\x1dF\xdb\x06K \xa5 LR.,.\xa1S\x8d\x94\x92\xc0u\x18*\x97p\x8c\xb6\x17\xeb9\x0eRJ\x9e\xba8\xc5B\xbdqY\xf0\x11B\x10'\tE?O\xe0\xb1UC\x11\xa8}/\x03\xe1\xc1f\xc4d\xd1S\xc0k\x8c\xe1-\xc0\xce\xf1\x82qו\xb4\x1c/\x1aQ\xf4\f\x87\x97\x14k\x86\f7mN\xf1\x1d\x90\x02\xd2,\xf69\xc2\xfe\xaf\x8d\x8d\x87\x00N\t\\\x17|\xcf0RL\xf8\xfci\xf7\xba@\xe9kO\xd5\xd4\xcf5#\xda;\vާ\x84\xe0\x0f\x0ff\f\xc4O\\\xa1\xf8_\xc7\xd2\u007fu\x1c\xb9\xba\xe0\x19m\xf4\xf1T\xebm6$\xd8$\xd0\xc6k\xfb}'\x8a\xb98\xbf\x88s\xeaҌ\xda2>\x01\x18N\xcf\xcc1\xb3\xb0\x8c\xe3\xc8\f\x11d\aLkr\x81\x8f\x17FTr\xb9\x1b\x16\x9a\xe10P\xfbvNҳ\xf9\xe8ɢ'\r\xf8F\xe3\xa6\xc6H)\x04J\x92\x00\xb1\x10\f^\x9d\xf7\xde\xe4(\xdeZtM~sEsͨ\xa6\xe2\x1b\x12\r\xd3-ɹH\xf2\xc37'\xe4=H\xb4\xb5\bG\x81\xef\x82+W\xc1\x17 N!\x8c!N\xc0\xcf\xc1\x80\x84\xa5%\xb8w[\x8c\x03\xe2n\x9d:3-Q>2\xaf^{\xb6.^{}\xd9\xfb\xb2\xa3\xf8\xef35\xb3\x17\xd2K\x00w\r\xbb|a>\xfe\xae\xbd\x96\xd6f.\xcd2uG)\xb41\xa4OC\\\x06p>\xf5\xf5\xaf˷\xfeЫ\x88Ә\x85z\xddf\xe9\xa9YI\b}ϥ\x13\x85H!\xa8\x16r\xac\x19\xa8\x0e/\xb5.\xfd\xded\xd1\xfb\xe5\x83\xcdh\xea\xdbE\x1a\x93EoC\x9c\x9a-\xda\xe8\x8dF\xeb5\x9d(^#`\xd4Q\xa6\x128҉SC7\xa2m\xa0\x06\xf2\xe6+&Ď\xeb7h\xd6\xe64\xb2\v)\x10\xa6p\xb6.)TᕛR\x12m- \xef\x83\xe7\x82#AJP\xc2\xfeoi\t\xfb\xbd\xe7X\xc5t3\x99\x96\xaa\xd0\xe9\xd8C\x97\x840\x9a7LlLh\xc6\xf0Ԣ\xba\xfd\xe4\xb2\xfc\xec\\[쿩\xea\xbd\u007fCQ\xbf\xfb\xa3\x17\xe2\xc6w\v\x00\x04\x98$5\x9dD\xa7\x16\xb9\n\xd0:\x83\xbbR \x10+\xcaqZ\x9dn\xfc\xb5cG\xd5\xeem\xdb\xd886¡3\xe7\xd1\xda\xe0:\x8a\r\xa3C8Rq\xfc\xe24\x06PB2\xb9a\x1d\xa9\x8e_s\xe8\xe2\u00adW朇\x85\x90\xff(%\xa7\x04,a\xe5&2\xb7]Ն\xed\xc6\xe8\xbb\r\xe6\x860I\xc6\a\nN~\xa8X)\f\x97+^\xb5\x10P\xc9\x17\b\xbc\x00G)R\xadiv\xdbDqL+\x8c\x98^\x9ab\xc7X\x9d\xb0\x0e\x91\xa5zX\xec\nT^p݆\x94X\x83\xef@γ\x96ẙ\"\x14\x04\x0e(e?8\x06\xa2\x18\x9a\xa1\xfdYI\xab\x840\x01/\aq\xd7>G+kA\x81\x82kFR\xb6\x0f\xa4\x9c\xaf\xc9k\xbex\xc1\xb9\xe6T]\xde\xf3\xb6\xabx\xd9\x1f\x1e\xb11\xe6e\xeb\x1d\x1e<\x9f|\xdb\n\x19/\x1b曦\x1d\xc6\tJJ\x8c\x01\x8d\xc1X9Ṋ@*\\)\x11\xbft\xcb\xd5\x1d!M\xf0\x13\xf7\xbe\x90R\x10`0$IJ\xe0\xba\xcc7\x9bL/.3_\xab\xd3\xeat\x10Rb\x8c\xa6\xd9\x0e\xa9\xb7뜜\x9dcf\xa9N+\xd4+\x1a\x16ٿ\x8e\x84b\xa0\x18\xae\x94\x18\xafV\xd92>J1(!\x10\x18t\x0f\x0e>c\x92\xa4\x94\xe2\xc9\xd3\x17\xb8jd/n\x1b\xe2Ȟ\xf4\xaf\xcc8\xbc\xfe΄D[\xb7\x14d\xca(\xf8V\x196>d\x96\xd1\xf7X\x80UJ\xbdecL7\x86\x85\x86uuI\x02a\x03\x92\x14t\fƲE\xa4٥\xb5C\xc1\xbf\x9cw\xb8\xd4\x16\xd3\x03\xbe\xf9=\xcf5\x1f>\xbd(\x17\xb7\f\x1b\xe3;\xe8\a\xcf\xc6\xdf2\x05\xd8U\xf4\n\x8e2_\u007f\xc9\r\xd7]\xb5mb\f\xb2\x18ҋ\xcf\xd5|\x0eGJ\x1c\xe5t\xc5[\x9e7\xd9it:A>\xe7q\xff-7\xb1m|\x82$M\xd1\xdaЎ\xbat\xa3\x88\xa5f\x9b\x85z\x93\x1b\xb7o\xa1\xd1\xee\xf0\xf9\xfd\x87H\xd2\x14%\x15\x83\xa5\x02\x8dN\x8bf\xa7\x85\xc1\xe0H\x87B.G1(\xe0:\x1e\xa96(\tQ\x9cdg\x02\xd6\r\x0f\xd2\xea\x86,5[\xcf\xc2\xffH\x8e^\xbc\xc4X\xfeq\x86\x94!j\xc2\x17\xce;\xbc\xf2\x96\x84b\xde>\xa6g\x19y\x0f\\e\x15\x11\xb8\xd6\x02|ߞ\xfa(\x82nd\x05l\f4\xda\xf6\xe7D\xc3b\xd3*&J \x8d\xa1ӄ4\x024\xa4\xba\x8fд\xb1?\uf7d1\xec_p)\xe6\x02\xdaag\x8f\x14\xf1\xc1N̑n\xcc\t\x8cX\x92R.I)g\xa5\xa0=R \xbc\xe7\xeaD\x1d\xbc(+'f\xe5\x15qb~s\xacZ|\xc1\xcbo\xdeM\xce\xf7\x90O\xa3Pʹ\x1c\xeb\x87\aIR\xddu\x00\xca\xf9<\xed(\xe2#_\xf8\x12\x93\x9b7p\xff\xee\x9bp\x1dIQ\x06\xe4}\x9f\xa1r\x89-\x13c8B\xe2(Ŷ\xb5\x134\xdbm4\x86\xeb\xb7n\xe1\xe2\xfc\x02\x17\x17\x96\xd0Ơ\xb5Fk\x88\x12M\x94t\x18\x1f\xac\xb2~h\x90'O\x9d\xed\xd1\b\f\x97KL-,=;\x9f#\x14\xf3\xb5Y^\xb1\xcbp~\n\x8e-Kvn\xd4T\x8bV\x98\x9e\xb2\x82\xef\x05rϱ\xc1\\I\x18\\7\x81r\x14\xe9\xf2\x05r>\xc86\xb4ZV\xc0\xae\v\x9d\xccu\x15\x03HSH\x05\xe0\xda`ߎ\x9efV\xabn\u05cci\xb40\x8c\xaf\xdbA\xe0ʛ\x9b\xdd\xee\xcd\xf5N\x9bV\xb7C\xb3\x1b\xc5K\xcd\xc6\xfcR\xb33\x15\xc64/.\x8b\xee\a\x1f\xc5\x11\"\x1d\x12\xa4;\x87K\x05\xf7\xe6m\x9b\xc9\a>Rʕ\x83\xb9B,v\xbb\xb4Èr>\x87\xd33\x9d\x9c\xeb\x12\xb8\x0eG\xcf^dߩS\xdc\u007fӍ\\\xb7e+\xaeT$:Eh\x8d\xc6P\xc8\xf9\xecܰ\x162\xe7#\x84d\xfb\xc48\xdb&\xc61@\xaa5\xdd(\xa2\xd5\r9?\xb7\xc8x\xb5B9\x9fCf\x94~\xde\xf7\x98Y\xaa\x91h\x8d\x14\xdf\xf8\xe9\x85\x10\x1c\x9b\x9a\xe6\xf6\xad\xd3\xe4}\xeb:\x96c\xc1\xad\x1bR\x8bB\x148N&`e\xc98?cB\xca\x03\x01\xb9\xed/@\xe4\ai}\xf9]\bc\xef\xebv\xb3S\x9f\xe5T`\x95\xe8:6>\xa5\t(\x17\x84\x04\x93\xae\xca_\xe8#\xcd\xd4\xc0\x96rĩ\xb9)n\xdfu\xed\nt\xcd\x0e\x99\x1b\xc7z\"5\xe9D7\xecЍC\xb41())\xe5J(\xe5\x90\xf7]\x84\x14H)\xf0\x1c\x87v\x18!\xb27J\xb5\xa6\x1bǔ\xc9\xe1\xb4#\x8b\x1a\x1c)q\x95\"\xef\xf9\x14D\xc0?<\xf2(\u007f\xfd\xd0?3X)\xb2e|-k\x87\xaaT\xf2yF\xab\x83()\xfb\xa4\x98T6\xed\x176\x82:R\xe1:\x8a\xf1\xc1\n뇇\xd0\xc6\x10\xc61\xa5|\x8ef\xa7\xc3\xd5\x1b\xd6qr\xfaҳ\x1dD\xea\xed\x0eE\xe7\b\u05ee\x8f\x89\x138tQ\xb2qTS\xf2,Z\x12\xc2\xc6\v\x81\xfd^Ȟ\x9b\x03\xc7tI掀N\x10\xc6ާSk\x11ɪ\xb8л\xf6\xc0\x85N\xd4åւb\xdd\xcf_z.\v\xec{\x06.\x98\xa5\x8b|~\x9fྛw\x03\xc6\x16\xf5\x048ʠ\xb5e:\x00\x94\x92+9\xc6\xd5\xeb'8|a\x060ف\xd5\x04\x9egC\x831xR⻮\xd5\x03\xdafR\xb6\x10\x15#\xa5\xc0Q\x8a5\x83\x83\xace\x18m4s\x8bK\x9c\x9d\x9e%њ(\x89Y]\x8e4\x18\\%p\x95\x83\x94\x8aj\xb1L)\xe71X*3X.2P,3^\xadp\xfbU;\xe8D\x11RJ*\xc5\x02\xf3\xf5\x06B\x88\x15\xe5\xf6p\xf8\xf9٣<pM\x13υ\xa3\xe7`vYp\xfd\x16\xbd\xa2\f)\xfa'\xd8dt\xb5\xc9Np'\x04s\xee\t+P\x01:\xb1\xc1<\xd5YҘZE\xf4\xf4\xa2\x14\xe4|\x8b\xb8z\x8a\x95\x0e\x88\x14\xa2\xd0>^\xa9\xbe\x06\x95\x80ue\xc1#\xe7\xa7\xf1\\\x85#%I\xaa\x89\xd3\xd4\x06\x9f\x1e+\xdbú\x18R\xa3I\xb4\xc1Q\x92$MW\xae\xbb\x14\xf8T3\x86$\xe7yT\vy\x8eOM)GkM\xaa5a\x9cP\xcc\x05\xd6\xff\xeb\xc4\x06m%\xf1\x94C\xce\rȹAϧ\xf0\xf4lf\xb5G\xd4:e\xb9\xdeav\xa9\x89\x92\x02%!LS\xca\xf9\x1c\xaf~\xfe\xf3\x19*\x95X?4@\xcesi\xb4\xbb4:]j\xad\x16\xae\xe3\xf0\xf9\xfd{y\xed\xcdӌ\x96\xe1\xec<\xcc_\x027\x80j\xa9\xaf\x8c\xd5\n\xd1)h\xa7\x8f\xe9[Y^\x91j\x1bktj\xbfGX\v\xe8e\xed=:H`AA\xab\v\xb1\xb1n+\x89\xec}JZ\x85\xcaUپ\x90\x90W\x86\x017\xe5\xc2\xdc\x02[\xc6Gq\x94DJ\x816\x86$\xd5h\xad)\xe5r,6\x9b\b!\x90Rra~\x91j!\xcfl\xad\x8e\x14\x02\x03tc\xab\x88R\x10\xe0(\x81\x92\x82O>\xbaG\xc8(M\x99\xaf\xd5Y\xa8խ\t\xa5)Zkڝ\x908Ni\x87\x11\x89\xd6V袟\xf2\xaf|Y4\xbd\xf2%\x85\xc4u\x1c\n\xbeO\xe0z\xb8ʣ\xe8\xe5\x88C\xcd\xef~\xe8\xef\xf8\xf2\xe1\xc3\x04\x9e\xcf\xc4@\x95\xcd\xe3#,5[H\xa9\xf8\xc2\xc1ü\xfaƋ\xac\x19\x80Ku\x98\xbe\x00\xf3-\xc1\xbaQ\xd3O\xf6D\xff\x9d\xac\xef\xb5()\f\xadR\x00\xc2\fYře\xf4nqb\xa1\xad\xe1\xf2\xc0-\x84M(\x8dɬ\xc3!sA6Ψ,V\x89\xacH\xa7\rl)\xc1g\xbe\xbe\x97\xc0\xf3\xb2ײ\x96\xee\xbb\x0e\xe5|\x8e|\xe0\xad\\\xa3\x00\xbaI\xccX\xb5\x8c\x93\x99\x9b\x00\x02\xcfCI[.\xcf\xfb>\xff\xe7k\x8fR\xf2\xf3\x89\xdaQ\xf2\xffk\xbb\xd3q\n\xb9\x1c\xae\xeb\xd0hw\x98]\\\xa2\xd1n#\xa5\xc0\xf5\x1c\x92D\xb3\xd8h\xb0\\o\x90\x0f\xfcˬ\xc4d\x81\xf8\x99\xf2\x89\xcb)}\xc1hy\x90=O\x1d\xa3\xde\xe9\xb0m\xcd\x04\xae\xe30R)\xf1\xa5\xc3\aXS:F\xce1\xcc-\xc3\xcc4\x88\x04\xf6\xcd*\xeeکQY\xa6\xadW\xbd\xb6\xc8\xe2H\x92Zw\x95h+\xbcg\xbaE\xb1\x85\xbc:\xcbOV\x8e\x8f\xb1BnG\x99\xb2R\x1b\xe0E\x16S\x84\xb0\xbf\x93Y,\x892\xa5\n\xe0\\-\xe1y;wR\f\x02\xb41H!\x90B \xa4`\xfd\xd0 s\xb5\x06Z\xebL6\x82-c#T\xf29\x8c\x81j\xa1\xc0\xfa\xe1A\x9b\x18;\x8a\xcf\xed;Ⱦ\x93g(\x05\xb9\xc4i\xb4;8\xaeC\xce\xf70\xc6\xd0\xeetX}\x0e-\xe3kXX^F\nI\x10x\xb8\x8e\x83\x10\x82N\xa7\x8b6\x86r\xb1\x80\x10\xd2j\x1ca\x85\x95]H\xef{+\xbc\x98\r#c\x1c>s\x9e\x81b\x81\xe7：4M\xe8v\x8e\xb2k\x93!lAԲBX\f\x05\x85\x92\xa0\x18X\xcac%7ȬDg\xfcU\xefg\x84\xb5\x02\xb2l\xbc\x17\xa8\xbb1tC+|\x9d},\xb1*\xc0\xf7^\xeb2כ\xf9\xb3\x1ehЙ\v\x8c\xe2\xec\xf9\x12\xb6\x155_<t\x8c\x9f{\xe9\v\x99\xaf5H\x12\xeb\xfa\xb51\xb8Rr\xd5\xda\t\x8e\xcf\xcc\xd2\xe8t\x18.\x95V\xf2\x8d\xa1R\x89\xc0\xf3p\x95\xe2\xf1\x13'\xf8ҡ\xc3L\xcd/\xe1)\x97(Np\x00\xf2A`\xf1q\xd6ab/J\xe0\xbb\x0e`\b\xa3\x18\x8cE\x0eR\xf6\x83S\xbd\xd5FJ\x89繗'vB\"\xa4\xc0Q\xce\n\xfa\xca,\x1b\x81\xa4\xe0\xf9|n\xef~vm\xda\xcc#\x87\x0e1*#\x9as6\x88\x92\tk\xff\xac\xe2\xfe\xeb\x13\x9a\xed\f\xbaƖ\xb3\x82~\xcc衫4\x85Ȁq\xac\xe00V\x89I\x06uE\xc6\x1d\xf5\xb2\xf0\xd5\fp\x9cZ\x04\xb6\xa2\x8c,1\xec\xfd\xa2\a\x10.\x87\xe6Pp\f\xe7O\x1fa\xdf\xe9+\xb8i\xfb&Z\xd9\xe1L\x8d%c\x8b\xb9\x80\xdd[7\x91\xa4)y\xdf\xc7s\x1c\x1c\xa9\b\\\x97\xfdgN\xf2\x91/}\x85$\xd6\xf8n@%\x97gf\xa9F\xe4y8\x9e\xa3\xc8y^\x96\x91\xa6+\x94\xb0\xeb\xa8\f\xb6\xd9Z\b\x80T\xf22 \x91$)\xbe/W\x02d\xcf5-,\xd7\xd1:ex\xa0\x8aȒI\x91\xb96#4\x02A\xd9\xcf\xf1\xfe\x87\x1f\xa6ٜ\xe7\xe6AKa\x00D)\x1c\x98S\\;\x91\xd2X\x82\xc6b_\tA\x00\x95\n\x94J\x16\x1d\xf5→V\x88iԷ\x16azIf_ɆL\xf8\xa6\xaf\x98(\xe9;\x04\x93\xd8\xdf\t{\x1e/\xb3\x14\x95%\xa3:\xed\xbfvI\xb4\xf9\x83\x0f~\x84\xff|\xff\xbdܹk'\xae\x90\x96\xab\xc2\x10%\t\xbe\xe3\x10\xa7\x06\x9dj\x96\xbaM\x0e\x9c9\xc3g\x1f\xdbC'\x84\xa2\x1f\x10\xb8\x1eR\bK\x059\x0e\x9d(\xc2\xc9\xfb9\xe28AJI\x1c'+\x1c\x93\xe7\xbaD\x91e'\xa38\xce:\"\x04ib0\xe8\x8c\xefI\xc1\xb8\xfd\x02|旻aH>\b2?\xadYn\xd8xT\b\x02\x10\x02%\x15\x8er855C\x9e:j\bL\x16X\x97\xbb\x82-\x83\x96jO5\xd4\"A\x9c\n\xa2\xc4@C0\xde\xd1\xcc\xcf\xc1\xc4\x04\f\x0eX\xa5\xf4\xdc\xd7\n\x02[u\xe2{yD\x9a\xb9\xbd\x95\xdfk\xab\xfc8\xed[L\x92Q\xa3\xbd\x04\xb1\x0f_\xad\x12\\'\x8b5ً\xe4\x15\xac\xcfi\xfe\xe1\x91=|zϣl\x9eX\xcbP\xb9\xc8h\xa5\x82\x01\xa6\x17\x17Yn\xb6Yh,\xd3hw\xc9{9FJ#P4L-.\x11\xc5I\x16ܕU^\x92\xe0,t\x9aAA\xb9\x80 I\x93\x95\x00m\f\x84q\x84\x92\x924\x83+RJ\xb4\xb1<\x97\xd6:;e\x8645Ha\x8fS\xa2\x93\x95\xc4\xc8~zC\xad\xd1\xc4\xf7\\|\u05f5\xf4\xbe1h\r\x95|\u0090g\xd8\u007fI\xb2\xd0\x16\x04>T\x8a\x90\xc3\x1090P48\x89\xa5\"\xc3\x04\x9aM\xc3\x133\x8a\xab\x864b\xda\x10'0:\x92\x91\x89\xda&%\x92~\xf0gU0\xd6Y.҃\xc0=K\xe9\xc4\xfdxcVC#\xb3\n\xd6\xeb>\xec큉\x15\x1e\xcaI\x89\xa4`0_e~\xb1\xce\xecb\x8d#b\n)$\xdd0\"I\x13F\a\x06\xa8\fT1\x18t\x9ab\f\x04\xaeK;\f\xe9\x84!\xdah\xe2L\xf6Υfx\xdcw\xa2\xed\xc39\x8f46+A8J\x12H\xac\xb5h\xddOh\xd2T_\xc6߃\xc1\x18Mj\xac\xbb\x8a2+\x93\xd2\x16a\xba\xddжHJ\x856\x02\xa3\xad\x93^\xec6QNH~Pp\xedz͵\x9b5\x83e{\n\x8d\xb1\xb9\xc1\xc2R\x16|\x8d!J\xa1\x1d\xc2\xe4Ɣ\a\xf7:T\xdb\x1a\xd0\xe8\x14FF\xfan\vy9,^\x9d+\xa5\xab0\xb3\x14\xd0MW\xc5\x16\xddwIfU\f\x89\x93>\xc4Ѻ\x87*\xfb\xb1\xbf\xe4\xc2\xf9\xfa2\fT\x10F \r\xb8\xca\xc1sl\xa7N\xb3\xa3\x99[\xaeQ-\x14\xc8y\xfe\xca\x059\x8eBD\x82\xe5V\vGZУ\x04\xa8Ka\xfa\xae1_\xa5K\xddd\x8dQiE\x1b\xa1\xa4\x90\x98\x1eB¬p6I\x9a\x12\xc5\xd6B0\x860\x8e\x10R!\x84D\x1bM\xaa\r\xdd0Bg\x15F%\x84%!\xb5!\x1f\xf8(%\xd1\x1a´ƽ\xbb\x96y\xc95)\xb7]a\xb8m'\f\x96 7\xbc\x96`\xec\n\x9c\xb4N\xc1\x8f\x18\xce\\R7\xb4\xb9Bއ\x81\"\xdc~\x85\xe6RK\xb0\xef\xa2$l\xdb\xcc:\xf0\xa1\x93Z\xe2qu\xd0\xc6Xkx\xa6[\x8f\xf9\x85\xccE\x89,\x8edV\x93\xa4}\x97g\x8c\xcdw\x84\xb0\x16\x17g\xf07\x05:\x1a\"m\xe8F61\f\x93\xc4\x1eh,\u007f\a\x90h\x8d\xd6)\xad0\xa4\xd5\xed\x12\xc7\xf17\xa4\x0eژD\x01Lw\xd3/\xbeh\x8b\xfc\xf0rG\x1c\b\xb5nŤNB:\xdaI4\xedD\x13jC\n$\xc6\x10\x9b\x940\x8diF1\xaecݔ\x94ظ\x82&J\x12b\x9d\xe0\xba\x16U\xb4:]\xebos\x01B@\x98D\\\xb5~\x99\xe7m\xb5\xdcЎ\r6`\xa6)\xa8\xd1+q7ݎ\t\x1b\xa4\xf5\x19\xa4\x80B\x0e\x9a\xed̿g\x82\x89S\x18\xaf\x1aF\xab0\xd3\x10\x9c\x9c\x11\x9c\x9e\x93\xcc\xd4\x05k\x86\fnf-&\xcbOV\x85\xb8\xcb\x02u\x98X\vЉ\xcdГ\xc8R\xf1=$\xd5\xc9\x12\xce4\xed[J\xaa\xa1\x15Ze\xb4\xb5 T\x92\xb5\x830\x9e\xef\x10%!\xcb]\x89\x14\"c\xbd\xf5\xaaXf\x88\x92t\xc5\xd5\x1b\xd1\xf3\x8e\x02\x90\x96\xe1h6\x10\x93E\x8f\xd7ܔ\xf2[\x9f\xb7\x9f\xf8'\xafq\xfc\x13\x97D)I\xc9w\x13\x8a\x03\x05sK\xaa\xc5\xce$!0 \x92\x14'N\x85J4?\x98w\x18)y\x0e\x81\xf2\xf0\x1d\a\xcfshu:\x84qB\x90\xf7\x11F\x10vm\xf9\xb7T*\xa2\x13\xa8'5~\xf1\xc5m\xa4\x80\xa1\x82EKղ\xa5G\x94\xa3\x10N\x0e\x93\x84DQL\xbbc\x95\xd1h\xf7\x11\x95\x00ZY6\xae\xb3`}q\x01\x16\x16\x05\xfbg$\xf7Lj6\r\x9b\x15+\xf1]\xab\xf08\xb6.+\n3\xaa\xbdk\x83z\xbb\r\xed%H\xe2,>\xacrG\x8dV\xff}\xfbT\xb9e\x03η\x14\xf9\x82\xe1\x9e+4y\xd7>\xbeހ\xaf]P̵K\xf8\x8e\x8b\xc8\xc67\xc4\n!\xd6S@\x06\xddeB\x98$Ի\x11\xf5nL\xaaM]|7Mi\xd9\xfd\xca\xc0{\x81\u05cf\x17\x1c\xe1\x1aa[N\xb3\xe3\xe8\xfb>\xed\xa8\x03\x89A)\x85R\x0eq\xaa\xb9iG\x83۷\xdb\x12\xac+a\xa8\xb4\n\x1d\xad\x8e\xc8=\xa8\xaa\xfb\xbcSOX\xb5\xae\x85\xc9:\xfb`Ij\x05u\xa9f\xa9\x88mc\x1aOB!\xb0\x85\xac\x9e\"\xdb1ԛ05\x05~\xd1>\xb7\xb5d\x156>n\x95\xb3\xb0`\xaf\xa7\x13\xdb\x02\xd7걎8\x85Z\aN6\x15\xbb\xb7\x1a\x9e\xb7]\x13\xa7\xd0j\xdb\xeb\x0e#\xe8\xb6\xe0\xa13\x8a35\xc7\x14\\\xa5\x8b\xbe\xab<\xc7\x05aQi\x1c'\xb4\xa3\x84P\xa7q+4\x89@\xb4\x1c\x87\x87\\ɟ}m!\xfa\xb2\xf3\xad\x9a\x13\xbe\x89\xb2R\xe0\x8d;\vޡ\xb9N\xf2?\x86}%\xbd\xb4_\xd3H\xa2ض\xe4\xf4 \x90Hi%\t\x93\xeb\xf4J\xf2\x96hX\xee\xd8\x1a\xb8\xcc\x1a\x13DFo\xe8\xcc5\xc5I\xd6ړ\x11\x81\xbd\xc0\xdbS\x9a\x10\x16\b\xb8\xca\x16\x9d\xa6\x96\xedc<\xd7*Ø>A\x98\xf7@\x94\xa0^\x84\xb9پE\xe4\xf3P.C\xb5j\x95\xd2\xed\xf63\xf9\x95\xbc\xcb\xc0RW\xd0T\x82\x1f\xba]\xb3y\xc4\x10\xc5\xe0`\x15\xdf{L\x1b\xd86`\xd8{ɜhG\xf1{.5\xe3ە2#J\xe0\xdaV]\xd1\xf0]qL\b\xf3ئ\x11\x0e}\xe2T\xb4g\xb5l\x9d\xef\xb6רgA\x87Z\xd1\x1f\xed,z\xe5\x850\xfd\xaf\x03\x9e\xc1\x17\xd2Ba\x1de\xbeڐ\xa6)q\x92⸚\xbcg\xb3\xd9(\xb1\xfe\xb8\x13g\x14z\xd2\xffP`?|·r\xde\x10\xb8\xb6$\x1bf\xc1\xd4觑\xce\xc6r\x01\xa9\x86-#\x86\x82߇\xa69\x0f&\xd6\xe4\xa8/wX\xa8Y\xe5\x0f\x0f\xc1\xe2B\xf6~\u0096|m\xa2\v\xa3\xa3p\xe1<\x9c\xae)6\x14ҕ\xf8q\xa1-ټ\x01^\xb0QS\xc9[\xa5/6\x04Gg\x04\x17\x16\x05Z[&x{U\xb3\xd8\x01)8~\xa8\x15\xbf\xa3\x8a\xffǷoL\xabk\x06\x8c\xe3\b\xd8<\xa2[o{H\xb7\x01\xc8z&\xef\x9epyx:\xfe\xee\x15\xf2\rJiF\xbf9Y\xf4.-G\xfa]\x03>\xc8\xecD\vl\x9d\xa5wҺ!|z\x9f\xc3}\xd7$+>\xbdބ\xaf\x9f\x92̵%q\x04\x12\x83\xa7\xa0\xab\x05\x81\xd0\xcct\x1c<eXW\xd4l\x1d5\\\xbd\xc5P,|\xa3o\aù\x05\xc9\v\xae\xd6+\xb9\x82N`\xe4\x9a\xdd\fNތ\xf7\xe8\xdf\xd0l\xb7\tc\xdb\x14\xb1\xd2T\a\xb8^V+\x11\x96\rp\x1c\xdbr$%,wadLpߵ\x86\x81\x82\xc1u`j\x01>\xf1\xa4\xc3\xc5yI\xd11+\x9e6\xf0\f\xdb*\x9a\xb35\x85\x14\x1c\x02X&4\x9f:\xcb\x12g\xfbW\xfa\xe3W9\xbc\xffH\xbfk\xa5\xa7\f\x00\xf5\xaf\xe9ʛͺI3\xc5\xec\x19rԑNjnq\x1d*\u0088\x15t\xd3cV}אw5\xeb\x86\r\x9d\x18NNK>wHQҚ\x11\xa5\x19t5\x03\xae\xa1,\r\x83\xae&'\rþ\xedϝj\n\x8e\xcf*\xbe~\\\xd2\xe9\xc0pŬ\xd0\xe2&\xf3\xefs\rA%\x0fCE+`WY\v(\x94<:\xd3Ǩ52W\x98\xc0\xf4\xa5\xfe\xb5\xadY\xe3r\xcbm\x1b\xa9\u05fa\x84݄ӗ\x04I\x02\x1b\x06\r7\xdd\x00kF\xec\xf5\x9f\xbe$\xf8\xf0W\x1c\x1e:\xe0\xa0b(8\xe0IÚ\\\x8a/\r\xf5\xd8\xd2H\a\xe6T\xa4$/\xbd\x14\xa5\xcf\xe8\xf7\x0f\xcc\xebg\x95\xa9\xc3sp\xebśC\xad\xe8\xc3;\vޓ\v\x1d\xf3\xe6\xc05wH\xc4\xf5\xda\xe0\xe6\x1c\xc35c)\x9b\xcb)\x89\x16\x1c\x9b\x92tR\xd8\u007fB\xb2\xa3\x90໖^ovW\xf1S\xc0цC3\x11\x984\xa1Z\xf4\xa1\xd3!E\xf2\xb5S\x8as\xb3\x82\x89AC>\a\x05\xdf\x10\xf86\xb8\x9f\x9d\x17l\x1918ʒ\x82\xb5\x99i\x1a3ӈU\x85\xacFsU5P\x80\xa3\x04Z\xdbdM\x1bXn\xc3X\xc1\x10\xa7p\xf0\xac\xe4ܢ\xe0\xfc\xac\xe0\xe2\x92\xc0U\x82\xc01,Š\x11\f\xfb\x1a)\r9a\xa8(ə\x86\xc4\xc0\x83\a\x1aQ\xf3\xbbi\xac{N\x14ҳ\x92R`\xf8\xea|t\x1cx\xcb\xd5\x05\xef\xbdJ\x98\x9b\xaf\x1c\xd2\xec\x1cN\x19\xcc\x19\xa4\x02\x93\x1a\xd2Hp\xae&\x19\xf34\x8e\x84uC\xd6}\x841\x84\xfa\xf2\xd6\xca\xe5\b^\xf6\xc3/\xe6\xd6;o`fj\x8e\x8f\xfc\xd5'h\xcd/r\xa6)\xe9Ě\x82cX\x8a\x1c\xb4\x10\x18!\xd0F\xb3\xael\xb8z\xa3\xa1\x1dZ\xa1\x1b\xfahI*k\x1d\xab*ǴZ\x11\xfb\x9e8O\x92\xa4\xb4ZP\xeb\b\x9e\x9a\x974Bp\x10D\xb1a9\xd4h!X\n\r\xc2Q+\x89sE\xf7\xc1\x85\xc0\xb0\xd8R\x00\xb7=ݭ\u007f\xdb#p\xcfe\xa7\xfa\x85\xb6\xe6\x8e\x11wː\xeb\xecY[5/\xfc\xf9\x17&l\x19\xd0\x14\vP\x1c\x00Ǉ\xb0\r\xbe4\xc4F\x10wa\xa0`\xa8\x14,Rju3\xf6\x95>\xbd\xd1\x14\x01/~\xf9ݬ\xdd0\xce\xc8\xf8\x10\xa5r\x91\x83{\x8f\x13&\x86\xe5X\xb2\x1cI\xaa\x19\x98P\xc0r\b\xad&8\xa1\xe5\xcbr\x81\x15~\xaf\xfc[o\xc2\xd9\xf3\xabj\xe5\x80\xe7A.g\x1f\u007f\xea\f\x1c\x99\x95\xb4\xba\x92@\b\x1a\xb1\xa1\xe1\x16\xf8\xe9_}\x03\xf7\xfd\xc8\x0f\xb0v\xd3\x1aΞ\xbaH\xd8\r\x19\xf14k\xf3\x9a\x9c\x02\xe5\nN5\x14\x9e\x90tS\x8aÞ\x1a\x9d\x8d\xd2O\xcfF)\x93EoŽ\u007f\xcf\x15\xd2S\xc6]\xeb\x84\xcc'\xdeO\xba\x8ex\xf0\xc6M\xe9\xd8\x1b\xeeH\xa8\xe4\xc1\t@f\x89\x93\x94\xd0mZ\xb71\x943\x1c\x9a\x97\x8c\xe6\f\xa5\x9c\xf5\xd19\x1fj\xad>\x17\x95S\x86\xa5VB32\f\x8f\x0f\xd2Xnr\xe2\xe8YN=uve\x84;\xc9`\xf2\xcer\x84+aK1\xe1dMэ@D6@\x1b\x99\xf5\x06\xc7p\xeal\x9f\xea\xa7\x17\xfc\xb5\xbd\xb6\v\x17\xe0\xe4\xac\xe0Ԓ\"\xef\xd8\xeb\x98\xeb\xc2\xf8\xc6\t^\xf2\xca\x17P,晟]\xe4\xe0ާ\x10QȺ|ʨo\xc8\ap!T\xec\x1eO9\xb9,\xf1\x85$\xc1\xdc0\xe0\xa8d\xccW_;Ԍ\xf4\xb7\xab\x14\xf1\\\r\xd3\xec,x\xffߺA\xf3\x9f~\xf0\xfaTn\x1dՖY͂\xad\xce*wa\x02\xcb36\x8f\xc8\x17a\xae#\xf8\xcaQŋw$+\x89\xdfb\v\x8e\xccHJ\x8e!\xa7\f\xa1\x16\x1c\xaf\x19ZA\x15!%\xadV\xc7\xf2iY~Qt\f\x1b\xf2\x9aq?]\xa9\xb9c`6\x94\xa4\x9ed[5\xe5\xeaq\x83tm~\xd1k\x9a\xab\x94`h\b\xceNYȭ\x04\xcc\xd6\xe1\xf3\xe7\x1c\xf2JRt49\xa5i\xa5p\xbc\xe5r\xcd\xf3\xae\xa3P\xcas\xe0\xd1\xfd\xe4:5\xd6\xe5S*\x8e\xa1\x10@KJ\x02\a\xb6\rh\xf6\xce(\xbe>\xe50\xe8\x1bZژŮ\xf8\x88\x12\xbc\xf1@3\xea|;\xeeK\xfck\x95\xf1\xfc1W,4ſ\xac\x1b0w\xbf\xf9\x85\t9\xd7R\xab\xab݄66`\xb7B\xe8ԡ]\a7'X\xbf\xcepa\x01\x0e\x9f\x96\\5\xa8W\x88\xbcz\x1b\x9e\x9cVtSX\x9fKQ\x02B-\x98\xe9\n\xea\xb1D\x00\x83\xbef\xc83\xb8\xa2_\x8bU\xca6'\x94\xf36\xbf\x99\xedJ\x96cI΅;7$\xe4s08\b\x03\x15\v\"\xa4\x82\x03g\xa09g-\xed\xe3G\x1d\x1c\x04\xebs)\x05G\xaf\xe4:\xb1\x86\xd8H\v\x00\xd0+\xe3\x0e\xe5\x1c\xac\x1f\xb6J\xbciMJ%\xb0\xa5\x82\xbf}\xc2g8\x97\xb2}0\xe5\xf1Y\x87ٶ8\xa5\x04/<Ќ\xce|+\xa5|WA\xfd\x9a\x92\xc7\xfeFĕyo|\xa9Ň\xae\x980w\xbe\xf9n\x9b\x99+\x01\x8e\x9b\xb1 \xda\xe2\xf90\ue4c3*\xab\xf6\x9eZ\x14\f\x8d\x1b6\x8dA%oh\xd4\xc1\xb4m\xf0\xad\x16\xe0\x8eM)\xa7g\xad_\x0e\xa4a\xd0\xd3l\xca\x1b\x84Xťdn0pa\xb8j3\xf1յ\x8c\xc6\fl\x1cJ9\xd1r\xb8\xf5&{\u007f\x92\xda|\xa8ga\xa5\"\xcc\xce\t\xf6OI\x9a\xb1`\xb2\x920V\xb4\x82\xcdxQ\\\tn\xc6\xe9\xf4ڑ\x06K01h\xe9\x9eb`X7h\x91\x9d\xa7\xa0\x1a@\xde3\x04\xcap皘\xfd\xf3Ζ\x135\xf9\xf0\xd5\x05\xef%\a\x9b\xd1\xd1o\xa6\x94\xef8\x86\xec*y\x1chD\xec*yk\x04|\xe0\xb6\xed\xe9]o\xbc#A);\x1e\xe0d\xb9A\xaa\xed\xc9jw\xac\xbb2\x19ߟj\x88\x9ap\xa9-\xd92\x0e\x03\x05K\xab\xfb\x81\xf5\xf5Qh]\x9a\xcax\xae\xb1\xbc\xcd7\xdaZ\x92w\r\xbek\x1f_\xcaC\xb5\b#U\x18(\xd9\xf7\x95\x0e(ς\a-\xa0(\fg:\x8aWޢ97/\xd8<b\x90\xc2ƕ^\xa5Q\b\xdbuRL\f\x87\x16\x1c\xae(\xa5\xb8.\f\x97\xedkb\xb2q\a\xc72σ%X7\x02C\x15\xfb~G\xe6$\xbb&\f\x83E\xf0,2g\xcfyE\xc1\xd1\f\xe7\fy\x0f\xd6\x165\xb1\xa6\xbaؕ\xbbG\\\xf5\x0f\x87ZQ\xf7\xd9b\x8a\U000ddea9\x03\x8d\x88\xeb*ަnăw]\xa9w\xbe\xea\xe6\x14O]\xde)\xde;\xa1\x9d\x10\xf6]\x94L-\t\xb4\x11\xb8\xcap͘\xc5\xedqb\xc8e\xd3NF[J\xa3Z\xb1\x1f|y\xd1\x0e\xd1\ba\x05_̙~ƟAW?gs\t\xa3\xad\x12\x94\xd3/\xdf*\t\xd35\xb8\xf1J\x18j\x19\xae٠Ylg\x01\xbe\xd7\xfc\xe6B[C)\x805C\xb0x\tF|m-#\x04Y\xb6JI\x8b}\xda\xdfq,o\xe5d\xcd\xdd\xf3\x1d\xc1\xd6qöQC1g\xdf\u007f~YЈ`,/\xc8y\xf6\xf5\x8d\x81\x1b\xc6Rj\xa1x\xdetK~z\xb2\xe8\xddq\xb0\x19\xa5\xcfd)\xcew\x12/\xe2\x14&\x8b\xde\x03ݘ\xffu\xdf5i\xe5\xd57\xf7\xa7\x98L\x167z/8S\x13\xfc\xcd#\x0eݎD'b\x059\x9d\x9cIy\xf5\x8ex\xe5t\xba\xab\nJa\nŢ%\xfbj5\xa8/\xf6O\xf1ӗ \b\xc7\xf6⮎\x82:\xe31\xf7\x9d\x81\x1f\xbb\xd7\xd6Z\\G\xe0\x96ƘH:t[M\x16\x97\xf4Jgb\x0fH\f\x94`h\x00v,&+ײЀ\xb5C\xfd\xf7-\xe6\xade:\x8e\xed\x82\xf1tǈ$\x00\x00 \x00IDAT<X7a\x9b\xf8\xdc\xcc3,\xd5\xe1\xe1\x93\x12m\x04I*.\xab0\x96\x03\xd8=\x96\xf2\xd93\xf2\xd6D\xf3A\xe0\xd5\xcf\xe4\xb6ԷR\xc4l\x94\x92a\xe9!\x03\xbf1\x90\xe7\xdd?zK\x12\xbc\xf4zK=\xaf\f\xd9H[\xd5k\x87\xf0\xe5㊿\xfb\xb2K\x1aJ\x84\xb1\xd8Rf\xe3\x03q*XWִB\xc1\xd80LT\xa1R\xb0\xb5\t\x91\xb94\xa5\xa0P\x84\xa1A\xab \u05f5q\xc9ˁ\x97\x87|\x05\xdcL8\x9d\b\x16\xebp~\x1e\x9e\xba\b\xa7f\xe0E7\xc1\xdd׃+\xc0_w=Ζ\xbbQ\x13\xd7\xe0\xf8yZ\x97\xec\x84X\xaf\xf3d\xa0\f\xeb7\rr\xc3N(\xfb\t\xe7\xa6m\x9c\xe9D\x16\x1c\xf8.\x94K\x16\x95\x15\xf2V1\x95\xac~\x93\xcf[\xabv\x14\xcc/\u0087\x1fU\x1c\x9ev\x88\xb3\x18Wp\r\x03A\x9f\b\x1d\xc8\xd9&\x8e\xb9\xb6\xd80\xe6\xab\xcf\xcfF\xe9\x85\xefH!=\x1fwU\u07bb3\x8cyϮu\xe6u?q{®\xf5\xb6#D\xb0\xd2II΅3\v\x82\x8f\xeeqy\xfc\xb8\x83\x8b\xb4\xa7\xc7\xd3\xe4\n)Ţ\xa6PLq}͈\a\x8e0̇\x82\xeb7\x1b\x86\xaa\xe0\t;\x11\xd5;\xb9i֑\xe8yP(A\xb1d\x95S,\xd8\xc7\x05\x99O\xaf\x96\xec\t_3l)\xf7z\v\xa2D\x90\b\xc1\x85\x05\x18+\x85x\xc5\nT\xb7\"\vä\xe7\xf7\x10F\x86(\xb1\xaf32^\xa4|\xe3k\x10q\x93\xb5\xa59\xf6\x9f\x12<\xb5\xa0\b\x84\xc1W\xb0nԖ\x87\x8b9\xfb~\x8e\xb2\xff\xf7\x0eX\xa7\v\xa7\xcfç\xf7)\xbe~\xd6\xc1Q\x82\xae1\xe4\x95\xe2R[2\\Д\xfd~{Қ\x82\xe6\xc0\x9c\xf2\xb4\xa11\x17\xa7\xff\xf8mŐ\x1b\xaa\x1eO,G=e\xfc\xb6\xe7\xf0\xabo\xb8#-ܼ5\xb5\x84\x9d\xe9{\nǁ\xc1\x02<\xb8W\xf2\x99'\\\xd2H\x90w\r\xc5JB\xae\x90\"\xa5a\xb1\xae85\xa7H\xb4eD\xef\x1aI\xe8b\xd8sN\x11ƚ\\\xa9Bu\xf7\v\xa9\x1d\xfc\x1c\xa5zm\xa5\x8b$Ҷҗd\rt\xdd؞\\A\xbf\xa8\xe5:֪\xa6\x16\x04\xb5\x96 N\xe0\xa9\v\xf0\xb5\x13\x92\x99\xae\xa4\xf8\xd1\x06\xefx\xdd?q\xd3=K0v\xa3\xa5\xda\x1b\xf6\xf5]\x17L\xd2\xc5\xcc\xee\xa76u\x8e\x8f<,\xf8\xf0A\x17_@\xd7SL\xcf\x18ηa퀦\x947\b\f\x9ek\x91T\x92\xc0|]0ӀS\v\x92\xa5HQr\x05\x9d\xd4Я\xb5I\x1e>\xe7r\xfb\xba\x98\r\x15{\x88\x03\x17n\x9eHy\xf8\xbc\xbam\xb2\xe8\x8d\x1elF\xb3Ϩ\x90\xd5\x01&N\xf1&\x8b\xde\x1d\x9e\xe2/\xc7*f\xf3\xeb\xef\x88Y7\xb8ʬ\xb2F\xe4R\x1e\xa6\x96\x05\xbf\xf5Q\xc5ԜC\xce1\f\f\xc7\xe4Ki֟%83\xe3\x9av(Ā\xafI\x84a\xd7\b\xe4\\C7\x06Ok>\xbb_\xf0ʊf\xcbU>\xf9\x81A<]\xb3Ӳ]\xebr\x12ǖ[\xe3\xc4\x16\xb4\xc2,\xc1<?o\xdbK\xce\xcd\bNM\tZ\xdd\f\xe1e'\xc5\x17\xe0\x1a\xc3\\C\xf0\x9f\xdf\x03\u007f\x13\xecc\xe7\x8e\xd3$\xb1^\xd5A\x03:I\xb8\xf8\xe4\xa3|\xf8\xab\x82\xf7=b)\x85\xc0\x9543F\xbc6\x0f\a/)\x12c\xa1\xb0ɘ\x01\x99\xb9iW\b\\\t\xa5\xac\xaf,1\xd6u{\xbe!\f\x05\x9e\x91|\xf1\x9c\xc7=\x9b\"&\x8a\xb6\xb4\xbc}H\xb3wV]ۈ\x18\af\x9f51\x9c,z\x811\xbcXI\xdet\xf5:s\xff\xbd\xbbR\xae_o\x8b\xf2*\x1b\xb2\xf4\\[Ӟ\xa9I\x1e=&y\xf8\xa0\xb4պ\xa2&_J,\xffԖ,7%s\xcb\xf2B\x94\xf0w\xc5\xc0\xbc5\x8db\xc2\x14nۤ\xd8=\x9aR\xef\xda\xc0y\xaa\xa9X;\xacٲ\xc6e\xac\x1a3Z\x81\x9cg\x98Y\x14,\xb4\xa0\xd1\x11\xcc\xd7m1h\xb1!X\xa8\v\xc2Ȟ$G؆\x06ߵ\xf4\x85EdP-\xd8`{v\x1e\xbev^щ\x05\xbb\xd6k\xde\xf9\x13\t\x81\a\x8d\x064;\x16\x04\xd4:p\xf4<\xfc\xedW]\x16:\x82\xaakX\x9b\x83\xc1\x82\xc1w\f\x8d\xaed\xbem+\xe1\xad\xd8\xe0g\xeb\xaa\x1c\x01Rض\xa9N\"H\x8d}L=1x\x81f\xc7ƘڒC\xa3\xe6d\xa3ٚ\x17n\x8e)zV)\x8f\x9cW\xec\x9fSo<֎\xfe曹\xac?r\x14?\xfb\xa2\xc9T\xad\x194\x8c\x94-\xa2\x88S\xc1\xcc\x12\x1c\x9d\x96\\X\x10\xcc.K\xe6k\x02#5\xa3#\t\x9eg\xb3ڥ\x86bnYҍ\xc4l7⏵\xe1\xef\a\U0008c986\xb7\x86\x19\x852\xd5\x10\xdc4fc\xceh\x15ʹ\x94Ŏ\xe0\xc0\xe1\x98'\x85@(@HZ\x91\x15\xd6RG\xb1Ќ\xa8\x06\x92\x89\xbc\xa0(-\xb6/\xe7\r\x13Æ\xcd\xe3\x86\xe1\x8a\xe5\xc3J\x05k\xb9^\xc6C]Z\x82u'\xe03\a\x15\xfb\xceI>\xfb\xa4\xe4\xb6m\x9a$\x85Z\x1b\x16\xdbp\xe6\x1c|\xf2\xa0\xc3|[\xb2!\x9f\xb0\xb5\xa8\x19+\x1bƪ\xd6\x1d\x86q\xca\xc1i\xc5\xc1\xa9\x94\xe2\xdaq\xdc\xc5\x19֖\x04\x92\xde4\x97\xe1L˥\x95\xf4'{\x1ce1e\xa9\x9c\xe0\a\x9a\x85K\x1ea\"9S\x93\xec\x1cIQ\x12F\v\x06\u007f\x91\x17\x01\xdfT!\xff;ռ\xee\xb3\xfbU\xb5\x927\f\xe4\r\x9dX\x11\xb8\x1a\xdf7\x94\n\x9a81$F2<f'a\x1a-\xc9\xf9Y\x97ZG`\fG\x81\xdf\x04>&%\xfap32\x93\x8e\xf7\xcbyǢ\x1a\xa5`\xaaa\xa8\x87\x82\xbco\xc8ecb\x95\xbc!I\xa1\x19\x1a\xaayk\xb7I\x02\x87\xa7%#k\xd7\xf1\x96?z\x1b\u007f\xf2\xfb\u007fɑG\x9f`<\x90\x8c\x0f\x19\xee\xbc.e\xf3\x88\xb5\bWY\xeb\x15º\x93\xacG\x9c\xa1\x12\f\x965\xa3\x15̓\xfb\x1c>\xf9\xa4\xa4\xe8ڦ>!\xad;z⤤\x11Jn\x1b\x8e\x18+\x18\xd6\x0e\xd9\xe7/\xb6\x05\xb3uA9\x0fOͤ\xfc诼\x91\xfdO\x1c!\x9f\x8c\x10\x1d=\x80\xe3\xac\xea\x1d\xee\xcd\n\x02\xb11,4$\xc1\xacb|8\xc5\xf54\xa3kC.]\xf49SS\\9\x94\"%\x94<\xf0\x147==\\\x88\xa7ǐɢ\xe7\x01o3\x86\x97\x1b\xc38\x82A)\xc8I\x81Ț\xcfj\b\"\f]\xad餆\xf3J\xf2\x11G\xf2\xe9}\x8d\xe8\xfcj\xed^\x95\xf7\x06=\x87c\xd2$\xc3I\xaaW\x1a n\\\xa7\xb8eMB\xac\xfb]&RA\xbe\nq\ba\xcbf\xf5'\xe7$3\x85m\xfc\xee{~\x87D\n^\u007f\xe7O0\xe8j\f\x82\xd8\xf1XS\xe8\xf2\xc6\x17\x1b6O\x18\xdb\f\x9d\xf1f\xba\xb7\xef$\x8bus\xcb0_\x83\xaf\x1c\x97\xd4:\x82\xad\xa3\x1aBCm\x01\x96\x1aV\xa8\xe5\xa2e\x1a\xb4\x813\xf3\xb0\u007f\xb9Ė\x1b\xaf\xe3ɇ\x1e\xe1\xca\x1b\xae\xe4\xff\xfd\xd0\xffÏ\xbc\xf0\xa7\xd8<ZD\x9d?\xbe2\x02\ap\xa6\xe5ш%\x8d\xd4\x10j\xf3\x9e\xbc\xc7;\xbb\x11\x0f\x0eV\xf4\xb6\x91jJ\xe0\x19\xd2Dr\xe6\x82\xcbݛb֗4K]\xc1\xa7N:,wE\xfeP+\xea|\x83\x85\xac*\xa6D\xc0\xdb'\x8b\xdeۅ\xe0*\xe0\n`8Ք\x11\xb41\x9c\xc4\xd00\xb0\x84`\xfeh\xfb\xf2\xf5F\x97i[\xf0\x16G\xea\xc10\xec\x13uZ\x1b\xce\xd64\x93#\x02O\xf6\x89A\xaf`q}\xe4\xd9f5\x13\xd9\xc7\xeal@\xb0\xddl\xb3\xeb\x8e\x1b\x18\x18\x1c\xe0\v\x9f\xf8g~\xeaW\u007f\x86\x0f\xff\xd9\a\xf8\xc0\xbf,\xf2k\xaf\x85}'\xa1\xd12Ln\x15\x8cT\xccJW\xbc\xd60\\\x81\xc12l_\xab\xe9\xc6p\xec\x1cL]\xb4\xf7\x8fT-\x8a;x\x01\x1cWR\xcd\x1b\x8eԋ\xbc\xfa\x17_\xcf\xf57\xed\xe2\r\x9fy\x84\x1f{\xf3\x8fqiz\x8e\xc1\x91A\xe6O\x9f`\x8d\xd7\xefVj%\x92V\nˉ&6\xfc)\xf0\xb6=\x8bQ纲w\xfb\xa5%\xf9_jM\xf9\xbabNo\xaa\x145\x83e\xcdW/\xb8\xe47G\f\xe7\fJ@\xde3;i\xf1\xd83\xba\xac\x9eRV\x95e\x8fd_\xdf\xf1N\xab\xab\v\xdeV\xdf\xe1\xf5Q\x94\xcaˆ4\x05\xcc6\fG\x16$\xd7\x0eg\x99\xa5\xb4\x85\xa4\x89\x01\x98\xabA-\xb5\x89_P\x84\xceR\x8dF\xad\x89\xf0<\x06\xca\x1eo\xf9\xcd7\xb1\xe7K\x8f\xf1\x867\xfd\b\x12\xcd_\xbf\xfdOyǇ\x1c\x9e\x9a5\xa8\xd2\x00\xde\x17\xe6\xf9\xfd\x9f\xb1\xe5]\x95\x8d\x15h\r\xf9\xc0Z\xc0\x89i\xc1`\xd10\xe7\xf6\xfb\u007f\x1f:\nW\xbd\xe8^\x1e\xfb\x97\xafP\xadw\xb9\xe5\x95\xf7r\xeb\x9d7R[\xaa\xb1mr;/\xfa\xc1\x17\xf0\xae?\xfaK֮\x1f\xe7ؑC\x18\xaf?*}\xae-\x99\vA\xc3\x0f\v\xf8\xc4\xc1f\x94\xec\xaez<\xbe\x1c\xcd^[\xf1\xfe\xef0\xe1\u007fF\ryO\xbd-\u007fA\xc0]J\x18>u\xc2\xe1\xba1m\x9b9Rq3\xf4\x15\"\x9f\xa9>\xfe\x9dցW-\x98\xb1yLٓ\x8e\xe4\xe7\x8d\xd6\xebz\xa4I\xce\x11T\xdd^\xbd°\xe7\x82\xe1R[\xae\xb8+\x99\xf5f\xe9\xd4v\x8b\xb89ظư8=ǅ3S\x04\x9e˾'\x9fbh\xfd\x1a\xb6n\x1b\xc7\t\x8a\xec\xba\xe1*\xaa\x9b\xd6sz:\xe1\xae\x1fy\x15\x9f=\xfdu\xae\xfa\x81\xfb\xf9\x8d\xf7)\xe2X\xd8\xd7\xcdr\xa5(\x86\xb7\u007f@\xf1\v\u007f\xe2\xf0\xe7\x9fR+\x04\xe6\x9es\x0ew\xfe\xe4\x1b\xf9\xa3\xbf\xfe\x036N^\x81\t\x8a\xdc\xf3\x83\xf7\"\x95\xe4\xf0\xde#\xec\xba\xfe\n\xa8\x94\xf8\xd2?~\x99ቑ\xcbN\xf0lW1ݕM\x03\xb7\x1cjF\xff\x00$\x00\x8fg9ܾZ\xa4\xa5`\xe1P+\xfa\xfb}\xf5\xe8\xee8e,1\xfcx#\x12\x8f|\xe1\x9c\xeaL7\x05J\x98\xe2e\xa3\u007f\xcf宫^Q\xca\b^\xa5\xa4\xf9e\x81\xb5\x80\x01\x17\xd6\xe4\x05\x1b\xf3\x92 \xabq\xa7\x89\xe63'\x04\xd3m\xdb1\xde\xe9¹\x05\xdb\xc0&%\x049\x9b\x81\x8fV\xe0\xf1/=N\x1a\x874[\x11S'\xcf26Z\x05\x04\x9b\xb6mf\xd3U[Y\xee\xc2\xee;n\x80\xa4\xc1\xaf\xfc\xd6ϒVGy\xd7\xc7\xfa\xf1\xc9Q\xf0\x9e\a\xe1b\xe1V\xeez\xdd+8>\x93r\xfc\xa2\xe0\xf8%H\xd6^\xc9\xdb\xfe\xe0WY\x9e\x9d\xa7:XF\x94\xaa\xdct\xebut\x9am\xde\xf5\xfb\xefc\xf7\xad\xd7A\xb3\x81\x16\x92\x87\xde\xffq\x06\n\xb6\x96\xdeH\x04G\x9bj\x11\xc1k\x0e6\xa3=\xcfF\xa9\xf7\xbc\xced\xd1\xe3P+\x9a\xddW\x8f>p\xb0\x19\xdd\xe9Hv(ɏ\x0f\x04\xfc\xfd\xf7\xa4\xc9aWV#\xb9\xa6\xe4\xedֆ\xbftH\x88\xb5\xe1\xca\x12\xf8\xbeDD\x06\xad\x05\xebs\x92cM[Q\xecF)\x9f;%\xb9g\xa3`\x8b\xab\t%$]ȕ-\"\x13\xc0\xf6u\x82=_|\x1c\xa7\x98\xe7\x15?r?O<\xba\x9f(\u0590\xc6\xe4\xf3\x05\xaeڹ\x99\xaf}\xfa\x8bvk\xb4\x86 \xf0\xf9\xd9\xdf\xfaY~\xefͿ\xc5\xd5_\x93\xfc\xf0\x9d\x9a\xcf\xef\x13|uf\x1d\xef\xfc\xf0\xdbP\"\xe6\xf0\xe3\a8v\xf6(g\x96\xe0\xfd\x1f\xfa\x1d\b#\xda\xed\x0eI\xa2\xd9u\xcb\xf5x\xf9\x80\x93GO\x93\x18\xc1\xbaM\x13,\xcc.\xb2|i\x9e\xb2\x881B\xa0\x84\xe1h\xc3!5\xfc\x8f#\xad\xe83ߪ\xd3\xf3\x99\xee\xdb߈.\x00\x1f\xd8߀\x17\x8f\xbb<4\x13?\xb7\x16r\xa0\x11qM\xd9ې\x1a>\u0ae4\x94&\x86\x8dy\x18\xcaK\xf2\xc26\x93Ia\xf0\x05\xac\x0f\xe4\n\v\xda\n5\x8f\xcd\b\x96\x9b\x82\xe6\"\be\xeb\n\xbd\xb83>\b͙i\xfe\xe5\xc1/\xf0\xa6_y#\xf5z\x83\xd2\xe0\b{\xf7<N\x10\x04\xdcx\xeb5D\xaeO.\xe7g\xb3*0:>\xcc\x03?\xf5j\xfe쓚\xb3\xb3\x82\xf7\xfd\xa3\xe2\xf9\x0f\xdc˦\xab\xb7\xb1\xb4\xb4\xcc\xcb_\xf7\x00'/\xc1\xf3_~/\x9b\xb7mF\x87\x91\x1d\t\x8fcn{\xe1\xf3\xe8ԛ,.,\x93\x97\x86\xf5\x9b\xd6qp\xefS\xf8i\xd7.:\x00\x16#A+\x11F\t\u07b5\xdaU\u007f\xb7\xb7\x9e2\x9e3\x85\xdc6\xe4q}\xd5\xcba\xf8㼛\xee\x88#\xcdD\x1e\xae(BW\n\xaa\xca\xe0*\x93Q\x0e\x86\x8a\ve\xa7O\xc9O\xd54\xa7\x96\x84m\x11\x95\xfd\xaa\xa2]\x8cc\a\xf5o\xb8q\x92\\Υ\xddl\xb1a\xcb\x06>\xf0ޏ\"KE\xc67ma\xcb\xe6\x11\x9a\xf3\xf3\x80\xa0X*b\xb4\xe6\xb6\x17\xdeʦ\xebv\xf0\xbb\xef\x97\xd4\xd3\x1c/\xff\xd1\xfb\xa0\x1b\x12G1\x03#\x03t\x1c\xb8\xfe\x8e\x1bA\xa7$iJsy\x99\xc3\aN\xf0\x92W\xbc\x88V\xab\xc5\xfc\xdc2\xbe\x82b\xa9\xc8\xc1\xbdGP\xd9F\x01\x9b\b*\f\xec9ش\x85\xa6\xe7r\xab\xe9\xbfZ!ו=\xbe\xb2\x10\x91\xa6\xbc\xc9W\xe6U\x8eN\xd9\\\x82k+\xd0\x11\x82\xa2\xb1\x907\xe7\xf4\x87+\x15\x86@\xf6\x93 \x81\xe1\xf0\xbcX!-\x95\xea\xed\f\xb6t\xc8@\x116n\xdfH؍\xf1r9\xf6|\xe9q\x0e\xef\u007f\n\xa4\xdd\x15\xf9\xc0k\xee\xe3\xf8\xe1\xe3\xe088\x9e\x8f\xeb:\x14K\x05\xee\xfb\xb1\a\x98\xe9\x04\xe8|\x81-\xd7\xefBG\x96\x99l.\xd5\b\xca\x05vݰ\x13\xb4\x9d\x00{䟿\x8ar\x1c\xc8\x17i\xb7Z\x1c\xdf\u007f\x14W\tڭ\x16'\x8f\x9da)\x12+\x89gǎ\xe5\x1ez\xae\x16e>g\n\xb9\xbeⱷ\x1eq}ٻ\x17xg^&l\xcc\xc3ւM9\xbbZ\xe0g\xbb\x1e\x02\xd5\x1f\xec\x17\x06\xfcU\nA\xc0bǰ\x1cYJ\xc2Q\xfd\x86\x05ׅ\xf11(\x16}\x16\x16\x96\x18\x9b\x18avf\x01\x9d$\x9cz\xe2\x00\x95J\x99\xddw\xddʑ'\xf6[\xb8\x06\f\f\f\x90$\t\xd7\xdd4\xc9؎\xad8R\x02\x0eQ\x14!\x10\x1c\xde{\x98r)O\xa1d\x01N\xb7\xd3\xe5k\x8f<\xc9\xf6+\xb7B\x12\xf2\xd4ѓ\xb4f삜\v\x17.Q\x1d,\xe3\xe6\xf2\xb8\xb27Q\x06\xc0\x97\xbf\x17K@\xbfk\x85\xec\xaez<Y\x8b\xd8]\xf5\x06R\xc3\xdf\x0f\xf9\x89\x18v\r\xebr\xf6E#\x84\x9d\x99\xcbN\xba\xcc\nX+\x05\xadU\xa8,\x1b3\xe5b]\xac\xfc.\xe7ۦ\xe8\xc0\x83\xf5\xa3\xf0ؗ\xf70\xbf\xd4`fj\x9e\xe7\xbf\xe4N~\xe1\xado\xe4o\xdf\xfdw\xf8\x83\x15\x94\x10\x1c>6C\xfd\xfc90\x86\xc1\xe1a\xd24%՚\x1d\xbbv\xd0iw\x80\x84$k\xc8\nØ\xad;6S,\x16\x008\u007fn\x8a\xf9\xb3\xe7ٰi\ri\xbbã\x8f<\xc1\xfc\x9c\x9d\xc7>u\xf44ۯ\xdcB\xa1\x98\xa7\xec\xa4\x19\xa9\b\xc0\x13\xff\xae\x14\xd2\xc3\xda݄\xb7\x17\\].jͦ\\\xd6\xfa\x93\xb5\xceHaVv[\xe9\x14r\x8eY\xb1\nW\\\xdeΉ\x81v\xafԛu\xaf\xf4\xb62l\x1c\x83\xea\xec\x1e\xde\xfb\xdb\u007f\xc0\xff~ǟS\xccy\xdc\xf8\xfc\x9b\xe8tڜ\xd9w\x98\xf9\xa99\x1a\xad\x16\x9f\xf9\xf8?\x81\xa3(\x95J\xb8\xd9\xc4o\xbb\xd1\xc2\xd1)\xa6\xd1Dk\x8dr\x14\xb3\x97\x96Y\xbfe\x1d^\xd6\x12z\xe2\xf0q.\x9d\x9ee\xcd浜<\xf2\x14\x0f\u007f\xfaKH\xd7Cb\xf8\xe4G>ˎ\xab\xb7\xe0\x8b\x98\xbccH\x8c\xe8\xb5+\x9d\xf9w\xa5\x90\f\xea\xee\xf6\x1c^W!\x11\xeb\xf3\x97\xef!\x91\xd22Ź\x9cm\xf1\xf7=(\xfbY'\x87\xb0\x9d\xe3\xb9UC\x1e\xa96t\x13\xb1\xb2g\xc4hK\x1a\x16|[\xcb\u07bd\x03n\xc8=\xc5\xe6|H\xa9\x94C\x18\xb8\xf5\xee\x9b\xf8\xf8\a\x1fdaa\x89\xab&\xb7s\xea\xc49\x96\xe7\x16A\b\x8a\xc5\"ʑ<u\xf0)0)gN_\xb0\x8d\xd6\xf5\x16q\x1c3\xb1q\xad\xbd^)\xf8ć?C-\x81\xbb_\xf4<\xfe\xe7\xdb\xdf\xcd\x0f\xbc\xecN\xb6\\\xbd\x9d\x822+\u007f\x88@E\x9d\x95I^c\x13\xc0\xe6\xbf\x1b\x85\xbcz\xbbÍ\x15W\x05.\xbf>\xe2\xa4\xe5\x8dy\xab\x00\xbdjw\x95p\xec\xdcy\xae\x98m\x03ul\xa5-\x971\xbf\xc2\xc0\x80s\xd9\x04\x1b˝~\x87\x87\xc1ֳE6\r\xe59v\xc2id\x14*\x95\"\x8e\xe7Q,\x17\xf9\xea\xe7\xbeʇ\xde\xf7q6l^\xcb\xd5\xd7]\xc1\xc7?\xf4)КѱQ\xda\xf5\x16\x8bK-\x92\\\x81K\xe7\xa7Q\x9eñ'\x0frt\xffq\xf6?~\x04\xcfsy\xf2\xeb\xfb\xd8\xff\xf8\x11\xeey\xc9-|\xf0\xaf?Ƒ'\x0e\xf3\x86\x9f\u007f-^\xe0\x91jx\xc1\xfdwr\xfa\xd4E\xf2&^\xbd\n+\x01\xf4\xf7b\xa9\xffw\xac\x90\x97nt\xf8\xc8\xf1\x84\xa1\x12\xb7z\x98\x1fޑח!\xa64k@S\x9e!\b2ZD\xf5G\xc3J\xde\xcaJ\x11\xaaN\xff\x02\x94\x80\xf9\x8eY)߮\xecF\xc9\xfao\xf3Y\xd3t%\x0fż\x87\xe3\n<\xdfc\xed\x80\xcf\u0091\xc3\b\xa1\xb8\xf3E\xb7\xf1\xe9\xbf\xff4K\xf5&\xc5r\x89V\xa3M\x18\x86\xfc\xf9\xfb\xff\x90=_y\x82|\xb9\xcc\x17\x1ez\x94\xedWl⊝[\xe9\xa4)\xbf\xfd\u007f\xbd\x9d\x9f\xfe\xc5\x1fG\xf8>\x9f\xfd\x8b\x0fr\xf3]71\xb2a\x9cv\xbbK\xdbHn\xbb\xebF\x9ezb?W\x8dG+\x93\xb3R\x12H\x01\x83\x81\xf9\xfe+\xe4Sgm`\\h\x8a\xbf]\x13hJ\x9e\xedo-\x05\xb6\xf1\xc0\x176`\x87F\u061d\x88\xd2v\x8b\ba\x89\xbe\xc0\xe9\aw)!'/_\x80Fo\xbd\x9e\xb0,l\xaf\x1d5\x975\xc7m_\x03\x1f{\u05fb\xf8\xe7\a\xbf\xc0ɧ\xce\xd3\xed\x86\xec\x1a\x04a4\x85R\x91W\xbd\xe1\a\xf9o\xbf\xf2{\xb8\xd5\n\vs5^p\xdf\x1dl\xber3\xe7N\x9f\xe3\xc4\xe1\x13\x1c}\xe2\b\x0f\xfc\xf8\xcbi7\xdb\xfc\xfc\x0f\xbd\x99\xb9sS\xbc\xf6g^\xcdP1\xc0\x11\xf0\xba\x9f\xfbQ\xa6\xcfLQ(\x17)\x8d\x8d\x91/\x17Y<\xba\x0f%\xcce\xb3\x8d;\x06\xd3\xe2\x17\xbf\xfbM\xd7ύB\xee[g\x99\x96;FܟS\xc2l\xb9\xa2\x90\x12\x046N\xe4\x02\u06dd\xa1\xb2\x9d{QlQV\xb9\b\xe5\xac˯\x17\xc4K^\xdf\x12\xaa\xae\r\x92\xa9\x81\x82/V\xd6_\xc8l\x81\x8c\\\xb5I\xae\xe0[\xba\xfc\xfa\xe1i\x0e\xfd\xd5;\xb8\xf8\xf1\xf7\xb21=\xcb\xfaQ\br\x01\x02ɮ\xddW\x13\x85]\xfe\xea\x9d\u007f\xc5\xd4\xf4\x02\xb7\xdd}+a;d\xe3\x965\xfc\xc5\x1f\xbf\x17a\x12^\xf0\x92;\xc9\xe5\x03.\x1c>\xc6}\xaf\xb9\x9f\xea\x9a1|\xdf\xc1\xad\x94\xd8u\xe3$\v\xf3K\xc4q\xc8\xdd/\xbe\x85w\xff\xb7?\xa1\xd4<O\xb6\a`e\x14\xae\x13\x89\xbb\xbf\xef1\xe43\x17\x12>\xfb\xa38\xcb]\xf1KW\x97\xb4\x9d~\r,U\xee\x06\xe0x\xd9\xee\xc2Uk\xf1|\u05fa\x19\xd7\xef\xef5̻\xfdq\xb2j\xc6\xf2:\x12\xea\x1d\xcdŦ\\ٯ\xd8\xdbU\xe59\x96:/\xe6l\x80߸\x06n\x9e\x8c\xd8:\xd6d \x97 \x1dX<{\n\xd7\xf3A\xc3\xe6\x1d\x1b\xf9\xb3\xdfx\a\u007f\xf6\xdf\xdf͵\xbb'i\xb5;x\xb9\x80/~\xe2!\xc67\xadg`\xc3:\xee}\xe9\xf3Q\x03\xc3\xdcp\xdbu\xd0j\xd3\xect\xb9\xffu\xaf l4\x999\u007f\x91\xa9\xb3\x17\xd9\xf3\xf1\x8fQ~\xea\x9f\x18+ڹ\x15\x91\xd5ҕ\x80\xa5\xae\xd8\xf9\xbdPȷM.\xf6(\x82_\xfb\x8c\xfb\x8a\x82ú\xf5^\x8a\xe8\xad\xf4v\xfa̪X\x15Lzs\xe4=\xb4\x15\x87\x19\xb5.\xac\x80\xbb\xa9UD\xd5\x11,'vg\xe1\x17\xce)6o\x10$i\xbf\x9e\xb1*\xa5'\x17\x80\x8a\xed\xf3\\\x17\xda\x03\xb6vz\xe2\xf0\x05\xf6=\xba\x97F\xbbI\xbb\xd1\xe4\x8aA\xa8\x19\x1f'\xc8Ѯ/242\xc8`)\xc7\x1d?p\x17t;x\xbe\xc7Ć\t\x82|\x8e(\x8c\xe8\x86!\xbb\xae\xdbN}\xb9\xc1\xe3_\xdd\xcfm\xd5\x05\x86\xc6ҕf\xc0v\xd8k䳉n\xd15\x8f}_\x14\xf2\xf4\xbf\xe3э\xe5\xcb&\a\x92\xbc\x11ٮ[\xd5\x1f\xfa\x17\xab\xd6\x1fy\xd9l\x88Ț\x9b\x03\x1f\xba~ֳ\xdbK\x12\xb3SWu\xa1\x9eX\xe1/44O\x9c\x13\xdcS0\x88\xe2\xaa-=\xbd-<\xc6*s\xf5\x1f@hu\xe1\x8e\xcd5>\xf8\xb6\x9f\xc3)\x8d\xd3^\x9c\xe2\x96-\xb04\xb4\x8d\xbc\xef\xb3\xd0\xe9\x92\xcb\xf9\x18\xe5\xb2\xe3\xaa-\x988!N\x12\xf2y\x9f\xedWl$Nc\x92\xc4\xf0\xf9O~\x9e/}\xfcS\x88\xe9'Y[\x89\x89c\xab\xec(鯓]\x8c$\x91\x16_yh6\xfe\xa7\xdb+\x0e_\xae%\xff\xb6\nYU|z\xa36\xfcz\xa0̖QO\xaf4\x1f\xaf\bfհ\f\x80K\u007fA\x8b\xeb@>g\x05\xe7tm\x92\xe8\xaeZ\xcfWTPp\x04\xcd\xc46U\u007f\xf9\xb8`Ǹ`\xacj\x102\xdb\fG\xbf\xef\xaa\xd7 \xd7[\x10\xd0[\x9fq\xe7\xce.qr\x06\u007f\x13\xd4\xea0\x1b:vj\xab\x1b\xa2\x94\xc0\xf3\fWLn\xcd\x16^:\x10\x87\xfc\xfe\x9b\xdfJa\xe3\x0e8w\x90\x9c[gb\x04\x06\xd6\xc2\xec\x9cm\xd2\x13\xc2^\xb7\xd66v\\\xe8(b\xcdo\x0f\x88\x80\x81b\xf2\x9c\xff\x15\x15\xe7\xd9J\xb1\x93E\xcf5\x86\n\x82\a\xa4\xe0\xf7r\x8a\xf1\x92gp\x8c!\x90\xc6v\x9b\xcbU\xd0\xf4i{\xa4\x841D\xab\x16\xbf\x04\xd9lx\xbed\xad\xc4w\f\"\xb2\xa3\xd3J\xc0\xb0+\xe8$\x86T@\xbb\xab\xf9?O8l\x1b\xb3cq\xa9\xb6\x8a\xeff]\xf2R\xadZ3\x8eM s\x9e\xed\xb5\xea-\xa9\xd4E\x98zl\x1fG\xf7\x1f\xa1\x19v\x98\xbf\xb4@N\xb7X\x9c\x9abϥ9>\xf8\x17\x1f`|\xf9\x10\x1b+\x9ad\xea\x1c믄\x81A\xa8\xd7mk\xe8\xea\x95\x1a\x8dl\r兎b9\x16\x1f\x1b\t\xf4\x97\x0e6#\x1e\xbc\xf8ܻ,\xf1\xf4?\xca2Y\xf4*\xa9\xe1fmx\xc0wx\u0560k&&\x02͖|\xca\xe3\xcb\x0eC\x9eaK.\xc5/\xd8\xc6g\xa9\xfa\xe8)M\xed\"\x978\x82z*p\xaa\x82\x9d\x13\x9a\x89Q;\xf71\xbd`+\x83Q\x17\x1aK0\xd5\x12\x84\xab\xd6qLw5sQo\x14Np\xcbv\xc9\x1b\x9f\x9f\xda?Ԓ\xe5/\xbe\x93)e\x15\x1c\x11\xb2ϋ-5\xfa\x02\\X\x80\xcf\xed\x05U\x1e \xd0ˬ/\x19\x8e\x9c\xb7\xca\xdd6\x0e[\xd6ۭ\x0e\xdd\xd0\xee\xe6\xaa7\xed|\xc7\xcah\x1cv\xc9ͅyh%\x82\xaf.\xb8\xad\xbcc\xee\u07b3\x1c?vc\xc5\xe5\xb1\xdas\x0f{\x9dU\x8a\x18O\r\xbf\x14\x1b^V\xf4ظ!Х\t/\xa5\xec\x1az\xe5\x89\xc5Hre1\xb6\x02\xc8\xe8t\xf94\x9c\xe6\x06V!\u0080\xd1\xc6N+i\x9b\xa9\xe7\x03\xfb\xe1]\xcf\xd2)\x85\xc8\x10&b%i\x1c\xf5%]mh$\x06G\x19\x9e<\xad\x19,(^z\x9d=\xf6\x9e\xd3_\x88,Wq`=\xe1Ii;\xd6Kyk=#Uر\x110K$ں\x9e5ö\x9b=\b`f\x0eN\x9f\xb3\xcbc\xb2\xbdk}כŧٚ\xb5\xbaCu\a\x03\u007f\xbag9~\f\xf8\x9e(\x03\xc0\x99,zW\x00\xbfa\xe0Uk\x8a&ؑKĀ2+'\xae\x17\x16\x8c\x81\x01\xcfPq\xad4\x9eaU\xafm2\xce \xad+\xa1\x9b\b\xba\xb1!M\xec8\x81\x9f\xfd\x89\x89\xf8\xff\xef\xed\\b캲2\xfc\xad\xbd\xf7yܺ\xb7\xaa\x1cWَ\x1d\xbb\x1d\x93\x87\x102\xdd@C\xe8\x96\x02BBJ#Ѣ\xd5-&\f\x10H\x88\tL\x90z\x12!\x8fz\xc0\f\xa1f\x02\f`\xc0\x00\xc1\x00\x89Ǆ4D\x02u\x0f\xe8nE!\x89\x9d8\xddy8\xb1\xe3\x8a_q\xb9\xea>\xcf9{/\x06{\x9f{\xce-\x97!\xadN|\xa5\xabR\x95t\xeb\xdcs\xd6\xde{\xad\xf5\xaf\xb5\xfe_c\xb2x\xa4\x82{\x8b63\x8fg۩R\xb82\x85E\x88\xc4i/^\n\xf8`\xf9\xca\xe7}\x9c\xb7p\xd1o,#\xba\x142\xb7\x18Y;\x1a!\xa6SL0\xa9\xef\xf6ν\x18\xa5\xa1\xf0\xc6\xdbq\x06E\xf5\xc1×\xd3*\x1a\xf1\xbd\x99e\xd2\xc8k\x97\xc6\xd5\xf3\x00\x9f\xdf\xccx\xe9S2\x88=74\xb7O\x0e\xf4s\xbfx\xb4ɞ*\xbc\f\x1e\xf0\xb0\x15A\x8cr$\x8fXTV\xc4(\xcb\x1c\x92\xc9\xf8*F\\\xbb\x8dasMYO#\x04\x8d\x8f\xc7ڼ\x8a\x9f\rMdr\x9bֲl\x920\"\xe4\x16\xc6M7\xf0\xff\x83\x1d\xc1\xab\xe1\xc9\x13\xf1\xe9\xb5\xed\xa2m;gf\xbb\xa2\x96\xa4쪍\x96\xbb\xba\x8aa\xb4f\x98͕\xd7߆\xdbw\x0e\xbfOzA\xca\xd5[0n\x84w&\xb6\xa9\x83\xfc\xd2\xcd\xca\xdf=?\xcaye\xff\xd31\x06\x80y\xf6hS\xfd\xc2F\xc3H\"k\x9c>0\x83TN\x17\x01M\x0e\xdc7\x87\x18C\xbac\xcb\b\xcb\x18~\xbe`)5\x91g\xdd\xd0\xfe`\x1d6K\x18\xa6aM\xd1H\\\xb3\ue113\x85,\x1fLf\x03\xdfzU\xf9\xb7Wm$\xb1\xa9:<,t\b\xec\x12e6-\xab\x90t#\x13y\x993X\x1f\xb0\xb5\x19\x05\xc1\xac\xfc\xdf\xdd37w\xe31vuj\xd9o\xe4O\xab\xc0\xbb?\xbb\xf1\xe9\x8bP\xba\x81h\xf0\x1f\x03#\xb3Y<\xfbC\xe8\"+\r1\x0f\xe9\xaf*H×YdS\x98\xd4\xc2l\x9e\xa4\xf9\xd2QS\xe6\x918߸\x88\x06o\a\xa5\x9eH\n\x93\xe3\x93\xdcp\xc2c\x85\xe1\xea\"Ο;\t\xfc\xc7E\xc1\xab\xe5+?\xe7Yo\xba\x1e\xde\x1e\n\x1b\r%\xbd\xbf'RO[nb\xf3\x02\x1f\xc6,\xaa\xf4\xd9Cv\x85j$C۟\xc0\xf5\xb9\xe1\xda̼e\x85?\xbf4\xa9\x02\x0f\xe1u߁\xd3\xe2J^\x0fЭ61:jI\x85Ű\xd2\xdfڿ)II\xe3\xb1<\xf0\xc1\x9e\x89C7M\x12c\xb1\xbd9\x0e\x8dÛk\x83H\xf6\x92\xa5\xe2V\x9e\x98\xb07\x9d\xf2h\xd1Y\xdc{\xe5\x85W\x02\xdf|\xc1\xf1\xf6\x8d\xeez\xb6\x17eI\xdf\x18\xed\xbc\xbc+\xb0\xe5\b\xbb\xf58\xa6\xd8\\\tiW\x1eF\xea\x19\xfb\xe0\x16\xec\xcc\r\x97\xf7]\xd8\xc8\xf5\xf9K\xe3\xea\xfa\xc3\xd2\xcf5\xab~\x02\xee\x89\xe1\xb6\x1av\x1a\xc3Nm\xb8\xe3\rS\x8d\xa1\x87\xfa\xd8\b\xbd\x98vR\x10A￩e\xf3\xb4\x83\xfdE\xdcI\xd3y\xfc\xff\x834\x0e\xe6z\xba\x1cY\x9a\xed8\xf9\x88&u5\xa5\xb0\xb19b+S\xb6{F\xb1\xa2\xbcy\xad\xe1\x1b\xffd\xf9\xf7W\rM\xc3J\x00\"\xbd\xbc(\x0ew\n\xb6\\C\xb2\x01d\x03\x1a\xb7y\x9f#W\xed\x06H\xdfى;\xe3\xe2=\xc7\xc0\xe9\x0f\xff\xfbn\xfd\x8f#ʇ\xa6\x1d\xbf4\x88uP\x0e\xe1\xccf\xe0\xdcf\xe0\xf1a\xe0\x98SLPv\x1b\xc3\x0f+\xcb$D\x047x\x98\xef\xc3\xdemx\xf7\xce\xea6Y\x92\r\xa7]bk\x98\xd41\xffhq\xad\x15\x83\x10AI$\x82\x8e[C\x8d~J\xa10Q\x19\xe1X\x01[E\xb6\"\xc42\x9dy\xfe\xf2E\xe5\x9b/X>\x1a\v\xb9\xeb\f\xec,\xac\xad\x0f\x19m\x8e\x18\x1c9\x81[?\x868\a\xc3cL\xe6nř\x87\x10\x879\xc7\vx\xeb*\\\xbegyg\xe2ZT\xfa/\x00\xbe\xf6\xe4Ó*w\xc3#1b2\a\xc6?\a밮\x8a\xafa>\xf1\xccgpea\xf9@\xe0\x9c\xf3\x11\xfa\xf0P\xd4ʥ\x1d\xcb\xf9G\xfd\x92/\xdd\xd8$\x8c\"p\xb2\f\\\xb9k\xd9\xda\xf04>\xc1(e\xec\xb55M7\xf6\x9c\x97q\xe7m\x96q\"wo\x92`\x17\x02\x8a\xe1x\x11\x80\x8cۋzY&\x0eA\xf9\xf6\x1b\x81\x8bW-\u007f\xf0\\\xe0K?\x13\xe20g\x99\x93onb\xb3\x01\xe2\\\xe4\x16\x1elCyd\x85*\xbc\xf1p\xe6$\xbc\u007f\x13\u07bc*\\\xdasL\x82`\xb4\x01,\xb3F\xfe\x05\xe0o\xdf\n\x0f\xcd \xf6\xcbOq\xc1Z\\\x1b\xaf\xf7\xa9R%\t5\x16C\x18n\xc0\xf1R\x19\x04x\xb7\xb2L\xbc\x90\v\xac\x19e\xd2\b\xfb\x938\x8b\xddV\a\x9bE\xf4\x1b\xb9\x87+\x95\xe5\xecF`\xb8\xd61W\xb7\x82+\xad\x18\x83M\x0e:\x84X\xf7\x987\x1d\xe4mQ\x14I\f=\x96\xf9\x81sr:\x0f|\xe7r\xe4\xee=w\f\xd6J\xa1p`\xb3\xb8\xf5L1B6\x1e\x037dS\xaf\xe1\xf7n\xe1\x03\x9c9\x05\xdf}\x1d^~\xcfpi\xechP\xbc\xaf\t\xaa \x96&ȟݨ\xfc\xbd\x1f\x85^\xe9\xc76\xc8o<ͅ\xe1\x007H-7y\x11q\xa7<%`Ʀ\xec8Ĥo4\x82\x93\xa5\xe2\fܚ\v\x13\x15rQ\xe6*\xb0\x88\xe0XVF\xa8\xbd\x9aE㌽0̕G\xd6\xe3\x983\x89\xf8\xbee\nZ\x8a\a\xbb\x8e\xea{T\xc0,\x19ŘX\x85\x14\x94\x81\x13\xc4Xf\tޗev\xad\xbcu]\xf9\xf6eû\xb7\x94[\xbb\x01\xdfԜX_`[xx\xb0Eؿɺ\xeep\xfd\x0e\xfc\xeb\xf7\r/\u007f\xe8ة\"\xa7}\xdd\xf8\x1eU\x8a\xa5\xc8\xe4\xd5\xebs\xff??*\xe7Տ\x85e\xfd͗\x99\xe5\x8e\xd2\xf4\xd4\x05ڐ\xb6\x0e\x1d\xdfy\xd3\xc4P\xb5\xaeSt\x15\">u\xf7#\xa8U\x98!\fQJQ\xb2\"\xee\xaaq\x92\x9a\x98\xa80w\xc2\x17\x9e\x0e<r$FE\xe3I\x04\x03\xa7\xb3\x0e\xb6 %\x82\x8bi\xc7Er\xf5.,j\xe9j\xf6\b5\xb0\xdfX>\x98y\xbc\x86\x15P\xb3\ruQakC8\xbdm\xf9\xdc9\xe1\xfc\xe3\x96\xcf\xfe\xc4\x1a7w\xf6\xf8\x87\xff\xf2\xbc|\xc50n`Z\x85%\x1d\xb8\x91v\xcaV\x126$\xf7\x14\xfe\xda\nϿ\xba_\xd5\a\x01\xd8O\xc5 \u007f\xff\x9b̲\x8c\xf2`ԡ}\x99\x87\xa6\xfb}VE\xb6\xe9\xf6\xc6}\r{wb\x04\xb6\\\xb1\xbd\x9cD5\x1a\xf6\x06\x96g\x1e\xf7<v*\t<\xce\"\xa7\xc8\xde8\xfa\x93>˨o\xe2\xeejiů\u007f$\xb4\x8bS\x93Q<\xb13\xf2\xfdi\xa0\x0e\x0fN\xa4|\x12hY_\x13\x9a\x10\xa32g\xa2rZ\xab\xf2f\x80Q\xe6X\xcb\x1c\xe3\xbaf\xbf\xf6\xa8d\xac\x17\x81\xca\v\x957\xef\v\xfc\x9d\xc2_\x19\xe1jf\xf1/\xedV<s4gZ}\xb2\xed\xa4\xf6w\u007f\x9e\v\x99\xc3Ywx\xf8hL7\x1a\xa0IC\xa3Ȼ\xf3\xdfX\x18\f\xa3aBs8\x01\x97\b\x8ck\xa1\xc8\xe0\xf8\xd1\xf8?\x8c\x89\xbb\xcd$\xdew\xdf;\r\xdaLާ\xdce\x98ì\x8e\x89\xe3\x12\xafJ\xe5ԑ3T^X\xa8\x1ez\xedV\x90\xb8ib\x10 \x92ڐ\x92!֬p\xactld\x82&v\xeeڇ\xa8\xb2l\fU\xd5Pd\xbai\f\xcf\x02\u007fd\x8c|\xd1+r\xaa\xb4{/\xedV\xbb7+ϯ<\x9aqe\xfc\xc98~\xfb\xfb_\xe4\x825\xb8VA\xb3Mڼ\xbf\xdf0\xc6t$\xc6m\xf8ڒ\xfc\xe7\x83\x14\xb9T\x87\x03u\xf3 \xf8\x06\xb6\x8e\xc4D\xb05\xf2\x1b\x1f\x1a\x1e\xddХf\xed\xf2A\xa60\xd6'\xa3\r\x8bx\xedY-\x1d\"\x90\n:#\x171\xb0\xa9?\x14\xc9Y\xb9\x8fv\x97\r\xac\xe1Xn\xd8\xca\ry\v\xb1\x88\xe2\x11\xaa\x10\xd5\f<\x16C\xa0i\x14\xef\x03V\x14U}\"3|5 _:5\xb0\xec\xcc\xfd\xf7\xaf\x8c\xc3'\xe6c\xec\xef}\x81\v\xce\xe1\\o\x87d\x89~\xa8\xe9qJ\x99\xd4t\xd0\"\xa7b\xba\x95^\xd5\x1d\x86e\x1d,f\xf7\x03w\"po!\x8cre;\xb1B\xe4\x19\xdc܋R|\xc3\"r\xa7\xaf|\xb94o\ue376BU\x00\x00\x04\x96IDAT\xebx\x9d\xb5\x02,\xb2\x14a\xe9'S\x03'\x94V\x98x]Qs\xeb\x1bG\x80\xcc\x18\xb6sˉ\fJ\xdbΛkR\xfe\x89>PQ\xc6M\xcb\xf8`\x11\xc2\x12VѤWh\b\xdbb\xec\xaf\x1d\xcf\xed3\xa7\xd7\xe4\x9f_٫?\x11\xc4\xd1\xfe\xe1\xb3\\0\x12\x1bĝ\xebV\xae\x91X\x85kwEK\xb6b\xd3\x11\xd6\xfa\x906!k\x8d⊘\xe8u\x83\xbe\xa9\xb0$p\xc3\x1bFA\xd9،0\xb8\x86\x98\b\xbey\xd3p|\xbdU\x95>\xf0\x05ӵڅ\xb1V@.´\xeei\x12\xa6\xfaHi\x85\xf5\xac+%GҴ(gg\x05rk8\x96\vG\x9d\xa6\x96\x1eř\xf8\xceP\x1c\xba$\xbf\xd9k4\x86\xbf\xc6%|M\xefü\x82\xf7\xe2\xac<\xed\x83\xf9\xe3c\x99}\xee\xf4\x9a}\xe23C{o;\xb7\xe1\x97\xcfH\xf3\xfaG\xcbN\xae\x8f\xbd\x83\xe4\a\x17\x98\xd5\re+\rׄ\x9e\xf2Y\"~i\x12纯;\xa8\xa4\xf1\x89).ͅ\x8f\xa7\x91Ⱦ]\x8e\xf5\x1c\xc6w{|\xb9\xc0Nc\x18\x19\xe5\xa9s\xca\xd9ӝ\xb1\xaf\xdd\x15\xee\xeeG!\xf8\xe94:\xfb\x83A\xc6bړ\x94\x00\x16s\xb83\x15&ͪ\xb6T[}\xacB\xec\xf7\xac\x82&b}\xc1Iԍ\xd2d@#=R4\xba\x13\xa2Vxs\x12KЈA\xc5\"\xa1\xc1\xd0\xf9\xa9\x16i\x8e\x10\xbf\x01\xb1\x04\x84\xcc\x04\xc0\\5\x86\x17}\xe0[\x8b\x86\xef]\x1aWo\u007f\xdc\bM\xae|\x83\x99@\xb9\xa8:%\x82\x90\x141C\xca\x17\xe6\t\xf6\xa8}|(\xad\x94P\x9d\xb4fCR:؟D\x15\x9a\xf6U/`\xff\xce\xea\x83}/X\xce?\xe2y\xe2\xc9H\xb5\x04\xf1z\xaf]3\x1c-\x95\xc2*\xe3\xd9\xfd\xc7\x17I\xfb\xa3\x058[\xff2\x9b\xc7\xf2\xean\x95\x14\xd4L_>j\xb5п\xac,\xa6\x00\xa0\x15:n\x85#\xe9=\xec\xcbce\x1e\xbaC\xd1HjKj\xa3\xd0\x04\xef\x04\xd1DT \xe4\x99ň0[xr\a\xce\xd9z\xd1ȇ\x8d\xe7;F\xf8\xfak\xe3j\xe7\xffŲZ\x19\xeb\xb52\xbe\x9dI\xad=\xa9\xf9 sq\xa0\xdf\xdaX\b\xcazE)g;\xc8E\xa4#-\xee\x97s\u05f7:gm\x05JQ\xf6\xa7\xc2\u07b8'\xd2\"\xf0ӧ\x03wg\x82\x98\x8e\x97ꠇ\xce\xcb\xf8\xee\x97\x04\x06\x05\x1cɕό\x94\xf5<\xe9\x16.\xc5\x00\x14\xd1\xeemT\xb1\xaa\x98\xd4\x11\xb9f#\x15mn\xd2Q%\x0f\x88\b\bK?u\xa2\x80sC\xf8\xc9\x11\x9c\x1d9\x8e\x0fJ6\xf2\f\x01\xaa\xaa\xa1\tJ\x9eY\x9aF\x19O\xeb\xccj}fT\x84\xdf\np\xf1\xfc(\u007f\xee\xfc(\x86\x10\x0fB\x8f\xed\xd7\u007f\x95\v\x80k+oe\x91 \x8c$\xa6\"\xa9V\xedR=\x9b\xe4\xd8C\xd3\r\xe5kOk\xe3\xa0\x06\xadK\xa4\x94\xd54\xae\xae\xa1(\xbbb\x18\xe5Q堅RTa{\xa4,\xeax\x9d<O\xa1\xea\x81\x14\xc3e\x9d$jk\x94vY\x97\x16\x8a,:jt5\x8c_\xae\xc0Ĺ2t\x8a\xebi\xebJ\xea\xeen?s\xab괫\xbc\xc2\xd95ǙR\x19Y\xc8M\f\xb9\v\t\xd4j\xb0Ʋ]\x18\x8c@\xd5DJr\xe7\x1c\x16\xa5\tʢ\n\x94\x99\x0eD\xcco{\xe5Ɖ\xdc^\xbe8\xae\xaa\xc3\xfc\xca\xd2 \xfd?\xe6Y\xcfq\xe9*\xb4\u07b2@\x87td\xf5\r\xa2\xd2\xf9\x9c\xfe9\xd1\xe6\x15\xf5\"\x9d\aet\xce&Q>9\x17?\xd3\xf2\x93\xb4b\\E\xd6#\xd6<\x10}\xb5\xe4\xfa\xc1'Z\xdat\x9e\x18\x8d\xf3\x8c\x03\x17\x1f\\&q|.3\xf1g\xe1\xa2\x1f\xc9M\xb7kM:\xd9L\xfa|P\xb8]w\x06\xc9\r\x9c\x1d\xe8Js^\xfb\u038d2U\x13\x89\xf9]`3\x03\xa7Qy4\x18\x93\xe8=\x14\r\x8a\x10\xc83\xf3뵗\xcf\x1e\xcf\xedw/M\xaa\xbb\a\x8d\"\xd7\xff\x84]\xa08l\xfb\xb4\xca\xcbU\"\x0f\xf3\xadsO\xdd|\xf7\xf6;\x88\xa3m$[T\xb1I\xed\xb0W\x93J\xb9\x9a\xc5\xf3\xbd4\xca\xf1\xad\xa8]\x184\xc2(m\xf8=I\x90\x8aj\xf7=\xee\xab\xf3'\xf8&4\xdd\x02\xaa\x17].\xb4\x14!\x0e\xddٿ\x92\x97\xf4~\x1a\x1b\xe7\xe3\xd5D\x86\xeb\xef\xed\xc08ErC+\xfc\xd4z\xef\x14k\xe5\xbc[_\xa9\u008e\xcf\xc9$p\xc44iW\x19\xf6T\xb8UI\xee\x8c\xd7\xe9\xdcW\x92((\xcb\xccم7;!\xf0;\x17\xc7\xd5\u007f\xf6\xef\xe9\u007f\x01Y\xd5*'\x88\xc8\xda\x01\x00\x00\x00\x00IEND\xaeB`\x82")
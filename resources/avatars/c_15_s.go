@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_15_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14$6\x03\x9b\x8d\x88\x00\x00 \x00IDATx\xdaԽy\x98e\xd7Y\xde\xfb[k\xaf=\x9d}\xa6\x9a\xab\xabz\x94\xba[Rk\xb4$[\x96\xe5A\xb6\xc1\x18<O\x18ȵ\xe1:\u038d!\tO !!7\x0e7\x10B\xc2\xec0\xc7\x18\xae\r\x81\x10\x1b\xc35\x10\x88q\xb0\x85dɶd\xcdC\xb7\xa4\x9ej蚧3\xefi\xadu\xffػNwi0\x0e\x8e\xb0\xbd\x9fG\xaa\x96\xba\xce\xd9\xe7\xeco\xadox\xdf\xf7\xfb\x968r\xc5Kv\x00\x1f@\xeb\fc4:ϰF\x03\x12G)\x1c\xe5\x12\xf7\xdb\b!\x01\xb0֠\x94\x87\x90\x0e\xbd\xce\x16F\xe7<\xf3r\x94\x87\xe7W\xa86ƙ\x98>\x821\x06!%K\xe7\x1e\xa5\xddZ\x1d\xbe\xd7\xee%\x84(\u07bb\xb8\x01\xca\rP\xca\x05kOK\xe5\xfe'k\xf2?\xab\xd6'r\x84\xf8i,or\x94\xfa\xa9\xb8\xdf\xff\xf5\xf3Oߛ\x00\x1c\xbd\xfa\xe5\x9c~\xfc\xf3\xfc\xaf^\x97_u+3\x87\xaf宿\xf80\x00o\xfa{\xff\x0f\u007f\xfa{?\xce\xd7\xe3\xfa\xf9\x0f\xff\xa5\xa3Jc\x04\xd6Z\x84\x90\xb8Ja\xf2\x8c,\xcf\xd0y\x8a\xb5\x86əc\xc4\xfd6֚\xe1\x8b\xf3<\xc5Q\xde\xf3\xbe\xb919`\xe9l\xafPoN\xe2\xfa\x15\xac1L\x1f8A\x92\xf6H\x06ݡQ\x1c\xe5\xa2\xdc\x00\xd7\xf5q\xbd\x00c\fVk\x1c\xe5b\x8c\xb9F\xe7\xe9o\nWe:\xcfN[k\xaf\x12R\xa2u\xfe\x8b\xcaU\xdfs\xec\xeaW|\b!>\xf5\xf4cw\xf6\xae\xb8\xeevz\x9dM\x16\xcf=\xfaU?\x84\xa9\xfdWp\xd7_|\x98W\xbd\xf1\an\xa85\xc6O\xff\xe9\xef\xfdx\x97\xaf\xd7eA^\xbaB\xa5t@H@\x90g\t\xd6\x1at\x96\x81\x85 \xac\xed\xaeߋ\xaf79\x02\xf1\xdc\xefm\f:\xcf\x11\xc2!\x8d{\xc8\xf2\xe1\v)\xd87{\x02\xd7\xf5\x87ﷻ#=?BJ\x85\xeb\xfaxA\x05\xe9(\x94\xeb\x13\x845\xfc\xa0\xe6JG]%\x1dU\xee&\x8b\xb5\xf6\xc5֚\x8fY\xa3\xef\xb8\xfc\xaa\x97~ۓ\x8f\xdc\xc1\xe2\xb9G9t즯\xfa\x19\xdc\xf3\x99\x8fr\xfb\x1b>\x10l\xaf\xcd\xffB\xaf\xbdu#\xc0\xd1\x13\xb7}]\xec\xe18\x12\xf9\x1ck\x1b\xe5\xf9H)v-\xc5\xf6\xe6\"\xd3\a\xae\xc4\xe4\xf93v\x81\xe5y\xecQ\xee\xa2\x04\x84\xa0\xd7\xdd\xc1\\\xb2\xbb\x82\xa8\xc6\xd8\xe4\x91=n+\xcb\xe2\xe1\x0e\xb4\xd6\x02\x02!d\xf1\xf0\x87\xf7\x10\b!0\xda\f\xff\x1eP\xc0\xcdB8\x9f\xba\xfc\xaa\x97\xfe0\xc0\xdc\xd3\xf7\u007f\xd5\x0f\xe1\xc0e7\bc\xcc;\x1cG\xbdz\xfe\xf4\x03\x1f\x038\xfd\xc4\xdd_\xb7M\"/>\\\x8d\xb5\x96<K\xe8ww\x90\x8eW\xf8}!\x18\xf4v\x90R\xd1\x18\x9b\xd9㶬\xd5e\xacy\xeeK\xe7\x19F\x17\xab\xffR\xbbYk\x19\x998@stv\xb8K\x8c\xceɳ\xb84\xc23\u05c9\xd8\xf3gGy\xc5n\xde{\xf9B8?\u007f\xf4\xc4\xcb~\xf3艗\x1d\xff\x9b\xbe\xf8K^\xf5n\x00f\x0e^\xbdO:\xeaC\b\x81\x10\xe2\xf0\xd1\x13\xb7M}\xbd\x8c!\x84@Zk\x10B\x92\xc6}\xba\xadM\xd2$&\x8d\xfb$\x83.֘2\xc8\xc2\xd2\xdc\x13L\xce\x1e\xdf\U000f0110\b)\xbf\xe2\r\xd2d@\x92\xf4鵷\xf6\xbc\xd6\x18\xcd\xe4\xec1F'\x0eaL\x8e5\x86<Ϟ\xc3\x18\xcf\xe3l\x9f\xff\xae\xef\a\xee:v\xf5+\u007f\xe2\xe8շ\xddt\xf4\xaa\xdb\x04\xc0\xb7\xbc\xed\x87^\xff\xbaw\xfe\xc84\xc0\xeb\xde\xf1ϸ\xf7\xaf\xff\x1b\xfbg\x8e\x86~X\xf9\xb8\x10r\\\xebl\x18V\xben\xbbC\nd\xaf\xbdI\x12\xf7\b*u\xb2\xa4ˠ\xb7\xb3'\xeb\xc1Z\x84t\xd8ژG\nI}d\xbat)\xe5\x9b8\xeeW~t\u0590\x0e\xbalo.\x92&\xfd=\xab\xddZ\xcb\xd8\xd4aƧ/\xa7ژ\xc4\xe8\"\xc3\xfb\x8a~\xf0\xab[k\x93\xd6\xea\x0fb\xf9\f\x82sǮ~\xc5'\xba\xad\xf5\xffWg\xf1}oy\xefO\xdc\xfe\x97\x9f\xfcy\xae\xbc\xee5\x8d\xcb^\xf4ڿ\x90\x8e{[\xb1;\xd3\xdd\xd7N}=\xe3\x88\xd8\u007f\xe4\xdaA\xaf\xb3\x1d4F\xf71\xe8\xb5\xe8u\xb6J\xbf-\xc0\xda=\xabrj\xe68cӇ\x98?\xfd\x00\xfa\x92T7\x19t\xf7\x18\xe9\xb9./\x88P\xcaej\xf6\n\xfcJm\xcf{\v\xe9\x90g\t\xab\x8bO\x12\xf7[\xd4G\xf6=\x97K\xfa\x1b\xb6;\xcf2\xa4\xbd\xe4_\xd6\x1a\xa4\xa3\xa8\xd6\xc7\xf1\xfc\xf0\x8f\xbd\xa0zBJ\xe7\n!\x04y\x96\xb1\xb1r\x86<K\x00Ξ~\xe2\xee˿\x1e\xc6\xf8\xa5\xdf\xfe+G\xb9^\x88t\xda\xe4YL\x9a\xf4J\x97a\xcb\a&.q\x0f\x82\xcd\xf5y\xa6\xf6\x1f\xa7Z\x1f\xa7\xb5\xb5\\>\x05\x81r}\xb2t\xf0\xbc+[:\x0e\x95\xa8\x81\xeb\x85,\xcd?\xc1\xd8\xe4!j\x8d\x89\xa1\xbb\xb3F\xe38\x8a\x03\x97\xdf\xc0\xce\xc6\x05:\xadu\x1cG\xa1\xdc`\x18Al\xf9Ѕ\x90HG!˟H\x81DR\xa9\x8d\"\x10Xk\xb1V\x17I\x84\xb5\x18m0&G\xeb\f\x9d\xa5\xf4:\x9b\xb4\xb7\xf3\xb7\t)\xf0\xfd*~\xa5\x8a\x14Υ\xb5T\xed\xe8\x89ێ\x9c~\xe2\xees_\x8f\x18\xa2\x00\x82\xb0A<\xe8 \x1d\x85ȳK\x16\xaf-}\x9b\u0098\x9c<KX_>\xcb\xd4\xec\x15\xb4\xb7W\xb1X\xc0\xe2(\x97<K\xf7\x04\xfc\xbdn\vҤϾ\x03'P\xcagc\xf5\x1cY6`l\xf2\xf0\x9e\xf5\xac\xf3\x94\xfa\xc8\x14AX%\x1et\xf1\xfcJ\x91\x12c\v\xa3X\b+5\x1c7\xc0q\x1c\xa4r\x11e\xe6e/\t-\xe2y<\x9e\xd1\x1acr\x8c\xceIӘdХ\xb5\xb1\x04\x14ny7\t\x04\xae\x00\xbe\x0e\x06\x01\xb5\xb3\xb5D\xb5>Q\x04pk)\nD\xb1\xc7\x05\x15E^q\xad-\x9ffl\xea\x10\xfb\x0e\x9e`\xf1\xdcC\xc3\x18\xe2\xfa>ɠ\u007f1\xf6\xec\xa9I4\x83~\x8b\xb5\xa5\xa7\x999t\r~Xe\xf5\xc2S(\xd7gl\xe2 \xc6X\x1c\xc7)\xea\x9f<\xc5\v\xaa\xf8A\r\xe9H\xac\xa54\xb4\x1dn\x93\xdd\xcfg\xf2l\xe8\xf2d\xb9ۤt\x86Ɇ\xb5\xb6\xdc\xec\x1ac,BJ\x1c\xe9\xe1(\x0f\u05ef\x10\xd5F\xb1\xd6\xd0\xefl\xd1im\xec.\xa8\x00\xb8\xec\xeb\x15ؕ\xc9s\x92~\x9bJm\x94\xd6\xe6\xd2\xf0\xcbJG\xe1H\x87\xac\xf0\xab\x17\xeb\x85d\xc0\xd2\xdc\xe3\x1c>\xfebZ\xdb\xcbtۛE\xba&\x14\x8e\xe3`\x8cy\xde\f{k}\x81\xa8>Fst\x06\xe5\xfat[k\xb4\xb6W\xa85\xa7\xb0\xb60\x8ar=\x84pJ\x03]L\x9f\xa5t\nWU\x16\x86F\xebb\x87Z\x8b\xd6yY\xd4\x16\xdb\xdeq\\\\/D\xca\"\x85\xb6\x94\xaeKg\xe8<#\xcfӡ\x8b\x12BRmL\x12\xd5\xc7\xd8\xd9\\b\xd0ۑ \xaa\xbb\x81\xfd\xef\xb2&q\xa4DZ,IYI\x87Q\xa3\xf0\xbdB\x14َ\x90\xc3\xfd/\x1d5\xfc\xb9\xb1r\x8e͵9\xa6\x0f\\Y\xe0M\xa5\xa3\xffJP\x8a\xc0\x12V\xeah\x9dcm\xf1\xe7\xf1}\x97\x11F#E\fQ\x1e\xd2qQ\xca+\x1fd\xf9`\xfd\x90Ju\x84 j\xe0\xfaa\t\xb3x\xf8aD\xa5ҠRmR\xad\x8fS\xa96\t+\r\xfc\xa0\x86\x1fV\xa9\xd7\x1b\x8c\x8dMPo\x8c\xe2\xf9\x11\x06A\x96\xa6\xd4G'KxF\x17\x9fŘrg\bF&\x0e\xe2\x875\xc7\x1a\xf3\x83GO\xdcv\xc5\xe9'\xee\xfe;Ͷ\x1c)Q\x02\x81\xd69\xfd~\x8bZc\x9c4\xee\x96\xf5\x80(\x03\xf5\xc5\az1H+\x16\xce<ı\xab_\xce\xe8\xe4!֗\xcf\f1)\x9d\x17\x00峀C!\xf0\xc3*\xcd\xd1}\x17c\x8d\xb5\x84a\x15\xe5\xfaE2!(wJa\x18\xa5\x14B:\x85{29\x8e\xa3\x8aD\xa0\f\x18B:\x18\xadIӄ \xac\xd0l\x8eQ\xad\x8f\xe08.`\b\x82\x80J\x180\xd2\xf0\x18\xad\x87\xdc\xfd\x85{h\xb5cZ&\x1b~\x06cr\x1c\xe9\r]k}d\x1fi2\x98\xb5&\xff\xf0\xd1\x13\xb7\xbd\xf5\xf4\x13wo\xff]\xed\x14\xe9\bā\xcbo\x18\xecl\\\b\n_,\xa1\x8c#E \xd7_\xb1\b\xf3\x83\x88\xe3\u05fe\x92\v\xe7\x1ea0h\x03\x02c\fi\xdc\xdb\xf3{AX\xa5>2M\xb51I%j\f\x8d\xe4\xba\x01\x8e\xeb\x17\xeeH\n\x84tP\xca\xc5u\xbd\xf2\xae\x16U\x06\xee4K\v7e\x8b\x14v7ƹ^\x85\xd9\xfd\x87q\x94d\xe5\xc2\x19Μ\xba\x8f\x8d\xb5\x05\xfaݝ\x02,u=^\xfa\xaa\xb7\xf1\u007f\xbd\xff\xbd\xb4[;|\xf2\x8f\xff;\xcb\xcb+\xb8~@\x96\xc4\xc3bt\xd7\x03 \x04\xe9\xa0\xc3\xd6\xfa\"֚\xc7N?q\xf7\xb5\xbb\xdf\xe3\x15\xaf\xff\a\xdc\xf5?~\xf3\x053ȇ\u007f\xefNG\x16\xc5\xdeT\x11;\xcc\xc5/j\x8d\xa6\xd6\x1c\xff\x8aEZ\xdc\xefp\xee\xc9{\x99>x\x02?\xa8b\x8cFJ\x89\x17D\xc3 \v\x16c-A\xa5FT\x1d\x19~iϯ\xa0<\x1f!\xc0Q\n\xe5\x05x\x9e_dOR\xe28\x0eB\b\x92x@\xaf\xd7!M\x12\xf2<C\xeb\x8c]d\xba\x80z\x06<\xf6\xf0\x9d|\xf6\xcf\u007f\x97;\xff\xf2\xf7\x98;\xf30\x83^\xbb\f\xee\x82<\xcf\xf8\xebO\xff\x1e\x9f\xfd\x9f\x9ff||\x9c[_v\x1b\xb5\xe68:ϙ\x98>0\xc4\xef\x86\x10\x90\xb5xA\xb5\x04?\xb9\xe6\xe8U/\xfb\xc0\xdf)\xc0\x18\x84\xb5\x0f&\x83\x8e2\xcf¤,Fk\x84,\f\xf5|ys\x96\x0e\x18\xf4v\x989t\r\xc6\xe4E\xfa,\x1d,\x02\xa3s\x84\x90\xf8a\x95,\x8d\t\xa2Z\xe9N@ Q\xae\x8f\xa3<\xac\xd5\x05\" \x1cL\x99\x11\xe5yN\x96\xa6\xe4y^r)\x05\xae,\x85C\xaf\xbd\xc9\xc6\xday6Vα4\xf78\xabKO\xd3m\xad\x835CC\xec\x85$\x1c.,\xafr\xfc\xca\x1b\x98\x9c\x18\xa3=p\xd8\xdeXA)\x0f?\xa8\xd0붆nU\x88\x02\xb4\xd4F\x17(\xb5\xa3\x8e\x9f\xb8\xf1u\xaf8t\xf4\xa6\xe6]\xff\xe37\x1f\xb8\xe5\xd5\xdfÅ\xf3\x8f\xbe \xc6x\xf3;\xdf'\x1d?\xa8|0Kc\xf5\\d\xd1.\xe0\xf8\xdcƐE\xf0\x95\x12)\x1c\xb2,fj\xf68\x95J\x93vk\xad\\\xe5EV\xe4\xfb\x15\xfc \xc2\x0f\xa2\"^\x94\xd9O\x9a\xf4\xb0\xc2R\xa9\x8e\"\x05hm\xb0\xb6\xb8\u007f\xbd9ơÇ\xd8?;C\xad1\n\" \x8d\xfbh\xa39\xfd\xc4\xe7\xd9\xdeX\xa0\xdfo\x91\xa5\x05J<D\x17\x9e\xe7\xeauZ8\xe1\x147\\{\x9cŕ\x1d\xfc\xa0ʅ\xf9\xa7\x8b\x8c͚\";\xbc\x04UVʥ\xdbޠޜ\x1c\xf5\x83\xe8\x1a\xac}\xf3\xf8\xbeÿ\xf2\xe5;?>x\xa1vǛ\xdf\xf1>)\xb3t\x00\xcf\x00\b\xf7\x18\xe19\f\"\xa4D)\x97\xa0R\xa3\x125\xf0\xc2\b\x9dg\xac,\x9c\xc2\r*L\xce\x1eC\x88\xa2B\x97\xd2!K\ax^\x80\x1fTwAt¨N\xad9\x85\xab|\xfa\x9dm\xb4\xb1\xc3\xd7\x04\x95\x06R*\x06\x83\x8c\xa9\xc91\xc20D)\x87\xa0\xda\xc0\x98\xbc0\x821{\xe1\x17\xe1P\xaf\x86DQ\xa5ء\xf6\xd9\xdc\xcdC\xf7\xfd5\xbd~\x8c﹄Q\x9d\x03G\xae\"\xcf2\x94\xf2q\x1c\xb5\xe75\xca\xf5\U000432286*\xc2J\x8d\x91\x89\x19\xaa\xb5я\xbe\xe0.\xabޜ\xfc\xa0\xceS\xf5\xbf\xf2\xa20\xaa\x13Tj8R\x15\x0fQ\xaa\xe1\xce\xeav6\t\x82*~\x101跇. M\a\xb8na\x14k5Y\x1a#\x85\xc4\r*(\xe5\r\x1f\x88\x1fVQ\xca\xc3Xȵ\xa6ۋ\xa9\xd7\"\xda\xed\x0ey\x96\xa3܀4\xee\xa2\xf3t\x17{\xc2\"x\xf1\xf5\x97\xf3c?p+/\xbaj\x9a\x03\xfbFXXޡ\xddMpJ^GH\xc9\xce\xd6\n\xcd\xd1Y\x9a\xa3\x93\xb4;}\xfc\xa0\xc2\xd4\xcc!\x82\xa0\x82\xa3\x14\xfdn\xbb0\x86r\xb1\xd6\x10V\x9a\xb8~\xc8\xfe\xc3\xc7\x19\x9b\x98\x01\xd8\xd7n\xb5\xfe\xa0\xbd\xbd\xbc\xf3B\x18\xe3\xad\xefz\x9ft\xea#S\x1fL\x93\xbe\x12\xe2\xabCX\x8b\x80\nJ\xf9H\xc7\x19B\x0e\xd6h\\/dr\xe68~P\xa1\x12\x8d`lQ\xa1\x83\xc0ZM\xdc\xdf)\x19\xc0:\xd6\x1a\xf2,A\xb9\x1e\xae\xe7\x17\x95\xb9\x10T*u\xa4\x94e&\x05\xc6d\xb8\xae\xa2Z\riw\xfb\b$\xa3\x13\ah\x8c͠\xb3\x94vk\x95L;\xfc\xd8\x0f\xbc\x94\xf1\x86\xa2QS\\{l\x94+\x8e\x8c\xf3Ň/\x90\xa4z肥\x14\x9c=}\x92\xe9\x83\xd7\x15I\x87\xb5H!\xa85FH\xe2\x98vk\xe3\"\xb7o-\x8d\xd1qf\x0f\x1e\xc7\xf3\x03\x94\xf2X[Y\xd0R\xca;\x97\xe6\x1e\u007f\xea\x850\xc8[\xde\xf9\xf7\xa5\x8c\xcbU\xfc\xd5^\x9e\x1f\x12F#Ȳ\x92\xb6\xd6bM\x11\x88\x93\xb8\xc3\xda\xd2S\fzm\xac\xb5Lￊ\xd1\xc9\xc3\x05\xdaZ\xd6;kKO\xd1n\xad\ry\x8f^{\x93\xb8\xdfAH\x81\xe3\xfaE\xd5]\x82\x88\xd6j\xb2̲\xb0\xb8\xc2\xc4x\x83o{\xcd͌\x8cԑ\xb2@m\x0f\x1e\xbb\x99щ\x03\x18\x9d\xe3)A\x96\x1b\xf6O\x8fs\xcbMW\xf2}\xdf}\x1b\xbf\xf4o\xdfI\xadZ\xd9\x13\xf7\xfa\xfd\x16\xe7\xcf<\x86\x14\x171\xb0\x8d\xb5\v<\xfa\xc0_\xb1\xcb\r\xe5yJ\x14ը֚\xc4q\x8f͵\v<v\xff_\xd1mo\x12\xc7}\xfb\x82bY\xfa9\x14#\xcf\xc9dI\a\x84\xc0\x98\x9c4.\x80\xbf!\x8caA\xb9\x85\xdbq\xfd\n\x9d\xd6\x1a;\x1b\x17\xf0È\xe6\xd8~<?d\xd0-vy\x12\xf7X[:]\x14\x85Q\x13G\xb9dY\x8c\xce2\xa4\xe3`\xac)!+K\xe8\xfb\x05M\x8c\xe2\xf1\x93s\\vh\x1f7\xdfp\x9c\x9dv\x8f\xf9\x85U\xba\x95\x88\xf8\xb2\x1bq\xfd*\xff\xe1#\x0f\xf0\xbe\xb7\x1e\xe3\xd8\xe5\xb3\xe09`\xe1Mo9\xc1\x89\x13W\xf0\x8aw\xfc\fy\xd2+ݧ\xc3\xf2\u0093\x05\xb5\xdc١V\x1fa\xe1\xec\xa3\xf4\xba\xad\x82\x8f1\x1a\xaca}\xe94B:di\x82N6\x99\x99=\xc0\xf9\xb9%\x820z\xc1\xe2\x871\x96\xaf2v\x14q@\xb9\x1e\xca\vP\xca\xdfS0J)\xa95'\xa8\xd6'\b\xa3z\x89\xaaj\xb4\xce\xc8ӄ l\x10\x84u</@y\x01\x9b+\xe7Y_>\x8b\x1fD\x04\x95:AX#\xaa\x8e\xe2\x05\x11i2@\xd5\xc7p\xa4\x836\xe0)\x87,\xd7\f\x06}N\x9f[\xa4\xd5\xe915\xd1\xe4\xda\x13G\x90Bл\xe6r\x9ez\xea$\x8f=z??\xfa\vw\xf0\xa9\xcf>ūn=\x86\xef\xb9L\x8cT\x88Ӝ\xc9\xc9i.\xcc==\xac\u007f\xfa\xdd\x16\xf3g\x1eF\n\xc9\xf2\xfcɢ\x10\x16\x82A\xafU\xec\xe5\x12+K\xd3\x01J\xe6\xfc\xf3\x0f\xbc\x1c\xcf\xf5\xf9\xc1\x1f{\x94ٰ*_(\x83\x9c_\xdc\xfc\x9b\fR\xa0\xa5R\xa9a\xb0\x15R<\xabz\u05faP\x97H)ɒx\x98]\xc9\x12\xda\xd0yB\x10\x8d\x14\x80\xa15\xcc\x1c>A\xa56\xc2♇H\xe2\x1e}o\x1b\xe98\x1c9~\v*\xf0\x8a\xd8a-X\x83\xb6\x02\xdfsq]\x85r$\xdb;\x1d\xfa\x83\x84 ئ\x12x\x1c>8\xcd\xeb\xbe\xe5U\xbc\xf2\xe5\xb7p\xf6\xccky\xe8\xfe\xfb\xf8\xcf\u007fp\x0f\x9b\x1b븎@HE\x96eXc\b\xa2\x1a\x9e_\xc1hM\xd2\xef\"d!\x98\xe8\xf7\xb6\x86xV\t)\x17t\x801\xfc̿y\x13ox\xcdQ~\xf2\xd7\xeeE\trm\xf3\v/\x94A\x92L?\xbfA\xa4\x90\xb8~\x80\xebUP\xeeW\x00\r\x85\xe4\xc8\xf1\x97P\xadO\xe0(wh,\x8b@I\x89\xa3\\ҴOgg\r\xa3-~X\xc3\x1a\xcb\xf8\xd4\x11¨\xce\xf9\xa7\xbeL\x9e&,/\x9c,D\a\x87\xae\xa6\xe2(LnP\xaeKj\xc1\xf7\x14\xc2X\x8c\x05\xcfuйf\xd0O\x88\xe3\x94\xde`\x0ec\x0ep`f\x8c\x9bn\xba\x99\x97\xdcr+\xae\xfaaVV7x\xe0\xc1\x878}\xfa)\x0e\x1c\xbe\x8a \x88\xd0\xda\xf0\xd0\xfdwp\xf2\xe1/\x14\xab_9e\n\xef`\xad\xc4\x18\x8b1\xbatђz-\"\xf4=\xaa\x95\n\x9f\xbd\xf3a\x820\xecO\xed;~r\xfe\xf4C/\f\xf4.%\xa2֘\x18\x94\x1c\xc034B.\x9e_\xc1\xf3\xc3g\t\x19\x8c\xd1\x04\x95\x1a\xf5\xe6\x14\xb5\xe6$a\xa5\x81\x10\xcf\xcc\xfd-\xcaU\x84A\x88(\xa1y!\x04\xb9\xceɳ\xac@Z\x11\x18\xa3iבּ\xb9r\x96ng\x83zc\x8a}\x87\xae\xa61\xb2\x0f\xc7q\x11\xd2\xc1\x91\x02דH$\xcaUHQD@)\x04ʑ\xb8\x9ebj\xa2\xc9\xcc\xd4(q\x92a)bE\xb7\xd7enn\x8e\xf3g\x9edq\xfe4K\vg\xe9\xf7;\x1c8t\x19G\x8f_\xc5\xf1\xa3\xc7\bÐZ\xbdN\x92\xc4\xeclo\xd1n\xb7\xf0\xfd\x80\xb0\x12q\xe0\xc0A^q\xd38++\xab\xdc\xfbD\xc2\xd9s\xf3w\xfc\xd6/\xff\xabW\xbfP;\xe4G~\xf2\x0f\x9d\xe76\x88-X\xc0\xa8>V\x06B1Ĵ\x8cьN\x1ebt|?\xca\r\x86Fp\x94\x8b\xeb\xfa\xc5\nC\f}q\xe0\aeFVp,\xaerQ\x9e\x0f\xb6x\xaf<ψ\xe3\x98~\xbf\xcd\xfa\xf2Y\x96\xe7\x1e\xc7\x0f\xaaL\xce\\\xce\xcc\xe1k\xf1\xfdJ\x01\xb48NA\xeb:\x12\xc7)\xb8\x934I0\x16\xaa\xd5\x1aJI\x84Ɉ\am\xb6\xd6W\xd8\xdaZf{c\x89\xb5\xd5e\xfa\xfd>\x95\xda8/\xba\xf1f^\xf1\xf2W\xb0\xff\xc0\x01FFFq]5$\xc0vw\x85\x94\xa5\xf6\xcb\x18\xb4֤\x99AJI\xe09l\xee\xf4\xba\x83A\xf2\x89\xfe \xf9\xd7\x1fx\xcf\xed\xcb\x00\u007f\xf2\x99\x87y\xf3\xb7^\xff\xc2\x1aDH\x87\xa8\xda,k\f;ğ\x00F'\x0f\xee\xa1^\x85\x90h\x9d\xd1\xd9Y\xa3>2M\x18Շ\xb0J\x10\x84DQ\r\xc7)v\x8f\xd6zȇ\b\n\x15\xa3\x10\x12\x01\xe4Ɛ\xa5\tg\x9e\xbc\x9f\xa5s\x8f\x16\xae\xc4\xf5\x99=|-#㳅\x84LX\xa4\x90t;\xebdq\x87j}\x9a4鲵6\xc7\xce\xf6::\xcf\v\x90\xb0\x84m\x82\xa8\xc1\x95\xd7\xdc\xc2K^\xf2R\x0e\xee\x9ff\xb4\x19\xe18%(\x99\xeb!O/\x85$\xcb5\xfd~B\xab3 \xc94\xaerh\xd6C<\xb7(~\x95\xe3\xe0\xba\x0e\x8e#1\xc6j\xb0\x1f˴\xf9\x91\xb7\xbe\xee\x86-\x80?\xfe\x1f\x0f\xf0\xb6\xd7\xdf\xf8\xbf\xdf \x8e\xf2\b\xa3FQ\xb8%\x03\x82\xf2\x01\v!Kc\x1c\"\xcf\x12\xf2<\xa5\xdf\xd9*U*\x92\xc9\xd9cԚS8\x8e\x83\xe7\xf9(G\x95\x18\x91F\xe7\x9a,K.a\x13\xedE\xba\x18\x10\x8e\xc2-\xf9\x8f,\xcf\xd8Z_ \x8b\xfbt;\x9b\f\xfam\x94r\x19\x9b\xbe\fߏ0F\x93\f:tۛ\xf4\xba[\xa4\x83>B\x8a\x82\xd8R.\xbe_az\xe6\x00/~髹\xe1\x86\x1b\x18\xa9\adyF\xa77`{\xa7\xcfN\xbbO\u007f\x10\x13\x0f\x12\xe2\xb8GT\xad\xe38\x1e\xb9\u038b\x9d(\vW\bebqI\xa6)\x05LMԙ\x1e\xaf\xe3\xfb\n\xdf\xf3\x90\x92\xcfXk?*\x84x\xc0X\xdb\x16Bl\xbf\xf1\xb5\xd7\xed\xc1\xbb\xfe\xd5\u007f\xfc#\x0eΎ\xf2\xfd\xef\xb9\xfd\xab7\x88\xc5\xe2\xfb\x11~XE\xe7\ti2 \xaa\x8e\x16<\xb4r\x19\x19ߏR\x1e\xbd\xce\x16\x83~\x9b,\x1dP\xad\x8d1>}\x19\x8d\xb1}e\f1{T\"\x94\xbc\x85\xd6\xf9PŨu^\x82\x96E\xba\x1c\x86\x15j\x8dт\xbaE\x14<\xba\x814\x8d\xe9\xb6w\x88\xe3\xdeЅ\x80%\xcfR\xb2tP\xeeB\x874\xed\xd3\xef\xee\xd0\xebv\x98\xd9\u007f\x98o}\xddwp\xfd\r7\xa0\x1c\xc9\xda\xfa6K\xab[t\xfb\x05\x05\xac\x8dFg)I\x12\x93\xc4\x03\xf2<\xc3\xf7\x03\xc6'g\x003t\xcd_I\xd2$\x84`l$\xa2Q\v\t\x03\x8ff=\xdc-\xac\xdb\xd6\xda\xf3B\x883\xc0Z\x9aꥧέ>\xbe\xb5ӻ\xe7?\xfe\xab\xb7/\xef\xbe\xfe\xf7\xff\xf8K|\xcf\xdbn\xf9\x9b\r\x12\x845\\\xcf'\x8e{`\fa\xb5\x89\x94\x12\x9d\xe7\x04a\xc1u\xe4Y\x82\xa3<Ʀ\x0e1:y\bϫ \x95\x02\xc3P\x81\xb2\x1bgҸO\x9at\xc9\xd3\fc2\xb4. \xf6\xb0R\xa51:A\xbd>\x8a\xe7yE\x8a\\\x92C\xd6h\xb2,'\xcfs\xd24%\x8e{\xb4w6\xe9\xb6\u0588\a=\x820\xc2\x0f#\x94\n\x11\x8e$\x19ti\ufb11\f\xfa\xbc\xe1\x8do\xe7\xa6\x17\xbf\x84\x8d\xed\x16KKk\xf4\x06)yn\x91\x8e\x87\xeb\xca\xf2\xf3\xe7C6S\xca\x02\xf6)b\x87*\u007f\n\x82\xa0\xe0d.\xe5\xf4/U\x166\x1b\x15\xa4\x108R\xe2y\x0e\x13\xa35\xa2\x8a\xff\xec\"\xcfZ\x06\x83\xa4\xaf\xb5\xed!\xc4\xe7\x05\xfc»\xdf\xfc\xe2\xcf\x03\xfc\xfa\xef\xdc\xc1\xf7\xbf\xf7\xf6\xe77\x88燁\xe3z$\xbd\x0eV\b\xa2\xea\b\x8eRXc\n\xbc\n\x81\xeb\x87\xec;tMI\xc1RJiz$q\xaf \xb6\xb4\xc6q=\xd2x@!˴%-+\xf0=\x9f\x91\xb1)F\xc6&\xf1\x83ʐ\x95\x14B\xa0\xb5!I\x06\xc4qL\x96%$qL\x92\xf4\xe8u\xb6\xe9\ueb13e1\x13Ӈ\x999x\x1c\xdf\x0fh\xb56Y[\x9a\xa7\xdb\xde@\xe79c\xe3\xe3\xbc\xfb\xbb\xdf\xcb ќ9w\x814\xcd\xf6j\x81\xec\xae|\xac\f\xd8R\x95\xbb`\x17-.\xd56\xc6R\xad5\xf1|\xaf\x04\x18\x15J\xa9=\x18\xdeh\xb3\x8a\xab$\x96\"\x15W\x8e$\xaa\xf8ԫ\x01J9Ϫ\xbaw\xbf\xe3%\"\xd0\xf3\xadN\xf2\xf6\xefy\xeb\xcd\x0f\xee\xc2U\xbf\xfbG\xf7\U0009edffdh\x10\x05\x90\xa5\x85\x8b\x02\b+\x8d\xa1$GH\a\xd7\r\x18\xdfw\x94\xc6\xc84\xbd\xee\x16\xf3\xa7\x1f \x89{\xa4i\x1fk,\xae\xebS\xa9\x8d\xe2*\x1f\x91\xc6Ò\xd1\xf5\x02\xaa\xb5\x06#cS\xd4\xea#C\xec\xa8(\xc6 \xcf3z\xbd.\xbd\xce\x0e\x9d\xce\x16\xad\xed5:;\x1b\xc5*\x1c\x99\xc4\xf5C\x0e\x1f\xbb\x9e\xe9\xd9\xcb\xc8Ҕ͍%\x16Ν\xa2\xdb\xd9\xc2\xe49\xd5\xc6(W_s=Ǐ_ɩ\xa7\x17iw{8R\x96e\x9d\x80]%= ˦\tk\x04֤\xa5\x85\xec%\xf5m\xa1\xe2\xef\xf5ZTk3ς\xe2w)\x83\\k<\xb7\xa0\x9b\x03\xbfH\xe5\x95*ȸ\xb5\xcd\x16\xb5\x8aO\x18\x06\x05\x04\xa2\x14kk\xab,]X \xcf\xf3r\x81\x8a\xc3\xddn\xff\xcf^\xf1\xab?\xf3\xab\xbf\xf4\xdb\u007f\xf5\xe8\xed\xaf~\xcd\xfd\xd7\x1d\x11K7\xbe\xfc\x1d<\xf0\xf9O\x16B\xc0a\f\xb1\x16/\xa8P\xa9\x94\x9cw\x91\xec\x13V\xeax~Ġ\xdf\"\xcf\x12\xfc\xa0Z\xa4\xb8~8\xe4\x11\x8a\x15a0:\xa7>2N\xa39N\x14\xd5\b\xc2\n\x8er\x91\x97\xf4\x9e\xf4z\x1d6ח\xd8\\[\xa4\xb5\xbdJ\xbf\xd7\xc2\xf3+\x8cO\x1db|b\x86\xa8\xd6$\x8a\x1aH\xc7!Iz\xac.ͱ\xb3\xb5Π\xd7\x06\x01\xae\x1f199\xc3\xd5\xd7\\M\x9aC\xbb\xddE\xebK\xdb\x18\xecp1\x15\xd1\xcc\\L\x1e\xc4\xee\u007f\x97\xc1[\x16\xd9`T\xad\x13V\",\xe0<\a\xe3\xb8\xfb\xde\xe3#U\x1cG\x12\x86.\xbe\xe7ễAZ\xad\x16\x1f\xf9\xcd_\xe3\xc6\x1b\xae\xe7\xed\xef|7\xd6\xc2'\xfe\xe0w\xb8\xfb\xf3\x9fesc\xbdp\xd7e\"\xe3(\x85\x14\x0eǮ\xba\xd1D\xb5\xd13\x9b\xdb\xdb\u007f\xff\xbe;\xfe\xeb]\x00\xff\xe2\xdf\xff\xa1\xa3.\xd5a\x05a\x15kt\xe9Ӌ\x0f\x15\x0f:ă\xee\xf0\x8b*\xd7G\xb9\x1eRH\xfc *4\xb3r\x97\x19\f\x98\x9a\x9a\xc5\x0f+8\x8e,\x8dU\xbcO\xa7\xdb\xe5\xfc\xe9GY\x9e?I\x9e'h\x9dSkLrŵ\xb73={\b\xdf\xf3Q\xcaA\xeb\x9c^\xb7\xc5\xca\xf2<\xebK\xe7\x8a/#$A\x10\x11V\x9b\xcc\xee?ȁ\x03\a\xd8\xd8l\x17\xaa\xf9K\x82m\xe1\"%ynȓ\x8c\\g(Y|?m\x8a\xcfo\x8d)Ds\xe5\x8eUJ\x11\xc7)\xae\xbbC\x10\x86\x04A\x05?\b\x9f3\x86\xec\xf6\xa7H!\xf1\xdcB%\x93\xa4\x9aO|\xe2\xe3\xa4I\xce\xc9'O\xb1\xbe\xbe\xc1O\xfd䏲\xbc8\xff,\xc3\xeebd\x06ͩ\xc7\xef\x95J\xf9Ǣ\xda\xc8GJ\xa5d\xf1y\xa4\xa3\xd0y\x86\xe7\x87C\xf1\x98T\xdeE\b\xa4\xf4\xb3\xd2)\n\xbf \xac\x12D͂\xbfv\x9c\"\xb0KI%\xaa\x12\x86\x11B\n<\xcf+yiC\xb7\xdbby\xf1<\xf3g\x1f\"\xee\xb5q\xfd\x80Zc\x92\x83\xc7ndj\xea \xbe\xef\x96P\x8d Nzl\xae-3\u007f\xfe$\xed\xed5<\xbf\x82\xeb\x05%\xe4ߤ12I\xb5\xd6dm}\xbb\xf8\\\x02\x8c.\xda\x18\xd24%M\x92\x12\xbe/\x17\x99\x94$C\xf0\xbf\f\xdeN)\x9e\xb3\x02k\x05i\xaaIҤ\x80\x8az\x03\x94j\xe1\a>\xa3\xa3\x13x\xbe_\xbaY9Ԟ\x01\xe4ڠuq\xff{\uef87ܺ\xb8\x9e\xcf\xc3_\xbe\x93\u007ft\xff\xe7\x87\xeey7\xc11F\xa3Ju\r\xa5jF\xcaB?\xe0z\xe1\x1ea\xb7\xb2\xb6\x90X\xea,\x85R\xccv)xh\xad\xa5֘\xa41:EXi \x1d\x97$\xeebM!\x15\n\x82\x90\xb0\x12\x11\x86\x95\xe1\xcf4\xcbi\xb7wX<\xff$\xab\xcbgho\xaf\xa2\x94\xcf\xd4\xec1\xf6\xed?\xc6\xd4̑2\xc3\x128\xd2\xc1\x18\xcd\xf6\xd6:sg\x9f`e\xf1)t\x9e\x11\xd5Ɛ\x8e\xc2\xf3C*Q\x93\xb1\xc9\xfd\xd4\xeau\xf2R\nd\x8c!Ibz\xdd\x16\xbdn\v\x9d%\xe8<C\x9b\x1c\x9d\xa5\xe8R\x01#\xa4Ėi\xb6\xe3\x14\r\xacJ\xf9(\xcf\x1f\xa6\xf9\xca)0\xb84\xcbHӌ$\xcb\xe9\xf5\x06(\xa5\b\x82\x80j\xb5\x8a\xeb\xbat\\A\x10\x04\x18\x03\xfd~\x9f\x85\xf9\x05Ν\x9fǑ\x85{\xc5\xdaa\xa5oK\x9e^\xeb\xac\x10};\xaad\"m\xf9\xec$~Xgbj\xff'O^\x92ũ]\xf9\x8b\xd69~\x18\r\xe55\xbb\xd5D\xb5>\xcaľˆ\x81N\xeb\xac\x10*XK\xbd9\x8a\xe7\xfa4FF\xf1\xfc\x10Ǒ\xb4\xbb=Ο~\x9c\vs\x8f\x11\xf7\xbb\xe4i\xcc\xf8\xbe\xcb8|\xecFF\xc7'\xf1K\xe9\x8f\xeb*\x94\xabȳ\x94\xf9\xf3Or\xfe\xf4#\xecl\\@HI\xad>\x89\x10\x92 \xacQ\xa96\x19\x9f\xdc\xcf\xc8\xd8\x04yZ\x14sy\x9e\xd3\xda\xd9`gs\x99A\xbf=\xa4s-\x94r\xd4\x00Ϗ\xf0\xfdJ!\xc8\x16b\xf8;\x83^\x87,[\x1b\xc2=R*\xfc \xa2Z\x1b%\xa84p=\x1fr\x8bF\x90\xa6)\xfd~\x9fV\xabE\x14E\xb4;\x1d\x94#\x11\xc2\xd2\xda\xdeb}m\x19\xc7Q\xf4:\x9bă\x1e^P!ϒ\x8b\x9d\xcc\xd6\xee\xe9\x93)\\^\x91S\f\x06=n|\xe9\xeb7\x8e]q\xdd?\xbc\xf3\xd3\x1f\xbb\xb8Cvc\xc8n\xff\xc4\xee\vӤ\x8f\xeb\xfa\x8c\x8c\x1fܛuX\x8b\xe3(\x9a#cx\x9eG\xa31\x8a\xe3\xba\x18m\x98[\x98\xe3\xf4\x13_\xa4\xd3Z\x03!\b+5.\xbf\xf9uL\xcf\x1cB9Nɿ\v\xbc\xb2\x87q{s\x95\xb3\xa7O\xb1\xbd\xb1\xc8\xe6\xea\x1c\xca\xf5\xa8\x8fL\x17\"\x88j\x93jm\x8c\xa86BTk\x90ă\"\xb8\x0f:lo,\xd2mo\x16r\xd72\x19i\x8e\xcd\x10\x04u\x1c\xcf/\x97\x92E؋\x82\r\xd7\r \x84zs\n\xad5q\xbfM\xbf\xb7\x83\xcecz\x9d\x84n{\x03)%\xae\x17\xe2\a\x11\x95h\x04?\x8c\xca\x1aE\x92Ľ\xb2\xbd!/<\x97\x948\x8eK\xa7\xb5\xce\xf6\xe6\x05\x84\x10\xf8a\x8d,M.i\xfe\xd9+E\xdaՌ$\x83\x1eG\x8e\xbd\xa8}\xf4ʛ\xde\xf4\xa1\u007f\xf7ޝ\x9f\xfd\x8d\xbf\xe4G>\xf0\xba\xc2 \xbbZ$.Ѹf\xe9\x804\xee15{\xb4\xd0\xfb^\xc2*\xfa~@\xbd9J\x10\x84T\xabu,\x82\xcd\xcdu\xe6ϝ\xe2¹G1VS\xad\x8d15{\x8c\x99CWR\tC\x84(xx\xd7\xf3P\x8eb\xd0\xef\xb2t\xe1<\xdb[\xeb\xa4I\x8f\x9d\x8dE\x84\x94T\x1b\x13E\xc0\f\xaaD\xd1\bAX-h\xd4~\x97\xd6\xce\x06\x9d\x9duv\xb6\x97H\xe3\x1e\xca\rh\x8e\xceP\x1d\x99\x02k\xc9\xe2\x1eI\u007f\x9bl\xabC\x9et1y\x86\xce\xe2b\xc7x!\x8e\xf2A\x858a\x84\xefGT\xeb#D\x8d\t\xb2\xa4O\xdc\xdb!\x89{\xe4yJ2(Z\xb6[۫\b)\xf1\xfdJ\x11\xcb\xdc`\x98\xcc\x18cp\\\x97d\xd0ek\xe3\xc20^\xb8n\x91uj-\xf7jل(\x80W\x9d\x93\xeb\x8c\x13\u05fdx\xfduox\xcf?\xfc\xf1\u007f\xf1\xee/\xfe\xe4/\xfd\xc5\xd0\x18C\xc6К\"SR\xaah\xbc\x89\xfb\x1d\xa6f\x8f3>udh\fk-\x95\xa8J\xb39JX\xa9\xe2y>\xfd\xc1\x80\x85\xf3Oqa\xeeq\xba\xadM\xea\xcd)&f\x8e29}\x90\xa8Z/\x10XaQ\xcaA)\x8fnw\x87\xcd\xf5\x15ڭ\xed\xa2\x1aOz\xac,\x9cD\xeb\xbcT\xb1\xb88ʣ\x125\xf1\xfc\n\xf5\xe6Xѓ\xb2:\xcf\xd6\xda<\xbd\xce\x16\xca\xf3\x19\x9d<L\xa5\xda\x04+\xd8^z\x92x{\x01=h\xa1\x93>\x0e\x19\x12\xc8\xcdnWI\xf1\xd93k\x98\xf6}\xa6\xa3\x1a\x17\x8cbˏ\x18\x9b\xd8G\xd8\xdcO0>[ \x04i\x9f$\xe9\x11\x97\xd0\x10\x16\x92\xb8_\xec\x0e\x04\xae痆\xf1\xb1}M\x92\xf4KA\x86ݳ\v\x1cG\x91\x9bd\x98\xd5\t\xa5\xc8\xf3\x8cjs\x9ao\xf9\xd67\\x\xcb[\xde\xf6\xee7}\xcb\xd5w\x03|\xf0\a\xbf}\x0f\xf3\xaav\xe5\xa3ATC\xe7\t\xfd\xde\x0e\xb5\xfa8S\xb3ǆ`\xa0\xb5\x96\xa8Zetl\n?\b\xd1ڰ\xba\xb6\xc6\xf9\xa7\xefgsu\x0e!\x1c\x8e\\\xf1\x12\xc6&\x0fQ\x89\"<\xcf\xc3q\x04\x8e#q]\x1f\x8bea\xee)67V1\xa6\x80\xb3\xb3\xb4\xcf\xca\xc2)t\x9e\x17jv?\x02!\xa8T\vW\x11\xd5\x1b\b!X]:\xcb\xcaⓤI\x9f\xc6\xe8>\x9ac\xfbq\x94Kg{\x85\xf5S\x9f\xc31IQcX\x8b\xe7\x80\x12\n\xe1@X\xa2\x00\x88\"\x83\xbb\xad\xde\xe4U\xa3#\x80%ӆO\xaen\xf2\xe0\xa9\x05\xb4\xbd\x97\xa0Red\xea2*\xe3ǩ5\xa6\xa8\xd6\xc7\xc9Әn{\x93\xc1\xa0\x85\xb0\x85\xe3ϳ\x94<K/\xc1\xbc\x9e\xad\xe8t\xbd\x00\xb2\xa2\x85B\bIP\x1d\xe5E\xb7|\x1b'\xae\xbd\x99}\xfb&\xee\x1a\x1bi\xbc\xf3M\xdfr\xf5\x1a\xc0\x9f\u007f\xee\t\xbe\xe3\xd5'\xf6$PjW4]\xf8\xc3\r\x94\xeb3s\xe8\xdaR\xe2i\xcb\xea=brj\x16\xa5\\\x06q\xc2\xfa\xda\x12gO}\x89\xb8צ>:\xcd\xe1c7\x13U\x9b\x04\x81_\x14[%\\\xed8.\xed\xf6\x16sgO\x91e\xe9\x1e\xbd\xed\xfa\xd2i\xf2\xb2\xb2\xf7U\x84t\vYg1$ \xa2R\xa9\xb2\xbat\x96\x85s\x0f#\x84db\xe6(\xb5\xc6$:\xcf\xd8Z|\x9c\x9d3\xf7\xa0v\x87\b\x94\xd2$\xa7\x94\x9bz\x14\xada\u0091\xc3lu\xdcsq\xac\xa5\xdf\x18!\x1b\x9f\xe4-\xcd\x15&\x16\x96\xb8\xae\xe2\x91k\xcd\xe7ן\xe2\xe1\xb3\xf7c\xc3Q\xfc\xe6\x01\xaa\xe3\a\xa87&\xa85&\x18\xf4\xdb$q\xe1\x06-\xe6\x92&\xa2\x8b\x14\x84\x90\xb2t{\tR:\x04\x95:\xd3\aOp\xd3K_ρ\xd9)FG\x1bO\xbc\xf8\x86C\xefyٍG\xd7>\xf1g\xf7\xf3\xae7\u07b4\xc7\x18\xbb\xc5鉞\xf6\x00\x00 \x00IDAT\xb5r\x94\xc2\xf3B\xe2~\v\x93\xa7L\x1e\xbc\x8aZs\x12\xb3\xdb\xc7\x11FLM\xefG:.\xadV\x9b\xb9\xb3\x8f\xb2<\xff8R*f\x8f\\\xc7\xf4\xec1\xa2j\r\xdf\xf7Kb\xab\xe8`\xea\x0fzl\xae/\xd1\xda\xd9,+bg(\xf7\xdc\\9K\xbf\xec\xf6\x95\xa5\x9bRR\x11\xd5FQ\x9eO\xa5R\xa3\xd3\xda\xe4̩{\x91B2\xb1\xefr*\xb51\x06\xbd\x1d\xda\v\x0f\xd3_;=T\xbc\b[\xc0\x9aR\x16\xb4\x98\x10\x02G\xecm\x9e\xb6\xc0\x1d\xdb\xdb\xc4\xf5\x11&\xbe\xe5\xf58a\x05\x95\xa5\xdc\xf0ٿdd}\x95\xc6u\xd7s\x95\x90|\xf6\xf3\x9f\xe7\u007f\xb6\xda,\x9d\xbb\x8f\xb5\xa7\xef#h\x8c\x105g\b\x1b\x93\xf8\xd1\x18N\xad\x10\xd4a\x8b)\x15Z\xa7\x05\xea\"\x05\x83n\xd10\x9bg\x19Qm\xb4ЦU\x1a\x9c|\xf4\x8b\xe4ɉ\xfek^\xf9\xee\xf7\xbf\xec\xc6\xc3s\xff\xf5O\xee\xe5]o|\xfeI\x13J\xb9>\xb9\xceH\x06=&g\x8e\xb2\xef\xc0U\xa51\fQ\xb5\xce\xf8\xc4\f\x16\x87\xb93'\x99;\xfb\b\x83\xde6AX\xe3\xc8\xf1\x97PkNR\xadV\xcbB\xb0,\x9ar\xcd\xda\xea\\\x91\x05i=T\xc1\xef\x92V\xab\x8bO\xd1im\f\xe1\xfa]c\x15\xfc}\x88R\x1e\xdad\x9c9\xf9%\x8c\xd6L\xec;JT\x1f\xe7\xec\x93\xf7\xa1[\v\xa8d\xfb\xa2\xb2\xbe4\xc6.W!J\xcc\xea\x12\x89.\xa2\xb4J?ω\xaf\xbd\x0eW)\xc8R\\\xcf\xe3\xa10\xe45\xdf\xfe&n\xfb\xe1\x1f-td\u007f\xf4\xfb\x8c\xff҇p\xde\xf26ҩ\t\xbet\xf7=<xߗٜ{\x9cJ\xbd\x8e\xe3U0^\x84t+XYf\x9e֒g1Y\x16\x93\xe5y\xa9Y\xee\xd3\x1c\xdb\xc7\xea\xd2\xd3\x1c\xba\xec\x04o}\xf3\xb7\xbf\xebU/9\xfc\x05\x80٩\xe6Wn\xfa4Z\x93&]\xa2\xfa8\a\x8f\xde4$\x8e\xa2j\x83\x91\xb1Iz\xbd.'\x1f\xb9\x8b\xd6\xe6\x05\x10\x92J\xd4\xe4艗\x13՚\xa51\x8ab'MS\xda\xed\x16\xdb\x1bK\xe4y\xba\xb7\xd7\x1d\xd0y\xc2\xd2\xe2Să\xce\x10\x01\xd5\xda\"\xa5\x83\xa3\xdcrΉ\xc4\xf3<6V\xce\xd1\xef\xed0\xb1\xefrj\xcdI\x96\xe6\x1f\xa7\xbfy\x8e\xaa\xee\f\x035\x97\xe8C\xa4,\x1b\x8a\xac(18p\x85\xe4\xf2J\x9d)?d\xae\xdf\xe1\\{\x9b\xfe\xda\x1a\x1c\xbf\x12G)6\x96.\xb0\xb2\xba\xcaK?\xf4\xebTju\x14\xd0>r\x94/e)\xbf\xf5c?Nuv\x1f\xd6dtz\x9b\xfc\xe9'?\xce\xc7\u007f㷙?\xb3\x80\xa2\x8f\x97]BZ]\x8aw)\xd8\xce\x151\x86\xd6\xd623\a\xafL^z\xeb\xab\xdf\xf2\xf6\xd7_\xf7\xe9;\xbe\xf04\xb7\xdfz\x8cW\xde\xf2\x95\x87L\xa8$.\x1a5g\x0e]=\xbcIP\xa9R\xad\x8f\xb2\xb9\xbe\xc2ӏ\xdd]\f\x13\x10\x92\xb0R\xe3\xb2+o\xa5>2F%\xac \x84\xa0?\x18\xd0m\xb7\xe8\xf5Z\xc4\xfd\xceE\x92\xaalI\xd3yF\xb7\xb3\xc1\xfa\xf2Yt\x9eR\x8c\xc6P\x18\xad\x87z/\xc7Q\xb8^\x80\x90\n\xa3s6V\xceS\x1f\x9d\xa1Z\x9f\xa0\xb3\xb3\xca\xe6\xf2\xd3D\xbaw1kz\x86\xdcO^\x02\xb7\xef\xae\x01\xdfQ\xa8\x12\xfd=\\\xa9\xb3\x91\xa7\x9c{\xe8\xcbL\x1d<\xc4\xf2\xfcyN\xdd\xfb\xc5\xc2\x1d\x8e\x8e\xe1\x00\xa7O>\xc1/\xfe\xf0\x0f\x11ǆ\xcf\xfd\xe5gx\xdb\xfbޏq\x14A\xa5\xc1[\xdf\xf5\x1e<\x1b\xf0_~\xe1C\xac\xaf\xad\x0e\xdb\x16\x10\xcfng\xaaɌ\xcb\x0eL\xea\x17\xbd\xf8\x8a/]\xff\xda\x1f\xfa\xc1\xefz\xeb-\xf7\xff\xfa\xef\xde\xc9\xed\xb7\x1e\xfb\xea\x94'B@\xbd9I\xad>^\n\xa9\x1bxA\x85\xe5\xc53\xcc=u_\xd1 i\r\x8d\xe64G\xae\xbc\x85\xb1\xf1i\x82\xc0c0\x88\x19\f\x06\xf4\xbb-\x92\xa4\x87\xd1iY\x85:Eå\xe3\xd0\xdaZf{m\x9eN{c\x98\x16\x86Q\x1d\xe5\xfa\xecl-\x15\xbd\xe6\xd2\xc1Q\x1e\x8e\xe3\xe1y\x1e\xad\xade\xb2,elz\ncr\x96\xce=D\x98l#\xc4\xf3\xb3xVء\xa3rJ\xb8=\x90\x0en\xc9\xd7[,\xd3Q\x83\v\xedM\xee\xfa\x93?\"\xedv\x90R\x92g\x19w\xfc\xe9ǩ\xbau>\xfc\xd3\xff\x9e\xce\xc6\x06\xae\xe7\xe3T*\xc3d\xa0\xa2\x1a|\xf9ԓ,\xef\xf48\xf6җ\xd1\xfb\xec\xa7\xd9\u007f`\x96\xe5\xe55v6\xb7q=U\xb6}\x17\xc1\xdc\xf3\xdc\xd5k\xae\xbe\xfaG\xdf\xfa\x8e\xff\xe3\x8fo\xb9\xfd\x96֛\xaf\x86\xef\u007f\xcf+\xbf\xba\x966)PB8L\xce\x1c\xc5Q\n/\x88\xf0\xfc\x80\v\xe7\x1eae\xfe$\xa6\x14D\x8fﻌˮz\x19\x8dz\x13\xd7Ut:]\xd2$\xa1\xdb\xd9$M\xfa\xc3nXϏ\x90\x8e\xa4\xb5\xb9\xc2\xc6\xca\x19\x92\xb8\x871\x1aW\xf9\x84\xb5&\xa3\xe3\xfb\xf1\x83\nK\v\xa7\xb0\xc6b\xd0 \x05AX+\x8a.\xe9\xb0x\xfeq\xa2\xfa8\xca\xf5\xd8Z_\x80\xf6\x05\x1c\xe7+J\xf9\x9e\x85\xc8z\xd2A\tA K\x01\x05\x96)\xa9\xc8\xfd\n\x8b\x83\xeePt\x11\xd5\xea\xfcƿ\xfd\t\x06\xfd\x1e\x83A\x0f%\x05GN\\\x83\xeb_ԗ\xc5\xf1\x80\xd3O?\t\x16\xda[\x9b\x1c\xbd\xfeZ~\xe4W\u007f\x0eG\xb9\xfc\xe2?\xfdQ\x1e\xf8\xeb{\b\x03\x8f7~\xe7\xdb\xc0\xb1w\xdc\xfd\xe9;\xdf\xf3s\xbf\xf5\x87\x8b?\xf7[\u007fȯ\xfe\xf4\xbf$\x1c;ʟ\xbc\xff\x1f|\xf5\x83\x03\x82J\x8d\xe6\xf8~\xac\xb1x^\x85\xc5\xf3\x8f2\xff\xf4\x03\xb8^0\xec\x86\xda\u007f\xe4z\xa2J\x15\xa5\x14\xfd~\x8f8\x8e\xe9\xb67ȒA\xd9\xfb\xed`\x81\x9d\xcdE\xb6\xd7\x17\xe9\xf7ZE\xa0\xf6|¨YV\xdc#D\xf5\x11\xb0\x86\xd6\xd6\xca\x10\t\x15\x88\x82cq\x14\xed\xede\xb24&\x8c\xea\xf8a\x8d\xed\xc5G\xf1\xa5\x81\xe7\x98\"%\x9e\xa3\u007fE\x94\xbf)\x04\x84\xd2AI\x87\x1cKf-\x81\x10L\x06\x15\x96\xe2\x1e\xc6\x16\xea\x15\x9dkv\xb6\xb7@@4s\x82\xe9\x1bn'\xdf^`\xed\xfc\xe9\xa1A66\xd6Y\\\\\xc0Q\x8a'\xef\xfb\x12o\xf8\xbe\xbfGP\x89\xf0\x02\x9f\xcd\xd55\xae{\xd5\xed\\s\xebK\x99\xd8?\x9b\rz\xf1\xc7>\xfd\xa1\xdfY\xfc\x91\xef{\a?\xfb\xd1O\xf2\x8f\xfe\xe5O\xff\xaf\x8b\xe5F\xc6\xf7#\x84\xc4\v\x02\xe6\xcf>\xc8\xfcS_&\xac6I\x06]\xb24\xe6\xd0\xd1\x1b\xa9\xd6ư\xd6\xd2\xedv\x89\xe3~A\x9f\xea\x94 \xac\x91\xa5\x03\xb6\xd6\x17ho\xaf\x92\f:\x05\x86\x15Ո\xaac\x84Q\rk\xc1\xf7C\xc6'\xf7\xe3\x87\x11\xf7}\xfeSC4\xd4Z\x83,G\b:\x8e\xcb\xfa\xf2\xf9!\xd4\xde\xeb\xee`:k8\x97\x88\xf4\xecE>\xf0\x19\xe4\x91\x18\x0e\x15\x90\x14\xff\x84\x8eB\x011\x10\x94C\xd9\x04\xe0\b\x81)\xa9U\xacA*\x8fڕ\xaf&\x89\xa68\xb3\xbcC\xb0\xb3\xc6V?\x1f\x1a\xed\xcb_\xbe\x17+\x04\xe7\x1e{\x94\xfa\xe8\b\xdf\xf1\xbd\xdfC\x9e\xe7\xf8\"\xa0\xd7j3}y\x8d8\xc9Y_Z1\x8d\x91\x91\x16\xc0\xcf~\xf4\x93\u007f{\xb1ust\x06\x81`\xe1\xec\x83,\x9e{\x04\xe9(\xfa\xddm\x8c\xd6xA\xc8\xc1\xa37\x17қ\xa4\xe8\\\xea\xb6\xd7\xf1\xc3*~0\xc1\xfc\xe9\xfb\xd9X=\x87\xceR\xac\x85\xa86Bsl߰\xd0D\b\x1cG1{\xf0\x18y\x96\xf2\xc4\xc3w1\xe8\xb5.Yٶ\xec3Q\xe4yB\xbf\xb7M\xb5Q(!W\x17\x9e@\t\x83\xb5\x05\x11\xe4J\xa7HY\xb1\xe4ڐ\x96\xad\xdb\x17S܂\x9f\x10X\x94,b\x88\x05\x9c\xf27\xb45\x9c\xee\xb6\xc8J\xf4AX\x8b\xac\xd4\x18\xbf\xe5=,\xad/\xd3]:\x83\xe3\x06\xabU\x99\xff±\xebo\xd0R8\xff\xf6\xc9S'\xab'\x9fx\x1c\xd7uy賟\xe1\x8d\xef\u007f?\x13\xfb\xa6H\xe2\x984N\xd8Z\xdb\xe4\xbaF\x13)\x04:\xd3\"I\x92\xcaע˲\xd6\x16.kkc\x9e\xa5\xb9'\nx=/\xe0uk\fS\xb3W\x10V\xea\xe4yJ\x9e\xa7dـ\xe6\xd8~6V\xcf\xf2\xe0ݟ$K\xe3b`\x80\xe31{\xe4\x1a\xea\x8d\xc9B\x05\bH\xa7\x10\xba]v\xec\x06\xd6V\xe68s\xea~\xda;+\x85\xea\xc3ڡ\b\xc0q\x14R:$e\u007f|\xbd9\x896\x9a\xa4UL\x95\xa8\x87!\xb5\x92\xc1\xdbM\xc9s\xadItN?NH\xf2\fc\xc1\x18Q\xa6\xbf\x02W\x14\x01\x9dK\x9c]b4\x83\xdd\xe9\r\b\xbc\x91)\xa6^\xfe~\xce<\xfd \x9bksX˯\"\xc4?{\xf0\x89{\x92O\xbf\xf1\x8eW\xad\xae\xad\xa4\x9f\xfa\xd4'q]\x17\x9d矼\xfe\xf6\xd7\xc5\xfb.\xbb\xec\xef\xe9,CJ\xc9\xf2\xdc<Y\x92\x125\x1a\x05\x8d\x90f\xc2\x1a\xe3\u007f\xcd\xfa^k\r\xcbsO\x90\xe7ɰcVJ\x87J\xb5ɕ\u05ff\x96<OK\x00M\x93&}N>\xf8\x19v6/ \x1d\x17)%͑}\xcc\x1c\xba\x1aG9h\xad\x87TjX\xa911}\x88\xd3'\xbf\xcc\xc6\xda\x02\xbd\xee&i:\x00cQ\xca\x03\x01y\xa6\x87*\xf9ݾr/\x88H\xe3\x1e\x83\xd6*\xa3\xd5\x1a\xe3\xd5ZQ[؋A\\[\x8b\x9f\xe7x\x8e\xc3v\xaf\x87\xces\x8c\x15\xe8\xb2aԓ\x02T\xf1\xdfE\xd33,\xc5=\x06y\x86\xef\xf9\xd4g\x8eQ=\xf1\x06\x16\x16\x9ef\xf5\xc2\xd3\x06\xf8\xd5\xf6\xd6\xea\x0fmo.j\x80G\x1f{\x98;>\xfb?w۳\xef\r\x82\xe0\xfb\u007f壿\xbb\xfe\x9fnz\xd1\xfa\xd2\xc2\xcaw,/\xac\x1c\x9e{\xf2\xb48p\xfc\xb8\xeb\x05\x01ґhc\xb4D.\u007f-\xc6p\x1d\a\xd5\xebl\xd1i\xad\x0f\xb5Qɠ\x87\xe3H^t\xeb\xdb0\xe5\f\x91ե\xa7Y\x9e\u007f\x9c\x8d\xd5s\xbbZS<?dz\xf68ͱ}\x18c\xc8\xf3\x1c\xa7D;\xc7'g\xb1\xd6r\xe6\xd4\xfd\xf4{]z\xddB\\'\x85\xa411\x85r\x03V/\x9c\x1e\x8a\f\x84\x10\xc5XA\xe9`\x8d%\xcdS\xea\x8ee_\xe0cK1B \x05\x1a\x8b\xb4\x96\xdcJb)\x8b6\x05G\xb1\xba\xb3U\xee\x1e\x81\xb6\x96\x9e\xd5\x18)\xb0\xba\xd8!\xbd<e-\xee\x13\xd5GhN\x1d\xc0?\xfem\xacl\xae\xb3x\xf6a\x80\x0f\xce=}\xff\u007f\x008t\xec&枾\x9f;\xef\xbaS\xc4I\xe2*\xd7\xdd\u07bf\xff\xd0\xdf\xff\xcew\xbd{\xbdxb\a\xfe\xf9\u007f\xfd\xf0\xa7~v|\xa2\xf6j/\f\xd4\xcb\xdf\xf9\x9d\x1fɒD\x85\x95\x90~\xa7o\xad\x10_\xdbDSaQ+\vO\xeeQ\xb7[\xab\x99\x9c\xbd\x920j\x90\xa51\x8f\xdd\xff紶W0%\fb-LL\x1ddb\xdf\xe5(\xd7\x1b\xee\n\xc7Q\xb8\xae\xc7\xf8\xe4,K\x8bg\xe8vv\xb0\xd6\xd2\xdeYf\xd0katN\xb59\xc5\xf8\xd4\x11\x06\xbd6F縮_\x92b\x96,K\x86\xa33\xf2\xb8˸\x93\xd3\x14\x92\xd1\xc8\xe5x\xcd\xc5s\x1c\x8c#\xd9\xe9\xf4\x18\x18XK,s\xb1\xa1\x1a\x86t\a>\xddA\x8c(\x05{9\x16]\xf2\xe8\x02\x88\x1c\x97#c\xfb荎!&\xaec\xa7\x97p\xfe\xe4\x17\xb0\xf0G\xcf4F\xb9\r]?\b\xd7g\xf7\x1fx\xe3;\xdf\xfe\xae\x93\x00\xbf\xfck\xbf\xc6?\xf9\xc0\xbb4\xb0\x04\xfc\x1e\xc0O\xfdԿ[\xab\xd6*\u007f~ŵǹk\xf9\xf3\xb6Vo|M\x061\x16T{\xe7\xe2P\xe3]X\xb9Z\x9f`y\xfe$O<\xf4i\xa4p\x86\x95\xa9\x1fD\xcc\x1c8AT\x1b)&\xec\x18\x8d\x94\xaa\xd4\xf3\x06\x84Q\x9dӧ\x1e(e/\xd0\xde^a\xd0o\xa1u\x86#\x15\xf5\xe6d\xd9×\x94@\xa0\xc4q\x83\xa1hM\x96\x83lD\xd6\xc359\xe3~\x85+\xab\x0e\xcdz\x84\xaa\x84\xe88#\x02\xe2$a\xcc5T\x95䱞f\xacޤ3X\xc6\x00\x99\xb6\xe4\xc6\x16\xc6)\x9a\xde\v\xec\xab\xd6 \xaf\x1e\u0089\xf6s\xf2\x8b\xff\xdf\xee\xf7}`\xf7a\\:\xc94\xaa5\x1f\x99\x9e=|\xed\xb7\xbf\xf6\x95\xfd/?\xf8\x107\xbf\xe8\x06\xfe\xc9\x0f\xfc\xc03\x9a-!K\x92\xf9\x91\xcb\xf6\xf7{\xbd~E)\xd5\xff\xaew~\xd7c\xdf\xcdw\xff\xed7\b\\lع\x88;\t\xce=\xf9E\xb2,\xbe\xa8z\xb7\x96\xa0\xd2`\xff\x91k\xf0\x83h8\xf2U9.\xae\x1f \xa5C\x9e\xa6̝~\xa4\x10L\xe8\x94\xce\xcez\xd1\"fs\x1cǣ>\xba\x8f0j \xa5,3\xad\x02zP\xb2\xe8\at\xfd\xf0\xe2.M\xfbH\xab\xc9tN\x92kL\x19\b\xc6\x0e죷\xbd\x83\xdc\xdaF\x0ebF\xb5fT\xc1\x16\x8a\xd1(b\xab\xd7\xc3X@\x17{\xc3\n\x90\xd6ҮT٩\xef\xc7T\x0f\xf0\xe4C\x9f\xb9\x04\x05{v]\xf9\xdb\x1f\xfb\x18\xff\xe7{\u07fb\n\xf0\x17\u007fu\a7\xbf\xe8\x86\xe7~z)x\xbe\x1fM\uf7ea,\xcd] \x8c\xaa\xbf#\x84\xc8>\xfeG\u007f\xc8w\xbe\xfd\x9d\u007f\xfb\xa0\xfe\xec\x894\x85\n\xe4\xe2\b\fp\xfd\nS\xb3\xc7\n\x19h\t\x81\x14\xf8\x93G<\xe8\x13\xf7;E\xab\x81\xd1\xf4\xdb\x1bC\xc6\xcdZ\x8b\xeb\x064F\xa7\xf1\xc2\xda\x10\xa5mﬗm\b\x02\x83\x05)\x10H\x8c)P\xbb8\x19\x90\x1aC?\xcd\xd8\xee\xf6\xa8)\x89M\x12\xf6}\xeb\xcb\xc9\xf2\x9cs_\xb8\x1f\xb3\xb2N\x98Y\xa6<\xc9v\xae\xa9V\"\xb6\xbaE\xd1g\xcb\f.5\x96\xcc\xf3Yh\xccҢ\xc9\xce\xf9\xc7ɊA\x9c}!x\x14D\xf2\xcc\xef\xff\xbe\xef\xfd\xde\u17ff\xfd\xb5_Y\xad>53\xf1b/\xf0\xc82\xf3\xc9\x03\x97_\xfeA\xe0k2\xc60+\x94\xb2\x98\x86si,\xd9\x1db,\xa4\xa2֜$\bk\b\xcaq\x19\xb2\x10:o\xae-\x15\x1a\xdc\xf6&kKO\xb3\xb1r\x96n{\xa3\xcc\xd8\x04\xae\x17\x10\xd5Gq\xfd\xb0l7\xf0\xd8\xd9\\\x1a\xa6˦\x9c\x13,E\xa1z\xcf҂\xf64:c\xa0\r\xb9\xb1\xf4\xb2\x9cL\x1b\xf28\xa1\xbd\xb6\xc9\xc8\xcc>\x0e\\{5\xae\xef\xe1H\x88\x1cA \x8b\x18&E\x01&&V\x0fk\x8f\x95\xc64\x9bv\x94\xed\x8d\vt\xdb\x1b\xbb\xa4[b\xad\xf8q\xd7\xf5\x1f\xfbZ\x1e^\x18U<\xab\xed\xcf\xd5\xea\xf5\xf7\xbe\xed\xdb\xdf4\xf8\xc8G?\xf25\x19#ӦP\x9d\x14\x1cD\xbeG!Q\x10@\x92\xc6\xc84\x13\xd3G\xcaIoE\vZ\xbf\xdb&\xcbb\xe2~\x9b\x9d\xade\xb4.\xe4\xfe\xca\xf5\xf0\x83\x88t\xd0C8\n?\xac\xe1\x05\x11B(\xfc0\"\xcbRV\x16O\x0f\xe7Y\x19\x9d\xa1\xb3\x04J\xc47M\xfb`\r\xb9\xd6\xc5$\akIrM^\xf2\xd2;\v\x17\x98\xbc\xe22\xea\xfb\xa7\x10@=\xf0\xd8J\x06\x8c\xbb\x82Vj\U000540f5\x9aA\xae\xb1\xc62\xa8\x8d\xb0\xe6\xcd\xd2\xdd^\xa1\xbd\xb3z\xe9\xa4\xd3\x1e\x98ǫ\x8d\xb1\xbfu\x03\xe7\xaf\xfc\xca/\xf2\xdd\xefz\xef/^\xfa\xff\xde\xff}\xef\xff\x9a{\f\xe5\xae\xfcrwG\x14ih\xc1\xee\x8dO\x1edj\xf6(\xae\xe7\x97\xc4KL\xa7\xb5\xc1\xce\xe6\x12\xab\x17\x9ed}\xf9t1\x8d\xba\x9c\x89U\x89\x9a\xc5\x1c\x12l\xe9\x9elY\x93T\x11\x02\x96\xe6\x9f\xc0Z=\x1c\xe9j\xb4.8j\x8a\xa1h\xbb\xf3\xb0\x84t\xd0\x16\xb4\x85\xcc\x18\xd2,GHAwm\x8b\xb4\xdbG\xb9.\xb6L\x97\x03\x01uG\x14B8)\xd1\xe5@\xd5\rkX\x8f\x0e\x92jC\xa7\xbd~\xa912 \x15\x88\x8d\a\xef\xf9\x94\xbe\xf2\xfa\xbf]\xcb\xe0?\xfe\xc7?\xf4\xbf\xbf\xe9ӕ\x85\x94tW)\xb1\x8bh\b)\x99\x9a9Fsl\x1f\x8eS\x14|\x9d\x9du\x06\xfd6ۛ\vCݑ\x90\x12\xe98\xd4\xea\x938\xca#\xcb\x06C\xea7\xcfS\xaa\xde8Q\xb5\x89Ű4\u007f\x8a~gk\xe8\x0e\xad-f&\xa6i\xbf\x90Z\x96\xf3E\xac5(/D\x97\xf9gn!\xcd2\xac\x10\xe8,e\xd0\xea\xe0תX\vZkBGд\x16eM)!\x85\xccZ\xb6\xbc\b\xed\x8f\xd2^[x\xc6\x10\x1a\xf3\xcbBʷ\xdab\"\x01\xa7\x1e\xfe\x1c\xdf(W\x96i\x94\xdc\x1dx?liSL\xce\x1cedt\x1a\x84`\xd0\xef\xb0x\xfe\xb1\xb2u\x8d=\xa3\xc0\x1dǣ֘\xc4q=\x1c\xe90\xe8m\x0fa@\xd7\r\xa96&\xc8\xd2\x01\x1b\xab\xf3\xa4\x83\xee\x10\xa2u\x94\x8b\x12~1\x9cF\x16\xe2\xbc\xdd\xe6\x19\xads\xa4_%\x17\xc5I\x01\xb9\xb5%\x99\x05&\xd7\xc4\xed\x0e\xed\x15\aS\xb6^\v\xc0\x17\xe0\xec\xf6\xa3\xec\x8e\xfb\x88&\x88\xb3\xa2\t\xb4\xbcq\fx\xd6\xda\xcfamO\b\x11\x00\x03\xbe\xc1.%\xa4\xdc3\xbckr\xdf匌N\x93\xa5\t\xad\xed\x15V\x97O\xa3\xb3\xf4\x92\tq\x94\x8d\xa0\x851\xa4\xa3\x18\x9d\xd8\x0fְ\xb3\xb9\xb4'\x0em\xad\xcd\x11\x973\xe4\x95\xe7\x93&\x03\x82\xb0\xc6\xd8\xd4a\x8c\xce\nyϠ\xd0\t\vQ\x88˲4\xc6\v\xaa\xf4p.\xb6\xc7\xe9\x1c\x93e\x18\xa5\xe8\xacm\xd2\xd9\xdc!\xd3\x1a[ve\x81\xa5B\x8e3\\X\x86\xc4\x1d#\xee\xee9l\xe6ak\xedS`\x1eʓ\xc1\xa7\x91J~\xa3\x19\xc3\xda\xd2 \xbb\xd4\xe7\xf4\xfe+\xf1\x83\x88\xe5\xc5'i\xb7\u0588\xfbݲ\xebH>kX\xc0\xf8\xf4\x91\xe1\x9cvU\x0e\xbf\xbc\x944*\xc8)\x83\x94\x82Zs\x9a\xceN1\xc1\xadژ\xa0\xde\x1c/\x0et\x91\x92$\xee\xa0M^\f\x1d\x10\x90\f\xda\xd4\x1a\x13l\xe1\x90[]6߀\xc92\xacR\xb4\x97Wю\xc2jC\x96\xe7X\x8aV\x83A\x96\x0f\x9bt@\xa0\xa5G\xbf\xb7|I\x87\x99\xfd\x84\x10\xe2\xf7\xadP+K\v\x8fX\x80\xd9\xc3\xd7p\xe1\xfcc\xdf0\x06qd9\xd9Z\b\xf0\xfc*\x9b\xeb\xf3\xf4;[C^\\>\xc7\xc9\a\xd6\x18F\xa7\x8f\xe0z\x15\xf2l\a\xb7\x94Wj\x8a\xa9@\xbb\x13\xa6M\x19#\xea\xa3\a\xe9\xb5ױ\xc6\xe2\xd7j\x97\xf4\xbd\xef\x8e\xe5Ȉ\xfb\x9dB\xe8M!FS^\b\x8eGf\xfb\x05\x871\xbc\xb7%\xe9\r\x90\x95\xb0\xe8!\xcf5\x1aA/\xd7$\xbb\xf3\"\xad\xc5zU\xb2<#M\xfa\xbbnlq\xfe\xf4\x03?\xbf{ϙCW\xb34\xf7\xf87\x941\x86C~v\x95\xef\xdd\xd6\x1a\xdd\xd6\xfa\xb0\x89\xfe\xb9\x8f\x8d\xb0\x04Q\x9dJ\xa59\x1c\xd9\xed\xfa!BH\xe2~\xb7h\x01p\x8aɢQm\x8c\xfdG\xae\xc7\xf3\xfc\"\x13\x93\x12\xd7\r\xcb^\x10\x87$\xe9\x0fgb\rz;\xb8n\x80Tn1\xd8L*\x1c?b;\xb78\xa2\x90\xf8P\x8e\xe0\xd3yN\x9e\xe6\xc4IZ\x8e\x1f\xb7t3\xcdnˠ\xb5\x9a`\xe2r:\xdb+\xbb\x86\xd7\xd6\xeaw\x01\xec?r\x1d\x00Ks\x8f\xf3\x8dz\xc9<K\xb1f\xf7\xd8\t\x8b\x17DT\xeb\x13\x85v\xf6YȂ \xac4A@\x96\xc6\b\xe9\xe0\xfb\x15\x84㰵\xb1\x88r=\xaa\xf5qƦ\x8f02\xb1\x9f\x91\xb1}\xf4\xbbۀ,\a\xd2\x14\x1c\x89\x15\x96x\xd0\x19NA\x1d\xf4; \xa0Z\x1b-\fm\f\x9e\x1f\xb1\x9d\x1bt\x89G\xd9</2@,\x18]\x9c\xf6#\x04\x89)\xa6\x9e\xe6\xe58r\x8d\x83S\x1d'\x89;\x94\x83\x80\xff\xc1\xfc\xe9\x87\xee=x\xf4E,\x9e{\x84o\xf4K\x8aK&qV\x1b\x93D\xd5\x11\xbc\xa0\xf2\x9c#\xc3w{\xd6Ӹ\x87γ\xa2\xe13\xaa\xb3\xb5:Ϡ\xdb\"\b\xeb\x84\xd5\xe6\x90\xc93F\x97g\x86\x14\x13G\vYj\x8d<K\xe9ww\x86\xbb.\x19\xb4ɳ\x8czs\x9a,K\xb0V\xe3\x065zڰ\x9d\x1b\x12c˳\xb0\xca\x13\x0f\xf2l\xa8X\xecf\xc5p\x82D\x17M<~\xa3hN-\xdd\xedoX\xec\u007f\xb9\xec\x8a\x173\u007f\xfa\xc1o|cHQvP\x95\xc3\\\x82 *W\xa1\x18j\xa8\xf6\xe2,\x12a)\xbbJ\xa1\xd6\x18\xa7\xdb\xd9fc\xf5|1K\xcbu\vCX\x8b\xefW\xe8\xb660:\xb3\xd6ځ\x10\xd2\b)\xab\xbe\x1f2\xe8wHJ\xc1\x9c\xb5\x96,\x1d\x90%}\x82J\xadP?f)n\x10\xa1\xb5e-5l\xfb\x86\xc8\x1aT\x9e\x0f\xe7\xcb[ ֖\xb8la\xab\xba\x82\xf5\xd4\x10Du\xfaY\xba&\xa4\xf3qr\xf1O\xe7\xceܯ\xf9&\xb9\x84\x10\xc8\xdd\x13\x02\\7\xe0\x92\x9e\x1c\x94\xeb?\xcbeYQ \xa8\x80\r\xab\r\x9b\xa5\x83\xad\xc5s\x8f\x90e1\xb9NQ\xca\x1f\x8eyU\x9e\xcf\xe6\xda<Z\x9b\u007f\x13\x85\xe3\xf5\xa0R\xfb7\xca\xf5\x89\xfb]\xd6V\xce\xdeU\xfc\x9e\x1c\x8e\xd4K\xd3\x01B:\x84Q\x83<\x8b\xf1*\xa3X,[\xb9f5\xb3\xacj\x18$iq4R\x96\x93\x19\xcbv\x92\x91j\x8b\xc0\xe2\n\x89\xe7\xf94L\xef\x03\xe7\x9f\xfa\xf2\xd4\xf9'\xef\xfb'\xe7\xcfܫ\x0f\x1f\u007f\xf17\x8b=v\x9b\xa6\x8a\xb1ޮ\x1f\xec\xf9\v\xd7\v\x9f5!v88RJ\x91Ž\xee\xd2\xfcI\xcfX\xf3\x05c\xb4\x16B\"\xcbj\xbb12\xc5\xd6\xea<\xbd\xce\xf6\xef\x83\xfd\x0f\xf3s\x0f\xe8J4\xf2;F\xe7\xf7t:\x9b\xb6\xdf\xd9\xfa5騞\xe3\xfa(\xd7G\xe7\x19\xbd\xce\x16\xd6X\xa2\xea\bq\xbf\x8d\xe3\x17Ҡ\xae\xb6le\x9a\xd8\xc0V\x9cbrM/IٌS6\x92\xac\xd4\xf4\x16\x8a?!\xe5\xbao\xb2\xbb\x00\x8e]\xf3\n\x00\xce?u\xdf7\x8dA\x94r\x8a\x04Ƒ\x0e\x8e\xdc{\x96\x94\xe3x\xcfj\xeb\r\x82Z\x99~\xeal{c\xa9\x06\xdc\x19\x04\xb5\xd7\b!\x1f\xdc=X\xa5Z\x1feg\xe3\x82][9wJ\b\xf1\xcf6V\xe7\f\xc0\xe3\x0f|z+\xcb\xe2\xef5\xda\xfc\xe1\xc6\xeaܟ:\x8e\xfb\xaf\xa5\x90iq\x04\x85Ckk\t0\xf8A\xb5\x98e\xe2(\xdcJ\x13\x8c\xe1|\x9c\xd3Җn\x9a\xd3K\x12\xb2\\\xb33H\x87\x82 \x01\xd4=\x874\xd7Ow{\xbd5\x80\xa7\x1f\xbb\x8bo\xb6+\xcfͮ,\xf6\xd9c\xfbxƙNB\x14\xe7HYk{\xdd\xceV\x17\xecG\xd6Wνa\xf1\xfc\xa3\xb1\x10\xf2\xdf\t!\b\x82*\xad\xad\x15V\x97N\xf7\x1c\xc7\xf9\x81\x8d\xd5\xf3+\x13\xd3G\x00\xb8\xfc\xc4\xcb8\xfd\xf8ݧ\x9fz쯿\x13\xe8Y\x9b\xffgk\xed\xd3B\x88\"óE\x8d\xe38\nl\x11G\u0091\x02\x01\xe8i8\xd5\xcf\x18\x18Ck\x10\xd3\x1a\xc4\xc4ƔM3\xbbZ^\x90X\x11k#\xf8&\xbedq\x1c\x04{\x0e~ܕ\xca\xec\xdd \x02\xad3Ҹ\x9b\x1b\x9d~l}\xe5\xdcP\x1f\xb9\xb5\xbe\xf0'\b\xd1\xe9\xf7Z\xecl\\ؒ\xd2\xf9\xae\xb5峟\x9b\x98>\xc2\xfaJ!\x8c8\xf3\xc4=\x1c\xbc\xfc\x86\xb20;\xc1\xf2\xc2S\xb1\xb5\xf6\xff\x06Q\xb4%\x8b\"\xb8\uf7b1\x9b\xa7}\xa2у\xc5pe\xdfcK\xb8<\x15[\xb6\a)\xfdA\f\xc2\x19\x8e\xbb\x91B\xa0\x84`:p\x0fFJ6\xbf\xb9\r\"\n\xa4W?\xa3stw\xb0ץ\"\xae~g˦\xc9\xe0\xbf\x19\x93\xff\v\x80\x89\xe9#\x8cO\x1d\xda\xcd^\u007f\xb9\xdf\xdd2V\xd8\xf7\xac\xaf\x9c\xfb\xef\x97\x1ac\xf7\x9a?\xf3PY\x98=\x01\xc0\xf2\xc2\xc9?\x01\x1e)Pc\x8f\x9d\xad\xe5\xa2yG\xb9\xe8<\xc7\rj8~D\xbd^\xe3\xfaW\xdeJg|\x8a\x05\xbb\v,\x16\xf2\x1e\x03\x18+\xf0\xa5\xa0\xee\x89ف\xceo\x9f\xaeG\xec\x1f\x1b\xff\xa63FY\xa9\x8b\x12:\x8f\x9f}\xaa\x80\xeb\r\xb5\xb3%9\xb5\\\x1b\x99\xfa\x99͵\xc5l\xf7\x81o\xac\xce킉\xbf\x9dg\xe9\x0f\xad]8\xf3\xe7_\xcdͧ\xf7_\xb9\v\xc5\xfcC(\xe4@\xf1\xa0C\x92\f\b\xa3\x06Zg\b)\xa94g1i\xcau\xaf\xbc\x95o{\xdfwӫ6Xo\xf7\x86\xc7K\x18\v9\xc5\x00\x82HIR\xad\u007f\xc2W\xaeZ\xdc\xdc\xf8&4HI\xe1*\xd7Eg鞓q\x8c\x01Uf`\xa5\xa16\xa4\xa3>\xa7\xb3d\x05س\xfa'\xa6/c\xe5\xc2Sg\x96\x17N\xfd\xd2졢o\ue67b\xe3\x99\xd7\xca\xe2)\xf6\x1d\xb8\x92(j< \x85\xf8\xac)\x8f\xceko/\x11\xd5F\x89\xfbm@R\x1d;H\x92$\xfc\xf7\xff\xfc1\xfa\xdd.\xc7\xde\xfa\x06*\xb7\xddFm|\xec\xff\xaf\xee\xccb\xfc\xaa\xea8\xfe9\xe7\x9e\xfb_g\xebL;\x9d\xce\xd0\x0et\x9fV m\xb1\x82T\x01\x8dP\x8c\b\xce\x03!\xa8\x18\xf5\xc1H0\xd1\x001\xf2\x82\x18\xb7\x80\x1aY$.1<)\xb1\x04\x88,\x82`\x95\xb2\x14Ji\xb5l\x9dv\xba\xd0N\x87i\xff\xb3\xfe\u05fb\x9f\xe3ý\xff\u007f\xa7\xc5\a\x13ft\xe6>\xfd3\x99\x99{\x92\xdf\xff\x9c\xf3[\xbe\v:\b\x88L\xbcK@Ф,Ғ.\v}\x03\xc0Ҏ\x8e\xf9xdŗ\xaa\xb0\xac3,\xb5\xc1$\x99W]\xf7\xc9\xfa^wo\xdf\xd7W\xac\xdb\xe2\x9e\xfdOFO\x1ei|\x1eN\x8e\xa3\xff\xe6\x19\x19\x1a\xc0\x12V -\xeb\x8fFk\xb4\x89\xa9Ɓ\xef\xd2\xd4\xd2A\xe0\xd5P\x99V\x8cHѺ\xa4\x13\x94\xa4}\xf1BV\xf5_Kw\xff\xb5\x18\xa5\xd0&\x96\x8f\xd5\xc9\b\xb45e!\x8d\xfe.\xc0\xd0\xf8\xf8\xfc\vH}ZhI\x85\xe7:L\xa7J\xcaD\x04\x13DUZ\xd6\xc3\xfb^{\xda\xdb\xfe\xe7{\xa3\x8d\x97\xf6\xcf\xd8\x02\xf6\xbf\xf3\xa2\x11\xc2zEJ\xebh\xe09\x98HS)\x8f\xd3Բ\x10ϭ\xa2RY\x82Pҳzyl\\\x96\xcbcIA\xd5J1V\x8e\xdb2A\xd2F\xa9\x8f\x86\x05f\xfd|\xbd\xd4U\xfd®kIŶ\x12\xd98 \xcaNXt&\x1fN\xb3\xcf\xdb\xfb\xcac3\xba\x88\xc3\x03\xaf\x0e,_\xb3yw\x18\x86\xe7i\x13\xe2\xd5\xca\xe8\x96E\xb1f\xb0\xb2\x91v\x9a\xa9\x91Q2v\x0e\xafT\xa3V\xac\xf2\xf6\x8e=\x04\xc5\x12\x8bri\x94Rh\x03^\x10Rr=\xf8\xf0f\xba\xff\xdf\xf6{\xe0;\r\xa2\xe6tC\xaf\xb3ܠ[fc\x11\xab\x12[:!\xd4CR\xca(\xf4]\"\x13\xef\x92l\xbe-\x06/(\x9b\xf2\xe8\x14\xb5b\x99\xc9\x13\xa3\x8c\x0f\x9f\xe4\xe4;\xfb\xd0J\xe2:U,\x13\"LD\xa1R\xa5\x1ch@\x14\xe6m\xda[\xaf1\xea\a\x95\x8e\"tbe]\x17\x88\x9c̀\f&vt\x87\av>+\xa5<a\x8cAG>\xbeWEG1\xf4\xdf\xce\xe4\x19>r\x84\xb7^\xd9\xc5\xeb\xdb_`\xdf\xcb;\x11\x19\x1b\xa95U\xa7\x86\x89\x02\x8a\x95\nG+\x1e\x9e\x81r\xb5v\xfb\xd9\xef\xb9\xfa\xfa\xaf\u0383^V\xa2\xe4 \xa5lH\xbb\xd6զS\x96j(\xffD\x91\x0f\bkֿ\x1dR\xfd \x8a\xc2\xdfGA\x80I\x1bj\xd5\x12\xcdm\x8b\xb0Ri*\x05\a\x1dD,_\xbd\x02\xa7\xe6p\xf4\xf0\x11\na\x8crw&\x8aL\x86\x82\x89А\xcad\x1f\xfb\xe5\xaf\xeeu2\v\xd4o\xa4\x90\x97\x8cM\x95\xf5\x0f\xef~\xf6\xb2g\xb6=T\x9c\xeb\x01i\x88\xcf4,J\x13\xc4_\xe0;1MY\x88\x18\x00\x1d\x03\n\x83\xd9^\x90mg\xff\x10E\xe5\x9fi\xad\x17Da\xd8\xf0_\x17\x96\x8d\x14\xb0a\xf3&\f\x86\xd1\xe2\x04E\\,K\x12\x060\xaa\x15\xad\xedm|\xfc\xa2\x8d\xe6\xf2\xcf~\xba?\xbb@\xf5\x83\xa0Vs\xf8\xeb\x13\xcfӢN\xad\x04\xf6̇\x1d\"\x1b'W\xc3\x16\xc8\"\xf0݆!\xb1L\xa8a0\xbb\x01Y\xb5n\v\xfb\xdf\xdc\xee\t\xc1\xe3\xc6Ĩ\xc6\xd8a\xc7Ųs(\xdbƶm\xf6\x1f:\xc0{\xef\x0f\xd1\xd5\xd5ò\x15+Y\xb6\xe1\x02.\xbev+\xfd\xdf\xfe\x06[\xbfx\x9d\xe8X\xd4\x12\xfb\x1f\x06!/\xed\xdc\xcb\xe0\xbboO\xfdk\xf7\xee=\x00\x0f>\xf0`\xe3}ߺ\xfdι\xd7\\\x8ct\xb2C,y\x86\xf7\x92\x10P\xab\x16ij\xe9H4J\x00\xa8\xce\xe6b\x06\xdf}9\x8ez\xe0\x15,+\xd5@1\x06n\r\x95m&\xf0}v\xbf\xb9\x97\x9a\xef\xb3q\xe3\xc5,Z\xdcɊM\xebIe\xd3Ȕ\xcd\xca\xf66R\x89\xc8\xc9d\xc5\xe1\xc0{ü\xb6s\x17\x93\x95\xda-\xf5w\xdc|\xcbiJ\xc1}w\xf7q\xff=s\xedȢ\x8e:\x89gޱ,]\xdcE\xd5a@\xad4ѐ\x8b\xfd\x1f\x0ei\xba\xb5\x0e\xd0Q\f-\n\xa3\x80L\xba\t\xa4drr\x92\xbe\xf3/d\xe1\xc2E\xac\xbdt#*e\xa3\xb5\xa6\xb7\xb5\x19М*\xb9LT\x1c\x0e\x1f8\xc4\xdf\x1e}\x8a\xc8\xf3\xb6m\xbedӓ?\xfa\xe9\xf7\xbbl\xa5\xaeV\x96\xb8ɘ\xf0\xf2\xa1\x13c\xfdB\xdc\xf0\xf8\x9c˰d\x83\x8e bb\xce\a\xfa\xf3~#\xfd\x95Һ\x04x\xa2\xa3\xb3\x97\xf1±\x19_̒\xa5k\x19\x19\x1a\xc0\x18.\xd5QD(b\x8b\xed(\xf4\x11\xf9V\x90\x16)c\x91J\xa7\xe9<\xb7\a\xbf\x1a{\x19\n!\x18\x1c:\xc9d\xa9\xcc\xf8\xc9S\xbc\xb5럜8\xfc\x1e]K{\xaa\x9f\xba\xe6\xca\xd4\xc76\xf4\xbd\x9eM\xa5\xd6ض\xc2q]\x9e\xfb\xcb\xf6\x81\xdf\xfd\xe6O\x8f\xcf\xd5{D5\xaar\xeb\xb4\x17a6ׂ\xebT\xa7\xdd#\n0?\a\x9e\x18/\x1cc6\x82224P\x1f\xc3t#\x04\x91\x0e0ɗB\b\v\x95i\xc1TF)\x9f\x1ac\xec\xf8\b\xc5Bl\xbeR\x18\x1dejj\x8aZ\xb9B\xb9X\"\xd7\xdc\xcce\x9f\xdfʪ\xf5k\xf2}\xcb{\xaekok\xc1u=v\xbe\xba\x9b7v\xed\xe5С\xe3_\x06\xb8\xe0\xfc\x8f\xf0\xe6[s\v\x97eL\xa3Rg\x1a:\\ U\x8a\\\x93Jd\x94\x1a̪\x95\x1d\x9d\xbd\xcf\x00_\x18/\x1csgcA]ݫ\x17\x18c\xb2@l\x89\xe1;(K\x11\x06\x0e\xcd\xdd\xeb\x19{\xf79\nǏS\x9e\x98D\xe4sL\x96\xcbg(\u007f\xa62\x19\xae\xbe\xe1:V\xac^\x8e%4\xc3\xc3#\xecx\xee\xef\xbc\xf1\xda\x1e\x1c\xc7Akq\xab\xef\xb1\xe7\xc7w]\xc1\x1dw\xfec\xce\xed\x8eF\x1d\x12K%\x85\x8d\x16\xb0\x00T:K&\x9b\xc7u\xaa\xd3\xdb\xf2[\x81\x1d\x1d\x9d\xbd7\x8d\x17\x8e\x1d\x00ft\xb7\x84\xa1{\x95P\x16\"\x11\xfbw\x9d2v*\x83S-\xd2ܱ\f\xbd\xea\x13\x94\x8e\xed\xa1V*\x12:\x0e&u\xe6ع\xb5\xbd\x8d\xc1}\xfb\xd8\xf5\xfc\U000d498a8\x8e\x83\xb2,\x94RU!\xd4}\x87\x06\x87~\x01pǝ'\xe6z\xdaK\x02\xfb\x89e\xe6\xa20Fwdr\xad\r\x9a´g3\xf0bGg\xef\xd7\x00\xeaG\xd8L<\xb5\x9a\xfb\x881\xfa\x11C\xdc.\tC\x1fש\x10xN\x8c\xf9]\xb2\x96\xf6\x95[\x90v\n\x15\xf8H?@\x04\x01\x84!Fk\xdcj\x89\xa3\a\a\x99\x1a\x9f\xc0D\xa1\xc9Z\xf6q%\xc46\x8c\xfa\xca\xc1\x83Cw\x00\xacX\xbd|\xee\xb7N\xear\xd8\xf5\xfb\xa4\xa1Ӗ\xd0\xd2\xfe\x83\xc1I'\xf0\xeb\x8e\xce\xde';:{\xbbf\"(\x1d\x9d\xbd\xd4*\x85Ȓ\xea\x9b\x18\xf3B\xfd\xe7\xaeSƭ\x15c\x13\x19\xcf!\xb3\xe0\x1cҋ\xd7!\xec<© <\x0f\xe9y\xe0T\xa9\x94\xab\x8c\x15j\x04\x91M\xa0\xdbJ\x9e\x9f\xb9-<R\xf8\xd2ࡡG\x01֬Y\xc3\xe1\x83G\xe6~\xb7wz@\x84\x94\rUf!D\xac\xdbk4\x81\xf7\x01*\x85\r|.ɼ\xae\x18/\x1c\xfbPuJ\xfd\xd8\x1b>\xf6\xce\xf8\x92\xa5ko\x06\xf34\x88s\x8dѢ\\\x1a\x8b\x05\xf4\xa5E.\xdfFӢ\xf3ȶ\xf7\xe0\x97\v\xd4&ާT-ad\x9a\xb6\x85\xbd\xb4Y\x8a\xf2\xd4)t\x14Ԍ\xcc\x1e\x1f\x99V\xd0\x1e8p`\x9e\xb4ߵ\xc6h\x83\xa5Rd\xb2MT+\x13d\xf3\xad\x8d\xfa#\x97o\xa3|\xd6D\x91\xd3c\xed\x8f&\x8d\xc7\x19)\x1c\x93\xf4w\xff\x92\xa5}[\xc0<\f\\\b\x84\x95\xf2\x16\xa7\xb0W\x00\x00\x01dIDATXG\xb9t\n!-\x94\x15\x1b\\\x1a\xc0\x18\x1b\x99Y\x80\x94\x92je\x9c\xd0w'-\x95\xda\a➑\xa1\xfd\xbb\xe6\xed<\x84D@/F,\nB\xdfo\x80焐\xe4[\xda)O\x16\xa6\x9b\xc8k\xe0\x01c\xccrc\xf4\x8c1\x91\xea\xe9\xef\xc8\xd0\xfe\xf7\x17\xf7\xac\xbaFJy\x19\x88\x92\x10b\x99\x10j\x1d\xd0\x1b\x86~\xb71\xa6S\b\xd2\xf1N\x15Rk\x0e\x02/#\xe4\xf6\xef\xfc䥗n\xbb\xb1]Ϸ`hm\x8c\x02<\x93\x98w\xa9\xa4\xa1\x98\xb23xn\x99T&\x97T\xee\xb1u^&\xd7\xd2\xf0H\a\x8c\x90\xb2\xed\xfc\r\x9f\xbc\xf1\xca\xcf\\\xe5t/=\xd7\xc2\x18\xf2\xf94ժ\x8fR2q\x9a\x89\x01\f\xb6\x9aN\xb9\x16ӪSA\x94\x00\xa5\xeb\x8dN[Y\x18\f=\xe7\x9cW\xd9\xd4\xd7\xf2T\xfdw\xaf\xbf\xe9V{x\xe8\xa8]\xad\x96\x15:\xb4Bmd\x14\x85` \x97\xcf\xfb\x9b.\xba\xd8\xf9\xed\xfdw\x85\xcf\xec\x18\x14۞\xdcm\xd5A\xdfn\x10!0\xb4\xb6\xe4Б\xa6Tq\x12\xea\xc2\xe9\xec\xc6\x18\xc8fl\x820&\x02ջ\xafQ\x14\x93\x8e\x8c9=\xc8s\xbd\x80tJ\xe1\xf9!)\xdb\"\x9d\x8e;\x06A\x10%f\xca1\xbe\xb9\xf1Yk\x94\x8a-2\x82\x84\xc0\x8a\x81R\xc5#\x97\x8d\xffV\ba\x1c7\xb0\xfe\r\x93\xf0F\xaf\x93\x89_v\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_16_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14%\x13Q\x84h\x8e\x00\x00 \x00IDATx\xda\xec\xbdw\xacf\xe9}\xdf\xf7y\x9e\xe7\xf4\xf3\xf6\xf7\xf6{\xa7\xdc)\xbb\xb3\xbd\x8a\\\xf6e1U\x18\x89M\x89\x117Ɂ\x10\xc3q\xa0 A\x9c\x00\xd6\x1a\x01dIp,[A\x80\xc0\x8e\x04\x19\xb6 \xd9)\x8a\xa8B\x88Ԛb\x13\xc5%\xb9\xdc]n\x9b\xdd\xe9\xed\xf6\xfe\xd6\xf3\x9e\xfe\xe4\x8fs\xdew\xe6\ue4aa\xb3Z.\xc5\x03\xcc\xceνs\xe7\xdes~\xe7\u05fe\xbf\xef\xef\xfb\xc0\xf7\xaf\xef_\u07ff\xfe\x9a\\u\xcf\xe6\x9ec\xf3\u007f\xa6\xbfW\xf7\xec\xef\xca{\x10\xdf+\x86\xe8\x06\xd1\xe4ϳ\x8d\xcaR\xb3\xea\xdd!\xd0\vZ#\x01\xdd\x1fE\xbd$\xcbv\xe3${\xb53\f\xf7\xbf[\xef\xe5-k\x90\x86\xef\xd0\x19\x86\x004}g&\xc9\xf2\x0f\x98J\xfe}ː\x8fI!jR\b\x84\x10\b)@\x83\x10\x90kM\x96kt\xaeGi\x9e\xaff\xb9\xbe\"\x84x\t\xc1+I\x9a]TJ\xed\x19\x92Ή\xf9\xf6\xfe\xd3\xe7W\xa3\xefd\xf0\xef\x1b\xe4O\xb8j\x9e\xf5!\xad\xf9\xf9\x85\x86\xff\xb6٪\x8fg\x9b8\x96\xa2\xe2\xbax\x9e\x8da\x9a\b@k\xc8uN\x94dDiJ0\x8a\x19\x86\x11\xfd\xe1\x88a\x18\x13\xa5\x19Q\x9a\xf5\xfaQr%I\xf3K\xa6\xa1\xbe&\xd0O\xee\xf5Gg\x01f\xea>\xdb\xdd\xe1\xf7\r\xf2\xed\xc2\x13@7\x88\xa8\xfb\xf6'$\xe2\xd7\x1e>6Si\xfa\x0eI\x96\xa3\x94\xa4^\xabb\xbb6\x15\xc7F)\t\xba\xb8S\xad\x014Z\x17\x1f\xcauN\x96\xa4$i\xc6`\x10\xd0\xeb\x0f\xc9rM/\x8cY\xef\f\xb3\xad\xee\xe0@J\xf9Պc\xff\xd4\xea^w\xf7\xfb\x1e\xf2\x9a\x90Q\xf7l\x99kmk\xad\x85\x94\xf2GL%\u007f\xf3\xd4L\x83A\x94\x10%\x19\xf5\x8a\x8bmYh4:\xd7\x04qB\x92f8\xb6\x89\xefXx\xb6E\xb3\xe21U\xf71\f\x85\x92\x12!\x04I\x9c \xa5$M\x13\x0e\xf6\xbb\xa4i\x8a@\x90\xe49/\xaf\xee\xb2\xdd\v\x9e\x95R|h\xaf?\xea\xfc\xb57\xc8\xd8\x18\r\xcfnfZ\xffצR\x1f1\x95|\xc41\x95\xe7Y\x06\r\xcfa\xb1]gn\xaa\x81_\xf1&^ \x84\xc0P\x12˶\xc85\fF\x11\xc3Q\xc4~\u007f\xc8AoH\x96\xe5 \x04\xaem\"\x01CJ\f%ql\x13\x95gD\xa3\x90<\xd7h\xady\xfe\xc66۽\xe0\x1ft\x82\xe8W\x8eN\u05f9\xb1\xd3\xfd~Ȫ\xb9\xd6\xef6}\xf7\xc7\xee\x98kbJ\x89m*lC\xe1z\x0e\xd5Z\x15\xd34Ѕ5n\x1a\xb3Q\xa5ި\xa1\x94,\xf2\b 4dYF\x9ae\xe4\xb9f\x14'\xf4\a\x01[\xdb\xfbDQB\x94ft\xca\xf05\xe5\xdb\xcc\xd4<V\xf6\a\xbc\xb2\xbe\xb7\xb1\xd3\v\x16\xfeZ{\xc8$T\xf9\xcecB\xeb\xcf\u007f\xf0\ue8de!%\x1a\x90R\xe2\xb86\xa6i`\x9a&\x8es\xb8\xaf\x98\x9an\xd2h\xd4^s\xb7\xaf\xb9\xdd2ѣ!\xcbs\x86\x83\x80n\xa7G\x14\xc5ę\xe6ŋ\xd7\xd9\xdc\xef3߬pn}\x0f\x8dx\xffno\xf8\xa57\xb2\xeaR\xdf\xcd\x06\x89\x92\x8c3'\x96\xe8t\xfb\xffꎹ\xe6\x833\xb5\"$I)J\x8f\x00\x8d\x04%\t\xe3\x84Q\x9c\x90#h\xb4\x1bd\bzÐa\x18\x11\xc5\tq\x92\x92\xeb\"\xbbK!Q\xb2(\x89\x85\x90\xa5\xad\x04\x96m\xe1y.B\b\xf24e\xaa\xea\xb3ج\x10&\x19\a\xc3\x11A\x94\xbc\xd32\xd4\xef\xf5Fq\xf7\xaf\x95\x87\xd4=\x1b\xc71\xd9\xda\x1f\xe0;ֽ\x15\xc7\xfc\xc2{O/MGiF\x10'\x04q\x8aR\n\xcb6K\xef\xb0\xf0*\x1e\xaem\xa1\xa4 \xcbr\x84\x10E\xa2\xces\x04\x02\xc34\x80\"\\EQ\f\xc0L\xb3F\xabQe\xa6UGIA\x92\xa6\xa4iF\x14%\xecl\xef\xd1\xef\r\x18\f\x03l\xc3`u\xbf\xcb\xean\x87\x1b\xbb\xfd\vI\x96\xff\xb8\x92\xe2\xa57\xc2K\xc4wk\x98\x02hU\xdd\x0f\xc4I\xf6O\xab\x8e\xf5x\xd51\xa98\x16U\xc7b\xaaY\xa5Z\xf1\xf1\x1c\x8b\xe9\xa9&\xd3\xd3-<\xcf\xc64Ml\xdb´,\f\xc3@)\x89_\xf1q<\x9b,\xcb\t\xa3\x848M\tØ~?\xe0\xda\xca\x06\x1b\xdb\a\\\xb8|\x9d,ϩW<\x8e̵9:\xd7f\xd8\xe9\xd3\xeb\x0f\tG!q\x94\x90\xe49q\x14qy\xf3\x80\v[\a_ʵ\xfe\x80\x00}\xbb\x8d\xf2]\xe9!5ך\x93R\xfe\x9a!Ň\x9b\xbeñV\x8dv\xd5E\x00\x8e\xeb\xe0W<\x1c\xc7faq\x86\xb9\xb9i\x1c\xc7ƴL\fӠZ\xab\"\r\x85\x14\x12\xcb6QJ\xa1\x94\x81F#\x10h4h\x8d\x06,\xcbĴL\x942\xb8r\xf1*\xaf\x9e\xbb\xc2\xd5\xd5-\x9e;{\x05\x95g\x1c\x99\xae\xd3\xf0\xdd\"\xa7D1I\x92p\xd0\x1b\xf2̵-\x86QR\xe9\x06\xd1m\xef\x14\x8d\xefB\x0f\x99ʵ\xfe\xec\\\xcd{\xf0\x9e\xc5)lS\x91\xe59\x02\xb0l\v\xdfw\xa9V|\xe6\x16g\xa8U}\x1c\xc7\xc60\x8d\"\aX6J)@\x94\xc60\x90J\x82\x00Q\xbe{\x82\x12NA\xa05\xc4q\x82\xed(\xee\xb8\xe7\x0eFÀ\xb9V\x9dw?t\x86\v\xd7\xd6\xf9\xc2W\xbf\xc5\xf9\x1b[\xd4*.*\xd7L\xd7=\f)\x91\x02\x94\x94u\xe0{\xdf Z\xeb\xaa\x14b\xf9\xd4l\x03C\n\xd2,G\x19\nC\x19(CQ\xa9\xfa\xcc/\xcc`Y&RJ\x84\x90H)'E\x94\xd6\x1a\xa9\x14BH\xb4\xd6\xc4Q\x8c\x14\x02\xa9$\xca(\f7Ƹ\x04\xa2\xf0&\xa5\x90RbZ&\xc3A@\x9a\xa4\x9c8:\x8bŃ\\\xbf\xbeN\x18'\xect\a\xbcpu\x93\xbb\xe6\x9b\b\x04\xbec-\xee\x0fF\xeb\xdf\xf3\x06\x01L)D\xad\xacJQJb\x1aE\x8f\xe1W<\x16\x96f'\u007fN\x92\x94\\\xe7\xe4\xb9FJA\x92$(\xa5\x90iF\x16G\xa4I\x8a\u038b\x06P\x17\xe5\x19\x96i\"\xa4$\xcf2\x04\xe0\xd7*\xe4^\xc6\xe6J\x87~w0\xa9\xb8\xb2LS\xadW\x10\x02<\xc7\xe2\xb8\xdbFkͷ\xae\xae\x93\xe49y\x96ͼ\x11\xc0\xe3w\x9f\x87\x80PR\bAQ%\x19\x86\x81\x90\x82F\xbd\xc6\xf2\x89\xa3\x054\xa25i\x96\x11\r\x03\xe2,\xc3q\x1c<\xcf%\x0fF\x8c\xc2]\xf6;\x03v\x0fzl\xefu\xe8\xf4\x03\x820¶m\\\xc7B\xe7\x9a<\xcfH\x92\x94`\x14\xf1\xe0\x99e\x1e\xb9\xf7$\xb6e\x90f\x19RLZH\x84\x10\xb8\xaeC\x18Fh\xad9>\xdb\xc2U\x82\xe7/\xaf\xb3z\xd0\xffe`\xe9v'u\xe3\xbb,\u007f\x90湶MC+%\x84e\x16\x95R\xadV\xa59\xddbs\xbf\xcb^\xa7O\x9e\x832%\xa6ea\x1a\x06\xca0P\xa6\x81mY8\xb6E\xbd^\xe1\xed\xa7\x8e1\xd5jЬW\x8a\xf0&\x98\xbc\xfdcx%\xcfr\x9ez\xf6\x15\xbe\xf1\xd2%t\x96\x16\x86\xd0L\f\xa2\x04t\xf6\xba\x18RP\xf1l\feP\xf3]\xeeY\x9ab\xad\xd3_\xac\xbaf\xb3?J\x0e\xbe\xa7CV\x9ekm\x1bJ\xb8\xb6\xc5n?`\xbd3@\x19\xbb,\x1e\fX\x9ci17\xd5\xc0P\x92\xe5\x93\xc7h\xb7\x1ax\xbe\x8b\xef\xbb8\x96Y \xb8YN\x92$diJ\x9e\xa5\x1c\xec푧9R)\f\xc3\xc0\xb0LL\xd3(<@\n\x1e}\xe0N\x1e\xb9\xff\x0e\xae\\\xbe\xce\xc5K\xd7\xc9\xf3\x9c8N\x18E1\xdd\xfe\x90\xddN\x9f J\xe8\x0eG\x18Jr\xf7\x91Y*\x8e\x8d\x14\x02\xdfsO\xf5G\xc97\xbf\xe7\f2\x8e\xc3\xdd \xa2\xe6ُ\xf5È\xaf]\\\xe3\xd8t\x83\aN,p\xfa\xd41Z\xad\xfa\xad\x89\x1f\x95gH\x9d\x13\x0f\x03\xb20\xa4W~\xfc;B\x12y^\x94\xb8R\x92g9\x00\xe10\xc4\xf5<\f\xd3\xe0\xd4\x1d\xcbt\xf7;\x84aD\x96\xe7\xe8,g\xa1\xdd\xc0\xc9\xf2\xa2J\x13\x82Q\x1c\xf3\xf4\xb9\xeb\f\x86\xa31~\xff_\xbd\xfd\xf4\xd2\xc17.\xae^\xfa\x9e\x81N\xc6\xc6XjU\x84\x86\u007f\xe1\x99ƿ\\\x9e\xaa\xf3\xe0\xf19\xa6\xeb>\v\v\xb3\xcc/L\xf3\xdag]\xab\xf9\x05\xa2k\x18\xaf\x83\xa8\xbe\xddeY\x16\x9e\xef\x91e\x19\xa3  \x8e\"\x10\xa2(\x1aL\x13\xc7u\xa8֫\xac\\_E\x8a\xa2_\x91B\xd2\x1f\f\xc9\xf3\u0080\xa6R\x9c\\\x98b\xb1UG\xe4\x19\xbdQ\xf4\xe8\xee \xf8\xe9\xb9F\xf5w\x0f\x06\xa3\xcd\xdb\xf1<\xe4\x9bm\x90n\x10Ѯ\xb8F'\x88\xff\u05cam\xfe\xe3\xbb\xe7\x9a,5\xab\b!\xf0\xab>\xbe\xef\x1e\xea_\xb5\xd68\x8e\x8dm\xdb%\x14\x92\x92eٟ\xe8\x1d\x00\x86UL\x0e\a\xbd>\xe1($\n#\xa2Q\x88\x94E9l;N\xd1\xc3\xe8\xc2\x1b\xcaQ\x16\x9e\xe7L\xfem\r\xa4Y\x8e\x90\x82\xa5v\x8d\xc7N\xce\xd3t\x1d\xa28\xf9\xd8\xedz\x1eo\xbaA\x1a\x9e-\xe2,\xfbI\xdf2\xfeǻf\x1ax\xb6\x89\xedXT*>\x96e\x11\x86\xf1\xe4\r\x1d_\xd5je\xd2O\x8cg\x16Y\x96}\xfb\x1a\xda4\xf1|\xbf(\x95K\x83\xde\xfc\xda\x02\xe7R\x86B*Ig\xbf\x83Tr\x82\x83\x01\x98\x96y\xd8آx=L\xcb*<ܵ\x18\xc5\xe9\x89\xef\x99\x1c\x92k\x1a\xa6\x92?wr\xaa.L\xa5\xa8U<\xaaU\x1fӶ\xca^#!I\xd22\x11S\xc2\xed\xc6-\x88\xba\x98\x94\xa8\xaf5D\xa5VEJ9y\xa0\x05\xa2k\x13\x8eF7\xbfV\x94=\xbc.\xbeF\xe7\x1a\xe4M/q\x1c{b\x9c\x9b\x90\xbd\xc64\x14\x01\xe0Z\x06\xc0\xa3\xb7\xab'y\xd3\r\"\x04\u007f\xab\xe1سU\xdb\xc0\xb1-*U\x0f\xd34\xcb\xf2\xb3h\f\rC\x1dz\xd0R\x95\x9d\xb9\x14\xb8\x9e\x8bi\x9b\x88\x82\xedC\x96fh\x9dS\xab\xd7ɲ\x8ca\u007f@\x14G(\xa9\xa8Ԫx\xbe\a@\x9a\xa6\x18\xe5P+\xcbsr\xad\xa9\xd6*\x13C\x8c\xbdH)\x85e\x99\xc4q2\t\x99Z\xeb\x92͢q-\x03!\xb8c\x1c~߲!kLV\x90B\xfc\xe7U\xc7DIA\xa5\xe2\x16o\xa9\xce\x11B\xdf\xd23ܚ\x9cM\xa4\x14e\xe7\xeec;6BC\x9eg\xe4%\xccbZ\x16Y\x9a\x12\f\x87\x84a\x88\xce5i\x9a2\x1c\x14Г\xe7{\xb8\x9e[\xe6\f\x8d\xa1\f\xb4\xce1Mc\x02\xd3S\x0e\xc1\n\a\x14\xb7\x94\xe5\xf9\xa1\x9c\xe2\xdb&R\bU\xf7\xec\xfb\xdf\xd29d\xfc6I)\xde\xe6\x99\x06\xa6a\xe08\xd6$P\x17 a\xd9U\xa7\xe9\xe4\x019\xae\x8d\xd6\xc5C\xb5,\xab\xac\x9aFD\xa3\x90(\f\x89ˮ:\x8c\"\xe2r\xee1\xbe\xe20\"\x89\xe3\x89\aH)\x11RN\x06UI\x92\xbc.\x17\xe5\xb9&\xbf\xe5cc\x0f\x99\xbc \x86·M\xb4\xe6\xbdo\xf9\xa4^\xf3lG\xe7\xdau\f\x85\xe3X\x87\xe2}q\xe3 \x84\xc4PE\xc8RF\xd1\xdcQ\xc2\xf0y\x9e\x13\x87\x87\xc3D\x9a\xa6\xa05Yi\xc4\xd7\x0e\x1b\xf2\xbc\xf0<)D\x11\nu\x01\xc7\v\x01\xa3 <T@\x14\xc6\xc8I\xb3\xfc\x90A\x8a\xdfo\xfe\xb9\xea\xd8h\xf4;n\xf5\xfc\xb7\xa4A\x04\x9cA\x80i\x8c\xdf\xd2\xc3of\x11^\xdcI\x0e\xb1-\xab\x8c\xebE%T\xe0R\xf9\xeboJ\xa9\"9\u007f\x9b\xe1\xcf8oH\xa5\x10\xb2\x80\xe6u\xc1f,\xbc\x85\xc28B\x14\xe1)\xcdJ\x80\xb2\xbc\xb2\x89qn\x1a\xa6\xe6Z\b!\x1e\xb8\x1dy\xe4M5\x88\x86\xa3\xa6\x94\x18\xb2 \xb3\xdd\xea\x1d7K\\\xbf|\x895\x96m\x96\x10;\x93\xa4n\x18\xe6k\x8a\x04\x81eYخs\xc8(Zkl\xb7\xcc\x1b\xb7\xfc]!$YV䅢\x83\x17\x87^\x88$\xc9\x0e\xfd\\\xaf\ri\xba\x18\xa8\xa1\xb5^z\xdb\xf2\x1d\xf2-\x1d\xb2\xd0,\x8c\x13\xb4*C\xd1\xcd\x12\x15\f\xc3\xc0q\xecI\xef`\x1aŜ\xa3\xc0\x9b\xe2\xe2c\x96y\xa8\xe4u܂\xa4`\xdb6\x8dv\x03˲\xb0l\x8bZ\xbd\x8e_\xf1_\x0f-KA\x9e\x17\x0f9\xe7ph\x12\x82\t\xccRxǭ\r\xa8\x98\xfc=\xdf2\x10B\x98\x9dx\xff\xa1\xb7t\x1f\xa2\xd1\xf7U,\x83</\xe0\xf4\xf1M\x8e\x9fo\xa5\xea\xdd\xf26\xcbI\x8e\x11B\x10\f\x86Eb\xb7m\xac\x12\xec\xb3l\a˱1\f\x834I\x10BPkX\x87j\xec<\xcb&\x8d\u007f\xaes\x1c\xcbE(\x85@\x10G\xc9$όC\xe18<\x8e\xbde\xfc\xffR\xde\xfc9M\xa5\xf0,Cu\x06\xe12\xf0\xec[\xd6 \x02\xaa\xe3\x04\x1bG\tT\x8bA\x93\x10\x82<˩ժ\x93\a3\xfe\xf8\xf8\xf2|\x1f\xc7u\x8b\xf2\xd50PJ\x15y\xa5\x9c\x9d+\xa5\xd0yN\xaest\xae\x91J\"\x95\"+\aS:\xd7hA\x91G\xca\xf0\x94&\xc9k2\x0e\xc5\xc0\xab|\x11ƞ4\xfe\xfc\xb8,\xd7h\x9a\x9ec\xac\x1d\f\xce\xfce\x1b\xc475d))\u007fiw\x18\x12$\x19à\xc0\x95dɷ-&\x81\xf9-\x8dZ\xf1\x1fe\x184\x9aM\x9a\xad&\xf5V\x13\xdbq\xb0&,\x13\x85i\x14d\ae(\x94i`\x98&\x86U\x90\x1d\xf2,'O\xb3\"\a\t\x81\xces\xe2(.g\xec\x94e\xb2\xf8\x0e\xb8\x98~]Ot\xb3\xd2\x02\xdbT*\xcb\xf3ſlb\u007f\xd3<\xa4\xe9;\x1c\f\xc3\xe7\xeb\x9e\xfd\xfbk\xbd\xc1GN4klm\x1f\xb00?\x85\x94Eϱ\xb5\xb9\xcd\xd1c\x8b\xb7\x84\tI\xad^\xc30\r\xa4a0\xec\x0f\x18\xf6\x87\x1ct\xbb\x8c\x82p\x02Aj\xc0q-\\ǥR\xad\xe0U<l\xdbB)E\x14\xa6\x98%\x142\xc1\xb5J#$\xb7x\xc8xn\x88\x18\x87&Q6\xa4c\xe3\xe8\xc9\xd7#`\xa33\x8c\r%\x9f\xfc\xcbzțf\x90\x83r\xd9F\b\xf1\xd3\xdd0\xf9\xc1~\x9c\x18\xa9ְ\xb9\xcb\xd1\xc5\x194\x9aQ\x10\xb2r}\r\xc7s\xb1L\x83,\xcd\xd8\xd99\xa0?\nY\xdb\xdack{\x9f\xee d\x14'\x98\xb2\xa8\x90\x82(CJ\xa8\xba6\xd3\xcd\nՊ\xcf\xe9c\vLM\xb7h\xb4\x9a\xcc/̕\x0f3/<\xb2d.\xea<'\x8d\x93\xef\x9c\xeft\xf1B\x8c+\xb1q\xb3\xaa\xa4\xe4\xd2\xd6\x01a\x9a]\xf9\xef>\xf9\xf8\xa7\u007f\xf6ן|kz\xc8\x04\x9b2\xd4\xfb\x9245v\x86!\x96!\xd9\x18\x8cHm\x87\x99\x9a\x87\xd4\x19\xa38a\xb8\xb9G'\b\xb9\xb6\xb9\xcf0\x8c\xc95DI\x8a\xa9\xe0\xeeE\x97\x0f\x9c\xa9\xf1\x1bO\xed0\xd76\xf9\xc4;\xa7\xf9\xf9\xff\xeb\x06\xfd0\xe5̑*\xfb\xfd\f\xd7q\xf9\xe0c\xf7r\xff\xe9c\xac\xad\xacq߃\xf7\xb0\xb4|\x84~\xa7\x8f_\xf7ɳ\x9cQ?\x98<\xe4[]$\xcf\xcb\xf5+4Jʢ\x94\x16\x90&)R\bz\xa3\x88\x1b{=\\\xcb\xfc;?\xfb\xebOf\x8b\xed\x1ak{\xbd\xb7\xaeA\x92$\xfd\xd0B\xddg\xb9]%\xcb4\x1f\xfe\xc8{\xa9\xcd\xce\xf0\x8b\xff\xfa\xfffk{\u007f҈ɲq\x1cC\xee5\xdf\xe4Ĝ\xc7O\u007fh\x96_\xfe\xf26\x9f\xff\xd5\xf7\xb1\xbe/X\xbdx\x9d\u007f\xf4cG\xf8ͯl\xd3\x1d$\x98\nV\xb7\xf6\xf9w\xbf\xf3%\x1e:s\x9c\x8f\u007f\xe0m<\xfdԳ(\xcb\xe0\xe8ɣ\xe4%\xed4\fFdi~8\xa9\v]4\x85\xb7\xf4=\xe3\xe9a\x92\xa6\f\xe2\x84\xe7\xaeo\x11\xa6\xe9\xff\xd2\xed\x05\xcf\xce5\xab\u007f)c|W\x18\x04\x81)\x85 \xcf\xc1\xf5l\xba\xfb]\xee{\xe0\fO\xfe\xe6/\xf1䗞\xe1\xc9/|\x9d\v\x17op\xed\xc6\x06\xa6Q\xc4\xfb4˹s\xa9\xc2\xdf\xfd\xc0\"sM\x81\xe7IZ3\xf3\x98\x15\xc5\xd9\xe7.\xf1\xa1;j\xac\x1fd\xfc\xfe\xd77H2PR`+\x83g_\xb9\xc2\xf9k\x1b|\xe4=\x0f1\n#\x8e\x9fX\xc2\xf7+\xc5\xcc#ϊE\x9d\x922$DIxК\xf1\xbeɸ\"\xd4\xc0vw\xc8K+;\xf90N~a\x18&?\xdb\xf0m6\x0f\xfa\u007f\xf9B\xe7\xcdD{\xa3$ñ\xcc\u007f\xd2\xf6\x9d9\xdf2\xf0=\x17\xdb4\xb9\xf0\xea%z\a]\x96\x97f\xf8\xd8\u007f\xf68\x1f\xff\xe8\a\xb9\xf3\xf4Q\x0e\x0ez\xac\xaco\x13\xc5)\xfdQƱ9\x8fF\xc5b\xdeS\xbc\xf4\xf2u\xf6Vֱ\x85\xc5彌Ͽ\xb0ˍ\xed\x11I\x9aS\xf1\f\xd2,'\xcb!\x8c\x12^8\u007f\x9d\xad\xbd.F\x9e\xb3rc\x9d˗\xafs\xed\xda\x1aA\x18c\x99\xc6$yk\xad\xe9\xf5\x87l\xef\xf5\x18E\x05\xf1a\xbf\x1f\xf0\xc2\xd5u^^\xd9!J\xb3ܐ\xf2\x0fj\x9e\xfb\xb5,\xcfu\x94d\xb7\xa3\x15xs\xaf\x96\xef\xe8\xbb皴\xab\x1e\xb33m\xaa5\x1f\xad5ǎ-bZ\x06RJ\xeez\xf0.\xeey\xf0n\x924cw\xf7\x80O}\xfaK\xfc?\xbf\xfd\x87\xecnm\xf1\xe8\x99\x16\xcb3>\xb35\x13-\x04/\xaf\x0e\xf9\xda\xd9}\xbaA\\`]\xe3\x85B`q\xae\xcd\xf2\xd1y\x96\x8f-ШWq}\x17!\n\xfc\xea`\uf015\xeb\xeb\xec\x1e\xf4\xc8\xca0\xa5\x81<MqL\x03)\x04Y\x96!\x05T\x1d\x8bA\u007fH\x9ci.n\x1f\x10\xc4ɩ\xee0\xba|\x9bz\xb37\xef\xaa{v]\tѹ\u007f\xbeE\xab^af\xa6\x85ﻘ\xa6\xc1\xe2\xd2\\\xb1\xb0Y\"\xb8\xf3\xf33\x1c?}\x9c\xf9\xa3\x8bT\xaa>\x96e\xb0\xbe\xb1\xcb\x1f|\xe1\x9b|\xf9\xa9\xe7YY\xdd&\x8ac\x94\x14\xd4|\x87\xe5csL\xb5\x1a\x9c8\xb6\xc8\xe2\xfc4w\x9e:B\xbb\xdd,\x9b\xc7\x12\a3դ\x9c\xde\\Y\xe7\xf2\xc5k\x04\xfd!;[\xbb\x93\xd9\xc7\xc6\xda6\x83A0\xd9EL\xb3\x944\xcd98\xe8\x92\xe59/\xac\xec\xb0\xd9\x19\xde\xdf\x0f\xe3\x97\xde\xf2#\xdcn\x10u\x9b\xbe\xf3R'\x8c\xef\xaby餶\xb7m\v)obE\xcdf\x03\xd7u\xd8\\\xddd{}\x9bZ\xa3F\xb5^\xc3\xf5\x1c>\xf2\xf8#|\xf2\x87ށa\x9a \x04\xa6e\xa1\x94$K\U000c2510$\xa4I\nR2\n#\x040\nFH)\xa9\x96d\x8a8\x8eY\xb9\xbe\x8eR\x8a\xbb\x1e\xba\x87\xf4\x99\x97\xd8X\xddDkM\x14'dy\x91SҼX\x83\xcb\xf2l2\x1e\xd0\x13\xdc\xf7\xf6\\ƛ\x99C\xbaA\x84\xd6\xfa\xb3Q\x9a\xdd\x17')q\x94\xe0\xba6\xb6m\x95\x9bM\x05\xe4Q\xa9\x16ܩ1\x841\x1a\x06Da\x84R\n\xc34Kr\xf5\xb8y\x934\xdbMj\xed:\xc1 `oc\x9b,\xcb\xf1k\x15\xa6\xe6\xa6\xe9\xec\x1f\x10\xf4\x8b\xc9a\x1cGL\xcf\xcfp\xfe\xc5\xf3\\:w\x05\x01\f\xfb\x01K\xcbKl\xacm\x92ey\x01\xb5\x88\x9b#\x811\x19o\x8c\x1e\x94\x9f\xaa\xbd\xa5\rR\xf7l\x94\x9c\xccūR\nr\xad\tF!\x95\x8a\x87结\xf7\xces\x1d\xdc[\x88\x06\xe3\xae=\xcf2\xf2,#\x89\xe3b\a\xc4(`\x92,I\x11R\x90\xa59\x9d\xdd}\xa2Q\bB0\xe8\xf4p}\x97Q\xff\xe6\x06Aw\xafKk\xa6\xcd\xde\xee\xc1\x04+\x1b\x85\xa3\xf2{\x89\x82\xcc}\b\xc2/\xbe\u007f\x9af\x13\xef-?\u007fۊ#\xf9&\x85*\xf6\a#\x1a\x9eS\x93\x88w$\x99&\xd7\xd0\xe9\r\x18\x06\xa3\x92\xf0\\\\\xb6\xe3\xf0\xa71\xe1\xb2,#\x0eC\x86\x83\x01a0d8\x18L\xa0s}\vқ\xa5\x19\xfa5\xffV\xd0\v8~\xeaX\xe9m\x06\xc7O\x1e\xa7\xd7\xe9\x95\xc6Nn\xc1\xb5n\x06\xa7\xb4\x9c\x89\xdc\xfc\x8c\xee\xbc%\rr\xebx\xb3\xeaZ?\x94\xe6\xf9gk\x8e\xf9\xa0k*zQ\x84\xce5{\xfb]\xf6\xf6\xbb7\xc9j\xb7\x00\x8c\u007fry\"&\xf8\xd3\xee\xfa6\xa3`\x84_\xb2H\f\xd3djv\n\xd7\xf7\xe0Є\xb1`\x8f\x1c9~\x84ţ\vضM\xbf\xd7\xe7ҫ\x97\xc9\xf3\xfc\xd0\xfcc\xec)Y\x96MBV\x96k\x92,O\xd1t\xde\xd2!\xab\xe6ڿ\xa2\xa4\xf8ɓ\xed\x9a\xd9p-\x04p\xf5`@\xc52\x19\x8d\"ξ|\x89jգR\xf1\x89\xe2\xf8/2ga\xe3\xfa\x1a\xcbw\x9e\xa0ڬ#t1\x9e-\x12y\x83\xde\xfe\x01\bAs\xba\x8da\x9a\xa4I\x8a\xe7\xbb\\<w\x99\xbd\xdd}\xd24%,\v\x80[\aVEŗM\xa6\x86Y\x9e\x13g\xd9\x1a\x82\xf0v\xed\x89\xfc\x956\x86Q\x92Q\xf7\xec?\xac\xd8\xe6\x8f\xdf;\xd7TU\xbb\u0602\xaa\xf8\x0eӵ\n\xafn\x1dP\xb1M\xe2(fkc\xb7\xc8\rRR\xad\xf9\x18\x86q\x98\xb0\xf6\xa7\x19%\xcf\tG\x11\xe8\x1c\xd7/\xf0\xaaA\xaf\x8f_\xabPk֩6j\xe4YN0\f0L\x8b\xde~\x87\xad\xf5-\xb4\xd6\x04\x83\x11I\x92\xa2\x81\xd1(,\xf2F\x96\x91\xa7\xba c\x979$\x88S\xae\xed\xf4^@\xf0\x1b\xbdQ\x1c\xbd\xe5<\xa4\xe6ُI!>x\xd7L\xb1\xae\x86\x10\xd4k>\xf5\xf2\x81\xcfϵ9\xb7\xb6C\x92\xe5\xacl\xef3\x1c\x85,\xceM\xb3\xbb\xb3\xcf\xdc\xc2\f\xd3\xd3-Z\xed\xc6!vʟ\x14\xc2\xe28f\xf5\xca\r\xea\xad&{;\xbb\x04\x83!\xb9\xcei\xb6[\x04\x83!{\xdbE\xbfa\x18\x06q\xe9\x89Z\xeb\t\xc8x\x88\x81\x92\xe5h]l\xe2\x8e+\xac\x9d\xfe\x888Ͼi+5xK\x86,\xad\xf5=\xaeejː\"ךV\xa3\x8a\xe7ٓr\xd2sm\xde\xf5\xc0i\xeey\xf4\x01\xb6\x0e\xfa\xfc\xfeg\xff\x88\xcf=\xfd2\xc7\xe7\xda\xdc}\xea\bS\xed&^\xc5\xe5\x9e{O\xd3h\xd4&\xd5η\xb7G\xc9,\xf4<\x86\xbd\x01\xfd\x83.\x96cS\xad\xd7\t\x86A\x11\xc2T\x81ކ\xc1\x88p\x14N\x8cP\x94\xba\x02\xad\x0f\xcf\xd8\xc30\x9aЈ\xa2$\xe3\xdan\xb7kH\xf9{\x9d \xcan\xd73\xfa+\tY\xad\x8a\xcb(N\xb1\f\xf5\x8f\xa6+\xce\xc3M\xd7\xc6/\x17mƃ\x1f\xd321\fE\x9ekL\x01Ǘf\xf8\xa9\x9f\xfa/\xf8/\xff\xf6\x8f\x12\nɧ?\xff4\xbdA@ӳ\xb9vm\x8d<ϙ\x9en\u007fGO1\f\xa3\x9c0*\xf2\xac\x00\x0e\xab\x8d\x1aJJ6\xae\xafaX&YZ4\xa3\xb6簻\xb9;\x91\xd5H\x92\x14)\x05q\x94\x92$\x19\x8cW袘4\xcd1\r\xc97\xafm\x11\xc4\xe9o\xf4F\xf1\xffq[\xa7\xa8\xb7\x13(\xfcN\xd7(NiW\xbdF\xa6\xf3'\x8e\xb7\xaa\xb3\xbem\xe1{\xf6d\x16n\x9a&\x96mM\xb8\xb4\xcdf\x8d\xee~\x97s/\xbe\x8aD\xf3\xc9O|\x98\xff\xf6\x1f\xfe-Fi\xcaS\xdf:\x8f\x89f\xd8\x1b\xb0\xb7w@\xb5V\x99L\x03o5\x8e,s\x8e\xce5y\xb9\x9b\xeeU|LӢwPTq\xf5V\x03\xc7qp=\x8f\x1bW\xae3\x1c\x04\f\x87\xa3\x82\xb7%e\x91/\xb2\x8c$M\xc8\xf3\x1c%%;\x9d>/\xaf\xed\xb1\xdb\x1f\r\x85\xe0\x83Q\x92%\u007f\xda\xfd\xff\x95\x18\xa4\xee\xd98\xa6Q(\xb4\x95?Lյ\xdei\x19\xea\x87\f%?j\x99\xeaÞm\x9e\x98\xa9yao\x14\xef()~\xba\xe1Y\u007fs\xb1\xe6K\xc3Tؖ\x892\xe4\x84\xd5a\x98\xaa\xa4w\nj\xb5*JI\x94R\xec\xef\xecs\xf9\xc2\x15\xb2,\xe5G?\xf6a\xde\xf1\x8e\a9wm\x83\xe7^8G\x18\x8c\bz\x03FAH\x9a\xa6\xf8\x95\x82^:\x0eWJ)&rr\x02\x942\xa8\xb7j\x04\x83\x800\x18a\x18\x06~\xadJ\x96\xa5\xac]_c\xd0\x0f\b\x82\xd1$G\f\aE(\x1b\xef\xc9\x1f\fCn\xect\xd8\x1fF\xa4y\xfes\xdd \xfa\xfcw\xcd\x16\xee\xf8\x87hxv-\xd7\xfc\x0f\xa0\xff\x8e!\xe5T\xd51ݪmYB@w\x14\xc7\xdd \xee\xd7<\xfb\xaac\xa8{\x8f7\xab\x06@\x96\xe6%rj\xa3\xcb\xf2q\xbc\x06\xa0\xb5&M\x12\x94*z\x16e(\xc2Q\xc8\xfa\xf5U\xc2\xe1\x88\xe5;O\xf0\x8f\xff\xfb\x9fd\xef'>ί\xff\xc7\xdf\xe3\xb7\u007f\xfb\x0f\x99\xae\xfb<p\xfa(\xadz\x05\xd7w\x99\x9d\x9dbaq\x16\xc7u\v\xa8\xa3Do\x83\xc1\x90\xee~\x8f\x85c\x8b\xf4\x0ezXn\xa18\x17\x87eן\xa4\x939\xb9\xce5\x9a\x82\x19/\x84\xc04\x15ߺ\xbc\xceB\xddc\xa33\x04\xf8\x95[\x9f\xc3m\x1b\x0fU]\xcb\x15\x88\\\b\xd2\x02\x91\xc8\xf4 J\xff,\x1e\xe2\x01\xffP\b~\xc1\xb7Lk\xa1\xe6\xd1\x1a7~\xfafb\xedE1\x17vz8\x86b\xaa\xe20\xe3;\x00X\x96A\xbd\xe6\xe3\xfb\x1eJ)\\ך\x80\xcf\xd3\xd3-\x1a\xcd\xead&Q\xa9\xf84[\xf5b\xdbI\x16t\x9e\xf6L\x9b#'\x8f\xa1\x94\xc1o\xff\xce\xe7\xf8\xf7\xff\xe1\xd3\xc4q\xc2=\xc7\xe6\xf0m\x13CI\xa6\xa6\x9a\x1c_>B{\xaa\x89e\x99خ\v\x02*բ\xf4͵&\x8d\x13\xb24\xe3\x95\x17\xcfr\xed\xea*Q\x18\x97\r\xa6\xa6\xdb\x190\x1c\x04\x84I\xc2++\xdb\xf4\x86!\xe49;\xfd\xe0\xc58\xcb\x1f|C\xb4N\xda\x15\xf7+Y\x9e\xef'y~^\b\xb1\xad5]`\b:\x01\xfa :B\x10\t\x88\x81!\x1a)\xa5\x98\xd1Z\xff\x8b\xbac\xbdg\xa9Q\xa1\xee\x98E2\xb6L\xac\x92ү\xa4\"N\x12\xf24\xa3\x13\x84\x9c\xdd\xd8CkX\xac\xfb\xccT\x9c\x92]h23\xd5į\xb8\x13\x12\xb5\xd6\xe0y\x0e\xf3\v3\x93\xb5\x83z\xbdF\xbdQEI\x892\x8b\xb5\xb3\xbc\x14!\xf3<\x8f\xa5\xe5#8\xae\xc3\xf9sW\xf8O_y\x8e\xed\x9d=\xf2QȠ\xdbG\xe79\xbe\xef0\xd3n0;7E\xadR\xc1um\x1c\xdb*s\x8d´L\xd6W6\xd8\xdf\xef\x14T\xa1<'\xcbr\xa2(f\xfb\xa0\xc7KWֱ\xca\xef{uk\x8f(\xc9\xfe\xb9\x10\xe2\x9ft\x83H\xdf\xee\x02H\xbccy.˴\x96i\x96\xd3\x19\x15\xf3\x04)\x04\x99Ή\xd2<\xce\xf2\xbc\x1fgy\x9c\xe5:\xce\xd1#CJ\x19\xa7\xd9\xccR\xddo,\xd4|$P\xafW\xa87*8\xb6\x85a\x14\x98\x10y\xe1\xeei\x9a\x15s\x85\xfd>_{\xe5*i\x9eS\xb3-\x16\xea\x1e\x9ei`\xdb&ǎ\xcea\xdb\xf6!\x0e\xed\xc2\xe2\f\xbe\xefM<\xa4\xe8?Di\xb814\x9fc\xbbN1\xd7РL\x03Ƕ\xe9t\xfb\xf4\xe3\x94A\x10\xb1\xb9\xb1ùW.q\xfd\xda*\xdd\xee\xa0 \xceiHs\x8dc[\xd4<\x9b\xe9F\x85\xe9F\r\xdb.\xf6\x10\xb3\\\x13%\tW6\xf68\xbf\xb2\x85\x00\xdeu\xf72i\x92\xf2\xe9o\xbeJ\xae\xf5\x0f\v!\xfe\xe0\r\x91g\xfa\xf0\x99##!p\xbaa\x8cB\xe2\xdb\xc6M@n\xccA\xba\xa5\xb6\xdf\xe8\x05\f\xa2\x84;\xa6\x8b5\xe5\xd9\xd96S\xed\xfa\xe4\v*\x95\n\x96i\x94\x1a\xb99I\x9c0\x1a\x85()\xd9\xed\x0e\xf8\xecӯ\x90d9\x96R\x9c\x9a\xaaQlN\xd9\x1c=:whYF\b\xc1\xc9SG&e\xf1\xd2\xd2<\b\x81aH\x84P\x13\xe2\x83Sq˅\x1b=!\xd3\tn\x12\"\xa4R\xcc\x1d]*\x88\fa̰?\xe0`\xbfC\xaf\xd3\xe3\xc6\xd5\x15v\xf7{\x9c\xbb\xb6\xce\xe5\xeb\xeb\f\x83\x11Z\x17\xf4Q߱q\r\xc9\xc2T\x9d\x9a\xeb \x04ll\xee\xf2Ջk\xdbA\x9c~`\x10\xc6g\xdf\x10\x8a\xc1߸si\x14\xa6\x99\x93jM\xd3uJ9U}\v\xed\xfe\x16T5\xd7\xec\fC\xa6}\aC\tffZ\xccL7\xc9\xf3b3\xd6\xf3ݛ\xe2.\xe5\xaf4M\x89\xa3\x98\xe1\xb0@qGa\xcc\xe7\x9e;\xc7nw\x88\x06\x96\x1a\x15f+\x0e5\xbf\bSv\x19J\xb4\xd6x\x9e\xcb\xe2\xd2,y\x9e\xd3n7\xa9\u05eb\xe5*\xb3\x9a<p\xc7wQ\x86\"\x8d\x13,\xd7A\xe8\xc3sP\x9d\xe7L/\xcc\xe3\xb8\x0e\xa6e!\x95$MR,\xdb\"\x8dbο\xf4\n\u05ef\xac\xd0\xeb\xf6\x19\xf6\x87d\xba\xa0\xb3\xee\xed\x1e\x14\v\xa7:\a-\xe8tzt\a#\xbet\xee\xc69\xe0\x87{\xa3\xf8\xda\x1ba\x10u\xff\xf2\xfc\x13ZI\xe3\xd8\xdc\x14\xed\xa9:\xedV\xbd\xb8q`\x14F\xe52Kq\x87i\xae\x89\xb3\x1c\xc7P4\x1bU\x16\x17\xa6ɲ\x1cǱ\xa97\xaa\x85R\x82\xa10\fE\xb5\xea\xe1\xf9^!}Q\x96\x9f\xe3\x86\xeb\xd4\xc24\xa0\xd9>\xe8\xd3\x1d\xc5\x04\xe3\xea&\x89Q\xa5*\x8fR\x8a8NAC\xa5\xe22\x1aET\xaa\xfed\x928\xfe\xddP\xaaP\xf4\x91\xaa,w\v\x9f>\x84\x10\v\xa8\xd4*\xe8\\\xb3q}\x95\xed\xf5MF\x83\x80j\xb3\x8e\xd69\xaf\xbex\x8e\xa8To\xc8sM\x1c%\f\x06\x01Y^X7\f#\x82\xe1\x88(\u0378\xba\xdb}I\n\xf1kQ\x9a\x85o\x84A\x8c\x10\x8c\xbbO.M4l\r\xc3 I\x12\xe6\xed6\xadf\x95\xb5\xb5]Fa\x01\xb0\xc5i\x86)\x05\xfd(f\xc9u&\x90\xb4_\xf1\x0e\xbd\x95\x93\xf1k>\xde\xdbS\x93\xe6m\xd0\x0fHӔ\x87N\x1f\xe1\xd8l\x8b/\xbdp\x89\xbd~@\x10g\xac\xf7\x86\x9c\x8e\x12\x16\xda\x11\xd5Z\x15\xcfw\xe9tzX\xb6I\xb5곳\xbd\xc7\xfc\xfc,\xb9\xce'\x03\xa5(\x8a1,\xf3\xe6\xf7\x17\xa03M.\xf3\tO8\x1c\x06diVPI\xa3\x82C\x1c\f\x87\x8c\x82\x80~\xa7_\x94ݷ4\x95I\x92N\"\xc4\x182\x81\x82\x93\x9dkRː\x19oХ~\xf8ѻ~F)ih\xadi4j8\x8e5\x99\x94\x19\x86A\xb3Y#\x1cE$i\xca\xce \xa4\xe59Di\x8eD07ӤR\xf11͛:T \x8aj\xa6V!\xcb2\xb2,\xc72\x8a7\xde\xf3<r\x9d\x95\xff~\x8ek[\xdcul\x8eN\u007fD'\x18\x91\xe5\x9a\xdd\xc1\x88\xad\xce\x10\x9d&\xa4a\x8c\x94\x92\xc1 \xa0V\xafL~h\xb7\\g\x9b\xec\x96(us3ẁ\x177\xd9\xf2Y\x96!\x95\xc2\xf5<\xc2`D\x12'\b\xa0R\xab\xd1\xd9?`me\xa3\\]+\x9es\x14'\x93\xad[\x80(\x8c&:\x8e\x9b\xdda\x92g\xfaSa\x92\xbe!\xa2\xca\xea]\xf7,\xff\x8c\xd6\xdap]\xa7\xd8Nu\x1dҴ\xd8\r/\xdevh6ktzC\xd6;\x03\x16\x1b\x15\x92L3\x8a\"\xa6\x9b\xb5\"\x8c\xa8BFI\xe7\x9aV\xbbN\xa3Q+\x1b\xbc\x94f\xab1Y76-\x83z\xbdJ\x12'Da<y+O/MSqL\xf6zC,C\xe2\xd9&[\xbd\x80\x9d^\xc0n\xb7O\x12Ftv\x0f\xa8ת\b)'\xd2|\x85N\x96$MS\xac2?\xdc:o\x15\xb7\xc0\xf5Y\x96a\x98\x06^\xa5B0\x18b9Nы\xe49\xd7/\xdf )G\u0085\x87\xdc4HA\x82H&@f7\b\xa7\xe2<\xff|۱.\xfc\xe8\xfb\x1e\xe6\xa5\xcbk\xb7\xd7 \xef\xb8\xeb\xf8\x13\x86a\x18S\xd3͒\x82\xa3\xa8T+\f\xfaA\xb9\x02\fJ*.\xael!\x84\xe0\xf8\xc2\x14i\x92r\x10\x84T\x1d\x8bV\xbb\x8ei\x9a\x18\x86A\x9e\xe74\x9aul\xdb\"K\v0N\xcab\xdaf\xd96\x8df\x15\xd34\x8bufۢ\xd7\x1d\x14si\xad\x99nTY\x98\xaa\xb3\xdb\x1b\x92\xa5)5\xc7FJA\x10\xa7\xec\xf6G\xec\xf6\x03:\xfb\x1dj\x9e\x83i\x99ض\x8di\x16`\xa4\x14\xc5\f}\xcc\xe3\x1a\xf3\x9b\xc4Xv\xa1\\?H\xa2\x98Z\xb3\x81\xe7\xfb\xf8\xf5*J)z\a]n\\\xbd1\xe9=КQ\x10\x1dB\x92\v\x83\x14\\\xde8\xcb\xe9G\xc9\xdd\xeb\x9d\xe1/\xa7Q\xc4n/\xb8\xad\x06\x91B\b\xaa5\x1f\xcfs&\xa2ĮcO\xdam)\x05a\x14\xb1\xd9\x1d0Sui4j(S\x11\xc4)\xc3\xe1\x88\xe1`\x84a(L\xab(_\rC!\xa5@\x19\xb2\xac\xba\nL\xca\xf3]\xda\xd3S\xcc-\xce35\xd5\xe4\xee{NQ\xab\xdd\\1K\xb3\x9c\xaa\xe7\xf0\xee\xfbN\xe2\xf9\x1ei\x96#\x85\xc0\xb3\f*N\xb1\xb6vvu\x87\xdf\xf8\xecW\xf9\xfa3\xafp\xb0w@Z\xee\xff_\xefQ\xde\x00\x00\x1b\x89IDAT\x15\xa11e\x14\x847s\xc1-I]\x94\xbdU\x9a&\xa4IR싔\x13\xc4\xceA\xa7\x80m\xca/\xcbu\xb1\xd3~\x88\xd9p\xcb\xd5,\x14N\x1f|\u06ddG~\xe4\xd5\xd5ۯ\xcf/\xa5\x14\xd4k\x15\x942&\xa4\xb18J&\x8b\x90B@\xb73 \xcd4\x15\xd7\xc1\xb2L\x16g\xdb\xc4Y\xce\xcan\x97\x9d\xbd\x0eI\x9c\"\x85<\x14\xbb\xa5\x94T\xab\x15\xa6\xa6Z\xd4\xebU\f)\xe9\x1d\xf4\xd0Z35?S\xa0\xbb\xe5\xdb,\xa5\x9c\xf4 \x8ei\xf0\xde\xfbOQ\xa9\xfa\x84iF\xaeu\xb1=+\x05튋o[\xfc\xc1\xd7_\xe2\x9f\xff\xdb\xdf\xe6\xcb_\u007f\x9e\xc10\x00]\x90\xe9Ⱀ\xf5\xfbN\xf3\x11\xcf\xf7'; \xe3ܳ\xbb\xbd{3ԕ\\\xab1\xe4>V/=\x04\x19\xb96\xbem\xb2\xb6\xd3y\xff\x1b\x91C\xa4繅\x1a\x82(\xe2\xaci\x1a\x98\xb65yK\xf2\x1c6\xf6{\xd4\x1c\x8bZ\xd5'\xcfs\xea\xb5\n\xad\xaaG0\x1c\xf1\xc2\xc5\x15\xae\xafmM\xc2G\x9a\x94P\xb5R\x13H\xfc毌~\xa7Kw\xbf3\t%B\xc0}\x0f\xdcɻ\xde\xf3h\xb1\xe0Y>\xacw\xdds\x82\xfbN\x1d!E\x10&i\t\xdaj\x94\x14\xcc\xd5}\\\t\xbf\xf9\xfb\u007f\xcc/\xfe\xf2o\xf1\xc5o\xbc\xc4h\x14\x91%)a\x10\x1e*w't\x1d]\xa0\x06\x83\xfe`\xb2롁\xa0d%\x82(\xfb`=i\x86\xc7?\xcb8\xd9\v`\xbb?\"N3\xd2L_|C\f\xd2j5\n\x9ad\x92p\xea\xaeS<\xf2\xceG8s\xdf\x1d\xfc\xf0G\xffF\xa1\xf4\x9c\xa4얃\xa1F\xb3\x86WJRL7\xaa$qJ\xd4\x1d\xf0\xf9\xaf\xbf\xcc\xcb\xe7\xafS\xabz\x84a<ٝP\xea\xf0\f|\xdcj\x16jo\xc5\xfe\u07fb\xdf\xfb6\x8e\x1c]\xa0=\xd5\xc4u\xedB#\xb7\xec\t\x96\xa6\x1aE\b\xf3\\\x06Q2\xa1\a\xe5\xbaвjW]\xe2Q\xc0g>\xf7\x14\xff\xf2W?\xc5\xf5\xf5\x1d\x82\xc1\xb0\xf0\x92[`\x18\xc7s\xb1l\x9b4I\xcb\x1d\x8f\f!\x05i\x920\x1a\x95\xf0\x878\xac\xd00\x0ex\xa3 \x9c\xdc\xcffo\xc8\xf37\xb6\xb7\x06Q\xfc\xa3\xc7\xe7Z\xff\xee\r\xa9\xb2~\xec\xf1\x87\x9fй6N\xdfu\x8aF\xbb\xc9/\xfd¿\xe13\xbf\xf7\x05N\x9c:\x8a\ufe6c\xde\xd8\xe0\xd2\xfa.G\xa7\x9b\xcc\xcfO\xd1n7Iˁ\xcd\xc5\x1b\x9b\xf8\x86\xc2\x11\xf0\xca\xea6s3Mf\xa7[dY\x91\xdc_\xf7\xcdD!eQ,\xc2\xe4\xb4Z\r\xea\x8d\xead\xad\xac\xd7\xeds\xcf\xfdgx\xe0\xc1\xbb\xf0<\x87\xed\xad=LCqba\x8a\xdd~\xc0V\xa7?V߹5\t\x162\xe1Q\xccW\x9e}\x95\x13G癙j`\x8eE\x06\f\x83\xf9\xa5EL\xd3D\x19\x8a\x99\xf9Y\xa4,\xf2\\\x18F\xbc\xfa\xe2\xab\x13R\\\x9a\x169iT\xceD\xe2(.B\"\xd0\x19\x86<sm+\xcc\xe1\xbf\xe9\x05\xf1\xa7\xd6\xf6\xba\xf9\x1b\xe2!B\b*\xb5\nͩ\x16\xbf\xf8s\xff\x9a\xb5\x8d\x1d4\xb0z}\x9d\xe5\xd3\xc7\xd9?\xe8a\xa0i\xb7j\xcc\xcf\xcfP\xa9\x14\xaal\x8d\x8a\x87,up\x11\x82\x9a\xce\xf9\xcc\x17\x9f\xe5\xfc啢i\xfb6\x8cW-\x8a\x9a>\x8e\x13\x84\x90Tk\x95R\xef\n\xb24\xe3\xc1\x87\xefev\xba\x85a(\x96O\x1e\xe1\xf1\x0f<F\xb3U'\xcbr\xdes\xef\t\xdeq\xef\t\x86qF\x92\xe5\xafc\x89K!\xf0\r\xc9\xff\xf6\xab\xbf\xc5g\xbf\xf04q\x94\x94\x13Ü,M1-\x8bz\xabQ0\x1c\xad\x82~\x1a\f\x82r\xdeQ\xee\x1b\xde2;H\xb3\x8c~\u007f\b\x1a\xf6\xfa#\xb6\xfb\x015\xc7ʳ<\x0fx\x03/)\x84\xa0V\xafr\xe9\xfc\x15\x06\x83\x11\xb6e2\xd5np\xdfCw\xb1\xb9\xbeEw\x18b\x19\x8a\xa5#s\xc5\xf1\x10\x96I\x9e\x177ШW\x8a\xf0!e1\x17\x8fc~\xff\vO\xf3\x95o\xbcDPN\xe4&\xdea(\xd6\u05f6\xf9\xe67\x9e\xe7k_}\x96\xebWWn\xe1\xe42\xa9\xff5\x02\xc7s1L\vǱy\xfbc\x0frϽ\xa7A*fj\x15\xde}\xff)Lۢ;\x8a\x0e1\x1cǉ{\xba\xe6\xf3\xff}\xfa\x8b\xfc\xef\xff\xf6\xb7\xd8\xdb\xed`\x18Eik;6\xb6퀆Ѱx\xd0cO\xa0\x14Z\xbeU\xaai8\b\x8a.=I\xd9\x1b\x8e8=\xdbĵ\fSk\x16^K\xfa\xbb\xad!\xebG\xdf\xfb\xf0\x13\x86i\x18˧\x8f\x13\xf4\a\xb4Zu>\xfc\x91\xc7i4\xeb|\xf9s\u007f\xcc\xca\xe6\x1e\x8em\xf2ȃgp\x1c\x1be(\x0e\xf6:$I\xa1\xd49\xe8\r\xb1\x8c\x9b8\x92\xa7$\xe7\xd6v\xb9pe\x95\xe9\x9a\xc7\xd4T\xb3 Nkx\xe9\xc5st\x0ezDQ\xc2\xe6\xc6633-\x1c\xd7)\x9fI\xa1w\xa2u\x01\xb9\xd4\xdbM|\xdfg8\x1cR\xafW9\xbe\xbc\x88e[\x84À\xf9V\x15\xa1\x14\x97\xd7w\xf1^\xa3\xe4\x00\xe0X&[\xdb{|\xe5\x9b/\xf3\xc8}w\x14\xbd\x91\xe3\xa0J\x9d\x934.J\xdf\xf3/\x9f\xe7`\xbf3\xa1\xf9$IB8\n\x19\xf4\a\x93\xdcru\xb7\xcb|\xbd\x82c\x1a\f\xa2D\xee\xf4\x83\xe7\xd3,\xff\xe2횡\xbf\xce \x1f{\xff#ODQl\x84A\xc8#\x8f=\xc0{?\xf8N,\xdb\xe2\xf7\xfe\xdfϒ$)\xeb\xbb\a\xb4\x9a5\xee\xba\xf3\xf8d\x17\xbc\xd3\xe9\x12\xc7\tq\x9a\xb1\xb9}\x80U.\xed\vQ\xb8\xbag\x99\xec\a\x11\xab\xeb[\xecm\xee`\x98\x8az\xa3\xca\xda\xcaf\xc1\b\x1471\xa2#G\x16\x10B\xb0\xbf\xd7acm\x9bF\xb3\x8eT\x92\xd1 \xa0ҬӜj\x13\x95*=\xbe\xe723;E\xbb\xdd\xc07\x15s\xed\x1a\u05f6\xf6\xcb\xe6\x90C\x86QR\x12\x87\x11\x9f\xfe\xfcӼ\xe3\xe13ؖ*\x99-\x05\xee%\xa5\xe2\xa5g_\",q\xba(\x8cH\x92\x94^\xa7O\xaf7\x00\n\x18'N3\x16\x9aE\xbf\xb4\xd1\x1d\x8a\xde(\uee96\xf1\xa9(\xc9\xde\x10\x8b\xc8qE\xd1\xd9\xeb\xf0\xf2\xb7\xce\xd2\xef\xf5y\xe9[g\x89\xe3\x984\xcb0,\x93\xe9V\x1d˲&3\x8fj\xd5\a\r\xbekcX\x06\xb7nH\b!\xd0I\x8a\xab\x04\xa6ar\xf6\xda\x06\u007f\xf4\xd5\xe7Y\xbd\xbe\xc1\xf1\x13KdYN\x9a\xa6Ő(\xcd0LE\x92\xa4|빳|빳<\xf7\xccK\x98F!\xa2\xbf\xbf\xb9M8\x1a\xb1t\xe2\x18\xb5V\x83~\u007f\xc87\xbe\xf6<+76\xb8\xe3\xccI\xde\xf1\xe8}|\xe2\xfd\x8f2;\xd5 E0\x8a\x0f\xf7 \x96\xa1\xf0\f\xc9\xff\xf4\xcf\xfeO\xbe\xf9\xccY\x06\xbd>y^\x80\x8cfI\xb2\x10%\b\x9a$)q\x9c\xb0\xbd\xb5O\x9e\xe5\xec\xf6Glw\x87\x9c\x9em\x92\xe6\x9a\xddAH\xb7\x1f\xe0(\xf96\xe0\xe4\x1b\x06.\xfe\xd8\xe3\x8f<\xa1\xb56\x84,\xe4R\x8f.\x1fame\x83\xdd\xed]\x86\xa3\x88\xed\x83\x01\xf7\xdfy\x8cV\xab1i\\k\xb5\nk\xab\x9b(\xa5\xd8\xee\xf4!\xcd\x0e\xbd\x9dZk\xe2$ef\xba\xc9\\\xab\xc6\xc6~\x8f\xcb76\xf9\x81\x87ΰxd\x8ev\xbb\xc9\xec\xdc\x14\xa7\xee\\.\xa6\x82\xc0\xf6\xc6.\xfd\xfe\x80\x99\xb9)\x16\x96f\xcbu\xe4Bt,K\x13\xea\xed&\xd5Z\x95Z\xc5c\xe5\xc6\x06\u05ef\xad\x92$\x19KG瘮\xf9x\x86$\x17\x82\xbd\xee\x00\xdbT\x87\x90g\x91\xe7|\xf9k/\xe2:6w\xdeq\x1c˲\xb1l\x8bn\xa7\xc7\xce\xe66q\x9c\x10\x86\x11[\x9b\xbb\x8cF!\xbb\xfd\x11\xfd0\xe6\xc4t\x03%\x05\x9b݀\xcbk\xbb\xb8yN\xa8u%\x85\xcf;\xa6q\xee\x8d\b[꣏?\xf2\x84\xd6\x18\b0\f\xc5ұE\xae_\xbe\xce\xce\xf6>a\x18\xb3\xd9\xe9\xf3\xaeG\xef\xc1\xb2o\x8a\xb8T\xab\x1566\xb60\x94dc\xbfO:*\x88ɇb\xb9\xd6t\x82\x90\x93K3\xcc6\xablu\xfa|\xed\x99Wh\xf9.\xc1 `ss\x87\xf9\xa59\xea\xcd\x1aJ)\x96\x8e\xces\xea\xd4Q\x8e\x1c]8\xa4\xc03&5'a\x8cW\xabP\xadWi\xb5jDa\xc4\xce\xce\x1e\x1bk[\xd4\xeaU\x96\x8f/a\xe6\x19\xca0\xb8\xbeu\x80W\xa8\xbcM*0C\n\xbe\xfc\x8d\x1792?\xcd\xcct\x93F\xb3\xc1ڵ\x15v\xb6\xf7\xe8v\xfb\x1c\x1c\xf4\xd8\xdd\xd9'\xc9r\xb6z\x01GZ5\f%\xe9\x87\t/\xad\xec\xe0\xe59\n\b\x11\xa4\xd0\x12\xf0\xebc\x1a\xd4m7\b\bC\b\x81\xedX,\x1cY\xe0ʅ\xab\xec\xed\xee\xd3\x1b\x86dB\xf0\xf0\xbd\xa7\x0e\x9d\xd5d\x9a\x06I\x9c\xd2\xeb\r\x18D1\xfd\xee\x00\xf9\x1a\x83(!\xe8\x04!\x9e\xefЪ\xfa\xcc7kDi\xca\xd7^\xbcD\x1aGH\xe0\xea\xa5\xeb\xac^_\xa7R\xf3\x8b#\x8b|\xb7@\\_\xab)R\xaa\xf3\xc4a\x8c[\xea%..ΰ|\xe2(G\x8e-\xe0\xb9.\xd33-\xa6\xa6\x9aXB3۪quk\u007f\x02\xff\xc8\x12\xcb\xf2,\x93\xdfy\xf2)\xae^]\xa5\xb3\u007f\xc0\xd9W.\xf2\a_x\x9a\xeb\xab\x1b\xec\xec\x1c\x10e9\x9b\x9d\x01S\x15\x97\x9ak\xd1\x1f%\xbcpe\x1d\x91\xe5Ā\x16\x82\xaa\x80Xs2\xd3\xfa\x88!\xe5gGI\x9a\x8f\x99\x99\xb7\xcd B\x94\x06\xb1\x1d\xe6\x16g\xb9\xf8\xeaez\x9d>k\xfb=\xe6\xa7[\x9c<\xb6pS4L\b\x84\x94\xd4j>+7\xd6qL\x93\x8b\xab;\xb8\xb2\\\xa6\xbc\xc5(\xae\xa1\xb8\xb2\xd3\xe5\x8ecsdyN\xb3\xe21?Ug\xeb\xa0\xcfV\xa7O/\x88p\x94bcu\x83ݝ\x03\xd2,\xa3Ѭ\xe1\xfa\xceMA\xb3[\xe1\x8f,+\tn&\x8d\xa96\xf5v\x93F\xb3\x8e\xe7\x15\x1bW\x9e\xe7277\xc3\xect\x93SK\xb3\x04Ð\xfe0\xa4\x1b\x84\xa4Zc+E\xdds\xd8\xdc\xd8\xe1\xd5\x17\xcfq\xe3\xea*\xbeY\x88\xd2$Y\x01\x91LU]Z\xbe\xcbv?\xe0\xd5\xebۘ:G\tAM\bB\rBk\x1a\x96\xa1\xa5e>\u070f\x93\xb9\xbb\x16f?sm\xe7@\u07fe\x1c\xf2\xbeG\x9e\x90J\x1a\x00\xae\xe7237ÅW.1\xe8\x0f\xb8\xb0\xba\xcd\x03w\x1ccz\xaa=a\x82\x8bR\xab\xd0\xf5\\v\xb7\xf7\xb1\r\xc9\xcb\xd76\xf1\xe5My\xd5\xd7R#wG1\x8f\xdes\x8a(N\xd0y\xceT\xbd\xc0\xc2r4g\xafmrm{\x1f[@w\xbfÕK\xd7\xe9\x1e\xf4h\xb4jԚբ\x9b,D\x15&\xd0K\x12\xc7\x04\xc3!J)j\xcd\x06\xd5F\x9dم9\x10E\x8fQ\xa9\xfa\xcc\xccNq\xe7\xc9%\x1c)\xb0u!\xa4\xb9\xd9\r\b\x93\x94\xbag\xb3<Ӥ\xe2X\x98\x86µ\f\xaa\x8eIձ\xb0\r\x83\xed~\xc0\xe5\x95\x1d\\\x9d\x93\t\x81\x14\x05\xa3\xb0\xafs\x8eN58\xb6\xd0\x16\x8b\xad*\xfbA\xf8\xd0fwЋ\xd3\xeck\xb7\x8bN\xaa>\xf6\xfe\x1f\x98\x18\xa4V\xaf\xd2l78\u007f\xf6\x12\xc3A\xc0\xf9\xd5\x1d\x1e\xbb\xff4~\xb5r\xd3 R\x94Z\xba&y\x9e\xd19\xe8\xd1\x0fBz\xfd\x00K\x1df\xa6\n\xc0\x94\x92\xde(\xc2q-\xde\xf7\x9eG\x88\xc2\xe2,')%\xbecql\xaeE\xbb\xea\xf3\xcc\xc5\x15\xf6{C<ˢ\xbb\xdf\xe5ճ\x97\xd8\\۞\x9c~ \r\x13\xcb2\x8b^\xa5\xd4H\x8c\xa3\x98$N\x8a\x85P%\x99\x9e\x9b\xa5=7M<\nI\xa2\x18۱9}\xc71,\xd3$\xee\r\x10B3\x8c\x13\x828\xa3\xe1;\x18\xaf\xc1\xda4\xb0\xd5\x19rau\a\xbf\xec\xdcc\r\x12\xcdP)\xdey\xef\tN\x1c\x99)\xf1GM\xcbw\xc4fg\xf8\x83\x86\xa1\xbe\xd2\v\xa2\xab\x00w-\x160\xcf_\xd8 \x9f\xf8\xe0۟PR\x1a:\xcfi\xb6\x9b8\xae˅W/\x11\x8eB.\xac\xed\xf0\xbe\x1f\xb8\x17U\x9eh3\x9eQ+)q}\xb7\x00\xdc6v\xf0l\x93g.\xafa)\x89Yj݊r\xe6\xad\x01[Iv\xfa#\\%y\xf7{\x1eeqi\x0e۶\x88\xa3\x98(JPBpzi\x1aS\x19\\Z\xdbf\xa7; Js\xf6\x0ez\xac^[\xe5ƕ\x15vw\xf6\x18\x0e\x878\x9eK\xb5V\xc5\xf7\xfdR\\\xac\x94V\x8a\xe2RA\xae\xceܑ\x05\x9a3\xedBH\xb97`~~\x86\xd1h\xc4ՕMz\xa3\x84\xaac2W\xf7'\t?I3v{\x01\x97\xd6\xf6\xd8\xdc\xebҐ\xc5z\\\x06\bS1\xb78\xcd\xe3\x0f\xddA\xbbV!IRF\xa3b\ue8b3\x9c\xceA\x9fH\xf37\xab\x8e\xfd\x9f\x828Y\xdf\xed\a,O\xd7\xe9\xfcE噔q3Y\xdb%\xec>\xe6'#\x04\xb6cO\xe6\xd7c\x05\xb51\xab\xbc=ݢV\xaf \xca9A\xa65;QĴmaH\x85\xa1T\xa9\xea\xa9\xc9\u0088g\xcf^\xa6V\xf5x챇X>q\x84\x85\x85\x19Fa\xc4\xf6\xd6\x1ek\xab\x9bTݘGN\x1f!N3:\x83\x11A\x9cp~}\x97\xe4\xc66\xad\xca\x06G\xa6\x9b\\\xb9p\r\xaf\xe21=3\xcd=\x0f\x9caj\xba\x85\xa6Xg\xb0]\xa7\x9cg$8\xae\xc3\xf2\x9d\xa7\xe8\xec\x1e\x10G1\xedV\x03%%Q\x9a\xd1T\xc5Vo\x12'lt\x06lt\x86DQ\x8c\r4\xa4d\x94k\x12%8\xba0\xc3\xe9\xa33\xb4\xaa~\xd1\xf4\xa6\x19\xa3Q8y\x1e\x9d\ue43ae\x02\xda\xd9\x1b%\x9f\x9e\xad\xfb\xff\xfeo?v\xe7\xcf\xfcғ\xcfe\x8f̗߳\xce^\xfd\xf3\x1bd\x9c\xa4e\x9ec\xda\x16y\x96\xa2ʓ3=\xdbı-\x86\xa3\xa8@H\x95,\xf6\xebD\x81\x8eNO\xb7\x99\x9ei\xd1\xeb\xf6Y^\x98fs}\x9b\x86i\xb2\x1aD\x1co\x15+\aIj0\x18\x868\x02V6\xf6x\xee\xe5KT+>w\xdd{\xba\xc0\x97\x1c\x9bf\xb3\xce\x1dgN\xd0\xeb\xf68\xf7\xca\x15\xb6\xb6v\x99\xaa\x17\xc7Q\x1c\x99n\x90$\x19\xfb\x83\x80\xcf=w\x9e\x9a\xefp|\xb6ű\xb9\x0e\x17^\xbd\xcc\xf2\xe9c\xbc\xeb}o+&\x82Y\x86MQ\bhJ\x05\xd1r\xbe\xb1\xb6\xb1M\xae!-\xd7\n\xae\xeetX\xdb\xeba\t\x81\x8bƔ\x92A\xaeI\\\x8b3Gg9>7\x85k߄e\x82aH\xb7\xdb/\b\x13\xb2\xe0\x97\xed\x1d\xf4\xa9T\\<Ca\xaah\xf6 \x8c\xff\xe7\xff\xf0\xf5\xf3\x1f|\xfc\x9e\xe5w\u007f\xe9\xec\xd5\xe8G\x1e\xb9\x93\xcf<{\xfe\xcf\xe9!R\"\x94$MK\nP\x14\xa3\fE\u007f\x142Өb\xd9&A\x18\x15k\x03\xf25$\x02Cr\xe4\xc8\"\x97/\xde\xe0\xc4\xe24WW6\xf1\f\x83\xe3U\x97a\xa6I\x82\x90\x86c\xe19\x16I\x96\xb1Tu\xf9օ\x1b\xf8\xaeC{\xaa\xc9\xf4l{¥\x95J\xd2h\xd6y\xd7{\x1ef8\x1cq\xe1\xfcU\xb66\xf7H\x92\x82\xfc\xbc4\xd5`\x18\xc64+.\xbd \xe4\x8b\xdf:\x8fe\x1a\xbc|u\x8do<\xfd\x12w\xdfs\x8aSw,S\xadx\xb4\xa7\xdbT\xaa>BJ\xa2(\xa2\xdf\x1b\xb0\xbbs\xc0\xa8Te\xb8\xb1ק\xed;\xccU}\xb2<#\x13\x02\xcfsxhq\x9a\xa5\xe9\x06y^\xcc\\\xc6ұ\xbd^\u007f\"\xaf!\x84`0\f\xb9te\x83Jͣ\x1b\xa7\xb4\x95dηi\xd8&\u05fa\xc3G_Y\xd9z\xe5=w\x1f\xbb\xf73Ϟ\x1fM\xd7<v\xfe\x1csw\xa38NN\x91\xc4b\xb2\xbb\xa7\xa4d8\x8a\xa9U<ty\xde\xdfX\x9aH\x1a\xaa<A\xd3C\xe7\x9a\xc5c\x8b\xd4j>Q\x9c\xd0jTI\x87#\\\xc3\xe4H\xbd\x82S\xaf\xb2\xb1}@\x96k\x1a\x8e\x05B\xb0ܨ\xf0\xcc\xd9+\xf8\xbe\xcb\xc7\u007f\xec\x83\x18\xa6I\x12E\x85n\x95\x14\xa0%\x9e\xef\xf1ȣ\xf7\x11\x04!\xebk\x9b\xac\xadn\xb2\xb3\xbd\xcfR\xbb\xce++[\xdcsl\x8e;\x96f\x88\x92\x94\xbd\xee\x90\xddހ?\xfc\xa3gy\xf2\x8bO\xa3ʣ\xf6,\xd3\xc02\r\x1c\xb7P*\x05A\x8a\xe0\xf8t\x83\x86\xe7P\xad\xfa8Vqƕ\xe7X\xd4<\xa7\x98\xa7gzBl\bG!\xa3QH\x92\xa4\x93\xb1t0\x8a\xb8\xb1\xb2\x83a\x9b\xecF)\xadrޯ58\x86d\xb9\xees\xad\x17\x9cxuu\xfb?\x9e\xff\xa7?\xf3\xf1;\xff\xd9\xcf\xff\xf9\x92\xfa\xdf\xfd\xe8\xe3O(\xa5\x8c8J\x98\x9e\x9bFJ\xc5\xc6\xea&\x17\xae\xadӬW9}b\xa9\x10u1\x8a\x91lA\xa66\xa9\x8c\xf1\xacj\x85<MY\xb9\xb1N\xa25\xdd\xee\x00\xa3l\xc4\xe6\xda\r\x8eηɄ\xe4\xc2\xfa.R\x14\xe2\xf5\xb6\x94\x9c[٢\xe1Z<\xf2\u0603\x84c\x89ﱨd9۵l\x8b\xe9\xd9if\xa6\xdb\xcc̶0\x94B\xe59O\xbd|\x99\x85\xa9\x06\x96R\xf8\xae\xc5T\xbd\xcaL\xb3\xcaT\xa3J\xa3\xeaѨx\xd4|\x17ϱ\xb0\r\x85\xc83^\xbcx\x03\xcb4x\xfb\xdd\xcb̵k4\xab\x1e\x15\xd7\xc6w-\xacR9;\xcf5\xc10\xa0\xdb\xe93\xe8\x0f\x8b\xd3\xd9\xc6\xfc\xaf\x1cv\xf6\xba\xacn\xec\xd3I2:YNõh\xba\xd6!\x1e\x84!\x05\r\xc7\xe4`\x14\x9f\xfa7\u007f\xfc\xd5\xcd(͞\xfbs\x19\xe4\xef\u007f\xf2\x83O\b%\x8d4ϙ\x9d\x9f\x01\r\x9bk[\x9c\xbb|\x83v\xb3\xce\xf2\xf1\x85\xa2\x12*\x95\x0e\fS\x91\x97\xa7\xdcH\xa5p}\x8fj\xd5\xe3\xea\xc5\xebH\x01\xeb\a=l\n\x18&N\x12ڭ:Ӎ\n'\x16\xa6\xd9<\xe8qq\xbbS\x1aE\xf0̹\xeb\x9c^\x9c\xe2\x8e{\xee,fݷn\xbd\x96\xb1\x1f\r\x95z\x85j\xad\xc6\xccl\x8b3gNp\xe7\xf2\x02\xbf\xf5\x85o\xd2\xf4\x1d\x9c\xf2\x18\xbd1ne*\x85m\x1aؖ\x81c\x998\x96\x89g[\x9c^\x9cƳ-\xbe\xfc\xe2%N-\xcd\xdc\x14&к\x9c\xb5\x8f\xd8\xdf;(f\xf3Y\xc6X\x854\x88\x12\x0e\xbaC.\xae\xee\xb0\xda\x19\x10\x94'N7=\x9b\xb9\x8aS\xd2M_?,s\fC\xee\x87Ѳk\x19\xbf\x13%\xd9\x00`\xaeQa\x10\xfe\xc9{\xf7\xea\xef}\xec\xfdO\x98\xb6eخC\xa3U\x9c\xb9\xb1\xbe\xba\xc9+\x17\xaf3ݬq\xfc\xd8\x02q\x9cbY\x06\x96m\x15\x844\x04\xf5V\x1d\xcb2q}\x17\xc7\xf7\b\x87C:\xbb\alt\a\xe88-4ԁ8Ii\xd4+(%92Ӣ\xe5\xdal\xf7\x02\xfa\xa3\x18!\xe0\x1b/^\xe4\xd1\a\xee`qi\x9e`X\f\x85\xc6\xe2\xf8E\xb7\x9e\x93\xc6)i\x9a\xe0\xb8.\x8e\xe7\xd2n6\xf8\xc0;\x1f\xe0\x8f_\xbc\x88\xe79\xccM7K\x92BA\xe1\xb9u\x9d\xf9惇\x9a\uf8b5f\x18\xc6\xf8\x96A\xaf7\xa0\xdf\xed\xd3\xeb\x0e\x88\x93\x18\x9d\x17?\xef(J8\x18\x84lw\al\xec\xf5Y;\xe8\x17\xb2\x82J\"4x\xae\xc5\xd1f\x95\\31\xc8k\xc5&\xa4\x10t\xa3t&\xcds߷\xcdsa\x92\x1e\xfci\xc6\x00P?Q\x1a\xc4\xf3\vrt\x96gl\xaenr\xf6\xc2uf\xdbu\x8e\x1d\x9d'I\x92\xe24fˤZ\xad\xa0\xa4(\x9b\xb1B\x11\xda\xf3=\x84.ƾ\x9emrnu\x9b\xaa\xa1J\"A\x8a\x90\x92j\xc5-\x0eM\xa9\xfaŀ)ω\xa3\x84\xcep\xc4\xcb\xe7\xaf\xf1\xbew?\xcc\xcc\xdc\fAo\xf0\xba\xcdZ\xad\x8b\xf5\xb21\xc5ǩxX\x8e\xcd]'\x968wm\x03\xdbsy\xdf;\x1f\xa4\xd9j\xd0n7\xa8\u05eb\x98\x96E\x9e\x15G\xe0\x15\xabjE\xa3\xda\rBv;}F\xa3\x88\xf5\xdd\x0e\xfbAD?J\xd8\xea\x0eY\xdbﳾ\xd7e\xbb\x13\xd0\x1b\x04\x04A\bi\x86'\x05\x9e\x14\xf4\xb5\xa6Vs99\xdf\xc6sm\x1cǘ\x84\xf18\xc9&\xc7!\t Hs,)p\f\xe3\xd1 I\u007fĳ\xad;\x97\x9a\u0557\xf6\x87a\xaf\xe5;X\xa6\xfa\xb6\x9d\xbd\xfa{\x1f\u007f\xff\x13\xca0\x8c1]_k\xcd\xe6\xda\x16g\xcf]\xa5ݬr\xfc\xe8\x02q\x9c\xe0yny~GqxV\x12'Xe\x99l\xbbN\t\xa5\xec2\x1a\fY\xdf\xefc\x95\xc4\x05\xa5$Q\x94P\xa9\xde\x14\xc2W\x86\xa2Q\xaf\xa0\xd3\x14_I\x06\xbd\x01/\xbcr\x89\xf7\xbe\xe7!\xa6\xe6f\x18\xf6\a\xdfq\xdd9K3\xe2(\xc2\xf5<*U\x9f\xbbO\x1e\xe1\xe9\xe7\xcf\xf1\xf4\x8b\x17y\xdf\xdb\xef\xc3\xf3=Z\xad\x06s\xf3\xd3,\x9f8\u0089\x93G\x99_\x98\xa1Z\xf5\xb1\fœO\xbd\xc4\xfeN\x87\xbd\x83>Q\x10\x13\xf4\x03\x86\xbd\x80t\x14A\x9cb\xe4\x1aCkL\xc0\x14\x02U\x163\xbbY\xce\xe9\xa3s<v\xff)\xda\xcd:\x9e\xefR\xa9\xf8\x05j\xa1$\x9ekO\xd8\xfaA\x9a\xb17\x8aX\xac\xb8T,\x83\xb6c\xb5\xe2,\u007f\xdb\xfe(\xfa\a\xbee\xf6\xf6\x87\xe1\xd3-\xdfCI\xf1:\xa3\xa8\x9f\xf8\xd8\a\x9eP\xa6a8nq\xba\x8d@\xb0\xb5\xb1\xcd\xcb\xe7\xaeR\xf7]N,/\x91$)\xb5\x9a\x8f\xa1\xcc\xc9\x0eH\x18\x8c\xb0ʓҔR8\x9e\x8b\xcesn\\[\xa5\xe1;\x9c[ݦf\x8fO\xc3)\x96:\xabU\xffМ\xa2լ\x150\x8aι\xbe\xb6\xc5+\x17\xae\xf1أ\xf7Қ\x99\"\x8e\xe3R\x9eO\xbf\x0e#+N\xccIp<\x17Ӳxۃg\xe8\xf5\x03>\xf3\xc5or\xe6\xc4\x12\xa6\xa9\x0e\x11\xf6<\xaf`\\\x0e\x06#\x9e|\xea\x05\x1ah\x94\u0588<G\x16\xbb\xa6\x937[\x1c^/)\xe45L\x83\xf7>|\x86\x1f\xb8\xff\x14\xedv\x13e(\xb2,\x9d\xac_\x8c\t\x15Պ\xcbn\x10\xb23\b9V\xf7\x18\xaf\xaa()h:&\x15\xd30\xfbI\xfa#\xaee\xdd\u05cb\xe2\xdf\x1d\x86q6S\xf7\x19F7\x89\xdd\xea'?\xf9\x81'\x84\x92\x86\xe3\x95{~B\xb3\xb3\xb9\xcb\xd9sW\xa8\xb8\x0e'O\x1c%\xcbR\\\xc7\xc6v\xac\x89\x11\xb2\xac\b\x1f\x85\xa2g\x8e\xeb{x\x9e\xcb\xf6\xe6\x0eA\u007f\xc00N\x89\xc2\x18\xabċ\x924\xa3R\xf1\x0e\x9d'\x05P\xaf\xfb\xc5\xd9\xe6Zs\xe5\xc6&\x97o\xac\xf3\xc0\xdd'Y<~\x84\xb4$9\xeb\x92xp\xd3(E\xc2u˕\xb7\\kN\x9f8\x02:\xe3\xf3O\xbd\xc0\xfcL\x8bF\xd5'\xcbs\fC\xb1\xb9\xb1ËϿ\xcaן~\x99\xf5\x9d\x03\xfe,\xf4\x04\x01$\x80Y\xaf\xf0\x83\xefy\x88\a\xef>A\xa3Y\x9f슌\x95\x82\fàV\xab`\xd8&\x17\xd6\xf7\x18\xf4\x03\xe6|\x87\\\x8fɏe\tn\x99XRR\xb7\x14\xa3,\xbd;\xcb\xf5\xdb+\xb6\xf5\x85\xdd~\xd0o\xfa\x0ea\x19\x8e\xd5O~\xe2COH\xa5\f\xb7܆\x05\xd8\xdb\xde\xe3\x95sWp,\x83\x93'\x96&\xca\bʐ\x87\x0e劣\x18\xab<N\xa28\xa9\xc0\xc2uL.\x9c\xbf\x8a\xefX\xac\xecvq\xd4\xcd\xe3\xed\xb2,\xa3Q.\x03\xddz\xf9nAdˣ\x98\xf5\x8d\x1d^\xb9\xbcʝ\xc7\xe6\x98?\xb64\xa1|\x16ba\x85\xaa\xb4a\x9a\xf8\xd5\xca!\xb6\xbb2\x14\xa7N\x1dÕ\xf0\xa9'\x9fb\x14Ŝ9\xb9ċ/\x9c\xe7ܫ\x97I\xe2\x84\v+[\f{C\xcc?\x83AFZS\x9fi\xf1\xe3?\xf4.\x8e\x1f\x99C)\xc5\xc6\xfa6\xbdްT\x9a+\x0f\x9b4\x14\xd76\xf7\xf8\xc6\xcbW1☊U\xac\x04\x8eY\xa0Ǐͳ\xb48C\xad\xeaM$\xd4\xddB\x8a\xf6d\x90f\x1f\xf5l\xf3\xb7\x0e\x86a\u007f\x8c\x16\xab\x9f\xfc\xe4\a\x9f\x90R\x19\x9e\xefMn\xb0{\xd0\xe1\xd5sW\n\xb6\xfb\xd1\x05l\xc7\xc6u\xad\xe2\xfcq\xa5P\x86*\x92l\x9c`\x18\xc5I5\x96](3\xfc\xff\x85]Ko\x1bU\x14\xfe\xee\x9d\xf7\x8c\xedql\xb7yӤi\xdc64\x01\fDH\x15Y\xb1A\xaaĆ\r\x12l)?\x80%\v\xfe\x02\v\xfe\x04[6l\x90\n\x85\"\xa1@I\x80R)%\x8e\xe3\xc4v2v\xe2\xd7\xcc\xf8\xce̝aq\xc7nJ\xa9\x98\xdfp\xce\xf9Ν\xf3=\f\xcb\x00\x0f\x028-\an\x18a䳉\xd7\xfah\x14\xc0L-\xfc\xfe]\x8e\xba\xae \x9fςy>\xda\xce\x19\xeem\xff\x89ks%,,-N\x84D\xe28eBKq\xec\"\xe8\xdbSy(\x9a\n\xce\x18^_\xbb\x8a\x9d\xc7U|\xf5\xf5w\xa0\xe9K\x9fs\x8e?\xaaMH,\xf8\xdf\xde\be\t\xd7\xcaW\xf0\xc1\x9d-d,\x13Q\x18\xe1\xe8\xa8\x01\xc6\x02\x00OC]\xe28\xc1\xcf\u007f\x1d`\xff\xb0\x89iC\x85L\xa9`\xfa\xab\nn\xddZ\xc5\xe6\xe6:LS\a\xe7\x1c\xaa\xaa gg\xa1H\x12T\x85\x82\x82`Ȃ\x02\x8b\xf8]]\x91\xef\xf5<VO\xdf!\xef|F$\"\x1b\x96)\xa8\xfcI\x02w\xe0\xe2`\xbf\x8e\xee\xd0\xc3\xca\xd2,r)u\u007f<\xfb\xe5T\xa0#\bo$\xddh84SO\xe5\x06&\xea\xb5#X\x8a\x8c\xdd\xc3\x13\xe8\x12E\x9c\x00\xaa$\xa1\xdf\xf7`ۙ\xe7F\x97\xc0\"\x8ab\xc1\x068\a\x8d\"|\xf3\xd3\x0eH̱Z^\x86\x95\xb50\x0e\v\x1boxR\xaaK\xc9\xda9d\xec,\x86\xbd>N\x1a'\x18\xf4]\x142:\xba\xed.~ݫC\x91%\xf4\xdd\x11\x1a\xc7\x0e\xa4\xf8ńØ\x10\x18\x85,^ٸ\x8ew߮\x80P\n\xcf\x1b\xa1\xd5:M\xe5\tI\x9a-\x92\xe0\xf4\xbc\x8f\a\xbbO\xc0\x86\x1eJ\x86\x06J%\x94\x8ayll\x94q\xfbv\x05ł\x8dnw R\xe2.\xe0\xa0ajp}\x86\xfdV\a3\x96\x0e\x8dR\xb5\x1fD\xefk\x8a\xfc\x03\v\xf9\xa1\x1c\xc7\x1c\x12d\xc1^O\xd9ފ\xa6\"cj\xa86\xdb`\xa3\x00\x9a\xa6Nv{\x1eq\xf0HdpĜ#\n\x05O\x97G\x1c\x81Ϡ\xea\x1a\xa6JET6_\xc5\xf7\xdf\xfe\x88J\xf9%<\xd8\xd9\xc3L\xceę7\u0094\xa1\xa3\xd9p\xb0\xb00\xfd\xac\xc0\xe6B\x85\xce\xcd]B\xaf7@\x96\x85\xb8\u007f\u007f\x1b;\x8f\xfe\xc6\xc7\x1f\xbd\x87++\x8bPTM\xdcD\xd2ݟ\x12\x82\xc6\xe11~\xdb\xfe\x1d\xb5\xfdCt\xdag\x93\x19?\x951\xb0\xb5\xbe\x82\xe3N\x0f\x0f\x1f\xd7@\x83\xf0\x85v\x81.\x8fqy\xae\x84\xb7^\xbb\x81\x8d\xebˈ8G\xe7\xec\x1c\x83\xbe+\xfc\x1dS\x1d\x8a\xcfB\xec<\xa9\xe3\xbc\xd3CV\x95a\x98:\xec|\x06\xeb\xebe\xcc\xcfO#\fB\x9c\x9e\xb6\x9f\xe2L\xda\xc1\x94\x120\x16\xa1\xd5r\xf0\xa8\xee@&\"yq\xd66!i\x8a\xbd\xef\xf4\xbe\xb0MmKN\x12\xb0\xf1\xe1)\x181H\x8a\f\xd3\x12i\x04,\x88$\xd7\xf5\x9e9r\v-\x06O%\x04\xc2Q'\f\x02H\xb2\x8c\x801\xd1=\x94bum\x15\xb5j\x1dqR\xc3\xc2L\x91r\xcf'%\xcb\xe0\x8e\xeb\x93f\xdfU\"B\xf8\xf2\xe24'ϱ\x0f\x05\x18\xdav\x16V\xc4A\xbb\x03\xc8l\x94|\xfa\xf9\x97\xea\xdd\x0f\xef$/߸\x1a\xe9\x96pd\x18t\x87x\xf8\xcb.\x8e\xeb-\x84A8\xb1U\x1ao~\xe3\x02+dM\u2efe\x94#\xe4?\x0f\xdf\x1e!\xea͛K\xe1\x9b\x1b\xab\xc9\xfc\xece\f\x87\x1e\x1c\xe7Lp\x98\x91L\xb2\xac\xaaͶ\xbc\xbbWG^\x91\xa2\x19\xdb\xc2\x1b\x955\x94.M\xc14\x85Įq|\"~\xb7\\`\xfc\x8f\xbf\xc1\xd0\xc7A\xb5\x91\x1ctz\x9a\"\xd3 \xaf\tff>g\xc0\b9\xe9\xb8~e8\n?\xf9\a\xc6\xf1\x82U!QBR\x00\x00\x00\x00IEND\xaeB`\x82")
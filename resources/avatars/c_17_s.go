@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_17_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14%0\xf3\xe3\x19\xfc\x00\x00 \x00IDATx\xda\xec\xbcg\x90e\xe9y\xdf\xf7{\xdf\xf3\x9eps\xe8\x1c\xa6\xa7'\xef\xcc\x0ev6b\x17\x00\x11H\x81 X\xb2BQrY\x92%\xcbv\xd1\xe5P\nV\x99_\xe4b\xb9\\.\xdb\xe5\x92K\x96,\x8b\xa2\x95MJ\x10-\xd34\xc1\x00\x11D\xe6\x02\x04v\xb1\xd849t\x9a\xce\xf1\xe6p\xf2\xeb\x0f\xe7\xdc\xd3ݳ\xb3 \xa5*K\xac\xb2\xeeԭ\x99\x9e{\xfb\xdes\xde'\xff\x9f\xff\xf3\xc0\x1f\xc0\xc7\xf5\x8b\x8bO\xfd\xf7\xbf}\xfc\x1b\x16ƿ\x15\xca\x1f,\xc1|\xe1\xfa\xc5ş\xf9\xff\x9bP\xe4\x1f\xd4\v\x13BԀ\xbfv\xfd\xe2\xe2/]\xbf\xb8X\xbc\xbd\xb4\xc6\xf5\x8b\x8b?T0\xff\xaa\xaf\xfdAz\x18\u007fP/l\xa2V\xa9:\xb6\xfd\x87'\xc7\xebׇC\xf7\xa7k\xa5b\xa0\xb5>RJ\xb9{G\xcd\xe0i\xbf3Y\xaf\x16'j\x95g'j\x95\xbf4;9\xfe\xf3Sc\xb5\xff\xa0^)\x15'k\x95\xbe\x94\xb2\xb7\xdfh\x05O\ni\xbf\xd1\xfa\xff\xdc\x05\xff\xb0\xefx\xf2u\xf1\xaf;6\xdc^Z\x03\xe0#\x17\x17K\xb1\xd6\x1f\x89c}E\b\x8a\x80\x16R\"\xc0\a\xde\a0\f\xe3\x8d\xcf\u007f\xe6S\x1c5\x9b\xec\x1d\x1c\xb2\xb9\xbbw\xd8\xed\xf5\xbe#\x85|C)\xf5\x96aȎ\x14\"\xe7\a\xc1B\xac\xf5%\x1d\xc7\x1f5M\xf3\xb3\v\xb3\xb3\xe6\xd4\xc4\x18\xb1\xd64\x9a-6vv\x87\x9e\xe7\xfd\xa6\x94\xf2\xebq\x1cc\x18\xc6֝\xe5ǿ9\xba\xae\xd15\xfd\xab<\xfeҟ\xff\xd3\xfc\xad_\xf8\xa5\u007f\x99s0\xd2s7o/\xad\r\x9f\xfc~\xf1\xaf[\xf3\xaf_\\\xfcl\x1c\xc7\u007f%\x8e\xe3\xe7\x95R\xf9B\xce\xc9\xc5Z\x1bq\xac\x19\x0e\x87\xc4\x10\v\x18 \xc4Аr\xe6\x93\x1f}\x85\x99\xa9I^{\xf9E\u07bdu\x87ã\x06\xf7\x97\x97\xe3\xcd\xed\xed\x9e\xe7\x05\x91\xa1\f\xe9؎].\x16\x9c\xa9\xc9q\xce\xcc\xcc073\x8daH\xf6\xf6\x0f\tÐޠϽG˴\xba\xdd g;\xba\xd9i\aq\x14\xff\xa6\x10\xe2/\xdd^Z\xdb\u007f\x9a\xc2<M\xa1~\x98\xe0\xfe\xe2\x9f\xfb\xf7\xea\xcb\xeb\x1b\xaf\xf5\a\x831?\b.\xa2\xc9\xf9AP\xf1\x83\xe0b\x1c\xc7\x15\xaduIkm\xe9\xd4+I!l)\xe5\xaf\xdf^Z\xfbON\xb9\xea\x13_hk\xad\xa5\x10\"\x04\xf4\x89g\xfc\xc3.\xe4\xc3.\xf4\xe4\r\xa6\x9f\xfdq)\xe5\xdfP\x86qcjb\x9c\x8b\x8bg\xa9U+ئE\xb9\\\xa2^\xabqpx\xc8\xfa\xd6\x16áG\xa7\xd7c\xe8y\\>\xb7\x88e\x9a\xfc\xe9\x9f\xfa㸞\xc7\xfbw\xee\x12E\x11R\n|?d0\x1cp\xe5\xe2Ef\xa6&\xb1L\x13\xd7\xf7\x18\xf4\x87x\xbe\xcf\xde\xc1\x01ۻ{\xc4:&\x8e5A\x10\xa0\xb5\xa6\xd1j\xf1\xe6\xbb\xef1\x1c\xba\xc4Z\xff=\xad\xf5ߋ\xe3\xf8\x81\x9422\xa4\x8cb\xadG\xf7\x8ei\x9aR\na\x18J\xa90\b\xccb!\u007f\xd10\x8c\x8f\x05A8\xe1\xf9ނ\x14\xf2U\xad\xf5e/\b@k\x10\x02)%\x96i\x92w\x1c\xf29\x87B>O>\x97C)\x03e\x18\b\x04\xdb\xfb\xfb,\xad=\xfe\xd5;ˏ\u007f\xea\xc3\x04\xf2\x8f\x81\xf3A\x18>\xd2Z7\x05x\bёB\xf4\x84\x10\xfbR\xca&\xd0\x01z\xc0\x11м\xbd\xb4\xf6\x01_~uq\x1eC\xa9LH\xd7/.>\x1f\xc5\xf1\xcfVK\xc5?1;5\xc5\xc2\xdc,\x17\xcf-r\xe1\xdc\"S\xe3\xe3\x94J%,\xd3\x1c\x05r\xfc \xe0\xe0\xe8\x88\xd5\xc7\xeb<Z^\xc9n\xf0\xa3/<\xcf\xf4\xe4$\xbd~\x9f\xe5\xb55\x06\xae\vZ\xa3\x81\xb1Z\x8dz\xa5\x82a\x18\xf8\x81O\xa3\xdd\xe6\xe8\xa8A\x10F\b!\x00\x8d\xd6\x00\x9aXk\xa20\xa2\xd5n\xb3\xbd\xb7\xcf\xde\xe1!\xcdV\x1b?\b\x0e=\u07ff\x1b\x86\xe1\x9e\xe7\am\xaduK\b\x94Rj2\xef8\xe7r\x8esE)U\x1f\x1d\xbaR\x06\xb6mc\x9b&\xf9\\\x0eǶ(\xe4\xf3X\xa6I\xb1\x90G\x19\n\x00\x9d^c\"\xe3\xe4\x11E\x11?\xb8y\x8bǛ\xdb\u007f\xf6\xfe\xda\xc6\x17> \x90\xeb\x17\x17Ka\x14}\xfb\xfa\x95K7\x1c\xdb!\x8a\"\xa2(\xa2\xdb\xeb\xd1\xeav\xe9\xf7\x87\f=w \x12\xe1\xf4\r)[R\xca\x0e\xb0\a,\x03\xb7\x80\x9be!\xee}\xf7\xd1j|B\xc8\u007f$\f\xa3\x9f\xbfz\xe9\xc2ܹ3\xf3\x8c\xd5j<{\xf5\x19.\x9d[$\x97\xcb\x11\xc7qv\xa1\x86\x91\xe4\x17\xfb\a\a4\x9am\xa4!i\xb5\xdbh\xad\xe9\xf4\xfa\x18R\xa2\x94B\xeb\x980\f\x89\xa2\x18\xadc4\x10\x86Q\xfa\x19\x12\x1dk\x92#\xe0\xf8@4\xd9{њ \x8c\x18\f\x06x\x9eG\xa9Tdvj\x9a|.\x87\x17\xf8\xf8A@\xb7\xd7\xc7\xf7}\x84\x10\xe4r\x0e\xc5|\x1eǶq\x1c\a)\x04a\x14\"\xa5\xc4\xf7}\\\xd7\xc3\xf5<\\\xd7\x05!\x12\xa5\xf2|\x9a\xed\xf6\x93Ycv\xaf\x1a\xcdW~\xe7;\xadn\xbf\xff\x9a2\x8c\a'=\x8c\x1a\xbd?\fC\xfd\xc2\xf5g\x99\x99\x9ab\xef\xe0\x90^\xaf\x87a\x18LOM\x82\x86\xfe`\x90\xdf\xdc\xdd\xcd7[m\x1eonqpx8\xba\x80X\b\x11\x03qGk\xef\xfa\xc5\xc5w\x80\x9f\x13Ћt\xfcO^{\xf1Fenf\x9ar\xa9\xc4g?\xf5IJ\xa5\x12\"\xd5\x12!\x04\xa6a0\xf4<6\xb7w88<bzj\x82K\x17\x17\x11'\xc2\xdb`8dm}\x13i\x18\x14\xf2y*\xa5\"\x8em\xa5\a\xad\x19\f]\xf6\x0f\x0e\xd9\xde\xdb%\bC$\x12\x8dF\xc7:U9\x9dd\xf8Z\x13\xeb\x98V\xabE\x14Ǽ\xfa\xe2\v\x9c;\xbb\x80\xd6:\xb5\xa4\xe3\x87\xfe}\x04\xd8X\xa7\n%\xe0\xa8\xd1do\xff\x90V\xabM\u007f\xd0\xcf>O\xa4B\x1a\x0e\x87\b!\xb0m\v\xcf\x0f\xe9\xf4z\xef*\xc3\xd8\u007f\xf23\x15@\x00\x1d\x01\x83\xfe\xc0\xe5\xe3/\xbf\x84\x90\x12/\xf0i\xb5\xdat\xfb}\x1c\xdb\xc6\xf7\x03\xae^\xbeL\x14\x85\b!8l4\xd9\xdc\xdeau}C\x1e\xb5\x9a\xb2\xd9l\x11D\xa1\xe5z\xfe\xa7\x83 \xf8\xb4\x06\x9e\xbbz\x953\xb3\xb3\x8c\xd5j\xfc\xd8'\u007f\x04\xa5\f\x02\xcf#\x88B\xfa\xfd!\x87\x8d\x06\x0f\x97W\xe8\xf6z|\xe4\xda3\xbc\xf4\xfcG\x90B\x10\xc5\xf1\xa9\x8b\xcc\xe7r<w\xed\x99$\xa0\xc5\x1a\x1d\xc7\xc4Z\xa7گ)\x97\x8a\x94KE\x16\xce\xccq\xf7\xc1C\x9a\xad\x16h\x91TY1\x99P\x84\x00!\fJ\xa5\"\xfd\xfe\x80\x95\xc7\xeb\xcc\xceL\xe3\xd8\xf6)\x97\xf2{e;R\n\x82 d\xe8\xballm\xb3\xba\xbeA\xab\xd5&\x8ac4\x9a~\u007f@\xb3դ\xdd\xedQ\xb0-\x0e\x0f\x0f)\x14\n\xdcx\xee9\x94\xa18\xec5\x89\xa2h\xc52\xcd\xf6\xadG\xabO\xffޫ\x8b\xf3_\xb9\xf1\xec\xb5\x1f\u007f鹏pn\xe1\f\xf3s\xb3\x94JE|\xcf'\bC\x18\x99\x9b\xd6\x04aH\x10\x84\xf8aH\x10\x04x\x9eG\xa7ۣ\xd3\xebq\xd4hp\xd4l\xd1\xeev\xb1\x94b\xe8\xbaLN\x8c1Q\xafS,\x14\xd1:\xa6\xd5\xee\xd0\x1f\f\x10B\xf0ܵ\xab\\\xbdr\x89\xf1z\x9d0\f?X \x8e\xa2\xeb\t\xcd%uC\x89\xc6\x03:\x11\x10\x80\xeby\xbc\xf1λ\b\x9d\xb8\x06\xf4\tM֠\x89\xd1\b\xc2 d\xe8\x0e\xc9\xd9\x0e\xe7\x17ϲ\xb8p\xe6\xf7*TQJ\xd1\xef\x0f\xd8\xd9\xdfggw\x97\x83\xa3#Z\x9d.\xadv\x878\x8ei\xb6\x9a\xb4\x9a-\xdc\xe1\x908\x8e\x89\xa3\x88 \b\xb8r\xe52W._Nj\xa5\xf11\xbe\xfa\xed\xdf\r\x1f,-\xff\xcc\xc3\xf5\xed\xff\xf5\xa9\x16\x92\xde\xe8\x91\xebyt{=\u07bfs\x97\aK\xcb\xe4\xf39\x9e\xbbv\x95\xb1\xb1:a\x18\xe1\xfbA\x9a\xa9\xc4IPs,t\x9c\xf8\xe4R\xa1@uPfrl\fǶQJ1\x18\x0e\x18\xba.\a\x87\r\u07bd}\x8b\xdd\xfd\x03\x9e\xbdr\x99r\xb1\xc8\xf8X\x9dO\u007f\xfcc\x8c\xd7kH)? \f\xc30\x10@\u007f0\xc0\xb6\xedc7\x11\xc7\f\x06.\xc5|\x0e-\x04B'\a,Db=9\xc7\xc14\x14Q\x14\x82\x16h\xa1\x91Hb\x11'֒\xba.\xa5\x14\xa5B\x81 \x8ax\xb0\xbcL\xa7\xdb\xe5\xf9\x8f\\'~\xc2:\xd3L\x8b^\xaf\xc7\xcd{\xf7988\xc4\xf5<\x00ַ\xb6\xe9\x0f\x87\x18\x026\xd67\x88\xa2\x10\x1dk\xe28F\bA\x1c\xc7\\\xb9r\x99g\xae\\\xc9,жm\xb6\xf7\xf6:B\x88o>M\xf0\xea\x84\x06\xac\xb6{=mH)\x820\xc4\xf5<\xb6ww\xf9\xe7\xffϯa\xe7s\\\xbep\x9eg.\\\xe0\xe2\xb9E\n\xb9<\xbe\x1f$\x17/\xc0\xb1\x1dr\xb9\x1c\xb6m\x13\x86!\x87\x8d&\xe5b\x11\xdb2\xa9\x94KLON\xf0\xcc勬ol\xf0[\xdf\xf8\x16\u007f\xe8S?\xc2\x1f\xfd\xfc\xe7RM\xd7\xc7\xc1.uܞ糳\xbbK\xb77\xe0ʥ\xf3Y@\x8c\xe3\x98\xe5\xd5\xc7\xcc\xceL\x9f\xb0\x1e\x01B\x13\xc7:\x13\xca+/\xdc\xe0\xd6\xdd\xfb\xf4\x06}\x04\x02\x8dF\xeaD(B\v\x10\x89\xa5\v)\xf1\x87.\x83~\x9f{\xed\x0e\xbb{\a|\xee\xc7>\x9d}\xdf\xc8\x1b\xbc\u007f\xe7.\x0f\x97\x96\x91R\xa6.Kr\xe7\xc1C\x820\xc4\xef\xf798<L_Kn`\x14?\xca\xe52\xe7\xcf-f\xf7\x97s\xec4>\xf7\x1f=x\xbcu\xf3\x87B'\xd3\xe3\xf5i\xcf\xf3\u007f\xea\xf2\xf9sb\xf4\x01\xf9\\\x8eR\xb1\xc0\xb0\xd7\xe7\xe8\xf0\x88\xfb\x8f\x96\xf8\xf6\x1b\xdfg}k\x93\xfe`\x90\xb8\x02 \fC<\xcfEk0\x95J\x82\x98\xeba\x9a*K\xf9D\xe2\xc0y\xed\xc5\x17\xf8\xf4'^#\nC\xc2(\"\fB<ߧ\xdd\xe9\xb2wp\xc8\xe3\x8d-\x1eol\xe2y>\u05ef]!\x9f\xcb\xe1\xba\x1e\xedn\x97\xdb\xf7\x1e\xe0\a!\xb3\xd3SYVF\x1a0\x87C\x97\x9c\xed\xa0u\x8c\x14\x82\xd9\xe9i\xaa\xe5\x12RH\x10\x82X\xc7DQ\x9c^\a\x8cb\xb8eYX\x96\x95X\xdep\xc0\xf2\xea\x1a\xf9\\\x8e(\x8eXY{\xcc\xf7\xdez\x9b8\x8e\b\xa3(;\xd8[\xf7\xee\xd3j4\xd8\xdb\xdbc8\x18d\x82:\xed`\x05\x97.^\xa0^\x1f\xcb\\\xfd\xf8X\x9d\xb7\u07ff\xc5\xf6\xfe\xfe\u007f\xddhwo>\rV\xc9,\xc4\x10\xe2f\x18E\xba?\x18\x88|.\x97i\xc8\xe4\xc4\x04\x85|\x9e\xc7\x1bI\xe0\xb2\x1c\x9b\x9d\xed\x1d\xee\xdc{\x80i\x99L\x8d\x8fS\xafי\x1c\x1fc\xbc^#\xe7䨔\xcbX\xb6\x852$\xa6ef\xd9P\xb9TDk\xcdå\xd5T\vc\xba\xdd~b\xf6\xa9\x8b\x12B`\x9a\x8a\xab\x97/\x10E\x11K\xab\x8fiw\xba\xf4\xfb\x03\x84\x80B\xc1>}\x03\x86\xc1\xe6\xce.S\x13\x13\xc9A#\xd2\xe0\x1fS,\x16)\x16\x8b\x04a\xc0`0`\xef\xe0\x90\xfd\x83\xa3S)\x94 \xc9|\x94\xaa\xd0\xeb\xf5\x19\f\x06|\xff\x9dw\x91B\x10D\x11\x1f\u007f\xe5e\xea\xb5*_\u007f\xfd;ıf\xf9\xf1\x1a[\x1b\x1b\x84q\x8c!\xe5S\xd0\xd9䃫\xd5\ns\xf3\xf3\x99\x10\x95RDQ\xc4\xc6\xceN\xff\xd1\xfa\xf6\x17>\f\x15\xc8\x04r\xaeV\xbd\xb3\xd4hƍfK\x16\xf3y\xa2\x13A<\x9f\xcf\xf3\xcc\xe5\xcb4\xdb-\x96W\xd60\x80\xb1j\x85\x81米\xb7\xc7\xee\xfe>\xf7\x84@\x99&\xb3\xd3\xd3(Crfv\x96\x1b\u05ef3V\xaf&\xea(\xd2\x00\x1bk\\\xcfC\xc71\xab\x8f\xd7A\xc0\xe4\xc4D\xa2\xc9'\x1e\x0f\x96\u05c8S\xad\xd4Z'5\x86\xd6\x04~\x12\xc3F֡S\xbcj.uc\x89P\x8e\x03c\xac5\xcaP\x94K%\xf2\xb9\x1c\x9dn\x0f\xd7\xf5\x90R\"\x85H\x02s\xbbK\xadZ\xa1X,b\xdb\x16\xcdv\a/\bx\xed\xe5\x179wv\x81\xb7\xde{\x8f0\f\xd9\xda\xdd\xe1\xee\xbd\xfb\x98B\x9cH\x93?\x98 \xc7qĥ\x8b\x17Q\x86\x91Ťb!\xcf\xea\xc6&C\xd7\xfb\xdb@\xfca\b\x87\x01\xf0\xfc\xe5\xf3|\xeb\xe6==5V\xfbL\x1cG\xe7\xce\xcc\xcd} \r\x8c\xb5FI\x03\xd34\xf1</\x83\a\x84\x10\x84a\x98T\xc0QD\xb3\xd5\xe2\xa8٢\xd5\xe9\xd0\xebu\xa9V\xaa\x94\x8a\x85c F$.ny\xed1\xf9\\\x8e\xa9\xc9\xc9D\xab\xb5&\fä\xe8\x8b\xe3̥\xc5q\x8c\x942\xcb\xe7\xe38frb<s\x8d\xadV\x9b݃CΟȒ\xb4քQ\x84\xebz4\xdbm\xb6w\xf6x\xbc\xb9\xc9\xda\xfa&q\xac\x89\xe2\x88[\xf7\xee\xf3\xeb\xbf\xfdUd\xbeDmr\x9a_\xfc\xc2\x17x\xff\xee=l\xdb\xe6\xec\xdc,\xfd\xc1\x90٩)\xb6wvx\xb8\xb4\xc2`0\xe0\xf5\xef~\x0fG\xa9$5\aTzMR\x8aQ\x12\x8a֚R\xa9\xc8\xf5\xeb\u05c9\xa2(\xcb\xd0\n\x85<\xefܼ\xe3\x1e6\x1a\xff\xeeA\xb3=\xf80\x04X\x01\xbc\xf7p%\x91\x8e\x14\xff}\xa3\xdd\xf9\xb1f\xabE\xa5\\΄\"\x84`k{\x87F\xa3A\x14E\xa8\xf40\xa4\x94\xe4\x1c\x87Xk\\\xd7E\xa7\xa6iY&\x83~\x9f\xdb\xf7\x1f\xd2\xee\xf5\xf8c\x9f\xff\t\xea\xb5*A\xa0\x89#\xcd\xee\xde\x01\xf5Z\x95B>O\x10\x86\xac<~\xcc\xe6\xce\x0e{\xfb\x87LLM\xb2\xbf\xb7\x97\xa6\xa8\x1aS\x99\xd4kU&\xeau&\xc6ǨW\xabx\x9eO\xdeq@k\x8e\x9a\xad\xc4\x00\xa5DhMw\x90\xd47\aGG\f\x06C@g>\u07b2,\xd0\xc9\xcfg\xe6\xe6X\xdb\xd8\xe4\xd1\xfd{ܿ{\a۶)\x16\xf2\x84AH.\x97\xa3V\xa9p\xef\xe1#\xfa\x83\x01J)\xf6\x0f\x0fQ\xd2@\xca\xc4\x1at\xa43\x05\xa9\xd7\xeb\x04AH\xa7\xd3\x01\xe0\xa5\x17_$\b\x82c\x01\x15\x8b\xacom\xb3\xbd\xb7\xf7\xb7\x044\u007f\x18P\xa9N\xfe\xf0ރ\x95o~\xe4\xe2\xe2\xbb\x1b\xdb;/\x94\x8a\xc5\xcc,\xb5\xd6L\x8c\x8d\xe1\xd8V\xe6˻\xbd>a\x98@\b9\xdb&\x8a\"<\xdf'\x8ab\xc6\xeb\xf5$FĚ\xbd\x83C\xde~\xff&/ݸ\x81i*V\xd6֨Vj\xe4s9\xe24E\xfc\xc1{7\xb9\xfb\xe8\x11\xe5R\x89_\xf8\x95_\xe3\xaf\xfe\xcc_\xe1\x1b_\xfd\n\x86\xa1\xb2 \xa9\x94\x81m\xd9\xfc\xc8G_\xe1\xf2\xf9s\x00\f]\x8f\xc3f\x13ǲ\x91R\xf2py\x85F\xab\x9d\x80\x8fB\xe086\xbd\xfe\x80V\xa3\x95\xe0XiAY.汔\xc1k/>O\xbb\xdb\xc3\xf5\\\xa4T\x14r9ʥ\x02G\x8d\x06hX8s\x868\n\xe9\x0f\x864\x8e\x8e \nq\xa3\x908\x8e\xd0*\x012\x952YXX@\b\xc1\x9bo~\x9f\x853g\xc8\xe7sYܴM\x13۲\xf8\xde\xdb\xefn\xf5\x06\x83\u007f\xf2`m3\xfaa\xf5\x8e\xfa`\x01\xc4_]^_\xff\xf2\xf9\xb3\v\xe4\x1c'\xfb\xff\\\xce!\x97s0\xa6\r6\xb6\xb6\x18\x1c\x1c\"O\xc0\x03\x85\\\x8e(Np\xa6\xfe`H\xa5T$\x161J\b\xae^\xba\xc4p8d\xe5\xf1\x1e\x95R\x99R1O\x1c\xeb,(\xff\xf1\x9f\xfc\t\xc28bm}\x83\xff\xea/\xfe\xe7,/-eV8\xf2φ4\xf8\xe8\xf37x\xfe\xd9kt\a}\xa6\xc48\x87\xcd\x06\xbe\xefS\xafV\xf9\xfe;\xef\xe1\xfb>\xadN\x97\xfbKKlnﲷ\xbf\x87\xeb\x0e3\x10r\xa4\\\x86RH)\xa9T*L\xd4\xeb\xcc\xceLQ.\x14\xd1:\xb1\xa2R\xa1\x80\x1f\x04(\xc3\xc0tl\xea\xb5\x1a\xf3\xb33|\xf2c\x1fCHA\xceq\xf8\xa5/\xfe\x06\x9f\xfb\xd4'\xf8\xc7\xff\xf4\x9fQ\xaf\xd5\x10B`Y&33ә\xfb\x92RR\xaf\xd7\xf8\xe2o\u007fU\xb7:\x9d_y\xb0\xb6y\xfb_\xaac\xf8ҕ\v\b\xc4^\x10D\xcf\xf5z\xfd\xcbg\xe7\xe7O\x01u\x00;\xbb{lmoc[V\x02\xb6Iy\xec\xe7\x81 \f\x89\xe2\x88R\xb1H\x18\x86\\X\\\xe4\xd2ŋt{=ڝ.O\x8bO\x96ir\xf5\xf2%\x820dyy\x89\xc0\xf7\xb1\x94I!\x9fcfj\x92\x1f\xfb\xc4'\xf8c?\xf19.\x9c=K\f\x98\x86\xa2V\xad\xf0`i%\x81y|\x9f\xf5\xad-~\xe3+_\xe1\xab\xdf\xfa\x16\xab++\xec\xecl3>1N\xb9Ra\u007fw\x970\b\xb2\xa7\xef\xbax\xaeK\xab\xd1\xe0\xe0\U00010575\xc7\x1c6\x9b\xe4\xf3\x0e\xb5J\x95j\xb5J\xa9XD\xa9$f\x9a\xcaD\nA\x1ck\f)\xf1\xfd\x80\xdf\xfd\xfe[\xbc\xf3\xce\xdbLLN011\x81\x10\xd0\xeb\xf5\x99\x99\x9e\xc6Lc\xabmY|\xfb\xad\x1f\xb0\xb6\xbe\xf1N\xceq\xfe\xf4\xeea#\xfa\xbd:\x88\xa7\x04\xb2s\xd4d\xf7\xa8\xe9\xcfO\x8d/\xb5\xbb\xdd?\xab\xd1\xe6\xcc\xe4\xc4)튢\x88\xb1\xb1:SS\x93T\xab\x15\x9a\xcd&a\x18%f\x1a'\x819\bB,\xcb±m>\xf6\xca+(à7\x18P*\x14pNT\xddB\x88$\xdd\x15\x02\x1d\xc7\xccMO3?3\xcdx\xbd\xc6\xcc\xe4$\x97\xcf/r\xe3\xd9k\x9c[X\xc8\n\xc3Q\x82\xd1\xeevp]\x0f\xd34\xf9\xfe;\xef\xf2/~\xfb+\xeclo\xa3\xd3\xf7\x14\x8aE\xfe\x87\xff\xf9o0>>\xcew\xbf\xfdz\xf6='\x9fR\xa6\x80c\x14\xd1\xedt\xd8\xdcޡX,qn\xe1̱0L\x13S),Ӣ\xd9n\xf1˿\xfak|\xe3\xf5\xef0\x18\f\x90B077G\xb9\\Fk\xa8U\xab8\x8e\x93\x15\x96oߺ\xcd\xea\xfa\xc6oI)\xff\xd4͇+\xad\xdfOw\xf2\xa9=\xf5ݣ\xe6\xf6\xfc\xe4x\xf7\xa0\xd9\xfaIw8dr|<\xbb\t\xc7q\xc89\x0e\xca0h\xb7\xdb\xec\xef\x1f\xb0\xb8x\x16)D\x86O\xf9A\x80\x1f\x04\xbc\xfaҋ\xccNO\xa3\xb5\xa6\xd7\xef3>6\x96ť8\x8eq=\x8f\x83\xc3C\x0e\x8e\x0e\xd9?8\xa0\xd9n\xe3\xfb>ӓ\x13\x9c_<\xcbX\xad\xce\xd9\xf9\xb9L\x88\xae\xe7%>ٱ\xe8\xf7\x87H)\xe9\xf4z\xfcꗾD\xbb\xd9̲1\x80(\f\xf9\xf6\xb7\xbe\xc1\x9b\xdf\xfbn&\xa4\x1f\x86S%\xb0\xb9\x87a[\x9c\x99\x99\xa1V\xa9bZ&\x962\x93ƒRT*e\x84\x14<x\xf4\bK)l\xcbfaa\x01\xcb2\xb3ZC\b\x812\f\xbe\xfb\xf6;ll\xed \x84\xf8\xe2\xed\xa5\xb5_\x05\xd8o\xb4\xf8\x97\xb2\x90\xd1\xe3ƥs\xbc\xf7h\xf5\xcd\xd9\xf1\xda+\a\x8d\xe6e\xb4f\xac^;\x05Qk\xad\xd9\xdbߧP\xc83?;\xc3Q\xa3\xc1\xd0u\t\xa3\b?\bx\xee٫|\xf4\xc5\x17\x01\xc1Q\xb3A\xa3٤?\x18P\xabUq\xdd!G\xcd\x06\xedN\x1b\xd7\xf3\xf1\\\x8f8\xad\xe6\xf3y\x87sg\x170\f\x03۲(\x16\x8bH!\xf1\xc3\x00S)\n\x85<J\x19X\x96\x85\xef\aH!\xf0\xfc\x80v\xb7\x87繧\xee\xc3\xf3<\xa2\xa7\x00\x96O\x85\xd2\xe3\x98R\xb5\xca\xd5K\x17\x91B\xd0\xe9uACo\xd0\xc72-r\x8e\x83e\x99<\x93\xba\xd6\xf5\x8d\r\xf2\x85<gS\xeb=y.\xefݹ\xcb\\\xda\xc1\x1c\f\xddO\x8c\xd7*\u007fa\xa2Vٙ\xacW\x8f\x00171\x16\xed\x1e5\x9f\xaa%OE\x99?y\xfd\nM\xd7\x15\xb7\x97\x1e\xeb\xe7.\x9d\xfbB\x18\x86\u007f\xe6\xdc\xfc\x1cW/_\xa2P(d\xf9u\u007f0H\x81D\x83\a\x0f\x1f\xd1l\xb5iu\xba\\8\u007f\x8e?\xfa\x93?\x81\x14\x92\x83\xa3#vvw)\x15\x8b|\xe6\x13\x1f\xa3V\xad\x12\xc71Q\x1c\x13\x04\x01C\xcf\xe5+\xdf\xf8\x1d*\xa5\x12\x95J\x99j\xa5\x82e*\x820\"\x9fˑ\xcf\xe5\xe8\x0f\x06\xc9\xc1\nA\xcev\x88R\xf46\fCz\xfd\x01\x8em\xb1\xbe\xbd\xcd\u05fe\xf9;\xac\xae\xaf\xd3\xeft\x10\xf2\xf7\xcfp\xd2ZS\x1f\x1f\xe7\xe5\x17\x9e\xe7\xcc\xec\f\xa5b\x91r\xb1H\xa9P \xd6\xf0`y\x19˲x\xfe\xd9g\x19\xab\xd7p\x1c\x9b_\xf9\xe2\xaf\xf3hm\x95sg\x17\xb3\xf3\xf0\x83\x80G\xab\xabT\xcbe\x1c\xcb\x024\x9e\xe7\xb3\u007fԠ\xd1\xee\xe0\xfb~\xe4\x05\xfe{a\x18\xbf.\xa5|d\x18r\x138\x04\x86q\x1c\xfb\xc0\x83\x0fX\xc8\xcb\xcf\\\xe0\x8d\xbb\x8f\xd8o$\x1d\xafɱګ9\xa5>\xe1\r\x87l\xef\x1f\x92\xb7mJ\xe5R\x92\xd2Y\x16BJ\f)\xd9\xdaޥ\xd7\xef37;ÿ\xf3\xb9\xcf!\xa5ds{\x9b\xa3F\x03)%\xaf\xbc\xf8<g\xcf\xccg\xe9`\xd2\t\x154\x1a\r\xf6\x8f\x1a<\u007f\xfdY\xea\xd5*\xca0\xd2\xca>\xc1Ҥ\x94\xf4\xfb=\xe2\x18J\xc5b\x8aC%z$\r\x89i*\x82 \xa0V\xae09Vòl\xb6\xf6\xf7\t\x82\xe0\x03M\xa7\x0f\xf1W\x8cOL\xf0ɏ\xbdʵ\xcb\x17\x99\x99\x9a\xa2V\xa9\xe0\xd86B\b\x8a\xc5<\xd7._Ʋl\xbe\xfe\xed\xefp\xfd\x99g\bÐ+\x97/a\x18\x06\x87G\xc9\xfdEQ\xc4\xc6\xf6\x0e9\xd3\"\x9fs\x12\xa5K\xb1\xb3BΡZ.Q)\x15e\xad\\\x9e\xcd;\xce\xc7\xd0\xf1\x1f\xf6\x83\xf0Oj\xad\xff\x8c\xd6\xfa?\x06\xfe\x04\xf0\x8b\x06\xc0+\xcf\\`\xfb\xb0\t\xc0\xf6a\x93\u05ee],MԪ?=Y\xaf\xfe\x03\xad\xf5O\x99\xcaH\xb0\x9d `{w\x17\x1d\x85\x8c\x8f\x8f\xa3\xb5FJA\x14\xc5l\xef\xeeb\xdb6\x9f\xfḑ\xd1hV\x1f\xaf\xe1\xba\tL]\xabV\xf9\xcc'>F\x98v\t\r#\x81-\xa4\x94\x98\xa6\xc9s\u05ee\xa6D\x84\x04\x18\x14R \x85H\xd0\xe3(d0t1\xa4\xa4P($&-\x92ד\xa7\xe4\xa0ѤZ)S\xa9VYz\xf0\x00\xa2\x88\x9e\xeb\x11\xfe\x1eB\x11RR(\x95\xf8C\x9f\xfc\x11^\xba\xf1\x1c\xb5j\rS\x99\x18\x86\x91>%\xb1\xd6x\x9e\xc7\xf4\xf88\x1b\xdb\xdbLM\x8c\x93s\x9c\xac6k\xb4\xda\f\x86I<\xb3,\x8bR\xb1\x88\xe7yY{:\x8e\xe3,\x19\t\x82\x80F\xbb\xc3Q\xab\xcd0\x81\xf07\x81\u007f\x04\xfce\xe0\xbf\x05\xbaI\xc70\xd6\xf9\x1b\x97ν\n\xfcQ\xe0\xa5a\x10}\x12\xc04$*Mg\x01\x1c\xa5\bc\xcdÕU:\xdd\x1e\xcf^\xbb\x9a\xa5\xbe3S\xd3(\xcbdmc\x1d+\xbd)R$\xf8\xd9g\xaed\r\xa4\xac\xebfH\x84\x01e\xa3H\x9cVϦ\xa9\x12\xad\x8ac\xa4\x90DA\x02\xa5\xec\x1f\x1ep\xf5\xd2\xe5$32\f\x84N\xc8\n\xc41\xddn\a\xcbL\x82o\xbbۡ6>\xce\xc1\xde\x1e\x8b\xd3Ӭ\xee\xec\x10\xa4\xbd\x8b'۳\x86aP.\x97x\xe9\xda5^y\xf1yJ\xc5b\x06\xe1\x8f\b\x11\x86ad\xad\xe6F\xab\xc9\xec\xd4\x14\x9b;\xbb\x8c\xd5jDQ\x84m\xdbܸ\xfe,\xdfy\xe3M\x82 \xa0\x90\xcbei|\xb3\xd9\xc4\xf7\xfdL0\xae籼\xb1\xc9\xd0\xf5c!\xf8kB\x88\u007f|{i\xed\xe1S\vC!\xc4\xc5(\x8e\xff\x85\x06ǔ\x12ӐX\xd2@\n\x9e\xa8B\xc0\x94\x02iY\xec\x1f5h\xbd\xf9\x16\xf33\xd3\xccLOqv\xe1\f\xbd~\x1f\x99\xba\xb0\x93\x18\xd8\xe2\xc2|\xd20zZ\x00\x93\x02\xdfM*|\xa5\x14R\xc6\xc4Q\x12c\xe28\xc6\xf3}\x86C\x0f\xa5\x8c\f`\x8cc\x8d\xd0\x1a7\b\b\xa3\x10\xd32\x91\x86\xa47\x18`Z6\xc5J\x85(n\xb205\xc5\xc6\xfe\x01\xdepp\xcaEY\x8e\xc3\xccX\x9d\xb1r\x89\x1b/<\x8f֚\x8d\xad턼 d\xd6\x11\xadU*LN\x8cs\xef\xc1#\x942\xd8\xd9ۣ?\x18r\xf5\xd2\x05\x1c\xcb&\x8c\"&\xc6\xc6x\xee\xdaU\xde|\xe7]L\xa5\xb2\xe6\xda\xd8\xd8\x18\x9dN\x87v\xa7M\x1ckL\xa5X\x98\x9eb}wO\x0e]\xaf\xa0\x94\xb1\xfa\xa1Y\xd6\xd4X\xfd\xc0\x10\xe2ׁ:pޏbSJq\xea`\x9fL\x13\xcd\xf4p\x1a\xed\x16\x1b;\xbbt\xdb-r\x8e\xc3\xca\xca\n\xe3i\x9a\xac\xb5Ʊm>\xfa\xc2\xf3Dч\xa7\x9eC7emh\x8di)\x84\x94H\x99\xb8\xb4\xe5\xd55r9\x87z\xad\x86m\x9b\x19\x98'$\xf4\xfb\x83\x04?3\fL\xa588J(=\xa5R\x99n\xa7\x8d\x12P/\x17\t\xa5$\x8c\"\feR\xaf\xd6X\x9c\x9a g\x99\xcc/,\x10ƚV\xbb\xc3\xd5˗\xd8\xde\xd9ec{\x87\xe1\xd0e0\x18\xd0l\xb5837G\xb5R\xe6\xe1\xca*wn\xbe\xcf\xce\xee.ˏ\x1ea\x9a\x16\xf9|\x12\xe3f\xa7\xa7q=\x97\xad\xed\x1d\xba\x9d.F\x9a\x05Z\x96\x85\x14\x92^\xbf\x8f\xd6\x1a%%\xa5|\x9e\x81\xe7\xbd\xeay\xc1\xf9\xe9\xf1\xfaoN֫\xd1\xc94X%ZO\xfc\x83\xfb+7\x81?\xf5ܥs\xff\xa5\x12\xf2oX\x86\xf1\xd4\x00(\xd0\xe8\xb4\xeb\xa6\x04(\x99h\xc5\xd6\xee>\xdb{\xfb\xe4-\v\xc302\xd8|jb\xe2\x03V\xf6\xa4\xfb\xd0q\x84m\x9b\xc4qL\xe0\x878\x8e\x85֒\xc3F\x93\xb5\x8d\r^}\xe9\xc5\f$\x14\"I\bB/鉇QD\xb1P\xa07\xe8\x13\xa6B\x17R25;\xc7\xfa\xca2\xca0X\x1c\x1f\xc3\x1d\x1bG\xe8\x18\xdbH\x90Y'\x97#W(2V\xaf\U000493fe\x82\xa9\x14s33\xdc\u007f\xf4\x88\xf7\xef\xdcKZM:!\xd7]\xb9x\x81\xdb\xf7\xee\xa5\xed\x00h\xb7Z\xfcگ\u007f1\xb3\xea$\x05\xf7\x93\xf8'\x04\xbb@\xb9Zebj\x9aJ\xa5\xc2\xd0ui\xa7\x94&)%g&'X\xdf\xdb\xff\xf7=?\b\x81\x9f\x06\xc2Q\xd1h\x00L\xd4k\xec\x1d5y\xe1\xf2\xf9?\xa6\xe1\xff\xc8[&\xc6(\x18\xa6\x9d>!GĜ\xa4\u007f-OU\xbd\x12\xdbT(i\xa4P\xc28RJ\x8e\x8e\x1a\x98\x86\xe4\xc6\xf5g\x89\xe2\xe4\xe2\x830\xc8z\x1fRJ\xc2  \x8et\xe6\xea\x84\x14\x98\xa6I\xbb\xd3\xe5[\xdf\xf9.\xf9\\\x8e\xf3\x8b\vY\xd6cH\x03\x91Pd\xb8y\xef\x1e\xa5B\x81b\xa1\x00Z\xb3\u007fxȋi_\xbc\xd7\x1b\xe0\x0e\x87\x84a@\xac5\xad^\x8fJ\xce\xc9p\xa6\xfa\xc4\x04\xc5R\x89^\xbf\xcf\xe3\x8dM\f\xc3\xc0\xb1\x1d\xe6ff\x88\xb5f\xff\xe00e\xd74hw:\xec\x1f\x1e\xb1\xb7\xbbK\x14k\xaa\x952J\x99\x14\x8b\x05fϞe|j\x8aj\xbdN\xa9R\xc1\xc9\x170M\x13w0\xe0\xe8\xe0\x00/\b\xc8\x17\n\xf4\xfa\xbdDI\xd3\x00\x9f\xb7m\x86\x9e\xff|\x18\xc7S\xb3\xe3\xf5\u007fq\xebѪ\xbe~q\x11\xf5\xfc\xe5\xf3\xbc\xf7p\x85\x17\xaf\\\xf8Q?\x8a~1o*NZG\x14E\x14\n\x05|\xdf'J\x9b\xf7\xa3\xceޱ\x96'BBGT+\x95$\xe5\xdd\xdc\xe2\xfe\xfd\a\xbc\xf0\xdcu\xa28\xc6ql\xbe\xf2\x8doqa\xf1\x1cg\xe6gP\xcad{w\x97Z\xa5\x96\xb4zӌ\xc4\x14\xb0\xb9\xbd\xcd\xdb\xef\xddJ\xfb\xd0\x0e\xa5b1\xebY\x8f\xb0\xb3\x83F\x83\xa3F\x93\xe9\x89ILC\x11\x86\x11Q\x183?7\xcb\xd0uy\xbc\xb1I\xbe\x90\xc7\x1d\xc5\x0f\rQ\x96\xc5I\xd4\t\xb6\xe4\xd0uy\xe7\xe6-\x96\xd7\xd6X\\X\xe0\xe2\xe2Y\x1eol\xd0\xef'd\xbaG\xabk\x89\xb2\xa4\xfa95;\x8b4\x12\x802!\xefi\x94\xa3\xc0\xc9A9\xc9\n|\xcfc\xf3\xf1\x1a\xddV\x93~\xbf\x9f\xb2'\x93\xf8\x17\xeb\xa4\xdb8Y\xab\xb2{\xd4\xf8O\xfd0\xec\x01?s{i\r\x99\nc\xc1\x8f\xa2\xbf\x933\xcdr\u07b6\xb2\xa2JH\xc9\xd9ų\xe4\xf2\xb9\xcc\xdcF\x96\x91X\x8d\x81\xa1\x14\xa6\xa9PJa(E\xb9\\bii\x99յ\xc7Đ\xc0\x1e\x02\xdex\xebm\xfe\xc1/\xfcS\x94i`H\x83\xef\xbe\xf1&++k\x98\xa6\x81\xa5\x12\xbcH\x99&\x9dN\x97\xbf\xf5w\xff!\x8dV\x13!\x04\xd5J\x99B>\x8f2\x8c\xd4:\x92\xd8\xd2\xed\xf5pl\x9b\xa1;\xc4vln\u07b9G\xb5RN\xfa3qR\xf5\x9b\x96\x95)\x90a\x88\x13\xbc*\x89\xe3\xe4N\xf5{\x84\x10t\xba=n\u07b9\xcb\xf7~\xf0\x83\x8c\xa8\x97X\xa5̲%\x91\u07b7i\x9a\x99k>fH&\x16\xa0\xb5ƲmƧ\xa6\x12\xd7\x17E\t\x9f,%\xea%\xef\xd3\x18R0Y-#\xa5\xfc\xcb\xcf^\\\xfc\xf3\x00\xf2\x85+\x17JA\x14\xfdu\xcb0\x9e\xa9\x14\xf2ؖM\xa5\\bqq\x91\x8f\xbd\xf6*\x02A\xa3\xd1L\x1a@B&\u0092\x12\xa5LJ\xa5\x02\xf9|\x1eC\x1a\x98\x96I\xa9Xb}c\x83\x83\xc3CL\xcb\xc2P\x06Q\x1c\xf1\xfe\xcd;\xfc\x83_\xf8\xa7\x84aҒ}\xff\xee=\xfe\xe6\xff\xfe\xf7Q\xa6\x99ܤ\x91\x1c\xde\xf6\xf6\x0e\xff\xcd\xff\xf8\xd7\xd8\xda٥\xd7K\xd8\u007f\xe3\xf5z\"\x8c\xb4.\x10\xe9\x81\xee\xee\xed\xf3\xf9?\xf4\xa3ԪU\xbe\xf6\xfaw\x18\f\a\x8c\xd5j\t\x90\xd9\xef\xc3\t\x81\x8cz\xe7\xa3z\xe0I\v\u007f\xf2\xb1wpD\xb7\xd7{\"֥\r\xa9\xdf\xe7Ȁ֚j\xadN\xb5>FLj\x19\xa3\x96t\x94\xd6'Q\x92\xde\xd7K\x05%\x05?\u007f\xfd\xe2\xe2\r\x15F\xf1\xcf\x15\xf2\xf9?yqa\x9eB!O>W\xa0T*\xa2\x94beu\x95\xf5\x8dM\fC\xa5\x94IM!_\xa0T*Q.\x970\f\x83\xf5\xf5\x8dDX$\xad\\?\xf01\xd2\xf4\xaf\x90\xcb\xf3;\xdf\xf9\x1e\x1b[[\x18\x86\xa2P(\xf0\x1b_\xfe\n\xef\xbd\u007f+\xe5.\x1d\x1f\xcc7^\xff6\xbf\xf8\xcf\u007f\x99\xc1\xd0%P\x9a\xed\xbd=\xe6fg\xb8r\xf1\x02q\x1c\xb3\xb4\xbc\xca\xd5+\x97\xd1h\xb6vv\x01\xc1\xfc\xec\f{\xfb\a\x04\xbe\x9f\xb8\x8f\xb4\xca\xdf?<̊\xc6Q\x1c\x94R\x10\xc5\x1ae\x1c\xa3\xd6#\xb7\xf5\x01f\xe2S\x845\xb2\"\xad\xe3SؕmY\xf8)\xab\xfe\xc9G\x14ELLM\xd1\x1d\f\x18\x0e\x12שS\x97\x950v\x12\xc1X\x86AѶs]\xd7\xfbgʶ\xcd?\xf7\xf1\x97_$\x9f˝\xe2\xa3\xee\xedﳶ\xfa\x18R\x84wl\xacN\xadVMR9\x99\xa4\xbc[[\xdb\xf8~\x90\x14Pi|\x91\xa9pF\xc1\xf9\xe0\xe8\b\xc7qБ\xc6ʛ\xbc\xf7\xfem@`*\x93R\xa9D\x18E\xfc\x9d\xbf\xff\x8f\xb8u\xf7\x1e\xfd\x81ˎ>\u008d}\xec=ş\x9c\x9f\xa7\xdb\xeb\xf1\xfa\xf7ޤ\xd7\xefq\xe3#\xcf\x12E\x11\x0f\x97\x96)\x97\x8biZ\xaey\xe6\xe2E\x9a\xed\x16\x8fVVi\xb4[t\xba\xbd\x8c\xee3\xb2\b\x99R\x81F\a5\x18\xf4qr\xb9\xa7\x1e䇧\x83\xfa\x14\x8bB\bA\xb1\x90O\xc8\xd9A\xf0!\xb4S\xc9ٳ\v,=Z\xc6\xf3\xbdc\xd76\xaa\xe2S\x01\xe7m\v/\f\xaf\xa9O\xbd\xfa\xaa_\xc8笓\x845\xd7uY[{\xcc\xcc\xec\f\xb333\xe4\xf3\xb9\f\x02\xd0i\x95\x1cE\x11GG\x8d\xac\xde\x10'4R\x99\nC\x19)\x19-i嚎\x89m[\xb4Z\xed\x94ɒ\xe3ݛ\xb7\xf8\xeb\xff\xdb\xdf\xc9\x0e\xaa\x19\xf7\xe8\xea\x01Z\xc00L\x18\xe4\xbf\xf6[_Fd\xc5Z\xc0p\xe8\xd2j\xb7\x99\x1c\x1fO*nip\xf1\xfc\"\x8f76y\xef\xf6]\x8e\x9a\xcdL\x9bG\xa0_\"\x848\x8b\vZk\xfa\xdd.\x95j\xed\x04\xa7\xea\x87py\r\x83ݭM\xa2\x94*|2K\x94RR\xabV\xd8?<z\xaapu\xcazYXX`iy\x898:\x86TF\xf1f\xf4sɲ<Y\xc8\xe7\xe2'?H)\xc5K/\xbe\xc0\x95K\x17ɥ\xc2\x18]@\x9cRq667\x9f\xa0\xd9'ͦ|!\x8f\xed\xd8\xd9\xc5J))\x15\x8b,\xcc\xcf\x11\x06!\x86a\xa4\x8c\x15\x8b\xaf}\xf3\xf5$\xfe(\x13\x10\fp3\xb2Y \"~\xf57\xbe\x84\x94F\xa6\xe5\xcdv\x9b͝\x1d\xfa\xc3!9\xc7N\xde\x17\x86\x1c\xb5Zl\xed\xec&1愻\xf1\\7\xb9q\xc0\v#\xc4\t\xcd\xeeu:\f\xfb\xfd\x0fA\x85\xd3t^J\xb4\x8e9\xda\xdfb+ q\x00\x00 \x00IDAT\xa3\xddl\xa2\xa4A\xb5R\xc1\xcc\\\x9d\xce\\\xdcX\xbd\x86a|\xb8p\xf3\xf9\x1c\xd5j\xf5t`?!\x8cT\x06Z=M\xaa#6E<\n~B\xe0z\x1e\xbe\xe7\xe1z>\x81\xef\xd3iw\x13\xe9+\x83z\xbd\x8eR\x8aXk\xc20H\xb51I\x11\xc7\xea\x93T+\x15\x06\x83\x01A\x14&\xc0\xa2\x94(ed),@\xd3\xef1\xd4^V\xeb\xf8\"$\x8a5G\x8d\x06\xe3cc\x18\x86\xc1\xf7\xdf~\x97\x81;D\x00\x87\xcd\x16\xfb\aG4\xdbm\u07bfs\xf7\xd4H\xc1\xe8\xfa\xfb\xdd\xeeHM\t\xe2\x10\x8du\xea\x1ew67\x98\x88\xc2\xc4R\xd2Ì\u00880\xf0\xf0\\\x0f\xcfs\xe9w\xbb\f\a\xc3\xe45\x1dS)\x96\x90iv%D\xa2\x00\x9a\x84\xb1Y\xafVi\xb6\xdbټʓg:3=\xcd\xe1\xc1\xc1i\xebx\xc2Jԇ\"\xa1@\x18\xc7x\xae\x9b\xcc\xfee)\"tz=\xa44\x98\x9f\x9f\xa3Z\xad$<\xa5\xf4\xc3\xc30\xa4\xd9j\xa1\x94J\xc6\xccҾ@\u007f\x98t\x13\x91\x92Z\xa5\x92\xa0\xb4\x87\x87H!\x89\xa3\x98N\xd8\xcf\xfa\xf7\x02\x81+<\x86A\x88\xef\xfb\xd9\r\x1d6\x1a\x99\xa5\x1e5\x1a|\xe9\xab_\xcb \x99cH\xde\xc0\x1d\x0e\xd8z\xfc\x18?\x05\x16c\xad\xe9\n\x9f\xbc\xb0?\x10t\xf7\xb6\xb6\xd8\xdf\xdeN\xe2b\x9a\xa2\x9elY\x9f<\xd0a\x1021>\x96u\b\xa5\x94\x99\x85\x85a\x88R\x8aZ\xa5\xc2a\xa3\xf9\x01\xf7\xa5\xb5\xc6q\x1c\xc6\xc7\xc7\xd9\xde\xdeN\xe9\xad\xc7,\xfeQ\xc1(O\xa0\"\xa7\xbe\xbc\xef\x0e\xe9\xf7\xfb\x84i6\"\r\x89\x14\t\\>=9ɋ/<\xc7\xd4\xe4\x04J\xa9\x14R\x01\xcf\xf7\xe9t{\x14\x8bE\x16\x17\xe63ֺ\xd6dl\xc1J\xa5\xc2\xfc\xdc\x1cA\x10d.-\x8cc\xdaa\xff4\xbe\x15z\b[\xd0\xf1\x06t{\xfd\fu=\xf9\b\x820\xbb\xf18\x8e\x19\x0e\xfa\xac-=b\xf9\xfe\xfdL\x18B\b\x84e\xb3;8\xa2\xd1\xeb\xf1\xa4C\x18if\x18\x86D)\xe1\xefd<\xcd\x14$\b\xb0\xf3\x05\xaa\xe52B\x1c\xbbc\x91\xa2\xbb_\xfe\xea\xd72\xce\xdax\xbd\xfe\xd4\xd8\x14E\x11\v\v\v\x8902ˈ\xb3\xfeP²L\xabr!8\x05\x00\x1aB\"M3#Kk\xad\xd1B'st\x96\x99\x8c\x94\xa5\xa7\x1d\x86!a\x10\xa0\xa4\xc1\xd4\xc48\x8e㤮H\x9f8\xbc\x80b\xa1\xc0\x99\x99\x19\xa20DH\x91\xa1\xb7\xfd\xd0e\x18{\xa7g1\xa4\xc1\xd6`\x9f\xd7\xe6nЏ\x06\xb4;\x1d\xc2aD\xa5XJg\xf9\x14\xae;\xc4s]\\\xd7e\xd0\xeb%1aD\x9c\x00\xa2Xc:9\x8e\xfa]\xdc\xc8e}x\x80\xa3,\n\xa6\x8d\xa3L\f\x91\x1ch\x82$'\xb4O/\b\b\x83(I4\x84\xc0\xca\xdb4:-\xdc0\xe6\xe3ϝ#\fC\x9c\x9c\x93\xc4̔4\x17\x93\xa0\x00_\xff\xe6\xb7\xf8\xd1O\u007f\n\xd34\x19\x1f\xab\xd3\xe9t\xb3х\x93Y\xd7\xc2\xc2\x19\x96\x96\x96\x91\"\xc1Ւ\xea=\x11\x8e\x92)~4\xf2e\xa6i\x12\x04\x01fZ\xb4\x8d$\x1b\xa60\x81\x95\x92\xa7\x85\x10\xa9F\xc5\x14\n\x05,\xd3L(\xa5q\x9cj\xb3L\x99\x1b\x8a^\xbf\x8fe\xd9\xccLM\x1d\x8b(\xad\xfcc\xad\xe9\xb8}B\x91\b\xa9T-\x11\f\x03\x86\xfd!C\\n?x\xc4_\xf8/\xfe\x14\x8f7v\x19\xf6=\x96\xefo\xf0\xd6\xf7or~\xf6\f\x962\xe8\xb6[\x04\xbe\u007f|MQH\xa85M\xafO\xcb\xef1ħ\x1f$\r$\x1f\x9f\xfb\xddu@bJ\x03\xdb0\x11\b\x1ci\xa3\xa4$\x8a53\xe5q>\xfd\x93/\x13\x86\x11N\xce\xe6\xee\x9d\x15^_\xbbɄ]\xa5\xdb\xedP\xab\xd71R\xf7\x96%\x04Z351\xc9\xcd۷\xf9\xed\xaf}\x9d\xcf}\xf6\xc7pl\x87j\xa5\xcc\xc0uSF\xe31\xb9cbb\x82\xf5\xc7\x1b\xf8\xbe\u007f\x1c?t<\x9a[I\u0088\xeb'\x017\x81\x03b\xc0\xc8\x1a5a\x98\x98\x98mۧH`\xc5b1\xe5 %V\xe4\xfb>\x86!\xb1,;\x13\x9c\x94\x82N\xa7\xcb\xd4\xc48J\x1d\u007f\xa6\x90\x12i\x18\xc4A@\xcf\x1b \f\x81T\x92\xb1\xc91\xbc\xa1\x8b\xbf\x9e4wn\xaf-\xa1\x84\xc1\xe7?\xffq\xbe\xfc[\xdfe\xe3`\x87\xdb\xed\x15\xeet\x96)\x1a\x05\f!\xd1餭\xd0\xe0\xeb\x007NH\x13O\x16x\x02AD\f\xc4Dq\x88\x9bYe\xf7\xb8\x1aw\xe1G?\xfd\x12G\x9d\x1e++[\xfc\xe0\xdd[x2@X\x92(\n\xf1\\\x97r\xa9\x94u<u\xaa\xb0s\xb33\xbc\u007f\xf3&\x87\x87\x87|\xe1\xff\xfce\xfe\xc8O\xfe\x04\x93\x93\x93\xe4\x1d\aǲh\xb4ZY\xb0W\x86brj\x92\x8d\xf5\x8dD\x18\xe8lV\xc6\xf8\x89O}\xfcg\x83 Ta\x18\xe08\xb9\xb4\x0fa \r\x89!\x13S6M\x85\xe3$ӹ\x96eR\xc8\x17\xc8\xe7s)\x9es\xecO-3\x19\rV\xa6\xca\xd0[%\r\xf4\x13siq\xac\xe9\x0f\x06h\r\x8dN\a\xa5\x14\xad\xa0C\xb1V\xa2P\xc8c9\x16\xe3g\xc6\x10R\x10\xea\x88\xe5\a\x9b\xfc\xe1\xcf~\x92\xaf}\xed\r\xbe\xfe\xbd\xef\x11\x191\x12\x81\xaf}\xbc\xd8ǋ\xbd\xf4o\x9f\x900)LO\x02\x1c\xa7\xc76>t\xaaS \xe8\xb8}\fO\xf1\xca\xcb\xcf\xf2\xf3?\xf7\u007f\U00068e4ea\x18LT\xeb\xcc\xe4j\x98\x96E\xadV\xcbx^#\xa1\xe7\xf29\x96\x96W\xb2Xw\xff\xe1Ä\xb9X\xabcYf\xe2ƣ8m\xd4\t\xc6\xc6ƈ\xe38\x994>\xae\xef\"\xe3\xc7\u007f\xe4c?;\x1c\x0e\x95RF\x8aK%V\xa2R(B)\x85\x95\xb2\xf7,+\x99\xc9Vf\xc2䓆\x91\xbc?\xfd\x1d\xd343A\x18\xa9\x90\fe\xe0\xb9^F:\xd0\xe98\x99i\x9a\f\x86C,\xd3d~j\x86\xd5\xd6\x16\x95\x89j\x02\xfc\x95m\xac\xbcEy\xbcLe\xaa\xc2~\xaf\xc97\xbf\xf6&\xefܻ\xcf\xd0\xf0\xb0,\x13a\xa4\xb5\x82\x16\xa7f=N\x16\u007f\xa3\xe7\x87\xe1VZ\xe8ӂK\xe3׃\xd5U\xde|\xf36\xf7vV\x10FR\bN\x8fM0n\x16qr9\xaa\xa9@NZ\xa0\x14\x82N\xa7\xc3\xe1\xd1Q\xc6\x0f\xdb\xdc\xda\xe1\xf0\xf0\x80\x89\xf1\tʥ\x12fz\xdfQ\x18\x11\x86\t/\xc1\xb6-vww\x938\xabѪ\xdf\x1f$Ub\xb1\x98P\xfc\x9f\x80\x06\x8es{\xc9\a\xeeK\b\xc4\xc9\xe1\x17!\x8e5/\x1d\t1\f\x83 \f\xb3ڠX,R\xafWY^^E\"\xb9\xb0\xb8H\x10\x84L\x17ƈ\xa4\xc4̛(\xc7\xc4\xed\xb9\x1cl\x1e2\xe8\xf4\t\xfc\x90\xbdp\xf78[\x11Qr\xf4B \x94@\x92\f\x06\xb9\x037\x03\xec\n\xe5\x02\xf9r>I\x97\xb7\x0f\x93\x11\xe9\xa7\"\"ǩ\xf6HH\xbd\xc8cig\v\x85\x95L^\x11$0\x8d \xab!\f\xcbʚX#\xa1\x9f\x99\x9fgiy%\xb37\xa5\x04{\xfb\a|鷾\xccK/\xbd\xc0\xb5g\x9eIz\xee5\x93\xde`@\xbf?`zz\x1a!\x04\xef\u07fc\t\x9aX\xc5i\xe3\xa8P\xc8g=\xe1\xe3\xc3\x1da7\xa3\xa1\xca8\x03\xe5\xc4\t_\x90\xd0`〉/NiN\x02{\x18R21>N\xa5T\xc2\xf5<\x06\xc3!\xb33SH)\xc9\xe7\x1cΗ\xe6yo\xf8\x88\xd2d\x89\xd6^\x93\x8d\xfb[ɍ\x02\x96)A\x89t\xb6\x03\xa2(\x9d\xa5=\x01N\x96\xcb%f/O\x13\xf8\x11\"\x86b\xb5\x90)J\xbe\x9cc\xfd\xfe\xc6\a\x84\"ҡ\xd0Qj\x9eW\x16\xf3\xa51^\xba8\xc5\xf5+\x15\x1c\x13\xde\xfe\xfe\x1a\xff\xf7\xdd\x1dlӄ8\xf9>\xcf\xf3Oa\u007f\xa3!\xcf\xe9\xa9)*\xe5\x12\xdd^\xffDV\x05a\x14\xf2\xbb\xdf}\x83\xbd\xbd=>\xfa\xf2+ضE\xb9X$JgX\xa6\xa6\xa6\x98\x9b\x9bcec+\xe9\xbfڶ\x9du\xe48qԣ\xb9\x8b(\x8cR\x89\xf6)\x16\n\xe4s\xf9\xe3i%!q=\x17\xd7\xf5\xa8U+\xe8Ѭ~zX\xfd\xfe\x80B>\xc7\xe4\xf8\x04\x8ec#\x10\xec\x1f\x1e\xe08N\xd6O\x88\xb5f~r\x86\xb7\x1f\xdc#&\xc20\x15\xf5\xe9\x1a:\xd6\xf8\xfd\x01\xffя_\xe6ڕYv\xd6\xf7\xd9^o\xb2\xb6\xddf\xb9\xe1\xf2\xf0p\x80!c\x84L\x845\xe8\x0e)֊\xe4\n9\x94e$\xb8Q\xac)\x8d\x15\x19\x9b\x1d\xe3p\xf3\xf0T\\\x19Y\xc7d\xae\xc8G/\xcc\xf0c\x9fy\x86\xcf|\xfe\n\x13g'\b\x87.Ko>\xe2\x8b_\xbeKH\x8c\xa5,\x88\x13\x05\x1b\xba.\xe3F\xca\xe4\xd4\tu\x14\xa0V\xab2>>\x9e\xf4\xd0O\x9dd2ɻ\xbc\xb2\xc6\xee\xee>ן\xbd\xc6\xe2\xd9\x05\xc6j5\x8e\x9aM\\\xd7\xe3\x99+\x97\xd9k4\x95\U000693fe\xf4\xb3\x95rI\x95\x8a\xa5\x13\xf1 )\x00\x11\x82ã#\x8e\x9a-\xba\xdd.\b\xc1\xdc\xccL\x96\x8d)C\x11\x86\x01\xeb\x9b[\xccLM&A>\xfd\xfdQ\\\xd1$Î\xb6\x9d\xf4\xda\a\xc3!;{\xfb\xd9~\x93\x11r\\\xc8\xe5X\xdbؤ+\aL\xccNd\xbbB\x84R\x1c\xed\xb6\xf9\xcf\xfe\u070f\xf0\xea\xe7_\xe3\x95W\xce\xf3\xf1\xe7\xa6ye\xb1B)\x18ro\u007fH\x18i\x02\xcfO\\\xa42\x12\x16K\x18S\xac\x16\xb0\n\x16\xcaV\xe8@\xd3k\xf6\x88\xd3ZK\x8bD\x1cuU\xe2\xa7_\x9d\xe5?\xfc\x13\x17y\xe5\xf37 \xf4h\xbew\x87\xd7\u007f\xe5]\xfe\xbb\xbf\xfb\x16?\xd8o \x8d\x98\xf3\xd3\v8Qr.\xb6\xe3 \x84\xa4\x90/\xa4V*\xb3\xfa\xc7vl\x1e<\\\xca\x1ay#\x9e\x99H\xf7\xa3DQ\xcc\xce\xee.[;\xbbTSVK2\x9fb\xd0\x1f\x0e\x85\xf1\xe9W_\xfeى\x89q\x95\xb3\x1d\xa4\x91\xd4\x0eQ\x1cspx\xc4\xd6\xce\x0eC\xd7%\x8a\xa2D\x8bg\xa6)\x16\nYV\x15\xeb\x98\xf5\xcd-\xb4NZ\xa7\x02\x91T\xf42\xc9В\xbaFa*\x95&\x00\x06\x8fVVNu\xea\xfa\xfd~\n\xe9\vz\xcd>k\xfe.S\xf3\xe3(\xd3`\xd0\x1e\xa0\x94\xc1\xc1 \xe4\xab_z\x9fO\xceI\n\vs\x14\xe6f\x18?7\xc73c\n\xdd\xeark\xa7\x9fnQ\x00˱\x90*)\U0009cf0dU0ɗ\x1c\xa20&t#\xfa\xbdc\x88FI\x89\xb0c\x06\xb1í\xf7\x9a\xbc\xfb\xf5\xdb\xfc\xee\xd7\x1e\xf2K_\xde\xe6\x97\xde\xd8`}\xd8D\x9a\xa0\x84\xc1\xd53\x17\x90n\x88\xe58\xe4\xf3\xc9\xfc\xc8x\xad\x96\xf1\rF\xeez||\x9c\x87KK\f]7\t\xfa\xa9\xd7\x19\tN\xa6U~\x14\x84,\xad\xacP\xabV\x98\x9a\x9cd\xe8\x0e\x19\f\x87\xb12\x95\xa2Z)\xa3ㄡ\xb7\xb5\xbf\xcf\xe6\xd66\x85\xf4\xe0G\x19K!\x9fczj\x920ݰ\xe3\a\x01˫k\f\x06\x03\xce\xcc\xcfa*E,\xe3\xe3\x00)4a\x14\xe2XN\xa6%\x8f76\xb3\\\\\bA\xb7ߧ\xd1lQ,&d\xb9\xa9\xb1q\xd4\xee\x12Zj\f\xc7 _\xce\xd1o\r(U\xf2l7\xbb\xfc\x91\x9f\xfd\n7&\xbf\xc5\xe5\xf9\neC\x13\vE\xbb'\xb0\xa5\"&@\xc7:A\x1b\xd2 \xab\xb5&\xf2cTER\x1a+\xb0\xa7ĩ4XK\b\r\xc9ۇ{\xc4\x11\xe8P&3\xbc2F\x15$9\xe9$s\xf8:![\x872\xc42\x13K\x0fÐF\xbb\xc5X\xadv*\x8b\x96\b^z\xe1y~\xfb\xab_ð\xac\xacnK\x84\x91\xb6\x05\xe2\b\f\x85\xa5L^\xff\xf6\xef\xf2ҋ/0?7K\xadR\x89\xd4\xd4\xe4\x04q\x14\xb1\xbd\xbbG\xab\xdd\xe1\xbd[\xb7\xb8t\xfe\xc2),&\x8e\xe3d\xf5D:%4\x1c\x0eY^]\xc5u]\x84\x10L\x8e\x8f\xa7\xfdv\x99\x05\xd2(\x8c\xb0,\v\xa5\x92|\xbd\xd3\xe9rx\xd4Ȇ{FpJt\xa2\x037V\xaf!W\x93~L\xa9X$W\xc9\xe1\x0f\x03B/\xa4R-1\xb4Ln\x0e}~p\xa7\x95\xc0\x1ba\x8c\x90`ے\x9c\xcca\x98\x06\xcaT\xa9\x8b\x00\xa1\x04:҄~\x8cS\xb6\xd3BN'\x80\xa6\x8eQ\x96BY\nS\x8aӃIY\x1f*ijUseL\xa9\bt\x8c4\f&\xc7\xc7iu\xda\x1c\x1e5\xc8\xe7r\x14\xf2\xf9S\vi\xae\\\xbe\xcc7\xbe\xf5;\b\x91\xc0\xf7\xa3\xc8l\xa8\x11\x9b3 &\xc40,l\xc7歷\xdfƲ,\xaa\xe5r\xa4\x82 \xe0\xee\xfd\x87 \x04\xdf{\xf3M\xa2XS(\xe4\xb3\xe6\x8e֚b\xa1@\xa5\\F\xa4\xfcޥ\x955\x06\x83a\x96o'\x01Z\x9e\xaa\xb9\xe2X'x\x91\x94\x84a\xc4\xd2\xeaZ\u0096w\xec\xacH3\xa4\xe4\x9d\xe5;Xy\x93\xf9\xb1d\t\x8c\x19\x19\f;CjcUL[a\xe6L\xa2 \xe9E;9\x1b'g\x1f\xef-\xc9\xd4/%#\x98\x06V\xde\xc4\xcaY\x18Ja\x88\xe4\x00\xe2(\xc6.\x98\x90\xd6\x1d\x1a\x9d\x10\xb5\x1d\x13\xd3:\xa6\xbd\x8eZ\x01at\fZ\n\x92\x11\xb6\xa2\x93c\x90\xba\xe0b!\x9f\x909VV\xd9=8\xe0\xc2ٳ\xa7\x14ض,\xae_\xbd\xc6\xed\xbb\xf7\x902Au9QB\x98\xa6\x99ҟ\",\xcbF\x03o\xbe\xf5}~\xfc\xb3\x9fE\xee\xec\xed\x13k\xcd\xcd[\xb7i\xb6Z\x94\x8a\x85Sik\xacu\x82\xea\x1a\x06\xa0Yy\xbc\x9e\r\xe6$\x90\xb2\x8d\xa9\x8c\xac\x10\x94)3ĶLL#鑼\xf5\xee{\x19\xcf\xf5\xa4\"\xe6\x1c\x87=\xbf\xc1\xfb\xab\xf7\xf1\xfd\x00\xd0Ԝ2\xfd\xde\x00i@y\xbc@\xb1\x96G\xd9\xea\x83\xfdm\x99\xc4+C\x19\x98\x96\xc2r,\x9c\x82M\xbe\x92\xc7rL\x9c\xbcM\x14\xc5\xd9\xfa\x0fe\x19\x98\x8e\xc9h\xd4_\x18\x12\xd3NHn#r\xb5i\x9ai!|L\x8e\xd0@\xb5XF\xc6\x02-\x04\xb6i&\x96o\x18,.\x9c\xc1\xf3|6\xb6\xb6\xd3k\"\x8d%\x9a\x1b7\xaeg(F\xa2\xd8\xc7`\xab4\f\fC\x11\x85!ZǘJ\xd1\xeb\xf5\xb9}\xfb\x0eR\b\xc1\xd6\xce\x0e\xbb\xfb\xfb\x19\x11\xe1$\xf4\xac\f\x83Z%Ig\xef\xdc\u007f\xc8AJ ;\x9e\x0fL\xe8\x92\xc7\xcf\x04\xa95\x95\x89빼\xf5\xce{\xc9̸\xe7}\x80ߛ\xcb\xe5\x10\xc0f\u007f\x8f\xa5\xed\xc7h o\xe5跇\xf8~@\xbe\x9ec\xf2\\\x9d\xb13U\n\xb5\x1c\xa6\xa3\x90Jb\x98\x06\x86e`\xe6Lre\x9b\xd2X\x91\xdat\x89\xcaT\x19;\x97\x04u\x04\x89\x16\xa6t\x1b\xc30\xa8\xcdT\xb1d\x9a\xdd)\x91N\xf9&\x99\x9eJQ\x86c2\x9e\xc8P\xf0z\xa9\x92\xd50\xa5R)c\xef\xe7s9榦\xe8t\xbb\xec\xec\xed%\xbfG\x92Y\xd5*U\xe6\xe6\xe7\xb2\xc6W2\x86q\x02\xf5U\x06R\x1a\x04\xbe\x8fa(\f\xc3`eu\x15\x15\x84!++\xabY\xf7.\x9f˟\x12\xc8h\xb3ϭ\xbb\xf7x\xf7\xe6M._\xbcx\xaaW\xfda\xdc\xdfV\xbb\xc5;7oe\xc1\xac\xdb\xed\x9eh}&ʢR<(\x10\x11w\x0e\x96875\x9f,\"\xf0\x93\xb8a\bA\xae^\xc0rL\xc6\xcf\xd4p{>\x81\xeb\x13EIQh\xe5L,\xdb\xc40\x15q\x14\xe3{\x01\xfd\xe609<\x03\x86}7\x8d\x13&\xa6\xa5(N\x140M\xc5\xd0w\xb3\xcch\x94\xaeZ\xa6I\x98&+\xb1\x8e\xb3,R\"\xc9[9L\x91\\k\xa9\x9c\x00\x8bZ&\x88F\xadZf\xff\xf0\x90\xa3F\x93B>\xc7X\xad\x96m\x9e\x98\x9f\x9b\xa5\xd1hf}\x9b\x11\xb7-\xe3 (\x03\xa2\x88(<\x1e\x9bP\x8f\x96\x96\x88t\x8c2\fB\x91\xac\x808)\x10iH\x96V\xd7x㭷\x18\x1b\x1b\xfb=Y\x1a\t\x13p\xc8\xdf\xfe\xfb\xff\x90\xe7\x9e}\x96R)\x19\xeei\xb5\xdb\xd4*\xd5S\x90\x852U2v\x10\x87\xb4\xcd>wW\x97pL\x8bx\xa0q\xfb\x1e\bA\xb1\xe4\x90/:\x04^\x80[Jz\x15\xc9\x1eC\x8d2\x12\x10T\xa6|\xdd\xe6n\x970\x880\x94A\xe0\axC\x8f\xeaD\x99\xfaD\x91\xdaD\x898\x8c\x88m\xc0\x838\x88St\xc1\xc8b\xdfȽ$\xe4\xba\xe4\x8f!$%\xa7@\xd8\x0f\x91Bd\x13\xb6\xa4<\xadQ\x83\x0e`w\xff\x90R\xa1\x98`mRR\xb0m,e\xe0\xa7\xdb/\xa2(>^\x9a3\xe2#H\x89\xa1\xe3c\x9c\xaf\xd5j'\x90\x89N\x17\xe1=A\xb2\xee\xf7\al\xb7w\xd8\xda\xde\xe1¹s?T RJڝ\x0e\xff\xd3\xff\xf27\x935K\xbeO9e\u007f\xec\xed\x1fPKSđ\r[i\xea\x17\xe8\x908\xd2<\xeao0-\xea \xc0\x1f\xfa\xe9~+pr&\xd5Z\x01)!\x0ec\x86n\x807\f\x8e\xb5ΐxn\xd2\xcb7\x94A\x18\x84\x1c\xee\x1ca\xda\n\xe5\x98\x18\xa6$W0)\xd7r\xe4j9\x06\x9d\x01\xa1\x1f\xe2\r<욓\x1d\xa88A\xda\xc8\x02\xb0a\x92\xb7\x1c\x8e\xb6\xf7\xc8\xe7\v\xe4\xd2)\xdbS\xf1,\xfd\xb7\xeb\xbal\xef\xed\xb18?\x8fi&\xbd#\x03\xb0,\xf3ԬȓCCD\xa3\xc1'\x8dTJaH##\x9a)u:\x80\x0e\x86\x03\x1e<|\x84\xedX\xe4\x9e\xe01=ya\x9e\xe7\xf1O\xfe\xf9/C\nᏲ\x96\xc1`@\xa3\xd985\x80\xa9\xd3߱M\x8b8%Ete\x9fv/\xe9M\x84AD\xe0\a\x84~\u008cwr&\xb3\x93\x06\x17\x16\x15\xe7\xcf\x16\x98\x99\xad06Q\xa6Z+\x02\xd0븄^H\x1ckڇmܾKe\xac\x82a$\xcb\xd1\xce͛\\\xbe\\\xe5\xc2\vg1L\x03\x89d\xd0\x1e\xe0\x0f\xbdc\xcbH\xa9\xb2'\xad8o;\xa8H0\xec\xf7\xc9\x15\n\x1c/\xf6:^\xb4vr=\xd3\xc1\xe1\x11\x8dv;aӘ&\xf9\x9c\x83N\xd96O\xb6\x86\xb3\xb3K\xdb\xe3\xa9G22\x18Đ\xc9^ٓ}\xea\xfb\x0f\x1e\x11FQ\x1a\xbcOý~\x10\xb0\x94V\xdeZk\xde\xf8\xc1\xdb\xec\xee\xede\xc1\xd2\x0f\x02\x94R,\xad\xacbH\xf5\x81mm1P-\x94\x92\xad\x0ei\xee\xdf\u0379H$^\xdf'\bb\xa20\xf1\xe7\x05\aƪ>\xc5\\@\xa5\x18\x92/\x98X\x96´\f\xbca\x887\b\xf0݈n\xb3K\xeb\xa8\xc3\xe2\x8d3\x14k\x05\xc2 \xa6XRT\x8a>\xe35x\xfe\xd5\v\x98\x05\x1b\xc32@C\xfb\xa8}jlZ\xa4ą\xd1z\xc0j\xbe\fA\xd2w\xcf\xe7\xf3?\xd4CLN\x8c\xb10?\xcb\xfa\xe6\x16\x9e\xe7S*%\f\x95\x84ԗ\x18b\xf8ԩ\xe0c\x84]\x8e\x1aQ\xa3\xde\xc5\xc8\\c\x1d\xf3\xde\xfb736\x85\xe38\xa7\x98\x10~\x10\xf0\xfe\xcd\xdblln$4\x9ev\x9b\u05ff\xfb݄4\x9dbYA\x10\xd0\xeb\xf5\xd9?8\xc44\xd5\a\xfa\x12q\x141Q\x1b\xcb\xd2a\x81\xc05=\f!\xf1݀Ћ\b\xbc0\x05\xf5\x04:\x16\xa7\xdbIR\x12\x86)\xa5&\x8c\x88\x82\x90^\xb3ǥ\x97\xcf1v&q}\xd10\xc4\xf5\"b\x9d\x8cM4\xf7\xba\xe4\xf3\x0eV\xd1B\x9a\tp:\xec\rO]\x9b!e\x96UM\xe4kD^2\x92=\xe2\x88=\x193-\xcb¶mΟ=\xc3\xe4\xf88\xe8\xa4<0Ӿ\x91\x92\x92\x82\x93C\x19F\xba\xdeV\u007f\x80\x149*\a\x94R\xeax\xd7m\x94XE\x18E<z\xb4\x94\xe4\xe0\x95\n\xcaL\x96?\xf6\xfb\x03\xc2(\xa4\xddj\xf3xc\x03\x8dƲ\x12\xd8\xfe\x8b\xbf\xf9\xa5t\x80%Ѽ\xa4g\x01\x9b\xdb[X\xa6J\xa9\xa62#\xe2%<\xe0\x80Z\xb1\x9c\xf0[-\x8b0\x0eF{\x8a\x13\u0081\x1b0\x1c\xf8\xf8\x9e\x8f\xeb\xd9\x1c\xb6-l3\xa67P\x04!\x84~\x80;\xf0ѱδZ\v\xf0\xfa>\x9bw\xb7\xe9\xec\xf7\xb0\x1d\x9b\xbboDH\xcf%\x18\xc6|\xe3Wo2\x18\x0e\xd0a\x12o\xe20&J\xe1\x1c}\x8a\x93\x96\xac\x03<[\x9f\xa5\xd7\xecb\xdaN\xfaZz\x81'\xf6\r\x1f\xed\xefa\xe7\xf3\xd9F\xbd|>\x97\ue2b40\xd3a\x1eǶ\b\xc2\x10\xadI7]\x98\x1f\x8c%\x80\x92\x86L\xf7B&\x96\xd1\xeet8<j\x10\x84!\xb6m3;;\x83\xe7y4\x9b-\x1e.=\xa2\x90O\xe6\xd4\ri \xa4 \nc\xbe\xfa\xcd\xdfI\xe6ʝd5\x9eNO\xd5\xf3}Z\xed6\xa6e\"\x820\xe9X\x01\xb5j\x85^\u007f@\xe0\aT\x8be\x88u\x12K\x82\xa4ߢ!Ɉ\xe2\x18w\xe8\xd3\xeb\xb8\x14\x8a\x0e1f\xe2^4\xf8\x81K\xeb\xa8O\xe0G\t\x94\x1ek\fi`\xe7lV\xdf{L\xe8GDn\x844%N\xd9a\xe9\xddU\xa20\"\x18\xfaD~\x840\xd2y\x13C\"\xedcv\xa4Jq\xaa8\x8a)\x9ay\xceԦ\xb9\xb3q\x8br\xb5\x96\xee\xe1\n?@#\xb2-\x93\xe5\x87\x0f\xb9r\xf1\x02\xc5b\x91j\xa5B\xb7ק\xd5\xee069E\xaf\xdbM\x19\x9a\n!\xa0\x9f\xceT\x9ed:\x8e\xe2\x90R'z\x12\x1a\xcd\xce\xde^\x86\xda\xce\xceLS*\x16(\xe4\xf3T+\xe5\x8cLm\x18\x06\xf7\xee?\xa0\xdb\xeb\x13\x84!\xadn\x87kW.\xb1\xb1\xb5\xc3\xe6\xe6\x16H\x90h\xda\xedNrP\xe9w\x8c\xf2q\xc7q0\x95\xc9\xfe\xfe\x01\xe5\\\tC\x1aIӧ\x90\xa3\xdb\xebe<\xa5\xd0\r\xf1\xad\x90~ץ\xdbu\xb1\xbc\x00e\x1aD\x91\xa6\xdf\x1e\xe0\xb9I\xe1\xe7\r\x03\x10\x02CIƦj\xf8\xaeO\xaf\xd1\x05\xad\x89\xfa1n4\xc4()\x84\x91di\b0T\xe2\x96̒\x99!\xb5\xa3@\x1e\x84>hx\xe9\xec5\"/ \x8ac\xf2\xf9\xa4`\xf6=\x1fQ:=\fk\xd96a\x10\xb0\xb1\xbe\xc1\xb5g\xaf\xa5\xeb\x99\x12\xfes\xa1XĲ,\x82 \xc0JٝŜCo\xe8fu\xd0I\x81\xc8\x11\x94>1V\xe7\xe5\xe7op\xe9\xfc\xb9\x04\x920\f\xa6&'\x12\xfc߶\xc9\xe7\xf2\xe9\x8es\x1b\xa5\xccl\x0f\xed\xd4\xe4\x04\xf3\xb33X\xb6\xcd\xe2\xd93T\xab\x95\x84\x1c!\r\xa4\x90\xa7\xfe\x8eS\x02\x80c۔\x8a%Lۤ\xe4$\xf3%\x9e\xeb\x91K\x87(Gk\xc3C/$\xf0C\xbc\xa1ϰ\xe7\xe1{!\xee0\xc0\x1dx\xc9<\xa1\x10\x04^D\xe0\x87)\xd4\r\x86ip\xe6\xf2\x1cs\x97f)\x8e\x17q&\x1cd.I\xeb\x85\x10\xe4\xaa9\xf2\xf5<V\xd1®\xd8i\x1dc\x1c\xef$\x0eB\\\xd7e\xbcP\xe5\xa5\xf3\xd7YY[M\xfb\x1f\"\x1do\xf6O\xef\x11\xd6ǋ\xccv\xf7v\tÐB\xee\xf8>\xa28fz~\x1e\x1d\xc7\tn\x96\xd2h\x1d\xcb<\xd5\xc1\xcc\nCC%\x8b\xbb\xae^\xbc\x98PZ\xc2\x10\xe2\x98Z\xb9D!\xe7d)i:\xc1\x8cNi79\xdbbvf\x86\x99\xe9\xc9\x040$\xb1\x9e\x1b\u05ef\xf3\xfe\x9d\xdbt:\xdd\x046\xd0\xc7\xc3.\xae\xeba[6\x96if\xdbs\x9a\xdd6\x8ee\xe3{>q\x18S,\x16\x18\xba.N\xce!\xf2\"\x82aRst[\x03L\xdb\xc0\x18A\xec@\x1c\xe9\x04\x03ӂ\xd0O\xd8\x1cv\xde\xc4\xce+j3%\xe6\xaeL\xd1m\xf4\xe9\x1c\xf5hl5q\x87\xde\t\x0eT\x92D\x98\xa6\x89c\xd9\x04a\x90l\xab\x1e\x0e\x01\xc1\xf3\v\xd7\b\\\x9fv\xab\xc5\xf8\xc4dv`'\x81Ǒ@\xcaդ\xbej\xb7\xda\xf4\xba=*\xd5\nʐD)M4_(R(\x95\x18\xf4\xfbY\xd3α-:\xfd\xc11\xb66\x12\x882\x14gfg0\x95\x91\fG\x1e\x1d\x11\r\x87,\\\xb9\x84\x12\x12\xcct\xde#\xed\xe9*\xa58l\xb5\xa9W\xab\\{\xe62\xa3:f$\xb5Z\xddⵗ_\xe2\xadw\xdeK\x84\xa2\x12\xa8@\nI\u007f8\xa4Z)e\xf0A\xadRa\xff舉J\x8d\x8d\xd6.A\x10`;6\xcaT\xd89\x9b\xd0\x0f\x91\xae\xc4\x1b\x06(\xd3cгpr&Q\x94\x8c\x84\x05^@\x14\xc6\x04AȠ\xe7!R\x10Q\x1a\x12\xa9$9\xdb!Wt\x988Sg|\xa6\xce\xe6\xed\x1d\xc2 <\xa5\x95RJ\xc2(\xa4\xdb\xefeK\x90ǊU\x16'\xe6x\xf4h)\x11X\xee\x18^ױ\xce\x12\x8f\x93\x9fS\xad\xd7i\x1e\x1e\xd2\xee\xb4)Wʄ\x9e\xc7\xd0\x0f(\x14\x93M\xacձ1\x06\x83A\xb6\x1cA\xc7ǈ\xf5)\x0e\x82e*&\xc6꘦I\x1cE\xaco\xacS\xafר\xd7ǒ\xf5DfB\xff1ӹ\xeb\xbd\xdd=|\xcf\xcb6\xaf\x8d\xb6\xe4\x8cV\xd9Yʤ\\.\xf3\x89\xd7^\xa5V\xabf\xdd\xc3Q\x05].\x97\xb38d\xa7P\xfcX\xb9\x96t\xf4ҽ\xf2\xffocg\xd2cǕ\xa6\xe7\xe7\x9c\x13\xe3\x9ds`\x0e\xcc\xe4,\x0e\x12)\x95JU\x12\xa5*\xb9\f\x01\x06\f\xd8\xedE\xaf{\xd5;o\xed\x1f`\xaf\xbd\xb7\xf7\x86\xe1\xb5\xedF/\ft\xa3\xacB\x97ԥ\xae\xd6\xccIJ\x0eb\xce\xc3\x1d\xe3\u07b87\xe6\b/N\xdc\xc8LQew\x02\xb9!\x99 y#\xce\xf0}\xdf\xfb>\xaf[.\xf9\xa2\x804\xce\b\xfc\x88$N\x99z!Q\x94\x91\xa6\x19Q\x94\xe8[\xd8(d\xf8\xcc#K\xb3\xb2\x03,*\xc7\xd4\xd9B\xccm9\\\xb8\xb6Lk\xb9\x851\xf7D\x96\xbd4Ó\x98\x81\xaa>\xdc[k\xd788:$\x9e\x05\x98eww\xfe\x95\x96\x88\xbf\xb3\x9a\xe88IXZ]\x05\x04\xdb\xdb\xdbe\xcdⲷ\xbdMR\x8a\xc5\x1d\xb7V\x9e\x9d\xaa\x14\n\n\fC\xbe\xa25\x96\x96\x12\xa2V\xb2;\xbe\xfc\xf2+\fep\xf5\xeaU\xdcR\bgZ6v\x89\xfa\xdb\xdf\xdb\xe7\xc1\x83\a4\\\xa7\x84{Y\xfa\x8c1M\xc2(ƶ\xec\x8a\xc0\xd6i\xb7\xf8\xf0\x83\xf7\xa97\xeaHC\x9fS\xcb\xcbK\x1a(S\x9eQJJ\x1a\xf5\x1a\r\xa7FQn?E\x96\x97ۤ\x80L\xc3&\xa3 !\x9a\xc5Da\x82?\x9a\x11\xceb\xa2YB\x92d\x8c\xbe\x1b\xf3\xeb\x95;D{a\xd9n/\xf4C) \xf6b\xc6{\x13\xfa\xdf\x0f9~\xd8ez4E$z\xbec\xdb\x16\xcaR\x14Q\xc1Go\xfe\x9c\x8b\xcb\x17\xf4,ôXtی\x87\x1a\xbe\xd3h\xb6\xca.0\xe5<(=\x17\x16P\x14\x1a\x03e\x1a&\x9d\xc5EN\x8eO\xaa\a\x9b$\x11G\a{\xa7\x1c\x94v\xbb\x9a\xb3\xeb>\x9a\xe4ǚl\xa3]o\x14yQ\xf0\xe8\xd1c\xa6\xd3)KKKlllh\xeb\xb0R\x14B\xb0\xb7\xbb\xcb˗/\xe9\xf7zZ\x93\xa4\x8c\xea\x1c\x10h\v\xf0\xe1\xe1\x01\xab++\xa4iZ-\xe7\xb9)\xff\xb7\u007f\xf7{\x90p\xef\x8d;\x95\x94r~\xae\xb4[MZN\x03\x01\xc4a\xcc\xcc\x0e\xa9\v\xa1\x8bT\n\xfd\x80Ҝ`\x1ac\xd9Z\x8b\x9be\xba\x97\x1d\x87\t2\x15\xfc\xdb\u007f\xff\xe7\xa8\xffl\xf27\x0f?'\xbf\x98\x13\xa6\x82\xd1\xf1\x88\xee\ue0297;\xdd\xf7\xcfȆ\xea\x8d\x1a\vK\v\xfc\xf2\xca-\xfe\xe2/\xff\x8c/\xff\xa3Ǝ\xac4\x16\x11)\x14Q\x8a2l\x9a\xcdVYvhS\x8e\xe7\x8dϝ!I\x9a\x12\x97\xdb`k\xa1\xc3\xcb\x17\xcf\xe9\xf5z\x94YW\x04\xd3)\xc1l\x86S\xab\xd1^X\xc4\x1b\x0e\x90J1\xf6\aض\x83a\x94\xc5\xed\x1c\xb8,\x95dk\xeb)\x87\x87\x87(\xa5x\xff\x83\xf7\xb1m\xbd\x02\x0e\x0e\x0e\xf8\xf6\xc1\x03\xed\xb3\x16`\xb95\xf2\"׃\x1c%Kh\xa4\xc9wO\x9e@\x96\xe9\x0fL\x94\xb1?\xe5dN\x1b7_\xe3\xe8\xe4\x98K\x1b\x17+Ԫ@V@\xc9\xc5f\aS\x99\xc4\xe3\x88ĵ\x98R\xa0L\x03\xa9$y\nH}\xb8\xcf\xfc\x88<\xcd1\x1d\xfd2đ~[gӐ\u007f\xf9o\xeesp\xd4\xe7\xef\xff\xf1kF\xd9\x18o49\xb5,\x8b9\xcbY s\x81\x89\x89\f \xde\x0e\xb8\xff\xe7\xf7\xf8\xdd\xc7_0\tgdy\xc6\xfdk?c\xeb\xf9\v\xda\xe8\xb3c~\x03SJR\xaf\xd5t\x14\xc6\x19\xb5\xe2I\xb7[\x8d#\xdc\xd2\x10\xfb\xd5\xd7_\x93\x95\x1d\x0e!\x05\xdd\xe3C._\xbb\xa1\xf3X66\xd9\xfa\xee;\x820\xd2!\x06\xe5@p.A5\x9e?\u007f\xa1\x1d@n\x8d_\xff\xea\x03\xea\xf5\x06\x13\xcf\xe3ӯ\xbe\xe2\xe0\xe0\x00\xa5\x14\x97/_bs\xf3\x12\xe3\xb1\xc77_\u007f[\x92{,(\xf4[\xf0\xf2\xe5K\x16\x16:\x98e\xd5?o\xcdh}\x96\xe4\xde\xebwP\x86b\x8e\x9e\xd5*@}\a7\r\x83\xa6[\xa7\uee0c\x83)\x81\x17Rt\n\xfc\x89\xaf\xb7;)\x11)\xba;;\x8d\x11\xe5\x14\x93B\x90F)\xe3\xb1\xcf\xc1~\x8f\xa7\xcf\xf6鎆\xd4M\x87\x84\x04ё\xd8\xca\xc4q\x1djM\x8b\xfa\x85:ւ\x83jJr\xa1\v\xc0\xeeV\x9f\xff\xf4\xdf\xff\x1b\x86/\x89\xad\x84K\x1b\xeb8\xd2\"\x8aB,\xdb\xd5f\xd5\xf2\xa1.vڥ\xb5@\x9cky\xecno\x13&\x19\xb5\x86v.7ZmF\xfd\xdei_\f=(\x1b\xf6{t\x16\x97\xa85\x9aԚ-8>)\xb5\xbdc\x9a\xcdf\xd5\t62!\xa4\xa5\x14\xedF\x9d\x9am\xf3\xe8\xe1\x03\xb6\xb6\x9e\x91$1\xa6i\xd2鴹~\xfdz\xd5&H\xb3\x94\xe9\xd4\xd73ti0\x18\f\xc8\x01o\xe23\x1e\x8fi\xb5Z\x1a\xd7W\xb1Qte\xfe˷\u007fV\xb6\xf3\xa7,.,T\x01Z\x96e\xe3\x186\xadz\x83q\xe0\x13Mc\xa4!\xa1\xae\x0f\xd0z\xbd\x8e-l\nr\xa4J5\xd6I\b\x84\x92$A\x86)\x15\xff\xee?\xfc\x17\xdc+.+\xef-qs\xf5\x16i\xac!\x9c\x1b\xeb\x8b\\Zk\xb0\xb2\x10\x11\xa5&\x83\xb1\xc5\xf6a\x9f\x93\xaeG\xd0\v\xa9/5\xd8|\xdb\xe2d\xb7G\xd8\xf3\xf9\xe8\xf5\xfb\xfcᛯX\xae7\x11\x9987\x13\xea\xb4Z\x1c\xf7\xceOK\xa3(b0\x1a\x11\x04\x01\x8d\x12<\xbd\xb0\xb8\xc4\xc4\xf3(\n}\x16\"\xf4\v4\xf6<l\xd7\xc5q\\n\xdeԘ\xc0\xed\xedm]\xdfd\xf9\xa9\x83\xaaH\xd3\"\x8e\"|\xdf\xe7\xe3\x8f?\xe6ѣǤiR\x156\u05ee]ö\xf5a\xdd\xeb\xf5\xf5\xb5\xb7\xdbӴ\x1b\xd3\xd4\xfc\x8f$!\f\x02\x9e<~\x82@7\xd3*\b\x98\x94H%+D\xc6\xd3\xe7?T$:)4\xaeܵm\x16\x1a\xed\xf2\xad\xcb\t\xc6!I\x90\x90e\x19\xbe\xef3\x9dN\xc9Ӝ$L\x89Ä8ԉh\xa6\xa3h\xdciѹ\xd7\xc1l\x94\x8a{\xa1pj\x0e\x86\xa5\xa8\xd96\x962\xc8bI\x16+\xf2\xb4 \x18G\x84\xa3\x88`\x18\x91E\x19\xbd\xc3\x01\x93\xb1\xcf\xda\xe2\n5i\xf3\xf4`\x1b?\x9a\x9d\xb3\x95\xd5ݚ\xe6\x97\x04A)\xb0(J\x8a\x916\xa9Fa\x88\xefksN\x92\xc48\xa5\xccT\xbf<\xfa\xff\x99e)\x833\xdb\u06dd;\xb7\xb9paE\x03w\xb2\x9c \xcdI\va\x18R\x88B\x17m\xe1\xb9\x12\xbe(\n\xda\xed6\x17/^$I\x12&\x93\t\xbb\xbb\xbb\xfa\x81\xf4z\x95\xa1g\xecyU[\xfd\xd9\xd3gܽ{\x97N\xa7S\xc9I\x11\xa7j\x14\x81\xa0\xd9lppt\xcc\xea\xf2\xf2\xdc\u0085i\x18,5;H!+3\xbd\x1ełݰ\x98ʹ \xbc\xd1l\x90\xa5\x8a8\xd0\x1a,e\x1a\xa4Eδ\xef㏦\xec<\xd9e\xed\xd6*\xab7/\xe0\xb6\x1c\x9eo\x1fq\xd4u0\x95ğ\x06\x84\x81\xbe&\a\xa3\x84\xdev\x8f\xe3\xbd\x13\x92(%\xcd3\u07bb\xf1&\u007f\xf3\xe5\xa7Zqy\x06\xa5>\x8f\xed0\f\x83\xe1pH\xa3\xdd!+\xd1\x1d;\a\x87\x1a\xe0\xa6\x14\xfd\x93\x13\xd667y\xf9\xc36\x97.m\x12\x063\x94a\xe8\xebx\xf9YL\xa7S\x9e<y̛o\xbe\x89@\xf0\u07bb\xbf\xe4\x93O?\xc5\xf3<\f))D\x91\x1a\xa6ir\xe7\xce-\x8e\x8eN\xe8v\xbb\xe7\xb4X\x97.]*s5\x02>\xff\xfc\x8bjx\xe5y\x1eQ\x18a\x97\xd9T\xf3\xed,\f\x03~\xffɧ\xfcٿ\xfeW\xe78 gj\x1e4\xe6\x00\x00\x10\x92IDAT=v\x17\xd7\xd6x\xf8\xf8\t뫫\xe4\xa5*^\"\xb8\xbe\xb2\xc9\xef\xe5\xe7\x95\xc0\xba(\nf\xa3\x99\x96\xf04,\x82  ISڴp\x1b\x0eE\x94b\x16TFӢ\xb4\xa0\xed?>b\xef\xc1\x01Ҕ\xd4\x1b5\x16W\x16\x90@\x1adDÈ\xd9@\xa7\xfe\x84VLa\x94[j\xad\x85\x91+\x9e\xefo\xd3p\xeb\x18Rw\xac\xe7\xc3'\xb7\xa4\xe6yވF\xbb\x83\x10\x82\x97;\xbb\xc4q\x82\xed8\xa5\xa46%\x89\"\x82`\xc6\xe1\xc1\x01u\xb7\xa6-\x18\x86 \xcf5\x92}\xe0yL\xfc)a\xf89\xf7\xee\xdd\xc3q\x1c~\xf3\xe1\x87|\xfd\xed\xb7\x1c\x1e\x1ej!{Q\x14\xc5x<!\f\x83s\x12Ϣ(X]]\xa1\xd7\xeb\xf1\xf0\xe1\xa3så\xa2(\xe8\xf5\xfb\\\\_\xd7M3\xcb\xe2ڵk\xec\xef\xefstt\xc8\x1f\xbf\xf8\x92_\xdf\u007f\xb7\x12\xcfs&9@\xb3\xadf\xba=]\x16\\i\x9eqm\xf5\x12\r\xab\x8e\x17\x8d\xab73\xcfs\xc2IH\x96d8-\x1b\x01\x8c\xfa#\xb2\xbcI\xb3\xdd\xd0t\x1fC\xd1hבJ\x11\x87\x11ʐ\xa7[E.\b\x87ZЀ\x10P\x93(\xdbBLST\x90U\x90\xca\xd5\xc6\x12[\xdb/\x89Ӥ\xd2-\x8bL\xabRL\xc3\xc0\xb1\xadr\x1c\x10\x92\xa5\x19{\a\x87L|_\xaf&[w\x16\xf28&\x98\xcd0M\x8bn\xb7K\xdcnӨ\xd5ʇ\xaa;\xdfA\x18\x91f\x19\xbd~\x9f?|\xf6\x19\xab\xab\xabt:\x1d\xae^\xb9\xc2\xf2\xd2\x12\x0f\x1e>\xc4H\xd3\xd4\xda\xd9\xd9%\xcfs:\x9d\x0eq\x1c\x13\x04\x01\x86a\xf0\xc5\x17_\xe2\xfb\xfe+*\x13\xc30x\xf1\xe29\x9b\x1b\x1b:\xf30״k\xa5$[\xdfo\xb1\xbb\xb3Ó\xc5\x05\xee\u07be\xfdʄ\xcc4M\xd2,\xa3?\x18rqm\xad\xb43h\xb0\xcc\a7\u007f\xc6_\u007f\xfd1\xb6\xa9\x87G\"\x13\xbae\x1e&\xa4q\x8a\xd3t\xb0\x1b\x16^\u007fD\x14\xc6,\xad,\xa0\x8a\x02\xd36\xe9\xb8eG\xd551m\x13\xcb\xd5\xcd;\x91\x83i\x19 \xf559M3\xa2I\x84w<\xe1\xe4\xa0G\x96f4U\x9dG;z2\x9afZi2\xef&\x18\xa6\x81ii\x91B\x92$di\x82_\x9aK\xe74;e\x18\xa8,'\n\x03\xb24Ŵ\xf4\x8aNӔ\x85N\xa7\x1cgk\xcb\xdf\xfc\xb2\x13\xc71;;;\xec\xef\xefW\u007fW\x9e\xe7ȼ(T\x9ae,//q\xff\xfe{lnnT\xab\xc1\U000fc7d4\xfcH)99>\xa9\xde\xe48\x8a\xab3\xa7\xd1l2\x1e\xf4y\xb9\xbd\xc3I\xaf\xf7\x93\xe8\x1cYB%\xe7-\b\xd3\xd4(\xf2w^\xbb\xcbJsQ\xdfɥ@Z\xb2\x92\x86\x16yA\xe0\x05L\xfbS\xb2$'\x98\xcc8\xda=!\x98\x04\xc43-\x1br\xea\x0e\xb5\xb6\x8b\xe9\xe8\a\xb2q\xf5\x02\xaf\xff\xfc\n\xb7\xde\xda\xe4\xfa\x9d\x8b\xac^Y\xa4\xde\xd6\xdd\xde4Oɲ\x8c\xbb\xeb7\xe9\xb8-\x06\x93Qe\xc8\x01P\x86V\x97\xb8\xb6\x83\x94\x8a\xc9dRe\xa5T7\xad\xa2(\xddf\xf3\xb9\xbaVv\xfaS}~\xe4y\x8e7\x1e\x97\x13؟\xf0<\x96]b\x1d\x19\xa5\x1b\x9f\xf2\xc1`;ٝ\xf5\xb8z\xf5*A\x10rP\xaa\xf0\xce\xfe\x90{ƜR\xe9\xaeJ0\xd7\xfc\xd7G#\x0f\xc30Y\xbf\xb8N\x96\xe7\xf4\xbb'|\xff\xecy%\xff\xffq\x02[\xb7\xd7'(\xb5\xc1\xae\xads\xa6\n\xe07w\xdeՅR\x92a\xb9\x96~(\xb6\x16\xc8i5J¤\xe7\x13\xfa\x11Q\x10\xd1=\xe81\xeay\x04\x93\x90h\xacoN\x86\xad\xb0]\x13\xc71\xabή\x94\x02ױ\x88\x83\x98\x9d'{\xf4\x0e\xfa\xbc\xb5q\x8b;+W\xf9\xe3\xd67%\x18\xa1\x94\x99JE1\x1fk+\xdd6\xf1ƞ\x86\xec\xfch\xc5K\xa5\xf4w9\xc6h\xb74\xa5.\x8eb]\x97\x15\x05\xfel\x06B\x8b\xec\xfe\xbf\\\x95\xa1\x9ce\xa628\xd8?\xe0\xdbo\xbfe\xecOx4\xda\xe5\xf1H\xb3L\xda\xed6\xf7\xee\xdd-\x1d\xb8\xc59\x98K\xaf߯n#\x83\xc1\x00\xcb2YZ\xd4\x18\x8c8\b\xe8v\xbb\x1c\x9eYIgՐ\x05p\xdc\xd5\xe9fV9\xde\x14@\xcdp\xb9\xber\x89,\xc9H\xa3\x94z\xbb\x8e(Պ\x86]Bm\xb2\x82p\x1c2\xeb\xcf\b'\x11\xe3\xe1\x84\xdeQ\x9f\x93\xfd>\a\xcfN\x18\xec\x8f\b\xa61\xbd\xc1\x84\xc1Ч\xdb\x1fsp0\xe0\xc9\x17?\xf0\xf8\xb3\xa7\xf4w\x87\xbc\xb3\xf9\x06W\xda\xeb\xfc\xf5g\x1fs4\xecjezy\xd35\x95\xa1\x19\xc3\xe5\x16;wM\xcdWȏ\xbf\xe6\xf3\x12!\x84NUPJ#6\xb2L7mK\xec\xed\xf2\x92\xa6\xe7\xfd?\xf3CV6/\xb06[\xe0\xf8脀\x98O\x8e\x9e\x10e\xfa\xe6Աj\\\xbfz\xad\x8a\xc3Sg2\x95\x84\x10\xec\xef\xefU\xc2/\xaf\xbc\xfeڶ浏\xc7c\xd24\xe6\xbbgϸri\xf3ܥ`\xfe\x10\x0f\x8f\x8f\xb9u\xe3\xba\x16\x96\x95m\xe8`\x1a\xf0\xf6\xb5\xd7\xd9\xe9\x1d\x10\xcfb\xea\xcd\x1a\x8dv\x8d`\xaae>\xcaR\x88\\\xe8qo\x9a\x11x\x01I\x90\xe04\x1d\xb24c:\x9e28\x1a\xb2\xff\xf4\x90FG?\xcc4\xd2M\xc9d\x96PS6\xb7\x17\xae\x91\x879\u007f\xf5\xe8\xff\x10\xe7\tv\xc3&\x9ai\x1d\x98!u=#rQ\xcd\xd8%\xa7¸*\xffj\xde\xfdM3\x1a\x8d\x06\x93rk\x92R\xb2\xb4\xb0\xc0q\xaf\xcb`8b}m\xf5\x9cDu\xf5\xc2\n\xdb{{\u007f\x8a\xf8*\xe4b\xeab \xe9\xb9S^\\\xf0\xb8xo\x9d\xa5\xf5\x05l\xd7\xe2\xc5\xec\x84\xceB\x9b\xc9D\xd3\xd5\xde\u007f\xff>\x97\xaf\\\xaeD\xd8[\xdfo\xd1/W\xc9|\x1f4J\xb7nQ\x14x\xbd\x01a\x18\xb2\xbb\xbf_i\\ϊ\xcb\xc2P\xa3\x96\xe6Z\xda8\x89)(\xb8\xba\xb4\xc1z炆\xe1{S\\\xb7\x86]\xd3\xce\\Yޢ\f\xc7\xc0\xb0\xf5\xf9\x92\xc6)~o\xc2\xe4dB\xecG$AB\xecŌvF\x8c\u007f\x18\x93\x1cŴb\x97K\xce*\xab\xf62G\xbd.\x9f<\xf9\x828O\xf4v\xa8d\x95Vn\x99\x16uۭ\xe6\xddi\x9a\xea֒iV\x87\xf3\xb9\x9d\"M\xb5\"\xe7L\xb9pquE\xe3ag3<o\\9\xcaD)=\xb5\xfe\xc4*\tEnʺisx!`\xb0\x98b*='X\xdcX`\xe3\xceEV.\xaf\xe0:.\xa3\xe1\x90\xef\a{\xec\rO\xb8\xf5\xdaM\xea-\xdd{\x99\xcef\xccJR\x9a\xe6g\xf5\xb5\xea\xa4t\xc8\xc6qD8\xf3\xd9;8D\"^\xc1\xeb)\xa5\xd8\xd9\xdb\xc3*\x85\xceI\x92\xb2\xb4\xd0\xc1\x9fN\xf9g\xb7ߥ\xc8!\t\x12&\xa3\t\x9dV\x1b\xa7\xe6\xa0\x1c\x85Q\x8a\xae\xa5\xa1\xcf\x17\xc36P\x96\xa1+\xf1\xf2\xe0\x0f\x06\x01\x91\x17\x13\x8d#f\xa3\x19'\xdd>\xcf\xf6\xb7y\xb8\xbd\xc5\xde\xf0\x10ei\xc1\xb6\xb4$B\xea\xc6#\x05\xd4l\x87N\xad\xc5p4\xae\xe4P\xa5^\xea'\x89r\xf3ܭ\x8aE\x89@\x99&k+\x17\x90R\xd0\x1d\f\x18\x8e\xbc\xf2\xcf\xe8Ϧ\xddn\xbf\xa2Q\x8b)8d\x14\xcb\xc9JN֒\xb8\x8e\x85\xedZXN)ϗ\n\xb3i\xb2\xb3\xbd\xc3\xf3\xf1>\x87Ƅ\xff\xf1\xd9o\x19\x05>o\xbdqW\x9bD+/\x88\x96\xfd\x9f\x9ctK?:%\xc5A2\xec\xf5\t\xa2\x10\xaf\xbc\xa5\xfc\x18=[f\x99W7\x1c\xc7vH\xb2\x8c\xd76/\xf3\xf6\xfam\xd2,\xc3\x1f\xf9̂\x80f\xbdAͭ\x9d>\b\xd7@Z\xf2\xf4\xe18\x06\x86m\"MI\x9c'\xcc\xe2\x80I\xe8\xe3\x85>\xd3$$)R\x84%0mS+\xe8\x1d\xad\x9c9[\xbe:\x96MͲ\xe9\x0e\x06%>I\xbfEFi\xb6\xa1,F\xcfYåDT\x811\xfa\xa5k\xb7Z\xb4\xcbd\xecn\xaf\xcf\xc8\xf3\xb4y\tX^\\|E\x975`J\x9c'H\xabi\x97\x1cvQ\x1e\x9c\n\xcb1\xb1]m\xc7\xda9\xdcc/\x1f\xb2\xb4\xbe\x80\\2\xf8\xaf\xbf\xfb\x9f|\xbe\xf5\xb0\x02\xeb\x17\x14lll\xd0h4\xe8\xf7\aL\xa7SfӠ\x9a\x89di\xcad4\xa2;\x18\x9c\v\x19k\xd5\xeb\\\\\xb9@\x9ae\f\x86\xc3\xca'\x9eeZ\xf8\xbd\xb4\xb0\xc8\xfbo\xbc\xcdZ}\x99,\xc9\x18v\x87d\x99\x8eRrlm5\x13R\xe8\aS3P\xb6\xd20\x01S\xa0,\xbd\x8a\xce~\x9b\xe5\x16'\xa5\xac~\xff\xf4\xfaz\xbajm\xd3\"\fÒ4Q\xf2\x11\xb3\\\x1bk\xe6a\xf5\xe7u@\x1a\xa4s\xc6\xc2@\xa9睧=\x14E\xc1\xd1I\x97Y\x10\x96\x8cc\xf3\xdc67#c\\h&\x97\xac\xb7k\xb4\x96\x1b8u\aU\xdaϴ\aCRs-\xfcZF\xd6\x00e\x19\xb8M\x97\xb4-\xf8ۗ\xff\xc8\xd6h_\x0f\x95\xf2\x94\x1b7\xae\xf3\x8b_\xfe\x82f\xabɓ'\xdfq\xf9ʥ\xcaz \xa5dXF\u061d\xe6\xfai\xe1\x83]F\ni\xeezV\x8dH\xe7s\x92\x97\xa3\x03b\x91\xe8\xd6\xc4,\xa5\xdfӖ\xb8V\xab\xa5\xad\xd9g\x80es\xbf\x88\xe9\x98\xd5\xf9\xa2L\x854K?I\xb9\"̺\x89\xb2\xcf+\x10\x1d۩p\x84JH\x9e\xbf\xdc!I\x12\x924\xd5\xf6\xb3<\xc7-A\xfbR\xf0\xe3GR1&\xb5\xb2q\xbe5KڭV\x15\x87\x94$\t\xdb\xfb{\xda\xe2P\xf6\xef\xe6\x0fxTL\xc9(k\xaf\xa2l\x949M\x9bz\xa7\x8e\xd3p*\x87Rn\x82\u007f!ôM\xcd\xe65\x15\xb6c\xb2\xbc\xb1Ĥ\x9d\xb15=\xe2\x1f\x8e\xb7\xf8\xe4\x8b\u007f \x9a\x05\xb4ZML\xd3\xe4\xeaի\xac\xaf\xafW\xfbd\x14\x86\fGC\xa2r\xbe|\xba\xf4\v:\xcd\xfa\xab\x9a߬\xe0\xef\x9f|\xc9\xe1\xa8˿x\xfbW\xbcs\xf9u\xadZ\x19\x87x\xa31E\x9eӨ\xd7i\xd6\x1b\xe7婂\nb#M\x89\xb2˫\xb2\xadP\xb6:\xbdX\x9c\r\x81,uSy^P\xb7k\x98\x85\xc1`4B)\xc9x2!ʹxO\xceg8e\x01\xf8*\xb1T\x965\x89<}\xe9J\xe7\xf2|\xf6\x13\x86\x11/wvK$\x94\xa1\x19cE΄@\xe3\xb0\f\x85Qm\xf89(S\xe2\x18\x0e\x96m2\x1b\a$QBa\x82*$\xd2\x11\x88XT\a\x9aY\xb7\x98\x18\ti\x00\x1f?\xff\x9aGG?\xf0\xd6\xc2\x15\n!\x88\u0088\xf5\xf55\x0e\x0f\x0f\xab[ʨ\x8co\x98\x9f=Y\xae\x99T\xae\xa3\xa7\x93UG\xa0\x80ݣ}z\xb9\xc7\xfd[o\xa1\xa4d\xc9nq<\xe8\xb3=>`\xdc\x1fc\x98\x06\x8d\x86\x0e\x183\x94b\xe4\x8d\xf8'\xb2E\xcf}\x88\xedV\x1b\xcb4\x99F\xd3\x12\xb6,\x19\xf5<6\x97V\x18O&\x8c}\xbf\n:S\xf2<\xb4\x8c\x9fض\xb4:\xc7$.\xf1T\xa0=\x89\x9dV\x93\x91\xe7i\xe3\x92\xef\xf3\xe8\xfb-=\x97\x17\x8a\x01\x9e\xfeyCS\x98\xa42\x14v\xdd\xc6nڥ\n\x03\xa4\xa9\xa8/\xd4q\xea\xce\xe9\x9b'\x05\x86\xa5\xb0]\rH6\f\xcd\x17YXk\x93;p\xe0\x0f\x18F>\xa3٘\xcf\x1f}C\x10E\xa7\x8e\xa9R\xf1\xe7\xcd\x19\x88\xe5\xfd}\xfe{\x8ee\x9dil\xc2\xf3\x83]\xd6\x16t\xc2\xc2\xe5\xb5\rn^\xb9\xceG\xaf\xbfǒ\xdb!Os\xfa\x87}\x82 Բ\x1f\xc3`yiY\x8b'\xfe\t\x89:\xb2\x14\xea--,V\xf0\x02m\xf5\x96\xf8\xd3\x197\u05efR\xabאJ\xd2-\xa9\xaby\x9eW\xf3\x9by\xfa\xf4\xb9\xf6G\xa1ͤBɊ\x844Oq\xc8\xf3\x8cͲ{q\x16\xe6\x96g\x19A\x912.\xa6\xa7\x85s\x01F\xe7b\xab\xa2>\x17Y\xa1\x15\x1d~D^\xe48\r\x1biHB?\xa4\xc8\xf4hV)\x85i[\xe4y\x88*\x04fn\xd2Xj0\x9b\x04\x9c\xc4c\x06\xb3\t\x9f~\xf9=ן^\xe4Jm\x19ǚs\xddu\x97\xb4Q\xe6:\xcdi\xa1\xb2\xb4Z3;\xbd\xb5xє\xc1x\xc4ť\x15V\x16\x96\xa9\xbb5\xc6\xfe\x84\x0f\xa7\xef\xf0\x87\x97\xdfp\xe2\x0f8\xd9;\xe1\xc2\xda2\xf5V\xbd\f\xffm\xe0\xba.Q\x14\x11FQْ\xafL\xe2\x98Ja\x99:{q\xcek\x9f\xd7S\xfepBVd\xacZ\xcb\\\xbd\xbc\xc9h\xa2\xaf\xbc\x03\xcf+ɧ\x19\x86\x92\xe5\x8c\xfcծC\x9a\x9eN2\x11\x02\xdbu\xc9|\xbf\xda\xdalK\xfbܓ3l\xdf\x02AOL\x10\x85@\xa8\xd3]ʨ\xb5]\xd2$#K\xb48\xd9m\xd8(%\t\xc6\x01i\n\xa6m U\x8d`\x1c\x90'9\x85(\xb4\x9eȱ\x88\x82\b)uܶ\xedX\x9c\xe4>\xb3\\K\xfb\a\xb1ǚ\xd5&\xcds\x9a\x8eE\x96j\xa0\xe5\xe3ﷴUz\x1e<\xac\x14\xe2G\xf0͜\x8cϷ\x1e\xb2\xa8\xdax\x9bc\xad\xac\xbcuS\xb7/\n\xf8\xe3\xf6\x03\x0e\xfc\x1e\xbd\xc3>\xb3I\xc0\xc2JG_eQ\xba\x88\xb4\xec\nRLy\xfd\x96gpJE\xa5\\\x97t\xf7\xbaZ\x8a\x9a\v\xee^\xbaI\xbb\xdd\"+\xf4\r2\xcfR&\xfe\x94\x85N\x1b)M힒\xe7W\xa1a\x1aDqrn\xa0%L\x93(Np\x1d\xbb:\x1f\x17\xdam\x8eNN\xdbH3\x12\xc2\"\xd6\x0f\xa3\x14\xe0)Si\u007f\x88\xe5\xe8ۉY\xd7}\x1b\xcb5q\x9b6J\xceQ\x19\x02\xb7\xe5\x96?\xac\v(\x9dMk\x9dj\xac\fE!\xc1j\xe9\x19\xc7,\x8dP\x96\xe0x6\".\xc1]\xf3d\xb5\xf92O\xfeD\xa4\x9d\x10\x82(O\xf8\xddw\u007f\xe4\x8bo\x1e0\xf1\xf5\xb2~\xfb\xcd{ܸ|\x95\u007f~\xfb]V\xeb\xdaW\xe2O|v\x9f\xefq\xbc{B8\v*t\xe0\xdcެ\xe1\x05\xc6OvZ\xfb\xc7Cf\x13\x8d\xda\xe8\x18\rn\\\xbe\xc2ʅe\x8a\\\xcbg\xf3\xbc\xc0\x9bL\xc8Ҍ<\xcbh\xb6[\xafҎ\xa4,W\x88\xaaX\xbf\xf3\x82w\x0eu\x068\x9b-\f0+b2\xf2\ni%\xa4dqu\xf1\x14\x13kZ\xe5=^B<M\xca\xe2)\xa6\x88\x8bʃm\xb8&\xb9\x1fU\a\xa8\xb2\x14Fj\x92\xa5\xbavȳ\x9c\xc2T\xd8m\x9b8Hx6>b\x18\xf9HO\xd1i55\xd5@\xa8s\xc9:5\xc79\xb7\xbf\xce\xc5gv\xc3\"H\"\xfe\xf7W\u007f\x87\xe3\xd8\xfc\xe6\x83\xfb\xd4\xeb5~\xf1\xf37)\xbe\xca\xf9({\x97?\xbc\xfc\x86]\uf202\x82\xe9dJ0\r0L\x13\xb7\xa1s{\xa5\xa1\x90JT\xa9\x0eT8\xa5\x02ߛ0\xf1tV\xa1(\x04\xb77o\xd0j耗0\xd6@ϔ\x94\xa17\xae\b\xaa\xadV\x8b\xc9t\xfaʿ5\xcf\xd2s݇\xed\xedm\x06\xc3a\x05\xfe4\xcb\x04\x89y/0\xa7  \x99cP\x10B\xb0\xb8\xb2@\xad\xe1b\xccg\rE\xa1\x9dCҶ\x00A\xe8\x85\b%\xc9Ҹ\x1a\xfbUа\xf2g\x84\xd0\xde\x12Q\xfa\xb5\x94R\x14i^\xd5\x01]\u007fB\x9a%\xbc\f\x8e\xb8\x1c\xae\xb2v\xd6\xdd+\x04\xd3 ĵ\x1d&\xd3\xd9\x19\xf6T\xa1C_\x1a\n\xb3f2\xf2&\xfc\xd5g\xbf%\xcb2>\xfa\xf0W\xb4ZM\xde{\xe7\x1d\x9elm\x91d\t\xaei\xf3}o\xbbJ\x83N\xe3\x94\xe9h\x8a\xefM+\x02\x8f(\r\xfcs\xf7l\x96dD\xe1\x1cdP\xd04\xeb\\[\xdd\xe0\xf6\x8d\x1b\xfa\xffU\xf6\xb4F\xb9O\xdf\x1b\x12':\xe7j\x9epz\xee@ϲs\x94\x86ݽ=\x9e=\u007fQ\x11\xf3v\xf6\xf6y\xadL\xb7\x9e߷Ӣ \"\xae\xb6?\xc7u\xa87u漱\xf7\xf80Z\xbf\xbd\xaa-cZ\x12\x8ea)\xac\x9aEog\xa0\xbb\xaaEN\x91\x16$A\xa2\x0f\xf7R\xe9\x9e\xe7z\xfe-J\xd4\xc5\xfc>.\xca\x03\xdbi\xd8\x04qN\x94\xc6<8xa\xac\xad\xad\x17\v\x9dvv\xb6\x11\xd7\x1d\x9e\x87\x0e\xa7y*\xe2<\x16R\xa8\\\b\x81\xdbv\x19\x8c=\xfe\xd7g\u007f+\xa4\x90\xea\xc3\x0f\xdeM[\xcd&w߸C\xa7\xdd\xc6\xfe\xd2\xc62\f\xf9\xe8腅 \x97J$E^\x14\xbaГ\xa4IJ\x91Pev\x14g\x94\xef\xa2T\xd1\xddX\xbcd\xbc\xfe\xda\xcdt\xa1\xd3&IS\xc20Ə\x03cR\xcc\xd2Y\x14\x18a\x18\xa65W\xd7g\xf6\x99\n[\xdb\r2!\x84,L\xd3\xe2\xf0萧O\x9f\x9d\xdbΆ\xa3\x11G\xc7'\xb4Z\r\xb2\x02% \x9b\x89\xd4H\xc9RS\x1a\x14@\xb3Ә\x8fͣ\xff\vhn×\xa53\xa0n\x00\x00\x00\x00IEND\xaeB`\x82")
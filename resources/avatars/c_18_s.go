@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_18_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14&\x16\n\xc3\xcf\xc2\x00\x00 \x00IDATx\xda\xed\xbdy\x94\xdc\xd7u\xe7\xf7y\xef\xb7\xd6\xdaU\xbd\x00ݍF\x03 v\x10 As\x91D\x8b\xd4:\xb2d)\x8a\xc6\x13\x8fǱ\xa3$\xb3\xe4(\x13grbO\xced<q\x9cLf&\xce\xd1\xc8'\xf69\x8e\x9d3\x8e\x93\x19yI,\x8f\x8e\xd7\x11\xb5p\x91HI\xa4H\x93\"\x88\x85\x00\x1bk\x03\xbdW\xd7\xfa\xab\xdf\xfe{\xf9\xe3\xfd\xaa\xba\x1b\v\xa5\x91I\n\x10\xf5xp\xaa\xab\xbaYU\xef\xddw\xf7{\xbf\x17~\xb8~\xb8~\xb8\xee\xf05\xb9\xef\xee7\xfc=\xa7\xf6\x1f\xbd-\xf7*\xee$\xc2L\xed?z\x1c)\x1fR\x99*\xa7I\xac\x80q\xe0Q@\"\x04\x02\xcc|O\x19\xa0\x94~b\x00\xe7\x80\x13JqAJq\xde0\x8c\xb3\v\xe7^\xe9\x0f\xdewǡ{1\f\x83+\xa7^\xfc!A6\xaf\x89\xdd\aY\xbdt\x16\x80\xed\xbb\x0f\x98\xedvk\xb6R\x1b\xbdo|j\xe6\xe7\x80G\x02\xafg$i\f\x80\xca2\xd2$Ae\x19\b\x89\x10\x02P\xf9\xe3\xc6RJ\x91)\x05Y\xfe\xbb\x8d_\xbf\xa82\xf5\ri\xc8'T\xa6\xceHC.-\x9c=\xd1\x1a\xfcҜ\x9c$YZz{\x12dlv\x1f\x8d+s\xc3\xe7#\x93\xb3\x1f\xf3}\xef'\x04|\xd00\xad\x9d\xa3ۦ\xa8Ol\a!H\xa2\x90(\nɒ\x04\x05 \x84&\x8aRzCB\xe8\xd755PJ\x13E\x91\r7\x9be\n\xa5\x14i\x1c\xa3P\bD\x17\xc1\xb7P\xeay!\xc43\xf5\xa9\xe9\xc7\xce<\xfd\xe5\x14`\xfa\xe0=,\x9c=\xf1\xf6䐱\xd9\xfd\u007fKJ\xf9\xcb\nf\xd34)&aH\x18\xf4\x11B`\xdb\x0e\xe3ӳTG\xc75\x11҄(\n\x89\xa3\x90\x01\x05\x84\x94\x98\xa6\x894-\xe4\x80kD\xbeK\x05I\x12\x93D1Y\x96\x92\xa5\tJ)\x02\xcf#I\xe2͜\xd5\x13B\xac\t!\u007fw\xf9\xfc\xa9_\xba\x19\xf7\xfe\xc0\x11d|v?kW^\x03`tfoAH\xf1\x11\x10\xff\x87\x90r\xbb\x14\x02iZ\x98\x96\xfe\x97e\x19\xbdf\x03\xbf\xdf%MRLˢ>1I\xb5>\x8e\xe5\x16\x10@\x18\xfa\x9ac\xd4M6\x98\x1f\xb4\x10\x02)\x04JH\xa4\xd04T(\xe20\"\x89C\x928&\x89B\x94\xca6\xbfO$\x84\xf8\x1d\xe0\xd7\xe28\x9ck\xce_H\x00\x0e<\xf4^\xce}\xeb\xa9\x1f<\x0e\x19\x9d\xdd\xf7\x00\xf0+R\x1a\x1f0M\x13Ӷ1\f\x13!\a\xb7[`H\x03\xd3v\x90R\xe2\xf7\xba\x84a\x80\xd7n\xe2uZخKyd\x94R\xa5\xca\xf8\xf4,\xa6\xe3\x12\xf6{Da\x88\xca2\f\xdb!\xf2z\xc4QH\x96\xa5\xa0@H\x81\x10\x02!% \x90R`\x986\xb6\xebj\n\xe4\xef\x1fG\x91\xfe;\x01B\x1a\x14˕_w\n\x85\xff\xf3\x95\xaf>v\xfa\a\x8aC\xc6w\x1f`\xed\xd29\xc6v\x1d\xf8\xa4R\xeaӖem3L3\x177\x16\xd20\x10R\"\r\x03Ӵ\xb0l\x17!\x05*SHä\x96\xeb\x92o\xfc\xf9\x1f\x02`\xbb.\n\xb0\x9d\x02\x93\xbb\xf7Q\x9f\x98\xa24R\xa3X\xa9\xb1羇)\xd6\xc6I\xe2\b\xdf['\xf4\xfa4\x97\xae\xe1w;x\xad\x06\xed\xe5k$a\x80Spq\nE\f\xcbFe\x19\xfdn\x878\xf4\x87bP\xa0\x89\x98)5/\x94zLH\xf9\xbf\xbc\xf4\xf8\x9f\xce\x03L\x1d\xbc\x87\xc57PǼ\xa5\x04\x99\xd8s\x90Ջg\x19\xdf}\xe0\x1f\x02\x9f\x06\x814\f,\xdb\xc60,\f\xd3\xc00L\xa4i`\x18\xd6\xf0\xeb\x199\x91L\xcbb\xdb\xec^\x16.\x9c嵗\x9ec\xf7\x91{\xd9{σ\x14\xcaU\x9cr\x95\xc6\xc2e\xbcV\x93\xf6\xda2\x8d\xa5\xab\xc4a\x80[\xae0\xb9k\x1f\xe3\xb3\xfb\xa8m\x9ffdl\x1b\x96\xed\x10\xf6{tW\x16\x89\x93\x10\x91i\U00065498^\xa7\xc5\xfa\xd2U\xd28\xd2ƀ\xb6\n\x10R+\xa3(\b\xe8u\x9aQ\xe8\xf7?\xb3x\xee\x95_\x04(OL\xd3[]\xb839db\xcf\xc1\x9f\x00\xf1o\a\xb7\xcf0Ll\xc7AHc(J\x94\x1a\xfc\xce\xc0\xb6\x9d!1\xc6g\xf6\xd0i\xacp\xf1\xe4\x8b\xec\xbf\xef\x1d\x8cM\xed\xa44Rg\xe6\xe8C\\9\xf1,Y\x92h\xee\x92\x06\x86i\x92f\tA\xb7C\xb7\xb5\xceڵ\xcb,]>\x8f\xd7j`\xda\x0e\x86ek\x8b,?t\x95edY\xaa-6 \xcdR\xd28\xde*\xea\x10\b\xc3@J\x03\xa5RT\xc6E)\xc5\u007f\xde__y\x1a\xc8\xf6\xdd\xff\xa3\xcc\xfd\xe5\xd7\xef4\x82\x1c\xfa\xac\x10\xe2g\xa5a M\x03Ӱ\xb5\x98\x12 \x84\x81\xca\t\xe5\x14\n\b\x04ҐH!\x99\xdas\x808\x8e8\u007f\xe2y\xf6\xdc}\x1f\xe5\xda\x18c;\xf7\x12y\x1dm1e\x19i\x9a\xe4\x1b\x1a\xf8\x1bBs\x97\x10Hä02\x8a2\x04~\xb7͵3\xaf\xb0t\xe1\f\bI\x9a$$qD\x92$\xa4qD\x9a$\xa4Y\n*\xcb\xdf7\xd5>\x8f\xcar\xe2\xa9\\\xb7H\xa44B\xa7X\xfaW\x95\xeaȿ\xb8\xf4\xca\v\x8bG\x1f\xfd0'\xbf\xf6\xd8\x1dE\x90\x17\r˺O\n\x89i\xd9H\xd3ȭ \xfd(\xa5\xc1\xd8\xf4\x0eP\x8a~\xa7\xad\x9d\xc4\xd9=8\xc5\n/=\xf5\xef8x\xff\x8fbZ\x16\a\xde\xf5A֯̑\xa5\tBH\x10\xe8\x9f\xd1\xc6\x00*ӏ\xf9\x16\xb34e\xea\xc0=\xec\xff\xd1\x0f2\u007f\xe2[l\xbb\xeb\x00WO\xbf\xc4\xd7\xfe\xe0\xb7Ȓ\x04\xd3rPh\xf1\xa4\xb2\xfc\xe0\xb53\xb3\xe1\xcfl\xfa\x19\x01R\xbb\xa2\xb9\xae\x11\xaf%Q\xf4\xb3\xa7\xbf\xfe\xe5o=\xf8ѿ\xc9\xf3\u007f\xf1\x87\xdf\xd3\xf9ȷ\x8a\x10\xdb\xee:<\xf8\xb1\x98%\tB\xe4\xb7\x17\x10B\xa2\x94BHI\xa1T\xa2X\xaa\x12\xf6ud\xa34R\xa7R\x9f\xe0\xdbO\xfd;\x0e?\xf8\b\x02\xd8s\xdf\xc34\xae\xbcF\x96&H)5\x17\x19\xb9\xde\x11R\x8b;\xdb\xc10\xf5s\x00Ӳ\bz-\xa4a\x91e)s\xcf~\x95\x1d\a\xef\xe5\xef\xfc\xda\xff\xcb\xc1w}\x00\xa569\x97\xb9Qa\x98&\x86iaX6\xa6eaY6\x96\xed`\xd96\x96e\x0f_7-\v\xc30\xf7;\x85\xc2\xe7\xef}\xdfG\x8f?\xff\x17\u007fȱw\xff\xb5\xef霌\xb7\x8a ^sM\x1fp}\xfc\x17\x85\x94\x15\xcbq\x91\x86\xa1\xc3P\xb9\xc2t\\\x17\xcbvI\x93\x98(\b\xb0l\x9b\xc9\xdd\xfbx\xf5/\xbfΎ\xbb\x0e\"\r\x83љ=\x18B\x90\x86\x01\x86i\"s\xdd38L\x99\x1b\x06\b\x81\x102\xff\f\x854M\xc2^\a\xd3q\xd8y\xf4\x01\x9a\v\x97X:\u007f\x06\xaf\xd9d\xff;\xdf\xc3\xf4\xc1c\xb4\x97\x17\xf4\xf7\x14bhvorh\x86ϯ\x0f\xcfl\xfa\xa3*B<\xba}\xd7]\x9f=\xf1\xb5/F\xb75A\x06\xabT\x1f\xff_M\xd34L\xdb\xde\xd8t.\xbe\f\xc3\xc40M\x928Be)ձ\t-\xba\xba\x1d\x8a\x95\x11Lˡ\xbe}\x9a8\xe8c\x98\x966\x87U\xa6]<\xa59MJy\x83,\x16\x03\xae1M\xdaKW\xa9M\xed\xa44:\x81\xd7X%\n<\x9a\v\xf3T'&9\xfa\xfe\x8fQ\x1e\x1dc\xf1\xdcI\xb2,\x0f\xb5\\\u007f\xf8߁(Rʉ$\xf0O\xad\xcc_|\xe5\xb6\x16Yy\x18\xbd\x00\x18j\x10\xe8\xd3v\v2?,!\x84V\xd0iJ\xa9V\xa761\xc9\xe2\xa59\xdcbq(\xbe\xb28F\x1af\x1e`Tã\xd7t\xdd\x14*\xd9|\x86\x03G3\xffË/<Mu|;\xa6\xe3h\x85/\r\xd6.ϱ\xf4\xea+\x1c{\xff'x\xe0\xa3?\x8d\xed\xb8Z\xc7Iy3\xf7\u007f\v\xc7\xdcp\xa8\x96\xf5\xde\xef\xf5\x8c\xe4[\xcc \"W\x93\xc8\\\x89#\xd0\x0e\xa1\x94\xb9\xf7\xac\x95gmb\n\xbf\xd7\xe1\xf2\xab/#\r\x13\xd34)\xd5\xead*\xd3:\x1b\xb5\xe9\xf0ņ\x98\x19\xbc\x98?\x17B`9\x05\xbd\xd9\xdcd\x8d|\x8f\xd0\xebᔫ\x83($\xa6S\xa0\xd3X\xa1\xb7\xbe\xc6އ\xde\xc7\xe8\xd4\f\xa6ej\x1da\x9a\xfa\xfb\r?c#,s\xb3\xe8\xb24\xec\xe3w\nA\x06l\x9d+b\x89\x94y\xc8\xc44\x91\xf9\xe6\xa4aR(WX\xbar\x01\xd3\xd2\n\xb4>5\xab\xbdu)\xb5_\xb0\xe9?\x04\xf9M\xbe\xf1\xc6JàT\x1f\xc7)\x94\x98\xdc\u007f\x8c\x99\xbb\x1f\xa026I\xf3\xdae&f\x0f\xa0\xb2T\x1fd\x9ab\x17\xcb4\xe6\xcfS\xaa\x8f2s\xf0^J\xe5*\xb6\xe3b\f.\xccu\xf11n\xf5\\\x8a\xf1;\x8b \xa6\x91+c\x89u\x13\xb1\xe0\x14\x8a\x18\xa6E/w\xe2J\xb5Q\n\xc5\x12Ґ7\x95\xddB\x88[Z\xf0*M)Tj\x8cL\xcd\xd2^\xbe\xca\xd2\xdcI\xea;v\x13\xf6\xba\xd8\xc5\x12\xa6e\xe7Ρ\xd6EA\xafC\xe8\xf5\x98<|\x1f(\x85\xeb\x16(\x96ʔ\xabU\x8a\xe5\xb26\xb1_W\xb1k+\xfb\x8e\x12Y\x86a\xa1\x94\xf69\xa4ms\xbd\xe07-\a\x81\xa0\xd3X\xc5v\x1cFƷk\x0f9\xb7\x9e6]\xd5\\:\xdd\xfap\xd2$\xc1*\x16)TG\t\xfb]\"\xafKkq\x1ei\x9a$Q\x88aڨ4\xcd\t\xa2\xff>\xe8\xb5\x19\xd96M\xa1ZG)\x85a舂e\xbbC}\xf6]\x10\xe5\xf6'H\x9a$\xa0\x14Fn\x8a\x1a\x96\x891\x90͆\x1cf\x02\x1d\xb7\x80R\x19\x81ߣP\x1e\xc1-Un\xa4\xeb\x96ø\xf5\xc1\x18\x96Eom\x19\xb72Bmr\x16\xb72\x82](Rݶ\x834\nI\xa2\x10\x04\xa84\xcbu@\x86\xdfi\xe1\x96*\x94F\xb7A\x96\r/\x8c\x10\x02\xd30s.\x11[\xac\xae7j\x99o%A\x94\x90\x90\xa5\xda\xea\x91rx\xe3\x05 \xa5IB2\xb4\x8a\xb2$!\x8b\x13J#uL\xcb&\x89\xa3\r\xc7m\xa8\x8a\xbf\x8b`\x83\x10X\x852\xebW/\x10\xf9}\xea3w\x91\xa5)\x8d+s\x04ݶ\x8eU\tPY\xaa\x9dS!\x88\x02\x1faZ8\xa5\nJe\b\x8c\r\x05>\xfc8\xb5\xe53D\xae\xd0s\xaazw\x04A\x06\x9b\x91\xd2\xc00\xb4\x03'\x86\xd6J\xbe!\xa1\xe3WI\x1c\xa1\x00\xb7PB\rS\x82\x02\xb1){$\xbe\x8b\x1b\xaa\xad,\x97\xf5\xab\x17t\xfa\xb7\xef\xe5\a\x97\x81\x02c(2u\xca\x17!\xc8\xe2\b!\xc0)Ws\xd3\xfa:\x9ff\xc3\x0f\xbd\xf9\xc5C\x9d\xbd3D\x96J\x87\x9b\x1az؛|\x85\x8d\x83\x96\x84A\x1f\x94\xc2r\v[w\xff\xef#\"\xf2\xb8\x93[\x19Ae\x99\x8e$g\t\xa8t\x18\x80T\x99\xbaA\x17%qD\x96\xc6T\xc6'\x87\xbae(\x02\xa5\xfc.t\x87h\xdc\x11\x1c\"2%\xb4\xc1\xcaV\xe5,\x052\xb7^\xb4\t+h7V\x11R\xe2\x16K4\x16籝\x02\xa5\x91\xd1\x1b\x0e\xe8u\xef\xa9RԧvR\x99\x98\xe6\xe0#\xdb\t{m\xd24!\x8dB\x82^\x87\xce\xda\x12I\x1c \xf3{\x99\xc6\x11\xa6[\xd0\x11\xdf(\xa1T\x9f\xd8*\x9a\x06\x8a\xfc;\x12D\xfd\xe5\x1dA\x10)T6\x88]m6U\x85.\xab\xda\xc8\u007f\x1b&\x81\xd7\x05 \x0e\x03\xba\xeb\xabHi\xb0\xf7\xf8;\xfe\xbd,\x9b\x99\xbb\x1fd\xe7=\xef \x0e}^}\xfek\xac\\>\x8fR\x19cӳ\x1c~\xe7\xfbؾ\xff(\x8bgO\xd0^\x9e\xc7\xc9\r\a\x81 \xcbR\xe2Ч4:\x8e\x90[\xa3K\x834\xf3 q\x95n\xaax\xd90zշ\xef\b\x82\xc4q\x86m\x9b:\x02\xbb)\x94!\xa4\x1cFe\x01]\x15\x92eڣ7\f@\x90\xa6)~\xafM)7E_\x9f7\xe0\xae\a\xde\xcb\xc4\xee\x83<\xfeo~\x8d\xa7?\xf7\xdby4\xd9ȫ\x1b2\xbe\xf8\xaf>\xcd\xc7\xff\xc1/s\xf0]\x1f\xc0-\x8f\xd0Z\xbe\x824L\xa4a\x90\xa5)q\xe0S\xac\x8fa\x15J\xa4\xb1ί\xa7I\u0085\xb3\xa7h\xaf7\x10B026A\xb5>z\xe3\xf7Qj\xe5N\xf1C\xb2\x81\xb4\x92B\xa0\xb2\x94\x85\xf3\xafr\xea\x9bOp\xfe\xe4\x8bڒ\x02\x1d\xd8\xcb\tf\xd9\xee\x86\xc2El(\xf8[\x90B\x00\a~\xf4Ô\xc7&\xf9\xec/\u007f\x8a'~\xef7tH\x1e\xa1\xfd\x8d,\x05!\xf0\xfb=\xfe\xf5/}\x8ag?\xff\xff0\xbe{?#\xdbf\xf2\xf7ב\x82\xc0\xebb9.\xe5\xb1m\b\xa0\xd7i\xf1ҳO\xd3XY&˓VkK\v,\\\xbe\xa8\xc5\xe8fΕr\xed\x8e \x884e\x96W\xb7\x01pu\xee\f\x9d\xf5Ujۦ讯2?wFsK\x9e\x99\x13RP\x19\x1d\xc3r\x1c,ץT\x19\x19h\xa0\x9b\x92E\xa5\x19\xd3G\xeegd\xfb\x0e\xfe\xf8\u007f\xff\x1f8\xf7\xdcW\xb1l\x97\xd8\xf7\b{\x1d\xc2^\x9b\xb0\xdb\"\xf2:ڃ/W\xf9\xcag\u007f\x83\xab\xa7_\xa2\xbec\xb764P 7\x8a\xefJ\xb5\t\x02\xdfc\xee\xccɍ\x1cN\xaeG\x06\x950\xa6\xe5lN\x03\xac\n!\x92c\x8f|\xf8\xf6'\x88!\x94P\xba\x9c\x00\xaf\xdb\xc6\xeb\xb6\xd9}\xf0\x1e\xf6\x1c\xbe\x97G?\xf13\x18\x86d}y\x81Ae\x9b\x00\f\xd3f\xf6\xf0\xbd\xec>r\x9c\xce\xfa\x1a\xcbW\xce\xeb4\xefMt\x89\xe9\x16\xb0\x8be^\xf8\x93\u007f\xc3\xe9o>\x81\xe5\x16\b\xba-\xe2~\x8f4\x0eɒ\x98,MH\u0080\xb0\xdbDe:-\xfbܟ\xfd>B\b\xdcJm\x18'\x1b8\x83\xd24XY\xbc6tj\xc3^\a\xbf\xdd \xf6\xbd\xa1.L\xa2\x10\xb7P\x1c\x88\xaeˀz\xe5\xe9\xc7\xee\x00\x91\xa5\f\x81R\x90e\xf8\xbd\x0e3{\x0eR\x9f\x98$M\x12\"\xdf瑏\xff\f+W/m\xaaF\x14D\xbe\x87i٘\x96Mg}\x85,Nna\x02+,\xc7!\t}^}\xf6I\f\xd3$\xf6\xfbdqt\v\x8bX\x11v; \x04\x97N\xbd\xc8\xd2ų\x94Ƿ\x91e\xb9\xf8Q\xba\x94.\xec\xf7\xf1:\x1d\x9d\x97i\xae\x91\xe4Eyq\xbfG\xd0i#\xa4\xa4\xdbjR(\x97\xf3\b\xb4X\xb9\x05\x03߆\"\xcb0T\x96e$I\x8caX\x8cLl#\xcbR\x84\x10\xf8^\x97\xe2H\x8drm\x94n\xab1\xd4\x15Y\x9a\x0e*Aپk\x1f\xa3\xd3;\xf3\f\xe3u\x1e\xb3\x1a\xf8u\x82\xc6\xf2\xa2.\x81\x8f\xc3\xef\x10ʉȢ\x88~\xb7Ek\xe9*R\x1a\xc3ht\x9a\xc4\b\t~\xb7M\x18\x06\x84\xfd\xee\r1\xc34\nH\xa2\x804M1\r\x03\xa1\xb3짳,M\xef\b\x82\xac\\8\x13*\xa5\xe2$\x8e\x91\xa6\x89\xca \xcb\xfd\n\x95e\x04^\x8f\xf1\xe9\x9d\xf4;-L\xd3\xce\xeb\xa1tܨ\xd7Z\xc7r\x1c\x9cBq\xeb\xfdS\xb9\xc2W\xba\x90.\x8dc\xcda\xb9\xb5\xf6\xfa\x81\x03A\x92\xe82R\xbf\u05fb!\xa8 \x04x\x9d&q\x1c\xe5\x9c&n0\"\xb2\xfc\xf3\nձ\x81\xf7\u007f)\x8d\xa3;\x83C\xf4\xc1\xa7\r\xddF\x90\x0eow\x1e\xb1 \xec\xf7(VjD\xbe\x9fW\nf\xc4yi\xe7\xc2\xf93\xac\xce_\xda\xe4ѫ\xadN\xa2\x10\x18\xa6E\x1a\x87H)\xbe\xbb8\x97\x0e\xb0a\x98\x16\xe5\xfa8q\xe0o\xf8\x1dy|*\xec\xf7_\xc7\xcc\xd6\u007f\xd3Y_c\xe6އ1m\a\xa5\xd4\xc2\xc9g\xbe|\xe7\x10\x04!^\xd3E\xcd\x10G\xc1P\x81\x83 \xcb\x14Y\x12\x93$\xf1Pd%q\x9c\xd7m\x19x\xaduT\x96\xea\x826\xa5\x86\xd9\xc5\xc1\nz\x1d\xd2$\xa1\x90g\x02\xa5\xf9\x9d\xdd,\xc3\xd4ɰ\xea\xc4v\xfa\x9d\xf5a\x15̀\xf9\fA^ol\xdc\xca\xdb%MS,\xb7\b\nO)\xf5Wj*\xf9~$\xa8^\x8c\xe3\b\xa7P\xa4\xb9\xb2\x84a\x1aC\xddl\xd96ݦv\xba\x1cW[-I\x12c\xb9\x05\xb6\xcd\xde\xc5\xc8ĤV\xba\xd7\xddX\x85B\t\xf2\"\xb7\x98Jm\x14\x10\x18\x96\xfd\x1d.\x87İ,\xca\xf5\tF&\xa6\x88\xfb\xbd\xbcrR!\fCG\u07b3\x04\xd34\xb16\xac\xa8-\x1cbX\x0e\xb6[`d\xfb\x14\x91\xef-\vA\xe3\x8e\"\x88\x10\xe2\x994M\x86\xb7\xbc\xd7na\x98\x16I\x12c\xbbE\x16/\xbf\x86S(b\xda\x0e(E\x16G\xa84\xa1\xb6m\x92\x91\x89ɍ\xca\xf5\xeb\x0eG\xe4zH\x87Ff\xa8Tk\x18\xb6\xab\x89r\x13\x91\xa3\x94\xc2.\x95AH\xf6\xdc\xf3\x10n\xa1\x98G\v\x06id\x032]ϵ\xf3\xc0QL\xa7\x80](\r\xfd\x13\xa5\x14v\xb1\fB\xb0\xe7\x9e\a\t{\x1d\xa2п\xaa\xd2\xe4\xce\xe2\x10\x89|Re)Aߣ6\xb1\x9d\xf9\xb9Ӭ-\xccc\xbbE\x9e{\xec\xdf\"\x94\xa04R\x1fZR*\xd3!\x0fô1-M\xa4-\xb9\x87\xebV\xaf\xb1\u0081\a\xde\xcbH\xbdF\xa1X©\xd61\xdd\xe2\r\x01B\xbbX\xc1t\nTF\xc7\xf9\xf0\xdf\xfd\x87\xb4V\xae\xe5\xedq\xda_\xb7l\x97\xd0\xef\x91&1\xef\xf8\xd8OS\xac\u0530\x8ae\n#cإ\n\xc5\xfa\x04\xa6[\x00\x05\x1f\xfa\xdb?ω\xc7\xff\x18\xcb),\xbd\xfc\xd5\xc7:w\x14A\x1aW皀\x17\xf9}\xe2(dr\xe7]\\\xbbt\x8e\x17\x1e\xff3Z\x8d\x15Ʀfp\v%\x9c\xfc\x10\xd34&\xcd+\x14\x85!swQ\f\xe5\xfc\xf5\xf2<\xecwQ*c\xe7\xe1\xe3Tkul\xdb\xc1)W)\xd6\xc7q\xabu\x9cj\r\xabT\xa521ɱG?\xcc\xcf\xfd\xe6\x9f\xd0o\xaf\xe3\xb7\x1a\xa8ܬU\x80\xe9\xba\x04\xddu\x92\xc0g嵗\xf9\xb1\xbf\xfd\xdfb:\x0e\x19\ni9\xa4\x89.G\xfa\xf1O\xfdc\xfcN\x93K'\xbe\x95\x19\xa6\xf5*\xc0\xb1G>tgd\fGw\xeee}\xfe<\x02\xf1R\x1c\x85\xef\x8e\xc3\x10\xa7PdǞ\x83$i\x8c\xeb\x14\x11\x86\xc40-\x9cB\x11!\x04a\xe0\x93\xa5)H\x89Pr\x18\xba\xdf\xec\xa9o\xb6\xb6\xa44h-\\f\xfa\xc01\x96/\x9e\xc3v\\\x82\xbe\xc7zc\x95$N\x90\x86\xc1\xfd\x1f\xf9\xeb<\xf0\x91\xbfɶٽtז\xe8\xae-\x10\x87a\x9e\xc1Ԝg\xbb\x05V/^B\x9a&\xcd\xc5+\x8c\xcd\xee\xe7\xa7\xfe\xfb\xcf\xf0\xfc_\xfc\u007f(\xa0\xb6m\x9a\x83\x0f=\n*\xe3\xd9\xcf\xff\x0e\xa6\xe5$*MN\x03\xbc\xf2\xf4\x97\xee\f\x82\xacϟ\x1f\x88\x8c\x97\xb2,{w\xbf\xdb\xc2q\vH)q\xed\x12\xb6\xe3\x12\xfa\xbaQ\xc6r\x1c\xa4a\xe2\xf7:\xba\xcd@ț\xe8\x82A\xe7\xad̛7ɓP\x19~\xa7\x8da\xd9\x14*#\x8c\xef؍]\xba\x82S\xaa\xf2\xbe\x9f\xf9\xfbl\xdfs\b\xaf\xdd`\xf5\xe2\xabx\xed5\xa2\xbe\x87\xe5\x14\x86\x05xBJ\xacB\x91\xe6\xd5\xcby\x04\xd8\xe4\xc2\xf3O\xb2\xe7\xc1\xf7\xf3Ώ\xfd\x14\x8bs\xa7\b\xfb=N>\xf9'\xb4\x97\xae\x91&1\b\x11\xa7I\xf8\xd2_\xf5\x8cL\xbe\x0fKH\xf9%\xa1\xd4\u007f\x1d\xf4\xfbDa\x88eۺ\x17ô\x10@\x9a%H\xc3\xd2\xca>\x8eH\xc2`\xd0\x14x\x13\xdfBl\xf2\b\xb4n1L\x93~\xb7\x8d\xed\x16y\xf8'\xff.\xa6e!\xf3\xe2\x84Le,\x9f?I\xd8\xf7t\x90q\x90IT)\b\x85R\x02Ӵ\xb1l\x8b\xf5\x85KC\"!$\xe7\x9f\xfd2\x81\xdf'\fB2\x95\xe9v\xeb\r6M\x96N\xbfx\xe1\x8e#\xc8\xf8\xee\x83di\xfa\xaa@,#\xc4\xf6Ns\x8d\xd1\xed\xd3\fB*Y\x96\x92\xc6\t\x86a`9\x0e*\xcdtX^\x800\x8c\xeb\xe8\x91{\x94j\xc39\xb4l\a\x80\xa8\xefQ\xaa\x8f\xe3w\x9a\x04\x9d&\x86\xe5`:\xda(\x88\xa3\x10\x95\xa5y\x1b\x83Ȼz\xd3\xdc\x1dR\x18\xb6\x83R\xe05V\xb6\x88FiZ\x98\xb6K\x9a\xe7\xd9\xe3(\"M\x93\x81\xc7{n\xb5\xd1H\xfe\xeaF\xcf[\xbcLӤR\xa9_@\x88\xcf\x03$Q\x84\xdf\xeb \xa5\xd0\x0e\xa1RÄP}\xdb4i\x9a\xe0\x94+y{\x80}\xb3(\xe1 ~\xa2uO\xa9\x8a[\x1ea\xed\xea%\xc6f\xf6\x90\x84\xbe\xf6g\xa2\x80\xd0\xeb\xe9\xdeA\xa5\xb6\x18\x05J)\xb2,\x0f\x86)0\x1d\x87\xd0\xeb\xea\xf8\xd5uQ\xe5\xcd\xe1\x98\xcd?+\xa5\xfe\x10\xe0\xc8;\xdf{g\x11di\xee\x14\x97O?\x9fY\x96\xf5\xab:2\xaa\xe8w\xdb$Q\x8cJu\x1e$\xf0=\x928flj\aQ\xe0c9\x05T\x9a\xe5\xf5\xb5\xf2\x969mӲ\x91\x86I{u\x914K\x19۱K\xff\u007f\x86q]\xed\xef\xf5\x04e\xe8[ \x04N\xb1L'\x0fP^\xef\xbbdy\xcb\xdb\xe0q\xc0\x99I\xe8\u007f\x01\xe0\xf4\xb3O\xddY\x04\x01\x18\x9b\xdd\xcfꥳs\xa6e\xfe\x14B,'qL{}\x854\xe7\x90\xd0\xf7h\xaf-\xb3}\xe7\x1e\xfa\xbd\x0eQ\xd0'Mu8E\xa7t_7\xa2L\xe3\xda%]~Z\xa9\xa1\x04\xc3^\x91[\x11R\xa3?\xa8a\x9e\xc5.\x14Y\xbfv\x01%\xa4\xb6\xf06\xa1D\f\x02\xb9j\xab\xfe\xe0\xe4\xd7\x1f?\xf5\xc6\xf8i߇ո\xf2\x1a\xa3;\xf7Ҹ<\xf7\x94a\x18\x1f\x91R>\x93F\x11\xed\xc6\n^\xb7\x85\x10\x92\xb5\x85˔*u\x84\x94\xac]\xbb<l53,\xfb\xa6!\xc3A\x03g\x12\x85\xc4a\x80\xe5\x16Pi2L\xfd\u07b28Bl\x8e\xee\xe6ՉN\x81\xce\xca\x02\xa1\xefm\xc4\xccr\xee\xd8\xe0\x90tH`\xa5t\x95ɑw\xbd\xef\xce$\xc8\xc0\x04\xce\xfd\x92\x97\f\xd3\xfcqi\x98\x9fM\x93\x04\xafӦ\xdb\\\xc3\xeb\xb6\t\xfa=J\x95\x11:\x8de\xbc\xf6:R\x9a\xb9\xf8ٚ\x9c\x92\xd2\x18\xf6\x15J)ɒX[ha\xb0q\x93ŭ\x882\xe8\x114\x86f\xb3aZ\x04\xdd\x0ev\xee\vm\xb6\xed,\xdb\xde\\\xa18\xc0Z\xf9\x03\x80\xd3\xdf|\xf2\xce%Ȁ(\xe3\xbb\x0eи2\xd7m^\xbb\xf0IӲ\xfe;\x94\x8a|ϣ\xb3\xbeFc\xe9\x1a\xb5\x89)ڍUګK \xb5\xa5#\a\x87\x97\x8b\"a\x98\b\x04i\x92b\x15J\x94\xea\xe3x\xad\xc6P\\}\xa7\x9c\x88\x10B\x87A\xf2<y\x1a\a\xc4a\x1f\xdbv\xb7T\xe6\v\xc0u]\x8a岶\x00\x87\x81\xce\xecKoԙ|_\t\x02\xb0v\xf9\x1c;\x0eޓ\x8b\xb2\xb9\u007fi9·\xa4\x94/\x84\xfd>\x17N\xbe\x88\xe58\xb4V\x97\x89À\xa0\xd7խlC\xdf@\f\x1b>\x85\x91\xf7\x8e+\xc5\xce#\xf7\xd3ﴈ\xfa\xfd\u05ef\xe3R\x19\x86\xe3b\x17+C\vδ]B\xcf\xcb\x0f;\xbb!!%\x10X\xa6E\xb9:\x9a\xe7\xc6\xd4Y!\x8d\x85\xbb\x1f~\xff\x0f\x06A\x00\xae\xe5\xd0\x14c\xbb\xf6Ӹ\xfc\xdaW-\xcb\xfa\xa8a\x9a\u007f\x14x]\xae\x9d?\xa3|\xafCc\xf1\n\x91\xdfͽ\xf8B\x9e@\x12\b\xc3D\x9a&\xa6\xe5\xe8\x02\xb6,\xa5T\x1bE \xf1{\xed\xd7!\x886q\r\xcbʓ^\xda\xec\xb5Ke\"\xbf\xa3\xf5E\xbaŬ\xd5V\xf1Po\f\xd3\x00\xcfeQ\xd4;\xf5\x8d'~p\b2T\xf6\x975B\xd0ڕ\xb9\x95\xd6¥\x9f,\xd7F\u007f:\x89b\x01\x92\xd5k\xf3,̝\xd5=\xe5\xb6\x0e\xab 5 \x80\x94\x06\x86mcڮ\xf6\xc8\r\x13\xa7X\xc4\xef\xb6o-\xb2\x06j`\xe0\u007f\xe4\x87^(\xd7\b:]T\x92l\xb1\xa4\x06\x95\xf1(\x85\xb4,\x92\xa1\xc1\xc07O<\xfd\xc5\xf0\aFd\xddjM\x1f\xbc\x87\xf6\xea\xf2\x1f\xc5Q\xe8\t\x01\xad\xb5ez\xcdU\xae͝\xd6`3\x85\xd2P\xa1˼\x99\xc7\xc8!3P\x8aB\xb9\xaa\xfb<\xca#\xb7.U\xcfq\xb76YK\xd8\xc5\x12A\xbf\x93{\xe0\xe2\x06\x13W\n\x81\xe5\x16\x89\xfa\x1eH\xb9\x8aR/\xbf\xb1\xe9\x89\xdbt\x15+#Dq@\xe8{\x8f\xeb0E\x80\xd7i\xd3\\\x9a\xc7\xef\xb61m\a\xa7T\xc1ȹŰl\x1d 4u\xdc\xca-\x95\t\xbc\x8e\xae\x9e\xdft\xe0\x83\x82\x88AX,\x1b\x86>\x14R\bL\xc7%\xe8\xb4Ȓ\xf8\xba6u\xad\xfc\xa5e\xa1\x10\x04~\x1f!\xc4r\xd8\xef\xbd\xf4\xb6 \xc8\xdc\vOS,UR!\xc4W\x00\fi\xd2X\xbaJ\xbf\xd3b\xed\xda%\x02OGhM\xcb\xc6)\x961rd\x05]A\ba\xdf\xdb\x02(\xb0YRm\xee0\xc9\x06\xf8%Ja\xb8.R\xea$\xd7\x10\t\xe8:_\xc7t\x8ax\x9d\xd6\x00~\xe3\x853\xcf>\x19\xbc-\b\x02мvI\x19\xa6}RH\x99(\x14I\x1c\x93\xa5)\xf3\xa7^\xe2ʙ\x97h.]%S\x19Ҳ5\x04F\x8e\x1c\xa42E{u\x89\xea\xf8\xe4\r\xf9\x92\x1bD\x96R\xbab8\xcb(T\xea$Q\x88\xd7\\%\xcf\xd4\x0f\x89\xa2\x01l\f\xa4eⵛ\xda9L\xe2\u007f\xf9F\xef\xf9\xb6&\x88>\xcctmPM\x1e\xf4{4\x96\x17@\bV\xaf\x9c\xe7\xd43_\xe2\xf4\xd7\x1e#\x0e}\xecR\x05+o\xf6\xf7\xda\xeb\xc4Q@\xa96N\x1a\xc7\xc3\xd0\xfcM\x15\xfb&\xa0\xcc\xd2\xc8\x18\xad\xa5y\xa20O\x8a]\xa7z\xecb\x19\xbf\xd7#\x0e\x03P\xea/O|\xf5\v\xa7\xee\xfb\xe0\xc7\xdf\xd8\xe0\xebmO\x10T\x1bh\x02\xd3J)\xd6\x16\xae\x10\xf6=\xa6w\xefG\x9a&\xed\xc6\n/\u007f\xe5\x8f\xd9y\xe4~\xea\x93;X\xbd<\xc7\xe5\x93\xcfS\x9f\x9ce|v\xaf\xbe훕\x81Ry\xde|P~$\x879\x91\xf2\xd8\x18\xe7\xbf\xf8$R\x1aC\x93w \xf6,\xa7\x800-\xe2\xb09\b\xa3|\x06 \xce\x13jo\x1b\x82X\xa6\xe3\x87I\xdf\x1b\x1c\xaaa\x98t\xdb\r.\x9d\v\x98\xddw\x18\xd3v\xe86\x1b\x9c\xf9Ɨ\x11\xf9M?\xfa\xbe\x8f2}\xe0\x18^sMw\xd9\xde\xcc\x05\x194p\xa9\x8cL\xa5\xb8\xe5\x1aB\x1a4.\xcfi4\x06)\xc10Ae\b\xc3\xc4,ꊓL\xb7P_\x01>\ap\xf2\xeb_~{\x10ĩi0\x84 \xe8\xbf\v8\xbe\xe1\\g8\x85\n\x91\xefq\xe9\xecIf\xf6\x1e\xc2)\x96H\xa2\x88\xda\xf6i\xde\xf9\x89O\xa2TF{i\x9e4\x87\x81\xba^ol\x05\\\x16HaP\xaeM\xe0w\x1aġ\x8fik\x84;\xa9\x14\b\x03\xabP\x1a6\xec\xf8\xbd\x0e*S\xffD\r3S\xbc\xad8D\x02\xff\x13`Ki \fI\x1aE\xa4qH\xb1Z\xc7\xf7\xda\\\x9d;C\xb1:B\xe8\xf7i,]e\xef}\x0f3>\xbb\x97$\n\x87u\xc1\xb7\f\xf1\xa2\x01kLۡ0R\xc3\xefv6\xe9\x8b\xd2P\x94\r\xc2\xf7\xad\xd5e\u00a0\u007f\x12!\xber\xe2k_x\xd36|[\xae\xb0\xb5\x06\xf00p\x1fy\xa3\x8c\xe5\x14pKU\x928\"\xf4{\x14\xab\xa3d\x99\xa2\xb5\xba\x8c\xdf\xeb\xd2n\xac\xf2\xc2c\x9f\xa3\xdfiQ\x9f\xde=\xacf\xe7;\x84\x17\xadB\x11\xb7\\\xa11\u007f~S\x0e]\xe4)c\x8d\x16\xb4\xbe\xbc@\xaf\xd5H\x84\x10\xbf\xfb\xf2\x13\u007f\xb6\xf4f\xde\xc0\xdbV\\\x01\xff\xe5P\xb1\n\x89Db\x17KX\xb6K\x12\x85\xa4QD\xb1Z\xd3\xf1(!H\xe2\x88\xe5+\xe7\xf9\xfa\xe7~\x9b\xf6\xea\"\xe5\xd1m\xaf\a66\x8c\xeeZN\x01\xcb)\xb0v\xf1՛\xc21\xb5ז鮯\x82\x10kA\xb7\xf9[o\xb6H\xb8]\xb9\x03\xe0=\x83p\x85\x86q҈AneD\x97\by\x1d\x92$\xa6X\x1d\xd3>\x88\x80vc\x95\x85\vgy\xfe\xcf\xff\x80\xb0\xefQ\xac\x8d\xdd\xd4%\x1c\xbc$\xa4\xc4)WX\xbfv\x91~\xbb\xb5\xc5\"\x13Hڍ\x15\x1aK״e\x95ƿ\xf2귞i\x1f{\xf4\xc7\u07b4\xbd\x1b\xb7\xab\xc8rj\xe3&\xf0i-\xe7\r\x1c\xa7\x80\xed\xba\xc3̠a٤Q@\x1a\x87Xn\x01+\xaf\x14QiJ\xe8\xf7\x89\xfa\x1e^k\x8d\x99\xc3\xc7q\xcbU\xa2\xc0\xd30\x19y^]\xe6\xe8\x11\xa6\xed0\xbe{?\xe7\x9f\u007f*w\b5)\x844\xf0:-\x1a\vWt\xddW\x9a~\xed\xe5'\xff\xe2S\x00+\x97Ͽ\xbd8$_w\x0f=d!tB(\x8f\xb8Z\xa6\x85\xe3\xba\x14\xaa5T\xa6\xf0;M\x84\x908\xc52\xb6[@\bA\xb7\xbd\xce\xe2ų<\xf3\xb9\xdf&M\"F\xa7\xf7P\xaaM\xe4\xe8\xd5)I\x1a㔫\xec}\xe8\xbdD\xfd.\x8d\xcbs[B$\x91\xdfg\xed\xda\xe5\x01@g\x9ce\xe9\xdf\x03\xbegp\xcb;\x9eCL\xb7\xf8a\xe0\x13B\bܢ\xaej\x04p\xdc\x02\x13\xd3;\x18\xa9\x8f\x13\xc7\x11\nE\x14\xf4\x89\xc3\x00\xd3qu.=\xcbH\x93\x98~\xb7\x8deZ,\x9c}\x85Le\x14\xcb#\x14\xaa\xa3\x1a\x11\xc8-R\x19\x9f\xa4\xb7\xbe©'\xff\x94$\b6\xfaB\xb2\x8c\xe6\xea\x02Q\x10\xe4\xa9\xe1\xf4_\x84\xfd\xde\xe7\xb7\xed\xbcK\x9d\xfa\xc6\xe3o\xee\xbeoc\x0e92\xb8\xadF\xdexcY\x16\x93;wQ(\x96@\x89a\x8d\x9c\x90\x06\xbd\xe6\x1a~g\x1d7\x87s\x12R\x12\xf5{\\\xbdp\x96\xc9ٻx\xed\xb9\xa7x\xed٧(\x8e\xd4ٶk\x1f\xa5Z\x1d\x81\xa0\xbb\xb6\x884\xf3\xaa\xc6<Ǒ&I\xde/( \xcb.\xa4I\xfa\xaf_}\xeek\xd9[\xb1\xe9ۙC\x1e\x1d(u\xcbv0M\v\xc7-\xb0m\xc7l\xae\x8d5\x81\xa20\xcc\xf1\xb6\fB\xcf#\x89\x02\x8d\xa7\xeb\xb8\b)I\xe3\x88~\xb7\x8d\x02\xca#u\x928\xc4k6H\xa3\x90\xd0\xeb2\x00\v$\xcbt`\xb1\xbdN\xb7\xb5F\xd0\xf7\xc8\xe28K\"\xff\xc7O>\xfd\xa5Wު}\xdfv\x04qF\xc6HC\x1f\xd3-\xbe\f\xfc#\xd0\xc8\n\xb6\xa3\xa7$\xd4F\xc75\xd6V\xdeSX(\x94\xf0\xba-\x10\x06B\n\xe20 \x8d#l\xa7\x80\xb4l\x84\x94$QH\xbf\xd7!\x8d\x13\x8a\x95\x11]U\xef{$QD\x14xx]]\xe9\xd2]_#\f|\r5\x1eG^\x12\xf8\x0f\x9d\xfa\xe6\x13/\x1e~\xe8Q]\x8a\xf4v$H\x9a\x8f\x89\xb0\xdcbM ~\x0e\xa5L!%\xb6\xab\x91E-ۦT\xa9\x0e\xf3\x1e\x96m\xe3\x16J\xf4z]T>m!\x0e\x03\x92(\xc4r\x1c\xdd\xe4\x83\xee]\xec{\x1d\xa2 \xc0.\xe4VY\x96\xeab\xee8$M\xd3A\u007f\xfaB\x96$_\x88}\xef\xc7\xce<\xf7\xd4\xc5#\xefz?g\x9e{\xea-ۿ\xb8ݸ#l7pF\xc6>\b\xfc&\xb0\x0ft\xf3\xe6H}B\x8f\xb6p\x1cfv\xed\xa5Z\x1fՇ\x88B\b\x83^\xb7\xcd\xe2\xfc%\xd24!\xe8\xf7i7\x96\x91ҠP\x1e\xd1\xd8&\xdd\xd6p\x04\x85](zc\xdbw|attL\b\xa1\x88\xc2Hd*\xf3\f\xcb\xfe\xa6\xca\xf8\xfa\xc9g\xbe\xf8\n\xa0\x8e\xbc\xf3}\x9c~\xf6ɷ\xf4\f\xc4m(\xb2v\x01\xdf\x04\xa6\x18Hx\xc3\xd0xY\xd5\x11\xad_L\x8b\xda\xd8\x04\x93;wm\xf1\xc4[\xebk\xac.ih\x8e^\xa7I\xa7\xa1Ay\n\x95\x1a\xfdNss\xf6p\xd1v\x9c\xbfV,\x16\xe7,!\xa4iZ \xc8.\x9d;=\f\r\xdf\xfd\xf0\a9\xf5\x8d\xaf\xbc\xe5\xfb\xbf\xedD\x96\xe9\x16\u007f\x01\xf8\xe8\xc0\xc2\xday\xe8\x18Y\x1a\xe3\xf7{ā\xafE\r\x10E\x01~\xbfG\xa1\\\xd1X\xef\x80[(\x12\x87\x01q\x1caZ6i\x9a\x91\x84\x81\xc6%\x11r\xe8\xa9\v)C)\xcd\xcf5\x17._\xe9\xb4֓\xd6\xfaj\xd2j\xacnA\x19X\x9d\xbf\xf0}ٿ\xbc\x9d\xc4U\xbe\xde5\xbc-\x96Eut\x82}\xc7\xdf\xc9Ԯ\xfd\xa4ILkm\x99\xa0\xdf#\x8e\"\xba\xed6\xd7.Α&\xba\a\x11!\x98\x9c\xd9E\xa5Zöl\xca#u\xdc\xcaH\x9e\xaaݰZu۳\xb8-\x87j\x1a\xb7\x11g\f\xac\xab_\a\\\xa5\x14\xe5\x91Q\x02\xafG\xa1\\\xe5\xc8;\x1e\xa1>9C\xbfݢ\xb9\xb2@\x12GyG\x94\xa2\xdbnb\x98&\xa5r\x05\x94\xa2X\xae\x90\xa9\x8c$\x8aH\xb2\x14i\x98\x1a\xa5a\x83\x06}\x94\xfa\x83\xd8\xf7\xae\xfe\x90 \xafc]9#c\xb3\xc0?\x1e\x88\xabJ}\f\xf7\x9b\xba\x19\x00\x00\v\xa1IDAT\xb2\x94\xd0\xefa\n\xc1\xec\xa1{9\xf4\xd0{ر\xffn\x16.\x9c\xa5\xb3\xbe\xacg\x10\x1a\x06\x9dv\x8b^\xbbE\xa9RŴ,l\xdb&\f|\xbcNg\bǑ\xc6\xd1 x\xe8K)\u007f\xff\x87\x04\xb9\xc5\x1a\x9b\xb9\v\xbf\xd3\xc4t\x8b\xef\x01~z`o\x94*Ձ\xcc\xc7q\x1cR\xdf#\xf2\xba\x8c\xed\xd8\xc9=\xef\xfd(ձ\tZ\xab\x8b4\x97\xaei\xf35MX[^\xc4\xf7<lǥX\xae\xd2i5\xc9T6\xc4\xfe\xcdgL\xfd\x90 \xaf\xb7\xfc\x8e.\xab1\v\xa5#\xc0O\r\xe4\xbc\xe3\xb8\xc3qRI\x14Q\x1d\x1d\x834\xa1\xb7\xb6D\xd4\xf7\x98ع\x87\x83\xefx\x0f\x95\xfa8a\xbfGcq\x9e\xc8\xf7\x89\u0080\xd6z\x83\xbe\xd7\x1d\xea\x0eӴ4x\x99α\xfbB\xca\xdfO~H\x90\x8d\xf5\xf0O\xfc\xa7\xfc\x8d_\xf8\x15\x9e\xfd\xd3\xdf\xe3o\xfd\xe2gv\x86Q\xf4\xe9\xf6\xda\xf2\xcf\x03\xe5\x81\xc8J\xd3\x18\x99c\xfcf\x99\xc2\xf7zT\xeb\xa3\x18\x86I\xec{x\xeb+D\xbd\x0e\x93\xfb\x0es\xf0\xc1\xf7\xb0\xeb\xf0q\xb24a\xf5\xda%¾G\xe8{\x907\xf1\xf8^\x97(\xef7\x04|!\xc4\xef'A\xff\xb6#\xc8\xf7\xd5\xd2\xd8~\xe0\x80\xfc\xb1\xff\xe4\x17~\xb5P\xad\xfd7*\xcb\xf8\xdcg\xfe\t^\xa7\xb5\xa5Iưl\x9cB\x91B\xb1\x8c\x90\x92Re\x84\x1d\xbb\xf7b\xd9\x1b\r\xa0Y\x9ab\x17K\x8c\xcd\x1e\xa0<\xb6\x8d4MX\xb9<\xc7\xd9\xe7\x9ff\xf1\xe2Y\xa2 \x18\x8e\xc4\xcb\t\xb2f\x98\xe6\xc7\xfa\x8d\xe5\xe7&v\xedg5/\xf2~\xdb\x13\xe4g\xff\xe9o\xfd\xf3J}\xec\x17\xb3,C\x1a&\xdd\xe6\x1a\x8f\xff\xeeo\xb0z\xf5\x92\xee\x03ɫ\x05\r\xc3\xc0-U\xf3\x96g\x81m\xdbl۱\x93jm\x14Ӳ\x865\xbbY\x9ab:.\xc5\xfa8N\xa9\x8aY(\xe5\xd3u$^\xa7ɥ\x93/\xb2\xbex\x85\xee\xfaZ\xb3\xdb\\\xfd/\xfc\xe6\xda\x1f\r\xbeKib\x92J\xb5\xce\xd2\xf93o\x0f\x91\xb5\xf3\xf0q:k\x1b\xb5\x01\xa3;v?r\xf7#\x1f\xfa\xe7R\x1a\x15}\xcb\x13f\x0e\x1e\xe5ػ?\xc4\xfa\xe2UV\xe6\xcf\x0fg\x8c\b!\x11J\x91e)\xa6i\x92f)^\xb7\x8d\xefi8\xa5b\xa9\x9c7\xef\x18\xa0\x14A\xb7\xcd\xe5WO\xb0:\u007f\x81\xf5\x85y֮^\xa4\xd7\\\xc3v\\J#5\xa4\x10nc\xe9\xda\a\xecb\xe5a\xa7\\)\x8fN\xcf\\l^\xbd\x14\xf4\x9ak\xdfw\x0e1\xde*\"\f\x1eg\x0e\x1f\xff\x1b#\x13S\xbf\x05\xf2\x97\xca\xf5\xd1\xea\xe8\xf6\x19\xb2,\xc3-W8\xfc\xe0\xbb\x19\x9f\xd9\xc5\xe1w\xbe\x874N\xb9x\xf2\x85\x81'\x874\xcd|\xe4v6\xe4\x8a(\xd4\x15\xf1q\x1c!\x84A\x9a&$q\xcc\xca\xe25\x02\xbfO\x9a$dIL\xe8\xf7\t\xfb=\xfc^\x9b\xc0\xeb\xe2wۢ\xd7n\x16\x95R\x87\xb2,\xfbh\xe8\xfb\x9fr+\xb53Q\xbf{v4\xb7\xf8\xeex\x91%\x0e\x1fG\x9d\xd9@\xd8\xdeu踙@\x1d\xa5&\x84\x14\x8f\xaaL}\x00!\xfe:`\xe8\xbc8\xa02\xde\xff3\u007f\x9fb\xad\x0eJ\xf1\xc0\x87>\x8ee\xe7\x15\xe8\xed6/\u007f\xf51\xfe\xe47\xfe\x19\x8dū:\x1fR,\"\x90z\f\x91\xa5g\xe5n`\xe8\xea\xda)-\xfe\xf4\xe4\x85\xc1(%\x91G\x81A\x03\\\xb6W\x97Y\xbez\xe9\x86\x19$\xa6e\xfd\aI\x18<\x1ev\x9b\xfe\xe0B͟\xf9\xf6\x9d\xc5!C.\xc89`ǡ\xe3\xc7F\xc6'?\x91*\xf5\xf7P\xea\x1f\t!\xfe\xa9e\xd9\x1fs\n\x85#\xa6iI G\xb0\xd6\x1d\xac\vs'\xd96\xbb\x17\xa7X\xd6\xf5Tۧ\x91\x86\x81\xed\xb8L\xef=́\a\xdeM\xaf\xb5\xce\xfc\xd9Wr,\xc6\x1ch&\x9fA2(\x94\xde<H\x18tgT\x96ezdj\x96m\xe8$)Y\xbcrạ\xf6*\xcf\xd7\xcbO\x94\xaa\xb5{\xc6gv\xa7\xff\xe1\xecę\xa7O\xbc\xcc\xec\xd1\xfb1,\x17\xbfۺ\xbd9d\xf3\xed\xd9w\xf4\xb8\x15\xa4\xfcWJ\xf1\xb3\xc0\xce,\xcbFK\xe5\x8aY\x1f\xdf\xc6\xe8\xf4,őQ\x00\xe2~\x8f,M\x88\xa2\x88\xb5\x85yV\x17\xae\x00\x8a\xca\xe86\x1e\xfc\xf1\xff\x88J}\x9cB\xa9\xc2\xde\xe3\x0fR\x1a\xa91h\x05\x8f\xfc>\x17N\xbe\xc0\x9f\xff\xe6\xa79\xf9\x8d/c\xda\xcepX\x97[,3\x98\x89\xa8]\x0e5\x1c\x93g\x98&\xb6\xeb\xe2\x16\xcb\u0605\x12Y\x9ap\xf9\xcc\t\xfa\xbd\xceF\x8f\xa0\x90\xd4'&\x875ZZg\x89\x008kH\xf9\xf3WN\xbf\xf8\xc4m+\xb2v\x1d9\xce\xe5Ӛ\b\xbbﾏT\xa9\x9a\xca\xf8\x1f\x15\xfc\x9c\x10\u0092\xa6I\xb1\\ar\xc7,\x85b\taZT'\xa67\x89\x86\r\xc0KòX\x9d\xbf\xc0\x99o=M\x9a&\xd8n\x91\x1d\xfb\x8eP\x9b\xdc\xc1\xcc\xc1c\xec\xbb\xf7\x01\xc6w\xec\xca\xfb^\xb5wh\x18p\xe1\xe5\x17\xf9\xd3\xdf\xfcߘ;\xf1\x1c\x91\xd7\x1bb\xb5[\xb6\x8de\xbb\x98\x96\x89\x90z\x02u\xa56\x8a[\x1d!\t#\xba\xad\x06+\xf3\x17\xb5c\xa8\xa0\\\x1fc\xcf\xd1\xfbQY\xca\xea\xd5\xfcu\xb6\xe2\x01\xb7\x1b+di\xfaE\x10?oX\xc6\xd9\xee\xf2\xc20\"\\\x9f\xdeEs\xe1\xf2\xf7\x8f {\x8e\x1c\xe7bN\x8c\xbb\x8e\xfc\x88\x9b(\xf5\xf1\f~]Jc\xbb\xc6A\x14\x98\x96M\x14\x85\x18R22:A\xa9:\xc2䞃\x1a\xab\xf0&M3\x86i\xd1m,3\xf7\xedo\xd1m7\x89\xc2\x00\x01L\xdeu\x88}\xf7?\xcc\xf4\xdeC\x8cO\uf8be}\x92b\xa5\xa6qy\x93\x18۱\xe8\xb6Z\\x\xf9\x05ο\xfc\x1cWϝ\xa4\xdbl\xd0\xef4\tr\x870\n|\xedw\xe4\xc8\vv\xb1H\xa9Zgbf7\xd5\xd1\t\x8a\xd5\x1aI\x14\x12x=֗\xae\xd1k\xae\xe9\x1e\xf5TO\x8e\xf6:\xad\xbc\xd2]\rD\xdd\xff\r\xfc\x91i\xda\xcftV\xaev\xde,\xa2|W\x04\xd9y\xe8^\xe6_}\x99=w\x1f\xaf&\x19\xff\xb3R\x1cU\xf0A\x06\x19\x86M]\xac\x9bg\x94\xa3\x14\xd5\xd1\t\x0e?\xf8nʣcC\xfc\xf4\xebW\x12\xf8\xb4V\x16\x99\u007f\xed\x14\xed\xe6:B@\xb1Zc\xe6\xc01v\x1f\xbb\x9f\xd2H\x9dB\xb1\x8c[\xd6\xfd\xe4\xba\xd4'\xcdg\x9d+B\xbf\x8f\xefu\b=\x8f\xc0\xeb\xd2\xef\xb6i./\x90\xc4!Y\xa6\x88|\x0f\xcbv(\x94\xaby>\xbd?\x9c9\xe5\xf7:4\x16\xe6\xe95\x1b\x84A\x9f8\xf0\x897\xc1\x93\xdfdf\xe1\x13R\xca\xdf\xf1\x1a˿7xmdj\x96\xf6╷^d\xcd\x1c:~Z\xc1\xe1\x8d\u07bb\xeb\xfb\xf0n>'V\x1a&ӻ\xf71{\xf0\x98Ƭ\xba\xc5\n\xbc.\xd7Νdq\xfe\"Y\x9a\"\xa5\x81](\xb2\xfb\xe8\xfdL\xef=\x8cS,\xe9I\x9fJW\xa7;\xc5\x12RJT\x9a\x92$\xb1v\x0e\xf3Q\x17\xad\xd5%\"\xbf\xaf\xa7\xae\xe5x\xbed\x99F\xa9N\x93\xbc\x18\xceg\xf9\xf2yV\xe6/\x10\x05\xfe\rH\xd8\xd7O\xf5ܴb\xe0\x9a4\x8c_\xf2֖~\x17`tf7\xebW/\xbd\xb9\x04\xd9y\xe88\xf3\xaf~{`=\xfd\x9aR\xea\x1fhHo=ںX\xaa\xe0\x96\xcay\x95\xb9ʋ\xd6|\xfa\xbd.Q\x10^\xb7AŮ\x83G\xd9u\xe4G\xb8)V\xbdR\xa8\xbcM\xd9o\xafs\xed\xc29\xd6W\x16I\x93$\a\x1c\xcb(\x8f\x8e3:9C\xb9>\x8e](h\xe47\xd7\xc5v\nXn\x01#\x1f\xc8\x12\x87>\xfdnG\xdf\xf6($\xf2\xfby\xc7mJ\x96&x\xed&\xebKWY\xbdz\x998\fr\xb4\xb9-\x94\xd8躕F>\xf30\x1a\x8e\xd1\xd8\xc0:\x01\x848!\xa4\xf8d\xbf\xb1\xfcrib\x9aJ\xa5\xc2҅\xb3o\x0eAj\x87\x8e\xd3z\xf5\xdb\xec8x\xef\xdfQ\xf0\xdbJ)\n\xa52cۧ(Wk\x1a\xe8e\x13\xb7\xe87\xd4\n8\xf0z\xac-/\xd2m\xae\x0f\xc1\x89\xa5\x94\xec\xb9\xfb>\xa6\xf6\xec\xc7v\x8a(\x95m\x10Mm¨\x16\x82\xa8\xdb\"\xf0:t[M\xfa\xbd\xaev\xee\x02\x9f\xa0\xaf\xb1\xb44\x10C\x1e\xc9\xcd\xe3]n\xa9\x82iۤi\x8aJS]γ\xa9\xedY\xa9L\a\x1d\xfb\x1e\xa0\x86\x13u\x068\x8d\x83Q\xb0\x86\x94\xba\xbd\xda4\x062Y\x133\xaf\x88\xd4\xef\x9f\x10\xc79\nw\x96u\x94R\x9f\xe8,\xcd?\xb9\xfb\xee\x1f\xe1ҩ\x17\xdf<\x915s\xf8\xbe\x1dY\x96=\xa6\x94:Z\xa9ՙڹ\x1bi\x98z$Q\xae0\xa5!1d\x8e\xe8\x99w\x1a\x81N\x9bv\x9a\r\x16\xaf\\\xdc\xe8\xd5P\x8a\x91\xf1m\xcc\xec;\x84aHj\x93;\xf3\xa1\x03j\xe3\xd21\x80Cҳ>@O\xe3I\xd3$瘈(\xf0\t\xfd>\xad\xb5eZ\xab\xcb9\b\xa6\x18\xf6\x0fj\xc4l=\xce۴\x1d̼\xfaQ)E\x12G\xc3ٹB\x8aM\xe3T\xe5\x96y\x85\xea\xba\x03\x1a\xbc\xff\x06\xa0@J\x96)\xd2$&\xe8v\xaeڎ{߅W\x9e\xff+\xc5_\xcc\xd7\xf75\xee\x93i\x96\xfd\xc7J\xa9\xa3\x85b\x89\x99\xbb\x0e\x90\xe5\x8eVyt\x82\xfa\xd4N,\xb7\x88\x94\x92~\xbbIwm\x91\xd8\xf7\x90\xd2\xd0H\x9d\x98\x8cNLb\xbb.\xf3\xaf\x9d\x1d\xe2\x1b\xb6\x1b+x\x9d&\xdbv̒F1\xb5\xc9\x19\xa4a\xe4\a \xc8T\x9a\xa3\xf4\xc8!\xe6\xfa`\x10\xa5eg\xd8N\x81b\xb9\x82\x10019M\xbf\xdb&\xf2\xfb\xf8}O\xd7\xf0\x96*\xd8na\x18\x98$\xcb\xe8\xb4u\xb3\xa6㺺\x11'ӱ\xb1(\f\x89\x83\x800/\x8e\x18\x94\x16mс\xc3.*\x81Pbș\xc3C\xb4l\xa4aL%I\xfc)\xe0\x9f\xbd\xe1:d\xe0\xf4\xed8t\xef=J\xf1M!Dqϡ\xbb\xa9\x8eO\xb2m\xef\u074c\xcf\xee\xc3.\x147\x87\xb3\x11B\x10\a}\x9a\v\x97X\xbb\xf2\x1aA\xa7M\xb2\tl\u007f}e\x99\xe5\x85+[\xf6\x9a\xa5)\xd5\xfa(\xa3\x13\xdbq\x8b\x05\x8400m[\xdf\xe6|:\xb3\xe2\xba\x0e\xda\xe1\xc3\xc0#Os\xa7_\x80\x14\xf9\x94\xb5솦\xff$\x8eP*\xc30m\xa4\x14CH\xbf\xc1\xf0\xb1,\xd5EsQ\x18\xd0\xefv\x87\"J\x8bU\x95?f\x1b\x87&\xc4\x16\f\xe18\f\x01^\x8cC\xff#\xd7ν\xf2=\x0f\x05\xbbi褳\xb6į\xfe\xe4\x83|c%{R)5]\x1b\x1dc\xc7\xc1{\xd8s\xff\xa3Ԧvn\f\xa2Ͽ\xb0RZ|\täX\x1b\xa3:>\x85i;D~\u007f\xe8p\xb9\xc5\x12Q\x18\x12\xfa\xfd\xa1\xa3(\xa4$\f\x02\xbcn\a\xa5\x14n\xa9\xa4\xb3\x839@\x80\x06\xdeO\x87hr\x83\xcfb\xe0egj\xeb\\c\xa5P\xd9-Ɔ\rP\x1d\xc4\xc0$'o\xa2\x92Ð\x8ai\xe9\x92U\xb7X\xc2-\x16\x87\xff\x1c\xb7\x80\xe3\xbaض\x8baȜ\x8bn\x9c4\xaaPSd*\xee\xac-=1{\xf8>\xdakKo\x9c\x0e\xd9q\xe8\xf8\u007f\xa6\x94\xfa\xbf\xa4aD\x933\xb3<\xf8\xf1O\xe2\x965^\xfa\x86\x18\xbd\xd5\xc8\xec\x84\xd0\xf7h-^a\xe9\xec˹N\xd1\av\xe1\xecI\xc2~\xff\x06\x94\x1ei\x18\x98\xa6\xc9ض)\xaa\xf5ѭsg\x85\x1e<)\xa4\xbci\xcb\xd9M\f\xb6\xd7\xfb-\x9b@\xe6 \x9f[8\xe4tr?j\xc0\x81\x03\xe5\x1d'\f\xbaw;-\xed\x80n%\x88\"\x0eC\x81\x10\xa6J\xd3\xe3WN\xbfx\xf2{\xe1\x90\xff\x1f \a5l\xedg=+\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_19_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14&4ߣ\x8e&\x00\x00 \x00IDATx\xdaԽw\x94e\xd7Y\xe6\xfd\xdb{\x9ft\U000ede95\xbb\xaasP\xab\x95\xb3,+ز\x108\x81\x03\x8c1\xc1\xe4a\x80\x01\xc3\a&\xcc\xd806k\x02\x8b\x01\x06\x98\x190\x83\xc18\x1bc\f\xd8\xc8\xc6I\xb6,[\xb2dYjI-uVW\x87\x8a\xb7n\xdd|\xd2\xde\xfb\xfb\xe3ܪ\xea\x96\xda \xdb-[>k\x9d\xb5\xbanu\x9ds\xee~\xf6\x9b\x9f\xf7=\x82o\xc1q\xe3e\xd3Û\xc6K\x0f]{\xe9\xa6\xd1K/٫\v\x81\xa6\xd7i\x06\xff\xebo\x1ep\x1fx\xf4\xd4\r\xf5f\xef~\x80\x91j\x81\xe5\xd5.ϧ\xe3\xda}\x9bx\xe0\xf1Ӽ\xf6\x8e\x8b^\xb1o\xe7\xe8?\xba\x8el5\x9a\xa1<\xb9\xd0R\x1f\xfc\xc4\x13\xf9\v}?\xf9\xad\xf8RRIi-Cq\x9c\xe4\xd34)\xa5\xc6+\xed\xde5\xe3\xfe\xd6\u007fx\x117\\\xbe\xe9\xaeR>\xb8\x01xށQ-\xe5x\xe0\xf1\xd3\x00\xec\xde2\xfc녜\x87\xef\xfbeclQI\x91>'k\xf5-\x01D\x80\xc0\xca$5\xac\xac\xacb\xad`\xa5i\x18\x1f\xaf\xf1ӯ{\xc1\xd0\xcbo\xd9\xfe\xfe\xe1j\xf1r\x80\x8b\xb6\x8e>o\x00Ym\xf7\x01x\xf9\xad\xbb\xde\x18\xf8\xce\r\x93\xe35&'F0\xd6\x02\xc2|\xc7\x02\x02X!D\x12F)++\r\xd24%N\fݾaǶ)^~\xfb%[~\xec\x95\xfb>u\xd5ES3O>\xb5\xc4\xed\xd7o\xff\xb6\x831R-\x00\xb0o\xc7\xd8-[\xa6\xcao\x1d\xaa\x16ԥ\xfbv\xd0\xebGXk\x11\xb0\xf0\\\xdc\xd7\xf9V|\xb9ŕ^R\xab\xe4\x8eƉ\xbe&I\x12\xea\xf5\x06\xbe?F\xe0C\x9aZ\xf6\xed\xddF\x92\xea\x91j\xc9\u007fr߮\x91\xefz\xd7?\xed\xbf\xf7\xdb\x05D\xadR\xa0\xde첼\xdaeۦ\xa1;.\xd95\xf2\x89\xbd\xdb\xc7\xc4u\xd7\xecCkMc\xb5\x83R\x92\x85\x95\xfe\xfcw\xac\x84\x1c|j\xb9\a\xf6\x9e45\xf4\u0084\xa5\xe5:q\x1c\xa3\xb5\xc5\x02JJ.۷\x93\x99M\xa3\xf9\xed\x9b\xca\x1f\u007f\xfdK/\xf9q\x80ɑ2\x13\xb5ҷ\f\x8c\x89Z\x89z3\xb3cSc\xe5_\xb8\xed\xda\xcd\x1f\xbb\xe9\xca-\xe2\xd2}\xbb\xf0=\a\xad\rK\xcbM\xa4\x10\xcc/w\x16\xbf-\x80\x9c\xbd \xdf\xc4\xe2\xa4\v\xf5\xde}q\xac\xd30J\x89\xa2\x88z\xbdA\x9a\x1a\xac\xb5X,\x9e+\xb9t\xdf.\x86\xab\xc5\xe2\x96\xc9\xf2_\xec\xd92\xf2\x87s\xcb-\x8c\xb5\xe2[\x01\xcaD\xad\xc4|\xbd\r\xe0m\xdd4\xf47\xdf\u007fǞ?ܵ\xb9\xea\xeeؾ\x85j%S_\xcdv\x97N/\"I\r\xdd^\xfc\x89o\v \xf3\xf56\x13\xb5Rm\xa2Vz\xd1|=\x16\xdf荾\xb4\xff\xd4'\x1b\x9dp\u007f\x18i\x8c\xb5\xcc\xcf/\x92\xea\x844\xb5\x99\x91\xb1\xe0{\x82\xcb.\xd9\xc5p%Pw\xbep\xdb\x1b\xa7F\xcb\xef^\\\xe9\xd8\xf9z\x9b\xe1r\xfe9\x01\xe2ҋ\xc6ֿ\xe7\xe4H\xf9\xaa]3\xb5\xd9\xd7ܾ\xfbG&G\vΎ\xed[\x99\x18\x1f\xc6Z\x8bR\x8a\xd9S\v(%\xe8\x85\tqj\xee\xfaz6\xf3\xb3\xdd\xd4\xea\xd9<t>\xe7_\xee+qϥ\x13\xfep=u\x1fM⸵\xee\x1aV\x8b\x84a\xfc\xaf\xfe\xfd\xa6\xb12\xedn\x14v{\xfa̦\xf1\xe2\xeb\x8a9W\x18\xa3\x19\xae\x96\tr\x01J\t\\G`-\x04\x81K\x18\xa5(R\xb6n\xaa^\xd6l\xc7\xcbK\x8d\xee\x03\xfd(yN\xec\xc5S\xa7W\x01\xdc\xcb\xf7L\xfe\xdc\xf6\xe9\xcaG^~\xdb\xce\xe2p%\xc7\xc4\xe4$[6o\"\xe7g\xff\xd7w\xe1\xae\xcf<\x82\x14\x92\xa5\x95\xfe\xe7\xef\u007f\xf4\xd4\x1f\x8fT\v\xf4\xc2\xe4ߒ8\x80\xca\xe8PqG\xb9\xe8G\xf3\xf5vt!\x00)I\xc9O\xbfzg\xee\x05o\xba\xaa\xf4=7o.\xfa\xfft\xb8}\x1ah\xae\x81\xe1\x17\x8aT\n\x1e\xfd\xf3\x80\xd3\xeef\xcf\xd0섇\x8c\x11\xd5Mc\xc5\x1b\v9\x17c\f\x93\x13\xa3\x18c\xa9\x96\x1c\x92\x14\x84\x80r\xb9ș\xb9:\x81'\xa9\x96\xfd[Z\xdd\xf4\xe1\xfaj\xef\xc8D\xadD\xa7\x1f_P\xe3\rp\xe7M;\xdeq\xc3eS\xbfq\xf9\x9eQ\xa1\xa4drr\x82\xa9\xa9\tJ\x05\x85\x10\x82|\xce\xe5\xa1\xfd\x879>[\xc7\x1a\xe8\x86ɯ\xb5;Ɂ\xb3\x16\xfc\x1c \x8ay\x9f\xf9z\x9bm\x93ձnd\xdf|ӥ\x13oi\xf7\xd2\x1f7\xda>\xd0\xe9\xc7'\xbei\xa3n\x04\xb9\x82\x11<p<bgU\xed\xfd\xe1\x8br\xbf\xf7\xf8Om;\xf4\xa6\x9b\xc6\x0f^\xb2\xa9\xfa6Dn_\xd4\xed\xb0\xb2\xda\x01\xe0\x92M\x95g\\cM\xe5\x1c>\xd1x\xd3W\x9f\\\\\xd0\x06\x96\xeb\r\x8c1X\v\xfd\xc806\xa4H\xb5\xc5\xf7\x1c\xae\xb8l7\xa9\xd6l\x9d\xaa\x14\xb7o*\xff\xbf\xe9\xb1r\xe1|\v\xb0vl\x19\xfd\xd7\x1d\x80\xa7\xdb\xc2\x01\x18\xe3/\xbe~듷]\xb3\xf9\r\xa3C9\x91$\x86\x91\x91\x1aS\x93\x93\xe4\x03\a)3\r\x1dE}\xbe\xfa\xe8I\x1c%\xd1\xd6\x1e\xb1\xd6~\xe5\xe4\xc2\xeaה\x8a\x81\x9a\u007f\xe3\xe2j\xf4\xe4Ͽr\xe7\x9b\xfe\xeeM\xd7\\\xf7\x8a\xeb\xc6\xfb\xf3+\xedC\x17\xca\xed\x15\x1axi\xd3\xf0\x1f\xefZ\xe1\x17o(\xab\xcbG]\xf5?^P\xde\xfd\x96\xeb\xf8ϏՓ\xff|\xac\x99\x1eM\r\u007f\xba\xa3\xa2>\xfdg\x8fu\x8e>v\x9a\x1e\x80\xfd\xeaM\x88+\xefe\xa5\xd5c|\xb8\xc4\xc2J;]mG\xffe\xa1\xde\xfd?\x13#y\xe6\x17\x96\x99\xde4N\xa7g\xd8<)\xc8\xf9\x82~h\x19\xaa\x14ٱ}3ǎ\xcfr\xc5E\xe3S'\xe6Z\xbf\xbb\xd5\x19J]\xadG}%bm\xa9Gƞj\xc6\xe6p#1gN\xf4\xd2vŕ\xaa6T*\xbb\x92Q_\x892\x06\x1bZ\xdblj\xbb\xbc\x98\xdafi\xa8\xd4\x1cqh\x86\x86\xa0R̽d\xd3X\xe9]wܰ\xad,\x06\x96ql\xac\xc6\xe6\xe9)\x1c%\b<\x89\x00\x02_\xf2\xf8\xc1\xd3$\xa9\xcel\x9d1_\xf8\xd0'\x9f8v> f\xaa\xae\b\x93\xc2\xcd9W\xfe\xf5X\xd9\xdd\xf6\xbb\xaf\xdb\xc3O\xbez\x0f\x8f>:\xcf_~r\xf6 0w\xa1\x00\xf1\x01Q\x03\xeel\x18\xdeuW\x83\x8fn\xf5\xb9r{\xc0\rc\x1e\u05cf\xbb\\;\xe6\xee\b5\u007f؎\r\xbf}]\xe5C?\xb5\xaf\xf8\xbe[\xdfs\xe2\x1fĕ\xf7\xea;v\r\xf3\xc9\xc3+,\xac\xb4\x19*\x16QR|v\xb5\x15\xb6\xa6F\x8b\xe5\xd9S\xf3\xcc\xccL`R\xcb\xf2j\xca\xe4\xa8\xcb\xf1S1\xdah\xb6m\x99b\xf6\xe4\x19F\x86]{\xeb\xb5[\xde8\xd2n\xb1\xab\xdf!\xef*z\xdaЈ,\x8b\xa1f91\xacDv\xe9p;\r\n\x8e(\xbdz&\x00\x04a?e~%\xa6e,+\x9a\xc6\x13\x91}t6\xb1\xfbs\xc2n\x9e\x1a-\xbe\xe2%7l\x11kR0>6\xca\xc4\xe48\x8e\xe7\x92\xcf9H\x95\xa9\x0f%4'O\xaf\xa0\xb5AH\x11\x1e?\xdd\xfa[\x80+\xf6L0\xbf\xdc]w\bfF\x8b\xc1\xfe\xf9\xe8\xcd;jޯ\xfe\xec\vƽ\xef\xbda\x92͗L\x82\xa7\xf8\x93\xf7?\xae\x8d\x14\xf7\x9cǶ|c\x80\x88\x81\xadр/\x04\xb7\"h>\x95\xf0\xd4\xe9\x84\xcf\x04\x92hX\xf1\vW\x14\xb8e\xc2G[A\xde\x15\xaf\x1d\x0e\xe4˾\xfc\xa3[\x0f\xfc\xdcݫ?\xfb\xc9\xc3+\x0f\xfe\xe4U#|\xe8h\x88+\xe1\xc9\xe3+\xcb;\xa6\xab\x8fF\xb1\xbe\xc9\tc\xea\xf5&\xc3Ce\x96W4Sc.Ւ\xa4\xd12H)\x98\x9a\x18\xe3\xc4\xc93b\xf3\xa6\n74S.\xee%$\b\x1cW\xe0\x0e\fn/\xb4\xb4\xfbv44\x96O\xccG\xdc_O\xf8\x93\xab+`,\xa7N\xf7X\xaaG$\x82\xa1\x8e\xe1\x96#\x89\xbd\xe5\xc1\x9ee\xbe\x9agz\xb2\x8c\xb0\x96\xe1\xda0\xa3c#\xf8\x9e\x8f\xefJ<w\xb0\v}A?\xecr|v\x05\xd7Q\x1c\x9em\x1c\xfd\xc4\x17\x8f\xfe3\xc0\xc3\a7\xe2©\x91ҍ\xf5о\xfdU\xdbs\xfb~\xeb\x8eIq\xd1\xce\x1a\x16C:\xdfD\xb6:|\xecP\x87j\xce\xf9\xc8r\x9f\u007f\x15\x8cgo\xd4\xf3\xfe\xe6\x00\xf1\x86یP)`\x01_@\xd9\xc0\xee\xd8Ҭ\xc7\xfc\xf9\xc1\x1e_^M\xb9i\xcaÓ\x02)p'\vr\xea\xa5[\x83\x9f\x99\xed\xcb\xe5\xf7\xee\xaf?\x10E1\xdd0&NS\xb1}f襅\xc0\xddS*xH)\x19\x1d\x19\"5\x16\xdf\x15\fW\x14\xed\xae\x01\x91=\xe2\xc2b\x1dk-\xd2Z\xb6\x87\x1d܊\"(\t\xa4#\x90\xae \xc8\v\nE\x85/$\xd7T]v\x14\x1d~\xfe+\xab\\[\xf3\x98\xaa\xf9T\xaa>\x18pSˌ#xaA2\xd6\vy\xf7\xc3\xf3\x04\xa5\x1c\xfb\xf6l\xa6\\*\xe1(I\xa9 QR \x04\x8c\x0e\xbb|\xfc\xeeC,.5\xe9\xf5S>\xfa\xb9c?\x1f'\xe9\x01\x80\xc9ZI\x14r~\xad\x98\xf7\xff\xaf#ş\xfc\xd5\xedCc\xbfqMQ\xe4\xe3\x18\x93h\xac\x94\xf8\x8e\xe0\xbd\xff\xf2\x14\xffp\xb4\u007f\xcf\xcaj\xfb\x8f\x9f\x8dS\xf2\xac\x00)\xe6\xfdQ\x8b\xf8\xc9[\x8dPOϨفԤ\x89fa!\xe6\xa3s\tWLyL\xe4%\x89\x81\xbc+\xf8\xdem\xb9\x97\xee\xa8\xe6j\xf7\x9e\xb2\x9f\xed\xeb8\x05\xbc-\x13\x95\xd7TJ\xfe\x9eB\xceE\n\xc1\xe8\xc8\x10\x8e\x92\x18`bԥӳD\xb1\xc5q\x14\xf5\x95&Q\x9c\xe0[\xc3eN\a\x99\x93`3\xbcd\xce\xc1\x1d\xca\xe1\r\xe7ȏ\x05\xa8\xb2\xc7HNqC\xd5\xe5\xcf\x0fu\xb9\xb4\xecP\xf4%ժGe\xc8#\x89\rݾf\x93/yi^p\xe4\xc8\x12\x8f\x9ci\x82\xef3:\xe6\xb3y\xb2\xc8\xf1\xd9e\xfe\xe9_\x1eb\xa5\xd1\xe7\xc9#\xf38J\xf0\xe8\u196f\x1c\x9a]~#\xc0\xf4Hi(4\xf6\x87])\xfe\xf6\x86\x9a\xf7\xc2\xf7\xdd9̕c.\xbd\xd4\"\x84\xc0F1\xa6\x1b\xe2tC~\xfb\xde\x15\xe6\xba\xfa\r\xdd~|\xa2\x98\xf7\xffM@\x9e\x95\xcaR\xa0{XV\xa5\xa0d2\x10\xd6=0\xa0&\x04U%1h\xfa\xf3!?\xff\xc9\x06\x1f}\xf5(\xbe\xcc\x02>)\xe0\x15ۃ\xff\xb8\xda3\x95_\xbb\xa7\xf3\x06\xc0\x15\x82\xbc\x10\x10'\x86^\xafO?\x8cp]\x870\xb4D\x91a\xb8*i45\x9e\xe7R.\x15h\xb5\xbbĮ\xc2Q\x92\xd4fzT\x06\x0e\xeep\x1e\x94D8\x12!\x05\xbe/\xf0J.{G}~\xab\u242c\xc4X\vZ[\x94\x12LM\x17\x10R\xd0X\x89PR\xf0\xaaa\x97\x85\xa59\x8e\xff\xed\x19>{\xf7\x10\x1f\u07b3\x99\x89Z@\xbb\xd3\xe3\xc1G\x8e\xa3\x94$\x8a5Ij~i\xa0\x9e^P\x8f\xed\u007f\xdbQtn\xf9\x8d\xbd\x05n\x9d\n\xa8\x16\x04\x91\xb6\xacG\xcdB \x8d\xe5\xe0|\x8f\xa3M}rq\xa5\xfd\xf95[s\xa1rY=\a8\x81=\xafHi`JJ\x10\x02_\xc2X#\xe6\x1dO\xf4\b\x1c\xb1.E\x85@\xf0\xea\xed\xb9\x1f\xbdb\xba\xfan u\x94\x1c\x93B`\x818Ii\xb52\x979ՖV\xd7P*H\x84\xcaB\xf8J\xa5\x84\x94\x82\xc8@'\x1d\x88\x86\x05\xb7\x9aG\xe5\\\x9c\xbc\x87p\x06R\xa3$B\t\xf0\x15c\x93>C\xa3.R\x89\rq\x06&&\xf3\x8c\x8f\xe7\x00\x88-\xd4<\xc52\x92#g\x1a\\\xf7\xe0\xe3<\xfe\xf1\xfdD\x1a\x94\x92\b\x01Z\x9b\x0f\xdf\xfb\xf0\xec\xbd\x13\xb5\xd2\xeb{\x9aO\xbf~k\ue58f\xbcp\x88[\xc6|\x94\x04}\x9eD\xbc\x94p\xdf|L'1\xef\xfdz\xd2N\xf2Y\xbaXG\x04t\x9b\xe2\xfc\u007f`\x81\xaa\x94\xe4\a\v\xec\x19\xcb\xfe\x83]N\xf72q\xd2I&)î\xe4W\xf6\x14\u007f(W)\xfey\xb5\x1c\xe4\x1dG\xe2(\x81\x10\x82\x85\xa5\x95L\xe2\f4\xdb\x1ak2\x10\x8d\xb1\fUK\x99פ\x05\x8f\x9c\x8a\x11\x80*z8%\x1f\xe1:X,\xd6d\x12#\x94@8\x12\xe5+\xdcj@eg\x85\xd1K+Զ\xf9T&\x1c\xbc\x9cDJ\x18\x1dϱek\x11\xa5\x04\x06xeIr{Q\xf1\xaeՔ\xc9^\x1f}h\x0e!\x04Z\xdb3\xab\xed\xf0\x8fFk\xe5\xff^\x10\xbc\xe7\xa7K\"xˎ<\xde\x00\xe44\xb6\xe8\xc4b\fX\x03&\x05\xa3!\x8e\xe1\x89唥\xbe~\xd6\xd2\xf1\xacl\xc8D\xadĩzۖ\xf2~1\x11\xe2\x96\xeb\x8c@\x9f\xdf\x13\xa3e-]k1@\xd47\x84\x9e\xe4\xeaI\x0fD\x06H\xafk\xd8Upx\xa4\xa5/͏\x95\x87\xa7F\n\xf8\x9e\x83\x10\x82n/dr\xbc\x86\xef{\x84\x91\xa5\x98\x17(%Ym\x1b\x94\x92<\xf6\x85\x03\xe8\xe5\x16\x936b\xfbL\x1eU\xc9!<\x85p\x04B\t\xa4\xeb`\x8d\x85\xc1iM\xa6\xd7,d\x00\x95\x02\xbc\xe1<\xaek\x11iJ\x1a[\\O\xe1\xe7\x14\xab+\x11Z\bjJp{QҲ\x82\x03+!\xf3B\xda3\x8b\x9d\x0f|\xee\xd1\xf9\x97\xdc苟\xfa\xa9<\xdc9\x93#\xa8\xfa\xebA#@\x12\x81I \n!\xecX⾥\xde2\xbc\xe7x\xaf\xf3d;}\xdbx)X}\xb6\x19\x86\u007f\x13\x90\xb5\v\x95\xf2\xfe}1\xfc\xea\x16\x84S\xb1\xe7ڑ5@\xfa\xd6Ҳ\x16\rH\v\x0f-\xc5T\x86\\\xf6\xd5\x1c\xb4\x81$\xb4\xa4\x1a\xb6\xe6%\x8f\x19\x8fM\x13\xa5s\xfe\xbe\xd1l\xb3m\xcb\x14Z\x1b:=K\x18\x19\x8c\x11|\xe9C_\xe2\xa6\xe4\fW\x16\x13\x1a\x11\xec\xdeU\x81\xc0A8\n\xa9$\x02\x01B \x84\xc5\xc6\x06\x1b\xa7\b\x04\xc2U\b'K\u007fX\x9b\xa9?\x198\x98f\x1f\xe9\b\x8c\x06ǕD\xa1!\f5\bAja\xda\x15\xec\b\x14\u007fu\xbc#\xea\x8d\xfe\xd6W\xe5\xc5U?\x10\xc0t\xd5efW\x05q\x9e\x14뚄\xac\xfdn5\x85?=\xd4}\x18\xf8+G\x8a\u07b3\x05\xe4Y\xa9\xac\x89Z\x89\xb9z\xbb߄\x9f}P\xd8\xf3J\x88\x04\xf2B\f\x82)\x81\x150\x13[~\xe3\xeeU\xdam\x8b\x90\x90+e\xb7\xbb\xb8\xe40\xd9l\xd1\xean<\xa4\x10\x82v\xbbǡ#\xb38\x8e\"I-adY<YgK\xeb\x14\x97N{\x8cW\x1c*\xc3>\"\xef\xa2|\a!\xc0\xea\xc1ְ\x16\xddO\xb0\xa9\x06\v\xd6\x18L\x98`\xc2\x04k,ґ\x99r\x15\x02\u007f\xba\x82\xf2%\xd2\x01\xe5\bF\xc7r\xb8\xee\xc6R\xa4\x16&\x1c\xf8\xf7Ò\x9b<j?\x10\x80\xc2R\x1e\x0e\xce\v\xc63\xb4\x85\x10<t\xa2\xc3|l\x1e\xca+\xd1x\xb6\xea\xeaY\xbb\xbd\x9d~\xccD\xadD\n\a\x17\x05\x17\xef\xb0b\xef\x10\xe7J\xc9\xdas.\rT\x96\x19|V\xb6\xf0ٖ\xe1\x8e\x11\x1f\xe5d\xfe=\x1a\xa6\x1d\xcb\a\x0f\xb7ٹ}\x04c\xb2+\t)h\xb6:\xb8\xae\x8b\xa3\x14Z\x1b\x1a\x87f\xd9\xd3?\xcdH\xd9\xe5Ċ&?Qb|<\xc8v\xbc\u0380\xb6\xda`\xa2$[o\xb9Vė\xeb@\xa1\rH\x81P\x12\x8cE\xb9\x99tE\x8d\bk\xc0q$F[z\xbd\r\xdeB\n\xec\xf6\x05\x0f\xf6-\xc3\x02\x8aR\x80\x85\xdah\x80\x94\x99\xdd;\xfb\x84\xec\xbbű\xe1Ա6\xef>\x13%\x87S\xf1竍\xf6C_O\xd2S=\xdb\xff\xd8\xe9Ǆ\xfd8\x8d\xf2\xfe\xc3\xf3Ҿ\xf6\n#\x8a\xde\xd3@q\x85\xa0n-\xa1\xb5X\x91\x19\xcb\n0\xd7Ny\xca\b\xae.\xb9h\x9d\xad\xd1D^q\xe0L\x9b\xe3\xd6aj\xa4\x80\x15\x82\xb8\x1d\xd1[\xe9\xb2pj\x89\xfaj\x93\xa5\xc6*\xcd\x03O\xb1\xd3K\xb8\xe7p\x9f`\xaa\xcc5\x97\ra\x05\bc\x01\x8b\x89S\xac\xb1\b+\x90\x81\x87\x9a\x18AH\x8bT\x02!\x05\xd6d\x06_\xb82\xf3\xc0,YM\\\nt\xa8Q\x81C\xda\xd7\xe4r\x99\r\xea\xf54Bd\x9bI\x93\x99\xa43\xb1e\xbb\x82~\xa4\xe9vR\xd2\xc4\xd0\xefiZ͘N;!쥄\xa1fq>\xe4\xcc\xc9.s̈́?\xeb\xb1X\x90\xfcf\xb7\xbfQ\xaa\xb8\xa0\x80\xac\xeb\xca~\\\x17y\u007f\U00068d2f\xba\xe9i\x06\xde\x01Z\xc6вYiV\x0fķl\xe1ɕ\x98Y\x04/\x1c\xf10\x16\x12\x03\xb7\x8e\xfb\xfc\xc1\x83K\xec\xdd;A㫧\xa8\x1d;\xc3\xe6^\x87ֱ\x15N>t\x8aH\n\x16\x1e_\xe0\xfe\x83\x1d.\xd9Q\xe4\xf6\x17M0\x10\xa6,\x1aN\xf4@:\x05\"p\x90\x9b&\xa1RF\x00\xa2\xdf\aGa\xa3\x14\x93\x98\f\fG\x02\x02\xa3-:1\xa4F\xe0\x15\xbc\xccvt\x13\x82\x9cC})\x1c\xec\xf8숌\xe5c]KI\xc1\xa4\x84~\x98\x01\xd1lĴ\x9b\xd9\xd9\\\xcd~\xeev\x12\x02c\xf9\xeb\x10f5\xef_^i\xbf\xe7\xeb]߯\x1b\x90MYrl\xbf\xc8\xfb7\x14`\xe7\x8c\xcd$aMm9B0g\xcc\xfa\x0e\x93\x03\xbbR4\x96\xcf.\xc5L\fy\xec-;h\x9bI\xca\xd5U\xc5[?v\x9cW\x964\xaf\xdd[b[ե\xea\v\xb4\x85\x9b܈\x9a/\xb8\xf3\x9a\n7\xdd<\x8e\x95\n\x84\xc0D\x1b`\x80@z\n\x11x\x88ё\f\xac\xd3\v\xd8(\xc1jC\xba\x1aB\x9c\x82\xa3\x10Jb\x12\x8b\xc0\xb22\xdbF8\x8a|Y!\x84%ZM\xb0FP_>\x17\x90\x9c\x80Qm\xf8X\b\xbb\x1c(\x88g:4k\x9aQ\x02'\r\xbc\xabO\xb3\xa8\xb8s\xb5\x17\xc7\xcf9 k\xe1\u007f.\xef\u007f\xac!\xf8\x99\x8b\xac\xc8yg\xc5#\xc1\x00\x90d\xf0\xb3\x19\x80\x14\t\xc1\x04\x96\xbf_J\xb8f\xc2g:\xa7H,\x8cx\x12\xd3\xd7ġ\xc1\x11\xf0\xd8BD34|\xf7\xee\"\xc3\x05\xc5֊\xcbĶ\x12\xb2\x94e\xfc\x84\xb5\x98dC\xd7\v%3sa,6\xd1\xe8SK\x98V\x17\x13jt+B\x87):\xb2$털\x95`\x93\x14\xd3\ty\xea\xe1\x06թ\x02\xb9\xe1\x00\x15(\xe2Ո4\xb6ϐ\x90e\r\x87\xfb\x86=\x0e|&\x81\xeb\\\xf8Z\x84\xac\x96\x85?\xeb\xa3;\x96\xef=\xb5\xdc~\xf2\x1b)\xa8}݀\xac\x19\xf8.6\xec#:\x89\xe4e{\x8c8\xe7\x82\t\xd0\x18\xa8-;P[z-\x80ԆO7S\xf6\r{\x8c\xf8Y\xeej\xbc\xe4\xf0\xa5S!\a\x17\"V\xfa\x86\x17\xef(\x10\fJ\xba\x06\b\xc6\xf3\xb8%\x17\xa4\xc0\xa6\x86u\xbdE\x06\x84\x895\xc9R\x97\xe4\xf4\n\xba\xd1\xc1\xf4\x13\x88\x12\x84\xd6`\f\xc2X\x1ca\x90Z#Ҕ\xdej\xcc\xe1\xfdm\xb6^Uï\xf8HW\x91\xb6ct?\xa5\xbe\x18r\xb6+\xf5hd\xa9G\x961\a\xf6'\xf0B\xff\x99\x80X\xc0\x05\xfe2\x84\xc7R~y\xa9\xde~\u07ff\x95f\xbf\xa0\xbc\xac\xb5\x1b\x95k\xa5\xf7\u007f\x0e\xfb\xc3\xd7Jq\xe3\xd4 ǥ\x81MJ1\xab\xf5\xba}\xd1\x03p\x00\x1c\v\xf9\xe5\x887?\xb8\xca\xff~\xc1\x10UW\xb2\xb9ష\xe6\xf1\xf8|\x88\xe7@\xce\x11k\xd9\x11\x9c\xbc\x83?\xe4#=g\x10\xf5\xeb̈\x8b\f\x9c\xb8\x11b\xfa1\x82\xec\xb3~W\xd3X\x8eh7SzmM\xb7\x95\x92\xa4\x06!\x04՚\x8b\x17H\xe6O\x85\x14\xcb\x1e^n\x10\xd9+\x89[p\xf0\x02Auģ\xb1\x94\xac;i\ac\xcb\xc3)\xccYxm.SMO?r\x02\xde\xd1\xc3~1\xe6\xed?i\xf8\xe3?\xfa:\"\xf3oZB\x9eV%\xeb\x97\xf2\xfe\xe2\x11\xc1\xabn1\xc21g\xa1\x1cYK\xf3l)y\xda.\b{\x9a/t\r߷%@[\x98*:<<\x1f\x81\x85\xed\xc3\x1e\xa5 [\x11\xaf\xecQ\x98)\x0eԈ\xc5\xc6)\xa6\x93\x90\xf6b\x92f\x84\xed%\xf4\xba\x9acOvy\xf4\x81U\x8e?\xd1\xe1\xf4鈇\x96\x13\xeeZ\xd5\xdc\x1bZ\x8e\xa5\x90\xb3\x16\xd9ѴW\x13\xac\xb5\x8cL\xe7\x99\xdcS\xc1\xc99 %*PD\xcb}\xb0\x96v3]\xb7\t\x9f\xe8Z\x8e\x1b\xc1\xc1ذ\xdb\x11\xecT<Ñ\xf9\x87\b>\x1cqWg\xa5\xfd\xfa\xfb\xc2L\x83|\xa3\xb5\xffo\x18\x90\xb5\x1b\xf6\xfb\xf1!\x9d\xf7F\x11↋m濟\xed\x02'\x83-\xf5\xf4\x8dU\x04f{)\xc3y\x97\xbdC.9O\x92\x1a8\xb8\x9c0Sq\x99(:\x19\x89.P\xe4'\xf2\x99DhC\xbc\xdc#m\xf5\t[\x9a\xa8\xa5i\xae$<\xfa`\x83\xc6B\x94\xc5\x01R\xf07\xb1\xe0=m\xc3#\xbd\x94\x05\xa98\x94\xc2\xfd\t\xecp`L\t\xa4\x14\xcc\\T\xa1\xb6\xb9\x90I\xde@\xadƍ\x90\xb8\xabi52\x12\x9f\x10\x82\xd9 \xe0\xc7\xff\xc3-\xf4\x9a!_>\xd3\xe6v_\xac\u007f\x17)\xe0\x9eT\xf0\xa1\x90\xf7OH\xfe\xddR/6ߨ\xaa\xba \xccŵ\f\xe6\x10\xf6W\x1e\x10\xe6\xe1Cb\x03\xe1\x82\x10\f\v\xc1\xd7\nl\xb5\x80-\xc0\xa7\x8f\xf7p\xa5 J-\xd7\xcf\xe4@@\xa3\xaf7\xcc\xc4Y\xf6¦\x06\x9bh⎦\xd7H\xe9\xb5R\x8e\x1ch\x11\x86\x86\x05)\xf0\x05| \x82\x87b\xcbk\xbek\x0fo{\xe3\xad\x14=\x89'\xa0o\xe1\x90\xce\xf4\xbfT\x92|NmD\xf9\x03@\xa4\x04ϓx\xbe\xcc\x12\x85\xc6r))'\xe6\x9a\xf4Ä.\xb0b7\xa4\xfd\xa4\x86\x0f\x86\xf6\xb1\x14~\xe5\xc0r;\xf9f\xc1\xf8\xa6\x01Y\xbb\xf9S\xf5\x8eY\x86\x1f\xb8K\x9aV\xeb,Q\x1e\x91\x12\xef_\xc95(\x04\x8dn\xcab\xa4\x11\x80+\xe1\xcaɀ\xe5\x9e\xdeHiK\xb1\xee\xf5X \x8d\xa1\xd7̂\xbd\x13\xc7\xda4\xdb\t\xf7\xa7p8\x85\xa74\x1c\xd1\x10%\x9a\xb7\xfcڋ\xf9\xb1\xd7^I0\xf0\x01\xc5`\xb3\x1c\xd6p\x12\x81\xa75\xba\x17oȮ\x12\b_\xe18\x82|\xd1Y\xb7\xeb\xa3F\xf37\u007f}?\x8f\x1fY\xc2\x17\x82\xdcY\xaa\xe5\x9d}\xe2\x96\xe6\xf7\x97\xea\xed9\xbe\t\xbbq\xc1\xb9\xbd\x13\xb5\x12\xedz\xfb\xf0c\x82_\xb8[\xd9D\r\xbefMJ\xaaR\x9e\xd7o_/\xd6\x0f\x82DD\x96\xe1\xb8xԣ\x1b\x1b\xf4\x9a\xf5\xb4\x1b\xf9*\x93Z\xa2^\x96\x8fZ\\\xe8S_\n\xe9IAd!/a\xc5@K\x83\xef:\xfc\xe2o}\x8c\xb7\xfc\xcf\xcf\xd0\x1dp\xc2$P\x93\xd02आ\xa5\xf9>֞\x1bTx%\x1fk-Ų\x83\xe3f\x88\xf8\x02*\xbeb\x93\x12\xbc\u0603\xcf'г\xf0X\n\x8f\xa4\xdc}S\x9ew?\xef\xc8\xd6\xeb^\x97I\xde\xfdya?\xf6\x05e\t\x06\xae\xe0V)\t\x848?(\x03@\x84\xd8بC9\x85#ź\x84\x98Đ\x86i\xc6߂,\xd5.\xe1\xcc\xc9.\x8e#\xd7\x03\xd2\xc4B\x90%}\x11\x02\xee{\xe8$\u007f\xfb\xd1\xc7\xd1:˭\xcdH(\v\b-\xe4\x05\xb8e\x0f\xe9\xa9,\xe2_\v\xec\xf2\x0eJ\tryE\xa1\x94ٖ\x8a\x80\x97\x15%7{Y\xa4\xee\x93I\xe2]\x11\x04\xf0{\x1f=\xd5֛FJ\xcf/@֤d\xb9\x11\xda\x1c\xbc\xe1.a\xc3\xe3rmWJƥ<o0e\a\xaaͦ\x1b\x8a\xd9U\x82\x82'Iל\x01m1\xb1\x01\x93\xa5H\x00\x92ؠ\a\x9c\xe05\x85\x18Zp\x05\xbc\xc2\a%\xc08\x12\xc7SDd\v\xf9\x86<4,l\x960Qu\x18\x9e\xcc!\x94\xc0\xc4z\xe0\x06Z\xa4\xe7\xe0\xe43u5\xb59\xbf^\xf3xQARUY,\x95\x17\xa0-\xf4`\xae\xb5\xd2\xfe4\xc0\xe9\xe5\xf6\xf3\x0f\x90\x01[\x8f\xb9z\xbb%\xe0'\xde#\r\xcb\x03\x8d\xb0\xddq\xceo\xdc\a84\xfbz\xfdA\\\t\x9e\x82$\xb5\x19;\xde\f$$\xd6\xd8T\x93vb\x92\xc4l\x94\x86E\xe6\xed\xd8\xc1νу?/\xc3o\x16\xe0\xa7r\xf0[\x05\xf8\xf5\"\xccj\xc8\x03\xbbJ\x8a\x1d{\x8a(\tQ7%\xe9$\xc4\xed\x18\xac@\xba\x12\xb7\x9a\x95e\x95\x03\xd3\xdb\xf2hmq\xad\xa5\xe0K\x12\xbb\x91\t\xbeX\xf1鯧4\xfb-\a\xe4lP\xe6\xeb\xed\xf7\xd5\xe1\xed\x1fP\x86Td\xaa+\xff5\xd4ֺ\xb6\x12k\xb5hA\xe0H\x8c1\x84QJ\x1a\x1bt/\xc1\x841\xb6\x1fc\x13sNp\xe6\x01\xd32SY\x89\x85\a\x128\xac\x05\xc3R0\xa5\xa0a\xe0\xde8+\x98\xedua\xeb\xee\x02\xb9\xbc\xa2\xb3\xd8\xe7\xc8\x17\x17\x88\xe6\xda,\x1eX\xc1ꬺ\xe4\x96|\xa4\x9b\x15\xbd*\xc3.ŒC\xa4\u1a9c\xe0\xb0\x1eD\xd2\x02\xbap\xfcB\x19\xf2\xe7\f\x90\xb3\x0f\x0f\xdev\x02\x1e\xff\x84\xb2\x04\x16*\xe71\ue0b3\xb2\x14v\xe3\x81ʁ\xc4w\x15ZC\xd8O!ј0\xc5$\x1a\xe5I\xd2Ԭ\xbb\xaa\x1aئ`\xb7+H\a\xb6\xe4Xj\xf9jb9\x98f\x06X\x02\x17)\x98\x1c\xf6\x18\xaey\xa4\x89\xe5\xe8\x13\x1d\xa6/\xab\xa1\n.K\xc7Z4f\xdb\x10\xa7H%p<\x85\xe3\n\xa4\x12Lm\xcee\x9f\x01{\xf3\x92Ђ\xb0\xb0j8\xf0\\\xac\xdb\x05\a\xe4,)9\xe5\xc1\u007f\xfa<\xb6wZA\xf5\xbcJK\xa0\x84@\x9d\xe5\xecX`\xac\xe0\xe09\x02\xcf\x154VB\x0e=\xd2\xc8\x1e\xd6SH\xf7\\\xcfhM\xcfoW\x96\xdb\x02\xc1f\aJ\x02\xf6:\xb0k@\a\xbd܁Q\x05\xab+1\x87\x1fo\xf3\xd8C\xab\\|\xe74\xd5My\x94#\xf1\x14\xac\xccvH;1&֨\x82\x8b\x90Y\x00Y\x1a\xf2\x98\x98\tH\x81kr\x92\xc3&SY\xc7u\xc6]~\xde\x03r\xb6\x18\xcf\xd7\xdb\xff\xe0û\xfeZ\x1a\xbc\xc1.;Ǆ\bP\n\x86\xf3*\x8b1\xb4%1\xd9Ϟ\x14\xf8\xbe\x83\xebI\x16g\xbb\xf4:i\x16\xbc\xb9\x92\\^\xad\xc7\x16k\xa7\xb1\x19\xdbe\xaf\xcc2\xb2Y\x8d\x1f\xaeqaL\x81\x95Y\x1d$E\xf2\xe2\x9f\xda\xc3\xd8\xeejV\u007f\xb7P\xac\xb8t\xea\x11FglI\xe5+\x84ܸ\xf6\xc4t\x8e\xb1ɀ\x92+\xb8&\xaf\x98\xcdr'S\xcf{\x1br\xbe(އ\x9f\xeb\xc2Ï\xa9̎l\xc8F\xf6\xefᜢ\x96W\x99]\x18T\xear\x83R\xaf\x94\x02?Px\x9e\xe0\xc4\xfeU\xa4\xb4\xa8\x9c\"Ȼ\x04\x81\x1a\xb4'\xb3\xc1\xbb\x1a\x18\xdch`\xecw;Pt\x04F\x80цm\u05cer\xed\xf7o\xc3\x1b\n\xd0\xfd\xac\xd1&\xed\xc5\xe4\n\x8a\xb4\x9fb\x13\x8d\xd5\x06'\xef\xa2\xce\n(\x85\x80\xa9\xadyf\xb6幢$\x99Eз\xfc\xe2D\xad4\xbc\xa6\x11\x9e\xf7\x80\xac=\xe8\x89zۤ\xf0\x13\xa7\xa5\xc0\x91r\xbdv.\x94 N-7nέ\xc7\x1c\x8e\x148O{\"\xcfUT\x86=N\x1ch\x12\xf5R\xfc\xa2\x83p`bS\x1e\x9d\xd8u\x03/\x95Xw\f|\x01U%PJ\x90D\x9a\xeaD\x9e\xdb~f/\x97\xbft\x86\u0090?`\xa6\x98\xac\xbc\x1b\x1b\\OҮG\xac\xccv0&\xbb\x96?\x92\x1bT\x18\xd7Ɉ\f\x8d\xfalݜ\xe3\x97G\x1c\f\xec\x05\xfe~\xa2V\xf2.\xa4aW<\x87\xc7Z\xed\xc4B\xbb'\xc4\xcd[-[\x12m\x06ԝ,/\xf4#WVȩ\xaf\x1d8\n!\xb0\xc2\xd2i\xa7t\x1a\t\xd3{+xU\x8f@)\x9a+\x11Q\x98f1\x8a\xdcp\x12\x1cW\xe2\xe5\x14c\xdb\xcb\\\xf3\x9am\\t\xc7&r\xf9\x81\xc24\x90F)6֤\xbd\x84\xb4\x13\x93&\x96\x853!\xc7\x1e\xef\xf0\xd4c\xab\xd4\xcf\xf4\xa9M\xe5ɏ\x16Б\xcej\xf3\xda\"\x04\x049\x85\x8c4\xdbS\xc3g\xfav3\x10U\xf3\xfe\x17\nς\xb7\xfbm\ad\r\x94j\xdeO\xfb\x96\x89|\xa0n_\x8c\f\x91\x81\xc8Wl\x9e\xf4\x19-(\xb6\x15\xb2\x92\xeeه[r\xf1\xf2\x1ehK\x12i\xa4\x12\xcc\xcf\xf6\xa9\x8c\a\x14\x87}\nS\x05\xc6wVQ\x81CP\xf1\x18\xdaT\xa04\x9egd\xa6\xc0\xcce\xc3\xec\xbdm\x92\xad7N\x10\x14\x1dl\x92-&\xa9AG)6\xd2Y-\xa5\xde\xc3$\x86\xfd\x0f\xac\xd2\xeb\x19v^W㚗O\xb3\xe3\xa6q\xdc\xc0\xc1\xa9\xf8\xe4\xb6T\t&\n \x05\xba\x1dc\x8d\xc5/8\x04\xab\t%\x81\xf8jh_\x90Z>\xba\xb8Ҟ\xbf\x10-wߒ\xc1\x01g\xeamK>\xff\xd4M\xbb\n\xf6\xaajY<ވ\xb9~\xccg[AQT\x82\xc8\xd8s|0\xa1\x04n\xc9\xc5\t\x1c\xfc\x9aOzܒ\xa4\x1a\xd7\x15\x1c\xfar\x9dꨏI\f\xb9\x8aϾ;\xa60fP\xca]\xf3\xd3\xe4\x80*\xb9\xe6\x1eK\x8b\x0e5h\x8bՙ\xaaJ\x9a!$\x9aG\xeeo\xd0j&\\q\xc7\x143\xfb\xaa\x19\xcb\x12\x81*z\xd9utV\xdc\xcaϔq\xcb>\xad\xc7\x16\xf1\x90\fO\a\xdc\x16v\x89\x87\x9d\xe0\xfd\r\xfd\a\xc0\x8b\xed\xf3]e\x9d}\x14\xf2\xfe\x981\xf6\xfb~qw1\xb8b\xd8%\xaf\x04\x8d\xc8\xd0\xd1Pv\xceu\x89\xad\xb6\xb89\x17\xbf\xe6\xe3\xe4\x1c\x82\x8aO\xb7\x9e\xcd\x1dY8\xd1%(8T\xc7\x02l\xa2\x11\x8eB\xb9\x12\xa66A\xa9\x84\xe8\xf72\x1a\xe1Y){\xd3M\xb3Rob0aJ\xd2\fѝ\x88\xfd_n\xb0ZO\x18\x9d)\xb0\xef%S\xd8\xc1\xdf\tW!\xdcg.\x8d\xf2\x1d\xa4\xe7\x10\xd5\xfb\xf8y\xc5\xcab̥\x0e\x1c\x8a\xed\xb6\x86\xeb\x1d[Zi\xef\xff\x8e\x01d\xa8\xe0\xbb':\xf1k\xf7\r\xfb\xd5\x0f\x87E>_\xdd̩\xcd;\xf8ǥ\x1e\xfft&\xa4\x11j\xca\x18\xc6\x02\x85\xb6YR\xb18S\xcc\xe2\x01GR\xd9T\xc0Q\x82ƙ\x1eg\x8ev\x18ݔ\xa7PvH;\tBI\xa4\xb4\x88$\xceX\u0383:\x8a\xee'\xe8Ala\u0094\xb4\x13\x13-uI\xbb\t\xc7\x0fv\x99;\x19\"$L\xef\xab2\xbe\xa7\x82\x89t\xd6\xe6\xe0\xa9̠\v\x01\xae\x97qD\xd7TJ\xc9\xc7\xf4S\x92\x81\xedi\xb6R\xae\b$\u007f\xd76/\x88\xc2\xf8\xf7\xbfS\x00\x91I\xac\u007f\xf0ʋ\xa6_;v\xf3u\xea%\xdfs-\xb7^\xbd\x8d\xed\x935\xb6\x8c\x97\xc8\xe5-\x8b\x85\"_ZIэ\x0e\xfb\x86=\xa2\xd8\x10\xd4\x02\xa4'\xb3z\x88\x10\x04\x15\x9f\xa4\x93\xd0\\\x8eX\x98\xedQ\x19\t(\xd5<H5\x84a\xc66\x89uf\xb0;\x11\xa6\x9b\xa0\xc3\x04ӏ\xd1\xed\x18Ӎ1\xa9\xe1\xf8\xc1\x0e\xc7\x0fv\xa8\x8d\xfaYK\xf6T\x81\xd1-EL\x92\xa5OT\xce\xcdh\xaa\xd5\x1a\f\xd72U\x18\x85\xeb\xee\x96Sp\xe9\x9dj#\xa5`e9\xa1\xec\xc0ǻ\xd6Q\x81\xf7/\xfd~|\xe6ymC*\x85\x80\x1f{͕\xc5\xcb\xf6\x8e\xff\xea%{v\xb8\xbe\xe716\xac@X\xea\rM\xb9:\x04\x16\xc6K\x1e\xa3\x17O\xf1\xb9'\x05\xb5\xc5\x16W\f\xfbtNv\xa8\uea6e\xbb\xcaʑ\xec\xbai\x82N3aq\xb6˃\x1f\x9f#_v\x19ۜ'Wr\xc9\x15\x15C\xe3\xc1z\xb21\v4L\xa6\xb6b\xcd\xe2\\\xc8\u0099\x88\\\xd9岛G\x99\x18sy\xf4\x81\x06+\xa7zYa\xd2f\\Ե\x82\x98\x10`s\x05\xacr\x10\xfd.$Y_\x85*y8\x81\x83\xecj\xa4\x14\xc4\xc6\xd23\x16%\xbe\x03lH\x94\xa4\xd4*\xf9\x1f\xf8\xb9\x1f\xbe\xfe\xc7\xc6jy6M\f\xd8#&\x8b\xd2W[\x96\xd5\xd5&q\x12\x83\x05\xbf\u0cfc\xd0\xe4\xf2\x92B\xf75\xcaW8\x85\x8d\xba\xb7P\x82\xe9}U\xc2N\x82\xa3\x04\x93;JD\x9d\x84\xe6RHj$C\x93\x05T\xceC\xf8\x0e\xc2s\x109\x0f\x19x\xc8R@u\xa6\xc4\xe6K\x87\x19\xdb^\xa2:UD\x15}\x1c\x9dr\xfc\xf1\x16\xb5\xcdE\n\xa3y\xa4\x02\xe1;\x18\x03a\xbb\x83i6Qq\x84\xd0\xc9F9\xd9Z\x9c\xa2\xcf\xea\x91UZ\x8d\x84Ot\r_\x0e\xed}\xf5\x95\xf6\xef~\xb3\xeb\xf5\x9cJ\xc8\xdaȾ[o\xd8\xfa\xa3۷\x0e\x11\x85I6>\xc3\x17$\xa9\xa5\xd9\x06k\rq\x12\x13'\x9aV\xbb\xc7r\xa3͕\x9eD\f\xb8l\xb8\x00\x00\x1fzIDAT\x02F@\xf7T\x17'\x9f\xd5)\xd6\xe3\x12k\xb9\xf2\xa53Y\x8c 2\xf2\x9cP\x12\x94B'\xe6\x9c\xecqVy\x92\b\x9b\x05\x91I\xbc\xd6LjA\tF\xf7\xd4\x18\x9d\x8d\xf8\xf2ߝ\xe0\x92\xef\x99&\xb7\xb9ȡ\xa3\r\xee9\xd8\xe2\x91\x13=J\x15\x97\x8b\x87]\xae\xdb;\xc45{\xca\xf8\x81\x84\xc4ฒz\xdf\xf0\xf6\x15\xcd'\xfb\xf6Ԑ\xe4\xe5\xab\xfc\xdbm\xcf\xdfV@\xd6F\xf6\xdd\xf1\u009d/J\x13\xbd\xaeG\x8c\xb1\xcc\xd7an\xb1\xc3\xe1#\xc78yz\x89(\x8eY\xedŜ<\xd5\xe1{&]\xe4 ?\xa5#M\xebX\x8b\xca\xee\nRJ\x84\x93ٓ4\xce\x18\xed\xeb\x0e\xb3!\xf3\xaeĀ\x18a\xc1\x0e\xb8Z\xe7\xe4\xeb\xc5\xd3*d\x9eb\xdfMc\x1c\xb8{\x9e\xaf|\xe8)̐\xc7\xc7\xeb\t\x8f\xf4\f\x8b\x16\x1a\x1a\x8c\xb5\x8cܷ¾\x89\x1c\xaf\xbc\xb8\xcc\xcb\xf6\x94\x98=\xb4\xca{\x0e\xf68\x1cY\\\xf8\x1fǗ\xdb\xcd\vAr\x10\xdf\x02\x83\xbe#:\xf0\xdbG\x10\x1bi\x8eC'R\x0e<y\x86G\x1f{\x92\xb9\xa5\x15\xac\xb5\x1c?\xd9!\x9a]%К\xb1\x92\xc7\xef\xdc0Ba\xadgÂ\xf4%C{\x87\x90\u0380:*\xc4\x06\xe1\xcbX\xd6\xfa\xa52f\x8aA`1IFd\x10\x8e\xc4\x1d-\x0fj\x1e\xcf|@\xd3\xeaӛ\xeb\xb34\x17\xf1\xf8\xfe\x06\x87\x11\xcc\xea,\xaf\x14\x02\x1d\x93U!\x87D\x86\xbb\x1d$\xde<\x01\x8fk8\x98r\xe3\xe9\xe5\xf6}\x17b\xb1\x9es\xa3\xfe\xfa\x97_\xbe\xd3q%I\xa2QJr\xeaL\x87O|\xfa1\x8e\x1e;A\xa3\xd5gi51\aOt©(\xccW\xa5\xc5\n\x98kF\xbc\xe7\xc0*7O\x17\x98.\xb9T|\x89\x89\f\x8d\xc7\x1b\x14\xa6\v\xb8E\x17\xe9H\xa4:\xab\xa0b\aA_\x9a\x81\xf1\xc5\xc5\x04\xdb\xe8rMU\xa2r.J\xb0Ng]\xd3d\x19\xd9Ē\xf4b\xbc<\f\x8d\xf8\x8c\x8c\xf8x+\x11SBp\xdc@\xd3BN\x0e\x80X\xaf\xe1\x9c\xd5\xcc\n\f\v\x16O\u007f'\xe4\xb2\x00\xfe\xef[_\xf1\xbd\x9b&*w\n!\x98=\xb5\xc2;\xde\xf7\x05\x9e<|\x8a'\x9ejqh\xb6\xfb\xa9ç\xba\xffmX\x983\xc3\xc2^\xbf\x96\xcaSRp\xb4\x99p\xf7l\x97㭄\xcbGs\x14=I\x9af\xa4鴟bc\xbbΥ:\xbb\xd2ec\x8d\xe3K\xfe\xfd?\xcd\xf3\xb1\x83m^\xbd#G0]\xe1=\xfb\x9b\xd8f\x9fQ_\"\x1d\xc5g\x8ew\xf9\xc3{\x96\xd9\xeahƤ\xc1\x88\xacs6\xf0=:\xdd\x14\x15i\xa6\x94\xa0&\xa1 \xa1(\xb2\x9a\xbc:\xabR\xd9\xcf@m[\xf8\xa30\xf0\xdb\x17\"\x97\xf5\x9c\x8f\xf8\x9b\x18+^\xaaS\xc3\xfc\xe2*\xef\xf9\xbb\xfb8xl\x89{\xf7\u05fb\xc7\xe7z?\xb4\xdaN^\xdd\xe9\xf6\xfeR\n\xf1\xe4\x1a_\xdb!\xa3}^D\u0088\x82C\x8d\x88\xb7}i\x81G\x96B\x8a\x9e\xc4\x18K\xd2J\xe8\xcewi\x1em\xd1_\x8e2\x9a\x90\x00\x94\xc4\x1a\x83P\x82N\xa8\x99\xed\x18B\r\x91\xe7\xf0\xe6\u007f\x9e\xe3-w\xd7IW{t\xe6[\xdcu\xb8\xc3\xe7\x0e\xb7\x18\x93\x86V\x9aU\x01\x95\a~N\xb2sO\x85\xf2\x90Oj\xb2b\u05cc\x84=*+xe\f\u007fxBÓ\x1a\x8a\x82NE`.T\xc6\xf79\a\xc4qԶ8I\xf9\xcc\x17\x0e\U00045bdc\xe1s\x0f-\x1f\x8a\x12\xb3{\xa1\xde~\xaf\xf4d\x9b\xc9I\\k\xad\x18\xac\xa9g-\xc3\xd6\xe0[\xcbV\x930i5\x8d\xbe\xe6\xad_\\\xe0O\x1f\xaa\xaf\x13#\xb0`RC\xfbd\x87\xe6\xa1&\xa6\xafA\x1bl/\xc6\x1a\xb8r:O\x94\x18\x8c#H\xad\xa0\x1dj\xaaA\x06X\xe2;4Cͻ\xee\x1c\xe6\x96\x0f/Q\xefkZq\xb6\xdd\xfd\x9c\xc0\xf1\x1d.}\xc1\x18\xe5a\x9ft@\xecF\nv\xa9\xac\xe0\x15\x00\x8f&pJCǒ\xabI\xe4\xcd\x13\xe5\xef\f@r9\xb7\xfaؓ\xa7y\xf7\xdf?£G\x9b\x1fu\\\xf6\xcd-\xb7\xcfL\xd4J\xd4\xebmrIO9\xb0\xc5Œ\xb3\x96\x9a\xd5T\xac\x1e\xe8w\xc1\x98\xd1L\x99\x94)\x05w\x9f\xe8\xf0\xd6/-r\xa0\x1e\xad\xf7\x89\v)Hz\x9a\x95\xc7V\xe8\x1c]\xc1\x84\tq+\xe4'_8\u008d\x9b\x02\xf2Jf\xd5J%\xd9;\xe4\xd0K\x01\xcf\xe1\xa7\xf7\xe4\xf8\xd0\xe1>\xad\xc8\xf2\x0f\xc7C\xfe\xec\xd1\x0e\x8bʥ\xb0\xabJ\xed\xcaa\x1e\xce{\xfc\xda\\̩\xc0%\xdaR⡡\x1c\v\xda\xe2Zh\x0f\xb4dj\xa1\x0f\xa5@\xe2\xdc3\xdf\xfa\xce\x00$\b\xdc\xe0}\xff\xf8\b\xfb\x8f\xac~\xa6\xd3O~tn\xa9\x93\xae\xb9\x87\xe3\xb5\x12ChG\v.w\x81\x9a\xd5\x14\xad\xc1\x97\x92\x8b\x8b\x01W\x94s\\\\\xce1\"!o\r\x15\tO5\"\xfe\xe8\xc1e\xfe\xea\xd1FV\x8fϦ4c\x85\xa0߲t\x9b\x96p)dw\xd8\xe7/_2DQ\x1aD'd\xb2\xe2\xb2w\xd8Ᾱ\x88Ѣ\xc3f\xdf\xf2ɓ\x11\x8e\x84/\xce\xc5T|\xc9\xdb\x0f\xf4\b\xaa\x01n\xd1\xe1\xaf\xef\xab\xf3\x13\x01ȭ%\xder\xacǟ\x1c\xeb\xf1\xe7\x91$\xcd)\x06\x940tv\xaa\xbe\xa5\xf0\xbc\xaf\x18\xaeW\x0e\x17څ\xcf~\xf9\xcc\xc2b\xa3\xf7\x83\x9d^\xd88\xbb\xe6\xbePo\xe3\x19\x02\x8d\xb8y\r\fO\n.+\xe7\x98\xf0]\x8a\x8e\xc2\x15\x82\xc4Z*\xd6\xe2a(\x8bl(\xc0gNt\xf8O\xf7̳\xd0M\xcfiUN\x13\xe8\xb5,\xedS\x11\x81\xce<\"\xb3\xdc\xe5\x8e\xedyNw\f\x87VS\x94'yh>\xa2\x15gI\xc3G\x96cFr\x92\x93\x8d\x18\\I'\xb1ܶ\xdag\xcbM\xe3\xbc\xf9\xbe\x15\x9a\x9d\x14_\xc0\"p\xb4\xe8s\xf6\xa4\x0ek\xa1k\x18z^\x01R\xablL\f\x1d\x1f.\x9ecB~\xff\xed_Hza\xf2\x83`\x17\xcf7r\xb6/\xc4\xcb$\x14*\x03\xaa\xe8E\x85\x80\x8a\xb3\xe1\xfc\xcd\xf6c\xb4\xb1\x8cx\x8am\x0e\x04R\x10X\x8b\x03\x9ch\xc5\xfc\xda\xdds<0\xd7_\xaf}s\x96[\xdb]5\xf4\x9a\x16\x11k~\xf3\xba2_\x9c\x8b\xe8%\x19\x99\xf8\xa9\x96\xa67\xd8\xea\xed\xd8r\xac\xa5\xb9~\xdc\a\x01\xfd\xd3].\xde]\xe6\x97>\xbbDpV\x82*і'\xda\xe9zO\xbb\x19\xd4\xf0c\xc4\xf3GBF\xab\x05\xea\xcd\x1e\xc0\b\xb0}a\xa5s\xf6\xafӿ\xf8\xe0\x83\xaf:vj峗\xed\x9eX\x97\x8c5\x95\xb5\xa9V*\x00\xffu\xda$Hk\x99\xf4=\xc6|\x974\x1b\xe5M;5,D)y)\xd9Y\x0e\x98*\xfbl\xcfI\\)\x90\xd6\xe2\x0ff\xaf\xfcу\xcb|\xe0\x89&\x9d\xd8\xe0\xc8s#\xbf\xb8o1\t8QBWH\xae\x9d\xf4\x88\x96{\xacDv\xbd\x96\xef*\xc1\xbf\x9c\by\xdd\x0e\x1f\x93Z\x8c\xb6\xfc\xcfC]\xba}}\x0e\xc8\xc6B_d}\xedk\x1f\xa7\x197Y</\x00\x99\xa8\x95XZ\xed\xa2`\xf3\x0f\xbd\xf2\xda\a\xde\xf0\xaa\x1b\xff\xbf\xb5k\x9e%\r\x8f\xd4\xcay\xf6\x1fz\xe6d\xee\x14\xfetȚ-\xbbuLё\xec.xh\x9b\x8dQr\x95\xe4h/c\xae\xcf\xe4<rRR\f\x14UW\xb0\xcd\xcb(Db`\\=%\xf8\xe7cm\xdev\xdf\x12\xb3턳\xeb]Bf\x03b\x02W\xf2\xf6\x1f\xdc\xc2\v\xa7\x03L\xaa\x19\xab\xb8\xac\t\xa2\x12\xf0\xf8JJ\xbd\x19#,\x9c\x96\x92'\x1a1\xea<\xab\xa3\x94\xc4\xc9\x06\xb4\xad\xb7\xf0]ȩ\xfc\xdft\u007f\xc8\xe4H\xf9\xa2b\xc1\u007f\xf4E7^\xb45\x1f\xb852\xe6\xe899\x9dz\xabw\x0e\x88\x03\x83\xfeZ\x03?ps\xdaG\x00{\x8a\x01j\xb0\x1d\vŬ\xc1\xb3\x1e\xa7\x14\x1dI\xcdw𤠔s\xc8\xfb\x8a!W0e\xcd9\xadeR\xc0b'፟_\xe4++炒\x84\x96\xb4\xaf)/\xac\"M&\x01\x97O\xe5(\a\x1b\xaaQ\x03_\x99\x8f\x11\xa9&\x15\x19\xe0\xe7;\xdc\x01\x97\xd8=+\x1d\x96X\x9b>/\x00\x19\x1f.\x8e&\x89~\xef\xcb_|iy\xdbt\r\xd7S\x056\x9e\xf5\x19\xa3Y\xd7\xc0\x18\xad\x95n\x93\xf0\xa77\xa5a\xbe`\r\xe3\x81Ku\xb0]s9\xc5̖\x02\xcdA\xa2pkΣ\xa8$\xd5\x11\x9fђ\x87\xef*<W1\xe1\v\xcak-\ng=S\x19\xc3\xef|q\x91\x8f\x9c蝓\xf0m/\x1b\xa2N\xd6裵\xe5\xfa逩\xf2\xfa\xa3\xe2+\xf8\xf2b\x9c\xf5\xb4\xaf\r\xaby\xfab\t\x98\xcc+\xbcAp\xb8\x06\x88\xb44\xbe\xad\x80L\xd4JL\x8e\x94D\x9c\xe8\x1fݵu\xe4\xca\u05fe\xf4*Rm\x99\x1a\xab\xbag_s\xc0\xcdr\x9e6\xcf\xf6z\a>tE\x1a\x8do6\t\x06\xc1\xd6\xc0_\xcf\x02\xcfl+\xe2*\xc1\xd1n\xccL\xe01\xeae\x9d\xb2c\xe39j%\x0f%\xa1\x94S\x04\x9e\xa2\x86\xc5}\x1a\x81\xc8\x00\xc3\xd2\xf2\xe1\x03\r\xbe8\x1f\x9e5\xe5\r\u009e\xc5\xea,\xc9\xe9\xc4)?z\xdd0\xbdج\x13\xbe\xbd\xc1\xaaۯ\xc1Vp\x95dk\xd1\xc1$f}\x80\x80̤kǷ]B\x8c\xc5\xf3\\\xf9\xab\xbb6\x97\xf0\\\x17)\x05\xb5\xa1Biۦa\xefi\xd2\xf1\x97\xc0=\x00\x93\xb5Ҍ\x81\x8f\xef\xd5qm\x8f\x89Im&\x01y%\xd0\xc62<\xe23\\\vhƚ\x00ˤ\xef\xe2\b\x81\xe7+\xd2\xc4\xe0;\x12\u007f0\xf4\xac\x92s)*(\x9agjp;\xc8Ⱦ\xf3\xb1\x06\xad\xe4\xdc\xd5\xed\xb53JP\xb7\x19\xf3\xe3\xd7\u05f8h\"\xeb\x02\ue15a\x97\xde0\x8a\xf5\\r\xce \xbey\xba\xfd\x90p\xd9D@\x9c\x18\xaab\xc3v\xf8\x82۾m\x80\xac\xedv\x01o(有B 7\x18\x83\x9e3q\xcb\xf5\xbb\v\xd5b\xb0nC,\xbc\xc0q\xe4\vǇ\x8b\u007f\x90\xc2\xff\x9b1i\xf5\xea4\"\x01ʎbG\xdeG[p=\xc5\xe6m%\x8c\xb1,\xf6R\\!(8\x19\x81N\xcal\xb2\x9c\x10\x90\xf7\xb3\x04uΓ\f\x17\\\xf2\xd6\xe2\f\xaa\"\xe2iu\x85v\x98\xf2\xbe\x03\xab\xf8g\xb9\xae:\x81$\xb2Xk\xe9\x9dj\xf2\x9e\x9f\u070e6\x96\xef\xbfz\x18U\xf4y\xcbG\xcf0Rr\x9f\xe1\xad1p\x1e\xb6\r\xb9\bk\xf1\x06b\xe5g\xc0\xec\xfd\xb6\x01r\x96\xb1\xfe\x95r\xc1\xc1\xea\x04\xbd1tpz\xd3x\xb5\x94$\xeb\xe0\xed\xd5Ǝ]wQ\x95\xa1\xb2\xff\xcb\xc2\xf2]ץ!}\x91M2\xbd\xb4\x18d.\xae\x80\xd1\xf1\x00\xdf\xcb\xec\xc8rw0\xe3\xea\xac%^+D\x15<\xb9^\xef.\xe5\x1c**\xcb\xc0\x8a\xb3N\xce\xf2\xbe>u\xbaۻg)nzk\v,X\xefSLV\xfb̐\xf2\x81\x9f\xddŭ{ʼ\xec\u007f\x1dd\xa9\x9d\xe2\xab̋:G\xea,\xcc\fyT\x80\u0080G\xe6K\x18\xcb:Ţo\xabʚ\x1c-\xe5\x80=\xa3U\x97V\xa7G\x9a$\b\x01\xb9\xc0\xf3\xe6\x17\x9b\x17u\a\f\rc\xecͥ\x9c\x13\x8c\f\xf9\\u\xe9\x04\xfb\x94\xc6\xc5\"\x81\x1dy\x9f\xdc\xc0\xaf\xf4|\xc9H\xcdǭ\xba\b_ъt\xc67X\xab\xa3\x0fv\xa3r%Ŝ\xb3\xbeX\x81+)\xba\x02\u007f\x10\xb7س\xea\x1d\xa1\x10\xccI\xe7\x03u\xe9\xdc\xf4\x17G\xbb\xaf9\xd2I\xd7#l\x9dB\x1cZ\xac\x94\x84s\x1dn\x9f\xf6yў2o\xfa\xeeI~\xff\xfbg\x18\xca+ʹs+\x13ajx\xd5\xe5U\xeas}\xf2\xc0\xd5.\xfcF\x1e\xaa\x99\x82\xb8\xff\x82\x01ri9`_\xc9\x13\xdb\x03%\xc6\xce\xfa\xc5m\x13\xe72\xbagj\x1b\x11\xb80\x19\x15\xbf\x94wHӔTg\v82Td~\xb9\xf5;\xebq\x86暉\x9a\xef\xa5ڲ\xba\xd4e\xb2\xdf\xc7\x02\xa3\xbe˦\xc0\x1b\xccA\x81r\xc5'\xc8989\a\xa9\x049\x01\xc9:\x17*3\xc2q\xa2\xb3\xfe\xf2\xc0\xc5\x1bxd\x8e\x14\x04\x9eb\xc8\x1a\x06\x95t\x14`\x10\x1c\x91\xde\xef\x1dX\xe9\xbd\xeep\xa3\xf7\xf0\x03\xf5\xe4\x9e{\x16\xe3\x0f\xa6\xf6܀\xd1ꌔ\xd7|r\x99\xe9\xa8\xcf\xef|\xcf$9a\xf1\x02ɖ!\xef\x1c\x9b\xe4;\x92\x1f\xbef\x98\xd9#-\x02%ؤ\xa0,\xb3\x01\b%\xc1c\x17\f\x90M㕙\x9b\xae\xdc\xfe{\xd7_\xb6\xe5\xbf\xdcq\xe3\xce\xd7ܺc\xf4\"@\xdd=\x9f\xa9\xa6\x99b\xe6\x01\x9d\xacw\x98\xae\x15\x87\xa7\x86\v#\x03\x0f\x10)3c\x1cE\xf1\xa0\xac\t/\xb9i\xef\xbe\xf1Z\xe9\xad\x13\xb5\xd2\x16)\xb9fz4G/Դ\x0f/2\x84\xa5\xec(\xf6\x15\x82\xf5\x05\x04\xc1P\xcd\x1b\xa4>\x04\xa8\xac\x81\xa7\xa3\xcdz\xae\xc8\x18K\x1ago\xe3\t\\Aa\x10?X\xa0\x94s)`\xd6\xd5V\n\x9c\x91\xce\x13s\xf5\xf6\xaf\x03\x8c\xd7J\xac4\xda\xf1\xbd\xf5\xe8\x1dOu\xd3\xf5\n\x92N3[\xb2v\x9dx\xb1K\xe7\xf0\xf2zi\xf8\xea-yRcI\xb4E\x1b\xcb/\xdd>A\xe3P\x93\xd6RȄ\x82q\x01]\v\xd3\n.u\xf8\xca\x05\x03\xc4\x183\x1c8\xf2W_p\xc5\xd67_u\xf1̇\xbe\xfb\x96}\x9f\xf9\xa5W^\xfd\xce}\xa3\xc5\xef\x068ى\xb8z\xa6\xb6懿^\t\xf1)k\xecϬ\x8f0\xb2\xd0h\xac\"\x84 I57\\\xb9\x9d\x9b\xae\xde\xf1\xe6V'z\xdfXտx\xa5\x97\xf2ؓ\r\xa6\xa3>#\x9e\xc3\x15\xe5<\x83\xae\x84AZ\xc2\xe2y*\x9b\xf2\xe6d]K\x9e#\xe9\xa4\x1bޓ\xd6\x16clր\xe9(ʹ\x8d\xf8\xa1\x14(r\x8e\xc4\x1f\xa8\u0086P,\v\xf5\x06\x80\xb1Z\x89\x85\x81\xcd\xfb衕{\x16C\xf3\xc5\xf5m0\xb0%i<\xb0;R`\xfa\t\xf1|\x1b\x93Z^{\xe5\x10\x93e\x97\x97^R\xe1\xcf~d\x1b\xbfpU\x85\x03\x9f\x99\xc3\xf176CMB\x0e\xf6\xfb\x82\xf6\x9e\xd1\v\xd3#\xa2\x8ai\xdak\xac\xb6n\xc9{b\xf3\xa6\xc9\x1a\xb5\xa1bir\xacr\xd9\v\xae\xdd\xf1\xda\xe9\xa1\xc2m_>8w\xd7\\\xab\xdf\x03\x18+\x05?\xb6s:\xf7r\v\xd7wC-gF\xb3\xa1\x90\x95r\x9e\x89\x891\xa4\x90ĩ\xe1\x92\xdd\x13\x1c?qzfv%t&\x97\xda\fE\x11{\xa5e_)X\xafc\xc0\x06\x05wf[\x96\x9b\vj\x01V\x1bN\x9e\xe9rl\xb1\xc7T\xe0\xe1\f\xe6\xed\x95J\x99k\xed\x0e^#\xb1\xd0N֥\x12`\xa1\xa7\xe9IɪP\xbf\xd5\x13\u20fd~L\xf7ܒj\xd2\x11N\xf4}\xd3\xc1\xf7ٳl\xa7N\xb2q\x80\x8e\x9b\xa1d\xa3\x14\xe1(J\x95\x80\xd7]S\xe3{/\xaf\xb2\xb7\xe4p\xe4SgX\x9a\x0fק\x04\xad\xa9Ǟ\xe5\xef\xff\xb8\xc7G\x9e\\j\x9b\v#!\xc2醑\xf9\x97\xa7f\xe78v\xec$i\x92\xaeM\x86\vn\xb9v\xe7K~\xfb\r\xb7\x1e\xbaf\xebȝ\x99r\xb1W\x16}\xc5e\xdb\vj\xb4\xec\xe2\x0f:\x9d\xac\xb5\xac\xd4\x1b\b%\xd1Z\xd3l\x87D\xab=\xee\xe8\xb7\x19\xd3\t{Lʶ\x82O\xfei\xc9!)\xb3\\\x93\xa3\xe4Fӧ\x92x\x83|\xd1R\x9cf\x04\xf44k=K\xa2Lm\x95\x02\x17\xdf\xcdԥ\x1dHI \xc1 \x8e\xc7B|`\xa9\xde>ohwב\x95\xf7\u007f|.jy\xf2\\\xef\xa9\xdf6t\x1bf0\xea\xd5\x12\x9dn\x92\xb6BJ\x0e\x98\xc5>\xcb\x0f\xd79\xf5T\xe7\x1c0\x06\xb9\xb8\xd4\n\xee}b\xa9}\xc1\xde\xc7\xe4<\xdaꙫJ\xfeW\x93\x84\xe8\xd4\xe9y?\x97\xf3زm\x1a\x85Cdc\xf6\xed\x9a\x1c\xb2B|\\\xdds\xe0M\xcdN\xef\xf2\x9c/iwSF*.\x06\x8b#\x15\x9e\xeb\xd0h4\x88qx\xf0\xbe\x83\xec\xff\xf4W\xb9\"\x89(H\b5l\xca{\x8c\xfb\xeeƸ\x8c5\x16\x8f\xcdZ\xdb\xd6?6\xd9\xf4\x1fߕ\x04\xaed1Nؚ\xf3\x88\xb5\xc9Ԗ\xce2\xb4\x8e\x84\xb1\xb2O\xb3\x93\x01\x86\x10\x8c\xe4\x14'\xfb\xf6\xc1\xc3\xf9\xe1YV\x9e\xf9\n\xd7r\xb5Hk\xb5\xc3\x1f\x1c\xec\xfc\u074bƼ\x9fv\xe5\xb9MBI\f\xa6a)\x8f\n\xac\x81t\xaeI?\x94\x84\xcb1\x8d\xd5x\xfd\xe5eO\xe3P\xcd\x1dO\xb9\x8f\vxH\xb2\\\xcc|\x92\x98U\aX\x98_f~n\x81v\xabC\xb7ݥ\xb5\xda\xe2\xd2ݓ\xfc\xbb\x97]\xfd{\n\x11(\x91\x8d\x02\xaf\x16\xb3i:\xf9 \v\xa2>\xf7\xd5Y\xde\xf7\xc7\xffH\xfb\xe3\xf7q\x95\x8e\bd\xc6\xca؞\xf7\xd8Y\xf0\xcf\x01\xe3lP\x8c\xb1ă\xe13\xd6X\xa4+\xc9\a\x0e\xbe\xabh'f\x10\xa7\b\xfa\x83\x11\xaeF[\xb4\xb5LU\x02<7\x03SI\xc1p \t\x91s\x9c:y\xdeD_k\xf0:\xa6\xc7W\xd3w|\xe4txN\xb0\xb8\x9e\x1a\xf17\xfcf\xa3-a#\x01%Xm\xc4\xe7\xa8\xc75\x1b\x12Z\x1e\xf9\xaf\xa7\xdaG.< \u00866\xb5I\xb6S\x12\xfa\xdd>K\v\x8b\xb4[\x1d\x1a+\xab\x9c8~\x8a\xe9\xb1*\xb7ݰK\x84aB\x14ir\x03\xb9\x9fk\xc4\xfc\xd5\xfb\x1f½\xf7\t.^mP\x95\x1bi\xe9\xad9\x8f\x1dy\xff\xdc\xf0\x19\x9e6\x8cX\xd0i%\xd9\xd8\xd64k\x1f\xab\x94]\n\xbeBc\a$\x03\xe8v\xb2ʠ\x1d\xb0\x12=%\x98\xac\xe5\xb2W\xda\t(*\xc1U\xae\x9e\xa18\xee\xfe\xab߸\u05fd\xef\xfez\x8c\xfb\xb4\xc8O\bp\xbclڵ\x18\xb8\xc5\x00q\xa4\xe9v\x92\xf3\x12\xda\x16,\xef\x04x\xfdd\xf9\x02\x03\x02\ba\x11֒&\x9a\xb0\x1fa\x8d\xa5\xd3\xee\xd0\xeb\xf4\xe8\xb4\xda\xd4\xebuj\xe5\x80\xd9\xf9\b\x1diΜ\xe82\xbb\u007f\x85\xdc\xfe3\xdc\xd9n2j\xf59u\x81)\xdfew\xc1?g\xf1=O26\x99'\xc89Yߞ\u0378\xbd\xcdV\xb2>(\xd7\x02c#9J9\x85\x14\x82\xde`\xc2i\x9aj\xa2H\xaf\xcf8\xb1\xc0\xa6Z\x0e\xc7\xcd\xe6\xa8\xe4\\E\xa0\xc4\xed\xb7\x89\x95\xcb\x00F\x87Ω\\\xb2m\xa2,~\xee\xa7_\x02\xc0\x99\xbey\xaa\x9b\x9ag\x00\xa2\xd3l\xa8\xbeN7\x00i\xb7\x12\xfa\xdd\xf4骊\x1e\x9c\xfa\xd5\x13\xed\x0f\x01\xbcw\xaeua\x01\x11\x16\xcf\x11\xa8l\x88\xbd&\x8e\x92\x8c\xdf4\xd8}\x16\xc1\x97\xbez\x9c\xc7\xee?\xc4\xd8j\x9f\xad\xabm\xf6-\xb7x\x8dLyi\xc9#\x18,\xca\xda\x14ҭ\x81\xc7E\xf9s\xc1P\x8ed\xf3\xd6\x12[\xb7\x17ٱ\xa7\xcc\xf4La\xa3\xd4\xdaNH\x13\x93\xcd\xd7\x15\x02?\xe7\xb0s<\x8f\xa3\xd6\f{63\xab\xd3N0\xe9\xc6h\x8d\xa2\xa7\x18\x1b\x0e\x06|j\xc1\x90#\xca\x15\xc1\xf7\x03,52\x155\\ɿx\ufd91?j\xf4\xd2k\xff\xcf_|*\x8b\xba\xb5\xbdg\xb6\xabϥ\xf9\xda\f\x84\xa8k\xe8\xb5\fz\x80\xc1\x99\x93=\xc4y\xf2Z\x87S~\x0e\xe0\xf6\x89\vۧ\xee\x008\x02\x8d\xb1F\xd8L%\xc4a\f\xa5<\xca\x11\x9c\x98o\xf3ԑ%\xa6\xba=\xc6\xd3,\xfd0鹌\xb8\x8a\xa2\xa3XM4\x91\x19\xbc\xa9S\t.\xceg5\xf1\xf5v\x8b\xb5A`c\x01\x95\xaa\x87֖ Px\x13\x01\v\xf3}zݔ(\xd4ı\xc1\xeb\xa7 \xc0\xb8\x82\x8bgJ\x1c8\xdd\xe5\xccB\x9fm9\x8b\x14\xd0\xefk\xd2\xc4d\x1d\xb1\x8e@X\xd89Sbi%\xa4\x1fk\xf2\x9e\xa4*\xd2_\xf7\x86J\xff{ܕ\x9b\xbf\xfb\x85\xdb~wr\xacx\x9516\xf8\xea\xe3\xf3\x1f\xfbؽG\xd7\x16\xff\xd3\xc7:\xfaG\xb6\x14\xd49ͦFg'd\x9d\xbcG\x0f\xb6\b\xc3\xf4\x9c7!(`\xd1\xf0\xc1\x0fD\xfc3\xc0\xa7\xe7/\xec\xac\x13\xe7\xbb\xcb^~\xd9p\xaa\x90\xda\x15,\x9b\xb2\a3,-w8|\xb4N\xa5\xdbg\xaf4\x83\x97\b\v\xa6}\x8f\xaa#q\x85`%I9֏I\xace{\xdec\xca\xf36\x18\x19v\xe3,W<\x86G\x83lN\xbbe}G\x97\xab\x1e\xddNJ\x92\x18\x92\xc4d\x03\x92\x8d\xc5\t\x1c\x84#\xb9\xf3\xb2\x11\xdey\xf7i\xdaZSq\x14:\xb5\xf4\xfb)~\xde\xc1U\n\x9d\x1aj#9\xb6L\x14x\xf2D\x1b\xdfU\xec\xccI^\xac\xf5#\x97\xbc⒡\xb1Jn-\xa0\fwl\xa9*\x06\xef\xa0^\x8c\xcc\xfd\v\xa1N\xf9\x1a\xdcf!\x04\v\xa7{,\xce\xf7\xcf\x01CdqǓ\x1dx\xd3c\x8bm\xfd\\\xb0tԞ\x9c\xfa\xb2\xc2֭\xe5\x9ajљ\n\x13Kc\xa9Gxr\x85\xediLEfoC\xf0\x84`s\xe0Q\x19\x80\xb1\x9ch\x8e\xf4#R\x03\xa3\x05\x97\xdb/\xaa\x11ǆt0:I\f@\xd1ڲ}W\x89r\xc5}F\xe1G)\xc1\xe2|\x1fk\xa0\\\xf5(\xe4]T^\xe1\xe4\x1dtO\xa3\x12\x83\xef+N.\xf4\x19\x1ad\x82\x1dG\xe1\xfb\x12ח\xebi\x95\x89\x99\x02\xf5\xa5>\x896\x94\xf2.\xc5n\x98\xcbM\x8f\xe0\x94skb\xda\xfe\x93w\xdc\xf7+\xb1\xb5\xf1D\xad\xc4\xe9尷\xad\xe2\xee\xde)\xec%66\xf4C\xbd\x9e\t\bC\xc3\xe2|\x9f3'\xbb\xe7\xa8\\\x178iX:\xa1y\xf5o\x9el?\xf9\xd9\x1d%\xdeو/< /\x9e)\xfd\x01Ƽ\"ֶ\x16\xa7x~+d$\x8c\xa9Ȭ\xael\xc9&\x8cn\x0e<\xf2R\xe0H\xc1\x998\xe5T\x14\xa3\x80ݓ\x05n\xbeh\x88\xa1!\x9fڨO.ﰲ\x1c\xafgd\x85\x10\xec\xde[\xe1<u$\\W\xb2<\xdf\xcf\xde\xee\x19(\xaa\xc3>ґ\xb8\xa5l\xf8K\xb4\x12#\\\xc9\x13\xf3=F\x95ZO\xaf\xfb\x81\x83\xeb\xab\xcc3\x8b\r\xf9!\x8f\x9c\xa7X\xaa\x87\xd9\U000fa4a5S\xab\xf83#(ϡ\xd7K>\xf3\xc9\aO\xfc5\xacMS5əD\xdeO'\xd9ڭ\x87{u#\xa6\xb5\x12\xb1\xb2\x1c\xb2\xbc\x14\xd2\\\x8dש$k\\\xde\aSxw\x9f\xd7\u007f`\xbe}\xb7}q\x89[f\xa1ջ\xf0\x80HO\x11\x15\x03\x99+J\n\xd3\xdd>5\x9d\xe2<m:ψ\xe7\xe0g\x11\x18\xa7\xa3\x94\xf9(ɦ\xab\x8d\x16\xb9n\xf7\x10弛\xf5\xca\b\xc1\xe8d\x8e\\^e=3\x16\xfc@\x9d3\xba\xfb\xe9\xa1Uu$ȼ\xd1^\xe6֦\xbd\xecm\aN\xc1A\xa8L\xfalѥ?P\xf6\xfdPg;\xb9\x9b\xae\x1b\xe5\xe6\x99>\x93\xd3\x05&\x86\xb3\xfe\u0082\xaf\xa8D\x11\x9d\xc7f\x01\xcb\xc2J\xef^\x80\xabw\x8f\xaf\xdfy\xee\xffo\xee\\^,\xbb\xaa0\xfe\xdb{\x9f\xf7}Uխ\xeeNu\xb7I:\x9d\x87\x89\x89\x90\x10\x8d1!\x83\x88\x82\n\"\x0et \xe8X\x9cF2\x15\x11\xfc\x03\x1c;\x8aHā$\xe8Ƞ\x98\x81/0\xe9\x16\xf22\xafN\xa7;\xd5]]\xef\xfb8\xaf\xbd\xf7r\xb0Ͻ\x9d\x98\x8e \xe4\xd1\a΅*\xa8[p\xbe\xbd\xd7>\xeb[\xdf\xfa\xd6\xde\xf4\xed\x97Z\xbe\xfd뒣\xbf\x98\xcb\xf7\xceT\xfe\xc97*\u007fyb\x85\xd4\x04\x87\xd3D\x05\x93\xe4\xf3\x0e\x9e\xaax\xec\xec\x95\xc9\xd3\x00\xabg\xe0\xed\xed\xc9G\x11\xb1\x88\\+\xaa<h\x18\x890\x8d#\xe2.\x856\b\xday\x8c\xf7\xc4(\xbc\b;ֲY[\x8c\xd1\xdc}j\x85\x87o\xea\xe1\x1dˤOIp\xdc)\xfa\x11\xf3\x99\r\xb6\xab\xe9\aw<(%\xac\xafgl^\x98SϻѦV\x10+\xa8$\x98\xecǭg\xe3h\x8f\xd7^\xde\xe1\xdeA\x86\xf5p\xb0W\x93f9\xb6\xf1\x98D\xe3jG;\xf5\xdcqzDU;\xf6\xe7\x96Q\x11s\xf8\xea&\xe5\xc91\u007f\xf8ǅg\x00\xfe\xf9\xef\xcb,\x18\xe0\xcb;\x13\xfeV\x8b\xddߝ\\\x01\x9ex\n\x9e\bKt\xd0\xff\xcc\x1aw\x1c\xd3\xdc\xe7\x14#/\xe4\xefx\x9e}}{\xf2\xe7\xc5\xdf\xed\xef~4`\x00\x98;\x9d\xfdq\xcf\xfbȍR\xe2A\xc2\xca\xf15\xe2q\x1f\xbd\xd6\xc7\r\vZ\xc0T\r{\xadc\xa7u\xace\x11\x0fܺ\xc2\xdd\xc7r\x9a\xae\r@\xa1\xdeSHJ\x12\xc3\xd6f\x89R\x8a^?\xe6\xc8\xd1\xfc}\x99n\xd8\x1f\xc1$l{\xab\xa2n<\x1b'\v\x8c\xd1\xc4\xc3А\xe3*G;o99J\xf9\xd5\x1b\x13NkH\x8c\x0e\x89i\x11\x11E:\f\x97\xd4\n\xdb8Voȉ\x9c0--\xdeC\xeb<[\xe7v\xb9\xfd\xae\x8d3\xf7?p˗\x12c\ue323\xe8_\x17.\xef{\x80\xea]\xe4\xe3\xca\xda \xfc,Mse\xdel\xbe9k\x9e{k\xd6\xfc\xe5\xfc\xacyvo\x1e&<\xcfʆ\x8f\xfa\xd2#qU,\xc1\x1fOyO=/\xe9ŚA\x1aqt\xad\xc0*\xcd\xd4:fγ\x91\xc5|\xe1\xf4\nG\x871\x95\rV\xae\xbe\xbb\xc5\a\x8a\xdc\v\xe4=C1\x88\xf1\x02\xdapM0\x16'\xbfҊ\xd5q\x8a\xb3\xc2lb\x97ټH\b[\xde\xc3\xc8\xc0#w\x8dy\xe6\xb0!Q\x01\x80\xed+\x15\"\x82\xeb\xf4\xb9\xdez\xea\xd2q\xech\xc1(\x8f\x19䆵<\xa2\xf0\x8e\xe4\x8dK??q\xa4\xf7\xb3\xbbN\x8f\u007fzϭ\xeb\xd7ܲ\x1f\xe5\xaa\xff\u007f\x13C\x89\x14\xf8yhKnꖦnQ\"\\|m\x8bb\xfb\x10\x01\x8eg1\xf7\x9e\x1e\x92\xe6\x06\xeb\x833\xe7\x82\xec\xb3^\xb0\x12\xc0p\"K\x05\x89\xf7\x1eD\xf1\xbf<@\x8c\x86\xd1j\x82\x89\x14\xbb\xbb5Zu.\xa4\x04#~\xa5\x15\x95\x13\xbe{c\x81?6\xe0\xcdy\x83&t\xd3Φ\x0ek\xc3B\x10\x81\xea\xa0\xc1\xf4cn\xb9\xa1G\xa4\x15Ej8V\x18\xdc\xe1\\\xcfϾE\xe3\xe4ɗ\xcf\xedX\xae\xe3K/\x0e\xee\x9e\xf7\x94sK\xdb8\xe6\xfbs\x0e_ߢ\xb7=!1\x8a\x1b\a\x19w\xde2D\xc5\x1a\xe7\xc2Ψ\xad\xa7\xf6\xe1\xe1\xd3%\x86^\x16\x0fG1\x9f\x05\a\xb8\xd9̆\f\xfe\x83v\x89Vd\xb9!\xcd\f\xbb\xdb5\x88\xc2\xd5\xdd\xe4\x9c΄\x06\x81\xb9\xf3\xfc\xe0\xae!\u007fm\x85\xda{\x94\x82\xc3\xfd@\xf1؎\x96\xaf\xa6-mi\x19\x1c\xc989\xce\xe9g\x11E\x12\x94\x8e\x9b/^䕿\xbf\xf1\xc2+\xe7\xb6\xdd\xf8Cv\x81\xfb\xd0\x01YH$\xf3yC\xff\xb0Fo\x1d\"\x93\x92\xc8\xc0ͫ\x19\x9f\xda(\x10%]\xe5.\xc4\xe6\xd6w\xdbK\xe4j\xe8\xf2\x8b\x8ed\xe1\xf0\xb0\x05\xad\x98L\x1b\xe6S\xbbL\x16\xaf\x11\xb5(\x8a\x88<74U \xf2|\xe5\x96n\xd6\xe98]R\x177\xe4\x86O\xdfPp\xae\n\xb4GU9\x9a\xda\xe1\xbdǻ`I2\xbdR\xa3s\xc3\xc6F\xc1(\x8fX\xe9E\fS͚x6\x9a\xe6;\x00;;\x13\xc6\xe3\xe1\xf5\r\x88\aVM\x90\xfa\x8b\b\xb1R\x9cZ+\x18\xaf\x05\xfbm\xe7\xc3*_P\r\x11ݙ\xe1\x03(ޅ\x90%^h\xad\xa7\xee\x8c\\P\x8a˗\x02\x1f$\x1f\x10\xbcL\xa4XYM\xd1F\xb1\xbb]\xe3k\xbfT\xa1Ey\x84\xe9<\x16\x8d\x86\xaf\x9c\xeas\xde;<a\x81\x1c\xec\aj\xdcw#\x90\xeai\x8b\xad\x1c\xf9J\xc2Ƒ\x9c^\x1a\xb1\xd6\x0f\x03\x8bO\xf9\xe6ѯ\xae\xe7\xbf\r\xa0\x1c\xb2~\x1d\x82\xa2\x17\xab\xb4\xa7\x83\x9bt\xe9=\x89Rܼ\x9e\xd3\x1fF8/\xb8\xee\xe1[/8\xef\x97;!\x1c\xe2\xfe\xea\x99\"\x1e'`m\be\v\x1f\xc5E=a\x91\x9b\xbcOi\xe8a|$E)\xc5\xde^\x13\xbcI\xba\x8c\x1f\r\xe9j\xba\x9c\x16z\xd3j\xc2C\x1b}^\x9e\xd5\xc4Zqx\xd0ж\x8b\xc5\xe0Q\xba\xdb%\x89\xe1\xf8\xc9>\x83^D\x16k\xd6\a\t\x83\xccpG]~\xf3\xeb+\xc9\xef\xc7\xe3Q\xb1\xbds\xc8\xc6u\x16\xbe\xb4R\x8aDkj\x11\x1a/\xa4Zst\x18V뼴\xccf\x96\xe9\xa4\xe5\xe0\xb0a\u007f\xaf\xe1`\xbfe2iiZ\x87\xa8\xe0\xd1.\xfe]!\xab;W\x82F*\x80RV\x8eIW\xe5\\\xfc\uefef\xa2\x17\x91冪tԕ\xc3\xce\xed\xd2e9\x19%\x98.\x9fi\xbd\xf0\xc5\xdbB\xe6\u007f`\x1dZ)\xf6\xb6\xeb\xa0n\xecإj\xd2Ж\x0e\x95jn\xbfu\x84\x88\x90ƚ\x13\xab\x19E\x16q\xca6_\xfb\xb2\x9d\xfd\xe6\xc8\xfapusg\xc2\xfd\xab\xf9u\x03\x88y(U\x8f[\x91\xdc\x13\xba\x93\n\xad\x11/Te\b;M驫\xf0\x90\xea\xdaS\x95\x96rn\xc3<?\xebɲ\xc5$\x01\xf5\xae* \\\xb9\\u\xc4\\H\xfb\xabڳ\xba\x96t#Ry\u007f\xf6\xae\xc06\x9e\x83\xbd\x86\xd1jJ\xa2!]O\x97\x94A\x94E\xd4;up\x9f\xce4i#<\xbf5\xe3x\x16S՞\xe10\xd0-\xaa{-\xb6\x95#\x1f%đ&3\x9a\xcd\xed\x92$\xd2\x14\x89\xc1z\x81\xda\xdev\x93\xb7\x8f\x9e]?\xf1\xcbw\xb6v\xed\xb7Vb^\xaa\xfc'\x0f\xc8\xe7\x12\x1e\x17\xc8c\xa5H\xb5ƘД\xa2;wc\xe1j\x8b\x90\xd6*L$\xe8^y\xabұ\xb7\xdbP\x96\x96<\x8b\x88\xe2`m䝰\xbd\x15\x00\xe9`\xa2\x9c\a\x1a{4J\x16\x93\xc2\xdeۂ\xe6CV\u007f\xf1\xfc\x8c\xe1JB\x91\x1b\x92Q\x12Fq\x03:Ѹ\xb9\xc37\x1e/0\x1eg\xbcza\x8a\x88Pt\xc9\xe2\xeaZ\x8asAE\xe2[A\x19E:\x8cIZ\t;i\xd2\x10G\x9a$҈\b\xd2\xd8\x13\xf7Փ\x1f\x1e\xeb%\u007f|z\xbf}\a\xe0\x9e\xf5\x1e[\xf3\xf6\x93\x03\xe4\xf3)?\x89\x942\xbdĐ\xe5\x86<\x8bH3C\x96\x19\x8a^D^\xc4a\x17D*\b\x12\xba\xf0\x14p\n\x1aض\x16\xf6wk\xa2H\x93u\x12\xcc\xed\xad\xb2+,\xb1t\xf19\xd8o\xc8rCQ\xc4(\x1df\x1c^-\x10\tQ\xa4\xb9\xb4Y\x92e\x86\xd1(\x0e\xd4I/^jD\xa3,\xa2ޫ\xbb\xef\x83^\x1e\xf1\xc2\xc5\x19\xebI\x84\xb3B\x9a\x1a\xe2D#.\xa8Yڙ#\xee\x19\xd2~Bn\x14\x93\x99\xa5n\x1d\xb1\xd1\xc4Q\x98\x95\u07b6>\x1b9\xf7\xfd\xcf\xe6\xe6\xe2s\x0f~\xe5\xec\u058b/q\xe3\xb8\xcf\xc1ǐ\x95_\x13\x90\a3\xf5X\x1e\x9b,ITG\x81\xbc\xb7\x90\xafTx\vʲ(\x00\x94\x87;\xed\x9c\xdeZ+K\r\xee|\xd6\x12\x19Mыؾ\\/k\t\x8bO\xad\x14\xbb;5\xb6\xf5\fG\xc9R\xf2\xbf4\xecQp\xb0\x17\x14\x1e\xe3\xf5,0\xbbk\xe9r\xb0\xae2\n[ڐ8*X\xe9\xc5\\<\xa8\x98\xcc-\xa3\xc8PV\x8e\xc1 \xb9\xaah\xd1POZ\xd2QB1L\xd1^8\x9cZ\xbc\bq'7R\x1aZ+F9\xff\x8d\xbb/\x9d3\xcf\xd7\U000a70f2a}<d^\xd6\x1f? \x8f\x14\xfaG&\"\x03\xe5\xbb\x01\x06\x02J\xba\x81\xcf\x02\x88\xea&\xc8*\x85\x18\xad$\x8e\xb4\xa4\xb9\x91^?\x96\xfe \x96\xb6ub\xad\x88\x12\xc5t\xd2\xfa\"\x8fd:\xb5\"\"\xa2\x94\x12\xad\x94h\x1d\xces\xa5\x95\xccg\x96\xdd\xedZ\xf5\xfa\x91\x14\x99\xa9#\x85\U00082208\xec\xed4R\x97N֏\xe5\xa2A\xd2\xd5TЈ\x9dYѩ\x16<\xd2\xceZ\x01Dkd}\x98ʙ\xf3\x13\x19DF\xb4C\xda\xd6\xcb`\x10\x87\xff\x05\xa2\xb5\x92\xb6\xb4*]I\xfc\xdaZ&\xfb\a\x8dƋ\xb3N\xa4\x9f\x1a\x89\"-\n\xa4\xb6\x9e\xd8\xfb\x87\x8f\xe5\xc9\xc9Wj\xf7\xbbO\x02\f\x80\xff\x00.\xa8\xa9\xaf\xda\xc3V\x9c\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var C_20_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14)\x13\xfd1'\x82\x00\x00 \x00IDATx\xda\xec\xbdi\xb4$\xe7y\xdf\xf7{\xdfګz\xef\xbbo\xb3\xef\xd8\xf7e0\x00H\x10 AR\xa4D\xd22\xb5XK\xacHN\xeccE\x1f\xacHT\x1cڎsl\xe7\xc8q\xe2\x1c%G\x8a$G\x94B\x1dR\"E\x12$\xc1\x15 \x01\x90\x00\x01\f\x80\xc1\xcc`\xf6\xb9\xfb\xd6ݷ\xf7\xee\xda+\x1f\xaaoc\x86\xa0\xa4\xc8\" \x0e\xcd\xfa2sgnwU\xbd\xff\xf7ٟ\xf7\xff\xc0\x8f\xae\x1f]?\xba~t\xfd\xe8\xfa\xd1\xf5Ct\xed\xdaq#\x1f\xff\x93\xe7\xbf\xe7\xff\x1d\xdaw\x9767s\xbd\xb5c\xf6\xfa\xdc\xe1\x83\xf7\x94o\xbb\xe5\xedS7\xdf\xfc\xe0\xae\x1b\xae\xbbo\xdf\xf8\u0601\x03\xa6>>\xfa\xef~\xebwŕ\x9f\xb9\xe7\xaeG\xaf\x99w\x17?H\x0f35q\x90\xd5\xf53ß\x0f\xec{@\b\xd1\xdb\rb_\x1c\xc7{\x12\x92]B\x88)\x12t)EFJi+\x8a\x9a\xd7u#gY\xa6\xa5j\xba\xb6\xbe\xb1~6\x8a\xc2S\x13\xe3\x13Ϗ\x96\xc7^D\xcc\x1d\xff\xd4_\xfc\x8fɏ\x00\xf9\x1b\\;f\xafga\xe9U\x00&\xc6\xf6ۺ\xa1\xff\x9a\xa6*\x0f\xaa\xaa\xbeO\bi\n!LM\xd3,)\x85*\x84\xc4v\x1c\f\xdddtt\x94\xd9\xd9\x1d躎\x94\x82f\xabM\xb3\xd9\xe0\xdc\xf9\xd3l\xd56\x02\xdb\xcet\n\xf9b+\x9b\xc9?k\xdb\xf6gb\xa1|ꓟ\xfc?\xbd\xed\xfb\xde\u007f\xf4\xbd|\xe3\xe9\xcf\xfd\b\x10\x80\x91\xd2n\xf2\xf9<\x17/\xbf\xc4\xf4\xe4!\xa1(ʴ\x10\xfc\xba\x94\xca?6-\v)$\t\tRH\xa4\xa2\xa0k:N&\x83e\xd9X\x96M&\xe3 \x10\x94\xcb#\x14\x8bE\x92$a~a\x9eN\xb7\xcbVm\x93^\xafK\xad\xb6N\x1c\xc7H)\x19\x1d\x9d\xa2\x90/\x10EїH\xf8\xfd \xf0\xbf\x9e\xcf\x17:\xeb\xebk\u07b7\x9e{\x1c\x80w\xbf\xf3\xc3|\xfe\xf1\x8f\xff\x97\a\xc8\xc4\xd8>\xd67\xcfoK\xc4\x01)\xe5\x87\r\xc3\xfcu\xc7qLӲQU\x15!\x04RJ\f\xc3\xc40LL\xcb\xc42M2N\x06Mӈ\xa3\x18\x04\x18\x86A\xa9T&\b\x02^;{\x06\xdf\xf7\x89\xe3\x88~\xbfK\xbd\xb6I\xa7\xdb\x02 \x8ec\x92$A\x91\n\x96m\x93qr\xfd\x8c\x93\xf9j&\x93\xfb|\x18\x06O}汏\x9d\xfe/RBF˻\xa9\xd4.mی_\a~ٶ\x9d]\xa5\xf2\b\x86a\xa2\xaa\nR*H)\x91R\x92\xcdf\xc9fr\x18\x86\x8e\x94\x92$I\x10B\xe08\x0e\xa6i\xa2\xaa*R*\xf4\xfa}^;\xf3\x1aQ\x14\x01\x10\x86\x01\xbd^\x97\xcd\xcde\xa20\xb8\xea\x19\x92$5)\xba\xa63R\x1eedd|1\f\xc3_\xfb\xccc\x1f\xfb\xf3\x1f{\xf4gh\xf7Z<\xf1\xe4g\u007f\xf8\x01ٖ\x8c\xf1ѽ㊢~B\b\xee+\x14J\xa2T\x1eAU5\x14E\x01@JI&\x93\xa1T,\xa1i\xda\xf01\xa5\x94X\x96I\x92\x80\x10\x02EQ\xb0-\x1b1\xb0\x1f\x17.\x9e'\x8a\"\xa4\x94\xc4qL\xe0\xfbԛ\r\xd6\xd7\xd7Qe\x82\xaa2P\x83\x02!$\xban215\x97\xfe\x9fTH\x12\xfe\xf9\xe7\xbe\xf0'\xff\n\xe0m\x0f\xbe\x9f\xaf?\xf1\x17?\xfc\x1225q\xf0\x01)\xc5c\x8a\xa2:\xc5R\x99B\xa14\x94\x06!\x04\xb6mS*\x96\xb0m\x9b8\x8eӇ\x14\x02MӉ\xe3\x18\xc30\xd0u\x9d$I\xd04\x15UՁ\x84J\xad\xc6\xfc\xfce\x12@\nA\x1c\xc7\xc4I\x82\xef\xfb\xf4{=:\x9d.\x9dn\x970\f\x99\x99\x9d\xc1\xb1\x1d\x92$!\x8e#<\xaf\x87*\x92\xa4X(\x89(\x8a\x1e\xf3\x02\xef\x17\xbf\xf4\xe5OV\x1ey\xe8C|髟|K\xd7Gy+o6=~\xf0\x06EU\x9eUUU/\r\xc0P\x14e`+\f\x8a\xc5\"\xe5\xf2\xc8p\xc1A\x90*\x97\x04M\xd31\fc\x00\x8e\x86\xaa*XV\x0eH\x80\x84N\xa7C\xa3\xd1@\b\x81\x10\x82$I\x88\xa2\x88 \bRI\xb2m\x14E\xa1\xdf\xef\xa3i\x1a\x96e\x0e\x01WU\x8dN\xa7)\x04\t\x96e\xefW\xa4\xf2\xc8\ue747^z\xfc+\x9fXy\xfb\x83?\xce\xe5\xf93o\xd9\x1aɷ\x04\x88Ƀ\xe9\xcdT\xf9A\x92\x84bi\x84|\xa184ޖi11>I\xb1PBU\x94Tp\x85\x00\x12\x04`\x9a6\x9a\xa6\r\x17;\f\x03l\xbb\x80m\x15\x88\xe3\x10H=2!\xc4Uvb\x1b\x98T\x12b2\x8e\x83a\x18t:\x9d\xa1\x1dپ\xb2\xb9\x11\xd6\xd6W\xa8\xd5*\xa8\xaaz\x83\xae\xeb_\xbc\xfb\xaew\x8e|\xed\x89Os\xf4\x9eG~\xb8\x00YYKwX\x10\x04\x0fZ\xb6C\xa1PDU\xb5TD\x15\x05M\xd3\xd0\x06\xe0@\xba\xe8\f\xec\x84e\xd9\xe8\xba>\\\xec$I0t\x8bba\x96Fku\xb8\xb0\x81\x1f\xa0ȫ_G\b\x81\x18\xa8\xc2mOk\xa4\\\xc6\xf3<\xda\xed\xf6U\x00J))\x8dN\xb3QY\xa1\xdf\xef!\x84,\x8e\x8d\x8c<\x03\xf0\xf4\xb7\xbeĻ\x1e\xfe{?<\x80\x00L\x8e\x1f8 \xa52S\x1e\x19\x1b\x1ao!\x04\xba\xae\x93\xcdf\xd1\f\xfdu\x8b&@\xd35\x1c'\x83\xae\xebW}\x8fmg\x98\x9e\xb9\x9eFc\x05\x92+=\xa7\x18)\x957\xec|\x92\x84d\x1b\x9c\x81\xbaS\x14\x85f\xb39\xf4ȶ\x816M\x9b\\a\x8cZ\xad\x92J\xa7\x90\xfb\xdf\xfb\xe8O\xfdo\x00_\xfc\xf2'~\xb8\x00\x89\xa2\xe8H.\x9f\x9f6\f\xe3\xea\x1d\x8c \x9fϣ**\x824\xf6\xb0L\v\xd30\x87\xdeR\xaa\xe7U,\xcbbj\xf2\x10\xbdn\x8d\xbe\xdb\x1cJ\x14I\x1ac$\xdb\x16g\xa0\xa6\x86\x120\xf8\xf9\xf5\x857\xe9v\xbb\xd4j5\xe4\x15R\x15\xc71\xd9l\x89 \x8aiw\x9a\b!Q\x14\xf5\xbf~\xf7;?\xfc^\x80\xdbo}\xe0\x87\x03\x90\\fFڶ}s6\x93Ӷ\xa5\xe3uݝ\x1dJ\x81\xa6i\x98\xa6I\x1c\xbf\xbex\x8a\xa2`Y\x16\x86\xa133}#\x9eס\xd9Z\xbfBݤ@H\xa9\x90\xc4\xc9\x1b$\xe4J\xb5\xb4\xfd\x9d\x96i\x12\x04\x01\xcdf\x93\xb5\xb55\x84\xb8\xfa\xf7\xb2\xf9\x12\xab\xeb˸n\x0f!\x84\xa9(\xca\xef<\xf2\xd0\a\x8b\xdfy\xe1\x89k\x1f\x90\xc9\xf1\xfdؖi\x19\x86y\x8f\xa6\x1bo\xd0\xdb\xc5b\x89$I\xff\xaei\x1aQ\x14\xa1\xaa\nB\b\f\xc3\xc4q2\xd8V\x9e\x9dsw\x12G\x01\xb5\xady\xae\xd2U\xdb\xee\xa2\"\x89\x93xh\xc8\xff\xaaK\xd7u\x14%Uo\xddn\x97K\x97.\xd3n\xb7\x87R\xa5\xeb&\x85\xc2\x18\xf3\x8b\x17\x88\xe3\x18EQf4M\xff\xfd\xedg?v\xf4\xcd\xcb\x1e\xbf\xe9no\xa7[cddf̲\xed\xdf\xced\xb2ʶ\x84$IB\xb1Xb\xa4<r\xd5\x02n\x1by\xd34\x91\x12\xb2\xd91\xa6&\xafCQt\x9a\xed5\\\xb7\x85\x10o\xdcG\x9e\xefQo4\xde\xe0emۉ\xe1F\x10\x02\x92\x84^\xb7G\x14EC\xdb\xd2\xe9t\x88\xe3x\xf8{\x96\x93EJ\x95f\xb3J.\x9bGJyh\xcf\xee\xc3\xd1\xf9\v'\xbf\xb9\xb0x\xfeZ\xb7!\xc9O\x99\x86\xa9_\xa9\xae4M\xa3T,\rmD\x1a\xe8iX\x96\x85\xaek(\xaa\xc6\xd4\xc4u\x8c\x14wA\x92P\xad^$\x97\x1dC\xd3\xcc7HH\x92$h\xaav\x15\xb0ے\"e\x9a\x9c\x94\x8a2\xf0\xde\xd2߉\x93\x98$N\x06v#;4\xf4\xeb\xeb묯\xafS\xdf\xdaB\x91\x92\xad\xfa\x16\xadNsێ\xfd\xb3G\x1e\xfa\xd0C\x007\\w\u05f5\v\x88\x10\xca?M\x83\xba\xe1\x12\xa2i\xfa -\xb2\x9d[J\xb0\x1d\x1b]31\x8c,S\xe3G\x88\x93\x18\xa4 \b\\\xea\xad\x15*\xd5K\x8c\x94w\xc1\xf7\x90\x90\xef\xb6MCp\xaeP\x91ۻ_\b\x91\xc6@\x83\xaf1M\x83|>\x87\xaa\xaa$\x83\xe8\xbeV\xab\xd1l\xb5\x99\x9b\xdb\xc1\xea\xda\x12q\x1c!\x84\xc8j\x9a\xfa.\x80\x13'\x9f\xbd6\x01\x19\x1b\xd9=\xa1\xaa괢jCU#\x84\xc4\xf7=z\xfd\xde\x15\xde\x0fDaD6;\xc6\xdc\xcc̈́\x91\xc7\xca\xea\xab,,\xbd@\xafWg\xfe\xfc%V\x96.\xe1\xfb.\x19\xbb\xfc\x06)QU\x15q\x85\x87u\xa5\xda\x1a\x02\"\xe5U\x80\xa4~v\x82\xaa\xaa\x94JE\xc6\xc6F\x19\x1d\x1d\x19\x02\xe3z>͖\x8b\xaah\xd4\x1b[\x83\f\xb4zӱ\xa3\x8f\xe6\xaeY\x95\xa5\xaa\xfaCi\x94}\xf5.\x8d\xa2\x88n\xb7\x8b\x90\x02\x04H)\xf0}\x9fFs\x99\x8d\xca9l\xab\x84ifI\xe2\x88N\xb7N\x9c\xc4\xd4k[\x9c;\xfb\x12\xb9\xec\xf8\x1b\xa4D\x19\xa8\xa5\xbf.Y\xb7}\u007f9\f4S\x87 I\x12,\xcb\xc4ql\x8a\xc5\x02RJ\xa2(\xa6\xd5\xee\xa2\x1b6\xcdf\x1d)$Bp8\x97+N_s\x80\x8c\x8f\xee\xdd^\x80\xa3\xaa\xa6\xa1H\xe5\xea\xf8C\b\xb6\xb6jx\xae\aI\x82\x10\x920\f\tÈv{\x83\x95\xb5\x13\xccN߈\x10\x12M3\x11\x83\xcf5\xb6*4\x1aU\x8a\xf9i\x92$\xba\xea;\xd5+\x02\xce\xefvw\xaf\xf4\xec\x84\x10 \x05q\x9c \xa4 \x8a\xe24o6\x90\xa8L\xc6annf\x10\a%\xf8a\xfa\xef^\xe0\x81`\x82$\xd9w\xcf\xdd︶\x00٨\\\xd8\xd6\xe6G\xb7S\xe4B\bʥ2\x93\x13S\xe4ry\x84\x10,,\xces\xa5\x97\x1a\x86!BH\\\xb7\xcd\xea\xfa)f\xa6n\xa2T\x9c\xa2X\x1a%Ib\x84\x90\\\xba\xf8*\xd9\xcc8\xdf\xed\xddj\x9a\xf6\xfa\x82\x0f\x16\xffJ\x15v\xa5MQ\x84\x1c\xe6ʶ\xdd\xee\xef\x06nrr\x02H\xe8\xf5\\\x84Th6\xeb\x88\x14\xb8\xfb\xb3NA\\s*klt\uf526\xe9\xd3ꠦ\xb1\x9d*\xd1u\x9dr\xb1\xccHy\x94$\x8e\x99_\xb8L\xbf\xdf\xc7u]<\xcf\x1b\xba\x9f\x9eס\xdeXB\xd3ln\xbc\xe9!\n\x851 \xa1Qߤ\xdbm\xa1\xeb\xceU\x12\xa2]\x91f\x19澶\xf5\xd2\x15\xff\x1e'\xc9P\xbd\xc5q\x82\x94\xbc\x1e\xf5_\x05\xb0J\xa9X \x89c\xfanD\xbbS\xa7\xdb\xed \x84x\xbba\xe8\xdc\u007f\xdf{\xae\r@v\xce]?\xa8\xc8i\x0f\x98\xa6eʁ\xba\xdaN\xb5\xc7qLB\x8c\xe38\x8c\x8dM\xa0i:\xeb\xebkDQD\x18\x86\xb8\xae;p\x87Ӭo\x14\x87\xf8A\x9b\xdb\xef|\x94\x1d;\x8f\x00\x82\xcb\x17O\x90͖\xaf\x0e\xf84\xfd\x8d\x0e\xf7\xf6\xf7|\xd7\ue5c3g\x81d U\xdf\xfb]2\xd9\f\x86a\x10\x061Q,h4k\b!n\xe8t;\xda7\x9ez\xec\xfbos\xdf\f@\xe6\x17_\xddޏ\xc7t\xc30\xb7#pC7\xd24\xf9\x15q\x82\xa2(\x14\xf2\x05<\xcf\xc3u]\x1cǡ\xdb\xed\x0e\x8c\xac\xcdHi'ˋgX^>\xcd\xf8\xe4\x0e\x0e\x1e\xbe\x93\xb1\xb1\t\x96WΠ\xa9\xf6\xd0\xdbJ\xa5O{\x83\xdb+\x84@Q\xafV]\x8a\xa2 \a5{\x00C\xd7\xfe\xd2Z\x9d\xa2(\x14\x8ay*\x95*I\xa2l\xd7\xfa\x85\x14\xca\x01\xe0\xd5k\x02\x10\x80\xd9\xe9#e)\xe5u\xa6i\r\x82\xe3\x980\n\x89\xc2\bE\xaa\x88\x18\xa4\"\a\xb9\xc1\x04]7H\x92\x98^\xaf\x87a\x18x\x9e\x8b\xaaj\xa8\xaaN\xabU\xa3\xd3\xee\xd0n\xbd\x8a\xdb\xef\xb0o\xff]\x1cȎ\xd0\xe9lb\x18\x0ea\x18\x10\x86>\xba\xa6_\x95\x90L\uf6fc\xd1\xfdݮ(\x0eT\xa3e\xdbx^\xf0\xbdC\xda$\xc1\xb6m4M\xc7\xf3C\xb2\x994\x00\xcd\xe7s\xb9kʆ(\x8a:\x93\xc9d\xaf3\rs\xa8\xc2]\xd7%\x8a#\xe28\"\b\x03\x82\xc0\x1fԷ\xc50\xc1\xf7z\xb5\xef\xf5\xbcT\x1a\x9d\xa7N\xec\xf2\xd2<\xa7N~\x03\xcfo\x93\xcbN2Rރ3\x88K\xb6sTW^q\x14\x12\x85\xe1\x1b\xf2[a\x18\x0eR'\xa0\xa9\xea \xdd.\xfeRI)\x95\n\x84a@\xe0\xc7۩y\xf5\x9a\x01df\xea\x10\x86a\xdcbZV^\x19\x04YI\x92\xe0\xba}ܾK\x10\x04\xf8\xbeG\xbbӦ\xd9l\xd2\xedu\xf0\x03\xef\xf5*\xdf@\xaf\xf7z]ڝMff\xf6_\x91J\x87\xcd\xf55._|\x85Vg\x03]\xb7\xd1u+\x05q\xb0\xeb_\xdf\xdei\x10\xaa\xa8\xea\x1bv}\x10\xf8Cco\xdb\xd6\xc0\xf5\xfd\xde.s\xaa>-,\xcb\"\x8a\xd3\xd2r\x10\x04Ǯ\t@f\xa6\x0e\xe18\x19\xa1\xaa\xea\xfbTE\x1d\x04\x81\xe1P=T\xaa\x15V\xd7Vتo\xe1y\x1e~্\b\xfd>\xdd^\x87\xbe\xdb'\nB\x18\xd4\xc4k\xb5\x15\xf2\x85\x11\x1c\xa7p\xd5.\xdfX]\xa5\xbe\xb5\x8c\xe7u\xe9\xf7ӄ\xe3\x95.\xefЎH\xf1\x864|\xafۥ\xd5l\r\x92\x8f1\x9b\x95*\xb6m\r\x9b-\xbeW\xb28\x8ecr\xb9<\xaa\xa6oo\xb0\x1f\xbb&\xb2\xbd\xadv\x15\xc7.چa\xfe\x81n\x98r\xbb\x86\xed\xb9}\x820 \x89c<ץ\xdb\xebR\xa9l\xd0j5\xf1}\x9f0\x8cp\xdd>\x9e\xeb\xe2\xfb\x1en\xdfC\x91\x02E\x91\x14\v\x13XV\x86\x95\x95\vW\xc5\v\x9a\xaeS*O\xe1zm\x82\xa0O\x1c\xc74\x1au\xe2(J\xc1\x10\x10F\x11\x82\x81\x175\xa8\xc7/.,\x92\x90\xa0\xeb\x1aa\x10\xe2\xf6]\xa6\xa6^\x8fk\xd2?\xc5\x1b\xd23\x9a\xaa`\x9b\x06\xaa*\x91RN\xcd\xcd\xee\xf9\x0f\x97.\x9f\xf1~\xe0\x8dz\x92\xf0\xb0\xa6\xe9j\x92$\xb4Z-\xe6/_$\f\x83\xb4\x98\x94\b<\xcf\x1d؉4\b\xccfsds9\xc6\xc6&PU\x15EQP\x14\x95\xdaV\x95F\xb3\xc1\xe8\xe8N&'\xf6R*MP\xafo \xa5\x02B\xe0\xf6]TUG\f\xf4\xfe\xf6g\xb7;U\x92$A \x86.m\xbb\x95fs\x15E\xe1\xd8}G\xb1,\x83F\xb3\xc1\xb3Ͼ\xc0\xf1\xe3'\x18\x1d\x1d!{\x85\xad~Cme\xe0ɵ;-2N\x16Ӱ\x1e\x02\xfe\xfc\a\xbe\x1e2R\x9e\xbe\xc7v2\xef\x8b㈾ۣZ\xd9 \x8eS\x15T,\x16\x99\x9c\x9c\xe2\xe0\xc1\xc3\x1c<x\x98{\xef=ʅ\v\xe7\xa8U\xabx\x9e\x8baZ\x98\xa6I\x14Ex\xbeG\xa7\xd3\xc1\x0fz\xec\xdcq\x04'S`y\xe9\xdcPJ\x14Eejz\x1f\x9e\xd7\xc6\x0fzH)i6\x9bip\x19E\xc4q\x8ciY\x18\x86\xc9\xfa\xfa*++\xabLOM\xf3\xdew\xbf\x87\xe9\xa9YbB\x1c\xc7bjj\x82\x85\x85%\xb6\xb6\xea4\x9bMr\xf9\xdc\x15\xceAr\x95\n\xf4<\x8fF\xbd\x82m9\xa8\xaa\xb6t\xf6\xfc\x89/\xff\xc0KH6\x9b]\x95B\x10\xc6\t\x81\xe7#\xa4\xa0T*r\xf7\x9d\xf7pϽ\xf7\xa3\xaa:I\x9c\x10\xc5\x11a\x18\xb0w\xef\x01\xbe\xf3\x9dgy\xed\xb5S\xac\xae,\xb10\xef\xa3j\x1a\xd3\xd33\xd8v\x86\xf5\xd5%V\x96\xcf3;w\x80\xb1\xf1Y\xaa\x95Ձ\xf1\x95\b@\xd5L\x18\xa4?\f\xdd \x8eB\x00\x1c\xc7!\xf0CΞ\u007f\x8d^\xaf\xcf=w\xdf\xcd\xcd7\u074c\xa6\x9a\x94JӴ;u\xc2ЧX,\xf0\xf0\xc3\x0f\xf0\xdcs/R\xa9\xd4X\x98_`ff\x06\xdd\xd0\xdf %\xf1\xa0\xb9.\b\x03\f\xc3Я\x89\x8a\xe1\xd4\xe4\xae\x1cB\xfcr\x92$\xb4;m\xca\xe5\"\xb7\xdcr\v{\xf6\x1c@\xd7M\xb2\x991\x1c\xbb\x88\xa6\x99H\xa9\xa2\xa9:\x13\x93\x13\xdct\xd3-\xdcr\xcbm\xcc\xcc\xce\xd1\xedt\xb8p\xe1\x1c\x8a\xa2\xa0j:\xcdF\x85\xfd\anES\r*\x95\xc5\xd4\x15-OR\x1e\x99\xc4\xf3\xda\xf4\xdd\x16\xbe\xef\xe3\a\x01\x9e\xef\xa3i\x1a\x9dN\x87\x93'Ob[\x0eo{\xf0A\x0e\x1d<\x84e\xe60\xcd,\x19\xa7@\xbd\xb9\x81\x1f\xb8\xc3\xe2؞=;1M\x93\xe5\xe5e\xaa\xb5MJ\xa52\x8a\xa2^\x05HBB\xa7\xd5\xc04\fL\xd3z\xee\xdc\xf9W\x1f\xff\x81\x97\x10\xcf\xf7+\x9a\x96J\xbb\xa1k\x83\xd6\xcd,\xban`\x1a\x192N\x91\\n\x94x\x90Z\x0f\xfc>\xb6\x95#\x8c\x02z\xfd\x16\x8em\xb3cn'KK\x8b<\xf1\xc4Wh4\xea\x90$<\xff\xfcW\xb9\xff\xd8\xfbX]\xbd@es\x91ѱ\x19Z\xad\r\x84L\x03\xbd͍U,'\x87eYl\xae\xafs\xf2\xf4iv\xed\xda\xc5\x03\xc7\xee\xc7q28vq`\xb3\x8a$$Է*\xb8^\x1f'\xe3\f\xd3\xed\xbbv\xcda\x18:_\xfa\xcac\xcc_\xbe\xcc\xde\xfd\xfb\x86\x06^\bA\x1cEDQ\xb0\x9d\a\x8b\xae\x89H=\x89\xe3\xad$\x8e\xd1t\x8dɩ\t\x1c\xdb\x19t)B6S\xc6q\nd3#i\xfa<\x11\xc4V\x84\x14\x82v\xa7\x86c\x17\xe8\xf5[\x88v\x85={\xf6R*\x95\xf8\xdc\xe7>M\x18E\x9c<\xfd<\xbbv\x1d\xe1\xb6\xdb\x1f\xe6\xd9o=F>7\xca\xca\xdai\xf2\x851\x84\x80͍M\xcacP\xaf\xd79y\xfa4\xfb\xf7\xed\xe3\xde{\xef\xc5\xd0M2v\x11]\xb7\xd91{\x98o|\xf3s|\xebۏ\x93ĤYh\x04N\xc6abz\x92l6\xcb\xdc\xdc\f7\\\u007f3Ͽ\xf0\f\x8e\x93ajz\x92x\xd0\xd1\xe2{\x1eQ\x14\x91\xc9\xe4\x90R\xcc_\x1b\xa9\x13!\xdc(\x8ez\x86\xd4m!b\xac\xc1\x99\x0fð\xb0\xac\f\x81\x1f\xf0\xd9\xc7\xfe/^=\xf5-\xda\xed\x06\x8a\xd4\x18)O\xb2s\xd7av\xef:H!?\x82c\xe7\xa97\xd6P\x15\x95\x9f\xf8\x89\x9f\xe4+_y\x9c^\xbf\xc3ן\xfcs\xde\xf9\x8e\x0fs\xe7\xdd\xef\xa6Z]\xa2٨\xe0d\n$\x89\xa0\xd3\xefqꩧ\xe8v{\x1c>t\x88\xa3G\xefEQTr\xd92\xb9\xdc(\xeb\x1b\xcb|\xfa\xd3\xff\x8c\xcd\xf5ez\x9d\x16\xb5\xad\rB\x01\xb1\x00)$\x9a\xa6r\xf7]ǘ\x9d\xdd\xc5\xed\xb7\xdd\xc9\xd9s'\xd8\xd8\\\xa2T.\xa1\xebi\xca$N\"L\xd3BQT@t~\xe0m\xc8\xde=\xb7\x10\xf8>Q\x14=\xa0\xeb\xean\xdb6(\x15G0-\x8bRi\x8cv{\x8b/~\xe9Ox\xec\xf3\u007f\xca\xe2\xe2<\x95\xcdM\xd67ֹ<\u007f\x9e\xd7N\x1f\xe7\xf4\xa9\x17h\xb66ӝ9\xb9'mTP`ǎ\x9dT\xabU\xaa\xd5\r\xdc~\x93\xe9\xe9\xddH)٬,`Y\x0eq\x1c\xb0\xb6\xb6\xc6\xfc\xc2\"G\x0e\x1f\xe2ر\xfb\xd2nz+\xcfHy\x96WN|\x9b\xa7\x9f\xfa\x02+\x8b\x97\xa8m\xae\xd2\xeb\xb4\b\xa3\x88\x96\xd7\xc1\xf3]\xc2(\xc4\xf7\x03Μ{\x95Ze\x13)\x15\x0e\x1f\xba\x81\x97^\xfe6\xf9\xdcȰ\x1b\xbf\xdfkch:\x99L\x16\xe0\xb7\xcf]xu\xf1\a\x1a\x90\xad\xfa\x1a\xba\xea\x88$\x89\xa7LK\u007f(\x97˓\xcd\x16\xc8\xe7\nh\x9a\xc6\xcb'\xbeœO~\x99n7m\xbbI\xe2\x04Dj4\xc3 \xa0\xd9j\xb0\xb2\xb8\xc4\xc5K\xa7\xe8\xb9[\xec\x9c;\x8c\xae\x9bD\x91\xcb\xcc\xf4,\xb5\xad\xea \xf1\x98\xb0c\xc7\xf5t:[x^\a\xdd0h\xb7[t\xbam\x1ey\xc7\xc3\b!q\xac\x1c\x93\x13\xbb\xf9\xe67\xbf\xc0\xf1\x17\x9fb\xe1\xc2\x19:\xad:I\x1c\xe3\xc6\x01\xbd\xb0?l3\x95R\xa1\x94\x1bMK\x01\x95U.\\<\x83\xa6\xe8\xe4\vyVV\xd6(\x95R\x15[\xad\xad\xa5\x1b\xcc0\x89\xe3\xf87\xce_<\xd5\xfd\x81\xf7\xb2\xba\xfd:\xb3\xb3\xbb4˲~.\x9b\xcd\xe18\x0ea\x10\xb2\xb6>ϳ\xdf~\x9az=m\xe1T\xa4\x8ai\xd8d\xed<\x96ac\xea&$1a\x18\xd0n5\x99\xbf|\x89jm\x81\xb1\xd1it\xdd@*\x82\x9d;w\x11\x04!I\x12\xe2\xd8yl+\x8f\x1f\xb4@$4\xea[\xecٳ\x9bL6\x87T$\xb9\xec(/\xbf\xfc\x1d\x9e\xff\xce\xd7Y\xbat\x86\xc0\xf7I\x04\xb4\xfd\x1e\xbd\xd0%\x01\x14)\x91B\x10F!\x8a\xa2a\x9b\x19TE\xc3\x0f]V֖\xc8\xd8\x0e\x8df\x1d]\xb5\xe8\xf6\x1b\xf4\xda-&ǧQUm\xe3\xf3\x8f\xff\u9ff8f\xd2\xef\x99LfUJ\xb1\xac\xa9\xea\x8c\x14\x12D\xccŋ\xe7\xd9\xdcL#e]5(d\x8b\xd8v\x16Ǵ\x10R\x10D!\xae\xe7\xe2\a\x1e\x02\b\u00803\xa7O\xb3Q\xd9\xe0\xde{\xdf\xce\xe4\xc44\x96\xe5p\xf0\xd0!\x1a[[ll\\`Ϟ;p\xbd*=\xb7\x89a\x99\xe4r\xf9\xb4\xae\x85\xe4\xf8\xf1gx\xfe\xb9'\xd9\\Y@\xd1l\xa2$\xa4\xd9\xdfBWUF2%J\xd9\"\xa6n\x12\x86!˕\x15ڝ-\x10\x90\xb5s\b)h\xb5\x1b\xcc/]FJ\x89\xeb\xf6iw7\x19+O\xa2i\x06q\x1c\u007f\x1c\xe0\xed\x0f\xbe\x9f\xaf}\x1fOZ\xbdi\x9d\x8b\x13\x13\xb3BJ\xf1h\xb142k\x1a&\x9b\x9b+\x9c<\xf9\nQ\x14\xa6\x11\xf6\xd8,\x85|\x91\x8c\x93\xa5\xeb\xf7X\xaa\xac\xe0\x86>\xba\xa6a\x996\x8a\xaa\xe2\x06>\x111n\xb7\xc7\xe2\xe2E\x9c\x8cC&\x93CUt\f\xd3@QU,\xb3\x88\x93)\xd3j\xad\x12\xc5\x01\xa6e\xa2\xab&\x17ϟ\xe7\xe5\xe3ϰ\xb9\xba\x88\xa6\xe7\b\xe2\x84V\xbf\xc6\xdc\xe8\x14\x87v\x1e`rt\x9aba\x04\xd3\xce`\xdaY\xb2\x99,\x1b\xd5u\x82\xc0C\xd3\f\f\xdd\xc2\xd0M\xa28$\b<\xe2$!\xf0{LM\xccQ\xc8\x17\xf1<\xf7\x1f]\xba|f\xfd\xfb}\x98\xe7M\x03$\x97-\x87\xa6i\xbdKJqH\xd75\x16\x16.\xb1\xb6\xb6\x82\x10\x82ى\x9d\x14\xf3Et\xdd`~}\x81V\xbfá\xb9\xfd\xe44\x93\xa4\xe7\xa2 (d\vL\x8eL\xa2+*\x95\xad\n\xcdV\x83\x8d\xf5U\x1c\xc7\"\x97Kϗ\x98f\x960\xeac\x18\x19\x82ȣ٪`\x18\x16\x8b\v\v\x9c>y\x9c\x95\x85\v(Z\x860\x89i\xf7+\\\xb7\xf3 \xb3\x93s$H\xa2F\x85h}\x81pc\x9e\xa4\xb5\x85\x1e\x05L\x8dM\xb1\xden\xe0\a>\xa6n!\xa5\x82\xa1Y\x04\x91O\xdf\xedb\x19\x0e\x13\xe3\xd38v\xf6\xbc\xa6i\xff\xc3\xd4Ԏd~\xe1ܵ\x01H\xad\xb6\x1e\xcd\xce\xec~\x9b\xa1k\xb7JEp\xe6\xcci\xfa\xfd\x1e\xe3#\x93\x8c\x97'@\b.\xac\\\xc4P\r\x0eL\xee\xe4ҹ\xd34\xe7/p8\xe8rK\xd2'\xbb\xb5\x81\xbf2\x8f\xect\x98\x98\xd9E\xa0(\xd4j\x15*\x95\r\xe2$\xa0X\x1c\xa1\x90\x1b'\x8a\x03z\xbd\x1a\xe5\xe2n\xd66\xce\xd1\xefw9}\xf2U*k\xcb\x04A\x84\x17\xf9\xf4\xfc\x067칞R\xa1LT]Ü?\xc5d\xab\xc6\x0ebf\x12\x98q\xfb\x98\xed\x06A\xb3Ffn/\x9b\xedz\xda4\xa1\xa6\x15H˰\xe9\xf5;d\xec<\x13\xe3\xd3ؖ\xf9\x11?p_\xfc\xca\u05ff\xff\x87B\xdf4\x1b2\xa8!T-\xdbJ\xfa\xfd\xbe\xa8\u05f7\xd0u\x9dR~\x04!%\xeb\xf5\r\f\xcdd\xef\xe4Nξv\x02\xad\xbe\xc9\xcf\xef\x9e\xe5\x86\xc9q\xacL\x06S\xd7i\xb5\x9a\\\xb8x\x89\xf9\x8b'\x91\x87oC X\xdb\\\xa1V\xabry\xfe,S\x93\xfbЄ\x83\xeb6\xd3t\xbaf\xd2\xe8m\xd1\xeb\xf6\x91\x8a\x8ePT|\xbf\xc5\xcd\xfbn\xc46-\x94K\xa7\xd9\xdd\xdabg\xbe\xc0\xdc\xd4$\xd9|\x96\xd5\xd55b!\x98h4\xc9Wk\xf4\xaa\xab̌O\xb1\xb8\xba\x84\xae\x99(RAJ\x95R~\x8c\xbe\xd7E*\xcaR\x8c\xf8\xea\x17\xbf\xfcgo\n]Ǜ\x02\xc8-7\x1f\xe5\xf8KO\xa3\xaaʆ\xa2(a\xb7\xdb\xd1\xe28f\xac\x94v\x98$$\xf4\xdd\x1e{\xa6v\xb2\xb6t\t\xb5^\xe3!\x11q`|\x94\xf1\xbd\xbb(\xed\x9c%\xb3g\x8e~e\v\xe7\xdb\xc7\xd1^<Nx\xf1$\xdd}7\xd0l7X]YFS5\xba7\xd4\xc9\xe5f\xd2\n\x9e\xdfE\xd3\f\xba\x9d.\x81\xef#\x15\x95~\xbf\xc5u\xbb\x8f`[6̟\xe5p\xbf\xc3ΉIn\xba\xf9F\xb2\xb9\f\xe6H\x9e\xf2\xec$\xab\x97\x97\x10\tL\xc51\xd5\xfa\x16^\xa1DŶh\xb7\xb7(\xe4\xc7Ҿ^)\x88\xe2\bE\xc8(\f\xfc7\xad\xf4\xfd\xa6\x00r\xfc\xa5\xa7\xb7\xeb\"\xe7\x85\x10\x81\xef\xfbZ\x9a\xbeȡ*\xea\xa05\aTEekm\x85w\xe1qxf\x8a\x91\x89q&︉\xf2\xdb\xee\xc2\xcag\xe8\xafU\x88\xda]:\x8d\x06K_\xfb:\x93n\x9f\xf6\xc4\f\x17.\xbd\xc6\xfe\xfd\xd73\xbfp\x9e\xbd{,\xf2\xd9i\xa4\xaa\xa3\xa8*\x9d\xc1\xd9\xc1^\xbbN9_\"\x97ɂ\xdba\xba\xbeɎ\xa9i\xf6\x1f\xdc\xc7\xec\x8d\xfb\x19\xbd\xfb\x10ή\t\x9ag\x97\xc9~\xfeY\xc2\xe3'\x88\xe3\x98\xf1\xad-\xd6ZMƊe.-^\xc6\xf3{\x98\x86C\x14G\xa8\xaaFL\xdc\x02\xd1\u007f\xb3\x00yS\x1b\xe5\x9a\xcd֩$I\xbc$\x89\aE'\x05dz\x103\x1e\xb4\x03\xa9IB\x91\x04;\x93\xa185\x8e\x96u0\n9\x84m!\xb5t\xbf\x18\xa6\x89P5F\x96.\x92\xcb\x16\x91BAWLz\xdd>[\xf5\x954c\xac\x18H\xa9\xa2(*\x9e\xd7\xc3\xed\xb5\xd3\xfb$\tJ\xafKYHl\xdbf|n\x9a܁\x19\x8cr\x8e\xa4\x1f\x90\xdf;\x85\x91w\xd2cu\xaa\x8am\x98X\xdd\x0eYˆ\x04\xfa^\x8f\x8c\x93\xc1\xb12h\xaa\x81\xeb\xf6+R\xca\xf65\t\xc8\xe9מ\xdf\b\x82 \xb4m\x878\x8e\xd2\x03\x97RA\x1b\xa4\xb4\xe3A\x95\x0f@(\n\xf8!A\xb3E\xd4\xea@\x1c\xb3\xf5\xdc\t\xbc\xad\x06\x9e\xeb\x91\xc4\t\xae\xdb\xc1\x90\n\xd9l\x9ef\xb3N\xaf\xdb\xc1\x0f\x02\xea\xcdE@\xa0k&\xba\xae!\xa4\x8a\xd4\r|\xcfE!D\xb52\xa8Q\x84e\x99\x18\xbaN\x14\x86x\xf56BW\xf0\xebm\"/ \x8a\xd2n\x18\xdfs\x89\x14\x95H\b\x92$Ʊ\xf28v\x16!%\x9a\xa2\x12\x86\xe1\x96eY\x9dkJem_\xc5\xec\xac\xdanwD\xb1X\xc0\xb4\xac\x01\xf9\x8bD\"pL\x9b\x95\xea\x1a\xa5\xd9]\x9c\xbbp\x9c\x89j\x8dVm\vke\x93\xa5\x8f\u007f\x0e\xaf\xd9!\xee\xf4\xe9\xb4Z\xac.\xaf\x90D\x01\xf5\xdd7\xd3q\xfb\x98\x86\x93\xb6\xf1\xc41q\x14\xe3\xba]\x82\xb0\x8f\xae\x99\xc3\xc3;v\xb6@\xa7Q%@\"\r\x8b@3\xa8V\xaaT\x96װ'K\xc4~Hw\xb9F\xeb\xdc\x12\x95\x85\xb4\x9a\xb8\xb1\xbe\x81\x9f$D\xbb\x0f\xd2w]l+\x87cg\xb0-\x9bz\x13\xa4T\xd0\rc\xf3\x93\x9f\xfa\xfd\xe8\x9a\x04\xa4\xde^\xeav;\xbb_-\x14\n\x0f\x96\xcb#\xb4\xdaM,\xd3\x06)(\xe7J\x9cY<ώ\xfd7\xb0R\x9d\xe2\xdc\xfa\x1a\xf9s\xe7\xf1=\x1f\x12P5\x95͍\r\x96\x17\x16Xݪ\xb3z\xe8\x16\x9a\xf9q\x16ϼ\xca\xc8\xd8$q\x1c\xe3d\xd2\xde^\xdf\x0f\x86\xde\xd0v1ɱ\xb2\xf8\x9e\xc7\xc6\xf2ev\xec\xd8\xcf\xea\xc4\x1c\xf9\xda*\xaf\xbc\xf2*}\xd7etj\x9c\xa0\xef\xd1i\xb5\xb9x\xf1\x12+\xf3\v\xb4\xa2\x88\xca\xdc>\xba\x99Q6知\x98\x1f%\x9fˣ\xaa\x1a\xae\xe7\xa2*j\xa4Iu\x19\xe0\xe6\x1b\xef\xe3\xa5W\x9e\xbav\x009t\xf06^;\xf3\x02\x9e\xe7\xfd~\xab\xd5zptt\x8c\x13'^b\xa4<\n\xa88V\x86B&ϫ\xf3g8z\xfd\x8d4W\x8a\xbcx\xfa\f\xe7//`(\n\xa1\xd7'\x88B\xc2\xd2\b\x9b7\xdc\xc3\x021K\xe7O\xd0\xf7}\x8a\xc52#cc\x8c\x8e\x8e\x03`Z\x16\x9af#\x10\x94FF\x06\u074b\x90\xc9\x16\xa85\xab\x88\xa5\xcb\xcc\xed\xde\xcf\x19\xe0pu\x99\x97k52\xb9,BQH\u0090\xb5\xd5UjN\x8e\xa5\x03Gh\x14\xca,]8M\xb98N\xb94\x8e\xed\xd8)\xe5S\x14\xa3\x9b\xba'U\xe5\x14\xf0\xa6\x80\xf1\xa6\x06\x86\xd5\xea*\x00\xe5b\xf9T\xdf\xf5\u007fu||\xdc\xdc\xd8\\\xa3\xde\xd8bbt\x8a \fq\xac,k\xb5u*\xed:w\xee\xdbˣ\x0f\xbc\x8d\x99B\x01EQ8t\xe4:f\x0f\x1e\xe4\xc5\x04\x9e\xdaXaec\x89N\xcfe\u05ce}\x1c8t\x1d\xe5\x91Q\x82 @\xd34\xc6\xc6v\xd2iwy\xe5\xe43\x18\x86N\xe8\a4\x1b\rTE\x05)\xe9z}\xe8w1w\xec\xe2\xb2S\xa2\xa2[,\xea6\v\x9aͼjQ\x1d\x99`}\xe7A\\;\xcb\xd2\xe2E,\xcbbfrGzV^HZ\xed\x06\xedN\v\xc7\xc9\xf6M\xc3\xfa\x0f\x8bK\x176ެu{SO\xe1\xee\xdd}\x03g/\x9cH\n\xb9ѳ\x02~rǎ\x1d,,^&\x8a\x13\n\xb9\x02\t\xe0\x98\x0e˵u\xbe\xb3\xba\xca\x17/\x9c\x83B\x0esj\x8aW\xdd>\xff\xf6\xf8\v\x1c\xdfX'\f},\xc3f\xe7\xdc^v\xef9@id\x84f\xa3\x8eiY\x8c\x8eβ\xb9\xba\xc1\x17\xbf\xfa\xff\x12\xfb!\xf5\xcd\rv\xed\xdd\xc3\xd2\xc2\"\b\x81\"\x14\x82$\xa1\xd1\uf44b}\x0eO\x8d\x12es$\xb9\x1cq.G\xcfɰ\x1c\vj[\x9b4\xdaMTUefj\xe7\xb0\xdb$I\x126\xab\x1b\x18\x86\xc1\xee]\xfb\x96\x9e\xf8\xe6g?\xf2f\xaeٛN\xcf\xf4\xaeG\xde˥\xcb\xcbE]\xd7_\x99\x9a\x9c\x9cUT\xc1\x89\x13/S\u038d1Z\x1e#\bCzn\x97js\x8b\xb6ۡ\xeb\xf6\xf0\x03\x0f\x8d\x04K\xd304\x83Rq\x9c#\x87o%\x9b+\x10G!\xbe\xef\x91+\x14\xc8f\n\xf4݈?\xfdO\xff\x16M\xd1\xd04\x87\xa8\xb1Ɓ;\x8e\xa1eJ,-, \xa5\xa4\xef\xf7\xf1C\x8f^\xab\xceh&C^Wi\xbb}\xbc0&\x10\n}\xb7O6\x9bgbz'\xa6io\x1f\xf0D \xe8t\xdb\xd4\x1bUv\xed\xda[\xf5\xfd\xf0\xfdO>\xf5\xd9g\xee\xbb\xf7Q\x9ez\xe6\v\xd7& ;f\x0fS\x1e-\x89(\x8c>\x90\xc4\xc9\x1f;\xb6c(\x12\xd6\xd6VQ\x14\x85\xe9\xf19TU%N\x12\xfa\xae\x87\x1f\xf9DQ\x88iY\x98\xba\x89\xedd\xc8\xe7\x8bh\xda\x15-9\x02l\xcb&_\x18\xe7\x8f\xff\xf8\xff\xe0}z\x8fOlt\x91\xbaÇ\xca*_\xaf{\x94\xf7_G\xb7\x1f\xe0{>q\x1c\xd3\v\xfaD\x81O\xafQAj\x06\x8a\xa6\xa3H\x81\xe5\xe4\tC\x1fSU\x19\x9b\x98I3\t\t\xf4\xfa\x1d\xfa\xfd\x1e\xf5\xda:s;\xf6bX\xf6O~퉿\xf8\xc4\xdb\xee\u007f\x1f_\xff\xc6g\xae]\t\xb9\xf2\x9a\x9b9\xb4K\b>\xdfju\x0e)\x8a\xa4\x9cϒ\xcf\x16\xf0\x03\x0f\xd7\xed\xa3\x1b\x16\xa6\xe5\xa0\xeb\x06\x9an019\x83m\xa7\x9eT\x14\x85i\x17\xa2L\x99\xe4FǦx\xe2\x89ǹn\xf5e\xee-\xe7\xf8\xc8B\a\xd5\xcc\xf2Ky\x8f\x9d\xf9\"\x1f\xb9\xb0\xc1\xde#\xb7\xd1n6\xe9\xf6=\x82(\xc0\v}\xfcn\x13E3\x10\x8a\x8a\x88#t'\x8b\x8c#\xc2~\x97\x91\x89Y\x9a\xad\x06\x9dn\x8b \xf0\t\xdc.\xbbv\x1f\b\v\x85\xf2ov\xbb\xed\xff\xf5\xb9\x17\x9f\f\xdf\xec5zә\x1cn\xb9\xf9~\xd6\xd6\x17\xd2ȽUmđ\x1e\xc5q\xf8\x9e(\niu\xdaԛU\xea\xad-ڽ6\xf5\xd6\x16[\xf5\n\xbdn\vE*\xf4\xba=\xca#\xa3C\x06\x9f$I\xb0\x9d\x94\xde\xe9\xe4\xa9\x134^\xf82\x1f=<\xc7+\xad\x1e\xa7\x13\x9b\xd2\xd8\x14\xbb\xbd-\xee+;\x1c\xaf\xb5\xe9;\x05\n\xd9\f\xae\x1f\x90$\ta\x1c U\x1d\xa9(\xc4a\x80\bC\x12/\xc0\xeb\xf7\xe8\x87>\xd5\xfa&\xbdn3\xf5\xf0\xfc>\xd9l\x81ٹ=\xbf\xfb\xb5o|\xf67W\xd6\xe6\xe3\xb7bӪo\x1e\x10\xc7p\x9c\fO=\x9d\xea\xda{\xefy\xe7\xed\x96e}\xa0R\xd9\x1c\xbbx\xfe\"B\n,C'\xe7訊J\x14GxA\x80\xeb\xbb4;M\xda\xdd\x16\xf9\\\x11UU\xd8w\xf0:\xa4LY\xe1\x1c'\xc3\xc6\xe6\x06\xdfy\xe2s\xfc\xbb\xfdSL\x99\x1a\x97:\x1e\xb9\xec\b\xbanP\xab\a(I\xc2n\xdb䊀*h\x00\x00\x1cNIDAT\xf1\x85K\x1c\xb9\xfe&\xc6\nYV\xab\r\xa4P\x88\xe3\x10\xd5\x0f\x91\xbeO\x9c$xI\x1f?N\xa5O\x15\x02\xa9j\xa0\bza\x9b\x1d;\xf7\xf6jՍ\x8f\x00\xdc}\xfb\xdb\xf8\xf6\xf3_\xbf6\x01\xb9\xe7\xeeG\xf8ַ\xbf\x04\xc0]w>t\xa3e9\xbf\xad\xaa\xea͍F\xbd\xd4l\xd6\x11\"\xa5k\xb5-m@З`\x19&\xbaf\x90\xb1,\xa2$\xa1\xd5\xed\xb0լ\x11]<\xc5\xe4\xd4,\xd9|\x810\nYY]\xe6\xd9g\xbeƃ\x05\x8bۊ\x0e\xba\x14\\jw\xd8w\xeb-\xb4z-\xb6\x96\"r\xaa`GƢ\xb9\xbe\xce\xe5\xf9E\xf6\xed\x98\xc1\xb1M\x02\xdfc\x14\x83v\x12Q\v]\x82$@\x93\x1a\x96b\xa6\xd4P\x80\xb0l\xbc\xd0#\x9b\xcdcY\x99O>\xfd\xecW\xb6>\xf6\x87_\xe1g\u007f\xe1\x1do\x89Z\xff\xbe\xaa\xac\xfb\x8e>\xca\xe2\xe2y|?\xe6\xc6\x1b\xee\xcc\xed\xd9s\xe4ߘ\xa6\xf5\x9f\xa2(ڽ\xba\xbal\xd5뵔t\xd2\xf5H\x12\xb0L-=\xeb'$\x86\xaa1\x9e\xb5\xe8\a)\xabh>\x93#\x9f\xc9\xd1h7q\xdd\x1e;w\xedC\n\xc9k\xe7O\xb2z\xeeU潐\x8f/T8\xd1\xea\xf3R?\xe6\xe8\xdb~\x9c$\x8a\xc8֗\xf8f\xdb\xe3\xf77\x9a\xb8\x8a\xa4\xd5m2^\x1a\xc5\x10\x12\xad\xe3\xb1\xd0\\\xa3\ued59\x1c+\x92\xd1l\xa2p@\xd6<\xe8\x13\xc60p\xdd.cc\x93(\x8a\xf6K\x8b\xcb\x17W?\xfd\x99\x8f\xbdev\xf6\xfb\x96\\\xfc\xc5_\xf85\x9ez\xfa\v\x1c9|\x87~\xf8\xf0\r\xef\xb7m\xfb\xb9$\x89\u007f\xb5R\xd9\xe0ҥ\xf3\xf4\xfb=\x1c'\x83\x10\x92\xe4\nw\"\xa5\xf1s@J\xa62\x0e\xff\xe4\xee\x1b\x19\xb1-zn\x1f\x92\x98\xeb\xf7\x1dƐ\x82\xb5\x95Ŵ\x953\b\xe8\x99\x16màn\xd9|\xb2\xe1R\xdew=ss\x87 \t\xf8\x9c+\xf9\xbfך\xf4\xa2\x18]Q\x18\xcd\x15\xf0{}\u00ad6\xd5N\x8d^貧X\xe4\x1f\x1d\xd8EI~\x97W# \x89\"\xe2(\xc02\xed\xee\xd3\xcf~\xe9\x05\xde\xe2\xeb\xfb\x02\xc8\xddw=\xcc\x1f\xfc\xe1\xbf\xe7\xce;\xde19==\xf7\x87R\xcaOW*\x9b\a\x17\x17/S\xadV\xc9fs\x8c\x8d\x8e\xe18\x0e\x9e\xeb\x02rHL\xb9͋e\x1a&\x17769u\xe6$\xbfq\xec6\ue79b\xa2\xeby\xb4\xdaM&\xc7'\xe95\xabt\xbb-\xf6\xec\xd8\xc3Ti\x04\x92\x840I\x18+\x96\xf8\x89w\xff<\x1b\x1b\vx^\x9f\xdd\xe3\x13\x98IL9\x9b纙=\xec\xcdM [.\xadN\x8b\xaaۤl\x96\xf8\x877\x1c\xa4P\xa9\x90\xb8ސ\xf8f\x88H\xdfEU\x14TM;\x0ep\xc7-\xf7\xbf\xa5\x80\xfc\xadU\xd6\xfd\xc7\xde\xcd\xd3\xcf<\xce\xd1{\xdfuS6\x9b\xfbF\xa7Ӻgii\x81n\xb7\x8b\xaajLN͐\xcdd\x99\x98\x98byy\x9eV\xebuFPSW\xd14\x15˲a\x10\x88\xad.^\xe0\xc8\xcc\x14\xff\xd5ݷ\x11\xc51'+\r,\xc3\xe0̅\xd3Զ\xaa\xec=p=\xa6TH\xdc>\x89\x90\xbc\uf47f\xcf\xec\xec>\x9e{\xe6S\x8c\x8c\xee\xc0\xeb\xb7\x18-\x8e0[\x1c%\xacu\b\xfb\x1eq\x9cPs\x9bH\xa1p\xf3\xe8\x04\x1f\xd83\xce\xfc\xc2\"'݈F\x94 \x06\xbdY\f\xec\x990\x142\x99\xfcﭬ-<\xb5\xb2\xb6\xf0\x96\x02\xf2\xb76ꞗ\xa8o{\xf0}\xbf \xa5\xf2\xbb\x1b\x1bklmU\a\x14\xe19FGS\xc2˽{\x0e\xb0\xb2\xbc\xc0\xe6fux\xb8\x12 \f\"\x84)\x86\xc4\xfb\x89f\x12\xe6'x\xfe\xfc\x05n߷\x8f\u007fx\xfb\xf5\xbc\\\xedpqs\x9dv\xbbM\xbfﲼ<Oyd\x82=q\xc4~E\xe7\xfa\xeb\xee\xe5˟\xff=\xe2\x18\f\xd3&\x89A\tbZ\xcb\x1b\x83\xfe,\xf0\xe3\x90j\xbfA\xd1\xc8\xf1\x9bo\xbf\x99\xf3'^!\x8a\xd3z\f\x80\"\x04R(DQDD\x84T\f\xa2(|\x9a\xbf\x83\xeb?Ke\xdd{\xcf;\a\xaa\xea\xa1|>\xef\xfc^\x1cǿ\xbb\xbc\xbcH\xb5\xba\x81\xae\x1bLLL133\x87\xae\x1b\xec\x98ۍ\xa6j\x9c9s\x860\x8c\x86\xaaA\x8a\x18\xcf\xef\r\xc8+\xd3f\xe7D\n\xb4l\x89\xe3k5\xe676\x88\x82\x10%\x8e\x99,\x8f\x12\x03A\u0cf6x\x11۶\xc9\x15Ǹ\xe7\xd8\a\xb8p\xeeE\x12\x04\xfb\x0f߁\xef\xf5\xd1t\x83\xadf\x95~\xe8\xa1\bI?\xf49[_FQTn\x9b\x1ea\xae\x98\xa1\xd5IY\x88\u0081\xa6RT\x85b!\x95\xd2(I0L\xb3_,\x8eTo\xbf\xf9\xd8\x0f> \xc7\xee{7\xcf|\xebqn\xbf\xedm#\xb9\\\xf1\xeb\xadV\xf3\xe7\x17\x17\xe7\xe9t\xdad2yfgvP,\x94P\xa4dvf\x8eb\xa1\xc8\xf3/<\xcbFe\x03\xa9\xc8\xd7Ϥ#\x88\xe3\x880\x8a\x06g\rE\xaa6T\x9d\xa44ɿ\xf9\xec\xe7\xd9\xect\xb0,\x9b\xfd3;\xf8\x8dcw\xe3\x86!\x97.\x9f\xdf\xe6\x1b!\xf0]\xdc~\x87\xc9\xe9\xbd|\xe7[\x9fg\xe1\xf2i\x14U\xc5\xd4tV\xbbU\xaan\x8b\u05f6\x96\xf8\x8d\xa3w\xe0\x98Y\xa2(\xc1\x0f\"z\xbd\x1eQ\x02^\xfcz㈪)\x18\xba\x8a\xa2Kl+\xb3b\x18V\xe7\xbb\ti~\xa0\x00ٖ\x8ao>\xf5y\x8e\xde\xfb\xae[\v\x85\xe2\xa5\xcd͍[VV\x96\bÐl6\xc7\xdc\xec\x0eL\xcbDJ\xc1\xf4\xf4\x0e\xb2\x99\x1ckkk\x9c<ub@0\xf6:\x19rL\xca`\x1d\x04>A\x18\f\xf2T)\xc9X\xa2\xe8P\x9a\xe6_\xff\xf9\xa7\x18+\x15\xe8\xa3\xf0\x0fn8\xcc\xff\xfc\xd01:\x9e\xc7KǟA\xd3uڭ\x1aN\xa6ȩW\x9e\xe6\xc4ٓ\x9c:\xfb2 \t\x88iy]\xb6\xbc->\xf1\xa1Gy\xef\x81=\xa8\x8aJ\xbd\xef\xd3h\xb5\x88\xe3\x98z\x18\xd3\x1f\x00\xa2*\x92$\x86L\xd6\"\x06\xb2\xb9B\xc3\xf3=\xf7\xb9\x17\x9f|\xcb\x01\xf9km\xc8m\xb7>\xc0\xbb\xdf\xfd~\xfeſ\xfcU\x1ez\xfbOd\xe28\xfa\x958\x8e\xff\x97\xe5\xe5\x05\x9a\xcd&\x9a\xa6Q.\x8f211\x89\xa1\x1b\xa8\xaa\xc6\xe8\xc8ؐ\xba\xe2\xb9\xef<\x83\xae\xe9\x8cOL\x93\xcd\x17تV\xa8l\xac\x01\t\xb1\xd0\t\u00800\b\xaef\x81\x93\x82\xc4)\xb0\xd5k#VV\x18\xdfw+\xfd0䧯?\bqĿ\xff\xf6\x8bt\xdaM&\xc6\x15\xd6Wϳ\xb9U!\x8c\x13:\xbd\x1eq\x9c\xd0\xeb\xf7\xb8wn\x9a\x8f\xde\u007f7;\x8b\x05\x16\xda=\x10\xb0\xd9\xf3\xe84\x1a\xa8\x91\xcf\xe9@\xc3\x1d\x00b\x99\xe9QA/\f0\f\x93\\\xae\xb8\xd9ت\xf4\xfe.l\xc8_\v\xc8\v/>\xc9\v/>ɻ\xde\xf9\x93\xc7Z\xad\xd6o\xb5Z\x8dw\xb4Z-\xe28\xc40Lfg昘\x9cBQ\x14\xcc\x01\xad\xebkgNr\xdd\xe1\x9b8s\xe6$\xb5Z\x95B\xb1D6\x97G \x18\x1d\x9b@Q\x14*\x1bkD\x11x~ʪ\x10Gq\nĀ\xcc,\nC\xb4\xf2\x14\x81f\xa1\xeb\x06\x9d\xf9װ\x0f\\\xc7\xcf\xddx\x1d\x86\xa6\xf1X\xa3J\xb7۠V]E!\xe1gn<B\u07b6Y\x88}r\xd9\x02\xff\xf1\xc1[)Z&a\x1cӊ\xe2Ԟ\xf8.\xebgO\"w\xeeg\xe1\xd2\nA\xecQȥ\xec\xd9I\x1c\xd3\xf3=,;\x8b\xa6\xe9Q\xbbӈ\x0e\xed\xbb\x91\xd7ο\xf2w\xab\xb2\xee\xbc㡫~~\xe4\xe1\x0f\xcd\xdd\u007f\xec\xbd\x1f[ZZxlee\xf1\x1d\xcdf\x838\x0e\xd1u\x93\xfd\xfb\x0f03;\x87\xaa\xa88v\x86b\xa1\xc4\x13O~\x99\xa9\x89\x99\xb4\xa5\xf3\xb5\x93)i\xd9\xc8\xf8\x90o1\x8abr\xf9\"\x85bJ\xad\x14%\x82v\xafO\x14\x85W\x11V\xc6@,%1\xa0\xe5\xc79\xfe\xea˸\xebK\xf8B\xf2\xae}\xbb\x91n\x0f\xd7\xed\xd2q]~\xeb\xe8\x1d\xfc\xf7w\xdf\xcc/\\\xbf\x9f\x82\x8c1T\x15\xa9(\x84\x03\xb6\xa1K/?\x8fPU\xdc \xe4\xec\xc84瓈V}\x9d\xf1\x91\x02\xf9\\\x16\xe2\x98^\xe0\xd3\xf3\\TMgc}\xe9\xd1n\xb7s\xdbk\xe7_a\xff\xee#\u007fw\x12\xf2\xc0\xfd\xef\x13\xba\xae[w\xdd\xf9\x8e\x92\xa2(\xef\xf5<\xef\x97VW\x97on\xb5\x1a)\x1dހҨP(\xb3g\xf7~r\xf9<\xbe\xef\xe3\xd86\xbaa\xf0\xf5'\xbe\x84eY\x8c\x8d\x8e\xf3\xdc\vߢ\xb6\xb5\xc5\xe4\xd44\x8a\xa2]q\x97dнa\xa2(\nq\x1c\xe1\xba>\x9d^\x87\xa2^Js[ۜ\xbb1DI\x84)\x05\x8f\x8d\x1cF\xf9\xca\xe7\xb8\xf5\xf6{(\xed؋\x8c\xc3ԫRU^~\xed\x04O}\xf6\x14\xc5|\x16y\xe4\x18q\x12\xd1\\\xbc\x88OLg\xf1\"\xabM\x0fUf\xd1\x15\x85O\x9d]$ho\x92\x1b\x9f\xa5\xe7'\xc4a@\x18\xc7\xf4\x03\x1f\xcf\xf3(\x95F9w\xee\xa427\xb7\xe7\x8f2\xb9\xc2\xdeWO=\x1f\xdey\xeb\xfd<\xf7\xe27\xde:@>\xfc\xf7\xff[\xbdV۸\xbb٬\xdf\xddhx\x0fDQ\xf4\x8e^\xaf+=\xcf\x1br\xdf꺁eZ\x8c\x8c\x8d135\x8be\xa5G\xbcr\x99\xf4@\xce3\xdf\xfe\x06+\xab\xcb\xfc\xd8{>@\xb5^\xe5\xf4\xe9W\xb1L\v)\x05\xbd~\x1bӴ\x91R\x19r\x8edsyڭ\x06\xddn\x8b(Nh\xb6\x9b\xe4\xb2\xf9\xab\xd8|\xe28\"\x11\n\x97ο\xc4\xd1\xf7\xfc2\xff\xf1\u007fz\x82\u007f\xf0\xe2\xf3\x1c[]\xc0\xf42\x83\xcab\x8c[Y\xc1\xd0\x15\xda\xfd>\x9b\x9d\x0e\b\xc1ڷ\xbf\x8a&\x15\xa2\x1d;\xb9<6\x8e\xbeT\xa5'\xa0\xd6\xf1)\x8c\xee\xa2V\xef`\xea*\x18*\xbd\xc0\xa7\xd9i35\xb3\x8bje\x9dv\xabN\xaf\xd7\xd916>\xfd\xdf\x00\xff{yd⭓\x90G\x1e\xfe\xd0?\xb9t\xe9\xccO6\x9b\x8d#a\x18\x16\x82 \x18\xb0AǨ\xaaA6\x9b\xc32\x1d\xa4\x14\x94\xcae\xc6F\xc7QUeHj\xafk:Q\x14\xb3\xb0p\x99}{\xf7S,\x96\xf8\xfc\x17\xff\x820\x88\xc8\xe62\x00\x04\xbeK\x1cE\x18f\xca;\x05\xa9*\x19\x19\x9b\xc0]\xe8\x11%\x01\xbd\xbeO\xb3Ug\xa4<6\x1c\xe0\xb5]!l46\x89\x88\xb9\xf5\xbd\xbf\xc2\xc7>\xfb;\x8cE.V~\x0f}\xdf#\x16\x82\xd8\xf7\xd2Lm\x1c!\xe3\b5\x8e\t\xf6\x1eĝ\x9d\xa3\x12%,\xbe\xbaB\x12G\b N$\xf5Z\vAʃ\xd5\r<ڽ.\xa6\xe5`Y6\x17.\x9cFU5\x16\x17/b\x9aֿ\xfc\xe0\x8f\xff\xe2\xe3\u007f\xf6\xe9?8\xf7\xbe\xf7\xfc,\x9fy\xec\xcdO2*$곍Fc.\fC3\x1c\xc4\x03\x19'G\xb9<\xc9\xc8\xc88\xb6\x95A\xd7U\xa6\xa6\xa6)\x97G\x06\x8c9\x02۲\xd1\a\xcc8\xae\xdb\xe3\xccٓ\xdc\u007f\xdfC,\xaf,q\xe6\xccI\x84Pp\xb2\x99\x81T\xa4l:\xbe\uf8aa\xdbc\x8a\x04\x8a\xaa\xa0\xe9*\x9dV\x9b$I\xe9\xc2s\x99\xf43\xc3\x1d\xa3j\x98v\x86\xe5\x95s<\xf8\xf0ϱ\xb6\xb1\xc0\xb7W\x96hz.ٹ\x83\xf4\xbam\xcc\xc6\x1aD!\xba\x10\xb4ʳH\xc3dn\xe7\x18_y\xf95\xbe~\xea\"8%\xba=/\xcd\f\fX/\x1d\xdb$Q\xa0\xe5\xf6\xe9\xba.\xb3\xb3{XZ\xbaH\x14\x86\xc3Rj}\xabb\xe6\v\xa5\xfbn\xbaᮯ|汏\xd5\x1fy\xe8\x83\\\xbc\xf4\xe6\x0esS\xb2N飚\xaaaY6\xe5\xe2(\xe3cS8\xd9<\x9a\xaa\xa2H\x89i\x19LLL\xe28\x99\xc1\x02\xa5\x06\xdc0\xd2\t\x03R*\xac\xaf\xafR.\x8f259÷\x9f\xfb&\xadf\x17\xd341M\x8b\x84\x94\xe3\x04\x91\x90\xcb\xe6\xb0\x06\xd3r\xe28!\x8eb\xa4L\x87\xb7\xb8n\x9f0\x8c\x89\"\x9fl&3Tm\x8a\xaa\x12E!\xadz\x8df\xab½o\xff)\xe6/\x9e\xa0լQ\xda}\x1d\xbdN\x83\xa5\xda&\x95Xж\xf2\x84\x99\x12Q\x18\xf0\xc2\xfc\x1a\x1d\xabLb\x17q\xfb}:\xcd\xee\xd0g\x90R\xa0\x19*-ϥ\xd9i195G\xbbӤ\xdbI+\x95\xba\xfe:\xeb\xf6feu\xc22\x9d\u007f|\xc3\xf5wl\xc6qt\xf9\xe2\xa5\xd7\xfa\x00o\xbb\xffǸ\xbcp\xf6\xfb\x0fȞ\x1d\x87>:R\x1e\xa3T\x1aA3\xd2Q\x11I\x1cc\xdb\x16\xf9|\x96r\xa94h0\x00\xc3\xd0q\x9c\f\xaa\xaa\xb2\xb6\xbe\x8e\xe38\b!\xf1<\x8f\x9d;\xf6\xb0\xb2\xb2\xc4\xf9\vg\x89\x93\x04'\x93!\x8e#\xa20db|\x82\x83\a\x0e37\xb7\v\xc34\xb0m\x03?\b\x06ǧC\x14U\xc5s\xfb$q\x84\xeb\x05(\x03\t\x04ҎDE\xc1\xf7=\x9a\x8d\n\x89\x80{\x1e\xfa\x19\x96\xce|\x87\xfc\xcc>:\xed&Q\xb7I\x12\xfa\xc8\xf24\xf6\xd8\fza\x94X\xaa\xf4\xbb\x1d\x1a\xb5\x06\xedf\xe7*\xbe,\xcdP\xe9\xc7>[\x8d:\x13\x93s\x00T6\xd7\x000\xf4\xd7\xe7\x9bH)\x89\u0080Je]J)ߓ\xcb\x15\x8e\x1e9t\xeb\xc6\xd9s'\xce_^8\xcb\xfdG\xdf\xc5\xc2\xe2\x85\xef/ 7\x1c\xbe\xfd\xa3v&C\x14ńa\x88\x94\x92r\xb9H6\x9b#\x93q\x863\x05M\xd3±3\x90\xc0\v/\x1d\xc74\rr\xd9\xdc`r\x9aI\x92\xc4\\\xbct\x8e\xcd\xea\x06R\b\\\xb7\xc7\xe4\xe4\x14ǎ>H&\x93\xc1\xf3C\x96VV\xa97\x9ax\x9eO.\xeb\xd0\xed\xba\x03n\xaa\x18\xdf\xeb\x11FQJ\xc9\xda\uf4b1\x9d\x01\x0f\xafB\x92\xc4D\x03\x0e\xacZu\x95 \xf4y\xe4\x03\xff\x14\x90t;u\xda\xf5\nVq\x8c®CHM\xa7\xb1\xb9J\xb7٠^k\xe0{\xc1Ult\x8a\xa9\x10Ʉz\xab\xce\xec\xdc^\xa4TX[]\x1c\x06\xa6ۓA\xb79\u007f\xa3(&Nb\x9a\xcd-\xaa\x95\xf5YE\xd5~\xe2\xe6\x1b\xef\x1e=w\xe1\xe4\xe3\xdb`<x\xec=|\xbf\x8e\xb6)\xd7\x1d\xb9㣮\xef\x11F\x11\x99\x8c\xc3\xe8h\x19\xd3L\xa7k\xa6\x1c\xe9\xa9:3\aCP^9y\x82J\xb5\u009e\x9d{\x86,\xa0)\xa9dą\vgi\xb5\x9a\x8c\x8d\x8e\xf3\xb6\xb7?\u009e\xdd\xfbY^[C\xca8\x1d[\xd7\xe9\rb\x91\bǶ\x18\x1f+\x11E\x11\x9dn\a\xb7\xdf%Ib\x14\tq\xe8\xd1h5\xc8\xe7\xf2h\x9aF\x92p\xd5\x14\x85\xca\xe6\"H\x85[n{\ak\x1b\xf3\xf4\xdc.Vq\x8c8Ihl\xae\xd2ڪ\xb3Um\xa4\xd3@\xaf\f\xba4\t\xba\xa0\xd9i319\x87e\xd9\xcc_\xbez\xf4\xc4\xf6t\xb7(\x8a\b\xa3p8\xb5\a\xd2a\x95\xd5ʪ\xea\a\xde]7\x1c\xb9\xe3#\xfb\xf6\x1e\xf6\xe7\xa6\xf7\x9cr\xec\xacw\xfe\xe2I\x00\x8e\xde\xf50\x8b\xcb\x17\xff\xf3\x01\x99\x9b\xdd\xffQ\xdd\xd0)\x16\x8bd\xb2\x19tMGӵA\xe1\xc8\"\xe3d\x10R\xb0Y\xa9p\xea\xcc\xe9\xe1h\xba\xdd;w]\xc5\xee\x16E\x11\x9e\xe7r㍷p\xe0\xc0\x11j\xb5-\xce]\xb8@\xaf\xdf'\x97\xcd\xe0\xba>\x8a\"1\f\r\xd34\xc8f\xec!\xffI\xb7\xeb\x12\x85\x01\x9e\xe7\x0e8\xdd\x13\x92$\xa2\xd1j\xa4\xd3?\xbf\x8b\xd8R\b\x85je\x89]{o\xa0\xd1ؤ\xddi\xe0\xbb.\xcd\xca\x06\xd5\xf5M\xba\x9d\xfe\x1bx\x13\x85\"H4\xa8\xb7\x1b\x8c\x8cNa\xdb\x19._Jm\x80\xa6jh\xaa68L\x94 \x151hޖۂ\x95\x0e\x17\x1b\x98\xfbV\xb3\xce\xdaڂ\xd2\xebu\x1eB\U00033aa6\xea\xb7\xdcto\xfd\xb5\xb3/W\xfe6`\x00(\xb7\xder\xcfG-\xdbF\xd75L\xc3\x1c\xb0\xb2\xa9\u06036\xfcJ\xb5¹\v\xe7XXZ\xc4\xf7\xfd\xc1\xc0\x15\x9d\xe9ɩ!%\xab\x18\f\U0005a65ee\xab\xd9d~a\x9ez\xb3I\x92\x80\xaekd36\xba\xae\x92\xcd\xdad3\x0eٌC\xb3\xd5F\x19\xa8\x858\x02\xb7\xdf\xc3\xf7=\x92$\x06)I\xe2\x88$\x81v\xa7\x85\xae똦\xf5\x86\x9d\f\t\x96\x9d\xa5٬\xd2\xd8\\csu\x83(\x8c\xdf\xc8\xfb.\x05\x89\x9e\xd0h5\x98\x99ݍiZ,\xcc_ I\xe2a\x8bѕ䗉\x10\xc3\x00\x15!\xd0T\x15U\xaa)\xadm\x92\x12\xd6@\xfa\xcc\xcdf=\xd7\xed\xb4\x1e\xf2\xbc\xfe{n\xbe\xf1\x9e\xe9#\x87n=y\xf6\xfc\x89\xce\xdf\xfbௐ\xb1l\xfe\xa6\x05.妛\xee\xfc\xa8e\xa6\xack\x9a\xa6a\x0f\xfc\xf1v\xa7͉\x93\xaf\xb2\xb8\xbcD\xbf\x9f\x9e\xe0\xda\xceW%I\xcc\xf8\xe8\x18\x9a\xa6\r\x87\a\xc7$\x9c9w\x9eJ\xb52 \xf9\x8a\xd15\x95\xf1\xb1\x12R\xa6\x11\xbe\xaa\xaat:\x1d\xce_\xb8D\xa7ӥP\xc8\x11\xc7\t[[\r\xb6\xea\x9b\x03\x1a\xc0\x01a\xa5\x94$QJ\xef\xdal5\xd3q\xac\x03Oo\xa8B|\x1f\xd3rh5*lm\xac\xd3\xefy\xdf\x13\faB\xb3\xddf\xe7\x9eC\xa8\x8aƥ\x8b\xa7\x87\x1e\xe36Q'@\x1cE\x84\xf1\x80\xaf1I\xb9\xe4uUC*\xa9\xab/E\xfa\x0e\xdb\x12ŀ\x16\xca\xf7=\x9aͭ\xfc\xe6\xe6\xcaݺa\xfewG\x0eߪ|\xf6\xb1?zrem\x81\a\x8e>\xca\xfc\xdf`\"\x8f\x9a\xcb\xe6\a7I[5;\xdd\x0e\xf3\v\xf3TjU\x06CgS\xaeAU%\x9b͑\xcf噚\x98 \x93\xc9\x0e_\xde\xf7}N\x9e:M\xa7\xdb\x19N\x14\xd05\x9d\x91\x91<\x8a\x92\x8a}\xbf\xdf\xe7\xf2\xe5\xcblll2>1\x8di\xa6#TWת\xf4\xfb]|Ͻz\x1d\xa5\x06ZL\x1c\xf8\b!X\xdbX\xc1\xf5=&ǧ\x86\xe5\xd6^\xafE\xb7\xdb$\x89c\x02\xff\x8dM\x85B\x11DjL\xb7\xd3gjf\x17\x81ﳴx\x1e!R\x89؞\xd80\xb4\x1b\x83\x19V\x8a\x92\x9e\xf2\xba\"\xdbC48\x01\x06)\xf13\x80\xae\xeah\x8aF\x10\x06\x03\x95\xed\x89WO|G\x19\x19\x9d\xfc\xe7G\xef|蝆\xe5\xfc\xf4מ\xfc̅w<\xf8~\xbe\xf2\xff\x93\xedA\xb5-\x87v\xb7C\xb5\xb6E\xad^ckk+UC2\xedT\x12RP*\x96\x98\x99\x9eald\x14)\xc5`\xacD0\x98O\x1bq\xea\xeciڝ\x0e\xaa*\xb1L\x13\xcb2\xb0,35\x82\xd5*\xd5j\x85\xd5\xd5U\x84T\x18\x1d\x9b\xc0\xb2,&\xc6Gp]\x9f~ߥ٬\x11G\x11\xbai\xa2H\x95~?\x8d\x19\xa4\xa2C\x9c\x10Gi\xbc\xd3hl\x11\xc7\t3\x93\xd3É\x9f\xbd^\v\x06\x12y\x15\x18\x9a$  N\x04S3;\xe9t\xdaT+kàS\xb9\"\x8d\x13\x86!q\x12#\x15\x05M\xd5P\x06*k\x1b\x9c+\xbd\xae$IHd2\xf4\xfa\x00\f\xcd VS/\x15\x04\xf5\xadMڭ\xfa\x1ds;\xf6=\xf3·>\xf0ˏ\u007f\xf5\xcf\xff⑷\u007f@\xac\xac\xcc''ϼ\xf8W\x03r\xe2\xd4\t\xba\xbd>\x9e\xef\xa1\ff\xd2\xc6qB\x18\x06\x94\x8a%v\xee\xd8I\xb9X\xa2\xdbﱸ\xbc\x84비aȁ}\xfb\x89\xe3\x98\xd5\xf5e:\x9d.ٌE6렪im\xfa嗏\xb3\xb5UO\xcf\xed\xf9\x1e\xf9|\x99\\\xbeH\xb9\\$\x97͢i\x1a\x1b\x9b[x^\x9f^\xb7\x8daZ\x94\xca\x13$q\x84\xe7\xf5\x87/+U}`O\xd2\xdd\xdbn7X\x91\x92\xb9\xa9\x19\xa2(\xc4\xf3\xfa\x88$\xb9z\x02\x82)\t\xa2\x80DQq\x9c\f\x9b\xeb+\xb8^\xff*'d\xfb\xef~\x10\x90\x90\x82\xa1\xab\x1a\x884\xc3,\x05\xc3q\xaf\xaf۬\u05c9\x96\xb7\a\x8e]\x99\xe6ѴT\xbd\xf9)=\x15\xf3\x97ώEQ\xf8\xf1w>\xf4\xc1_~\xfc\xab\u007f\xf6G\xef}\xf4\xa7\x01\xf8\xab@Q[\xedvJ\xf2\x95\xc9\x02\x02MU(\x16K\xccLM\xe38\x0e\xbd~\x8fˋ\xf3t\x06e\xd3$Iس+\xad\x93\xafo\xae\xb0Y\xad26ZD\xd7\x14\xa28!\fC\xd6\xd6\xd6X\\\\\x1c\xbe\xd0\xd8\xd84N&K\xb9T\xc0\x1a\x9c5t\a\x83\\\x9a[\x15\x84\x00\xcbNi\xf6\x12!0M\x9b~\xbf\xbb\xedR\xa1\xe8&\xa1\xd7\x1f\xb6\xeb4\x1bU\x16\x81]\xb3;!\x818\xdd\xcf \x05\xd2R\xf0\x02\x1f\xa1iH\x04\x9b\xeb+oTe\x83\x05\xf5\xc3`0\xce[\xa2)\nɠD \x85@\x91\u007f\xf9P\x18\xae\x90\x9em`\x86\x1bh\xb0\xa9\xb7\x13\xb3\x97/\x9e1\x93$\xfe\u007f\x1e~\xfb\a2\x9f\xfb\u009f\xfc\xce_\xab\xb2\xca\x03\xa2ǌ\xe30:2J.\x97E\nI\xbdQg\xf5\xe2\x1a\x9dn窩\x02S\x13\x93d\x9c\f\x95\xda:\x9dn\x9br\xa9\x80\xefy\xacolb\x9a\x06\xb6\xed0::\x8a\xa2(d\xb29\n\x85\x91t\x12t!\x87mۯ/\x86\x1f\xd0\xeb\xf7\xe8t\x9bi:~\x10!\v!\xb139<\xb7G<\x1c\xec%Q\r\x8b\xc8\xef\x0f\vX\xcdF\x95fy\x94\x91\x89\x19\xfa\x9d&N֦\x1fy\xf4|\x17\xcb\xca\x10F!\x8dz\x8d\xefU\x17W\xa4\x92NiK\x06G\xb3U\x15\x8428\xa8\xbd}\xcb\xef\xfe\\\x926\x9b\x0eس\x05)%\xba\x88B\x04\xa4\aP\x93x8\xc3\xd70\x8c\xf4\xbc\xa4\xaa\xb20\u007f\x01M3\xfe\xf5;\x1f\xfa\xe0\xf1ǿ\xfag\u007f\xe541u\xf7\xce]\xe9\xa8S\xd3\x1c\x8a\xa6\x17xTjUڝ\x0er0\x84\xc54\rr\x99\f\x8a\x02\xeb\x9b+\xb8^\x0fUU\xb9|\xf9\x12\xab\xab\xab\xb4Z-n\xb9\xe5֡\x1b95\x9dv\x9d\xa4\xe78r\x98\xba\x06\x02LC\xa3\xd5N\x17\xbb\xd5\xdc\x1a\xec4\xf5\x8a\x84b\x82a\x98Xv\x86n\xb7uE\x8b\xa3D\xd1-\xa2\xc0K3\xb7B\xb24\u007f\x81~\xaf\xc3\xde\xdd\a\xf1EB\xdb뢪:\xedN\x13\xdfs\xbf'\x18\xdb\xdc\xf2iИ\xa0\xa8:\b\x95\xf8\niP\xa4\xb8\xea\xb3\xe9\xc8\v\x13\xa1(C\x87`\xfbY\xe38&\n}\xf0\\D\x92~w\x12'\xc4I\x8c\xae\xeb\xf8\x81\x0f\xc0\xea\xcaBA\xd3\xf5\x0f'I\xf2\xec\xfdG\x1f\xe5\x9b\xcf|\xf1{\x03R\xc8\x17\xd2WN^'\x84\x8c\xe3\x84B.\x87e\xe8\xf8\xa1?\x18\x91\x1d\xd2\xee\xa6\xee\xa7\xef{,..\xb1\xb00?t\x89\xa5\x94\x84\x83f\x05)%\xb6\xed\x90\xc9d\xc8\x0e\x18?\x85T0M\x9d\xa5\xe5\r2\xb6\xc5\xff\xd7ع\xec\xc6QDa\xf8\xafk\xdf\xc73\xf6؉\x14\x11$\x10b\x91\x05\v\xa4H\xa0H\x04\xc2E\xb0\n\xf0\x00\xbc\x01K\xd6y\x01\x96<\x03[\xb6(\n2\x12\x84\xc8J$\x8c\x95H\xc4$6\x0evb\xcf\xcd3\xf6t\xcftuW\xb3\xa8\xea\x9aqlK\x99\xfdLOש:\xb7:\xe7|\x85\xd6H\xc7#\xfb\xb2\xdc\xce0\x9c\xc5\x18\v\xad%dY\n\xad\xe7:\x90\t\x05\x93\xbe9)Z\xa3\xd4%\xf6_\xec\xa2s\xf0\xc2\xc6\r%\xcai\xe6N\xdaY\x9f\xaa2\vV\x01 \x8c\x03\x94c\x1e\xf9\xc2\x19q\xbd\xf3\xb50\x84\x17\x81гj\n\xcd$:*|0&\x90O\xc6\xe6\x0e\x87V\x0e\x89\xe1I\xa3\xbe\xb2l\x8c\xce\xfe\u07b7\xd7\xde\xfb\xf4\xc7\xdf\xefݾ\xf7\xc1\xb5\xcf\xf1\xebo\xa7\x85§\xf9\x14Ea\x8c\x90!\x14\x00Y6\xb6\x95 p\x13\xa9\xf3<G\xa7s\x80\xdd\xdd=\f\x06}'\x84\x1a\x91\x1d\x86\xb1\xa3\x14TU\x85\xa5\xc5\x16|߳P/\x0e]i\xec<{\x01\xa5\x14\xf8B\x82Q\u007f\x00\xa5L\xdc\xc1)s\xc5r\xf3\v\xd7^\xbe\x88^o\x1f\x9e\xf4\x909\xc4\x1e\x01\x93\x11J\x95\xa1\xb2\x03\x93O\b\xed\x15>\x1a\xf5\xed'\xc7\\\x99\xb1\xbd\x8f \xf3\xc7\t\\\x86 Ԡř\xf4\xa1\xd5\xf4\xf4\xf3\b\x01e\x1c~\xb4\x80|:F\xa9\xf2\x1a\xd9jp㾇\xc9d\x82N\xe79\xa28\xf9㓏n~x\xfb\x97\x9fV\x01\xe0\x9d+W\xb1\xfepm&\x90\x9dݧ 0C\xef\t\xa1V09\xd2q\x8a,K1\x1c\x8e\xd0\xebu\x1d\ueebe\x0f\xe1\\Bz\x1e|/@\x10F\x90\xd2woV\xd3t\xb8\xe0\b|\x1f\a\a\a\xd8y\xf6\x1f\xa2h\x01Q\x14\x01\x04Hӣ\x99m\x12\xf2\xac\xd9\xf7`\\؛F\x8a\xa4\xd1\xc4h8p\xaa\x82\t\x1f\x15UF]T\xaf8\x98g\x8eO\xe2X\xeb\x98%\x1eA\xe0\xe2&\x00f\xd03\x13 \xd4\b\xaf\x16\n)\v\xe8\"7Y\x85\x97\x9aФ\x17A3\t\x95OP\x14\xb9\xa5\xc7q\x97E\xde\xdez\f\xce\xe5ϟ\xdd\xf8\xfa\xbb\u007f\xb7\x9e\xfe\xb0\xfep\xad\xb8\xfa\xeeu\xacْ#.\x85\xc4p8D\xb7\xdb\xc1`0@\x9a\xa6PJ\xd9X\xa3p\xbcX\x03L\xf1\x10\xc5\t\xc20v\x99\xd8:\x10$\x04\xf0=ϒ2\x15\xc2\xc8D\xfe\x9b\x9b\x9b\xd8{\xfe\xdc\xccL\xa4\fA\xe0\xa1,KL&Sc\x14m\x02\xf3\x1c\xfd\x82(J\xd0\xefw\xd0n_\x00\xe3\x02\x83ށ\xb9#)\n\xe3\x12W&\x19y\xc2\xf82\x13\xe4\xea\"?\xa1^\x980'\xb6\xc83g\xc0\xabzI\x89QW\xf5\xbbRn\xda\xde,V\x01tN\xa5R\xc6A\xa8\xe9\xc2\xd2\xe5i2\x0f\xe5\x02\x92q\xb0\"G>\x19\xbb\xc9ٌ1L\xa7Slo\xfd-\x95ʿ\xbft\xf9\xf2MM\xf5Wk\x0fV\a\xef_\xbd\x81\xbbkw\xc0WWW1\x1c\x1e\x9ebo\xcc\xfb\xebBxXh\xb6\x10E\xc9\t\x9f\xbcNW\xc7q\x84\xa5\xc5\x16<OBk\x8d 0\xc3\xf4\xd7\xff\xfa\x13\xfdޡ\xdb%\x8cQH\xc11\x9d*\x94\x85r\x99\x00!\xbd\xb3\t\xcf\xc4\xdc\x18\x86a\x8c\xe1a\x1f\xad\xf6\n*\xddF\x9a\x1e\xa3\xd9\\B\xaf\xbbo:\x9e`\x82GT\x15\b\xe5`bv\xa71\x13Je\x9c\x01\u038d K5\x93\x86)\xa5\x84p\x1cD\xeaع\xc4\n\xe0ej\x18!\x04L\x98\xf6\xb8\xc2\xc1a\xe6ip\x00\x17\x12\x8cqL\xb2c\x94\xa5r\xde\xd7d:\xc1\xce\xf6&+\nu\xfd\xf5\xd7\xde\xd8j-4?\xbe\xbbv羭3\xf6n\xcd\v\xa3>Μ\vDQ\x03\xed\xe5\vh\xb5ڶ;\xb5\x9a\xab\x1c\xa1\b\xc3\x00+\xcbmı\t\b\xeb]0\x1a\x8d\xf0\xe0\xc1}\f\xfa}\ba\x10\xa7a\x18#Nb\x04\xbe\x87q\x9a\xa2\xdf\xeb@\xa9)\x18eh,,\xe2,$w\xbd\x85}/\xc0\xd1\xd1!(\xa1\x88\x92\x06tY`\x92\x8d\xd1Z\xba\x00e\xed\x1e\x1c\x8c\x98\x99\x9d]\x1bmBPY\x9do2\xb9\x06\x01[\xe9\xd2zM5n\x89BrK]\xe0Ҟ\b\nʸ\xfd\x0e9G\vR0.f\xe7\xed\xe5\x8dU\x01\\\xfa6#\x90\xdb\xff@\x01B0\xe8wq|<\xf2\xdb\xcb\x17\xbfy\xeb\xcd+\x83\xd6\xc2\xd2\x06\v\xfc\xc6-\xc0L\xfd'\x84\xd86\xe4E4\x9b\x8b\x88\x93\x86\xf9\xf3s\x843ϓH\x92\x18\x8d$F\x14\x99\x11\xe2R\nԠ\x80'O\xfe\xc1\xa3G\x1bH\xd3\x14\xd2\xf3\\\xa5I\x147\xd0HbpNqt4\xc6\U000301e2P\x90\xbe\x87(J\xceg\xa0W&\xec\v\xc2\x18G\xa3\x01\xa4\xe7#\bL\xe0X\xa8\x1c\xcdV\xdb\x14R(e\x16Q[F\x14\xb5\\)\xfb|#\x14\xb3\x8b\t\x97@\xa5\xcd\xe1\xa8\xd1\x17\x84\x80\xdb\xdac&|gc(\xe7'\xee\xf8\xcf5Ov֊\xd3\u007f\xf3P\x98\xaa2^\xa4C-\xcdw\x0fd\x18\xf4\xbb\x9cs\xf1\xc5\xcaʥ\xb7\xb9\x8c\"TZ#\x0eb\x84QrBu\xcd燢0\xb4\xa9\x11Q\xfb)\xf0}\x0fBp\x9bW*\xb0\xb1\xb1\x8en\xb7k\xd2\xf1B\x00\x15q^\x8c\x10\x9e\xe9Gצ\x98\xa1\xfemY\x03\xbf\xeaD\xe6\t\x13\xa2\xa1\xcb\t\x18\x0f\xcd\xdc\xf8F\x13Yz\x8c(n\xa0\xd9j\xa3\xb3\xbf\aΏ\x914Z\xf0\xfc\x00\x87\xfd\x0e4\xa1F\x88Z\xa1\xe6v\x11&\xc0\x00\x94j\n]\x16 \x85\x02\xe5\x02U\xa1\x9cIf\x8c\xb8T\r\xb1*\x8c\x10\x02\xc2ī\xbbo\x84\x98\xb8\x06@\xa9r\x1b/qT\x95F\xa5Kx\xbeY\xeb45^l}\u0094ʱ\xbd\xf5\x18\xdd\xee\xfe\x97\xff\x03\xa4%\vH?L\xef1\x00\x00\x00\x00IEND\xaeB`\x82")
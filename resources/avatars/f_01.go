@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_01 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x02\x00\x00\x00\x02\x00\b\x06\x00\x00\x00\xf4x\xd4\xfa\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\nOiCCPPhotoshop ICC profile\x00\x00xڝSgTS\xe9\x16=\xf7\xde\xf4BK\x88\x80\x94KoR\x15\b RB\x8b\x80\x14\x91&*!\t\x10J\x88!\xa1\xd9\x15Q\xc1\x11EE\x04\x1bȠ\x88\x03\x8e\x8e\x80\x8c\x15Q,\f\x8a\n\xd8\a\xe4!\xa2\x8e\x83\xa3\x88\x8a\xca\xfb\xe1{\xa3kּ\xf7\xe6\xcd\xfe\xb5\xd7>\xe7\xac\xf3\x9d\xb3\xcf\a\xc0\b\f\x96H3Q5\x80\f\xa9B\x1e\x11\xe0\x83\xc7\xc4\xc6\xe1\xe4.@\x81\n$p\x00\x10\b\xb3d!s\xfd#\x01\x00\xf8~<<+\"\xc0\a\xbe\x00\x01x\xd3\v\b\x00\xc0M\x9b\xc00\x1c\x87\xff\x0f\xeaB\x99\\\x01\x80\x84\x01\xc0t\x918K\b\x80\x14\x00@z\x8eB\xa6\x00@F\x01\x80\x9d\x98&S\x00\xa0\x04\x00`\xcbcb\xe3\x00P-\x00`'\u007f\xe6\xd3\x00\x80\x9d\xf8\x99{\x01\x00[\x94!\x15\x01\xa0\x91\x00 \x13e\x88D\x00h;\x00\xac\xcfV\x8aE\x00X0\x00\x14fK\xc49\x00\xd8-\x000IWfH\x00\xb0\xb7\x00\xc0\xce\x10\v\xb2\x00\b\f\x000Q\x88\x85)\x00\x04{\x00`\xc8##x\x00\x84\x99\x00\x14F\xf2W<\xf1+\xae\x10\xe7*\x00\x00x\x99\xb2<\xb9$9E\x81[\b-q\aWW.\x1e(\xceI\x17+\x146a\x02a\x9a@.\xc2y\x99\x192\x814\x0f\xe0\xf3\xcc\x00\x00\xa0\x91\x15\x11\xe0\x83\xf3\xfdx\xce\x0e\xae\xce\xce6\x8e\xb6\x0e_-\xea\xbf\x06\xff\"bb\xe3\xfe\xe5ϫp@\x00\x00\xe1t~\xd1\xfe,/\xb3\x1a\x80;\x06\x80m\xfe\xa2%\xee\x04h^\v\xa0u\xf7\x8bf\xb2\x0f@\xb5\x00\xa0\xe9\xdaW\xf3p\xf8~<<E\xa1\x90\xb9\xd9\xd9\xe5\xe4\xe4\xd8J\xc4B[a\xcaW}\xfeg\xc2_\xc0W\xfdl\xf9~<\xfc\xf7\xf5\xe0\xbe\xe2$\x812]\x81G\x04\xf8\xe0\xc2\xcc\xf4L\xa5\x1cϒ\t\x84b\xdc\xe6\x8fG\xfc\xb7\v\xff\xfc\x1d\xd3\"\xc4Ib\xb9X*\x14\xe3Q\x12q\x8eD\x9a\x8c\xf32\xa5\"\x89B\x92)\xc5%\xd2\xffd\xe2\xdf,\xfb\x03>\xdf5\x00\xb0j>\x01{\x91-\xa8]c\x03\xf6K'\x10Xt\xc0\xe2\xf7\x00\x00\xf2\xbbo\xc1\xd4(\b\x03\x80h\x83\xe1\xcfw\xff\xef?\xfdG\xa0%\x00\x80fI\x92q\x00\x00^D$.Tʳ?\xc7\b\x00\x00D\xa0\x81*\xb0A\x1b\xf4\xc1\x18,\xc0\x06\x1c\xc1\x05\xdc\xc1\v\xfc`6\x84B$\xc4\xc2B\x10B\nd\x80\x1cr`)\xac\x82B(\x86Ͱ\x1d*`/\xd4@\x1d4\xc0Qh\x86\x93p\x0e.\xc2U\xb8\x0e=p\x0f\xfaa\b\x9e\xc1(\xbc\x81\t\x04A\xc8\b\x13a!ڈ\x01b\x8aX#\x8e\b\x17\x99\x85\xf8!\xc1H\x04\x12\x8b$ Ɉ\x14Q\"K\x915H1R\x8aT UH\x1d\xf2=r\x029\x87\\F\xba\x91;\xc8\x002\x82\xfc\x86\xbcG1\x94\x81\xb2Q=\xd4\f\xb5C\xb9\xa87\x1a\x84F\xa2\v\xd0dt1\x9a\x8f\x16\xa0\x9b\xd0r\xb4\x1a=\x8c6\xa1\xe7Ыh\x0fڏ>C\xc70\xc0\xe8\x18\a3\xc4l0.\xc6\xc3B\xb18,\t\x93c˱\"\xac\f\xab\xc6\x1a\xb0V\xac\x03\xbb\x89\xf5cϱw\x04\x12\x81E\xc0\t6\x04wB a\x1eAHXLXN\xd8H\xa8 \x1c$4\x11\xda\t7\t\x03\x84Q\xc2'\"\x93\xa8K\xb4&\xba\x11\xf9\xc4\x18b21\x87XH,#\xd6\x12\x8f\x13/\x10{\x88C\xc47$\x12\x89C2'\xb9\x90\x02I\xb1\xa4T\xd2\x12\xd2F\xd2nR#\xe9,\xa9\x9b4H\x1a#\x93\xc9\xdadk\xb2\a9\x94, +ȅ\xe4\x9d\xe4\xc3\xe43\xe4\x1b\xe4!\xf2[\n\x9db@q\xa4\xf8S\xe2(R\xcajJ\x19\xe5\x10\xe54\xe5\x06e\x982AU\xa3\x9aRݨ\xa1T\x115\x8fZB\xad\xa1\xb6R\xafQ\x87\xa8\x134u\x9a9̓\x16IK\xa5\xad\xa2\x95\xd3\x1ah\x17h\xf7i\xaf\xe8t\xba\x11ݕ\x1eN\x97\xd0W\xd2\xcb\xe9G\xe8\x97\xe8\x03\xf4w\f\r\x86\x15\x83ǈg(\x19\x9b\x18\a\x18g\x19w\x18\xaf\x98L\xa6\x19Ӌ\x19\xc7T071\xeb\x98\xe7\x99\x0f\x99oUX*\xb6*|\x15\x91\xca\n\x95J\x95&\x95\x1b*/T\xa9\xaa\xa6\xaaު\vU\xf3U\xcbT\x8f\xa9^S}\xaeFU3S\xe3\xa9\tԖ\xabU\xaa\x9dP\xebS\x1bSg\xa9;\xa8\x87\xaag\xa8oT?\xa4~Y\xfd\x89\x06Y\xc3L\xc3OC\xa4Q\xa0\xb1_\xe3\xbc\xc6 \vc\x19\xb3x,!k\r\xab\x86u\x815\xc4&\xb1\xcd\xd9|v*\xbb\x98\xfd\x1d\xbb\x8b=\xaa\xa9\xa19C3J3W\xb3R\xf3\x94f?\a\xe3\x98q\xf8\x9ctN\t\xe7(\xa7\x97\xf3~\x8a\xde\x14\xef)\xe2)\x1b\xa64L\xb91e\\k\xaa\x96\x97\x96X\xabH\xabQ\xabG\xeb\xbd6\xae\xed\xa7\x9d\xa6\xbdE\xbbY\xfb\x81\x0eA\xc7J'\\'Gg\x8f\xce\x05\x9d\xe7S\xd9Sݧ\n\xa7\x16M=:\xf5\xae.\xaak\xa5\x1b\xa1\xbbDw\xbfn\xa7\ue61e\xbe^\x80\x9eLo\xa7\xdey\xbd\xe7\xfa\x1c}/\xfdT\xfdm\xfa\xa7\xf5G\fX\x06\xb3\f$\x06\xdb\f\xce\x18<\xc55qo<\x1d/\xc7\xdb\xf1QC]\xc3@C\xa5a\x95a\x97ᄑ\xb9\xd1<\xa3\xd5F\x8dF\x0f\x8ci\xc6\\\xe3$\xe3m\xc6mƣ&\x06&!&KM\xeaM\xee\x9aRM\xb9\xa6)\xa6;L;L\xc7\xcd\xcc͢\xcd֙5\x9b=1\xd72\xe7\x9b\xe7\x9bכ߷`ZxZ,\xb6\xa8\xb6\xb8eI\xb2\xe4Z\xa6Y\uedbcn\x85Z9Y\xa5XUZ]\xb3F\xad\x9d\xad%ֻ\xad\xbb\xa7\x11\xa7\xb9N\x93N\xab\x9e\xd6gð\xf1\xb6ɶ\xa9\xb7\x19\xb0\xe5\xd8\x06ۮ\xb6m\xb6}agb\x17g\xb7Ů\xc3\ue4fd\x93}\xba}\x8d\xfd=\a\r\x87\xd9\x0e\xab\x1dZ\x1d~s\xb4r\x14:V:ޚΜ\xee?}\xc5\xf4\x96\xe9/gX\xcf\x10\xcf\xd83\xe3\xb6\x13\xcb)\xc4i\x9dS\x9b\xd3Gg\x17g\xb9s\x83\U000c82c9K\x82\xcb.\x97>.\x9b\x1b\xc6\xddȽ\xe4Jt\xf5q]\xe1z\xd2\xf5\x9d\x9b\xb3\x9b\xc2\xed\xa8ۯ\xee6\xeei\xee\x87ܟ\xcc4\x9f)\x9eY3s\xd0\xc3\xc8C\xe0Q\xe5\xd1?\v\x9f\x950k߬~OCO\x81g\xb5\xe7#/c/\x91W\xadװ\xb7\xa5w\xaa\xf7a\xef\x17>\xf6>r\x9f\xe3>\xe3<7\xde2\xdeY_\xcc7\xc0\xb7ȷ\xcbO\xc3o\x9e_\x85\xdfC\u007f#\xffd\xffz\xff\xd1\x00\xa7\x80%\x01g\x03\x89\x81A\x81[\x02\xfb\xf8z|!\xbf\x8e?:\xdbe\xf6\xb2\xd9\xedA\x8c\xa0\xb9A\x15A\x8f\x82\xad\x82\xe5\xc1\xad!h\xc8쐭!\xf7\xe7\x98Α\xcei\x0e\x85P~\xe8\xd6\xd0\aa\xe6a\x8b\xc3~\f'\x85\x87\x85W\x86?\x8ep\x88X\x1a\xd11\x975w\xd1\xdcCs\xdfD\xfaD\x96Dޛg1O9\xaf-J5*>\xaa.j<\xda7\xba4\xba?\xc6.fY\xcc\xd5X\x9dXIlK\x1c9.*\xae6nl\xbe\xdf\xfc\xed\xf3\x87\xe2\x9d\xe2\v\xe3{\x17\x98/\xc8]py\xa1\xce\xc2\xf4\x85\xa7\x16\xa9.\x12,:\x96@L\x88N8\x94\xf0A\x10*\xa8\x16\x8c%\xf2\x13w%\x8e\ny\xc2\x1d\xc2g\"/\xd16ш\xd8C\\*\x1eN\xf2H*Mz\x92쑼5y$\xc53\xa5,幄'\xa9\x90\xbcL\rLݛ:\x9e\x16\x9av m2=:\xbd1\x83\x92\x91\x90qB\xaa!M\x93\xb6g\xeag\xe6fvˬe\x85\xb2\xfe\xc5n\x8b\xb7/\x1e\x95\a\xc9k\xb3\x90\xac\x05Y-\n\xb6B\xa6\xe8TZ(\xd7*\a\xb2geWf\xbf͉\xca9\x96\xab\x9e+\xcd\xed̳\xcaې7\x9c\xef\x9f\xff\xed\x12\xc2\x12ᒶ\xa5\x86KW-\x1dX潬j9\xb2<qy\xdb\n\xe3\x15\x05+\x86V\x06\xac<\xb8\x8a\xb6*m\xd5O\xab\xedW\x97\xae~\xbd&zMk\x81^\xc1ʂ\xc1\xb5\x01k\xeb\vU\n\xe5\x85}\xeb\xdc\xd7\xed]OX/Yߵa\xfa\x86\x9d\x1b>\x15\x89\x8a\xae\x14\xdb\x17\x97\x15\u007f\xd8(\xdcx\xe5\x1b\x87oʿ\x99ܔ\xb4\xa9\xabĹd\xcff\xd2f\xe9\xe6\xde-\x9e[\x0e\x96\xaa\x97\xe6\x97\x0en\r\xd9ڴ\r\xdfV\xb4\xed\xf5\xf6E\xdb/\x97\xcd(ۻ\x83\xb6C\xb9\xa3\xbf<\xb8\xbce\xa7\xc9\xce\xcd;?T\xa4T\xf4T\xfaT6\xee\xd2ݵa\xd7\xf8n\xd1\xee\x1b{\xbc\xf64\xec\xd5\xdb[\xbc\xf7\xfd>ɾ\xdbU\x01UM\xd5f\xd5e\xfbI\xfb\xb3\xf7?\xae\x89\xaa\xe9\xf8\x96\xfbm]\xadNmq\xed\xc7\x03\xd2\x03\xfd\a#\x0e\xb6\u05f9\xd4\xd5\x1d\xd2=TR\x8f\xd6+\xebG\x0e\xc7\x1f\xbe\xfe\x9d\xefw-\r6\rU\x8d\x9c\xc6\xe2#pDy\xe4\xe9\xf7\t\xdf\xf7\x1e\r:\xdav\x8c{\xac\xe1\a\xd3\x1fv\x1dg\x1d/jB\x9a\xf2\x9aF\x9bS\x9a\xfb[b[\xbaO\xcc>\xd1\xd6\xea\xdez\xfcG\xdb\x1f\x0f\x9c4<YyJ\xf3T\xc9i\xda\xe9\x82ӓg\xf2ό\x9d\x95\x9d}~.\xf9\xdc`ۢ\xb6{\xe7c\xce\xdfj\x0fo\xef\xba\x10t\xe1\xd2E\xff\x8b\xe7;\xbc;\xce\\\xf2\xb8t\xf2\xb2\xdb\xe5\x13W\xb8W\x9a\xaf:_m\xeat\xea<\xfe\x93\xd3Oǻ\x9c\xbb\x9a\xae\xb9\\k\xb9\xeez\xbd\xb5{f\xf7\xe9\x1b\x9e7\xce\xdd\xf4\xbdy\xf1\x16\xff\xd6՞9=ݽ\xf3zo\xf7\xc5\xf7\xf5\xdf\x16\xdd~r'\xfd\xce˻\xd9w'\ueb7cO\xbc_\xf4@\xedA\xd9C݇\xd5?[\xfe\xdc\xd8\xef\xdc\u007fj\xc0w\xa0\xf3\xd1\xdcG\xf7\x06\x85\x83\xcf\xfe\x91\xf5\x8f\x0fC\x05\x8f\x99\x8fˆ\r\x86\xeb\x9e8>99\xe2?r\xfd\xe9\xfc\xa7C\xcfd\xcf&\x9e\x17\xfe\xa2\xfeˮ\x17\x16/~\xf8\xd5\xeb\xd7\xceјѡ\x97\U000974ffm|\xa5\xfd\xea\xc0\xeb\x19\xaf\xdb\xc6\xc2\xc6\x1e\xbe\xc9x31^\xf4V\xfb\xed\xc1w\xdcw\x1d\xef\xa3\xdf\x0fO\xe4| \u007f(\xffh\xf9\xb1\xf5SЧ\xfb\x93\x19\x93\x93\xff\x04\x03\x98\xf3\xfcc3-\xdb\x00\x00\x00 cHRM\x00\x00z%\x00\x00\x80\x83\x00\x00\xf9\xff\x00\x00\x80\xe9\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17o\x92_\xc5F\x00\x04!\fIDATx\xda\xec\xfdi\xacmiz\x1e\x86=߰\xc6=\x9f\xe9N5WuW\xcfs\xab\xd5\x1c\xc4A\"E\x89&i\n\xb4)ɖ`(\xb45X\xb4\x84\x180\x90HH \x01N\b\bp`D\x10\x10#\x06\x82\x18\bDD\x80\x03\x02\x06\x94\bПH\x89\x13\x9b!e\x89\xddlvu\x8d\xb7\xeaNg\xd8Ӛ\xbe1?\xdeo\r\xfb\x9c[\xd5E\xb1\xd9\xea&\xd7\aܪ[u\xef9g\xef\xb5\xf7^\xef\xfb>\xef30\xef=\xc63\x9e\xf1\x8cg<\xe3\x19\xcf\x1f\xae\xc3\xc7K0\x9e\xf1\x8cg<\xe3\x19\xcf\xd8\x00\x8cg<\xe3\x19\xcfx\xc63\x9e\xb1\x01\x18\xcfx\xc63\x9e\xf1\x8cg<c\x030\x9e\xf1\x8cg<\xe3\x19\xcfx\xc6\x06`<\xe3\x19\xcfx\xc63\x9e\xf1|\u007f\x1e\xf9A\u007f\xc8\x18\x1b\xaf\xd0x\xc6\xf3\x87q2\xe0\x1cBHH\x19\x85_\x12q\x1c#NR\xc4i\x8a8ΐ&\x19\x92,C\x12\xa7H\xe2\x14Q\x94 \x8e\"H\x11\x81qFӅg\x00\x1cHkD\xffd\xde\xc1\xc3\xc3{\xc0\xd3?`\xad\x865\x06\xd6\x188k`\x9d\x9d:\xe7_\xb5\xce\xc1:\vc\x1d\xacsp\xde\xc3\x03p\xde\xc1;\xc0Á1~ąxȅ\xf8\x97\x9cKp.\xc0\x18\x00\xc6\xe1\x19\x83w\x0e.\xfc\xb2\xd6\xc0Z\x03\xad\x1b\xec\xd6\x17X_>\xc6~\xb7\x81\xf7\xee\xfb\xf2u\x1aU\\\xe3\xf9\xbd\x1c\xf6Ao\xa0\xb1\x01\x18\xcfx\xfe\x00}ػ\xcf3\x1b\x14\x90\xbe\xf0\t!\x91$)\xd24\xa7_Y\x8e$͐\xa6\x19\xe24C\x12'\x9f\x8e\x92\xe4G\xa4\x8c>-\x84\x04g\fT\xc5\xdd\x02\u07bf\xe2\xad\x03\xbc\x85w\x0e\xde[\xc0Yxx\xc0Q\xc1\a\xddk^u\xceN\x9d\xb5\xb0\xce\xc2Y\vk\xa9\xc8{\xe7\xe0\x9d\x0f\x8f\xc9Ã\x81yz\xb8\x1e\f\f\xa0\xc2\x0e\xd6\xff\x1b\x8c\x9e\x17c\x10\\@\b\xf95)\xe57\"\x19\xbf\x16E\xf17\x84\x8c~\x93\v\xf9\r\xc6\xf9\x1e\x8c\x03\x9c\x03B\x80\xf1\b\x8e3Tu\x83\xcd\xfa\x02\x8f\x1e\xbe\x8bǏ\xde\xc6~w\x05\xef\xbe\u007f\x9a\x81\xb1\x01\x18\xcf\xd8\x00\x8cg<\xe3y\xda'\x18\x8c\xf5\xc52\x94\f*\xb2a\x1a\x8f\xa2\x18\x93\xc9\x1c\xb3\xd9\x12\xb3\xf9\n\x93\xc9\x02i:A\x92&\v!\xe5W\x85\xe0_\xe5\xc0W\xbd\xb7_u\xdeM\xbd\xa3\t\xdd{G\x05\xdd9\x0064\x02\xf4\x8b\x85\xef\xee\xbd\xef\xa7\xef\xae\xe0\xf7\xd38\xddz\xae\xdf\u007f\xc2#\xf3\xdd\u007f\x0e\x9b\x87\x80\x1c\xd0/0O\bC\xd7\f\x80\x90\a\xc6\xc1\xb9\x80\xe4\x12RJp&\xc08\xdee`\xafYk\xbf\xa1\x8d\xf9u\xe3\xeckښo8\xc6\xdf\xe52\x01\x97\x11\x94ָ\xbaz\x82\xcd\xfa\x02u\xb5\x876\xfa\xe6\xc3\x1b\x1b\x80\xf1\x8c\r\xc0x\xc63\x9e\xef\xdd)\xbf-\xfa\xa1\xf0{\x0f\xe7=h\x8e\x06\xb2|\x8a\xc5\xf2\x18\xc7Ƿ\xb1Z\x9da2] \x89\x93W8g_u\xd6\xfe\x90\xb7\xfa\x87\xac3\x9fp\xce\xc0;\x03\xef,\xbc\xb7\x01\xae\x0f\x85?|/\xaa\x90\xd4T8\xefᭃu\x06\xd6R\xb1\xb7\xd6\xc1{\xd7\x15\xaa\xb6x\xf7\xb0\xbe\t̀\x87\xf3\xf4\xf7\x9d\xb3\xdd\xdf\x1bޟ\xfa⏧B\xf6.|_k\x1d<\x1c5\x0f\xdd\xca <v\x84\x87\xcf\x188<\x00V1\xb0\xaf\x81\xe37\x05\xe7\xaf\t\x11}\x03\x8c\xbf\xe6\x9c\xfb\x97Z+h\xa3\xe9\xb9t\xe8\xc4\xf7V\xc1\x1d\x1b\x80\xf1\x8c\r\xc0x\xc63\x16\xfek\x85\xbf߯3Αf\x13\xacVg89\xbb\x8b\x93\x93;r\x92O\xff\xa8\x14\xfc\x87\xbc5_uN\u007f\xd5\x1a}ꝅ\xf3\x0e\xe8\xfemB\x81\tS\xfd\xf5\"n-\x8c10\xdd\xee\xde\xc29\xdb\x15\xf4n\xc7\x0f\x0f\xe7<\x8c\xd5P\xc6\xc0\xb4{xk\x03\x92\xd0\r\xf8\x1d\x02\xd0\xf7/\f\x87\xbfe\a\xf7&\x178\x02\xd6Z\xb4|\x81A\xc70\x04C\xde\x0f#\xb9\xf6c\xe8\x1a2\xc6\xc1\x18\xfb\x1a\x80o\x00x\xcdy\xf7\r\xef\xfc?5ּAM\xca\xc1\x83\x1e\x1b\x80\xf1\x8c\r\xc0x\xc6\xf3\a\xbc\xca>\xed\x0e\xfc]-\xf2\xc3\xcfk\xf7\xf9d\xecF!c\x9c#I2\xcc\x17'8=\xbb\x93\x1f\x1d\xdf\xf9\xfcd:\xff9\xc9\xf0C\xce4_uFў>\x14h\xfa=A\xf5\xe8\xa6k\xd7#\b\xce\xc1\x18\x03\xa5\x1ah\xa5\xa0\xb5\x815\x9a`\xfdv\xa5\xe0\x0fk\xae\xf7\x84\x128\xefa\x8c\x862\x1a\xd6\x11\"\xe0\x9c\x83\xf3\x80g\x9e~\f\xfc\xa0\xfc\x0f\n8;,\xf8\xd4X8X\xe7\xa9\xd9\xf0>\xb0\x05\xd0\xfd\xb3\xbbF\xfeƷyZ\a\xd0]\xbb\xebMÐo@?\x9f\x83\xc1\xffK\xe7\xfd?\xb2\xce\xfe#k\xedל\xa3k\xf3ojU06\x00\xe3\x19\x1b\x80\xf1\x8c\xe7\xdfD\x03\xf0\xfb~\xf3eO)\\\x1e\xfdn\x9f\xb5\x15\f-!.IR\xcc\xe6\xcb\xe8\xe8\xe4\xce'\x8f\x8fo\xfft6]\xfcGq\x14?\ao\xe1t\x05o\x14\x18Z\x18\xdf\x05\xe2\x9d%6}\xf8\xde\xde{8렵\x82R\nZ5PM\x03mL \xf8\r\xca\xf4\xfb\xdc#\xd8`\x91ｇ\x0eӿm9\x01\x1d\xbc\xef\x0f\xee3\xa1܃\v\x06\x0eb\xf3[O͇\t<\x82VA\xc0p\xbdn\x87\xef\xe9\xe9\xde\xc59k'\xf9\xfe\xf5b\f\x8cs\"0\x02\x1dJ\xd1\xf2\f\x88\xbb@\x13\xbe\uf6a3\xfe\xb9\xf2pݩ\xd5\xc0k\xc6\xda_\xb5\xd6\xfc\xb7\xd6\xd8_w\xee\xbb_\x8c\xc7\x06`<c\x030\x9e\xf1|\xb7\x1b\x80߷\x1b/{\x9f\x9aJ\x05\r\x8c\x87zϻ\xe2\x19E\tf\xb3\xe5\xea\xe8\xe4\xf6\x9f>>\xb9\xfdK\xb3\xd9\xe2G\x85\x8c\xc1\xd0\"\x06\x16^W\xd4\x00xKĽ\xc0\xb4\uf78es0\xc6Bi\x05\xd5\xd4T\xf0\xb5\x825\xf6F\xfb\xe1\xaf\xf5@m;\xc0\xe0\xc1\xda\xc7\xe5\x19X \xe9y\xd0~\xdfذZ\xf0\x008\ag\x00g\x9c\x8a\xf4`\x1ag\x8c\xbeFk\x83F7h\xb4\nȁ\xbf\x81\x10\xf8\xd0\tp\xce\x11\t\x89XF$E\xe4\x92\x1a\x00\x90B\x80s\x868J\x90g9&\xe9\x04\x8csZ\x1d8\v\xad\r\xb4\xd1\xd0VC\x1b\x03c4\xfd\xb71PF\xd3\xca\xc2\x18\xe8V\xa9\xe0=8\xe7\x03T\xc2\xc1{\xbc\xad\xb5\xf9Uc\xf4\xaf9\xef\xff\xd9w\v\x11\x18\x1b\x80\xf1\x8c\r\xc0x\xc6\xf3\xddl\x00~77\xdd\x0f\xf95O\xff\xac1pΩ\xd8p\x1eJl\xcfޟ͖\x9f>:\xbe\xfd\v\xc7'\xb7\u007f~\xbe8\xfat\x1c%\xe1\xeb\x1c`\xa90:g`\x9a\x02N\x15T\xfc;A]\x80\xf5\xb5A\xd34P\x8a\x8a\xbe\xd1&\x10\xf3\xa8h\xbb\xc1\xc4n}+\xd7\x03\x1c,A\xee\x1d\xdcN\xff挃q\x06\xc188\xe3\x10B \x12\x82\xb4\xf9\x038\x9d\x05\xbc!\xcc\xed\xa1P\x03\xd6:4\xbaA\xadjZ\x19x\x0f~m\xd6w\xa1\xe8\v&\x10\t\x89$\x8e\x91D1\xa4\x90\x83i\xbf\xbfZ\\\b\x9c\xacN0\x9b\xcc!\x84\x84\b\xb2Aߑ\x06-,\x1c\xac\xb10F\xa3\xd1\r\x91\xff\x8c\x83\xb6\xd4\x00\x18G|\a\x1d\xfe\\i\"\a\x02\x80\x10\x02Y\x9c\x02\x8c\xa1Q\r\xea\xa6y\xa8T\xf3\x8f\xacu\xbf\x06\xe0\x9f\x8c\r\xc0x\xc6\x06`<\xe3\xf9\xfe\xff\xb8\x10\xee\xfc\xa1o\xba7A\xea\x0f\xf3\xf9\xe2\\\x80\vA\xf25F;t\x06@F\x11\xa6\xb3\xd5WON\xef\xfc\xec\xf1\xc9\xed??\x9f\x1f=\x97\xa4)8\xe3\xf0\x8e }\xe6l0ԩaM\rg\x14\x9cӀ\x1b\xb0\ufd41R5\x9a\xbaA\xa3\x14\x94n\x88\xc8g-\x8c5\xd0\xd6t\xa4:\xdf)\bzz^\xab\xc7\uf207Ẵ\xa4\xc3\xee\xd9\x066\x1fg\x1c\x82\xb7\xbfD\xf7o\x19\x1a\x03\xce\x18\xacs\xa8U\x8dZ\xabn2\x1f\xca\x15y\x80\U000a5408\xa3\bI\x14#\x12\x12\x82\xf3\x0eu\xf0-{\xd0\x1f\xf6^BH\xdc:\xbe\x8d\xc9$\x87\xb5\x16\xda\x18h\xadP\xeb\x06\xc6\x10\x11\xd1_'\x1f\xe2\x90\x1bТ\x0f\xce\xd15TF\xa3Q\n\x8d\xaa\xe1\x9c\xeb\x1b5\xc6\xe0=\xa0\x8cF]Wh\x94zb\x8c\xf95\xe7\xdc\u007f\v\xe0\x1f\x03\xb0c\x030\x9e\xb1\x01\x18\xcfx\xbeߊ\u007f\xfb\xafo{\xd3}\xda\xe7\xc6\u007f\xe0g\x8b\xf4\xebaR\xe6\x9c\bt\x8c!\x8eb\xcc\x17G?qtz\xe7gONn\xff\xc2by|;M20ޚ\xe488c`\xb5\x82Q%\x9c\xaea\xad\xee\x19\xfb\xd6A\x1b\x05\x15\xa6\xfc\xba\xa6_MCк\xb6\x06\xc6j\x18\x17\xf8\x00\xf0\xe0\xe0\xe0\x9c\x85\xe2L\x85\x9bq\x01\xd1j\xec\xbb=x\xdf\x00\xb4;t\xe7]@\x0e\b1p\xa1\x91p\xa1\xc8\x0e'~\xc1ZdÇ\xf5\x80\xa7\xef\r\x06\xeb{5A\xbb{\x97B \x89\x12\xa4q\x82H\xc8\x0em\xb8^\xa8\x0f_.jN\xa4\x90H\xe2\x84P\x0fgё\xfb:\x0eŠ\xecs֣\x15\xad\xd1P\xf7\xfa\xb1\xe0}\xe4`\xacAQ\x97\xd8W\x05\x1a\xad\xba\xf5\x06\x0f\xcd\x0e\x82\v!5\x1c\x1aژ\x8d\xb5\xf6\xbf\xf3\xde\xffj@\x06\xaa\xb1\x01\x18\xcf\xd8\x00\x8cg<\xdf/\r\xc0\aL\xf4\xd7\xff\xce\rIލ\xcf\x15\x03\x17dZ\xc39\x83\xf7\x04\xf9'I\x16/WG?qrz\xf7\x17NN\xee\xfe\xecby|\x14'\t8k\u007f\x84\x0fE\xbf\x86\xae\vhU\xc1\x19} \xd3s\xd6B\xa9\x06u]\xa3,\xf6(\xaa\x12e]\xa2\td>\x1b\xb4\xf6,\x14\xd7\x16\xaa\x97\"\x82\x14\x02\xb2\x9d\xae9\xef\xd9\xf4\x03\r?\xc9\xef\x02\xa3ߓ\x0f@`\xfeu\x8c\x80\x9eT\xd7O\xf2\xdey8\x04&\u007f\xeb\x03\x10 }\x86\xbe\xf0\xb6\xd7IpA\x93\xf5\xc1U\x0f\rD@J$\x97\x88\x84\xe8Ѕ\x9e \x89\x9eO`4\x8c\xb5\xe0\x8c\x81\vI6ǜC\x06GC\xfa{\xf4\xf8\x8c#¡\xb1-7\x80d\x8b\xae\x956\x06\xb2\xa11\x06ƙ\xe1\x8b< 7\xf6ϡ}\xfcރ\xa4\x8a\xd6\xc0Z\xb7\x87\xc7?\xf1\xde\xff_\x022\xb0\x1f\x1b\x80\xf1\x8c\r\xc0x\xc6\xf3=_\xfc\xaf7\x00C\xb7=\xffAwkb\xa13\xdeA\xc6\b{\xf24ͧ\xab\xa3\xb3\x9f8\xbbu\xef\xcf\x1f\x9f\xdc\xfe\xa9\xc5b9M\xe2\x04Lr\xb4\x03\xa8\xb3\x1a\xa6\xa9\xd0T\x05tS\xc2\x18\x15\x9c\xf8\xe8/X\xeb`\xb4FSW\xa8\xaa\x12E\xb1Ǿ,\xa8\xf0k\x05\xe7\\WT#)\x11\xc9\bq(\x86BP\x13\xd2>\xd7\x16\xea\xb6A\xdb\xdf\x1a\xf7t\xa6>\xde\xc33\xc0\xfb^\x80\xd7^\x95 ƻ\x06\x94\x1c\xfe\xb7\xf54Aw\xb0{h\x16\xa8!\xe1\x10\x8c\xb7=DP(\xb4ʇ\xfez\f\u007fh\xbb\x92\xe0\xc3\x15\x03\x17\x10B\xc0X\x8bJ\xd5\xf48\x03\x82\x01\xd6+)\x18\x188\x03\xb4\r$H\xa3\x03\xef\xc1\xf5\xa4\xc5\x03D!\xb0(\xbe]\xf1}\x8a\x0f\xc1@A\xd0\xf1,\xbc\xf3\x95w\xfeW\xbc\xf7\xbf\xe2\xbdWc\x030\x9e\xb1\x01\x18\xcfx\xbe\xa7\x1b\x80\xa7}F\xfc\aT\x02\xde\xc9\xd28\xe7\x102\xc2$\x9f\x1d\xad\x8e\xcf~\xfa֭g~\xe1\xe4\xf4\xf6O\xcdf\xab8Nb*\x12<L\xfaN\xc345\x9aj\x0fU\x150Z\xc1w\x106\x11ٴ\xd6h\x9a\x1auY\xa2\xac\n\xec\x8b=\x8a\xb2D\xadj\x18kH) %\x92(A\x12E\x88DD\x05?L\xbd.\xe8\xfc\x0f\fu\xac\x85\r\xbe\xfcl\xa0\x95\xe7\x9c\a\xab]\x16`nނ\x12\xc3R\xdf\xcb\xea\xfcp2v\xa1\xa0ӎ\\YM\u007f\x06\x0f\xe6\x89L\xc7@\x05\xbe\x93\x1a\x86\x06\xa0\xfb\xfaö\xab/\xfc\x9d\x92\x80\r\xfeM\xbf7\xce\xc2z{\xb0\xb6hɄ\xf6\xc0\xb8\xc8\x1f \a\x1f\xe6\x1e\xf8\xa1\n\xf05r\x01\x1b\xf86\f\xad\x8d\xbd\xc77\xbcs\u007f\xcd;\xffO\xaf#\tc\x030\x9e\xb1\x01\x18\xcfx\xbe\a\x1a\x80\xeb\xde\xfa\x1fx\xd7\x0fS\xa6\xe0\x02Q\x9c`2\x99\xdf>>\xb9\xf5\xf3g\xb7\x9e\xf9ٓ\xe3\xdb?5\x9b\xce!\xa2h\x00[{8o`T\tU\x16P5\x15}\xe7l\xf7\xbd\x00\xc0YGE\xbf*Q\x95%ʲ\xc0\xaeܣj*(\xad\xe1\xbd#d!J\x90%\t\x92(\x86\xe0\"\xc0ܮ+\xf2&\xec\xc4]\x80\xf3[*\x9ch\x1b\x95\x16\xad\b\x933\xe7|pM\x0e\xdd\x01o\\\x8f\x811P\xe7\f\b\a\xef\x19\xac\xb3\xa8u\x03\xeb,\x18g\x88\x84\x04\x03\xeb\xf8\x03.d\n\xa0\x85\xe6Ú\xc1:b\xe4w\x1a\xfd\x0e\x01\x18L\xf3\x1dW\xa1\x97\xeaik\xa8\x91\bSwל|\b\x83\xa0\xf6\xb9\r\xdd\x16\xdb&\x83V\x1f\x1d6p\xb0~\xc0Aq\xefɔ]\xf3\xc2\ak\x8a\xc3f鿁\xf7\u007f\xd3{\u007f\xd9\xfa\x11\xbc\xdf}zl\x00\xc636\x00\xe3\x19\xcfw\xa1\xf8\xdf\xfc<\xf8\xf7\xbd!3\xc6 \x84D\x9c\xa6\x98OWϝ\x9c\xde\xfd\xc5ӳ\xbb?\xbbZ\x9d\xfc\xd0d2\x03\x17\"|\x00\x83\xb6\xde\x19\x18\xd3\xef\xf4\x8di(\x95n`\xf2\x03\x06\x18\xadh\xd2/\nT\x01\xde/\xea\nuS\xc3X*\xa6\x89\x8c\x90\xc6)\xd28\x86\xe4\xf4s\xfaX]\x1b<\xf7\xfb\x82Ԛ\xe6\xc8A\xd1\x1f\x9a\xe5\f\xa7վ\x98\x0f\xaa;\xebm\u007f\x89#@?\xcb\xf9\xd0h\f\xe0\xf4a1t\x01\x11`\xb8\xe6\x00\b\xb2\xe2\x15\xedc\x19\\\xf7\xf6{\xb4\x13\xb2G\x9fsp=\\\xa8]\x1b\xf0Ph[UÇ~\xe5C\xc3#\x85\x84\x14\xb2\xe3;0\x10\xa2\x92%)\x928\xe98\x17\xd69\x803H.\xc0\x18럣\xeb}\x10HJH\xe4\xcbVb٭&\x06|\v\xebܥ\xf7\xee?\x05\xf0\u007fj\xfd\x0e\x9e\xd6\f\x8c\r\xc0x\xc6\x06`<\xe3\xf9}*\xfe\x87\xd3\xfe\xd3\v\xff\xf03$\xa4D\x9eO\xb1X\x9c\xbczzz\xf7\x17\x8fOn\xff\xfc|~\xf4\xb94\xcd:\xa2Y\xfb\x0fo\r\xaci`t\t\xa3*8k\xe0B\x88͐S\xd0\xc2\xfcU\xb9G\xb9ߡ,K\x94u\x85\xf2`ڗȢ\x04i\x92 \x16\x12\x1e\xe8\x88l\xaec\xe1\a\x18\x9f\xf50~\xc7\xf2\x1f@\xe6xJA\xbe\x9e\x03Ѕ\xf7\x84\xa6´\xeb\x03\xefzH}\x90<\xd8\n\b\x87\xd1>\xed\xb4\xce\xc0\xc3\xc4\xdd6\x06\xc1\xe9\x8f\r\xc3\u007f\xfa\xeb\xee\x0f^\x03v\xb8\x9f\uf694\xc3\xe7\xc1\xae[\xfe~\xc0=\x8f\x01\x1d\xd7@p\x81X\xc6`\x9c\x11\x1f\xc2\xf7ʄHHL'S,&\xb3\xd0h\x11\x82q}\xaaw\xc1\xc9\xd0z\a\x06\xfa\x9eQ\x14\x833\x06m4\xf6\xe5\x1e\x9bb\x8bF5p\x1e\xdd\xebAk\x18\xfbϼ\xf3\u007f\x19\xc0\u05fa&(4\x02\xeew\xd9Ќg<c\x030\x9e\xf1|\xe8\xe2\xff\xfe\x85\xff\xa0\xe8\v\x89\xe9t\x89\x93\x93\xdb_<9\xbb\xfb\xf3\xab\xd5\xd9/N\xa7\x8bW\xa4\x94\xf4w\x9dk3\xf3\xe0\x9d\x813\nFW\xb0\xba\x86\xb7\xa6+(\xc3^\x83\xa6J\xd2\xeb\xd7%\x11\xfaʪDUרT\rm\f\x18\x80(\x8a\x90\xc6\tb\x19C0*@\xda\xf6\x90>\x18\a\xef\f\x85h\xef\xdfB\xe3\xc3\xc0@\xf8\x0fnpZ\xf6:\xc9\x06mg\x8e\xd3N\xdf\a\xdf\xe4`Q\xcf\xc0CA\xe5]\xa3\xc1!\x04\xef\xbf\xc2{X\xef\xe1\x82\xf4\x8f\x8a,\xbb6\xcd\xfb^\x92w\xb0C\x1f \x12\xc3\x18\xe2\x81w\x81s\xbe_o\f\x9ewg\xa5|\x1d\xc9i\xb77 \x9eC$$\xc0X\xb7z`\xdd#\xa2\xafKcr\x18̓\x14I\x94tk\x92\x0e\r\x02e3\xb4\xff_r\x814\xc90\xc9&\xc8\x122\x10\xaa\x9a\x1a\x9b\xdd\x1a\xe7\xeb\v\\n\xafP\xab\x9a\f\x1b\x89\xfb\xa0\xac\xb5\xbf\xe2\xbd\xff\x15\f\xa4\x83\xd4\x18\x9a\xf1\xa3:\x9e\xb1\x01\x18\xcfx\xbe3\x9f\x88.V\xe7F\xf1\x1f~V\xa4\x8c0\x9b\xadprz\xf7\x8f\x9d\x9e\xdd\xfb\xb9\xe3\xe3\xb3_̲\xd9=\x9a\xfcB\x9cm(S\xcej\x9a\xf4M\x05\xa7\x9b\x10\xaf\xebqHfg𠽼\xd2\rL\xdd@\xab\x1aeUb_\x16\xa8\x9a\n\x8d\"K\\\xce\x19\"\x19\xf5\xeew@'\xc9#H\x9d\x05-\xba\xe8\xadv\x87r\xf7o#Vh\x1b\x11\xeb\x1c\xb4\xa6\x10\x1fe4t\x88\xee\x05|\xbfc\xe7l`\x8d\xe0oX$t\x84\xb7\x01e\xa2\x85\xed\xfd\x00\xc6o\xffn\x8bHpN2\xbd\xd6@\x88\x0f<\bZn\xc5\xf5\xa6\xc5\rd\x87m31\\}\xb4V\xbfn\x10O|\x83\xf4w\xad\xedh\x91\x03\xdeq\x11ܠ\x11\tŝ\t\xba\x0e\x8c!\x8e$\xf2t\x82Y6\x81\x14\xd4\x00\xce\xf2\x19\x16\xb3E ^\xf2\x03%@{\xda\xff\xef\xbcî\xdc\xe3j{\x85}Y`_\xeeQT\x05j\xd5\xc0Z\xf3\x9a\xf3\xfe\xaf\xc1\xf7\xee\x82J\xe9\xf13;\x9e\xb1\x01\x18\xcfx\xbe\xd3\xc5\xdf\xfbC\xe7\xfb8N1\x9f\x1f\xf1\xe3\x93;?~tr\xeb\xcf,\x16'\xbf\x90f\x93S9`\xd4\xf76\xbb\x16\u05909\x8f\xd5\x15\x9c3\a.y,0\xe4\\0\xcb1F\xc3(\x05m\x14\xacѨ\xeb\x06\xdbr\x87\xa2,\xa1\r)\xc3\x04\x97\x88$\xf9ݷ\xfb\xe2\xce)\x0fm\xc8\xcd!\xf9\xedzeg\xfe\x83?\xeb.\xc8\xf3\x94V\xa8\x95\xa2\x15\x82w\x9d\xc4N\x06C\xa0\x8e\xb0\x17\xa0\xe8\xe1N\xde\xf7\x8b\xfd\xfe\xea\xf9\x9bd9*\xf6d~D\x1e\x04\x02\x82\a]>gd\x01\xcc\xd0\x1b\r}@\xe7\xd25\x19\x87Y\xc2\a^\x04\xd69\xe8\xa0>\xd0F\xa1\xd1\x1a\xa6kj\x86M\x00{*9\xf0\xe0\xbd\x01\x80\xa2\x198\x04\x13\xb8\xbe)\x92Rb\x96\xcf0\xcb'\xb8{z\x17\xcb\xf9\xb2\xfb9\x87\xdb\vv\xf35\xeaȐ\xf4\xab\xacK\\m\xd7x|\xf5\x04\x17\x9b+(\xd5\xfc\xaa\x87\xffex<\x19\x1b\x80\xf1\x8c\r\xc0x\xc6\xf3{\xaa\xfb\xec\xe0\x16\xef\xbb8\\ \xcbfX\xadN\xe5\xf1\xe9\xbd/-W\xb7\xff|\x96O\xfe\x9c\x90℁\\넔\x81$&@Z|\r\xa3**\xfc\x86\xd8\xfb\x9dL\xae#\xcb\xf5E_\xab\x06Fkxga\x9dA\xd54\xd8\x17{\xec\xab\x02\xda\x18p\xc6I\xb3/d(\x8e\xfdT\xcd\x0e\x9c\xf98\xaeq\xe0\x0e+\x97\xbfY\xd3ڂ\xe6\xe0\x83\xe9\x8dB\x13\x82p\xac\xb5\x9dz\xa13\x05\nHC\x9b\t\xd0\xc2\xf5A\xf1w\xc0\x1b\xe0\xc3\xc7\xc8E\x98\xe6%$\x17\xe0\x82C\x86\xa9\xb9}\xec-\xab\xfe:\xea\xf2\x81\xaf\xdb\xf5\xdf\xddPd>\xfd\x19;\x101\xcfx\vm4j\u0560R\x15\xf9\x12tR\xc0~\xfaw\xd7\x1aA6\xf8y-\xdaB\xec\u007f\x1c\xc8\f\x01 \x8e\"\xac\xe6K\xdc9\xb9\x83I6\xe98\x0fֺ\xee\x9aw\x81C!o\xa0\x95dzO\x0e\x86q\x1c#\x8d\x13\b.\xb1\xddo\xf1d\xfd\x04\xdbb\xb7QJ\xfdgM\xa3\xfe\xab\xf1\x13<\x9e\xb1\x01\x18\xcfx~\x0f\xc5\u007fH2K\xd3\tn\xdfyA\u07bd\xfb\xd2G\x96Gw\xfeL\x12g\u007f\xc9y\xffl\xa3\x9b\xc8\xe8\x06\xde\x1bb\xcdG4\x91\v\xe6ᭆ3\r\x8ci\xe0l;\xed\x0f\x98\xe9a\x025:\x14}\xa3\xe0lX\x158\x87\xb2\xa9\xb0\xdd\xefP6\x15\x9cu\x10\x8cCF\x11\xe2\xe0p\xd7\xee\xbeې\x9d\x16\n\xf7\a\xbc\x84oW(\xfb\xe7\xdc:\xfa)\xadQ\x1bB\x1e\x9c\xa3\xb5\x04\x0f\xae\x80\x82\x8b\xe0^G\xcdɐ\xd1\x1f\xe0\x82~\xaa\x0f\x13\xbd\xec\xbe6@\xf8\xc1\xb2\xb7C%:C\x9c\xa7=\xb2\xebӷ?@9\x0ej\xbb\xf7\a\x11\xc0\xaes\"l\xdd\x04y\xf7\xfd9À7@\x85\xba\xcd\x1e\xb0\xce!\x92\x14\"\xd4(\x85\xa2)\xa1\x82\xado[\xd4[T\xe1\xc0\xe1\xaf\xef\x00\xbaע'\x10\xb2N\x05\xd0\x1a'I\x19a\x9aO \xb9艓!s\xa1\x8f#\x0e?\xaa\xb3 \ue957,\xb85\n&\xe0\x02\xf7\xa2\xd1\n\x8dj\xfe\xdf\xd6ڿ\f\xe0_\x0e\xd1\x16kG\x82\xe0\xb7;\xe3\xf5\x19\x1b\x80\xf1\xfc!/\xfe\xc3\xf7\xff\xc9\xe9]\xfe\xcaG>\xb3z\xe6ُ\u007fz:]\xfc\x15g\xdd\x1f\xab\xca\xf2\xa4nʨiJhk\x00g\x01X\b\xee 8\xc0`i\x86v}\xbcn\xe7\x94\a*\xeeFS\xf8\x8c\xd1\nƐ\xf9\r\x03\x19\U0003417f\xaa+x\xef\x89t&\xa3`Q\x1b\fw\xc2\x1e\x9f3~X\x06\xaf\x15ǧ}\x94\xd9\xc1\xd4J{reMH\xb4\xa3\xe0\x1d\x06\"2\xb6\xe1:\xf0\x80v&L\xa5\xee\x1a\xc4\u07fb\xee\t&:;]\xd9B\xf8Lܰ\xe2\xc5\xd0\xe2\xf7\x03\xee)\a\x12Ag`:\x18\xdc\xf6\xde\x00\xacG\x1bZ\xcf\x00?\xcc\x15\xf4\x83\"<(ƭ\x8b g\x1c\x92s\x18\xe7P\xa9\xf6\x9a\x13\xd3?KRD\\\xa2\xd65\xf6u\x89F7]\x10\x13\xeb\x12\x04ݠY쉛\xed\xf5\xe8\xd8\xff\xe8\xaf[\xff\xdc\xe8\xe9\xf3\x8e\xd7\xd0\xf23\x0e\x89\x89\x1dO\x80\xf5\xc8B\xfb\x92\xb7\xcdC\xfb\x98<\x00\xa5\x1b\xab\xb5\xf9{\x1e\xf8\xbb\x80\xaf\x80`\xb9\xec\xfcX\xe4\xc6\x06`l\x00\xc63\x9eko\xec\xceʕ1\x8eӳ\xbb\xc9'>\xf5\x95\xe3\x17^\xfcīI\x9c\xfehS5?\xaf\x9a\xea\xe3Z5\xb2V\r\xb4n\xa0U\x03g\x15\x00\x05\xe6-8\xf7\xc1\xd9o0]v7\xfb`\xb2\xa34\x94jz#\x9fP\x8a\xbdw\xa8\x9b\x1a\xdbb\x87\xb2\xae\xe0\x9d'\x98?\"\xdd~\xebM\xcfo\x14\xfd\xebs\xf0!\xe9n\xf8{v\xad\xc91\xd6\xd0Ĩ\x15t\xe0#\xb4\x85/\x12\x12\x8c\x01\xdaj4:\xf8\xde\a9\xa2\x1b,\xf2\x19#\x8d{$#DB\x06>\xc0\xcd@\x1e\xe0\xf0\xba\x1cL\xfbC\xf5\xc1@\xe6\xa8,\xa5\xebi\xab\xc3\xf4\x1a\x9a\x8e>\x88\xe0\xc6B\xa0G\vX\xe7Y\x10ڀ\x83\xdd\xffP\x1d0\xbc:m\xa1n\x01\x8d6\xd67OsDB\xa0ъ\x82~\x8c\n俐:\x18\xae\xfb\xd3@\f\xc1E\xe7>\xe8?D\x044\x0f\x9e\v\"\x14|\x11\x1a)\u007f@\xa0\xe8\x9fq\x17\xba4\bJb\x9c\x93\x05\xb4n\xdep\xce\xff2\xe0\xff;x\x84\xe6i,rc\x0306\x00\xe3\x19\x0f\xba\xf0\x9dPT\x8f\x8en\x89\x8f}\xe2\x8bG\x1f}\xf53/%i\xfe\x95\xba*\xfe\xb4\xaa\xaa/\x19m\x8e\x8c1L+\x05\xadj\x92\xec\xd9\x1a\xde\x19\x80\xb9\xce\x0f\xbek\x00\x867\x15硵BSW\a\x85\xbf\xd5\xc07\xba\xc1\xaeأ(+Xo\x11\t\x81$NH>\x16\x9a\x88C\xbd\xfc\xfb\x15\xff\x1e\n\xbf\xde\x00\xb4ӡs\x8ebku\x83&\xf8\xdb3\x06\xc42B$c\b\xc6a\x9dA\xa35\xb4\xd3\xdd\n\xa0/\x8e\xe8t뱌\xe8\xeb\x84\x1c\xb8\x01\xa2K\xea\x1b6\x01\xed\xf4ڑ\xdc<\xebH\xfb\f\xfd\x84\xdc\xfe\x1cm4ʦ\x0e\x89z\xf6\x9a\xb1\x0f\x0e&\xed!ɏs\x01\xc1\x0e\x8d\x8bZġ\x85\xfd\xe1\x19\x00\a\u05f9\x10\xfa\xce\x05\xd1yۭ5Z\xb2#<=\xdf$\x8e\x91'\x19\"!\xd1h\x85RU0Ơ\xd7v \xacB\xfa\xf7\x80\x0fւ\x87\xff}\xbdY\xc1!r3H\x1c\x14B\"\x8fS\x1c-\x8e\x90\xa5iG~\xd4Ơjz\xb3'\x1b\xa4\x98m\x17\xc2\x19\a\x0f\x99\aMS\xc3:\xfb\x8f\xbc\xf7\u007f\xd39\xff\xae\xb3n\xfc؏\r\xc0\xd8\x00\x8c\xe7\x0f\xf1\x9b\x9csH)\xc1\x19Y\xe1Φ\v\xf6\xd1W?;\xfd\xd8\xc7>\xf7\x91\xd9l\xf9CJ\xd7\u007f\xb2i\xcaϩF\x9dZ\xad#\xab5\x8cn\xa0\x9a28\xf2\x99\xaex\f?\x1b\a\x90\xb6\xf7$\xe1\xab+4M\xdd\xc9\xe5\xdac\xadEQ\x95ؕ{\x18kɦ7N\x91\x06)\x1f\x1c\xe0\xbc\xed=\x03\xbe\xed\xbd\xc9?\xb5ȴ\xbb}\x9a\xf6\x1b\xb2\xc0\xf5\x9e\xc8d2F$\x04\x9c\xa7\xa2\xa2\x8c\x82q\x81\xec\x17\n\xa7\vřy*Hi(\xfcB\xf4\xd0~\v]s\xde+\x0fX\x1b\f4\xd8ͻN\x82G.x6\xac\x15\xe8\xf7\xf6\xc0L\xa8\x83\xcb}7\xc3\x1f@\x1b\xfe)<\xbf\x83\xe7\xddJ\xf2\xc0\x0e`\u007f\xce\b\xa5h\xe5\x85R\xc8\x104D\xba~cL'\xe7SA\xfd\xd06\x03D\xc0\x8c\x90F\t\x00t\xd7\v\xed*\x02l\x80\xec\xf4\x1d\nc쩈E\xcb\x15\xe0\xfcZ&A@A8c\x88\xa3\x04\xab\xd9\n'ˣ\x8e\x9b\xc0\x18\v\xca\fM^\fZ\xa3j*Tu\x8d\xc6(\xd8\xf0X\xa5\x90p\xf0\xa8\x9b\x1aZ\xab\x8d\xf7\xfeo;\xe7\xff\xfe\xd8\x04\x8c\r\xc0\xd8\x00\x8c\xe7\x0fߛ\x9bqH\x19AF1\xbc\xb3\xa8\xaa\x02_\xfaҏƟ\xfc䗟[-\x8f\u007f\xc0\x98\xea\xdfmt\xfd\x15\xa3\xf5Jk%TS\xc145\x8c\xaea\x8d&\xd3\x1e?\b\xb5\xb9\xe6A74\xed1\xed\xd4?\x88\xe5m\x8f\xd2\n\xbb\xa2@\xa3\x1b\x00\fq\x14#\x8b\x13\xc4\"\"X\xd7\xfb\xb0\xe7v]\xe0͇m\x00zC{\x0fm\rjE\x85\x9fB\x80\xc8\xc4&\x91\x140\xa4\x9d\x852\r\x8c\xa1\xe2+\x84@\"cp\xce:\x83\x1fx\x0f\x19&\xe0D\xc6!%\x90S\x84n\x88\a\xe6\x03(܅u\a5\x14:\x14xCz{g\x0f\x88\x83\x87\xfb\xf3\xc3r\xce\x0eB|\xd0MҴ\xb7\x0f\xcdEp\v\x04c=D\xee=\\\xd74\xf4D\xba\x96|w \x1f\xf4m!\xa6\xfd{\xfb'\x9cs\xc4\"\n\x8e\u007f\x1c\xd6\x1aԺ!5D \x03J.)\xb2y\xb0\xd7\x1f\xee\xfb?L-\x19F\x1b\xb3\xc1j\xa7僴vɜq\xe4i\x86\xa3\xf9\ni\x94\x1c\xfa$\x04\u007f\a\xc18\xbc\xf7\xa8U\x83}U`_\xed\xa1\x9d\x85\xe0\x12\x8c3(\xad\xd0\xd45\x9cs\xbf\xee\x9c\xfb%k\xddo\x8ew\x84\xb1\x01\x18\x1b\x80\xf1\xfc\xa18BHDQ\x02\x04;\xd7g\x9e}\x89\u007f\xee\xf3?\xb0\xba{\xfb\xb9\xcf[\xad\xff\\\xd3\x14\u007fBku\u05f8F\xea\xa6F]\uf85b\x1a\xaeu\xe6s=\x83۷\xc14\x9e&\xcdN\x14\xe6=\xbc\xb3T\xfc\x9b\x86\n\xe8a\x8b\x00k-ve\x01\xa5\x14M\xd4q\x12v\xee=r\xd0ں\xba0\x15{\xff\xed\x04p\xf43x`\x9a\x93f\xbfA\xa3\xc9(Hp\x8eXƐB\x043\x1f\x92\xf7\xf9`\xe0\x13\xcb(\xac\x1d\xe2\xa0@(\xa1\x8d\x85\x14\x02I\x14\x93\xc1\x90\x8c\bbo\x1b\x80P\xb0\x9c\x0fʁ\xa6A\xad\t\xb6WZu\x16\xc0\xc3\t~H\x9e\v\xb5\x1d\xcc\x1f\x12ۺ\r\xbd\xbf\xd9\x04t\x8d\x00\xef\xd7\b׆\xed\xee\xb7\xdd\xca!\xb0\x14[\xa9\xe40I\xb0\xcb(h\xb3\t\xbaƤ\xbd\x9e4EG\x82|\x16\xbc\uf0d2Z\x02\"\xa1H~`\x88Ԓ\x03\xaf\x11\xee<\xc2\xe3\x1e\xe2\x19\x8c<\x14\x02\xb9\xaf35j\xaf\x93\xf7$\xb1\xb4\x16`@\x1a\xa78\x9e\xaf\x90D\xf1`U\xd0+\x03\x88x\x19u\x96\xc2E]bSlQk\x05.\x04\xacu(\xab\x02\xd6\x1a\xeb\xbd\xff/\x9c\xf5\u007f\xd79\xb7\x1f\xef\x0ec\x0306\x00\xe3\xf9\x03;\xf5G\x11\x15/\x0f\x8f[\xb7\xee\xb1\xcf~\xee\a\x92\x17^\xf8\xc8+\x82\xb3\x9fQU\xf5\x8bZ\xa9\x8f\x1a\xdddJUh\x9a\x02J\x954\xb9\xbbv\xda\x0f\xc5\xcc]\x87Ny\x17u\xeb\x9c\x05\xc2.Y5M }\x1d\x16\u007f\xef<9\xd0iMSd[\xf8\a6\xb5\xf0\b)wv\xd0p|p\x03\xd0\x166ڝW$[s\x0eRJD2\x02g\xbcӷkKV\xb1\x91\x8c\x91'\t\xd2(\xa1\x02\xc7\xe8\xb9hk`\x9d\x81\xe4\x12\xf1\xa0\xf0S\x80N\xbbR0hT\x83Z5\xa8U\x8dZ+ڇ\x0f|\xef\xdbB\xcfC\x01o\x1b\x066\x94*\xfa\xeb\xc8\tzk\xddk{\U000e17cf\x1f\xac\x15\xfa\x9b\xf7\xd3\x11\x91a*`k^ă\x9fA[4\xdbצ]?\x90ӡ\x1ax\xfd_\xd7\xf7\xf7\xc4E\x17|\x02Z\x8e\x85G\xef\f\xd8\xf6$]\xb2\x1fЯG\x06\x9c\x06\xc6\x0eW\x15\xedslc\x90\x87\xca\x01\xc1\x05\");e\x00\xed\xfb\xc9|(\x8a$\xd2$C\x1eQ(Q\x9e\xe5\x90B\xe0rw\x85\a\x17\x8f\xbb\u05fe(\v\x18\xad\x01\xe0mk\xed/;\xe7\u007fm\xbcS\x8c\r\xc0\xd8\x00\x8c\xe7\x0f\xd8\xd4/ e\f\xef)*\xf7\xc7\xfe\xc4\xcf\xc9O}\xf2\x8bgq\x92\xfcdS\x97\u007f\xc1j\xf5y\xa7̲i\x1a\xd6\xd4;\xd4͞\xf4\xf8\x8ed|\xf4o\x1b~\x859\u007f\xe8;\xefÞ\xbe\x95\xf19G\x01.\xd6u\x13\xa9\r\xc9p\u07b9\x1b\xac\xfc\xb68\xb0k7\xa0n\x12\xedBt\xdeG\xd2\x17dhJkT\xaa\x0e\x85\xdfCJ*\x12h\xb5᭼\x8fq$Q\x8c<͐\xc7)\xc1\xc3 ^\x1co\x8bc\x9b\x11\xc0YG>\xf4\xdeC\x11\xab\x1cUS\xa3V5\x94фP\x00\x03\x99\x1aI\x14\xfb{E\xbf*\xe9\x14\xf9\a\xcd\x01\x91\xf38\x17d\x9f\xcbz\b\xbe\x9d\xa4\x87\t\x83\xce\x0f\xab}o\x1f\xdc\x16b\x04S%;\x98\xe8\xfd\x00J\xef\xa2\u007fqXx\x05\x0f>\x05B\x04\t#\x0fп\x852$\xd9l\xd7\x19nР\f\xa3\x92\xe81\x8bN\ue1c1\xec\xd0\x0f\x91\x1dx\")\x0e\x1a!\x86\xc3\xc2\xdfB\x17O\xbb\x17\xb7&I\xed\xea`\x88\x9a\xf4M\x0e\xa1:\x93,\xc7\xd1\xfc\bǋ#\x18g\xf0\xee\xe3\a\xd8U{8\xefP\x14\x84BQȐ\xfb5\xe7\xdc_\x03\xf0\xee\xd8\x00\x8c\r\xc0\xd8\x00\x8c\xe7\xfb\xfdmL\x93\xab\x10``x\xf9\x95\x8f\xf3\xaf\xfe\xc0\x9f\x98\x9d\x9c\xdd\xf9\x8an\xea\xbfZ7ՏX\xad\x16\xceXn\x9a\x06e\xb1A\xd3\x14\xb0N\a\x9d\xbb\x85\xb7\x96\xd2\xf8\xac\xc55\x0f\xdbAA>,ޭk\x1bc\xbc\xb3ĵ\x96\x92\xdf\xfc\x019\xac\xff\x9a\x03\xb1\x9a\xef\xa7J2\x05r\x83\x027\x18\x96ô\xd9h\x15\x8a\xb1\x02|`\xc1s\xfa\xd9ʐǀ\xf5\x0eRHdq\x82<ɐD1D\x88\x04faz\x94BB\x84\x88[.x\xf7\\\x1a\u0560\xa8)i\xb0QM/ec\x80\x18\x10\xeapMr7,\xf4-\xd1.\x8a\x82\x97AkO\x1c\x1a\r\xd1\xc6\xe4\x06\xa9_G\x96{\x1f\x97ߎ\xe20D\x11Xg\xac\xd8\xff\xbd\x10H\xd0~o\xe7,\x8cwtM\x9c\tDDC\xaf\x99w\x1do\xa0\x95)\x0e\xfd\x17d\b\xff\xf1\x96Ȕ\xc4\ah-\x91\a\xab\x87\xf6y1\xd6\xf9\x11\xf4^G\xbdR\xa0\x8d\x10f\x81\xbb\xc0\aM\xe5\xd0\x18\xa9%q\x0e9\x06\x87\xa4\xc6\xc1\x17\x1c\\\xa3A\xb3ɀD\xc6\xc8\xd3\x1c2\x92h\x9a\x06\xd6[pα/\nhB\x02`\xad\xdd;\xe7\xff6\x80\xbf\x0f\xc0\x8e\r\xc0\xd8\x00\x8c\r\xc0x\xbe\xef\x0e\xe7\x02RF\xf0\xdea\xb18f_\xfaʏ&\x9f\xfa\xf4\x97^\x12B\xfe\x05\xd5\xd4\xff\xbe\xd1\xfa\xae6\x8a[\xad\xe0\xb4F]\xecPU{\x18\xdb\x10\xec\xee\x1c\xd9\xf7jE&?~xS\xed\\\xfbq(e'x\xdc\x18\x1b\fW\xfa\xc9\xd3;G\xc5\u009a\x83\xe9\x1f8t\x1b\x1c\xee\x86\xfbhW7\xd8%\xd3ϵ\xd6\xd2$\xaeꎤ\xc7þ\xdby\xfaY\xc6\x1a\x00\fQ$\x91\xc5\x19&I\x868\x8a\x0e\fe\b\x1d\x91\xe4&\xc79Xh\x96\x8c5\x14G\xbbߢ\xacKb\xc5#('\x18\a\x13\xbc\xb3\xf4\xc5\x00¦)\x9a\xbe\xa7\x14\xc1\x17@\x92\x11\x10\x17d\xf7{`\xef\x1bH|Γ\x1b\xa2m\x1b\x1e7\x80\xf7[輝\xb6\xbb\x02\xe8\xe1\xdf/\xca\xd7\xdf\xf8͵\x05\x02\xbaX\xe2V\x95\xa0\x8d\x86\xb6m\xc8Q\xf0=p\x0e\xedOa!\xa3\xa0\r\\\x12\\\xc0X\x83\xa2.\xd0hݣ\n-\xcc\x1f\x9a\x9cv=\xc4\x0eV5\xe1\x11\xb8\xd6D\x89u\x8d\xc7\xf0\xfd\xc4\xc0\x82\xfe\x1f\xdd*\xa2\x9b\xfcC\x82bGtl\x91\x81\x01\xf7\xe0Z\xfc\xc1`\x1d\x12ԂA\xb6\xda4\xaa\xcb$\xb0\xd6\xc19\xf7\x9b\x00~\t\xc0\xaf\x8f\r\xc0\xd8\x00\x8c\r\xc0x\xbeo\x8e\x94\x12\x9cK0\x06\xf6\xe2+\x9f\x94?\xf0\x83?\xb5:=\xbd\xfb#F7\xff\x89\xb1\xe6\xcbλ\xc4\x1aM\xf6\xbcJCU%\xaar\v\xad\x1a\xb2\xb6\xb5\x06Βɏ\xf76\xdc=\x87E\t\aѯ\f\xad\xf7\u007fLED\x05X|\xa01w\xd6@k\xd3g\x00\xbc\xcf\r\xe7\xfa\xe7΅\xb5\x03\xed\x8a\x1d\x94\xb1\xc1\xa3\xbe\x0e\xa8D[L}Ђ\x139\x8d\x8b\x00\xf3'\xf9\x01\xc1\x90\x85\x9c\x82HH\b){\x98?|\xa6\x1b\xdd`\xbb\xdfcW\xeeP\xab\x06\xde;r\xf6\x93\xc1\rpP8i\xb2%EA\x14EHd\x8c(H\x03)\xa5O\x00\x8c\x831\xdfi\xef\xbbо\xc1\xe4\xebB\xba`k\u007f\x8b\xa0\xa3G@\x0ex \x1c2.\xbaI\xdb\ax\xbf}\xbeݪ&0\xfc\x99\xbf^\xfa{Ba\xb7W\a\xae%<\xf6\x06D\xceYhk\xa1\xb4\x822*4\aÆ\x80䐒3\x98\x81T\xb1[=\fx\"-\x1ap(\x00\x1c\xa4\f\x86\x95@\xfbw\xae\a\x01\xf1\x10=̹8\xe0u,&sDB\x12\x12\xe5\x1c\xac\xf7\x88\xa2\b\xceZTME\xe8O\xb8\xa6\a\xc6T\a\b\x12)2\xda\xf7\x9e\x0f\xcf#4\x01\x00\xf0_\x02\xf8[\x00\x8a\xb1\x01\x18\x1b\x80\xb1\x01\x18\xcf\xf7\ue6d6\x11\xe4\xafT\x83\xe5\xeaT|\xfe\xcb?\x96}\xea3\u007f\xf4\x958\x8a\xfe\x923\xea\xcfx\xe7\xee8\xef\xb8u\x06\xd4\x00(\xd8FA\x95{4A\xdbo\f\x99\xfc8\xd3t\xd2;\"\xf6]\x1b\xf7\xbd\a\xc0\x83\xaa \x06\x97\x92\xe0\xf8\xba\xea\xe0d\x04\xbe\x80\xf5\x1eV+\n\xf7y\x1aX\xeb\x87d\xb0\x9b\x9f;g-\x1a\xadQ6%*2t\xe9&\xc5a\xb4\xad\xf7\x1e\x91\x90H\x93\x14y\xdc\xc2\xfc\xc1Q\x8es*\xfc2\x02\x0f\x10<\x1b4\x0f\x8dn\xb0\xd9\xef\xb0+vPZ\x811\x8e4\x8e\x11\xcb\x18\x9e\xd15h'\xd0V\a\x1fG1\xe2(F\x14$f\x8c\xb1\xdexg\xb8\xbf\xc7\xd0\x14(L\xae\x81[\xc0@N\x83F\x9b\xc0#\b\xcdT\xdbH\xc8v\x8a\x06\x9c\xf3\xb0ZCk\x05\xad)3\xa1+\xfaamp\x10'\xdc\xfer\xfe\x80l\xf7\xed\x17G\x1d\xdc\x108\x1dD\xc6\xd4֠&\x9f}h\xa3a\\\xef[\xc0\a\f~\xca\x14\xf0\x9d7?0\xe4G\f\u007fN\xff\x1f\xed\xe3\xee\t\x80\xfe\x00\xb7\x88e\x84\x93\xf91\x94Ѹڭ\x01\x00\xcb\xe9\x02\xcb\xc9\x14}\f\xb2G\x96\xa68Z\x1c\x83K\x8e\xbaQ\xd8\x16[l\x8b-\xf6\xe5\x9e\f\x83:\xbf\x02\xd6I\x0eۦ\xe5z\x13\xd0\xcaB\x01\xdc\x0fh\xc0\xff}l\x00\xc6\x06`l\x00\xc6\xf3=X\xfcy0\xa5\xe1\xfc\xce3/\xc5_\xfa\x81?\xb9\xba{\xfb\xf9\x9f\xf4\xce\xfcUx\xfb\x05\x06\x1f!\x10¬ӰF\xc1*\r\xab\x14\x9aj\x0f\xadk4M\t\xad\n8g\x02\xbf\xcc\xc2\xda\x16~nu~.\xc0\xea\t\xa2(\x01\x17\x82Pl\x0f\x18\xa3Q\xd7u\xa7\xddG\x80\xff\x8d\xd2\xe4\xf5\xff\x01\x1c~\xef\x0f\r|X\xf8y\xca(\xec\xeb\x12eU\xc1:K,\xfc\xce\xfb\xdd\xc0Z\x9a\xee\xe2\x88\x1c\xea\xf2$\xe9\x18\xff\x8c\x87)UF\xc1\xf0\x88\x03\x8c \xe3\xf63\xac\xb4¶\xd8a_\xee\xd0h\x05\xce9&i\x8e,ͩ\xe8i\x05m-8@E?\x8eC\xec\xb0\xe8\x1e#\xed\xa99\xb8\xa4f\x83\x81u\xfb}!\x04\x84\x14\x10B\x92\x1dnkv\xd3\x16C\a\xa8\xd6[\x9f\xb3\xe0&\x18\x88p\xa1\xc0\x1ac`\x03$o\x8d\xedÒnܣ\xfc5\xe4\xdfw\xbd\xda\x10\xee\xefH\x83OQ\x16\xdc\\\x1b\x84\xde/\xac*\xda\xd4\xc6\xd6I\xb1R\r\xf9+\x183\x908\x86\xf2\xce\xfa\xb8\xe1n\x9a\x0fFI\x878\x00\x0fͦ\xefayvsy\x91\xca\x04\x93,G\xa5\x1a\xd4M\r\xc19N\x97\xc7H\xe3\xe4\xe0Z\xc4Q\x8c\xe9d\x86,M\x91D\t\xa4\x94\xd0F\xa1\xaa\xc9\x1b`\xbd\xbb\xc2z\xbfE\xad\xea\xc0U\t\xbc\x84 \xd7l\x1f\x87w.\xac\x97h3\x00\xe0o\x82\xb8\x01c\x0306\x00\xe3\x19\xcf\xf7ț\x95\x13\x8f<Ms\xf9\xd1O|y\xfa\xf1\xcf|\xe5\xd5<\xcf~\t\xce\xfd\xac`8\x15]=\xb0\xf0\x9e,S\x9dQTL\xb4BS\xefQ\x16[\xa8\xa6\x80\xf3\x16\ft\xd3v\xa6\xdfܶ7\xc78N\x90\xe6\x13D2\x86k\x99\xde!\\E55\x94R\x1d4m5ź>\x8d\xf8\xf7\xf4;\x0f\xe0\x03v\xad5鷋\xa6\x82\xb5\x16BpJ\x8c\xb3\xb4\x020\u0381s\x9a\xd2'I\x8e,N e\xd4I\xc2\xda8b\x1e\x9c\xfa\xdaj\xd3N\xdd\xc6Y\xec\xab\x02\xdbb\x8bF5`\x8cc\x92\xe5XN\xe7\x10\"¾ܣj*pN\xe63y\x9aC\x8a\x88\x84\x86\xed\xd4\xdd\x06\xd8tn\x80T\xf4\x89S0(\xf8\x9d\xb9Mo\x89ۢ\x11ι\xe0\x95``\xac\x865\x06\xceP\xa1\xb7\x9d\xff\x81\xeb\xd8\xf3\xde\r\xa6\xfc\x1b\xbe\xf8ק|\xff\xf4\x14\xe4\xf0e]l\xb1#\x94\xa6\xff\xe2Cq\xe1\xfb)/\x00J\x0f$ie\xaf\x8a0-ϣ%s\xe2\U000312b0\xbf\x1f\"\x01\x8c\xf3\xc0=\xe9\xf3\x99\xf9\r?iR\xb4\b!\xba\xd5G\x9ef8Y\x1c\x1d\xa0\t\xc3&2\x92\x11\xb2$G\x9e\xe7\x88\"\t\xc1\x04\x1a\xa3PV%ʺĶ\xd8bW\xeeQ\xab\xa6/\xfe\xe1I\xb7i\x8a\xd6t\xef\xdf\x06\xc0W\x00\xfc\x8b\xb1\x01\x18\x1b\x80\xf1\x8c\xe7{a\xf2g\\\b~r\xf2L\xfa\xf1\xcf|\xf5\xd6\xed\xbb\xcf\xff$g\xfe?\x10\xdc\u007f^\b\x1eK\xc6 Zj\xb8w\xf0\x9e\x18\xfd\xceҞ^\xd7\x15v\x9bK4u\x19\xccW\x1d\xacq\xb0\xae\x95\xe2Qr_$c\xe4\xb39\x924\xa7,xg\xc3~\xbe\x97\x9a\xa9\xba\x81\n2<\xad\x14\x99\a\x01`\x1f\xeay\xb0NfW\xd6%\x8a\x9ar\xe8\x19g!\\G@\x19\x82\xbf\x19cȒ\x14\x93t\x82$N C\x01\xe6\x81|\xd7M\xe7\x03\x89Z+\xb9s\u03a2jj\x94U\x81J5\x00\x80,I1ͧ\xc8\xd3\x14`\x1cMS\xa3\xd1\r\x04\x13H\x92\x18RD\xfd\x04\xdbN\xf5-\x93\x9fs\x80\x93\x11\x0eゼ\xf6\xdb\xfd\xfe\xa0H\xf3\xe0i/#\t\x19E\x10B\xc2{\x8f\xb2أ\xd8\uf81a\x1a:H\n\xbb\xe9=|\xaf\xde\x10\xc9\xf5\x89\x8a\xfeZ\xe0\xd15\x85FW\xb8\x0f\xd8\xf9\x03\x86\xfd@<\xd0\x16:\xdfI.CF\xc0\x87(\x04C\x83\"\xe7\x1cj\xadP6eHU\u0530\xa1\xf9\x1b\x86\x0e\rI|mC\xc4C㦃\x1drK:\x1cr&z\xe5\x85\x00\xd93\x13!\xf0x\xbe\xc24\x9b\\{\xbc\fy\x9a!IR\xec\xcb\x02\xc6\xe8\xaey\x90\x81\x98٪1\xac\xb5xp\xf1\b\x8f.\x9fP\xb3:\x90\"\xfa\xa0\x9e\xb0\xbdm\xf0\xef\x00\xf82\x80\xed\xd8\x00\x8c\r\xc0x\xc6\xf3o\xf4}\x9a\xe5\xb3\xf8ŗ?3{\xe1\xe5O\u007f<ϳ\xff\x80\xc1\xfd\xb4\x10\xec4\xe2\x8cs\xce \xc0 \x83U,\xc1\xac\x86\x82e\xacAS\xed\xb1߮)\xd1ϷR\xbd~\xc2t\x8e\xcc\\&\xd39\xa6\xf3\x05\x84\x88\x02#\xdfu\x92\xb26\xa1\xceZ\x83j\xbfGY\xed\xa1\xb5\xbe&\xed\xfb6\x05\x04\f\xda\x19\x14U\x89\xa2*:\xf6~\xeb\xec\xd6\x15_p\xc4q\x844N;\x16:\v\xae|B\xf0\x03\xf8\xbc'\xda!\xc8\x11)i\xb05\b\xe2 \u007f\xf9<ː\xc4I\x1f\x81<P!tѹ\xfcP&x\xdd\xf3\xbfդ\xb7:t\x1f\x9a'.x0!\x8a\x03ᐊ\x9e5\x06\xc6(\xa8\xbaFQ\xec(\xa8f\x90S\u007f=\xca\xd8\a\xfd\xbe\xb7\xfd\xe4\xdf\xfa1\xb4\xf0\xfeu~\xdd08i\xe8\b\xd8!-\xad\xd9\x12\xeb\xa7\xdd\xf0\xc3\x0ex\x04λ\x1bN~\a/\xec\x90Y\x1f\x9a\x816ȨRt\xbd+E\b\x87\x1f\xac&\xba\xec\x84\x01\nЮiZ\xe7\xc6\xd6Q\x90\x1d8\x18\xfaNiaC\xb3\x10G\x11Ζ'\x90\xa1\xa9j\xbf\xdf\xed\xb3;XL\xe7Db\f\xa6SֺД\xf8ΪYJ\x898\x8ap\xbe\xbe\xc0\x1b\xef\xbdE\u007fw\xe8V\xe8=\x8c\xa5\xf7|x\x06\xffW\x00\u007f\x16\x1fƘrl\x00\xc6\x06`<\xe3\xf9N\u007f@9\x17\xf2֝\xe7\xe2\x8f~\xe2\xcb'\xc7\xc7w~\xc2{\xf3\x1fq\xb8/\b\xc1\xa4\xe4\x14b\xc39 \x83P\x8a\xc1\x81q\x82حiPl\xafP\xec\xb7\x1d\x91\xac1\x16F\a\xc8?\xc0\xceQ\x94`\xbe<B\x96O\xc3\xcf\xed\xe5x\xce9\xb8\x90\xc0F\x96\xc1\x15ꪀҺ3\xa8\xf9v\xf7\x11\xba![\x14u\x89}]B\x1b\r\xe6ѹ\xed\xb5\xeb\x8dD\xc6H\x03\xe1\x8e\xd8\xf8$\xa9\x13\\tr\xbc\xe1粟t\x83A\x90Q(\xaa\nJ5`\x8c\x11I0\xcd\x10G\tZ\xd6\xfb\xd3\x1a\x93\x16Q\x10Q\x8f*\xf0\x0eҿ\xe9XG\b\x01\x83\x10\x11\xa28\x86\f\x92ö\xd8\x18\xad\xa0Uә,9K\x86IZ\xeb\xce\xee\xf8\xd0\"\x17\xbd\x94\xd2Y\x8a\xb0u\x87q\xbe\xc3\xda\xcf8\x1b\xa0\x0f4ȶMG\xdbHt\bB\vs\xb7\xdfk\b\xff\xfbA33\xc8|hI\xa1\xef\x1bA\xfc\xd4\x15\x01\xadl\xf6u\x89}\xd7\xdc\xf5\u007f\xa7m\xf0\x18\x88\x1f\x90\xc5)<<\xaa\xa6\x81q\xa6C]\x18\xfa\xd0 \xc6\x19\x16\xf9\x1c\xb1\x948\xdf^\xc1Z\x8b\xc5d\x8e\xd5tqp\xedV\xf3%\x8eWǴ\xbaaC\xb4\x83<)\xaa\xba\xc2f\xbf\x81s\x1eI\x92`\x9aM\xb0ޭ\xf1\xcd\xfb\xaf\xd3{\xb1m\n\xd1\xe6Z\x1cD\x19\xff\x15\x00\xff\x87\xb1\x01\x18\x1b\x80\xf1\x8c\xe7\xbb\xf6\xc1d\x8c\xf1$\xc9\xe4G?\xf1\x85\xf8\xc5W>\xf5\\$\xe3\xbfh\x8d\xfa\xf7\x19\xfc]\xce=\x13\x00\"\xc9!\xc2Hʼ\x03\aH\xff.\x18\xb4\xae\xb1Y?\xc6n{\x058\xc0X\x0f\xa5\x1dZ\x84\x93\x9a\v\x8e|:\xc7l\xbeD\x14E\x83\x9b \x15\x88\xb6\xf8\xab\xa6AU\xee\xa0U\x1ddS6h\xfd\xdd\xfb\x16\xd6\xf6\xf3\xe3\x9cC\xd5T\x94+\xafUG\xfej'I\xca\a\x88\x91\xc5)b\x19\x13\x91\x8e\xcb \x89c\a\xa11]\t\xba\xf6\xb1\xb4\u03a2\xa8\n\x94M\x05x \x8d\x13L\xf2)\xd2$\r\x8f\xc1\x1e\xc8\xd5\xda\xc7&\xa5\x84\x90\x11\xad\x16\xbaT?\xd61\xfd\xc3\f\v\xe6{\r\xbb\x94\x11\xa2(F\x14\x13{\x1f\x8c\xc1Y\x03\xa5\x1a\xe8\xa6!㝐\xa7\xd0>G\xe7CB\x9dR\x84\xa8\xa0-\xf0\xad\xa4.L\xe3n\xe8\x83\xd0\xc3\xff\xad\xd1\r\x0fJ\a\xc6\xf9\xc1L\xda\x12\x0e[h\xdf\r\x9a\x86õ\xc1M\x05\x86\u007f\xca\n`ؠx?\b\xff\xf9\x00\xacg\x88\nT\xaa\xc6f\xbfž)\xbb\xebί\xf1\x01$\x17X\xe43\x18簫\xf6$\x8d\x84\xef$\x98\xad\x10%\x89\x12\x9c̏P4\x15\xae\xf6[H\xceq6?F\x92$\x9dk$\aC\x96e\x98M戣\xa8{\xfe\xad\xcfCUUؕ{\xf8\xc0'\x91R\"K2\\\xed\xd7x\xe7\xf1\xbb\xb0\xd6\x1d\xc8\x17\xa9\t0m\xaf\xa4@|\x80\xdf\x1c\x1b\x80\xb1\x01\x18\xcfx\xbe\x1b\xc5_\x9cݺ'?\xfd\xf9\xaf\xa6\xa7g\xf7>a\x8c\xf9%g͟a\xf0K\xc6<\x98\xf7H\xe3\x18i\x12\xc1[\rUU`\xde#\xc9&\x10B@\xeb\n\xbb\xed%6\xeb\xc7\xd0J\xc3\x18\xc0\xd8\x01y\xcayDI\x8c\xc5\xf2\x18\xd9d\xda\xef]\x0f\xd8\xe4\x80\xd5\n\xe5~\x8b\xaa؇t\xbd\x9e(H\x85\xce\xf6>\xf5\a\xb1\xbc\xf4\xf5\x8dj\xb0\xab\nT\xaa\x86w\xae\x9bZ)\xf65\x0e2\xbe\x14Qؕ\v!\x02\xab\x9f\xe3i\x89yO\xab:\xde{\x94M\x85\xbai \x84\xc0$ˑ&i\xe7\x10\xd8I\xe8:M\xbb\xe8\xd5\x02B\xd2\xea h\xf9op\xd1Z\xa6\xba\x10$\x05LRH)\xd1\ue96dn\xd0\xd45\x94\xaa;c\x9f\xa1o\xfep\xbaoT\x83\xa6Q\xb0!C\xe0\x00m\x1f\xc0\xff-\x17\xc3#\xf81\x04\x9b@\x11L\x8c\xd0M\xe6\x87\u0380\b\xdf\xf3\xfa\x9a\xa0O\b|\nw\xa0\x05\x02\xfc\a\xab6z{f\xf7\xa1\xc0\xf0\xb6\xf1ۖ;\xac\xf7[\xa8\x10\xc4Թ(\x86\x9f'e\x84\xe3\xd9\x12\xc6\x1a\xac\xf7[\x18k\xc3*\xe70%q\x9aN0\xcfg\xb8\xdc]\xa1\xd6\n\x93$\xc7\xd1l1\x98\xdc{ĆB\x8ch\x15\xc6B\xbcs\xb7\xde\x18D&\v!\x90D\x116\xfb\x1d\u07bbzD\x11Ռ\r\b\x91\aM\xc0\xb7\x00|\x01\u007f\xc0\xf8\x00c\x0306\x00\xe3\xf9\x1e\xfb@J\x19\xc9W?\xf1\xf9\xe8Տ\u007fn\x9af\xe9W\x8c\xb1\xbf\f\xf8\x1fd`\x13\xcei\xd2O\x13\x92\xc2\xc1{\x98\xa6\x82j*\xa4I\x0e!%\xea\xba@\xb9_c\xbd>GY\xeea\f\xa3(ٶ\xb9\xe0\f\x93\xc9\x1c\xf3Ŋb\x82\xfdu\x11\x16:\xd2\xda~\xbb\x81V\xf5S-Xmk+\x1bHe\xc3\x1b\x8au\x16\xbbb\x87}U\xc1\xf9\xde\xc4G\n\x814\"\x8b\xde4I\xa9\x10_g\xf0\u007f\xa8Om_\xf9\x84\x90\xc0\x80\xf4\x05Olu\x1b̈Z\xa4\xa3-\xfaRJ0A\x91\xbeC\xa9\xdeA\xc1\x049\xfd\xc9(B\x9c$\x90\"L\xfb \xcb`\xa5\x1a\xa8\xa6\x82\t\x1a\xfd \xec?\f\xef\xe9v\xeb\xad\xd6\\\xa3Q\x14\xb8\xe3\a\xa6G\xce\x0fd{n\xb0\x87o\xa7[N\x1e\f\xade\xb1wO϶\x1f:\xee\x1d\xfe\xff\x01B\xe3o~E\xf7x\x9f\xfe\ro6\x02\xde\xf7W\xc9\u007f\xbb\x17ɣV5\xae\xf6\x1b\x94u\x05\a\u007f\xd0 y\x00\xb1\x908Y\x1cC[\x83\xab\xdd\x06ƙ\x1b\xaa\x00\xce\x18f\xf9\f\xce9쪂\xe4\xa02\xea\x1a\x1b\x1e\x02\x83҈\xa4\xa1\x91\x90\x90\x8c\x93o\x85\x10\xbd\xb1\x91\xeb\x9b,\xc6|p:LP\xd4%\u07bbx\x84Z7\a?\xdb;?\xf4\b\xf8\xbf\x01\xf8\xf9\xb1\x01\x18\x1b\x80\xf1\x8c\xe7\xf7\xe5\xc38\x99\xcc\xe4g\xbf\xf8\xc3\xf1\xb3Ͻt\xec\xe1~\xc2\xc3\xff\x15\xc1\xc4g\xb9\x14\xb1\xe0\x1cB0$R\"\x8bS*\x10\x96\x8c~\x04\xa7\x1c\x80\xbaڣ\xd8^\xe1\xf2\xe2=\xecv;X\xd7\xef\xd8i\xd7\x1fc\xbe<F>\x9d\xd1\xf4\xe4nB\xbf\xce\x194u\x05U\xd7\xd0ZC\xab\xfa\xa97\n\xefi\xdfm\xad9Ȉw\xde\xe3j{\x85]\xb1\xef\x1c\xee\xa2(B\x96\xa4ȓ\fq\x9ct.z\xed\x8e\xfd\xdb\xf9\x06\xb4\xae~\\\x88\x0e}\x10\x9c#N2d\xf9\x04Q\x1c\xc3Y\x8b\xba\xae\xd0T\x15\x8cQ\x83\xc2\x1f\x13\xc2 I\xaa'Z\x8f\x00\xc6n\x84\x12\xb1\x00\x0fGq\x8c8J\x88\xa3\xc0\x18\x9c\xb50ZC\xe9\x06F5!9\xd1\x1d4E\x1di\xef\xa0P\xa2+R\xceZ4M\x83&\xac\x01\xbcs\xfd\xdf\x1f\"(\x1d\xc9\x0e\ahH\a\xdd?Mn\xe1q i;(\xff\xfeZ\x8e\xe0S\x9a\x00w\x9d\xfc\xf7Ԇ\xebp5\x80\xce\x11\xf0\xfdD\x88\xfd=T\x1b\x8du\xb1\xc1\xae,`\x9d;@Z\xbc\xa7B|<[\x01\x00.wkhg\x9e\x8a\xfb\xb40}Ke\xe0O\xb9?\xb7n\x82q\x14\xe3xq\x84\xe3\xe51\xe2`\x14e\x83\xe3\xa1\thMU\x97\xb0\xce#\x8db4\xba\xc1\xdbO\xdeC\xad\xea.ɱG\x02\xba&\xe0?\x04\xf0\u007f\x1c\x1b\x80\xb1\x01\x18\xcfx\xbe\xa3g\xb1:\x89\xbe\xf0\xe5\x1fK\x8f\x8eo\x9d9\xa7~\x06\x8c\xfdu!\xf8\vq\x1c\x8b8\x8e\x10\xc9\bi\x1c#\x89\"\xd2s[C\xa4?\xc6ᝃjjT\xe5\x16\x0f\uffc1\xf5\xfa\xaauu\xefX\xfa\xd9d\x86\xf9\xea\b\xf1\xd0L\xc5\xf77\x82N\xea\xc68D\xd8s[\xa3qy\xfe\x10\xeb\xf3GO\xbd\xc5\x0fek\xed\xb4\xed\xac\xc3\xd5~\x83F+dq\x8c4!\xf6}\x1b4\xc38\xa3\x02\xfcmn>m\xe1\x97Q\x8c8I \xa4\xa4\xe7\xa9j0.0\xc9g\x88\xd2\x04\x00\xa0\x9b\x1aUY@5d\xf8\"\x84@\x14%D\xce\x13\xfdn\x1f\xd7\x1c\xea\xbc뛋(\"2_$\"0\x1e\\\xf1,%㙦\x81\x0erJ\xdfA\xf4}\xc1\xf5\a)\x80\xedD\x1f\xfe\x9e\xf3a\xe7Of3\xda\xe8\x10\x04\xd4*\x10\xfa\xfd9\x18\x06\x16\xb9\x03\xd4\xe6\x00ʿV\xa9=\x0eɂ\x03l\xdf\x0fv\f\xfeZ\x17\xe0\x9f\xd2<\xb4Hύ\x1e\xe3Z\x8f6D\x01:\a\xc2\x0f\xba\x8f\x82\xc1z\x87]\xb9ú\xd8\x1e\x10\x04\xfb\xf7\x9f\xc0r2\x87\x14\x02\xdbr\x8fƨ\xa76\x01C\xc2\x1e\x1b\x86\n]/la\x95 \x85D\x9efX͖8Y\x9e`1\x9d\x91\xb2\xc09l\xf6[<\xb9x\x02\xc1\x18\x928A\xa5j\xbc\xf3低\x18\xf8\x94&\xa0\x02\xf0E\x00_\x1f\x1b\x80\xb1\x01\x18\xcfx\xbe#g\xb9:\x8b?\xffG~|\xb2X\x1c߶N\xff\fc\xfe/\v!^\x88\xe3\x88'iұ\xd9S);\xa6x+\x96\xb2\xda\xc0\xe8\x1aM]\xe1\xbd\xfbo\xe2\xfc\xc9Cx\u07fb\xb3\t!1_\x1ea2\x9dw0\xf2A\x91\x05\x10\xa7\x19\xe28\vR\xb7^\xdb\xce\x00T\xe5\x1e\xef\xbc\xf1;ЁY\xff~\xf8\xb3\xeb2\x04\xc2\xce\x15@\xc4\x058\x17\x9dM\xee\x87Y \xb7\x85?\x8eSd\x93\td\x1c\xc1[\x82\xf5\xbds\x88\xe2\x04i\x96\x83s\x81\xa6\xa9P\x15{\xd4uI\u007f\x16ň\xe3\x18BD\x810w\xe8\x11\xd0\xc5\x113t\xd6\xc6QD\xc4\xc3.\xd1·i_5\x04\xf1;\xdb5R\xd6\x0e\xdc\xf9\x0e\x17\xed\a|\x83\xa7A\xf9m\x12!\x03\x82\x0f\x80;`仠Ƞ\xda\xc5\x06D\xc0\xa7@\xf1C\x82\xdf\xc1\x84\u007f-hi8\x9d\xfb\x1b\u007f+8\x1c\x0e_\x17vM\xfaw}\xb6\xf7Oi\x04\x06\xb1\xce\xe1=\xf0A\xa7l*\xac\xf7\x1bTM\r\xcf\xfc\xc1k\x03\x00y\x92!\x92\x11jE~\t\xef\xd7\x00\xf4n\x84\x87V\x82\x8c\x1d>\x87\xa0~\xa4\xf7\xa3\x10\x98\xe6S,f\v,&3\xc03\xec\xf6[Xg!\x98@\x1cE\xd8\xd7\x05\u07bbxt\xc0y\xa1f\xd1Ak\v\x00_\x03\xf0%\x00\xd5\xd8\x00\x8c\r\xc0x\xc6\xf3{:ǧw\x93O~ᇧ\xb3\xc9\xea\x19\xc0\xfe\f\xe7\xec/q\xce^\x90B\xb0$\x8d\x91e\x19\xe6\x13b\xb4Ӎ\x88\x1c\xfc\xe0<t\xd3\xc0\x1a\x85\xba.\xf1\xde\xfd\xb7p\xfe\xf8A \xaeQ\xe1H\xb3\x1c\x8b\xd51\x92$\xbbv\xeb\x0fD\xc0(F>\x9dAȨ\v\xdc9\x980\x19C]\x16\xb8\xff\xc67\xa0\x9a\xe6C~\x1e\x06\xb1\xad\xdf\x06\x1e\xbeQ\xf8C32\x99͑\xe6Sxx\xa8\xba\x84\xd6\nRFH\xd2\x1cB\n\xe8F\xa1,\xb6\xa8\xcb\x12\b\xf6\xc0-A\x8f\x05)Y\xbf\xd7\xf6\x03\xe9\x9e@\x14Sя\x82\x9b\xa0om\x93\x0f\xe4{zP`ȾVi\x05g\xec\xcdb\xec=)#\xbc=\x9c\x84[\x9b\xfdA\xb3!%\x05\x159o\xa0\x1a2;\xb2\x9d\xc9Rx\xac\x0e\a\xb1\xc8\x00n\x14\xfc\x1b0\u007f\xb8\xee-I\xef\xa0A\xe9\x8ae\x8b\x80\x1c\x86\xe4<M\x1d\xe0?d\x03pPHڟ\xcb<\xf9\x18\xe0\xfd\x95!\xc6h\xac\xcb\x1d\xb6\xe5\x0e\xd6\xda\x1bӶ\x10\x02\x91\x88`\x82\t\xd5\xf5\x13IR\xac\x18k\x91\x86 \xa8Ƅ\xdc\x02k\xe1\xe0\x0e↯\xbb\azO.\x85q\x14!\x8dRdI\x8aD\xc6\x1d\x8fd]lq\xbe9\x87\xb6\x87\x81V\xd6ZX\xe3\x00\xe0\xbf\x02\xf0\x97\xc7\x06`l\x00\xc63\x9e\u007f\xedsv\xe7\xf9\xf4c\x9f\xf9\xea\"\xcb'\xf7$\xf8/\xc8H\xfe{\x82\xb1g\x18\xf7\\J\x8e4M1\x9f\xcd1\xcd&\x1dt\xcd<\x85\xe6hU\xc3\x04\x96\xfe\xfd\xb7\xdf\xc0\xfa\xea\x02\xad\x90\x893\x8e\xe9|\x85\xf9r\t\xceŵi\x95\xcaA:\x99\"\xcfg\x94\xae\xe6\xcc\xe1$\xd5Bь\xe3\xea\xc9#<\xb8\xff\x06>t\xc2\f\xd8\xef\xfa&D\x85?\xc5d\xbeD>\x99B\b\x81\xa6\xa9\xbb\xa6#I3\b)aT\x83b\xb7C]\x17\x00\x18\x92$E\x9a\xa6\x10\xa1\xb0\xf6\f\xee0\x89zG\xe4C\x19!\x8a\x13\"\x85q*\xe8\xd6\x10I\xb05\xebу\xbd>\xa3\x05<A\xd7\u0590\x81\x92u\x83\xac\xfaVoo\xbbf\xca\xc3u:\xfa.\x82XrH\x11\x05\xcb`~\xf0\x12\xf8\x10\v\xac\xb4\xee\xe2x\xc9\xf0\xc6\r\\\x02\aE\xde\x1f\xfe~\x18\xd1\xec\x03\x02\xe1\xad=\x84\xc7[S\xa0A\xbc\xf0\xa1\xef\xefP\x8a8\x18\x97\xfb\xa5\xc3͕\xc2\xfb\xb0\xff\xae\xaf@\x86\x84\xc1\xeb\xef\x8dv\x8a/\x9a\nW\xdb+Ժ\xb9\xd6\x04\xb4\x91˲K\x99\x1c\x9e8\x8a\x91\x06\xf2\x1ec\f'\xf3#LҜ\xa2\xa3\x8dB\xa3闶dW\xec\x9c\vk \x1c\xa2%\x838\xe3$J1\xcb&\x98\xa5\x13\b)q\xb9_\xe3r{y\xa3\t\xd0ڴ(ǿ\x03\xe0\x1f\x8d\r\xc0\xd8\x00\x8cg<\xbf\xebs\xfb\x99\x97\xd2O|揮\xf2|\xfa|\x9a\xa4\xbf\x98\xa5\xf9/FR\xdc\x02\xc0=\f\xbc7\x98d9f\x93\xd9@\x1b\x1d\nR`\xa0oחx\xe7\xcdװ\xdf\xef\xba\xf7j\x14\xc5X\xacN\x90O\xa6\x9dL\xee\xfat5\x99\xad\x10'\tLk\xe6\xc3n\xb2\xca\x18\xe7h\xaa\x12\xef\xbc\xfe;\xa8\xab\xe2CO\xff\xf8\x90\xa6\xc0>\xfc\xdc$I1\x9d/0\x99- \xa4\x84n\x1a\xd4u\t\xc0#Ms\xc8(\x861\x1ae\xb1C]\x14\xf0\xde#ISb\xe7\xcb\b\x9c\xb3N\xf7\xddiڽ\a\xe7\xc4\x1f\x88\xe2\x04\x92K\x80\xf9\x90\x8ch`\xb4\xa2\xe0\x1dK\xfbx\x84b\xd9\xc1\xc7Azg\x8dF\xd3TAQ\xe0\xe0\x83\x8dm_I\x0e\t|\x8c\x11\x97\xa230\x12a\x05r\x13[\x19L\xe1\xc470m#\xa2BѲm\xf4\xae\xef\n\xb8\x1b\x12\v\xdb\xc0\x1eg\x0fV\x0e\xb4m\xe1\x9d;\xe2u5e\x8b\x06\x1c\xac\x06:\xa4f\xb86\x18\xfc\x1dw\xf8\xe7\x87\xc8\x03\x0e\x88\x81-/交\x14C/\x01\xd6a\x12PF\xe1|w\x85\xa2*\x9f\xfa\x1e{\xbf\xf7]\x1a%\x00cPZ!\x8e\"\xdcZ\x9e\x1cX9\xb7+\x9b,\xcb`\xbc\xc3\xf9\xd5\x13쫒\xa0}\xe0\xd0\xdc\t=\xa9p\x92fXN\x16\x88\xe3\x18\xeb\xfd\x16\x97\xdb+Xo\xfbU\x85G\x90\a\xfaK\x00\x9f\a\xf0\xf6\xd8\x00\x8c\r\xc0x\xc6\xf3\xe1\x8b\xff\xbd\x17\xb3O}\ueaeb\xd5\xea\xf8\xa3\x93l\xfa\x17\xe3$\xfd\x19\xc9ű\x10\x9c\x91\xed\xac\x83`@$%Ġ8Yc\xa0\x9a\n\xd6(\\\x9c?\xc2;o|\vu]u\xef\xd3,\x9fbyt\x8c(N;i\xde\x10\xf2\x8f\xd3\x14\x93\xd9\x02\f\x1c֚\x1b\xf0h\xf7\xbe\xe7\fuU\xe2\xc1ۯ\xa3خ\x03\x13\xfd;3\xfd\x0f\xa1\xfe\xd9r\x85\xd9l\x01\x11\xc5кAU\x14\xb0\xd6 \xcd2$iN&B\xc5\x0eu\xb9\x87\xf3\x9e\"y\xe3\xb8\v\xe5i\xc7_\x8f~'\xcc\x03\x03\\F\x11X\x80m\xb5Q\xb0!ZWk\x15x\x00|P\xf4ـ\xa1@߳\xa9+\xd4U\x19L}\x18\xb8\f\x8e\x84\a\x1a\xf56\xe0\x86R\xedڢ\xc2١s\xe1\x8d\x06\x80\x91y\xcd\xcd\xcb\xc5\x02\xf90\x049\x01\xe0\xac\xcdihÉ\xe8{u\xb1\xc5]n\xc0\xc0\xee\x178\xe0\x13\f\xe1\xfb\x96d\xe8\x0eC\x02\x0e\x14\f\x1dT~m\xf5\xe0\xdc0\x96\xb8E\x10\xe8\xbb\xc1\x0f\x92\xa4=\x9e\"\x1d\x1d \b\xbd\xfc\x82V\x02\xd6\xe1bw\x89]\xb9\xbfq\xcf\xf5\x83\xeb|\xfdZEB\x12\xe7\xc49L\xb3\t\x8egG\x87\xe9\x82\xde\xe3\xec\xf4\x16\x8e\x16G\xa8\xea\n\xeb\xfd\x1aW\xdb56\xfb\xa0H\xb06\xf8\a\xf4\x84Y\x0f\x8a<Β\x14Y\x9c\xa16\r\xb6\xfb\xed\x8d\xcfR\x90\a\xfe3\x00?\nJ\x10\x1c\x1b\x80\xb1\x01\x18\xcfx>\xf8\x9c\x9c\xdeͿ\xf0G~\xec\xe8\xf4\xec֫\x93|\xfa\x1f\v!\xff\x04\xe7|\x16\t\x01\x19\t\xc8\xe0u/B\x86l\xcbT\xb7\xd6@U%\x8cQx\xf2\xe8=\xbc\xf3\xe6\xeb]`\x0e\xe7\x02\xf3\xc5\x12\xd3\xf9*@\xdc\xfe@\xba\xc5\x18C\x96M\x91M\xa6\xb0A\x0f\xcd;\xcfx\xd6yݷ:\xf3\xfdv\x8d\xf7\xde~\x1duU\x1cx\xf4\xff^\v?\x00\xa4i\x86\xf9\xea\b\xb3\xe0C`T\x83\xb2\xd8\xd3$\x97&ȲI@\x1f*\xd4e\x01\xe7,\xa4\x14D\xec\x13\xbc\xcb\x14\xa0]\b\xfd\x96s\x01\x11\xc9N\xf7\xed\xac\x85\x0e\xce{J7a\xe2\xd7\xc1\x87>\x06\x97\xe2\xdac\x0e~\xf5L\xc0Z\x83r\xbfC]\x15\x04\x1dsq`\xc2\xd3\x1a\x19un\x86\u05cdk8y\f<\x9d\xc2\xdeN釩\x81\\p\bI\xbc\x04\xceE籯\xb4\x82i\xbd\x03\x82\x87\x03c\xfcpo\xdf\xd9\"\xf3\x8e\xbfO0<\xe9\xdd[\xfd\xbbu\x86\xb8\n\x01\x12\xef\xe3\x82\a\xab\x8b\x96\xc0\xe9\xfb\xb5\x065\x1f\x16\x8dV\xa8\x9b:x\xfd\xdf\xcc*h\xb9\u007fm\xd8O+\xb9l}\f\xa4\x90\x94\x0e8 \xfd\xb16i\x11\x1c\xce9\x9cﮰ)77\x8a}\xeb\xc2Ȟr\u007fn\xf3\x19\x00`5]b1\x99\x1d41Y\x9a\xe1\xf4\xe8\x04I\x92Br\x01\x0f\xc0\x18\x83M\xb1\xc1ËǸ\xdc^\xa1n\xea\xb0\n8\xb4Rf\x8c#MRr\x13l\xaaC.\x81\xf3\xd0\xc6\x00\x1e\xff9\x80\xbf=6\x00c\x030\x9e\xf1|\xe0\x99\xcdW\xe9\x0f\xfc\xe0O\x9d=\xf3\xdcK\x9f\x8c\x93\xe8\xaf\v!\xfe\xb8\xe0\"\xa1\b\xdc6^\x96uQ\xb6>\x90\xfd\x9c\xd5h\xea\nZ\xd5x\xf0\xee\xdbx\xf7\x9d7\x89\xb4ǀ(J\xb0<:\xe9}\xfc\xaf\x19\xbcp.\x90O\x17\x88\xe3\x04\xd6\x1aʰ\x17\"\xf8\xc7\vp)\x06im\f\xe5~\x87o}\xfd7C\xf1\x17\xbf\xe7ɿ+\xfcy\x8e\xf9\xf2\x18\xf3\xc5\x12Q\x9c@\x1b\x85b\xb7ESW\x90Q\x8c|2#=\xbf֨\xaa\x02F)0\xce!\x04\xef\x88}\x83\xc1\x8e\n\x8c ;\x94\x11\x00\x0f\xad\x14\x9a\xba\xa4\xb8\xe2`\xcb묅\xf3\x1eQ\x1c#I\xf3\xf0\x9c|\x80\xc89\x18\x17\x1d\x12\xa0T\x83b\xb7F]\x95\x00(\xcaWHR\x14\xf0n\xd2g\aY\x04ݞ\xbb\r\xbda\x1c\xb8ιh\xa7\xe1\xb6a\b\x85\x9b\v\x11\x94\b\t\x84\x8c:\xa4\xc5{\x0f\xa3\x1aJ+T\x84X\b\u0381A\xb2\x1e\xef|\xff\xa9hc\x10\xcatݙ\xb1\x9b\xe8\xbb\xd4;\xba&-\a\x82\x1a\r\rc\xa8Q2A\xed@AP\x06\xd6;ԪA\xad\x9b`\xa2\x83C\xbe\xc5\xc0\xae\xf8@\xae\xc8z\xa6~\xc4#DR\"\tY\x0f\x9d\x0f\xc4`5\xe0\xe1q\xb9[\xe3j\xbf\x1e\xc8!?\xf8~<4r\x8a\x84\xc4\xf1\xfc\by\xc8\x19hO$#\xe4yN\x81P2\xa1\x86\x8bK\x00@\xd5T8__\xe0\xbd\xf3\a\xd8W\xe5\x00\x9cpݚBHI\xe6R\xf6p\xd0\x0f\xab\x1b\v\x8f\x9f\x04\xf0O\xc7\x06`l\x00\xc63\x9e\xa7\x9e4\xcd\xe3\x1f\xfc\xe1?u\xebc\x1f\xff\xdcg\xb9\xe0\u007fC\b\xf6\x83R\x88L\x04ow\x11R\xe7x[!ڛ\xbb5\xa8\xab\x02\xba.q\xff\x9d\xd7\xf1\xe0\xdd\xfb]\xc9M\xb2\x1cˣS$Iz3a\xce{Dq\x1aL\u007fh*\x16\"\xe4\xd6s\x1eR\xebn\x16\xf8\xaa,\xf0\xdao\xfd\xff\xd0\xd4\xe5\x81\x0f?A\xf7Cؚ}\xa8\x9bK\x12&\xfe\xf9\xf2\bq\x92\xc2\x18\x83b{\x85\xb2\xd8CF1&\xd39\x924#\xc3\x18U\xa3\xae\x8aγ\x1dC\xc6\xfa`=!ۢ)D\xd8\xd3\xd7h\xaa24Ia\xbf切qΑM\xa6ȲI\b\xfai\xbf\x8f\xa0\xf8\xa4\xc0\x93h\xea\x12\xc5~\a\xdd\xd4D\x1c\x8c$q\f\xda|\x00\xaad\xc3\x19\xb0'\xc4\x05\x0f\x85\xf7\x01\xfc\xbb\x9c\x01t\x8d\x16\x99#Eq\x88 \x1e@\xd0\xce[\xc0yp!\x90$\x19\xa2$%[\xe5\xaaBU\x950ZuS{\xef\"\xf8t\xb6\xfc\rzG\xf8\x1a\xeb\x1c\xac\xd6Ц!s\xa2\xba\xa2\xf5\x88Q\xc1\xdd\xd1]\x93)2Xg\xa1\xbb\xd8\xe7\xdeO\xc1\xbb\xf08\x02\x14o\a\x96ƽ,\xb0/\xc7<\xa4\x01\xc6Q\x82<\ti\x8f\x8c\x0f\xa8\n\fW\xc5\x06\x97\xbb\xab\x10\x03<\xc8\x11\xfc\x80\xb7\\K~M\xa3\x04\xc7\xf3\x15\x92\x81\xcbe\xaf\x02i\x93\x1e\x05䀣\xc1\xc0И\x06\xe7\xebKl\x8a-\x94n\by\xe3\xbc\v\xacjs\x05\xac{J\x13\xa0ͻ\x00>\x03\xe0rl\x00\xc6\x06`<\xe398Q\x14G_\xfeʏ\xdf\xfd\xf2\x97\u007f\xe4\xf3\\\xf0\xff\x84q\xfc\xa0\x14\"\x162\x98\xd4\x00\xbd\xf5\v\xf3\x1d\x19\xdb:\v]\x95\xa8+\xd2\xe2?z\xf4\x80\x16\xbd\f\x98\xcc\x16X\xae\x8e!et\xa8\x05\x0f\xcc\xf7|2C\x92N\xc2Ζ\xccq(`\x87\a\xd6\xfc\xd0a\xae}\xbf\x03U\xb1\xc77\u007f\xeb7\xd0\x04nA\v\x85F\x11M\xa8ι\xe0g\xef\xdf\xf7V\xec\x9dG\x14'\x98\xaf\x8e\xb18:F\x9af0Zc\xbf\xdb`\xbf\xdfB\n\x81\xe9|\x89,\x9bBH\x01\xef,\xb4j\xa0T\x03\xe7L\xd0\xc1\xa3\x03\xb4\x81 \xa5\v\xc4?\x06@\xa9\x06M]\xa2\xa9K\xd4UM\x92:s\xb8\xa3\x962\xc2t\xbe@\x96O\a\x9fev \x81sޡ.\vT%\xe5\x1d\b\xce!\xa3\x98\x90\x87nҿ.\x8f\xf7aB\xe5\xd7Bn\xfcao\xc4X\xb0\xf1\x95\x9d4\x911\xfa\xfeq\x14ӂ?x60NS\xa9\x8c\x13\xa4y\x8e4%kg\xef\x1dtSC55\x9a\xba\x86Q\r\x94nȠH\x1bR1hM(@\x88D\xeew\xda4\xc9j\xa3\xa1U\x03\xad\x1b\xd4M\x03\xa5j\n.\xb24պ\x8e/r\x18\x12tm\xce\x1e\xa0\x1e}\fq\xff\xcc\aM+\xc8\b\xc9z\v\xd3&IZ;Ȏ\xf0\xdd\xda%\x8d\x13L\x92\fY\x9ct\r)\x03\xb0)\xb78\xdf\x1e6\x01\x1ft?n\x81\x16\xce\x05\xb2$\xc5\xd1l\x858p\x04\xae\x17=\xc6\x00)\"DR\x80\vB\x02\x94\xd6D\x12u\x16eSa[\ue80d!\xf4'\\O\x11l\x85\xd55O\fg\x1d\x8c\xb1\xbf\x06\xe0\xe7\xc6\x06`l\x00\xc63\x9e\xee\xfd#\xa5\x14\xaf~\xfc\vw\u007f\xec\xc7\u007f\xee\xcbI\x9c\xfc\r\xce\xd8\x1fᒥ\\0\xc8v\xca\xed\xa6\xad\x9e(\xe5\x1c\x11\xfe\xea\xaa\xc0;\xaf\xff6\x1e?|@\xecn\xc61_\xae0_\xac\xba}\xf1\x01\xe4/\x04&\xd3\x05d\x1c\xc3\x1ar\\\x93\xc1F\x97\x98\xe9р\xc4~\xa8)\xe7\x82\xe3\xe1;o\xe1\x9do}\xbd\xfb\xf3$\xcd1[\x1d!\x8aS\x9a\xb6˒ \xfa@\xa6;\xbc\xa1\xd0\xce|\xb6X\xe1\xe8\xe4\x16\xd2<\x87u\x0e\xe5v\x83\xddn\rx\x86\xd9b\x81\xe9|AN\x83\x8ev\xf56\xe8\xee)\ro\xa0\xa7\x0f\x12>\x19E\x90\"\x82\xf5\x16*\x90\xf3T(\x8a\xaa\xe9w\xe4\xc3\xc9<NS\xcc\xe6+\xc4Iv\r.nK\f\x91\xed\xaab\x87\xba.\xc1@\r\x83\x90\xa2C>\x0eX\xf48\x8c֥\x89\xbf\x95\x96\rJ!\xeb\v\u007f\x14E\x84\xbex\a\x84\x89R\xc8\b2\x92\xe0\x9c\xf6\xe22\x98\x17EI\x8a8I\x11Eq\xcf\xf9P\x144\xe4\xb4\x05\xe0\x0e\xf4\xf6\xceYh\xadQW\x05\x9a\xaa\xa2l\x06k:\xcbb\x15l\x87\xe9{4\xd0\xc6\x04\xb9a\x90,~Pؒ\xffp\xee\r\xfd\xf3g\a\xbd\x8fo\x91\x8e\xf6\xbay\x0f\xe3,\xb46h\x8c\x822\x9a\xfc\x0f|\x9b\xbb@\xbb\xf6i2A\x1aŝ|qW\xedq\xbe\xbd<\xf4\n`\xef\x9fI\xe8\xbc\a\x0f\x85:Or\x1c͖\x90B\x1c\xbc7\x84\x90X\xce\xda\xf4KB*\xb4\xd1(\xab\x12\x8d\xaa\xd1rb\x946\xb8\xdc_\xa1V\x142բ\x1eRH\x92\x18\x1aup\xed\xacu\xb0\xc6\xfe2\x80\xbf?6\x00c\x030\x9e\xb1\xf8CF\t\xbf\xf7̋g?\xf9S\xff\xeeWWˣ\xbf\x01\xe0\xabR\xf0\x98\v\x8e\x0e}\xb7n@Ģ\xf7\xa21\x1a\xba\xa9Q\x96;<x\xfb5<z\xf8\x1e\xbc\xa7B\xbe8:\xc1t\xbax\xea\x87YH\x89\xc9l\x01.dg\xec#d\x14Ht\x87\xa1;\xce\xf5\x91\xb3\x94x'\xb1>\u007f\x88o\xfe\xab\xff\x0f\xeab\v\x99\xce1[\x1c\xe3\xe4\xf63\x88\x93\x14\x9c\x01\xc6\x1a\x82\xa2\xf7[\x14\xc5\x1e\xaai:\xc4\xc1;\x874\xcbqtz\x1b\xb3\xc5\x12`\f\xc5n\x8b\xed\xfa\x12\xd6\x1a\xcc\x16K,\x96GH\x92\x14\xd6Y\xa8\x86\xa6Y\x82n\xd90$\xae[SPB\x1f\xc9\xf1TSS\xe1W\r\xb4\xd6ݎ\x1f\x03K\xfevo\x9cO\xa6\x98̖\x81\x1b\xd0\xd6\xfcC2c\xbbZ\xa9\xeb\x12<@\xfe=1r \x89￢\xfb\xefn\xd7?\xe0\x02\xb4,|\xceYp\x17\x8c\x81 E\U000e1e48\xe2\x04Q\x9c I2$i\x868NC\xc6A\xbfBp\xce\xd1\xf3\xab+X\xad\xba\xfd~\xf7:\xb7=\x8c\xf70\x9a\xb8!MUb\xbf\xdfb\xb3\xb9\xc0f\xbbFY\x95\x94\xd68\f\x0e:(\xca\xc3\xff\xf7\xaf_\x18\xba\x86ʷ\xcd@\x8f\x98x߮\xb2\x86\xd0=\xa5\x04ZgQ\xeb\x06\x95jh\xe5\x00\x17\x1a\x01\x81I\x92a\x92N(\xd6\x1a\f۪\xc0\xc5\xf6\"$\x05\xb2\x0f\xbc7\xb7\x1c\x04\x1e\x8ax\x96dXM\x97\x88;\xd3'\x8f\xd9t\x86\xd3\xd5\t\xac\xf7\x84\x9eX\x83\xba\xa9Q\xd55\x11E9=\x8eHFp\xf0xp\xf1\x18\x9bb\x031H\x8b\xec\x9a\x00k\x0e^\x1bc\xacr\xd6}\xdfD\a\x8f\r\x00 \xc7R5\x9e\xef\xf4\xe1\x9c#NR\xb6X\x1c\xcf~\xf8G\xfe\xad\xcf\x1d\x1f\xdf\xfa\xeb\xf0\xf6\x8f\n)b\x19r\ue365hX\x1e\bm\x1e\x04G\x13\xb4\xabP\xec6x\xef\xfek\xb8z\xfc\x04\xde\x03q\x92`ut\x86,\x9f\\s\x89\xa3;\x9f\x8c\"\xe4\x939\x18\xe3p\xd6\x00\b\xd3s\x1c\x91|\x8d\xf7p5\x15\xfdִ\x86\x03px\xf0\xe6\xd7\xf1\xc6\xef\xfc\x06\x15\xff(\xc3\xf2\xf8\x16n\xdd{>\xa4\xe8E\x88\xe2\b\x00C\x9d\x90^\x9b\x8a\x1d\xa70\"!\x91\xcf\xe6X\xaeN\x10')\xea\xaa\xc0\xd5\xc5\x13\xa8\xa6\xc6d6\xc7\xf2\xe8\x14\xe9$\a\x1c\xc9\xeb\x94j\xe0\xbd\xed\xbd\xdcY\x8f@\x88\xc0\x84\xb7\xd6\xc0(E\xd0wC\xbb}g\x1d\xb4\xd2aU`\x0f\a\xd8`{<\x99͑O\xe6\x9dw\x02\xae\xa5\xfd\xb5\x89~\xba\xa9`LC\xca\v.o\xc2\xf8\x87K\x8d\xb6ϡ\x9dq\v\xfb\xb3\xe1n\xdfCH\x81H\xc6]\x80\x105m\x11\xa24E\x92d\xc8\xd2\x1ci\x9eA\xc6i\x178\xd3r\v\xe0\x01cuG`\xb4ր\rx\x1dݵ\xf2\x0eZ5(\xf7;l\xd6\x17\xa1\xe8_\xa1(\xf7Dҳm9\xed\v%g\ḟמ10\xdfJ(]?U\xb3@\x8d\xbc\x16\xeb\x8ckW\xc5\x0f\xf2\x0f\x864\b\u007f\xddNx`\x1b\xd02\xf8\x87\xac\xfd$\x8a!\xb9Dm\x14j\xd5\xc0:\v\xe3,\xb6U\x81J5\x98e\x13L\x92\x1c\xb3l\x02\x0e\x86'\xdb\v\xca\x10x\n/䰟\xa1ϒ\xf5\x1eESB\x1b\x8d,Ɉ|(\"\"\xf2q\x86\x88\x91\xeb_\xe4,\x92$\xc1t2#\x04+\x909yP,,\xe7K\xfc\xf6\x9b\xdf\xc4z\xbf\x06\x87\xe8\x1a\xe184\x97C\xf4I\b\x1e{\xe7\xfe\xa1\xf7\xf8\x12\x80\xfdx'\x1c\x11\x80\xf1\xfc!;B\b\xa4i\u03a28\xc9~\xf4\x8f\xff۟\xfb䧾\xf4\xbft\xce\xfd\xf1H\xc84\x92\x02`\x1eMSR\xccn\xf8\xfb\"\x14<\x15\x8a\xffn{\x89\xf7\uefc1\xdd\xd5%\x8c2H\xf3\x1c\xab\xe3\xb3\x03\xb2߰\x8b\x97Q\x84|:\x0f\xb6\xb5!k=\xa2\x89\xb3\x95\xaf\xd1N\x9b\xc8V\xadg=@$÷~\xe77\xf0\xf6\xb7~\v\xdeY\b\x19\xe1\xf6s\x1f\xc3\xdd\x17>ޮ0\xba}\xb4\xb5\xa6\x9b\xea\xb5VPM\x05\xc68f\xf3%Ҍ\x9cض\xeb\v\xec6k$i\x8a\xd5\xf1-L\xe7\vp\xceP\xd7\x14[\xec\x8c\xc5`\x1d\xdf5\x00\x8c\xf1\xc0l\xf7\x01\x01!N\x001\xf9\xc9n\xd7\xe8&4\x0f\xfeF\x89\x96Q\x8c\xd9|EL\u007f\xc6\x06\xf9\x03\x832\xee=\xb4\xa2Ձ\xf7\x8edi\xbc\xf7\x13\xb8^\xeeH\x0eG\xa46\xc6\x18xK\n\xe4\xadz \x10*\xb9@\x92\xe6\x90R\xc0hM\xc8\x04@ʃ$C\x9a\xe5H\xf3)\x92$\x01\x17\"4\x13\xbeC\x0e\x1c\x02\xeb\u007f0\xf5\xb7\xc8L\xbbs7J\xa1*6X_]\xe0\xe2\xe216\xdbK\x94UA\xef\x19\xe7\x06\xab\x8d\x9b\xedK\xafq\x0f\r\x01xw\xbd\x87܈᪣\x8b(\x0e*\x03\x87~\xf5Ъ\x0f\x86\xf6\xc1\xee\xc6\xf5c\x1dJ\xd01\xfa\xaf\xe52\xb4M\x81s\x1eڙ\x0e-\x01\x00\x11\xb4\xf8\xb3l\x82X\xc6(\x9b\n\x17\xdb+\x18g\xbb\xa6\xe6\xc3!q\x80`\xc1Á3D\"\xc2\xd1b\x89\xe5\xec\b\xb3\xc9\x04i\x9c\xd2g䠉\U0005d1121\x86\xb2*\xf1\xb57\xbf\x81}\xb5\xef\xbc \xdaw\x97\rk\x04\x1b\xf29\xc8\xd0\xc9~_X\x05\x8f\b\xc0\xd8\x00\x8c\xe7;\t'\xc9\bi6a\xf0>\xf9\xf2W\xff\xf8\xc7~\xe0\x87~\xf2o3\xe0\xa7\x05\x17i$$\x8cQ\xd8\x17kp\xb8ΈF\b\x1e4\xc5\nF7\xd8\\]\xe2\xc1\xbbo\xa0,60\xb5A:\x99bu|\xda\xf9\x9f߄\xfd#\xe4\xd3Y\xd0\xff#\x14\x9e\x04q\x92t&7\xa2\x9d\xfc\x03\xf4ݦ\xdaY\xab\xf0\xe6o\xff\x06\xee\xbf\xf9u0ƑMf\xb8\xf7\xc2\xc7p繏\xc2{*\x1aI\x96A\xca\b\xce:4u\x85\xed\xfa\x02Z\xa9\xa0\x8f\xa7\x89\x9d1\x86r\xb7\rv\xc4\xc0\xe2\xe8\x18\x8b\xe51d\x14\xc1\xe8\x06u]A\xab\xe6\xa08\xb0a\x03\x80\xd6S\x9fv\xd8ZQ\x11uA\\n\x8dE\xd3P\xcc\xefӖ\xd3I\x9aa:_!\x8e\x93k{\xfe\xbe 9kIM\xa1k\b\xde>n\\s\xc2\x1b4\v\xa1\x00XG&7\"\x8a\xba5\n\x05\x1c\xb5p\u007f\x8a4\x9fPȏj`\xac\x01g\x1cq\x92 IsR 䓎\xbc\xd8\xef9\xd0=7\xa5j\xe8\xba\xea̙\x86\x1c\x85r\xbf\xc1\xe5\xf9#\\\x9c?\xc2fs\x81\xa2*\xa0\x8d\x81k\x8d\u007f\xde\xf7\xe6\x1e\x9e7|G@|\nV\x15\x1e\x13\xeb\x1c\t\x9d\xf7\xb0\x81\xbd\xef\x0e\x8c\x81h\rB\xdfu@\x01\x1c\xca\x1cq\xe86<X\x14\\{\\\xd7\x1a\x94n-\xe5\xc8\x05q`l\x14q\x814\xa4I*\xadQ\xd4%\\ۼ}\xe8\xfb3#\xd6\u007f \xb1\xba\x90~\x99D1&\xf9\x04\xab\xd9\x12\xcb\xc9\x12i\x1cÃ\xf4\xfdJ\xd3zJ\x06\xb9\xe0f\xbf\xc1;\x8f\xdfE\xa3\x9bN9\xc0\xc25#\xff\x00\xca&`\x9eAi\rc͟\x05\xf0\xabc\x0306\x00\xe3\xf9Cp\xe2$E\x9af\xcc\x1a\x1b\xbf\xf2\xea\xa7_\xfd\x93\u007f\xfa\xcf\xfe\xaf\x928\xfa)\xc1\xe5DJ\x81\xba\xdc\xe3\xf2\xf21\xa4\xe4\xc8Ӽ\x83C\xc9\xd1\xcd@\x1b\x8d\xcd\xe5c<|\xef-T\xc5\x06\xceZd\xf9\x12\x8b\xe51\xb8\x10O\xd1u;\x82ާs\xb00\xc10\xc6\x10\xa7\t\xa2`\x93J\xcex\xe2`\xb2v\xd6\x04\x8d\xb3\xc7\xeb_\xff\r\xdc\u007f\xfd_!\x8aS,\x8en\xe1\x99\x17?\x86\xd5\xe9]b҃\x8aX\x14\xc5\xf0\xceC55\xb6\xebK\x18\xa3\x91f9\xe2$!\xef\xf5\xba\xc4\xfa\xf2\x1c\xe5~\x8f\xd9|\x81\xc5\x11\x11\x06\xe1<\xb4\xa6i\xdbt\xa9n\xecZ\x03\x10&\xad@P\xd3J\xc3Y\r\xebz\xbf}c\x14M\xc6\xc6ܬp\x8c$~\xd3ق\xa0\xf7\x1e\a>8Z\xd5(\xf6\x1b8g\x90\xc4\x19D0\x02b\x83\xa0\xa0\xa1Ͻ\xed\x18\xeb$%d\\ \x8a\xd3P\xfc\xa9\xa9\x8a\xa2\bi6!\xef\x02c\xa1\xb5\xa25\x80\x88\x90d\x19\xb2l\x8a4\x9f M\xb3\xc04'O\x87\x8e\xaf`\x1dLk5lT\xe7\x90\xe8\x1c5Z\x9b\xcb'x\xf4\xf8>\xce\xcf\x1f\xa1(v\xd0\xd6\xc0\r\x82\u007f\x9e\xb6\xa6\x18F\xfb\xfa\xa7\xac3:\x1f\x80\xf6\x97k\xad{\x87\xed\x0f\xeb͖B\x91\xeb\xa7w\x1cH\"\xdb]\xfb\xf5N\xa4_Y\xb4\x0f\x95\xf7\xdf\xc3\xf7AM\xadt\xb0\xf5\x10p\xc1\xc0\xc88\vmzu\x02\v\x05<\x12\x11\xa96tCv\xcfB\xfc\xae\x92'8\x1fDC\x0f\xae\x03\x03y\x05L\xd2\fY\x9c!\x96\x11\xe28\xc1т\x9aJ\xc0\xc3h\x83\xab\xdd\x15\xde~\xfc.\xaa\xa6\x82\fH\x80\x0fJ\x92XF\xb0\u0381Q\xbf\x88\xa2.7\xd6\xda\xcf\x03xcl\x00\xbe\x87\x87\xb6\xb1t\x8d\xe7\xf7\xd4A2֒\xba\x981::=\xbb\xfb\xd1?\xf6c\xff\xd6\xdfI\xd3\xe4Oq\x86D\b\x8e\xdd\xe6\x02\xeb\xabs8o\x11GӠ+#\xa6w\xebI\xbf\xbez\x82\xc7\x0f\xdeF]n\x01\xef1\x9b\x1fa:?\xea\xf6\xbe=\xfb\x8b\x8a\x84\xe0\x1c\xd9t\x0e.$\xe9\xe6\x85@\x9a\xe5\x10Qԇ\xa9\x04;Z\xe7,\x8cQ]\xc8\fc\f\xef\xbc\xf6[x\xf7\x8d\xaf!\x9f-qtr\x17g\xf7^\xc4\xe2\xe8V\xf8{\x0eY>C\x1c'$G4\nU\xb9\a\xe3<X\r'Pu\rUW(\xf6{DQ\x8c;\xcf<\x87$\xa1\xc6\xc3j\r\xeb\fI͌\x1e\x10\xceC\xc1u\xae\xbf\xd9\ao~kC\xc8\nc\x00h\x95\xa1\x02,\xee\xdd\xcd\x14>!$&\xf3\xc5`\xdf\xcfn̝\x0e\x0eu\xb9\xc7~\xb7\x06g\fY>\xed\x8aF\u007f\xef\x1b\xf8.\x80$\x8e֘Ύ\xd7ZK\xb2I.\xc0\xbdE$#*\xecY\x06\xc6E\x90\x1f\x92tQ\xc6\xe4d\xd8\xfa\x0eDQ\xd2E0\xb7\x86A\xcc\a{⦁6\r\xf15B8QY\xecp\xfe\xe4\x01\x1e=\xbc\x8f\xf5\xfa\x02U]\xd14~\r\xa6?\xb0\xe8\x85\xff\xc0\x9b\xb9\xf7 c\x9f\xe0\xfa\xe7\xfc\xc0\x9f?\x8c\xd9C9\x1fg\xc1&\x99Ӫ@\x84}\xb8\xe8\x8a'\uf2bb\x0f\x9e\x02ƒG@\x9b]Я\x1b\xe8u\xe0\x9c#\x12\x12\x91\x88\x10K\x19ȝ}\x12\xb1\x0f\xde\x01]\xba\xa2\xa7\xf7E\xd9(Ժ\t*\x02\a@!\x12\x11\xd2(A\xa5jX\xeb\xc0\x03\x17\xe5\xc3\x1c\xe7\x1c\x1c\xc8\x05\xb3\xb5\x00\x16\xe1\xda\x1a\xab\xb1\xde+\xacA2\xd5Y>C\x1a¦\x92(\x81K\x80$!$⭇\xef`_\x15\xf4\xbe\v·\xc6Y$Q\fX\xba&y\x9c.\x8a\xba\xfa\x87λ\x1f\x06\xa0\xc6;\xe5\x88\x00\x8c\xe7\x0f\\\xf1\xe7H\xd3\x1cq\x123\xe7\\\x94\xa6\xf9+?\xf3s\u007f\xf1\u007f\xf3\xdc\v\xaf\xfcIx\x97rΰ^\x9fcsu\x1eV\x04\x12iJ\x13\x06\x11\xc5\x1c\x8cVج\xcfq\xf1\xf8>\xca\xfd\x06\f@>\x9d!\xcb\x17`L\xe0z\xb8\x8e\xb3\x16\x8cs\xcc\x16KpAR&\xc6\x04\xb2\xc9$H\x9bz\x8d5c\x80\xb5\xfa@\xb2\xc78\xc7\xe6\xe2!\xbe\xf9/\xff{$I\x8eխ{8:\xbd\x87\xc9|Iz\xf2\xc0\xe6OS\xb2\xe4\xd5J\xa3*v\xf0\xa0xa!\x04\x9a\xaaD\xb1\xdf\xc2Y\v\x99Đ\x82\xf8\x014-\xd3t\xa5UC)zA\xc7\xed\xdb\x024\xe0\x1e\xb4P\u007fx`h\xe5d\xceY\"\v6\xf5S\xf7\xfdq\x9cb:_u\x06B\xc3\xeb\xc3\x067\xfb\xb2\xdac\xbf\xbd\x02\xe7\x02\x93\xc9\x1cR\xcaN^Hr\xb5P\xf8]H\xe6k\xea@Τ\x84\xbe\xde\xe7\x87!\xcd&\x98Η\xc8'3H\x19\x93M\xae\rV͂\xb4\xfdi\x9aa2\x9d#\x9bLI\xce\xe7\x19\xc5\x04\x0f\x99\xe2\x9a\x14\x1eF+\x18\xab`\x145Q\x97\x17\x8f\xf1\xe0\xc1;\xb8\\\x9fCiE\x13r\xbb\xa3\xf0\x87\xd3\xf5\xeffrS\xda\xc0\xb4\xc4\xc06\xa2\x18\xad\x99OogL\xd31z^\xc0uӣ\xeekH\x9da\xac\x852\x86t\xfe\xceu\x8fk\x18L\x04\u007fx/e\x9cAr\x89XJH)\x11\rMy\x9eRĭs(\xaa\n\xbb\xaa\xe8\fx\x04'\xb3\x1fc-\x8a\xa6\xeat\xfd\xddd\xff\xbbA\x04B\xa33L\x82h\x95\x04\xde{H.0\x9b\xccp\xfb\xe4\x16n\x1f\xdfB\x1c%0\xd6`\xbb\xdb⭇o\xe3r{90\x8a\xa2k\x13\xcb\b\xce\xd05QZ\xa1l\xea_\xf1\xde\xff/F\x04`l\x00\xc6\xf3\a\xe8\x04\xb2\x1f\xa4\x8c\x98\a$\xbc{\xe9\xc7\u007f\xe2\xe7\xff\xb7_\xf8\xe2\x0f\xffi\xc0&\xce9\\^<\xc4n{\x05\xce\x18\x84\x88 \x04\xed #\x19\x85\x9b\x89\xc6\xe6\xea\x1cW\xe7\xef\xa1.\xb6T\xa8fs\x8a\x8ee1\xd8\xc0\xa9\xaf%\x18\t\xc11\x9d\x1f\x91\x81Nx\xeb\xa6Y\x8e$\xcd\xe0C\x0e:\x0f\xbc\x02ku\xd0\xd8\xf77\xf0\xb2\xdc\xe1\xea\xd1}T\xc5\x16\x8b\xa33\x1c\x9d=\x03\x19'\x9d\xc3\\\x92N\x90\xa69\x18gPM\x83\xba, d\x844\xcd\x00\x0e\x94\xbb-TS\x93\x8e=\x8e\xe0\x9d\x83jjXkIv\xc7\brWu\xddOu\x81sІ\xd4\x10QN\x85\x9c\x03>(\xfe>H\rI\xe7\u007f}\x94e\\ \x9b\xe4\x98L\x97!\xec\xe7)\x93\x1f#\x9eCY\xecP\x16;x\xef1\x9d. \xa3x\xb0!\xe8y\x02\xde;\xa8\xbaF\xb9_\xa3\xae\xf6pNS\x91\xe3Qǟ\xc8&S\xcc\x16GH\xb3\t\x18\x17\aA;B\x92c`\x1cR\r\xf3\xc9\f\x91\x10\xc1\xa5\xcf\xf5\xb42G\xec}\xd5TPu\x89\xa6*QU{l7\x97x\xf8\xe8=\x9c_\x9d\xa3i*t\xa07\xf3\x83\x88\xddé\xff\x03ng\a\r\xa3\xf7\x1e\xcaP\x06\x00X\xeb\xcd\xdf\x17>\xfe\x94B\xef\x0f2\x80C\xc1\x17\xac{\xcfz\xc6a\x8cA\xadz\xd7\xc0v\xcaw\x01\xb5i\x13\xf7z\xb2 q\x11\xae\xbbN\xf1𘤐\x88$\x19\xf3\xb4\x99\x01-\xf2\x000T\xaaƮ\xdcC\x19j\xca\"!0M'ht\x83]Yv\xab\x82\u07b4\xe7wQ\x00\xc2c\xe9\xd2\x01\xaf\xa5\x17\xbb \xa5\\͖x\xe1\xee\v8]\x1d\xd3種\xf0\xd6÷\xf0\xe0\xfcQX\x8d\xf0\x90\xcb\xc1\x10\x8b\x18Zkx\xe7Q\xeb\xc6VM\xfd=i\x15<6\x00c\x030\x9e\u007f\x9d\xe2/#di\x86\xddn\x83\xe5\xf2Hjc\x9e\xff\xe2\x97~\xf8?\xff\x13?\xf1\xf3\u007fFp\x1eժ\xc4\xc5\xf9C\x94\xe5.\x04\xa0\xf0\xce\xe8\x87\xccx\xc8\x19n\xbf\xbd\xc2\xee\xea\x11\xaabG\xb6\xbd\xb39\x10Hz\\\xa6`,\n\u0380\xe4S/\xa4\xc4|y\x04\xce\xfb\xcd\x15\xe7\x1c\x93ټ\xf3\x91\xe7a\x02\xa7\xe2o\x0entMSv\xe9z\\H\x9c\xdc~\x0e<\xd8\xe9\x02\xc4cH\xd2\t8g$\xb7kJ\x82o\x93\f\xf0\x1ee\xb1\x81n\x9an\x1fn\f\x19\xf3x\xef\x10\xc5\t\xbcwh\x9a\n\xaa\xaeC\x8a^(\xfe\x81h\xe7\x9c\xe9\xfc\xf9\xdb\xe2\x82֑Б\xae\xbd5\x19\xba~\xa3\x92Q\x84\xc9d\x81|2\xed\xdcۆ\xce\xc4\xed\xb4\xae5I\xe4꺄\xb3\x06i6\xe9\x02\x86\xc2\xd8\xd7~\xf0a\xadAS\xefP\xee֨\xca-\x00\x06!SpA\xc4\xc68N0\x9d-\x90Mf\x10Q|\xe0;/\xc2\xeb(d\x844\xcb1\x9b/\x91e\xc4\xed\xf0\xce\x1e\xc8\xe6\xac1h\xea\x12\xd5~\x8b\xaa\xdcBU\x15\xf6\xfb-\xce/\x1e\xe1\xe1\xf9#\x94u\xd9y\xcf\x1f\x00\xfb~\b\xf2\u007f\xb8\xa2\u007f\x03\xf6\x0e\xdfc(\xc5\x1bN\xbb\xedc\xe4\\\x84i<B\x1c\x939Q\x1a\xa7\xe4W\x90\xd0\xef\x9d\av\xbb\x1dv\xc5\x1ee]\xc2Z\a)\x04\x92$\x82\x10\x11\x9c\xb5Xo.\xa1\x1bթO|h\xeat\x8b\x16\f\xe0~\x02`\x06\x84\xd0\xd0\x14p. \xb9\xa0\xec\x808F\xc4#X\xef\xb0\vRG\x80|\xff\xf34C\xd5\xd4(C\xb3\xc9\xda`\xab\xf7\xb9o\xb3\xe1\n\xe5\xfa\xffc\x037N\xf4\xc8H{}\x8d\xb5\x90B\xe0\xf6\xf1m\xbcx\xefyL\xb3)jU\xe3\x9dG\xef\xe2\xc1\xf9\x03\x18k!\x82\x8f\x84\b6ӭcf\xd5\xd4\x0f\x1b\xad>\x03\xe0\xc9\xd8\x00|o\x9d\x91\x030\x9e\xdfՉ\"\x92v\xedvk*\xfeZ\xdf{\xf1\xe5\x8f\xfd\x9d\x1f\xf9џ\xfe\xb7\xa5\x14\xd1vs\x89\x8b\x8b\x87Ё-\xec\x9cGUn\xc0\xb9@\x92\xa4\x00c\xa8\xaa-\x8a\xed\x05L]\xc24\x15\xd2|\x82|\xba \t\x98\xb3\x94\xdeft\xd0ޣ\v̙/\x8e\xc0\x99\xe8\xef\xf7\x1e=\x11\xca\x19B\f\xda\xd8\xd6Ap\tc\xac\xb3\x93m\xf7\xed\xabӻ4\xb5YCa-qJ\x13n\xaba\xb7\x06B\x12\xf3\xdd{\x87r\xb7AS\x17\x90Q\x02\x0f\x0f\xa3B\xc2\x1eg\x88\xa3\x1c\xd6h\xd4U\x89\xa6)\x89@\u05fa\x1b\x06\xaf}c4\xac\xb6\xa1\x18\x91\x96\x9e\xf6\xcf4uj\u0560*)\x0e\xf8:\xe4\x9f$\x19&\xf3\x05\xd2$\xa7\xafc77\xfe\xde94M\x81\xfdv\v\xad\xea\xe0J(!\x84D+\x83\x03<\xbc6pN\xc3Y\x05Uר\xeb}@4\"\x88(\r\x13/G\x9eϐ\xcf\x16H\xe2\x84\x1a\x8e\x80R\xf0\x10\xe2Å ?\xfb\xd9\x1c\xb3\xd9\x1cQ\x14\xd1\xfe\xbb-\xfe\x9e\x18\xe2MSS\xf6\xc1nM.\x86M\x89\x8b\xcbs<~\xf2\x1e6\xfb-\x11\xc7\x06\x1e\x04\xfe\xdb\x16}\xf6\xe1\xff\x1f\x03D\x87t\xf8\xee\xfb\xf3 ]\x94\x92\x8a}\x12\xa7\xc8\xf3)\xf2<G\x1a\xa7!k!\x86\x1c\xb8\"\x02\x1euݠ\xaek$\t\xc9K\xbdwARj\xa0t\t\xd5(8E|\b\xe6\t=\xe1\x82#\x8b\xd3Pp܀3\xa0\xa1M\xe0\x0e\xb4\x9c\x10\xefɠ\xc79hhԊ\xa1\xa8+H!\x88\x98'\xa3.\x93\xc0X\x83\xb2\xaeh?\x1f;Ԋ\xe4\x93\xce\x03\"\xac\x94\xda@-߃\x0e\xa1\xc8\xf3\x81\xfd\xf1\xcd+\xd8Y=\xfb>\xedQ\b\xfa,\xbf\xf3\xe8>\xaevk\xdc9\xbe\xd55SG\xf3#\\l.\xe1\xbc\xeb\x92\r{\xfbh\x86$\x8ao[\xef\xfe\xcfƘ?5\xdeAG\x04`<ߧ'\x0e.n\xdb\xed\x15\x16\xcbc鬾\xb7Z\x9d\xfe\x9d\u007f\xe7\xcf\xfe\xe5_<:>M\x9f<\xba\x8f\xcd悊\x8f\x100ڠ\xd8oa\x8cF\x1c'\x88\xe3\x98vӛ\v\xe8zOd\xbb\xc9\f\x93\xe9\x02FW\xb0Zw7I\xce9\xa4\xccQ\xd75\xe2$\xc5bu\xd2\x15x\xc6X\xb0=uЦ\xc1|\xb1B\x9aM\x00\xc6\x03\xc4\x1e\x1c\xe4\xc2|c\x8dF\xb1ߠ)\xf7\xd8m.0\x99-q\xf6\xccK!\xa8\x85d\x83i\x98\x92} &\xfa\x01\xa1\xab.wh\x9a\x92n\x9d!M\x0fރ\x89\x98\x92\xfd\x9a\x1aU\xb9GS\x97\xd4<\f`{\x17\x9a\x19J/d`\xc1t\xa7Ց{oC\xd0\r\x85\x00\r\v?c\x1ci>\xc1t\xb6\f\x16\xb9\xfc\xe0\xcf\xdbc\x8d\xc1~\xbfEUl`\x8c\t\xb06i\xf4Ӕ\x88[\x00\x15*\x1fT\x10Ɛծ\xd1\x16\x9e\xf5\xc1HRʰ럇\xc0$\xd9A\xc4\xed\x94\xcc\xc3\xfag6_\"\xcb۩?8\x05\x86\x82k\xb5\xc6~\xb7\xc6\xf6\xea\x1cM\xb5\x87\xd6\n\x9b\xcd\x15\x1e=y\x0f\xeb\xcd\x15\x94\xd1\x03\aC\x1ch\xea\u07ff\xc0\xbf\xff\xa4\u007f\xbdi\xc2\xc0?_J\x89X\x9244Ms\xe4i\x86$I\x91$\t\x928\x85l%\x8e\x81\xe8G\xb1\xcb\x1c\xce\x194\x8a\x02\x83\x94jPU%\xb6\xbb-\xaa\xaa\f\xde\xf9\xc4/ \x92*1\xe0\x1d\x10\xdc\vA\x05\x94\x13\x19UH\t\xc9D\xf0^ \x12\xa0\r\xda\u007f\xe3\xe8\xfbhC\x99\x01\xc6\x1a\x98!\x97\x00\xe8x\n\f\x80um\x16CȈ\xe0\x02\xca莈Ȁn}\xd06=\xedߧ\xa8m\xd1)P\xba\xd5ʠ`\x0f\x16\"\x9d:\xa3}O!<?\xef=\x928A\x96$\x90\"\"\xe9\xa0Q\x01\x05\b\xa8\x82u-\xbf\x14\xc6ZTM\xfd\x9fZg\xff\x8b\x11\x01\x18\x1b\x80\xf1|\xbf\x15\xff$E\x12\xa7\xad#\x9b\xb0\xdeݍ\xa4\xfc\xbb?\xff\v\u007f\xe9\xdf{\xf1\xa5W\xa3w\xef\u007f\v\xe5~G\x10$\xe74\xf9\xed\xb7\x9d\x99H\x1bNcU\x05Un\x00\xef\x90\xe63Lg\xab\xe0JW\a\xd3\x19\x92\xb7\xc1Y\x94\xfb-\xf2\xd9\t\x8e\xcf\xeeQ\xa1s\x14\xcf\xea\x9c\x01\x87CU\xecq\xfe\xe8m<\xf7\xf2'qz\xe7y8k\xb1\xdb^B\xca\bI6\xa1B\xa7j\xec6Wh\xaa=\x8a\xdd\x1a\x9b\x8bGx\xf9\x93_\xc6\xf2\xe4\x0e\x9c5\x90Q҅\xe5X\xa3)\x8d\xce\xd3n\xbcM\xe7\xb3Zw\x85\x8d\xb5NiBBF\t\x9a\xbaAUl\xa9\xf8\xbbé\xca\x05&\xbd\xb3\xb6s\xd0\xf3\xbe\xbf)[\xa3C\xe3P\xdd(`m\xa6\xc1d:\xef\xa6\xf8\xeb\xc5\xdf{@5\x15v\xdb5\xaar\x0fgCR\x1e@\x1a\x00g\xc0\x19\x05\x13EQ\xd4Y\x16\x93\xf2\xc0\xc0\x81\x87u\n\xeb\x1a\xbc\xd9\xe2\bi>%\b\xbb\xdb\xeb\x8a`\xd7+ \xa3\x18\xd3\xc9\f\x93\xe9\x1cQ\x1c\x85]w[\xfci\xfa\xab\xcb=v\xebs\x14\xdb5\xb4V\xd8\x17[<z\xfc\x00\x97\xebs4\xaa\xe9\xd2\xf3\xde\xffF\xcc\xfe\xb5o\xe4\xac\xcd!\x90\x11\xb2,\xc7t2\xc3t:C\x96M\x10\x87\x10\".D\x0f!\xb5\xe4\xd0P\xe0<\xa3\u05ed\xa9+\x14e\x81\xcd\xf6\n\xfb\xfd\x9e\x88\x91\xdeS\x816\x06p\xe8\r\x97\x02\xb1\xb3u\a@p\xd1#_~\t\x11\x05\xb4AF]\nb\x14\xa4\x91.(-\x9c\xa5&Q\x1b\v\xa5)/\xa0Q\n\x95j\xa0\x94\x82v\xc1$((\v\xac\xb3p\xc1\x9dQ\xf0\xa0\xf1\x1f\xa4\x10^'2\xb6\x1c\x140\x86x`\x89\xed\xd1\xcb!\u07ff\x9d\xba\xfe\xe7\xbdw\x85\x14\x12i\x9c\x90ǆ\xb3\xbdQT\xdb(X\u05ed\x00\x953\xaa\xaa\xeb\xafx\xef\u007fsl\x00\xc6\x15\xc0x\xbeON\x92d\x1d|\xcfh}|\x06\xef\xfe\xf6\x0f\xff\xe8O\xff\xe2\xf3/}$z\xe7\xedo\xa2*\x8b\x8e\xc9\\\x96{\x94\xe5\xbe\xfb\x809\xeb \xe3\x18p\x1a\xf5\xfe\n\xde{LfKL&3*\xfe\xba\xea\xb4\xd8\xe4\r`\xb0]\x9f#˧X\xae\x960\xba\x84RA*\xe7\x1d5\x18\xe5\x0e\xe7\x8f\xeec2[b\xbf\xbd\xc2\xfa\xe2\x11\xf6\xdbK췗\x98͏q|\xfb\x19\nб\x1aZ+\x14\xbb5.\x1e\xdd\agd^Ӛ\b\xb5\xee\x82Z\xd5\xf0!\x86\xd6{\x87\xa6.\xb1\xb9|\f\xa3\x1b\xa4\xd94\xec\xeb=\xb8\x88\x82]o\x84\xba\xaaP\x15{4uq\xe3f\xe2\x82*\x80R\xd4\xda\xe4;\x04_8\"\n\x96\xc5\xfe\xa9\xfb\xfe(\x8e1\x9f\xaf\x90\xe6\xd3kS\u007f\u007f\x03v֢,w\xd8m.Q\xd7\x05\x9cm\x82ǁ\xa5i4L|\x06 3\"&:8\x981\x01)S0FDJ\x11X\xfe\x93\xf9\nI\x96ӄ\xc8\xc3N\x9a\x13c]HI\xab\x88錴\xfd\xed\xba%\xa8\n<@\xb2\xc8\xdd\x1a\xc5\xe6\x12MS\xa1\xae+<z\xf2\x00O\x9e<@Y\x15$\xc1\xc3S\bq\xff\x1a\x85\xbf%\u05f5\xeevRJ$YJ\x05?\x9f!ϧH\xd3\x14q\x14\xdc\a=\xb9\xd5YCiv.$\x13\x12\xe1\x94\x11Y\xc09(c\xa04\xf9\x13\x94e\x81\xb2\xae\xc0\x84\xc4$\x9f\x85\x00'\xca*\x00\x00\x19L\x91Z\xf2\x1d\x85N\xc9^.ȉ\xe7!8\x1f\x90\xe48I\r=\xbaH\"\n\u007fr\xf0\xce\xc2\x19\x03\xadIAb\x8d\xa1\xc0#\u0560jj\x14u\x89\xb2\xa9`\x9d\x85\xe0\x02\x80\xeb̃\x8c\r\xfb\xff\xe1\xe06l\x8c8\v\xd385\x9f\x91\x10\xd4\xec8\x87\xf0R\x069\xe2\xcdf\x80\x05\xa9\x9f\x0f\xae\x88\x9d*\x82\xb1.=P\n\t\x1e\xfe;\"\xb8\x02\x9c\th\xeb\xc0\x03\x1a\x17q\x19\xbb(\xfe\x87\xb5jF\xab\xe0\xb1\x01\x18\xcf\xf7\a\xec\x9fR\xf1\a\xb0Y_`\xb9<^j\xab\xfe\xc6\x17\xbf\xf8\xc3\u007f\xfe3\x9f\xfdrv\xff\xado\xa2\xae*\xda\xf7{\x8b\xb2أ\xae\x8an\xf7M\x89z\x13\bxl\xd6O\xe0\x9d\xc5d\xb6B\x92N\xa0\xea\nZW\x81\rO7G\xa3\x15\xd6W\x8f\x90\xa69NN\xefAk\x85\xaa\xae\b\x1e\x0f\xd3h\xb9\xbd\xc2\xc5\xe3w\xb1X\x9d`:[b}\xf5\x04O\x1e\xbe\ro-E\x96\xaa\xf7\xa0U\x85\xf9\xd1\x19\xa4\x88\xb0ݜcs\xf1\x18\xd6\x1a\b!\xb1\xdf]b\xbaX!\x8ei\xe7mt\x03\xadT\xb0\xfb\xb5\xd0M\x81\xed\xd59\x8a\xed\x15!\x0f\xd6@pҿs\xc6i\xb7]\xef\xd145TS\xde,\xfe\x81\xf8\xc7E40\xa6\xf1\x1d|ZUDFt\xc1>\x15\x03\x19V\x9a\xe5\x98/\x8e\x0eR\xfc\x86\x83\xbf\x87\x83\xaa\vl\xd7O\xb0߯\xa15E\bc\x10i\xcb\x03\xfcߖTr\u007f\xb3\xe0L@D\x19\x84\x8c\x03ӟ#I3d\xf9\x1cq\x9a\x85\x94\xbe\x96L)ib\x8ei͑\xa4\x19\xd2,\xa3u\x02kanj3\xac1(\xf7[\xec\xb7W\xa8\x8b=\x94\xaaq\xb5\xbd\xc2Ço\xe3j}E\xc9w\xdd\xe3hek\xecC\x14\xfe\x9bV\xc6>\xf0*\xa4\x8c1\xcd'X-\x8f\xb1\\\xac\x90&\x19\xb8\x10\x90\x92\xb2\r\xda\xe2f\xacA]\xec\xc86X5\x10\\\x12\xfc\x1f'\x88\x92\x88<\x0e\xc0\xe18\x15`\x06\x0ff\x1d\xa2$\xc52\x9b\xe0DF\x88dL\xc4UƠ\x8d\x86RԴQ\xa1\xa7\u007f\x83\xb3\x03\xcf?6\fx\xf2\x03(=@\ueb7dp\xfb4Y\x98\x9c\xb9\x90\xe0RBD\x12\xd6X$\xde!3\x16\v\xeb\xb0/wx\xfbѻa§u\x85ed\x1c\xd4\x1a\x1a1\xc6n\xe4\x05\xb4\xef\xc1V\xfe\xe8B\xa1\x9f&9\x94\xd5D,d\f\x9c\tp\xf4fI\x9d\xef\x86\xed\xfd\x03\x04\x13\xb0\xde\x1e\xa6\x1fzr\x0f\x8c\xc25RZQ\xe3ũ)R\x8aV\x03\f\fq\x14\xbdj\x9c\xfd\aƘ\xbf8\xde]\xc7\x06`<\xdfӰ\u007f\x824\x14\"\xc6\x18\x96\xcb\xe3\xd4\x18\xf5\x1f\xbf\xf2\xf2\xc7\xff\xeaW~\xe0Ǧ\x8f\x1e\xbe\x8d\xba*;&}Q젛:\x84\xc7\xf4\x05\x8d3\x86\xf5\xe3w\xe1\x9c\xc5d\xba@\x9c\xa4Ъ\x84\xaa+\x00.\xf8\x94\v4M\x8d\xab\xf3\xf7\x00\xce0\x99\x1f\xa3V\nM\xd0\xc2ӍV`\xb7>\xc7\xe5\xf9\x03,\x8foc:_b\xb3\xb9\xc0\xf9\xa3\xfb\xdd\xf4N\xd3\x0e\xc1ժ.q\xb5\xdbt\xfe\x02B\b\x18\xa3q\xfe\xe0m\xdc}\xeeUp!h\x0f\xae\xe8\x06\xa8U\r\xa3\x14\x8a\xfd\x15\x8a\xed:\xdc:9tSC\xe6T\x04\x8cѰ\xd6\xc3\x1aE\x89u]\x11\xef\xa1V\xe6i\xe7ۺ\xbc\xb5\xc7\x1a\x83\xa2ء\xa9\xcb\xf0\x9c8\x99\xe0X\x05\x06\x8f\xe9\xfc\b\xf3\xe5\t\xa4\x88o\xd0\xe0\x9c\xb3p\xba\xc6~w\x81\xf5\xd5#\xd4\xdd\xf78,\x94\x1c\xad\xbf\u007f?\xed\x01\x80\x94)d\x9cA\x8a\x18Q$\x11\xa79&\x939\xa2\x80촏\x871\x8e(h\xfa\xd34\xa3& !\xfe\x06\x17\x82\x82f\x9c\x87\xf7\x84B4M\x85r\xb7A\xb5ߢiJ\x14U\x89G\x8f\xdf\xc3\xe3'\uf86e\xab`xD\xe4\xb6\u038b\xe1F@ѵ\xa2\u007f-\x97\xa8\xe5c\b)ɺvu\x82\xe3\xe3S\xac\x96G\xc8\xd3\t\x04\xa7\u05f5\xac)-q_\xedPV%ʲ@\xddT\xf0\x9eP\xac\xc5b\x85\xd9t\x8e$\xa1\x14B\x80v\xd3F+(\xa5\xc9bWHLg9\xa2(\xea\xd4\x16\xdeR\x91mM\x9d\xa4\xa4\xb5\tg\xec \xfbgHN\x85oE\xa9\xe1\xcfڿ\xe7\xd9\xd3z\x9b\xc1Z'\xf8X0\x01&\bY\x11\x82\x9e\xff\xb6$GD\xef{7B)%\x98\xe30Vw\x9f\xb9\xden\x99\xe1)\t\x0f]#\xaa\x8cF\x12%Ȣ\x04\xfb\xa6\x1c\xb8\x0f\xfa\xee\xbd4\xc9r\xcc&3\x14U\x89m\xb1\x83\xb1\x86\x9a\x00\xd0{{\xb0d\x80\xb6\x1a\x91\x90\xf0\x00\x1a\xdd \x8d\x13p\xc6!\xa5\x805\x0e\"\xacY\x12\x19\xff\x05g\xed?q\xde\xff7\xe3]vl\x00\xc6\xf3\xbd\xf8\xc6\x10\x11\xe2(\xc5\xc0\xb4\x9e[c\xff\xdc\xc9靿\xf2\x03?\xf4\x13\xf3\xfd撢d\xc3Ծ\xdf\xef\x82\xdf}\v\xcdrdi\x06\xef=.\x9f\xbc\r\xa35\xf2\xc9\f\"\x8a\xa0\x9a\x1a\xba)Aֱ\x02\x9eqTe\x81\xf5\xf9{к\xc1t~\x8cF5P\xd6\xd0m(\xa0\x03\xbb\xcd\x05.\x1f\xbf\x8b\xd5\xc9\x1d\xcc\x16+\xec6\x978\u007fx\x9f\x92\xe3\x18\vN\xb3\x1e0\x16\xbb\xed\x15\xb0\xbd\f,\u007f\x82y\x9d\xa3\xc9\xee\xf6\xb3\xaf@H\tUW\xd8\xef\xd6\xc1 \x87t\xeaM\xb9GU\x15 K\\\x83\xba.1\x99.\x91\x86\x14B\xeb\xc8*\xb7\xae+\x18\xad\xfb̝P\xd0Z\xc6<\xb1\xe1]G\xacR\xaaA\x11<\x04\x00\a\xef\f\x8c\xa9aL\x83$ɰ<\xbe\x8b\xc9\xec\x18\"\xe8\xec\xbb\x1b\xb8\xb3p\xa6A]\xef\xb1\xdb<\xc6v{\x15\x9e\xef\xa1\xf1\v\x03\x0e\xc2mz\x88\\BF)\xa2(G\x9ae\x98L\xa6\x98L戳\t\xa9 \x06\xa17\x8cS\x00M\x9ae\x88\xe3\x182\x8a\x91\xc4\x19\x15\x19\x16\x9c\xf0=\x15t\xa5\x1aT\xc5\x1eU\xb1CSS\xac\xf0\xd5\xfa\x02\x0f\x1e\xbe\x8d\xf5\xe6*\xb8\xef!\x18\x01\r%fh\xfd\x8en\xce\xfd\x83\xe7\xd3=~0di\x8e\xd5\xea\x18g\xa7\xb7p\xb4:\xc1$\xcb!B\x1a\x9d1\x06\xbb\xfd\x16\x9b\xcd\x15֛K\xec\x8b\x1d\xaa\xaa\x84w\x1e\x93\xe9\f\xab\xe5\t\x96\xab#Ls2'\xf2\x8c\x9c!\x95Rh\x1a\x05m48\x17Ȳ\x1cIB(\b<M\xc9\x14l\xa3\xa1\xb5&Ϳ\xa7\xd0!>X\xcb\f_\x01v\x10\xc0\xc8:\v\xea\x16\xeboU\x03\xd6\x12\x8f\x85Q\xa0B\x9f\xae\x18\x10\x1e\xef\f\xe0\x83b\xa4\xed\x1c8G\x96\xe7H\xe2\x04uS\x1f\xc8,\x05c\xe0Q\x04\xadM@\x01\xfa?{zl\xb0\x87\x0e\xfe\x18\x823\x1c/V\x98d\x19.\xb6\x1b\xec\xea\xa2{N\xd6[\x14U\x89,\xcd\xf1ʳ/\x831\x86\x87\xe7\x0fq\xb5]C;\x8a\x116\xc6\x1c8\x11jkB\xb8\x90G\xad\xa8\t\x88\x84\x84w\xba{=\x85\xe0\x90\"\xfa\aʨ\u007f\x86\xefa\xab\xe0?\fg$\x01\x8e\xe7\xc6i%{\xc4<\uf63f?\x92&\xd9\u007f\xfdc?\xfe\xd3/\x1f\x1d\x9d\xa0\xaa\v*lM\x8d\xa2\xd8v\xcc\xf9VS\x9df\x19\x9c1X_<\x80\xb5\x1aq\x94\"\x8a\xc8\f\xc8\x04-3\x17\x04)\xd6U\x81\xcd\xc5#h\xd5@D\x11\xd2|\x8a8\x9b\"\xc9rH\x11\x811\x8er\xbf\xc6\xc5\xe3\xfbX\x1d\xdf\xc6\xea\xf8\x16v\xdb5\x1e?x3\xec\xd0Y\xc7f\xa6\x1bo_\f\xbbB\xe9\x1d8\x17x\xe5\x93\u007f\x04Ͼ\xfcIxﰽz\x82\xb2آ.\xf7dg\xcb\x10bx\x1b\xd4\x15\xb1\xfa\x8fϞ\xc1\xd9\xdd\x17\xc0\xb8\xec\xe4}UY@\xa9\xba\xcdz\r\xda\xeb\xde<\xc7;\xf2\xd0\a\xf3p\x1e\xa8\v\xdaի\xa6\x84\xb7\xaa\x93\xe1q\xce1[\xde\xc6\xf2\xe4\x1e\xe28\xbf1F\x1a\xa3\xa0\xd5\x1eU\xb9\xc1vs\x89\xaaܣմ\x0f\x9fsg\xedڡ\x05Tq\x85\x88\xa9\xf0\xe7S\xccf\vL\xe7ːlد&\xda\xf8X\xce9\x92$\xa5I\x9fsDQ\x84$\xcd E\xd4q\a\\\xf0*(\xcb\x02\xe5\xa0\xf0\x17\xc5\x0e\x8f\x9f<\xc0\xa3G\uf8ac\x8b\u0ccfC\xe7~v\xb8\xcb`\aQ\xc2\xfd\xffo\xedt\xb9\x10\x98O\x178;\xbd\x83[gw\xb1Z\xac\x90$I >\xd6\xd8\x15[l\xb7kl6\xf4\xab(\xf7p\xde\"IR,\xe6+\x9c\x9c\xdc\xc2jy\x8c8L\xa1\x1e\x94\xa8\xa8\xb4\x86\n\xbc\x8b$J\x03ґ@\b\x19\x1e\xb7\x85\xd6\x1a\x8d\xaaQ7Mx_\xbb\xee\xb5n+|˪\xefgkF\xd9\t\xae\x95\xfb\x91\xeaB+\x05\xab\x15\x98!\xf3'\xab\x14\x8ci\xd02\xf8\xb8\x88 \xa2\b\x91\x8c \xa28\xa81z\xf9]\xdfarX\xef\xb1/\xf7\xb8\xda\\aW\xecP\a\x03\xaa\x83\xc2\ue0a5t\x88p\x06\xebW\x15O\xdb)I!\xb0\x98̰\xccg\x00\x18\x1e\xae/P\xa9\xeaFj\xe1b\xba\xc0K\xf7^\xc4\xd1b\x85\xab\xcd%\xaa\xa6\x86\xf5\x0e\x0f\xce\x1f\xe2bsu-Y\x11\x1dGD\b\x81,\x90\x04\xb5\xd6`\xc1zY\x1b\x8d\xaa\xa9\xff\xbf\x00\xfe\x8dY\x05\x8f$\xc0\xb1\x01\x18ύל#\x8ac\xc4qBD#\x06x\xe7\xef\b!\xfe\x1f_\xfe\xca\x1f\xfb\xe4\v/\xbc\xc2T\xd3\x00\f\xa8\xaa\x12E\xb1\xed\f?Z\xb7\xba8I\xe0\x8c\xc1n\xfd\x18\xc6(D2\x0e\x12\xa6\x00\x1b\x86\xfd3\xe7\x02u\xb9\xc3\xf6\xf2Q\x88B%\xf8\x9e4\xf9S\xc4)5\x00U\xb9\xc5\xe6\xea1VG\xb7ptv\x17U\xb1ãw\xdf@\xd3T\xf0\x83\xa8W\x82N[\xffu\x1c\x04\x9f\b!\xf0\xf2ǿ\x88\x17_\xfd,\xbc\x03\xce\x1f\xbd\x85\xcb\xc7\xefb\xb7\xb9DS\x15\x94\x13\x10\x82pZb\xde\xdd\xe7?\x82;\xcf}\x14\x8c\x89.B\xb8\xaeK4e\xd9\xc1\xba$\x1b\x93\x10\xa2\xb57\xb6\x81\x03\xe0au\x83\xdd\xe6\tv\x9bs\xba\xe9\xfb\xb0\x12\xf0\x1eI>\xc7\xd1ɳ\x98\xceO\x0eb\x8ciGk\xa0\xea\x1d\xaa\x92\x8csHJ\xa9\xd0;\xdc\xf5\x05\x95\xa3\x8d\x12\x1eD\x0e0\x01\x19\xa5\x88\xe3\x1c\xf3\xc5\n\xcb\xe51\xd2\xe9<\xc8\x01\x83\xf7}\xd8Y\v!\x10ɨ\vM\xe2ΰ$\t\xea\x03\xfay\xce94\xaaA\xb1\xdf\x06\xe2c\x85\xba.p\xb5>ǃ\x87\xef\xe0r}\tmT\b\x87\xf1O\x1b\xea\xbb=\xb8\x8cb\xc42\t\xeeyD\xaa3V\x831\x9a\xf6\x97\xcb#ܹu\x17g\xa7w1\x9bN!\x82\xd5rY\x16X_]\xe0\xc9\xf9#\\\xae)\x0e\xd8\x1a\xe2}L\xa63\xacV\xc7X-\x8e1\x9b\xceH\xb1\xc2\x05\xac\xb3h\xb4\"{a\x87N\x1d\x90\xe7\x19\xed\xa9\x19\xa78bcШ\x1aU\u0560\xd15t\xc8Dh5\xf1]pP0\xf2\x19J<)Ӣ\x81\x0e>\x16\xae\xf3\a\xb0Ъ\x01\xd3\x1a\x11<|\xeb\x0f\x81\xe0\xc7\xc4Y\xa7\x0e\xe1\x8c\xd3k!\"pI\xdc\x04\x11~\xcf8\x87\x0f\xf0\x82\x0f:{\xa5\x15\x8a\x92`\xf9\xa2*д$\xd9\xd0\xe8\xd8\xf0\x1el\xe1\x17\xc1(\x0e\xf8i\xf7{\x0f\x8fD\xc6XL\xa6\x90\\b_\x97\xa8\x82\x8d\xf5\xf0dI\x8ago=\x83;'\xb7QU%\xa4\x94\xc8\xd3\x1c\xdfz\xf7M\xbc\xf6\xce\xeb\x83\xe6\xf4\xb0~D2B\x1a'\x14\x1bllg\x99\\5\x15\xacs\u007f\x0f\xc0\u007f66\x00c\x030\x9e\xef\x81\xe2/\xa5$\x99\x92\x8cۥ\xa5\x00\xd8?\xfeԧ\xbf\xf0\xc7?\xfe\x89\xcf1\x1d,l\xeb\xbaDY\xec\b\xce\xf4\xfd\xf4\xdd2\xef\x8b\xdd\x15\xacV\x10R\x80wЦ%\xf9\x9c\x94``\xa8\x8a-v\x9b\xf3\xceN\x95\x88G\x9e&ט\n\x90nj4M\x89\xa3\xd3;89\xbb\x87\xa6.\xf1\xf0\xdd\xd7QUe\x17\xecҾW[c\x12\xe6{\xdd4\x82K\xdf+\x1f\xff\"^\xfc\xe8g\x00\xc6\xf0\xe4\xdd7\xf1\xee\x9b_\xc7\xfa\xf2Q\x87 \f\xee\n`\x9c\xe3γ\xaf\xe0\xces\x1f\x05炼\xde\x03\xecOSx\xaf1\xe7B\x10\xa9\x0e\f\xcej\x18]\x87)\xb2\xc1\xf6\xea1\x8a\xddehz\xe8\xe6\xcbE\x84\xf9\xe2\f\x8b\xe3{H\x92\xbc\x97\xed\x05\x0f\x03\xd5\xecQ\x15\x1b*\xfe\x15\x11ꜥ\xafw\x03;\xdc6\x8e\x15\x18\x12\xeah\xa2\x8cc\xb2\v>:9\xc3|\xbe\x82\fSp\u007f\x9d\x04\xb8`\xe42\x17P\x1e\xeb\x1c1٣\x1824\x04\xed\bh\x8cEUS\xfeAU\x15Pu\x81\xa2\xd8\xe3\xe1\xe3w\xf1\xf0\xe1}\x14U\x11v\xfc\x83\xc5\xfd\xc1ޛ^\x90HF\xc8\xd2\t\xb24\xa7Ց5К\f\x95\x92$\xc5\xd9\xd9\x1d\xdc>\xbb\x8b\xe5b\x85<ˉ\xa0i\x146\xdb5.Ο\xe0\xfc\xe2\x116\xdb5\x1a\xd5\xc0;\x87$M\xb0\x98\x1f\xe3\xe8\xf8\x04\x8b\xd9\x02i\x92uM\vM\xfb\x86\xa6}Ƒ\xe7\x19&\xf9\x14Y\x9a\x91\xbfA\xf0\x92\xb0֢j\x1a\x14\xe5\x1eM\xdd@[C\xbfT\x03\xa55\xa5U:ׅ#\xd1cn\xc8\xeeY7PM\x03x\x13\x1a1\"!\x8aVf\xe7\t1\x11\xce \tҽ\xd6Ǣ\xf5i\x10A^\xc9ۆ\xac\xd3\xfb\xb7r\xd3\b<\x8a\x82\x1f\x83\x00\xb8\x00\x18\xad\xcd\xc8K x\xee\xd7\x15v\xfb\x1d\x8a\xbaDUW\xd4\xc0X\xdbe\b0\x002(\x16\xdcS\xf2\x14Z\xeeA\x12GH$\xf9u(k\xba\xafo?\x1bBD\xb8}r\x86\xe7n=\x03\x1b^\xb7\xf9d\x81\xd7\uefce\xaf\xbd\xf1\xf5\xee>p\xd8\x002$Q\xf0\x01\xb1\x16\xc6P3\xddh\xd5**\xfe\x14\x80\u007f<6\x00c\x030\x9e\u007fSo\x840\x85D\x11M\xff\xed\xb4 8\xff\xe5\x17_~\xf5\u007f\xf7\xd9\xcf\xfe\x11\xe1\xe1\xe1,I\xe4\xcar\x0fct\xc7>月KF+\xd4\xe5\x06F+\x9a\xc6\x19A\xa3\xde\xd1j\xa0գ\x97\xbb5\xca\xfd\x9a\xb4\xf3\x8cu\xb0e\vy\xb2VE\xe0,V'\xb7qz\xebYhU\xe3\xe1\xbbo\xa2(v\x03\x82\x1b\xebH\u007f,\x04\xee\xb4\xf0,\x03\x90\xa4\x19>\xf2\xf1/\xe0\x85\x8f|\x86v\x98\xf7_\xc7;\xaf\xfdOخ\xcf;\x8f\x82\x83\t\x951\x9c\xdd}\x11w_\xf8(\xa4L\xe0\xc24\xa5뚼\xf5\a\xf4g\x06\xd6\x11\x18\xbd3к\x84w\x16Z)l֏Q\x15ۮ\x88zx\xa4\xd9\x1c\xab\x93{\x98\xccN:X\xba5+2\xa6AY\\\x06O\xfe\x02J7\x9d\x95qk\xe4r\x03Ig\x94\x06@{b*\x16Y>\xc3\xf1\xc9\x1d\x1c\x1d\xdfB\x92\xe5\x1d\xcc\xceY/I\x8b\xd3$8\xde\x11\xf7\xc1\x86\xc8_)\xa9\xb8\xb4\xfbh\xe7i2.\xcb=\x8a\xdd\x06uU\xa0nJ\\^\x9d\xe3\xc1\x83wpq\xf1\x18\x8d\xd1\xd7Cw\xd1\xfa%\xb4\xa43)%\xb2l\x8aI6\xa3\xbc\x84\x16\t\x01C\x9af8>:\xc6\xe9\xe9m\xcc\x17KH.\xe0\x9dC]W\xb8\xba\xba\xc0\xe3\U000c7e3c\xbc@S\x97D\xc2c\x1ci\x92b\xb1X\xe1\xf8\xe8\x14\xd3\xd9\x1cq\x14\a\xed9\x83\xd2\x06\x8d\xa2&5\x92\x11\xf2\xc9\x04y\x9e#N\xd2 \x9d\x03\xe5-4T4\xeb\xbaB\xa3\x1b\xca\f0\x16\xc6Ҋ@\xa9\x9a\n\x94R\xa8\xeb\x1ae]\xa1Q\x155,Z\xc1;\x03\x06\x0f!x\x88ύ E\x1c$\x93\x1c<\xb83*\xa5B\x03\xc0\xc3\xf4\x1f\bv\xc1p\x88s\x9a\xfc\xfb0\xa2\xc0y\x19f\x14t\x9a\xfe\xf0:\xcb\x18\x101<\x17\x1dq\x93q\xfa\f)\xab\xb1/\xf6\xd8\x16\x05\xf6\xe5\x1eEY\xa0\x19HM\xfb\x8c\x81\xa7\x17\xc0\xf63 \xc2g\xca_\xcft\b\r\xe7r\xbe\xc0\xf3\xb7\x9fE\x1a'\x98d\x13$q\x8a\xd7\xdf}\x13_{\xfd\xeb7\xb8\a\xed\xef\xf3Рic\xe0\x8c%\xb9%\xad\x03\x9f\x00\xf8$\xbe\xcbV\xc1c\x0306\x00\xe3i'\xd9\xe0\xf2\x96\xa4Y\aͦI\xfe\xcasϽ\xf8\xb5\x8f}\xe2\xb3Q\x14\xc5p֠i\xea\xbe\xf8;\x17bl]\xd0\t;4U\tcj\x92\xa69O\x80s\x909Qf\xbdG\xb1]\xa3\xaev\a\x1f\xc4vJ<\xa4\x80{,Wg8\xb9\xfd,\xacQx\xf4\xde[(\xf6\x9b~\xda\fA*\xac+\xfe6@\xb5\xc4̟L\xa6x\xf9c\x9f\xc7s/}\x02RJ<x\xe75|\xeb뿎r\xb7\t\x8f\xebp\xf2\ac8\xb9\xf3<\xee>\xf7\x11\xc4I\x06特\xaf\x9bP\xfc[\xe7\x95\xeeFI\xb9\b\xf0\x80\xd5\x15Yª\x1a\xeb\x8bG\xa8\xab}'\x03\xa3]\xff-,\x8f\ue1a9\xbfo\xba\x9c5\xa8\xca5v\xdb'\xa8\xaa=\x8c\xd6\xc1\x90\x88\xf6\xceC\x87|v\xed5\xa3\xe1\x8a\n@\x1cgX\xacNqv\xf6\f&\xf3%5&\xad\xf6<\xbc\xbeQDi\x8cI\x92\x80\x87\xa9\x9fxh\xa4g\xf7a*\x86\xa3]x\xdd4\xd8\xef\xb7(\x8b\x1dt]b_\xee\xf0\xe8\xf1{x\xef\xc1;\xd8\x17;\xd8\x03\xff\xfeP`\x84@\xd4\xed\xb2\xc9::KsD2\x0e͠\x03g\x02\xd3\xe9\fG\xa1\xf0/\xe6KDBB\x1b\x8d\xedv\x8d'O\x1e\xe0ɓG\xd8\xee\xd6h\x9a\x86䒌!\xc92\x1c\xadN\xb0Z\x1ec2\x99\"\x92\x94Y`\xadC\xdd\xe8\x9eЗf\x98NiڗR\xd2u\xf6\x1eJ)\x94U\x81}YC\x1bE\xc6R\xc1\xa6\xb9V\xd4\fTU\x85\xaa\xa2\xc2YV\x05ꦆV\nƆƒ\x01\x821HF\xa1S\xadMo$\x05YGK\t.\x02\xfb\xdfX(\xd5\x00\xc6`\"d\xe7\x01\xd1z\xf6\x93\xa52\x87`m\xdc0\x83\xe0\xack\x02\x18ǵ\f\x03JnnI\x86\x0e\x1c\xda\vxp\x92)ʨ+\xee\x0e\fUS\xe1\xe2\xea\x02\x17\x9b+\xec˲[ʹ\xa4Z·Q\xcd\xef\u007fo\xb8\xee\x10\xd8f\x06eI\x8a;Ƿqvt\x82\xc5t\x01\xce\x05\xbe\xf9\xf6k\xf8\xed\xb7\xbey\xe8G\x10\xbeHp\x8eI\x9a\xc1\x83C\a\x12fh\x00\x00\xe0\xef\x02\xf8_\x8f\r\xc0\xd8\x00\x8c\xe7\xbb\f\xfb\xb7$\xb6$͉\x11\x1f\"q\x9f\u007f\xf1\x95\u007f\xfa\xd2˯\xfeX\x96氖\bMU\xb9\x83R\n\xce[XmB\x90\x0e\xedF\x8dVpZ\xc1Y\xdd\xed\xba)\x06\x95#\x92\x11\xbc\a\x8a\xdd%\x05\xe8\xb4\x05\xf8@#=\x9c\xfe\x1df\xcbc\x9c\xdd~\x16\xde9<z\xf0\x16\x8a\xdd&\x84\xa9\x00\x8c\x8bP\x00Ț\xd7;\x0f\xe74\xc9\xf2<0_\x1e㕏\u007f\x0e\xf7\x9e\xfd\bd\x14\xe3\xc1;\xaf\xe1\xeb\xbf\xf9\xffB]R\xde9\xbbV\xfc=\x03\x8e\xce\xee\xe1\xde\xf3\xaf\"M'\xf0\x9e\x11ܫ\x1aZu\x18ӻ\xc5\xc1\x05\xa2\x1a\xe9\xe6\xadi\xe0\x1d\xa5\xf8]^<\x80j\xca\x0eҏ\xe2\f\xab\x93g0[\x9cQ\xae\x80\xebI~J\x95ح\x1fa\xb7\xbb\b\xe9i.\xb8!\xf6\x1aq\x16\x92\xf1\x0e\xa0\u007f\xdeގi\x1a\x9c\xccV8=\xbd\x8b\xd5\xd1\x19\xe2$\xeb\t\x90 \x98XFdx\x94ei\x97m@dMr\x02d\x01\x8dp\xdew\x11\xcdeU`\xb7ۢ\xae\n4u\x8d\xf5\xe6\x1c\xf7\xdf{\v\xe7\xe7\x0f\xd1h}\x83\xb1\xcf\x19C\x96O\x82aN\x04)$\xe2$\x85\xe44\xf1\x11\xd4둦\x19NO\xcepvz\x1b\xcb\xe5\x11\xe28\x81\xb7\x16\xfbb\x8f\xf3\x8bGx\xf7ݷ\xb1\xbe|\x02\x13\xf6\xd9d\x8c\x94\xe0hu\x8c\xe3\xe3S\xe4\xd9\x14RHJ]\xd4\x1aeU\xc1Z\x87$\xc90\x9d\xcd1\xc9'H\xe2\xb8[a\x18cP\xd5d\xa2S7\r\xb4\xb5\xdd$\xad\x8d¾\xdca\xbb]c\xb7ݠ(\xb6(\xca=%\xfa9\xd3y\x1d\xb4\xefI0\x0e\x1e\xf6\xf7\x9c\x01BP\xcae,$d\xb0\xfb\xa5\x9d>\x15Mg\b\x8d\xb0M\x8d\\JdQ\xd4q\tE\x1b>$)\x0eX\n\x0e\xc995\x00D.y:q\xcf\x1f\xfe\xc6y@i\x0f\xe3\xc8fZD\xa2˚\xb8\xd8\xedp\xbeY\x93\xe3dS\xd3J\xc3\xf7\xe8\x15\xe3,\x98\x14Q4\xf7\xb7\x8f]b\xd7\x14\x0e\xf4\xfb\xc5t\x86\x17\xee<\x8f[Ƿ\xe0\xbdǿ\xfa\xd6\xd7\xf0փ\xb7\xba&p\xd8\x04DB\"OrX\xe7PV%\xea>\xf5\xf2!\x80\xe7\xf1]$\x04\x8e\r\xc0(\x03\x1c'\xff\xe0\x19\x1e\xc7q'\x87\x8b\xa2\x18\xcf>\xf7\xd2\u007f\xf8\xdcs/\xfeX\x92\xa40FSR]\xb9\x87j\x1ab8[\x03\x13\xbc\xfb\xbdw\x04\x8d\xaa\x1a\xce\x04\x93\x94\x01t)\x85\x84\xf3\x16\xfb\xcd\x15tS\xf5\xfa䁧y\xcbGo\xa1\xffl2\xc3\xd1\xe9\x1d8\xe7\xf0\xe4\xe1\xdb(\xf7\x9b\u0cce\xc0#\x88\x0f\xe2K]\xf0U\acX\x9d\xdc\xc2+\xaf~\x16\xb7\xee<\x0f!%\x1e?x\v_\xfb\x8d\u007f\x8eb\xbf\x86\f\f\xf8\xeb\xd3t>]\xe0\xf4\xd6s\xc1B\x98\x05\t\x98BU\xec\x83\x15p\xab\x86\xb0a\x17J\xa6'V+x\x18\xd4U\x81\xcb\xf3\xf7\x88\xf4\x15&\xdd4[\xe0\xe8\xecy\xe4\xd3\x05\x18x\xe7\\\xe7\x8c!=\xff\xe5{\xa8\xab=M\xd2\xc1\"\xd8\xfakͷ\xef\x8b?\xbdV\x81o!\"d\x93%\x8e\x8eo\xe1\xf8\xe4\x0e\xf2l\x12\x1a\x12\xd6A\xccLp\xf2k\x0f\xb2\xbe\x96g\xd1\xda嶯}\xfbZ8cP55M\xfd\xfb\x1d\x9a\xa6FU\x15x\xf4\xf8]ܿ\xff\x06\xb6Ů7\x9b\xe9\xca\x10]\x8b\xe5b\x89\xd9l\x898J\x82\xcf~J\x11\xceZù\x12\x1a\nI\x92♻\xcf\xe1\xee\xddg0ɦ\x00\xf3h\xea\nW\xeb+<y\xf2\x10W\xeb\v\xec\xb6W!َ\xcc~\xe6\x8b\x15N\x8eO0\x99Ω\xf0\x83A\x19\x8d\xban\xe0\x9cG\x92fX̖\xc8\xf3\x9c\xb8\v\x9c\xc3:\x8b*\xc0\xf6EU\xc2hC\xef2Al\xfa\xb2.\xb1\xd9^\xe1\xea\xea\x1c\xeb\xcd\x15ʊ^㖼\xd9}6\x98\x18\x04\xe8\xf4ů52\xe2a\x05C!>\x00k\x93\x1f\x1d\x11^\xad1pVCi\r\xa34\xe4\x94\x05Y\x9c@\x1cKD\xa1\x01h\x9d\x04\xd9\xf5B\xdb}*\xae\xc7\x14\x0f\x10\x17\x06\xa41\x836\xa0\xbd\u007f\b#r\x00\xbd\x8eu\t\xe6}G2D\x9b1\xd0\xf23\x1c\xbd'\xa5\x14$\au\xfe}\x1b\x81Vu⽇\x1f4\x01W\xdb\r\xf6\xd5ױ-vx\xf1\xde\vx\xf5\xf9\x8f\xa0\xa8\n<Y\x9fwk\x97\x16\xbd0֠\xd15\xd2$\x83<l\x10n\x03\xf8y\x00\xbf:ޙ\xc7\x06`<\xbf\xff՟\xc8Fm\xfch\x14\x01\xa0\x1b\xc5\xdd{ϭ\xee=\xf3\xec\xaf\xe4\xf94\x90vt\x90}U\xc1o\x9c&\xff\xf6\x86i\xb4BS\x17\xb0\xa6\t\xb2\xb4\xde\nT\x84\xa6b\xbf\xb9\x84Vu_\xfc\a\x93\xbfG\x1f=\xea\xe1\x11%)\x8eN\ue003\xe1\xe2\xf1\xbb(vk\x00\xa4㧄\xbe\xf8@\x82e\x8c\"\xbf}\xc6qr\xeb\x19\xbc\xfc\xeag\xb0::\x03\x17\x1c\x97O\x1e\xe0k\xff\xe2\x9fc\xb7\xbd\n\x06.\xfe\x06\xa0.\xe3\x04\x8b\xa33L\xe6+*\xfe\x01\x86\xaf\xca}\x90,\xb6\x96h\xc1\xf3\x9d\a_\x01k\xa8\xf8\x97\x83\xe2\x1fj\xf6lq\x8a\xa3\xd3\x17\x10%\xd9@P\x05(Ucs\xf5\x00\x9b\x8b\x87P\xba鞷\x83Co\xbe\xc6z\x8fz\xf4E\aAb\x99\xe4s,\x8fNqt|\x1b\xb3\x10\x14\xd4\xee\x88[.\x86\x90\x12iJ\xc5\u007f8\xf53\xde\x16\xb6>,\x06\xdeCi\xda\x1d\xef\xf6\x1b\xd4e\x01\xd5\xd4\xd8l/q\xff\xdd7\xf1\xf0\xf1{PJ\x1dz\x0f\x04^B\x92\x12,?\x9f͑\xc4)\xb2$\x87\x8c\xe2\x10\xc9la8\x11ɤ\x90\xb8s\xfb\x1e\xee\xdd}\x16i\x96A\x1b\x8d\xa2\xd8\xe3\xe2\xf2\x02\x9b\xed\x1aE\xb1C]\x95\U000160a4\xa6\xf3%N\x8e\xcf0\x9d/\x10E\x115\x13֡\t\n\x94\xe9t\x1e\xec~\x93Θ\xc7ZK{\xef\xaaB\xad\x1aXKϗ\t\x01\xad5\xd6\xeb5..\x1e\xe3\xf2\xea\x1c\xfb\xa0\xac\x18\x060\xb5\xa17\x9d\xd5-\xdaݻ\xefH\x97\xf4\xd6fA\x98\xc7Z\x0f]\xc09X\xdfz\x01X\xc0\x91M/\xf7\x1e\x93(A\x12\t\xcc\xf2\x1c\x934\x83\x14\xed\xeb\x14\xe4\x9c\xec\xa6'\x02;p\x0fbm\x1bp\xf8XC\xf3\xe1<\xc0\x99\a\xe7\x1e\x95\xa14A\x06\x86,\x8a0K\x12\xd4J\xc18\a\x19V!>\xbc\xe6.\xa0\x19\xf4ޠ(d\b\xfaz;h\x86\xdeoz\xb6A\xd7\xcf8\x876\x1aߺ\xff:\xaevk|\xe4ٗ\xf1\xea\xf3\x1f\xed\b\x89\x1d\xe7 \\\xc7F7\x10B M\x13\x14\xf5\x81\x93\xe6\xdf\x18\x1b\x80\xb1\x01\x18\xcfw\xe1\xb4$\x1f\x00\x90\x91$(\xdd{\x9cݾ\x83{\xcf>\xff_Ng\x8b\xa3V\xf6TUD\xfako\x1cD\x82\xa2\x82\xa2uC:z\xd5\x00̇\xe9\x97Â\x8a\xb5w\x0e\xfb\xdd\x15tS\x0f \u007ftS\b\xfc\x10\xea\xf4\x10\\\xe2\xe8\xf8\x16d\x14\xe3\xea\xf2\x11v\xdbKb\x83[\x17\xe4vqw\a\xf4\xce\xc3\x1a\xf2u\xe7\\\xe0֝\xe7\xf1\xf2\xab\x9fF>\x9d\x03\x8ca\xbf[\xe3\xf5o\xfc&\xd6\x17\x8f\xa9\x11A\u007f\xbf\xc6@7\x9ff\x13,O\ue00b\x88<صA\xd3TPM\x1dn\xfc\xac\x93\x83\x81\x85\xeb\xe6-\xbc\xa7&\xe1\xeaɻ\xd0-ъ1\xacVw\xb0<{\x16\xb2}\xac\xa1\xd06\xd5\x1e\x97\x17\xefb\xbb~\xd2\xed\xb5=z\xd9\x1c\xbb\x86\xf3\x1eN\x85\x1cI>\xa1\xc6\xe2\xf8\x16\xe6\x8b#$q\x16\\\xff\xa8\xa0\bA9\xf2\"\x92Ȓ\fqBR;\x8a&\xeew\xfd]\xdd\x0f\xa4Ϊ\xae\xb0\xddm\xb1\xdfo\xa1\x9b\nu]\xe2\xd1\xe3\ax\xe7\xfe\x1bXo/\x83d\x9d\x1fB\xfe\x9cc6\x9d\xe3hy\x8a\xc9l\x8a,\x9d MR\x88\x909\xe0\x02\xbf\xc09\x87<\x9f\xe0\xf4\xe4\x16\xceNo\x81\v\x81\xaa\xaa\xb0^\xafq\xb5\xbeD]WP\x8ab\x89\x8dj\x90\xe79\x8e\x8eN\xb0X\xac\bE\x10\x12Α\xd5,g\x1c\x8by\x98\xf6\xa3\xb8{]\xea\xa6AUW(\xab\n*L\xfb\\p0\xeeQV\x05./\xcf\xf1\xf8\xfc\x11\xd6\xeb+\xa8\xa6\n\x92\xcf\xc1\xf5\xf6]\xc5\x05\xf3\xed\xa4?XI\xf1\xeb;qvh\xe4\xe7<!C\f\xe0\x9e#\xe2\x02I$\x90\xca\x18\xa9\x98 \n+\x02\xd6\".\xa1op\x00\xf8\xd0\xec\a\xefk\x10x\xf0>\xb4\xceQh\x905P\xc6\xc2X\xdf\x15v\xe7@hD@ގ\xa7Shk\xd1\x18\x83\xaaQ(T\x83F\xeb.\xd1\xcfu\x9f\tۅ\xfb\xc42\x82\xf7\x12Ƒ\x8a`H\x02|\x1a*\xe0\x9d\xed\xd6\n\xe7\xeb\v\xec\x8a=\x9e\xbd}\x0fwO\xee\xe0\xcd\aow\xef\xf5\x9e;\xc3P55f\xf9\x14\x93l\x82}\xd9\xc5\x02|\x15\xc0\xe7\x00\xfc\xe6x\x87\x1e9\x00\xe3\xf9}:\\\x88\x0e\x9a\xa3\xc8\xd8\f\f\f\xcb\xd5\x11^\xfe\xc8\xc7~\xe8\xe4\xf4\xce\xff3\x8ecx硵\xc2\xd5\xe5cځ\x03\x9d\xee\x19\xa0@\x9b\xaa\xd8\xc2\x1a\xd5\xde\xc6:9\x9e\x90\x11\x04程-t \xfa\xb4ҨnrhK[\xd8is!pt|\x1b\xf9l\x81\xdd\xf6\n\x9b\xcbG}\xb6<x\xb0f\r\xfbUo\xbb\xe9\\\xca\bw\x9fy\t/~䓈Z\xf7B\xe7\xf1\xe0\xfek\xf8\x9d\xdf\xfa\x1f\xba]%\xc2\xc4\x13\x85\xbc\xf7\x96\x95}\xe7\xb9Wp\xeb\xde\xcb0\xc6B55\xac֨\xaba\xc0O\xff\x19\x11!\xc5\xcd;\x8d\xaa\xdc\xe2\xe2\xc9{\x9do\x80\x10\x12\xab\xd3\xe7\xb0X\xdd\"bb\xa7Ep\xe1\xef\xde\xc7n{\xd1q#h\xef\xde\xeb\xfa;SA\f\xa4}\x8c!Nr\xcc\x16'X\xacΰX\x1cc\x92Oh\xcf\xcb\xd0A\xf9BЯ(\x8a\x90\xa6\x19\xa2\xd6\xc0'\\k\x04r\x19Z\xa2_`\xf8\xef\xca\x02\xbb\xcd\x1aeE\x05x\xb7[\xe3\xed\xfbo\xe0\xbd\a\xf7Q\xab\xfa\xa0\xf0\xb7\x92>.%V\xcb#\x1c-O0\x99L\x90\xa5\x93@\xf2\x1bL\x87\x019J\xb3\x1c\xcb\xe5\n\x93|\x06)$\xea\xa6\xc6\xc5\xe5\x059G\x1a\r\xa3*T!Fy9\x9f\xe3\xe8\xe8\x14ik\x00\xc59\xac%\xe2e\x92\xa4H\xe3\x14\"\x12ݴ\xdf45\x8a\xaaB\xd54a\xea\xa5\"\xeb\x9cž\xd8\xe2\xf1\x93\x878\xbfxD?K\xab\xa0>8\xa0~\xb4\xc06\xd8u\xf9\"\xeb3\x1aZV\xbc\x94\x11\xadʄ\x845\x14\x18䝃\x10\x02\xb1\x10\xc8b\x89<\x8e\x90GD\nd\x8c\x0fP\x85\xd0U\x84櫷\xf7q\x03\xa2\xdd!/u\xb8\x06\xb2Ρ1\x16\xb5Ҩ\xb5Ac-\xa97\xbao\xcf\xc3\xfbf\xf8\xf38b!\x89W\xc0\xc9\xe7\xa1R\x1a\xeb\xb2\xc4\xe5~\amLW\xb8\xdb'.8\x83\xe02\x04\x1d\xb1\xc0\v\xb10\x81\x9b\xf2\xa1\xf7\xe6\x8ca9]\xc0Z\x8b]\xb9\x03\xeb(\x90\xe8\x1a\x998\x8a1Ir\x9c\xaf/\x87\x86F\xff5\x80_\x1a9\x00c\x030\x9e\xdf\x17\xe4\x9fCH\xd1E\x97\xc6I\x82HƘL\xa7x\xf1\x85\x8fķ\xef<\xf3\x1bi>\xf9D\x9b\x19\xbf\xb9:\x0f\x16\xbfĈwF\x01\x8cA5\x15\xcabMR\xb5\x96\xa9\x8c~\xe7\x0f\xe6є\x14\xa3\xdaI\xc2\x06r\xb6\xf6\xb6C\x84'@r\x8e\xe5\xd1\x19\xa6\xf3\x15\xca\xfd\x16\x97\x97\x8f:u\x81\x03\a\xbap\x1b\x06k5\xac\xd5`L \x8eb<\xfb\xe2\xabx\xfe\xa5\x8f\xc1;\x8f\xa6\xa9\x10')\xaa\xfd\x16\xff\xea7\xfe9\xaar{@F\x92!\x01Pp\x01\xe7\f\xb2l\x82{/}\x02i6GS\x97tc\xaf\x88\xd1\xdf\x1b\xf4\x04\xee6'¤w\x1aM\xb9\xc7œ\xfb\xe4\b\b@\xc8\x18Ƿ^\xc0lq\xd6M\x89\x00\x87w\x06\xfb\xfd%\xae.\x1e\xa0,6]h\x8f\xf3\x80\xf5\xee\xd0J6ܻy\xa8\x13q\x9ca\xba8\xc5ly\x82\xc5\xf2\x18\x93|\x1adt\x04C\xc7\x11\xd9\xf5\x12\xeb\x9cx\x1c\xc4\xf0\x17=\xe4\x1fH\x98]!o\x8bI\xa3\xb0\xddo\xb1\xdfm\xd0\xd4%\x9a\xba\xc4\xe3\xf3Gx\xe3\xado\xe2j}\x1e\xd62\xfc\xfa\x1d\x13Q\x9c\xe0\xe4\xf8\x94\x98\xf8ٴO\x06\f\x8f\xddYJ\xdd\x13B`>\x9fc:[ \x89HW\xbe\xd9np\xb9\xbeB]W\xb0FC\xab\x1a\xd6idI\x86ł\xd2!\xe3(\x86\x10\x11\xed\xec\x19\x10I\n$\x12!\xa5Qi\x83\xb2\"\xb6\xbe\n\x11\xbd\xed*Ki\x8d\xednMFA\x17\x8fQ\x94\xc1\xe0\xa9{\r\xc9\x1d\xafwA\x1c\xbe\x1f{fH\xeb\xc2ǅ@,c\xa4\x81\xd3\x10\xc7\t\xa4\xa4\x04E\xab\x89\x10+\x9c\xc64\x89\x90\xc5\x11b)\x82\xfa\"\x94\xf6.b\x98\r~^\xef\x1c-\x880\xd0\xed\xfa{0\x82\x1e\x95\xf5\x0e\xcaXTʠR\n\x8d\xb6}\x11\x1e\xa8\x03\xda&C\x84\x06\x83\a\xa5\x02\xed\xfde\x1f\xfc$%\x18\x97\xf0\x10\xb8\xdco\xf1\xf0\xea\x92$\x82\xacU\x03\xb8\xee\x9d\xc89'bb\x88N\xb6΅M\x87\x81\xbd\xd6H}@\x89\x85\x14Ѡq\xf0\a\xbe\x1d\xde{\xcc'3Xcq\xb5ݴ_T\x01x\x06\xc0\xe5\xd8\x00\x8c+\x80\xf1|G\x8b?\xebLF\x10<\xb9\x85\x90H\xd2\x14gg\xb7\xb1:9\xfd\x9f\xc7i\xf6\x89V\u07b7\xdd\\v\xc5\xdf9\vk\x14\x00\x0f]W(\xf7kXg\xd0\x1a\xbbSƉ\x87`4\x815U\x11\x8a4\xebF\\\xe7\xfd5\x1d{7V\"Isd\x93\x19\xaaj\x8f\xf5\xd5c\x9a\xac8\x19\x9f0\xb0nZ\xb3V\xc3:\x03\xc1%\xa28\xc5\v/\u007f\x02Ͼ\xf0Q\x18\xdd\xe0\xc9\xe3\a\x98/Vp\xd6\xe2\xed\u05ff\x86\xb2\xd8t\xc60\x00%܉ \x1d\x03<\"\x19cqt\x06!b4UA\xac\xff\xa6\x0e)}\x83\xe2\x1fP\r\x82\xd35tS\xe1\xea\xe2a_\xfcE\x8c\xe3\xb3\xc3\xe2\xcf\x18\x875\xe4\a\xb0Y?FS\x974\x81vS\xffaA\xf0\xf0`\xe1:Eq\x8a\xf9\xf2\f\xb3\xc5\t\xf2\xc9\x02\x93I(\xb4\x8c\x87P\x1cj|\xa2\x88Ho\x8cs\xc4Q\x828\x89;nE\xeb\xaa\xd8\xf9\xb4\x87\xffg\x8cAU\xd5\xd8\xee\xd6(\x8a-T]c\xb7\xdf\xe0\x9d\xf7\xde\xc4\xfd\xf7\xdeF]U\x9d\xa6\xfe\xfa\xcd2\xcbr\x9c\x9d\x10{\u007f\x92S\xb1\x1e\xda\xfc:K{\xef\xc9d\x82\xc5bI\xc6<R\xa2\xaek\\\\\x9ec\xbb\xdd\xc2h\rc\x15\xbc5H\xa3\b\xd3\xd9\x11\x15\xfe$\x81\x8c\x12R%\xb4\x0e\x85Q\x04\xce\x19\xac\xf3(\xeb\x12EY\xa2\xae\x1b\n#\n\xdc\x110\xa0n*l\xb6k<~\xf2\x00WW\x17\xe4\x95\x1fT(m\xc3u\xe0Q\xd4\x16\xa3.\x94\xc0\x87B\x8d\x10\x17\x1d!Kr\xa4Y\x8e$N!\xe5PR\tD\x8c!J\"\xb0\x84\x83[\x15v\xfa<\xd0Y[{cj\x16\xbb\x9d\x13c\x88\xa2\x18BF\xb0\xba\t\x9e\x06\x04ٻ\x90\x9bЦ\xf0Yk\xa9\xf0\x1b\x83Z\a3\x9f\x01J\x14&\xb7.\xc4)\xec!`\x06\x9fs\xcb\x18$7\xe0\xccA\xca\x04Y\x9ab:Y \xcd\xe6\x88\xe3\f\xcfx\x8fgv\x1b\xbc\xfd\xe0m\\m.\xc19G\xa9\x1a\xd2\xe8;\x82\xf5\x9dw0\xce\x05\x99\xa2@\x1aI\x00\x12\x8d10ֆF\xe0\x83\x8a()i\xba\xcf\x1fc}\xcb\x15\xb8Be]\xe2h\xbe¾*[S\xa0\f\xc0\xff\f\xc0\xdf\x1b\xef\xd8c\x030\x9e\xef$\xf4߆\x8e\x84ۈ\x10\x02q\x1cc\xb5\\\xe1\xf8\xe4\u058bY6\xf9[\xe4\x87o\xb1\xdfm\xa0\xaa2\xdcd\x1c\xacVa\xffߐ\x16\xbf\x93S\xb5\ve\x16\xf4\xd6\x16\xaa*\x83\x83\x18\xebnT\xae-Jm\xd1cÉ\x8b\xc1:\x83\xfd\xf6\nE\xb1\x835D\x04\xe3Rº\xfe&\xe7\xac!\" ';\xd7\x17>\xf2)ܽ\xf7\"\xea\xaa\xc0\x9b\xdf\xfa\x1aV\xc7gȲ\t\xee\xbf\xf5\r<y\xf8\xce\xc1\xe4O\xfc\x01ه\xd2x\x87\xf9\xf2\f\x93\xf91\x8cV\xc4V\xb7\xb6C4:B^\x98\x829#\xb3\x1f\xa3k\\]>\xea\"\x8f\x85\x88pr\xfbE\xcc\x16'ݍ\x9f1\x06\xadk\xac/\x1fb\xbb9\x87\xd6\xe4\x8b\xd0Ŭ^\x9fD\x1c\xe9\xe9e\x92`\xb1<\xc5bu\x1bi6E\x92$ȳ\t\xe28\xee\x12椔H\x92\xb8\xe3mpN\xc68\xad\x82\x83\x1e7\v+\x88A\x1c\xad\xa3\xb4\xb6}Q`\xb3\xddP\xd6AU\xe1\xf1\xf9C\xbc\xf9ηpu\xf9\x84\x10\t\xfet\xed\xd9t:\xc3\xed\xb3\xbbX-O(O \x04\xbe\xb4\xaf\xbfцV8Gǘ\xcd戢\x18\xcey\"\xde]^\xa0\xae\n\x18K\xd7XF\x02\xb3\xf9\n\x93|\x82(N\x10E)D\x14A\x04\x8e\x02\x0f\xc68\xce9\x14%1\xf9\x1bE\x85\x1f`p\xccCk\nj\xdal.q~\xf1\x04\x9b\xed\x15\x1a\xd5\x04x\xdd\xe1ƈ\xfa\xd4\xff\xf4\xbd\xa1\x93\x10H\xe2\x04y6\xa5\xd8\xe3(\x0ei\u007f\xa1\xe8s\x81D\b\xc4\x11G\x1cE\x10\xf0p\xcaê\x10\xaf\xdb\x15\xff>\x86\x99\xb5{~.\x90\xa69&\xb3\x05\x00`\xbb\xbe\x84\xaa*R\x9a\x84\xf74<\x83\xf5\x16ڐ\x03\x9f\xb1\x83\xb8`\xb0\x8e\xa3\xd0\xf6/\xce\a\xde\x01Z?\x82\bI\x14#I2DqL\xa4Dg1I3\xac\xe6G\x98L\x16\x88\xa3\xa4\x8b\x89\x86\a\xf2l\n\xeb,\xacV\x98\xa5\t*\xa5\xb0\xa9*\x94u\x05m-5#\x8e\xa2\x80\x01 \x8bsdIJ\x84ѦB\xa5U\xe0?\xb8\xfe}\x1dP\xbe\xa1Ҧ]\xf5\xb5\n\x946\x1e\x9b\x85UN\xad\x1a\x9c,\x8f\xf0\xe0ɣ\xf6)\xfeU\x00\xff\x05\x00;\u07b5\xc7\x06`<\xdf!\xe8\xbfc~3\x0f\x06\x8e8\x8e1\x9f/\xb0::\xc1l\xbe\xfc\xdfK.\xa6\xceZ\xd4e\x81\xaa*\b\xba\x04\xc1\x9dΑG~Ul`\x9d\x0e\x9f\xf5~\x8fOy;\x0e\xaa)C>}\xe8\xf6\x19\x82\xb4(\xfc\x9dk,|\n:cPJA5\x97\x1d\x9cɹ\x84\xf3,\xecv\xd1\x11\xb7\xb8\x880\x9d\xcc\xf1\xf2G?\x85\xe3[\xf7\xb0\xdb^\xe1\xb7\u007f\xeb\u007f\xc0ru\x86\xe3\xd3;X_>\xc6\xdbo\xfcv\x97H\xd8\xee湈\xba\x1b\xbe\xb5\x06\x8b\xd5\t\x16'w\xe0\xbdGS\x97ab7\xfd#\xe3d\x99\xdb\xd9\xf8\x82b{\xb7\xeb\v\x94\xfb\r\xc0\xc8\xd6\xf7\xe4\xf6K\x98.\xce\x06\xbbT\x86\xba\xdec}\xf9\x10\xc5~\x1d`n\nh\xf1\x83@֖P\xc9\xc1\x91dS\xcc\x16ǘ/O\x91\xa6\x13HI\x86=y:\t\xd2-\x0f\a\x17\xf4\xfc\xc1\xbe\x97\x93\xedr\x9b\xd9\xd0\x1a\auL\xfd\x96\xb8\x18ȚJ+l\xf7\xa4yWu\x85\xdd~\x8b\xb7\uefce\xfbﾉ\xba*\aV\xca}\x89lM\x8c\xe6\xf3\x15\xee\u07be\x87\xe5\xe2\x98\n#B\x91\x13\xe4>g\xb5A\x9a$X\x1d\x9d \xcbs\bN\x19\xf0\x97\x97\xe7Xo\xd6P\xc1\xee\x17\xdeb\x92\xe7\x98\xcf\x17H\x92\x04R&\x88\xa2\xa4\x8bޥ\x06\x95\"z\x8b\xa2\b\xa4>\x1d,\x90\xa9Il\x94BU\x15X\xaf/qq\xf9\x04\xbb\xdd\x16Z\xabN)\xe1q}*\r\xbe\r`\x03ȿ\xdfE\xcb(B\x9e\xe6ȳ@b\x14\xad4\x92\xf6\xe1D擈8G$$\xb2<G\x9a\xa6\xf0\xb6\x81*\x19*.\xd0(\x15\x14\"|p\xed\xc8\x1b!M2\xa4\xf9\fi\x9a\x83\t\x89\xb2\xd8b[\x14\xa8\x94\xea\x1aN\xe3\x1c\x8c\xb5\xd0\xe1\xb5\x1a6\xca}\xb40\xfd\x87\v\x05\x973\x81<\x9b`1_a>\x9d#O'H\x92\x04\xb1\x8c\xc1Et\xc0\xc7\xe9\xd3\xfd\x1c\xbc\xb6\xb0ކ&\x98A\xd5\x15X@@fi\x86,NQ\xe59\x8a\xbaF\xd9\xd4P&\xf81\xc0aS\x15\xb0\xceb\x96M\x91\xa7\t\xf6U\x8dJ5\xf0\x8cV3:(q\x18\v2\xda\x16!\x1c<\x86\xe1k\xe3\xc25ڗ\x05n\x1f\x9fa\x9aM\xb0\xaf\n\x00x\x11\xc0O\x03\xf8\xb5\xf1\xce=6\x00\xe3\xf9\x0eA\xffC\fQJ\x81\xe9l\x8e\xf9l\x81\xc5\xe2\xe8g\xa5\x90?\xedA1\xafEp\xbdc\x9c\xc1j\r\x1b\xc2Z\xear۳\xdd1\xe0\x880\x82\u007f\xb5\xaa:_\xff\x96\xd8\xd6E\xf4^\x83\x06\xbb\x1b\x14g]\xa0O\xcbK\x10B\xc23\x06ml\xb8\xf9\x85\xdcq&\xb1\\\x1e㥏~\n\xcb\xe51\xae.\x1e\xe2k\xff\xe2\xbf\xc7d\xb6\xc0s/~\x14MU\xe2\xf5\xdf\xf9\x17Pu\xd9M\xff\x14\xa8\"\xfb\xe9\xc4\x18\xe4\x8b#\x1c\x9d=\v0\x8e\xaa\xd8\x05%\x9c\xed\xa3^\x83N\x9e\no@(\x8cB\xb1\xbb\xc2~{ѱ\xa5i\xe7\u007f\xda\x1b\xab0\x86b\xbf\xc6\xfa\xea\x01\xad\x14tC\x866\a04\xdd\xe0\x05\x17\xc8\xf2)f\x8b\x13\xcc\x16ǈ\x93\f\x8c1\xc42\xc6$\xcf\x11%\x19\xe0=\xad`\xbcC\x9afȒ\x142\xa6\xe2\xdf\xeeƉ\xef\x18,\x8dE\xb0\xa0\r\xc6H\x1e4\x056M\x83\xdd~\x87\xddn\x83b\xbf\xc7㋇x\xf3\x9d\xd7py\x11x\x16\x9dL\xeb\xb0\xf0\b)qz|\x86;\xb7\x9f\xc1l\xba艣\xe1e\xb7\x96^\x9f\xc5r\x89\xe5\xe2\bQ\x9c\x801\x86\xdd~\x87\xf3\x8b'(\x8a\x02\xd6P\xf1\x97\x82c\xb18B\x9eOh]\x11\xe7\x88\xd3\x14I\x14CȖ\xd8gP\x96%vEAfS\xa0\tZ+\x85\xaa\xa9Q\x14;\xac7\x97\xb8\xbc\xba\xc0~\xb7\x83\xb1\xba[\xcfPĲ?\x98\xf0;\x04\x04\x03\xe8\x99Q0T\x1c\xa5\xc8s\xca%\x88\xa3\x10\xb8\xe3=\x1c<$c\xc8\xe2\b\x99\x14\x90\xc1,'MRL'S\xa4i\n\xeb\f*\x038&\xc1e\n\xe9\x18\xb4V\xdd\xeb\xcb\x18G\x9c\xa6\xc8'3\xc4I\x1eȌ\x16\xe5n\x83\xab\xcb'(\xab\x02\xcahhmȂ8x=\xf4\x9e\x18!}\xd1\xf7\\\x05\x84\xc7&\xb8\xc0j~\x82\xb3\xa3[8Z\x1e!Ksjp\x83\xa1\x17\x05x\xf1\x81\x956\xadelH94\x86\x9awm5\xadM.\x1fBk\x8d\xc2\x03i\x14C\n\x81i\x94 \x8f\x12\xe8|\x82\xb2i\xb0o*4Z\xc1Z\x8bmY\xa2R\n\xf3|\x82y\x9e#O\x13\x14\xaaF$i-\xa0\x8cE$$\xe2\xc9\f\xd69삱\x128\xef\xdf[א\x18\xef\xe9\xef\x1d\xaf\x8e\xda\x06\xa0E\x01\xc6\x06`l\x00\xc6\xf3\x9d\x99\xfe\xd9A({\x96M0\x9d\xce1_\xac\xa6i\x92\xfe\x03\x9a\xbc\f\xcab\acT'\x1f3\xaa\x861\nMSB)u8Y\xf9\x96\xf8e\xa0\x9b\xa6+t\x87p \xba\t\xa0m\n8z7\xb1\xbe2\xd2\xf7とd\x82Űo\x13\xc6\x18\xc7ɭ;x\xe9\xe5O\"\xcf'x\xef\xdd7\xf0\x8d\xdf\xfa\x1f\x91\xa69>\xf2\xb1ρy\xe0\x8do\xfeO\xd8\\\x9d\xd3\xd4\f\"\xe6\xb5;\u007f\xe7,\xbc\xb3\xc8&s\x9c\xdc~\x0eRF\xa8\xab\x026\xc8\xcbH\x19\xc8\x037\"8\xfcyKD@gP\x97\x1bl\xaf\x9e\xc0Z\v!%\x8e\xce^\xc0ly+\x14 *\xa0\xbb\xed%6W\x0fЄkF\xe9x=\xd9̇0\x95\xc9d\x86\xc5\xe2\x14\x93\xf91\xa2\x10\x95\xca\xc0\x90\xa7T\x948\x170A\x86(\x04\xc7l\xb6\xc0d:\xa3\xc9.\xa8\x18\xe2(\xea\xe0`\xce\x18\x98\xe0\xbdIK\xf8Y\xdahTu\x85\xfd~\x8f\xddv\x8d\xab\xed\x15\xde~\xe7\r\xbc\xfb\xee\x1b\xa8벋K\xc6\x01&C\xfb\xe5(\xc9p\xeb\xec.\xee\xde~\x06i\x92wP\u007f\xbbA\xb2\xc6@\xca\bG\xab#L\xa7KH!`\xac\xc5\xe5\xd5%..\xce\xc35\xd0p\xce \xcfS,\xe7+\xa4)I\x13\x934G\x9ad\x14\x0f\xcd9\xac\xf3h\x94BYV\xa8\x1b*\xfc\xe0\x02J\x91\xed\xf4v\xbb\xc5fs\x81\x8b\xcbs\x14\xc5\x0e\xc6ڐ]\xd1J\xcb\xdaV\xe1:oa\x88\xfd\x87\xdd~\x9a\xf5\xd3>\x97\xbd\xc4\xd3{\bƐ\xc612) \x98\x87\x80\va73d\xf9\x04\x9cK4\xaaA\xddT0ڒ\xd7\x05\x97`\xdctr\xcc$N1\x99-\x90O\xe6`B\xc0j\x8d\xb2\xdcc\xb3\xbe\xc4zs\x85}I\xc5\xdfXC\\\x06?P\xf7\xfb\xa7[\xf0\xf8\x10e\xbd\x9c\xccq\xef\xd638Y\x9d!\x89\x93\xc0\xec\xa7L\x016\xf0\xd2\xe8%\x85a\xfa\x0e\xde\x0fRF\xa8U\x85\xab\xf5%.\xd6O\xb0/\xf6\xe1{shK+\xaaDJD\x818\x98\b\x89d\"0M\x13\x14M\x85]U\xa31\x06\xda\x18\\춨T\x83Y\x9e#\x8d\"X!\xa0\xad\x81\x14\xb4\x86\x9a\xe59\x8e\x16'PZ\xe1\x9dG\xef\xe2bs\x19\x9ak\xdcx\x8d\x18c\xd8W\x05V\xb3%\x96\xd3\x05\xd6\xfb\r\x00\xfc\x14\x80W\x01|c\xbc\x83\x8f\r\xc0x~\x0fş\a\xe3\x9aV\xc6%d\x84\xc9t\x86\xf9l\x81\xd9t\xfe\xb7\x84\x94\xf7\x00\xa0.\xf7h\x9a2\xa4\xe8\x01\xaa.at\x03\xad\x1a\xe8\x96X\xc5\x06*\xe8\x00\xa7\x1bU\x85\xe8Q\xf4\x00\xeb\x81\xdd^\x1fzC\xe8:\xeb\xa0B\xdf\"\x01\x9e\x81K2\xb01\xd6u\xb2 \x1f\x8c\x8an\xdf}\x0e/\xbc\xf4qH)\xf0\xe6\xeb_\xc7k\xdf\xf8\x17\x88\xa2\x04\x1f\xf9\xf8\xe7\x91$\x19\xdey\xe3\xb7\xf1\xf8\xc1[Ṓ\x8b\x9c\x941\x18\x17\x14\xd1j-\xb24\xc7ɭg\x90\xa49\xb4nH\xe7\x1f\x8a?c<\x04\xea\x04_\xfc\xc1\x1d\xaa.\xb7\xb8\xba|\f\xad\x1b\b\x11\xe1\xf8\xf49̗\xb7z\xd8\xdc;l7\xe7\u0604\xf8cctW\xfc\xdb\t\x873\x81\xc9t\x81\xc5\xd1\x19f\xd3\x15\xa28\t~?\x94ɞe9\x92(\t\xa6J%\x9c1\xc8'\x13,\xc2Νt\xed@,[\xe3\x9b\x16\xd9a\x9d9{[Ȝw\x84\xe4\xd4\x15\x8a\xfd\x0e\xdb\xcd\x06\xef=\xbc\x8f\xd7\xdf\xfa\x1d\\\\>\x06\x1bL\xfd7\xdf0@\x9aM\xf1ܽ\x17qzz\x9b\xae\xa1\xf7\xb4\xde\xf6a\xdfml\xd0\xeb\x9f!Ϩ9\xa8\xeb\n\xe7\x17\xe7\xd8l6\xd4\xfch\x05!\x80\xe5r\x81\xd9l\x89$ɐ\xa4\x19\xd24G\x12'\x81\xd5\xef\xd1hM\xb9\xf6\x86|\x1e\x92\x84\xa11\n\xfbj\x17r\x01\x1e\xe1\xfc\xfc\x11\x8ab\x0fc,\x19\xfbp>\xd8%\x0fЕ\x96\xcc\x17\xd6\x17\xad\xe5n\x1c'\xc8\xd2\x1cy\x96\a\x88\xbc\x9d\x90CB#gHd\x84,\xe2\x10p`^#\x8dS,\x17+L&SH\x19\aK\xe1\x06\x8d\n$QF׃\x12\xfb\x04\x12\x91c2[\x85\xf4\xc5\x14\xd6\x1aTU\x81\xcb\xcb'xr\xfe\b\xdb\xdd\x16\xb5j`\x9c\xed\x90\n\x1f,\xa8\xdfOd\xdf6\x8fy>Ž\xb3gp\xeb\xe4\x0e&\xd9$\xc4?S\x03\xe4\xd9\x10\x89\xeb\xd7j\xed\xf5p\xce\xc3XC\x92\xcfb\x8b7\xde\xf9\x16\xd6ۋ\x10\x84E\x19\x04mD\xb7\a\xf1\x0f\x18\x88\xf3\xe0\xc3*)\xe6\xc1\x83\"I\xb1\xad*l+\xe2\b\x14u\x8dFk\xcc\xf2\fI\x14#\x8d\x12XA\xe4\xddXrXk0ͧ\xf8\xc4\v\xaf⭇\xef\xe0\xfe\x93\xf7pÆ\xb3\x15az\x8f\xf5~\x83{\xb7\xeeb_\x15\x81c\x82\xbf\xae\x8c\xfa呭\xff\xfbX\x1fF\x19\xe0\x1fl\xe8\x9f1\x01!\xf8\x00\x12\xf5Ȳ\t\x9e}\xeeEܽ\xf7\xec\xe7\xf2|\xf6?Fq$\x8c\xd6\xd8\xed\xae\b\x12\x16\x12M\xb9#2\x9fը\xeb2h\xffفA\x8a\xf3\x16\xa6\xa9\t>\x0f\x9f\xe5\x9e\xec\xd77\t|\x80\x02\b\xce\xc8\x14\x85q\x828\x83#\x00\x17\x02\"\"o\xff&d\r\xc0yDI\x82{Ͼ\x8c\xbb\xf7^\x80\xf7\x1eo\xbf\xf1u\xbc\xf9\xad\xaf#\x8e\x13|\xecS_\xc2\xe9\xad{\xb8x\xf4.\xbe\xf9\xb5_'ɡ\x10\x902\x81\x94=\xack\xad\x86\xe0\x12\xc7gwpt|\x9b\x9c\t\xb7\xeb\xc0\xa8\x8f\x02dJ\x93\xbf\x94qp\xf9#x[\xd5;\\<y\aU\xb1\x05\x17\x1c\xcb\xe3g\xb1<y\xa6k\xaa\xe0\x80\xed\xe61\xb6\x9bsXK\x85O\xa9\xba۽{x\xa4\xc9\x04\xab\xa3\xdb\xff\u007f\xf6\xfe\xecŲn]\xf3\xc3~c\x8c\xd9\xcf\xd5G\x1f\x91\x99_\xbb\xfb\xb3\xabJU\x92\xed2\x82\x02]\t\x0e\x18\f\x05\x02A\x81\xa0@\xe6@\x99\x82\x03\x12\x12\xbe36\xe8\xca\x18$l|m0\xf8\x0f\xf0\xad\xafDI\xae\xaa\xd3\xec~\u007f]\xf6\x91\xd1\xc7\xea\xd7\xec\xc7\xf0\xc5\x18s\xae\x15\x99\xb9\xf7مm|\x9aX\x9bd\u007f\x99\x19\x19\xb1\x9a9\xc7\xfb\xbe\xcf\xfb4\xf4G\xfb\x04A\xd4y\x00\ba\xa1\xfc(\b\x01CYd\xd4e\x81\x90\x92\xf1h\xc2h\xb2oau'A\xf4<\xdfE)\xd3%\xc0\xb5\x87\xa7\xd6VR\xa6\x1d\xa9j\xb3Y\xb3Z\xaf\xb8\xbb\xbb\xe5\xf9\xab\xafy\xfe\xf2\x1b\x8a<#\xf0\xd4︯\xad\xc9\xd1p0\xe6\xd9\xd3/\x98\x8c\xf6\xbaB\xabZς\xba\x01a\xe8\xf7\x86\x8c\xf7\x0e\xf0=륰X-\xb8\xbd\xbba\xb3^QV\x15F\xd7\xc4Q\xc8p0$I{\x84a\xe2B\x88\\8\x8f\x104uCVd\x94u\x83'\x15\x9e\xe7\xd14\x86\xc5z\xc1\xd5\xcd;\xaeo\xaf\xb8\xbe|\xc7m'CuhEǵ0\x0f+\xe7\x8eь\x95H\x86$qlQ\a\xdf^\x0f\xad\xff\xbe\xef\xf9\xb6\x01\xc1\x1a\U00068984\xa6@\x98\x868N\x18\x8f\x0e\x18\x8e\xc6\xf8~D\xdd\xc6@g\xeb.\xfd\xb2\xed.Z\xf4D)\x8f\xb4?$\x8eS\x00\x8a\"\xe7\xfe\xfe\x86\xf3w\xaf\xb9\xbe\xbf\xb5\xb2\xc7\xd6q\xd0\xfcU\x8e\xfb\xdbF.\xf0\x03\x8e\x0eNyz\xf2\x94aolc\x86w\x8c\xb3\xb7/[vʖ\xa6i(\xab\x82\xac\xc8X\xaf\x97̗36\xc5\x06\xcf\xf3)ʜ\xfb\xe9m\xe7CѹG\x8am@\x90'\x14JH\"w\x9d\x99V\x01\xa3\xa4\xe3\x13\x98\xceK`Y\x144F\x13\x87!\xbe\xa7\xf0\xa5\x87\xefy\xf8\x9eM\xfc\x8c\x82\x84(J\x88\x83\x18\x83\xe1\xcd\xd5\x1b\xceo/;\xa2\xed\xeeC\n\x1b@\xf6\xe5\xd3/P\xc2c\xb9Y\x02\xcco\xeeo\x9f\xac\xb3\xf5\xea\xff\x17\xe7\xe3cc\xf1\x88\x00\xfcm.\xff\xce,F\xee\xc0\xbb\xd6\x1a5\x8c\"Ɠ=\xa2(\xfeo\x95\xa7\x94ц\xcdf\x85n,Ѭ\xc8\xd6\x14\xd9ƥ\xffe\x9d\xf1\x8f\xd8\x15\xad\x19MS\x96;2$W\xfc\xb7B_v0j{\x93\xcb\xd6~X\x82\xd9~7\xa1l\x92\x9aT>eUu\xf4\xe98\xed\xf1\xc9\xe7?do\xff\x98\"\xdf\xf0\xf2\xdb_q\xfe\xe69~\x10\xf2\xf9\xf7\u007f\xcad\xef\x90\xd9\xdd5/\xbe\xf9\x85\x9d8=\x0fߏ\x90\x9e\x85\xc7[9\x93\x14\x8a4\x1d0\x1c\xeeY\x87\xc0\xd9=UU\x12Fi׀\b\xa9P\xca\xdf9\x98l\xb8\xd0|vE\xb6Y \xa4d89c89qRJ\xfb\xda\x16\x8b;\x96\x8b;\x8b\x84TV\xdb\xde\x06\xfa(\xe53\x18\xee1\x9e\x9c\x10Ž\x8e\x97\xf0\xc0\tA\xd7lV9MSa\xb0\x87\xfe\x9es\xfa\xf3|ϙ\xdbXo\x05\xe5$\x91\u00857\x19's\xb3\xbbbM\xddT\xd6\x03\u007f\xbdf\xbe\x9cs~\xf1\x86o\xbe\xfb5\x17\x97\xe7\x16\xde\xf5\xfd\x8f\x06\xcc\x18aw\xc7{\x93#>}\xf6%i\xda\xeb|\x05<OY\xb2ZS\xe1\x87\x01\xa3\xe1\x84\xde`\x88\x10\x8a\xa6\xae\xb9\x9f\xddswwC\x9e۵\x87\x920\x18\r\xe9\xf5\aDQJ\x14\xd9\xe2\xef\aa\xc7\xec/\xf2\xdc\xee\x85]\xb4\xaf\x94\xca\xc6(\xd7\x05\xf7\xd3k\xce/\xdepw\u007f\xc3b>\xa5\xa9\xaa\x0f\xae\xe1\x1d{<Z\xdb\xe4\xb6\xf0\xa7Iʰ?\xa6\xdf\x1b\xa0\x94\xea\xb2\v\xc2 \xc0\x0fB\xfb>*\x894\xe0\x89\x06SeTY\x85\xf0\x15{\xfbg\x8c'G\x84\x81Ml̊\r\xd9fEQ\xe4覶\xbc\b\xa7&\xf1|\x1f/\b\x89\x82\x98 \x88@*\xea\xaa`>\xbb\xe7\xcd\xf9K\xce/߲Z\xaf\xa8\xb5~\xb0^\xf9\xab\x1e-\xc1o\u007f\xbcǧO>g\u007f|@\xe0\x05;\xaf\xdc \x8ctjC\x9b\rQW\x15\xabl\xc5|9c\xbe\x9c\xb1\\-\xc8\U0008d354v\x8d{\xbb\x16k\xe3|?\xd8\xfbt<\x03ݑ)-\xca\xc0\x16\xa5p\xa1Tq\x10\x10x\x1eiQ0\xcf2\xabHКB\x97T\xbaA\xd5\x15\xbe\xe7\x11\x046G\xa4\x10\x92\xc0\x0f8\xde?\xc4\xf7<^_\xbd\xa3\xac\xcbN\xd6ڑb\x05\x9c\u07fc\xe3\x1f\xfd\xe8\x1f\x92\xc4\x11eY\x0e\x9b\xa6\xf9\xcf6\xf9\xe6\xbf{,֏\r\xc0\xe3\xe3\xdfq\xfaG\x88\x0f\x12o=\xe5qtx\xc2p4\xf9\x13\xe5\xfb\xff\xa1\x14\x82,\xdbP\x95\x05\xca\xf3\xa9˒b\xbdD뚪*\xa8\xeb\xfa!lg,U\xa9\xa9Jk\x01\n\x9d\xb5\xefÙ졽\xad\x14\x02\xd5y\xc9K\xcb\xec\xc6 \xa5\x87T\x1eBy4\x8d\x85\xea\r0\x9e\x1c\xf0\xc9g? \xed\r\x99Oox\xfe\xcd/\xb9\xbb\xbd\xb4AE\x9f~\x8f\xc9\xe4\x90\xd9\xfd\r\xaf\xbf\xfd\x15E\xb6\xc1\xf3\x03\xbc t\xcc\xfdVng:W\xc2\xe1x\x0f\xcf\v\x98\xdf_\xb1^N\xe9\xf5\xf7\x9cS\x9bp\xb6\xa9>Bn\x93\x05\x85\x80\xf5⎕\xb3\"\xee\x8fO\x18\x8cO\x9c\x1b\xa1}\xbd\xcb\xe5=\x8b\xc5-um\xb3\x03\xca2\xb7\xf1\xc8@\x9c\xf4\x99LN\xe8\r\xc6\xf8~\x88\xc5A\x1c\xb9\xabn\xa8\xcb\x02\xd3T\xdd䥔$\x8eS\xf6\x0fO\x18\xf4G\bw`w\x99\rR\xba]n;\xf5\xbb\xb8`\xa7w\xaf\xeb\x9au\xb6f\xb9Zsw\u007f\xc3\xf3\x97_\xf3\u074b\xafY\xae\xe6V\x83\uea4f\xa7\xcb\t\xec\xe1|t\xc6\xd3\xd3O\x88\xc2\x18!%ql\xe1za\x1aʢ@\x86\x11\x83\xc1\x988N\xd0\xc6XG\xbf\xdb\x1b\xeeg\xd6+\u0098\x86$\x89\xe8\xf7\xfa$IJ\x14\xa5\xc4qJ\xe4\"y\x11\x82\xba\xaaɜ\xfcMy>\xbeo\xd1\x17\xa35UUqsw\xc5\xdb\xf3\xd7\xcc\xe7S6\xab\xd5\xd6A\xb2\x1b\x13\xcd\a\xab%c,1.\x8e\x13F\x831\xe3\xe1Ī$\x84\xb0\x92\xc9 \xecB\x82\f6\xb0G\x99\x1aє\xe8\xa6\xc0\v<\x0e\xf6>c0\xd8#\x8c\x12\xa4\x94\x14U\xc1j\xbd ߬;e\x88\xe7\x05xA\x80\x1f\x04\x04AD\x18%\xf8~\x88p|\x88\xf5z\xc9\xdb\xf3\x97\xbcx\xfd\x82\xf9bJ]ם\xe3\xe2\x03\xb3\x84\xbfb\xea\xef\xa7}\x9e\x9d~\xca\xd9\xf1S\xe20\xf9`R\x95Bb\xdc\xcf\xcc\xf2\x05\xf3\xc5=w\xd3k\x96\xab9Eiy8-\a\xc7\x13\xb8\xf0+\xd3!s\xb2\x93\xedv-\xe8\x0e\"\xb4EV\x1a\x1c\xffF\v\xc7!2HT\xd7\x04H\x04\x83(&\xf6|\x96eN\xd6\xd4]#\x00\x86\xaa\xa8\t\xbc\x88\xc0\v)ʼ\xe3ZD\x81ϓ\x83\x13^_\x9d[e\xcfn\"\xa8\x10l\xb2\r\x97\xb7\x97|r\xf2\tw\xd3[zI\xfa'R\xca\xffn\xc7)\xf0\xf1\xf1\xd8\x00<>\xfej\xe8_~\xf4\xc89<:\xe1\xec駓0\b\xffwR)\x9a\xc6&ٵ\xba\xeel9\xeb$\u007f\xa5\xd3\xfe?,頝\x9e\xdb`\xbd\xde\u06dd\xeb.\x8b\xbc\x95A\xd9=l\x9bI\xbf\x85M5ֿ_zV\x02\xa6]\x11\x13Rrxx\xca\xd3O\xbe\xc4\xf3|.Ο\xf3\xe2\xbb_\xb1Y\xafP\x9e\xcf\xc1\xe1)\x83\xfe\x98\xfb\xdb+.\xdf~G\x91\xad\x91\x8e\xecg\x10n\xfav\x12;)1B\x93\xf6\x06\xc4i\x9f\xe5\xe2\x8e\xfb\x9b\xb7$\xbd\t\xd2\vv\n\xac\xea\n{\xfbJ\xcb\"cz\xf7\x0ec`0>b8>F*\x9b$ht\xc3j9e9\xbfuD\xb7\x86\xb2\xdcP7%\x9e\x1f1\x1a\xed3\x1c\x1e\x10\x86\xa9\x8b\xa5u0}\xd3PU\x05E\xb6\xa2\xc8\xd6\xf8\x9e\x87煠$\x93\xc9\x1e\xc7'OI\xd2~wX\n\xb9%xI\xe9\x92\xe7\xc0\x19\n\xd11\xfd\xeb\xda\xc6\xe9\xce\xe63\u07be{\xc3\xd7\xdf\xfd\x8aw\x17o\xa8\xaaʙ\xfa<\x90\x91wg\xbd@\x10\xc7)\xa7\xa7\xcf8><#\xf0m\x8cn\xbf\xd7#\x8e\x12І\xba1\xa4\xbd!\xfd\xfe\xd0\xf13\x1a6\x9b\x15W7\xd7,\xe63tS\xe3+I\x92\xf6H\xd3\x1eq\x9cZ\x92_\x14\x13\x87\xb1\x85ڵ\xb12\xcf\xca\x1aCy\xce\xd9OH\xe7\xeeW\xe6\\ܼ\xe3\xe5\xebo\xb9\xbd\xbbb\xb1\x98\x93\xe5\x1b\u05c8\x89\x87\xea\x04\xe8\x18\xf3\xbe\xe7\xbb\xc2?a\xd8\x1bZ\x89\xa2\x14\x04AD\xbf\xdf'\xed\xf5\x11FP\x96\x05MS!\x9b\x12tIS\x17x\x9e\xc7`r\xc6xrH\x18%.κb\xbdY\xb2^.)\xab\x02c@\x05\x01a\x10\xe3{>\x9e\x1f\x10D1a\x10a\x84\xa1\xa9k\xf2<\xe3\xe6\xe6\x92\xe7/\xbfu\xa1IV\xb6hv]/\xcd\xef\xae\xff\xadb#\xf0}N\x0e\xcf\xf8\xec\xecs\x86\xfd\xf1C\x93\x1f\xb6\xceyE\x91\xb1\\N\x99MoX,]\xb6\x81\xd1xB\xe0\xfb[Rc\xad\xadz\xdft.][\xc3c\xeb2h\xba\x15U\xcb\xea\xb1\x1b\x16\xbb\x12@\xeb\xad\b_؈b\xe9\x14>\x00Zl\x1b\u007f_Z\xd5Nc\xb43i\xb2\xf7_Q\x97\xf4\f\xd6y\xb2\xcc)\xab\x82\xdb\xd9\x05'\xfb\xcf8\x99\x1cr~w\xb9\xc3\xe5\xd8\xe6\x04\xbc\xb9|\xcb\xd9\xe1\x19\xfd\xb4OY\x15?\xf6\x94\xf7\x1f5M\xf3\xff|<\xd9\x1f\x1b\x80\xc7\xc7\x1f\xdc\x00X\x8f}\xd3\xc5\xe5j\x86\xa3\t\xdf\xfb\xfeOHz\xfd\xff#0A\b\xb2\xf5\nm\f\x81糚\xdfQ\xd7V\xeeS\xbaibW\xbf+\xdcn\\\xd7U7}\xe9\x16\x82~\xbfIp\x93\x8f\xe7IgI*]>\xbd\xa0ֶ\xf8\xfb~\xd8\x19\xd6\xd4M\x83\xf2\x03Ξ|\xc6\xe1\xf1\x19\xba\xa9y\xf1\xed/y\xfb\xfa[ꪲ>\xfb\xe3}\xfa\x831\xf3\xe9-\xb7\xd7\xe7TU\x81\xe7\xd0\x03m\f\xa6֝۠\x95^\xd5\x04aD\xda\x1f\x91gk\xee\xae߂\xf4\t\xe3~g]l\xad\x91\x83\xee\x99[\xe9T\xc3\xf4\xf6\x1dUU\xd2\x1b\x1d\x93\x0e\x0f-W\x00\x8dn`\xbd\x9aZ\xd8\xdf\x15\xff<\xb7\x93bڟ0\x99\x9c\x90&\x83\x8e[`\x04\x98\xc6\x1d\x80ٚ<[v\xd9\x01eY`D\xc6h\xb2\xcfh\xb4O\x94\xa4]S\xd5\x06\xfb\xb4\xc5Ck\x03Rwn\x81\xb8\xc0\x9d\xaa\xaeY\xadW\\\xdf\\\xf3\xed\xf3\xaf\xf8\xfa\xbb_\xb1X\xcc:\xe4\xe0A\x9a\x81\xfb\xa7F\x18\xa4P\f\xfac\x9e\x9c}\xca\xfe\xde!JY_\x88~oH\x1cDhS\xa3\x85!\x89m!\x95RR\xd5%\xcb圫\xebK\x96\x8b\x05RB\x9aFn\xe2\x8f\xddğX\xc2_h\xb9\vumW#\x8d\xb6Lv\x9b\x0ei\xd7B\xbai\x98/f\xbc\xbdxū\xd7Ϲ\x9b^\xb3Y\xafl#h\xb6\x12ѭz\xdf\"3\x9e\xf2\xe9\xf5\x06\x8c\acz\xe9\x00ߥ\x01\x06A\xc8h8f0\x1c\xe1{>e]R\xe6k\x94.\xf1M\x851\x15(\xc9hp\xc4x\uf634?DJee\x86\xeb9\xcb\xe5\x8c<\xcf0\x8d\xc6\xf7Cg\xaa\x13\xa2|\xaf\xf3\\@\b\x9a\xba\xa6,\xed\x9e\xff՛\x17\x9c_\xbee\x93m\x1e(0\f\xe6\x01E\xc1\xec\xb8f\xecBrR\b&\xe3}>{\xfa\x05\x87{\xc7xm\x86\xc3{L\x81\xb2\xdc0\xbb\xbf\xe5~z\xd9)u\xda\xed\x9alM\x9f\xd8\xda\x02\xfb\x9e\xb0\xee\x82l\xad\x85[\x83\xab6\xe1\xd08;\xea\xb6hK\xb6Qǻ?\xddhC\x8dq\xb6ʢC،\x01!-\xb2'\xddJB)\x9co\x83\xa1\xa9\xab\x8e`X\xd7\rU]\xbb소\xa3\xbdC\x8a\xba\xe2~q\x0fm`\x95{\xe4e\xce\xeb\xcb\xd7\xfc\xf8\xb3\x1f\xb1\xc96\x84\xbe\xff/\x8b\xb2xl\x00\x1e\x1b\x80\xc7\xc7\x1f\x04\xfd? \xeb9\xe6\xbf\xf2\xf8\xec\xf3\xef3\x1a\xef\xfd\x87\xc64\xffL )\xb2\r\xf3\xd9\x1dq\x92\xba\xbd\xff\xda\xf9\xadg\x1d\xb4\xfc\xf0 h0\x8d\xf3\xc8w\aB{\x10\xbc\xdf\x00l\xf7\xcb\x12%\xbdNY`\\r\x9fr\f}\xe1|\xc6\xc30\xe2\xc9'_2\x99\x1c\xb2Y/y\xf1\xfc\xd7\xdc\\\xbe\xb1\xdf\xcf9\xc4\xf9~H\xb6^2\xbd\xbb\xa6*s\vo\n\xb5%ܙ\xadm\xafe9C\x9a\x0e\x10\xc0|zM\x96\xad\x19흺\xc9\xd3 \xa4պ\xef\xea\xe0\x8dnX\xaf\xa6\xac\x16\xf7$\xfd}\xd2\xfe\x04ϳ\xaev\x88\x86\xcdj\xc1jqOUW\x18\xd3t&B\xfbGO\xe9\x0f\xf6\t\xfdȢ\t\xceA\xb1\xaa*\xf2lE\xb6\xbc\xa7\xcc7νO\x91\x97%y]3\x99\x1c0\x9e\x1c\x12'=\xcb\x0e\x176E\xd1\xf7T7\xaa\x1bc\xa8\xcb\x12!m\xa6\xbb\x106\xf3\xbe,K\x16\xcb\x05\xe7\xef^\xf3\x9b\xaf\u007f\xc1\xeb\xb7/(\xcb\xf2\x01\xc3_\xec|.-=\xd3S>\xe3\xf1\x01ON?e<\x9a\xa0\x94$\fb\x86\x83\xbe-@Z#\x84\xb2:\xf98\xb5\xe6<u\xcdl>\xe3\xf2\xea\x82l\xb3&\f|\xd2Ԛ℡%\xdbEQB\x1c\xc5x\x9e\xefP\x91\xca9B\xe2\xc8\u007f\x968\xd9h\xcd:[qquΫ7Ϲ\xb8|\xc3r5\xb7\x88E\vsK\xb6V\xcc\xees\r\xfc\x90~\xaf\xcfx\xb0G\xaf\xd7Ǔ\xf6\xf8\xf2\x03\x9fQ\u007f\xcch<!\fc\x9a\xba\"\xcf\xd7\xe8\xaa \xa4B(\x83\xf4\x02\xc2h\xcc`8!\xed\x8fPRa\xd0\x14e\xc1bq\xc7b>\xa5\xaak<\xe5\x11\xc61A\x9c8\xbe\x80r͜\xf5)\xa8\xaa\x92\xd9\xec\x8eW\xaf_\xf0\xe6\xdd+֛u\xd7\x1c\xb7\xcd[\xb7\x17{X뻕\x94\x85\xf2\ri\xd2\xe7\xe9\xc9'\x9c\x1d?#\x8e\x92\xaey\xe8P\x0e\xa3\xa9\xca\xd2\"W\xb7\xefX\xadf֭Q\xc8\xee9\x89-\x9c\xd3\xf9h\xb4\x9f\xb54[\xc3)\U0005e7c01mn\x80\xc2\x13m@\x97錃\xb4ٽ\x9f\xcdV\xb1\xd05\xfe\xcek\u0080\x11\xaa[M\xb4AV\xbe\x8b\xdeֺA)\x9f\x1a\x9b려GQfx\x83}\x9e\x1e>!\xf0\x03\xae\xa7\xd7\xf6y\x99\xadA\xd3\xc5\xcd\x05\x9f\x9f~\xc6d\xb4G\x1a\xa7\u007f\xbcX\xaf\x9e\x01\xaf\x1fO\xf8\xc7\x06\xe0\xf1\xf1\aL\xff\xbb\xfb^m\f\xc7G'\x9c\x9e>\r\x84\xe0\xffb\xe3]\r\x8b\xf9\x1dUi\xb5\xe6U\x9e\xb9x߲c\xc0\xef\xe0\xad\xf6fv\xfb\xd0֊t\xb7\xe2\xbf_\xf8\x95\x10x\x9e$\xf4|\x94\xb2\x8c\u007f+\xe7WH\xe5۩ŭ\x03\xa28\xe5ɳ\xcf\xe9\x0f\xc6L\xef\xafy\xfe\xcd/Y\xcc\xefwv\xf4\xb6\x18̧7.g\xbe\xb6\xfbz\xa1:\xa8\xb5u\xec\xebR\xf6\xb4!\nC\xa2(\xa6(7̧w(?B*\x9f\xa6\xa9\xad߀kNvۗ\xba\xb6n\u007f~\xdc'\xee\x8d\xf1\xfc\xd0~\xff\xa6&\xcbV\xac\x96S\xeaz\xeb\x8c\x18\xc6=F\xe3\x03\xe2\xa8\xe7\x94\a\x96]\xae\x9b\x86<߰Y\xcf)Vw4e\x8e\xf4\x02\x84\xf4\xd8\xe49\x9b\xb2\xa27\x1c1\xd9;\xe0`\xff\x90(\x8a:g\xba\xb6Y\xb2\x91\xc7\ruӸU\xb2\xe3\x10hk\x8c3\x9bNy\xfd\xfa9\xbf\xfd\xf6\x97\\\xdd\\v1\xbd\xe6\xbd\xe2o\x9b7M\x83$\x8eB\x0e\xf6\x8f9=\xf9\x84Q\u007f\x80\xf2\x14Iң\x97\xf6\xec~\xd8y\x15\x04QD\x1cE6?\xa0\xaa\xb9\x9fM\xb9\xb9\xb9F\n\xc1\xc1\xfe\x01q\x14\xd9T\xc5\xc0:\x13\x86aL\x1cEH\xa1\xacƽ\xb1\x00\xb4\xda\xe1/\b!\xa9\x9a\x86\xeb\xbb+\x9e\xbf\xf8\x8a\xd7\xe7ϙNo;\x13\x1d\xf9\x80\xe6g\v\x95%\xf0E\xf4\xfb\x03\x86\xbd1\xbd\xb4\x8f\xef\xf6\xdaJ)F\xa3\t{\x93\x03\xe20\xa6nj\xb2lIS\x15\xf84x\x1eH\x19\x11\xc5)\xbd\xfe\x98$\xed\xdb\xc2o4\x8d\xb61\xd7\xd3\xe9\r\xab\xe5\x1c\x01$i\x9f8N\xbb\xcf\xd1sJ\x81F7\x96h\xb7Zp~\xfe\x92Wo\x9e3]̷aKn\xb26\xef9\xf9\xbd\xb7q\xe9P\x9b\xd0\x0f89<\xe3\xd3'_0rp\xbf\xcd,h=\t,\xefb\xbd\x9a3\x9f]\xb3Z\xda\xc2\xef\xf9!\x9e\xf3\x1e\x90\xaa\xb5\x1d6\x98F\xbbf\xbcm\x84\xed\x92M8\x05\x8e'\xecA߲P\xb46\xdd\x1a\xa0h4\xa5[!\xb4\r\x88~\x8f\xcb\xd3y\x15\xb4fEҺ$\x1am_\x8f6\x8d}\xfaJ>\xe0,\xb4\x0e\x9e\xbe\x1f \xb4E*\xe2(\xa1i\x1a\x8c\xd1\x04\xbe\xcf'GO\x11\xc0\xbb\xbb\xcb\x0e\x95\x10@Vd\xbc\xb9~\xcbO>\xff\t{\xe3\x03uq{\xf5'\xc0\u007f\xfdx\xc2?6\x00\x8f\x8f\xdfQ\xf8\x8d\x83\x87原\xbb1\x86(\x8c\xf8\xe4\xd3/\b\xa3\xf8Ou\xd3\xfcX\n\xc1f\xb3\"[\xafPRQ\xbb\x1di\xa3+*\xb7\xfb\xdc\x19C\xec\x81\xec\x8a\x108Kѝ\xbd\xff\xfb\xc5_\n\x1b4\x14\xf9\x01\x9e\x94\x96\x89-\x853\xb2Q\xd6\x15\xccq\x02\xa28\xe5\xe8\xe4\x19I:\xe0\xfa\xea\rϿ\xf9%E\x9e=\xf0\xf1\xb7|F\xe3Ha\xa2\xb35\xb6\xabM\xbd#;\x14;\xf0\xab&\x8e\xac\v\xdb\xfc\xfe\x9a\xb2*\x19\xa4c\xaa\xa6v\xfe\xee\x92 P;\xde\xf7\x02\xddTd\x9b%\x8d1$\xbd\x91u\xe3s&BE\x9e\xb1^N\xa9\xaa\xdc\x16I\xa9HF\xfb\xc4\xc9\x00\xdf\x0f\xdd\xf3\x15Ժ\xa1.K6\xf9\x92\xf5r\x86\xce\x17\xceU/BJIV\x14\xacʊ(N\xd9\x1b\x1f\xf0\xf4\xecS\x86\xfd\xa1\x93!\xda\b\xd66\x98\xc6\x0605\xb6\xd8I\x85\x90\x92\xba\xaaYm\x96\xdc\\_\xf2\xf2\xd5w\xbc|\xf3\x9c\xbb\xf9]W\x8cv\x91\x98\xf7\xb3\xe5\x830\xe2\xf8\xe8\tON\x9e\x91\xf6z\x04\x9e\x85ғ8\xb1\xe6LR\"\x95O\x18YKY\x8d\xa1*K\xee\xee\xefX,f\xf4Ҕ4M;F}\xe0\x87NVg\xb3\t\x8c֝\U000e1360\xf5;\xd4A*I^\x14\xbc|\xf5\x1d\xbf\xfe\xea\x17\\\\\xbd!۬:\xaeCgW\xdb\xc2\xd1B\x10\x051\xfd\xb4\xcf`0\xa1\x9f\xa4֓\xc0^`\xf4\xd3\x01\x87\xfb\xc7\xf4\x06\x03\x1aݰެ\xa8\xcb\x1c_\x18\"_\xa1\xa4O\x14%\xf4\x06cⴇ\xa7<k[\xdc4Tu\xc5r9g6\xbb\xa5,2\xc20\"Nz\x04Al\x932\x85r͠\x8d\xbe\xce\xf3\r\x17\x17oy\xfe\xea[\xee\xef\xef\xa8u\xd3\xe5j\x98\xd6/_\u007f$\x18ǡpV~۠\xa4b2\xdc\xe7ӳ\xcf9\xdc?\xb6\b\x96ٹ\xc7tCU\x16\xacVSV\x8b\x19y\xb1\xb1\rC\xdc\xdbJ>ٚ=u?Dٰ\x1ei4B\xd7H\xdd\u0602+@\xbd\x87\x02\x81\xc0\xdb9\x1fB\xa9XT%\xb9\xfeC\xa3~Ŏ㡕\xef\xb6\r\xb8=~\xb6v\xe3Fۆ:\n\x13\xdb\b\nA\x1a%\xe4eN\xd5ԄR\x81\x10\x9c\x1d\x9c\x92W\x05\xb7\xb3\xbb\a?\xe7\xdd\xf5;>;\xfd\x94'\xa7\x9f\xf0\xea\xf2\xed?_.g\xff[lZ\xe0\xe3\xe3\xb1\x01x|<\x84\xfdy\xcf\xcf}{\xdb\x1f\x1e\x9d\xb2\xbf\u007f\xf4\x99\xc1\xfco\xacVX\xb3\x98\xdfw6\xb0MUZ7\xbf\xb2\xdcqO\xdb\x1e0Z7\x1d&\xa8[PP\xc3\xc7\x14\xcdm!\x8b|\xcf\x16\u007fW\x00\x94\xf2Q~@\xa3\x8d\x95\x17y\x8a$\x1d\xb0\xb7\u007f\x82\x1f\x84\xbc{\xfb\x9c7\xaf\xbe\xa6\xaaʇ!>\xe2\xc17\xa7\xcd9\xefHC\x88\xf7\n\x9f\xdd=F\x81u\x9c\xcb6+\x16\xf3\xa9-\xe6RR75\x02\x81寮n\x8a2\x86\xa2,(˂ J\tB\xeb\xc8ga\u0602\xcdjNYf6\x9b>\xb6\xdav\xcf\xf7\x9dӠ]\x89\x14eA^flV\v\xf2\xd5\f\xa9\v\xbbb\xf0\xect\x9fךU\xd5\xe0\xf9\x01\x93\xf1\x1e_~\xf1}&\xe3\x89\xf3\xf0\x17\xdd\xfa\xa6%\fj\xdd8Oz\x0f\xa9\x14eY2\x9d\xdd\xf3\xee\xfc5\xaf\xdf>\xe7\xe2\xea\x1d\xf3\xd5\xe2\x83\xe2/>2\x81\xa6I\x9f\xb3\x93\xa7<=yJ\xafg\x8bx\xaf7$\nB\xcb\xd1\b\x02\xb7\xe7\xb6ּMc\xe5z\xb7w\xd7\xe4y\xceh8\"\x89c\v\x91\x87\x11~\x10\xe0y\xbe\x9dƍ[\ai\xd3\xf1*\xda\xc6J\xb85\xd0|1\xe5\xd7_\xfd\x82\xdf|\xf5s\ue9f7\xd4\xda~\x0e\xb4\x88\x85i\t\xa5\x820\x88\xe9\xf7\xfa\fz#zI\x0f\xdfo\t\x9b\x86(\x8a\xd9\xdb?b2\xdc\xc3\x00\xebՒ\xb2\xcc\t\xa4\xa1\xef[\xcf\v?\xb0\x8a\x85\xa4gC\x89\xda\xc6\xc4*5JK\xa0\x9b߃\x10\xf4\xfa#\xe2Ě\xfd()\xd1\x1d\nf\xbf\xf6\xf6\xee\x9a\x17/\xbf\xe5\xd2I\xd7ڄE\xe3\x18\xefZ\xeb\x8f\x06<\tG\xdal\xaf\xca4\x19\xf0\xec\xf4sN\x8f\x9e\x10\x87\xb1#\xffi\xd7`j\x1a\xd7|\xce\x17S\x8ab\x830\xe0\xf9\xe1\x03\xf6\xa3m\xb8\xb5[\xcf9\xa2\xa8Ѷ\xe0\x9b\x06\xa95\xd2\xfd\x99\x11\xbfg-\xf7\xa0Y\x87\x9e\xe7\x13\x19Mc\f\xb5\xd1T\xdaPk\xe3v\xf9\xec|\x8e\xbc\xb7\x16\xdcU\xfa\x88\x0e\xe5\xdb\xfe\x95pdJ\xdd\xf1\n\xa2 \xa6(3\xaa\xba$\xf4C\xd7,z<=8\xb5\x89\x8f.\x80L\n\xc9r\xb3\xe4\xed\xd5[~\xf0\xf9\x1f\xf1\xa3\xef\xff{\a\u007f\xfe\xb3\xff\xfe\x9f\xd6u\xf5\u007f}<\xf1\x1f\x1b\x80\xc7\xc7{\xfbE\xe1B\xc6w\xd3\xfe\x8c\xb1\a擧\x9f\xe2\a\xc1\u007f\xab\x1b\xddC\xc0z\xb5p\xfbh\xdc\xe4\xdfP\x95\x95\x93\xb0\x896\x01ױ|5h\xf3`\xf2Ў\xfcg\xadz\xe9\x0e)k\xf4#\t<\x0f\xaf%\xe6a\xa5HA\x18[\"\x98\xb2\x866i\xdag0\xdaØ\x867/\xbf\xe2\xea\xf2\r\xba\xd1[\xf8\x9b\x9d\xc3D\xec\x946acW\x85+\xfe\xef\xeb\x1c\x9b\xbaB\xa0I{}\x1b`r\u007f\x03\b\x82\xd02\xbd\xa5\x86Z\x97\x980ٱ\xc1\xb5\xe9}\x95\xf3\x9f\x0f\xc2\xc4z\x02\xb8\xef\xb7Y\xcd)\x8b\x8d%\x86%}++s,v\xe1\"O\xab,g\x93m\xec\x9e6_\x13\a\x1ea\xd2wS\xb0\xa0\xa8*V\xa5\xf5\xae\x1f\r'\xfc\xf0\xcb\x1f\xb3?٧\xa9\x1b\x97*\xa8:\xa4\xa5\x9b&]3%\x84$\xcb2\xaeo.y\xf3\xe6\x855\x98\xb9\xbbf\x9d\xaf\x1f\x14\x1f\xf1\xc1ua}釃!OO?\xe5\xec\xe8\x94^/%\nb\x92\xb4gS\xed<\x9f \x88P\x9e\x87\xe7Y\xaf\x01\xad\xedn\xfc~z\x87n\f\xfb\x93=|\xdfsĸ\xc8M\xfc\n)m\x02a\xfb|[\xcbf\xb5\xd3\xd0\xd4uś\xf3\x97\xfc\xec\x97\u007f\xc6\xf3\x97_\xb1\xc96.\x1ffk\vk\x13\xe5l<s\xbf7\xa0\xdf\x1b\xd1K{\x84\xce4I\b\b\xfc\x90\xf1h\xc2\xde\xe4\x10\xcf\xf7\xd9dk\xf2<#\x10\x86Ah\x9bM\xa5<\xd2ހ\xfepB\x18Ŗ\\ZW\x94yAU\x974u\xcdj\xbd`\xbd^\xe1\a֫?\fck@\x85\xa0r\r`U\x15\xcc\x173k\x9b\xfc\xee-\x9bl\xe5,u\x95\x9b\xf8\x9b\x8fN\xfc6\xd8J\xa2\xba\x15\x9c\xc6\xf7|\x0e\xf7Nxv\xfa9\xc3\xc1\xd8\xc6L\xbbl\v\xe3V\x02uU\x91e+6\x9b\x15B\b\x92x\xe0P\x11\xb3\xad\xbc\xad\x03\xa71\xd4ME]f4\xc5\x06S\x95\b]\xff\xce\x02\xff\x87<|)\xf0\xd9\xfa\x04hc(\x8df]\xd5\xe4\xce\x03\xe4}\xa4oKjl\x87\x0fӭ\x18Z\x04\v\xa0\xac\xedp\xe1yA\xf7\x19{ʧ,\vt\x98\xda\xdc\x0f4Q\x18s\xbawċ\xcb7\xd6\xeaٝ)\xe77\xefxv\xf2\t\xc7\a\xc7|\xf6\xd9O\xfe\xe57\xdf\xfc\xe5c\x03\xf0\xd8\x00<>>h\x02\xd8\xd5ًn\xaa=>y\xc2\xde\xfe\xe1\xff\xc2\x18\xf3ǭi\xc8z9\xb3\xc5[k\xb4\xae\xa9k\x1b\xf6cv\xc1B\xb3\r\x131\xbb\xff3\xce`g7\xb5̝\fRJ|O\x11z[-\xbe\xe7\xf9Dqb-y\x85&\f\"[|\u0098l\xb3\xe6\xe6\xea-\x8b\xf9\xddNT\xe8\x0e\xec\xff^U\x93R9f\xb3FHώ/f\xcbޯ\xeb\n\xd3\xd4\xf4\xd2>\x9e\x17\xb0\x98O)ʜ$\x1d\xd9\xc6\xc1\xf1\a\xb4\xfb\x01ƅ\xb8\x96UEU\x95\xce\xc8\xc6#\xf0\xad3_\xd34\xac\xd7s\xaa*'J\xfa\x84n\xa5 \x9ct\xd0\x18(뜢\xc8Y\xafW\xacW3\xa4\xae\xe9\xa7)Q<Dy>\x9e\xf2)\xea\x9a\xc5\xf2\x86Fk\xf6\xf7\x0e\xf8\xd1\xf7\u007f\xc2\xc1\xfe!Ui}\x16|?\xb4\x05\xc5\xfd\u00adG\xa4+8\xcb՜\x8b\x8bs\u07bey\xc1\xc5\xd597\xb3[\xf2\xb2x\x80\xf5\xb7\a\xb4|\xaf\xf8\x8f\xc7{|r\xf6\t\xa7G\xa7\xf6yE\t\xb1Ӱ\a\x81նK\xe7\xc4gɓ\x86,Ϙ\xcfg\x04~\xc0x8\xc6sh\x8e\x1f\x86xʷʋ\x1d\x0f}v\x98\xe5\xd6P\xd0Bҫ͒_\u007f\xfd+~\xf6\x8b?\xe3\xea\xfa\x9cF\xd7\x16^߹̬jC\xd2Km\xb2]?\x19\x10\xc71RZ\x12Y\x10\x84\xec\x8d\xf7\x19\x8f&\x04AHV\xe4,g\v\x84\xd1\xf4C\xcf:\xd6\t\xf0\x83\x98\xc1h\x8f\xb47D9yk\x91\xe7\x14EFUU4\xba\xa6\xccs\xaa\xaa$M\xfb\x04Qԭnꪢ(s\xaa2g\xb5\\\xf0\xee\xe25\xaf\xcf_1_.\xdc4o\xaf\x1d\x1b\xddk>(\xfc\xc6q^\"\xdf#\xf0T7\xf1\xf6z#N\x0e\x9fq\xb0\u007f␒\x9d\x02\xe9\x9a\xe8\xaa,(\x8a\x1c\xa3\x1b\xa2(}(w\x14\xbb\xca\x01ی\xd6\xd9\x1a\x9d-1e\x06ζ\xf7\xffӇ~Ϫ[\tA\"\x14\xc2\x13\x94\xba\xb1\x92Ax\x10Ld\x1e\xf8\nl\x97\x03\xba\xfbo\x97\xa0ij\xca\xda\xcac\x85[\x83\x04~h\u05cd\x8dU\xf7\xb4\xe7հ7d\xd2_p=\xbbs\xabL\xc5b\xbd\xe4~v\xc7d\xcf\xe3\xf4\xf8\xe9?\xdal\x16\xff\xf8\xfc\xfc\xc5\xff\xf0\xef\xde\xe6<>\x1e\x1b\x80\xbf\x9d\v\x80\x9d=\xf9\xae\x8b\x9d!\xed\xf5y\xf6쳞\xef\xfb\xff'\xed\xa6\xf5\xd5rNYd\x18\xddt\x1e\xf9\x9d\\g\xd7\xeb\xcf\xec\xec\xf5\xd8\x12{\xb4\xfb\xf3\xf7aO)\x04\x9eR\x84\xbe\a\xce3^)\x9f0Nm\x81\x03|%H\xd3>\xca\xf7Y\xccﹿ\xbd \xef&B\xb1\x03_\xef@\n\xee\t)\xa7\xc1\xc79\xa5I\xa1\xb6\xc5\xdf\xed\x1a\xb5\xae\xf1=\x8f0NXm\xd6\xdcO\xefl<\xad\xe7wMM]\x15\x04QLc\x1a\xea\xba\xc1肼\xcci\x9a\x86Z7\x84Ad\x8f2\xad\xc9\xf3\rZ7$\xbd!\x9e\x17\xb8Hc\xbb\xe3\xac늪,\xc8\xf3\r\xcbՒ\"_\x13y\xca\xc2\xeaI\xdf\x12邈\xda\xc0\xcd\xdd\rUS\xb3?\xd9\xe3'?\xfc#\x0e\xf7\x0e\xad\xf3\xa2\xae\x11(\x9a\xc6\x16\x17)\xa5UH\xb4L\xff\xa6f\xbe\x98s~\xfe\x8a\xf3\xf3W\\^_r\xbf\xb8\xa7\xac\xab\xf7\x0e~\xf1\xc1\xe4\xef)\x8f\xbd\xc9\x01OO\x9fq|xL?\xed\xd9\x10\x9c(!\b]\xf1wk\x10\xa5l\x11\xd5\xc6\xca\x157ٚ4\xb1\xf1\xb2B\bK\xf6\xf3\xec\x1a\xc2y\xb4w.\x84\x1d\xe1\xcbM\xaa\xd2A\xe4\xd7w\xd7\xfc\xf9\xcf\xfe5\xbf\xfe\xed\xcfY\xaef-\x13`[@Z^\x82\x1f\xd2\xef\r\x19\xf4G\xa4I\x8f0\b\x1c\xcfC0\x1cL8:8&\x8acʢd6\x9f\xa2uM\xec+\x92 F)\xfby$ɀ\xc1h\x8f0\x8e\xd1\xdaPd\x1b\xf2\"\xa3*\xcb.\x9b\xa1i\xb4\xfb\xda^\xf7\x9a\x11XǼ,c\xb3Yru\xf5\x967篹\x9b\xddS7\x1a)EW\xf8\x9b\x8f\x14\xfe\xf6\x11(I\x12\xf8xJ\x81\xd6Dq\x8f\xfd\xfdS\x0e\xf7\xcfH\xe2ރ&\xb9\x9d\x9e\xad\xffAI\x99\xe7\xd6\xf6Z\xc8ΫA`\xac\x05\xaf\x8d٤\xa9\n\xcalA\x95\xadh\x9c<\xf7\x0f1\x17\xfa\x83\x8a\xbf1\xe4M\xd3!8\x9e\x14\xf8R!\x01\xb5k\xbb\xbc\x1b\xe3\xbb\xf3J4\xba\x8b\x87\xc68\xc9a+3t\xd7bQ\x15\xa4\x89\xe8\x94\x02\xbe\x1fRT9U]\x10\x06\x91M\xb04V\xde9\x19\x8c\x98\xaf\x96\x14u\x89\x92\x8aFk\xae\xee\xaf\xd8\x1f\xef\xa3L\xc5ӧ\xdf\xfb\x93\xf9\xe2\xfe\u007fX-g\x8fG\xffc\x03\xf0\xf8\xd8\x16\xe06ǜ\xce\xdd\xeb\xec\xf4)\xc3\xf1\xde\u007fa\x8c9\x03\xa8ʜ\xcdr\xee\xf6˵\xb3\x8f\xad;\xa9\x16\xbby\xf5\xad\x8bَ4I;\xf8\xf3\x03\xe8\xd3\x15\xff\xc8\xf7\x90\b\x1a\xd3 \x95O\x14\xa7Da\x8c\x17\x84TN\x0f\xbe^/ȋ\x9c\xf9\xec\x16\xddTH\xa1:.A\xe7J\xf6\x9e\u00a0u髚\xc6\xca\xe8v\xdc̴іE\xae5\x9e\x94\x04ADY\xd5\xccW\v\x84T\x84A\xd2-\xc5-\xd2\xd1\xe0#)\x8a\x1c)\x94\xdb\xe1j\x9a\xba\xea\\\xf6\x1ac\x9b\"0Dq\x0f!\xadOy\xcbM(ˌ\"߰^\xafX,g\xa05\xbd8\"\x89S\x82\xa8oe\x8b\x9eG\xed\xe4Ly\x9e3\x1e\x8d\xf9\xf1\xf7\u007f\xca\xc1\xe4\x80<\xdb؆\x050\xba\xb2\x1c\x89ҳ\xe4\xbbPb\f\x14E\xc1tz\xc7\xf9\xdbW\\\\\xbe\xe1\xf2\xf6\x9a\xd9j\xee\x9a\x06\xf1\xe1RW\xec\x14\u007f/\xe0\xf8\xf0\x88\xb3\xe3'\x1c\xed\x1f\xd2O\a$qJ\x18[\x83\x9e \b\x90\xcas͆\xec&\xff\xba\xb6\xec\xec\xe1`謆5\x9e\xe7\xe1\xfbθg\x17\xa1\x01\x17ck\xba\xe2/\x94\x8d\xc5}\xfe\xfc;\xfe\xec/\xffG^\xbfyaͤZS*#\xba\x95\x95T\x8a8N\x19\xf4G\xf4\xd3\x01iҳ\x99\x15\x1a\xc20d\u007f\xff\x88\xf1p\x8cֆ\xc5rNY\x95\x04R0\x88C\x97g Q\xbeO\u007f`\xa5}R*\xca<'w\x9e\xfduS\x93g\x19\x8d\xae\xf1\xfc\x80(\x8el\xbcr]\xa3\xda\xd5@\x91\x91m\xd6\xdc\xdd^\xf1\xe6\xfc%W\xb7\xd7\x14\xed\xf3\x15\x82F74\xcd\xc7w\xfc\xed#\xf4\x14I\xe8\xa3\x1c<?\x18L8=\xf9\x9c\xd1h\xafS\x98\xbc\x1f\xf8c\x91(\x9bN)\xa4@4[ݽU\xb1\bLSR\x15k\xca͒:[\xa1\x9d\xc3d\xeb\xf0\xf9\xefH\x12\xda\xee\n?87l\xc1w\x1b\u007f\xd4\xce\xd5Uj禹\xf3\xf4\r\x1f\xbe\x16#\f\x0f\x19(.\x9b\xc2=עry!\x0e5\xf2\x94\xf5h\xa8\xeb\x1a\xdd4\x9du\xb7\x12\x92$L\x19\xa4=n\x1c!PI\xc9\xddbJ\x96g\x04JQ(\xf3\x9f$q\xf2_\xae\x96\xb3\xcb\xc7S\xff\xb1\x01x|`=\xf6\x85\xdc\u07b8\xad\xe9\xcf\xc9\xd9'\xb1R\xeaOZ\xa8v\xb5ZP\x96\x99#\xf59\xe9P]m'il\a\xafw\x8cL:\x9b_\x03\xba\xf98\xd3YIA\xe8{\x96D\xa5-<\x1f\xc5)Q\x9c\x10\x85\t\r\xb6Ho6K\x9b\xea\xd6T\bg\"b:\xabT\xdc\xc4\xf5\xf0\xe0j=\xf0\x9b\xb6\xc91ۃ\xb2\xaa+ʪ@\xba\xbf\x13ʚ\f\xe5y\x86@t\xeep\x06k\x9dZfk\x94o\x93\u074c\xc6y\x05\x88\xee@\xf6\xbd\x00S\xe4()mn\xbc\xe7\xdb\xe3̑ȪjMY\x16\xd4U\xc9f\xb3b\xb9Z\xe1)\xc5 \xb5z\xf9 J݊@\x91W5\xb7\xd7\xd7\x14E\xce`0\xe4\xc7\xdf\xff\t\x87{\xfb\xe4\xf9\xc6\x15Z\xb1\xd5=c}\xec\xa5+\xc4e\x991\xbd\xbf\xe1\xcd\xeb\x17\\^\x9fs5\xbd\xe9\xf4\xe6\x1f\x9d\xfaD\xbb\a0DQ\xc4\xf1\xc1\t'\a'\x1c\xec\x1f0\xe8\rH\xe2\x1eQ\x12\x13\xc5)A\x10\xb9\xecx\x89\xf2\xa4Kĳ\x86B\xc2\x18\x92(\x05a\x99\xedJ\xfa\xf8\xbe\xb7%e\x9a\xed\x04\xdb\"J\xad\xe7\x84\x01\xee\xeeo\xf9\xd9/\xff\x9c_\xff\xf6\xe7\xcc\x16w\xce\xc9o\x87\n\xe7\x9ez\x18\xc5\xf4\xd2AW\xf8\xe38\xe9\x8cl\x86\xc3\x01\x87\xfbG\x04aDY\x95\x14\xa5\xb5\x9c\xee\x87>I\xe09[_\x8f \x8c\xe9\rF$IjW5\xab9e^XyfS\xb1Z\xcc1X\x17\xc30\x8a)˂l\xb3Fz\x1e\xba\xb1F>\xcbŔ\xf3\xb7\xafxw\xf9\x96\xc5fE\xe3\xb8\vZk*'U\xfb}\xb8[\xe8\xdb\xe7$1x\x9e\xc7\xfe\xde)ǇO\x89\x93\xfeC\xbf\xfd\x8e\x13\xd1\xdeO\xf6\x1b\xd8L\t\xd1A\xe6\b촿\x99Ql\x964\x95]\rt\xb1\xbe\xbb\xdf\xd0l9?\x0foI\xf3q\x92\xd0_\xc1\x01\xb0\xa5\u007f\xfb\x1djc\xa8L\x83'\xa5=+\xba\xa4\xc9\x0f\u007fF{\x1f\xb5\x8e\x93\xa6U\n`\t\xbfU]Q\xd5\x15\x81\x1f:O\x12\x1b8Ԯ\xed<\xcf\xef\xd2\x19\x03?d\x98\x0e\x98.fn\xb5\xa2(\xab\x82\xe9r\xca\xf1\xfe1\xeb*\v\xa4T\xff\x1c\xf8\xdf?\x9e\xfc\x8f\r\xc0\xdfy\xf8_tN`\xa2;\x9c\x95R<y\xf2\x8c\xc1`\xf8π\x03!\xa0\xc826˹\xf56\xd7\xd6Ʒnj\xcb(\xde\xd9᙭\x93H\a\xf7[-\xb4\xa6\xf9ȁ(\x85p\x19\xe2v\x9aF\xda\xc9.q;s)\x05\xab\xe5\x8a\xd5j\xc1z\xbd\xa4i\xaa\x8e\x00f\x8c\xc1H\xf7\x1a\x90[\xe3\x17\xc1C\u007f~!\x10\xdat\a\xb2\x92\xf6PXm6\x80!\x0e\x02\xa4\x84\xb2\xd2\b\xd3\xe0{\x1ei\x92\xe0\a\x81=d*\xeb\xd3o\x8cƓ\x9e#p\x95\x88F\xa0\x84\xb2\x10\xac\x11\xd4M\x856\r\x8dh\xf3\x01,\xecm\xcd_nY.\xa6\x9d\x8e\xba,+뜗\xa4v\xf2w*\x83ҥ\xc7-\x96\v\xb4\xd1\f\xfbc~\xfc\xfd\x1fstpd\x9b\x87\xbaq\fr\xb7\xe3\x15\x820\b\t\x82\b\xe3\xc8~w\xb7W\xbc}\xf3\x9c\xcb\xeb\vn\xe6\xf7\x14e\xf6\xfb\xcfp\xb7[N\xa2\x98\xd3\xc33\x8e\xf7\x0f\xd9\xdf;`\xd0\x1b\x92$=\xa2(&Mz\xf8A`\xd7'ʮ\x1a\x10\x06\xdd\xd8\xe6FI\x81\xf2Z.\x82\r%\xea\xa6\xfe\xf7\xd6\v\xad\u07fb\xd8iľ}\xf1\r\xff\xe6\xcf\xff\x15o\u07fe\xb4dR)\x9c\xc2bK\x14\xf1\xa4G\x9c\xa4Nz\xd8#MR\x02\xc7\xf0\x97\xcac\u007f|\xc0do\x0f!\xb0L|c\x88\x82\x80H\t\x02%\b\xc2\bߏ\x88\x92\x94\xb47DHI\x9e\xad\xc8\xd7\x1b\x1am3+\xaa\xaad>\xbb\a\x01\xa3\xf1\x01Q\x92\x92mV\xac\x97s\x84R\xd6\xe2\xba,\xb8\xbf\xbb\xe2͛\xe7\\\xdf\xddPT\x96\xffb\x1c\xc1\xae\xd5\xc9\xff\xbeG\x14\xf8$\x81\x8f\xc0\x10G)'G\x9f0\x99\x1c[w\xcb\xddy\xd8\x11\x1e\xa5R\x0e5\xd1\xe0<+\xda\x1bͦ#\x96\x14\xcb)\xf9z\x86\xae\vG\xec\x15\x0f\x1a\xa8\xf6\x1bJ\xe9Y+k)1uES\x97\u007fe\x91\xff\xc3xD\xae\xb9s\xf7~(\x15J\bja\x9bӼ\xa9?j\r\xde\xfa\aHa\xbd\x01\x8c\xda\"=\xb6A\xa9)\xaa\x9cЏ:\xb4RI\x1fm\x1a\xaa\xa6\"46x\xc8\xca]=\xfaI\x8f$\x8aY\xe5\x1b\xa4g\t\x9a\xf3՜\x93\xfd\x13B\xa5\xf0\xfd\xe0O\x80\xff\x06x\f\bxl\x00\xfe\xaeO\xff\xf2\xc1Ph\xb4f\xb2\u007f\xc8\xd1\xf1\x99RJ\xfd\x17\x96\x89ݰZ\xce(\xf3\x8d\x9b(Z\tS\xf3\xc1\xa0`S\xb9\xb6n_-\xcc\xde\xe8\xe6#Ȣ \xf4\x14\x81\xef\xec]\x85$\x8cz$\xbd\x11QlY؛\xf5\x8a\xe5b\xea\x8a\u007f\xdd\x1d|F\xd3M\rJl\rDL\xc7'h\xf7\xd3\n\x83@ץ\r!\x91\x82\xa2,Xgk\xb46\x16y\x10\x82\xbc(i\x90\xf4\xe2\x808\f\x90B\xd2hC]\x15\x9d\x9e\xdbw\xac\xf2F\xd7\x161\x80\x8e\xe7 \xdc\x02V\xeb\xda\xed\u007f-\x9aQ\xaes\xe6\xb3;\xf2le\x8dM\x80\xaan\xf0<\x8f~\x92\x12G\xae\xf8\v\xc9|\xb5d\xb6\xb0IlQ\x14s\xb0\u007f\xc8\x0f\xbf\xf8\x11\xc7\a\xc7\xd6\x12\xb7\xb1\x064[\xf9\xa2\r\xdd\xf1\xfd\x80\xa6\xd1d\x9b%W\x17\xef8\u007f\xf7\x92\x8b\xbb+\xa6\x8b)\x95\xb3\r\xde\xf5\xc3\xff\x18\xdb?NRN\x0fO9\x9a\xec\xb37\xdec8\x18\x91$֛?IS\x17\x90c\xb3\x05\xa4{\xbf\x9b\xda2\xf8\xbd\x1d\u03816\x06\xdf\xf7\xf1=\xef\x03C\xa1v\x05\xb43\xb32_\xcc\xf8\xcb_\xfe[\xfe\xe2g\xff\x96\xf9\xe2\xdeM\xa5\xaaS\x92\xb4\xcf8\f#\xd2t@\x9c\xa4\xa4q\x8f$\xee\xe1y\xb6\x19\vÐÃc\xfa\xfd!\xba\xb1\xc6GRH\x02\xdf\xc6\xd2\xfa\x9e]\xed\xc4Qb\x91\xa5$E7\x9a\"_S\x15v\xea7\xc6P\xe59\xf3\xc5=\x06\x98L\x8e\x89\x93ԙ1\xad@H\x17\xdaTss\xf9\x96\x17\xaf\xbe\xe1~>\xb7\x9e\xf9\xeeuյ\xe6\xaf*\xfd\x02\x88C\x9fķ\xbc\x89A\u007f\xc2\xc9ѧ\f\xfa\x13\x94\x8bе\xf7\x86\xect\xf2\x1d\xc1\xb2i0\x8dݑ7l\x9d\x03\x8b\xf5\x8clqG\x9d\xaf\xbb\"\xff1\x94_\b\x89pi\x84\x06\x8b\x16\x98\xf7\x8a\xf2_\xb5\n\x10R:\a?\xfd\xc1u\xa4\xcd64\xc8B\xf2\xf6\x1a\xf5\x10xR\xe2\t\xc1\xa6\xae\xa9>\x12m\xdc\xe6\x0f\b\x01h\x85qFA\x96H ȋ\r\xfdtԱ\xfb\x95R\xe8\xbaFk\xd7\x04\xf8\x91U\x11\x18\x88\u0098ao\xc0:߸\f\x11EUY\xa42\xf4<\xd28>\x03\xf1O\xc1\xfc\xdf\x1f+\xc0c\x03\xf0wv\xfa\xefLqvd\u007fA\x10\xf2\xe4\x93OI\xd2\xde\x1f#ė\xc6@\x9e\xad\xad)MSw\x13\\\xed\xfe\xbb\xcd\n\x11;\xcc\xden\xcas\u007fV\u007f$\x89K\x00\xbeR\x04\xbe\xdf\xf9\x8b\x87Q\x8f\xfe`D\x18\xc5h\xad\xc97\x1b\x16\x8b{V\xeb\x05M\xe3\xa0̝\xd0 !D\a\x95Z\xf6{k\"\xe4[\xed\xbb灐\xdd4\b\x86\xb2,X\x17\x85\x9bR=Bߧ(+6eI\x1c'āM\x82\xd3\bjǲ\xd7چ\f)\xcfG;v\xbf\xf4U\xb7ް\x10\xba\xec$^ƀ\xaek\x16\x8b{֫\x05Z7v\xba1P\xd65JJ\x06\x8eT\xd7\x16\xff\xbb\xf9\x8c\xdb\xe9-yQ\x10G\x11G\a'\xfc\xe8{?b2\x9a\xd045\x8d\xd6v\xf5\xe0,U\x95\x92$\x89-\xcceY\xb1\\-\xb8|\xf7\x86\xcb\xcb7\\\xdc]3_Ωw$X\xbf\x03\xdc\xc5\x18H\x93\x1e'\a'\xec\xbb4\xbc\xe1pB\x9a\xf6\x89\x93\x94$I\xbb4<\xe9\n}\xab\xdbo\xe1X\x83\xb1\xf9\x04R\x10\x86\xa1\x8b\x8eu\x8c\xfe6\xfc\xc55\x8c\xadUo\xd5Լy\xf3\x82\u007f\xfdg\xff\x8ao_|EY\x94(o\xebN\xd7\xfe;\xa5|\x928%v\xcdR\x1a\xf7\b\xa3\xc8Z\x0e\x1bM\xbf?\xe0`\xff\x88(\x8c\xba\xe8Z\xa9\x14\xa1R$\xa1%*\x86QL\x18%\xee\xdf\x054\xb55\xac\xb2\x06S\n\x94\xa0\\g\xd6=R*&{\x87DI\x8f\xba\xae\xecʥ\xa9е\xe5\xbd\xdc\xde\\XC\x9f\xf9\x8cF\xb7\x85\xbf~P\xf8~w\xfd\x14ľG\xe2\xfbH!\x18\x8d\xf68=\xfe\xdcf?(\xd5M\xbc-\xc2\xe2\xf9V\x05b\x9c\xa3\xa3m\n\x1c\xa2V\x95l\xe6\xf7l\xe67\x94\xd9\xd2\xd9/\xff\x01\x89\x81ue\x9b\xe1\x8f\xfa=\xfe\x9e箼n\xd7^W\xc5V\xc2k\x13:\xe8\x14\xb5\xef\x91\x15\xdb\xdf+ R\x16\x11X\xd7\x15E\xddlm\x87;\xdbpg\x0e\xd6\x18\xc0s\xd1\xc5\xd6\xfa\xbb\xa8lR\xa6\xe5\xfcئ\xb3\xaa\x85{>\x15\x81\x17v\xbcF%<\x86逛\xe9-\xdahB/\xa4\x97\xf6,\"e$\xc3\xde\b\xe5y\u007f\xd2\xd4\xd5c\x03\xf0\xd8\x00\xfc\x1d\x9f\xfe\xdf; \x0e\x0e\x8e\xd9\xdf?FI\xf5_I!)\xab\x92\xd5\xfc\xde1\xff5\xc6hjm\tH\xad|\xac\x85\xfbq\x93\xb0q00X\x1bԏE\x9a*)\t}\xaf\xd3\x02\xfbAB\x9a\x0e\b\x82\xd0f\x80\xe7\x19\xebՂ\xe5j\x8e\x8d\xf2\x14\x1fr\aZ\x02ZK$sl\u007f\x9b\xb9n\x11\x80\xb2.l\x06\x81#\xfbeea\xe1I\xdf#\xf4=\xf2\xaa\"/K\x94\xe7\xd3KR\xc7LWԵ\x9d\xfc[\xcfr\xdf\x0f\xc0\xd0ِ\xda\xf7n\xcb?h\x8dY\xda}\u007f\xb6^\x90mV\x9df\xdd`(*K\xc4\xea'=\xcbX\x8fb\x8cT\\\xdf\xdfqswCY\x95$q\xc2g\x9f|\xc1O~\xf0G\xf4\x92^\xf7\xf3ꪴ\xa0\xb9\x90\x04\x9eO\x9a\xf6\xf0\xfc\x80<ϘNﹺx\xcb\xc5\xd5\x1b\xae\xeeoX\xac\x96\x1d\xe2b~\aT\xdb~\x1a\xbd4\xe5h\xef\x84Qo\xc0`0b<\u07b7\xa9|]\xf1\x0f\\A\x92\xae\x89\xd1]sע\x1cuS\xe3)E\x10\x06\x1d\xe4l\xcc\xf6\xf3n\roZӥ\xd5j\xc9/~\xfd\x17\xfcۿ\xf8\x1f\xb8\xb9\xbbq\x05F<\x8c\xbb\x91\x10\xfa\x91}..\x1a8\x89\x1d\x12\xe1\xbej2\xde\xe7`\xef\xc0\x16Ħv0\xb9 \n\x03\xd20\xc4s\x16\xc3a\x14\xe1\xf9\xd6E\xb2v\xbar+\x13\xb3\xd7OU\x14\xacVs\xa4\xe71\x1a\x1f\x12\xa7=\xc0\x90\xe7\x1b\xd6+\x8b<Ue\xc1\xcd\xf5;\xce/\xde2]\xcc\xedJKk\x97\xe9\xf0W\x8b\xca\x04\xb6\xf8\xc7n\xf2\x1f\x0eƶ\xf8\xa7\x03\xbbFr\x1e\x17RY.\x8c\xef\x87x\x81\xef\xecp5\xdaE\xea\xd6u\xcdjz\xc3\xe2\xf6\x1d\xc5z\xd6eV\x88?\x88\xd8g\xdek\xc1\u007f\xdfW\x9a\a\x17\x8a\xd1\r\xba\xd4.\xc2\xfb\x81\xd1/\x1ak\xc3\xdc&\x016\x1fs4l\t\xb9R\x92:n̦\xaa\xec\nq\xe7\x1ej\xb4\xa1\xd25\xbe[M\x1a\x87\xda5MCQ\xe6\xa4Q\x9f\x06\x1bF\xa5\x1c\xb7Ē-k<\xcf\xefLMҸG?MXgk\x8e&\a\x9c\x1d\x9cY\x931`\xdc\x1b\x10\x06\xe1?\xd9\xd4\xd5O\x81_<V\x82\xc7\x06\xe0\xef\xde\xfc/Z\xcb\xdfv\xfa\xd7\xc4q\xc2\xe9\x93O\b\x82\xf0\x1fK\xa5\xfe1\x18\xb2\xf5\x82\xf5jn\xa7\xabַ\xbby\x18X\xf2P\xd3oo\xe2\xf6\xc6\xec\xc2Mv\x0e\x1c)\x04\xa1\xef\xe39\x177/\x88\x88\xa2\x14\xe5\xf9\x9d\xa6:\xdbX\x86|\xdd4\x9d\xbf\xc0n\xe1\xf2\xa4e\xf3\xb7\x9e\xf18\xd8_)\xcfZ\xbe\nI\xa3\xad\xa7|\xa3\xed\xe1Q\x94\xf6\xc0\t|\x9f\xd0\xf7(˚\xac\xb0n{\xa3\xfe\x90\xc0\xf7;\xeb\u07b6\xf8릶27%\x1d\xe9\xb1Az\x9e\xdd9\xee66Fw\x85\"\xcfV\x14\xf9\xe6\xc1qZ֖\x031L\xfb\xf4{C\xa2$A#\xb8\xba\xbb\xe5\xfa\xf6\xda\xea˓\x1e\xdf\xfb\xec{\xfcя\xfe\x1e\xa1\x1fP\x14\x99\xddKוKJ\xf3\b\x82\x90~\xaf\x87\xf2}6\x9b\r\xb7\xb7W\\\xbf{\xcb\xd5\xcd\x05\x17w\u05ec\xb2Ճd\xb4߇\xff\xa4I\x8f\x93\xfd#zqB\xbf\xd7g\xff\xe0\xd0z,\x04\x11\x91\v\xe5Q\x9e\xea\xc8f\x1a\xb7\xf61\xf63\xac\x9d\xe9P\xe0\a]\xb1j\xaf\x87V\xab\xde\xec\x98\xfc\x18\xa3\xb9\xb88\xe7_\xff\xdb\xff\x9e_\xfd\xf6\xe7dE\xee\"\x9e\x1f\x9aO)\xe5\x91\xc6\tI: \x8e\xd2.-\xb0E{<\xcf\xe3\xf0\xe0\x98\xd1p\xe4P\x0e\xd1)\r\xd2(&\x89c\xeb)\xe1\a\x04Qdm\x90]\x18\xcfn\xe0\x931\x9a\"߰ZΑR2\x18\xed\x93$}\x84\x94\xd6G\xff\xfe\x96\xaa*ȳ\x8c\xab\xab\xb7\\\\_\xb2ڬ,\xa1\xb3\xa9i\xea\x06\xfd\a\xdek\xa1\xa7\xf0\x95\xf5e\b\xfc\x88\xa3\xc3OHӡ-nu\x8dR\xbem\xb6\xa4M%\f\x82\xc0\xaa<\x1a'\xaf5\xb0^θ\xbbx\xc1zvCS\x97;\xb9\x1d\x8e/a\xfeP;^\x1e\xac\x85\x1e\xb4\x06;\x84\xde\x1d\x82\b\x98\xe6w^G\xec\xe8p\x84\x10\b\x03ڽ3\xe6#>\x82R\bb\xcf\x03cXV\x05eݸ\x00!㊹\x955\x86\x81\xda>K!\xc8\xcb\ril#\x9a%\x96cS\xbbF\xb7\xaa+<\xe5wא\xe7\x05\x8c{c6ْAң\xe7Ȟ\x00q\xe03\xea\r\xd8lV\xff\x02\xf8_=V\x83\xc7\x06\xe0\xef\x1c\xfc\xdfN\xffb\xc7%\xef\xe8\xf8\x84\xd1h\x8cT\xf2O\xa5T\xe4\xf9\x9a\xf9\xf4\x96\xaa\xc8\xed\xd4\xd4:\xf8\U0006144906&V\u05faK\xe6j\x9a\x8f\x1f\x8f\x81\xa7\xf0<\x85\xc6ڕ\x06\x91\x9d\xecl\x81\xadɳ5\xcb\xd5̮\x19`\xe7Pk\xd3\xe8l\xf1/kK\xdc\xf2\xa4\xe8<\xe5\x95\x17t\x87\xbb\x95\t\xd5\x14EAVX\xa2S\x10\xf8\x84\x9eG^\xd6\xe4E\x81\x90\x8aQ\u007fD\x18\x06\x9d\x8ba\xe9\xe0a\xe3\x0e=\xe9v\xa6\xba\xb1\xb0\xa5\xda1\xb2\xb1\xc65\x16\x86,\xf2\rUUP\x969\xba=\x1c\rTZS7\x9a^\x9c2\x1e\x8eI\xd3>\x956\\\xdd^q}wMUU\xa4I\xca\xf7?\xff\x01?\xf9\xe1O\x89|\x9f\xc5bf\xa7\x9e\xaa@\nI\x14\xa5\xa4\xbd\x1e\xbd\xde\x00Oy,V\v\xae.\xdfqs}\xc9\xdd\xdd\x15oo/\xd9d\xeb\a\x05\xc0\xfc\x9e\xe2\xdf\xef\r8\x9a\x1c\x10\x87!q\x92pxxB\xbf7\xb4V\xbdQ\x84\xe7\a(\xcfN\xa3֦UwH\x8c\x14\xb8\xc6\xccJ\ue527\x1e\xa0H]\xf1ot\xa7PȲ\x8co\xbf\xfd-\xff\xe6\xcf\xff\x15\xaf\xde<\xa7q~\f\xf6-\x17\x9d7|\x10\x84\fz#\xfa\xfd\x81# \xdaT\xbd6\xa0&Iz\x1c\x1e\x9c\x90D1\xc6h\x8b\xa4\x84\x11\x9e\xf2\x88B\x1b.$\xb0;b\xcf\xf3@\xb6k\x8bf\xa7\x01\xb59\u05eb͚\xf9\xec\x0e\xad\x1b[\xfc\xd3\x01\x9e\xe7\xb3\\ι\xbb\xbd$\xcf\u05ecV\v\xae\xae/\xb8\xbd\xbfe\x9d\xad\x1d\x17\xc3\xf21\xfe*\xb8\xbd]Q\x05J\xe1)+\x05\xb5\xe6JG\x8c\x86\xfb\xd6\x12Xk\xe2\xc4\x1a]a\f\x9e\x1f\x10\x04\xc1\x0e\xf4.)\xb3\rw\x97\xaf\xb8{\xf7\x822_w\xfc\x8f\xb6\xb9`'\xf2\xf8\x0f\x9d\xff\xdb{\xc9|d\xcf\xff\xf0~\xe3\x01ǥ\x8d\xec\xeb\xdcC\xcdV\xf6\xdbf\x88\bGv1;\xd6`\xecH@\xa5S\x9d$\xbe\x0f\x02n7+J\x17 \xd6\xc6\x0e[\x83+\x83\xea\xd2\x0f\x05Ei\xe5\x80JY\xe4\xd0#\xa0)s\x97\bj\xf9\x00\xd25YB\bz\xe9\x10\xef\xce\xe3vz\xc9\xf1\xc1I\xb7\xbe\xf2\x95\xe2p\xbcϻ\xebw\xff)\xf0_\x02\xf3ǚ\xf0\xd8\x00\xfc\x9d\x82\xfe\x1f\xf8\xdf\x1bM\xaf7\xe4\xe4\xf4\x19\x9e\xe7\u007f\xe9y\xfe\xff\xd2\x18\xcd\xec\xf6\x8a\xd5b\x8an*\v\xe9\xef\x14\xff]\xf2N\xcb\"oӽ\x8c\x83\xca?\xf4\xe4\x05OIB\xdfN\x8b\xca\v\b\u0084\xd0\x0f-t\xd84\x14ņ\xf5za\xa7\xcb\xd6Jxg\xef\xdf\xca݊\xba\x06\f\x9e\x92(\xcfsv\xb4\x81+>v\x1a\xaf\xabҢ\tyiw\x81\x81\x8f\xaf\x14EU\x91\x15v\x82\x1a\x0f\x86DQ\b\xda\x1e\xb6\xad\xe9K\v\xe9+\xcf&\x0f\xea\xa6qZ~\xcf\xeaͷ3\x8f\xb5bݬ\xac\xd3ZU\xd2hݽGZ[\xc6~/I\xd8\x1f\xd9(٢*\xb9\xbc\xb9\xe6\xe6\ue6b2\xaeH\xe3\x1e?\xf8\xf2G\xfc\xe4\a?!\n\x03\xeen\xaf\x98/f.\xe8F\x11'\tI\x9av\xc5\u007f6\x9fr~\xfe\x86\xe9\xdd\r\xf3\xc5=\xe7wW\xac\xb35\xbbX\xb4\x11\xbf{\xe2\x1b\xf4\x87\x1cN\xf6\x89\xbc\x80(J8=y\xcah4\xc6S\x1eA\x18⇡5\xee\x11-\x84o\x89u\x02+\xbd\xac\x1b˺\x0e\x03\x1f\xb1\xbbFr;\u007f\xe3\xe0q!@\x18\xc1\xfd\xfd\x1d?\xfbş\xf1\xf3_\xfc[n\xefo\x9d3\xadr\xcf\xd1V\x91@\x05\xf4\xfb\x03F\xc3\t\xfd\xc1Ⱥ\rz\xb6\x10\xd6\x0e\x85ٛ\xec\xb1?9\xb4v\xd0J1\x18\f\x89\xa2\x04\xdf\xf3\b\x02\x9b\xa9PW%\xd2]\x13\xedD\x8a6\x0f\xe2t\xa5\x10\xacWKfwW\x14y\xc6hrH\xda\x1b\xe0\xf9\x01\xeb\xe5\x9c\xfb\x9b\v֫\x05\x8bŌ\xdb\xfbk\xee\xa7\xf7\xac7+\xaa\xa6\xa6\xaa\x9b\x1d\t\xe3\xc7\v\xbf\x14\x828N\b\x83\b]\x97\xd6(K\x1b\xa4R\x8c\a\xfb\x1c\xee\x9d!\xdd\xe4\xde\x1b\f\tØ,\xcf\xed\xfb\xe0\xee\x8d\xf6\xfb\xafgw\\\xbe\xfe\x8a\xc5\xed;+\x8b\xfc\xc8\xda\x0e~\xf7\x0e\xc2\xec\x1a/|H\xff\xa4͎\xd8m\x00:憔[\xc5\xcd{]\x84֚\xaan\x10\xe8\x9d<\rGNm\xeb\xbd\xd9\xdaqJ\xb7.\xd3\xecp\x0e\x05ĞO/\b\x99f\x9b\xf7^WC\xd3TH\x19v\x05\xbd\xd15U]\x91D\x11\x06\x83\x87G%e\xb7\xae\xa8\x9b\x9a@\xaa\xae\U0004e0d8~:\xe4~q\xcf:[\xd3O\x06\x9d$y4\x18\xe2y^\xaf\xae\xeb\u007f\x0e\xfc\x1f\x1e\xab\xc2c\x03\xf0wf\xfaߝ\x1el\xe7\xeeqrzF\xbf?@*\xf9/\xa5\x90j\xb9\x98r\u007f{e\rm\xccC8\xaf\x8b\xf64\xfa\xc1\x82O\xbb\xa9\xa8qѢ\xdb\xed\xe7\xf6\f\n}\xdf\x11\x9d|\xfc0&\xf4#\x17\xa2b\x13\xf3Vn篵\xb6\xb1\xc2;玔\x02%-\x81\xac\xed\xe4=\x15\x10\x84\x91\x83\xff\\\xf1\xd7V\xa7]\x949\x9b\xc2\x16\xe4\xd0\xf7\xf0\x95G^V\x14U\x89@0\xea\x0fH\"\xeb\xfe&\x95\xea\xa0\u007f\xe3r\xc8-\xa1\xd02\xcd\x1b\xa7\xa7V\xca\xc6\xd3ZA\xb3\x85ŭ1Oe\x03\x91\x9aj{H\x1a;\x03\xc5a\xc0x0\xa1\xd7\x1f\xb3\xce3n\uebb9\xb9\xbf\xa3\xa8\n|/\xe0\x8bO\xbf\xe0G\xdf\xff\x11a\x10p}\xf5\x8e7o_RV%\xbd\xde\xc0\xc6Վ\x0f\x18\x0eGH)\x99\xce\xeey\xfd\xfa\x05\x8b\xe9-\xebl\xc5\xf9\xdd%\xab\xcd\xd2\x1e\xb6\x9d\x1a\x9e\x0f\xe8\xfe\xedo\x87\x83\x11\a\xa3\x03\x02\xa5\b\u0090\x93ӧ\x8c\xc7{H\xe5ᇡ+\xa8~״5\x8d\xdds\x83%c\x99\xa6\xc1S\x1e\x9e\xef=\xdc;w\xaf\xd9\x1a#\xd9\x15A\xcd\xdb\xf37\xfc\xc5\xcf\xfe\r_\u007f\xf3K\x96\xeb\x05\x88\xf7\xa4\x81\u0090\x84\t\x93\xd1!\xe3\xf1\x1e\xfd\xde\xc0q\t\x945\x17\xaaJ\xa2(\xe1\xe4\xf8\x8c\xd1pd\x1b\x8f0\"\xe9\xa5D~\x88T\x12߳\xab\x9f\xa6\xa9\xf1à\x8b\x8a\xb6\xcd`\x83q\x13\xa7p\xb2\xb4l\xb3\xe6\xf6\xe6\x82\xd5r\xc1x\xff\x90\xde`\x8c\xe7)\xd6\xcb\x19\xf7\xb7\x97\xccg\xb7,\xe63f\xcb\x19\xd3\xf9\x8c\xd5fMQ\x95\x1d\xc1\xf3\x83M\xba\xd8\xfa]x\x9eϠק\x97\x0e\x90\xc0z=gS\x97(\xa1\xe8\xa7#\xf6\xf7N\xdc\xeeZ0\x18\x8eI\x92\x14\xa7\xc5\xed\xe4}\xad\x89\xd6\xec\xea\r\xe7\xdf\xfe\x92<[ن\xe1\xbd\xe2\xff\xbb\xa7\xfc\x0f\x8d\xb6>\x00\xe2w|\x00<\x97\x14i\xed\xa3\xa5m\xa8|\x1f?\b@\b\xaa\xa6\xa1(K\xaa\xb6\t\xc6\xf2A\xf2\xa2v\xc4T\xcb\tj\xb4q\xa4}a]\x1b\x85k\x0e\xd8\xc6l\x8b\xdd~ġ\x06\xa9\x1f\xb2\xa9J\xf2\xbaz`\xe0YW\x16\xd67j\xeb1P5\x15R\nG\xe0\x94\xdd\xe7.\x84\xa0\xd65>A\xe7>(\xa5b؟p\xbf\xb8\xe1nvC?\x1dt\xefR\x14\x84xRQS\xff\xc9c\x03\xf0\xd8\x00\xfc\x1d\x9b\xfe\x1f\x9a\x81\r\x06\x03\x8e\x8eϐR\x1eH\xa9\xfeyU\x97\xdc\u07fc\xa3*\xf3N\xb2\xb5\x8d\xeb\xdc)\xfeF8V\xb2\xe8\xb2̛\x1dy\xe0\xfbCG\xe0{\xf6\xb0\x11\n?\x8c\xf1\xfd\x10\xe5\xd9@\x98\xb2\xc8Y\xad\xe7.\v\xbe\xa1j\xf4\a\xc5KJiw\x84\xda\xe0{\n\xdf\xf7?(\xfeumu\xdaEY\xb2)\n\xea\xc6\x12\xfe|\xcf#+*ʺ\xb4\f\xec\xfe\x804\xed9\x8e\xc2֫\xddƪ:\x92\x9bg\x0f\xc0\xc6\xc1\x93R)\xa4\xb2p\xb7K_\xa7*\xf3\xceF\xb8\xaa\xcb\a\x8a*!\x15\x81\xf2\xe8\xa5}\x92^\x9f\xc5z\xc9\xdd\xec\x9e\xfb\xd9=Ei\xa3\x89\xf7\xc6{|\xf9\xe9\x97\xf8J\xf1\xe6\xedk^\xbf}\xc1f\xb3\"\xf0CF\x9e\xcf\xfe\xde!\xc3\xe1\b!$\xf7\xf7w\xb6\xf8\xcf\uea6a\x82\xf3\xdbK\x16\xabeg\xae\xd2\x1e\xae\x9a\x8f\xe7ɏ\x06#\xf6'\a\x84\xca\xc3\xf7\x02N\x8e\x9f\xb2\xbfwd\x8dq\x82\x90(N\b\x82Э;4\r\x86\xb2*ݞ\xdfN\xa6\x9e\xe7\xd9\xe9\xfa#\xa43\xed\b\x82B\b\xb2͆o\xbe\xfb\x9a\x9f\xff\xf2\xcfx\xf5\xfa[6y\x86P\xea\xa1\xc6]J\x06\xbd!\a\xfbG\x8cG\xfb$\xb1\vQ\x12\xb6\xf1h\x9a\x9a\xe1p\xcc\xd9\xc9S\xe28\xb6;\xfe\xb4O\x14EV\x1a'E\x97-`\x8c\x85\u007f\xa5\xf4;\xe3\x1bKV\xb4(\x8cp\tqE\xbe\xe1\xf2\xddKf\xf77L\x0eN\x19\f\xf7\x90J\xb2Z͙\xdd\xdd0\xbd\xbfa6\xbbg\x95\xad\x98Χ,Vs\x8a\xa2\xb4\x11\xd6\xe6\xa3\vp\x8b\x8e\tI\x9a\xa4\fzC\xa2\xd0J\xd2\xf2lEY\xe4(\xe9\xd1K\x86\f\xfbc|eU\t\x93\xfd\x03\xe2$\xb5\u03781\x84Ahe\x94\xaeɘ\xdf]\xf1曟S\xe5\xeb\a\xe9\x96\u007f(\xc0\xff~\f\xa0p\xe1\x18\x1ak\xfa\x15\xfa>Q\x18\x12\x87!\x81\xe7\xbbfZY\xf5\x81Rvf\x17\x9aZ\x9b\xae\x01h\x1a˾\x0f=\x9f\xc0\xf30\x1a6y\xc9*\xcf\xd9\x14\xd6>\xd9\xe8\x06\x8c\xc6\x13\x02\x89\xea\xd47-\xb8\xa0\x10ԭQ\x98{\x8a\x81\xa7\x18\xc61A\xa5\xa8\x1bM^\xd9F\xa0\xd1v\xdd\x12\xa8\xed\xeb\xb7<\x8e\xed\x9boc\x9au\x87J4\x8d\x95ضSJ?\xe9\x13\x06\x11\xb7\xf7\xd7<=\xfa\xa4\xcb\xc7\b\xfd\x10\xdf\xf7\xc9\xcb\xe2K\xe0\x91\f\xf8\xd8\x00\xfc\x1d\x98\xfd\x85c>wE\xdd\x12\xae\x8e\x8eOI\xd3>R\x8a\xff\\J\x19\xaf\xe6S\x16\xb3{wX\xeb]|w+}ۉ\x18\xa5\xf5\x05pz\u007f\xf3\x00N܆\x9d\xf8.\xb1\xce\x0fc</\xec\xa4\\U\x99\xb3\\ͨj+\xcf+\xdf\xd3&\xb7)\x81\xda\xd8\xc2\xe0{V>إ\xee9o\xf4\xaa*mxKS\xbb\xe2\xaf\xf1=W\xfcˊ\xa2\xaa\xf0\xa5`\xd8\x1b\xd0\xef\xf5\x9dQ\x91\x1d\xbe\x9a6>\xd7h\x17\xc6b'\xfd\x96\xf1n\xb9\x00j\xc7\xd5\xce\x12\x96\xcaҪ\f\x9a\xba\xea\b\x8f\xc2M\x9b\x9eR\x9d\xce\u007f\xb6\\\xb0٬\x99\xcegdE\x86\x10\x82AoȧO?\xc5W\x8a\x17\xaf\x9es~\xf1\x96<\xdf\x10\x05\x11\xc3\xfe\x80\xc3\xfdCҴG\xa3\rw\xd7W\x9c\x9f\xbff\xb5\x98at\xc3\xd5\xfd5\xd3\xc5|\v\xdfvD̏\x8c\xfd\xc60\x18\f\xd9\x1b\x1f\x10\xaa\x00O)\x8e\x8eN888FJI\xe0\a$IJ\x10\x86\x1d\xe4\xdfh\x1b\xef\\\xd55q\x1c9r\xa5uU|\xd8]8\xf3\x96\xf6s7\x86\xd9|\xc6o\xbf\xfa5\xbf\xfe\xcd_p\xfe\xee5eU\"\x94\xb7-S\x02|\xcfg4\xde\xe3x\xff\x94\xc1`d=ݥ%}Y\x9b[\x1b|t|xJ\x18\xd8\xdd~\xaf? \fB\xfb98\xfbh\x8b\xd04\xdd\xfe|[\xfc\x9bN\xb1\xd0^\xf7y\xbe\xe1\xfc\xf5\xb7\xdc\xdd\\\xb0wx\xc6h\xbc\x8f\x14\x8a\xf5r\xcebv\xc7b~\xcfb9g\x93\xe5\xdcO\xa7L\xe73\n\xa7\x1a\xf9\x18\xc2.LkK\xec1\x1c\x8c\x18\xf4\x06.\x1c\xa8`\xb9^R\xe4\x1b\x84\xf2H\xe2>\xfd\xb4O\x1cŌ\xc6\x13\x8eNΈ\xa3\xa4\xfb\x9e\xadJ\x02\x04\xba\xd1L\xef.9\xff\xf6\x97T\xd9\x06\xa1\xd4\xef\x03\xf3\x1e\xfef\xf7\x9e\x91\xd6\x02\xdbs)\x8dB\u0604=\xcf\xea\xe0\xe9\xc5\tI\x10X\x02\xa7\xb4\xde\x0e]\xc2\xe2G6\nV\xfa\xe9\xd0%a\xb1|a\f:ml\x18\x96\xae\xa9u\xcdb\xb9\xe0v>\xa7\xac\x1b$\xda\xfa$\xb8\x8e\xb4mI\x94\xb4\x1e\x1b\xecp\r|)\x91Aء\x1f\x9b\xb2\xc2\x00U]Z\xb3'\xb7\x9a\xa8\x9a\x9aF\xd7\xd6&٘\xee>\xb5\xdc\x13\xbb\x06\xf0\xdc\xfa\xc7\x18\xf0\xbd\x90Qo\x8f\xeb\xe9\x05\xcb͒\xd1`\x82n\x1a\xfc `4\x18\xb1\\\xaf\x00\xfe\xf8\xb1\x01xl\x00\xfe\xd6C\xff\xb2ݏ\x89mC\xd0\xef\xdb\xe9_(\x11K\xa9\xfeE\xd34\xdc\xdd\\\xa0]\xb8̮\x95oWU\xba\xdf\xe8\x1d\xd8\xd7lY\xd6\xef\xed\xfd\x05\x10x\x1eBH\x82(\xb1\xc5\xdf\x1dJu\x9d\xb3\\\xce)\x8a\x1c\x04\x14\x95MLۭaʙ\x155\x8d\xb6q\xc1A@\x14\xa6H\x17Bc\xb4\xa1,K\x8arC\xdd\xd4l\xf2\xd2z\xf6{\x1e\x81瑕%eY\xe1{\x92Q\xaf\xef\xa2~\xb7\x16\xa4ڭ\r\xf4\x0e\x87A\xb8Кv\x95a\x8b\xbfk\x9e\x9c\xd3[]\x95h\x97Q\xd0\xca\xee\xec\xa4i\vS\x1c%(?d\xb1ZR5\r\x8b\xe5\x9c\xcc\xed:\x93$\xe5\xe4\xf0\x984\x8ays\xf1\x86\xdb\xdb+\x8a\"'\tcF\xfd!\xa7gϘL\x0e1Hnoo\xb8\xbc|g\xdd\xe8\x8c\xe6nq\xcf\xf5\xecn\xeb1o\xcc{;\xdf\x1d\xc8W\x1bҴ\xc7\xde\xf8\x80\xc8\x0f\x90B\xb0\xb7\xbf\xcf\xf1\xe1\xa9#N\xfa$\xa9%\xd2\xd9}{EU5N+o\xe1w\x1b\xe1\xeb\u007f`\x1a\xd5\xf2G\x1a\xad\x11\xae!\xba\xb9\xb9\xe1\xb7_\xfd\x82\xaf\xbe\xf9%W7\x17TM\x8d\xf0\xbc\a\xf5)\b\"\x0e\x0f\x8e9>8\xa5\x97\x0e\x9c\x01\x8e\x85\x88\x9b\xdaz\x17\x1c\x1e\x1estp\x8c\xef\aDQH\xaf\xd7\xeb\xd2\xf7\x84\x8b9\xf6\xa4r\x1e\t\rAh\xd9\xfe\xa6\x8b\x9an0Mӹ\xe8\xe5\xf9\x9a\xf3W_su\xf1\x8a\xfd\xa3gL\xf6\x8e\x90B\xb0ZNY.gl6\xd6mr\x93\xe7\xdc\xcen\xb9\x9d\xda\x15\xcdǊa\x9b7\xa1\xdd\xe4\xbe7>$\xedYs\xa1l\xb3\xb4\x91\xceu\x83\xe7\a\xc4QB/\xe9\xd1O{\x9c\x1c?\xe3\xec\xc9'\x84ah\xef\xab6\xc1\xd1M\xe8E\x9es\xf5\xee9\xb7\xe7\xdfQl\x96\b\xb5\x8d\x9b\xee\xe0\x9d\xdf5\xfc[\x88\xcc\xcakÐ8\x8a\b\x03\xcb_QR\x10\xf8\x01i\x18\x11;~\x87'=\xfb\x1e*\x0fϑ\x14\x85\x94]\xf0P{\xcf\x1bZ\xb7ǆZ\xd7\x0f\xcf\x02m\xc0x\xc4a\xe0b}4\x98\x86,\xcf\x19\xf7|\x1a\xa3\x99\xadV\x14UMm\xb6>\x1dF\b<\fec\xb6\xa6PB ]\x10P\x1a\x86\x94ڢ\x80Mm\xd7r6a\xd3Ə\xd7u\x85\x17z.\\\xcb\";\xdae\x054Ʈ\x0f\x95\xcb\b\xb1\xc8ׄ\xab\xfbs\xae\xef/\x18\x0f&\xb4\xaa\xa1\xfd\xf1>o.\u07b6\r\xc0\u007f\xf3X#\x1e\x1b\x80\xbf\xd5ӿ\xd8\xc9\xc85\xc6jۏ\x8eOI{}\xc0\xfc3\xa5\xbc\xe3\xc5\xec\x8e\xf5rfٴn\xaa\xdb%\tim\x1e,\x98\x85\x9b\fڽ}[\xfcw\xe5E-\xbc\x18\x841\xbe\x1fY/o\xa9h\x9a\x8a\xd5jA\x9eo\x10RPV\xf5\x03\x02\x1d\xf0\xc0\xe3^J\x88\x82\x90(\xb2ſM\x95\xb3\xfb\xfe̚\a\x15\x15U\xdd\x10\xf8\x8a\xc0\xb3;\xff\xb2\xaa\xf0\x95d\xd4둦=;i\xb5?\xa75 \xd1mz\xa1q\x04-\xf9\x80Ǡ\xdc^ٶ=\xc6:\xceU6\x06\xd9\x1e\x8cβUH\x17a\x9c\"\xa5b\xb9^R75\xebl\xc3j\xbd\xc28\xbf\xfd\xf1p\x88\xe7)\xa6\x8b\x19\xeb\xf5\x92\xba,\xe9\xc7)i\x9cr||\xc6\xfe\xfe\x11\xca\xf3\x98\xcfg\\_]\xb0Y-0\xa6!/6\\\xcfn\xa9\xeb\xfa\xe3\xbb\xdd\xddw\xcfy\xf3\x1fL\x0e\x89\xfc\x10\x81a8\x18s|\xf4\x04\xe5\xfbH\xa5\xe8\xf5,\xa4n\xb0\xbe\xea\xd9&s\xc6I\x9a8\xb4\x92:\xdf\xf16̮R\xdf\xd0\x19\x02a\fEUqqq\xceo\xbf\xfa%\xdf|\xfb+\xee\xa67֟\xe1\x01b \x88\xa2\x88\x93\xa3'\x9c\x1c=q\xd2>\xd55\x12US\xa1\x84\xe2\xf8茣\xa3\x13\"? \x88B\xa2(\xc2\xf7\x83n\xfd\xe2\xbb \xa2\xba*i\x9a\x9a J\xac\xbb\x9d+\xca-\x82!\xa5DHE\x91mx\xfb\xf2k\xce\u07fcd\xff\xe8\x94\xfd\xc3\x13\x90\x92\xe5r\xcej9\xa3\xaa\n6\xeb%\xab͚\x9b\xbb\x1bn\xefo\xba\x15\x8d\xf9\x1dc\xb71\x86(\xb4\x86Mi:\xa0*\vV\xcb)\xeb\x95Ee\xfc \"\fbҸϨ?\xe2ɓO9=\xfd\xc4eKl\xfd\xb2\r6\xef`\xbd\x9aq\xfe\xfc\xd7\xdc_\xbfA\xbb\xc6C\b\xf1\x01\xb8ov\n~;\x8d\v_\xe1\xfb\x1e\x81\x17\x90&1\xbd(&\xf0|\x94\x92\xf8\xca#\x0eB\x920\xc2\U000ec1feh\v\xbfSyH)\x9d\a\xff\xd6\xc1\xd36Ŧ\x83ץ\x00\xcfX\x89_K`\xa4\x8d\xfb6ڹ\x01\x98\xae\xc18\x9e\x8c\x19\rz\\O\xa7\xdc\xce\x17LWk\x8a\xba\xb5\xf1\x16xJbJ(\x9bڽ\a\x16\xa51@ \x04\x83(f\xba\xd9X\xaf\x85\xaa\xea\x9c(\x1b\x97\xd5`t\xe48\f\xd2\r*\xa6\x1bN\xea\xa6F\xf9m\x03\x0fiԣ\x17\x0f\xb8\xbe\xbb\xe0\x93\xd3/\xf0=\x1f\xdd4\x8c\xfbC\x92(f\x93g\xff\x18x\x06\xbc~\xac\x14\x8f\r\xc0\xdf\xd2\xe9_\xba\xa1\xdc\x02qJ)+\x05;>\x03PR\xa8\u007f\xa9\x9b\x86\xfb\xebw\x0f\x8e\x1c\xa3\xb7\x04\xc0\x87\xfar\xe3\x12\xbbm\xf1o\x9c\xeb\\wD:\x9d\x90M\v\x93\xf8A\x84\x1f\xc6.Dƣ\xd15\xeb՜\x8d3˩ꆪyh^c\x8dEd\xc7=\x88\xfc\x908\xe9\xd9B\xe0x\be\x99S\x96\x19F\x1b\xb2\xa2\xa4\xa8*<\xaf-\xfe%EU\x13(ɠ\x97\x90$=@\xba\x83\xcb\x12\x9a\x84\x94\xe8F\xef\xc0\xb0v\xda\xdf=t\x95#G\xe1\xdc\xfcZ绺.\xa9\x9a\x12\xe3\xb4\xcdBJ<e'jc$\xcb\xf5\x8a\xaa\xae\xc8\xf2\x9c\xf5f\x8d6\x9a(\x8c\x18\xa4\xfd.YN7\r\xa6\xae\xe9%\t\x81\x1frpx\xc2\xfe\xe1\tR)\xe6\xf3\x19W\xd7W\xac\\\x8a\x9fn*f\xcb)\x9b<\xdf\x12\xe9~\x87\xec\xaf\xdds\xee\xef\x1d\x10\x876\xc9.I\xfb\x9c\x9e>#t\x96ƽt@\x14\xc5\xce;\xbfb\xbd\xd90\x9d\xdecL\xc3d4!M\xad\xff\u007f\x17\xb5\xfc\xa0 \xd9B\xab\xb5&\xcb2ޞ\xbf\xe6\xb7_\xff\x8a\xef\x9e\xff\x86\xd9\xec\xdeF\xba*\xc9.#1M{\x9c\x9d<\xe3\xe8\xe0\x84\xc0=\a{H7\x94e\x89\xe7y<9{\xc6\xc1\xfe\x91\x9bbc\x820t\xc6N6{@\t\xeb\xc1P\x96\x19\xbaф;\xc5ߴ\x01U\xce\nXIE\xb6\xd9\xf0\xe6\xd5W\xbc{\xf3\x1d\xa3\xbd#\x0e\x8f\x9f!\x85b\xb5\x9c\xb1^.0Ɛg\x1b\xe6\x8b9\xef\xae.\xb8\xbe\xbd\xb4\xe6>\x1f5\xcai\x03y4Q\x14s|xF\xafק\xc8sf\xd3k\xd6\xeb\x15B\x80\x1fĄ~Ȩ?b2\xda\xe3\xec\xf4\x13\x8e\x8e\x9f\xe0\xf9~W\xc1\xdb`&!\x04\xf3\xe9\r\xaf\xbf\xf9\x05\x8b\xbb\v\x97\xb2Iǘ\xdf\xdeO;\xe8\x83'\x11\xd2:\x06\xfa~@\x1c\x86\xa4QD?\x8aI\xa3\x988\b\t\xfd\x80\xc0\xb7\x11\xc36\xe8Kv\x8d\xb7t\x06Zb'\x01\x04\t\xc2H\x90\x02e4\x8d\xe3\xf74\x8e{\xe3\xb9F\\9\xff\x8d\xce\xf3\xa3i\xa8\x1bۈa\f{\xc3\x01\xa1\xef\xd1O\x12\x02_\xd1\xef%<=>\xe6z6\xe3\xc5\xe5\x15\x8b,s\xf6\xbc\x12\xcf\xf7\x99\xad\xd7Tu\x1b\x9d,:O\x91\xc8\xf7\x89}\x8f\x95.\xa8\x9b\xca\xed\xf6%M\xd3P\xd6%\x91n,\xf7\xa73\fr\ue8c2\xf7\x12G-\xc9y2<\xe0\xd5\xc57\xcc\x16w\x1c\xed\x9dQ\xe9\x9a(\x8c\xd8\x1bN\xd8\xe4\xe7ʡ\x00\xff\xe7\xc7Z\xf1\xd8\x00\xfc-\xdd\xfd\x8b\x9d\xa5\xa1\xc0\xf7}\x0e\x0e\x8fH{=\x04\xfc\xb1T\xea\xc7\xf3\xfb\x1b6\xeb\xa5e\xd6\xd6Uw\x13\xb5\x93\xc1n\x98\\\xbb\xcfk\x9a\xa6\x83m?\x84&-S?\bB\x820\xee\xe0X\x8c&\xdf,Yo\x96\xd66\xd7\xd8\x06\xe0\xfdG\xab+F\b\x02\aU\xb7\x87=\x18\x8a2\xa3*r\x00\x8a\xb2\"\xaf*\x94R\x84~@^\x96\xe4U\x85\xaf\x14\xbd$&\x8ezVz\xe6\nW]W\x0e\xe67\x1ds\x1dc\\ђ\x0f\xdb\x10\xa5vv\x98\xc6\xca\xfd\xea\xd2r\x16\xda=$\x02\xcf\vH\x12\xbb\xb3_m\x16\xd4uMV\xe6l\xb2\r\x8dn\bÐ$\x8a\xed\xba@\x84v\x87m\xda\"&\xd9?8\xe2\xf0ز\xc4\xe7\xf39\xd7\xd7W\xd6Jؑ\xc36\x9b\x15\xf3\xf5ʑ\xda~\x0f\xed\xcb\x18<\xa5؛\x1c\x90D\x89%\x99\x85\x11''O\x88\x9d~\xbe\x97\x0eI\xd3Ԯ]ʊ\xcdz\xcdtvϻ\xcb7\x1c\x1f\x1c1\x18\f\t\xc3\xf0\x83\xe2\xdf~\xae\xdai\xe1W\xab\x05\xaf^\xbd\xe0\xebo~Ë\xd7_\xb3X\xce-\x03\\ʭ\xbe_J\x06\xbd\x11O\xce>a\u007fr\x84\xef\xfb]ǢuM^\xe6\x04~ȳ'\x9fq\xb0\u007fhߧ؆1)\xe5u͗\xe7\xd8\xf3y\xbe\xc1`lX\x94RV\xe3\xef \xf5n\xe7\xaf\x14y\xb6\xe1\xed˯x\xfb\xfa\x1bF\xe3CN\x9f|\x86\x92\x8a\xd5r\xcef\xbd\xb0\xaf=ϘNoys\xf1\x9a˛\xcb\xceX\xe8w\x15\u007fm\fq\x98prtF\xaf7\xa0\xc83\xee\xee\xae\xc86\v\xa4\xb4\xd1ǁ\x1f\xb1?9\xe0\xe4\xf0\tGG'\x8c'\a\x968\xb9\x93\x92\xe9,\xfe\xb8\xbb|ë\xaf\u007f\xc6fq\xbf\xbd\xdf\xde\x17WX\xfd%\xcaS\x84a\x88\xf4l\fs\x18D\xf4\xa3\x88^\x18ӏc\xe20\"\xf2\x03|߷\x88\x95\xc4\xd9\xf4\xca\xed\xd4\u07b5\xd6-We\x9b\xc6(\x90ݺAa\xe1\xf8\x0e=\x94r\a~0\x9dӧ\xd2\x06\xd5Xރ65\xa9\x97\x90\xc61\xa0\x11\xd2\"\x13\x83\xbe\xcf\xded\xc2x8\xe4\xab\xd7o\x98\xae\xd7()\xf0\xfd\x18\xe1y\\M\xef\xdd\xe7f\xba\x1f!\x80\xc8\x0f\xc8*\xbb⩪\x12\xcf\xf3]\xd3_PG5\x9e\xf3\x03\x90Bb\x84@o\x9d\xa8l\x8c\xb3\xf2\x1d\xc6bC\xb5\xbck\x9f\xeb\xbb\v\x8e\xf6O\xed\x1aIz\xec\x8d'\xbc\xbd~\x871\xe6\xb1\x01xl\x00\xfev>\xa43si\x8b\xb9\xa7<\x92$\xe5\xf0\xf8Ԛ\xa2\b\xf1\xa7MSs\u007fsa5\xef\x8e\xf1lv\x9d\xfe\xf4\x87`\xb3n,<g\xb6\xc9-\x0fV\x95\xd6\xd5, \x88S\x84ۡ+))\xb25\xcb՜\xba\xb1\x81\"e]\u007f\xe0/\xd0B\xff8)\x9e\xcd{\x0f:Ⱦ\xcc7T\x95+\xfeUŦ,\x90H\"ߧ\xac*\x8a\xaa\xc6S\x92^l]\xedl\x00\x8amdr\xf7\xb5\n\xe1\f\u007f\xda\xd7g\xf7\xf7fG\xaad\xa7\xa5\x9d\xbc\x04\xad\xbb\xe9\xbfi\x1a\x17Dd\xad[\xe34\xa5\xaa\x1a\x174\xa4)ʂ\xcdfC\xd34\x04~@\x14\x84N\xab\x1c0\xec\r\xe8\x85Q\xd7<\xed\xed\x1drz\xf2\x8c\xc0\x0fY.\x16\\__v\x01HF7\x94e\xc6:_\x91\x95\xe5\x87䯏Ⱦƣ=\xfai\x1fi,S\xfa\xe8\xf0\x844IѺ\xa1\xdf\x1f\xd1\xeb\xf7\x91RR\xd5%\x9b\xf5\x9a\xf9b\xc6\xc5\xc5\x1b\x8cn\xd8\x1b\xef\x13E\t\xa25v\xd9A\x15\xac\xd7CCU\xd7L\xa7\xf7<\u007f\xf9-Ͽ\xfb-\xaf\xde>g\xbdYYH_ma\u007f\xcf\xf3\x19\r\xf79=~\xcad\xbc\xb7c\xea\x03um}\x1a\xd28\xe5\xc9٧\xec\x8d\xf7\x88\xa2\x88$I\xac\x11\x91T]\xf1WN\xef]\x16\x1b06\x1ch7f\xb8]G\b\x8cM{,\n\u07be\xfc\x9a\xd7/\xbfb0>\xe0\xecٗ\x16U\x99ݑm\xd6(\xa5(]\xf1~\xf1\xfa\x05\x97ח\xd4u\xfdQu\xc3.\xec\x1fG1\xa7GO\xe8\xf7\x86\xe4\xf9\x86\xdb\xdbK6\x1b\xdb4\xfb~H\xe0\a\x9c\x1e>\xe1\xe9\x93Oٛ\x1c\xd2\xef\x0f\xbbՅى\xe1\xd5u\xcd\xf5\xdb\xe7\xbc\xfe\xfagd\xab\xc5\x03\x92ܶ\xcc:\xec]\n\xc2  I\x12\xc2 \xb4\x04\xbe0\"\xf2\x02zaH\x1c\x04\x84A\xb8-\xfc\x0f\xa2\u007f\xb7\x9f\xddv\x15\xe8`\u007f\xd9\"\x01\x0e\xadk\x1b\x02)\xdas\xc1&\xfb9\xad\xff\xce\x05\xd6eq\xa05U\xedQJ\xa8\xf5\x96\xd7 D\x9be`\xd75R*\x9e\x9d\x9e1\x1e\x0eyyy\xc9\xd5\xd4&c\x86aDQ\x97\xccW\xeb\a\xd1ڦi\xf0\x95\"\xf2<6\xa5\xb1\x81?\xda\"\x05UcQ\x01\xed\x87]\xf3m\x9b\x1cӽΦ\xb1\rB\x87\x86\x051\xe3\xc1>\xb7\xb3[6\xf9\x86$L\xd0\xd2F~\xf7\x93\x1e\x8b\xf5\xf2?\x02b {\xac\x18\x8f\r\xc0\u07fe\xdd\u007f[X\x85\xc0\xf7\x02\xf6\xf6\x0e\xe9\xf7\a\x18\xcc\xffDI\xf5O\xa67\xd6\x14E)\xcfj{w\x8e\xa2\x96\x94\xb7{\xa8hgQk\x8cyxhn\xe3\xcc\xf0=\xbf\x83h-$+\xa9ʜ\xd5jNQV\xeef\xfeоT\baw\x95Ҳ\xe9\xd3$\xc5\xf3\xfd\xed\xe4\uf2bf\x00ʦa\x93[\xf9]\x1c\xf9TMC^\x95V_\x1cFDAH\xe0\x85ݩZ\x14\x05M\xdd\xe0\x87\x81\x9bdt\xe7_\xd0\x16\x9b\xf7\x9b'\xd1:\x9b\x00\x8d3vi\xea\xba\xfbz\xdf\x0f\x89┢\xacXg\x1b\x84\x81\xb2\xacXe\x1bt\xa3\xf1=\x9f\xc0\xf7\xa9kkhs\xb8\u007f\xc4\xe1x\x8f\xcdzi\x8b\xff\xfe\x11gg\xcf\b\u0090\xe5r\xc9U[\xfc\x9dƺr\x85r\x95\xe7TM\xf3\x91\xf9\xf4\xa1\xe4\xab\xd7\x1f2\xea\x8f\xf0\xb0\xaew\xc3ф^\xafO\xd34\xc4I\x8f\xfe`\x80\x94\x92\xba\xaaY\xaf\xd7,\x96s./\xdeRU\x05\xdf\xff\xf2GL\xf6\x0f\x9df{\x9b*\xa7\xdd\xc1\xde4\x9a\xaa*\xb8\xbe\xbe\xe6\xf9\xcbox\xfe\xe2\x1b\xce\u07fd\xb0\xae\x8aJYI\x85{\xaf\xc3(fo|\xc0\xf1\xd1)\xc3\xfe\xb8\v\x0e2\xc6j\xbc\xeb\xbad\xd8\x1fqv\xfa\x8c\xe1pD\x92$$q\xea\x1c\bU\x179\xac\\\x02]Uf\xf6\xfd\x0e\x82.\xe6\xb5-\x95\xdah\x8c\x00)l\xfc\xf2\xbb7\xdf\xf1\xf2\xbb_\xd3\x1bNx\xf6\xe9\x0f\x90Jqw{I\xb6Y\x11\xc7=\xf7\x1a\xcey\xfe\xf2[.n.\xec\xfa\xe9\xf7\xfa\xe9\x1b\xe20\xe6\xf4\xb8-\xfe\x197\xb7\x17l\xd6\v\x94g\x8b\u007f\x18D|r\xf6\x19Ϟ~\xcep0v\x88\x95r\\\x02c!v4uUq\xf5\xfa\x1b^~\xfd3\x8al\xd5ݟ-\xe4\xaf\xc1N\xb3\xc26\xc2q\x14\xd3O{\xf4\xe2\x98\xc9`\xc48\xed\xdb\bmO\xe1\xbbt?\xe5Yh~\x9b\xcb\xf1\xd0\xdcGJ\x89\xea2\x1d콅\xd8j\xf4\xa5C\tE\xdb@\x88\x1d\xa8\x0faQ\x87\xb6!ہ'\x8c\xb1Y\x10y\xa1\xc8sk\xa4\x856HO\xe2y^g:&\x1c\xaa6\x1a\x0e\xf9i\xaf\xc7\xfe\xdd\x1d\xaf\xaf\xae(ꊳ\x83C\x1a}EY\xd7\xd6Fس{|\xca\x12\xdf\xf3PMM\xad\x1b\xaa\xba\xea\xe4\x92Mc\xd7g\xcaS\x9d\x9f\xc1\xae\xe3c\x9b\u0560\x1c\xaf\x00c\xd8\x1f\x1ds7\xbff:\xbb\xa5w\xfc)RJ\xa2 d\u007f\xbc\xc7b\xbd\x8c\x81\xff\b\xf8\u007f<V\x8d\xc7\x06\xe0oQ\x03 \x1f\x9ck\x9e\xe7\x13\xc7\t\a\x87'\xce~\x94?\xad\xab\x92\xe9ݕK$ӝ\x0e\xbe\xbd\x91\x1e\xb2\x8e\xec\x9d_\xbb\x84\xba\xdfuhn\xd3\xeaB,\x89N\xa2\xeb\x9a\xf5ja\x8b\x85\x104\x8eA\xfe\x01b!\xb6\aQ\x1a\xa5\x04~\xe8\bh\x86\xbcXSU9\x12\xa8\xb5f\x9d\xe74F\x13\x87\x81\xddG\x97%\x02I\x1a\x86DaХ\xed\xb5$\xb7\xbc\xcc\t\x83\xc8\xeeX\xb5\x8b/m\xa7\xa3\x1d\xa4\x04\xac\x12\xc0N\xff\xd2M\xacV\x1ag͂@\"\xf1\x83\x900L\xc8\xf3\x9c,\xcf\xedT\xddT\xac\xb3\x95\xf5x\x97v\xf7\xda\ua4cf\x0e\x8exzt\xcaj\xb9\xa0\xd16|\xe9\xe4\xf4)a`'\xff\xab\xeb+6\x9bu7\xf9WuEQ\x15\xe4E\xc6*\xcf?\xdc\xf7?\xa8\xfd\x96\x95>\x19\xee\x11(\x1b-\x9b\xa4)Q\x14Q\xd5\x15q\x922\xe8[\xf2a\xd34\xac\xd7+\x96\x8b9\x97\x97o\xd9l\x96|\xf1\xf9\x0f8=}j''c\xac\x81\x8eiejv\xc7ZV%\x17\x17\xe7<\u007f\xf1\r/^}û\xf3\x97T\xb5e\xfa\xb7\x04/\x04x\xd2c2>\xe0\xf4\xf8)\x83\xfe\xb0\xe3r\xb4~\vZ7\xecM\x0e8=}J?\xed\x13E1q\x1c#=\xbb\xef\x97Rb\x84\xc1\x93\n\xa3k\x8a<\xb7\xd2?߷AO;\xc5\xc9\x1aR\xd9ULSW\xdc\\\xbe\xe5巿$J{|\xf2\xf9\x8fP\xca\xe3\xea\xe25\xcb\xe5\x94\xd1\xf8\x000\\^\xbe\xe1\xdb\xe7_qysm'\xff\xdf\x13\x8ec\x1e\x14\xff\x11E\x9eqs}n\xd7e\x9e\x8f\xef\xfbDa\xc4\xe7Ͼǳ\xa7_\xd0\xeb\rH\x92\xb4+\xfe]\xb1\xc4\xd0\xd4%\x97\xaf\xbe\xe6\xd5\xd7?\xa3\xcc7\x1f\x10\xfd\x8c\x80F\xb4i\x99\x1e\xc3^\x8fa\xafϰ7`\xd2\x1fЏ\x12\x1ba\xedV#\x9e\x92\xceD\xc8ES\xef\\\x126?\xa2Mqt\x88\x9a\xcb^\x90\xb4\b\x9b\x8dxV\x9d\x19\x10.F[\xdaDM\xc7\xe3h\xe3\x89[\xb5\x86\xe9\xf8'\x16yQ\xbe@)AQ\xe4h\x97\x12\xa9\x1e4\x19\xdb\xcc\x10_I>==co4\xe6\xe5\xe5\x05\xd3Պ\xbc\xaa\xb8\xba\xbfC7\x06!\fR\xd9\xf5Oc\xac/\x80ֆ\xaa\xaelF\x82\xb1\xff\xad]0\x91\xd8\x19t\xe4\x0ey\xb7n*\x94t\xd2Vg\x1b\xddO\x86\\ܞs|pf\xefM\xcfc\u007f\xb4\xc7닷\xd4M\xfdǏ\r\xc0c\x03\xf0\xb7\f\xfeߙ\xfe\xa5\xc2\xf7\x03F\x93=\xfa\xc3!Ƙϔ\x94\xfftz{CY\x16\x04AH\x99o\x1eB\xff\xef\xf9\xf0\x835\x89\xa9\xeb\xeaw\x00\xa5\xf6fL\xa2\x84\xc0\x8f1.\v\xdc\x18+\x91ʲ\xacKﳖ\xbb\x0f\x03I\xa4\xb4!\x1fBJ\xa20\"\fc\a\x9d\xbaɿ̑\xaex\xaf\xb3\x82\xa6\xd1D\x815\x02\xda\x14\x85-za@\x18\xf8\x84a\xd2\x11\x84\x1am\xa1\xf9\xd6\xe8\xc4\x16~\xdd\x11\x1b\xdf/\xfe-Q\xc9\xee?\xad>{k\xfac\x9d\xee|\xdf\x16\xffu\x9e\x93\x17\xb9u\x16k\x1a\x16\xab%uӸCP:\x8d\xba\xcfd4\xe1\xd9\xc9\x13\xb2͚\xb2.]\xf1\u007fB\xe0\a\xcc\x17s\xae\xaf\xafɲ\ruU\xb9ɪ\xb2\xa9\x84e\xc1b\xb3\xb1֫\xef\xef\xfew:\x01)%\xa3\xd1\x1eI\x18!\x11\x04QD\x10\x06\x94eN\xafgC\x88\xc20Dk\xc3f\xb3a\xbe\x98q}\xf5\x8e\xe5r\xceg\x9f~\xc1\xb3g\x9f\xe3\xf9Aw\xe0ۃ\xd3tR\xb5\xaa,yw\xf1\x96\xef\x9e\u007f\xcd\xf3W_sq\xf9\x06]W\b\xe59R\x9a\xc2\xf7C\xd7x\xf489:c\xd0\x1fZ\xa2\xa5q\xf6\xaeu\rB\xb2\xb7\u007f\xc8\xe9љ\x8d\x1d\x8e\"\"\x17\xda\xd3M\xfe\x80\x12\x12\xddԔ\x85%=JϷ\xd2O\xb9C.t\xea\r\xb0a?\xf77W|\xf7\xf5\xcf1\xc2\xf0\xe9\x17?\xc6\xf7\x03.\xce_rs}\xce\xd1\xf13\x94\xe7\xf1\xfa\xd5w|\xf5\xf5\xaf\xb9\xb9\xbf\xa1\xfc\x9d\x84\xbfmS\x15\x85\x16\xf6\x1f\xf4Gdy\xc6\xf5\xd5[\xb2\xcd\xd2j\xec\xfd\x80$N\xf9\xe2\xd3\x1f\xf0\xf4\xf4S\xd2^\x9f8N\x9c\xb4\xd1\xdd\x15m\xf1oJ\xae^\u007f\xdb\x15\u007f>(\xfe\x82\x06\xebAыb\xf6\x86#&\x83!\xe3~\x9fa\xd2\xc3\xf7|<\x17\xced\u007f\xa9\xed{\xd09\xf0\xe1 |\xe1$\x80r;!\xcbퟵ\x01BJ\xda\xfd\xbet\xfc\x1cOI\xa4k\xe6\xa4\xf3\x0e\x00\xb6j\x01\xe7\x18آB6 J#\xd4\xd6\nX\x9b\xda~\x96J\xb9t\xc3\x16\x05\xb1\xee\x9euӀ0\xec\x8f\xc7\fz=.no;\xb9\xee|\xb9B`\xcf\aOJ\x86i\xafs\xf3l\\\x00\x93\xf2\x94%\x1e\x9a\x86F7x҅s\t\xedL=\xf4GQ\x00!\x04\a\xe3c^_~\xc7lq\xcf\xfe\xe4\x10O*\x97\xd11\xe2\xe6\xfe\xf6\x9f\x02\xffk\xa0y\xac\x1c\x8f\r\xc0\xdf\x0e\xf8\u007f\xe7p\xf3}\x9f0\b98<j\x0f\xa8?\xad\xabJͧ7x\x9eo\xe5S\xbb\xf6\xbb\x0f\xccx\xda\x1d\xb8\xb1;{\xf3\xf1\xe2\x0f\x10\x86\x11Q\x9c:/q\xfb/\x8bl\xc5&\xdfPi[\xc4\xeaf\xeb\xac\xd6\xed\xfd\xdd\xe4(\xa4$\bC\xe2\xd8\x12\u06041\x14\x8e\xed\xdf\xea\x94\xd7yIY\xd7\x04\xbe\x87\x12\x92\xb5\x9b\x8e\x93 $\xf4m\xf1\xf7\xbc\xa0\xf3(XgVR\x14E\xb1\x9b^t'\xfdk\xe5D\x0f^\x84\xb33m'\u05edϿ\x95߅a\x82\x1fD\xac6k[\xfc=\x8f\xbai\x98/\xad\x9b\xa1\xec&&I\x10\x84\f\aCN\x0e\x8eiʒ\xbc\xc8\xd9\xdf;\xe4\xe4\xf4)\xbe\xef3[̸\xbd\xb9\xa1(r\x9a\xa6B\x9b\x06]WTue㘳\r˼\xf8\xa8'\xfb\xeeg5\xe8\x0f\x19\xf4\x06\ba\xd3\xf1\xe2(\xa6\xaejT\xe4\x11\xc7\x16\t0Ɛ\xe5\x19\xf7\xf7\xb7\xdc\xdc\\\xb2\x98\xdf\xf3٧_\xf2\xc9'_\u2e58\xd6n*5\xce\xde\xd9\x18\x8a\xaa\xe0\xfc\xe2-\xdf|\xfb[\xbe{\xf1\x157\xb7\x17\xe0\x88\x94FI\xa4\xe7\x93\xc6}\xfc\xc0'\n\"\x8e\x0fO\x19\x0eFv\xaf\xab\xb5k$\x1a\xa4\x94L&\xfb\x1c\x1f\x9d\xd1KS\x1b\xd7\x1b\x06\x9d\xadk\xab\xc0\x90\x02\xfb\x1eT\xa5\x85\xb0\x9d鏒^\a:\xb5\xfbf\x04\x98\xa6azw\xc5w_\xff\x8c\xf5b\xc6\xf7\xff\xe8\xdf'\x8e\xfb\\]\xbe\xe1\xfc\xcdw\x1c\x9d<#\x8cb\xde]\xbc\xe6\xb7_\xfd\x9c\xfb\xe9-EY~ \xb1\x13ｧ\x9e\xe7s|p\xc2p0\xa2(r\xae\xaf\xcf٬\x17x\x9eO\x18\xc6\f\x06c>}\xf2\x05\xa7\xc7OIz}\xe2\xd8N\xfe\xb2\x85(\x9cO\x85\xa9Jn\xde>\xe7\xc5\xce\xe4ov\xac\x9b\x8d\xb0\xb6\xd2J)&\x83!'{\xfb\xec\xf5\x87\x8cz=\xa20\xb6\x16\xd4l\xf7\xf3\x96\x9fa\x1ep|\x84\xa0S\xa3\xb4RV\xe1\xbeV)\x85r0>Rt\xb6֭\xb7\x85\x94\x12\xa1d\xb7\xfbo\xf9:B\xa9\xae\xe9hM\x96Z\xb9l\x8b$h\xa9ly\xd7\x01\xe8\x06!B\x82 \"\xf0\xc3\xedZ\xcd\xf1G\x1a\xa3i\x9a\x8a\xcaqh\xa2(\xe4\xd3\xd33\x86\xbd>B\b\xfe\xf2\xdbo(\xab\xaa\x93\x19\x86~@/I\xc8뚦\xb2\xab\x00\x0f\x8f\xca5\xe2\xda4\x18\xe1\xd9\xe7\xd1\x125;y\xac\xe9P\x00\x81̈́\x18\xa4C|/\xe4\xea\xee\x82\xc9p\xdf\x19%\xf9\xec\x0f\xf7\xb8\xb9\xbf=\x00\xfe1\xf0\xdf?V\x8f\xc7\x06\xe0o\x05\xfc\xdf\x1a\xb0\xf8\x9e\x9d|\a\xc3\x11\xc3\xc1\x18a\x18\n)\xff\xb3\xc5욺\xaa\b\u0090b\xb3~p\xf8mu\xf0[\\\xd1·\x1f\x87L\r\x06\xdf\xf3I\x93\x1eBznr\x10TeF\x96\xad)\xeb\xca~\x1b\a\xbd\u007f\xb06p\x13\xa0燤Q\xda\xfdЪ*\xac\xab\x9a\xfb\xba\xac(\xc9\xcb\x12O\xd9\x1d\xe8\xa6\xc8\xd1\x06\xe20 \b|\xfc $\b\xc2n\"\xc9\xf2\x8c\xb2̉\xa3\xa4\x8b\xf1\xd5zk\xf2\xf3\xb0\xf8o5ɝ\xf4O\xd7n\xf2\xb7\xc62a\x18\xe3\x05\x11\x8bՒ\xbc(\xf0=\x8f\xaa\xaeX\xaeV\xd4M\xabu\xb6\xfa\xee8\x8e\x19\xf4\x87\f{}|)\xc9\xf2\x8c\xf1d\x9f\xd3\xd3g\xf8~\xc0|>\xe5\xe6\xf6\x86\xaa\xaa\xa8\xebv\xb7Y;\xe9SIQfL\xd7\xeb.\\\xe7wM\xa9a\x181\x1aNP\xc2\"(q\x92\xd8CZIz\xbd!\xfd\xfe\x000\xac7knn\xae\xb9\xb9\xb9 ߬x\xf6\xf43\x9e=\xfb\x1c\xbf\x9d\xfc\xa5\xad\xfc\xc6\xe0\xdc\xf4\xac\xc9\xd2\xdbw\xe7|\xf3ͯ\xf9\xe6\xc5o\xb9\xbd\xbbB:\t%\xd2F%\xf7\xd2>I\x94\x12\x04!G\xfb'\xf4\xfb\xc3.\xde\xd5\xf1ސJ1\x19\xedqr\xfc\x84\xb4\x97\x12\x85Q\x17\xda\xe3u6\xcbn\xf5T[3\"Z\xfa\x853\xffi\x89\x89\xad\xe4O \xd0M\xc3\xf4\xee\x9ao\u007f\xf3\x17\\]\xbc\xe2{?\xfaG\fF\xfb\xccg\xb7\xbcy\xf1\x15\xe3\xc9!\x83\xe1\x84\xdb\xdb+~\xf3\x9b\xbfd:\xbbgS\x96迢\xf8K)9\xdc?b<ڣ\xaa*\xaeoޱ^\xcd\xed5\xde\x1fp\xb0w\xc2\xe9\xd1\x13\xf6'\a\xa4i\x8f(N\x9d\x9d\xae\xdci\xa2\xac=\xf5\xe5\x9box\xfe[\xbb\xf3\x97B<\x88\xd15\xc2\xda\xe3\x06\x81\xcf\xc9\xde>g\aG\x16\xee\x8fS<Ou\xfb\xed\x87^\x1et\xa6Hm\xd3&]x\x8f-\xcct\xa1H\xed.\xbe\xf5\x00WRZr\x9eڑ\x06\x8b\x1d\x13+\xaf-\xfa\xaa[\x1fl\x9d&\r\xda=\x01\xa9,\x01Vj\x83q\x99\f~\xea\x11\x06\x91\x9b\xfa]\x13i]\x8f\xd0\xc2\xf2J\x94P(\xe9S\xd69u]!%\xec\x8f\xc7\xfc\xcf\xfe\xe8\xa74Z\xf3\x97\xdf}c\xdf\u007f\xa4[\x03F\xac\x8b\xc29\x8a\xe2\xe0\xfd\x9a\xaa.,\xd1\xd0\x18\x04\xb2K\xfbkWn \xaco\x80n\xf0\xa4U\x04x^\xc0\xa8?\xe1~q\xc7j\xb3p\t\x98\x8aa\u007f@\x18\x04\x14e\xf9Ǐ\r\xc0c\x03\xf0\xb7\x03\xfeߑ\xf1\xd8xW\x9f\x83Ó6\xe4\xe3_\xe8\xba\xee\xad\x16S\x94\xe7\x02ov\xb4\xfc\x0f\xa6\xff\x96\bf\x1c\xf4o\x1e\x8e\xfd\xa2\xfby\x92$\xee\xa1\xfc\xe0\xc1N6\xcb\xd6\x14\xd5\x165\xd8\xdd\xfb\xb7\xde\xfb\xcaM\x80^\x10\x90Ʃ3ⱒ\xbb<_wL墪Ɋ\xd2:\x9e\xf9\x166\xac\x1bM\x18\x04\x84\x8eu\x1c\x04QgYc\xd3\x007\x9d\x95mktb\xa5c\xce\a\xf8#DI)\xa4\xd3&[ز\xa9+\x84\x80 \x88\x91\xcag\xb1\xb4\\\x06\xa5\x14eU\xb1Z\xaf\\`\x90\x9b\xa8\x10\xf4Ӟ۹{V\x01PW\f\x87c\x9e\x9c}B\x10\x84\xcc\xe7\xb3m\xf1\xaf\x1c\xb7@[O\x85\xba\xb6\x81F\xf7\xab\x95%G}H\xf6\xef>'\xa5\x14\xe3\xe1\x84@\xf9H!\x89\xa3ؽ\u007f\ri\xdag2\xd9#\b\x02\x8a\xb2\xe0\xe6憫\xebw4uɳ\xa7\x9f\xf2\xe4\xe9g\xf8A\xe8\xf2hd\xbb֥\xa9k4\x86\xa2(x\xf7\ue72f\xbf\xfd\x15\xdf>\xff5\xb7\xd3;\x84\xb1|\f#=\x8c\x12\xa4q\x8f^2\xc0\xf3=\x0e\xf6,\xf3\xbd=\xfd\xc5\xce\xfex<\x9aXؿ\xd7\xebl\x87\x9b\xaa\u008fc\xd7\b\x18L\xa31\xad\xaf\x84\x9bf\xab\xaaF4\xb6\xb9\xb4\x06U[φF7,gw|\xf7\xd5_\xf2\xf2\xc5o\xf8\xec˟rx\xf2\x8c<\xdf\xf0\xea\xc5oI\xd2\x1e{\xfb',\x97\v~\xfb\xd5/\xb8\xbd\xbdf\x9d\x17\x0f\x1aP\xc3\xfbZ\n\xfb\xbc\x0f&\a\x1cN\x8e\xd0Zss{\xc1j9\xc3S\x1e\x83\xe1\x84'O>\xe3`rD/\xe9\xd3\xeb\x0f\x89\x93\xd41\xde\x1d\x83^Z\xe4\xa3.K.^\xfc\x86\xe7\xbf\xf9\v\xf2|\xedT#\xef\xed\xfc\x8d&\x8e\">9<\xe6\xc9\xe1\x91M\xa8\f\x82\x8eS!݊jW\x92\xd9N\xfd-y\xafC\x01\\1\xf7<\x0f?\xf0]\x01w\x18\x9e\x10x.8I:\xf9\x9fp\xf2\xe0v\xe5%]\x8c\xb2\x94\xa2c\u05f7\xcey\xedJC\x896\x15\xd8\x19\a\t\x8d\x90!\xa1\x17u\xcdM˭1\x18\xa41h-\x10Zw\xe4F\x1b\x81\xe0wM\xa66\x9a^\x92\xf0?\xff{\u007f\x9fMQ\xf0\xf2\xe2¥aJ\x92(&\xca2k!lDgN\x96W9Q\x98\xa0u\x8d\xa7\x02{ޘ\x1a)\x14M\x8b\xe2\x1b\xeb\xd8\xe9\x87~\xf7\xef\xc6\xfd=\xee\xe77\xdcL\xaf\xe9\xa5\x03\xeb\x92\x18'\f{C\xae\xefo\xfe\x18\xf8\xaf\x1f\xab\xc7c\x03\xf07\x1f\xfe\x97\xf6N\xf5|\x1b\xed:\x9eL\x18M& \b\xa4T\xffb>\xbb\xa7(2<?\xb0\xbb\xff.\x1etk)+0`,\U00067aaa\x1d\xab_\xf3\x01\n\x10\x861a\x14w?\xdf\xe8\x86<[QT\x15\xb5\xb6sC\xb3\x13\xa5ڙw8\x9bb\xbbOM\\!\xd2覦\xc8V\xa0m\x8ez\xdd4l\x1c\xd4\x1f\x85\x01eUQ\xd65\xa1\xef\x13\a\x81\xb5\xb6\r\xc2n\x02\xab\xab\xca&\xe5\x01\xa1o5\xed\x96\xf1\xbf\x03\xfd\xb7d\xa8\xdd\xf7N*\xe7\xc1\xeedIu\x85\x94\xca6\x16B\xb0X-)\x9d\xe7@\xd3Ԭ\xd6k\x9a\xa6\xe9\x1a%!$\xe3јA:p\x04ň\xd0\xf7I\x92\x94\xb3\xb3O\bB;\xf9_\xdd\\QU\x15MS[\xf7=\x97)\xd0\xee\xfe\x17\x9b\r\x9b\xa2\xfc}\xfc4k\xe7\xdc\x1b8\xc4\xc4\x10\x06\x11\xbe\xef\xbb\xf8\xe3\x98\xd1`B\x9a\xa4\x18m\x98/\xe6\xdc\xde]ct\xc3\xc9\xd1)\x87Gg\x04A`\v\x88\xcbQ\xb7\xaf\xd7\xfa\xe8o6\x1b\xce/\xcey\xfe\xe2+\xbe{\xf1\x1b\xee\xa6wn%\xa31Bb\xa4\x95?\x0ez\x96X\xd8\xef\r\x19\xf4Ǝ`\xa9w\xa0h\x18\x8d\xf689:s\x81?\x8a\xa6\xae\xad\xa5\xab\xf2:H\xb9q\r\x90q>\a \xdd\xfb\xa3\t\x027\xe1uaS\xd6\xcdq\xbd\\\xf0\xf2\xdb_\xf2\xfc\x9b_rp\xf4\x84O>\xff\x11\xda\x18\u07bd\xfd\x0e\xdd\xd4\x1c\x9c~JQ\xe6|\xf7\xfc7\\^\xbeeU\xe4\xf6Z\x14\x1fq\xd8k\xff\xcb\xc0\xded\x9f\xe3\x833\x84\x14\xdc\xde\\\xb1\x98\xdf\xd9\x10\xa9\xd1\x1e\x9f\u007f\xf6\x03ƣ}\xe20f8\x1c\xd9\x1c\rϺ\xeb\t!\x10fk\x15}\xf1\xf2+\xbe\xfb՟Q\x14YǠo9\aZ\x18j\x03\x83\xb4\xc7g'\xa7<=8b\xd8\xef\xdbU\xccN\xd1\xee\n\u007f{m\x8a\x9d\x82\xddA\xf3t\xeb\x00\xcf\xf7\xbb{\xbe\xe5Kx\xd2C\xb9u\x99\x85\xf8]\xc3'\xac\v\xa0\xfdw\xee5\xb4\xf2O\xa1\xdc\xcf|\x88\x14\x98\x96?\xe3\xb89v5#\xad\xa4\xb6\r\xd2r\xf7\xb6q9!R\xd9f[\xb5\xf6\xc2ΈK\xe9\x9di\xdd\x18\xfaiʿ\xff\xc3\x1fQ\xd5\x15\xf3՚\xa2\xaa \b:\x1b\xeb\xb21\x94\xb5\xbd>ʪ\xe8&|%\xb5{\x9f\xec\xf5\xf6 %\xb0i\x9d!\x95\xe3t$$Q\x8f\xe9\xe2\x8e\xe3\xfc\x848\xea\x11\x85\x11\xfb\xe3=\xae\xefo~ʣ+\xe0c\x03\xf07~\xfaw\xc4\x1bk\xbb\xe9\xd3\xeb\x0f8<:\xc5\xf3\x02\x94\xf4\xfeY\xddTǫ\xe5ԑ߬\xb3\xddV\xef\xacw&{\xdbq\u05f5\x85\xa6\x1f\x8eM[kX\xcf\xf7\x89\x9d\xfd\xad\xc1 \xb4\xa6\xcc\xd76(\xc4i\xddwI\x85\x06\xd3)\f|\xcf'\f#띯<\xa75\xd7\xe4٪\xbb\x89\xb5Ѭ\xb3\x9c\xa6\xd1\xc4QHS7\xe4e\x85\xefY/r)\x15\x9eg\xff_\n\x89\xd6\r\xab\xcd\xcaJ\xfe\x82\xc0N\xff\xce\xc3\xdf\xe8\xad\x1e\xbbc\xae\xef\xbco\xadT\xca\xfa\xa0\xdbI4N\xfb\xd4M\xcd|\xb1\xe8\xf2\xe9\xeb\xbab\xe5\x18\xfbݴ&\x04\xfb\xe3\t\xe3ᄬ\xc8\b\xfd\x90\xa3\xbdC\x928ao\xff\x800\x88\x98\xcf\xe7\\\\]R\x95\x95\x9d\x12\xebҒ\xa3\xea\xd2I\x9cJ6\xf9\x86\xd9\xda\x112\x1f\x8c\xa7;\xa5\xca`W\f\xbda\aoFQd\xbdЕG\xbfo\xa1\u007f)%\x9bl\xc3\xed\xed5MY2\x19M\x18\x8d\xf6\b\x82\xd0\xf9\xc0\xab.\x84\xa5n\x1a\x9a\xaab\xb9Z\xf2\xf6\xdd[\xce\u07fd\xe6\xd5\xebo\xb8\x9f\xdd[t@\xbb\x02\xe6y $\xbd\xa4\x8f\x1f\x04.\xc0h\xd4%6\xaa6K\x1e\x18\x0fǜ\x1c\x9f\x11\x871\xda4\xe4y\xb5\xdd!K\xeb\xbbP\x16\x99Տ;DHz\xbeU\\4\x15\x9e\x17\x10\x86\xd69\xb0\x9d\xfcuc\x9b\xcb\xd7\xcf\u007f\xc5\u05ff\xf9sz\xfd1_\xfe\xf8\x1f\xe1{\x017\xd7\xe7,\xe6\xf7\xec\x1f\x9cb\x10\x9c\x9f\xbf\xe6\xcd\xdb\x17,\xd6k\xea\xba\xf9\xa8\x85\xf2\xee{\xba7\xda\xe3\xe4\xf0\tR)\xee\xa7\xd7̦\xd7`\f\x93\xc9\x01_~\xf9\x13FÉe\xe8\x0f\xc7\xf4{\x03K~\fC\x94\xa7еm\\uUp\xf9\xea[\xbe\xfd՟Q\xba\xe2\xffP\xe7o0Rr<\x1a\xf3\xe5\xe9\x13\x8e\xf7\xf6\xdc\xf5/\xb7\x85\xbf-\xecn\xaany%\xed\xa4\u07b2\xebm\xd3*\xb61\xcd.\xf6\x18\xb7\xfb\xf7\xbc\xd6Iq\xbb.\xb0\xeb\x1b+\xb7\xf5\x94\xe7V\x05\xdb5\x83\x10\xaa\xe3\x03\xd8fɸ\xdeH\xbb\x89] \x8cp\n\x02\x0f\x8c\xa6\xa1v\x88\x01[\xabma\x95G[\x1f\x12\xdd\xed\xee\xa5k\x98\xaa\xb2\xa0vH\x801pvtė\xf3g<\u007f\xfb\xd6z|\xe49I\x18R\xe9\x06\xa95\u058c[P\xd7\x15eU\xe0)\x8f\xc6X\x98_HaU\b.'\xa2}\xb7\xab\xba\"\n\xbdθk؟p=}\xc7|9#\x89z\x04~\xc0\xdep\xdcZ\x03?\x9a\x02=6\x00\u007f\xd3\x11\x00\x9c\u07ff\xcf\xde\xfeA\x17?\xea\xacA\xfft3[\x90o\xd6x\x9eO]\x15\x0f\xea\xfan\x84g[\xb8\x1b\xddXF\xf0{\xf0\xbf\xdd\x05*\xc20\xc6\xf7\x02\x97\xcae5\xdbeUlM~\xccΡ\x00\x9d\x01O{\xb8\xc7q\x82\xef\xfb\x9d\xd1L\x91\xaf\xd1M\xe5\x0e#X\xe7\x05E]\x13\a\x01B\xc0\xa6,\x91R\x92\x84A\xe7\xbf߲\xee\r\x86\xcdfMY\xe6\u058c\xc89\xcf\xd9\bc\xba\x03d\xd7\x1d͘\x1d\xe6\xbfC Z\x85@\x18&\x14U\xc9b\xb9\xb0\xaccϧ\xacJ\xd6\xeb5\xb5kP\x84ۭN\x86c\x0e\xf7\x0f۔1\x9e\x9d=\xe3\xf8\xf0ę\x11)\x16\xcb9\xef.\xcfmB\x9e\x16\x9d\x9f}SW.ئ\xa6,\v\xa6\xab\xb5])8\xfbavv\xc6\xed\x13V\xcac\xd8\x1b[b\x1c\x10GQ\xf7\xf5i\xdacз\xf0fU\x96\xdc\xdf߰\\LI\xe2\x84\xc4Y\xfcz\x9eg3\x15p\x81HuMU\x94L\x173\u07bd;\xe7\xf6\xee\x92w\xef\x9es;\xbd\xb3\x89n\xc6\x16`ϷF?q\x94\xd0K\xfb\x04^\xc8hh\xa3n\xeb\xbar\x10\xb3m*\x86\x83\x11\xc7\xc7gDaL\xd5\xd4\x14En'4\xa9\xba\xa4\xba\xba\xaa0M\x85\x14¦=\xfa>eYX\x94E*\xe28\xc6\xf3\x03\xd7\xc0\xd9\x02\x9bg\x1b^~\xfb\v~\xf3\xf3\u007f\x8d\xe7\x87\xfc\xe0'\xff\x01i\xdag\xb9\x9crs\xf5\x96^o\x88\x1fD\xdc\xdd\xdf\xf0\xf6\xeds\xee\xa6\xf7Tue9\x1d;\xb2\x13\xf1\xe0\xba\u05cc\xfacΎ\xad2\xe3~z\xcb\xdd\xcd%MS3\x99\x1c\xf0\xc3\x1f\xfc}\xfa\xfd\x11F\x1b\x06\xc3\x11\xbd\xbeM\xff\xb3j\x8b\bLC\xd5X$\xe3\xf2\xf5w|\xf7\xeb?\xa7\xc8\xd6ې\x9d\xae\xa1\xd6xA\xc0\x93\xc3c\xbe8}\xc2x0$\fBG\xce{躸+oS\x1dQOt\x8d\xb9\x90\xe2\xc1\x9e\xbf\xd5\xf5\v\xa7\xc3\xf7Z)k7\x1d\v\x84\xb42]\xcfwi\x80\x9er\xc8\x02\x9dۧt\xd2W\xe1\x9c\x01[\x17L\xfbEv-\xd1\xee\xf2\xdb\xf8莟`\xacw\x83\xef\x05\x9d\xa1Q\x8b\x06\x80\xc4S6\x10\xc8\x04\x06?\b:4\xa8\xac\xac߅\x10\xf0\xfd\xa7\x9fp7\x9d\xb1ج\t<\x9f\xe9j\xcd2\xcf\x11\xc2\xd0\x1dD\x02\xf22#\x0eS+\xb3uj\x80F\xd7X3J\x85v\xc4Ӫ)\tM\xd4\xddE\xfdd\xc0\xfd\xfc\x8a\xeb\xe9\x15I\xd4#v!\\{\xa3\t\x9b\xcb\xf3\xff\xf4\xb1\x01xl\x00\xfe\x06\x17\u007fّ\x98\x8e\x8e\x8e\xd9;8\xc4\xf3l:\x9d\xe7\xfb\xffq\x91g?^\xcc\xef\xdd\x14&\xa9\xcbr[\xec;\xdd\xff\xb6\xf8\xd7M\xb3\xf3}\xf5\xce\xe1imr\xad\tJ\xdc\xdd\xfcMc\xa5kUݸ\x94<\xab\xf9o\x1fmt\xb0\xa7<\x9bZ\xe6\xc2K\xda\xddnYl\\Sb\x0f\xc4MQ\x92\x95%\x813?Yl\xacYW\x1c\x06\xce\xf6\xd5\xee5[\xd6s\x9e\xe7dEf5Ǯ1hs֍s9\x14N\x0fmv\x86jٲ\xa2\x8d@7\xb5\x8d\xf4\xf5\x02\xb2<c\xb1^\x80s\xd5+\xcb\xc2M\xfe\x96\x84\xa6\x9c\xb4\xb0\x97\xa6\x1c\xee\x1f\x92\xe59UU\xf1\xe9\xd3O\xf9\xfc\xb3/\xf1\xfc\x80\xa6\xaaX,\x17\xbc\xbb8\xa7(\n\x04\xc21\x99-\xf1\xafnj\xb4\xb1(\xc0l\xb3&\xab\xaan\x1d\xf3\xfe\xe4\xdfvx\xfdt\xe0\x92\xe5\x1a\xa2(!\xf0}\x9a\xa6!M{\xf4z\xf6\xef\x1a]\xb3X,\x98NoѺ&\bBk\\\xe3\xd9X`\x1b\xcdjs\xd47\x9b\x8c\xe9\U0010e2eb\v\xe6\xf3{\xae.\xdfp3\xbd\xeb\xecq\x8d\xd1\x1dl\x1c\xf8\x81\x9d\x84\xfd\x80\xc1`D\x1c\xa5N\x1e\xa9\xba\xa9>M\xfb\x1c\x1d\x9e\x10\x85\x91\xcbD\xd8X\x877\xcf\xc7\b\x8dT\x92\xb2̭\x85\xb3\x94h]\xe3\xfb!\x06AY\xdb\x15K\x9c\xa46\xa9\xd0A\xceMUS\xe4k\x9e\u007f\xfds~\xfb\xcb\u007f\x83\x11\xf0\x83\x1f\xfe\x03\x86\x93}\x8a<\xe3\xfa\xf2-`\x88\xd3>\xcbՂ˫s\x1b\xb3\\\x96\x1d\t\xee}\xe0\xbf-\xfe\xfd\xa4\xcf\xd3\x13\xeb\xc90\x9d\xdds{\U000ce9ae\x98L\x0e\xf9\xf1\x8f\xfe!\x83\xfe\x90\xb2(\x98\x8c\x0f\x18\x8f\x0fl\xd8N`M\xa0,Z\x04\xba\xdeps\xfe\x82\x97_\xfd%\xd9z\xb6%-\xb6\xd7>\x9a \f\xf8\xfe\xd9'<;>a4\x18Z\x02\xe6\xc3\xf6Ρ\xed-\x17En\xaf͝\xe7\xefI\xe5\xec\x92Uw=\v\x04R\tg\x0ed\v\".pH\b\x81\xf2$\xbe\xef\x13\x04\x11^\xe0\xed\x90\xf5\xcc\aICv] m\xd1\x15\xc6\xf9flM\xb3<\xe9\xd9\xf5\xa0i:D\xa7\xb5\x14\xf6\xfd\x10\xcf\vh\x9a\x9a\xb2.\xba`&)\xbd\a9\x16\xda\x0f\x1d\xe7\xa5$lj\xa2*b\x93\xad\x19\xf6z|\xff\x93O\xf8\xd97_Yo\x0f\x17'\xde4\xba#6\v!(\xcb|\a%l\x90£q\x9dL{\xdf\v!-\x8f\xa7\xa9Q\xca\x1a\x8a\x05~h\xd7\x00\xab{.\xef\xdf1\xeeO\x18\xf7'\x1c\xef\x1fs~}\xf1\x8f\xb5\xd6\x13\xe0\xfe\xb1\x9a<6\x00\u007f#\xa7\u007f\xad\r\xc7\xc7G\xec\x1f\x1e[\u05fa \"\x8eS\xb4\xd6\xff\xd5\xday\xa1\xfb~\xd0M\x9e\xed)\xf5>;\xbfq\x99\xeaf'G\xa03\xdfp\xd3G\x18\x84.\x83\x1bLSS\x17ֱ\xae\r\xf7\xd1\xef\x19\vicw\xfaQ\x14\x13\x86\x11\xa1\x1f\xb8\x9dnMU\x15\x94Un\xb5\xc0\bʺf\x93\x17()\x89B\x9fu^\xd0hM\x1cZ\x1bP\xa5\xfc.\xad\xae\xb3\xb6\xcd7\xdd\x0e\xda\xf7\x83\xce\xc2\xd6z\xb9뭵\xaf\x90\x1f\x12\xff\\\x93#]D\xed*[\xb1Z\xad:bUQ\x94\xac6\xabn\x0f\xed;\x19Q\x18\x04\xec\x8f\x0f\xa8\xeb\x86u\xb6a\u007fr\xc0\x97\x9f}\x9f$I\xc9\xf3\x9c\xd5zɻ\xcbs\xb2<\xb7\xa9f\xee@j\xe5~\x18\x1b\x85\xbb\xc93\x16\x9b\x9c\xf7\r\x16w\xf7\x00\xc6\xf9\xd1\xf7\x92\x9e\xf5\x18\xf0\xac\x15lY\x96\x9d\x8f~\x18\x06\x18\xa3Y-W\xdc\xdf]\x93\xe7\x1b\x1b\x02\xe4\xf6ƾ\xef\xa3<\xdfi\xa5\x1b\x96\x8b\x05ww\xb7\xdc\xdeݰZ/\xb9\xb99\xe7\xe6\xfe\x86\xa2,[&H\a\x1d\v)\x19\f\xc6DaL/\xed\xd3K\xfb\x96\x1c或`\b\xa3\x98Ã#\xe2(\xa6\xac*\xf2<\xa3i\xec\xce_\x9b\x06\xd3\x18\xee\xef\xef(\xf35Q\x10\xe2\xfb\x01\x9e\xef\x81\xf0\xa8t\x81\xef\xdb\xec\x87$M\xb7\xc13uEY\xe4\xbc\xf8\xeeW\xfc\xe6\x97\xff\x9a\xa6\xa9\xf9\xe2\xfb\u007f\x9f\xbd\xc33\x9a\xa6rN\u007fk\xd2^\x9f\xa2(\x99ͧ\xbc|\xf5\r\x8b\xcd\x1a\xe4\xc3,\x83\xddwT\x1bC䇜\x9d<%\x89\x13\x16\x8b)7\xd7\xd6\x19q2>\xe0G?\xfc\a\f\a#֫\x15\x83\xfe\x90\xe3\xd3'\x96I\x1f\xf8\xf4\x06#\xbbB+\xad\xb4\xed\xe6\xfc%\xaf\xbf\xf9\x05\xcb\xf9\xfd\x83{\xc5 \xd0X?\x88\x1f>\xfb\x94g\xc7g\f\x92>A\x10|\xe8\x84ٺ\xf39\xdd~\xe7\xde\xe7x\vRH\xbc \xc0\xf7=g\xe0\xb3]\x17\b\xe7\xf8\xa7\x9c\x9c\xb6\xddᷦ7a\x14\x11\x04\x96\x10ܮ\xb9\xd8\xd9\xd7\v\xe8䘲\x85텕p\"\x8c5\x10\x92~\x97Ʃ\x85\xc14\xa6SjH\xa4\x8d\xfb\x96\x16uS\x9e\x8f\xdfrB\xc46Q\xd3N\x10\x16\x11\xb0\xb1\xd4\x01U]\xe2{VB\xb8^/xvr\xc2\xfdb\xc1o_\xbd\xb4\x03\x83\xf3 \x10ۏ\x90\xba\xa9)\xaa\xcc\"\x99MM\xe0Y\x9e\x836ڮ\x01\xa4\xec\xf2!ʺ$\xf1|{o!\x18\xa4c\xee\x16\xb7,V\x96\xdc\x19\x851G\x93\x03\x06i_͖\xf3\xff\x18\xf8\xbf=V\x93\xc7\x06\xe0o\xdc\xc3\x18\xc3\xc1\xe11\x87\xc7g4M\xcdl6\xe5{\a'H\xa5\xfeA\xb1\xde\xfc\x93\xc5\xf4\x16a\xec\x8d^\xb8|\xfav:a'\x90\xc4h\x8d\xae\xf5\xc3\x1bvk3fon\xe5\x138ֿn\x1a\x9a*\xa3\xd2\rUSw,`m\x8c\xcb>٦\xa0E\xa1mH|\xcfN\x04\x8d#\xc0\x95e\xd6=\x87ZkV\x99%\xfd\xc5AHQV\x94UM\xe0;\xb8߳\xe1+\x0e\xf8\xa4э+4\xb5\x9b\x18<\x94\x83\x95\x85\x8b\x14n)\xc8b\xd7\xd2\xd4M4\xd2\x1dN\xd2E\xd8.\x96K\xd6\xd9\xda\xc1\xfb\x8a,˻\xe6\x02\x04Q\x10v\x819\x83\xfe\x00\xdf\xf7\xb9\x9fM\x89\xe3\x98/?\xfb\x92\xe1hB\x9e\xe7\xcc\xe7Sޜ\xbfa\xbd^\xb9@\x93\xad\x95i]ז\xf0\xe8\xfc\xfeg\xeb-\xf4\xff\xbb\x1eJ*\x06頛\xde\"\a\xa3j\xa3\x89\x1d\xe1\xafњ,_3\x9fOY,\xa6xʷ\r_\xd3X\xa5\x84o\x89fu]3_̹\xba\xbed\xbe\xb4\xe9\x8c77o\xb9\x9fސ\x95\xf9\xb6$I{X\vpE\u007f@\x1c%\xd6\xe5Ͻ\x97Jy\x96s\xe2y\x1c\xec\x1d\x90&=\xaa\xba\"/rt]u\x1a\u007fc\xe0~z\xcb\xd5\xc5+BO2\x99\x1c\x10\xc5}B\x91\xa2\x9a\x9aȏ\x89\xa2\xc4\xcaI\x9d\x17\xbd-\xfe\x05o^~\xc5o~\xfe?R\x149\xcf>\xfb\x11G\xa7\x9f\"\xa5b1\xbbc>\xbb\xc5\x0f\x024\x82,\xdb\xf0\xfa\xd5w\\\xdf݀䃽Ug\"\xec\x02\xabΎ\x9e0\xe8\r\xc86K\xae\xaf\xcf)ʜ\xf1h\x9f\x1f\xfe\xe0\xef1\x1cMX,gx\xd2\xe7\xe9'\x9f\xe3\xf9ֆ8\xe9\x0f\xf1\xc3\b]\xd7TM\xc1\xedۗ\xbc\xfe\xee\xd7\xcc\xefol\x83(\xb6\xd9\x18\x06\x83T\x92\xef?\xf9\x84O\x8eN\xe9\xa7)a\x10\xa0\xd9\xe5x\xb4E_\xecX\xf6ʝ)^t\xe6C\xbeڑ资A)\x1e8\xf8\xb5\x12A\xa5\x14a\x14\x12\x86\x91%\x18\xb6\xa4\xbeV\x9f\xe9\xa6\xeb6\xf2\xfa\x01Wǭ\x03\x85\x14x(k\x1e$T\xa7\xed\x17F\x83\x96h\xa7B\xf0T\xd0\x15\xff\xaeH(\x1f-\xac\x17\x04\x1d\bc\r\xb2T;\xb18\xe2^\xddTx:\xc0\xf7C\xfc 䇟\x95\\\xde\xdd\xf1\xf2\xf2¢ Π\xa8a\xeb#\x92\x15\x1bҸ\uf205\x16\xf2ojK6V\xcaCkK\xe2\xad\xea\xca\x0e\x00\xce\xcd0\x89zDA\xc2:[\x13\x061\xbddC\u007f2\xe4\xc9\xf1\x19\xb3\xe5\xfc\x8f\x1f\x1b\x80\xc7\x06\xe0o \xf9Orph\x1d\xe6\xc0pq\xfe\x96(\x8a\x19\x8c\xc64M\xfd_\xb5\x11\xbcA\x18\xdb\xfd\xf3\x8e\xa3߃\xc0\x9fNý\vL\x8a\xae8+\xcfGI\x8f\xc0\xb7\xc4;[\xfcs꺦n\x1a\xea\u038d\xcb~\xbd\xee|\x05,\xfc\x96\xc6=\xa2 t\xbbvk@T\x95\x99\x85\x91\xdd\u05efs\xcb\xd8N\xa3\xd0\xda\xfc\x16%\x9e\xf2\xac?\xb8\xb4\x06;-\xb4\xa9\x8d\r&*\xab\xaa\x9b\xe8\xed\xb4\xa9w\xa0\xde\xc6M!\xe2A1h\xa1U\xe9LR\x8c\x81\xf9|\xce:\xdb\xe09v\xf7z\xb3a\x93oIyq\x18\xd3K\x12\xaa\xba&\f\"\xe20f\xb6\x98!\xa5\xe4\xb3g\x9fsv\xf2\x84\xaa*\xdc\x14\xfa\x82\xc5r\x8e\x92\xaa\x93=a\xacэn\x19\xf0U\xc1|\x93\x91\x95\xd5ּ\xe9C\xa1\x05\x00\xbd\xa4\xef\xde;C\xe0\xcc{\xea\xba\"\x8ab\xbbǯK\xaa\xa2\xa0\xaeK\x16\xf3\x19h\x83\x1f\a\x0e\xb1\b\x88\xe3\x18\xe5[\xef\x82\xf9|λ\xcbw\xac\xd7+\xf2l\xcd\xcd\xf5[f\xb3[6Eх5!p\x16\xab6\xe7~8\x1c\xdbX\xe3\xe1\b\xbf%x\xb9\x83]\n\xc9d\xb4O\xbf7\xa0\xa9k\x8a2\xb7\x87z\xcb(\a\x97=\xf0\x8a:_!\x83\x90\xbc,\xf0\x82\x84H\xd8\xcf4\x8ac\x92$A\xb4\xd9\xf4\xba\xa1,\n.\xcf_\xf0\xab\x9f\xff+6\xeb\x15G\xa7\x9fr\xfa\xe4s\x82(!۬\x98MoЍ&\b=\xaa\xba\xe6\xe2\xf2\r/\xcf_\xa2\r\x1fD'\xbfOe9\xda?f2\xdeG\x979\xb7\xd7\xe7dن\xd1p\x8f\xef\u007f琉F\a\xacVs\xb2\xf5\x9a\x9f\xfe\xbd\xff\xc06X\xc6\x10'\xa9\x8bR\xb6\xfb\xec\xd9\xdd%o\x9e\xff\x86\xd9ݥM\x9at\n\x12\xdbX\v\xb4\xd0|rpħǧ\xa4.\xd4\xc7\xee\xdaEw\x87)!\x11JtIu\xbb\xbe\xfb\xd6\xc5\xd3\xc7\xf3\xad\xb4o\xeb\xe6g_\x85r>\xfejǇ@JI\x18\x85\xa4\xbd\x94 \x8a\x9c\xba\xc6I\xf7\x1aˇ\xd9:\x00Z\xfd?X\xa5L]U.\xd4\xc7a\x18Bu\xe6Xm\x8c\xb3iɉ.\xecH\ne#zy(\xb1\xe8\u0085\\jc\xf7\xc6\v\xb9\xfd4\\C\xe1y\x81\xf5\x06\x10\x92A\u007f\x82\xe7\xf9\xfc\xe4\x8b\x19\xbfx\xf1\x1c\xaa\nO\xb9dS\xa7\xe2\x15RPT\x05e]\x12zv\x9d \xbdк\x18\xb6(\x80\xb0+\xac\x1a\x97'\xe0\xdb{\xc7S\x1e\x83t\xc8:[\xb0\xc9Vl\xb25Z7<=:\xe3\xf9\x9b\x97\xff\xf1&\xdf(\x1e]\x01\u007f\u007f\xbdy|\v\xfe\x1auc\x9e\xc7\xd3g\x9fqz\xf6\x14\x80ˋs\xee\xefo88:\xc5\xf3\xfcguY\xfc\xd3\xc5̮\xb5\x84\x92\x94e\xd1\xed\xf4\xdbB\xc4\x0eiGk\xf3\x91\xfd\xb3A)w\by\xaa\x83؛\xaa@\xd7V\xeeW\xefXqnI\x84\x1a\xed\xb2\xb9\x93$%\x8e\x13g\x11\xab\xa9\x9b\x86\xaaȨ]\x18\x88\x01\xb2\xd2&\xfaE\x81\x8f\x94\xce\xe6WH|\xdf\x1ep6O\xdeN\x03\xad?A]\x97\xdd\xeb\xb1\x01Db\xbb\x9b4\x16\xe0|?\x1c\xa9m\x16\x94\xb2\x93Q\xdd4L\xe7S\xd6ٺ3\x1a\xca\xf2\x8c,Ϻ\xe2\x1f\xf9!\x83\xb4gs\xe7]X\xd1&Ϩ\x9b\x86'\xa7O\xf9\xde\x17?\xc0\x18\x98ͦ\xbcx\xf9\x1d\xf7\xf7\xb7\x96\xed\xdfԔeIY\x94\x94\xa55A1F\xd3\xd4\x05\xcb|\xc3\"\xcb\xf8\xa0B\xf1\x10\xb9\x0e\xfd\x904N\xdd\xe1-\xf0\x94\xb4к+\f-B\xd045\x8b圢,\xac\xeb\xa3;l\a\x83!\xfd\xc1\x10\x81`\x93m\xb8\xba\xbed\xb5ZZ\u007f\xfb\x9bs\xe6\xf3;\x8a\xb2\xa2(\xab\x1dkgI\x9b\xe70\x1c\x8e\xdd\xe4?\"\x0e\xa2nOa\x95\x17\x86~\xafO\u007f0\xa4i4y\x99QW\x05\xcaIR\x05\x82,˸\xba|CS\xac\x91R\x10&}\xc20E\xf9\x01Q\x1c\x91$)iڷ\xcd\x12\xa6#E\xde^\xbf\xe3\u05ff\xfc\u007f\xb1\x9cO\x19\x8e\x0f9}\xfa\xa5UfT%\xabŔ\xcdza\v\x1c\x82\xf9\xfc\x9e\xaf\xbe\xfd-EUu;\xf8.\xcbbw\x13\xa05\xa3\xc1\x88\x83\xbdc\xa4i\xb8\xbf}\xc7r\xb5\xa0\xdf\x1f\xf2\xbd/~\xc4d|@\x96Y\x14\xe5\xf4\xf4\x13&\x93\x03@\x10\x04\x11QܳF<R\xb2Y\xcdy\xfbͯ\x98^\xbf\xa3\xcc\xf3]\xfb\u007f\a\xfdk\xf6\x87c\xbe\xf7\xe4\x19\xbd4%\n\xe3\x9d\xfd\x8e\xb1ן\xe7\xb9\xc2n\x8b\xe8\xd6\xdd\xcf\xcax\xc3(\xc2\x0f\x03'\xb9S\xdb0\x1fa\xf3\x00,\xafc\x9b\x11\x10%1{\x87\a\x1c\x9c\x1e1\x98\x8c\t\x93\x18?\n,a1\x8a\x88\x92\x840\x8a\xad\u007fF`\xd7/\xc2\x15\xee\xb6\xd9P\x9eB*\xa7\x12\x90\nO*ۤ\xb0\xcd\x13\xe8\b\x8a\xca\xc3S~\xd7 \xd8\xe7G\xeb\xe2ԙ\f\xed6-<X+\xb2}=\x0e\x15TR\xd1O\x87\xfc\xf0\xf3\xefs0\x1e\xa3\x8dq+\x91mC'\xdcY\x95\xe7\x1bw\xe64\x1d\xff\xc9\x18\xd3! \xf6\xdb+\x8a\xb2xpO\xf5\xd3!Jzd\x85\xe5\xf9dyF?\xed\xf3\xec\xe4\xc9\x04\xeb\n\xf8\xf8xl\x00\xfe&\xec\xfd\x05\xa7gϘ\xec\x1dPU\x15\xef\xce_ss}I\x9a\xf6888F\xeb\xe6_f\x9b\x95ڬ\x97xAHSUh7\xfd\x9b\x0f\xa6\u007f\xed\xc8{\xef\xd5!\xad\xed!\xe5[r\x91\xefY\ry\xed\x8a\u007fK&\xd3\u0383[w\x8er\xba\x93\xdf$qJ\x1a\xf7\xacV\xbd\xb1\x93\u007f]\x15\x1d\x12a\xb0\xf1\xc0YQ\xa2\x94\"\xf0}6EI\xadu\xb7\xf3\x8c\x82\xc8z\x93\xbbC\xb4\xaakj]S\xd7M\xf7^H%\x1f\xb6-\xee\xf0\x10\x1fI\xfbS\xcec\xbd(K\xeegS6y\xe6\x9c\xd2\x14\xcb͚\xd5f\xdd5\x16\x9e\x90]\xb2_m\f\xbd\xc42\x90\xb3<\xe3`\uf41f\xfc\xf0\x8f\xac\xbd\xef\xfc\x9e\x17/\xbe\xe5\xf6\xf6\xc6I\x1e\x9d\xc1O\xd5t\xba\xffڑ\x9f\xb2\"c\xba\xb2\x11\u0082\x8f\x17\u007f\xb0\xcc\xea4\xee9\xdfs\x83\xa7\xa4\x83\x9a\x85\x83:5Qh\xd9\xfdy\x9e\xb1Y٤9\xe5\xfb\xee\xefB&{{\xf8~H]\xd7\xdc\xde\xdd2_,\xa8ʂ\xdb\xdb\v\xa6\xb3[K\x06,\x8bN\xb1\xd1B\xc4\x06H\xd3\x01\xbdt`\xc3n\xe2^ǥh\x0f\xdb \xf0\xe9\xf5\xfa\x18\xddP\x94\x19U\xe9\xc8_\xc2\x1e\xc0e]qu}N\xbe\x99\x83nH\xd2\x01\xa3\xc9\x11A\x94\x10EV\t\x92\xa4)\xcaII\xb5\xb6ʄ\xf9\xf4\x86o~\xfb\x17\xdc\xdd\\\x10'\x03Ξ}\xc9`8AH\xc1f\xb3d:\xbd\xa5*K\xbc \xa0\xa8*~\xfb\xedoX\xac\x17\xb6P|\x98b\xed\xa0\u007fk\xbesrxF\xe8)V\xf3\x1bf\xf3{\xc2(\xe1\xf3\xcf~\xc0d|HY\x15\xcc\x16\xf7\fz#\x1b\x92\xe4[\x8eC\x18\xc7\xf8\x8e\x80\xda\xd45o\xbe\xf95\x97o_\x90mV\x1dw\xa5\xfd\xb1\r\x9a$\x8a\xf9\xde\xd93F\x83>q\x1c\xed4%\xce$ɱץ\x92\xceF\xd8\x11\xf6\xa4\xc0\x0f|\x820\xb4?{ז\xb7\xfdzO9\x15\x80\xefv\xfc\x01\xe3\x83=\x8e\xceN\x18썬ɓ\xe3m('\x13\f\x82\xc0\xfe\x8a\x02\xfc(t\x92\xc2\x1d\x82\xa1ю0\xe8YҞ\xe3\x10\xb4\x05\xbc\xe5R\xb6\x06\x9a\xc2]\u007f\xb2\x93\xd4\n:S\xa2\xf7\xd9\r.>\xfb\xfdu\xccC\x17n\xe5$\x82\x968x09\xe2˧\x9f\xdaFI\n\xbc\x1d\x02o\x8b\x9ed\xe5Ƣk@\xa3\xab\aY\x16ʭJlVG\xb9=ی!\t\x12\x92(\xa1\xaeJ\x96\xeb9\xcb\xcd\x1cc\fϬ\x12\xe4?}\xac,\x8f\r\xc0߈\xe2\u007fxx\xc2do\x9f\xa2\xc8y\xf3\xfa\x05\xd3\xfb;@pz\xf6\ti\xaf7\xac\xab\xf2?_\xcc\xee;\xf9X]\x16\x96\xd8\x03\x9d\x11K\xbb\xabl5\xb9;\xa9\xe4\xce\xd5\xcd\xca\xed\xa4#|\xf9\x9eo\xd7\bU\x81FS\xe9\x86\xda\x15\xff\xd6𧅼\x85\x93\x8d\xa5\xe9\x16\xfa\xaf\x9b\x8a\xc6\xf9\xbd\xb77r\xa3\r\x9b\xbct{\xff\x80\xdc\xed\xfd=e\x0f\xc00\b;M3\b\xaa\xaaBk\xddE\x18\xb7\x05\xc9B\x8e\x96\xb4\xd4i\xe9a\xe7\x80r\xfbt\xc7%Xg\x19w\xb3;\xca\xd2Z\xfb\x02,WK6٦\x8b<VR\xda(Z\xa9\xa8\xea\x9a(\xb0\xb2\xbb\xf5fE\xbf?\xe0\xa7?\xfe\xfb\xf4{\x03\xee\xefo\xf9\xf6\xbb\xaf\xb8\xbc\xb9\xb4\\\n\x17\xa8\xa3k\x8d\xd1V\xea\xa7\x1b\x8b\bdy\xc6\xfdjMY\xff\x8e\xbd\xff\xce\xc7\x10\x06Vj\x891\xae\xf91\x96\xd9,\x15\xba\xd1(!\x89\x82\b\xad\x1b\x1b'\xdcXV\xbdt\xf0\xfb\xde\xde>\x83\xfe\x10\x8cf6\x9fqw{G]\x95L\xa77\xdcO\xaf1\xdaPT%UU\xed\x98\x1aٟ\x13\x86\x01\xc3\xe1\x84^:\xb0\xfc\x03%\xbb\xbf7\xee}\x1d\xf4\xac\x0f@U\x16\xd4e\xe9vت\xe3\x87\xdc\xdc\\\xb2\x9c\xdf\"\x8d&\xed\x8f\xd9;|J\x10\x84\x84AH\x9a$$\x89%\xfc5\x8duF,\xf3\x9c\xf9\xec\x8eW\xcf\u007f\xcd\xc5\xf9\v\x94\xe7s\xf2\xf4\vF\x93\x03\xfc  \xcf2f\xf7\xb7\xcc\xef\xaf\t\xe2\x14\xa1<^\xbe\xfe\x8e\x8b\xab\xf3\x0e\r\xf9\xb8\x89\x92A\ti-\x8b\xe3\x84b3g:\xbdA(\x9fO\x9e}\x8f\xfd\xbdc\xb4\xd1,\x97s|/\xe0p\xff\x88\xc1hܑJ\xa3$\xe9\b\x917o_\xf0\xe6\x9b_\xb2^\xcd]\xe1\x94;\x8aw\xdbt~vr\xc6\xe1hB\x14\xc6]\xd0\xd1.\xa1\xb2u\x9d\xb4v\xbd\xb2\x9b~\xdbB\xadܵ/[\xb6\xbf\x14\x9d]\xaf\xe7T8q\x123ޟp\xfc\xf4\x8c\xfd\xc3\x03\x82(\xdc!\x13\xba)^y;̓\xe7\x92\x15U\xf7\x19\xe3в\xad\xf1\x90K\t\xdc)\xe7]\x16\x01;\x16\xc4B\xa1\x84\xea^\xbb\xd8QW\xec\xa2W\xdb)_\xbc?\xfb\xb3C\x05p\xd9\x04\xaas?\f\x83\x88\x9f~\xf9#&C\x9b-\xe1{\xaa#D\xb6j\x80\xaa\xae\xc8+\x1b\x1aU\xb7)\x81\x8e\xd0+\x90\xae\xf9\xb7\xbf/\xab\xc2]\xb7\x06\xa9<\x06\xe9\x18\x03\x14E\xcet~GUW\f\xfa\x03N\xf6\x8f\xfe\xf8\xb1\xba<6\x00\u007f\xbd?\x00\xa5x\xf2\xf4\x13\x8eO\xce\xd0Zsu\xf9\x8e\xf5j\tƐ\xa6)\xa7g\x9f \x84\xfcϳͪ\xb7^\xcdQΒ\xb6q\x91\xb6`\x1eL\xff\x8d~\xb8\nؽ;۔0!\xa5\x8d\xe85\x9a\xaa\xca\x01\x8b\x18\xd4.髃\xfe[\x14\x00\b\x82\x88^\xda'\n\"\xa4\xb4\xe6\x1f6`\xa7pis\xf6f\u038b\x92\xaan\x88\x03\xebf\x97\x15e\a\xfd\a~`w\xce\x0evlꪛ\xac\xbb\xe9\xd9\x1d\xba\xbb\xa3E\xdd4\x94u\xd31\xf7wW&\x9e\xf2Y\xafV\xdc\xcf\xee]d\xafO\xd3h\x96\xebU\a\x17\xb60g\x1b\xb0c\x8cv\xff\xd6c\x93m\xf0|\x9f\x9f\xfe\xe8\xefqtp\xc4\xfd\xfd-_}\xf3k\xde]\xbc\xed\xd4\a\xa614\x8d\rB\xa9\x8d\xe5Gh\xd3PU\x05\xf3͚MQ~\xe0F\xf8\xb0\\\x19<\xe5\x13\x85\xa1\xb3\xe1\xb5\xf0\u007fU\x16ve\xa2-I.\b\x02\xbb\x13\xcd3\xf2<\xc3\xf3-\xf1\xcfhC\xaf7\xe0\xe0\xf0\x18\xa5\x14y\x9essk\x95\x01\x8bŔ\xbb\xbbKtS\xdb\xe9\xbf\xc8w\x02\x06\xb7G\xff`0f<\xdeg<\x1c\xe3\xf9\xbe[\xdb\n\xe7\xaeؐ\xc4\t\xbe\xe7QV%uU!\x9d\t\x8d\v\xab\xe3~v\xcf\xdd\xdd\x05\x9e0\xf4\x87\xfb\xec\x1f=uR1\xab\xeeH\xd3\x1e\xbe\x17\xd8\xc8\xe5\xa2$۬Y.\xa7\\_\xbc\xe2\xed\xeb\xef\xd0M\xc5\xc9\xe9g\xec\x1d\x9c\x10Ɖ5aZL\xb98\u007f\x8e\xe7\x05$逻\xe9=\u07fd\xf8\xb6\x83~?6\xfd\xb7H\xd6\xdeh\x8f\xfd\xd1\x1eM]2\x9f^S65\xa7\xa7\x9fq|\xfc\x04!\x04\xcb\xe5\x02\x10$a\xcc\xe4\xe0\b\xcf\x0f\x10B\x11\xa5\x89\x93\x9c\n\xcal\xcd\xcb\xdf\xfe\x9c\xd9\xfd\x8dK9T]X\x8ev\xfc\x85\xe3\xc9>O\x0e\x8e\x88\xe3h'l\xc9\x15aG\xa8\x93-<\xee\xe8\xacRI+\xd1\xf3=Gt\xf5\\\xf1o\xadz-\a\xc0\x93\x8a8N\x98\x1cL8zv\xc6\xe1\xd91頇\n\xbc.\xfc\xa7\xf5Z\xb02B\x97\x06\xe8y6\\\xa8#Ê\x8e\x84\xd8\x16\xf9\x8e\xad߹\t\nv|\x89\x1c\x94/\xb6\xbe\x03\x9dű\x81\x8f\xcc\xfe\xbbc\xbe\xe8\x1a\x81\x8f@\x00;_\xd3\x15m\u0cf3O\xf8\xa3/~\x88\xef\xf9\x04Ju\xe8\xce.Yq\x93\xaf]\x8e\x85\x8d\x9cV6\xee\x1c0\xb6\x81r<\x9f,\xdf<@\x06\a\xe9\b\xdf\vht\xc3|5#+6H!yrt\xf6L\b\xf1\xd3\xc7*\xf3\xd8\x00\xfc\xb5\xdd\xf9?y\xfa\t\x93\xfd#ʪ\xe2\xe2\xdd\x1b\x16\xf3i'\xef9>}\xca`4\x0e\xea\xba\xfa\xd3\xe5|f\t2JR\x97y\x97\xa1\xbee\xe6\xdbi\xbd\xd5˿\xffP;1\xa2\xcaņVe\x06Z\xd3hCU\xdb\xdd\xff\xd6\xd1\xcb\x05\xfe8\xb2M?\xed\x13\x85\x11\x9e\xefQ\xd7\reYv&8mhOQU\xe4UI\xe0[/r\x9b\xf0g\b}\x8f\xc0\vl\xd3᎗\xb6\xe10f\x9ba`ܡ\xa1\xa4\xb57\x15BR\xd65eU9\xa3\x12\xb9CL\xb2S\xc1r\xbd\xe2~>\xeb\x92\xdf\xca\xca\xca\xfc\xaa\xba\xea&1%\x15\xbe\xef\xd1s\f{\x83\x85G\x8b\xcaJ\x12\u007f\xf4\xe5\x8f\xf8\xf4\xe9g\xccfw\xfc\xea7?\xe3ݻ7\xddx\xd2&\xa0\xb5ix\xdah\f\ruS\xb1\xca6\xac\xb2\xf2\xc1A\xf6\xf1\x9bL\x12\x05\xb13\xfc\xb1$\xa8\xa6.i\x1a\xeb\xa8WV\x05\x06\xbb\"\xb1\xfb\xd0\fc \b\xad-\xb3\xf2<\x8e\x8eOI\x93\x1eZk\xeeg\xf7̗s\xb2͒\xfb\xbb\v\xaa\xaa\xc0\x18\xc1\xba\xc8;\xe2_w\xa8\x1bC\x14\xc5\xec\xef\x1d3\x1a\x8c\t\xc3\xc8j\xfd\x9d\xeba]U\xb6\xd0\xf8>\xa5[\x05\xb5Num\x1b\xb9^[f}\xe4I&\x93#&\xfb'6v\xd8h|\xe5\x11\xc7\tq\xd2w>\x10\x86҅G\xcdg\xb7\\\xbd{\xc5f\xb5\xe0\xf0\xf8\x19\x87'Ϭ\xe6^\xdb\xef\xf9\xee\xcdw4e\xc9\xf8\xe0\x84\xbc,\xf8\xfa\xbb߰ެ\xb6\r\xe0ǚ\x00\xad\xe9\xc5)'\x87'H)X\xccoȳ\x15\x93\xbd\x13NO?\xc3W\x01\xab\xd5\xc2\xc9\xed\x04Q\x14\xd3\x1f\x8c܊# \b\xa2nR\xbey\xf7\x9a\x9b\x8b\xd76\xae\x98\x1dm\xbb14ڐF1_\x9c\x9eҋc\x02?\xb0ץc\xe0w~\xfe\x8e\xf5/\x9c=\xaf\xe7\xde\xcbV\xc7߮\x04\xa4\x94\xee\xbe\xf3:\xbf\xfe\xb4\xd7\xe7\xe0\xec\x98\xfd\x93#Ҟu\xe1l\x110\xe5\xfb\xd6#\xc0\xf3\xba\xa9ۆ\xfb\xec\x06\x03\xe1\xee=\xf3\xb0\b\x1b\xb6ɂb\xcb\x01\xe1#Ӻ\xbbѶ\x1c\x8b\xf7\x8a\xf8\xeeG\xf0a\x8b+\x1e\xac\f\xba_bw\xfbd\xaf\xc1~\xda\xe3\x1f\xfd\xf8\xef\xf1\xe4\xe8Ȯ\x06\x95\xd7q\x92[\x9eOQ\xe5\xdd}\xdb8\x14\x00\xc7\xff\xb1\xef\xa1\xe5W\xe4ef\r\xbc\x9c\xb7E\x14ƤI\x1fm4\x9b|\xcdb5Gk\xc3\xdeh¨?|D\x01\x1e\x1b\x80\xbf\x8e\xc5\xdf\xe7ٳϙL\xf6\xc9\xf3\ro\u07fc\xe0\xfe\xce\xc2\xfe\xdah\x92\xa4ϓ'\x9f\xa2\xa4\xfaO\x8al}\xbcZ\xdc#=\x0f]\xd7\xe8\xba~O\xe6\xe3\x88z\xa6\x95\xd6<\xb0$\xe92\xda[\x88/\xf0\x02\xb7\xb7\xb7\xb0{\xd5N\xfe͖\xe5\xab;\xf9\x90t\x99\xef\xb15\x80њ\xa2ʩk\x1b~\xd3>jݐ\x15\x166\x0e}\x8fMQ\xd14\x06\xdf\xf3\xba\x18\xe36\xb1\xcc\xe6\x12\xd4]:\xe1\x83\xe7\xea:}\x04N\x82f\r\x85\xc2\xc0\xdf!\x03\xd9\x03q\xb1\\0_Α\xceF5/r6yf\xddú\xe2o\xb5\xfei\x9c8\xdb\xdeښ\xe745UY\xf2\xd9\xd3\xcf\xf8\xd1\x0f~B\x96\xaf\xf9\xf5o~\xce\xf9\xf9\xebn\x9a2N\xc2\xd6*\x1dt\x8b\bԍ\xd5\xfbg9\x9a\xe6a\x95zo!*\x80\xd0\x0f,\xc9\x0f\x9bߎ#=*\xe9ٕMmI\x80ʳ\xfe\xe7eY\xb8B\xedY\xa2\xdbh\xc4\xde\xde\x01R\n\xf2\"\xe7\xee\xf6\x96l\xbd\xe2\xfe\xfe\x8a,_\x03\x82\xa2\xb6\t\x8b\xe2\xc1\xf4o\xff\u007f29d2\xda#\xecT\x17\xb6\x81\xaa놲\xb6\r\x8c\xcd2\xd0NCnC\x85\x8c\xd6\x14E\xc1\xdd\xdd%\xa1\xa7\xd8\xdb;\xa17\x98\xa0\x9cA\x8c\x92\x10\x85\x11\xbd\xc1\xd8^3\x18\xaa\"\xa7(r\xd6\xcb)\xb3\xbbkno.\xd8\xdb?\xe6\xf4\xc9\x17D\x91]\x11d\x9b\x15\xd7\x17\xaf\x99\xde^qx\xfa)\xc2\xf3y\xf5\xe6\x05\x97W\xe7[\xe6\xfc\xfbF\xff\xc6 1\x84\xbe\xcf\xc9\xd1)Q\x10\xb2\\\u07b3^\xdc\x11\xf7F<y\xf2%Q\x14\xb1\xceV\x0e\xc1\xb2\xfb\xfd~\u007f\xe8\xbc\xf9\x05Q\x92v\x9c\x88\xba*\xb9z\xfd\x1d\xabŽ\xd3ȷ\xfd\x85\xbd\x8f\x84\x94|zr\xcad0\xb4\xae\x8b\xbe\xb7U\x9f8\x8eJ\xeb\xe9\xdf\xeeՕ\xe7`y\xdf\xc3\v|{ߵR@\xc7ԗ\xca^\x8f\xbd\xfe\x80\x83\xb3c\x06\x93\x11\xca\x11\xf8\x84\x14\xd6)\xd0y\xf3\xcb\xee\x97\xdaj\xfb\xbb\xf0+\xd95\x9e\xe6}~\x04\xdd%\xbc\x85\xec\xc5G@\xfb\x16\x01\xd8-\xf4B|\xd8\x01\xec\xac\x05\xe0\xf7lf>\xd2\x18\xb4\xab\x12O)\xce\x0e\x8e\xf9\xf7\u007f\xfc\x0fH\xa2\x88\xd0\xf7v\xa4\x9c\x0e\xbdl\x1a6Ŧ+\xec\x8d\xd6]\xfag\x977\xa2\xac\x1dpVlv\u0095$\xc3\xdeȞCu\xcd|5C\xeb\x86\xd0\x0f998~l\x00\x1e\x1b\x80\xbf~\x93\xff\xd3g\x9f1\x18\x8dY\xaf\x96\xbcy\xf9\x9c\xcdz\xe5رv\xfa>9}\xcah<\xa1\xa9\xab?]-\xa6\x94E\x8e\x94\x8a\xbaʷ\x13\xff\xce\x01И\xe6\x03\v`[\xfceg\x8f\v8ٗ\xa6\xaa\n\xa4\xc0\xb1\xfem\x03\xa0;$\xc1tR\xb78\xea\x91\xc6=\xe7\x15`\vEU\x16\xce鯥\xf1\x18\x1b\xe9\xab5\xa1\xefQ\xd6\rEi\vm\xe0\xfb\xd6\xc0\xa6ۭBUU\x80\xa1\xd6\xf5\x96\xac\x06݁g\f\x94UM^\x14\b)\tà\xf3#o\xfd\xe4\xad\xc6?\xebdS\xeb|C^\xe4\xa0m\xb4\xa8\xe7\\\a}? \n#\x04PV6\x98\xa7њ\xaa,9><\xe6\x1f\xfc\xf4\x1f\"\x10|\xfdկx\xfd\xe6\xc5{S\x8c\x8dAm\x83S\f\xb6\x11\xc8˜\xf9fCc\x9a-\xe1\u007fG/\xbe\xdb\b\xb4\xdbV\x1b\xa3\xdaN\xff\x95\x1b\xbc\xac\xb7\x81\x94\x92$\x8e\x91RZ\x97Aa\xf7\xc2F\xd7x\xbe\xcf\xc1\xc11q\x1c\xa3\xb5f:\x9d2_̘\xcfoY\xaf\x176NWk6y\xf6P\xee\xe9\xb8\x13i\xd2\xe3\xe8\xe0\x84(L\xb6\x93\xa0\x10\x96\xf8Xl(˜\xaa.\x91`\xfd\x19\xa4r0\xac\xe58,\x96S\x841\xecM\x0e\t\xa3\x14\xa9<\x9a\xaa\xc44%I\x1c3\x18\xef\xe1y\x01\xda\x18\xaa\xd2\xc6>\xaf\x163V\xcb\x19\xb7\xd7\xe7$i\x9f\xd3g_\x12F)Z7dٚ\xd9\xf4\x86\x8b\xd7\xdfrp\xfc\x84\xb87\xe2\xea\xfa\x92o\xbe\xfb\x8d\x8bL\xfe\xb0\xb4H)I|\x9fa\x10p4\x1cҏS\xf2<cq\u007f\x85\xef\xfb\x9c>\xf9\x1e\xbdt@Y\x16Tu\x89\x92\x8aM\xb6B\"\xd8\xdb?B7\rahC\x96Z\xfe\xc5z>\xe5\xea\xfc%e\x9e\xef\xfcL\xabZh\xb4\xe6p4\xe6\xc9\xc1!\xbeoM\x9a\x84T\x1d쭤B\xb2մ\v7\xb1[\"\x9f5\xb6\xea\xa4|J\xee\xc0\xf8\n\xcf\xf3\x19\x8e\xc7\x1c\x9c\x1e\x92\x0e\xfb\ue6b7\x85\xbfC\f\xa4\xe8\x1a\x15!-Qp\x17\xc6ǥ\xf9\xd95\x9dy\x98\xfc\xf9\xa0\a\x15\x1da\u007fK\xde{\xafW\xed\xdc\x15?\x8cV\xda\xcd*0\u007fE\xc9\xff+XN(\xe5\x11\x05\x01?\xf9\xfc\a|\xf9\xecS|\xcf\xc5u\x9b\x87\xe3J\x96[\x1f\r\x1c\n :%\x82%\xd1*i\a\x8aU\xb6x`\xabݏ\a\xf8\xcaF\x06/V3{\xaf\x03G\x93\xc3\u007f\xec)o\xf2Xu\x1e\x1b\x80\xbf\x1eo\xb8\x94\x9c=\xf9\x84\xe1h\xccz\xbd\xe4\xf5\xab\x17l6\xebn\xf2\xd1Z\x93&=\xceΞ\xa2\xa4\xf7O\x8a<\xfb\a\xcbٽ\xf5\xb7w\xe63\xa6-G.\x1cG\x9bm\x10\xc7\xee\xf0\xb4\xcd\x17\x97[\xb6\xaf\xe7Q\x15y\x17\xf9Y\xd7\xef\xed\xfd\xcd\xd69 \fc\xfa\xfd>Q\x18\xa0\xa4\xb2R\xbd\xaa\xa0,\xb3n\x05\x01\x90\x97\x15U\xdd\x10\xf8\x16\xae\xb4{\u007f\xba\xe2\xbf\xdd\xe9\x8b\xce`H\x9b\x87\xf1\xc5v\xfa\xb7佲*)+\v\x05\xc6a\xe8H}\xa2\xfb\xbb\xd9jEQ\x95\x96L\x84`\x9dm\xa8\x9c\x1d\xb2\x94\x02\xdf\xf7\x88\xc2\xd0\xfa\xa4+\x0f)\xa0\xac*\x1a\x87\x924M\xc5h8\xe6\x1f\xfd\xfd\xff\x80$J\xf8\xf6\xbb\xaf\xf8\xe6\xbb\xdfZãvg\x8a\xf8\x80\x05e\xb4\xa6\xaaJ\xe6\xebM\xe7\x92h\xb6L\x8c\x0f\xd6\x00\xca1\xb3u\xd3@m\x9b\x87\xba\xa9Цqn\x8f\rFׄ\x815yi\x11\nߑ,\x1b\xad\x19\r'L&\xfbH)\xc9\xdc\xee\u007f>\xbfe1\xbf\xeb~\u07baȩ\xeaf\xbb\x9b\x95\xa2[\xa7\x1c\x1d\x9e:\xb3\x1f\xd1\xed\u007fuӐ\xe7\x1b\xf2\xc2J\x1f\x95\x94VJ\xa6<\xf7:\xec\xd7dن\xb2\xc8\x19\r\xc7(\xdf\xc7\b\xe1̞6\xceG`\x8f8N\xad{dY\xb0\xd9,Y\xaff,\x16\xf7\xdc\xdf\xddP\x95\x05ǧ\x9f\x11\xc7=\xaa\xaa [/٬\x96\xbc{\xf3-I:`rx\xc6r\xbd\xe0\xb7_\xff\x82\xf5f\xd3\x199\x99\x9d\xd6Ry\x1e\xbd8%\xf5=zaH\x12\x04\x94ْ\xfb\xfbK\xd05G\xa7_0\x18\ue8cd\xa6,\v\x94\xf4\xd8d+\xa6w\xd7\xec\xed\x1d\x12ǩ->i\xdaU=c47\xe7/\x99\xde\\\xb9\xf4;\x9c\x05vM\xad\x1b\xa2 \xe2\xf3\xd3S\x92(rr:oK\x98k\x19\xf5\xd2z\xf2\vW\xe0[\x8d\xbf\xe7\xabnE\xa0<\xb7\x06\xf0,\x12\x10\xf8\x01{\x87\a\x8c\x0e&Di\xd25\xe6\xad\"\xa0\xf3\xfcߙڥ\x92\x0f\x1c/w\x11\x11\xa35\xa61\xdb\xfd\xff\xef\x98\xf4\xdbB.\xe5\xc7\x16\xfa\xad\v\xe1C4\xa0\xeb\x83\xdb8\xe3\xff/\x9c{a\x10\xd0O{\xfcO\xff\xe8\x1f2HS|%:آ#\x036%y\x99u\xd6\xc0\xad\xf1σ\xef\xe3\xc7\xe4yF\xed\xb2F\xb41\x84AL\x12\xa7\x1d:\xb0Ζ\x18\f\x83^_\x8d\xfa\xc3\xff\xe4\xb1\xf2<6\x00\u007f-\x1eGG\xa7\x8c'{l6k\u07bczAQ\xe4ۘQW?\x8e\x8e\xcf\x18\f'h\xdd\xfc\xe9z9c\xb3YZȶr:yc\xb0\xffg:\x05\x80u\xe9\xda\xfa\xcf\xee\u0087\u0099t\xf8\x9eGS\x15\x18ca뢮)\x9b恬\xa6\xf5\n\xf7<\x9b@\x18\x05a\x97\x9c\xd7\xd4%E\x91\xa1\xebm\xe1.뚼(-\xa9\xc9\xf3Ȋ\x12m4\x81\xef\x13EQg-ے\xf9\x8cS\x17\xd4u\xfd\x11\xa4²\x81ۢ\x14\x87\x11\x9e\v\xbb\x11@^\xe4,V+\x8cn\b\x9c4n\xb5Yw!1\xd2!\x0e\x83\xb4\x87\xe7<å\x8b\x13\xad\xdd\xebl\xea\x864J\xf9\xf7~\xfa\x0f\x19\rƼ~\xf3\x92_\xff\xe6\xe7Tu\xe3tQ\xb2[Sh\xe8ȍV\xef_1߬\xc8\xeb\xf2=\x9b߭\xfb\xe2\xf6\xc6\xdaNO\n\x89\xd6\xd62X7ug\xd1\xda8\xd4%\x8e\x13<\xe5SW\xb5#v\t\xea\xba$\bB\x0e\x8eN\x88\xa2\x88\xa6i\xb8\x9f\xdeqs{\xc9lzc=\x17\x84 /K\xb2\xa2\xe8\x98\xd9Bl3\xe0\xfb\xfd\x01\x87\a\xc7\xf6=t\x85EkM\x96mXoV\xd6|(\bI\xe2ԑ'm\x94o+s,\x8a\r\xbd\xb4\x87TV\xa5`\xdd\x0es<O1\x1c\xed\xd1\x1b\x8clSTW\xac\x963֫9\xb3\xe9\r\xabŔ\xc5\xfc\x8e\xf1\xfe\x11i\xafOU\x97,\xe7\xf7\x14E\xce\xed\xcd9UQp\xfc\xe43ʺ\xe1\x9b\xef~\xc3\xcd\xedU\xb7\xde\xd9\xf6[\x96\f:\x19ڔ=OJ\x8b$!X\xaf\xe6\x14ٜ\x83\x833\xf6\x0e\xac\xadoQd ,\x82\xf2\xee\xddk\xc20\xe2\xf8\xe4\x89\xd5ԧ\x16\xb9Ў\x81Rd\x1b.^}K\xb1Yv\xc4S\xa3k\x8cnPB\xf2\xe9\xd1\t{\xc3\x11\x9e\xf2\b\x03\u007fk\x93+Zv\xbb\x00i\xd7(\xbe\xd7\x16~+E\x15ؐ\x1d\xfb疬'\x85 \x8c\"\xf6\x8e\x0f\xe8\r\xfb\xf6\xcf;\xad\xbcx`\x15ܒ6w\x89y\xef\xa3z\x06\xd0uCS\xd5\x1f\xe7\x9e\xec\xf0\x03\x1e\xfe\x99|\x0faٽf\xb6\x04\xc0\xdd_\xadW\x00\xff\x0e\v\x80\xdf\xf5\b]$\xf0\xf7\x9e}Ə?\xff\xde\xd6\f\x89\xf7ɀ+\vo\xc2\x16\x05\xd8i\x00\x82 \x04\f\xb9[\x17\xb4\xc4\xe1~:\xb2\xc8b]3]N\xad\xf1\x95\xe7s\xb8w\xf0\xb8\x06xl\x00\xfe\xff\xff\xd8\xdb?\xe0\xe0蘪,9\u007f\xfb\x8a<Ϻ\x9d\xb6\xd5\xdb7$I\xca\xf1\xe9\x19\x9e\xef\u007fYU\xe5\x1f/\xe7w\x9d\x0e_\xeb\x06\xb4\x9b\xd0:\x12\xa0\xb3\xe8t\xfe\xd8\xc6\xed\xed\xb7\x87\x8a\xe8\x0eS\xb4\x9d~\xad\xec\xc6\xda\xfd6͖4\xd8Z\xe4J)mF}\xe8\x02j\xb4\r\xb9\xa9ʂ\xb2,\xba&\xa3q\xee~\x06\xf0}\xdf\"\x01Uc\x89aQd\x19\xec\xee\xb8\xd0\xdaN\xc0V&VoC\x89h\x19\xc3\xd2\xfa\x014\rJI\x82\xc0\x1e\x9e\xed\xcfZe\x1b\x96뵛$B\xaa\xbad\xb5YS7\x8d\x955JE\x1aF\xec\x8f&xʺ䵇C\xe3\x10\x0e\xa35\xa1\x1f\xf0\x93\x1f\xfe\x94\xa3\x83c.\xaf\xce\xf9ů\xff\xbck\xc2Z\xe62;\xcesm\x93\xa5\x9b\x9aU\xb6\xe9Ѝ\x87\a\xb2yp,\xb6,\u007f\xb0\xdae\xdf\xf3\x1d'\x01\x1b\xf3\xdaj\xa0\x8d!\xf0\x03\xe28q?\xce:\x9f\x19\xc7ŘL\xf6\x19\x8f\xc6\b!\xd8l\xd6\\\\\xbc\xe5\xee\xe6\x9dͦ\x17\x82FkV\x0e\xfao\xa78ܵ\xa4\x94\xc7\xfe\xde!q\x98t\u007fo\x8c&/6\xac7K\xb2\"'\b\x02F\x83\x11q\x14S;WCml\xa3T\x14\xb9u\x89\x14\x92\xb2\xb0\xa6G\xba.\x90R0\x18\x8c\x18\xef\xfd\xbf\xd9\xfb\x93\x18\xdb\xd2\xed<\x10\xfb\xfen\xb7\xa7\x89\xf66\x99\xf92_CR\xd6cQ\x1de\xaad\x14T2\r\x17\x04<\x1b5\x90\f\x01.\bЈ\x13\xb3@@SO\f\x8f<\xf5\xc4@\re\xa3\xa6\xf6@U@\r\f\x1b6\\\x92%\xd9(\x91~l^\x9b}\xde\xfeFs\x9a\xdd\xfc\x9d\ak\xfd\xff\xde'\"\xee\xcd\uec6a$\xc6!\x92/\xf3F\xdc\x13'\xf69\xfb_k}\xebk\x1ed{\xd6햚\xd4\xcb\xd7/\xb0\xdd\\\xe2\xfa\xea\x15\xaa\xb2\xc6\xfa\xe8\fBJ\\\xbcz\x86\xd1\x0e\xd8ﯱ\xbdz\x8d\x87\x8fރ.k|\xf1\xf4\x13|\xf4\xf1/\x0el\x9d#\xa6\xae\xeb\xf4\xe8\f\xeb\xd5\x11\n\x04\x14RBJ\x92\x18\x8eC\x87\xb6Y\xe0\xd1\xe3\xef\x12\x99s\xa4\xec\x05\xef\x1d\x9e\xbf\xfc\x02\xdb\xdd5>x\xef\xfbh\x9a%\x94\xd6$\xa9\x8b1;ao/_\xe3\xe5\x93O3\t3\x06nL\x03p\xb2\\\xe3;\x0f\x1f\xa1\xd0:K\xf8DJ\x9e\x942{\x00\xc8Y\x91/\x8c\xe1\xd4>\xba\xeeƘ\x9c\xee'\x85B\xdd48}t\x8e\xbam\xe83a\x8aY8\x90\xcc{\xfd\x03\xf5\x86\x10\xb7Yx\t\xf2\xb7\x1ea\xe6\x99!\xdeT\x92\xc5]:~\x91\x1b\x8d\xe9\xbfc\x96\n\xdeY$r\xa4\xf07\xaf\xffD\xd2%)cY\x14\xf8\x1f\xfdտ\x89\xe3\xe5r\xb2.O.\x8fB\xa0\x1f;\fn\xc8d\xc0pc\xc5a\x94\x811%v\xfdvv\x8d\"V\xcd:\xf3j.7\x17\xb0\x8eP\xc1\xd3\xf5\xe9\xefj\xa5\xeb\xfb\nt\xdf\x00\xfcw\xf6h\x9a\x16\x8f\x1e\xbf\x8b\x18#\xbe\xf8\xe23\x92\xfaa\"\xb4QA\x148?\u007f\x88\xd5\xea\x18\x10\xe2\x0f\xf6\xbbk\xb5\xdd\\\x91\x94\xc6;\x8a\xdaM0=7\x047\xa1gɎod\f\x92ܴhz\tnd\x06~\xc4\xe0\x1d\xbc\x0f\xb3\xbd\xffT\x90\xdbf\x81E\xb3\xc8A'\xc9rx\x18\xf6\\\xec\xe8\xffw\xc3\b\xe7\x03\n\xf6\xa8\x1fG\v\xa9\x04\xea\x8a<\xf6\xd3\r\x9b\xec`S\xd3\xe0\x83\xbbqN\t8O&D\x8a\v\xa6b\x1dq\x8c\x11\x9b\xdd\x16\xbb\xfd\x1eZ\x1bTe\x85n\xe8\xb0ݑ\xa7\u007f\"\x00\xae\xda\x16\xa7G\xc7\xe4:\xd8S\x1e\x81\x94\xf4\xbc\x8e͍\x94\xd2\xf8\xf5\x1f\xfc\x06\xde\u007f\xef}\\\\\xbc\u009f\xfc\xe9\x1fb\xb3\xb9\xa6\x02\x14\x91\x0f\xf8\x89J\xc1\xbfk$W\xbcm\xdf#\x8axǆS\xdc\xf9\xdfB\b\x14\xba\xe0=\xb0\xcc\x13Ϝ\xa8\u05f6K\x14\xa6\xc8\xef\v\xbd\xaf\x11u\xdd\xe2\xc1\xf9CJ\x04t\x0e/^>\xc7\x17\x9f\u007f\x84n\xbf\xc90q\x82\xfe\xe5\xac\xf5\x10\xdc\x06.\x17+\x9c\x1c\x9f\xe5?\x8d1\xa2\xeb;l6\xd7\xd8\xf7{\x14\x85\xc1\xc9\xd1\t\xdav\x91c\x93\xa5T@\x14D\xcc\x14\x80\b\x11}\xb7\xc5\xd0\xef\x81\x18\x00f՟\x9f\xbf\x83\xb2\xaa\xe1\x9d\xc5ns\x89\xfdn\x83\xcd\xd5%v\x9bK\xec\xb6\xd7\xf0\xcea}r\x0eST\xb8|\xfd\x02\xbb\xcd\x15\x85<m\xaf\xd0.VX\x9d<\xc0\xd5\xf5\x15~\xfa\xf3?eַ<X\xb5\x84\x10\xb0hW8^\xae\x81\xdd\x15\x8c\xed\xa0\x85@\xe0&\x14\bx\xf4\xe8}\x94\xcd\x02\xce:X\x96\xc4^]\xbd\xc2\xf3\xe7Opv\xfa\b\x0f\xce߁)\fLQ2\x8a\x14s\x93u\xf9\xe2)6\x97\xaf\xf9}\xe6\xd8\xe8HR\xb3\xf7\x1e>Ģ\xa9\xb9\x90\x17\aS\xb3\xe05\x8a\xcc\xf0\xbe\xe2b\xae\xb2\x17~\xc1\xcc}\xd2\xdb+4m\x8b\xe3\xf3S\x94UI\x8d\x99\xd60\x85\xc9HϤ\xd9\xcf\xcb\xfa[\xf55}\x14\x83\v\xf06\x1c\x06~\tdW\xc1;\x00\xfe\xc3&`N\n\xbc\xfb\xbbq\xe0\x1d2_'\xce8<\xdf|\r\xa0\xd0T\x15\x9c\xf7\xf8\xc1\xbb\x1f\xe0o\xfc\xe5ߜH\x8c3%\rqZv\xf9\xef\xd1y1\xbdN)\x15\xea\xb2\xc1\xbe\xdf\xe5s+Ĉ\xa6j\xd1T\xf35\xc0\x96\xe4\xd4uS\xaf\x16\xcb\u07fd\xafB\xf7\r\xc0\u007f'\x8f\xa2(\xf1\xeew>\x801\x05^>\u007f\x86\x8b\xd7/\xef쐛\xa6\xc6\xd9\xf9C\x94U\xbd\x0e\xce\xff\xe3\xcd\xd5\x05\x9c\x1ds\n^\x9a\x9a'\xe8?\x1ct\xe5Y{\xcbR\xb3t\v\v\xc1\x10'\xff\xf7`-\x9c\x9b\xf6\xfe!\x85\x87$\xd9T\xbbb\x9f~bK\ao\xd1'r\x0e߆\xc3h18G\x10\xbf \x1e\x00\x04P\x95\xb4\xcfN7kF.\x18bt\xce\xde\"ͧ\x98R\xa5\x14JN9\x93R\xc2\xfb\x80\xab\xed\x06\xbb\x9e\xa6ժ\xaa\xb0\xdd\xed\xb0\xefR\x81W\xa8L\x81\x93\xd5\x1aǫ5\x84\x94\xd8\xee\xf7y\x85\x10b\x84\xf5\x0e.P\f\xee\a\xef~\x80\x1f|\xf0\x03\\o\xaf\xf1\x93\x9f\xfd\t^_\xbe\xce{g:\x18\x15\xa7(\x81\x17\x00\xf4\x9a\xed8b\xd3\xf5\xf0\xf1-\xb6\xe2b\xbeo\xa5\xff(d\x01%4\x14\xcb\xc3\xe4\x8dD\xbb\xc2\x14h\xdb\x05\xb4&WE\xe7\x1d\xf9\xb7K\x89\xe3\xd3s\x1c\x1d\x1dC\b`\xbb\xdb\xe2\x93O~\x89\xab˗l\x9fJ$\xbe~\x18\x0e\xd5W\xfc\xf4Zk<8{\x88\xa6j\xf3\x84g݈\xed\xee\x9aÑ\x80\xb3\xe33,\x9b\x15\xad!\x10P\x14%\xe7\x05x8;\x02ޢ\xef6\xe8\xf6\x1bJ\xaf\xd3D`;={\x88\xe5\xea\x18\xd1\a\xecv\x1bl\xb7\xd7\xdc\x00\xbcD\xdf\xef\xd1\xed\xb7X\xaeO\xb0X\x1e\xa1\xefvx\xf9\xfc\t\xbcw\xf0\xd6\x02Q\xe0\xec\xc1\xbb\xe8\xad\xc5\xcf\u007f\xf9gx}\xf1\xea\x00\xfaO\x8d\xad\xd6\x06gG'\x10\xdd5\xfc\xb0\xcd\xd3p\f@\xf4\x1e\x8b\xe5\x11NN\x1f#\x80VFJit\xfd\x0eO\x9f}\x0e!\x04>x\xef{X,\x97\x14\xba\xc3\xc6OL$\x87\x1b\a\xbc|\xfa)\x86~7q\xdfb\x84\ap\xb4Z\xe3\xfc\xe4\x84a\xe6\xe2\xe0=\x13bzo\x15O\xf8\x84\x10H\x0eP2\x14լTF\xd1\xeaE\x83\xf5\xe91LY\xe4\x14\xc0\xa2*\xb2\xa6_$\xe7\xbd\x1c\xc3{{\xb8\x8e\x11\x88>\xc0;\xb6\xf6\x167P\x02L\x9c\x81\x9b&Y7\x9b\x80i\xca\x17o\x02\b\xde\xfa\xf1\x96\xf3FM\x88o\xd4\x10Te\t\xcd\xcd\xfd\xdf\xfd\x1b\xff>N\xd7\xebYD\xf0\r2\xa0\xa3\xc2\x1ff\xde&\xe9u7U\v\xe7\x1cF;f#$\xa54\x8e\x96\xa7\xd448\x8b\xdd~KrZc\xb0j\x97?R\xf2\xbe\xdc\xdd7\x00\xff-?\x88\xf1\xff],\xda%\xae\xae.\xf1\xfc\xf9\x93;\x8b\xbf\x00p\xb4>\xc1j}\x02)\xd5\xef\rC\xb7\xd8\xef6\x99}\x9cv\xe7tHRjV\xbc\x01\x0f\xa6}\u007fb{\xe7$0\xe6\r\b\x01\x8c\xceú\x89}\x9f\\\xee\xe8\x10+\xb0Z\xacQW\x15\xb4R\\\xfc\x1d\xc6a\x97\xa7v\x01`\xf4\x9e3\xbe\xc9il\x18-B\x88(\v\x83\xa6\xaa!\x85\xbaU\xfc\xc1{\xff\x9b;\xcbd9\xac\x95F\xa9\vb\xce\xf3:\xe0j\xbbA?\x0e(\r\xc5\xcd^_S\xc0\x8f\x00P\x16%\u058b\x05Ύ\x8f\xb1h[B$\xba\x0e\x83%S\x9e\bP\xf1w\x0e\x02\xc0\xf9\xc99\xbe\xf7\xfe\xf7\xb0\xdfo\xf1\xb3\x9f\xfd\t^\xbcxF\x87+&H\x17Q2\xa2\x12x\"\xa1\xbd\xff\xb6\xdfcp\xee+a\x9f\x82\xe5\x83J\x90\xd6YΊ\xff\x04\xbd&\xa4\xa5E\xdb\xd0\xfe}\x18{\x80ߓ\xa6Y\xe0\xe1\xf9C\x98\xa2\x80\xb3\x0e_|\xf1)\x9e~\xf11\x02\xa7$\xe6&\xce\xfb\x99\f-\x15\x92\x88\xd5\xf2\b\xa7'\xe7Y!\x11#\xd0u{t\xdd\x1e\xce\x0e8=>\xc3jq\xc4\x01L#\xaa\xb2\xe6d\u0088\xbe\xef0\x0e\x03\xfa=\x15\u007f\bAA4Jcٮpz\xf6\x10BJ\xf4\xfd\x1e\xdb\xeb\xd7\x18\x86=\xae/\xa9\xf8\xef\xb6\xd7(\xab\x1a\xc7'\xe7\b\xde\xe3\xf9\xd3O\xb1\xdfo)\xb6\xd8;\x9c\x9c=\x800\x05>\xff\xe2S|\xf4\xc9/X\xe25#\xbf\xf0\xc7\xf9d}\x84\xca\x0f\xf0\xe3\xb4\"\xcb_\x15\xc0\xd9\xf9\xbb(\x8a\n\x8e\xb3\x02\xbcwx\xf1\xfc\vl\xb6Wx\xf0\xe0\x11\xce\xcf\x1e\xa3\xaa\x1b\xda\xcb\x17\xe6`M\xb3\xdf\\\xe1\xf9\x17\x1f#x\u007f\x80<h\xa5\xf1\xce\xf9\x19\x9a\x928/\xa6(\x18\x81JI\x80T\xb44\xef\xfc\x8b\xb2\xc8{|mh\xaa\xa7,\x03@)\x89\xbam\xb0>Y\xa3(\x8b\x8c\xcb\xe8\xc2\xe4$\xc2C\xa9\x1d\x13Mn\xe88\x10\x01o\xc9u\x123\xad?5#1G\b\x1f\xee\x9f\xe2\x9b\xf6\x01\a(\xc0\xcd\xef\x11\xe2M\x88\xc0\xe1*\xe0\xdb\xe0\x00)\xc0\xa7.kXg\xf1\xc1;\xef\xe2o\xff\x95\xbf\x96\xd1\xc1\x98\u007fMB\xa1\xfa\xb1\x87\xe4\xc1\xc1Gw\xd0\x15\x95\x05\xf1\x83\xf6\xc36#n1F\x1c-\x8f\xc9\x14(zl\xbb\r\xc5\x10+\x8d\xb6n~T\x95\xf7[\x80\xfb\x06\xe0\xbfŇ\x10\x02\x8f\xdf\xf9\x0e\x96\xab#l\xb6\xd7\xf8\xecӏHk\u007f\xe3{b\f\xa8\xaa\n\xc7''hۅ\x8a\xd1\xff\xfen{\xc5^삥h\x13\x1c\xed\x93\xf3\xde\xec`\x9b\x17\u007f\xc1Lg\xc4\x001\x8b\a\xb6!`\xb0\x0e>1\xfd\x13\x93\x98\xff\xfer\xb1B˄0\xe7,\xbc'\xd8\xdf\xdaqR\x18Ĉ\xae\x1f\x11\x02\xa0\x15퀝s0F\xa1\xad\x1b\x86[ٗ\xc0{\xa4\xed\xa2O>\x03\xb3\xc3 \x91\xe0\x8c1(\xb4\xc9\xd7c\xb0#\xae\xb6[8\xe7P\x9a\x12R\n\\]_\xa1\x1f\x06h%\xb1hZ\x9c\xac\xd68Z\xaeh\xfd\x10<F;\x92\v\x1eG\xa4z\x1f0\x8e\xe44\xb8h[|\xff\xfd\xef!z\x8f\x0f?\xfa\x05^\xbez\t\x97̇b\xca9Wyǚ\xe0H\x1f\x02v]\x87n\x1c\xf3\xea\xe3\xed\b@j&\x80B\x15S\xbc\xab\x92\aE,Fb\xb7\xafVG\xa8\x9b\x16\xd6Y\x96-\t(S\xe2\xe4\xe4\f+\x0e\xfc\xb9\xba\xbe\xc4\xc7\x1f\xff\f]\xb7\xcd\xcf\xe1C\xc0\x90=\x18\xc4\xdc\xed\x19Z\x1b<|\xf0\x0e\xea\xb2\xe5xX\x01kG\xec\xf6[\xec\xfb\x1d\x8e\xd6\xc78^\x9f@i\x8dq\x1c\xd16\x8b<\x85\xef\xf6[JU\xdb^b\xe8:@\b\xd4U\x03cH\x86y\xf6\xe0\x11\x17\xde\x11\x97\x17/0\f\x1d6\xd7\x17\x18\xb8i\x10\x00N\xce\x1eC*\x8d\x97\xcf?\xc7\xeb\x97OQ\x94%\x82\xf7X.֨\xea%../\xf0ӟ\xfd1\xacs\a\x9e\xfaɀ\xa7\xa9j\x1ci\x85\xc8rW1\xb9\xc5 ƀ\xb2j\xb0>>G\x88Ȳ\xc1\xcb\xcbWx\xf9\xf2\x19ʢ\xc4;\x8f>@\xdb.P\x94u6\xdeI\x056:\x8fWO?\xc7\xe5\x8bg\aĶ\x18\x81\xe3\xe5\x12g\xeb#B\xa1X\x11!@\x8dw\"\xd4jM\x8dA\xc1\xc4\xd84\xf9\x1bc\xf2\xf3))Q5\x15VG+\x98\xa2̓\xba\x14\x12Ei&\x89\x1f\xee\xe6\xea\xcd_\x93w~\x16\xe8ť9)\x00R2\xb68|\xbe[f<w|>\xdfT\xe4\xa7K\x12\xdf\b\nd\x14 \xe2-\xac\x81\xb7?\xea\xaa\xccQ\xd8\u007f\xf7o\xfc\x0e\x8eW\xab\x0342\x9f3\xc3.\u007f\x06r\x13\x84\xa4p\xa1\\\x92\xdd~3)qbDU6X\xb5k\xc4\x10\xb1\xefv\xec((\xd1\xd4\xed\xfbeQ\xfc\a\xfa\x06\xd9\xf4\xbe\x01\xb8\u007f\xfc\xb9=\x1e=~\x17\xa7g\xe7\x18\x86\x1e\x9f\u007f\xfaq\x96\xaaݚ\xfe\x85\xc4b\xb9\xc4\xfa\xf8\x14\xa6(\xfe\xc18\f\xef\xef\xb6\xd7DN\x8a\x98\xe0~\x8e\x01\r\f\xfd\x1f\xca\xfd&\x82\xcf\xc4\x11\b\x90Ii\xe3\x03\x86ђ\xd3\x1fK\x9f\x02'\xecI)\xd16\v,y\x1f\xed\xbd\x87w#ơ\x83\xb5=y\x8d\xf1\x8d\xb9\x1fF\x8c\xdeCiE.vl\xac\xd3\xd6\r\xb4.\xa6\xe0^\xe73J\x99\xbc\xfe\xa7\xdf9dr\x8f1\x06\x85\x99\xf2\xc7\xfbq\xc4f\xb7C\b\x91_K\xc0\xf5vC1\xa0e\x81ӣc\x9c\xac\x8fP\xd7\x14\xe5\x9a\u008b\x86q䉘\x0e\xc8э\xf0\x81\x1a\x88\x0f\xde\xfb.\x9a\xba\xc1\xd3\x17Oqu}130\x8a\xf9pgO^:\\\xd3\xde\u007f\xe8\xb1\x1f\x06\x8aD\x8eo\x99\xf9\xc5ax\x8a\x96\xc9\x04F\xc0\xe8yHKn\x01PW5\xd6\xebcH\xa1\xd1\xf7=\x10\x196n\x1a\x9c\x9e\x9e\xc1\x14\x06\xd6Z|\xfa\xd9Gx\xf1\xfcɍ\xac\x87\xc0\xcd\xd4!\xa3;\"\xe2\xf8\xe8\x04\xa7\xc7繨\xfb\x18\xb0\xebv\xd8l\xafP\x95\x15\xceO\x1f\x10\xe9ώX,\x160%E\xaf\xeev\x1b\\o.q}\xf9\nn\xec\xb2\xff|U7\x90Rc\xb5>&n\n\"\xae._\xa3\xebv\xd8\uebb1\xdf^\xc39\v;\xf6X\x1d\x9d\xa1\xaa\x1a\\\xbe~\x81gO?\xe3k\xa1P\x14%\x16\xabctc\x8f\x9f\xfe\xec\x8fq\xb9\xb9\x82JN\x83I\xd7\x1eir>ojH\xefrᛗ\x98\x18#\x96\xebc\x14E\x05ˈL\xdf\xf7x\xf6\xfcs\x8cv\xc4\xf9\xe9#\x9c\x1c\x9d\xa2\xaaj\x92\xef\xf1\xd4\x1e<\xdd;\xe3\xb0\xc7\xf3\xcf>D\xdfmg\xcdG\x84\xd2\ngG\xf4\x99\xd2Z\xa3(\r\x94\x94\x1c\xa7+\xb2\x0f\xbf\xd6\x06ffH\x95\xc2y\xb2\x05\xb0\x940\xa5A\xbbZ\xa0(\xcb\xec\x10Hk\x03\xb2\x06\xbeYlか\xa4\x98Vr!\x1c\x06|\xddh\x0en\xfb\xf5\x88;\x88\x03w7\xa9B\xdc\x01\xff\xdfz\x86x'#@\b\x8a=\xc6]\x1c\x83\xaf\x88\x02\x18M\xb6\xd8\xd69|\xef\xdd\xef\xe0w~\xf8[p\xce\x1f\x98\x02A\x80\xa3\xa8]&7\xfb\x10\xa6\xa4\xc6\x18\xb1hV\xe8\xc7.\xbb\a\xa6\x06\xe5xy\n)%\xfa\xb1G?\xf6ĵ\xa9\x1ah\xa5\u007fT\x16\xe5}a\xbao\x00\xfe\xfc\x1f\xeb\xa3c\x9c\x9f?\x82\xb5\x16\x9f\u007f\xfa\x11\xban\u007f'B\x00\x00uM\xd9\xec\xcb\xd51\x00\xf1\a\xfb\xdd\x06\xe3\xd0M\xbb\xfe\x10\x81@\xd3i\x88~6\rDf\xfa˃\xdd3@\x8a\x02\x95\xdc\xcd\x100x\x82\xfeq\xc0#\xa0\x1b\xba,k\xac\x16kT\x15\x87\xfc\xb8\x11v\xec1\x0e\x1d\x10\xa7\xe2?XGy\xf7\xec\x83>2\xbc\xdeԜ\x8f·\x84sn\x9aN\"\xed\xe3r\x01\v\x91\xbd\xf4ɔ\xa8*\xca|\xc0tC\x8f\xed~\x0f@\xc0\x18\x83\xc1\x8e\xd8u;\xd6\xf3.\xf0\xf0\xf4\x9c%~\x8a\x12\x0f\xbdg]?5\x00\xe9 t\x8e\x92\x05\x95Txx\xfe\x10\xa7\xc7'x}\xf1\x12\x9b\xcd\x15F\x0e.bG~H\xa9\xf3\xba$y \xa4\x86b\xdf\xf79\x98\xe4M\x1d\xc0|ꊈ\x90\x90ВX\xffZ\xcbY\xf4\xe9\xe1\x14utt\x8a\xaa\xac\xe1<\x85)INd[\xaf\x8e\xf2\xf4\u007fy\xf5\x1a\x1f\u007f\xf43\x8c\xe3dVCaM\xfe\xd04\x87aRc\f\x1e=x\x17UQ\xe5\xcf\xd78\x0e\xb8\xbcz\x05\xef\x1d\x1e=x\a\xcbŊ\xd4\x10eI\x1e\rB`\xbb\xdb\xe2\xf5\xc5K\\\\<\x87\x1d\xf6\xe4I\x80\x88\xaa^@\x9b\x12\xc6h\x9c\x9d=\x82\xd2\x06\xfb\xdd\x16\x9b\xcd%\x86\xbe\xc3\xee\xea\x82|\x01\xfa\x0e\xda\x14h\xda%\xf6\xbb\r\x9e?\xf9\x04\xd6\xf6\x94d\a`}t\x8a\x18#>\xfb\xfc\x13|\xf1\xe4ә\xdb_\xccJ\v\x88\x88\xb3\xbaF-\x057\xb8\xf1VA\x12Bb\xb9:\x81\xe0\x9d~\x8c\x11/_=\xc5\xf5\xe6\x92H\x93\x0f\xdeAUT\xc4_Q2\xc3\xed\x91\r\x9d\xf6\xd7Wx\xf5\xfc\v\x82\xffSk\x11\xc9\xcdp\xbd\\\xa1К\t\xa8:\xa3kRIHN\xec+\n\x93\xbd*(J[g\xa5\t\xb9\xfd\x11\xf4_\x94\x15\x87(\x89\xdc\x18\x14U\x91w\xfe\a5s\xbe\xfe\xe0\xa6=)}\x12\xdf\xe3f\x01ż5\x12S\x11~\x93W\x9f\xb8\xeb\x8f\xdf\xd8\x01\xc4/\xe9\"\x90\xd7No\xf9\x96/Y\x93\x81W\x85\xd4\x1c\xff\x8f\xff\xe6\xdf\xc2z\xb1̻\xfe8[\x03\xcc\xe3\u007fC\xe6 \xd1\xfdZ\x17\xe4\xa5\xd0\r\xbb\x83\xeb\xd36+\xe6\b\x8c\xe8\xfa\x1d\"\x9fQF\xeb\x1f\x19Vg\xdc?\xee\x1b\x80?ǽ\xbf\xc1Ç\xef@H\x89gO>\xc7\xf5\xf5\xd5\x1b\xbfW)\x85\xa6i\xb0Z\x9f\xa0\xa9\xdb\xdf\x1e\xc7\xe1o\xef\xb6W\x88\xdee\x92R:\x8c<\xa3\x00\xf3\x9cw1\xcb\x1dO\x87\"\x05\xeb\x90\x06>p\xc1\x1d\xad\xcd\x1dv\xccP\x9b\x801\x05\xd6\xcb#4M\xc3ּ\x16n\xec\x89\xf9\x9d\x14\x06썾\xef\a.4\x1a\x8eU\x04UY\xa1\xae\xeb|\xb28\xce\x06H\x85\xcfyw`2D\xfe\xff\x11\x85Ҩ\x8a2ì\xbb~\x8f]\xdfe\xcf\xf4\xaeߣ\xeb{\xd2\xf1\x1e\x9f\xe2\xc1\xc9\x19Jvr\vy}\x10\x18\x95\xe8Y/L\x88\xc0h\x89\x90\xb8\\\xac\xf0\xf0\xfc\x01./_\xe3\xea\xea\x12\xa3\x1d\xa89\x89\xc9\x18\x85\xa7d\x0e$\xf2\xc1\xc19\x8bq\x1c\xb0\xef;\x8c\xcef\x86\xf8\xdd\a\x19\xa9-\xa6sX@+\x9a\x1e\xa5\xa2\x06\xe0\xae\t\xa8i\x17X\xad\x8e!\xa4\xc28\x92{\xa1T\n\xa6\xaaqt|\fch\xfa\xff\xe4\x93_\xe2\xe2\xf5\x8b\xcc\xda\x0e\xecӐ\xa5\x9f\xb3.$\x028=:\xc7\xf1\xfat\xfa\xfe\x10py\xf5\x1aW\x9b\v<z\xf0\b\xc7드$W\x95T\xfc\xf7\xbb-\x9e?\xff\x02\xaf_?\xc3\xd0\xed\xb1l\x17\xd93\xa2n\x16\x88\x11X\xaf\x8f\xd1.\x96\xf0\xce\xe3\xea\xfa5\xac\xed\xb1\xdd\\`\xb4=\x9c\xb5\xb0v@\xdd,\xe0\xbd\xc3\xcb\xe7\x9fc\xbf\xdf@)\"s\xae\x8e\xcfPV5^_]\xe2\x97\x1f\xfe\x04\x96m\x9aoN\xb4\xeb\xa2Ī(\xf8z\x87\x1c\xba3\xb1\xf7\x81\xc2\x14(\xb4\xc9{\xf9\xdd~\x83\x17/\x9f\"ƀӓs,\x17k*\xe0\xda\xe4\xe4\xcb\x04\xff\a\xefq\xf5\xea9\xb6\x97\xaf\xa7I8\x02\x10\x12\x8bf\x81\xf5\x82V!\x04\xe7\xcb̑Q<\xfd\x93!\x10!UR\x91\xfe_\xe0\xd0s\xa3(\v\x94U\xc5\xd2AN\xe33\x1a\xf5\xb2AٔP\x9a\xd7AIN(fFRqZ?%\x94lJ\xef\xb9\xd90\x88\xbb\x01\xfa\xf9\xc7T\xbcݺ\xf7N\xf1`\xc4W\x96\xf8))\x0f\x88\xaf_\v\x05\x00Phr\x06t\xce\xe2\xd7\xde{\x1f\xbf\xf3\x9b\xff\x1e|\f\a|\rB\x04w\xf9\x10\xbcI\x06\x94RbѬI\r0\x93\x15\x1be\xb0l\xd6\xf0\xc1\x93\x12 D\x94E\x81\xbaj~\v\x10\xef\x97\xecjz\xff\xb8o\x00\xfe\\\xf6\xfe\x8f\xdey\x17M\xdb\xe2\xfa\xea\x02\xaf_\xbfx\xeb\xf7\x1b\xce\a_\xad\x8e!\x95\xfa\x83~\xbfE\xcf\x11\xb6i\x9f\x8f\x8c\x04\x04L\xae\xdc\"\xeb\xa7\xc5L\vKС\x83\x96Dh\v\fӇY!K\x87\x9bV\n\xcbv\x89e\xbb\xa4t>\xef`\xc7\x01}\xbf\xcb,\xf8\xc0\xff\xb6\xeb\aX\xefs\x1e\x80u\x8e<\xf6\x9bv2\xb6\t\x1e1\xf8\x99\xab\xa1\xcf\xd0\u007f\x04`\x9dG@$\x93\xa0\xb2\x80\x94\x12\xce{ڳ\xf7\x03\xed\x05\x85\xc0\xbe\xdbc\xb4\x16\x8bv\x81w\x1f=\xc6\xd1r\x9d\xbb\xfe\xc0\x11\xba\x81Â\x86\x91\xf6\xe7\x823\x14H\x12\x16\x19\xee>C\xdf\xed\xf0\xec\xd9\x13\xec\xbb=\x86\xbeϻ\xcbT\xfc\x9d\xf7\b\xbcF\xa0\xe2\xea1\xb8\x11\x83\xb3\xf0qr]\x8c1\x1e\x9a\xac\xcfMU\xf2\x0eV\x13\xf4\xaf\x04\x8cQә<\xcbg(L\x81\xf5\xf2\x18UY3,=\xb2\xddk\x81\xf5r\x85\xd5r\x05\x01\xe0\xe2\xf2%>\xfd\xe4\xe7\xf0\xc1\xe5\xc8ޔ\xf5\xe0ذ'\x17\xff\b\x94E\x85G\x0f\xdeAQ\x94\xf4\x93\x84@\xd7\xed\xf0\xec\xc5\x1789>Ń\xb3\xc7T\xb4\x048uPa\xdf\xed\xf1\xf4\xd9gx\xf5\xfa9\x86a\x8f\xb6i\xf9\xfduh\x17GP\x9a\xccmNN\x1f@\n\x89\xdd\xee\nݖ\xdc\xfc\xf6\xbbkr\x14\xec\xf6\xd9h*\xf9\x00@\x90\xe4q\xb9\\\xe3\xf8\xf8\x01\xbaa\xc0\x87\x1f\xfd\fW\xd7W|\xdd\xc5̵R\xa05\x06\xc7U\x99\t_\xd35=,bu\xddR\x8a\xa2s\xb0\xce\xe1\xc5\xcb'\xe8\xf6[TU\x83\xb3\xb3Gd4\xa3T\xb6\xd4\x15\x82\xe1\xff\x10`\x87\x1e\x9b\x8bW\x18\xfa.\xc3\xe0\x00 \xb4\xc2\xc9z\x85\xa6\xaa\x89\xe0\xa7uF&\xa4Tl)͉~l\xdac\xb4>\x80\x9c5G\xfb\x965\xad\x1e \"\x84Ph\x165\x96'+Tm\x9d\u007fo\xccL~$\x93uC8L\xf4L\xe6S\xa9\xb8\xe6\x89{\xb6\xfb\xbfU|c\xfc:\x87\xd4\x01Bw\xe3\x8f\xefl\x1b\"\xb2@&\xf7\tR\x88oqN\x92\xd1\x17\xc5\x03k\xfc\x9d\xbf\xf67q\xbaZ\xdf*\xf2\xc3\xd0\x1f8\x86\xa6\xb3\x8bMӱjV\a\xe7L:\x83\xdbf\x05)un\x0e\xa4\x90X\xd4-\"\xe2\xdf6\xda\xdc\x1d\xdd}\xdf\x00\xdc?\xbe\xed\xe3\xe8\xf8\x04\xa7\xa7\xb4\xf7\u007f\xf2\xe4\xf3\x03\x12\xcf\xed\xe9_\xb2y\x88\xc2r\xb9~\xe4\xbd\xfb\x87\xdb\xed\x15\xbc'S\x968\xd3\xf9\xfb[R\x181\xc1q\t\xc2F\x84\x0f\xfe\xe0`\xb1\xfe\xf0\xe6H\x93\xa3\x94\nu\xddb\xb5<\x82\x92\x8alP\xc7\x1e\xfd\xfe:'\nR\x18\x0e\xd0\r\x16\xdd@r+%$FKEi\xb9X\xce\xecc\x03\xbcsP\xca\x00\xec?@?7f\xe7?2\xbe1(Y\x9a\xe5\xbc\xc3v\xbf\xc30\x8ePJ!\x84\x80\xdd~\x0f\xeb\x1d\x8eVGx\xf7\xe1cTE\xc1fE4\xfd:\xe7s\x94\xad\xf7\x1e]\xdf\xe5\xebb\x1d\xa1\r\x84j\xac\xa0\x85ċ\x17\xcf\xd0\xed\xf7\xd8\ufddcA\x00\xd6\xfd\v\xb8\xe0\xd8\xfb\x9d \xf5\xe0=Fg1:KҤxsrI\xe4ILl\xe8l\xb6\xae y\xf7\xaf\x15\x87\xfe\xdc(\xfe\x84\xf6,д-\xa5*ڑ\u0089\x14I\xc8NN\xceP\x96\x15\xac\xa3\xdd\xff\xe5\xd5\xeb\x89\xe1\xcc\xcd\\\x8c\xd4HEq\xb8\xf9=9>\xc3\xd1\xea$\xafAB\bx\xf6\xf2\tJS\xe0;\x8f?\xa0p!\x8e\x81VRc\x1cz<}\xf6\x19^\xbezFp\xbd.P\x97\x15\xbc\x1fQ\x965\xaaf\x01\x1f=\x11\x15\xeb\x05\xac\xb3\xb8\xba|\x85\xbe\xdbas\xfd\x1a\xc1;\xd8a@\bd]\xdcu;l\xaf^!\xb0\xd9SU5x\xf0\xf8}\xf8\xe0\xf1\xf9\xe7\x1f\xe3\xe9\xd3O\xb3\xddl\x9c@}\x14J\xe2\xb8*\xa0\x95\xcchS>\xe0g)7Ji4M\x9b\x1b\xa0\xdd\xee:7\xd7g'\x0f\x88\xcc('\xdf}\xc5\xf2\xd4\xc0\x16\xbfc\xdfa\xcf\xf7\x96\x14\"\x1f|Zi\x9c\x1d\x1d\xf1ߣ\xe9\xdf\xf3\x92]\xb3f_\xb3\xb6\x9f\xd6:*sn\x94V\x14\xfa\xc3!@\xf4\xb9\x96PJcq\xb4D\xb3Z@j9A\xf7\x87F\xfd\xfc\x1e\x8a\xbb\x97\xfc\a\xd3x\xe2\xf6\xe0&\xabo\xfad\xc6o\x82\xc5\vL\x89<\x98\xbf\xb0C[\xcb7l\x04\xe4\xb7,\xa2\xc6\x14(\f\xe5H|\xef\xddw\xf1W\u007f\xfd/q\x030Y\x03;\xef0\xd8!\x9fw\xd4\x00L\xacW\xcaj\xa8\xb3\xe9On\x16\x8b\nuQ\xa3\x1f{8o)R\xbbi!\x85<\x97\xec\xe0x\xff\xb8o\x00~\xa5\x0f\xa5\x14\xce\xce\x1e\x00\x00\x9e~\xf1\x19\xfa;\xf6\xfe\xf3\x02N\x1e\xf7\xc0\xe9\xd9#\x14U\xf5\xfb\xdd~Wt\xfb-\x10\x02\"Cܩ\xf8ύz\xf2.q\x16\xfaB\xae\x80\x9e\xa1\u007f\xd2\xc0\xbb@\xd0\xff\xd4=ǌ,Te\x85\xa3\xe5\x11JS \x04\ag{t\xfb\xeb\x83h\xde\x00`\x1c-v]O\x1e\xfbZg4a\xd9.h\u007f\x9f\x8b\xaf\xcdieBP\x12[\xea步\xc2\\\x18\x8d\x8a\xed@\xad\xf3\xd8\xec\xf6\xb0\x8e4\xfb\xce;t\x03\xad\x18\u058b5\x1e\x9e>\x80\x12\x02\xde%\x89P\x80\xb3\x93\xdf=\x00t\xe3\xc0$!d\x95\x81R\nu\u0560\xd4\x06W\x97\x17\x18\xc7\x11\x01a\xf20\x10*\xc7\x11\xd3t8\x91\xfe\xacw\x18\x1dI\a\x13\x02rs\x18\x12\x98\xdc\x15\xf3\x17\x84\x80T\x05\n\xad\xa0\x8c\x9c\xa0\xffxxX6U\x8b\x82\xadw\xa5\x90\x18\xedH\xd7KI,\x16-\xd6k\xf2쿸x\x85O?\xf9\x05|\x96\xfd!+?B\xa0\xc8f\x91\xa1\xffH\x96\xc1g$\x1bL\xbc\x8e\xeb\xed%\xfa~\x8f\xef~\xe7\xfbh\x9b\x15\nm(\xd2V)87\xe2\xc9\xd3\xcf\xf0\xe2\xe5S\xd2\xd1\vZ\xbb\x88\x18\x80\x10Ю\x8eI\xe2)$\x8eO\xce!\xa5\xc4~\xb7\xc1n{\x8d\xcd\xe6\x02\xe3\xd8\xc3YJ-\xd4\xda\x00Q\xa0\xdb\xef`\xad\xcd.\x84\x8f\xdf\xfd.\nS\xe2\xd9\xf3/\xf0\xd9g\x1f\x92\r\xef\xcc\x01\x11\x82\\\x11\xd7\x05\xe5\xc3\x1fN\xb1\x02Se\x02#\x1c\x05ʲ\xa2ϵwx\xf1\xf2)\x86a\x8f\xba\xaaqv\xfa\x90\x1a\xd1\x10P\x964m\xabdA\rB\xa5\x86\xfd\x0e\xbb\xed\x15\x9c\xb39\n\xd9\x03X\xb5-\x96mˊ\x01\x95\xd51RI\x98\x92R\x1ci\xfa\xa7k\x94\x90\x85\x8c4h\n\xeb)8\x02X\b\x89\xc5z\x85\xb2\xad\xc84*\x8a;!\xf2(\x90\r\x98\x0e\xa6ћ\xd1\xd2b\xdedލ\xe9\u007f\xd5\xda/n\x93Wp;&X\xdcZ\x1f\xdc|\x86\xb9\xedԁ\xb7\xc5\xd7=/\xd9\xee\x1b\x00\x9a\xb2\xc6\xdf\xf8\x1f\xfc&\x96m\x9b\xa5\xc1\t\x81\xecm?5\x8d1\xcc\b\xb1\xf4\x1a\bM\v\x99#\x90V7\x8bf\x89a\x1c0\x8c\x03\x02b\xe2\x01\x9c\x90ZF\xdf\x17\xac\xfb\x06\xe0W\xfbX\xad\x8fѴ\vl7\u05f8\xbc\xbc\xf8\x12\x9e\x80\x82Rd\xbc\xf3\xe0\xc1\xbb5\"~o\xbb\xbd\x82\x1fi\xef\x1c\x18\xe6\xa5\u007f\u008d\xae}\xf2\xf0\x9e\xbbg\xc5\x1c\x9fI\xc5l\xb0\xf6\xc0c;\x82\xc2n\x8c1X-\xd6h\xea\x86\n\x8a\xb7\xe8\xf6\x1bx\xf6\xd4Ov\xac\x83\xb5\xd8v\x03B\xa4\x9d\x9d\x0fD$\xac\xeb\x1a\x8bf\x91oR\xcb\xc1=Z\x11\xf2@\xfbt\x9aړ\xe7@i\nTE\xc9~\xff\x1e\x9b\xfd\x16\x96y\x0e\xa3s\x18FjTV\xed\n\x0f\xcfΩ)`Ԁ^\xa3Ü\xbel\x1d\x11\x84Ү\xdb\xf2\u05cd\xa1i\xb2\xef;\xf4C7E\xf8\xf2\xbe7\xa5\x8c\x85\xe0'\xeduB\x17،\xc7\xdf<Q\xe7&?7X\xe9\x11\x02B\x19\x86\x8f9\xf2U\xdc\bO\x15\x025\xcb+ˢBQVl\xaf\xecrT*M\xff%\xc6a\xc0'\x9f\xfc\x1c\x17\x97\xaf\x0e|\xd2C \xee\x87K\xd6\xcd3\xc9\xd6\xf1\xfa\x04'\xeb\xd3l\xf4\xe2\xbd\xc3\xe5\xd5k<8\u007f\x84\xe3\xe33Te\x99'bk->\xff\xfcc<\u007f\xf6\x05\xac\x1d\xa1S\xca\\\bpc\x87\xaaY\xa2\xa8Zx\xef\xb0\\\xaeдK\xf8౹\xbe\xc0\x9e\xbd\x01\xc8\xf5q@dhu\x18:\fC\xc7aA\x0egg\x8f\xb1\\\x1d\xe3\xf5\xeb\xe7\xf8\xe4\xd3_\xa2\x1f:X\x17\x0e\x8a?\"\xd0\x1a\x8d\x8a'\xeb\xbbj\x93\xc8͓\xcc\xd7/@`\xbb\xbd\xc2\xeb\x8b\xe7\x10B\xe0\xf8\xf8\x1cu\xbd\xa0\xa69D\x94U\x05\xa9d6\x82\x8a\x81\xf6\xff\xfb\xed5\xba\xedf\xe6\xa5@\xb0\xfa\xf9\xf11JS\xcc\\ \xe9\x1eI\xae~\xda\x18\xf2\U000d72bc\x00\x04\x15|\xad\x15s=(\xaa75\x1c\xf5\xaaEՖ\xb9y\x11\at\xffٴ\x1dp\xa7\xb7n\"\xa3\xde\"\u05c9\bqh\xe3s(%\xc0\xd7o\x02\xc4\rH\xe2\x06\xbd\xf0\x8dO\x10\xe7\xe7\xd0\x1d\xab\x9a\xaf\xb3.%\t09'~\xf7\xf1\xbb\xf8\xc1{\xdf\xc9\xf7f\u0082\xfa\xb1?0A\xcb\xc6e\xfcu\x8a;\x97L\u061d\x1a\xf5\xb6^\"\x86\x80\xed~C\xd9\x1aƠ*\xaa\x93\x10i\xe8\xb8\u007f\xdc7\x00\xbf\xba\v)%NN\xc8z\xf5\xd5\xcb\x17o\x85\xe4\xd2\xf4\x1f#p|z\x8e\xe5\xea\xe8\x1f\x8dC\u007f\xdem7\xec\xc7\x1e\x18\x86\x8fy7=M\x03b\"H\xc5\xc9\xde42\t\x0e\x00l \"\xdcd\xf6CP\xa8\x00\xa0$uƋŒ\x19\xef\x16\xfd~\v;\xf6\x13\xef:F\x8c.`ߏp\xc1\xc3h\xda͏\xd6\xd1\x0e{\xb1\xe6\xfc\x81\xc8\x1e\x01\x11\x86u\xd3T\xd8\xe8gS\U0004fa0a\x02Ua\xd8\xe7\xdb\xe2z\xbf\x83\xf3D\xf4\xb2\x96\xf6\xb9RJ,\xdb\x05\xceONa\x94&$\"\"?\xdfT\x18\xe8\x18솞U\x11\xb4\xe6H\x10\xbb\x04Y\xc6:;\x90\xc3 O\xcei\x82\x9b\xa6\x88\xd9Y\x1cb\x0e\f\x9aX\xff7\xcf=\x91\x19\xdfsȔ\xbc\xdc\r\n\xd6\xfbkuch\x134\xa1P$\xb2@\xc3Ӧs.C\xfa\x8b\xe5\n\xcb\xe5\x1a\"\n\xbcz\xf5\x02\x9f\u007f\xf61\x82\x9fR\xd0\xfc\xac\x01\x1c\xb3\x99\x12\xbd\x16cJ<z\xf8\x0e\nS\xe4\xe2z\xbd\xbd\x82\x92\n\xe7\xa7\x0fQ\x18r\xf8\xf3\xcec\xb7\xdb\xe2\x93\xcf~\x89g\xcf>\x87\xf5\x96vڌ-\x8f\xfd\x0e\xc1;\xb4\xcb\x13\x8e\xcc\x05NN\xc8\xef\xbf\xefv\xd8n.\xd1\xed\xaehUdGx7r\xa6\x84\xc5\xd0w\x10\x88\b\xcec}t\x8a\x93\xf3Ǹx\xfd\x12\x1f\u007f\xfaK\xecv\x1b\x8c\x96\xae\xed|\xca-\xa4D\xa35t\n\x84\x99\xdf/lћ>\xf2J+TU\x03\x80\xe0\xf9\x17\xaf\x9e\xc1\x8e\x03\x05\xec\x9c> 3 ;\u0098\x02J\x1b\xb6楦&\xc6\x00oG\xec\xae\t\x11\xa1\x86\x82\xb2fʢ\xc4\xd9z\x9d#y\xb3$OI\x18.\xfe:M\xfc\x9cܧ\xb4\xca\x06K)\x19P)\x05m4V\xa7GX\xac\x17S6\x84\x10yW\x1do\x92\xebgѝ\x023\xc0\x83\xdf\xdb[\b|<D\x00\xb2\xabߛ\xeao\xfc\nM\x80\x9c\xc9\t\xc5\xe1\xc6\xffNt\xff\x8e\x00,q+`\xe8\xeb\xa3\x00UARԣ\xe5\x12\xbf\xf5\x83߀1&\xdf\x1bB\x88\xecI\x92\x10\xafDJNk\x92\xa4\x84B<\\\x85\x94E\x05\xad\r6\xfbk\xec\xfa\x1d\xbc\xf7\xa8\xab\xfad\x8e\xa6\xfeE\u007f\xdc\xe3 \xbf\xa2\xc7z}\x8cv\xb9\xc4v\xbb\xc5\xf5\xf5\xe5\x97L\xff2\x13\x8a\xce\xcf߁2\xe6\x0f\xae\xaf.0\x0e\xe4\x06\x17\xfcT\xfc}\xeejE&\x83%\xf2N\x02\xc2Br\xe9c\xd6\xffh'\x1du\x9c\xef\xfd\x95B]\xd7X/\xd7PB \x04\x8f\xbe\xdfa跳uu\x84\xf5\x81\xf4\xfe\xceexx\xb4\x16RJ\x1c\xad\x8e \x15\xedߝ\xb7\xa4\xb5/J\bI\xb1\x9c֎\xf0,9\f1\xa2*\v\x9a\xb0\x10\xb1\xef{\x86\xf9'E@\b\xe4\xc1\xdeT5N\x8eN\x88\x19̑\xc1\xa44p\af'RHtC\xc7ps̅%\xad\x1ehz\x10\xf0\x91Hs\x04\xe7j\xe6* \xf3\x1b\xd2\xd5\va\x82\xd5\x1d7]\x19^\x14\xb7\xa7\xfe\xf9aH*\f\r-\x01\xa5\x04\xb4\x14\a\x1ai\x01\xa00\x1c\xf5\x1b#\x8a\xb2\xa4\xf8\xdc\xc8+\x8b\u0874\xc6\xc9\xf1\x19\x8a\xa2@\xdfwx\xf2\xf4S\xec\xf7\x9b<\x15\x87\x94\xf4\b\xc0\x05ϨɄ\f<8}\x88\xe3\xd5i~\xff\xad\xb5\xd8\xed68>:AaJ2\xe9\x11\x1d\xfa\xbeëW\xcfqu\xf5:\xdb\x03K\xfe\x10yo\x11l\x87f\xf9\x1dhS`\x1c:\x9c\x9c\x9c\xa3ii\x82\xba\xbe\xba\xc0v{\x85\xa1\xef\x10\x9c\x87\x1bG\xf6\x98\x90\x18\xc6\x1e!8\x04\x1f\xb1Z\x1d\xe1\xbd\x0f~\x03\xe3\xd8\xe3\xe9\xd3Op\xbd\xb9\x84\xf3\x01\xbd\x1do\x15\xafZk\x14\x9c\x94'\xe2]ywӿi5\xed\xe6\xf7C\x8f\x8b\xd7\xcf\xe9\x9e[\x9d\xa0m\x96d3l=\xea\xba!\x0f~)\xf3\x04\x19\x9c\xc7\xd8\xef\xb1\xdb\\a\xec\xbb$̃C\xc4\xf9j\x89\xd5b\x91Iy\xe9\xdeR\xa9\x01`2\xa1\x94\xe4\xe4\xa8g\xc5_\xf0\xeaF\n\tc\n\x9c\xbe\xf3\x00\xf5\xa2}\x03F?\xab\xd3\xf1\x90\xe5>\xff\xfe\x1c\x90\xc5(ě\xcbw\x9c\xfd\xabx\xf3~\xff\xab \x03sr\x81\x98\xb9\rƛ\x00\x85\xb8\xf1\xaf\xd3\u007f'\v\xe9o48\xf1\xf5\xd3r@a\f~\xfd\xfd\x0f\xf0\xf8\xec\x01>y\xfaE\xb6K\xf6\xc1c\xb0\x03\x8c.g\xe6g\xd4 HA\xefG&\xcb\xc6\x00!t\x0ef\xaa\xca\x06ݰ'\x84*F\x94ƜgN\xc6\xfd\xe3\x1e\x01\xf8U<\x8c18\u007f\xf8\x18\x88\xc0\xab\x97\xcf\xf1\xa6\x0e\x93\xbc\xfaE&\xa0\xb4\xed\n'\xa7\xe7\u007f/\x06\xff\xc3n\u007f\x8d\x10\x1c\xd9\xfc\x06\xda\xff\x1f\x14\u007f9\x91\xfe\x92\xe6<\xed\xfd1+Z\xa3\x9f\xd9\xfcb\"\xcd\x10\xea@V\xbf\x86\x13\xfe\x06\xf6n\x9fK\r\xa7\xe2OS\xb7V\x9aL:b\xc4z\xb1Bi\f<\a\xf7X\x9e\xba\xd2*\x82\xa2|\x1d5 \x11\xa8\xcb\x12\xa5)\xe0C\xc0fO\xa6:\x89\xb3\x90\x88}\xc6h4u\x83\xe3\xf51\x1a\x8e\xbd\x8d\x812\x03\x1c\xaf$2\xcb^\x92R\xa1\x1b:b\xfc{\xc7S\x02\x1d\xc4\xd1\a\n\x8da\xdeDfr+\x8d8\xf3\x14O\xc4\xca\xc0\xeb\x95\x04\xc7O<\x01\x81Rk\x94\xa6\xa4\t\xf0V\xe5'\x88\x11RA\x8a\b%XU!\x99\xe2\xc6v\xc6F\x1b\"\xdf\t\x92L\xd5uK\x013\x88\x1c\r\x1c\xb0^\x1e\xa1m\x16\x881\xe2zs\x89\xab\x8bW\b\xde\xe7\x838\x1b\xe20\xfb=\xf0\x94\x1f\x11(\x11\xef\xe1{\x99\xb9\x1ec\xc4v\u007f\r\xad\x15V\xcb5\x9c'?\xf4\x97/\x9f\xe1\xe5˧\xb8\xbaz\x9d\x9b%p\xcez\x88\x01c\xb7Ǣ]b}\xfc\x10\u038dPR\xe0\xe4\xf4\x01\x94R\x99\xf4\xb7\xdfm\xf2{\xee\xdd\b\xf0\xf4\xef\x1c\x11\xacV\xebc\xbc\xf7\xdd\xdf@Dċg\x9f\x93\xe1\x92\xf7\xe8\x86\x1e\x9e\xf5\xec\xe9\xcc-\x94Dm\x14\x87V\xdd1\xc2\u0383m\xd8m/\xad\x95^]\xbe\xc40t(L\x81\xf3S\xb2%\x1e\xfa\x8e\f\x87*2\x87\x12\x11\xa4\xec\xf0\x1e\xc1[t\xbb-\xba\xdd\x06v\xec)\x8d1\x12Zt~t\x9c\x95\x11)\xf1N\b\x01-9\xe1O+n\b8\xfcGiH\x96\xf1\xa5\xb4M\xa9\x14\x8e\x1f\x9c\xa0^\xb6\xf3\x8f\xc7\r\xad\xff\x8d\xe2\u007f\x97\\oF\x80\x8c\xe2\xf6\x00\x1fE\xbcS\xd9/\xde\xe4\xea\xf3\x96G\xbc\xf5q\x167\xd6\x02i\xe5p\xf7\xe2\xe0.\xde\xc07E\x01(\x12Y\xa2,\n\x18\xa5p~|\x82\x1f~\xf7\xfb3wd\xf2p\xe8ǉ\a\x10X\"9ݯ\x12\xf3\xec\x91\xf4\x1bJ\x10\xeff\xb4#!Uc\x0f\xa5\xd4I\xb2Ǿ\u007f\xdc7\x00\xbf\x92\xc7\xc9\xe99\x9a\xa6\xc1\xe5\xe5+\\]\xbe~+\xf6FD\"␟\x9f?F\xd3.\xff`\x1c\x06҃\xb3\xb9M\bdn3\x8f\xc8<\xd8\xf9a\xda\xfb\x87\x10h\x0f-\xd8\x00\x87'bBQCޣ\x19]`\xb5\\\xa3\xaek\x82\xe7\xc7\x01\xbb\xc4\xf8\xe7\xfb\xcc9\x8f\xfd8bt\x1e\xde\xc7\xcc\xf0wޡmZ,\x9a\x96\xa5~\x81s\xe1\r[z\xd2\xcdh\xc7\x11֒\tPS\x96(4\xe9\xd97{\x92\xf5\xa5\t\xdd1\xb1\xaf,\n\xd4e\x8d\xa3%ɰ\x92\xb6\xdfZ\xcb>\xef\xf2 -MD2\v\xa2\xd8`\x97\xdd\xe3$\xe8y\x95\x14\x90Jf\xb2\xa3\x94\nJ\x91^;\x84\xd4X\xf1d\x18\x93\xb7\x82\xe7\xc0 \xcf\r\x8f¢\xae\xd1T\r\x8c\xd2\xc0]\xebX\x90\x16\\\n\x01M\xd1\xf0\x90\xd1C\xf1\x04%\xf3\xa1VA\xb3\xdcP+\x83\xa6^d\xb5\xc30\x8e0e\x81\xa3\x93\x13HEE\xec\xea\xf2\x02}\xdf\xe5\x95\x0f\xc9\x1e\xa7\u007fO\xc6Kirzx\xfe\x0e\x16\xed2\xbf\xc7v\x1c\xb1\xef\xf6X-\x89\xc4\xe7Ƒ\x02z\x86\x0e\xdb\xed5Y\x103\t\x10\xcc\x19\x19\x87=\x04<N\xcf߅1%\xbc\xb38>}\x80\xa6%c\x96\xed\xf6\n\x9b\xeb\v\x8cC\a\xef\x1c\xad|\x90H\x99#b\bX\x1f\x9d\xe2\xdd\x0f~\rRJ<\u007f\xfa)\xae._\xc1:\x8f\xbe\xef1\xb2Kۜ\xdeWi\r#y\xfa\xbf9\x0fϤi\xc9\xf2Uk\x82\x84{\xe7qq\xf9\n\x88\x01\xab\xe51\x16\x8b5Ʊ\xc7\xd0\xedI\x82W\x96\xecp\x19\xa8ap\x16n\x1c\xb0\xbd\xbc\xc0\xe6\xfa\x02\xceZH.\xa6mU\xe3h\xb9<\xccg\xe0UQa\f{\xfb\xabL\xf6\xd3\xc6\x1c\xc4\xf7ҿ\x03ˣ\x15\x16ǫ\x9c\xb5!pX\x90\xe3\x8d\xff\xbb\r\xa5\xcf\v\xd7!b\x80;\xfa\x84\xbb\xf0}\x91 \xf0\xaf[\xfcgk\x84\xc3<ɉc \xbe\x82\xca_0\xa9\xf2\x9b.\x02\x94\x94\x14\xa1\xac4ڪ\xc6\x0f\xbf\xff\x03\x1c\xafֳ\xa8r2\xb4\nܴ\xc6\x10\xf3\xd0\x11b\x98%\xaa2\xd14e\xa0\b\x81\xba \xff\u007f\xeb-\xac\x1b\xa1\x948\xd1Z\x1fđ\xdf7\x00\xf7\x8fo\xbe\xc3R\n\xeb\xf51\xbc\xf7x\xf9\xfcٝ\xd0\xd2\x14\xef-\xd8\xc5.\xa2\xae\x1a\x9c?z\xe7\x87Bȿ\xb7\xdb]\xc3\x0e\x03\xed\xb4ٔ&̦QHq\xabSO{\u007f\xc4\b\xc9\xcd\x009\xfd\xc5<\xf9\xa7W\xa2\xb4B\xdb,\xd06\v \x00\xce\x0e\xd8\xef\xae\x10\x9c\xcd\x12B\xeb=\xba\x94\x12\xe8<\xb4\"3\x1b\xeb\x1c\xd9\xd6.V\xb3\xac\xf8.\xc7\xf6\x82\x93\n\xfba\xc8\x06<uIaB\xfd8`\xdbu\x04\xd5)\x92\xf48\xef!%P\x14\x06eQb\xb5X\xa0\xa9\x12\x8b7\xc0\x8e\x16\xce[\b\xf6\xb1\x9f\xae\xa1\xc2\xe8,M\x94\x8e\x9a\xa4\x84^\xfaH\xd7\xc1(\x8d\xe8c\x9e\xf0\x88\x95\r\nNJ\x1ajČ\x04\xa4ݿe\xbbѪ(\xb1\xa8\x1bڧ\x03\x18\xad\x9d\xf6\xef3ɿ`\x13\x14%#\xb4\x14@\xf4\x04\xa7saΰf\xca7\x00P\x94\x15\xaa\xaa\xa2\x89u\xa0¹Z\x1e\xa1iZxg\xf1\xc5\x17\x9f\xe2ɓO\xd1\xed\xf7y:\t\xbc\xe7$W4ޣ\xcf\x02\u007fΎ\xcf\xf9w\x14\b\b\xe8\x86=\xcb,)(\xa7\x1fz\xf4C\x8f\xddv\x03\xc7<\x8bq\xec\xe0l\x8f\x18<Ʊ\xc7\xd8\xef\xb0j\x97X\xae\xcf\xe0\xbcES78:>\x87\x94\n\xc3\xd0\xe1\xfa\xea5v\x9b+\x04\xeb8\x1c\xca\xcf\xf6\xb2\x0e\xab\xa3S<~\xef\xfb\xd0\xca\xe0\xf9\xb3\xcfq\xf1\xea\x19|\x8c\xf4\xb3\xc7\x11!\xd2!\x93b_\v\xa5Pi\x05%\xee\x9c\xfd\xf3\xce<\x15+\xa54\xbb\xec)\\\xef\xf7\xe8\xbb=\xb4\xd28=y\x00%56\x9b+\x88\x18\x99$\xa8\t]r\xf4Z\xed\xd0a\xbf\xb9\xc6\xeb\x17O\xd0m\xaf\x10\x83\xcbĵ\xf5\xa2ES\xd5č\x99\xafm\x84DQ\x969\x92\x9a<\x00LVk\xc8\x1c\xb6\x054m\x8b\x93\xc7\xe7Ԩ\xce\xc7\xff\xbb\xee\xff;\xa1\xfa\x98\xd7<s\x14\xefM\x85;\xbeI5\xc8?D\xc8\x1bK}\xdcF\a\xc4]\xb0\xa4\xb8)I\xbcm.,\xe2[\xc4\x01_\xb3\t\xb9\xab\x81\xd0R\xc2\x18\x8d\xb20x|z\x8e\xbf\xfc\xdd\xef\x91\xf2\x05\xf4ڬ\x1f\x0fy\x00\xac*!\xd33Z\xfb\xa4\u007f\x9fs0\x8c)(\x1c\xc89x\xfa잔\xc6\x1c\xf0j\xee\x1b\x80\xfb\xc77~Tu\x83\xaa\xaa\xb1\xdbm\xef\xb4\xfb\x9d\xdfi\x92ID\x00p|\xf6\x00\xeb\xf5\xc9\x1f\xd8q\xc0~C\xc58\x15\xff\x14\x9aC\xc5_Π\xb9\xe9\xc0\b\xbc'O\xd3U\"\x87\xc5\xd94A|&\x85\xa6j\xb0\\,!\x05\xe0܈\xfd\xee\x1a\xa3\x1d\xe8\x06\x8a\x01\xd6{\xf4\xa3\x83\xf3\x81\xc8y\x92x\n\xa4Q\xd7X-ל\xbaF\xeco\b\xc0\x14E\x9eֺ\xbeG?\f\x10B\xa2\xa9*()\xb0\xef{\xec\xfb>O\xf1\xa3%\xe7@\n[)є5V\x8b\x05\xea\xaa\xe6b\xe7a\xb9\xa8H\xceJOǛ\x10\x02!z\xec\xfbn\x06էU\x02\xad<\f\xbb\xafE\xe6:(\xa1\xf2\x8a\x84\xe4s\x11\xfe\x80A\x1c\xe1\xbc\xc5ȅ\xb1\xadk~\xed\xc4w\xd8w]\x0e\f\xca\xe7$g\xaf+) A\xc5_\x82\bs\xd9\xf7\x1d$1*My\x00\xa1\x96e\t]\xd0\xea\xa5\x1f{\x18S\xe0x}\x04%%\xb6\xdbk|\xfc\xe1\xcf\xf0\xf2\xe53\xf4c\x87\xa4\xeb\xf6\x93\x1b\f\xc9/\xf9\xbf\xb5\xd2xx\xf6\b\x15\xef\xbc\x05\x80q \xb9S]5\xf0\xc1a\x18:\xf4\xfd\x0e\xbb\xdd\x06֎Ċ\x0f\x0e\xdd\xfe\x1a\xce\x0e\xa4\x92\xe8\xb60B\xe0\xf8\xe4!\xb4!\u05fc\xe3Ӈ$\xb7\v\x1e\xfb\xfd\x06W\x17/`ٌ\xc5y\x9b\xfd\x17\xdc8b\xb5>\xc1;\xef}\x1f\xda\x18\xbcz\xf9\x04/\x9e\u007fN\xe6Lv\xa4l\x06\xe7\xb2\xee?\xf2\xfa\xaa\xd6\nF\xbe\x9d8\x96$\x8et-\xc9Q\x10R\xe3\xea\xfa\x82M\x8aVX\xad\x8e`\xed\x88\xeb\xab\vTU\x83\xb2j\xa0\xa4B\f\x11\xdeZ\xb8aD\xbf\xdbc{u\x81\xcd\xd5k\xd8q\x80`+yc\n\x1c-W$\xc3\rӾ]H@+B\x00\x84\x92l'\xccֱ\xbc\x1e\x10<\xf9k\xa3q\xf6.I/\xf3_\xbe\xbb\xc42\xa1\xe4\r\xd5\xf3.\xd6\xff\x1b\\zߔ\xd3w\x00\xe0\v\x99\a\x86\xbb:\xac\xf8\x86\x02,\xde\xf2\xb3\x0f݁cn\x06\xf2?\xf8\xda[\x88\xdb?\x9fWf\x85.\xd0V5~\xf3\xfb\xbf\x86\xb6\xae\xc9\U0009a0dfFg\xf3\xcb\v1ɝ\x03\xabQ\x14[H\x879\x88\x04%\x15\xea\xb2!\xcb\xf0\x10 \x858i\xcaZ\xdds\x00\xee\x1b\x80_\xc9c\xb9\\A*\x85\xed\xe6\xfa\x8dĒ\xf4\xc7Z\xd3>Y\x9b\x02\x0f\x1e\xbdw\xae\xb4\xf9G\xfb\xfd\x06\xfd~Gle\xef8\x03;\xbd;\xf2p/\x97`a黎\x00\xa4\xc1?\x8c\vf\xbd\xbf\x94(\xcb\n\xcbv\x05\xad4\x19\xe7t\x1b\xf2\x96\xcfFA\x01\x9d%#\x1c2\xd9!\x1bX\xda=\v\xac\x96K\x92\x00z\x87a\x1c\x11\x82\x871e\xb6i\xed\xfa\x8eS\xfa\x14\x9a\x9a\x8a\xdenO\xb1\xbc)\x19\xb0\x1f\a2\xe8\xd1\x1aeQbѐ\xf6\xba0\x05$'\x05\xe6\x82!&\x87\xc3\xf9\x89\x94\xa6Y\x17|V+\xa4}\xbe\x96\x8a\x9d\x0f\x01\xc9{ۀx\x90,\x16\xd8I1I*\xadw\x18\x9c#7ĺEY\xd0\xd4\xdf\r=\xf6]?\xe3_L\xf3\x10\xed+\xc9DFK\"\xfdAD2\xa0Q\x8a'\x11\x85\xc2T\xb3\xf7\x8cv\xcb%\xc7ˎL\xa0[,\x96h\x9a\x16!x<{\xf6\x84R\xcd8>y\xce\xddH\xab\x9e1I4c\xc4j\xb9\xc6ju̖\xb3t\xfd\xf6\xfd>\x87Fy\xe7\xd0\xf7{r?\x1cz\x9aZ\xa5\xc0\xd0\xef\x11\x18\x1a\x1f\xed\x00 \xa2ij,V\xc7\xf0ޡm\x16X,W\x14\xc7<\x0e\xb8f\xe8\xdc{Vb\xf0\xf5\x1c\x87\x1eu\xbd\xc0\xa3w\xbf\a\xa3\v\\\xbe~\x8egO>%?\x86H\xefe\xb2\x9eNf<\x80@\xa9$J\xc5\xd3\xff\x1d\x15#'\xff\xa5\x9a\xc9q\xd3\xda\x18\f\xdec\xb7\xbf&\xb5\xcd\xf1\x03\x8a\x87\u07bc\xc6\xd0\xef\xb1hW\xa8\xaa\x9a\x8a0#I\xde9\xb8q@\xbfߡ\xef\xf7\xf0\\<\xa4\x94\xa8K\x8a\x92\x16)\xabRLS\xac)J\x82\xff\x93\xe9\x8fR\xcc頵\x8eb\x93\xa1\xe3\a\xa7\xa8\x16mnP\x0f\x8b\xa7xs\x15\x9fi\x01#\x87t!\xc4;ay\xdc\x1c\xca\xc5\x1bNlq\xd74\xceJ\by\x88\x02|\x95:-\xde\xda.\xdc\xdd \xc8(\xbe\x11\x1f!\xffL\xbe\x87\n\xa3QW\x15\x1e\x9f\x9d\xe3\xfb\xef\xbc\a\xcfg\x1d\x10\xf93;\x9d\x83\x82\xaf\xe7\x1c\xc5\xf1\xec\x96:\xbf\x96U\xd10\xd2I\xf7\xfe\xa2mO\xee\x8d\x00\xef\x1b\x80o\u007f\xf1\xa4\xc4b\xb9\x82\xf7\x16\xdb\xed\xe6K\xbb\\\xc5\xf0\xffj}\x84\xe3\xe3\xf3\xdf\xf3\xdeջ\xebK\xf8$\x9b\xb3c.Z\xe2\xae\xe2\x0fd\xd8?\xc5d:\xef'\xa8\x8c'\xdc\xc0\xbb\xb0ҔX\xb6K\x94\xa6d\xdb\xd6\x1d\xfa~\xf2ֶ!\xa0\xb7>G\f\x87\x18\xa6\x14\xb9\x10\xd14-\x1a֬[k\x89 \xa6\x8b<Z\xf4\x03M\xf9Z\xd1\xe4\xef|\xc0f\xb7\x87\xf3\x9eȃ\xdeaߓ\x86\xb70\x06u\x95\xa6\xfe\x8a\xf6\xf3L\xea\x1bƁ\xe19d85)\x93\x05\x80ю\xd8u\xfbL\x1cL7~\xba\x06\x14\xf1)(\x80e&\xb1J\x03\x90\xcb+\x95\xa9\xa0Z\xe7`\x8cF\xdb4\xe4\xca\xe7<\xb6\xfb=\xfa\x1c\xfd{\a\u007f#C\xbd\x91\xe4kL@L֢B\b\x94E\x05\xa5䌩\x1c \x15Me֎\x18G2\xffY.WPJa\xbf\xdb\xe1\xe5\x8b'tͽ\x9bR\x1fc\xcc^\x0f\xd6{\xb8\x10X\xc6)q\xbc>CY\x10A1ƈq\x1cཅf\xb5\x83\xb3#\xfa\xbe\xc3~\xbf\xcbE\xcfY\v;vD\x96\xe4\x0fS\xa9\x14\xdav\x05c*\x0e\xed9\xa1D\xc7\x101\f\x1d.^=%\xc9\x1f#Si\x17\xab\xb5\xc1\xe3\xf7\xbe\x87\xb2\xacpu\xf9\x12O>\xff\x98\xd4\x1a\xdc\xe0\x8c\x96Ƞ\xe9\xad\b\xa0:T+E\r\x94\x10w\xba\xd9\xc7\x19S\x80\xf8\n\x04\xfd\x97e\x83\xed~\x0f\xefF4U\x8b\xe3\xf5)B\bx\xf1\xe2\x19\xb4\xd2h\x9ae\x0e\x1e\n\xd1\xe7F\xday\x8b\xaeۢ\xdfo\xe0\xad\xe5\xa2n\xd0V\x15\x9a\xaa\";陝\xb6\x92\nUYB*b\xffk\xf6N\x90\x8a\xd6]\xa6(PV\x05\x96GK,\x8f\xd7w\xac\xf2\x0f\x95\xf5y\x83.\x05n\xa9\xebcD\xe4P\xac\x03\x87Ϸ\x96\xe5\xafQ\xb5ěk\xb8xß\xdc\f\x17\xca\xeb\x8b/A 0\xe3\xa5\xc8o\xc8\x04P\xcc#0Z\xa3)K\xd4e\x85\x1f~\xffנ\x95Β\xc0d\xe8\x93V\xa0\xe9\x15\x117 2:\xe5\x0e8$\x10D\xba6\xbaȫ\xbfʘ\x13}\xef\x03@C\xe9\xfd%\xf8\xe6\x0f\xda\xeb\xd6\xe8\xbb\x1e}\xdf\xddY\xf4'\xae\x80\xccL\xe3\xf3\a\xef\x14eU\xfd\xfen{\x8d\xfd~\x83\x18\x03\x1d\xe2\xce\x1d\xec\xf2\x12\x01\x87i~\x88\xc1\xe7\x0f\xb1`\xd8\xdcy?\x15\xff\x99O\xb6\xd1\x05ڶE]Q\xb2\xdb8\x10\xe4\x9b\xee\f\xe7=F\xe7y\xf7\x1ey7O\a\xb4\x0f\x14\xf2\xd3\xd6\ry\xf8\xdb\x11\xc3س\x9f9\x15\xe0a\x18\xb1\xdbw\xd0J\xa1*\v\f\xa3E7\x0e\x10\x91LT\x02\x02\xb468*kڧ*\x85\xb2,\x98\x03AE\x894\xfcT8\x8a\xa4\xddNk\f\xee\xe4\xads\xd8u\xbbl\xf3\x9b\x0e\xa1\xb4\xc2\xd3l\xfa\"\xa5\x9a\xf4\xf1\xb3\xeb\xee\x98T\x99\x8e\xe7\xc8i\x85\xdahN$\x13\x18G\xe6\x16\xf0\xf3ߞ\xc6\"Tz\xda\xc8\x11\xc2|2\x1am\x902ƍ!\xed8\x0e\x12\xdb\xc8\xc66\x06\x0f\xef(\xf9\xaf,+\xb4m\x8b\x18\x03^\xbexJ\xd9\x0f\xbc\x92H\xb2\u0379\xc9\xd3\xdcЩ\xaaZ,\xdbUΣ\x0f\xdeeG\xc1\x94\ue5ca\u007f\x8c!\xe7\xae\xf7\xfd.\xe7\xc8\xd2jH\x903c\xb3BDD\xdb,Q7\xecQ\xe0\x1d\xae\xaf^\xe3\xfa\xea5}6\xd8f5\x04j\xd2\x1e\xbf\xf3=4\x8b56\u05ef\xf1\xc5g\x1f\xc2Z\v\xa5\x15\x91\x04\x9d\xc7`G.lȄ\xc6J)\x18%\xa1ӵ{ˌI\x0ez\x11RjRQ\x14\x15\xb6\xbb+H\x80\x8c\x8d\xea\x06\xbb\xdd\x15.\xaf_\xe1\xbb\xef|\x1fUݰ\xb3[\x84w\x01\x88\x0e\x02\x1ec\xdf\xe1\xfa\xe2%v\x9b+\bk\xa1\xb5BUU8Z\x1fq\x04\xb5ȹ\x15B\xb2\x9f\u007fY\xb2g\x04\xad)\xa4\x94\xd9\x10H\x17\x05\x8a\xb2@Q\xb1\xb1\xd2\xdbJ\xb6\xb8\xb1?\x17@\x8cb\x06\xfb\xcfX\xee\x00⛞-Πw\xaa\xb2\a\xa1A\xe9\x9cy\x93\xb4\x10\xecF8\u007f\xb2\xf8ֆ\xe1P\x03x\xa7\xcd\xc0\x1d/6G\x14\b\x01\x11\xdf\xf4z\xbed\r 8\x82\xba\xacP\x16\x05\xde\u007f\xf4\b\x0fON\xf1\xc5\xcb\x17P\x92\x9c3\x83\xa7\x94\xcf8k\xf2\xd3\xf5\x94R\xc1\xba\x91\xd6\x00)\xa4K\bHA\xf7\x86u\x03\x9f\xc5\xfa\x91V\xf2'\xf7\x15\xec\xbe\x01\xf8v\x17\x8f\xa3%\xad\xa3\x0f\xe6\x9b?\xdc\x04\xffGD\xb4\xed\x02\xa7\xe7\x8f\xffa\x8c\xf1\xd1\xf6\xfa\x02\x8e\xa1\xefq\x1c\x99\xa0$\xb3&y>\x15\x85\xc0\xb2&L\xb0\xb6埙\xc2D\xd2\xdf1\xda`\xd1,\xd0\xd4-Om\x1d\xba\xee\x1a1R\f\xaa\xf5\x01=\x9b\xf4$\xb7>\xc1\xc54\x00к@S7\xe4\xfb?\x8e\xe8\xfa>\x1f\x86J\x92\x89;\xdbCk\x82\xec\xba~\x80\xf3\x1e\x85.P\x97%e\xaa\xf3\x84*\xc4\x1dq\xa6\xb3L\xefdə\n^\xf2\x83\xa7\xc6á\x1fz\x8c#\x9b\x1aE\xda\xc1'F\xb5\x14\x02F\x19\x8a\x87\xe5&I\xcc\x12M\xbc\x0f\xf9}I\xb0\xaaw\x1e*\x1d\xf4\xa0\xe2\xbfgS\xa1\xbb`\xc1(\xe2\xc42\x0ela+\x15\"H.f\x94\xe6i\x95~\xe7\t}\x10\aG\xae\x92\x12\xceR\xda\xe0\x83\xf3\x87(L\x81\xae\xdb\xe3ŋ\xa7\x99\xb86\x0f\x1cJ{p\xeb<F\xd6\xfe\xc7\x10\xd04\v\x14E\x99\x0fAra\xa4\xcfba\n\xf4\xdd\x0e\xfb\xfd\x8e,~yڶ\xb6\x87\xb7\xfd\x81\xa2\xa20*\xafh\xa2wX\xae\x8f\xa1\x95\x01B\xc48\xf6x\xfd\xe2\tM\xff\xde\xe7\xb5I\b\x01現\x83\xd5\xfa\x04\xfb\xdd5>\xfb\xf8\xe7\x8c\bhF\x8d<z\xce7H\xb2\xd2\xc0\xbb\xffR+\U0009463d\x86;\t\x80\xdc\x04\n\xa4\x8c\x8c\x13\x8c\x10\x18\xba-\xaa\xb2\xc2\xe9\xf1\x19\xdb%\xbf@\xf4\x0e\xc7\xebS\xe6BHv\xc3\xf4p1\xc0\xdb\x01\xfb\xdd5.^?\x87\xeb{\x94J\xc1\x14%Vm\x8b\xe3\xc5r\x92\xaeI\x91\xf9\t\xa6(P\x96\x059\xfd)E\x8diQ\xa2\xa8J\x98\xa2\x80)\nHM\xbe\x18\xf2 \xd6\xf7˧\xdet=\xe2\xcc\xe7_H.\x96\xe1v#pW\xfd\x8c\aH@|K\x13\x15\xa7vwV\xac\xe3\x1b\f\x83\x0e\xf1\xb2x\xf0\xec_wU.\xe6\xb9\x05\xdf\x00Q\x15\x82\x1a\xd3E]\xa3\xadk\xfc\xfa\xfb\x1f\xe0\xb3\x17Ϡ ):\xdb[FR\xc1\x9a\u007fr>\xa5\xc0\x1f\xc1\xb2\xde\xc0\xae\xa4\xa9\xb1 \x15\x8e\x94\n\x11@UT'\xc5}\"\xe0\xfd\n\xe0\xdb>\xea\x9a\xf2\xa8\xc7a\xf8\x92\ue58a\xba\x00p|\xfc\x00\x8b\xc5\xea\x9f\f\xfd\x1e\xbb\xed\x15\xb3\xb1;\xfe0\xcf\x12\xfe\xe6O\x10\x02\xc3\xc31O\xfa\xd6{Ā\x03m;\"\x11\xc4\xdaz\x81\xa6i\xa8\xe8\x8c#zNn\x03\x17\xff.\x15\u007fޅ\x87\x18s\xb1V<ui\xa51\xda\x01]\xbf\xa7\x9bK)(I\\\x80]\xb7\x83\x90\x04G\x8f\xa3EQ\x148?9\xc5\xf9\xe9\x19\xd6\xcb5\x16\xed\x02\x053\xe0眄x#\xfb|~\\\x056@\xf2\xdc\xe8\x8c#Mˣ\x1d9\v!fb`z\xedF\x17YU\x91\x9d{\xf2%\x8bYڗ\xb2\x13\xbc?,\xfe\xfd0Њ\"\xc4\xf9)}8@q)O\x04Bb\xf9\xd3\xda!M\xfe!FTE\x05)ԁ\xdbk\xe2gH%\xa1t\t\xebɬf\xbd>\x02\x10\xf1\xe2\xf9\x13\xec\xb6\xd7y\xfaO\xcf5\x87|S\xf6\x82`W\xb4\xa2(h:e3\xa6\x10<\xf3\x0e\n\xc4\xe0\xb1\xdbn0\x8c}\x9e\xa8\x10#\xfanw\xb0V\x91B\xa0`\x87\xbb\xfd\xf6\x12J+\xd4U\xcd\xe9\x8c\x0e\xdb\xcd%./^r\x8c\xae\xcd\x1c\x8a\xe3\x93\a8::\xc30t\xf8\xf4\xa3\x9f\xa2\xeb6t\x18\vP8\x90\xf7\xe8gFO\xe9w)\xa5\x84\x16ę\xf8R\xbd\xf8\xecsQ\xd7-\x96\xc7\xe7\xd8\xf7[x?b\xb9X\xa3m\x97\xb0C\x8fW\xaf\x9f\xa3*j\xacק0EI\xd7γ\xcc3\x06\xb8q\xc0\xd0\xef\xd0\xed6Pl\x17\xdb\xd65\x96M\x8b\xba*\xf3\xf4\x9f\xd3\xf6\xa4\xa4υ\x9e<\xfd\xc9[\xa0\x84)\v\xe2\x05\xb0\xf1\x8f\xbcŶ\xff\x8ax|\xb8\xe1\xae#DnDn]\x97\xb7\x91\xf7\x04\u07be\xa5\x8f\xb3\xc9\xe3+?\xe2\x8d\x06\xe3\xcbz\x9bx\xe0f\x98~\xa6\x14_\x95i\xf0\xe6\x06\xc0(\x8de\xd3\xc2(\x83\x1f\xbc\xfb\x1d4l\xa4\x15c\xc0h\x87\x83\xe6zn\x8a\x95~\x8b\xe0}&+\xa6_B\n\t\xad(ɴ,\xaa\x93\xb2\xb8o\x00\xee\x11\x80o\xf9\xa0t\xb2\x88\xddv\xfb%\xf0?u\xa1Jk\x9c\x9c=\xfc\x0f\x94\xd2\u007fm\xbf%\xe9\xdf0\xf6\xd9K?\xc9\xcb\x0evX3Ӝ\xc4`\xf7\xa9\xa0b\xfa\xb3\xc0ҷ\xa6n)\xf4\x82w\xc1\x14\xf0C\xf21\xeb=\xed\xfc\x91X\xf0\x93\x13_\xf69W\x9a\xf4\xfbn@\xd7wİ7%\xd9\xf3\x06ړ\x03\x11ZQt\xear\xb1BU\x94\ai]κ)\x1c\x88\x93\x01\x05[v\xc6\x10\xf2\xdaB\xf0D\x9f\x1c\xbcR\xe1&\x93\x1c\x9fe\x83\xde\xcf \xfc\x18\x10\x11a\x14y\x88\xc7l\xc9;\x11\xb1h\xa5\xe1\xd8\xd2V\"\n*\x0e\xdaP\x13\x13#\x05\t\r\xe3\xf8\xe6\x83\xee\xe6\x92:\xf0>Qi\xcc\xe3D=\x17\xf5\xb2(\xf3\xf4\x946\xc1\x83\xa5\xdd|]\xb7|}\x81\xb3\xd3s\x14E\x89\xcd\xf5%\x9e<\xf9$s?\xe2\xec=M\x9f\x01b>\xbb,\x8dSj\x8a\xb9%\x05\x84\xe5\x1d\xa9\x87R\x12\xdb\xebk\xec\xbb\x1db\x98\xd6\x14C\xbfC`4 \xaf\xae\xd8\xc7>IM\xc9NW\xb2C\xa1\xc5\xeb\x17Oa\xedȄ:\xfa\xec\xadV'8:>\x83\xb3#>\xff\xe4\x17\xd8\\\xbfFY5\x80\x94\x94Z\x19#\xfaq\xccY\x05\x81/\x99\x042\xf1O\xdd\xd5\xdc\xdeY\xe4\xc8\xc3a\xb9>\x85\xa9[\xecvW\xe4Byr\x06\xa55^\xbd~\x8e\xed\xf6\x1a\xef<\xfc\x00\xcb\xd51\xb9P\xb2?\x85\xe0\x9f\xeb,\x05\x16EO\xbe\xef\xa6(\xd06-ڦ\xcdȇd\xff\xff\xe4\xf0WV\x154C\xff\x11\x80*4\xdb\x01\xa7\xebN\x1c\x82\xc35\xcfW\xac\xff1ީ\xc1\x8fB@Ȉ\x18f\xc8\xfb\x97\xd8\xeb'\xc8\u007f\xde*Ʒb\x0f_\xd6k\xc5;I\x03s\U000e6ede3\xbe\x01\"\x10\xb3u\xdbכFEF\x1a\xeb\xaaDS\xd78;>\xc6;\xe7\x0f\xf0\x8b\xcf>\x85R\x12\xa3\x1d\x0e\x82\x81&\xe9a\xcc\xeb&\xe7-\x8c.\xd8\xccHf\x94\xc0\xa8\x02>X\x18]\x9c\x94\xf7\b\xc0}\x03\xf0m\x1e\xb4Ӯ\xe0\x9d\xc70\xf6w\x16~0\xb4\x98LC\x9a\xa6\xc5\xd1\xf1\xd9\xef{oɚt\xe8\xd8G=M\xa9\x12s\xdfP\x01N\x02D\x98\xa0\xf1\x18\xf2Ě\bc\xc9\r/\x17\u007fc\x88\U0007ffc6˞\xed\x1e\xbd\xa3\x82/Dd7\xbf\x90S\xfe\x84$\xabܢ\xa0t\xc0\xa9\xf8\x1b\x18\xad)\x82\xb5\xdbC\n\x81\xb6nQW\x94}\x9e\xa0\xfb\x81Mh,\xab\x18\x9a\xba&f>\xc3\xe6\xc4V'$#[\x13\v\xc90%\x9d|S~\x00\x15\x14\"\x93Q\xc3BŃ\x9a\x1f!$\x8a\x9c\xf6&\x0e\x82\xcd(=ѳ\x11\x90\xcc\xeb\x13m4\xa7\x0eF\f\xe3\x90_\xe7[w\xb7\xb3\xa9\x99\xf6\xfd\x9a\xf3\xde\xc9m\x10\xc1\xa3,\xc94(I\xa9&\xff~\"].\x16+Te\x83\x10\"\xdav\x89\xd5j\r\xe7\x1c\x9e|\xf1)\xba\xfd\x8eC\x88\xec\xc1\f\x96\x1e#K\x1eS4na\n\xda\xf3+ɤIʦ\xb7.\u008d#v\xbbMVRH!\x10\xbc\xc70\xec\xa1\xe4a\xd1)X\x8a\x1a\x82\xc3\xc9\xe9C\xb4\x8b\xa3\xcc1\xe9\xbb\x1d.9f7\x99R\xad\xd6'X\x1f\x9d!Ā\xa7O>\xc1\xebWϨ0\x1aèM\x80\v\x01\xdd0\x10\tu\xa6\xc0\xa0\xbd\xbfȻ\u007f\xf1\xa5e\x89\u007fע\xc4\xf2\xf8\x1c!\x10\x82Q\xd7-\x16\x8b#8\xef\xf1\xf2\xd5s\xc4\x18pv\xf2\x00u\xd3\x12\xa7\xc5:\x80ÉBp\xb0v`\xe9\x1f\x15\x89\xba\xaaQ\x97%ꪜ\f\xa6ҽ\xc9{\xfe\xb2,\xb9!e\x02\xbd\xe2hg\xad8\a@Ô\xe4\x1c\x18<\xa1b_g\xc7}\xcb\xe1G\xd2\xda\nA\x12\xd3GL\xbb\xfex\xc3w_\xdc,\xb8)\xd0 \xe2\xcdҽx\xb7\xcb\xc2\xed\xe2\u007f\a\xf2%ė\x8a\x00n5\x1eq\xf6\xbbƈ\xaf\x1d\x13\xc4D[/\x04\nm\xb0n\x17\xb8\xden\xf0\xebｏ\x0f?\xff\x94\xf80\xe3\xc0\\\x14\x919?S\x8c\x029\x88B\x03\x01\x01DI\xa4!\xc0\xc7\x00\xa55\xfc蠔:/\x195\xbao\x00\xee\x1f\xdf\xe8Q\xd5\rʪB\xdf\xeda\xc7\xf1͟i9\x91\xf9\xd6G\xa7\xef7\xed\xe2\x1f\xec\xb6\xd7\xd8m\xae\xd0uD\xccJF'\x87%\x80ljS1\xcc\f\xff\x90\xbc\x00BN\xb9\x13\x10h\xea\x06\x8b\xba!s\x9e\xe8\xd1\xed7p#y\xb0\xd3\xce\u007fb\xd0\x13<N\xd3R\xa1\r\x94&{߲( Y\xdaG\xf1\xbd\x053\xe4\x1dFk\xb1h\x1a\x8eԜ\x17\xfe\x01\u05fb-v\xfb\x1d\x04\x04ʪ\xc2z\xb1\xa4ɔw\xc7RR\xc1\n\x8c8P\x14\xac\x84\x94\x93E\xead\xc7;\x85\x04\x8d\xceэˁ#.x\n\x921\x86=\xf7\xc5\xcc%\x11|\xbd\xa8)R\xcc\vH\xf2C\xad\x14F\xe7\xd1\rC\xf6Yx\xe3\xd4?+\x96\xe9\b\xd3J\xe7\xd0\x12r\x15\x8c(\xeb\x1a\xcbf\t\x93\v\xc1T\u07ba\x8eHxm\xbb\x80\xd4d$s\xb4>\x81R\x1a\xaf^>ǋ\x97OiE`\xc7\xdc\xc8\xc4١\xe98\xe10fYSDU5hX\xef\x8e\x18Q\x16%\x99 \x85\x88\xddn\x8b\xa1\xef3\x9c,\x05\xb0\xefw\x88\xde'\\\x96\x8dx\x884I6\xcc%\x1e<\xfaNn\xa0B\x8c\xb8|\xfd\x02CO\xa4D\xef-\xda\xc5\x1a\xeb\xa3s\x84\xe0\xf0\xe2\xf9\xe7x\xf9\xfcsD\x04\x14e\x9d\xd7*\x10\xc0`'韟\x11\xc8\nޗk\xf9u\xc4e\x11Eբ\xac\x97d\\\xe4,\x96\x8b#\x94E\x85\xcd\xf6\nW\u05efQ\x955\xce\xce\x1e\x93K\xa0\xf7\xec\x1f\x01D\xa5\xe0ǁеn\x87\xe0\x1c\n\xad\xd045*\xb6\xa6&'G\x82\x85\x15[\xfaVu\xcd\xf1\xdc\"\xd7N)\xc8\x02XJ\x05\xad\r\x15\u007fn*\x93\xa4T|U6\xb9\x14\x80\xbf\x9b\x1fDF<\x87\x88\xfaݥ6\x1e\xc0\xedB\n2\xbeJOtP\xf0\x03n\x89\vg\xe1\x81BČ\x9ee\x16\x00\xffw\xe6*\"~9\x1cq\xd7\xe6\x01s\xd2\xe3\xd7C\x01\x94T\x10\xc2Ak\x83Eݠ,\n|\xf0\xf8\x1d,\xeb\x16۾\xc3\xe8\xc8ַ\xe4(\xf2H0\"Y[\v\x89\xde\x0e(bDUx\b\x05@(\xbeƂ\xcf\x1c\t\x01q\x92\xec\xd8\xef9\x00\xf7\x8fo\xf4HNa}\xdf'\x87\xa9[\xd3\u007f\xb6\xc8\x14\x82\xdc\xcb\xce\x1e\xfe\xbe\x94Jm\xaf/\xb1\xdb]\xd3nWJ\xd6s#\x17\x80\xb4\xd3J\xe14\x91\xc9zyW\x8d)\xe26\x02(\xab\x12m\xd3\xf2\xf3Dt\xfb-Y\v\v\x01\x17\"\x17\xff\xd9\xf3\xf2\xae\xb40\x05LQ\xf2\xa4O\xb0p\xd7w\xf0\xdeS\xd1\xd4d\xac\xa2\x95\xc6\xe9\xd11\x8e\x96k*\xfe\x00\x86a\xc0\xf3\x97/\xf0ٳ'\xb8\xbc\xbe\xa6\x1c\xf4v\x81\x93\xf5\x11\xb42S\xf1\xe7\x88XϜ\x05\xc1]\xbe\x14\xd3\x1a\xd1y\x92\x19\xfa\x19٬g\xf9\x99\x84\xc8Ѯ!\x10\x12P0AK\xcc\xdc\xcb\xe2,C<\x13\nS\xf1\xd7\n\xd69t\xfc^}i\xf1\x17\x98\x11\xe6h\u007f\\\x15%\x94\"\xd9\xe2h-\nc\xb0l\x97(\x8cơȋ\xe2K\xad\x1bP\x945ʊ<\xe2\xdbv\x81\xa6\xae1\f=\xbe\xf8\xe2\x13X\x86\xcb} y]\xb81\xadY\x0eTJ\av\nr*\xab\x1a\x80Ȓ\xbf\xe8ə\xb1\xdb\xef\x0f\xb2\f\x9c\xb7\x18\x86\xee \xaf]\x00\xd90)\x04\xda\xe9\xafV'\xec\x19A\xee~\xaf_?cO\n\vST8:>G\b\x1eϟ}\x8e\xe7O?\x83\xb3#\x8a\xa2\xe2\xa61\xc5*\x03{\x9e\xfe}\x9c\\\xff\xb4\x940L\xfc\xbb\x1d\xbf*\xde:-˒\x88}\xa3\xed!\xa5\xc0r}\x02\b\x81\x8b\xcbW\xe8\xfb=\x8e֧899'\x13\xac\xb1\x87\x1d\a\n\x8fr\x16C\xbf\xc78v\x18\xbb=\x10\"\xaa\xb2BS\xd7(˒\x8c\xb8x\x1d\xa5x\xbaׅA]W\x90J\x1c\xb8m\x93\x05\xb0\x86.\fti\xb2\xe9R\xb2\xfb\x9d\xdbu\u007f\x19\xf2.\xa5\xa4\x1c\x81\x9b\xa8\xc0\x9cH\x98\xdc*o\x12I\xe2\x14\x90x+\xa9ZN\xf1\xe0\a\xfb\xfc\x9c\x18y\x97w\xff\xf4\xbf\x93\x83a<XwQc.\xee|\xaf\xe2W(\xeb\xdfTf/\x98\xb8\xaa9\x1f\xa0\xae\xc8*\xfc\xd1\xd9YNI\x1d\xec\x80\x19\x938\xafl$\xaf\xb5v\xfd\x96\xbcQ\x92\n\x85\x15U\t\xf1\t\x88'\x85\xb9G\x00\xee\x1b\x80o\xf1\xc87\xef[v\x812Y\xf8\n\xa0j\xda\xc5\xfa\xe8\xf4\xf7\xdc8`s\xf9\x8a'\xbf\xd4\xf1\x8a\x03v<\x91\xaf\xfc\x01\xc4\x1fR\xb7\v\x82\x1f#C\xadeQ`\xd9.a\xb4\x86\x94\x02}O\xe6/\x80\x80\v\xc4\xf6\xcf&A@\xf6\r0J\xa3,Krǒ\x12Fi\xf4cG\x1a~S\x90\xa4MQPϲ]@k\xd2\xe3:\xe7\xf0\xfa\xea\x12\x9f?{\x8a\xab\xcd\x06\"\n4e\x89\xf5b\x89U\xbb \x83!\xef\xf2\x11\xe1\xbcCHf'\x82v\xbb\x82-X\x02\u007f\xdd:\xcb\xfb\xbb\b\x81\x88\xd1Y\x9a~\x81\\8<\xdb|\x96<M\xcf'#R7\x80\v\xe9d\x10d\x8c\x81\x96\x12\xa3u\xd8\xf6\xfd\x94\x17\xfe\x15&\u007f\xc1͋V\x12\x15\a\x95\x04~\xad\x851X/\xd7d\xees\x83\x95=\xba\x11\xdd\xd0AJ\x85\xa6i\xa1\x8cAQ\x14X.W\x80\x10x\xf1\xe2\x19./^\x81\x02\x81\x86\x19Z;\xcdO.\xadB\x98\xb8\t\x04\x18m\xd0T-\x8a\xa2\x80\x10$9L\xe9\x8b}߱\xb3\xe3t\xb0w\xdd\x0eb\xe6wN\xd7R\xc0(\x89\x10I\xf1\xf1\xf0\xd1\xfb\x19ƖRc\xb7\xbd&b*HRu|r\x0e\xa5\x14^\xbd|\x82\x97\xcf>g\x1b`\x89\xb2n\xa8\x91\x1c\aHIR\xcda\xb4\xb74\xed\x86\x1b=#\xef\xf2\x89\x8fo,\x1c^HHc\x10\x83\x87\x1d\a\xd4U\x8b\xa6Y`\x1c\a\\^\xbe\x84\x10\x02\x8f\x1e~\au\xb3@\xf0\x0e]\xb7\x83s\xa4>\xb0Èa\xbf\xc1\xd8\x0f\xb0=\x99 \xb5M\x83\xca\x14\xe4\xf0\xc7E@\n*\xfe2\x05\xd1\x18\xcdk\x1c\x96n\xea\x99\xfc\x8f\xbf\x96\xa7c1\x15\xect\x9f~\x95\n(\xb3\xbcwV\x96\xef\x82\xe2oD\a\xc7/\xa9\xb4b\x16\x16\x96\x1aƈ\xb7\xa5\t&\xd8\xe1\xc6K\x9d)\x02\xc4W\x88\x13\x8ew\xb6\x05\xf1\xb0\xcb\xf8\xba\xe7*DVY\x94\x85\xc1\xb2iP\x15%>x\xf4\x0e$\x1bn'\xb7̄Z\xcd\a/\xa3\f\xf6\xdd\x0e\x83\xed\xe9\xfd\xc9aZ\xe9eI\xc4\x18O\x8c\xbe\xe7\x00\xdc7\x00ߪ\x03\xf8\xf2>W̊\xc9\xfa\xe8\xf4\x1f\xd7\xcdb\xbd\xdbop}}\x01\":\xd1\xfe1p\xc1\x92\a\xd3l8d\xd0\xf3s\x12'\x80\xd6\x01\x94\xee\xb7\xe2\xa9\\\x12\xa7`\xd8\xe7\xef\x1b\xac;@\r\x1c\xfb\x06H)\xd1\xd4\rejsP\xd00\xf6pΑ\x1d\xa71\xa8ʒ\xf6\xfcZ#\n\"\xd5mw[<{\xf5\x02//_\xc3\x05\x0fc\f\x9a\xba\xc2z\xb5B\xdb4\x88\x10\a\x1e۞mx\xe3\xec\x00\xccg'(\xa0\xc3Y\x9b\x0f\xd0dlԏ#|\x88PR@i\x95\x91\x10\x9a\xe65\xe6\x01*1\xbb\x80\x89\xfc\xbb\xd2j\x83\x0e\xed\xc1\x91\x19\x11e\a\x88\xb7\x16\xffCW8\x01\xa3\x14\n]\xc0(\xcd\xca\x04\a\xa3\v\x1c\xaf\x8eє\x15$\x0e\x1d\vC\xf0\xe8\x87=\xc3\xf3\x15\xaa\xaa\x85\xd6\x05\x96\xcb5\xb4)\xb0\xdbm\xf1\xf4\xc9'\b\x91\xe3\x92\xc3\x14\xf4\x93\x9e#\x02\xb0\x8el\x91\xe7\xc7jYU\xa8\xeb\x16J*&\x03jxo1\xf4\x14\xf5\xeb\xbdϞ\xe8v\xec\xe1쐛\xa7\xf4\x1c\x86w\xff\x88\x01M\xb3\xc4\xf2\xe8\x14\x9e\xed\x9e\x05\"./^\xc0[j\xde\xda\xc5\x1aEQ\xe1\xea\xf2\x15\x9e?\xfd<\aOU\xed\x02Rj\xb8q@\xf0\x8e`WF3\x02f\xd1Ӡ¯\xb8\x89\"\xd2g\xfc\xd2\xfb%\xc4\x00\x17\x05\nS\xc0{\v\xef=\xda\xc5\x11\x8c)\xb1\xdd]a\xbb\xbdB\xdb,\xf1\xe8\xe1wH\xf7=\x8e\xe8\xf6;\xd8q\xa0\xe9\u007f\xdcc\xbf\xdfb\xe0\bXc\f\x16m\vc\f\n\xa5\t\xd6\x17\x140d\x98\xf1_\x96evU\x14\x82\xee\xcbvѢ\xacK\"\xff\xcdʝ\x888\bȉ!R6\x85\xf7_\xe9̠4\xc1\xa4\x008\xe4\fŐ\xcd\x01rVE\xb2\xba\xbde\x17|\xb3\t`\xebbL\x1b\xb17\x8f\xe2qb\x1bN\xf7\x04ݔ\"~\xd55\xcd]O\u007f\xe8\xc0'\x04\xf0M\"\x82\x94R\x80d5@ݠ*\v\xbc\xf3\xe0!\x9a\xba\x06\x92\xf1U\xbew\xc2t\xdfF\xc0\x14%\x9c\xb7\xe8914\xce`\x93\x98S)\xc3\xf9\xd7\xe1o\xdc7\x00\xf7\x8f\xc3\xc9\u007f\xde\xf5\xbe\xa1\xd3\x15b\x9a%\xa5T\xea\xe4\xe4\xfc\x0f\xa4\x94\xb8|\xf5\fC\xbfό\xfb\x14\xed:\xc9YBN܋!fR\x15A\xe3\x13\x0f@J\xc5\x104ٖ:\xd7c`\xb3\x97\x10=\x06벗|\xe4\xbf\xeb\x1c\xa9\x01\x16͂5\xc9\x01\x85\xd1\x18m\x87\xd1ZheP\x96\x05\xea\xaaB\xc1{\xfe\x10\x02\xfa\xae\xc3\xf5\xe6\n\xaf\xaf\xae\xb0\xefz\b\x10\t\xaf\xadk,\x17K\x14\xa6dO\x01\x9f7\x87\xde\xf9\x99\xc5)A\xf7\xe9\xb0!$\xc2\xc2[wp\xb0\x85\xe0я\x16\xd6{\xb2\xdbU\x1a\x9eu\xbdJJT\xb3\xe8\xe1\xc4gPRQ\xbe{ u@&5\x82\x9c\xf7\xf6=\x1b\xfc\b\xf1\xd6\xd3kJq\x13l\x92c`L\x91\xd3\xfb\xacw(\n\x83\xe3\xf5\x11ڦ=\xbcu8\x10\xa9\x1b(?@k\x83\xba^@\x17%\x16\x8b\x05\x9a\xb6\x85\xf7\x0eO\x9e|\x8a\xfdn\xcb\xde\v\xe3\xec<\x9e\x8e[\xef=\x85\x10\xe5\x82B$\xa6\xa6nQU5\x84\x14\x14N#\x80\xb1\xef\xd1\xf7=\x86\xa1?\x90C\xf5C\xc7k\x96\x99\x12E\b\x18\xa9s\x01X\x1d\x9dA\xf1\x1e\xd4\x14%\xc6q\xc0\xf6\xfa\x02\x11\x11EY\xa3\xaa[\x8c\xe3\x80\x17\xcf>\xa3\xc8\xdf\x18\xa0\x8dAY\xd6\b\xc1\xa3\xebv9\x80\xa5\x1f\a6\xb6\x99>oZ\xca\\\xfcŗL\x94\xf3\xeb\xd8Y\vU\x14\xd0J\xc2\xd9\x01B\b,\x16k\xc4\x10p\xc9\xc8\xd9\xf9\xe9c\xac\xd7'\x00\"\x86~O\xff\f\xb4\x06\xe8\xf6[\xec\xf7\x1b\xec\xb7\xd7\x18\xf7{4u\x8d\xaa\xaa`4\xc9\xf8\xa8\xc1 \u009d\xe0\x14\xba\xaa*\xa7UI\x04\x9a\xb6\xc1\xf2x\r\xa5\xf5\x14\xaa5'Չ\x1b\xa3:\x13X\xbdMh\xdb\xe4gq\xf3\xec\x10\x04-\xf1\xbd 9C\xe2\xf0\xda\xe5[b\xe6\v\xf1֊,f|#1\xb3\xe4\x15o\x96)\xde,\xdc1\x1eB\x01\xe2+\x82\xfa\xf1K[\x81oP\x94\x84\x84\x96\x9am\x9b+\xb4u\x8d\xe3\xd5\n\xe7Gǈ\x91\x06\x87q\x1c\xb2J!\xceP\xb8B\xd3\x19\xd1\r\xfbL\x06\xce\\\x86\xf4KF\x9c\xc8{'\xc0\xfb\x06\xe0[=\xbe\x8c!˺\u007f!\x80\xa6]\xfc\xe8\xf8\xf8\xec\xd7\xfa\xfd\x16/\x9f\u007f1\xed\x04\x05\xed\xac&\xe0\x91\x98\xdb1\xc5\xd62\xeb?\xf1\x03B\"\x1e\t\x81u\xbb\xcc\xe9r\xde;\fݎ\x19\xf0\x11\xfd\xe8\xa6HY\xa4=;\x1dN\x8b\xa6\x85\xd4\n\xce\x11\xac\xec܈q\xb4T\xb4\xaa\nuYs\f0\xe5\xbd\xef\xf6[l\xf6[\\mw\xec8'P\x14\x06mS\x93\x8dn&\xfbM\xb9\xe6\xde92\xe6ab\x92\xe45G\xfa=,\xe7sg\xc9\x1b\x13\x1e-\xbb\xda!\xb2qR\x04\x9b\x14\t\x94\x059\xb4ř\x82@\xb1\x04\xc8\x05\xc7f<T\xbc#\"z\xdbc7\f9.\xf4N\x14\xf4\xe6\x9f1\xf1\xab\xd0\x05!\b\x92\x1a\xb2\xe0\x1dJS\xe0h\xb9F[\xb7\xd9\xd9-\xadIC$Xrt\x03\x05ޔ\r\xed\xff\xcb\n\xeb\xd5\x11\xa4T\xb8\xbax\x8dW/\x12\xf1o\xe0f\xe9\xb6\x1f\xc2\xe0ܴ\xfbG2K\xa2(a\xa3\vJ\xa8S\x06\xce9\xf4\x03M\xff\x13ْL\u007fb\xf0\xd0Y\xab\x0e\x96M2\xef\x02d\xbe\xb4Z\x9f\x12RQ\xd6PJa\xb7\xbdB\xdf\xed\xe8g5\v\xc4\x18q}}A\xeb$V=\x94uKi\x86\xdd\x0e\xde9H\xa5a\xbd\x83\xb5D\xc0S\x02\x1c\x90\x84\xcc\xfc7b\xb6\xf7~[\x00\x90\xa0\xcfh\xef\x1d\x9a\xba!\"\xe88\x92?|Q\x11\xd9\xf4\xfa\x02EQ\xe1ѣ\xef\xc0\x94\x15\xbc\xf7\xe8\xfb=\xec0`\x1cz\xf4\xdd\x0e\xbb\xcbW\xd8o.\xb1\xbd\xbe\x84\x88\x01\x8b\xb6E\xc1n\x8d\xd69tÀ~\x1c1\xd8\x11\xfd8fi_B\x1f\x8a\xb2\xc4\xfa\xf4\x04\xba(\x98\b\xe9ɯ\x1f\x872\xc5x\x03\xf5\x8e\xa9\t\x18-\x02gjL\xd3\xf6\xd4\fй0\x15\xea\x10Ƚ0\xfax\xa8i\x8fw#볟~\xf7Yą\xff\xed\xc1|\xe2`\v@\x93\xf1Mu\xc0\xd7/\xe1\xf3\x18Ἒ\x10\xf8r\xef\x87;\x1eZ\xf1z\xc6\x18,\x9b\x05\x16u\x8dw\xce\x1fd\x12.\xad\x01\x98\x18\xc1\xde\x16\x91\x87\x06\xad\f\xfa\xa1\xe3\x1c\x01\xe2K\x89Y\xe3\x16c<\x91\xe2\xbe\xf4\xdd7\x00ߪ\xfeǷv\xd7r֕\x9f\x9f=\xfc'e\xd5\xe0ٓO\xd0w\xbb\x1c*\x92`\xfeԱ'\xabߤ\xee\t\a\xd3q\xc8\a\xfd\xb2Y\xa0\xae\x1a\x9a\xf0B \xadw$\x12\xdc`\xed\xb4\xf7\xe2\x83ó\xc1N\xcdQ\xb7\xe38Bkn\x1c8\xc8g\xc1\x12B\xcd\xf1\xb2֍\xd8\xefw\xd8u{l\xf7\xc4\rPJ\xa14\x05ںFUT\aZrp\xb8P\x9a\\\xa5P\xd3\xd43\xbbf\xd6Y\x92\xea\xa4_2A\x9d!b\x18Y\x05 E6\xa5A\x8c(\x8cA!u\xbeV\x89\xb9\x1d\x01&bM|\v\x1f\"\xbaa\xc0~\x18\xa7&\xe4KF\x93\xf4-R\x8a\x83}0B\x00b\xe0@%rV\x943ρ\xd4\\\x8d\x96\x92\xf8\x12\xb3\xbe\xac\x1ahc\xb0X,QV5Ʊ\xc7ӧ\x9f\xc1ځ\xed\x9b]~!s\xf2\x9f\x0f\x81\xf8\x107\xa2J\xab\xaaB]\xd5S\xc41[;\x0f}O\\\x92DG\t\x14\x98B\x92;\x95%\x16R\xf0J$=_\xbdDQ\xd5d,T\xd5\b!\xe0\xfa\xea5b\f\xa8\xea\x96`\xfdn\x8f\xcd\xd5\x05\xbd7\xdeC\x9b\x12\xa6(\xe1\xbdC\xb7\xdf\xe5\xa8\xe3qdY\xa7`\xd6<\x04\f\x135\x95\x10\xec\xfc\xf7\x86\x90\x18q\xb8\xb7\xde\x0e=\x94֨\xaa\nޓ\x94O\x9b\x02RJ\xec\xf6\x1bt\xdd\x0e\xeb\xf5\tNN\x1eB\t\t;\x8e\x18\x87\x01!\x04\fC\x8f\xed\xf5\x15^\xbfz\x86\xed\xf5\x05lߡ(\n,\x9a\x16Z)\xf8\xe0Џ=Fg\xe1c\x80\v\x1e\xa3\x1b\xe1RT4(\xe1\xf2\xe8\xc1\x19L=y:\x80y/!\xd9Pgy܌\x90˄S\xf0\xbd\xebG\a;X\xf8\x19\xf9v\xee+!\xa5\x04$\xa1dvp\xf4}\xb3\bۙ\x0e\xf8\xc0\xe0&\x1e\xa8\xff\xef(\xfes{\\\xbc\xc1t)\x1e`^3d\xe3\xa6\xe6?\xde\xda\xef\xe7^\xe6m}\x85\xb8\xdd\x10\xccW\xa1_\xb90I\x99\xfd\x17\x96m\x8b\xb6n\xf0\xe8\xec\fEQd\x94\x8b\x14?\xc8k@\xc1\x83Aa\n\x8cn\xc8k\xb4\xc0\xb9\x011\x8aħ*\x84\x90\x8b\xfb*v\xdf\x00|\v\xf8\x1f\xb8\xa5뽣\xcbn\xea毝=|\xe7?\xdcl.\xf1\xec\xc9'DBR\x1a\x02\x92\xa7S\x99S\xd0B`\xd9W\xd2\xfb'8\x9d\x9d\xf0b\x8c\xa8\xab\x06m\xd3\xd2\x1e1\x06\xf4\xfd6k\xeb\xfb\xd1\xf2^\x9fF\xd3\xf4w\x9dw(t\x89\xa6j0Z˾\xf9\x01\xc3\xd8\xc3h\x8d\xa3\xd5\x1au]'\x86,e\xc8\xef\xb7\xd8u\x1dv\x1d\xed\xcf)\xc6נ\xadkZ;(E~\xfcq\xca\xe5N,t\x99I_\"\xff~\x81\xbd\x02\\\x8e-fS#\x96\xedYO\xb2?\xf0\xee\xdd\xf9\xc0<\x00\x955\xff\xc9\xd7[\x80\xd2\xfe|$>D\xd2X\x87\x10я\x03\x06k\xe7G\xdc\xe1p\x84à\x161\xdb\xe4\x94L\x14K\x96\xa2\x02\xa0\xe8⺡\xe2/\xe4\xc1\xfb\x1d\x10\xe1\x82\xc38Ҿ1E\x8fR\xa2\\\x81\xc5b\t\x01\x81\x8b\u05ef\x98\xf8'0\xba1\x1f\xea\xf3\xe2\x1f\xd8\xd6\xd7y\u007f0\xe3I)QU-\x8a\xa2ʪ\x12\xe7\x1d\xa7#v9\x03=1\xff#۠\x12\xf8B\xe4K#\x144_#\xa9$\xaaf\x81\xdd\xf6\nΑ\x91\xd08\xf4\xd8m\xafP\x14\x15\xb4ְ\xe3\x80\xed\xe6\x12]\xbf'\x12gn\f\x04\xfa\xfd\x9e\f\x88\x8c\x81\x8f\x11\xd6\x0e\x90\x02\xd0bʺ(\xa4$\xf7\xbf\x03\xe7\xbf\xf8\xc6\xe2/\x85\xa0\xa9\xdc\xd9,3\xf5n\x84s#\xb46\x881b\xbb\xbd\x06\x00\x9c\x9f=FS/\xd8'\xa2\x87\xb3cn\x90w\xdbK\xec6\x17\xb0\xe3\b\x05\x81E\x93\xb20\x02gI\xdc\xe1\x18\x19#F;\xc2\x14\x05N\x1f>@\xb5\xa8oA\xf7\xd1{\xf8qDH\x84Z\xbe\xae\xb9)OI\x93\xb3\xc6 \x86\b\xdb[\x8c\xfb\x01n\xb4\xf0\xa3\xe7\u007f\x1c\xdc\xe00\xee-\xc6\xce\"\xb8\x90\x11\x848\xb3\x81\x8e!f\x89_\xf8\x92U@B\no]g\x81\xbb\x18~\xb7\xfe.f?\xfb\xf67Eܱ\xcdx;2\x9a\xa2J\x85\xf8V\xab\x80d\xd0Ԕ%VM\x8b\xf3\xe3c\xac\xdb%5\xddn\xc0\xe8Ǽ\xa6̪\x1d!P\x1a\n0\x1b\xd85pj\xae\x90\xcdӄ\x10\xe7\xf7\x15\xed\xde\a\xe0[\xaf\x00\xc4܈\x06\xd3\xd4\x1f\xd9^\xf7\xe8\xf8\xe4\x9f4\xcd\x02\x1f\xfd\xfc\x8faǁ\xd8\xf5Z\xe7`\x15)\x14 (\xe27\x04\xf2NO\xce~`\xe7\xbbd\x93[\x96%\x96\x8b%3遡\xdf3\x89\x0e\xe8G\x97\x8b\u007ḟ\x1b\x15e\xad4\x96\x8b%'\xb4E\xc8\bʤ\xd7\x06\xc7\xebc2\xb7\x01)\x04\x86\xbeC?\xf6\xd8\xf7cv\xca3J\xa3(\f\xea\xb2dG;\x95\x9dͤT\xac\xefO\x89\\\x92\xfa\xca\xd9\xcd\xefC\x80\xb5\xa4\xbf\x8f\xb3,\x838{\xbd\x83\xb5\x88\b\xecq\x0f\x96\x00\x92\xf9\x0e]#\xea\xeeӁ\x98\xcf+1\x19\"\x8d\xd6\xc2%\x83\x1f\xbe\xd9s+\"\xee\xc0\xfbg\x8d]\xa1\v\x94E\xe2S\x90\xfb^\xc5aOMU\xe7$@\x91'w\xfa\x99\x03\xef\xfd\x85\x00ʒdzJ\x1br\a,+\fC\x8f\x97ϟ x\a\x17S\xaa\xde!\xf3?\xb04s\xf4\x13z\x83\x19\xfc_\xd7\r\f;\x97\xf5}\x8f\xe8-\xban7\xb3\xa0\x16\xd9\x04E\t\x01-\x04\x19\bqsVhM\x9e\xf71\xa2,j\x98\xa2\xc0\xd0\xef\x89\xe0&e\xb6#֦`\"c\x87n\xbf\x83\x1bGX7\xa2,k\xe8\xc2\xc0;\x87n\u007f\rm\n\b\xa90\x8e\x03\x9cs\x99k !\xe0A\xe6?J\x8a\xbc\xa2y\xdbM$@\xfe\x0e۱\x83R\x1auYC\t\xe2ohSr\xc0\x8b\xc3~\u007f\x8d\xa6np~\xfa\x18\x851\x84\x10\x8c\x03_O2e\x1a\xba\x1d\xbcw0R\x91Rc\xb5\x84\xd6\n\xce{\x9e\x0e\xa9a\x8c\x88\x88\xec\xcc\xc9x3N\x1f?D\xbdh\x91,*\xc9Anr\x82HY\f\xc1yH%\x10y\xe2\xcc\xfa\xfd\x18\xa753\x17\xd5T\xc8]\x1fآ6 X\xc7.CS\x06\xc6\xe4\xfd\x15\xa6'\f`\xfbk\xcc\xf5z\xb7j|\xbc{\xb8? \xe3\xc77\x1c^t\x1f\x85\xd9\x1fM\x96\xe2\xf1\xab\xec9ﺝ\x0e\xfa\xbdx\xd0\xef}]_\x00\xc9FW\x8568Z.q\xb2Z\xe3\xfc\xe4\x18\xcf^\xbf\xe0\b\xf1\x81\x92NS\xf3\xc3+ǂM~\xfa\xb1\xcb+\x80(\x03\x0fZ!\xa1\x96\xe7\x00>\xbco\x00\xee\x1f\xdfb\x05p7\xae\x9c\xbaѲ\xaa\x1e\x9d\x9c>\xf8\x87\xdb\xcd%\xae._B)\x03ST41\xc4\b\xc9&\x15\x91a֤\xf7\xf7\xb3q \xf0\xee_)\x85\xe5b\x95mL-k\x9f#\x80\u07ba\x03\xf6}*P!P@Ƣ]\x92F\x9b\x15\x00\xc3\xd0AK\x8d\x93\xf51\nS  `\x1cF\x0e\xde\x19\xb1\x1f\b>\x13\x9c\x89^\x99\x12UQ\x10\xd3X\x88<\x15H)\x0f\xcc}\xe8`\x93\x98;\xfc\x04O\xfeݤw\x9fO,17K\xa3\xb5\x1clD3\xb9gm\xb9R\xb4\xef\x96iE\x92\xae|\xd6\xf9\x13\xe11F\x92\x0e\x12\x94:Y\xa5\xa6\x00 1\x8f;\xbd\xf1\xee\t\x00\xa51\xa8JÁ=dfӔ\x15\xe9ǫ:ۍf\xa4\x81\x89\x99\xd6[\xe6,DBXx\xfaWJ\xa1m\x97\x90R\xe2\xf5\xab\x17\xb8\xba\xbeD\x14sӟy\xf1't\x87\x88\u007f\x81\xa3M\xa7\x871%\xea\xaa\xe1\xa61\xe0\xea\xea\x15\xb1݇\x1e\x9e\xa1M!8J8\x04\x98d\xfa\x13=\xc9٤\x82\xd62\xbbM\xd6͂d\x9f\xa6Bۮ\xe0\xbd\xc3~\xbf\xe1\xebN1\xbav\x1c\xd0\xf7;\"\xff\x85Hn{\x00z.\xb0e\xd9\xd0\xe4<2\xc7b\xd6dE\b\x14\xb3$\xbd;\xa5\xb2B̆҈\xdd\xd0Å\x80\xb6,\xf3\xe1=\x0e=\xdav\r!%\xecH\xae~\xc7GgX.\x8f \xf8}rØWP\xceZ\xd8a\xcf\xea\x03\x85\xa6\xaa\xb1l[\x8a2\x0eܤD\x95\xa7\xf3\x18cv\xf8;\u007f\xf8\x10\xedr\x81D\xb8\x84RLZe\xed\xbe\x9c7\xb4\x87\xee{\xe93&\xd3'.YvϿO\b \x102\x16\x84@p\x0eB\xcbl\xbf,f\x9f\x87H\x18\xf5\xc4\x01\xf2\xac\xf5\x97\xb7\xb7(\xf1\xa6s\xd0]NB)\xdd/\x88\x83\x11=\xcc\x02\x88\x10\xe2\x81\a\xc9\xd4\xc8|\x8dݿ8\xec\x01H\xb2,n!\x0f_\xd7\"8\xf9],\x9b\x05N\x96k\xbcsv\x8e?\xf9\xf0\x17lB\xd6\x03\xed*\xff\f)H\x85T\xb0Tx\xd7mh\xdd(\x04dP\x19\xb5c\xb4\xe4侊\xdd7\x00\xdf\x18\xfeOЙf\xb9Ẏ1홴VX.\x97\xbf_7\x8b\xe2\xf9\x17\x9f \xc6H\xbab\xa5`G\x97\xe1l\"\x1a9\x1e\x1ab\x9e,ir\x8e9\x05o\xb1\\\xa2\xe0\x00\x8b\xe0F\x8c\x03u\xb7\xa3\v\f\x1b\xe3\x00\xd6L\x8exMM\a~?\x0e\x1c\xdeC\x8c\xf1\x93\xa3c\xb6\xfd\r\xe8\xfa\x1ec\xdfc\xf4\x16\xfb\xbe\x87\xf3\xec\r\xa05ʢ@i\f\a\x1a\xcd`s\x86\u07b2\x84/ҁ6g\x9e{\xefa\xc7\xfe\xa09\x99O\xfe\xe4\xe1\x1e3ҠxJ\rlLSh3S\x0f\b\xb6\x04V\f\x01Zf\xf92\xbc;\xbb\x06\xd9S\xe1\xce\xe2?\x8d,\x04\x17\x1aTE\x01)\xa8\xa8\x04D4e\xcdֱ\xf5\x01\xb4\x98\xdf\x1f^7\x8cC\x8f\xc8)duբ(+HN\x05l\xea\x06\xceZ\\\xbcz\x06ϼ\x87Iן\x1a=zoG\xef\xe0\x82\xcf+\x94\xf9\xf1X\x95\x15\xaa\x92\xd63Cϓ\xb9\x1b\xf9sA\x8e\u007f\t%\x12\x91\xa4\x931x\x80W\x18\x86c\xa8c\x8c0EE\x0e~\x10h\xda\x05\x8a\xaa&\xe6\xfcn\x83q\x188U-\xb2\xaf\xc0\x88\xd1\x0e\xa8\x9b\x05\xb46\xf0\xceb\xbf\xdb@\xeb\x82\xc8\u007fΒ\xf7\x00\x0e\x1d\xfe\x8c\x14y\x1d \x81\xbb\xb1\xe3Ybb\xef,:;BI\x89\xb2(\xf3t?\u0601]\xf6$\a\xc0\x00\xa7'\x8fP\x96uF;(3\x82WH\xb6\x83\xb3\x031ȅ\xc4j\xb1\x801\x06\xc3H\u007f7H\x019\xb5\x90\xd9\xeci\xb1\\\xe2\xe4\xec47\x99RRS ٝS\xa8\x89M/\xc5a\x9d\x9d\xb0\xf3ÄC!%K\x1egӴ\x04\x10%\x94\x96\b^ \xb2\xa7\xc2AB`zm\xf1p\xa8\x0e!\x90DX\xdeX\xa7\xc4[\x97t\xf6\xc7q\x0e\x05\x1e~%\xa4\xe6a\x92\x19\xdfL\r\x16\x1cB\xfeV\xed!n\xfagMM\xba\xc8\t\x16S\xd1\xff&)\x81R\x90\"\xa7*J\x9c\xacWx\xf7\xec\x01\x16U\x83M\xb7\xc5\xe8z\xf8@\xf1\xc0\xf3FT+\x03\xa3\f}F\xdd\b-\x15yKH\x95\r\xd4\x10\xefk\xda_P\x0e\x80\xf8F\xacԛ\x8fq\x18\xe0\x9cEݴ(\x8a\xf2\xe0\x83-\x00TUY/WG\xbfw\xf9\xea96\xd7\x17Pʠ(*\xda\xf1\v@*\x86e\xb9\x88\x86d\xf5\xcb;\xca<\x01\x84\x88\xbaf\xbfy\x001\x90\xf3\x1bI\xc9(,\xe6\xe0l\rS\xf1/x\x17=ځca\x89`v\xb4:\xa2\xe2\x1fig\xde\x0f=zk\xb1\xed\xa8\xf8+)Q\x18\x82\xfcs\xf1\xcf\x12#b\xe2\x93\xfd\xaa\xcf\xdc\x04\xa9\x04'\xc3I P\xa8̘\x8a\u007f\x9c\xab\x18\xe2\x14\x85\n\xd0\xe4\x9b\x1b\x04\x91o\xe4Bi\xce(\x98\xe0O\xadH\xf2\x97\xe4=!\x84,\x99\x8b7 й\xf4L\xdcX\x9e&r`a\fJS0\xecO䰺\xa8P\x97D\xba\xcb\xf0\xac\x98`\xffd\xc1l\xed@;w\xd6\xfc\x97e\rcJ\x96\xec50Zc\xbb\xbd\xc2fsM\n\a&$\x81M\x9d\x02\x17\u007f\n\xfc\xb1̡\x98\x88`\x92\x8bQ\xc3\xc4\xcd\x18\"\xf6\xfb\r\a\xef \xebF\xb2\x05r$\xb2\x9f\xe0d\xbeDbK\x89\x85\x02\x02U\xddd\xbd\xfbbIꄾ\xdba\x1c:\x9a\xa4\x05\xbdo\xc3\xd0\xc1q@Qő\xd2Cב\xf7DY3\x025搦\xf9\xe7\xde\xf0\xaaD\u007f\x85{,Ā\xfd\xd8\xd3g\xd5P\xf0\x8e\xe4\xb8\xe9a\xd8c\xb0\x03F;\xa0\xef\xf7\xa8\xaa\x05\x8e\x8e\xceI\x1a\xea8(\xca\xd1\xda(\x04\x8f\xa1\xdfS\xe6\x83 \"\xe7j\xb1@\xe0\xa6*\x8a\x19_'\xad\x86\xf8ώ\x8e\x8fQWU\xf6P\x90\x92B\xb9\xa4\xa6\xc4E\x8e\xa89T\xb8\x8b)\xb4&\xe5|$\xf2\x9eH\\\b)!\xe6\xcdD\xfa>)\xd8\x110 z\x87\xe8,\x82\x1by\x05\xe82!1f)^\x04@\x19\x1a\u07b9\x8cxe\xbf\x00Ļ\xdd\b\xf3*\"\x1e\xa8A\x92\x94\xf8\x80\xbcx\x90\xea\x17\x0f\x9b\x88o>1MW\xed\x1b\xfa\x01\xa4W\xa0\xb4\x82\xd1\n\xebv\x89\xc7\xe7\xe78]\x1f\x91\xb2ɹ,\xa7\xa58oɼ\x19\x05cJxo\xf93\x02n\xaec6\x1c\x03pu_\xfe\xff\x026\x00\xe2[\xc4U\xce\x0f\xbaa\xe8\xb1\xdf\xefP\x14\x05\x9avq\xf05\xa5\x15\x16\x8b\xf6\x1f\x05g\xcf/^=\x83\x10@\xdd,\xa8S\xe5\xf8V\x95v\x88,\xf9\v1\xe4\x890Θ\xf1\x86-g\x93Ny\x1c\xf64Y{\x8f\xf1\xc6\xe4\x9fb[S>}U6\x944\a\x10\xbc\xeb-V\x8b%\x19j\x00\x18\xc7\x11\xfd\xbe\xc30\f\xd8s\x11PJ\xe6}\xbfцHwٸDe^A\xe0\xc3#\x06G\xce]\x92\xe1U\x04\xb6\xa1\xed\xb3M쁑\x89\x98\x94\x01\x8e\vx\x8e\xf4\x98I\xfc\xcc\xcc\xf1O\x88\x99\xb6\xdf\xdb\xfc\xb3\t\x05\b\xb7\v\u007fF)\xeeڒ\x92W@i\n\xf6\x15 \x1f{\xef\x03\x9a\xb2BY\x94\xa8\xca*\x9b2\xa5\xe7\f\xb3\x9c\x01\xe7=F;\"p\xe6@]6(\x8a\x12BiHE\x0e\x80\xde9\\_^`\x1c\x06X?\x99\x1d\xa5|\x82\xc4^\x1er\xea^\xc8닲(P\x98\x82>[<\x81\x0f}\x87q\xe83!,\xd9\xf7\x12\"\x01 \x04hI\x84\xb5\xf4\xb3\xa4$\xbb\xdb\x18#ta\xa8\x98\v@\x1b\x83vy\x84\x18\x02v\xdbkj\x1e\x14\x11C\tn\x1faG\x8b\xa2\xac`LA\x13\xf9\xb8GYV0\xc6 \x04\x8fq\x1co\xddG2\x914\xf9\u007fߴ\"K\r\xc9ގ\x18\x9d\x85V\x8a\x82\x9645\x9b\xce[Xk\xd1\xedw\xd8n\xae0\x8e=\x8e\x8e\xce\xd04K\x92\xbd\x06\x87\xe0\\nR\xbc'\"&@\xfe\x03m]\xa3.\v\fv\x9cH\x9fB@@\xe6τ`_\xf8\xf5j\tct\x8e\xe6=4\xe8\xb9;\x16w2\xe8J\x1f\x90\x99\xd2A܈\xf9e\x04\x8b\x1a\x86\xd4إ\xb5~\xc8\xfe\xbe\xa4\x00\xe2\x86 Xʛ\x88\x9e\xde_O$C\xef=\xbc\xf5Ӻ0D\x9e\xee\xa9I@dU\\\x98\xe8\xfaqV\xe8cV'\xf2^<\x17\xffx\x87\xab\xdf\xdb}3\xbe\x921\x01\xb7O\xf3?\xbaˋ\xeb+\x8cl$O\xadj<<9ţ\xb3\x13&\x16\x87\x1c\xc4\x16\xa2\xcf\xeb\x16j\xeeK\"\xd6r\x18Z\x1a\xb4&\xa4\xf6\xbe\x01\xf8\v\xd7\x00\xbc\x91\x91\xfc\r\xba\x88\x18#6\x1b\xf2\xc0_\xaeV\xb9\xcb\x16\x10(\xcb\x12Z\xe9?\xe8v\x1b\x84\xe0\xd1.\x8f\xd0,V\xf0\x9c\xfa\xa6X\"\x17X\x9e\xe7Sq\ti?\x172\xb3u\xd1.H\x9a\aA{\u007f\xb6\xcd\x1d9\xdcgj\xdcc\xf6&\x17B\xa0\xae\x1a*\xd4\xfc\\\xce9\xd4U\x85\x057+\xde{t\xdd\x1e\xfb\xbe'\x1f\xf7\x10(\xf0\xa6,P\x17%\x8cR3\x9fq\x91'o\xe7\xc8]\xd0{\a\x1f\x1c\x9429*\x95\xecaG\x8cc\xcf7\x1b\x17\xffٙ2I\xe8\x04\x86\xd1\xc2\xf1\xce[JI\xc0\xa1\x100Z\x92\x8e=r7\xaf\r\x02\x80\x81\riRV\xc0M\xb9\x9c\x98[\xa0ݑ\x99\x9e\xfc\xc2ˢDi4\x93\xf8\xa8\xf8We\x05\xa3\vT\xf4\xde\xcd\"\xd5\x05<\x1f\xc4i\xb5\xe2\x1c\xed\xeb\x11\t\xa2/\xcb\x1aڔ\x80\xa04E\xa5\x14v\xfb\r\xae7\x97p\xde洿$yL\xef[Zc\x04VC\x10\x1f\xa1\xa4\xdcr\x10\xa9\xb0\xae[\x84\x10\xb0ﶴB\xe0\xcfH\xdeH\xa7\xfdm$\xd2d\xe2Z\xc4\b(5\xe5%\x94%\xb9\x12\"\x02uݠ\xae\x1a\x8cl\x9c#\xa5\x04b\x84\xb5\x0e\xd62r\x13\x03\xaa\x8a\x98\xffv$%\b\xed\xe7E~\xdd7\x1f\x89()\x0f\xa0\xea\xdb0\xb2\x10\x02\x83wر\x81\x91њ\xfc\r8\xc0ɦ\xf7VHX7B\n\x89\xe3\xe3\a0\x86\xf2܃\xf7\xb0v\xa4\xb5J\b\xb0#\xc1\xff\n\xa0\x86y\xb1\xe0k\xeag\x93\xb9\xc8\xc1=i\xb7_W\x15V\xcb\xe5A\xc1\u038d\xc0\f\xe2\x9eG<ϡ\xbe\xdcp\x87\xc9\xf8'7\x1b7\xcf\x1a1\x8b\xfa%\x0f\xa0)\x1d\x91:8n\x04\x1d\x82\xb7\xf4Op \x12@\xc8_\x8f\xc1\xc3;G\xea\f6\fC\x98\x15\xf4@\xdf\x13<]\xa7\xe0<!\r<\xf9\xe6f μ\nR\x03\xf1\xc6s\xf1\xab\xb8\x11\xbd\xa9\x11\x10\xf8\x92N\xe2+=\x8c&\"\xf2\xc9r\x8d\xf7\x1e<$W\xcb\btC\x9f\xd7rs\xb4.\xc5\xfd\x06\xe6ṷ\x92y\b\xbco\x00\xfe\"5\x00\xdf\x06\xf6\u007f\xd3\xdf\xed\xba=B\f(\x8b\x8a\x18\xcf1B(\x89\xb2,~7z\xff\xc3\xe0\x1c\x96\xab\x13\x9c\x9c>\x86\xb7#\x02O\xcbB\xf2\ue7e5I\x81\xad\u007f\x13,\x9a\x1a\x81\xa6&\"\x9ad\xe4\x80\fd\"\x06\xeb\xe1gL\xf8\x04\x87'r^U\xd6yR\x8al\xccSh\x8d\xd5r\x95\xcdtv\xfb=v\xfb\x1dz;\x00 \x9bݺ,9\xf4F\xf1Gc\x02Ͻ\xf7\xec\b\x17s\b\x8cdK\xdad\x822\x8e#\x11\x13Ôn7/\xa4b\xc6\x16w\xce\xe5\xf5\xc5<\xd3[\n\t\xad(rW*\x92\x01\xf9@R-Z\x95P$\xe8|\xe7\x8f\x1bf#\xe2\x8e\xc1\x8d\xbc\xf0)\xd4\xc7H\x8dȒ\xc1\x10i\xf27\xda0\xe3\xb88\x885MhLrN$\x04\x80\x9d\xf1\xb4FU\xd6\xd0EI\x04I\x90\xc5\xec\xd8\xf7\xb8\xbe\xbe\xa4\xa4H?N\xfbVL֥\x8ee\x8f\xd9\xcb\x00\x1c\xced\f\xafw\x1c\xdaf\x01\xa35\x86aτ\xbb\t\xf2M\x91\xb44\xfc{H\xc1\xe4\xa6\x18\xb2\xd2M3\xcb]j\x9du\xff\xc9\xe6W*\x85~\xbf\xe5\xa6T\xc0{\a\xc7Ik\xc38\x90unEAQ\xe30@+\x96~zO\xda\xfbx\xe8\x16'S\xa3(\xc0\xda\xff7\x15\u007fZ\x81\\w$\x9d\xa4\xe9_\xd1t\xacT\xce7\b\x01\x94\xf4\x17<\xeaf\x89\xe5r\r!d\xfe\xec\x8dC\x9fM\xb3\xec\xd8q\x1a\xa0DUT\xa8\xab\n6y-\b ;7\xf1\nKq\"\xe2\xd9\xf11\xca\xc2\xe4\xe8\xed\xaf\rm\xe7\xe5{\xbc\x03\x1d\xb8[\xaf\x9f\xaeJ6\xc7\x12)\x99\x8f\vv\xe0U@p\x88aD\f\x161\xbalx\x935\xf9! \xf8\xc0N\x99>'a\xce%\xc3i\xa8\x883\xa3\xa2\x18&4.\xde\xd8\x14\x1c\xbc\xd4x\xa7\x03э2>S\r\xccs fț\x10\t\x05\x10\xdfj\xf4\"\xbeN\x81\xb6i\xf0\xde\xf9#\xb4u\x8d(ȃú1\xa3b\ty2\xba$\x97P\x8eJ\xe7\x14\xc0\x19Rs\x8f\x00\x00\u007fAH\x80\xbf\x8a\x9d\xff\x9d;L\x9e\xc8dN\xa6\x03\x8c\xd1(\xb4\xfe\x03\x84\x00e\n<|\xe7\x03\xf4\xdd\x1en\xeci\xa2\xe5C9X\u007f\b\xf3ŉ \x17B@a\f\xdazAv\xc1|(*\xa9\xe1|<d\xfc\xa7\xe2\xcf7\xac)JhS\x10\xfb\x15\xc4\x0eWR`\xb5X\"E`v}\x87\xeb\xdd\x16\xa3%\xb9\x9b\xe1}\xbf\x96*\xf7\x84\x01\x91\x89LĬ\x8di\x87\xc6(\x83\xd2\xe4\xb8\x15h\xa9\xcdD\xb7qf\\\x14\xef\xb8\xfe\x82\x1d\xd7Ȱ(ݰ\x92\t\x85\xc9$E)\x8a_M*\x83\x91\x9d\x05c\x04\xfaa\xc8Ny\a\x93?\xee\x8eg\x88y:\xe5D?)\x11\"A\xd8\x11@S\xd50\x85\x81\x92\x02UQq\x828\x13\x97b\x84\x8b\xf3\xecqp\x140\x99\x15\x95E\x85\xa2\xa8\xa1\xd9~T\n\xfa\xfa\xf5\xf5\x05\xae\xaf/1ةQ\x89\xb3\x94\xbc\xf4\xfb'(\x96\xfc\x0e4J\xc3\x13\xb6\x1d!\xa4\xc0\xb2]\x92\xf1N\xb7\xa3\xeb\xcf\r\x80\xe0F\"\x80\xf8\x12\x91%\x93\xc1\xbb\x83i\\\xb1\a\u007fU6(\x8a\n\x01\x11Fi,\x96k\x04O\xa8B\xe4\xcf1M\xd34]\xc6\x18QV\xe4\b9ZJ\xd9+\x8b\n`{e\xcb2Ƀ\xb5W\xf2\x19\x98;\xff\xddy\xdc\v\x8au\xf5\x96\x9c\x02\xb5\xca{s\b\x89\x18\xa8\xc1\x10J\x91_F\x14X\xaf\x8e\xe9\xe7G\xc0;\x9b%\x801z\xceC\xd8\x03!@\xb2\x9b%\x19By\"\xd9\xc6y\x03\xca\x05II\xb4m\x83\a\xe7\x0f\x10,\x15Oq\x977\xfc\x01\x9a4˞\x17L\x9a\x8b\xf1K\xa6\xd9\xc8\xd6ܘ&u7\xa5b\"\xc6L\x16\x14)\xeeO`F\x9f\x03\xdfw\xa9\xdc&4#\xfd\xb7\x9c`\x85)\xd3\xf90C$\xf9\xdf߰\xf7\xbd-\xf9\x0f\x88Q\xbeŭ\xf1\xcdqN\x87\xe6\x81q\x06uL\x9c\x96t\x96d\x02f\xfc\xfà\xd1\x06m\xdd\xe0\x9d\xf3\axx|\x82\xab\xed\x0e>xtC\x8f\xd2T\xec\xc5A\x9f?\xcayP3\xb7Q15\xaa\x11\xa3\x80\xd8ޗ\xff\u007f\xc7\x11\x80\xbb\"zS\x97\xfem\x1es\xc9Nb\xfd\xa6ӥ\xa9\xca_\xd3R\xfc(Ā\xf3\x87\xef\xa1(k\f\xdd\x16ѻ\xdc\xf5\x83\xbb}\xcf]\xfc!\xf4O;\xea\xa6nQ\x98\x12\x02\x02\xe380\x99G!@N\xb72\x93Ȓ\xb1\x8e\x90\neQeR\x9c\xf7tP\xb7M\x8b\xb2\xac\x88\xbb0\x8e\xb8\xbc\xbe\"\xe6=C\xd6uU1\x81\xef\xd0\xf2<\x05ܤ\xc43\xcf\xff+\xa4\x82R\x86\x1b\x02\x82c-\xefē\xd1F*\xcc\xf3X\xdd\xf4N\x8c\xce\xc1\xb2\x0fB\x9a\b\xd3\xdf0:\x11\xf3h\xda\x1b\x19\xbe\x8clؒ\xc9to:\x97\xee@*\x95\xa4D?\xa5D.\xfe!F4U\x8d\xc2\x14\x10\x1c>\x93\x82\x86\x12\x14\x1cb\xe0\x83y:\xad\x9cw\bH\xeb\x12\xfa\xfbR)\xfe]%\xdc8\xa0\xebvdQk\x87ٔ8\xa5\x14\x0evd\t\x1fX\xa3\xce͇\x94\x8c\x8c\x8c\xa8\xaa\x06EYa\xe8\xfb\xcc\xfa\x9f\x0f\x9b\x9e-\xa4C\b\x901\x00\xd1O\xfbM\xa4\xd8_\x9a\xaa\xab\xba\xc90\u007fQ5(\xaa\x06v$N\x01\"Y\x13\xd3\x14I\x85JJ\x89\xbaj\xe8k\xc3H\x0e\u007fZ\xc1\xfb\x00\x9bÛ\xa6\x99Y\xce\xd1\x1d!\xdeXD\xa4\x90D6\x1d{2\x0f\xd2\x12ZkR2\xf0\xb8\xe8\x1c9\xf6iS@B\xa0(+4͊\x8d\xa7ȔȎ#\x9c\x1d)\xf0j\xe80\f\x1d\x84\x90(\x8c\x86)\xcc$\xc1\xcb\xd5i\xf6\x19\xe4i\xf0\xc1\xd9\x19\x16mK\x9fݡ\xe7b\x9bL\xb8\x0eY\xf69\xa4'I\xfc\xc2]Ļx댘\xb8z\x91\\\x02\xbdc\xd4\" 8\x97Î()sFB\x14r\xe2\x0e\xe4\x9aLk\x1e\xb0T38\x87\xe0,q\x06\xbc\x83\xb7\x16\xdeZ\xfes\x87\xe8\x1c\xf1A\xb2R'يM\xab\x8a\x1b}\n\x13\x8f\xa7\xd8\xe1C\f\xe0\xcbV\x00\xf1\x8eo9\xfcy\x93\x8a\x88w _s0\x13B\xa0.+<89\xc1\a\x8f\x1fC1A\xb8\x1f\xf63E\x12]?\xad4\x8c.f)\xa33\xc7@!\xef\xa7\xff\u007f\xd7\x1b\x80?\xaf\xa9\xff\x96'w\x8c,}\x8bh\xca\x12MU\xfe\x81\xf7^\xad\x8eNq\xfe\xe8=\f\\\b\xd2\xfeY\xe4ݿ\xcb{\xe5\x14֓\xa0\xbb\xaa(\xc8\x0f\x9d]ߜ\x1d\x10\"e\xc4{ރG\xdeqΓ䪲\xce\x19\xd8$\x05\x8bL$\xa3\xe7\xb2\xde\xe3\xe2\xea\x02\xdd@\xeemUQ\xa0.˃Ը\xf9\xc99g\xf9S\xf1'_\u007f\xcdq\x9d!D~}c\x86\x15CH7\xfc\x9426\x87L\x89\xbf0K)\x9c\x8d\"Fi\xd4e\xc5\xee^\x9eR\x00\xc3d\xf23Z\xfb\x86\xf5\xe2[\"\x99\x05Ž*\xa5\x98\xbdO\x1c\x8a\xb6\xaa\xc9\xdb \xc55\x9b*Ǫ\x92\xe4of\x1a\x93\b|LĒ\x90(\ri֕.&s \xef\xd1\xedw\x18\x86\x9eI\x90\x01\x87\xb6\xee\x11\xd6{Xn\xcc\xd2\xd7K\x8e\x9d\xa5\x04ǁ\x02iꖾ?\xadTҤ\x98\xa6\u007ffw\xd3J\x81\xf7\xbf\xb3\x82,y\xfa/\x8a\nEY\xe6\xcb\\5-\xc9\xef\xfa.\xdb\x12;\xf62H\x04Rï\xc7qs\x97\x94\x03\x9e\xe3\x90\xe7\x8ew\x99\xbf\x11\x91#\x94\xef~\x1f\x04\x06gq\xd5\xed\xf9s$Qj\x03%ҚIdR\xa4u\x1eF\x97\x10B\xa0mH\xfe*\"%E&\xf9\xa5s\x16\xde\x0e\xe8v\xd7\b\xdeA+š?\xfa0\xe6v\xc6\xd1K\xbd\xc0\xa2m\xf1\xe0\xec\f\x10t\xdd\xfa\xcd\x0e\xe3n\x9f\xc9v\aC\x02\xaf֒*\xe7\x10\xf2\x9f\x87\x01 \xc7\x03\xa7\x02\x9d\v0\xa3F\xe9}\x8c\xdc\xecS\x8a \xc7>\x87\x00)\x14\xfd#I\xb2&\x94\x84\x90\x1a\x90\xbcÖI\x8d\x93d\xc8\xe10k\xe0\x8e\xc6\xe4V\u007f\x1c\xe7M\xfed\x8a\x94\xdd\x04\xe3W)\xf6o\xc1\x02\xf2l\x12\x0e\x83\x8d0}~S\xec\xf7\xd7=\xa1)\x11\xd4\xe0x\xb9\xc6\x0f\xde{\x1fMUB\x82\xfc5hE\b\x0e\x04#\x1f\ac\n>\xbf\xa6\xb3\x87\xdc0\xc5U\xbc\xd7\x01\xfe\xbb\xbb\x02\xf8U\x16\xff\x83\xe7zC\xd3/\x98X\xb6^\xd4k\x00\xff\xb8\xaa\x1a\xbc\xfb\xfe\xaf#F\xa0\xdbo\x10\xec\xc8ѿĴv\xdeqq\rybv\x9enf\xad$i\xf7\x95&\x9d\xff\xd0\xe5\b`*\x1e\xf6`ט\xfe\xbdb\r\xba\xf3\f\x15\x87@l\xf7\xb2b\xed|\xc4\xc5\xd5%v\xfb=\xd9\xc1\x16%M\xdaR\x1c$z%\xf81\xf8\xc9\xda4d\x8b_\x82e\xa5R\x99\xb8\xe8\xd9~V\xcc&\xc2yĨ\xb8\xb1?\x1d\xad?\xd4\xec3\xafJI\x89\xb2,\xb9\xe9\xf1S\"\"\a\x02\x11d\x1fo\x9djo\x94\x17E\xe4\xacw\xadt6\v\n!\xb0\xc9O\x99w\xdfU\xddf?\x87|,\xf2a\x9e̔\x88\x9cfs,qU\xd60\x86\xaeyz\xf4ݎr\x19B\x80u\xc3A\xa1LD\xbfa\xd6Ą\x10\xa0\xb5\"\xe8?ǜz\b\xa5PW5\xdc00\xd1k\x92\r\nP\xd3%\xa5bַG\xe45\x92Lp<\xcb\xce \x04\x8a\xa2\x82\x94l\xa5,%\x99\xf8\x04\x9fM|\xec\xd8ϊ\x1b=\x811\x15\xa1\x19n$3!\xad\xe93\xea,\x9c\xf3\x103xWJ\nE\x0e7\xab\xcc\r\xfe\xc5v\x18\xb0\x1d\x92\xc7\x04\x11\xffd\x8e'\x8e٫`L\x99\nZC\x99\x02U\xd5B\t\x95\to\xc45\x19\xe0\xddH2\xc1q\x0f\xc5~\x0euQBk\x959!q\xceM\xe0W-\xa5\xc2\xe3\a\x0f\xd16\r\x82\x8f\x90\"\xc2G\x87\xfd\xd5\x15t\xdfC\x17\x05\xa4\xd1ЅAQU\x10\x8c\xee A\xff7'\xdet%\xc2\xc4\x11I\xbf\xd3\\-\x10\xe7_\x0ft\u007fyn\x10\xc0\xdf#\x95\x84ԆɊ\xe4F\x18E\xa4\xd5Ȭ\xc8\vE\xb1\xc5\a\x17\xfd\xc0\xe7\xe7\x86\xe4\x15sN\xc6\x1dGY\x8c\xbc\x02\x00\"\xfc\xc4ə9\xfb̟Q\xbce-\x90;\xc1\xbb\xf6\f\xb3/\x85o\x88\xc4J)\xb0^\xac\xf0k\xdfy\x1f\x0f\x8fO\xf0\xd1\xd3'\b\xd1c\xd7oP\x9755\x00B@J\r\xa3L\x8eVO\xd9$\xf4uy% \xee\xab?\ue340\xbe\xd9\xd4\u007f\x00\xf3\x11\xeb~\xbdl\xa1\x95\xf8=@,\xce\x1f\u007f\x80\xaan\xb1\xbd\xbe\xc4\xd8\xed\x80\x18\xa0\xb4aǶ\x00\xc7\xf9\xe9\xe9^M0\xbe\x14\x89U^\xb1F>A\xeb\x80\v>O@\x89\x98\x96^\x91\xd6\x1a\xc6T\\X\x89\xe1\x9b\n@]\xd0~z\xb7\xdfc\xb3\xdd@)5I\xfc\xe4t^P\xbd\xa6}e\b3\xb2Q\nz\x81̝uZU\x10S9Y\x89\x92q\xc8\xdc/\xff\x80$&\x1550vJ*\x04\xef0\xa5 (X+\x9d\xe5\x90ib\xb2\xdea\xb0\xe3\xa1P)\xbf\xde7\x1fE\x894\xa4\x95\x06\"A\xd7\xc1yTE\x89\xaa\"\x19\xa4c\xb7æ\xaao\x04Ӱ\xb6|\xec\x11\x03E\x19G~]\x14\x15\\A\x9b\x92\xdfS^\x17\xb0~\xde{\x0f\xeb\xc6C\x92\"\x1f\x8cC2\x03\x12`b!A\xffBJ2\xde\xe1\xf5\x86ц\xccK\xac\x9dr!\"\xd8e\x8e\x0e4%\n\xfesω\x92t=$\"\xab2\x88\xf7P\xd6\r\x129T\x1bM^\xffv̫\x9d\xb9\x97~j\x12\x925\xb4\xf7\x1eB*\x0efb\xe9\xe3\xcc\xd0%M\xfci\xc2\v\x81\xa4\x9d\xa5R\xb3\xbd\xb0\xc0\xae\x1fp\xd5\xed\xb2\xc4NK\x8a\x93\x96\xb3&\x10\xdc\xf8Yky\xe5\xa0QW-\x8c)\x10=\xb1\xda!\xc4\x01\xfc?\x0e=\xb9\x1f*\x8d\xd2\x14(\xd9͑*\xffd\u0097\x98\xfdRI\x9c\x9c\x9c\xe0\xbdwށ\x16\x8a\xf32X\xd9\x11#\x86a\xc8(\x81R\x1a\xf5r\x81z\xb5\x84)\nj\x04f\x89\x96\xa9\x98ǹ*#yeĻ\xb9\x00\xd1\xf3\xe7\xda\xd3:\x00i}Ǎf\xf0\x02\xc2{(c \x94D\xcc\xeb\xb38\xb3\xea\xa5\xcfA\x14\x8c\xdf\xce\xc2|\xee\x9ePx\xff\x1fo\x16\xffx\xe3Dㄍ\x18H.\x19o=͗\x1c\x8e3\xe6\xc2\xdc\xf2wF\x04\xce\x16\xdeL\x82\x98\x9f=_6\xb4EF\xbc(\x9f\xa3\xc0w\x1e\xbe\x83\xef<z\x8cO_<C\f\x11]\xbf\xc7\xd0\x0eL\xb2V$%V\x06}\xa0\xcf\b\xa4←V\x00\xe2\xbe\xfe\xff\xbb\xdb\x00d\x9b\xd9?\xefw99\xf6\x8d\x1d\x94\x12*\x84\xf8\xfbU\xdbb\xb5>f\xa2R\x0f;\xf4\xb4wR\x92\xa3r\xc9\xf66K\xf7\x98\a \x04I]\xea\xa6\xcd9\xebcOLi\x17RҞ\xcb\xeb\x82\t\x82\x95(\x8az\xb6\x03\v\xe4j\x17\x02\xea\xa2̙\xe6\u05fb-\x84\x14\xa8\xab\x12\x05\x17\xae\x83a!Y\x12gTa\x92\x14\x92\xbe\x9bn*\xcf\xd3(\x19\x97\x84\xc3\xe3D\x88;L?\x04\x14\x17\xf6\x9e'\\dhV你\x92\n)\xd0'\xc1\xfe6\xf0\xe4\x1fo3\xc9ovf\xf3\xb9\x84\xdc\xc3\n(M\xcfi\x1d\xc1\xac\x851\xa8k\"\xfa%\xe8\xfbhu\x9c\xe3`S\xfaZ\n\x88qv\x84T\x9a\xd0\b>\xe4\xb52\xac\x87\xa7\xdd\u007f.\xee}\x9f\xe3G\x1d\x9b\x93\xcc\x0f\xcf\x14\x9b\x9b^f\b\x01F\x17\x1c\xa7L\xbe\xe6\xe9\xfb\r+\x11\x12$\x1fg\x86.\xa3\xb3\xbcǤ\x02\x1d\xbc\x87\b\xe4\xa2(\x90\xd2\x12\xc9-\xb1\xac\x17\xa4\xa8ȩ\x80\xf4^\x06f\xd2[;N\xa6J\x98\n\xa5R*3\xc6\xe9\xb3\xc8\xc9u\xd6N\x05\x10\xe9gN\xbf\x0f\xc9$\x15\xa0\xa6\xcf\xd4`-6}7\xad\v\x84 \xd3\x1f%\x0f\xcbP$\xb3$\xef=$G\x00/\x16+\xfa\xccE\x0eH\x8a\x81\x82\x97<e*\xb8\xb1\x83\x02\xa0\x95DY\x18\x94ɷBL\x1f\x14)\x15\xb4V\xd0F\xa3nj\xfc\xe0\x83\xf7\xb1l\x97ܳ:\xf8\x88;\x19\xfb֏\xb0\xaf.\xb0\xbf\xde\xc0\x94%LE\xf7R\xb2\x0f\x16\x98<\x03\xd2\xcfJv\xcb9\x01S\xa4\x90/\x8a\x14\x9e\x1a\x80i7\x9f\x9b\x9b\x98\xa0x\xfas\xc9?'[\x10\xc7\xd9\x0e\xff\xa0\xd6\xc7\x19\xf9nB*\x0e\xd1\x00q\xfb\xef\xdc\xc6:\x19i\x00\xc2\x1c\x05xkɏo\x98\x93\xa6h\xe19/\xc8g\xb9\x9e\xc8\xd7(I.\xbf\x1a\x1a\x10y\xc8\x108jW\xf8\xfe\xbb\xef\xe1\xdf\xfc\xf4O\xd0\x0f\x166x\xec\xf6\x1b\xd4Eͼ\x1f\x85\xc2T\x99\x88\xab\x15\xa9k\x94TPR]}[\x1e\xd8}\x03\xf0oE#\xf0\xb5y&_\xfa\xf1\xbb\xf9\xfc\xd6\x0e\xe8w\x1b\x88\x88\u007f\xe0\x11߯ظ\xc5ڑ\xbc\xd3\x1d%\xa7I\x861\x93\x81L\xea\x88\xd3ͯ\xa4D]V(L\x89\b\xcau\xb7n\x84\x8fb\x92\xf4$\xe7\xaeY\xf7nL1K\xc4\x02D\x14\xf0\x91\xfe\xbd\xa9h\x9f\xde\x0f\x03\xac\xb5Y\xee&8Jw~\x13d\x870\xfeYBD>\xe8\x00!T\xb6-\xa5\xc9\xdfM\x8eZ\xf1n\x14R\xf2jDI\x82\x8f\xbb\xbecW\xae)\xad0\xb1\xd4\x13\\\x8f8\xc5\x1e{Od=\x1f\xfd\xa1\x0f\xdbWx?\x8d1(\x8c\xceS\xbew\x8e\f{*\"\xdayG\x05\xb7\xd0\x06M\xd3\x1c\x8c8\xe9Z\x0f}\x0f\x80\x15\n|hII\x90\xba1\x05\x91\xd4(\xa9e2\xa2\x89q\xb2\xe9M\xa4#\x90\xa5\xf3\x90\x1a\x19\xc1\xd367)\x02\x02\x83M\xba\xfa\x99\x869N\x8d\xd0\xf4\x1e\xd1\xfa\xa44̈\xf7\x0e\xc1;r\x00d\xabڤHQJ\xa3a\x0f\xff\b*\xe00\x1c\xde\x14\x02y\xe93w#\xceu`Y\xe6\x163\xe7#\"p\xb3\x10\xb2\x93{\x8a^M\r\x81\xf3\xc4`\xd7J\xe4Ϸ\x0f\x11\xd7=\xf9\xfc+\xd6ak-\xf9\xbd\x11\aw\x94u\x01Q\xf8l\x94մ+\xd4UKE\x93\x11#\xef\x1c\xdc0\xb2\xcbd\a\xe7\x06h!\x18\x010\xec\xdf\x103\xe0o4\x85\x02%\xe4\xe3\xc1\xf9\x19\x1e\x9f?\xa2\xeb:\x1f\x14R\xb3p\x17i8F\xb8\xbe\x87\xeb\a\x92*j\x05m(2Zj\xf6\x8bH\xc4=!\x01\xc1\xe4\xd6\xc4֏@p\xbc\xf6\xe2\xf73$\xbd\xfe\x8cs0\x87̣#o\b\xa1$\xd7n\xfe_A9\x18\x88q\x1e\xa7\xc0\x8bv1Y\xf9\x86Y\xbd\x17\xf3E@|\xcb \x13\x0fPMz\xbfė\x02\xa3b\x863\xe0`\v\xc1i\x9d\xf9\x9fxxB$\xad\xeaW<\xa3\x89\x109y:\xb4u\x8d\xef<x\x84U\xdd\xc0\xb9\r\x00\xa0\xebw\x18쀚\x1d\x18ˢĮ\x133\x9f\x80\xc8af\xb8\xba\xdf\x00\xfc\x85X\x01D|[\u05ff8c\xb4\xdf|\x84\x18\xd0m\xaf\x88\x89\x1b\xe3?\x8aRb\xb9:\x06 0\x0e=\x86n\x9fwy\x02\fq:\xcb䣘M\x80\x14\x9b\xa1TUC\x856x\fÞ4\xbe|W\a\x9e\xd4\xe6\x05AH\x05\xa9\fMMl\x15\xee\x13\x91\xb0\xaa\xb2\xa6\xbc\x1bz\x14F\xa3`[ߔ2\x98o\xd6DN\xe2\xe9C\xa4\xccr6\x00J\xf6\xbf1i\x8ec\xb8\xb1\x1c\x8c\xb7`\u007f)\x15\xa44\x88\b\xe8\x06\xcaa\x9f3\x8b\x8d\xd6d\xfb\xea-\x05ְ\x06\x9c\x8a\u007f\xe0\xa2\x18f\xf9\xeb_vP\xd07\x15\xa6`\x13\x10&(rZ]UU4m{\"\xda\xc5\x18\xd04\xbc\xfb\x8f4!\xa5i%M\xc6\xda\xe8\x19\xaa@\x1e\xe3EQBkC\xf2L\xfe\xb9\xe3\xb8G`\xb5\x82\xbb\xa9R\x00\x19\xfe\x8c\x9cHǪI\x94\x86^\xcf\xe8\xc6쩟\nnU\x9490*M\xc7ɀ'Y\xd6\x12d<NL|\x12\x851\x92\x02\xd4\xed\x12ڔ\x13\xbb\xde\x0e(Vd\xff\xeb,\xa5\xfcy\xe7\xb2~:\u007f\xa6\x92\xc3`\x98\xe6<\xef=\x130\xe3\xe4\x0f\u007f#R\xd7z\x8f\xa6(r\xf43\x00t\xd6b\xb0\x16\xcc1\x85\x94@i&\xaeEz*\xefI\xd0(U`^\x84\xc1jqD\x0e\x88\xae\x9b\x8c\x93\x06J\x1ft\xceb\xe8w\x10\xc1C)\x8d\xb20\x14㜌~\xa4\x80)\feohM\\\x90\xb2\xc0{\x8f\x1f\xc3h\xc5\xeb6\xf6\xfd\x17\x9cV\xe9=\xb6\xfb=\xaew{\xf6\xa7\x88\xec$\xe7Џ#\xfaa\xc4`-\xf3/('\xe3\xd1\xd9\x19\xbe\xff\xee\xbbX4\r77Ԉ\b)\xb0\xeb{\xec\xfb\x01\xa5Ҩ\x8dɾ\rI\xf2K\x85,\xde>Y\xd2\xfd\xc4r[Hq`e,HX\x0fܐ\xbe\xe6\xd5\x00\x90\xf3H\x0e+r\xc8+\x85\xdb\xc5u\xa6\xe3\xcf\xcd[ȩ\xa3\ao֭3\x90Ȉa\xf6\xdf!e] |\x89\xf7\u007f<\xa0\x06\u07b5\xc6S\xcc\xc1\xb0\xceb良\"\xa5\x82Q\x1a\x8f\x1f<\xc4\xf1\xd1\x11\xae\xf7{\x00\xc0`\x1d\xf6\xfd\x96P\x00!`t\x01)\x15\x87\x8bEN_\x95\x00pu\x0f\x00\xfc\x05\xe1\x00$\x18\xfb\x06o\xe7[O\xff`\bԻ\x11\x02(\\\x8c\xbf\xbb\\\x9e`\xb1<\xca\xda\xedq\xe8r\xe7\x1a\x82\xc70\xf4\x99\x14\xe4C\u0a49\x13\xf7\xaa\x1a\x9aݫ\x86q\xc8\ty\x89\x1d\x9d\\\xfd\xe6\xaf(M<\xf9\xb5\x04\xde\xc3+\x85\xb2(\xc9\xff\xdf\x13Ӹ0\x86\xbc\xc9y2\x9b\xba\xfd\xf9N\xd3g\xdf\u007f\xccM3r\xf1w\a)g\xf1\x06\xd9\v\f\x81j.\xee>\x90U\xe7h\x87|0\xa4dC\xa3\r\xb9\n\x029\xe1\xd0{\xcf\xc5\u007f$\xa6\xfc\x1d\\\x82[\xb1\xa3\xb3Ga\f\x19\xbb\x00\xd3>\x19 \xbd>7C\x03GؖE\x89\xb6n2<\x9a\x0e\xbf\xe0\x89dFa6ӄB\x16\xbd\xa4\x8d\xd7\xdadjyp\x16\x96\xdfW\xeb\xec\xc1\xf4\x0fN6\x1c\x86\xf1\x00*WJ\xe5,\x86\x81Io\xe9\x17\xaa\x98\x9f\x11g\xf9\bS\x91\xb5(u\xc9\xcf\xe3X\n\b(A\xff\x80-x\xa5֨\xebe\xfeۤ\ufde8\x9b%M\xb4<\xfd\xa7\xd5\xc6A\x12\xdd\fm\x10\x92\xe6}g\xddd\xac3'\xb9\x85\x88 \x80\xc1Q\xb8U\xcd\xee\x8a\xe0&`\xcf;u\x99\x91\r2[\xe2Nc\x82\u06dd\xe3\bmZ-\x99\xba\xc5r\xb1f\x99\xa3Ϩ\x94\x1d)U\xb2\xef\xf7\x18\x87=\xc3\xff\n\xa5)\xb2\xecQ\b\x9a\xfcLQB*\x99\x1b\xf5\xe3\xa3c\x9c\x1d\x9ff{\xdcn\xb4\xb8\xdcnp\xb1\xdd\xe2\xf9\xc5%.\xb7[\\\xed\xb6\xd8u\x1d\xac\xa5p\xa6\xd1Z\f\xe3\x88\xd198難\x0f\xc48\x1f\xac\x83V\x1a\x8f\xcfNp\xb2Z\x03\x02\xa8\xcb\x12\u007f\xf9\x83\xef\xe2\xfb\x8f\x1f\xe3\xf5\xf55\xf6}\x0fĈ\xa3\xc5\x02\x1f<z\x84wNOQ\x95%[~\xcfv\xf0\xf3d\xc1\xf9\xb9\x95B{D\xcc\xc4\xda\b&\xff\xdd!\xb7Lwc\x147\xf9w3\x96~^\x13̛\x03\x1cp6\x126\x13\x83'\xe5\xc1\x81!B̜\x92\x98\x9c\v\x93\t\x19\x99\x1b\xf3s\u007f\x89\xb5\x92\x98P\x89tFO\x9e\x1d\x14\xc44\f\x03^\xbez\x81\x8f>\xfa%>\xfd\xf4c\xec\xfb]\xbeJ\xc6\x14h\x97k|\xe7\xec\x01\x9e\xbdz\x85\xae\xef!\xa5\xc0\xbe\xdb`\xbd8\x86\x11f\x92\x02\x06\"Ɋ\xe9\x9a]\xdd\xe5dy\xdf\x00\xfc;\x8e\x05\xbcy\xff\xf5\xcd\x1e\xabEC\x93\xb7*~\xa7\xaeM}v\xf6\x10\xa5)\xb0\xdd\\\xa1\xdb\\#x\v\xad\xc9\xd6u\n\xae\x88\x93\xa6\x9eM\x84\xb4RD\xfc\x13\x12\xc1;\x8cC\a\xe7\xb9A\xb8\x81Ḑ\u007f!UV\x0e\xa4do!\x05\x94\xa0b\x18A1\xa9ӎ]L\x89s3\"a\f\x1e\x11\xe1`'\x9f\xf4\xb4\xb9\x80D\u007f@\xe8I\xda\xe1\xf9\x84 \xa5d\x03\x1f\x05\x1f(\xd1-\xfb\xf5\xcfR\x00\v\u07b9\x87\xe8ɰF*xG\xcc\xff\xc1\x8d\xb0\xde\x1eB\xb1ӹ\x83;\xdfFA\xcfY2\xa4\uef03\x1d\xe9Z\x9b\xa2@U\x16\xe4\x81`G\f\x9c<\xd74\r\x8c1\x103\x90\x88\x02F,\xa1\x06)Y'I\x14u\xc9{\u007f\r\xa9\xf8\xef!\x90m.\xf3!\xd2\xf4?O\x8b\xeb\xc6\x11\x8e\xd73\xe9eW\xa6\x82\x14\x02\x9d\xed\x0fL\x8d\xa4\x94h\xeb6\x13\xeb\xd2{\x11 0:\x92\aj\xa5\xf2\xebL+.\x95W\x00\x04\x15Wu\v\xcd\xef\xbf\x10\x02cס(\n\x94U\xcdR\xbe\x91\xa2\x93}\xb8\xc1\xd8O\xd2Bp\xca!\xd9?[7\x92\xd2C\x1c\x12\xc9|$\xb4f\xf4\x1e\xab\xb2\x9c^7\":˨G\nr\xd2\x12%#*b\xb6\xf3%\xabd\x0f#Mv\xb2k\x9b%\xaa\xaae9+\xbb/&\xeb_o\xd1w\x1bDo!\x15M\xfeF)\xe2{H\x81\xa2$7\xc5\x146\x15\x11at\x81Ǐ\x1ea\xd7\xf7\xf8\xe9g\x9f\xe1r\xbb\xc3\xe5f\x8bכk\xf4\xc3\x00\xe7C\x968\x0eÀ\xbe\xef1\x8c=\x86\xd1b\xb4.#u\x83\x0f\xb0>\xc0ŀ\x10\x80}\xecq\xb1\xb9\xce\x045\x00\xf8\xbf\xfd\u007f\xfe\xbfh\xca\x12J+\x1c/\x968_\xaf\xb1l\x1a,\x9b\x06\uf71d\xe1\xaf\xfc\xe0\a\xf8\x8d\xf7\xbf\x03\xcd\xd2IB\xbeB\x96\xb0MPW\x9c\x1aÉq\x99as\xa4\xc1F\xce-\x8a\xd3\xf2-\xb9\v\xdeحǙ\xf5pDv\xe3\x893\x8fb!n\x18\x06\xc50\xf3\x1d\x89y\xe5\x90 \xf5lnuCؗ_\xfa\x8dUC61:P\x18\x90!\x12\x85\xf8hl\xae\xaf\xf1\xe3?\xfeC\xfc\xe9\x9f\xfd1\x9e<\xf9\x1cWWW\xb0\x9e\x1a\xd2Rk\x1eb<\x94P(\xda\x16\x0f\x9b\x16\x9f3\xb7\xaa\x1f:\xf2\x91\xa8i\xfa/\x8b\x12\xc3HV\xe7*#\x1a\xf1\xca\xdd0\x13\xbbo\x00\xfe{\xfa\x98\xbb\xb0\xfd\xaav\xf7\xbf\x8aGY\x18TU\x89\x18#\x16\xeb\xa3\x1f\x95\xcd\x02\xab\xe51\xbc\x0f\xe8:\x9aPD\xe4\x00\x1d֟\xa7\xc3̳\x85'@\x0ejeY\x91O;\x90\xd9\xe0\xf3\x88\u07fb\x8cGȈg*\x12\x9e\xe5j\xce9rq\x93\n>\x06\x8cv\xa4\xb0\x1e!\xe0\x92\x9b\xdf\xec\xf9\xd2j!\x1b\x90$\xef\xf4\x9cm\xee\xb3Um\xbcɯ\xc87:AuZ\x11\xbc\xeb=i\xf6\x93k^^/\x00В\xbe/M\x94\x8a\xa5\x91!\x04\f\xe3\b\x17\x0e\x8b\xff\x1bQ\xff\xd9\x17\x8c6(Y\xed\xe0=\xfd\xce\x02I\xaeG<\b\xeb\x1c\xba\xa1\xcf.a\x8bzq@\xfc\xa3\u007f<F;P3$\xcd\f\xfe\x97(ˊ]\n'\b\xdb{G\xdf\x1f#\x9c\x1fg\xab\x11:\nG\uf674\a\xfe\xfe\x00e\f\xb4\xd6p\xc1c\x18\x86\x83\xddkU\xd5\x04\xff\xe7\xf7XqV\x00\x11\xe4\fK\xc4<\x93\xe0\xc0h\xb0\x16\xd3\x0e[\x1b\x83\xb6]MQ쁤~˓GPJS\x83cG87r\x14u8دd}x\xd2N{\x8a[\xbe9m\xa6B\xe2|\x80\x91\n\xa5VԀ\x02p1`?\xf2\xf4/H\xbaU\x17&\xfb`L\xd2Ȕi\x91\x90\n\xb2\xf3=ZӤ\xee\x92\xddo\x04\xcb\x03\tQ\x1a\xfa-\xd9.3\xfb_*R*\x14\xc6\xf0\x9ak\x9aYc\x8cxpv\x06\xa5\r\xfeŏ\xff\u007f\xf8\xe4\xe93\"\x85\xb2\xc44p\x9c\xf4v\xbbž\xdb\x11\xca\xc0dD\x1f\"Yn3\x99\xcdG\xc0\x05\xc0!9\xe7\v\xce=Hq\xc1\xf4Sw\fU_\\o\xf0\xd1ӧh\xca\x12\xabE\x8bEU\xe1_\xffٟ\xe1\xaf\xff\xc6o\xe0\xfd\x87\x0f\x10C\xc4w\x1f?\xc2\xf7\x1e\xbf3+\xf8\xb3\x0eRL\xff\x1e  |\xa4\xb5A\x8a\xc7>h\xdeDfߋ\x83\x81!\x1e<\xdd[&\xa3\xe9\xef\xde\xf8ZLAC7\xf2\x87#\xe8\x9a\xc4\x1b\xd3\xfe\xcd_\xe3\xe6M\x9b{n^\x85J\xd6\xec\x0fÀ\x1f\xff\xf8\xdf\xe0\x9f\xff\xf3\xff'>\xfa\xf8Cxg1Ĉ]\x88\x18#\xb9\x93\x1a1\xa2V\x12\x95\x94P\"\xc0o78\x96\nCQ\xe2\xf3\x91<>\xba~\x87e\xbd\xe4\xe6\xaf$5ρu:\xae\xc6d\xd2u\xdf\x00\xfc\xf7\xbb\xf8\xff\x8a\xc7\xff_\xd9c\xd16D4*J4\xcb\xf5\x8f\x9a\xba\x856\x05\x86~O\xf1\xaa\xcefӎd\xb8\x92\xc80\x8e\x0f\x17ɻ\xff\xb2\xa8\xc9\xe7\x9c\xfd\xfe\xa9\xe0&\x18\x8e\n\xf6\xfcR(>\xf0\xc0\x92\xafD*\xa3\xb5\x82GY\x144\xed;*\xf8\x82w\xed!L\x922\"\xdc\xd1\xd7'+c9\xa7\xc3\xf1\x84\xe7\x0e\x9c\x01\xe7hDb\xe4J)(\xddP\b\xb8\xe0\xb2[^^s\x00\x88\x9c]O\xb2<*,\x8aa\xf6\x10\b\xf6w\xde\xcdB\x95\xde\xf6\xc1\x98`\x01\xa3\f\xa5\xfaA\xe4\xf5\x01\x98\xed[\x95\x15\xb4$\x16\u007f\xd7\xf7d۬)\x04\xa60\xe6@\xaeD\x19\xf3\xc4ј;\x18\"\x82\x8cq\x18\xf6W\xda I\xab\xac\x1b\x11\xbc\xe5\x90${\xf0Y\v\xa0\xac\x81\t^\xa7\xa2Q\xb3q\x90\xb5\x16.\xc9\xf7ؼdɉ\x91ɱ\x8c8\x12\xb4\xca@$\xa3\xa445GP\xa6\x80\x111#5\x90\x82\xa6\xff\xa2\xcc\xda\xfan\xd8CJ\x85\xbamIF\xe7,F;fł\xf7\x0e*\xc1\xf2\x1c3\x9c<\a\"R\xf0\xd1m\xeb\xdfd\xe9\xecC@]\x16,\x1d#\x92Z7\xa6ߍ\nUY\x98\x9c\xe6\x98\x1a\xf2\b\xd0t\xed\xa9m\b\b\x88>\xa2i\x9789y\x94'\xfe\xc8dGgG\x84\x10\xd1w\x94_P*\x8d\xb2 5\x81\x14\x02ZP\xa2\xa0\x10\x02q\xe6\xd7_\x98\x02MU\xe2\x8f~\xfc\x87\xf8o~\xf6S\xecG\v\xa5\xd9\xcb\xc2{\xb8q\x84\x1d{D;B\t\xe0HI\x14\x95\x86\x92\x05\xc7\xf6\x8a\x1c\x95\xe3C\xc4\xde:lG\x87\xceyt>\xa0\xf3\t\x91\x9f\xc7y\xe3@2\xb8\xed:l\xbb\x8e\x9a\x14\xa5\xf1\x93O>\xc1z\xb1\xc0\xa3\xd3S\xbcwv\x86\xbf\xfb\xd7\xff:~\xfb/\xfd%H\xbe\x87\xefZw\x89\x98\b\x80\xb3]\xe6̀A\xcc\xee\t\x927\x02Q\x84\x8c\x0eĘ\x81\xfd\xc9\xef\"\xdc}L\xc6\x03\x98o6D͚\x8a\x00\xe4<\x92\xfcc\xe7\xcc+\x81\x03\xff\x81t\xae$\xcb\xecԺ\xa8Ba\x18z\xfc\xe1\x1f\xfd7\xf8\xd7\xff\xfa_\xe0\x93\x8f?\x84w\x0eB)X\xa9pi\x1d\x86\x10\xe1\xf8\xfa\aP\x17&\x05\xa0 Pk\x89\xf3\xb2\xc4iQ\xe1\x95\xee\xb1\x15@?\xee\xe1#\rDZi\x80\x95$Zd\xf2\xe9=\x02\xf0oC\x03p\xb0\xbf\xff\xd6\xddį\xae\x11(\x8b\x82\xc2(bD\xbdX\x9fh]\xfcVQ\xd4@\x04ơ\x87\x1d\xba\xecH\x158\xfe5\x15R\x1f\"ۿ\xf2\xf4\xcfR=\"\xad\x8dS\xa3\x90\x18\xc3q\xf2\xd6N\xbbhɒ\x16-eJ\x01%\xe9\xda8R\xb8JA\x1e\xfd\xde{\b\xa1\xc8Q\x8f\xa7\xdc@+Ŝ\x1a\xa7\x92\x8d\xf1\xcc^8y\xa9{\x8e\xf3=\xdc\xf9\xdfX\xc2s\xc0\n\x04\xe0x\x1f\x98\x88|\xa3\xf3\ap`\nbq\xc1e}6\x91w\xec\x04\x85ǯR\xfc\xe9\x1b\x8d\xe6\t\x905\xe4)-P\v\x89\xaa,i\r\x12\x02ü\xe4\x16\xa7\xa4$\x97\xc5\xd9\xd4\x129'|t#\a\xeb\xcc\xfc\xec\xa5D\xc9.\x81\a\xb1\xc7!pQ\xf2\a\xb2\xbf4%\x8d\x96Ls\xd2\xc3\a\x92\"\x19\x96e\x8ev\x98\x0e\xc7\b\xac\xda\x05\xea\xa2ʿ\"\x19G%\b\x9e\"s\x13\xf9/e\x9cKD\x18I\xc5.\n\"^\x16U3M\xd8\xde\xc3\xf5\x03\xaa\xbaF\xc1H\x88w\xe4\xa3\x1f\x98\xd0郇\x9a\x1d\x03\xc9e.5\x9fֺ\xd9}x\xc8\xf9\xb03\x14+\xfd\xde\xce9t㘛\xa8Rk\x82\xfe\xc5!\x1e\xec\xbd\xcf(\x97H(\x17\"\xce\xcf\x1e\xa1i\x96\a\x9c\x17;\x8e\x84D\xd8\x01}\xb7\x85\x021\xff+cfȇ\xca;\u007fɌyk\a\xf8\xb1ß\xfd\xe9\x1f⳧_`\xb3\xeb1F\xf2!\xd0R\xa22\x1aGe\x81\xb2Y\xa0\xd0\x1au]\xb1|\xd4d{g\x92\xc7zv\xea$\x8e\xc28\x8e\xd8\xef\xb6\xd8\xecv\xd8\xf4ln\x04\xc0F\x81\v\x17q\xe5\x81~\xd6\fdwD\xf6\x82\x18\x9c\xc5\xd5n\x87\xcf_\xbc\xc4/\x96\x9f\xe3\xe3g\xcfpq\xbd\xc1\xbf\xff\x9b?DSU3\x88M`F\xb9!X?/\xf0\xa9\xa3\x14\"\x19WM\x96\x87aF\xf0=(\xda\xe9>\x8co\x18\xae\xe2\xc1\xed>\xa7\bd\xae\xccD\xf4\x03\xff̙\x10X\xcc5\"\x877l\x8aCN?75\xba\x1f~\xf8s\xfc?\xfe\xef\xffW\xfc\xe4'\u007f\n\xeb\x1d\x9c\x94\xe8#\xd0\r#\xfa\x10\xd1\xf9\x00\x0f\x1cL\xf0i\x1b\"!Џ\x1eC\x00\x1e-\x17(\x14A\xfc\xd6\xf6t\xf6qX\x99\x92\x92\x1cTu\xc1\x88\x88\xb8r\xfe\xbe\x01\xf8\xb7b\x05\xf0+\xd3k\xfe\nQ\x80բeB\x89DY\xb5\u007fOK\x85\xa2,\xe1\xad\xc3\xd0wp\xec-.\x84B\f`\xa2\fM\xdb\xde{\x84\xc8zh\xadQT\x15 %M\xcb\xd6\u0086\x00\xe7'\xeb\xd1\x14\xe6\x91둚\xf6\xa8\x92\rj \x00;\x12\x01\xadn\xa7)2$\x93!\xefy:\tL0\xf6y\xe7<\x15\xffI\x96\x95\\\xcan\xf7N\xe1 |D\xce\xf4\xce\x04\xf5\x83\xd3\xc9b\x8e-\x9e&\x18\xc1\x86:\xc8\u007fN\xec^\x97\xdd\r\xc5W|\x1f#\x80BiTE\xc9\xf0>q\ab\x8c\xc4\u007f(x%\x10\x05FGfJ\t\xe5(L\x89\xd2T\af(\x84\xb2\x10\xfa\x12b\x84R\xd3\xfe\xdf(\x9e\xfe\x01(Mҿ\x88HV\xceޱ\xab\xa3;܍\x87\x80\xdeN\x92\xc7\xc0\x8d\\S\x94\xec\x86\xd8\xc3y\x97w\xe1\xa5)\xb1h\x16\a\xe13\x94\x18I\x04\xb9\x88\xc8^\x05\xc4oH\xeeq\ns#\x1e\xc0\x14%\f3\xff\x85\x10p\xce\xd2\xd4YT0L\x1et\xcerF\x01\xa5D\xa6B\x1dX\x1a\x87ٔI\b\x8e\xcb^\xf4\xf3{(!Y\x9a\xe5}\x8e%\x82\xbd\xb5Y\x05\xa0\x95D\xc5AK\x98\x85\xc1\x90ς\x9b\x94\x06\x82\x90\x88\xbanq~\xf6.qH8y2\x04\x92 \xc6\x18\xd0\xf7[8\xdbQp\x93)آ\x98\xdd\b\x95\x9e\xf1\xe2\x04\xfan\x87\xfd\xe6\x12J\nt\xfb\x1dT\bx\xb0lQU5V\x8b\x05\x9a\xb6A]\xb7hZ\nJJ\x1a\xff$\xed̍!\xf3\x03\xbcs\xe8\xc7\x01C?`\xec;\x94u\x83\xb2\xdeb\xb1\xdfa\xe8:ʗ\b\x1eG\x1a\x18\xa3\xc0.D\\\xfb\x88\v\at~&\x8d\xcd\x132\x15ꗗ\x97xu}\x85\x0f\x9f<\xc1\xbf\xfc\xd3\x1f\xe2\xef\xfd\xce\xef\xe0\xb7\xff\xd2o\xb0?\x01\xdfo,\xf1\x93\x81\xf9\x13\x82\x19\xfda\xc2S\"7\x92\x01\x82s\x040\t\"\xc5l\x9b0\xb3\xe6\x15\x93\x15S\x06\xff\x0fP\x80\xf4ٚ\x85!Q\xf1\x17\u0530\x1d\xb2\u007fo\x11\x19\xe7\xdc\x16r/\x9cxEW\x97\x17\xf8W\xff\xea\x9f\xe3\xbf\xfe\xe7\xff5\x9e\\\xbeF\a\x89}\b\x18\x83\x9b\x92N#Kp\x0f^c\x92\x18\x02\x81\u007f\xaf\xce;<\xdd\xed1xR\xa3\xc0;X7@+M͡T\xe4\a\x10Hf\n\x11_\x8f\xf7\b\xc0_,\x12\xe0\xafl\xf7_\x16\xa89&\xb5w\x1eR\xa9\x1f\x99\x82\b'\xfba\xc3\x10\xa5cC\x12\x91\xb5\xdb\xc4\xe4\x8fp\xce\x11<+%\x8a\xb2\x826\x86'n\"!9\xe7f\xeez3m\xb6@\x86\x85c\x8cdy\xca_\x1b\x1d1\xba\v\xa3\xd165\xef\xf6\x03\xc3\xfe\x9e\xa5\x81Stl\xbe)\xe7ş\xc9>!\xdd$\a\xf0\xdd\x1c\n\x14\x13\xc1J\xa4\xec\xf7\x90\xd1\r\xfa\xc71\x94\x99\xe0i\x91\x93\xfer|(\x17\x11\xcbR4\xf15\xfa8#\x15\x15x\b8\xe7\xd9)\x90!qeP\xf3\xbe\xdeY\x87~\x183\xd2!\x84@U\xd5\x1cw<ߑ\x12\xfc\uf763\xe9(\xe9\xd6@\x89\u007f\x82\x83Z\x94\xd2\xd9\xc9\xcc;\xb2s\xf63\xd9_Z\xaf\f<\xb1\xa6\a\xa1\x0f$I\xcb2\xc4<\xe9S\xea\x9ffr\x13]\fɓ\x8c\x85uԼh^\xf9x\x8e\"\xa6\x95\x002\xbc*!P\x96\xfc\xbb\xcd,\x9c\x956\fyk2\xd2\xe9\xbb\xdc\xdcy\xe7frF\x91\xf7\xba\xe9\xf7\b\xc9hhV\x10\xf3\xef\xc4rP-͔\x15\x01Px\x137&ua`\xd8\xeb!\x1bO1\xaa0\x87\xba\x89t\xe8q\xbc>\xc7ry| \x81t\x1cvc݀\xdd\xee\x12\x88\x01F\x1b\x14\x85\xc9\xc6F)92\x15\xadq챹|\r)\x81\xaal\xb1\\-\xb1\\\x1da\xb1\\\xa2\xae\x1b\xe8°LUΌh\xc2ĜO\x06?B@\x18\x91\xc9n\r#y\xd6Z\xf4}\x87\xeb\xeb+\\_]\xa3l{\xb8q\xc48\x0ep\xe3\x80\xc2:\xb4\xc1\xe3<F\xf4>\xe0\xc2F<\xb7\xc0\xb5\xbf=\x1f'\xe9\xe2˫+\xfc\x97\xff\xe2_\xe0\x8f?\xfc\x10\xff\xe9\xdf\xff\xfb\xf8;\u007f寢\xcf\x0e\x981\xcbGż \x8b8\x93ֲG\a\x00D\x95\x91\xbd\xb4n\x91\x1c,D\x1f\x9d\xdbS\xfa\xad9K\xdc\xe6ڄ0I\xfc\xbe\xea\r+\xa5\x80Pd$\x16c\xc4\xe6\xfa\n\x1f\u007f\xfcK\xfc\xf3\u007f\xf9/\xf0\xaf\xff\xe4Ǹ\x18-,\x04<&\xc5Ӥ\u007f\x89\x98\x8f'\t_\x88y\xf0\x00\xf32\xc8g\xa3\x90\x12RHl\xba-\xfaq\x8f\xa6l\x99\xa7\xa1\x90\xfc\x03\x18ٺ\xb2v\xbc/f\xf7\r\xc07\xd8\xfd75\xa4\x94\xd8\xeev\x10\xa6\x86)\xcc\xffTk\x03;\f\xd8\\_\xf0\xf4O{\xf7D\xd4I\x1aq\xeb\x1dk\xdb%\n\xa5PV\x15\x99}\xa4\x83\xc5yxǒ\x9a\x10g֕i\xfa\x9fi\x98\xf9\xae\xf0\xde\xc3[b\xd37U\x9d\xa7U\xebH\x03O\x88\xc3\x14f\x03\b\xdem\xce\xefnvq\x8b\x9e\x99\xfa\x87\xc1\xa1\x890\f\xc8I\x97ϒ3\xc1\xbbDZ[\x90\x16\xc1r\xa4,\x84\xe0\xa0!\xfa_b\x95\x87\x1c\xed\x9b\xd6\x1d\xe2+\x14\xfd\x89\xff Q\x14\xb4\x9fM\x84\xbf\xf4\x1cR*Ԝ}\xe0=ń\x86\xc0)\x8c\x10\xd0l\xb6t\xf0\xdc̱p\x8cX(9y\xbf\x1bmr\x84\xb2\xd2&\xbb\x15F\x1f8\xfex\xc8\rU\x92TZ\xe6\x11\xc4\x19\xd4\x1d#\xa5\x98I!й\x9e\x18\xcd|\xd85U\x8b\xaa\xac\x0f^\x13\xad#,\x19!yO\x86AB\x92M2K\xf7\xa4\x00\xf4\xac0+\xadQ\x96\xf5\x84\xe4\xf05\x11R\xb2\x89\x91\x84w\x0e\xdd~ǤK\xf2IЦ\x98\x05\xc3\xe0\xc0\x81\xd01\xfa\x80\x03\x8c\b\xacj\xf1\a\xe8\x90\x00\x87U\x05btW\x85Ai\xf4Tj2\xeb\xdf\xc32\xf1o\xde\x01\x14\xa6ă\xb3w\xa0\xb5aU\x05\xad\xcc,_\x83\xed\xee\n\xdd\xfe\x1aZ\bTEɜ\x02\xba?LV\x95D\b\xe70t;Tu\x85\xf3\a\x0f\xb0Z\x1f\xa1\xacX\xc1\x912\x12\x92\xb4\x92\x95\rR)h\x95\x9a\x02\x0eݙ#N\x8c\x9ey\xe7r#\xae\xb5A]\xb7X-\u05f8\xbc\xba\xc4\xd0\xed\xe0\xc6\x11C\xdfa\x18z\x8c#\x19P\xd5ʣT\x0e\xa7&\xe0\xc5(\xf0\xc2Et\x94\f\x9c\xb9\x05)\xbf!ƈ\x9f\u007f\xf1\x05\xfew\xff\xf9\u007f\x8eq\x1c\xf1?\xf9\xed\xbf\xc9\xd7\xd4\x1fލ\a\xcb\xf68\xcb\xe0`ΐ\x8f\x00\xf4d\xb4\x95\xae\r\xb8\t8\xc0\xf9\x05\xdb;Gܬ\xeb\t)H\xeb\x99p\xc0\xff\xf9jk\xd7\xe4\x9a\xf8\xfa\xd5\v\xfc\xf2\x97\xbf\xc0O~\xf13\xfc\x9b\x9f\xfe\x19~\xf9\xfc9\x86\x18\xb3\xfb`zV\xc9E)I[5\x00-\x044\xaf\xbc\x82\x10\x18\x03\xadX\\\xa4\xaf%\xf9a)%\xce\xcb\n\x97\xbb\r\xba\xbeC\\\xd2/\xa9\xb4\x81\x18;6z\x92\x881^\x8d\xf7\r\xc0}\x03\xf0u\x1fJJ\xd4E\x81\x18#\xf6]\x8f\xf3\xa3\xb3\xdf\xd2J\x9fK\xa5a\x87\x9e\xf6\xff\xceR\x91\x15\x92\xf7n\xd3>\xdf9\xcfLx\x01S\x14P\xc6\xe4\x9d]\"\xf0e(\xf6F\xf1\xa7\xcctreӜ+@{\u007f\xf2N/\x8d\xa1@ !\x10\x03\x15F\xcfA\x18`8Q\x00\x90Jܤr\xe5\xfd\xe6]\xeb\x969\xe1o.\x02K0c\x00\xefHc\x04\xa4\x84\x1d\x1d\x9b\t\x91\xbcOB\x90y\x8eR\x84p\xb0\xc1\x89c\x19\xe4\xd7ax\x10ӻ\x80b7\xbb\xc1\x0e9VW\b\xa0)K6?\"E\x81e$FrlhU\x94(\xd8$h\x0ee\xd3\xe1n\xf9\xfa\xc8\x1c\x1cS\xb0O\u007f\xe29D\x1e\xc3|pY.\x18\xe7E\x82\xa3~\xe3\fY\xb0!P&\x81ִ\x1a`\xcb_b(\x1b,\x9a\x05\xa3(\xd3\xf5\xf6\x1c\xb2\x94\xbeWK\n\xb8\xf1\x8eљ\bh\x11'\xf8?\xd2k5U\x95\xe5\x19\x81\x89\x84!\xb8LJ\x1b\x87\x1e\xdd~\x83\xa2nH\x05\x90\xa5}\xb3=qJ\xa5d\x82\xe0\x01\x0f\x87=!\x1c\xaf\x94\xc0\xeb\x0e\xc9p\xb3uԜ\x14Z\x111P\x8a\x83\xe49\x1f8\t\U0008ed39\xe5\xf2\x18\xeb\xe33\nE\xea{Vr\xd0\x1ac\xb4=\xae7\xaf\x11\x83Ca*T<\xfd\x93۟Fi\x14\xa1\x06!Bh\x85\xf5\xd1\x11\xd6\xeb\x15\x96\xcb%4\x9b_I)x\xe2\x97Y\x0fn\xb4F\xd5\xd4\x14\xeb\\\x90\xb9\x93H\x9c\x8a\x94\xbb\xe0=\xa1C\x9c\xdag\x9dC\xdfw\x18\xfa\x01Z)4u\x85\xa3\xe3#l\xae\xae\xb0\xdfn0\f=\xba\xfd\x1e\x9b\xcd\x06\xfb\xfd\x9el\x8b\x85\x04\x84\xc3;2\xe04\x04\\\xb9\x88K\a\xf4A\xa0\x8f\x116Lk\x81Bk<}\xfd\x1a\xff\x9b\u007f\xfaO\xf1\xf3\xcf?\xc7\u007f\xf2\x1f\xfdGh\xab\xea D\xea\xf0\x06\x9d\xc8})\x97\x001\xc0\xbb\xc8;:(\xe6\xf9m\f\xcc\aJ\xb2\xcfY\xc6C\xfaր\x9b\xea\xa383\xfe\x12_\xe1\xbcT\x18\xfa\x01\x1f\xfe\xf2g\xf8\xc3?\xf91\xfe\xf8\xa3\x0f\xf1ѳ\xa7\xb8\xea:DvN\xf4\\\xf4\v!\xa0\x11\xa1\x01\x94JbQ\x1a,\xcb\x02\x1a\xc0\xd8\xed3*)@]\x81\x17\x12;\x1f\xb1\xf1\x116Fj\x04\x01,\xb5\x81\x16\x02\xfd\xd0\x11\xbfE*h\xa9'\x14\x80\xae֕u\xf6\xbe\xa0\xdd7\x00_\xefa\x8c\x81Ԛ\x9cќCQ\x14?\x92JAI\x85\xceS\xc7\x1f\xbcg\xa3.I\x931\xc3\xff.\x90\xe4J\b\x89\xc4\x19\x90\xd9\x0f=\xf0\xa1Ϻ\xe9H\x9d\xfaA3\xcd\xfb~\xa55\x8c&\xed\xf0\xd8\x11˼,\n\x14\xdap\x9e=8\xb8\xc5\xe6\xbd|d\x95@2\xf8\x891y\x98\xdf,\xfe\xb7\xad\x8e'C\xa0\x14\x1aF>\xe5\xa9\xd1\xf0\xe9\x84\xcf\xf24\x0f`J\xa3#y\xa0\x9aHT\x91bPc\xfcz\xc5_H\xc1v\xaf\x8acc\xed\x04#ǈ\xb2(Qr\u009f\xb54=g\xafq\xc1\xd3\u007f\xd5P\"c\x96J!#\x1f\x9e-h\xd3杖\x81ac&)8\f\xc8Y&\xa3\x91\xb9Q\"R\xa6\x19l`\xcd\xf8\x04\x93\x93\xfe\xbf,*\x86\xa6\x87\xbc\xfb\x87 \xcd\u007fa\xcc\xc1\xc4\x15\x90\x10\tZ\x05\xe9\xac\xf8\xc0\xb4\xffG\x84\x11\x93\xd0Z\x00\xa8\xea\x06J\xe8)h\x85Y\xfe֎\xf9\xf7\xd8\xed6\x18\xc7\x1ee\xb3\xc08\x8e\xec\x84'1\xf7n\xf7\xc1\xe5bꃟ%$N,\x91\x14d%\xf9\xb3\x96\xf8*\x9eW[uU\x927\xc5,\x16\xda\ajVC\b\xc0\r\u007f9\xa94NO\x1e\xa2,+\xc4\x18\xd1\xf7\x1dF\xceЈ!`\xb7\xbf\xc68t\xd0R\xd1g\x80Q\x1a!\x04\xcar\xb2®\xaa\x02\xcb\xe5\x12\xed\xa2!$H\xab\xc9ۂ\xa7\u007f\xc1\xab\x9c\xban\xb0X\xb4$\xc1U\xec\uf7ed\x80'\xc2m\x92!'\xd2d\xf0\x1ev\xa4\x15\xc0~\xbf\xc3\xc0\x064\x8bv\x01\x04\x8f\xa2(\xb0X.\xb1>:\xc2\xe5\xe5\x05.^_\xa2\xef;\x9e\xa0\x05*)Qʀ\xb5\x8eغ\x88+/\xb0\xf1\xc0.\x10\xab\x9ed\xaa\x1a\xfd8\xe2?\xfbg\xff\f\u007f\xf4\xe1\x87\xf8_\xfd\xc7\xff1~\xf8\xbd\xefa\xe04ʻx\xb19\x06<\xa9|\x84 \x97MV\nM\xa4ݹ\x91W@8\b\xee\xc1,Z@\x1c\x06\x9f\xa5\x84\xccCJ\xc0\xdd\xf7*\xdfs/_\xbdğ\xfd\xe9\x8f\xf1G?\xf93\xfc\xf1\xa7\x9f\xe0\xd9\xe5\x05l tT\v\x8a\x8c0B@A\xa04\x1a˪\xc4ɢ\xc5\xc9r\x91\xa3\xba\xbb\xfd\x1eϞ\xb9\x9c\x129/\\'Fa\xa5\x81\v\xeb\xd1\xc7\b\r\xb20\x16\x02\x18\x1c)]T\x8a\x06\xe6\xa1IJ\xd5Yg\xc7\xfd\xd0\xdd\x17\xb4\xfb\x06\xe0\xeb=\n\xa39\xd7ܱ_y\xfb#%\x15\x84\x14p\xd6\xc13Y\tB21\xce3\x04?\xc1\xa9ZH\x18\xa3I\xaa\x95\xe1Z\x86#\x99\x1c\x18f\xda\xdd馢\"P\xf1D3\x0e\x03\\\xf0(M\xc1~眄\x06\xb2\x9e\xf5lg\x1a\xd9|#\xed\xb5\xe3\xcc\x02,䌁p\xc7\xe4\u007f\xf3\x84\x01\xa7\xd0\xd1A\x99\xcc}(\x05M\x92\xd7\x01w\xd5j6iie\xa6)\x9b\xa7\xa9\xaf]\xfc\x85@\xa1\xd8\xeb=2\xc3>\x11\xefb\xa4,xF?\xbc\xb3\xe8X\xf7\x9b~o-)\x19Дe\xe6\xfeӔGӴs\x0e\x81bB\xa7\xf7\x9ay\x1d\"\xf1\x1c8}.\xc9\xe2\x0e\x98\xffl\x85\x9ba\xc58\x91\xe4*\xb6\x0e\x0e슘\xbe\xbf.*\x86\xfe\x0f\xe3\xa6\xd3g!D\x9fe\xa225W\x8cR\bDf\xde\xd3\xc1L\xbf\u007f\x83\x83\x04\xb6\x10\x10<\xa5\t\xd6\xed\x12!\x06l\xae^Ss\x10<\xbc\x1b\t\xe1\xc8\x1e21\x1b\xef\x00\x02!\xd8\x1bΓ\xbc\xd2\b\x913\x01&\xf8?5bB\x00uQdß\xfc\xb9a\x1f\f7[\x97\xe4/\v\xa0\xa9[\x1c\xadϠ\xa4\xa6&)M\xbb1bt\x03\xb6\xbbk \x06TZ\x93\x11\x8cH\xb9\x02d\t\x1bc\x801\x06\xed\xa2A\xbbhQU%{\xbe\x93\xb4U\xa4\xe9_*(\xa5\xa8Ih\x170\xc6@ij\xe0\x13\x1f@0\xc7EJ\x99\xd9\xfb\xc9)3D\r\xcf\xd6\xd2Z+\x94e\x89\xfdn\x87\xed\xf6\x1aB\x12\x17\xc1:\x92L\x96e\x89\x87\x0f\x1fa\xbd>\xc6\xe5\xe5\x05\xae./\xb1\xdbmɘKJ\x14\"\xe2X\x04,uĵ\a^Y\xe0\xb5\x03\\\x9cE:\v\x81\xff\xd7\x1f\xfd\x11>y\xfa\x14\xff\xeb\xff\xe4\u007f\x89\xff\xe1\x0f\u007f\b\xe7o\xfb\x9aL\xd6]l7\xccH\x16\x85\x8f9n\xb2T\xda\xf6\xe5\xf7J`F4<\x18\xf2\xc5d\x12\x84\x99*d\x96\xc87\x11\x05\x0f\x05VJ)\xf4C\x87O>\xfe\b\xbf\xf8\xc5\xcf\xf0\x87?\xfb)~\xf2\xf9g\xb8\xea{\x04F?K)P\n\x81Jk\xb4u\x8d\xc7\x0f\x1f\xe2\xdd\xf3S\x1c\xaf\x96X4\r{n\b\x8c\xa3\xc5\xd5\xe5%\x86q\xc4\xf3gO'\x9e\a&7U\xa3\rΥDg\x1d$\"\xac\x14\xa8\x94\x86\xf5\x16\xa3\x1bQ\x98\x92\xce\x00Eh\xabR\xea\xaa\xeb;\xf4ý\x0f\xc0}\x03\xf0\xb5\x1b\x00\xda5\x0eÈ\xbai\xeb\xa6n\u007f[\xb2\xaeݎ#\x11\xc2bLf\\\x19\x82\xf71\xe4\x1cu-%\xc1\xffz\x82\xe6B$\x05@\x9c1]\xe7\xa0]\x9a\x00\v֣w\x03\xb9\x94%\xeb[\xc5r\x97\xc49\xa0\xdc\xfa\x90\x89/\x87\x92\x9f8ɮb|k\xf1O\xd3\xe1\x01s_\xc9\x1c\x1a\x94\xb5Π\xf4\xb4\xec\xb6\xc5<\x01\xadi\xa7J\xfb~\x82Oi_/\u07b8\xeb\xbf\xcb\x0f\xdch\x9ds\r\xacs\xf0a\xd2\xdc\v\b\xb2\xcfU\x14:Գ\xc5m\x9a\xfa\x94\x10$\x8dԚ\x8ażpE\x9a˂\xf7\x101\xc1\xe6\x91\u007ff\xc1?_\xf1\xf6\x91\x0eSj\xea\x1cO\xf2\xd3\xee\u007f\x98\xa5\x16\xa6)Y\n\x8a\xfb\x95B\x90# \x93\xef\x94Rh\xaa\x86\xa0\xfd\x1bl\x8b\x94_\x1e#\xb2\xb9R\x9a\xfeS\xec\xafbre\xfa[eU\xc3\xf0\xf4,\xd8W\xc1{\x82\xaa\x97\xcbc\x94e\x8da\xe8qy\xf1\x12M\xbb\xc0ȩ\x8c\xb9(\xf0*$\x86\x1b\xeb\xaa\x18o\xad\x83\x0e\f\xaan\xbc_\xa5֨\v\x93\x91\x02\xc1ӥ\xf3.\xcb<S\xe0N\xca]\x90Bb\xb18\"\xf3\"P\xdco\xe6U\xc0c\xbb\xbb\xc40\xee\x893\xa3\x15q_\xd8\x1e\xd7h\r\xadI\x02ش\rs`\x14f{\xb3\f\xfdK!s\xf1_,\x96t/)\x05\xc9\xd2\xd0\xd40H\xb6\xc2\xce|\x87\x1c\xbeE\x8c\xb3\xd4\x14\xa5\xe6l\xb1XP\xa3\x10\bm\x91\xe3@Q\xbf\xc9\xfe\xb8,\xf1\xe0\xc1C\xacWk\\]_\xe1\xe2\xf5+l\xb7[2\x1b\xe2\xe7^K\x81e)p\xa4%>\x1f\x03\xb6\x9e\x15\x03B\xa0.K|\xf6\xf2%\xfe\xb7\xff\xc7\xff\x13\xfe\xc9\xff\xe2\xef\xe3o\xff\xe6\xbfG^\x147 x\"\xf9)xA\xf6\xbc1\x04DF1<\xaf\xaa\xa4\x94\x90\a\xc1Z\x91\xd3!\xe3\x9d\xefgZ\x01\x84\x10\xb3+\xf8\xfc\xefKP\xbeCR\x1c@H\xbc|\xf1\x02?\xf9\xe9\x9f\xe2\xc9\x17\x9f\xe1O>\xfc%~\xf2\xe4\vtly^\n\x81F\n\x9c\xd5\x15\xceV+,\x8f\x8e\xf0\xee\xbb\xef\xe0\x9d\xf3s\x94U\x99\xf9\x19\xe9sg\x8a\x12\x8b\xc5\x02u\xd3\xe0_\xfd\xcb\xff7^\xbf~I(\xe6̧\xc09\ac4j\xad\xa8\x91W\x02\vc\xf0\xca2\x17\x87\xcf)\xa3\fIq\xa5\xba\xba\xee7\xb8\x97\x01\xde7\x00_\xeb!\x84\x80a\xaf\xf3\xd1Z\x9c\x1e\x9f\xfd\xae1e]\x98\x82\xc3I\xf6\xf0I\xce\xc6L\x9b\xc0;}\xe7\x1c| y\x99R\x12\x86\xe5kHY\xe4\xce\xcd$Y\xb4\x9bK\xc8\\\"\xb7\xa5\xb4@\xcfѱJI\x94\xc6\xd0\xce:\xc1\x9b\x82\x82[\x12\xc35\x11\x960'\xfc\x89\x89\xb1\x1f\xa2\xbf\xed\xb3\xcf\xc4 1\xf3\xfaOnt\aik\xb3\x02DF8!\xbf\x86\x14\x15\x9bL\u007f\x1c;\xe6%\xefzq\a\xb9\x0fw\x16\u007f\x82C\vmr!q\xc1\xce\x12Q#\x85\xff\xb0{\xdeh-E\xee\xf2\xeb'\x82\x98b\x9dx1\xa5\x1b\xb2\x9ci\x82v\xddL\xcb\fJodM\xf1|\nL\xa9b\x8e\x1b\xbd\x04\xd9Sn\x83\xbb5%WEE\xa4\xb6\x10\xb2\xe7\xbf\x10@]\xd6(\x8a\"\x17^-\xe5\x01\x1f!\xf2\xea@+\x9d%\x9d\x13i)@\x8bC\x10\xbdi\x96Ԩ0\xfc\xef\xc6\x1e\x96'\xe9\xd5\xd1)\x84\x94\xb8x\xf9\x14\xfd~\x83\xd5\xfa\x18]\xdf\x114\xca\xeb\x94\xd4\xf5\x05\xe6r\b\xe0\xf6\xfe?K<\xfd\x9d\x8d\x9bQ\nuYB)\xa2\x9ag\xaf\x00\xef0\x8c6\u007f\x8ffr\xac\x0fD\x16-t\x81\xa3\xf5)\x8a\x92\"\xad\x87a`!\x84\xc0\xbe\xdbc\xb3\xbd\x84\f\x01\x95\xe2\xe4\xc5\x18\xa1cd\xdd~\x89\xa2\xa0\x88\xe7\xb20l\x8b\x1b\x0f2\xae\xa5\xa4\x06P)\x8d\xb6m\xb1\\,\xc9؉\x8b\xbfV\x8a\xb2+\xd8\xe3AH1\xbb\x1ed\xbe\x13\x02!@\x11~\x82\xc8Y\x19\x02\x00U]\xc3Z\xce\b\xe8z\x8c\xde\x1d.M8\x85n\xb1\\\xb1\xbcS\xe2\xea\xea\x12n\xb4\xdct\xd2`p\xa6$V\xb5\xc0/{\xe0\x95\x8bY\xa4W\x1a\x83\xa7\x97\x97\xf8\xdf\xff\x9f\xff/(\xa5\xc2o\xfd\xfa\xaf\xc1d\xc7H\x91U*\x82\t\x91\xc1%S\"Gdd\x00\xf0\x0e\x02\x1a\x81\xe1y\x91\xa7wZ7\x86\x80\xc3L\x888\xa5\t\xa7k\"\xf3MK\x9fc\xa5ؙ\x90\xf7\xfd\x9f}\xfe)~\xfc\xc7?ƳgO\xf1\xc9'\x1f\xe1\xf3W\xaf\x88\xa8\xa7$*!\xd0(\x81\xc7\xeb#\xbc\xf7\xf0!\xce\xce\xcf\xf0\xe0\xe1\x03\xd4u\xc3V\xcfD\x82N\x9f\u007fk\x1d\xa1\x9c\xdeC)\x85\xef\xffگA\xfcR\xe0\xe2\xf5\xebDG\xccÒs\x8e֟ABzBYc\xb4d\x98\x15\x03 \xe8\xbdf\x92\xef\xd5\xf5\xeez\x16vu\xdf\x00\xdc?\xbeʅ\xe2\xc3\"\x05\xee,\x96\xcb\x1fIE\xfb\xc4\xdd\xe6\x1a}\xbf\xe7\tM\xb2\xf3T\xcc!\x14֑V_\t\t\xc3p}\x9a\xd6c\x8c\xb0<=\x8a\xac\xa5%\x8d.\x158\n\xf1)\f\x11\x9f\xb6l3\xdaV\x15M.\xbc\x0fU\\\x90\x1dۘ&\xcd\xed\xe11\x1d\xf9 '\xdb[q\xc76q\x1e\xa1L+\xff\xa4\x9d\x95\x99 %\xa5̾\xfeI\xab.%\xef\xcaS\xe1V\x94\xb27\xfa\x91\x1c\xdf\xdc\xe1\xe4\xf8\xa5\r\x17\xa8\x00\x16\f\xf9\x8e\x9ea\xff\xd9_VJ\xa2\xaaJ(vQ\xcc{\xc2Y\xf1W\x92d@E>0#3\x81C.z$a\x8by\x02,T9krfi\x8c\bl\x11;\xad\x1f|\xf0\xe8o\xac\x03\\\xf0\x90R\x92\r\xb1$\xf2\x9d\xe3\xe6P+\x83\xba\xacsν\x92j\x82Y\x99\x9d\x9f\b|\xc9Zy\xb4\xb4v\xd0\xe4\xe04I@\x11\xa1\x8dAY\xd7\aD>kG\xd8aDU7X,\x8f\xe0\xac\xc5\xcbg\x9fCq>\x80u\x96\xd7\x1b\xd3\x1b\x9d\"\x98\x11#\xb98z\u007f\v9r\xde\xdfZME\x00ZH4e\x81B\xab\x83\xe2Kɑ@]\x94\x90\x82\x9a%B\x05\bb\xf6\x92\x9a\x97\xd5\xea\x04J)\xb8q\xa0Պ\xa0\x06\xe4zs\t;t\xa8\xd2g\x8bÚt\xa5\xd04%\x8cV\x93<\xec\x96\xfd,O\xfe\x1c<\xa5\x8b\x02U]3\xe4Oh\x806\x86H\xa5Fg\xd9\xee\x9c\xc0)dD\xf4\x12Bx\xb8\x18\x0eP\xb99cF*\x89\xa2,Q\x16\x05\xb41\x18yՓH\x95>\x91{cDQ\x94X\xad\xd6\xfc\xe7\xc4w\x10\x02p\x91\t\x94J\xe2{\x95@\xb7\a\xba05z\xa5!r\xe0\xff\xe1\xbf\xf8g\xf8O\xff\xe7\xff3|\xf7\xdd\xf7HҨM\xe6\xe7P\xae\x86\x84\x91jJ4\xf4\x8eU\r\x1aA0\xea3\xcf\x06B8\xb0\x00\f\xb3\xf9>yueih~\xd3\x03\xb4dJ\x1d\xcbf_\xbdz\x81?\xfb\xb3?\xc1\xf3\x17\xcf\xf0\xe1G\xbf\xc0n\xbbE\xa35 \xe9sTk\x85\xc7'\xc7x\xef\xf1;8:>\xc2\xf1\xf1)\xb4\xd6\xe8\xbb\x1e\xfd0\xb0b\x87PKZ_%\xb9\xb2\x82s=\x8a\xa2\xc4w\xbf\xfb=(\xa5\xf0\xfa\xf5k:\x97\x92\x91\x147bJIt\xa3E\xe7\x92\xe595\xb4\x9a\x15L\xbcB\xb8\xba\xda\\\xdd\x17\xb4\x19\x8as\xff\xf8*\r\x80Vy\x17\xab\x8dA\xd3,~7\xf9\xc2\x0fC\x87q즽3\x93\x92B\fl\xfb\xeb\x19\x8a\xe6\xe9?\xed\xe3A(\x81\xb76\x13\xee&\xe2\x1d\xb2Gv\xc1^\xf7\x03\xe7\x04T\xa6 \x93\v\xa9\xb2\x99G\xea\xd2Gg\x0f8\x03\xb8Qv\x83\x8fS\xd2\\<<0!E\xde\t\xd3\x01\xe5\xb8ᡛ\x8bv\xa5\x87\xb0u\x92\xfc\x91?\x01\x17ff\xf8ZgIژR\xe7\xee\x9c\xf3\xef^\x05H\xa5`\xb8Qr\xde\x13\x87b\xaeSbV\xbfᰥ\x81\xe5b\xe0\x9d\xbd\xd6\x1a\x86_kQ\x94\x1c\xedK\xb2#0\xb4\x1b\xb9a\x9a\x1b\x13\xa9Y\xda\xdf\xe4\a@\x13}\f\x91\xd8\xf3ɟ=␌\x98\xd6>!\xa04%O\xffԘ\xa4\x83\xaa\xad)\x828\x15|\x99tY!Nq\xb1\xbc\xd7W\xac\xfd\x1f\xec@\xefr \u05fd\xb9j\x80P\x86\x92\xaf\x8d\xa4\xf7\xccZ\xf8\xe0\xb0>>\x836\x05v\x9b+l7\x17(\xab\x96~\xdf\xe0\xef\f\xf6I\xf6\xa8އ[\xfb\u007f\x1f\"\xdc\x1d\x9c\x00)\x04ʒ$\u007fr\x06#\x85\x18\xa1\x84¢\xa8\xb0\xaaj\xac\xaa\x06\x8d)\xb91\xa5\xe5\xbf\xd1%V\xcbc\xd4\x15\xe52\xf4}\x87ȇ\xfa\xf5\xee\x12\xbb\xdd%4;;\xd2\xfd P\x18\x85ʐ!S`\xc9\xe58:\x9e\xf6b^y)\x0e\xd9\xd2\xcc\xec\xd7JfYgQ\x140E\x81\xb2\xac`J6\x14\xcaj\x0f\xc1r@\xe2\x06(\xa3\xc9G!5\x83\x02w\xdeWZkh\xcey\x10s\xc3\x1an\x9a\x92\x1f\x86\x94\nUUa\xb1\\`\xb1\\0\xaf%\xb0\xadr\x84\xf5\x01\xa5\x00\xde-&\xa1n\"\xddi\xa5\xf1\xd3Ϟ\xe0?\xfb/\xfeK\xfc\xe4\xe7?\xc1fs\x8d\xa4\xa1\v\xc1#\x85\xf2&\x9b\xf0\x18\"\xc0\xc54\x04\"({\xbe\x8f2\x8f`\xa6\xa2\x992\x14\xe2\x81Of\xf6\f\x8e\x011XH\x112\x89\x10\x00\x9c\x1d\xf1\xf9\xe7_\xe0嫗\xf8\xf8\xa3\x0f\xb1\xddn!\xa4@k$\x1e\xb65\x1e\xb65\xbes~\x86\xf7\x1e=\xc6r\xb5D\xbbXP:jߣ\x1bzt}\x8f\xe0\tAT\x1c\xb5\\\x96Uv\xf3\x94,\x91\xac\xea\x1a\xdf\xfd\xde\xf7\xf0\xee\xbb\xef\x12\u007f#\x11d\xf9\xfc)\x8b\x82,\x83c\xa0\x01jfz\xc6\x04@\xb8௺\xa1\xbf/h\xf7\b\xc0\xd7{(ֳ:\xef\xd14\xed\xfbeY\xfe%2Q\xa1\x06\xc0\xa7)P\x1c\xc6\xf7R\x81L\x05T\x92,i\x063R8\x8b?\xf4\xe7f\xe7<\xcd{I\xc5\tp\xfb\x9e\x99Ѕ\xc9\x04\xb7T\x14\x13[\xd99wG\xf1g\xf08\xc4Yt\xe7\x8c@\x94a\xee\t\x95H\xaf?\x15\u007fÖ\x9a!\"O\xff\x89D\x98\x8b\x18\xbf\x0e%\x15\\p\xd9jv.m\xfcj\xd7Ze\xed;\x05\uec2b\xde<\x00H\x19\x94\xa6\xccR1k-rT\xa8\xd4(\xd8\xecG)\x8d\xaa\xaa\xf2\x01\x9e$^\xe4H\x974\xfb\x11\x12D\xba2\xbc*\x98\xdb\x01S|+;\xc2y\x97\x8f}\x17\\&}\xe5F\xc1\xd3\xf5(\x8b\x12B\x00\xe3\xc0\x19\a\x10\xa8L\x99\x8d\x85\x9cw\xd0RO\x8d \x92\xfbc`\xae\x88b\x97CB>\x8c\x10\bѣ\x96\x13\x83BJ\x85\xaan\xf3\x01)\x84\x80\xe5\xa0\x1fS\x948::G\x8c\x11\x17\xaf\x9fR\xe2\x9e)0\x8e#\x10\x91\xb9\x1aS\f2+!\xb8\xb0ޔ\u007f\xf9p\x9b\x13 \x00\x14Z\xa3\xd2\x1aJr6|\xf2P\xe0I\x94\xd2\x1e\xe8\xffJe(\xe52x\b%\xd1\xd4k,\x16\xc70EAʕa@DD?\xf6\xb8\xba~\x8d\xe8\x86l\xdb[h\x8d\xc2h\xf6\x16 K^/\x01\xb8d\x16\x148\x11\x91Y\xdfFS\xd3\xceNpUYR\x06DQ@ij\xf2H% \xa7\xb6\x94\x99q\xf9\x9e\xe05\x8f\x10\x80W\x1aR:H\x19f\x01W1\x13(\xc9\xe6Z\xe7u\x02)\x82\xc2L\xe3>]3\xa5\xa8X-\x9a\x16v\x1c\xb1\xdbn\xb3\xf7B\x88\xb4\x1e9\xd5\x12W\x06xn\x89-?\xf1\x904~\xfc\xd9\x17\xf8\xa7\xff\xd5\u007f\x85\u007f\xf0\x1f\xfe\x1d\xfc\xad\xdf\xfe[h\xea6\aoI%\xb2D2\x84\x89s\x93\xbd\x83\x82D\x8c\x1eR\xeaI\xe5@\xb4\xc1\x03\xc7\xc0y\x1c\x80@\x84\x88\xf4\xfe+\t\x10u$\x01\xf0d\xb5}qy\x81_\xfe\xf2\xe7\xb8\xde\\\xb3\xe5o\xe4\xd8o\x85\xa6ip|\xb4F\xb3\\`\xb5^\xa30\x05\f;/\n\b\xc4\x15\xdd7\xce;8\xebr:#\xf1a\xa6\xb5D\b\xe4\x88\xf9\xee{\xdfAY\x94\xf8䓏hP\xe1\xac\x15\x15\x04*\xad\xd8\"\x9d\xef\xd3\x18\xf2ZS)\x8d~\x1c\xae\x86\xf1\x9e\x00x\xdf\x00|]\xa8\x84\xb5\xd81F,W\xeb\x1fI\x9e.\xbc\xb7\x04\xff\xfb\x90\x9d\xa6F΅\x0f\xc1\xc19?\x05\xe6h\x92\x11&\xa2KN\t\x8c\t\x96\x8e\xb98g\xe62\x93\xd8\xf6\f\x91-\xea\xff?{\u007f\x16kݖ\x9d\x87a\xdflW\xb3\xf7>\xfd\xdf\xde\xfeV'VI$%RT$K\"E\x99j\\\x82aXT\xe4\xd0(E\x0e\x1c\xf8!/\t\x12\xe4!Ѓ\x11D\bb\x19\x02\x02(A\x1a\x04\xb6\xe1 \x88b\xe5%\xb1\xad$\x8e%\xcbVC\x91\x92E\xb2X$Kdu\xb7\xeav\u007fw\x9aݬfvy\x18cε\xf6>\xff\u007f\xc9*\x92\x0f\xae\x9cM\x14\xebV\xd5i\xf6Y{\xad9\xc6\xf8\xc6״\xb3\xdd>I}r\b\n\xc9\xf0\xd2-\xe8\x96X\xddi?h\x04\x98\xe5c\x17\x8f\xad\x02ag\x94@J\xc0j\v\xa5H_\x9f\xff\xfb\xc4{b\xcc\xe3=S\xe2&\x81\x1eJ\x82>\xc3\xec\xb0x5\xdc?\xc1\xa9$\xf5R\x92 \xe4\xd1\xe5=\xe9\xf4ET`\xc9\x0f \xc5H6\xac\x89\xcdq\x94\x825\xbaL\x9au\xd50\x03xbKG^\x85\xe4u\x89\xe4]1\xa1\x0e\xb6\x10ղ\xf7A\n\xb9\x99sE\x06E^\v~\xaf(z\x9e\x9c\xad\xad\xa1\x15\xb9\xfeQZ Mem\xddBJQ\xb4\xf6R\xcb}~\x01\xdf\x13F\x13\xb13%r8\xcb͕b\xa3\xa2\xdch\x1a\xada\xabf/\x1cɻ\x01\xc1{\\<x\x88\xba]\xa2ۮ\xf1\xfc\xe9G0\x9c\x05@9\x02\xa2HF\vi\xd1SX\x94\x90\xe9֞?F\xb6X=\x80\x0e\xf3\x01\xaf\x8b\xe1\f\x91\xfa\xb4\x94\x90i*(\x05\xb1`\x95K\x12\x12\xc66X,\x8eѶK\b\xa9J\n_J\x117\xebK\xb8a\v-\x80J)TF\x17\t(bB\xf4\x1e\xc9\xd0\xf5\x15\xec\x81_V\x1a\"\x15R\xac\xe6ϳ\xae*\n\x86b\xc7@)2\xe9O\xee=\a\x02/!\xa1pS#XFK\xe1[\x01>G\\s\x130\xa1\x0f\x84\x16\xc6\x18\x90\xfcm\x1eQ\xfew\xa54\x94\xd1h\xea\x06\xc1;\xb6F\x0e\\|\x05\xb4\x14x\xa7\xa2\xcf\xe6\x99\xc3\x1ewF)\x8do=\xbf\xc4\xdf\xfd\xaf\xfe\v4\xc6\xe2G\u007f\xf4\xbf\xc5\xf4\x9e\x88\x94\xef\x8f\xc4\xd3D\xca\x0e{\x92?5\xde\xef\xc7@\x0f\xf7\xcc\x0e\x98\x80\x99L@b\xc8\t\t)\x06\b\x11y\x9d6\x03\xe1\x84\xc0\xe8=\x9e>}\x86/\xff\xf2\x97qu}C\x9c\x19.\xfeR\bXk\xb0\\.qq~\x0f\x8f\x1e?F]Uܘ\xa9ry\x89C\x14 \x1d}6p\x94\xb7\x18\x19fSRN׆=F\x1e<|\x88\xedn\x8b\xeb\xab+&\xc8Rd\xb4b/\x05\x840\xadr\xf8|\xd3J\xe3j}y\xed|\xbc+hw\r\xc0w\xcb\x01\xa0)Wi\x83\xb6]\xfc\x19)$\xb4\xb1\xe8\xbb-\x86nW()\xa4\xe7OPJ±\x04\xd0𡣭!\x92\xd4l\xbf\xeeG7\xd7\xe4\xf0.ZrӠx\x9a\x0e\xe8\xc7\x01MUCk\xb5\xd708\xe7X3+f\xbb\xc6\xd9\xd9\xc56\xa7\xd9\x13`2\x03\x11\xe5\xe0+\x87X\fEםk\x8a\xd1db\x93\xad{\xe7\xbb\xee\xfcu\xb9\bf\xe3\xa2\xfc@\xc7[\x841\xf1\x89\x99L9\xd2UJI\xbaq7\x926\xfa\xe0d&b A\xfa\xb4f\xf0\xc5\x13>s%\x00\xc0\x182\x06*`\xea\x01\xa1\x8fP\x83\xc4Ӿ\x80\xd6\x16Z\x11\xe7bڏǒ\xe6Xt\xffY\xd5\x10&3\x91X\xd8ҢD\x13\x0fn(\xbc\x85\xdaR\x02!\xb9\x05:(%\xcb\xe4\x9eR6fI\xa5\xe1\xcb(\xc1\x18\x1c\x19\xed\xc4\b+'Rba\xb0s!\x17R\xb0\xcd\xee\b\xad\rN/\x1e\x02\x10x\xf1\xecc\xecv\x1b\x1c\x9fޣu\x14\x1f\xa0\x19\x85ʲübI̋\xd8\xdf\xfd\xef7:\xd9_\x81\xf6Ͳ\xa4\xbb)\xa9`\xa4f;e\xc1\xa1<b\xaf\xd1\b\x89\\\x15\x9bf\x89\xb6Y@I\x057\f\xe8\xfb\x1dB\n\xb8٭\xb1\xde\\B\xa6\x80F\x1b4\x96\xb8\x05Z\b\xc0\aD\x91\x00\xad8:X@[K\\\x00\x0e\x9a1\x8c\x14XV\x8eT\x15O\x9b,\xe9\x9c\b\xa2/\x8b\xac\xf9d\x12\xb0\xc0\xcc\xef~\x96Ց\x1b\xf7\x1c\x84%x\x056'\xf2\xc6\xfc\x0f\xd9Փ\x9b\tm\x14\x946\xd0)\x01I\xd2\x1a(F\x04\tX%\xf1\xe9:B#\xe1c\x87\x12\xe6\x93\x04\xb02\n\xfd\xfa\x1a\xff\xd5?\xf8/\xf0\xe0\xc1C\xbc\xf6\xf8\r\xba\a\x12sJ\x10\x8bU0E1\x04\xc4$@ǘ$\xf2^\xa2\x82/f\xba\x9c,\xcd\xe4\x9b\x12)\xba\x92\xb1 d\xdakbb\x8a\xb8\xbe\xba\xc6\xcf\xfe\xe3\x9f\xc5\a\x1f~\x00\xa5$b\xf2\xec\xab `\xab\n''gx\xfc\xf81\xde~\xebm\xd4U\xb5w~\xe4\xfbV&\xc1\xe4Q]\x12T\xa5\x10\b\xcac\xe8\xfbb\x9f^<\x0e\x10\xa1\xa4\xc5\xf9\xc5\x05\xfa\xbe\x87\x1cI\x81\x14\xbc\x87\x88\x0eVeI\xa7\xdc[\x1bJ!q\xbd\xb9\xbe\x9e7cw\r\xc0\xdd\xeb\xb7\xcc\x01 \x12\x8fUƘ?\xaa\xb5\x81\xd6\x06}\xb7\x83+\xeen`\x0fx: F\xe7\x8b\x1d\xabR\xb4\xcf/\x89Y)\xc1sb\x9c\xe0=V\x91\xc8\xe5]$O)\xbb~KZok\xb9\x03\xa6.\x9fBR\x12Msi2\x8f)\xd0.\xa6uD\xc9\n/\x0f0\xf6\x98\xfe\xb9\xc8\x152\x15O\xd3$\x87˚\xf9)X|\n\v\x9a\xb2\x02\x84\xa2\xdd/\xed\x90o\x17\u007f\x88\xdb\x19\xe1e\xaa\x11T\xbc\x15\x9b\xbf\x8c\xbc[\xcfD\xa3)|\x90s\x00\x04\xf11\xc6R\xc4\x05\xacV\xc5\vA)\xcdzbY\xecH\x8b\xb4+q^A^\x97d\x99\xa5!\xed\xff<\x10&$BC\xb2\xee_\xf0J`p\xaex\xb3Sd-\xfd\xcdF[\x18c\x10\xd3\xc4\xfcW\x8a&=\b2)\n)\xa0Rv\xda 3\f\x9fx\xc2\xcfҿls\xac\x04\xcd0\x86e{yW\xaa\xb4.H\x81R\x8a\xd2$݈\xb3\x8b\ah\xdb\x15\x86n\x8b'\x1f\u007f\x87t\xd0ưO\xc3\xe4\x03[\x10\xa7D|\x0f\xa4\\\xc4&\x8a[\x9cE:\x1f\xee\xfe\xad\x92\x9c\xc0Ʀ<ʐ\x97\x85\x14%{a\x1eB5\x86\x00/\x80\xda\xd4h\xec\x02\xc6T\xf0~D\xb7#\x99l\xd7w\xb8\xbc|\x82\xe8\x06,\xb5Bk-M\x8b\x8c\u0094\x94D\x01H\xa1PU\x96\x88w\x8a\xd6bm]\xa3\xa9\xaa❠ԡ\x96\u007f懗~k\xc5?\xdf3\x99\xf1O\xd1\xdbL<\xe3\xd5VFn\x82\xf7\xc5t+\x84X\f\xf3\xf2\x9aeo\xdd\xc6\xd70\xdb\x13\x93\\\x96R\x0e3!ӥ\x04\xad$\xdem\x13\x8e\x1c\xf0\xdc\x03cL8\xd2\x02\xafY\x01\x9d\x80\xcb\xebK\xfc\xf2W~\tm\xb3\xc0\xf1\xd1\t\xa2Ά=\x91#\xa11\xc9Uc@\xe2F(2\xffDB\x16K\xe1\xfc\xef\xd9\x1f \x86\x11\x02\x91\x87\x055\x9dMl\t\xde\xedz<y\xfa\x14O\x9f=\x83R\x8a\x9bO\xb2 \x16\x108Z\x1d\xe1\xe1\x83\ax\xfbͷ\xd0\xd4\xf5^\xac9\xb9\x11\xa24M)\xd2٤\x84\x02\f!\x9c\xde\x01^)h\xad\xe0=H\xaa\xc0\x17od\x83\xab\xc5b\x81\x1b\xef\x00H2]\x8b\x91̅x\xc51%\xa9\xd2\xfd\xbeޮ_\xdcU\xb3\xbb\x06\xe0\xbbze\xe8/\xa5\x04c\x9b?,\x84<\xcb\xfe\xe3]\xb7\x81\x0f\xe3\xb4c\x0f\xa9\x90\xbf2\x93Z\"\x11\xfbؔ<j\x82\x94\x87\xb1t\xd3YzC\x12\x1d\x92\ri\xa9\xe0\x82\x83\x0f\x01\xab\x92\x16\xc71\xb1\\\xfc\xca$Ʉ\xbcãk>\xf9ϻw\xf0\xe1\x9c\xd3\x01\xe7\xc5?\u007f\x8d\xd1\x04\x87\x87\x94\xca\xde_\b\x01\x1f\xa7|\x81\xc8\x046\x91\x93\xfe^U\xfc\x91\xf3\x04^\"\xff\x13\x82\x8a\xbf\"H\u007fp\x8e\t}\xe2\xb6\xd6\xdcR\xe3\x85D\xd3\u007f\xbe\xc6Z3\xe1+\xefJ\xad-$\xa1\x1cW\\&u&\xbc\xa5\x99\xf9\x8fR\nZ\xdbR\xb8\xf2;%\xf8\xdbM\xb08k\xe1]\x98v\xff1\x06\xa4\xe8\x91 \xa8\xe9\x10r\x9a\xfeA9\x00F\x1b\x84@\x12\xce\xca\xda\xc9t\x88\x91\x94T\x94\v\xbcΉ\x01#\x1b\xff E\x18A\xe6?\x19\xfa\x17\"\xc1\xdaz\x96\x0f\xa1\xe0\x86\x1e\x02\x02\xa7\xe7\x0f!\xa4\xc0\x8bg\x1fa\xb3\xbe\"\x87Ĕ͛櫟i\x92\v,=\x8ci\xdf\x12:\x84i\x0f+\xf6\xee\r\x85\x86\xd1\x1a!\x04*\xa9\x99\xe4\xc6Em>9'\x81\x90\"\xc6\x10\xa1\x8cE]\xb5%\x94)\xf8\x80(\xc8#\xe2\xea\xe69\xfc\xb0E\xab%Zka\x94\x84,\xcevt}\x94T\xa8\x8cE۴hښ\xfe\xbd\xaa\x99\x18+g\rʴ\xaaI\"\x16\xd6xҩ\xa0-\x92?\u007f9s[ĬI\xc82\xd1\f\xa9\x11\xca\x12\xf7\xbc\x00\"P\\\"G7\xf0\x19p\xe8\u07ff\xffOif\xb1\x9b\x8bԜ8\xa8\xa4\x80\x14\x9a\x88\x991AB\xe0\xbe\x11xPI@\n\x18\x99m\xc65\xbc\xf7\xf8\xd6{\xdfĽ\xf3\xfb\xf8\xfc\xe7\u007f/\xa0\x18\xf1K\xa1\x14\xf3r\x1a\xa4Hp\xbe\x04\x91\x87s\xe3\x19#GiM\xe4\xbf\x14=\x90\x02O\xe4\x8cH\xaa\x89G\xd3\x0f\x03\xae\xae\xafa\x8c\xc1k\x8f\x1e\xe1\xea\xfa\x05R\"uEL\x11Ue\xb1\\,\xf0\xf8\xf1c\"\xa2v\x1d\x86q\xa4玹H!\x90\x0477\x9d\x14\\\x16\x91\xb8\xe1$\xdf\t\n_\x1a\aj\xa6\x8d\xd1t\xdfĀ\x14\"\xea\xba\xc1f\xb3\xc68ve]\xd6{G\xee\x873\xc4EI\x85a\xec\xb1\xdd\xed\xee$\x00w\r\xc0wO\x00\x94\xbc\xfc\xd2\xd6|Q\b\xda\xe7{\xef\xb0\xdbmJ7M2*A\x11\xa4\x83\x9bI\xfb\x04\x8c\xa9&\x06}\x02%\x9c9_\xe0\xe7\xbd\xfd<\xef\b!\x04\x15\fCF7>zN\xa4cx<\x04T\xb6\x9a\xe5\xc4\xfb\xd2\xed\xd2\xe1\x1dʴ7uޢȝ\x04k\xf4}\x98\x91\xf4\xf2\x03\xc3\x1c\x87\xec\xf5?oTH\xcfO\xe4\xa1ܹKA\xd3\xf2!Y\xec\xe5\x16#{m\x01\f篧\x04\x8c\x9e\x93\xe2^\xb2\x93UJ\xc1r\xe3\xe5c\x80snF6\xd2\xe5\xfd\x19C6\xbe\xc5\tn理\x92\xe6'Ą\x03kc\xc8\xeaY\xce\xcdq\xe8\xeb\xe6\xbe\xe1!F\xf2\xfbO\xd3\xd7\x10g\x82\xac\xa2\xad&\xe7Þy\tFk\xd4u\v$\xa0\x1f(\x06\xb8U-\xfb䧙\x040\xf1\xd4J2-\xe7\\\xf1M\x90H\xb0\x8a\xee#r\x9e$\"\x976\x157\xa8\xac\x16\xe8vh\x97\xc7X,\x8e\xe0\xc6\x01ϟ\u007fD\x89}\xa6B\xc8\b\xce\xec_\xf9\xfeL\x8c\xb4\b!\x10\xc3P\xd8\xdd)&\xb8\x18n}~ZJ\xacl\x05\xcb\r\x97Q\x9a\n\xbf\x98\bh\x93\xdf,9M\x0e1 J\x8dZW\xa8\xab\x16\xd6\xda)\xca(\x01\x9b\xdd\x1a\xfd\xf6\x06\xadVX\x1a\xda\xdf\vF\"\xf2\xcf5F\xa3m\x1aTl\xa3m\xb4\xa5\xe8\xe7\xca\x10̯$\xb4b\x0e\x02\x8f\x98!\n@\x90\x8a\xc4;ǌ~\xfay\x95\xad\xe0C\xc0z\xb3\x81\x1b]!\xb1f\x94\xa5\xa9kr\x98\xb4\x84J)Ax\xd78P\xa1\xf7\x10\x05usn\xe4\xac\x06\x0el\x8a\xfb\x8dD\x81\xbd\xb3\xeb\xe2l\xf2\xcd\xf6|r\x96ޗe\xc3yE\xe4i\xef\x04\xa3eqߑ\xdc8_ݼ\xc07\xbf\xf5u\xbc\xf6\xf81\xaa\xa6\x85\xd4\n\xda\xea½\x10\xb3\xee;E\xe6\x04\b\nmJ)\xd0d\xce2?9\vB\x12,\xa1\x95<d\xe4{$\x84\x88\xeb\xeb\x1b\f\xc3\x00\xad5\xaa\xda\xc2Z\x8d\x94<B\x88%\xb0\xeb\xf4\xfc\x02!\t<}\xf1\x02\xbb\xaeC\xd7\xed\xe0FG\xa4]\xef1\x8e#\x86q\xc08\x8e\xe4\x14\xe9\xfd\u07b5˄\xcc|&\n\x00\x95\xb5\xa8*[\xd4\x0248hl\xb7[B\xea\xb8\xd1\xcb\xcfFVD()\xf1|{\x83\xc1\xb9\xf7^:\x85\xdc5\x00w\xafW7\x00\xaa$I\t!\u007f\\\xb2\xedg\xdf\xed(]\r\x92\xed~C\x91\x1d\xb9yx\x87\x14\x14\xfc3\xdb+{Gr,)\xf5\x1e<\x99wUJ\xe9\"\xe9\xab+\xcb\xd2-jB\xbc\xf3\xf0n$\xc8L\xab\x99\xf2 B)\x01\xa94\xcb\xdb\xd8L\xe4\xa0\xf8s\xe5\xa2I6k\xdag\x12')\x04*N\xc1+\xd3<\x1fN>\x04\x88$\x8al)\xcb\xeebJ\b\xfe\x15\x81Bx\xb5\xf9\x0f\xb1\x81y\x9f\xef\xfc\xe4\x95\xff\x92\x9fa\x8d-\xab\x18\xe7\x1c\"2d\xae\x8a\x12A\b\x8a\xf0\xcd\xfbu\x99\xbd\xdds\xb9\x89\xf4>K\xee\x022\x9b\xbd.d\xc1\xf2;c\x84\xf3\xe3\x1e\xa2A)\x8b\xa1\x905)\xc0\x89\x1d\tmE\xba\u007f7\x16I]S5\xd0J\xc1{\x87~싹O\xfe\xa3\xd2L_N\xf9\xe5\xd4̍~,\xd7\xcd\b\x9a\x023\xd2\x01$H\xa9\x8b\xb7\x01i\xa5\x1d\x82wx\xf8\xf8-(\xad\xb1\xdd\\c\x1c\xfa\x12c\xec9\x1b`\xbe\xe3\xcf\\\x91\xc0;V\x00\xec\xd7@\xff\xec\x98Y>/\xfeJ\n\x1c\xd7\x15*\xe6\x85hE\xac\xeb\xec\x11\x91%\xb0R\xf03\x11#<gF(\xadaM\x85\xa6n\v\x02\"\x84@?\xf6X\xdf<G%\"\x96\xc6@)\x01\xc1AQR\xd2\xe4m\x8dE\xd3\x12\x92Br\xccPly-\xcb\xef\x14\xa3g\x89\x9d\x10\x13\x14d\xf1W\x88%\xb0\xa9m\x17x\xfa\xf4)~\xee\x17~\x01\xff\xe8\xe7\u007f\x1e\x1f~\xfcqɎ\xc87\x85\x92\x12m\xdb\xe2у\a\xf8\xf4\xbb\xef\xe2\xc1\xbd{\x85\x8c\xd6TUiF\x8eWK(NZL1RAe_\x89|\xbf\xed\xf1\x05\xc0\uf0e3\x96\xfḓ\xae\xa03\xb3\a%7\xa9\x99\xe3\x10\x99\b\xa0\xb9\x91\xa1F\"\xe0\xfa\xe69\xbe\xf9\xad\xdf\xc0\xa3\xd7\xde\xc2b\xb9\x82\xd4\x02\xb2\xa8L2\u009f\x10\xc1\xeb\xc0$\x00x\x88\xc0?\xb7\xec\xc5c\x91\xeb\xe6\xd5D\x960fn\xc3\xcd\xfa\x06\xdb\xdd\x0eR*<y\xfa1\xde\xfbη\x88\x88\x9b\x15F\tX,\x8fP5-6\x9b-B\xf0\x18\xfa\x0e\xddv\x8b\xcdn\x8b\xae\xeb\xd1\xf7=\xfaq`\"n\x9c\xa4\x89)\xcdb\x99g\a\x06\x93\x9a6\xdb-9\xffY\xe2&I)\xb1Y\xdf\xc0\x8dc\xb9\xdeVH\x8c\xfc,˙aً\x9b\xcb_I\t\u007fﮢ\xdd5\x00\xdf]\x03\xa0r\x82\x98<K\xc0\x8fi&ƽ\xd8\xde`\xf4#i\xd5\x1d\xc1W\xc6PL\xad\xf7\xa1\x9c\x9b\x86%G\x05V\xe6\x14?\x14\xd8/\xee\xed\xaa\x14\x93\xc0F7\xa2*\xe9w\x01R\x11Ih\x18zbT\xf3\x9eS0T+\x84\x80\xb6\xa6\x1c\x80\x98\x11z\xe7\x93?\x12\x05ո83֙}\x1d\xb1\xa5\xf5\xb4\xbb\xe4C\x88\x90\x82Lx\nejE\xd1;\xc7W\xecQ\xc5^\xf1\xcf\x04C%\x15\x8cQ%J\xd6q\xdc\xea!L\x90\x12K\xfb\xb4\x81\x00K\xe3\xf8\xf0W\xac\xf1\xce*\x01k)\xfa\x15\xb3\x95\xca\x1e\xf1\x88a\xc54q\xfc\xa1\xd4,Iq\xf6N\xbd\xdf\xf7\xfc\x8f\xccMH\xb3\xfdxL4I\x19ma,\x15\xa7q\x1c\x91R@\xa5+4U\x83\x84\x84n\xe8\x19\xb1\xa9)\xa65۬\xc5T\x9a\xae)\xcba,\xbe\xf9\n\tf\x86\xcaP\xa1\xa0P(\xa953\xeb%\xfa\xbeC\xdd.qtr\x81\x18\"6\xebk\xc4\x10\xa04\x91\x05\x8b\xc9O\b\xd0\xc6\xce\xee\xbdTlQ\xb3\xac3\x1f\xa4\x93\xed\xefD\xf2<\xaak\xb4\xd62\x1a!`\xe6͗\x14%' \"r\xf1\x0f\b\x9c\x17\xa1\xa4D]5%\x9c(\xcbj\xaf\xae\x9eC\xfa\x1eK\xcb\xfbc\xde]K6f\xaa\x8cA\xdb4\xcc\xc0\x8f\x85\xd5\xdd\xf5\x1d\x9a\xa1Fh#\xcbE\xc9<\xcb;GS+\xbf\xb7\x88\xc42A\xa0m[\xbcXo\xf0\xef\xfd\xdf\xfe\x16\xbe\xf6\xcdo\xb3㢼E\n\xcb6\xc0\xbf\xfa\xcf\u007f\x1d\xff\xf9\xdf\xfb\xfb\x10\x92\xd7\n3\xa2jS\xd7x\xe7\x8d\xd7\xf1\xc3_\xf8<^\xbb\u007f\x0e\xc3R\xdf\xfc\xb3J\x18\x17_ϜؗC\xb3rh\x15ݦ\xb2 \x01\xd9()?\x8f\xc4e\x90\xc5r\xd7h\x89\xe3\xa3\x15\xb4\xd6\xc5;\xa2i\x1a|\xf8\xf1\a\x80R\xf8Ի\x9f\x85dC\x9d\xec~\x990\x19\xf7\xd0sJ\xbf\x8f\f\x8f\xd4\x1e?!s\x17( I\x15\xe5\x84\x10\x1a\xbb\xdd\x16\xeb\xf5\x9aVp\xc1\xe3\x9b\xdf\xfa&\xae\xaf\xaf\x99욊\x1d\xb8\xb5\x15\xfc\xe00&*\xf6/._\xe0\xea\xe6\x06]\xdf\xc19\xcf\xe7E*u>7_\x05\x94˦\x8eY\xb5\x90\x89\x96\t\xf0}\x8fq\x1c\xa0@\xd9\x1f\xc1M\xf1ۑ\x17\x19\xc5֙\x9b\x17\xe7\x036\xdb\xed_\xfb\xadQ>\xef\x1a\x80\xbb\xd7\xfc\")f\x10\v\xf1g\x90\xa0\x96\xcb#\xc4ȇ,C\xa4Ώ\xacC\x16\xc53\x1e\xfc\x9f)\xf9O\x95\xfd\x1fY\xff\xbab\xbc\x11\x19~˳j\x9e\xd8$(\xc4&\x1b\x89 &\f\xe3@\x87 ?\xd8JH\x0e\x9f\tPF\xb3\xf3܈\x18Ҍ\xf5<=\xdc\xd9\xf3>\x93\xbe\xf6\xc0x\xfe:\xc3\xc1\x19\x11\x91\r4d!rI!\x8a\xba\xa1\xac/B\xb8m\xad)^2\xc2\xcfW\x1cR\x90\\\x0f\x92U\x03~\x0e\xd2Ok\v\xfe\x9d\x95\xb5P\x8a\xdc\xf3\x9c\x1f\x91\xd9\xfbJMD&\xa5(\xa0EH \xb8P\x12\xde\n\xfb\x9f㙋\x93\x1fr\x96@\x03͆B\x13\xf9-`t}\xe1:\x94\xe9?\x04&>\xd2\xde\x1c\x89\x96\fuUA\b\x89\xc1\x8d\xa4\x0e\x10@S\x13\x121\xba\x01\xc38\x10\xd7\xc1\xda\xc2\xc9(!+\x05F\x97,\x1dte\xefn9\x9b@H\xc9M$\x1d\x88\xc6VE\xfaF9\x05\x0eg\xe7\x0f`\x8c\xc5n\xb7\xc6\xcd\xf5s\x8cn\x801U\xc9qO\xcc\t\xb1\xdå\x14ɧ\b\xba\x9e\x10\x9c\x1cM=_լ\xaa\n+n\x94 \x04,\x17\u0604T\xee\x91\x1c\x18\x13\xb9\x81H\x02\xb4_\x16\x04\xd9\xd7ͲLs\t\t\xeb\xcd\x15\\w\x83\x85&\u038b\x88\x84Z)V\x11hM\xc69y\xcdE\xe1S@\xf4\x01\xcf\xd7/\xb0\xddl\x90b\xc0\xeah\x85\xcaY\xb45\xa1\x04\"\xcc\xc8\u007f\x91\xec\x9a_\\_\xe3\x83'O\xf1\v\xbf\xfaU\xbc\xf7\xe1Gh\x9b\xea\xbb:\a2a3\xfbmܬ\xd7\xf8\xa7\xbf\xf4\xcb\xf8g\xbf\xfc\x15\x9c\x9f\x9c\xe0\xf1\x83{\xb8wz\x82{g\xa78;>\x82a\xc3'\xc7^\xfc1\xc4Ҽz\xe71\f=\xf1v\xd2KS0 $\xab=ؤ+\u007f\x16\x91\x11\x10\xc56\xc6\xc6\x18\x96\x1eF|\xf4\xd1\aX,\x97x\xf7\x9dO#\xa7EB\xe2\xb6\xe57&\xe4\xa9p\x1cx}\x93=7d\x0eF\x12d\x8c4\xba\x11\xd7\xeb5\x9cs\x90R\xe2\xc9G\x1f\xe0\xe3\x8f?,\xde\x12\x93\n\x82\x92/\x9f=\u007f\x86\xeb\xf55n\xd6kt}\xcfi\x8aq\x0f\xda\xcf)\x92.\x04\xf4\xce\xf3\n0A2\xd7AsS%g\xe4Ɍ\xa6\x18\th\x8elF\x88\x80\x94\xe8\x82ǖ\x9b\x05%\xe9\xde\x14Rb\xd7\xed>\xea\xc7\xe1\xffrW\xcd\xee\x1a\x80\xef\x81\x04XB*~JJ\x89\xa6Y`\xbbYc\xb3]\x178/\xf8\x00\xad-\x04\x04\x06?\xb2֜\x82J\x8c5{F?\xb47\x9c\xed\xfc2\xebZL1\xba\u038d\x85Ȗ\x18\xf6s\x81%o|\xe8\n\x0e8\xc9!BRI\xf8qd?\xfcI\xae%fLh\xdac\x87\xbd\xa6c\xbe\xfb\xb4L\x84\x8b\x88\x90)B*26A\bP\x92<\x0eB\x8c\x98\xf2\x02\xe2\x1e\x8c}X\xfc\xf7\xa0\u007f68\xa245]\x0e\xf51\x87\x04I\x82&\x05\x1f@yrѼ\xeb\x03\x04\x82#\xe8T0\xd3\\ή\x05Mך\x0e|\xefPWm\xb6\xfeAQD\xa4|H\xb1ݰ\xb1\xa8\xebf/\x16\x97\xa6pJ\x13\x9b\xf8\x00\xac\xc9\xe7\xaf\xc9\xe48\x91\x12\x8c\xb1\xd0\xc62\xf3\x9f\xfc\x1a\x8c\xa6\x8c\x82\x94\x129\x9d\xa5\b\xa3\f\xe7\x1a\xa0 ?\x91m\x9f3\t\xcd\x05\aϟ\x8d\x01\xc8\xfe7\xef\xfeg\xb3\\U7\xd3N\xd6{hcpt|\x8e\x98\x12n\xae/\xb1\xdd\xdeд\xdfZvs\x9c\x1a\x0eU<\x00\xc8,\xc6\xf1~<\xa4P>Ow@\xe4\\V\x16\xc7MS\xdc\tKL1[\xd0fid\x86\\3[>\t\t\x1f\x897\xd06G\xb0Ɩ\xd5L?\f\xe8\xb7Wh\x15)\x1c2\xdfAg\xe8\x19\x02ue\xd9;\x81W`\x12p\xa3Ú\x8b\n\xa9\x1f\x02\x8e\x8f\x8e`\x8d)M\x8b\xb1\x061\x01\x9b\xae\xc7\xe5\xf5\r\x9e]]\xe3\xf9\xf5\x15v\x1dYiW\x9c\xc5\xf0ݼ\xe4lE\x90\t\xaa\x8a\x1b\xfb˛\x1b<\xbb\xbab\xa2\xaaŃ\xf3S\x9c\x1d\x1d\xe1\xe2\xec\x14o<\xb8\a\xa34O\xfc\x0en\x1c\xd0\xf5\xbb\x92{\x90f\xe4\xc3\xf9\xd2,s\x19\xc4̠\x88\xa1#Z1\xb2R\x87\xee\xe7\x1a\xc6j\xa4\x18\xf1\xc1\xfb\xdf\xc6\xd9\xe9\x19\xce.\xee}r얘\xb2\n\x80\b\bZ\xeb\xa4Y\xf1\xcfI\x89!\x04l6S\xfc\xf10\x8e\xf8\xce\xfb\xdf\xc6f\xbb)\xb6\xda!\xd09\x10\x91pyu\x85\xc1=E?\xf4\x05\xe2\xcf\xd6\xc21&\f\xc1c7:t\xa3\xc7f\x18\xb0\xee\a\x8c>\x14\xe7ь\x8ai%a\xb5Bm\f\x1ak`\x99\xe8\x19b\x84\x96\x02\v%\xd1\xf0\xd7v>\xe0î\xc3:D,\x99\\\x9b3-\xae7\xd7\u007f\xc31\x9cw7\xff\xdf5\x00\xdf\xd5+˯B\b?\xd9.VP\xca`\xbb\xa5\x8cr\xa4\x84\xe0\xdclM\x15\xe1\x9dg\x18\x8d\x8a\x83\xd4\x06\x93\xa7|$\xed?u\x16\xb4/\xe4\x87C\x82\x8cpr6}\xbd\x17\x19\x1cyϕʁ\x91\xc99e\xba\r\x81 \xc5Hpl6\xf9\xd9{\xf4\xb9SO\xb3i|N|ʉyd꣦ĸ=\xb5\x001\x95K\xd7\u007f\x98\x1a\x87W\x03mB\x12\xe9\x8f\xf6\xa9\xe0\x84\xc0p\v\x82\x9d\xdc\xee\b\x05ɻqϼ\b)&w\xc6\xc4\\\x02\xcb\xfa\xfbq\x1c\xe9\x80Pz\xff\xa7\xf1~8G\xdefG6\xad\xed^\xf1O)a\x1c\xfb\xe2\xca\adR\x1e{\x95\xb3g\xbf\xe4\xe9\xdf\xf2\xee\u007f\x18(\xf1O\b2\xfdQR\xa2\x1b\a\f\xdcH\xd8jF\x04\xcd\a/\xbbD\xe6\xbfo\xf0\x8eIy\x80A\x84\xca\aa\x9e\xfe#\x15pk\xebҜ\xfa~\x87\xe5\xf2\x04\xa6\xaa0\xf6\x1dn\xae\x9f\xa3\xdf\xedhM\xa0t1\xf1)\x13\xbd\x94\xe5~\x8d|=|\f$\xf5ā\x15pJh\xac\xc5I\xbb(+\n\xcd\xe1T\x84V\x11\xd4\xeaB`([\x14;c!\x15\xe5\xbf\v\x81\xbanѶ\xcb\xc2W\xf1!b\xbb\xb9\x82\n\x03\xac&$CD\x92<*!\x912/\x9d\x95%\x82I\x86nt\xb8\xba\xbe\xc60\f\x10l:\xf5\xfc\xf9\v<{\xfa\f\xa3\xf3\xb8\xdcu\xe8\xb88f\xf3\xab\xfc\xbe\xf2$9\xadz^\x0eX\xa5\x97\x94˜\x1e\x99\xf1 \x05\xb6e\x9e\x11Ts3\x10c\xc4w>~\x8a\xf7>|\x02)\x05\ue7dd\xe2\a?\xf3\x0eΖK\xe6\r\xedxM\x94\xf39\xd2\x04w\xf3\xbbQJ\x94H\xed=d\x8c\x1190wCd2\xe7H\xc4;\xa9$\x86a\xc0\a\x1f\xbe\x8f\xd5\xd11lU\xbdt\x1d\x87y,\xf6\xe1\xb5H\x94\xfa)\xa5\x86\x94\x1a>\xb0moו`\xa3뛧x\xf6\xfci\xd9\xfd\x93ԘΝ\xc1y\x8c~˞\"\x011GB\a\x8f\xed\xe0p\xd5\xf5\xd8\x0eT\xf0=\x9bL\xa5\x970\u007fBJ\bΣw\x1e7\xdd\x00%%*\xcd\xc6PZCI\x81\x1e\xc0\xb9\xd68\xb2\x16\x1f\xef\xd6\xf8\xa0\x1f(k%\x87!\t`p\xc3\xe6f\xbb\xfe\x1bw\x01@w\r\xc0\xf7\b\x01\x00H\xe9\xf7i\xa5\u07bc\xb8x\b\xe7\x06l77\xe4@\x15(\xb9\n\x9c\x02\x9c\xedos\ak\xad-\ag\x86\x0eC\b\xe5vτ,\xc1\xf2>;\xe4Zkh\xa9ȆVP\x01\x8aqJYKB\x94\xb0\x98L\xec\x1aG\x87\xe0c\x99\x9e\xe7,\xf7l\n\x94%_\xf9\xe1\x9f\x1f0f\x163\x9a\x03Sr\xb7\xed\x19}H\fϕ\xbc\x83\x03w\xc1$^r\xa0f\x0f\x14\x96\xb9\xd1\x0e\x9c\x0e\x04ϫ\x92Wq\x06\xac6\xc5\xf4\xc73!Op8Lf9KA\xbbe\xa9\x14b\b\x18\xdc\x00\xab\xab\xf2\xfe\v\x14\xcc\rKF\x0f\xc8r\xb8\xe6@\x9e\xe9@\x1c}O$\xcbY\xd36\xb2cc\x02\x11\xdb\x18Y\x85ֆ\xa7\xff\x84~\x1ch\xba4\x16UU!&\xdaS\xa7H\x13se\xaa\xb2\xd6H3ӧL\n\x1cy\xc5@\xd6\xc4\tFr:\xa1V%=2\x01\xa8j\n\x81\xc9v\xb5B\b\xac\x8e\xce\x00\bl\xd6\xd7خ\xaf\x11\x82C\xdb\x1e\x95\xfc\xf4|\xaf\x89\xc2\xd4\xcf\x1e\xf3\x84\x8a\xe4⏃\xc8_\xab5N\xdb\x05,\xcb\r\x15\x17\u007f)f\bLJ\x90\x12\xa8\x84a\xc3$\"\x01\x0eL\xba\xabl\x8d\xd5\xf2\x14Z\x11G\x02B`\xd7]cܽ@\x93\x9b\x91\f\xfd+B\x15RH\xf0\xd1c\x94\x03D]A\v\"Ů\xd7\x1b8\xe7\xc8\x15\x10\x82R\xe4\xb8\xe9\xddz\x87\x8ds\xc5\xf6\x16l#\xbc\xdf\x00O\x92ƌ\x80\xc5Yq\xf7\xfc\xcf\xe5\x99\x11\x02Iptt\x9a~\x86\x10\x02\x1a\tF\bX)\xcaA\x9a\x9b\r\xadT\xf1\xf0}\xf2\xe2\x12\u007f\xf7\xe7o\xf0\xf0\xf4\b\xaf\x9d\x1d\xa3\xad\xec\xcci\u007f*\xfe%}O\x90\xd7Ǿ\xa3\xb7\xd8\xe3b\x14\xff\tnJ)\x13aD\xd3. \x84\xc0\xd5\xe5\v<\u007f\xfe\f\x8f\x1e\xbf\x9e\xb7\xe2\xaf\xe8\xcag\xab\u0082ԉbk\x1cc\xc48\f臁\r\xb7\xe8\xb9\u007f\xf1\xe29nx\x1d\x10b \x8d>\xbbg\xc6\x04\xce\x11\xa1\x89~7:l\x87\x11\xeb\xbe\xc7u7\xa0\xf7~?y\xf4\x15\x01\xe1\x85\x170C\xe1\xbaѡ\x1b]\xf1\x9a\x88)\xe1\x85Rx\xfbh\x855\x13gs\x94\xb2\x00\x9d\xa77\xdbͿ?\x8c\xe3\xf5|\x9d\x97\xeed\x00w\r\xc0w\xb3\xfc\x8b1~\xf1\xe4\xec>\x96\xcb#<\u007f\xf6\x11v\xddf\xb2\x87\r\x13|\x1db,<Z\xa54\x11\xaef\xa4 \x1f\\\xd9\xe1\xefi\x8a\xb9 \xd5UM\x10-\xb3\xfas\xa0\x88\xf7\xa1\x90\x89\xf2>P\xb3\xe5m\x8c\x11\xe3\xe8\xe0\xc7\xeck/\x8a\xfcp2\xc3`\xc89N\f\xe5\xf9Э\xa4\x82\x96\xba\x98\xad\xe4\xa6%\x82v\x98\x8e%:e]0#\x8c\x1d\x16\xffC\xc4!O\x9d\x14pCr?\x17<O\xcbi\xdaU\x1e\x1cPyB'g@\"NeHTq\x01\x80 \xed\xbd6\x14\x19<\xb8\x11\xd1\a\xd8\xd6\xee\xed\xff\xf3AG\x11\xc3\xf4\xb5J\x1bX[\xef\x1d\x061E\xf4\x03A\xf6\xf9\x1az\x1f\xb8(NF9\x99\xafAS\xbdD7t\xf0\xc1AH\x89\xa6n\xa1\x04\xfdw\xa3\x1b8;\x9e\x1b\x99\x99|0G\xe4N\xab\x90\xb1\xa4\xae\x19\x91x\xfa\x97\\\xecP\xd6.U\xdd\x16\xc2T\b\x01\x8bv\x85\xaan0\x8e=nn^\xa0\xefv\xd4X\x18S\xa4\x84\x81=*\xac\xd5,1\xa5\xcb\x17bD\xf0\x13\n\x15\x98\xb5\x0f\xdeß\xb6-jN\x11\x94\\PŬ\xf8烙<\xff\x13\x9b#\tx$\x8c1Bk\x8b\xa3\xd5\x19\x9ajQH\x99\xe3\xd0a{\xfd\x14*yHAk3\x89H\x1c\x00P\xe6\x01\xa54F\xa4\x81\xb8\x13N\x92lltc\xb1\xf0uΑ|\x0f\x89\x95qY\x89\xf0\t;\xfc\x83¿\x8b\x11c\x9c&\xfbtP\xc43\xc2&g74\xd9`\a\f1bH\t*\tT\x02h\xa4\xdc\xf3\xedO3\x0eQJ\t\xef?\xbfē\xeb5^??\xc1kgG塉H\a\xea\x19q\xe0R(\xf6\xde\xf7\xfc\x9fS\xa2gTr\xe44\xb9,6\b>\xe0\xd9\xd3'8=;C\xdb,\x0e\n\x9e8x\x8f\x1c\xeb\x9b\x1bC!\xc8\x11\x13\x82c\x8e)k#1\xcb\u007f\x1c\a\\\xdd\\\xa3\xef;:\x03\xf29 \x04\x02\xa8\xf8G\xde\xeb_\xef:\\w=6È\xdd\xe8\xc8\xd7`\x8f\xe9\xf7\xdd\xcda\x93\x9b'J\xca\xea&x|\xb0\xddbH\x91\x1bS\x05\xab-\x8c\xd2\b1\x85\xcdn\xf3\xd7\xe6R\u07bb\xe2\u007f\xd7\x00|\x97\b\x80\x00\x80?ڴ\v\xf8\xe0\xb0Y_a\x18zr\x93s\xa1\x84ɤ\x19\xa4\x9a\xf7\x90J\xab=Vp\xf0\xd3\xf4\x9fw\xb3J(f\xdfk\x18\xdeM\xceY\xb2>x\x9e\xd2\x12\xff.5E\xee\xf2\xd7\xf6\xc3Pd8\x82!\xc2\xdc\x05ǜ\\\x9f\xa6<\x80\xb99\x8e\xe0I;3\x98\tn\x96H,S\xf2L\\JL:̇\xf4a@ϫ9\u007f\xa2\xc4)g)\xa1\x9f9\t\xbedȡ\xf7\xa44\xc3\xf8y\x97G\xbb{\xc5n}\xe4VȎ\u007f\xbc\v\x1f\xfa\x9e\xf4\xe0\xda\x1cp\x10\x04\xef\x9e%\x84\xa2\xb0\"\x92\v\xaa=8tt\x03\x9c\x1bf\xbc\x89\x88э\xbc\xdb$\xf5\x04\u06dd\xf3\xe7U!Ĉ~\x1c\x90\x00ҹ\xdb\n1Et\xfd\xae\xfca\xd6V\xb3\x90\xa6T\xdeK\x9e\xfe\a?\x96Xb\x85D\xbb\u007f\x91\x99\xffr\x8f\x8f\xa2\xb4.\xc4<)\x05\xda\xe5\x11 \x80\xdd\xfa\x1a\x9b\x9bK\xe2\x8fT\x15 i\xc5Dr|j`\x8c\xb6l\xe8\x02\xf6\x1b\x18\x10\x82/M\x9d\xe3\xf4:)\x80\xa3\xaaƢ\xaa\xcbu4\xec\"\x975\xff\xd34\x8cR\x14\xf3g߇\x00!\x14\x96\x8b\x13,\x9a\xa3r}\xbdw\xd8\\?E\x1c\xbb\x02\xfdK$h\xf6\x92H̐\xcf\xde\x04\x14\x82\xd5\xef=W)\x01\xc1y&\x8e\xd2g7r\x18\x90Q\x82\xe2\xae\x0f&\xfe\xc3\xf2\x17\x01\\\x87\x00\x9fn\x0fƴ\nSlȔ\x9fQ\xb9\x97\x19 3\xc1/\x04\xb8\x18\xe1\x13Х\x80FH\x18\x01vo\x9c\x05\xf9\xf03\x10c\xc47\x9f<G?\x8ex\xeb\xe2\xb4 ;ŗAʗd\x12\x1c\xfe\x05\xb3l\x8d\xbc\xaf\xe7ɼ\xef;\xe6\x0ei\xf4]\x87\x9b\xeb\x1b\xd4U3K\xb6,\xec\xa0\xc9\xf4\xa7L\xe1\xec\xe3/\x89L\xec\x03\r\x16ٙ0\x93\xef\xfaa\xc0f\xbd\x86w\xcc\x19)\x1c\x9bID\x98R¶\x1f\xf0\xd1\xf5\x06\x9bq\x84ϲΗ\xac%\u007f\xcbs\xd8\xdc<i>\xc0\b\xa0\v\x1e\xbbDȀ\xb55!\xa8\xda`\xd7w\u007f˹\xf1\xbdy\x03p\xf7\xbak\x00\xbe;\xf2\x8f\x10\r\x80\x9f\x94B\xa2\xdbm\xd1\xf7\x1d\xc1\xd1!\xde\x0eN\xc9\fy\x901La\xffg6p\b\x13\xe4\xc7\xf6\xa0\xd9\xfc\xc5\xda\nJ*f\x97\xf3\xa1\xc0\x92\xc2\xc4{\xb6\\0\xa4\x94T\xa8\x85@?\x8e\xc4\x0f`^\xc04\tMio\x93\x0ey\n-\x99&mzX$\x93\x10\xa5\xa0B=2A\x8cBpR\t͙R\x05_\xbe3=\xe4\x1aiޏ\xe6f&\x17\xff}\xcds\x9a<\xfbA\xf1\x9e\x96\xc3[Bp\xa5H)\x91\xf7\xa3\xd4\xc4\xd4U\x05\xcdhJ\x9e\xdckS\x97\x1d\xf5\x04\xb3N\xc67!&he\xca\x1e}>\x19\f%֙\xbe77+\xf4\xde#\"\xafz\x04\x00\xcb\x01;\xe3H\xf0\xa8\x92\nm\xdd@\n\x81\xddГ\x93\x1f\xa3\x15Db\xdc?Ȳ]\xa9\x8f\x81\xaf1\x1d\x9f\x9a\xa7\u007f%$\x8c\xde_chcٯ\x80\x8e\xef\xb6Y\xc2\xd6\r\xbcs\xb8\xb9!\xf2\x9f@B\xdd,&I\x1e\u007f^B\b\x98\xc2+\x01\x12\x02\xfa\xa1\xe3Ɗ\xdc\xfa|\xa0\x06ga+\x1c7-\x14\xef˵T\x85\x98\xa7\xb2U\xf2LE\x12\xf8\xbe\a\x801E\x84\x94\xb0l\x96X\xb5'\xb4\xaaa\xfb\xe5\xcd\xf53\xb8\xdd\r1\xdb\x13\xed\xd05\xb8\xf83qkϤ(\xc5\xe2\x8f_\xb8(\xbcF+\x85\xc6{\f> \x14\xa2#^\x9a\\H{|\xc0\xa5\x84!%\xf8\x83\xfeu>\xf5\xe7\x15\x15E\x0f\b\x18I\x88\xc8\x18SVn\xb2\xfd\xb4.\xf7HH\xc0&EV\xe7\x00\n\x02F\x00V\b\x98\fe\xf3=\xfc\xd1\xd5\x1a\xce\a\xbcs\xff\x94WPS#\x80\xf9s\x91\xad\x84\xc5\xfe\xfb\xdc\xeb\r\xe4\x04\xa7\x87@H\x891D\xfe\xec\xb6;t]\x87\xb6]\xcc \xf7}$\xa0\x94\u007ff\xe9I&u\xbaq,\x8a\xa6\xc0\xc3E\b\x01\x9b\xdd\x167\xeb\xf5^S6\x1f&BJ؍\x0e\u07fe\xbc\xc1U\xd7\xcfb\x86\xbf\x9b\xa9?큂R\x92φ\x0fD\x1a\x9e\x9el\x81\x8aׁ\xceEXc\x99\u007fC\x8d\xf3f\xb7\xfe\xeb\x9eSY\xef^w\r\xc0\xf7\b\x00\x88\x9f\x14B4)%\xec67\x1c\xff\x1b\xe0\xdd\xcc>\xb7\xec\xe2#\x1dj\xc6\xc0\xb0g=\x11\x92(\x93{\x8a\xda$B\x1a\xf9q\v@є\x88b\xd3\xcbD\x98\x90\x1f@B\x0eDɿ\x9e\xc2d\x86\xbe\xa7\x89\x89\xb5\xafe\xc2g\xc2\x1amb'Ov1˓\x17\x02\xec5@\xefS*\t\bb\x19\xbb\xe01F\x87\xc0\x1eޒ\xf5\xeb\xb4F8\xc0\"_U\xfc\xe5D\x8e\xca\xfa\xe7WZ\x03\xcd\xf6\xd5Fkh\xa3ˡ\x93w\x9f\xd4\xe0\xc8\xf25\x15;\xfe\x8dn\x84s#\xa4\xe2H\xdfbl\x94\xf6\x9b\x00FB\x8cٟ\xfe\xc1p\xa7\x9b\xed\xfe3̜r\x11M\xb3\xe9_i\x18\x9dw\xff=\x80\x88\xbajP\x19\v\x1f#v\x1c\x10%A9\xf49\xfa7C\xe69\xb6\x962#\x88\xb4H\x06:\x80Fb~\x81:\x88vN\xb0U\x03\xa94 (ݬ\xe6u\xc3\xcd\xf6\x9a\xa4\u007f\xc3@\x88\x886p}O\xbc\x0f6\xf41\xc6\x14\xf3 \xfa\xdb<1\xd1y\xac&\xb9ZD\xa55Nچ\x11\x1bZS\xa8\x99\xdc\xef\x10b\x8f)\x92\xba\"E\xb8\x181\x84\x04c+\x1c-Oa\x8c.y\xf5\xbb\xf5s\xf4\xbbK\bv\xf7SJBK\x019w\xca\x03!\xcai.\x95\xe4BC\x1a\xfaX\xfc&\x04\x80\x9d\x0f\xd89\xcf\x16\xcfӴ\x1fg+(\x8f\x04\x97(\x88\xc8\xcf!~)o\xed\x9as\xc4v\x0e\x982Z\xc2H\x81JK\xd4Z\xe1z\b\x18\xf2\xba$;8\xe6F \xbb\xfb\x01\xf0\xfc{\xc7\x04tH\xb0R\xa0\x16\x026#\x03R\xe2\xf9f\a%%\xde<?\xd9S\xe2\xe4L\xd0\xf42\x00\x80\v\xf4|\x02VB\xb1s'\xf3\"<\x99\x8c\x91\xe1؈\xddn\xc7\xd6˲\xa8\t\x12\xaf\x1f\xc4$\x03\x9a\x1a\x02\xde-P\xfcu(fC\xd4\x04;l\xd67\xd8l\xb7\x93D6\x9f;\x82P\x83\xed\xe0\xf0\xcdg\x97\xb8\xea\xfa\x82\x14\xbd\xaa\xf8\x17\x134F\x80\xe6_\xaaK\xae\a)O\x8eW+\xdc?=\xc7\xd7\xdf\xff\x0ev]\x87\x98\x12\x94\x00V\x95\xc1&\xd2s\xda\xd6\vT\xa6\x82V\x06\xfd8\xfe\xbdэ?\x97\x1d\x06\xef^w\r\xc0\xf7R\xfc!\xa4\xf8\x8b\xe0id\x18:\x8c\xe3P\xf6\U0009985f\xf5\xb3B\xb2\u07b9\xa6\xbdtF\xf8\xbc\x0f\x88\x9e\xads\x19\xaeK)\xb1t)\xa16\x15\xedf\x01\x88\xd9\x01\xe3\xbc/\xa6>R+\xf6\r\xa0\x00 \xc1\x0ed\x03\xfbdK\xa5&\x98\xb2\x04\x02af[\xfb\xb2d=[\xa2a\x15\x17)\xe7\x1c\a\x15M\x93\x96\x92\xa4G\x0e\xe9eV\xbf/\xc7\xff%\xcb\x14\xf3\xdf\xeb\xa3?\xe0\x1f\xa4\"\x83:\x84`-3\xffs\x03\x94\xa7\x00ŒL\"\xfe\xd5\xc5\x1cfd'0-4\x8c\xd4̓\x98\x85\x0f0!-\x06\xd2O\x1bS\x1dh\xa3\x13\x06f\xfe\x97  \xf6\xfc/\xcd\xd3\f<\xcd\xcc\xff~\xec1\x06\a-5\x9a\xaa\x85\x10\x12}\xbf\xc5\xe8]\xe1+T\xa6*\xcc\xf3L\xfaʦ>.x\xfa\x1d|M\rO\x96dk\xabp8\xea\x19[\x95\x89\xca\xda\nJ\x93\v\xe0\xcd\xf5\vl\xd6\xd7@Jh\x17+BY\xcaTM\x9f\xa11U\xd9G\v!\xb0\xebv\x85\xec\x18x箄\xc4Iݠ6dի9\x93\"$\xfa\xdf\xe4ܥE\xa0\xac\x95B\x8c\x18C\xc0\x10\x13\x84\xd28Z\x9e\xa1\xaa\x1aZ\xbd\x04\x8f~w\x85ns\x05\x11)~\xd9(\x85\xc6hhL\xceo\xb4F\xa0\x865W\x83\x18\xc83>\x95\xf8\xdd8M\x99\xcec\x9b\xad\xa3\xf9]y\x00\xeb\x10\xc9:wv\u007fJv+TB\xbcr\x06\x9d\x8c\xa2\xe8\x1eo\xad\xc2Im\xa1$\xd0Hj6\x1c;\xfb\x91tu\xee\xde\xc9)\x8eJ\x11It\x16\x14\x14\x00\xf41\xc1\t\xf2uh\x84\x84f\x15\xcb\xd3\xf5\x16R\b\xbc~v\x8c$&b\xe6>\x8015\xcbģ\xc9\xde\x1e\x12UU\xa1\xaa\xea\x92\tR\xc8|\x93\xb8\x00c\xdfÍ#d\xd3\x14cl\x01\x89$\xb1\xaf\b\x14(\xe69٫?FB`r\xf3\xe5}\xc0f\xb7\xc30䡃ߝ\x92\x18\x1d\xb1\xfc?\xb8\xba\xc1宛\x15\xff\x97\x17\xfe\x10#*kQ[\x8bя膞'wA\x91\xe0Z3\xb9\x90֧\x9bn\x87?\xfc{\u007f\b'\xcb%~\xf6\x97\xbf\f\xe7\x1d\xa5D\"\xa1\x8f\x1eZ\x1b,\x9a#\x1e\xba\x046\xbb\xed_O)\x15\x02\xef\xdd\xeb\xae\x01\xf8^\xe0\xff\xa5\x12\xe2\xa7\xf3>\xd0\xfb\x00W\xac^\xe9H\x17i\"\xdbi\xad\x01\t\x18kي\x93\x1a\x87\xbcC\x8b\xdc\x00\x90m/˟\x10'O\u007fF\x11\x84\x94\xf0\xa3\xc7\xe8i\a'\x95\x86\xc4\x04\xbfgHyt#F\xef\x908>\xb8@x\xbc\xbf\x8e3\x986;\x89\xe5\x93Q\bY\xbc\xf5%\x9bf\xe4\x80\x1d\x9fb\t\bR\x92\xfe5\x97U\x95\xc3U\xbc\xf2\xba\x15\x8bޘ\u007f\x16[\aω\x89/\x03\x12\xac10F!\xe6\xe9\x9f\v\xd6\xc4\xeaO\xb0֒\xa6\x1c\xe0\x90\x11W\xac\x91\xa5V\a?\x93\xa6\xc3\xc8P\xbe\xb5\xf5\x9eB\x00<\xd9x7콡\x81\x95\x17qf\xd5<\xed\xfe-\xed\\\x87\x1e\x88\tuKq\xbf>x\xec\x86\x1d\x13\xab$K\xf6f\xac\xefx\x80\u07b8\xa1\xa8)\xa4\x00MĹq\xca\xde\x04\xdc\xccMk\x8bD\x0em\x8a\xa6\xeb\xbe\xdb\xe2\xe6\xfa\x19\xdc\xd8\xc3T5\x946\x18\xfa\xae\x90\xfar^C\x95\x11&VC\xf4\xbbm!\xb5\r\f\x91.+\x8beE\x1e\x03ZJh\xa98\xebb\u007f\xef\x0f1\xb1\xd0}\x8c\x18\x82#x\\H\xac\xda#,\xda#\x92\xe9\x85\x11#\xfb\xfcG\x8e6\xd6J\xa2\xad\fj\xab\x11\xdc<\x1c&\xf0\xf5!\x18\x9e\xec\x8d\xc3\x1es_\b\x811\x04܌\xaeH\x0fs\x1ds)a\x13\x13\xc6Y\xf1W\xafP\x02ă&V@\xa024A\x0f\xce\xc1H\x89\x8b\xb6B\xad\x04\x16V\xe3\xcd7\xde\xc0\x87\x1b\x87\x0f\xbe\xfa5\fn,$\xd99\xaa3zO\xf7\xaeR\xc0L\x12\xe8\xf8\xd9\x0f)aH\x80\x17\x11-#\x02B\b<\xb9\xd9@\n\x81G'+Έ\x00C\xeb\xea֊\"s\x8a\x94T\xa8\x1brU\x9c,{\xb3m/\xe7\x97(\xc5\xfe\x15\x03\xb6\xdb\r\r$Z\x17y!\x15\xfd\xe9\xfc\xcav\xbftNM\xe7Gdo\x88\x10h\x15\xb0\xdd\xee\xe0\xdcH\xa6&BBH\x85\xde\x05lG\x87ˮó\xcd\x16\xf3$\x9e\xe2B:k\x8a\xa4\x94\xf8\xc1\xcf|\x16\x9f\u007f\xe7S\xb0\xc6\xc2\x05\x87\xaf~\xf3\x1b\xf8g\xbf\xfek\xfc\xbc˃\x14PZ3m\xbb\x1d.NN\xb1l\x1a\xacw\x94\xfc\x17R\xc2\x18\x02\x8eVǰ\x86\xd41\xe3\xe0~e\x1c\xc7\xff\a}.\xe3]!\xbbk\x00\xbeg\x04\xe0\xa7!DC7p\xc28v\xa5H\xe6\xe9zN\x8a\x82\x04\x93в\x8c\x87v\x9f\xa9\xc0ߢ<\x04ٸDi\x03c\xaaR\xb43l;\x8e\x03i}%9q%\xe6\x18\xe8b\x10\x14\xd1\xf5\x03|\b<1sbWB\x99\x98\xe6\xbb\xcd\x18I\x97\x9b\xab\x11I\xf2\x14\x1b\vE\xf2\xc8\xe7\xefs\xb9\xf1\x00gu\xf3\x94\x98w\xac\xbf\xc9E+\x1e\x05\x11\x11!\x91\xd1O\xdeA\xbe\xb4\x19ωo\x9c\xf6\x96Q\x03r\xed#YR&\xaa)\xa5\xd8yO\xb0\xa5\xea\xc8^\xf4\x80V\x06\x13\x17{2:\xc9\tm9\xc6y\xbeG\x8d\x89\x9c\x1c\xc3L\x92\x18c`\x8f\x82\xbcW&\x8cG@\xc0\x9a\xec\xfa\xd7\xc3y\a\xa34ں\x01 H\r\xe0\\\xf1n\xaf\xb4-\x9f\x9d\xe0b\x9eM}FNz\xccD\xba\x9a\xa3o\xa5\x140\f{f\xfb\xe6\x14\x13lK\x8e\x85b\xa6\xee\b1`\xb3\xb9\xc6f}\x85\x94\x88\x97\xe0\xb3\xed/&%\x89Vz\xb2\xe0\x15\xb4\xdfu\xdc\xf0\xf8@\x0emV+\xac\xaa\x86\xdc\xd7X}\x90т\xbcZJ%■\u007f\x17\x02z?b\b\x01I(\xd4\xd5\x02GY\xf2\x17\x1c\xc6n\x83\xbe_û\x9e\xaf\x1fE\bW\xd6P~\xbcc\xd2\x1f\x17\xf32\x8dr\xc39\x9f܈/\x11\xb1\x1e\x1d\xc9G3I6%\xf4\t\xb4\xd7/fլ\xd3\x17S\n\"Ĵ\xb7o\xada)\xa8\xc4\xc2Z\x9c/Z\xdc[-\xf0\xab\x1f=\xc1\xaf~\xf01V\x95F\xad\x05VM\x8d\xbf\xf4\xdf\xf9\x12~\xe4G\xfe\x10\x00\xe0o\xff\xbd\xff\x12\u007f\xf5\u007f\xf7\u007f`\xce\xc7\xed\xee\xd7s\x130G\xb34\xa6\xa4\xce\xecʸ\x8b\xd4T\x1b!\x90\x84\xc0\x87\xd7k\xa4\x04<>]A\xa9\xac\f\x00\xa3\x02\xb3\xf8\xe6Di\xa3uݠb\xd7ý\xa8\xe2\x8c\xf8\tB\xd1\x12\x87\x84\xf5C\x8f\x86\xdd(\x8dQ{+\x87,\r\xcd\xe3|\xbeg\"#\x91\x91\x11\x89\x14\x03\x82w\xd8n\xb7\xf0\xbc\"QB`\x88\xb4\xf3\x1f|\xc0\x93\x9b\r<\xaf6K\xd3\"\xe5lp\xa0\x86\xe2\xf3o\xbf\x8b?\xffS?\x85ϼ\xfb6\xb6\xbb\x1d\xbe\xfa\x1b\xdf\xc4\xfd\x933\xa4\x94\xf0O~\xed+̑\x8a{D?!$\xbe\xf5ч\x85\xaf\x90x\xad\xd5G\x0f\xa9\r\x8e\x16G\xfcwH\xec\x86\xed\xff:\x964\xc1\xbb\x06\xe0\xae\x01\xf8\x9e;\x00|)þ\xde{t]\a\xef\xfc\x9e\x99\x0e\xb9ѡ\xe8ҕRD\xf0\x9aA\xc9\x14s\x99\xe1\xff\xb0\x97\x97\xde\xd8l\x15<\x1dvn\xec)\xed\x8e\xf5\xf8R\x88B\x84\xb3\xd6\x14\xa8~\xd7\xedX\xb7\xab\xca\n \xcet\xdc\x12\xb4\xafVJ\xa2\xcb06?U\xc6h\x86\xa63ъv\xcfc\x98\xa0\u007f*\x06\x8a\xada\xc3oI@\xa3\x94,q\x9c\xb4\xf7\x8f{{\xf5\xd2B\xa4\x1cA\x9aI}t8\x17\u0380wH1\x95\xbf?W\xaf\x9a\x1d\xffb\xb6FNS\x9a].\xees\xe9\x145\x12\xc4\x10\xa6\x89I\xed\xa1\x18!\x06>$\xf2,HSÜ\xd8FH\x0f\xa7$ZN\xfc\x1bz\xa4\x94\xd0\xd4\r\xac\xb6pޡ\xeb\xbb\xd9\xc1\xa7f\xbe\xf79\x03^r\x8cl\xc2\xe0\xc7R`\x8d\xd2P\x8c\xddV\U000c648b\xbf\x10\x02U\xd3\xee\x11\xb5\x84\xa4{b}s\x89q\x18 \xa5\x826\x15\xa1SBPQ\xe5\xfb\xd4\x18\v\xc5\xccu!\x04\xfa\xa1+͇\xf3$\xaflm\x85\xc6Z\xcaW\x103\x0fy\xb1\xcf\x19\xc9\xc5߇\x80\xc1;\xf4\xde#\tZ\xab\x9c\x1c\x9dØ\x1a18\xb8a\x83\xb1\xdf\xc2\r=YU\x83\x9c\xdd*m\xa0\xa5\x00b\xe2\x88\xda8\xb3\xbf\x9f\x9a\x9bLN\xcc\xcf\xc9ntX\x8f#F\x1f\x10\x91\xe0S\x82㤼\x00\"\xf5\x85R\xe8\x01k4\x96\xd6bQUh\x8c\x81\xd1\n\xab\xaa\xc2٢\xc1\xc2کi\x94\x12m\xd3\xc265~\xf1\x83\x8f\xe9zI\x89\xe8=\xfe\xf8\x1f\xf9\xe3\xf8\xf1?\xfe\x93\xe8\xba\x0eB\b\xfck_\xfc\x97\xf0\xad\x0f>\xc0\xff\xfeo\xfe\xdf\xd1T\xb7\x8fϘC\xb9f\b\x81\x00\xf6\x88\xa5\xb9i\x19\x125\x00\x191\xfb\xe8f\x8d\x98\"\x1e\x9f\x1e\xa1\xaa̭\xe7E\x80Ч\xban\xd04-\xa5\xe2\xe9\x1c.%x-9R2%g@\xc4\x14!cD\xf4\x01\xe30BI\x92\x81N\xe8\xe3\xf4\\\x95\xe6!\xaf\x0f\xe2\xf4\xfcd\xd3\xeaap\xd8l7\xbcJ\x93HB\xa0\x1fi\xff\xfe|\xb3\xc5v\x18K\xf1\xcfM\x85R\nM]c\xd3m\x8b\xcd\xef\xe7?\xf5i\xfc\xc8\x0f\xff^,\x17mir\u007f\xe9\x97\u007f\r\x9f}\xebm|\xf9\xeb\xbf^x\x01\xb9\xe1\xf4>\"!ཏ?B[\xd7臑-\xa3%\xb6\xce\xe3\xf8\xe4\x02U\xd5@\x02\xe8\x86\xf1\xa3~\x1c\xffC\x01\xcaՈw\xf0\xff]\x03\xf0=\xbe\xdeTR\xfd\xb8R\xb4S\xf6~\xc40\xf6\x9c46=\xdc\xe5aϤ\x1cm\x8a\xec&K\xffBqJ\xa3\a\xda\x18\xcd\x01>\xb4\xc7+'kJ\b\xc1qq\x99\x92\b\xb3c \xc9[\x14\xc3\xcf\x03\x86q,,{\xa1\x04\xed\xebRi\xe6I\xd2d\xc8D%\xe6C\x88'B-5u\xf7qb]\xc7\x14\xcaΌT\x01j\xcaE\x17\x02\xf8M\x1e\xa6l\xb2#\n\xf11\u0380\xf8C\xc2_\xe4\xe0\x0e&aI\x05c\fT^\xb7\x04O=\x02C\x95\x94\x82fXRG\xe6'λ\xd2Z(\xa5I\x1a9\xd3\xfe\x83MIRJ\xb0\xc5?_N\xd4\x00\x0eN\xf2\xde\xd1{a\xc8\xd3\xf9\xb0\x97\x03\x90\x15\x8f\xd6V\x14\xbe3\xf6\x18\x9d\x831\x06M\xdd\x02\x00\xba\xbe\xa34C>X\x8d\xb1\x85ב\x1b\x9d\xbc\xa7u\xdc`dh]\xb3\x8b\xa4A\x84\xc9ߓ\x11\x93\x18a\xabjR-\b@*\x03%5\x86\xa1\xc3\xfa\xe6\x05R\x8a\xb0u\v!\x15\";SfkV\x00\xb0u\xb3\x17\xe7\xdc\xf7\x1d\x91\xc4ئ\xb5\xd2\x1a\xab\xaa\"\u009f\x94\x05\n\xce\xf7\x91\xc0\x94XG\f\xf9\x88\xde;tn\x04x\xa5q\xbc<E]\xb5@\xf4\xf0\xc3\x16n\xe80\x8e\x1db\xa4\\\v)\x04j\xabQY]\xd6\x109\xec&\xc6\xc8>\x12\xa2\xc4\x1b+)\xa15݃\xdd\xe8p\x15\x02\xd6\xc1s\x80R\xc2\b\xcc4\xfc\xd3\xde\xdcǄ\xb3E\x8b\xcf^\x9c㍳S,\xebj\xb2~ֹQf\xa4N\x19\x1c\x9f\x9e\xe2\xe1\xa3G\x90U\x05\xfdK\xbf\x921:\xd4U\x85\x1f\xfa\xc1\xdf_\"\u007f\xc1\xeb\xa6/\xfeď\xe3o\xfe\xa7\u007f\x1b]ߗ\xe9\x1b{\re܋\xd5UZCxRz\x18=%\xf4u1A\xa7\b+E\x99\u009f\xac\xb7\xb0J\xe2\xfeъ8\x05\xd0\xf4,%r\t\xad\xea\x1auӢ\xae\xc9\xc0Jq\x82\xa4T\xa2dE\xc4H\x1e\x17\x88\xf4\xcfQ\xd2\xf3\xedF\a\xa5F\x96\x81Z\x18)o+\n\x12\xf6L\x8ar\x96B\x8a\xa9\x18[m67\b\xc1C\x9b\xba<K\x9ba\xc0\xf3\xcdn\xafя)\xe1h\xb1\xc0\x1f\xfb\xfd\u007f\x00ϯ\xae\xf0\x0f~\xf1\xbf.\xf9\x1c\xa7GG\xa8+K\xf6\xd1R\xe2\xe4xE\x8e\x91\xb6\x82\xd1\x1a\xa3c\x0e\r\aL\xe5\x81d\x88cAS\x1b\xad\xa9٪j\x9c\x1f\x9f\xf3y\x16q\xbd\xd9\xfc\x95\x18c' ؾ\xfb\xeeu\xd7\x00|o\xaf/-\x16K\xa5\xb5A\xe4Cӹqb\x94\xb2\x8c\xaf0\xfd\x90\x8a\x83\xd6\xe4\xfd\x1f\xa8\x00\xc5T\x88\x82\x9a\x89q!F\x8a\x8bec\x9a\x04:\x9c\xbb\xbe\xa3\xb0\x0f\xad(Ɠ\xa7!\x9a\xf0L\x89\x1b\xde\xedv\x881\xa12\xa6@\xff\xb4K\xe6\xc9ZJ\x18c \x84\x9c\xc9`\xa8;\xd0Z\x17f|6o\x11B`\x18\xc8\xf2V\xb0\x03\x1d\xa5\x00\x06\xf2!8\x98\x06oI\xadf{\xff432B\x0e\x18I\xd3)3O\xf9\xccȉV\x02F+$Ar\xc1l03\xff\xf9͜\xf8熙\xd3\x10\xc7\xe9f\xb6=w[1\x92\x84P+\x03\xc9Q\xcd\xe5\x90J\x111\xe5H֩X\x8e\xde\xc1\xf9p\xc0¦ݴa\xcf\xff~\xe8\x01$\xb4u\v\xa34\x91\x98\xc6nBA\xa4\x84a\x8eB\xce\x1ePBR\x06[\x8ap\xc1Ѯ?\x17\xd8\x14\x90\xa2\x87\xb5\xaa J\x85,\x8a\x04[/\x8a\xa4TJ͟\xab\xc0\xfa\xe6\x12]\xb7E\x12\x12\xb6n\x890ʨF╍\x96\x9a\xf6\xffy\x87\xde\xf7\x18\xfa\x0e!&\xf8\x18\xa0\xa5Ģ\xb2h\xade\xa2\xdf\\\xe3?a\xb0\x89\xe5\xab>\x91e\xf1n\xec\x11\xa1\x90\x92\xc0\xb2^\xa2]\x1c!\xa5\x00?t\xf0C\x0f?\xf6\b~\xe4\xe4J\"v5\xc6\x16\x12[ve\xd4\xc6\x14\xf9l\x9e\xf8\xe9ZkhI\xdfk\x9d&gA%p\xdd\rp>\xd0\xe4?K\x94\x8b\\t\x90\x12\x96J\xe1\xe1j\x81\x86\xd1\x1a\t\x01[[T\x96\xa2\x83\xabʢij\x9c\x9c\x9c\xe1\xe2\xc1C\x1c\x9f\x9e\x90ÜRĿ\t\x11\xab\xc5\x12\x17\x17\x17\xa5\xf8d4\xefd\xb5\xc2j\xb1\xc0f\xb7\xdbk\x00\xf2\xfd\xb20\nFK\f\x9eb\x90\x83\xf7\xf8\xe1\x1f\xf8\x01|\xf8\xec)\xbe\xf3\xe1Gt\x8fs3\xbf\\.\xa0\x9c\xc3n\x18\ni\xf4\xc9\xcd\x16!D<\x14\xc7X.\t\x81\x13)\xa1ij\xb4M\x8b\xa6\xaa8\bȰz\x87\xdd\x1d%!TR\xa2\x9c\x01`\x84%\xf3p\x9cs\xac\xae!\x84jʅ\x98\xf42e\x87R\b\x89\x84\xd4 &l\xb7\x1b\xec\xb6[$\b(\xad\x11\xb8\xe1zr\xb3\x81\vq\xef\xdey\xfb\xf1c\xfcď\xfeA\xbc\xf3\xe85|\xfd\xfd\xef\xe0g\xbf\xfc\v\x85\xc0\xdc\xf7\x03\xbe\xf3\xc1\xc7x㵇\b!\xe0\xfd\x0f?.\x96\xc2!DΕ\x10P\x90{\xcfbF\xac\x8c\x92X\x19\x8b\xeb0\xe2\xf4\xe4\x1e*S\x01H\xb8|q\xf97w}\xf7\u007f\xa2\x8c\b\x920\u07fd\xee\x1a\x80\xef\xb9\x018\xbb\xb8\x0f\x11\xc8,\xc53\xf9oF\x14/ŋ\x0e.\n\xfe\xc9\xf0w\x12\x94\xda\x15C\xda3\xb8Q\x92\n\xb2\x84\xa0 \x9a\x8c\x16\x84\x80\xa1'\xf7\xb8\t\xae\x05R\x88%(Hq\x04\xe8\xc0\xf6\x9cJ\xa9B\f\n\xd9b\x13\xe4\xc9=7߉1 \xe5`\x19\x86\xa7\xe7S\x93R\x12\xbd\x1b\xcb\x03#\xb9SO9%\xee\xe5\xfc\x88\x03蟜\xfe2\x93\xbaxo\xcf-8\xd34]L\x13\xf1D\xfe\xa3\x8c\x80T\xe2p\xe7Vƕ\xb5\xec\xe2\aJU\v\xbe\xcc\x1bB\b2\xb9\x99\x1f\xc8)\x119P\x92+\x1e\x1d\x94\xaa\xfc\xc0\xccp\xf6~\x98b\x8eS\xc2\xe8\xc8xi\xceq\x14\xec\xd3 \xa5B?\xd2\xee_k\x83\xda\xd6H\x00\xb6\xfd\x8e\x9d\r\x05\xbb\r\xdabbD\x05\x88$\x8c\t\x14\xf8\x03\x10\xe1/\xa5\x04\x91\x02\xa2\x1fa\x94\x80\xe5,\x869\f.\xa5Dݴ\x85XE\x10\xaeF\b\x01\xeb\xeb\xe7\x14DU5PZÍCi$3\xbb\xbe\xaa\xebr?\x01@\xb7ے\xd2#P6|e5\x96UU\xd0\x13\x01\"VMfR\xd3+Ĉ\xd1;l\xc7\x01.\xa5\xb2V9>>\x83\x14@\x18zx\xd7\xc3\xfb\x11\xe38p\x03B\xb0\u007fet\x91\xb7Iθ\x97I\")\xdeC\x17\xb2'\x15hʠr@\x8a0V\xa1\xae,N\xba\x01\x1fmvx\xb2\xdeb;8\b&\xaa\xf9H\u007fl\r༲\xf8\xd4\xc9\t\x96M]P\xb0\xa6\xaeж5ڦF\xd36\xb0U\x85\xa6iprz\x86\xe5\xd1\nu\xd3\xc24\x12UE\x81G\x9e\xa7\xce\xe0\xfd\xde=\xa5\xb5\xc6z\xb7\xbbU\xfcc\"\xed\xff\xbdE\x85\x93\xc6\"Ą\x1b\x11\xb0\x1e\x03\x86q\xc4\xc5\xe9)\xbe\xf4\xaf\xfey\xfc\x8f\xff\xea\xff\x02>\x06\x1c\xd55\xfe\xf0\xe7>\x85\x8b\xd5\n\xc3\xd0\xe3W\xbe\xfe-\xbc\xb8\xbe!\xf2cJx\xb6\xa5f\xf2u\xad\xb0l[Tu\x85\xe3\xa3#TUE\xb0?\xa7G\x16\xdfo^g\xec!\\\xbc:\x11\x99`\xc9\xe7@\f\x11\x01\x1eN\xf9\xd2\x00d\x9e\x89\x90\x19v\x17{VAy\xb5q\xb3Yc\x18zh\x93=H<.\xb7;\xdc\xcc$\u007f1F\xfc\xe0g>\x8b/\xfe\xd1?\x86\xf3\xe3\x134U\x85\xfb'\xa7\xf8\xff\xfc\xe3\u007f\x88\xf7>\xfa\x10Jit}\x8f/\xff\xcaW\xf1\xc1GO\xe0C\xc0\xc7O\x9e\xc1\x18\x83\xef<\xf9\x18\xfdЗ\x00\xa2yB\xe5\xc4W\x00\x16ڠV\n[\xbb\xc4\xf1\xe2\x04\x02\x02\xbb~\xf7\x8d\xa7\x97\xcf\xff-\bEg$\xe7\x14ܽ^\xfd\x92w\x97\xe0\x95\xaf\x1fk\x9a\xf6s\x0f\x1e<*\x92\xbd\xec\x887\x87\x84\xf3\xf1(\xa4\x846\x8a\ru&֫c\xe8=\xb1\x13\x96fH=\xc4\bc-\xef\x88\xe9\xb0\x1f\xc7\x1e\xc38\xb0\x14g&\xe5cy\x8e6\x1aJ\t\xf8ѡ\xeb{\xf8\x18\xa8hjUt\xd2`HTJ\xcdY\x014Ѥ\x99*N\xf1$\x9c\r\x89\x94\xa2\xfd\xe1\xc0\a\xb6\x90\x02Z\x929\x91?x\x00_\xc1\x93 \"$\x174\xbf\x97\x11 \x8a\xf2 a\xd28\x8b\xd2dL\a+e\x11`?6T\xb0Y\x92\x94\xa8Y)Af'Þ\x92@\xf1\xfa`\xfe\"\ta\x84f\xaf\x80lt\x04L\xda\xf5\x10}i\x9crn\xf8\x18\xfcA\xb8!\x15%k+\x92\x82\x8e\xbc\xfb\xaf\x1a\x18e0\xba\x11\xfdЗ\x86\x882\x06\xecto\x80%b\\@3\xb92r\x86C\xf2\x03 \x12\xac\xd6{\xbb\xff\xc8\xc5\xcdT\x15\xb4\xb5\x05\xd5\xc9I\x8a]\xbf\xc5f\xb3FH\x02\xb6\xaegJ\x93Xx BJ4m[\x8a\xb8w\x0e]\xd7\xd1\xfb\b\tV\t\xb4Ơ1U\x81\xa1s3\x94=\xe7\xf7\x8a\u007f\b؎=\x86\xe0\x00\xa1 \xa4\xc2\xc9\xf1}T\xa6A\xf4\x0e\xc1\xf5\x88~\x84s\x1dR\n\xd0R\xa1\xb1\x06\x95\xd5E\xa5\x12\x19\x15Ҋ\x9f\x19)\x998K\x85\xbfn*XkJ\x18\x8d`i\x98\xb5\x12g\xab\x06\xef^\x9c୳c\x9c\xb55\x8e\xea\n\x8d\xd1h\x95\xc4R\x00\x17Z\xe13\xf7\xef\xe1\xc1\xd9\t\x00\x01c\r\x96\xcb\x06\xc7'K\x9c\x9d\x9d\xe0\xe4\xec\x04\xcb\xe5\x12u]1\x91\xae\xe14G\x83\xd7\x1e\xbf\x81\xfb\xe7\xe7\x90B`\x8c\xc0\xf5f\x8d\u007f\xf8\xb3\u007f\x1f\xce9\xd4U\x8d\xa6n\x10B\xc0\xdf\xfcO\xfeS\xbc\xb8&\xa6{F Z#\xf1\xe6I\x8b\u05cej,\x8cB\xcd\xfe\x01\x19\xe6\xea\x86\x01J\b|\xe6\xcd\xd7\x11}ħ\x1f\xde\xc7[\xf7.\xd0\xd6\x06\x17\xc7+\xbc\xfd\xf0\xde\xde\xee\\\n\x81ntXo:X[\xe1\xe2\xe2\x9c\"\xb1\xb5*|\x9f\xf2\xec\xf1\xbd-\xf2T\xaf\xe4$\xf9\xe5\x95V\xf0aʠ\xe0\xe66\xce\xe4\xa7%ibv\xdfK5K\vL\x84~\xaco\xae\xe1\xb9)\n1a7\x8ex\xb6\xd9\x15ߒ\x18#>\xfb\xe6[\xf8\x97\xff\xf8O\xe0\xd1\xf9=\x9c,W8^,qqr\x8a\x1f\xfe\xcc\xe7\x10\"\x11n\u007f\xe3;\xdfF\xf0\x11\xdf\xf9\xe0#|\xf4\xd1S\x18\xad\xf1᳧\xf8\xb9\xaf\xfc\xd2t\xf6\xbd\x04e\xb4R\xa2\xd5\x1a+c \x8d\xc6\xf2\xe8<\x9f\xa9\xe3\xc7ϟ\xfdk.\x84\xebLl\x1d\xef\xc8\u007fw\b\xc0og\xfa\xbfw\xff!\x96\xcb#\x96\x8f\xa5\x12\xfe\x93GB9sۓ\x8a\xa6s)e\x91o\xe5\xdd\u007f&7\xe5\x82\xe7i\\A]7\xe5Av~D?\f3\xe3\xa0\xe2\x86B\x0f\x84\xa2<\xf8\x9c\x17?\x8c\x03\xb4T\x94\x80\a\x81\xc0\xec'\x1f=\xac\xb2\x04\xc5g&~\x8a\xfc\x1e\xc0\x1al] S:\x94\x13\xbaq\x98v\xb2|\xe0\x86\xac\xc1\xfeM\x8a\u007f.4Bd\x99b\x98\x1d&i\xaf\xf8O[\x88\x89\xec%\x85\xe0t/J\b\xf4\x81$\x96\xf9k\x84\xa0\xe9[k\x83\x94\x80\x91\x93\xf9\xe4l*\xb03\x1f\x85<\xfd\x87\xe0!\xb5.E_\t\x85b\x8e\xc4.yލ3\x03\x1a\xd2\fg\xe4\x82\xedщlę\x04\x83\x1b0\xba\xb1\x10\x9b\x12\x12\xba\xa1+\xd7.\x13\x11\x95\xd63\x94H\xb2\x91\x0e\xfdmE\x1f\x1e\x03d\x18\x01D\x82\xe9\xf5l\xfaG\x96\xaa%\"7\xcd\x0ex\xcdΑ\x9b\xeb\x17\xe8\xfa\x0e\xda\x18Xm\v\xa9/\x94\xdf\x11QW-\xac\xa9\x8az\xa3\xeb\xb6\xc4c\x89\x01RR\xd8\xcf\xd2\xd60J\xee\xc5F\x1f\xa2;1Q\x84\xebv\xe8\xb1\x1bG\bF\vV\xcb\x13,\x17GdV5\xf6\x88\xc1\x93K\xa6\xf7P\x90\xa8\x8d\x86\xd5zү\x97\x00'\xb0\xa3!\x10U\x9c\xd2-\x85\x9c\x10-\x96L\x86\xfc(0+\xbc\xb1\no\x9d\x1dἩ\xf1l\xdd\xe1\xe9n\x87a\x18\xa1\x1dp\u007f\xd1\xe2\xfc\xf8\bZ+\xacV\v\x1c\x1f/\xd145\x9a\xba\x86\xb55\x11_\x99\xa4j\xad\x811\x9a\x93$k\xdc;\xbf\x87\x1f\xfd}?\x88\xff\xf2\xe7\xff1BH\xd8y\xe0?\xff\xbb\xff\x19\xbe\xf5\xde7\xf1\xda\xe3\xd7QW5\xde\xfb\xf67\xf1\xe5_\xfcE|\xfa\xde\x12\xbd'\xe9\xe6\xaa\xd28\xae\rj\x86\xf6\x03\xe7\x03\x8c\x81\x1a\xfd\xb6\xae\xf1\x87~\xf8\x87\xb1\x1b\x06\xdc;\xbb@\xab\xbf\x81\x87'G\xec:H\xfe\x1a+\xabq\xa6\x13F\xa1\xe1A\xec\xfaJ\x93C`\xddTh\x9b\x86\x9aY\x9e\xfc%&?}!\xa9\xc9\xc7L\x97/g\x13\xbc(\xa1A\xa9\x14\xe9\xdc\xd0\xc5\x10\xa0\x8d\x99\x92\t\x8bO\xc7d\xb8U\x1c4C\xc0f\xbb\xe6\xf0\x1f\xfa\xdbvÈ\u07b9r\x16<\xbew\x0f?\xfa\x85/\xe0\xfc\xf8\x18˖\x8c\xb1\xf2\xbd\xfeG~\xdf\x0f\xe1?\xfb\xf9\x9f\xc50\x8e\xf8\xa5\xdf\xf8*\xac\xd1\xf8\xec\x9boCI\x89\xdfx\xff\x19\xfe\xd1/\xfd\x02ֻݾ\xff\xc5l=\xa1\x85\xc0yma\xa4\xc6E\xd3`W/\xe0ٍ\xf3js\xf5W\xaen\xae\u007fN\xcf~ߝ\xf9\xcf]\x03\U0003dfac\x10\xe2g\x1e\xbf\xf6f\x99Đ\x12\x9c\xf3\xd3\xe1\x98\x04 \xa7,z\xc9D\xbc\x1cՙ\xa1乭\xa9bm\xb3\x0f\x1e\xc6Xv\xfe#\xb2\xdc0t\xfc<K:\xe8f.[I\bhC\x8c\xde~׳}+\xc8\xf1\x8d\xa12pg\x1f\xb3Y\x88V\xa5\x90g\x92\x1f\x12J\x93\x92x\xe2URp\x8a],\xbf\xbfč\xc6\xf8\xc9\xc1\x19\xb9\xc0\xb1\x9baF6>\x11t\xe3\xc9$O\x97\x99\x04\x96\xe5m9\xf4';\x93\xd0\xf4\xacQ\xdb,\xfb\xf3{\xc4?\x82\xfe\xf5\xe4\xfeW~\r)\x03d6W\x12\xb2\x90\xcb2\xb9/E\xda\xff\xe7\xef\x1a\xbd\xa7\xe9?\xff\f\x91Y\xfb\xb2\x1c\x92\xfd@\xd7jQ-h\xfa\xf7Ԍ\xe5\xf7+%\x91:\xa5\x14\x05!\xd2l\xdf쳌2\x11\x8c\x9e\xa2\x87\x16\xb4O\xb2J\xef\xc5\xd4N\a2\xed\xf6'\xf2\x9f\x86T\x06\xde;\\^>\xa3\x02SՅ\xb05Oh\x14\x90h۶\xa0Q1Fl\xb7\x9b\xc2\a!3\x1eڋg\xa8?\xbdd\xb5\x13\x13\x85,m\xc7\x01\x9b\xa1\x87`\x9eGS/p|tNͮ\xeb\x11\xd8Iqt\x03$\x80\xba2hj\x03ú~!\xf2=\x97\x95\"\x1c\xe7̜\x18)\t\x15\xc8)o)F$\xa1 \x94\x84\x0f9U22b$q\xbc \xf2lSk\xf4\xfd\b\xbfޡ1\x16\x10\xc0r\xb5\xc4\xc5\xe9\t\x9ae\x03c\f*[\xc1XS\x90\x18\xe4\x02\xc7\xf7\x85Ѥ*\xf9s\u007f\xf2O\xe2o\xfe'\xffO|\xeb\x83\x0f\xf0\xa2w8k-~\xe5W\xbf\x82\xaf|\xe5\xcb%\x8a\xf7\xa4\xb68mDa\x97\x8b\x99'B\xe2\xe2\xbbu\x1e[\x17\xb0\xebz\xfcٟ\xf8q\xfc+?\xf5\xa7\xf1K_\xfd5\x1c\xafV8\xb1\x06\x17\xcb\x16\xb55\xa5\xf9t\xceAF\x87\x93\xb6B\x14\x061E\x18\xa5P\x19C+D\x80\xe2~\xa5\xe2\xf7\x8c\xf2\xbb2b\x18\x99\xf4'\x95\x84̫$F\xf4\x04;\x8ffEB\xe6M\x84\x18\xa1\xb1\xbf\xea\x99\xd4Mt\aG\xfe\xe2\x18i\xf5\x18C\xc0\xe8\x1dz\x97ͣ\x00h\x85\xb7\x1e?\xc6\x0f\xbc\xfd\x0e\x8e\x97G\xf0\x9eb\xb1#3\x92]\b\xf8\xdc\xdb\xef\xe0O\xff\xd8\x1f\xc6\xdf\xfa\xbb\u007f\aR\b\xfc\xa3/\xff\"\xfe\xd9W\u007f\rJ\x12B\x92\x80W\x16\u007f)\x04\xee-Z\x9c\xd7\x16\vSca*l,5\xc6!\x86\xbf\xf3\xf1\xb3\xa7\u007f\xdd\xc7\b\x95\x12B\n\x1c\xacu\xc7\xfe\xbf[\x01|o\xaf/\x1e\x1f\x9f\x9eݻ\xf7\b\x9e\x19\xf11%\x82\x8agA\x14\xd9q+[Ԗ\x844\x91\x1d\xcc\x1c\x87А\xd4(!\x15S\x90\xaan8\x03\x80lw3\xeb>ͬo\xb3[\x9efȯ\x1f\x06\xac7k\f\xa3c[W&\x0f2\x99*\x13g\xb4\xa1\x83n\xd2\xf5\x86\xc2L\xce,\xe4\x9cC\xef\xbc'Gì\xf7\xe6\xc2\xe5CDH\xe17\x9f\xfc\xf9{r\xfez*^\xc7b\xe6\xed\x8f\xe2Ɨ\xcdF\x84\xa4\xfc8\t\x01-)\xfa81l\x8f2\xc1\x10_\xa2\xa9\x1b(\xc5\xe1+\xe3H\x93N!\x1d\xd2\xea@ϧ\u007f.\xf2\xd991\xc3\xf2\x99x\x96ߟg\x9f\xf3L\xd4\x1b\xdcXv\xdf\xf9/\x90R@i\x03\xa54E\xae\xba\x01Z\x11\xf3?!3\xffC\tS5\xca\x14\xaf~p\xf3\"\x19\xf6\x0e\x8c\x8c\x8cn\xa4&PD\bf\xbb\x1fN\xff\xd94JjN\x94\x04\x8aJBJ\x81\xcd\xe6\x1a\xeb\xcd\x1a\x8a\xff\xf7\x10\xa6\xe4Ȍ0TuMn|٣\u007f\xe8\xd0\xf5;\xa4\x18\xa1\x84\x80\x95\x9a\xd7\x0e\xf2V\xfe\\\xfe\xfcbJ\xe8\xfd\x88\xf5\xd8c=t\x80$\x89\xab\xb5\r\xceO\x1fB+\r\xef\x06\x047\"\x04\x87q\xec!\x12\xb0\xa8+\xac\x9aj\x162\x95\xedwEA\x04\xf2sc\xb5FU\x19\xd4֠\xb6\x16MU\x95\u007fI^\r\xd0zG!a\xcaր\x108^X<:Y\xe0\xe1Q\x8b\x93#\x92Iv\xdb\x0e\xd1{h\xabQW5\x96\x8b\x05\x9a\xa6\x9eȹ\"\xffm\x14\x83\x9c\xad\x83w}\x87\x1f\xf8\xd4g\xf0\x97\u007f\xfa/@\n\x81\xf5\xe0\xf1b7ҳƎ{\xd9|jn;\x1c\x98!\x9f\x15\x12W\xbd\xc7G\x1b\x87M\xb7\xc3\xc3{\x17\xf8\x1f\xfe\x1b\xff&>\xfb\xee\xbb\xf83?\xf1'\xf0\xfa\xbd\v\x9c\x88\x88\xc6\xe8\"\u007f\x8d1\xe2f\xbd\x8625 \r\xa1#\xec\xc3\xd0.Z,W\v\xd8ʲ\xfb紙\x17\x98\f\x80\xc0\xde\x1d\xd9g\xc2p\xa2(!\x04bz\xcf3\x13\xae\xdc\x18\xe6\xc3&\xafZ&\"\xa6,y\fى\x93\xf2O\xd8\x11p\x18Ȣ\\\x00'm\x837\xee\xdfǃ\xf3\v,\xea\x06۾\xc7\xf5f\v\x17\x029\x8a\xb2\x1f\xff_\xfcS\u007f\x16?\xf5c\u007f\x88\xac\x96\x05\x19\x99u\x9c\xf8xh\xaeT>\xe7\x94\xf0\xf6\x83\ax\xf7\xec\x04\v%\xb1P\xd4\x041\x05\xfb\xe9\xf3\xeb\x17\xff\xfa\xd5\xfa&\xe4\xb8\xf5ё\x81\xd4\xdd\xeb\xae\x01\xf8\x9e\xe1\xff\xd7\xdex\vu\xdd`\xbb[OR\x984\xa5p\x15\xf8\x1f x\x9e\xd9\xc3\xf3\x10\x99\x18\xf8\xc1\x01\x05\xec\x04O\xff\x9d1\x15\xb1\xb2\x13u\xfe1\xf8i\x9f\x18C!~E.\xdaJ\x93\x89O\xbf\xeb\xa9(H\x85\xa6\xa9i\xe7Φ\x1b\x94SO&$6\x1f.|B\x8d\fs\xe78\xde\fKg2]f\x9a\x8bB\x0e\xc4D\"\xfb\xc4\x0e \x1b\xc4\bv\t\xe4F\x02\x93\xa6{*\xa5\x98`l&\xf2\b)X\xa6h\xb88\xf3\xcadV\x80+K\xd7JΦ\xff\xfc\x93\r\x17\x12\xad,\xbf\x8f,b\x9e\xad\x182\x8e \x15\x1f\xd2\x11پ\x99\xd8\xffi\x9a\xfeyO\x8a\xd9d'\x19\xd2O\x00\xfaq@H\tm\xdd\xc0j\x03\xe7\x1c\xba\xd9\xee_\n\xc9\xefU\x96М|\xa8\xf9H\xe8\x1197z(\x91\xa0\xc0ӿ\xd6L&\x9d\xb4\xd8)Q2^\xd3, %5\a\x8a\xcd|b\x8cx\xfe\xe2)|p\xa88\xe9-q\xd8N9\xac\x85\xc4r\xb1b\xb4\x87\xd4\x0e7\xeb\x1b\xf2\x96\x10d\xc5k\xb5\xa2\x84\xbf\xe2Ǿo\xec\x98\x12y\x15l\x86\x1e7=\x17\u007f\x86\xad\xcfO\x1e\xc0\xda\x1a~\xec\x10\xdd\bĀq\xec R\xc4QSc\xd1\x10S}\xbe\xa6тT\x1e\x9a}\x1d\x94\xa2\xbf\xdd\x1a\r\xa3\x15\xac!\xd7Dc\r\x16\x8b\x05\xee]\\\xe0\xe2\xf4\x94 zCΏ\x80,M\xc0\x04WSQ\xd7F\xa3\xaa4R\x8a\xb8\xbc\xba\xc6GO\x9e\xc1yB\xdb\xf4\x01\xbf\x02\xa5\x81\xa7\xef\r\xc1c\xb7]\xa3\x1bz\xfc\xe5?\xff\x17\xf0/\xff\x8b?\x85a\xf4x\xefz\x87\xabޗ<\x88\xc0\xaa\x99\xfc\xaf|\xaf\x01@\xe7\x03\xbe\xb3\x1e\xf0ͫ\x1d.\xd7[\xfc\x9eO}\x1a\xff\xc7\xff心?\xf8C?D\xe4O\xe7\xf0k\xbf\xf8\xcf }\xc0\xd5\xf5\r\xad\x14cċ\x17/\xb0\xdet\xd0,\xf3̡]\x8bE\x8bw\xdfy\x1b\x9fz\xf7SX.\x96\xb4.\x98\xf1\x83\xa8\xf8\xd3\xe7\xeb\x9c\xe3\x800\x92\t+6q\xca\x06:\xf3L\x8d\x88X\x1a\xfe\x848\xa9/\x90\n\x0f$\xaf\x00d\x0e\xd5bs\xaf\xacj\n٨\b@\xa3\x15j\x04\\\xbex\x8e\x18\"\xea\x8a\x02\xa7\xba\x81\x8d\xcc\xf8\xa3\xf2\x81\xfcO\xfe\xfb\xffʟ\xc7O\xff\xe4O\xa1\xad\x9bB.$\xd9o`\xe6>\x15\xf1\xd19Xc\xf0c?\xf0y\xfc\xe8\xdbo!\x0e;\xc4\xe8ѹ\x1e\x8ev\x87\x18\xfd\xf8\x97>~\xfe\xf4\xa3\xc0V\xc5#K\x83\xf7$\xbcw\xaf\xbb\x15\xc0w\xf1:\xab\xaa\xfa\x8b\xaf\xbd\xf6\x16F7`\xb3\xb9aǱlL1\x99\xce\xe4\x82#gĬ<\t{\xe7ʁ\xae\x14=\xa4>x\x86\x88I\xca\xe6\x03\xfb\xfccڝG\xb6\bFJ\x10\x92\f\\ \x05\xc6ޕ\xc3\xdbZ\x9a\xfeS\xd6\xfcK\t?\x8e\xec.h\xd9ُ\x8ck\x12ȭMrF\x01f\xb0{7\xf6{\xb1\xc3\xe4h\b\xb80\xb3\x10>\xc4\x05g\x87hq\x0fK\x98Mֳ/\xcb\xf6k\xbc\xe7ϿCͦ\f-\x15\a\xe5$\xc4l\xfb\xcaD4\xab\r'{Q34\x8c#\xa3\x1d\x92\x9b\x19I\x8cx\x96+\xce\xf5\xcb\xf3J\x96C\u007fҌ\x11\x1c\xf9 \xcbj\x80\xc1;\x84\x14g\xb8\x05\xc7\xf5*\xda\x19\x8f\xceap\x03\xbb\xfe\xd1\xf4\xbf\xebw<\x01\xb1\x8cQ\x1bZE\x88}\xe2\x9fg\x04\x86\x88I\x0e\x02\tF\xc4҈UL^̗6\xc3\xdfRI,\x96\xc7e\x85\x94\xed\xa27\x9b5\xae\xae\x9e\x97uC^3\xc5YB^U\xd7Ů\x18\x00v\xbb\x1dn\xd6\u05c8\x91\xc2~\x8cT\xa8\x8d\x85\xe5榄\xd1\xcd>\xc4!8\xac\xfb\x1e\xd7\xfd\x0eRi(E$\xcd\xe3\xe3s\xd4\xcd\x02~\xe8\x10\xc3Ȏ\x94[\xa4\x18p\xd4\xd6h\xaca$)\x81\xed0\xa1J\xf1g\xa4\x8c\x89\u007fٲV+B7*S\x91֝\to\xe7g\n\x977\xd7x\xf2\xf4\x19}ޚ\x9002\x96\x9c\xf6ڕ5\b\xcecL\xd4\bwC\x8f\xcb\x17W\xe8\xbb\x1e\xa7\xa7'x\xf4\xf0>\x8e\x8f\x8fa\x8c*\x93z\x8a\x91\xbeg T\a\xf1\nZ\x1b\\\x9c?\xc0_\xfd\x9f\xfcOa\x8d\xc1\xff\xf5?\xfe\x8f\xf1+\x1f^᭳\x16狊\xb8\x12\xb3\xeb\x15b\xc2\xd6y\\\xf5\x1e\u05fdú\x1b\xd1\xd6\x16\xffֿ\xfe3\xf8\x1f\xfd\xf7\xfeM<\xbc\xb8\a\x1f<z\xe7\xf0\xd7\xfe\xfa\xbf\x8bo}\xf5\xd7Q\x19\x81o~\xfd\x1bp\xe3\x00!\x15>~\xfa\x8c,\xef\x99D\xab\xa5\xc4\xeb\xaf=\xc4\x17\xbe\xf0y\xbc\xfb\xa9\xcf@k\x89\x9b\xcb+\x04\x1f˽-9B<\x81\x90I!\x04E@GJ\x8c\x9c\x13P\xf3\xca2{\x89\xec#\x00\xb4\n\x13J\x95\xb5@~\xac\x81I\rD\xbfVR\xa0\x19;+f\xa9a\xad$\x96Z\xe2\xfa\xf29~\xf9\x9f\xff*\x16M\x8b{\xa7g\b1\xe0z\xb3\xc5\xc9j\tk$#\x8b\x94\xc5\xf1\x17\xffԟ\xc5\x0f~\xf6s\xf8\x85\xaf\xfe\x1a>x\xf6\x14W\xeb\x9b\xd2Lk\xa5\xb1\xa8\x1b\xbc\xf9\xf0\x11\xbe\xf0Χ\x90v7\xf8'\xff\xf4\x1f\xc29\xcfgc\xa4P'!\xffڳ\xcb\xe7\xff\xafm\xb7+\xcf}\x8aw\xb0\xff]\x03\xf0\xdb{\xfd\xcc\xc3G\xaf\xd9\xe3\xe33\xbcx\xf1\x04\xfdn[\x0e\x8cB`\x93\x93\xc5(\x84\x80\xd23(\x8e\xe1\xe4\x9c\xc3. !\x92\x80\v\x8e\x1d\xd9*\xda\xfd3\\%\xa4D\x18G\xf6\n\x88e7\xe9\xfd\xc8\xc5_\"\xa6\x80\xbe\xef)\x8dMI\xb4M\x03\x91\x04|H\x90P<\xcdz.\x88j*\xd8e\xff\x9f\xc9C\\\xa8\xa4*2\xba\x12\x11<\x93䅜\x1a87\xf0\x11\xf3n`\x9a\xba\xc0P\xf5\xad}[\x9a\x16\x00\xd9q-\xeb\xc1'\xdfr\xda\xdf\v9\xedPQ\xbeNbѴ0Z3\xf1oD`I\x9fV\xd3$\xaa\x19\u009e\b\x87\a\xf6\xac<\xcdD\x1f\x8a\xe1P^5DF[\xc6=\xbd\xf0\x9c\x04%\xa0\x94%\xbf\xfdq@\x8c\x01\xab%\xed\xfe{ף\x1b\xbb\x19\xca\x00*Z\xb3\x043\xc1\x8e\u007f\x9e5\xee\x8e\x11\a+S\xf1\xaf\xb7ZC\xcdN\xdcl=\xec\xa3G\xbb8\x82\xb1\x15k\xc6\rA\xfd1\xe1\xf2\xf2\x19\x86~\x87\xb6]A*\x8d\xc8\u038ds\xeb\xe7\x86e\x83\x99\xbfrss\x8d\xd19.\xc0\x02\xad\xb5\xa8\xd851Oy\xf3Op\xf0\x0e7\xdd\x0e7}G\x05\x9a%\x96\xa7'\xf7p\xb48E\xe0i,\xa5\x88\xbe\xdb\x00a\xc4\xe9\xa2Ae\xf4\x94a\x80\xc9\xdf\xc1*f\xfb3\x89QIڽK\xfe\xef\xac1X,Vhۖ\x9bX\x0e\x85\xaa,\x16\xcb\x15\xea\xaa\xc1\a\x1f}X\xb86\xe4\x81\xc1dQD(-\xb1X5\x90݈\xb1\x1f\xd1u\x1d!4]\x8d\xcdv\x87\x8f>z\x82\xa3\xa3#<\xb8\u007f\x81\xb3\xb3\x13\xb4\v\xf2L\x18\x86\x1d\xe4V z\x8f\xd1Z8Oұ\xb3\xb3\v\xfc\xbb\xff\xb3\xbf\x82?\xf9/\xfc\v\xf8\xf7\xfe\xa3\xff\b\xff\xf4\x97\xbf\x8c\xf7\xaf.a$\xd0\x18R\xa4\x04\x00\x9d\x8b\xe8C\xc48:T\xd6\xe0_\xfa\x89\x1fǿ\xf1\x17\xfe\xdb\xf8S\u007f쏳\xa2Fb\xbb\xe9\xf1o\xff\xcf\xffm\xfc\u007f\xff\xf6\xff\x1bG5\x9d\x15C\xdf\xe3\xbd\xef|@\xbbz>S2\xc9\xefs\x9f~\x1b?\xfa#\xbf\x1f\xaf\xbd\xf96\xb4\xb6\x18\xdd\b\xad7\xb0\xd6\x16~Q\x96\x88\xc6\x10\xb9\xf1\x9c,\x81'c21CuR\xb1\xf2\x9ew\x9b\x89\x8d\x83\n\xd9XL\xcfn\xfeY\x82\xb9\x00\xf9|Q\x8a8?\x8ac\xab\x8d\x94Xh\x8d:%\\\u007f\xfc>\xfe\xf1\xcf\x0f\xf8\xe1/\xfc\x10\x1eܻO\xae\x91\xeb\r\x16u\x85E\xd3\x14[`!\x80/\xbc\xf3i|\xfe\x9dOad$m\x18GR\x82hSTA_\xfd\xe7_\xc1\xdf\xf9'\xff\x00]\xb7+$_\xa5\f\x84T?\xb7\xeb\xb6\u007f\xe5ɋg\x93\xdc\xf8\xeeu\xd7\x00\xfcv_B\x88/\xbd\xf1\xc6;\x88)\xe2\xe6\xe6\x92\xe0\xf3\f+\x03{\x10Y\x02\x11]\xc8~V\x96D\xad\x18\xa6I^\xc6,\xf7JeZUJr\xf1G\xe1\x00d演\x92BS\xa4\x80\xe0`\xa1q\x18\xe1F*Pu]\xa1\xb2\x16\xde3\x12!Eq\xad\x9b\a\xdcd|\x82\xb4\xf2\xec\xce\xc7\xff\x0e\x91\x98\x846\x15n\xc9\xfc\x05\xcf\xef\x1d3٠\x80`\x0f\x81\xe9\\\x17\x13C\x8cW#yw=O\x1cߏ\xf8\xcc\x060`\xbf\xee\x8cD\b\xce1\x0f3خ\xb65\xea\x9a\x18\xbe\xa3w\x18ǁ\xdc\xf5\x98\x0f!\x05\xb9\xb8If\xf6ߦ\r\xe5U\x8d,\xbc\x82\xbcޠ`\x13\x8f\x14=\xc1\x86\xa3G8Ȇ\x97\"Ǭj\xb8@ӿV\nu\xd5 \"a\xd7w\x14\xec\xc4S\x90\xe6<\a\xb0\xe3\x1d\xf9\xfd\x03\x8eM\x94\x88\x13\xe2!\x91\xa0\xc5t\xcd+\xado\xed<S\"\xc2^\xd3,\xca'\x99I\x8eC\xbf\xc3\xf3\xe7\x1fCk\x83\xa6i\x8bD\x95\x8a!}\u007f]5\xa8l]\x88\x9d]\xdfc\xbd\xd9\x14\xb2\x9a\xd5$\xfb\x93b\x92\x9cEL+\xae\xc1{\\u[\xac\x87~f8\xa3\xa8\xf8/O\xe0\xc7\x0e)\x06 F\xf8\xb1\x83\x11\x1e\xcbe\v\xa3\xd5\xdeg/\x00\x18%\xd9]\x90\x9c\xea\xac2\xa5\x81Μ\x99\xcaZ\x9c\x9d\x9d\xe1hu\x82\x8aY\xdd\x10`T\x8d\x9e\xb9\xb7\xdex\x13m\xd3\xe0\xeb\xef}\v)\x01mۢۑ\xf3\"\x18\xbe\x96R`\xb1\xa8a\xb5®\xeb1\xf4#\x86aDUU\xa8\xab\n]\xd7\xe3ɓ\xa7h\x9b\x06g\xe7\xa7x\xf8\xe0\x1e\xce\xce\xcfg\x92[2\xccy\x96\x12\xban\x8b\xe5r\x85?\xf7'\xfe\x04\xfe\xc5?\xf2G\xf1k_\xff\x1a\xbe\xf2뿎\xaf\xbd\xf7\x1e\xbe\xfa\xf5\xaf\xe1\x17\xbe\xf2\x15\xbc\xb8\xbe\x86\x14@[\xd7\xf8\x83\xbf\xef\a\xf1?\xf8K\xff]\xfc\xb9\x9f\xfaS\x80R\xe8\xd7\x1b\\\xde\\\xe3\x17\xbe\xfcK\xf8?\xff\xfb\xff>~\xf1\x9f\xfc\xd7XU\xf4\xbc*c`\xaa\nB\xa9\xf2\xf9\xc6\x10\xd0.Z|\xeeSo\xe1\x0f\xfc\x81ߏ\xb7\xdf\xfd,\xaafQ|AR\x8a\x9c.*J/\x9e\xa7\xf9\xbcz̮\x9d\xc5Z9NJ\x009#\x82N\v<\x14Ts\xfe\xe4(\xe6+\xe5{\x94\xd0\xca\x00eH\nK>\"\x80\x86@\xab$\x8c\x04$\xc8\x04\xe8\xd8H\xc4\xcd%~\xe5\x17\u007f\x0eO\xee=\xc0\xc9\xc9\x19\xce\xce\xef\xe3\xec\xec\x1ev\xfd\x80E\xc3\xe8\x8eT\x18\xc2XΒE\xdd`ٴe\x10\xd8v;\xfcگ\xfd<\xfe\xc9\xcf\xff}\f|\x1f\xe6\xc1\xa02\r\"\xf07\xd67\x97c?\xf4\xc0]\xfd\xbfk\x00~\x87^\x9f;>>\xfd\xb1\xb3\xf3{\xe8\xbb-\xb6\x9b\x1b\n\x9aI\xa90\xdb\xf3^8[nZM\xfa\xfc̘\xcdD\x14\x82=eI\xd3\x12\xbc\xbf3\xc6\x14kZ)\x15\xdcؗI\\\b\tD\xf2\xdcW\xac\xcbN\x88\xe8{\x92\xe8)\xabѴ\r\x90D\x89\xe2\x04'\xf8a\xef\xd8\xcd\x1eށ\xedf\xc9NU\x80\xcczz7Ф9ͻP\x92\xd6\x10>\xbb\x9e\xa5Í\xbf,H@>\xa0\x11\xd3,zeF\xf9\xcbfDb\x1f1\x10\x8a\xa6\u007f\x89)3AJ\x1a\xff}\xe2he\x91`\xa4Ʋ\xa5\x9c\xfb\x10)\x829!\xc1hMNm\xac˗l\xb8\xb4\x9fq\x9c\xf6\xf4\xcc\x11\x81\xf3\x04f\xde\xeal\xffKq\xbf\x01c\xd8'\fe\x84\"\xc79\x0f\x8e\x10\x9aU\xbb\x82\xd1\x16\xc38\xa0\x1f\a\xf6=\xc8\rKU\x88\x88ZiHFR(\xb4\x86\xcc\u007fR\x8cЂ\xa4c)\x01\x15\x13\xe4R\xb9\x9cT\x84\x83\xf7\xd0ڢn\x97%9\xcdh\x8b\x10\x02._<\xc5n\xb7\xc1\xd1\xea\x18\xcc #s\xaa\xdc\xc8\t\x89f\xb1 ^@\fH\t\xb8\xba~\x0e\xcf\u0383FQ\xe0\x8f\x90\xb4\x1aɇ}\xe4\x85v\xefG\\u;lǑ\xa1y\x83ʶ89\xbe\x87E\xd5\xc0\xf7;\"Υ\b\xe1\a42PQ\x90⠁\x120Z2dN\xeb\x90\xca\x18\xdecS3V\xdb\n\xab\xd5\x11\xce..ptt\fSՅ\xcbQ\\\xeaxE\x13\xbc\xc3\xc3\a\x0f`\x8c\xc57\xbe\xf5M\xac7k\xb4m\x8b~ KfB\xb1\xe8o0u\x85\x95\xd1\xe8͈~ף\xeb:\f\xc3@\xfbq\xa51\xf4\x03\x9e_^\xe1\x1b_\xff\x16\x8eO\x8f\xf0\xe6\x1b\xaf\xe1\xd1\xe3G89=C\xbbX`\x18=v]\x8f\xcd\xfa\x1a/^<\x85\xadj<8Y\xe2\xe4\x87>\x8f?\xf2\x83_@\xd3,\xf1\xf4\xf2\x12\xbf\xf1\xde{\x18܈\a\xe7\x17x\xe3\xf1kx\xfa\xfc\x19\xfe\x9d\xff\xed\xff\x06\xdf\xf9\xf0C\\\xde\\\xe3\xdb\xdf\xf8\x06\x9e|\xedk\xd0>a\xd9h&l\x1a\x18\xab\x8bUpL\tJI<~|\x1f\x9f\xfd\xd4;\xf8\xdc\xef\xf9\x01\xbc\xfd\xe9\x1f\xc0\xd1\xf1);=^\xe1\xfa\xea\x05\x82\x0f\xd4TJ1\r\x1b3\xd2h\xceP\x88!!\xb0\xb2$\x0f,eYɾ\x13\xe5\x11-\x8d\xcfL\xfa\x99]\x9a\x18\xadS\x9ad\x92\xe38\xb0ASEn\xa6R\xa2\x15\x14\x0f.b~\x0eRY\x9f\r\xdd\x06\ufff7\xc1\a\xef}\x1d\xca\x18\xbc\xf1ƻx\xe3\xedOc\xb5:\xa6,\x83\xaa\x82e\xd4\"\xab\x11\x9c\xf7\xd8u;<y\xf2\x11\xbe\xf6뿊\x0f\xde\xff\x06\xd2\xc1`\xa3\xa5f\xc9c\xfaӾ\xef\xfe\xc3;\x9f\x9f\xbb\x06\xe0w\x96\xfc\xf7ڛ\xa8\xea\x06\x1f}\xf8\x1d\xec\xb6kz\xf0\x04\x1f.\x02\x10\x89'\xa6D\xfb8m4[\xe6N\xf0\u007f\xf0\xb4\xab\x12I\xb0vYB\x8a\xc9\x1f|d\xa8+x\x8fq\x1cK\x02 M~\x81\x00y\xde=\xf7\x03A\x9aB\n\xb4u\r\xa3\f\xa2\vE\xeb\xeb\x82/\xfe\xf3R\xca)\r\x10\xa9\x90\xdc2aH)\xcau'\x19\xddT\xfe\x15\xbb\x87e\xa2ڭ\xdd\xff\x8cLWv\xffS\xa9\xbd5uC\xec7\"\x99\xf0%\x84\x80\x9c\xf1NUq\x1bL\x13\x11\x11\x1c̢M\x89\xcbM\x91\xfc\xf1\x15_?\x82\x81\xf5\xd4X\x1cL\xd0\x138\x11\x90B*\x064HD\x80\x8a1 \xb8\x11\xde\a\f\xdes\"\x01\xf6\x9a(\xa9\x14\x941܀\xf4Вv\xff`?\xf4\x1cT\x93\x99\xffU\xd5p\xf1WP<Q\x8f\\\x98=g\r\x00\tFNSV\xa5\xf5-k\xe5\xc8\xf7\xc0\xea\xf8\f\x8a\xd3ܔ\xd4\x14X4\xee\xf0\xfc\xc5\x13TU\rc+F\x9a\xc2,\x992\xa1nZX[!FR&l\xba\r֛5Y;+\x89\x93v\x01\xcdz{\xad\xa6\x0651\xec\u007f\xd5\xedй\x01BiX]a\xb5<\xc1\xe9\xf1}h!\xe1\xc7-5\xa2\x88Pi\x84\x94\x91\xb2*\xca\xfaK\x94\x06\xcaj\rͶ\xcbVk4\xb5Ee\xe9з\xdab\xb1\\\xe0\xf8\xe4\x14GG'X\x1e\x1f\xa1m\x97\xb0U]\x98\xeeJj2\xd2r#v\xdb\x0e\xddn\x83a\xe8pzr\x02k>\x83\xf7\xde\xff\x0e\x9e_\xbe\xe05\u0080~\xe8\x11S\x86\xb0\xa9\xe1^,\x1b4\xb5E?\f軁%\x8acA\x82\xbc\xf3x\xfe\xe2\x12\xdf\xf9\xf6\x87X.[,\x97K\xac\x8e\x8e\bѓ\x12\xedb\x81\xd5j\x01c\r\x11\xed\xbcÓ'/\xf0\xe4\xe3g\x10I\"\x86\b\a`\xeb\x1d>\xbc|\x81\x8f\x9e?\xc78\x8e\x10\x00\xaa\x14q$\x04\x8e\xad\x81j\x15\x17\u007fz\xa6\x8bKoJ89>\xc2g\xde}\x13o\xbe\xf1\x18\x8f_\u007f\x13o}\xea\xf7`\xb9<&\xe5\aGv\x8f\x1c:%\xe4\f\xa6/0\xbe(\xc5\x1f \xf3\x9e\x18\xc5\xe4\xe2('9-\xb0\x1f\x146\xb7\xd9$\x1f\v\t!\x99c\x00Z\xd1(\x80\x1ba\t\xad\x80\xd5\xea\xa8\xfc\xb4Z+\xf8\xa4\xe1\x93+\xeb\xbbC\tiB\x82w#\xbe\xf6\x1b_\xc1w\xbe\xfd5\x1c\x1d\x9f\xa1]\xac`mE\x91\xe9l\x1b\xee\xbdC\xdfw\xdc\xf0<\x87\xf7\x8e\xdd\x0e\xa7\x95\xa6R\n\xb5\xa5\xa8j\xa4\xf8\xa7k\xda5ݵ\x00\xbf\x9b\r@q\xa3\xfb\xfe\u007f\xa9\xb6]|\xe9\x8d7߅\x1b\x06\xdc\\?\xc78\f\xc5\xc7=\x17\xbe(r\xe1%\b\xdeT\xb6dp\x03(\x8e[Z+$\x9f \xa4\x02b(\xe5rt\xa4\xff\x06ü%\"\x98h\xdf\x04\xd1)b\xb7\xc7\x14\xd1\xf7d\xdaR75\x11\xbeb\x9a\b8\x02\x1c\xdc\x03\xb6\x9f\xa5d\xb0<E\xa6\xe2A0I\x15w}Ǥ1\x14\xcbO%'\r\u007f\xba=\xfaߺ\x1f\xf2\xfbM\xb3=;M\xdd\x02B\xb0\xd7\x1f\xef\x11%\xc0\xc5_\uec70\xb3\xecG@\xc0\xf3\xb5@\xa2\x15G\xcb\xc15\xa3s\xf0\xa3+\x81Hy\x9fo\xf2ʅ'\xdeyFQ*f'\xa4\xf1\x97\x1cw,0i\xeb\xbd\x1b\xe0\x83\xa3\xdd?\xdb\xf7\xe6\xbfE\xe5}\xa72\x90B\xa2s\x14\xff\xbcl\x960\x9au\xffn\x981\xff\x05\xdaf\xc1\x85P\x169\xa3\xf3\xa1\\\u007f\x97\x9b\x18\x19\xcb\xf7\xd5\xd6\xec\xe5ԧ\xd9\xf4/\xa4\xc6bu\\\xfe(\xa9\x14b\b\xd8\xedȆu\xb9<*\xab\x02ǍE\x8e\x89],W\xb4\xc3\xe5\xc2~y\xf9\x02!\x04\x18\xa5q\xd44hJ\x81U\x84\xf8$\xba\xff\\p\xb8\xdcm\xd0\xf3ﷺ\xc6\xc9\xf19N\x8e. R\x84\x1bvH\x88\xd0\"\xc1\xc2C B(\xfaL\xe7R\"%%\xacVe\xddSi\x8be\xdb\xe0\xe4\xe8\bM]\xc3h\xda鯎\x8f\xb1:>\xc6Ž\xfb8:9-dW!&\u007f\x00\xb2iv\x18\x87\x11\xddn\x8b\xcd\xfa\x06\x9b\xcd\r\x946\xf8L]\xe3\xec\xf93|\xf8\xe4#\xac\xd7[\xb2\xb2\x1ez\x04\x1f\x90\xd8\x04'&@h\x81VK4M\x85\xd1y\f\x9d\xc30\x8e\xf0\x83\xc38\x06.>\x1d\xd6\xeb\rb\xf8p\x96\x8a\x97\x18\x05Ҩ\xab\nU]\xc1V\x06n\xf4\xd8n;\xec\xb6;\xecv\x1d\xad\xe2\x04 \x94\xc0\x85\u0590\xc6кCIh)\xe9\x1a1\xe1QHAC\x01\x12\xea\xda\xe2\xcd\xd7\x1f\xe3\x9d7_\xc7\xc9\xf1\x11\x1e<~\x13\xaf\xbf\xfd)4Ͳ\xac\xb8b\x8a\xe8\xb6\x1b\f}_\xa6\xf5y\x93\x9a\x9f\xc5\xf9\xfdOE\x9fH\xc7\x10\x82\xd5J\x13柽\nr\\x6\xfb!\xf5\x0e=Ò\x03\x99D@A-\x95V@\x00\x8e\x8fO'\x9brI\xfe\x189\x91\xd0y\x87\xa1\xef\x91\x10\xa7\x9f;#\xe2\x8e㈧O>DJ\xef\xcf\xee\xfb\xc3YC\x94\xb5S\xa9?\xa0\xb3m\xb58Fmj\xfa\xfc|\xb88\xb6\xf6\a\x01\xfc\xc2]\x19\xff]j\x00\x0e\xbb\xb9\xef\xf3\u05cf\xbf\xf9\xd6;o\xae\x8eO\xf0\xecɇ\xd8n\xd6\x04\xcdǈ('N-\xa3c\x04\xad\xab)\x94%\xf1\xdd\x1cBdқ\x9c\x1a\xec\xc8I|1@\x8a)\x1et\xce\xc0\x8f\xc1CJ  \xa12\x14U\xea\xc6\x11C?\xc0h\x83\xba\xa9\x8a\xd9G\xde݅\x18\xe0\x82\x9b\xfc\xe7\x95a8mr\xc1\n<\xa9\x1a=S\x1d$\x94x\xcf<\x85\xfb\x18^\u00a0\xddׅ\xedC\x8aiN\x13(ġ4\xdb\x02O\x93\xff~\xf1/\x9c\x83\x92\x98\x18\x8a\x82a\xd5.\b\xa9\xe0\x88^\x95\x1b\x05\x96\xd6im\xa1\xa4\x9e\xa6\x14L0|nTC\n\x94?/Ti~\n\xe1)D\xf8q\xc0\xe8<:\xe7\xd9jg\x1f\xe5\x90lL\x14cD\xd7w\xec%\xdf\x00\x10\xe8\xfa\xae0\xffS\x02\x9a\xbaAUU\xd4\fJZO\xb8\xe8\xcb\xf5'\xcf\xfd\b\x91\x12=l\t\x05\x0e\x87\xd8ON#\x9f\xf6\x80vy\x04[ճ8\\RA\xac\xd77\xa8\x8ce\"U\xa0\x9c\x89\xe2\x89 \xb1X\x1e\x91_\x02\x93S\xaf\xaf\xaf\xb0ۮ\xa1\xa5\xc0Q]\xa3ֆ\v\x13\xe5C\faj\x12_tkt\xa3\x83V\x1aM\xbd\xc2\xd9\xf1},\x16+\xa4\xe0\xe1Ǟ\xfc\xf8E\x80\x15\x9e'l\xc1\x8d\xd9t\xdds\xf1\u05ecΨ\xeb\n\xabv\x81\x8b\xd3s\x1c\xaf\x8eP5\r\x96\xcb#\xb4\x8b\x16\xedr\x89\xd3\xf3\v,WG\x04\xe9f\x02\xa3\x92\x13\xd9K\x03\xda\x1aTu\x8d\xa6]`ut\x8cq\x1c0\x0e\x03\x86\xbe\xc7Ç\x8f\xf1\xfa\xebo\xe0\x83\x0f?\xc0\x87\x1f}\x88\xab\x9bk\xecv;R\x8b\xf0\xb5KI \x81\xee\U0006a4b0\x95A\xebk\x8cÈ\xed\xa6\xc7n\xdb\x17\xcdx\x96S\x96\xd4Δ0\x0e#\x86n(\xc5i\xee\xff\xdf4\xf5\x1eb6\x97\xcbe\xa3#\x99\x9b$\x96\xd4Yk\xf0\xf0\xfe\x05\xde~\xe35\x1c\x1f-`\xab\x1a\x8f\xdf|\x17\x8f_\u007f\x1b\x96\x8d\xbdȪ[\xc2\r=\xd67W\b>Ll\xfc\xfc\xfc(Y0\xb94{Ls\xb8V\fbZ\xd5\x15\xee\x92,\xa1>\xb1\xc8a\x13\x92@A\x812\xbf@(\t\xe1\xa9\xd1\xcfr\xdb\x18#\xceN\xcf\xd04\r\xbc\xf70Z\xa1\xb6K4U\x8b\xe5r\x85\x04\xe0f}\x8d\xa7ϟ`\xbb۔\xf3bo\r\xc8\xebH@\xce̮^]w\x84\x10X\xb6+\x1c\x9f\x9cC%\xe2\xf3h\xce\bXj\xfd\xa7+\xa5~a\bw\x9a\xff\xbb\x15\xc0ow\xfcW\xeaK\x0f\x1f\xbe\x8e\xe0=nn.1\xf4;\xc4@\xaev\x89\x13\xf6\x04\x1f\x12\x91\x8d\x81\xacV%\x9cfN\xe0\"\x1dx6\x04\xf2\xc5\xff=\xcb\xc2\xc4<-\x8f\xf5\xb9B\x92\xa4(\x818\x05\t\xec:\x17#\xdaeK\xb0e\xc0tP\x02e\xf7[\xa6Qf\x12{\xef\xe7\x9a8(\xa3\x01Aј{Ns3y^\bq\"\xfc!ݚ\xfeK\xf1\x17\x87\xfe\xdc\xdc\x06\xccd\u007f\x89\t\u007f\xaaD\x03\xdfn$3\xe90[\xd7*\xa9\xb0h\x160\f\xfd\xbb\x91\xb8\x17B\x91[\x1b\x04\xb9\x18j\xadg\x90\xe7>s=\xbb\x959\xce\t\x90*\u007f\x0e\xd3\xef\x0f\xc1\x91\xf9\x88w\b\x89\xd9\xf8<BenG\x86\x1e\xfb\xb1\xc7\xe0FZI\x18\v\xe7\x1d\xfa\xb1+\xd7\xc8j\x8bE\xbd(;n)$5\x1fYo\x1d#峧\x04#&s\xa3\x86#\x8f\xf7\ue6cc\x06\t\xa0],\v)MJ\xb2m\x1e܀q\xe8a\xab\xba\xac\t<\x87\n%$,\x96G\xa8놹!\x02\xdd\xd0\xe1ŋ\xa7@LX\xb4-jc\xa0\xe5\xe4J8\xb2\xdeZ\b`=tع\x11F\x1b\xacVg\xb88}\x04kjD?\"0\x01\xad\x12\x1eV\xfa\xc9\xc9QR\x92a>\xa45\x1b\xfahcP\xdb\n\x8b\xa6\xc5\xd1j\x89\xc7\x0f\x1e\xe1\u07bd\xfb\xc4\xe4oȹ\xadj\x1a\xd4mC\x1e\a\x8a\f]PP*} \xb7\xa5N@J\xfa썵\xa8\xeb\x16q\xe9\xe1܈\x93\xd33<|\xfc:\xae\xaf.\xf1\xc1\a\xef\xe3\xfd\x0f\xde\xc7\xd3gOq\xb3\xbe\xd9[\x81%b\xcdQӫ\x05Zݠn*\xac\x8e[l7;t\xdb\x01\xde\x05\x84\x98\xb9\x04S{\x9b\xb3<\x0e\xa91{\x869r*\xf2JM&:y\xfd\xb6h\x1b<\xbc\xb8\x87\xfb\xf7\xcfp\xbcZ\xc2X\x8b\xd5\xea\x04\xaf\xbf\xf5)\\<xT\x02~\xaa\xba)\x1e 7W/\xb0ݮ˳=\xfd^Y,ó]u\x9cۆ\v\"\xfa\x16\xc2m~^\xe4\x140E\xd2\xdd\x04\xa58\xa5t\xe6\x0e\x98\x89\xac\xf4\xfd\x89\xb8 \xc6`\x18F\x1c\x1f\x9f\xe0\xf4\xf4\fϟ?\x831\x16\xab\xe5\x12u\xd5\xc20\x9f\xa5\xb9x\x80ӓ3<\xbf|\x86\x0f?z\x1f\u038d\a\x91ɉ$\x8f\"N\x8e\xaas\xc9.\x9b\x84\x91\xab\xa6\xa2\f\x86f\x01k*B\xc7\x12+\x11\xc8\xcb\xe2_m\xb4\xfe_\xdd5\x00w\r\xc0o\xf7\xd5\x1c\x1d\x9d\xfc\xf4\xd1\xf1\x19\x86\x9e\xf6\x8d\xe3829*\x02\x88\xe5`RR``F\xbee\xa7\xb2\xfc\xe4\xa4D\xee Zk \x8aY*^,)V\xb9\x18̓[\x12\"\x14\x14vޡm\x17\xac\x12p\xe8\xfb\x01\xb6\xb20V#\x85\x84ٚ\x953\xd9\xfdd\xdb\xc9\x18\x1f\x99\x8b\xa4=\x1fz\xab\r\x9cw\xa5\xc0\xe4\xce_2\\X\x12\xb7\xf2\x81\x9e&\xaf1\xccH~s\xaf\xf8i\a\x99\xf6\xb9\x02\x1c\xbd\x9a5\xde\xe2\xd5\rׄ<$\xa0m\x1b\xd4\x15\xf9\x14x\xefȫ>\xfb\x05\x80\xa4?FO\xeb\x16\xf1\x92\x9f\x1cc\x80\x1bɪ\xb4p\x04J\x032A\xec\xbbq\xc4\xc8&M\x19\x0e\xcd(N\x14\x82w\xee\x11]O\xba\xe4\xb6n! Ѝ\x1d<O\xffRH,Z\x82kՌL\xe7fr<\xe7\x1d<\xa3>F\xd254ZMn\x8c\xf3\xdd\u007f\xf4\xac\x1cѨ\x9aE\x89QVl\xe9:\xf4}\x89\xaa\x8d1`\x1c]\x99\xde\xeb\xbaA\xdb.\n\x9e\xea\x83\xc7\xf3gO\xe1\xc6\x01\x8b\xaaFk\xed\xc4\xf5\xc0\xe4K\x00\x00\x9d\x1b\xb1\x1dGXU\xe1\xf8\xe8\x02\xe7g\xe4\xee\x17݀\x10\x1dd\n\xa8\xa4\x83\x16\xa1\xa8=\xb2r __\xab\x14*kPU5\x96m\x8b\xe5b\x81ӓS\xbc\xfe\xf8uܿ\xf7\x00-\x93\x12c\b\x90J\xa1n[\x18v\xabL\x85X\xab\x88\x15/\xb3\xa4Sf\xfez!\x95fY ,E\xec\x1ac\xe1M\x85\xaarX-Vx\xf4\xf05|\xee\xb3k|\xe7\xfdo\xe3k_\xff\r|\xf8ч\xd8\xed\xb6\x18\xddȮ\u007f\x98\xa1\x02\x04\u007fS(\x90\xc5x\xe41t\x03\x86\xc1q\x82g@`\x9b\x01\xc1\xaa\x8d\x14\xb3\x8f>\vg\xb9\xc1U\x9a\x1d@崒\xca\n\x1dk\f\xceNO\xf0\xc6\xe3\x878^-`\x8c\xc5\xc9\xd9=\xdc\u007f\xf8\x1aN\xce/дK6ﱰUM\u007f\x93\x1b\xd1\xf5[\\]\x12\xa7\x80\x9c6E\x91\xe6*5[{\t\xcc\x18,\xd3s*f\x01A\x99\x0fP캋!V\xa4I\xbc\xac Sy\xa6\t\xa1S\b!2ra\xd1\xc9\x1d\x16\x8b\x05..\xee\xe1\xfa\xf2\x92\f\xbd\x98؛O\t-%\x96m\x8bӣO\xe1\xde\xd9\x05\xbe\xf1\xde\xd7qy\xfd\x82LѤ\x98и\x94fAD\xb3\xf5\x06\xab\x19\xf2\xd9E\xfe\x19\x9e\xef5\x01\x11\x89\x9fc\xb5\xc1\xe8\xfdg\x16Z\x1f]\r\xc3\xcd\\\x02{\xf7\xbak\x00\xbe\xdb\xd7O\u07fb\xffhi+\x8b\xe7\xd7\xcf\xd1u;f\xe6SQ\x913\x0e\x00X\xa6\"\xa4DUW\x13s\x96\vP~p\xb2\xb1\x8fgW*+5I\xd6\x18\xba\x9f\xe4[\x81t\xc1l\xe3Y\xb1\xee{\x18(\xeb\xbenj\xc4\x10\xa1\x92B\xc1\x90A\xdeڎ\xc9\u007f\x13W\x03d\xeb\xcb_\x96\xb8\xd0\x02\x89\xfd\xeagpsJ\xd0ܼ\x84t\xb0\xfb\xdfk\xca'F\xb0\x00PX\xb79ed\x0f\b \xa2\x92\x16\x92\b`\xb3\xdd\xe4\xde\xf4\xaf\xa6]y\x88\x01\xc6\x18\xb4USX\xffş\u007f\x9e\r_\xa4\x96b\uf697\xc9>\x06\fcO,g\x8eG\xa5\x8c\x02Ņ^\x00)\xa0\x1b;tyo^\x00\x15\x9e\xc0\x01\x86a\x15\xbcwp~DmkT\xa6\x82\v\xec\xfa\xc7\xff\xb7\xa8\x97\xacW\xa7\xe9W\xf0T\x1d\x18\x92\x8f1\xc2\xf9\x91m\xa0\xb3\xdc\x0e\x14z$\xe6\xbb\u007f&%\x86\b\xa4\x88vqB\x04\xbf\x82H\x10q+x7)&\x8a{#\x85$\xadVǳ).\xe1ٳ\xa7\xd8\xdc\\\x93\xb6\xbe\xaeˁ\x1eS\xc28\v\n\x1a\x82\xc7\xcd\xd0\xc1h\x83\x8b\xb3G8>\xba\a!\x05\x82\x1f\x81\xe8a\x92C%\x1d\x04\xe6\xc6/b\x8f\x81\xdeV\x16\x8b\xb6E\xdb,\xb0Z-qz|\x82\xd7\x1f\xbf\x86\xd7^{\x1d\xcb\xc5\x11\xbbXR\xe3\x15@f5Zi\x96\xce\nV\x84J\b1\x11i'\x12)\xafw\xc4aH\r\xa0\xaa\nQGj4\x9d\"⭎8\xb3\x15NN\xce\xf0\xe6\xebo\xe2\x1b\xdf\xf8\x1a\xbe\xfeͯ\xe1\xd9\xf3\xe7\xd8\xf5=\x1c[0GVf\xa4\xf2[@I\x9b\xfcL\aFc蓖\xbc\x9a\xa1h\xef\x9c0)\x8b\x8fA.\x80\\8\xd9OC\x1b\x8d\xa6\xaeqzr\x84\x87\x17\xe7X-\x168\xbdx\x807\xde\xfe\fN/\xee\x17\xdd~F\xb5\xb4\xae\x88\x18\x1ch}\xb4\xbe\xbe\xc4f}\x8d\xe8<\r\x1fizv\x8a\x03\xa9\x10\x98\xf7\xe2i\x0e\xa1\x8b\x19<\x80}g\xcc\xc2\x01\x8a\tIbv^L\xa1X\xd4|\nx\xb2Z \xbbm\xada\x82\xc5\xc5\xd9\x05\xbe\xa9\xbfƟS\xe2\xc4N\x14S\xa7\xdc|\x9f\x9d\x9c\xe1hu\x84gϟ\xe2\xe9\xf3'\xb8\xbe\xb9&\x02-\xab<\xe6gWy\x1eb\x84g'\xd4\xdc$T\xd5)\xb4\xb1d\x9c$\"g7(()\xeb\x955o\xa6M\xfa\xe5C\x12\xf0]#\xf0;\xd8\x00|\xbf\x13\x01\xb5\xd6_z\xf0\xf0\x11bL\xb8Y_\xa1\xeb\xb6e\xd7Nd\x17Uv\x9c\x81\x0f\xf9\xbafR\x1e\x04\x92\x88eBPR\x17;Y\x17\x1c\xc9dXʕw\x9d9\xe65p\x01\x0f1\xa2w\x0eGˣb\b2\f#\xaa\xdaBJ\x81\xbe\xf3\xb0\x95\xd9c\u070f\x9e]\xf1\x18\n̖\xafi\xe6\xd8'\x05u\xe4\xa3seǇ4\x87\v)\xa1.\x85W\x11iS\x81\x1f\xa7\a\x8a\x8fM\x91f@\xa9\x80\xe0\xe9AKI\xe1/\x823\xe5\x0f\xa7t$X&\xcc%N)l\xdb\x16\x86\xbd\xfe\xa7t\xbe\xa98\x18cK(O\x96\x89M\xb1¤\xc8\x18]_$N\xa2\x90\x9fh\x1f\x9b%\x8b\xce9\xac\xbb]\xb1pM3?\xf7\x94\x12 %\xd9\xcd\n\x82\xdc\x13\xdb\xfe\x12\xa4\xde\x13\xa9P\n4\xb6A[5\xacsאLdt%H\x88\x90\x18Ϻ\u007f\xc3\x10\xa8\xe1\xc9e\x0e^\xa4\xc8\xe9}\x89\f]V\xc7g\xb3\xb5\n\x11\xe1h\xb5C?;\xb2\xb7z\x96\xca-\x17GĘg-\xf7\xf5\xf55\xae\xae^\x00R\xa0\xb6\xe4+/X\xb5\xb2\x1d\xe9>PB\xc1E\x87\xceyh]\xe1\xfe\xc5k8Z\x9d\x91\xbc48\xa8\xe4\xa0\xd2\x00\r_\x96,j/\x03\x83\x12%\x8f\x8fV8=>A\xcbS\xff믽\x8e\xb7\xdf|\x1b'\xc7'\xb3}9\xdd\xcfJ\n\x84\xa08Â\\\xfe\xc89s\xf2\xe6\a\x93\xd0r\xc7M\x9fO,V\xdcs\xc5G\xe4\xeczSYH\xa5\xe0F\x89\xc0~\x1aJJ\x9c\x9d]\xe0\xe4\xe4\x04o\xbe\xf9\x16\xbe\xf5\xcdo\xe0\xfd\x0f>\xc0\xd5\xfa\x1a\xdbݖx\x04n\x84wd\x86\x95\xfd\x1f\x05#9\"&&\u245dsJ(h\xa0\xe7$J\xccl\x80\x85\x94䩠$\x8c\xa2H㶩\xb1Z\xb68?=Ã\x87\x8f\xf1\xfa[\x9f\xc1\xbd\a\x8fa\xab\x8a\x10\x0fvV\xd4Z\x174,\x17\xc0\xddf\x8d\xe7Ϟ`\xb7\xdbN\x04V\xbe\x1f\xe6E?\xf3pJ\xe34#\x02\xe6\xa6d\xa6\x13\xd8'\x12\x8a}۬|\x8d'\x1do\xa2\xf5\x93 \x92\xb1\xd6d\xd3\xec\xbc\xc7\xc5\xc5}\xd4u\xc3\xf7#%kJ\x9dWtD\x00Ͷ\xdbJj<~\xf0\x18\x8f\x1e<\xc60\x0exq\xf5\x02O\x9e~\x84\xab\x9b\xabbQ.\x98\xb4Y8\v\x1c=\xac\xa4\xc0\xf2\xf8\x18\xab\xd5I1\x8dJR\x12\x9fF)TJ\xe9F\xeb\x1f\x11B\xfc\xf2]\xf0\xcf\xefb\x03\xf0}~q_;99\xffɳ\xb3{\x18\xba-\xd6\xebk\fl:3\x87\xaabJВ\xd8\xff\x00P5\xf5d\xd9\xcb\xf0\u007f\xbe\x89\x13\xfbR{O\xf1\xc1&\x1fv\x893\xdeKPPD\x8c\x04Ò\u07ba\xa2\xfd\xac\x1b\x01$تB\xdf\xf7ť/\xc3\xf4)\a\b\x81Ҿ2\x90\x16g\x90\x1aū\xaa\x12>\x13\xd3T,cL\x85\xa5\x9d\x91\x88Oj\xfe\xf6\xa0\u007f\xb1\x97\xf2;\r\xff\x82\x1c\x06\x8d$\xe8\xffe\xc5?!11P\x15$\xa2\xb2\x16\x95\xb1\x80\x10\x1c\x1a\x12\x8a\xb4_J\x05\xab-\x14\xa7-\xcaܰ\xe4\xff'\x88\xf07\x8e}1\xfb)\u007f\xbf\x98\x02\x80\xf2;\xd9\xf4;\x8c\xceME\u007fV\x84S\x02\xac\"\x89Z\x8c\x11\xe38\xb2\a}\x85\x10\x02\xfa\xa1\xa7\xcf\xddTX4\vZ\a\xf1.2\xa5T\x12\xf6\xe8\xef\x8a\x18܀\x18\"\xac&\x96\xbc\x92\x02\xd6\xe8\xbd}h\xe2\xfc\x84\x1c\xfd\xbc<:\x85\xad\xdbR\xcc\xdd0B\xb7\x16@*6У\x1f\xb9\xf8I,\xda\x15\x8c\xa9\n\xe1m\xbb\xdd\xe2ٳ\x8f\xa9\xc8\x1778\x14tb3\xf4\xb3XX\x81\xbajq\xef\xe21\x8e\x96\xa7|M\x03T\x1c |G~\x05\xb3i<\x17_\x01\x81\xb6ip\xef\xfc\f\xc7G\xc7\xd0Za\xd1.\xf0\x85\x1f\xf8\x02\xdey\xeb\x1d(\xa5I\x82ȍiJ\tJ\x90~]iBX\x14\x1b\x06M\x93\xea\x01\xd9d\xfe\xdc\x15\xc3\xfd\xfd;i>=jcȬFK\xb8a,\xa4R\xa94\xee?x\x84\xf3\xb3\v\xbc\xfd\xd63|\xf8ᇸ\xba\xb9\xc4\xcdf\x8d\xf5z\x8da\x18\xd0\xf5\x1dv\xbb\r\xba\xbe/Q\xcdd\xee\xa5إ\x90\x02\xb4jk9ˁ,\xb9\x83\x0f\xc5C\x9fl\x8c5\xea\xca\xe2h\xb9\xc4\xe9\xf11N\xcf\xcep\xef\xfec\xdc\u007f\xf8:\x8eO\xcf\tY\xe2\xe98s(\xa4\xd4\xd3s\xcd\u007fS\x88\x11W\x97\xcfq\xfd\xe29\xc0\x8dH!\xa7rCU\x8c\xb7\x048M\xe2\xb6\x15\x96\x14\x12I\x8aɑ3\xf3627aJ\xbc*\xf7\x03!H\x13\x14O^\x11\xe4DJk\x80\n\xdd\xd0\xe3\xe4\xf4\x14\xa7'\xa7\xe4\xfd\x1f)gB\xaa\xc94h\xbe\"̍\x11\xb1\xfb[\xb4M\x8bG\xf7\x1f\xa2\xeb;\xdc\xdc\\\xe3\xea\xe6\x1a\x9b݆\xd6~\x81}K\xa4\xc4b\xb1\xc4\xd1\xd1\x19\xaafQ´\xa4R\xac\x92\x91\x10\xe4,\xa9\x96\xc6\xfchJ\xe9?\xb8+\xe5w+\x80\xef\xf5\xf53\xaf\xbd\xfe\x96\xb2\xb6\xc6\xf3g\x1fa\xbb\xb9A\xf4\x01J\x99\x19\x938\xf1tM\x0e~J)\xd4u\xcd$\xb483\xbf!\xb82E\xdaQ\x06\x9eԴ҅8\xe6y-\x90\xb8\x10\x0f\xde!E\xa0Y\xd4\xfc\xb0Q\xb0\x87\xadl\xd9\xf5\xae\xda\xc5^q\x1b3\xb3\\\x00Q\xa4\t\xe9\x9bM\xb4\x99e\xef\xfcHf4\xf3.\x1f\x89 WL\xec\xdfW7\x00\x87x\x00\xf6\xd9\xff\xf9F\x92\x12F\xb2\xb6\xfc%\xd6\xc0ٜ's\x03r\xcaamkh\xa9\x10S\x80\xf7c\x19\x02\x95R\xb0\xc6\xd2\x14\x92S\x16\x81=\xe6pV\n\x840\xe5.СH\x16\xa5\x82\xd7\fH\xc0\xe8\x1d\xb6\xdd\x16\xe9V\xf9\x9f\xa6+\x8a[\x15\x18zJ9k\x9b\x15\x94R\xe8\x86\x0ecp\xa8\x8cŢ\xa1P\x165[c\x8c\xb3\xbd\u007f\xe6w\x90\x1e<\xc2H2\x13RJ\xde\xda\xfdG·H)\xc2V5\x96Gg@\xa4\xc3}\x18{ &T\xb6\xc6vs\x05\xb0\xaf\x83簤\xb6Y\xa0i\x17\x85,\xe7C\xc0\x8b\xab\xe7L\xa8$\x98|\f\x0e\xca\xd3u\xe9\xddt\x1f\b!\xb0lV\xb8\u007f\xf1\x1a\x9az5\xc1\xa6a@\x1a\xb7\xb02\x955E\x81\xfd\xf9\x9eZ.Zܿ\xb8\x87墅\x94\x12G\xcb#\xfc\xc8\xef\xff\x11\xbc\xfe\xda\x1b峑,\xa1\x9c\x8axd\x82\x1fY7\xcfv\b{\xbb_\xc1Ml\xcar\xd4\x04\xb6L\x16\x13\xb9\xed\xa0\x01(S\xaf\x940UE\xeb\x1b\xe7\xa6܇\x94\xa0\x94\xc2\xc3ǯ\xe3\xec\xfc\x1ev\xdb5\xbc\xf7\xd8\xf5=n\xd67Xo6\xd8n7\xd8l6\xb8Y_\x11L\xddQ\xa3\x1831VH(M\x90\xbd\xb1U^(A\bb\xf5/\x9a\x06M\xbb\xc0\xf1\xf11\xee\xdf\u007f\x84\a\x0f\x1eau|\x8a\xban\xc9\xf8HJhk\xb9\xf1\x15\xb3\xf7\xbf\xcfv\x8f!`}u\x89\x17Ϟ\xc0;Ǆ\xd7I} sfF\x9c\xf8\x18\x19\xc9:Pݕ\xef\x99\x1a\xa8\xec\x13\xa2\xf6\xbc\x04\x8a\x11\x90\xb8\xc5\xfb\xa5\xb5\x95\x94\x10!\x14\x14@+\x8d\xe5b\x89ӳs\\>\u007f6\xf9H\xe4\x100^qʹu\xb0\x9c\x82\x89h\x97\xa4\xb0Z\x1c\xe1xu\x8c7^#41F24s\xe3ȫP\x8b\xc1\x05<\xbf\xbeb\xeb\xe3Tδ\xc8+X%%Vƞ\xb6\xc6ȝsw~\x00w\r\xc0w\xff\xaa\xeb\xf6/?~\xfc&\x86\xa1\xc3\xcd\xcd\x15\xfa\xa1\x9f\xed\xadS!wI)\x11\x02Y\xee\xb6\x15\x91\x98\xe6{\xf3\x12\x0f\x9a\x04R\n%e\x8f\xac\u007f5\xc7qF\x9er\x89\xa8\xd7s\f\xb01\x86\x13\xb4X^\xc8\xd3\xe5v\xd7q\x9e\xbc\x9e\xed\xac\x13\xe7\n\xc4\x19\x8e'\x8a\xb2`N\xb2#4\xc1M.]\t\xb3\x840Q\x0e\xe7O\x9c\xfeqH\xfc;\xd8\xeb\v\x0ez\x91$\xff\x9a\xaf\x18\xe6\x8d\x035%9\\\x84%Q\xc6\xc2d\x94b\x1cY\xd2Fa VW\xc5\xf5KJ\xb5\xff\x1e\x12O\xfen@\x9c\x15\xffҘ\xa4\x04pX\nxW\xde\r;87\xe2\xb0\xfegv\xb7R\x846$\x8e\xbf\x95B\xa2\xb6\x14\xa5\xbb\x1b:h\xa9\xb0lW%S!+:\xc6\x18\xa6\xd8d\xfe\x99\xe3H\xbb\xe6\x85\xd5P\xcc\xfe7\xb3$\xc6\xfc\xb7\x84\xe0\xb9\xe0\x03\xcb\xd5)\x94\xb6\xd4h\x02جopq\xef\x11\x84\x04\x86q \xa74G֩u\xd5bѮ\ni+Ƅ\xcb\xcb\xe7\xe8\xfb-\xcb\xc3\bE\x0f)b3t{\xbba\x01\x81\xe5\xe2\x18\xf7/^Ge\x9bR\xb4\x93\xef\x91\xc65*\xc9\xfbY\xe4hhQ\xa4eMU\xe3\xfe\xc5\x05\x8e\x96\v\xce\x058ŏ\xfe\x81?\x88\x87\x0f\x1e\xcdB\x91\x18j\xce\xd2>\x0e\xb7A\xbe\xdfR*ɇ\x02\x1316A\x90\nfVH\xf2\r6\u007f\x1f)\xdf\xef\x05\xdeHe1%\x01\x88\x8a\n\xaew\x8e\xe5n\xe4\xaf\x01\x014\x8b\x05궅\x1f\a\x9c\xf1\xaa\x81\x12\x1d\a\xf4C\x87\xae\xeb\xb0\xdb\xed\xb0ݮ\xb1Y\xafq}s\x85\x9b\x9b+\x8c\x1cR#\xa5\x821\x16\xb6\xaa\xb0Z\x1e\xe1\xe8蘧\xe13\xac\x8e\x8e\xb1X.Q\xd9z\xfa\f8\xe7@p\x18\x95\x98\v\xf2\xa7\x1b\x81\x9a\xc8a\xc0\xf5\xe5s\xbc\xff\xde7psu\xb9\xf73r\xe6E\xfe\xfe\x18Ք\x1dR~\x8cx\xc9\xf3+\x01\x04\xca#\xe13\xa54QٻDL\x90\x1e\x9d\r\xfb\xa4\x02\xc1\xa8\x83\x10t\x16j\xadQU5\xce\xcf/\xf0\xde7\xbf\xceM\x1e\xa9\x9d\"K\f\xf3\xf7)~\xdf\xfbY\x03\x92\xef\xd9\xc8\xf7\x025\t\xc6(\xca:hZF\xc5\b\xe5\xb2֠\x1f\xc6\xf2\x99KF\x18\xc8\x05T\x88V\xab\xb3\x86L\x03\x86C\xd4\xfa\x8e\ap\xd7\x00\xfcf\xaf\x1f\xb9\u007f\xff\xc1痫\x15\x9e?\xfb\x10C\xbf\x83\x1b]\t\xafIB\"\xb1ǵJ@ǩ[MK\x9d=\n$\xcf02'\xf0yO\xe12\t\xac\xcd\xe7\xaf\tޓ,\f亖\x1b\x88\xa6n\xd8\xc7;\"\x82\x9a\x06\xe7<\xdc\xe8\xd0V\x1c\xfb\xcb{\xf1\x10\x02\xc1\xcd3X>\xb3}s}\x94\xccN\x1e\xdc\b\xe7|aZOn{T*C\x8c\xb7\x8a5\x0e&\x80=ӟ\x97<O\xb9\xf8\x1b>\xe4\xfdK\xf8\x04%l\xa8d\x8c\x03VS\x12\x9d\xe0\x95GNETZ\xa3\xb2\x15\x94\xd4\xc5>Y\xccv\xfe\x00\x10\x92\xc7\xc8\xe1<\xb7\xde\x14\xefeM\x9e:\x12\xe0\xa2Ƕ\xdb\x12\xfby>\xfbϲ\x0e\xac\xb1\xd0Ja\xf4#\x9c\x1f\xa9\xc9\xd3\x06\xa3\x1b\xe0\x9dÊ\x8d\x80(JWCB2\x9a\xe3\xb9\x0fK\xcc\xefH\xe8\xdd\x00%\x05j5\x91\xc4\xf2ߚf\xd3?\xb9>FX\xdb`\xb1:)\x93\xdans\x03\b`\xb1X\x12\x19q\x1c\xe8\xefM\x84\b,\x16G,]$\x0eŋ\xabgX\xaf/KS\x97W\xebioMCꆣ\xd5)\xceO\x1fQ\xac0\a\x05\x85~\v\x116h\x14Y\xf7f\xddz^\x1fPT\xaf\xc5\xf9\xe9)V\x8b%\x94\xd2x\xfc\xe81~\xe8\xf7\xfd\x10\xceN\xcf\x19\xf2\x9f\x9a\xc5CwF!\xe5\x8c\xf1\x9d\xca>:\xbf\xb34\x93\xa6ͣ\xa0\xb3g\xf2^\xb4\xf3<e\xf2%\x8c\x15\x91P\x8cj\xa2\xf7\xb4\xc6H\xba|\x8bR\xe4\xca\x17\x1c\x91\xf9\x9aڠi[\xc4x\x8c\xe0\x03\x9c\x1f18\x87a\xa0k\uef47\xf7\x9e\x15\b\x15Y\xd8\xd6\r\xaa\xaa\xa6{\xc6\x10\x89O*=kJp+T\xe7p\xe2ϏS\x88\x11]\xb7C\xb7\xdb\xe0ų\x8fp\xf5\xe2i\x81\xe4)e\xd4@\x19=CT\xb2\a\x80F\x80\x9byw\xa4[\x8b}1'\xf5rӝ\x95\x10b\x02,\xb1/\x00\x9c\x85x\xcd\xd4\x1e\x19Aȩ\x8d'\xc7'\xa8뚜\x05s\xa4o\xf0\b\x8a\x1a\x15\xa5\xd9\x03AJ28\xcb\xe6B\x00\x14\x147\x16\x89=B$\x89?\x92\xe0TDI\xda\x04N\xbb$\xc5\v5\xc6R\xa9\xb2&\x10R\x88J\xa9\u05ce\xad]\x1e6\x00w\xaf\xbb\x06\xe0\xb7\xf2\xfa҃\a\xaf!!a\xbbY\xa3\xef\x89\xe8U\xd9z\xc6DN\x90B!\xb0\xc9O&1\x15g\xb9<ٰ\\\x06\x91w\xee1\x94\xd4:\xb0\x9a`db\xd9\xe8}I\xf4\xa2\x8e\xba*\fn)\x04|\x02\xfaa\xe0\xe8\xdf*\x1bw\x93\xf3[p\xac3Oe\xdaͱ\xa0\xb9\x98\x1b\xa9\xca\x1e:\xcd\xd8\xee1\xc7\xdd\n\xb0\x14蓋\xbf(\x86\xc1\xe9`\xb2\xa0\x83H\t\x01\x9b\xf7\x8aYN8\xa5&L\xc8\b\x9f\xe59\xfe\xd7j\x03k\fA\xd7\xde\xf1u\x01\xefZk2\x98)Ĩ\xb4פ\x84\x18f\xc5\xff`u̓\f%שB\xde\xea\x87\x1e\xfd\xd8O\xe4\x85\xdc\b\x94\x18dI<\x04\x9e\xde\x05\x04\x1b\xff\x90\x15q]\xd5D\xde\x02\xbdG\"8F8\xe7\xe7n\xaa\f\xc5S3\xd3\x1aBD\x04\u007f\x1eY\U000966f6Ҍ\t\x89\xa3\x93\v(mJ\bP\xdf\xefp|r\x0e)\x04v\xddṖ\xea\xaa\xc1ry̅\x9e2\x1e\xaeo\xaeqssY\x1a\x90\xec50_\x95\xa4\x94`m\x8d\x8b\xb3GX\xadN\xc9\x050\x01)\x05\f\xdb\x1b\xd8ء\xadt!o\xe6=\xb1\x14\x02\xc6\x18,\xea\x06m\xdb`\xb5Z\xa2\xa9k|\xf63\x9f\xc5\xef\xf9\xec\x0f\xc0\x1a[\x18\xf1\U000ca4e1i\xb0[\\\x994\xf7t\xac@\x12\t!N$Ӊ\xde9Iل\x94\xe5c\xcbl\x8e4G\xa6\xf6\xff\xdfԘr\x13\x80\x10\x91D\xda#\xb2\nf\xdf\xfb\xd11۟\x83\x9f*z\x16\x17ȼ\x1f⡐W\x01\x8a\x0f\xc8\\f\x97\xf7\xdb/kD\xcb%y\xc5$\x1a\x82G\xdfu\x18\xfb\x0e\xdb\xcd5^<{Z\x9a)j8j\x8e\xf0\xce\xe6Dl\xdc\x13y7\x1f\x15\x00\u007f0\xf6\x1f4G\xdc\x15I1\xc5\x18\x17B\xe0\xcc\xf3#\x1d\xc6}\x8b\xd9\xeaAQ\xc6\x00eG(^\x03Pr\xe3v\xb3\xe6k\x8br\x86\x19e\x18\xfa\xa7fR(E\x9e\x1e3\x15\x89ʿ?\xa5\"\x0f\x14R \xa9yf\x01\xa1N\x9bm\x87\x14xu\xc9ʋ$%\x0f\b\xfe\xf5\x8b\xba~,\x84x~G\x04\xbck\x00\xbe\x9b\x97:::\xfe\x99\xfb\x0f\x1e\xa1\xefv\x18\x86\x1e\xfd\xd0M\xfb3!\x8b\x9b\x9b\x10\x82'\xf7\x84\xba\xae\xc9\xe6w\xd67\xc7,\x19\x12\x02\xce{x\xe7\xf8\xc1\xd1e*\x1b\xddH\xeema2\x8a\x01\x80\x9aeg1\x05z\xc0#I\x00\x9d\xf3h\xaa\x8a&\xd9R4\x88l\x96u\xeb\x05\"\x9d\xa94\xe8\xd0Tl\x97\xe9gS\xd6\xc1d\x9e>);;\xedE\x01\x97Cy\xfaVҶ\xcf\x1er\xd2\x17\xc7í\xff\xd4LpA!6qU\xae\xef\xc0\x04E\xa3\f\x15\u007f\xa5J\xd6\xf9\xde\xc1\x04\xb04ox\xe9ڢ\x00\xc1BBk[F\xe0\x10\x02\x91\x8cB\xc0\x9e\x95Kn\x80\x041\xb7\x8dҴ\xbb\xf7\x8e<\xeb\xb5e\xcd?E\xbf\n.*\x92\x03\x9e\x9cs%!\xb1\xc0\xe8\x00\x86\xec\x05\xafT\t\x8e\xa2f\a\xb7\x1b\x80\x18\xb1X\x9db\xb1:*\xbb\xfcq\x1c\xa0\x8d\xc1b\xb1D\b\x1e\x9b\xed5ƱGmk,W\xc7\xe5\xb3$\xd2\xdf\x06\x97\x97ϊ\x86;\xd7!bs\xe7\xf7\x16\xd1\xd6Kܿ\xf7\x06\xdafE\x857\x0218t\xbbKTq\xc0\xaa\xb1%k!\a4\xd1JD\xa3\xae)\xbd\xadm\x1a\x9c\x1e\x9f\xe2\v\x9f\xff\xbd\xf8\xf4\xa7>Kź\xc0\xf9\a\xc4\x10\xf6<\x90Be\x9bŃitj\x0e\x8bW}6\x99:\x98\x98\x8bT0a\u007fe%\xa6U\xc0<\xfciN^\x91Z#$\x96\x94\xa6\xc9J:_']YH\xe7Y\x8d#\v\v\x9d&R\x01\xa9\xf4\xa4T\x98Oٿ\x05h\xf97\x83\x9fc\b\xe8\xfb\x0e\xfdСﶸz\xf1\x8c\x9c/Y\"i\xac-Qׅ(J\x1f.Y#ĽZ=\u007f\xac\x0fx;\xb4\x1c\x11\xec% J\x9ag\x9a\x03\x14\xb3\xb4\xef\xe9^\xce;w\xa54\x90(UT\x1b\x03\x1f\x03\x96\xcb\x05\x8e\x8f\x8f0t\xbb\xb2nLy\x85\xa7g\xcfpI}\xdc\xf3\xeb\xe6\xa4GY\x10TB\xcaf\x04\xc2D{\xfe$%n\xb6[t]\x98L\xd08\xb3@*\x05\x0f,\xcf\xeb\xea3)\xa5/ߕ\xf3\xbb\x06\xe0\xbby}\xf1\x9dO}\xee^\xb3X\xe2\xc9G\xdf\xc68\x92\xbd\xe8\xfc Ȯ~@bC\f\x05[մ\xc3\xca\an\xf6\xdb\xe7\xfd\xf78\x8eEÝ\x99\xd8.\x90\xa6<\xc4H\x841~֔R\xa8\xd8\xf25?\x81\xcey\f\x9c4W3\x12\x91]\xbbB\xf0\x85\r>\xc1\xadlu\x1b#\x92\xc8\x1e\uf465\u007fYK\x1e\xd9\xfas\xb6\x02\x8f\xe9\xb6\xef\xff\x1c0.\xe69\xb7\x8b\xad\x04M\xfe:{\xf4\x03\b\xd1\xefU\xeb\x84)\xb3 \u007f\x8dRĪWJ!1R\x12B\x80֤\x80\xd0J\xb3\x91ʾԀ\x02u\xc8\xc1\x0f\xe9\x15\x9c\x05\x9e捱\x94\xb1\xce\aY7\xec\xb0\xeb\xbbY\xf1\xdd\xdf\x11\xd2\xf7P\xf8\xcb8tH)\xa0\xaeH\x8e\x99\\\x82цW*\x8a\\\xf4\x12\xe0\x02\x05\b\x1d\xf2\tB$\b\xb9R䒗@\x99\x10\x869 \xa5\xf8\xa7Ȧ8\x06\xc7'\x17\x85\x88\x15b\x80w#\x96\x8b\x159\x11\xf6\x1d\xb6\xbb5\x11\x04\x97Ǭf$\xf2\xe7nG\xc1@1\xfa\x82\xd4Ln\x8c\xdc\xdcI\x81\xd5\xea\f\xf7\xcf^\xa3\x18c\xfe\x9b\x87a\xc0\xb0y\x8eF{\xacjZ}(&6\xe6\xc9\xcdh\x83\xaa\xb2\xb0Ơi\x1a\x9c\x9d\x9e\xe1s\x9f\xfd\x01\xbc\xf3\xf6\xbb<\xa9\xe7iX\xce\np*\x96\xcdy\xd7+f\xe6Ws\x12)\xa4\x84L\xfb\x16\xb6\xd9\v\x80\xe0\xde\xfd(\xe9\xe2\t\xc1\xc53\x1d\f\xd6\xfb\xa1J\xe0x`\x89$\x15{C̋\xb8,ӭV\x9a|\x16\xb8\x89/\xbfK2\x8f!#\x17\xdc,\xbe\xb2\xe0\xe3\xe0\xef|\xf9\x96\x82\x8a\u007f\x8cT\xfc\xfb\x0e}\xd7\xe1\xe6\xea\x12cߣ\xaa*he\xa8\xe0\n\x94iYf\xcb\u07bc\x93O\tBL\x86\x1ci\xber\x99_\aq\x9bϓd\xbeV3E\xc1\xc1\xb5+\xe8\x11#0z\xb6ް\xc6\xc09\x87\xba\xaeq~vN|\x85\xe20H\x04U%e\x86\xfc\x8a\xe3g6L\xcb\x11՚\xd7\x1aJ\xa9b\x9d\x9c1 Q\xe2\xc2\x01]W\xb8\\\xdf\x14\x1e\f!8\n\"\x92\xd9WJI,\xad}\xfb\xae\x94\xffn4\x00\xdfǐ\xca\xc9\xc9ٗ^\u007f\xfdm\xec\xb6k\xdc\\_\xa1\xef{\x8c\xe3\xc0Lۉ$\x97}\xd7cJ\xa8\xaa\nƚ\xbd18\x06f\xe1K\x81\xe0h'\x9c\x0f=\x95]\xdb\xdc\xc0\xc5\xdfO0|\x02\xed\xba\x95\"8W+x\xe71\xf4\x03\xc7\f\x1b\"\xc8e\x92M\f\xe8݈\x90\xc2^\xa2^&\xf5\xe4\x86DH\x01\x1f=\xdb\xc4&v=;D\x04\xd3'~\xe6E\xbf\xcd\x060\x87\x96\xc0F*\x18\x8e\xe5\x15\xa0u\xc2\x1c\xa6Oi\x167:\x93\xbd\x19\xad)\xe7\x00\xc4Pw\xe3X\x94\x00\xa6\x18\xc3L\xf2\xb1\xfcÜ\x1b(PG\xcc\xe0e\xb1\x8f3\xd0gE$\xad\\\a}\bX\xef6\x85\xa4\x97\xf6\xb0\t:\xa0\xb4\x10%ypt#\x942\xa8L\x85)َ\x8a\x051\xb2\xc9}1d\x05\xc6\xde;\x10l\xfa\x94`\xb4*)\x88\x95\xb6{\x93-\xad\x8bȉ\xef\xe4\xe4\x82<\xffcD\x12\x80s\x03\xa5\xa4\x19\v\xe7\x06\xac770Ƣ\xa9\xdb\xc2\x1b\x90B`\xcb\xc5\xdf\aW\x1aU̚є\"\x8c\xb68=\xb9\x8f\x93\x93{\xd0\xca\x16\xd6\xf8n\xb7A\xbfy\x81\xd3ZbQ\xd53\r6J\xf3\x9b\xc3kH\xedRaѶx\xfd\xb57\xf0\xf6\x1boA\x80\xa4j\x19\xf6ߟ\xbeg\x05_썕{\x93j&\xfa)%g\xc7\f\x05\xe7\xb0\x19\xf3$\xf5\x9c#\a`89{ڋ\xc3\x1b\x9b\xe0n\xbaG\x98[\xa0\x15\x92K\xd3[ISq\xcf\x1a{e\x88l\x1a\x9c\xbf\x85R\xfcV_iv^\xce=\xf8_6\xf9\x8f\x03\x9d5n\x1c\xb0\xdb\\ù\x11U\xb3 MQL\x05\xd9Ȯ\x83\xf4L\xc4i;\u007f\xd0̋\xbd\xc4\xce}\xdeGA\t\xb9\x99)k\x80÷8]\xa0Y\x13\x90\xf3<$\x84\xa4\x95]\x8c\x11\xbb\xae\x83R\x06\x8bv\x01c4r\u007f\x15\xe7M\n0[\xe3\b\x96\xe4\xd2gK\x1c\x01ŉ\x94\x14\xfcc\x8cfu\r\xaf\\R\xa2\x8c\x06\xa7\xb0l\x17\x1c\xd6\x14\x91B\xa0\x95\x84\x97\x90\x94\xc2*\x8d\x94o>^.\xc4\a\x9b\xed\xdd\x0e\xe0w\xb2\x01\xf8\xbe\xbd\x9aB\x9c\xbd\xfd\xeeg\xbeX\xd7\r>\xfc\xe0=\x84\xe0\xd0\xf7;\xb6b\xc5\x04E\x89\xe9\xa1\xd5Z\xa3i\x1bJ\xc5\xca8[\xda\xc7ܜs\x85ɮ\xb8\x88\x8en(Ľ0\x83\xae\xa5\xcc\xd3\xff\xf4\xc0\x0f}\xcf:u\x01\xabmiB\xbc\xf7\x18\xbd##\x9aC\"\x91\x98\xb8\b\xd9\xcb~ʇ\x17\x04S\xe7\x8a,>y4\xa1\x9d4&NA&6f\xe9R\xa2\xbf˲\xbe>\x17\xda0\xdb\xc7\xcf9\x03r\x96E\xae\x84D]\xd5ЊB\x89Fv&\xacl\xc5S\xb6\x9c\f\xa7J\xed\x8f\x18\\\xcf\xf1ŘB\x88fq\xc4\xf3\x9b\xd4r\x9e|dB\xd9f\xb7E\xc7\xfa\xfd\xc2t\x9e\x86\xc0\x92\xa0\xa7\xf8=\x85\x18\xd0\xd6-\x8cR\bH\x05\x0e6,\xe3\xcc+\x9cɌe\xd6R\xa4\x84\xd1;h\x01h\x1e]\x8d֥\x19ȅ/\x1fl\xc6V8:>-\x93V\x8a\xb4\xd3\xd7\xda\xc0{\x87a\xb7\x81\x10\x12m\xb3 \x1e\x04\xcb\x03\xb7\xdb\r\x9e\xbfx6\xe5\x00\xa4\xd9$\xc8FPm{\x84\xfbg\x8f\xb1X\x1c\x95\xfb4\t\xa0ۭ\x11v\x97\xb8\xb70h\xac\x99oC\xca\xfd\xa3\x04q:\xb4\xa2\x1cx\xab-\xee\x9d\xddÛ\xaf\xbf\tm\f\xff\xbe\xc9t*\x85\xc9\xff\"+<2\xac?[\xf6\xcfn9\xfe\xccb\x04\xb8\x89d&Zɢ\x97\x10\x1ch\x18\xcb\xf35\xad\b\x04\x0fⲠ>\x82\x1b\xe0\x14\x12\x99r͐z%\x14%h\xe6gONd\xb4\xa2\x88\xe1\xf8g\x80\xd3<\xe7Ď\xd9}&\x0e\x1f\xbc۸ل\xc2\x1c\xc6ⲻ\xa0w\x8eM\x88F\f]G~\xf7u\xc3\xde\x02\x94|\xa9\xb4dWOW<\x15\x8c2\b,\xff\xc3L\xf2[\x02~^r.\x14@\x83\xe1\xf5|ߊ\xd9\xff͛\x86\xa9\xc1 \x83\x1f\x9845\xc1ܘ\x90?\x02)\nr|\xf3\x10F\b\xc8Y\xfa\xe8\xec\x9f\xf9=\x16\xb3#\x01X^o(N\x134Z\xf1J@\x15\xb7\xcb\x10#\x9c\x10Pѣ]\x90\xea\xca;\xc7q\xc6\xd4T\xb05\xb6T!|Ἦ\r\x80q\u007f\x96\xb9S\x02ܭ\x00^>\xfd\xff\xf4\xa3\xc7o6\xbb\xed\x86t\xff1\xa2\xeb\xbaR\xfc\x84\x14\xf0\x9e\x0e\xe4\xec\x9fo,\xc9\u007f$g\xbd\xf3\xb0Ql3SH\xf0\xcecb\xf0*x?\xc2\xfb\x11\xce\a\xb89i-\x81<\xfe\xb5!\xb2`\xad\xe1\xc7\x11\xbbn\xb2ɬ\f!\r>\x04\x8c~\xa4\x1d\xf6K\xd8\xcf\xc5\x164\x1b\xfd̼\xb3\xe7ҿ9\xcf\xf7\x95\xedެ{\xe7\xd0нCBIA.~\xf3p\x9d\x14o9\xea\xe5\x9d^y\xf8bBU[\xd4Ɩ\bۘ\"*SA\xb3%\xaa\x9c%*&A\a\xe6\xe0\xfa\xd9\xdf2G\x16\xf8pI\x99$\x18\xc9JUY>\x17\x05\x067\xe0zwSv\xc6i\xbe*\x10\x99;\x91\xd8\xceW\u0085\x99ￔH\xde\x13T\xc9;qj\xe2\\)\x1c\xe9\xa0M\x0e\x81\xd8\xe2V\xe6dF\x89ښ\xbd\x86+\xef\xb0}\x8c\xb8wr\x01\xad\xcdtx'dH\x13\xdb݆\x1aDk\x8b\xe6\x1b)a\xbd]\xe3\xc5\xd53D\x1f\xf6\x02`hED\xbc\x80\xb3\xd3\a\xb8w\xfe\x10FW\x93Sc\n\x18\xbb\x1b\x18\xbf\xc5\xf9\xaaad\xa9\xacŋ\xd3n\x96\x9fj\x8e\xa3\xb6\xda\xe0\xfc\xe4\f\x0f\xef?@\n\xe4\xb9`\x8cAJ\x01!pQM(\xae~y\x15\xa6\xe4\xe1!\xbcG\xbd\xe0\xdfI\xb9\x19I\xa4\xa2d\xc9^\xf7\xe0\xd8\\Q\xbeq\x96j7\xfb\xfcD\x81\xe6\x89Y\x9edD\x8c\x82\xf5\xfb\xd34.\x95B\x9a\xbb\x99\xb2\xe1\xcd~\x8c.1̳\v\x1dRnBRQƥY3r\v\u05587\n\aAO>\x04D\xefx\xd5\xe0\xe1\xddH\xfb~N\x84\f\xde#\xc5\x00\xcdJ\x14)\x15\xe5\x83(\r\xef݄J\xf0\xcal\xceM\x92R\xc2\xfb\x88}\x99a\xdaC\x00Ԟ\x8a\"M\xc1@r\u007f\xe5\x97Iy\xf4#ba\xff\xa4r\xfd\xa7\x8c\x80<\xc0\x18cɬL\xa6\xa2\xe6\x98[4$n@\xd4\xcc\u007f\xc0\x1a\vk\r\x15\u007fC\xf7Z^\xfb%n\bEQ\u007fH\xb4M\x83\xa6\xae1\f}I(\xa4FN@*)|\b\xef\x9eV\xd5\n\xc0\xf3\xbb\x92~\xd7\x00\xfc\xa6\xaf\xd7\xdfx\xe7g\xaa\xaa\u0093\x0f\xbf\x83\x18=\xc6q\x84s\x03g\x91\xab\xa2_\xa6\xdaE\x01&\x86\xedK3\x1d\x8e\x1c\xffbaᗨݲ?'/x\x17\x02\xc6\x03\x97:\x8a\x97\xad٭O0\xa1\xab\xe3fC\xc0\xb2=h\x88\x11\xc30 \"\xbeR\xaf/f]\xbe\x10\xd40d?\xfa\xbd\xa2y0\xfc\xa7\x97\xd4\xffy\xe2\x1f\xe2$\xdbB\x99\xfc5Y\xc2\xf2\xd7\xf8\x14\x8bf7e\x1d12\u007fB\x14\xe2\x92Q\nmM܉\x10\x02R\b\xa8m\x8b\x8a-~\xf3\n#\xef\x1ec\b\x854y\x1b\x8d\"\x16tf\x1c\xc7H\x87Re\x1bj\x80X5p\xb3[\x13\xca\x00\xb1\a\xcfN\acb}\xb5!\xf3%\xef)\xda\xd7\xd8\x12\x10\xa4\x98\xbd\x1f\xb9\x19\xa1u˴\xf3\xe6\xfe\x83\x0fy^\xef\xf0\x0e\xbc\xb1\x86\xf4\xef{\xdd\v5?\xf5b\x89\xe5\xf2h2s\x99E/\rn\x80\x92\x143\x9d\x8b?E\x01_\xe1\xfa\xfa\n!z&]r\x91c7Ac-\x1e\\\xbc\x81\x93\xa3\v\x9a\xc0\xf9\xef\xf5~\x84\xefo`ӈUS\xb1\xafE\x89K\xb8\rժL\x0048?:\xc5\xf1j\x85\xb1\xef\xf0\xe2\xe93\xb8a\xc4\xc9\xd9\xe9̝\x8e\x11\xa0\xe0\vy\x10)\"%C\x13g\x81\xa8&X}j\x06\x12\x82\x1b\x89ɝId\x89\x9c1\xa5P\x1c\x00)\xf6\xf6\xee\xfb\x05\xfc\x80\xab\xc2HJ.4)\xa6[q\xb3sb!0y@̘q及>P\x91!\x13\x84b\xfc\x03q\xb0g\x9fM\xfb\xe2%\xbb~\xef\x1d\xcb\u007f\xa9\x01\xf0\xc1#8W\xb8E\x19Q\xcaɈJ\xea\x12EM\xa4W\r\xe7FN\x86\xa4\x98l!\x15\xb4!\xb4%\x04O\xe7\xc6A\x82\xe1\xbe\xc9\x10\x13\x1f\x0f\x15\x16\xb9\x03\x92\ak\x8b4\xa5\x85\xce{0J\xf1\x13\xa5\xe13Ƣ\xaakl6\xd7{|\x9d\xbd\xcf)\aH1{?\xa3\bJ)\x9a\xfcg6\xc8sd\xa3\xbcG\x90AW\xdb4Xo6\x80\x18\xb9Y\x95\x88l.\x15\xd2ptd\xed\xc5]\x03\xf0\xbb\xd0\x00\x88\xef\xb3U\xc0b\xb1z\xe7\xd1\xe3\xd7\u007f\xbc\xdbm\xf9\xc6\x15\xe8\xfb\xae0\x81U\x99d\x04\x1d\x02\xc8\x06 ffۉ\xa9h!\xc3\xffa2\xbc\x00\x10صm\xf0~\xb6%\xa6\xc3\xd0Z\v\xa3\fb\xa2 \x9c\xb1\x1f\xd0w}n-\x8a$m\x18\xc7B\xfa*,\xe0[ş\xe1y>\xd8sќ\x10\xff\xb4\x87\xf1\xbd\x14\x11\x9b=t\x85\xad;\xe9\x82h\xf2W\x14b\x93\x0f\nb\xcc{\x96\xfeaO\xe7M\xfa\xed\xc8\xdf\vԖ&\xfd\xcc|\xd7\xc6\xc2j[\xec\x91s\x10I\x8a\x14p\xe4\x83{\x85\x93\xe0$\x83\x12\"\x13\x19\x81\xban\xa0Y\xf6\x95\x00\xec\xfa\x1d6\xdb\xf5\xfe\x94.\xf6\x1b \x91\x00\xcd\xccw\x1f\x02\xac6XT-\x84\x90\x854\x96\x9d\xfb:&\x11j=KM\xcb\xf1\xb2,\xdd\xf3\xd1\x17\xe2c\x8e\x89\x9ek'R\x02<\x89\x9cqvv\x8f\xee%\xfe\x10\xb3\xe3\\qP3\xa6\xfc\x9e\x18\x02\xae\xae/\xb1\xd9ܔ숬\x89N\x81З\xd5\xea\x14\x0f.^G\xdb,\v\xecJ\t\x82\x1b\xa4q\x83\x85\x02\x16U\xc5\r̾\xd1N\xb6\x88\xcd\xfa\u007f\xa4\x04-\x04V\xcd\x02V*\xec6k\x8c]\a#5\xae^<\xc3\xfa\xe6\x14\xe7\xf7\ue4d2\x83\x8b~\f\x98⮳w\x84LE\a\xbe\x97\x19\x91\xcbu\xc8\u007f\x03K\xbb\xb2K^L,%eN@)L,ZK\x80\x88Ӻc\xded\x94|\b)3<G\xf7\xa0\x06b\x94\x93\xf2\xa3L\xf2sd\"\x95\x90\x1c\xa4\x047:jе&\xebb!9\x88F\xed?G\a\xc5?1\xe2\x95\x10\xe1Ya\x10\xd8\x12\xdc{W\x14B\x89#\u0095\xd6PZOP9\xc7\x02\v!1\xf6\x94o\xe1g\x96\xdd\xe4\xa7!!\x14\xed\xd5C\xa0\x065\x047'B\xcc$\x99\x89\x87\x03\xceޠi\xe1p\xf9\xbf\xdf\x18\xed16\x89\u007fP\xba]!&3,c8\x13`\xe6\xd4Q\xd6\x003\xa31\x91Y\xfe\xb24\x10\x14\b\xa5\xf7R=S\xda_$\x92\xfb$!S\x8bE\v}\xa9\xf6\xce;\xc1J\xab\x98\x00-\xe5\xf2\xae\x9c\xff\x0e7\x00b\xff\xb6\xfe\xbex\xbd\xf1\xe6\xbb_j\xdb%>\xfc\xe0\x9b\x1c\xee\"1\xb0\xfb\x9f\xe2\xfd`\n\xac\xc7\xe7\xfd\x9bR\x1a\xda\xe8\xbdʛ\xa3\u007f\x05O\x12ލ\x85\x91\x93\r^\x06\xe78_|V\x80\xa5@]5\xa4\xf9g\xact\xb7\xdd1K\x9fX\xb6d@3\xc2yG\x9a\xf3\x18^\xbe\xbb?H\xfa\xa2\x04\xc24\x8b'~\xf9'\x97^\xf1\x1f\x04\x9b\xf4\xe4\x9f!\x04\x15p#\x15t\x9e\"R\xa2\x95\x86\x0fl-;\xf3\x0f\xcfk\xdc\xc8+\x04A\xfe\xfaUU\x97\fs\xf2C\x973[W\xd0a\x19|\x89\x01~\xf9z\x82\x8d\x8bBd9\x13\xfd\xe7E\xbd\"\xdf\x06~y\xefqusIR\xcb=\xbbة\r\x93 -\xb9֦@\xba\x95\xb6%\xc5,\xa5D$G\x0e\x01\x1a܈\xb6n'\xc3\x1a\xec+$27\x001A[YBx\xe6'[D\x82s\x1eG'\x17\x94g\xcf\x12\xba\xf9Ĕ\x1b\xcf\xc4M\x95\x1b\aܬ\xaf\xb1\xdbm\x8a\xd3^F=\x90\"\xac\xadp~\xfa\x00'\xc7\xf7\xa1\x95\x02\x10`\x05\xe0\xa3\xc7vs\x05\x19\x06\x1c\xd7\x15\xeal)[\x0e\xff)lI\t\"\xfc\x91ZA\xa1\xb1\x16'\xcbcԶBL\x01\xd1I\x04&\xc0K\x01\\>{\n7:\x1c\x9d\x9c\x12)\xd6P\x0e\xbc\x94\n\xa2\xb2\x13\xb9N\b\x92\x1b\x02l\x05\x8b\x99vm\u007f\x82O\xde#\xb1\xec\x0eR\xec5MB\xec\x93\xd9\xd8I\x06H\x92]\xe7R\xa9S\xc4ʜ\x13\x10%Rd\xc5\x00\xf3\x06$\xa6,\x89\xc2c@D\xa1ʤ\xc8\x1c\x06j\xbe\x14_\xbbT\x9a\x8fPX\xea\xf3\xd4\xccxP\xc5b\ft\x0eD\xfa\x9c\xbd\x8f\bޗ\xcf\\\bI\x1c\xa0\x9c<\xc8DSc+hm\xe0\xc6\x01\x90\xb4\x16\x92b\x84gTOI\x85\x88X\x8a \x84A]'\f\x03)\x95\xe6\x84\xc9\xece\x80\x83\xeb'gt\xa0$RY\xbd\xbct_~8<$\x94\t^k\x8d\xban\xca\xde~Ob[P\x90Y쯘\xac\x81\xb3\xc3\xe0\xedS-\xed\xfd\xc2\xecUpt\xb4\xc2\xc9\xd11\xb6\xdb-B\xda7+ʳ\xdd]9\xff\x9dF\x00\xbe\xcf\xea\u007f\xdb.\xf0\xe6[\xef|i\xbb\xb9\xc1\xfa\xfa\x8a\xf7g\x81vK\x02\x10,O\x13r\x9a\xb2\x94\x12\x1c\x15\xaafi[Ӎ-\x85\xe4T\xb1\xc00Y\x84\x04Ů\xba\x10\x0f4\xba\t\x95\xa1C\x13\"Aj\x85q\x1c1\x8c#\xb2\xe5iSՔ\x110\xbaB̥\a\xfb\x15\xa4#\x86\xd7\x04\x04B\xf23\xd8s\xea\xca1{P^\x16ѓ\x0f29\x83\xb4\xb3y\x8f\x16\x9a\xac\x88y\xbd\xe0}@H\x11!\xce\fD\xc4!\xcc:q\x06\x1a[\xc1j\xca~\x97P\xb7\xa6z\xe2,\x10\xc11\xbd\xa2]\tL\xe2\xca\x06H\"\x11\x13ݚ\nMӂ\xecQ\x13\"\".o.\xd1\xf7\xfd\xec\x84\xcb?FL\a6+4\x8c6\x9c\xe3\x9e\xca>\x95̘H\xb6\xe4\xbcöߡ2՞qм\x84E\x9erB\b\xd0JbY\x19\x92\vb߬\xc6{\ae\fV\xc7'{\xe4\xa4\xc8\a{\x88\xa1L\xa81\x91;\xdc\xcd\xfa\x1a\x8e\xe5O\x99{\x10\xa2\x87\x92\n''\x0fp~\xfa\x10UUC\"B\xc1C\t\x81\xc1\xf5\xb8\xbez\x86F\x01\xab\xba\x86Qjb\xd0\x1f<\xdb*\xb3\xb0\x15\x91\xfe\x96u\x83\xd3\xd51*[C+Y\x10\x9fL\xecc\xab*l7\x1b*܋\x05\x1c\xeb\xb9);\xbeA\x8d2(\x16\xc4,\xc4@\xcf\xcf\xdcU.\xcb\a\xf9\xbd\xc4\xd1\x03VB\xce\xfc\xef\xc5\\\xf0\xc1H\x80H\xb9q\xa0\xa6*\x17r\xdc\xe2ݑV\x9c\xfa\x8e\xb0wg\xe5\x1d\xb8\x04'\xfd\xc5\b(\"\x11\xe6@<\xdb\xd4\xf0\xa3\x83P\xf2\x16\xe4\x9fS\n\xb3Q\xd1a\xe1L\xb9\xf0\xe5\xf66\x92\x9a\xc8Z\x8b\x18&ri\bd\b\xa6\x14ychk\xf7\xa2\xae\xf3\xb4O\xc4P\x8f\x90\xfc\x84\xf6\xa5iJ\xd6Ʋz` O\x92\x92\x1a8#\xf1\xcdx\x13\x82\x132\v\xc1W\x00R\x1d\u0083i\xb6\xa2\x9a>\x83\x04@+\x1aR\x8c6h\x9a\x16\xc6X\x8c\xe3\x001s\r\xc9(YJ\x84\xfa\xa5\x98 \xf4\xa4\xaa\x11bnT%\x0e\xb3\x9e\xf6\xee\xd3l\x12vq~\x8e\xe7/^`\xbb\xddN\xc3Oyfŝ\x02\xe0\x8e\x03\xf0ɯG\x8f\xdf\xfc\xc3u\xb3\xf8\xf4\xb7\xbe\xf1ϋS_\xef\a8?\x96T\xaf\xfc\xc0\x90\xcb\x1b\x11\x88\xac1Eb\x84Y\x94l\xbemI\x9f\x9e\xb8\x18P#\xd0;\u007f\xebn\x96̄\xa7\x03\x88\xe6\x86q\x18\vLf\ri\xe1\xfb\xb1/\x92\xaf\x18^\x9d\xd6W\x1c\xc9\xcaA\x1b\xf7\xd9V\xf3\xefc\xa9bz\t\xf4_~\xd6,QPf\x8b_E>\xe6\xce\a&\"\x02!\xe1\xd6^\xbd\xfc\x1c>\xb1\xa5$4c\xb5X\xa0\xb6U\xf1\xac\xcf=\u007fd\xebP\x1f\xdc-W\xbf\xfd=*g\x84\x17e\x037Y\x8a\xd2\xf0\xb2\x852\x04p\xb3\xbe\xc1\xcd\xe6f\x92\xf9\xa5\xbd\x05B\xb1#\x8e\x82&+\x8a\x19\x16\\\xbc\xd5$\x89\x934\xc1mv[fū\x03\"\xe5\xf4\xb9\xa68\x05\xfa,\xab\n\x8d1\xfb\x86w<\xfd\x87\x10pz~\x01\xad\xf4\xd4D\xb2\xf6<\xdf7y\x02\xddln\xb0^_OV\xcd)!&\x9a&\x17\xed\n\x17g\x8f\xb0\\\x1cS\x83\x06\x0f\xcd\xc8\xccz{\x8d\xcd\xfa\x05\x8e\xacŪi\xca\xefϲ\xd14\xbb\x0f\x8b\xb7\x01\x87\x14\xad\xda\x05N\x97+J_T\xd9\xdd1\x1b8ͬ\x85y\xfa\xeav[H\xb6+\xce\xfb\xfb~\x97\xa6\xbd2\xff>Ü\x8a\xc01ĥ\x15\xb95\xd9\vDN\xb8TZ\x13Z\x90\xe4^\xac\xed\xfej[Pъ\t1M|\x9c۫KQ\xfc\xe3\x91\xd7\x06s\xd8^\x02R\xb2k\xa0\x02d.V\x8a\x9a\xee\x18b1\xb4\x99\xd6\r\xb7[ս\xdd\xf7\x8cG\x13\xe3\xb42\x92JSS\x92#\xa0cBU\xd5\xd0l\x8c5\xf7q\x98\xf8\x05\xb4\xaa2\xa6\xa2\xe7$1$_\xb2\x19\xa9\xe4jC\x84S\x87\x91\xb9\r\x02S\xac\xaf\xc4<\x84\x88\\\x01\xb3\x9f@\xb6╷G\f\xe6\a\xccْ\x99\x18k\x8c\x81e\x87̪\xaa0\x0e=\x92\xe0b?S6e.F\xccנ\xa0^\xfb\xbc\xa4\xf9'\x97f\x92\x8bܸfҪ5\x06ۃ30%@߱\xfd\u007fw\x1a\x80\xef\x17{E!%\xce\xef\xdd\xff\xcb\u038d\xd8m\xd70\x86\xccY\xfa\xae\xe3\xbcr53/\x11\xa5\xc8\x18mI'\x9c\xc31\xf6L\xc1H-\xe0\xc7\xc0\x85\x89Lz:6\xe19\xd4\xe4\x1am\xc8G@Rz\x97s\x01\xc30\xd2\xfaA)\xd4U\x05\x17<\x1c3\xd0\x13\xb0\xe7\x1axX\xfc\xb3\xcf|.\x8a\xaf\xf6m\x10\x9f,\x87\xe1\xe6`\xde\x14h\xc1iw\x91\xb5\xefqF\xf2\x89\xe9\xa5XB\x91\xfcI\xe21\x9c\xacN\x8a\x86=\u007fOb~\xc4\xe8(0\x87\x02>\xe4K\x9d\xddS\xcaD*_ \xf0\x9cn\xb6j\x8fa9\xa25\x01\xe8\x87\x0e\x977Wt\x1d\xa48xO\x93?{>ƚ\xaa\x86\x91\x1a\x8e\xb5\xf4Y\u0096I\x86\xdbn\x87\x10c\xe1cL\xb2PQ\xe0`r?$ނ\xe5\xe9\u007f\x1e\xb7\x9a˄\x1b\x1d\xeaf\x81f\xb1\xe2\xa8^5\xc949\x14(\xb2\xd7\xc3fs\xcdʔT\x8aZ\x8c\x01R*\x9c]\xdc\xc7\xe9\xf1}\x18\xad\xa1E\x84\x12\xb4\xaa\x1a݀\xab\x9b\xe7\xf0c\x87\x93\xa6\xc1\xb2\xaa\xa7m\xf0\x9c\xfdΓ\xa7\xe2\u2bd4\xa4&\xad]\xe1h\xb9 \x9bc\x8e\xa8\x15\x8c*M\xa47Q\xe4v\xcc^@\xdfw\x10\thW+nl\x12\xfcHnv{\xfe\x0f\xc6\x00 y\xa6\x94\xea\x13\xc9F\xc9y\x04\x00\xca(b\xe2Gj\x84\xe7\xd9\x01{\x95C\n\xa2e2)7\xafO\xe6\xfb~Q\x8a\xb2:\x1c.\x99W \x8a\x02\bb\xb2\xa3UR\xc1\x8d~\x8f\x0f\x933BDF\x1f\x02y8\xe0 6{\x92\x02'\xa2\"dnFJH\b\x90\xd2\xc24\xa6\x18\x88\x15\x05!߯\x8a\xe5\xa9!8\x80\x9b\xe9\x10\f\x05B\xa5\x03\xd7\xc4\xc4\xc1C\xcc;\t\xdeM\xeb\x13!\xf7>\x8b\t\xb5\x98\xa8,B\xee\xaf\xe4&\xe2\xa0\xdc'\xe8\xf1u\x15\x8a\xe4{mӢ\xb25\xea\xba\xc1z}\r \x96\xa6U\xf2\xae!\x93\x83\xb3+\xa6,\x84\xca)\xbbd\xae\x10-\xcfiH\x876\x12S\x14\xb0\x98\x05N\x15R\xf2]\ap\x87\x00|\xc2k\xb9X5'\xc7g?\xdd\xf5\xdb\xd2\x15\xc7\x18\xd0\xf5;z\xd85\xa7Lq\xdcd\t\xbf\xe0\fw\xe2\xf5\xa72\x95g$\xc0\x8d#R\f\xe4N\x16\x13|\x8ap\xec/\xbe\xcf@&\xb2\x9ab\xfb\xcb\x18S\t\x1a\x01\xa8\xb3\x95B\xa0\x1b\x87\xf2\xa0\xfa\x83\x98\xd9\xc3\x033?ܤŏ{\x10zJ\xfb\xbf{\xbf\xcb\xc6-V\xbc\xc0\x14\xb5\xaa$y\xf6\x93\xf5p({\xef\x9c7\xb07\xff\xec\x9d\x0fD\x9c[\xb6-\x8e\x17G\x1c\x99\xbco\x82\xd3\xf5=v\xfd\x0e\t\x11\x9a-g'\xc9\xd7~\xe3\x19\"\xb1\xf3s\xa3\x10#\xd9\r\xaf\x16+,\x9a\xc5\xc4&\x0f\x01ϯ/\u173b\xad\xbf.\x800\x8ac^SըmˇS\xe4\xe8RU\x9c\xf6\xba\x81\xfc\x184\x17\xb2\f\x95\xa6\xb9\xfb\x1f\xefyI2\x16\xb1\xaa\r\xac\xe1\xac\xf7r-Eam\xaf\x8eϧk\x9d9\x92\t\x84\x80\xa4\x88\xe0\x03n\xd6W\xe8\xba\rMM1{\x06xTU\x83{\x17\xafa\xb58\x82B\x82\x16\x01\x8a?\xd3~\xd8\xe1\xf9\xe5\x13(D\x9c\xb6-\x1ac\x8a\xe3\xdf|\x19\x04\x86\x933\x1b[)\x89\xcaZ\x1c\xd7K,\x9a\x05\x05\x1cIŪ\x14nȤ(^\x0e\xe48'1\xb7x&\xeb\xe2\x11\xd8n\xb0X\xae \x15\x11=\x83wPZ!\xe6\xfcv!h\x97\x9d\xb2\x8e_\x1d\x8e\xf3\x19\x9a\xa0\xdf\x14\x02\xa2\x00\xa4\x16\x14\xc8%'\xc2kiT1\x9bĹ\x81\x15R!Ez~3Ar\xde6\x1c\x86\x14\xcdy\x06\x98\xbb\x00\xe6\xbfN\t\x98\x8a\xc8\xc0!D֠\xe3\x00\x01H{\x9c\x80\xb9\xb5\xa14\x1a\b\x92P\x85\xb9\x97\x00\xbb\xe3%\x8e>\x16\"\xcdg\n\x92VB\xa3j\x1ar\x8d\x1c\x06\x8e\xab\xae\bq\"\xd6e\xf1E\x80\x00D$\x92\xa2Qd;M\x88\x19\xf6%\xb6 \xa9\xa3\x9c\xad\x03\x0fD\r\xfbk\x121{x\xe2\xf4\xfc\b~\xce۶EӴh\xda\xc5,6\xdd#\x85\b\x18Y\xfe\xa0\xa2\xf090X\x9a\xdc\x13SI3\xccD\xcf\xf9=\x96\x89\xafι\xa2$\x98G\x17\xb0\x12\xe4.\x0e\xf8\xae\x01\xf8$\xf8\xff\x8d/\xd6M{\xf6\xec\xbd\x0f\xca\x1ej\x1c\a\fC_B.Dq\xe0\"\x82\x9eֺ\xc4p\x16\x17:\xae\xa4\x02\xc4\xea\xf6\x8e\xe4_!\x06\xf8\x98\xf6$\u007f{\x17Z\x1b\x9a\x84$\x15\x85\x18\"\xfc\x98]\xe5(an\xf4\xae\xec\x84\x13Ҟq\xd0\xe1K\x8al\x9f\t\xce\xe3\x8e/\xe5j\b\xbcZ\xf9\xbf\x87&\xf0NP\n\t#\xd9\xef\xde\a̱\xba\xf4\x92\xa8\xdfl\x86$\xa5D۴8^,\xd1\xd4-\x8cV{\xa7\xec\xe8\x1d\xd6\xdb5v}OѢ\xd9IPf\xcd\xefl\xc2\xe2܃\xdc \x95,\x84\x14QW\rV\xcb\xe3i\x12\ap\xb5\xb9Ʈߕ\xa2\xf7R*\v\x99á25\x16Ղs\xcbi\xad\xa0\xa5.\x86*\xe38\xa2\x1f\xfaɸd~R\xee\xfd\xc9Ԍ\x84\x10PI\x81\xd6Z\x0e8\x99Y\xb0\xb2I\xd2by¾\xee\x82\\\xce\x18Mqa,\xec\xf0\xeb\x9bKtCG\f\xf7\x98\xca=\xd5.O\xf0\xe0\xe21\xda\xcaBe\xb8_\b\xc4\x14\xb1\xdbmq\xb3\xbe\x82\x95\x02\xab\xaaE\xa5uy\xa3\x85\x95_,\xa3)q\xcd(bqW\xc6bY\xb7h*\xda\xf7g4If\xf8Z\xce\xccd\xc4~J\xdc\xe1\xea\xc8{\x87n\xb3\x86h\x97\x90uM\x1e\x1aޗ@\xa7\xe0IW\x1fe\x82\"\xbc\xb6\x10`1\xa3j\xec\r\xf9\x1c\xb0E\xa8[\x9c\x9a\x86t\xc8\xc0g\x12j!\x03\x12\u0091\x94\x84\xcc(JL/\x91\x82\x96\xf2}\x1b\x0e\x9b5M\x99\xb8+\x05q)\xa2\x0f\x87\xd1X8\xf4\xdf\xdb[\xfb)\t\x14i\xf1\xb4\x8eJ\xb3\xff}/\xd1/\x87\x1e\t\x01\xad-\xea\x9aV\x12#\x87\x83ic\x01\xef\x100s-L\tP\xbc\nA\x82\x96\xa6 K\x13\x9aC/5wp|\xc5\t!oy\x1d0!h\xf6>\xa5\x94\xb0\xb6B\xdb.\xd16\v(\xa5y\x8dE\r;\x91\xa6\xa7\x95]\x0e?K/]\x9f\xe4\xf5\x04^I:\xf3\x81\xec\xcd\xc5,\xearFľ\xcb\x01\xbak\x00>\xe1\x8f4\x16\x0f\x1e\xbd\xfe\xa5\xbe\xdfb\xbd\xbe\xa6\x9d>\x80\xedv\x03\xef\x03*k\xcb\xee\x9f\x0e\x11\x01\x05\"FIE\xbb\xd0\xc8Pd֟g\x87>\xcf:\xdcl\xf5\x9b\xf6\x96[\xf3\xdd\u007f\x03c(\xa5ύ#\xa4T4\xb1\xa6\xc46\xb3\x98\x99~\x90\xaf\x00R|e\xf9\xce{\xbd\f\x93\xbf\x8aC\x03\xf12\xed_:\xf8YSP\x88*\xe8D\xb85)E\x1c\xfe\x1e\x9a\x8ckk\xb1Z\xac\xb0l[6֙\x0e\x95\x94\"\xb6\xdd\x0e\xd7\xeb5F\xef\xa0$۴\x86\x84(\"b\x92\x90\x89\xc8e\xf9\xe8\x8cl\x9e✧\xdf\xc9D;k*\x9c\x1c\xe54;:0֛\r.o\xae\xf6O\x804\xdb[\xce&\xba\xca6\xa8m͜\x89\xc0\xa6%2g\x8b#\xa6\x80\xc1\r3b\x17\x03\xb23\xf9\\f\x90\xe7&\x0e\x88XX\x03\xab\xa6\xa9\xb6 &)\u0098\x1aU]\xb3\xfci\xca\x04\xf0\x9e\x92\xe8\xbc\xf7\xb8\xbeyAD\xd4L\x02\xe4\xd6\xe6\xe4\xe8\x1e\xee\x9d\xdfG\xa3%$|ѡ\x87\x18\xb0ݭ\xb1\xdd\\\xa1\xd6\x1a\xad1\x14ǌ\xe9\x80՜ՐMs\x94\x940J\xc1*E\awUS\xfe\x82\xa6\xa9\xbf\xb8\xb0\x15\xfd\xf5\x9e@l\x0f\n\x9eJ\x18\x9b\xf0\xf0ʬ\xdbm\x11\x82Gմ\xb3\xc9lv\aG\x81ȟ\x9d\x11\x96V!\x13\x05`*\xb8\xb3&;\xc7\xfa\x92\x9cOM\xee\u007fB\xec#*\xfbx\x1b\xbd\u007fE\x1a\xfe4+\x16)M\xbe\xfa9@)\xa5\x97\xc0\x02\x989M&Q\xb2\x05\xa0fY\x04)\xed\aL\xbdd\xb5\x96o\x1aQ\x1c\x97&\x0eDA\x13\x04\x13\x19\xf9\U0004accb&\x159\x05\xa6\x18\x11\xc7\xc8\x11\xc1t^\xc4\x14\tU\x11\t*\x01I&\xf8\xe8hB7y=\x16g\b\x0e;\abߩY\x88\x97L\xff\x98)\x88\x05ig\x92\x88\xc5\xe5/s\x8f\x16\x8b\x05\xda\xc5\x12UU\xa1\xeb:$P\x1e\x86\x8dU!r\x06^\xcdd)c\xf9\xd7AS6\xd9\aL<\x80\xfc\xb5\xce9x\x1f0\xb7:\x9a\xa4\xacb\xf41\xdeܕ\xf3߅\x06`\x0f\xfa\xfco\xe8\xeb\xf4\xf4\xec\xde\xf1\xd1\xc9\x17/_<\xe1\xeeV\xc29\x87\xedv\xc37r>\x94h\xf2\xa5u\x00\xc9rT1=)Qk$oJ\xb4\xef\f\x81\x92\xba\xdc'\xc0\xf5Ziԕ\x85\x90\xc0\xb0\x1b\xcb4\xeb\xbd\a$\xc1i\xd9\xe6\xb3\x18\x9b\xc4W\x17\u007f\x91\xfd\xb9\xc54\x19\xbf\xec\x00\xcb\xd3\v^\x01\xfd\x97\xfe`\x06\x91\x86\x10\t\x9588\xc7\xc2A\xe7N\xb1\x9f\x1a\xcbv\x81U\xbb$G/fzOaJ\t\xeb\xcd\x16W\x9b\xeb)\xc3;O\xa8\xa0]r\x8a\tI\xa6\x19\x16Kr\xa6a\x1c\v1.\xa6\x00\xad\fNVg0\xdaҵ\x11\x02\xfd8\xe0\xf9\xd5\xf3\xa2\x8f\xdf;w\x13\x85#I\x9e\xa6\xaa\xaaAe\xa9\x10\xe7<\x86\xc4\xcdY6I\t\x1cӬ8\xdaXβ\t\xa6?>\xee\xc5\xf9Z\t4\x96\x02\x83\xe6H2\xc9\xc94\x9af\x01\x80\xf6\xb3\x19-\xc9\xe4\xc7\xe0=nn^\x10\x81\x8a:\x1f\xfe\xfd\n\xab\xd5=\\\x1c\x9f\xa0Vdo\x94\u007ff\x8c\t\xe3У\xdfݠ\xd2\n5\xfbS`\xb6S\xd5JR\xbe\x01\xa3\xb7D\xf43\x14\xb7\xac\f*ka\x8ca;VB\xbfd\xd9\xf3\xcb[pxΌ\x97 \x87>$\x81\x98\x0e\n._\u007f?\x8c\xdc@:^\x8bE$c\xa0-\xd8Q1\x15\xa2\x98\xb5\x15\xa4\x9e\xbc\x03D\t\xea\xc1<ߙ\x9e7\xe7\xe9\xf7+\xf9\xd2\xed\xc1\xe4$\x97n\xed\xa6\x8a\x94P\xce\xd6c3.K\xda\xf3\x02(ۅ2\xb5\vnZ)g\x80T(\xd9\u007f_\x88T\xe4j\x13\xc4=y,L!\xc63\xd0b\xf6\x9e\x89\xdc\x16\x11\x03\x90D\xc0aj\x95\xe0\xe0\"\"\x01FF\b#\xa1+q\xe6K!\x00\x99\x14\xa4\x98A\xff\xbc\xc6\xc3ޚDN<ù!\xd0|\x91'g&A|=\x94\xd1@\x8c\xf0\xbc\x9a˓x\xdb.Ѷ-\x16\x8b%\xfa\xa1'\xb4\xcfuhӂ\u009d\x98H\x99\x13\r\xe7\xff\xfa\xa4\xb1=7ۄ\x1eD\xf4\x03Y\xaa\x87\x109u\x90]K\x89\xcf\xf0~\x02\x9eܕ\xf3;\x04९\a\x0f_\xfb\x19)\xa5\xea\xbam!!u\xdd\x16\xa3\x1b\xa0\x95)\x10ibw8\xa5$R\x04\x94\x96\xc5U\fs\xf7\xb04%\xf3\xc5@z\xf8\xcc\xe4?\x9c\xb0\x05\x04꺆\xb2\x1aΑ\xeb`UW\x18\x87\x11!D\x18\xabY\x8e8\xad\x0e\x8a\xe7\xf7-\xc0\x92a<\xd6ѧ\x94\xe1\xff\x97'\xfb\x1d\x16ř9\xe7\xc1Of\xd89\xc4\xdb\x10\u007f\xd9\xfbO\x87\xa4\x94\x12\x8b\xbaŪ]\xa0\xb6\x16\x92\xedc\xc5\fm\x881b\xdb\xef\xf6\x8b\xbf\xd8\xd7\xfd\xc68\xe9\x90\xe7\x90y?\f\xc5\x028'2\x9e\x1c\x9d\xa2\xae\x9bR\xbc}\bx\xf2\xe2\x19\xfaqؓNa\x9e\x19_\xf4\xd6M\xf1\n\x88)\x16O\x83=\xe6C\"\x8bޜ%\x10B\x98dY{\xc3\\B\x8c\x9e\xb9\x03\t\x8dְ<\x91\x15\u007f\b$(\xa9Q5KH\xada\xad-{ؔ\xd8\x1d\xcey\xdc\xdc\\a\x18\x06.\xfet(\x1a[\xe3du\x8aU\xd3\xc0ʉ\xe3\x90\x12\xad\xa5D\x8c\xe8\xfb5\xb4\x14\xa8\xb4\x99\xbd\xff\xc4Il\xaa \\ZJ*\xfe\xca@k\x05+\r*C\xf9\x04Fi\x82\xfe\x95\x9cv\xe3\xd9\xc4E\xf1\x9d\x9b\x15/b\xcf\xeae\xb2\xf3I/\x99v%\xe5\x1aD\xe71$\xe2נnH\xcae+H\tDO\xa6O)FTMK$\xdb\x19|\x9ex\xe2.\x94\vA\f~\xef=\xb40\xec\x95\xc0v\xb0\xb7\x8a\xab\xd8'\xa9\n\xb1\xbf\x93\x99}]\xe6\x1c\x88\xc3g\x84\xbd\x01([\x80y\x0f\xfc\f\xc8(\x10\xf9\xd9\xdb\xfb\xe6\xe2\x17,&\xb4\b\xd8s\u0604\xbc\xb5\x98\x9a5\x12\x12\x02\x91\"\xb5\x8b\x82g2\xb0\x12\xcc\xf4O)bH\x11\"\xec\xb7:\x89\x91<\xc1&C\x191\xc9\xe4Y$\xe2\xa6\x14d\xab$\x8a\x8aB\x00L\x1c\xc1K\xffy\x86\x80)\x05eMiP\x93\xf3HI\x144\xe2xu\x82\xe3\xe3S\\__Q\xb1\x0f\x03\x9c\x1b\x89\xb3\x90\xa6\xa6>\xa6\\\xfc\x03bT\xb7\xbd\xfa\xf7VA\x89}M\"\xdc\xe8\xc8\x115\x06\f\x03\xc9b\xb3\xa3%R\n\x00~U\x00Ww\xe5\xfcw\xa1\x01\xf8o\xfa\xf4_\xd5\r.\xee=\xfcR\xd7\xef\xd0\xf7\x1dÿ\x11\x9bͺ\xf8\x98\x13\xe3\x97]\xd0x\"\x91ZQ\xda\xd4\x1c&\xe3\xdd4AR\x9e\xc2)b\x84\xcfL\xe0\x83⟐P\x19\x8b\xa6m\x80\x94\xd0\xefv\xa5\xe3\x1e\xc7\x11B\x82\xc8v\x99\xb0$\xc5~\xe6\xf9\xcbF\x1d\x80Q\tޯe\xf3\x8ft\xbb\xf8\xdf\"\xc6\x1e\xac'&\x82\r\xf6\xfd\xd3g\xdf\x12\xd3l\xf5\x87\x84\xcaV8Z,\xd1\xd4u\t\x8eQRO\xf6\xab\x94!\b\xe7\x1c6\x9b5|\btm\x0fkš֗\x8bs\xdf\x0fd\xaa4\x93{\x1d-\x8f\xb1l\x97\xc5x'\xa6\x84\xe7Wϰ\xddm\xf7\xbd~\xb0/\xd3\x13\x00\xac\xadQ\xd9\x06\x10\x02n\x1c\x98鮈]\x8e\xc9\x1b`Nn\x1c٭-\xef\x9d3\xab;3\xcc\x03\xbf\x0f-\x80\xc6h>\xd7goDJT\xcd\x12jf\xecB\xcdc\x84c\x1f\xf8\xf5\xfa\x1a\xc3\xd0\xf1$J\x93}ݬp\xba:F\xa3\x15\xb4\xc8\x01C\x03\x067`Ѵ\xd0B`7l\x11\x83G\xa5\r\x14\xf6\x89m\x82\x8d\xa4\xb2á\x91\x92\xede\x15\x05\xfb\xb0ǿ\x91\x1aV\tj\x00J\xf1\xcf'/\x9b\xe3D\x89\x98\xb9\x03)\xf2Q1\xa9\x1fbLH2\u07ba\xf5\xa6\x1e\x90\xad\x88\xc7\x11\xe3L]c\xac\x05\x045\xd8ɍH\x02h\xe4\xa2 m{\xddD\x8a\xec\xe0\xc3\xedG\f\bA@\tv\x90\xe3&\xe0\xd6F\x9e宙h\xb9\x17-\x9d\x91\xb5\xbd4Ij8\x04\x0e\xfe;%\x8alo\n\x14\x9a\xac\xb0\x0f\xb9\x04頙\xc8\xf6\xca\xf3H\xe4<U\x17\xc5\xfc\x81u\xee\xcc\x04\xa04.\xf3\xd0\"c,\x1bb\x85=t6\xb72\x92\xe3\xc0\xc1|\x1e\x95\x8b\xbbHE\rP\x18\x01\xcc\xfb\xc8\x04>\x8a[\x9eH\xa0\x19\x04Q\x92\\\x19\xb3-z\f\x11\"FN2\x8dX\xad\x8ep\xef\xe2!>\xfe\xf8Ct;\"Y\x0f\xe3\x0eZ[\x8a-N\x9cwP\xeeuB\xd8B\x88̵\x99\xdf?\x13\xea\x93C\xd4\xfaa\x80\xf3\xa4\x8e\xdav]i\xc6\"\xfd\xbc\x84\x84o!\xc1}\xd2\x00t\xf7\xfa\xffS\x04\xe0\xe4\xf8\xf4\xf3\xcb\xc5\xeaG./\x9f\x94T\xbf\xbe\xef\xd1\xf5\xbbBD+G?\x13\xebHʦxG9\x1d\f1L\xde\xebc?Pw\x1a\x03A\xf0/\xb9\xd9$$ڶ\x85R\x12}?`t\x0eu[\xf3\xee7pB\x97\x9c\xd8\xc5`\x03\x91\xf8\xb2\xd1j\xda\xfd\x97(\xd6\x14o\xc1\xff尐/\x9b\xfeq\v\xfe\aHr\xf32\xa3\xc1\x90\x9d\xd2\x18\x16_\xb6K\xac\xda\x05I\xbe\xd8\x124\x1f܁\x83N\x84$]}\xd7\xf7\x18\x9c\xe3\xf3Y\xbc4\x88p:\xd8\xe8\x88\x1f\xddH\x9e\n3\r\xfd\xa2]\xe2huRB~\x84\x00.o.q\xb5\xbe\xba\xfd\x80\x8b\xfd\x1dpek\xd4U\x03!(\x1c(\x04\x8fE\xd3Nl\xe6yh\t\x9f\xbd\xa3s\xf01\xa05U9<\xe7e!$\x9eƅ@\xad()\xf00h\xb1\xa9\x17P\xdaP\x80\x14\xeb\xe0\x91\x12\xbc\x1f1\xf4\x1d\xd6\xdb\x1b\x8c\xc3\xc0\x861\x01\t\x02\xcb\xe5\tN\x96G0r\x92\xa3uC\x87\xae\xdfb\xd9,a\x95.\xdfo\xb5&\x15\x00\xa6\xe6\x051a\xb5l\xd1VUYk\b!\x99\v@\xeb\x99\xec\xf3o\xd4\x04\xfbϜv\xa9)\x93Ӆ\x8c)\x02\xc1\x03Ir!P\x9c\xeb\xceE,b\xcf\xc5p\n\x82\x92e\x1aN\x00\xbc\x1bKc@\n\x04S\n\xb7\x1fG\xf4B\xa2i\x17\x90\xb3\xdf]$j\xf3\xb4\x1e\x80\xd7=(+\x95\x14\xd3K\xe3w\xc5,\xc0f\xb6\xbd)9\x0f\x85\x18\xca\xdd\xe8-\x0e\xc9\xecw\x8a\x9cm\xcf\xdf+\xa5|)d]~\xaf\xdc_\x83\xcdv\f\xc4\xef\xc8+\x88\xfc\f\xe4$\xc7\xf93\x9a\xf6s\x13P\x942$\xc1#r\xac\xa35@Fcx\xc7_\x12\x02\x99w\x92ߝ\xe2\xe1&͌\xa1n\xc92\x85@\x01\x00b\x02d\x9a\xf84q\xca\x12Hb\xe2\x8dh\xa5\xf1\xe8\xe1c|\xf4\xd1w\xf0\xden\v!\x04\x9c\x1f\xe0\xfd\b\b2\xdbr\u07b3\xf7G\x84P\xe4\xb2\xe8\x85+\x86Q\xfb\xd0\u007fFXG\xcai\x19Gx\xe7\xb0\xdb\xee\xd0\x0f\x034\x93\x94C\b\x88t\x01\xaf\xf1}\x1c^{\xd7\x00\xfc6^\xe7\xf7\x1e\xfce\xa9\xc8W\x9bL(@\xbe\xea>0DE\aH\f\x11\xa6\xce\xfa\xe5D:d\xc1\x8c\x1c1Ed&\x90O\xb8sc\xe9b_E\x00\xaa\xeb\nu[S\x17\xdb\xf5\xa5h\xeev\xd4\xc5jν\xce\x11\xb7)\x1b\t\xbd\x92M\x84=\xf6tܛ\x1c\xe6\x10\xe7\xcb&\xff\xf4R\x84 \x85\x04ă\x89&\x81\x0f*\xfa\x1d\xd6Z\x1c/Vh\xaa\x9a\x19\xe2\xc4[PBq\xba\x1f\a\x92H \xb9\x88\xd1{\xf4C_\x9c\x12\xc5+\x90%9\vg\x89)\xa7)Nſ\xb2\x15N\x8e\xceʁ&\xa4\xc4\xcd\xe6\x06Ϯ^\xdcN\\\x15\xd3$&\x00\xd4U\x8d\xban!\x84\xc2\xe8H\xed\xb1l\x97\xe4H\xb7\xc78f\xaf\x85D\xc4\xcb\xc1\xd3Z(\xcb5\xe7\xd0db8^\bR95F\x97\x06\xa7 $U\vS\xd5Ц\x82\xb1\xb6쒽w\xe8\xbb\x0e\xeb\xcd\r\xc6q(f0RJ\x1c\xafΰj\x17P\xa0FK\n\x89~ء\x1f:()\xd1V5\x80\x84\xbeߑ\xf9O\xb6^\x15\x02.\x04\xb8\x10q\xbe\\\xe1\xa8m\vr\x9b\xb3֕\x94Dh\x95\x02\x96W\x01J\xa9)\xb2W\xcc\f\x12Ą\xa3L.q,G\fD\x9a\x94I\")\x03\xa3\xe4\x14\x19\x9d\x12Rvό`\x99\x9b(Z\xf9\x98\x12\xc9eY\xa9bk\t\x99\x88\xa0\a\x00c\xdfQ \xcebq\xcb93\xbb\xfe\x95\xf0\xa5(\x10\xb2I\x17\xbbs&v\xe5+J\x9dY\xf0\xd0\x14\xa0\xc9\x1dNĄd\xcc\xf4\xf9\x87H\xc2-\xbeL\x9c\xb8\v\aV \x13\a\xa2hӱgĕx%VLr\xb2\x1a \xcd\xdc4\x0f\xbbc\xfe\x19)\xde\xe6\x16\x89D\x06K\x14\x15\xbc\x9f\xb8(\xd8\xc0)EQ<6\xc0\xfb\xf2\xa91\x9b\u0380\xf9y\"f\xa9\x80{\x97\x81͑R\xcaY\v\xf4\xf3\xc1\r\x84\x90\x14[\xfd\xe6\xebo\xe3;\xef\xbfGS~\xf2\xf0~\xe0=}\xc28\x8e\xec\x11\xc0\x84@!\xe0x확VY\x1e\x1b\x13\x85\xa0\xf5\xfd\x80q\x18\xe0\x9c\xc7n\xd7\xe1j\xbd\xa6\xe6-\x87G\xe5\xeb\x9aRwW\xff\xef\x1a\x80[\xaf\xb6]\xaaG\x8f^\xff\x99a\xe81\f\x1d\x84\x90\xf0\x9e\xc8\u007f\x13\xebyf\xdc\xc1\x90\xff|\xd2\xc9gG\f\xec\xe0\xc5{\xa8\x1c\xeb\x9a^\x92\xfd\x9d\vu\xbb\\\x00\x10p\xbd\x83s\x0eUM\r\x87\x1b\x1dK\x8b\xe4,\xcf\x1d\xbf)1\x06b\x92\xf1䯟\x0f,iF\xefM{\xf4\xa3\x97\x14\u007f\bĐ \xe2$\xf7\xca\u007fE\xc8>\xf7B\xe2h\x91\xa7~\xc55\x87\beJH\xc4\xe8\xe1\x03\xed\xcbC\x8a\bc(q\xbf>\x13\xf3\x04\x0e\xa2s\xcb\xe6\xb18\xf3\xe5HԘ&\x13\x17\xa54\x8e\x8fϡ8\xadO\b\x89~\xe8\xf1\xe4\xf2\x19#\r\xf2%\xb0?\xfdܶjP\xd5\f\xfb\xfb\x11\xfd\xb8CekT\xc6\xde\"\xb5\x92\xdf\x00\x1d\xca\xfd8pc\xa6'\xb9Ԍ\x11\x1eٮW\n\x81Z\xcb)\xed\x8f\xef!m\xec\xff\x8f\xbd?{\xb5m\xcd\xf2\xc3\xc0\xdf\xd7\xccn\xad\xb5\x9b\xd3\xdc>:e\xa4\xe4\xb2\xfc\xa2W\x81A`0\x18\f\x05\x05\x02\x81@ 0\x18\x04\t\t~*0\x18\xeaUO\x86\xfa\x17\x8c\xfdP\xe5\xc7*\xa8z(TT\xd9B\x96\x90\xd3UN\xdb\xca&2#2\xee\xbd\xe7\x9ef\xb7k\xad\xd9|ͨ\x871\xbeo~s\xae\xb5OD\xc8\xe5tF\xc4\xdeIƹ\xf7ܽ\xf7\xea\xe6\xfc\xc6\x18\xbf\xf1kP\xb7[تA-ʒ\bf\xb1\x1f{\x0e\x9frӔQ$k+\xbc\xb8|\x85Mۊ\xdb\x1e7K\a\xe1\xa7\xc4\x18\xb1i70\xc6\xc0I\x86|e\xacp%x\xf5\x14\"a\xdb4xq\xb1˟k\xd2\xfa/\xf2\x17$p'\xc5\x1bk\xe8y\xfa\x97x\xd5\xf9ӑ\xa8\xe5dΣ\x96\xf2LF[\xe5\x9e1q\x917\x15\"\x01\x82\xb4\xcd\xfasn'\xbc\v\x88\xf1\xc0\xcd]\xdbB\xc5\x19\x96\x1e\x8fGh\xad\xd1v\xdd2\x82\x8e\"(j\xf1\xfa\x9f\x99\xe1\xc19\xc0 \x17܄\xd41_@\xe5\x06\x91\xceL\xb7\xe4Kҟ\\\ra\xd5<\x17+\xbd\xe4\xcb\x10\xa5\x10\x96\x93>\x12̟\x1b\xc6B\xee&\x907\x85\x98\x9d\x1cgǟrڏ\x8b8\xe1\xb2\xff\xa0\x18\x17\x8b\xfeD>5Z\x89E\xf0$D\xbb\xc2\xed2\xb9c\x12#\x06Yַ\xe2\xc9\x18\t\xe3ICP\"\xff\xa5;4\xbd\x86L>,\xac\x9c\xd3\xeb6\xc2\x03\x98\x86\x01/_\xbe\xc6f\xb3\x95\xf5*\xc1\xf9\x11\xc6\xd4<\xed\xfb\x007M\xa8\xab:\xaf-\x15\xcd\xea\x00\xa3\vO\x93\xe01M\x0e}\u007fD\u007f\xec\xb1\xdf?\xe2\xc3\xcd\r\xc6i\x9a\xf96\xe9z\xe4\x14\xa47\xff\xe5\x9f\xfe\xe4\xd9\a\xe0\xafj\x03PX\x89\xfc\xe5N\xff\xaf?\xfdw\xb6\xdb˯>|\xf8\x8e\xbd؍\xc10\x1c\xe1\xdc\x04k\xab\u008f=\xa0j\xea|`f\xa8,\xc1\x8b\x94\xf4\xbflF\xe1\xa7\t>ę\xf8\xb7\xda\xff\x13\x11\xba\xaeCS\xd7\x18G\xc7\x13\x1f\x11\xaa\xda\xe6i9Iyrr\x16\xe8\f\x01o\xd5T\x14{\xfdu\xb3\x90w\x81z6\xd4X\x0f1(v\xc6\xec27C\x95\xa5\x04\x8f\x10QW5.\xb6[t\xcd&\xbf4\xab9\xc2SA\xb1{\x9d\x1c\x86>x\xf4C\x9f\xf5\xc6\xe3\xe4\x84$\xa4\x9e\xb8\x12\x90\x8d\x8c\xb4\xe6P\x17\x86\xf3bFC\xae._\xa0mZ!\x01\xf2\xe3}w\xfb\x0e\x93\x9b\x96ʆ\xc2/^i\x8dm\xb3A\xdd4\x00\x00\xe7'\xf4\xc3\x01\x95\xadш\xfcO\xa9%ǂ\xb2\xa7C\x80\x8f\x9e\xe1rk\x97aE*5\u007f\f\x1dWF\xa31fad\xa2\xb5A\xb7\xb9`ؿ\xae\xcb\x03\n\x87\xfe\x80\xfd\xe3\x03\xdc\xe4@į\xb3\xad\x1b\xbc\xbcz%M\t?\xf7\x18\x03\x1e\x8f{8\xef@1Hl\xf4\x16\x91\b\xd3\xd4\xc3*\xfe\xdc\x06\xf1\x9e\x00\x14\xac\xd6\xf8\xe4\xf2R,\x9bU\xb6\xf9]\xc7\xc1\x1am\xc5\x01P,~\xb5\xca0\xb0Z\xefgN\xfe\x91\x96\xb6s4;\x18\xbaI\xb2\x13T\x95\x89Y1&\xd3\x16&\x87F\xb0\xd7\x03\xe4\xf9\x8f\xc3\x11J\xf3>[%\xe2Y$\f\x87\x03\xaf(\xe4\xf3c\x89\\\x82\x9fS\xa0\x8f\xca0>\x91\xe3\"&\xea\x12\"\xcc\xf05\xe6U\xda\x1a\x00\xcb$BE'i>\xf3>>]\x1crgiFU`0;\xe4\xcd\x1dԂk\x90Ȟ$\xe1U\xf4T&7f\xab\\\x14\xcdEn\xbc\x8a{\\\xe5\x82\x1csj\xa11VV\"\x04\xa5\xc2|_QI\xe2\x9b\x1bˌ6J\x93\x98l\xa9\xe7\xe9_\x9a\xc2$\x87N\xe76\xfbq\x03*\xb9\xa5&g?V\x1a\xf0:f@\xd7vx\xfd\xf2\x13<><\xf0*0N\bѳ\x84\x90\"\xfa~\x90`0iآBTl\x82\xa5ă\"\x88\xf3\xe78\x8e\xb8\xbb\xbb\xc7\xe3ဇ\xfd\x1e\xe38\xe6\xf3/\xe7r\x84\x80\xe8\xdc[\x05\xf5gϥ\xfc\xafh\x03\xb0fy\xfee~\xbd~\xfd\xe9?\x80\"\x8c\xc3\x1e)0\xe6p\xd834m\vW3\xcd\xe4$\x86\xcc\nX,M\xd3af\xa4N\xe3(\x93W<\xd3\xd0\xf0MZW5\xb6\xdb\rB`\xcd\xff\xe4\\v\x14\x9c\x86\xa3\xdc|f.\xf8\xe9\x86\xc7ǧ\xff2xd=\xfd\x8b\xa6mELZ\xbd\xe9\xe9g\xc5f\xb3$\xc1%\xfe\x81\xd2\xc0\xa6ei\x9f\xb56\xfb\x85[c8-\x8f\n\x95\x82R\x18\xa7\x11\x0f\xfbG\x84\x18a\x85P\x19)\x9cݓ\x96\x1cr\x00\xb0F\xe5\xb4C\x17\xc4\x14\t\nW\x97\xd7\xd8mw\x19\x02\x8eDx\u007f\u007f\x83\xc3q\x0e\x01!̐\xb5\"\x9ef6\xdd\x16\x95\xad\x85\x844b\x18\x8f\xb0\xb6B\xd3ty\xe7\xbdn\x88\x92\x9c\xcdK\x1ct\x86\xff\x8bs=\xc5\xf2\x12EX\xcdӿ)\x89]J\xa3\xdb]\xa2n;TU\x9d\xaf\x83\xe0=\x8e\xfd\x11\xfb\xc7{q\x8c\xe4Io\xdb]\xe0\xea\xe2\n\x95('\x8c\xe6\t\u007f\xdf\xef\xe1\x1d\x17\u007f\xe7'\xbc~\xf1\t*[\xc1\x8d=\xa2\x9b8\xb8\xca\xfbl\xfd\n\x00/.v\xb8\xd8l\x16\xd0=\x95Ƿ\f\xaa3*\x80\xc2\xe3@-\xd6&(\xd0\xf2T\xe8\x17r<Y\x9a+5\xef\xfbC\x8c\x1c\x04\xa3\x14`+\xf1\xee'~?ݔ-jS\xd8\x11\xa4\xb1v\xc3\x00\x05\x8e\x94\xa5\xc0\xb6\xdb\b\x1e\xc3~\xcf?#J\x86ԡ\xe5\x1c\x82\xc5Z\x86\xf3$41aS'hZ-\x8du\"͐\xfc\x02\x8d\xa2\xa7\xcf$\xa5\x14H\x17\x9e\b\xcb`I<\xb5\xf7Kk\x10.\xde\x05\v\xb1\b\xe9\xca\xcfe\xe5OP\x9aH\x11ED\xe7\x16\x9f\xeb\xfa\xf1ټ\x89\t\xad3\xfb\u007f.\xe8\xa4J\xb7=\xe1\x9bD\x032s(OAX\x9a\x951)<\x88\x8a\xfe&\x12\x94UBTV\xd9\xfdP\x1b>\x1b\x94V@\x04>\xfb\xf4s\xfc\xec/\xfe<\x9b\x93y?\xc0\xda\x0e!\x04\fÀI\xe4\xbd\xc6\xd8b%!\xbe*\x85\xb2*\x84\x80\xfd\xe1\x80\xe3ᐝY\xb3\x9aB.\xcd0\x8d\x14C\xf83\xa5\xf1\xdds)\xff+\xbe\x02\xf8˶j\xaa\x9bf\xf7\xe2\xd5\xeb\xbf;\xb9\t\xd38\xe6\xd8\xdf\xe3\xf1 \x11\x9b:k\x81\x93\x14\x0f\xe5N\xac\x80\xf6\x99\xa4\x17ᝃ\x1b\x1d|,\xa7\xff\x15\vY+l6\x1bhm0\xf4#_\xd01\xa2ij\x84\x10\xe1}\x10ot\xcc\x06!\x12\x8e\xf3Q\fE\bT\x99L\xb4\x98T\xb0`&\u007f\xac\xe1\xa2\xc07\x1b\a\xb3\x14ş\bUź\xfe\xae\xe9rSbdRPJ\x03\x14gI\xa0R\x18\xc7\x01\x0f\x8f\x0f\x9c] ;=V;\xa9\x8f\xf51|\xe1i\x8e\xa0%\xf0\xca \xed\x03/v\x97\xb8\xbc\xb8F\x19^r\xf7x\x8f\xbbǻ\xa5U\\\x81ZTU\x85m\xb7\x938_\xc20\x0e\x18\xc6\x1e\xd6Xt\xcd\x06VBo2\xb1\xad(\xfeP\"\u007f\x94\xc4:k\f_\x1by\xe0\xe5\xe6/\xc4\x00\r\xa01\x1a\xcdJ~\xd6mvh\xbb-\xbb\xb4\x15\x87\xed\xb1?f\xd8?\xf9\xf9\xef6\x97\xd8u[\x18\xa3\xb3V{\x1c\a\x1c\a\x86\xfd\xbdsp~B[\xb7\xd8nv\xf01\xe0\xd8\x1f0\r#\\\b0b\xc0\x12\x89\xa1\xff\xd7\x17\xb3+b\xde\x01\xd1\x1cࣲ\xd6{\xb5|IPo\xb1#W\x8a\x8a5\xc0L>\xa3L\x11H\xf9\x05\xc86\xca\x00Kͼs\x8c.\xc0\xe4u\x02\xb3\xb8\x9d\xac!t\xb6\xcb&!\x8a\xfai\x84R\xec\x92I!\x00\xda \xb8\t\xc3\xe1\x80\xcd\xe5Ea\x19\\vc\xb1\x98\xbc1{\xffC\xc9穗M3\x8a\xe2_\x0e\xdf\xe9~Q+\u009f\x9a\xef\xb7Ŷ\xbf\xb8\xdf\xd4\xea֢\xa2\xa0\x97\x89\x8e\xe9|\xc9D\xc0R\xae\x9b\xa4\x86\x11\xa7\xb9\xddľ\xf7\x84\x92\xa9X \x01j6\xe55\xda \xaa\x90\xf94\x10\xff\v\x128\x9d\xcd{\xe6\x06?\x04f\xf2+\x91KΈa\xe2\x18\xc9٘l\x97\xb5\x86\x92L\x069\x02\xb8\xd9\xd0\x11:\";\rڪ\xc2\xd4;\xbcz\xf9\t./\xaep\xf7p\v@\xc1\xfb\t\x14\x8d\x10\x83\xfb\xd9\xd6;\xc4l\xb3\x9d\\\x1f\x99(\x18\xf2sM\xbf\xdb;\x87\xd9'B\x8e\xbc\x10ᇁ\x00\xfc\x1ct*\x01|V\x00\xfc\x15i\x00\xfe\xd7\xf2h|\xf9\xf2\xf5\xdf\xddl.\xba\xfe\xf8\x98\x8b+\xe7\xaaG\xd42\xedG)\xd8\x1c\xa2A\x92\x90\xa6g\xf4O!\xef\xfeb\x8c\x18\xc7\t^\xba\xd3s\x06I\x04¦\xedж\r\xbc\xf3\b\x9e;Zk9=\xebx\xecEïf\xe6\u007f\xa1\x1d\a}\xbcj2\xa1N!P\xc0B<_\xb8y\xa9\xa7:\x00\xb6\xe4b\xe8P-\xcdT\x12\xd1\xefr{\xc1\xcd\t\x01*\x1b!%x5\x96\xb3\x05\x8eC\x8f\xfb\xfd=\xbc\x8f\xc5!\">\xf8\xeaI8H&\x17\x8d\xa6\xaeĐi\xc2\xe8X\"tuq\x85\x17\x97/\xf3{\xa2\xb5ơ?\xe2\xfd\xfd\x87\x85yO\xde\xf3B\xa1i\x1al\xdb\r˓b\xc40\xf6\x18\xa7\x01F\x1bt\xed\x06\xc6XX]\xe6\xe1\x15\x87\x88\x1c\xd0^\x98\xf8F\xabB\x8e6K\x8d\x82\xac&\x98H\xa7s\xe3GDh\xba\r\xda\xcdN\"\x9e\x85\xa9\x1e\x03\xf6\x87=\x0e\xfb\x87\\\xfc\xad\xadp\xb1\xbdF]\xb7\xd0Z\x89\xfd0\x87\x18\xf5\xc3\x11\xa3\x9b0\x8e\x03_[D\xb8\xdc]\xc2\x18\x8b\xc3q\x8f\xfda\x0f\xef=C\xa0F\xf3Ĭ\x14>\xb9\xbcBe\xed\xdc<\x16\xfb[\xfeg\x96\x81\xb1\x8fͩ\xe9m\xb9\xba\x9aKJ9\xed#'`\xc6\x04\x1d\xcbHM\x8a\x9d\x1c\xb5\xc0͓g\xe9\xa4n\x14\xab\x06\xe4\xfd\xf5\xd2\x1cZT\x02\xdfF\xa9\xa7\x9a_\xeb8\xf1\xef\x95\x04@\"\x03?\x8d\x18\x0f\x1a\xcdv'a9\xe5B\x9f\x96\xa4Ebc'\x03\x9eLAa\xd1Đ\xdc\xc3l\x1e%(Hꎌ\xcat\xc7|+\xa9\"\xecy\x91q\xbf\xb8\x84\xf3\xd4\x0e,\v?\x15d\xc1\f\xd7\x03K\xbb\xdf\x04\xe7G*\xd4\xf6s\x8f\x11\xbc\xf8\xe9\xa7\xf3\x01\xb1\x88\xf9N\x84a\x95-\xb8\xa1\x15\x94\x14\xccR\xde\x12\x82\xcfFBX\xf0nx\xd8\t1\xc2\xea\xd9\xf2:!\x93%\xa9qɇ\x94\xf7N\xde'Rs\xae\x83\xadj\x8cC\x8f\xae\xed\xf0\xd9g\x9f\xe3\xfe\xf1.\xafB&7\x80\"\x0f\fnr\xb0\xad\x99\xd7\x1e\xd2\xc4ń\x98PBp\xa49Q\x10\x1f\x91\xc2]T\x01~\x1c\x10\x9c\x8f\x00\xfeX+5>\x97\xf2\xff\x05\x1a\x80_g\x83\xe5O?\xfd\xe2\x1fV\xd6\xe2\xb6?\x8a\x83Z\xc4\xe3\xe3#\xb4\xb8\xe8\xa5\x1b\xb7\x92\x10\x1e\x88\xd1\xcar2\a;O\x11{\xfe\xbbi\x82\v~.!\x8b\xf5\x06\xa1\xae*t\x1d\x9b\x9e8\xc7>\xf61F\xb4\x12\xea\u1f47\xd16Cu\x19\xce/\xc8oOU\u007f\xf6\xeb\xe7\xe3\xe2\x04-X\xc0\xbag>\xbb\xc4\xf6'\xe1\x11\x14\xa5 \x12\xa1i\x1a\\\xed.\x98!/~\x04\xd6\b\xc3=O-*\x1fV\x87\xfe\x88\xfb\xfd\x03\x1f\u0ae4\xb1\x8f\x06\x0f\x10\x93\xbe\xba\xa6\x86\xb1\x06\xce\xf9\xec\xf8wqq\x85뫗\xf9p\xd4Jc\x98F\xbc\xbdy\xcbE\x04\xab\x0eG\x01m\xddb\xd3m\xa0\x95A\b\x1eG\xe1wh\xadx\xf2\xb7\x15\x17\xffDF[َ\x12I\xf1\x17\x98\x99\xd7\x04:C\x8cig\x1a#[\x906Z\xa3J\x9f\x03\x11LU\xa1\xedv\xbc\xcbV&C\xc0\x87\xfd\x03\x0e\xfbGL\xd3\xc0j\x93\xaa\xc1\xc5\xc5\v\xe6\x9d\xe4\xddkı?bt#\xc6i\xc20\x1es\xa2\xe3\xb6\xdd`\xb7\xbb\xc6\x10\"\x0e\xc7c\xbe\x8e\xa8\xb8\xde>\xb9\xbc\xc4\xd5v#\a'\x16>ꪘ\xfeu\xbenʗ?Cщ9O\xeb\xa4K\xf9o$\xac\xf9\xa4v \x05\xd6\xc6S\x84\x82\x05i\xb1\x87\x8d\xc0\xe4F\x18\xa3s\x040\xc9d\x17d?mM\x95\xf9.\x88\x84\x88\x80\x00\x00\x93B\x05\x05[\x99L\x9cs\xc3\b\xa5\r\xean#Eg~\xae\xe54\x9e.\xca\x10bF\xf1\x16P\xbf\x10\xe7tAr˯/\x13\xef\x964\xd5s\xe4Ғ+@\x89_ E\x9e\xf7\xd1\x05\xa4Oe\xc1.E:3\xa9n\xbd\xa6K\xbf/\xc6(\xd6ǘ\x95\x00\xa0E\xdcp\xbeψ\v\xbfQ\x1aAEF\xab\xe4\xbf'Bs\xa4(M\xad\xe4<H\xc1'I2\x84\xd1\xd9]\x91àL&\xe6\xe6\xc8s\x05(\xb1DGr\x834\x9c\x14\xaa\x05\xfe7\xb2\"\xa4\x18\xf1\xe9'\x9f\xe3\xe7_\xff\x8cWv\x00\xa0\x02\b\x86\t\xb9C\x8f\xa6\xa9go\ry\x0f\xe3\x82\u007f1\xafDR\x00Xa\xfb\x8b\x10\x02\xa6c\x0f\xc48(\xe0_\xfdg\xffӿz&\x00\xfeU_\x01\xfce~5M\xf37_\xbd\xfe\xf4\xef\x84\xe0\xd1\xf7{((\x1c\x0e\aL\xd3\x00+y\xed1\xd0\xc2W;u\xb7\x05\xa2\xce\xdd|\b\b\x810M\x0e\x93ss\xbe\xf7r\x1d\tm4\xbaMǌ\xed\x89\xf7X\xce1ۿ\xaakx\x17\xb2\xdb}\x19\xcf\x1aeOH\x1f[\x01\x14Pn\x8c+\xb2`\x86\xfe\x9fn\xd6\x12\x8bY+5'թYjw)\xf28\"\xb6\x8d\xb5\xc6\nԯ\x16~\xeeD\x11\x0f\xc7\x03\x1e\x0f\"\xc7)\xe0\xd6\xd9R\x17\v\xa8\xb5<D\xb5\xd6ش\r\ufd43\xc381\xa2r\xb1\xbb\xc0\xcb˗\x99,\xc4\x11\xb7\x13\xde~x[8\xfd\x15\xc6\xe4\x00\xba\xa6æ\xedX\xd9\x11\x1c\x8e\xfd\x01.L\xd0ʠm6\xa8\xebZ\x8a\xff\xb9C\x9e\x11\x8dPLj\xe74\xd1$\xeb\x9fH\x11\x8d֨\xad\x9e\xd1\x0e\xad\xb1\xd9\\\xa0nZ\xf99\xfe\\\xf7\xfbG\xecE\xea\xc7I~\x1b\\\xee^\xc0X^3\x19\xc3\xcc\xedq<\xc2\a&\x88\x1e\xc7\x1e$\x8em\x956\xb8\xbc\xba\x06t\x85\xe1\xb8\xc74\x1c\x84l9\u007f\x16m]\xe1\xf3\x17/rBe\t\x89g8\x1fs\\t\"\xfc\xa1\xc8Y/#h\xd34\xcd\u007f(FX\xb0̀\x17\x02>\xa2\x8eP\x11\b\x10O\x04\x10*[\xc9ZBa\x1cG(\xc51ËP\xab\xa8\x008n2\x95\x866l\b\xa3T\x84\n\x01n\x1a\x004\xb0\x95\x02\xc0h\x8e돬:i۹\t\xc0*\xbd\x8ff\x9dz\x8c!O\xb7%!w\x19\x81\xbb\xd2\xfcg\xb9c\x9907\xaf\x03\x96\xdfJ\xc5\x0e>\xed\xfb\xf9qS\x03\x80\xb5\xf2F\x1c\x89\"\x15\xfe\x1e+\xc5_\fs\xd3\x10\xbc\x9b\xe1~\xc2|\xde\xe0\\\x88N\x9c\r|\x94FD\xc8\xf4\a\xa5\f\x13\x16\xa3\x16\xbf\x91\xe2>\x8e\xb3\x05w\xba\xad\xd2\xde?G\xa2\v\xf7\x86$\v#\xe5e$\xc7Tșg\xa2\x81\xd6\x06A\x05Tu\x03\xef&\\\xee.\xf1\xea\xe5k\x1c\xfb\x03\x93\x81U\x04\x14\xabm\x0e\xc7\x03\xb6\x9b\x1d\xb4\xd5\xd98\x8co\xcfX\x84\x05%yi\xe2\x1b-?'?\x8e\xf0\x9c\x9a\xda\x03\xcf\xfb\xff\xe7\x06`\r\xff\xbf\xfa\xe4\xf7..\xae\xd9\xeew\x1cA \x1c\x8f\x8f\xd9\xe0'\xddIƚ|\xb0T⩞\xbb^0\xf3\x9f\xcd+<\xc6i\\\x06䨙\xf4\a\xa5\xd0v\x1d\xac\xb5\b> ƐC\x82\x9a\xb6\x81\xd1\x1a\x93\x1f\xa5\x80\xcf\xd3\u007f\x82\x06\xd34z\xbe\xf0\xcbs\x95.>\xc42\x05\xec\xc9A{9\xe9\nԨ\xcb@\x17)\xfe\x17\x9b\xad8zQ6\xf6I\xd3T\x0eG\x12\xcb\xe1\x87\xc3#\x1e\x0f\x87\xccԟW\x13jn\x98\xd4\xecJV\xd6\x1a\xa35\xb6]\x87\xda\x1a\xf8\x100\x8d\x13\x9c\x9f\xb0\xe9vxq\xf9j\x112\xe2\xbcǛ\x9b\xefp\x90\x84\xbf\xfc\x9c\xe5\xf7m\xda\x0e]\xb3\x81\x96\xef\xdd\x0f{x\uf815B]\xd5\xe8ꖡͧ\xba)\x99\xb4B\xda7\x8a\xff~\xe6X\x14\xfb\xe5\x189p\xa55\xac\xa5O\xff\xb9\xdd\xec\xd0t\x9b\xd9\xe5\x0f\x84\xc3\xe1\x01\x8f\x8fw\x98D\xf3\xbe\xdb^c\xbb\xb9\xe0\x84:ca\xb4\xe5\x82?<2\xd1o\x1aЏ}\x02\xdcy\x9a\xab\f\xaa\xe6\x02\x91\b\xe3\xf11\aD\xa5\xb7[+\x85Ϯ\xaf\xb1i\x9bEZd\xf2\xf0I\xfb~\xad8\x15/\xef\x86S\xa3H\x84\xb5+S\xfe\xd7H`\x0e\xdd\xdc\x19\xa8rJ\x85\x82\x8eB\x8e\x93\xe9l\x9a&(\xf0\x1e_\x81%\xa4\xc3\xd0\xf3sh\x1an\xc0b@\f\x05\xd1P\x1c\x87\xb4\x06b\x003\xd8)\xc2\t\x94n\xeb\x9a\xff\x1b\x00\xd7\x1f\xa1\x8d\x82I!SB\xe4T\x88\xa0\xe41Q\xac\b\xa2\x10\xe2r\xa3\x96\x953\x11\x02\xb0,\xc2~f\xe3\xdey\xa5@'\x93?-\x1a\x8e\x94\xd6\bqpD\xe6!,Wo\xb3t0\x9eل\xa5\xe8㘛\xc7\x10\xfc\xe2\xe6\x8dIF\x18\xcbm\x84,/\xe3,\t\xccp}D\x86\xe8\x13\xfa\u0088\x84\xc9+<R\x1a\x8a\xa2\xe4l\xf0\x10T\xd5\x15\x8c\xb5Bt\xe6\xdc\r*\x91\xc5R\xf0\xa05\xf35R\x10TU\xf1\xd0CĖΒ\x12\xf8\xf9'_\xe0\xdd\xfb\xb7\xe8\x87A\x1c\xa0X\xc91\x8c=\x86q@\xa7\xbb\x8c\x04\x9d\x98I\t\u007f$I]\xd3G\x93\xfa:\xc7\xd3?\xa0Խ~n\x00\x9e\x1b\x80\xd5\xd7\xd5g\x9f\u007f\xf5\x0f\x8c\xad\xb8\xe8\v\xf4\xde\xf7\x83\xe8\xfbU>$\x92\xa5\xae҆e\x80\xe5\xd8J\xe2_\x1d٠f\x14\xe7\xb63\vT\xb4m\x8d\xbaf6s\x14\xb9\x98\x13S\x9b\xa6\xe1\x83:\xae\x0e\x89\xb4\x9bL\x9d\xfcǾ\x94\xe8\xff\xcb\xe0\x1f\x8d4\xa9\x9d\x9b\xfe\x8b\x1b*̅\xa3\x84\xfe\xbb\xb6Ŷ\xdb\xe4\xe9\xd5\xda\nFq\xf17Z˚Dv\x921\xe2Qv\xd1e'^\x96\xf9\xbc\x96\xa0Y\xe7\x9c\xf4\xe3\xd6\x18l\xbb\x16\xd6X\x84\xe81L#F7\xa1\xa9[\xbc\xbcz\x05mf_p\x1f\xa4\xf8\xf7\xc7e\x96\x81\x8c~]\xc3\xc5_)\xde;\x1f\xfa=\\\bR\x84jl%\x9aT\x81\xa4\xd0,]\x02\xa2Ln\xa9\x91Jh\x8a\xd1f\xf1\xfe$\x86{\xa4\x88\xd6\xca\xf4/䫺٠\xddlam\x9d\xdf\xee\xe3q\x8f\x87\xc7;x\xe7a\xb4\xc1v\xfb\x02M\xcd.\x84\xd6TL\n\x9dzF*܄a8`pC\xfe,\x15\x11,\x01\xdd\xe5kTU\x83\xe3q\x8f\xa1\xdf/cr\xa1\xb0\xeb6xyqY\xec\x94\xd5\xcc\x1f\x91¬U\x9a\xfeu6bҙ\xb0\xaaf\xe3\x98\xfcy\xad]\xf0\xa8PY̞\xff\x8b\xc2\x05\x12_\x9d\xc8v\xcfƊ\x94U\x81B\xc0\xe1\xc8\xd3{\u05f6\x88\t\xf6\xd6jα\x17\x02aF\xe3L\x05\x8a\x91\xf5\xfd\x00\xb4\xb5\xdcT\x04\x8f\xf1p@\xb3SТBт\xd4A\xd3L\x88K\x1a@5\xdb\xfd\xaa\"1s^\x8bљ;\x982\xbc\x8f\x92o_*sJ\x89_\x8cE\x98P\xf1\x19d^I,x\x00\xb4,\xfcY\x89R*\x01bV\x99\xa8\x82\x9fR\xae)f\xf8\x1f\x88\xc4ɕk4 \x19jQ\x8c\x12R\x16\x8a\xf0+\x88\x9f\x06\x9b\x16\xbbȤ_\xe7\x00[U\xa2^\x01\xbc\x0f\xec\xd4x\x8eH\x97\x8c\x8e\xc2\x1c\x89\xac\x8cFݶ\x18\xc4ǡ\xaak\x04\xe7\xf0\xf2\xc5+|\xf2\xeaS\xfc\xc57\u007f!\xd7\x1b\u007f\xfe\xde;\fC\xcf<\xac\xbc\xaeYz'\x84\x14\xaf\xee}n\xd2\x13\x82\xe8\xbdc\x94\x84\xa5(?!\xa5\xde<\x97\xf1\xe7\x06 \u007fm\xb7\xbb\u007f\xf8\xd9g_\xee\xdc4\xe0\xf0\xf8\x00\xad5\x1e\x1fy\x92\xaa\xb33\x1bO\xffi\u00ad\x9bZ\x02\x80f\x884\xf8\xc0\x93K\xf4\x92D\x15\x97\x93\xb9\x1c\xa4\xb62hچ\xcd-\"_\xd0\xc1\a\x04\xefQ\xd5\x15le\x19\x85(\x0e\x8c\x9c\x02\x06Z\xdc\xdc\xeb\x12^f\xb93\xd4\xed˓\xe4,\xec_\x1ev,\xf7S3\xb4'\x8fݶ\x8d\xb8\xfaqq\xa8l-Im\x81\xedb\v\x13\x9c\x10#\x0e\xfd\x01\x8f\xc7}\xf6\xb9_\xf3D\xa8\xa8\x10k\xe9\u007fe-\xb6\x9b\x96\x9b\v⠟q\x1aam\x85WW\xaf\xd9\xd1,\x1d^1\xe2\xbb\x0fo\xf1x\xdc/u\xf8\xf2:ۺæ\xdd\bJ\xe0\xf0x\xdcs\xd8\t\x11\xac1\xd8u[T\xa6\x92=\xf8Li\x9b\u05f7\xb3\xa1K\x88)\xda4J\xc1\xd4y\x8a\xe6ƀ?{\xa3\x80\x8d\xd5sVD]\xa3\xdd\xeePI\xb2\xa0R\n\xc7ဇ\xc7;\x04\x17\xd04-6\xdd%\x8c\xa8\t\xacX\xd6\x1e\x87\x03Ʊ\xc74\r8\xf6\x8f\xe8\xa71O\xf0\x9a\x80Nkl\xb7;\\\xbf\xfc\x14>x\xec\x1fox-P\xc0\xd2Z+\\m7\xb0\xd2\f\xce\xcc5*4\xfd*\xb3\xee9\x80F\xfe\xbdHiT\x8b(\xda2\x88\x91V긂8(r\xc5D\nL\xc5LA1\xb7\xc5h\x18]#\t\xf6YƵ\x871\x86ﻬ\x9cQ\xb3\xf9 \xb1\xb4\fQ#\xea\b\x03!4\xa6X\xed\x10A\x95\x85\x0e\x01\n\x1aՖ?\xfb(\x9f\x17\xd3@5(Y\bӌ\xaeE\xf1\xef\xd7\xf2\x1e,\xf4s\xb4\xda\xf3\xab\xf2?-\x9b\x9e\xf5]\x99\t\x858\xdd\xeb\x93\xf01\x16\x92\xbfս\xa2\xcaf$\x92\xac\x98\xc2\x123+\xdc\x02U\x19\nT\xac\x14ʻ?\xf9\f\xc4\x10\xe1\x83\x17'>\xe1\x86\x10\x01\xceg\x9f\t\x82GT\x06J\x05\x0e;\x8b\x1cM\xed}\x05k\xab\xec\xef\xa1\xc5\x11\xb5\x84ߒ\xb3`\\\xa5\aڶ\x851\x16\xceO\xa8\xea\x06S\xdf\xc3\x18\x83\xaf\xbe\xf8\x1en\xefo\xf0\xb8\u007f\x14\xa4\x90ϓa\x1a\xd19'\x84\xe3\x98M\x13\x93\xb2$\x86\x80\xe0\x03&\xe7\x16\xe7.\x01\x98\x86\x81s\x10\xd8\xf8\xe0\x9f\x1f\xbcߟ\x0eL\xcf\n\x80\xdf\xd6\x06\xc0|\xf6\xc5W\xbf\xb7\xd9^\xe0\xee\xe6\x1d\x9cc(v\u007fx\x9c!m\x91\ne\xd7?cxz/\xec̈\xa4\x01\x10\x9b\xdbq\x1c\v\xde\xd4\x1c@\xa2\xb4B۵\x00\x01\xde\x05\x0e\xf6\x11'<\x02冃\u007f\x17\x95\x8a\xab\xdc\f<%\xff+I\xb8\x89\x9c\x17d\x9cO\xfa}\xa2\xa7\xa6\u007f&%e\xbf\xef$\xccS\nMS\xa3\xa9\x1b&\x05i\xc5F4\x8a\xd3\xf5\xac\x04\xfb\xa4\x89\u008b\x94\xed\xf1\xb0\a\xce9\x1e\xaeNɵ\xfc\xaf\xad*l\xbbNLA\xd8\u07b3\x1fل\xe9\xf5\x8b\xd7h\x8at?\x10\xf0\xfe\xee\x03\x1e\x8e\x0fs:YA\xd2j\xeb\x16[q\x89\xf3!`\xdf\xef\xc5d\x84\xa7\x92m\xb7\x9bMuT\x99\xe0P\x9e\x9b2\xfd\a?O\xb2\xb2\xfa\xc8\x06I\xf2\xba\xa2\x1c\xa4mm\xc5d\x87\xb3\xd97\xdb\v\xd4M\x97\xa3\x8d\xc7i\xc4\xc3\xfd-\x82\xf3\xe8\xba-6݅\x18\xa4h1j\t\xc2\xf2\x1f1\fG\x1c\x8e\x8f\xf0~b\xc7<\x19\xb27Ƣ\xab\f\xae^|\x02c,\xee\xef\xdfÏ\xc7\x13\x87îi\xd0\xd5\xf5\"\xee\x99/\xdb\x12\xe6g%\x8bQ\nFq\xa2\x1f\x9b\xac\xcc\xfe\xefT\xee\xffsa\x8b\xa7\xab\x92r\xefL\x84@\x04\xa5\xaa\xe5\x8aG~\x89w\x01\xb5!(\x93\x14#s\x13pm\xaeE\xa3\x1f\x11\x15\xef\xfdӺ͇\x00+\x90\xaf\xd2\x1aFq\xd8L2\xc2I\u007f\xef\x9d\ai\xa0\x96dAґ\xf7\xdb\xc6\b\xd3}Yhs\xb4t$ \x14<\bu\x06+#\x9c}\xeds\xb30O\xf5IN\x98'\xfd\xcc\xf6_\xfe\x9e\xb3\xf9\x1b\xb2J\xcb&?\x89\xf9^\xe4e\xb0\xc2e\xa9\x0eP\"YV*fo\x8c\x94nL\x10\x13+7q>\x80\x14Ԓ\xe1\x10(\x02i\xa2\xf6\x1e\xd6ZTU-\x92d-\xf2dF\xae\x94\xd6\xf0\x9e\x93\xfc\xb0Z\xe5A\x01\xba\xb2P\x93_\xbc(c\r\xeaM\vw?\xb2ꦪ\x01\x1cp\xb5\xbbď\xbe\xfa\x11\xfe\xc7?\xf9\x1fX\"\xac\xb9!\t\x9e=\xfe\xe7D?\x96DF\xb9?\x9d\xf7\x98\x9ccπb=\x10\xbd\x17\xff\b\x00D{E\xf8\xe7\xff\xe5\x1f\xff\x89\u007f.\xe3\xcf\r\x00\xbf\x98\xca\xfe\xbb_~\xf5\xc3\xdf\x05\x80\xfd\xfe\x1eJ\x01\x87\xc3\x01n\x1a\xf3D\x94\xa6v-p!;\x00\xaaE\xb3\x1b\x83\x04WD\xc24\xb2\x01K\xb6\xe9Ts`E\xdb6\xb0\xc6b\x18FXe2D\xef\x83\xe7Ƣ\xa9\xd9\xe26\xc4\x05\x87-\x15\xb6\xb8\xf2\x16_L\xff\xaa\x9c\xfct\x86\xc2t\x1e\xe1\"\xce\xc2\x06\xe0\xbd*-&Bf^W\x95E%\xe6>\xd6\x1a)\x98\xfc\xbb\xad\x14,\bT\x18B@?\xf6x8\xecE\x03\xac>zj\xae\xd5\x00]\xd3`\xd36\x80\xc0\x92\xd34\xa1\x1fG\xd4U\x8d\x97\u05ef\xd0\xe5\xe2\xcf\xdf\u007f\xfbx\x8b\x9b\x87\xdb\xd5\x01\xcd\x05\xa8\xb6u\x9e\xfcC\xf08\xf4{\x96\x06\to`\xd3nе]\xf6\xae?\xb7\xf7O\a5Ix\x93V\x061\x93\xe5\xcc\xd2\xf7A\x90\x0fk\x146\xd5L\xa0\xea6[\xd9\xfb\xb3\x85\xaa\xf3\x0e\xf7w\x1f\xe0\xa7\t\xdb\xcd\x05\xba\xee\"7lZ\x1b87\xa2\x1f{87\xe1\xd8\xefq\xec\xf7|]%#\x17R\xd8\x18\x83MS\xf1jc{\x89a8`<<@e\xc2[Z\xa3h\xec\x9a\x06\x9b,7,\xa3m\x91\x8d\xa2\x98ǡs\xa6\x80R\xc9\x13`\x19Ȥ\xe6|c\xac\r\x9a\x9er\xc8I\x99\x00\xbc\x1e\x92fڨ\f3\xfb\x18P\xe9\xd4l2\f?M\x0e\x87\xe3\x01\xbb\xed\xaeXw)\t\xf2\x13)`Z0{\r]q1\x9f\xcd_\";\xc6y\x8fx\x17A\x97\x84\xaa\xed\x800O\xe3Jsr\xa7.\xbb\xe6E\x84u\x04\x05\xe4\x06I)\xb5,nO\f\x8c\xa9Hg\v\xe0T\xec\xe3\xaa\xc8/ޮ't\x04\xf3\x98+\xaa\xa4r߯\xf3b\x05qIXMrN-\x84=R\x05\xff\x86\x90!\xf5\xe0\xdd\x13}\xf9\x9c\xe5উϣ\x18y\xda\xf7\x1e\x95\xad\x10\xbcC\x8c\xb5\x9c\x03F\x82\xbd\x96f^\xa9\x10+\xad\xa0+\x03=\n\x19P\x9eK\xbbۡ?\x1c@!\xa0j\x1a\x18c\xe1\xa7\t\x9f}\xfa9\xbe{\xff\x1d\xbe{\xff\x06IB\x1c\"+\xa4\x9cw|\x0f'ҟ\xf8mp\x030\xc1ɪ\x81\x02s\xb1\x18\x19\xc8\xf5\xfe\xa7\x81\xe2\x1f>\x97\xf0\xe7\x06 \u007f}\xfaɗ\xbf\xff\xfaէ8\xec\xef\xd1\x1f\xd9s\xfc\xfe\x9e\x8bJbx+\t\xe4\x01\x80\xcaT\\\x10\x17\x8d?!\xb8D\xfe\v\x18\x86q\xc1\xdaO\xf0\xbd\xad\fڦ\xe5.5D\xa0\xb2\xbc\x0f\xf5\x1e\x14\tMWCk\x85ѻ\xbc\xb7'\x91\xe2\xc4\x04ٝ\xd9\xfd\x93\xe0\xd0ɬ\xc5\b\x83\xd7\xfbٴ_$\xfd'\x87\xf4\xdcT,\xbd\xf6#xʵ\xc6r.{U\xa3m\x9a̲5\x85-h\x10x<9\xfc\xcd\x1e\xe0j\x017.#<K\x82\x97ƦkyRU\xb3\x16\xbc\x1f\x06T\xb6\xc6\xeb\xebr\xf2\xe7\"\xf0p\xdc\xe3\xed\xed\a\xc4\xc2\x169͟V[l\xbb-\xb41\xbc\x8e\x18\x8ep\x923@\xe0П]\xb7\x95]w,\xa8]\xf3W\xc4\x1cƒ\v\x8b\x90\x99R\\\xeb,\x13T\x12\xc0\x12\xb1\xad9\xf3\x80\xbd\xeb;6\xfb\xb1U\xb6B\xbe\xbb\xfd\x80i\x1c\xb0\xdb^\xa3m\xb7\x19QRJa\x18{87`\x9aF\xec\xf7\x0f\x18&&\xfb%f\xb5\x8e\n\x9d1\xd8\xd55j[\xe1\xe2\xfaS(\xa5q\xd8? \x06\x97\xb5\xd6ɺ\xa7\xabk\\vm&0\xaem\x96\xb5R\xa8\xc4\\\xa9tyS\x85z \x11Ʋ\x83\xc5\x02\xa5.4u+\x91\xdaz\xd7L\xa4\xf3\x16\x8a\xf7\xd6:#3))r~\x00B?\xf6\xa8\xace\xe4)\xa1h1\"\x8a\xbd.\xb3\xf7\x81\b\x0f\xaf\x15,\xecB\xb9\x91X\xfcq\x8a\xa0\xfb\a\xb4\x91`\xeb\x8a%k0\xa0\xe8\xc5hI\xcf6\xbd)\x86\x17*\x9b\x1a\xceѻE\xf0\xef\xd9\x0e`n.B\b+V\xed\xb2\xf8Ӭ\xe2?\x83\xfa\x97V\xc4\xd2@\x88\xe9\xd4\xda\xf4\xff)\x19p\xfa\xec\x8cR`j\x03!j\xbe\xc2\xdd\xe4p<\x1cV\xc5?!\x14178A\x14I\xc1\a\xe15\x8dh\xda\x0e&\x84\xec\xd3\xe0\x9d+\xa6~\x0e\xafb^@\xba'bn\xa0tea\x9a\nat\x12\xf6\xa3\xd1\\l\xd1\x1d/p\xb8\xbd\x85\xad*غ\xc28\xf0\xfd\xf0\xd5\xe7_\xe1\xfd\xcd{^a* \x04\a\x1f\x1c\xdc\xc4<\xac(j\x86(\x01Y\x93\xf3p\xce\xf3\xbd\"\xcd\v\x88\x10&\x97\xc8\u007fD1\xfe\xb7c\x88\xdf<\xc3\xff\xcf\r@\x82g\u007f\xf7\x87?\xfa\xf1\xbfk\x8c\xc1\xe3\xc3-\x00\xc2~\xbf\xc70\f\xcc\xf6O;\x02k2K\xb8N\xd3\u007f\xf1\x15\x92\xc7?\x11\xc6q\x82s>#\a3\x19E\xa1\x95\"6\x0e#\x9a\xba\xc9q\x9eαԩi\x1bn\"|8\x85\xa2\xb3S\xd8\xf9cV\x15\xae~)\xdf[8\xd8\xe0-\x06a\x91\xc0R\xde\xfc\xb1p\xf5*X\xeb\f\vktM\x8b\xb6n\x84\xc9L\xd9\xfd\x0e\x00<\x05P\x8c\x98\x9c\xc3\xfd\xe1Q\xf6\xe43y0\xed\xfb\xb5R\xab\xe7:?\xdevӡ\xad\xaay/\x19Y﮵\xc1\xcb\x17\xaf\xd04]n|\x94\xd28\fG\xbc\xf9\xf0v\x0e\x0f\x92w#\x82\xdd\n\xb7\xed\x16\xd6Z\x10\x11\xfa\xf1\x88яYNY\xd9\x1a\x17)\xe1/\xfb\xb6\xab\xd59,\x87\x17\x85,\x9b\x826\x004\x88|\x11\xae4\xafh\xbc\xf7h\r[\xfe\x02\x1cL\xd4n\xb6\xa8k\x8eڍ\x14qw\u007f\x83i\xe8q\xb1{\x89\xa6\xed\xd88I[\x90\".\xfeӄq\x1a\xf0\xf0x'\x11Ǆ(\xc4B\x04v\x14ܵ\r\xdaʢ\xedvh6\x178\x1c\xee\xe1\xc7\xe3\xec\x13\x91y\x14\x06כ\r\x1a[\xc9\xcf{hU-p|m4l\x8e\xf8U'9\x0feJ\x14\xf34\t\x1f7m\xc0Y\x9e\xc9\xcc\xf9Xz\x06&\u007f\nι0\x8b\xdf\x1d<\xbb\xc0\x19cr\x00M$\x82\x8e\x11d\x80H\x8cB)%P?\xf1\xba%7\x00Ļp\xa55\x9c\x1b\xa1\x0e\x1aP[(\x99R\x8d\xb5\xd00\xb2K\x8f\xb3\xfb\xa1\x98Xe\x9ed)#$U\x98&\x9f\xd7\xfe\x13\xd1\xf2\x1d \x14\xc4@Z(\n\xca\x1b\x9c\xca_\x94\xbc\xa6\x13\xd1/\xc6\xd9f\x198\r\x06*\a\x81\xc2\xfe6\xdd\xffJ\x01:\x02\xd1{\f}\x9fU\"\xcbϬ\xe0\"H$\xb0\xf3\x01\x91\x1c\xaf\xf6B\xc08\f\x92\x8dң\xaa*\xf4\xc7\x03\xbaͮX\x81E\x90\x8d\xa7\xeb>qK\xb5m=\xe7`T\x15lSa\xf3\xe2\n\xc3~\x8f\xe8\x03\xea\xa6Eo\x0e\bn\xc2\xcb\x17\xaf\xf0\xe2\xea\x05\xde~x+(\x1e\xfb\xaa(\x18\xe8\x10\x16n\x80>\x046[\x13\xd2t\x9a\xfa\x99\x04\x9d\x89\xd4{\x02\xfe\x1f\xbdw\xfds\t\u007fn\x00\x00\x00\x9f|\xf2\xd9\xef\xbd\xfe\xf4\vs<\xec\xb1\u007f|\x04\x11\xf0\xf8x_@\xa4\xbc\a\xb4\xb2\xfb\xaf\xeb:\xf3\x00ʳ0ʾ\xdeG\x0e\xaf\xa0\xc2\x14(}5m\x03k\r\x0e\xfbc\x86Y\xa1\x00\xef\xc4\xf8GH1\xe302:P\xc0\xf9%\xdb\xf5\xa937\xcfnJ\vs6\xe4\xc9\x1fYJH\xab\xb5\x05wй\xf8\xcb\xe1\x11\xe5\x10\xd3Za\xd7m\xd1\xd4u\xd6ڲ\xbf\xbf\x99\rq\"ar\x13\xee\xf7\x0f\xf0b\xe1\xba.\xfej9\xfa\xe7I\xdcj\x8d\xddv\x83\xca\xdal\x15L\x14q\x1cz\x10\x01\xaf_\xbeB\xd7lf\x15\x83R\xd8\xf7\a|\xf3\xfe;L~Z4\x15<\xf1\x18\xec\xba\x1dja'\x0fӈ~b\xd6<\x89i\xcf\xe5f\xc7\xe9xg\x19\xffsYOFP$F):\xb9ˉ\xc6y1\x14\xc6\x00\x90Ƕj\x84_\xa0\xd0m\xb6h\xdbN\x98\xee\xc0\xc3\xc3\x1d\x86\xc3\x01\xbb\x8b\x97h%,\xc9Z6A\x19\xc7^t\xfe=\x1e\xf6wp\xde\xe5]}\x88\x01**TJ\xe3\xb2m\xb1mjX\xa5\xd0l/\xe1\x82\xc3p܃\x84\xe8Y\xae\x80.\xba\x16\x9b\xba\xe6I\xc8\x18\xa8\xe4\x1d_Hݬ2\v\xd6\u007f\x89\f䄿s˦\xd2_\xff\t5\xc9\xc9p\xba\xf8\x97T\"\xb9Y\xf2\x12q\xbcF(\xa6i\xc2X\x8dh\x95\xe2\xe2\x8eY\xaaf\x8cF\f\x84\x80\xc0\xbd\x99\xb8dfC\x9aT\x8cc\x84Q\nn\x1c\xa0\x8cB\xddm\x10\x92\xfe_\x9b\x1c\f\x84$)\r(H\x80\xa9ۖ\"\xb2 \x99ί\x89\xd6\xf1\xbe\x89PXH\aO\xac{A\xa7\xfd\x92\xc8oAE\x1a`\xc1wY8\x1a\x9e\xa7\x1b\"I%s\xbb\xa68\xbd\x93\x140\x0e=\x9c\x9fN\x88C\x9c\xd0\xc8\x01=Mݠ\xed6\xa8\xea\x16\x93\xf3\x98\xa6\t\x87\xc7;\x1c\x1e\xef0\x8e}6\xef\x89!\x80چ\xed\x9c\xe5~\x9a\xd1\u007fi\"\xb5Z웴\x15n\f\x01\xca\xf0\xe36\x9b\x16\xdd\xd5\x05\x8e\xb7\x0f\xa8\xea\x16\xd6Zx\xe7`\xad\xc5\xf7\xbe\xfc\x1e>\xdc~@\x88\x81\xa3\xbf\x03?V\x8c\xf3{J1\xc2\xc7ف3\x06\x8f0M\xf9\xbd\x8f\x82\xa2(\xa5\xfe\xc8\xc7\xf8_\xfd_\xfe\xfc\xa7\xcf\x19\xc0\xcf\r\x00\x00`\xf7\xf9\x17\xdf\xff\x87u\xdd\xe0\xf6\xfd\x1b\xc4\x180\f=Ƒ\xbb\\֡s\xf1W\x8ao\x90\xaa\xaeN\x8a\u007f\xf0\xb39\xcc4Nl䓜\xcb\xe4{\xaa\xaaB\xd3\xd4l\v\xec=ڜ\\\xc6\r\x80Rj\x8e\xfdu.w\xc9D3d\xf8\xb1\x06`6ma\xf9\x9c$\x99dG\xb7\xa5\xad(2\xa4\x9a\xccb2o\xa0\x88\xf3\xac\xab\n\x97\xdbK4u\x9d\x99\x03V\xb31M\x8c\x04\x1f\x99u=9\x87\xfb\xc7\a\xf8P\x16\xff\xf4\x9c\x97P\u007fr\x16K\xcf\xf3b\xd3\xcd\xc5_\x0e\xd4^\x82\x93^\xbfx\x8dM\xb7[\x16\xff\xa1\xc7\xd7\xef\xde`\xf4\x13\xc3\xec\xf9\x98\xe3d\xbd]\xb7\xcb\xc1>\xc38\xe20\x1c\xe6\xd4?(\\\b\xe9\x0f\v(\xf6\xfc\xf4\x1f\x05\xd2O^\xf1\x1a\n\x9e\x05\xe8s\x94\xac\xacMB\xf0\xd8T\n\x95L\xb1Mӡ\xebD\xf2\xa7\x14\x8e\x87G\x1c\x1e\x1fpq\xf1\x12\x8d\xc8\x11\xad\xa4\xb2\rcώ\x84\xc7=\xf6\xfb{a0\xf3;\x12\x88\x80\xa8`\xa0\xb0k\x1bl[.\xfe\xb6\xd9\xc04\x1d\xee\x1e\xee\xe0\xdd(\x06K\xf3\xc7\xdb\xd5\x15\xae\xbann^\x12\xe3<\xbb\xf3aaa\xac1\xbb\xb7\xe5i\xb7\xe0\x9d\xa4\xf7\xef\xa4\xf6?1\x05\x9f\xfb\n1B\x93\x99\x15\x1f47\x13$\x12\xae\x12\x19\"\x00\x81\"\x86\x91\xa5\xb8\x95R\x99\x83Cy\xa5\xa1\x19q\xd2:;\xe5)\xf1\xac\x9fɹ$\fu\xc2\xd8scYw\x1d\x94\xe4\f\xa4u\x95\x96\xc6\x16\x9a@Z\x03Q\xcf\rb~\xae\xa1(\xacjEm\x99\v\xfe\xac\xf3\xffŵ\x86\x16\xdc\x01Ysȵ\x97}C\xca\xf7\x98N\xdfq*W5\x12_\x1c\x15\xbb\xef)i\xe6\xc7\xc9c\x1a\x87\f\x02\x92|\xaf\xb5\x15ꚓ/w\x17\x97\xa8\x9b&70-)h[!|\xf2\x19\x8e\x87#\x1e\xef?\xe0\xfe\xf6]~~[{!(\x8e\x9f\xc3}\x8a\xf5\x8b66\xa9B\xb3A\xd2\xec\x05¯K[\x8bf\xbb\x91!*\xa2n[\x8c\x03\xdb[\u007f\xf2\xf2S|\xf2\xf25~\xfe\xe6k\x00\x9c\xd4Yٖ\xd7QTjN\x18\x99\x8cr~&\x17\xc7䐨\x94\x9a\"\xf0\u007f\x8fD?{.\xdf\xcf\r\x00\x00\xe0\xe2\xf2\xea\xef\u007f\xf6\xf9\x97W\xdeM8\x1e\xf7PJa\u007fx@\n\xbf\xd4\x02[%7\xab\xba\xa9O\xd3\xea\x84e\x9a\xf6~Ǿ_(\x03\x12\xb9\xab\xedZ\x84\x101\xf4\x03\x87\x9f\xc8\xcd\xe7\x9cC\x88\x01uS\xa3\xaa+\xb8qB\xf0q\xe1&\xb6\xb4\xe7\xc5I\x00HJ\xe4*\xa1u\xca.p\xdc\x1c\xc4\x10N&\xb3\x94\xee\x97~\xad\xc6\x1c/\xdcT5^^]\xa3i\x9aL\xe4I\x99\xf0\xa10\xc3\x19\xa7\tw\x8f\xf7\x88\xc1\xb3]hA \"\xac\x8b\xffldd\xad\xe5\xe2/:\xf0\xf4\xa4\x86\x91\x8d\x93^\xbfx\x8d\xcdf\x97\xd5\x0eZ)\x1c\xa7\x11?\u007f\xf7\x06\xa3\x1b3\x13x]\xfck\xd1؏\xcea?\x1cf\xb5\x00\x80]\xb7Ŧ\xe9\x8a7.\x9e/\xfeD\x1c\xbf+\xac\u007f\x92\x95\n\x17$\tPIOY\x18rFEl\xab\n\x1a\x04S\xd5\xe86;غ\x86\xd2\n\xd34\xe2\xfe\xee\x06]w\x81\xa6ٰ\xa7\xbf\xa9\xe0\x9cC?\x1c\x11\x83\xc3\xe1\xf8\x88\xc3\xe1\x81\x1f\xbb(p\xe4\xb9\xf0n\x9b\x1a\x17]\x03\xab\x15\x8cm\xd0\\\xbd\xc6q\x18\xd0\x1f\xf7Ђ\x16\xccכƋn#.{\x91W\x1d':v\x95]\x18\xf5\xa9l;\u007fN\xebB\xff?gS\x9as\xe2\xd3:H\xa9\x85\x9f}\b\x11Z\x85\xdc|\xa7/\xef\xd9\xfaY\x89ۤ\x16\x94+}\xb6Z\x8a\x95\xd6b\xb0\xa381rQ \xa5p\x83X\x12F1\xa2\xee:\x982\xde;\x04DY\x0fhc\x84\x00l\xb8\tX\x04\xf2P戬wȱd\n\x9cW\xdc\x16$\xbb\xb2\xf0G\xe1\x0f\bܟ~\xd3\ni!U&w\x16Ɍ\x14\xb3lW)\rR\x90\xc6_Ɋ\x8bঁ\x9b,\xb0\xf7\x81\x82B\xdd2\xb9\xb6\xed6\xd9\x190ݻZ)\x91\x8erc\xbf\xdb\xed\xb0\xd9]\xe0\xe2\xea\x1a\xfb\x87\x1bT\x95E\xd7n\x18I\x8c\x01\xca\xda̙\x8a\x91\x11\x9a\x1c\xb3\x9c\x90'}\x06\x00Q\nU\xd3\xc0o\x1c\xa2\xf7h\x8f[\xf4\xfb\x03Ɓe\xa2\x9f\u007f\xfa9\u07begwOB@D\x9csI\xd2`\x93\xf6\xfe\xde\xc3O._\xc8^R\x02A\xf43\x02\xfe\xcf\xff\xa73\xec\xff\xe7\xfd\xffoc\x03\xa0\x80\xdf\xf9\xf1\xbf\xf1\xfb\x17\x17W\xf8\xf0\xee\r\xc6i\x84\x9b&I\xfd3\xd9\xed\x8a\xcda\xd8\xf4\xc2Vg\xa6\u007f\x99 \x88\b\xe34\xc1M\xc5\xf4_@\xffJi\x1c\x8f\xc7,\x1fK\x13$\xa7\x9e)tm\x9b\t:I\xeb|\n\xed\xad\xc6-\x9a\t\x863\x84\xcf\xdam\x8f\x90\xf3\xe2)Y\x83\x96\x04\xb7\x10W\x99?*O\x11m\xd3\xe2\xd5\xd55\xea\xaa\xce\xcf\xc3\nI͋Q\b\x01\x18\xc6\x11\xb7\x8f\xf7\xcc\x0e\xd6s\x18\xce\nh(a\nIq\xb3إ\xc9?\a\x1a)\x8c\xc2\xe0}u\xfd\x1a\x9d\xc0\xb4鹍n\xc2\xd7\xef\xbf\xc30\xf6\xf95\xe9\xa4-\xd7\x1a\xdbv\x87\xbab\x04e\xf2#\x1e\xfb}A\xa2$\xec\xda-v\xddvY\xfc#@Z\x9d-T!\xb04\nڀ\xa0\xf3\x0e\x9f$\xe6x\xf1\xfa(bc\r[\bk\x8b\xb6\xdbfFs\f\x11w\xb7\x1fP\xd9\x06\x9b\xcdE\xceS\x9f\x1c\xfb\x9b{7a\u007f\xbc\xc70\x1c2\x92\xc3M%!x>/\xdb\xda\xe2\xaakQk͖\xc5W\xaf1ň\xfb\xc7{D7B\x97\x06=\x00.\xbb\x16M]\xe5\xd46\xb5*\xe3\xec\xcc6{\xdc+)\x143n#E\x86\xd6륏\xad\x01\xca\x1b\xe3\xe9ʗ̈́\xb2-\xb6t\xba\xe9uG^˔\xea\x91H\x04\x17\x1c\xac\x13٥\xb6y2\x8f\x12\xb0emzJ\xc2)\x80\x87\xd66\xff\x1e\x12\xf2\xac\x86\x01\x10\x11\xbc\xc34\x026FXq\xf3L\xf0~\x94ly\xe6q\x18\x9eb\x17\x84U\x9c\x95\x8bf\xc7\xc1\"\xba\xf7t\xf5Q\xfeu\x9a\xf8\xd9:\x9c\x10V\xb2\xca\u0096\xb8\x8c\xb1Ĝ\xac\x99\v\xa0\x14\u007f\xad\xb3\xf5\x9eXrC\xde''D\xe3\x98}7\xaa\xaa\xc1\xe5\xe5\x15v\x17\x97\x85\xf51\xfb\xfa+\xf1\xa3\xe0\xb8`'\x03\x0e\xa7\x92\xee..\xd065\x9c\x1b\xe7\x15GRV\xa4{B^\x17dU\x96\xac\x81\vc\xe1b\x82\a\xaa\xba\xc6d-\xaa\xaeE\xddvh6\x1bI\x04$\\^\\\xe1\xfa\xea\x1a_\u007f\xf7-\x14\x98\x90h\xad.(\xbf1#L\xe38fd(\x1bD\xf1\x03\xfd\x13\x8a\xf1\u007f|.\xfe\xcf\r\x00\x1f\x92\x97\xd7\u007f\xe7˯~\xf07\xa7q\xc0\xfd\xdd\a(\xb0+\x1bE\xe2\xdd\"\x91\xec\x06\xf9\xa6h\x9a\x1a+\x1f\v\x86\x89\xc563ĀQ\x98\xff\xaa\bQid\xb2\x1f\xc7\x11nr\xa8+\xcb\x13\xbb\xec\xe8C\xe0\x98ߺ\xe1]\x9a\xf7\xe1\xecEI\xe5\x04r\xb2\xfb\x9f\xbf\xdf\x18\x9bw\x99\x89\xd1|R\xfc#-\xb8\x80\x89<\x18cĦ\xed\xf0\xea\xeaE.\xce\n3C=\x84\xc0\x01\x1b\x00\xfaq\xc0\xcd\xc3=(\x86L\x06\\\x8b\xc0\x96{\u007fq&\xb4\x06\x17\x9b\r\xac)Li\b\x98\xbc\xc3\xe8\x1c^]\xbfƦ݈ޝ\xb2y\xcf\xd7\xef\xdfrHH\xb1{\x17\x13;l\xda\r\xc3\xfa\x04L~\xc2\xfe\xb8\x17b\x17?\xfe\xb6\xe9p\xb9\xb9(\x92\xebb>\x9cp2\xfd\xf34\x13\x83\x83\x12\xc9_\x9ajxzR\xab\x00%\x85J\x11\x1a˓W\xd3mP7-\x8cX\xfd\xee\x1f\xef\x80H\xd8\xed\xae`\x94\x815\x16\xce;\x8c\xe3\x80i\x1c\xb0?\xdc\xf2A\x9a=\x1a$fX\xfc\x18*k\xf1bӢ\xb1\x86I\xa2W\xaf\xe1\xa0\xf1\xb8\xbfG\x98z\x98\xe2u\x02\x84M\xdd\xe0\xb2\xeb\xf2\xeb234$\xbe\xf6\x19\xcc/\xa2\x00\xd4)\x02\x90R\xf3\x16ѿ\xeb\u007f\u007f\n\xd0Ύ='\xbe\xf8嵸\xb4\x88H\xe8\x13\xcb\xf72G&\xad\x0f\x02\xfbd\x18\xad\x11\xacd\xca\v\xf4\x15\x82\xcf\x19\xf7\x8a\xb4L\xa4\x04\xa8\x00\x1du6ǠH\x80I\xectB\x10\xe2 \xc5\xc8?k\xb4\xa8gtFт\xf3yEǾ\b:\xab%f([\x17\x85\xbaXם\x89ڦ\xf9\x8d\x00\xb23 \xef\xfaA\x1f\xcf\xf58i\xach\xf6\xf5_r8\xe4\xb3N\xf6\xc4+ʀ\x02\xab>ڶŦ۲:\xa9i\xd1l;ئa;\f\tl\u07fb\x11\xa2\xb0\xe7\xd8\xe9~\x84\x81\x85\"6ݙ\xe5Ɂ\xef\x11)\xbe\v\xebo*.\xae\xfc\x1c絤\xb6\x06\xb6\xaeA!\xc2\xd6\x15\x9a\xb6\xc34\f\xf0nB۴xq\xf5\x02\u07fe{\xcb\x12k\xef\xd1T6_[\xe9\xfa\x9a\xc61\xaf_\xd3p&g\xcc-\x80\xff\xdb\u007f\xfe\xaf\xfe\xe8\x99\xfc\xf7\xdc\x00\xf0\xd7\x17_|\xff\xf7\xbbn\x87\xdb\x0fo0M\x03\x00\x85~8\xb0\xb3\x1fA\xc8A\xbcKl\x9a\xba\x88{\x9do\xbe\xa4ӏ12Yi\x9c\xf2\xf4O\xc4\xd3C\xdb6Y\x16\x98mV\xc5\fƋ}i۵P\n\x98\xc6\xe9\x14\xf2/'\x81xJ\x1aZ0\xff\x95Femf\xf8\xa6\x84\xbaL\xfe\x93\x03\xb8<\x84\xd3\xde?ƈ\xaei\xf1\xfaꥰ\xe7\xa34\x14\f\xfb\xfb\xe0\xd9\x18D)\x1c\x87#n\xef\xef\x10\x89P\x15V\xb8\xb4B\x14\x96\xf91\\\xcc.6\x1b\xeeދ\xef\xf51`\x9cF\xbc\xb8|\x89\xae\xddd\xa7\xbbT\xfc\u007f\xfe\xee;<\x1c\x1e\v\x1b\xe1b\xd7\xddl\xd0\xd6-\xa0x?x\xe8\xf7Ҥ\xf0B\xa3\xad\x1b\\\xca\xe4M\xc9!M\xa4N\xa4Θ\xfePD\xf0Ӽ\xabD\xe2PPv\x1e,\rq4\b\x9da\x13\x9d\xbaݠn:\xf1\xa47\x18\xfa\x03\xa6q\xc2vs\rc-k\x9c\x83\xc78\xf6\x18\x86#\x1e\x1fo\xe0\xa3\xcb\xefU\x14R\xa5\x0fQ8\x02\x1aW\x1b\xd6\xf0+S\xa1\xb9\xfe\x04\x01\x06\x0f\xfb\aLC\x0f\xed\xa7\"\xd0I\xc1jf\xfd\x1b\xf9}Z\x17aH\x84\xfc\xf7\xfc\xdc\xf5\x9c\x94\xae\x8aω\nè3\xb8?\xad#\x80\v\x14\xe0d3}\x92\x8a\x83l8\x93\x9e7\tV\x9e\x90\x95\x1c\x8d\xab\x97\xc4Q\x8a\xc2\xf4\x8e\x01J\x8a:)\x82\xd1(\xb22\xb8\xa8\x80\f\xef\xc0IH\x9e\xb2c\x88I\x8ef\f_\x03\xa4\x11\xbd$\vZ\x89\b\x86\xcf,\xfa\x04\x83#M\xfc\x11\xec\x01Q\xfc\x1e-~\xf5\xe5g\x18%\t\x94\tpZ\b\x86\t2L\x9e\x004;\xefe\xdb\xda\xf3ȉZ;x\xe6?\xe2\xc2\xd91\x19\r\xf1\xf5\xba49\xd2b\xb4\xa3\xdd\b\x1d#\xea\xa6E\xb7٢\xaa*T\xdb\x0e\xdd\xe5%l[\xc3T\x92\xbcH\x11\xe4#\xfc81\x84\xae\x00e+Զ\x82\xb1\x15\xa6~\x98cw}\x14Dfu\bD\xac\xfc\x13N\xbb\x9a\x14\xea\x03%v\xc0\x82\xa0\xd6u#\xe7P\x00E\x8b\xcb\xed\x05\xea\xaa\xc6~\xf0\x12&şM\x90\xc1\xc8\x05\xb1]ψ\xc4l\xc0\x04\xa2\x9fD\xe0\xbfy.\xdb\xcf\r\x00\x00`\xb3\xd9\xfe\xe0{?\xf8k\xff\xdb\x18<\x1e\x1f\uf814f8\xd6{\xd1yG\xd6E+\r[ٙ\xf8\xb7\x98\xa2Ł\n\xcc\x14\x1f\x86i־K\xf1h\xbb\x16P\n\xfd\xa1\aň\xaa\xaa\xc4@F\xf3\xae\xca{\xd8ʢm\x1b8Ѱ\x9e\xfbZ$\x82-m\xde\x16,\xf8\xaa\xaa\xf9\xa6\xc8\xda\xe64\xed\xd3\xe29\xa30\xfb\xd1`3\x96\xcaZ\xbc\xbc\xbc\xe6\x9bN\x18;i\xda\t\x14\x04NW\xe8\x87\x1e7\xf7\xf7\b1\xa0\x12h\x94VM\x8b:\xc3\x1b\xafm\x85\x8bM\x97\x1b\xactV$\a\xaf\xab\x8b\x17\xd8nv\xf2\x9e\xf2\xeb\x1a\x9d\xc3\xcf\u07fd\xc1c*\xfe\xc2Nc\u007fz*\x92\xfd8\v`?\xec\xe1c@Z;ֶ\xc2Ew\xc1Qʹ\xf83\x17\x82NB\u007f\x04\x82\x0e\x0e!8(m9\x00Eϰ07\x86sБR\n\x8d\x8a\xa84P\xd7\x1d\x9b\xfdT5\xacfr\xdf\xd8\xf7hk\x89\x176\x15B\f\x18\xfa#\xfa\xfe\x80\x87\xfd-b\xf4\v\x1b\xd7\x18\"\x82\xe3C\xcb\x1a\x83\xabn\x83˺\x82\xd2\x16\xed\xf5'\x88\xba\xc2\xfd\xe3\x1d\xbc\x1b\x00\xd7\xe72\x9c\x0eث\xcd\x06\x8d\xc8\xe5\xb2-s\x02\x83\x13#;\xef\xf8\xe7br\xf2i\xa9s\xcb'U\x1c\xda\xe5\x06\xea\xdc*`\t'\xa8r\xdf-\xea\n\xa5\xcdҚZ䘌\xcf0\n@\x98W\x01y\xc2\fl:CJ!j0Z \xa4T\xad\x84\xec\xa7\"(\xb2\x12\x86\xb9\x01\xaaX\xed\x04Y\x1b)\x00\x1eZ\x1bxG\xb2\xb3\xb6ŚBP15\xa78\x1acf?\x02(\x16~(\xcd$\xbb\x94j\x99\x1a\xcct\xcf*\x92U\x8c.\xf6\xd51\x1bԜ\x12z\xe6EMnw\x17\x06Z\x94ђ\xf9LX\xaex\"\x92g\x83\xcaFd\xc6\x18t\x9b-le\xa1\x88\xddFۮC{q\x89\xee\xea\x02\xaa\xe2@/\x95폅\x1c\xa25#\x15\xc5\x15\xa0\xadEݵ9\x06\xddOS^\x83\x92\f\t\t\xb1T\xa6\xb0\x97^\xb9\x82\xd2*}\xccT6\xbfG\xb6\xe2\xa0!\xe7&@\x01]\xc7\xf7\xfa\xfd\xf1>\x9f\x11\xd0*#\xb0\xe38\xca\xdaf>\x9f\x89Q\x16\x0f\xa5\xfeI\x88\xf1\xdbg\xf8\xff\xb9\x01\x00\x00|\xff\x87\xbf\xf3\x8f\xae._\x98\xfb\xbb\xf7\xe8{\x96\xe4\xf5\xfd!OzJ+\x98\xcaB\x1b\x9d\x19\xb1%f\xc90m\xc87\xe1\xe8&\x893UY\xc7\u07b4\rle1\xf6\xa3\xd8e\xea\f#\xf2\xb4\xca\x17kױ=\xec8\x1c\xcfJ\a\xcb \x9fs\xe4\x99\xdc\xe1k\x8d\xdaV\xf0\xc1e\xfeBi\x1a\xb4f\xfc\xcf\x03\x1a?\xe6\xd5\xee\nM\xd3,\xb2õV\"\adW\xb1~\x1ap\xf3p\a\x17<jc\x19a8W\xfcW\x13a]U\xb8\xd8v\xb0\vr\x17\xe5\xe7t\xb1\xb9d\xc2_\x8e\x11Up\xc1\xe3\xe7ﾕ\u2bd7Ǣ\"4\xb6\xce\xc5?J\xee\x80\xf7^\xa6W\x86D\xb7\x9b\x9d\xa4\xeeq\xa2\x18\x1f\xcc\x010ժ\xe8\xcdE\xc6\xfbI\x06\x12\x9d\x99\xff3\xfcO\v{S\xab\x81\xc6\x00uݠ\x910!k\xd9\xcb`8\x1c\xa0Ƞ\xaa\x1aتB\x8c\x01\xe3x\xc0\xb1\xdfc_\x14\xff\x94U\xef\x03\xc1;\xde\x01\xd7U\x85˶\xc3e\xc3\x11\xd4\xf5\xc55`[<<\xdc\xc1\x8d=0\xf5\x80\xf00\x92\xbb\xe4\xaem\xb0m\xea\"\xc9oU\x84\xd5\x1a\xbaW\vm\xfar\x11\xb2Z矻\xf8\xa8P\f\xfc\"z`\x9a\xa8s\x96;\x16\xbf<!/\x94\x9c\x1a\x84(\xa8\xb2y\xd4\x1c\x1c\x15B@0Q\n.!\byπ=\rt\xba\xae\x88\xa5\u007fT\x16\xf4\xc4\xfe\x905\x83\x92\xe0.h\x8d\xe0\x81\xa0=\xef\xbf\v&\xbdJ\xac5\xb146\xd6β\\%\reԹQ\x9a\x11\x19Q7\xc8*\"\xa60\xa3r2=S\xf8\v\xbcoq].\xbfo\x19\xed\xadh\xd6\xff\x13L\xa1\x14(\xf6\xec1\xb0\xdf\xc6v\a+\xcdH\xb3٢\xd9m\xa1D\x96Ǎ\xca\xfcy\xc5H\x88\xdea\xea\a\xb8ad\x98\xbe\xaa\nJ\x8f\x866\x15\x94\xf6\x82\"\xb2\x0e\xbfJ\xe7dL\r\x80\x99c\x93#\x15=ߒ\x87\xa4\r;a*0\xbaR5\rơ\xe7hg[a\xb7\xbd\x00n\xee\x19\x89,δ\x10\x02\xc6qZ\xa6\x03F^_D\xe0;\x05\xfc_\xff\x8b\xff\xe9_\xb9\xe7\xb2\xfd\xdc\x00`wq\xd9\xfd\xf0G\xbf\xfb\x1f\x84\xe0pw\xf7\x01\x8a\x00\x1f\xd8\x163i\xe5+a\xb3Vu\x95\xf5\xff%D\x9a4\xff\x90\x89b\x1c\xc6\xccV'\x02\xaaڢi\x1b\xf8\xc9\xf3Z\x00\xc8+\x04cس܋\xb7v\xdb6\xf0Şq={\xe5\xe2\xaf\xd6\xc5\u007f\x9e\xfe\x95R\xa8\x84\xcd삟\xa7\rJ>\xf6\x84\x10\xd6\xc5_bP\x89\xb0\xeb6\xd8m6\xf9\x97+\x01\x88\x03\xf1\xf4\xa4\x84\xa0w\xfbp\xcb\xcf[͑\xbf\x8b\x83h5\x8c()\xfe\xbb\xed\x16f\x05\xfb\xa75Ħۢm7\v\xbf\x80\x10\x02\xbey\xff\x1dg\t\xac\bwZ\x11\xac6\xd8v\x1b\x18\xb1Q>\xf4G\x8c~\xcaD)\xad4v\xdd\x16u\xd5\x14S0\xeb\xf4\x95\xd6 \xa5\xcf\x13\xff<+2xD6\xf9\x05\xa5\x82\x92\x8c\x84\x189\x01\x1aœz\xd3\xed`\xacEU1\xd9s\x1c\x8epΡ\xb6\x1dl݀b\xc40\xf68\x1c\x0f\xd8\xefo\x11\x92y\x91\x14\xff\x10\tα\xce9\x99\xf7l\xab\nZ\x03\xf5\xf6\x1a\xa6\xbd\xc0\xbe?`\x1a\x0e\xc0\xd4Ϛ\u007fic\x1akqն\xb3\xdc\xf3L9\xd6\xc5\xe3\x01\xa7\xbb\xe9\xe5O}L\x01\xa0pꃻN\xc9)\fkR\xe3\x96Ȇ\xc9\xd2ve\f\xc5\xc3r\xe4\xf7]\x8a=3\xfc\xb1\b\xd1\xe2\xb8WA\xb8H\xe5-\x03[<+誖)Tg2X\xfa\x13zn0\x92\xbd\xac\x04\xdd\xe7{\x9f(\n\xb4\xafs&\xbd\xd2*\xdfG\x10\x04O)%\xdazŉu\x82\x00\x10\xf8\xef簚\x99\xbb\xf3\v\a\xce\xc2\x1d\xb8\x10\xd2\xceo9\xcd\xcd\xd2\u0083\x84\b\x8a\x84\x13\x01\xb5X\a@\x10\xca\xe8GF\xcf \t\x9aZ\xc3H1O\xeb\xaeĢ\xa7\xc0\xd0\xffx<b\xdc\x1fx\xc2\x0f~\x8e\x86\x16\x9f\v-r\xcb\xe4{\x91V\a12K\x9fB\xc8\x05\x9fB\xccN\xa5\xb9\xf1<\x97\xa5 \xebW\xb6E\xb70\xe2\x93a\x8c\x91\x14R5G\x14\xcbzs\x18ƼN\xcd\xf0?\x93\xb3I\x01\xff\x12\xc0\u007f\xf7\\\xb2\x9f\x1b\x00\x00\xc0\x0f~\xf0;\u007fo\xb7\xbb\xfc\xe4\xf6\xe6\x1d\x86\x9e\xa3(\xfbã\x90px\xffi\xac\x85\xad,ꪞ\xb9\xd1R\x9c\xa2\xf8]\xa7\xc2\xe0\x9c\xc3(\xbb\xfb\x149ڶ-b$\fØY\xeai'\xaf\xb4\x82\x1bxJ\xef\xda\x16Z\x1b\xf4\xc7\xf3\xc6AI\xb7\xbd\xc0\\\xc1\x93G)G4ڰ7\xb7L\x16sq\x8e\xf9\xf7\x94'\x86.r\t*kq}q\x95c\x83\x95\x84\xfddm\xb4\xd2p\xc1\xe3\xee\xf1\x1e\x93\x10l\xcc\xe2 -f\xff%\xe5\x9fw\xfe\xdbӝ\u007fj\x96\xea\xbaA\xd3t\x8b\xe2\x1fcě\x0foq\xfbp\u007frbj͏\xbdm7\xa8\x84d7\f\x03\x06\xd7/tߛ\xa6C\xd7t\xc5r; F\a\xab\x14H\xdb'\xd6,\x11\xdeM\xe2r\xc8͔\xa2y\xf7ϊ\x00\x9b\xcd_\xacQ\xb0\x1ah\xbb\r\x8c\xadX\xf2g\f\x9c\x1f1\f=\x8c\xae\xd14-\"EL〾\xdf\xe3\xe1\xe1\x06\x14g;b-\xde\xf6a\x12\xf9\x99Q\xb8h:l\xaa\n\xd6\x18ԗ/a\xda\x1d\xfaq@\u007f|\x04\\\x0f\x8a\x1e\x89\x83\xceq\xcf\n\x97m\x9b\xbd\xf4c\x8c\xa0\xfc\x19͎~\x19\xb2Wi\xfb_\x06C\x9d\x12\x01\xcf\r\xf4\xcbP\x9c\x82\x99\xbe\"\x06\xd2ɢ\x00\v\x9b_\":\xefb\x17\xa9\x90\x89\xd1l\x1f\x1cg\xf9Xr\xc94\xc6@\x91\x82!\xf6]\xe0\x80 \x86\xf4\xad5\xb9\xf0&\xcb\xe1\xe4\x93@\v\x12\xa7Ο\xa7\x92Q\x9a\x88\xefqJ\xc1CJ\xf1s\xd0\tE\x11G\x9d\"\xb6z\x11\xeb\x1b\xd9\xc0'\x19\ae.\xa5\x96u\xc1\xb97v\xb1\x1e)\xf9(\xd2\xff\xc6e\xc0U\x81\x9ag\xf3\x1bMznj\xa2\x02R\x00\x10\x11B\xe0(ܪ\xaaf\xcbc1B\x8a1\x82\x86\x91\xdf\xdb\xe0\xe1\xa6\t\xd30\"L\x93\x10\x00UZ\xe6\xcfh\x9f$\x86\xf2\xd0\xc2ꃤ\x96\n\xd2D)\xc5\x1e\vn\x98`\xc5\xdf$\xeb&\x9f\xcaP\xa0\b?\x8c\x8cp\x04'(\xa48\xb0*\rk\xed\x025\x8a2\xf9\x0f\xe30\xab\"\x88\x15\x15\xbc^\xc1\xa8\x80\u007f\xe2\x88n\x9f\xe1\xff\xe7\x06\x00m\xdb\xe1\x8b/\xbf\xff\xfb!\x06\xec\x1f\xee\xf2\x812\fG\xde\xe9Ef\xe4[kQ\xd7UQd\xe7& \xf8Y\xe9\x1bbD?\x8c\x1cL\"\xfb\xb6\xa6m`\xac\xc6\xf10\xe4\xc0\x92\xb4\x8f5\x95\xcdy\xd5uU\xb17\x80\x0fY:X\x1e\x9f\x91Λ\x88\x90\x00\xa5\xa5쯪*h\xa51\xfa1\xefLg?\xec\xb8\xf01W\xc5\xf4\xaf\x94\u008b\xdd%[\xfc\"M7*뀓\x9f\xfd\xdd\xe3\x03\x8e\xe3 \xceq:'\xb2-6\xc3'q\xbe\x06\x17\xbbM\xb6\xe3]\x17\u007fc,\x9a\xba[\xec\\#q\xf1\u007f\u007fws\x12\x1fl4\xa7\xd4uM\x8b\xa6an\x85sN\\\xfe\xe6\xca\xd3\xd656\xcd&Ø@\xc44\r\xa8\x15@u\x87\xd3\b%)\xf2ޱ\xf4\xafp\x9c\xcb\xc4\xc9\xc2K 5P\x95f\xb9d]\xb7\xa8\xaa\x1aF\x1b\xd9\xfb\x0f\xd0ʢm:P\xe4\x95\xc20\xecq{\xf7\x0eD\x12DT\xd4C6\x91\xe2\x82\xf6b\xdbaW7\xb0Z\xa3\xb9z\r\xdd\xee0\x8c\x03\xfb\xfc\x8fG\x9e\xfc\x89\xe6\xb0&\x00ۺFW²\x85\x03\xa3*'uI\xf8;_\xdf\xd7t\xcd\xf5\xc1\xfcԩ]\xec\xff\xd5\x13#m\xc1Q\xc8\xe6/\x94\xa6ָ\x90Tƕkd\x82\xeaIϫ\x80d\x18\x94Bg\xf2t/\xa8\x92\xf7.;\nrcY\x14\xe7\x95E/\x89=^\x9a~gу\xfc\x1c\x93>\xe4}\xe4J\xacrcE\x05\x87!\xa5\xce1\x93_\t\xf12\xc1\xf2sj\x9f:\x01\xfc\xa9\xc8>(\xe8|\xf3=\x13\x97v\xe2\xaa0\xd0I\xef#\ag\xb1y\x15{$P\xce\t`\x82\xf2\x80\xe0=꺑\x1a\xc9\x1d\xc5\xf8\xb8\x87\x1bF6,J\xe6Cq\xb6\xb6N\xf7A\xb2\xf6\xceV\xc4\x1aE\xf8\x90$ez\xc7V\xcd! ژ\xa7t\xdf\x0f\xa0\xcb\xed/\x84?\x94\xd6\x18\x1f\x8f\xf0Ä \xcf#\x16d`\x80\x95 \xc1\aX\xcbg\xb5\x9b<\xfa\xbe\xe73\xae\x80\xfec\xc8)\xaa7F\xeb\xff\xf7\u007f\xfe\xdf\xff\xe1\xb3\xf3\xdfs\x03\x00|\xf6\xf9W\u007f\xfb\xf2\xf2\xfao\xf5\xc7=\x86\x81w\xff\xc3xd\xdbU\x99\x9cmmQյt\x9b\xc5>Nq\xb2X\xbeM#a\x9a\x1cF\x99\xf2#\x11\xea\x9a\x1b\x87itY\x8e\x92\n\xb26|(\r\x83\xe3Пm\v#\xb6\xc0spNA\xf09c\xfe\x93\x18\xd8e\x82\x9e\x91]<\xa7\x10\xfa\xbc\x97\x8frPҊ?P\xda\n_lw\xd8mw\xf95i\xc1[\x19\x06\xe7\xc7\xdb\x1f\x0ex\xd8?\xe6\xf7G\x1b}:\xbc\xadl~\xadL\xfe\x95Y\x16\xffDZ2Ƣm6\xd0\xdaʴ\xc0\x93\xff\xb7\x1f\xde\xe2\xdd\xdd\xcdI\x81\xb6\x86х\xa6n\xd0I\xba_\f\x01\xc7\xe1(\xbby\x1e.jc\xb1k\xb7s\x01'\xc2\xe8z L\xb0\xed\x16\xf1\x04\xfa\x97\xc6.\x068?!\x10\xe7\x04d\x9b_\xf9J\t\x82\xe9譔Bc-ڶ\x83\xad\x989\x1d#O\xfa\n\x06\xb5\x04&\xf90\xe18\xecqs\xf7\x0e!\xb0Sڬ P\xf0\x9e\xe0$1\U000a2b71\xab[h\x10l\xbb\x85j\xb6\x18݄\xc3\xfe\x0ea<\x80\x84\xdbQr,\xda\xcab״\xb2%\x99\xaf\xb5<\xdd\xe7P\xa0\x95\xd4\x11(\xa6W\x9a\xb9-\xeb:\xbeb\xff\xff\"\x1b\xa0\xe54\xac\xce~\x87VT$,*(\xb3\xfc\xde(po\x89\xcc(R\v\x19\x19s\x01\xbc\x90\xd6\x04\xc1/Ț!x@U\x12\xa0\x15\xb3\x1a&\xc4(r9\x02H\x1a1\xad榃\x96,\xfb(\xdc\x11\xa5\xa5Y!\xcd\xd7\x10\xbb3\x01\xf0\xe2r7\xbb'p\xa1\xd4\xe7\x82:\x96ş\xa8\x88\xb4]\x85\xfa\x10\xad\xde\xf7Ӷ*\x12\xe5ƌ\x18[\a\x8a\xc4\xc8\xf4S\xce\xf5\x98&V )\x00\xe30 \x86\x00k-t\xb4\x80\x14\xeela\x9c\x1a\x1e\x02\xc6\xfe\x88\x87\xdb\x0f\x18\xfb\xe3<]\x03h\xba\x0e\xbb\xabkTuS\xac\xf4)s\x91R\xf0\x14\x94\x82\x1b'\xb8~D\xb5i\xe7\xd7Cs#\x93Va\xd3\xfe\x88\xfe\xe6\x01!\x04\xe6\xe1\x10\xb1\x86\xbf\xb8\a\x1f\x8f\x87,C\xe6\xe1k\x98\x89\u007f\t\x99H\xa6J\x00\xa6\xe0\xff\xb4\x8f\xfa\xbf\u007f.\xd7\xcf\r\x00\x94\xd6\xf8\xec\xf3\xaf~O\x1b#v\xab!\x87Z\xa4\xfd^UW\xacɯK\x92X!\x9f\v\x94aLft\x0f9\xb4\xc7\x18\x8d\xa6k\xf2N\xaaܽ&\x1b\xe1\x18b\xd6\xfd\xb7m\x03\xef\x03&\x91\x0e\x96\xbe\xe0܅\x9f&\x89\xe5][\x01\xfd\x1ba\xd8:?\x15\xc5\u007f֒c1\xb9\xaa\x02\xfa\xafp}q\x99\xa7y%\xeej!xa\xcc+\xec\x8fG|\xb8\xbf\xcd\a\xa7\xc9\a\x1b\x9d\x9d\x1f\xd3\xeb\xbc\xdct\xa8\x04\x86]\xee\xfc9D\xa8mZ!\xcb\xc5l.\xf4\xed\xfb\xefps\u007f;\x9b\xa9\xc8\xf4d\x8d\xe6\x89\xdbZlڭ<߈\xe3x\xc4\x14\xa6<\xbd\x19\xa5\xb0\xeb6l\xbd+\x9f\x9e\xf3\x03\xc8O\xd8\xd6\r\xc8VK㣙\xaa\x05\xef\x9dp'\x8c\xe8\xf7\x05\xeaՊ!\xcdHP6\xc9\xfe\x80\xb62\xe8\xda\rl\xd5\xc0\xd6\r@\x04\xef\x1c4,3\x99E\x9b>\f\a\xdc\u07be\x83\x9fFy\xbd\x92\x84\vf\xa7;\xcfit\x951\xd8\xd6\r\xb4\"he`\xba\vΔ8>\xc2\x0f\a\x90\x9f2\x84\x9d\xde\xeb\xd6Z\\4\r*ab+\xb5\x88\xeeY\xfa\xc6\x17Ž\xa0\xdd-\xfeM?\xc1\x06@)\xf1<_\xd3gXy9\xd6>\x017,\x8dy\x14-eb1i\xf2\x176\xbe\x91\xf7ۚ\xa7P&~EA\xd8$x)O\xc2\x1cɬc\x04Ic\x94P\x02\x15\xc1h\x02\x04\"\x8fɫ@IC\xa4\x16\x17\a\u05fc\x80\xe8g\x1eC\x9c\x06\xfe>\x91\U000e6d5eZ\xcb\xfd\xd2\xfaE!G\xf3.\xd42\x85\tN2\xf2\x01>\"\x01NxV&\xf6\x9e1\x18PrF%\xf3\x1f\xe2b\nix\xdc\xe4\x10e\xd8Qh\xd9\xf4G\xe9\\\xf8\x01\xc0M#\xbc\x9b\xd0\x1f\x0e\xb8}\xf7\x06\x87\xfb{\xb1\xfd\x9d\x1b7@\xa1\xddl\xf0\xea\xf3\xafp\xfd\xfa\x93\x99s\xa4\xb0z\x0f8Ű\xbf}`\xf4\xb0\xa9\v\xdbI~\u007f\xa2\x0f\x18\x1e\x0f\x18\xef\x0f\xa0\x180\x8d\x87\xec\xaa\xcarf\xe6%\x8c〛\x87\a^\x99\x85\x88q\x980\x0e\xc3\x1c\x92\x968X\xce%\x12i\xbc\x99\xc6?\xf9\x83\xbb\xf7\xd33\xfc\xff\xdc\x00\xe0ŋW\x9f\u007f\xf2\xe9\x17\u007fw\x1a\a\xec\xf7\x8f\x12\x95\xeb\xe0\xbc\x03\x81\x84\xc4U\xe5\xe46\xc8N0k\x88\xc5\x19,ݐ\xd3\xe40\fC\xbea\x9b\xb6\x81\xd6\x06\xc7\xc31\xff]\nTQ\x02\x9b\xbb\x89\x89jm\xd7B\x1b\xfe\xde4\xfdS\xb1\xf8c\xb7\xaes\x87\xed\xf2\xb0\xcc\x06=\x12\x94\xa1r\xb7\x1c\x85\xf4\xb7\x82\xfe\x8b_uuq\x89F\x9c\xfe\xb44HQt\xc9\n*\xef\xfd\x9d\xf7\xd9]\xf0\xf4\xc6Q\x05\xc2\xc9\xd0\xf9E\n\xf69\xd9\xf9\xf3>\xaf\xae[X\xdb\xe6\xe2\x1fB\xc07\xef\xde\xe0\xf6\xf1~~\x1fd`J\xc5_\x19\x8dM\xbb\xe5ɐ\x80a\x1c0L\xe3,\x94R\xc0\xaeݠ\xad\xda\xfc\xb1\xf9\xe8\xe0܀\x9d\xb5\xd0U\x83@k\x82\xa5@\xff!`t\xac\xfb\xaf늋Hʚ\x87\xcak\x9c\xf4X\xad\xd1ش-j\xb9V\x94\x02\x82\xf3аP\x86\x8bQ\b\x01\xc3x\xc4\xcd\xdd;L\xd3\x00kش$\x8aa\v)\xc09N/\x83\xe2\xb5Ec\xb9q\xb0\x17/\x81\x8a\xd9\xcfn8\xf2\xe4\x9f\f\x88\xe4\xb9w\x95\xc5eӠ\xb6v\xb1\xe3\xcf\xc7\xee\x82O23ɩ\xf0\x94?)1j\xf9\xbd\xe5^\x9f\xf2ĺ\xcctPe\xf1_#\b\xea쒻8\x80)\xef\xee\xcb@\xa6\xc4\xd27\xab\xd5\x00$\x05PAg\xb7?\x8e\xa4Հ6<\a\xeb\xc2\xd2װ)\x10+bf\x86;嵃\x02-\xc5v\xd2\x1c˵\x9a'T\x8d4`\xaf\xed\xb6\x95\x9e\x15\x03Z/\xef\x0f\x95\x9d\x96T\xf6,H\n\xa1\xfc~\x12-<\x96\xe8\xcc{UJj\xf2Zp\x81d\x15Ŷ4o\x02\x93\x9b\xd3#\xb0\xecpV\b\x95\xea\b\x02a8\x1eqx\xb8\xc5\xe1\xe1\x1e\x93\x98TM\xc30#0\x8a\x1b\xab(\r\xf1t\u007f\x8f\xe3~\x8f\xfd\xfd-^\u007f\xf1\x15\xba݅\xbc\u007f\x01!8\xf1\x0faIj\x18'\xec\xdfޠڶ\xb0M-\r\x03!L\x1e\xee0 L\xcc%\x18\xc7#\xdc\xc4\xf5ډ\xa6?xn\x04\xf6\xc7#\xee\xf6G\x84\xc8\n*\xf2!\x13\x90\xd3\x1a\xa4\xd8\xfd\xa3\xf7\xce\xff\xf9\xf1\xf1\xcds\xa9~n\x00\xa0\x00|\xff\xfb\u007f\xed?캮~\xff\xee\r\xdc4@k\x83q\x1c\x10)\xe4\xb8Ѫ\x99\xa1\xffr\x90I{\xf44\x95\x87\xc0\xf0S\x90\x8b\xadnj\xd4u\xc5lTA\x04\x14f\u0378\x16\u05fb\x10\x18\xdel\x9a\x9a\t2\x85mp\n\xafH\x87\xc2\xfa\xecD\xb9N\x043e\xb5\x90\x8aB\xf4s\xf1OY\xe48\xc3\xfa\x97\x9b~\xdbu\xb8\xd8l\x8b\xa8N\x86풿\u007f\xa4\x88\xfb\xfd\x03F7\xe5\xf0\x15\xa5\xf5\x13\x90/2Ig\xb7٠\xaa\xca⟬]\x95Hyj\xd4U[\xb0\xfd#\xbe~\xf7\x1dn\x1e\xef\xf9\xc0-\xf8b\xd6\xea|\x88n\x9b\rj!\xfd\x8d~B?\x0e\v\x88\xb4\xab\x1a&\xfd\xc9k\xf4\x91=\x01.\x8c\x86\xa9\x1a\xb0H\xec<\xf3jr#\x82\xf7졠\xab\x8c\x06\xb1\xf7?\x13\xa5\x8c\x10\a\xad\x06\xba\xa6\x86\xd5\x06U\xdd@\x1b#{_\x96/\x914b\xc3x\xc4\xdd\xdd{\x8c\xd3 ^\nJ\xfcxd\x97\xe9\t\x93\v\xac\x18\xd1Z\x18\xff\x06vw\r\xdb]\xa0\x1f{\f\xc7\a\xf8\xa9\aB\xc8Ň\x00\xd4\xc6\xe0\xb2a\x9e\x80*\\\xfe\xe6\xe1ui&\xa3Ύ\xf5˨\xe6u&=\xd1)\xd1\xff\x1c\xa2\x9d\xf7\xe1\xc5c)\xb0\xbd\xb2:\xa7\x12Pe\x82\xde,\xed\xe3kl\xfe\x9e\xc4\"/\x9fD\x8c\x11\xca\xe8\xdc8&\x17N\xaf\x1cL=\xe7\x02\xa84%\xc7\b\xad\xf9\xef\xb8\xff\x8c\xd9ן\xa8\xd4ϫ\xfc:\xb2Gb\xc9vO\xafS)\xb1\x06\xd7\x1c6\xa4\xd4b\xf5\x95\x1d\x16\xb3\xd3R\x19\x14D\x85\xe4\x12\x05C\u007f\xfd\xd1\xd0i\xbfD+)\xb0D6/Wok2\xae\x12Bp\x80\x86FP!\xbf\xe7F\xf3\xf3O$\x85q\xe8q\xfb\xfe-\x1e\xefn\xe0e\xfa\x0f!\xf0\n\x13\x92\x82\xaa\x90\x8b\xffl\"\xc6N\xa67o\xbfC\u007f8\xe0\xf2\xe5+\xbc\xf8\xe4S\xb4\x9b-{{L#\x8c\t\xa2\x14\xe0\xb3#\xdc\xef\v\x8e\x03\x84+!\xf7\xac\x1b1\x8el\xd47\r\x03\xdc4\xf2`\x13<\x82\xf7x{s\x8b\xc3\xc0\x8e\x99\xc1\x87yMS\x04\xa4\xa5\xe2ODxp\xd3x?\x8d=\xfe\xe7\xc5W<\u007f\xfd&4\x00\xbb˫\xfa\x8b\xaf\xbe\xff\x8f\xbcsx|\xb8͓\xc78\r\xe2\xf3oa\xedy\xc3\x1f\x8a\x94/\xact\xd1M\xcea\x1aF6\xac\xb0\xb3\x91OV\x03\xac`&#\aWj\x16\x8c18\x1e\xfb\xdc@\xa4 \x8f\xb5\xa1\xceګ\xbf4\xfbL\xde\xfc9\xab^v\x96\x85\xfd\xe5\xe9\xc1,\xac\xff\x17\x17\xd7\xc2\xfa\x9f\x0f\f\x9f\xa0\u007f(\xec\x8f\a\x1c\xfa~\x99/\xb0Z\x89\xa4ח\x9c\rw\x9b\x16MQ\xfc\xe7Xq>`\x8d6hı/\xed\xf0\xbey\xff\x067\x8fw\x85\x1c\x8c\xa4\xf8\x9b\xec\xc9\xd0\xd5\x1d\x9a\xaa\x01@\xf0>\xe08\x1c\xb2ͯ\x82Be*l\xd3\xde_\x0e\xd9\xe3x@\xab\b\x8d\xad\x10u\xf5DK\xc8\xd0\xe2\xe4&hcaL-\rTȰh\xf0^\x9a\x01~~\x9bʢ2\x1aUU\xa3\xaaj\xc4\x18\x00\xd20Z\xa2Q\x01\xf4\xfd\x1e\xb7\xf7\xef1\x8c\xc7\xdc\x04&\xe8_\xcb\xe4;9/\x88\r\xa16\x16\x9bn\x83z\xf7\x02\xa6\xddb\x18\x8f\xb8\xbf\xff\x00\xd7\x1f`(\xc0\x96!?J\xe1\xaam8wae\x02\x95\x83c2\x1aL\xb9\x90\xa9s\xfȁ\xed\xfb\xe9\xfc\xc6?sP\xa4\x18\xaa\xd5/:YC,\xf6\x04\x05\to\xadv!\x9a\xaf/J\xee\xee\x04\xbd2\x18\x8a\x92m\xc0\xc5\xd0@\xc5\x00\x15\xc0\xb9\xef\xd6΄\xc0\xe4\xd3!\xc8K*\xbd\x91\xa2\xb0\xe5e\x0fm\xb8\xf1\xc8\xcfW\xa9%F\xb2\xe8\x82س\x01\xa9Y\xd0愨\x9aapZ\xdf\xcbTH\xf8\x9e\xc8Hȟan\x13\xe6\xc8[,}6N\\\x03蔋C\xe4r\x1c\xaf\x8e\xb3\xe2C\x1b^Uh\xad0\x8e=>\xbc}\x83\xc7\xfb[\f\xfd\x81?\x03!6\xdf>\xeeq\xec{x\x1f0:\x8f\x10\x02.w[\xbc\xbe\xbeBSK(\x9a\x02B$\x1c\xf6{\f\xc7#\xf6\xf7\xf7x\xfd\xf9\x97\xd8]_\xa1\xaa\x1b&\x06&\x06\u007fUØ\x8a\xe1}\x8a9\xf10\x92\xfc)H\xdb\xd8\x1fq\xdc\xef3£\x95\xc2\xdd\xfd\x03~\xf2ͷscX4\x90);!\x93\xff\x00\xb8\x18\xe9\xe7\xc3\xe1@\xc0\x80ef\xd33\xfc\xff\xdb\xd6\x00(\xa5\xf0\xbd\xef\xff\xb5\xff\xddv{\xf1\xf9\xc3\xdd-ơ\x876\x1a\xd34\xc2\xf91\x1ffuS/\x13\xa6\xe4\xc6JE:\xc1\xff!\x12\xfac\xcf\xd0x\xde\xfb\x13\x86~8y\xdc\x18#le\xa1\xb5\xc24\xf1\xe1\xd4v\rB\x88\xd9\xf67\n#7M5\xb4^\xc8. \xc5T\xfcmNr+m}\xbd\x0f'\xbe\xff\n\xcbBq\xb9\xbdD۴\xf90\xd6i\xcf-<\x80\xc1\x8dx<<\xce\x00i\x11\xc1:W\x97\xf4\xf7Lx\xdat-\a\aaYD\xd2\xf7(\xa5\xd0\xd4\x1d\x1f\x9a\x82v|\xfb\xfe\xed\xaa\xf8\v\x87\xc0\x9a\xdc\f55\xcb\xf9\x94@\x98\xc7\xf1\x88\xc0ў9Tg\xdbt,\t\x94\xc21\xb8\x1e5\"\xb6U\r2\xf5\x19\xd6\xffL\xa2\xea\xc7\x1e\x04!\xfei\x95#N\xb5\xb1\x00E\x8e7\x16\xb8\xb43\x1a\x8dQ\xac\xfbo[\xbe>\xa2\x86\xd5U\xbeF\xfa\xfe\xc0\xc5\u007f\xea%ߦ\x9c\xfe\xf9\xf5M\x8e-m\x01\xdeu\xbfx\xf9\t6/?\x852\x16\xd34\xe2\xee\xee=\xfa\xe3\x03T\xa4\x93\xfd\xfe\xb6\xaexU@\xac\x8a(\x8b¹\vF\xa7DK\xf1\x89W\xab\"\xa2J\xa4\x8bf \x81Vl\xfcyW\r\xc0,çP\x90\xb9N\xe8\u007f\xab\xdcz\xa5\xd6\x1c\x81\xa4\xae\x99\xf5\xff(\xe1\xf1UVAN\x12\x8c\x00\xe9\x88H\x1a*\xb2\xfcO\x89\xbd/7\r|M\x1b\x89\t.eo\xa9iU\x9a\x8d\x80Hl\x88\xb3caA\xb2T\xa5\x92Q\xa7\xa0-\xe1\"`\xe9b7\x9b۬\x9a'*\xe5}\xeb\xb6J-Ζ\xfc\xbf\x91M\x8727G\x9fk\xc5\xe6_\xa7R(F\x02\xf3b\xba\xbe\xb2Wd\x0e\xb82F\xc3\xca5u\xd8?\xe2\xb0\u007f\xc4$!fD\x84\xf77\xb7\xf8\xfa\xbbw\xf8\xee\xe6\x0e\xe38\tÞ=\x1b\x9a\xbaƫ\xabK|\xf9\xe9'\xb8\xbeܡm\x1aTb\x8c\x14\x89px|\x80w\x0e\x9b;N\f\xdc]^\xc1\xb6\r\x94\x1033\xaa\x96\xe2\xae\v\x04@k\x85\xfd\xc3\x03\x8e\x8f\x0f Q\x13h\xa5\xd0\x0f\x03\xfe\xe0\x8f\xfe\b\x1f\x1e\x1ePi\x03-k\xb4r\xfaWi\xfa\x97U\xd1\u07bbp;\x8d\x01\xc07\xeb\x06\xe0\xf9뷨\x01PJa\xbb\xdb\xe1{\xdf\xff\xd1\xef\xc7\x18q\u007f\xff\x9e\xf7\x8b\xa4\x19n\x12C\x8c$\xfd[\xbb\xed\x84\xe0\xe7\x0eS\xaeW79\x8c\x03#\am\xd7Bk\x85\xfe0\xf0\x9e[l4u\xf1\xf8\x95\x90\xbfb\x88h\xbb\x06\xc6\x1a\x1c\xf7\xbdL\xea1w\xb3D\xb3\xd1\xc8\x1aoU\x8b\xb8_\t,A\xb1\xff\x028\xa5/\xc4\xf3\x8d\x83\x1c\x9e]\xdb\xe1r\xbb\x9b\xa74a@'\xe8߇\x80\xfb\xc7\a\xf80\a\x12\x19\xbd\xf4\x14\x87R0y\xef\vl\xba\x06m]/\n\u007fB\r\xd2\xe4\xd9T\x1b\xb1Y%\x99\xfc\xbf\xc3\xed\xe3\xdd\xecN\x97\x8a\xbf\x99\xedT\xeb\xbaŶ\xd9\by\x8a\xd0O=F7\x89\xac\xd20\x91\xb2jr\xfc/\xc9γ\x8a\x01\xad5\x80\xa9\x80s\x9a\u007f9\x90\xdd4!D\x0fk\x1b\x8e\xcb%\xc8\x01\xc9\xd7A\x10\x8dte+\x18\x05\xb4Ve;Um\f\x17\u007f\xcb\xe1%\xa4\b\xc3\xd8\xe3\xf6\xe1=&7\x00\x91ĎW\xe5\xebƈ\xb7\xc20\x8c\xf0\x14\xd0\xd6-\xbe\xfa\xe2\xfbx\xf9\xe25\b\xccGy\xbc\u007f\x8f\xe3\xfe\x1eD\x11\xad\xbc\x17\xe9s\xb0Zc#r?-\xc5\xe8I\x97\xbe\x84\xdah\x9d'\xb0\x05d]\x0e\xd6\xf2{\xa8\xc8\t \xf0d\xa7\xf5\x99\xac\x84\xb2\x95Pg\xd6A\xeb\vO\xe1\xe9=B\x9a\xacˈ\xe04\xed\x87\x00-\xf7\u0382# \xfa\xfb\x04\x8b+\x02BT0!@+3'\xc1\x81C\x9d\x92#f\"\xf9\xa7&\x80\x89\u007fs\xb3a\x8c*\xea\x91ʈ@z\xb9Z+\xb1\x02N;}\xb6\x00^\xc0\xfc\xab\xa1<\x9e\xcaep\xfeM\xa1l\xd9\xcd\xe8\x06\x15\x8d\xeb\xcc\xf6纗\xa4\x97E\x06C\"\x06\xa7\xff\x9e\x92@\x931\x94\xd2Lf\x8c\x1e\xd1\x1b\x90X\x1e\x0f}\x8fq\x1c1\x8e\x0e\xc3\xe4qw\u007f\x8f\x9f}\xfd\x06\xef\xef\xee1M\x93\xd8\x1f\xcf\xd3\xc8\xe4\x1c\xbe~\xfb\x0e\xb7\x8f{\xfc\xf8{_\xe2\xe5\xd5%./v\xa8\xb3\xa1\x10\xc1M#\xf6\xf7\x01c\u007f\xc4\xe3\xc3\x1d.\xae_\xe0\xe2\xea\x05\xaa\xbaΖ\x85ڔk\fFpn\u07fd\xc5\xc3\xdd]V5)\xad\xe1b\xc0\x1f\xfe\xd1\x1f\xe1'?\xff9\xacXw\x1b\xe1#%7\xd6\xf4\xc1&\xe9_$\xc2\xd7\xc3!F\"\x0f\xe0\xcf\xdf\xdd\x1e\xe9y\xfa\xff-l\x00҇\xfd\xf9\x17\xdf\xff[\x97\x97\xd7\u007f{\xffx\x8f\xfe\xb8\x87\x91`\x9bɍPZ\xc3\x1aS@\xffE¼hNU\xd2\x19\t\a\xa0\xef{\xc4\x18Ѷ\r\xac\xb1\x18z\x91\xa2\x94\x13\x99x\x90W\x95\x85\xb1\x1a\xd3\xc4Sk\xd36\b\xc2\xfc\x9f\xa3e\x95\x1c\x82\xb18=\x96;QU\x8c\xff\f\xfdC\x18\xcf3\xc9\xce\x17\xfb\xaf%\xf4\xaf\xb2\x17\xf8\xf5\xeeR$V\xe2\r\x0f\x05\x1f}\x96!=\x1e\xf7\x18\xc4\xd28\x15\xffń\x9e\xa6'\xf9\xbb\xaei\xd05\xcd\xe2,S@v\\\x03\bM\xc5R9%\xfb\xfbo\xde}\x87\xfb\xc3c\xfe\xbdl\xbd\xbcl4\xaa\xaaf9_\"(M\x03\xfa\xb1\aǳZ\xd4\x15\xbf\xf7UU\xe7\xcf+\xc6\x00\x13=j\r\xc0X\xc0\xd4O\xe6\xb1S\f\x98\xdc\b\xa3+X\xc39\x01^\x18\xe5ZP\n\x9f\x90\x06\xa5\xd1Z\xcdM@ӡ\xaa[(\x12\xb5\x80\x10\xb3\xa6i\u00ad\x10\xfeا\\\tD\xaa\xb2\x99K\xa4\b\xe7\x02\x9a\xa6ç\x97Wx\xf9\xf25\xb6\x1d\xdb\x1f\xfbi\xc0\xf1\xe1\x06\x8f\x8f\xb7\b1\xa06z\x0e\ue46f\xae\xb2\xb0\x19\x11\xd0ON\xff\xc9\xec\xc7Ȕ\x9bR\xf5\x16Α\x8b\xff)$h\xc5u\x16\xc5fu\x8e\x97-\x91f\xb5\x9c\xceO\x8a\xbd:3\xe1\xce\xff~\x1aw-\xe8W\x82ԋ\xd8\xdb\xe4\x1bP6C\xfc\xbd\xa6\x88\x0ff\xb4\x86\xdd\xfe([\xe1\x86\x10\x8a\xe8\xed\x19\x06\xcfMB\x91\x91\x81Ԁ\xa8\xf5\xf2BA\x1b1\xbaQ\xea\f\xf9\x9e\u03a2\xf13\xd2rn\xa5\x97ΙX\xb0\xebg]\xfd\xf2]T\x85lnn\x80PDa\xcf\xe4`\x05\xa2\x00E2\xfd\xab\xd9z9(\x91\xe5\xf9){\xees.ŀq\x1a1\x8c\x13\\\xe0s\xed\xc5\xe5\x05\x1e\x0eG\x8cΉ\xfadv\x1c\xb5\xd6`\x98&\xfc\xf1_\xfc\x1c?\xf4\x9f\xc3\x18\x03{a\n/\x86\b\x15\t\xe30`\x9a&\x1c\x1e\x1epӼA\xbb١\xed8(K\x15\x92B7\x8e\xd8?\xdcc8\x1e\xa0\xb5FU\xb7\xb0\xd6\"\xb8\t\u007f\xf1\xed\xb7\xf8\xff\xfc\xf1\x1f3\u007f\xc8\x18hp\x98c6y\x12t\xb3\\{\x8e1\xe0\xbb\xe1\xa8\x008\x00_?\x97\xea\xdf\xe2\x06\xa0ij|\xf9\xe5\x0f~\x1f\x8acY\x93Ƙ\xd3\xff\x98\r[5\xb5\xec3\v\x16.!\x17\x80r\v\xe7\x9c\xc78\x8c\xa8\x9b\x1aV\"~\xa7iZ\x91\x81f\a\xaf\xaa\xae\xb8\x1f\x0f\x1e]\xdb\xc1\x18\xd6\xfd;\xe7V\a\x03\x9d\xf7\x06\x97\x1d^*\x95F\x9bl*\xa4\xb4\xce]\xaf+H1\xe5W9\xc0m\xdb\xe4\x8eG\xb9\x88\x04\x8aL\x16R\n\xc3\xd0c\u007f<,\xf6\xb9\xa6\xdcq*\xe4bB\x04tm\x83M\u05ee\x90\n#\x8fɝ\xb8\xb5\x8d\xd8\xe3\x02\x87\xbe\xc77\xef\xdf\xe08\xf6'\xb0\xbf\x95}\"\x81x\xa7\xdf\xed\xe4{\x14F7\xe28\x1eA Xm\xd1T<\xb17\xb6\xca\a,\x11A\x93\x87e\xd16`\x9b\x13\xf8\xb9\x1c\xd2&\xc7~\xff\x89X\x18\x02\xef\"\x13\x9a\x10\xc4S\xc1\x18\x83J+\xd4\nh\xaa\x1am\xb7e\x99\x9e\xe6\xc7\x0e\xc4\f\xe5\xfb\xfbw\x18\xc6^\x9a\xba9l'\xca\xf2\xdfh\x8bn\xb3\xc3\xc5\xee\x1a\xdb\xcdEvd\v!`8\xdc\xc3\x1d\xefq8\x1e09\x8e\xbb\xb5J/RԬ\xd6\f\xfd\xcbg\xbaf\xf8\xaf\xd9d\xbaH\xacS\x02m+\x9cA\fTi͊LG\xcfdEQ\x88\x14\xa1\x03+\xf8\xbe\xdc\x02\xa8'\xa6\xdds\u007fu\xba.\xa0\x82c\x90y\x00\v\x14bmRD\x19\xdafrڬ\xd8(]%g_\x81\xa5=1EBԔ=mbd\x14@-\x88|l\x86c\f7S\xa4\xf5\xe2\xf1\xe9\x17\xd9\xcb,\\\x87\x96MC\xe6\ue524_%$\x02iЗRN\xe4H\v\x14\xb2\xdd\\\xfc\x93e \xe6uF\xfa\\H\x9akR̫\b>`\xf2#\xfb\x98L#\x0e\x87#\xfa\xbe\xc74M0\xda`Ӷh\xea\x1a\x93sp\xc9TGV-1\xf2u\xed\xbc\xc7O\xbf}\x83\xa6\xaep\xb9\xdbβZb_\r(\x8d\xe8\x1c\x02\x14\xdc4a\xff\xf0\xc0i\xa8\xcapL\xf9\xe2\xac\xd6\x19\xf3\xf0Ρ\xef\x8fxws\x8b\xff\xef\x9f\xfe)&\xe7\xb0m[\xbe\x0eC\xccr\xbf\x84v\xaal\xe1\xcc\xe7\xc0\x9b\xb1\x87\x8bр\xf7\xffwϥ\xfa\xb7\xb0\x01\xe0\x83\x8f\xb0\xdd]~r}\xfd\xe2\xefy\xe7\xd0\x1f\xf7Yv\xe6\x84\xdd\xce\xe4?s\x82N\x06\xef\x11\x03{\x82'\u05ec\b\xa0?\x0e\xd0Ơ\xae\xeb\xd9\x04h\x85\x88\xaa<\xa9[\x18\xa3\x99Ȣ\x99+\xc0\xb9\x01C\x8e\x95\x9dY\xcb\xf1<+H\xcfE\\A\xe5\xb42\xadM\x86\xbb|\xf0Y\xba\xb4\x98\x1e\x8aݬ\xb5\x16\xbb\xcdN`\xcey\xb7\x9b\f\u007fB\bx8\xee\vC\"\xccR\xc8\xcc#й!j\xeb\x1a\x9b\xb6]<\xd7䟞*\xad\xd6@S\xb7\xd0J\xe3n\xff\x80o\xde\u007f\x87){\x15P.\x94i\xc2L\x05~\xd7maE\xa6\xe5\u0084\xe3xȇx]5P\xca\b\abN\xf3ӈP$J\b\xdb\x00ʜ?\x9c\x15\xaf9\\\U000127609~7\x06T\xa6ʐi\bLҫ\x95Fc\x80\xcajlw\x97\xa8\xaa&\x17\u007f6\x9cq\xb8\u007f\xbc\xc1a8 \n\xb7!\xc5\xdaV\xb6F\xdblе\x1b\xc9;\xd8f\xb4\xc1y\x87\xe3\U000401c7[(7\xc0j\x85\xc7a\x90\xc7T٪9q\xf7ZkQ\x89\xef\xba^\x99\x19-\xc4y\x85-cy}\x9dL\xb5\xea\t\xee\xc0\x9cf3{\xecǘW$\xd0b\x00\xb7.\xef\v[\xdcs+(uJ~{\x02\x8eU\x052D\x85\xde}\x15$'S\xa0\xcaҽ\x18u\xf6\xf6\xe0܆ِ&\x11D\xa1\xce<o\xf93\x13\x04\x15e\x84Ek\xcd\\\v\xb5\x84\xdc˵\xff\xa2\xf3\xfa\x05_\x94\xed\x81i\xf6\xf1]\xa0#\xc9ů\x88i\xa6\x95\xa2\xa0h\xe09\xad/\xfdw\x9a\x1b\x80\x92\xc0 fd\xc6\x18\xf8\xc0\xa8W\xa4\x00\x1f\x9c\x10\xf0\xd8\xd0l\xbf?2\xe9/$;q\x85ݦCS\u05fc^\x8c$.\xa6A\xfe=\xa2\xaa,ڦA]W\xfc\xbe\xc79\x83\x80(\xe6\xf5\vJ\xf4\x06\xb3\xa1\xa0\x92ב\x92K\x89\bw\xf7wxss\x8bo\u07bd\xc3q\x18q\xb1\xdd\xf2\xf7\x87\bO\xa1\xe0\xa3\xcc(N\xf4\x9e\x83\x9d\x00\xfc\xf4\xf0\x98\x96!\x0e\xc0\xf8\f\xff\xff\x166\x00Zt\xa6\x9f}\xf6\xe5?l\x9a\xae\xdb\xefo\xe1<K\xeeƑu\xfaƚ\f!/n\xd2@p\x93\x1379ʇ\x113\xc2#\x87\xfc8\xf6\x00X4\xee%\xa1EiT\x15\x93\xb5\x82\xe7ݿ\xd6\n\x8f\x0f=&\xe7\n\x9f\x01<9E(\x85eA\xb5\xb3\xe6\xd8\x18\x8d\xc99iT\xc2\xe2\x90<iD,\x13\xe5Zq\xa7K7C\xc8ݴ¡\xdfc\x9c\xa6\x15\xb3\u007f\x86\x8bMa\xeeQ\xdb\nۮ]\x92ʵ^\x12(\x95\xe2\xe2\xaf\r\xde\xdf\xdf\xe0͇w3\xbb>\x15(P>\\I\x1ec\xdbma5\xef\xe0}\xf4\xd8\xf7\a>Եɓ\xbf1Fb\x88\xd3\xeb$ z^\xd3؆w\xffg\x0f_>\x1e\x19\xfa70\x86Y\xe3\u038d0\xb6\xca\xees!\xf8,\xab\xac\x8dF\xa5\x156\x9b\v4\xed\x06Ft\xcd)\x94f\xffx\x87\xc7\xfd\x1dB\xf4 \x02\xac\xa9\xb1\xd9mqyq\x8d\xedf\x87\xca֙-\x1f)\xe2\xf1\xf0\x88\x9b\xbb\x0f8\xec\x1fЏ\x03\x8c\x02\xae7\x1d\x8e\xa3\xc7\xe4\xbd\xf8\x1e\xccQ\xcd<\xfd\x1b\xd4\xd6\xe4\xa9\xfe\xfc\xce\xff|&\x9f*&\xee4?\xcf^\x81%c\u007f\xc5+\x97£\x85d\xaa\x10\xe7\x18g\xd9㪕\xfb\xe32\xb1\xfe\\\xd8\xd0\x13\xfb\x98dQ,\x9e\x02\xb9\x01\x81\x12\x8f{:\xb1ɞ;\x94\x99\xb3\x10cD\xd4|M+\xadr\x88N\x88\x01\x96,\xc3\xe6\xb3\r㒘G\xc2&7\xf3{\x9a\xd4-TL\xda\xf3\xfb\xac\x9e\xb0R>\x838\x89Khν?'\xf5+\x9b\n\x85\xc2\xc6Z\x14\x13$\xae\x84(\x8a\xa9\xdc;\x99\vRXs'\x03\xa4\xfc\xb9+\xc0X\xe6\xadD7\xc1hV&im8u\xaf\xae1L\x13\xa6\xc9\xe53)D\xc0\x1fz\f\xc3\x04e\xf4\x1c\x1f\xae56u\x85\xa6\xaeq\xb1\xdd\xe0\xfa\xf2Rx$\x1aP\x1c\xed\x9d\xee\xe7d-\x8e\xc5\xeaI\x15\xc1T\x82Dƈۇ\a\xfc\xfc\xdd;\xdc=\x1e0L\x13\x02E\xb4M\x93\x1c\xa3\xe0\x84\xe4\xa7T2N\xe2\xfb\xc1\x8ds\x10۷\xfd\x01}ȑ\xc0\x01\xcf\x04\xc0\u07fe\x06 u\x93\x9b\xcd\xce|\xef\a\xbf\xf3{\x04\xc2\xdd\xed\a\xf1\xba\x8ep\xde\xc1X\rc+\xb9\xc1\x97\xa2\xdbiJq\xb0Ȭ\xfc$\xaf3\x96\xdd\xfc\xa6i\xc2Z\x0e\x97\xa6\xb3\xc4d\xe7,k\xdeK6M\x83it\xe8\x8f\xfdr\x8f\x98\"1\xcf5\x00sb\x8f\xc0\xe4\xdc\x00TU%Z\u007f/{\u007fu\xb6yP\x82B4\xb6ƶ\xdbp\x86:Q6A\xf1\x12'\xeb܄\xc7\xfe\x90O\x1f\x86\xfeS#\xa3\xb2=0\xc0\xfe\xfe\xdbM\x9bIV\fU2)\xb1\xfc\xaa\xab\x06\xc6X\xbc\xbd\xfd\x80\xb7\xb7\xef\x17\xc8BF\x14\nr\x91R\n\xdbv\x87\xda\xd4B\x9e\n8\xf4{N\x04+\x8a\xbfVJb\x88\xe5\x00\xa1\b\xceq\xf5P\xa6\x06\x12!\xf0\x89\xa6\xca;\aD\x82\x91\xc8\xdeɱ\xd9H%M\x03\xdb)s\xa4im\f\x1a\xa3ж\x1d\xb6\xdb\vXSeBb\b\x01\xfb\xfd\x1d\xee\x1eoPU\r\xae\xbbW\xd8t;4M+E\xdfd_\x85a\x1cp\xec\x0f\xb8\xbb\xbf\xc5\xcd\xfd\r\xbcc\x98U)\rc\r\xfa\xc9a\xf0>;\xd1%Ն\x16(\xbf\xd2\x1a\xb5\xf0>t\x19K\xad\xcfϟYϞ\xf6\xf9j\xad\xc8_a\xd2iw\f:Sؐ\xf9\x00\b1\xdb@Ǵ\x1aX?~v\x9a<\xdf\xd0>\xd9\xec\xea2\xbf`i>\x90\xa0\xf9\xbc}x\"z\x80\x84\xe5\xcf.\x80Z\x1c\xff\xc4\x14(\xd9\x01\xe7\tT\xe5)5E2G\x9a#|\x17Ά%/ \x0f\xebt&5A@\xec\x95\xc5/\xaf\x81\xe6\x14\x1cZK\x04V܈%\xccP\x9a8\x15\xe4Ziȓ\xbdo\xf1\x01,\xd6+\xf9\xf3$>\x9btU\x03z\x02\x05\xc7r\xe0\x97\x97\xb8\xbf\xbfGU;\xbc~\xf5\x1a\u07fe\xf9\x16!\xc4\"G\x810y\a\x1duF\x94\xb4( \x14\x80\x83R\xd0И\xa6\x144\xa4r\x06\x05{&pÞ\x06$\x1f\x02\xaf\x15D^\xebB\xc0q\x18p\xfb\xb8\xc7\xdd\xfe\x00\x1f\x82$\xb1jA\xf9\x14\x10\t>\xb2}\xba\x16\xdeS\x94\xc4\xd3\x18\x82\xdc\xd3\x11\x9e\b\u007f\xb2\xbf_\x1cC\xcf\xd3\xffoc\x03`4(\x06|\xef\xfb\xbf\xf3\xef_]\xbd\xfc\xc1\xe3\xc3-\x0e\xfb\a(\x05LS\xcf;\xf5\xccn]\x9eH\xde{\xf8ɡj\xea\xec[\x9f2\xac\x93\t\x86\x9b\x9c\xdc\b:\x87P$\x9f\xfc4\xa1\xa7\x00\x9c\x18\"6[άO\xae\u007f\xba\x90vQ\xa4sK\xd9\xc5\x04\b\x88\u07ff1\xa8\xb4\x81\xd1\x06\xc3(\x86C\v\xf3\x16Zle\xb5R\xecVWU\x1c\xf6Cs\xc3\xe2\xe5P\x04\x80}\u007f\x80\xf7!K\xc5r瞵\xde\xfc0\xd6\x18\xec\xbaMQ\xfc\x01\xa5\x8c(\x12\xe6\xe9\xce\xda:\x17\xffww7'\x87\xfaZ\x96\b\x00\xdbf\x83\xa6j\xf2\xa1s\x18\x0e\x98<\xbb\x89\xa5\xe2Ϧ$\x96\x9b\r\xc5\x1azE\x111:(SAU\xcd\x19\x8d\xf4\\/\x03E\xf8\xe8%\xb5\x8c\xc9P\x93\x9b\xd0e{a\x92\x10\x12\x9e\xd4Zk\xd0V\x15...QY^=\x10\x01\xc1\a\xf4\xfd\x1e>8|\xfa\xfa{\xd8v;\x96!ʄ\x18B\xc0\xb1\xdf\xe3p|@\xdf\x1fp\xec\x0fx\xdc\x1f\xe0S\xbabq\x9d\xc4\x181H4\xee\x12\xc1W\xf9\x1a\xab\xc4\x13\xe1\xe4\x10[/\xd0KZ\x80RgfS)N\xb4D\xab\xf2{V\x0e\xd7t\x9e^\x90t\xf8A\xecz\xf3zj\x85\xcf?u\xde>\x81\x01,\x14\x00T\xfes\x91\b\b\xac)\x85j\xb1\x02IfZ,\xe54YQ\x13\xe5\xdeUe#\x94\xbc\x01(\"\xa60 MY\x15TZ(\x17\xdaHA\xabh᷐\x12\x96\x16\xe66(5\xfftJB\xa1\xe5\n\x86\x16\x85\xaaH\x04T˵\x01\x9b\x14\xf1\xb4\x9dL\xc0f\x0f\x83\xd4\x1d\xb1\x1c2&\x85C\x8e\xaf\xb6L\n\xb5\x15\x14\x05P\xf0x\xf9b\x8b\xc3\xe35\xc6qċ\x97ר\x9b\x1awww\x19\r\x1b\xc7\x11}\xdf\xe7H\xf3\xc4\v\x99\xc6Dn|\xc4\a{\x87\xedf\x83\xed\xa6\x83\xb5vV[P\x84s\x1e\xc3\xc4n\xab>\x04\xb8\x18qt\x93\xb8\x8b\xaaŪEk\x83\xa6\xaea\xa5i\xd0r\xbd\xfa\x18@!\xca\xed\xc5$\xc7\xf4yx!)\x02\xc0{\xef\xd0g\xc9\xf6\xaf\xb0\x97y\xfe\xfa\xcdi\x00\xb4\xe1\xbd\xdf\xee\xe2\n?\xf8я\u007f\x9f(\xe2N&P\xef'.\x00\xd6dX\xb6$\xe2E\x8a\x18\xfbQ\x8c2R\xfe72)\xcc;\x9f\xc9{\xaa\xf0\xaaGֻK\x01\x15Ґ\x9b\xbc\xa4\n\xd6\xe8\xfb\x01\xc30d7=\x95\xb1\xff\xf3\x9e骘\xf4r7\xac5Cu\xe3\x80\xc99\xb1\xbf<\x85\x11\xd3AWW5*k\xd15\xed\xacE\x97\xc3\xcd\a\x86\xb8\xfbq\xc0q\xe8\x17\xd0\u007f\xb25M\xaf'\x91\x0f\xb7]+\xcdˬ\xed\xd7\xca\x14\xa72\xef\x19\xad\xad\xf1\xee\xfe\x06\xef\xefod?/\x87\xb1\x02\xa0\xe2BZ\x96\x8c~ں\xcd\xef\xf5q<`t#\xac\xb5hl\x93\xd9\xc5ƚ\xcc\xe8\xd6<\xdaqd\xa86\xd0US8\x1d.\xdf\xd0\xc4\x18\xf6bi\f\xa5\x11\xa2\xc70\r0\xc6f\x0f\x01\x96\xfd1\xe1\xa9\xd2\n]eq\xb1\xbbD\xd3l\xa0\x95Ͳ#\xa2\x80\xae\xdd\xe0\xf2\xe2\x9a9\x19D\x98\xfc$\xc5~\x8fa\xec1\x8c=\x1fT\x04\x8c\x93C$,⛓)o,ܚu&\xedɵ\xac\x00\xa3\x90c~\x95ZO\xdb\xeb\xa9~6\xe99[\xfc\xb14\xa2I&M\xe7\xa8'\xea\x89\x13TI\xb1\xa6\b\xa8\x18\xa41\x93uU\x81a\xeb'\n\xfdG\xf7\xe3\xe7\xf8\x04*\xad\x01\xa2\x10Ÿ\xc0\x96V\xc2\xe5\x13\xe5\xcf\xc8#Z\xcb\x19\x03\xe0Ŀ(Ɂ\xb9\x0f\x02s\x06\x8c^\x86\x19\x06\x8ar\xbd\"kosxV\xeaϩ,\xfa\x10/\x81r\xd1\xf2\v\xf8I8\xe5\x05f\x89\xe6\x8a\x10\x98\x19\xff\tّ\xbd?\xab;\xe6\xac\x01\x85\x95\xaf\x82ҢH\xa6\xcc7PZ\xc1*\x03S\xdc?\x14#~\xf8\xfd/0M\x0e\xefo\xefq}}\x85\xedv\x83\xc3\xe1(\xd7\xdb\x05\x8e\xc7\x1e\x8f\x8f\x8f\xd8?\xee\xe7T@\xcc=\xd10M8\x0e\x03\xdeݔ\x19\x14\x90L\x06\x91&k\x959B\xd6Zt\xdd\x06\xa5kbZQXca-\x878\x05\xef3Y\xb1\x947'\xf8\x9fUZ\x9c\x8e\xe9\x89\xf0\x17\xd3\xf0\\\x95\u007f\x9b\x1b\x80\xc4Z\x8f1\xe2˯~\xf07/.\xaf\xff\x9d\xc3\xe3=\x0e\x02\v97e\xef\xfc\xd9s>\xc7\xeca\xecGP\x880M\x9d\xbbJ\x889\xcf80c\xb6d\xe7'L\x92\xf5\xdef\x96\xceY#\xff)\xa2\xe9:\xc4\x18q8\x1cN&\xa3\x13\xb7\xbe\x02rL\xeee\x8c&T0F\xa32\x16>xξ\x8e\xf1d\xc4-\a@k-\x9a\x9a\x1b\x80dУ\xe4`v\xe2\xf7\x1fcă8n%\xaf\u007f&=\xe9Ŵ\xa4\x94\xc1\xb6\xeb\xb2O\x82ɺr\x9dez$\x93\x93\xad\x1a\xbc\xbf\xbf\xc5\xfb\xdb\x0f|\xa8\xd2<Mљ\xe2_\x99\x8a\xa3{\xe5\xf0<\x8eG\f\xe3\xc8ȅmrt\xae\xd6\x1a\xb5\xa9d?N\x1c_\x1a\x02;\x9a\xc9\xe4\u007f\x1e\xfa'!\xf5\x85\xc2\xf7<b\x98FD\"tu\v\xad\x14|\x8c\xb9)\x8a1`S[l7\x1b\xb4\xdd\x06F1q\x8f!H-\xc6N\f\x85\x1f\x8f\x8f\xb8\xdf\xdf\xe1\xe1\xf1N,\x85\x03K\xc6$\x14)\xc8\xfb\xfc\xb1\xa8\xdc\xf4\xa7Q\xfc\xff\xba\xd8\xd2[Ϳ+\xcb\xc4\xca)\xf6\x17\xcc7\x94\xa7\xd9%Q0\x83\xc9jiZC%\x93@\x88\x9aj5e\x97_\xa1(\xcc\x1f{e\xbf\x10\x05H\x93u\xfe|P8\xfa!\xaff\xb2\x1e=\x93?N!\nR\xfc\xbc\x92\x89\f\xa9\xe4\xef\x10\xa1-\xe50\xa7\xc4D\x9b\x9b)\x91lF*b\x94O\x1fC\x9d\xec\xed\xcf+\x01\b\xa5\xdd/}\xf4\xcc*\t~I\x9a\x9b\xc2\x0f\x97}NrԔ\x06\\\xafY\x1f\xc5\x12HVHT\x9c#Z\xf3\x02K+@\xe7\x14\xc1\x88\xcdf\x8b\xdf\xf9k?@\xa0\x9f\xe2\xf1\xf1\x88\xbaf\x04\x8fsN\"\xae\xae\xae\xd0u\x1d6\x9b\rnno1\xf4\x83|6\xfc\xe4\xb4x\"x\xcfn\x818s\xae\xcd\xebQ\x80\x82\a9\r+J\x98l\xc4VUh\x9a\x1a\x95m0\x8e\x03<\x11\x9cs\xf0\xce/x\x1a$D[7My\xf7\u007f\x1b<\x86xv\x95J\xa5\xb5\xfb\xf3\xd7op\x03\x90\x0e\xe5n\xb3\xc5\xf7\u007f\xf8\xe3\xdf\x03\x01\xf7\xb7\x1fd\xfaw̨7zE(\xe2\xaf\xc99\x8c\xfd\x80\xb6\xebV\x1d:a\x1aG\x8c㲻LR\x98\x12\xd2E9\xfd;\xcfɂ\xd6b\xbf\xdf\xc3;\x9f\xe1\xf4\xb3\x8ch\xacCD\xf8I\x18k\xf9wJ:Zb\xe9\xd2S\xc5_\b6]ݢ2\\\xfc\xe7\x833\x99\xe5\xf0\xee\u007f\xdf\x1f1\xba1\x17{-\x12\xc3e\xf1W\xd8v-jI\xb8K\xfb\xc7\x04?\xce\a\x0eP\x99\n\x1f\xee>\u0edb\xf7\xbcs\xccP\xaaL\xfe\xab\xf3Z+\x85M\xbb\xc9$&\xd6\xfa\x0f\xa8\xebZ\xe4xɋ\x80W\x19\xf9\xfd\x8b\x92\xb9n\f\x94\xb1Xs\xb1\x97\a1'\xa2\x85t8\xc8\xde߹\t\x8d\xbcG\xc9!-M_F\x01ۦŦۢ2\xf5|\xd0\x15\x9a\xfaa8\xe0\xfd\xed[<\xecﹱԆ\xaf\a-\r\x92<\x97\xe0\x03B\x81$\x95\xcaĒѭ\x8b\xcfN\x15+ [\xc8\xce(\x12\xa0O\vn\xfe\x1chI\xef\x8b'v\xbe)\xe3\x1e\xf9\xb5&\x91\xebLKY\xdaP\xceA5\xeaD\xed\x82H\b\xe0\xe9\xce(u\xba\xbf?W\xec?F\x04('yy\xbd\xe9\xd7%_\u007f@\xe7\xc7Q\xebן95\xbc\x86I\xd7i\xb2\x00N(@\xc4\f\xd3\xcf\xcc\xfby(\x88 \xce\xed\x9b)\x01\xd2\x14%b\xe1\x12v\xa33\xaf\x94hv\x12=s\x8b\x9f\x84z\xa1,\xfe\xb9\x91-8F\x89\x10\xac1\xa3D\x19\x01,\xec\x00\tř47\x03J\x82\x8a\x14E(\xf1<\xe0$D\xfe\xff\xcb\xdd\x05~\xf0\xe5\x17\xf8\xe9\xd7\xdf`\x18\x1c\x00\x87ͦ\x83\xf7,\xfb\xb3֠\xebZ\xecv[\xbc}\xfb\x0eww\x0fE\xf41\xa0\x15\x9d\xac-O\x1a\xa2\x10\x00\xada*;\x9b4\x81\xcf8ktn\x12\xbc\x9f\xf8qC\x84s\x8e\xe3\xbes&Kș\x04i\xfa\x8f\x00\xbevӹ\x13\x80N\xae\x8f\xe7\xaf\xdf\xcc\x06 ]\xf41F|\xf5\xbd\x1f]]_\xbe\xfc\a\xc7\xe3\x1e\xfb\xfd=\x93O\xfc\bc\xf5ٟ\x8d!\xe2\xf8\xc8\x06A\xda̤\xc0(\xba\xd7q\x1cs\x1d\x8b\xa5qH\xda]\x15\ar\x9a\xfe\x01\xa0i[8\xef\xd1\x1f\x87\x02\xda-g\xad3'\x83\x9a\xefi-\x8cw\xad\xf8y\r\xd3\x04\xe7\xfc\x93^\xe2\xe9\xe0o\xaa\x06m]Ak\x8bF\fx\x04\x1c\xce;\xd2\xc99\xec\xfb\x03\xdftHнYy\xc3+t]\xcb\xd1\xc8Z\xc1(;O\x17Ћ\xf5\x895\x167\x8fw\xf8\xf6\xc3w\xa0\xc81ũ\xb0\xf0\x9f'\xa5\b\x9b\x86I\u007f\x00\xe0\x83G?\xf5\xc2Y\xa8A\xc4\xef\x97\xd5\x1a\x95\xad\xb29\x0f\xc5\xc0Ӌ12ݪ\xc5\xc4u\xba\xb3\x8eY\x9a\a\x00γ\xee\x99W3\xec\x15\xc0\xdaf\x9e\x18C\x8c\xe8*\x8b\xddv\x8b\xb6nYj\xa8\x00\x05-\x9c\x8f\x80\xdb\xfbw\xb8\xb9}\xcb&)D\x12\x14\xc4\x16ѕ5\x8b\xebʇ\x98es\xa90\xe8b\x9f\x9c\xdfj=\x17\xff\xf4쵼\xfe\xd2\x00&\x11\xcbJ\xe2\xe9\xe2\x15\x17*\x8b̚S+\xa2\x1aͿ+1\xfaQ\x10\xe0f7'u\"s˿\xae\xf88ٍ\x8f\xa1\xe5s\xc8\xc49$`\r\x83\xabr5\"\xa3o\x94\u007f\xce\xcdT\x8c0:\xa1 \x1f\x91\x10R21\n\b\xa2N!br\xe7\xccN/Ñg\"\x1d\x89\xea!\x15p\x95=\xfcլ\xc8+\xdeS:\xd3z\xce\xc5\xff\xcc\xf4\xaf\xd5ɧ\xa6\x8a\x9c\x84\xb8.bjn\x96S\x83\x90|\x12\x96\x81\x80$Ͳ\xc9gM\x92ץ\xeb+\xbd@\x12E\n\b\xf2\xfej(Rx\xfd\xf2\x15\x9c\x8fx\xf7\xe1\x03\x8e\xfd\x80a\x9cr0Z\xba\x8fv\xbb\v\xb4m\x8bM\xf7\x01o\u07feceUκ\xa0'Q\x1f\x88\xad\xb31:\xf3\v\xf2\xb5&+\x94$It\u0381\x88#\xba\xbd\xf3\x05\nĄ\xbfH\xac\xc8B\x8cP\x00\xf6!\xe0\x18\xe3\x93-\xe7s\xa9\xfe-h\x00\x12\xa1o\xb3\xdd\xe2\a?\xfc\xdd\u007f\b\x85\xdd\xfd\xed{\x84\xe0\xe1\xbccyϹ\ue500\xfe\xd0#\xf8\x80v\xd7\xe6\x1b)\xc6(v\xb1\x8e\xc9v\xc5\xe0Br\xc1\x1ac\x16\xb7rb\xbc\x06\x1fP7\xb5xm\xb3\x8c-\x05g\xf0$\x87\x05\xf4[\x1e!s3Ay\xf2\xb7\xc6\xc0{\x8fa\x1cO\xfd\x02\nb\x1f\b\xa8\x8dE\u05f6\xb2۶\xbc3/\n\x86\v\x1e!F\xec\x8f\xf2\xbc\x14\x93!\xad1'椛\x96-~\xb9ɱ\x19\xaeS\xc5Ο=\xc25\xee\x1e\xee\xf1\xf6\xe6\x1d\x10(\xef&\x19b\xa5⽛\xfdX\x9b\xbaAW\xb7\xc9\x04\x15\xfdx\x84ՌX\xa4s\xb22\xfc\xda3)+\n\xbch\xec\x897\xfaY\xe2_Dv[L\xd3\xc30\x8d\x92K\xd0\xc2h#\xbaf\x9f_\x93U\x84\xcb\xcd\x16\x9bn\x03k\xea\xfcy(\xa5q\xe8\xf7x\xff\xe1\r\x8e\xfdc\xb1\xf3\xe5\xf7\xc3\xf9\xb4\n\xd2\xf9\xfaHj\x02*vƧ\x16\xb0ɫ_eyW\x19\xa2d\xb2\x97{\xc10WO\x93\xec\xb0*\xac<\xcd\"\xd3\xe7\xd7\tvٸeEF[\xa7\x01*,\x9b\x16*wΔ\x9a\x00\xbd\x98b\xd5SH@\xe2ͬ\xf6\xfe\v(\x9c\xe6W\x9d\xbe'\x84\x80P\x18a\x9d\xac%\n\xe4,\x8a/\x00\xc5\b\x12\x1e\x81\xcay\x17\x16\x94\xad\xb4\xb9ȗ\xa4Դ\x06H\xf0=\xadL\x8b\xce(\xf9f^@\xe2\x03\x14D\xc0\xd9RX\x9d\x905\x13\x02\xb3\xf8\x99\x85\xc1@\x8a\x19.\xee=\x85\xa5\x90>5\b9\xd10!u\xf355\xf3\x18\x8a\xe0hc\xa0\xc8\xe4O\xde*\x8b/?\xfb\f\xbb\xcd\x06oo>\xe0\xfd\x87[Y\x03@\xa2}\xb5h\xffk|\xfe\xc5\xe7\xd8\xeev\xf8\xfa\x9b\xaf\xb1\u007f\xdc\xf3\xfa\xcfZ\xb8\xc9=\xc9{P\xb2\xff_\x96f\x95y\n\xec\xe8\xe7s\xb31M\x0e1\x86\xfcܽsl\xd8\x15#\xa2H\r\x15\x80\x9fM\xe3\xc7\xe8\x16x\x16\x00\xfc\x867\x00Y\xafK\x84\x1f\xfe\xe8w\xf1\xe2ū\xdf;\x1e\x1f\xf1\xf0x\xc7\x0e\xbe\xf02A\x9e\x1e\x95\xd3\xe4p<\x1e\xb9\xd0\tTN\x91\xf2\xc4\xe1\xbcC\xe9\x05\x00Ņ%\a\xe1\x94M\x88\x15\x99\x9dV\xa8\xeb\x1a\xe30b\x1cG\xf1c\x17]s\xa4'\x9bSU\xf8y\xb0\xb4\x8e\v3\x00\f\xc3 \x04\xb5Ssղa\xd8t\x1b\xd4U\x05\xa34K\xdbdlIf)!r\x11\xecG&\xfeY\xa3QY{\xf2\\ڦF\xd7Ԍ\x8a\x88\xf6]-`\xcb9B\xf6n\u007f\x87\xf7\xf7\x1f\x84i-\xd5\xfb)\xa3:\xf1\xd6߶\x9b|\xf8\x8d\xd3\b\xa54\x9b\xfc\xa4\xc3(\xbd\xf6\xd4\x10\x85\x00\xa5\bJ\x82G\x9e\xacz\x8b\xe2\x179\xe8E8\x0f\xc3\xc4\xd1\xcfm\xd3\xe5\xc6\xc8\a\x8f\xd2Qn\xdbT2\xfd\x8b\xe6_Ȟ7woqs\xf7\x16!xq\x00Lֱ\x1aAX\xe6M]\xcd\xcekY\xfeE\x19\n.W\u05f9\x88\x11\xa0mʦ/\xe2_K\xc4}\x11\x18\xafN`z<\xb1LRymP\xecbez.\x1dis\x13\x90\xc2qV\x99\x00\xb3\xe8K\xcd\xd3o\x99 ,\xf7D\x94C\xfc\x84\xac\xf8K \x01\xe7\xe9\f\xb4hrI\xf2\x19\x94p%N\x9a\x80b\xa8O\x9e\xfa\x91\b\x86\xe6Հ\"\x804e\xc9#hi\xb3\x9d9\x12T\x14s%\x8fBK\u007f\xfeR1\x918?k\xd7_\xb5\xde\xfb\xac8\x1a\xd9\v\b\xb3# ?\x1d\x9d\xa1{\xad\xf4\xd9Ʊ\\\xa5\xa5\xf3-\xf1\x91\x16Os\xedנ4\xb4Q32H\x91\xe5\xb4\x04X[\xe1\xd5\xf5K\\\xee.\xb0i;|\xf3\xe6;\x1c\x8e=s\x8e\x12\xf1W\x9a\xef\xeb\xebKl\xb7\x1dnoo\xf3y\xf9\xf5\xd7\xdf\xe2x8\xce$\xcd\xc2Xʬ\xe2\xab\xd7M\xbc6&ǮO\x93c{uy\r!x\xb8\xc9-\x11=\xa5\xb0\x8f\x01\x8f1|\xac\x17\xa6g\x10\xe07\xbc\x01H\x90\xd2\xc5\xc5\x15~\xf8\xc3\x1f\xff{\x00\xfd\xee\xdd\xcd{\x04\xe7\x11\xe0r\"\xd6\xfaڈ!\xe2\xb8?@\x11\xc1\xd6UqpH\xcdq\x9e\xb3\xde\xcb\xe3J\x88(\xeaL\x13b\x8c\x01EB]W\x9c\\w<fK\xe0\x99\xa7{\xfe\xa8Sz\xd6\xf4R\xf1\xfb\xb4\xd6\xe8ǁ}\xeaq\x86<\x85\xc4\xc0Vش-j\xf1\xd9\xd6z\xf6\xe6N\xfbR\x17\xd8\xc1\xeb8\xf4 \"Զ\x92\xe2\xbf\xfcj*v\xf9\xd3\xda2\xbc]\x14\x8e\xf2\xb1#1\x89\xf0\xf6\xf1V\xe4a\xf2\x1eiu\xd63\x9d\xdf'\xde\xfb\x1bͻw\x1f\xb9\x007\x05\x8b\xdfdS!\xf9=b좴\x9dw\x9f\x8b}\xf2iA\xa4\xbc\xf2\x0f\x88D\x18\xc7\x11>zT\xa6ʎ\u007f^\xa6\r\xf1%D\xa5\x81\xcb\xed\x0e\x97\xdb\x17\xa8m+\xd7Cć\x9b\xefps\xffn\x86\xf6E>\x96^\xa3\xf7\x11\xd6h1K\x9a\xe3I\xd9\xc8f\xf9q)\x05n\xd0DJI\xc9\xea\xf6dGP\\r\xb4\xa4\xa0\xd3Yh\xbfd=<}\xe4\x95M\xe6r\xe2\x97b\xa4\n\x89\xe0\x02\xb4(\x8d\x86\x16\xc6\xf4\x8b5\f\xf3bh\xd1d\x9cm\x02\n.\xc0<U\x9f\x83֗m\x02\xd1\xccj\xa7sfC\xa5D\"\x99\xf0\x000\xe9g\x8a\xeb\x98 h\x8a^6\x13J\xad\x9e\x01%f}\xe9\xd4\xcb\xfatZq9֯9\xcaŸ\xe6)\xa4ײ\\~\xa4\x06F/\xa4\xa2Pg\xc8~4\x1b\x85)Q\xe6\x98һ\x80P@\xff\xf36\\+\xc5hhZ\x01\xc8d\xae(\x81T\xc9\xc2\xdb\xe2\xab\xcf>Gmk\xfc\xec\xeb\xafq\xff\xf8XD\x97\v\x1fFs\xb6\xc9g\x9f}\x8a~\xe81\x8c\x13^\xbez\x81q\x9cx߯\x96Z\x90\xd2\xf7#\x9fM\x89\xeb\x92M\xb8\xd8\xdfd\x9a\xa6\x1c\x0f\f\x12o\x16!\x00\xa2 \xfb\xfdl\x9a>:\x1b>\x97\xe9\xdf\xf0\x06 M\xff\x91\"\xbe\xfa\xde\x0f\xb1\xdd]\xfd^\xdf\x1f\xb0\u007f\xbc\xe7\t\x10\xe1,\xf1\x88\x880\x0e#\xdc8\xa1ijh\xad\x10\xc5\x19/Ar\xce\a\xd9\xf9\xab|\xe8\xc6@g\xc9NFv\xbf\xa6\xd2\xd0V\xa3?\xf4L\xfc+\x0f\x9cx\xa6\xf8\x97^#\xc5\xdfYka\xade\xe3\f1&Zle\x8b\u2be0\x04R\xef$\xbcG3\xa9F/\x83]\\\xf0\x18&&\xc0=U\xfc\xab\xcab\xd3q\xd0\x0eO\xc0\xfa\xd4H&\xb9\xe0\x1d\xf7\xb8}|`i\x10\x14\x1f\xae\x85c้\xa7m6\xa8m\x9d\xe1Ufw\xdb<1\x18\xad\xb2\x0fA\xba\xf9\xe7)\b\v\xabC&3E\xc4R\x8a\b\xf1u\x17\x9b\xe4\x18#F7b\xf2L\xd4k\xea\x86\rD\nֿ\x92\x11\xf6r\xb3\xc1\xab\xabO\xd0\xd4\x1d\x1fF1\xe0\xe6\xf6-\xee\x1e\xde\x17Q\xcd\x12eJ<\xc1\xb8\x10\xb8\x81̹\xf3\xc8f(\xe7\xd2\xe0*kr\xf1W\x00\x8cՅ\xb9Ma\xa3\x9ad\xa6\xabꖂl\xcc\x19D+\x15#*\x18\xec\xe9y\xcc\x12C\x9dy\x19\v\x96?\xad\xfc}\x89\xc4M\xae\xdcX\xcf<\x00\xfe\xf5B*,\xb6\n\x91\x00%\xc6=\x1fC(\b\nkǠ\xd4\x04\x9cණ\x86%\xcbw\xa9\xcc\xc2]\xc9\x1f\xf3:%\xf9\b\x98\"\xa3\xa1ؾ'\x92 \b\x06j\xd1\xe8\xcc\v\x80(\x9c\x14y\xed\xa4\xe6\xe0^:\xd3}\x10!\xe4\x02\x9f\xdc\r\u05ef\x95֥\u007f\xce\xdaX\x15\xffs\xc2A\xa5T&4'\xbf\bu\xeel)\xaeIufݢ\x12\xc94Dy\x1f\t\n\x01\x14\xf8\x8c\xfb\xf4\xf5kXk\xf1\xaf\xfe\xf4Oq\xfb\xf0\x90#w\xb3\x9aG~o\b\x11\x14\t\xdb\xdd\x16\x9b\xdd\x06\xfb\x87\xfd\xb2\xf1\x93TJ\"\xc8J\x83\xdd\x1f\x95\x91kRk\xb8\xc9qL\xfa\xe4r4\xb1\x82\x82s,\a̡I\xf2;\xc7\x18q?\xbb\xfe=\x99<\xf1l\x02\xf4\x1b\xdc\x00$\xdf\xef\xedv\x87\xaf\xbe\xff\xa3߅¿\xf7pw\x03\xefF\x10\xbcܴ\xf1\xa4\x10y\xe7\xd1\x1f\x8ePP\x8b\xc0\x9e\x9c\xae\x17\x02\x13MJ\xa84҂\x80UN\xef\xc6\x1a\xf1۶p\xce/\x1c\xff8+;>MKY\xedt\x8d\xd69\xa4f\x18\x87\xe5lQ\x12\xc2\x04O\xb6\xc6b\xd3v\x1cX\"\x87A\xca\fHg\x80\x8b\x01\xces\x03`\xc5J\x97V\xcf\xc7\x1a\x0e\x01\xb1\xb6\xca\xc5_\xab\xf5n\x98\xad>\x0f\xfd\x11w\x8f\x0f\\D\x93\xe1\x9c6O\x8e\x9e\x04\x82\xb5\x156M\x97\x0f\xf8\x9c\xbe'\x85^kHa[\x06\x05\xa9u\xd2\x1c)\xa8\xe0\xa1( \x9aj\x05\xdfJ\xd1S,\x05\x9b\x1c\x9b\xfd(\xc5k\x19\xa3ٞ\xd9y/D*\xfelvu\x8d/?\xf9\n]\xb3\x05\x00x?\xe1\xf6\xee=\xee\xf77(\x10ڂW\xa7dZ!\xb6\xe9M\x1c\x8fUюų3Z\xa3\xaa\xcc\xea\x12(\"\xa8\x88\xc3ZJ\x83\x9bs\xf88\x1b\x16Ŝaq\x0e\xfe_L\xd2Ť\xbdȨ\xa7\xb2p\xa7m\xbbD\xab*\xc5d\xcbE\x1d\xa6\x02\xa4(mxi!Ì \xe8\"\xc4\xe7,\x12\xa0\x90\x9b\x875\x1f 7\x02\xea)G\xc1%\xa6\x90\b\xa3e\x90Ђe_\xf0dTڕ\xe7\xe8iU -2\xb4\xaa\xb9\xf9\xa4\xd2:\x98f\x84\x05j\xa9\x14R\x19E\x99Q\n\x9e\xd0\xcd\t\t3#\x00\x05s\x1f\x99\xb9\xafg\x0eM\xde\x05\xd1b\xed\x90b\x9e3\x9c_$o\x96\xef\xb4\xd6I\xa6{\xe6\xc8Q\nJ\xa2\x8dUI\nM\x8d\x91֠\xc0(ߋ\xeb+|\xfa\xc9k\xdc?>\x02Z\xc3{\x87i\xf0\x99\xbf\x03!\x17\x93\xac\xeeڮ\xc5\xf1\u0603\n\xf44\x99\x87\xb1g\x02ߣ\xda\x18h!-&\xfb\xf5\x98\xa6\xfd\x10\xc0\xe0_\xccr?Zހ\xf8ƍ'\n\x93\xd55s>[\xfd\xf9\xeb7\xa3\x01`\x88\x9c\x8f\x8e/\xbe\xfc\x1e..\xaf\xff\xd14\x0e\xe6\xf1\xf1\x0e\x81\x02Hų]z\f\x11c?\xc09\x8f\xaeka\xace\xb7\xa9$\x8f\"эǘo\xf0\xc4\f>w-\xd9d]i\x99\xa9\xde\x1fz\x89(U\"CZy\x98\xaeNl\xbd\x82\xd7몆\xb1\x16\xc30.b.\xb1\x9e\xfee\xb2k\xeb&\xef\xf1s\fl\xf1;#1\xfb\xddI`\x06\x9b\xe9\xa8\x05\x9bY)\x85Mۡ\xa9j\x0e\xbb\xd1\xcb\xe7\xa4d\"\x9e\x9cC?\x1cq{x\xe0\"\x8a\xa2\xf8\u007f\xf4Ka\xd3t\x99\xa5\x9c\xc8y\xcc݈0\x1a9\x03 \xed5M\xe18X\xfe\x1e\x1d\x1dT\b\bւV\x92\xb5\x1c\x13\x1a\x98\xbf1\xba\x91\xe1}[eN\x04K\x9bB&5\xb5U\x85\x1f~\xf1\x03l\xba\x9d\xd8\x03O\xb8\xbd\u007f\x87\xc3\xfe!G\xa1\x06qcK\x9f+\x81\xe0\x82@\xfffFI\x02\xe2\u0091\xb1t\xb7\xab\xaa%it\x1d\xe8\x17\x03!(\xc0\x16`\xc7SGW\x9aj\xcf\xf9J\x9c3\xee1\xc5\x03*\xac\x9a\xc8\"\xbb\xde\xd0y\xa6{\xa9$)\x8bu6\xc1)\x90,(6\xd4aR\xe02XhmVH媀\xe8\xc4\x17\x1f\xab\xbf+]$\x97\xa4\xb8\x152X\xb8̕\xf5 \xb9\x80\xaa\x05\x99\xb1$;,W\x04\x8aαmJ\"`Z-\x90$\x86Ӣ\x98\xaf\x9b\xe0\xb52\x83\xb2lO\x02\xa0\xf4\x13\x84\x96L\xe6\xd3\xf2\xbd\xe7\x1fc\x89\x0e\xcc\x1c\x85\x05\x86#k\x03\xb5\xcaa,\xbea.\xa8\xd2\x1c\xec6\x1btm\v\xe78@h\xea{^\xbd\xca*\x90\x8a\xb5\x1c\xfb\b\x18&\xc0\xc6Y\x9aXJ*\x93]z\xfao>x&\xcd\xca\xe0\xc5\xe4U\x05\xef]>\x8bgb&__\xef\xcb\x01\r\xc8<\xa5B%E\x10n\xc2\xf3\xd7o`\x03\x90vJ\xddv\x8b/\xbf\xfa\xe1N+\xfb\x1f\xec\x1f\xdfa\x18\x0e \x15\xf8\x96[\x93\xe6Ef2\xf4\x03\xac5\xa8ۆ\xe1\xd68\x1f4I\x8e\x92倁\x16\x17\xdfz\xfa\xafD\xab\xaf\x94B\u007f\x1c0\x8d\xd3\x12\u008b8ه-'\xdcbJ6\x06UU\xc1{\a秅Ԯ\x8c\xdbL?g\x94\xc9h\x81\xc2l\xba\x91\x8cT\x94\xb8\xdeM\xce1\x81m\xd1l̇\xf3\xa6k\xd1\xd6\r\x8c\xb6\xc2EX:\xbd{\xcfj\x8a\xe3\xd8\xe3\xfe\xf0\b\xe7|\xbeô\xd2\x1f\xa5ڒ\xec\xf8\xeb\xaa\xce\a/\a\xb6h\x80\"L&.\xa9\xdc4鲠м\xd8\xd7\xdeA#\xc0\x9b\x1a\xa4\xec\xc9A\x99\b\\\x93\x9f\x18=\xa1\b\xa3\xb4p\f\xb4\xb8\xfdy\x9eZ(bS7\xf8\xf1\x97?\xc0\xe5悝 \xdd\xc8\xe9~\xc7\a\x84\xe8\x18\".'\x0f\xf9b\xebd&Q\xa6\xe4I\xf6(/\xc8a\x9aw\x9b\xc9X\xa62\xe6\xa9y]V\x0e\x84\x88(q\xc0)\xc5D-\rk\x16;\xfbȶ\xaf\xe7\xdf\xf4\xa5|\x10\xa7\xc1@\\\\uf\xf8S\x8c \xad\xa1\xd7\x16\xbbO8\xef)5\xaf\x1b\xb2\xbf>\xe6>7\xd9\xfb\xaayx\xcd\x04\xc3R\x847w\as\xf4\xf6\xb9\xa0\x9c\x04\xa7sЏ\\\xbb\xa4\xa0N\x82\x10U\x01y\xaf\x04wɿ\x82\nN\x8f*x\t\xd9\xd2p%\xd6M(E6\x00S\x055\x98\x8aX\x05\x05\x9c\xc0\xf1\xe7Ώ\xf9\xde\xd3\x1a'\xea\x8cS\xde\xc6ܸ\xe8\xcc\x11\xd0g\x90D]x\x00\xa0Pt\x96M\x93>{\xbd\x9c\xf4\x1cJ\x83\x10\xa1\xa4\x81\xae+6\xe2\xea\x87\x01\xe3\xe4\xd0\xd4s\xc1-\t\x8duӠ\xaakv͌\xb4X+%\xf8dF0\xd2\xfdĺ\u007fＸ\xfe\xb1\xac6\xad\x02\xca\xeb\x18\x00n|\x80?1\x1cb\xc4%\xae\xf2\u007f\xe2s\x03\xf0\x9b\xd7\x00$xKi\x85\xcf>\xfb\x12\xd7\u05ef\xfe\xbe\xf7\xd3\xd5\xc3\xfd\rbdӟ\xac\xc7-n\xe2\x10\"ƾG\x8c\x11\xdb\xdd\x06ژ\x85N\x9c\xe4 \x8c\xc2z%\xd9m=5\x8bUU\xc5\xc1?\x86\x031\xfac\xbfH\xcd*\x0fϓ\xfe!+\x03\xe7)\xa8\xaa\x1aPd\x18,\x16D\x9d\x1cؒ<͓\xecO\x028\xa2@\xe8\t\n\r\xe2>g\xb4\xca>\xdc\xc1\xfb\x95g8?f\xdb4\xd86\x1bibpR\xfc\x9d\x14\xff~\xec\xf1x\xdc\xe7ɟ\xc0\xcc\xdd_D\xb5\xd1Jc\xd3t9\xcc\xc8ǐ'\xa7\xe4A\xb0\x80?A\v\xe2;\xdf\xc4\x01:8(\x04xmAڮ&\xe29\xa6\xd6{\x97\x19\xffP\x1au\xcd\xc8\x03\x11\x13\x8cҁu\xbd\xbd\xc0\xef|\xf1}l\x9a\x8eU\x02\xe3\x11\xfb\xfe\x1e\xc7Ã\xac\u007fT\x9e\x8cKr\xa8\x0fl\xf1[Y]x\xf4sC\xe1C\xc8мV\x1aF\xb3\x0f\x805fq(g\xc2W\x86\x8f\xd3\xef&L:\xa01\xfa\xa3.r\nҴ\xaa\xd3T>:#1ͦ@\xaa\xdc</i\xa9)\x9f\x9e\x12:Ds\x92\xdc\xda\x1e\xb8\f\xbeQ\xb9\x80\x17\xf18Je>\xc3yk\xe2e\x13@Y\x1a8\xeb\xed矝\x85\x85F/u\xfb\xd083\x05\xea\x93\x1dx\xfag\x05\xe0)\x9a!\xa4O_\x93]Ӕ>\xbf_*O\xfdKn^2\x15ZN\xfc\xe5\u07ba\f\xfb\xe1]\xf8\x8a\x00D\xe7\xd0\f\x9d\xdf\a\xa34\xf4J~\xacd\xc7\xce\xf7\x8e^6Dk-\xa7RO\xed\xcbWO\x80\xe6\xf5e$\xb4m\xc32?粑\x98\x0f\x01\xd6h1&c\xa7\xcc@\x11m\xdbbw\xb1\xc34\x0es\x9cz\xb9\xd2R\x8a\x11\by\x98\x108b8I\xfc\xd2\x1b\xe5\x9d˜\x83\xf5\xf0\xf5\xad\x9f\xce\xde\x15Zk\xce\x00\\\x80\x82\xcf+\x80\xffEj\xf0\xff\xda\xf0?\x00\xec..\xf1\x83\x1f\xfe\xf5\x9d\xb5\xf5\u007f\xb2\u007f\xbc\xc3\xe1p73\xa7W_\x91\x80i\x9a0\x8e\x93\x14\xee*\x93\xbbP\xecB\xa3\xe8\xcd)R\xf6\xa0\xa6\xb3\xd0?\x9b\xe7h\xcb9\xd8C?\xe4ơ\xf4}\x87:\xe3FUf\xb5˵j\x8c\x15',/\xa14\xb4<\xf0\xa5 \xa4\xdc\xec\xca\xf0\xf4OŮ\xb2\xec\xc8!\x84\xb1D\xfc#,\xc5\xddD\x84\xba\xaa\xb0\xeb6\xd9\xd3@a\xe9Wｇ\v\x13\xfa\xf1\x88\xc3\xc0\x816\x89\xb40\xbb*~\xe48!\xf6\xfa\xef\x9a\x0eUU\x15v\xab\x80\x11\xf6o\"rj\xb5rSK\x93qp\x88nD\x8c\x1e\x13\f\xa2\xae\xd7\xc0jNA\x0f\xc1\xa3\x1f{f\x10\x03h,\xdb!\x13\b>\x88\x8e\x98\"^]\\\xe2o|\xefG\xd84\x1dB\f8\xf6\x0f\xd8\x1f\xefq\xdc\xef1\x89\xabX\xb9\aN\xcddr\x80d\u007f\x025O\xc0\xd2\\\xba\xb4\xf7L\xf1\xb8Z\xad\xa2\x95g\xbf\u007fuf\xd2\x03H\xac\x9a\x93\xda\x00yb\xa5ի\x8e\x02\xbf.v\xe3g#fi\xd5L.!\xf6\xf4\xfd\x91\x18.\xa5\x05w\xa0h\x8c\x97l\x90\x99E\x0ey\xae\xebaR\xe1t\xf2=3i\xd2yZ̙1\x18\x05\x12Q\"\bȑ8J\xab<1\x03\xf3ߗ\x0ey\xcb \x85S\xe2\xe0\x82,\xb7h\x97\xa8XK\b\xba\xb8\xf0\xfb\x99\xb9\x02DQ䧴\xf0\xfbO\x9f\xbfֳ'\xc3iHѪ\xa0%\xb7=#)\x80\xb4\x82\xfbS\x18W\xb6\a\xc6\"\xe8\xf9\xe93g\xf1\xc2\xcf|\x02\xe59Q\xe3b\xb7\xc38M\x18\xc71\xfb]\xf8\x18E.\xedYe\xe49\xcd\xef\xd5\xeb\u05fc\xe3\x17\xe3\x1f\xa5g\xb2_\x89D\xa436\x8aB)\x856\xf1\xdf\xfb\xe5\xf5#\u007f\x1ec\xc4ቩ~E\x8e\x8dx\xd6\x00\xfe\xe65\x00\xe9@5\xd6\xe0\a\xdf\xffk\xb8\xbe~\xf5\x1f;7}\xf5\xe1\xc3\x1b\xb80\xc3\xe6\xeb&\xc0\a\x87A&t[WІ\x0f\x93X\xd8\xc4\xc6t\xf8\x12!\xf8\xb8\bO9ݳ\x99\xcc\xfe\x1f\x86\x01\xd38·\f\x96\x86\x1etf\xe2X\xb3\x9dS\\\xaf\x8fl\x1f[~w\x86\xfe\x89\x1d\xe6\x14\x80\xa6\xae\xb9\b\x11\xf1dPj\xa3%\x8es\x18G\fc/\xd3\xf0\xf2\xa6\xb6\xd6\xe2b\xbb\x85\x15\"\x9dR\xa5\xb3\x1ek\xe4]p\x18\x86\x1e\xfd\xc4\xdeܙʐ ȏ\x1a\xd20\xdf\xe0jw\x89\xae\xed\xa0u\n\x05\"\xf1ʷ\xd0\xc6f\x03\x93\x93]+E\xf8\xa9G\xf0#\x02E\f\x04\x90H\xf8\x8a\xadn>\x94\xa3D\xefz\xef@\x00*۠\xa9\x1b\x00\x10\x14\xc3#\x12\xe1\xe5\xc5\x15~\xf7{?Bm+\xf8\xe8q\xe8\xefя\xc7\xcc\x19(g\xa4XLp1r\xb8\x89\xd5\x1aZ\x10&\xef#\xbc\xe7\x83kr|\b\x96\x03!{9\x14\xaf\xad4fy\xe2\xcd\v\x910H#\x11\xd7+\x0eZ\x96\xce5zu\x0e1\xa0\xd4̦\xe2Nec@\x19\x85XC\xd5t\x06\x06_X\x12dg\xc2em_\u007f\x92K5\x04=9w\xae\r\x8aTy\xf5\xa7FH\xa9\xe5RL-\xa1\xffe\x11U\xf3\x9e\xbe$ϕMCVG\xccΒ\x8b\xf7\xa8\xb4\"\xa2\xf2^\xa6\x02EY\xff\xef\xba\xf0\x17\xc5_\xab\x05wA\xad\xfc\xfb\xd7\xeb\xc5T\x9bS\x14\xf6\x82;T\xb8\x90f\x1b\xe9s\x85\x1f%\xe0GK\xb2\xe2\xa2A\xa33\x8c\xcd\xf9\xdd~\xf5\xe2\xa5L\xeb~N\xb3\x94\xf5(\xab\xf3f\x1f×\xaf^`\xd3u9\x8fBI\x80Q\x0e\x8eʪ\x00V\x14\x85\x10E]\x93|%\xe2\xcaxm~\x1e\xdfx\xf74\xd3H/\xcaRx.ӿ\x81\r@\x9a\xfe_\xbex\x8d/\xbe\xfc\xd1WJ\x99\xdf\u007f\xb8\xbf\xc1\xe3\xe3\xed\x93?\x13B\xc0\xb0\xef\xe1F\x0e\x04\xb2\x95\x85\xd2&\xb3W\x93{\x18E>,}!;\xf9h#\x92\\\xfa\xfa\xa1\x98@>\xdetfn@\xd1\x1c\xa4\xdd]\x90N\xb8\xbc\xf1\xf8\x86\xa0|`\x13\x18\xfa\xb7\xa6\x12&{1A\xcbٔ\x0e\xba\xe3\xd8\v\tfy\xb8\x1a\xadq\xb9ي\xe7>\x16{\xc1H\x9c\x9d\xe0e\x9a\x1e\xbc\x9b[i\x8d|3\xff\xa2\xdeZ\x11p}q\x85\xddv\xc7İ\xe8A\x92,\xc8\xc4I#\xc9~\xea\xa4|\x90\x9b\xe0\xc7#\\ppDpD0\xa6^t\xf8|P\xab\f\xfd\x0f\xd3\xc0\xa4?\x05ԶBӴ\x02U\x8a\xae\x98\bכ\x1d\xfe\xfaW?Be*\xf8\x10\xb0?\xdec\x9cz\x91\x85\xf6E\bT\U000a33f2^\x10\xc9bF*\x14|\x88\xbc\xa7\x94\xc9\u007f\x10\xa3\x92D\x02$\x9aS\x15\xcb\t\x10\nx\xca\xcc6\r\xb8\xbd\xf3p!\xaeЁ\xf2u\xcf\xff\x12WY\xf3e\x01_\xa0\x001\x9e4\xa2\xeb\xc2\xcc\xe69E\xa3Pp\x1f\x88pR\xf0\xf3\xb7\x14\xedJ9%\x97\x8dx\xa4S\x13\xec\xe5~~I\xd0[+@\x12YV\xd1:.[\xe1\xdcl\xab\x12\xa4_L\xdes\xc1\x9f\xd1\x02\x14+\xbaS5\xe4\x19\x04\xa4\x14\xe4PB\x02h\xf6-\\%\t\xe7עg\xe7\xc8\xe5\xb5p:\x80\xe7\x98\xdf4\xe1k\xb5h \xcb,\x0eUx\x19\x9c\xc7W\n\x88'\x96\x90\x05\x9d\xe9,\vV\xa8\xc0\xfai0j\xeb\x1am\xdb\xf2\x94\xbej3\xac\x99\xcf\x1f\xa5\x14\xb6\x9b-\xae_\xbd,TS3\xa9'y\x94$2p\x88q\x8e\xe1F\xb2b\xa6\x1c\x83\\^\x83\x1e\x84\x0f\x1fk\x00\x96o\xe4\xf8\\\xa6\u007f\xc3\x1a\x00\x86\xc1\x80\xb6m\xf1ŗ?@\xb7\xd9\xfe\xe3\x10\xa6\xee\xe6\xe6\xbbLr;w\xf8M\xe3\x88\xfe\xd8\xf3\xc5ZW\x02\x9b!w\x9d|@r\xf7\x1e<GX>U\xfcӤe+\v\x8a\x84\xfe\xc8V\xc2\xeb\\\x00\x9cch\xab\xe5M\x9e\xa7\u007fcA\xc4lXZ\xc1\xba̲\x8d\xb9\t\xb0ڠ\xa9\xea\\\xa4\x12\x11mV@1\xb4\xee\x84Hxn\xb2\xba\xd8\xeeе\xed\xbcc,\x8a?\xcb!#\xc6q\x80\v\x1e\xd0:\x9f\x1f\xebI\xedc\xd0\xff\xc5f\x87\x97\xd7/y\x9f'\xa1\"F\xa7`#]La\xc5\xcf\x05\a?\xf4\x18\xa7\x11\xa3\x8f\xf0\x91$\x8c\xa4\xe2\x15\xc2j\xefO\xc4$\xa5э\x18\xc7^\x18\xff\x16M\xddJ\xb2_\xc48\xb1\x12`S7\xf8\xf1W?@mk\xf8\xe0\xb1?\xde\xc19\xcei\x18\xc7\x01\x93\xd8\x04'\xf8\x9e\x8a\x89\xd9\x17\xdc\v\x9dߏy\x82r>\b\xb7\xa10\xffQ4\xefoW\xc5\x13\xea\x89Ր\xbc\xbd\x91\"\xf6\x93\xcb\\\x8e\xd3qy}~\x97S{<\xfby$V\xb6*\xebX\xe9FW\xde\a\x05\xeaJ\vL\x95\xce|\x06+čV(\x81\xec\xf4\xd7d\xc6\x12\xaa^\\R\xba\x94\xb9\x15\x10\xf9\xc7\x1c \xd5\nf/\xa2\xbe\xf3g\x90\xa6ֲ\xd3\u008c\xf00¶\x92\xf9\xadU\x00E\x16\x02-\x84\x80t\xea\xa5P\xc0\xf83)\xb8@\xdaV܁\x93\x89\xbd0\x04Jk\xb7\x84\"\x94o\xb4*\x02\xa3\x16\xfb\x9dՇG\xe7z\x03\"\xa8x\xa6\xf3Q\xf3\xf5\x0f\xb9\x8f|\x88\xb8\xba\xbc\x82\x91\x00-*\x90\x14\x95\xb9<\nU]\xa3\xaak\xbcz\xf5\n\xb6\xaa\x16\u05cc\xd6\n\xb6\xae`\xacM\xb6\x03ܘ\xc62\xee7fR\xcfZ\x19\xf2\xd6{|\x8cҧ\x96\xe8\xc7\x11\xcf\x1a\xc0ߜ\x06\x80%n\\@^\xbe|\x85W\xaf>\xfb\xb7\x8d1\u007f\u007f\xffx\x8f\xfd\xfen\xe14U\x9e`\xde9\x1c\x1e\x0f\x88\xc1\xc3\xd4\x06\xb6\xaa\xd8\xf8G\xe0\xa7\f\xf5\xc6\b\xef\x18⢏\\71FT\r[\aO\xe3\x88i\x9c\x16\x89\x80'gT\x8c'\xd3\u007f\tק\xd7\x14\x02'_a\x81`&\xf8\x96\x0f\x18\r\x85\xb6i\xb2ΟaM\xb3\boIr\xba\xe38 \x84\xa5k\xa1V\n\x17\xdb\x1d6mWd\x0f$\xb8;\xe4\x1d\xf98\x8dp\xd1A\x89\xbdq\x94}\xe6/\xf3ņ?->y\xf5)\xb46<}/\xa6\xe7t\x01͂+\x10!N#\xdcУ\x1f'\x8c>\xe6\x03\xd5\x1a\x8b\xda6\x8bb\x19i\x9e\xcaB\b\x18\xc7^v\xf3\x16M\xd5\x01J\v\xb1\xafG\x88\x01\xb5\xb5\xf8\xf1\x97?\xc0E\xbbE\x88\x01\x87\xfe\x01γ\x05\xb1\xf7\x1e\xc3p<a\x93Ǆ\x06\xc5\bM\xb3\x99\x0eT*\xfe\xb3\x83\xde\x14B\xe1\xfaH\xa7ӈJ\x93\xfa\x13'r!\x1dL\xd9\r\xbd\xf388\xff$\xd0B%\x0f}\xc1\x0f\xc1\x82\xe0\x87B\x8a\x98\x02q\xf2\xacGkG:\t~\xa1\xb8\x80\xbf\xa9x\xe3Y}\xa0V\x9e\x02K\x1f{:\xf1.X\xba\x0e\x02O\xa1\x01\xf3\xe4\x9bM\xa8r\xfct\x99\r\xa0\x16\x06:\xb90\xaaU\xfa\x9d*}\xf4\xf5B\xca[\xbeo\xf9\xffV\xd2ę\xfa_\x80\xffE\xc3T\xe6\x0f\xd0z\x82\xcfv\xe1\xaa$+,\x1b\x96\x93\xd2?\xff\x9c>\xd3\x18\xa8Bۗ\u007f\u007fY$\x89\xc4\xe5o\x8e\xcf=1[\xfa\xe8\xfaER\x0fC\x00\xc9YD\x91\x8d~b\x8c\xd8m6\xd8\xed\xb6\x8b\x86K\x17\xbc\x8b\xaa\xaap}}\x8d\xaa\xaepu}\x8dW\x9f~\xc2\x1c\x00\xc3\xd2CSW<\x80\x89?\v#\xae1\xf3\x9d\x92\xdcϋ\xd7?\x95\b\x14\x807n\xfa\x05Eb\xf1o\xf7\xeb-\xda\xf3\xd7\xff\xff\xbe\xfe\xd2U\x00ZvI\xdb\xdd\x0e\xaf_\u007f\x81\xcdv\xf7\x8fc\x8c\xb8\xbd}\x87Ha\x86\x87\vX3ƈ\xfe\xd8\xc3M#\xa0\x15\xea\xa6\xc9:\xf9\x90\xd9\xecE\xf1w\x1e\x1f\x8b\xf0\x8e1\xc2V\x06M\xdb \x84\x80\xbe\xef\xb3\xf9ι\xa3,C`\xe9`\xc4*\xf9\f\n\x95\xb6\x1coyBlQ\xe2\xff?O\x9aU]\xf3\xf4\x9f\x91\x03\x93\rm\xd2\xc1i,\x87܌\xab\x90\f\xa54.6[l\xdbN\xa0w\x9d\x0fR\x12\x18.\x12e\xe7<e,\xdbr\x06\x8f@\xf4Ѧ\xa8,\xfeuU\xe3ӗ\x9f\xa2\xae\x1a\f\xe3 \x92?\x95\xf7Щ\x11I\xeew\xd1{DI:\x1cC@\x04`ūܘ\x8a\xa7\xf9\xa2\x98\xc6r\xfbJ\x84~\x1a\xe0B\x805V\x12\xfc\x185\x99\xa6\x11.8T\xc6\xe2G\x9f}\x85W\x97/\x10(\xe28\xec1\xb9>K\xfc\x8e\xfd\x91\xe3c\xd5i\x11\xf41\xcaJE-\x0e\xe0\xf4y(%\x99\x11!\xcc\x16\xc62Eg\x96wj\xb0\"A\xab\xf3\xc8ЩG\x04\x1b\xa6\xdc\x1c\a\\5\x95ؼ.\x95$T\xe4\fd\x1bk\x91\u007f\xce\x01DeZ\"7\xbc\xc9\a\xa2\xcc@(=S\x12\xd9\xd1\xe4\xe2VX\xde&\xc5\t\xad\xf6\xc4\x04q/\x8c\x00\xe9\xac\x02\xc83>\x01O\xae\xa7\xcf3\xe0\x98@\x89\xf9\xde\xc9\xees\xc5\x1a%\xdfT:\xc1˜TYfW(\x8d\xbc\x87\x9e\x1f\x8f\r\x84PL\xf9\v\x9c\x8cfD\r\xaa4\x18\x9a\xd5\x0f\x05Y>{\x06d\x1f\xff\x8c\xcdc\xc1)Q\x1f\x87\xacg\x82\xe8\x19GME\xf3\xef\xcbͳ<\x0fE\xa7#\xfe|m\xb1\x19\xf2\x93܋\x05oC\x81\x82G\x94\xa8\xdd\xcc\xc5q<\x18\x18\xcdy#\xce9\f\xe3\xb8X\xd5\x10\x11ڮ\xc3g\x9f~\xc6C\x96\xd2\xf8\xe2\x8b\xcf1M\x13\x8e\x87\x03?oYߒ4ѳ\xcf\teD*\x06?\x9f\xcdEcy\x17\x02\xc6_j\b\xc9\xdf\xf3\xdds\x03\xf0\x1b\x82\x00$赩k\xbc~\xfd)^\u007f\xfa\xc5߯\xaa\xfao\xef\x1f\xef\xf1\xf0p\x93\xc3UJԎ\b\xa2\xf9\xef\x11\t\xa8\xea\x1a\xd6ڙ|\xe2S\x87\xcb\xde\x00\xceM\vH\xf3Lu\x83R\n\xddvÙ\xf0\xfd\x80\xe8\xe3\xd9⟟\xb3\xd2\xc5\x04\xa2\x8a\xdd%\x9f\xa0\x95\xb1lN\x13\x13\xf4_\xd8\xf7Jg\x9c]\xb6\xacŦ\xe9\xf2\xc1\xa0\vB\x91V\x9a\xe36%,gr\x93\x04\xa7\xe8\xdc<\xed6[l\xbaV\x0e\x11\x9d!\xf4\x18\xa3\x18!\x11&7b\x9c&\xc0\x18\xb8\x100\x88'7\xfd\x12ZZ\"\xd6\v\u007f\xf2\xf2\x13l\xda\x0e\x93\x9b\xe6\xe2/r\x9fE\xf1\xa7\x880\x8dp\xe3\x80~\xf2\xe8=O\xa76\xd9\x02\x1b\x8b\xa6\xedV\xfc\x04ds\x11\xfe|Y\xe1`\xb4A۴\x1c\xfd\n`\x9aF\f\xd3\b\x10\xe1\xabן\xe1\xcbן\x81@\x18\xc6#\xfa\xf1\x90\u007f\xe70rsX\xee\x9d\x13\xa3?\xe4\xc8\xe7ٜh\xb5\x92D$bYT\xe4UL20\x88q\xbd\xbf\xe7\xc2I\xab\xc9O\x15\xb1\x06F/U\x15J\x11\xf6\x93\xc3M?.-\xa3\xd5\x12\x05(3\xe4\x97\xd7\xcc\xe9\xb1\x18#\x15\x9e\xeeE#\xb1j,\"\xb1\x916\x88\xce\xc7,\xcfa\vR\x84Ua(4\ag\xcd>lt\xbe\xf0\xa8\x95Q\x8f:\x1d\xe6\x94a\xaeMB\xbb\xf2t\xaf\x97S\u007f\xbe\xd7\xf42\xf8(\xc1\xf0\xbaHc\x9a\xad\x90O\xaf\xe1S\xf90;4\xce|BZ\xda\x13\xe7߉\xd5\xf2\u007f~\xfc\xd3\xc6ge]\xac\x15GO\x17\xaf\xa9\xfc\x8cә\xa4S\xafC\xc5>?\xed\xf6\xcf|\u007f:ߐv셡μ\xb2\x10\xef\x02\xa5@ރR:\xa6\xbc\xc7<\x91O\x88!\xa2\xaa\xaa\x9c9b\nF\u007fR\xf2|\xf6\xd9gش-\x8c\xd6ش\x1d^\xbf|\x8d\u05ef_\xa3m\x1b\x91\x042\xaaF!\x82B\xcc\xdc\x1a*\x02\x9b\x12\xff*5\a\xa9\xb7\xf96\xb8_\xa5dD\x00?{.ӿ!\b\x80\x11I\xc9\xd5\xd55>\xfd\xec\xab\xdd\xc5\xc5\xd5?v~\xc2۷\u007f\x81\x10\xdc\f\xff\x13\x16p\xe7؏\x98&Ǧ?M-\x87\x88\xca\x17\x19\xc0M\xc24:\xb9\x9f\x9e.t!Ft\xdb\x0eUe1\r\x13\xdc\xe8\x9et\xc0[\xfb\x92S\x91\xa9\x96\x9ed\xa5\xd9\n\xd3\a\x9f\xe5\x863\x19\xab\x84\xfe\xf9\x80ض]V\x1d(\x99\xfe\x15f\u009fQ\x9c\xa0\x17\x890\xb81\xbb\x92\xb1U\xf0\x06]\xd3\xe4\x03)\x11\xd5\xe65\bat\x13F7\x02Fc\U0009e6c8\x9c\x8f\xf0\x8bzn\x96ƽ\xbe~\x85M\xb7\x81\xf7\x1e\xde;\tđ\x9b\x19s\xdc1E\x8f0M\x98\xbc\x97\xfdy\x84Q*\xb3뭱\xa8\xdbn\x19\xd3ZL\x04\x11t\x12䀹F\x040\n\x190ƈ\xcf_\xbe\u008f>\xfb> \u007f\u007f\x1c\x1e3[\xda\x05\x8f\xa1\xef\vc%~\xacT\xfc#-]\xe7 \x87a\xb9\xbe\t1\xd9\xf2\x8aC\x9b|:>\xccV\xbd%\xc9k!M\x17ϙD\xf9L.\x8e>\xc4\x05N\xf4\xf6\xd0cSY\xec*\x8b\xd2\xeb\xef)*F\xa0\b\x15U*\x15K\x1c^,\x98\x8d\xd1\xe2RYL\x8b\xa5DT\xbc0x\xbd$)z\x85\xa9O\x940\xa4\xe4\xc4Y\x96lZg\x06\xa0\b1,\xfe<q+P\xea\xe4\xfeQ+\x03\x1b-Y\x119\xfave\x92\xa3\xe6\x05z\xf1>\xa9\xec\u0588BRɶ\xfeT\xc4&\xcf\xcb\x051\"\x98\xa7\xf8ҕy\x9d\x95\x94\x00\x83\x82?R6~\xeb\x1c\xa05\xe9O\xa9\xb5\xa2fiĤ\t\xd0 \x18D\x96\xe9\x96n}T\x92.\x8a\xd8\xed\xc4\xef\xe0\x8e\x19\x11\xc4?+\xf1\xda\ta`\x1eT\xc04\xf4\xf0C\x9f\xb5\xf7\xe9\x9e\xd7P\x18\x8e\x03\xa6\x10`\xbb\x06\xc6h|\xfe\xe9g\xe8\xba\x0e\xb7\xf7w\xb8\xbd\xbb\x87\xf3\x0e\n\xc0\x8b\x97\xaf\xf1\xf9\xa7\x9f\xcd?\x1f#\xba\xcd\x06/\xae\xaf1\x8d#>|x\x9fW\x99\x91Hd\xae4\xc3\xff\x82\xd8\xc6\"t(\xfd9P\xc4C\xf8eI\xfd\n\x00y\x00\u007f\xfa\x8c\x00\xfc\x064\x00\t\xfao\xdb\x0e\xaf^}\x86\x17/>\xf9}\xa5\xf4W\xb77\xdf\xf1\xf4o\U00102393\x0e(?9\xf4C\x0f\x80PUu֣\x96ӿ\x9b\x1c\x86a\x9a;Szz\xbam\x9a\x1aM\xdb\xc0\xfb\x80\xfe8<q\b\x9f&\x94\x95\xde\xe0\xf3.~\xf6\xeb_;U\xcd\x01&s'\xbfiZ4B\x82K6\xb9Fv\xa4\xa9 \x95\xd3\xff$\xb9܍\xadе\x9d\xb8xa\xb5\xeb\xa5\xcc9\x98\xfc\x84a\xeaAZa\xf2\x1e\xdeK\xf1\xa7_\xae\xf8\x1b\xad\xf1\xf2\xfa%\xb6\x9b\x1d\xf3\b\x04R'\x9a\xf5\xbcL6$&\x19\xba\tS\b\x98<O\xac\x1a\f\xd5\x12\x11lU\xa3\xed\xba\x05\xb2B\x98\xf3\x14\x12;\u007fr<\xe1\xb7u\x9b\x89y\xd34b\x9cF\x84\x18\xf0\xf2\xe2\x12\xff\xc6\xf7\u007f,\x84\xc8\t\x87\xe3}.\b1F\xf4\xfd1\xc7\xcb&Țѐ\x88P\x18\xd8$Ԇ\"̈́\xbe\x04aJ\x10Re4\x1f\xb1\xd2Hx\xe1LX\x99\f\xf5zt\x17\x9bU#羗t;\xa35K\xaa\x8a=\x94\v\x01o\xf6G\xfc\xf0\xfaBБըC\x11\x86\xe6נ@\xe2xh`\nO\x9c\x14\x0e\x14c䕇\xd5\v\xb6;-\xcca\x13\n\xa0ah\u07b7\x97$[\xd6l\x9b\xc2(h\x99\t\xa0\xd6\xc6\x03yǬ\xe7\xa2Y\xd8ܞ\xaeB\n\x1f\xfbl\xbc\x93\"rKRݲ\xc1)KmʠW\xca\x00%\xe7\xa4@M\x88\x88\x1b&5\x17\x9c\x99#X\xc4#\x17?wv\xf5\xbc\xf0v\xa6\x8f\xa2\x99\x19,x\xca\xce\x17\x80&@Q\x80)\x9a|,\x92\x1a\xcb\xc2\x1f\x8187\x00\x94\x90\x81\x85i@\x10e<!:\x8f\xb1\x1fp\u007f{\x8b\x9bw\xefp\xf7\xfe\x06\xceMY\x82\x17\x03k\xfb\xc7~@p\x01۫K|\xf1\xa3\x1f\xe2\xf2\xfa\x12mӠk?ŧ\xaf_\xe3\xee\xe1\x01\xfb\xfd\x1eu]\xe3\xe2\xe2\x12\x80b\xf7>ō\xb4V\n\x17\xbb\v\x84\xc0Y$\xb77\xb7\xf01\xac\x1c\x04g\x8f\x8a(&[k\x9e\xc8\x1b\xe1\x04\xfc\n_=\x80\x9f\xbc\xbf\xeb\x9fI\x80\xbf\xf6\r\x80\x98I\xbcx\xf1\x02\xaf?\xf9\xfc\xab\xa6\xed\xfe\xf7\xc3pĻ\xf7\xdf\x14\xa6\xe2\x05\xe4E\xcc\xe4\x1f\xc6\x11\xd34\xa1\xb2\x16uSe\x980\x86\xc0{-\x1f\xd0\xf7\xc3\f\xfb}\x84\xf5o\x8cA\xbb\xe9\x00\x02\x86Ð\r+\x16\xde\xd32\xd1Qq\x06\xe4\xe2_N~\x98\xa50\xce\xfb\x93\xc7M\x8dH\x94θ\xa9+l\x9a6CmFkA<f\ue0518Z\x92\x1d~\x88\x01]Ӣk8!Pe\x00\x91\x01c6\x91\t\x80\x02&7a\x98\x06\x04@,\x83\xc3\x13\x9c\x84\xf3\xdb6\xad\x14\xae/\xaeq\xb1\xb9@\x8cA\x8abBM\x82\xec\xbe\xf9q\x83\x1f\xe1\x9c\xc7\xe4\xd9\xeb\x80\xdf\xc7b\x85PK\xf1Wz\x05]ϒ\xa0H\x04\xe7ٓ\xbci\xba\x19\xf6w\x03;\x00ƈ\x8bn\x8b\xbf\xf9\xa3\xbf\x8e\xa6n\xe0\xbd\xc3\xfex\x0f¼\x1f\xefǞY\xff\xc5n6Dv\xf2\v\x14\x17ȃ\xd2Z&\xa8\x04m+a/\xf3s1\x02Is\xb4\xaf\x99\x9b+\xc9\n\xc8&4%L\x9c\x90-\xa5r\n\x9d\x97\xf7\xbb\xd2\n.,\xb9\xfc\x8f\xe3\x84w\x87\x1e_\xec\xb6\xeb\xb99\x1f\xa2\xebB\x12B\xc1\x8bY5\x0e!D\x18C9\xe1.\xed\xfdS$ufg\xeb\xc8.}`w\xc0(\x8f\xa3\x8b\x89Mk\x93wФf\xb7<\x853A4+n\xc2Z\x9f\xb2\xfe\xdb\xf5?e3\x9e\x15\x9c>\xaf\x1a\xb2%\x10\xbf畁.8\x0f\xe9Q\xd4\xe2y\x14\xc9~\x14\x13\x933\a3\xa5fe9ïЌ\x02\xe2\xc9\xee\x9eJ\x9d\xd8\rq\xd3B\x05j\xa1\x17\xafPIn\x83\xa2\xc8\u007f*1\xe7\x8a4\a/\xe9\x14s\xaf\xd2\xcd\xc1C\x84\x14Ғp\xa82\x12Cp>\xa2?>\xe2\xee\xc3\a\xbc\xfb\xe6[|\xf8\xf6[\x1c>\xbc\x87;\x1c\x01\xe7\x80\x18`\xd5\xdc̎\x910:\x0fm4\x8eM\vw\xdc\xe3\x87\u007f\xf3\xdf\xc4\xee\xfa\x9a\x91\x1f\xa5\xf0\xe2\xea\x9am\xb4\xc1\x8d\xb3\xf7\x1e\xde\x05\xf4S\x8fa䰞JL\x84\xf0\xf9g \"\xdc?<d\xbeQ\x8c1\x9fs\xf3\xea*\xce; \x05\xef\xfc\xaf\x02\xff\x13\x00\xbc\a\xf0\xee\xb9L\xff\x9a7\x00iw\xb7\xdb\xed\xf0\xeaէ\xb8\xbcz\xf9\x8fA\xd8}\xf8\xf0-\xfa~\xcfҰR\xabK\x9c\x02\xe7\xbd\xc3Qd\u007fuSC\x89\r-\x00x\x81\xb5\xe7\xe2O\x1f\xf5\x8b\xd6Z\xa3ݴК\xbd\xfe\xbds\xab⿌#\xcdv\xe1Iw\xad\n;U\x81\xefRXFL\xddp\xd2!\xa7\x1d\x9d<\x1fk-v\xdd\x16\xc6Z\x98\xb4\xf7\x94\xd3N\x17\x93Pe*\x8e\xce\f\fݧ\xe2\x9fH]J\x99\"\x1c\x88Ms\b\x1c\x124L=|\x8c\x18\x83\xe7\xe7\x14\xe2GW!믋\xed%\xae.\xae2\x9a\x91\x1e'\x88\x17x2\xf9\xf1\xdear\x0e΅\xcc\xe2\xcf\xc6l\x04\xd4M\x8b\xb6mO\x8c~\xca\x15D\x04?\xf7\x18#g\v\x80Ö\xc6i\x14\xfb_\xf6\xf7\xff\xb7~\xf8\xbbض\x1b\xf8೯\u007f\x8aL\x1d\xa7\x01c\u007f\xccֳ\x89@\x17\x824/jƧW\xe1\"\x8bC)\x05\x03%\xbbT\x0e5\xd2yU\xe1CDC\xe5\x1aK\x9f\xa9[\nF\x01:J(JB\xff\x8d\xc6\x14bi>\x8b\xf7\xc7\x01۪\xc2U[\xaf3e\xf8\xb9\xe8\xb8\xe0\x10$\x99\xa2>c\xff\x1a尮\x93;\xa3|\xbf^R^\xa4yK\x01H\x98\xf5\xee)(Fl\xa7m\"\x1e\x92\xacAҴ\xae\xd6\v\x02\xb5(\x9ae+\xa0NV\x004C\xeaEQ\xa3\"\xb9P\x15\x05?\xa1\x01)/\xc0\x18Þ!\xc9ryA\xc0X\xed#\x8a\xd7Le*^&\x01\xcc{~\x85\x93݅\xac\x00p֞\xb8l$\x95\xbc&\x93\xeeG\x9a\xdb\x16-\x9f\xb3\x8e\xb1\b3\xca\xc1\x12\xf3\xc1\x12#7$\x89A\x1ffuIF^\xa4\xc9\x1b\xc6\x11\x8f\x0f\x8f\xb8\xbf\xb9\xc1\xdd\xdbw\xb8\xff\xf6[\xf4\x1f\xde\x03\xfd\x11&\x04\xd4 ti\x1d\x95^\xb8c\xa0 -\f)(\xd0q\x82\xfa\xb3?\xc5{Dп\xf9oa{u\xc9ȕH\xa6=\x05\xec\x1f\xf7\xb8\xb9\xf9\x80\xf7o\xbe\xc3\xcd\xfb\x0f\b\xc1\xc1\xda\x1a\xedv\x83W_|\n۴\xb8\xbc\xb8\xc08\x8e\x18\x86\x81\x13Weϟ\xad\xa8\x8b\x00\xa1\xf4\xf5^\x88\xc1\xbf\xe2\xd7O\x00\xdc=\x97\xe9_\xe3\x06@I\xa1\xac\xea\x1a/_\xbc\xc6˗\x9f\xfd\xad\xban\xff\xde\xf1\xf8\x88\x0fￕi\xab\xcc\xe6f[\xc9\x10\x02\x86a\x84w\x0eu\xcd\xfa\xf1\xb4+O\xf0tߏ\x82\x04\xa0ؿ\x9f\u007f\x0e\xed\xa6\x85\xb5\x06\xd38a\x1a\xa7\"%m>\xda\x12\v?\xa1\x11\tZW\x8b\xd8s\xc6\x02\x12s\xdf/\xa6\xffYΓ$f\xd6\x18\\n\xb6h\xaa*[\x98\x96\x90ib5W\xa6ʹ\x03\xde{XS\xa1\xb6\x16Q&Yk+\xa4\x99-u\xde*\xc1\xfe\xe3\x00\x17#3\xfd\x05\xf2\x8f\xbfd\xf1'\"l\xbb\r\xae/\xae\xb3\x85\xf1L`\f\xb9\x19 %\xc5\xdf;\x9el\x17\x9am.\xc2M۠i\xda\x15X\xcc\xc5?\x16\xc8N\xb2\xe6\xcdLv\x9a\x8b?\x88\xd0Y\x83\xbf\xf1\xfd\x1f\xe1b{\x01\x1f\x02\x0e\xfd#\\\x18\xb3ׁ\xf3\x13\x8eǽ\xf8\a\xcc\x05*\xc4\b'M\x97Q\xa5\x13\x9c @\x85\xd1J\x88\x8c\x1e\x15Q:\b\x91\xbf'\xc9K\x99\xf4\xc8k\x80T\xd8\xf4\xaa\x99\x90z)\xe8\x00P\x1b\x05#V\xc0\t\t\x98\xe2\f\xab\x87\x18\xf1\xe6p@W\x19\xd4\xc6,\x89{\"\x17UF\x94'$\xfb]\tCZO\xe1$\xea\x85\x10\xa3\x18kQ^\x81\x94\x84\xda\x10\x83\x843\xcd\x13'\t' 7\x0e1r|\xb0( \xa0\xd6:\x17:\xd9k\x9f\x18\xf1\x9e\xe8\xf91WRE\xcb\x06\x023\t_)Jn?3\xd9V\x102\x9d\x82\x8d\xd2\xfe^\x97\x1a\xfe\x95\xd9\x0f\x95\xbe~\u0091!u\x96\xbb\x80u\x82\xa0*\x96'e\xacp\xba\x8a\x13IN|\xfaU\xb1R\x98\ru\"s\v\x88\xb2\xfc\x91ʭ\x91\xa2\xbc\xd3O\xd28>R⒐H\x9c\xdd\xf1p\xff\x80\xf7\u07fd\xc5ݛ\xef\xb0\xff\xf6\x1b\xf8\xdb\x1bP\xdf\xc3Ā\xad\x90\x0e\x13b\xa9\x8a\xe7\x99?w\x00\xa1Lj\x04@ӈ\xfeO\xfe\b\u007fq{\a\xfa\xec\v4m\x83\xbai\x11\x89p{s\x83\x0f\u07fd\xc1ݻ\xf7\x18\x8e{\xe8\xc89$\xc1h\xf4\xda\xe0\xfd\xcf~\x06նP\x9b\x96\xddy\xb4\x16\xc4mV\x1b$d6\x96\x19\x15\n\xf8\xe6\x17I\xffNOm\x02\xe8\x0f\x01\f\xcfe\xfa\u05f8\x01К\xf7\u0097\x97W\xb8\xbaz\x89\x8b\x8b\xeb\xff#\x00\xf3\xe1÷\x18\xa7\x01u\xd3\xcc\x17~L2\xa8\x00\xe7ؙ\x8f3\xe0+\xde9\xcat\xe0C\xc08L\xf0\x02)\xfd\xa2\xa4\xa8\xaa\xa9PU\x16\xde{\x8cø$\xf6ъ𗊿\x90\xea֦Dl\x84û\xfb\xb02\xfcAr\xbe\x12\xed\xb95\x16\x97\xdb-6m\x93\xf7\xc5 \x95\x8bzb8\x1bma\xadͻ`\x10\xc1j˓\x9bѨ\x16\xc5?\xe6\xfd\xe68\x8d,\xf7\x8b^\x1c\xed\"G(\xc7_^\xeb\xdf4\r^^\xbe@]U\xf0~nx\xa2 ,I\x8e\xe7\xfd\x84\xd1;\t\r\x99\xa7;\xa5\x14\x8c\xb2h\xea\x1auU\xaf\xf6\xdaJ\x0e\x03,\xc2@\x88\xe6\xf7\x99b\xccl\u007f\xad\x81\xc6Z|\xef\x93\xcf\xf0\xea\xea\x15g \x8c\aL\xae\x97\xb4B\xb6\x03>\xee\x1f\xe5\xf3\x99w\xc7\xeceλG\xb6\x87.&;\x8as\x96\xb9p\ab$\xb6\xfc-$\x811\x12\x9a\xca\xf2k\x96p\x94L\x10\x94\xa4\xc8D\xca\\\x02ϼ\x06\x88Z\xc1\x00\xa8\x14\xafj]d(\x9a\xa4!Hү\xdey\xbc=\xf4\xf8\xf2b\v\xbd\x82ؓ\x04p\xae*j&Y-Bn\x12I\x8f\x15\f\xa5\x87E\x89\x02(\x9a9\x061\xcezo\x10q\xc1\x17\xc8\x1aP\b\xe9\xb1sC\xae\x16h\xbfZ\xb5\x03K\u007f\xfc\xa794sT\xae\xac\xbc\xd4\xfc\x1cH\x03\x11j\x16\xb8%\xe6z\"\f\n\xc1\"5D\xeb\xcdA\xc1l\xe0\x15\x0f-\r~3ea\xcd\xfa;Y\xfe/K\x0f\x14K8St\xb1\xc9\x06>8\xdb\xfc\xa8\x84\x02Ƚ\xa9\xcaD\xc4\xe4\x0f\x91\x8d\xca(\xfb7\x94+\xc3\x18\tn\x9a\xb0\u007fx\xc0\xed\xb7o\xf0\xed\x1f\xff\t\x8e\u07fdA\xd5\x1f`\xa3G\x95\x14\x11zI\xd5\\sy>j\xef){}\xbc\xfd\x1677w8\x8a\xd3\xe6\xe3\xfe\x11a\xe8Q\x89\r\xf6F\x9bE\x1c1\xfb\xfa;\xec\x0fG\xec\xdf\x13\xb0\xdd\xc0^l@\xda \xf8\x00S\xf0o\xe2j\xfa\xbf\xf5\x01ӯ\x1e\xe6\x13\x00\xfc\xcbg\x02\xe0\xafq\x03\x90\xb4\xf5m\xdb\xe1\xea\xf2\x1a\x97W/\xff\xbe\xad\xeb\u007f\xfb\xd8?\xe2\xe6\xe6;\x18)zH6\xb9\x90\xe9?F\xf1\x83\xf7\xa8\xebJ\x8a#\xe7\xce\xc7@\x18\x86\x11\xe38\xe5\x82\xfc1\xab_[Y4M\r\x8a\x84\xb1\x9f\x10\x02\x9d\x98v-BG\xa4\xf8\xc7U7\x9d\x1e\v\x04\x18c\xe1cX\xf8f\xa7\xbdv\x82\xc2\xea\xaa\xc2n\xb3\xc1\xa6i\xf3\xefVB:\x9b͐4\x8c6\xa8\xac]<F\"\x8fi\xadP\x1b\xd6\xe2\xb2\"\xc2'耥~n\xc4\x18\xbc\x10\xde\xe2G\x9d\x0f\xcf\xed\xd8*[\xe1\xc5\xee\x1am\xb7\x91\x1d$\x17\x92H\x9c\b\xa6\x94F\x8c\x01\x93\xe7f+\xc8$\x9bH\x98P\x1cf\xd4\xd8*[;\x17g^\xb1\xe3\x8c\xf3\xa1\x97s\x1b\xf8u\x8ec\x8f\xc9O\xa8\x8cAW1Z\xf2\xf9\xab/\x18\xc5\xf4\xd3B\xee\x17\xbc\xc7\xf1\xf0Xx\xf5+\xb1'\xe6i>\xa4]>J\x83\x1aZ\xb8$\xa6\x83v\x14\x8d2\x13\xf6b\x1ez\xd3\xe4\x1c\x8a\x95\x94\xf7\x81\x11\x9f3\xf5\"\xbd۶Է\x13!k\xb6#\x01\x9a\xaf\vO\x94\xa1\xee\xdb~Dc\f^\v'\xa5H\xe8=\xcb\x05\x88\x14\xa1\xc9,\x88\xe3\nX\x10\x02\xb5\xaer#\x9aP\x80T\x10B\x8c\x8c\x02\x94\x85=\xef\xe2g\xfay\x10\xe5üc_6\x8c\xd9\xd8G\x15\x13ga`U\xe6З\xc1>\x9cp\xa7\xc5\xf2z6\xc89\xb7Nɻu\xadŗ@ȉ\x1a\v$ \xa7\x19\x16\xaf\x85xǔ\x9f_&\x03\xaa\xa4\x9aYJ\xf3\x16,\u007f\xb5\x843ҿ\x1a(\x98\xd4\fDi&\x90D\x06\x8b\xd1=\u007f8\x11\x04\x15Vy\fŽ\x191\a\x95\x05\xef0\r\x03\x86\xfd\x01\xf7\xdf~\x8b\xbb\x9f\xfd\f\xfdw߂\xf6{\\\x82\x8a\xb4J\xa0L$%qD\xa4\x15i1\x05k\xf9\xa2\xb9\\\xa34J)\xbc\b#\xc6c\xc0\x9bC\x0f\x9a\x06\xec*{\"\x87.͔\x8cҸj\x1b\x18\x1fp\x18'\x905\xa0\xae\x9d\xe3\xcbW\xa4\xe7\x84\xff\xfc\xc54\xfek\xd4\x0e<\x12\xf0\x87\xcf\x04\xc0_\xe3\x06\x80!<\x83\xeb\xabkl\xb6\xbbz\xb7\xbb\xfc?(\x00\xb7\xb7\xef\xe0\xfc\x84\xaa\xb2\v\xf8.\n\x11\xc69\x8fa\x18\xb3+UyU\x8c\xb9\xf8S&\xa0<\xf5e\x8cF\xd3\xd6b\x13;I\x00\x06\n\x03\x12Zj\xc7A\xa0\x18\xb2<j\x9ewf2`e,\x1f\x94YC\x9d\xc1\xdb\xec\xc6VU\x16\xbb\xcd\x06mU\xe7C1\xbbf\x89\xce\xde\x18#Y\xf4\xa6x\xecY7\xab\x94\xe2\xfd\xb8R\b\x14\xc5\xd1K\x8a\xbf\x1f1\xb8\x01Cp\bA\x82\x87$r\xf3\x97\xfd2\xda\xe0r{\x81\x8b\xed\x054\x14F\xef\xb3\xcb\x1ae\xe7\xc1\x88ɏL\u058b\xb3\x03b\xda\xd5V֢\xb2f\xfe\xb9\xf2\x18\xcaq\xc7\f\xb1\xc7\x1c\xc6#\xc5?F\xf4\x03\x9b\xf7tU\x85\xae\xb2\xa8\x8c\xc6篾\x80\xb5\x15\xaf\x80\xc6}>\xbd\x82\x0f8\x1e\xf7p\xce/\xae\x87(p\xa9\x8f\x11Iz\x9d\xc9ӊ\xc4|G\xe5\xcf4JP\x93\x0f\x11\x8d\xb5\xd9\x191O\x91º\x0e\xd2d0\xc7\"\xa2\xa9\xe9\xacK\xa4\x92QEA\xf1\x0e]\x9a8kh\x1ed\x88\x18\xee\x0fܤh)\x00\xef\x0eG\x18\xa3\xf1\xb2m\x16\xa4\xb4\"\x95w\x9e\x1ci\x96\xb8\x9d3\x1f\x0e1\xc0D\x93\x1b\x98H1Y\xe9\xcc\xc5=\x06^\x83(\xccӴZ\xee\x14\x12R\x90\x13\xdf\xcaݹ\x92\x1d7\x15q\xc1\xe9߳jO-\xbd\xf3Kߌ\\gU\xf6\xc7OfVI;\x9f\xc2~L\n\x97\x8a\xf3\x16!\"\xc2\xc0H\xb1/\x1e\xa7DEDip\x12\x82T\xc0䐵\xc0\x9a=\xb0p\xd1%n\xe1\xb4b##-a>\xd38\xe0\xe1\xf1\x11\xb6\xaa\xd0m\xb7\xb0\x95\x15\xa6\u007f\u1f7f.\x9e\x98C\x9b\x9243\xb8\t~\x181\x1d\x8f\x18\x1f\x1f1\xdc\xde`\xff\xcd\xd78~\xfb-\xe2\xf1\x88J\x06\x1e\xb5\x9a\U000d3b0f\x12\xda\x00 *\x85\x00\xc0\x83%\xb41\xc3\xfe\xf4\xb4\x03\xa5\xc8\x12_Є\xc9j\x90n\x16\xe1f\x89\xa3\x11\xe2*\x8eO\x01\x1bc\xe0\x9c\x87\xefGD\xa5\xb3\x8d1\x85\x98M\xcf\xd2\xd71\x06\x1c\xf2y\xfa+}\xfd1\x80\x9f>\x97\xe8_\xd3\x06 \xed\xcc6\xdb-v\xbb+\\]\xbd\xfa\x8f\x9a\xb6\xfb\xddq\x1ap{\xfb6C\x8dyb\xa1(\xack\x8fq`_\xfe\xaa\xaa`eO\xaa\x94\x86w\x1e\xfdq\xc8\x01\x13\x1f\x83\xba\x95R\xa8\x1b\x96\rz\xe71\x8dә\x8bP-\xc8JQ,t\xf3\xda2O\xbb\f\x89'?\xf2\x10Wv\xb0\xf2\\\x14\x14\xea\xcab\xbb\xe98\xa0G\xccz\xa0\x18\x9e\xf41\xa26V\xf4۬\xedO:nE\xac\xfd\x8eBB\xb3)\xe6XV\"iPq~\xc4q\xea1\xba\x89\xe1i\xd9\xf9\x83藖\xd8h\xa5\xb1鶸\xba\xb8\x865\x96w\xef\t\xfa\x8fs\xe6\xfc\xe4F8?IQd\x8aSҹ[k2;\x9d\n\xdf\xf8\x88\xa5\\\xae\x9c\n\xd3Z%\x05\x14)\"\xec\xda\x1a\xb55@\x8cxq\xf9\x12\xbb\xed%\xab \xa6\x03\xbc\xe4BP\b\x18\xfb\x03\x9cs\x02\xcd\xce\r\x9b\v\xbc\xfeP\x855sȆ+s\xf1Ok\x02\"`r\x01\x9550F\x81\xc2쿢\xc0\xe4\xd2\x14\x10\x94JK\b\x11\xdeGT\x959\xdf\x00\x10!\x00h\x92\\P\xcb\b&\x138\x01P\x91U\xeaS\x88\xa2Wg\xd9\xe0w\xfb\x03\x8cҸn\x1b,\xa4m\xab\xff\x87\x84\x19\x95\x9c\x87\xe5\xfe\x9b3(\xb4\xae\v\x05@\xe2=\x90p1x\x9da\x94\xc9\x0e\x8e\xd9ۂf\xe9^\x88\x11\x9aD\xaf.+\x8c\x12\x11\xc8\xfe\x83\x8aU\x05\v\x8b@\"\x90*\xf6\xe4+]\xfd\x12;g]{\x8a[Fa\x9f\x9b'\xf7Uqά\xfe\xb5\x1fg\"+\x12g\x81\x10),\xaa|27\\\x95\xfae\x92\xdf,\xdd3\xa9\xf0\xa7&\xc0\x18|\xfb\xf3\x9f\xe3\xe7?\xff9\x941x\xf1\xe2%\xae\xbc\xc3\xc5n\x87\xba\xaa\xb2\xfa \xe7\x85`\x96\xba\xa6\xe6\x97bD\x18G\x1c\u07bf\xc7\xe3\x9b7\xe8o\xdec\xba\xbfG<\x1c\xe0\xf7\x8f\xa0i\xe2sA'\xff\aZ;\x15/\x14\x15t\x02\xfdg_H\x14n\xc3O\xae<\x86@\x18)\xa2U\xc0\x94\xcc;\x8a\xc1\x88\xef\xe5U\x16\x84\xfc]g4\xf6!@\x8f\x13\xb0\xdb\xe4\xb5\xe7\"\xfe\\)\xfc\xa4?\xfe\xeb\xc2\xc7\xff/\x10\xee\u007f\x89B\xf3+\r>\xcf_\u007fI\r\x80֬\xdd}q\xfd\x02\xdb\xed\xee\xf3\xab\xebW\xff1\x01x\xff\xfe[\x8c\xc3!\xc3\xff\xd9PB\xcc#x\xfa\x1fd\xfa\xb7\xf9\x00\x01I`O\x8c<\xa9\x84\xf0Q\xb8\xbb\xaa+Tu\xcdA2\xe2\x11\xf0T\xa3@H\x93w\\\xba\x8f\xa5\x9d\xaa<\x96\x11\x83\x9e\xd3\xc8[\xbeq\x9a\x9a\xcdz\x18\xb6\x9f\x13\xe4\b\x02\xd1*-\xaf[\xa4\x80⪕\x8e\xe8\x10\x04\x966F\x8aqd\x99\x9f\x9c\xba\xa3\x1fq\x1c{\f\xd3Ķ\xbe\x14\xf3\xda\xe1Wi\xcc6m\x87\x17\x17/\xd0\xd6\r&?r\U000e2d68\x17\"\xb4\xe6\xf4\xbdq\x1a\xc5^Wg\x970\xab\xad\xa0\x16k\xbf\xfa\xa2\x99*nJ\x86\xb2#\xef\x1dA\x98܄q\x1aQi\x85MS\xc3h.\xd0]\xd3\xe2ՋO\x04\xfa\x1f1N\xbd4@\x11c\u007f\xc4$\x0e\x8f\xf9p%\xe6\x82\x04\x1f\xc4Av&\xf2\xb12AC\x9bB\xa7,\xcfo\xf2\x81\xc3L\x84\xf1\xee\x02\xc7\v\xa7\xe6.F\xc28\xf9\x85\xb7>\xa7\x11\x06TU\xc1\x03(\x88r\x04`\x88\x11\x1bcx\xbaW\nV\xf3{S\xc9\xdc>)\xe4*4IQ0\np!\xe2\xbb\xfd\x1e\xd6h\\\xd6\xf52\xb6\xb7|\x9c\xc4\x030\v\\*_\u007f\x89'\xc06\xc1f\xf1\xf7\xf3N^\xf8\x02J3ѐ\x00\xa5\"\x88ti\x95\x9f\xbf\xaf6s2\\^ϓZz\xe7gNAឧ\xe6\x860\xedóӠ\x94.%\x8f\x99T\b\xact\x98\xff9ݗي;G;\xd2\x02y(\x15sK\xe8\xbap\xd59a\xfe\xab\xa2\xa0\xa5\xa6J\x14\x0f(\x9c\xfa\xe47\x8dÈ?\xf8\x83\xff\x16\xffݿ\xf8\x97\xf8\xf1\xff\xe6o\xe0\xd5˗\xa0\xe3\x01\xfb\xfd\x03\x9c1\xd8\\\xecPow0U\x05\x95\xde{Yg\x92(K\xc20\xe0\xf0\xf6;\xdc\xfe\xe4'x\xf8\xf9_`ꏀ\x0f\xb0\x10ɠV\xbcs?)\xe92\x9c\x9c#+`\x96\x1dj(Du6\x01\xf8\x14җf\xb2\x8f\x11\x8e\xa4a\xa6\xe5\xefO\x8a\xa6\xb8j\x1a\xd2wTZ\xc1F\x05/\xd7f\x14\xf4\xb4<\x8bB\x8c\xf8\xe0'\xd8\x157\xe8\x97\xd9O*\xa8\xff绻\xa3\u007f.ѿ\x86\r@\"\xfdlw[l6\x17\xb8\xba~\xfd\x9f\xd4M\xb3;\x1e\x0f\xf8\xf0\xe1M\xb1\x17,\x9c\xa3d\x8f9\xf4\x03\x82g\xbbJS\xec\xc6\xdd\xc8>\xf3J\x0e\xe3\x8f\xee\xfd\xadA\xdd\xd6\x12\x0f;\xe6\xec\xeb\xb3\xcd#d\xb7N\x80V\x06\xda`\x9e&\x00\xb9\x89c\xf6\xbf^\xdf~\xc9沩,\xdbkZ\x9e\xea\x8dt\xd4\xecv'\x8cp\xc3\xe9YZkf\xc0\x17j\x80\xe4jh\x84\xf9\x9c\\\x10\xd3a\xdaOC.\xfe\x89y\x10C\xfc\x95c\xb2ں\xc5\xf5\xc556]\x97Q\x17c\x19e\ty%B\xe8\xc7\x1eNH\x96Z8\v\x95\xad\x16\x129*\xa7>Hf\xf8,\x97ȯ\xc3M\x0e1\x06\x8cӀ\x10<\xb653\xe0g;d\x8d\xcf^}\x81\xbaj\x10\x82G?\xecs\x92\xd9\xd8\x1f\xc4\x12\x99\x16\x1b_\xef\x03\\\"\x02\xea\x15\u007f\"\xb9\xdb\x117\x04\tb\xf1҄T\x869%\xce{\xd6H+\rS\xec֝\xe7&h\xe1\x1e\xb7(\xfa\xa7\x91\xb5S\x8c\x98bDkL\x96\x89i)\x96\xb5\x10\xd9\\\x1a\xc9\x02X\x1e(;\xf5I\x9a\x80\xfa\xf2\x12meg?\v\x8a\x88\xa4\xb3g\u007f\xce\t0\xcbM~I\xfcK\x8d\xa6\xd6J\x8c\x80H\bZ4[$ǐ\xadx\x89\x18ކX\x1c\xab\xc2EЫ\x88Jk\xcc\v\xb3y\xa8.\x12x\xb1\xa8\xf8Ys?\xff\x8c*\x99\xf6ī\x84\\\xd4U\xb1F#\xbd(\xccy\x17RH\xff(\xef\x96W\xee\x03\xab|\xa6\xf4ڕ\xa6\xa2\x01\xc1\x92\x95\xbf*9\xba\xb8\x96\b\xc089\xbc\xfd\xf6\r\xfe\xe0\x9f\xff\v\xfc\xb3\u007f\xfaOQ\xd7\x15\xbe\xff\xa3\x1fb<\x1cq\x1c\x8e0D\xd8\x0f#n\x83G\xb7ݢ\xb9\xb8\x80mZ^!y\xcfR\xe6a@\u007fs\x83\xfd7\xdf`\xbc\xbdA\x9c&\xe6A\x88u/@lXu\xc6?\x81P\xca(\xcbE\x87\x12/\xa0\xe4\x808\xcb;\xa1\x9e\xb6/*]\x1a\xb2R\x02\x94]\x88\xe3*6j1\xea\xac\xf8 ,}\x95\x06@\xae\xe5\x18\xa8HLU\xf8\xd9q\x9f\x9f\xfb\xaf\xf8\xe5\x94\xd6\xff\xc3sy\xfe5m\x00\xb4ְU\x85ˋkl6ۿuqu\xfd\x1f\x82\x80\x0f\x1f\xbe\x85\x9bz\U000537a7\x9c\x04\x1d9\xe7r\x9c+\xfb\xfd\x8b\xff\xbc\x0f\x18G\xf7\vI\u007f\xc9O\xbfi\x1bh\xa51\x8d\x13\xdc\xe4\x9e.\xfe\xe2\x02\x97\x9e\xb3\xd2s2H\x86\xef\x84)\xae\xd5\x1a\x84\x9bo\xc7\xc6ZtM\x8b\xdaְ:\x11i\xb8kO\x96\x9aiﯤAI\x87q&\xf8\t\x93\xdbh\xb3\xf04PP\x18܀\xc7\xfe\x80ѹ\xbc_\xa7\xb8d9\xff\x12\x11?h\xea\x06/.\xaf\xb1\xed6\xd9mP\tA.M\x8aZ+\xf4\xc3\x01\u038d|\xb4(&)VU\"#\xaev\x92\xc5\r\x9f\\\n\x93\x8e;\x85\xf9\xb0o\xc0\b\x83\x88MSe\x02\x98\x91\xc9\xeb\xd5\xf5'\xb8\xdc]J\xf2\xdf\x011:61\x19\x8e9\x8b\xa08\x0e\x05\xf6\x17\t_f\xbe\xcfP\xbe\x15\xe9X\xa0R[\x9d\xa4\x87:C\xfd\x83\xf33~Q\xe4\xebf\x12\xa3Z\xed\x8e?\x82:F\x00}\x8ch\xedl\xa8\xc3\x0e~\n\x8a\x14\x1a\xadD\xc3\x1e\xf3\xcf:Qth\xa508\x8f7\x87#\xbe\xbcء\x96&\v\x91\x10 \xd7EY\xe8K\x96\xbaZ\ue635\x02B\xf4P\xca\xe6陛\x80\xac\v`\x88\xbf\x94\x0e\xa6+-\xbb]\xca}\x17#Lj \x9eؕ/o\x05\x95=\x83\xcb>\x89J\x82`9\xae\xd3,룄\x14,T7t*>\xcc\x01?K\xcf\xc3\xd2\xdd\xf1\\\xf2\xb2^\\\xb3X|\u007f\xf9,\xd3e\xd0\x0f\x03\xfe\xe4O\xfe\x18\xff\xec\xbf\xfa\xaf\xf1\xd3?\xfdS\f\xe3\x88q\x1c\xf1\xdf\xfc\xd7\xff\x14/\xaf\xaf\xd1)B\x03\x85\xd6h\xec\x9a\x1a\x15\x11H\x9c\xf2\xa2s\xb0im'\xefu%rhn0=\xc6\x10\xe1\x89`\x15\xe7fx\"\xf8\x98Ȣ\x80#\x82\x8b\x84\x00\xb6\xb3N\xc4\xe1\xca\x18T\xb2\x82\xdbX\x83\xad\xd6s\xb1>\x17eL\xcb3\x0f9^\x19\xb0\n\x98\"IH\x98pg\x16F\xd5'\x1f\xd7\xe2\x17\a\xb9\xe6TR \x15)\xa8\x91\b?\xed\x0f0u\xf3\xafSB\x8e\xd6\xd8\xdb\xe7\xf2\xfck\xd8\x00\xe4\xdd\u007f\xb7\xc5\xc5\xe5\x15^\u007f\xfa\xc5\u007fZ\u05cd9\x1e\xf7\xb8\xbd}\xbb\xf0k\xc7j\xfa\x9f\xc61;\x99\x19k\x01R\b1`\x1a'!\xd0\xcda4\xe7\xd7F\x9ccm\xacE\fa!\xf9;?\xf9\xcf\xc16Z\xa9UxH\x82\xadUv\x86;=\xf1\xc0\xec\xf5\xa6AS\xd7l\xe4S\x84̈́\xc0\xceZF\xeb\x8cf\x18#\xbbs*a\xeb \f\xf4\xe4@'\xc5_k\x8cӈ\xfb\xc3#&\xef3\xb1.\x9că\xfe\xe2\xf2\xdf\xd6-^쮱\xed\xb6\xb0U\x85i\x9a\xe6\x15\bED\xb0\xe3\x9d\xf3#\x8e\xe3Q<\f*\xd4U\x03c\xaa\xac`X\x13\x89R\xf3\x94CZ\x88\x9b\x88\x10#\xc6q\xc4\xe4FPph\xadB\xa5\xabL\b\xb3Z\xc3(\x85\xcdv\x87W\u05ef\x19\x8e\xf7#&7\xf0\x9e~\xec\xe1\xdc\xc4{\xfbLL\x92\xe2\xef\x99KP\x06\xfbD)^\xfc\xfe\xaab\x8a\x9e\xd1\x01-\x06L1\x12\x06\xe7\xb33\xa0\xd5\x1a\x95\xd1p\x05\xa2\"\xaa\xbb\xd5aJ\vn\xc3B./(\x80\a\xd0h\xc3P;\x14Hσl\x9dR\x06\xe6\x8c\x1d8Y\ah(<\x0e#\xdej\x85/v;\x185\a\x12\xf1\xd4_\xc6(Ghh\xb1\xd5-\xd3\r#@&\xdb\x1b\xcf\xca\x05\x12\xe9\xa9\xceMT\x92\x0e\xa20\x03:\xb1\xc0\x16\x9f\v]ׅ\"\x80\x8a \x1e\x95拾\t@\xabs!\xf9\x0e(q_ЉC\x00U4\aEG+\x9e\x04\xa5\x1b_\xb2\tW\x98\x89|뮄\b3\x87\xe1d\xfaU\xa7&@4#_\n\xec2\xfa\xf5\xcf\u007f\x8a\u007f\xfe\xcf\xfe\x05~\xfag\u007f\x8ei\x98\xb2\x01\xd1\xed\xcd-\xeenna\x8cF\xad4\xacVh\x8c\x81\xd5\xfc\xb9&$梪\xb0\xb1F^\x96\xcaV\xd1A\bā\xe6\xa6\xd4\x13a\xf0\x01}$\xb8\x10\xe1(\n\xf9\x8e\xb2\xecTav\xa24Z\xc1*\x83\xba2x\xd1u\xb8\xe8\xd8&\\\x99\n\x1bE\xd8\x11\xaf\x16\xf0d\xf1惿Q\no'\x8f\x89\"Z9\x9f\xd4G\xd7\xf2E\xc3K\xc0\xe8\x03\xa2r\xd0Jeð\xf4M\u007f~|\x84\x03\xc1\xaa_=oN\x01\x1f*[\xf5\xbf\xf8\xfb\x14\x9e\xb7\xff\u007f\xc5\x1a\x00mx\xf7\u007fyy\x89\x17/?\xf9\xf7w\x17\xd7\u007f\xc7\a\x8fo\xbe\xfdsLn`\xe8\xbb8\xc8(3\xff\x1d\x17z\x10\x8c\xa98\x1b\x80\bn\xf2\xb9 ~\xcc\xd6V)\rc\r\xaa\xdaf\xe8?Y\xfd\xae\xaf\xe0\x05\xb1.\x05\xeb\x94\xec\xddT\xfce\x97|\x12u\xaafo\xf9\xb6i\xd06\xed\xaa\xf8ss\x11\xa2gv\xb8\xb5\xc2PW\xf3\xa1\xac\nҒ\xf0\v\xd2{\x92\x9a\x94ɍ\xb8=\xdcc*V\x18'\r\xd0/\xda\xff+`\xd3t\xb8\xda\\\xa0\xeb:tm\a\xefe\xef\xadM\xf6-0\x9a'\xf6\xe3\xd0C\x11'6ֶ\x9a-U\xe9\xcc\xed\xa7\xd2\xfb\xa3\x8b\xcf\xdf\b\x8c\u007fD\xf0\x0e\x96\x02\xac\xd5\xf9\xf0\xb2\xc2\xf06Ơ\xad\x1b\xbc\xb8~\rm\f7l\xe3\x1e\x91\x02\xfc4q\xf1\x0f\xb3u0\x17\xff\x80\x10\xc3\xec\xcb_\x16\u007f\xf9\xacl\"m&KU$d\x80\xcbA$\xc2\xd1Mb\xd9\xcbE\xa4\xae-\x1ak\x11\x06\x97\t\x84%\xb7\xa3<\xc4i\x15.S6a\x11\n\xc7\x10\xb0\xa9-\x1f\xf41¤U\x92\x14\xc9Z\b^\xe5\xca\xd5eN\x80\xc2}?\xa2\xd2\x06\x9fn7\xf9\xc0\xf5\xd9\x0e\x98\xa4\x81\xe15\xc0\xda\xd4&\xc7\x1b\v\x9b?D\x99:\x8b\xdc\x03#M\x1aɞ\xbfRv\x01\xc7/\xd2\xe5\x04\x1ef\xb7A+нZ\xec\x9e!d\xb9e\u007fT\x90\xc9h\x86ՕZcg\x89\xd3~Z\xa5h\xc1\x1d(I\x83\xea\xec&|\x89\x14 \xaf\x19\xd6M\xdaB\xab\xa2NW9\xa0\x88a<\xe2\xa7\u007f\xf6gx\xfb\xf5\xd7p\xa3\x93^DPI\x05\xb1\xd9&\x1cc\x00y\x02\xc8ͻ\"\xa4 \xac\x11mUAkn\xc8*\x05\x98\x9c\x9a\xc7\xd7\n\x19\r\xa5\rHi\x04\x05\x04\x15\x85Sa\xa0\x89f\xd4'\x06\x96\xb9Ɛ\xdfd\xa5<\x94S\xb8\xeb\ah\xf3\x80\xca\xf2\n\xf2bw\x81\x17m\x83\x9d\x02\xb6\x14\xb0Q\x94W\x05i\xd8\t\x00\xee\xa1\xf1\x93\xfd#\xde\xdc\xddc[\xd7x\xb1\xe9\xd0\xd5\xf5\xcc?Y\xbdej\xb5\x12\xe8C\x80\x8f\x01n\x8a\xd2\xf0Ӽ\xa6\x02\xe1'\xc7GɘP\xff\x1aUD}m\x8c\t\xbf\xb8֘̃z\xfe\xfa+\xd0\x00$\x98j\xd3m\xf0\xfa\x93\xcf\xeb\x17/>\xf9O\x95R\xb8\xf9\xf0\x1dn>\xbc\xc9\xd0w\xde׆(\xba\xff\x80q\x1c$\xb7]\xc1VL\x94\v\xce\xe5L\xe9\x94L\xf5\xd4\xe3*ņ?J)\x86\xfe\xdd\xf9\xbd?\xaf\x1cBQ\xc4\n\x0fr\x9a\xa1\xd4\xec\xfd\xae\xf5ٝ\xafR\x1a]]a\xdbmQ\x193g\x04F\x82'\x9f\x93\xf3jk\xe5\xf0N\x92?=\x1f\u007fE\"[n\x88\x88\xa0\xb4\xc28\x8d\xf8\xb0\xbf\xc7\x14\x9c\xb8\xb3\xe1\xc4\xdd\xefc<\x88\x04S\xef\xda-.7;\xd8\xcab\xbb\xddrn\x80\x9f\xb2\xa7=\xd7A~\xff\x8e\xc7=@@\xd7tY\x06Fg_=\xb2\x8cK\x17\xe68V\x1b8\xef\xd0\xf7{ x\xd4\xd2 \x18=\a\x1f\x19\xada+\x8b\xcaV\xf8\xff\xb1\xf7g\xaf\x96fY\x9e \xf6[{\xefo8\xe7\xdcɮ\x99\xb9{x\f\x99\x95\x99\x95\x91Y\x99Y\x83Z\xddHР'\x81\xfe\x00=\xf5S\x83\xa0A\xa0'\x81\xa0\xa1@O\x82\x02\x81@ hh\x10\b\xf4\xa8\a\xbd\xeaM\x03\x12\x92h\xa9\xe9FUYCVF\x0e\x11\x1e\x11\xeen\xe66\xdc{\xce\xf9\x86=,=\xac\xb5\xf7\xb7\xbfs\xcf53\x8f\x8a\xc8\xf0\x06;\x89y\xa4\r\xf7\xde3|\xdf^k\xfd\xd6o\xd8m\xafк^<\x1a\xe6#|\x98\x11\xe6\x19\xb3\xf7\b\xeaŐ\x9d!%\x98g\x81\xcc\xf3a\x94t7O\x86ĳ\xbf\xda\xff\xa2fB\xeb\xfb1\xcc\x1e>.9\a\xd6\x1a!\x04*B\x10\x12\xaf\xa0\xee\xbc8\xaec\u007f\xd3J\xff\xbe\x9eF\xa6\x98pL\x92\x8c8ǈ^'E\x03\x83h\xc4^\xae%m>\xa2F\xb8*z\x10\x15vxu\x1c\xd09+\xca\x00\xce\ri\x84sv\xd1\xf5\xab;\xe0Rxe%\xb0j\x02RҤ?\xb3\x84\bU͓\xf0\x05\xe4\xb3\xc9\xef\x8f\xc9\xe8G\x86\xfdYL\x91\x02E4\xad\xa9\xaf\x000\xa1\xf0\x13\x80\xf5r\xbd\xf6i\xa8c\x86,\xd60}f\xf6/\xd3\xfa\xb2\xf3.\x8e\x85\x94\xe1\u007fTS?=,\xea\\y\xf8\xf2\xc3yv\xa5\x1f\xa8\xb8*%\x17Bn2\x8c\xc3\x11\xc3\xf1\b\xe7\x1c\x9e\u007f\xf2\f\xbbm\x0f\xaf\xf2\xe40M\xca+JJڕ\xe7f\x8cA\xa3\u05f5k\x1c\xb6\xdb-\x9a\xa6E\xf0\x1e\xde{\x95\xc8e4\xc7b\xb3وT\xb8\xd3X^%\x04\x8b\xe4V\xae\x8f\xdc(\xa7\x181L#\x8e\xc7#\x0e\xc7#\x86a\xc0q\x90\xff\xcd+\xc6\x18\x84<\xfd\xf6\xee\x1e\xdf\xf4=v\xbb\x1dv]\x87[gpk\x81N\x1b\xc0\xbd\x0fx\xe1\x19_\x8f\x13^\xbd}+\xaa+\x1fp\x98g\xdc^\\\xa0s\x0e\xad\xa2h\xd5V\fQ/\xb4\x04\xc6\x18\x12\xf6\xdec\xf4\x1e\xd8\xf4+\x17V\x02\xf0o\xf7w\x88\xccp\x86\xf0\xab\xd4\u007f\"\xfa7\xf8\x00\x03 !\xed~,\xe2ߙ\x06\xc0Z\vc\b\xcf?\xfd\f\x9f\u007f\xffw\xff'm\xd7\xfd\xc10\x1c\xf0\xf5W_\x94\xa2\xb9\xecc\x93B\x95\x11~\x9e1ͳ8\xaaY!ȉ\x1b\xa0_\xc1\xe9\x8f\xc1@ \xa0\xedD2\x18B,&A\x0f&\xff\xf4\xb8?~\xd6\xe1׆\x1d٦x\xf5m\x14\x86ߴ\x1d.\xb7\xbb2\xd1\xe7\xe90\xa4\x80\xa0\xc9y.\xef\xfds\xf2ߙ\xd8a\xae\x9c\xda2Qk\x98F|\xb3\u007f\xa30-=0\xd7x_\xf1\xcfw\xdd\xd5v\x87\x8b\xcd\x0e\xc6\x18\\^\\\xa1kZ\xdc\x1d\xf6\xe5\x04\x16Ɏ@\xc5\xc7\xf1\bfz\xe0\xe6\xf7\xc0\xe1My\x02F\xf9\f\xcc»pN\xd6\x15\xc7\xe3\x1e&\x05aړA\xa3\xd7\x04A\xa6Ѷm\xe1\xac\x15\u007f\xf1v\xab\xea\x87\x19\xe3t\xc04\x8d\x88!\x94\tK\xa6X)\x8ce\xa2\xcfşY\x83\xd2r\xf3a\x96h\xe4\xc4\x15I3\x95\x03~\xf0\x1es1\x11\x92o\xd7\xe9\xc1;\xa5\xa4\x06Ct\x96<\x95\xd5\x1f\xd9\xdb\x00\xab\xe0\x98\xfa91\xdeL3\x1a#D\xad1&t\x8d)\xdaw\xa8;`c\xe4kf\x15\xba\xb7D\x98cD\xd0\xcf\xe4\xe5\xfe\x88\xd6\x1a\xec\x9aV\xa6\xf5\x14ay\x99\x96SJ KeJ\xac=\x18\x12s\x99\xcecL g`\xf4\xda\x12#\xaa\x05\x10\x0fQ\b\x81\xa6\xa2\x871\xe8\xc14\x1fS\x82\x89\x11ֺ\xea\xefh\x95\xb2G\xd5\xfd\xb8\xb6ӧ%K\x83\xea~\x81\xaa\x06M\xbf\x82\x1e\x92\xf3J\x83\x83ŵ\x90i\xad\x10`u\xfa\xe3\xca/\xe1QO<\xae(\x94\xd5\x1a G^\xc7\x18q\xfd\xe4\x06\u007f\xf8\xc7?\xc6\xed\xd3\x1b<yz+\x84\xbd$v\xd8\xc1\a\xc4\x100\x8d3\xe6y*\x96\xdf]\xd7\xc1\xb5M\xf1\xbd\xf0\xd3\f?Jp\x96\x01\x89\x82\xc6\xc99`\xad\x85\xb3\x12%\ue703\xd3uL\x9e\xf6\xf3\xf5\x964\x17%#m\xcc\t\xb3\xf78\x1e\x0fx\xf1\xe2%\xbe|\xf1\x15^\xbdz\x85a\x18J.\xc9\xfe\xb0\xc7\xe1x\x80\xb5\x16\xbfl;t\xce\xc2i\xee\xc5\x10\"|\x12\U000e13241\x18\x83\x9f\xf1\x8b\u05ef\x8b7I\xa3\xe7\x16\xe99h\xac\xf2\x93\x00\x84\x940\x8c\x92=\xd2n\xbaU\x8e˔\"\xbe\x18\x8e\xd5\xe7\xfb\xad;\x00\x06\xf0_\xe3\x03\x1d\x00\xbfM\xde\xc9\xc7Ƿm\x00ޝ\x88\xf9\xa0\x1b\x03\x01\x9b\xed\x0e?\xfc\x9d?\xb8\xed7\xbb\u007f:\xcf\x13~\xfe\xc5Op8\xde\x15\xf8,\x13Dr\xe0OL\t\xe38!\xa9kV\xa3\xffn\x9e\x16\x8f\xfdS؛V>\xefb\xac\xd34\x8dH\xb8\xa6\xe9aqT\x9f\x81,\xb9[\x8f\U0009532a\"\xd2\xd8\xcaZ\xb5\xfe\xf7\xce\x1al\xbb\x1e\xdb~\x03g\\q\x1eO\xea\x18\x17\xa24-\xce:\xbd\x89L\x89\r\xa6\x132\x95\xc4\xf4*)L\x11\x87\xc38\xe0\xd5\xfeMI\xb3;M8\xe4\xf7\xc0\xfe\xf99_nw\xb8\xdc\\\x00\x04\\_]\xe3rw\x81\xfd\xe1P\f}\x84S\x91`\xad\x83\x0f\x1e1\x065\xb2\xa1GW,\xa4{{\xaabM\x8df\xd2\xcfӀ\xf1x\x0f\xab\r\x853\x06\xce.rʶi$\xceY\xe5\x84]\xbb\x15\x95@J8\x1e\xf7\x18\x87C\x91[f'\xc2\x18\xa3\x9a\xa7\x9c\xec3y\xe1#0\x89_\xb9\xc9:\xf7\xaaP\xd7\xfa\xe5q\xf6\xc5\xfd\xaf\x10\xc3\f\xa1s\xb6\xe8\xe3\x97\xebc\x1d,cH`z\x1f\x13:c\x11\xf5:1T\xb9\xe5鮚u\xd7\xcbi!wE\x88l*1+\xab>\xe9\x01)\r\xe6\x84\b0\xa1\x85\xc8\x02c\x02\x06\x1f\xf0b\u007f\x84\xbb2\xe8\x9d+DQry:&\xb5\x8a\xe6\aF8\xc5\xf5\xae\x98/E\x90]\aI\xd9U\x80\xd2\xc21Xf\xf1::XF\xef\x18T\x19\xe0,N\ry˯\xda\x10\x11\x84\xb5\xd1\xdeIh\x0fN%|\v\xe3\x9fi\t\xa0*\x12\xc0|?\x98\xfa9\x1ap\xa5R :\xa5\xb0\x9dA\x01\xe8aC\x90\xd5H1\xce C\xf8\xec\xb3\xef\xe1\x93O\xbe'\x9e\x1e]WP\xb2\x04\x06\xab2(\xc5X\x1a\xd6\x18\xa2\xc8Rs\x10\x18\x03}\xd7\x03\x17\xf2\xec\x1c\x1982K\xa8\x93:\b\x8a\xf9\x91]\x14\x15\x9a\x8b\xe0\x9cC\xb7\xdd\xc28+\xcd\xc60b\x1a\a\x84\xd9c\xb7\x01n\xafo\xf0\xe9\xb3O\xf0{\xbf\xfb\xf7p\xb7\xbf×_}\x8d\x9f\xff\xf2\x17b\xe9\x1b\x038\t\xb7h\x1c\x8e\x18\xb1\x98s\x99\xea\xf04\xa7\xfbt\xbd^|\b\x983\xe1X\xef%\x02I6I\xe6\xa5d\xa7\xd09\xc0\xb6\xa6|\x96\xff\xfa\xfe\xad\xa8\x89\x80_\x11\xfeG \xa2?\xff\xc9\xcf~\x91\xdeWo\xf8#\xf4\xffw\x80\x00\xac\xf1\xd0wL\xff\"=\xfaя\xfe\x00\xb7O\x9e\xff/R\x8a\xb7/^|\x817o^\xa8\x86\\\xe32t\xe7\x9c3\xdb\xe7Is\xefu\x92r\x8dC\x8ci\r\xfd\xbf\xe3\x836֠i\x1b\x81\xb6\xa7\x191\xc43\x93}\\\xb9s\x15\xd92p\x12\xca\x01\x95\x03\x9a\xe5\xe0Ò\xfb\xdd6\r.6\x1b\xf4M\xa7\x8c\xf8T\xf6\x8c>x\xf8\xe8\xd5\xe2\xd7ʄ\x9c\xf7\xde\xd6V\xe1,\xbcp\x0ejE\x03\x01\xc7i\x94\xe2\xaf\xdc\x05>7\xe9\xbf\xeb\xbdP\xd8n\xd3oq\xb1ف\f\xe1\xfa\xf2\x1a\xd7W7\x18Ga\xe3\xe7\xa9.\xf3#R\n\x98\xa6\x11k\x9e\xf4\xfa\xb4,\xcel\xab\xf7\x05\x05\x05\x98\xa7=\xa6\xf1\x00gD\xea\xe8\xd4\xc5Mv\xa7\x16]\xd7\xc2YW\x9a*\xe7Z8\xd7\xc8\xe41\xeeq<\xdc\x15;^\xaf\twQ\x03\x84\xdeU\xfc\x93\xeef\xad1\xe2\x96X\x1dX\xf9\xdf2\x11&\xef1\xf9\xf0\xe0}\xec\x9c\x04.y\x1f0\xd5?\x87ϠCJzj\xb5A\xe5\x13\xb4e!tJ\xe3\xe6\x15\xeaeݗ\xf6MS.'g\fH'\x97\x8e\f\x88\x19SLe\x1d\xe0\xf5\xb5\xdfO3\x9a\xfd\x80O\xafd\xcd\x14\x99a\x12\x83,W&Q됣|\xc5f\x14\x80\xd4*\x99\xc8\xc8\xd7!\xaf\x02\x16R`J\t\xd1\xe4t;\x14$j\x81ܗ7?\x04\x99F\x1b\x87\x15\x19Ҝ\xbewD\xcbT_\xca?\x15\x83 \x9c\b\x02N\x03\x88jR`\xed\x03@&s\x0fL\xbdh(\x04\xbf5\nƨڐ\x95څ\xeb\xc9\x1fb\xd4\x14\x93\x14s2\x0e\x97W\x1700\xa2\xed\xa7\xfc\xfc\x13(1\x92QWDE\xb9\x92MHFc\xa8\x93\x04rE\xb5\xa3\xcc狅\x95\xef\xa7\n\x99\xdcH\x9b\x1c2\xa4\x87R\x82ps.\xae\xaf\xb0\xbd\xb9\x12\x8e\x00+Q\x9a\x13\x02\x8b\xbe(\x01\x98\x87\x11\xfb\xaf_\xe2f\u007f\x89۫\x1b\xdc\xde\\\xe3\x97_\u007f\x85W\xaf_\xe18\x88\x95z\x8c\xa1ث\xa7*\xa1t\x95\xd6\xc9\xd2D_t\x1d\xb6\xad\xa8}\xa2N\xf3s\x8c\xe8\xda\x0eW\xdb\rZk1\xcd\x1e/\xef\xef0\xfa\x008\a\xd2k\x9c\x01\xec\x83\xc7W\xf3P>\a\xf3+\x10\x00\x01\x1c\xf0A\x0e\x80\xa4\x1e)\x1f\x1f\u007f7+\x80w\xa0\x01y\a\xff\xd9g\xdf\xc7\xef\xfd\xfe\x8f\xff\xbbd\xe8?\xb9{\xfd\n\u07fc\xfcRw\xdaf!)UV\xab!\x04\x8c\xd3\x12\xf6\xe4\x14f\x9a\x15\xc2\xcfR\xa4\xd3鶞N\xbaN\xa6J?\a\xf8\xd9?\x80\xc9\x13\xe2Y\x8c\xa9\x86zS\xf57\xd9à&\x179C\xe8\xdb\x1e\x17\x9bM\xb1\xee\xcd\xfbif\xc68O\b1H\xe2\x9fNZ\x8e\xa4`Z+\xf0\x19\xaa\x83\xb5\xacA\xaa7t\x98'\xbc\u07bf]\xa2lO\x8a?\xbfg\xeaϓE\xdf\xf6\xb8\xdc\xec$\x88\xe8\xf2\nW\x97Wj\xbb;\x94\r\xa8\x10\x1c\xa1\x05x|\x18(\x92%\x81\x1a\xc6bMe\xf7\xa9t:\x99~#\xa6\xf1\b?\x1f\xd1\x1a\x85\xfb+fn۴\xb2\xdf4U\xca\x1b\x11\x1aׂ@\x98\xa7\x01\xf7\xf7\xaf\x8a}\xa8\x8f\xa2\xedO\x1a\xc1\\н*.w\xf9\\\x17\x9f\xf6L@\xca(\n\x15\t 0\x86(\xc6>\xa7\r\xab1ص\xcdB&L\t+\xfb4^\x1f\x90I\x8bj\x88\x11\xc6\xd9Uolh\x91 2PdUs\x12\x1d\xfd\x10#\xb6֢3F\x12\aKLl\x02%\x80\x1a\xabFO\xa9\xbc\xcfB\f\x04^\x8f#\xac5\xf8\xf4\xf2\x02\xce\xc8\xeb2LE2\xb7\xb6\xfc]G['^lzs\xb0SN\x9f\xcc<\tS¯TY`\xb1Ht\x95\xa5\xcf'a?1Ȋ'7\xb5%\x17\xa0P-i\xe1\xd8\xd1I<\xc0I\xc0P\x81h\f=<k\xceZ\xe0\x9d\x13#.\xfe\x03\x85\x06X5\xd6\xcbz\xa4\xca5d^\xc5\x123'M\xd9L b8\x95\xbd\x16\x0e\x10\x01`\x83D\fcX\x9d\x06\x8dr/\x18l\x19\xad\x16ٔ\x16\x03\xa0\xcc\v\xa2\xa4\xe4\n}ZV͢Li\x04\x00\x9b}\xff\rе\xb2.3M+\xbd\x903\xa0\xcd\x06\xdc6\x98\xbb\x06a+\xa8\xc4\xf5q@x\xf1\n\xc7/~\x89\x8b\x9f\xde\xe0\xea\xea\n/^\xbe\xc0\x9b7op\x1c\a\x8c\xe3\x84\xe3p\xc4\x1c\xa4\x11\b\xba\xe2HYI\xa4\xaa\x82˾\xc3\xedv\x8b+\xe5&t]\x8b\xa0\xcdv߶0\xd6\xe28\x89\xa7J\xd3w\xf8\xf2x\xc4\x1c\x13\xa0+P\x03¿\xb8{\xbd\xfe<̯\xd4\x00\xbc\x05\xf0\x01\x12@\xfe\xe0г\x8f\x8f_\xb1\x01\xa8\xb7\x82x\xcf\xca\xf9\xc9\xeds\xfc\xa3\u007f\xfc\xdfi\xbb~\xf3\x9f\r\xc3\xc1~\xf5\xd5\x17\xf0~\x16\xafo\xb3Ȕ\xa4\x9bE\x91\xfd\x05\xaf\xa9{\xcc\n\xe3\xa7\xc2\xfaO)\xe2\xdcX\x9au\xbcm\xdbH0\x8fJ\xfeN\x8bd\xe4\x883h_9\xf4\xc1'~[L\x05\xa9\xc8\xff\xa0q\x0e\xbb~\x8bMө\x01\v\x17X>\xfb\xd9'f\xf4]Wvd\xb9I\x90]\x9a[\xc3V\xf9}HK\x133\xf9\x19w\xc7{\xf8\x14\x1e@[\uf0f92\xbf\x80\x99ѷ\x1d\xaev\x17\xe8\xda\x0e\x97\x97W\xb8\xd8\xee@dq\x1c\xee\xf5`[\xe4rd\b\xc38H\x06\xfd\x83\xc0\x17\xd9\xfbY\xa3\xfb\xfb\xea\x1f\xe4]{\f3\xa6\xf1\b\x8e\x1e\x1b\xe7\xf49d+`\x83\xae\xd3t\xc0\xca\x0f^\x90\xa2\x06D\"7\xbc\xbf\u007f\x85\x14\x03\x18\x849\x04)\xfe\x85\x84\xb9\x88\xba3\xa4*\xc55\x95\xcf\xdfT+\x95\\P\f\x19%\x93J\xf1\x1f|(Imu\x03\xb8i\x1b4\xaa4\x19\xfd\xb2\x0f\xad\xeb\xcc*\xaaW\x0f\xc39F\xb4\x8ahQ\xd5L\x9a\x13\x99d\x96}Y%yއ\x80\xae\xed\x90\x1dW-D>\xe9!\x86?\x9d\xc2\xeas\x8crp*\x8a\x10\x12\xe3\x9b\xe3\x11\xd6\x10>\xbd\xbc,J\x06\xa2%Ƹ\xa0\x00\xb4<\uf4b6\x97#qU\xf6Z\xcbp3\x8fBd\x93\t!E4ƭVa+V\u007f1\xf7\x91\xc8j\xe3\x1a\xc0\xd1ٵ\xda\xe2\xd7_Y7\xd3\xd2\x04\xe4\xcf,\xa7\u06dd(\xff\xca\xe7\xben\xfa\x19'\x98@\x91\x14\x926nTe\x11\xd3#\vf\xac\fo4\xff#e\x1f{\x9d\x90\xad6\xad\xca\xdd`m\n\rWMK\x0e\x17\xe2$\xbfU\xc9j&\xae\"% q1]*\xa6Y\xccp\xec\xd1S\x80!\xc0@$\xbe\x8e\b\xad\x89\xd2\b\f\x1e\xc6L\xa0\x8bkPۃ\xcc\x16ԵH]\v\xdav\x80\xdaRۛ+\xb8'\xd7\xd8~\xff3\xdc\xfc\xf0s\\\xff\xf5ߢ\xfb\x8b\u007f\x8b\x9b\u05ef1\x8f\x03\xe6\xd9\xe3n\u007f\x8f\xfda\x0f\xa4\x88Ɛ\\\x83\xaa\xdb7d\xd0w\r\x9e\xf4[<\xdbl\xb1mZ\x80\xe5:LQ̭\x86\x10p\x9cF\x1c\xc6\x19\x81\x138\xaf\n\xabs\xe1\xe5<\xe2m\x98W\xc1j\xe6W[\x01\x1c\xf1\x01\x11\xc0\x1f\xe1\xff\xef\x10\tp\xb3\xdd\xe2O\xfe\xf4\xbf\x85\x8b˫\xff\xe98\x1c\xff\xf1\xcf\u007f\xf6\x97\x18\x8e\xf7%\x86\x15\x15\\)\x84\xbe\x80\x10\x02\xe6i\x12C\x93\xc4eZ\x0ej\xceRb%\x1f[98\v\xd78\xa4\x940\x9f\xb1\xfaMg\xc8!y\x9f[C\x8b\\Ø'Ůk[\xec\xfa-\x1a\xe7Nr\xe0\x85\xad{\x1c\x8e0\xc6`\xd3\xf7\xc5\xd3\xdf\xe6\xc9\xdf\t#\xf8\x1ci\xa5N\x11\f1\xe08\x8f\x98\xfc\xfc0\"\xf4=\x17y\xb6\xe7]\x8a\xff%6\xfd\x06\x97\x17W\xe8\xfb\r\xacs\xb2b\xf1s!\x80\xe5)9\xb3\x8dkGƼ\xfe\xb0\xd6\x16\xf2b>Ս\xee\xff9\x05\x04?\"L\xa3ؘ69\xc6\x18\xca\xe1h\nѯ\x0eY\xab\xa1Z\xefgL\xe3\x01!̢'\xf6\xb3\xba;\xa2\xb2Z~X\xfcC%\x01̅\xc80\xc1f\xad\x9d\x92\u0558\x19c\b8\xc4\bpz\xc0\x17ot\xfa\a\b\xa3\xf7\x98C\x14F\xbdB\xcb6\xefzy}\x80I4\xb0X6[\xd3\x16\t\\b\x16\x12\x17\x1e6\x01>1\x1aC\x98b\xc2\x10#v\xce\"V\x18Tc\x00$\x03PB\xe7\xa4\x12z\xf5\x9f\x8050)!D\xc6\xd7\xfb#\xac1\xf8\xf4\xf2j%ɢ\"a\xe5\x8708\x16k]\xadS\x88$~\x0f\v\x1b\x8f\xd5\xf3\x80\x96TA疽\xfa\x19\x19\x1d+\xd2\xe0}\x00\xc8\xc9tz\n\xf7\xd2\xe9>\x1eX\v\xf7+\xf2be\x05P\xfe 7\a\x15Q`\t\xe8Z\n~\xa1`2\xad\x15\x83\x0f\xb6\x98\x95R\xa0Z'\xe5&\x88\xb3ʈ\x1f\xa6\x04H\xa1_\x05!(\x9a\x89U\xcae\x96\x1e\x82\x00J\x8b\x9bc^yrb\x18\xbd\xb7:Jh\f`\x88\xe1\x90\xe0\x10`\t0\x10e\x8d\x89\x13\xb0\u007f\t\x9a\xef@\xcd\x06\xdcn\xc0\xc7K\xf0\xf5\x13\xc0ނۋ\x12\x14\xc5)\x81\xfb\x16ן\u007f\x86\x9b\xa7O\xb1{r\x83\x9f\xfc\x8b?\xc7\xfe\xd5+\x98\x18\xf1\xfdO\x9f\xc1D\x8f\x10&\xa4\x18\xe0,\xa1\xb1r?[\x03l\x8c\xc5\x05\b&\x011$\x8cS\xc0q\x94Wv\x9f\x12f0|\x88\x98\xa2Ƕi\xf1\xcdq\x10¬1\x85\x94\xb9L\xff\xf5\xe7\xff+7\x00\xf4\xb14\u007f\x97\x1a\x80w\xd4!\xe7\x1c\xfe\xe8\x8f\xfe\x11\x9e\u007f\xf2\xf9\x1fx\xef\xff\xe7\xbf\xfc\xf2\xa7\xd8\x1fޖ\xb8X\xcaf\x1e\xbc$\xe6\x85 >\xf3A\x99\xfd)&\xf4\x9b\xb6\xc0e9\xc8\xe4!\xb9M\x8a\x81\xb5VR\x02\x19\x98\xe7\xf9\x01I0i\xa8\xcei\xf1'Zv\xb6\x8bi\xd6r(\xd4ɀ۾æ\xedK\x81͠\xb3X\x84\x8a'\u007f\xeb\x1a\x89\xfbկ\xb3\xba\xcfsv\x1d\xf1\x9b\xcdVb\xf5\xbc\xb2\x0e{\xf23\x8eӰ*\x1c\xefG\\\xa8\xe4\xbf3\x8b\xc3\xdf\xf5\xf6\x02\xdb\xed\x0eW\x17Wh\xdbN|\x05\x188\x8e\xc3\xea L\xfasE\"\x96\xb3\bD\x97\x9c\x9f;\x99\x85\x13\x9e#r\r\x18\x1cg\xc4Y\x8c}\xdal%[\xae\x03\x8b\xa6\xe9\xe0\x9c]\x0e\x80\nC\x8a1\x8a\x14\x0e\x01\xcc\x11\xf3<\"Ą\xc9{\x84\xcc\xf3\xa8#\x84sS\xa1\x87\xed\xa4\x8e\x8c˾\x9ba\xa0\xcfWeb!&5\xf9\x99q\x8c\xa2\xa96\xd5[\x99\xa7\xce\v]\x1b\x85\x980d\xc5\b\xf1\x92d\xa7\tuLi\xd5\x04$\x86\xb0\xf4CB\xe3\xe4\xe7\x9b*\x92\xd5\xd6.v\n\xafG\x96\x10(g\f\xee\x83\xc7\xc6YX\xfd\xf3\xdc@4fɒGC \x8a\x98BTS%\vK\x8c\xc0\t_\xdf\x1dй\x06\xb7\xbb\x1dNJ\xa1\xa2\x02\v\xd1nIuSB\x9d&\xe0qJ`\xa2jR_\xc8_\xd9dʨ2\xa0X/\xabi?\xafH\x82\v\x12@\xd4\xc08*\xda}:Q\xeb\xaf\xfe\u007f^\"\x03\x1e\xa6\x1arU|\x97\xf6\xdc\xd4\xe8T\xf1g\xa6*\xd5/?\xbf\xca\x1a\x86\x97\x8c\x8a\xe5>?\xbd\x93\x14\x06\xcf\x12Ӳ:\xa8i\x85\xf5\n*\x16NҒ\x81D\xe0\x98I\xc6\xf9\xfa\xd5\xf7\\́8E\x84yB\x9c\x83Dn[\at\x16\xa6\x816\x01\x01\x8e<,\xa4Y%c\x95 jAs\x00\xc2\f\xf2\x030\x1d`\xef\xbeA\xffr\v\xfb\xf49\xfc\xb3g\x88\xdb\x1d`\x1c\xcc\x1c\xc0>\xc09\x87\xdf\xff\xa3\x1f\xe3\xd3'O\xf0˿\xfc\xd7\x18\xbe\xf9\x12n\x1eѢ\x83\xc5V9\x01\xa1\\;\x8d%8ψS\xc4\x1c\xa4\t\xdf:\x8b\xa6\x01\x0e!\xc0D\x91\xc6\xde{\x8fM\xd3\"0\xe3\xed8-\x12[\"\xfc\xf5a\x8f!\x853m\u05efT\xc7\x0f\xc0G\u007f\x9f\xefL\x03\xf0\xbe)\xf4\xf7\xfe\xe0\xc7\xf8\xdd\xdf\xfbC\x80\xf8?{\xf5\xcdW\x9b\xb7o^\x02d\x90\x92_\xcb\xfe\xb4\xa0K\xe0\x8f\x98\xfe\b\x94\x9c\x14\xce\xef\xd4\xdb~\x89\xc4=W\xfc\x89\x84((F9\"\xc99\x9dORJg-AW\x99ڨ-\x80\x16oqk,\xb6\xfd\x06]\xdb.\x11\xbe\x89\x01c\x918`R\xc9b\xdfv\xd8\xf4\xfd\n\xb2'\xca\xc5\u007f\x89\x01\xae\x19\xffI\xfd\ad\x97\xec1\a\x8fC\xe5\xb9\xff!\rW\xde\xcb\xe7\xf7u\xd3v\xb8\xb9\xb8\xc2\xc5\xee\x12\x17\xbb\v\x89\x10\xb6\x06\x8du8\x8e\x83\x86\xf9Т\xbcP)$\xd1:N\xb5\xd1x\xe2\xfa9\v\x81\x91\x80\x14\xc0\xc1#\x05/\x12?Z&P\xd1?\xb7h4\xfaxQ|/\xc69^\xa5R]\xbb\x01\x831M#\xc6y\xc2\x14\xc2\xe2\xb1\x0f\xac\x1c\xffR\x8e:e\xc6ы\xdbX\xa3k\x06V6}\xebr(\x11\xa9\xf1R\xc28{\x8c! 9\xab\xd3\xc9\x1aQ\xe9\x9cC\xd7\b\xcc=x\x8f\x98\xf2\x1e\\\xdf\v\xb3h\xe0\xad1\x88qm\x8a\"\x9c\x14\x83\x10\x83\xfaZ\x00\x0eKAύ`-\x0f\x8cJț\x12\xe3\x10\"nڦ\xa4\xc4e\x04\xbc\xb1F\xfd\x8c\x13\xa0z\u007f\xaf\xde\xea\xd6\x10l\x92\x10\xa1_\xbe\xbd\x873\x06\xd7\xdbmɋ3X86垫>\x87\xdc\x04\xe4\x82\x18\xd5\x06\xb8\xd6\xd5絋\xa5\xc5*\xd8T\xe1>\xf2T\x13$\x8d\xa8\xd6ңdm\x88O\xc1\x922h\xaa\xbd\xfc*°\x90\xfaW[y}\x9e\x8b\x94\x10'\xb4ٳ75\x96\x06g\x81F\xf8\x11&s\x19ߗ! I\xd46\x17\xb21?H\f\x8c%\xe6v=ݖ\xb5\x81\"\x9b\xc5J\x9ay\xf99*y\x0e1 \xc6\x19!x\xa4!b<Z\x8c}\x83\xebM\v\xdbD\xb8\xdc\x04\x1a\x03\x9b\x12\b\x01\x88\"\x19%c\x80`\x01\xdb\x02\xae\x87\x9d\x06ػ\xd7h\u007f\xf93\xa4\xdd\x0eh7 j@m/\xc4<\x1f\xf0\xe4\xee\x1b\\퀁7\xb8{}\x84I\x1a\x85\x0e\x8b\x98\b>\xc4\xe2F\x18|B\x9a\x13\x88\x19\x8e\x15\xbdPwL0\xe3\xcd<\x03D\xe8\x1b\x87\xbf~\xfd\x06S\x8c%\xb8(2㯏\xf7g)c\xbf\x1a\x00\x80\xd7\xf8@\t\xe0\xc7\xc7o\xb8\x01x\x9f\xc5\xec\xf3O>ŏ\xff\xe8\x1f\xc1Z\xf7\x1f\xdd\u07fd\xfa\xef\u007f\xfd\xf5\x17\xc5C\xbf\x80s\xcceמ\xa7\xffy\x9e\xd5\xc0Bn\x8e\xae\xdf\xc0\x92\x95\x9b#.>\xf8\xe7\xb8\x06MӈaK\b\b\xde?\xf87\xe5kkkГ\x8b1q\xc5o\xa8\x9c\xdd\x1a\xd7`\xd7/\xae~\xa5\xdbO\t\xc7i\x84\x0f2-n\xbb\r6\xddf1\x10\"\x81\xfe\x9b\xa6A\xeb\x1a\xd9\xf9U\xa7WF$\xf2j\xc3\a\x8f9zL~\xc60O\x95\x03\xe1\x87A\xfe\xf9\xb3\xd9v=\x9e\\^\xe3J!\u007f\xd74 \xf5Q`\x10\xc6iZ\x92\xe4\x8a;\xa1B퉋ӡ5\xb6\x82W\xb5\xb0\x13`)\x81B\x04\x82\aG/\x18\xb2\x9e\xa1\x82\x164\xfay\x98\x93\xbe_~fP\x8f\a0\xa3\xefŏ`\x9cF\xdc\x1f\xf6\xf0\x9a|\xc8'N\xfby\xba\x02\xc4\xf9o\xf0^\x89\x83\x12\xe0\x93\xd4\xe2\xb6k\x1a\xdd]˄\x1dB\xc4q\xf6\xe2\xc5n\xcd\xf9F\x8a\xa8d\x11\xf8\x18\xd5aq\rs\x1b]\x05e\x92!Y\xc0G^];\x169d(\x93\xa8\xcc*\x9d21\xa3\xb5\xa6X\xfc&\xce\xf2D\u009d\xf7\xd85\xaa\xfb\xd6&\x80H\xa6\xdc\xd6J\xa6;\xa5\x04\xe3\x1c\bQB\x83 &E\x1dY\xcc1\xe0\xab;9p\xafwۂ:,\xa4\x16\xae\x9a;:{O\xe7\xeb0\a;\x15~J\xf6v\xd7p*SK>+\xe9L\xdd0\xe7\xbf\fA\xec\xac\x1bk+\x02\x05\x95\x10\xc0\xec!\xc8Y\xd7\x0f\xac8\x01\xe5\xbf5ROu\x1a\"}\x80Om>uN\xdd+j\x1a`\x95\r\xa1i\x9b2\xbd\xc7\xe5\xeaW\"i\xbeg\xea\\\x8e\xfc\xb5Aw\xe4\xa5\xe0\xf3B\n.ܢL\xfc#B\xb2\x0e\x013|\b\x88\xf3,\xe6W#\xe10t\xb8\xd9u\xb8\xed\t[\aXu\xe3\xb7\xcaq\xa1\x14\x81\x14\xe4\xad\xf4\x03`\xee\x01j\xc0\xae\x85\x19\xef`\xdfd[dq\x154\xc6\t\xc2\xe6\ap\fp`\\w\x06!\x10\x12\x13B\x02\xa2\xba\x93&\x1f\xe0\x8f\x11\xec\xe5\xbe6L\xa0Ȉ\xfa\x96\x1bf\xbc\x9c&\xdc{\x0fK\x84\x17\xfb\x03^\x0f\xa3\x10I\x95K\xf2\xd3\xfd=\xa6\x9a\x8d\u007f\x96\xb0\xf9\xad\x1e?\xfd\xd8\x00\xfc7\x80\x03\xe0\x9a\x06\xff\xe0O\xff\t\xb6\xdb\xdd\xed0\x1c\xfe\u05ff\xfc\xc5\xdf\"\x04\x0f2$今a\x9b;\xe1\x94\x12\xc6i\x82\x9fge\xcb2\x9cs\xd8\xf4\x1b\x81\xa6T\xeb|Z\xf4\xf3In\xad\xd3\xe2\x1f\x85+pr\xc0Ge\x93\xaf\x02,\n\xe4\xb8f\xd6\xd7Af\x86\b]\xdba\xdb\xf5Br+\xc5?)L<\xc0{\x0f\xeb,.7;\xf4m\xb7\x86\x95\xc9\xc09!\xbd\x99\x93A\x9e\xd5\xccF \xff\x00\x1f\xc4\xe5\xce\xeb\xee_\fn\xdeo\xb8P\xef\xe4\x19\x8c]\xb7œ\xcbk\\_^\x17'1c,\x9cup\xd6a\u007f<\xc8\xf4\xaf\x05)jPI\xceT\x10$\x81V\rE~\xaf\f\x00\xc3\x01\x14<\x98#RT\xa2\xa6N\x84V\xe1~{R\xf8\xeb&,\x06/\x86H\xfa\x19\x13IC\xf2v\xff\xb6\x14\v>i3snz\xe2\x84)D\x8c!\xc0\x1a\x12\x9fr\xbd~\xac5\xd84m\xb1\xfc\r)a\n\x01ä\xb9\x01\x86\xc0\x99\x9d\xcekW\xbf\xd6ZtN\xbc\x1b&\x1fʚ\xa96\x83a\x1d]l!\x02Ҳ\xa2XA\xd1X\xf9\xf0[c\xe0+n\a3pٴ\xb8\xd3\xc33j\x130\xa5\x847\xf3\x8cO\xfa\x1elHQ\xe8\x12\xc7#\x88\v\x00J\x8c\xce)_C\xf90Db\\4\xfa\x80\x17\xf7{\x00\xc0\xcdn\v\xebܲ\xff\xa7\x13ǝ\a\x11\xb1ˌ.n\x82v\xb5vʼ\x10\x82Q#*\xbbh\xf2\v)\xe0TI\xa3fA1)\x9aD\x85\xf4\xb7\xac\xeaiՂ,\x8b\x02^/\x00\xe8<x\xcc\xc4Ŗ\xfa\xc1\xdfW7#\xe3d\xe5\x83:F\xaa~\x0e\xa9\x9cA)\xe7\xe0UZa\x86\x18Pe\xd5R9O\xa2\xa8U\xeak e\x89]I\x92Z\x9a1\xce\xff\xd6X4\xdb\x1d\xe0\x1c\xf8\xb8\x87?\x1c\x10\xe7\x80\xe0g\fÈW]\x83\xabM\x87\xeb\xdeb\xdb\x02\xada\t\x10\"\x12\xe9`\xbe\xfeb\x00\x82GJ\a\xc0X\x90u\xc2\x15\xd1ϝ\x85RX\x9a'\xb9\xa7\r\x1a\xd7\xc8:j\x96\xb5V\xd2\xf73\a\xb1YϠ\xa0<-UE\xfc\xf28\xe2\xcb\xe3P\xbc8^\rÊ\x98\x99\xceL\xff\xa7Q\xd5\xdf\xf2\x91\x00\xfc\x9b\x8f\r\xc0w\xa5\x01xGM\xfa\xbd\xdf\xff1>\xf9\xe4\xfb\x881\xfc/_|\xfd\xc5\xf3q<\xc0\x18S\xe4%\xb5{]\xee\x8a\xe7i\xc64M\xe2\xf1\x9e\x18\xceZl\xba\x1e\xc6\x10\xe69>\x80\xfe\xcb*\x8f4D\xc7\xca\xf7\x97\xe2Ϗ\x17\xff\xfa\x10\xa0\x87v\x94\x06Kd\xa8ː\u007f\xd3.2)\xbd\xe0\xe7\xe0q\x9cF\xc4\x14\xd15-v\xdb\x1dZ\u05ec\"3\t\x84\xc65eep\x8aFd\xf7\xc1Y\vbN\xcd\x1a\xe6\xb1jv\xf8\x83\xf7\xfd\x86\f.6[\xdc\\\\\xe1\xfa\xf2\x1aM\xd3*\xb1ȡq\x0e\xce9Lӌ\xe3\xf1X\xdc\xf3\xa2j\xeb\xa1_\x9f]\xbd@K،\x04\xf40l\x9e8R(9\rL\xcbz\xc35\xed\x03GÒ\xf4\xc6,\x1e\xfe\xc1\xaf8\r>\x06L\x87;\x8c\x93\xc8%\xeb\xf7\x89\xab\xa9?\xb2D\xf6\xce\xda 9c\xd0YWV(\xce\x18lUV\xc8\x00|\x8a\x18f\x8f\xe34/*\x81Zz\xb4\"\xe41\xb6j\x15\x1d\x1fL\xff\xeb$\xc3\xd2t\x19S\xa4rq\x95s\xce\x05\x010\x15\xec\x1f\xb3\xd3\x1e\x809Flw[Xk\xf0j\x18\x17S\x1ef\xbc\x9d=.\x9b\x06\x1b\xe5/D\xacÝ\x1ak@\x94\x80(M\x1a\x01\x989\x95\xa0\x19\xb2\x841x|}\u007f\x8fȌ\xdb\xcb\x1d\xfa\xa6)\xa6KIɮf!cTT8՚\xeb\x9b\x1f\x93\xe4\f\xac\x1b8\x06\x19\x91(\xfa\x10`L\xb3\x14W\xae\xac\x95OH|\xf9k\xa3\x91Ƃ\xb8\x86\xedyU\xe0k\xe7\xc2Ӽ%&\xe1\xa4\xd8\x15r\xb7\x98.q\xd5\x01\xd4\x11\xb9t\x92\xee\xc7\xd5\u007f\va\x8eX\x9b`\x96\xe66\xc3\xff\xd5\xfd\xbc\xa8|x\xe1\a\x01\xe2ǟ\xef\xd9*\x83\x82O\xbd\xf3\xb5\x99\x06IC\x19|@\xf2\x01\xc6X\x90sh\xb6\x97\x80q\xf0\xc3\x01i\x9a\xc0aB\x8c3\x86q\xc2\xeb\xa6Ŷ\xefp\xd19\\9Ɔ\x18\x8de8GpV.\xf2\x18\x19\x1c\x19H\x1e\x80\xd7Wg`[\v\xdb\xe54R#<\x02\xb2\x1a\x1e\x960ƈI\x95F)x\x989\xa2\x89\x8c8\x8b\xb9Q\xe2\xa5\x11\xff\xd9\xfe\x88\u007f\xf3\xf6\x8d\xf8\x008\x87\xbbq\x14#-%\xc9\x18\"\xfc\xec\xb8_O\xff\x1fP7\xde\xf3\xf0\x00\xfe\xd5O~\xf6\x8b\x8f\x1c\x80\xef2\x02pss\x8b\x1f\xffџ\xc2\x18\xfa\x0f_}\xf3\xcd\xff\xe8\xedۗ*\x9f\xe3\x15Q\xa6\x84\x8b@\xf6\x84\xc30H\xf1N\fg\x1d\xba\xa6\x83s\rbX\x1c\xb5\xceN\xbfj\x9d\x99\x99\xc7\xe7lq\x1f\xb8\xfcU;݇\xf0\xba\x9c\xfc\xadk\xb0\xdbl\xe0ԥ\x8ftr\xf21`\x9c&\x8c\xb3\xa8Qv\xdd\x16۾\x87SӋ\x05\x92'4\xb6A\xdbv\x0f\x8b?\xa7\xb2#\x9c\xa3Ws\x10i\x06\x06?a\x0e\xfe\xbd\xef\U000d2ca7;ikq\xb9\xd9\xe2jw\x85\xab\x8b+\x85\xdfEj\xe8\x9aF\x82\x96\x18\x18\xb5i\xc9>\xf2\x12i,\b\x8asN\x1d\xf8X\x0f\u007fBk,(y\x81\xfaS(\x8c\xe5\x04\xf1p\x90Ƣ9ke\x9ckK\x8c\x01a\x9e\x11S\x10\x98W\x8f\xa5\x98\x12\xe6 \xf6\xc8\xf9\xe0X\xcdbZLC\x92\x144\xaf.k\x9dڑ\x02\x04N\xa2&\xd9\xf6\x1d\x9c\x92\xee|\x8c؏\x13F5\x91\x82\x16\xe0*\x1f\xb7\xf2\x98al\xdaF\x13\xcfX\xb9\a\xcb\xf37\xb4\x04\xfe\xe4\xdc\x05Y\xeb\xe4\xccsi\x82j\xad\xbf\xd7̀\u0588\xec\x90\xd4\xe0g\xd6k\x98\x01\x1c\xbc\xc7\x0f\xaf.\x91\x98\xf1j\x18\xcb5\xe8\x13\xe3\xe58\xe1\aۍ\".J$\xac\x9e\xb3\x13\xad\x16\xc0@\xa7\x06=s\x85D8\x92=\xee\xcb\xfb=b\x8axvu\x89\x9d\xae\xa5\xd630N\xaa%\x970\xa0\x12\x17\x9cÅ\nQ\x8f\xc51Rۅ\x10\"L\xab\x9c\x0e2k_\x80\xe2\xf8\xc7Ż?%\xa1\xde\xd9j\x15\x93!\xfd\xf5\xae\xbe^\xcfU\xf1\xc6\xf9}\xaex{F\xa1\x84\xc2-\xa8#\x86+\xab\x1f\xe2\x8ax\xb8\xca˦\xd5s)ܞ\x13?\x8e\x02\xe1\x9f\x14v\x0eq\xf1$a\xa8m8W$\xdf:L\f\xb2RPD\x89\x9cE\x18'\x84qZZ\x1e2@'2\xdd\xe4G \x04P\x9c0\x06\x8fy\x1a\xb1oZ\f\xd6\xe1\xc6\x1a\xf4\x06p\x8e\xd08\xa0i\xf5\xbdH\f\x8e\xf2YI\x03\x13\xc1>\n\xa2Ե\x80\xb1`\xdb!R+\xb2\xd88c\x9eG̃\x87\v\x1e<\x05\xa49\x81C\x12gC}_\xc6\x18\xf1\xb7\xfb=\xfe\xed\xdd\x1d\x8e!\xa2w\x16\x91\x13ގ\x13\x82\x86\x18\xe5\x86\xe8'\x87\xfb\xf7\xac\x8e\xf9ۮ\x01\x8e\xf8 \x13\xa0\x8f\x8f\xdfZ\x03`\x8c\xc1?\xf8\xd3\u007f\x82\xdd\xee\xb2\x1d\x8e\x87\xff\u074b\x17_\x14X\xa8\x14=>ٙ%\xc64O\x98\xe6\x19)&4\xd6\tï\x01H\x98\xfd\xa3^\xff\x12\xa5k\xb5\x89\x88g\x8b\xff\xa3\x9c\x01C\x0f&\xc1|A\xf6m\x87\x8dB\xfeEZF\x84\xd9{\x1cƣ@\xfe\xd6`\xb7ى\x11\x06-\x0e\xe3\xa2\r\x16X\xadm\x9a\ar\x97\xc4b\xb8\x11\xe2\x92\v\x90\x1d\x10\x87 {\xffw\xaf3\x17\x97\xb0|\x8e5\xce\xe1r\xb3\xc3\xcd\xf6\n\xbb\x8b\vY\t\x18\xb1\fm\x15\x05\xc8#m^\x17\x14Y\x975eM@\x85\t\x9e\xd0\x1a\x03\x84\x19a\x1e%ؤ\nB2֢u\x82*d\xb5@\xfd\x16\xd6\xf0\xa7$\xf7\x85\xea\xc0\xe4\x12\xdd\xebC\x10\x12\\\x05\f\x96HZ\xb5\u05cdj\xc5\x1b\x92\xb0\xa0;gK\xf1\x881\xc2\x1a\x83\x8b\xbe\x85\xd3\xe6a\x0e\x11w\xc3(\x11\xc9\xc8\xea\x06\x14\xe8?˰\xf2\x11d\x8dd\xb6\xe7\xdd\xf6\x9c\xdd\"\xb5\xe0\x18u\xad;\x13\xfa(\xe1E\xd6h\xbe@\x85\x02(/\xa0w\x16\a\xaf+\xaaz\xba%i\x00\x00\xe0G7W0\x86\xf0\xf5\xfeX\xf8\x15\xfb\x10\xf0\xcd<\xe3Y\xd7\xe9\xfc\xb6\xf8\xf8\xe7c\xb3\xb1\x06\x88R\x84\xc9Yp\x90\xc6g!\x84\xca\xfb\xff\xfa \xbe\xec\x9f^3.7\xdbR\x14\x8b\x1f?\x9d?\x8fs9ͮ\x94\xa5Y\xd6\xcfJ\x8c\x84\x04\xb1\n\xd1\xc8\xf5R\xc3rծ\x9ea*wM\xfd\xda\xe2R\x98\x83\x8a\x14\xf0OB\xb6XT\x06Ц\xa2\xa6\xfbU0\xc3c\xdb\xfdj%\x91\xe3\x1997̴F>r\xf4sR\x17Dh3\xbe\xf2\x92\xcfS\xff\xc2\xe7\x03\xc0\x88\xe1d\x05\x94΄r\x154@yH\xf5ڈ\b\xa6o\x10\xe7Yж\xeacH\xd6!\xc5\x0e\x91\x00\xc4\x196y\x10y\xf80!\x90\xc1L\x16\x97\xa6\xc1ƈ\xbd\xb6\xb5\xb2&j\x1d\xa1\xa1(\xefS6\x182I\xd2\t\x0f\x01\x89-\x02\x8f\bl\xd4\\K\x88\xbc4{x\x16\u007f\x82\xecD*\xf7\"\xe3\xed<\xe1o\xee\xf7\xf8\xd9\xe1\x881\x068#\xba\x84\xd7Àc\xf0\b\x95\x82\xe3\xcbi8\xc3\xfc?\x9d\xbf\xbe\xf5*\xe0\x0e\xc07\x1f\xcb\xf2w\xb8\x01\xf8\xfb\u007f\xf8'\xf8\xfe\x0f~\x17!\xc6\u007f\xfa\xe2\xe5/\xfe`\x1c\x8f\n9\xa9\xbdo\f\xaa\xff\xb7冘\xe7\x19\xe38!\x86(Eӵ\xba{\x97\x9bɇp\xb6\x88\x1b#zzY}\x9d\xff7r\x03\xf3\xc3l\xb0R\xa4\xea\xfe^\b[}ۡk\xbbrX\xe7\xe2?\xcd3\xee\x8f{D\x8eh\x1b\x87\x8bͅz\x00\x985$O\x04\xe7\x1atM\xbb&\x1a\xe8\x01\xe3\x83\xc74{$\x95\xf5\xe4&e\x8e\x1e\xc34\xbe\xbb\xf8\xe7t\xb6\xea\xd4n\x9b\x16W\xdb\v\xdc\\\\c\xbb\xd9Js`\fڦA\xdbtR\xfc\xab\xaf\xef\xfb^M~\x12\xba\xaeC\xdfupV<\xc5Y\xc9w\xc1{\xcc\xe3\x80\xe0\xa7%s\\\xc9v9\x8eY\x18\xe5\\\xa9\x1c\x162XJ\x11\xc1{\x84\xe0\x17\x19Y)\x8e\x02\xb1\x87\x94\xcer\x04b\x12Y\x9f\x04\xfepq\xff\x93\x86c\xb1\xa3\xcd1\xb8\x97}\x0f\xa7҃1\x04\xbc=\f\xf0\xd5A\xca $Cx\xb8;\x92\xf7\xefB_\u007fb\xc6q\xf6\x85}\xbf\xf6+_\xab\xe8\x17I(\xd0:\x8b9\xa4b\u0093\v\xca\x18\"Zc\xe1m\x82O\n\xcfg\x1f~u(<\x84\x80O\xba-~\xe7\xfa\n`\xe0\xcb\xfd\xa1|\xefWӌ\xd6\x1a\\\xb9\x06I\xbb\x18\x93\x11\xad\xb2\x0e \x98$\xc4\xc0\xceJds\\\xe5C\x88\x91\xcd\xfdQd\x95\x9f\xdd$<\xd9]\xac\x9d\x1bkW\xbdST\xa0\x04\xe3$\xa4d\x8a\xa1PF\b\x84?\xc2 \x13\xb5\x19\"\xd4Y\xc8\xcb\xe4͠d\xb0\x18\x01&dsEK\x06X-\xce\x12\x88\x15\xe1ȍC!\xfd\xa9\xd2\xe0\xd4\r\xf0\xe4>;\xbd\xa6\xd6\r\x02/t\xbf\x02\x86\b\xc4\xcd,\xc5?\xa5\xa4.\xa1\\\xae\xf1\x1c\x06V/Lb\xac\x8b\xff\xa9\xff<#Ņ\xe7\x94\x1d\x14Kd/$l\xc9{\xb1\xe4\x853H>.\xdf?\xb1 \x9f)\xea5l\xe5:\x8a\x11&&x\x02F\x10\xde҈-Yl\x8dEK\x06\x8dqh\xacAc\t\x9d\x01ZCp`\x98\xc0`\x0e\x98c\xc00\aL!\xad\xee\xcbl0\x95\xb27\x86\xa2|\xc7\x10\xf0\xd58\xe2/\xdf\xde\xe1\xc58J$\xbb\x11\xbf\x8a\xfd4\xe2\xed8j\x9fEE\xba\xfa\x17\xf7o~\x13\xf5\xe8\x80\x0f0\x01\xfa\xf8\xf8-5\x00O\x9e<Ï\xff\xf8\xcf`\f\xfd\xf8\xe5˯\xfe\xd3\u05ef_Tş\x95\xc5\x1fU\xca%\x87\xff<\v\xf4\xefg\x8f\xc6:)\xfe\xe5\xc64*\x8f\x89\xe7\v\xa1\x93\x83 \x86x\x16!Ȼ\xbcs\xab\xa7Z\xa3\xbe\xecV\x9dB\xf9\xae\x9a\xa2\f\x88\x80a\x1c\xb1\x1f\x8eH\x88\x12\xf6\xd3mN\xe2{3$\xafS\xf7Y\x93\x1f\x96Ձ\x1f\x17\xa7\xbăO\x01\a\xd5\xfb\xd3#\x85\xbf\xc4\x0fW)\xa5]\xd7\xe1rs\x89'\x977\xe8\xfb\xae\xa0\"mסo\xbb\x95\xec\v\n\xb9\x8f\xe3\x11\x06\t\x17\x9b\x0e\x8dk\x01N\b\xf3\x88\xe4g\x04?\xc1\xcf\x13\x82\x9f\vrc\x95C\xe0\x9c\x93¡\xa1u\x19\x92\xce$JfF\nI\x12т\x17\b\xb5\x9a\x96\xa36s>\xc6\xf5\xaa\xa4:\xb2\xe7\x141\xf9%\xf4\xc7G\tQit\xd2ί!\xa6\x84\xaeq\xb8\xec\xfa\";\x1c\xe6\x80\xd7\xc7c\xf1\xdb/\x88\x8bz\xb3\xcbP/\xff\xd8\x00H\x04t\xd6a\xd3\xcag5z\x0f\x1fcq\x9d\xcd\xd3xU\xf5q\ns0\v\x1co\r\x15\x8f\x8a\xfcYg\x19\xe3E\xd3\xe2\xcd4\x95\xcf<V\x9f\xb1H\x18\rZK\xf8\x9d\xebk\x1c\xbc\xc7~\x928\xe6Č7\xb3Gg\xac\x14\xf7\xcc\xdf\xe3\f\xcd+\xb2P\x9e\xa4\x01;\x871\xc4\x12H\xb4p\xfb\x12\x0eӄ\x9f\xbd\xfc\x06\xde\a<\xbf\xb9\x115ȉ\xbf\x02=:\x98Q\x91\xacA\x1d\xf0\xf2\xd7I1'I\x0e$\x02Y:\x89\xe7\xd5\t\xbfH\r\x93 \v$h\x98\xd18eN\x04\xd8e\xf5\x90\xa5s\xcb\xd6\xc6,\xeb\x00T&FJ\\M\xf99%\xc0\xd8%[`\xd5\xcfк\x80\xf3\xca=P\x03\xb8\x8a\xef\xc4\xe2M\xf2`\x8fO\x12\x83̧v\x90\xc5TI\x03\xa4\x12\xaf\xad\xa3\xf5\rNj\xb9\xeb\xe7lr\xa5\x96ʆ\x94h'\xd7*[\x03\x82A\x02#FB\xd4\xd0%\xa4\bb\x91\xe6\x19\x02\xeeT\xf6\xda(2茅5N\xef_\x83\xdeX\xf4\f\xf4`\xd89\xe28O\xc5\xfe٩\xaa\xc1h8\xd5\x1c#\xa6\x181\xa5\x84c\bx1\x8d\xf8z\x18p\xac²\x04\xbd\x8c\xd8OS\xb9\xdf\xf2\xa5\xf4b\x1a\xb1\x8f\xe1=Ղ\u007f\xd5\x06\xe0\xe3\xfe\xff\xbb\xda\x00\xfc\xd1?\xf8\x87\xd8nv8\x1c\xee\xfe\xb7_}\xf5\xb3\x16\x1a\xb5*\x89bA!zh\x84\xadL\x82\xd38b\xf6\xb3\x98NTES\xbaPB\xf0\xf1\x81\xa7\xf3\xa2\xf5\x97\x9b\xf0\\\x83p\n\xfd\x9f\xee\xe6O%\x02}\xd3a\xdboVp=\xa9s\xd7q\x1c1L\x13\xc80\xae\xfa\x1d\xfa\xa6נ\x94e\xcfh\x8cU]\xbc[\x9b\xfc\xe8\xf7I\xcc8\x8eG\x8c\xf3\xb4\xec\xee!\xeeu1F\x1c\xa7QR\xd9Μ\xbeT\x02A40\t\x02\x9d\xf6}\x8f\x8b~\x8b\x9b\xcb\x1b\xf4m/\x16\xa2\x8d\xa8&Dw\xbf6[\x89)\xe0x\xb8G\x98GXN\xf0\x87#\x06\xaf\xf9\xe2\x9c=\xc0\xe5\x10ώd\xd6*\xc2Q-_+\xb3\xb4\xea\xf3\xf5\bsP\xa8?\xad\xc2Z\xa4\xf0G-\xfck'\xbc\xfa\xfb\xcc!bTEAPn\x00W$\xbbq\xf6\x1a\x9bL\xb8\xe8;l\x9a\xa6\f\x82\x87q\xc6\xdb\xe3\x80xb]\x1c\xadA:Y\xc1\x90\xee^\x19\x06;%g\xfa(\x84\xc1\xfc\xb4\x13\x1e&\xc7Q\xd5\x04\x14\xaf\x1c]\x11\x88\x0f\xffZ\xad\x11\xa2\x1c\xa6\xcf7=\x8e\xc1KXO\xa5\xd40D8x\x8f\x04\xf1-\xb0\r\xe1\x87W\x97\xf8\x8bo^/|\x80\x98p\x88\td\b-\tLNF\x11\x04e\xa2\x17N@\x0eaj$7\xc0g/\x01,\xe6C>$\xfc\xfc\xd5k\f>\xe0\xfbOo\xd17\xddR\x06e\xe0.\xf1\xbb\x8b\xdd\rWױȿ\x8a\xab]!\xc5\xe9:I\x9b\x1c\x93a\xf6\xdc\x04\xd4߯H\xed\x17⣡ű\x8fWh@n\x04H\x11\x80%}\xb0F\"\xf2֡\x18|%,\u05ed\xee\xe0\x8b\xb6\xb04\xd0\"j/υ\xf3\xb4\xafiv\xd5{W\x1bP\x82\xa0\xb2δ\xa8Ur\xb3\x02\x94p\x1d^\x1d>\\VC\x9c\x83\x854!\x90\v\r5\xaaiT\x152f\b\xcc\x16\xe4D^\x9a8!x`\x0e\x12\x88\xc6)\x82\x90`\x99\xd08B\xd74h\x8d\x91\xb5L\xa8\xac\x9d5U\xd0\x12\xc1ń\x96\x81\x0e\x84\xae\xc4d\x03s\x8a\xb8\xf7\x1e\xaf\xc7\x19\x87\xe01\xc5\b\x9f\xb8\x18\x94%^\xac\xc2cJ\x98f1\xe9\xb2$dX\xd6A\xe0/\xf6o\u007fS\xf5\xe8\xedǒ\xfc\x1dm\x00>\xfd\xecs|\xef\xf3\x1f\"\xa6\xf8\x9f|\xfd\xe2\xe7\xffa\x98'I;\xd3)\xdc{/.W\x95\xf9\xcf<Ϙ\xe7\x19Ģ;]r\xd3S\x99\x00CXw\x92ƚe\x87\x1dӃ\xbf_\x8a\u007f|\x14B\a*\xa3\x1a\"l\xbb\x1e}ۯb\x84\x01\xd9Ս\xf3\b\x1f<\xdaF\\\xfd\x9a\xbcK\xc72\x91g\x16\xbeӝ{=5\x19\"\x84\x14\xb0?\x1e1\a\xaf\x19\xdfU\xf1O\x11\xc7y,$\xb8sS\xbf\xe4\xb1/r<\xe7,6}\x8f\x8bN\b\u007f]\xdb!\xe9*`\xbb݉\xcd\xee\xc9\xf7\t\xc1c8\xdc!L#\xfc<\xc0\x8f\x83\xc823II\x9b\x98F\x03\x8bje\xc1َ=s\b\x82\x97\xcf6\x86E\xffMK&\xfd\x14B\xb1\xf1}\xc07\xab\n\xea\x14\xc4\xdd.\xb14\vA\x9b:[!5\xc6\x10\xba\xc6a\xd75p\xcaLO`\xdc\x0f3\xee\x06\x81&kk\xf8\xe4\xac@\xe7Xv\xd7\xd50\x8b\xce\x1atNVQ\xc7\xd9#%.\xe6&\xb4\xc2&hհ\xf0\xeaO\x16.\x80\x89\xbc\xcaĊ\x9c\xe05\x1b\xe0\xba\xeb\xf0z\x9c\xa0\xb4\x16\xa8D\x1csH8\xcc\x1e϶\x1b\x84\x94\xf0\xecb\x8b\x97\xc7\x01\xaf\x86Q\xbf\x87H\x03]$MLD\x91\x17JS\x98\x8a\f\xd1\x19%\x8f%\x82m\f|\x14f\xf7\xaa\x18\xe9\xfc\xfd\xf2\xee\x0e\xc3<\xe1w>y\x8e\xeb\xcdE\x89I\xe6J\x92w\xca\x0f\xa8\x132\xb9\x0e\x01b\x06'\x99\xf0\xc5$H\x12\x01m\xe6ð\x96\xe9\xec\xfb\x9f\vvi 4n\x17\f\xc3f\xd5h\xe1\x81\x1cT=\xf6\xb3\x8a\x882a\x91\x8bn7\x13\x17\x13%\xbdNT\"Xh\x03\x15\xf7\x9f\x19T\x18\xfb\x1a\xbf\xad\xaa\v\xae\x8d\x83N\u0087R\xe4\aDWN*q\xcd\xef7\x9f\x9cI\xb91\xe0JiP\xb8\x05\xa9\xec\xffa\x8d$B\x1b\x00\xac\xa9\xa1\x91\x10Ņ\nl\f\xc8N\x18\xc7\x11\xc13\xbcW\x131\xcf\xe0ô\xac0\xf4W\b\xb1\xc4[\xe7Gg\xaczDd\x9e\x8b)1\xdbsL+\xd4#1/i\x81Y%3M\x88\x10W\xc2\xc8\f/\x93\x1d^\xfb\to\xd5\x0f\xe5\xfd\x8foM\x02\xfc%>J\x00\xbf{\r\x80\xb3\x0e\u007f\xfc'\xff\x04m\xdb~\xf6\xf6\xed\xab\u007fv\xf7\xf6Uq\xc9\x03\xa0\xda\xf6Pa\xaa\x80\x9f=\xc6a\x021){\x9c\x96)\xc5{\xb4]+\x1dnU\x91\xc5\\\xc6)\xab<>\x88\xf6\xad\v\xf7c`S\xed?\xe0\xacŮ\xdbJ(\r\xd6J\x00ɼ\x9e\x918\xa9\xf3_W&\xea\x1c\al\x8c\xd1\xc9Ka\xf2\x8a\x05\x9f\x0f\x85a\x96\xd5\x01X\x82\x83rɈI\n\xe30O\x98r!\xae\xe7uBI\x1e\xac\xe70\xe7\x1c\xb6\xfd\x06\xdb~\x83\xeb\xed\x95\x18v0\xa3\xef7\xd8m\xb6+ӝ\xfc<\xfc4\xe0x\xb8\xc34\x1c0\x8f\x03b\x98š\xaf\xed\x96$\xbf\x1c\xe5K\xcb\xc4\xc2gȑ\\<\x03<\xa2\x0fe_H\x95\xff~\xd2\xc9\u05ebw\xc3\n8\xaf\xb4\xf19Xi\xf0\x01\xa3\x0f\x05\xeeg\xf5\x9e\xcf5\xa01\x06}+Ѽ\xad\xb3\xc5W?0\xe3\xedq\xc0a\x9c\x1f\x98\xc0Eg\xc0\xe7Mߋ\x9b\xf0\xae\x11ӟ9DL\xaaǯY\x885\xfcoN\xd6Ft\xf2Y\x15\xa5@\xd9\xf1˚d\x8e\x12\xcdz\xd5u\x98S\xc2<ʔ\x1c\xd5c\x9f\x99\xf1f\x9c\xf0\xc9\xc5\x0e\x16\x12\v\xfb\xfd\xebK\xdcϾD\xbc\x1e\xbd\xc7\xd69\x18g\xd0\x1a\xb14ί\xc1\x90\x05EA\n\xc0\fG\x12\xe6ⓐ\x04\x8d!̊\x06\xe4H\xe4\xfc\x19\x1c\xa6\t?\xf9\xe5\x97\xf8\xd1\xf3O\xf0\xec\xea\xbax@\x9c6\xcak$\t\xab\xa2\x9dY\xfe\x85=\xaf\xd07\xa5$H_\x9e\xfc\x1f@\xefiI\xcf\x03W\\\x83:e\x10\xd5\x14N\x05\xf6\xcfp3\x9dC\x83iA\rb\nbǌ%\x950o\xff9%$C\x15\xa7b)\xca\xc5\xe5\x89\u05fe\x01e\x8d\x90\xd2\xe9\xa9R\nf\x8e\xad\xae\xad\x85\xa0|\x95B\xaa\xab\xf2+P\xbe\x86W\xca\x13\x98Eޛ\xef;\x9b\x12Rj`\x9c\x83qV\"\x87\x9d\x83m\x82\x10m}\x90\x15\xda\xec\x11\x83\xc8\x17C\f\xf2\xfb(<\x02k\x96\xf8q\xab\xd3?U\x81\\\xb4Rp,\xe6O!I\x84q\x8cQ\x8c\x8a\x88\x00c\x11\xf4\xefr3\xf8f\x9e\xbfEy\xf9Vş\x01\xfc\x05\x80\x8f\x19\xbfߵ\x06\xe0\xef\xff\xf8O\xf1\xe9\xa7\xdfC\b\xfe\u007f\xf5\xe2\xeb_\xdcr\x92\xecr@:\xd0y\x9e5\xab[\x0e\x82\xe0e\xefoxm\xf3\x91\x9b\x05&!\xa4\xf8qZ.\x15C\x85O\x10CzT\x12\x98Nn\xce\x1aN3f\xf15o]\x8b]\xbfAc\x9dz\xa0/\xb2\xb4\xd1OHJ0\xdb\xf6;\xb4Ε\xb6\xd3X\x03K\xb6\xe4t\xe7\xc9\xd9\x1aS\xa6\vC\x06)1\xee\x0ew\x18\xe6Qdx:\x953\tA\xce\xc7 \xc5_C~\xea\x06\x85V\x19\xf2\xcbn\xadm\x1b\xec\xfa\r\xb6]\x8f\x8b\xed%\x8c5\x1a6\xb4\xc1f\xb3-\xa4\xbc\xb2\xbeH\t\xc3p\xc0\xb0\u007f\x8bi< \x06/\x85\xbf4\n\xf4\x9e\xd5\xdcR1\xb3b \xe8ğS\x01\x97\x95\r\x978\\\x1f\xd7Y\v\xfc\x80<'\aQb\xc6a\x9eq\x9c\xbc\xc8\xfb Ls\xa3\x05\xd7\x1aS\xacyERi\x8b;]\x8c\t\xaf\x8fG\x8c\xb3\u007f\xa8\xb2\xb0\xb6\xec\xfcq\x824\xc8Ęd'\xaaִ\xc3\xec\x85[\xe6h\x8d\x14\xe0\xbc\xe9L\x96\x06֯\xceP\xe5)\x91\xf5\xd2`\x95\xfd\ti\xea\xe9f+(Gb\xb0Y\x92\x15\xef\xc6\x11>Ft\xd6\"\"\xe1\xd9n\x8bg\x87\x01_\x1f\x8e\xb0dD*\x19#v\xdd\x0eD\f\x1b$\x00(\xaf\x8c\x1a#\x11\xd3A\xd3\x12\xc5\x16\x99\nɲu\x06&JS\x10N4\xedS\b\xf8믾\xc2\x1c<>\xbf\xb9\x05\xa9WG\x0e\x00\xa2\xeaM\xe0z\x05R\xf9\x06ԅN\xa6\xf8\xec\x1faK\xd1%^\xb8\xfbL\x85\v\xb8Z\x9d\x17\x9f\xfcR\xfc\xa9Z?\xf0\xe2\x10\x88\xbc±\x0f\x8c\xb5\xa86\xf1V\t\x1c9SN\x02\x93\x8b.\xa7bi\x8bR\xfc\x17y\xf2\x83ᴾ\xd6\xd3\xc3\xc6\x18:\xe1/\u007f\xb7,\xbbb\xacx\x05e\bA\x85`,\u0089\xdc\v\x95\xb3\x8a5-P\tz\x86\b\xb6a\xcdc\x10\xa8߫ѕp\xa9f\xb5&Α\xea\x822Ɛc\xb4Q\x9c2s#\x90\x91\x81ڞ\x9cJ\xd3\xc5%\xaf\"\xf8\xdc\xf43\xd8\x18i`\xd7Yθ\xff\xd0\xe9\xff\xdbo\xf2#\x80\u007f\xf9\x93\x9f\xfd\xe2#\x02\xf0]j\x00\x9e>\xfd\x04?\xfe\xe3?\x05\x80\xff\xc1\xebW/\xfe\xa3\xc3\xfe\r\xc8\x18\x05\x1a\xa1\xb6\xbe\xa9䰧\x94\xb4\xf8\x1bu\x04\\\xee,\xef\x05)\xe8\xfa\xbedR\x97b\xa8{F\xef\xf9QK\xdc\f\xad=V\xfc3\x13\xb7\xd7\x14?\"S\x8a\xbf\xec[#\x0e\xe3\x11\x00c\xd3\xf6\xe8\xdb\x0eΘb}\xe9t\xca\xcf\xc5\x1fD\xb0\x9a\t^\x8eE\x12\x9f\x80o\u07be\x81\x0f\x1eۮ_r\xd1!\xc6*s\xf0\x18\xe6\xf1\x81ֿ\xec\xeb\xb2\xf4,OV`l\xbb\x0e\xbb^V\x15\xdb\xcd%\xfa\xaeG\xdf\xf5\xe8\xbaNI\x8b\x8bl\x8a\b\xf0\xf3\x88\xe3\xe1\x0e\xf3pD\x8a^:\xff\xae{7\xac_MjY\xae\x14B@\b2A\x88\xed\xa9\x82\xa7\xfayB\xd3\xd2b\x8a\b\xd5\xce\xf9\xf4\xdc\\M\x1aD\x98c\xc2\xdd0aP\xe8\x1d\xea\xb1/\x96\xb7R\xf8\xc5\xcb_\x0e\xbdF\xdd\x02%!1\xe2\xcda\xc0\xe4\xc3\xf9\xe2o\xceY\xdc.\x1a4\a\xc2e\xbb\xc8\xfeF\xefK|\xef\n\xa5\xa0\xf5\u0382*k\xe7\xd35O.\x89y\xba/\xc6G9\xb9\x0f\xe2\xd2\xf7l\xbbE\xe0\x04\x8a\x11\xa3\x97\xb5\xcf\x14#^\x0f#~ps\x05$i&~\xe7\xc95ތ#Bb\x18\xcb8\x04\x0f\x9f\x12vm\xa3\xb1\xbd\t1f\xcbZBks\xba\xa04_\x06@\xe3,\x10E\xfb\x9d\xdd\xe0d%%\xd3\x1c\xeb\xe7\x18S\xc2\xcf^\xbe\xc4a\x98\xf0\xf9\xd3[\\\xf4}Q\x8eQ\xade\xaf&\xf0U\xda\xeb\xca^A\x1d\x19\x8d\xe8\xc3\xeb\xcc\a1\x18b\x10\x1bY\x19\x18\xdd\xf9\xd3\x12\xecC\xc5\x16\xdc,\x0e\x83\x19\x9d\xab\xec9\x8b\x9d>\x96 \xa3\xc5\xedw\xb1\v\x8c1j\x00\xd5\xd2L\xe7\xb9^\x8c\xb0xYk`\xc9 (߃YJO\xf5\xfa\x1e^\x04kx\xbf|\x1dhA\x05\xd2Z&\x9bm\xces\xd3ȴ\x0e\xf8ZPL\xd2\x14\xc2P}/i\xa2\x12\x18\xd3\xe4q\x1c\a\f\xc7\x01\xf34+\xba!\xbe\x1b1\xa4\x05\x91\xc8\xc7IJź:\xaaݳ\xb5fQ\x82T\xbb\x8b\xbc\xce\b)\x89R!Kv\x1b\a\x03\xb7\x9c{ս\xb1\xff\x00\xff\x92_q\x050\x00\xf8\xc9ǒ\xfc\x1dj\x00\x9ck\xf0g\xff\xf8\xbf\x8d\xbe\xdf\\\x1c\x0e\xf7\xff\x9b\x17\xea\xf5\x9f;\xf9\xe0%ا.l\xe3q\x94\xe4\xbb\x125+\x17AP\xa3\x1f\x82\xc0\xde\xd34\xaf\x0ew\xc9\x10\xe0w\xf8\xe1\xaf\xf7\xfe\\+M\x89\xb2g\n6m\x87]\xbf\x11&s5\xad\xcd~\xc6^\xe3{\xafv;t\xaaF\xc8d0\x97Iqd\v\xccn\x14F\xab\x1f\xc7q\xc0\x8b\xb7\xdf\x00L\x05\x92\xcfgR\xe2$\xe9~\xf3Pvܥ\xf0\x1a*>\xf9%aMߛM\xdfc\xd7uش\x1b\\]^c\xb7\xbbD\xbf\xd9\xc0\x19[\xdeo\xa3\xcdBJ\x01\xc7\xe3\x1e\xc7\xfb7\x88\xd1W\x88\x02=Xs\x9c\x8e6B0\x92\x06%x_\xa6\x86\x9cS@Jv˯?\xb3\xf1\xe3;\n\u007f!1Ҳ\xdb=z\x8f\xbba\xc2\xe4\x03X\xc1\"g\fzg\xd17b\xf0\x93_\xbd3V\xd9\xff\x8bb\xe2\xeda\xc0\xa4v\xc1\xeb\xe2o\xce\x17\xff\xea32̸\xee\x1a\xb4NR\x1c\xf7\x93\xd8N[k\x1e\xc8\xe1ke܊\x1c\x8a3R\xb3\x12`\xb3\xf6\x96\xf0a\xe1?0\x18\x9d2\xb53\xef!\xb1\x90\xa8^\x1e\x8f\xf8\xfe\xf5%\x9c\x95\xeb\xf2\xe9\xc5\x16?\xba\xb9\xc6O\xbey\rk$q\xed\xe5q\xc0M\xdf\xc14\xa4a<\x111[ղ\x86\xb3P\x84OKS\xdbZ\x8b@\x11\x14\x81h\xa9L\x91!\x894\x91y9\x84\xbf\xd9\xdf\xe3~\x1c\xf0\xe9\xcd\r>\u007f\"h\x00/O\xbe\xe6u\x96S\x9fVk\x01\x99\xda\v\x89\x97\t\x9c\x8cX\xca\x12\x83\f\x97u\x01i\xa1\"\x93=\x00\xd4`\xa7D\xfb\xc9kB%\xd7c\xe2\xd2lq\xaa?\u05f5\xc1om7+\xde#,\x91е\xdb /\xa9\xa2\xa6\x84\x89\xd5i>\x92\xf3\xc01G\xf6\xf1ٞ\x99s\xf1\xcfL\xfe<\xb5\xeb\xf7\x8cJ\x16\xacW\n+^AF\f\x91%\x8e\xf50Õ\x84\x965\xfd\x92\v\x9f\x80\x99AvA!\x9b\xae]\xd0?\xee\x10B(\x85\xdbD\x92\xb5@\xf6\xe6\xe0P\xd8\xfb+\xff\x8d\xc4H1Tk\t\xc99MY\xb3و\xef\aU\xeb\x89l\t\xc5\xcc\x18\xe2o\f\xa1\xff\x06\xc0/>\x96\xe4\xefP\x03\xf0\x87\u007f\xf4\xa7\xf8\xe4\x93\xef!\x84\xf0O\xbf\xfe\xeag\u007f0ϓƄ\xaa\xb9\xcf4\x96\x9b\x8e\x880ϳ\xb8J%^A\xc8!\x06\x84 \x11\x94VIY)\x9e1\xd3x\x17>\x14O&\xff\xb5\xd8\x1f\t\xc06\x17\xff\xeandf\x1c'\x89\xef\xed\x9a\x16\u05fb\v8犙G\x8e\xc0\x95¿\xc8\xf0j\xf2_\xd6\x03\xdf\x0f\a\xbc|\xfb\x1a\xceZ\\n6\xe5\xb9d\xbd\xf4q\x1e1L\xe3\n\x1e\xa7ڝ\x8e\xa8\xa2\x95\xc9!\xd2w=\xfa\xb6\xc1ns\x89g\xb7ϱ\xdd\xed\x8aLQ\x02E\x12\x90\xa4y\x9a\xfd\x88ḇ\x9fǕ\x0f\xfd\x9a\x97@\xe5\x10\x8a)!Fٽ\xcf\xdec\xf6\x1e)\x04\xdd\xcf\xe2\x84{a\x16\xf8\xbd*\xfa\xef*\xfc\x99d\x99\x8b\u007f\x02p?\x8c\xb8\x1f\xb5\xe8\x12\xc19\x83\xbeu\xe8\x1b\ag\xf2ᕹ%\xb6\x04\xfa\x80\x80\xc9\a\xbc=\x0e\b1=(\xfe\xb5\xc7\xffj7k\xaag\x93\x18\x17\xceb\xd3\xc8\xfbw\x98f\f\xba\xfb_\x91\xfa\xe8A8]E\nd\xd0{6\x99\xf5\x81\x1a\xd4ˀJ\x9a$\x95\xd8\xe2ƚR\x80\xf6ӌ\xa3\xf7\xb8\xda\xf4%\x01\xf0w\x9f\xde\xe0\xeb\xfd\x01\xfby\x865\x847Ä1F\\4\r|2%\x99/\x90\x11\xcbژ\xd4!\x90\x11L\xd2)\x13\v\x11.\xcb\xf4T\xcaI\xc4\xf0\xd5\xfe9\xab!~\xfe\xcd7\x18\xe6\x19?|\xf6\x1c۶+\x92<^2\xb3\x978g\xaa}wkS\x1fֺ)\r\x8a0œ\xec\xe39\x81\xeb$P\xae]\x02\xe5\x1e\x13y\xa0\xc0\xfc5\xba\xc5%\xa3\x93\xf4ߘ\x95\xcdoig*3\xa2\x98\x02\x8cm\xb1\xb6\xfc5˞\x9e\x97\xa8\xe7<\xdc\xe7u%Q\xa5\x19\x05\xd6\xc6@z\xceŜ\x85q\xd2\x1f\xa4tBF\xceIż\x8c'9P\xa86\x0f\xaam\xd2\xcbzA\xff\x9c4<,\x84\x00\x1f\xa2zV\x10l\xeb`\xd9\x16\xb2\x9fՕcT\xa2tT2\xae\x9f%iu\x1e'9sy\xed\xcaG\xbc\x9c+\x84\xacB\x80t\xe9\xc6\xc0XWV\x12\xa7\xf3{`\x16\x13\xa1\xdf\f\a\xe0k\x88\f\xf0\xe3\xe3;\xd3\x00\xfc\xe1\x9f\x00\xa0\u007f\xfc\xfa\xf5\x8b\xff\xd9\xdd\xdb\u05cb\xb3\x1e \xbb(\xb5\x9b%\x02R\x88HsD\x8a\xe2\xe7nJ\xf1\x8fZ\xfc\xe5\xc2n]'ҿ*T\xe2\xbd@R\x15\xeb\xfa\xd0u[n\xa0]\xb7\xc1\xa6\xeb\xd7\x13#3\xf6\xc3\x01\x93\xf7\xd8\xf6\x1b\\ně=\x1f\bN-\x86K\x11ˤ\x99\x8a\xa0\x93X౻\xe3\x01\xaf\xee^\xa3i\x1c.6\xdb\xf5n\x10\x8c\xc34`\xf0\xd3\xda\u007f\x9ej\x80\x9a\x8a7{\x86Y\xfb\xae\xc7n\xb3\xc1\xed\xf5-\x9e\xdf~\x82\xae\xef\xd5\u007f?\"\x85\x19\xc9\xcfH\xd1#\x86\x00\xef'\xe1O\xe4\xb5\t\x9d\x14}\x16\xd5A\x88I\xdd\xee$\x19/$\r\xf3\xe1Ũ&\a\xbb\x10\x89\xcdr\x96\x98\xe5\x1ds\xaa\x0e\xa8s\xeb\xd2\xecV\xe7t]B$\xee|o\x86\t\xa3\x0fp\xd6`\xe3\x1at\u0588\xfcM\x0f[y\x8e\xf2\xb97\xd6\xc85\xa2\xdf\xeb8N\xb8\x1f\xa7\x12zsZ\xfcӉ\U000a1e3dU{歵\xb8h\x1a\x00\x84\xd1{\xec\xc7iM|\xa2\xf3g\x14/C\xe1\x03\x8aD\x91\n\xf2\xe9f\x80J\xd2c\xact\x93\x96\x8c\xca\x1a\xc5byH\xa1@\xf7wӌ\xdb݅\x16\xbb\x84\x8b\xae\xc5\xef<\xb9\xc1\x9f\u007f\xf5\xb5L\xed1\xe1\xe5qē\xdb\r\b\t\x91\x00\x97\f\xace\xc4`\x10(\x00Av\xc3\xc4\x04\xaf\x1c\x9c\xa5\xf1\xb0\xf0Qv\xdf\x04\x03cEC>k^}&l\x1a\"|s\u007f\x8f\xc34\xe1\xfb\xb7O\xf1\xfc\xea\nN\uf2d8\x18\xd6$\x10\xdb\xea\x1a\xa3R@k\xdeJb\x86e\x01\xfe\xb3\xacW\x9aK*\x1c\x83\xbc\x02H\t\x15\x91S\r\x80\xa0\x99\x03\x99\x1c\b#\xe4@\x03\x10\xa2\xa0\x00lU\xb7x\xaa\xb5_\x8aZ\xce\xdc Ck%C\xb9\xdf2\xe1s10\x82\xe1\xc5-\x90\x17(\x88\xb2\xdc2Oǜ\xea\xf9yE\x92L\xa7Z\xf8U\xf1\x97\xaf]\x88\xce\x04<\xb0\x19:I\x10\xd4\xf0\xb1y\x9eq8\x1e1\x8e#\xbc\x97f\xba\xf6\a1J\xfed\x06\x9c\x13\xd5Tl\x1c\\\x8c\xe8\xba\x16i\xb7E\f\x11\xd34a<\x8e\x92\xbf\x12\x82<\u007f#\xbe\x03\xb5QT\x12\xd8@\xceBu\x18-7\x15\x19AIY\xd6T\xbf\xc1\xc7\xdf@\xb2\x00>>\xbe+\r@\xd7\xf7v8\xee\xff\xf3\xaf\xbf\xfa\xc2r5\x19\xa5\x180\xcd\xd32Y@\x88\u007fQ\xa1\xb2\xdcig\x19_\xbe\x91\x8c\x91B;\x87\xf9\x83\x8b\u007fݕ/\xe6!\x95b\x87\x81]\xbfE\xdfv\xd5H+\x9d\xfb\xdda\x8f\x10#\xae\xb6\x17ش\x9d\xd8\xf92\x8a\x9e\xbf\x90\xfc\x94!O:\r\xe7Cn\x98'\xdc\x1f\xf78\x8c\x03\x86q\x84\xb3\x16\x17\xfdv\x81\x88I\xa6\xe5\xc3<`\xac\xc9~TC\x95z\x18\xd3rp\x90\x01v\x9b\x1d\x9e\xdd>\xc5\xf3\xdbOp\xb1\xbb\x048\xc2O\x83\x18\xf6\x84\x19)\xeaNN\xa7\u007f\x81\x0f\xa9*fT\x1a\xaci\xf6\x18\xe7\x19>\xe8\xbe=S\xc15\xc3ݮ\xbb\x11!\x1aYS$x\"W\xcc\xec\xe6\x87HL\xa6\x1e\x06\xd5\f[c\xd0Z'V\xb4\f\x1c'\x8f\xfd<\x03 \xdclz!\xa5\xad\xe0c\x16\xa6zLh\x9c\x90\xf3r\xe3\x10#\xe3~\x1c\x85\xa8\x873\x19\xe2g\x8a?W\xa8J>Z\xb7\xce\xe2\xc69I\xe5\x8b\x11\xf7Ø\xcf/\x1dj\xa9\xb2饇k\x8c\x93\x0e\xa0Z3\x83\xce5\a\xc0\n\xcdY\xbd\xb7D\x98YT(\x14\x17緃\x0fh\x14\x9d\x10\xff\xf6\x84\x1f\xde^\xe1o_\xbf\x11\xc3 Cxu\x1c\x10\x9e\\\v!\x92\tl\x12,\x03\xd12\\4\xf0F\xfc\x18\xb4\xc2\"\xe4\x03\\Ɂ\xad\xb3\xa2\"\x88IH~V\xe4y!\x12B\xca\x06L2\xf0\x8d\xf3\x8c\xbf\xfe\xeaK\xbc\xbc\xbf\xc3\x0f\x9e>\xc5\xed\xc5%\x12)\xa2\xa1Ɋ\xe5UVq\xbcT\x85\xbdg\x03\xa0T\xf9\xf0\x9b\x84b?Ȥ\x81T\xdaD\x19C@\x92U\x86I\xb2\xe7\x965Zi\x1f`X\xbc0\x8a\xcf}^Ud\xf7\xc1:\x1aXI\x88\x91\x13\x1c\\u\x0f.(!\xeb\x0e~\xd1\xcc/\xd8\xcf\xf2R\xf25e@\x86K؏!\x16\xb9\xb3\x86zq\x14\xc7\xc02]s\xb5\xc2\xc8<\x03u\x15L\xa9\xb2./\xa4ǥ9\xc8뷼b\x90\xb0\xa1\x80q\x9c\x84[\xc5i\x15\xa0d\xac8\xa3\xe6@\xab%ǀ˹\x15C\x04H\x8ay۵\xd8^l\xe1'\x8f\xe18\xe0x8 \x86\xa8\xe8\x87)\x88\x05\xa5\br\x16\xa6i\x8ad\xf8\x1cں\x8f\xbf\xb1\xfa\x9c\x15\x00\x1f\t\x80\u007f\xd7\r\x80Qv{٩\xa5\x84?\xf9\xd3\u007f\x82?\xf9\xd3\u007f\x0f\x91\xc3\xff\xf8\xab\xaf\xbe\xf8\x0f\xe6y,LRf\xc64M\n?ʴ\x16\x83\x04΄\x9c\xb0\xa6\xc5߫MlR\xf6m\xd3t\xba\xa7\xfa\xf0\xe9\xbf\xec\xaaN\x8a\u007f>\x95/\xb4\xf8/\xaeu2\x11\xdc\x1d\x0e\x88)\xe1zw)\x06D\xba*\xb0d\x8b\xd40\xbb\xe0\x15\x8a_\xd5\x15\x1f\xa7#\xee\x0e{\xec\xc7#f?\xa31\x0e\xbbͶL+\x99\xf0x\x98\x06\x8caZ5\x05gw\xe3\x00R\x8ap\xce\xe1\xf9\xeds|\xfe\xc9績\xbc\x028\xc1\x0f\xf7\x88\xf3\x88\x18E\x1a&.b\xbe@\x8f\xe5\xb0*\u0382\x82J\x8c\xde\xcbA\x91\x1d\xbf\x8c@\xe2eg\u007f\x02\xdfgYcv(\xf4i!\x11\xe5\x83\xf4\xb4\xb8%ȿ\v\xca0\xee\xacCk\xad\xc4\x1b'\xb1\xe6\xf5I\xa6٦Z\x99\xb0N\xc7^c}\t\x84\x8b\xaeC\xdf.\x87\xf4\xe8\xa5P\xfb\x10\xd6\xf2\xa4jʎu\xf1?\x9d\xfa!\xb2\xb8K\xe7\xb0S\uf210\x84|\x18*b*\xc1\xac`\x8cs\x90~\xe6\xab,\xf6\xc0\xcb\xd4\vU\x8f\x17\xe8\x99\x16ϙ\x9c\xdb^\u007f\xaf\xc6Z\x1c}\x80\xb3\x12\xe5\n\xb5w\x1ef\x0f\x18\xd9\xe5\x8b\xd5q\xc0\xa5\xb3\xf8\xe1\x93k\xfc\xab\xaf^\xc2\x19\x91L\xbe\x19'\xfc\xe0\xf2J,\x92\xf5ʲ\tH\xcaI\t\xc6b\x9e\xbd$\xc2AT\x04\xa9\xc2\xc8D\xc9\x02!\x83i\x13d S\xa3\xcf(OZR\x83\xef\x0e\a\xfc\x9bq\xc4\xf7oo\xf1\xf9\xedS4*=\xa5({}2Z@թ\x8f4\x90\xa7PYJ'Vk\xf9\xb3\x18\x90@ڬ\b/@\xf8\x02\x1c\x01\xb6\x11\x89\xad\xa2Z\xdaf\x1a#\xe49\x98\x12\xd7kU\x9f\x0e\xae\x82\x83\xb0n\xe2R\x8a`\xbd\x06J\xff\x9b'\x04\x93+h\xfe\x02\xb3\x10\xdb\x18\xea|(\x8d\xa5\xb1r_\x13\x03\x14e\u007f\xcfVsE\xd8\x00N\xbf\xc6\x13\xc8\x19pL\xeaT\x1a\xcb\x0e\xbf\x84\xa1U\x83\u007f\xbe^DI \xcc~\x1f|!\xf2e\xa4!%]W\x18\x117\xf6\xce\xc1:\x95\xf5Y\v\xd74EI\x11\x82\x18{\xe5\x9fctm2\x8d\xe2\xbf\x12\x82\x04\xaf\xb5m\x83\xcdv\x83\xcb\xebK\xec\xef\xf6\xd8\xdf\xef\x01\x12\x8e\x17Y\x03\x97\xe4:c#\xaf\xe7\x01\xf1\xda\xc8\x00s\b\xe1\xc3+\v}+\x12 \x03\xf8\x17\x00\xe2\xef\xff\xf0s\xfc\xe5O\u007f\xfe\xb12\xff]5\x00\xb5\x1bZJ\t\xcf?\xf9\f\u007f\xff\x0f\xff\x04 \xfe\xfe\x9bo^\xfc\xb3\xbb\xbbo\x8a<\x0f\x80@\xd1\xf3\\\xa6\u007f\x82\xc0\xffއUў\xbd_\xb4\xb7\x10\xa8\xd9\x18\x8bi\x9c\xbe\xc5\xf4\x9f\xbf\x9eW\xb5\x8c\x95E\u007f\xd1oѵm%\xbf\x91\x9d\xe9\xfex\x80!\x83\xab˝\xba\x851\x8c\xb1e\xd7ϥ\x11\xb0\x8bϿ\x16\xa0\x94\x18\xc7i\x90\xc9\u007f\x1aཇ3\x16\xbb\xcd\x06\u0588\xb2\xc1$\xa3\xf2\xb2\x11S\x98\x1f\x14\u007f*\xb2\xb1\xf5\x1e\xf4\xf2\xe2\n?\xfa\xfc\x87\xb8\xbd\xba\x81%`>\xbeE\xf4s\x91\x10Ť\x85?\xc4\x15\\H 1\x9d\x99g\xcc\xde#\x84\xa0\xbbGBk\bd\x9d4]\x15i\x8fVC\xb46:$S͜\tMyr\xa9\xb2\xd0KP\x8f\x12\xf2B\xcap\xbf\xc1V\xa7\x8f\xcc\v\x88)\xc1\x92\x98\xf7\xe4\x890&1\xc7\xf11*A\x8e\xb1i[\\\xf4\xad\xc4\xdc\xead\xb5\x9ff\x1c\x94\xa0w\xeez`\"$g\x1f\x14\xfd\xc2=\x00pi-v\xce\xc9ELBȻ\x1fFL1\xae\xa6\xfc\x9a\x86\x81\x93\xa9\xbf\xe6\x00\xd4E\x85\xb4\xb8\x93\x16\x87\xec\xc8w\x8a\x02Ծ\x05\xf9:j\xad\xc0\xd6d\xa8xG\x18\"\x8cQV4}۔k\xd6 \xe1GO\x9f\xe0\xaf^\xbdA\x8c\tD\x8c\x17\x87#~ts\rG\x06\x89\x15\xe2v\x00\xb3 \x1c\x8d1p\u0380&\xc28{\x81\xceK&\xbdL\xbc\xd6\x18\xe5\x00\xa8n\xdf\x10,\v\x99ԫ\xa2\x83Y\n\xb1Ѧ\xf1\xa7/^\xe2\xd5~\x8f\xbf\xf7\xe9\xa7xry)E)\t\x82P\xfb ,\xa8\x88J\xd9Ȭ\x92\xf9X!\xe3ei\xa79\x01\xb4\xb4R)O\xe7\xca\x1f(S\xb9jΓ\x12:YY\xf1\x12\xd6$\xde\x02up\x10\xd5\xd3w\x8a\x80qU\xb3Xфj\xd5G\xfd\xa1\x1b!%g\x1e_\xf6\xffH1\x15f\xfd\t\x81\xbf\x9c5\xd6Y\xb0\xb30\xceJ\x93\x92t\x9a\xf7\x01a\xf6\x8b\x92\x81+/\x02pA\xdc\x00\x92\xaf5\r\x8c%@\x8b|N>\xb5\x86д\x8d\xe4y\xf4=\xac5\xc5\x1f%i\xacx\xf0B\x04L\x95\x19WJ\x11\xd38a\x1a'\xcc\xde\v\x0f\xc8Kv\xc7\xd5\xcd\x15\xee\xef\xf6x\xfd\xf2\x15\b(\rE\x88\xea\x03\x80\x026\xae^+\x83\xe0\xd3ol\xff\xbf\a\xf0o>\x96\xe3\xdf\xca\n`!\xbf\xf4}\x8f?\xfb\x87\xff>ڮ\xff\x83\xe3q\xff\u007f\xfa\xfa\xeb/.\xd6D<\x81\xa7bLE\x8f\x9c\xd2:\xa2W\xd2\xe1|U\xbc\xc5X\xc75\r\x82\x0f\xdfJ\x19\x92#7O\xf9\x81\x8f\x15\u007f\x1f#\x86iD۶⓯\avc%\xd26\xefl%\xf0\xc6\x15\xb9Sv\x1f\x9bc\xc0a\x1c\xb0?\x1e0z\x81\xd4\r\x11v\x9d\xfa\t\x80\xcb\xf7\x18\xfd,\x93\u007fe\x1d[\xa8d\xb4L\xeb\xcc\xe2\xe0\xf7\xe9\xb3O\xf0\xbd\xa7\xcf\xd1Z\x838\xdc\xc1\xa7\x8aU\xacd\xbd\x9c\xa8\xb7z\xcf\xf5\xfd\x9c\xe6\xb9x#\x18\x92)3\xd7\xed\x15iOW\x00\x85,\xa4\xa44I(\\\x12\xd2됤2E\xb1DՆ\xcaTƒ\xb0\xf7\xb3!R>\xfc\xb3\u07fd\xa4)\n\u007f`\f\x01s\bE\x16\xda9\x8b]ߢs\x8d\xac!Xw\xe1Ä\xd1\xfb\xb3\x1a|\xd9\xf9\x1bDg\x1fT\xed\xfc|{cp\xe5\xac\u061c\xaa\x8d\xf0q\x0e8\x0e\xe3ʇ\x1f8O\x94|\x80\xd6\x10\xad\x88\x99\xa4\x81'y`L\xbch\xc3W\x96\xb1\xccب\xdez\xb1\xa1ct֖\xaf\xce\f\xffF\x9b\xd39Fe\x85\vLK\f\xdc^\xee\xf0\xec\xe2\x02\xbf|\xf3\x16\xd6\x18\xbc\x19F\xcc)a\u05f5\x98}\x90\xa2n2[[\xdc\xe2\xac\x15T\xc1\x10\xe18{\x91&j\xb3`\xf24\v\xa0\xb5\x84HPE\x80\xf2\x14\x8c\x85O\xa4~\x0e\x19\r\x90\x15\xdd~\x18\xf0\xaf\xbf\xf8\x02\xdf\u007f\xfa\x14\x9f\xdf\xde\xc2Xi~(:\x18\xab\xb3\u007fM\xbcd9\x03`\tv\x85\xcd\xd0*\x83\xa0\x90rAHH\x9a\x85\x90I~\xd9G@\x1b\x18\xce0\xbdZ\x10s\x84S\xdfA\xe6\xc5s\x00\xf5\x84\x0f)bֲr\x95\xb8\x18\x12\x90\xa9`mZ\xafn\x8c\x11u\xc9b\x19\x8cř\x93YV\x17\xe05\xcb_\x87\x10\xc9!S\x16\xbf\x1anY\a\xb8\xb6A\xd37ps\x80\x9f\xc5!\x95\xab\x89ڦf\xb1Gv\x06d-\xac\xb3\xe8\xfa\x0e\xdb\xdd\x16\xfdv\xa3\xf7\xf7\xe2\xcf!>\x04\x8c\x14\xc4b\xd8\xeb `\x8d\x85s\xae\xfc>\xa5\x04K\x0e\x9b\xadA\xd34\xc2\xc1\xf2\xbe\xe4\x16\xcc\xf3\x8c\xcdv\x8b\xdd\xc5\x16\xaf_\xbe*\x9e\x04\x98=8\x04\x90\xb3\xc5gഔ\xb7\x0fH\xb8\xef(\xffDߦ\t\xf8\x05\x80/>\x96\xe3\xdf*\a\x80\xf0\xc7\u007f\xf2\x8f\xf1\xf4\xd9'\xff\xbd\x18\xc2\xff\xf1\xeb\xaf~z\xeb\xfd\x04c\xe4pK\x891N\xa3t\x9b\x05v2j\x01\x9c\xca\xc5\xedu7\x96'LC\x06\xd6X\x85\xb6ӷ\xb8&d\xafw\xae\xf8_nvh\x9b\xaa\xf8\x83\xc4\xd9/x\x8d\xf8\xb5\xca\x04&\xb4\xae\x81s\xb6H\x10\xfb\xae[9pe\xf2\xcd\xe4=\xee\x87\x03\xde\x1e\xeeJx\a\x01\xd8u\x1b\xb4M\xb3*\x96\xa3\xf7\x18\xfc\xb4\x18l\x9c\xec\xd7s#\xe4\x9c\xc3ӫ'\xf8\xec\xf6\x16\xbb\xae\x03\xa7Y\xf6\xb3Ȏj\n\x1df)\x91\xbe\x87Io\xfc\xa0\xaf)\x06y\xee\xe2\xec\xb5L\xa2!\x17\xfe\xbc\xbf\xaf\x8cH\xb2\xfeX\xc2Oj\xc2䉄\x12jG\x1b\x93N\xfb\\\xce\xec\xceZtjmL\xc8A&\xa9\x18\xd00\x80)\x04)\xfc\xda\x04:\"l\x9a\x06\x9bơm\x1c\\\x0e\x17b`\xf4\x01\xfbq\x82\x8f\xf1\xd1\xcb Y+\x93\xffɴmI\x1c\x03w\xc6b\xa3\x9a\xf7Č!\x04܇\x00?\a\x18\xe6u\xc2_\xfe\xfdR\xabV=\xc5\xca\xc3\xe0\x84\xa2U\xff]b\xd9U/\x84ԥq\xb8\xe8\xdb\xc2\xefȏ\xde5\xf2\xba\x01\xe5$\x84\xb2\x9a\x92p Bb]\x1d\x908\xb7\xfd\xe0\xe9\r~\xf9\xf6-\f\t\x87\xe1\xe5qħ7O0\xa9\v\\\xf03\x12\x92\x06\x1fI(\x95\xa9\f\xab\x8e\xe3,Q\xc1\x86\x84p\xb7\xe0\xe5\xba\xf6\xe1\x12\xb7\x9c\x18 }\x0f}H\bٶ6\t\xea\x11b\xc4\xdf|\xf5\x15\x0e㈿\xff\xd9gh۶\xe4\x00Xc\xa0,\xbd\xb2\x03\x87\x06\xdc\b\x87\x06\x8b\x850\xea4BV\xe2\xdf\x12\x1e\x84<5[,\xf6\xc7\xca) $0\xdb\"\xc3\xe3\x1ce]\x91\x82W\xf9\x8d,\xe8BT\x8f\x82\x95\x15uE\xe8X\xd4\x1f\x8b3a\xf6D65\xcfAW\xa30\x84\xa4\x91\xcc0(\xafӒ-J&SH\xa1\x19\xbe'\x90\xe9\xd0\x17nT,\xd0? 9'\xaekaZ\xc9\xe10Ơ\xeb[t\x9b\r\x9cU\xcb^?+yW\x82\x85d\xa8J\x00\xe9\xfa\xd2\x12\\t\xb2\x06\x88\x01M\xdbH\xc2\xe7\x9c\x1b\x01\x03\xd7\u0605\xbc\x19\xe4\x8cqn\x83\xa6mж\rڮ\xc3\xdbWo$\xb3@\xcf\x12\x00\xb0\xd6a\x9a\xe6\a\xc3HwJ\xc4}o\x03\xf0\xc1\x8f\u007f\x0e\x89\x02\xfe\xf8\xf8m\xac\x00bL\xf8\xe1\xef\xfc\x1e\xfe\xde\xef\xfd\xf8?f\xe6\xff\xfcի/ۻ\xbb\xd7Z\xfc\xe5\xe6\x98\xe7\t\xd30\x95\x148g\x8c\xca\xfbbٻ\t\tm\xd9\xd9g\x13\x9dL\x8a\xf96\x8fT'\xfdiѲ\xc6\xe0r\xbbC\xeb\x9a\xe5\xfb\x11\xe0\x156\x17\xa2\xdfB\xbek\x9dLg\xde\a\x89\x01\xee\xba\x12\xf2\x93\x0f'\xe6\x84q\x9eq\x18\x8f\xb8;\xdc\xc3\a_\xa0\xe1m\xb7\x11\x94\xa1\x82\x8c\xe7(&?\xc6\xd2\xc3\xe92\x1f\xb8\xd6\xe2fw\x81O\x9f>\xc5e\xbf\xd1Ղv\xf1\xccHI\xa6\xe4\x14\x13\x12\xc7U\xd1\x17\b=H#\xa5\xd3\xdcb\xe9\xa9S\xba\xc2\xeb)\xad́@\xb4\xdag\xaf\xa4\xcd'\x85\x9f\x01\xb1\xae\x8dI]\xfa\x16\xc2ec\f\xb6M\x8bN\xa3y\x8d\x86\xc4D\x85\t\x13K\x9c\xef\xa8R%\xa8\xc7~\xef\x1atΡq\x06\x96\x96&k\x8e\t\xc3\xe41\xcc\xf3\x83\t})\xfc\x06ɘ\x95\xd4\xcf\x11ac\f:c\xd0\x18*\xfbv\xd65Ƭ\x8d\xcf\xd6XD˘*4\xa8\xacuN&\xbf\xb3aL'K\x01\xaa\xadm\xb5 \xe6\xf7\xaf6\x9fr\x86\xb0k\x9b\x15\xaa\xc5\x00\x1ag\xd0Z\x8bY\t\x93\xa9j\xdaF\xefOV\x12r\xef|\xff\xe6\x06\xff\xb2\xfb\x12\x93\x0f0\x86\xf0\xf3\xb7w\xf8\xe3\xef\u007f\x0f]۠q\x0e\xc19L\xd3\b\xe6\x88\xc8\x12\u007fL\x11 jʄ+M@X8\x18X\xf6\xd0\x16\x04\xb2V\b\x81\xba\xe36\x86a\x1b\xc2\x1c\xa9\x98<I\xa0\x91\xf0\x05^\xbc}\x8b\xc9{\xfc\xde'\x9f\xe2\xe6\xe2B\xde\a\"\xb4\x04Xhq1ڤ\xb3]Б\xb4\xec\xdb\t\xc0)\xe5\xb2\x14`V\xa3\xaeb\xf8\x030ITpV\x19\x10\x18\xc4Q\x9a\x01μ\x94E\x16\bC0\\\x12\x8e\x10c,)\xa2\xa8\x1a\xe1u\xa2\xf0\x9a\xed)\xfb}Y\x93\xd4\x1d\xa25\xf95\xb2z\x16,N\x83&\xd9uD\xb9r\v\x04\tXT/\x8e\b\x96\xa5h7M\x8bnۣ\xe9ZX]\x9b\x15ӥ\xca\xd90\x85\xa8Υ\xb2\xc60Ρ\xb3V\xce\xdbY>_\xc4e]\x11\xa3\x91\bac`\x9cC\x98\xbdF\x10\x1b\x90Ip\xc9\xc25\xa2H\b!\xa2\xc9\t\xa0\x8d\x10\xa1\xef^\xbf\x95\xf3\xd5Z\xb5\x14\x16\xb7\xc8y\U000ab1b95\xbf\x91\x06\x80\x01\xfc\x17\xf8\xa8\x00\xf8\xed4\x001&\\^^\xe3O\xff\xec\xdf\xfbgֺ\xfft\xbf\u007f\x8b\x17_\xff\xbcڡ\x12f?\xe1x<\xe2p8h\x81\x13{ݼ\xf7g\b+6UV\x9b\x96l\xd1|\xbfO\xe3\u007ff\xf3\xaf\tv\x15lf-\xaevR\xfc\xb3[\x1d\x88Խ.\xa1m[M\v\x13\xf6u\xe3$In\x0e\x1e\x8dkз\xed2\x02dbWJ\x18\xe6\t\xc34\xe2\xee\xb8Ǭş\xb3S`\u05ed\xa6\u0090\"\x8e㰆\x14\x81\xe2\x1b\xd05\x0e\xd7\xdb-\x9e^\xdf\xe0j\xbb[R\x11U\xa6\x94RD\xe2<\r\xa4\x8a\t,\xcf\xc5Ǆ\xc9K\xfc\xa6\x05V\x05/\x02\x98}\xc0\x14Bٽ\xdb\xeap\xab\x8b\xd6\xe9\xdd\xc5U\xd1\x15\x0e@҉?\x95}z\xd2\xd3{״\xb8\xe8\x96\t6\xb3\xffC\x92IqVR_H\x11\x16\xc0\xc6Zt\x9d\xb8\xfaYe\x17\x97$Df\xcc>\xe08\xe9t\x8a\x87>\x02\xc9\x18$keG\xad\xaf\xa9!I1댁5X\xb9\xc5\xcd1b\b\x11\x8d\x01\xae\x94\xf5\x0f\x96T\xc0\xfb\x99$Ҵ\xcev!z\x90#\xb0.Dk\u007f\xf4R\x9c+\x13\x94\xa0\xfb\xda5a\x12\xe8\x9cöi\x96\xf4\xc4J\n\xd87\x0e\xd34\x15~@v\xe5\xcb\ue0045\x99\xf1\xb2\xef\xf1\xf9\x93k\xfc\xe5W/\xd0X\x8b7È\x17\x87\x03~\xf8\xe4\x06>D\x18\xd3\xc1\x1a\x8by>\x82RB\xe4\xfa;8l;h\x13 hXVu\x14[Z\x92\x9dzk\xc4\xdd2\xa8\x1d\xb0\x83\x81\xb3\x849\x18L\x1a,\x149\x81U\xaf\u007fw<\xe2Ͽ\xf8)~\xf4\xec9>\xbf}*k\xb9\x94\xd0:V-:\xc1\x10\x03\x88H\xac\x8b\x13\xb3\xc8&\xd5P\xb3\xac%\x94\u007f\xb7\xb6\x19\\:T\x91\r\x9a\xacY_\xa0\xf7\x18\x12\xd0\x10\xa8\x00\x1bR\xb0\xe5(H\x85l\x9b\xc9qm\xe7Vp\xce\xc2\a\xc8w\xf8\xfa\"\x90&\x80\n\x190\xaf\xf4\f\b\x1c*{\xe5,\x17$\xc0F[H\xcd+˼z\x95d\x85#\xe0\x9c\x83m\x9c\"/\xa6RQ\xf0\xd2\\2#E1\x8fz =!\x823\r\x8c5\b\x86\xe0\xb3\x03\xabQ\xd2'\x1aY\x97\x84\b\xef\x1c\xac\x17\xf9p\f\xf2\x99J\x83@\xb0\xd6\t\xf907\fח\xe0\x14a\xf62\xd0y\x1f\xe0CBÍ\x10M\xb1Dfu\xf4\xe1+\x00\xf3\xe1\xffv\x02\xf0_\xe6W\xfa\x91\x00\xf8w\xdc\x00Xk7\u007f\xf6\x8f\xfe\xfd\xff\xfd\xc5\xc5\xe5\xff\xd0\xcf\x13\xbe\xfa\xf2o\x11bP\x1bHI\x99\x1b\xc7\x01\xc7\xc3Ql7U>\x97I~\x04R\xb9\xdab\xedk\xcc\xe2\xf4\xf6m\x8b\u007f\x86\xcfe\u0097\xe2\xe7\x9c\xc3\xe5\xee\x02\x8d\xb3K\xf1G\xf6#\xe7%\xa1/\x01M\xe3и\xa6\x84\x13um\x8b\xb6i\x16S\x93ʟ`\x98F\x8c\xf3\x84\xbb\xe3\xbeL\xfěM\xd7c[y\n\xe4\f\x81\xfdpT\xd7\xd5\xe5\xe6%2\xd8t\r\xae\xb7\x1b<\xb9\xb8\xd2\xe7\xe9\x94ԗV\xae[)C\xfe\x05\xb6\x97\xbdnH\t\xa3\x0f\x88a\x86\xd5]\x9b\xa9\nv\x99\xf8Y\x1a\x03W\xb9ڽ\xab\xf0/l|Ƭ\r\x86׃\u007f!\xea2\x82\ueb6f\xfa\x16\xbds'\xab\x81\x889&\x8c1 \xa8;Yc\bۦE\xeb\x16\x88\xbf.\xb61\x89\x9ey\xf4\x01\x93\xf7\xab\xa9\xbfH\r\xad\x85id\xb2\xe9\f\xa1%\x03G\xd9Xh\xa9\xa6\x91\x19s\x8a\x98\x93N\xfd!\xe2\xaaqx\xd2v\xe5U\x12\x00\xaf\xa4\xa9\xd6X\xcce\xc5@\x0fx\x06g]\xfet\xa7K\xb5\x04\xb0\x9a\x14C\xe4\xe2\x99^7\x13\x9b\xc6\t\xa3\x1f\xeb\x18`ҿ\xbb\xab\xdc.S\x12\x04K\"\x90qb\xd9,\x85\xe3\xef=\u007f\x86\xbfy\xf1MA\xc0\xbex\xfd\x06\xbf\xf3\xf4\x16ƨ\xe7}\xd3\xc0\xda\vLӀ9\x84E\xaf\r\x00\xce)\x02dp\x9c&\xf99\xca\xf8\xa7\xda\xf5H\x91\x15q\v\xd4\xef\v\x02\xb9\xec\x19@\x98\xf5\xebX\xd5%>D\xfc\xe4\xab/q\x9c&\xfc\xee\xf3O\xe0\x92CJ\x11mӊ\r\xaf\x85*\x04\x18\x88YNjr\\ȲB\xca\n\x82J\xcaJ+\xaf\x05R\t\xde\xe2\x1c(\xd9\x03\xb2.\xa3\xb8\xb8TJc#h\x81\f\xe1I\x1b\x12\xd1\xe7Ǥ\x16\xb8tb\x1b\x80\x93n\rK8\x0f\xc7\xcacPI\x8aBΓdRN\xbcB\x94l\xdb\xc0\xf5\xad\"\x1fkD\xa1(\x80\xb2\xcf?r\x93\x81\"q<\r\xe6\xe2\x9c2H\x8b̄\x96)\xa8\xa0\xa0\xb6k\x01\"\x84y.Hh\xe1\x1d5\x8c\xa6m\x850<\xce\xf0~\x86\tQ\xd4# $#g\xf4\x8cY\xfd\x19\x12\xfa\xedV\xa2\xdb'I\x9bd\x1dƭ\xb3\xab4\xd6\xce~xz\xbc\xf9p\xbe\xc0k\x00\u007f\xf5\xb1\x14\xff\x96\x1a\x80?\xfc\xa3?\xfb\xbf~\xfe\xfd\x1f\xfd\a)&\xbcx\xf1s\x1c\x0f\xf70J0\x8b\x1c0N\x03\xc6Av\xff\xc6Xa\xa2*\xfcL$.\u007f\xb9\xf8\xb3\x06\x02\xd5$\xbeo\xfb\xc8]p\xfe\xffۦ\xc1\xe5n\ak\xec*Q+O͆\x16\xc9Q״p\xd6b\x9e%\r\xaf\xefz4Ζ\xfduf\xe4\xfa\xe01\xce\x13\x86y\xc4\xfd\xf1\x88\x10C\x89*\xee\xbb\x0e\xdb~S\xe3\x85\xc2Z\x1f\x8f\x88)\xc9^M\xff\xaau\x0eW\xfd\x06\xb7W\x97\xb8\xb9\xbcB\xdbtȖ\xc5\xd9_\x9f\xf4\x86\x0fQw\x81\x95v8\xe4I<F\x98\x14\xd0f\xfbb\xe5\\\x84\x94V|\x04{\"7É\xcdg>\xb72L\x9f\v\xfeiѯ\r@\x1a\"\\\xf4\x1d\xb6M\xa3\x9c\x06.\xfb\xfa\xa3\xf7\x98u\xcf\xe9\xac\xc1e۠!M\x19\xab`Tҵ\x8b\xac\b\xa4\xf8O\x9a\xfeW\xaf\x1f\x88\b\x8d\xb3h\x94\x1f\xd0\x1a\x03\xbb\x82\v\xe5g\x87\xc4\b\x9c0%\xb1\x1e\xf5\x99\xb0\x98\"n\x1a\x87\xe7}\xb7L\xe9\xda\xe0xm\x18m59=\xc8\v\xaaF>z\b\xfaW\xbe\r\xcb!\x1f\xaa\xb5\aW\xf9\xf1\xce\x18l\x9a\xa6x)0\xd6ʧM\xd3\u0098a\xc9\xc8P~D\xac\xc2z$\xc7^'\xdc\xc4\xf8\xe4\xfa\x1a\xb7\x17\x17xy\xbf\x875\x06_\xbd\xbd\xc7\xe8#:g\x85\x9d\xcd\f&\x8b\xae߁ǣ4\xadV\xc7[%\xad\xa1uҽ\x8c\x9a\x19\x11\x04i\xa2ʛ\x02\xd9\v\xc3\x00L\x92\x85\x01f\xd9+\x1b\xf95\x85\xa49\xf1\\H\x91?\u007f\xf5\nS\b\xf8\x83O?-\x0eym\xdbIӭ\xe7}*\xb6\xdc\f\xb0\x05\fI\xbc\x1b\xa9\x11\x15\x96\xa4\xa0\x12\xaeDT\f\x1br\xe4s6\t\x96\xd4@\xf9ߤSk\x8e\xf2\xe5%0@\xbc\rR\x02tO\x1d|\x801-\xf8d=S\xc7\x0fSm<\xa1]\x9fpq\xf4\xfa.dCa\xeb\x8bjB\xeeߜ\xaeI%7d\x99\xd4k))U\xaf\xb5\"\x1d\xad\x06\xa3Bʭ\x1c\vq\x82\x10-dmy\xabڮ-\x12\xec\xf2\x8a\x18\xe2\x01@\x16\xd6m\xe0\xda\x16\xd3q\xc04\x8c\b\b0l\x81 \xbdbJ\x0eA\x83\xf7ڦ\x81\xef:=Ӎ\x0eY\x84\xb6k\x11r\"\xab\xa2G\x1f\n\xffӇ#\x00\u007f\r\xe0\xd5\xc7R\xfc[j\x00~\xfcG\u007f\xf6\x1f0\x18o\xdf~\x83W\xaf\xbe*7\x18\x00q\xa2\n\xc2\xfc\xa7\x1a\x8eֽWT\x86j\xaa\xc9z|\x9e\xb9\xff\xe1\xd3\u007f\\\nl\xd3\xe0b\xbbS\x03\x9f\xe5\xa6Iy\"\xc8T\x1e\x02\xfa\xa6\x05\x91\xc18\xcfp\xd6b\xab\xb2=\xaa\xf6\xc0\t\x92\t0\xce\x13\xc6y\xc4\xfdpP\xe9\x95T\x8c\xbe\xe9d\xf2\xaf\xbay\x80q\x18\a\xf8\x10D\xf6#\xc7\x036]\x83'\xbb-\x9e\xdd\xdc\xe2r{\xa1\xa66\x11!\xcc\xe2\xfe\x95\xa7h\x95\xc4\xc9\xf3]\xc8{S\x10\x9fwBB\x039\xa0\xb3qH\xac\b9T\xe9̹*\xa45d\xcd,\x13\xf0\xacD\xbe\x90\xb3\xc2ϐ\xdarAn\fa۶ضM\xb1\xe3efL!\xe2\xe0%\x98Ƒ)\xfa~\xbbZype\xce\x04\x85-\xb5\xe9\b2\xa5\xd7Ͽq\x16]\xa3Q\xbf\xd6VߋUy\x90d\xc2OI䇺\x8b^\xa9\x14\x98\xb15\x06\xcf\xfb\x1e\xad\xba\b\xe6wa\xe4\xb06\\\xc1\xbbL{\x16\xfd\xfa\u009d\xa8\x9b\x81\xda\x13\x800\xc6T\xd6E\xb5\x80\xa5\xb5F\xf6\xff\xf40\xb4\x96\x19\xe8\x9c\x15S\x1e%\x8d\x85\xc4谎\x9e\xcd\x05(_\xa3\xadk\xf0\xf9\xed\x13|}w\x8f\xc6X\xec\xc7\t_\xef\x0f\xf8\xfd\xe7\xcf1\xf1T\xee\rk\t\xfdf\a\x1a\x8f\x98\x83WD\xaaN\xc9\x14ŋ\xa4(\a\x88oPZ\x10\x10B\xf1\xe2 5\xa8\xb1\xfa\x9e'%\x9b:\x930\x06\xb9v3RE\x00^\xde\xdd\xc1\a\x8f\xbf\xf7ɧ\xb8\xdan\x10gFH\x0e\xa9iа\xd3\xfd;\x8bZ\x81\x19\t\x06\f\v&\xa3\xd9~\"\x034\x06\xb0,ҷb\xc9[>\x81E\xa7/\xc1=\x19\xe0Ӧߘ\x82֤\x8aZ(\x04@)>\xacg\x938\u007f\xd2:*\x1a'y\xa2\xb9h[\x03\x0eqQ\xc8в\x9a(\xf7acN\xabݺ\xc0\xe7xoCkB\xea\x83\"i\xca\x05(\xa1[\x19\xf5\xcc\n\n.ϭxO0U\x06_\"\xe1#\x8a\xba^L\xc86\xcd\x19\xf0q\x8d\x83\xbd\xba\x80q\x0e\xc7\xfb\xbd\xaa52\xb2\x11a\xb2\x8d1\x01m\xd7\xe9J\xc1\xab\xfd\xb0\fC\xf34\x97\xe0(G\xe6\xec\xbd\xf5\xef\xb8\x02\xf8\x17\x90 \xa0\x8f\x8f\xdfF\x03и\x06\xc3p\xc0\xd7_\xfd\xacL\xbc \xc28\x1e%\x1av\x126j\x86wCH%u*\x16)\xdb\x1a\xbe\xaf5\xf0\xdfz\xfaW$\xa1qj\xbcCu\u0097@\xf7\x92\x96F\xca50\xe8\x1bq\x01\x9c\xfc\x8c\xaei\xb1\xed\xfbU2\x9d\xac\x12\x12\xc6y\xc24\xcf8N#\x0e\xe3\x11\xccKPH\xe3ڵ\x9b\xa0\u07b8\x87q\xc4\xe4g\x811\r\t\x13\xbd\xef\xf1\xf4\xea\x12\xcfnnѷ=B\x94\xf7)\xaaS\x16\x19\x03\x8eQ\xa6\xfeb\xf5)\x85y\x8a\x99\x8dͰ)\x82S\x10(\xf6A8\xc8Ch\x9f\xaa\xa2gT\x1f?Ǆ\xa3\x0f\x98s\xdc.\xf3\xca㾞0@\xa4\x04?\x87\xads\xb0\xd6T$K\t\xaf\t)\xa1\xb3\x0e\xbb\x86\x14\x11X\x0e\xa3\xc4(v\xc1\xa7+\xca\x10#\xc69h\b\x14\xa1մ\xbf\xaeqh\xd4n9?\x8d\xa0&DS\x92)\xdfW\xcd\n\xce4-\x80\xa8\u009e\xf5\x1d\xb6Ί\xe1\x11\x9f#\xf0\x89\x95mb\xe0[\x89\x902*B\\t\xff\xf9\xba\v9z\xb5\xbaȝ!\xb4\xd6\xe1B\xc3YV\x84\x02mP[\xb2غ\x06w\xf3,\xcd\xe1I\x03\x91;\x80l=\x9d\x83y>\u007fr\x83?\xff\xe2祑\xfe\xd97\xaf\xf0\a\x9f~\n\xd74\x88\xd1 \x85\xa0ְ\x84\xae߀'\x86\x87\xf2\nX\xe0x\x00\xb2//\x9c\x86\x889\xa04e+\x92\x16SI\xc7#\x9d\xa4\x19\x04\xebĮy\xf4\x06\x83\xfe\xcc|\xfd\xbd9\x1c\xf1\xe7?\xfb\x19~\xf8\xf4\x16\xdf{r\vDF\xf4Q\x98\xe5ѡu\x0e\xe4\x80\b#S93\xd8X\xb5#\x96F \xff\x1c'l;Њ\x1b\xa0\xb6\xc0P\xf3\x9d\x12\xf0#Mr\xbe.32\xb6(\rP\xce*\"\xa3(\x00\xa11\xad\x12\xfc\xf8\xf4\xa3Z\x82{\f\xa9'\x80=\x8f\\\xd6$\xda\xcc\x01Z!\x00K\x86»\xae\xb2\x82\x9ce\xb3\x1fE\x12\x04\xe1ZR\fYW\x85+\bCݍ2Re\x88\x00k\v\xd93V\xc4\xd7ڕt{!\xe7\xe8\xfe\xed=\x02\x8bcg&\xfc\xe53\xd29q\x0eL\xccpN\xaewײȷc*\xa8\xac\x81\xa6a\xbe\x17\x01\xf8\xa0\xbb/\x01\xf8\xaf\xf1\xd1\x01\xf0\xb7\xd7\x00x?\xe3\xcb_\xfe-\xe6y\x94\xce\x19\xc0<\r\x98\xa7I\x98\xa0\xf3\\\x0e\xc8\"\xf7S\t[:c\fA\xf5E\xfe\xed\xcc J\xf1w֖\xa4\xbd\x02\x81q\x12\x8d\xb9\x16mf\x86\xb3N\x88zIt蛮C\xdf\xf5\xe2RVu\xe5\xcc\t\xc7iĤl\xffa\x9aP\x87\xbe8۠u\xae\x14\xc9\xec vT\x8e@\x0eX\xe9\x9dó\xcbK<\xbb\xb9\xc1\xe5ŕ\xe4\xadO\x03\x82\x9f\xb5\x99\x10\tO\b^r\x12\xf4\xbd\xca\xeb\x80\xc0\x02\x93S\x8a\xe0$\xbfG\xb5\xeb\xe7\x02\xb7\x9e=:*ְh\xb8\xf7\xb3\xc7\x18\x84\xa1\xeeL^\x11\x90\xfc\x9c\xb4\xecǝ\xc6\xedn\x1a\x87^]\xc5\xea\xa6+p\xd2\xe40!\xb6Q\xc5!\x88\xba\xa2\x98c\x80\x8fICn,4~\x061%%&2\x9c!l\xbb\x1e\xad\xb3\xab\xa2\x0f(\xa4\x1f\x19cJe\xd2\xe7G\x8a\xfd\x83\xeb\x82\x19O\x1a\x8b\x9b\xb6Achuee\xb8\xb4sF\xf8\x021\xc2\x14\xf4\xf6\x84y~\"\x01,\xb9\b\x0f\x90\x02y\xbf\x83J\x1e\xc3I\xf1v\xc6\xe0\xb2k\xd0+\xd7ÜX\v\xe7\xc2t\xd1w\xb8W\x8bdF:\xe3\x80I+^Bb\xc6\xd3\xcb\v\xdc\xeevxq\u007f\x0fK\x06_\xdf\xdd\xe18\xcf\xd8h\xc6\x01\x11\x01Y\xefm\f\xfa~\v\xe6\x83DZ#A4u\xf9\xf3s\xab\xeb|\xf6@\xac\x83\xaa\xaa\xffd\xb2WV\x9a\x10Ks\xedZq\x8fܫ\x96<\x17\xaf9\x04\xfc䫯\xf1\xf68\xe0\x87Ϟ\xe2r\xbb\x05Ϭ1\xb5\x0em\xdb\xc26\x8d\x84\x86\xd3b\x18$\xd9\t\xb2FJ\f\xe1\x0fHI\x02\xd9\xfaz8i\x97*\x1d>'\x06YM\xfa\xab\x99\x8eڴe;r\x02\xc1\xcf\x1ed\x8cD'gs\xa0\x12`\xb64\x8e`\x06%\x02\xac\xa2\x14|\xe6\xfc\xca\x06D\\C\xfd\x06d\x16\x89\"N\x94K\xab\x9fS<CLI\xdb+\xfc\x00\xce\xeaJ\x95B\x82\x00\xd2\x1c\x0fMV\\\xb7\xbaU\xf3\xc8F-}\x93\xf0.\xb0N6$C\xe8\xb7=b\f8\xdeEp\x90\xfd\xbe\t\x8b\x15:\xd4t(*\x11\xd4)'\xc1\xaa$7\x87J}P\x03\xf0\xe1\xe7\xfe\b\xe0_\xe5\x06\xe0#\x01\xf0\xb7\xd0\x00|\xfd\xe5Oqؿ){\xdcy\x9a0O\xa3\x16\xb2\x00?\xcf\xd2\x18\xf0\xa2\x83^r\xe3\xab\xe9I?xc\xe8\f\xc5\xeaæ\u007f\x81\xec\xb2랭\xbcUX\x92\xecR\x92I)1\xba\xb6Ŧ\xebJ\xe1\xdc\xf6=\xbafa\xfa\xe7s6\xa6\x84i\x9e0N\x13\xf6\xc3Q\v\xfa\x8a\x05)\xa4=m4\x92\x92\x8f\xc6y\xc2\xe8\xa7Ҁ\xec\xfa\x0e\xdf\u007fr\x8b\xa7\xd77h\xda\x0e1xa\xe2\xa6X,\x84\xe7\x18\x90b(\xfb\xf5\x10#\xa2\xfa&\xe4d\xbeӦ)K\x00O\xd9\xe8\xf9\xc0)Y\xf4\xdaU\x87\x944\xe5.\b!\x91d\"5\xda\xf0\x18\x10Z\xc8\xc1j\x14\xb1h\xacF\xee\x922\xabu؈\\e\x9cc1\x15J\xfaY\xf8\x90\xd7\x17B\xb0봰\xd7\xcf;1\xb0i[\xb4\xfa3L\xc5n\x0e)af\xc6\x10eگ\x1b\x9e\x0f\x9d\xd0\x19@\x03\xc2\xf3\xaeCk\xcd\nB\x97]\xb6\x11\x83\x12k1\x84\x88VW\fc\x88kc\xe3\a\xc3\xd92)\xe5\xa0\x18\xe2\x85L\x05\x12\xf9\xe2i\x14\xb2\xd5\xf7\xf3f\xbb9%i\x17\xf4,\xafqv]\a\xa2\xfd\xb2\xb6\xaa\x1b\x00\xe6\xb3FG\xads\xf8\xde\xcd\r\xbe\xbe\xbb\x83\xb1\x16\x87i\xc67\xfb\x03~\xe7\xe9\xad|\xbd1\xb0\xae\x01b\x00\xa7\bK\x16\x9b~\x8bc:\x88q\x93\xd0\xe6\x1eDf\xb16;\xb3\x0f\b\x9c\xce\" y\x8a\xad\xd4\xfa \"l\x1b\ag\r\xf6\xd3,.\x8b\x15\x91\xee\xeb\xfb;\xbc9\x1e\xf1\xa3g\xb7\xf8\xec\xe6\tд\xc5\xf0\xa8\x0f\x82\n\x90s\xa2\xa1G\x14G\xc0\x1c{\x9b\x18\x94\x04Ml\x98aᔸ\xa7\xd7<Wf?L\xa5P&%\xffe\"e>\xbbP\x91+\x83\x0f\xb0\xce\xc1\x00\xf0\x93\x87a\x80\x9c]\xde\vR\xba\x1f\xad\u0085\x05\x11\xb1\x00\xe2\x92RY\xabPP\xf9\x87,j .vµ\xf7/U{&R\x82&V\n\"E\x01\x14\xfdI\xb5R\xa6 \x98\xa6\xc0\xfb\xabnH\xd72\x19q\xcc^\xady\u007f\xbf\xa2\x02k\x98\x90s\x8d4e\x8a8\x1ac`\xac\x95P'=\u007f\\\x13aC\x84\xd3XaIo\x15\x1f\x01R\x1e\x8b\xe7\x0f\xe1\x00|\xd0\xf9\xff\n\xc0\xdf~,ÿ\xc5\x06\xe0\xcd\xeb\x17\xc5\xde\xd5\xfb\t\xf38\x95)a\x9a\xc4\xf5O\x92\"\xcd\x12m\xc9|v\x13\xf4>\xe6\xe7\x9a\xf9\xbc~dYʮ\xdf\xc0VlS)\xfesɶ\x06\xcbn*\xc3\xf5\xd6\x18\xb4]S\"t\xa9*\xfe>\x04\xdd\xf9\xcfx\xbb\xbf\xc7\x1c\xfd\xca\x03\xa0Q\xb9`\xfe\x02a>\vs\xddǀĒ\xb7\xfe\xfc\xfa\n\x9f\xde>\xc1\xc5v\a@\xd6#Iw\xfaƈ\x17\xfb\x92{ \x85߇ \t\x89\x1a\xe6s\xfa\xba\xf3^\x15̕\xbdj5\xfbT\xbb\xff\xfc\xbe\x1d\xa6\x19{/rKGb\x03\xecԜ%K\x06\x97P#\xd5\xcdg\a?=\x9729p\xd6N?\x17\xa6\xa4\xa8A1^Qo\x00g\r6\xad\xc0\xf8T}\x8e9>\xd8V7{\xce\x17\x98S¨\xf0\xfei\xd1\xff\x15\xf6B\xb8m\x1b\\j\x88\x0e\x9d\xacD\x1aC\x98\xab\t<\xf3\x15\x12P\b\x8cg\xab\x1d=\xe0\x81\xafP\x81\x98\x18s\x8ec\xad_\xb71ض\r\xb6mS\xf4\xfd\xb4\"\x13.ĭ]q\tDɎ\xa0G\x9aߢ\x9a\x01\xf0\xe9\xcd5\xdc\x17\xb6\xa0b_\u07fd\xc5\xdf{\xfe\f&%\x99\xa8\r\xc1\x91C\f@JA\x91\xb0\r\xc6\xf1\x88(\n\xfdU\xa3\xc4h\x01\xf82\xe1Çb\xe6\x84Ǹ\x12\xb4\x90%\x13X\x9a?\xd3\xe3\xe0=\xf6jsK\x9au\xefc\xc4_}\xf5\x02/\xef\xf6\xf8\xc1\xd3[\xdc^^\xa0\xb1\x0eC\x8a\bѣm:\xb4m\xa3\xfc\x80\xac\x88\x11\xcc\xdfkP\x103\xc3\xe9\xe4\xeb,\xc1 \x15\xff\xff\aR\xe2\xb2\n\xb0\xba\xb7^VU\x99\xa3À$\xe09\a b\x9e\x01\xc7\x12\xa8\x03\xa3Y\x1eժ\xadl\x19\x15f\xcf\xd1\x04\x85\xd0X7\xe7e\xe7\x90G}Z\xf1+\n\xaa #\xfa\x12\tN\xe5o\xf4\xbc$8\xdb\x14\x14\xc0\xa7\t\xac\xd1\xc1%h\x88\xd3ٳ\xf6AH\xb7\x91$\xc5\x12\u007f|\x02`P\xb5\xba0\x86$\x96\xd9\n\x1a\x98\xbd7$p\xc8\x16\xaeSJNb\x9e\r\x97\xd7\xd0\x1a\x83!\xc6\xf7\x9e\xf3\x1f\xf8\xf8\x1b\x00\xdf|,ÿ\xc5\x06\x00D\x1aN1c\x9e&u\xdfc\x8d\x93\x9c\x17\x82_Z\x98\xd0\xe7j\xf8\xfbH\x1f\xef\xba(\xb2\xe6\u007f\xb7\xd9\x14I_\x06\x88\xe70#$\xb1Oe\x00\xbb\xbeC\xd7t\x05\xbaj\x1a\xa7\x87\xeczg>{\x8f\xc9Ϙ\xbd\x16\u007f\r*\"E\x14\x9cu\xab\xe2\xcfz\xa8LޗԴ\xeb\xdd\x16\u07ff\xbd\xc5\xe5n\x87\xa6iT\xd2'7e.ʳ:v\xb1z\xacO> \x06\x0fDIG<\xbdUs\x1a[\xd6\ngMpMT4X\x9b\x97\x8c>`?{\xf8\x14\xd1\x1a#\x06GD%f\xb7>THy\x11\xb665a\x91\x00\x1efa\xf6\v\xbb\xfb\xccg\xc4e\t,\x93nc\xd1V\xae\x89\xd6h\xb3a\x96\xf4D\t\xfc\xd1}>\x8b\x19O\xfcw-\xfa\x02`\xa03\x06=\x19|\xd2u\xba\xf7_\xd6\x06bS,\x05\xb9\xb1\x06\x91\xad\xe6\xdd'\xcc)\xa2aS\x19\x1c=L\x02z\bį\xf3~\a\x1f\xf4ud\xcf\xfe<\xfdK\x03@\u0557\x98\xac\xdeX\xf3$\xd1:\x87\u07b9\xf2=bJ\xa5E(\x91\xb5\xa4\x9e\xb2);-\x02\xb7\x17;\xec\xfa\x0e\xfbq\x84!«\xfd\x01A\x8dj(\x99\xf2z\xacs\xe0 \xd7mӶ\x003\xc6q\x00\xac\x81\xab\x81\xd8Fk\xbaA!\xa7MAV:\xe7\xef\xd5\xea\x1dR\xd8\x17\xca\x01\xb8j\x1b\xb4\xd6\xe0n\xf2\x12=\x9cW\fDx3\f\xb8\xff\xc5/\xf0\xfc\xf2\x02\x9f\xdf<\xc1\xd5v#\n\x18N\bѣk[\xb8\xa6\x85u\x99t*\xc3\x05EB`\x00\xf0`b \x89ڈ\xd5J\x99YH}\x12\x14\x04\x98\xa4ͪe\x00\x0eFÄ2a.)R\"+\xac\bV\xb4 Ƹ\x14aS\x87\xf2.\x11\xd9\xc5\xc4H'k\xf9x\xd6\xd2\xc1zȐ\xc6$\x02\x89P\xef\xee\xb8\"\xaf.\rA\xd5Чʉ\x10\xa2\xdfOQ̿r</s\xca\xcc\xdfw\xf7\xc8\xfa\xbcI\x91\x8d\xfc}\xeb9\x8d\xeb\xebA\x9f\xbfQ\xfbbcL\xf1I09\n\xddp\xf9{k4j\x99\t\xbdux\xfb\x9eT\xc0\x0f\xe4\u007f1\x80\xff\n\xc0\xe1c\x19\xfe-6\x001z!\xfb\x05\xbf\x82\x83\xa7i\x94\x0eZ\xdb\xe3\xc0\xe9\xd1\xc3\xfc\xbd\x90\x0f=@\xaf\xd6\xf0dJ\xd8n6h\x9af\xf55\xe2o\x1d\xcb\rw\xd1\xef\xc4ӿ\x1c\xc6N\xb5\xbf\x95\xbb\x17\v\x19pV\xa9\xdf\xfex,ſL[Jz\xa9[\xe9\xc4\t\x93\x9f\x11bD\xe3\x1c>\xbd\xbe\xc2\xf3\xeb+\xf5\x10\xc0\x92\xfd\xadO>\x04u\xebCBLb84M\x138z\x9c\xeb\x90r\xe3\xc4\xe0b\xffYK\xc42\x84ik\x04#\t\xc1\xcf\xc7(\xaes\x8d\x14B:\x99\x02\xf2~\xdbd\x14\x00\vq2\xa4\x84\xc3\xecq\x98g\xf8\xac\xf9\xa63ӊʛ\x8c\xee\xf8\x1b\x85\xf3IS\x06\x8d1+\x84!Ĉ\xc1\a\x8c1b\x06\x10+{;\xfaw\xeeJ\x81\vg\xd1\x1b\x83\xad\xb5ت#!*\xc9' IiO\xba\x0e\x97m#&J1\xe2\xe0\x03\x0e\xb3G\xe4\xf9\x9d[I:]C\x14\xf8_Ȑ\xb3\xee\x91s\xa3d\x8c\x81#B\xe7\x1c:gW\x8d/Ѻ\xc1\xcdH\x903\x06ۮ-FA\xb1ķ\xae\x11\x8e\xdc\x040\x8b\xb1U\u05f6x\xb2\xddJ\x03`D\xd7\xefc\x14I\xebɁn\x9d\x93\xfa\x13#\x9a\xaeS\xb2\xeb\xa8~\xfd\x15,\xedj\xb7\xc8\xfc9\xfa\x95\v\xe4\xa3k\x81\x1c\xad\xa7\xd7\xf5\xd6Zt\x1b\x83\xfd\x1cp\xef\xbdZ\xf7.\x8e\x91_\xbe\xbdǛÀϮ\xaf\xf0\xe9\xf5\x15\xfa\xaeCj\x1cB\x8ahc\x10\xb9n\xe3\x00\x95\xf6&mR\x83\xea\xf0a\xc5\xd6ׂ\xc1l\xe5\u007f\x15b7 $\xca{z\x00,\xa4@cy}_\xe4\xb8C\xa3\xb6\xdaQWjF\b\x87\xa58\x1a\xac\xe0\xa0\x1a\x9e/C\x85]\xf2>J!\xad\x1bI\x86\x04\x11\xc1\xae\xbe\xc7b=\xadE9\x87\x1a\xe9}\x94bԯC\xf1\x06Qx\xa3\"\x05\x9f+\x9d\xe9\x118\xa0\x8e\xb5\xa2\xd5 EX\xc8\xc8\xf5\n\xcc\x18\x03\xd6\xf0*ɮ\xb0*q\x8c\x15\x91\xba\xc4(\xa2\xfb\x007\xc0\x0f\xf4\x00`\x00\xff\x1f(w\xf1\xe3\xe3\xb7\xd4\x00\x04\x85\xd7cLe?\x1d\xa3\xa4I\xb1\xee\xf4\xf9\x11\xfb\xd6\xe5B2\x1fL\n9\xbd\xa0Sbt\x9d\x98\xf5\xd4?\xc4k\x81͝\xea\xe5f+\x0e\x84j\f\xe4\\S\xc8l\xa4w4C\x8a\xbf\xf7\xc2\xf4\x1f\xa6\x111\x85\x93\t\x90\x16\x87B%\x04%fL\xf3\x84\x90\x12\xae\xb7[|\xff\xe9-v}[\xed\xfd\x12R\xca\x13/ë\x05\xb2\x98\xf8D\xccӌ\xe8'<\xa6}̼\x02\x89\x1fv\xab=yn\xbar\x93\x93;w\xaf\x16\xbd\r\x116m\xf6\x9b_`\xe2\xda\xea\xd7\x14W\xc0e\x92\x89)a\x9c\x059\x98u\xf2\xb1\x0f\x8a\xb4\xaedt\xbd\xd28W\n\xbf$\bʴ_{\x9d\xfb\x101\xce\x1e\xc7\x18\xe1\x89\xc0jJD\xbf\xa6\v\x92\x98\xb1u\x16\xbd\xb5h\x88\xd0Z\x95\x9f\xe6\xe5\x88֡\xce\x18<\xe9:l\x1b\a\x9f\x126:ho\x9cGk\f\xc6\x18\x91\xe0\xcfR\xfc\xce=_R\x03\xa2Ȭ\xf1\xc5\xd9\x1dQ\x1a \xd2\x00\xa6N\xf3\r\xa0S\xe6c\xd2\xe8B\x04\xccJ\x01BQ\x13\xd0i\x17\xac,t\xd6\\\n\xc0\xe0r\xd3\xeb\x8a\xc5`\x0e\x11S\x88\xe8\x9b\x06L\xf9\xf07\x1a\xb6+$\xd6\bB\x8a\x01m\xb7р\xaeI\xd4tl\x00\x93J$2\xbb|\xcd\xe4&\xd0Kj\xe3\xfb\xee_z\x88\xd0\\u\r:kp\xef\x03\xa6j\u007f-MT\xc0\u07fc|\x85W\xc7#\xbew}\x85\xab\xed\x06\xaei\xd07\rZ'\xf7{\xdfw\xd2`[\vf\x8b\b\x06\xa5\x88\x00\xb1\xdeM̰\x86\xc1\xc9\xc2Z\xd6\xc0@u\x1e$1`\x8a!\b\xf1\x8d\x1a\xf5\x1a8y\xae:ѧ\x98\x10\xd8k\xb3 2I\x16{\xc2B\xec+r@,\xf7\t?\x82\x8ed#\x9fu\xa0\x96\"<U\fp\xd5I-\xd7J\xa5\xf8\x91\xf0.\rN\xcb\x05_\xf6\x0ezn\xa4\xb3\x83\x04N\x87\xa8\x8c\x14T2\xc7:\x9a\x9bY\x14\\\\\xc1\x13dt%\x92M\xaeՁ\x90\xccəN\xcb;a?\x00\xde\xff@\x04\xe0\x1e\xc0\xff/\xff\xe6#\x01\xf0\xb7\xd4\x00\xa4\xac;\xcf\xe6;\x00&M\xfc3֜\"\xa3\x0f\x8e\x86\u007f'\xe8_m{\xbb\xb6[u\xd4!D\xf8\xe0\x01#p\xf6\xc5F&\xff\x94\x12\x9cuh]S9\x82-\xc5\u007fV\xc8\xff0\f\x18\xfd\xa8\xbb\xdctz\xf7\x16\x1f\x81l\xbf9\xfb\x00k\r~x\xfb\x04\x9f\\_\xc1\x1a\xaa\x1c\xd4\x18H\xb2\a\x15\x87;q\xc4˞\xfd\xc1\v\xdc\xffX\x11\xc87\xa2լ\xf2eB\xd4\u008f\xb5\xce?\xa3\x11D@\xe7L\xb5\x1b\xe6\xd5\n!\xef\xf9%\x14\x06E\x1d\x10S\xc20\xcb\x14<\xe7C\xb9B\x15j\x0e\x04X\xb2\xd6[\xe7\n\x81\xcf(\xacn*\xad}J\x8c\xc9\xcf\x18\xe6 d>C`\x9d\xca\u007f\x1d\x85\x9f\xd2r\x98:\xdd3:\x19\x04\xe1Ȝ\x94na\xdd\xef\x9a\x06\xbdN\xe2&\xc7/\x1b\xe0ڴ \x00w\xb3\ax\\\xbb\xf3\xd0CĪV\x020K\xe1J\xfa\xb9\x88\xe2$\xbfτ\xd6Ylʊ\n\x8bL\xf2\xdc\xe4\xac\x06P\x17]';ר\xd7PLg\vK\xcd%0\x04\\\xef6%K#1c\xf2\x1ef\xb7\x95\xf7\xea\xc1\xf2Bly\t\x92\xd8\xd9o6`N\b\xc1\x17[\u07baf\x18\x97W\x10Y\xaf\xee\x8b\xde\xff\xbdh\x00*\u007f\x1cf\xf4\x8d\xf8\x1d\x1ctM\x15\xd3bfC`\xdc\x1d\a\x1c\xc7\t\xb7\x17;<\xd9mѷ\x1d\xba\xa6A\xd76h\xa7\t}\xd7a\xd3w\xe8\xdb\x16κ\xe2@X\x9e\xb0\x15k`fq\x16\xb4V>h\xd2=>#\xcb\xfd\xc4u/Q\xbdd1+S(\x06!\xf8\x002\x04\xe7\x9cJ\xea\x17C\x01\x826\x05\xe0\xd5\xd4[\xbd\xf4\xf5\xba\xa8\"\x05\xb2|\xe0\xab\u007f[\xa7n\x92\xe1\x87EU+sq>\xcd\x1e*\xe9<\xc7*\xa7\xa3V\xb7\x02\xea\x0f7\x95\x17\xb1,\x17r\xe3.1\u0092\xe3R\x14KH\v\n\xc0\x12\xd4\xccPr\xa01\xea;\xa1\xeb\xab(\xb9$\xef\x8b\x04.\xb2\xc8\xf7?~\x0eI\x01\xfc\xf8\xf8\xed\"\x00A\boi\x81\xb6\xa7i.\xf6\x9d\xfc\x8e\xd9\xe0\xdb\x16\xffS\xf7+K\xa4\xb1\xbd\xcb-\x1bR\xc2\x1cf!\xe9\x19\x8b\xcb\xcdN\x0e\x86\x94\xe0\x9c\x90\xf6\xac1%\x823w\xaf\xb2\xef\xf78\x14\xa6?-\xd3\xf5\x99\v2\xc4\b\xd6C\xefz\xbb\xc1\x0f\x9e\xdeb\xab\x10j\xaa\xa5<\xa4R\xb8\x14e\xeagAK\xbc\x9f\x11cXI\xbfN\x90]\x18S\xbb\xe6e\xc4#\x16\x89\x919q\xfb\xaa\xd7}5\x80\xb7\xf2\a\xd0ݿ\xad\xa0g\t\xacI\x18\xfc\x8cCv\xe0c \xbf\xec\x93\xd8\x02\x10\bN\xd3\xf5\x1a\xb3\xe8ޝ\x95d\xba<\xe5\xc4$k\x91a\U00098651\xac\x01\x9f!\xe3\xfd\xbbL\xfb&\x04\xb01`\xbd\x96\xac\x92\x17I\x9f\xffz\x1d\xcd\xe5\xbai\xd4\xe6U$\x90K\xa8\x8a\x05pٶ\xb8hfX\x83\xb3\x9e\x00\x0ft*y墚\xff\xacD'BA\x99\xac1\xaa\x820\xd5\xca\xc5<\x8am\x926\x8c\x9bV\x03\xa9\xa2$\xc1ՙ\b\xfcp\xb4,\u007f\u05fa\x066\xafa\x00\t\xf91bM[\xc28\x05\x10G\xcaכFV\xa7\x94\xd0o\xb6\x18\x8e{AX\x99\xe02O\x9c\x8d\x06\x16\x11\x00\xb9~MG0\xb3\xc7\x1c|1}y\x8c\x1b\xb0Լ\x8aw\x02Ʈ\xb1\xe8\xad\xc1\xde\a\x1c}(\xc5\xc7@\xd4&_\xdf\xddc?θ\xbd\xd8`\xd7w\xe8\xa7\x06\xb6qh\xa6\x06\x9b\xa9\xc7ŦǮ۠o[Xu>\x14h\x9d\xe1X\x14A\xc9\x02)\x11\xa2eXMS\x14\xd4F\xe4~M\xd7*ԭq\xc8fA\xde\xf2M\x99\x90\xc4\xe3\x9e\x19\xaeq\xeb\xe5P\xae\xbb\xbc8\x12>6\xc0,\x8d\x83p\x13\xa0\xf0>h-\xf0Ȧ>\x1c#$\\\x98\x1e~\x97\n\x11\x00\x9f\xb4\x87e\rp\xe6$\xa6\x8a )\x96\xa3\x0fΡ\x9c\xfe\xe8\xe7\x19ч\xc2=ʹ)$\x10\xab6\x1fq\xe5\x81\x10\xd4\xd69\xc6\x05\xa5\x1c9\xbe\x17\xed\xfd@\x13\xa0\u007f\x01\xe0\xed\xc7\x12\xfc[n\x00\xa6Y\x9d\x9eX\xa4}\xf3<\x8b\x90Hs\xde\x1f\x1bW\f~\xf5\xc9?_\x98}\xdb\xea.|A\x04|\xf0E\x0eu\xb9\xb9\x80\xb5\x06)Jħ\x14\u007f\xd5,\xe7\xe2\xaf\x12\xc1\xd9\xcf\xd8\x0f\x03&?U\xfe\xeb\xe9Q8*%F\xe3,>\xbb\xb9\xc6'\xd7W\xb2\xbfLi\x95\x17ΜD\n\x17\x82\xbeG\xa2\xe9\x0f\xde\x17RԹ\xe2\x0fe\xe6\xe7<\x85l\x9c\xb4J8\xac\xa4VK`\xcfB\xe7K\x15g \xbf\x9f.\xfb\x8bW\x85\xdfǄ\xe3<\tW \xbb\xef\x01\x154\xbd6\xbdm\xac\x14}\v.C\x88\xb5Fu\xfb\xf2\xdc%$i\xc60Ϙ\x13\x83\x9d\x05\u007f\v\x1f\xf0\x0fy\x98\x18aB\x94\xa6\xe2$\x010\x93\xa7,Ly\x8egx\xcfe\xef\x9c\xf9\x0f\xb5G\xff\x95:\x18\x8e!\x02\x8f\x1e\xe2˻S\f\x8e*\x02\x95\xb3F\xa4\\`\xb4\xd6bӸ\x936\xea\xf1J\x99M\xadZ\xe7\xd09\x87a\x9a\v\xf9N\xdc\xec\xd6\xf8\xb1\xa6k\x97I\xd5(\xfc\xaf\x195\xab\x00gc\t\x88\x8c\xa4Kf\xa9\xc7\xda\x1cY\a\tV3\xe8\xfa-\xc6\xe1\xa0E\npd\x01\x8a\xe0 \x924\xd2\x02H!\xc2v\xf2\x1e\x8e\xfa\xfc\xf8\xdc{\xa5ɹ\xa9\xbaǹ*R\x86\xc4\x1f\xa1\xb5\xc2\x0f\x98\xd5\x14*\u007fV\xc7y\xc2\xf4\xc6\xe3\xb2\xefp\xb5\xd9`۶p^\xb83\xe34`\xe8\a\\nv\xd8m$\xb2\x16\xe4\xc4\xeb\x1fQ\x931\xc5Q0!#\x02\x06\x86D=\xe4=\x83\xacQn\x8fp*\xe4+\xa8\xa2/\xe8\x94N\t~\x96f\xa7ilA:U\xbf+\x1fD\xe2:\x19J\xc1\x84\x1cUL\xab\x16\x9dtm\x93\u007f\x86p\x86\xea\xfd\xff\x02\xef\x15\x95^\xe5d\xb5\xca\x12X\xc1\xfa\xef\xc9R\xc9\xcfW\xffmF,\x19\xbcd[0KL\xf08\x15\xb25\x19#\xee\x9d\xd5\xe7\x13+\xb7K\xd9B\xc8{ĉ\xcby\ac0\xbf\x0f\x010\x1f$\x01L\x00\xfe\xdf\xf8\x98\x00\xf8\xdbo\x00\xbc\x0f`uЊZ\xecLe?\xf9xq\u007f\xc7b\x80\xce\xc3\xe1\xf5\xa3k[X\xebPˢ'/\x06:\x9dkq\xb9\xbdP\xb9\x8aX\u007f\xb6M#\x9d%c\xf1\xacO\x8c9̘\xbc\xc7q\x180\xcd\xd3\x1af?\xb3\xbf\xc8\xc6-\xd7\xdb\r\xbew{\x83K\x85K\x13x\xf5\xbc}\xf4\x98|P閐\xb3\xbc\xf7\xe24V\xf7\xef|\xf2\xbe(\xa4\xec\xd4\bG\xf4\xf0kӤŋ?\xad&\xfe\xbc\x92\xa8c|M\xe56f*i\xa0\x8f\t\a?\xe38\xcfҡ\x9f\xe6\x02P\xbd\xef6\x8b\x1f\x80! \xa3\x10D\xe8\x1a[P\nf\x89\xed=\x8c\x13B\x9e\xf8\xbb\xe6\xd7{\xc51\xc3$\x06%\x96\xc9\xff$c\xdc\xe9~\xb5\xc0մ.\xfe\x85\x87\x91\xb8\xc0\xe5D\xb4\x92s\x19\x92\xfd\xf4e\xdbH\x03p\xba\xff_\xc1\xff\xd0,t\xb9.\xe4\xec'\x89\x1fִ\xb9\xce:\\\xb6MI\xf6\xcb\xee\xb4\xf4\x10ZY}\xbe!Fq\xaaԵ\x81!\xc2\xe8=\x06\x1fpѵg\x1b\xece\r@\x8a\xc2-\xfc\x04g\x00\xa1s\x10\x8c5\xba7\xa6j\x15\xa66\xbb\xb6\x01\xb3\x87k\x1at\xbc\xc18\x1c\x91\x17)\u0588E\u007fP\xa8\xb9\xb1\xd9Â\xb0\xe9\xe4g\x8e\x93G\xd02\u007f\xa2\xbe\xd3\xe6\xbfZ)T\xa689\xf6\xbb1\x06W}\x8b\xc1{\x1c}T3\x1c\xd9\xcf'f\xbc9\x0e8N3.\xfa\x0e\xbb\xbeǦq\x98g\x8f\xe38\xe1\xee8\xe1f\xb7\xc5\xf5n\x87\xbe뀶\x01\\\x03K@b\xf1 0\xd1\xe4g'ȑ\xa2|~\x96z\x92\x87\n\x8e\\\x9aa\x868g*7\x12\x9c\x18)\xcdHѡ\xe9\x1a\xf1\xc9H\xd9# -\xc1aU\xb46\x91x\x13p\x96HҚ\xef!o\x8cQ\xb3c\xb1\xe5\xcd^\n\xabɞ\x17\x1e\xcf\x03\x0f\xbc\x93\xc6\xff\xd1\xdb(\xf1)\x16\xb1n\xf8\xf5\x1e\xf2\xb3\xc7t\x1c\xe0\xe7\xb0 \x8c\xea\xf1\xb2\n\xa3R\xdd\u007f~\x9a\xb3\xd7H\xe1\x94dm\xa2\x9f\xf1\xf8Ⱥ\xb3pC\x8c\xfd\x90\x06`\xd6\xfd?\u007f,\xc1\xbf\xed\x15@\x94\x84:\x91ˤ%̇\xdfC\n:7\xd2\xf3\xe9\xd4yztȣu-\x9a\xaa\xf8\x03\x84\xc9O\x98ÌM\xdb\xe1j{!\xfb\xbc\x98\x8aGz\x0e\xdc\xc8r\xc0\x94\x92\\\xa4\xc1\xe38.\xb0\u007f~\xc4\xf4\x90)+\x81A\x0eO.vx~%\f\u007f\xb1\xae]O\x82\xf2}C!\fξ\x82\xcfJ\xd7\xceU\xa4\xf8\xa2\xf1\x15\x99\x9c\xeecS\x94U\xc3\x19\xee\x03?h\x06x\xed\x13\xaf\xc5ͪ[Xv\b\xf4)a?y\x1c\xbd\x17K\xe1\x93\xe0\x8dڃ\xd1@V\x10\x9d\xcdE\x1e\xe0$R#gew\x9b\xf7ؓ\xf7؏\x93$\xc1\x19\v4N\xd8鿦\a)\xe4/{L\x88\xa4\xeb\x84Ag \x8a5\x83\x85I\u007f\xae\x9bd\x06\x06\xcd[X<\x14\xd6\xfb\xf7\xads\xb8\xed{\xbc\x1e\xa7U&\xc2\xe9#*\a\xa6\xe6aX]\xb3\x88W\x04\xe1\xb2oK\xf1__\xcd\xfc`\x9fP\xff\x8c\xa0A-m\xe3\xf4\xda\x05|\x8c\xb8\x1bF\\\xf6\xdd:s\x82\x17\xcf>\x82\xac5\x1ak˺A\x9a@AkRb\xf1\ufde6p\n\x96wP\xccl\xacu\x881\xa0i[\xa4\x101\xcfcAxdQ\xb2HT\x9d\xdaJ\x87\b\xf4$>\n\xc7YUA\xc4+\xc9\xe8\x999\xb5\x14\x12R\xa3\xa9\x9c\x1b\xb0m\x1c\x1c\x19\x1c|@\xa8\xec\xa3\t\x04\x9f\x12\xde\x1c\a\f\xb3Ǯk\xd17\x8d\xac\xf8\xe6\x19\x87ሻ\xc3\x01O\xb6;l\xfa\x1e\x9bM\x8fmߋ\xa9\x10\t\x8c/\xe9H\x06\xc60\"qYV\x92'\x81\xf6\xf3\x9a\x82\x19\x96\xa5\xd14V\x92\x03\x8b\xbf\x063\xa26\xf5\xa9upM\x03k\xa9\xbc&\xaa\x92\xfd\x16e\x8d\x86\x9e)\xb2\x90OCF\xac\x9a\x03,օxd\x8a'\xc2\x19A\xc8\xfb'\xfe\x85\r$\xff=%\x94\xaal\x91\x13\xc3\xcf\x13\xc6è\x93\xfc\xa2,\b\x1a\x17\xad\x81\x83\xc8Y\x17Y&\x19S\xc28\x8c\x881`\x9eg\xc9,\xc9k2~7\x02`?\f)\xfc\n\xc0O\xf2o>\x12\x00\u007f\x8b\r@.\xfeX\xb9\x94\xbdc\x0fx\x06\xfa\xaf\xafAz\xe4\xc0Ηl\xeb$\tn\xd5\x0e\x06\x8fa\x1a\xb1\xe9z\\m/\xd4\xd3[.\xb4\xb6i\x8ag\xbdɩsU\xf1\x1f\xa6\x11\xe34=\xf8\xb99\xfc\xa4\xbe9.\xfa\x0e7\x17;\xdc^\\\xc0Y\xabd;*\xc5:\x17\xfe|\xf3GeS\xf3\x83f\xa22\x801\xcbss\xca\xf0\x0f1\x16/\x81\x1ay\xe0\x93B\xc4J\x14;}/\xf3\x04h5N\x14:\xa5ޫ\xc4-\x88\ufaeca\xf8\x04A\xd0\xe7\xd2\x18\x8bF\xbbqQr$\xc4\x10\xc4ѯi\n\xe9/\xa6\x84\xfd8\xe28{$c\xc0m\xfbk-\xfc\xf5\xebM\xa5Qb$2\xa0\x93]\xa7U\xa7\xb1|\xad\xc4G4Йe>\x86\x88\xae\xb3\x0f\xc1*\"4D\xb8\xddt\xf8\xe5\xa1\xc1\xfd\xec\v\xccnN\xdaאx\t\x95\xd2'k̒\xf0\xb7i\x9a\xf2\x9c\xce\xed\x80\x1f\x13>f\xa9d\x88\t\xad\xb1p\xaaa'\x10^\xee\xf7\xf8\xfc\xe6\xea\xa4i\xe3\xaa.0,Yt\xce)2EEbg4\xa26F=\x94\x99\x10SM<7jqc\xb4\t\xf0h\xfb\xbe\x18R\x95\x04<\xb3$\xbdS)\xd9\x16\x91\x18\x9dz\xe2\x0f\xf3,\xd6\xcaz\x95\xd6\t\xb6\xb5\x8c\xf2\x94\xcb@%\xcaWS$\x8d\xa0\x01\x93rSJ\xc3\xcd\xc0\x18\x84\xb3\xd2:\x8fM\xeb\xe0\xbcC\xe3\x8c\xd8v\x1f\a\\n6\xd8\xf6=\xb6]\x87݅\xac\aZ\xd7\x00v\x99\x80\x8d\xee\xe0\x13\axm4\xb2\x85m\x96\x04fx\xdeX[\xa0\xef\x1c\xf8C)a\x1eg\x84\x10Ea\xd48-\xfc\xd2$\x94F wq+\xf4oyA\\V\b5B\xf0\xb03\x94\xd7N\x8f\x14\xf63\x88@9sh\xb5\x1e8\xbd~R\\\xd4\\~\x9a%\x05\xd0\a1\xf6\xd2\xe2\x1fC\xe5FZ\xf9&\xcc\xf3\xacRD`\x18F\t\x82\x9b\xbdX)\xeb\xe75\xc4\xf0n\xb5\b\x89\x8c\xf0\x03\x1e\u007f\x0e\xe0\xeb\x8f\xe5\xf7;\xd0\x00\xe4B[3\xa5\x18'w\xfa;&\xff\xba\x91=_\xfc\xd7\x11\xaab\u05fbLl1F\x1c\xa7\x01\xdb~\x83\xab\xed\xae\xec\xee\xc1@\xd7vzA-ҽĩ\xec\xfc\x87\xf9a\xf1\x97\xc2\x1d\x1f\xdcN]\xdb\xe0j\xbb\xc1\xcdn\ag\xadƍ\xc8k\x9d\xe6\x19\x99\v\xa1\xdb\x05\xcc\xc1#\x84P\x8aT\x9d\xa2\x06Ԗ\xb1\xd9qO4\xcdSe\xf7{ʪ>;\xed\xd7\x13r\x96\xdf婞$&v\f\x01{\x1f\x84\xd5_\x0f\xc5'\xa7\xaf3\x06\x8eĻ\xbd&\xe2\x84\xe0\x01N\xe8\x1b\x8b\xaei\xca\xfb5\xce\x1e\xfbi\u0094\x18ܸ\xd5.\xfe\xd72\xf1\x9f\x03\x92H\"h\xa9>\xc0Xr\xe4\v\x0f\x81s\x82^\\\x99\n\x9d\x0eC\a\xefqӷ\xeboN\xcb{yն\xb8\xee[\x1c\xbc\xaf\xe3eNP\xa2*j\x15k\x99Sgm\x81\xefߍi\x9d\xf7\x8d\x8f)b\x0e\x12H\xd3:\x87\xd1\a8k\xf0\xfax\xc4q\xf6\xe2&\xb8\"\xc6V\x8d%A\xaf\xd3*\x1e\xbe\xb2\xa6\xb5\x8e\x10\x83(u\x18I\x88\x81\xb4VK\x10\f؈\x13^\xdbo1\r\a &]\xe6\xdb❟\t\xa3\x0e\x92N\x19٠i\xa4\xe8\x99y.\x19\x1cY\xcaH\x8f\x80\u007f|\xe6\x0f3\x04\xbfmDb:\xf8P\x12\x12\xf3?\x8b\xcc\x18\xbd\x18]\xf5MB\x13\ff\x131\xcf\x01\xe3\xecq1Ϙ\xda\x0e\xfb\xe3\x11}\xd7\xe1\xea\xe2\x02\xbb\xed\x06]\xdb\ti\xd5\xc9semh\xe7ɣ\x01\x8aGHJ\x04\xb2B\xe6\x95\x1c\x01[T.\x15_\x1e\xd1\xeb\x1a\xd4{X\r\xcb2v!ɖF\xc0,\xd6܄\xcaݔ*\x8d}]\xa4Oo\x04:\xf9\x83\x95l\x90\v'\xa0\x96\xfb\x16>A\x15&\x84\xc4b\xea\x15\x97_\x9c\"\xe6Iw\xfe\xba\xe7\xcf\xcd[\x8c\xb1\x18\xbd-\xcb~\x89N\xf6^xN\xf34\xe1p\u007f\x80\x9f\x03\x86q\xaa$ʄ\xb7\xf3\xfcn^\x8f\xaaV\u07b7\x04\x04\xf0\xff\x82\xe4\x00||\xfc\xb6\x1b\x00^U\x14>\xc7E93˝\x9e\xe8\xfc\xce\xe2\x9f\vf\xdfv+D71\xe30\x8d\xd8v=\xae6\x17\v\x9c\xc8\xc2\x11p*\x9d+{}U\b\fӄq\x1a\xe1\xa3\u007fP\xfc\xc5/\u007f\x89\xde\xcdE\xfb\xa2\xeb\xf0\xf4\xf2R|\xff\xf3\x84\x1f\xa3|\x9f\x10\x94\xc0b\x10\xa3\x1c\xda\\\x19\x1f\x9d\xee\xfaL\xc5\xec\xcfD\xbfP\xa5#\xe2\x03\n?\x9dt\xce\xf5\xc4\xcf,\x87\xa2D\xf3\x8a\xc6z\xe5>z2N8#\x13\xbf\xcd9\xe6z\xa4Ř\x10\xd3,ѵ\x9b\xae0\xdaC\x88\xd8O\x13\x86\x10\x11\xad\x05Z\xf3k\xbd\xa8Lu\xe9\xf0)rB'6F\xcc \x9dP\xb2\"\x81\xcb\xf5!\x90\xf9\xc6ٳ\xcd\xc51\x88[\x9f}d\x0e\xdf5\x0eWm\x837\x8d\xc3\xc1\x87\x95\xc9R\xfe<Vh\xbeY\x8eoK\xa4\x01<\x8f\\\xfd\xfcN\x9a\x8c\x86g1\x86Y\x14-ۮ\xc5\x14\xa4\x01\x18\xbdǫ\xe3\x01\xbb\xeeI\x05\x8f\xe9uC\xeb\x98C\xa2\xd3>cI\x8b\xcc+\x00\x93\xd3\x11\xab\xebC\"p\x934\xa6\x10\xc6{\x8a\x1d\xfc8j\x80\x8e6\xb5V\x8c\x83C\xaaJrd$\x8dq6\xd4\xc2\x1a#\x0e\x97\x9c\xa0\xb2\xf1\x95\x8d\xf5j\x0f\x9e\x89hu\xec\xae^\xf7\xce\x10vmS|\r\xd2z\xee@\x88\t\x874\xa3\xb3\x16\xad\xe3\x82i\xf9\x18qt#\xb6m\x87~\x98\xb0\xdf\x1f\xb1\xd9H#puq\x89\xed\xa6G\xdb8]\x85\x19)rӌ\xa6i\xc4(\x89\"\xa2\xaeÒ\x81\x84)E\xe1Q\x18S\x05\xf2\xe8O\x8c>!\x85\x88\x90\xe5\xb0F\xfe]\xc9\xe6P5\xc6b\xa8\xa4rJ\xaa\x87\xa4u\xfeDm0\x94\x15\x06\x8b\xc4E\xc2~\x16\xa3\x9e\x1c\t\x9c/\x8d\xb48\x0e\xeb\xc4\xce)\x17|%\xfd\x95\xc9_Q\xcc:\xe3#%\x84\x90Tn\x88\x93\xec\x8f\xec\xf8\xca\xf0\xde\xe3\xee\xee\x1e\xf3<c\x18'\x84\x10\xcaDO\x00^O\xd3{\xe1\xff\x0f\xf0\x00\x18!\x06@\x1f\x13\x00\xbf\v\r@6Y9\xe3-\xb1\xaa\xff\xe6,\xf4O\x8f\xf7\x05'3Rߴ*\xdf[\xfe|\x98FtM[\x8a\u007f\xfe\xb2V=\xd4\xe9$\xd1o\xf43\x8e\xe3P\xa2|O\x9b\x0ek\x8cz\xf2/O)Ƅ\x8bM\x8f\xcfn\x9f\x14#!\x82\xb8\f\x8eӈ\x10\x17\x92X\x9c\xe7\xe2\x87P\xeb\xe6s\xf6A\xb6\xee-\xf0'\x19\xc4$$\xbf3\xee\x1c\xef,\xfcew]\xf9\xe9\xcb ,\a\xdeA!\xee|H\x1a·\xc7\xf2}\xac1h\x8c\x15\xe6\xbcB1\x11@\x88\x8c\x10fX\x93p\xd1w\x92\xf0G\x84\xc4\t\xc7q\xc6\xc1{xc\x84`\xf5k\x86\xfakS\x95U\x13\xc4u.\x9d\xfeYbPV.\x10I\x1frb#\x1c\xd5\xf7\xc0\x9cx\xf7\x1b#\x92\xba)D\\\xb4\x12p\x82\x93沵\x16\x17M\x83˶\xc5ѯ\xc9Ku\x98\x15\xab\x03\xa2\xa9\x1a\xbe\xbeub\xa7|f\xce/\x90+\xbf[\r\xc0\xcc8N\x13\b\xc0\xaem\xb1\x1f\xa6҄\xbd\xbc?\xe0\a77\v!\xb1܃R v]\x8f\x8bM\x87\xfd8\xe1r\xd3c\xd7u\x82,Y!\xd4\xc9\xd4n\xf5\x1agXK\xc2\a\xa8$p\x99\x84f\x8dEdF\xd3vB\xf6\xf5sI\r\xb6\x85\x15\aDR\xf8\xdaPi\xc0r\x13n\x88\xa4\t(J\x19\xf9\xfe\xa8|)Jy#I?L\xa70\xb5\xfe\xbes\x16\xd6\x10\xa6\x90V\x99\x04Y\xfd1\x06A\xbb\xa6h\xb1k\x19\xbd\x93\xfb\u007f\xf6\x11\xad5\xe8\x9b\x06\xc7a\xc0۷\xf7\xd8n\xde\xe0ɓ+<\xbd\xbe\x91\x101k\xc5T)\x11\xe6\xd9\xc3i\x90\x15\x8cA\x04\x8aɍ\xb1\x04\x9b,\x92%X\xc3 {\x92\f\xc9\f\x8e,\x99\x03ژ\x1b\xe5\xad\x10\x19p$\x8d\x18V\"j\xca\x1c%ZAt\xf5\xf0\xb0\xec\xf8\xb9 \x8a\xf9\xbeMՎ\xbe<\x85\xc2\xf0\xafQ\x01>\xf1\x90R\xd8?\bzQ\x13\xf9d\xedWy\xbc\xe8\x9fec\xb2\x14#\x86a\x94\xa4\xd2\x10\xf1\xf6\xed=\xc6\xe3 \xaa\x8ca\\M\xf3\x04\xc2\xd7㻇\xf6l\xe3\xfe\x9eǗ\x00\xfe\xf5\xc7\xd2\xfb\x1di\x00\xea\x80\x1e\xe2%\x1e\x1b+(\x91\x1e\xc5x\xb3|\xed\xc1\x86\x94\x17X\xb3s-\x9a\xa6Q\x973\xf9\xe2a\x9e`\x8d\xc5\xf5\xf6\xa22\xb9\x11\x8e\x80sN\tx\xa6\xdc<\xa3\x92\x83\xee\x87C\x99\xc2O\x8b\xbf\xc8\xe2b\x998RJ\xd8v\x1d~\xf4\xfc\xf9\xb2\xf3W\xc2\xe14M%\xa3=\xe5n:GlV\x861k\xc8ߔ\xa6=0\n\x93\xb6\xb6ݯ'X\xaeP\x90\xa5\xe1\xa7r\xa0\x9a\x93\xc2\x1fR\xc21\x04\f\x1a\xaf[\xa7\x03\xe6\xa6ƩϿ3\xb6\xd8\xfe.Ӳ\xa8\"\b\x01\xbb\xd6a\xd7\xf5\xc5\xe4c\xcc$?\x06\x92s\x8fJ\xe3~\xe5\xa9_\xdfs>\xd3\xfe\x11\xafW\x9e\xa4S?\xf1b\x8a\xe3 2\xc7s\x84ɐ\x12Z\xbb\xe4£B\b\x8e\xde\xe3\xaam\x97\xc9y\x05I\x12.\xdb\x06\x17M\x83ךz\x06\xaa\xac\x97\xf5\xfb[\x95m\xe6\r\xfa\xc694ƬW[դ+\x05\x8e\xd4\xda\xf7ݏa\x928ھi\xb0i\x9b\x82R\xbd>\x1cq\x98g\\h\xa2%xAN\x12\x18ۮ\xc1\x8f\xbf\xff=\xdc\x1d\x06<\xb9\xd8a\xd3u\x88Q8\x12y\xc8ʦ-\x9c\x18Q\xff\xff\xc4\x11\xd9%0\xa31\"\x9b\x95\x9c\xfb\xb6\xdfb\xe2\x04\x0e\x01.\xbf\x97ƪ\xddmD\x82Qz\xc0b\x80%\xbc\x11\xe1\xb7\xccA\xe4\xa6&7\x1a\x9c\x10\xea3#\xbf\x0e^\xb4\xe6\x955]QxXc\xb0i\b>\x11\xe6\x90\xc4!q\xd5,0\xa69`\x0e\x01\xads\xd86-\xb6\x8d\x03\xd8\x16u\x85#\x83\xc30\xe0\xcd۷xy\xf1\x1a\x9f>\xbb\xc5\xed\xcd56\xbd6\x02$aTl-\x88\xd6\x03'\x19#N\x97\xce YS~o\xb2a\x17-d(\xe6\x88\xc4b\xdd[\x10IE\x0e\xea\x00\xa9:l\xa7\xe4{\xe4쏪\x88g\x8e@9c4_\x84+y)Wd,^q\x8f\xa4\x91\xe7\xec\xde\x1a\xe3z\xda\u05c9_\n\xff:}\xb44\x12I\x1a\x81a\x1c\xe1\x15\xfe\u007f\xfb\xe6\x0e\xc3\xf1\x88i\x9e\xb1\xdf\x1fV\x03\x1e\x11a\x88\x01\xf7q^H\xe2g\xf7\xff\x1f\xd4\x00\xfc\x17\x00^\xe4\xdf|$\x00\xfe\x96\x1b\x80SHs=\xa7\xf2;v\xff\xa4;\xbew\x15\u007f\xa0\xb1\x0e}ۖ\x8b\x8fH$&\x04\xe0zw\xb1~2\xd6¹f\t\xc9\xd1\xef2\xcc#\x0e\xc7#\xee\x87#\x98S\xc9\x03\xa8\x0fz\xe7\x9c\xee\xf1YI}\tW\xdb\r~\xf4\xfc9Z\xe7\xca\xeeR\xa2\x81\a\x99d\xb2\x15oU\xa0O'\xff<\xa5\xe7\xd7\x19\x13cNI\x89Z\xa4\x13\xd4\x19ܣ\x96\xf2\xa8\xb1\r\xa82\a\xd2\xfb9\xa7\xf3\rZ\xf8C\x15\r\x9c\x9f\x97U\xb3\x1bg\r,YT\x15@\xc2V\"\x84\xbd\x1f=zǸ\xe8z\xf1\x8dϰ\xea4I\b\x90u\xa2%\xfbuN\xfdU\x92]y\xe9'\xa66\xa7\xc5\xdfĸf.'I\x9b\xb3g\x1b\x00!@:c`\xe9\xe4@\x04c\xef\x83\x14?%\xc9\xe1dms\xd14\xe8\xad\xc5e\xdb\xe2\xf58I\xa3R9\x0ff\xe5\x064\xa3\xa1w\x16\x8d\xa6\xa1I\xb1:\x17%,?;\xd5p\xed\x99FY8!\x11\xaem@\x04<\xd9m\xb1\x1f'\x10\x03S\xf0\xf8\xea\xee\x1e\x97\x9f\xf4+\x96,%\x16\xa6;\x017\xdb-n/.\x96\x9f\xa5\x81,\xa5~p\x02\xc8\xc0Z+F3\x04\x91V&\xb5\xfe\xa5\x12r\xad\x9e\xef\xf2ٷ\xdd\x16Sڃc,\r\x02\xaa\xa8\xe5 \x10\x89\xba\xdbI\x83j\x8d\xc4\xe9\x92!I\x13TB!\x19\x83\x86\x00\x8aIb\x86\x8b\xe5\xb4)\\\x9e\xd5MQ7\xc9jFe[S\xac\xafS\xdd\b\xe8?\x9c|\xc0\xe4\x03\x0e\xd6b״\xd86\r\x1a\xc3\b\x880\fLD8\x8e\x13\u07bc\xbd\xc3\xd5\xc5\x05\x9e?{\x8a\xe7O\x9f`\xbb\xe9a\xd8\x02\x89a\x9c]5\U0001ccb9\x17\x8a\xf3\x9d1\x04\xe3$\x88\xc8Z\vc\xad\x12Bki\x9dt\xb3\x92\x01\x94VQ\xe8\xf9\x00ɦT\xa4D\xd75\x89oI\x16$ZV\x89\x89+\xcf\xfe\x93\x01\"7\bP\xeb`N\\\x9a\x80\x8c\x14dD*\x86\x88\x10\x02R\xe45k\x13*;f\xf1#\x99\xc6\x19\xf34c\x9c&ܿ\xddc\x1a'\xccӌ\xbb\xbb=b\\\x9f\xb1\x96\b\xff՛o\x1e-\xfer\xf9|\x90\xfc/\x00\xf8?\x038~,\xbd\xdf\x15\x0e@\xb5wZ\xdbHһ\x8b\xff#\xfc\x0e>)\xcc}\xd3\n\xfc\xac\xb2\x93\x10\xe5\x02\xbc\xda]衽H\x88\x1a\xe7\xd4\xd6v)R\xc38b?\x1cq\x18$\x82\xf7\xb4\xf8\x93\x16\u007f1\x11\n\x85\xdc\xf5\xe4b\x87\x1f>\u007f\x06gl\x81j\xa7y\xc48\x0e\xd29\x97\xa8ͥ\xd8\x1aZ\xbf\x12\xaa\xbc\xc1\x19\x8c\x10\x13\x82\"\xa4\x96\xde\x05\xfdbu\xa3\vW@\x88\x82\x86\x96(`\x1f%\xecg\x8c\xa1\xc4\xe6\xd6\xdcˆ\b\r\x194&\xbb\x06R\x99\x10\x13\x13Bb̑\xc1)\xc2Q\xc0\xd5Ƣo\x9a\xa2\x96\x18\xe6\x80\xfd4c\x06\xc0M\xf3k\x9d\xfa3\\\xfbX\xf1\xa7sH@JR\xfcO:&\x03\x14[\xdfӧ\x188\xc1\xa7\x04\x97Ԛz\xfd\xe1c\b\u0091\xd8j\xf6{\x9d\xab R4\xf1\x038\x86\x80\xbblz\xb5\"\xa8\xa9\xdcR}\x12\xaczM\x14\x975$$6K\x1e\xc1*=2\x95\xa6\x96\xcf\xf0:bb\xcc1\xe2\xd2Jt\xf5\xe77\xd7\xf8\xe2\xf5\x1b\x1c\xe7\x19\x04\xc2/\u07fc\xc5\x0f\x9f\xdc\x14^\n\xa7\xb4d+\xb0\x98\xb9ds\xaa%\xf3\x91W\xcfA¥\x84\xf0\x169\x16\xc4'/\x8d\x99\x96xܼ2\xb0\xb6\x85\xb5\x97\b\xf3,\xea\x00\xf5\xff\x90=\xb6\x05BF:\f\xc8H\x04q\x82\xe6E\x18\xc0P\x83iF\t\x12\"\x02Zg`b\xb6\x8a\x95\x86̑\x91\x1c\rfĳ\xe9\x93\vg\xa4\xb3\x06Έ\xb1UH\xe9d\xad \x8f9F\xccq\xc0~\x9e\xb0k\x1al]\x83\xa6\xdcߢ\xba8\x8e\x13^\xbe~\x83\x9f\u007f\xb9\xc3g\x9f<\xc7\xf3\xa7\xb7\xd8\xf6\x1d\\r\xa2\xd2qn\xd5\xdc\v\xdf&\xa9\xe4\x180!\"\x98\xc5{\xc35\x0e\xae\xb1\x82\xa0h#φK\x86\x00\xd7\xe4`\xbd\x18b\xb6J\xa6\xb8\xe8\xfa\xb3\x93'\xd7dM\x94\xf5B\x8d\x8e\xe4)\xbe\x90\x88+\x0f\x81|\x9dd\x1e@\xbe\x0eS\x92D\xc1\x18c\x91\xda\xd6\\Ô\xb2\xe5p\xc24\xcd8\x1c\x8e8\x1c\x8f\x18\x8e\x03B\x90\x14\xd47o\xef\x11}(\x11\xd6\xf9\f\xff\x9b\xc3=^\x87\xf7\xed\xff?\xa8\x01x\t\xe0\xff\xfe\xb1\xec~\xa7\x10\x80\xa5E\xa7ծ:\xf7\xb5\xf4\x81\xc5\xff!w\xa0k\x1a\x95\xdd,pkL\x11\x97\x9b\x9d\xe4L+\xb9\x05\x00\x1a\xd5\xfa/\x1e\xff\x84q\xaa\x8b\u007f\x82\xb5k[\xddl\xb8CD\xaaW\x95\xe2\xf2\xfc\xfa\n\x9f?\xbd\x15\xbfk-\b\xf3<\xe18\x1c\xd5\xef`\xfdZX\x0f\xac\xd3\xd7Y\xe4k\x9c\x10\x92@yvQ\x02=\x80\xb6\xeb\xe2_\xc3g\xd6,\x05$*\xa4=\xf8PHl\xa7\xe4\xb6\xc6\x18\xb4F\\\xccr\x10\x0f\xebM\x1cRBdB\x84U\xef\xfc\x84M\xcb蛮\xbcw>F\x1c\xc6\x19C\f\x88\xce-\x9e\xc0\xbf\x81\xa9\xff\xb4\xf8\xd3#,\x10\x13c\xd9\xf7\xaf\xfe\x013\x9c\x11\xff\xff\xc7\xf6\xe8\xb9\x01h*\xd7\xc8\xfc^Ŕ\x84Q\xef\xdcI\xca\xe1\xb2\xe3~\xba\xedq\b\x01Wm\x83W\xe3\xb4Lhژ\xe5\x88c\xf3\xe0:\x96\x03~ᔬ\xa5XR\xd8\x12\x1c\xdc\x03\"\f\x11!\xf2b\x00e\x88\xf0\xec\xf2\x02>F\xfcՋ\x97\xb0\xc6`?Nxq\xbf\xc7\x0fn\x9f\xc8:AmW\xad\xb1\xb0\xb4.\x80\\v\xee\vk\u007f\xb1\x81g͛0\xe0\x10\v9\x90J\x13@\x1a\x1add\xd2\xe7\x84\xc6YD\x8d\xb8F\x12\a\xceq\x9a0ͳ\xa0\b\x8a\x15\xc7dA&\xc1\x14\x83\x1b\x03k\x81\xbe\x05\xa8R\xa6@\x136M\x92\"\x1eUj\xe7\x8cATg\xbe\x98\xd6\xe7\xcb)/\xc6\x12\xc1:\x8b\xc4\x16!-\x88\xc0).\xe9S\u009bi\xc2\xc1\xcf\xd8Yi\x04\x9c1\xa5⥐\xf0\xcd\xeb7xsw\x8f_\xfc\xf2+<{v\x8b\xdb'\u05f8\xd8lж\x1dڶ\x935c\xe5h\x94\xa7\xf4\xc8\x11\x944\xad\xcf$\x84\x10`&\x03\xeb\xac\xfc\xb2\xb6@\xff\xa8\xfc?\xf2\x13\xa4R藵\xd1\x02\xc3\xe7u\x00-\xb2<\x0e+\xb6\u007f\xde\xf1ӊ[\xb58\xfc%\xb5\xab\xaes\x03r\x03P\x10\x97ܠ\xaag\x01\x97\xbf\x97\xb8\xe8\xb7w{\x1c\x0fG\xc4 \xa4e\xef=\u07bcy\x8by\x9aW\x85\x9c\x00\xbc\xf1\x13\xfe\xe5\xfd\xeb\xf7\xaf\x00?\f\xfe\xff\xbf\x01\xf8\xeb\x8fe\xf7\xbb\xd4\x00\xf0\xf9\xe9\xfd\xdc\xf4O\x8f\x9d\xeeg\xa1\u007f+z\xddB\\\x91\xe2\xbf\xebz\x917U\x1aok\xad\xfa\x9e\x9b\xf2s&?\xe3\xfex\xc0a\x1c\x90X\x98\xce\xf6\x84a\x9a\x1b\x02f\xc6\x1c=\x123>\xb9\xbe\xc2\xf7\x9f=E6\x01!\"x?\xe3p\xd8#T\xa6)T\xe5\xc0\x9e+\xfe\xf9uHӲ\x14\x93:>\x96O\xf7\xfe\x95Oz\xb6\x03\xb6U\xe1O\x891\x84\x80C\x90@\xa1\xfc\xcd$k\x9e\x04\x0e%\xf5\u007f/!DR\xe4|\xce@\x97g\x02\xe7\b\x9dI\xe8\xad\x11r\xa3\xa2+\xa3\xf7b\vL\x04\xb4\xed\xaf\xfd\x82!\xe6\xb3\x1f\xffc\xc5\xfft\xdf_\xff\x03Ң\xd7Y\xa7\xf0\xe2\xf9\x8d\xfa\x1c#\x1a#\x05\xc80\x1e|^\xf7\xb3\xc7\xed\xa6/d\xcaT\xd3\r\x19\xb8h\x1cn\xba\x16S\x8c\xb8\x9f=\x02\x8b\x04\xb4Fx\xa8\x90JeG˼\x8e]\xce\x1e\x02\xf9s\xe7\x82\xc4<\f\x86\xc9\xe8H\x0eV\"\x00\xbb\xaeC\xef\x1a|zu\x85/^\xbf\x11\x9f\b\x00?\u007f\xfd\x06\u07fb\xb9^9\xe9\xa5\xfc\xfc\xca}%\xbe\xaeT<\xe3i\t\xe4\xc1\x927O\x160,Y\x01\x12\xe6\"M\x001\x81\x94\x14X\xca/+\u007f\x80-\x18\x11mۢi\x1b\xb4ӌ\xc3\xe1\xb0x\xe8S\x02GE\x10t\xe5$\b\xbfA\xdf:\x18O\x98\xc2\x12\x1a\xb3pq\xe4z$\"\x90\x05\x84~/\xab\x85\xf4\x80-\xb3\xbe\x8f\f\x01\xad\x159k\xe0\xa4\xe1[\x0f\xcf'\x9f\x18oӌc\b\xd8Z\x87\x8du\"%UUD\x8a\x11\u07fc\xbdÛ\xfb{l\xbf\xdc\xe0\xfa\xea\x127\xd7W\xd8\xed\xb6\xd8m7\xd8m\xb6躦\xac39O\xe1\xdaরx3Ь\x84?+F_ƚ\xe5\xf3\xa6\x85\xdbS8\x02\xf5\xd9P8\x00\x9a\x00\xa8E<)\xac\xbf\xe2\n\x95,\x01\t?Z\\W+$!)\x0e\xb8\x8a\f^R\x02s\x1eD\xe6\t\x88\n b\xbf?\xe0\xedݝ8&\xea\xf53\xcd\x1e\xaf_\xbf\xc1p\x1cˠRC\xff\xff囗\xef\xe5\xb9P\xe1м\xf31\x03\xf8?\xfc\xe4g\xbf\x18\u007f\xff\x87\x9f\u007f\xdc\xff\u007f\x17\x11\x80\xba\x92\xd1Y\xaf\xff*\x17\xfbL\xf8M\r\x1buM[\x19\x9cȯ\xbe\xed\xe0\xacj\x9f\xb376\x18\xbdsž\x93@\b!`\u007f<\xe00\x1c\x11S\xd0CŬ:\x10km\x91\xe0\xcdAL<\x9e\\\xec\xf0\xf9\xd3\xdb29\x12\x11B\xf0\xd8\xef\x1f\x16\xff\xfc\x1a\x88\x1eG8Rb\x81\xfc\xeb\xf5@E\x18x\x00s\xebA )n\xca\x19\xd0C}\x8c\x11\a\xef1\xc7\x05\"\xb5Z \x9a|\xe3\xd1\x12ϛI\x81!\xad\x8dp\r\x01\xbd#\xf4.ɔH\xc2\xfe\x1e\xbc\xc71\x04\t\xc0u\xf6\xd7N\xf2\xc3;\"\xa1\x99\xd6\xf31\xaf \xff\xf4\xc0\xa9\xacn$\x88\x04\xfe\u007f\xbc\xfcg/\x84\x88\x8d\x93F!*q/\u007f^\xc7\x100\x85\x88\xad:\xc0\x19^ϗ\x96\bOzi\x00n\xba\x16_\x0f\xa3H.\xcfv\xb7T\xd6`\xa7\x8d\x0e\xf3ɚ\x88\xa5){P\xfc\xb1\xf8^\\\xb6-\x9el\xb7xr\xb1\x03\x11p\xd9wxvq\x81_\xbcy\x03c\f\xde\f#^\x1d\x8ex~yQ|\tR\x8a\x803҄\x9cH\x17\x97\xfb\x89\x80:z\x96\xf2}!\xb9\xf3F\xbdis\x13\x00,\xea\x01\xb1\r\x16\u0380\xac\x0e\xf2:\x8c\xd0w\x1d\xac\xb5\xd8\x1f\x0e\x18\xa7I\xadv\x85\x87\xc1\x06\xe2\xaa\xc7,lx\x00\x9dJ\xe1\xe6\x10*\xc5\x10\xc1Y\x85\xd75\x8f\xa31\f\xa3$\xcbYw\xd6\xe7>\xf3\x15\xb2D\x10\x14\xccY\xbd\x17\x93\x98\x1e\x9d\x84\x8aM\x9c\xe0Ìc\f\xd8\x18\xbb4\x02Z\x8ccb\xdc\x1d\x0e\xd8\x1f\a|\xfd\xcdk\xf4}\x8b\xbe\xebp\xb1\xdb\xe2\xf6\xf6\x06O\xae\xae\xd1w\xad $e\x92\xcf!8\x8bOJ\xad\xf9g\x95\x8d\x1ac\x94+`Ա\xb3F\xa1H\x8b\xb8\xbeg\xbcH\xf1\x92f\x83\xd4\xd1\xc3yU(\xab\xc3X\xe4ƅ\xbd\x9f}+\x18\x95y\x95\xae \xb2Z O\xfbqY\tLӌ\xfb\xfb{\x1c\x87\xa9\xac:\x18\xc0p\x1c\xab\xc9\u007f\xed\x1db\b8\xa4\xf4^\xef\xff<(~\x80\xfc\xefK\x88\xfc\xef\xe3\xe3\xbb\xd5\x00\xa0苉k\x9a\xce\xf9\xe9\x9fh\t\xb0y\f\r\xe8\xb2\xe4/O\f\x90\x86`\t\x18\x92/\x98\xfd,\x8c]e\xb4g\xb8\xec~8\xe28\f\xf0Q\xf6QYo[\x17\xff\xdcq&N\x18'\xf1\x0f\xff\xe1\xf3g\x05\x11\x90\xc2\x18\xb0\xdf\xdf#Ā\x9au[\xb4Դ\xb6\xab\xa9Y\xbby\xc23j\xc1˕t\xe7\xf4\xa0\xcaG\xbf@\xc9\v\\\x9f ;\xe0\\\xa0\b:\xd9\xe4\xc8]=ܓ\x1e,\x9c\"\x82\x17\xfb\xe0(\x8e\xaezSR\xd1\xfbo\x1b\x8bƪ\xef}b]%Dx\b\xdb\xf9\xd7^\xf8O\xbb\xbb\xd3\xe3\x9a\x1e*\x1f\x88E\xdeG\x89\xb5П\xe8\xed\xabJ\xd9Z\vW\x11\xd0\x1e\xd9(\xe9\xfe7\xc2\x1aW\x12Ί=i\x8c\xb8\x9bgl\x1aWv\x9f\xf5\x04\x0f\x00[\xd7\xe0\xa6K\xb8\xddx\xdc\xcd҈\xb9\x8aݿ\xe4'pe\xcd˪\x99W\xa9\x1bq\xb1\u007f\xcd\x1f|\xaa\x94\f\xa7<\x00\xd6\xd7wѵB,L\xe2Y\xff\xf9\xcd5\xbe\xba\xbbS\x1dv\xc2Wo\xef\xf0\xfcr\xb7\x10\xcfU\xaae(7]\xa6\xfa\x1c\xa8\xba\xe2\xf4\xf9\xe8\xae?O\xed\xd6\x01!\xb0^\x8b\x04*\xa6\x98u\x13@%\xed\xd2X\x8b\x18\x97\xa6\xbcq\r\xae\xaf\xae`\x0f\a\x1c\x8eǬ\x15T\x12\x19\x15\xf4NC\x93ё\xc8\xebf\xafQ\xcaڄ5\n'\a\xb5\x985F\x9a\x89\xce\n\xf15ꙃ\x13\xa4e\xb9b\x96\xd3\xc8\x18B\v\xd1\xf1\x87\x94'\xe8\xf5\xbd8q\xc2\x14\x13\x0e1`c\x1czk\x05U++\xb8\x84y\x9e\xe1\xbd\xc7\xfe\xfe\x80o^\xbd\xc6/\xbfz\x81\xcb\xcb\v\\__\xe2\xe6\xe2\x02}+\x9f\x95U$\xa1n\xcc\x17\xa92aa\xe8s)\xe2\xe6A\x10\x8e\x1869\xeb\xcaE\xcfu\xf2\x9f\x12\x05\r-7Q\xacB}\xc2\xda\x12b\xb9\xe5\x88J\xd8OD\x02G\xd6i?\xaa\xa4O\xd4\x01>\x04\x8cÈ\xe3qT30\xb9\xb4C\x8c\xb8\xbf?\xe0\xfen\x8f\x14\xe3\x83\xfd\xbd\x010%\xe0/\xa6\xf9\xc3PAzo\x00\x10\x03\xf8\u007fj\x13\xf0\xf1\xf1]D\x00h\x15Ek\xce\f\xfeT&碡\xe6\aK\x00\xb4\xb6\x11R\x13P\x88C\x9dk5\x91c\xf9\t\xc3<\xc2Y+\xe6@f\x99\xbe\x0e\xe3\x11\xc7a\xc4\xe8\xa5+\x15\x16~\xdd!\x9bR\xfc\t\x84q\x9ep\xb9\xdd\xe0w>y^\bP\xd9\xe4\xe7~\u007f\x0f\xefC9\xe4\x85\xd1\xcb\xc5\xc0\xa44\vyϞ!\xba\n\xce/\x1e\xe3g&\xbc|<\xe5=2鴚\x981\xa7\x881Dx\x96\x1c\xed]\xe3\x948\xb8\x84\xd60\xb2n_\xf3\x182{\xb7\xc0Ӧ\xf0\t:g\xd1;m0\x18\x98B\xc41D\xcc:\x8d\x81\xcc\xdf\xf9\xc5\xc3'\xc5\x1f\f\x98\x94\xa4\xf8\x9f\xf1E\xa8\x97\xbe\xf9\xfdl\xad)\r\xd6c\x1c\x80\xfc\xa5c\x88\x05\x1a\xe6\x93\xcf\xe2n\x9aq\xdb\xf7\xe8\x14\x9a\xad/7R8\xf3\xb2m\xf0\xb4\xef0\x86\x80\x9f\xdd\x1dV,\xa9\xecc_绫bJBb\x8c\xfa\x11\xd4\f.\xa6\xf5\xbdp&\x1b(\x1b2e\x15JL\t\xd7\xdb\r\xae7\x1b\xbc>\x1caH\xac\x81\x8f\x93\xc7&\xfb2$\x16\x86\xbf\xa9\n\xbf\x1e\xfa\x9c\x18lY\xd7\x11KxQ\xbd\xfb\x15.\x8d@ŖP wʫ\r\xe2\x12S\x9b\xefkk\x1dX\r\xb4\xc4f\xda\xe1\xea\xea\n\xd6Z\xdc\xed\xf7\x00\x181\xc9$Τ\xe4G&X'M\xbd\x8d\fg,&?k\x86\x80\xbcx\xf1R0\x8an\xa8D.\x89\xcbbH\t\xa1\xb2\x19>W6\b\x8b\xe9P~O\x9d\xba\xfe\x95F\xfbċ\xc0\x83\xe1\x93Ǒ\x03z\xb2\xe8\x8d6\x02\x99\x1c\x9aџ\x04\xf89`\u007f8\xe2\xab\x17/\xd17\r.w;\\]\xeep\xb1ݢ\xef[\x89ev\x02\xf9\xaf\xad\xd2y\xbd\xfb/kA\xae\x14U\fc,\x9c\xb3\xa0\x12\xed\xac\x88\x8e\xa6z\x82\x92Zd+^\x1a\xf3瘊\xe9O\xe6\x1aq\x1dS\xceI\x15\x01Q\t~\xac*\x81$y&\xb3p:\xfc\x1c\x8a\xb3\xabp\x00&\xec\xef\xf68\x0e\xa3\xdc\x17έ\x1a\f\x03`L\x8c\xbf\xf0\x1ew\xe9òz\xe8$\xf2\xfc\x91\x06\xe0\xff\xf2\x93\x9f\xfd\xe2c\xfa\xdfw\xb3\x01\xa8k\xbdy\xc7\a-\x9a\xd5\xc7\xc65K\x16\x9d\xee\x9ds\xea^\xdfv\xab\x83\x9c@\x18\xa6\t\xb3\xf7xry\xa5\x1e\xdbJ\xfa\x9bg\x1c\xc6\x01\xc34\xaa\xf1˺\xb34\xc6\x14\x86*A\xecz\xfb\xd6\xe1GU\xf1\xcf(\xc2~\xbf\x17\xb9aU\xfc\xeb\xe7u\xb6\xf8/\xbc\xb4U@\xd0C\xb3\x96\f\x93IA6Y\xcb\x0f\x81)}\x8c\bJn\xeb\xc8\x15eo\xfe\xda\x00\xf1\xa0\xf7\xea\xcd\xcd1\x01)\x81V\xe1B\xd2\xe2;\xb5\xa3\x95Զ\xec\x15\x101$\xddt\x1b\xf3\xae`\xc6_\xdf\xee\xff\f\xe4_O\xfcH,ş\x19\x8ft\x87\x0f\xd8%\x04Bk-ޅ\xff\xd7v\xaas\x8a\x98\xa3\xd5h\xe4ExgT\rp?\xcfh6\xbd\xaclrtk\xf5}[kq\xbb\xe9`\x8d0\xb5\u007fy8.\xbc\x13\x9c\x18\xfd\x9c\x84\xac\xe4\xf2\x13Yx\b\xc5>\x96\x85\xb1\xfe\xe0c\xa8\x9a\x89:^\x97\x99\xe1\x8c\xc1\xf3ˋ\xd2\x00\x8c\xde\xe3\x17o\xde\xe0\x0f>\xfddm\x18S\xf1LL=\x15\xab<\xd1h!/\re\xf5>:g\x11|T\xa5 \xadr\xdeQ\xbd\xc6LZ3\xc6\x14\xa7\xb9r8\x10\xe1\xf2\xe2\x02D\x06w\xfb=fE\xd2JZ\x9d\x9a\xe3\b\xc1\x90\xc5G\xdfY\f\xd3\x04\x0e\xa18Z\xe6$\xcb\x10#by\xb2\xf2>\x18\x06\x02\x8b\x8f\xc1c\xe9㤮\x06\xa7\xab\xb0\xccE\x10>\x80\xac\xcdbe3\x1c\x99q\xe0\x80!Et\xc6H#@\x06&\xf3\x18*4'Ƅ8{\x1c\x0f\x03^~\xf3\x1a]\xd7b\xbb\xe9\xb1\xddt\xd8\xf4=\xba\xaeE۵h\x9bF$\xb6d\x1e\x90N\x89\x97\xeb\xa4\x14o\xa4\xa2\x80*\xf9\x01\xb9\x11)\x96\xc2\x06\xb5\x05pʾ\x00%<k\xd9\xf5g\xe9_\xc9&P\x9eR\xde\xf7{M\xf1\v1,\x9c\x00N\x98g\x8f\xc3a\x10\xd6\u007f\x8c\x0f\xf6\xfd\xc2,\x02\xf6\x89\xf1\x13-\xfe\xf6\x03\xd1D\xf3\xfe\xe1c\x0f\xe0\xff[\xff\xc1\xc7\xfd\xffw\xa5\x01\xe0ӑ\x8e\xcew\xe2f=\x91\x9d{tmS\xa4}\x86d\x9f\x98o\x84\\\xd8\xc6i\xc2\xfdq\x8fOo\x9f)\xbc\x9fmS\x13\x0e\xc3\x11\xc38\x82\x91\xe04\x03\x00ծ\xb7\x96\xa7\x84\x18a\x88\xf1\xc3g\xcf\xe0\x8c)?\x83\x99\xa5\xf8\xcfs%\xddʮr\xe9\xd1\xc9?U\x81\x1cDg\xe2^u\x12)ұ<Q`!\v\xfa\x14\x8b\xb3\x99\xcb>\xe1\x90\xc6 \xea\x1e5\xb0\x98\x93$\x85\xc9ML%<\xa5.\xb5\xd6\xc8\xd4\xdfV\xb1\xc2#K\xf1\x0f\\\x11\x19~\xe3c\xfe\xf9}\u007fY\x14\xa5ܼ\xd4\xc5\xf6\xf1\xe2\xaf\x1e*\x85\xf8h+\xb3\xa5w\xfd\xe8\xec\n8\xa7\x04\x9bH`\xcb\xfa\xb3aƛi\xc2EӠs6G\xb8\x97]r.\xa2b&\x03\xfc\xe1\x93+L1\xe2M\x819i\x8dC\x9c\x16\xffj\nK)\"\xb2)v\xbf\xd9C\xe0\xdc\n _+\xa9\x92\x9c\x06Nxvy\x81\x9f\xbdz\x8dQ\xa3V\u007f\xfe\xfa-\xbews-\xc6@\x1aum\f?\x80f\xf3\x05\xc7\fpV\x02h\xb3x\x9a\xcb\xe1\x9cŜbY\r\xb0\xae1\xaa:T\x9a(\xb9\x9cl\x99<k>\xcfn\xb7\x851\x84\xb7\x87{Ls@JK\x04o~\xeb\fKCB$g\x80\x19'\x8c\x9c\x83h\xaa\xfb7%\xc0\xa4\x82tY\x12\u007f\x87\xdc\x10\x8b\xe8p\xddt\x9d#\x1c\x97iZ\u007f~g-`\xe5>\x8eI\x94#E}\x04\xc61E\x8c)\u0091A\xab\xbfl1\xe5Z\xf4N\x04\xf1\xcc?\x1c\x8f8\x1c\x8e\xc2C0\x06\xce\xc9p\xb3\xddnp\xb9\xdd`\xb3\xe9ű\xd49\xe1\x0e\x90)i\x8b\x85Щ\x17A\x8c\x11N\xafI\xd6)}\x95\xedS\xf3ND0\xb1p\x0f\xaa\xe8\xe0\xcc7M\x19\xa9̰\u007f\xc5\xf2GҴDm\"\xfd\xec1\f\x03\x0e\xc7\x11\x93\xf7`H\xd2%\x9d\xdc\xc7\x06\xc0\xeb\x94\xf0\x93\x100\xaa\xf9\x13\u007f\xa0Y\xef\a\xf8\xff\xff\r\x80/>\x96\xdb\xef`\x03P\xa88\x8c\xf7\x14\x14F\x8a\xfc\xe8:\xb8q\x0e\xce85\r1ب\xc3Y]\xfc\xa7i\xc6뻷\xb8\xbd~\x82m߯\xfc\xb1\x8f\xe3\x80\xc30`\x0e\xbe\xa4\x05f\xe8\x9f4i\xaf>Y}\xf0\xf8\xe1\xf3gh\x1a\xa77\xba\xbc\x8e\xfdဩ\n\b\xcay\xf1Q\xbd\xfd\xcdI\xc1Iŷ\x9c\x97\xd8\xd8G4\xfe\xd91.\xaf \xf2M\xee\x93L\xfdY뜐-l\x05\x11\b\x19\xa6\xaco\xb8\x18a\x12\xaf\f\x98\xb2C`c-\x1a\x85\xfb\x13\x00\xcfB\"\x9c\xf3\x14g\xe8\xef\xe6\xea\xd0\xf7%\xdb\xdcԪ\x87<\xf5S.0\xefi \xf2G\x9d\x1d\x10kR\xe1\x99(\x9du\x03P\x91\xe0&U\x04\x18$\xb4\xd6.\x9aj\"\x1c\xbdh\xfdoM\x0f2ِf]?,\xa4\xb1J\x00\xfe\xf8\xf6\x06\xff\xfc\xe5kLJ\x8c\xca/\x90Oq|\xa8\xb2 \x9bL)\x9cz69\xfb\xe4`u\x8d\x83\xb1\xb6\x1c\xf8\xf9\x9a\xe9\x9a\x06\u07fb\xb9\xc1_}\xfd\x02\xd6\x18\f\xde\xe3g\u07fc\xc6\x1f\u007f\xfe\x99\\\x13)\x01\xbasϼ\x1bi\x02x\x05\x8b\xe4\xc3;\xe1$s\x1e\fc\xc5<*\x04\xaec\xe2\x17\xde\xc6Z\xbaR\xd8\xec|\"ڷƠ\xef\xc5U\xf2\xed~/\xe4@\xceI\x95\xd5\x1a\xc5\x10\x9ca\x18\x12\xd7@2#01|\fH\xfa:\x1a\x8d\xca\xf6\xfa\x1a\x8b煑B\xec\x13)\"\xb0&\x1bן\x8c)\xd3\xf7:\x8dQd\xc1\x04X\xa0\x87\xc1\x14\x13\xa6\xa0\x01@\xfa\xd53'̺\x96sd\xc4gC=\v\x12I\xcb^\x1a\xfbL\xaeSY\xe3<y\fÈ\xfd\xdd\x1e]ۢi\x1c\x9aFV\x9e\xce\t/\xc9X\x95p\x1a\xa3\x92Ai-\x8c!]%,\xe4Db*!?%\x9bb\x15\x04\xa4\xe7n\xe6<D\xc9\x1b\x89\xea\xf4\x97e\xd4\x05TI\\Ȇ\xc1\a\x1c\x87I\xb8T\xea\xdeH\xea+b\xab\x8b[\x03\xa4\xf1\x8b\x18\xf1E\x8c\x98Y\xd5.\xf8\xf0\xf9\xe2=\r\x00C\xdc\xff\xde|\x9c\xfe\xbf\xc3+\x00&\xc2\xe3\xc7x\x86\xd6̣\x17@\xeb\x1au\xc73\xd8t\xbd~͢\x81\x1e\xe7\x19\xaf\u07be\xc1n\xb3\xc5\xcd\xe5%jR\xde4\x8b\xe4o\x9cF!\x0eU\x1e\xdayr\xa8\xf5\xdfc\x98q}\xb1\xc5\xe5\xa6/ş\x00\xec\x8f\aL\xe3Xi\xf0\x97\xe2/\xbf7\x8b\x01\x88\x16\xe9\xfc3\x96\xdaJ\x0f\nP\x96s\xd9*\xac\x86u\x92\x97\u009c\x109!\xf2\xc2\x01H'ָT\x15\xce\x02\x95g\xa8W\x89\x86ΊG\xba5r\x18\x05\x06|\x8a\xf0\x95\xb1\a\xff\x86\xa7~\xe2\xb5:\xbb\xd8\xcf\xe4\x02\x9e\xfd\xfb\x99E\xda\xc7\xe7\x8a\xdf\xd2T\x16U\xa4~\xa6EJ\x87*y\xef\xd1\x0e\xe0!l\xedS\u009c\xa2\xa0\b\x89\ns\x9b P\xf2\x9bi\xc2\xc69Q\x04\x14x\x92W\x93qc\f\xa2a\\6\r~|{\x8d\xbf|s';\xfa*\x17c\xd9>k\xfb\xc3F톩\x98\xaf\xc0\x10\x18\x8fǟZ\r\x9b9M\u007f\x94k\x90\xf1\xbd\x9b+|}w\x8f\xe3<\xc3\x1a\xc2/\xde\n\np\xb3݊\x1f\x80M25g\x9eG\xe1&\xe4Ьu\vE\xca\xfc\xa7\xaa\t \xa3\xfe\x00\xa8\xe0\xe5\x94t=B\xc5\xdbߚ\"\x88T\xe8d=\x81Zc\xd04\rn\xae\xaepw\u007f\x8fa\x9a+\xdeɲ\x04\x17Ҭ\x14\xb8\x1d\xf5 28\x8e\xc0\xac\x90\xb4\x01\x15t\xcf+<\x9d\x03\x99-\x91$\xf5)R\x16\xab\xa6\x19usN\x809Y'1\xadWu9?\xa2\xb1@\xd4\xfb\xbb\x96\x1e\x9f6\x03\x86\b\r\x8c&j\x12\xac\xbe\x93D\xc2\xd31y\r\x13\x13\x98g\x84\x98\xd0\x04\x87\xc6{8U\x01\x98l/\xac;q\x93Ä\x14\xc9̐\xbf5\xd9iД\xaf]\x02\xcfr\xc2_,RA\xaev\xfc\xe5\xb3\xcfׂ\xa2\x05\xb99\b!b\x9a<\x86y\x92\x15\x90r\x88rRh\xfd\xaeY\x00#\x03?\x8d\x01\xaf\xd4|\xc9T\u007f\xff~\xa3k\x9c\xb8\xb6\x9e}$\x00\xff\x8f\x9f\xfc\xec\x17\xf3\xc7r\xfb\x9dl\x00J_\xfd(\x00ˈz\xb9\x9c\u007f\xb4\x1a6#\xb0\u007f\v\x10ԕ\x8f\n\xec\xff\xea\xed[8g\xf1\xecɓ\xc2~\x17\xb8-\xe1~\x10\xc9_\xbei\xeab\xe2N\x18\xaa!F\xf4\x8d\xc3gOn\x16\xa3b\"\x1c\x87\x03\x86a\x90\xa9\x84\x97\x83\"\xaa\xbc\xc9\xe8\x01\xb5@\xf6X\xad\x00p\x06\xbe\xcdS\u007f^U\x14\a?f\x1ccĠ\x0e~Y\xbb\xbdL\xcd\x0f\xf7\xe6P\xb8\xdcf\xfbΊ\xecW\x9a\vCH$\x1c\x81\x18S\xf1\x9a'\xbd\x89\u007f\x93ş\xce@;\x04 evyJJ\xf0{ǡP\xcb᪢\x9e\xb9\x1c\xe6\xe4{[\xf3\x0e7\xc5\x13\xf8\xbe\xfe\xba!\x044ƈ'\xbd\xae\x11\xf2\xdf\x1d|\xc0\x9bi\x12Ӡ\x1c(u\x02\xef\x1b\x10:\xcd\x06\xb8\xed:\xfc\xde\xf5%~zw\xc0\xac\xbbZ\xd2|\x8b\xf8`i^\x91\x03U\x8f\xcd'\xfa\xf4\xba\x97\xc9\xe4\xa8\x10#l\x12\xaf\x8b\xfc6E0\x1a\xe7\xf0\x83\xdb\x1b\xfc\xdb/\xbf.\x89\x92\u007f\xf5\xf2%\xfe\xe1\x0f\xbe\x0f\xa3\xf2Ng\xb5\x92\xa5\x8a\bX\xfd\x1f\xd530\xd7\xde\x06x(\xdd$\xe1\xc2D&5\xaa\x11\x18?\xa5\x88\x18\x01\xe7\xa8\xf0\x80\x96}5\x17\xbe\x86\xd5܁\xeb\xab+\x98\xc3\x01\xc38\x95o\x9c\xaa\xf7\x16V\xbe\xaf\x14E\v\x03\xc2~\x1c1W&\\\x8d10\x8d\xca\a+R.q\x82!\v\xcb\t\x81I\xdc7+\xe9\xdbb\xd7M'\xe45*qωS\xf1\xdd\xc8G\x89ӯ\xf1ii\x9e\xea\xf6)\xb0X\vS\x8c0*\x1d\xcdȀ\xcd+\x8e\xdchF\xc0Ą&\x04Q\fh\xa1\xcf\b\x80\xd3_\xd6Z\xd8d\x96T\xd3l'\x1e\"@\xfe\x01|Ċ\xb2\xc6l\xf3˂\xaa\xd8lr\xa4\xd6\xe5\x893\x1a\x90%\u007f\xa2\x1c\x92\xff]V8\xb9\xa93\xf55\xa1\xf7\xa1%\xc2\xdb\x18\xf17)\xe1\xc8,?#\xd5aD\x0f\xfdQ\xces\x83\xde+\x01<\x02\xf8\xe7\x1fK\xedwu\x05@\xd0e\xe2\xa3\xc0\xff\"\x1b:\xf7\r\xac-\x91\x91}'\x91\xb3s\n\x05\x12\x1c\xc7\x11\xaf\xef\xee\xc0`<\u007fr+V\xbeT\xb3\xfeEj\x14SD۴\xabA\xf2\xb4\xf83\xcbn\xea\xfbOoaȖ\x83c\x9e'\f\xc7a\xe5\xbeG\x95D\x8b\xce\x14\x96\\\x18R\xc5.F\xadg'\x823Kw\x9b\xf4\x908\xa6\x88A}ϗa\xb9\xbe\xc1\x1eF\xe0\x12+\xe4_\x88<\\\xed\xc3e'\xc7V&\xfeP\xa6\"E\v\x98\xc1\xf67T\xfcyi\x8a\xce\xff5\x83bF,ҹ\x96\xe6\xc1\xbe\x9f3L]\x1d҆\xce%FЂ\xbc\xe8D\xf7Pa\xf1\xc8H\xc1\xa2\b\xd85\x849F\xf4\x15\x93\x99\x01\x91\x04:\x87km\x06\x1b2\xab`\xa7\x9c\xda\xd69\x8b\xe4#\x9e\xf6\xb2\xae\xfa\xe9\xfdA\xfd\xec\xa9ʂ\xd1\"[+\x04\x14\xe1)V\xd6g\xdc\xeaj4Il\xa4ce\x96\xa2q͜\xf0\xc9\xd5%^\xde\xef\xf1\xfap\x845\x06\xaf\xf6G\xfc\xed7\xaf\xf0\xfb\x9f<\x93\x88ט\x8a\xdfE\xd1{S\x15\xff{Z\xe1\xf5\xe2^4\xe2)\xbfۥ\xfd5\x86\x90Ҳ\"\x03\tK_\x1a\x0eS\xa4\x85\xab\x1f@,\xd3,\x8b;\xd5\xd5\xc5\x05\f\x19\xe5\xecT\xb0qe\x92\x91etd6\x00\x01\xfb\xe3(a]\xfaT[k`\x8d\xc4\x03\x83sD\xaeU\xd3!\x03\xcb\t\x8e\x8c\xb8_\x82\x91T\x9eC\xa7\xd2M^\xe7x$^H\xbdP\xb2d\xe9O\r\xca\xf7)\xcf\xf7d\x85\x95 \xe8\x83gq\xdal\xb2\xb7\a\b\xeak\bC\x06!&\xcc\x1c%\x913\x9a\xd2\xe4f\xde\a490˃3BP\x12X\x15\x95Hj\x8f\xbe\xf8\xfe\x8b\x84\x18\xa7\xa4\xe0\x8a\xe7T~a\xbd2\xa8ϱ\xdct\xd4\x1c\aK\x06s\x8a\xf8\xc9\xfe-\xbeL\x8cv{\xb1\"\xfbQ\x91+~\xe8\xe4\xf0^\t\xe07\x00~\xfa\xb1\xd4~g\x11\x00zt\xf2\x87v\xd3\xe6\x1d\xd3\u007f\xde͋ɏ\x15\x873f\x10\x19L\xf3\x84\xd7\xf7wH)\xe1\xd9\xcd\x13\xec\xfaM\x05\x87\x13\x8eӄ\xc3p\xc4\xe4g8\xeb\x16}\xb8N\xdd\xf5n)\x17\xedϞܠuM\xb1\xf8\r!\xe0p\u0603k\a\x03\xaa\xc35\xe8\x81<\xab\x94\xae\x8a{\xc7\xcb\xce\x01\x16b\xe2B*\xb9\x8b\xb9\xf0'F\xc0\xe2\x14V\xa6JZ\xb4\xbb8)dF\x8b?\x9d\x16\xff\xea\xe7\xceٹ\xacʆ\xcfHAr\xf678\xf9\xf3\x83\xf7\xa4\x10\x129\x13\xfb\xf8\xdd[\xfa\x93\x02djY\x1d\x1e\xba\xf6僯Ħ\xd2\xf9\xdc\x00\xac\xfdu\x1e\xb4\x1dS\x8c謅3\"\x89,Y\x02\x00\xa6\x98\xf0v\x9aD:)\xf5\r\x8e\xcc\x1a\t`!j6\xd6\xc0$\xe0\x93\xedF\x9a\x80\xfd\xb1\x14\xf7U\x83S\xe7\xb9ӂ\x02D\xa4\xeaZ{\xf8L\x17e\x8aLou\xc40'\x86\xb3\x06\xbf\xfb\xfc)\x0eӄ\x90\x12,\x11\xbex\xf5\x1a\xbb\xae\xc5\xf7\xae\xaf\x10b@\xeb\x14\x80V\xc2\x03\xf1b\xfc\xb2\xf8\x18d_z\xd6\u007fF\x8b\xe9\x0f\xd1\x19\"\xa6L\xedyM\x96\rs\fq1\xa3\xe1\xd5\x1a&\xdb\t[xm\xf0/\xb6[E\xdf\xc6EU\xb02\v#\x18\xa7\x93\xfdn\vb\xe0~\x94\b\xee\xfc3['\x05r\xf6\xa1\xe4\x1a\xc85\x92\xa7}Yc\x90:\b.\x92y^qD\fr\xfa\xe0Iq/\x18\x9a\xfc\xd7@\x10\xb6b\xff\xadJ\x91\xdc4\xd4d@h\xd3\x1f\x99\xe1H\xb29Lޛk\n\"%`\xd2\xcfm\x91\x1a\x1a\xd8\x14\xcb*$\xef\xe9M\x85X.\xfd7Ww!-ʄ|\xae\xf0Z\"\xfb\xc0\xb8\xec\x14u\x02\xad\b̦j\x02\x12\x18_\f{\xfc\xe5\xfd[\xbc\x9aG\\\uebb0\xc19?\xc6s\x95\xe0\x91\xfd\xff\xfbϦ\xbf\x85\xa8\x00>>\xbe\x93\r\x00ӣ\x1fwd%x=B\xf2pJh\xe9\x9aV\x12\xf7X\xf6P\x04\x82\x8f\x1eo\xf6wH1a\xd7opsyU\x92\xb2\x88\b>\x04\x1cF\x81\x11I\xf7\xa5\xf9\x02\xb7\xa7\xc5_\xa5D\x9f\\_b\xdbv\xa5\xf8\xa7\x94\xd4\xe27\xae\xa6\xfftR\xb4\f\x1e\x1a\xb5,{\xed*uO\xf7\u007fY\x9a\x98\x18\x18S\xc4>\x8a\xde\x1e\xd5Tx\xfe\x1d[O\xc4&&P\x88\x0f\x1b\x8f<\xacY\x8b\xa4\xe6(e\x11\x93\"(&\xb01\x88\x8d\xfb\x8d|\xe8t25\xc8zBMo\xf4\x17=r(\xe0\xd1\xe3\x81Vq\xc7T-\x00\xcf>\x01:qX<\x83>\x9cl\x15\x1e\xfc\xf4\xa3\xfa\xfb\xcf)\x82\"\xd0[[\x9e\xd5\xc1\a\xdcM\"\vDJ\v\xf9\xb0vs\x83L\xa1#3\x1c\x80\xcfv[$\x06\xbe\x1e\xc6B\xdc,\xfbe\xd4\t\x8d\xd5\xf7\xa8\xd7?\x8f\xad28\xf3h\"H\x8d\x8cra\x8d)\xe1j\xd3\xe3w\x9e\xdd\xe2\xaf^|S\x9e\xdf_\xbf\xf8\x06\x9duxz\xb9CJiq\xa9\xc3\"(\xad\xbf\xb7\xb8Fճ\xf8B\x11\xa4\xa5\x92\xe8\xcaKd\x8b\x9c\xb84\x03L\x06\x91%\x88\xc7Ո\xc7\t\xc8Cd\xe0\x9c\x93\x15\x1f\x01\xdbM\x0fN\t\xe3<W\xc6ZUS\f\x95\xfb\x19\x03s\xb1\x05\fa?\fU\x93\xa5\xcdvk\x11|R\x15\x80\"\bʻ\xb0\x94_k*\xeb\xc3|\xdf.\xc17'\t\xa6eu\xb1\xdc\xf7\xb1\x98\x18qYqd\x13\x1eK\xc0\x9c\x92\x88\x142\x8f\xa1j!<K\x06\x873\x84F\v\xaa\xc1\"\xff\x8d\xca\t\xca\u007f\x9e\xcf9\x93\xff-\x11,\x16\xf2\x9f\xa9b\xc13b\xc9'H\x12W\xfc\x99\xc7\xeeFҀ\xa2E\x92\xb8Hl\x8d~|sJ\xf8f\x9e\xf0ׇ;|9\x1e5\xd3Ā\x95PhԴ\xed\xe1F\xef\xc38\x00\xefA\x00\xfe\xa5\xf6\\\x1f\x1f\xdfU\x0e\xc0y\x985\xa9f\xde>\xfa\xc1;kѶ-\xba\xb6\x05\xe7\x03\x8e\b!E\xbc\xde\xdf!x\t&yz\xf3D\x99\xbb\x8b.\xfa0\x1c1N\x13B\n%\xe1/\x13d\x9c1\x0f$`\xcf./\xb0\xeb\xfaՔ|<\x1e0\xfby\xd5\xed\xf2)\x89\x8d\xb0bߟ\xae\x02\xb2TK\x92\xe0\x16\xf2ژ\x12\xf6)`\x88\xe9\xace\xf0\xa3\x95U\x1b*\n\xb2O,\xfb\xf0|C\xebt\x95\xecz2#^ls\xa3\xb3\xe2\xea\xf7\x1b(\xfc+/\x03\xcek\x86$\x8c\xfe\xb3\x05\x9eށ\x1a\xd4\x122z\xb4=8\xf7\x1d\x8c:\x1b>V5鱾\xa3\xfa\x9e>%L!\xa2\xb1\x16C\x88e\n˓\xdb\xdbiFk,\xae\xbb\x16sL\xe8\xd4̥&8ew\xc6)D4D\xf8|\xb7\x855\x84_\x1e\x06\x84\xc8˛f\xd7\xcdc\xf6\xeb\x8fE\xb7\xcd\v\ntR\x8c2\xf9,'\xb2\xd9\x13d+\xa6\x84\xcfn\xae1\x85\x88_\xbe~\xab\xf7P\xc2_~\xfd\x02>%|rs\x05\xe7\x1a\x95\x1arɔ\xe7\xfc\xbf\x19y);\xfb\xca\xe5\x0f\xb4^\u007f`q\x01,N\x94ր(\x81\x88\x11B\x84OI\xac\x92KR\x1dVѲ\x99\xf0&I\x85\"\x13,i\x9cT\xfb(,\xc8Iv֓\x9d6\xe1\xfe8\x14\xd7@i\x02$\x891F!\x00f\xbeMf\xa9\xbb\xec\xfcY\xad\xcfHH:U(\xd2z\x12\x16Y\xa2)\xa6PR\xe4%A3j\xda\"1\x15\xb5Hk-f\xc8\xeb\xa7\n\xa9$\xe5\x04d\xe3\xa1\b\x127O2U\x13KEQ\x94\x94|i\xaa\xb8\xf4\x1c\xffk\xa8\x8e\x1e7%\xfdԪ5\xb8\xc9r\u0093a\x85\xb0\x0e\x1d\xc2\xd2\xda\xc9Ϫ0ɨFd\xf7aƋiċq\xc0}\x98\xb5A!ؼ*I\t)EXc\xab\xfc\xd7\xe5\xe1\xe3\xfb}{\xe8\xdd\n\x80\xa8\r@\xfcXj\xff\x1b\xd4\x00H\xc8I*\xd3\xc1\xb9\x875\x06\xadk\xb1Q\xa3\x9f\xa4{\xeb\xc4\to\x0ew\xf0^.\x1e\xf1\xd9\xee\xaa\\A\xc2q\x1ap\x9c&\x8c\xf3Tr\xa4\xf3\xde̝\x14>fƓ\x8b\x9d0\xfe+\xd6\xfe0\x1c1N\xe3j\xf2?\xb7;\xe639\xed\xb5\xa9H\xa3?S\xe9Y\b\f܅\x80C\x8c\x85Cp\xb6F\xbd\xa3;&\x1fJ\xf2\x1d\x9d\xb0\xe4ٚ\xe5p\xd4\x11.\xbb\xe7\x81\b\xb1q`c~cſ\xf0\x12\xd4x(\xc3\xfc\xa7\xbc\x85\a\xa5\xfc\x01ԏ\x95O\xfd\xbb`\xc3s\v\x04)\xd4\xf4\xb0\xcf`<\xb4\x9a~\xc7k\x1aB(S\xc8\xd1\a\\\xb4M\xd9i\x8e1\xe2\x9bq\x84\xb3\x84\x9dk0\xa7\x84\xd6\xd8\x13\x19\x9c\xac\x02\x921\x98SBc\b\x9fm7\xb0D\xf8\xe9\xfd\x01\x93f7\xf8\x94\xd0eNJq\x8b\x94g\x1a4\x85\x92V\xab\x825t\x9b\xa3`\x93\xc2\xec5\f\x9c\x9b\x87\x1f=}\x02\x1f\"^\xed\x0fB\x02L\t\u007f\xfb\xf2\x1b\u070f#>}r\x8d\xcb\xed\x0e\xce\x19\xb4\xceT\xc1\xd0f\x1d\xdf\xcd%\xe7X\x1d\xe4x\xf1\x00\xc0\xdaL\x87H\x9c\x04\r\x11R)N\t\xd3\x1c`\xac\b\xd29\xe9>\x9bh\xb5\xf2\x91U@*E\xe0\xe2b\x87\xc3ALfʳ\xa9/\x0eu\xe0k\xdbVeq\x06\xf7\x87\x01\xccQw\xe5:\xf5\x93\x81ՔŠ\x06Y\xd9v\xb8\xb1\x04\x8f\xf8\xe0\xdeS$\xbf\x14~\xab\xb2I\x03\x93]\x8cu}\xc0ؐ\xa8*\xee\x83Fp\xa7\x04\xabgN&\x872b\t\x1f\xca\xff\x8d5\t1\xef\xd3Y\xe3\xbaa\n\x87\xa5L\xe2)#\x10\\\"\xa7\t\xa9\x90\x0fI\x9b\xfe\xff?{\xff\xf5lY\x96\xe7\xf7a\x9f\xb5ֶ\xc7]\x97>\xcbu\xb9v\xd5\xdd\xd3\xd3\xd33\x03\f\a#\x00\f\x91\x90\b@\x80\x10\x14\x02\x12\t\xca\xf0A/\f\xbdH\xa1\b\xbe\xe0\xaf\x10#\x14\xc1\x10C\x01\xea\x81\x0f\x14\t\x11\xde\x13C\f0\xbemuwu\xf9\xaa\xac47\xaf;n\x9be\xf4\xb0\xd6v\xe7\x9e{\xf3f\xfb\x9a\xc8\x13Q]]\x99\xd7\x1c\xb3\xf7\xfa\xfd~\xdf\xdf\xd78!p\u0086`\x01\xe8ڞ\xe1=`{\xdc \x15~Qc@VX\xc3BWhkYj\xcdY]QX\xc3J\xfb\xe0\xab\x06\xbd\xdaf쳩V\xea7\x89Z?\x99\xb8\xff\x04\x05\x80\x06\xbe\xfd\xf6\x87\xf7ܳR\xfb\x8b\xda\x00lqak}\xaa\aԬ\xe1#\x89b\xf24\rP\xbci\xa7\xa0\xd3՜\xb2\xac\xc0B\x9ef\xccFS\xfa\xcb\xf9\xaa\xaaX\x15k\x8a\xb2\xf0\bC\x98ʤ\x18\x16\xff\xc6\xf8e\x92\xa5\xec\x85\t\xa3\xb90\xeb\xbab\xb5^\ra(\x18@\xb1\xadnz\x8bEk7\xf5\xfb\x00\x8cf\xa7\xb86\x96S\xad)\x9d\x19\x16\xees\xb5\xd1]8\xb9\xcaZ{c\x9cޗ9!|Ī\x14\xc3\xec%k\x91ր\x05\xa7\x046\x8a~*\xfb\xfe\x96\xd9\xdeb\xc6nh<\xe2\x86:\xeb\xed?C\fv\x90\x17=M\xc1\xd5\x1a\x82s%\xf2\x92U\xd4e\x8b\x16\v\x9c\x94%\xd3\xc4\xe7O,k\xcd4Xꊰ&x\xb0\\qg2!v\xde\xc0&n\f\xa8z\x0e\x90\xb1\x92-\x8c\x1bI\xc9\xcdQ\x8e\x14\x82\x0f\xce\x16\xac\x8d'\xc8\xe9^\x86C3\xa1Z\xeb\xa8B\x86\xbd\x8a\xa2\xf3\xd7J#?k\xb6\xd0!@(R\xa2%z:\xe1-\x85\x95\x10|\xe6\xfa\x01B\xc0\xc9j\x85\x12\xbe\xe1\x98\x17\x05\xd5c\xcbx\xb9\"Kb\xf6'\x13\xf6\xc6y\xab\b 0\xe0\a\x861\x81c!B\xfe\x00ֶ\xfb\xee\xbe\x01MS\xace\xf0\x9c\x88P\xb8$\xc1\x1a\x13l\xbf\xado3\x9a\x86\xad\xa7\xf9WJ\xf9\xd8a!\x90Q\xc4d2b\xbd\xf6;~km\xd7\xe8\xd2e\x1a\b\xe1 I\x18\v\x9fb\xb9X\xaf\xa8\x8dnw\xf8\xbeq\xb1\b\x17\x98\xf3ƻf\xdaF\xc7.U\xdbp\xb9Mb\x83\xebB\xb6\x1a\xdb\xe1\xe6\xa56H\x82q\x8e4\n\xefk\xad}\xecp8\v\x84\xf0\xd0\xf8H\bֵ\xa6\xdeX\x85\xb9\xfe\xf2\xcf\xf9\xeaV:\x13$\x84\x82\x88\xce\\h`\x1c\xee\xfaS\xbfG\xa9\xa4\x94-\xc9\u05ef\x04,\xc2\xfa\xf7\xc0\x9b\"\xd9v\x01\xa1\x9d\xa3\xb4^n\\;\u007f\xd6zo\x10\x1dȊ\x96ښ>Fpi\xd1߆\xe6b7pR\x01\xfa\n\b\x80\x94\x97\"\x95\xf3\xc0\x01x\xf6\xf8\xb4 \x00M?\xb8\x99R5\x98ޢ\x88<\xcdQJu\x96\x958\xce\xd6\vVE\x01\xd6_\x8a\xbb\x93i\xf0\xf3\x0f\x05\xdaX\x96Ś\xa2*=+:p\b\x94\xf0N[\x9bMH\x1aE\x1cL'\xed\xfe\xb2\t\xf8\x99/\xe6\xed\x81\xd0\xc2\xda\x1b\ta\x16.\xbc\xf8\x95\xe8\x82J\x1a\x87\xc03c\x98k\xdd\xee}\x9fz\x1f\xe6\x1c\xa2\xd6C(]\b\xac\x14\xddD\xdf\xfa\x15\rCr\x9c\xba\xe2\xbe\xff\xa2\x85\xf8\x95\x8a\u007f\xc7x\x94\xfdB\x1b\x1a\x81m/o\xb3\xd8o*2\x86\xe3\xdd\xe5\r\xc1\xe6\x8f\xd7\xd6m \x03\xeeҦ\xe1\xb2\xf5\xc2R\x1bJSrk\x9c\x87hh\x0f\xf77?\u007fYk\x1e\xaeV\xdc\x1c\xe7\x18݅\xf4H\xc0\xf6\xe0\xf1T)\xac\xd6m\xda\xe0\x8d<C\n\xc1{g\v\xaa\x90Mo\xacC\x85\xa9\xb91\xb2q\xceQjM\xba\xd9\x00lڸ\x8a\xae@\xf7\x13\r\x9b\xfb\xce:\x88\x94\xe4\xe5\xeb\a<<M8Z\xad\xdaՖp\x8eZ\x1b\x84T̋\x82i\x96\x90\x88\xa83\x18\xea]`\xa2\xb1\vn\b\xf9\xae\xbf\xf4p\x176vM\xd3к,:\x87\x90!O\xde\xf9Р~\x13\xd0L\xce-\x9a\xa6\x14\"\xcfX\x17\x15\xda\xe8\xd6\a\xa4\u007f\x11\x89`\x14&\xe2\xa8\xd5\xc1{\"\xb0n?P\xe9d`\xf3I\x9c\x02!,Z\xf8\xf7^J\xe1#\x8f\x9d\xeb\xc1\xde\xfd& p\nΑ\xe7\xbc\t\x94\x90PY\x8f@M\x93\x88Ӳ\xa6\xb2Σ\x03QG\\͢\b]_ͺ\xde:G\x81\xb7\xfd\x15\xae\xe1\x00H\x90\xc1Y1\xc4F;!\x98\x8dg\\\xbfq\x1b\xa5\"\xaa\xb2\xe0\xed\x0f\xdfg\xb9Z\x06\xbf\b\u007fnYg{\x0e\x14\x1d\xac\xdf\x18\x8b\xd9\rPN\xb4\x19\x03\x97\x19\xb9oi\xe9C\x80\xd1Ew\x9c1\xfa\x89\x8d\xc3\x13H\x80\x0f\x80\x87\xcf\xca짨\x01\xb0C\x90p\xeb\x87>Js\xd28j-t\xads\xac\xd6k\x16\x8bU{-\x8d\xf2\x9c4N{\xb8\xae`U\x16\x14UIU\xd5m\x80\x8e\x94\u07b2t۞r\u007f:\xf6\xb2\xa4\x86\x8c\xe5\x1c\xf3\xe5\x02\xb3A\xfas\xe7\xe027\xe8\xfe7\x8b\u007f\xd3x8`e\fg\xba\xa6\xe8\xed\xfd\x9e\xfa\xe1\x1c\xb2ҭ\xe4\xaf)\xea\xb6_\xf8\x1bxv\xa3pZ\xa5\xb0W\xd9\xf7?e\xf1\x97=f\xba\xe8y\xf4o\xd3\xfb\xbb-E\u007fs\xcao\xa1\xea\xf05I\x14\x91\xc6\x11\xe0u\ue96e\xbd\x13[\xd8Gڰ\xdb6\x1b\x12\xa5\xa6(W\xd6\x0e\n\xf5\x8f\xfah~\x8e\x16\x8eEU\xb3\x9f\xa7\x98\xc0\xdcn\xf6\xb6R\b\xceJ/\r\x9c\xa5\t\x851ލ\xaf\x87\x044\xfb\xe84R\x14\xdax\x0f{\xe0Z\x96\xa1\x84\xe0\xdd\xd39\xb5q\xa8\xd8[:{\u007f\xfb\x8epW\x1b; \xb6\xf9\x8f̶͒\xeb;ЄD>\xb7\xd9T\xe1\xb0ֳ\xc8o\xef\xee\xb07\x1e\xb1\xacjJ]\xb7\xef\xbf\x04\xf2$AH\xe5\xd7tւT-\x06\xdeybv\x96\u007f\x1d\xb3|\x98t\xd8 @\xb4[\x03\x89\x15 \x9d\xc5I\x81s~\xd7\xdd\x10\x1d}\x9a\xa1\x18\xfc,\x15)\x8c6\xedg\x18)E\x9a&P\xba\xe0m\xd0M\xa6\xfd\x04N\x19\x02\xacd\x96\xa0\"\xc9j]\xfa\f\x81\xc6\x13\xc0\xdb!\"\x8d\xff\xbaH\np\xbe9k\xe4\x97n\x83\xd7\"6zQ\xe3D\xbb\x02l\x11A!H\xa4\xa0\xb4\x86\b\xc9,\x8eY\x04\xa7\xbc\xd8\nb\xe9\xd7Z\x89\x12dV\xb16\xe6Jg\xc2\xc0S\xa0\rXs\x9d\xa1\x12\x9e\x8f\x90\xe7cF\xa3\x89\xf7_\x10\x82\xc7E\xc5\xd9j\xed\xcf@)\xb6\xf1.7\xee\x9dn\x87\xff$\xf4\xedIm\xb4/\xe0jx\xb3\x87_l\xac\xe9\xec\x88/A\x05\x9f\xc0\x01\xf8\x10xf\x00\xf4\v\xdd\x00\xb8\x8d\xe2\xef\x1a\x83\x11y\xae\x01pΑ%)y\x9av:V\xeb\x83&N\x17\x8b\x96\xf4\xa3\xa4d6\x1e\xb7W\xad\xc5Q뚢*\xa8\xb5\x0e\xe9c\x9e\xe9\x1f\a\x13\xa1\xcd\xdf3\x1b\xe7\xe4i\xd2\xc1\xfaB\xb0X.\xa8\xab\r\xd2\xdf\xc6\xf4\xda\x1c\x10r\xeb\xbeJ\xb4!\x18\xda9\xcet\xcdBk,?F\x9e\x8eu\x1e\xf6oR\xbb\xa4\xdf'\x0fC\xdc=\xd1\xcemJ\x12\xfb\xe8\xc0e\x85\xff\x02̽o\xf6\"{ȅ\xe8\xa3\x18\xce=\x11\xbd\xd8\xdc\xe57;c\x84ߏ'J\x92E\x8a<\x8eɓ\x98Q\x92\x90'1Jz\xfd\xb86\x86uYQ\xd6u\x17\x8c\xe4l \xe9YJ\x13\x92\x11\xad\x1d\x1cfK\xeda\xe6D\xca+\x82\xff\xe7\x1f\xde\xf5/\xc6X\x1f\x94tVVL\x93\x04m\xbd\x15nǦ\x16<^\x17\xa4J\x91HI\x11\xa4li\xb3\xff\r\r\xa8\x12~\x0f\\\x1a\xd3N\xcc\xfbYJ$%o\x9f̽\x03\x9c\xf2\x048+\x04\n\x15\x90\x01o\a\xddG\x01\x9a\xb5X\xb7\x13\xf7E\xc0\x11\\\xf865\xed\xbd\x12\xde\xd8\x05\x8fÚ\xad\t\x1dRJ1\x1d\xe7\xa1\b\xfa\xaf\x8f٤R\x88\xdel\xec=$\xa4T\xddDװ\xe87\x88\x9f2\xc8Q\x85\x14H+;\xbf\fՊ緐l}\xf2`C\xces8\x92X\x81K(\xcb*45n\x88$\xd1\xe9\xef\x9d\x14$q\xdcj\xe4\x97\xeb\xa2m4\x9c\xf3\x015\x18\xff\xbb\x95TXk|\xa1\x1ch\xfd\xb7\xcb\xe1d_\xfd\xe8z\x99\n\xc2\xf9\xcf8\xa4K\x8eEĢ\xaeY\x1b\xe3у\xf0s2\xe5ͦ\xb4sOu>\x88މگ\xaa\x12\xffZ]h\x0eV낪*;eƦ\xb1\xd3\xc6\xcf\xdb|\x8d\xe7\xeeߧ\xb8\x81<\xffC\"6\x15\x00\xe1\xe7h]_\xedg\\n\x02\xfe6\x17\b\x81\x9e=~\x01\x11\x00o?\xe1\x18\x8a\x89\xba\xe9D)\xc54\x1f\r\n\xaf1\x96\x93\x1e$\xefpLF#\u007fS\xb7F)P\xd6U\xbb^P*BIA\xb4Q\xfcE\u0605FJ1\x1b坮^xC\xa1b=4\xfb\xe9\xd7\xc7f\xf2o\xc2f\xce\x17\u007f\xd9\xe6u\x17\xc6p\xfc\xe3N\xfdx霨k\x84\xf5{~\x1b\xc9\xe1\x0e?\x10\xfc\xda-h\x9f\xe8\xf6\x14ſ]u\xb4\xab\x83a\x91\x17Oj\x1e.\x89\xdb\xdbl\xbeLH\"K\xa4d'M\xd9\xc9\x12\xa6Y\xc24\xcb\x18\xa5Ɇ\x1d2a\xa7m\x18)Ū\xf4\xe6(\xbe\x91TX\x1cUd)\xb4&\x8f#*mXkC\x19\xa6*\xeb\x1cg\x95&U\x92Ty\xab\xd2N\a\xee\x060c[4\xc2ۘ*ŝ鈽,m\x9fwi\f\xa7eŢ\xaa\xa9\x03<\xdf0\xffq\x9e\xc8w\xb8.\xb8=\xf6\xfa\xf5\"H4\x13\xa9\x06J\x86X\x82CR\x85\xc2c\x9dc\x96\xc4|n\u007f\x87wN\xe7,jM\xac\xbc\x11U\x1dP\x0ea\xbd\x1b[\x14T,M\xb1\xb4\xc6Bܣ\x8e\t\x06\xa8\x98\xb5!x\xa5\xbf,n\x1a\x12\v\xb51$QD\xa2\xa2\x96/ck\x83KUo/\xedڢ\xd8\xfe\x18\xd1\x18\x19\x87\xffP\xa2\xb3u\xa4c\xea\xbbAq\xf3еsaj\x17\rA1p\x04\x1a\xbb\xe4M\x99\xad\x94\xfe찮\x95H\xfa<\x0f\x87\xab\x00g\xb0ld?\x04\x02b\x93l\x18\xa9\x88\xf1\xc8;\xe8\xcdWk\xb46\x01\x9e\xf6\xb1ص\xb5\x1eZw\x1e\x99PR`\x8c\xdb(\xfa\xfd\xd7!\xc2\x04\xdbg\xce\xf7́\x02\x17@[K.|\x13\xb7\xa84\xa54\x8cB\x13\xa7\xa4 \x8b\x14+m\xae$\x89۸\xb1ΟAJ\x11\a\xb33\x87c\xb1^Qi\xdd*D\x94\x14\x97rk\x1a\xf5\xcc\x10\xc6\xef.\x1b'.\x80\xf5.\xf8\x81J\xc8\xf0y\x9f?'\xae\xdc\x00\\~x~\xe7Y\x03\xf0\v\xde\x00\xb8>\xfcx\x89\xe1\x04\x0e&\xf9\x888\x8a\xbat4\xe78[-Z)\x9e\xc3\x11G1\x93|\x8c\x10\xaa\x85\xf2*\xa3\xfd^\xcb\xda\xf6ƊU\xd4\xc2\xf0\x9b\xf7\xcd\xeedD\xa4\xa2\x16\x0eԺf\xb5Zv\xf7\xf2\x06\xe9\xafe\xeb^\xa0Z\x90\xbd\vua4'a\xcf\xfbcq\xed\x8d/\xfe\x00V\xa9֫\xbd=\x80\x1b\xf7\xbc\xa0\xb1na7\xeb\xce\u007f\xfdE;\xfb>\x84\xff\xc4i\xe3Ig\x91\xb8\x10Lp\xceO\xcfJJvG\x19ק\x13nLFL\xd3$LD\x1d\xa8l\x9b\xbdv\xaf\xfc\xf4#Yd\x03\x19\x87?\x89\x84$Q\ncu0\ue448\x1aֺ\x83VK\xe3a|\x11$J\xe38f\x14\xf9&\xb15S\xc1\x85\x9f%\x19'\x11\xfbY\xc68\x8e\x06\ue2f9U\xc1\x17\xc0\xb2\xa8jN\x8b\x9a\xd2\x18\x92 \xf1\x14B\xb0Қ\xa3\xb2\xe2Z\x9e\xf9\xdd}\xb0\xa7M#5\x88\x02\x8e\xa5\xc4:Z\xd4\xc28G\x1e)>\xbb7\xe3\x83\xf9\x92\xc7E\xe5ed\xd6\x06\x98[Q\x19K\x1cِ\x8cי;\r\xe5\xf1}\xb7ɡ*\xa0\xa3\x88\x04x]\x8a\x16\xd9h\x88ZRJ\x8c1\xe8\xaa&\x8a#\x0fn\xf7M\xdc{\xefG\v\x807\x067M\xf1n\xb1sћ\xea\xc3\xd7H\x81p\x12\x8fO\xd0\x06\x185\xfbb\x17\xd4*6\xec\xcd۽\xbd\x94\xde7\xa4\x9d\xb6]\x98v\xa1p\x0e\xab\xcd\xd0\x1a\x9a^\xd8O#/\x14\xa2\r\x1d\x9a/W\xd4u\xed\xf5\xea\xc2\x12I\xe9elR`\xac\b\xeb\n\xb7\x11\x80\xd4\x1bW\xdaT\xbe\x8b\xa1z\t\xad\xa5\xf4(Rh\xeb(\x8d%Uށ\xd0\xe1\xd1)\xa3\\\xdb,>\xcdZpX\x89\x83\xc49 N\xd69\x96ź\x1d\x9c\xfa皻\xa0\x8f\x10[\xd9\x1bCkd\xd7\xc2\xfb\\\xea\xe6'\x91(\x15\x05Ӵ\xf38\xc3U\x14\x00\xde\x02\xf8\xc2\x13\xa8\x06~\xf0L\x01\xf0)A\x00\xac\xb0-{N \x10Jl@\xff\t\xa34koX\xe7\x1c\xebb\xddz\xf87\x8f\xd9xL\x1cv\xec\xcdZ\xa1\x0e\r\x80\xd6up\x13\xf3\xd3\xcc\xe6\x15j\x9dc\x94%L\xc2\xe1\xecaT\xcbj\xb5\xecH\u007f\x1b7\x89\xeb\xf5\xf5\xf2Bx\xccàgF3\xd7\xfaB_\xbb+7\xf7\xc6 j\x03Bz=\xff\x00\x15\xb0\x88\x90.ge\xef\x19\x85\U0001c2ca\xbft]\xda\\\xf3\xbe<\xa9A\x11W:\x80.\x1eJ\x1a\xe8:Ob^\u07bf\xc6+7\x0e\xb89\x9b\x91\a\x16}\xad5eU\xfb\u007ft\x8d\xb3\xf6\xdc\xc1\"¤Uk\xd3\x12(E/g\xbei\xc0\x9a\xcc\x05\x0f\xf9+*\xd1\x11\x9dZ\xc85R\x1c\xe4)\xd7G9\xd38&\n\x88\x8d\xb5\x9d\xc9J\x14,\x9a\xfd\xd4\xec\xda,u\xd9Bؾ\xf8F\xa9g쟖\x15\xabZ\x13K\x15T\x1f\x82\xb3\xb2$U\x92Yx\x9d\xc6Y\x8c\xf5_ߠ\x05\x0eA\"e\x90\xf9\xb9\xc1z\xe9\xc5لQT\xf0`\xbd\xa6ІR\x1b\xefko\f\xb9\x8d\xd0!\xa9P\bZO\xf7\x01\x8f\xa2\xd5\xc8\xfb\u007f\xbc*\xa0\xfbL\x1ax_\x86\xc2j]\x13\x0f,Zi\xae\xb1\x16\x82\x17\xbdu\x16iU\xb8\x01:\x94\xe4\x1c\x17@\n\x9c\xe9c\xf1\x1d\x83\xb0E\x12z1{\x03\xff\x8a\xc1\xe7\xdd\xf37\bSh\x93\xe3aC\xb8\x10-\x12\x10{_\rWRk\x1d\x82o\xc2\xd7\a\x87\xa5\x01G\x02H\x92\x84]\xa5\x98/\x97\x94e\x15\xd2#=y\xd0\u007f\x066D#\xc8ޔ\u007f>\xbeٞ\xc30\xb7\x9bY\xc7R\xa2\xadc\x12G\x9cV\x15\xa5\xb1\xa8H\xf68\x03><\xaa\xb6O3̞\xb7Ȏ\xa2\b\x19\xd2\x10\xb51,\xd7\xeb\xceoi\xcb\xee_\\\xb2{\xbf\xe8k\x1a\xcc\xe4\x89B\xda\x01ٓA\xf3\xe0\x9c\xbb\xa2\x02\xe0\xd2\x13\xe8\fx\xf7Y\x89\xfdEo\x00\x82g\xbe3\xae\x15\xc8\v\xb9\u9fef\x98\x8d&\xed\x9e\xd49_\x1cN\x16\xf3.\xa5\xce9\xf24e\x9c\xe5\x03\x17?\xdd+\xfe\xd6\xf9\x00\x14\xa5\xe4\x857\xcd\xcex\xd4Jz\x1a\xbd\u007f]Ճ\xe2O\u007f\xfa\x0fE\xa5\x9f \xd8?\xac\x94\x94\x18\xe0D\u05ec\x02\xf4\xfc#\x17\u007f\x17\x8a\xbf\xb185\xb4\xe8\x15\xce\xf9\xc2＋\x9f\xed'o\x85\xf4<\x1b\xa9\xad\xb0\xbf\fr\xa0\x01\xec\xffS\xfc\xd0](\xfc\xa34\xe5\x95\x1b\xd7\xf8\xec\xad\xeb\\\x9bN\x88#\xd5\x1e\xdc\xce:\xefT\x18\xf9\x1d\xbf2\xcd\xc4\xe5z\x86N\xde\xfc\xa5\xa8**]\xb7\ue096\xe1kh\fRZ\xf6x\xb3\xf7\x0f#Z\x1eEܙ\x8c\xb85\x191I\xe2n\x0f\xdb\x16c_\x84\x85\x10!~U\xb5\xab\bc\xfd\xb5\xa5k\xdd^\x8bQ 7M\xf0\xee\x80\xf3\xaa\xe6Ѫ\xa04\x86qh,NʊT)\xc6q\xec\x0fd\xeb[\xc9Xu+\a\x83\xf3\xbe\x01\x18j\xe3\x06E\xe4Z\x9e\x12I\xc1㢤0\x06-\x15\x95\xf0\xe4A\x17&\xf5(4/\xed\xebw\xdddJ\xaf\x19\xf0$\xbb~v\x00]\xd4O\xe0\xb38\xd7hȻ+\xd8\x1a\x8b\x0e\xaf\xd7\t\x8bPQ\xb0\xf0\xedr\xa9=\xfa\x10\\\xef\xa4\xc0+\xe8\\[\xff\x1b~\x04=\xae\x82\xe8w\x00N D\xcf\xe8H4\xc1Z\x1e\x89\x10A\xb7.B\xb3\xe2\xdc \xdf\x1a\xe7 \t\x8d\x96\r|\x91\xa85\xfe\x02\x19\xf8\x14X\xdaXn\xf0d\xc2\xdd锅\\\xb1X\xaf\xfc\x9a\xc5\xf9&MK\x01\xc6!\x1b\xb1\x80ۜ\xed{\n\x80\r\x19\x8a\xbb\xa0\x91\x8eúa\x1aǬ\xb4\xa6v\x96D4M\x80_%\x99\x1e\x03\xff\xa9\x87\x06 \n\\\a\x82\x13jQ\x96C\xc4usڿ\xc0\x06[\x88ͅ\a\xdd\x0e@\f#\xc6/,\xde\xe1l\x1cH;\xc3\xff{\x12\xfb\u007f\x88\x00\\\xf88\x01\x8e\x9f\x95\xd8_\xf4\x06\xc0\x81\xd5v\xc3\xees\xc0\bc6\x1a\x13GѠ\xe8\x9e.\x16h\xa3\x91\x81\xa5/\x85`6\x9a\x84l\x00\xd1\x1e\xfc\xb5\xd1\x18\xa3\xd1\xda\x10\xab\xa8\x95\x0emv\xb0\x85\xae\x99\x8er\xb28\xf8\xfcKAU\x96\x14\xc5z\x00\x1b\x9e/\xfeb\x10\x04\xd2\xff\xa1RJ*\xe78\xd65\xa5\xb5?\x1e\xe4\xef@\x18\xef\x987`\xed;\x10\xd6x\x17?A\xf8\xbb\xde\xcdi\r\xc2:L\x1co\x9d\xfcŖ\xe2O\x98\xa0\x9f8\xfd;\xb7}\xbc\xbf\xe4{M\xb0\xc5}\xfd\xceM\xbe\xf6\xd2\v\xdcڙ\xf9]v`\xbe\xabV\x0e\xe6z\xd20\xda\xe2\xe0\xc2\xf4RT5EUQֺ%c\x19k\x83\x9d\xaak\xa7<\a\xd4ڻ\xccEB\x06\xfe\x83#\x0e{\xef\xeb㜗wg\xecd)\x8a\x81y\x1c\xd29\x94\xf2Q\xd3Y\x96\x92\xa6>o\xc2?\a\x8b6\x9a\xba\xd6T\xc1NYk=x;\x12%I\x94`\x92Č☷ON9*J\xeeLF\x8c\xa2\x88\xca\x18\xc6q\xe4S\xee\x82\xf9\x8c3\xce봛\x8cx\xe7|\x12\xa63^.\xd6{\x8e;\xa9\xdf\xe7Ϋ:0\xfb\r\xb5\xf1΄\xda\x18d\x98\xb0\x8c1\xb4\xef\xa0s\xe7-^\x85\xe7҈\xa8K\x9dt\x81?\xa3z\x88W\x1b<$\\/Y\xce\"0~\xb2\xb6\x8a\xc6Yڅ\xb5\x89\b\\\x83\xd6\x1f(L\xe9m?&:P\x18\x1a\xbf}\xd1z\xf9\xf7g\xc9\xe6>wM\xb4\xb3\x14\x18\xadQ\xaa\x83\xb0\xbd7\x80i?{\x82R(NbrgY\xad\v\xea\xba&\x8e\xe3^^\x84ĩ\xc6Q\xb1\xe9\v=\xda4\x9b\x8c\x89㈓\xf9\x82\xa2\xf2$\xd3DE\x14\xb6\x82\xe0\xe2gz\xc19]\x03u~\x18\x18\"\x17[\x0e\xc3P\x10\x9bՏ\x94.4\x93~5\x95\xa9\x88\x95\xd6?r\a\x10\xab\xb8e\xcd\xd7ZS\xd6uo\xady)}\xa0;\x17\xb6\xd9\x02\xf7ٕ\\\x8d\r(\x84_\x01l{/\xae2\xfd\xfb\xf3\xf5R\xf5\xd2C|\x12\xe0\xb3\xc7/r\x03`M/\xd5n\xe3bp\xce1\xc9sFY\u058b\xcc\x15,\xd6K\x96Ū\x85\xf1\x9ds\x8c\xb3\x11y\x96w\xfb`\xe1\xf3\x00\x8c1\x18\xed\x93\xd0\xe2H\r\x02x\x9aBW\x1a\x8d\x13\x8e\x83\xe9\xa4\xdd1j\xadY\xafW\xed\x84\xdf\\\xf7\xb6\xbfy\xbeĔF\n\xc9\xdaZNt\xfd\xe3\xef\xfb\xfd\xf8\xe2\xdf\x039\x9c\xec\x1b\xfb^'\x85\xb7\xef\xed\xbd\x972X\x01{w\xbf\xedw\xf5\xd3\x16\xff\v\xff\xee\n$%c-\xd3,\xe3민ȗ_\xb8K\x9e\xa6\x98 _SJQ\xd5u\xb7\xdd\x0f\xa8\x8e6\x86\xaa\xaeY\x96\x15g\xeb5'\xab\x82eYQ\x06\xe9\x94\x0e\xfc\x84FY\xe1\x03eDkq*\x85\x97VEa\xc2\xd2\xc1\xe1-\x8f\x14/\xecLya6!Qr\x00m\n\xf0\\\x92q\xcex<\xf602Pֵ7\x99\t2\xbffz\x95\x81\xd1\xecz\xbbe\x1f\xd8\xd28\x9d\t\xf6\xb3\x14\xb7;\xe3ۇ\xc7<Z\x15\xbc\xbc\xeb\r\xaa\x16U\x1dL\x84<\xccl\x9d\xa3\xea\xf1\x06\x94\x10^M @\x1aB\x9c,-\x92\xd14\x01\x8b\xaaF8\x8b\xa95\x89\x94ԁ@\x18KE]i\xd2ԅKgc\xdaw\xdd\xfe\xdf\x18\x1b|3\x02\x810\xd8\xfb\xb7+\x95\xf0\xfc\xa4\xeb|:\x84\xf0\r\x86\xa8\xbd|P\x88(0\xc5\xfa\xb2C\xef\x82ע\x00\xf6<iL\xf4`\xfbvm\xe6:v\x99\b\x96\xc3\xc8\xcetH\b\x813\x16cLk\x82\xd44\x01\xadtW\bTX\u007f%I\x82s\xb0X\xad\xa8\xaa\xda\x13\x85\xc3\xeb\xb5\x0e\xfcq\"\x82\rygm\x9bg)Q\xa48:\x9d\xb3*\n\")\x89\x95\xa22A\x11`\\\x17^\xf8\x84\xa9\xb7\x91\x0f\xda\r\x1f\x86\x01\x12\x10)\x9c餤R\b\x8cpD\xd2#\x10\xf5%\x9c\x1c.\x81\xed\x93$\xe9\x108c\xa8{\x93\xb6\x14OB\xfd\xc49b\xb6\xb8\xe0\xf0{\"\xff\xaf\t[\x13\x9b\x19\x00\xc1\xe0\xc8\\e\xff/\x9e\xe4\x02\xf8\x80g\x16\xc0\xbf\xf8\r\x80\tEj`\xa4\x13\x8az\x12{B_3\xb5\b!(\xab\x8a\xe3\xf9Y\xbb&p\x01\xae\x9bM&\xed$\xe5\xa5N\x96Z\xd7^\xb6\x13\x0e\x85\xcd\"%\xf0\x9e\xedkS\xf3\xfc\xfe>I\xc8hw\xd6R\xacW\x98@\x14\x13\xbdX\xd5f\x05\xc1E\xc5?LVsk~\"\xfb\xfepZ\fGS\xe7|\xd0O\xc3\xf0\x97\x12\xa7\xe4\xc0\xe1O\x04'4\x1b]\\\xfc7w\xfe\x17\xb5\xfe\xc2\xfd\xf8<\x9a\xda\x18nͦ\xfc\xb97>˫\xb7o\r'Ak\x11\xc8\x01\xd3{]V\x1c\xcd\x17<:=\xe3\xe1|\xc1\xe1rż\xa8\xda\xc2;4!\x19\xa6\xff\r\x83a\x1bU\x89g\xfa\x8f\xe2\x98\xeb\xa3\x117\xc69\xa38j\xbdӛ8\xd84I\x99N\xa7\xecL&$\xa1\xb8jk\xa8k\xd3\xc6\xe0J\x82\x8b\xa3\x12h\x01\x85\xb3ԡ k\xeb(\x03a\xcb3\xf2}e\xa8\xada7Mxuw\xc6w\x0fO\xf8x.y~6\xa6\xb6\x96E]3\r\x16\xc26\x14\x9e\xdaZ\x9c\x90mz_\xe3\x00\x18YGmL\xfb>(!\xd8K\x13\"!8\xadj\x16\xa5\xb7\x1eVRQX\x83\x96\x16)<:\x11E\xaa\xf3\x04\xde\x12Qi\thІ\x97\x80@\xb6\xe9|6pE\xdaМ\xf0\xbfZ\x1b\x84\b^\fq\xd4\xday\r\xaf\x1f\xff\xb3\xbd1\xa0c{Hs\x8f\xa7\xd0&\xd4\xf9\x1c݆\xcb\xd1\xf6\xe3R\x81H\xa8\xca\x12k4*\xac D\xbf\xb9\tM\x80\xc4{\f\xc4qB\x9eY\x96\xab\x15eU\x92\xa6i\x1bwܼt\x19x\x1d\x9e4\xec\x1b\x918\x8a8\xd8\xdd!^F̗+b\xe9]\bM\u007fU4\x881\xbe\xb8p\xb9\xb6\tخ\x18\x8a\xa5 \xc7O\xfb\xda9\x92^~D\xaa\x14\xc6Z\xae\x80\xcf\rzs\xa5dh\x00\xfcwV\xbaFk}>KC\\\xfc\xbc\xcf\xf1\x00.\xca\xe8\xba\xf4\xbc\x10\xe1<\x8e\xda\x15\xcdf\xdbP\xeb\xab \x00b\xb0*\xde\xf2,>\x00\x9e\x11\x00\u007f\xd1\x1b\x80m\x10\x90\t.}\xd3|\x8c\x92\xb2%w\x19c8:;i\xa34\x9bo\x1dg#\xb2\xa4\x87\x12H(+?\xfdGJa{\xfa\x82M\x1b\xd7EU\xb0;\x1e\xb1;\nv\xbfBP\x16E\xc8\x12\xd8\xc8`\xefi\xfe\xfb\xe4\xb1\xcd\xfb\xee\xcc\x18\x16}\u007f\xf6\xa7)\xf6}\xa9\x9e\xafN\xf4\xb5\n~\xea7݀\xa5\xa4g\xf97\x96\xa7ֵ\xbe\xfe6~\nk_!~*\x85\xdf\x01Um\xb8\xbb;\xe5/~\xed+\xbcp\xf3Z\x90\xfayRY\x84\xf2\xe1+a\x02~|:磣c\xee\x1d\x9fp\xba.XW\xda\xc3\xfa\xbdɰ\xc9\x01P\xa1\xe0K1\xb4 M\x94$\x8f#\xf2H1\x8a\x14\x99R\xc4R\x92G\x11\xfb\xe3\x9c,\x14\xfe0\x0f\xe3\xac#\xcf3vg\xb3n\xe2\x17\xfd\x94F\x85\x88!\x96)Zu\x8b_k-\xb5s j\x8c\x14\x18)y\xb0Zq\xb8\xf2i~Rxba\x16Eԡ\x11\x9d\xc61w\xa7#>\x9a/\x11\b\xeeNǔ\xda\xfb8Lӄ8\x90\xd4\xfainM\xc1\xf0\xf6\xd1\x1e\x0e\xae\xad\xf5h@x\x15\xd3$!V\x8a\xb3\xaab]U\x8c\x92\x14\xe7\xbcׁu\x8e\xb8(\x89c\xbf\x02\x13M,t \xf5\xf5M\x82\x1a\x9b`\xcfi\xf1\x99\xf2\x1d\xf7Ƶ\b@##tm\xa2\x9co2\x9a\xf5\x82\x8a\xa2\xf3I~\xcd%.\x15\x04\x1f\x84\x8b\x8b\xc5мG\x9c\x8b\xe6\x0e!?QD\x02TE\xe9\xef\xf7\xc0\xcd`K\x13\xa0º0ϼ\xb7\xc1j\xb5\xa6\xaa*\xe28\x0e\x05\x896\x92W\x05\x0f\a\xd36\x01\x1e\xbd\x98M\xfc:r\xbe\\\xfa\x9d\xbc6\xbd\x04Ы\xed\xe2U Y^\x16t\x9d\x84면~\xef\xdfX\t+\xe1I\x83\xd5e\x84@q\xfe.\x8cTD\x1c\xc5\xed{\xbe\n\xb1\xc8}\x05\xcdE\x87\x95\x14\xdb&\xff\x8b\xefx\xf7\xc4c\xc67\xa8\x9b͂W\xf9\x18\xac5W:\xae.\xf1\x00\xb0x\x02\xe03\t\xe0/<\a`+\x9a\xec\xc8\xe2\x84$\xec㛋\xe3dqF\xa9k?\xe9ۆ\xac\x131\x1d\x8f[v\xb8\b2\xa5ZW\xe4I\x1c\xbat\xbb\xf5\xfeXV\x05B\xc0\x8d٬=T꺢\xaaJ\xff\xf3\xc5\xf0\x12\xeb\x06e1h\x0e\xfa\xa7\xdb\xc2Z\x16\xd6<\xfd\xd4\xdf\xdf!6\xc6%}Ə\xb5!\xb4\xa7w\xc34\xc5?<\xb9\xc6q\xcfɫ\xfb\xfa\x0fx\f}'ğD\xf1w\x8eJ[\xbe\xfc\xfcm\xfeʯ\u007f\x8d\x1b{\xbb\xd4ZS\xd55e勅\x94\xa0\x9c\xe4\xf0\xe4\x8c\xdf}\xebm~\xf8\xf0\x90eY\xfbtٞ<\xa9)\x88M\xd1W=\xeeE\xe3\x008\x8a\"\xa6I\xc4,\x89ɚ\xe0\x9c0\xb5N҄\xddQ\xe6\x1bʖ\x18\xe7H\x93\x84\xeb\u05ee\xb1\xb7\xbbC\xa4\xbcϻ\t\x93\x91\x92\xaaMrk\x88\x80\x06\xe1ѥ\xba\xe6l\xb5\xe6d\xb9\xe2x\xb5\xf6\xfa\xff\xbafQ\xd5\x18ۅ\xf5\x9cVU?W\x15%\x04y\xa4\x98\xc41\x1f\xce\x17\x14\xc6\xf0\xfct\xec\x0f\xbf\xb2b7Mɢ\x00\xff\xcb.z\xd6\xfb\xd1w\x8d@\xa2\x14RX\x8cu\x18$N\xc0XF\xc4R\xb0\xa85\xd6\x1a\"\xa90\xceqV\x14DQD\x92&\xe4Ygz$7S&EG\xd0\xf4\ue621ɕ\xd2\x17\x9d\xaa\xf6\xcfA\xd2F\x1b\xb7\x97n\x13\x91m,uU\x01\x82(V\xedT<\b\f\nͅ;\x9f\x104h\xd1E\xabܐ\xdb\xf9'\xfd& uTUP\x8a(\xd9N\xa8\xce\f\xa5\xc5Q\xa4\xb0V\x90\xe7\x19\x91R\xac\x8b\xc2\u007f\x9fs\xa8(j\x8c\x8c\x81\x90\x13\"\x05&$r\x12\xcc}\xc6yN\x9a\xc4\xc4Q\xcc\xe9b\xc1\xba\xaa:\xac\xf9\x8a\x868J\xfaϔ\x96gq\x9e9\x9f*\x89\x0eF@Q/\xb93\x0e\x06A\xee)\x8e\x96$I\x89TL3N,V+\xbf\x86h\x1cK/,\xfe\xb2\x8b\xb1\xbe\xc2o\xbc\xcas\x92\r\x02\xc0yc\xe8\xab\xee\xff\xe1R\x0f\x00\xcd3\t৷\x01\x88\xa3\x98Q\x96{\xe9]\xb8qWe\xc1r\xbdna\xfe\xe6\x82\x1de9I\x9c\xb4\xb0\xbc\x10\x82\xaa\xae\xc8\x02㵬\xeaA\xb65\x01\xc2]\xe9\x8aB\xd7\xdc\xdc\xd9!\x8d\"\xbf۷\x96\xaa\xac0\xc6\x0e\x1a\x85f\xf2i\x15\a\x01\x81\x10\x1bW\xfe\xdaY\x96\x97u\xaf\xee\xf2N\xbd\xd5H\xbb^\x01\xb6!\xado\xc3\xc6\xd7)\x85\x15\x1d\xc4\xda\xec\xfb\x9d\x10\x98(z\xb2moo\x87-\x9e\xd2i\xec\xaa\xc5_\x1b˟\xfb\xe2\xeb\xfc\xb5\xdf\xf85\xf24\xa5\xa8+\"\xa5\xbcE\xab\xb1ުWk\xbe\xf7\xf1'\xfcOo\xbe\xc5'gs\xef\x94\xd8\xee\xe4\tq\xa7\xa2݅\xf6'}\x19\\\x02\xc7q\xccn\x1a3Kb\xcf|\xa7\x93\x18\n`7\xcf\xd8\x1d\xe5\xbd\xccs\xff\xf9\xed\xef\xefs\xf7\xf6-\x1f'\x1b\f\x88\xe2\xc0\xa8o\xe0\xd1f\xda0\xd6R\x97\x05\xcbՊ\xc3\xf9\x82\x8fN\xcex\xb8Z\xb3\xe8#\x14}ht3\xe10\xec7k\xe7Е'B\x8e∇\xab5\xcbZsw:b\x12\x1a\xde\x1b\xa3\xbc-\xc0\xaa\xcdmw\x18\x11\x9a\x90\xf0\xbb\xfcZ\x00Tϻ=\x92\x12%\xa4g\x92\x1b\x83\f.\x98\x0f\xcfΈc\x85R\x924\x8e\x83I\x90\xf7\x0f\xd8\xe6\xe4\xa6C\x03\xd1dU\xc4R\x91\xa41UY\x87Ha\xb51iw\xa1N\xc6Z\xa8J \x19\x04\x14\xb9\xde\xdeX(\x81\xd3\rA\xc0u\xf1\xd0m\xf1w\f4\x9fO(\xacQ\xe0i\x18m:\xde\x01=i`\u007fw\x1c\xbc\xfaI\xbc\xe2(\x8a\"ʪ\xa2\xae*T\x90\b7\x1c\x00\xdf4\x88\rWE\x81\x92\t\xd7\xf6\"\xb24\xe1t\xb1\xe0x\xbe\xa0l\x92!{\x02ȋ\xe0t\x11\x8az\x15\b\xabjc\xa2m]!#\x1f5m\xc3\xf5\xdeHA\xa3\x80\x02\x88\xab\u074c\xa4I\xea\x1d\x16õ<_\xf5\xc2\xcc.\xb0-\xf7\xe6erؚ\xb9\x8b'r瞐R\xdap\t\xa4\"\x8a\xa2-\xe9\x1b\xee\xca\r\x80\xb8\x1c_]\x87\x15\xc0\xb3ǧ\xad\x01\x10B0\x1d\x8f{\x85^`\x8ca\x1e\x8cx6\x1d\xda\xc6y\xd6^BJ\n\xac\xd5đ\xb7\xf8]\x17\xe5\xb9\xdbP\bAi4˺$\x8bc\xaeM\xa7\xedTUT\x15\xb5\xae\x03۸\xc7G\x80`\x9b\xdaQa6M\xafj\xe7X\x1a\xfbt\xb6\xben\xa3\xf0\xf7\xa7\xef\xb0\xe7gsOO0\xfe\xd9V\xfc\xe5S\x84\xfa\x84\xbb\xd6m\xfc\xec\x9f\xc4\xe4\xefw\x8c\x96\xdf\xfa\xfc\xab\xfc\xef\xfe\xdco\xfa\xc9:\xacc\xba\xcfJr\xba\\\xf1\a\xef\xbcǿ\xf9Ửˊ8\x10ߚ\xa2//\x82\x1fi\x02S\x14\xd38f'\x8d\x99\xc4\xc1\xb8GH\x12)\xc3\x1e\\\x92F\x11\xe3 \x03\x83\x90Ɩ\xa6<\u007f\xf7.w\xef\xdc&\x8ac\xac1\xd4u\xedm\xa2\xe92\xdf]\x80x\u05eb\x15\x0f\x8fO\xf8\xf8\xe4\x94OΖ<*\xca`\x1c\xd4\x154ٳ\xd4\x15x\xcb\xdcXz\xe3\xa04Rd\xca;\xfb\x15ư\xac4\xa51DR0\x89#\xe6u\xcd\xdb'g\xdc\x1c\xe5\x1cd\xa9\x97\xf9\x8ds\xc62j\u007fG3\xa9\x89\xe02\xa7\xadi\x87`\x15\"l\x9b\x02\x9bǊX\tV\xb5a\xa9k\xa4s,\x8a\x82\xd3՚(\x8a\u061d\xc8V\xcd`\xad\r\xd9\xf1=\xfd~0\xa1\xd1\xc6BXIhkI\xa3\x18\x17;\xea\x10\x9c\x13G\xaa5J\x12aUИ\x00Ykۯ\x8b\"\xd5\xcb\b芋\x93A\xb1A?\x17p\xe8\x9e\xd7.\x02\x9c8\x9f\xec\xd4C\x01\x1c\xa0\xe2\x00\xe3\xdb\x1e\xd6\xd0p\x02\xfa\xbe\x10\xcdق\xc4)E\x12\xfbϫ\xaa*\x8a\xb2DZE\x1c\xfc\x03Z\xe9h\x90\xcf9\xdb-\x14#\x14\xb3\xf1\x984IH\x93\x84\x87'\xa7\x14u\xd5^\xa9\x12.\\\r\x88\xd0\xf8(鋺\xdaة7\xcf9\x91\x92Zz\xa4G\xf4\xd2\r#)\xa8\xaf\bp\v\xe1\t\x80\x1eE\x93TuMQ\x95\x978y\x8a\xaeQb3¹9/:/\a\x81_\x81\xd8'x\xf77\xa4Q\xa5\x14JE\xc1\xdd\xd9\rD\x80Z\xd7Oq\xca\\Ȱ\x9a\x03\x87\xcf\xca맰\x01\x18e\x19i\x98\x84\x9a\xcb\xcf[V\xd6\xed\xe7\xddx\x86'qB\x12%\xbeXJ\x9f\xea\x87\xf0\xf2'c\f\xdah\x86ࣤ\xb2\xbe\xf8\xe3<\xf4\x9f4\xb2.]SUU0\xfc\xd9\xc0\x93Zc\x1cyN\xf2g\x03\xabze-5O7Io\x16\xfef\xc7\xef\xf5\xfcn\bw6\xbf/Rm\xcc\xe9\xb9\xe2\x1f=]\xf1\xef\x17ի\xc8\xfe\xae\xda\x1a\xd4\xda\xf0ʍ}\xfe\xdao\xfc\x1aY\x9a\x86}\xbf\xa46\r/\xc2q\xff\xe4\x94\u007f\xfc\x8d\xef\xf0\xfd{\xf7\x01\xef\xa9\x1f\xf7\ni\x03\xbb\x9b\xbe\xb9Os\x98I\xc1(\x14\xfe\xfd,e\x92\xc4\xe4qD\x16\xa9\xd6L\xc7S\n\xfc\uee11\xafYkI\x93\x84Ͼ\xfe\x1a\xb7o\xddj\v\x81U*\xac\x06,\xce\xf8\x10\x92f\xda\xff\xf8\xf0\x88\x1f\x1e\x1eqo\xb1b\xa55\"\x04\xc3()\x86\x90fp\xaa\xf3E_\x91Ǌ4R$R\xb1\x93\xc6\xc4RRh\x1f\xa5ZY˼\xacx\xb0ZSj\xcb4\x8a\x98ך\x8f\xe6K\xceʚ\xb3\xb2\xa64\x96k\xa3\xb4]\t\xc8\xc6%O8\x84\x85Xy\x1e\x83\xb6\xb6M6l\xdc\xf7l0\x11\x8aRI\xac$\x8a\xe0\xeaWW\xd4u\xcd|U0\xc93\x92H\xb5<\x13דM\xf6\x1d\x01\xb5\xf1\x0ez\xd2\xfa<\x80$\x89\xfd\n\xc5\x18j\x04q샙\x1a\x83\x9d\xc0D\xf1I\x85\xd6`L\xaf\x98\x88\xfe\x96\xd8_\x13\xae\x87\x98\xf9kLҐ\x01\x85\xcf\xe1\xa1g-H\xe7\x0fp\xbe\th\x1a\xafv\xe5纂ߐ\x8d\xfb\xbfKJ饟\xe1gdY\x8aj\xd7\x02\x957\xce\t\xf0wC8\x13J![2\xb0C)A*b\xf6fS\x94T<8>fU\x95\x03\x1bmw\x81)\x96\xcf\x1a\x10X\x01\xb5\xb3ě\xac\xf6pͧJ\xb1\xb4\x9a~\xe6\xa2\xea95>\xa9DJ)I\xe2\x84&\x04\xa9\xaako\x01,:9妥\xb1\n\xf6\xd48\xb7%QS\f\xfe\xd7]p\x96]\xf4\x88\xa38\xac\x80L??\xf2\xe9V\x00\x97\x1f\xb4'<\x93\x00~\xfa\x1a\x00\xa5\x14\xe3l\x14\bp\xdeЦ\xa8*\x96ź]\xb9{\xb8\xca\x1f\x15Y\x92\x04\x97=ڌ\xf5\xe6*\xac\x1bBR\xef\xcc(LͲ.1\xce1\xc93\xf6\xc6\xe3\x96\xd9_\x14\x85?$\xfa\xf1\xa6\xcea\xf0Pr\xac\xb6\x15\u007f\u007f\x13\x97\xceQ8\xfb\xe3\x15\u007f\a\xd2\xe8\xe1\xde\xff\xb2ɟ\x9e\xcf\xffSN\xfeM\xc1WO\x80\x10\x1b\x94E\xf6dY\r\xb4~\xd1\xc3:G\x16+\xfeү|\x95[\a\xfb\xc1tɻ\xf5)\xad\xa9\x05\xfc\xc1{\x1f\xf2\xdf\xfc\xeb\xdf\xe5\xc1\xc9\x19\xb1\x92da\xc7\xec\xe8bjG\xb1'\xef%\xaa\xf9wG\x8e\x8b\xa5߁\xef\x8f2v\xb3\xb4%l5\x10d\xcb-\x92\x9d\u05fc\xb1\x964\xcbx\xe3\x8b_\xe0έ[\x18cZɧ\x94\x02\x94\"\x8e\"\x96\x8b\x05\x8f\x1f=\xe2݇\x8f\xf8\xde\xc3#>Y\xae\xa8\xad#R2\xb8\xebuAP*ȲTh\bT\xb0[\x8d\xa5$U\x8aT)\xa6I\xcc$\x891\x16b\xe5a\xfc4R\xdc\x1cg\x1c\xe4\t\xef\x9d,\x99\a\xf9\xe3\xa2\xf6Y\x02\xcbZs\\\x96\xdc.G\xdc\x1a\xe7\\\xcb3v\xb2\x84Ty\x1bl\xa7\x1c\xce{\xd7\x10I\x81\xb6~Bt\xa1\xd95\x81\xe3 \x82}p$R\xf28bUkD]㔏\xf4\x9d\xa4)I\x93\xaa\t\x88ƸǹVk\xdf\xc8\x03+\xb4\xdf\xebg\tY\x96\xb0^\x97\x01Z\x8f:\x8aC?$KH\xbf;\xb7\xc1\x82\xdby\x9d]g\xb0D\xfb\xde\xfb\"\xe6\xcb@\xe7\x1e\xe0\xba\x15C\x988\x9b\x90\x9es\xcdh(\xe0\x9d?\x80lW=\xed\uefaf:\xe9\x15\xd7Hu\xcex\xd6\n\x92D\xa2\"E]\xd7hm\xbd\xcba\xab7\x17-*!z\xc4H\x11B\xc5v\xa6c\x9c\x80\xfbGG\x14Uݦ\xd9]\xd4<7\\\"\x15VC&\xf8\xf1\x9f+\x98\xd27tՆ\x13f,%\xe6I\x849\xe7P2\xf2\x88Fx\x97\x8b\xaa\xa4\x0e\xae\xa8\xed\xcb\xea\xfd\xda~\xb3\xb6\xd5\xd3w#\x15\xc8Y\xeb\xcfk.&\xe65Ȓ\x800\xb4\x01\x1b\x03\x936\xf5Sd\x1e\\z\xda>\x04\xcag\xe5\xf5S\xd6\x00L\xf2\x91\x87\xfe\x9b@\b\xeb\xa1\u007fcB0\x87\xec$>RJ\xd2$\t\x06\x17\n%$\xc6yȱI\x88\xeb\xa6\u007f\xd1\x16\xff\xc6h\xe4\xfat\xdaf\x01Te\x89\xd1\xde1\xb0[\xe1\xfag\xa1\xadC5\xb1\xab\xbdk\xae\xb1V1\xc0\xaa\xbd\xf8\x9f\f\xf7o/\xfe\x0e\xa9\u0379)\\l\x16\xfff\n\t\xbc\x00\xaf\xff\xbfj\xf1\x0fY\xe8\xb8s;\xffs\xbfW\b\"%7t\xb6=?\xf7^\xc2\xda\xf9\xe9\xdf\xf2g\xbe\xf8:\xbf\xf2\xd9\xd7H\x93&M\xd1\xe1P\x8c\xa2\x88\u007f\xf2\xe6\x0f\xf8\xaf\xfe\xe9\xbfbY\x95$\x91\"\r\x8eu\xcdn\xf3\xe6(\xe3\xc6(#\x8fT\xf7\xbeo6\x198&I\xc2A\x9e\xd1\b \xfa\xbe\xf7>%\xb0\xf7\xf5\xd62\x9aL\xf9\xf2\x97\xde\xe0\xf9;\xb7q\xce\xfa\xa2VU~\x0f.$B\xc2\xd9\xe9)\xef\xbc\xfb.\u007f\xf8\xd1}\xde9\x9d\xa3\xad#\n0~s0\x8b\xe0\xd3\x1f\x87?k\x1a\xa4\x86L9\x8e#\xf6\xb2\x94i\x9ax\xf5\x81\x92\xad\xde\xdb:_l\xe3\xc0q\x88B#so\xbe䰨p\u0093\b\xb5\xb3\x9c\x965k\xb3`^i\x16\xb5\xe1\xa6\xd6\\\x1b\xe5\xcc\xd2\x18%\xfd\xe4^\x19\x8b\xb6aM\"\xbb\xf9M\t\x8f\x9c\x18\xeb|\x8a^@8\xd2@r\x8c\x8c\xc6IX\x14\x8e\x91KZN\x00A\x02g\x84%\n\x81D\xc3&\xa0FV\x82Q\x9a\x90\xe7)eY\xb5\x9c\x18k\x1dB\xf58\x1aR\x06\aA\xd76\x18\xcex?\x8e\x8ex苧\xb06di\x88\xb6(xe\x8bk37Zkak;\xd1\xfaE\xfbh\x19\x9a\x05![\xff\x8cV궥\xc0\xa8\xb0\xe76\xc2b\x8dw4\x14\b\xa2\xc8_'\xb6\xff3\\o=Ѭ\x17\xac\xc5\nI\xa4`w2\xc6:˃\xa3c\x8a\xba\xde\xc2\x059O\ajVb\xb6Y\x05l)v\xa9\xf2\r@\xff\xfbT@\xba\x9e\xe4\x0e\x18EQK\xba\x03X\x17E\xe09\r\xad\xcdEx\x1eR\x9eG\"\x02\xda߃\xfd;ki;\x80\xf1/\x86\xff=\ua8c8\x93\xa4\x17\xf5\xdbi\x9c\xccS\xc0\xffO\x18\xb6>\xe1\x99\x04\xf0\xd3\xd5\x00\xa4qB\x96\xa4\xed\xceLJ\xc1\xaaXS\xe9\xdaw\xa4\xb2\xeb \xad\xb3$qB\x1ay铒\xcaOF\xe1\x06\xa9꺵~\x15BPj_\xfc\x9bb\xbb;\x1e3\xcb\xf3\x00\xfdk\xaa\xaa\x1aL\xb5\r\f\xaa/\x88\xf6\xb5=\xf6\xf2\xfa)\xe3:ݖ\t_is\xe9\x1e\xbe\x85\xfd\u007f\x84\x9d\u007f\xe3\xf4'\x9fP\xf4\xfb\x85_\xb5\xba\xe8\xbe\v\x9b\xdf\xcfJ!\x89\xa5\xa2\xb4\xfa\xdc\r\xa9\x8d\xe5\xe6΄\xbf\xf8\xeb\xbf\u008d\x83}\x9f\xc3Pk\x8c5\xc4Rq\xef\xf8\x84\xbf\xfb\xfb\xdf`^\x94d\xb1/\xfe!\x90\x8dI\x1c\xf1\xfct\xccn\x96\xf4V\x00\x9b\xe7GG\x16\x1b'\x11J\x89\x16ޏ\xa3\x88\x9d<c\x96gdI\xccó%\x0f\xcf\x16\x18c\x18\x8d\xc7|헿\xcas\xb7o\x85\xc3\xdc\x13\x91\x84\x10\x94e\x81֚\xc3\a\x0f\xf8\xe6w\xbf\xc7o\u007fp\x8fâ\f\xe6A]\x91\xf7ӢG\x01\xfa\u007f\xd6|\x9c\xb34\xe1\xc68\xe7z\x9ey\xc8^\bb\xa5\xfc\xba\xa0\xa7F(\xb5A\xbb =\x8cbd\ue6c0\xe9jͽ\x85G-\x97\x95\xc6\xe1\xbf\xf6\xdeb\xc5Zk\xd6\xf5\x88em9\xc8\x13n\x8c3v\xf3\x14\xa5\rEmp.\xa4\xe1\xf5\xec\"b!\x89$DV\x04\xc3$_ܕs`\xb4_\xb7H\xc7\xd2:\x8c\xf1\x11\xba\x9e\xfb\"\xb1N!c\xdfH\xf4\xcdy\x8c\xb1\xde\r\x0fA\x12G\xa4\xc1'\xa1\xf1\xf2\xb7\xc6\xfaF\xbd\xf1dhV,ִS\xb41\x16E\x17\xf9,\x00\xa9\x04\x9d\"\xadY\xd65k\x89Pt;\xcf\xefP\xdc\xc5ytKt\x18\x82\x94\xd2?\x1f\xa5\xfcgnmkB\xe4\xb6\x14'\x19\x1a\x13\x138G^.\xd9L\xfa\xfegy\x94ŞS%\xf8\xefu\xc8`\x95\xbc7\x9d\xa2\xad\xe5\xd1\xc9\xe9\xc0n\xf7\xd2՚\xf3ӳ\xd9b\x10\xd4\xf0\x9b|\x8c\xf4pe\x12KI\xd9\xefv7\x82|\x9d\xb3\xc4I\xe2_Oh\x16\x96E\xd1\xcap\xfb\xe1\x90RJ\xa4\x88.\xc2+z\xc4\xccvGԪi.\x1b\x80Dϛ!\x8eS\xa4\x8c\x02r1\fc\xbb\x8a\x01\xd0\x10=\x11\x17\x8dZ\x1f\xf2\xcc\x04\xe8\xd3\xd3\x00\b!\x18\xe5y'\xe5\v^իb\x8d\x14\xb2\xf3\xe1oS\xbb$\x93<'I\xa2ִ\xc3_\x84\x16\xaduo\x8f/\xa9\x8cfQ\x17\xed\x85\x16Iɍ鴅\x85\xab\xb2\xf0\xce_\xd6\xf5i\xff\x01\xdeo\xaci;+`ۻ=*\xe7(\xaf\x02\xfd\xbbm;2\xff\xdf*\xc4q^X\xfc7\t\u007f\xc1d\xc6*\x89\xbd`\xe7/\x00\x19\xac\x87/C\x15\x06\x1fD\x98V\xc5\xc0\x9c\xa3#]Ƒ\"\x8d\x13\x92(b\xbe.)\xfa,\xf9f_l\x1d\xff\xfe\u05fe\xc2W_{\xc5\xefb\xad\xf3NtFST5\u007f\xfc\xce\xfb<:=#\x8d$\x99R`-\x934\xe1\xe68\xe7\xb9\xe9\x88DJ\xb4q-$){{\u007f%!\x12\x924\x89\xd9\xcd3\xf6\xc7#\bp\xf7$M\xd8\x1d\xe7\xe4IB\x96xH\xba6\x96\x87\xa7gdYƯ\xffگ\xf2\xc2sw\x83U\xaf\xc3ZZ\xf7A\x81\xe0\u1f4f\xf9\xc37\xbf\xcf?}\xfbC\x16\xdaG\xedv(\x88\x9f\xb2\x92\xf6\xfd\x19\x86\xdc\xccҘ;\x9317\xc69Y\xb0\xd2UR\x10K\x85j\xdc\xed\x02O\xc4X\xd7\xc5\x157\x85Z*\x92\xc8qs2&\x8b\x14\x89\\r_\x96,J\xed\xa5\u007f8\x1e\xafK\xd6Z\xb3\xac5+\x9dqVռ*\x04\xcf\xcfƜ\x16u \x15\x06\xd2\x1e\x9d\x97\xbf\x80\x80Tx9Y\x1d\xb2\"\xac\x05\x17\x8c\x81\x9c\xb5,뚵R\xe4i\xd2A\xe5֒\xa7ih\xbc\xbb\x1d\xb1\xb5\xd6O\xb6!m\xaf]!\x04\x86\xbc\xb1 \xf1ɎB\xcaN\xca\xd7\xe3q\xb4\xc1Za\xed\xe3y=}\xcd~s\xad\xfa\xf7N*\x19`f7`\x9bo6\x01ݟy\xec\xcf\x17|\xdf\x044\xd3j\xf3gn\xb3\\6\x92\xc2@|\xd3\xc6\"\x91!\fɄ\xa4B\xbf\xfe\xb2\xd6nq\xa1\x13m\xb6@*c\xf6&\x13\x8c1\x9c\x00EU\xb5\x83\x8d\xed\xa3\xe7=\x833\xe3lk2\xb5y\xa04K\xbf$\xa0\x00}\x1c\xc0;\x03\xba\x1eٰ\x97\xf2\x14\xfe;M\xd2\xf6}\xb1\xd6\xdb!\xb7\xaf_v\xaf\xbf\xdd\xfbo\xa7\x05\x86\xf7\xa8\xb3qnН\x16a\x14CtCl|\xbf\x13^\x8e\xe8\a\xa1\x8dmB\x90`_\xb9\x01\xb88\xca\xdc\xe2%\x80\xcf<\x00>-\r@\x9e\xa6$Q<\xd8\xff,\xcbuwH\xf7\tk\xd2\x13\x05'\xa3\xf1\xe0&\xb4\xce`\x8c\xa5\x0e^\xd9\x02\x9f\x8evV\xadij\xbb\r\xd3\u007f\x1ebB\xeb\xaaj\x1b\x86\xfe$\xd1\xc8\xc8\xd4F\xdetKv\t\xc6\x1f\x95\xbb\x9a\x16w+\xd9\xcf9\x846\x97\x17\xff\xfe\xe4o\xbb\xc9\xdf\xca\xedſ-\xfcW\x98\xf6\xfb\xf0g\xacT\x80\xfd\\\x1b\xcd\xeb,ıb\x96eL\xb3\x9cq\x96 \x85\xe4\xf1|AQU\x9d\x11R\xabS7|\xee\xce\r\xfeү\u007f\x9d4I\xa8\x83\x1f\xbb\x97\xb3\t\u07baw\x9f\u007f\xf5\x9d7Y\x95%\xd3$\x06kyqwƗn\xeesm\x94\x91\xc71ژv\u007fݠ@i\x141\xc9\x12vF\x19\xd3,c\x92\xa5\xe4\x89g\x10w6\xc0\xb2c\xb0K\xc1\xc9r\xcd'ǧ\xc8(\xe6W\xbe\xf6\xcb|\xe6\xc5\x17\xd0Z\a\xff\bX\x94+ʺB\"x\xfc\xd1\a\xbc\xf9\x83\xb7\xf8\xc7?\xfc\x80y\xa5I\xdb\xe9]\xb6p\xbfo\x04\xba\x83\xcc:G\x16)n\x8cr\xeeN\xc7\xec\xa4IϏ@\xb6\xfa\xfd&\xba\xb8\xe9\x1a\xa4\x14$\x91\xb7\xe9m\x88\xd4N\xb8\xd6\xe4e/\xcfɢ\x88\xd1|ɣU\xc9I勻\x05V\xb5想\x05ge\xc5\xcdɈ\xb56\x94\xda\xf2\xda\xc1\x8c4R\x9c\x16\x15\x850\xfeg\xf7\x11\x94`\"\x93(\x19dg\x82\x1a?\xcdZ\xed\x8ds2\xe7Xk\xcd\\\x1b\xb24\xf1E=\xa0gY3\xe5\xd3s\xc4t\x96\xaa\xd68\xbc\xb9N\x1cGH\xeb\xbá\x90//qH%\x86!=\xb23\xe8qƆ\xb5A\xc77\xe8\x17\x14\x17\u0080BB\x98o\xa5\\`\b8\xda\xf7V\\\x84\x04\x04\x12a\x83\x04\b\xeb\xba\xf8[7\xd4%\fbz\xa5$\nh\x85\x15\x0e\xe9T\x98\xf0\x1dJJ*\xad=JЗ\xc8\xf5\x1bg!\x18e\x19ӀDFEAU\xd7\x18k\xa8C\x13\xd8W\xd5{\xb9\xab\xcf\x14\xb0nh\xd5<@\x01B\x12eiz\\\x1e|\x18Su\x81\x9b\xa7@\x92\xa6Y\xbb\xbe\xab\xb4fY\xae{\x1e\x1aM#\xa1<\xd1\xd1]\x80\xde\xf7\x9e\xad\xc0\xbb\x1e\xdaЌ4q\xcfۊ\u007f?\xcfCIE\x1a\xa7\xc3\xf3\xb6i,\x9d}2\x9f\xa1\x8f\xba\x88\vs\x00J\xe0\xedg\xa5\xf5S\xd2\x00H)\xc9Ӭ톥\x94T\xba\xa62\x1a\x15{\xbf\xe8N?ꛅI>\n\xc1\x19\xddM\xec\x9c\xdf\xfb7N^\xb55\x9cU\xabv/윿\xc8\x0f&\x13\x0f7iM]W\xeda\xd5\xef^]\xf0\xee\x17\xfd\fuA\xeb\x14\b\xdei≱\x1c\x17L\xfe~\x92\xb7\x97\x16\u007f\xb79\xf9\xf7\b\u007fv\x03\xf6\xbf\b濨\xf8\xb7f2\x91j\xa5y:8\xc6eI\xccs\a\xfb<w\xed\x80\xdb{\xbb\xe4i\xc2\xc9r\xc9'\xc7'\xbc\xff\xf0\x90\x87'g~\x8f\x1d4\xe8R\bv\xb2\x84\x17\xa7c\xfe\xcao\xfei\x9e\xbb~\xd0\xc6\xd7\xfa\xbd\xabdUV\xfc\xab\xef|\x8f\xef\xbe\xff\x11\xe3(\xc2Y\xc7\xdd阿\xf0\xda\v\x9e\xc8\x14>\xa3,\x89\x91B\x90\xc71\xd3<ew\x94\xb33\xca\x19g)i\xac\xbaC&T6/7\xf3!:Z\x1b\xb4\xb5\x1c.V|\xf3\x83{,j\xcd\x17\xbf\xf8\x06\xaf\xbf\xfa\x8a\x87\u007f\x83\x8d\xec\xb2\\q\xb2\xf4\xbc\x92\x93\x87\x0f\xf8\xfe\x0f~\xc0?z\xeb\x03\x8e\xd7\u0560\xf87\xef\x8f\x12C\x98\x14\x01\xbbY\xca\xcdq\u038dQ\xce$\xf1\xe1S2\xc8\xf1\"%\xba\xe9\xa8_\x94\x1a\xc86\xec\xe3k\xe3s\f\x9a\xcfB\x86ĻQ\x12\xf1\x99\xbd\x19\xb3\xb4\xe0\xe1r\xcd\xe1\xba⨬0ֿ\xe2\xc7E\xc9iUs-O9)*\x8e\xd6\x05_\xb9u\xc0\xb5Q\xc6YY\xb1\xac4U\x90\b\xd2s\x9bk\xfa\x904H-\xeb\xe00gqD\x02\xc6RPZ\xcdje(\xe3\x88Q\x965\x8bn\xf24\x0e\x96ɴѸV\xf8\xa4͢\f\x0534\xea&܃\x96\x06U\x93-\xbc\x0e\xae%\xf2\xb5^\tƵRC\xc9p\x9f\xed\x11\x80~E\t\x8d\x82\xed-\xea\x1cm\xc2\xe1\x067\xad\xfbzaۄĦY\xb4f#3\xd2\r\x9b\t!e\xb8\xaf\xce\xc7\x11\xa7qL-\x04\xb5\xf6Y\x00\xcd\x1ak3\xecg\x9a\xe7^Z*\xa0T\x8aJk\xa4\xd6H\xe5\x86\xe4Hh\xed\x91\xdb5\x0e\xdb\x03\x85\x92s\x90\u007f\x90\x88Z\xd1\xc6\x10ӓ0+%I\xc3\xd4\xdd(\x00ʺ\xearL\x02qU]0Q\xf7\xf9\x1a\xfd\x8a\xedz\xe9\x8d\xfe\xba\xd8>\xf9\xf7\xd7:q\x94 \xa5\xea<U\xfa\xf0\u007f\xfdt\x9c=u1\x02\xb0\n+\x80g\x8fOC\x03\x90%ig\xf7\x1b2\xd6W\xba@\xc5A\x13.z\xb9\xd8q\xcc8M\xda\xe2\xef\\S\xfc\r\xd6\xda`\xfc\xe2\x8b\xffi\xb9\xc68\xdb+\x1a\x8e\xddqN\x96$Xk\xbd\xdb_\x90\x86\t:\x84\xc0\xb5\x05\xb294\xc5\xe0\xa6\x06\xcfT\xaf\x9d\xbd\xdc\xfe\xf3Bؿ)\xfe\x97\xec\xfc\x95\xec\xfc\xfb{~\x00\x9b;\xff\xa7-\xfc\xcd#V\x8a$\x04\xc0\xd7Z\x13)ŝ\x83=^\xbb}\x8b\x97o\xdd\xe0`6%\x8b#\xe6E\xc1\xef\xfe\xe0m\xfe\xe0\x87\xef2_\xaf\x11\xf8\xbdv\x16y8|\x96ļ\xb43\xe5\xb5\xfd\x19\xb7f3>\xf7\xd2\v\xdeⴧ\x13\x96B\xf0\xee\xfd\x87\xfc\xcbo}\xb7\xb51\xb5\xce\xf1\xf5\xe7n\x92D\x11B\b\xafyO\x13\xf2$f\x94&L3/QS\xb2S\x88\xf7W4\xaew\xd2H\xd1M\x98\x1f\x1e\x9d\xf2\xad\x8f>aY\x94\xdc}\xfe\x05\xbe\xf0\xd9\u05fc;]X\tic9]\xae(뚇\x1f\u007fĻ\xef\xbe\xc7?\xf9\xe1\a\x1c\xadKb\xe5'\xfd$4\x01m\xf1\x17ݵ\x91Ċky\xceA\x9erm\x94\xf9,\x81\xc0\xeb\xb0\x0eZP\xa6\r\xb0\xe9\xb2\xe17\xfd+\xd2H\xa1\x8ch'\xf6(\xbc/\x1ey\x92\xdc\x18\x8fȢ\x88IR\xb0[&<\\\x15\xcc+\x8d\xc0\x93\xff\xee-V,\xaa\x9ae]sT\x94|\xf5\xd6\x017\xc69\x89\x92,\x82π\xedy\xe94>\xfe\xd69\"<JѮ%B̬\x14\x82\x18˲(9\xaej\xa6\x93q{\x8d\xe7i\x12\x88\x9f\x16+\x03\x97\xc0\t\x8c\xb5\x94U\x85H\x13T0xi\x88s\xad01<\x81\x96\xc1߆\t\xf9/\xf1\x13z?\x94\xdeu\xb2\xff->@B\nD\b\xedi^\x9c\xeb\x19\xe9l\xae\a\xa4\x90\x1d\x91/\x14;!Lפ\xb5\xf7\xaa\xeb}\x8f\u007f\xe3\xb41=X\\\x84\x94@\u007f\x16)\xa5\xbc\xda\xc8uQͭ2\x00\xfcy\x95\xe5\x1e\x19I\x02'\x020Z\xfb\xfc\x0e\b$EZ\xf2\xaa\x0e\xe1Xn\x8b\xff\x85G\x01d\xdb\xc0\rP\x021H9ko\x94(Jz\n\x00\xe9\x15\x00\xb5\x0e\u0379o\n\x94RC\r\xa0\xbb\xb8\xf87m\x83\xb1&8b\xbb\viy\x8d)R\xf3=i\x9c\xb4\xe8\x8b\xd8\xf8\xba\xc5\xea\xec\xa9\xf6\xff\xf2\xe2\x06`\t\x9c=+\xad\x9f\x92\x06\xa0\xd1\xfc7\x1f\xeaZ\x97~*\x91\xd2\x13\xb5ڢ%ɓ\x84XFa\x8fhڜp\xe7l\xdb=\xd7\xd6pZ\xad\x86\xc5\x1f\x1f\x86\xb17\x1e\xfb\xa2Wׁ\xf5\xef\xcd+\x1a5@{x\f\xaev׆\xc0\b!\xb084\x0e\xed.6\x9an\xb4\xd5Ù\xbbO\xf8\xe3\tſ\xb3\xf7U\xad4Q\xb4ſ)\xfc\x03\xaf\x80\xab\xc2\xfd\x91\x9f\xa4\x8du\xccF9\xafܺ\xc1瞻Ý\x83=\xf2\xc6M\xcd9~p\xef>\xff㷿\xc7{\x0f\x1f\xfa \x9d\xc87\r\xa9R\xe4\x91\xe2\xf9ل/\xde\xd8煝\x19F\x1bd\x96\x91\xe6\xa36w\xbe9xk\xad\xf9g\xdf\xf8\x16\xf7\x8f\xce\xd8\xcdbjk\xf9\xc2\xf5}\x9eۙP\x19\xc3\xed\xdd\x19\xcf\xed{7\xc6f\xef.\x04\x14uͺ\xaa\x99\x17%\xc7\xcb5˲$R\x8a,\x8e\x88\xa4O\x11\xab\xb4FJA\x16\xc7TZ\xf3\xe1\xe3S\xd6eM\x1c'\xbc\xfa\xf2g\x18\xe7yP\x83x$\xa2\xacJ\xd6UŇ\xef\xbd\xc7'\x1f~\xc8\xef|x\x9f\xc7\xeb\x828\xec\xf7ӰÏ\x1a\x86\u007fc'\xeb\x1c\xbbYʍq\xce,I\xb86\xca\x02_\xa1k\x02\xe3ޚ\xa0A?\\oe\xb0m\xa6\x8b\x95d\x96%,\xab\x9a\xa2ց\xef\xd2),\xf6\xf2\x8ci\x92pPU\xdc\x18e|\xbc,\xb8\xbfX\xb1\xd6\x06\x87പ(\xada\xad5\x8f\xd7%_\xb9\xb1\xcf\xe7\xaf\xed\x92F\x8a\x95\xb6\xack\xed\x1b\x8cƽ2,\xd7M\xd8e7j\x10\xe9$&(\a\xa4\x95ıcm,g\xa7\xa7\x14yN\\TL\xf2\x84\x9dɸ\xf5\x1ep\xa1\x10G»\xe4U\xb5&\x8d\xfd=\x1cE\x12k\x05Z{O\x85\x06\xaeu\x1b\xdaxј\x0f\x89&\x04\xca\r\xa0t\a\x17[\x05\ał\v\x0e}\xf4V\x02\x9b|\x80\x86\f\xec\xe8R9\xa5R^\x01\xd20\x11\x85\bdî\x90\xcbpMZ\xe7z\xbd\x81\xff\x9d\x16\xd7N\xce^B\xe8\x13\ampXl\x8a\xe7$\xcf(\xeb\nS\x14>\x90'\x8e\xa9\x83=\xb9\x970\x8bv\xa5!\x83N\xbf\xb1\xaaVB\x9cC\x01dh\xa2\xeb\x86\xd3\xd1Z\x15o\xc0T\xa1%I\xe2\xa4U\x00\b)X\x15E\v\xb5\vA\x97\xcaד\xe4\xb5\x11\xdcn\x83\xa6\x17\x1a\xe2Z\x9b\x16\xc6ߔ\xfe\x9do[\xfc?JH\xa28m\x91\xa9\xfec],\xaf\xe4\xff\xdf\xe7\x13\bqi\x03\xf0\xcc\x03\xe0\xd3\xd2\x004&\x10RI4\xa6\xf5\xfao\\\xa8\x1a\x88\xcb\xf3\x04\xa2A\x97\xef\x9c\x19\xec\xfejs~\xf2\xef\xa6\xff1\xa34\xc5hM\x15\x98\xb9\xd6t\xc5\u007f\x00ي\xa1mi3\xb14:u\xed\xfc\xd4t\xb5}\xffՋ\xbf\x93]\xf1\x17\x8e0\xf9wſ)\xfa\xfd\xef\x93O1\xf57P\xec͝\x1d\xdex\xf19^\xbfs\x8b\xddɨ\x95\xd0Y\xe0t\xb1\xe4w\xdfz\x87\xdf\u007f\xeb\x1d\xd6UI\x1aE\xa4Q\xc4(\xf2\xcev\xfby\xc6Wn\x1d\xf0ś\u05f8>\x1d\xa3\xa4\xe2x\xb1d\x8dD*E\xdf\x02UJ\xc1\x83\xc3\x13\xfe͛?\xf0\xd080K\x12\xbe~\xf7F\xab\xdb?Y\xad=|.e \xc9\x19\xb45\xacʚe\xe5c\u007f\xb5\xed\xc8Fvc_\xdb\ue09b\xed\xa4\xb5\xec\xef\xeep\xf7\xce\xed\x16\xddi\xde\xffeY\xf2\xc9'\xf7\xf9\xe4\xc3\x0fy\xeb\xe8\x84\x0f\xcf\x16\x81\xa9/I\xe2h\x10\xef\xdc^}\x0e\xae\x8frn\x8cs\xc6q\xcc\xedIN$\x05\xabZcB\xf3\xa8\x02O@l\x9bښ\x15\x14\xe75\xd2\xcd^w\x96&dQĢ\xaaC\x0e|gY\x9c\xc7\x11y\xac\x18'\x11\xd34\xe6 Oy\xfft\xceQQa\xad\xa4Җ\x87˂\xcaX\x16eͣU\xc1\x9f~\xe1\x167\xa69\xcbJsZT\xacC\xee\x82\xebEXH\xa1\xa8\xb5i\x8b\xa3\nk\f+|s0R\x8aT\n\x96UIe\f\x8f\xeb\x8a\xcaX\x0efS\xef=\x10\xf8(JJߐYG\xadu\x88N\x0e\xa6H\xb1j\xf3\v\xc4\xe6=\x82\x18\x94\xaa\x86\xe0k\a,\xfbm\x11^\xe7\x17Ծi\xb0`\xfb1\xdd}\xb7\xba\xcel\xc6\x06e\x0f=\x94\xc0\xf5\xed\x86\x19J\x05\x95R\xc8&\xbd\xaf\x91\x10(\xe9\xff\xccY\x90\n)\xfd:\xc0\x19w\xee\x1e\x94R2\x1b\x8f\x03\a \xa8\x8a\x94\xc4j\xdf@\xb8\x10=\xde\"Z\x886t\xccm\xa8\x1d\x9bP\xa38\xa8Pjg\xd1ڲ\x8d2\xd8\xde\xf7q\xda\x12\xa9\x8d1,\u05eb6\x16]J\x82OG\xb7\xe6\x14\x9b\xbb\xff\x9e\x99\x92\xe7\x10\xd4ݮ\xbe\x87\xec\x9c\x1bDD?+\x12T@Lܹsӱ\\?\xe5\xc0~\xb9\u0379\xe6\x99\x04\xf0SD\x02T\x02\x19y8\xac\xac+\xa0\xd1\xddw\xba\xeb<Iɢ\xf8\x12\x93\tAe5\xa7\xe5jk\xf1\x8f\xa4\xe4`<\x01\xe7(\xabj\xc0Z\xee\xfc\xad\xbb\xfc\xf1\x06\x92볟\x11\xa1\xf8\xe3\x1b\x00\xfb\x14\x93\xffU\b\u007fN\n\xacꑊL'\rt\xe1\xc0\xf9Qmz\xa5\x10đ\xe2\xf6\xfe._y\xe9E>{\xf7\x16\xa3,\xc5\x18\xbf;\x8fTD\xad5\xdf~\xef]~ﭷytr\x86R\x92Y\x9e\x92*\x89B2Mb\xbepc\x9f_{\xe1\x0e\xcf\xefΈ\"?\x85/ˊEQ\xe2\xe2dxL\x87\xc1\xea\xa3\xc3\xc7<8=%Q\x1e\xe2~\xfd\xda.\xd3$fU{\xe8\xb4X\xd6<\x9c/[\xad7-!ț0i먍\x9fv\xbc\x9c\xcd\xff\xbb!\vZ\xe7'Wm-w\xa7\x13FRr\xf7\xee]FY\xd6\x1e\xacB\xf8쁏\x1f\x1d\xf2\xd6;\xefp\xef\xe4\x8c7\x0fOZ\a\xbf4V\xc8v\xa5 \x89\x82dM\b\xd8\xcfR\xae\x8f22\xa5\xb8=\x19\x91\x86\xd71\x8act\xc8\x00\xf0\u0380r;\xfb\xb9-6\xdb}ԛ?J\x94d/OYמ\xedo\x1a\xb2ZXsL\xd24\xe4\xd0KvӘ\x0f\xe7+>\x9e/)\xb4A[\xc7\xe3uA\xa1\r\xe6Я\x8a\xfe\xd4\v\xb7\xb89\xceɒ\x88\xa3E\xc1\xa2\xac0\x03\aW\x17\xecxi\xbd\x1a\x04]κ\xb6\x0ea%;\nj,+\v'g\x9e\x00zk\u007f\x974\xf1\xa4͢\xf2\xf7_\x9a\xf8\xc9UkM\x14Gt\xb1\xafa]\xb7\xb5\xfd\xa1\xcb\xf0\xa0\xfbw;\x8d\x0e\b\xed\x1d\x9e\xe7\xdc\x06\xd2Ҭ\xeadp lW\x1f6\xb0Ņg\xbb7\x92\xb5\x9e\\\xb0\xe5\t\xf4\x9a\x80\xfe\x90\xd1/\xab\x8d\xffB\xb3RjdɮuP\f*\x81\xf0\xde6?#\x8dc\xa6\xa3\x11\xd5\xd9\xdc\x0f3q\x8c\x8b\xe3\xb6\xc0\xd6ư*\n\xaf.\xb0\x0e'\x9b\xd4\xc5\xed\x817\xcd\x1a\xa0A\x1f.\xdaA\n\x04I\x9c\xb4\xe6H\xeb\xb2`\xb1^\x12GQ\xdb\x00l\x9f\xa4\xdd\xc0\x06Y*\x9f/Qi\xed\xb9\x13=\xae\xc3\xd6\xef\xef\x1b\v\x85טD)\x029\xb0\vn\xa0\u007f\xf7\x94皸\xbc\axV\xfc?m\r\x80q~g\xa8\xad\t\xddjwQ\x8d\x92\x84Q\x92\\\\\xfc\x85\xa06\x86\xc7\xebe(\xfe篆Y\x963J\x12\xaa\xaaB\xd7>\xa9\xac\x99\x0e\xfb\xe2\x19wn\xd7՞P^\x19\x80C[\xefڵ\xf5\xb2\xbb\xa0\xf8?\x89\xf0\x87\x10XձZ\xa5\xe9\xe0Y\x94\xe4\xc2\xea\xf1\x84G\x03\x83\xbe|\xeb:\xbf\xfa\xfa\xab\xbc|\xf3\x06i\x14Q\x1bCUi\xcf\xe0V\x8a\x0f\x1f\x1d\xf2/\xbf\xfd&\xef>x\xe8\x8bM\x96\xfaHX\xe7\xe3l?w\xe3\x80?\xfd\xc2]^=\xd8%\x8e\x14\xdaX\x16E\xc5\xc9r\xcd\xe9rŪ\xacIF\x8eJk\x84\x8aZW0e\x05\x87gs*\xedH\x12Ϩ\xd7\xd6\xf2\xc7\x0f\x0e\xc3\xee\xdb\x1ft\xb5\xf1D>\xbb\xb1\x93m\x8a\xbb\rS\xfe\xb6uc\xd3\xc0%\xca\x1b\xc7\xc4i\xcaK\xcf?\xef\rcz\r\xd0\xe3\xe5\x8a\x1f\xbc\xf7>?\xfc\xe4!?x|\xd2\xeeP㨗3\x1f\u0085T8`&I\xccN\x96\x12I\xc1s\xb3\tY\xa4|\xc0N\xfb\xb5\xea\xdc5sY\xc4륟Us\xbd\xc7\x11\x89R,\xeb\x9aJ\x9b\xc1:*Ob\xbf\xc6\x11\x86ɁG\x03\xde9\x99\xb3Ԇ\xda8VZ\xe3\xd6%\x9f\xccW\xfc\xe1'\x87|\xfe\xfa\x1e/\xeeN\xb9\xbd\x1b\xf1h\xbe\xe6\xac(\xbdr\xc2\xf9)\x13႗?\x9d>>T\xcfX\n\x8f\x068G\xec`\xe6,\tpt6\xe7ͳ\x05w\xaf\x1fpmgJ\xa5\r\xc6\x15X\x97\x92\xa71Ƴ\x03=)\xb0gNt\xe9\xd0\xd6'\x85\xf5\xa7P\xb1\xe5\xe4o\xb8\x15n\xfbY\xef%\x83\xae[\x01\f\xac\x82;°\xb7\xa2e\xf0\xfc\xdc\x16C,\xe7z\xda\xf7\x80j\t![\xfd\xbbp]\xe3\xe8et\x86\xaa\xaeQI\xcfP\xc7\xf9\x04\xc1庠\xa8AJ\xe5}GB\x11Γ\x84D)ΖK\x8f\xd2\b\xbf\xder=\xdc\xc8m\xac\x01\x94\x14H{\t6\x12\x92Q\x93$i\x9b1\xad}慠\xd9\xfd\xcb\v\xbe?X\xa7\xe2\x9bA%\xba\xf0\xae\xd6\xf4\xc7]\x8c;\b/o\xe9\xe4\xd3R\x10\xc7\xf1\xb9\xf7\xd7\x1aͺX\xfe\xa4k\x8a\xfc\x11n\xbfg\x8f\x9fW\x03\xe0\x1d\xa9\x1cښ\x16\xeeo\x1ey\x9a0J\xd2K'\u007f\xeb,\x87\xaby\x9b\xb7\xbeyu\n`g4\xc29KY\x96\xed\xa4\xdfj\x8e]7u6\xab\x80&\x81\xad\xbf\x12\xb0\r\xf4\xbf-\xe5\xcbn\x1e\x1e\xdd\x12\xf3I\x84?\x04\x83\xc9_\x9a\x10\xfb\x1b\xf6\x83\xce\xe3\xc1\x17v\xc2\x17\"\n\x01\x9e\xfd\xb5Ͼ\xc2\xff\xf2W\xbe\xca\xc1l\x82֖eQP\a\tӪ(\xf8\xa3\x1f\xbe\xc7\xef\xbd\xf56EU3I\x93@\x0es\xe4Q\xc4\x17n\x1e\xf0g_}\x89\xcf\xdf<@\x02\xf3\xa2\xe4dUp8_p\xb2XqVV<^\x17<\\\xae\xc8\xf3\x11\xbfZמ\x04\x16\xe4oJt\xbe\xfc\xe0\x89n\x87\xab5\x0fC\x14\xa9\xb1\xfe\xbd\xce\xe2(\xb8\xe9\r\xa3A\xe8A\xf2b\x8b>\xba{\xad\x1e\xe5\x11\xceq\xf3\xba\x8f\xf6\x1d\xd8\x15\v\xc1'\x8f\x1f\xf3\xe1\xc7\xf7x\xb4\\QY\xeb'(!\x88D7\xedK\x01\xdaY\x8cq\x8c\xe2\x88Q\x1c\x13I\xc1\x8b\xfb;|\xe9\xeem\x8c\xb5<^,9Y\xae\xbcN\\tΈ\x17լm\xc8\xe5\x13\xb8\xa3Dү\x05\xaa\xc8\xcb\xfa\x86\x9f\xa9'\xb9\x1e\xadK\x94\x94\xbcv\xf3:ZHt0\x19\xaaj\xcd(\x89\xa8\x817\x1f\x9fr\xb8*ye\u007fƍو4\x92\x1c-\vJcϯ+\xc2\xfbg{\x05\xb9q94\xceb,\xe4\x02n&1\x87\xeb\x92\xef\xbd\xff\x11\a{\xbb|\xe6\xf6\rb$\x8b\xe0\xa71J\xd3v\x82\x8c\xa2\b)\x1b\"`\x1f\x9a\xdf\xfe\xc2m\xf8>)\x9f|~\x8b\xd6c\x81v\x87>D]\xba\xb5\x9e\xeck\xdbۦ\xc0\x9d;K\xd8\xd2\x044ݑ\xeb=\xaf\x86\xc8i\xdal\v\x06\x96\xd9\xd6Z\xb4\xd1H\x11w\xebD!\x98\x8eGT\xa7g\x9d\x948\xecc\x9cs\xe4I\x82ֆUY\x90\xa8(\xfc|ۢ!\xfd\xa5\x86\x83\xd61p\x985 \x86\xf7\x84R-\x01\x10 \x8e\"\xd2$\r*\x80˦hמ\xc5M\x02\xa1\xb1\x9e\xcf\xd1\xf2-\x02\xd2*6e\x98b\xd8\xc19gI\xe2\x1c\xa5\xa2!\xa2\"\x04\xa7˓\x9f\xceP\xf9\xac\x01\xf8\xf44\x00\xb5\xa9\xdb\xd9[\t\xd9\xdeԣ$f\x9c\xa4\xe7\xf4\xb0\x83\xe2\x8f\xe3\xd1jNi\xea֎uP\x97\x9dc\x94$\x8cӔ\xa2(1\xd6\x1bzXk\x86\xc5?L\xa2-\xab|s\xfao\x8b\xff\xf9\xe9\xdfًv\xfe\f\x1c\xfe.\x82\xef\aF?\xd6\xf90\xa0f7\xa9\xe4\xe5\x87\xe0%\x0e\x84\xc6Zn\xed\xef\xf1\x9b_\xf8\x1c7vfXk\x89#\xc5l\x94S\xd65\xdfy\xef#\xfe\xe0\xad\x1fr\xef\xf11i\x123\x1b\xa5\x18k\xc9\xe2\x88_\xbas\x93\xdfz\xe5\x05>w\xfd\x80<\x8e(\xb5\xe6\xc1\xe9\x9c\xf7\x0f\x8fy<_rV\x96\xdc_\xac\xb8\xbfZ\xb3\xae\xfd\xce\xfe\x9aP\xbe\xf8\xe4\x9eX\xe9\xdf_;\x90X\n<I\xb32\x96R{X\xdf:\xbf瞤1Y`\xfe\xb7\xe1 \xdb\u07b3\x01\x1c\xdc\xf6_!\x1aW\xf0\xdc\xedۃ\xf0\x97f\xf7\xf9\xfe\xc7\xf7x||\xc2YUwD0)\xda43\xd9*N\\\xf0\xe5\xaf\xd1\v\x8b`\xc48\x8e\x19\xa51q\x14q0\x9dP\xd65\x9f\x9c\x9c\xf1\xe8t\xde\"\x02\x97\xbb\xa0\x8a\xa7\x869\xbd*\x00N\x8b\x8a\xd3u\xc9\xda\x18ֵfm,&<םш\xbd\xe9\x844\x8e\x90\r\xc7Cz2\xe34\x92`\f\x8b\xa2\xe0{\xc7s\x0eҘ\xbb\xd3\x11\xb7w'\x1c.֜\xad\xcb\x01\x13\xbf\xb9reh<\xfbf9*h\xf0\xb5\xb5\xc4Rp{\x9c1M\"\xee\x1d\x1f\xf3\x9d\xf5\x9a\x97\x9f\xbb\xcdt\x94\xb3,+\x8cu\x8cӄ8\xf2\xefd\x1cEH٭\xd8\x1aC/\xb7\xa5\x11\xee\xe79lm\xf8\x1cl\x8a\xe4\xbaF\xd1\xc7 \xb5\f\xf6P\xac\x1br\xb0\xe8\x05ش*\xc2\rd\xad)h60\xfd[B\xb0\x10\x18\xa3qtr\xb9&x\xc8\xda\xc6\xd3\xc1\xb5\x89\x88RJ\x1fu\xedlKttΑ\xc51y\x9a\xb2,\xd6\x03\xe2q\xf3jFy\x86\xb6\x06ݘQ\x05\xde\xc16\xb5\x91\f+I\xb7i*\xdc\xd33\xc7QB\x14\xc5\xedkI\x93\x84\x1b{\a\x14\xa5\x1f\x02\xc4\xd6P]\xd7\xee\xfcek\xfbm\xdbгA\xe4\xaf\x10\xdb\xe1\xf9\xb61\xf0\u007f\x9f$\x1e\xfe\xef?ת.\xa9u\xf5c\x94\x8e\vo\xb81\x90\xe1#\x81\x9f=~\xd1\x1b\x00\x0f\xfb7.TM\xf1O\x98\xa4\xe9\x16\xb7\xad\xee@\xb5\xceq\xb8:cUW-\xa9\xc7\xf5`\xc2\xe6B>\x98L\xc1z\xdfw\x11\xa0\xff60\xa6\xd9\xc1\x85\x9d\x9e\f\xf8Q\x1b\x93\x1bܫL\xf0\xbb\x1e\x14\u007f\xb7\xc5\xd6\xf7\x02\xc2\xdf\xc5\xc5_\x9e\xb3\xf8m\x8a\xbfU\xf2B(Y^\xc1z\xd8YO||\xee`\xbf\xbd!\x01\x928\xe2\xf1ق\u007f\xf4\xfb\u007fDQ\u05cc\xd2\x04B\x8a\xddן\xbf͟}\xf5E\xbex\xf3\x1ay\x1cQh\xc3'\xa7g\xbc\xfd\xe0\x90\x8f\x8fOY\x94\x15GE\xc9\xfd\xe5\x9aUm\x82t\xa9\xb11q\xac\xd6\x05\x93ш\xe6X\xd2\xdap\xb2X\x04w8\xcf\x1e\u007f\xbc*\x83|\xa8;?VUͺ\xd6D\xd2\xcb\xe3\xd2ȇ\x00I9\xb4a\xf6D\xacа\x05\x1d\xbbá\x8då\x96t\u007f\x97\xeb\a\xfb\x83\xe47!\x04\xab\xa2\xe4Ï\xef\xb1(+Jm{y\x12MR\x9d8\xa7+\x16@\xa15\uf71cq\xf4\xe6ۼw2\xe7W_\xb8ë7\x0e\x98\xe5\x13\xf6&#\xce\x0ev\xb9wt\u0083ӅW$\b\xce[\xd4\xd2\x10V\xc3^\xb6\x97\x1d\xe0.\xe0k8\xe0\xe3\xf9\x82\xb7\x1f\x9fq\\\x94\xe4Y\xca\xf5\xbd=^:\xd8c\u007f:e\x94\xa5\x1e^v\xbeѫ{\\\a\b\x91\xc7\x12\xa6Jrm6\xa1\xa8jN\x97+\xde>[qs\x94r{w\xc28\x89\xb9w:\xa7Զ{\x8f{\xf5P\x86H\xe0f\x9d#\xa5 \x16\xaa\xddqO\xe3\x88\xd7\xf6w8)J>\xfe\xf0c\xf6\xaf\x1fp}o\x972\xc8\xe2F\xa9#q~\xd7\x1cG\xaa\x17\n\xf5\xa4\x94\xbc^\x83ල\x9f\x17\x80\xd6\xde\x05*\xf0p\xba\xd2*C \x94\x13û\xc95d\xc0mY7m\x96@״H\xa5\xd0Z{UC\xb0\xcanb\xa0u\xbfH\ad\xa9\x8b)v-\xd8\xe0\x84`:\xca)\xea\xaa% \xf7\xbdETp8=].۠*\xafNp[azcݖ\x1e\xb9{\x8dI\x9c\xb4\xe7j\xf3\xde^\xdb\xdb\xe3dq\xc6\xe9b\xce@c\xd9\x1bx\x94\xece@\x04\xf3$\x17\x14S\xae\xe5\x8bl\x99\xfe\xfb?,x8DqL\x12\xa7\xe7\xa6\xff\xc5\xf2\xf4\xa7US&\xc0>p\xfc\xac\xbc~\n\x1a\x00\x192\xdce\x90*\x8dҔI\x9a!/I\x95j`\xffeS\xfcÍ\xd7\x1e\x1c\xc1\x85m\x9c\xa6\xec\xe49\xe5zMC\x1e\x1a*\a\xf0\xa6)\xfd\vZ\f\x17\xcc6\x90\xfe\xaa\xb0\x8fn\x0e\x0f\xec9ns{\x13\xc9+\x14\u007f7\x90\xfb\x05\xd2_s\xbcIqN\a\xdcB\x8dW\x9c$\xad\x83k\xb3\t\x93,\x1d\x10\x85\xa4\x90h\xa3)\xeb\x9a(\xec\xe4\xbf\xf6\xdcm\xfe\xfdϽ\xcc\x1b\xb7\xae3Jbjc\xf8\xf0脷\x1e\x1cr\xef脳\xb2b^i\x0e\xd7%\xf3\xca'\xd7\xf5W5\x8d\xad\xf2\xba,8[.\xbd\xae8\xfc\xb9\xd6Ƴ\xcb\xe9X\xfb\x9bn\xa3\xcd{^[GU\xd6,ʺ]\x1d\x88\x8d\x1d\xa8\xeb\xc1\xd4ͻn\x9c#\x16\xb0\xbb\xb3\xc3x4B\xf7\\ڄ\x80\xc7''\x9c\x9c\x9eR\x18\xe3\xe5[\r\xe3\xdd:\x8c\xb0(\x19m-0M1:.J\xfe\xc9\x0f\xdf\xe7\xf7?~\xc0\xaf\xbdp\x9b\xff٫/\xf1\xe2\xc1.צcvF#\x9e;X\xf3\xd1\xe3\x13\x1e\x9c\x9ey\x1eD/5N\nH\xe3\x98<\x89\xc9\xc2\x0e\xbf\xac5\xab\xb2\xa4\xacM\x98\x14}C\x17Iɼ\xaa\xf8ޣ\x13><9\x03!x\xf9\xcem\xbe\xf0\xcag\xb8\xb1\xbf\xe7\xd9\xdb685:\x8bў7\xe3\x9c\v\x863\xfe\xfa\x8d\x833\xdc\xd2\xc2Xy\x99d\xb23eUV\x1c\xaeV\xcc+͍QƋ\xd7v\xb9w<gQT\x83H]\x06\x88\xb9\xec\x1a\x18\xe1\x99\xeb\xd2Y\x8c\xf3\xd7\xe2~\x9e25\x96\xf9\xd1\t\x0f\xab\x9a\x83\xeb\a\x1e\xde.+2kI#\xcf\t\x89\\D\x1c5r@1\xf8\xdc/j\x04\xfa<\x96\x01\xe1\x83\xcb\xd1\x16\u007f\x98\xb8^\xb3\xb1\xc1\xcb\x11[T?nHS\xdclP\x94\x94\xa0\"\x8cў\xab\x14<*\x1a\xbe\x92k\\\x1f\x9b\xf4A\xb1\x05\xa2\x0fΦ\x93|\xc4\xc9b\xd1S\xb3\xb8Vr\x9aD\x11\xe34\r\xbe\x1btM\xc0\xc6\xfb\xd4:'\xc2y\x11^\xf8\xba$d\x00\xd0#5\x8eҌ\xfd\xd9\x0e됳\xd2GV\xc4\xc6^\xc0spl\xab\x80\xb0\xbdtC.(\xfe\xa2g\x9d\xee\t\x90\x192\x90'\x9bGQ.1V\xff\x98\xa5\xe3B\xa6\xcd\bx\x89gn\x80\x9f\x8e\x06@\b\xd9fnO\xb2\x94\xf1%;\xff\xe6c?\\-\x86ſÞz~\x18\x82\xfd\xf1\x04g\fZ\xd7a\xfa0\xad)\x8as~\xa2\xaf\x9d%\x0e\xc5Bn\xf9]\xda\xfaT\x89\xba\xfbƞ\x8d\xe8\x96\t\xceض\xe3\x17\x17\xec;\x9d\x14X\xd9\xdf\xfb\x9b\x96L\xe4\xc4\xf0\xefڗ\xf6\x14\x81C\xcd\xe3\xc6ά\x8b6\xee=\x94\xe8\bJE\xa5\xf9\xf2\xad\xeb\xfc\xe6\xcb/\xb0(K\ue75c\xf1փG|px\xc4\"\x14\xfe\xe3\xf0o\x13\xe0\xfcs\x9f\x8f\x10\x14Uͪ(\x19\x8fj\xeau\x01\xc2\x17\xb4\xbdɸU\x00<\xa9u\x11\f'>\xe7\x18\xf8\x9e\x8b\xc1\x16@\fʵ\x12\x92\xf1x\x14\x9a?\xdb9\xbb\x19\xc1\xd1\xc9\t벤6=\xb3\x97@\x023\xbd]\xf1\xb6\xf7ק\xd8z\x84j^U\xfc\xfd\xef\xbf˿\xf9\xe0\x1e\xbf\xf6\xc2]\xfe\xfc\xeb/\xf1\xc2\xde.\xfb\x93\x11;\xa3\x9c\xe7\x0f\xf6\xb8\u007frʲ\xac\xfcĬ\"\x12%\x19e)yp9\xac\xb4\xe1h\xbe\xa0\xackƙ\"O\x12\x8a\xaa\xe6l\xb5棳\x05\xdf~\xf0\x98um\xb8s\xfd\x1a\x9f}\xe9\x05^\xbe{\x87Q\x96\xf5\x94+\xfe\x106\x86 \x9b՜\xae\xd6^\x82\x17\x1c\fE\x90\xd1j!(k\x98F\xbe)H\xa2\x88q\x96\xb1X\x17\xbc}2g7K\xb8\xb6;%[\xad9Y\x16\xd4fà\xa5_T\x85\x9f\x06\t\xecw\x9c\xcfThT6{YBY\xac9\xf9\xe4\x01Ӄ=F\xe3\x11\xeb: 56\xf0B\x9c\xf3>\x14\xbd\xa9[\xf4\xa2\xa6/2\x93\x19\x1a\xfb\x88\x9e\x87\xfe%\xd8@\x1fii\x1b\x01\xd9\xfbo\xd1[\rl\xf8\xe8_p\xa1\xfa\xa2\x1f\x05\xb7\xc3\x16\x93\x1a\x14LѮ\x96\xb6߭.\xb8m\xe6iBQV\xfd\xc1\xbb}\x06Y\x9a\xb2\xae*\xca\xe0aᶖ>\x81\x12\xfe\xfa\xddz/I\xe1\xa1\xf7f\x9awݚco:\xe3\xf0\xe4\x88J\xeb\x8df\xb7C-\xbcC\xaa_\xa9\xd8\xc6e\xb5\a\xf1o[\xcdv\xbeA\xc1\xfaWI\x928=\xf7\xec\x9f\xc6\xf4\xe7Gx$\xc0\xab\xaf<\u007f矽\xfd\xe1\xbdg\x8a\x80_x\x04 \xc0\x9e\xe34\v\x84\xbf\xcb\x1f\x8f\xd7\v\x96uy\xc1\x05(p»ƥq\xcc$M)\x8b\x82\x86\x8c\xd2j\xc9\xc3aZ\x1aC\x14&\xd9hp\x03\xf8\x87v^\x8aV\x85\xafg\x8b\xa7\u007f\xff\x0f\xa46\b{\xf9\xce\x1fA7\xf9\xd31\xfe\x9b\x1fլ\x05\x1a\xbd\xbf\x84\x1fI\xfe\xd7y\xabs\x8e\x17\x91\xc4\x11\xb1R谿\xfe\ao\xbe\xcd/\u07fd\xc9GGǼ\xfb舓»Ν\x955+\xad\xa9[V\xf5\xf6\x86\xcc:\xc7ɪ\xe0\xe3\xc7G\\\xdb\xdd\xed\bxΑ\xc5\x11q\xe4e\x866\xbc\x9e\xab\xbc\x1a\xf1\xa4M߹\xe7\xe1\xd0ڄ\x98\xd3p\x18\ay\xe0z\xb5F\x1b\xd7N\xda\xfdc\xb4٥\xaa\xcd\"\xd3\xfbl[\xa76!\x90\x91b^\xd6\xfc\xbd\xef\xbd\xcd\xef\xbc\xf71\u007f\xe6\x95\xe7\xf9\xf3\xaf\xbd\xc4\xed\xdd\x19{\x93\x9c\xddqNe\fEU\x0fv\xb7\xc6X\x16eɲ\xf0\x1e\x14\xb7\xf7v\x88\xa3\xc0\xf8/K\xfe\xf0\xa3\a|\xe7\xc1!\xfb;;\xfcګ/\xf3\xc2\xed\x9b䩇N=\xa1Lb\x8c\xe6\xc1\xd1\t\x1f<x\xc4\xfd\xd33NW+櫂eQb\x9d\xdfϧJ2\x8ec\xa6y\xc6\xfel\xc6\xeet\xca|\x94\xb1\x97Dd\xaa\x97\xd2'\x04\x8fW\xde?\xe0\xc6t\xcc,\xcfxx\xba`^T}\xe2\xfc\xe0:j\xc9u\xb8\xd0h\x80u\xa2eħ\x91Oy[?:Į'\x8cvw \x15X\xadI\x82\xf5\xad\xb5\x11I\x1c\x05\xf7\xc6Τ\xa7o\xdasi\x13\xd0h\xc0\x9a\x85\xf4\xe5\x9a\xf0\xf0\xb3\xe5`\xf50\x88\xe8\x15\xae\x1d\x1a\xfa|\x95\x8b\xe6L/mTXkZz\x9e\x10\xf8`%\xed\xb3\x0e\xa2\x10\x84u\xd13SR\xb27\x99ph\xce(\xeb\xfa\xdc>\xbd6\xc67\xe8\xe78\n\xdd\xf3\xf4\x9f\x81\xe4\xa2\xd0;)=\x01\xb0O\xaem\xd0\xd3Q\x963\x1bOY\x95%\xc6\xe8swZsO7v\xbf\xb5\xd1\x1d\u007f\xe3\x02\x13\x1e\xd1[\u007f5\x12\xcc8\x1a\x9d#\xff-\xd7\xf3\xa7\xe6\xc3<%\a@\x00\xaf\x02\x8a+\xb8\xb5?{\xfc\x9c\x1b\x80H)\xa6iF\xd6c\xabn\xfd\xa8\x85\xe0\xf1z\xc1\xbc*.$\x06\xfa\xf0\x0f\u007f8\xec\xe49\xc2\x1a\xbf\x0fn.枴\xac\xb2\xb65\xde\xf0MȰ\x9c7Ŀ\xcay\xdd\xf90t\xfb\xfc\xef\x97\xda^^\xfcE\xb3\xf7\xef\x93\xfe,\xa2\xc7Vo,\x80\xe5\x86\xd3ߏ\xfax\xfb\xfe\x03\x8a\xban\xa1c\xf0\xfe\xedY\x920JSN\x96Kb%\xf9\xe0\xf8\x8c\xff\xf2_\xff!\xcfMs\x1e\xaf+Nʚ\xca\x18oyl/\x0e\xd52\xd63\xcf\x17U\xcdٺ\xe0;\xef\u007f\xc4\xe7^x\xae\r41\x06\xe6˥\u007f\x1da\xdf)/Q5\xf0\x94E\u007f\xf3\xa0~xt\xc4j\xbdb<\x1e\xf9\xbd\xa9\x03ct\xeb\xf9\xd0\xdf\xd3:oS\xdf:\xf5m\x1f@\x87\f\xb9\x96\x14'\x05\xb9\x8cX\xd45\u007f\xe7\xbbo\xf1\xfb\x1f\xdc\xe3\xdf}\xed%\xfe\xcck/1\xcb3\x9f\xc0\x97\xa5hmX\xd75EUS\xd45\xb51!נ\x97\xcd^\xd6\xfc\xab\xb7?䝓3\xdex\xf5e\xdex\xe5e\xa6\xe3Q\xf0B\b{bcypr\xcc\x1f\xff\xf0]\xbe\xf1\xde\xfb<:[\x04\xdb\xeb\xcdɭ\xcb\x1b\x18E\x8a\xfc\xd1c\xf2$ag:a\u007f6e7K9\xc8\x13/\x99\f\xa4\xbeӢ@\bxioƫyʽ\xe39\x8f\x17\xab\xd6\xe7~\xa0\xbf\xef\x80ޖ\xc4\xd1h\xael\x03e\a5A=\x9f\xb3(J\xb2\x9d)n:\x818\xc6ֺm\xbe\x938\n9\x14\xe2\x82O\xdfm]\t4/\xb2\r\xaeq}\xf3\x9f\x8bh\x02CBa\xf3\xbd\x9b\xda\u007f':\xf9`_\x8dґ\x17C#\"\x05J\xa8a\xaa\xa0\xf0y\b\xb51(\xe5\x13\x04\xfbp\xfc\xf0,\x93H\t\xb3\xf1\x98\xc3\xd3\xd3\xc1\xdf\xd5Ơ\xeb\xbaS)m4@\xfd\xd5\xc5yzD\xf7I)\xa9H\xe2\xb4\xfd\x9e\x86\x17\x13E>>\xfd`w\x97\xe3\xf9)\xeb\xb0Bj\xcd\xce:&\xa6\x0fL\xd35&\xa8F\x1a\xb5\xc3\xf6\xe3Wn\xa0F\x924\xcd\a+\x10k\xcdOC\xf6\xb7\xed\xa6}i\v\xa0\xfb\xec\xf1\x8b\xd8\x00̲\x11i\x14]\xfai:\x04G\xeb\x05g\xe5\xfa\xe2\xe2ߢO\x828\x8e\xd8\xc9\xd2\xd6\xf1\xcf\xf52\xabM\x88\xad\xad\xac\xf5\x91\xb4\x10\xf2\xb7\x87\x1dp\x1d\x8a\u007fi\xec\xc6M|~\xef/MW\xc8/+\xfeNʍt?;x\xde(\x85r\x16鮸\xed\xba\xe4\x11+ɷ\xdf\xff\x88\xdf}\xeb\x1d~\xe3s\xaf\x05\xb7?KYk\xa4\x10\x1c̦\x1c-\x16\xa8pS\u007f\xe7\xe1\x11k\xbd\xd3ƒj{\xb1ۡu\x8eB\x1b\x96\x81\xbcg\xac\xf7X\xffއ\x1f\xf3\x83\xf7?\xe2\xf6\xf5k\x9eI\xbfZ\xf3\xbd\xf7> \x12P\x87\"a\x9f\xb0\xca\x10OQ\xf0\xdb\xfdq\x80\xf2\x1f\x1c\x1d\xf3\xd1'\x0f\xd8\xdbفp\xe8\x99\xde4\xb5\xf9ɵf1[bd6\xaf@\xb7\x15\xbe\x12D2\xe2\xe1j\xcd\xff珿˷\xef\x1f\xf2\x97\xdex\x8d\x17\xf6w\x19%1\x91\x92\x8cDܺ\x056i\x85\xcd\xe1~\xb2Z\xf3w\xbe\xf1}\x8e\xb5\xe3\u05ff\xfc\x06/ܾ\xe5\x1d\x11\x9b\xe7\f\x1c\x9e\x9c\xf0ͷ\xdf\xe3\x8f\xdfy\x9f\a\xa7s\\\b\x1dR\xd1\xc5\xe7\x9bq\x8ey\xadY\xd4\x1a\xb1*\xf8\xf8\xf4\x8c$\x8a\xc8Ӕi\x96qk:\xe2\xee΄<\xf6\xd0\xfc\xe9\xba\xe4\x9eZ\xf0\xfa\xb5=^\xbdu\x8d\xbd\xf9\x92\xfb'\vN\xd7E\xe7\x9c\xd734\xea2g\x82\x8aB4\xde\xfc=7\r)\xb1\xbaf\xf5\xf8\x98z\xb9\"ߙ\x91O\xc6\xed\xbe\xdd\x18K\x9a\xc4\xc19\xb0\x9f8'.m\x04\xce[\xd5\xf9t\xa0v\x85 .\xbbs\xfc\x84.\x90mC\xdf\x1a\x0f\xb5\xc6_\xae\xe5\x11]\xc4\ah~\xb7\x8f\xbc\xb6\xed:AJ\xaf\x99\x97R!\x85\xdd~\xcd6ס\xf3ܐ,\x8eY\x87tM缑\x92\t\x19\x04\x84{\xb3/\xcd\xec\f\x87\xdc\xc5\x06\x00@\x9c$$q\xd2>\xffuUQV\x15\xd7wwq\xce1\x1bO\x98\x8e'Tu\x8d\x0e(\xc0\xd0\x13\x05\x8c1\xc1-\x92\xed\x1c\xa9\xf3\f\x80\x96\xa0\x9d\xa6\x19q\x94\f\u07b7\xf9OT\xf6w\xe9\xa9x\x9dgR\xc0OG\x03pi\xf1\x0f\x17\xd3\xd1z~\xf9俱c\x9b\x8dF(\a\x95\xb3\xbd\x9d\x96w\xe9r@\xe9,\x91\x90\xad\xe5\xeb6\xe8\xbf\x0e\xba\xea\x8bO\x1f\xd7M\xf1ƶ\xf9\xde\x177&\xb2\xdb\xed;\xdah\xdfv\x02Q\x12\xb59m\xf7 @\xf7\x94\x85\xd2O\xe7\x9a\x0f\xef}\x8c{\xe5%\x96\xb5\xa66\x86\xa2\xaa\xa8\xb4\xe6\xf9\x1b\xd7x\xfb\x93\xfb\xed!\xb3\xae\r\x8f\x96+\xf6G\x19e\x93&w\xae\xf0CQ\xebֻ\u07b4\xb6\xb2\xfe=<Z\xae\xf8\x17\u007f\xfc\r^\xb9}\x1b\x15E\xdc?:\xe2\xa3\xc3#\xef7n\xbb\xf5\x8bzR\x13\xb7\xad\xe9\xd8\x18\xe4Ā\x92aYk\xcdYQ\xf1\xe0\xf1\x11/-\x96\xa8 eSJ\"\x9b\u007f\x06l\xe7\xde\xef\n\x13\xcf\xc5*uq\xe9\xe2\"\naD߸\xff\x88G\xab5oܼ\xc6\xe7o\x1e\xf0ڍ\x03vG9I\xa4\x82\xef\x81\r\x9c\x02\xc9\xe9j\xcd\xdf\xfd\xce\x0fa<\xe5\xdf\xfd\xfc\xeb\xec\xef\xecP\x87\x98Y)\x04\x8fO\xcf\xf8\xf6\xdb\xef\xf2\x8d\xf7>\xe0\xde\xf1)Nt\xc6-ێ\xc0\x86\x9b\xa1\x82\xaa\xa1Q\xc6X<A\xb3\xa8\r\x95Y1_\x17||t\xcc\xf7҄\xd7o\x1e\xf0\xf2\xfe.J\xc2\xe3e\xc1Gќ\xe7v\xa7\xdcٟq}6\xe6\xe1ق\x8f\x8f\xce<I\xb0\xff.\xb4\x96\xb9=y]\x93\xe5\xe3\xbcRC\xe0\x8d\x84\x00\xea\xa2\xc0T\x15\xd5bA>\x9d\x91MF\xbe\xe1\r\x16\xc5q\xe4\x13\x12\x11\x02q>P\xf6\x92\xab\x82\x96\x93\xe0\x06+\xbaN\xde\xd8o\xdd\x02\x8f\xb1E\x10\xda\x1a\xdd\xf7\x9f\x10\x02\x1b䄛\xa4@O\x82\xeb\xf9\x84\x87\xc6\xce\x044Q\xd0\x14\u007f\xdf\xc0\x9d\x8f\xbd=o\x15\x9d\xa5)ePq\x18\x13H\xa1\xe1߃{=\xd8-\xdb@\xc0<w*\x88\xe1\x00\x93&i\v\xbf\v!(ʒ\xc3\xd33v&cҠ\x0e\xb8\xbe\xbb\xc7\xd9b\x1e\xaeK\xdbC?\xfcs\xb1=3\xa5\x8b'\xff\xa1\xf5r\x83X\xe4\xe9h\xd08\x95\xd5\xeaǔ\xfd=\xd5c'\xac\x00\x9e=~\xd1\x1b\x80ˊ\xbf\xb6\x86\xc3ՂBWW+\xfe\xe10\xdeMRt\xed\x89\u007f6@\\\xa6\xf1\xf3\x0eE+\n\xf0\xa5\x92C\x1a\x90\r>\xff\xa51\x03\xdf\xf9\xed\xc5\u07fb\xfc=\t\xaaw\x88\xadſ%\xd4Iq\xf92\xbf_\xb4\xce\xfd\xf7\xf0|t@\xa9\rwfc\xfeڗ?˟}\xf5E\x94r<\x9a\x17\x9c\xac\xd6-\x04{co\x87\xbdɘ\xe3\xc5\xd23\xbb\x85ବ\xc9\x1a\x8b\xd2ޫ\xee&~ͺ\xd2mĲ\xd8\xe2\xc8\xf7\xd1\xf1\x19\xcbu\xe1}í\xa3\f\xb1\xb7\xfd\x02\xb2-\x1a\xe7\t\xc7|\xfb<\x066϶{\x8f*m8-*N\xe6K\xea\xba\xf6\x1e\n!\xe1N \x88\xa2\xf8|\xc6z\x8f\xf3e]\xf7\xcc./\xf7\x17_\xaf\xb1\x12ܟ/\xb8w\xb6\xe0_\xbd\xf7!\xaf\xee\xed\xf2\x1b\x9fy\x8e/ܾN\x96\xc4-\xfb\xddZ\xcb?z\xf3\x1df\xd7o\xf2\x1b_y\xc3\xdb\x16\x87i\xeb\xf8\xec\x8co\xbe\xfd.\xff\xe6\xbb\xdf\xe7\x83\xc7\xc7h\xbc\xde\\l\x14\xfd&\xac\xa8\xef_\xa1\x82\x9a\xa6Ϛ\x97@$\x14%\x96\xca\x18\xc0\x93^\x8b\xba\xe6\x0f>\xbc\xcf\a\xc7g|\xe5\xf6\rn\xcd\xc6<\\\xae\x11\x02\xae\x8fG\xec\x8e2^\xbey\xc0͝)\x1f\x1d\x9d\xf2\xc9ќe\xb0\xd1\xee;\xe3m\x168߀\x04\xbeEȘ\x90!\xbc\xa9\\\x15貢\\d\xe4\xb3)\xa3\xd9\xd4\xcb\x03\x9d\xc3*E\xa4$\x84Ƽ\xdfg4p\xcde\xbb\xe3\x86\xc1\xee\\H\x8e\xdcH\x04\xdc\x1c\x12Z\xb5\x00\xb4!@\xcd>\xddk\xd6\xed\x96\xdf'z\xa19\x1dI\xa2ɱ \xac@\x1ay铚Z\xdf\xfcD\xa4q\x8c\xd6:\x84\n\x05Y\xe7\x96Ձõ\xda\u007f\x17\xd6l\xdb\a\x06A\x9e\xe5\x01\xad\t|\xa7\xaa\xa2\xack\x8e\xce\xe6\xdc>8\xc09\xc7\xcex\xcat4\xa66\x1a\xad\x03\xb6\x10\xf28L\xf8\x9c#\xa9z\x89\xa9\xe2\x82\xe2?|o\xb3tD\x1c'\x03\xe8\xff\xa7L\xfc\xdb|\xcc\xf0^\x00\xcfB\x81>\x8d\r\x80\xb7\xf7\xd5<\\ͩ\x8c\xbeR\xf1o.\xbe\xdd|D\x84\x9f\xfe\x9b\x0e\xdc\x06\x1d\xbf\x13~\xba\x8f\x02\x81I\xd1\xe9̛{\xa9v>nU_\bU\xf7\\\xfe\xcc\x13\x8a\u007f3\x85(ٞ\x17\xca\x05\xdd\xf5ftܓ+\xcc\xc5xy@,u\x90\x88\xfd\xe9\x17\xef\xf07\u007f\xe5K\xbcz}\x97\b\xc1\xe3\xf5\n\x8c\xa1\xac\xeb\x10\x8b\xeb_\xdb\xed\x83=N\x97\xab\x96K\xe5\rz\fy\x12\xb5\xd3\xe3\xba\xd6,+M\xa1u\xeb\xdb\xde\x18\x84\f\\\x17\xc3sX\xd5\xdaG\xa1F\n\xe3`Yk\uf6b7\xf1\x0e\xf6\x93ή\xc2uh\xcc[\\\xe7R:8V\xb5\xf5\xe6=\x0fNNY\x97%\x93<\x0f\a\xa6m\xa3YEϰ\xa7Q\x82І\xc4\xd0\x15\xb7\xad3\xff\xd5\xda\x02%\xbd\x95pe,\xdf|p\xc8\x0f\x1e\x9f\xf0\xc5\x1b\a\xfc\xd9\xd7^\xe23\a\xbbDJ\xf2\xe6\xbd\xc7\\\xbf\xfb<\xbf\xf1\xcb_\xf1~\xeeMf|Y\xf1;\xdf\xfe.\xff\xe2\x9b\xdfeQi\x1f\xb43\x8c\xab\xf7\xd9\f!\xd3>\x0e\xa6?q\xc8\bP\xadtΏ\xba2\xd8\x15gʇ\xbf,\xea\x8aB[\x96\xb5\xc6\xe2H\x1d<Z\xac\xf8\xe7?|\x9fW\xae\xed\xf1\xa5\xdb\xd7I\x02\x81M[˵ɈI\x9e\xf2\xb9;7\xb8\xb37\xe3\xc3ǧ|r|Ʋ\xac/P\x83tJ\x0eἃ`\xe3\xcd\xd3@\xd7\xd6Z\xca\xf5\x1a]\x95\x94\xcb%zo\x8f\xd9\xce\f!,\x95\xb3DR\xf9\x86G\xf4\x8cpŰ\xd8<\xb1\x11\x90t)\x81W\xba\xb7\xc2\xf4\xae$\xb6A\xf3\x82g\b}r\xdbEH\x9c\x10!\x99\x14\x1aY3\x1bp:\xe7\xd6z\x9d:!OSN\xaa\xca\xe7\\\x84\xc9\xfb\xdc\xef\vh\xa8\xb5\xfe\x1f\x1d8J\x9b\x03\x8aC\x10E\x11y>j\xd77\xd6\x18\xea\xaaF\t\xc1\xd1|\xc1\xdetJ\x9e$DQĵ\xdd=\xe6\xabe\xf8\xb9\xa6MHm\xf1\x17)/Y\x82\x89\x8d\xe6ȯA\xf2|\x1c̼\xfc\xf7\xfc\xe4\x88\u007fW\xc6@\xa7\xc0.p\xf4\xac\xc4~\xca\x1a\x00)\x04k]\xb7\xf6\xbeW.\xfe\xc0(\x8a\xd8O\xb3\x96D\xd3'\xfe\xf9\xfd\xbf/ \x8a&\xfdl\x03\xfa\xb7\xd6C\xff\xce]\\i\x9b\x83^\x9b\x01\x81\xef\xa2\xe2ߐ\xfe\x04\xa0B\x04k\xff\xdauB\xfch˪\xa0\x14h\x1e\x856\x8c\x93\x98\xbf\xf9Ko\xf0W\xbf\xfc:\xd3$aY\x95\xfc\xde\a\x9f\xf0\xc7\x1f?\xe4\xc6\xed;Di\xda\x1e2\xcb\xc2\x17\xc9\xfdل\xa3\xb3\x05\x8dI\xcbZk\xf2$b\xad\r\xf3\xb2b]\x9b\x1e\x03\xb8\x95X\xf7z\x12q\xee~\\i\xe3\xb9\x13x\x93%\xb7q@o\x82\xbb\xe2\n\x9f\xf1P=\xb0a\xfd\x8a\x97\v.k\xcd''\xa7\x1c\x9e\x9c\x92\xa7\x89G\x84Á\xae\xa2(L\x9b\xc1\x00\x8aN\rҬ\x9a\xac\x03ɏ\xdf\x044_\x19+Im\r\u007f\xf0\xc9\x03\xbe\xf7\xf8\x887n\\c/Kx\xe13\xaf\xf0\xe7\xbf\xfc\x86\x9f\xf8\x8c%\x89#N\xe7\x05\xff\xec\x0f\xbf\xc1?\xfe\xc6w\xa8\xb4\xf1\xd30ݚ#\x8f\x14\xa38BI\xc18\x89\xb9;\x1b\xf3\xdcl\xc2,\r!Ym\x0e\x95\v\x99\x15~\x02\xf7\xfc\x03\xdf\xe8\x96\xc6Pj\xc3IYs\u007f\xb5\xf6\xf9\x01u\xcdYY\xf1\xe1\xc9\x19\xa7E\xc9\xe7o^\xe3Ճ\xdd\xf6\u07b96\x1d\x93D\x8a\xddQ\xce\xee(\xe3\xf9\xfd\x1d\xde;<\xe6\xa3\xc7g!\x8eY\xb2\xd5\b1p\x15\xa4\xe8Vo.\xec\xca\xfd\xae\xdb`\x97+tYR\xaeV\xec\x1e쑏F]\xc0R0\x82r[&\xf9'5\x02B\b\x84T\x9d\xf2\xe7\x02s\xa6\xf6g\xf5\x88\x84\xad\xbb\x9fu\xad\xa4\xb4I\xc2\x13=S\x1e\xb1q*\xf4!\u007fq\x05+czrd\x19\xd6U\r\xfc\xdfo,\xe8\x01.u\xf0\x8c0aH\xb1}\v`\xd1\xdd!i\x9a\x11\xa9\xa8\xed\x1aMU\x92J\xc7:RTu\xcd\xd1|\xc1݃}?0Mw\x18\xe7G\x18;\xa7v!\x83\xa4\x87\xb6H\xbc\x1d\xf9\xb9u\x86\xd8\xfe\xa2\xb2tD\x1c\xc5-鸮K\xca\xea'k\xcaw\x85;p\x02<\x0f\xbc\xf3\xac\xc4~\x8a\x1a\x00!\x04\x8b\xaa\xe0\xf1z\x89\xc5>U\xf1\x8f\x85\xe0 \x1f!\xc3\xfe\xaa\x1f\x1f\xdb\x04X\xe8\x00\xdf\xc90\x81\x0ece\xc3\u07bf\xd9}]\xd2i\xaa\xfaj\xc5\xdf\xc7\xfb\x06\xd79{\x1e-pm\x1e\xf8S\xd6\xfe\xde\xf3k\xa6\xb5/\xde<\xe0?\xfa\xda\x17\xf9\x8d\xcf<\x8f\x10\x82{'\xa7\xfc\x0f\xdf~\x8b\xef|\xf2\x90\xdaZ\xe6\xd6\xf1\xfc\xed۬\xb5A\x1b\xe33\x01j\xcdl4b\xb9.)ko\xa7\\ԚG\x8b5k\xad\xb1\x96\x16R\x16\x9b\a\xe7\x938\b\xe1뚩\xd4\xf6\xde\x1a)ĕ~\x06\x17컇pp\xf7|*m\xf9\xf8\xf4\x8c\x1f~\xf4\t7\xf6v\xdbC\xba\xc9\x1aO\xc2\x1a\xa0/\xac\xb2\xd6\xf9\xf8]:\x8e\x82\xdchR\x9e\xd4\x04\f\xde\x131< U\x80\xc5\vm\xf8\x9d\xf7>\xe2?\xf8\xf5_\xe5\xeb_\xfe\x12eUa\xac%O\x13\x1e\x1e\x1d\xf3\xb7\xffɿ\xe0\xdf~\xff\x87\xd8\xc0\x11h\x9eK$%{\xa3\x94I\x1c\xb3;Jy~gʭq\xce\xc1\xc8{\x03\xd4\xd6\xdb-o\x1e\x8c>d\xa9s\xbd\xc49\x12\xe5]\xe1\xae)\xc9$\x898*kJ\x9dp0\xca|\xc3Wi\xbe\xf7\xe01\xf7\xe7K>{}\x8f\xd7C\xbc\xee\xc18\x0f\xab!\xc1\xc1t\xc4\xfed\xc4K\xd7\xf7x\xeb\xfec\xee\x1d\x9fa\xad\v\xcfy\xbb\x84/\x92\xaaMx\x14\x02l\xd0\xf2[kѵc~tL\xb9\\1\xdd\xdbe\xb6\xb7C\x9c$\xd4\xda# J).\x8a\x81\xb8\xac\x11\xf0\xc4>90\a\x13=\x18\u007f\xf3\xb3\xeb\u007f\xbc2 vM\x130h\x16z(ǀX:h\x92;ߋ\v\xfd\r\x00\x17\x9a\x9d\xba\xae\xbb\x06b\xe3\\\xf2n\x9b\x8a\xca\xf8\\\x88*\xa4b\x96\x9b\x1c\xa2V\xa1\x101\xce\xc7\x18cQ\xb1\xc3\xd6\x15\x8b\xe5\x9c<\xf2y\x11g\xd6qtv\xc6\xfetB\x96$\xc4Q\xc4\xf5\xdd=\x96\xeb\x15F\xba6\vEo($\xce\x13!Ź\xf5\x8f\x94\x8a<\x1b\xd1\xf9\x1e9櫟\x96\xe3ߥ$\xc0\x14\xf8,\xf0/\x9f\x95\xd8OA\x03\xd0\xc0E'\xeb%'\xa1[\xbcjYl\x8a\xffN\x1c3\x8ab\xea`Eڐ\x82\x9aε\xe1\x00\xf8\x9d\xa8<\a\xfdk\xe7(\x03\x02p\x19\xf4/\xaf8\xf9;\xd1\xc5\xfb6\xb2\xbes?Q<\xfd\xecߟ<jc\xc9#\xc5_\xfb\xd2\xeb\xfc\x95/\xbf\xce\xfe8gU\xd7|瓇\xfc\xbd\xef\xbcŃ\xb3%q\xa4\x88\"\xc5\xc3\xd3S\x92$FD\t\xebZSk\xed\x13ǜ\xdfCV\r\x11\xc9zݹ\u05fd\xffx\x00]\xe7%߭Zď\xf1\xf3\x1a\xebT\xc7\x16=w\xf8?\xabZ\xf3\xad\xf7?\xe4\xb5\xe7n\xb7!PEYb\xad%\x89\xfc\x04]\x1b\u05f7L\x0f\xd0j\x8f\x9bp\xc9\xfex{\x13pY^}\xf8\x9bZ\xf3\x9b_\xf8<\u007f\xf9\xcf\xfc\x06\xab\xa2\xa4\xd65y\x92\xf0\xbd\xf7?\xe2\xff\xf9w\xff\x01\xef\xdc\u007fD\x1aǭâ\x0fdR\\\x1b\xe5L\x92\x88[\xd31\u007fꥻ\xbcx\xb0\xcb\xf1rMQվ\x11\xaa**\xa7\xb76\x01\x91\x92m\x13\xd0\xde+\xd2\xfb\xd9+)I\x94丨Yj\xc98I\xd8\xc9,\x87\xab\x82\xd3u\xc9\xef\u007f\xf4\x80\x8fO\xe6\xfcҝ\xeb\xbcr\xb0\xcb\xfe8g\x9c&\xc8\xc07\xb8>\x1ds0\x19s\xef\xf8\x8c\xef\xdf{\xc4\xd1b\xd5r\x11\xb6\x8dkRH\x84\xf2\x84D\x19\"y\x85\xeb\x14:\xe5\xba@\xebG\xac\x16\v\xa6;3&;3/\x1dt\x0e\x15\xa9\x10,u\t\xec\x0f\xe7\xecf\a\xff\xdf]\xbc\xb7\xde\xd6\bH)qjC\x11 \x04\x12٭\x92\xdc\xc6\x1a\xc05\xf1\xba\xa6E\x1e\xb7]\xf3.x\x1fXh'\xff\x06iP\xce\xf9Ơ\xd7\b\xa4I\xc2\xd9bIa,\xa5\xd6]q>W\x8c%Y\x9a\x92e\x19\xceY2\f\xb94,\xac\xa1\xb0\x8eI\x1c\xa1\xadc^\xd5\x1c\x9e\x9e\xf2\xdc\xf5k\xe0`o\xb6ã\xe3#\xdcz\xe5㔅\xf3\xf9\xa7\xbd\x14\xc5~\x03 \xb690\x01y\x1at\xff\xe1Z\\\x15\xf3\xb6\xf9\xfeI\x17\xff'lw\x04\xf0\xe5W\x9e\xbf\xa3\xde\xfe\xf0\x9eyVf\u007f\x81\x1b\x00)\x9at\xb3-\xee~W(\xfe\x91\x10L\xa3\xa8\xf5\x11С\x01h\x0e\xd1ƫ˄\x1b\xaf!J\xf5\x0fK\xeb\x1c\x85\xb5\x94\xd6n\xe1\xe9\xf6\x0e\x05c\aҽ\v\xeb\x82\x18\x06\xf9\xfc$\x8b\xbfl\xf4\xb9\xc6\xf0\xdcl\xc2\xdf\xfc\xfa\x97\xfc\xd4/\x05\x1f\x1d\xcf\xf9\xe7o\xbd˷\xee=D[K\x12uDXk-\x9f\x1c\x1e\xb1\xb73c\xad=1\xaf\xaa\r\xb5\xae\a\x19\xdd\x17\xc3{?\xfa\x96\xeeB\x97\xbd+6\x04\xfd\xafk&\xf8\xd6z\x15\xce\xc5\x04\xdf?>\xe5\xad\x0f?\xe6swo\xf9϶\xac\xbc\" \xec\xc4+S\x0f\xa4\u007f\xceu\xeb\x00\xd5j\xc0ŕ\x81\xffƗ\xe6\xa2\x06\xc7Y\xcbs\xfb\a\xfc\xe5\xdf\xfa\r\xaa\xaab]\x96di\xcc;\x9f\xdc\xe7\xff\xf1w\xfe>\x1f\x1e>&K\x92\xc1\xeb=\xc8S~\xfdŻ\xfc\xf2\x8b\xb7\xd9\xcb2nLr\x9f\xe9^\xd5̲\xac=\x00exr\xa5\xf5\x862J\xca\xd6\xebB\x84\x82\xaf\xad\x1dH\xc9TP\x14H\xe0\xfaH\x12\x95\x9ay]\x93(\xc9\xf5q\xc6ÅO\f\xfcx\xbe\xe4\xf0\x87\x05\x1f\x85F\xe0\xce\ue53d\x91G\x03t\xb8W\x9e?\xd8\xe1\xdal̻\x0f\x1f\xf3\xf6\x83#\xd6e=؛o^\x0fJ\b\xacW\xeey\u007f~\xd7\xf1\x03t\xad1zIU\x96,\xe7\v&\xb3\x19\xe3鄘\x04'\x9d\u007fΗ\x9d\xfa=\xa3\xa2s\xff\xfd\x84\x0fq\xdb\xf3\x8d\x82aQGsq]\x1a\x9e\x87\xe2\x86\a\x89h\xdc\x02]\x1b\x12\xb4\xfd}\x10-:\xe099\x86.\x13E\xfa\xe2\xef\xba܈UUSi\xdd摈sk\xd3\xc0\xabQ\x8a\xc9d\x8a\x92\x92q$ɕ\xe0\x86\x80\xe9ވ7O\xd6\x18\xe0Z\x96P\x1b\xcb\xd1|\xce\xeed\xc2$ω\xa3\x98\x83\x9d=VŚ(\x8a\xb0\xb5m\xaf\xa3\xa6\ri\xe5\x88\xdb\xd0\f'P\x91\"\xcb\xf2\xf6\xbd0\xa6\xfe\xa9i\xfe;c\xa3K̀\xde\b\xab\x80\xd3ge\xf6\x17\xb8\x01X\xd4%\xc7\xeb\xe5S\xed\xfb\xfb\x8f\\)\")\x89\xa4\xa2\xae\xebv\xe2o<\xe3\x1d\xa0\xc3~T\xf6v\x0e}\x1dyi-kc.-A\xc2X\xef\xf1\u007f\x85\xe5T_\xef/\xedvC\x1f'\xc4S\x17B\xd1\xe35\xfc\xda\xf3\xb7\xf8\x8f\xbf\xfee^\xbe\xb6\x876\x96?z\xff\x13\xfe\xe1\x9b?\xe4h\xb9&V\x92\xa8o'\x1c\xa4Qe]\xf3\xe1\xa3CT\x9c\x02^eQ\x94u\x1b&\xf3\x13{\xf4%C\xee\xf2\xd7枨\xa0\xb8\xc0*&d\xb0\xdb^#\xd0(\x11*cx\xeb\xde}n\xcd&\x9edg\xbc\x91\x93\xb5\x86T)\x16\xd4\xedA\"\xfa|\x00kQ\x810\xe7\x04\xe7lq/[\x05\x88\v\xaa\xbfs\x90 \xf9\v\u007f\xfaW\x99\x8e\xc7\x1c\x9d\x9e\xf9@\xa6\xd33\xfe\xdf\xff\xf8_\xf0\xf1\xe3cҞ\t\x96s\xb0\x93%|\xfe\xc6\x01\u007f\xfd\xebo\xf0\xfc\xde,\xf82x\b\xbd2\xc1JXkJ\xadI\xe3\xc8\x13\xb8\x82v<\x8bco\xe2\xa4u\v!\xc7ʿ\a\xbaw\rI)H\"Em,\aYL\xac\xe0\xb4\xd4\b\xa5\xb8>θ\xbfX\xa3\xad\xff\xbd߸\xff\x98ON\x17|\xf9\xf65^\xbb\xb1\xcfs\xfb;LӴE\xa1\xd2H\xf1\xf9\xbb7\xb81\x9b\xf0\x83O\x0eyp\xb6\xa0\xd2\xe6\x1cs\xbc\xc1!Bn\x0f\xd6umX\x1b\xd0\xe5,\xba\xacYjCU\x96\xac\x16\vF\xe31\xf9dB\x9a\xa7\xc4QH=\xbc\xe4\xe8\xbf\xdcJܵY\xf5W\xba\x94\xa5\xc0\x99\x8dFsK\x8b\xebz\u05fa\ro\xbc\xe0b\xcf\v\xe7\xfc\xda\xc4Z\x81\x0e!`j`I\xeeW8\xf3\xaa\xf6f<\xd6R\x84\xf3\xc7\r\x8a\u007fX;8\xcbl<e\x92\xa4\x8c#\xc9D\t2\xabQB\xb3\x9f\xc5|\xf9@\xf1\xc1\xb2\xa4\xb6\xe0Hy\xeftŃ\xa3c\xb2\x9b\tq\xac\xd8\xdf\xd9\xe1\xd1\xc9c\xd6e\x89\x92*4٦\xcfB\fj\x0e\xb7\xf5~\xcf\xd31JF\xad\xc7\xc5|\xf9Ӭ\xbbA\xe9q\xb9\xd0\xef3\xf8P\xa0g\r\xc0/r\x03\xf0(\xc8C\xe4\x8f8\x11\x87\xbb\x14\x81h\r-\xda\x06 X\xf96\x14\xa4X\f=\xac\x9b\xbd\xff\xe2\x9c\xe4oxd\tk}\xba\xdf\x15\x9e\xcc\xc0\xcb\xdf\xf9x\xdf\xcd\x1f\xec.\x90\b]\x9e%/\x10ƒE\x8a\u007f\xefs\x9f\xe1\xaf~\xe5s\xec\xe59\x8b\xb2⟿\xf5\x1e\xff\xe2\xad\xf7\xc0y\xf6\xbd\xd8\x06`8\xbf\xe68Z\xae\xb1\xae R*$\xe9\xd9s\x04\x9f\x1f\xaf\xf6o\xc0+\x97 \xe7Oc\t|\xd9Z\x80^\x11\xb1a\xdd\xf3\xc9\xf1)\x1f?|\xc4\xc1t\xe2\u007f\x8f5\xd4Z\x93E\xd1\xd6kͅ\x9dy\xd4H\xee\xb6`\x8c\x975\x01۲\xee\x9d\x1f\x85\xf8\xf2k\xaf\xf2\xc5W^\xe6\xe8\xcc_\xeb\xda\x18\xfeο\xfe=\xbe\xff\xd1=\xa2Hm@Ϡ\x9d/\xaa\xbby\x8a\xb6.\xa0\x1d\xfe\x91D\x8ai\x9e\xb3\xae4&\xc4-\xa7q40\x8eiH\u007f\xb6\xd7@EJ\"\xacE\xdb\xee\xdeP¯\x01*c\x99\xc51\xa9\x8c8)+d\x8co\x02\xe6+\x9c\x13\xc4J\xf2\xb8\xa8\xf8\x9d\x0f>\xe1\xe3\x933^\xbb\xb1\xcf\xe7o\xdf\xe0\xce\xee\xb4\xddw\xe3\xe0`:\xe6\xabY\u0087\x87'|tt\xc6\xe9\xaa\bF2\xa2\x95\xa4\xb9\xcdɵ\aϋ\xb6\x11p8c\xa8\n\x8b\xa95uh\x04\xb2Q\xceh2!\x1f\x8f\x88\xe3\xa8\rй\xea.\xaak\xfa-Ή0\xad_\xa19my\x00\x83'\xba\xe5\x82\bDRq\xb9\x944\xbc\xc2V.\xd8\xf8\x91\x102\x1c\xacs,\x8b\x92eU\xa1\x9dc]՜\x16%vۙ\x10\xae\xd1i>b\u007f<!R\xbe\xf8+S\x11YC)\x05\x95\x11dJ\xf1\xb9\xdd\x11K\xed\xa8m\x86\x00\xde>]\x90\x9f\xa6\xdc\xde\xdf'\x8d\x13\x0ev\xf6\xf8\xe8\xe1}\xe2\x80\x02 ek\xad\xbd\xd9\f\xf5\x8b\u007f\x1c\xc7d٨}\xbf\xd6\xe5\x12m\xea\x9f^\xf9w\x0e\xeb\f\xea\xf2\x0e`\x1fx\x11x\xf7Y\x99\xfd\xc5}\xa8(\x8b\xff\x96\xf81L\x9bjk\x19')\x91\x03\x1b\x0e\xc1\xb6\x10@\x1b\xe1\xab\x10\xa4\x1b{D\v\x9c\x85\x9d\xdaE\xcf@\x98\xab\x14\xfff3/\xb0\xbd\x03\xbd\x91\t\x0e\xb3\xcdEK\f\xbcJ\xc1sB@\xa4\xa8#ɋ\xbbS\xfe\x8f_\xff\x12\xff\xc1\x1b\xaf1N\x13\x1e.\x96\xfcw\xdf\xfc>\xbf\xf3\xee\x87()\xbc!\r\x9d\x8dqC\x1a*\xb5aUy\xa3\x9cJ\a\x96\xb8\xf1\xbbG~\\yN\x88J\x95\x91\xf2䩫V\xf6\xed#Օ\xf7\x10\x03W2w\xfeϤ\x10(\x1c\x99\xf4&(\xab\xb2ନPR\xb0\xac\xbd\xdbZ\x13B\xd5\xff1I\xf0D@l\xa8\x1c\x9e\xae%m_\xdfn\x9e\xf3W\xff\xdco\x11+EYפq\xcc\x1f\xfd\xf0\x1d\xfe\u007f\xff\xf6\xf7\x03\xc9R\f^r\xa2\x14Y\x1c\xf1`\xb9\xa2І\x97\x0fv\x99d)Q\x1b\x0f-\xda\xf4?\\\xf0\x8d\x0f\xaf\xdbZoS+\x10>º\x8dq\xed\xa2u\x87$HZ\xd8\x19 V\x82<\x8aZ\xb2\x9e\x92\x82E\xedy\"2\x90\xf7N˚\xa3ŊGg\vjc\xd9\x1bedIԢ(\xb1R\xec\x8fs\xa6y\x86\x92\"x\xc9ہm\xee\xe6;&\x06+\x83\x8dD:k\xbd;\x9e\xf6\x16\xb9UQR\x16\xa5\xe7\x12(\xd52\xf4\xaf|\xad4͢\xed\xf6Ȣ\xbf\xebFl\xf9\x1c\x87H\x95\xdb\xda`\xbbA|nYח:\xf5\xf9fO\"\xa4\xf49\x19ֶ{\xf6\xd3՚EYR\x1b˺\xaa\x98W5\x86\xf3\x88T\xe3и\x93\xe5\\\xdf\xdd#R\x11\x89pL\x84#\xd2\x15\xc2u2\xe8\xda\xf8%\xe8\xb5<\xe5\xd68\xe3F\x9e\xf0\xde|\xcd\xf1rM\x12E\x8cҔ$\x8a9Y\x9cu9\x00}#\xa5\v\x90\x15)\x14\xe3\xf1\x8c8J\xda\xe6\xeat\xfe\xd3O\xe2U*\xf2J\x87\x8b\x1f\x12\xf8\xd7\xfb;\xd3o\x1c\x9f\xcd\a\x9f\xc4\xdf\xfa[\u007f\xebY\xe5\xfdEA\x00~\xdcNP\xa9\x88TE\x98\xaa\x1a\xb8\xba5E\xb0\xb9\x12\xe20\xa1\xf5\x1b\xf7\x951T\x97\x15\xff+L\xfe}\x16\xbfQ\xdbC~\xfa\xfb\xaaˊ\xbfۨ\x9dƧ\x8c\xa0\x94\xe0k\xd7\xf7\xf9\u07fe\xf1:ww&<\\\xacx\xff\xe8\x94\u007f\xfe\x83\xf7\xf8\xe0\xf8\x84H*J\xed\x89`\x16\xd7\x12\xc2\b\xd3pm-:t\xee\xf2iSv.\x81F\x9b\x03l@\x10R\n'\xc3\xd4\xfa$dA\x9c\x0fc\x1fz\xba?Y\xf7\xbd\xf9s\x1a?\x84\xcaX\x1e\xad\xd6ܚ\x8c\x88\xa5$\x8f\xa2\x96\x14\x17K?\xf5n.\x19l\xf8\xbe\x91T\xad\xa9ܥ\x89s[\xff\xa4SMK\a\x9f}\xe1\x05\xae\xed̘/\xd7DJr<_\xf0\x0f\xff\xe0\x8fY\x97u\x1b\x8f\xdb\xde\fR2J|l\xae\x03\xfe\xc1\x9b\xef\xf0\xcd{\x0fy\xe3\xd6u\xfe\x9d\x97\x9f\xe7\x8bwn`\xad#K\"n\xecLy<_Rk/\xedKB\xe1\xd6Ƣ\xa4\xdfE\x9b\xda\xcb\x01\xbd\xa9\x8d\xff\xf9R\xfa\x82\xef\x9cm\xfd\xfb\xbd\x89\x91D[G,\x1d\ay\x8a[; \xc1XǣU\x81\xc3\xfb\x1bX!8\xaa4\xf5ɜ\x93U\xc1\x87\x8fO\xf8ڋwx\xf1\xda\x1eJyC\x1c\t\\\x9b\x8d\x98\xe6)\xf7O\xe7<8Yp\xb2Z3_\x97\xe8\xe0+\xbf\xf9\xae6\xcc\xfaƏ\xdfmdi\xd6u\xed\xcdrj\x8d\xaek\xca\xf5\x9aezF>\x193\x9aLȲ4\xbc.\xf7DtJ\x026\xfc\x1e\x1f\x00\xe4\xdf\x17wA\xfe@\xf3\x1e\xb5\xcd\xc0\x06\x8c\xd5\xf0+\x9a\x86k\xdbUa\xfb\x06>\xe1>\xa5\xf1\xe7W\x11Rjʪ\xe2\xf0\xcc\x1b.\xe9\xc0\xf4/\xb5\xd9\b\xb1\xea\x93\x1a\x05\xfby\xce\xc1d\x1a\xe0yK& \xb6\x16\x9c\xc1X\xd16\xb1\xfe\xbe\xf08g\xa4\x14ײ\x84\xaf\\\x9b\xf1\xdb\xf7\x8e\xb8\xf7\xf81i\x920\x1b\xe5\\\xdb\xd9\xe3\xe3\xc3\a!\xd0\xc8\x0er\x11\x9a\xdf\xdd\u007f\x8f\x93$%M\xf26 \xea\xe8숧\xb7\xd1z\xfa\xc7\x15ȅ\nx\xbd\xf9\xb8\x9f\x95\xda?\x89\r\x00\xb0\x9b\xe5(k\xa9z\x17\xa5\xc5K\xfel\xb8(\x15\xc3d\b\vTα\nq\x9bۋ\xbfC\xd5O(\xfe!\xf8\x04\xc0\xf6\"@\x85u\x03\xa5\x80o:|\xfa\x8c\xdbt\xf2;7U\x88\xf6\x1f\x05\xc48^\xc82\xbe\xb8;\xe3;\x9f<\xe4\xb7\xdf\xfe\x90\xfb\xf3\x05\x1f\x1c\x9fz\xbd\xb8\x94\x14=\x12\x9f\xe81\xb1\x8d\xf5\b\xc0\xb0\x90\xf5\x19\xb4O\xd7\x05\b!\x10J\x9e\x9b\xbaܖ\".\x94\x00\xe5\x0f%g\xe9\xacZ\x9f\xb4:\xb8\xe0\xef.\xd3|osM\xab\x8c\xe1x]\xf2xUp{:\"U\x8aLE\xac\xebrC\xd6\b}\xf4\xbeҚ,\x96\xed\xc1'.\x92p]\xf2'\x8d\xda$\x8f\"^{\xfe.\xeb\xaa\xf2\x99\x03B\xf1o\xbe\xf7}\xde\xf9\xe4A\b\xc2\x19N\xe2Y\xacڦ\xa0\xf1\x11\xf8\xe4\xcc\u007f\xd6\xff\xf2\x87\xef\xf3\x1f~\xf5\v\xfc\xe5/}\x96XJD\x04{\x93\x11몦6k,\x90\xc6\x11\xcey\a\xccHʰ\x0e\xb1!dƶ\xf9\x17\x8dU\xb0l\x8aY \x9c))\xfc\xfe\xda\xf9&@\xac+\xc8}\x13\xf9xU\x86\xee\xd1\xf3K\xe6\xdaQ\x99\x8aʜp\xb8X\xf1\xd9[\a\xfc\xd2\xf3w8\x98\x8e[Ú$R\xbcx\xb0\xcb\xde(\xe3\xdeɜG\xa7K\x8e\x16+\n\xad\xb9H\b*Z\x04\xc0\rl\xb0\x1b\x8bڪ\xaa\xd0Z\xa3\"\xe5\x1b\x81\xa2dq:'\x1f猧\x13\xf2<'\n\xf6\xe2ۮ\x99V\xcfߋ\nvA\x05\"\xb6\xd8\xddv\x05]\xb4dB\xe9\xbaМ\xb6\xf8;\x82\x99\x8e\xa5\xd2]\x12\xa4\v\xa1K&\xc85\x8d\xf5\x04\xdc֟$XD/\xaa\x9a\x8f\x8e\x8eY\x96\xd5\xf0{\xdc\xf6\xeb]\tɍɄY\xe0b\x18cȔd,\x1c\xc2\xd9v\x98\xb0\xae'|t0/K\xc6IL\x92e|nw\xcc['K\xee-K\x0eONȓ\x98\xfd\xd9.\x0fO\x8e\xa8\xb5\xf6M\xa4\xbd\xf8>UJ1\x1eM\xc3\xc7%X\x15\xab\x9f*\xf4\xcfFCu\x058\xee5\x9e\x85\x02\xfd\xc9l\x00|\xfch\xcc4\x8e\xa9\x8bb\x00\xc35.Y\x92\xae\xf8\xf7\x19\xe9\x06X_\xb8\xf7o,~//\xfe\xb6W\xfc\x1d]į\xd8\xf4\xf9o/X\xb0\x91\xec\f|\x1a\xf2N\x0f\xcek\xa6 \x19~h\x84c\xa2\x14\x13\x15\xf1\xe6\xfdC*c9Y\xafYU\xba\xb5~\xdd6\xa56,p\xe3@*\xd5\x15\xb1^F\xbau6\xc0\xa0\xb6\xe7귽\xc0J)\x11J\x9e\x87R\x9fx\x13\x06m\xb4j\x16\xa9\xe7\xbfǚ+6\xe7[\n\xfd\xe0\xaf\x18\xfe\xbd\xb1\x8eU\xa5\xf9\xe0t\xc14MH#o5\xbb\x0eŧ\x81\xc2\xfb\xeb\xdc\x06=(k\xef\x86\xe86\x10\x82\xa7yH\a/\u07bc\xc9\xc1\xee.\xeb\xb5\xe7\\<8>\xe1_\u007f\xf7\xfb\xe76\x1cR\b\xe2H\x92D\xaa'\xb5\xa2-\xe4\x9e\xc9\xef\xf8ۿ\xffm\x96U\xcd\u007f\xf8\xd5/\x10)IJ\xc4\xdexD\xa55˲j\x9b\x06m-Rxw\xc0:xb()|r\xa3\xb1\xe4q\xe4\x95\x00R\x0e\x1c\xdb\xfa\xd7!B\xb0\x97%\xa8\xba\x9b\x1eO\x8a\x1a!=\x8f \x89\x14\x16xX\xd4T\xd6Q|\xf4\x90{\xc7s\xbe\xfa\xc2-^\xbf}# \x12\xbe\x10\xcd\xf2\x8cQ\x9a\xb07\xce\xf9\xf8\xf1)\x9f\x9c\xccY\x95\xf5\xa5\xb6\xcfmB\xdff\x16Fc\xdaTk\xb46DJ\x11\xc55\xba\xaaX-\x96$i\xc6h2b<\x99\x90\xa6IW,.i\x06\xbaky\xf8Y\xbb~\xfcw\xaf'i\f\x86\x8cu\xd8\xe0\xdb_k\xe3\x83u\xb4n\v|\x83*\x99`\xda\xe3z\xb1\xe2.\xac7jkx<_\xf2\xd1\xf11\xeb\xb2\xf2\xa8\x8c\x1b\xbak\xba\x8dF8Q\x8a[\x93\t\x93$\xf5(\xa71\xcc\"\xc9N,Q.\x18\x04\xf5_W\u007f\xa5\xe2\x1cG\xab\x82q\x123Kb>\xbb;\xe6Ѻf\xbe^\xb3X\x17LG#&\xf9\x88\xe3\xf9)\x91R\x9eW\xe5\xb67\xe4\xa3lB\x14\xc5\x1d\xf1ou\xfa3K\xe0q\xee*\xe7\x86{\rD\x0eT\xcfJퟴ\x06\x00\xd8\xc92\xe8\x99\xfe4\xfb\xef\xda9\xa4\xf0\x18\x90赀\x8d$\xb0\xb2~\x1a\xba\xb0\xf8\x1bs\xa9=\xadU\xd2k~\xdb\xff\xee\xef\xfdͅ\xdf\xe3\xa4lM\x88T\x90\x9cI:+\\\xd1kb\x140Kcv\xf3\f\xf0\xfe\xfe\xa7EIY\x9b!\xc3\u007f\xcb\xfbb\xacÅ\xc8QAW\xb8\xa5\x92H\xa9\xda\\\xef\xf6\x90%4\x02a\"\xb4Ƅ\f\x05۞\xc6M\xae\xba':\xd9V\xeb\xfb$\xd6\xf5\x13'\xfd\xa71\u07bf\xa0\t\x90\x1b1\xa9\xcdC[ˣ\xe5\x9aw\x8f\xcf8\x18e\xacjM\x1d\x1a\x8eH\x8a\x10u,Z\x18\xa0i\xa6\x8aڐ\xc6Q\xfb\xeb.ra\xbe\x10\x05p\xb03\x1a\xf1\x95\xd7_\xf5*\x93\xbaF\x1b\xcb\xef\xbc\xf9}\xee\x1f\x9dxs\x9b\xdeKR\x81\x91߷Gf#\xdcNI\xaf3\xff\xef\xbe\xf5}\n\xad\xf9\x1b_{\x83D)v\xc6\x19\xd6Y\xea\xe3S\xcaZ\xa3\x94\xf2v\xd7J\x92D\x91\u05cd\x9bΔiQk*c\x99$\x11qx\xef\xe4\x86ƻ\xb5\xd3E0\x8bc\xa2\x16QZ2\xaf4\x91\xf4;\xe54\x8a\x98\xe6\x19'E\xc1Jk\xb4u\x9c\xfd\xe0}><:\xe5\x97^\xb8\xc3\xcd\xdd\x19B\xf8\xebQJ\xc1\xad\x9d\t\xbb\xa3\x9c\x83\xe9\x98w\x1e\x1eq\xbc\\\xb7\x01I\xe7\x1a\xc7\xe6\xda\n\x05\xbf_\f]o/_;\x1f\xa0\xa4\x95F\xd5\n]\xd5\x14\xeb5\x8b\xd33\xf2\xf1\x98\xc9tB\x92&\x88\x10C}Y\xd3\xeaz\u007f\xefz\x1e\x05M\xd1\xef\xaf\xd6j]S\x87&\xa42\x1ac\xec9s\xaa\xf6gn\xfc\x0e?\xdd\x1b\x96e\xc5''\xa7<\x9a/Z\x12\xe7\xb6g\u05f8o:瘦)\xd7Gc\xb2(\u00843\xe4 \x8d\x98$\xca'`\xf6V*M\xc1\x97\xbd\x86S\x00\xeb\xe0\xfexs\x1c\xf1\xda\xee\x987\x8f\x97<XW\x9c,\x17LFy\xdb\x00x\xef\x051h\x12\x9b\x1f\x92D\x19Y6\tN\x86\x92GG\x0f7\x966?\xed\x06\xe0\xc9~\x1d\xceq\x17\xdc\x1eϔ\x00\u007f\xb2\x1a\x80f\xfa\x9fD\xdd\xf4\xdf@\xcc:\xec\xdabĹ٭a\x88\x17\x17L\xffW+\xfe\xca\x13\xfbZ\xe8\u007f\xb8\xf7\xdf\x06GK)P*B\x86fD\x86\x93\u007f\x93n\xd4\x1cn\x91\x80\xdd4a'\xcb\x10RP\x19\xcb\xe9zM\xad\xb7\xecO{֤MԱ\x97\xec\xb8V\x8e'\x95DɈ(\x8eP*FE\x9e@\xa3\xa2\b%\x95'\x8e\xb5\x848Ѳl\x8d1h]c\xb4\x0eH\x80\x02|\xccn]W\xe1\x9f24\v=\xc7\xc0m7\xa5{zx\xff\xdcTx\t/\xa0\xd1)\x0f\xc9Z\x8e\xd2h>>[\xb41\xc7\xdd\xf6E\f\xae\v\xd9\x16\x1e\xe1\xf7\xafڐ'\xaa\xcdI\xbfzc\xea\x88\x10\xbcr\xe7\x0e\xd7\xf7\xf6\xd0\xc6\xdb\xe5>x|\xc4\xef\xff\xe0\x9ds\xb1č\xc9J\xaa乷Kld\xdd7\xab\x9d\xbf\xf7\xdd\x1f\x82\x83\xbf\xf1+_\"U\x8a\xfd\xc9\x18m\x1d\xf7\x1e\x9f`\x9d\xf7;0\x02\xb28\xa2\xd2\x11\xb5\xa9|\x11\x95\x82XJ\x96uMm\f\xb3,!\x8b\xa2\xe0\x8f\xd1צ\v\xa4\xf0\x9c\x01\xeb,\xa3(B\xe6\xfew\xbf\u007f\xb2`\xa5\r\x12I\x15Д\xfdɈ\xb3u\xc9ǋ5\aYBu\xff\x88\ag\v\xbep\xe7\x06\x9f\xbfs\x93I\x9e\x85\x89\xd9#\a/]\xdf\xe3\xfatĻ\x8f\x8e\xf9\xe0\xf1)\xeb\xaa\xf6M\xab\xdbN\x13lH\x99\xd6ٮ\x11hz\xc1\xc6\xc3\xc1jo\xa5\xab}\x13d\xaa\x9a\xb2\xacX-\x97\xe4\xe3\x11\xa3\xf1\x88$I[\xa2\xaa\xd8zy\x8a\x9e}\xb8m\x8bL;\xb1\x87\xe8hc\xad/\xfe!_cs\xbd\xe5\xdc&\xca\x00\xdaX\xaa\x80\x0e\xd4Fs8_\xf0\xf1\xf1)\xab&\xb2|[\x85\xdb\xf8\xec\xf7\xf3\x9c\x83\xd1\b)D(\xfep#\x8f\xc9#\x89u\x04\xc4\xc5m\x0f\"r\xc3\x1f}\xba.\xd8\xcd2v\x92\x98\x17g9\x0f\xd6\x15\xcbuAQ\xd5$q\xe2\xd1\xc5\xe0Bh6P:)$\xa3|\xd2\x06m\x9d\xceϰ\xb6\xfe\x89\xf9\x87\\\xb9\x03x\xf2\xa1\xb1c\xb4\xfe\n\xf0\u07b3R\xfb'\xa9\x01\x00\xbf\xfb\n\xa9Y}\xdb_\x1f\xf6\xd3ْ6Ǫ\t;\xf1ҹ\xad\xc4?\x19b}\xcf1\xf1z\x0f\xa3\x14\xb2)\xfe\xceyؿ\xdd\xfb\xdb\xc1\xde_6\x01#~\xc4k\xfd\xd2Ŗf\xa6\xbf\x9e\x88\x84`?K\x98e)\x02(\xb5\xe6t]R\a\xbe\x82\xb5\xae#\xf6\x88\xa6\x91\x10\xc1\xeeX d u%\tq\x92\xfa\x9bY\xc5H\xa5\x02٨\x0f\xe5\x8b\xe1\xd4׳\xfd\x94H\xcf\xec\xcdF(\xa9\xda|\xfa\x86\x04Մ\x87Ժ\xa2,֔努\xf2\x0ew60\x8e\x9f\xb4\xdbo\x1b\x80\x1fer\xd8@\x03\x1a)\x95\xe9\xed^\x9bǪ\xd6<Z\xae\x99e\xa9w\x02\xb4\r!\x8b\xf6s\xe9\xa6\t\xff\xfe\xae+M\x1a\xa9-9\xf5O@\x01\x9cc\x92\xa6\xbcx\xfb&\xfdt\xc1o\xbd\xf7\x01\x8f\xcf\xe6\x03\xe2_\xf3\x13#y\x99\xf1\xf4y\xb4#\x92\x92\u007f\xf4\xbdw\x18\xa7\t\u007f\xed\x97>O\xa4$\xd7g\x13ʺ\xe6\xe1\xe9<\x84\xd1Xb%\x18%\tE\xedÜp~E \xb5_\a\x1c-K\xf6ǂ4\xac\x1e\xbc\xa1\x91\u007f\xceM\xacp\xac$\x956\xe4Jqs\x94\x03\xf0\xdeɜR\x1b\xa4PTF\xb3(`7\xcfXG\x11\x8fVk\x8f28\xc7⽏\xb9wr\xc6W\x9e\xbb\xcd݃]b\xa5\xdad\xceQ\x9a\xf0\xc5\xe7orkw\xca;\x0f\x8fxx\xba\xec\x11YiS\xed\xfa\x92V\x15b\u007fm\xcb\xd0\xec%\x00\x83\x87ڭ\xff\xb7\x94\x06\xa9kL]S\x975\xc5jM>\xca\xc9\xf3\x11I\x96\x86\x9c\x81\x9e\u007f\x83\x10\x83\xd5K#\xa4t\xbd\xe8ʶ96\xa6\x85\xf6/\xe2\x194D\xe4\xda\x18VuE\xad\r\xdaZ\xe6\xeb5\xf7\x8eO9Z.7,\xc77N\xb8^Ty,%\xd7Fc\xa6iھ~\x01\x1cd\xc3\xe2o\x9c\x1d\xd8X\x0fV\x19\x1bj\x93u\xadYT\x15\ay\xc6KӜo\x1eΩ\xb5fU\x14đG\n\x9d\xb3(\xa9\x80zp\xf6\xa6Q\xda6\xafe]2_\x9e\x92\xc4?\xdbU{\x83Z\n\xa1.\xa9\xffB\x1ak\xfe\xa3k\xbb\xf9\xdf?<Y\xb7k\x80\xeb{#\x00\x1e\x1d?\v\v\xfc\xd45\x00~\xfa\x8f\x18I\xe5\xcdNz\xfb\xb2\x06\x12kJ\x8a\xecO\xfe\xc1\r\xad\xdc\xc2L\x17&\x14\xff\xcbv\xfeJzc\x8f\xa6\xdb\x17\xa2\xb7\xf7w\x9e\xf5\xdf\xecP{\xbe\xe3\xa2\xf7u\xdb^K\x9f\x90&\x81I\x1c\x93\a\v\xd4J\x1bN\xd7\x05\xb55\xc4QL\x12\xa7di8Ē\x9c,MI\x93\x8c8N(\x8dfQ\xac(\xab\x82\xaa*<+\x98\x90\xf0e-\xdaT\x18S!\x95B\xa9\xc8#\x02*\xf2+\x01)\xdaUA\xbf\x12\xb5.`!\xa1M\x8a\xc0a\x10\x9dŋ\xc3\xc1\x8c0\xe1i\xaa\xaa\xa4,\xd7\x14Œ\xa2X\x85\x86@\xb7\x01+[\x83].\xd9\xef_\x8a\bl\xa0\aB\xfaC\xd3Zw\xee\xb0XV~\x12\x8f\x031\xb1\xc9[w\x9b\xe7nx\xdd\xdaX\x8aZ3J\xa3KmG\xb7H\xc1\xb9\xb9\xbf\xcb\xeet\xd2\xfa\xd9\x1f/\x96|\xfb\xbd\x0f\xcf+\nZ\xefx\x81\xb6\x8eH^\xcd\x0f\xa3\xb1D\xfe\xff~\xf3M\x8c\xb3\xfco~\xe9\v\xc4Jr{o\x87Z\xfb\x9d\xb2\n\xd3\xfb8\x8d\xa9mF\xb5X\xfat>\x04y\xa4<\fm,\xa7뒽QF$EX\xeb\b\x1cފV:A\xa4\x1a.\x81%\x96\x82\xdb\xe3\x1c%\xe0\xed\xa39\x95\xb1DHj\xa7\x99\x170IS\xb2ٔyYroY\xb0\x97\xc6T\xe6\x94Z\x1b\x16e\xc5\xed\xbd\x19\xbb\xa3Q \x1b\xfa\xca}m:fo\x9c\xf3\xe8l\xc9\xfb\x87'\x1cΗT\xda\xf8\xfd\xfa\xa6C%\xdejW\nZ\x0f\x04\x9f-\xc0\x00\xdd\xf1\x12H\x83\xb4\xd6\xcb\a\xab\x9a\xba*)VkN\xa33\xb2Q\xce\xce\xee\x0ey\x9e\a\x98\xdcB\xb3\x0e\x1ad[\x89ޥ\xe9\xda{\xd6n4\x98\xc6\xd8V}c\x9dm\xdf\xdbf\xda/*ͼ,x\xbcXr\xbcXQ\x1b\xddJ2\xdb\xd7\xd6\x0f$\"\xacۄ#W1\a\xa3\x11i\x14\xb5\xc47\xeb`7UL\"\x15P\x10\xff>ā\x13t\x15$\xcd9ǲ\xac\xc8T\xc4n\xac\xd8\xcfb\xee-JVŚ\x9dɘHI\xb4\xf6fE\xa2\xb7\x82\x88\x94?\x87\x9c3T\xba\xe2\xfe\xe3G\xe4\xc9Ϟg\xe7\x82\xea£\x92\x97\x0e\x18\xff\x9e\x10\xe2+\xc0\xef=+\xb7\u007fB\x10\x80i\x9c {2?_仠\x1f\xdb+\xa8M\x8a\x96\v\xbb\xffMͿ0\x0eeL\xeb\u07ffm\x8f\xd5z\xfa\xf7\n\x8b\r\xe1'\"\xa0\a\x1dyj\xe3{7\\˚\xee]\t\x1f\xdb\x19\t\x89\x142\x14'E\x16\xc7\xc4YF\x14%\xc4*b'\x8aɒ\x8c\xd9dF\x96\xe6$a\xaao\x8bw@;\xb4\xb5\x1c\x9e>f\xb1^bL\xd0nK\x89\xb1\x86\xaaZS\x94k\xaa\xc0\x80o\x8a\u007f\x14\xf9HP)\xd5\x067@\x9c[Mt\u007fޟ\x86\xbb\xf1\xc89\x87T\x8a$ɘN|\x9a\\]\x97\x14\xe5\x8a\xd5z\xc1j9g\xbd^\xb6M[\xa7\xbf\xf6\x93\xa65?\xda\xfeps\x85\xe0W\x01\xee\\y6\xce2/*\xd2P\xfc\xac\x1b6a-j\xd0\xfb֢\xd2d\xb1z\xaaP\xaaDJ\x9e\xbb~ݣ2\x81\x8c\xf7\xe6\x87\x1fsxv6\xdc\xfd7\xcfW6\xf0\xb6ù\xf3\xc66\x17\xfdj\x19\x18\xfb\xff\xed7\xbe\x87\xb5\x8e\xbf\xfe\xcb_$\x8b#\xee\xee\xefR\xd45\xc7\xcbuH\xa9\x13̲\x94\xaa֜\xae\v\xac\x83X*2eYYωX\xd55yc\xac\x13\x10\x90fҬ\x83c\x9d\x92\x02c}\x94\xf6\xad\xb1\x87\xa1\u007f\xf0\xf8\xcc7\x06JQ\x1bâ,\xc9ӄY\x96\xb3\xaej\x1e\xac\n\xce*\x85v0\xc92\xaaZs6)\xb86\x9b0I\x13\x9fT\x17\xb88\xb7v\xa7\\\x9f\x8d9\x9c\xaf\xf8\xf0\xf1\t\x87\xf3\x15eݙ\x1dٍP\x1d%$R\xf6Hu\xa2KN\xecsa\x04\xc1\x10\xccZ\\Yb\x1d\x9c\x9d\x9e\xf1\xf8\xf0\x88ݽ]f;S/!\x8c\xa2\xb0\x02\b\x01J\xb6c\xe9\xf7\u007f\xb3\bv\xbfRJjSq\xb2\xf2ϳa\xf3\x9b\x90\xd8W\xd45ˢd^\x94,˒R\xd7\xc1_\xa1\xf3]\xe8\xf3\x03D@v\x1a>\x86\x14\x82Y\x9a\xb1\x93f\xfe>\xeeI\x9a\xa7\x89b?\x8d}\xbf\x12\\+e\xb0\xb4n}\x8a\x9fx\xc9\xfa\x80\xaa\xca\x18b%y~\x9c\xf1\U0006292cj\xeaڄ\x06\xc4\xf6<N\xfcsO\x92\xb4E\v\x8fN\x8f\x89\xe4\x8fF\x92\xfdI<\xaeb`\xa6\xa4\x9a\b\xc4\xff\xf5\xdan\xfe\x9f\x1e\x9e\xac\x9fq\x01>\xcd\r\x80\x03\x12\xe5\xa7\xff\xbe\xe6\xb6q\x0f\x13\"\xa4Ѕ\x1b\xc8ፀ\x1a\xf2_\xb9Y\xfc\xadC\xf6\xec.\xb7Z\xf6\xaa\xb0\xab\xef*\x06.\x18\n5\x04\xbe\x8b\xfa_':ӟ\x06\xea\x8fUD\x96$dIJ\x1a\x8ay\x12'\xa4IB\x96d$qF\x14E\xa4I\xc68\x1f\x93%\x19JzHN\xb5\xd0\xc2\xc6\xe4\x8b\xdf\xd9*\xa9\xc8Ӽ\x8d\x15u@\f\xa4q\xc6x\xb4Ӣ\x01\xbd\x11\xfa܂\xbe\x91\x85u\xe2\xa7^\x15\xda\xcc\xe0E\xa0uE\xd5\xf2\x00:\xd8_\xca\b\x15\xb2\xc1ǣ)v\xff\x96\x9f\u008a\x15\xeb\xf5\x82\xd5jAQ\xae\xb1ƴ\xab\x8c\x1fՔh3\fF\x05&\xfc\x90\xb7\xe4\xa7\xceu\xad\x03ⱉĄ\xf7\x95N\x15\xa0\xad\xa3\xa8\r\xa3Tp\x15&\x80s.D,\xcf(uM$\x15\xa7\x8b3\xbe\xf1\xf6{\x83UO\xbf\xb2\xcb~P\x8d\xb8\x90\xf9p\xe9:\xe0\xbf\xff\xd6\xf7q\xc0_\xff\xe5/2\xce\x12\xee\xecﲪj\xe6E\x89\xc0\xef\xddwFY(\xd2\xde/#\x8f\xa3V\x1aXԆX*\xef\x1f :h\xdc\t\x9f=Q7V\xb5B\x84b\x037\xc79J\b\xde::\xf3\xd1\xcfN\xa2\xadaUVdqL\xa2\xbc;\xe1Z[\xde=]b\x85䗤\xc4\xe2X\x955\xbbcO\x06\xcc\xe2\xa8-\x9e\x02\xb8\xb93\xe1`:\xe2\xf1bŽ\xa33\x0e\xe7K֕\xf6i\x82Ɲ+\x9a\xb2)\x82\x8d\x14o\xe32\xf2\xa6?\xb6\x97F鿡.K\x1e\xdc\u007f\xc0Ç\x87$i\xc2h\x943\x99\x8c\x19\x8dr\xe28\x0e\xa4\xc1PеiɊ\x0e/k\xad\x8d\xe6\xde\xf1\t\xf3\xb5\x0f/[\x97\x15\xa7\xeb5E\xedC\xb6\xaa\xa0\x0e8\a\x1f5\bBS\xd0\xdb\xc2/[\\a\x1c{\xfeO\x16\xa6~\xeb|\xe3\x15+A*%;\xa9\xea\xe5\x99\f\xd7`\xde\xc3\xfa\xfcR\xed\x1ca.\xa0(\rg\xe0\xb9IJ\xf6\xd8\xf3:*]#D\x83 z\xd4\xc5YM\x1c\xa5D*B\bo\xae\xa5u\xf53\x87\xfe\a+Y\xf7䜟0\xd8\xfcE\x1c\u007f\xe6\xdan\xfe?\x1c\x9e\xac\xdd\xcet\xefY\xe5\xfdt\"\x00\x8eY\x14\xa1\x02\x93\x96\x1e\xc4\xef/\x88!\xfb\xb6\x0e7\x9b\b\xd0\u007f\xdfP\xa3\x91\xeb\t{\t+X\x86\xb8^\xd3\xc3\x17\x85h\v\x88pCT`\xb3Yq*ؤ\xe2ȓ\x8c\xdd\xf1\x0e\xd3ɘ<\xcdH\xa28L\xe1\x11q\x94\x10Gq\xd8;\xfaC.KR\xb2$\r\xde\xe2\x16k,2J|\xfe\x88\xe8\\݄\xf0\xc9n\xc5jA\xa9}\xc8M\xd46H\xb6\xf1\vA\xe0'\xff&\x16i\xb3и\xde\x01\xd5\xed\rþ\xd1\x05\xac\xa3]\v\xf8\xff5VST\xbe\x88;l\xbb\xf7t8\x9c-z\xef\x97DIE\x1c%\x8c\xa7;Lg{Xc(\x8a\x15\xcb\xe5\x19\xabՂ\xb2ZS\x95%.0Ư4U\xf4t۾X\xf9HU\x81\x0f\xc51\xc6n-\xdb\xdb\xd8\xc3\xceq\xfe}\t\xbb\xd2,V\x9eTy\xc1sjc\x85\xc2n[)\x895\x16\xed\xe0\x9d\xfb\x0fxpr\xdaN}C8\x9b!\x17\xa3\xb5\xcc\x15W\x1e\xaa\xa4\x1fI\xf9;\xdf\xfa\x01J\b\xfe\xfa/\xbf\xc1\xfex\xc4ݽ\x1d\xde}tD\x19\xf4\xdcY\x1c3\xc9\xd2\xe0\f\xa9\x91\x02FI\x84.+jc(\x83M2\xd2\xf5\x02\x97<\x99\xa5\xe1\x9e8\xe1\xf95Nx\x1f\xff\x9b\xe3\x1c%%?8<\xa10\x16\x85D;\xc3\xd2ږX\x1afG\xde=\x9eSh×o\x1dpg\xea\x1d\f\x17Eɵ\xe9\x84\xddq\x1e\x10\r\xd7~~7f\x13\xaeMF\x9c\xae\n\xee\x1d\xcfyx6gY֭\x96\xde\x06v~\xdf\xc9Q\t\xff\x19X\xfa\xb6\xbf\xe7w\xc7\xcd>_\xe1\re\xca՚\xf5r\xc5\xe3\xc3#T\x14\x91\xa6\ti\x96\x92\xa6)I\x9a \x1b\xeeB \xff9kY\x14%\xc7˥W\x01h\xc3\xd1bAQ\xd7>u\xb4W\xdc7u\x016\x9c\x1bJ\xcaV\x8aٜ\x11Y\x143K\x13\xf2(\xc6\x05>K$\x05\x99\x94$\xca7;\x99\x92-aS\xd2\xf9\x11\\\x117\xc7\xf5\x9aw\xdf\x14\xfb\xff\x9cŊY\x12\xf1\xb8\xa89[\xad@*\xac\xf3\xc4\xdf8N\xa9uE\x12\xa7H!\xd1\xc6K\x1e\xe3\xe8\xe7+\xb1w\xf6\n\xe4a!P*ʌ5\xff\x19\xf0;\xc0a\x9f\v\xf0\x8c\a\xf0)i\x00\x1c\x90I\xc5HEmg-B9knt\xd7밫\xd0 \x88\xc0\x98\xad7d\u007f\xc2\xd8'\x16\u007f+e\x97\xfe\xd7\xfa\x82\xf7\xb2\xe2\xb7iQ\x1br\x92\x94\xd4\x1e\x82\xe2\xe6\xde5n\xec\x1d\x90'\x9e\x84\xd6\xc0\xf0qh\x02\xbc\x8d\xabE\xe0\x90R\x92&)Y\x92\x81\x13\xedj\xa31BQR\xf5bW;\x1f\xfc\xf9zIUW\xed:a\xa0J\xeb\x1dJ\xdbY\xf7\xe2\x1c˾\xf9\xf1\xaei$\x1a\xdf\x00\xba\xa8\xe5Z\xd7Ժ\x1c6\x1b\xae\xb3\xa3m\f]\xac1\x18]SUE\xab&P*\"N\x12\x0e\xf2[\x1c\xe0eUE\xb1\xe4\xec\xf4\x88\xd5j\x811f\x8b\xc4\xf0\xe2\\\xc1\xb6\b\a$A6\b̶\xc3х\x95\x91\x10O\xcc\x1a\xd0\xc1\x8dmt\x85=\xa7\x14\x82,I|R\x9e1غ\xe6\xa3\xc3#\xb4\xb1D=\x96\u007fk=\xdb\xcb1h\x9a\x19\x87xj6\xb5\fp\xd4\u007f\xff\xad\xef3Jb\xfeW_\xfe\x1c\xd7g\x13\xd6U\xcdG\xc10*\x8b\x04\x934\xf1D\xbb0\xd5GJ\x91\xc71˲f]kb%\xc1\xfa\xa2\xa2\x02\x12P\x1bK\x14\x8a\x95\x0f\x1c\xb2D\x91_\tX\xeb\xb86Jq\a;\xbc\xf9\xf0\xc4{:H\x81\t\xafAI\xd9N\xf6\x89\x92\x1c\xad\n~\uf8c7|fo\xcaK{3\xf6\x9d\xa36\xa7̋\x82\xeb\xb3\t\xa34iw͞y\xee\xd8\x1dg\xec\x8c2\x9e\xbf\xb6\xc3'\xc7s\xee\x9f\xccY\x96U\x80ۃ\x16\xdfu\x00\x92\xec%\xf5\xbav5 \xba\x04\xbf\xde.\xbf\x8b*\x16-\x01\xd4\xd55\xab\xaab1\x9f\x87\xcfB\xa0\xa2\xc8;^\x06\xb3/!\x15k\xad)J\x9fҷ\x0e\xaa\nKgPӅ\x1b\r͌\xfaI\x8c\xcdߏ\xe3\x98i\x9a\x92\xa9\xb0\x86\xc0\x11KȔ\"Q\xfe{\xc0';\xa6\xaa\xf9\x99\x82\xe0\xe44\x90Gn\xf3\xc4\x18\xdc==C\xb0\xe6\xffkk\xd1\u0590\x06+\xf1\xa2\xaa\xc3\xf5\x19\x11E\xfesO\xe2\x94$I\xd1Z\xf7\xac\x8b\u007f\xbe\xc5\xe3j^\x00\x10G1\xb5\xae\xfe\x1d\xe7\xdco]\xdb\xcd\xff[\xf8\x19\xea\x15\x9f=~2\r\x80\x00\xa6Q\x12&\xe2\xae\x18\xb7\xa6\x1c=\xe8zm\f\x85\xb5\xc1\xfe\x17\xf4\xc6'.\x8dE^\xb2?r\x81\xb8'.\xf9\x1aᶯ\xdb\x1a\x9f\xf1R\xc28\xcb\xf9̭\xe7؟\xcc\x02d\xe7m8\xe3\xc8\xef\xf0\x1bx\xb2\xb14N\xa2\x844\xec\xf7\x85\x14^vԮ\x10\xfc\xff\xc8-\x84Bk-q\x14\x91\xc6\te]\xf5\xd2\xcb\\\x90\xf9\xd1\xee4m\x10\xbd\r\rg\xb6\xc3͋\xe5\te(\xda\xfd\xdf\u0558\a9\xdbx\xbc7\xf6˶#6\n\x19~\xb7\x877\x9b\x06\xc6\xe10F\xa3uMQ\xae|\x06\xbaT(\xe5\x03E\xf2|LU\x16\xcc\xe7',\x16\xa7\xe8\x00G\x0e\xe6\xf3'2\x9dD@\x01\x82'\x82\xdb\xdeQn\x93Lmݕ֚<\x8e\x9e\xf8\xb5\n\xc1$\xcf=\xbaa|\xf8\xd0\xc3ӳ\xf3\x93\u007f\xbfpo\xec\xfa\x9f\xa4m\xbe\xac\t0\x0e\xfe\x9b?\xfa.\xe34\xe1\u007f\xfeٗ\xb96\x1bSj\xcdó\x05\xb5\xf5\xbb\xfaI\x96\xfa\x00(\xe7\vh\x16\x12\x01+mX׆q\x12\xb5zr)<1\xb06\x06B¤\xb6\x16\x1d\xf6\xfeJ\n\x8c\xb3\xdc\x18g\xd4\aS\xbe\u007fx\xd6ރ\x9b\x06UQp\x1a,\xb4\xe6\xbb\x0f\x8f\xf9\xe8l\xc9\v\xbbS^\xd9߁Ptf\xa3\x8c\x83\xc9؛\f\xf5\x8ct\x1c0I\x13^\xbb}\xc0\x9d\xfd\x19\x0f\x8e\xe7<8]pV\x94m\xa6\x85'\xf9z\x1e\x85\x10~a\xe3D\x17\x1d\xdb*\x06\x82\x8b_\x1f)\xd8&)m\x8a\xaau\x0e]U\x1d\xf9\xaf\x95\x04\xc2Ț\xf6\x8c\x90a\xa5\xd2<\x8f\x01\xfa \x84G'\x18\xeeӳ(b\x12'\xad1\x93\xbff\x05Q\xf0\x86\xe8/\xe8|\x94u\x8f\x81Ӯ\x02\xed\x15\x17F\xc3k\xbfA\x10\x9b\xd3d\xad\xed9ä\xbe4x<\x9a\x12\xc7\t\xeb\xa2\xf4\xabUm\x11\xc2\xfe\\Q\x80\xab\xaa\x87\x94\xe7Ke\xc6\xe8\xff\x14\xf8\xe7\xc0Q\x1f\x05\x80g\x8a\x80_\xd8\x06\xa0\xb9\x11s\xa9ȕ\x1a\x10?\xfa\x89\u007fMC_ZK\x11\xbe\xa6\xde&ѱv\xab^\xbf?Kz\xa3\x1f\xd7\xc6}\x0f\xb8\x01\x01\xae\x13\x9b{\xc6\xde\xf3qRpc\xff\x80\xd7\xef\xbc\xc4l4\x0e\x05\xac\xb3 m\xb4\xcbRH\xa28!\x92\x8a8\x8a\x89\xa3\xb8\xbd\xb0\x8d\t\xc4B'}ڡ\x18B\xd8}$\xc2\x01\xa34'\x8d|\x03\xb0.\xd7T\xbaFʸ_\x9dZ\xc9d\xe3Bf\x9dm\xffݬ\x1e\x9a\xe2\xadu\xcdru\x86i<\xb7\x9d\x87Kݶ\n\xbc\xd1\x05\xb9F\x97\x1d\xd8\xce\xfe\xb0Q]v\x00=\a6\xebж\xa6\xae\xeb`\x97\xec=\x13f\xb3=F\xa3)g\xf3cV\x8b\xb9\u007f\x8eb㢸삡s1\xb4[\xcdfBCӆ\x02]\\Xk\xe33\x02\xd2\xe8\xf2\xd5D$\x05Y\x9a\xb4OmY\x94\x9c\xaeV\xe7\u007f~\xd8[w\t\x82n\xb0\x86\xf9QiUJz\x0f\x83\xff\xfa\xf7\xbe\xc5,M\xf9\xfa\v\xb7)j\x8d\xb1\x8e\xa3\xc5\n#,y\x1cS%^\x92\xb6\x0e\x93\xde8\xf1\xa8Z\xa95i\xe4\x9d\a]\xdb@\x8a6TJ\xe0\xdd'\xb5\xb3`,I$\x91\xce\xc3\xc8wfcJcy\xfbh\x8e\xefQ\x87f\xbf&ܠ\x8d\xa6\u007f^V|\xfb\xc1c\xde=\x99\xf3\xea\xfe\x8c7n\xec{fzQ\xb1?\x1d1\xcb\xf3 \xd5s\xed\xe7\xe4\x1c\x8c\x92\x98\xcf\xdc\xdc\xe7\xd6\ue507g\v\xee\x9f.8[\x15\x9d\x840p\x01Zd\xab\xc7`\xef{>\xfb\xe9\xde\xf5\x9a\xc1-\x85\xa5w\x1d!\x04\xb2\xb1\xfe\r놉\x8c\xc9Þޓ\x15\tM\x88kW\x1f\xcdOkѱ\xe0\xe0\x19+E\xdcK\x03Tb\xd8\x10\xba\x1e\xaa)\x85GP`H\xdd韅\x036\xcfE\x8e\x99\x83\xeb\xbaC>\x9a\b$\x1dP\xbcM\x17\x01)%I\x9cR\x94\x95?\x8b,T\xb5\xf6\x9e\x17J\xfeܐ\x80\x06ټ\x8a\xd48\x8eb\x8cѿ\t|\x1d\xf8\x87\x00\r\x17\xe0g\x11^\xf4\xecq\xd5\x06\xa0G\x9ai\x9d\xf2\x80Y\x14\x0f4\xffB\xf8\xc3\xce47\xbc\xb3\xad\xbf\xff\xc5S\xbb\xf3\xb0\xffen`M\xf1\xb7ns;>\xd85\xb8\r{\xcd\xe6y\xe5Y\u00ad\x9bwx\xf9\xeeg\x18\xa5\xa3\x90]\xeeo^c5U]\x81\x80(0\xf1\x95\xf2\xc5_ɡ\xe9\x8cu֏\xb0\xce!\tY\xf5\xd23\xd2U\x14\x03>\xff\xbda\fG*B\nI\x12'\xcc\xc6S?\xc5YKY\x97,\xd6KO\xeci4\xfe\x8108\x906\xb5\f\xe6f\x8a\x97(\x15\xb5\x13~3il5\xfa\x11\x9c\vI\x19x\xad[\x8b\x15\x06L\xe7((\x03\x94ڠ\x03B\xb8\x16\xbaԺBS!\x84b6\xdb%\xcbF,\x97\xa7\x94E\x81ufKy\xec\xc5\xf2r>\xbcD\x06(\xfa\\\xef\x12N\xbb'5\x018X\x97uH\\T\x17\xeeW\xa3(\xf2\x16\xb8\xc6\"\xa5\xe0d\xb9b]U\x83\x03\xaa\xfd\xff\x1b\xf1\xc1r\xe3z\x92\xe2G\xcbȌ\xa4dY\xd5\xfc\x97\xbf\xf3G\xc4J\xf2ś\xd7\xc2\xc4\xefS\xe6\"\xa9\x18\xa7\xb1w\xaf\v\x86G\x91\x10\x8c\x93\x98\xb3\xb2b]\x1b\xa2\xd4_w6\xe4\x05xt\xc1RhG\x16G\xed\u007f\xd7\xc6\xc3\xd2\xd69\x9c\x85\x97v'T\xda\xf2\xc1\xd9\x12\x15H\x0eM\x03\xd64\x01\rϡy\xbd\xab\xb2\xe2\x0f?~\xc8ۏO\xf9\xea\x9d\xeb|\xee\xfa\x1e\x8fN\x17,\xd6\x15\xfb\x93\x11y\x1a\x0f\xf8\xa1\r\"\x90Ɗ\xe7\x0ev8\x98\x8e8^\xacyp\xba\xe0x\xb9\xa6Ժ5\x15r=\x94\xc75&?\xe1\xf7Z\x1a\xbf\x81\r\xca\xc5F\xae\x93\x1a\x14c\x11\xc8sn0\x99+!\x88\x91X\xd5=\xbf\xc15\x18>\xf7(x+\xc8@\xda\x15b\x93\x86+z\x97\xa5kܳ\x89\xa5G\x106\a\x8e\xcb֥\x975\xc7\xfe\f\x90A\xe2'\u009a\x14\x96\xb5\xa6\xe9\xdd\\\x0fnS\xd2_\xd3U\xad\x03\xb2\"Z\x96hQ۟\x8b\f\xb0\xebs\xae\xd6.\xc7QLU\x97#k\xed\u007fr:?\xfe\x9fv\xa6{\x8b\xfe\xdf?\xe3\x03\xfc\xbc\x1b\x80-\x0ezQ\xf8`3\xe9\xe1\xc7\xcd\xe9\xdfXӲd-\xb0\xb6\xf6\xf2\xcba#\xa4\xe7\xdc_7]\xb6\xed[\xf3n\xbf\xc1Z6rx\xdei\x12\xb3\xbf\xbb\xcf\xdd\x1bw\xb8yp\x8b8Nz\xaf\xa9G\f\x922\xec\xff\xa3@\x8c\x8b[#\x9a6\x95.\xb0ҕ\xf0\x13s\x9ed\xdcػ\xc6\xeel\x87\xd3\xc5\x1ck\r\xda\x18\xcf\xc45^\x82\x94\x04On\x17(\xdc\x02\x88\x1cđ7\xff9[.\xbc\n\xa0\xf5<\xef\x17I\xe9=\xd5\x15]b\x99\xb3\xa8\xfd۬\x8b\x15\xb5.\xa9\xebʻ\x02Z\xddS`\xb8\x81\xc7\xe8\xc0\xbb}C1\xe0B\x93f\x01!,V\x00F\xb7IlBȶ1\xa0\xd7\x04YcQ\x91d:\xdb%\xcfk\x8abEY\x95\x18\xbd\xe9Q\xde4\x11\xc3S\xb0iXT\x83\x04\\2M\\4I\b\xd1(\x024i\xe4#{ݖkB)\x15\xfc\xd3\r\xca\tΖk/\x9d\x93C\xf6\xb5\v\x13d\xff\xf2\xbaȼ\xe5Gi\x02b%9^\xad\xf9/~\xfb\x0f\xf8?\xfd\xa9\xaf\xf2\xf9\x1b\xfb\xad7\xc2|]\xa0\xa4d\x94$\x01>\xf7\x8db\x1a)r\x1b\xb1\xae4u\xa4\xfck\fM\x80\x10 \\\b\xe3ц$R\xc1\x11\xcf!\xf0H\x95\x0e:\x89\x97\xf7\xa7\x14\xda\xf0pY\xa0d7=\xbb\xb06\xd3X\"!\ak\x90D)Ί\x8a\u007f\xfa\xc3\x0f\xb9?_\xf1k\xcf\xdfD\x00\x9f\x1c\xd7LG\x19\xb3QF\x1c2-\x1aŏ\r(Z,\x15צ>ypQ\x94<:[\xf2x\xb1\xa2\xa8t \xe4\xf5JD0\x9d\xb2\xc1/D\x85\xf6\xbeA\x17l\xe0\f\xc8^!\x1e\x14\\\xe1\xf3E\x84\xdbΣ\x918hTC\x82\x9eۧhY\xfb\xa2\xbd\x1f6\xcf;\xb1q-\xf8\xf7=\x96\xb4\x1c\x00\xf7\x84\x02\xef\xb88$g3mYII\x12\x94ER\xc0\xa3uɺ6\x81?$\x02\x997\xf0*\xa4\xa0\n\b\x9d\x90\x9e\xe0jC\x93\xa5\xb5\xc4(\x87R?\x0f\x18\xc0{/(u\x85\x15\x99TD*\xa6\xb2\xe5_\x00\xbe\x06\xfc\xcbg\xe5\xf7\x17\xa1\x01\xd8d_\xf7\xa6\u007f\x15\nY\xa6\xa2\x8e\xc1\x1a\x0ej?\xfdw7\xd2ڜ\x8f\xcd<w\x11\xd8!t?\xb8\x94\u0085\xdf\x18\x02\x89K\xa0\xb4>\xcb7N\x12v\xa6Sn_\xbfō\xfd\x9b\x8c\xf3\xc99I[shI)IU\x8a\x12\x9d+߰\xa1\xf0\x93_\x96\xa6\x8c\xb3\x11y\x96\xf1\xc2\xcd\xe7x텗ٛ\xed \x84䏾\xf7M\xee?~D\x1a\xa0\xf4uY\xb4ӹ\r\x06(=\x8a\x04RH\xb2$\xf3\xf6\xbd\xc63\x98\xfd\xa4oB\x18Ж\x89E\b\"\xa1\x88\xf3\t\xe3|\x8c\xb1\xde\x16ؓ\xfe*\xaa\xdaK\xff\xaa\xba@\xd7U\xb7&8w\xa0\xb9\x01\x14\xebߗf\xdd\xd04F\xd6\xeb\xdf{y\x03J\xa9v\xef\xdf\u007f\xbf\xa38f\x1cM\x89ʄ\xf5jI]W\xdb\x04\r\xed\xfews\x9f\xe9\xa7ы\xfd\xe0/+\xbaM\xc2]\xa9\r\x0eG\xb2\x99E\xee\xf0\t\u007f\xc2s7\x9c\x11\xac\xabrۥ\xbd\x05\xb3e[\x04}\xa7\xa2\xf8\x91\x9a\x00\xc5ɪ\xe0\xbf\xf8\xed\xdf\xe7\xff\xf0\xeb\xbf\xc4\x1b7\xaf\xd1\x10\xbf櫂<\x8e(u\x84\xb6~\xb2\xf7d\xb4\bm,k\x1d\b\x81\xa2gV\xe5\xa5 \x9e@\x18Bx<\xaa\xe5@vn\x8aJ\n^ݟQh\xc3YU\xa3½\xacC\xb4\xacp\xb4\xa4H\xb1Ѵ()\xf8\xd6\xfd\xc7|2_\xf2\xb5\xbb7x\xfd`\x97\xd3\xe5\x9auY1\xcd3\xf2$A\x85\xd1\xd9\xf5\xc2u<\x12f\x99\xa4\t\xe3\xeb1\xfb\x93\x11\x87\xf3%g\xeb2\xac@:/\x816\x96\xdbт\xf4R4|\x81.2\xdc\xd1\xc0\xf9\xfd\xe99\x14t\xd9\xeb\xe4\x86i=\xcdr\xe1<\xc8#6?zq\x8e<\xd46\t}31q\xb5\xa9\xdf:wy\xb8\xd2\xe0\xce\xf4\x0e\x8fI\xe4\xbd?*c\xf8h\xbe\x1eؓ;ќI\x16cE\x1b\r\xdc|\xceM\xd3\x0e\x82\xb2rdY\xd7\xe4\xfc,W\x00֚\x81\xbf\xc6e\x8f$Nкޱ\xce\xfe_N\xe7\xc7\xdfؙ\xee\x9d<C\x01~\xee\b\xc0\xf6\x132\n\x1dz\x1c\x18\xc8}\xbfm\a\xc1լ+\xfe\xf5\x13\x8a\xbf\xb0>&s[|\xbb\xc3\xeb\xf5\a\xe8\xc0\xb6I\xb6ݵJ\xf2<cw:\xe3`w\x9f\xfd\x9dkLFSO\xeck'\xe3n\xb7\xd8\xec\xc0\x95\n\xa6?\xa2\x8b\x9bm \xb9$\xf6\x86?\xe3|D\x9a\xa4\x8cҌW\x9f\u007f\x99W_x\x998\x8aZS\x99\xd7_|\x85\xd3Ŝ\xd5zE\x1c\xfb\xa9\xbf\xa8\x83|N\x80h`\xfb\xde裤b\x94\xe6~\xa2\x0eӽw\a\xd4h\x1d\x0e\xc8\xc6\x00\xa5\xcfbn\x1b\x1d\x85\x8cT\xe0(\x8c<o\xc0Z\xb4\xd5!\x14\xa5\xf4\x8dAU\xb0*\x968k\x83\x8ez[T\xb0\x1c4\x00\xdd{\xdc\xfc\x99\xf5.n\xc1}\xf0|\x0e\xbb M3\xa4\x14\x14\xeb5eY`ی\xf9\xae\xed\x10\x8d\xb3݆/{\x90w\x87Ĵ-h\xd1%\xb6\u007f&\xbcǵ\xb68\xa7IU4\xc0\x8eUHmi\xbcӫZo\xfc\x021p\x1b\x1c\xfa\x0e\x89+5%W\xe6\a\b_T\x97\xa5_\a\xfcǿ\xfae\xbe|\xebz\xdbl.\x8a\x8aq\x9ax\xf6\xbas\x94Z#\x04L\x92\x98Ӣ\xa2\x0e;\xfe6.\xd1\r\x8b\x8d\t\xe6W\xcd\nE\xf4<\x14\xb2X\xf1\xea\xfe\x947\x0fO)\x83\xc9V\xab>\xe9EU\xab\r\x93,)\x04\xa3$\xe2xU\xf0\x8f\xdf\xfa\x80\x8fϖ|\xfd\xb9\x1b\xec\x90p\xb4X\x91%5y\x1c\x13+\x85T\xa2\x97\x03@+i\xb3\xce1J\"\x9e۟QԚ\xb3u\xc9\xe9\xbadU\xd6h\xd3C\f\x83z`\xe8+\xd0kV\xa1E\b\xfa\xc4ƾ\xd7\xce@S\xdck\x12\x06\x9f\xa7\xd8\xfe\xd9\xf4.k\xff%r\x8b+\x87\xb8\x04\xd6wCgB\xaeT\xfc\xbb\xc4\xc7T\xa9\x16\xc9:.J\xe6ڶFjIH\xcdl\xd6f֙\xde{#\xdaUe\xb3R\x14RQkC\x1a\xff\xecQ\x00c\r\xf1U92*\xf2kM]\xfd/\x80\xff\xf5\xe9\xfc\xf8\xbfڙ\xee\x99g\\\x80_$\x0e\x00]\x82\x9f\f>\xd8\xc2\r!\xf4\xcatSn\x11\xfc\xfd\x9f\xe8\xa1\u07b7\xfa\x1d\xdcXM\xf1w\x1b\x81\x19\xc1S?N\x82\xa6\xdc`\x9d%K\x13f\xd3)\xd7v\xaf\xb1;\xdde\x9cOH\x83>\xb6\xbf\xe3m\x8e\x95(\xf8\xe8\xcb0\xb17'\x8dw\xfe\xf2\xab\x80<\xcb\x19磰_\x16\xecN\xa7|\xfe\xe5\xcfr\xf7\xfa\xed \x892a\x87j؛\xed\xf2K\xaf\u007f\x91?\xfa\xfe\xb7Y\xae\x96(\xa5\xc8D\x16\x98\xf5&ܬ\xa2uG\x93B\x12G\x92,N\xce\xed\x02\xad\xb3hc\xa8MMU\xd7a¯\xdbC\xb2uE\v\xa7^\xf3g~J\xf7\x93z\x12'\xb8l\x1c\xe08˺\\q\x16\xd8\xfb\xd6\x19\x8c\xd6\x18\xab\xa1\xb7\xe2h\bXn+\xadߵ20c\xb4\xb7.\x96j\b\xcf;\x90*b4\x9e\x90\xa4\x19e\xe1=\x04\x06&G\x0e\x84\x94\xb8\r\x8bg\x9ft'\x90\xce^)\x84h\xb3\x18\xfb\xc2%\xc2^\xbd&\x8b\xa3\xf6sU=\xf8\xd4\xcb$5\xe2\x82\xc3X\f ]\xf7Īn\x9b\xaa\xd3\xf3\x81\xb8\xea:`]k\xfe_\xff\xf6\x1b\xfc\x8d_~\x83\xaf\u07bd\x81\xb6Ypƃ<N\xbc\x95\xadT\xd4\xd6\x10)A\x1e+Jm|\x03ПB{\\\t\xe3,։\x10\x18\x84'\xfe5;v\xe7\xd8I\x13\x9e\x9f\x8d\xf8\xc1\xe33\xb6-^,\x02\xac\r\x89s\xc3\xf7%\x8b=\xb1\xee\xbb\x0f\x1es\u007f\xbe\xe4K\xb7\xae\xf1\xea\xc1\x0e\x12\xd0!\xec'\x92\x92H\xf9\xd5Ր\xdaG@\x06!\x8d#\xaeG\x11{\xe3\x9cUYs\xb2^\xb3(j*m\x02\xf9յ+\x10w\xc1\x04-{\x83I\xdb(\xc8>J\xc3\xf6\x02,\xb6\x14\xf4s\x83\xc7y\x05ѕ\xf6\xf9=\x89\xe1\xd3\xea٤\xf0ɓy\x12#\x85`U\xd7<ZW,\xb4m\xb3\a\xa4\x10-\x8f\xa29K\xfa\xf2a\xd7ۯ\xf9?\xb3H\x19a\xacAɟ}\x03\xf04ʙ$NЦN\x9cs\xff9\xf0\a\xc0\x1f?C\x01~~\x0f\xa5\xd2\xe8omE\x00\xf0\xaeW\xb1\x90\x83d,\v\xe8\xc0F/\xace}A\xac\xef\xe0\xa2\xd7M\u009f8\a\x87\xf5\x1b\xf8\xe6\x86RJ1J3\xa6\xe3)\xe3|\xe4'\xf3ш\xddٌk{\aܾv\x9b\xbd\xd9>\xe3l\xec\x8b\u007f\xd0\b\x8b\xe60\vL\xffHE-\xc1\xaf\xb9@\x95T\xa4a\xdaO\x93\xd4;\x00Ʃ\xef\xcaӔ\x17o=\xc7\x17_\xf9\x1c\xd7\xf7\xaem-P\xce9\xa6\xe3\t\xd7\xf7\x0e\xa8\xea\x8a\xd5z\x154\xb9^b(e\x17\x98\"{R\xbc\x86\xec\xe3\x83~D\b\x1b\xf1Eܻ\x13\xa6diF\x96\xa4\xc4*j\tB\xb4\x84%\xd93\xe8\xe9\x15,'\x06m[\x12\a\xab\xe2(%\x8dS\xa28AJ\x15\"\x84\r\xfdO\xa1\x9f\xc7>\xa4\xf8w\xbfǻ\x17\x9aas\xd5C\x83\x94R\xc4iB\x92\xf8\xdf\xd3\xc8\x13[\xe8Z\x9e\xd7D\x8bK\v\xa8\xb80\xfe\xb71\xf3\xb1\x8d\x1c\xb3ɟ\b\x85\u007fw<\xe6`6\t\xcf\xd1\xf2\xd1\xe1\x11ǫ\xf5\x86\xdcO\x04T\xa6\x93\x9aI!\x88#u\xe1u\xdcW^\xf4\xb9\xf5W=\xf4\xbc\x92\xc1\xf2\xdd\xfb\x87ܜ\x8eyaw\x86u\xe1>rP\x87\u007f\x9b\xc0\x02\x8f\xa4\xa44\xa65\xaai~\x97\xdf\xf9\x8b\x1e\xedC\x84\xeb\xac\xfb=\r\xd7@\bA\"%\x8f\x96kV\xb5ie\xa0\xc3罅\x1c\xd9\xfb\xef\xa6yy\xef\xe8\x8c\xf7O\xe6X\xe7\xd8\xc9\x12b)\xd0=\xeb\xdd\xe6\xf3l\xf6\xf8\x9b\x1dV\xd3TL\xb3\x84I\x96\xf8\xe8\xe5`\xbb+ex\x8dbУni\xda\xc4\xe0\x88\x1a4o\x17\x14\xf8˪\xb8\xdb\xcc{\xde\xf6\x99\x8b\xedMh\x1f\x95\xb8\x12\x18\xd4|>\xf8\x86m'\xcb\x18\xc5ޯ\u2d2c\xf8\xc1\xe9\x9aǥ\x0eg\x88ϥ(M\xa7\f\xa2\x97\xf2)\x84@kMUם\xb9\x91\xf3\x96\xc1\x1e\xd9t?sU@\x12'W\xbe\x17:\x0e\x83\xd9\x05feU\xfc\x8fY\x9a\xaf\x00\xca\xca'\xcb\xfe\xdf\xfe\xef\xff\xf9\xb3\xca\xfc3z\x88d\x96n\nҽ\xacM*\xf6\x02\xf3\xbf;8E\xcb^.\xacei\xae`\x05y\x85\xa0\x9f\xa6\xd0&q\xcc(\x1b\x91\xa5)*\x04\xdfDR\x91g)\xe3шq6!M\xb3\xa0Տ{\xbb'q\x0e\xe9\x95R\x12\xc9h\xb8WW\x1d\xf9\xaf\x99\xe2b\x153\x19\x8dy\xfe\xc6\x1d^~\xee%f\xe3I\x80\xbfe\x8b*\x9c\xdf\xf7\xf9\x83\xaf(֜,欋\xb5߅j\xcd\xe1\xc9c\xee\x1f>\xc4\xd8\xe0\xe7=`\xe7\xf7ʯ\xe8N<כ\xd1\x1a־g\x88W>\xe1Ϙ\x16\x011֠\x8d>7\x89tӽ\xa5\xac<Yp\x00\x19\x9b\x9a\xb2*X\xaf\x17Tu\xd13\x12\x11\xe7>\x87\xad\xc7e\xd3\xe5\x8b\xcea\xae\xf3\a\xe8\x0e)g-u]S\x16\x05uUu\xa8\x80\xdd~`\xba\xc1\xf4'.\r\xfdQ\xb2\vp\xf1\x9f\xa7\xec\x15+\xc5\xcb7\xae\xf1\xf2훀\xc0\x18\xc3\x1f\xbd\xfd\x1eo?z<p\x01\x94\xa1\xf0ǪӺK\xe9Y\xf8\x97\x9fa\x1bҴ\x90\xa1\xc0\x15\xbe\xa5y\xd4\xc62M\x13\xfe\x93_\xfd\x12\x9f\xd9\xdf\xe1x\xb9\x0e\x10y\xc1\xbc(=\x1f\xa0\xf6hMe,\xb5\xb1LҨK\xdb\v\xbc\x9b\xa6y\x11\xbd+*\n\xfe\xf8M,\xb5\f˘\x8f\xcf\x16|\xe7\xe1\t.4\x13M\xc3#{\x9fc\x9b\x9c)\xb7/B\x9cs\x94Ơ\xad\xe3\xe6d\xc4/\u07fd\xc1g\xaf\xed\x12+\xd9\xfa\xebGJ\x92D\x11R\x9c\x0fgjГ\xfe5\xae\xade]k\x8a\xbafU֔\xb5\xa1е_\xef\xd0I\x0f[\xe0Ź-\xae\x82<5\x8a4\x9c\xa0/\xbeضY\xf9\x0e\xa3\xae\x9f\xe2\x90\xed%\x93β\x94\xfdQ\x8e@pVV|\xbc\\\xf3\xbb\x0f}\xb0S\xc3=\xa8\x8cWWպ\n\x1azI\xbf\xaf*\xaa\xaa\xe5U\xd4ZSU\x15\xd6Y\xd2$C\n\x8b@\xff̚\x00!\x04\xe3|ze\x1e\x00\x806\x9a\xd5z\x89sv\r\xfc\x9f\x81\xbf\xbd3\xdd\x1b\x14\x93\x1f~\xf0\xf1\xb3\xea\xfc\xf3X\x014)~\x93(\x1aXi\xb6Ŀ\xa7(\xfeºK\x8b\u007fC\xbc˒\x94\xd9d\x87q\x96\x87Iǻ\xf2\xe5Y\xcat<e\x92O\xc8ӑ\x9f\xe6U/4g\x03\xcf\xed4ﲳ\xeb\x952\x04\x97\xc8v\x127\xceۦNG\x13^\xb8u\x97W\x9e\u007f\x89k;\a-/\xc0\xcb\xf9\xe2.}\xafWH\xab\xba\xa2\xae5\x95\xf67\xe1l<a6\x9a0_-y\xef\x93\x0f\xf8\xe4\xf0\x11U]\xfb\xe2\x10$s\xa27m\xbb\xe6 \xd7\xc6\x1b\xbc\xe0Z\xb5A\xabZ\x0e\xeb\x01\xe7@\xc8\b\xd5\x04\x019KYԬ˲=,6\xa7fcL \x05v\x13\x93\x9f$3\xd2$c2\x9aQV\x05\xcb\xd5\x19E\xb1\xec%\xbe\xb9s\x12\xbeͩ\xbcQ\x12\xb8\xd0ɋ\x9eQRgs*HҔ$Mѡ\x11(\x8b\x12\xeb\xf4\xd6S\xb3u\xe5{¡\xdaL\x98&\x10\xa0h\xd7\x01\x1eq\xf1a/\xb6\r{\xf2;\xf2\xf3\xe9~\xfe=\xbd\nλ\xad\x05qO~\xa2OX\a\xccˊ\xff\xfa\x0f\xbf\xc3\xff\xfeW\xbf̍\xf1\bm\xbc\xb1OY{\x87\xc0$\xc4\xff&\xcaK'k눥\x97\xd1))\xd1:\xac\xdfz\x89\x90^\a\x1fr1z\xa1X\x02\xc1\xadɘ\x93\xa2\xe2\xfd\x93E\v/\xf7\x03v\\\x03\xdc\v\x0f\xc7o\xf2\x02\x9a\xf7>\x8b\"\x8cu<Z\xae\xf9\xfb\xdf\u007f\x8f\x1f\x1c\xee𧞻ɍ\xe9\x18\xeb,E\xe5͌\xd28\xf2\x8a\x81\x1e\xf0Ӯ\xe6]Wȕ\x10LҘQ\x1c3MM\x9b\x9d\xb0(*\xbfbġ\x90-\xe1\xb0\xe3\r4V\xd7\x17\x17\xe3\xcb\xf8\x1a\xee\t\x85\u007fs\xc7o7\xfe\xfb*\xbb~w\xc1\xfd3\x8ecv\xb3\f)|\x1c\xf4\xe1j\xcd['K\nc\xbd3a\x90T7\x89\xaa\x955Ȱ:\xec?e)!\xcf\xfcyh\xade]\x14\x1cϏIbE\x12\xe7\x18SS\xd7k~\x16\x86{OK\x04\x84\x0e\x99\xad\xea2\a\xfe3ශ\xb7\x9f\x95\xe3\x9fs\x03\xe0\xf0\xba۽8!\x15j8\xd9\xe1\xfd\xc3Wư\xbeB\n\x14\xce!\xb5\xb9\xb8\xf0;G\x9a\xa4\xec\xcdv\x98\x8dg\x9d^Y\xc0(\x8e\x18\xe7#\xc6٘<\x1b\x91DI\v\xe3\xbb-\xb10\x02\x0f5\xf7'v%\xbd$\xaci\x06\\3\x85Jؙ\xccx\xfd\x85\x97y\xe5\xb9\xcf\x10E\x11\xf3Ւ\x0f\x1fޣ֚\aǏxx\xf4\x88J\xd7~\xbf\x19B9\x84\x838N\x88\x84\xa2\xd45eU\xb5\x93\xf1\xb2X\xf3\xe0\xf8\x90\xd3\xc5Y\x9b\x00'\x02z\x11\a\xaba\x1d\x82\x80\x8c5\x94uMQ7ӱ\xafH\xaa\u05fc\xc4QD\xa2\xe2\x96\xe9\xdd\xc8\xf3\x9c\xb3\x14U\xe9\xd5\x16=\x89\xa3\b\xf8i\xdf/`\xdb\xd1\xe7B\x93\x94gc\xb2tDY\xadY\xaeΨ\xaa\x12\xeb\x8c\xf7\v\xb8p7\xdf\x18\xb7\xf8B\xec\xfe\xff\xec\xbdY\x8fm[\x9a\x1d4f\xbb\x9a\xddŎ8\xcd=\xb7˾*+\xab\x8a\x92)\xcc\x03\x18#\x19x\xa1\x91\x11]\x81x\x00$?\x81\x84\x04Hƒ_R\xb2\na!a\t\x89'\xe4\x1f`\x1e,$$@\xf2\x83\x91-\xcbF\b\xb0K\x05\x95\xae\xccjn\xe6\xedN\x13\xddnV3[\x1ef\xb3\xd6n\xe2D\xc49q3+S'\xa4\x9b\xe7\xe6=\xd1\xed\xbd֚\xdf\xf8\xc67\xbe1\x9c\v]\xa5\xa7\a\a\xeaxk\x80\v\x81\xb2\xaaѵ\r\xba\xb6\x853v\xf7\xf3\x130r\xee\xce7\xadK\xb1\xc61m.\x19\xd6\x04\xa6\xc4E\xbf\xf4\xc1\x8a:\x81\xda\xd41\x0e\xf0110\x1e\xf7\xb6\xff!of\x18$\x18\xc5\xf9\xb6\xc5\xdf\xf8\u007f~\x80\xff\xe0O\xff\x1a\xeaB@[\x8bZ\v\xf4\xc6\xc6\xe4\xbf\xd0!K\u0382b?z\x1f$\x16$\xad6\xa6\x1d{\x1a\xc1y\x8a\xc2N\xc0\x9dğ\xf7\xcd\xe5\x1c\xeb^\xe3\xa2Ua\xd4\x11u\x12\x89\x01p\xdeG\x90\x89(v%G)]F\t*\u00a0\x9d\xc3\x0f_]\xe3\xf9j\x8b\xdf\xfc\xe01~\xed\xe9#\x14Q7\xd0)\rM\x03\x10`c\x17M\x8c=,\xa2\x000\x16q\xce(fe\x81Z\n,J\x83\xadRh\x94FoBZb\xba>Q>\x02\xe7\t\"\xb9\x96A\xe9.+\xb0\xef\x84\xf8\x1aA\xe0>/\xe0\x8fw\xff\xfbc\x12\x82C\xb0\x80\x1b\x9e<\x02`\"%\xce\xea\x1a\xd6{\x18c\xf1\xc3\xf3+\xfc\xe0\xfc\x1a\x97\x8e\x82\x8e\xe2\xcc\xd2\xd7J\xef\x00\xc6`\x8e\x801\x17Y@\xce\xc3\xd9RW\x15\x9a~\v\xe7\f8\xads\xb2\xa8R\xcdO\x05\x04\xdcG\b\x98\xc7\x06\xb2\x80\xb1\x1aι\xdf@\x10\x04\xfe\xb5\xc5l\xa9\xc7Z\x00\xe0\x9dC\xe0O\x05\x00\x10\x04\xc5-'\x04S\xc6QR\x16\n\xc9\xc84\xa57\x1akk\xd0\xdf\xf1\xa0\xa67t\xfe\xce9p\xceq:_b9_\x82s\x96\xc5M\xa2(P\x16\x05\xear\x82\xb2(\xc0\xb9\x80\xa0\"Α\x8f[O\xa6.\u007f\f\n\x92\xbd-FṌRL\xab)>x\xf2\x1e\x9e\x9c>\xc2\xd5v\x8d\xbf\xf9w\xfe\x17\xfc\xc1\xa7\u007f\x84\xab\xcd\n&\xd2\xec\x9d\xeac\a\xbd7[Lt)\xa5;+r\xe9\xe8\x18f\xb3\xe4\xc8\xfco؟\xc6\xce\f~\xe8,s'\x96;\xb2\xf1j^\x9c\xefad!\x9ag~\xa1[\xa0$0\x1d\x8c&}\xb0\xcf]\xd8a\xf0N\xe8⋢\x82\x10\x05\xba\xaeAק\x18\xe3hJdu`\a\xfc\xbe\xa5\xcanr\xdf]F;\x8c3Lf3ȢD\xb3\xdd@u\xfd\x81p\x88ܔ\x1bp\x13\x9c\xf1>\b٬\x8b-}誔6\xc1=\xcf輙a\x8f\x98\x06x\xe2GY\r\x88\x9d\x17\xee\x05\x00\x807\x03\x01\x921\xfc\xf8\xf2\x1a\xff\xf3\xff\xfbC\xfc\x9b\xbf\xfe\xcb(\x85\xc0\xa4th\xb4A\xd3\xf7\xe0\x8ce\x95\u007f\x10\x84EO\xaa\xa8\x0f\xd06\xb8\xc0\xa5\b\xdat=\xb4\r6Ӕ\xec\xe6\x1d\xd4R\xe0;\xa7\v\xfc\xce\xf3s\xf4\xceg\u007f\x80\xb1ᑋc\x03\x17\x85e\xec \x92\xdaC\xdb\xc1\xe7B2\x8a\xc6X\xfc\xddO\xbeħ\xab-~\xf3\xfd\xc7x2\xad!\xa33_\xab\x14xT\xba\x8f\xf5\a\xe9.φ[i\xed/Vm\xc1\x19\x16\xacĬ,\xd0i\x83F)t\x91-\vn\x83\xc3{\x9e\xc6\x176\x03ཌྷ@\x8cM}\xc8kX\xc8\xdb'8\xe4HD\xf7\xeeC\xee\x8f\xd6\xdaD\xfb?\x99N\xd0\x19\x8b\xdeZl\x95\xc1?~u\x85k\x83x\xd3\x1d\xdeE\xdc\x19\x80\x12\xe8\xa3\xf7>\xa0\xb5FYTH\xb6\xc6uYC\x1b\x8d\xba.!\xb8Dӵ`\x94\xa2\xed\xb6\x00\xdcW\v\x00\xac\xb9\xb7\x856\xa3\fR\x14\xe8\xfb\x8ey\xf8\xbf\x00\xe0\u007f\x02\xf0\xfb\xefJ\xf2O\x19\x00\x10\x84\x95?I\b*\xc6 \xe9\xe0S\x8f(d\xeb\x8c\xc1\x95VP\xce\xdf鰣\xe60\xe8'\xad\xd1-\xe7'x\xb4|\x84\xaa(\xe1}\xb0\xb2\x14\"\xd8\xf0\x96\xb2@UT\xe0Q\xb8G)=0\xfd\x18(c\xb2'b\x1b\xfe\x9e1\x96\x19\x81\xd4\r\x19gq\xd9\\\xe3\xb3\x1f|\x81/Ο\xe3\xd5\xd5eT͆\xa8\xdfԝp\xce!nqH\xf6G\x04aw\x1a\x04\u07b9\xbc\xa5\x9f\x12\xbcս'\xb0G\xb4\xdc\xfb\x96\xa1c\x871N\x198eC\xeaY2\xfcɅ{\x98\xeb\xd7u\xd0V\xf4}\x8b^\xb50F\a\xf7>k\x02\x18\xda\t\xfd ;\xcc\xcb]\xaa`\xba~B\n\xcc\xc5\t\xfa\xaeC\xbb\xd9@k\xb3\xe3\xccG\xee9\xd3\xf5\xde\xc3\x00pƁ1\x02e\f:\xa5\xc09C\xaf\x03\x80\xa11\x8cj\xccI\xbb\xfc\xbb\x0f?˦.\xf8.c\x00\xec\xaaN\xde\b\x04p\x86\xdf\xf9\xfc%\x9eͧ\xf83_\xff\x00\x13'\xd0\x16\x12\x8dR\xf0\xce\xef\xf8\xf1[\xbf\v\xc1\x02\b\b\xe6AI\xffA\xa3C\x9eu\x1e\x84\r\x051A\xe3\xb3I\x89o\x9d\xce\xf1\x83W\xd7!e.\xad}\xd2aL\x92\x92\xf2Ҙj<\x0e\xd0\xd6\x0f\x02\xbfQ\x88\x8f\a\xf0\a\x17+\x9co;|\xb8\x98\xe0\x83\xc5\x14\x1f-f\x98\x16\x12>n\b\xb1h$\xb6[8\xe3\x1a\xa8\x1f\xaf\x18\xfb\xc1^\x16@\xc9\x19(\x91\x90\xdcAY\v\xa9\x19z\x1b\xc0@Rɏ\xa3Ǉ\xbb\xd3\x1f^\x97;\xef\xe9\xef^S\u007f\xa4\xf8\x1fK\xcf\xf6{\x82´\uedecJ<\x9dM\xd1\x19\x83\xce\x04a\xe7\x8fίp\xad,</v6(\xd27\xa0\u0380y\x87\x9e\x1c?\x87(\xa5\xe8u\xbf\xb3\x8aX\x975z\x15\x8c\xc9&\xd5\x04uY\xa3\xeb'\xb8\xde\\a\xbd\xb9\x82\xbfCt\xef\x9b~\xb8\x91\xe8\xf7^π(`\x8c\x86\xb1\xe6[\x00~\xebz}\xf9_\x8fY\x80\xc4\x04\xbcc\x01\xbeb\x06\xa0 \x14%eA\xf1?\xba\x15\t\xa1h\x9dŅ\xeaa\xfc݊?\xd9s\xfbK\x9doP\xce?´\x9e\x06\x81\x1ec\x90\"\xd8\xf1\n!P\xcajG\xb1\x9f<\xfb\x8fw\xfd4+\xe9sw<\xeaJ\x93iN\xa7\x14zݣQ\x1d\x9a\xae\x81\xd2*\x18\xa2 t\x1a\xe2\xbei\xc8\xfb\x85\xef+\xfe \xf9\x15\rKV\x1e\xc9\x06\xf9\xf8\x82s\x10\xfc\x19(kF\xd6\xc3$\xb2\x03\xc9\x03=\xacq\xd1(\n\x83\x0f;\xbau=GYLЫ\x16]\xb7EZ\x98\x0e\xe6F{\xa3\x01?̙\x93\xf9\x0e\xc1݀@YU\x90R\xa2m\x1a\xb4\xdba\x8bbg\xcb\xe0\x96\x03z\xe7\x00\xf2\x1e\xdex\xac\xdb\x0eW\xdb-*)\xd1t}p1\xa4\x14\xdaڝ\x8eЏ\x84|i\x1a\ue747gw\xdf\xf1\xbf\xf3\x05\xf47\xff\x15\xa3\x04\u007f\xe7\x0f~\x82g\xf3)\xbe\xb1\x9ccVJ\xacZ\x8eM\xaf\x82\xcf?!`4d\x06d\f\x13i}\x15\x8b \x8b\xe3\x8f`\xf2\xe3\xa3?\xc7\xe0\xf9\xef\xbd\xcb\t\x98\x1f̧\xd8*\x83OV\xdb\xc0\x9e\xc0û\x10gL\xa2\xd7\xfep\r\xb0\xa3\xb1p\xaf\xf1\xb7\xe7\x94b\xa54~\xf7\xc5\x15\xfe\xf1\xabk\x9c\x94\x12\xcff\x13<\x9bO\xf0\xfe|\x82eU\xc23\xe4\x11\xc5@\xb5\xfb\xec\xf07l\x10\xf8\x9dt\xbd\xe4\xc5\xcf\bA\xc1\x198\xa3\xb0\x8e\xc1D=@\xd2%%7\xc1!4\xc8e\x9f\x81c\xd7d\x9c\x04IF\xe6C\xb9\xdb\x1f\xe9$\x86\xf73A\xfeqؑϺ\x9d\x01\x1c\x84\r\x8a\xb3\xba\xc6Y]\xc18\x8f&2S\x9f\xaf6\xf8\xe3\xab\r<\x137r\x0e\xd2\x19(\xef\xd1\x13z\x94\xf9⌣\xe9\x1a\x18k \xa2\x03)\xa3\f\x85,a\xacFӵ\x98V\fuU\xa3(\xc2v\xd1\xf9\xe5\vX\xab\xbf\x1a\x00\xe0\x93w\xc8\xfdv\x10\t!(d\t\xdb5\xd4{\xf7\x1f\x02\xf8\x1b\x89\x05H\xde\x00\xef>\xbe\xe2\x1a\xb3<\xa9}Ah\xa0\xea\xb0K\x1d\xb6\xce\xe1\xa2ﲰ\xea.s\u007f\xa6\xedп:\x8f\xb2(\xf1\xe4\xf41N\xa6\vp\xces\xc7\xcf\x19\x03\xa3<\xac\xe2\t\t\x16g\xe5\xbb\x06\x1e\x87+d\xe9&K\xf4\xb7\xb6\x06\xbd\xee\x03\x85\x1f\xbd\xf7{\xddC\x19\x13\xd7\xd7ȎM\xae{\x83\xfd\xf3?\xa9\x1f>\x1eEH>\xfe7\x99\xd9`4\xdcL\xa9g\x84@2\x8eB\bH&\x06\xb1\\\xea\x06\x9dE\xdf5\xe8\xfa\x06J\xf7\xb0Ό|\t\xfcn\x12#!\xbb]\xdd]W\x82\b\x81\xd1\x1a\xcdf\x8b\xaemwF\x01\xe3k\xf4:_\xfeq:\x1b\x01\xf0x6\xc1\xa2,\xa1\xa2\xc2\xfc\xb2\xeb\xd1\x19\x9b\xbb\xc4\xf4}\v\x1e\x84j\xc9\x1f_p\x1a\xff\xdbm\xf0\xce\xef\x84)݊\tn\xb9Ք\xb5\xf8h\xb9\xc0o\xfd\xc6wQs\x86/\xae\xd7\xf8\xfcj\x05Ni\xec\xf0\x81\xde\x18\xf4\xc6\xee\x90HaK\xc0\xa2\x16Ö@\xf2\xc1\x1f+\xfc\x11\vtR\xe7w\xc6\xe2w\x9f_\xe0y\xd3\xe5\xed\bJ\xc2zڰ\xdd1\x8e\xa7\x0e\xf7\x8a\xb2w\xa3\x91S!Ov\xda\x13)\xf0\x8d\xe5\x1c\xdf}\xbcĳ\xd9\x04\xb5\x14Y\xb3\x91\xae\x87u>d\x8a\xec\x18\x81\x85\xdfWŎ_\xdb]\xeb\xf1\x943`\x92\x8f\xc0\xb8\xe1\xf0C(\x90\x1fm\x11\xec8\v\xee\x98\v\x8d\xee\xb5q\x80\xd1\x11n'ey\xecn'\f\xef;\xa3\x04\xb5\x908\xadK\xd4\xd1(l\xa5\x14z\x13\xa8\xff\xbf\xff\xe3/p\xae=\xc0\xf8\x01k\xe0\x01\bg\xe1t\x87k\a\xf0\xa2\xde%\x0e\xfd\x00xW\xdbk\x94E\x85\xf9d\xbe\xb3*h\xac\x01%\x14\x85,Q\x97u^Kn\xda\r^\x9c\u007f\x01m\xd4Wr\x16IY\xa0*\xea7\xfaڶk\xa0t\xef\x01\xfc6\x80\xbf\xb2\x98-w~\xc9\xeb\xf5\xe5;\x16\xe0\xab\x02\x00O\x97S/(\x1d֫\x10\x14ޭs\xb8\xbcO\xf1G\xdc\xf7w\xe1A\xa6\x94\xe1l\xb1ē\xb3\xc7(e\x19\n?\x0f9\xf4\xc1\x83_\xa2\x90E\b\xe4\xa14\xe1\xf0Q\x8e\xfd\xe1\xc1\x9a:\x1d\xc6\x18((\xd6\xdd\x06W\x9bU\x00\x00\xce\xc2\xd8\x18\x95;\xeeN\xef!\x82\xb9\xb5p\x91\xfb\xad}ݫ[\xdc\xcb6x\x1b@\xe0\xefP\x80S\x84p\xe8\xe08\n.Pp\x91\x8d]ҁ\xe2\x9c\v\x8c@\xbfE\xaf\xba<\xef\xc3H\x89\xbd\x9fP\x98\xbbyr\xf7\xf7\xbck[4\x9b-\xac\xd6\a\xb3YF\xc9͝\xf4\xde\x01\xca\b\xc1\xbc\x90\x10\x8c\x85\x99\xba6\xd1\x06w؛\x0f\x01/\xc1\xa0)0Bi\x8dm\xa0\xaa_{\x9f\x1fy\u007f\xdf\x14\x00\x84\xf1\x94ß\xf9\xe6G\xf8s\xdf\xfa\x18\xbd\xd2\xf8×\x17Xw}\xecxC\x1cNH\x04\xb4;4\xfcVi\x14\x82\r^\xf5>|\xaf\xa4\x03\xa1d\x10\n\x8a\xbce\x02\\\xb6=~\xe7\xf9\x05\xb6\x11\x18!\xbeoYt\x8aè䴁q\xff\xee0\x14x\xc1(\x9eNk\xfc\xf2\xe3%\xbev2Ų*!\x18\x1d̀\xf6@߸\xd8\xf5\xd6BY\x9b\xb3\x13R1\x1f\xbevH\x9b\xf47\x98\xf4$\x85\xfd8ip(\xfeC~@\x02@\x89]\xf0\xa3qGZ\x97\xdc\xff\xfe\x94\x84\xb5\xd2JpL\x84\xc4$\x9a\xfc8\xef\xb1Q\x1a\xbd\xb5h\xb5\xc1\xff\xf5\xd9\v|\xb1\xed`yq0:\xf0\x00\xb8w\xe8\xdb-\xae\x8c\x81(*\x14\xa28>\x18t\x1eۮ\x816\x1aON\x9f\x04\xd7\xcf\xc8zl\x9b\r\xa4\x90\x90\xa2@YT(e\x99i\x8f\xaeo\xf1\xe2\xe2K\xf4}\xfb\xe0k\x82\x942L\xea鍫ӷ\x8d\x10\x9av\x03\xeb\xec\x17\x00\xfe\xfcb\xb6\xfc?\xdf\x01\x80\x9f\x12\x00\xf8\xe0t\xe6\xc7{\xd2 \x04\x9d\xf7\xb8R\xc1\xd9\xed\xcel\xa7u \xda\xc0\x13\x82Y=\xc5\xd3\xd3ǘO\xe7\x90\"\xaa\U00063d6c`\"\x14\xfeD\xf7\x8fV\xcf\xc6\x06%\xe4\x18͋pXK.ѩ\x1eϯ^\xa1U]\x9eA\xf9\xe3a\xb9\xc7\xc9\xf2\x11\x8d\xeec\xae\xb7s\x16>\xda\xf3:\xadଆ\xb3&\xce\xc0\t\x18\x97`B\xdc\b\x04\xe8ht\x91\x1fV\x0f\xbc.9\xdb\xfb\x10\xf0\xc1\x85\x04e\x02t\xc7~\u05ff1[1\x06\x02\xb7A\xb84Ke\x94\xa2\xe0\x02e\x8cHN\xc2Ơ<vy4Ы6;\x80e\xc0\x85\xc3p\x15\xd0\xfb\xb1\x01\xd6Z\xb4\xdbmH\x1d\xb4.\xdf\u007f<\xa62:\u007fw<\xc6h\xd8Qw{\au\n\xa3$\x04\xa8$\xcf]3\x8f\x80@\xdc\xc1J\xed\x18K\xf0\xa6\x00\x00\b\x85\xb5\x14\x1c\xffگ~\a\xdf{|\x8a\x8bm\x8b\x1f>\u007f\x05cm`\x02\x04\x8bb\xc0\xe0\x13\xe0\xe26\xa2\x8a\xbb\xf9\x95\x18\u0091\x8c\r\xddp\x9a\xdf'\r\x84\xa04\xfb\aX\xef\xf1\xe9\xf5\x16?\xba\\\xa1\xb7\x83\xf44\xb0r\xf4\x80\x05\x18\x8bU\xed\x91}\xfc\xbbݏ\xc8ֶ\x13)pV\x97\xf8\xa5\xb3\x13|m9ü,b\xc1t\x03\x15\xef\xc7+\xb1\x11\x04D1\xa0\xcd9\x04\xc1<Ɏ35\xf6\xff\xdcc\x06\x92V\x86\xe4\xd0!r\xf0\x9cz?Hs\x13\xe0\xda]-\f\x9e\t\x9cR\x14\x8c\xa1\x14\x1c\x95\xe0\x91E\x1d46\x8d2X)\x8dW\xdb\x16\xbf\xf7\xf2\x02\x17M\a0\x01=\xf2'q\xa3QߦY\xe3\xb2m d\x81I9\x8b\xac\xe8^\U0004fbfd\xe9Z4]\x83\xb3\xc5\x19\xeaj\x82\xc4\x01n\xdb-\xae7Wa\xad\xba\x9a\xa2**\x14\xb2\xc8Ϥ\xb1\x1a\xcfϿ\xc4fs}\xbb\x8f\xc5=?\xear\x12B\xd8\xde\xe0C\xe9\x1e]\xdfz\xef\xfd\xff\b\xe0?Y̖\xe7c\x00\x90>\xde\x01\x81\x87\xfd\xe0\xe3\xc0\x11O\b:\xe7p\xadս:\u007f\xef\x1c\x881\xa8\xca\n\x8f\x97\x8f\xb0\\,QJ\t\xcehV\xcds.PD\x97:\x16chw\x0fTr\xeb\xec\x98 \b\xbd.\xd6\xd7X\xb5\x9b\xe8\x86\xe5G\x0f\xe7\xc1\xfe\xcfn\xc1'\xc3\n\x9b1\nF\xf7\xb0J\x05\xb7;kଁ\xb7qt\xe0\xdca\xd9vc\x9f\xf01A\x98\xd6\xfe\xd2\xf6\xc1\x80l\x83\xbb\x9b\xdf12\xf1\xbb}x\xfc\xfa Fd\\\x82\v\x01Q\u0590e\x05\x9e\x1e\xa8{\x82\x01\x82\xf1\xaa\xe0\xb8\xd3 \a\x80 \xbd7\xce{4Z\x85\\z!P0\x1e\x80@\xd4\x11\x94\xe5\x04\x85\xac\xa0t\x87\xa6\xdd\x04 `\rv3\x05F\xaf,\x9en\xe4\x0e\a\x8d\x8f\xee~\xf5t\n.\x04T\xdfC\xab\b\xc0\x12\xf5\x8a\xbb\xbb\xaf\x19\xe7\x0e\x00\xc3x\x04\x90\xe6ń\f\xc5\xc2y\xffF\x82\xbe\xb7\xee\x9e\bA\xab4\xfe\xf6\x0f?\xc1I)\xf1\xdet\x82G\xd3\x1a\x9f^^\x87\xfbHyTB\x80S\x8aR\x84\xc4\xc0\xa0\x05`\xd0N\xc3D-@bK\xd2\xc8\x03\xa3\x15Ƞ\x83\xe09\xe4\xebɴ\xc2Fk|\xben\xa1\"\xd0\n\x9fCr\xf6\x87\xdfg\x02\x10\xdc\x05\x9d\xf7\xd0\xee~\xca\xf2\xa4\x15\x00\x80V\x1b|r\xb5Ə\xaf֘\x17\x12\xefϧ\xf8\xa5\xb3\x05>:\x99\xa1\xe0,\u007f\x81KL\x9e'(\t\x81\x88v\xc9:z'X\xef@=\x81\xf76F\v\xfb\xbcq\xe0\xf7\xc0\x1a\x1d\xb1$!~x\x18\x0fX7H\b)\r)\xa0,\x82\x047r7\xccE\x9f3\x94\x8c\xa3\xe0<\x03&\x1b\xcd\xc1\x92\xc9\xd1E\xdb\xe3\xb3\xd5\x06\x9f\xaf\x1a\xbcܶ\x91\x99\xa1\xb0\xbb2\xc8\xfc\xbbm\xda\x06\xe7m(n\xce\xda`\x0ffm\x10i\xe6\x0e\u007f\x18aZ\x17\xbc\x12\x9an\x8b\xaa\xac\xf3\xf7\xab\xcb\x1a\xdbv\x8b\xcb\xd5E܄\t\xe2k!$<<8\x93x\xff\xc9G\xb8\x10%^\\<\x8f\xfa\x90\x87\xb9\x8f\xb5Q\xe0\\\xdc[\f\b\x00\x82Kh\xa3\x891\xfa\xcf\x03\xf8߯ח\u007f}_\x10\xf8\xee\xe3+`\x00\xde;\x9d\xf9d\x9f\xdb9\x87\xad\xd6Q\r|\xfbQ\xebc\xf1/A\xf1xq\x8a\xb3\x933\xd4e\x05\xc1\a\xeb[Ɔ\xc2/\x98\x88q\x977\xf5\xf97u\x0e\x16M\xdfa\xddn\xb0m\x1b\xe8QQ\xb8\xb1\xfb\xda\x13\x959\xa3\xa1\xfb\x16\xba\xef`U\x0fkU\xd8=\x1f-)\xe78\xddר\xd9|\x82\xecc\xdd1IsTv@\x0f\x87.\xd4e\x03\x93\xc3m\xa1\xa1 E\xa7\x14\xf8\x18\x94B\x19GYOP\xd4S\x88\xa2\x04\x172\x87\xfc\x8c#\x8e\xbf\x8aq\x81\x8f\x06E\x92\x85\xf1@\x9a\x11Ә\x18轇Rm\x04\x02M\x1e\r\x1c\x1bc\xdcg$\x90\x0f\x13\xa5\xa1\x95\x82\xd5*\x8c\x05ƅ\xfb50\xc0\xef\xb0F\x87Eȍ\x02\xaddLd3\xceGQ$ɩx\xf7\x1d\x03\xbc\r\x03\x90>EY\x8b_{\xf6\x18\xff\xcaw\xbf\tg,~\xf8\xfc<\x87\x05\x11BPI\x01F\bt\xa4\x94\xd3\xd7X\xe7Qq\x16\v\x1bЛ\xe0\xe7 9\xcf\xdd|Z+\x94\xd1\xe1\xd2\xc1c\xd5)\xfc\xd1\xe5\n/\xdb\x1e:n\xf8\x90\x18\x0f<~}i{$\xb3+\x84@\xb9\x87\xd1\xd2\x04\xaa=\xf8\x1a\xbc7\xab\xf1\xf5\x93\x19\x1e\xd5\x15\x16\xa5Ĭ\x9011\x0f9<h\f\x18\xfd\x88Y\xe8#;\x90\xee\x8f\x1d\x06`\xc4\xc8eV(\x8d\x13\xa3\x8b!%ck\xe8\x98\xe1\xc1\x826*\b\x93\x0f\xef#\xed\x1c\xb4\xb1P\xc6`\xab4\xae\xda\x1e\xab^\xe3\xbc\xeb\xf0\xb2\tI\x88)Η\x8c\x9e;M9l\xca.\x01\xe0\xac\xc1\x17\xd7\x170Q\xa9\xef\xbdǤ\x9e\xa2\x12uf\xa8\bM\x02\xca\xe08\xbai\x83\x89\x17\x05\xc1\xd9\xc9#\x94E\x95\x01m\xd35\xb8X]\xc0{\x87B\x148\x99-\xb1\x9c\x9f\x06\xc1\xe0\xe8\xfe]7+|\xf6\xe5\xa7\xf0\xde\xde鞿\v36\xa9\xa6`\xec\xcd\xc4\xd5#\x87\xc0?\x02\xf0\xef-f\xcb\xffc\xffs\xde9\x04>0\x008[N<<\xd0y\x8b~L\xf9\xfb\xdb\x1f\\J\bN\xeb\x19\xde[<\xc2t2\x81\x14\xa1\xc3\b~\xfe<\x16\xfe\x02\x9c\xf3\x18:B\xef1o\a\xb41X5\x1b\\7kt\xaa\x8f&7\xa1H[\xef\xf2\x9a\xdf\x0e\x10\x88\x03K\xe7](\x1eJ\xc1\xa8\x16\xbak\xe1\xac\x1e\x84p\xf4f\x13\xf0\x1b\v\x8c\xdf\xf7+O+X$\x1a\xb8\xd0\x01\u0590\xdd9\xa6\x87\xcbnfcհ\u007fMIp\xde\xc3Y\a\xef<\be\xe0\xb2@9\x99@\x965\xb8\x90`\\\xe4\xf9ߛ\x8e\v\x06'8rtT\x90\x9c\x11\v\xc6!\xb9ȳd\x12\xdd\x15\x13\x10h\xdb\r\xba\xbe\x81\xc9J\xe3C\xaa\x98\xbc\xee}?F\x8d[\v\xddu\xc1\xeat\f\x02p\xa8\xee\xbe)\xaeu\xfcid\u007fvK\t*\x11\x1c\xeeRw\x97@\xc0mo\xda>}\xfa\x10\x00 8\x012\xfcK\xbf\xf4u|\xef\xd1\x12\xe7\x9b\x06/\xd7\x1b\xa4\xed\x0eB\x10\xc7i\x04\xbd6\xe8L(0\xad1(GE\xdb\x03贉`\x86\x8e\xc0\xa8\x83\x88A>4:b\x9e7\x1d>\xb9Z\xe3\xbc\xd39Λ\x92\x18U\xbb3\xe3\x1d@@^\rt\x0f\xb7_>\x1e\x11HJQ\v\x8eE)\xf1tZ\xe3\x83\xc5\x14\x8f'\x15j!bW\xee\xb3Mp\xba\xaf\xbc\xf70\xa3\xad\x00m\xdd@\xdf\xc7BOӟ#\x8d\x04\xa5d\x1484\xe8\x01\xfc\xb8\xc8[\vm\"\xf3\x907\x0e\xc2\xcfZ\xf5\n\x17\xad«\xa6\xc3Uף\x8fV\xc6\xc9\xc3\xe1\xf8\xd9\t\x98\xe8SB\xe0\xf1|}\x8d\x95\xeac\xa6\x03\xc9__\x97S\x94\xbc\x18V\x1e\x0188t\xba\x8bv\xe0\xe1u\xd7E\x85ӓ\xb3\x9d7\xf3\xfc\xfa\x1c\x9d\x1a\x84\xb5\x8b\xe9\t\x9e\x9e\xbd\a)\xe4\x0e\xa3\xa1\x94\xc2g\xcf?E\xd7o\x1f\x04\x04\x94E\x85B\x96o\xfc\xf5m\xdf@\xa9\xde\x03\xf8\x9b\x00\xfe\xe3\xc5l\xf9b_\x0f\xf0n\x14\xf0\xc0\x00\xa0\xb3\x16}Z\xe3\x8a\x0e]\xaf;\xa8\x9ds(e\x81\xf7\x97Op6]\xa0\x94\x02l\xe4˞\x04(\xa9\xe3\xdf7|\xb9\xad\xf3WF\xe3j\xbb\xc6\xd5v\x05\xa5U\x9eA\xba\xac\xb8=\xe2\v\x10\xbbR\xab{\x98\xb6\x81U-\x9c\x0e]\xfe\xa1Z\x1dwZW\xf3~WS@\x12\x00\xa0Ü\x90\xd2@M2\xc6b\x11>\x9c@\xec0\x11>\xcdQ\x87\x83\xc4\xfb\x81I8,*\xb1\x93q\x01\f\xb8hjB\x19\a\x17\x02R\x96\x90\xd5\x04\xb2\xa8\xc0\x93>\xe1\x8d\xc1\xc0ͬ@\x18\xb5P\x14\x9c\xa3\x8c\x8c\x00b\xb1\xa048\xf9)գi\xd7\xe8\xfa&&\x10\x1e\x01S\x04G\xfd\x1b^7^RJ\xa1o[8m\x86}\xf1\x83\x91\xc3\xed\x00\xe0\x180\xa9\xa5\xc8B0\xc1\x02;%9\xbb\xcd4nϊ\xf95\xb7\xd4=\x00@\x1a]|\xfbl\x89\u007f\xfe\x1b\x1fbQ\b\xbcZo\xa1\x8d\xcd!\\.\x83\x0f\x82N\xeb\xd8\xf9\x06:\xbc\xe2<\xdf\xeb\xc6{\x98\x18)\x9c\x9e\xb7\x94)/\x18\x8d\x8cN\xc8'x\xb9m\xf1\xc9\xd5\x06\x97\xfd\xc0\xb8\xa6\x95Q2b\xd4\xe8\x88R7#\xe1\xddC\u007f\xf8\xbd\x99\xbd`\x14\x8b\xa2\xc0\x93i\x85\xf7\xe7S<\x9d\xd6X\x94\"\x03\x99\xfd\xe7\xcc\xc5\xd7\xdeG\xe1 \"\x88M\xafyx\x161:SRF\x81\x8f[\a\x0e\xc6\xd9\xcc\x18\f\xa4`0\\\xba\xec:|\xb9\xe9p\xd9v\xe8\xac\xcb\xe3\x87\xdbG]\xc3\x15'\x84\xe0\xb2\xed\xf1\xf9v=\x00\xca\x1d\xf1r\xd0;\t*ଃ\xd2\x06ʆ\xb1'\x17<\x9f\xd1\x04\xc0\xd9\xe2\feY\x05a.!\xe8U\x87\x8b\xab\xf3\xe4&\x02\x0f\x8fI9\xc1\xb3\xc7\x1f\xa0.\xeb\x118\n\xaf\xfb\xc5\xf9\x97\xb8Z]\xe0m1\x00g\x1cu5}\xa31@\xaa-\xdbv\x03\xe7l\x8f`\x13\xfc\xd7\xc79\x01\xef\x00\xc0\x03\x03\x80ɢ\xf2:ͻ\x03\xec\r\x00\x80у\x13-\x1d\xb8\xcb\xd9\x02\x1f\x9c>ż\xac\xa2]\xee0\xc7)\x8b*\xcf\xf9ɨ\xa8\x8d\xd7\xfb\x8e\x01\x80\xe0\xe7\xaep\xbd]\xe3j\xbb\x862j\xcf-\xec\xe6\xd3\xc6Y\x03\xdb\xf7\xd0\xdd\x16\xae\xef\xe0\x9c\xbd\xbdȐ\xd7\x17\u007f\x82\xb0\xd2\xc3h\b9\x91\x8cE\xaap\xa0\xeeHL\x91cd\xc8BO!\x1d\xd6{\x18\x17hI\x13\x05mG\x03FF\xf3s\xbf\x17\x98ⱷg\x1c\x13\x04\xc3\xc1m\xe3\xcc1\x1c\xea\x8crȪFY\xcfPT\xf5[\xb3\x03\x8e\xd0\x03F\xc0\x8f\x18\x81\x92\v\x94\\d\x05zr&\xf4>D\x127\xcd\n}\xdf\xe6\xdd\xeect\xe1\xdd\xd9\x00\x0f\xa3\rڦ\x81\xe9U\x1cO\r\a\xb7\xbb\xf5\xc0=~\x8d\x81\x90RGY\xe8\xa4D\xd4o\xf0\x1b\xc6\x00;\"2\u007f\xa8mx\b\x00\xe0\xa3\x18\xf3\x9f\xf9\xda\xfb\xf8ޣS\x90\xe8\xaag\x9c\x83v\x0e\xc6\xc6]\xffر6JC\xd9\x10\xacSr\x1e-\x82\x87\x91\xc28Q\xd0#\x88\x04%KfQq\x03\xc3X\xbcض\xf8\xa3\xab5V\xbdΟ\xcf\x19\x83\xa0t\xe7\xb9\x1dw\xc7?\xad\x8f\xfd\xd5\xc2Jp\x9cV%\x1e\xd5%*\xc9AG\xcc\x04\xa5a\v\xe4\xa4*\xa2GB\x00U\x8d6x\xb9ip\xd5\xf6\x01\xf8\t\x86\x89\b\x8c\x16\x1d\x8d\xa7\xfc^NP\xfa\xfa\xceZl\x95\xc6F\x19\\u\x81\xeaO`\x9f\xdc\xf1r\x93=\xf6\xcax\x8f\x17\xdb\x16[\xa5a\x89=ʎz\x1f2\x11\xa8'P\xc6F\x96$\x8e\x1d\x19\x05\xe3\f\x84\x02\x85(pv\xf2\b\xe3\f\x90\xeb\xf5\x156\xcd&\x8fߜs\x90B\xe2٣\xf7\xb1\x98\x9d\xe4QI\xf8\xdd(.W\xe7xu\xf9|\xcf\xf8\xeb\xa7;\x06\x00B*`\xd7w\x00\xfc\xef\x03\xf8\xd7\x17\xb3\xe5\xef\xed\x03\x80w \xe0\x81\x00\x80\x98\x17~\xdc\xf9{7D͎\xe7\xb6\xce;\b&\xf0\xec\xf4\t\xde[\x9e\xa1\xe4bP\x0f\xf3\x14\xaf[\x82\x8f.\xfcξ\xf4~\xd0Fd\x02\xac\xb3hU\x87\xebf\x83\xd5v\x03mu\x06\x0e7\xce{\xa3\xff\xb7\xd5\n\xba\xdb\xc2t-\x9cѣ\a\x97\xdci\xc4\xe0\xf7\xaaD\xeaz&RbZ\x16(\xa3\xf0\x8aQ\x16\xd2\xd6\b\x19\x99\xdf\xe0\x88\xda\u007f\xf4\x80GC\x12\xe7\x02m\x18hD\x97W\xd2h<\xac\x92\x8d19\xe8~\xe2\xaaS\xd4\x10\xe8\xe4\x80f}\f\x89\tT\xa7q\x0e&&\x05z\x17\xfd\xd2\x19\a\x97\x05dQA\x96\x15dQ\r\x80 \x9b\xa3\xf8;\x16\xa6\xc0\b\xf8=\xebߤ\x11H@\x80f \x10\x03\x97\xacB\xd3l\xd0tkh\xadn\xbc\x06)\xab\xe1\xd6\x1b5\x16\x9d\xaei\xd0m\xb7Y\xa3\xe2\xf1\x9a\xf5\xb4cc\x16\xbf\x9b\xcd(YP\xd9;?R\xce\xdf0\x06\b&Sn\xc8m\xf8\n\x00@\xeaԟL'\xf8g?~\x86\xa7\x93\x1aކ\x1c\te\x1dT\xba\x97\x9c\ae!\xfew\xab\x14Ze`\x9cǴ\x10;c:\xeb\\\xd6o$\x86\xc1\xfb\xe0\x83@c\xfc\xac\xf7@\xa3\r\xbeXo\xf1\x87\x97+l\xb5\xcd\x16\xbe\x92q0F\xf6\xccs<~\x96V\x1a\xbb^\x00G\xbaP\x1a\xd7\x1eGs}\xeb]\xb02\x86\xcf»\x8as\bF\"\xbb1Xr\x8f\x8f\x05\xeb|\x06_\xd6\xf9\x98@\xe9\x01\u007f\xbf\x89\x0f\xd9\xfb\xfd\x01\xe0\xa2\xef\xd1\x1a\v\x02\x02\xed\f:\xabG\x00d\xf7nb>\xb8\xa2\xa6\xbc\v`H\x02g\x9c\x822\x8a\xe5\xe2\x14\x93j\x9aG\xa5\xd6Y\\\\\xbd\x826z\xe7\xda\x11B\xf0x\xf9\x04\x8fO\x9f\x04\xe6\xd4\r\xf9!m\xb7\xc5\xf3\xf3\xcfa\xde\xc2/\xa0,\xea\xbcy\xf0f\x80/\xf8\x16\x18k<\x80\xff\x1e\xc0\u007f\xb9\x98-\x0f\xaa\xfd\xbb\xf5\xc0\a\x00\x00r^\xa4\x95\xf0\x1b\x03Y<<\xa6\xd5\x04\x1f?~\x86\xd3\xe9<v}\x1e4\xbaOUE\t\xce\xc4!տ_\x84}r\x8dr\xd0Fa\xddn\xb0\xe9Z4*\xec\xf1\xe7P\frS+\xe7ᭃ\xd1=L\xd7\xc0\xf4-|t\xbc\xc31Q\xd6k@@8\xe4\x19$\xe7\x98\xc8\x02\xb3\xb2\xc0$\x16\xfd2\x1a\x16\x91Q\x12\x99\xf7cc\x91\xe0u\x90\xe6\x90n\xff@\x18\x89\b\a\xff~\xecDЄ\xf7\xc1g\x91\xe0x\r)\x1dnι\x03\xf6\xc3{\xc0X\x1b|ҵB\xabt\x06\a&\xaeHaDk&\xdf\x04!\xcb\xc8\x10L!d\t\x9aG\x16w;\xc9\xd3h\xc0\xedY\xe0\x86\x03\x97\xa2\xe2r\x10\x9d\xa5\\\x04\xef\xa1u\x87\xa6Y\x87\xb0\x92\xa82\u007f\x1b6\x80\x10\x02\xd54h6\x9b\xbc&h\x8f\x01ś4\x16{\xfb\xe1\x94\x10T2Щd\x94\xaa(\x19=\xccP\x00\x82&#F\xf2\xb2# \x81<\x00\x00HE⻏O\xf1\x1bOΰ\x902\xaa\xd6CLpދ\x8f\xb4\xb3\xb2\x16\x9bNa\xab4*\xc1\x83\x81\xd0h\xa4\x90\n^\xf6\\0\x06\x82\xb2,x\xe4\x94\xc2z\x8fu\xaf\xf0\xd9j\x8b?\xbc\xb8Fk\x1c\xaa\xb8\xcf.9\v\xf1\xdc\x18\xec\u007fݟ`3\xad\xb1\x10uwSg\xff^\x1a\x9a\x8c\xe4\x11q\xbcx\x93l\x88\x94\xf6\xfb_\xf7\xdcܶ\x86\xec\x01l\xb4\xc6e\xa7vb\x04\x945P\xde\xde8N\xe0>4\v\xdaZX\xe3\"0\x1f~\xa2\x94\x02O\x1e=\x05\xe7<\xb3\x13m\xdf\xe2juyp\x9e{ﱘ-\xf0\xde\xd9\xfb\x90\xa2\x88\xb9\x1f\x81YRZ\xe1\xf9\xabϠT\xf7fc\x00.P\x97\x937\x1e\x03\x00\x801\x1aM\xb7\x85\xf7\xfe\x1aA\x10\xf8\xbf\xbe\x03\x00_\x05\x00\x98\x15!(\xcf\x1e\xfa9\xa7\xa2\xf7hq\x8a\xaf?}\x1f\x93\xa2\xc8FA\x85(\xb2}o\xeab\xfdȏ~\xff@v1i\xce9\x8bu\xb3\xc1\xc5\xfa\x1a\x8d\xeaG^\xdb\xc7\x1e\x93\xa1\xb0&\x15\xbf\xe9[\xd88\xdb'\xe4\xe6M\x82A\x1b0<\xec\x8c0TR`QWXN&\x98\x16\x05J\xc1!b\xf4qx\xcdq}\xcc\xfb]\a\xbd#\x0f5\x89v\xba4\x8a\xc7H\xb2\xeeL\x1b\x05\x04Y\x17\xe0\\\x88ʹֆ\xf5\x9e\xf8K\xedF\x9d\xba\xa1\x80\xef\x99\x18\xa5\x03\xcaX\x1b\xba\xfe$v2\x16\x8dRXw=Zm\xa0\xad\x89\x9f\x13\xe7\xc5dH\n\f\xc1\x83\x14B\x16\xa8\xa6\v\xd4\xd39\xb8(\x92:\xeeN` \\\x15::|\x86C\x96S\x862\xae\x0f\x8e\x0f>o\r\xba~\x8b\xa6\xdd@\xe9\xfe\xe6\xebuG\x17A\x02\xc0t-\xdam\x03m\xcc0\xd3=r\xfa\xfa\xd7\xd0\xff\xe9\xa3\xe0,\x8cr\xe8\xae\xf2}l\n\xb4\xe3\xb67J\xb1\xbb\x95\x05x\v\x00Pp\x86\xdf|\xf6\x04\xdf<\x99\xa3b4\x8f\x9aT\x04\x00\xbd\xb5бxm{\x85U\xd7\xc3:\x8fy!#7\x1d\xae\xb9\xf1\x1e|4\xaa1ޣ7\x16U\x14\xed\xf28\xea2\xce\xe1\xbaS\xf8\xf1\xf5\x06\u007f|\xb5B\xa3\r&\xb2\b&I,\x8c\x17\xc6s\xf6\x9f\xfb\xc3o\xc4\xcb'\xf3\x9f\xd7}0\x1aZ\x94\xc4\xec\xdd\xe5\x9a\x1e\xf7 \x01\x9eo;4\xc6\x04\xd6\xd5\xfb\fB\x8c\xb7\xb0H1ۻ9(\xd4\x130\x04\xfbnkl\xdc\xdc y\xc6\xef\x9c\xc7t2\xc5\xd9\xe9Yv[\x04\x80\xf5v\x85m\xbb=x\xb6\x9cw(d\x81\xf7\x1e=ì\x9ee\xad\x11\x10\xf6\xf2_^|\x89>\n\t\u007f\xdac\x00\x00h\xbb-T`\x0f\xff>\x80\u007f{1[~\xf6\x0e\x00|\x05\x00 \t\xcc\xf2\xaco\x94\x14\xf6ᣧ\xf8\xe8\xd1S\b\x16T\xabR\xec\xcd\xf9ɐ\x8b\xba_ȓkU\xda]mu\x87\x8b\xd5%V\xcd6\x98\x99\x90#E\u007f\xa7\x9b\xf3pZC\xb5\x1b\x98\xae\x81\x1f\x15N\xbc\xf6\x01\x1b\xe8Y\xce(&RbQ\x85\xa2\xbf\xa8+\x14\x9cg\x05\xfb\x81\xa2\xfc\x88\x83X\xb2\u007f\xa5\x94\x821\x16\xff\x89!B\xf48ӑi\xfcd=\xec\x87\xf7Ck\x05k\xddA\xbc\xe8\x98\xfeGJ\xfcc4\xaf'\xfa\x80$\xb2K\x99\x8a\xe6(&\xea\fZ\xad\xd1\xf4a\x8f_Y\x8bV)4q~<6v\xc9\xc6?\\\xa0\xa8'\xa8\xea\x19\x8aj\x12L\x8e\xee\xc1\n8P8r\x18\x8a#\x19\x0f{\xeb\x84\x0e拾\xb0V\xa3\xed6h\xdb-\x9c\xb7;Wo\u007fTt\x1b\x10 \xdeé\x1em\xd3Bi\x13\x8d\x9c\xf6\xae\xdd1.\xcb\x1f\xa3\x8cip\xaa\x1c\x15\xf4d\x0f\xbc\xff\xbd\xbc\v\amx\xfd>\xaa\xea\xc9!\x01\xf4\x96\x00 \x15\xd9\xf7g\x13|\xefl\x89\x0ff\x13\x14qvo\xbd\x8f\xd78\n\xdd\xe2={\xddvXw\n\x05\xe7\xa8G\xe6@Y\xf5=\xb2\xfa\xedM\x88\x97*#\xf8\x15q\x15\xae\xb7\x16Wm\x8f\x9f\\o\xf1\xc9\xd5\n\xeb^cRH\x94\x82\a\x1b\xdf\bB~!\x0e\xbf{`\xb5\xb1\x8f\xc1\xc0\xd2\xf9[\x9e\x0f\x1c\x18t\x13B0\xe1\x14?^mq\xdetG#\x86\xb5\xb7\xf0\x14Y\x94\xaaMd\x9dR\xfc\xf6h\x1c\x95b\xafǖ\xc6\xcb\xf9\x12u]g\x0f\x0e\xef=V\xebk\xf4\xba?\n\x02(e8;y\x84\xb3\xc5\xd9p.z\x0fc\xf4\x1b\x83\x80B\x961\xad\xf0-F=\xceFA\xa0\x03\x80\xbf\x02\xe0\xbfZ̖\xdd\x18\x00\x00\xef\xb4\x00o\xf5\f\x88\xa9\xf4κ\x9d\xbb?\x89E\xbe\xf5\xfe\x87x\xb2X\xc6\xf5#\x11V<D\xb0\xefͳ[\u007f\x9c\xee\x1f\x8a\x9f\v\xaa\xfe\xcd\n\x17\x9b\x15\xb4\xd5QHG\x0e\xe9\xfd\x91\x84\xde\x19\x05\xddl\xa0\xdbm\x10\xf5\x1d\xa1\xf9q\xe4\xe1\"\x00\xa4\xe0(\x85\xc0rR\xe3l:ż,\x02==\xa2\xefn\x1b\x11`D\x9fs\x1e\x8a>\xa5,v\xfa\xe4\b\xd30(\xfd\xc7Ѷ\t\x00\x8d7\x04\\\xb4K&{\xe3\x82\x1d;`2\xa4\x1d\x92\xc8\x13\x0eQ\xbd\x16\xd6\x18ظꤌ\x81\x8a\xd9\xe9\xd6ZtƠ\xe9u\x06\x02\x9b\xbeǦ\xeb\x0e\x80@.\xf6\x04`\\b2;\xc1d~\x02.\x8b\bb\xee&\x06r\x84\x1e\x8c\x06(\t\x9e\xe8\x89\r\b\xaf+\x18,%\xff\x00m\xd4a\xd6:٥]__9\x1d\xbc6\xe8\xba6\xac\n\xda]_\xf8\xbb\x14\xff\xf4\xb33\x1d\xceB\xac2H\xa0\xf9ǫu\xf9\xbe\xb6\x83p\xb37\x16\xb3\xaa\xb8y\xc1\xe4-\x00@\xb26\xfe\xe6\xc9\x1c\xdf^\xce\xf1lZ\xe7ٶu.\xc4\xe4F\x81\x9aC\x10\x86\xbeXoa\x9dǴ\x90\x19\x98\xa4\x15;>r\xf9\xf3\b\x19\x03\x8c\x10H\u0383\x9dm\xdcwo\xb5\xc1E\xdb\xe3\xd3\xd5\x06\x9f\\\xae\xb0V\x1a%稥Ģ*\xe1\u007fQ\x0e\xbf{\\\xa6\xb4Bx\x1f\x00\xb0;\x82\bϾ 4\xb05*\xe4T\xdcH\x81G\x16\xa0(8\x00\x1f\xf4C\xda\x05A\xe0\xe8\xf7\xb0\x91\x11L\xbe[),h\xb9X\x82s\x1eł\f\xce[\xac6\xd70\xc6\x1c=\xab\x01`6\x9d\xe3\xf1\xf21\x04\x97y\x84b\xac\xc1\x8b\x8b/\xee=\x0e\xa0\x94aRM\xef\x1d\x10\xb4\xff\xa1T\x8f\xaeo\xe1\xe1/\x01\xfc\xd6b\xb6\xfc[\xef\x00\xc0\x03>\x03\xbc\x16ޏ \xbdu\x16\xf3z\x82\xef|\xf01\xe6uX\xe7(D\x89\xb2(w\xd2\xfa\xd2#tl\xd7\xd5ǽwk\x1d\xae\xb6+\x9c\xaf/Ѫ\x1e\x04t\xaf\xe6\x0e\xc12i g\x8d\x82i\xb7\xd0]\x13g\xc67\xf5\xf9#\xcfr\x92K\x06N&5\x9e\x9d,p:\x9d\xa0\x962\x8b\xc7\x0e\x1e\xd6Q\x91M\x14~\xb0+\x8e\x8e|4\bk\bٛ\x05\x13\u007f|\x8b\x01\x83\xee`l*\x12\xd9\xf5\x1d\x0frD\r\xc1\x98\xed \xbb\xd5/\xbe.\x8a\x9d\xe9\xf6XG\xe0}\xa0\x01\xb5\x861\x066\x82\x80N)tZC\x9b`\x16\x93\x18\x00\x13\x81\xc0\xba\xeb\x83\xe3\x1bpt\xdcù@5\x9d\xa3\x9a\xceQ\x8c\xc2D\xeer\xd89B\xc2x`t\xa0\x16\x8c\xa3\x12\x12,\x8d\x85\xbc\xcbl@\xd75\xe8T\x1bC\x9bv/\xec\x9d\x00\xc0\x80X\xd1u]0\x0e2\x06F\x9b]Sh\xff\xfa\x03>\xddC<*\xe3\x83%n4\xb2\xda\xeb\xee\x01\xc0\x1a\x97\x0f\xf4M\xa7\xc0(Ţ.\x8eӾo\x01\x00R\xf7^\v\x8e\uf79d\xe0\xeb\x8b\x19Ϊ2\x8b\x10\x8ds贅v\x16\x9dsp\x00\xd6]\x8f\x8bm\v\xc9\x18&R\xe4\xf7\xc1Fp))\xcb?\xd1FMA\x11Y\x8c\xa4\x8b\x01<\xb6\xca\xe0\xbc\xe9\xf0\xd9j\x83O\xae\xd61͎b^\x148\x99Կ\x10\x81Z\xe4\xee\x97(\xd3\xff\x181u\xee\x1e\x00 yP41\x1b\x80\x82\xe0\xbaW\xbb\xde+\xe3\x19\x01<,<\xb8\xa0\x98\x94\x12\xb3\xaa@\xc5\x05\xbe8_c\xd3\xf6Y\xd3\xe1\xe1amr!\x1c@\x80\x94\x12'\xb3\x93\x9c\xf8\xc9\x18\x85\xb6\x1a\x9bf}\xe3\xb5s\xceaRM\xf0h\xf9\x04UQ\xe5\x93G\x1b\x8d\xe7\xe7\x9f\xc1\x9a\xfb\x19\xf3Ue\r)\x8a\xb7\xbaF\xc1\xf6x\v\x13~\xf6?\x00\xf0\xef,f˟\xec\u007f\xde;\x83\xa07|\x06X\xc9\xfdP\xfc\x1d\x1e/N\xf0\xad\xf7?D%K0\xcaP\x15!R\x92\x11v\xb0\xd3\u007f\x10\xfc\xe0\x01OBq\xdbt\r^\xad.\xb0n\xb6\x87\x94\xeex\xbe\x1e\xe5\xfeVuP\xed\x16VuG\xa9\xfe\xc3\xc2\x1f\xc1D\xfc\x94\x82\v|xz\x82\x0fO\x97(b\n\x97\xcb9\xe3\xbbH\x97\xc48b!\x04\x84\xe0\xc3\x1c?z\xa1ӝ\xf5\xb9]\x81\xd9\xceL.\x9a\xffp\xc6 8G!8\n)\xc2:\xd9(\xfaԻ\xb0\x12\xe8F\x86\x1e6\x16e\x1bw\xb8\xbdOֽÌՏ\xde'\x1bMH\xbcs\xa3XR\f:\x02\x1b\xf4\x05\xc6Z(\xa5\xb0m;4]\x8fV)\xac\xbb.\xd8ǒнn\xfa\x1e\xab\xae\xcb֯\xfb\x81K\x88\xf9\x04\xb2(\x03\x18\x98D\xad\x00\xee\n\x04\x06F`\xcc\x06H\xc6\a@\xe6,\xbc\xd3\xd0F\xa1\xef\xdb\xc0\x0687\xdc+\xf7\xd1\x0fE\xf0\xd8g\x10`\xa1U\x9f\xa9\xfaۊ\xc0x\r\x8cG\xcf\xfc\xa0\t\xd8]\x11\x1b\xa6\x19\x03\x8b\xa4\xacŪS8\xadK\x94\x92\uf080;\xd6\xc8\xdb>\xcdz\x8f\xf7&5\xbe{v\x82\x0f\xa6\x13Le\x98\xdd#\n\x00\xd3(\xa0\xb5\xc1h\xf6\xaai\xb1\xee\x14&2\xac\xb9\xf98\xae\xd0\xceAҰ\x0f\x9f~f2\xcc)y`\xb7ht\x02t\xdec\xd3k\xbclZ|\xb6\xda\xe2\xc7W+\xa8\x18\xaf\xbc\xa8*̫\xf2\x17&U\xf3\xd6n6;`\xee`\xcal\\t\xdb\xfde=2(O\x9e\x02\x94\x12\xb4\xda\xe2Z\xa9\xc3&*\x8dj\"\b}z6\xc5ǏO\xc0)\x852\x16_^\xac\xf1\xf9\xab\x15zm\xa2\xee(0_\xd6Z\xb8QXQUV\x98O\xe7\x839\x1a<\xda~\x1bͺn2)r\xa8d\x85\xc7gOQ\x15U\xde.jU\x83\x8b\xab\x97\xf0\xfb`\xfdu\xa0\x89qL\xaaɎ\x01ܛ|\x18k\xe2\xd8\xd0Y\x00\u007f\x15\xc0o\xefo\x05\xbc\x03\x00o\x01\x00R\x87\xf7\xec\xec1\xbe\xf1\xf4\x19\x84\b\xf6\xbdUQ\xe7\xae\u007fL\xe5\xdcd\xe6\xe3\xbdök\xf1j}\x89U\xb3\xc9\xf6\xa3\x87\x85\u007f\xd4\xf1\xf7\x1dt\xbb\x81Q\x1d|\xa2\xfaG\u007f\xef\x8f=\x88\xa9\xb0P\x82RH,'5><=\xc1\xa2\xae\x0e2\xc0\xc7E\x85s\x8e\xa2\x88)\x84\x82\aZ?ŀ\xe10\x8b~L\xc7'\xb7\xbf\xa1\xd0K\x14<\x14|)8\x04\x1b6\avB\xcf\xf31\x10U\xf9ы\xdc'W\xc0d\x0ed\x1dz\xad\xa1M\x10\xf1)c\xa0\x8d\x89\x9f\xe3\xf2\xe7'\x11\xa0w\xc9Y\xd0\r{\xf0#v\xc1;\a\xa55\x9a\xaeæiq\xbdmpݴ\xe8M\x88\vu\xdea\xdd\xf5Xw\x1d\xd4\x11F ]ODK\xe2z:\xc7tq\nQVw\xd6\t8\x90\xe8'\x10\xde\x01AY\xb6\x15N#\x01o\r\x9c\xd5!\xc69\xb2\x01o\xa3\x1e\xee\xfb\x1e\xbaW\xb0\xd6@+5h[n:\xdc\xf7\x00\a\x89\xdd\x1e\xa7\x14R\x1c\x8e|\xf2X\xc7\rn\x8dWm\x0f\n\xe0lZ\x81\xb3\xe1\xf5>\x14\x00H\xdf\xef;\xcb\x05\xbe\xb9\x9c\xe3qU\xa2\xe4<\x8b\x16;\x13\x12\x0f[c\xc38\xc0\x03\x97M\x8b^\x1bTR\f\xabpq\xab\xa4\xe6\x02)\x03\x04\b\xd1\u0094\x90\xec\xc3\xcfi\x00\x01\xda9lz\x8d\xe7\xdb\x16\x9f^o\xf0\x93\xebu\x06t\x8b\xaa¬,\ue677\xf9\xf3\r\x00\xe8\xc8\xfc\xc9\xc6\x18\xe2\xdbp\xa9\xb1\x1e\xd7]\bVcG\x12\x16_n[\x80\x1e2\xa9\x89\x99d\x8c\xa2\x94\x1c\xdf\xfd\xf81\x04\x0f\xec\f%\x14m\xaf\xf1\xe9\xabk|y\xbe\x86\x89\xe7\xacwA\x13\xe0F\"\xe2\xe9d\x86\xba\xaa\xe1}\xf8\xbbm\xb3\x81\xf1&\x18\t\xdd\xd8u;\x14\xb2ģ\xe5c\b.\xc2\xeb\xf4\x1e\xdaj\\^\xbf\xbcWbi]M\xc2H\xe1-?\xba\xbeE\x1f\xc6\x10\xaf\x00\xfc\xfb\x00\xfe\xd6b\xb6\xf4\xfb\xe3\x80w#\x81{\x02\x00Z0\x0f\x00\x1f=~\x8a\x8f\x1e?\x85\xe02'Hq\x96\x8a$=\x98\xcb\x06a\xda\xe0$ը\x16\xe7\xabK\\o\xd7a\xa5o\x8c\xfaƎz\xf1F\xb7ZA7k\x98\xae\r\x85?u\xa29\x9e4\x1a\x91\xc4\x19\xb2\xd2:\b^b\xf4\xe6\xa4,\xb0\x9cL\xf0h6\xc1\xbc*\xf3z\x0ev\xc8w\x801\x06)\x04\xca2\xe4\x11$\x16#l,\x1c\x12\x82\t=\x13\x84\x9fS\x16\x05\xea\xb8\x1e(\x05\x0f\xb6Ƒ%\x10\x8cE\xe1ر\x9dq\x1f\x15\xbe\x87\fB\xa6\x05\xa3;\x19\x1b\xad\x9c\xe5|t\xe7`\xac\x89\xc6Ba\a9\xa5\x1e\xa6\x8d\x81\xb0F\x18\xc0\x82\xd2\x06\x9d\xd2P#\x10a\x13Pp\x0e]\xdf\xe3z\xd3\xe0|\xb5\xc2嶁u>&\xe69l\xba\x0e\xab\xd7\x00\x81\x94O@\x19C5[`v\x0f \xe0A\xe2h`\b\xa6\x11,\xb8\t\x06\xad\x84\x83w\x06\xce(\x18\xa3ѩ\x06\xce\x19\xbcq$\x0f\x01T\xaf\xa1\xba\x0e\xd6h\x18m\xf2\x01v\xd3\xe1Nv\xa7/\x99\xdd\x11\x8cA\nz\xe0\x85?\x06\x00\x88\xb4{o,j)pR\x95\xc8۬\x0f\x04\x00\x12\xd0(\x18ï?9\xc5\xc7\xf3\x19fR\xa0\x1c\x89\x02;ca\xbd\v\xf1\xb31\bi\xd5\x04\xddGJS\xf4\b\x0e~\x822\x14\x8c\r\xeaqO\xa0\x9d\x85\x8c\xaf\x19\bqɜQ\xf4\xd6b\xdbk|\xb9i\xf0\xc7W+|\xb9n\xb2\x85\xee\xb4(C\x92_\x1c\x13\xfd\"\x03\x80\x94\x1eH\t\x19\xf9s\xf8\xd7\x16\u007fm\x1d.\xb7]4w\";\xcfVb\x9e\xce\xdb\x1e[\xa5\x83\xfb\xe4(\xf6\x9a\x10D\xedQH\xb5|v:\xc3\xd7\xdf[\xc6\xe0\"\x9f\x01ê\xe9\xf0\xc9\xf3+\\\xac\x9a\x81]\x8c\x1b]\x88MKY\xd6\xe0\x8c\xa3\xd7\nm\xdfƸv\n.\x18\xe8\r\xb6\xd7\xde;\x94E\x85\xd3\xc5Y\x0e8#\x84\xa0S\x1d\xaeV\xe7 w\x84}\x82\vTo\xb9\x12\x98G\x01\xc1\x1b\x00\x00\xfe7\x00\xff\xd1b\xb6\xfc\xf2\x1d\x00xK\x00 *\xe1\xbf\xfe\xf4\x19\x9e.\xcf \x85DUN \xb9\x04g\f\x9c\xf1\x03\x91[6\x92\x89\xa7[\xdbw\xb8X_\xe3\xaaY\xc1\x18{\xa4\x18\x8e|\xef\t\x85\xb3\x1az\xbb\x81\ued91\xea\x1fnf\x12\x0f\xdeJJL\xa4\x84\x14\x1c\x00\t\x1d\xb1\r\xa2\xb7YU\xe2l6\xc1\xa2,!\x85\x88\x11\xaf\x83x\x8e\x90A\xa9\x9f\n\xbf\x10\"o+\xec\xda\b\xefb\x94\x14\v[\x95\x05fU\x8dIU\x84|\x83\xa8{H\xdd=g\f\xb5\x14\xf1`\x1dla\xc7̈\xc7n\xa1N\xe6)\xe3yz:H\xf3\xd7\x11Dw\x0f\u007f0\xefO\xa6.\xc6\xc4\x15?\xb7o%\x9c\xc0\x83\x836\x06m\xdfc\xddt\xd84\r\xba(\x90sޢW\x1a\xd7\xdb-^\\^\xe3\xbai\xe0\\H\xe1\xb3\xceE\x8d@\x87>\n\x85\x0er!\xe2ϣ\x8c\xa1\x9e-0Y\x9cB\x96՝\x04\x83\xc9C \x01\x01N(J!\xe2~y`9\xac\xee\xa0u\a\xa5\xba \x9c|㻚\xc0h\x8d\xae\xed`t\x18\t8k\x8f\x16\xa9\x9b\x00\x00b\xe4\xab\xe0,\xc7\xf1\x8e\xef\xff\x04\x00\b!\xd8\xf4=:\x15@ˢ.PG3\x9e\x87\x04\x00\t\x04,\x8a\x02\u007f\xea\xe9\x19\x9eMk\x94<\x14rJ\x82=\xad\x8a\xec\xd0E\xdf\xc3\xc6\xd5\xd3붋3\xec\xe4V\x19\x18\xa3*\xee\xf6\aN\x80\xe4{\xb4\xe0<w\xaa2j!Z\x1d\xc2n>[o\xf1\x87\x17+\\\xb4]P\xa43\x8aJH\xcc˰*\xf8\x8b\x8a\x01rtpZ\xef\x1d\xc5\x14\xdfV\xfcC\x14\xf3\xbe\x15\xfa\xe0Z\xd8h\x8b\x97\x9b&\x00Αm3\x00p\x1eƋ\x89\xed\xfc\x95\x8f\x9f`Z\xc9Qz\xa1ύ\xcf˫-~\xfc\xe2\x12\xebm\x9fϩt\xae\xa4\xb3\xcb9\x0f\xe3\xcc\x0esAY\x00\x02\xe4\x06-״\x9e\xe2d~\x9a\xb5X\x94\x10l\xbb-V\xeb\x8b;AtB\b\xeaj\xbac\x10\xf7V\xa3\x80n\v\xe7\\\x0f\xe0?\a\xf0?\xbc.1\xf0\xddX\xe0\x0e\xd7\xe7{\xdf\xfe\x86?\x9b\x9d@\b\x89R\x86\xf5>\xc1%\x04\xe7;\x17q\xa8\xe5q\xa5\xaf\xefp\xb1\xbe\xc2U\xb3\x81\xb57X\xef\x8e\x14\xde\xce;\xa8\xa4\xea7\x06$\x9aN$\x06^r\x8eZ\x16\xa8\n\x99s\xb5\x93\x8d\xac\xe4\x1c\x93\"\xb8\xf3UR\x80\x82d\xb1K\xb2fME\x9fs\x01\xc1\xd3|_\xec(\xf2\xf7\u007f\xa7\xb4kK\b\x81\x10\x1cӲ\xc2|R\xa1\x10\x024\xda\xfe\xa6\uf6d8\x83Rp\xcc\n\xb9\x13\xb22\x9e\xc7\xef?@~4\xcbOt\xbe\xf3\xbb9\xe3>[\xac\x0ea$C6I4\x11J\xccE\xa4\xf9\x94\x0e*\xff0&H\x99\xe8nG\x9f\xe1\x11\x02\x95\xae\xb7[\x9c_\xaf\xb0ٶ0\xd6\xc0Y\x87\xdeh\\n\x1a\xbc\xba^a\xb5\r@\x80\xd1\x10\x10\xb3\xeez\\\xb7-t\x8a\x95=<\x19\xe0\xbc\ac\x1c\xd5l\x1e\x18\x81\xb8\xf2s\x1b\x10H\xfa\x80\xc4\x06H\xce!(\x8f\uf3c5\xd5-\x8cj\xa0M\xff\xc6,@\x8aVpƢo[\xa8^\xc1E7\xbd\xfd\xfdmv\xb0}0\xba\xa2\x11ؕ\x82\xe7T\xba8\xdbȇhR\xcdo;\x9d\xcdbN'%8gw\x02\x00\xf7\xad\x99\xd6{<\xad+\xfcS\xcf\x1e\xe3\xac* )\x83\x8c\xd4}\x02\x00\xad\xb1x\xbei`\xbcG\xa7u\xbe\xb6H\xf7`,(E\xb4\xf1v\xd1C!\xad\x9a\x95\x9c\xe7\xa9X\x11\xf3\x00Zc\xb1\xea\x15~r\xb5Ə.\xae\xd1\xea0J\x12\x9cA2\x86Yܴ\xf9Ee\x02\xc6\xf4\u007f\xeai\x8e\xbdVB\x80V\x19\\\xb5}\xb4\x97\xa6\xa3\xf3a0\x06K\xa0\xc2y\x8f/7\r\xb4\x8d\x81TlP\xf9\x13\x82\xe0QB\t\xac\xf38[\xd4\xf8\xa5\x0f\x1f\xc55L\xbf\x93+\xc0(\x81\xd2\x16_^6\xf8\xec\xe5\n\x9dR \x94\x80\x12\xb6+v$1d˘A\x80L\x00&b\x9e\xc9\x11\x10\xb0\x98\x9d`1=\xc9^\x05\x94\x12\xb4]\x83\xab\xd5\xf9\x9d\xee`)\nTe\x85\x87\b\xdaV:n\x05x\xff{\x00\xfe\x8d\xc5l\xf9\x83w\x00\xe0\xcd?\xd8/\u007f\xed\x1b\xdf\x17\\F+\xdf\"\xac\xf9q\x1eӲ\xe8A\xe7o\x9d\xc5\xf9\xea\n\x9f_\xbc\xc0\xa6k\xb3\x9d\xebM\x0eo \x80\xe9:t\xebK\xe8\xae\x01\x9c\rsW\x1a\xd6j\xa4\xe0X\xd65N\xa6\x13TR\x86Y\x96\x0f\x0fC%%N\xa7\x13\x9cM'\x98U%$c\xd9\x17\x1c\x91\"\x93B\xa2(\n\x94E\x81\xb2\xacP\x96%\x8aB\xe6\x9b9\xad\xe5\x91H\x87q! \x85\x80\x90\x12\xb2(0\xad*\x9c.fx4\x9fc>\xa9QH\t\xc69\x84\x94a\xce/%\n\xc1!\x18ô,0-\x8a{\xa5f\xe5\x9f\x1d;&NYdWb*[\\\xbd\xdawLN\x00\x85\xd2\x11\xb8\x89\"E\x11\xb5\a\x85\x90\x10\x11\x9c\xe4\u007fv\"\x84B8Ǭ\xaa\xb0\x9cMQ\x97%\xac\xf3\xd0\u0382z\xa0\x14\x02\x93*\xd87+\x13F\a\x94\x10TB`*e\xa0Ӎ\xc9q\xb4\xe3S.0*\x0e}ע\xdd\\\xc3\x1a\x05.\x04\x98\x90\xaf}\xcc3\a\x10\xc7\x0262\x19\xf9k\x18\xcfL\xd1({\xf9\xcd\xd0m\x14z\x86\x11\x86\xcb\xf7㮐\x93\x1c)\xfec\f\x10\x0e餒ǈm\xc9\x00\xce\xfb<>IlT\xc1Y\x06\xb8\xb7L,\xee]\x88\xd6:\xb8?>\xae\xeb\xe8\xe7?\xe4V8\xf8\fj.\xdbn\x14l\xb5;\x05\x89^W\xe1w\x8e1\xb9\xf0\x83|%\xed\xbc;\xefC\x02!\t\xac\x8d`\f\xd6y\\\xf7*\x8cor\xc7k\x0f\xbc\x13~\xb1X\x00\xf2Z\xbdC\xea\xea\u05fd\xc2U\xdb\xc1\xc3\xe7\xe4\xcc\xc4\x1b\xa4\xfb\x81\xe6\xee<0\x9eI\xc4I\xf6Ϋ\xf4\x1c\xb3\b\n\xda^\xa3*\x04f\xb5̩\xa4\xa9\xcbw\xdeCp\x81\x0f\x9e>\xc3\aO\xdf\x03%\f۶\x856&\x8b\t\x93ԅDMט\x8dL\xc2\xd6ckν\xea\xc1\x19G!\x8bxVQ\x14\xd1\xfa\xbd\xeb\xbb\xdbA\xbfw`)\n\xfem\v\x16\v \xd3Z\xfb\b@\u05eb\xee\xef\x96Ee\x8e}\xee\u007f\xfa\x9f\xfd\x17\xef*\xfcm\xef\xe77?\xfa\xf8\xfbuQ\xe7\xb9?\x8b\x86?\xbb\xe2\xbd\xf0?\xdbn\x8bOϟ\xe3|s\x15o\x16z܉/\xf9\x8e\xf7=\xba\xf5\x15\xd4v\x05o-\xd2Y\x1b\xe8.\x86I!\xb1\x9cNPJ\x19ʂ\x0f\xc6*\xf3\xaa\xc4\xd9t\x82\xd3I\x8d:\x82\x82\xd413\xc6P\x14\x05\xaa2\x00\x16\x11\vzQ\x94\x10\"\xdddd\xb4\xc3\xcf!\xa3\xf0OH\t!C\xf1\x9f\xd6\x15\x1e\xcdg\xb90\n\x1e\xd2\xf5\x84\x94\xe0\x82\x87\xce4F\xafrB\xc2H\x82\xb3\x87=P\xe2\b E\t\xb3H\xe7\xe6\x03\x82М\xb6\xb7\xf3\xf0\xf8\x01\xf5K\xc1Q\xa4m\x86\xf8\xb9,mk\xe4N!\\\xabIUb9\x9fBp\x0ee-\xbcw\x104\xe4\xccs\xce\xd1\xf4\n:n`PB0)\x02#\xe3\\\xf0\x1a\xc0\xbe> \x03\x01\x0f\xd55h\xd6+X\xad d\x01\xc6卧%\x01\x90\xa3\x86\bb:\"\x86\xfc\aJ\x01*\x02\x00\xf0\xe6\xad@\x00\b\x81(\x8a\x90;aͰ\xd6\x19\x83eȍ\xa6R\xc3ѝ\n\xa1H\xc1W{BS\x10\xb2\xf3\xfeh\xe7\xc0b\xb1$\xe4\xab)FW}P\x8f?\xae\xcb숙|\v\xac\v\xaa~\x00\xd8j}\xb3\xd8+v\x91.\x99T\x8d\x06dd\xb4\xf7\x9e_{\x1c\vpJ\xd1\x19\x83ML\x0f\f\xa6a4\x83ű\xb3\xe6/D\xf1\xc7\r\xc6T#\xbd\xb2\x83\xc7U\xd3c\xd5\x05\x0f}\xc1\xc2\xda\xe0\x88\xd3\v\x02SF\xf66\xa9¿7\xca\xec$\x15\x0ek~\t\xa8\x06F\xaf\xed4\xce\xe6u\xcc$\xc0H;\x85`\xf7-\xa7\xa8\xab\x1a\xcf\x1e?\xc6ӳ3\x00\x04\xab\xed\x16\xc6Z\x90\x18Ж@\b\x8b\xcdG6*\x8b\xf1\xe3\xd8˹\xf0ޣW\x1d\x04\x17\x01\x04\xa4\x11\x85\b\x96\xbf\xbd\xee\x0fWy\x8fP]\x9c\x8b\xb7\xd6\x02$\x10\xe0\xbc#\xce\xd9_\x05\xf0\x0fˢ\xfa\xfdw\x00\xe0\r\xef\xed\u007f\xf5\xcf\xfe9_ʰ\xe3O\xb0\xe7\xc9>\n\xbbyqu\x8e\x17W\xaf`\xbd;\\\xff\x1b\x17\x04\x00F\xf5P\xdbu\xf0\xea\x8fiu$\"\xdb \x9cc(\x04Ǥ,\xe2\xf7\n4\xff\xac(#\x95\xc8\xf2z[*^\x82s\x88X\xa8\xd2ϧ4(\xfb9\xe7yf;\xec\xf2\xd3\xc1H'\xaesQJQH\x81YU\xa2\x922\xa8\xf6\xe9\xee\xae?!\x04bTp\v\xc6Bb\xdcO\xeb@\x1b1.\x83\xc5\xe2ݺH\x1fM\x81\xda^\xa1\xd7:\xac\x05\xb9\xc1&\x14#\xfaq\xdbv\xf8\xec\xe59.Vk\x18\xa3\xa1\x94\xc6˫k\xfc\xf8\xe59\xb4\xb5#\x1a2\xfc\xb9\xed{\\5\r:m\x0eV\a\xf7G\x03\\\b\xccO\x9f`2?\x01\xa1\xf4ƌ\x89\xdc!\xc4m\x01B\xf6\f\x91\xe0\xe1T\v\xaf\xef'\xea\xf17\xbc\xaf\xaa\xeb\xd07\r\\ڛvnG\xcct\f\n\x102\xdc\xd7\x05\x0f\xf7mʴH\xba\x11\x0f`\xdd\xf6\xd9w?t\xd0\x04\xcb*\x8e\x02\x1ep\x040\xd6\x03pJ\xf1O?{\x82o/\xe7A\xc5\x1fM\x8c\xb4\x0f\xc1A\xce{|\xba\xde\xe0\xaa\xed\x92+\xf0\r\xb7\x1b\xd9\xd1\xc6\xe4\xf9~\xa4\x9f\x11\xb3\x0f\x04\xa5\xd0\xceA[\x87OW[\xfc\xee\xf3W\xb8\xeeT\x06\xad\"\x8e\x00\x12[V\xfc\x9c\x8f\x04\xc6;\xfc\x94\x1c\xca\xde\x12%\xae\xad\xc5e\xd3Ǳ\b )ň,\xca\xe5\x9f\x1d\xd9(a\xf1\xfb~\xba\xda\xe6\xfb'\x00\x83 \x82&t07#\x11ܽ\xffh\x8eo\xbe\u007f\x1aY\x9bᷪ\xaa\x13\x14\xc5,\xb2\x8aњ\x9cP\xbc\xba\xbc\xc2\x0f>\xf9c|y\xfe\n\xdey\xb0\b\xe6|f\x86\x1c\xb45\xb0y\xfd:\x04\fq\xc1w@\x00\xa5\x14g'\x8f0\x9b\xcc\xc7\xc6'\x80\xb78\xbfz\x85\xae\u07fe\xf6\xfd\xac\xcb\t\x84\x90\x0fsm\xbcC\xd350F\xff\x00\xc0\xbf\xbb\x98-\xff\xd1\xfe缳\n\xbeC\xb9\xf9\xb7\xfe\xc5\u007f\xd9sƇn~\x84B\t\xa1\xd0\xd6\xe0\x8b\xf3\xe7\xb8\xd8\\\xed\xa6\xfb\x1d)ZV\xf5\xc1\xbd\xafo\u1cc9\x0f\xb2\xa1J\xe8\x1e\x82_|UHPBQp\x86y]bZ\x14\x10Q\xf0\x92\x84m\x8cQH) \x84\xccJ\xf9\xf4\xf3\xd3\x1e?cA\xc9\xcaX\x12\xb2\fŉF\xcb^\x12#}gU\x89I\x11G\x1cy\xa6\xb7\xeb\xff\x9e\x9c\xd6\xd2ZT\xc1\x19\b~\xca\xdd\xcc[\x80\x8dD#\xf6\xda`۵\xe8z=\x14S\xb7+@\xb4\xce\xe1\xf3W\xe7\xf8\xe2\xfc\x12\xd6ht]\x8f\x97\x17\x97\xf8\xe2\xfa\x1a\x9b^\x0f\x02\xb9\xf4\xf9\xdecն\xb8j\xda\xc3\x15Ͻ\xb9!\x00\x14U\x8d\xc5\xd9\x13\x14\xf5\xf4֍\x81\xb0\xab\x1e2\x06\xf6\xff\u0099\x0e^mq\xf7\x04Û\xdfW\xd3\xf7\xe8\x9a\x06\xc6\x04M\x00IV\xcdG\x00\xc0\xf0\xab\f\"\xcfJp\bJaSfD\xfc\xa9\x9bNAY7\x02c@%yTɓ\xd7t\xe1o\xfea\xbd\xc7L\n\xfcs\x1f\xbe\x87\xa7\x93:h*\xe2s\xa0\x9d\xcd\xc5\xfa\x93\xab5\x1a\xa3o@\x00#a\xdbިAr\x86*\xba\xc9\x01\x88\x946\xc9\xe9x?:\xbf\xc2\xef>\xbf\x80\x8a\x801\x01\xed\xd4\xc1N\xcb\x02S)\u007f\xee\xb7\x04\xd2\xfc\u007f\x87\xf4A\xd0\xeb6\xbd\xc6U\xdbCېM\"(\x01'tg\fG\x81\x18\x8f\xee\x0f\x82\xc2RR㗛-\xd6\xf1\x99\x1b\xb3\x0ea\xa4B\x06A`|o\xbf\xf7\x8d\xf7\xb0\x98\x14#A A]/Q\x14\xd3\xdcФߗ3\x06c<>??Ǐ~\xf2\t^]^\xe4\r\x81\xacg\x88\xeb\xc3Z\x9b,\xc0\xa5\x8c\x84uA\xba\xebrz2[\xe2d\xbe\xcc\xd75\x81\x89\x8b\xebWh\xdb\xf5͝;e\xa8\x1f\xc0\x1d0\x83`g\xd1t\x8d\xb7\xd6\xfcm\x00\u007fa1[\xfe\xd1M\x9f\xfb\x0e\f\xdcpM\xfe\x89\xef\xfc\xca\xf7\x87\xa402\x12\x86P\xb4\xaaǏ_|\x86U\xb3>0\x01\x1a\x1f\xaa\xceht\xab+\xf4\xeb+X\xd5\az5\x1e\xa0\x8cRHƢ\x9a:t\xdfu!Q\n\x81\xd3i\x8dG\xb3)&E\x01\x16).\xef\x03B-\xcb\x12\x93\xba\x82\x942\x8b\xf0\xd2\xef)\x84@Y\x06ʟq\x96\x11o\x1a]\xf0H\xe73\xce!\xa5\xc0\xbc\x0e\x96\xc0Ӫ\f\xf9\xd9cA\x0f\xc8\u0381\x97,?\x8b(n\"ckڟ\x03\x00\x90io\xceQ\xc9\x00\x9cB\\\xb0\xdf\xc9HH\x88~>\x99\x80s\x8auӆtG\xceQ\v\x01\x8a nsy\xd4\x13\x00R]H\xd4R\x84,\x02c\x0f\xc7\x02\xa3\xf1\x86Q\n\xcdf\x05k\r\x84,\xc18\xbfq\x1dow,\xb0\xeb\xabK(\x0f\xb1\xa5V\xbd\xfda\xce9\bc\xc1\x88h\xaf\xf0\x93=\xe7v2Rn\xa5\x039u\xde\xfb\xc5;\xd80\xfb\x9d\xf0)\xe3\\\xf6\x14 _\x01\x83D\tAk,Zc\xf0tZC2\x16\x85\x88\xbb\x85\xb8\xe0\x1c\x1b\xa5\x8e\xaa\xd6o\n\xe3J~\x1a\x94\xc4P\xa4\x98z\x99\xd87N)\xa6R\xa23\x16\x97]\a\x8c\xf2*\xc6V\xc3*\x8e\xfe\xf8\xe8Y\xca\x1b-{bX\xf2'ul\xb0\xc7x\x05\xca\x1fX\xb5=\xae\xda\x0e\xd6ŵaJ\xb2\xfdr\xfa\n\n2\xbc\xf6\x9c\x9bBr\xf7\x8f\x18\xc9,\x19\x8b逻w\xa1G\x14\x00\xc7\xe7/\xb1\x00\xda:<ZL\x86\xb3\x8c\x10HY\x831\x19\x9f\xf1A\xfd\xef\xe3\xa6\xd2r6\xc7Gｏ\xe9d\x8a\xd5v\x83\xb6\xefóMS\xdc3\r\x8cUb_]\xf0'!\x14;\xe7\u007f\xdb7\xd0Z\xa1,*p6\xac\bN\xaa\t\x8c\xb5\xd07\x04~\xa5{#1\xb6o\u007fY(\x18e\xc4X\xfb5\xef\xfdI\xaf\xba\xbfW\x16\xd5\xd1*߫\x0e\u007f\xf1/\xfd\xe5w\x15\xff\x18\x00ع\xdd\xe2E\xbeڮ\U000135df\xa3\xd3\xddq\xc4\x16?O\xb7[t\xd7\x17\xb0}\x97H\xa5\xdc\xf1\v\x1e\xa2I9\xa3\x98\x14\x05\x16\x93\t&\x85ļ.\xf1h\x16\xd2\xf8\x12\xb5\x96\nRY\x14\xa8\xeb:\xac\xf8\x8d\xbc\xf7\xd3\x01!\xa5DUUC\xc7O\xa2#\x1f\xe7\x90B\x04\xc0\x10\xff}>\xa9p:\x9bbVU`\xaf\x99߇\x9d\xef!\xd5/\xa4\x9f\xb1\x9d9\xd8\xcf\x13\v0\xfe\x1eR\b\x14R\xe4\xee?\x8b\x8cF=ʬ\xae\xc0\x19\xc7\xf5z\x03\xc6\x19\nY`\"%*\xc1r\xd4\xf0\x98}I\x14\xaf`\f\x9d1\xe1\x00\xbcI\x04\n\x0f\xd56h\xb7+\x00\x80,k\x10Jo\x05\x02~l\xf3H\x00\xc2\xc6 \xc0\xbf\xd5\x01\x92r\x1dRB%\xa2\x9b\xdaM\xf4x\xea\x92\xc7\"\xb0\xe1\xde\b]\x9d\xb1\x1e&v\x81\xe3\xe2j\x9d\x8f\x1b\x04\xe4+\x03\x01\xd7J\x83\x82\xe0ɤ\x02\x8dl\x05\x8b]\xa0\x85\x87\x8c\"\xb2\x8d\xd28.\xab\x1c\x04bc`c\xe3\xae;\xa7t\xc7\a\x9fQ\x9a\xd3\n'R\xe0\xbaSX+\xbd3\xc2IE\xd3:\ae\r\x8cuQ\xe1\x1eVN\xed\xc8\x1bĎB\xb9\xfc\rE\xf7gZ\xffG\xcf#!\x04\xc6z\\4\x1d6q\xdeO\xe2\xc8G\x8cl\xa3\tB#\xc1\x19š\xddH\xd4WD\xfb\xe9ӺD%8\x9eo\x9a\x83\x00!\x8f\xf1\xe6\x00\xc9n\x8d]\xafQG\x8b\xe0 \xe6\xf4`\xac\x00\xe7et\xde\x1b\x83\x80P,C\xbe\x05\xc3\xe3\xe5\x19>|\xef\x19\x9cs\xb8\xbc\xbe\x8a\x81@\x83\x9e+\t\x8f\x93\x8b\xa9K\xde\x03#\xddA\xafz\xb4\xaaEYT\x10L\xe4ǹ\xaajh\xa3a\x8c\xba\xb1k\u007f(A`:\xbb)%\xc4Z\xfb\xab\x1e\xfe\xb2W\xdd\xff}L\x14\xf8\x0e\x00\xdcp\x16\xfe\xfa\xb7\u007f\xf9\xfb\xc3CO`\xbc×\x17/\xf1\xe5ŋH\xf3\x1e/\xfeV\xf5\xe8V\x17A\xe07\x12\x80\xa4\x8e\xa3\x10<\xdb\xe4.\xea\x1a\xcbI\x8dI\xf4\x11\x9f\x95\xc1f؏\x02r\x8a\xa2\xc0\xa4\x0e\xb6\xc3Iў)\xfd(\xe8\x93RB\xca\xc1̇\v\x9e\xff\x9b\x10\x02\\\x04\x8d\xc0\xb4\xaap:\x9dbZ\x96\x91Yاx\xc7\x0f\x17\x81\xa0,\xaf\xdf0B#\x13\xb0ߑ\xfc\f\x0e\xa4\a*\x18\x8cQH\xc1\xe3\xea\x1a٩\x9fI\x1d>\xad*\b\xc1q\xbd\xd9\x02 \x90\x85D]\x16(\x18\v\xa8\xdeZ\x8c\xb2\x9a\"\x1d.P\x172\xfc\xbd\xb9\xc9\xc1/\xed ;t\xdb5T߀Q\x0e!\x8bxP\xdd`\xd03\xda\x14\xc8߉\x85\xed\x14\xef\xcc[\xd3\xe94\x8e\x8e\xe0}t\x1f\xa4\x83Jz\xd4ŧ}8::\xc0\x03\xed9X=\x13\x84\xc46\xe3\xdcA(PZ\xbb\x13\x91]\xfa\xaa\ue84b\xae\xc7L\n\x9cV%|\xdc\x0fg\xf1\xf0\xb7\x1e\xa88\x87q\x1e\x8d6\a\xcf\xc28 h\xdf\xc0Ҹ`!+\xf7Fa\x89\r\x99\xc8\x00\xf2ϛ\x0e\xdd\xf8\x1e\xf0Ê\x99s\x1e\xdaZ\xf4\xda\x04\x16!v\xb4և\xff\x9eL\xab\xac\x0f\x99\x05iM\xf6 \xad\xf3\x0e\xef\xdfA\xb8\xd4\x03\x01\x00\x12\xd7q\x95qx\xb5mѥ\xf7\x91\x00%\xa7\a\xe3\xb0t\xa6\x1c\xd7]\x84g\xa2`\f\x8f\xea\x12E\xbc7Λ.\xb0ji-(23>v\xe7$\xa6\x83\xd2\xf8\xf6\xb6J\xe3\xd1b2P\xf1\xc4C\xc8\t(a;\xc0.\xfc92\x1b\x03P\xc8\x02\x1f>}\x86\x93\xd9\t.\xaf\xaf\xd0v]\xd4\x06\f\xfe\x01\xc9\x04λ\xc1]\x94\x8eF\xb1\xc6jt}\x00\x01\x9c\x8b|ͫ\xb2\x86\xd2=\xac57^#\xf1@\x82\xc04Z\x00\xc0\xac\xb3\xff$\x80\xdf\xe9U\xf7\xc3\xfd$\xc2^u\xf8o\xfe\xeao\xbf\x03\x01\a\x00\xe0;\xbf\xf2\xfd$\xd1\xea\x8d\xc6g\xaf\xbe\xc4\xe5\xe6*\xee\x90\x1ezT\x83\x10\xa8\xcd5\xba\xebsXՏ\xe8S@F\x9a_\xb0\x80@+)\xf1x>â\xae0\x8b\xfe\xe1\x82\xf3\x9dd5\xce9&u\xbd\xd3\xd5\x0f\xa859b\xf1\xfc\x8f\x10\x02B\n\xc8\"8\xfb\xf1\xb8\xef/\x84\x84\x94\x02\x8b\xba\n\xc6$\xe3\x10\x97#Im\xc95\xb0\x10A\x94\x98:'\xc99\x18\xc1A\xce\xfb\xcf\b\x02<\x1c\b\xa0\f\x8c2(\xad\xb3 \xd2G:\xd2\xc7M\x81I\x19<\x10\xd6m\vg\x1dhtB\x14\x8c\x86\xac\x81\xd1\xca^V\x02\xd3\xc0\x06x\x00\xbd6\xafy\x19i,У\xdd\\\xa3\xef۰\x95!\xe4kV\xabb\x978V\xea\xd38F\xf0\xf6\xed\xdfژ\xff\xe0\"\b\xd8G\x11\x99a\xc2\x11s\xa6\x98\x16\x98~3emdB\x0e/\x9f\xb6!\x89O\xb0\xaff\x14@\xa2>\xe3\xa2\xed\xf1\xa8.1+dt\xba\xa4ye\xd5\x13\xa0\x12\x1c\x9d\ti\x91\a\xc5\xf4`\f\xe0\U000da809s\xe6\xb4\x05\x13쀇gb*\x05\x1c\x80\x8b\xe6H\xe2\xe4\xe8\xa9s1\xc6\xd88\x9b\xad\x89\xd3\x06\x88\xf5A\x98i\x9c\x8b\xacS\xfc\xd3\xd8\f\x12\xb4\xb50.\x82\x84\x11`H_\x97l\xb2\xc7\x1b\f\x0fŲ\x10B\xb0\xed5Λ6Xq#0#O\xa6A\x91\xaf\xa2\x80.mJܴ*L\xe2\x98r\"9N\xeb2\xdbbsJ\xb1\x1a1)c\xc7\xc0\xfc\x9e\xd3aC\x88\x12\x12\x9e7\x02\x9c\xce\xea\xf8~\x1a0\xca\xc1y\xb5\xc7\xec$\x133:\xac\x0eҰ\xeduz\xb2ć\xef}\x80\xb6\xefpy}=Z\x19\x8c[\v\x84F\xa0\f8\a8\xebv>\xc7Z\x8b\xb6oQ\x95U\xa4\xf6\xc3ϫ\xca\tڮ9\xba\x1d\xe0\x9c\v\xe3\x86\a0\aJ\x1fQ\x1fQ;\xe7~\x03\xc0\xdf+\x8b\xea\xf9>\x00\x00\xf0\x0e\x00\xecׅ\xef}+0\x00M\xdf\xe2'/?G\xd35a\x8d\fGN2g\xd1^\x9dCm\xaf\xe3\x1a\xd50?/\xa5@\x11\xd7\u007f<\x80YU\xe2\xf1\"\x14\xffy\x15\xact\xf7Oǲ(0\x99L\xb2aϸ觃\x8ds\x9e\xbb\xff\xaa\xae!\xcb\"P\xfdB@\x14\x02R\x04\x8d\x80\xe0\f\x8b\xa2\xc8\xebOGΟܘ$f\xa3\x8a\xab~\xe9\x93$\x0f\afڇ\xfd\x13\x01\x00\x1e\x10\x04p\xc6PH\x91\u05f5\xb2\xf1G\x96\xb3;Te\x01\xc196]\x17\x1fT@J\t\xce8\x9c\xb5\x80\x1f(۱g\xc1\xa4\x90 \x94\xa07济pɥ\xcc\xf4=\xda\xcd\x1a\xf0\x80(\n\xd0QJ\xddA\xf75\xa2N1\xee0\xdf\xc6-0uu\xd1c\xc1Y\x1b\xbd\x02\x86\n\x97\x19\x81\xd1/C\xf6B\x8eҌU[w\x14\x00\xe4Nں\xecٰ\xb3\xe8\xf1\x80E*\xcc\xe3\xfb\xec\x12\x98_c\xbc\xa7I4\xf9\xd9j\rm\x1d\x8e\xa2\x95\\\xacw\xaf\xc1Xϐ\xd4\xe3\xe1\xfb\x06 4\x11\x02\x9d\xb1\xb8\xee\xfb\xc3TD\xb2\xfb\xec8\x0fhgw̔\xc6\xcfg\x1aN%&\xc0z\x0f\xe3\x02@\xd0\xd6\xc6\x00\xa4\x04\n\x821V\xfa{\xeb\xc2ȃ\xddB1\x1fMn\xbc\xe9\xd1\xf3\x04\xd7m\x8f\xab\xae\xcb\xda\b\xc1(>\x98O0\xaf$\xac\r\xb1Ј]=\xbd1\x1b:\xac\a\x9eT\x05\x16e\xb1\xb3Y@)\x81\xb2\x0e\xaf\x9a\xee(\x00H\xb7\xffx\xe6N@\xb0\xe9\x14\xa6U\x81\xba\f\x01h\xc6\xf4\xe0\xbc\x00\xe7\xc5\xce9:\xb0\xa9\xe3{\x9f\xc3{\xa0\x94\x05\xbe\xf6\xec#0\xca\xf0\xfc\xfc\x15|\xda\xf4\xca\xe71\x8d\xab\xc5\x14\x044\x80\x002\x84\x15Yk\xd1\xf5-\xaa\xa2\x1e@\x00\xa1(\x8b\n\xdbvs\xf4N\xb7\xce \x89\xcf\x1f\x8a\xa7a\x8c\xc39\xf7\xc49\xfb\xeb\xbd\xea\xfeA\xaf\xbaW\x89\t(\x8b\neQ\xe1\xbf\xfbk\xff\xed\xbb\xf5\xc01\x00\xf8\xd5o\xff\xf2\xf7/\xd7\xd7\xf8\xfc\xe2y0\x829rA\b\xa1\xf0Ơ\xbd|\t\xd3mcLm\x14\xb2D\xeb^\x1e\xfd\x03\b\b\x16u\x88\xe4]\xd6Uv\xee\xf3{\ao]\x95\xa8\xeb:\x1f\x88\xfb\x87\x00e4t\xfcR\xa0\xaek\xd4\xd3)\x8a2\xee\xf2G\x81_\x1aO0J0/J\xc8\x1b(\xb7\xf4\xc0'\xfb\\\xc9Y\x06+Y\xe1\xccF\x87[\xfc\x9e7!\xf8\x9f\xdd0\xf2\x01vh)EU\x84U\x1c\x93\xd4\xdbi\xccC\xc2{TEmF\xd3+$q\x17\xe7\f\x8csXc\xc1\xa2\"ڍ\xa9YBPK\t\xc1x\x00\x01\xb7$\xf1%#\xa1\xae٠o\xb6\x10E\t!\x8b\xe3\xeej㑀'9C\x00\xa3Q\xc0\xdb\x14Ӕ\x04i\xf3\x98#\xa0\x00:\x06\x1fY-\x1f~\x1a\x01١ε\t\x1d\xe9\r\xf5?\x142\xf8\xac-\xf9*\xee\"J\b\xd6Jc\xd5k|8\x9f\x06\v\xdaQǘ\xe6\xf9\x82Q\xac{\x1d~ߛ69\x8e\xfc\u007fc]\xd6\x13\xf8\xd1\xcft\x1e(8\x87\xe4\x14\x9b\xde`\xab\x14\xf6\x02\xae\x0fhy\x0f\x12\xa8\xe5\x1d! 9\n\xfe\xf6g\xe2\xe9\xcft\x0f\xa6qAb\x03\x04\xe3\xe0\x9c\x1d\xbc\x86\xd7\xc5\xff\x1e\x1b\x0f\xd2\xc8~\\4-6J\xe7\r\x9b\x821|0\x9f\xa2\x92\"{\xee\x8fw\xf7qt\xd4\bԂ\xe3Q]\xa2\x96\xfc\x86s\x85\xe0Ŷ\x19\t3w\x1f}\x9fG\x11d\xf4\xde{t\xca\xe0l1\x01\xa5\xc1\xeeW\xeb.:\xa2\xca!*}\u007f\x14\x10\x1b\x00FY\x16\x1a~\xf0\xe4=̧3|\xf1\xf29̨\x16d\x10\x90\xd2R\t\x83\xb3\xc1\x0e~\x00\x01\x06\x9d\xee0\xa9\xa6\x91\x92\xf7\xd1J\x9e\xa2훣\xf7\xbbs\xeeAG\x01aT\xcc\xe1\x9c\xfd\xc8y\xf7\xa7\x00\xfc\xa3^u_\x96E\xb5s\xc9\xdf\x01\x80Q=X,f\xdf\u007f\xb5\xba\xcc7\xf0\xb1\xa2c\xda-ګW\xb0Z\xc5\xe2\x1f\x8b&\xe7\xc1ϝ\x0e\x85x9\x9d\xe0ó%\xe6u\x9c\xbf﹏Q\x02L\xea\t\xaa\xaa\x1c\xed\xde\xef\x16\xff\xb0\xfe\x17\xc4~\x93\xd9\x14e]\xe5\x04\xbfc\xdb\bS\x19\xec\x83o\x92\x869\xefa\"\xaa\rk\\,?P\xc9\v?\x8d-\\\xa4\xc3پx\xe7\x17\x04\x00d\xf6E\n\bΣ\xd0\xcbe\xb1\xa5G\b\x13)\v\tc\x1cz\xa5\xc1\"\x05\xc8(\x05(\x81\xd2:k&\xf6#w˸}\xa0\xac\x85\xf1\xee\xf5\xefV\xa2\x11\x8dF\xb7]\x83K\tY\xdc\x1c3;$\t\x04H\xe0L\x0f\xc0=\xc8\x01B\xe3n\xb4u\xd1\x03!V\x18B\xf7J\x84\xf7\xa3\xf5\xd1\xe8\x9b\x11\xfd\x17\x9c\xbf\xb9\xa3\f\x87d\xf8~\x82\xb2\xaf\xccW\x82\x11\x82˾Gc\f>\x9aM\x83\x0e \x15\x8ex\u007f'\xab\xed\x8d\xd6\xe1w&\xb7\x03\x80\xe0\x1886D\"1E\x80\xe4\x9d\xf7\x89\xe0\xf0\xf0X+\x8d\xce\x04\xc7O\xdc\x10u\x9bF\x80\xe9\x1f\x17\xe3jwb\xc2o\xb1\xc0 \xa3\xbd\r?\xb2\xc6%\x84\xa0\x10\xe2\x9e\xfd#vf\xe44\xb26\xe7M\x8bN\xdbl\x983\x11\x02\xcf\xe6\x13\x94\x9c\xc3\xc6\xdfv^ʐ\x99\xa0̍'Ʋ*#\xe5\u007fs\x8c\x0e\xa3\x04\xaf\xb61\x94k\\\xb8G\xc0\xc29\x1fM\x80\xc2\xf7a\x84\xa0\xd7\x16\x9cQ\x9cL\xabx\xdb:h\xdd\x00\x84B\x882w\xff\xc9+%\xfd\xbb\x87\x03\xa3<\x87\xfd\xc0\x03\x8fN\xcf\xf0x\xf9\b_\xbc|\x8eNuq}q\u05f8(\xfd\xe3\x1d\t  \x8e\x15\xb4\xd6p\xceaZM\xf3\xfb\x18\xdc\x02[\xd8#\xba\x9d\xb0nH3k\xf0`  l\x06|\xe4\xbd\xff\xd3\x00~\xb7W\xddOƚ\x80w\x00`t\xcf\xcd\xe7\xb3\xef\x1f]\xf1\x8b\xff\xbf_]\xa2[]\x1c\xe4ЗB\xa0\x14\"\x1fd\x923\xbcw\xb2\xc0\x87\xa7KTB\x1c\xd0\xc0I\xe5?\x9dLP\x96E\x16Q\xedj\x86<\x84\x10\x98L\xa7\x98\xc5\u009f\x1c\xfa\xf6S\xabȈ:\x9bHy@\x99\xe5\xae?\x1e.\x05\x1b\xba\xfe0\x06\xf0Y\xb4UD\xa3\x1f7\xa2\x1a%c7\x16\x96\x9f\x15\b\xf0\xc0\xd1\xd7\xf9\xa6ߋ1\x86R\x06\x04n\x8c\xc9\xff\xcdEZUp\x8aM\xdb\xc5q\x01͆(\xc6\xd9\x00\x02bb\x9c\x1d\xed\xf8{\x84-\x81YQ\xc0E\x8bܻ<\xb4\xce9\xb4\x9b\x15\x00\x82\xb2\x9e\xbc\xe6\xbdG\x16hz\x00Ψ\x9d\xf7\xe5\xad\x1e\x86h3\xea\xa2\aF\b}\xf1;I\x8d\xc3b\xc0\xc0\\\x85\x00\xa6\xbb\xbc\xce0*\x10\x9c\x1d5\x85yH&\xe0UӁ\x12\x82\xf7\xa7\x93\x9d\xf7\r\x84\x04Q\xa0\bq\xbf\xad>\xe2\xf0\x18\u007fY\xbf\xc7\x10\x10\x00&^k\xceHN\xd5LA9)G\xc0X\x87u\xaf`\xdc\xcd]\xf1\xa0\xf5\x19ć9\xdez\x14r\x95\xf4)\xbb>\x05\xe3\xad\x052\xfa\x9cH/\xfb\x18jt\xc4=\x13{\x91\xbc\xa9\xd36Ρ\xd5a\xfe\xce\x19\x852\x16\xaf6\x1d\x94q\x00\t\xaf\xe3\xa4*\xf1tV\x83S\x16\x1a\aB\xb0(%&B\xc2y\x1fW-1\x02%\xe1\xb7;\xabK\x9cTž\xfe\xf6Ȉ\x8e\x06\x06\xa7\xd3\xc3*\xdfx\x84\x92L\x9b\xbc\xcfN\x80i\x14\xd5\xf4\x1a\xcby5r+\xf5P\xaa\x81\xb5\n\x9c\x17`L\x1c\xbc\xf7\xe9\xf3\x18\xe3\xd1\xce=\xa8\xfeO\xe6\v<{\xfc\x14\xcf\xcf_\xa2i\x9b(\xfc\xdb\x15\xc7&=\x02\xfc0'K\xdb\x01R\x04k\xf9d\x94$\xb8Ħ\xdd\x1c}\xdd\xd6\xda`5M\x1f\xcee5\x89ȍ5\xcf\x00\xffg\x01\xfcA\xaf\xba?.\x8bʽ\x03\x00{g\xde{O\x9e|\xff(\xe5o\r\xba\xcbW\xd0\xcd:\xae\x90\fk0UL\xeaK`rZ\x16\xf8\xf8\xd1)\x9e\xceg\xb1\xeb\xf7\aş1\x86\xd9t\x82\xb2(\x0ev~\xd3\xe7O&\x13\x9c,OP\xd7a\x96\x14\x92\xaa\x8e\xaf\x8c\x8cw\xb7w\xd2\xdaF\x0f\x9f\x8b^\xf2\x92\xb3\x1c\x9bjӬЧ\xd7\"\xc0\"\xd5g\xacˇ\b\xa74\xfa\xac\xff\xc9\x00\x00\xe9\xe0P\xf1\xa0\xa2\xc9\xc8\xe3\x01>\xa4Hl\x80\x831\x06\x8c\xb2\\\xd4:\xa5\xa0\xe2\xc1\x98\x0e\x02\n\xa0\xd7:\x8c\x10\xe8`y\xebF\x870\xa34\xaeyR\xf4\xc6\xdc:qM\xbb\xc7m\xb3\x81\xd1\ne5\x01e\xec\xe6uA\x820Ä\u007f8\x10\x10WJ\x93p\x89\xa4{9\nF\xc9h\x14\x90\xc6\x04\xde{hc\xf3Zۭ\xd71\xd2\xd6)\\\xe8+\x11\x05\xc6\u007f^6-N\xab\x02\xcb(Ҥy\x14\x10>\xaf\xe0\x1c\xad1P\xd6\x1d\xee\xbac\x14]\xbd\x0f\x02\xac\x8f\f ˟\x97|\a$\xa3q\x9em\xb1\x8a&T,y\t\xec\as\x8d؉\xa4\r\x19\x83\x81a5\xd0g\xbb\xe2\x81\xf2\x1f\xfd\u007f\xb7\xcb$\xd8h]\x9d\x8c\xc7\xc6FNtt\x1b\x1ak\xd1*\x8du\xd7a\xd5\xf5h\x95\n\x8ex\xcegs\x1fJ\bfR\xe2Ѥ¬\x94\xa0$4\x0f\x9cQ\x9c\xd6Ѽ,\xea!6}x&\x10\x9b\fJ\x81'\x93\x1a\xd3B\xec\xac7\xe6\x1c\x06r\bܬsx\xb1\xed\xf2x\x92\x80\xec\x01\x00\xe4m\xed1E\x1f\\\x01\tN\xe7U|d\xa2\xe8\xd6\xf4P*l\xf6\x046`\\̳\xe0%\xdb\x02\x13B\xe1\x9c\xc3l2\xc1\x87O?\xc0\xc5\xf5%\xae7\xeb\x81\t\x18o璘\x17\xe3\xc9N\xf4\xa56\x1a\xd3z\x1a6\b\xbc\x87\x10\x12J\xf7\xd0F\xdf\x00\x02L\x1c\x05<\\\x8e\x04\x8b\xd7\xdd:s\n\xe0_\x00\xf0\xa2WݏʢR\xef\x00\xc0\xe8}z\xfa\xf8\xf1\xf7\xf7\x0fb\xd3wh.^\xc0\xaa._\x142\x9a\xf7\x8b\x18\x13L@p6\x9d\xe1\xebO\xce0/\xcb\xe3\xb3\xc3X\xfc\xa7\x93\td\x12\xfb\xed9\x0eRJq\xb2\\bq\xb2\x88.}\xa1\x13\xe5)\xc6\xf7X\a\xb13V ٫<\xdd\xd26f\xa2\xb3HU\x9a<o\xcc\xec\"\n\xc1\xc1)\x85J\xfb\xe0{\xab]\x92\xb1\xd7ҏ?U\xf6\x1f!˽\xd1\x1a\xd6\x01\xca\xd9<\xd3}[:y\xcc\x06\xa4\x00 \xebC\xc0˦i\xa2\xe1\x8f\xcf\xe8\x9a\x04.\x12}L\x9aC<\xe0\xf9(\xac)\xcc\x15c\x9e\x80\x10\xe8M\x88!\x1d;\x94\xdd4\x12P]\x8b\xbe\xddB\x96\x15\x84(^\xfb\x9e0\x1e\xba/o\xf4ȝ\xf4-\x9c\x14S\xea\xa2\xf7\xb9\xd3\x0fV\xc6~D\xa5\x0e\x16\xc0&\xba\xed\xddg\x8acc\x1e\xbbx\r\xcb\xf4\x10T\xa8q\x1e\x97]\x8f\x8f\xe7S\x88Q\xa6;\x8d\xab\x81\x9c\x92\x1c\xf7{L\xbf@bA\" \xd8ۈ\x85\xb6>\x9f\tI_C\"\xbd]Dব\xc3F\xe9\f\b\xd3\xf6\xc9\x18\xf4\xef\xb3\x0fcf\xe0p\x1c\xb1\xfbԹ\xf4L;\xb7\xbb:\xe8}.\xee&>\xdbɸ\xaa3\x06\x8dRX\xb5\x1d\xd6},\xfaт7\xa8\xf9\x1dVm\xc8İ\u03a2\x96\x02\xef/\xa61\xee\xd8C;\x8f\xa7\xb3\x1a\xdf~\xb4\xc4V\xe9\xfc{QJ\xb0U\n\x9d\xb6\x11\\1<\x9d֘\x14\"$P\xee\x84\t\x91\xb8\xa5\xb1\x0f\xba\xc2\xf5x\xd5t\xe1w\x1a\x9d\x95d\xec\x89\x11\x1e\xbf\x98!24f\xbd28\x99U!\x95q\x04*\xe0\x1dT\xdf@\xeb\x16\x9cK0&wV\xac\x01\aFYH\x0e\x8c\xa2Q\xe7=\xea\xb2\xc2\xd7>\xf8\b\xab\xcd\x1a\x17W\x97\x19\x04\xec\xe8b\"\x1b@@\xe0\xe28@\x1b\x1d\x92B\x8b\x1a\xc9k\x84Q\x86M\xb3\xbea\xdc\x14B\xe6\x04\x97\x0f\xfa<\xf0\xc4\xe89;\x8d \x80\xf4\xaa\xfb\xff\xfe\xe2_\xfa\xcb\xdbw\xa5?|\xfc\xff\x03\x00\\\x04\xa9\x96\xd3\xd6D\xff\x00\x00\x00\x00IEND\xaeB`\x82")
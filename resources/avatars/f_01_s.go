@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_01_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x000\u007fzTXtRaw profile type exif\x00\x00xڭ\x9ci\x96$\xb7\x91\xad\xffc\x15Z\x02\xe6a9\x80\x018\xa7w\xf0\x96\xdf\xdfEde\x95\xc8\x12E=5y\xc4*eF\xb8\xc3\x01\xb3;\x18\f\xee\xce\xff\xfb\x9f\xeb\xfe\xf1\x8f\u007f\x84\x10Kw\xb9\xb4^G\xad\x9e\u007f\xf2\xc8#N\xfe\xd2\xfd\xcf\u007f\xceן\x9f\x9f\x05\x9f\xdf\u007f\u007f\xfc\x13\xbe\xfe\x1b\xdco\u007f\x11\xf93\xf1g\xfa\xfc\xb0\xe5\xafߦ\xaf\x9f\xff\xf8|\xfd\xfe\x93\v\xfd\xe6\x17\xa1\xfc\xe1\v\xe9\xfb6\xf1\xd7\x1b\xb7\xf9\xf5\xf3\xe8\xe3?\x8d\xa8\x8d\xb8\xfc\xaf\xff\xf4\x9f\xff\xbbw\xf7{\xcf\xe7\xe9f\xaeLC\xfd<\xd4\xe7\x16\xee\xc7e\xf8 \x17\xc9\xe9}\xad\xf2o\xe3\u007f\x85\xbf\xb7\xf7\xef\xe0\xdf\ue9f7\x90\xfd\xf6\xe6\x17\xffZ\x18!\x86\xe4o\xc8a\xbb0\xc3\r'l\xfe\xb4`\x8c1\xc7\x13\x1b\u007f\xc6h1\xbd\x9f\xf5\xd4∖|\n)\xeb\xdfpcK#\xed\xd4SL\x16OJ)\xbb\x14\xbf\xc7\x12\xde}ǻ\x9f\x85Νw\xe0\xa31p\xb1\xc0W\xfe\xf2_\xf7\xef>\xf0w\xfe\xbd\xd7<s\x14\x02O\x1f\xbe\xe6\x8aqŨu\b\x9aƤ\xff\xf21\x16$ܯu+o\x82\u007f\xfc\xfb\xfd\x8f\xfbea\x13+X\xde4w\x1ep\xfa\xf5\xb9\xc4*\xe1gl\xa5\x17\x00\x89\xcf\x15\xfe\xfc\xc4Wh[\xab\x16_\x94d\xee]\x18LH,\x81\xaf!\x95P\x83o1\xb6\x10r\x8a\x9d\x05\x9a\x8c<\xa6\x1c\x17+\x10J\x89\x9bAƜRem:qĽ\xf9N\vﳱ\xc4\xcf\xcfI\x15֧\xa4\x9a\x1ak3\xd2d\xb1r.\xc4O˝\x18\x9a%\x95\\J\xa9\xa5\x95^F\x99\xae\xa6\x9ak\xa9\xb5\xb6\xaa\x9c\x9b-\xb5\xdcJ\xab\xad\xb5\xdeF\x9b=\xf5\xdcK\xaf\xbd\xf5\xdeG\x9f#\x8eDJ\x96QG\x1b}\x8c1'\xf7\x9c\xd9\xcd2\xf9\xf6\xe4\x13s\xae\xb8\xd2ʫ\xac\xba\xda\xeak\xaci\x84\x8fe+V\xadY\xb7asǝv\xdee\xd7\xddv\xdfc\xcf\x13\x0e\xa1\xe4N>\xe5\xd4\xd3N?\xe3\xccK\xac\xddt\xf3-\xb7\xdev\xfb\x1dw~\xaf\xdaת\xfe\xe9\xdf\xff`\xd5\xc2תŷR\xfa\\\xfb^5~ښ.\xf4.\x11\x843EkƊ\xc5\x1cX\xf1\xa6\x15 \xa0\xa3\xd6\xcc\xf7\x90s\xd4\xcai\xcd\xfc\x88dE\x89\f\xb2hmv\xf0Ӆ\xca\x12\xe6\x03\xc8\xdd\xf0\xbdv?W\xeeo\xaf\x9bc\xae\xffݺſ\xb3rNK\xf7\u007f\xb0rѝ\xf4\x87u\xfbͪm!\xa1\xbd\x15\xfbd\xa1\xe6\xd4'\xb2\xef\x02)}\xa4\x1b\xbc\x9b\x99)\xb3Po\x8a\x9b\xbb\x1b#\x8a\xf3\xf8YOd\xe9N\xbb\xcb\xd6\xdd6ob\x11y\xba\xc3\xd7N\x0fs\xdc\xdb\x0ep\xc8\xc5\u009ek\xb8\xbbrk\xd7:\xd8t\xf5\xe3y\xc8!\xfe< \xd1nvv\xf6\xe1\\.\x028\xed\xd8\xee\xf6,\xc0=\xd7>\x9fb\x1a\xf8v\xca\xcd\xe5\xa1\x1f\x80\x9fY\u007f\xae\xeb\x9b\xfe\xecg\xb7N\x10|_\xc5\xff\xe6:?\xaf\xc2E\xdc\xfd\\\xe3s\x85?}\xff\xafG\xf1\xeb \xdc\u007f3\x8a_\a\xe1\xfe\x9bQ\xfc:\b\xf7\xaeRl\xe5\x1d'K\x9d\xadְ\xca\xe8\xd6b\x12\xd4\xddQ\xe1\x06\x82\xdbF\x84r\xdf\xfdN\xfa\xf3x\xdd_\x0f\xf8\x8dw\xe4\xbd\x1aYp\xf69!\xef\xb36!\x11n\x1a%\x8f\xb0`%Vx\xb9\x13W\x89Q\x03j\xf94\xa2>\xf6V\x89\x86\xd5a\xa6[\xcfi\xfb\x10&Dy8#\xd4E\xb8D\xbb\xa4\x0eQ\x96\xdbl\xb9\x86\xb1\x12P\xbb\xfd\xb0\xe6\xd3\x1bl\xda\\\xf1\xd4\xcbc\xcc5\xa7\xbf\xf1رF\xda\xdd>c\xb3F(\xb6r\b\xbc4\xf7\x80t{^\xb3\a\xf36\xbb\xb3R\x93-~\x95\xe1\xf6T\x8b7\xee\xe3C\xae\x9d\x91նb\xde7\x18_\xef<\r\xcf0+\x0f\xbf\xea\x9b\xea\xcbD\xcea\x038l\xae\xdc\x02\xf4\x1cr\xaa\xb6\x93<?կ\x97\xb51\xd6\x0ea\xdd8\xbb͕\xda\r\xb1_\xf25pò\x9b\x12\x10\xdc볅\xbb\xf9\xb6+\xfc莑>\x8bX\x00\x1e֤\xf5qJI\x8b\xb4\xd9`\xd8%Qħ\xca+\xb2.\x9c\r\xce\xf1\xdc\xe4\xe9\n\xb3\xec\v\xa5\xbaV\xb9j\x1c,*\xc8\xc7`\xf4q\xb4B\xd1\xfaճA\x82~W\"e\x97\xf5^yN\xa4\x03!\x92yZ#l4\xb2Xk7\b\x92\xd5\x12\\\xe4\xfe\x9d˗\x00L\xac\x1c\x01ʂ\x1f\xf3\xa5\xf4\xd5\xea\xe2~\x84\xa8\xfe\x13y\xbe\xb3\xb2ﷅ͐kw,\x1e\xa4\x99\x15\x01\x99\x88H\xa5\x8e\xc4j\x82\xdc\x00<\x01p\a(\xb5\xcb9@\x14\xcb\x18\xb2\xa2\xb5\x18\x93zc\x06LZY\xe7֔\x9c\x85\xb3Ʊ\xba\x8e\x04\x8fB\xb3\xf5|\xee9\x05\x02\xee0\xf7B\x01\xd5\x17\x1c\xf1\xec\x18;\xc1\x1a%\x1aoJ\x80ԝaz\x16~\xbb\x04\xc2~\x00\xea\x04\xa4Yg\xd6O\x8f\xfeeT\xe3c\xef7\x91\x87\xd7b\x14n\xfb\x99\x80\x89\x0e\xd02\x11X\xef7ˁ\xa2\xe7ƿu\xb1YW&27y\xb2ȓ\x1ew\xf4\xeb\xe4:f\x1b\xc1\xc1F\x85U\xca-\x1a\x11μ\\\xbe\xd0}Z\x95\xb5\f(\xb6\x89@=\xdd \xb8\xc1\xcf\xfd䦑\xdf\x1b\x03\x02x\x99C_\x15\xa3\xe0\x11\xe15\x19\xc3\xf6\xb5\xcc\v#\xac\xd3\a\x8b\nF\x9f\x1c\xb8\x9bƳ\xa1\xaa\xfb\x01\xfb\xc1t\xb6ʃ\xbc+~\xff\xe9~\xf9\xc1\x80\xee\x06#\x9e\x13'P\x93t1\xd8Q\xd1̫_\xafE\x80\xfd\n\v\xe0\x97\xed#\xf1F\x8a\x13B\xc0|\xf5n\xa3[\xc9\xf0u\xe1\xb6Q\xc8?ۡ\xa1T\x89\xee\x9a\xc6<Pc'/\x03\xc1>\xa1\xef\xe6\xed\x12\xeb#\xf4\xe3\xdbYe3\xfa\x95\xce\x18\xae\xd6\x05\xbd25p\xac\xb4\xdbX&f\a)l\x10;\x8dU0DY\"\xc1K&i|L\xbd\xde5\xdbj\xc1\xc8=\xac\xcc$\xfe\xb6\xf3\xa76\x00e\x1c\u007f2\xb0\xa8\xf8+0/\x81\xef\xcbޣ\xef\x96\xd6&\xe0\"\x1fQ\x041\xff\xd3\xfa\xd8@[\"\xdd\xc0\xb0I:\xa1\xd8FY+O\xa8S\xac\\\xfc\b\x03\xbdr\x1a\xe4\x8d\xc2\xe1\x97\xfb\xa2\x19\xc2h\xe1Lx=h){\x8d\xfb\xd4\xc1\xbc\x85Ax\xac5\xe6(\x0e\x1c\xe2\x19J\xe0\xe7<\xc5n9W(\xfd\x04\xa8\xfe\x14a\xe0\x8b&\xc2&\xe6\n\"\x15(\xbdޝ\x990K)\x10\xd2\xfa&\x92\xc3\x15\xb0\x9c\xcfn\xa1枌Ѹy^\xbe\xa6[\"\x19\xb0+`\xd2\xc1I/\xe4銙@\b\x92\xac\x1d`\xbc=\xed[uU\aA\xf8\x8b\xaa\xb9\xc3\xc8\xe5[\x94]e\xe4\x9c00\r\x10Gu\xd8\xe9\f\x92\xf8 uQ`\apN\x823F\xc8Cp\xebj9:\xbbai\x80\xe4L\xdfD\x05\xa2\x18\xacZ(\xb8f\x13,2\xc04\x0f\"9\xef\r\xba\xec\x16X\x89XHd\xa6\xee\x05\xe9b\xf6\x969dY\xee@,\xcbA4\xccL\x00\x86r\xf6,\xfc\x9c\xa4\xc0\f\xb6\x85\x12Z\xa0m\x1e\x1b^\x1b\xbb\xc0sİ1\xfe\t\x9e\fB\xd9X5\xee\xf2\xd8\x03WE\xc6\x114d]m\xdb\x00\xd9N\x04t\xc8\x00-E:\xf7\xc0\\\xc3k\xc4\x0e̖\xea\t\x8b\xd09\xfcN\xd1\xecl\xd5\x12I\xe5\xed\xf3\nqU\b\xf3\xf1>!x?\x10q,\x96\xba\x9f\xe4\x01\xf6~\xf9Џ\x8f\xbc\x0f8>\xa1ߓ\xcfgC3p\xeb-\xfc\x96̹F,\x81V\xa4\x0f\xa3\xae\x0fL\x00\x19h\xe5w0\xe0\xf4\xeb/$\xe0o\x1f  \xdb\xff5\x14|\x80@B⟀\xc0\xfd\x82\x04\x9f?\xa7\xedQ\x98\xc9v\x13ٰwj\x8b\xeb\x1b\xb2\x98Ec\xb8\x84\x167\xbc[T3$\x9f\x1b\x93\x1ap\x90\x84\xaf\xf4\"j\x9aP\xe4\x02\x8b\xd8\xf4\xf9\xb2\xa2\r\xbe\x05M\xc0O0s\xb1\xb8ҟ03\x91\x95\t\xefٌd\x06]Hr\x1b\x0ejE+27\x11\xde+\x1d~\xa97\xf2L\xb0\xcbK\x8e\n:\xadS\x10\xf7\xb1@\xf9\xb9\xecR\x03*\x88\f\x00`A\x8a\x96\x98D\x94?\xeb\xc8`y\x84\x88\xfa\x87\xf8\xe1ų\xc2j\x88\x9b\xb6\x0e6\xfb\x82$0%h\x0e\xf0\xf1\x80s\x92\xd2\x04&XS\x1aQ\x81^@\x95_\x17\xd0\x1f\x11\xa5\u007f\aք\x90b\xb0|5\x0fD\xb2\xa1\x85\x17kY\x0f\xe9\x15#\xee\x13\x95\x85\xdc\x02T\x8e\xd6!\xe9\x0e\x16\xd2*\t}\x84\xdc\xee\xe2\x88\x19PɌ\n\xbb\x01N\x18ssR\xab,\xf5Z|\x0f\xacZ\xf1F\xa0+I\xce̺\xb1\x14@\x1f\xb6\xc7Pt\b\x10\x02\x92\xc4\xec9(\xd1\U000ee4c1\xb5\t65\x0fw\x135\x06ޣ=\xa4\x98\x02\x9f\xef\xe0\xd8\x1a\xb5\f\x80\x19܍\xb8\x06\xf2\x9a[:t#\bh$6\xae\x045@\x8ce<\xb0\a\xc8!A\x9eni\xb6H\x97\xd2\xf8\x04\u007f@ɵn\xa8m\x83\x92\x8b\x11\x00{`)\x1a\xb2\x03\b\x00\xb2\xb4L!\xddR\xdd,,12\x16_\x86\xef\xb0HA\xee\x04\x80)\xac\xfb\xec\x95P\b\x1d&Y\xb5\xc3\xf2<{\xcd\xc1EVOa\x86\xa8\x85\xee\xcf\xf0\x9dO\xa1t\x92\"h\xa9\xd8!\xb4^\x92q\x9d0FC\xec<\x8fP\xe2\x90\x0e\x92C\x84\bO\xe9\b\xf9\x85.\x10\xba\x1a\x1a\x95\xcf\x02>\x11A5\nR/\x95\xb9\x1a^\xaa25\x19\xac\x1e{T\xd0\xe9\xe6\xc2@'\xe2g\x93}[|\xe7\x18+\x9akCʐ#\xcf\xdc\x00\xf4\xec\xf9\xf4b\x8e5X.Q\x19E\x8e|\xaa\x17\"\x12\xc1\x14+y\x0e\x12\xe19\xf9m\xe5\xb3ˡ \xd1I8U\x82\x1e\xcdR\xf8*B\x89܈0\xe6\x1aX\xc4\xc3u\xeb\x06%\x16\x99\x15\xdb*\xe0'\x83\x1d\x01\x90d!\xf0S-\x9d\xe5tk\xb4 x\n\xdf&\x9fp\xa5\xa4G3K\x9bp\x82\xe8@\xc1\t\xeb£\b@\x8c.\xda\x0e\x19\x15\n\xb4\xe2\x89p\x88\x93%\x0e\xd7-\x96s\xf1\xf7p\x99\x85\x82\xa6\x0e2\xec\x83\x14\b\\8\x85\x01\xc2\x16.\xc57a\rXy\\\xb3Ƚ\x99KB\x9cy\xabsm3\x87\xb2^1Iu\x05\"\xf4\x12\x91P\x12a\r\xf1{\xf8\v\x04\x00\xac\xc1PK\xa8\xd1h\x8d\xcc\b\xc9\x0e#K(\r|\xc5\x17`\xba\xbf\x84U\b\x00-\x0f\x18f\x004+;\x18w\x15\x9ba\x02r\x1b\xa8\xca\a\x83=O\xb7\x1fB\xe7\n\\I\x10_\xfc\v\"y\x1a\xf3\x80\x88;*\xef\xe4wm\xb4&_c\x16.f\x06A\x8bu\xca\x1fsFz\xad\xec~\x1aZ)\xc3gh\xbf\xedla\x01\x11\xeb\x81ˀ0;}\xc8[F\n\ri\xab\xa0\xbe\v\xaa\\\xd7+\xee\xc7_T\xc6$\xda0@\xd86f\xf2\x18:\xc4O\f\x8c\xdf\xd2\xf3\xe8!\xe2\f\xa5T\xb3\xdc\x13\x9a\x05\xb1\xc5<\x8d\xb0\x95\xa6\xc1\xe5 \x1a\x84\xb9\be\xa6\n&\x98\xa8{\xb2\x80\xbc\x1f!\x9fW ݈OE\u007f\xf6\x93\xcb7\x00Er\rZ\x80\u007f\x13K\x03\xc7\xe8B\x90'\x00\x8f\xd47\x00\x01\x84'b\xaf\x12m\x192\"\x85\x86E\xa8&\xc5E0f\x16\xb57t\x16\xb2\xa2\"L\xf6\x82\x98I\xa4\x02f\xfb3\xad \xb5b\x8aO\x18\x19\xdf0T!8\xc2C\xe0\x1eJ5\x8c\xc6U]\x19;9\xb1S\xc7?\t\x8f\xa0\xc9H!r+U\x87)\\\xf2X\x81\xe4;\xb5Hj UH\x8e\xb3\x14\x00\f\x8b+$\xaeĸ\xc9E\x16o(\x91/J%\xcb\xf3\x98\xf8\xc0Nv\x1e\f\x12~ɟL<\x81O\x95\a\xc0\x9c\xf10xY\xbc\x18\\\u007f\xb4\xc0q\xc6\x030\x00>\x83AC\xd88\xb7\x80\x10\xe1\xc7X\x1eG\xc8\xc0\x0f\x17wF\xbc\x93\v\xb1ס\x99\xe2:$2\x84\x05\xef`\xa43\x9f\x92A\x82\rWŹ\"\x01peb\x14$\bz.\xb8-o\xc3\xd4\xe3\x9c\xf7\x18,\x05)\x06\xaay{嚩\x80T\xe5\x8b\x1c\x95\x9aF?\xe7\x10fH[\x8cb\xf7\xf8\x88\x82\x04ϊ[\xe8?\xa2\x01\x00\x18\x15'\fE\x00\x92\xccp\x14\xfb\xe1K \x10yI[\xf0\x15,\xcb\xc0*\xc1E*\x00j\xbe\xd7M\n@햰\xa2\\%\xd5^\x824?\xfe\x1f\x843\xaf\xb4:I\x03\x8f\xa8\xed\xc5\xcd\xe7\xee\x80\t\xe3\xef\xaaN\x9d2 ^\xb2\xb8\x11\n\xfc\xba_Ǉ\xf9K\x99\xe8\x01\x18\x06\xa5Fd\x98\x950\xbb\x17\xf1t\x8c\x01@@2\x83G\x88>\x1e\xd5\xe4\\a>|\x80\xb4\x81\xb5\x85.\xabn\x99]\x92\xf0T\x10\xe8!\xc1\x9c!\xa6\x82\xdd%@ѷh\" 7r\x83A\xae\xacP\x91\xfd\x05F\"T\x80\xcc\xdd}\xc7R\xf7\xee\x1d|\x8eu\xf7\x87%\xcdB\xf8\x01\x1b\x91+}\xa1z\xb9<\n\x87E\xaa\x9ap#\xa4\b\x04 \x02$\xab@\xdbTf\x9e5q\x9c\xc7\x1c\xa1\x06\x96\xf9\x8e\xa8`\x02\x89F\xe0\xa2D\xa8\f\x1c^\xacTA\xe4\xe6\x8c\x18\b\xfc\x8e\xb9\xc6Ⴉ\xac%x\x83\x00\xe7ɻ\x11\xbd\xd5\xf5\xf3\x1c\x1fS\xed\x91G\x19\xad̀\xef%\xb4\xa0v\x84\xcaM\xcc\xda\x1e\xb7\xe3\x9f\xef\x00\x88PP\xc03\xfa M\xd9\a$.\xff\xcfg\xc7\x12\x87\x94dO\x8bv,v\xcd'\xef\xb1&y\x86#\x8a\xa5_\x8c0F}UI,\x98\x86\xc5\xc0\xee\xe7ys#c\xbcJz=\xae\xe4\x86\xee\xf0\x00\x93U\x01h\x99\x16ҝ|\x92%S\xa13\xf1Ee\xd58\x13\x86\x80\x85O\x84\xbe\x99\x0f\x8f[\x05;\x8a\x94X]n\x81\xd8H\xd0\x15 Q\x93JL\x85\x00\xc1\v\xf1\xdfV\xa4+\x93\x1cP\x96\xb8\xbd\x19_\xa4\x9a\x10\x9a\x0e\x8d\x82\xc6P\xe0CJ\x84+\xfa\xa8\xe0\f\x1f\x843\xa7\xfbQ@N\xfc\x8dla\x86\xaf\xac\xd6\xdfAqG(\x8f?[\xe7\xff\\2\xbb\xdfi\xe6/\xaf\xacݛ\xa1\xdd\x1bp\x1a\x19\x96\xb2t\x95\f3\xaeF\x05]ؼtlK\xa8\x88 <\xad\n\xac \x17\xff\x0eCB\x13\xb3]\x95\x01r\x92\xbf=Dc\xfa1\xc2(\bA\x82d\x8b\xaa{\xc4(\xeaPJ\x1cו\xa0# \f\xbfղ\xe1U\xa1L,\xac\xc4\xe6\x1d3\xf4\x1d\xe2\x81\x10\x88u\xe9\xccZ'\x81\x9a\fш&\x94eO\x12*\xccK\xeam\xc3k\xc1\x1a\xaa}D\xe49\xacƄ\xe5\x14Yv2\xba\x86l\tr\x966\n\x90\xc9\xe2AМ\x8b\xa7\x8c\xb0a!LA\x90\xae\"\b\x93\xed\xb7Eˊtd?\xea\x85y\xca\xd23\xb2_\x11TG\x0eW\xed,&\x88\x81)÷\x0e\xb9n\x06\x86\b^\xda\xe4\x020\xc3qI\xd5A<\xc1\x01y\x18\x9a\xa1\u007f\x01\xa2\xcd\xda1\xa2\xfa\xbc\xe2!\xfe\x04\xe9\xe8\x19F\b\xf0\x1e\xc2\x1a\xed\x87g\xc5)\x10\xc8\xc0\xd7u\x80\xc1T\t[\xee\x19\xcc'\xba\xa1e\xe2\xd3*\xe6\x9e\xccg\x85\x89\xa2~\xe5\xdf7\"\x13\xe6\xda\xc8\xd7\x1ee\x1f\x0e\xf3\n|\x88\x13\x1d\x93\tb̆!\n\x95Y\t\t\xe4*r\xa1\x86boM6\x96l\xcf\xe4\xe31\xb0iĈ\x944\x80\xe9\x9ex\x069\xbf\x1a\x8f\b\xf7\x036H\x11\xd5\rj\xfe\xd4\xebB\x97\xe9\xe1\x02,JD7\xaaJ\a\xfd͡B\x043c/m\x10z\x069j\xb7\n[jn\xa2)\b\x15\xd8\\\x15\x96.vDQ\x1c.\xc1\xbdUoj\x87{#\xc57J\xc0\xab<\x02\xf6\xc1\x1ffh\xb3\xd3zA\x86a̓\x839Ά\xe0\x98%\xd6\x11\x82\x1f\xe9U\xe7 mtEO<b\xbd\"\r\x95fе@\x8bWU\x10U\xa0\xb2\xcfA\xbb\x05\x16m:\xec\x1fٝ\x1e\x14\xb6&\x19\"\x9d\x97;Ϋ\"\x03\xc2\xf5\vj\x10~\x13pI[n郑\x1b}\xbc\xc0\x88ё\x1a\xa5\xb9\xa3\xbc2i5\xa68a\xc1w\n\x8b\xa1`\xb6ڨIFFŽ8\xb4\xe4\xb2\xf5Xh\xd8#ʚz\x1c\x9c\xca\xcb\xe4\xf4tW\xb5J\xfe\x11\tc6T\xe0\xc1\x10\xa9p\xccg\x9f\xab\xc2\x12\xd5w\x1f,,~rmI(x\xfdB\x85\x80\x1aQ:+\x91m\x13|\x88\xe8\xecyФ\x90\xb4\x9fFdI\x84\x12\xed\xad1\f@\xd3\x13J\x06\u007f\xa2\x90\x88\bKD\x15\xf3\x80\x17>U*\x1c\x04wYUr\xed\xd9J\xbfe\x88pD\xb8\x13\xa5\xb5\xcc_\xfc#\xbc\xde\xf8\xdeBS\x00\xe5\xb0=r\x13/2\x91\xf1\x00\xe3\xce\xca \xfbT\xfdf\xedL\f*hm\x1e\ap\x06Wo\xf0\\\"b\xc6\x19\xd1\xe2\x1bG@\x18U`cʢ(\x8f\xc8\x06\xdb\nɀ\x88\x8f\xe6v\xc4\xd1\xca}\x91\xccH)\x95\x18\xfc\xdb)\xc0g\xb0\\P\xfeA\x9f\x82^W\xa6\xe7ϠK8\"\xa5\xd2q\xac\x17\xa8\x90b\xc4d%\xcbr\xc4\x1d\xfb \xd8\xe8\xa4\xea\x99q\xa2\xb8\x80\xbf/\xed\xdb\xfa\x0fŬM\xc0\xa7\x99\x9fb~\xca\xff[4\xb7\xfd\xc4\xf7_i\xef_\x94:\xa2\xa2\x03\xa8\xda\\n\xd71@&\x12項J\r\xc2B\x17{p\xa7\x84\xa8ʄ\xb2\x9f)#HP\x1e\xa9\xe5\x8e\xcf\xc1\xe7\xe2oXЂxZ*\xb5\x99w*Z\x93\x01\x11\xa9\xc6\xfc\x02WyuE/\xf9\x87J\xbc\xba)\xe2\x86\a\"Y0U\x84\xfd\xf2\xc5K\x83k\x83\x83tB\x01\x911ͥ'\xf2\xb2\x88U\x06sJ#\x13\u07b8V\x04\v*\x98|\xc1ˢ\xee\x12\x9a\x82|\x82\x86;v=\x16/,E'\x11\xf4\x84\xd4t\x04j+\a\xed\xac$d\x8e\xb34$\xf2b\xda&\x98\xb1\xc8,\xc4\xe2\t\x1b\x03\x94\xf3\xc8г\xd7~\x8c\x80\xa2_U3\x176?\xba]\xa1\x86\x0ek\"\xccA\x87 \x9dTj+\xf8\x9f\xa9]\xa5v\x90\xccGr}\x11\xd7-\xaa\xa6\x1f\xe0\t\xd8R\x9d\x12\xa6\"#\x94Q\x1ci\t\x85\xb2\xea\x15\x01C*\xe0-!(\xa4X+\xe8vt\xd1@7\x82ρ\xa4e\xb6\xd0\xfc\xaaN\x14\xa9\x15\xa2\x04\x9a\x9e\xda\xfd\xbd\v}\xe4\xf9<\xab\xab\x1ck\xfe\x1cHo\xa3[\t \x90\xc9Ht\x03\xeb\x13\x1fG\u007f0\xf3\x10\x97\xdf/\xcd\xd1*\xb0M\x94*]*\xd5W2\x1fb\x0e\x8aTb\x0f\xe6\x04\x03y\x80\xa5}\f\x90jb\x9b\x80O\x15[O\x9c\xc01\xb4\xa9\xa9\x83\xd32K\xcdd\xe0 Rt\xa0\t\x89\x8fź$K[\x04Si\xc86L1\xab\xca\x04\xe1\x955\xb9(\xdfF\xfa\xb6zfG=^\xa9=ƥ\x12)\xaa\b\x87\xe8\xb0\x0e\x9e\xe5\x98H\xb3\xa6}-B\xd5\n\x115\xc9̌\v)b\x81dDq\x8b*S\xf2k\xf4\xc7ֆ3\x19\x04vOh\x01\xb6\x02F\xaa\xc4TW\x99\x1af\x008\xd2QY\x1etj\xa0;p\x16J\x03*\x10\x99MtC.\x01\a\x91\xb4\x05\xae\x03K>\xa1\x05\x1f\xa63,\bA\xa3\x8d\xee\x8a\tb9\fh\x86S/\xc1M\xdan\x01\xecH\xc2B\xb9\x8b\xad\xfa\xbc_~\n<\x19L\bZ\x15F\xe6R\xba\x12me4\x9cAYĞ\xf6\xc4\xdfҲ\x04>_{\b\u007f#0\x83kg9<+\xb1e/\v\xc8Y\xf6雨ێ;\x12\xf2\xda\xdb#\x19\x1bK\xb9\x01K\x13\x914\x1e\xbfC]\xb6\xa2\x14\xb9\xf2\xb7F\x00\x99?\x81P\xd0見\xa3\x12_@t:\xe9 te\xd5&\x98\x97Jf\xe2\xb7\xe6\v\b ޙv\xafj,b\xf2\x03*\xa8\xd1E\x8a\x0f\xf9\xa8_\x11\xca\xfd\x19\xa2\xfe\xff\x10\xca=\x88\x9a\t\xbbX\x01\rq\x93\xb6sI\xd2Hd\xc92m\xbcF_\x9a\xf8@R\x1bшpy\"\xb5\xbcV\x03\x95\xaf\f\xc1N\x90\x9el\xf5\x15\xcf#\x91\xdb\x00\x05\xa0\x01\x82\x00\xc2\xf8R\xd0\xe6->1w\x85e?HjȒ\x94\xd7~\xe1\x92\xd1\xd8\xe8B\xcc\xf1`\x1eu\x05Ez\xa8>3\x12R\x91\x14\x800\x9b\x17\x95\xc9Q#d\xd0\xfc\xc5\x00\xc71{\x9a\xe3\t;U\xce\xc9\x12\b\x9b9\x02\x17p\xfb\x01\x9b\x97\xf9\tY\x957\x13\x1d\xb4\x0f\f\x9f\xe2<B\b*8\x10\xee\x85\x1c\xeap\xdb\xdcXl\x96\xab\xa8\xae\x0f.\xa2\bRr\"@V\xb3\xe1瘄\x8e\xa5><\xe8\xc5\xfe\x90\xbf\x1e\x00g\xe1\xd0P\x19T己\a\x93\xb4ba\xbb\\\xeb\xf0\x8c\x0eEe\xc5ae\x96\xd5Z\x90\xd1\xfcPS\x9c\xb5\x172k@e\x13k@\xf0\xdeg\x80K䊊f\xb8;\x9eW{\aU[\x1aDO\rڨ\x03i1T\vy\xc7\x13ff$\xd6\xdc\b\x1e`\xaf\x93\xb3\xcc\x16\xa9\x19\xd4LVD(mr]\x15u\xf8\x92GB\xc7}6\xd6yy\x17\xa1\x873>\xbb\xf78M\x10\x1ej\xaf\xda6e\xa8ʨ\xe7\x15H\xec\xb3O\xd2*\x11\xdb%\"\x14\xfa\x14Xqw<\x10sᴕ1=_\xd7\xde\xf5\x92\xc9\xc6\a\x00\xee \xa5V-\x93,G.\xc9p\x03\xaa\x1e6Ԅ\xca8x\a\x12G\x19\x84@cQ\x1c`\xc5\x00P\xf6K\x15\xd4\xfb|\xd0\x049\xd0>p}e\xed\x10.5k\xa3i\x19\xcb1X\xf22\x94\xe3*\x98\x80\xe7%\xacf\x98\x9aqHZL\xdd\xd2vKGU\xa56>E0.\x05\xc4\\\xac\a*\x8b\x8f\x01\"p\x92\xe9a\x8a\x8a\xd7\xea)\x01\xbe\x90\v\x1b\xb0q3\"\xe8\x17z\xd3n?\xa42\x02z\xdc\xd9\a\xb1\xdb=\x98\nUŤ\xce\x1a\xb8\x1cq\x8fI說,\x90\xbb\xc3\xe9\xe5\xf8\x04\xc1\xf3h\x04\x8e\xf6\x16\x05\xf8\xa0\x83?\x82\xcfO#\n\xe6~\xa1`\xe6L\x05\xd7$4\xdc\xfc\xffp\x97\x19\x16\xa1\xab$\x9cas\xae\xc8\nN\x87\xcfa\xa0\x15[\x18;\xa3?\xb2\xdf\x04\x89]5Wx\xe4\xd4$\xab\xb3\xc2t\x84\xbd\xc0dt\xf3\x01\xeeQ\xbdӤ\xad\xce\xd1T\x9a\xab\xb3^\xdc\x14\t\x80LZ'\x1fLj\xb3\bII8B1 \xb9\x9fk\rV\xacnMG\x9b0\xa7j\x14\xac?A\x84\xf5ǌ'\xb7\xd0bx\"\x10\b\x99s\xb6\xf4\xa2\xf6\x16p\x98G\xdc[Xf\xf0 \x0f\fb\x9e\x1d-\xbd\xb4ǜe\x04\xf1\vHj\"\x0e\xbe\x1b\x8f YY4,\x81\xa7Z\x14\x8a\x914\xcd\xdc\x0e\xefy\xb6\"\x05S\\\xf2$\\:\x8b\xceb\x83\x99\xb0\x0e\xc2 \xa8\xdc\x1bU?\x9c\bv\xa6\x93p[*2\xa8ޜ\xf2Xu\xe0\x9b\xf5\x18\xd8հ&\xb9a\xea\xf0\xd2f\x02\xa1\xbd\xe3o\xf6\xb8\x9c6\xb9\x16i\xf2\xed\xae\x85\xd5\xffv'\x1b\xec\xfe\x83\xb1w\xbfu\xf6_\xdbg\xc4T!\xb3\xf5\xdc^\xd5\xc9\xc1\x8d.\xa9-l\xbe\xfc\n\x1a\x90\\ű\xcc\xe6\x10C2\tG\xa2\x1f\xd0\xd4\xd6pT\xe7\x03^]\x15-\xe6\x18\xa1\x93#鉹o\bc\xd9@\x1c\x88\xda\x1a\xa3\xcc2:\x10\xdd\xd6\tȆL\xb9j\xeb,\x12\x95\x05\u007fZ\xa4\x87\xd1mx\xbd\xbe#ĦG\xae\xe4\x02\x1evV\xa2\f\x19\x19\xce\xcaO\xb4\xab\xe5\x86ut\x13\xe54UR\n\u008d\\ \xb9\xf4\xb6\xb3\x01\xb4\x11\xaa\xac\x8b\x89Dq\xf7Y\x85`b\x80\xa4ǒ\x06\xa9\xceE&\b;\xfcp\x15\x8dȽ\x88i\x84sW\xd3\rr\n\xb8\x82\xbbQ\x90|6N\x0f`q}\x94;P\x83]P\xc3\x18:w\x02\xc0\xb1hە[dG$f\x15\"\bJm\xee&m\xfaG\xc4^\x8b[\xf6\x9d\xc1Um\xcd#@&A\xa4\x15E\x93c\xb51\x88<\xb2\xa9\xab7μ\x83\x83\x922\x14\xa6\x8d0\xf9\x95\xa4.\x8f\xf5\x102N,\xbbv\x83\xc9\x13\x15ސp\xa8U\xaf\xda6d7\x14\xe9\x13\xe4B\x9a\xa1h\xbaÞ49\x96\xf9\xf6\x8fPT*\xfb\xc9 F\xa9C\xb4\xcfP\xba{\x88P\xfb\x06u_2\x9d1\xb2舾5\xb5)i\x10Jr\xea\x02=A\xfa\x02\xf4\xd6vFo9\xaa\xabi\x15\x90\xae\x10\x96\xabBňq\xe2\\}\x02x9\x05$\xb0\xb7\r\xa6/*\x051\x9f\x0eD\x84\xf0\xba\f\xa8\xf4\x8ej\x1a\xf9@[\x18\xba]_#\xb4vDUҬfPC2\xc9\xe8\r\x17 \xa2!\xa9\xac]Ķ\x97ˠ&\xaaC%\x19`Qe-\xa6\xb6\xaa\xe9\xabŖ\n\x89^\x8e\xf0\x1cFB^\x11q\xb9A\xf5\xe5\xf5\xb4\x16I:\xbeΔ7dM.\"\x06\x96\xacv\x9c\x8cZV\x0e\xae\xf1ӑ\x14\xb7\x04\xfaզ\x01P\b\xffn\xb5<!1.\xe9E\xd41Fnݱ.\x8e\x95\x8f\x1a\xbcd\xfe\xa7$Z\x18\xfc\xd2\xc60k\x8d\xaf\\\xa3v\x95\xd9o\xabE&3\x1fU\x93:\x1c\x12@\x19y\xadT\xa14\x87\x03\xc1\\p\x05\x19\x8a\x8a.\x85\xf6\f頝P2]\x9b\xd1\xda\bPSJ_L\t>\x18\xc1\x8f\x95\xe6\xbe\x15\xb3\x84D\xe0a\xd1٧\x0f\x1e'\x013\xbe\x1d\x91t\x15}\x0e\x90\x06u,\xdf]\x88\xb3fc\xc8\xd5\x1d\xcc<\x91=T\xf0&\x95\xb0\xec\xacs\xd2\xee\xffu\xab\",X\xf0N\x96\xa8V\x01\n\x18X\xbeJ\xcabEU\xdb\x03l\x9f\xb5\xad֟\xb3㩗\x8ao\xa02j\x14\xfad!\xc0lx\xfd\x107ȡ\x10\t4PJ\x9d\tj!\u007f\xfdP\xda=\xab\x04\x87|8T\x02\x19\xb5\x8eV\xed\xda\xd2$\x8e\xd0\x0fPj\x8c\xe18\x19\x80%\xf8\xc1\x904?\"*\xa9\x06\xae˃\x91\xea\x97H\x84\xe4\x81%\x14\x0f\xcaˠz\xb4\x02!\x8e\x10E\x1a1\x93Hq\u0560\x9ce\xed6\xab\xbb\x13\r\b\x98]\xf9\xc8mڎ\xfd\t\xcb?\x1a\x0ff\xfcM\x8f\x02\xc1Y\xfck\x1c\xf8/\x8b\xab\x9fڪ\xfb\xb5\xb8\x8a\xbe١\xe28+\xe0F`*\x0fԊZ2O\x9e*\xf8\n\xcd\xe2S\x02y\xc0]c\x81\xa8H&u\x1b\xc0k\xfb\n\x11@\x89\xa4\xe2z\xcc\x00>\xa3\xee\x11\xed\x80oQ\xb7\xdaV\x1b,\xea \x8f\x14\xcei\xb2Ļě\x12\xf8ao\xc4$\xb9\x03@\xd4gd\xac\xdeB\x9dj\xf9A\x06\xe4m\xd3f\x15\xa6֫V\xdf\x1e$\x11 \xaa\xe7B\x0e|\t\x02\x01\x90\xebk\x80 <\xdd\xf8l@Ơ\xed`\xa92\x89\a\xd5\xce\xd5#p1\"\xb3\xa8\x96\x00\x18'\x95t\t5p\x13X\xcb@V\x10\xbe\x10\x9b\xa7\xa9GKT\xa7\xaf2fB\rӠ}u쟴&r\x18va(+.\xaf\x8a\xf4\x94k\xd0&\x84v\x12\xeaP\x89\x90\aWz9\xebZ\x05\x14\x13Z\x83/\xf9~\xd3X\x8aH56`\xeaV\xeaHʁ\xa3\xc0va3\xf8\x19\xc3\x02\x99C`\xd9{\xf3\x92\x8cx;\x87|&\x17\xb4\xfdHΠ\x1b\xce\xee0\xc8k;Z0A\x1f\xf6\xb6\x86\x1af\x9b\xe9F\xfbռ\xa1L@d3\xb3\xa5\x95\xad\r\xf4\x16]~m\x81\xc0*\b!\xc9\xc1X!\xc5\xf2j\x8c\x006\xfa\b0G\xb8\xe1\x05\xd7\xe5\x81ɼ\x83\xab\x18M\xfd!\xea\xf7\x91FF\x159\xa9\vfD{}\x86\x01\xe2\xf7\xaf\x19\b\x86߀\x83*\x82\xde\x06!\x82\xa5=\t\xfa\u05ee\xab\xf62U)\xea\x12\xb90\x17\xa2\u007f\xba\xad\xb5\x90:\x8a\xaawbP\x00%u1\xac\x8cT\x83\xa3\t\xe1\xd7ȪMX~\xcbe\x95-m\xa9\xca\xfc\xf6>P\xe1\x88jsE[\x02&#\x18wf\x0e-\b\x82e\xb7A\x93\x86\x88W\xc3\x06\xa2\x8b\xff\xa3\xfakծ\x91\xaa0\x9e\x10f\xa2\xce\xf2rq\xc8\x1a$\x04\x8b\v\x98.\xb9G\xab\xb9B\xa8\x00\x12\x1as\xa6PU\x9a\x03/\xc9!\x10\x839W\xe9C\xdbb\xd8}\x9f\xa2\x9a\x99`P\x81\xe8\xb3\x10\xbd#wU\x04,\xdaB\xddx|\x15H\xa3\x87pphP\xeb5\x92\xb1\xa2\x01f-X\xb6\xfa\xf6\xd6z\x89\xd04\xba\xefm\x148f\x92\xc4\v\x1d#\x97\xb0ȇ\x05\x8dz\xe8\xab\xc6f\xb9VT\x82ZD\xae\xd4\xdfT\xffޒ\x12C\x85=\x17\xf09Q\x04\x80;~\xbe\x16\xc1\xae\xe2\xb2\xfa6\xb4\x98\xe8\xf9\xd2\xd1YLA\xafY\x92\x9a\x95\x1e'U\xd8Y](\xea\xd6+\xc5\x04\xb6x^iE\xa0\xf6o\xee\uf4e5\xcf\xd5\xfe\xcbZ\xa4\xfbϝ\xfe\xf58K\xb4\xe0T\xcf\xe6wߤ\x8b\xea\xf3B\x13\x13H\x1d&\x16I\xa3#\x90\x1b\xbb\xa9\xa7\x9f\xff\x85\xd7Ф\xd3\nH\xb5\x8c1F\x1e\xe04\xb5G\xc2\xe4\x96\xcf\x06\x1b\x8f\x16\x91e\xa8@\x92\xba\xe0\xfec\xf6[\xbbk\xf8\xddp\x86\x85\xa9}\xbcq\xbd\x06\x8eWl\xc8'mUd̽\xae\x87\xa1Q\xebt\u007f\xfaH[C\x905A$\xca)U\xa7\x10\xbc_B\x0f\xffڝ\xeb+\xd55(m\tNV\xd3\x01\x9e\a\x02\xaa\xfclr\x19[\xe6\x10<d\x00\xf3\a/\xb2\x06Y\xcfH*\x108ahc \xeb$\x12\x1e\xfc u\x93\xe40\x9faᵱ\x03o@\x84;\xabt\xb1\\Q\x8f\x95v\xd4\xf1v\x18\xfe\xa6\x9dXh\x19\x14b8S\n3x\xbc{\x93\x86\x93\xd6\xd6\xfe\v\x16\x0eE\xaev4\x9eD\xbb_~N\a\xdc_m\x98A\xa8^F\x92\xf8\x86X\xd0<]\xb0ͷ\xbb\xf6i\xb7̶\x0e\x95tm\tsC\xe0\xf2H\x99g\x1eC\xfb+0-\xd2\x00\xb6\xdb\tT\xc3ݐ\x92\x922\x18EI\x9f\x11\xd5S\xb4Q\x1d$yT\tG\xb1R\x0ez*\xa1\xa6\xd6Z\x01\x9b\x01\x18\xef\xe8\x96f-=\xb0C-n\x19;\xd8UO~\xb5ϲ\xb6Od?\xc0C̟\xa4\x96\xe5P\t\xab\x95\xb5+\x91\xaf\xdc\x18\x80\x88\x18]\fv\x05\x12\xa9\xc3\xe4\xc8\xd1\xc2\xc2\xf4H,\x0e\xf5x\tr\x01\x16\xcc\x13\xa0\xd6%l\x91\x83\bi\x1e2^\x1c\xc8\xd4~\x96\x91\x14\u05c93+A9\xd0\xd1D\x84*\x99\xc4\x05.\x17\xe5\x1e\b\xbb3\xd0\xf38Y\xb5\xc6\xfbW\x8f\x85\xbd\x12\xbe\x18{\x81\xe0\xce\xda0DI&W\x1b.\xe9\x90:z\x0e\x80\x1e\x9a'#!\x98\x13\xd5ٷ\x11+\x9d\x80f\x88\">5\x01\xca\xf4h\xfbEp\xc6\x04\xe0\xc0\xa0\x93\xed\x88P\xb5pV/\xb2ц\b\xc0\xf8\xea\xb5<~f\xa5oPղ{\xf8\xa0i\xe7nu\xe2K\x1b7\xc0pT\xc7\xffP\x97\n\bI\x1aΧAT\xd7-j\xaa*C{\xf0\x80\xbcG\xf1\xab?\xe1`%\xc8\x1c\x8c\x9b6B_\u0383S\x80O\xd2\xf9\x9e\x84\xc68#\xbe\xdd,uW\x88\xd9B\x81\xfc\x19\x14(\x89?\xb8Y\xdb4\x00\xbd\xbd\xba\x053\xbaD\xbedf\x9b\xaf\xb4\xaer\xa9YUgF\xcc\xee>\xa5\x1bQ\x9dW}.\xe0rB\xe0\xbd6W\xff\xb6\xb1\xa5P\xe7֖\x92~\xc5#\xf3=uO\xff\x01\xb3\x9c@+\xfcf\xdf\x1b\xc6\xea\xffܪ\xf4/\x11\n\xf1՛;\x80N\x9e\x15\xf1ܥ\x82\xe1P\xfe\xc22ko\x90uٯ\xfd=\x15\xf5\x15K\x8f\xa3\n\x89k\xb5\x01h\xfb\xae!\xa6\u0560Y#\xaaV\xc24Bďf\x12ᘡ\x9a3H\v\xed\x1f%\f5\x8c`\xeaX\xb1\x93%O\x99\x13\xc6\xfd\xd6\x05z\xd9d|e0N\xa2N\xa4[*\xb0r\xd5,V\x03\x14\x9c\xd5\xd3\x1c\x87\xdaY\f\xfd#G\xe8SR\x9eG\x14U\xc7{>\xb1\x15$\xb6\x14\x93\xde\x01+\x01\xe1\x82'\xda\xd8;c6e\xa3q\x0f\xb0\x9d\x00V&@\x1d#j)\xd6~)C\xd2^#+\fW\r\xb4\x1f\xc9\xcc\xf3\x05\a\x96\xab\xf5\x9c\x1fi[\\\v#\x80]\x8dY\x8b\xbb\x1e\x8f\x06\xc0\xa9\xdfw\xb8q\xab+\x85\xfb\x00\x0f\x93\xd9\xf3\n\xd5-LBo:\x1e\x06\xf1\xbe\b\xaa\x02O\xa9C\xa0\xf0Iu\xc0\\dgy\xb03tlmf\xc5rR\xa6#\xfdPWj-WWbi\x98\x9d\xe5\fX֮\xc1\xac\xaa\xac\x80\x84a/\x81\xf6L\x00\xc6\xd6\x01\x15@\x98L\xbc(\x92\xb2t\xb8&\xa8ٹ\xa15\xb6v\xa8\x86\xa0\xd26\"\x82\xdfnľzY\x89\x0f\xd4R\x90>\xc1\xcd4\x1d*UON\xafH=\xcc{\xf7*\xfc_I\xcf\r<\f/\x87I\xac\xe26\x03\x0eR͔`\a\x00\x04MM52\x8f\xb7\x85V\x10\x02ؔԎJ\x1f\t\x8d\x89\xfcA\x14\xaaQtdIwu\t`\x13p\xfd8S\xd7*\x98C\xf66\x8577\xbex\xf4$\x8f\xef\xf1P\xafl\u007fT\xdf\xebK\x03\xd7^\xb06P\xf9\x9bvݪN!q\xfb\x02\xc0\xba\xa0\x12\x18\xf3%1\x01\xfd`ȡ\b\x1e\x1e\xdd5d\xfau\xa8\xe8\xe2V\xf7\xd3^m\x80-\x18d\x14\xc4a\xbe\x00.\xf56</\x02z\xc8lT\xae\xa1\xf3K\xad\xe1A6\xb6)\xd8\xd4A\x1f\x96\x01\x02\xb5\xf4\xea\xa0h\xb1\xd7\x01 \xb3\xc1\xe3\x04\xac\xe3\xb1\x12\xa0\x91\xbc\xdcС\xbc!\x92\\\xa8u\x96\x9d\xb4\x0e\x90\xb4\xf6شWh\xea\xd3y\x88\xa5VH\xe1CT\x05y$\x99\x01\x04\x02dW\xb5\xb3\xe5\xa2r\x12\xa2Z0\xaef\x98\xd8GI\x91b-\xdf\xd7\xef\n\x06om\xdcc4U0\x901a^\x80\b\xf5\t\x01\xc0d\x93\xf2ŽJ*\xd2\x04\bk\xea[\x95g?\xe4\xe6\xd0^TP\xa2\x89@?E\xea\xabME\xc0v\x91\x00\xc4\x1d\x81\f\xa3\x92\x87\xab\xd4\xe5\b\x1d\xf52\xa1N\xf2P\xe4\x13\x95ӂ\x16\x16\xd5&\n\x1e\u007f\x05x\xb3M\xa4\xbb!\x17\x9c\xbd\xae7\xbb\x9f6\xc0\x1f\x9f\xe7\xfe\xbf~\xfet̃z\xa1\xda\xf9]k\xbc\x1c\xea\xab\xfa\xfd\xc1\xa4\xd6\xf8\xb3\xfd\a\x10\x8fv\xb1ϯ\xb7\x8c\x87@\x06D\x94\x14\xf7(}x\f?\xab\xa5\xa2\xa4\x8b`\xacz\xb1\x11Q8\xe3 ˯n\xb0\xd1\x03.\xfb\x95\xf8\x88\xcf\x06\x83\xdbmE\x8e\x8a\xcb\v\xfb\xd5C\xad\xf3\xe2Ig\u00899\x87yE\xbf\x12\xa4\x1bw\xcaov4<KU\xb3\xc7\xd0\xd9\xd4,u\xc4\xc4tD\xb8\x8e\x8ey\x9d\xe5\xea:6^\xd4=K\x86\xa4\xa6mf\xb7;\x00\xdf\xcaezI\xd0\xf0\x8c\xb2\x1a\x1cu\x1c\x8cE)\xdcf7y\xad\xa8\x9d\xb1\xa0\xc1\x93\n\x15\xd9\xc8X\xd7P-\xa9K\x81;\xa0\xb9\xe9\f\x93:\x03\x80\x1b$\x1a+\u007fNR\xb3;\xf0\xbd|\xd1g#\xe2C\x18\xae2'Y\a\x15-m,k\xefT\xbd-\x001\x0e2h\xb3\xf3\x95\xfc0w\b\xac\xfbzVI\n\xedV3\b\xaf\x0ei\x93P\xaa\xa6*\xd8+Z\u0095\xdcJ\x87\xdc\x06ʊ\x9f8Ɗ/P{\x89z{\xd4\xd2\xd6^G\v\x81\xb5\xd54\x88q\xc1\xb6\x8c)\xa3\f\xcfc\xaf\x98CL\x90\xa1K>\xcc9|d\x81]\xd3\x16>\xf2[\x10\xb9\xa2\x8e\xff4\x9dW \xd9\xee\xebs/\x84P\u007f\xd5\x1b\x88m~\xaa\xc99\x10\xb8\xdc\x12\x11\xbdF.L\xde(\xae\xaa\x16\x94\xd3s\xf6c\xa2\xa3\xc9^\xa8\xbc\x93\xf9D\xa5jEL\xa8\x1a'\xb2/\x1b\xd8ē\x83\n\xed%\x8d*\xecp%\xa8ܪcj2(X\xc9X\xf0*4\xfe\x8eF\xec \x00v\x16|P!\x15U\x18xn\xf5\x13\\L8$g*\xc7E\x03\x8c\xb2\x0e\xb5`\xcf\xddM\x88Ef\xf5\x10Z[\a\x13\xb0l\x05\xe8\xf7:5\x11\xb4-\xabw\x03 \xe1\xc0\x04\x00\xd3ԟ̈́\x12OL5\xecf{\xab(\x8b\x88\xd0AkS\r\x04\x16\xa8\x049\x1c\xac\x86ʭ\xbel\x1c\xa4ڳ6\x91\xb5\xd5\xeciڸV\xb1\xfa\xaa+X\xfb\x1b\x13{\x84\x96g\x8eL}\x9c\xcd\x17m\xf54\x95\x94\xc05|)2\x02jR\xed\x10\xb50t\x10*f5\xd4\b\xbc\xb0v\x04\xdbл\x15\xa0$Q><\xee\xc2R\x97p\x92\x94\x1a\u07b4Y\xad\x93Z\xe4\xd3\xe5{@/\xc6I\xdbu\x13\xfcf\x98J\xf5\xd7\xc6\xdcg\xcd\rP\xd0)$l\nv\x1d\x81\x00\xe3\x01lr\xf4\x05ĖZ\xc7\xcdV\xb59\xa9\x0fB\a\x950\x18\xc0\xa2gE\a봵\x81St\xae\x1a\x8e\xc2f\x96\x8b\xc9ph\x19\xe80Bs{\x80\x8f^-\xf3K\xd5Į\xadnp\xd7P\xae(\x13$\f\xb4\x83\xab\x06S\xaa-à\"5\v\"\a)\xa4n\x9f\xcb\xd8\xc8zT\x94N\x1dM]\x14˛\xaa\f͝\xc0\x11\xc1\xd4\xc3ǰ\x91\xc3\xefT5!ٲNR\x14\xb5|k\xaf\xb1\x1e\xc7x\xb9\x86\xbf\xef\xdd\x12*\xe8|\xb5\x8f\xff\xb0\xafʵ\xb7\x86\x98%u\xa2\xa4\xa2\xa3g\xac.\x18\xb3\xa1\x11 #)\xc9\xddy\xc7v\b\x15u\rǠB\x01V\x86\x89\xc6i\xddA\xee]\\\x92\x8e\xa5h\xa2\x91\xac\v\xb8\x90\xd8E\xcd3EHh\xc2Q\x1b\x1f\x12Z, HOV\xaaU\x84\xa5W\x8d\n\xff\xb0\xa4\xa8\xee@2\xa0\xd1\xed9\xca\t\xa9\xa5\bKy\x9d{I\xaa\x06u\xe1\xe0\u0378\xecl\xe1sL\xab_\xd5\xc7\xd4l\xae\xdeGu^\x017\x00R7\x84\xd1\xd5\x11/\xf3:#?u\x92C\x9a\x15ɩ\r\xb0Ʌ\xb0YH\x01\xc0\x17X\xa8\xa8\x192D:\x89\xec\x8c=\xed\xb7\x1fvu|\xa6\xa9\xf1\xea\x8e\r\xb3em\xb2YQ\xa5M\x85l\x15\xa4ߙN52\xa1\xeaU\xc2::\x8a\xafCM\xef\x18H\r\xaf\xaa\xf5\xda0\x81j5H\xca\f\xab\a\x83\x19\xd9ڀ\x13Y@(\xa4\xd18z\xe3@Q\x9b\xb2*V\x98m\x842\xdaf2\xe4\xd8u\x84U\xf5\xccFڊ/+\x90J\xdc\xc2\xefD\x95!\xa0yҮ~\x93\x1d\x90_\xae\xabI\x84\x95\x99zq\x01?\x82eZ\x80\x1b\x8f\x88\x83\x85\xc7[\x85wP\xa2\xe8\xd0\xe9T\xb5\"\xe9д:\xac\x97\xce\xf6N\x1d\r\xbcj\x1a\xec\xe3\xf5#͇\xf8:\xfd2dXAi\x1d\x03D\x00 `\x01\xfa\xfbt\x81\n\x06\x82(&\x93\xacGܨ\xe9\xa7'\xbd\x01E\xe5\x18\x9dkDO/\x9d\xa9)\xc8iP\x05W\xa1\xa3y9\x1e\xb5\x9e\x88wP$,A\xff\xd4l\x92\xfa{\x05V\xe7\xea\xb0\x16\x0f\x00\xd3vUA\xcb!\xe46Y\xcf\xc2w\x15\xfe\xb1P\xa87\x9d%\x98\xe0?S\x11\x8e8eH\xb7c\xee\x13de\x13\x13\x1e\xb5\xb9\xca/\xdc\xd4)Q\xccu\xab_\a'яs\xabܺt([\x9d\x1b\xf8\x1f\x12P}ЋKVS\xf3ASs\x8c\xda\x02\x9a\xef+j\xb2\v9\xb5\xa4M\xe7k\f\xc2$r\x19\xa9;٭w\xd0\xd2\xfaVqY\uf108h\x13d@\xd6\tMu\xd8]\xd60'\xf5\xd0G\xa7c\x97j>WP\x13R\x13k9\x0f\xaa\x10S\xd9 K\xddgg\x1d\xf4չ:\x84\xf0U7\x8d\xbaL\xd1ШuSӁ\n\xaf\x0e\xa1\xa4\x1eC\xd5\xdf\xf3V\xff\xd1\xeb\xdfe-\x98\x1f\xe2\x884\x1b\xdae\xf2R\x03\xeaw\xc2:\xb0\x12\xe0?\xe6\xccd!\x80C\xb4\x98r\x8dL@\x99\x06\x12>\xa8\x836\xea\xf8\xc3\xd1~\x11\xf7\xc9\xdd\xd4g8T@Ho\xf7\r\r\xb7T\xb6\xd1\t\x83\xfd\xf6\x06\x9a\x01\x8b^\xe7\xb2\xf1\xc1\xea\\\xaa\x9d\xbc\x12]e\xc4+\x83'\xd9\xd5\x12\b\raat\xc0\x8f\x14f\xb0&\xa0\xaa\x98\xa4\xef\x93/?\x8e\xc24\xbd\x11\xe7\x1d\xb2P\a\xf3\xd2qP\xd6~cȼ\xf6J\xd5;\xb11x]\xb2\xca\x17u\xaa\x11\xa6\x88\xafd\xd6_s\x1a:\xa1\xb2\xfc9\x9d\\\x81T\x1e\x0f\xf0\x9f\xf2sd\x1e\xe1\x1c\x97\xba\xa6\x89\xa6\x87\\~\xe2ç\x8a\x9c\xefdz\xdf\xfa\x16\x91\xb2\x92\xba\xc2fr\xb1\x0e\xed#\xa9\x14\x9e\xd5\xf5uM\xe2@\xc7n\x9b\xb8\xae\xa9'\xeb\xf4\xc0\xe0\xca\xfd\xf8Z\x8c'i\xdf\xf1p\v:\xd1Qc\x1c\xea\xc2e\a\xed\xb4\xa1!\xa7\xf6RC39\x80BZ\xc0\xbe\x01?\xaeu,\xaf5\x99\x14#%u\x16L\a\x98;H\"\x01\xf2\xf6\x9bVq:\xee\"\x10W\xf5\xcbk\xdf\t\xb1cz\r\n\xf0\x00\xe7\xd5(\xb4R\xdcU\xe9x\x15̓N\xdfX\xac*o\xc9ftu9\xa9Vkd\xfb\x9cz\v\x8a'?\xa2'\x98\xc0Al\x11_\\z\x15\xcb\xf6\xf0\xba\xbdjdd-\xf5\xda\x11\x15\xff\xd2\x18\xefh\xdd;a\x8f\xa9\x81,\x11}:\x18\xd7\xe4\xa4N}\x9b\xc2jN\xee\x9f.\x12\x95\xe0^Is\x1e\xd3\xd1\f$\xd1{\xb3H\xd4~\x1b\"\xd8tHx\xb9\xa7&t\xa0\u007f_\xac&Ԯ\x06\xf4Q\xc8\x10\xf5\x19/\x1d\xedm\xca\xef\xfaN=h\x1b\xd8#FT\xf8\xc5K\x1c\x1d\xfeҩy\x02\xf2.t,\x14\v(#\xd9t\x8e9\xa9\x91A\a|\xd5\x1e\xb0T\x80kH\xb5\x91\x80\x8c\v\xa1\xe8\xcd\b@\x9a\x9e-\x93Q\b\t\xf8\xbb\x05\x17dB\x00d\x9cL\xd7F\x06v\x1a\xf9\xa4\xe2\xb9z\xf4\x86\xfc\xa0!\xbd\xe3\xf0\x8a\xbdm:*O\x00\x8c\x8eiEP\x03lU[l\xd1\x05\xd3Q\x90M\x90\x82\x80\x9a)\xd53?\xa5\xb2\xa1\x9a\xb5ԑ\xe9\x9b5\xe8\xdd\x1c*o<CC\xacvrL{\x05z{Buj@\xc6_0P\x05\xcd\xd5\x01\xb8\xa5\xfdu\xecA\x959.\xaa%xu\xbd\xddg\xcc \xc0=d\xf8X\x1b\xad\x9d\x8eǮ\x16\x8b[jmM\xb8u\xe1\xbfD\x88\x9ee\x1a\xacx\xae^\xf8ţ\x9cS\x1a\xb7E\xf3?n\xf0\x04Eo%BTz\xfb\r̅z\xbfN%\xcaע-\xae\xf1eh\xdf2$0\x81\x1f\x80\x13D\x8d\xdfK\xa7w\xb5\xaf\xdf\xd4چP\x84\xcbY\x9a\x1c\xb4\xf3\x10+ʨ4\xbc\x88Wg\x85\xb6{SFy+\x9fJV\xd5!\xe8D`\xfd\x94\xe3UJ\xc2F)\xd0\x00\x93\x1d0\xc6\xccǕ\x8a\xb73=\xf6\xdb%;\x1d\x00\xf1\n\xaaz\x9e\xc0\xd8\xef\xc5?9\xab\xa2\x94TF\x82\x0e\xd7;'\xabWۜ\xdb\xd4\x10\x86\xbd \x8bQ6\x1do\xc1r9U\xa8\xf8\x9bΤ\xac\xdbLE\xe8\x1d\xd4cƢ\xcb]0uz\x17\x04\xb3\xa2s\x86\xaa\xcb!\xd4 \x85\xa0#\xa0C\xed\xdcڱ\xc9ө\xb8\xd9\x11\x8eH'yQ\x90\xfa\xf3\xfa\x9b\xdd\x11\x9e\xb8\x12\xc0\x14\x05#k\x8a,\xfc\xe7\x171\x90==\xe9l\x12\xcf\x18]S\x80\x97\xf1\xde\x04F\xb2j\xdb\x16\x18\xdaq\xac`\t8\xc1R\xa9\xb2\n\xaf.\x9d\x8fZ\xafE\x9c\x18\x98Y'\xb5t\x94-&\xb5\xee9\xbd\xe1\x80pA'\xa8\xfej:M\xf3\xceЂ%Iʘ\x99\f*qD\x8cE\xb4^\x9f:\xbd\xaf'U\xe9\xb8JHa!\xc2@H\xf5\xfcw\xb5\xd3q[\xb5\xe8mm\r\xe5\xe7\xe0\xa4\nqZC\xaf\x82P\xe5\f]\x1a\xa4\x83xF\x9d\ue4c5\xd9*\xa0-KncPYA\xa4fl\xaa\x95\xaa\xdc\x03\x03\x03\x82\xb0\x8f\x8a\xd5K\x05\x9f\xc8DW\x8fi\x9dڗ\xf2z&u\x86\x1eVH\xe7\xb3\xeanN@5\xe44\xb5oD\x9e \xc0\xa0$\xb5c\xbc\x03\xbee\xeb\x18\xae\x94:\xb4\xab\x17\x81\xe0\xe9\x98\n\xac<\xba\x90\xe0\x8b!\x94\x8c\xae\xb8\x0e\x90\xd0V\xf9U5O\x87\xab\x96\x8eF\x19B\\\xef\f@\x12\x00\x02\xf0\a\xf8\xf9dUcV\x86\xceh\xa8_^\x8d\xdd̺\xa9\xfd\xab9\x9f\x9av1\xd6\xe5K@K\x95-\x8a\v\x13\x85j\x88x\xb8\xa8\x14\xd1\xde\xf3\x90\xc1\x1a\xa0tx\xdbt\xc5\x10=r\x93i*\xdb\x16x\x84\xecnY\xba\x9c\xec\xd0\x06N\u05fb|$\x8f6\x94\f\xb6\x06\x1d\xa8U\x91\xf1\xea\xd4\xcd\x1c\xaf;\xa2\xabJ7\x16\xb4Z\xf5\x16\x83\xd1\\\x89ȴ\x0e\xf2\xa8\xb4\xdfƊDw\xf1o\xabS{\xabj\xc6Z\xa6\xa6!\xaf&\x9885P\xf0\rB\xd5\xde$\xb8~\x1aH3\x03\x9eVE\uea93\xa8\xa0\xf3P#\x95Z\xc9\x1b\xe6\b\xb8\xb7\xa3*\xa6\xff\xbcUj\x80\x1a\t\x00A \x91\xb6{6\xe2j\x03\x18j8)\x0e2\x80\b\x90ȈR\x84\xb6\u07b4b\x9f\xb3~\v\xc5\xc3W'\xec\xcdCȈ\x15\xf5\x05\xcc\xf0vڡ\xe6ϱpܦ*\x19\x0e$\x10\x89\x8d\xb6\x18\xe9Te\xed\xa8\xb0\xb2\xbc\x04\xa1|bݨ7\xd6\x17ۑ!+8\xfb\x10b9M\xbd\xdc\xe3NmA\x98\x14\x1bژL\xa8\x02\x16\tPu\xf0\x18\xae\xab\x03\xae\x9b\x8c\x0fj\x00g\xf2!\xac\x90`2\f\xab\xce1a<\x9a\x8eB\x037$\xb7\xb1X\xeeh\x97\xa3\xeae^گܨ\x11q\xc2\xeb}\xd3'\xc8%]]\xcd\xea\xa8I\x9dƇ\x9c\xaaJu\xc4\xf7\xd0\xdb{ԛ\xdcKu(B&\xae \xbc\xf4B\x05\xd3\r\x04BW\a\r_\xa9/\x92h\x17\xa3\xa4\x93\xf2_\xba7\xa8\xda\\\xab\x0e\xa8\xa0\xbf'\xa1\xad\x0e\x84\x191\x8d\xa3\xe9-v\xa4g\xbd\xd0JG\x8cp\x1dV\xff\xa8\x89\x90g{\x950<\x93Zq\x99[U\x9bս\x1f\v\xa6\x97\xc4I\a\xa8\x85h\xb9-V\x80\xdcg]\xd0Fz\xcf\xcb\xd1k[\xba:\x1b\x8b\xba?\xe7;?\x8d\xea\xd7K\xe2\x02\x0e\x11\xbcE\x83Jވ\f\x92\xb6Ŵ\x9d1\x98\x17m\xcd5\xb5\x99z\xa6\x98\x00R7\x92\x0e\xef~\xaaG\xaa\xfc\x95\xa6Mm\xc9\"\xc2K\xfd;~\u007fd\xbe'(]\xd3\x11\xf8~\xb4\xb9\x960\x01\x10\x81\xe6\xe5\x9dxGYU5\xee\xf3\x93\xae\xf7_\xa9\x06\x1atR\x8cH\x84\x1a\xf5\xba3\xf4\x14:\\\x1c\xec\x10\xac\xda]\x84\x13\xd1\x179\xe8PbR\x9d \xfa+\xde\x01\xee\xb27\x82أ\xbf\xc9e8L6\x11\xd5c\u05eb\xd9=5\xa6\x1eHvU\xbd\x1f\x032F\xa4\xda9W\xed\x9a`!\x02P\xe7\x05t\xcav\xbc\x97Bl\x98վ\xcf'~\x17*\x8fv}\xc0=({\xab|Q\xaa\u007f\xef\xcb \x1c\xef\x03\xb4e\x00=\x91\bU\xea\xe4Q\xdaEM%\x10y\x0e\rZ\xe7;S[\x99G;bC\xbe\xd0i1 T\xf5\xeco\x8d^Z\x1f\xb3\xa0#\x058?U\x14\xb0*\xb1\xf2\xc4(#|\xdf։Cp\x0f1rt,\x8f\x81\xa9\xb2\x1a\x9c\x1a9\xb9\xd5\x04\x89\x10S\xef\xdd/\x842\x04\v\xbc\xa87\xf2\x1dVo\xef\x1csc\xe0\xc0:\xfcN\"G\xe8\x00\x88d\x98\x92e\xb0\b\x11\xcajC\x8e\xf8C5Bk\xbb袈\xb5ͣm=u \x9bN\xbb,\xa9ާ)\x1b\xe2\x8a\xf8\x96\x03!J\x1b\x801ej \x9d\xa5\xd7N\x99Jb\x10\xecUK?)}\x00\xba\xc3c\xe17#\\\x85\x81R\x8d\x1c\x0f}\xf4~1\xbd\u007f\xb2\xaa\xdb7sM\x1e1:Y_\x1dy\x83it(\x13\tu^=fks\x9c)c\xfd\xfc\x0e\n\xc5\x04\x1d\xe1\xdb\xf5\xbd\x8b\x85\x83\xc1\xbb\x8e\x19#Y\xb4\xf1\xa3\x11\x91\xbd\x98<\x05\x19\xc0l\xef\xed\u007f\xb2:\xa6\xb3\xba\xd0H!\x98\aD\xdbI\xdc\xf2t\xa3\x8e7\x9a\xdc3̥W1\x80\x03ӡ\x14\x97 J[\nAͿz!T\x00.M/\xd2#\x0e|\x18U{\xefjW\xd4\x1b@t$T\xef?\xcc\xf7\xbd|Hgt\xe1\x85\xe12\xb8@|\x8d\xac\x85\xd2\xde^a\xf9\xfb\xc0\b\xb2\f#2<\xd8\t\x93\xfba\x8f\r\xf3ͮ\xf7n\xa8#\x1a%L2\xbd\x06\x88\xec*\v\x05@\xebس\xf8\xa9\xeb\x05*\xba\x9em\xbd\xb7H[\xd3Z\u007f\f\xa7\x8c\xa8\xa5\x921\xae\xe8٩j\xe2\xd1\x118U\x16\xea\nn\xe8h,&\x91\xe5\x81\xe7\xa5<k*g/\x9d\xc5RQ\x11,\f\x90\x9d\n\xdbc\x04\xd5#sD\xf6\xe9\xe0(\x06Q[\xdeЃW\x8dMs\xa4\n2Y\xb2ѷed\x83\x8du\x80\x02\x98\x11>O.\n\xf5\x19\xee6\xaa3\x9b'\x1ab\xcf9\x99\xe5\x15ud\xc7FB\xfa\t\x9a\x91o\x90\"\xf4\xaf\x97\xafi\x1b\x82O\xe3\xdd\x000Ƨ\xf7\x11)\xc0\xbd\xceǨ\x85^gWZS\x13\x18j\x1e-\x8b?JN_\x1c\xfa\xe2l\xa0\xd7\xc8\xfbS\x82D\xb8o9\xb8\xa0NUF\xa4\x97\xadL\x95\xde\xd4\x03\xdaF\xd6>6\v\x87[\x14\xf3\x02\xc8.\x8b\x94\xa59ԋ\xd1\xf7&C\x8et\xc0~V\x90G\\\xe3\xc8\x17\x17)Qb\xe5\xfa\xb7\xafp\x0f\t1\xfb]j\x8f\x9dh$\x17\xc4@{n\x9c\xc8\xeboWe8\x02\xd3\xc8\\$\xc9ԑDl\xa7*\u0378\x03\xf5\xfe{\x16\x1c\xe0\x16\x95\xfaw\xca\x1e'Sx\xb4)Ga\x12W\xaf\x91\xaeJ\xbfh\xff\x02ƊG\xfdO$\rB\x81\xa9\x858unT\x1b2y\xbd6*U\f\x82\xba\xc1IC\xcc\xf1\xaa0\xadvO\x00\xa4\xae~\x15\xb0m\xae\x8a\xfc)%\xc9֣\xedt༪\xc5\xf7\xbe\xf2Sm*&\x8b\x8c3ƥ\xea}\xb8.\xe2a\xe0!U\xda<\x06n\xea\x84\x1d\x17(ڧ߯\xda\t\xb545'\xea=\xbbh\rby\xfbY\xb4a\x83\x88 e\xb0\xd4\xcc\x12\x01\xa96\"\xbd\u05c9\xac\xc6*Uό\x18\xb7\xbb\xe2\xa9\\ȿ\xfc\xbd\xcd~\x91\xfc$\xb4\xfb_ϱ#!\xe6R\xf33\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0e;,\x15̬\xf8\x00\x00 \x00IDATx\xda\xec\xbdy\x90\xaf\xd9Y\xdf\xf79\xe7\xbc\xfbo\xed\xbd\xfbv\xdf\xfd\xce\xdc\xd9G#f4ZF\xec\x06#\x90\x04\x02L\xb0\xa0\x00+\t\x90\xc8`Sv\xfe\xb1S\x0e\xd81\x86\xb8\\\x95J\xecJ\xbc\xa4\x92\x8a!\xaer\x1c\x13L0\xc2\xc2Xh\x17\x92f4\xeb\x9d;3w\xee\xdaۯ\xfb\xb7\xbf\xfbY\xf2\xc7\xfb\xbb-\xe1\xd8I\x10\xd2 \x91\xfc\xaa\xba\xfaV\u05fd}\xeb=\xdfs\xce\xf3<\xdf\xe7\xfb}^\xe1\x9c\xe3\xff\xff|\xed|<\x80\x0f}\xe8C|\xf8\xc3\x1f\xfe\xba}\x88\u05ee\x8d\xa4\xa9\xabպΟ4\xa6z\x02\xa3\xfbu\x99\xfbU\x99\xfby\x91\xfb\xba֞s.p\x80\x14\xf2\xb6\xd6\xfa\xca\xc1\xf0\xe0J\xad\xeb+ֹ\xe1O\xfd\xd4O\xd7\xff\xae\u07fb\xb3\xb3\xc3\xcf\xfe\xecϾ\xf1\x80\x1c\x1d\x1d\xf1\xb7\xff\xf6\xdf\xfe\xba\x01@J\xc9\xdb\xdf\xf9\xdd\xfdno\xf9ۄ3\xdfa\x8b\xe97Ve~O\x96NT\x96\xa6\x14e\x815\x16\xadk\x8c\xb5\xd4FST%R\bB?\xa0\xd7\xeeqz\xe34\xf3|\xee\xd2<\xdd\xffG\xff\xf0\x1f|X\b\xf9\xcf\xe6\xf3\xf9\xefTu=\xbf\xfb\xff|\xe0\x03\x1f\xf8\xe39!_\xbb\x1fq\xf2\xa7յ-\x1e\u007f\xe2\x9b\xd6\xfbK+\xef\x02\xf3\xbe\xbaȾ%\x9b\x8dۃ\xc1.\xe3\xd11EQ\xe0\x9c\x83\xc5\rl\xac\xc1\x18\x83\xb6\x06c\rRH\xa2 \xc4\xf7|\xb41\xe8|\x8e\xb3V\x04^\xb0\xe5\xac\xfbѴ\xcc~Tyj\x1cH~\x1b\xc7\u007f\xe3\x9c\xfb\xd8\x1f\xcb\x13;\xe7\xf8\x95_\xf9\x15~\xe4G~\xe4k\x0e\x8e\x95\x95M\xde\xfcķ\x9en\xb7:\xefvN\u007f\x9ft\xe6)]e\xd1d|\xc8`p\xc0t2\xc6S\x1eR\b\x04\x02\xe7\x1c\xb5\xae\xd1FSi\x8du\x16\xe7\x1cRJ<\xa9p\x80s\x96Jה\xba\x02\xc0\xf7|B?\xa0\xdf\xee\xd1mwH\xa2\x16U]\xeb\xd1t\xf4?\x1c\f\x0f\xff\xcat6;\xfa\xff\xfc\t\xf9\x91\x1f\xfbK\xbe3\xf2{\x8e\x8f\x0f\u007f\x06\x8a\xb7W\xd90\xc8fC\xe6\xf3\t\xa3\xd1\x10]\xd7x\xca#\x0ebp\x8e\xdaԔuEY\x97\b$\xbe\xe7ъb|\xcfG \xa8Msu\x81C \x88\x83\x84\xa2.\xd1FS\xd6\x05s\x9d\x92\x97\x05U]\xd1JJj]{R\xc8\xff8\x0e\xa3ww:\xed\xff\xac\xaa\xab_\xfd\xa5\xbf\xf5\xcb\xf6\x0f\xfb\x1c?\xf8\x83?\xc8\xf6\xf6\xf6\xd7\xef\t\xf9\xb1\x9f\xf89\x920~r6\x1d\xfe\xf2d4x\xaa̦\xd2XM:\x9f\x91e)Z\x1b\xb0\x0ec-\xd6Z\x8a\xaa\xa0\xa8JB?@JI\xe0\x058\x1cU]Q\xeb\x9a\xdah\x8cmN\t\x80s\x0e\x87CI\xb9\x00Jc\xac99I \xf0\x94\xc2S\x8a\xf5\xa55\x04\x02!\x84\x1b\xa7\xd3\u007f5\x1c\x0f\xff\xbc\xd6\xe6\xea\x1f\xe6y^~\xf9e\xee\xbd\xf7ޯ\xbf\x13\xa2\x94\xc7\u007f\xf4\x9f\xfe\xf5\xe5@\x89\xffr::\xf8\xc0\xf1\xe1M\xbf\xcc\xe6\b\xa9\xd0Zc\xb4E\t\x85P\x82Ҕ\xcc\U000d4aaeh'-\xb6\xfb+XgI\x8b\x8c\xa2\xaeH\u0098\xe5N\x87(jN\x8f\xb5\x0ek\rUYb\x17q\xa5ֺ\xb9\xd6ꚴ\xc8ȫ\xa29=\x02\xb4\xd1\x00\f'#\xba\xad.\xbe\xef\vk\xcdw(\xa5\x9e1\xc6\xfeM\xe7\xdc\xdf\x02\xf4\x9f\xd8+\xeb\xe1\xc7\xde..^z\xf8]\xd9\xf4\xf0\xefiaό\x0e\xaeS\xe6)\xed\xde2\xd6\x18\x8e\x0e\xf7\xd1u\x85\xb1\x86Y\x96\xe2\x9cc\xa9\xb7D+Np\x80\xb6\x86\xd0\xf3\xe8\xf5\xfb\xc4qB\x10D\x04a\b\xce2\x1e\x0f\xc9ӔJ[\x94\x94xJ\xe1|\b\xac\xc1h\x83\t\r\x9d\xa4MUWL\xd3\x19\xb3b\x8e\xa7<\xb4\xd1\x18塤`}i\x8d\xf5\xa5U\x0eG\x83\xf8\xfa\xdeͿޤ\xd0\xfc\x17\u007f\"\x01\xf9S\xdf\xfd\xa3^\xbf\xdf\xf9K\xfdN\xe7\x17B\xac\u007f\xf3\xf5+\x14e\xc5\xea\xfa\x0e\xca\xf3ؿ}\x1d\x81k\x16\xde\x18V\x97V\t\x83\x10?\f\xf1<\x1fk\rJJ\x82(\"\nc\xa4\x92\xe8\xba\"Og躦*\nj]\xe3\x9cC)\xd5\\Y\xb6\t\x05RI\xa4\x948\xe7\xf0\x94\"\nB\xdae\x8b\xa3\xc91\x91\x1fR\xeb\x9a\xc3\xd1\x11\x93tF\xafե\xdb\uecbd\xb2ŝ\xa3\xbd\xbf\xa2\xb5\xf9\xa8s\xeew\xfeD\x01\xf2\x03?\xfc\xd3a\xbf\xbf\xfa\x8ba \u007f\xb6\x15\x06\xf2\xb5+ς\xf0X\xdb\\'iu1\xba>\xb9\xceڭ\x0e\bA\xbb\xd3%\xe9t\xc19\x9c1\xf8a\x00\xce\x01\x0e]k\xe6\xb31Zk\x04\xe0y\x1eKK\xcb\x18k\xa9ꚪ,Ob\x8f5\x8b\x94X\xd7ԵƸ\xe6\xe7\x91\x1frje\x93\xc3\xf1\x11\xda\x1a\x1c\xd0\x0f\"\xc2 $+2\xb22\xa7\xdb\xeax\x93t\xfa?jm\x1e\xc7q\xf0'\x02\x90\x1f\xfd\xf1\xbf\x14nnn\xfd\xb7\x81/?`\xaaB\\y\xfesx\xcaciu\x83V\xa7\x8f\x1f\xf8ܾ\xfe*\xadN\x8f\xd9dD\x18%\xf4\x96\x96\x89\xe2\x18g-\xd6h\xaa\xaad:\x1e\x12\x04\x11a\x98P\x16ESk\x84\x11J)\x94\xef\x13'mzK\xabx~@\x91\xcd\x19\x0f\x8f8>\x1e\x90\x17\x19y\x99\x91\xa53j\xad\xb1\xd6\xe1\x9c[\x04xXj\xf7\x98\xa4S\xb2\xaa`8\x1b\x11\xfa\x01k\xcbk$a\xcc\xd1䘢.v\xa0\xfe\xfbF\xeb\xf79\x87\xf9\xba\x06\xe4ۿ\xf3\a\xbc\x9d3g\xff+[W\x1f(\xb2\xb9\xb8v\xf5Y\x82 $iw\xe9\xf4W\xf0}\x8f\xfd;\xaf\xd3\xed\xf7鞽\xc4d4\xc0Z\x83\x14\x82\xba*\xa9\xab\x92\xe9xDY\x14\xf4W֑RQ\x96\x19R\n\xea\xda\x10\xc6\t\xadv\x97\xa5\x95\rz\xfde\x8a|\xce\xee\x9d\x1bܹ}\x9d[\xb7_#/\xb2&\x05v\x02\x10M\x1d\xe9\x1c\xb5i\x8aG\xdf\xf3\x99\x17)BHXdb\xb7\x8fv)u\xcdj\u007f\x853\x9b\xa7\t\x83\x88\x83\xe1\xc1{\x8c\xf2?\x98\x15\xf9\u007f\xfdu\vH\xaf\xb7\xac._~\xe8\x97FG\xbb\x1f\xccөȦ\xc7(\x01a\x94\x10'-|_1\x9f\x1c\xd1\xeb/\xd3\xe9\xafP\xe6\x19I\xabE:\x9b\x92\xce'TeE:\x9f!\x10\xacnlS\x95\x05\xe9l\x84\xef\xfb\xcc&C\xba\xfdU:\xbde\xd67\xb7\xf1<\x8f\xd7_}\x81\x17^\xf8\x1c\xfb\x83;\xdc\xe5P\x85\x10t\xbb\xcb\xf4\xbb}\xda\xed\x0eZkv\xf7w)\xf2\x1cg-\x9e硄\xa02\x9a\xc0\xf3\xa8t\x8du\x8e\xc3\xf1\x80\xc1\xf8\b\xa5\x14g7\xcfЊ[\x94e\xf97\x81\x8feE\xfe\xb9\xaf;@:\xdd>\xdf\xfcm\xdf\xf3W\xa7\x93\xe3\xbf8\x19\x0f\x84)3\x943hSS\xd5%\xb7o\xbe\x843\xcd\xfd\xff\xc8[\xbe\x95\xd1`\x8f\xaa\xccqNP\xe49u\xa5\x99\x8e\x8eIZ]\xe2\xa4\xc5\xf1\xe06\x93\xe1.J*V\xd6ϰu\xfa\"\xeb\x1b\xa7\xe8\xf4\x96\x18\x1d\x0f\xf8\xc23\x9f\xe2\xda\xf5+8@JŹ3\x17\xe9t\xba\x18\xa3\xe9v\xfa\xf8~\x88\x03\xd2<\xe7b\xab\x8f\x94\x12\xe1\x1cVk\x9c\xb3\xe4\xe9\x9c+\xaf_\xa5\xd2\x1ap(!iE1\xa5\xd6̲\x19\xd3t\x8a\xa7\xfc\xc4S\xea\u007fVR=i\xac\x99}\xdd\x00\xa2\x94\xc7\xdb\xde\xfe-\xef\t\x03\xff??ܿ%\x8at\x8c\xd3%\xb3\xf1\x11Ry$\xed.G\x87\xbb\x04a\xccCoz;\xcf|\xf2_\xd1\uebf0\xb4\xb2E\x9eͨ\x8a\x8c\xf1\xf0\x80\xe5\xd5\x1d\xca\"\xe3\xf5W_F\xd7%+k\xa79w\xe1AVW\u05c9\xe2\x04c,W\xaf<\xc7\xe7\x9e\xfe\x04\xdajV\u05f6\xb9x\xe1^$\x90\xa63|?du\xeb\f~\x18Q\xd55\xb7\xf7n38\x1eP\xe4s\x04\x1a\x8a\x8c\xc0Y\xdaIB\x14%<x\xe64\xb3\xb2b\xef\xf8\x88\xa3\xf1\x88\xac̱\x0e\xa4\x10<r\xe9!^\xbcv\x05\xa4\xb8\x1f\xc1/\x03?\xfdu\x01\x88\x90\x92w\u007f\xef\x0fw}\xdf\xff\xbbǃ=5>\xde\xc7\xea\x922\x9bᬥ\xbf\xbc\xce\xf0耤\xdd\xe7\xfe\x87\x9f\xe0\x95\x17?O\xe0)\x96\x967I\xa7c\xaa2%\xcb欬\x9fe\xef\xf6+d\xe9\x88V{\x89\xcb\x0f<ɩSg\xe8\xf7\x97\xf0<\x9f\x83\xc3}>\xf3ُq\xfd\xc6+\\\xbap\x1f\xddn\x9f\xa5\xa5\x15&\xe3!B\nΜ\xb9\x88C2M\xe7\\\u007f\xf5*\xd7o\xbe\xc6|>&\x8abB%\xe9\xc7\x01I\xe0\xd3\x0e\x03\x02ObmE^\xcdq\xb5\xa1\x1bx\x88N\x87a\x9a\"\xa5`\u007fx\xc8x6\xa1\xd7\xee1\x18\x1f\xe1)\xf5\xe3Ƙ_\x00\xf6\xbe\xc6\x01\x11$q\v\xa5\xbc\x9f\x1f\x1d\x1f\xee\f\aw(\xf29u\x99\x83s\x84A\xc0|6\xa1\xdd[c\xe7\xcc\x05\xee\xdc|\x8d\xba\xc8\xd8<\u007f\x99l>C\xeb\x8a\"\xcf\xe9\xf4ֹ\xf9\xfa\xf3(?\xe0\xfc=of\xe7\xd4Y\xd6\xd6\xd6i\xb5\x9a\x18\xf0\xe2K\xcf\xf2\xf1O\xfd.RJ\x1e}\xf8qV\x96\xd7\x00A\x91\xa5\x9c9}\x8e\xb2\xd6\xdc\u07bbÍ[\u05f9u\xfb\x1a8G\xa7\xdd\xe3ҙ\v\x9cZ]\xc5\xc7P\x169a\xd4\xe2\xe0p\x97\xfdш\xbc\xd6l\xae\x9d\xe6\xd4\xc6\x06Q\x10`\xac\xe5ūϑ\x97\x05\xb51X\x1ce]\xe0p\x84A\x10\x19c?\xa0\x8d\xfe\x1b_Ӏ(\xa5\xf8\xf6\xef|\xef\x9b\xd3\xd9\xe4\x83\xc3\xc1\x1d\xf2tJY6\xc13\xf4}\x84\xf4\xe8\xael\x11E\x11\xe3\xe1!\xc3\xc1.k\xeb\xdb8\v\u0093d錸\xddg\xef\xce+\xacm\x9e#\x89[\xac\xad\xae\xb3\xbe\xb1I+i3\x9b\xcf\xf8\xe4g>\xca\xd3\xcf|\x82\xb3g\xee\xe1\xfe\xcb\x0f\xd2nu\x99ͧt\xda\x1dv\xb6\xcfP\x94\x15\x9f~\xfa\xf7x\xe1\xa5/\xd0JZ\xdc{\xe1>z\x9d\x0e\xfdVL\xaf\xd5\xc69P~\xc0\xe0\xe8\x90\xe7^\xfa\x02i6gc\xf5\x14\x8f\x9e\xbf\x8f\xc0\xf3\x19MG\xdcٿ\xcd\xc1\xd1\x1e\xcej\x96\xa2\b\x84dZ\x16\xb4\xdbK\xac\xf6\x96\xb9\xb6w\x93\xcb\xe7\xef\xf9ɽ\xc1\xfe/\r'\xa3\xfak\x12\x10!\x04\xef}\xdf\xfb\xbd\xaa,\xfe\xee\xf1\xe1m/\x9d\x8d\xd1uE\xa5\r\x91\xef\x13F\t\xfd\xd5Sdٜ\xe1\xe068\x81\xef\a\x14EN\xa7\xbbB\x96\xcdQA\xccd|\xc4\xea\xfaY\xd2\xd9\b\x04ll\x9cB\n\xc1\xad;7\xf8\xf0\xbf\xf9\x10\xb7\xee\xbc\xce㏾\x85\x87\x1fx\x13I\xab\xcd|>\xe3\xfc\xb9\x8b\x84A\xc8\xde\xc1>\x1f\xfd\xd4\xefrt|ȃ\xf7=\xc2\xf93\x17X\xeb\xf7\xe9u:TUI]\xd7Lf\x13\x9e~\xfein߹N\x12\xb5x\xfcᷲ\xd4\xe93\x1a\x1ergt\x80\xd5%\xd2hN\xc5!\x99V\x94FS[\x8b\x02\xaa\xba \n[ܳ}\x91\xb2\xaev.\x9e\xbb\xef{\x87_\xf8\xe4?\xfd\x9a\x04DJ\x05\xf0\x13\xe3ヷΧC\x8cn\xc8<_Iz\xdd%T\x1018\xbcCU\xa4H\xe9!\x84@ZM\x9ag\xc4\xe9\x1c\xe7,ZW\xd4\xd62<ޣ\xd5Y\xe2\xd2\xf9˔U\xc9k\xaf\xbf\u0087?\xf2[\x94U\xc97\xbf\xed[x\xf4\xe1\xc7i\xb5;\xd4u\xc5\xda\xca:u]\xf1܋\xcf\xf2\xe1\x8f\xfc&\xeb\xebۼ\xed\x89obce\x85\xb5\xe5\x15\x02? M\xe7dY\xc6s/}\x81W^{\t\x01\\>w\x1f\xe7O_B\x00\xd3\xc9\x10\xea\x92\x04\x8bu\x16\xa4lz'\xbeO&\x04ò\x00\x04U]\xd0i\xf5\x88\x82\x84\xeb{7X_\xdf\xfe\xf3\xc0\xd7\x1e B\b\xbe\xe7\xbd?\xe4\xe7\xe9\xec/\x8f\x8e\xf6\xd0Z\x9fpJ\xbd\xee2\xca\x0f9ؿ\x85\xe7)\xa20&\xcbs\xb4\xae\xe9\x06K\x14e\xc9hr\x8c\xd15\x93\xf11\xedV\x87Vg\x89\vg/!\xa4\xe4\x99g?\xc7'>\xfbQ\x94\x94<\xf5\xc47\xf2\xc47\xbc\x9d$i\x81\x10\xc4Q\xc4\xf1\xf0\x88\xdf\xf9\xc8os{\xf7&\x97/=\xc4=\x17\xefckc\x93\xd5\xe5U\xb2tJ\x96\xa6ܾs\x9dO~\xe6\xa3L\xe6S|%y\xf4\xf2c\x9cٹ\x88\xef\a\xec\xddz\x95\xe9\xfe\xebX\xa3\x17\xc5!\v6M \x00_Jھ\xcf0\xcf\xd0Rb\xad\xc5X\xcd\xc6\xf2:\x91\x92O-\xf7\x96\xdf4\x9c\f\x9f\xf9\x9a\x02D)\x0f\xe7\xec{\x0evo\xdcSU%F\xd7\xd4Z\xd3kw\xf0\xfd\x88\xfd\x83\xdb8\xe7\bÄ\xbc,\xc8˂~\xb7O]Wx~HU\x16\x8c\xc7\x03\x92\xa4Ge\x1c;\xdd\x1e\xd6Y\x9e\u007f\xe1\x19>\xfd\x85O\xe1{>o~\xe8q\x1ey\xe4\x1b\xf0\x83\x10)\x15B\b\xae\xbe\xfa2\xbf\xfe\x9b\xff\x8c\xad\xadӼ\xe5\x1b\x9e\xe2\x81\xfb\x1fas}\x13\xdf\xf7Ig\x13\x8a\xa2\xe0\xb5kW\xf8\xf8\xa7?B\xa5k\x96{+\xf4;=\x1e\xb8\xff1\x8cּ\xf2ܧ\xc8'\x03\x10\x10F!\xddN\x87~\xbb\x8b\x94\x8a\xaa\xaeɲ\x8c\x83\xe1\x00$\x94\xc6\xe38\xcf1\xd6\x00\x82v\xd2F\b'N\xadm\xfe\x87\xc3\xc9\xf0\x83_3\x80H)y\u05fb\u007f@L\x86G?7\x9f\x0e\xb1\xd6PV%\x81\x1f\x10'\x1d\x8e\x8e\xf71\xd6\xd2\xed\xf4(\x8a\x9cY6g\xa9\xbb\x84u\x16k\x1dR*\xe6\xf3\t\x9e\x1f\xb1\u007ftș\xad\x1dZI\x9b믿\xca\xd3/|\x16\a\xdcs\xf6^\x1e}\xe4q\xaa\xb2D\xf5\x15\xc6\x1a>\xf7\xf9O\xf3\xaf?\xfa\xdb<pߛ\xd8>u\x9a7=\xf28\xbdn\x17\xcf\x0f\xc8\xe6S\x06\x87\xbb\xec\xed\xdd\xe2\xe3\x9f\xf9=\xb4\xb5l\xae\x9d\xa2\xd7\xee\xf1\xd6ǿ\x11\xa3+\xae<\xfd{\x98:\xe3왳\x9c\xdd\xd8d\xa9\xdd!\x89\x13|\xdf_\xb0,\x82\"\x9f\x93\x17sn\x1f\x0f\xf9\xdc+W\xa9\x8f\x8fȊ\x94n{\t\xe7\x1cY\x9e\xd3i\xb5\xdf\x03\xfc\f`\xbf&\x00QJa\xb4~j2<|\xab1\x9a\xban\x92\x8en\xa7\xcfx|LY\xd7\xf4\xbaK\xe8\xaa`\x96\xce\xe9vz'\xedU\xe5\x05\xd4u\x85u0\x9a\x8c\xd9\\\xdd\xe0\xf2\xa5\xfb\x19\x0e\x8fy\xfd\xe6\xab\x14U\xcd\xf6\xc6\x0e\xa7O\x9f\xa3\xae+\xbaK+\x18c\xf8\xbd\x8f\xfd\x0e\xcf<\xff9\x1ez\xe0͜9}\x9e\x87\x1ex\x84V\xd2B\bI\x99gܺ~\x95\xe1\xe8\x98\u007f\xfd\xd1ߦ\xaa*Nm찶\xb2\xc1[\x9fx'e6\xe7ُ\xff\x16\xabK=\xdet\xefc\xac\xf4z\xf8\x9eO\x14E\xc4Ir\xf2]y\x8a\xbcH1u̓\xd6r\xf9\xd2%\xfe\xe9\xbf\xfe\x1d\xae\xee\xee\xe3\x9c\xc5:\x10\x02\xe20\xda\xf1=\xff\xb1Zן\xfb\x9a\x00\xe4\xfb~\xe0\xc78ؽ\xf1sy6\x97\xd66R\x9cN\xa7OQ\xa4dEN\xa7\xdd\xc3\x19\xcd,\x9d7]\xbd\x85\xf0@\b\x85\xa7<\xb2\"\xe7x<bk}\x8b\xc7\x1e~3\x83\xc1!{\xfb7\xd9\x1b\x0eH\xe2\x16\x9b뛴[mp\x0e!%\x1f\xfe\xdd\xdf\xe2s_\xf8\x14\x8f<\xf4\x04\xa7\xb7ϲ\xb5q\x8a2ϐ@\x14E\xbcr\xe5Y\xea\xba\xe2\x13\x9f\xf9(i\x9eqz\xf34\xa76wx\xc7۾\x85*\xcfx棿\xc9\x03\xe7\xcf\xf3\xe0\x85\x8bDaH\xb8\x00\xa0\xd5j\xd1\xeet\x88\xe3\x04\xcf\xf3q\xc2ҳ]\xb0\xa0\xad\xa5\xd7_\xc1:\xc1\xdf\xff\xf5_#/3Zq\a\x01\x14e.\x1e\xb8p\xf9\xdd_\xb8\xfa\xfc\x97\x05\x88\xfcJ\x82\xb1\xb9\xb5M\x91\xcd\xef\x9bM\x86߭uE\xad5\xbe\x1f\"q\xcc\xd29\xadV\a%\x05\xd3\xf9\x04\xa4\xc4\xf3|\xaa\xba\xc2-\xe2N\x9ag\x1c\x8d\x8e\xe9\xb4\xda<x\xdf\xc3ܺs\x93\x83\x83;\xec\x0e\x0e\x00\xc1r\xa7O\xe8\a\x1c\r\xf6\t\u0090\x8f|\xecw\xf8\xcc\xe7?\xc6=\x17\x1fdme\x9d~o\x89\xfdݛ\x1c\x1e\xee\xe1\xfb>\xcf=\xf3)\x8c\xd1<\xf7ҳ\x1c\x1cﳽv\x8aեU\x1e{ӓ\xf4\x97\xd7x\xfa\xa3\xbf\xc5\xc5\xedm\x1e\xbb|\x1f\xedV\x9bn\xaf\xc7\xf2\xf2\n\x9b[[\x9c\xbb\xe7\x12\xdbgϲ\xb2\xbaF\xbbݦ\x9dtH\xe2\x0e\xadV\x8fv\xab\xcf\xda\xea)\xde\xf9\xc4;\xb9\xf7\xec\x05f\xd9\x14)\x04\xc6\x1a\xaa\xaa\xa6\xdb\xee\xbc[\b\xc1\x1f+ \x1b\x9b\xdb\x18c\x99\x8c\x8f\u007f&\x9bO\xfcZ\xeb&8\x06\x01\xb3t\x8aR\x01I\x940\x9fO\xa9\xb4%\x8e\x12ʲX\x9c\x10\x98\xa6s\x8eFC|\xcf\xe7\xbeK\xf7s\xe7\xceM\xf6\x0f\xf6\xb83\xd8#\xaf*\x12?A8\x03β\xb1\xb1͝\xdd[\xbc\xf0\xd2Ӝ\xda:\xc7\xc6\xda\x16\x81\x1fP\xe4)J\n\xb6\xb6\xb6y\xed\xeas\b\x017n^\xe3\xc5W\x9eg\xa5\xd5a\xb5\xbf\xccR\u007f\x953g/\xf1\xfa\xcb\xcf!u\xc1\xa3\xf7^&\x8a\":\xdd.\xfd\xe5e\xce]\xbeĽ\x8f<\xc8\xca\xc6:\xadN\a\xa5<|?h\xc4\x0f\x9e\x8f\x10\x12!%BH\x92\xb8\xcd\xf7~\xf3wPV9U]\"\x10Da\x84\xd6\xf5#o}\xe4\x89s\u007fl\x80\xac\xaemP\x149\xefx緯ͦ\xa3\xf7WU\x81u\x16_y\x94eN\xa5-+K\xcb\xccg#\xb2\xb2$\x89\x13j]59>0\xcb2\x8cִ\xa2\x88S맘Ϧ\x1c\x1e\x1e0K\xa7L\xf3\x14! \xf2\x14Q\x18\x11\x04!\x9dN\x87\xa7\x9f\xfe$e]\xb3\xb3}\x96\xa2\xcc\b\x83\x80t>bsk\x87\xfd\xbd\x1b\x8c\x8e\x0f\x18\x1d\x1f\xf2\xfc\xd5\x17Xi\xb5Y\x8aB\xfaK+\xf4\x97V\t\u0090\xabO\u007f\x82G﹗N\xbb݀\xb1\xb4\xc4\xe9\v\xe7\xd8<}\n?hT,\xa66\b!\x10R\x9edrB\b\xbet\xef?t\xe92\xefx\xf81f٬\xb9ڜ#/s\xaf\xdb\xea|\xd7\x1f\v \x9dn\x0f!$\xb3\xe9\x98\xf9t\xf4\x81t:\xeaj\xa3QRa\x8d&-\n\xd6V6(\xb2\x19\xd3,#\x8a\xe2F\xaaS\xd5H!I\xf3\x82N\x9c\x10GѢqd\xb9}\xe7&EY0\x9cM\x9a]\xe7ED\xa1\xcf\xfa\xda&\x1b\x9b\xdb\\\xbbv\x95\xe9|\xca\xd93\xf7 \x80\x8d\xd5\r\x86\xc3\x03\xe2(F\n\xb8}\xfd*\xf3٘\xdb\a{\xd4键\x84\xa5\xe5\rƓ1g\xcf\\`8\xd8\xc3\xd3\x05;\x1b[\xb4\xdb\x1d\xba\xbd>k[\x1b\xacn\xae7\x8bj-Fۓ\x9aJ,Hһ\xb7P##j>I\x14\xf3]\xef\xf8F\xe6\xd9\x14k\r\b\xf0\xbd\x00m\xf4w\xff\xf1\x00\xd2\xe918\xdc\xe3=\xdf\xf7\xfe0\x9dO\u007f\xaa\xaaJ\x84\x90Xk\xc8ʒ~o\x05\xa7KƳ)\x9e\x1f\xe2)\x8f\xba\xae\x10@Z\x94\xac\xf4\x97QJ1\x9dϐ\xc0`pHU\u05cc\xe6\x13\x9cs\b\xe9\xd1n%\xb4\x926\xadV\x87\xc0\xf7\xb9z\xf5Yj\xeb\xd8\\ߢ\x95$\x1c\x1f\x1f\x92N\x87ln\x9d浫\xcf2\x9b\f\xa9\x8d\xe5\xd5k/\xb3\x9aDH\x1cb\xb1\x90\xedv\x87\xc3\xdb\xd79{j\x9b\xa4ݢ\xb7\xb4D\xa7\xdba\xf3\xec6q;\x01!\xb0\xd6\xdd\xd5d\xe1\xbeX\xedrr6\x848)\x1a\x85\x10<x\xe1\x1e.n\x9f\xa6\xacJ\x94TDALVd\x17\xdep@z\xfde\xd2t\x86\x10\x02]W\uf6ce\x06g\xedB0P\x96%\xbe\x1f\xe1K\xc1p<\xc48A\xe8\xfbT\xba\xa2\xae5\xa5֬-\xad4\xc0\xe5y\x13ܝ\xc38K\xa9+|\xcfC\nI\xec+ZQ\xc8\xca\xf2:\x9dn\x9fk\xaf\xbdLYW\x9c\u07be\x80\x10\x82\xe3\xa3\x03n\xbc\xfe\"\x9b\x1b;\xd4e\xc1\xf0h\x8fvg\x89W\xaf\xbdL\xcbS\xf8R\"\xa4b\x96\xcd\xe9w\x97\xa8˂ۯ\xbe\xc8\xc6\xf22\xddn\x97N\xaf\xc3\xe6\xd9\x1dZ\xddv\xf3P\xce\xe2\xackdłf\xe1\x17GCH\xd9\xc8V\x9d@\xc9/.]\xa7\xd5\xe2\xdb\xde\xf2V\x8a*\a\x04RJ\xca*_~\xff\xfb~P\xbc\xa1\x80\xc4q\xcct2\xe6;\xfe\xf4\xf7\x8a\xd9d\xf83i:\xc59GU\x958$\xed8f8>\xa6І \b\x16\\\x96O\xaf\xdbc}y\x15!\xa0\xaekƳI\xa3:t`\x8c\xc19GYU()hE\x11I\x94 \x95\xc4\xe8\x9a\xdbw\xaeQ\xd65\xfd\xfe\x12U\x993<\xda\a]\xb0\xb9\xb9͍\u05ef\x90$]\xf6\xf7n0\x9e\xcf\xe8\x04A\xb3`\xfd5\xa2\xa4M\xab\xd5e4\xd8G\xa4c\xba\xed6KKK\xacmm\xd0_]^\x9c\bp\xf6\x0fR@\xdc\xfdj\xba\t\x8bo\x8b\x13\xb4\xf8\x81\xa7\x14\x8f\xdes\x19c\x9bn\xa3\xb1\x86(\x88\x93\xb5\xfeZ\xf2\x06_Y\xe2nu\xfe\xd6\xf1\xe8\xe8\xad\x00U]\xa1\xad\xa3\xdb\xee0\x99\x8e)jC\xe0\a\xf8\xbe\x8f\xe7y\x18k\x11B\xa2\xb5\xa6(r&\xb3)J*<\xa9\xb0\xd6Q\x1bMY\xd7H)H\u0090\xe5\xee2\xc6\x18V\xd76\xb9y\xf35\x9a\xec\xcd#\x89\x13&\xe3!e1gey\xa3\x11Q\x17\x19\x83\xc3\xdbL\xd39\xbe\x14\xf8\xaay\xbc\xa8\xddP/I\x14sp\xe7\x06;\xeb\xeb\xcd\xe9\xe8v\xe8.\xf7\x1b\xb6\xca5\x95\x9dT\v\xf1\x83u\x8bS\xb18\x1dw\x8f\xcc]T\x9cC~Ij{jm\x9d\aΝ\xc3\x01y\x91\xe1)?\xf2\xfd0y\xc3c\xc8\xda\xda*ã\xfd\xbf0\x1b\x1fa\xb4\xa6֚n\xabC\x9aN)kM\xe0\a$qB\x96e\xd4uM\xe0\a8k\xa9ꊼ\xc8)uM\x14\x84X\xe7ȫ\x82\xdah\xa4\x14t\x92\x84\x8d\xe5u\x94RD\xad6u]q||@^\x16,-\xadQ\x169y6\xc3\x17\x8e\xe5\x95u\xf2l\xcex|L\xadkʲ\xa4\xeb\xfb\x88\x85\xb2\x11a\xe9v\x96\xc9\xe63\x067^\xc6\xf3=\xfc$b}g\v\x018c\xa9\x8b\x12]\xd6\xe8Jc\x8d\xc5j\xcb\x02#\xee\xd6\x14\xce6v\x87/\x15L܅\xa4\x93\xb4x\xfc\x81\a\xa9\xeb\nm4\xd6Y\xbf\xac\xca\xee\x1b\x06\x88\x10\xcd]\x19\xfa\xde\xd9\xe9\xe8\xe8}JJʺ\xa2\x15\xb5\xa8ʜ\xbc\xacP\x9eO\x12\xc7Lf\x13\x94\x94\v\xf5\xa0\xa5\xacJ\xea\xaa\"\xaf*\xe2 DHI\xba\x90\xe7H!i\x85\x11k\xbde\x82\xa0\x899\xebk[ܺ\xf9\x1aE]R\xd45I\x9cp\xb0\u007f\x1btE\x1c\xf8\xac\xacn0<\xda'MgTU\t\xd6\x10y\x8d\xfd\xa0r\x96\xb2\xcc\xe9\xf7V\x18\x0f\x0f\xe9'!\xda\x1a\xb6v\xb6iw;\x04Q\x84\x94\x8d\x8a\x11k\xd1y\x81.\v\xea\xaaD\xd7u\x03\x8e\xb1\x18\xad\xb1\xc6\xe0X\xa8\xe8\x17@\xdd\x05KJɛ\xef{\x80\xbc̐RR\xd5%Ƙ\xafnP\xffwU\x9fK\xcb\xeb\x1fL\x92\xb6W\xeb\x1a\x81\xc0\xf3<ҢD*\x9f8\fɋ\x8c\xc0\xf7q8\x8c\xd1\xe4y\x8e\xaek\xb4iDi\x9e\x170KS\xac\xb3H!\x88\x82\xa0\x11\x19\x84\x11eU\xd0\xed,3\x1a\x1d1\x99\x0e\xc9\xca\x12\xcf\v\xd0u\x891\x1agJ:\xbd&K\x9bMG\b)\xa8\xaa\x1a\xb5X\xa8yQ\xe0\xfb\x1e\x0e\x0f_\xf9L\x87\a\xc4QH\x9cĬv\xbb\xe4\xf3\x86\x9b2Fc\xb5n\xfe\\\x95ت\x00W\xa1˔:Ϩ\xcbfq\xad5\v\x95\xfc\xe2\x8a\xfb\xb7\xfc\x99\xa77\xb68\xb7\xb5\x8e\xef\xf9\x00:\xf0\xfd\xf9\x1b\xc6e9\xe7\xe8\xb6c?i\xf7~bv\xb4G\x9ag\xb4\x93\x0e\x93\xd9\x04c-\xa1磔Bh\x811\x16!\xc0Z\a\u03a2\x94O^\xe5\x04\x9eO\x9a\xa7\x18\xa3\x11R\x10\x06>\x81\xe7\xd1N\xda\xcdNG\x10E1ǃ;\xe4e\x89\x10\n%=t]S\xeb\x9aX)\xe2\xb8E]U\x8b~\v\x14e\x81\a\xa4e\x89\x93\x8eZk\x96\x96\xd6I\xe7\x13\x02*ZI\u0085\xf3\x17\xb8\xb5\xb7\xcfg_x\x91\xac\xaa\x88\x93\x84\xd5\xe5eZA\xc0\xce\xca\n\xa1\xe7#<\x05J\xe0\x908'\xc0\t\x9ck\x04v\x0e\x81\xb1\x1a\xed,\xc69\x94PL\xa6\x13\xee\xec\xefrnu\x95ݣ\tBP\x05~P|U\x01\xf9RǮ\x10\x82\xd5\xcd\xd3o\xb1u\xbdR\x94\x05R(\xb45\x14eI\x10D(%\x17]\xbf\xe6\xd86;\xcc-NP\x06B4\t\xc0]\x8a\xc5\x0f\b\x16n\xa8\xd0\x0f\x98\xce'\xac,\xafS\x95y\xe3\x03\xa9\xebFk\xdb\xe9c\xadř\x9an\xbfO\x18F\xe4y\x8a\xb3\xcdUh]s\xa9\xd4V\x93D\x01emhE-\xf6\xef\xbcN\xbf\xd5\xc2\xf7$W_}\x89\xd7n]#\x89\"\xac5\xbc\xb0\xb7\xc7\xcd[\xb7\xd8\xcbJzk[\xfc\xd4{\xdf\xcb\xe3\xf7\xdd\aR\xe0\x84\xc0\n\x0f\xec\"\xd8;\xb7\x00\xa3Y\x8b\xbc\xc8\xf97\x9f\xfe$\xbf\xfe\xaf\xfe%\xc7yN\xa8\x14\xb5\b\b\x82\xa8\xb4\xce\xe6o\xd8\t\x89À0\x88\xbecpt@Q\x96ĭ\x16\xf3\xf9\x1c)%JI\xe2(b\x9e\xce\x17`4\xfaY!%\xb5\xd6\b$\x02\xdbdL@\xe0\a\xdc5\a\xb6\x936\xc6\x18<塔\xc7l6&\xcds\x84T\x04\x9e\xbf\xf8\x1d5\xd2T$I\x1b\xa5<\xc6\xc3\x01v\x91n\xdf\xf5\x15\xf6Z1eUsj\xfb2y\x96RL\x0e\xe8\x9d>ũ\xed-\xd6\xd6\xd7\xd8\xdeޡ\xdd\xee`\xb4\xe6\xf8\xf8\x88W\xae\xbc\xc0\xed۷y\xe5\xfau~\xf1\x1f\xfcw\xfc\xd9\xefy/\xefy\xea)\x90\x02-4\x9e\x17`\xad\xc0چ\x9d\x06\xc7s/_\xe1\x9f\xfc\xc6?\xe7\xcc\xe6:\u007f\xfamo\xe1\xc5\xe7\x9ee8\x99\xb0_\xe6\xf8I'\x97JVo\x18 \xbe\xefa\x8c\xf9\xcet>\xa5\xaa+\xe2N\x1b]\xd7Da\x8c\xe7\xa9/\xee&c\x9a\xfa\xc2Z\x02\xe5\x9dx\xfbʪZ\x80\xe1c\x8d!\x8ec\xa4s\xb4Ⅎ*\x88\xa2\x84\xba*I\xb3Y\xa3\xbbU\x1eq\x14\xa3\xb5\xc6YC\xe4{(\xcf\xc7\x18KQ\xe68\xd7\xd44\xb5\xd6\xf4\x93\x98\xc8\xf3\t\x95\xcf\xea\xf2:\x83\xc1mZ\x9e \x89\x02ڝ6[\x9b[lmm\xe3\a>E\x9e\xa3\xeb\x8a\xe5\x95U\x8a<\xa7\xaaj\xc4\xcd\xd7\xf9\xc7\xff\xe2\x9f3\x9b\x8e\xf9\xe1?\xfd]T\xa6ƅ\x16)\x03\x9c\x05)4W\xaf\xbdʿ\xf8\xb5\u007f·=\xf9$\xcb\xfd%\xa6\xb3\x19\x0f\xdc\xff\x00W\xaf^a\xb0{\x80\x92\xde\xe4\x97\xfe\xde\xdfy\xe3\xfa!\x9b\xdbg\xd6\xea\xaax\xac\xa8\n\xbc \xa0\xaek\x84\x10(Հa\xad\xc5\x18\x8bs\x82JW\x84Axb\aЋ\x93\xe1{\x1e\xc6\x18\xa2 \xc0YC\xa7\xd3C)\x8fZ\xd7\xf4\xba\xcb\xcc\xd3\t\xf3\xa2@*o\xf1{A\n\x89\xd6\x19\xed\xa5\xde\xc9ue\xad\xa5\xd6u\xb31|\x9f~\x1c\xe3+\x0f\x1b\xb4)\xb2\x19*;\xa2\xdbn\xd3\xebt\xe8\xc4\x11Xˍ;wȫ\x9a\xc0STٜ\xaa*\xb1\xd6\x11\x04>q\x18q9p\\{\xee3<\xb3\xbd\xc5=\xf7^B\bP\xaaIɍ\x81\xe7\x9e\u007f\x8e\v\x17.`\xac\xe5\xea믓\xe79y6'\b#h6\xe0\xed7\xb4A\x95\xc4\xedoI\xd34(\x8a\x92N\xafK\x91\xe7x\xca\xc7W~\x93\xf2-\xa8\x05m4R*|\xcf'ͳ&\x15\x14,\\\xb1\rE\xe1)E\xe8y\x04A\xb0(\xe0ZXkH\xf3\x14k,\x9e\xf2O\xb8%c\r\xb1\x828i\x13E-\xf6v\xaf\x13Fm\xf2,C:\xc7f\xafG\xe4\xfbdڰ\xd4Y\xa6\x9a\x1c\xd0R\n)\x04Ͽ\xf0\x12\xd7\xee\xec1\x9c\xcdx\xf9\xd5\xd7\xd0\xce\xe1DS\x04\x9eZ[\xe5\xc2\xf6\x16\x81i\xfa3q\x18\x10E!/]y\x86Sۛt:\x12\x81\xc3\xf7[\xdcٽ\xc3\xcbW^\xa0К\xddό9\x9eg\xa4e\x93\x82w=\xc9Hx,Y\xf3\u07ff\xa1\x80X\xa3\xdfu8\xd8o\x9aK\x9eG]iz\x9d\x1eY\x9e\xe1\xf9>Y\x965'\xc59\xdaq\vm\x16\xe9%\x0e)$\b\xb0\xd6Ҋb\xa4p$q\xd2\xfc\xdc9\xe2\xa4E\x96\xcdȊ\x02\xcf\xf7\x9b\xb8$US\xe1\xd7\x19Q\xe8\xd1juI\xd3)\xd66\xee\xa8,\x9b\xb1\xd9\xed\xd1\nB*k\x88;\xabT\xe9\x84\xd8V\xd4U\xcd\xe1l\xce8\xcd8\xbev\x9d\x1a\xa8\x01\xa9\x14\xe7O\x9d\xe2\xea\xed;\xbc28\xe2\xc6\xe0\x88\x8d$\xe4\xd2\xc6\nQ\x10\xa0\xa4D\xd75\xfb\xfb\xfbt\xbb}\xa4\xf4\xa8\xaa\x9a\xdd\xfd=f\xe9\x9cW\x06C\xf6\xa6s\xee=\u007f\x91\xe1\xad\xeb\xf4\x93%n\r\a\xb4\x96\u05efN\xb3ٯ\xbfa䢔\xb23\x19\x1f\xbfg>\x9f\x12\xb7\xe2\x85MY5ꌪj2*gQJ\xe1y_,\x06\x8dsMA\xa9\x9a\x9d.\x84\xc0S\x928\x8a\xb1\xce\x12x\x01\b\xb1\x10\f\xa4X\xe7\x16\xc6Ϛ \b1Ɛ(A\x10\xc6\x04a\xc4\xd1`\x0f\x84@뚶\x1f\xd0\x0e\xa3\x86\x9c\x14>\xd2j\x023'P\x92 \n\xb0\xbe\xe2vY\xd2\xedv\xf8\xceox\x94\xbf\xf8\x1f\xbc\x8f\xefz\xe7S\xfc\x9d\xbf\xfaW\xf8\xd6w\xbc\x83ȓl\ue721\xf2\x02n\r\xa7\x18\xe7\xd8\xdc:\xc5\xea\xea\x1aE\x96Q\x14\x05J)\xaa\xaafp|\xcc \xcd9w\xfe^\x9e|\xf8M\xfc\xf9\xf7\xff0\xa77Nq<\x19\xd1\xed.\xbb8L\xfe\xc6K\u05ee\xd6o\xd8\ty\xf0\xa17\xbf\xbb,\xe6K\xb8c\xc2(\"\x9fgDQB^dHO\x91\x97\x8d\x9b)\b\x166e]a\x16i\xa2\x94\x12c,8h\xc5Q#\a\xf2\x83\x136U)EQf\xa4E\x81\xd7\x14X\b\xa9PRR\x14)\xddH\x11&m\xaa\xaa\xa2\xaa\x9bLk2\x19\xb1\xdeja\x9dcT\xd6,\xf7\xfb\xa8jB\xaf\x15ӉcZI\xcct>\xa5ێ\xe8&\x1d\x9e|\xf3C\xec\x9c9\xcf=\x8f\xb4\xf8\x87\xbf\xfa+\f^{\x91\xb3\xcb\x1d~\xf2\xcf}\x80\x8f\xfc\xcb_\xe3`o\x8f\xf5\xcdS\xf4\xba=<\xcf\xc39K\x91\x17t\xbbM\x85>\x9eM)\x9c\xe4\xbb\xdf\xfe\x14\xb5\xd6\xfc\xa3\xff\xf5\u007fc0\x1er\xa1\xd7E\xb4\x96\xff\x97+\x87\xfb\xff\xf8\xcb\x1d\xea\xf3e\x01\xd2\xed\xf6~\xf4\xda\xe1m\xfc`\xb1`\xae\xa9૪\xc2\xe2\xf0\x17M\x1e\xb78\x11w\xbd\xe0ba\xb2\x14N\x10x\x8d\x1f<\x89b\x9c\xb3\xb4\xe3Nc\xcat\x8e4\x9dc\xad\xc5\xf7\x1a\x86\xb8\xdb\xeeR\xe9\x9aP\x1a\x84\xf0h\xb5{L&C\x84\x10X\aႢ\x1dU5K\xbd\x15\"\x9b\xd1i'l\xad\xacp\xdf\xfd\x0fr\xea\xd4\x0e\x87\a\xfb\xfc\xfe\xe7>\xc3d<䓟y\x9aK\xe39\x0f\xbf\xe9\x1bx\xf2\x89'\x91~\xc2=\xf7^fww\x97;7n\xf2\xaew\xbf\x9b\xfb.^\xe0\xe0έ\xc67\x82<\xa9\xd2\x03?\xc0\xf7<z\xb6\xe0ӟ\xfd\x14\x0f\xdf\xff\x10\xdf\xfb\xd47\xb2wx\xc0\xf3\x9f\xfd\x84\x13\xb6\xfe\x85\xbd\xa3\xc1\x97=b\xe9\x0f\r\xc8\xce\xe9\U000e7aea\xfc\x96\xb2\xc8\t\x93\xf8\x84l+\xf2\f\x8b[\xe4\xe8\x12\x87\xc3ZK\xa5+\xec]&U\b\xacq\xf8^s\x95\x05~@\xa0\x14a\x105ם.\xf1\x9c\xcfh6!\b\x9a\xd3\x13\x04\x01J*\xcatF\xbf\x1d\xe1\x05\x11\x02\x1a!v\xd2f::\xa6\xeb{̝b}i\x99\x84\x82\xad\xf5\r\x9ez\xf2\x1d\\<\u007f\x81\xad\x9d\xd3x~@U\x95<\xf6\xd8\xe3<\xff\xe2\xf3|\xeaS\x1f\xe3\xd9g\x9f\xe1\xe3\x1f\xfb\x18\xa66h\xad\xb9\xf5\xdcgy\xf0\xa1\x87\xf9ٟ\xfd\v\xdc{\xf9~^z\xf6\xf3DQ\x84\x10\x12\xb30\x89:g\x89\xa2\x84\xed\xcdM\x1e\xbct?\x16\xf8\xe8G>D\x96N\xf1<\x9f͍\xd3\xe2\xf8\xe8\xf8\x1b~\xfe\xe7\u007f\xfe\xe5\xbf\xf6\xd7\xfe\xda\x1b\x03ș\xb3\x17~hpp'\xb4\xd6\x12\xc5QÊZCVd\x84I\x02\xdaRVUC֡1\xa6Iu\xefRա\xd7T\xe3\x81\xdfp]\xbe\xf2\x1b\x91u\x995\"\xb9<\xa32\x86@4\xd7W\x12%deA\xcboڦ\xadv\x8f\xaa\xae\b\xa3\x98\xd9lF V\x94\xc4\x00\x00 \x00IDAT^̉\x92-\xd6\xdb\x1d\xba\xaa\xe2\xc1\xfb\x1e\xe2\xbd\xefz7\xab\xcb+ %~\x18b\x11\xc4Q\xcc\xce\xe93\xac\xaf\xad\xf3\xc4c\x8f38:b\xef\xf0\x80y:\xa7\xdf\xefsz{\x87\xad\xadS\x84aĝ[\xd7)\xf3\f\xa5\xbc\xc5Im\x12\vk\x1d\x9e\xefs\xf6\xf4\x19^\xfa\xc2Ӭ\xafnp\xe1\xf4\x19ʺ\xa6\xaak\x06\xc3\x11E\x9a=\xf4\xfd\xdf\xff\xfd\xbc!\x80t{KR\xe0\xde?\x1c\x0eN\xb2\x9f\xbc̩t\x85\x93ͱ\x16NP\x94%Q\x14R\x95\x8d\x90\xc1-\xba\b\xbe\x94\xf8Rb\xac\xa5\x9d\xb4\xf0\x94G\x10\x84\xe4E\xdax\xceU\xc0\xf1d\x9f\xc0\v\b|\x9f(\x88\x1a/F]\x92\xb4C¤\x8d\x1f\x04M/\xa5,\x98\xa7c\xb66\xce\xd3\xf1\x05=\xaf\xe6\xe2\xd9\xf3\xbc\xef{\xde\xcbR\xaf\x8f\xef\xfb\xb0\x10'x\x9ewB\xfd\x84R\xa2<\x8f\xe5\xd55\xee\xbd\xf72RyM\xbf|\xc1\x15LFǌ\x06\a\xf4\xfa\xcb\xe4yFY\xe4Ml[\xfc\x0e\xcf\xf3\xd8\\\xdf \x8e\xc3F\xad\xd2\xe9\xd0\xedvQJ\xf1\xc9\xcf?\xcd|<\xbe\u007f}}\xfd\xcbW~\xfea\xfe\xf2\x9b\x1f\u007f\xfb\xa3\xd3\xe9\xf8Qk\rq+\x06\xeb(\x8b\x82i\x96\x12G\x11UYQ,\xa4=w\xc7#\xb9\xa6\x05\x8d'\x04\x1e\xb2\xa1\xe5\x95G\x1c\xc6xR\x91\xe63\x8a\xb2@\xa9\x80\xc9|J\xad5a\x10\x12\x05Q3Q\xc1h\"\xaf\xe9\x87w\xba\xcb\x18\xad\x19\x0e\x8f\xa8+\xcdŝ{X\r`\xc9w,\xb7;l\xf5\x96\xb9u\xed5\x8e\x0e\xf6\xa9\x8a\x02\x8c\x05cP\xca#\x88B\xfc \xc0\x0fC\xfc0By\x1e\x02\xd1x\n\x17\x05k:\x9bR\x97%;\xa7/\xd0\xeb\xaf\x10E\t\xbe\x1f46\xeb\x13\xd5\t\xb4Z\t\x9dnw!\x1eﱺ\xb2L\xa7\xddfc}\x1d\xe9\xfb\xdb\xddn\x977$\x86\xd4U\xf1#\xe3ё\xf0<\x8f \f\xa9\xf2\x92Y\x9e\x12\xf8\x01\xda\x18\x9cm\xd8U\xa5\x1aU\xf8\x82\x92D!\x11֑\x9b\x8an\xab\xcdj\u007f\x99y\x9a\"d\xc3\xfe\n\x14\xc6:\x8e'#\xc2 $\x8e\xe2\x13\xf6\xd6蚥$ n\xf7q\xcer8ا\x97t\xe9%1\xa2\x9c\x12G!K\x9d\x1e綶\xf1=\xc5\xf8\xf8\x18\x8ff$\xd3\xd2\xea\x1a\xa1R\x98\xba\xc2\xf3\x13\xbc\xc0\xc7\x1a\xdbLp0\xcd\xf5S\x97\x15\xba\xae\x11R\x12F1a\x14a\xb5i\x86\xdb\xe4\x19B\xd0h\x88\x95j\xd8k!\x89\xe3\x84\xcdS\xdb\x1c\xde9hھ\xee\x8b2Z)\xe5\x1fɷ\xfe\xff\xfa\x84\xbc\xed\x1d\xdf\x1aVU\xf9C\xf3tF\x94D\b!\x98\xcdfhc\b\xc3\xf0\xa4\xfep\xce.\xee[\xdb\xfcv\a\xce8@\xb2\xb1\xb2\xc6ro\x89\xc9|\xca,\x9b\xa3\xb5\xa6\xaa\rq\xdcbwШ\r\xe3(A\nA\xa5k\x1c\x8e\x96\aq\xdc&\x8cb\x8e\x06\xfb\xf4\x926\xed\xd0CgczI\xc2Zo\x89S\xcb+\x04\x9e\x87[HW\xab\xb2b28d<\x18P\xa4\r\x13\\\x97%\xce:\xa4j\xae\x1f\xa1$R)\xfc0 \x8cb\x82(\"\x88B<\xdfG,b\\\x92\xb4\xf1\xfd\xe6d-\x16\x1b!\x05A\x1cs\xe9\xc2=\xe4Ō4\xcb(ˊ,\xcb9\x1c\x1c\x82\x10\u05cf\x8e\x8e\xbe\xfa'$\x8e\x93o\xdb\xdb\x1dl\v\x1ca\x18P\x15%\xf34#I\xe2\xa6\x104\x16\xe3\xecB:\xb3Pm,R\xdc$\x8a\xe9w\xfb(!9\x1a\x1dc\xaci\xba\x87@\xbf\xbb\xcc\xfe\xf1\x01\x9e\x1f\xe0{>\x81\xef\xa3\xefz3\xac\xa6\xdbIhu\x97\x18\x0f\x8f\xe9\xb5zd\xd9\f\x1f\b\x1d\xf4\x936I\x18\x11\x05\x01\x88f\x12CQ\x96\xa4yF\x14\x86\xa4\x931r\xb1s\xa3N\x87*/P\xbe\xb7\xd8\xd1\x0e\x9ch\x80\x11\x02\xa3kLu\xb7C\xd8t\a=\xe57L\xb4kt\x00J)\x04\x02\xa9\x14k\xab\xeb\xc4I\xc4\xd1`\x80\xaek&\xd3)\xc3\xe1\x88*\xcf_:88\xf8\xea\x02\xe2\xf9\x1eu]\xfd\xe4h8 \x8c#@0\x9dN\x9b\x87A\x9e\xd4\x1c\xceZ\x94\xa7\x16\xf5\aX\x03\xed(f\xa9\xdbG\b\xc1`|\x841\x86\xc0\xf7\tÀn\xbb\xcf\xd1\xf8\xb8\x91\xfb#\x88\xc3\x10\x81\xa3\xd2\x1aOy\xb4<E\xb7\xbfJ6\x9f\x13Gm\xf6\x8f\x0f\xe8\xb5\xda\xccfS\xce\xec\x9cB\xcaFZ$\xa09\x05u\x85\xb3\x96\xf9\\\x10x\r}\u007f\xb7Ռ\x10\x04I\xb2\x10v/z\xe4\x8b\u007fg\xaa\x1aS\xeb\xa6\xd60\x06]\xd7\x18c\x16b\x13\x87@R\x16\xc5I\vA\x05\x1e\xadn\x97{.^\xe6\xf3O\u007f\xbe\xe1\xe9\xd2\f]U\xd4Y\xf6\xf4\xee\xee\xeeW\x17\x90\xc7\xde\xfc\xf6\x8bE\x9e}WY\x15,\xaf,S\x95\x15\xf34#\bC̢`2\v՞\x14\x8b\x1a\xc48\x02靀q4>FkM\x14\x86$qD\x12w\x18ͦ\x14u\x93\"\xb7\xe3\x04\xa5\xd4\tk\xecaX]\xd9\xc0h\x83\xa7\x02v\x0fnі\n\x0fA\x1cݭ_\x02|O\x01\xcdL,\x01h\xe7\xa8j\x8fy\x9a\xe2\xfb\x01B4\xf6\xba\xaa,鮭\x13\xc6\t\xca\xff\xe2c7@4\xa9\xbb]\xf4\xcdq\xe0y>y\x91\xa1uM\xab\xdd!\xcf\v\xd24\xa5\xd3[\u008bC\xba\x9bk\x9c9{\x8e+/\xbf\xc8h<f<\x9bQe\xf9\xd8:\xf7\x91\x0f}\xe8C_]@:\x9d\xceO\xbe\xf6ʋ~\xab\xddB\xca&v(O\xe1\x8cE\xfa\n]6\xf3\r\xbd\xc5=[\xeb\nO(6W\xd7\x11Bp8jNF\x1c\x86tZ\tQ\xd4f8\x9b2\xcd\xe7\xf8\xca#\x0e\x9a\x05\xb6֞P,\xab\xdd\x0e\x9e\x17P\x97\x9a\x83\xc3[D\b:\xbd\x15\xeat\xcc\xf2\xca\x12B\x80\xbf\xb8B\x9a\xfe\xb68Q\x1d:\xd7\xd0\xf1\xb3\xf9l!?j2\xbf\xd9\xd1\x11u\xbb\x8d\xf4\x14\xbe\xef7N\xaf\x85\x9a\xc4T\xe5\xc9T9'\xc0\v\"<\xcf\xe7\xe2\xfd\x0f\xd2]Y\xa5\xcc2f\xc7C\xb0\x16\xe9yxa\xc0\xda\xceivN\xed\xf0\xd2˯1OSL]\xfd\x93\x9f\xff\x8d\xffcz\xcf=\xf7|\xf5\x00y\xf2mߜ\x14y\xf6\xe7\x8a2\xa5\xdb\xefR\x15%Y\xd64\x84\xa4/q\xc6b\\\xb3;\x03ϧ^t\a\xb7VWPJq8l\xe4AqԀ\x11GmF\xb3\x19\xc3\xe9\x88v\x9c\x00\x8e\xd0\xf3\x9a\x96nU\xe2\x10t\xa3\x80v\xa7GUT\f\x06wPֱ\xb2~\x8a\xf9x\xc0V'A\t\xd10\xc3\xff\x1e!\x86\xb5\x0e\xad͉\xd4\xe8K\xd3Vc4\x9e\xe7c\xa2F\xd6*\x1a\xb1.\xce:\xecb\x04\xa0\x15\x8a\xba*\tC\x1f%\x15\xa3\xdd]&\xc7Gĭ6FyX\xad\x01\x0f\xe1\xa0\xdd\xea\x90\xe69y\x9aRk\xfd+\xbf\xff\xfb\xbf\xffG\x92U\xfd?\x02\xb2\xb4\xb4\xf2go\xbc\xfe\xf2J\xab\xdd\xc2\x1aK\x9af\r\x18\x8bfT\x9e\xe5\xcd\x100\xcf\xc3\xd1,\xc4R\xabK\x1cF\x1cOF\x8d\x18!\f\xe9\xb4Z\x84a\xc2x6c09\xa2\xd7\xeaR\x9b\x8aN\x94 \xa5l\xd2\xe6f\xbf\xb3\xbe\xb4\x8c\xa9,\x87\x83\xdb\xd4\xdapj}\x87\xd9t\xc4r\xe4\x13-\xb2\xa0\xbb\xe9\xa6[X2\x95R\b)\x17ח\xc5چ\x12ɲ\f\xdf\xf3O\xaam_*\x845\x8b\xc1h\x0e'\xc0-\xb2Cc]\xa3\x02\x96\x02m*\xa41\xbc\xf6\xfc\xb3<\xf7\xec3\x14y\xc1\xd9\xd3;\x9c\xbb\xf7\xfeŌF\x18\x1e\xecqcw\x97\xd9lNZ\xe4\xfa\xe3\x83;W\xa7\xbf\xfa\xab_=@.\xdf\xf7\x90\xc8\xf3\xf9\a\xab:C)IUV\xe4y\x8e1\x860\x89\x1b\xfb\x99\xb5H٤\x89eY\xe2I\xc9R\xb7GZ\xe4\x8d\xc59\f\xe9u\xdax^М\x8c\xd9p\x01FM'n\xe3K\x87\x13\x92\xba.p\xc0Z\xb7C\xe0E\x1c\x8f\x8eXY\xdej\xbc#\xd3\x111\x9aV\xd0i:\x8d\xca[\x04j\x16W\x14 \xef\n\xa0ݢ0\xb5(\xeb\xa8\xebF8\x17\x86!JyDa\x93\xc6\xcaE\a\xb2\x99\xc9\xd8\x14\x86\xae\xa1\xa3\x91J\xe0\x01ZW\xbcv\xed5^}\xfdf\xd3\xe7\xf7<tUs#|\x19\xeb\x1c{GǼ\xf4\xf2\xabX\xadٛ\xcf\xea\xd2\xda\xe1o\xfc\xc6o|\xf5\x00\xb9\xf7\xbe\x87\xdfy\xfb֫\x8fJOR\x15\x15EYRך\xc0\xf7\x17\x05S\x8d\x10\x10\x04~\xf3@α\xda_F\b\xc1x6!\x0eC\x96\xba]<\xcfg8\x9d2\xcef\xb4\xe36\xd6\x19\xdaQ\x82/\x05H\xaf\x19t\xe9,\x81\x92\xac/\xafS\x95%\x00{\a\xb7(\xab\x8a\xc4\xf7Y\xedwO\xb2\xa3\x86\xe5]\xa8\b\x81\xdaX|\xebP\xdeB\u07ba`\x8d\xad58<ʪ\xa0\xaa\"|\xcfo<)J5דs'\x05\xac\x90\xa2\x99\xea\xe0yxJ U\x84\n\x02\xde\xf4\xf0\x03\x18c\xd9\xdb?\xe0\xd5\xd7o\xf2\xf2\xab\xd71\xce\x12\xb5\x12\xe28\x06mȊ\x9cky\x8a\xfb\n\f\xd2\xff\xf7\x16\x86\x9e\xe7\x91Χ\x1f̲I3\x1e\xcfh\xb24C \b\u0080</N\x1eD-\x1aSݤE'i3\xcbR<\xa5X\xea\xf6\U0003d423\xf1\x90q:!\\\xd4\x1aq\x18\x11x\r\x87d\x9d#_\xd0-\xa7\xd76\x99N\xc7ܼ\xf3\x1a\xd3\xd91Eը\xe0\x95\x92\xa8E\x87Q,8)\xb1\x10Nܥ쵱MC\xebnG\xd35\xac\x01\x0e\x8c\xb1\v\xeb\\\x13'\x9a1\u007fv\xd1HsX\x1cց\xf2\x14\x9e\x12\x8b\xe1\xca!A\x10\xb3\xb1\xb9\xc3So}\x92\xb3gNs鞋\x8b\x93،\x93\xcd\xd3&v\x1cd\xf9\x89,\xe8\xab\x06\xc8{\xbe\xef\xfd\x17\xa7\xd3\xe3\xf7\x1akе\xa6\xaa*t\xad\xf1\xfcfjg\xe3\xddh&CWuc\xbeI\xa2\x18m\fEU\xb0\xdc\xed\xe3{\x01\x83ѠqAIٌ]U\n_y8\xabq\x8e\x130έoa\xea\x8a\xe3\xd1~\xb3s\x9d#\f|<OQY\x8b\xb5,v\xfc\x17\xf5a\xc6\xda/\xee\xf4\x93\x18\xc0\"\x96|Q\xbd.\xa5<\xa9G\x84\x10\xcd\xccEݤ\xbb\xd6Yp\x0e\xe55\xf2%\u007f\xb1i<\xa5P\xcaG(\x8fN\xbbC\xa7\xdd!\xafJ\x96VW\x1a\xea\xc5:\xe6\xd3\x19EQ\xb0\xa7+\xa4\x94\xee\xab\x06\xc8;\xbf\xe9ۙN\x87\xbf8\x9d\x1d\a\xe9|\x8e\x90\x92\"/\x17>rA]\xd6'\x0f\xe8\x9cE\x1bM\x126WB^\x15\xf4\xda\x1d\x02?`\x9cN\x98U\x05\b\xc1\xc6\xf2:A\x10 \x85\xa0\xc83\x9cP\x94ڠ\x8d\xe1\xcc\xda&\x9e\xb0\x8c'Gh\xd3,lQ֨E\x15\xed\x1c\xcc\xef\xfaG\x16 \xe8\x93A\x96z\xb1\xeb\x17\xbb\u007f\x91\xba6ܓw\x92|x\x9eߴ\x87\xa1\xf1\xc4;\x8b\xa3\xa1y\xa4פ\xeb\x9e\xe7-Rw\xd1\x14\xbb\x8b/S\xd7tZ-\xa4P\xdc\xff\xe0\x83ĝ\x16\xb5\xd6\xd4e\xc9D\xd7\x14\xce!\x84\xccN\x02\xd8W:\x86\xac\xaen}Ӎ\x1bW\xdeW\x95\x15\xce:\xf22\xa7,+\xfc\xc0\xa7\xae\xf5I\x86\xa3\x16\x83\x8ec?\xa4\x9d,L\xf64-\xd9\xf1|\xc2(\x9b\xe1\x9cc\xa5\xb7L\xe0\a\r\x9d]\x96$qܨ\vu\xc5fo\x89v\x14p<<\xa0ZL\"Ջ\"\x13\aR\nZ\x9e\xe2`\x9e\xd3\r\x03<\xd3\f\u05ef\xb5&\xb0\x8d\xd8\xce\xf7<\xacQ\x8bE\x96\v!\xb4Dy\xcd0L\xe5yx\xbe\u007f\x12\xc0\x9d\xbb됒H\xd9\xf4\xf5=!\xf0\xadE\xd45\xba\xc8\x19\r\x8e8\xba}\x87\xa3W_\xa5\x98\xcd\xe8\x9e?ϥ\xd3g\x18\x1c\x1d\xd3IZ\xec\x8egTU\xc5\xfe\u0087/\x84\xb8ʗ9,\xe0\xff\x16\x90o\xfd\xb6wy\xd3\xe9\xe8\x97g\xf3\x91\x9a\xcf\xe7\b\x04\xe9<;\xa1D\x9a|ݞ\xdc\xcdI\x18\xd1m\xb5\x89\xc2\xe8\xa4\x16\x99dSF\xe9\x14\xeb\x1c\xbdV\x97N\xabC\x96g\x18\xe7H\x92\x16RJ\x8a2'\xf1\x14k\xbd\x1e\xa3\xf1!EY`\xecb\xe4\xabi\x1aZ\xda\x18\x94\x92,\x85!\n\xc1\xadɜ\x8b+މ\xbe\xab\xaek|\xa5\x9a\xbfg4\xbe\xf5\xbe\x18\u05fc\xa6\x0f\"\x95\xc4\xf3\x83\x93A\xfcw㎐\x0e)\x1c\xd3\xe3!\xbe\xb3\xf4\xdamҪ\xe2\xf8\xd6-\xc6W_&\xbb\xf6*8\xa8\x84D#8>\xd8\xe3vi\xa8\xad\xa5v\x96:\b\x98\xd7\x15#k\xee2\xbd\x1f?\x9e\xe4|E\x01\x11B\xd0\xe9\xf6\xdf\u007f\xe3\xe6\xcbo)\xf2\xa2\x89\x1de}\x12\xe4ˢ\xc49sb\xd6iE\x11ݤ\xdd0\xa0^C\n\x0e\xe7\x13\xa6\xf9\x1c\xe7\x1c\xed8a}e}aW\xb3\x84A\x80\x14\x92\xba*h)\xc7\xd6\xda)&\xd3#\xe6YJ\xa5\x1b\xee\xa8\xd6\x16m-\x12A\xa0\x9a\x1b\xa0\xc6яC&Eŝɜ\v\x9e\x8f\xf1\xfd\xc5|\xf7&\x865\xb4\x8b^\b&\x1awӗ\xbe5\xa1\xe1\xdb8I\x02\x9c\x83\xe1\xe0\x88_\xfb\xb5\xff\x1dQV$J\x11hӸm\x83\x90<\xecQ\xa4s\xc8\xe6lD\x8d\xc8\xef(/\x89|\x1f\xe3\x1ce\x96s\xa7\xf8\"\x00J\xaa\xe7\x9bN\xb5\xe0\x8f\x92l\xfd\x01@\xbe\xff\xcf\xfc\xf8\xd2\xee\xee\xf5\xbf1\x9b\x8d\x98\xcef'\x03\x87\x830\xa0,K\x8c\xd5'^\xa2^\xabM\x1c\x86Xgi\x87m\xac\xb3\u007f\x00\x8cn\xd2\xe6\xec\xf6Y\xf2\xa2y\xeb@\x10D\xf8Jbˌv\xe4\xd1N\x96\xc8\xd2\t\xe3ٔZ\x9b\x13\xdac^V$\xbeG\xa5\r\x956\xc4\xc0\xb4\xd6\xf4}\x9f\xd5$\xe48+ٛ\xcd9\x17\x84'ܗ\xa7\x14\xaa\xae\t\xfc&\x85\x0e\x03\u007f\xe1\x0f\x14'=\x99\xbb\xaf\x16\xb9\xbbT\xd6\x1a<O\xf0\x83\xdf\xff\xbd\xd8ZSe\x05\xa6\xa8\x89\x80\x8e/\xe8l\x9d\"\xdb\xdc\xe0\xce\xcd[|\xe2\xd7~\x9d|r\xc4N\xb7\x85\xef<nL\xa7\x8cfsv\xab\x92 \b\xbf\xf4\xca⏚\xf9\x9e\x00\xf2g~\xf8\x03\xec\xee^\xff\xeb\x83\xc1\xed\x9d<ϱ\xdaPW\x1a\xa5\xd4\t\xfb\xd9H9\x05\xbdV\xe7$\xfd\xec$m\xc01N\xa7L\xb3\x19\b\xc1rw\x89\x8bg\xceS\xd6ͻ<\x02?\xc0\xc7 mI\x904R\xcey:c2\x9fQ.|%\xbeR\f\x8b\x02_62Ԧ\xd5\xeb\xc0:\x94\xafȬ\xa5\xe3\xfb\xac\xb5\"\x86y\xc9`>g'Xƺ&\x8eH!\xa8\xb5\u07fcQG7\xb5\xd2]\xfb\xd9]O\xc7\xdd/\xe7\x1cZW8gp\xa6\xb1\xd1\x19\t\xc6i&\xe3\x11\x9d6D{C\x92\xf9mֻ\xab\xbc\xe9O=\xcet8 ;Hyy\u007fĕɄ׳\xd9\xc9\x1c\xad\xc5\xe7\xf0+\x91e\x9d\x00rpp\xfb\xed\xa3\xe1\xc1O\x96eA:K\x9b^\x81\x10M:\xa9\r\xe0\b<\x9fV\xd4P\xd8q\x14ӊ\x12\xacs̲9\xc3\xf9\x04!%k\xfd\x15\xce\xed\x9cm&5\f\x0fi\xfb\x12W\x17MF&=ʢ\x19\xc7T\xd65iY\x11.\n\xb1a\x96\xa3\x05t|\x8f\xb2\xd2D\xbe\"P\x82@J\x94\x94\x9c\xeeu8\x98\xceY\x8fBV\x13\xc1(M\xb9\r\x9c[_o\xf4\u008b^H\x146\u05cb\xd6\x06ϳ'\xaf\xa2p'\x9d\xfdf\xe1\xeb\x85\xc3IW\x1a]\xd5hmȫ\x82\xdcX\xf6nMH\xaa\x92\x8d萶z\x05\xe1<\xb2,\xe7\xe6x\xc2珎\xd8MSv\xab\x9c8j\xdd]\xbe\x82\xaf\xc0\x00\xcc\x13@\xfe\xe9?\xfb\xcdp6\x1d\xfd\xdd4\x9dy\xd9<Ú\x86r@\xd0ظ\x9c%\x0e\xa3E\f\x10$I\x9b\xc8\x0f\x1b\x8bYU2ΦH)9\xb5\xbaəS\xa7\xf1\xa4dz\xbc\vU\x01\xb6\t\xd2U\x9eS\xe9\xc69UiC^մ¦\x97q\x9c\xe5\x18\xa5h{\x8aJ\x1b\xa4l\xee\u007f_*p\xb0\xd9j!\x84\xe0\xccJ\x9f\xba\xaaI\x02\x1f%\x04\x93\"\xe7\xea\xee.\x976\xb7\xf0<\xb5H\x97+\xa2 X\xa4\xc5\x1acT\xf3\xdd\x1a\x8c\xbb\x9b\xa1Y,M\x80\xbf\xfb\xfa\x8a\xba\xac(\xab\n\xab\x04\xb2\xdb\xe1\x95\x1bc>\xfe\xdauf\xf39\xbeh\xd2\uf8ae\xa9\x80AY\x9c\\\x85\x8b\xcf\x1eP~E\x00\xf9\xd1\x1f\xffO8<\xbc\xf3s\xd3\xe9\xf0My\x9aQd9\xfa\xeeKP\x16\xd5p+\x8c\x9akG\xf9\xb4\x93\x04\x81\xe0h:\x02\x1c\xb32%\xf4C.l\x9f\xa1\xd7jQΎ\x19es\x8a\xb2\xa2\xa8J\x9cm\xfa\v\xb55\x8d\xc6\xc9Z\xb41t\xa3\x90\xb2\xae\x99U5\xd6o\x9aQ\xd2\x18\xb4]\xd0\xf8B ESq\xb7À\xc3<\xe7\x1bO\x9f癛\xb7\xd9X\xea\x13\xa5)j\x9e1/k^\xb8u\x933k\xeb\x9c]_[\xbc\xf6\xa8\xe9\xeb\xcbJ\x9c\x10\x91a\x186\xdab\xa7\xb1r\xd1\xc2u\x1eB\x9b\x851\x87\x93믶\x86\xde\xc6\n\xdd\xf5e\xb24c<\x9a08\x18P\x94%N*\xe6F\xff\x01\x172\xf0\xf2\xf2ں\xfd\a\xff\xd3?\xfe\xb7\f\xb1k\u007fx@\x8e\x8e\x0e\xff\xf2l6\xfa\x85\xf1hD\x917#P\xbf\xf4\xc5Z\xad(&X\xb0\xa5\xed$\xc1\x18\xc3\xe1\xf8\x98\xc0\xf3)M\xc9ٵM\x96;\x1d\xac\xa99\x1a\xecR\x16E3\x9e{\x01j\xb5\xf0\x12F\x9e\xa2\xb6\x06g-\x81\xa7\x98\x15\x05BJ\xdaI\x84\x12\x82X\b\x86E\x85\xa7$\x12\x81\x92\x02c\x1d\x9d$ \xf2<\xacsDQ\xc4\xdaR\x9f\xa5~\x8fn\xd2\xd0/r2#КݣC&i\xca#\xe7\xcf\x11.,؞1ԺFII]\xd7(Oa\x9c\xc1W>B6\x85\xad\xf4\x15~\x12\xe2\x04,\xab\r\x828jN\x9b\xd6\x14\xf3\x8ct\x962\x19\x8e\x18\x1e\x8f\xb9~\xed:\xbb{\x03\xd4\t\xa7v\xb2\x8e\x9f\xf9\xf0\xef~\x84?\x8a\xda\xe4\x04\x90\xe1\xf1\xfe/\x1f\x1f\x0f\x16\xb6e}\xd2gp\xce\x11\xfb!ja%\xe8$\tE]q8:&\nB\x02_ri\xe7\x1c\x02\xc7l6!-r좰\xab\x8c\xa52\x06\t\x84~\xd3s(\xeb&\xf06\xd2R\xd7dn\xd61Ն\x8bID$\x15iYa\x16ŠD0\xafk:Q\x84\xafT#b\x00N\xaf\xae1\xcf\vv\x96VN\\\xb0G\x93\x19\x81R\x14\xba\xe4\xd3W\xaep\xf9\xf4iά\xaf\xe3ڭ\x85\xaf\xa4yY\x98g\xbc\xa6\xc0\x8d|<\xbf\xa1\xeb\xbd@\"[щ\xb8A.ھuY\x10w\x9a\xeeb\xdcN\x00A\x9eedEE8\x1e-\x009\t\xea\xbf\xdf\xe9t\xbe\"\\\x96\xb7\xb7{\x9d\xc9x\xf1ލ/\x81<\xf0\x1b\xd3f\x12F\xb4[-\xd2<c\u007f8 \xf0}V;1K\xdd.y\x9e\x91\xa6\xf3/\xb1\xff\n\xb4\xb5(!h\x05>e\xad\x19f\x05\x81\x10$\x81\x87q\x90ZG\xe9\\S\xd3:\xc7z\xe0\xb3\x14\x06\xac\xc71\x89\xa7\xb82\x9a4\xaa\x13c\b=Ek\xa1\x1f\xee\xc7Ͱ\xb3N\x1c\xb2;\x99\x10%\tK'\x06~\x18L\xe6\x8d?QY\xae\u07bc\xc1\xed\xc1\x80{wN\xb1\xb5\xba\x86s=\xb8[\x97\bA:\x13\xb4:\t~(\xff\xc0P\x99\xbb\vl\x16vh\xe7\x1a˂\xf2\x1c~\xe0\xd3j\xb7\x89\x93\x04o1\x90`\xb1y5\xf0\xb9/w>\xd6\xff\xf5\x84\fG\x8b\xf7.\x89\x13S\x8c\x92͛\xce\xe2 \xa2\x15'\xfc\x9f\xad\x9dI\x8fd\xd9U\xc7\u007f\xf7\xbe1^\xbc\x982\"22\xb3\xb22\xabk\xeanO\xddm\xab\x8d\xd5ж12\x02\x01\xc2kĆ/\x80\x04\x12\x83d\ty\xc5g`\xc1\x06\x04\v$6H\x8c\x96ec\x8c\x8d\xc1ml\xb0\xdd\xee\xae\x1ekʬ\xcc\xc8Ș\xde<\xdc\xcb⾌\xaa\x82^`ȷKU)\xb2*λ\xe7\x9c{\xfeÉ\x92\x98\xd9jN\xdbs9\xd8\x1e\x81֜\xcfϩ\x9at \x04\x8dM\x9fi\x8b\xb3\xaa\"J\xcc-\xba\xe7\x19\x11\xceyYQ?\xf1\x8f\x16Z#\xb4\xa6հ\x06;\xaeC\xdbq\xb8\xb30\xf6\x1c\n\xc1v\xd82\x14T\xad\xf1m\x8b\xbc\xac\b\x02\x89k۸\xae\x83\xd6-\xfa\xca\U00035910\xcc\xd6\x11EY\xd1\xf3\\\xe2<\xe3\xdbo\xbcɰ\xf3\x90[W\xf6yfo\x97\xad~\u007f\x83\nj4\xad\xda\xc7\xf1\\,mxZH\xb1i\xe7\x95\x11\x13\xa2\xea\x9ax\x15Q\x14%Z@\x1c'\x94J=\xa9Q\u007f[\bqi\x8b]\xec\xaa25\xe3\"\x18B\b<\xd7ų\x1d\xda~\xcb\xecfJc\x1cKre8$MRʪ\u061c\x88\xb29\x1dB\b\xf2\xaa&)k$\x92\x96\xed\xe2X\x8a\xa8\xaaȞH\xb8O\xbeG\xbe\xbe\x98-AV\xd7l\xf9>\xbbA\x8b\x934\xa3\xeb\xb98V\xa3\xb2j\xfa\x99Y\x143\xecu8\x1c\x0f\xb1,#w@\xb7QM\x91\x95B\xb0J\x8c\x9a\xc9k\xbe\xf8E\x14\xf3\xed7\xde\xe0\xad\xfb\x0fxf2\xe1\xf6\xb5C&\xe3\x11\x9e\xef\x91\xc5\t\xae\xe7b\xbb\x0e\x96m ދ\xfa\xa9\x9a\xc1e\x96d\xa4IJ\x92\xa4<x\xf0\x88\xf3ٜi\x9e\x19U\x97\xf9?}\xed\xaf\xbf\xfc\x15ui\x01\x01eT\xb1\xc2L\xf3|\xd7õ\x1d|\xcf'N\x13VI\x84\xef8\xecl\xf5H㸹\xadӘ>\x1a\xb9A\xa54IY\x1a\x1a\x90\x06ۆZ\xd7\xcck\xfd\xd4\xf8S4\x93X\xa1\x14\xb2\xaei\xb9\x06\xf6Mʒe\x9e\xb3\x1d\xb4x~8\xe0\xfc\xe8\x04\xa7\xc1J\xca\xda` {\xbd\x1e\xb34\xa5,+\xba\x9d6h\x8d\xebXh\xe5\xd0\xed\xf6\f\x12\xe8,\rzi\xdb\xc8$k0^M\xa55\xb38\xe2\xfc\x9d\x88;w\xefse<\xe2p\xff\n\xdb[}Z\xbe\x87\xe3\xd8X\xcd\xc4\xf7\xc2\xe3\xe4\xa2\xe5/ˊ\xf5*\xe2\xe1\xd1#N\x8eO9J\x13\x96u\x89\xff\xd8\xc6\xe4/\x0f\x0e\x0e\xb9\xbc\x80\xe8\xcdp\x01\xcfvh\xb9^\xb3\xd6I\xb3\x88\x96\xb8\x8e\xc3ΠG\x96$TM0\f\x86-Q@T\x96\x14\xb5\xa2*+\x13\f\xc7b\xad\xea\xff1\xf6\x14\xbaISu\x85\xd0\x1a\xab\xb9\x9dk`U\x96\xb4\n\x9b\xa8(\x19\a-^\x18o\xf1\xde:6\xfb\xa3\x1a~\xb0m[\x8c\xbb\x1d\x8e\xe7\v\xda\xed\xd6\xc6\x04\xd3umJ\xadp<\x8f\xc9h\x88m۬\xe2\x04\xdfs9\x99/\xb1\x84 \xab+\xb3\xbfPHr]\xf1\xfa\xf1C\xde>>\xa6e\xd9t\x83\x16\x93\xad\x01\x9d 0\xb4\"\xc7n즌\xa3j\x96\xe7\xacV\x11i\x92q\xaa\x14\xef6\xba{\xc3\xeeg\x01|\xa7\xd5ԷK:!\xa2\xc9\xc1\x16a\x10\x90\x159\x83\xb0\xc7t>\xc3q\x1c&\xfd\x9eY5T\x19\xb8V7imUW$\r\x9fW\x96\x15Z\v*)(.\x1c[\x9e\xac\x15\xb5B^@\xa5\x82\r\xdbܔ\a\xf3\x06/\xf3\x82\xfb\xeb\x88ۃ>\a\xdd\x10\r<\x8cS\xf2f\xc9W\xadj\xc2v\xc0\xf1<\xe5l\xb9fo\xe4`k\xa7)\xba\xd6\x06\xe3\xd8\xeaw\x91\x96\xc4sM\xab>[,\xc9ʒ\xb84L\xfc\xa26\xa9,\xaa*\xa2\xaab\xb6\xcc8Y\xaf\t,\x1bϲp-\x1b\xbb1\x95\xd1@\xad4I]r7\x89\xa03\xd8\\\x06\x9b\x82\xfe\xa6\xed8k.\xf1\xb1/h\x9f\x9dV\x9b</\xf0]\x8f(M\x10R0\b\xdb\bԆ\xd1.\x84\xf9\xc2獓(J#\xaa\x1a-$Z\x82n\xb6Ԉ\x06J\x15Jm~\x9et\x02F\xed6\xb5\xaaY\xa5\x19\xe7YNY\x1b\x1c\xdd\xd8wۤU\xc5\xdd՚\x83n\x87\xfdN\x1bKHNs\xe3\xffn\x80+ŕA߸L繙\x1cH\xaba0\xda\x14(\x94\x12\xf4\xc3\xcefc\xa7\xef\xb9\xcc\x16KD\"H\xf2\x1cii\x9c\xe6\"X*E\\Vduμ6\xac\xfc\xbd\xbd\x03~t\xe7\xc7\xd4\x02\xca'\xa4\x14RZ\x8c14\xa2\x8b\x11?\xf0\xa3\xdf\xfd\xe2\x1fp\xa9\x01\xb9\xc04\x1c\xdbb\x15\xa5\xe6\x94$\x11\xaem\x11x\x0e\xd1z\xb5\x91\x14,\xeb\x8a\xe4\x82ծM0\xcc\x1fj\x90\x16\xb6R\xc8'pm\xb4\xe6\x93\xd7\x0fyvo\x82\xaa*\xde?\x99\xb2\x88b\\\xcbb\xe4\xfb\x1c\xf6B\x0e\x86\x03ڭ\x16eQ\xb0\x8a\"\xceҜe\x9e3l\xf9\xec\x86f\xd9J\x94\xe5\xf4*c͡\x84\"\xf4<\xa42\xa8\xa1c\x99\xfa\xa7\x85h\xb8Wf\x80\x18\x06\x81\xa1~\n\x89=4w\x8e\xbc\xaaе\xe1\x90\x05\xb6EV\x01\xb6źlR\x9a\xe3PԊX\xd5HiN\x81l\xf0y\xc76c\xfd\xb2*\x1a[&\x01\xf0&\x97\xfcتV\x84~\xc0\xf9jɨ?0\x94L\x01\xbdv@\x9a\x18\x97\x1e!\x04\xf3\xba2\xe9Hc\xd8{M0\xb4\x14(\xcbBhm\x82\xf1\xc4\xf8\xf9\xd7?\xfd\n\xb7v\xb7\xf9\x97\xd7\xef\xf0\xed;oSU5\xd7{!\xb7\xfb\x1d\xf6\xb6\x06<{\xe3:\xba\xae9:\x9b\xf1`6\xe7\xfebE˶8K2\x1c)\xd9\t\x03\\\xcb\".J\xa6\xf39\xad\xc0G\b\xbb\xe1M\xd5fU^c\xe5Q+\xa3a\xb4\x9a\x94\xa8\xea\x9an'$\x8a\x13\x10\xb07\x1e\xe1y.\xef?:1f\x95\x1aZ\xb6\x85Bcצ1\tZm\xd2<3&\x01f\xbb\xc3f\x9c\xee:.J\xd5(Um\xb0y\xe0\x9dK\x0fH\xe8\xb7H\xf2\fۖ\xb4<\x8f(Y#\xa5\xc0B\x915\x8e\v\x91\xaa\x1f\a\xa3\xaa\x91U\x8d\x96\x86P\xa6-\vK),\xadq\xa5$\xf0\\\xb2\xb2\xe4\x95go\xf3\xab\xaf|\x92?\xfd\xca\xd7Y\x9dMy\xf5ʄ+\x83.\xbbݐ\\H>\xfa\xd2'\x98/\xe6\xfc\xc9?|\x95i\x14q\xb5\x1b\xf2\xca\xfe\x84\xc0u\x98'\x19\xff~<e\x99缴3\xa2\xed\xd8t\xaa\x8ax\xbe\xa0\nCsOr\f\x1aX\xd7\x15\xa2ٓ+\x85\xdc\xf0Ą0_h'lc\xe7F_\xbe3\xdc²-\xdez\xf0\x10\xd1\x00U\xbee\x91J3C\xf3\xfd\x16\xa7\x8b\xa5\xc1W\x1a\x81j\xa5\x94\x81\a\x1co\xb3'\xf7\x89\xe6\xfd\xfdK\x0f\x88㹔\xaad\xd4\xe9S\xd5\x05\xf3,f\xbf\xd7'\xcb\xcc\xc2\xde\x1aH/`\xcfJm\x82!\x9a\nm\xd75\x1d\xd7\xe1g>\xf4\x1c\xc3nȝ\xfbG|\xa4\xd7\xe2\xd5O\xfd\x14\x8b$\xa5>\x9f\xf2\xcb\x1f\xb9E\xe8{(\rYYr\xf3C\x1f%\xces\xfe诿LR\xe4||g\xc4A/\xc4wl\xb4Ҹa\xc0\xa7\xf6'\x1c\xaf\x13\xbe\xfc\xee\x03>{m\x8fI\xa7\xcd:+\x88\x96\vfy\x0eB\x10\xf8ކ\xa9(\x84h.v\x8d=\x9f\x10؎\x8dP\x8a@\b\x1c\xdb\x04e\xab\xd3\xe1\xea\xf66G\xe7\xe7\xa4y\x8e݈Q\x01<\xd7c\x19\xaf\r\xc5T`DC\r+Ҷ\x1d\xa2xu\x81\x0e^\xdcЏ.= \xa5\xae\xd8\nC\xa4\x10\x9c\xc5+Z\xb6\x03\xcd\xd4U\x00\x892,\x10Q+\xac\xaaF\v\x81l\x98\x82\xb2\xf9;\xbf\xfd\x85_\xe2\xe1\xd99N\xbc\xe0\v7\xaf\xf0\xf6\xe9\x8c\xd3uL\xae\xd6|\xff\xd1\x19?<=Ǳ$\x1a\xb86\x1e\xf3\xfb\xaf~\x86?\xfa\xab\xbfc\x1aE\xf4[\x1e/^ݥ\xeb\xb9L\x97+l\xd7ƪ*B\xe5r\xd8\xebs\xe0x\xa4J1i9H+F\xc7)e\x913}pDUU\xeco\x8f\xf0=w#\xc16\x81a\xe3,*\xa5\x04kcx@\x9c\xe7\f\xbb]ʪb\x9d\xa4\xac\x92x3,\xf4\\\x97\xb6\xdf\"ʢ\r\xd7KJ\x89\xef\x05\xd4UE^$\xff\x9d\xb1\xe3\\z@\xb6\xda!\xb6\x94\x9c\xa5k2U\xb1\xe3\ad\x85\x912\xd7hҺn\x82Q\xa1/\xdaY)\x9e*\u07bec\xb3\x88b\xac(\xe6\xdb\xef\xdec\x95\x97D^\x9b\x96\xef3ϊ\x8du\xa4\x06v\x87\x8a\xe5j\xc5{\x0f\x8f\x8cadU\xf3\xc7\xdf\xf9O>s\xfd\x80\xcfݺF۵\xb9\xbfLP\x96G\x11\xa7|\xff\x9d\xf7IҔG[=\xc6-\x97\x9b\xc3\x1e\xf3u\x82\xccrғS\xee\xa6);\xbb\x13z\x9d\xf0b \xd5tE\xcdolj\xc0E\xb0\x1cˢ\xb6\x94\x19\x96\x16EC3\xd2\xc8Ɖ\xe2\xead\x877\xee\xbeMV\x9a\xe2m\\\xeeZD\xe9\xea\x83(T\x87\xc0\xbdK\r\x88\x14\x82i\xb2\"\xab+B\xdb\xc6Қ\\+l\x01\xf3\xb22\x10je\x96\x96\b!\x1e\x13\xb9\x84\xe0 \f\xf8\xb5O|\x84\xa3{\xf7\x88Ҕ\u007f\u007f\xe7\x9e\x19\x83T5\u007f\xff\xda\xf7\x1b\x92\x01\x9b\x94\xa0\xb5\xe6G\xf7\x1e\xf0\xee\xfd\x87\xd8\r[\x04\xc7\x06\x04_\u007f\xf7\x1e\xdfx\xef\x1e_\xf8\xf8\x8b<{\xfb6\xdf\xfa\xc7o\xe2\x17)/\xf6B\xea\x8eK\xa5\x14\xb3\xf9\x82U\x92\xf2\xf9\xdb\xd7PJ\xf1\xc6єl\x1dq?\x8aIv&\x8c\xb7GfƵ\xb9\xbe\n\x84%L\xa3\xa1\x9b\xe5\xf5\xb6\rEi\xb8\xc8E\x81\x02\xb2Z\x11v\xfa\xa8<5{N\xbc\x80\xac,A+</\xa0\xaaK\xaa\xea\x03\xad\xaf\x0e\x81o\\j@\x1e\xc5K\xea\xc6\t!\xb0l\x8a\xba\xc6\x12\x10U\x95!,\x97\xb5\x19\xb2Y\x12KkTs\xa9\xfb\xc4Έ\xcf\xdf~\x86\xaf\xbd\xf5\x1e\xa7똤\xd6d\xe5c\xdc\xddpe5vS\x1c\x01,!\xc8+\xc5W_\xfb\x1e\xba,(kCD\x93R6\xad\xb5dre\x9f?\xfb\xda?\x93\xaf\x16\xfc\xe6\xe7\u007f\x9a4\xcby\xeft\xc6\"I\xe9\xfb\x1eˢ\xe4Ͽ\xfb:?{\xf3\x80W\x9f\xbb\xc6;\x8f\xceyoz\xce\xec\xe8\x98\xc5ٌ\xd1\xce6[Á\xe1\x885\x85\xdbvl\x84\xa8\x8d\xa5G\xd3\xc6\xd6JQ#\x98&\x19\x96\xb4\xb8\xd6\r9tkV\xb5\"\x1em\x13\xa51\x15\x15\xbe\xdbb\x1dϟ\xfa\xd2\xd4cs\xdf\x17\x81?\xbb̀\b\xb7\xe7k\x1a\r\xf9\xc8\xf1\f̪\x14˲B\x96\xe6\x96l\xea\x866B\x17!\xe886}ϥ\xc0\xa1\xd2\x1aaI|?\xd8\b`\x84\x10\xa4iL\x9a\xac\x1a\x1f\xf5\xfa)\t\x99gI\x86A\x8b\xe3(!\xf0l\x02\xd7\xf0\xa6^\xb8~\x9d\x9d\xe1\x16\u007f\xf1\x8f\xdf`\xd0\xf6\t]\x97\xdf\xfa\xecOQ\xe4\x05w\x1eMY\xe7\x05\xa1\xeb\xb0.*~0\x9d\xb3\xdf\r\xf9\xc5\x0fߠe\xdb\xfc\xe7\xfdG̣\xc4l\xe2\xb4m\x06\xe3![\xa3-\\\xd73\x02\x9e\xb2b\xb9\x8e\x89Ӕ\xf3(\xe6\x9d\xd3)\xf7f焎\xcb3\xfd.\xa3\x96\x83\x14\x82\xad\xa0\xc57\xcf\v\xee\x1c\xdfGHCm*˧\xad\x13\xa5\x90f\x0f\x8a\xd6_\x8f\xd3\xe8g\x8fN\x17ZJy\xb9\x01\x19:.\xb6\x86\xac\xae\x88\xea\x1a\xdd\xf0\xb1.R\x8e\x94\x82\xfd\xdd\x03\xc6\xfd-\x86\xdd-ƃ1m?\xe0\xc1\xd9#\xa24\xa6\xaeK\xa4\xb4\x1a\x89\x9bqyS\xb5Yw\x94e\t\xab\xc59JU\xa6s\xae̩\xbb\xc8\xd1ð\x85\x10\x82\x9f{\xf1c\xfc\xf3\x1bo3\x9d\xcf\xe9\xb6\f~\xdfrl~\xf3\xd5Or\xbeZ\xf3\xd6ɬq\x83\xb0\xa8\xb4\xe6GgK\xf2\xaa\xe6W>t\x9d\x17\xf6',Ӝ\xb7\x1e͘\xae\"\xcaZ\x81\x10\xf8\x9d\x0eA\xd8F\x01\xef\x9eL\xb9;\x9b\xb1LR\xf3\x02\xb6Z\x8c\x02\x9f\x81go\xb4&\xa1\xebr7\xa9\xf8\xb7\x93\x19EY\xfe\xf7B\xbei{\xbba\x0f\xa0\x8e\xd3\xe8ӯ}\xff\xf5o\xed\xef\xef_^@ZR2\xb0]\xd6e\xc1\xaa\xaa\x90U\x8dT\xca\bh\xb4f2\xdc\xe6\xb9\xeb\xcf\xd1m\x1b\xcen/\xec\xf1\xc2\xed\x0f\xf3\xe0\xd1\x11\xabxM\x94%DIDQ\x95D\xf1\x8a(Y\x91d\x11uU5P\xa9\xd1w\xc7\xf1\x9a$Z7\xa3\xed\v\xff[A\xe89\x04\x9e\xcb'o\xdf\xe2o\xbe\xfb\x1f\xf8\x8eM\xe8;\x9b\xee't]~\xe3\xe5\x8fr\xbe\x8e\xb8;[\xe2X\x12ےTJ\xf3\xda\xf1\x8cZknn\xf5\xf8\x85\xe7\x9fa\xa7\xd7\xe1l\x1d\xf3\xee\xe99\xb3uJ\xa1\xcc\r\xbfjffJk$\xc2H\x0e\x9a\x17Mp\xa1\x9e\x82A\xab\xc5\x0f\xcec^_\xa6,\xd6k\x94ʐ\x1f\x80=uڽ\xc6\xe3=\xff\x9b\xbcH\u007f\xe5d\x16\xe9\xcb\x00\xa9>s\x942\x00\x00\x06EIDAT,˷\xbf4\xb0\x1d*U\xb3(Ks\xe3\xae\x15\x9d\xa0Õ\xf1\x0e\x1f\xbe\xf5an_\xbbE\xa7ݡ\x13tx\xfe\x99ۼ\xf2\xc2\xcb\x1c\xee\xee\x9b\r\x9d\xeb\x15\x02#}6\xfb=|<\xb7E\xa7\xdd\xc7q=\xa3`-\x8cx\xd2q\x1c<\xdfGZ\xd6\xc6\xe3\xdd\xd0r\x8cm\xf7v\xaf\xcb{\xa7gؖ\xc4m\x8c\x90\x05f\xe6\xf4\xc3\xe33^\xda\xdf1\xda\xf6\xaa\xa6V\x1aGJ<Kp\x1c\xa5,\xf3\x82\xff8\x9a\x92\xe69\xe3v\xc0\xa4\x172\xea\x04x\x8em\x98\x90\x02c?.%\xae%\xb1\xa4\xd8@\x0e\xba\t\x86cI,\xcb\xe6\xb5\xe9\xda@ЖD+\x01\x1f\xb0\xc3\u07b6\x8d\xdcM\ny\xab(\x8b\x1f~\xfa\xb3?\xf7\xe3\xcb8%bg\xab\xa3\xa5\x10\x9c\xe5\x19ZC\xd7v9ܽ\xca\xcehB\xb7m<<\xeaZQ\xd6f\xaf\xf8*Y\xb3\x88V\xe8F\x80\xf6\xf4\xc5u\xb3R\x00)$\xb6e\xe3X\x16\xb6\x10diL\x14\xcf\x1bS2\xa3\xf5H\xa2\x984\x8e\x91MZ\xbc1\x19q\xe7d\x8ak[\x84\xbe\xbbI\x97\x8d:\x99q;\xe0\xe7o\x1d2]\xad\xc9+3\x93\xb2-ɛgK\x8e\xe3\fײ\xa8\x94b\xab\xe5\xf1\xb1\xdd\x117G\x03\x02\xc7&/+\xa6q\xc2:+(\xea\xdap\x83\x9f`4J!\xf0\x1d\x9b\xb6\xeb\xf2\x95\xf7O\x98\xe6%\x05f\x9c\xe2\xb9\x1e\x9e\xe3q:;\xa6\xaa\x1fwZ\x9e\xeb\xe3{f\xec\x9e\x17\xf9\x8f\xb3<y\xe9\xdd{'\xf9\xff\x17[\x17\xc3A[\xaf\xca\x12KZ\xdc\x18ﱿ\xbdG\xbf\xd3Ǳ\x1d\xf2\xb2`\x1e\xad\x98\xc7K\xaa\xb2h,\xb83\xea\"C_x)Z\x96\xa1\xf6(\x85\xb0l\x1c\xbf\x8d뷌$Y\x9a\xcd\x1dZ\b<\xdbŕ\x16y\x16\x11'\v\x13P!(\x8a\x82d\xb9ll\xbc\r\xf0\xe5X\x92\xd0w\xb1\xa4x* \x00/\xedM8\xec\x06\xa4EI\xd24\x1dB\b\xbe\xf7hFZ\xeb\r[\xe5\xe2yf\xd0\xe1\xc6V\x8fa\xd0µ̀\xb1Rz\x83\x10\xd4J\x93W5gIʛg\v\xce\n\xc5\xf1z\x89\xeb\xb5\xc9˂^\xa7OUW\f{C\xa6\xe7'\xa4\xd9zS\xd8\xc3vw\xc3\xe5M\xd2\xf8\x8b\xd7o=\xf7\x87\xdf\xf8\xe6\xbf\xf0\xff)\xf0\xc2i\xbb\xba\xdfj\xf3\xfc\xe1M\xc6\xfd\x11\x9e\xeb\x91\xe6\x19\xb3՜Elv\x99\x97\xc9\x1aʜq\xa7è\x1b\xd2\xf6<\xbc\vvy#\xd8,\x8aҴ\x92Z\x93\xe69'\xab\x88U\r\xb6\xdf\xc6o\x05H\xcbF\ta\x9c~\x84 Z\xcf(\xca\f!\x8d\x80&^.\xc9s3֗\u0090\x19lK\x1a\u0378|\f\xad\"\xe0\x17\xae\x1f\xd0\xf3\x1c\x8e\xa3d\x93\xf6\x94\xd6\xfc\xeb\xc3)\xa2\x19\n~\xd0\xe3Y\x16\x9em5r8\xa3u\xa9\x94&\xab\x1e\xa7Ϩ(8\x8e\x13j4\xba\xd2\xf8\xad6A\x10\x90\x979;\xa3]V\xebŦ\rn\a\x1dl\xa3\xa3\xa3\xac\xca$I\xa3\x8f\xff헿\xfe\xe6\xcb/\xbf\xfc\u007f\x0f\xc8\xc1ޮ\xfe\xc8\xf5g\xe9\x85=\x94R\x9c.g\x9c-\xe7\x14Y\x8cJ\"\x84\xaa\xd8\x1b\xf49\x1c\r\xe9\x85m|\xdf\xc3u]:\xed\x80^\xbbM\xdb\xf7\x1b\tAc\xd8R\x94\xa4y\xce:NxpzƏ\xef?\xe0h\xb1\xc2\x0e\xba\xb4;=\x1c\xd7E\v\v\xcfq\xa8\xf3\x84$]n.\x8d\xf1:\"\x89\"\xb3sʱ\t<\x97\xaa2#v[\xcaM\xeb\xdcu\x1d~\xe9\xe6!y]\xf3h\x1d7\xe6\x00\xe6\xed\xff\xd6\xfd\x13c\xf0\xff\xbf,\xb0V#\xe2\xd1Z\x93\x95\x15Q^0\xcf\n\xb6\xb7B\x86݀\xb7\x1fN\xc1jQ\xd49B\b&\xa3\x1d\xa2dE\x9e'8\xb6K\xd0\xda\xd0II\xb3䟊\xb2\xf8\xdc\xf7~\xf0f\xfd\u007f\xad'\xd6g>\xfe\xa9/u\xda]Ζ\xe7ܝ>d\xb9\x9aS\xae\xe7l{\x167\xb7\x87<\u007f\xf5\n\xcf\xec\xed\xb0=\x1e1\x1a\x0e\xb9\xba\xbbÍ\xab{\xdcڿµɘ\xedA\x8fQ\xaf˸\xdfc<\xe8se<\xe2`\xb2\xcd\xdeh\xc8\xd5ɘ\x83\xc96\xd45\xcbŜ\xf3\xf3)eY\xe18\x8e\xc9ᖃ\x16\x16\xba6c\n\xc73kT\xab\xc2\b\x85\x84\x10\xd8R\xb2\xcerZ\xae\xb3)Uy\xad\xc8ʊ[[}\xa2\xb2d\x95\x9b\xd3\xd9v\x1c\xfa\xbe\xc7[\xb3\x85\xc1\xd5\xff7AiX-\xab,'-*\x1cK\x12\xe5\x05eU\xb3\xbf\xdd\xe7\xc6\xfe>ݰ\xcfl\xbe\"\xcfs\x92\"e\xd0\x1d\"\xa5\xf1\x86t\x1cw\xc3e\xb3-\xebP)uT)\xfd\xddW^\xf9i\xe3\xd9\xf5\x93\x06\xe4c\xb7?\xf4\xa5\xfbgG\x9c-g\xa4\x8bsB]\xf2\xdc\xee\x98\xeb\x93\t\x93\xe1\x16\xa3\xe1\x16\xe3\xc96W\xb6\xb7\xb9\xb2=\xa4\x1f\x86\f\xdam\x02\xd7~J\x15+\x1b\xfa\x90l\xc8Ѿ\xe7\x12\x06-\x02\xdfc2\x184\x1e\"\x15Y\x16s>\x9b\"\x84qS\x90\x96\x8d\x96\x0e\xba6\xd4X\xc7u\xcc}\xa60M\x84%%iQ5b\xa0\xc7\xe9\xe8<\xcb\tl\x9b\x1b\x83.\xf34\xa7P5E\xad\x18\xb4|l!xk\xb6h\xf6\x87\x98\x95\xae\x9aǄ\x8b'O\x8f֚UVP6`W\xc7sp\xa5aQ\x02\xecm\x8f\xd9\x1d\xefr\xe3\xea!B:\x9c\xcdf\xc4iD\xbf\xb7\x85\x94\x16y3n\xb9\xf8\\˲?\xf7\x9d\u007f\xfb\xd6W';W\x1e\xbc\xf4\xd2K?y@\xfc\xc0\xfb\xd2z\xbd\xa0X̸\xda\xef\xf0\xec\xee\x84Q\xbfO\xaf\xd7e<\x1e1\xdaަ\x1f\x86\xf4\x02Ñrm\v\xc76)\xe4\xe9\x05r\x8f\x9f\x8b6VJI\xe0{\xf8\x9e\x81Z/\xd6BXR2_\x9c\x93\xc4\x11^`6i\x1a:P\xdd`\x02F\x0f\xa8\x9a\r\xd3e\xa3\x15\xf1\x1c\xeb\xa9\xddO\x0f\xa2\x98\xfd\xb0\xcdN\x18p\x96dTM\r\xdb\x0e\x03\xd6Y\xcei\x9c\x92\x97%yU\x91\x95%ii>\xcfn\xba\xb1\xbc\xf9\xdc\xc3A\x17\xa54=ߥ\xe7\x1b\xbd\xc7I\x9cQ\x145\x81'hy.\xed\xa0õ\xbd\x03\x82Vȣ\xd3S\xa24bk0\"\xc9\xe2\xe63틠8R\xca/\xfc\xdd\xdf\xfeտ\xfe\xce\xef}\xf1\xeeO\x1a\x90\xff\x02\xc1\x8f=\xb9\xa7\x9a+\x99\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_02_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x001\xf8zTXtRaw profile type exif\x00\x00xڭ\x9ci\x92$7r\x85\xff\xe3\x14s\x04\x00\x8e\xf58X\xcdt\x03\x1d_\xdfCVU\x93M\x9aH\x99\x89=3ũΌ\b\x00\xeeoq8\u009d\xff\xfe\xaf\xeb\xfe\xf3\x9f\xff\x84\x10{t)\xd7Vz)\x9e\u007fRO=\x0e\xfe\xa5\xf9_\xff\x9c\xaf\x9f\x9f\xdf\x05\x9f\xde\xff~\xff\x13\xbe\xfe7\xb8\xbf\xfd\x8b\xc8O\xe3\xa7}~Y\xd3\xd7\xdf\xda\xd7\xef\xbf?_~~r\xa1\xbf\xf9\x8b\x90\u007f\xfb\x82\xfd\xdc&\xfe\xf1\xc6u|\xfd>\xfa\xf8\xa7'\xea+N\xff\xc7\u007fگ\xff\u07bb۽\xe73\xba\x91\n\xd3P>\x83\xfa\xdc\xc2}_\x86\x0fr\x91d\xefk\x85?\x95\xfff\xfe\xbd\xbe?\x9d?\xcd\x0f\xbfB\xf2\xdb/?\xf9\xb3B\x0f1\x98\xbf!\x85\xed\xc2\b7\x9c\xb0\xf9\xb9\xc2\xe2\x19S<\xb1\xf23\xc6\x15\xed\xfd\xaeYeY\x96y\v\x96\xf4'\xdcX\xad۶f\xd1V<f\x96\x9cşg\t\xef\xbe\xfd\xddo\x85Ɲw\xe0\xa31p\xb1\xc0W\xfe\xd7?\xee\x9f>\xf0o\xfeܻ<s\x14\x02\xa3\x0f_s\xc5sŨu\b\x9aF\xd3\xff\xf21\x16$ܯu\xcbo\x82\xbf\xff\xfc\xfc\xe3\xfe\xb0\xb0\xc6\n\xe67͍\x01\x0e??\x97\x989\xfc\x8a-{\x01`|.\xf3\xf3\x13_\xa1n\xadZ|Q\x92\xb8w\xe6a\x82\xb1\x04\xbe\x04ˡ\x04_c\xac!$\x8b\x8d\x05\x1a<y\xb4\x14'+\x10r\x8e\x9b\x87\x8cɬ\xb06\x8d8\xe2\xde|\xa7\x86\xf7٘\xe3\xe7\xf7\xa4\n듭Xem\xba\r\x16+\xa5L\xfc\xd4Ԉ\xa1\x91-\xa7\x9cs\xc95\xb7\xdc\xf3p\xc5J*\xb9\x94R\x8brnT\xab\xa9\xe6Zj\xad\xad\xf6:\x9a\xb5\xd4r+\xad\xb6\xd6z\x1b=v#%s/\xbd\xf6\xd6{\x1f\x83{\x8e\xe4F\x1e|{\xf0\x891f\x9c6\xd3̳\xcc:\xdb\xecs,\xc2g\xa5\x95WYu\xb5\xd5\xd7\xd8q\xdbN;\xef\xb2\xebn\xbb\xefq\xc2!\x94\xdcI'\x9fr\xeai\xa7\x9fq\x89\xb5k7\xdd|˭\xb7\xdd~\xc7Ϫ}\xad\xea_\xfe\xfc\x1fV-|\xadZ|+\xa5\xcf՟U㷵\xeaB\xef\x12A8\x93\xb5f\xacXL\x81\x15\xafZ\x01\x02:j\xcd|\v)E\xad\x9c\xd6\xcc\xf7HV\xe4\xc8Cf\xad\xcd\x0e~\xb8PX\xc2tB\xcc7\xfc\xacݯ\x95\xfb\xd7\xeb\xe6\x98\xeb\u007fZ\xb7\xf8oV\xcei\xe9\xfe\x1fV.\xbac\xbf\xad\xdb߬\xda\x16\x12\xae\xb7b\x9f,Ԝz#\xfb\xf8̈\x8d\xff0\xd9#\xf6\x19\xad\xe8ʓ\x9c\xb1|R>q߶n\xa9'\xf4\x1bl\x9fҮ\xc52|I\xb3\xeeޕG\xac\xc3]\xab\x8d\xbdB\\\xfe\xba\v@\x03\xbew\x87|\xfc\xbe\xca\xf1\xed\xe7\xa8\xf5\xaek;\xd7\xf7\x1b\xa1\x1f?O\x1cM?\x99\xad\xbcm\xdeS\x19\xc2|\xbf\xf1n{\xfd\v\x97K>\x9ckb'\xe3\"$\xe7\xe9\\\xd7\xd6\xcdY\x9fX\x9e\xb9\xbe\xb7\x8da\xf7p\xe1\x9a\xde\xf7\xfa\xa9c\xe8\xdf\xdc\xf1\xb6\x8c\xc8\xcbz\xb0\xb9~\x1e\xec=\xd6\xfd\xf5X\xc01\xd8\x1f\xc7ا\xcf\x1b\u0588;\x0f&\x88\xa5\t\xd3vq\xa5v8A\b\xc0b\xb1\xb6,\x8b\xb7\x98<\xa1\x14zd\x16mO\xd6\xf3\x94\x9b\xf2\x9c\xbb\xcc\x1c\x1b\x1fm=\xa5Y\x8e\x82\x82\xff\xd7[r\xa9\\}\xb7\xb6Ug=dr\xddy\xae|\xb9r\xcb~o\x00\xfd\xe62c&\x122\xb7i\xb7\f\xe8\x01\x1e0B\x9fLɉL\x88\x8b\v\x01{\x93\x95\xf2\x96X\xb6\xb67\x8f\xbb\xa6&\xa2\x9e\xda2S\xda\xc2\x1dK\xf3\xb0oo\xccm;\x99Y&\xcen\xe8s\xa5kg\x04s\xf3\xb4;z\"l\xca ڍL\x9b\x05.a\x81\xd2]\xc3\xf3\xdf\xca`\xf7\xe1G\x0f\x85\x8f\xf6\x9b\xec\x90\x01q\x1er\xe3\xb69\x89\xc5\xe2N\x1f\x9be:\x9ed|\xabC:-;qV2\u007f\xb1~\xac\xf5[z>\xa0EI9V0\x99\xe5m\x85`(z\x1c]\xd3\xe5\x17;-\x8f}\x89V\xbe\xb8\xaa\x11\xda\xd3\xdeb\xef]\xd3\xd6z\xb5\xb5\xeb-<C\xd8u\x14ƾ\x04Q\x00J(\xe4\xfe\xba\xcb\xed\xae\v\x15ҡ\xb6A4\x91\x95\x84\xc9\x18\xa7U}-\x9f\x9aG\xbe\xab\xf4\xeb'ë\xa9\xfb\xd0\xce\x1d\x84\x87\x8dQ\xeed\t\x00\x89\xec\xf6\xd22\x13\xc8\xf07wM|\x86\x87\"\x9d\x13s\xe7\xcb\xd8L\\\x89>\xaf5f\xd9q\xa4% Y\xc3ڂ\xaa\xc1\x85\x9b\xc7H\xdb`ڞ\"\x0f\xbc\xc3܄u\xb73\x95^5\xfbK\xbc\x03)w\xb0\xfa\x03\xb9`3\xf6>\xcf\";\xb9Bd\x14\x95_6\xee\xb8*\x019}\xbb\xcc\xe9\fo\xaa#\x19\x91\xc4\xc0\xb5\xbd,##\x100\xe7L\xa5\x0e\xf0\xa3߅]\xf8\x14@\xdc\xce.\x96vj\x84Ou>\x9f}\x00\xdb\xe6\t\x01\xc5\xc0-B\x8d\x87\r\xff\xe2gI\xfb\x12Ɖ\xecW\xf6\xc5\x01\xf4ر\x97\xc0\xab\xe7D*ܳy\xaa\x03&\x1e\xc6c$\xd8N\xc4\xd09\xfc\x97<\x0e\xa7\xae\xf2FA\xc8\x11B\xaeJԀ]!\x84\xcd\xf2{&*\x10\xe6>\xa4\t\x98\n?O\x03\a\xc7\xce \x02\x00N@\x87\xea\xcfhy\xb5\x16C\x91\xfe\x82\x1a\xb6\xf3u\xeb{\xb9\x82\xafa\xcey;\xa8\n|\x93\xd1+\xa1\xdd\bM\xbf\n\xdf\ng\xf2\xf9\xd9C&\xca.*\x00\xd0\xe3\x1e\xc7\xf7\rE\x90k\x04MbJ\xcf\t\xad\xa4\xb8\xb6\x9d\xd3P\x1b\xf9\xd6\x06\x97\xdc&\x19\xc74\xde$\xa0\"\xc4X\x06[>\x97\x1e\x89\xe9\x13\xc3N\xc4\xf2Z\xae\x13!\xbe\xf3\xb8e\x11\xa3գ6\x90%\xa0)\x93\xbf\xb4\xb0\xa0\xe7 ,\x96\xf0\x01n\x06GNl\x8d'ݑ\x05g\xf6\x90.\xe8M\xe7\xd7H\xb3\x0129\xebo\x9b\xbfF|\x02B\x02\x83\tS\xc2$\xb6\x99\x14\xe3FJ\xf7\xcc\x18f\xaa\xc2\xf3Շ\x18\xa7\xf2\xa5ŪY\x98\v`a\x12'\xa4\x18\xda^\r\xe5J6\x90\xa8}\x1d&\x1a༙\xb9\x1c\x97L\x0e\xa3\xaf\xc9\\\f\xab\xe1\x16r\x9f\xf8=%\xb9\x86h\xaa\xc6}b;H_-\xddٙ\xe0#F\x8f\xa1\xad\x1b\v\xc1\x9aG\xa2w\xa6\xb8\x81\xa8\x02\x90^\"/\x97\xd3;i\x05Q'\x9e\x88\xdf\xf3\xfc\xbb\x17.\xb9,t#\x87H\x93\xd1;\xa9\x15\n8\r&\b\xafI\xa1\x92Әȃ\xce\xec%\xeeC&\x96=\x0f\x89\x9d\x1c\xa0\t\x1d&[\xfcb\xdd\xc9-\xea\x8e=\xc7l}Y\xe3\x99 \x8e:\x16\xb9\b\xd0*\xe9o\x88\x13\xc4\r\x995<\x95\x81\x8c\v/^W\x9a\xddZ!T\x00\xab\x038P;\xd1\x0f\x9d\xa3.\xc2\xed>U֊Ϣf\b\x11\xd8\xf3Q{\x85\x8f\x02T?!иg*\xcb\x11u\xb7\x83̝T\xc4pՕ\xb9\u007fm\xa2\xb5\x03:\xb0\x80aUP\x1c\x9a'\x83\xa41\xd6\x11\xde\x13٠\x01\u007fa\xe6a\x84\xec\b\xc7\x12\x162\x1f\xe9\x02\x8a\x9d\x8e\x04\x9d\x9eu%\xde@\u007f\xe4\x14\xf3\nd\x8e\x01H\x0e\xb3\xd1A@\xc26mB}\x83l\f\u007f\x016\x0e\xfb\xb7\n0q \xdb,YBprirjo\x96x2\xf7\x11;\xc8sϾ\x81\xa2\x9b\"\x83\xbbz\xe8\xdb\xc8j\x8f\x12\xe1\x9a\vY#\x1e\xb3\x83T9\x9b\xb0\xe1b0!+9H\xc1\xb6֜F(\xadM\x80\x95\x83\xfa`\xe8\x81\xe4\x9a#ot\x0e\x1f\x1e\x8b\xc0\xf2ǍԵ2\x17\xab6\xd1r\x8f\xbc7\xa2\xac\xef\xc8\x1c\x85\x99\xf7\fƪ\xb5\x91x\xd4P@\xc0\xee\xf3\x84\xa2:\xb6\xaa\xad1B\xe4\x01\xa2\xabِ\f\x87\x1cB\xe7\xe6L\xce\x03\xb4\x01W\xd4\x01\xf1\x03\xb1\xf3<\xb8\xb0\xd6\x19UГ$\xb1:\x16\xa1\"\xfaJ\x00r\t̅\xf2\x87\x92\xf6\x1a\b\xaa\xc8x\x81\x97\x8d\xb8\xe8\x0f\xe3\xfcY#\xf4\xa2(\xcf\xfd\xb0l\xcd\xf2\xaa=\x93\x84,\x98qsk%\xfb\x83?\xf26\\M\xa1\x93\xdf\xfe\xccl\xa8&\xe4\xc4G\x81\xfd\xd3OR\x00^h\xdd\xeeތi@\xd9H\xa0&\x12\x05\x88\x19Z~jg\nE\aҘP\xf4(\x91\v\xb9\xc2\x10\b\x9d\x9e+\x8f\xc5\xec\x00\xc3±\xf9\xd4\x13\x01\xc9\x15P\xbd\x18r\x12M +\xb8&\xc9\xf6ވP4x\xb9\x1d\xfd\x83jFj\x94\x8a8\xf4\x89\xe8![k\x1d\x13Dˠ\xaf\x85\xdaݬ\x84\x1e\x14\xc8\xdaN\x96\x11> f\xc0\x80\xc0x\xfbL\xb6\x03\xf1\x06\xcd\xf6\xca툭:*\xc1\x013\x00\x82Ht\xa0\xb7\x18P\xd1\x1d\x8b\xe3\x99\xe9\xc2\xcc1Z\xa6\xb9Kb\xf8&H\x06\x8d\x11\xc7DM߹\x10fk\x9du\x98\xfd]\xc8\x10\xf0\xbb\x98,\x06Z'Xs$\xc2S\x1b<\x01\x99и\b0q\x80\xba\x8e\xae\t\x919\xe1:\xb9\xc3-\xa4\xeen\xac1`B\xa06\x18\x12\xcd\x19\xb8℆\x9a{\f\x0ey\xb2\b%\x1e,\b?\x83\t\x84@\x9cIP!\xb9\x11\xc0\xbdł\xd7\u05ca\xc1\v\x13\xf7I\xee\x91\xfbH\x8ar\x91φ\xce\xd67\x01\xe3U*ᰱ(\r\x1c\x9fa4n\x85\xca\x1cϹ\xec\xd6\x02\xa1~\x81\x06\xb9\xccuSѳ2\x99\x92\xc1k&'\xba\xb2\x8d\xbd\b\x80|(\bю\v@\x1a\x90\xf0\x84\x16\xc1\x81\f\x04\x11\xfaa\xc4\x06\xe5\x93\xda\b\xbe\x89\x8a\x01\x80\xa7\x85\x8c\xf6B\x9a\xc8\x1c\x03\x8aHا\x9bqT\xf0\xf1\xe4\"\xab\x83\xe1\xf0\xf4\xc9]\xa6\n\xb9\x04\xb6\xe3\xd7\xc8\xd6u\xfdc\x83\xc1\xeffa\x05\x10\xb5ۭ\tL$%\x18\x93\x85W\xb9\x06ϲ\xaa\xb8\xad\x82\x10\x99Da\x92l\x1f8\xaa}\xf1\x84\x15?\xd1@\xd4\t\x04\xa7*E\xab\xb8;\x8e\xd0c\xceH\xe2\x879+\x8e\f\xaf\xef\x18\x05\xdc\x1b\x94-\xfc\xa2\xf4\x91!\\\x9e\x8ct?\xd5GD#\xdc\x05v6L\x14\x99F\xa28\xe4\xb7*&Q@\x88\xf8:-\xc6\x19\xeb\x04*\xf8\xf5\xe4\x86,4\x0f!fəQ\xb0r\xc9_\xc0\x90\xa1@\xceȧ\xd0\x12\xcb\xeaȎS\x99K\x84z \xeeb\xab\x1b\xb1\x90!\x15\x8b\v\xba\xf0|\b?\x8au@T\xa0\x9d\f\x15\xa0(\x89R\u007f\x18N\x12\x1d\xe8\x1aH?\xc12Dç\xa0\xf2\xbe\x02k\xcc\x03\x92\x15\xc3\xe3\x02\xf9\xbc\x97<@\xea\xca:\xf0E\\\xd9\x1cؾ\x03z\xc2*\x15\xe5\x1b\x16\"\x82\xaf\x13\xa9\x00[ \x90\x188\xe6\x03\x05\x8c>\xc7\x1b%\xf0\x00&g\x1c\x1e\x90'\x90\xdb\"\xb1`\xbb+\xd2a\"\x84\xb8\xf3L0r\xb8\xfc\nLB*\x10\x9a{\x834\x04\x83\x94\xd0\x14\x05.\xfc6I\x817-\x80XG\x0fl\xd9T\xfc\xb9\xc2S\xa1Ƽ\xb74\xba\xc3\x19\xc6~ѓ\xdcL\xa0*\xf7\xe4gK\x05\x03\x04,\x1aڕ\xc4ǘ\xc0\x15+\xe3\xd2c\x00\xf6\x01\x04\xec\xf8\x8d]~\bA5\xae#\xaa\x12h\f\x1f\xa2\x9a`4d:\x8b\u007f\xc0\t+`sǉ\xdf5+\xb6\x02t\x14\x95\x90\xee\xd0\x15cCl{2\xa8A\x80\xca5\xc2\x03\xf0\xb0\xd1P\x03\x1b^ΰ{í\xeaI\x0e\x81|\x89\xa1\x1b\xb9\x05\f߁-e,n\x19}\x18\x1aL\x92_\xc6\xfaeN\xb9\xfe\xc9\xd8:d<\f\x81_\vv\x90\x85]d\xc0\xecP\t\x86\bh]11\xbf2\xbb[8k\xe5\xf9\xddgMct;\xca\xe4\x9egqI\\<\t\xe9\x8cy\"\xa1\xd1\xc3\xd81\xb9\x96\x82\x95\xf2\x88\xdbq\x0e\xb1ؙ,\x88\x84X_\x02\a$\xabY\a\xb3\x89_\xac\xbf\x1f\xb5\x1cnż!iH\r\xee\x8a\xca6\x84\x92\xea\x98y!\xafM\xd4ٽ\xfc\xda\"\x04Zj\x13\x15\xf7\xa9\r8\x04:\xd3\xc3ӡ\xcb\xf69$\b@T\xa0l&\xb8@\xa1\xaa\x83\xe9\u007f\xc1\xfa\vK2ŀ\xffUu\x00i7+&\x05\xf5OL;Ы[\xd7\xe0\xf2ͭJ\xb3\xc1\xba\x04\x04߄R\f\xf2$\xba7\xfcp4o\xf0\xf7\x9d\x1b\xfaO\x92\xf7\xf9\x97\xbcw\xdf\xff\xf2\xf9i\xf1\xdfp\xd8\x17\x85\xe5K:|Y\x11\r\xedS\n\xf8\x83\x1d\xf9\xab\x19a:1\x9e\x12L\xf8d\x99\x10n\xa3\xe2/\xbaA\xbe\x99|\x1e\x0ez'Fj\xd9\x1b\xe1{\xf2\x13lW\xeba\x04\x96\xcc-\xa8\x06\x1e\rK\xcf7\x95\xb8\xd2b\"s\xf4\x90G\a\xaeA\rB\xb52G\xdc6x\x16\xac4\xf9vf\xb4\x1e\xabpc\x00Q\x9fR\xc2Cb\xe4\xe2\x05\x94\xd2ł\x03\xa5\x05\x94 K\x90NAE\x1f\x9e\x0f\xa1\xe5I,@V\xc8u\r\xdb\x1c@w<\x06ƅL\x05\x82_i\t\xdfx\xf0\x16\x8b<\x9b\xa0\xc18\xaa\xa2\x0ft\x19\xb2\x93HZǑ.%\x8cU\xd2!60\n\x1e\xa9Ʉa\vP\x9d\x11+\x0eQ4X\x86\xe0F\xb6\xa0\xdcv\xf60\b\xa0\xd1\xc5\x15\ai\x80_N\xd1\xe1\x13ȉ\xa1H\xbb\x86\xfcV,\r`n\xa0\x86\xc1\xeb\xa9\xda\x12y\x89.\"\x96qfS\x9a\x06u\x00\xb5\x17\xdc\xf0}%\x06̉\x03\tx\x90\xa9\xea7\x8f\x0eX\x91Q\xa8h\xb0&Iu\xf7\xa1d\x16\x89\xf1\x10\xaa\x96C\x96\xd8p\xe3B\xf7\xd1%!\xaa\x1c=\x0e\xd3\xce\f\xb2\x04A\x054b\x86I$>\xf0#\x926\x1b\xc7S%\x1c\x9b&\u0557<\xf7\xcbʝ\xf1\x06\x821`a\xa3\xa0\xfbr\x90\x8a4\x8ajC\xe0\x1a\xeer\xe2\xf5\ta\xb2\x017\xda#p1\xe3&\r\x910)j\xdf\x00^ \x98\xf24n!o\x9f\x9f=q\x03\xfa\x9cX\xabY=\x0fv=\xb0z@m\x1c\b\n\x88DyA\xcaW\xb8\x1d3\xf5,|\x17\t\x02Z\x05k\x82\x81@\xad\xa2\xac0\xc7\x10\xa5\x8a'\xc4\x11\x99\x83m\xf2K\xd5Cp\xa4lX*0ƃ\x11 \x92A\x05\x8d\x1c\xf0\xf79\xf5\x9a\t\n\xb4\x1bb\t\x17\xdd\x1c\x0f\xc4c4y\x04\x95SPF(\xea\x05\xdf?뮢\x10\bE\n\xe2\x14\xa1UT.\xdaYU\b\x1e\b>am!XnQ\x1c\xc0\x8c\x03\x05\x83*\x97\x80DO\xe53\xc0\x99O\xca\xf6\x84\xd1$\x1e\xf8\x15υ\xbf!\xd8ЊD]\xc1\xfa2O\x9d\xa1W\xed\x80\x00#\xc8fl\x17\x8f\x8c\xf8\x82\xe5/\f\x9bU\xa0\x1e\xc83.\xf3\x9c*AD\x1c\x1b\x11\xbc\x1f\xe6 4҂\x1a4\x16d^\xcee\xba\x02ZB\xe2\xb5\fƖ\xa0\xf5\xec+\x8bN\xbe\xc0\xce8\xbd\xa6\xf2fڷ\xb0\x1a\xe3\xa2\x04\xbaT\x1a)\xf3v\x12\x80s\x84\xf6\xc1\xec\xb9'\x87\xfd\x90V\x02\x0e\b\x9eL\xd0C\x1c~\x81\xd7]6\n\x18\x9a00\x998!h\xa4\f\xb2\x16\xc5\xd3:\xa6(\xc3\xf5\x00\x1f\xe9\xce\x1ce\xa9\r\x9c\x03\xae\xab\xd8 \x9d\x87v\x81\n\xb2\x163%\xb1\x05\\\xa3\xd4\"\xc3lg\x81\x9f\rW\u007f\xf9\x01 \\\xbe\x86\xa3\x19ͣF\xe2\x90\xccDF1z\x96\x8b\x95\tڰ\xc3zf\x11\x89\x845+\xc1_\xd9F\xa0\x83.\xd0^\xdc\fȷ\xa8\xf9\x1b\xe0H\xc6\xf81\xf9b8\xd4\x0e\xfe'\xab\xc0%z\xc9;'|\xe9\x92-\xc5$\x0e\xac\x1d\xeb\xa9R\xd6w\x15\x89\xf0\xad\xb0Nʰ\x19\xa0\xeb@\x8b(J!R\xd0x\x17\x15\xfer),d1JX\xc55\x02\x12\x05F⤳\x10s\x8c!\x96\x84MK*\xbd\xef\xc5\xfa\xe0\x12\x1c\xf2.\x88=;\x0f\x01\xe6#6\x84Ӈ\x94F\x8cBy\xff\xd2\x1cM\xf7c\x8e\x9e5\xe2\xa9秼\xf4Gk\xe4\xfd\x9f\x89e\x02\x12\xf6\x8bX\x1e\xad\xc0\"\xdf\xe6\xe8\xe2\xd6-\x89eQ'\xe0-\x86[\x1bk\xfd\xaa8\xa9L\x9e\xac\x1d\xa8\x14\xae\x84\xd1e\xccD@\\p+\xf3_\x1diIpD\x04\"\xa1\x8e\x19߰T\xad\xac\v\xe2*\x8a%Fɻi\xbfq\xa9\x8a\xa3\xf2\xf0S\xe1\xb7\x05\x04tҦ\a\xdc|\xa6C0#4Ae\xf4\x15ZHbg7! ң#\xaf\x82j/\xaa\t\xf0\xc8CEG4\x17\xe4b\xf0V\x95\x18\xa8\xca_\xc0\xc0\x15\xcf4\x8b\x93\xdaI\r\xdb\n\xf7#֛L\xaf\x02\xc5\x10M\v\x05\x15\x80'\bT5G.\x99#XR\xfa2L?qDrx\xa7\"\x13\x8bQ\nHLƩ\xaa\xbd\xb5\x0f\xb0\xa2\x91\xaf\b~;\xd9МL)\xf8r\xab\xd29\x82\x96\xb9\xa9\xb6P\xd1\xff@M\xd7\x16\xb4g\x84\x1bP\xf1Qe;p\xb3\xe4\xb8\bg\xee\n\xfd\x80M\x98m\\\x10Z\x8a\x876\xf8l\xdd\x02\xf1\xedbVe\xbf\n\xf0\x84\xedq1_\U0008b150\xd9`\x84`\x18\xe8\t\x1c\xe1\xf8\xa1*\x98*W\xe8\xc4\xf7\x8d|\x01\xba\x90\xbaL\x1e\xe8\xce\xe3m\xa4&\xd2\x06\xa8\x9c\x85Uc\x90\xaaKx\fl\x99+\x12\xa0\x86hc\xf0[\x83B\xc8\xc4\xe5M\x95ᡔ\xce\x1b\xb0\xea<\x13i\a\xaa\xa9@\x95Eyn\xa6\fL$\xc2\xd5C7\xd0(F\x03\x89\aqËZ鎘\x02\xb0@g\x911\xa2\x0f\xf5\x02\xa9ٗ\\\x04Π\x80❗\x91U\xdem\xd4\x1e\xa3G]cCY]\x848\xd3ݧ\xbe\x98+\x92\x1eT\xb9/\xe6ᄎ\xe5e\xcaU\xee;{k\xe7\xc7\xe9\xd1j>\x97\x19\xe1\xf6P\r:bx\x10v\xadrI\xe8\x1b\x12\x10\x809\x15q\x9eK\x86\xaa,i\xcc\v!ɃwT\xa7D\x91#\\O!\xb4@\x00L\xc3!Qp\xbb\xb3'\\\xf1:\x13\x1b\x8fp\xc8D1H[IuP/K\f\x00\\\aA\xddN\x97\t\x864\x1da+\x9b\t\x83\xb0\f\xac\xb5\\\xe4@\xd2\xe3\x1dB\xe9\x04\xd6\x02\x97bK\xac\xac\n\xf7K\x9a\xa7ǩ\xc0Ed鎏l1~\xda\x15x\x8a%om\xcd\\\x95\x83\tB\x10\x0e\xba\x00 \x11I\xfa\v}٧\xbd\x02,\x8bP\xb9\xbdakA\x19\xc3O\xa3aAH(C\x98\xc0\xa8U\xe7\x02If\x82\xacW\x91=\a\xa1*\xbe\x1d\x98(*\xc4\x0f\x15;k\x97\a\x0e\xf2t\\\xc2Pk{`\x81\x1d\x19\u009c^\xd8\xdf&cI\xc1\xe4\xce\x18+\x04ł\x89?.\x01è:\xf4\x81\xc1\xa8]5\xed\xe91\xd4\xc8lTK\xf1\xda\u007fpxC\x15'U\xac\x83\xc1\x15(Y&L\xf1 e\x0eI\r/\xacU\xa5C{32\xb5X\xc26\x9bgd\xa9,\xecgEm\xb9\x8cZ\u0085\x8b.A\xc9\x04\x9a?\x94|\xdb.\x03^\x99\xbdݰ\x89\xc0\xa2k\x19f\xaa<?\x86\xfc\x81\x9e\xc6.Ap\xe1\xd1G\t+\x1aU<\x0f\xd81R\xf1 ^\xd0`\x19Q;C\xee\xd0\x1b\xc2\r\x95&LA\xdc\xf9W\x92\x19ڈ@\xd0\x10\x8e\xf0\x8c\x80\xd5\xfdI\xf8\xff\xc9\x00\xfc\x86\xd4R\xd1, $\xc6\xcax\xe4%\xf2\x03ߋt`UUҀ)\xc1\x02\xb4\x15\x18VY\x93\xdf\xd8\xe1\x94\"\x19\x8e'\x1a\x03\x8e\xc1\xa7\x93\xb7\x98@\xdc\xd9\xf4\xfc\x8bp\xdc\x039\xee\xf0\xe0[\x1b{䒖F\xfa1 ۙ[\xd5\xde{\xd3V\x15\xca\x11\x01\xa8\x9e\x8b\xb6\x99\xe1\x8e\xe6]*{\x8e.ӽHgs$\x0eA\r\x8fo\xb4A\xd5-=8\v7ǎ\xfaؠyDyף\xfd\xd9,\x9d\xe9q\x02\x06\"l\x15m\xb7\xea\xec\v\x80\xae\uee69\x92@f\x82t%\x00\x0e3\xe6%\xcd\x03\\\x03\x12B\xd3\b\"\xb8\xa9͋\xec\xbb\xd5R\x8a\x04YPN\x03\xa6\t\xe3\xad\xe5\xaf\b\xf7\x8e\xb2\x01\xf9\x99\xb2\x026 \xb6\xdfF!\xc1w\xbd*\xf9s\"DT\xd9\xd0\xc6Uis\x91\xf9\t\xad\x85\ue193'\xb9\x8a\x06t\v\x01}\xe1z\xe8i\x19Q\x81\xa2\x9a\x17c\x1f:\xc8$#\xd1x\xf2\x8cy\x87\x956\x02\x10/\xaf\xdc)^5ܠ\xc29\x11\x03\xbe\xa8\x12Q[\x98\xa9\xc0i\xe8ZV\x82\xc4\xe0\xf3\x10\xaa\xb6\x89\x88ּ%\x01\xb3\b\n\xe9]\x15\x1e\x98\xdcH\x84\xad\x8ar\xef\xaa\x15\xc6\xe1\x00.\x06\x80\x91\xc0O\x1co\xba\xad\xf5\x8e\xcf\r\x92\xbf\x90\r\xb6\xfdm-\xd4\xe0\xf5\xff\x01\xb8\xa8iUM\xb6t\x80\x96l\x94\xd7q &\xf8\x80H#\x96=r\x15rGq\xdb\x13n\x1d0\x8bS^\xbah'\t\x1d\x90N\xca\x15u\x01;\xce\xc0\x04\xe0n@$\xafM\xf1z\x95\xc2p\x11w\xd0.v@\x86\xa9F\x002'\x90\xf1\xc4m\x84g\xb2ɇ\x834\xb5\xf0,\x12\xbfI\x92r\xe3%\x89Sx\xd7!\x1f\xf1s\x04\x00\xf0\x0e\x14\xe2(\xacis\x94\u007fCH\x16\x15\x8b\x18\x1ck*\xf3Ѹ7\x16\x86{\xe3\x81T\x80+\xbdx>uRqXC\x85\xd4\x19\x9f\xddr\x1e\x8c\x18!j$mq\xee\xf7U\x9c\xee\xab[\x14ٛ\xa4\x12\x01\xba\x0e`)\xad\x15\x99\xbe,E\x81\x86\x1c*\x97.2#u4\x10ɢ\x8a\x0e\x97ƫ\x81\x87\x89\xc7?\x84\xc7L\xaa\xebH\x86\xf1uU\xf8\x10~\xc4=\x11\xa6\x8a\x9c0[\xb4\x12\x88\x9a\x8c\xaeF\xb1Mm^#l\x9av\x8d\x85\xea%\t\x03\xd1<\xc8H\x95\x99\xb2\x19\\\x02}\xb1\xaa\x1bz\x16^@t\xce\xe7\x87\x1d\xfe\xe7\xe7\xfam\xb7Q\xff\xd1\xee\xebÏH:Ϣ\xedOu\xfd$ \x0621͛#\xa4I\xa0\x04\x98bH\u007f\x83\x10\xd4J\x9b'\xa8p#y\x87\x13\xd1\xdeA=\vJ&Ӊ\x9a\x92\x90vV\xacE\xb7\x95\x98\xda\x05\xd6f\xe9\xc5LCVD\x1d\xf6Z\xc9-{H\xee\xa8>\n\v\x94-Mֵ5G \x01kD\xedUmN}lX\x15\xae\f\x01\x90zD\x05\x86\x81\xfc\x05\n\xdf\xce\x1c\xfe\x98H\"\x0f,'\x9eI\xae\x1a\xeaŹ\x05\f\x17\x13\x8e\xa1\xee啝\xcdA\x13\xd8\xe7\x81\xc4\xd9x\x98\xa5N\x83\x83\xd3C\x8b\f\xec\x1cBɣ\x05H8Ԩ\x9a\x1bx\u0382\n\x92\xcf\x1a\xaaR\x113\x11q\x82;\xca\xc0\x1e$\x0f\x1f\xc3\xf5\x84\x1f\xb6\x00qQ\v\xd8#\xe1\x87y\x8a\r\x05\x8a~\xbe\xcf\t\xaa\x14ؙ0\xb5ఔ\x88;U\x95Wpii\x8f\x01a\x13qIה`\xb57\xb4\xb7\r\xd8t\x1dr@\xf8\xe2/\xba\xf9\x14\x13C\xa0\x9d#\xd7E\xff\x00\xc0\xe8\x03\x1b\xa5\x82\xd9*\xf3\xbd\xba\x10\x8c\xdfaY\xee_\xe0s\xcfR\xacq\xbd\xae\x01\x0fW\x00M\xadX\x96<\xdfUUA\xe5\x11C\xfd{=\xc7i\x0eG\x13\xa4\xb8Mz\\\x9c\xbb\x13\x1e-\x83JY\xbbNd\xb2:)\be\xe9\xcc\xf5\xbavv㯌\xd0%\xb6!O\xf0\x15\\u\x18|\xc0\x8f\xe9\x95\aCe\\\xa9\xdaG\x82\x1bZ<\xa7dt\x16\x00\x89\xde\"\a%<\xd4\xc0\x84\xb6ywW\xb1j=\t\xe8ȋ\xb2D\xf2O1\x1cb\xccO@\x8f\xa4W\x89\xc3\xe6!\xe7\xc9\u007f\"\x13*\xbc\x13\xcdP\xc9d\x96\x82\x90\xd9K凍\x008\xde\x01\xc8|F\xbdM\x888\bt\xac\xb9UF\x19\x18-~\x97D\bDN\xd2.i\x93\x8c\xc24\x16\x8f:Gc`ӱ\\\xda>Ӿ\x88\x0f[ۦU\x1b@\xe8\x10՜\fy\xaf\x82\xbb6\xdcBR\x1cdtg:\x83\xf0V\x8b\x16r\x1eװFD\xeb\x9a\agJ\xedN=\x1bj\x9dܪ\xae5\xe9Z&\x0f\x99?gR\xe1UE\x1a<\x85\xb6\xa1\a#!\xf0\xa3(A\x18\xa9M\\\x04\xa0\xa6+\xa0\xb3\xb5C#\xfe\xb8Y\x0f\xb1\x0fY\x16\xca$\x14\a\xab\xbd\xb5\xe9\xe1\xf9qR\x04\xc0g\xc0M\xc0w\xa4$ӧn\x91\xa5Mxҥn\xf7\xaaMm\x9b\xea\xfb\xaaZ\xb4s\x95\x88u1\xb7\xc9\x0e\x1a\r\"2h\xf3,\x95\x06\xb4\x9a\x15\xdb7Z\x01\xea<T4\x0e@]\x82#\xc0եBR>\x88R\xfd\xf3\xfc\xaer\x98[\x04#\t\"6\xc0\xc5\xfby?\xdd\x13\xa9\b\xc1\b\xa9\b\xf83\u007fO\v\x01{\xe8\xc3\x06\x90G\x82\x12\x99\xa7f\x04\x01YW\t\x13\xed\xa6\xbe\xd2\n\b$\xc0\v\xb5\x8dMK\x88dL'\xbe\xafl')\xbc\x02\xc9\x06\xb9@W\r\x87\xb7\xaevy\xc2%.+\x8cZ\xe0\"/\x89\x80\xbdE\x00\xa1ݙ9\x1c\x05r\xc5w\xa1\xf1\xc1E\x0e\a\x01\x82\xabȻ\x93eA\xb0\xf5c\xa9\xd3ra\x05\xd14\x01\xfc\xf1ڙ\xc3qbp\xbc\x9a\v\xf8\x98\"#mL\x19n(\x11\xbe\xc0\b\x94\x04H#\xf9\xb9x\u007f\x12\x14\u0381\xd0\xd5\x0e\xbb\x95\xdf\b\xe5\xcf\xd0I0\xdc\xe7\x06@\xf4\xff\x91\xadj\x96\x19\xf0R@\x10.G\xf6\xc1\xea\xb3rAU\x81\xb93\x12\xa2\xed#\x8dzY\xf3\xcdD\xa37\xf0\xa6fgw\x1f\xb5\xbf\xe7K\xc6x\xa3\t\x89\x87\xa3v?4\xa4\xe0\xfa\x12\xf5`fW\rj/\xe4A\xc3\x1c\xc2p\xea\xb1\x18\xe86$\x13\x0e\xf2I\xf5\xbekT\a\x01\xd2rB\xfbXf\xa5\xc9\\\xdd\xc9'n\xac\xd3Vv\xc0o\xdaQ\xf4\xb1\xa1j\xb0\xcbM\xf5?@:oTOQ9-\"F\x87\xef\x1adE\x91\xd9A\xc8\x00mj\x1c\xf0\xda%e\xfc\xa4\x01\t\x02p\rQ\xa0\xb6\x89ȅ\x06\x01$V\xa5\xc1\x06^\xe5\x92͓\xc0S\xea\xcd\xe0\x9aR\x85/\xa2Zu\x1bU\x8f\xd4;\x03\x1a\xac\x88v\xcf̰lH]\"\xa6\xca\xc5\x19<\xa8\xfa\t\xa1%p\xe6c\x862!Ɍ\xf9\xde\a\xeb\u007f3\xb2\x06\f\a\x1e0\x14\xfe\xd3`\xa6]PFEP\xf4\x86\xbdg\xc5Y\xcaF\xb6\ad2r3)܀\x1c\xae\xc0L\xe2\x15\x11J\a\xacs\xe5\xe0\xb0\xd0\xd7\x101\xee\x00\x16U=J\x0f\x9b\x9aZ\xee\xd4\xfa\x86\x94\x1f\xcf\xcdB\xc6X\xbb\n\f-\faP\xf9R\xfdd\x13|\xc6B\xa8\x12\xa8l\xaf\\\x06^M\x9b\xf9\xde@8\x90\x81jQ\x846\xd9(}\xa5\xf2\x17`\xa4\xf6\xa4\aB\x1f\xa3\x9f\x00\x1f\x99\xc9s\\U\xb1ϫd\x16\r\xf5\xa0\x95D\xe7y<7\x83Q\x95Dz\\\x9b-\x00\xa6\x900\xabL2\x10\xe4j@\xce\xcf+\x83B\x03\xcaFc7u\fB\xbf-\xef\xc1\x1c\xe0\xb6OB\xe0\x05D&\x11\xaf\xea\xb6j\xd1\xf2\xf3\x18Cl2\x99\xa8\xf5\xac\x85{sY\xd6p6\x87\x9a؈>{5\x85!*A\xa7\x89\x04,1\x89\x97\bm\x05\xdeE  \xf8\x1a\xf1|\xa0u\xf8\xdc\x00҅$\x96\xdbg\x1c\xc11n\xb0\x03L\x02\x801\xe0Y2\x14\xed#7\x82V<\xfc\x93\xc4:\xac\xcdg\xeb\x9cG\x8cw\xab\xf7E\x12Wevuݠ\xb3\xf9B\xeb\xea\xd1h\x9ap\x88\xfe\xa2\xfb\x90\x01\x05jA\xa8Cm\xf0\xdb)\xea\x97R\x91\x8f\xa9\x9b\xbe\xc8G4\bRͩ]\x92\xfb&VM»*\xbaU4\x16\xde#NȽ\x89\xccTzI䪅\vq\x81\xdd\xe5a\x81\xd7]nClg-\xd0P\x03\xb0\xc1\"p\xff\x98\xf8\v\xd8\x13\x0f\b\xd7L5\x1f\xa0\xae\x02\x86\x1b/\x84\xb2!=\xc1\xbc\xda\xf9\x0f\x8abm\x81\x16A\xa9}\xd2\xd0*\x98\xc1<\xb95\b\xfa\xac\x92\x83\xf6YX\f\x03\x1f\xb9\x12\xde1@\xdfG\xc5{\x12\xf4\xad\x042\x95xmb\x98\f\x88\xa0&a\x06\x8c\x01\x92\xfa:\x91o~UJ\xc8\xfcƿ\xb6\xd0\xc1d\bsm\xb5kk\xee\xedM}\n>@\x1a*Q-R\xf8\xc5\xe0\xf8\x9dEe\xdf\xd4\xc6\"ӏ3j2\xc4\xf8\xb3%ZR\t\xbf\xcaB\x02PI\xbbQ\xea\xc8\xc5x\xe4\xc6\"O\x99\x1f\x10-p!c\x96X~$\x00B\xa1C\u007f\xdd\xcb\xf9\x1b\xb1\xaf\xf8ھ\x9d\xa3\x01cre\x95\xd5P\xa7\r_b\x1e69-i\xdf\xce߈`\xcf*E\x803\x98ˍ3Y\xd0\x1f\f\xa5ԓ\xdfa.H.L\xb2\xff4`Z\xeeD$\xa8\x0f{a\xb8\xb6\x88@h\xe0\xc0\xb3\xf5٨;*\x84a\\kZO\xc0\x04\xc0\x19\xad\xd0aE\xe4\u007f|^Eeot\x1b\x00\xa5\xf6\">\x87m\xd4\x1e\xc9\xc5\xf8\x19\x12V\xa5\xf5\xa9\xcd(fk\x04\x9fUx\xbb\xb8\xae\xc0\x98U\xe8\x04\xa5\xb4\xb9\x9aN\x03\x13\"\xec\xfb6\x8f\t\x04\x95\x86Z\xe9\xe0\a,\xf2:\x0f:\x8e\xba\xbd\xbfBsvU\xa6\xb8\xe2+jy\x15Vg\x80\xe6\xf1D5[\x01\xa6\xf1\xd9\x03f\xf0\xa8\x005\xf1\xdd\xd4U>\\8,<\xac/\xe4\xe0TE\xe5\x15\x9f\x94\xd4MB\x80\xd5G\x17\xb0:\r\x9fN\x14\xe2\x14\x10s\xccL\xc4\xcfCD8\xb3\x0e\x95\xc0\"\xa6\xe2%\xa0\x14l\x1b|`D\x9cj\x10\x19\r\xc0߰n*\xa2\xaa\x12\xaa\x06\xb12TDT2\xf8\x12\xd6y\xd8\xd0U+UǸ\xb6,p\x8b\aE\f65\xc5T\xea \xbd\\#\x9a\x02\x01\n\xdd,[Q]\x96}\xbf&\x96\xa1\xa6P@\xd7tȤ\x82\xa3n\xaa\xc1\xabj\xb8\xda\xe7\x1dj\xad'}\xd4\xe4\xa3֧\xa3-\x89\x9dG}MfSf\xc8l7U\x1a\xcf\xc2]B\x9cY\x95ރ>j\xdc8\xa9T\xc0p\x81E\xb5\x01\x0fm\xba\xe0\x9d\xc9ۄ#a~\r\x9bq\xb6:\x00\xd3\x18\xa3\xa0c\xa4.X\xe4\xc1\u007fB\a:\x1d\xf8N\xe4e\xd2<o=x(\xaaV\xa3\x90\x9aJ\x9d\xe8\xae\x19\xf2\xdbpW\xfb\xb1\n#\x8c[\xe5z\xc9jd\xfc\"\x1av\x05'\x9c\xd0\r\x9aMJ\xf0\x06\xdaH\xfe\xb2\xb8\xa4}N=\xdb\xf0ڥ\xea\xf7\x05\xad\b\xe0<\x9aHz\x10t\r\xba\xffi\x95\x14\xc1\xa3\x93\x96:\x1a\xfb\xc4U\x03\x81>\xa6ո\x15\xb1]\xd4C\x8dHP\x9d\xf3\xed\xb5\xe0̑\xaa\x8bhP\x9d\x16\x13\xaaZ\xe6a\x1a\xfap\xda7\xb4μ\xa3@\x81\xb2z\xfd\x11\x1b\x91y\x9a\xa4\x1c1ķ\xd4%Ͱ\xd7V\xa04\x84e\xc0\xf9և\xe2d\xdbV\xb7\x94\xeb*T\xa3t\xd54\xbb\x8e\n\t\x193\xbaq2\x1e3ATA\xf9L\xde%\"\x8c\xd8 \x16Q+\xe1\xd51\x0f2\x13\r\x93\xa4\xa7\xcdi\x16\xf0\xfa\xaf8\xa7ƚq\xf8\x94䋚\xb9>\xf6\v4\x89\xea&V!\x1b\xec?\xe3\xa3?pH\aen\xda(\xaa\xd9}v\x8a\x86v\x8ap\xc3uU\xc4\xf6&}~\xf0\xb6\u007fڈ\r\xa4~7\xdb\x0fr\x95\x93A\xcdg\xa5\xb7\xa7\x00\\EN\xde\xd7\x0e\a\xe6\xee/\xcc%\xee\xd6_1\x17Rz=\x01\x84\xf3P\x19\x1b\xbd\xee/\xf9r$9]\xad\xd8&\xa8\x01)\xaa\x9d\xb1\xa4\x12\n\xd3\x18\xb5'\xc9D\xa0\x1b\xc1\f\x1e`\xa1T\xc3G}\x90\xf2\x82\xfbϞ\xd1ז\xd1\xc0\x1c\a$\xda\x05v\xb4[|\xba-\x92Z\xf5\r\xd4j\xc3q0\xdd\xd0)\x8e\x9d\x89\xf5\xdc\xe9\x1c\xb9\x17\xf5\x91\x1f\xed\xc0x\xee5\xe5\x8a\xdd\xfe\x1c\x93P\xd1,o\x9c\xd7LUm\xb6\x13\xb1\x8a\xfcf\x1d?\x91!dBs%H\x04\xa6\xab\x02\x8b\x87^\xb9V\xedt\x8e\xe9Ծ\x95\xa2g\xe0\xf9s\xc2G[kd-\xd3\x0e\xe6\xc2&\xea\x96WC\x00\xf4\xbb@vt\x99\x8e\x18\x9c\xf5\xf6C\xdfPqQ\x96\xb0Y\xc8m\xab<\xd4I\x1fHD\xf2\xed\xd6\b\x1a\x95\x01L\xaa|3\xd0)Ci*\t}\xc4\x04\xb9\xc9\x02K \x032w\x99\x03\xbd\x06\x12\r\x1ex+\x9eT\n#*11\x88\x10>\xc5w|\xbc\x985\x12R\x9b\x10H\x00\xa6\xbeb\"u\x8c\xa5\xbd\x11\x88\xd0\x1dS\x19BƎ\x1e\xed\xa7\xb7F&\xa1k\x9a\x8a\xdeܔ\xa4OzҬ-\v\\>\x8f\xad\x16\x82\xa8\xfal\xd3N&\xd1ʼ\x81+N{\x0eH\x16\x80\x17\x124m\xb6\xbd^^H\a?\x88\u05ce\x1e\x87\ax\xa9a\x01\xccӑ\x9f\x95\xf3]r\xced\xbfG\xc4\xf4\x98\xbawQ\x1b\x16څՎX \x96\xbd\x8eʘ\x0e|\b0\x98/p\x86\xcb1\xc4ɂ$\xf4\"\xe4\xc4, W\xed\aDBv\a`\x18x\x1d\x84\x85\xdc3\x9cD~(eT0\x9a\xdacі\x0f.\x11\xb0\xeeU\xa5<\xed|g<\x1f7\xf1\x80cR\xaa\xdbtߵ>d5\x01\x8ak\tCu\x89&͡-\x06\xb2=\xe3͈\x96ޠ/u\xf7%\x15\x8cT\x17\x1f\xc8\xc6\x01=\x11\xa7\xf8~D=\xf9\x17_U\x8dU\x01_Q\xf0ځ\xf4\x96/\x8e\xb9\x8e!\xf0\xc4\xc0\x13Q\\\x18\xc2\x1c\xdcg\xbc5\xe5\xaf\xf1\f\xea[r\xaaU\x9f\x17\x1b\xaf\xb07ɟlHϰ1qCe/\xee\x1d\xf2\x17\x80\xd4\xfb:\xfa@?x\xe4eZV\xdb\x06\xb2ɑ\x8e|\xc1\x1a\x9e\x8e\x05\x95U{\x9dH^\x8d:d\xd1g\x0fB\x82\xedgG\xf9k/;0\xf7\x84Eѱ\x93\xf5\xe9\x88ZX\x8b\x87\x15J\xd9\xf5\u05ceܫ\x02\x80\x85\xaf\xe3\r\xed\xf7\xe3\r\xe0\x16\x9fp\xfa\x88\xb6\xb2!\xc9\x0fJ\xfe|\x04\xfa\x92\xae\x0f\x1fd8\x06\x13\xa9}\x9b\xa0V\xa9\x1b^G\x81\xe1\xd3e\r\x10_\x8e\x19;\"'\xa8]\xfa\a}\xccc\xb3d\x04\x1c\x03\xdc\\\r\x02\x80\xcc\x11\xff\xaa\xach\xe7\xef5-\xa7\xb7M\x86pV\xcf\x14y\xe1\x90\x1ej\xc6\x19\xafAjxu\x88\t\xfc\xcb&8T\xf9\xea窠\xc6\xd4DOL\xe3\x1fI\xe0xb\f\xcc\x18\xfc\xa7\n#jg\xaa\x93\x05\xd2V)'h\x9a\x01\x8a\xfe\xaa\x04:\xa9uwRW\xeb(j\xe9\x05\xecU\x88\xe1WE\x9du]d\x02\x8b.\xed\x83o\xb9\xa3\xe4\xbb\xe0hlTK\x95\x92\xd9\x04k\xd6):\xd2\x17\xf2b\xfaD\x0f<\xe4\x95)\b]A^\b S3\x9e\xb6AQ\x13\xc1\xb8\xd0y-\xf5\x13\xd0Q\xc7%\xd6oi\xab8\xe2\x92t\xb4\t\x84\x1a\xc6\x13\xa98\xdf\x17\x18\xc3\xca\xf9\xd8U~\x96F(H\x8b\x10\x9bN\x1eD\xf8\xa0(/\x8f\x89\xa8\xaer\x94\x85\xe2\xe1\xe3R9Zm\xd2\xd8(\xafS'0 \xeeG\xf5\x17\x9d\x02$7\xb4\a7\x19\x8b*\xecɫ(\x88\xcd%eQ\u007f\xc4[l*\viW|\x93\xaf:\xdb\xc5\xccݞ\x13\b\xa2\x83\x12\x8004\x0fw\xa0\xc6M2\a%\xdc]\x93\xabG\xcd\x19Q\xadS\x14\xea\xc5\xdcQ\xdd\x18\xe3\x1d\x16\x8cC\x1d\xee:\xe6'\xf5ĕ\x87:\xa3u\xa0\f\xddĠA~\x99\xf6\x83\xf4ېZ\xba\xf5Ɂg\xd3\xdf\xc1\x11\xf5ꪒ\x14T\x96d\xec\xa4\x18\xe8\x18\xb5u\xe6߱\f\x9d\xba\x86\r\xfaJK\xd0\xecޑ\x0e\x12\x95\xecNC\ua87dmt\x85\xbe\xaa.\x13\x00Dz\x05\x84\xd1A\riߊ\xa4\xcb\x02\xb7\x89l:[\xe7[\x01\xc2\xe1>\fXT\x85\xe1nS\x95\x97\x82yi]\xf5\xcaPŎQ\xa6\xb5\x01\x06H\xe3\xa2J\xf7y\xad\xbc\xd0\x18\td\f\x9e\x90\xeeN\x1b\x1fգř\xa5O\u074c\x87\xb7O\xef%L\xdf\xca\xfelt\xbcΔO_\x8a\xe9|K\bH\xa73\xe4\xa9LF\xd1\x01\x14\xf9Ko\xd4?w\xa0\x8c\x91uv\xa0D\xf9\xb4\x1eT\xdeJ\xa4\x17\x1f\xea㻌Wj\xd5\x11\xba>e\xfc>Z\x04>\x9e\xda\xe6ÙL$\xdc\xde\x19\xddjq\xad\xaa\xc6Ţ\xa8\xcdu\xe9\xe4\x06<\xcd\x17\b\xe7\xf1D\tj{\x90\xba\x0e\xae\x10\xe8\x065\xa5\xc1VА\xe9\xa8'\xba\xe1\xb0\xda\xea7\x90\xa1\x8f\x18\xef\xa0\xe3P\xd8\x12\xd3iS?}?,L\xc3\x1f\xc2ma:\xd2U\xe3h\x9a\x90\xaa\b\xe1\xe6<\x13+H\xfec\xaf,f8\x9f\x8c\x98\x9dD\xc1\xab!!\x97\x9a\xa7\x93\x14\x93\x8a\xf6\xb3\x9fx\x9ac8\xf7\x84\xa5\x9d\"\x1d\xed\x80\"\xb91\x017\xd4k\b/vuru\xb2@\xe0 \x99\x8a\xa1\tD׀\x10\xf7Qw\xae%Հ\\\x96\xaa\xda1\xaa\xa4$s\xbf\xb4\xbf\x8evScp\xc5yC\xd4J\x94\xa53\"\xe7\x04\xb5\x1a\xde\xc4wu\xfe\xa4\xab\xd3J\x8ds\xc8\f\x87J\xc09\xfa\xa5vF拹\xe0\a\x11f\xfd]1\x03\xb7`(<\x1f\x81ݨ\x8a\x96\u007f\xe7\x17\a\x10\xe7\xf1\x96-\xac\xa74\xd47\x82\xbcX\xeaz@\x032\x03\x11\x94Az\x96\xc9\fr)\xb5\xbd\xea\x84\xe7Б\bK\r7\x03\xd9h\xff[\rL\xf3\xf2\x88\xb3\xe9l\xd6\x14@'\xec!\xe0\x91\xfc\x95\xfd\x16\xd9b\xa3\xceѩ*\xec\x8d\xdak\xdfy\x81\xf3v\xd7ey\xaa\x8e\xe1\xa81J\xcd\x1e\xe9\xedeO\xaf\xca\x18Y\xbc\xa2\x0e\x05\xf7\x99<\xde^Mcj\x16\xd9\xe7\xaa\x1ee\vA\xe3\aX\x89}\x8eP\xf66c)\xd4ӧ#\x16\x11\xf0pP\x9a\x9a鐕I\x9b\f\xea\xd3\xc9\xc0רּ\xf6\x8c\xe3 \xb2\x0e\xe9n\xef\xb0Z\x9b&\x8f\xae\x136\bMr\xfeF5\xa9\xa8T\xe4Ԙ\x89eٛI\x82)\xd6\xc1\x02B\xb9$\xfeb\xdd=˯\xee3V\x9d\xbfė)\x9e\xb4\x01\xc0\xec\xa9N\xbfe\x82\xe5Άk\x06\x02+\x83\xce&ʀ\xeba\xda\xe6\x14\x10bQ\xa5\xaa\xac\xf8\xa2\xdalʕ\xa0\x86z\xa0\x83|\x96N\x8fX\xcb\xdaJ°F7TƿKG\x1c\xee\xd09\x9d\x01\xd0\x12\xebXs\"+\xb4x\x11?\x83\x84\x81Z\xb5\x11u\xbd\x8e\u007f\xe0\xf4tt\n? i\xac\x93\x1e\xce#L!_u\xd3L1\x1cf\b\xc5\xc52u\xfb\xb2Q\xe8byH\x1d\xd98\xaa`Cm\xf1b\xb7u\x16\x86p\x01\xedq\xe2\xa8\x11\vD\xe1\x05\x17\x81%S\xf34\xe3$\x93\xb2N\xb8\x900\xf8X\xa4\x15\xa4\x8b\b\x91\xcdԃ\xab\x0fP\xcd\xff\xa4\xe6\xf5\xd0@\xaf\x1dw\x04\xd7\xe9\xecA#\xe4Ӄ-\x15\x9eX\xff\x03\x820\x95O\x06\xde2ѝ\x17\xa5\xa2r\x00\xa1\r\xbeԬ\xf7,\xb4\x0f\b5\xf5\xd6\xcc\x13K \xfb\x0f\xa4\x98\x8du\xb9\xb3N|\x81\nq\x0f\xf2>\x8d\xe0\xe9\x1b\xc2~C0!c\x1d\x85\xa4Mᐸ\xf5\x89\x9a\xbf\xfd\xa8\xce\xf4\x9cw\f\xb8~\xfaX\xbf{\xddW\xcc\x0f&\xd5K\xe5\xf4\xe6\v\x1d\"8j\xe5A\xd3\x01xEʮMu\x84`ܰ\u007f\x89\xa4b\x91\xea&\xf3\xac\xcb\x00\x15\x04\x83\xf4\xcf\xd2!@\x13\xfb\xbbsU\xbcɩ\xb4${\x120&\x8d\xd4lK\\\x8e\x98 q\xea;\xe2\vH\x15\xb5X\x02,\xaa\xfaBm\xef\xf8\xa2\xf2l\x12-\x0er\xc7}]S\xf1tN\xf5\xd0\xe9\xd0O\x05\x03$n\xd5ʙ\xf5:\x8dK©ƣ\x1d\x8a\xa5\xa6\x03\xf5\xced\x84|\x97\xa6\x04\t\\'\xae\xbd\x8e2v\x85e\x1b\x8d\x1cP{\t\x19\x90Lgd\xf9 \x82n\xf5\xd7?\x1f$\xe6\xd5d\x80j\xaa\xe2T\xbe\x84\xcb\xc37\xbb\xf8t\xaa\xce\x04\x82\x18\x98\xe9\x1e\xb4\xe7\x0f7\xf5f\x04\v\xf7R\xb7\xe6i\xd0h%\x03=p\xaa3\x1a\xed\xa9\xf6\xa6j\xad\nq\xb78\x02A\xfa\x04o2\xd5\u007f\x88`T\x1f6S\x826\x8e\xaf\x1f\x0eӒ#v\x1a\x1b\x02ʫ\x12צ\xf2\x8bd'\vxȭ\xdcqE\xc5\xff\xa4\xd40\xbc\xe6T\xcbV\x82\xb1\x94\xf7@\x15\xf6_\xfb&\xaa\xe4\r\rf$\xb8\x16\xeb\xe6\xd5\x1a\xa1\x13\xf5\xdaDU\x95\xcb\\\x1ej\xfeW\x02k\x87\xbf\x93\xf5\fU{\x9dr\xb9-\x1d\x12q^mʽ\xf2{A\xfe\xaa\x00\x81[\x06Ʈ\x8e\xf1\xa0\xd7\xd5\xcfD\x1c5\xb4\xff5\xf5\xa4\xe9\x00i\x1d\xaf-q\xe1\xd6\x00ʁ\xc0\x02Bp^\x84\xa6-y\f\x06Jz\xe8\xe4\x97j\x88\xc8\xee\xacC\xbd\xde\x01\x04\xe885\xdf\xec\xac\xe6T\x94t~m\xd1:ʯv\xe0κ\xab\xb2\xaf\xbe\x9ar\x93\xb6\xc8u\xacL\xda\x0fY\xf8\x0e\xa5\x05\xd0\xc6\xe9X\xe7\xe7\xfd\x06\xcc\x164PY\x1bȊ\xa7d\xb2\xd4=\xcfM\xbb\xb6\xf0\xb5\x9b\xe5u8\x1b5\xae\"Y\xe9؋\xa4\xd3p\x19\xf1\x17܌\u0089VtR>\xaa\x1d\b\xba?-\x97D\x8c\xc0\xdajJ \xb4\xd4\xe2\xd8\xe1-\xcfo\x03\xe2\x9eo\xbfz\xc1zG\xe8\x10DC\x16\"\xebQ\xa7\xba\xc3\xe3\x90=}\xfb\xb2()\xc6\xc6xë\x81\xf7'ڵ\xdf.:\x1b\x19\xad\x8fB*\x04N\xd6\xe9\x84cБ\xdazA\x9e\xa6\x93NkK\xbd\x9a\xda즺\xa3$\xe6u\xc0@\x1b\x98K\xad\x1bE{\xed9\xcdwBs\x14\xafS\xbc\t\x15\x9e\x9d\x0e\xfdj\xbbY\xeb\xc5D&\xcbo\xaf7x\xb5\xb0'\xaf\xa5\xf7<\x87\xb6\x1b\x88\x8b\x9b\xd4\x01\x0ea\xa2-U㗰=\xe8T\xa06\xa8\x859mBY=\x89\x01I\x86\xfd$\xfe\x117C;\xa0(\"\x84\x91\n\xe4\xaa\x16\xa8'\xa8\xab\x1b\xb4)\xa8\x88\xbf\xf2\xded\x80=p\x92Bju$\xe5\xf5^\bՃ\xf5\x9a\x8a\x01\xf1W\xb5\x0fy\x8c\xa4\x8e3BȠ\x8ci\xabË\x87\x9e\xae\x04M>\xef\xb4\x18\xac\x1ab\xb0J\x9a3\xe4\x84\xfcұ\x12L\x9aN\xeb5\t\x0e\x00\xe6\xbb\x14\x85>R\x8b\x85ΡviN|\xafp\xa2\xf0\xcfn\xaey\xd5\ta^\xf5\xb3\x8d\xa6\xbd\u007f\xec\xaaL\xc0ɸ$\x94\x10k#\xf1\xad^\n\x94\xc3\xd4ڪ\xf3di\x0f\xf7\xa8:\xef\xd3\xd9\xdbaƇ\xf6t\xafƤ\xd7U\b\xc8L\x95O\x1d\x9a\x1eyk\x8b\x90\xa8\x03\x94P\xef\xaa鐾\xbeu\x9dz\xf1:ŧ\x89b\xa4.I\xe3\x04m_\x8b\xf2c\xd4\xe1y\xfd\x8e\xdcͰ\t\xf4t\x98&\x0f\xb5֢\xbe\x01`\u007f.)[\xb8m4\x9d\x11@\x88\x93nn\nL\x9a\xfa\x8fc-\xe8@\xcbL\x0eDLn\xb2@H\x1b\xd0\x05\x8c\xb7f\xb3ju\xd4;Kr\x1f\xb58\xab\xf3\x10vӾ\xf8r\xdcH\x1d1^}\x96\xf8\x8d\xab\x1d\x90\xbb\x9a\x1a!\xf3V\x9b\xbc\xde\x1d\xd2\xd54KRD\xd5a\xfa\x1c\xaf\xefS]\xbc\x88\xff\xcf٫\xe0\xden\xbe\rc\xe20\xe5:'\xa2\xa3\x15[\xed\x9b,.\xf4\xb1T$M\b*\x04\u007f\rH\x88\xb5\x8ae<\xf4\xe6\xeej\xfc\x86\x19\xef\xc8d\u007f\xefY\xf4H\x88D\xed\xf5?\x10\x14Z,\xf2\xa2\xa8\xd8\xddߙ\x8b\xf4N\x145\xd5\x16_\x81\xfa5\x8cx`\xbe\xde\xdaZuG\xad\r\x98`uy\xa8\xb7\xea\xbew=]ܾ\xda\xedT\xc9Kx\xe6\xc9,\xea\xe4{\xad_\xdbu\x00\xb1\xac\x80\x8e^\U0008403d\xe3\xd9T\x9c\f\x99\xd4\xc1\rD\xed\x1dt\x8cx\xec\xeaF\xefK;\xc1\xd0\u007f\xdcj\x14\x0e}\xcae\xb3jj\xeb\x19'a\xe9\xa17\x15\xd0݀T\xbb\x04\xc4+\xe3H\xb1v\xd8U۸K\xa70\xf7k\xdf\xe8\x8bl\xc7Y\xe1C\xb6\xceF{\xd9k\x96U[\xa6c6\xf5\xab \xb4\xa0\x1d\x19\xf1\xa3\xf3\xb0x\xb7\xd2y\xd0\x1a\xd0P}7m\xc1\xeax\x92\xd7\xee\x94\x0e\xab\x13A\xc0\xeb\x83a\t\x02\xd3\xf9]\x95W\xa6C̯4\x9bf\x80PG\xff\v\xc9t\x9f\xc8\xdd\xd5i\x8e!LR\x95\x84\xa7G\xfc\xaeJP\xcbŪkN\xd2\x03I\x8bfg\xb2\x8b4\x0e<\xd8q\xdbD\xd4 \xf6^S4\xa9\v/偖\xdc:\x17\x87\x1d\x13\x00\x94\xa5:T\xb4$\x9b\xa9\x8d\xedO!\xb89YT\xb47R\xbe#nF4T\x93\xceҩa\x87t-\xf8oLQ\xfaT\xf7\x10\x8b\x19\xbb3խ\n\x8dw\x8cI\x84\x15\xe1\x85\xe1\bL\x1d#C\xfb!\x82\xad\x8f\xd8\xd5/!\xd0P#\xbez\xb7A\xfe\xa8\f\x81?\xb9\x8dj\x11\x93%T5\xa9G\xdciR\xb7\xdf>\x0e\xd3\xe4_=\x85E\xd6\xfe8晁,1\x12Y\xa4\xab+B\xbd\xf6\r\x18vDIzl5!\xb0\xe4\x14-\x13\xfeKg@]\xd1y\x13,\xb9^Φ\xc72\xc9^$NPo\x04\x0fGB\x01TGg$RR\xbd|\xe9\xbd\r:\xfe\xb5Ճ\x19\xb4m\xcd\x1atT\xad*ª\xb4\xec\x04\xcax\xbc\r\"4\x0e]5t\x1d\x11N\x17\xc3\xdbU\x9d\x8as\xeb\x14\xe2\"\xd4\xf7\x8b3Ԗ\xaa\xdb\xeaks\xda\xc5ê\x82\xfbo\x83\xf6\xa8\x1c\x9bU\xb0\xccr\x8d\xa8Kn\xbd\xe2kWD\"\x99\xf6]`\x1a\b?\xea\xc4\xd7\xd2\xcbNT\xa3_\x0e\xf7j*\xb8W\x95\x9e\xd4ԋ:\xa80}\\\xad\xa5\xd8\xd4\tu\xb4㯝\xa9ƠI7\x04(\xeaB\x8c\xa1\x9dƣ\x04\xdf\xc9Ƀ5\x95L\xea\xc9\xca\x0f$\x8dE\xedk\xe9\x14kV\xb4\xab,\xa4\xee\xbbw\xb6\r\xf29r\x8f\xacMx\x85\x90\xfd\x8e\x91d\xef0Jg3\t*\xb0\xd4\xf8z\xeaA(\xcc*F\x88\fՋ\x1e\x94*\xc8d\x00\fL_PDB<$\x1d\xd5@\x91oE\xb3^\x80\xc3\xf2\xb6/\xb8\xfe0\x94\x04\x97D\xd5\xe8*)\x83\xc41<\x15.\xa7\xdc\xfcy\x9bO\xea\xab\xd5yf\xcd3\x1a&l({\xdc'\xc0u:\x16@\xd3~\x80,\xc0\x90yc\xd1\xe0\xa4K\x86`\xc0\xee\xeb\xfd:*\x981\u007f\t\x06\xeaH\xe4\xa0\xd6\xfe\xab\x1b´\b\x86\x88FV\x9b\x97\xf6\xb0\xeb\xf3JA;\x96\xb7o\xb5\"\x0e p\xaa\xc5\xdbGҩ\xb2\x9c\xc0\xf2s<\xe55-0\xb7\xa8\x91M\x98uY\xd7@J\x03f \x06\xeb\xa4\xe4\x0e\xaf)\x86\xc5J\xdam\xd3\xcb/\x82\xdeG\xa0~N4\x0e\xb7\x8d\r1\xdd\x10\xaa\xaa\xfc\xb8=\xf42\x9c\f\xf6Ib\xa9\x8aU^\x15\n\x93U\xf5Φ\xab\x1e\v\xb4\x94\xaeX\xb5MD\xe8d\x1c\"T\xa12\xa0\xba\x83\x8a^=\xe2\xb01\x03\x1bڐ\xea\xaf\xd9\x0f9\x93\xaa*\x8fS0\xa22\r|\x1d\x15\xfa\t\xb8C\x8d`6\xf4ƿ\xa5B\xe1ku\xd39\xc5Ӊl\xa4|\xce:]'\x1d\x1a\x05\xf4̓ޛ\x92\x15\xeb\x04\x9f\u038d\xab\x85\x90\xaf\xe9\xe4cU\xdb\xedθ:\x9c\xfdl\xaa\r'\x8f\xf4\x03\xa2\xd5E\xd5\xf5\xbe$\x82-J\xb3\"&_\xbd\x02Z\x84\xa3\xb8\xb2\xd7t\x1c\x14\xb9\x1awPz\xd8+`>\xc9tGV\x90\xa4r:\v\xfbr\x1e\xfe\xd0;a`\x04\xed\xa0v\xe2\\/\xa3Cnby4\xe7|\x8d\xbf\xd1\xebd\xf4\xde+\xc4QS\x17\xaa6\x04A\u008b]GUo\x11\x8b\xba\x012\x9e\\(\xa9\xb7\xf3$\xf5У.Y\xbe\xc9\nB\x9a\xaaА\xc6j\x15l\xd8+u\xc0\xf5G_\x9b\xdb8mbM\x9fQ\vS?\x98E,\xcdf\x16\xf4&\xae\xaeHiQ\xad\xcc\x13\xad\x1c7\x90\x97\xa7WE\f\xf78p\x05\xbe\xe8\x1c^Ћ\x94@\xe0\xa5W\xe8(mZ%5\xa26\x05\b{\xf9\xefK\x00\xe0\xfa\xf7\x9a\xab,\r\xe3\xbeW\xd10q\xacHe\x18 \x8b\xf6\xddrs\xb8\x03Il{\xe7\x01=>֫\xc4Y\xd5q@`܃w]\x8f\x18Xe\xb4S(hw\xa0\x90\xc4!\xc2\xd4\\\x8fh\xad\xf90\xd9h0t|\xd5\x1b\u007f`\x91\xe7\x9es\xd7+dt\xbaP\xef@\tE\xa0\xd1t즪\xb8DT]d\xcd҉P\x84\xe1\xc2!\xea\xfdG\x04^\xb8h\xf8\xa6\x97v,\x88\x9e\x98\xb1\x94\x96\x9a\xc4\xf5\xda\a\x1d\xf8\xdb\xea\x84b>@\xd8>\x90\xd3عn\x9b\x01\x80\xbeh\x9d\xc1\xacT\x94\xff(zI\xd1\\\xda{Ǩ\a\x84\xa7vkR\xd4n\x9b\x8e\xa0\xea\xacAW\xd3\xdcR¢\xc0u\x12\x06\xe7\xb6T\x05\xce\xf8\v,_\x87\xfbA5&흜'\xbe\xf4\xceE\x82\x14\u007f\x8d&!\xef\xd4Pݵ\x8d\x05\xc9\xc1Dz!\x8c\x97p \xfdŖK\x1b\x80\xad\xbc\x9d\x9a\x02\x83\x16\xf5B\xa1\x85\x8e\xde@\xb6\x1e\xc6l5\xf4\xeb\x8d\x05*\xa5\xeb<\xda\x1eD \x1e\x1c_\xe9\x85\xdc\xc4\x1c\xe0V\xe7Jg\xa9\xf7xh\xcbS\xdb\xc5H]Q>ƊU\xcbx\x8aI4\xb0>\x00\xeb{\x8d\x04\x8b\xa8\xb6f\xd6`\xa8\x97?\xa8\xd6t\t1D\x95\x0eU\xa4\xa2\x93\u007f\x8ai<#$\xad\x17L\xa8\xfdF\xaf\x17\xd2\x11\x8b\xca\xf2!\x8d\xbc\x8e\x0e\r\xd2q\xeb\x84WV\xbb\xc8P}G\u0604\x8e\x8e\xdb\xe9\x94<\x92^\xbbU\xea;.ڨ\x81\x1e\xe3\xebǕ$\xad\x8aɠ\xee\xe9\xac\x1aN\x809\xbc\xe4\x01\xaa\t:\xd6GH#Cg\xe7\xfaz;\xb3\xfa\xbaՍ\xc3\x04\x03\xc7`\xdbx}Y\x8f\x05\x0e\xc3{d\xac`Q\x8f\xd8x\xcd\xfe8\x17\xb5\xd0%3.t\xf2\xebȼ\xef\xed9z\xf1\xc3\xd6;\x18Ub\x90\x1bҾ\xf5Qa\x9a\xd8\xd1\u07bb\x1a\xdc\x00\x14\xe0ƫ|/dU\xe7'\xaeũY\x99\x14%\xb8E\xb7(\x89w\x0e\xb8\x03\xf6ꃁ\xbd\x82\xda\xe3\xea\xe3\xea\xc23!\xfe\xf4\n6\xa6Z\xefP\x93\xf3\u009c\x03\xa5.\x10\xf9@\x8c\x0e /\xa9\x1e|\xfb\xd2k\x19r\xf7\xef\xf5BD2j\x95\x98V\x8fnZz?\xa9\xc4g\xd3ˁ\xf4\x92,4\xd6\r\x881g:©\xf68\xf0Fǯ\xd5z\xa1\xfe\xed\xab\xb7\x11\r\x9d\xde\xe3K:\xcc[\x87^\xa0\xe2\xbb^p\x047\xbdW5͠\xc3C\x139\x9b\x9d\xb6\xadAh\x9e\x1d$\xdbҜ\xea\xb8\xcd\xd8\xd3\"\x93k\xf7\xa1wԋ%\xce]\x0f\xe4 ݸжx\x17|a\xd2ۤ\x96wz\x0f\x19\x16\x8d\xf9\xd1\x1b\x12\xb0\xb3 ހ\x00;y\xd5\xe2\xc2Z̓;\xd0\xd1d\xed\xb6\x17\xbd\xee\xc3tt9\x0e\xbd\x86\xe3\x98G\n\xb0\xa2.\x1ay\xe3U\xa5\xe5\xc1T\xd1b\x92\xc1\a\xa9Z\x86\x10\xb0*-\xfb\xcc\xf4\x88&%\xe902v\xae^ؤf\xe9\xd7װ\x90nN \x12\n\x19\xaa\xd3x\x8aȅ%9Fp\x15\x9d\x90i\ny\xaf\x8e\x90\xf7\x8e<\xb1\xbc\xde\x0e\xa52ʆ\xc3v\xd4Yk\xc4At~i\xdf\xffQ\xfbXꭃ\xdcP\xb2:'\xa7\xca#Q\x8dS\x95\x9c\xf5z\xa3\x98\xda\x0e\xc0\u007f\x0f\x19My,\x1d\x17\xb5,{\xe7\xa6\xe9l\xc0k\xc1]j\x19Б\f\xf5\xb3\xf4\xf7VFņ\xbd\x16x\x95su\x00q&\x1d\x01\xb8z\t\x9a\xbaɗ\xf6\xf8\xbd\x8eS\xab\xe2\xc3c\xe8\x14\x90N\x9ec\xefT\x98\x1cE\x87\xe6%\xc8\xfd;3\xd2\xeb\x93\xe6z1\x94^\xf9\x04\xaa\x1c\x9d\xe7\x89\x05'\xd8ߙq\xc7\"U\xbd\xc3K\xc5U{\xfdS[\xb5\x1a\x1c,\xa4Ƽ\xea\xe8!.W\x95\xa9\x8b\x91\xeb\xee\u007f\x00\x15Q\\n6\xb6l\x0e\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x014\"ˀ\xe0\x00\x00 \x00IDATx\xda\xec\xbdi\xb4\xadWY\xe7\xfb\x9b\xcdۭv\xf7\xcd\xe9{\xc8IrH\x88@\b\x11\xa5\x91\xc6\x1b\x01\x1d\x80\n(^Ųԡ\xa2\x18\xaanՐ\xbaP\x1f\xaaԲ\xfc\xe0-/U:\x94\xe1uT\x95ޫ\x88U\n*)\xa4\x14\x02\x02!!1}rr\xfa\xb3\xfbn5o3\x9b\xfba\xbek\xed}B\xac\x82B\x8e\xcdp\x8f\x9cqNv\xb7֚\xcf|\xba\xff\xff\xff<Kx\xef\xf9\x87\x8f\xbf=\x1f\xf2\x1f\x8e\xe0\x1f\f\xf2\x0f\x1f\xff`\x90\xbf;\x1f\xfa+\xf9\xa6\xed\xedm>\xfb\xd9\xcf~ݞD\x9a\xa5\xdc\xf2\x82[h\xb7\xdb\xcf\xf9\xf5\xb7\xbf\xe9M<\xf9\xc5\xfbD9\x18H\x89K\x15LI\x81\x90\x90\xa7Rl\x1a/\xdc\xd0y\x9c\xc0k)}\x9c5ݫ\xdf\xfe\x0e\xbe\xe5\xf5\xaf\xe7%\xb7ߎRj\xfc\xbbz\xbd\x1e\xf7\xddw\x1fEQ\xfcO\x9fW\xb3\xd9\xe4\x8e;\uee2e\x06\x11_IR\xbfx\xf1\"\a\x0f\x1e\xfc\xea\u007f\xb9\x10\u007f\xc5\x17@ x\xc7;\xde\xc1\x8f\xff\xf8\x8fs\xe6\xcc\x19\xa2(\x1a\u007f\xf9\xce}\v\xd28s\xd0\xe3o\xf7\x96[\xad\xe7\x06\xef\xfd!\x0f\v@\xaa\x05\x9d\x86\x14\xb4\xa4(#!v\x12\xc9Z,\xc4R\xe9\xbc1\x8e\xb3\r%\x1e/\xbd\xdfX\xa9죽\xca<2\x11Ǜg\xee\xfa6\xfbo~\xed\xd7\xc7F\xb9\xe7\x9e{\xf8\xb9\x9f\xff9\xee\u007f\xe0\x81k\x9e\x9a\xb5\x16g,\x1e\xf8\xbew\xbe\x93_\xf9\x95_\xf9\xfbc\x90\xd1\xc1?\x87\xa5\xf8\x17\xef{\x1fw\xdf}7\x8dFc\xfc\xe9\xd7,N\xb7\x8d\xe7\xfb\x84\x10\xef\xb2p\x83\x92\"\x12ރ\x03\xed\x05\xd2{2\x01\xd3:\xa2)%m\x05\x89\x82\x81\xf5\x18\xef\xebǃ\x81\xf38\x0f\r%|a\xed\xf6\x85Ay\xc1I\xf9P\xa4\xd5\u007f\xdc\xd6\xd1\u007f\xfd蹋^JI\xaf\xd7\xe3\x0f\xfe\xf0\x0fy\xf7\xbb\xdf\xcd\xf6\xce\xf6\xf8yx\xef1\xc6\xf0\xce\xef\xf9\xde\xebn\x10}݃\xa4\x10\xfc\xec\xbf\xfe\xd7\xfc\xe4O\xfe$Z\x87\x87\u007f\xdd\xc2tcJ\xab\x1fm\b\xf1n\x04\xfb\x94\x80DJ\xdaZ!\x9dC\x02\xcaK\"$\xce9\x06\xc60t\x02\xe1\x14\xd6I:\x91\xc0{\xa8\x1cX\xa0\xa5$\x91\x84\x9e\xf1\xa2\x1b\xe9\xee\u0084\xee\x9e\xeb\x177U\xde}g\x17\xfb\xd1\xffmq\xee\xdd\xef\xfb\xf0\xef?\xf1\x92\xdbo\xe7;\xdf\xfaV\xbe\xe1\xb6۸\xfb\xee\xbb\xf9\xa3?\xf9\xe3\xb1g\xef\xf5ؿ7I\xfd˼C\b\xfe\xef_\xfee\xde\xf3\x9e\xf7\xa0\xb5\xe6\x8f>\xfaQ\xeeh7n\xcd\a\xf9\x03\x99\xf7?\xb7?R\xfb\xba\x12:R\x90\xe2\xd1\xde1\xa1\x15\x8bZs0V\xccF0\x19\tf\x93\x98\x86\x94\xf4\x8da\xa3\xac\xd8*=J\b\x12\x19n\x98\xc2SZOK\t\x84\x17lW\x8ec̈́\xb6\x94\"6\xf6[O&\xeaK\xef\xfb\xf67|\xe0\x8e\xb9\x99\xc6٧\x9f\xe6\xf8\xf1\xe3|\xe8C\x1f\xe2\xbb\xde\xfa\x9d\u007f\u007f\xab\xacg\xe7\x8f8I\xf8\xf7\x1f\xfc \xefz\u05fb\x90R\xf2{\x1f\xfe0?\xfc\xd67\xbf\xfaP\xbb\xf1\xc9¹\x13\xe7\xfb\x03\xa4\xf3t\x94\xc69\x0f\x1er\xe3X.J\xce\x17%W\xca\n\xe3\x1d\x99\x12t5\xcc&\x92\xe9$\"\x16\x82\x95\xa2`5w(\x04m-\x10\b\x14P9\x8f\xf7\x9e\t-\xb9<\xac8\xdcL\xe8jIQ\x9a\xf4\xcef\xfc3\xb76\xb3\a\xdf\xf0\xc2[\xdexdzJt:\x1d~\xf1\x17\u007f\x91\u007f\xf13\xef\xbb&\xe4\xfe\xbd,{\xe38\xe6\xf7?\xf2\x11~\xf0\a\u007f\x10\xa5\x14\xff\xe8\a~\x80\x1f\xfb\xdew\xbc\x02\xef\xff߭\xb2j\xdf\xd0i\xb0]Yz\x95\xa1\xab\x04Q\x1d\xc7C\x92\x83\bA\xe9=\x97K\xc3ze1\xdea\x9dgZC'\x92tu\xc4jYp97\xc4JӍ\x14\x8d(B\x02\xb1\x148\x0f\xfbӈm\xe38\xd2n2\x1d)\xce\xf7rNfѱ\xd7Ov\u007fo\xa1*~\xffT\xbby\xac\xddns\xf7\xddw\xf3\xa1_\xfbu&\xba]\xf0\u007fO\f\xb2\xd7;\x8e\x1f?Χ>\xf5)^\xf3\x9a\xd7\x00plaN\xdc\xf3;\xbf\xfd\xf6\x8e\x94\xbf\x9f\t&\xd6\xf2\x82\xc2Xn\xea69\xdb\xcfqֱ\x18G\xe0}\xf8\x03\x14ށ\a\x85\xa4p\xb0R:\x84\xf0\f\x9d\xa3\xab\xa1\x1d\xc1d\x1c\xb1Q\x95\x9c\xed\xe7\xb4҄v\xac\x99Jc\x14\x9en\x12#\xa5\"\x13\xe0\x85\xe4\xd4T\x97\xc9H\xf1\xd0\xda\x16GZ\t/\xea\xb4\xef:\x88\xbb\xef\x1b\xbb\x8d\xef>}\xf0\x00oy\xcb[\xf8\xc8\xef}䚂\xe3\xeflR\xdfk\x8cS\xa7N\xf1\xbb\xbf\xfb\xbb\x9c>}\x1a\x80}\xddv\xa3%ſ\x9d\xd0\xea]\x18\xa3\xa4w(\x0fg\xfb9\x8bi\xc4M\x9d&O\xf6\x87\xdc\xd4n\xb0\x18iV*[\x87\r\x8f\xf3\x9e\x96T\f\x9d\xa5\xf4\x8e\xaa\x14Lk\x85\xf3\xd0V\x12p8b\x96\xf2\x82N?\xe7D\xa7\t^#\xbd\xa32%s\xcd\x16\x97\xb7\xfb,\xf5\xfa\x1c\x9b\x9e䦙\t\xbe\xb0\xbc\xc1C\xab\x9b\xbc`v\x8a\xc2T\u074b\x83\xfeo.\xe6\xdb\xdf\xf0\x8d\xad\xec\xff\xf8\xb3ް\x9c\x9f\x9f\xff\xbb\xed!{\x8dq\xe7\x9dw\xf2\xb1\x8f}ll\x8c\xc3\xdd\xf6\xc1\x14>\xde\xc1\xff\xd0\xd1H\xaa\xccY\x140\x9d\x84\x10s5\xafx\xba7`1\x89Y*\f\x99\x10\x1cI4\xd3R\x92\"\xa9\xbc\xa3o\r\xca\v\xb0\xb0^\x94\\*JJ\xef989\xc1l\xa4\x98\x89\x04\xd3i\xccc\x9b۬\x1bG\x1a\xc7t\x1b\r\xa4\x10\x18Sqd\xaaK,\x04\x0f.\xaf\x92E17Oup^p\xa9\x9fs\xeb\xcc$\x1d\x1dI\xe3\xecO\x1dlD\u007f\xf0ډ\xc6\xe4\xffR\xa9\xff\xb7-d\t!\x90J\xf1\xc1\x0f~\x90#G\x8e\x00\xb0\xd8j\x9e\xd4\xde}r\x0e\xf7\xd2Y\x01\xbd\xa2\xa2r\x9e\x854f:\xd6DB\"\x85`\xab\xb2|n}\x87^U\xb1UY\xb6*ǔV\x1c\x8a5\x87\"MC\x82Ţ\x14\xb4\xb4f\xbb4\\\xca+\x92(\xe2\xd8\xdc,\x93Jrs\x1a1\x93\xc4|\xea\xf2\x12=\xe7I\"\xcdL\xbb\x83@\x90\xc51\a:-\x12!xpe\x9d\xb9v\x8b\x9b;)\xdbÜ(\x8a\xb8m\xb2Ͱ4\xf4\xcb\xeaճi\xfc\x87\xaf\x9ej\xcf\xff\x9d\rYB\x88`\f)\xf9\x8d\xdf\xf8\x8d]\xcfh5\x0f\xce\xe0?\xde\xf6\xfe\x90\xf2`\x04\f\xadc:\x89\x98I\"\x9c\xf3$2\xf4\x1a\b\xf0\b\x9e\xec\x17\x9c\x1f\x14\x9cj\xa6\x18\xa7i(IC\nf\xb4B\x00\xc6{\xb6\x8c\xa5P\x92\xcby\xc1\xa3\x9b[|ӡ\x03\xec\f\x06\x98\xc1\x80\x9b\x1a\x11\xf7I\xc1\x9f^\xbc\xc2w\x9d>\xc5p0 \x89\"\x1aIBQU\x1ci7\xb9om\x8b\xb5\xbc`\xaa\xd9\xe4\x98s<\xb1\xb6\xc57,Lq\xac?\xe0JQ2\xac\xaa\xdbg\xa3\xe8ï\x9b\xe9\xbc\xf6c\xab\xdb;;;;\xdcs\xcf=\xff\xf3\x03՚\xbb\xee\xba\xebo\xd6 cc(\xc9\a\xde\xff\x01\xde\xfaַ\x02\xf0\xbcv3\x9b\xc0~8C\x1c\"T\xb2\x94\xd6\xe1\xbdg&\x89\xf0\xde#\x054\xb5D\x14\x1e\x11Z\x15\x00\x1c\xf0H?\a\xa0\xa1$G\xb3\x98H\b\x1c\x82DJ:J\xf2\x82f\x8a\xf7\x9e\xfbW\xd69\xd4\xed\xb2\xaf\xd5b\xc39\xb2\xb2\xe0\x8e\xf9I\x1e/J>sy\x99o>\xb4\x0f\x9c\xa5r\x8e\x89F\x83\x03\x13]\xe6f\xe7\xf8أO\xf0\xed\xa7Orlv\x86{\x1e{\x12\x11%\xdc43\xcd\xd6\xd22Ǜ\r\xfaֽ\x94\xb2\xfa\x9do\x9aj\xbf\xe9\x93\xeb;\x03\x84\xe0\xedo\u007fۨ\xd6\xc0y\x87\xafK\xeb\xd1\xc7m/|\xe1\xd7d\x10\xf9\xd7\xe9!o\xf8\xb67\xf0c?\xf6c(\xa5x\xc1̔hy\xfboc\xb8M\xe0\xf1x\x84\b\xb7[\vA\xbf4x@\n\x81u\x0e\xe7ǽ#\r%I\x95\xa4\xa1\x14m\xadH\xa4\xe4Ja\xb8P\x18\xce\xe5%\x8f\xf7s>\xbf3\xe0\xf3;Cv\x9c\xe7\xdb\x0f\xef\xe3lQњ\x9c\xe2\xf4ɓ\xcc\xcd/r\xf3\xfeE\xdez\xe6&\xce\xf5\xfb\xac\x15\x15\x8b334\x95\xe2\xc0\xd4$\xfb\xe6\xe7\xb9\xf3\xf9\xa7\x98i\xb7xjs\x87SG\x8frlz\x92\xa7\xb7vx\xed\v\xceP\x18CSI\x0e\xa41\xcfof\xdfr(\xd6\xff\xee\xce\xd9)\xf9\xa67\xbe\x91_\xfb\xb5_\x1f\xf7'\xe3\x9c)\xfe\x16\x85\xacQ\xcex\xe3\x1b\xde\xc0\a?\xf8AZ\xadV\xc0\x83\xf2\xe1w\v\xf8!M\xa8^\x85\x007\xea-\x80\x81q4\xb5\x1f\xbf\x18_\u007f>\x11\x12-\x04\xddH\xd3T\x8a\xb6\x96$B\x90)I&C\xef\xbfn,C\xeb\xb9RY>\xb5\xd9\xe3\x99\xd2\xf0\xc3/\xbb\x9d\xf3\x1b\x9b\xdc8;\xcd\x16\x82\xbc4ܐ$\xbc\xf2\xe4q\xeey\xe2)n;r\x90\xa9\xc9I\x9e\xb9\xba\x84\xee\x0f9\xa947/\xcc\xf1\xf1\xc7\xcfr\xcb\xc5K\xcc62>s\xf5\"[\x9b\x9b\xb4\x94\x86\xfa\xa95\x95\xe4D#y\xa7\xce\xcb\xd5\xdb\xe6\xa6\xfe\xc9\xe7\x97֜w\x8e\xef\xff\x81\x1f\b\xafE\n\xbc\xf3\u007f{\f\"eH\xc8w\xdf}7\xb3\xb3\xb3!Tu\x9a3\x19\xfc\x1b\rb\xd4\\\x89\xfa\xd0U}\xfaJ\x80q\x1e%<\x03\xeb\u0093\x91\x02-\x05\x93\x91\xe6@\x9a\xd0֊X@*\xc4\xf8\x175\xa4`Q+\x12\xa5\x198\xcbӮŽK\xab\xfc\xe6\x03\x0f\xf1\xc6\xe7\x9d\xe0\x8f\x1e\u007f\x92\xc4\x18.m\xedp\xf6\xdc9\x0e\x1f>\u0095^\x9fG\xaf,\xd3V\x82\v\x9b=\xd6\x06+\xf4\xb6\xb6\xb0E\x8e\xf1\x9e/<\xf14\x87\x1a1\x85\xb5\\\xb8p\x81\xc2:.\x0e\x86\x9ch5\xf1\xde#@\xccF\xfa\xa7\x17m\xd9{\xc9\xf3N\xbe\xff\xcf\x1fz\x98s\xe7\xce\xf1\u007f~\xe0\xfd\bB\xb8\xfe\xeb\xa2\xc2\xf5ל;d\xb8\xe2\x1f\xff\xf8\xc7yы^\xc4/\xff\xd2/\xa1\xe0\xeeX\xb0(}\bV{\xbdZԦ\x89\xa4\xac\xfb\v\xc1veQ\x02:Z\xd1T\x8a\xf9$\"Q\xe1\xfb,P\x01i\xfd;J\xe79r\xf8\x10/8y\x92ޠ\xcf\xfc\xa5\xcb<\xbe\xbe\xc1\xc3K\xcb\x1ci5xtu\x9d\x93\xed6\xb1\x14\xac\x0fr\xb2\xb5U<\xf0\xc5\v\x978\xd5m\x93W\x15\xfd\xd2p~c\vo+<\x9eͼ$\xb6\x15\x95\xb7\\\xe8\x0fX\xae\f\x1b\x95\xc1!\x98\x88#\x94\x14dRp4\x8e\u07b7\xbe\xbe\xfa\xa58\x8e?\xfc\x13?\xf1\x13<\xf5\xd4S\xfc?\xbf\xf9\x9b\u007f{\xca^!Dpm!8u\xea\x14J)~\xe9}\xff\xfc`\x82\xff\xc7#J\xc8\xefy\x90\x91\x97h)\x115T>t\x8e\xdcy\xbaQ\xa8\xa6R)\xe8U\x15\x17\xfaCr\xe7\xf0@\xe5=\x06(=\x18!8\xbc\xb8\xc8\x03O>\xc9=\xf7?\xc0}O\x9f\xa5\xa9$\xdeY\xee[Za6Kypu\x8dKyI\xee<\x8f\\]A\x00\x976\xb7yfu\x9d\x9d\xa2\xa2\xb2\x96\xf5\xc20t\x9e\xa1\xb5d\x12\xce\xe6%\ab\xcdg6{\xa4R1\xdf\xc8\x18\"\xb9\x98\x97\\\xca+6J˔\x96\xf2x\xac\xfe\xaf\x17Lwg\xd34\xe5\xbd\xef}/\xb333\xffc\xee\xe7zy\x88\xa8O\xd4\xe3\x99_\x98\xe7U\xafz\x15\xb7\x9d8\x8e\x84\u007f\x99A\xe7\xd9(/\"\x94Y\xde{2\xa5\xa8\x9c'\x93\x92\xb5\xb2B\x00\x99\x94DR\xd0P\x12\xbc'\x96\xb0U\x19\x1a*&\x02\x8c\x87\x81s\x9c>|\x94\xb3\xe7\xcf\xf1\xe9s\xe7\xf9b\xbf`\xe0=\xd6{\xe24aX\x96<\xb0\x92#\x11l\x1b˶\xb5\xa4R\xe2\xbcg\xab*\xb92\x90\b!\x88\x05$\xc2q5\xaf0\xdesv\x98Sy\x8b\x16\x82\xca\xc3\xed\xdd\x16ϟ\xec\xb003C\xd6l\xe1\xb4\xe6O\x1fy\x94K\xbd\x1e\xfbc\xbd\xef\xb2q\xff\x14xω\x13'x\xef{\xdf\xcbO\xbf\xf7\xee\x10\xda\xfe\x1al\xf2\xbfFP\t\x90B\x06BH\b\xfe\xd3\u007f\xfcO\xbc\xe5-o\xe1\x86N\xf3\x86\x14\xbe\xd8\xc2'\xd6\xd7\xe5`\xedA\x82P\xd6*!\x98\x8a5Z\b\xac\xf3\xac\x14\x15\xa9\x14\xcc%\x11\rUs \x80\xf5\x9e\x89(\xa0\xb9IhΉ\x92\x94\x1b&'xf\xe9*\x1f\xdd\xea33\xd5e\xb2\xdd@g).Ҕ\xc62\xe8\x0f\x19\xe4\x05fX`\x8d\xc5\x1a\x8b\xb7\x0e\x9c\xa7#$-\xa5\xf1xz\xc6p\xb5,\xf0uѡ\x048\x04E\xfd\xd8)\x82\x89H1\x97\xa5\xbc\xed\xf6\x171\xdfH\xf9\xff\xee\xfd\f\xcbEɒsk\xcf\x18\u007f\xf4\xd3\xcb\x1b;\x17/^\xe4ĩ\x93\xf5k\xf5\xbc\xf0\xd6\x17r\xef\xbd\xf7^_\x0f\x11{J\xa3o\xfb\xb6o㮻\xee▃\xfb\x85\x84\x9f\xc9\xf0\x89\xe7Z\xa0t\xef\xc5IU\b`\x91\x10,\x95%\x10\xc8(!\x022+\x80\xdc:&\xa3\x88\xa8\xber\xb9\x0f\x0f\xb6\xa0$WV\x96y\xd8z\x0e\x1f\\\xe0\xe8\xd1}\xec;~\x80\xc9\xd9i\x84R8\xe3\x90Rb\xac%\x1f\f\xe9\xef\xf4\x19\xec\xf4\x18\xf6\x87\x94e\x891\x96\xde0gm\xab\xcf\xd6\xea&Ŗ\xa52\x16)\x05Z)\xa4\x14\xa4Ja\x9dc\x90Wl\x97\x86\x8be\x8f\xf3\u007f\xfeY\xfe\xdd\xeb_\xc9ko\xbd\x85\xdf\xfd\xfc\x17h\x94nzV\x89o\x06\xfeˁ\x03\a\xf8'w\xbf\x97\x9f\xfd\xf9\x9f\x1bW\x91\xd75d\x8d\x9a\xc0\xd1\xc7\x0f\xff\xf0\x0f\x93e\x19\xd5\xd6\xe6\xed\t\xbcE\xd5ƈ\x84\xc0x\xc6I}d\xc8TJ2)\xd96\x16\xe7\xc3\xf7%RА\x92Q\xf3H\xcd\x18\x8a=\xb1y\xbeѠ*J\xcey\xc1\x91\x17\xbf\x80\x9b\xce\x1c\xe7\xe0\xb1}\xe8$Ah\r\b\xac\xb5T\xa5\xc1\x18\x8bw\x16\xadB\x88\xb2\x95a\xd8\x1f0\x1c\f)\x8a\x82\x8d\xed>\xab\x1b;lm\xf7\xd9\xdc\x19\xb0\xb4\xba\xc1\xe5\xcb+\fzC\xa4\xb4$QD\x92h\xac/\xb1\x1e\x96\a\x03\xfe\xe4K\x0f\xf2\xfc}\x8b\xbc\xe4\xd81\xeey\xfc\tz\xd6\xfd\xa3\xd7\x1dX\xf8\xaf\x1f\xbbxտ\xf9\xcdo\xe6g\u007f\xfe瞛\xae\xbe\x9eU\xd6w|\xc7w\xf0\x8aW\xbc\x82\x1b'\xbb\x12\xf8\xd9Tx\r =()1\xce|Y\xb2K\x94\xc4\x03=c\xc7]x\xaa$\r\xad\xa8l\x80ٻZ\xa3$\xa4u\xae\xd9\xd7h\xb0\xd9\xefqI)nz\xe5K8x\xc3qN\xddx\x94$M\x11R\x02\x82\xca\x18ʢĦ\x16\xbc\x03!p\xa3.Z\b\xb2N\x9b\xaa\xaa\xa8ʒvo\xc0\xec\u0090\xc1\xa0 \x1f\x16\f\x86\x05\xbd\xfe\x80\xa5\x95\r\x9e|\xe62W.\xad\x00\x82F\x1a3\xc8+\x90\x82\x8bÂ虳\x1c=|\x84\xe9$fc\x98\u007f\xeb\xa47\xaf\x00\xfe\xdb\xe9ӧ\xf9η\xbc\x95\xff\xfcۿ\xf55S(\xfak\xf1\x8e\x1f\xfd\xd1\x1f%\x8a\"\xbc5ߒ\xc0\x9d\xb2\x0ec\xa3\x9c\xe1\x9f\x15\xae\")\xd0\"0\x81\xc6\xf9q\x12\xefD\xea\x1a\x835T\xe83\x1c\x9en\x9a\xf2\xd0\xfa\x06S\xf33\xdct\xf3)\xa6\x0e\xed\xe3\xe4\x8d'\x99\x9a\x9fFH\x89G\xe2\xac%q\x0ek\rUY\xe1\xac\xc1\xb9\x00\xd18\x17z\x1c\x978\xac1X\x93\x9252\xfa\xbd>Y\x96SU\x15\xa62\xe4E\xc9\xcct\x97\xa3\x87\x16\xb8xe\x8d/\xdc\xff\x18\xbd\xed\x1ei\x02\xbda\xc1}\x1b\xdb\x1c_\x98♫WY\xecv\xb8\x90\x17\xd2\xe0\xff\xd9\xeb\x0f\xce\u007f\xe2\xa3\x17\x96\xfc\xdb\xde\xf66\xfe\xf3o\xfd\xd6ߜ\x87\xdcu\xd7]\xbc\xece/\xe3\x05\xf3\xb3\x02\xf8\xe7\x99\xf0bd\f\xeb\x1d\x129v\xe1\x91ab)\x90B\x8c\x1b\xc1D\x8e\xbcCSZ\x8b\x14\x01\xa7RB\xa0\xa4$֚\a\xd77\x89\"\xc5\xec\xe1}$\xfb\xe68u\xd3Iff'kc\x84\v\xa2u\x04R\x12\tH\x9c\xc7Y\x83)K\x9c5\xf8\xda0\xdey\x9c\xb3!\xce{O\xb7Ӣ\xc8\v\xf2<\xa7\xdf\x1f2\x1c\xe4\xa4I\xcc \xcbɲ\x94\x99\xe9\x0e\x9f\xbb\xff1\xce>u\x11-\x05\x17z}Ζ\x1d\x16)\xe9NNА\x92\x9es/\xd7Uy\x00\xb8\xf0\x82[^@\x14E_sВ_e\x02\x19\xbb\xe4\xf7\u007f\xff\xf7\x13E\x11\xd5pp{*\xb8S\x01R\n\x1c\x0e%ŵ\xae['\xbbHJ<\x9e\u0085\x12Q#\x88e\x80J\xac\xf7()\x82X!\x8ah\xc61\x97vzl\x1b\xcb\xec\xc2,\xea\xe0\"GN\x1e\xe1\xe0\xe1\xfdH\x9d u\x8aT1JF(\x1d\xa3\x94FJ\x85R\n\xa5#\xe24%\xce\x1aDi\x8a\x8e\"T\xa4\x89\xe2\x98(\x8aPZ\xa1\xa2\x88$KI\xb3\x94v\xbb\xc9\xe4d\x87\xa9\xe9\t\xba\xdd\x16\x13\xdd\x16\xb3S]^\xf6\xa2\x1b\xb9\xe1\xf41\"\xad\x90\x02>qu\x958I\xb0\xc60\xdbȈ\x11\x11\xf0\x8d\x00\v\xf3\v\xfc\xd4O\xfd\xe4\xf5k\fG\xa1jt\x03^\xf6\xb2\x97\x8dn\xff\xbb\xb3\x80\x1f\x00ԡH\xe2ŗ\xd7f\x91\x14\x94.\x842%\u008f\xa4*\x18o\xf49\x0fL42.\xf5z\x9c\x1b\xe4,t\x9aL>\xef\x18\x9d\xc9\x0e\xc7O\x1dA'\t2\x8aA\x84\xdc1\xf2\x14\x10\b!\x11B!\xa5D(\x1d\x8c\xa44B\xa91\xaa \x95D)\x8d\xd6\x1a\x1di\xb2FF\x92\xc4H%I\x92\x88\xc9\xc9\x0e\xddn\x9bN\xa7\xc9D\xb7\xc5-\xa7\x8fq\xe6\xccI\"%X\x1b\x16<U\x1a\x8a<g\xb1\xdd\x1c\x85\x97\xbb\xde\xf5\xfa\xd7\x02\xf0\xa67\xbe\xe9o\xa6S\xff\x85_\xf8\x05fff8\xdci\xee\x8b\xe1\x8d#|\xca\xd7U\x95\x162(G\xf6t\xeb#\xac\xaa\xb0\x0e)\x82'H\x01\x89RT΅\xe6P)ZI\u00a0\xac\xb88șnf̟<B{f\x82c'\x8fЙ\xe8\x82\xd0\xe3\x8aMH\t\xb5a\x91\"4\xa0R\x04\xa3\b\x89\x90\x12\xa55JGH\xadk\x83\x89\xba\u007f\x92(\xa9\x91*\"m4H\x928\xa8U\x94\xa4\xd9\xcch\xb5\x1a\xb4[\r\xa6\xa7\xba\x9c>y\x98\x13\xc7\x0f\xa2%\xfc\xd1\xf9\xcbDiF\"`*K\xf1\x9e\xd7-\u007f\xf1s\x1d\x80[n\xb9\x85\xe3Ǐ_'\x83\x88ݢ\xee\x15\xafx\xc5(\x01\xbd9\x13\xbb}\x87\xf3\x1e-\xe5\x98\xcfxv\xb3\xae\xa5$\xafÕ\x14\xc1@\x91\x94\x94Α*\x85\x12\x82\xc9,\xe5\xfcN\x0f)\x04\xfb\x17\xe7h\xed\x9bcnq\x86#\xc7\x0f\x8d\x9f\xae\x17\x12\xaf4D)N'\xf8(ū(<\xc7QQ!$B\xd4\x06\x93\x02\xa9\x15\xa2.\x83\xc7פnl\xa5T$iB\x9c\x04q\x9cR\x924MȲ\x94F#af\xba\xc3у\v\xcc\xccL0\xac\f\xffme\x83HJ\x0e\xb6\x9bDRL\n\xc9KG@\xeb\x8f\xfcȏ\\_\x0fy\xdd\xeb^Ǎ7\xde\x18JV\xef_\xab\xf7\xb8\x80\xf5\x9eT\x85<\xe1\xbc\xc7\xed\x91\xf3\xa8ڠ\x95\xddm6b\x19\xba}\xebC\x98\xeb$\t\x83ʰ^VLuڔ\x9d&\xd3\v\xd3\xec;\xb8\x9ff\xab\x85\x90\n/\x15^G\xc1 R\x80\xaa\xff_'8\x9d\xe1\xa2\x04/\xd5\x1e\xac-\xb8\xae\x00\xa4T %\xb2\xf6,\xeaH+\x00\xad#\xe2H\xa3j5e\x14i\xe28\"\x8a\"\xb2,ean\x92\xe3\x87\xf7\x91\xa41\u007fqi\x89Ǉ\x15ӱ\xe6p\xa7\x8d\x94\xf2\xb5\xa3\xf3y\xf1\x8b_|\xfd\f\xe2\x81\xef\xf9\x9e\xefAk͑\xe9I\x99\xc1\xcd{\x10v\xacw$Ra\xf7\x84+'vK\xdeQ\xfe\x18\xfd\xb2XIJ\xe7\x91B\x10\u05f7w\xad(iF\x8a2R,\x1c;\xc8\xf4\xdc4\v\a\x16\x11:\xc2+\x05\xaa\xce\x1fB\xe0\x85\xc2K\rR\x85?*\xfc\xed\x95\xde\xf5\x98\xd1\xd7\xeb\a\x15R\xd6\x1e\xb4\xa7\xfe\x93!\xfcI\xad\xd0Z!U0\x9a֊8\x8e\xd0Z\xd1\xed4\x99\x9f\xe92\xbf\x18h\xf6?|\xea\x1cF\xc7\xccD\x92D\xe9oy˱\xfdrD\xe1^W\x0f\x19q\xe5qU\x1eS\xb0o\xaf\xb1FU\x92\xa9\xbdb|\xfc\xf5\x81\x17\xce\xed\x02p\"\xf4\x1c\xc69\xb4\x94DB\xb0^\x96dZQ8\xcf\xcc\xd1\x03LLO0\xbb0\xcb\xe4\xd4$H\tJ\x83\x94x\xa9pQ\x82\xd3q\xf0\x18!\xeb?\xd7\x1a\xc8K5\x0eIB\xea:\xec\xfa:\uf330\xb8\xc0fzoǡ58\x90@k\x8dRjl\x98\xe9\xc96\vs\xd3H\xa5ة,\xf7^\xb8D#\x8eikq\xe3\xa0(O^w\xf8\xfd\xf6\x97\xbc\x84\xe7=\xefy\xc1 \u07bfR\x80\x1a\xd5]\xd6;ZZ\xe3\xeb\xd0\xf5l\f+\x12!W\xec\xf5\xa8\xa8ư\x94\x10\x18\x04\xb9\xb5ᶦ\tS\xf33LNO\xb0\xff\xd0\x01\x84Rx\xa1jϐ\xe1\xc0k/yv\xe1\uf16c\x8d\xb2\xfb}Bʺ\x00\x90\xbb\xa4\xfd^\xdcɹ\xba_\t\x17f\xe4AB\n\xa4\x14(\xa5\x88\"M\x14)\xf6\uf6e3\xd9mc\x9c\xe3\x81\xd5M\xb6\xfb}\xf6\xa7\x89\x98\x8f\xf5m\x00e\x8d\xcf]\x17\x83\xbc\xfc\xe5/'I\x92\x90\x13\xe0%\xa3\x1772\x82\x94\x12\xeb=\xcf\x06\x17G\x15\x96\xd9\x13\xcaFI\xdd8\x1f\u0095\x14\xc4JQ\t\xc9\xc4\xec\x14I#eva\x96\xce\xc4\x04>\x9cθ\xd4\xdd\rA\xbb\x84W\xf8/䅱Q\x84\x04)kVO\xee\x86+YS\a\xde\u176d\xbd\xc3ו[\xa8\xb4\x84\x14\xb5\xb7\x84\xf0%\x95$\x8e#\x9ai\xc4\xc1\xa3\x87p\x1e6J\xc3\xe5ANC\n\x0e\xb7\xb2\xe7C\x98=\xb9\xae\x06\x0181\xd9QRp\xe7\xe8v\x8e\x92\xb2\x10uu%B2\xdf\v\x9d\x8c\x9a\xbf\x91\x121\x92\x12-%\xb6&\xacJ\x0f\x85Rx!\x98\x98\x9dBG\x11\xf3\xfb\x17\x90J!\xae\xf1\x0e\tB\xed\xe6\x01Q?\xa0\xe0Z>B\x84\xd0\x16\x8cȞ\xbcQ\u007f\xa3\x18!\t\x81~\xf5c\xc8G\xa0\xa4\xfa2\x98H\b\x81\x8e\"\xb4\xf4LOvj\xd5\t<\xbe\xb9\xc3NQ\x12{\xff\xb2\xefy\xd5+\xc6P\xcdui\fO\x9c8\x11\x9a?c\xf7)\xc4QQ7r\xd6;b)kN\xc1\x8f\x99A\xbf\xc7\x1bB5Ug\x16\xc18\x89C\xe0:6\x9c\xa3\xf2\x1e/\x04Y\xbb\xc9\xcc\xfc4\x13SS\xe3\xc3\x1d{\xe3\xc8;<\xe0\xc5\x1eW\x14\xbb\x87=z\x06\xa3d?\xaar\xfdns\x1bl\"\x19\x9f\xecH\x89\x01xQ\x93M{\xb0\xb0:\xf5\x10iI\xb7\xdd\x04<\xa5\xf7,\xe7\x15\x9be\xc5L3{Q\xe3\u0093\xe9L\xcd ~\xdd\rr\xdb\v_\xc8\xe1Ç\xeb\xe7\xefo\x95A\xa4>\x86<d}\x10\xa5\xf5\xa1)\xdb=\"\xa4\x10{\xce.|6QA\xf4֊#\xa8\xb9\x88\xca9t\xab\x01\x91\xe6\xc0\xe1\xfd{\x86f<¹\xdd\xfc\xf1,\x04\xe09u8\xde\xd5yb\x84\x04\xf8q\x91\xe1Ů7<\xbb\x8c\x1c\x11M\xce:\xaa\xca\xe0\xac\xc3Z\x1bF\xdd\\Б5\x1a\x19Q\xa40a\xb8\x8bK\xc3\x1c\xe1\\\xfb\xf5\xfb\xe7o\xbenI\xfd\x9d\xef|\xe7n\xfe\xf0\xbcd\xf4\x83\x16\x87 @\xdd\xfd\xca\xe0\xc5n\xcf!\xf7\xdcF\xadԞ\x03\xf0D*\xa8\x1cg\x9b\x19W\a9\xceC\xa2\x15\xbe\xd3 m7\xd9w`!\x1c\xe0\x88uD\"\xbdD\x8c\x93\xb1G\b\x87\xc0\x8e\xfd\xd1;\v\u0381\xb38\xbb\x9b\xa8\xfd\xe8kc/\x10u{\xe2\xc6\xc9=x\xab\x1f\xe7%SUT\xa5\xa1\xac\xaa\x1a%\xb6x琵\xbelrnf\\\x1b\xac\xe5%[y\xce|3\xbd庡\xbd\xa3iԛN\x9e@\v\xff\x8d\xb2\x8e\xcd\x1e\x8fDb\x9dG\xeb\x102\x94\b\x033\xd4\xde!\x80L\xeb\xb1\xdf\vD(u\x95\"њ\xa1\xb1\xa0\fͬ\xc1PG\xcc\xef[ k4\xf1Ƃ\xd2\xf8\xa2\xc0\xfa\n!r\x84\x8a\x83\xf0\xc1\xe5T~\xc8\xf6V\x9f\xbc\x84f\xb3\x8dV\x11\x91\xd6\xc4\b\x92X\x83\x12A\xb7b\x1c\xbeVL\x8eS\u007f\xed\t\u07bb\xb1\xd7x\x1f\x80Qk=RILUQ\xe6%e^bk个*\x8aA\xb9\xdbl\x8a\xa0\xc6\\\x19\x16\x14\xc6>\xff\xba\x18$\x8a\"Μ9\x03\xc0\xfa\x95\xcb\xed.\x9c\x11{\xbap)j\xf6C\x8aq\x00\x19\xb3u\xd6\xe1=\xb4\xd2\x14\xeb\xb7\xc7\xde\x13\xa9\xe0\x1d\xc3\xca \x95\xa2\xb0\x0e\xa7\x15Q\x9ap\xe8\xf0~\xa4\x04\xe7\f\xae\xcc)\xf3\n\x8bd{k\x87K\x97\xaf\xf2\xc0\x03\u007f\xc9\x13\x8f<\xca\xda\xf2U\xaaʀ\x87\xd2X\x84\x8ap\xd6p\xf3\x8b^̙37\xb1\u007f\xdf\x1c\x8b\v\xd3\xcc\xceN\xe2\x9d\xc1Z\x83\xc3\"\xa5\n\a\xec]m\x10\x0f\xd6\xe2M\x85\xf3\x8e</\xb9rq\x85\xcbWV\xd9\xda鱲\xbe\xc9p\x90\a\x95\xa5P<\xf0\xd83\xac\\]AK\x89\xacч\x9e\xb1\xe4Ƽ\xe4\xba\x18\xe4\x9a9\t\xe7o\x8c\x95\xe8\x8c\xf2j\xe8\xcc]\x90\x88\xfaPIi)\x89c\x8d\xf0\x82^\x95#\x95$\x8b#\xe4\x9e\x00\x99E\x9a\x894\xe1\xfcv\x0f\x84\xa0\xddH\xa9\xb4`j\xb2\xcd\xc2\xe24\xce\x05\x1av\xd8/XZZ\xe5\xd2ŋ\xdcwߗX^]\xe7\xf8\xf3O\xf2\xc2;^H\x99\xe7l\xacn\xb0|e\x99\xf3g\xcf3\xccs\x9cs<x\xef\xa7y\xf8ӟF\tI\xda\xc88\xf3\xd2\x17s\xc3\r\xc79\xb8\u007f\x86\x99\xb9\t\"\x1d\x8dÜ\b%!հ\xcfՕ\r>\xf1\xd9\a\xb9tu\x9d\xb3\x8f>\xc3\xe6\xc6\x06\xd68L\x9d?\x84\x94(\xa5\x02E\\cwR\b\x9c\xf7\xecT\x96ʺ\xe7}\xec[n\x17\xaf\xfb\x93\xcf\xf8\xaf{\xc8ړ\xf7^\x14\xd5\xc9@\x88@\xd5\x16U\xc5\x10\x8b\xb2!4\x15\xd6\x11\xc9\x10\xc6\x02\xea\xeb\x11B\x90E\x11\xc6Xb%i\xc4\t\bA\xbf2\x18\uf61f\xecP65\xfb\xe7[$\xcab\xcb\x01e\x99\xf3\xe4\x93\xe7YZZfv~\x81\xb7\xbc\xed\x8dDQB\xa4#\xbcw\x94Ü\xed\xd5u\x96\xae.\xf3\xe4\x81E._Zbye\x8d\xf5\xad-\x86y\x89\xc4R\xf6z|\xee\x9eO\xf2\xe0\xa7>Ñ\xd3'\xf8\x8e\xefx\rsS]T\x14\x8d\x13\xbd\x19\x0e\xb0E\xc5\xda\xea&\xff\xe5w\xef\xa1\xc8w\x9b\xbb(Ih4Z\xe8$&\xcd\x1aDQ\x84\x13\x8ah\xabǅs\x17\x91Bb}P\xf4\xe7\xc6t\xe6[\xcd\x16\xb0s\xdd\f\xa2\x05Sj\x0f\xb2뜧r\x8e\xc2\x1b\xbc\xf7(!k\xdcʑ\x17\x06I\xe8v=\x82F\xa4\x19:O7KPR\xa2\xa4\xa0\xc23\x95e\x14\xc213\xd1d\xba\x930\xdc\xd9 \xcf5\x97.\xaf`\x8d军N\xd3nO֒\xcd0ܿ\xb9\xba\xc1\xce\xc6&\xc3ހ\xdev\x9ff\x9617=I\xbb\x912\xb7ӥ7\x18\xb0\xb2\xb1\xc5\xc6v\x8f\xd2\x19\xcc\xd0q\xe1\x91'\xd9Y\xfb\x06\x1a\x02\x92X\xa3T\x10V\x94e\x81u\x8eՍ\x9d]I\xa8\x12\xa4YB\x926\x89҄4m\xa0\xb4F\x10`\x9a\xa9\xa9)\xae\x9c\xbb\x88\x14\x90[\xcfNe\xe8\x95U,\xe0 \xf0\xf0\xd7\xd5 kkk\xb4Z-\x92$!\x91\u242f\x91\\\x00k\x1de\x9d\x18Kg\x19\x18C7\x0e\xb7o\xb48@\x10\xfa\x93Xk\x8a\xd2\xd0NS\x9a\x91F\bA+\x8eA*\xac\x82\xc9N\x83n;\xa3\xb7\xbdŠp\xf4v\n\x94\xd6\x18\x03Ue\x02\x12`\f\x9b\xab\xebl\xafm0\x1c\f\xd8\xd8\xd8bcm\x83\xb5\xf5\r\xaa\xa2D\xd5\xd0~3KI\xa3\x88f\x9a\xb2\xbe\xdd\xc3\x18\x83\xeed\xf4\xfb\x03\xccD\a)C\x1d\ue725\xc8+.-\xad\xb0\xb6\xb1\xc1ܡY\xd67\xb6j5\xbe\xa4\x14\x06\x9c\x82r\x884\x1a\xad#\xb4\x8eq֏\xb1\xaf\xcayvLx\xed\xce\xfb\xe9\xaf{\x0eɲ\x8c$I\xf8\xdew\xbc\x1d\xed\xfdT\xe5\x1d\x89\x0e\x823\xeb\x1c\x16W\v\xa9\x05\xa5\xb5\f\xad$\x91\n\xa5<҅\n\xc6\xfa\xfa\xa0҄H)\x16\x1b)K\x83\x9cC\xdd6\xf7^]\xe1\xcc\xe1i\xa6\xa7\xbah\x15\x83SDZ\xd3Ƞ\xaa,ۛ[\fv\x06xk\x19\xf4\x06\fvzlmn\xb1t\xe5*[\x9b۬\xafo\xd0\xdb\xd9!/\n\xa4\x90\xb4ZM:\xed&B\bf&\xda\xcc\xcfL\x10'\tZ+V\xd76\x99\x9d\x9aB\xa9\xc0\xfa\x97\x95au}\x8b\xa7\x9e\xb9\xcc\x13\xcf\\fus\x8b\xb2\n\x12%g\r\x83\xdc\xe2\xab\x1dT\xa4\x98\xe8\xb4h\xa4)\x9dɔ\xaa4\xe3\x9e\xd2\xf8\x10%\xfa\x95\xa1\xb0f\xfe\xebn\x90\xd14깧\x9eBx\xba\xce\xd7t\xab\x0f9\xc2\x01gN\x1c\xe7ԁ}l\xf5\xfa\\]Yguy%\xd4\xf62\x14\xeb\xb9s(\xa5H\xb4DK\xc1T\x9a\xf0\xc8\xc6&\a[M\xd2F\xc2d;\xa3\xddiཧ\x99e\xc88A\x0f\v\xfa\xbd>\xbd\xde\x0ekk;\x94\xa5ag\xa7\xc7#\x8f>Ʌ\x8bW)ˊ\xb2\xcc\xd9\xdc\x1e\x80\xf7D\x12\x9a\x89f\x90\x17\f\x869\vsӤiB\xa7\xdb&I\x93\x1a\xb9\xd5\xc88\f\x8bZ\xe7\x18\xe6\x05\x1b[=\x9e\xba\xb0\xc4\xe7\xbf\xf4\x18\xdb\x03\xc30wT&\xf4\x1bY\x9a uİ\xa8\xa86\xfb\xec[l\xb1\xbe\xb9\xcd\xda\xda\xe6\xb8\x1fu\x84\xd2w`\f\xbd\xdaP\xd7%\x87\x9c\u007f\xf4a\x95Ꮝ\xda;\xe3\x1c\x95w\xec_\x98\xe3M\xdf|'\x87\x0f-\xf0\xf1O}\x0ek\x1c+++\xa1\x1c\xaecri\f\x91\xd6x\xebh\xc6\x11\x99\x96Lf\x19k\x83!\x93\x9d\x06\xadN\x834\x8eB\x9f\xe6<\x91T\xcc\xcf\xce\xd1k\f\x886\xd6)\xf2\x8aG/\x9f\xe3\xbe\xcf\u007f\x89\a\x1e~\x86\xcaz\xa2T#\xd3\x04\x8f\xe6\xccm\xb7\xa2\xb1\xbc\xeaU\xaf\xe4\x9eO\xfcw\xae>|\x1fRJ:\x9d6JkZ\xad\x06\xddn\x9bV\xbbI\x94ƨ\xbaQ\x8d\x93\x84\xc1\xb0\xe0\xf2\xd5Uv\xb6+v\nG\xa3Ӡ\x15GtҔ[\xefx)M\t\xf7\xde\xfb\x05\xae\\\xb9\xca\xe5\xab\xcb\xc8ʠ\xea\xb9HW\xe3`\xd6\xfbz\xf0\xe8:aY\x00ʹL\t19R\xaf\x1b\xeb08n:r\x98D)\xce=u\x9e\xad\xe5\x15ʼO\x9a\xa5c\xdc/\bق\x98Y\bO;\x89\xc1{\x0e\xb52\x9e\xd8ܡ\xd3Ji4\x93\x90d\xf18B\u007f\x10E\x8a\x89\xc9)&\xa7gX\xd87G^\x96\x9c[\xde ҂[n\xbe\x0153\v\xcd6S\xfb\xf7\xf3]o{\v7\xder\vw\xbe\xe4E\xfc\xe3\xef{\a\xb7\xde\xf9J\xd667\xb1Γe)\x13\x13\x1d\x9a\x8d\x8cF\x96\x86\xd7\x12)\xa2$B\xc7\x11EY1\x1c\x96\x94\xc6393þ\x83\x87\xc0\v\xac\xa9\xe8\xf7z,.\xceq\xfa\xd41\xd24\xc1\x14%V@\xd4h\x843\xa8I\xb7\xbcr\xe1\x82Z'\xae\x9bAbȂP\xa4\xaev\\\xe8h\xf7\xcf\xcc0\x1c\xe4\x9c}\xfa1\xd6ח\x18\xf6\xd6Q\xbe\xba\x06\xf7+j\xa5b\x1ai\x9a\x91\xc6\xf9\xa0~/\x04DiD\x92Fc\xb8;0\xaf6\xf0\x13Jҙ\x98bfv\x86o\xbe\xf3E\xbc\xf5[_\xce\xe2|\x87r\xb8C\x86%NSJc\xf8\xc8o\xff\x0em\xadhe)\xadf\xc6\xcd7\x9dF\xa5m\x9c\x14LOO0\xd1퐤i\xe0օ@)=.\xa1ˢb\xd0\x1f\xb20\xd1a&K\x91UI#K\xe9\r\n\xca^\x8f\xcb\xe7/\xb3tu\x99V\xa48\xb68\xc3\xd4\xe4$=\xe7qqB\xb5\a\xca\xc1Ca\xcc\xdc\xf5S.\xc2\f\x88H\bp\xce\xe1\b\xb7\xaf\x95\xa6Xk\xc8\a\x9b\xd4##h)\xae\x81\xff\x8c\xf7\f\xaa\x8a\xe9F\xcaD\x1c1\x97\xa5\xfc\xc9\xf9˨H\xd3j%h-\x03 \x88\x83\xda\x18\xde\x19\xf0\x16\x15\xc5t\xa7fH\x1bm\x841\xa4\x91\xe6\x8b\x0f=\x82\xbbr\x85~>Dg\x1d\x86;[\x14\x83>\u007f\xf6g\u007fΕK\x17Y\xb9r\x9e\xef\xbc\xeb\x9b8zh\x11[\xabOFS\xc2B\x04\x88])\x85\x94\x8a\xbc,\xe943\xbam5\xbe\x10[\x15\x94\x9d\x06;\x17/\xf2\xf0\xb9\xb34\xb2\x88[\x9f\xb7\x9f4\x8e\xe8;\xb1~\x00\x01\x00\x00 \x00IDAT\xcd\x13\x1bC\x1e}\xf0a2\x01Z\x04\x1c\x0e`=/\xa6\xae\xa7A2D =GTA\xac4^\x80\xa9\xf2\x00M\xb7R\xfa\x95È\x12ѫ\xa8\xaf;\xceyzU\xc5l\xabA\xa6\x14\xa9\x92<\xb1\xdd\xc7\th\xb7\x12\")0&H@\x8dsH\xe7\xb0x\x843H'\x91\n\x92,\xe6\xf0\xf3N\x90\xa41\xa7O\x1dfiy\x85\xed\xcd\x1d\x86\xc3\x1cc\f\xcdb\x85h\xcbrˑ):\xb7\x1eF\xc71*\x8e\x10Z1\xe8\xf5ɇ\xf9\xd8 \xd4|\x87\x10\x02\t\xccNuɲ\x94n+#Kø\xf6\xb0,\xb1֡\x94\xa4\x91\x86*\xad7ȑ\xfd\x8a\xfd\xb3S<\xea\xc1\x8a\xdd9KD(\x14\xae\x9bA<\x1c\x1a!\x9c\xb6\x06\u05fcw\xe4e\x89V\x9eX+&;\rt\xe5(\x11xz\xbb\x80\xa2\x80\xbc\xacPR\x81w,\rs\n\xe7hvR\xb4T\xa1\x00\xb0\x0e\x1c\x01\xf2\xf6\x9e\xca:\x94\xb4\b\xefp\xb6B阬\x91r\xe8\xe41\x8a\xc1\x80\xfd\xc7\x0e\xe1\x8c\xc1\x94UhJ\x95B\xe9ZYR\xff[i\x8d\xf3\x0e\xe7}\xe8e\xb4\xbaf\x8aV)M\x1aǤIL+KX\x98\xe9\x92$\xd18\xffI!\xd1*0\x86J\bz\x83\x9c\xe1\xf9e\xda:\xc2\x03ƅh02\xac\xfc\x1agr\xbfZ\x83\x1c\x11\x02\xbc\xf5X\x1f`\xf7\xa2\xac\x18\f\x06t&;\xe1I\xd5\x02\xb8\xd1\xec\xe1\x88$\x1c\rF\n))\xac\xe5|?\xe4\x98$\xd6cLh4\x91e\x8d\x85\xb2BW\x15^\x04\xfc\b\xa9\xc1;T\x92\xa2SE\x945\xb1\xe5\x10gJ\x10\xc1\x00\x81\x06q{\x14\xac\x0e\x81\xa7*˚\xf1\xd3H]+\x1ek\xa1C\x14E\xb4\x9a\x8dZm\"\x88\"\xc5d\xa7I\x9aDD:h\xc5v\a\x94<j]\xd0L\"zFՓŵ8P\n\x02\v\xe4\xe3\xebf\x10\v'b/\xc6L\x9a\x14a&c\x90\xe7D\xc9<R'([2j?\xf6N\xa7j%QJ\x8cgկ\xf4\x87x\x01Q\xa4\xb0\xb5\xf8z\xc4};\xe7\xc0\x1a\x8c1H\xad1\x95Aǁ\u0095Q\x1a\f\xaf#T\x94\x04)\xa9w\xb5P\xc1\xe0\xacٕ\xe9y\x875\x15κ\xf0|\xa3p\x88R\x89]\xf1\x9eVdY\x16\xb4Z@#\x8dɒ\x88V3\x1dK[G\xded\x8c%Ҋ,\xd6D\xa8 \xf2\xb3n\x8c`\aZ\xc0\x1f\xb9.\x069y\xf8\x10xn\xbdv\x04\a\xacql\x0f\x06\x18kiv\xe6\xd8ٺ\x8cw暭\f\xd4p\xbb\xf7\x9e\xc2Zr\x0fke\x89u\x9e4\x0e\xe1\xc1XG\xaad\xad0\f\xea\x15\xe7̘\xc3`$\x9e\xb0\x06\x11\xa5\x81\xd5\xd5#\xee[\x81\b\xa3B\xdeV8S\xd4\u007f\xf2\xb1\xea]\x8cE'b\xcc\xd1\b!\x10J\x92e)\x91\xd6X\x1b\x1eC\xd64\x82Rr\xcc\x06\x8ff\b\xe3H\xd1Lc\x94\x95()\xc1\xba\xe0\x1d\"\x8c\xc5U\xd6\xea\xebR\xf6\xf677S\x0f\xcf\x17\xa3fhįyX\xde\uec71\xbdC\xd6\xec\xd0\x1bV\xe4y\x89\xb3vWiS'>S\xf3\x16;\xd6RX\x8b\U0005ee1e\r1\xd6\x06\xef\xb0v\x9c$}eqU\x853\xf5X\x81\xb38[\xe1\x9dEH\x8d\x90\n!#\xa4\xd2H\xa5\x90*BE)*i\xa2\xe2\f!\x035,jՈ\x1c)JĮ\"BHI#KI\xe3\bk-\xfdAQ\x87\xbb\xfa5>ku\x86@\x10Eab\xcbYW\xcfM\x86\xfc\xa2\x85 \xb7\xd7K\xe4\x10\x16\x82vd\xed\xeb҇}\x86\n\xc1\x13\xe7/\xb0\xbc\xbe\x8e\x90\x1a+S\x8cu\xd8ʎ)\xd14\x8e\x19\x16U\xe0M\x9cc\xb34X\xe7\xd0q\x10\xa1\x05\x047\x98\xd8YK1\x18\xe0\x8c\xc1\x1aCU\x14TU\x895\x06gL\xa0g\xab\xbc\xce\x15\xa3\xbc3\xd2Y\xedN\xc5\xfb\xfaI#\x18+\xddU\xa4ky\x8f\x18\x1f\xb8R\x8av\xa7\xc5\xe4D\x87\xa2\xac\xd8\xe9\xe7T&\\&W\xf3 \xde\xef\x1a%\x94\xfb\xc1\xa3G\x15\x95\x94\xbbs-\xbd\xafq\xab\xc3Wn\x10\xbcb\xa4\x8c\xaa\xe7?FS\xb5\u0560\xe4\xfc\xca\x1a\xab[\xdb\xcc\xcd.b\xad\xa7\xaa\ap\xa8g\xa9\x06e>\xa6\xb4\xf3\xaa\xa2r.\xcclh5\xc6ļ\x0f/\xd8[K5̩\x86CLQP\r\x87TE\x8e5%\xb6\xaa\xc2\xdf\xe5\x10ƌ\x1f{\xf8w\x1f>o\xf2 \x8c\x18'\xf7:\x14\x89k\x05\x0fB\b\xba\xdd\x0es\xd3S\b<۽!\xbdA\x81sa\x8d\xe0\xc8\x18\xbe\x1e\u05ed\x8c\xa5\xa8\x1cyQ\xd6ڲp(\x9dHQy\xcf\xce\xd78\xd3\xf6\x15\x1bD\x89=\xf2\x19vW1I\x19\x14$\x8f\x9c=\xc7\xf9+W\xc9\xd2\x06\xcd\xd6$\xf5@\x15B@Y\x99:|y\xfay\x81\xb1\x96\xc2:\xd2D\xa3\xeb\x8d\r!\f\x8ex\xeeP-\x85\x92\xb6\xc0\x96%\xe5`@5\x18`\xca\x1cg*L\xd1\xc3\x16}\xbc\xa9\xb0\xb5\xc7x<Ζت\x1f\xb64x7\x96\xf8\b9\x12F\xd4\x06\xf1\xbe.U%\xddN\x87ɩ\t\x16\xa6\xa7\xe8\x0f\nַz\xe4e5\x1e\x8b\x1bi\xb7\x8cu\x94eŰr\f\x8b\x12!\tb\r\x11F\xf3,\x9eJJ\u007f]\x92\xba\xde\xd5\r\x8e\xd5\u007fa\xac \xbc(J\xc3C\xe7/\xd2\xccRT\x9c\xd2l\xb7Q\x9bC\x9c\xb3{t\xbeb|\xe3*\xef\x99k\xa5\xa1QC`\x9d\xa5\xaa\f\xd6Z\xa4\xd6c\x01\x823\x16+\f (\xea\xb8\xef\xac!J2*\xbf\x8dv\x16\xa14\xae\xca\xc3\xcf8\x8b\xf7\x06oJ\x84\b\u0560\xf3A\xca\xe3\xac%\x89\x13\x10\x10G\x11\xb1\x8aQR\xd1l\xb5\x99\x9f\x9bf\u07fe\x05V\xb7\xb6\xd8\xd8\xeaӟ\xea\x90D\xba\xe6\xf6\x1d\xce\xf9\x80y\x15%\xb9\x93\xacm\xed\x84)\xe2\xd1ԗ\x14TΣ\xb4\xbc|]\f\"k\xef\x1c\x95\x1d\xe2ٳ\x1fH\xae\xae\xac\xf2H\x96\xd1I\x13\xb4\xda\xedEF\xf5z\xf0*\x81\x03,\x9ef\x16\xa3\xe4\xe8\x90\x1dUYQU\x15R\x85\xa4)\xf6*\n\x85\xc0\xd4\x17\"\xae\x9bRM\x8a\xf1;\xc8(\xa9\v\x82\x8aц\x14\xe1=\x9e\xe0\xc5A-R\x92\xa6\t\x8d\xa4A$5\x89JQR#\x926\xa29\xc1\xc2\xfe\x01\xeb\xeb\xdb\\\xb9z\x95\xf5\xad\x9d=\x1e\"\x03\x8a\xeb<yQ\xd2\xcf+\x06\x06.\x9c;\x8f\xab\x93\xbc\x16\x92DI\x86a\xff\xd7\xd6u1\x88\x87\xa3\x12\xa4\xf3\xf5\xec\x87\xf7\xe3\xfa{TIi/x\xfc\xdcy\xf6\xcdL3\xd1l\x8c'\x96DM\xf9\x8e8xSw\xce#/C\xec&ɲ(QJ\xd7\xe3̠\xe38\xa8\xe9kØ\xaaڝ\x94\x92\x12!b\xbc)\xea\xc6\xd1\xe3\xbdٍ\xadu\xf7^V%eU\xd0ld\x94\xfd\x9cA\xe1HtA\x96\xa6\xa4\xd9$\xaa١}\xf0\x04\x876\u05f9pi\x9e\x95\xf5\rʲ\xa4\xacvǺ˲b8,\xd8\x1a\x94\xf4r\xcf\xca\xca\x06\xda\a\xc1\xb8\x14\x90J\xc9\xd7&\xb3\xfe\xea\x1b\xc3\xfd\xba.\a\xad\xf78\aZ\x86[8\x1eYC`\x04\\Y]\x1f\x8b\xe7F\xacڨT\x16B24\x81\xc4\tЈ$\xd2\n\x10\x01ηA\xfb4\x82[\xac1\x888Ƙ\xb0\xb9'\x11A\x1ag\x94\xaaK\xdd0G8J\xee#Í=[G\x94\x95\xc5\x14\x15\x8f\u007f\xee1.?|\x81\xfep\x88\x05&\x9a-\x92f\x83\xfd\xb7\xdcJ3\x96l^\xba\xc0\x95\xa7/\xd1\xc82\x9cu\x14E56\xea0/\xe9\rr6\a\x15\xeb[9\xceyb!\x88\x94\"\x16\x02%\x05\xdb\x1eR\u007f\x1d\r\xa2\x04\xe3e]B\x8e\x95\x9a\xe3.Ux\x81wa\xd1\xccյu\xbaY\x8a\xf6\xe0\xf7\x109J\n\x06\xa5\xa1r\x9eޠ$\x9aSh\xa5PJRU\x86\xb22\x81<\xaa\xe73B\x0e\xa9PQ\x843\x86b8 \xce\x1a\x88\xa2\fS\xb7:»\x91\xa8ڏ=N\x84zt\xdcѯ]Yc\xeb\xe2\x06˽u\x1e|\xeain9y\x8a\xb8\x113\xd8\xde\xe1\xf1O\xfc)\xce{\xd6˜\xf5j\xc8\xc2L\ac,e\x15\xcas%\x04[;\x03\x96\u05f7ɭ\xe2\xe9\xf3\x97\x105\x95 \b+\n{\xd6\xe2\xa3h\xbc\x8c\xe2\xebn\x10\x01\a\x14aU\x12\xde\a\xdc\xe696\xdcI\x1f\xb6P;\xef(\xca\n\x1d\xc7c/\x01?^\xe9WZOQ\xaf\xf9sc\xa0\xb2\x06\x00\x85\xc0yGZ7[\xd4\x1e\xe5kx\xa3*\xf2`\xc0R\xa2\x938@\xebҍg\n\xd9U\\!u\xc4\xdc\xfeC\x9c}\xecI\xf2a\x9f\xa3\xfb\xf6s\xf8\xe8\x01f\xe7f\xe8NO\xf1\xf9\a\x1ease\x93\xedA\xce\xd0\x19f;\x8d1uPV\x15҄\x8b\xb2\xba\xbe\xc5\xeaV\x9f*\x99\xe0҅\xcbH\xa0\x91F\x18爔`\xb9\xac\x98K\x92\xafyN\xfd+2\xc8?\xfd\xe9\x9fFÑz*o|\x80#S\x8cW\x0f\n\xean5\b\x1b\x9e}WD\xadpt\xa3\x92S\b\x8a\xca\x12E!\u007fh\x022[\x99zp\xa7(\xc6\xfc\x845fl\x10!%\xce:\x94\xf7ز\xc4\xc51\x12\x85\x90~\xac\xdb\x05\x1fF\xa2\xa5$k\xb6\xb9\xe35\xaf\xe3\xbeƟ\xb3t\xf6iZ\xed&\xf3'\xf7\xd1l69U\x1cfez\x82\a\x1f~\x8a\x86\x81,\xd6`=Ui\xc8\xeb\"\xa4\xd7\xcf\xd9\xdc\xeec\x91l\x16\x15\x95q4t=7R{P\xcf9\"%q\xcf1\x10\xfe\xd7n\x90\x0f\xff\xfa\xaf\xc9\x04N\xe3\xafŧ\xa4سn\xa3\x0e_\x91\x14`\xf6\xf4h\xde\a\xc9M\xddD:\x1f\x128B\xd2\xcfK\x8a\xb2\"\xd22\xe8\xa4l\xd8\xcc\xe3\x95\xc7ԞR\x14\x05i\x9a\xd4?\xeb\xc1\x18\x94\xd6\xe3\r\r\xce:li\x90\xa9\nU\xddHުF\bp\xbd\u007f+Iy\xf17\xbd\x82\xcbG\x0e\xe1\x86[t&\x9b\xecl\x0e\x88\x93\x98\xd9\xe9I\x8e\x1eX\xe4\xca\xe5\xcb\xe3ݐ\xb6\xac(\xcb\n\x80aQ\xd2/J\xa2\x89y.=\x19\xc2U3\x89(\x8d\tۍD\b\xc7ZJ\x8a\xaf\xb1S\xd7\u007f\xfc\xc7\u007f\xfc\x9c_8s\xe6\f\v\v\v5\x80X\xcdi\xfc\x89\xbd\xb3Q\xa3ѯ\xbd\x82j\xefC7<:<\xe7\x02\xf1\x1f\x86\xf9GJT\x1fԎ\xcesuc\xc0\xb1Z\xa5\xd1HcT\xbd\xbfQ*\x89\xd0\x1acl\xfd\x18\x82,K\xeaο\xee\xe6\x9d\x1b[\xdd9\xbb\v\xa1\x8c\xc8'\xa9\x10R펮\xb9\xa0!>p\xf48\x83\xb5\xf38,B\f\x03\f$\x15\x13\x93]6\xd7\xd7\xf6\xecH\xa9_\xad\xf7\xe4eEe,I\x9c\xf1\xf4cO\"\x81Hk\xf2z{iQ\x93XZHrB\xdfu\xfe\xfcy\x1e}\xf4ѯ\xde oz\xe3\x1bj%\xf7\xee\xe1J%y\xf2\xec3{\xbf\xef\x15\x1a\xa2kG\xd5vK^9\x96\xf2\xd7(i\x1d\xc3=`\xacGJ\x87\x10:\xc0\xec\xe3\xcdk\x82\xca8.\xae\xedpt\xae\xcb`X\xa0\xb5\n c)\xc6\xcb5\x9d\v\xdeR*I\x9a\xa9\xf1XZ\xd0\xfe\xba\xf1<\xc7\xe8\xf0\xf0\x02\xa9E\bW\xb5B\xdfU%RG\xe0\x1d\xae\x1a\"\xa3\x18%\x04i#\x00\x89Q\x1c359ɕ4#\x1f\xf4\xf1.\x10p\xc1!\r\x83\xa2\x04\x1d\xb3\xb63\xa0\xc8K\xd2\x1ae\x8e\xebaՁ\xb5\xb4\xa2hTi\x03p\xf6\xecY\xde\xf0\xc67\x06\bƘ\xe7\\\x92\xb9w\xc2l\x14Υ\x1c\x93Ja\xe1\x8bR\x92W\xbf\xe6\xb5L\xd5\xd3K\xbf\xf1\xa1\x0f!=\xafV5\x80\xf7\\;\xbaF\a<ީ(v\xd7Ό6\xb6Iv\x9f\xac@\x8c\xa1\x98\xbf\xbc\xb0Ae\x1d\xc3\xc2P\x14\x81256t\xed!$\x85\x851U=\xa3!\xeb=Z\xde\xfb\x80kբlo\xec\x18\xaa\xaf\xe1,ʢ\xa4ʇ\xd82\xa7\x1clS\xf67)\xf3\x1eB(\x9c\x93Dq\xc2\xd4D\x8bN\xbb\x8dV\xaa\x1eʒuY\xef\xb1ΒW%EU\x91N/\xf0\xf4\xb9\x8b\xf8Z(^9K\xa2d\xe0\x83\x9c\xa5\xa1\xf5h\x94\xa5\aa\xd6pL\x1d\xfcՅ\xd2\x1e\xe3ԼQ\xacԗ}\xe3K\xef\xb8c\xfc\xcef\xef\xff\xa9w\xabL\xf0\xeaѯ~\xb6\xa5Ş\xcep\xe4({\x87_\xae\xb1`\xcd9\xcb\xd1P\x8f\x10\f\v\xc3C\xe7\u05f8\xed\xf8<\xfda\x89\xf7\x90eɘԒq\x1c \x14+\xa8\xaa\x8a8M\x90ޏ\xb1+Q\xff۔\x05\x91\x92(\xad\x10\xdec\xcbb<\xf3h\x9d\xa3\x18\xe6l\xad\xae\xb3t\xf1\nK\x97\xafr\xf9\x91G\x11\x91\xa6;=\x83K\x1a\xdc\xfb\xf9\ay\xe6\xc9'I\xb2\x8c\xd9\xd9Y\x0e.̐\xa4\t\x83\xbc\xc4zO\x89\xe2\xe9\xbf|\xac\x0eW\x01\x10\x8d\x84\xa0_U\xa8Hь#l\xa0\xc6.\x03\\\xbdz\xf5\xcb\v\x9a\xff\xd1\xc69\xff\x1cI\xfd\xd4\xe9\x1b9}\xe3\x8d\xfc\xab\x0f\xbc\x9f#G\x8e\xf0\xe67\xbf\x19k\xecI-8\xe8\xfc\xb5\xbc\x80\xac\x93\x86\x94\"\xec\v\xab-nݞ\xa9\xa4=\xe3d\xa6\xae\xccJc\x902\xbc\xc1\x97\xaai\x89\xb3K\xdbL\xb4\x12N\x1f\x9c\xa64\x06r\x01iL\x9e\x97h\xad\xc7\xf9\xca\x18\x83\xa9\xaa\xf1p\xfeh\x1fV\xe0I\f\xf7\xff\xc5\x17\xc1\v\x16\xf6/\xa0\x95b{c\x9b\xab\x17.q\xf5\xecy6.^\xa4\x1c\fva\xfa\xfa9.\x9d\xbb\xc0S\xab\x1b\xac씔\x16\x066ggx\x89\xe9\x896\xaeF\xa6e\xd6\xe1\xdc\xd2*\xd6\x05tB\xca\x10R˲\xa2\x8f\xa3%#\x9aq4B0\xccs\xba\x82\xff*\xaa\xacvw\x82,\x8d\x85-s\xff\xab\xbf\xfa\xab\xbc\xe7=\xef\x19\x87,\t\xdf\x1dե\x9c\xf3{\xc3\xcen\xfe\xb0\xecZ\xc4\xef]\xd5 \xf6\xce\xee\x85C\xad\xac#\xad\xb9\x83\xb0\r(\x00\xf4\xf7?\xbdJ\xb7\x99rt\xbe\x1bv&\x16\x01\x88PyI\xb3\x11v\xbc[\xeb(\x8b\x8a$\xcb\xc2&\x87\x9a\r\xc490\x15\x87\x0f.\xf0ԓ\x17\xb8\xff3\xf7\xf3\x97\x0f<\xc2\xe5KW\x99L5\x0e\x81\xab\xe7\x04m\xbd3+\xdc\x1b\x8fǱQiN\xddt\x92\x85\x89&\xc2YV7\xb7(lE9p\x14\x95\xa1\xb58\xcbC\u007fp\xcfx\xd0H\x8a0\x01\xb6\x91W\xe8f\x98&N\xa3\x88\xdc|\xb9-\xae\t\x10_\xc1\xc2e\x9d\xb5\x9a\"\x8e\xf5q\x01\xff\xb2\xdf\xeb=t\xd3\xf3N\xfe\xc2\xc3O<\x95\x03\x1c\xed\xb6\x17\xb4\xe0\x87\xe4\xd8\x18\xfe\x9a1\xcb\xd1\xcd\xddk\x03?n\x02Ÿ*\x1a\xc5HU\xe3šDd\xbc\x11(U\xe0\x8c\xe7\xd3\x0f_\xa6\x91\xc5,N4\xb064\x96R\x88\xc0{\xa7I0\x8as\xe3\xc9X\xef\x03w\xe2m\tR\xd3i\xb7\xb8妓l\x1f\xd8\xe1\xe4\xe1E\xae\x9e\xbf\xc2d\xd6\xe0\xc0\xcc4Y\x9a\xe0\x90\xe4Ʋ\xba\xdd\xe3\xfc\xf2\x1a\xdb\xfd\x013\x9d\x16\x8b\xd3]6\xb7\xb7y䩳l\xed\xf4\xa8*\x1b$IH\xb2\xe9\x05Vwr\x86\xbd!\x00I\xa4\xeb\x8dG\xa0\xa3`\x8cN\x12\xa3\xeb*\xcf\\S\xf6z\xbe\xda\xc6]+)\xdf/\xe1'\x84\x10\xa3]\xbb\xff\xfb\xf3\x8f\x1d\xf9\xad\xf5啍D\x8a\x1fh\b1/<c\x02id\x10)v\xcb\xddq\xba\xa8)OW\xbf\xeb\x81\xd8sK\xbc\xaf\xe1\x96=\u0558\xaeKd\xa9\x04m%\xd8.,\u007f\xfa\xc0y^y\xcba\xf6M6q.H\x8cd\xbdo\xb7\x91\x05\x81\x83\xad\x13\xb8\xaf12L\xdds8\x8f\x8c4i\x96\"#M\xe1-\x8f\x9e;ϓ\x17.2;5\xc9l\xb7\xcbd\xa7\xc3\xfcT\x97\xb9٩0\xc4\xe9=\x1b[[\\Z^ak\xa7Go\x98\xd3/\xf2\xb0| \x8e\x99\x9d?\xc0\x9f~\xec\x13\xbb\xea͚r\xd6R\xa0\x93\xb0\x1br2K\x11uH\xb6\xfeY\xe6\xf8*\xdb\x12-\xa5\xfc\x19\xb9g\xd6LJy\xbc\xbf\xd3\xfbg#\xf6*&\xa8\xdb\xed\xb3\xf3G\xfd\xf7(G\x84RԍW\xa5\xea\xd1\xfe*\xefw'\xa9jn\x01\xc2\x01k\x11\xd0R%%\x8d,\x01r\xb6\x8b\x8aO~\xe9</\xbf\xf9 \xfb\xa7[8\xeb\x18\xe4\xc5x\x19A\x9c\xc4\xe3\x95}^x\xbc\v\xa2o\x8cC\xfa\n\x1dk\xd2f\xca\xec\xfc\f\xfdaN\u007f\x98\xb3\xbe\xbc\xc6\xea\xd6\x16\u007f\xe9\x1cBJ:\xed\x0e\xd3\x13\x13d\xf5v\xb8KKˬml\xd2\xcbsV\xb6\xb7\x03S\x18\t&\x17\x0eqqe\x83\xde\xd2\xca.\x12!%\xce[:Y\xc2\xd2`\xc0d\x9cЍ\xe3\xf1\x10\xe8\xdes\x1a5\xd2_\x8dQt\xac\xf5X\x023\xaa釽ޘ\x9e\x8d\xf1\xd8=\t]\x8c\xfb\x0ej#\xf81\xaak\x9d\xc7\xd6\x19L+\xb9;\xf7\xb6wF\xbcn\fG^\x14\xcb0\x97(\xa4\xa0\xddL\xf1\"\xa7_\x94\xfc\xf7\a/p\xe7\x8d\a84\xdb\tb\xbc\"ȋ\xe2~L\x92f\xe1\xe7\tI\u074c\xea\\\xeb)\x8bp蕔L\xccN\xb3\xbf\xaa\xb0\x02\x9ez\xea<\xfd\x9dP\x8a&뛜\xbbx\xa5\x16wCe,;\x83a\xf0\f\xe7\xc2ܼ\x8ahN\xcfs\xcfG\xfehL\xab\xaa\xd19\x8d/\xa5g:MH#\x15\xf2\x18\x90\u007fY\xa7.\xbe\xaa\xac\xae\x938\x0e\xbcD\xcd\xe6\xf5vv\xc6\xcd]\x82GB\xbd4f\xd7+F\x97\u007f\xe4\x15\b\x81\xaf\xdf\xcan\x94\xcc\xd5X(\x17\xb63\x8c\x9e[\xfdn\x03\xe3\x12Y\vI\xe5\x02\xc5\x1b\xc7\x11ӑ&\x19\f\xd9\x1eT|\xf2K\xe7\xf8ƛ\x0fqb\xdf$R\x80\xad\f\xf9p\xc0Ʀ\x02%\xc9\x1a\x19\x91ԡ\x11\xad/\x8duPYCa\x1c^i\xa6\x16\xe6\xc9ZMf榹ti\x89\xf5\xf5M\xf2\xbc`X\x94\x14y\x8e\xb1\x16k\xc3F\xd3\xf1v\a)\x99>t\x82\xb3\x97\x96(\xb7\xb6\xc6\xd3bJʺl\x17\x18ki'\x11\x87;-\x86\xc6Ҭ\xc5\x13\xe5sHI\xff*s\x8ch\xe4k\f\xa2\xb5B+\x1d\x9a\xa0\xbc`\xb0\xd3\v\xb5\xb6\x10\xa4\xb5\xe4l\xef\xc6\xe6\xd1*X5j\xd0\xeaG\f\x90\xc3\ue6a6\x91\x17y\u007f\xed\xbeF\xe7=\xa6^\xd3$\xeb\xf1\x04|\xf8\xfe8\x0eL\xa1֚$.\xd8\x1e\x14|\xf2\xfes\f\x8a\x8a\x9b\x8e\xcc!\x85g8\xcc\x11R1H\x12\x10\nK\x84\x8a\x01\xe7j\x11C\x10\xe4E5f棘\x89\x99\x19Z\xdd.\xfb\x0f\x1f\x84\xba\xfb\xbere\x99+\x97\xaf2\x18\xe4lm\xf7\xd8\xdc\xda\xc6TA\x9cG\x94\xa0\x9b]\xbet\xcf\xc7\xc2\xd8\xf3\xa8GP\xc1\x10i\xac\xa9\xac\xe5H\xa7\x15PkW\xd1Hc\x84\b\xfb\xbf\xbe\xcc\x18{\x97؉\u074b\xfa\x9c\x1e\xa2\xb5\xae\xe1nI1\x18\"\xadE\x03\xcd\xfa\r\x1e\xad\xdbE\xf8G\x89\x9cz\xa7\xd4(\u007f8\xeb\xc7\"\xb3QC'\xea\x9f\xf5\xb5L\xd4\xef\xe9\x13\xedh͟\xda\xdd\xf8\xe0\xbc#\x895\xcdF\xc60\x0f0J\x92D\xec\xf4s\xbe\xf0\xd8e\xce]\xdd\xe0\x8e\x1b\x0f1;рA\xbf\xd6WI\x9c\x97D\xb1\x0e\x8ba\xb4Bk1\xceK\xce{\xe2(\x0e{\xaf\xea(\xe0\\(\b\xf6\xef_`c\xe30\xcb\xcbk\xac,\xaf\xb2\xb2\xb2\xc6\xf9\x8ba\xee]t\xe78\u007fe\x99j\xa7\xc7^\x82#x\x86\x1bC2\x13I\xc2\xd0\xda\xe0\x8dIxC\xe4b\xaf\x87콱{ۀ\xe7 \xd2v\x93z\xfd\xcb\xf1\x1e\x9b\xe7D@,\x04q\x8d\x0f\x8d\x0eU\xd4\xdb\x19\xf6\xae\xff\x1eUO\xc6\xdaqu!\xc4\xee(\xc2\xc0XR%\x82\xf1FlVmF\xe7\xeb&\xa8\xd6x\x19\xeb\xd1Z17\xd3a0\xc8k\x86\xae ֚F\x1a\xb3\xb13\xe4\x0f\xee}\x8c3\xc7\xe7\xb8\xf1\xc8\\0xeh\xdb\xd0\xf9g\xed\x16P\x03\x94\x1a\"Y3\x8a\xb52F\xca0S\x18ԈAE91\x19h\xe0\"\x1f\x92\x0f\at\xdbM\x96\u05f7э\x0e\xf7\u007f\xe2\xe3H»2\xec\xed)\x02\xe8\xe8\x99H\x93P\xac\x00\x83\xca\xd4Sb\xf6Yŏĉ\xa0/\xf6{\xdddOn}v,\xd3\xe3\x15K\xd6\xe0ʒ(\x8c>\xf3\xec\xdeC\xb2\x17\xdd\xddEn\x9d\xf7\x18\xeb\xc7\xde2\xda\xe0P9Gi\x1d\xed8\n\xf4\xec\x18\b\x14{\x84m\xe1\xf7j\x19V\x85\xe3\x05\xddn\x93$\x89h\x14\x15\x8d\xac\xa0?,\xe8\xf5\xeb%\xc7y\xc1\x93\x17\xd79we\x9d[N\xee\xe3ľi\xbc)\xb1y\x8fj8I֝@\xb4\xdbD\b\xa2H\x8ei\x81\x90\xf3=^\x86C\xf2#6\xb1\xc6\xee\x8c\t\xa1\xaa4\x15\xd1\xd4\"\xcf\\^\xc2\xf4\a\x01\u007f\xdb+\x9bu\x1e)\x03ڐ(Mn-\xdb՞u\x868\x06fO\xbeUA\xa7,\x84D\xca\xfa\r\xc4\xf6rH\xfe\xd9\xc2\xdcp\xf6!\x8c\x14e\rg\x84rT8?^F\x16\x9a\xbc\xbd\xab\xa9F\v\\\xdc\x18f\x1f\xf9\xe3H\xc3[\x19KK\t\"\xad\x82\x9b\xef\x91\x02Y\xeb\xc63磥p\xd6\x05P1K\x13\xba\x9d\x16\xdb;}\x92$\"\xcb\x12\x1aY\xc2\xd6\xf6\x808R4Ҙa^\xf2\xf9Ǯ\xf0\xf8\xb9\x15n>\xb1\x8f\xc3\U000d6a6a\xa4\xca\a\x98r\x9af'LKeY\x90\xa4*\x1fVB9wm\x04\xf1\x84|R\x96\x15yQ2(=y\x14\xf1\xe0\xa7?\x87z\x8eru\xa4?\xee\x0f\xcb:\x028\xd6\xf3\x92\xe9$\x1a\xef\x85ج\xec5\x9d\xb9R\n[\xcbd\x91u5\xe8wQ\x8dgw\xefz$ͱU\x85\x064\x1e\xe9\x82\x0ew\x84\xdfʱ\x14ֳw\xab\xbb\xf7\xfe\x9a\xfd\xbc\x91\x0e\xe1\xa10\x96Y\x01&\xd2x!\x18V\x15\xad8b\x94\x8e*\xebH\xeb\x8eW\xec\x91\t\x19\xeb\x18\xe6%\xfb\x16g\x99\xe8\xb6\xc9\U000d2d4d-\x92ހ4\x89\xe8\x0f\x8a\x10\xca\xfa\x05q\x1c\x91\x17%\u007f\xfe\xc0Y\x1e\xed68yd\x91\xa3\xf39Ӄ\x01yo\x82\xa4٦\xd1n\xd1l\xb5\xc2z>\xad\xebeh\xc1s\xbc3\xe4Ü͍-67\xb7XY\xdf\xc14g\xf8\xec_܇\xac\x95\x8e{o2#\x9c\x8e\x00,n\x17\x15J\xc9zEz<F\xb9\x8d\xbc6/Ⱥ\xb4\xb6\xb5fY\bY{ʨGy\x96\x87(\xa5PRR\xf6\a\x8c\xdej¹\x91\xf42h\x9aƪEvo\xf4hy\x80\xb3n|\xeb\xe2Ha\x9c'\xf1\x9eT\nv\xb4\xa2\xb2\x96\xd28z\xbe\"R\x82H\x8d\x04\x0f~Ϧ\xab`\xe6\xa24l\xef\f\xd1Z\xd1\xe94\x19\fr\xba\xddfX\x06\xb3\xb4\xc6\xc6\xc66\x8d,\xa6\x91\xc5\xf4\a\x05\x83a\xc90\x89\xe9\x0fs>}\xff\x13<\xd4\xedp\xf2\xf0\"\x87f\xc2 i\x92\xa4\xb4ZM\x9a\x9d6*\x8a\xc9\x1a\x19\x8dF\x03\xa5\x14yQ\xb0\xb2\xbc\u0085\v\x97XYߦ\x1fu\xb9\xefKO0\\Z\xae\x97s\x86;\xaf<\x98=e\xea\b\x1d\xb8\xb8\xb5\xc3\xc1\xe9\x89=h\x84'\x11\xd0Vr\x15\xe0?\xfc\x87\u007fρ\x99)uim\xc3\xcaze\xa0\xad5\xc3B\xeerI\xceգ\x14\xa3\x86:K\x12\x92H\x93\xa5\tEQ\x8c\x80\xa8kJU!\xf6(\x16k\x19\xe9\xe8PG\x1e\x12\xd52:k\x1d\v\xd23\x88\xa2 $+-m\x01}\xe7(*K\x1a\x85e5\x95\xb5\xa1t\x96\xbb{\x1b+c\xe8\xf5\x86\f\x869\xfb\xf6\xcd\xd0n7\xe8\xed\fH\x93\x98f3\xe3\xff/\xea\xccz#-\xce(\xfcTշ\xf5\xe2n\xdbc{l<\x8bg\x87\x19fA\x04\"P\x98\xa0\\\xa0D\x89\x14\x01\xc9E\x14\xe5&\xf9#\xfe\r\x91\xf2o\xa2H\x11AQ $\xa0\t \x1630\x1e{\xbct\xdb\xed\u07be\xad\x96\\TuϽo\xda\xf5\xd5\xfb\xbe\xe7\xbc\xe7\x9c:=\x1d\xb2\u007f\xd0#\x9e\xe4$ID#\x8b)\x8a\x84I\x16\x93e\t\xa3I\xceǟ~\xc5\xe7\v\x1d6\xcf/\xb3\xb6Ԧӌi$\x8a(\xf6\x06\x9c(\xf6\x11\xe4\xc6\x1a\xf2\xbc\xe4\xe4lʓ\x91\xe0\xd3/\xfe\x87\xd8\xdf\xe7\xf5\xad\vޅ\xd5h\xb1{6\xe2\xc9\xe3'\xc8\x10T\xe6B\xd9RR\xd2\x1f級M\"!\xa9\xb4\xc7`\xb5\xb5\f\xca\xea\xe2\xeaښXL\xe4\xcf.\xac\xad\xfe\xf9\xca\xe6\xfa\xbf\a\xc3\xd1_\x0eN\xce>:>\x1b\x06j\xe9\xf9\x83\x94R\n\xac{^\xb6\xd4+w^\xdc\xce\xe2\x88\xe9pD1\x1a\xfbIJx\x89=\x01\r\xab\x99\x8f.\b\xdd\xfcW\xe2\xafp]\x1b\x10\x824\xf1o},c\xb1qD\xa1\x14\xa6\xd6t\x9d\xa5\xb00\xb2\xfeA\xc9f\xe2\x179Q\xf0\xe4\xc5JQY\x8b\x16\x828\x92dI\xcc\xd2\xd2\x02k\xab\x8b>a\xba\x91\xfa\x9e\x83\a\x8e\xcdf:\x9fvD\xd0CE\x91O~H\xe2\x98(\x89(\xcb\u008f\xb1\xfb\xc7\xec\x1e\x0f\xd9\xefO8\x1e\xe6\x9cNJN\x87S\x8e\x06c\x0e\xfa#\x1e\x1f\x0e\xf9\xefwG|\xf2\xd1g0\x1c\xe2p<\x1b\fiN\x86\xb8\xb2`ss\x93\v\xf7ﳻ\xf3x\x8eŬs\xc4Ja\x9d\xa5\x95e$\x91bX\x94\xac\xb62\x10\x82\xa1u\xbfT\xba\xfa\xbd\x89\xd3?\x8cƓ\xadv\x96\u07bfr\xed\xea\x9f\x1aI\xfc\xf3\x854\x99hg\xbf.kmf\xd9\xc0\"\x84I\xcf\xe4U\xea\xb5{w\xb6\x1biJ\xff\xf0\x88\"D|\xcb\x19S\x1bv\x16r~\x18\xbeȘ\xd9\xdey\xa6\x80W\x12\xa4@jC;\x96LUD\xad5װ\f-4\x85\xe3Z\x1czE\x88\xed\x93\xcaS\xd8J\n\xff\x1c\xb7\x83(\xf2\xa1f݅&\xddN\x93F3\xc3YK\x96%a\xfc\x9e\x81I1\xcfY\xf1\x87\xeb\x95)i\x12\x91&1Y\x92\x90f\t*R8\xa3\x99N&\xf4{}\x9e\xed\x1f\xb0w\xd0\xe7\x87\xfd>\xdf\xec\xec\xf1\xfd\xd3\x03\xfa\xfd\xc1\x1c\xe5\x9f:\x988\x90\xce\x11W%z:\xe6\xd6+\xaf\xb2u\xefe\xbe\xfa\xec\xd1\xfc\x96\xcc\x16p\xcd\xccW\x97\u07b8\xe0|\xbb\xe19\xbfs\xcb*\x92b\xa5\x18\x8fZ\xbd\xbcb88\xa3\x91f\xe2\xee\xbd{\x17#%\u007f\x13\v\xf7\xc7\xc5v\xf3\xa0\xd3j>:\x1dO损\xb0\"P\x0f_\u007fu\xbb\xddjqrrʨ\xd7\xf7\x89p\x81\xa6T<?\x10%\xfd\x8a\xb7\xb6\xd6\xef\xc6g\rI\bd$\xc9kCG\n\xca8\"\xaf\rW\xb1t\x15\x1c\x1bǪ\x84\xe5H`\x84\xa4\x8c#\xdfʝ\xa0\x11\x1a\xbb\x94\x92\\;\xe2\xc4/\xa3\xb2$\xa6\xddj\xd0l\xa6\xa4Y\x82\xb5\xceKl\xac'\x15\x11>\x8cF\x87\x18?\x8fp\x95\xa7\xf8\x95O\xa4VJ\x92\x84\xb8\xf0,M\x88\xd3ċ\x1e\x02\x93\xe0\xc5\x17^\x05c\xb5!\x0f1\xb3\xdd`\x82)\x1c\x1cO+\xda\vm\xaeߺIo4\xa6\u007fx\x14\xea\xbe\x1f\u007f\x1bY\x821\xfeݫv\xa4\x98\x1a\xcbQ\xa5\xb9\xb0\xb9\xe1\r\xacU\xc5P[\x86'}Z\xed67n\xde$R\xaac\xaa\xf2\xbdF\x9a\x9c\xdb<\xb7\xfc\xb7\xbdމ\x99\xabd\xa4D\xbd\xfd\xc6k\xdbRJ\x9a\xad\x16O\xbe\xd9A8\xcf_\xc9\u0fde駔\xf2ȷ\xacM\xc0$\xa1\x19KA\xa5\xfd\x960Jb\nm\xd8r\x96s^\xdeľvlD\x1e{L\x85$\x0f\x11\xaf\b7/_\x89\x94\\\xae+v\v\x83\v\xf6\xb0F\x96\x10\x85\u007fj\x1c\xfbTj)\xe4\xbc\\\xf9\xbd{\x1dnk\xb8}\xd6\xd7d\x19\xac\x12*,\x93\xb46sw\xaf\x9d?\xee\xe2)\x9cR;\x8aZ㤢\x89\xe3v\"\xe6o&\xc6\x02\x8e\xc7S\x96\xda-\x0e{}N\x8e{\xc1`\xe4'\xcf4\xa4@\xb4\xb2\x8cLIJ\xe7(\xea\x82Q^s\xe7\xde]\xca|\x82-\nδe\xf8l\x8f\xb4\xbd\xc0\xe5˗P*\x12\x93\xf1\xe8\xc7R\x88\xb7/\xae\xae\xfc\xf5i\xaf?\fL;\xea\xad\xd7_\xddVR\xd1hfh)9\xdb?D!\xe6\xe0P\x84\x05\x11\xceQ\x86\xafR\xce:\xbd\x14\xd4ƃ\xc3f\x1a\x91זKXV\x95?\xd0\x13\xe3\x0f7\x15P8A)%e@\xce\b\xe1YR\x04B\u009bh^\xd7S\xf6r\xcb~\xed-l\xadf\x86\xb3\x0ec\f\x8dF\x1a\x92\x1e<\xa1i\x82\xf6v\x16vi\x9d\xff;\xf0̀\f\xb9\xbcZ\x1b*m\xa8\xaaz\x8e\x9b|\xe2\x82\xc1\x18G^\x999⎝\xa5+\x05g\x16\xa6\xce\xe7֯\xae\xae\xb0w\xd8\xe3\xe9\xce\xf7\\K\x1c\xd7^XㅫW\xd8?쑄\xc3i$\t\x89\x84\x1aAk\xf5\x1c\v͌\xe3\xc1\x88\x9f\xbe\xf3\x0e'\xc7G\x14\x931c\xed\x18\xec\xef\xb2y\xf5:\xeb\xeb\xeb\xc4I\xc2pxv\xc9\xe1~{i\xf5܇\xbd\xb3\xd1^m\f\xea\ue36b\xdb\xcdV\x13!\x04\xcb\xcb\xcb\x1c\xec\x1f\xe0\xf2\xe7#p\x14$/Em(k\x13\xc6a?\xba\x99\U00035949\xa2\xac\f\x9b\xc2q\xdegO\xe2\x80\x03\xedXR^\x1c-\x80\\Jj!\x031)\xc8BĆ\x94\x92\x15\xa3\xb9*4\xf7\xa4\x86\\\xf3٤bZV\xb4\x83\x1eKkC\x92\xc4!\f\xcd\xf7\x8f\xc9d:\x17\xe3\xd9ـ1S\x9d\x04\x05dQ\xd6\x18\x1d\"_\xed\x8c\x11\x0e\x1f\x97u\x8c*\xcf\u07be\x11[\xae'\x82'\xb5\xef%3 \x9c\xa6\t\x15\x92\xeed\xc0˩d0\x1e\xb3\xba\xb9\x89Z^\xe6p\xff\x90N\xab\x11\xb6\x9e\n\x83`\x88dcc\x9d\x18\xc3\xee\xf1)\xbfz\xf7]v\xbe\xfc\x82q^\xe0\x9ccow\x977\xde\xfa\t\xddN\x978I\x18\rG\x1dk\xcc\xef6\x96\x17w.\xae\xad|\xae6\x16\x9a\xdb\xe7\xd6\xd6\xe6\xa6\xfb\xc5sK\x1c|\xbb\x83s\xbe6*)\xa9\x8d\xa5\f\xe5!\x02\xaf\xec\x985{%\x98\x14\x9aU\xe18\xaf\x02\xca\a\xfaڑ\tA3|9\x02\u007fCL\xc8蝍ʵu\xa4q\x04eI\xa65\xeb\x89\xe4A긥+\x0e\a9\x9f\xf5\x86 $q\xe4\r\xa18o\xb6t\xce1\x99\xf8xY\x1b xUס\xd1\xfb\x92\x96\x17\x15Ue\xb033PX\xff\xd6\xdaRԆ\\Cn$\xbfH-\x0fR\xc9Z$iH\xff\xde\xee\x91\x0e7\xbb\xd6ԃ\x0173\xc1V\x12q\xac-6N\xa9Ҍg\xfb\at[\r\xc0ъ#4\xf0\xe8hL\xb7\xa1\xb8z\xebE\x86\x87Ϩ\x93\x06o=|ȗ\x9f|\xcc@[\x12S\xd1\xe8.q\xff\xc1}\x1a\xad&\xc63\x05I]\x95\xef\t!\x8c\xba\xb6\xbe\xba\xad\xb5aqy\t\xa9\x14\x8b\xcbˬm]\xe6\xe4t\x80\x9dN\xfc\x0f/\xbdro\xb6\t\x9c\x85\xd4[\a\x93\xc2K\xf6;RЖ\x9e\xb6/-\xecjǭT\xa2\x11T\x01\x8c\xe6RbU\x84D\xe0\x84\x0f\\Vҏ\xbb\xb6(I\x8dab\xbd\xe5\xe0z&\xb9\xeajV\xa6S\xbe=\x19\xb33\xf0ԉ\xc0y\x8a\x1c(+\x1d<\xee\xfe\x00l\xd0t\xcd\xcaY\x9e\xfb\x03\xaakM\xadg\"\a\xc8+ʹ\xb6\x9cU\xd0u\x9a\xbb\x91\xa5\x13>\x92\x96\x1f]\x11\x0ezڗ\xb6\v\t\xdc\xcd\"j'\xe8[K\xb2\xb8\xc4w\aGL\xf3\x82H\t\x1aiJ\xaa\x04\x16\xc9^\xae9:\x1d\xb0\xd2m\xf3ҽ\xfb|\xf2\xe1?غy\x8b\x85N\x87\xbdǏ\x99\x18\xc7\xf1\xf7;\xdc~\xf0\n\x9b\x9b\x9b\xb4\x16:L\xa79 d>\x9d\xfeL\xbdx\xe9\x85\xed\"\x9f\x92d\x19\x9d\xae\xbfF\xedN\x87\xf5\x8b\x17\xe9\x1d\xf79\xeb\x9f\xfa\xa6\x17\xd8Y\x15\x98Sc\x1dEY\xe3\x1c\x94@SB3 \xc8\xddڗ\xaa\xadDR:?\xb18\xa0t\x02\x17,\xc53\xd9i\x14)\x12\xa5\x90\xf9\x94J{\xe1\xf2\xc8:F\xc6q>\x96\\\x89\x05\x97\xcb\x1c9\xce\xf9O\u007f\x8c\x16^\a<\xeb\x0f6\xb8qːKRך\xbc\xa8\x98LK\xaa\xf0\xc8d\x15z_\xad}\x19\x9b\x96\x9a\xd3\n\x84\xb1,يCcYQ\x92\xa6\x14\xe4\x16\xbe\xaa\xbc\xd3v``#\x86\xbb\r\xc9j$9\xb3\x8e\x9e\x8c\xd0Y\x8b\xaf\xf7\x8e\x10\xd2\xe3\x92N\xab\xe1\xf1\x9b\x94<\xcd5\xda9\x8e\x8f\x8e\xb8~\xed\n\xd7n\xdd\xe0\x83\xbf\u007f\xc0\xaf\xdf\u007f\x9f'\xdf\xff@\xbf߇\xc5$'6\x00\x00\x00\xfbIDAT\xb0\xc7\u007f\xf3\xe1C\xd2$f\xa1\xbbHQ\x14\xde^q\xf3\xc2\xc66\xc0h8\xa4\xb3\xb8H\xb3\xd5\xf2\xcb\xfc,\xe5\xfc\xc5\v\x1c\f\xce\x18\x1e\x1d\xcf5C\xb3\x9a\xadC?\xa9\xa5\xa7\xa8s\xeboG\x05\f-\xdcN%\v\xd2ߎ\"x/\v\xe7p!+kfėR\x90D\x8ah4\xc198\v\xbb\x92\xa9\x83\x13m\xa9\xad\xe3J\xaa\xb8\"\f\x1b\x93\t\a\xbd\x11_\x8d\n\x84\xf4ĥ\b\xd2ת\xae\x83\v\xcb?\xf9\xed\xa4D&1*\x8dQ\x89\u007f\f\xc6\x01S\xad9\xad\xfcdxC\xd4\xfc(u\xacG\x82\x9d\xda\xd27\x8e\x13k91PY\xff\x9b\xaeg\x82\xbbYD\xe9\xe0IeЭ\x05\xc6\x0e^\xbau\x833m\xc8\xc7\x13\xba\xad\x86\x9fN\xa5\xe2i\xaeqXJ\xe3(\xf2\t/\u07fe\rF\xd3Z^\xe1\xe6\xcd\x1b<\xfa\xd7?\xc9-\xe4\xfd#n\xdcy\x99K[\x97\xfd\x00\xd3^\xa0\xaa5\xff\a\xe5Du.\x9bn\xed\xe5\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_03_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x000\xc7zTXtRaw profile type exif\x00\x00xڭ\x9ck\x92\x1c7\x92\xad\xffc\x15\xbd\x04\xc0\xf1^\x0e\x9efw\a\xb3\xfc\xf9\x0e2E\xaaդZcvI\x89EVeF\"\x00\xf7\xf3p8\u009d\xff\xf9\u007f\xd7\xfd\xeb_\xff\n\xfc*.\xe5\xdaJ/\xc5\xf3+\xf5\xd4m\xf0\x97\xe6\u007f\xfe:߯\x9f\xef\x05\x9fޟ\u007f\xfc\n\xdf?\x83\xfb\xe5\x0f\x8c\xaf\x91\xaf\xf1\xf3͚\xbe?\x8d\xdf\xef\xff\xf1\xfa\xf2\xe3+\x17\xfa\xc5\x0fB\xfe\xcb\x1b⏏\xb1?\u007fp\x1d\xdf\uf6f7\u007f\x1bљ6\xfc\x9f\u007f\xb5\x9f\xff\u07fb۽\xe7sw#\x15\xa6\xa1|n\xea\xf3\x11\xee\x8f\xcb\xf0\xc2\xc9,\xc5\xf7\xb6\xc2\xef\xca\xff\x99\xbf\xd7\xf7\xbb\xf3\xbb\xf9\xe1WH~\xfb\xe5'\xbfW\xe8\xc1B\xf47\xa4\xb0]\x18\xe1\x86\x136_WX\x8c1ٱ\xcaW\xb3e\xf1}\xaf\xc5j\xddV\xf41Ĥ\xdf\xe1Z\x8d=\xeeآ\xc5e'Ƙ\\\xb4\x1fc\t\xefs\xfb\xfb\xbc\x15\x1a\x9f\xbc\x03/\xb5\xc0\xc5\x02o\xf9\xdb\xdf\ueffd\xe0\x9f\xfc\xbewy\xe6(\x04\xee>|\xe7\x8aq\x99i\x1d\x82\xa61\xeaO^Ƃ\x84\xfb]\xb7\xfc&\xf8\x8f\xdf?~\xb9?-ld\x05\xf3\x9b\xe6\xc6\r\x0e??\x97\x989\xfc\x8c\xad\xf8\x02 \xf2\xba\xcc\xd7O|\x85\xba\xb5j\xf6\xa2$\xf1ٙ\xc1\x84\xc8\x12\xf8\x12b\x0e%\xf8jVCH\xd1\x1a\v4\x18\xb9\xc5d\x93\x15\b9\xdbf\x90\x96b,\xacM#\x8e\xf8l\xdeS\xc3{\xade\xfb|\x9fTa}r,\xb1\xb26=\x0e\x16+\xa5L\xfc\xd4Ԉ\xa1\x91cN9\xe7\x92kn\xb9\xe7\xe1J,\xa9\xe4RJ-ʹQcM5\xd7Rkm\xb5\xd7\xd1bK-\xb7\xd2jk\xad\xb7ѭGR2\xf7\xd2ko\xbd\xf71\xf8̑\xdcȃw\x0f^1ƴ\x19g\x9ay\x96Yg\x9b}\x8eE\xf8\xac\xb4\xf2*\xab\xae\xb6\xfa\x1a\xdbv\xdci\xe7]v\xddm\xf7=N8\x84\x92;\xe9\xe4SN=\xed\xf43.\xb1v\xe3M7\xdfr\xebm\xb7\xdf\xf1cվ\xab\xfa\x1f\xbf\xff\x0f\xab\x16\xbe\xabfo\xa5\xf4\xba\xfac\xd5\xf8n\xad\xbaлD\x10\xced\xad\x19+f)\xb0\xe2U+@@\x9b\xd6̷\x90\x92i\xe5\xb4f\xbe\x1bY\x91\x8dAf\xad\xcd\x0e~\xb8PX\xc2t\x82\xe5\x1b~\xac\xddϕ\xfb\xc7\xeb\xe6\x98\xeb\xff\xb6n\xf6OV\xcei\xe9\xfe?\xac\x9c\xb9\x13\xff\xb2n\xbfX\xb5-\xb8[o\xc5>Y\xa89\xf5\x91\xec\xe35\xc3\x1a\xff1\xd9\u07ff\xbc\xafɪ\a[X)q\xc2`P7l\x06\x92\xfa\xae7\x87\x1d\xf3a(m\xe6\x91w\x9a|x\xc9s\xf1Rk\xcb\xf1\x06\xcb3\xb6c\x03(\xe5\x83ɦ\xd3\xf5\xb7\x1b\xd7\xcd\xf9}\xcf3=\xf7\xb614\x86>l\xae0\xefa\rkz\xaf짺1\xf4\xd7\xe3\xc9,\xc2&gP\x03D\x9b\xa3ֻnܙ\vx\xa1\xe8\xd5'\xf9\xb8Oi\xe3\xfa\xc2eK\xcb;r\xbdʄ\xcc뮐\xf0\xeab!'\xde\xfc\x06\xb4\xf5\xef\uf03e\xc3\x01\xca\xff4 H\x82\tԂ\xe4\xc8Z\x94[\x1c\xab\x92\xb6͚R;۬\x94\xb8\xef\xf0e0\av\xdb$\x06\xea\xae6\xbd\x95NN\xf9d\xbbs'\x89e\xf3\xd6@kV\xf1\xcelӑ\t\xe10\x8e\xcc\b\x17/\xbd;\x1b\xa4\xc3[\xf3mK#\xa9ck\xe6'\x819֙\x8b8\xbe3\xaeq\x9a\xae8\x93\x9f;\xde\xe3n\x1fy\xadV\x17/z\xf7\x1fo\x18\xab\xb6NP\xceV\xa2.UV\xf8\xdc\\\x1fp\xdff`9\xac\xb5\x8d)\x99哒\xdb\xd9-\xad)\xd0n\xf2\xba\xfb\x9b\xb8Hd}\xe1\xb64{ٓ\x8f\xad=\xe9cm\xa4\t@0\x85y\xec\xb9z,\xe5\x90Z\xc6\xe2\x14\xb7\xda\xf0\x89\xfcX~qGGw\x9fc\x82\xcer?\xad*\x9cfڼ\x99\xe0\xdd\xcc[\xda#\x92(=\xb00!\x96\xbe`\xd0C^$\u05eb\xe6\xe5\xaePX\x82\u038bX\xb9D\x18\x92+\xad\xe4\xdeo\x02JZ\n\xab\xf8F.Ct=ܾ\xc1\xf7:I\xfb|,\x0e\xdeR]!\xb5\xceFά^\x94@\x060\xac\b\x18\x10\xb2\x02\x1eK$\x0f\xcb;n9,\xffK\x83_}u\xbf\xfb\x01\x9f{\x0e\v1\xb8\x879\n\xb1x\fr\u007f\xeb\xb8:\xac͐\xd0\x15\xb9\x1c&;\x95I\x8aD\x16\x8e\b\f\xa4\xd3\xf7\x15z\xf1\x1f\xaf\x81\xa9\xca\xcc\xd2\x13>\xa4\x1cr\xadilfyg\xa2\xa7\x95m!w\u007fnu\x99\tn-\xdf\xc5\xf7\xb9\x11&\x84\xa8\"oC\xb6\xd8\xf6\xc9=\x14\xbe\xb2R{5`,\x87n\x89e \xc9Ͼ|,+\x0e\x04\xf8\f\x8b\x80+kr\x85u^b\xb0,,9#\xe2\xbf\x05\xba\x91*-\x905gV\xc2\x16N\xdc\xc0_\xad\x8ce\xaf5\xecZn}\x87\xe3\xac\"<\x18\x8f\xc5\xce\xfa\x83ٕ\xfc\xb9i\x9cq\"`\x98\x97͔\x96\xc0Y\x82\xe5Ԑ\xdb\x1a\xa3\x96\xc8\xd7\b\xf2\x8dH\xb6\xa2t\u070ev\x14\x88\r\x86\xb6\x93\xa5\xa9N\x01삉\xa0}\x9e{\xb1d\x87%%\xf9ȇ\xbc\x9838\xe1\x06\x06\xe6\xef\xda{\xce]\xe2r}\xcc\xdeVc(\x81\xb1^\xb0\x96Ȭ\x93\xd7]\x12w\x97Y\xf2\xe8gF\xa8\x06P\xbe~\xd4\xec\xd7&\xeaY\xc0x\xf6\x01\xb9\xc9l;n\x17\x03OCЇ\x8dHr\x81\xa8`\x1d\xa4sOO\xe7\x9e=w.\x12i\xabZ\xd1\f\xfa\x97w\\\x9f̀\x91\xe0\xfdU\xc6p\xbbF\xab\x9d\x11\xa6\x86><[z.s\x13%\x14\xc8m\xc1US\x91t\x88\rne\xa6\xd8\x05\xb3$c9\xc9\x16aSI\xc5۲K\x9d)ع\xb3\x8c\xbb\xdc8\xc9\xe8q\x1e\xc0n\xa6\xa6\x11\xe0\xbd\xcds\f\x1c\x9eg\xf4\x06Psc\x82\b\xd0\x06\xe6\xb24\xeb\x90\x18\xbdE<vFN\x8d{\xc9~\xb4\x9e\xe7\x01\n\xa0\x97\xe1w%iR\v\x10њ\x9dA\rC`\xb2\xbee\xbf\x18'\x94\x14\xf4\xbdO\a<\x14\x9b\xa7A\\\bT!\xd3h\f\x960+\x17,a\x06&\x01Zzۈk\xd0\x1a\xe5*l\xb5T\xfd*\x84@>\xd9Fe\xf9;\u007f\x9b\xc4U&\f\t\xba\x1c\x0fk\x12k\xf1\x9do\xe5|\x0eD\x91v\xefLN|1O\x82\x8f\xdd\x18h\xa9}\x8b\xb0\x98\xc80=\x93\r\x00\xf1^\x86n\xcc\xe0B\xa5&M2\xf8G^\xd9\xf2\xa3\u05fdj\xf7\xa1\xd5\x02e\x10\xac\x19X\x9e\xc0Ո\x91\x88\x9fc\x8f\x9c\xa3\x8b`\x0e\xc0\xc12^\x02?\x9c\x16OY\x12\n\xa0N\xae\x03<9\xaco\x0f\xb0G\xaf\x91ec\x1cs\xf6\x8c\x8e\x14V\x11l\x05ث\xd1\x05p\x15\xc0B\xbf\xf5z\xb9D\u007fQB`O\xe0\x1a\xd6\u2fd8~\xfb\x13\xdej\ax#i\x99\x18Bk\x9f\x033\xc0\x02\xcc\xeb^\xe5\x80\xc3\xcch\xc4%D\xa4\xc5\xf92\xba\xfd\xcdW\xf7\x05.\x14\xca\a\xc9\xe0\xc0\xd6\xe2b.\xc8k_Wz\x98T\x02+\x82\x9a\b\x84\\\xe3\xef\f\xe61tG\xf2\b\xff\xefDDl\x10\xed4\xc2\x0f\x86!\x8c\xd7TN\xa0\x95l\x81\xe6h\xa7\x9c\xf7I\xe5\xf8\xc9ԉ\x1d\xa4\u007f`;\xf4\x123\xb7\x19\xef!:\xbcC)\x85:\xeeb\r$z\xee\x84\xda\b\x05|P\x17\x89\xec\xd8\xed\r\x9e\xd7\x03k\x84\xd5\xc9\xe4c;\\8\xc4\xc6\xe4^d\xc2Y\xe6\x90[v\xa0\x97\x83\x1f;\xa1\xb1L\x90\by\xe7\x11II!Iгj\xd6},g\xc1=\xad\xbcо\xc8Ʉ\xd8\x03\xdc!\xbek\xf0\x1a\x1cF:\x80\u009bۛP\x8da\r\x91\x82\x81\xf0'Bg\xae\x81\xfb\xdcg뾲1\xaa\tj\xb1v\xbd@\xfc0\xce$\xe9\xa0\xecj\xdc\x1a\x88׆b\xf2\x90\x14>\x83ш\x8a\xce\xfb\xb3\x883Mh\xb0\xfbJ \xcf\x02\xe3\xe64'\x02\x82qon\x19OyovK\t?\xe0\x92|\u009c\xbc\a\x9a\xbb\x04G1\xf4\x15\x10|q\x91\xa4\xb0\xb4\"\xc1\xb6q+\x1d\xfe\x1cs@)(\xac\x14\xe2\x9d\x135\xe7\x80\xe7b@\x0f\xa1[;o\x86\xa1\x11\xd1/\xf4`$>u'C\x96)\xfa\xd1\x1c\x9b\x15\x83\xf9\x81q\x14\xd2\x18\xe0\xfa\xdd;$\x06\xe0\xc8uM!\x9c\xd5\xfad\x12\xfb\x8e\x8c(a\xb8\x1f\x15{\u0098\xa93H\x830\x1d\x8d\xec\xe0F\x90\xa8\xbbflo\xbe/\x95\xb8\x1d\xb7\x9f\xf0\xe3&x\x11\xc8~r:\x800\xc1\xc0\x14\xa5\xf2\xc4Lx:1\x9f\xce\xf2\xb0\x86\xfe)L\x90[\xc2t\r\x90\x97\x98hD6\x168>\xdc\n|'\xa5\x8eu\x883\x13\xf5\xcc\x05k\xba\x03\x12\xa8?\x18C\x01q\x8f(+\xe1)\x04\xd9E!\x17\xdb2\xb3w\xb0#\xa6\x02\xa0\xd8\xcb7>\xc4\xc3\x1bV\x11\x81\xa4\xdf\x01\xb7=T\x8b\nO14\x81B\x1d\xa7T\x84\xb2\x8d\xa3\xd9-\xbd\xe7M$\xee\xe5jC\x17\x1e_`\xd4y'T\xdeG\x88B\xc15X\x1f\xbf\xee\xc6/\xc0\x00\xa3\x95%\x99j\x97\x05\x9dEw\xe8\aSh\xe7\xaev\xaaw\xc8\xc9\xe3\xc3)\xa3A\xc5ȱ\t\x95¯\x8c\x1d\xa6\x95\xa6A\x860\x9b((\xa0\xcd&f\xeb\xad\a\x8a+\x12)\x88A\xe4XG: \"\xaeo\x97\x15C\xf2\xca[\xf42\x85*P\n\xc1\x90\xd1f\x18!\x94E\x84\xfc\xeb(W\xc8\xd5D\xdc\x004\xd3Fj\xc1\t,]w;\t\xaf\xec\xad\xc4*0\x8e\xa2\xc5D\xe4 \xf4\x01\xdb\rĶ\xa5\x95ơ\xf1)L?\x9c\x82\xc0`\xe1&\xbe\xe9\xc4J\xc8n\xa7\xb8'\xd1n(\x15i\x87\xfa\xb5N\xfa\x13O\xbdzd>\x99\x01*\xf5\x0e\xe7\x10Ӱ\x02\xb6\x11\x9fC\x18%\xe6^|/\x84eR]\x84\xd8\xc2&bX\xc2\x175\tU\xb9\xb1XUQ\xc0\x98&\x9aZ\f\x8f\xf4c ̜\xd5\x06\xb2#qoeR\xfbs\r\xa58\xd0\r$\xd1\xfd쏦&\xdb7\xe2l%\t\xb4\xf4\x15j\xbf\xfe\n\xfb\x8a@\xb7\x11\xfd\xc8\x1a\xd4\xd9E\x161\x85\xf7$y\fP\x1e\xa4\x92L\xe2¸\xd11\xc9k>\xb4U\xb4?\xc2{\xa1\xd1\"\xba\xa1s{G됭\x16G\x1c\xcfv\xb9;L\xc3\xcdZY۰$\xe9\x8fZ\xafx\xe0!\x152\x90\xce\xe03\xef\x02-\x00\xcb\a\x18\b]\x92\x0eA\r\x97d\x87\x95\x81\x9cn\xecd\x0e\xdaZVT\xd6\x16LJC\xa1\xc0\x05Dy\xe2\x00\x043\xe0\xafpG.W\b\xaat0\x12\xc5\x14:,\x82w\xc8,\xf4\x06\x12ZB\x941~\x89\x0fLA|F\x101\x95\t\U00083b03$Ї\x03M\x8a\xa1Ǐ7P\x9e8\x82\x05\x88g\x97\"1\x8d\xa4\x05h\xd1\x1a0\x01\x8e\xb5\xf9@\xe6\xe0\xd2\xd7\rY6\x0f\x18D\x00p{x\xef\xf0ro\u007f\xa4\xb3\xb8\xa7G\u0560H\x11\x94\xfdi\n5̉\x95`\x82\x8b\xac\x82\x02\xac\xbc\xea$\xd3Hgc}\x90\xdb|<Y\xbb\x8f\xb2\x91u\xdeD\xbd\x9d\xaa\xb1;\x15\r\x11\xc8@I\xb6\x9e\x03\xaa\x94\f\xb3\x88\xea\x99\xfb\t\xea\x8d\xe5$\x8f\x99bcZBe\xb4A\x03\xca\xe1\x8c@bCR\xc0Jw\x84u\x86mО\"\x13\xa0\x14\xcc\x05\xbc\x89j|\xd3\x18\xb8લ\x1eҊ\x01\xc1<ֱ\x87\x9d\x1c\xc3k\xaf~\x10\x1e\x95\xc0\fŁ]&-\\\xc1`\x14 x\x1d\xf0N1\x1d\x99ò\x810\xc0\x1d\xad\x06\xefI\xc9\xe2\xfd\tr\xac*\xf2\x0f\x88\xc8\f\xa0<\x04E\x1f\x91\xf4h4\xd4\rS\x06\xdb\rR\bz\x86<\x9a\ax\xc3%6\xe1Ƴ\xd2\b\x187-\x85\xe4+\x86\x0ec\x89}\xabf]\x05\x84Hh ԕ\xe9\xf8\xc1*\xaf\t#r\xaf\xb2Y\xc8܆\xc0G\x1dD̻$澻\x83_\x00#\xe3\xe4V\xa2\\\x9f\xc1\u007f\x0e%\xae\x8a\x05\x96\xc5\v\xb3R\xd7\u007f\vS\xdbT\x8d\x98\x06\x0e\xb1Lx\x19)\xf0\xa8j'\xaa\xb0@1\x047\xe2$\xa3Xэ\xe0Q\xc9ҩ@!B\x9c\x18%<\xe0\x04\x8c\xb4(4b6\x93\x8a>MƏ\x81q7L\x99\"$#A\xf6\x19r\xa2|\xaaU\x93\xce\x1eu\x00YYq0\x89څ\xbf\xc9\xccWۛK\x80\xaad\xe0\r\x89,\x8b\xc4)\x18\xcc\x10\x8e\x11x\xf2\xfd[\xaa\x87<\xda\xee\xf8\x8cyb\xb5\x98\xf0@\x94\"\x17\xc9\x01\x16\x9cI \xb0\xc88\xb4\x1bb\x14\x11\xac\xe2\r\x9c\x8f\xdag\x85\x12֊\xfc\x1e\x04\x18tїC \xa0\xb2e,^(\x85j0J\xe7\xd5\xfd\x8as\xba\xea\x01\v\xb6CX,\xc1\xcbz\xa9\x11\xa5+\x11\xb4|\x87,\xb9̓\x03]\xe2\xc1C#ZA\x06\xder\x83dlYh\xf7\xc9\x12\xdd&7\xa3\xc2\x15 \x01\xd0\f\xa9&\x10\xd2\xfe\xa2\"\xdd/\xe4\xe5\x1f\xaa\x12\x1bGڙJ)\xfcKwgH\x92 \xfb\xe8\x87r\xfa|Lsm\xf0\xa9\x83\f\xd6E\xee\xe1 ,\x14\xc8k\xdc}\xday\xf8=?\xe6\x06\x04\x83\xa6\x11\x19U~\x96<\xced\xef\xad\ro\xb0f\x83\xdcш\x1dO\vQD2\b&\xed*\x9c3'\x97\f>\x02\xb3\xb0\xfbz\x97$\f\x12\xc1\xd1Ts'\xffS\x8fd7\xc1\xca\xd4\"\xfc!ȩ\x02\x82\r\xf9\x95@\x1aK\xa3\xb4\xe2\x11\xb1\xf5L\xd6\x1d\x03\xa6\xd2\xefn\xba\xe2Mq{\x94\xfa\xe3p>\xa4\xecq\xbc\xb0\x95\b9\x1b\x11\x91}cB3\x9fFP\xa8\x0eCZ\xa3\xcc 8\xe6\x84H\xc5\xfe \xaa\xb9\x1f\xa4\xc5\xc8\xd2\xf5\xa42\x9fW\xfd\xc1\xc4\xe6\n\x99\x14\x10\xf2F\x89\xfd\x80\xcd\n\x8cx \xadW\xda\x15I\xb2\t \x01\x13\xfa0\x9d\x00C\xf2\x825D\xe4\xa9a\x13\xb8N\xe7\xfa\x99H hѐ\x83!\xa2(\xc4RGZ\x15\x0f\x85\x18\xef(\xe8\x9c0\xae\x01k/\xcb=WUE\xaa\x12Q\xa1\xf5\x18\fƅ\xa9\x8d\xefFd\xc2(.-\x95p2,\a\xc5'q;\xd8\x1d\a\xd9)\x94%h\xeaP\xb5\xf0\f,\xa7Ip#\xac\xb9a\x14\x1e*\x85\x91\x8c)\xf9\xbd\x8a\xb3H\xd4\xc0\xb20$/\xdb\x12륐\x0ee\x81\x8fh\x19\x16\\\x1c\r\x1fr\xcbw\xf6\xaaB\x92\xea_*\xe4#3AtH\xa1-\x17\x8cu\xf7\x86\xcbB;\x8e\xc9d\t>V\xde\xc8{\x01\xee\xc0o|?\xfb\xd9@Q\x13\xaf&S\x0er\x1d{\b֣x`\x11\x00\x9d\xb7\x0f\x06\f\xfa&\x92\x83l\xc7A^\x92\x18\xb1\xcco\xa2\x8f$A\n\x81\xb2Q3\xc1\xdc\xf2\xc9\xd0\xc8)\xaflwd\xa3\x1c\xaf\x90.\x03\x00'\xe9\x97\bS.0\x98\xebt#Ƅl\xafD:\xe6|\xf0/\x06D\x8a\xa3d\xfa\x00{\x06\xb3*\x9b\x8d6h\xca5\b\xb3~\xed \x98\xc4L\a\xb1\x10tQ*\x81\xa9\x18\x83'H7l\xf0\x00\x9d\t\xc2\xf5\xd1OD\xcd\x04֘Z\xae\xa4\x9a?\x93&\x84O\xaa\xc6\x01\x8f\xf8\x8d!\x00\xc2\xfeF9@\xd9\xff\x884A1\xc5a\x11+\xb8\xd1ހ7,.\x1d&\x91\xd8\xcdq\x19\xad\xfb%\xe6F,\xb8Ϲ\xa4\x8d!\x9f\xc9\xdcs\x91\x96g\xc0\x91i\xc1\xeb,hi\f\x13\xae\x0f$\x86Qx[\xe82\x1dӝ\xa7\xb86\f@@\xccA6\xaa|\x83\xf3k\xf9)\xaa\xbf\x11\\\xb9m@\xa61\xc4\x13\x86\x9b\xba\x9e17\x00\xa8W\x95\a,&K\x18\xda\x10\xb5\xf0\x99\xfc\x04\xa1b\xa3\xe4)\xeeBM+z\x11`]\xaeo^\xb8\xb9\xdc(wD\xb2GU;\xe1pyXdېNPN\x8bR\xb0>\x8as\xed<\xe1\xed\x85tD<\bA\xa8X¼\x89\xef\xd6@h\xf5\xc4\xfc\x8f\x9aU\xf3\x10\x03m\x90U\x1e\x14Y\xba,@D\xf2x\xda\xe8D\xc8!\x9a®\xc8\x1d\tF\x92\x96uI \xf7\xee\x11\x11\x91\xc5Lm\xdf\xe2\x89\\e\xa3\xb4\x067\xd9L|\x9a\x91\xf7u\x12\xc4\xd8<\xe3:\xa4E\xf1\xd2\xc3hE\xe8?\xc3\x16\x10tN\xb0\x88(rp[\xac\x10RG\xef\x1f\x15\x951r\x93:gU\xbd\a\x18/p\xb6\x97\\\x1b\xb1\x0f}6\xa0\xdcD\xfd\x18\xf3\x81\x18p\xa812@\xff\xe6\xba\xc8I\x12%_\x94\xbb\xa9\xe6\x87\a\x18\x8c\x1f\xa6\x02\xc4%k\x00\xd2\x01\xfb\xa9\xac\x97\x11\x1b\x01\xccE\x1c\x13m\xd1e\x14q\xa9S2\xfd\x90o0w*\xd6\x18d\xc74U\xb1\xa3\xbc`C\xa6\xcaU\xc0\xa3\xacp!\u007f\tf|0\x89\xa8\x18\x00:\xdcV\xe1\x13\t\x87D.\x1eh\aO\xd1\xfa\xed\ri\x01\r\a\xd7tϙd9\x81,rȸ\xf7\xb5v\xc2搜^XCS\x8aD\xd5\x13^j\xca0ܬ\x88d\xa6By@Vd\xa2\xe7nںb\xed\x1fБg=\xaa\\T\x99\xa2UyYů\xa9P\xb8\x17\xb7p}\xd5\xf6.\x12\b06Ɂ\x82!*\xc6\xc8X\x86\xc0\x10\xb4y\xe3\x95D\xa0r\xe4&%\xcc\xf1\xa9\xe0\xec\xf4\xc5E\x1c\xaaaq\x11\b\xad\xed\x81||\xd6\x17\xe4\x86\xef\r\xb5\a\xb6j\x17\x03ъ\x8c\x0f\x13\xeaBP\xb3\x8cZ\a\xe4g\xe1V\x00\xe3\xee\x16j\xa1c\xf0P\xc8\xe3h\xbf\x93\x0f g\xac\x06r\x0f\x9agF\xa51\xb5{\xc6OЎ\xc4>\xec\x8a\t\x94\xbbƞV~\x88\xc9E\xfa\xf5\x01\xec\xa6\x12\x900LDAc\xc1\xb2\x92\xb3h\xd1\tS\x14\xd6B\x17z\xea\x0fRK\x02\xb3\xb86|\xae\xed\x1c<\v\x80\xbf\x9c\xfc\xf6՞8\xc1\x8a\xdc\xc0\u007f0\t\x19z\xe2\xe5\x93Y\x80,;9\x888'\xaa0\xe8\xf1\"\n\xa1\xf4]\xd1\x13A\xe5D`\n\xcb\xe0>\xfb\x1eL\f\xc3Vn\f\xd4.\xde\n\x94<\x1f\xbc@2\xff\x1eHT\x92\x103\x8c\xe1NC\x1cvC\xd6\"\xd1R\a\x06\x8d\xc4 \x9fў8[\xf9\x16\xf2.\xd9\xcf\xcaY\x18\r\x19\"ߋQ`\x88^5\x8a\x81\x88H\b\xd7]\x12\x19\x89\xb2\xaf\x89d\xc8\xf2\b$\x1c|\u007f\x99?B)\x10\tHq\x152\x80%D\x1d\xd1*\x11\xd8r5,\xd7@\x1eC\x1d\x11\x87\u007f\x03фF\x82Լ6\xe8\x00\xfd\x95\x88=`\xe9)Ί_\x00\xa5P\x17\xfc\x19\xabG2\x04\xde\x15\x81\x1af\x8a@\xc6\xd3jލ\x14\xc4|\x9c\xa1\xbaŉ\xe0J\xf1m\xb6G:\vd+}\x13\xc1H\x98ܲj`\xa8\x88\xb4\xf3lX/\xc8\x0fּ0\xed\xc2\xd4o\xcb*\xcat|+Ҩ :\xc0S9j\xe6\x02\xf3\xc8\xd2Fy\x16\xae\x01֤\x17_`\xe7\xab2E\xd5.l;\x8cz\x86\xa6$\x1b\xb5Q\x83\xb9\x1dR\x9f\x04/\f\\@L\x80\xe0p/*\xff\xaa\xc6[\xd2Fs\xc0v\x1a6\xf0\x18\x8b\x0f\x9a\x10\xa7\x1a\xd5\xda$fD\xb5ch\t}b\xf0L\xa9\x0e\\\x89\x87\x82\xf8f\xe1VP4\x9b\xfbO\r^\x14\xa1\x91ke\xb2<\xf0gT\x8ah\a\xd8s\x03P$\"jjE\xaf\xd4\x1b\xa6\x11\xe1Al&4%\x19D\x96\x11\xbb$\xb6\xdc1ʒ+̪\n\r\xc88SE\x8c\x82\\G\x9bYo\x9fCX\x8aKS \xe1H\xf6\x12\t\xcaǩ\xd6\a)\xaeN\xa4)\xdcT<о\x19\xe9\xc0\bƈ\xae\\%Y\x85\x86\xf97\xd2F%\x1c\xfeU\xb0\x18\xf0\xc8Ttq;w\xd8S\xb7\xaa\xf4\x033\x03X\x8a\\P^b㞣_\x8eX\f\x9b\xbc[ϩ\x92Ь%\x9ej\"\x92\xf9|\xb4y\x01\x1c\n\xa1*\a(\xb0Đ\x9b\n\a\xa0*Rgkgf\x93}\x0e\xeaߢ8\x14re\xc1\x8b\n\xa33+p\x96\xeaZ\r\xf8A\x84\t\xaa\x83\xa9&\x8d\x02@\xf8\xe0w\xa2\xa4\t`\x87\x01\x90\v\xc4\xd3\xceϽɀI'\x1c\x1bs/\x82\x8dP\x1b/l\x8a\xae\xa1r<\xf8\x8a\x1c'M?;B\x87\xebJ2t)T0{k\x8b\x8b\x11y\x92\xc6\xea\xd2f\xb9\x8c\x82\x8a+\xf0\xfd\x11_@\xaa\x1b\xae\xc1\x06\xa9\xe9c\xa3\x9c\xf2gs:q\xef\xf8\x8bx\xbb\xdb\x19\xb9\nv\xc5W\xd3\x02\xa9X\xa3\xbb\x11\x18\x8a\x91Wn&L\xc0\x8b\n礼\x87\xbb\x12\x12\x02\xe5$\xffb\x96 S\xe1\x9d[\x8d\xc9;v\xa4\x9c*\x13\xca0\xa4\xeefe\x15\xee\xe2\xa30\xc6\x18\xc7\xe5Uw\xc5\xc7\xe10\xb1O\xa9\x1e\xaf\xcao\xf1s\xec$\xcb\xe9.8\x02JcC`%Ӿ%@\x90\x01\x96\xda\xcbmR\x1cG\xd5.&h\xa1}PT|\x9fU\xc3\xe7H\xa8>\x1fB\xd6\xef\xe44\x11\x9d\x95-\x19j\xffle\x827\xf6\xcf\xf78\x9fh\xacס\xcd\xe7\xca\xd7\xc0\x8f\xa3\xfe\x8a\x8ctA]]c%\x91\xd4\xe4 ى\x04;q\xa8\xb6_<\x83\xb6\xaa\xa2\x95\x1c\bz\x19}\x88cn\xae!M\xd0\xe2!3\xa7\x05HWbE\x19\xb4\xa6\xbd\x99\x17\xcd\x04\x16\xf0\x06x!\x9cO`\x19T\xebD\x9fC\xd4\xf8\xb0\x9d\xb0\x99\xf3\xb8\x80$U\xd9Z;\x06\xb8J\xc45\\1\xe1Q\xb2\x1a\xae\x83\xf3\x19/\xefC\v#'\xe7H\x12/X\vp\x8dܘ\xa0^\xd9-\xb5\xea\x803\xeeB\x9fl\xda$\xc0\xe0OB\xa0vLI\xff\x14\x14\x0fp\xa3\xbd\x02\"\xb7\xe1\xfb\x8a]\xb4OĄ\x02\xa9\x13\xc93\x91\xd9':\xa5 \x02\x04\x94\xc8܋'C\xeb\f\x03 @\xbc#\x98I\xe2\xca\xe4A\xa58\xbd\xad͜\xf4\xb6%T;\x1a\a\xf1OL\xf9\xae\xadC\xf15\xb8BDD\xc4mP\x19!*\x9a\xa2D]\x1dA۱\xb8\xa5C\xe61\x81\xccO\xe3\xb2\xcd\xf8!9\x00Hv\xe9}\x12šP\f\xf5\xd0\xe6\x16\x84{\x9cṀ\xe1\b\xaa\x1f\x10\xac0\x8ev\x1d\x00O\xc2=3|D2\x91\xde\u20c9\xf0\x88\x10\x1f\x93\x1c\x99\xcaD\xc3x9k\xab#\x13\xe3Q[\xbb\xe8R\xc9\xcd쥓\xa0\xf3\x04\xfaՔQ\x8a\f\x05\x976{\ny\x92.9\xa12Zv\xb0U\x05Q\xe0S\xfc\x06DV\x1b\x99\x91\x00\xa4cr\x8a%W\xf5\xbc\x04h\x8e\u007f`\x1bՄ\x97U\xf4m\x06h\xe0\xd1 \x96\x02#\xe3\x8e\x1eV\xf1\x03ςzU\x1c\xfc\xf1\xedY;D\x05Y:\n\b\x11\x8e\x88\x1a%\v2\xa5\xd81\xbb\xaa\xf5kW\x16O\x8cL\x0fNF\x96\\\x1e\xde>[6\xa8\a\x82>\xa3c\xe2\xf4\x8f\x81T©\x184\xf4\xaaeh\x1a\x05\xdf.\xaa&\nkP`W%\xfe\xe4\xc80\xed\xe2\x830{\x90\xa5\xcciS\t|&\x1c\x06:Yy\a\xa6\xdd\xc6\xc4h\x13\xaer9\x84\xde\xc5c\x97s\x91\x96\x84\xb0\xea\xb6˱\xb6dw8\xc6\xfa\x83\xf4\xb7/UUy\x9b~\xfa\n\xd3!F\xa2\x06\xe9\x8dS\x82\xf9\xb90\x96\vStr\x9f`πQ\x90\r\xa8\x11\x12\x04\x90\xae22\x9bI:\xea\xb7$3\xb5\x00L\xcb\xc7\xe4\x9a:\x92\x90\x05\b@\xa6\xb7\x1d\xf9Qri\xaa\xa4\x87\xe6\xbd6\xdcXj\x9b\xec\xeb\xbdG\xbb;{>;\x13\xae\xf2*I\x97e\\\x82\x1a_Jc\xae\xa3\xf6{\nܙH\x15\xe0\n\xcc.\xe8\xf1\xea\x90gbk|\xe9\xd8\xcc\x15pS\x19<\xeeQ\xac\xaa\xec\x84B`3B\x13=\x89F[\xda\x1b\x84\xdcT5\v\xc8n\xe2|\an\xceuU\xc0\x89Z\xb0\x1c\v\x9a\xb0\xf8pWN\xd0\x10H~=lOr\xf5\x9f\x02\x91Pc\t\x98\xc3\x13\xb2\x8a\x03\xe7\xe2z[\xf3N6\x98\x1f\x83\xf7\xb8\x03Ɗ\xae\aAL[\xab_\xc0\x1c\x96\xfe\x01`\xba\x1f]!\x91`(jga\xa9\xe1.\xb4\x90G-\x00\xf67J\xa7\xa3\xcbQ~\x15\x99\x8a\xc9G\x1c\xc2\x102\xdbЭ\n\xba\xe5\x10Go\x8b`\xbfv\x989NIP\xba67\xd15\xdaVCߠ쑂\xb1\xf9:\xc8}>\x10'&\xa5\xa4\x86\x00&\b\xda\x024H\x91\x88\x9c\x83\xb4\x98D\x94-\xb7I\xae5$\xf9\xf5\x88H^\x88qR\xaf\nӁ{F!V\xee)\x84\xd1'\x90Ƚ\xf4\x97H\xa59(ǎ'\xf9E=\x1eXT\x1dI\xc5\xea\x04\xc6\x0e\x15\x15\x8a|\xf6\xe2\x1b\xf2\xe1\xf8\x97\xa3j\x1c\xd2/bJ\xd0\xe4\x8b@\x83\x9f\\Wo\xab}v\xf1\xb8\xe9\xfd\x90>\x1cEs,\xb20`?\xdfS*\xa0J\x18\xa8Z\xea\xd4D\xa0F5\x9cP\xd5\xc6\xe2\xf5Rl\xc8\xe8\xd8\xf9\xc1x\x05\xeeM\xc8B\xb6(\a\xc0\a&B\x90N\x95(\xb4E\x042\xa8\xac\x8ck\x93\x9c\x06/M\x96~\xa8\x8do9m\xbc\xe4I\xf2\x91\xe5>3q\x18j\x94c\xd0\xc4i+VS\x05\xdb&\x15T\xf1\xff\x15\xe9HP\xa3)𩪕\x11\xa6Pr i\xd1/H\xe7;:\xaa\x14F\x02ι\xd5\xd5\x039uQL\x8a\x87\x01#!\x87q\xcb#CK\xaa\"\x06\x19\x1f\x9c\x0e\x16\xe7h\xe7\f\x84<|6SJ\x06b\x8a\x8b*\x06]\xc5H\xee\xad-`g\x81)\x9b\xd8e\x85\x10\xb3\xfbx\x98\xae\xab\xc1\a)U\x91\xbd\xe8 \xedNn\"[\x9a\x05X,K\u007f}r\x1cV%X\xc1{R'\xc6\xf2Z\x0e+\xea\b\x8a\x0f\t\x04((\xadRҧ\x00\xeb%!\xcc;\x10\xf9\xa8N\xaf\xea\x88*ψGU\x0f\xb1\x06|\x8e\xdc\x06\\\xde\x03श\xab\xae\x1aK*\xea%I\xaa\x9f\xf3.\xfc\x8d)b\xdc'\xae\xd1=\x83\b\x19\xd0\x11r\x9fI\xcf\v\x95x\x98op\x13b\x80\xe2\x90\xd4\tɴ\xe5?\x8e\xeao\xc2F\xde\x15,\xb3\xcerٝ\xd7T\xe6zks\"\xa4\xa1\xfa\x06nԫ`R\x88\xe1\x06\xeb`\x9e\x91\xf4XH\x02\vY\x89\xd6\x1c\x82\xed\x03\x8c\xeb\x15\xa5/\xc7\x1dh\xef6#\x05\xcfl1\xab\xfc\x8e\x12W\x99\xb2e\xe4&\x1a\x98\xe1\xa0\x03I\x9d\xacv6\xb5\x11j\xf8P\xfe\xe7\xdbQ\x9d#ѱ\xe6+\xbc\xe6ym9\x89\x16 r\xc3\xc1\x10\x80\x03\x97\x80\xa8W\x8d\xcd#\xe1Hj|\x92ڨ\x89\xa4.\xff\xa0-F\xf4\x86h\xca5\xa9$\xa6R\xf9t:<{\xab:\xe0\x96\xbc8\xf1\xf0\xb6\xb8I \x95։5\x80\x14.\xc6nƖ\xa5\xd3P0\aw\x88\xedt\xfe9\x17\x82\x199\x02\x9cN\xb5\x9a\xb7\u07baՍ@\x83ݡ\x85\x04ҍp\xb0+\xfe>\xdaZ\b7\xe2\n&\xab\x95\x99\x92\x1es\x88\x16۪1\x06mo \b\b\x12|\xde\x02\xac1>P\xc0\x06\xb3\xbbZ\xf6\x9e[ɺ\b\xac\xf86\b:\xb6\xed\xb7\x9du\xbf\xeb\xa8{\xddr\xf6\xd7~\xba\x8c\xae\x00+`'7\xf8\xc6\xc5\xd3v\xc2#\xfa\x9eFS\x13\xe2\xf3\xeah\x02\xa2\xb3\xa9j\xfa\xebmW\xf8\vys\x10\xb13\xab4F\xdc\xe0\x9a\xa6g&\xb5\x97~\v\x9e\x86y\x1c\xe2@\x8f|W\x9d&\xcb/\x9c\x8a_;R\t\xb1~\xfa&P\x99&\xe1\xef\x83#\xf7\xab\xf6gq\n(`\xc0\xae\xe2\xbf+)\v\x11\x15R[\xcdPpsG\xc8j\xcfs\xcaۣ\xa6\x0fF\xb2\x83d\xc4|{\x97tA\x10\x8e< \xc3r\xac\xea\xf4`\xbc,q-\x88Xt\x0fZ\x133̈\xf3^I\xfa@\x15\xe8\xb5&*\x13ǌ^V\x8f\x15\x1a\xc0=\xbd\xa0]E\x8c\x1f\x9c\x81\xbe\xc0\x1a\x80\x12\xbc\x938\x1fط\xa5\xbd{R\xf6\x05n\x8ed\xeeQ\xfb\x83\xba\xb2\x91h\xdaKQH\xb9\xce\xdf\xd5g\r\xe4!\xa7Р\bW\xc2w\x01\xea\r\xd9\x05\xd6x5]\xa0V6.]\x87\x10rW\xd1.\xa8\x90XU\xc2:\xd6H7F\xa4\xdd-\x1dS`\x8d3\xa0\f\xe6\x11)fH\xd2\xe2_\xcb'\xe2\xb0\x1dp\x0f\x93\xc5\x1b\x8f\n)\xda\x0f\xacꑎ\x15\x18ADE\xa7B\xab\a\xd1\xcb5\xf9ǭ2\x05F\x05\x00Чb00ۈ.\xf4\xa9-t\x89ZGc\xe7&\xb4U\xb9\xc8\x02\xe2!h\xe3Ʃ\x86\x810\x8f7&‛f\x02\x94\xb1,<\xd2%g\x02\n\xe6\x12\x06.\xbb\xf5<\x80\xd5N\xb0\x17\x83\x93\x83M}\x89\x987w\x9e^\xdf\x04\xfd\x92{Ęw\x1f\xf8\xe6]\xaf\xf2\vW6\xd8\xfbb@\xa1\x99\xd3^0^\xd6\x133\x00\x88\xf1\x152W\xfb\xaa\x83\xb0^_F;\xda\xcb`\xcc\xe8!5\x8a\xc1\xd7[>\x16m'\x13\xfe\x84\x9dZ\xc0\x83\x11n\xd8\xe1#\x06\x00\xee\xb3\n\xf5\x15\xc1n\x90\xddEe\n\xaea@e\xa3j\xd9H\x02m$\xd5W\"\xc7[\xa9\x99〔_\xc0\xf7,a\xe7zd\x1d\xab\x1c\xb0\x11N\x15\v$\xee\xd1\xf8.~N\xed\x85\x17e\x82\xc9\b$\xd8T\xe3\xa8z\xbd\xea\xe6N\xf1\xf8\a;\xf6\x9a+J\x19Z\x1a\xa8\x83\xd5\v\xc5M\x864G\x80\x06gT\x8b\xf1L\xcc\x06\x9auq#̘2\x17/\x88b\xbeߝ\xcc\xff\xdcǜ\xaa_\xbb\xff\xda\xe9\xf67\x8dn\xf2x\xdfV\xb7\xe2>\x9dn\xdc\xf4\xebuSo\xe5B\xc8\x1cP\xe6\xed\x0e\x1b\x0eg\xa8\xb75\x90\x81\x85h\xfd\x1d\xa88\x11\x82\xdaxU{i\xf5T\xd6\xf9\xb5\xef \xa0\xd1Y\xd0\xedd\xed7n\x90\x05C\xa1\x82\x18\xbd\xfa \xea-\xda\xd7\a\vN\b\f\xc1៌\x14\xc4\x19\xaa\x18)\xdcնXB\\\xb1\xa8w\x8bާT\x9a\xfa[\xee!ѧ\x14\"\xe2\x0e\xd7yA\x9c1;T\x87\xf2'\xe0\xd0Tx\x99\x81mj[e\xaf\xae\xa3\a\x83\xfc\xd7D7\xf5\x87\x11\r\x92\\\xf8̢\x8a\x05\x80A\x14\xab?r\xa1\xb8\xb4\xd1էS+\x1aK\x8e\xf3\x8dj\xae6\x95?\x92t\xb7\n3X\xba\xa3\xce\x0e\x95\xf9:f\xa2\x02\x0fq\xc2\xe5\xd88\xb0\"Ṵ]ߠu\xc7\xed\x0el@\xae\xf8G|\x86v\xdcU\xa0E_^\xed;\xf1\xea\xa4\xcd\xd6.\t\xc2\x0f\xa5,\xf6\x03\x96\xa5]4D\x02V\x1a\xd1\xd9\x1d\xf3\x82\xf4?Ah0\x00p\xd4*\x1eP\x85\xfd\xe8'\x1e_v1\xe31T4\x1e\\s6r\xe7\x10\x93|*~\xedt\xa8\x19\xfcM\xa8ڶ}侵OH\xf2.@\xeb\xdbɫF\xc3\xf26\x13_\xb1\x8c\x94\xc4Y\xad\xd8\xd0\x04\x15\x13\xa9\x06\xdd+=\xf7j0n\xc2;\x05\xf0\xef\xa0\v8\xc6\bX7u'n\xb1\xd6=$\xe9\x06oP\n\xb8ʌn\x88\xe5hC\x9fxb(\x98Nf\xae\xa8\xe8\x8bO\x00\xb0\x82\xba\x82\x9f\xaa\x1d\xdaG*v\v\xd8\xdb1\xa9+e\x01\xcc\xd2\xe9\xb0F濢4\xd8\x01\xfa\x16\xfb\xf4D\x13\v\xa4\b(\x02tį4\x96)\x18U\x9b\xdd\xc6|b\x92\xf0\x1c҄qi\xf3\x87\xf8\xec\xb9\xf7>\xd3R\xd7moHš\xad\x04\xd2ܩ\x8e\xa7\xbe|ؑ\xf8\xcf\xf7\x1dvXC\x9bTS\x16S\x15^\x12\xfe`\x16YJE/\x16\xb3\x13\x06X\xc3L\x04\x1a`\x03}]\xa7\n \x9f\x8au\xc1\xc2vb\v2@3\x1d\xa5DV\x13\x1a\x06\x1da\xb2\x9bx\x90\xf8jϑ \x8b+^8\x12\xdb(\x10a\x8a\x83s\xcb\xdb\u007f#\x9a\xf2B5L\x1dG\xc3®\xa7\x17j\x88\xdaR\x80vQ\x98L\x97\xbaJ\xbaZ)\x13\xd4\xdcA2u\xe1\xa3ʏ\v$\xcfz3Dn}\xf1/hgt\xaa.\x9aPD*\x1f\x93b\x88\x12\xe9\x1d>\xa9uu\xc5k\xe3\x1dE\\RW\xc9뺎\xed\x8eR\xae\xab\xaa\u008a\xbc\xcdHDmD\xa3\xb0\xac\xfe\x16̞\xba\x92s\xe1{(\xb0\xeb\b\xa9W\x86ڪ?&n\xff\x94\xffض(\xb5ނ#\xd0%\xef\v\xd7\xee_\xd9\x18\xfbK\x94G\xaeU\xdd\xe7\xca&\xacEJ\xae\xe7_\x01\xc1)\xec\xad:\x98\xa1\xb7\xa9\xedm5\xa6\xf8\xe8T\xc6\xf7\x9a:0\xa4\xda\xe4皎8\x94\x1b\xf7*\xc7\xfd\xbc\xfe\xbf]>\x92\x9a\xfa~Q{\xa0.\t\b\xa8\x8a}\xb4\x93\xcc;\xf1#x\x11\x93\xda\xc0آ:ѷ&\x9b\xa4\x83\b\x04\x1c\xe9V\x06*\xf4\rq\x02\xdbj\xc8.\xaf\a0&\xed\xc2 3\b\xd9*\xfdR\x92\vh\x8a\xadZ\xa2\xcaY̒|\xbd\xceڅ΄\xe3\x05<\xf1\xda\xcdkr\x02|\xf26Z\xc8Ī\t\xd2\xc9\x00\x06C\x80\xc6\xe8\xb4A\xbaqg\xf69c\x86\x8bQ霈\x94\xee\f\xdau#2\xd54H,\ue369~\xbd\x118z\xd2,c\x9b\x90\xa2\xb0\xd5$EP\x18\xf8\a\xf4<\x89\x83PG\xc8\f\xe1\x93\x0e\xb80\xdbK;\x89\xb7#0\x81\xf3\x14t\xd2sx\xb5Á\x1d\xbd\xeaD\x86'[\xd0q\xa1\x9313\x02smG\xe2\xf5\x04\x9e\xa2\xb0\x8d\xec\x06\u007fd\xa1\tp\fHA~\xc0a\xf5\xa8\xe5If\f\xf8'\xc0\xf0\xbd\x98n\x87\x97\xaap\xfeDG\xca\xe6`\x02Յ\x18\x82\xc0Q=\x8dj\xac*\xb2W,.\x14\x03\xdd\x11ܫ\xe9\xfcfQg&\x19\xb0\x04\xf2\x0e}\xa9fap\xbc$,\xf2\x9b\xfb\xa3\x8dP\xbc\vh\xa8Z\xa0\xf0j\xc3\xf3\xd2\xdd\xda-\x86\xc6\xce\xdb\xfc\xc0\x0e'\x95\u07b8cs\x80\v\x10\xc1g\x94\xa2\x93\x95P\xa1\xa9\xbbL\x9d\xab\xb8\x99\x8d\\\xa8ܲ\a6\xeak\"\xec'\xb5\xf1\u0091\xec\x9e[\x8dZ\x02\xaeꈇ\x04\xce\x16ԯ\xaf\x8c\xeb\x10\xc5i\x80\x96\xa0O8j{U\x8d\xedӻ\x98\xd55\xf4\x0ew\xa0\xe0\x04\xe7}\xa8a\x1d\xba\xd8\xc7\xf9\xfc\xf6\xf5\x86\xa0\xf3\xaaJ\xc5\x1d0\xcdo+qFM\x1cv\x88\x80\x83¹ϊZQ\x8f\x87Ĝ\xa9\x1c\bGum\xb0M\x97\x88\xa1~\xeduB\x16\xf5\xd2\xe0>\xc8 \x92\\;=u\xe9\x04J\xd7\x11B\xd8\u05eb\xb1\x8b\xe8\x9e\xea\x97\xc9\t3:\x1fi\xa9\xa5K\"b*ŷ\x18^Bf\xabDgc\xf8\x00iudAB\x8c\x90p\x055\xbb\xf8\x02\xfe\x14P\x1a\x9cԦLF\xf7\xa9=\x90\xc8\xe6:j\x90_\xaaʍ[\x86\x8e\xe4\x1dU)\xe2\x13j\x1e\x00\x0eۿ\x89\x06)\xbd\x0e\xfb\x11\xbfL\xe2\xeb--J\t\xf0\x19\xa1\xa5\r\xa8\xe2K\xc3\xc4\x03\x90R\xebM\x1dƄާ\xfb\xb7\xea4\x06&4\x0e\xb5\xbfOy\t2\xf1\x02\xdeh\xf3\xe8\x89\x11B\xa52\xa2\x10a\xb8\x0fl\x84\xa5N\v\x16\xaaZ/~\xe9\x1c\xf5\xda\x00<\x12K{\x9e\x03\v\x02h\xc2n\xdbXa\x9c\xf4\xeb\xe1\xf2\x9d\xdc\x02!\xab\x8e\x12\xa8\xa2\xa8\x1a\xb8Zl\xb4\xefO\xb8\x85祵\xe5\f.CHܧ\u008c\x8f(\x92%/>OЊ\x8eP\xd57B@E>\xae\xeaH\xa9&r\xafF\xea\xfa\tN\x04\xb5\x17\xc7gg+\x01_\x1b\x99n\x1e\xb9\xfa\xf6\x02\xb4\xa7\x1e\x8d\x99\x99\x12\xfb\x8e5\xd5\x19<\x95\xe3\xf0ք\xeb*\xab%\xa4\"+\x13>\a\xb9:\x14\xa6Nx4V\x95\xe1\x03l\xf7;\xb3\xd7\xed\xaa_\u007f\x03\xbcG\xabVO6u\xa2ƺ\xbfj\x18\xc6P-\x99\x1b}\xbd\xeb'\xc5\xf2Y\xb5Kz\xe4\xbb?\xedr\xf0S\xc0\u007fb\x10\x98\x10\x87\xdcOX\x0f\x9d\x12Qi\xe1h\x17K\x9b\v\xc4?ҧ\xfe\x06\xeaۿ\xc3;\xe0\xee\xbe\xe4\x01\x80\xda\xdf\x11\xc0\x8fA\x12\x10\x1a\xe6w\x90\f1\x15azvA\xe7$\xech\x87\xe7\xcf\x05\xd4\xfe\xfd\x06)\xd8\xc5\xc4C\x15\x89\xb9\x91\xd8\f\x13\x8e\xd4a\x06\x9d\x1f)`\xb1\xfaᇻ*3\\\xf5\xf4\xa3\xa1%!\xf5ӤRX\x12pn\x01Q\xd2\u07bc\x96\xa1$$t\u05c9\xf0\x16\xc0W55ʎ\x13\x8a\x9bU\x83`XU\xd5y\x02\xdfFP^\xd2s\x8b\xa5\xd1\xd9W\x1d\x15M\x87J\x88\\96\xbc\x12\x06\x87\xe9\xa8L\x97*f\xfc\b\xfb\x94\\D/\xc1j('mc#\x13\x1b~k\x1fnW燻\xeaI\xdag(\xda\xc7\xce\xfeh\xe7\x82\xf4\rv\xb5\x1dq\xf0~j$\x01\b\x1c\x0eN\x95,\xfc\xda<\x00\"s\x8b\xb8\xd4\xc3\x03&+\x89LW\xff$ɑ\x01_59\xe1\\\x90}\xc8\x19|\xdcV\u05cbW}7\x96\xe0\b\x84\xf6\xfc\n+\xa6\xedA\x9c\xb6\xbd\xe6w\x1d\xdfP\x8f\b?\xf0\xda\xf8\xc2C\x83\x1c\x98:\xe8\xf1\xe8Le}\xc5T\x1d\xd2\f\x9e\x14\xb1\xa8G\x1bT\xe0\xe9\xa1~C\xb8b4\x87:w\xacz\xeb\xfbm\xd0@\xef\b\xba&\x1a\x83z\x12dy:\x8c\x88\f\xdbG\xe7\x19sv\xf0\x1e\xb4\xd8p\xd1\xe1\xf5B\xe8<\xa9\xaaҠ\xe59O\x06)\xffSD\xfcE\x1b:\x01]rR\xc9\xd3tZ\x16\x1f\xa0\xf2v\x18\xc5qc#&\xbc\xb8\n\xf9\x80\x18?m\xf7\x1d\x1c\xd3\xfeҀ\xa7u\x88\x01H\x9d\xea\\\x00A\x12\x93gh\xf3\xaa\xa6$\xe1\x9d\x1a\xe26\x91\xcd(U\xa1az\xe4\xa6s\xd1)\x91\xd2\xc9ZR\x1b\xfd\xac\xb61B\x1c\x83\x83^\x18[\xc5\xe4\xda-\xa2\x14P\x99%\xe8$\f\x11\x84\x17\xe1VL\x98\xabf\xdcr\xf2\x06\xdf;\x1a\xb7\xaa\x81A\xf1\x00<\xb2V\x8c\x03j\xc6O\xc2\x1d9\xbd^\x1fd\xb2\xb0մ\xf7\xb7\xf2r\xf9 3\x99ٜp\x9d+t_U9}xss?\xc8uF\x04\xa3\xf3\xa9\xe43b\x13\xc75\xf9d\xfcj\x0fpi\x1e\n\xb6\\\xdc\xd2\xf9\xd0\tU_\xa2\xa3\n\x99M\xe7\x05\xb4yַj+\xa6s6\xe1\xa4s\xd3ǿF5\xc4\n\x9eU\xf5\xc9\xda*ʅ\xec\xd7\tڃ\x12\x05\"\u05cc,{G\x80E\xecsj\x11\x8b\x13\x98u\x89\x1e\x9dt\x9f*\xab\x03\xeb\x0f\x03\xe0!\xe4\x9c\xc9a\xd4\tF8\xde\x0eae\x1d/\xc8W\xbdC\x04\xa1\xb6\r@X&\x8e\x0f}\xa5N\xb5?͋L'\xef\xb1e\x18\x9f\xc8\xeb\x89Op\xb1c5\xd5z.\xfd\xcd\x1c\x10x膻%\x114k\xc8\x03\xb0\x10\xaf\a|G\x01x\x93\xef|͙\xcfQ褐\xf4)\xc6\xe1\x16.\xaeޚ`:\xd4\xdf\xfa\xc99euU\x10\xb4:\xa1\x00{\xef\xfan-m\xc2\x1f\x02AA\xc0\xe7\xebuH~\x0fi\xae\r\xbc0U\xae\a\xc1\xac\x87\xf1\xb5\xfeAh\xaf\xa2\xc6\xf2ﰓ\x8a\xbb\xc6 \xb5\x83\x85\xc8Zs\xc9\xd4\x1a\xc2K\x99\x00\xef\\\xf4\xdc08\xdc)\x1d\x1a\x88\xa0\x1d>\x16\x03\x8d\xe0\xe5\u0588\xe8\xdcL\x87\xa1\xd41\xa0\xa9A\x89\x02ښ9e\x95\xce(\xbe\x1a\a \x9a3\xf2\xd6!e\xd0\r:Mܚ\xff\xf4R\xfa?\u007f\x9d\xb9Ԥ\x03\vU\xbd\xbbcOu\xd2@ۂ+m~\x14̡\n&\xee4f\x12\x88#\xf1P\xea\xe8KU\xc1\xba\xfc'\x9e$\x045\x04BxQ\xc7\xce!q\x92~\x85\xd7\xe0NXB\xfe:\xdaT\xd0G\xa8\x11\xed\xa2Ny7\x12]\x8d\xb8\x12\xbeD\x15\x99HL\xc3\xf1\xea\xeaޛ\x98\x84\xa6\x0eA\xae\x93qz\x12\x03j\x83\xb42\x92I\xa0W\xb1\x10\xaa\x8bE\xadR.xŮ#\xa6\xdcP\xd4<\x10%(\xe1w\x16_\xf2\x15\x19\xa3\a\xb2\x14\x1c\xc4U\xc9\x04\xed\xd5\x12\xc6\x04\xca8\xd9i\x97\\\xa7\xd6\xd5\xd9,L\xc7\xd0FDQ\x1f*y\xaa\xc3\x01\xfa\x8b\xf9`\xd2l-\xed\x0e\x9f5\x941`\xc8Q\xc3\xf6\xf4\xd2tу\x90]\xb6T\r\xf8 V%\xceoO\x8f\x92syA4\xd5\xcd\xd3U\xd8S/!\xf0\xc0\x85\xf9\x01\xe9߉\b\x1c\x06\x8b\x8d\x91q\x80^ԓ\x15Ԓ^\x99,\f\x9aZ0U)S\xf7\xbf\xba\x02\"XMī\xadQǚy#t\xf6\xca~\xa2\"\x1dj\xe9\a\xbf\xb6\xb5\xe9\xbc\xfdl\x84\xf3\xee\x19MOn\xa4ǂ:\xad&\x1a\a\xfb\xaf*\xc9\r\x8e)j\x9dǻ\xca#5D\xb9\xce5\xa2Ӌ;:0\xdf\xd4@\x03\xdb\xe9\xdc%p\xa2\x06[B\x80\x85\xd3\x11ˢ\xed`u\x0e\xaa\xe4\xa1.\xaa\xa3~\xffC\xb64\xc90\xec\x88\xf4\x98\xeb\t\x83\xc2\n\x83\x1fP\xea8^'J\xb8\xa9\xbc\x92\x0e\x89\f% ȣ*\u007f\x86L\xd0\xd2:2S\x12Q\a\xcc4\xf5H\x93\xb9\xa39\xc0\f+\xf0Q\xad\n'\xb9\x14\xee\x90\xf9\xf4H95\xa3\x13\x85\xe5l\x9d\xa7\x8co\xf9\xb9;|\f\xce\xddkgd\xec$\\T=\x1b\xa1\xde\xf5\x94\x03І\xa8\x98U[\x16\xb9\u007fN\xd7EU\xda\x1b\x94\x840\"B\x92\nP\xe3q\x8b\x1a]\xf1\xfbU'ݚG\xf9\xab\xdcp\xc7\x18D\x00\xa1w0\xa1\x10eV\x87~Q\x17#6\x1bFm\x9d\xb0~\xe7\x1a\xb2\x1a\xdc\xd0>\xbc4}ʎ\xe0\x9b\x1f%;UR\x8ej\x06\xa6\x03\xa3 \f\xf6F\x1b\xd9$kߤDH\xb8t+q\b\xd5\U0006e101j\xe1Q\x9a\x06c\xa5ê\xac\xff\xc5fi#\r\xdc\xc9\"\x11\xf5\u007f\xbev^ޝ_\xee%\x15\xb2\xe0\xbaJ\xe0w=e%CB\xc8^Dȧ\xb3K\xa6\"\x96\x02\x8b\x186\\\x87\xbeR\x98\xcfPk7\x8b<@\xf6\x9b\xe9\xb0j g\x02&T'\x91\xab\x856՞̸1A\xaf\x10\x8e\a\xe6\x92\xc1\x11)Ӵ\x19zt\xa8e!\xe5\xdeQ@\x88\x90\f\x86\x95\xbb:\xcdPDq\xc9\xf0\xef+\xa9\xa6\xa6F̌\x9d)\xbf\x8eJ\t;8O\x14\xe1\x134\xcb\x05\x03.\xad\xfd\x9e\xfe$'\x84PP\x178/-:\x86 :\x03\x8a\xb7Ώ\x03\xa9\xfctՇ\x93zr\x90{G@\xd4z;\x06\xb1\xa9\xb8\x9cO\xc3W\xaf~\x05\xe6$ 媞|\xa3\xc3;\\+\xbcm]d\t\xbe(\xc1\x0fR\xbdکI\xea\xc8R\xfb\x841q\x06\x19/\x1d\xbd\xe9Q[s\x11!\xcb|om\xf6\x82\x9d$ \xe2\"k\x8cd\x9e\xa0X!\x9c\x1b7\xa4\x93\aA>Zm\xd8\xe2\n\xd39M\xf2Q\x8d\v;\a\xaf\xe6\x8f\x16\x82<\xe5D\xe3e\b`a\xbf\x01\xfcq\xbbz͆zZ\xea\f\x01\xbb\xee\xd51\xf5\xba.wѨ\xf9\x10 Z\aF\x83\x8e\xf11R\xaf\x0e\xae\xb7\xc5t\x87p\x17\x16\x84\xd5t,\xbb\xebN\xb4q\x83\xf1\v\x9fs\xdbU\xfb\x80\xe9=\xff\x80\x1c\x9e\x89\x9b%\xc5t.\x0e\xed\xaa\xbe5ٌ\xb9\xee\xc0\xee\xa1\fs\x80`}$\xb1\x86n=㲻\xba<r@\xc1M\fb\x13\xb7\x0f\xf5\x827\x04\xc3D\xaf\xdf.\xb3\x89\x9cP\x83pV\x87\x8d\xce\xf3\xab\xf6|\x04\x9b\xe7h\xebv\x90\xb4\xb9\xa9~\xee\xa5jm&պ\xcb\x16Z\xb0p3\xac\x81L\\\x01\xbcn\x92\xe7w\xeb8.\n\x99\xab\x92\x94E\x95\x9c\xa6\xf6\xc2\xc7\"\xc4ZB\x94\x9f\x89\xd6\xc7\x18\"3\x96D\x9a6\xda\xc9\x1d\x88\t\"$#q\xbc\xf8\x13\xbc*\x86L\x0f\xbd\xc1\x13\xa2\xe6\xf1T\xea\xf5Bh\xa9\xbbMg\x0e\xa7\x8e)\xea\xdc\xe6<:\xd7]\x85\xd0U\xb4\xa1\xf3\xa0:\x1aW\xfb{\xbe\x8e\x9a\x93\x0e\xd0{\xa3\x9a\xac0\x15\x12bv\x83\x9b\x84%\xa9\xc65_\xdf4\x9f\xa8\x0e\xcf\x0e\x05\x86ׁ\xcak\xaf\x9a\x00\xf8\x9ev\xb1\x16\x19.;Ol\x12Y\xe1\xf3\xfc\x115\x93\xb9\xa3'\rM\x90\x89\xa0\xe9\x16\xa2N~n\x1d\x8f:()\x9d!$}\x8e]\x16M\xf7\x81\x8bc=\xd4\xe38\x0fk\x94Hl\xb5\xb3\xfb\x95\x88\xec5\xd4Ls\x98\x13\xb0\xb8\x81\xfc\x00C\xd09=\xed(\xb0\xf4: +H) B\xdaG\x05\xfa\xd7֪\xf6+\x80'\xc1\xc0\x03\xccn\xb5\xa2\xef\xd5\t\xa1&\x00=N\xa0\xea\xd0\xf2hz\x16\x80\xa9l\xcc\xd8\xe4\x88:\x04(\xfb\x9d\xc4\x1d\xea\x9aYjS\xb6\xdcH2\x18\xcb\xf5w\x980\x1f~\x02\x12\xa1{L\x8a\x1e{K\xccꄝj\xd2:ސ\xb5A\x9e\xd5,\x9e \xb3\xe4\xe3\xd6ћ\x8e\x8e\r\xdc.\xa6\x06\x97\xa9\x83\xedzB\xc6\xd1q\xf7\xae\x83\xd7\x1eO\xa2ǂ]\x1d\f\xc0u\xc2gjȭ\xf5S\xe4؟\xd6\xf5\xbd\x01%\x1dk(h\x16ן8W\t\xb3\xe9\xe8\xddџ \xbc\x97\x145Y\x1ac\xe6\x11C f\xd0\xe3|\xd4\xcfu\xf5p\x1b=\xbe\xe3{M\x1d\x02r\xb8\xc7?}V\xd7\xf7\x19\x00\x99\x84\xe0L\xea\x1aԖ\x8fL\x12\x8e\xa5\xdcOQ\xec\xa2N,\x8c\xa7\b0\xe3\n\x1c!\xa4T'*J[P\xaa,^m\xb3`b\xdeq|2\xf9\x84\xad\x06#l\x9a\xd7!$\x9d\x11\xfd\x1c)\x80:\xb7\xeaH\x12\xf3\xab\xbbә\x025\x0e\ngsC3\x0fu\xaa\x8c\xaeւ\x85|\x94^\x8c\xc41\xa2\x87\x15S\xab5)\xa6Ƕ\x90EPͲ\x87\x97\xd3\x05=\x1fJ\xcf\xefҳS\xc4\xf3\x82\xabC4z\x81 \x01\x1a\xb4\xff\x97\v3\x84CC\x81\xbc\xc7z\xe9I3*\x16\xbd\xee܈\xc49NEJ\xa2#t<\xa1\xea*\fP\xed.̱\xce+\xaa\x19M\x9b\xaa\xa0,z\xf2\xbcS~{\xaa\xfb\x01\xc8\xc1&p\u007fz\xe0\a\xf7\xe6\xb8'\x9d\x02\xd6\xc7,u۱\xa4j.G\x1a\xa8Ϋ\xb3\x86\xc6\xeb`\x18m\xa6\xa8\xcdnB\x88\x9f\xeeu\xb5N\x13A\xe44\x9aϩ\x8a\xa1G\xe7\xa8-c\xea\x91\x1fZY\x9d@\x97=\xbedA\xb6\xa2\xfb0\xa5/\x1c57lw\xf5\x889\xa8\xf9\xc4\xcf᰼\xa2\x1bxޭSQ\x18d\xf5\xafy\xbd\xb6\xa2[\xf5@\r\xe6\xf8x\x1b\xcc\x14\x9a\xfd6\xf2'\xabkF]!j\x8fԣ\xf8\xbc\xda\xfd\x91SN\xfb{GD\xa13D\xa0;\xf3\xf9\xfa9\x11\xadp\xaad\xf4\tڹN\x12`\xf7\x88u\xed\xc2GGBi\xeb\xdc\xc0\xd2\x11\xf1\xe4L\x0f̀\x80\xf7;\xb3\aJ\xe9\x04\x82ZK\xa5\x03T\xa2y\xa1\x9aOG\xe4\xe8\x18b-\x9eO!\xbb\x02\x02Q\x87s\xfaxB\xcc\xf1o\xb5?n5ʘz@aX\xd8\x14\x96\xba:\\\x11\xde!VE\x95N)mn$\xabڊ 9z\xb2\x04\xca\xc8CF\xbe\x1a\x82]\xa5\x12\xf5\x1e\xa2\x06\xeb;\xfd\x04\xaeԭ(\x1eU!\xaa\x86\xec\t=\xab\a\xc9c\xe8Uy\x8bjJ\x8bK\x0f\xd4\xe1V\xa2:\xc6w\x87\xcc\x18\xe8\x14y\x05\xa4\x18\u007f.\x1d\x88l\x13\a\xd1\xcak\x05\x9c:s\xaf\xb9U/U\xfeVb\x10\xd3I\xc7\xef\x96Δu\x87w\x8bC\xe7\x99\xe60$@imw5\xc5B`\xd3\xf4\xf8%\x82\x90e\x87\xc2u\xf2\x88\xcc\xd3\xd1\fT\x01\x11\x80\xad|\x88=\b\xbf\xe8P\x80\x9d\x18E\xd5\xe9x9\xf6\t\f\x88xS\xaf\x98T\x1f\x8c\x9e\x83\x85\xb7抅[6\x1dF\x96\xf0KQ{\x00hh\xd3\t\x9d\x14\xdcR'!\xe6\xac\t\xbb\x06\xf3\xa9\x03j\xda\xeb+E\x9d\x81\x83I\x8a:T\x89,\xafj\x11\xdb\xea\u007f\x87j\x82\x0e\v֡gJ&\x89\x00\x97p\xafz\xa4\x84:/\xf5x\x15\x04?\x1ah\x9a\x1cD~;\x9eX_\v\x9b\x81+St&\a\x00\aIC\\x\u038b\xa4eX\x88ѱuB:!\f\xd5\xfa\xa2\x93lW%\xf5\xb7\xc3\x05\xcb\xc2\aK\xc7Ҥ?y)\xb45L\xe8{\xb2\x1e\xad\x84.\xbc\xc0\xc1\xc0bB\xd9\x18\xe6\xb7\xef\x81\xe2B\xeb\x1e@$\x885\xe0\xc1\xab'\xa8I\xe8!/7\x8c\xc1\x02\xebČ\x0e\x95B%:7\x1e\xb6\xfa\xebA\xeb\xe1n\xae܉b\xa8\xe8<X7\xa6Pmcz\xb0\x8f\xce`\xdd\xe3\xd5\a\x02\"\xe8\xb0\xe6y\a\x1e^\x17\x92\xda\xf2\xd58\xb41\x9cz\x00\x8e\xe9!\vz6LV\x93+,\xf0\x0e\x86\xc4\xfa\x98\xf5\xbe\xde\x03P1\x16\x95M\xb1\xfcx<\x88Y\x87\x0e\xd1T\x02Np=\x009\xd1a\x03u\xb8G\x87Ч\x8e\x18\xc3\x1c^]\xb1DtS\u007f\xb1Ρ\xe9\xac\x1f\xe9Ђί\xa8\x87\xc5\xeb\x98\a\x9e\x86\x8b\x05\xd8\x0f\xf6jN\xfb\x1a\xe8N\xd6W^5h#\xf1\xa8(\ue2fa$q\x1aE\xa7~\xb1k8j\xbcY)\xaa'\xeaX\xc8\xc6\xfbi\xdf\x04\x9c\xc6\xd7-\a\xe1<T\x91\\(\x19\xc9}\x00\xe4\xc9Z\x19i\xd9u\xb2]\xed\xba\xb8!\xaf\x87(,\xf5\x1d0\x8f]{[@\xc5Q7\a)M\xae\x99y2\xc8\u0530J\xbc0\xa9\x18\xfa\x95\xd52\xa0\xf6?\xf5\xb0(\x18\xc8\u007f$\xb1N\xb7 \xdf\xca\x02\xe6\xbcW\xbb\xaf\x9eS\x81\xf9[(\u007fu:m\x15\xb1߃O\xc9¡\xf6!֜pK*\xb8\xf5\xad\x9a\v\x12\x1e\xf2\xf7\xb6\xf4\x88/=\xd4K5\x99\xab'\xaa\xe9!iP\xa1\xd3\x11\xbf\xa8\xe7\x04\xe0~\"\x10\x83\x80^\xda]\x81}u\x1c[%\xda\x11\xd4=\xd8@D\xd4\x1eާ\xbd\a\x8eXK\f\x1fA\x89a\xb05\x9d\xc4/`)\xfe\xd2\xc1\x10\xc9+\xd8\x06[\xaeG\x1ch'\xbd\xaa\x87\x93\xc9\"\xb0\x89J\xbb\t\xb8\xad\xcc\xfc\x9ezJ\x06ɀ\x91\bx\x91\xf7\b\xc74\xd4\x18B\xbc\xa0p\xaa\xba\xe43\x13RՇ\xa7\xeaa\xaf)\x01\xf8W\x87䧞_\xf4\x1e.\x98Y\x8b\xabc\xa3z\x94N\xc1\x8b0\x03@\x9a\xa0\xe4~\xb7u\xf48\a\x03\fM*x\xea\xd1Z*\xefv\xf8\x13\xd9O\x90\x02\x99\x98\x98\xff\x05\x10;\xece\x0f\xf0ύ\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x02&\xfa_\xa2k\x00\x00 \x00IDATx\xda\xec\xbdy\x94d\xd7]\xe7\xf9\xb9\xf7\xbe\xfdŞ\x99\x91k\xedUZJ;\x92,K\xb6\xe5\xdd\x16\x98\xc5>\xa6\xa1\xcdb\xe6\f\xab\xa1\a\x06h\x067\xaba\xfa\xd8`\xc0\xa7\x81\xc6tc\xf7\x9cih\xb3\x18\x1a\xb0\rƖ\xb1-\xb0e[\xb2%\xb9TR\xed{ef垱\xc7[\xef\x9d?^dH\xf24\x8bM\x99.\xcd\xf0\xeaĩ\xd2QfD\xbc\xf7\xbb\xf7\xb7}\xbf\xbf\xef\x15\xc6\x18\xfe\xe5\xbav.\xf9/\x8f\xe0_\f\xf2/\u05ff\x18\xe4\xf9sYW\xeb\x8d\xf2<\xe7\x13\x9f\xf8\xc4?\xfb\r\b\x04\xe7\x9f\xfct\xe8\xf9\xde>)䭶m\xed\xb5,\xab-\xa4\xd8\x006\x8c1\x9b\xc6pE*{\xb54s\xf0\xcbz\xef0\f\xb9\xef\xbe\xfb\xfey\xef\xe7j\x05\xf5$Ip]\xf7\x1fxx\xc5\xf5\x95|\xa2\x94\x92\xef\xf9\x96\xaf\x13\xf3\xf3\xd33^\x10\x1e\x9e\x9c\x9a\xb9u~n\xf66\xa9\xe4\xadFg\a\x81\xf2\xce\xc2\xc8Ҕ\\k\x18ߛI\x80/hm\xfe,M\xf3\x0f\xf4z\x83\xd3?\xf5\xb3og{\x18\x93\xe6\xfa\xef\xfc\xcc\xef\xfe\xee\xef\xe6\xbd\xef}\xef\xf3s\x87|\xe9\xc3\xdb1\xb4\x10\xa2\xf8\xf7迿\x1ccx\x8e\xc5/\xbf\xf5\xfb\x03\xa9\xd4\xfd\xf5f\xf3uӳs_\xebX\xf6\x9e\xa9\xe9i\xcbq]\xa4,<n\x96e\b!ƿ\xa7\xb5&\xcfs\x928b0\xe8\x93$\xa9\x13E\xc3\xfb\x06\x83\xc1}\xb6\x90\xbfT\xad\x85O\xfd\xd6o\xfe\xfb?K\xe2\xe4\xcf\xd7W\xb7\x9f|\xf0sG\xcd\xfb~\xff\xf79\u007f\xfe<?\xf9\x93?\xc9\xc7>\xf6\xb1\xffi.\xeb\xaa\xef\x10!\x04b\xe7\xc1\u007f\x05F\xb0-\xc5\xcf\xfd\xf0w\xee\n<\xf7k\xcb\xd5\xca\xeb\xf6\x1c8\xf4\xf2j\xadV\x1e\xf4z؎\x8de\xd9\xe4Z\xd3m\xb7\x89\xa3!Y\x9a\x92\xa5)i\x9a\xa0\xb5A)U\xfc\x9c\xed\xe08\x0eBJ\xa4*\f7\x1c\xf6\x91Ra\xc4h\xbf\x1ac\xb2,?\x93$\xe9\xffu\xf9\xe2ү\xbd\xf5\xed\xbf\x95nmm\xf1k\xbf\xf6k\xbc\xfd\xedo\xff\x9f\xb2C\xae\xaaA<\xcf+\x8c0\xda\x15\xe2\xcb4Ư\xfd\xd4\xf7[\xc0ۼ0|\xeb\xf57ݤJ\xe5\n\x9bk\xeb\xe4Y\xca\xd4\xcc4뫫\xac,^&ϲg\xfd\x96AJ\x85\xed8X\xb65\xfax\x81\x90\x02\fhc0:\x1f\xed\xd6\xc28\xae\xe7!\x95BZ\xaa\xd8\xc1\x801\xe6s\xc0\x9b\xa7\xe7\x0f\x9c\xbe\xf3\xbe\a\xf8\xed\xdf\xfem\x9e~\xfa\xe9\xff\x0f\x18d\xe71}\x19\xef+\x04\xbc\xeb\xa7\u007f\xa0)\x85\xf8oҲ_}\u05cb^\xcc\xc5s\xe7H\xa2!\xfb\x0e\x1d\xa2\xbd\xbd\xcd\xe5\xb3gɲ\x14\xad\rY\x9ec;6\v{\xf6И\x9c\xc2rll\xdb\xc6\xf3}\x1c\xc7ƶl\x84\x94\b!\xd0Z\x93\xa5)\xd10\xa2\xd7\xeb\x91&1RJ\xf2,C\x1b\x83\x94\x82\\\xe7\xe4y\xce0\x8a\x06Ƙ\xefV\xca\xfeï\xff\xd6\x1f\xe2\xe1\x87\x1f\xe6%/y\xc9\xf3\xdf _\xce{VB\x8f\xff\xf3\xc7\xff\xd7{\x95\x92\u007f\xa8s\xbd;JR\x94R\xec\u07bb\x97\xb0\\f\xf9\xd2E\xba\x9d\x0e\xfdA\x84R\x92r\xa5\xc2\xf57\xdd\xc2\xfeC\x87\b\xc3\x10\x83!K\x13\x8c\xd1h\xad\xd1y\x86\xc1\xa0\xf3\"\x8eh\xad\x01\x811 \xa5@)\x85\xb2,0\x86\xe1pH\x1cG\xe8\\\x93&\ti\x9a\xb0\xd5j%q\x14\xff\xa4\xe3ڿ\xf9\x86\xef\xf8\xb1\xfcy\x1fC\xbe\x9c\xebw~\xe9\xdf\xcaյ\x8d\x1f\xac\x84\xc1\xafZ\x96r\xdb\xdd\x1eR\b\xa6\x9aShm\xd8\xdc\xdcd\xbb\xdd\xc5\xf3=\x0e\x1f\xbe\x81\xfd\x87\xaeczv\x16)\x15Zgh\x9d\x17\x89\xaf\x10\x18\xa3\x89\xa3!I\x1ca\x8c\x1e/\n\xad\rB@\x9ek\xb24\xc7h\x8deYH\xa5P\x96\x8d\xe3:\bQĘ~\xafǠߧ\xd5\xde6I\x9a\xfe\xdfB\x88\xb7\xbc\xe1;~,\xfe\xff\x85A~\xe7\x1d?V\x1eFɻ\xd7\xd77\xbfc\xba9\xc1\xe6V\v!\x04\xd5J\x89(IY\xdf\xd8BH\xc9-\xb7\xdc\xc2\xdd\xf7\xdeMX*\x8fVz\x8eѦ\x88\x11\b\xb4\xceI\x93\x84$\x8eȳ\x94\x9d4\"\x1f\xa5\xb3;I\x06\xcf\xca\u0084\x10H!\xc9\xf3\x9c4MQ\x96\x8d\xef\a\b)\x89\xa3\x88A\xbfO\xa7\xdba\x18\r\xff\x14\xf8\xf67|ǏE\x8f?\xfe8\x8f<\xf2\xc8\xdf{Ooy\xcb[\x9e\u007f\x06\xb1\x94\xe4\x97\xdf\xfa\xbd{\xb3,\xff\x93\xadV\xe7ΉZ\x85a\x14\xd3\xee\xf6\xf0=\x97\xc10&\xcdR\xea\x95\n\x13\x8d\x1a3\xf3\xf3\xec?t\x90J\xad\x8a\xb2,\x8c.\\S\x92$\xa4IL\x9e\xa5\xa4iF\x92$D\xc3!i\x92\x90e9Y\x96\xc2(\xc0+\xcb*Rd!p\x1c\a\xcf\xf3\b\xc2\x00\xcf\x1f\xb9X\xad\xc9s\x8d\xe3xX\x8eM\x9af\xf4\xbb]\xba\xbd.\xfdA\xff]B\x88\x1f\u007f\xe0\x8do\xe1\xcdo~3\u007f\xfc\xc7\u007f\xfcwޛ\xd6\xfa9\xe9\xf75S\x87\xfc})\xedO|\xef\xbfzI\xab\xdd\xfd\xa3<׳i\x9a\xb2\xba\xbe\x856\x1ac\xa0\xd7\x1f\x92k\x8d\x14\x92N\xaf\xcf \x8ahw\xbal\xae\xad\x12\x84\x01A\xa94Nk\x95\xb2\xd0\xc6\x00\x02\xc7u\U00043409\xa9\x1a\xb6m\x8f\xd2\\A\x96&\xc4q22R\xcapЧ\xdb鲱\xba\x8a\xd6\x1a\xcf\xf7\xa9\xd6\xebLLN`;6y\x9e\xa0c\x8d\xeb\xf9\xa8Zu\xe7k\xffp\u007f\xd0\xff\xb8\xe7y\x1f~\xcf{ރ\x10\x82\xf7\xbf\xff\xfd\xcf_\x97\xb5\xb3b^z\xcf\xed\xdcs\xeb\xa1\xefv]\xfb?ڶ\xe5I)\x19\f\"\xd2,\xc3s\x1dڝ\u07b8fql\x8b\xdd\xf33\xec\xde\u007f\x00\xc7\xf3\xc1h\x1c\xdb!(\x97\bK%l\xdbAJ\x81T\n\xdb.j\r1.B5Y\x96\x8f\x8bF\x83A\x145\af\xb4\x8a\x8b\xack\xc8\xd6\xc6:k\xab+D\xc3\x01\x8d\xc9I\xea\xf5\x1aA)\xc4R\n\xc7\r\xc8\xf3\x9c\xd6\xf66\x9dng#\x8a\xa2\xaf}\xc3w\xfe\xd8\x17\xae\\\xb9\xc2\x03\x0f<\xc0\x93O>\xf9U\xd9!W7\xcbr\xdd\xe7\xd4\x1d;\xfe\xfb\x86\x83\xfb\xac{n\xbf\xe1W\x0e\xed\x9d\xfd\x11\xa5\xa4Ȳ\x8c\xe10\xc6q,\xea\xb5\nR\n\xce]X\x02\xc0ql\xfa\x83!\xb3ӓ\xdcy\xcf=\x1c8t=\x8e\xe7aLQ\xf4\x89q\x8d#\xc6\x05\xdf\xf8\xb3F\xaf\x9dJ\u074c\\[\x96eض\x83\x92\x12)%\x96e\x91fi\x11\xe0\x95\xe2ԉ\xe3\\<\u007f\x16)\x15\xe5J\x85\xe6\xf4$~\x18\xe0\xb8\x01Y\x96\xb1\xb5\xb1I\xbbӺ\x92f\xf9\xbdo|\xf3\x8f_|\xe2\x89'\xb8뮻F\x19\xdc\xd55\xc8U\xed\xf6\x9a/\xe9[\x89Q\x1b\xe5\x15\xf7\xdd\xfe\xeb\x87\xf6\xce\xfe\xef\bD\xaf? I3\xa6\xa6\xea4\xa7\x1a\xb8\xaeC\xae\r\x18\x98\x9c\x9c\xc0s]\x1a\xb5*\xeb\x1bۜ>v\x8c\xd5\xe5e\x84\x10x\xbe\x8f\xe7\a\xa3\xbf}\x1c\xcfŲm,\xdb\xc6v\x1cl\xd7-^\xa3\u007f\xbb\x9e\x87\xb2m\xa4\xb20@\xafߣ\xd7\xef\x13G\x11Y\x9aQ)\x95\xa9ժ\x04a\xc8m\xb7\xdf\xce-\xb7\u007f\r\x96m\xb1\xbd\xb9ɉc'\xd9\xda\xdc\"\x89\a(\xa5\bJ\x01\xb6m\xcf\n\xc1\x1f\xff\xe1\u007f\xf9\xa5\xf0\x8e;\xee\xe0w~\xe7w\x9e_\xedw!\x04\b\xc1\xcf\xfdțo\xdf5;\xf9\xfdI\x92\xd0\xef\x0fh\xd4*\xcc\xcdL\xe2{.a\xb9Dsv\x9a$\xd7؎͡\xeb\x0e\x81\x10\xec\xda5G\xb9\x14\xb2te\x95K\xe7ϲ\xb2\xb4H4\x1c\xd2\xefu\x91R\xe1z>\x8e\xeb=g\xc7\xec\x04{\x84\xc0\xb6m\x94e\xe18\x0e\xe5j\x85j\xad\x8e\xebzdyF\xbb\xdfg\xab\xb5\xcdvk\x9b4I\x91R\x12\x84!\a\x0f\x1e\xe4\xd6ۿ\x06?\bȳ\x8c\xb3'ϱ\xb6\xb2F\x9a\f\xf1\\\x9b \b\xb0\x94\xbc۶\xec\xb7\xff\xf4\x8f\xff o|\xe3\x1b\xb9\xf9曟\x1f\x06\xd91Ʒ}\xe3+\xa5m\xa9_\xef\xf5\xfb\xcaql\x16暄\xa1OX.1\xbf{\x81ٹ\x19\xfa\xfd\x01+WV\xa9T\xcax\xbeO\xadV\xa5Zkp\xf7\v\xee\xa6\x1c\x86\\\xbat\x99\xb5\xe5e6VW\xe8\xf7:\xac._fs}\x15c\f~P\xc2\x0f\xcb8\x8e\x87\x10EC3ϲ\"\x95U\n\xd7\xf3\x8a\n\xdes\x99lNQ\xadձ\x94\"ך\xedV\x9bn\xaf\xc7p0\xa0?\xe8\xe3\xba.{\xf6\xec\xe1\xde\x17\xbf\x98م]dY\xc6\xf9\xd3\xe7\xd9\xda\xd8B\xeb\x9cJ\xa5\fF#\xa5\xf8\xbe\xeb\x0f\ueeb9V\xab\xf1\xaew\xbd\xebyb\x90\xd1k\xd7\xdcķ\x0e\x86\xc3\xfb'\xeaU\xea\xb5\n\xae\xe72=7\xc3\xfc\xaey\x9aSSl\xacms\xfa\xf4\x05,\xcb\xc6wm\x1c\xcbbn~\x9ea\x9cPmLp\xe3\xe1\x1b\xb1-\x8b\xe5\xa5E\xb6\xd6\u05c9\x87\x11\xda\x18\xfa\xdd\x0e+\x8b\x97h\xb7\xb60Z\xe3\xb8\x1e~P\xc2\xf3|,\xcb\x06cH\x92\x84<\xcfGY\x99\x83m\xdb\xd4\xea5ffg\xb1m\x1b\x03l\xb7;DqL\x9a\xa4\xb4\xdam\x94R\xcc\xcc\xccr\xdb\xed\xb739\xdd$M\x13.\x9c=O\xb7\xddE\xca\x02\x1f\xd1Yꅾ\xfbk\xbf\xfd\xae\x9f\x97\xf7\xdf\u007f?o~\xf3\x9b\xafm\x83\xec\x04\xb5\xb7\xfe\xe0\x9bʾ\xeb\xbecz\xaa\x81\xef\xbbT\xaaef\x17\xe6h4ꔃ\x12g\xcf^\xe4\xfc\x85Kض\x8dm[\xf8\xbeG)\xf4\x99\x9f\x9f#\x1a\xf4\xb1\x1d\x97əY\xf6\xed\xdfO4\x1c\xb0\xb5\xb1\xce\xf6\xc6\x06Y\x9a!\x84$\xcbR6VWX_]&\x8a\x06h\x93c\xdb.^\x10\xe2\xf9!\x96e\x91g9i\x9a\xa1\xb5\x1e\as?\xf0\x99[\x98\xc7\xf7}\x8c1l\xb5\xda\xe4\xba\xc8\xccz\xbd\x1eFk\x9a\xcd&w\xdf\xfbB\xaa\x8d\x06\xbdn\x9fK\x17.\x13G1\xf5z\rc\fR\xe8W\xcdM7\xbe\xceu]~\xf0\a\u007f\xf0\x1a7\b`\xdb6\xbe\xef\xfe\x1f\x8dFe\x8f\xeb:L6\xa7\x98\x9aiR.\x95\xf1\x1c\x9fS\xa7\xcfq\xfe\xc2E\x10`Y\x8a \xf0\xe9t{di\x82cYLL4Ȳ\f\xd7\xf3\x98۽\x87\xbd\xfb\x0f\xd0\xed\xb4\xe9\xf7z\xb4\xb7\xb6\x11B\xe2\xb8\x1e\b\xe8w;\xac.]\xa2\xdbn\x91$1Zk,\xdb)v\x8c\x1f\"\xa5$\xcb\xf4\x18\xab\x92R\xe2\xba.s\v\xf3Ŋךͭ\x16\xae\xe30\x1c\x0e\xe9\xf5zdi\xca\xec\xf4\f/|ы\tK%\xb66\xb6\xd8\\\xdfD*\xc5Ts\x928\x8a\xa4\xd1\xd9/\xfe\xb7\xff\xf46\xeb\xce;\xef\xe4\xe7\u007f\xfe\xe7\xafM\x83\xec\xd4\x02\xff\xee\x87\xdet\xa0Z\x0e\u007f\xd4\xf3<f\xe6g\xa9\xd4*ԫ5<\xc7\xe5\xe4\xc93\\8\u007f\x11\x89\xc0\xb1\xed\xd1\xce\bH3\xcd\xd6\xf6\x16`\x98\x99\x9deuy\x11/\b\xb1\x1c\x8fC7\x1c\xa69=\xc3\xf6\xd6\x06\xfd^\x87N\xbb\x85\xe5\xb8x\xa3vG\x96\xe5l\xad\xaf\xb2\xbe\xb2İ\xdf#\xcf3\x10\x02˶p=\x1f\xd7\xf3Іq\xcfj'\xf0\xcf\xce\xcf\x11\x86!i\x96\xb1\xd5j\x11\x86!\xadv\x9b$.\xdc\xdd\xc2\xc2\x02/\xb8\xf7^\xa4\x92\\Y^%\x8e\"*\xd5\n\xae\xeb\xa2\xf3\xfc\x8er\x18>`Y\x16\xdf\xfe\xed\xdf~\x8d\xee\x90Q\x1d\xa0\x94\xfc\t\xd7uÙ\xb9\x19\x1cסR*\xe3X\x0e\xe7\xce]\xe4¹\v\xe8L\x93\xa79\xb6mc)\x85\xef\xb9\xc4q̠7`\xd0\xefc[\x16\xe5RH{{\x1b\xa9$\xca\xf1\xb8\xfe\x96ۙ\x99[\xa0\xbd\xbdEg{\x8bA\xa7\x83\xeb\xf9\xf8~\x88\x90\x05\xa61\x1c\xf4Y]\xbe\xcc\xfa\xca2q4\x1c\u007f-\xa5\x14\xb6m\xa3w\x80KS\xa4\xd9JY\xcc\xcd\xcf\xe1\xf9>\xfdaD\u007f8\xa4V\xab\xd1j\xb7\x88\xe3\xa2\xf5295ŁC\xd71\xe8\xf58s\xf2,:\xd7\xcc\xccM\x93\xc6\ty\xa6\xbf\xf7=\xef\xfci\xea\xf5:\x8dF\xe3\xdatY\xff\xe6\u007fy\xbdS\n\x83\xaf\x9f\x9d/\x8c\xe1{\x1e\xa5\xa0\xc4\xf9\v\x978}\xf2,BH|ߣZ\xab\x10\x06~\x91v\x06\x01~\xe0\xd1\xed\x0eh\xb5\xb6plE\xadVc\xd8k\x8d\x10>\x81\xe3\x05\x1c8|\v\xb5\x89I:\xad\x16+K\x8b\f\xba=\xc2r\x85R\xb9\x8a\xdc\xc1?\x8c\xa1\xd7m\xb3\xb2x\x89Nk{\xdc\xf5\x95R\x8eZ\xef6\xa3\x8e\vB\x80e\xdb\xcc\xcd\xcda\xdb6\x9dn\x0f\xad5\x8e\xe3\x12\r\a\xe4y\x8e\xeb\xba\x1c8t\x90\xb9]\xbb\xd8\xda\xd8\xe2҅K\xb8\x9eK\xa9\x1c\x92g\xf9+&\xa6\xaa\x13\x93\x93\x93\xbc\xe9Mo\xba6\r2=\xd5x\xc1®\xb9y\xc7q\x10BP\xaf6XZ\xba\u0089c'\xb1,\xc5\xf4\xf4\x14{\xf7\xee\xe6\x86\x1b\x0e1??Gsj\x12\xcfs\t|\x8fՍ\r\x8c\x86,K)\x85>\x95r\x85~{\x8bx8$7\x06!\x04\a\x0f\xdfJ\xa9Z\xa3\xbd\xbd\xc5\xe2\x85s\xb467\xa9T\xebLL͠,\xbb0\f\x824\x89\xd9X[\xa1\xd3j\x91\x8d\x10\xc6\x1d\xa3I\xa5\xd0ڌ[\xf7\xb6c3՜\"\xcbs\x86\xc3!A\x18\x90\xa6\t\xbd^\x17۶\xf0\\\x9b\xbd\xfb\xf7R\xad\xd7Y\xbe\xbc\xccp0\xa4>\xd1@\xeb\xbc\x04\xe6>\x80\xfb\xef\xbf\xff\xda3\x88\x14\x82\xe9\x99\xe6\xeb}\xbf\b\xb8\x95R\x858\x8ay\xe2\xb1/\xa2\xa4daa\x9e\xe6\xd4$͙&\xe5r\x99\xc0\xf7\b|\x8f$I\xa8U\xab(%Y_ۢ\xddj\x01\x86j\xad\x86\x14\x86,\x89\x89\a}\x94e\xe3y>7\xde\xf654\xa6g\xd8\xde\xdad\xf1\xe29\xd6WWp\\\x97\x89\xe6\fJ\x15\x9d]!%:\xcf\xd8\xde\\#\x1a\x0e\xc6m\x0e!\x04J*\f\x90g9\x8c\f\x1d\x84!\xf5z\x9d8I\x88\xa3\x88 \b@h\xa2x\x80\xef{4\xa7\xa6\x98\x9d\x9fCJ\xc5\xd2\xe5e<\xdf#,\x97H\x92\xf8\x81\xd3ǾxՊD\xf5\xb6\xb7\xbd\xed\xaa\xbcQ\x9e\xe7L\x87C5?7\xfd\x9bi\x9aN\xf8\xbe\xcfD\xbd\xc1g?\xf3(\xfdn\x9f\xc9\xe6$\x8dz\x8dZ\xa3F\xa5\\²,\xa28.\xda\xe8i\xc6\xf4\xd4\x14\xe4\x9a\v\x17.355\x81R\x92J\xa9\x84\x13V\x88\xfa]\x90\xc5jv}\x1f\xc7\xf3\xa8\xd4\x1a\b%Y]\xbaL\x12\rq=\x8fR\xa9\x82\x1f\x84\xc4Q\x04\x18\x84\x94\xa3~V\x8c\xeb\xfaX\x965.Z\xa5\x90h] \x8a\x12\x10#\x97\xd6\xedt\xb0F\x99X\xae3\x8c\xd1ضˠ\xdfG\bI\x9a\xa5\xac-\xaf0ٜ\xc4\xf3<Z[-o\xf9\u0089\xff|\xcfK\xbf\xce8\x8esm\xed\x90\xeb\x0e\xed\xbd\xb9\xdf\xed\x1ft\x1c\x87r\xb9\xcc\xe2\xe2\x12˗\x96\x8b\xa2\xacZ\xc5\x0f\x03\xc20\x18\xd5\x1e6\xd5r\x99R\x102\xddlR)\x97\x91B\xb2\xbd\xb2\xc9\xe2\xa5%Z\xad\x16\xadV\vWAcz\x06\x93\xa5D\x83>\xd1p0\xeem\xcd\xed\xdeˡ\x9bn\xa3\xb5\xbdͩ\xa7\x8f\xb2ze\x11)\x15\x13\xcd\x19\\/\x00\n\xc86OS:\xad\xad\xb1\xeb\xca\xf3\x1c\x84(\xe2\t\x90\xa6)\x02\xb0,\x8b ,\x91e\x19\xc3h\x80m9X\xcaFI\xc9p\x10Q\xab֨\xd5kHK\xb1\xbcx\x05\xcf\xf7p]\xf7\x06c\xccD\x18\x86\xd7d\fy\xfd\xd6v[8\xae\x83\xef\xfa<\xfa\x99/`Y\x16\xb5z\r۶\xa8V+Xv\x81e\x18m\xb0,\vc\f\x13\x8d\x06\x96\xa5\xd8\xde\xdcFk\xcdɣ\xa7\xd9XYgmm\x95n\xa7\x852\x19\x8d\xe9Y\x820,`\xda(BI\x89\xeb\xb8LL\xcfp\xe3\x1dw\x11\xc7\t\xa7\x9ez\x92\xe5\xcb\x17\xc0\x18j\x8dI\xfc\xb0T\x10\x19\x94\"M\x12z\xed\xf6s:\xb2;\x81>\xcb\xf5\b9T\x94\xaaeRaH\xb3\xa2[\x8c\x11䙦R\xad1\xe8\xf7\t\xc2\x12\xb5z\x95\x8d\xd5u\x8c6\xf8\xa1o\x1bcn\xbb\xe6\xd2\xdew\xff\xeaO\x13'\xe9\xeb,ס\x14\x84\\YZ!\x1aD8\xaeM\xa5\\\xa6\\)\x8f\x18!\x16B0\x06\xa5\xc2 @HA\x9e\xa4\x9c?u\x1e%%\x02\xc3\xf1#'Y_\xd9\xe0\u0085\xf3\xb4\xb66\xb1\x94\xa0\\\xab\x13\x96\xca\xf4\xbam\xd2,Ų,\x1c˦R\xabs\xf3\x9d/\x00\xa18v\xe4\tΟ>A\x9a\xc4TG?_숂\x97\xd5\xef\xf5\xc6|\xb1\"\xc0[\xb8\xbe?\xeaT\v,e\x15U\xbe\xceI\x92\x04\x80,˩\xd5k\xf4\xfb\x03|ϧR\xaf\x92\xa6)\xadV\x1b\xcf\xf3ɳ\xfc\xe6k\xce \xd3S5)\x04ͩ\x89:\xa5\xb0̩\x93\xa7\xb1\x1d\x9bR\xa9\x84\xeb:\xd4\x1b\xf5\x02\xcd\x1bA\xa9B\b\xd24\xc1\xb6m\xf2,\xe3\x89/\x1c\xc1V\x92\xb9\x89\nS\xd5\x12&\xcf8\xfd\xf4i\x96..q\xea\xc4\t\xba\xed6\x02\x83\xe7\a\xd4\x1a\x93$Q4\xaa\xca\vڏ\x1f\x96\xb8\xf9\xae\x17RiLr\xf2飜>\xfe\x14\xddv\x9b\xb0T!(\x95ɲ\xa2\xb3\xdb\xed\xb4\x88\xa2\xe1s\x83\xbc\xb2\xb0\x94]d]Rb;\x0eY\x9ecvzb:'ך\x82-)\b|\x9f\xb0\x1c\xd0\xdej\xe1z.\xc6p\xe7\xc7>\xf0GזA|ϕ\xb6e9\xd5j\x99<\xcbX\xba\xb8\x84\xe3\xd8\x04a@\xad^Ŷճ\x02m\x91\xd9\xe4Y\x8ee[,\x9f\xbf\xc8\xc9c\xa7X\x98\xaaS/\aLT\x02\x9a\xb52&Mٺ\xb2\xc9\xf6\xda&ǎ<\xce\xc6\xca2y\x9a`\xd96A\xa9<\xae1l\xc7\xc1\xb6,\\\xcf\xe3\xfa[\xee`a\xdfA.\x9c:ə\x13O\xb3\xbe\xbaL\x10\x84\x04a\xb9`\xa4hM\xa7\xddb8\xe8\x17@\x97\x10\b\x01R\x15\x99\x97@\xa2\xa4b\x87\x89\x1c\xc7\x11\xc6\x18\xb4\xd6؎C\x9af(\xa5(\x95C:\xedN\x01\x01H\xf1\x8asǾpU\xe0𫆩;\x8e-\x85\x10\x8ek\xbb\xb4[\x1d\x92\xb8X\xfdA\xe0\x8f\x1ay\x05S]P\xb8+\xad\xf58\vz\xe4S\x8f0U+\xe3\xbb6R\x16\x1c\xaa\xc0up',:\x83\x88\xb8ݥc[\x9cy\xfa({\xafK\x99\x98\x9e\xc5/\x95Q\xd63\x0fn'\x1e\t\xc7a\xef\xa1\x1b\xf0\x83\x903O\x1f)zYi\xca\xd4\xcc\x1c\x02\x88\x86\x03̨c\xac\x94\xc2\x0f\xc2\xc2uI\x89\xc9\rB)\x04\x12)-\xd24-\xb0\x16\f:\xcf\xf1\x03\x9f~\xaf\x87e;x\xbe\xc7\xe6\xda6Y\x96\xa1\x94jjm\x02\xa0s\xcd\xec\x10ױ\x15\xe0 \xa0\xd3\xe9\"\xa5\xc0ql\\\xcf\xc5\xf1\x9cQ\xba9\xa2\xe3\bA\x9a\xa4ض\xc5\xe2\xc5E\xe2$\xa5\xec\xbb(%\xa9W*4'\xeaض¶\x14\xf5r\x80\xca2\xd2V\x87x\xbbŹcO\xb1\xb2x\x89A\xb7S\xec\xb4g\xe1/\x96m\x8fQ\xca\xe6\xdc\x02\xb7\xdc}\x1f\x1bkk\xf4\xfb}V\x97\x17Q\xca\x1a\xc1\xc1\x9a,\xcb\xe8\xf7\xba\xe4i:\xaa\xdcG\x10\xb0\x94X\xb6\x03\b\xe28ƶm\x928\x1e-:\a\xa9\x14\x96U\xc4B\x04\xf4\xba=,KYB\x88\x03ה\xcb\x12 \x8d1\xce`8`0\x1c\x14]U\xcf\xc5q\x1c,\xcb\x1ag5;X\xef\x0ea\xed\xc8cOR\xf6=,%)\x05>\xfb\xf7\xccs\xc3u\xfb\xb9\xe3\xd6\xc34jU,%\xb1\xad\"u\xed\xaem\x90\xb7;,\x9e<\xc6\xe2\xf93\f\a\xbdQ,0\xe3Un;\x05\x01B\bA\xa9V㖻\xefes}\x9d^\xb7Õ\xc5K\b\x8aƢ\xce\v>W\x1c\r\n\x02\x84)\xb8^\xc60\xe2\xfe\xdacV}\x96e\xa3\x1d]\xfc\xae\x10E\xe1iۊ~\xaf\x80y-[\x1d\xbc\xa6\\\x96\x19\x03\xfd\x86 \xf4\xd1ڌR\xccg\xfe\xa7\x10\x05\x01:\xcfs,\xc7a}u\x83\xad\xf5M\xe6'kؖ\xc5\xccD\x9dR\xa5\x84_.\x11\x1ahL4XZ^\xe1ҥ%\xa2\xa8h\xado\xaf\xae\xe3\xf7z\x90f\xf4[\xdb\xec\xbe\xfe&\x1aSM\xa4z\xc6\xe8\xae\xe3\"eF\x92\xa68\x9e\xc7u\xb7\xdc\xce峧\x89\xa3!y\x9eQ\x9f\x98*2\xbb,\xa3\xdf\x1f\x90kC\xa9\\a\x87\x1e\xae\x94\x85e\xdb\xe4\xf9\x88\x9e:J\x00\x94Tϴ_F\xf8J\x96\xa6\b\x01F\x9b\xf9kj\x87<\xfc\xc8\xd1~\x96\xe5\xef3FS\xaa\x94\xa8\xd4\xcb\f\x87\x11R\xaa\xd1\x0e*|\xfd8@\xda6'\x9f:Q \x85\xb6\xa2^\x0e\x99\x98lPmNQ\x99\x9c\xc0+\x858\x9e\xcb\xdc\xfc\f\xb7\xdd~\x13{\xf7\xee\xa2\\\n\xf1\\\x87,Nh-]a\xfb\xecYN~\xfe3\x9c?\xf14i\x1c?\x93\xceJ\x89m[\xb8\x8e\x83R\x16ʲ\xd8s\xf0zl7`\xd0\xeb\xd1\xda\xda KS\x8cѤiR\x8c5\x8c\x8a\xc6\x1d\xf7g\xdb\xc5\xd8Ca\x90|d\x109bL\x82\xed\xb8؎=\xc6\xf2s\xad\x0f]S\x06y\xeb/\xfe:\xedn\xef\x17\xe2$\xed\x1bc\xb8\xe1\xd6\x1b\xe8v\xba\x05\xa9\xcdR\x88\x91\x1b)脐%\t\x17\xcf]\xa4\x1azx\xb6Ms\xa2F\xb9^\xc3\rB\xc0B9\x1ev\x10\xe0\x05>a)d\xcf\xde]\xdcx\xd3\xf5\xcc\xceN\x13\x86\x01\xae\xeb\x12\xf7\x87t./\xb1t\xe2i\x8e?\xf6\x99\xa2\xbb;Ng\x8b\x15썌\"\x94dv\xf7>\xbc\xb0Ġף\xdb\xda&\x1a\x16\\\xe04-Ht\xcf^8\x96S\xb4\xeb\xf3<'ϋbR(5fC\x1aSP\x96v\x16\x18\xe6\xeax\x9b\xab\xda\xcbz\xe9+\xbf\xa1\x9dG\xd1}\x8e\x14\xd7\xd5\x1a5r\xad)\x95\x03\xea\xf5\xfa\u061d\x98\x11a\xad\xd3\xeep\xee\xd8\t\x1a\x95\x12S\xf5*\xf3\vMjS\x93(\x01\xc2(\x04\x16R(\xa4e\xa1,\x85\xb2\x14\x8ecS\xa9\x96)\x97K;n\x02\x01$\xdd\x1eq\xafG\xbb\xb5\x01R\x11\x94+\xc8\x11#\xa5h&\x8e>\xd7\x18\x82R\x85$\x1e2\xec\xf7\xc7\fǝ(\xe8{\xfeh\xd1\b2\xa0\xd5\xda\xc6\x12\x02\x9d\x9b\x82ioL\x81(f\tQ4\x18\r\t\x15\xf7\x1eE\xe9\x85L\xf3\xfe]{\x0f\\\x1b1d'~\\:w\xe9\xc1\xdde\xefu+\xe7.qۋ\xeeb\xe5\xd2\x12\xbbw\xefƲ\xd4\xd8\x18J)\xb66\xb6\xa8\x85\x1e\xb6RLLT\xb0=\x8b\xf5\xcd\r\x04\x85\xff\x0e܀j}\n\xb0\xd1\xd2%\xb3\x12,;\xc5v\x12\\ǡ\\.\xd1\xef\x0f\xd8\xdel\xd1\xe9t\xd0Y\xcep\xe9\n\x17{}:\x1bk\xec\xbe\xe1f\xc2J\xb5Ⱥ\x94\xc2u\x9c\x82\xb9\x98$L\xcf\xefau\xf1\"\xc3~\x9ft\xe4\xaa,ˢ\xdf\xefQ\xaeT\xc1\x18l)@\xe7dy\x91\x1djcH\xd3\xf4\x99^\xd8\b\xe7\x92R\xa0s\x8d\xd1f\xd7\xc9'?Ͻ/{͵c\x10c\f/\xbd\xed\xe6s\xaf\u007f\xc9\vq\x1d\x87#ˋ\x94je\xd0\x1a\x83\xe2Y\xfcs\xb676\xf1\x1c\v\x89\xe1\xe2\xc5+<\xf6\xe4)\xfc\xd0cui\x95\xe5\x8bW\xc0@ub\x82\xdbﾓÇo`\xcf\xeeyl\xc7!W.\x96\x95b\xd91\xae\xebQ\xa9TH\x92\x84\xf5\xb5\rڭ6*M\x19,^\xe2Ԡ\xc7\xf4ރ\xcc\xeeދT\xc5\xf8\x81\xe78\xe8<G뜩\xb9]\\\xb9x\x96d4\x1f\"\x84Ĳm\xc2R\xb9\xe8q\tP\x14\xeeH\n\x81\xces\xfa\xfd>\x86b\xa6\xb1\bW\x8a<\xcfȲ\x1cc\xcc\xfau\xb7\xdcu\xeddY;\xd7\x03\xf7\xdcE\xe8\xfb\x00ܱ\xb0\x9b˺h\xb1\a\xae\xf3\x1c\xf7\xb6\xb9\xb2\x8a'$\xcaR\xdct\xfb\xf5\xd4\xebULZ\x8c\x1at\xb7Z\x1c\u007f\xe2\x04'\xce\\\xe2S\u007f\xf5 \x1f\xfb\xe0\x879t\xf3a\xee\xb9\xf7n\xf6\xef\xdfM\xbd\xd1\xc0\xb2=t\x9e\x92e1\x8e\x9b\xe28.\x95J\x85h0@\nA\x9e\xa5\xac\x9d?Ekc\x95}\xd7\x1d&\xa8\xd4PJ\xe1y\x1eq\x1cc\x8c\xa19\xbf\x87\xe5\x8bg\x18\x0e\a\xe4Z\xa3,E\xbfץ\\\xa9\x8c[*Z\xe7\x88\x11e5I\x92q\x10\xdf\x19\x10\xca\xf3\xbc\xc0U`\U0007e5ff\xf6\xda2\x881\x86r\xe8\x93\xc41\xe7O\x9ed\xd7\xfe\xfd\x94mI\x9cƄ\xa2<Jy5\xc9pHo\xab\x85[\xf6\xa9\xd7+\xd4k\x15L\x9a\xa1Ӽ\xd8\xfe\x99fvf\n\xcfR\\Z\xf2iu\xfb\\9s\x9a\xdf{\xf2)\xb4\x11\xec\xbb\xe1\x10\x0f|\xfd\xab\xb9\xf9\x96\x9bP\xb9\a\x06l7F\xd9\x0eL4\x90\x96\"\x8d\"\xea\x83\x01\xcb\xdd6'\x8e|\x81\x85\xbd\a\x98\x9cۅ\xa5,\xb4]tw\x1d\xcfgjv\x17\x97/\x9ca8\xe8\xd3\xeb\xb8x^\x80\x1f\x04EZ\xeb8\xc4\xc3A\x81@\x8ej\x91\x02Cɐ\xd2\"ϊ8\x96\x15\x06ɯ\xa9,kla%\xb3\x8d\xd5U.\x1e\xfd\"\x9f\xff\xc4\xc71È\xce\xf6v1\x809*\xe2\x06\xbd>d)&ϩ\xd7J\xe8,-n6\xcbI\x861Y\x9a\x16\x14\x9f\xc1\x10c4B\x18\x1a\x95\x90\xc9j\x80Bs\xfa\xe81~\xebW\xfe#\x1f\xfa\xe0\x83d\x91A\x1a\x1b\xcf+Q\x99\x9a\xa4Ҝ«V\xf0|\x1f\xdf\xf3i\"I\xd77\xb9t\xee\x14\xcbgO\x90\xa71\xb6ea[\x16R\bʵ\x06\xf5\x89&i\x9a\xd2\xef\xf7\xe8\xf7:D\x83!ƀ\xe58\xe3v\xfdNZ\x9c$\xc9\b\xfe\x95$IJ4\x8c\xc9s\x8d1f\xf3\x9a*\f\x9fqGz\x90\f\x87\x84\xe5*\xed\xcdu.\x1e;\x8e\u007f\xf8\x10\x18\x836\x1a\x81\xa0\xdbjc\x03\xb6\x92\xa84c\xd8ꑧ\x9a<\xcdH\x93\x94\xe10\xe2\xc4\xd9\v\x9c>s\x81,K\xb1\xa4\x85e\x15\xcd\xc9F5\xa07\x88i\xf7\">\xf4\a\xef\xc7\xcas^\xfd\xeaנ\xa4\"3y\xb1\v\xe3\x98l8ds}\x93\xbf\xf9\xf8\xc3<\xf6\xc8\xe3\xdc\xf4\x9a\xfb\xd1/(\\\xcc\xdc\xfe\xeb\v\x86\xe3\xa8\xee\x98Y\xd8M\xb7\xdba8\x1c\x8c\x8d\xe2x\x1e\x8e\xe3\x15.K\x8aQ\xdc\x18U\xf4B\x91g\ti\x92\x12E;\xf3\x8d,_\x93\x06I\xb2\xbc\xbf\xd3r\x10R\xb2|\xf28\x93\xcd\x06i\x92 \xac\"\xb0G\xdd\x0eJ\n\x1c[!\x85`\xd8ꓦ\x19\x99\xd6<u\xfa\x1c\x8f|\xfe\t\xb6\xd6\xd7\nX\xd5\vQ\xe5*F\xda\xd8qDõpm\x85!\xc3wm\x16O>\xc6\xca-\xd7\x17\xcdC\xabȈ\xf2(f\xe9\xd2\"\xef\u007fߟ\xb2\xba\xb4\x86\xe7:\x9cy\xe8\xb3\b\xad\xe1\x85E\xe17\xbf\xffz\xa4T\xc8Q\xa17;\xbf\x8b\xd3ǟ\"\x1a\x0e\xe9\xf5\xba\x84\x95*\xae\xe7\x16\x05\xa0\xed\x10\xc7\xf1x\nX)\x8b<\x1b\x8e\x9a\x8f\x05\xae\xd3\xefGO]\x9b;\xc4\xe8\x81\x17\x96\xf0}\x9f\xfa\xc2\x1e\xce/^$\xed\x0f\x88\x93\x18\xdf\n@@:\x18\xa0F\xf3\xe1B\n\xb2<\xe5\xb3G\x8es\xfa\xecy.\x9e?O'N\xe8\x1a\v-\xa1lR&|M\xad\xea!\xcbe6\xbb]T\xbb\xcb0N\xf0<\x8bL\bz\xfd\x0e\x95\xa8\x8e\x1b\xfa \x05\xdd\xedm\x1e\xff\xdc\xe7i\xado\x13\xf8#\"6\x86\xcf\xff\xe5'\x88\x95\xe4\xae\x17\x14)\xfa\u0081\xeb1\xc2\xc2R\x8aJ\xb5N\xa5\xd6 M\"\x92\xa8x9\x8e\x8b3\xc2\xe2{\xbd\x1eq\x9c\x80(\xc8]Y\x96\x17\x13\xbfƐ\xe5:_ZY{ꚋ!Z\x1b\xde\xf7ѿ\x19إ\x12\xb6\x1f\xe0\xb8\x0e\x8dj\x9d\xb8\xd3\x1d\xd7 \x96RdY\x86d4\xa6\xec\u0604\xe52N\xc9\xe7SO\x9f\"\xb8\xfeN~\xf6?\xff\x11/\xff\xa6o\xe1\xf7\xfe\xf2\xaf\xd9H4\xed\xcdu赉\x93\f\xd7\xf7\xe8\xe6\x86\xfe0\xa6R\x0e\x99\x9ai\x12gC\x94%\x8b&\xa1\xd6l\xad,\x93\xc6q\xd1\x04\x1c\xe1\x1d\x83(\xe6\xc4\xca\n\x9f\xfc\xdb#\xa4\xb2\xc1\xca\xf2\"\xe7O\x1c\xc5\xe4\xf9(\xe5\xb5h\xcȇ\xa4i\xa1\x97\x92\xa5\x18\f\xcaV\x18F#\x0fF\x8f\x93\x97b\xe4\xbapa\xc3a|\xe1Թ\xf3\x9dkn\x87\xe4:\xe77\xfe\xf4\xafV\x0e\xef\xdbu醽{wo\x9c=C\xb5R%Q\xd6\xd8\xff\xe6ڐ\xe6f\\\x8fx\xa5\x80F\xb9̛\xde\xf0*\x0e\xec\x9e\xe1O?u\x8c\x17\xbc\xe4\xc5DZ\xf3\x1b\xef\xf8\xf7\x1c\xaey\xd4\x02\x9f\x89=\ay\xe0\xf5\xdf\xc6'?\xf9 \x1b\xabkL5k\xcc\xceL\xe2\xfa>\bC\x92\r\x109He\x93'\x05\xaf˶\x15ƀ\xb2\x15\x8b+m\x0e\xdey7\xdf\xfcͯ\xe7\xe1\xbf\xf94\xbeʸ\xe7>\x9bKgO\xb0\xf7\xba\x9b\x91\b*\xd5*\x8e[В\xf4h\xc6\xdd\x18C\x14\xc5\xe3\x16\x89T\xb2Hw\xb3\xa2\x9e\x11B\xd2\x1fDG\xbf\xf9\xbb~@_s\x06\xd1\xc6`)\x15\xff\xf5S\xc7~\xaf\xf9\xe2\x17\xfe\xb4\xd7h\x90w:\xb8\x8e\xf5\x9c\xd4X0Zm\xda\xe0{\x0eA\xc9%\xf4}^\xf6\x92\xbbȅ\xe47\xde\xf1\x8b|\xfb\xf7\xfe\x00\xfb\u007f\xe6m\x1c\xf9ۇ\x10I\xc6\xfeC׳\xb4\xb4\xc8\xc7?\xf9 7\xec\x9d\xe6\xee\xeb\xf6\xe0\xfb\x1eA\xb9\x84T\x8a\xcc$؉\x85\x969y\x96\xe196a\xe8!l\v˱\xb9%pi\xf7\xdb\x1c}\xec1*\x933(\x9ds\xee\xa9\vt\x16\xda8\xae\xc7ܞ\x03X\xb6\xc3ds\x86\xf5+K\xe4y\x8e\x14r\xe4\xee\xb2q\xd7W\bI>\"E`@JE\xbb\xdb;\xb2\xd3\x1e\xba\xb6\f\xa25B\t\x94c\xff\xee_\x1ey\xea'\xbf\xf9\x8e[\xact0\xa0\x12\x96\x9eӂ\xb7\\\x17F\xeb)\x8eS,U\x10\x1b\x1c[\xf1\xaa\x97\xdc\xc9\xc2\xd9K\xfc\xc1\xaf\xfc\x14\xfd`\x86\x99\x99]D\x9d6\x1fy\xe8\xe3\xb4W\xce\xf3m\xaf\u007f\x19/{ѝtZ\x1d\xb2̠F\xbd\xae$K\xf0\xf0H\xba}\xf2\xa4\xe8\xfcڎ\x8d\x1aM?U\xab\x15&\xe3\x84t\xf3\nWΟf\x18\xc5\xe4Z\x93H\x8bo\xfa\x1e\x8b\xfad\x13/,S\xabOpe\xf1\x12I\x12\x8f\xe8\xa6\xf29\":Y\x96\x92\x8e\x04o\x84\x94hm\xb8\xbc\xbcrd\xef\u07bdעA\fJ\x1a\xb4֧\xb4\x14\x9f\xfe\xeb\xe3\xa7^\xf6\xca}\xbb\x18v;\xcf\x02E\xc0\xb2\xec\xf1\xb4l\x96\xe5\xe4#_\x8c\x10X\x96\xe2\xe6\xeb\xf6q\xfd\xfe]\xb4{}67Z\xf4Z.o\xb8\xf3U4&j\x94\xab%Rc\xe8NN\xd2\x1f&\f\x87C\xd24'\x1eDP\xae\x90&1Y\x92`[j\\\x99\xcf4\x9bx\x9e;ޡQ\x1c\xb3\xb1\xdd\xe5\xd2\xda\x06\xebK\xab\xbc\xff}\u007f\xc6\xc1\x1bndnOP\x10\xee*5\xfa\xfd>\x13#\xcc]*\x85Ѻ\x80\x9fu!۱\x93IFIJ\xab\xdd\u007fR)\xc55\x99e\x19\xe0ß\xf8,\xdf\xf2\x8d\xaf\xfc\xb3\xed$y\xd9#\x97\x97\x99\xf3,\xe6v\x064L\x81[\xef\xf8\xe1$Iɵ!\xd3\x1aK\bPr\xbc\xba'\x1a5&jU\x8c(\xe8\aF\x80\x11\x02\xa1\xc1\x0flr\xb33_\x18\x8d\xe7?\n\x15 \x83c\x17\xb5K9\f\xf1<\aǱ\xb1\xec\xe2v\x83R@\xadZa\xcf|\x93;o\xd8\xc7\xe7\x9f>\xcdSO\x1eebz\x1a\xd7/Q\xa9\xd6X_Y\x1a+=H!\vA4Qx\x01\xa3\xf5hpH\x90$٦eً\xb3\xb3\xb3\xd7$Q\x0e\xa3\rQA\xe5\xff\v!D~\xbe\xdd\xe5d\xab\x8b\xce\xf31T\xaa\xecb\xba)\xcf2\xe28&Is\xd2\\\xa3\x8bA\xf2B\xdeI\x16/l\t\x96\xc2(I.\x15\x89\xb0\xc8E\x81q\x04\xbe\x87=\"u\xef\x90\xdf|\xdf/4\xb2\xa4\xc4u\x1d|\xbf\x983\xf4K>nP\xe0\xfb\x965\xd2\xd4\x1a)<\x1c\xde7ϣ\u007f\xfb9z\xedva\xb00\xa4\xd5j\xd1\xef\xf7\x18\x0e\vʐ\xc1\x8c\xbb\r\xc5\xc4\xd5X\"\xeaD\xbfߋ\xb3\xe7HF]C\x06ѣ\xa5\x1a\xc5\xe99c\xccQ\x04\xb4\xa2\x82\xbf[\xfc@N\x92\x94Q\xf8\x8b\x00\x00\x1f\x92IDAT\xe5cxt0\x88\x8a\xa2\xd0\b\x12\rinЦ\b1FH4\x12\x84\x8dv\x02\xf0\xcaH\xdbC\x8e\xe6\xdf\x1d\xdb\xc6u\x9d\xd1`\x8e\v\xc6\x10\x04\xfe\x88\xf8f\n\x01\x80\x91\x8eV1gb\xa1l\vk\xf4\xb2݂&\xea9\x0en\xb7ŕ\xa5%t\x9e\x11\x86%\x84\x10lml\x90g\x19\x06\xb0mg\xbc\xe0\x9e\xa9\xd8%R\x88#\x9f\u007f\xfa\x14\xef~\xf7\xbb\xaf\xd1\x1d22\xc8\xc7?\xf5(\x06>\xa8\x94b\x90\xa4ln\xb7\x8bBP\x1b\xe4\x88\x19\b0\xec\x0f\x19\x0ec\xa4\xed\"l\x17\xa3lR-ȴ@\xa30\xd2A+\x17\xe4\xa8Ց\xe5\xcf0M\x00۲\x8aq\x86\xc0\a\x01\x9e\xe3R\x9d\x98\xc0\x8c0}{\xb4\v\x94RżJ) \xa8\x96\xb0\xdc\u00859\x8e\x83\x94\x92]\xd3\x13\x9c=]hr)KQ\xa9\xd5\xd9\xde\xda\x1a\xb5L\xf4\x98\x0f\xa0M\x11Cv8]R\x8a#\x00\xef|\xe7;\xb9\x1a\"\f_\x95)\\m\f\u06dd\x1eژ\x0fjm\x8cT\x8a\xa5\x95\xf5QA\xa5\xb1=oԺ\xd6DØ\xe1`H\x9ef -\xb0\x1c\x84\xe5 \xa4\x8d\x11V\xf1B \xb4Ƥ\xa3\xf1\x01D\xc1j\x8f\xe3\x828\xa7\x04R\tlKaY\x16\x8d\xe9f\x81\x18\x02B\x15*rB\x15\xc4k\xa9\x8a`\xef\x87\x01\xb6\xe3\xe0\xb8.\x96e\xe396\xdbKWH\xe2\b\x01\xd4\x1b\x13\xf4\a\xfdq\x0f\xab m\x98\xa2\xbb;\xaaЅPH\xa9\x8e^\xcdg\xf7U1H\xe1\x8e\f\x18\xf3\xb8\x90\xe2\xb2֚~\xaf\xa8ֵ0(\xc7\x1e\xe9We$qB\xaf\xd7g\xd0\xed\x8d*a\x01B\xa2\x85\x18a\x0f\x05\xe9Y\xe79:K\xc9\xf3\x8c4KH\xa2\x98\xb3'N\xb3r\xe12\xf9p\x88Қ\x92\xe7\x15\xb3\x1eA\xa1,75Y\xa1\xbd\xbd\t&GIQ\xc8m\x8c܍\x94\x027\xf0Q\xb6\x85m\x17c\nY\xbbM\x12E\x18\xa0R\xa9\x12GQ\x81\x9d\xc0xt\xc1`\x88\xa3h\xb4cL&\x04g\xaey\x83\x14U\xbb\xe1O\xfe\xf2\x93\x06\xf8\xa0\x10\x82ťU\xd28B\xc8¯G㠞\x10G1\x9dv\x874\x8aǩ\xb1`D\xac۩\xf0\xf3\x9c<\xcfƔ\x9cV\xbb\xcd\xe5\xd3g0i\xc2\xc6\xe22\x81\xe7\x17\xd2~B`\xb9\x0en\x100Ӝ\xc0d\tǏ\x1d/j\x9dQ\xf0\xdf\xe1\x87I%\v\xedEY\xe0\xef%r\xa2^\x1f\xb4.\x92\x03!\x18\xf4\xfb\x85\x1c\xe0\x0e0\xa5\r\xd10\xde\xd1j\\\x19\x0e\xa3\xad\xe7\x85A\xb4\xd6\f\xe3\x14\xe0\x83ʲ\xd8\xdcn\xd3\xe9vA\b\x02ߣ\x9f\x16\x81=\x89\x92\x11\xae\x10\x15\xe3\x02y\xbec\x91\x82\xe4H\x8e\xd1\x05v³|t\xb7\xdd)\n\xbb$\xa1\xd3\xe9\x92&\xe98\x86u\xda\x1d\x924\xc1@1\xee\xdc\xeb\xd3\xeb\xf4F:\x8c\x14\xfaY\x96U|@1\x05\n\x14d\x88~\xb7\x876z4\xda\x1d\x14(\xe1\x8et\x87)*\xf4\xfc\x19\xca\xd0\xc9\xdf\xff\xb3\xbf\xb8\xaa2\x80_U\xbd,\xad5i\x96?$\xa5\xd86\x88z\xabݥ9=\x8d\xe7\xb9\xf433\xce\xf3{\xbd\x01\xd5Z\x85A\xb7G\xa9Z\xc1U!\xec\xb4W(\xba\xab_\xaa\xbc3;?\xcb\xe7\x1f\xfa[>{\xf1\"H\xc1\xf2\xfa:/z\xf1K\xf0\xa4\xe2\xe1O|\x8a\xe3G\x9e*b\x87[\fuj!ɍ\x06-P\x96\xc0\xe8\xc2\x10Zk\U0010e0aa6tZ-L\xaeQ\xb6C\xb9\\ammmԃ+vG<\xeak\x8d\xae㛭\x0e\xcf\x1b\x83\x18\x03\u007f\xfc\xa1O\xa4\xdf\xf2\r/\xff+˲\xbe-\x8a\x8by\v\xcfs\x10\xa5\x90,\xcbI\x93\x8c\xf6v\x87fs\x82$I\x18\xf6\xfa\xd8#\x19W\x84\x023\x8a#\xe3vdqy\xbeǋ_\xf5\n>\xfd\xb1O0\xe8\xf7\xb8t\xea4\xdd\xe5M,-\xb9\xb2\xb2B\xa3Y\x88\x92\xb9\x81\x8f_)ᕂ\x91˒\xe3\xd4U\xe7El\xcaGUw\xae\r\xfdN\x0f\x9d\xe7HW\xe0\a\x01i\x9a\x8e\u007f\xc6\x18C4\x1c\x165I\xf1M\x8eow\a</\\\xd6Εi\x8d\xce\xf5\xef\n\t\xedv\x0f\x9dk\xa4Rԧ\xa7\xe8EIa\x84A\xc4p\x18\x91$)\xbdN\x97l46P\x04`\xc8MQ\xa1\x8fu\x13\r\x98\xdc0\xb3\xb0\xc0k\xde\xf8z\x16\x0e\x1edj\xb2\x89\xb2=\x96\x96\xaf\xe0\x87\x01A) \xacV\xa8\xcdL1\xbdw\x17\x96c\x8f\tt;+<K3\xd28\x19\xed>\x89\x10\x16ݭm\xd24\x19\x8d\xcd\x05DQ42H>R\xcbN\xc6\xe3\x14\xc0\xb1\xab}\xfe\xca?\x8bğ\xd6\xe6A)\xc5C\u06dd\xde\xcb\xd24\xc5r\x1c\x1a\x135\x9e:\xfa4\x95\xc0#O3:\xed.\x95J\x99h\x181\xe8\xf6(;\x0eF\x16\xca=;\xa2\xcc\xd2<\xd3\x123B@n\b\xed\x90\x17\xbe\xe0^V.\\F\n\xc5𦛸p\xf6\f\x96\xe7P\x9fmb\x85\xde\x18\x15\x14\xa3?\x85jPΠ\xd3\x1f\xc5\x1e\x811\x12۲\xe9\xb7Z\xc4\xc3\x01\xa6R\xc5\xf3<z\xdd\xee\b\x1f\xc9H\x93\x8c,\xcfv\xbeD\x02\xe2\xf8\xd5>\x0eG~u]VQw\xbc\xff\xc3\x0f\x19\x03?\xbd\xba\xb6a\xf2\x11۰V\xafr\xf4\xe2*\xc38a0\x88hm\xb6\x19\xf4\adYF\xaf\xd3%\x8d#\x8c\xce`\a\x14J4\xf9 \xc3\xe4`\xf2\x11\xc7#1H#\xb0\xa4=\x1e\x87\x0eK%\x0e]\u007f\x03\xfbv\xef\xc7\xc7\xc1\x1ejD\xa2!Ӑ\xe4#\x1eUF\xd4\x1f0\xec\x0f\x8a\xf4;\x83<7H!\xd8\xde(\xe6\xe2w8\xbe\x83\xc1\x80,\xcdFiz\x8a\x1e\x8b\xf7\x8b\xc7\xfa\x83h\xf5\xaa\x93D\xfe\x99v\b:\xcb?\xdbnw?\xd8\xe9\xf6\xbe\xa9T\nY\x98\x9b!՚\xa7\x16\u05f9k\xbfM\xbf7`\xf5\xca:\xae렔b\xd0\xe9Q\x9e\xb0\x80b\x90\xe6\x8b\u007f\xfb\x18\xb6\xe71?7\xc3\xea\x955V\x97W\xd8^ߤ\xbdZ\x14\x9c\x93\xb3Mn\xbc\xf5F\x96V\xd6x\xe2\x89'\xd9^_\xa7T.\xb3{\xefnj\xd52\xa5\xc0Ǳm.\xac\xad\xb2\xb0w\x17\xd90&Os\xd2T\x93&9\xb9\xce\xe9\xf5\xba\x1c[Z\xe3\x81(BJY\xd4>yN4r\xa1q\x9c\x8c\b\xda9\xb6-?\xf4\v\xefz7\xcf;\x83윌\xf0\xfe\x0f\xff\x8dy\xd37\xbe\xf2\xe7.]\xbe\xf2u\xcd\xe6\xa4=3\xd3d~\xd7,G\xcf-\xb1k\xa2\x82e):\x9d\x1e\x1b\x1b[8\xaeCk\xab\x85\x13\xf8X\x9eG\x1a'\x1c=z\x9c\xa5\xf5u\xf2\xb5m\xa4\xd6H\x04\xb6T\x94\xbc\x00%$\x977Os\xfa铬u\x9e\x91ӈ\x06C6V\xd7\xc6\xdfEy\x1e\x9d(\xe6\xd5V!j\x16E)I\x9a\xa3\x8da0\xe8\xb3\xd6j\xf3\xf4\xd2:\x96U\f\xe6\xa4i\x826\x86$\x8eIG\x8c\x98\xd1=ie\xd9\u007f\xd1\x1bF</w\x88\x19\x15\x8a\x18\xf3\xe4\xd9\xf3\x97\xfe\xe0\x96\x9b\xae{sX\xf2y\xe5k\xee罿\xfd\xfb<|\xf2\x12\xaf\xfb\x9a\xebp;=\xbaa\xc0\xb6_\x88\x89m\xadm097\x8d%4\xdf\xf4\xad\xaf\xa2Z\x99\xa0\xdb\x1aҹt\x19\xe18l\xb7\xbb\x9c?\u007f\x91\xc5\xcbW\x18ltiw\xbaę\xe6\xa6\x03{\xd9Ӝķm\\\xc7\"\xcb5O\x9c\xbeLu\xae\xc9\xf4B\x934Ɉ\x86)Q\\\xd4\x13i\x9a2\x1c\x0e8zy\x95$/\xc4\xfd\x8d1\xc4\xc3\bK\x14-\x98$N\xc6c\tژ\xb3\b\xf5\xf4W\xe38\xb5\u007f6\xdd^c\f\xbf\xff\xc1\x8f\xf3\xad\xaf{\xd9/\xae\xacm\xfc\xab}{v\xf9w\xdeq\x13\u007f\xbe\xd0dcy\x8d'/\xaep\xb7m\xd3\xe9t\xf1\xfc\x02\xbfPJ\xd2o\xb7\tK\x01\xa5Z\xc80\xe9\xf2\xc5G\x9f`\x9f\xef\xe3\a>\xd7]\u007f\x80\x1bo\xbb\x99<I\x88z}\xd6\xdb\x1d\xdc(\"\xdf\xda\"O\n\x92\x82ֆ\xcdn\x9fr\xbd\x82_\x0eI\xe2\x8c4͈\x92\xb4\x98\x98\xca5\xc3a\x9f\xd5N\x8f\xa7\x97\xb7pB\x1f\xe5\xbb\x18S\x14\x9df\xd4\n\x8a\xa3\xa4\x18\xe7֚\xfe`\xf8'?\xf5\xce\xff\xa4\xbf\x1a\xcf\xc9z\xf0\xc1\a\xaf\xca\x1b\xed0\xc3\xff>\x83h\x03\x1f\xfc\xd8\xc3g\xbf\xfek_\xf9\xde<\xcf\xff\xb7ɩ\t^\xf7\xbaW\xf2_\xff\xcb\x1fslq\x9d\xc9JX\b\x02\xb8\x0e\xb6]\xe0\x16\x96m\x152Nv\xc1\x03\x0e\x9b\x15>{\xe4$\a\xca\x15j\x97/Sٵ@0;\x87\xe3yL%.\xdb\xcb\xcb\xe4IZȕ\xb7\xbb\x9cZ\xbaBbI\xa6\x16\x16\x10\xca*\xb0\x97$\x1d\xcfxDq\xc4`8\xe0\xf1\x8bk\x18\xa1\xa8\xd5ʄ\xa5\x12:\xd7$I\x8a\x94\x8a$.\x8c+\x04\f\x86\xb1\xbe\xb4\xb4\xfa\xfb\x8eeS\x0ekl\xb57\xc6\xf7\xf8\xe0\x83\x0f\xfeӕ\xad_\xfb\xda\xd7\"\x85xN\xeb|\xa7\xa5`\xbe\n\xc1}\x98\xa48\x8e\xfd\x0e\x84x\xb3c[\xd5\x17\xbd\xf0v>\xf2ѿ\xa5\xbb\xb6\xc9\xe7\xce,\xe3\xda\x16r4\xcbg\xdbVAfS\x8a\xdad\r\x80\xbd\a\x16\x10Bp\xf2\xc8It\xb7O}e\x83\xb2\xf5\x14hC7\xce\x19d\x86~\x1c\xb3\xd6m\x91ˌR\xadNcj\xba\x98C\x1fU\xdc;\xf7\x97\xa5)\xfd~\x97\x93+[,\xb7\x8b\x86a\xb39\x81\xe7\xf9\xa4YF\xa77x\xd6\xd8Z\xd1\a[Y\xdf\\\xfa\xf8g\x9f\xb6\xe7\xe6\xf6\xfep\xbd6\xb987\xbb\xe7#\x1f\xf8\xf0\xfb\x06\xc6\x18\x1ex\xe0\x81\u007f\xf23R\x05\xec)\x9f)\xba\xbe\xf4\xef\xab}\t\xc1_\u007f쓽\xef|\xd3\x1b\xaaB\x88\x17۶\r\x18N\x9c8\x8f\xe3X\\Z\xdff\xa6ZB')\x96c\xe18\x85\f\x87\x14\x02\xc7s\xb1\x1d\x8br9\xa4Ҩҍ\x12.\xae\xad\xb2\xd8is\xa5\xdfgc\xd0g;\xee\xd2\xcf#\xa4#\t+e\xeaS\xd3x^0\xba\x1d3\xa2\xf7@\x96\xa6t:\xdb,mw\xf8\xf4\x99\x15\f\x82$˹\xedk\x0e\xf3\xaaW\xbd\x9c\xcd\xcdm\x9ex\xe2(\xc3^\x8fr9D\xaaB\xf8\xff\x93\x0f?V\xd9jG\xdfo+\xebkð\U000ad970\xf2\xfd\x87\xaf\xbf\xe3\xd1++K\x17\xa3xp\x95\f\xc2?\xe3e`\xf7\x9e\xc3\b\x11|\xc7\xde\xddS\xb7'\x99f\xba9\xc1\x91\xa7N\x90%\xba\x18\x95\xdeh1Y\x0eH\aQ\x81\x06\xba6:ׅ;\xf3\n\x04\xd0\x0f=f暔\x1bur#H\x92\x04\xe5X؞K\xa9Z\xa5>դ֘\x1a#\x89B\b\xf2\xbc\xd8!:\xcb\xe9t\xb6Yiu\xf8\xc4\xc9e2]HKe\xb9\xe6\x1b\xde\xf0\x00\xbbw-\xf0\xe8\xa3_\xe4\xbf\xff\xf7\x0f1Q\xabPo\x94\xf1\x03\x97\xad\xed6\x1f\xf8\xe8#\xc2V\xb6\x90ʢR\xae\xe1\xfba\xe0{\xe1+^tϫ~\xf7\x91\xc7\x1e\x1a^\x15\x830\x82D\xbfz\x86y\xe6\x9d\u007f\xf6G\xdfÁ}7\xbd\xfe\xe2\xd2\xf2\xdbV\u05f6\xacٙ:\xf5Z\x99\xc9\xc9\x1a_|\xfc8JY\xa0\x14\x977\xb6\x98(\xf9$\xfd!\xb6m\xe38\xf6\x98+UpnmlǦV\xaf03?\xcd\xcc\xc2,A\xa5\xcc\xec\xaey\xa6\x17fiL\xd6q=\x87,) \xd8,-\xfaaFkZ\xed-V\xdb]>v|\x91T\x17\x1cc!$\xda\x18~臿\x87N\xab\xcbC\x0f=\xccG?\xfa7\x1c\xbeq\x1fSSU\x1c\xdb\xe6\xc8S\xa78un\xa5\x98Y\x14P\xabM\xe2\xba>@\xb5R\xae\xed{\xd1=\xaf\xf9\x93\xcf~\xfe\xe3W!\xcb\x1aQp\xae\xbewznl\n\xfd\nB\xf2m\x95p\xf2\xbf\xfan\xd5:qf\x99\xe5\xd56/\u007f\xf1\r\xec߷\x8b\xe9\x85&\x9b+\x9b8\x96\x85\xb1m\x1e9w\x85[\x17&\xd1\xf9E\x06È]\xbbg\v,\xc3@X\t\xb1\x1c\v廸\xaeCc\xa2\xca\xde\xfd\xf3\xc4IJk\xabM\xaf7D*I\xbf\x1b\x91\x0e\x12@\x90e\t\xed\xad-\x96\xb7\xdb|\xf2\xc42In\xf0\x9cB=B\v\xb8\xe7E\xb7\xe2:\x0eGO\x9c\xe0#\x1f\xfd$\x02\xc1\xc4D\r\xd7u\x89\xe2\x84\x0f=\xf80Jz#H\xd8\xc2\x1bI@\x8dx\x04\xdf\x02\xfc\x8c@\x9c\xfe\xa7D_\xebK\x1f\xdeWr\xb2\xda\xdfm\f1\xe6\xc3\x02|\xf7w\xfd\xb8\xd4&\xff\xb7BJ\xcb\xf7\xcaІN7\xe2\x83\x1f9\x82-[\xdc}\xf7-|\xf8C\x0f\x15\xfa!V\x11Џ,o\xb3\xd1\xe93LR\xb66\xb6\xd9{`\x17\xbbvύ\x068\x03\x1c\xcfF\bHӌ\xe1\xa0hP\xee\x80P\x02Q\x1c\xec\"\f\x9dv\x8bn\xa7\xcb\xd9\xf5\x16\x8f^\xd8 \xd3\x06\xdfuplk\x8cw\xbc\xe2\x95\xf7s\xf4\xe8q\xfe\xf4\xcf?\xcc\xc6\xda\x16\xbe\xe70Ѩ\xd0nwx\xfc\xa9S\f#M)T\b\x04\xb5\xdaD!\x00픋\x919\xe1`Я\xbe\xfe\xd0m\xa7O\x9c\xfe\xe2?\xcd \x06\xbe\"\x80~\x8c\xea=\xeb\xc8\xc8\x1d\xf0\x9fQ\x13\x0f\xc0sK\x18cH\xd3\xe4p\xae\xf3\xdbB\xb7A\xb3\xaeY^;=>\x9e\xa8?t\xf9ˏ=J\xa9R\"\xee\xf5q\x1d\x1b\xcbRH\x15\xb22L8\xf7\xd4\x05n\x99\x9f`ЏXY^c\xef\x81\xdd\xec\xda3\xc7\xd4\xcc\x04\x96m\x8dac\xad\x8b\xfe\x99\xce5\xfdސN\xabM{{\x8b\xcdހGϯ\xb2\xd4\x1a\xa2\xa4$\xf0\\\xdc\x11tk\x8c\xc1\xa9\x04\xa4I\xca\a>\xf0W|\xf1\x89c\x00\xdcx\xe3n<\xcf\xe1s\x8f\x1c\xe1/\xff\xfa3\x84~1\x16\xa7\x94\xc5Dc\x86J8\x85Rvq<\x1f\x12\xa3\xf3W5'g\xde}\xe2\xf4\xd5*\f\xbf4\xfd\xfd{w\x80\xfc\x1f\xe2\x1f\xcfČ\xa25\xfb\xa3\xdf\xf7\xab\x81c\xbb\xaf\xb9\xb8xb3\x8e\xa37\x12j9\xd78L\xb3\x9api\xe5i6[\x8b\x05{\xc4q\tJӜ>\xfd$5עZ\x0e\v\xa3H\x89\xf4<\x94\xa5xbi\x8b\xd3+\x9bܺ{\x86\xd6V\x87\xf3\xa7/Ҝ\x9bba\xf7,\x95Z\x19)\x15\xd10fsc\x9b+Kk\xac\\^\xa1?\x8c8\xb3\xd6\xe2\xf1K[\x18\xc0w\v\xe5 g\xc7\x18\x14\xa0\xd5mw\xdd\xcaßy\x943\xc7ώo䞻o\xe1\xec\xb9\xcb|\xf4o\x1e\xc1uJE*n\xb9\xec\xd9u#\x8d\xca\f\x96\xe5\"\x04\x94\x82\n\xc6h:\xfd\xd6ݛ\xed\xad\xe7x\x89/w\xa1[\xff\xa3\xbe\xd3W겞]\x14\x19c\x98\xa8\xcd\x12\x06\xe5wvz\xdb?\xb4\xb1}\x85RZf\xb2\xbe\v!\x14\xf5\xd2<{\xe7ng\xab\xb54\x06|J\xa5\n\xbe\x17\xd0\x1f\xb4q\xedB\v^\xdar\xd4y\xb5\xa9\xd7\xeat{=>~\xec\x02\xb3Հ\x1b\xe7\xa6\xd8\xd8hq\xeeąq\x90\x8f\xa3\x988Nh\rb.o\xb69\xb9\xd6!\xce\v!\x9c\xc0sF\xb4 1\xa2\xafj\x8c\x11H\xc7\xe6\xf8\xf1\xd3\\>{\xb98o\x12x\xe9Ko\a\x04\x1f\xf8\xc8'\xe9\x0f2\x1au\x97j\xa9ɍ\x87^@\xa5\xd2\x18\xe9/Fx\x9e\x8f\x92\x928\xebъ\x06\xb3\xee\xcc\xee:\xc7\x1e\xdd\x1e\xb7\xfb\xa5\xf8\u007f\xa1\x9d\xff(\x83\xe8/\xe3 ȿ\xbf\x1a}f\x10\xff'~\xe8?|C\x9a\xc6o\xd1z\xb3\xc0\xba\xbb-:\xbd\r\x92\xb4\x8f\nf\xd8ݼ\x95\xb3\x97?O\xab[t\xb1\xa5T\xd4\xeaS\xac\xf5\xb6\xe9\r\x06ض²\x8a\x1b\xb6T1\x13H)Ķm\xd6:\x1d\xae\x9cXĒ\x82f٣\x16x()\x88\xb2\x9c\xa5V\x9f~R\x1c\x00cY\x0e\xa1-q]\x1b[\xa9\xa2◒8\xc9\xc8uQ\xad\x0f\x87Cz\x9b-,%IG3諫[<~\xe4\x04k\x1b-\xa6\x9b\xb3L5\xf6p\xf3\r\xf7\xe1{\xc1x\x00\xa8Z\x9aB\x93\x92\xe9\x1eQ\xd4g\xa5\xbd\xad\x06\xfd\xce\x02\xb0=r\xe0\b#\xfe\xd1;E\b\xf1%;\xe4\xcb\b\xd6\x00\xef\xf8w\u007f(\x80{\x9e8\xf6ɠ\xdb\xdf\xf8ܹ\v'\agΟ \xcf3~\xe2-\xffaVJ\xf5\x9eZeJ\xaeo-\xe18\x1eè\xc7\xd2\xf2y\x96gO1Y\xdd\xc7Tu\x1f\xb3S\u05cd\r\x02015\xc3\xc6\xcaE\xd2,e\xbb\xd3#\xcbs\xa6\xea\x15<\xc7±\x14\x8e\xad\b<\x97z\xa5D\x92f\xc4IJ\xacsV\x86\x05\t!\xcf\r\x96\xebS\xf7\x8b\x8c^I\x89c[\xa8\x91\xfa\xb5\x1c\x9d\xeef\x9e\xb5\x93\x8d)\x0e+˵\xc1\x8c\b\fG\x9f>KNN\xa36\xc1\xfeݷs\xfb\xe1\x97!\x94!\xd7\x05\x03ű]@\xa3ML\x9aE\xac\xac-\x91bP\xb6#\x9e\xfbL\xff\t.\xeb\x1f\f\xe1\xcf\xda\x19o\xfd7\xbf5;\x88z\x0f\xe9<\xbbn\x18u\xd0\xc6D\xbbw\x1d|t\xd7\xc2\xfeOK\xa1\x1e\v\xc3\xf2\xf7y\x8e?]\t\x9a쟻\x1bK\x84l\xb5VX^?\xc3Sg>\xc1ޙۨ\x85\xf3ܲ\xff5,\xae\x1e\xa3\xdb/\x86Xm\xdb\xe3\xc0\r_\xc3\xc5SGH\xb3\x98N\xafP[XhNP\n<\x1c\xadF\x0fP\xe1\x8e2\xa4\\낰\x9d\xe5\xa4YAU-\x8eH\x92d#@iG\xe3\x11c\x8a]\xf0\xac\x15[\f\xe6<C_2\x18\x90P\xabLp\u05ed\xaf\xe5\xd6\x1b\xee\xa7\x1fo\x93\x8dhH\x85&\x17dfHnb\xb6[\x1b\\\xb8|\x9a\U000be6e8MU\x9e\x1b\x02\xbeL\x12\x82\xfa\xc7Ǉg\x82\xf8\x8f|\xcf/ۡ_\xf9S%\xd5]I\x1a\xb1\xb8r\x9a<\xcf,\xad\xf5\x9ea4xɞ\xf9\xc3\xdf:=\xb9\xeb`\xb9Tg\xaa|\x1d\xf5\xf2<\x9e\x1b\x10\xa7C\x1a\x95Y\xa2(\"\xce:4k\xfb\xa8\x84M*\xa5I\xba\xfdM\xa2\xb8;\xd6ɭ6\xa6\xd1\x06\x86\xfd\x0ei\x9a\xd1\xea\x0e\x10\x02B\xdf%\xf4\x1c\x02Ϧ\x1cz\xd4\xcb\x013\x13U\xa6\xaa%\x1aՐz9\xa0Z\xf2)\x87^1\x92 %\xd9H\x8dAP\xb0\xe3Ӵ L\xef\\\xc38\x1d\t\xc8\x18\x86i\x8a\x16P\xabN\xf0\xf2\xfb\xfe5\xb7\xdex?\xed\xfe*q: \xcfS\x94\xb4\xf0\xbd\x10M\x8461k\x1bW8~\xee8VX\xc5kLc\x04\x9f\xbe|\xfc\v_\x11\x9bQ\x8c+\xf5\u007f\xc0E=c\fî\xd9\xeb\xb9\xfb\x8eW\xfc\x82\x92껄\x90$ɐ\xf5\xad%\xf2<e\x18\r\xe8v\xdbԫS\xccL\xed\xa1V\x99 p\x1b8V\x80c\x17\xac\x0f\xd7\t\x98\xa8\xcd\xd3뷈\xb3.\xe5`\x8a\x89\xca.\\'@\xeb\x9cVo\x85\x1dͪR\xa5A\xb5Q\x90\x17\xd24f\xbb\xdda\xb3\xdd-\x04)m\x1b\xdfs0\xc6\xe0:\x16\xb6U(\xd09V1\xa5\xeb:\x16\xa1\xe7\xe0:6\x81g\x13'\x85r\\\x9cd\xa4y>\xa2\x01A\x9a\xe5\xc4I\xd1Zϴ&\x93\x92\xbd{\x0e\xf3\xea\xfb\xbf\x8b\xeb\xf6\xde\xc5\xda\xf6y\xa2\xa4\x87\xd6\x19\xb6\xe5\x11\x06\x15\x8c\x88\xd1&fuc\x89\xa7\xcf\x1eC:\x1e\xd2v\xf0'fH\xa3H-\x9e|\xec+V\xc4T\xff\xd8x\xb1\x13t\u007f\xf4\xfb~őB\xfe\x1eP\xf2\xbd\x00\x84a}k\x898\x19\x12\xc5\x11\xc3a\x9fJ\xb9N\xad:I\xa3>\x83T\x06K\x06(e\xe3\xbb\xe1X\xfe\xa8\x144\xc8\xf3\x8c(\xe9`)\x97\xe9\xc6\xfe\"\x06\xe8\x9cNo\xf5\x99 g9\x84\xe5\x1a\xd5z\x93\xa0\xdc@(\x87V\xa7\xcf\xd2\xea\x1a\x9b\xad.\x88B\x9f\xd1w\x9d\x82\x04\xa7\xe4\xe8\xe8\xf0g\xbew\x9a\xe5\xf4\x87\tQ\x922\x18\r\xfe\x1bLq\x80䰘-ɴ&hLs\xf8\xc6\x17r\xef\x1d\xdf\xc8\xdc\xd4!V6\xcf2\x88Z\xe4:\xc7sJ\x94\xc3*\xa8\x04d\xca\xfa\xd6*O\x9ex\x02i;(\xd7C\xda.^m\x92<\x8b\xf7\xf8\xe5\xfa\x83*N\x96_x\xe7+\xad\x17\xde\xf9\x8a\xeb\xef\xba\xe3\xa5\xff\xfa\xf6[\xee\xfb\xb6\xdbn\xbe\xf7B\x92$\x1b\x1b\x9bW\x8aS\xe6\x9eu\xee\xfc?*\x86<\x9b2\x03\xe0:\x01Ƙہ\x19!\x04\x8e\xed\x92\xe5.\x8e\xed\x8e9\xb8\x96m\xd3\xeal\xb2\xb4r\x9e\xf9\x99\x03\x04~\x99Ho\xe0\xca:\x9e[\xa2Q\x9d\xc1R\x0eøKn\x15g\x10\xb6\xfa\xcb$\xe9\x90}sw\x8e\xc8\xd4pi\xf5\xc9\xe7T\xf9ʲ)\x95k\x04\xa5\n\x13\xcd\x05\x92xȠ\xd7\xe6\xf4J\x8b\xa7\xcf]\xa1\x12:L7\xaaL7*x\xae\x83ѐd\x19i\x96\xd3\x1b\xc6\f\xe2\x848\xcdH\xd2l4i\xab\x8b]\x83\xc0\t+,\xcc\xedgjb\x81\x83\xbb^@\xa32\xcf\xca\xe6\x19z\xc3M\xb4\xce)y\r<\xdfǈ\x01\xb9\x89Y\xber\x89\xa3\xc7\x1fC\xfa\xa5B\xce\x03\x81]\xaa\x90%1k\xa7\x8e\xf8̓\xb7|\xa419w\xec±/컲zin\xf7\xc2A1\xdd\\@I\xf5\x96\x17\xbf\xf0\xb5\xf7\x9e8\xfd\xf8\xe3 \xb0,\xbb8W1K\xffa\x83\xec\x1c\xc6\xf8\xec\xddR\n*\x00\xaf\x1dSit\x8e\xe3\xf8\x85\xe2\xc1H\xa9AJE\x14\xf7Y^;\xcfvk\x8d\xa9\xc6<\xeb\xad\vLT\f\xbe\xd5\xc0sÑ\xac\x91\"\xcb\x12ⴐ\xcf\xc8\xf4\x90\x8d\xd6%\xe6\xa6n(\xceG\xb7<\xce-}\x1em\xf2\xe7\x14\xaeR\x142{ʲ\xf1\x82\x12\xf5\xc9Y\xf2,%\x8a\x06\xac\xf6;\\X\xbfB\x1a\xf5\xb0U\xe1\xb6\xd2g\x8d\xcc\x15\xff.\x18\x88FH\x1c\xbfLcj\x9e\xc6\xe4,\x8e\xe5\xb1\u007f\xfe.&\xab\xbb\xb8\xb2q\x9a\xee`\x03\xad5%\xbfA\xe0W\xd0\"\"Ɇ\x9c8\xfd$\x17.\x9dA9\x1eҲ\xc7\xed!;(3lo0غBo\xab\xd9\xf0\xc2ꋓ$&Ib\x8e\x9fz\x82\xe6\xd4<\x96e9\xbd$\xfa\xb9\xf2\xe4\xc2\xeb;닣\xe3\x93\xe4s\xd2\xe2\xbf\xd3e\xed\xfc`\xf1\x10\x04\x96e\xf13?\xf2^\xb2<}\xbb\xc1\xec.\xb4\a%\x81Wb\xb3\xb5\xc2Vk\x85$M\xc7\xd4\xcf\x1d\x92\xf4\xc1\xbd\xb7\x12\xa7\x036Z\x8b\b)\xf0\x9c\xe2p\x17!,\f\x1a\xd7\xf6Fm\x95\bKY\xc4\xc9\x00\xad\r\xd5\xd2$\x9eSf\xab\xbb\xf8\x9c\x9d\xf2\xec\x8cO\b\x89\x94j\xa46\xea\x13\x84Uʵ)\xc2\xda\x14\x96_\xc6(\x17\xa3\\P\x0eH\a\xa4U\x8c\xac՛L\xce\xee\xa19\xbb\x97Jm\x12\xdb\xf28\xb8\xf0\x02\xa6'\x0e\xb0\xb6u\x9ev\u007f\rc4\xa1_\xa7\x1c40\"#I\x87|\xe1\xc8C,\xaf\\.\x94K\x83\x12bD\xd2V\xae\x87t\\\xba\xeb\xcb\f\xb6\xd7\x18n\xad\x12o\xad\x8d\x1f\xf2\xff\xd3\u05f9\xc4\xc8qUa\xf8\xbbU\xb7n=\xfa9o\x8f=\xf6؎\r\x81\xb0 XB\x10\t\xb1D\x04\x16\b\t$X\x85\r\b\x81\x84@ v\xc0\x9e5b\xcd\x02e\x85Ȃ\x15D\x11BB\x84\x80\x9d\x10\f\x89\x9f\x99\x19\xc7=\xaf~L?\xaa\xba\xea\u058bŭ\xae\x99\x01\x94\xdew\xab\xba\xce=\x8f{\xfe\xf3\xffg\xfb\xcaM6\u05f7\x8cp\x9bm\xdf\xecn\xdfxe\xf7\xee\xeb\x87\xf5n\x13aST\xffQ\xfe\xb7W\x18I\fQ\xc7zK\x98\xcefI\xc9x:Xw]\uf5b1\xac]\xc5gM\xd3\xef\xe0*\x97h\x1eR\x14\v\x01\xe29{O\xef\xf1x\xef_l]\xbc\xc1\xe0d\x9f\xfex\x87\x92\x8c\x86Z'I\x93\x8a\x00\x93\xe3\xa9\x06\x85\xe3\x93\xe8\x10\x81\xa0\xd5\xe8\xa2\xd39\x97/<G3X\xe2퇯2O\xc6\x1f\bz\t\x04¦^\xcb\xedzA\xd54<]\xf1-\xaaCT\x1bSX\xb4\xfc5n^\xfe$\xdd\xd6\x06\xbd\xe3\xfb\f'f\xe3h\xe0ui\x05+\b\x01\xe3\xf1\x80\xdbw_c<5\xa5\xb9\xf4\x8d1\x16\x1f\xa7\xd9EG3\xc2\xd1Q]\xba/D\x05\x94R\\\xd9z\x06)\x15Y\x9er2;\xb1U\xb3\xfd\xfd͛\x1f\xff\xc6\xfe\x83\xb7N\xe5\x03+/\x91g\x13\xb6e\x99\x874%qQ'\xf4<O\xb1lI\xefp\xe7ū\x97\x9f\xf5\x8c\xe1\xa8\xdbЍ\xa0\x83\xeb\xfaF\x03\xa4\xd2Y/)\x99Ec\xde\xf8ǫ\xac\xafn\xd1\f\x96\x98\xcc\x06\xe4\xf9.\x8e\x18\x92\xc4\t\xd3pD\x96\x1b\xbd\x90\xc0k\xd3\f\x96\x91\xb6$\x8a\xa7xn\x93\x86ߥ\xdb\xde\xe0\xc2\xea3\xdc\xdb\xf9\v\xef\xed\xdf!\xcb\xf5yx\xf9LX\xad\xe7\u007fE\xf9\x81\xf5\x8a\xafZ\xac-]c}\xe9*\xad\xc6\x1a\xb6e\xd3\xeb?\xe0\xf8d\xc7t\x91\xbd.\xad`\x95<\xd7\xec\xed\xdf睇\xaf3O\xc2j\x94(0\xa1\xaaz\x89N\xb3\x83%\x1d\xf4x@<\xee#\xa5\x83@T\x13\x8e\xb0}\xf9:͠\x8dmI\xa6I\x84P\x1e \xbe\xf6ܧ?\xf7\xf2h\xef\xfe\xef\xcb\xea\xf0.\x9e^\x9e\xd5%\xb4-\x87\x97\xbe\xfe#\x91e\xfaS:\x8d\u007f\x00\xe5kB\x88_\xbd\xfc\x9b_Dy\x9e\xe1\xfb\x8d\xaf\x14E^mشklbu\xf9\"\xae\xf2\xf1=3\x9c\\r*A\xf1t\xff\x11\u007f}\xeb\x0f\xbcp\xeb\xf3D\xf3\tQ\x14b\x95)\x83\xd1AuZM\x9b|<=bp\xd2\xc3h\xf0\x9aP\xe4\xa9\x06\x9d\xd6\x1aK\xed\v|\xe4\xdag\xb8v\xe9\x13\x1c\x8fv\x19Mz\x9c\xcc\x0e\b\xe7C\xb2<\xf9?\x170Q\xe7=[H\x94\xe3\xd3\n\xd6\xe9\xb6.\xb0ܹH+X\xa9s]\xa2#\x06\xe3=\x86\x93^UB7\xe94\xd6\x19\x8e\x0fx\xb8\xfb\x16\a\xc7;\xa4\x99\xe1\xadخ\x87T\xdeiX\xb7\x1d\xbc\xee\x1aI8f\xda\xef\x19\x8d-[\xd5\x03\x1f\x97.^\xe1\xe2\xe65\x1c\xc7CJ\x87q4\xa1t=\x8a<s\x85\xb0~\xfb\xfcg\xbf\xf4\xcd\xee\xf2\xea\xe3\xc2mPP&\xc3\xfd\xddDؖ\x8dm;|\xfb\xa5\x9f\x91\xe5\xfa\x96N\x93\x9fj=\xff\xe2,\x1a\t\xe3\xd6\xf6a4\x0f\xbf\xfb\xf77\xff\xf4\xc7/\xbf\xf8\xad\xbd\xb5\x95K\xbeY\xf4+Q\x8eBJ\x85\xab<\xee\xbdw\x9b\a;w\x18\f\x8fЩ>_[[\x92\x17n}\x81k\xdb\xcf\x12\x86SR\r\xc3\xe1\xe1\xb9\\P\x96\x05Y\x9eV`\x99\t\x9f\xe5\xc2\x13\x85\x8d#\x15\x8e\xe3\xd3\xf0;x*\xc0u\x1b(\xa9(\xca\x1c\x9dƆ\xe1T\x165\x85\xc1x\xbcD\xda\niU;q+\xbd\x92X\x87Lf}\xfa\xa3'L\xe7\x03\x1cip}G\xfa\xf8\xaa\xc3Ӄ\x87\xec\x1d\xbc\xcbdگc\xbb\xa5<\xa4\xeb\x99PU\x85\xbf`m\v\xcbu9\xe9\xbd\xc7\xe0\xf1]\x94\xe3\xd7\xfc\xf5\xed+\xd7Y_\xbb\xc8\xfa\xca6\xae\xf2\x98\xc7!o\xef\xfe\x1bm\xe24\xad\xcdm\xc3\x02\xce3P\x1e\xa5\x10\xe4y\x86\xfc\xf1w~\x89\xeb\xba\x1f\v\x82\xe6O\x0e\xfb\xbb_>\xec\xef\xda\xf3\xc4,X\x9cLO8:>\xdc\xd8ܸ\xf2k\xc7q_i5\x97|\xe3\x1d\xa6\xb9VV\xbd\x9aDǬ\xafl\xd3;zD\xa2c&\xd3\xf1\xb9R./2\xfe|\xfbw\xdc{|\x9b\xab\x97?J\xa7\xb1\x86\xe7\x05F螒\xb2\xcc\xcf\xc1\xc7f\x89Z\xc1\x02X.ʜD\xcfItD\x18\x8d\x10®4tm\x02\xaf\xcdR{\x83Vc\xb9\xc6c\x8a\x8a\u0090\xe6\t\xf3xJ^d8R\x91\xe8\x98\xf1\xec\x98i\xd8G\xa7\x11I:Ƕm\x10\ni+\xe2xνGo2\x1c\xef\x93\xe8\xb8\x0e#\xb6\xebc9\xea4o\b\x81\x13\xb4\xb1\xbd\x804\x0e9齇\xab\xfczm\xf8\x8d\xeb\xcf\xd2j\x99\xe7R\x8e\x8beI\x86\xa3c\xc2\xe9\x90x:6\xfcGi\xb3|\xf9&q4ŭJg۲\x91\xab+\x1b?\xef\xb6W\xbf\x17\xf8M\xe7\xc1\xce\x1d\xe2$\xac\x86\xc4\x12\x94\xe3\xd2nu\xe8\xed\xef(˲\xbfj\xee\x1b\x05y>'\xcbS\xb4T4\x82vU\xa6*n\\y\x9e\xc7\xd6\xdbh\xad\x89\xe1\x9cQ\x8a\xa2\xa0?\xeaq2\xe9\xd3\xf0\xdb,u6Yno\xa2\x1c\x0f!lJL\t\xb8\xc8?\v.\xe0\"\x97\x89\x05\x10V\x01Zy\xb1\xa0_\x87\f\xc7=\x1c\xa9pUp\xba\xac\xb86L\x86Nc\xf2\"%\xcb5\x89\x8e\xc8r]\xf1\xd8}\x1c\xa9\xc8\U000c2f43\a\x1c\x1e\xef\x12\xeb\xa8\xd2\xfe\xad\x94%\xbc\xc0\x18\xe3\xcc\x0eD!,\x9cf\a(\x99\x1c\xbd\x8f\x95\xe7\x86c\x8f\xe0\x99\xeb\x1f\xa6\xd9l\xd1n\xae\xe0\xfb\xad\xfa{\aG{\xc4\xd3\x13ʊ\x9a\x97\x8cG\xb0U0=|\x1f\x99i\x02\xdbx\x9e\\_\xb9\xf4C\xa5<t\x1a3<9\xa8YE&\xd1[8\x8e\xa2\xd9l3\x1c\r\b\xa3\ty\x91bۆ˗\xe89\xe1|\x82\xb4\x15\r\xbfE\xb7\xbd\xce\xe6\xeau(\x05Oz\x066;5\x8aA\xf3\xd22a<\x1b0\x8dF\xf4\x8e\x1e\x12x\x1d\x9a\xc1\x12\xad`\x89\xc0oa[\x8e\t7\x95q\xea\x8e,&\x1cU\x1c\xdc\xff\xe9N':B\xa7\t\xca\xf1*\x83\x9a\x10\x98皒\x92,ӤYLQ\xe6ՍހMa4a\xe7ɻ\x8cg}s\xb9=\xa3\x8e-\xbd\x00a\x1b\xad\xf7\x05x'\x84U\xdd\xcc\x15z>c\xd6\xdb1\x95\xa8\x94\\ٺJ\xa3Ѣ\x11,\xd1n\x9a<\x15\xf8-\x9e<}\xc4Q\xff\xa9\x99l\xa9\x14!t4\xa5\xd0\x1a=\x1d\x19\xfe|\xa3Ma\xdbH*\xf5\xcdǏ\xee\x92\xe8\xb0*\x15\xcbS\x1e\xb8㐦\xba\xe6j\xeb4A\x89Ŏ\x8dEk\"a4\x99\xd3m\xaf\xb1\xbat\t\x9dƤ\xa9f\xffx\xd7\x18%\xcfN\x81\xaf\xb24m\xeb\xa2D\x171Y\xa6\x99\x86\x03\x0e\x85E\xe0\xb7Yj_\xa0\xd9XBI\x1fi;5!\xd3\x12\vN \xf5\v\xa7\\\x80LřntY߅\xc4\x02\x93\xa8Jw\x84\u0091\x1e\xca\t\x88\xe6\x13\x9e\xec?\xe4\xe0h\x87D\xcf\xcfug\x85t\x90\xaeo\xee\x19\xd6Yc\b,)q\xbbk\xa6\xef\xb6\xf7\x00KXt\xda\x1dVV6\b|\x93ۺm\x03\xed\xbaʧ(\n\xfe\xf9\xce\xeb\xf8~@\xe078<\xea\x19\x19\xc28\"\x8bC\x8a<G\xa7\x9a\xa0,\xb0\xf2\x12)\xa5C\x9cD\xec\x1f=>u\xf73gO\x00Y~:\xb9\x97\x179Y\x96 \xa4[\xbf\xacE\x888\x99\xf4i\xb7VXjoP\x96\x05\xf38d<\xedW<\xef\xd3\xd3w\x96\x9fV\x94\x05\xa2,\xc1*\x99E'\x84\xf3\x89\t\x95\xcd5\x9aA\x17\xdfm\"m\a)]lKV\xbfq\xfa;\xc6\bF\x18@V\x9e\x1b\xf8\x1d,!\x88\xf5\x8c\xbcH+:3P\b&\xb3\x11\x8fv\xde`8\xd9G\xa7I\x9dw\xeaN\x80\xa3\xb0\x95[]\xfa\xac3\xad<\x01\x96\x8d\xd3^\xa6,\vf\x87Oɦ\x06\xbcj6Z(\xe5\"\x84\xc5Jw\x13G*\x83\xb5;\x1e\u007f{\xf35\xe2dƵ\xab\x1fb4\x1a\x9cCJ\x8a<#\xcf4\xd3لng\x19\xca\xca \xa3\xf1!\xc3\xf1\xc1\xe9\xb0ÙX`\xd9V\x85-\xd8մ\x90\xa8\xd6b\x9b\x813)U5\xd8`\xee.\x93\xc9\x00\xdfo\xd2mo\xb0\xb5\x99U\x15Є\xecL^X\x84\x04˲\x17\xcc\xe7\xda\xe0\x8bR8\x8cN\x88\xe6\x13\\7\xa0\xe9w\xf0\xbd\x16\xbe\xdb\xc4q<\\\xd5@U\x06\x12®\x9e\xad\xa8\xe5.\x04\xb61F^0\r\xc7DфI8d0\xde/\xa6\xe1\xd0ZL\xb1\x9f-\x97\x85ea9.\x96\xe3ԕTI\x89Xp\x9a\x84\xf1\x9c<O\x99\xf5\xf7\xb1\xa2\t\x97/mW3Z\x02\xa5\xbc\xca3$\x96%\xf1\xdd\x06\xef\u07bf\xc3\xee\xfb\xf7\xd8X\xbb\x88rT==_\xf7\t\x8b\x82\"ː\xf6\xe9\xfd\xfc?d9\xa5j\xba\x11=3\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_04_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x005^zTXtRaw profile type exif\x00\x00xڭ\x9cg\xba\xe6\xb8qF\xffs\x15Z\x02\x91\x81\xe5\x10\x81\xcf\xe3\x1dx\xf9>\x87\xb7'X\xc1\x92-OK\xd3=\xb7\xbf@\x02Uo\xa8*\xf0:\xff\xf9\x1f\xef\xf5\x97\xbf\xfc%\xc4\xd0\xef+\x97\xd6\xeb\xa8\xf5\xe6\x9f<\xf2\x88\x0f\u007f\xe8\xf7\x1f\xff\x9c_\xbf\xff\xfc,\xdc\xf9\xfb\xf7o\xff\x84_\xff\x0e\xd7\xdf\xfd\x8b\xc8\xef\x89\xdf\xd3\xcf\x0f[\xfe\xf5\xb7\xe9\xd7\xcf\u007f{}\xfd\xfdw>\xe8\xef\xfcE(\u007f\xf5\x86\xf4\xfb\xd7\xc4?\u007fq{~\xfd<\xde\xf1\xbf]Q\xc8\xf1\xbd\xff\xfcO\xff\xe3\xff\xef\xbb\xfb\xfb\x9e\x9f\xbb{re\x19\xea\xcfM\xfd\xbc\xf5\xfa\xedcx\xe1d\x95\xd2\xf7\xb6ʯ\xc6\xff\v\u007fn߯\xc1\xaf~?\xf7\n\xf9\xde\xf7\xba'\xbfV\x18\xacs\xbaߐþ\xc2\x13\xdep\xc2\xe6\xf7\x15\x16ט㉍\xdfc\\1}?\xeb\xa9\xc5\x11W\xbaSH\xd9_\xe1\x8d-\x8d\xb4SO1\xadxRJ\xf9J\xf1\xf7k\t\xdf\xf7\x8e\xef\xfb\x16;\xba\xef\x1dxi\f|X\xe0-\xff\xe3\xaf럽\xe0_\xf9\xf5\xbe\xebf\x8dB\xe0\xeeï\xb5\xe2\xbabt\x1f\x82˘\xfc7/cC\xc2\xfbk\xdfʷ\xc0\xbf\xfd\xfa\xfd\x9f\xebO\x1b\x9b\xd8\xc1\xf2-s\xe7\x06\x9f{\xfe|\xc4,\xe1\x8f\xd8J_\x00$^W\xf8\xfd'\xbeB\xdb\xeeZ\xfc\xa2$\xf3݅\x8b\t\x89-\xb8kH%\xd4p\xb7\x18[\b9\xc5\xce\x06=\\yL9Nv \x94\x127\x17\x19sJ\x95\xbd\xe9\xc4\x11\xdf\xcd{Z\xf8^\x1bK\xfc\xf99\xa9\xc2\xfe\x94TScoFzج\x9c\v\xf1\xd3r'\x86\x9e\x92J.\xa5\xd4\xd2J/\xa3<WM5\xd7Rkm՜{Zj\xb9\x95V[k\xbd\x8d\xf6\xf4\xd4s/\xbd\xf6\xd6{\x1f\xfd\x19q$R\xb2\x8c:\xda\xe8c\x8c\xe7\xe1;\x9f|=\xe5\xe1\xdd\x0f\xafx\x9e\x19g\x9ay\x96Yg\x9b}\x8e\xf9,\xc2g\xe5UV]m\xf55ֳ\xe3N;\xef\xb2\xebn\xbbﱟ\x13\x0e\xa1t\x9d|ʩ\xa7\x9d~\xc6y^b\xedMo~\xcb[\xdf\xf6\xf6w\xbc\xcf\xef\xbb\xf6kW\xff\xe6\xd7\xffb\xd7¯]\x8b\xdfN\xf9\xba\xf6\xfb\xae\xf1\xd3\xd6\xfc\xa0\xef#\x828S\xdc3v,\xe6\xc0\x8e7w\x80\x80\x8e\xee\xd9\xddC\xceѝs\xcf\xee\x11ɊB\x86\x87\xe2\xde\xecp?W\xa8la>!\x967\xfc\xbew\u007f\xecܿ\xbco\x17k\xfd\xcf\xf6-\xfe+;w\xb9u\xff\x0f;\x17\xaf\x93\xfej\xdf\xfeήm\x91p};\xf6\x93\x85\xae\xe9\x9d\xc8>^\xf3D\xbe\xed}\xd95.:\x1d\x80\x8d\x1f?a\xbc\xb9\x9a\xccoo\xfe\xa4?OzO\xa8\xfcw\xf2\xbfO\xafm\a\xdew\xa7C6\x9f\xf8\xf4\xdb[\x8a\xf3\xf2\xd3\xfe?>\xecʿ.\xed\xdf\xfd\xb0\xeb\xb7K\xfbw?\xec\xfa\xf3}\xfe;\x1fv\xfd\xf5\xa2\xfd_?\xec\xfa{;\xf0\u007f\xf9\xb0\xeb\x1fm\xe7\xff\xf6î\xff)6\xc6\"\x01\xdfFf\xad\x13\xdaʓ|\vo\x06\xa3C\xf0\xa3\xf6x\xe68\xbbL\x98\xedz{\x9d\xf9٤Ɍe\xb6\xed\x97\x12\xc6d\xca;\xcf\f\xb9\x9f\xd2\xf7\x04CN\x98\xad/\xde\xc25\x9e{\xc7J\xa2\xac:R\x9b\xe1\xf4\x91\xaf\xd2\xc6\xdc\xe3]u\xbe\xf7\xd9$˞{\x86\x9df\xe9=\xad3BMo\xaa\r\xd8/\xb9ða\x0e\x12g\xc0\xbf\xe7\xc9\xefSZޱ\xf5\xb7]\x8b\xbf3\x95\xc9\xcb\xf1\xe4\x98\xf8\xfb\x87T\x04\xa5\xb8\xf1p\xcc\xe8\xd0f\xba[ \x8bA\x10\xa0\x03x\xf0\xaa\xe1㶾[|\xe1\x92\xeb\xf4\xfd\xbe\x1d }\xfdQ(\xb9\xbd\xa4;\xff\xfd\xbd\x18\xbd\xf0>\xbc\xef\xf0\x1e>x\x81?7\xb9\x1d\xfbs\x9a\xa2a\xbf\xbd\xa0\x15\xc0\xd0+\xa7\x13\x13w\x9cG\x98\xf0AyA/\xee\xf4N\\t\x02JK\xc8\xfc\xef\x1f\xfe.\x01\u007f\u007f\xbc\xfe\xf9k\xbf\xdf\xf3\xbc\xcb\xcco\xbbw\xef5\x8c\x98!ɱ\xfb\x93Y\xfd7\x83\xc0\u05ec\xe7\x1e\xa0O\x8c\xc0Z\x98e\x1c\x90\xb5\x1f\x10\xf7\xbb\xb5\x9dc\x9d\xcf\xd8)!pfl\x03\xacf\x8bW\xb9\x9f^k\xcf\v\xa4}\xb8\xd9q\xad\xb7\xf2WP\xf6\x93\xd9\xe7~\xafUB/\x89P:\xc4\xd0y\xea$\xf0@\xe3\x10vL\xcfL\x0f\xe8\x17\x04\xfcZ\x1e\xb6\x86\xf7\x86\x8a\x9c\n\x17\xa4\xc1\x02\xd5|*_Zʚ\xed\xbd\xe7\x1a\xed\xce=\xa4\t7A%\x15\x1cܭ\x9c\xb8\xcay\xf9\xa4\x9az\t\ve\xd6c\xe1\xa6\x1bDS\xaf\xf9\x94\ue3842V\x06\xe2!\x95Ɇ\x84\x97\x18xf?\xb3\xd6x\x02\x9bP\xd2Sǹ\x9f\xf8\xee\xfb$\xb2\xe3&\xcavE\xce!\xd3B\xbf^Vn\xad\xd4\xdfu\x9ey\xce\x1b\xf7|\x1b\x98\\һ\xd9\xf4T\xe6l\r\xb6@-\x12\xdd\xd0\rq\\ӳ\\\xc1\x19\xcf\xf2\xb6bn\xfb\x8aO\x84\x0e\xd7(p\xc0\xa9PY\x95WJ\xe9\xd0\xd0Aǯ\xd4H\x92\xdaX'^\a\x95\x18\xeb\x8f\xc1\x1dI\x9c<H\x93\xd4*,\x82dE.6R\xbbrE\xbb\x1eR\xbb\xdf\xe4\xd3\\;\xe7\x1d҂?\x9e\x00%=\x83?\xec@b>\xab\xb1\xaa\x93P\xce\xf2`d]\x9f\x8b\xfc$\x18\xe7(\xe6\x19\n\x1c\n<^vy\xd6\xd3\xfa6LX\x10d\xe2\\\\\x12_3\xf2\x9ce\x8f\x88\xda\u007f\xcb\"\x86N\x9beqk\xab\xb3>\xbbOV\xa1痽\xe0ry]\x9c\x0fD' \xac\x99\x12\xb7\x8c\x84\x8e\xbc!D>\xbf\x904,\x17\x11*.}\x11G\xaeݻ\xb0\xbc\x85\xadK\xc2\x14\u007fȻri\xf1悸\xc9=y\xd3\xf3N\xc0g\xe6\x93ٷ\xce\xff\xee\xbf\xfa\xfd\xfa\xfd\a \xe1?x͟~\x9f\xb3\x8e\xa7\xec]\x0e\xca2<\xed\x1c\x8c\xc5>\xab\x9f\xeb\xae\xc4\x1e+Q*[\x9aè'\xdcp?ɐs`Gn\xb0\x93h$O\xda\x19\xe8\x9bg\x04B8\xb3s|n\n\xbbN\xb6O^C\xe9\x1e\xfe\x01\x9f\xc2J \xf5ΧՍ1x\x0e\xcb}v\xec\xad\x10\xc19\xf4\xc5vn\x00\xe9˗2\xdeM\x16t\x1d^2\xbeٵ]\x17\x12)d>\xbb\xde\x0f\x88>^\xf6\x05\x10R\xe5\xf0\xf1\x11m\u05884\xf6\x89U\xad\x89x~\xe6B\xb7\x90\x84\x83\xffj\xa0P\xaf\x17X\x8c\xf6\x88\x83\xbd\x89\xa0\x06/D\x8f\x04^\x1a#Ҥ\xbb_@j\x01u3\x10P\xeeI\xa2\xe0\xb2n\x02\xa6\xec\xd5تu\x9f\xfa\x8cK\x03\xca\xeb\x801\xbe\x89h\x02'\x06\x81\x96\x9b\xabZ\x1f\x93\xbc\x12\xf0\x80O\xe0O0\x00\xea\t0\x97hPD\xc0Nk,\x110R\x1b\x02*\x91\x19\\`\x01:`\x97\xbe^\x80\x88\xd0\fu\x1b\xa6ڷ\xf7\xb0\xb0s\"\xad \x0e\xd0j\x90\xc9h\xac\x95a\x85\x85\n\xbd/\x94\xe6D\u007f\x1d\x90<W\x00\xb8\x82\xf7\x9bu$\xd9َ\\c-ذ\xea~\xa0\xf5bA\xb6=\xab\x13gx4ذ\x961\xe0BR\xe4y3\xe1\x9b0~\v-\x1a\xf938z\x88ܴ?:x6\xd7x\xc39\xc0!\xe1\xc9Eֽ\x10j\xf3\xa3\xea\xb7<\xc2\xdb*\xedzY\x8c\t\x11\x9e\x0e\x1f\xf2\xb3\x9e\xe7\xbb\xda>\x1d\x05\xc8\x1a!\xb27\xa0\x99\xee\xd5\x14z\xa1?\x9b\x97\xcf{\xa7\x032\xec\xfb\x1d\xac\x18\xaa[\x84\\\x8dX\x84\xd4H\xf3z\x00\xe1/\u007f\x80\xaaE\xd0\xe4\xf8\b\xda3\x8c\xd9\xd7Û\xe5J\xccVC\x9b\x02\xbe\xed\x16[2ȸ\x11Z,\xd7\xd0#,\xe8u\x9d\xda\a\xf0\xd1o@\r O\xac-@\u007f#\x9f\x03\xf2\x15\xac6\xa6\xbdҼ\x17X\x94\xc1\xc9lҌ\xebL \xa6=\xbe\x9c\xef\x80\xea_șd\x98kվ\x00J6#߈\xe2\x11\xfaO\xae\x97\x06~\xa3.vY$3{L\xe6\xacv\x05>\x82\x9c\x80\x19ގ\xd6\x1e\a;\xbc\x9e\xb7\x9d\xb7\x91\xee\xfc\x1aw\x00\x00\xd1˧\xc4VY\xb9D0\xe4\xf4\x8e\xddڎ|\x16\xb2=\xacr\x15\x12\xc1\x9cy\xc1]\x80\xab\xc2\xc3\t/\xb3\x1f\xc2:\xa2*\xf6\xf3\x02)O\xe33\xdd\xff*\xb5\x80\x9e\x0f0w\x03\x84\xe4\xc7h\xacɾ0\tpz$\xc3\xf9\x96\xd3Y@\xf6\x95\xab\xaa*%Ѝ\xd4 \x9f\x94\xe5\xc7\\\xeb\xd0\xc9\x03\x1ao#[yP\xf3\xd6D\x95Kxc\xe3\v/zn\x12\xfd\x13r\xac\t«\xf7G\xa5\x86%x\xde\xd1Ug\r\xc4\xfb\xf00\xdfg\x12\x88+\x8c\x1fe\xa2\x88h\xff\xf8zD\xbb?.F!\xf8W\x97s\xff\\\x10\xc2\f\xa1\x15~\xb4\xce\xcfE\x11\xc9?\x97\xf5\xeb\xa2x\xf3wY\xe1\xfd\x87\xa0\x89p\x8b-\xcf\xeb\xc5\x04\xcd\x04?\xaf\fաǈ!\f\x16\x1b\nR\xcfD0\x92͇\x10\xe2\r\xe3!\x1b&\xbe\x1eH[@D\xc0\xa8)!\b\xcdt\xb5\x04#b\x1e\x87\xf1\vj\x8c\x9b\x0f\x1cB\x19L37\xe8\xd6F\xcd\xe1\xde\x0f\xd2\x02]\a\xef\xb3\b\x84\xc6\xc3\x0er\xc7\r29\xf0\xef\xd5uJ\xa5\xbeh\x96\b3\xebɦ\x92$C\xffx\xc7\x0fW`\xa5\x02,b\x16\x91#\x9b=G\xe2\xa2[\a+\x9ev*Z\xd8tq=p\xff\xe8 \xf5!\xde'^t\xbda\xbd\x13\x95\xc7\x0e\xf0/\xf4\xab걮Q\x89zn|\x80\x8b\xaa\xa8\x93\xe4\xdeE\x8c\xcc9.\\+\xc1\x02\x9c\xe7\x10\x81$4йK\x05-Ө\xdb\r\xc5F\x86\xb8\xc9f\x881\xad\x1fnG^\xe5w\x94g 9A;\x00\xf9\xb9\xcex\xfa \xaf\xde\x11\xdfCĀ\xc7\\\x14N\xf5\x86\x0e\xc0\xa4\t\x06\x90\x14\x834\xe7\x9dP\x16\x1f6\x06\x89\x86\x04\x82So\xb1\xe94X$Y\xb7\xea\xe4\xe1!\x9d\xb9\xa99\u007f\x02\xaeBGD\xecB\xb7Ê\xb7\x89\x81'E3\x90\xe5d?\x17\x054\xe1\xc4\xf1\x01\xa0\xc7L\x17R\n\x03\x1c䞌\n\a\f;\x16\xb6\xc1|\xcfY\x81}C\xbdE8\xa5.\x10\xea\x94\x1bm\t)\x06t\x1b\xfa\x9c\xe8&\x82\x11\\\x13wToP!)˹\xde\x00Kͼ6\x16\x03\xe71d\xcf2nv\xb6Ax\xb2I:H'd\xcc\\\"H,/\x848\xd0W\xe7j@\u007f-\xd1m@\x85\xecwG\xc4\x10\f_\x91n\xf2\x00f\x1b\xfam\xc6&B\x1c>a\xa9\xadόw`Uؓ\x000>\xad^\xa0\x03+I\x18\x9d2o7+\x1c\"\xb1\xe0Z\x0e\xaf\xe0_\x84\xb2!\xd9qD\xa8?\x16\x13\fFWvb\xe1\xd4L\x04\x88Xm\x00\xfe\xf0\xc4䆈%T\x04\xd6%\x1eb>\xac\xb52V\x04\xa9G@O\xe0\x83H㿻1G\x98\ab\x1aFB\x96/V!\x9c\xfbB\xa0\xb3x\b\x8fS\xf0E\xfd\xabE@|\x8b\xedS5r\x85\x89\x05f\xab\xe0I\xbc\x19k3O\xe8cfE\xd4\x01\xad\xf3,\xe4\xef{\xb1\xfe\x1b_\x02\xf3Op3|e\xda\x05\x81\xc1(\x95\xccji\rЈtbW\xc5\xc8'\x8e\xa2\xc8y\xd9\x18xf\x1e|\v\x9f\xec\a\x15\xbd&0\x03\x12\xb1\"]\x15\n\x9bC;\x05\xd0\xc9@\x88q\rkN\x84i.'e\x8cF\x1fd\f\x9c\x0e\x02\xa0\x96';t\xb1Ep\x05\x01\v=\xb2\xf1\x87\xbf\x10jvF\x14}\u007f\xba\xef\u007f\xf6;\xa2\xb0\x94k\xdc;7\xee\xb2r\xb3\x90\x1dW2\f\xc7\x1b\n\xd8\x11\xaaz \xef\xd2!\xca\xc8E\xbd\b\xdf\x17r\x87\xcep4\x15\x1f\x92\xb8\xc3z\xc7g]\xc4\x18\xe0Ӷ\x8aĺX?+C\r\xac\x02\xa8\xc1\xe7\x82e\x88\x987~\x02\xb0\xbf}\xba\xc2H\x89\t\x93byB5w\x11-\xd7Y|1\xc9v>\xe6\xbe\xf1\xd3E\x1a \xe1\x1e\xfe\x84e!\x00p\x00\xd9\xd2\x0f\v\xa8\xa4\xc0\x0f\x11[d\x15\x00y#\xd1R\xa8a_i\x00+\x0fr,d\xb2*w\xa2\x18r\xf3'+\xe1\xb8ARLʃz\xad\xfa\xe3\tl4\tv\xf3\xe9@iӇ\x95Yμ\x1eL\xd4\x13\xb5\xd6\xe0+\xfa)\xb1\xbb\xe9#g\xf5\x18\xa6\x1b4D\xbd\xf2\xf5\xe8=\xc4\x1d~\f\xd1\x02\x836r\x02\x17B\xfe&\x84\x1cj\x04\xa9\x02\x10\x0f\xae\x91\xf7O\xcc\xe1\x9cq\xe31\x00\xa81\xefW\xad\x8cjC\xab\x01jH\xea\x1f\xcb\f,(ߐq\xec\xc4B\x9e\xc1\xfd\xc0\xd8\v\x0e\xc1\xd6푈*\xa1KBc}\"[4ض\xacL\xdf\xd1o\xc16!\x8b@\xec\x1f/KV\xf2\xf1\\tx..\r\x15\x8c?\x1d\x98V\xfe\x1a\x8dG~D%\f\xe8|\x88\\\x04\x1f\x97\x03\xb5\x95\x88\x9bi\xe8\xfe9\x822\r\xe8\x84\x1bУ\x89\xa5\xb90o\b'|\xfc\xe3\xae\x1e\x97\xb6$±\xf6SR@\x9c\xc4\x0f\xaa@?\x9c\xd6\v\xc4!ϕ\x94Hm#\xac\x908\x15E\x1c\xae\x0f\xb6\xb8\x18\x01\xffq\x9b\x132+\xa2\xe3`Q\xc4pGrB7\x15\xf5\x94\x88\xc9[B\xc3p\xa2\x9d-\xfd\xc1\x00_a\x02\xcdr\xe1\x1c\x93\x96\x10\x9b\x87.A\xaeĶ3\xf6\x1a\x82xH:`\xf6M\x1b\xbe\xc7\xcd#\xafF\xc7B\x90\xde\\i\xc7ń\xa3[bu\xc3{Y\xf1\xd1\xe5p\xc9\x15\x1fK\x9a\xf3\xb7H;\x98\x18\xfd\xd8X\r\bN\x9a\x8aE\x93\xc3\x15u\xcd$k\xa0#\x04C\x812\xbd3x\x94\x88\xfd\xa7<\xc4\xd9M\"a\x99\xd2\"J\xe3=\xad\xc1\xd6\x17\xaf\x8c_\xc2\xed\x83A\x1dQ}2\xd6\x01\xd18\rY\x12\x81\xac$7\xd6\xf5\xe8\xacɍ\x03\x84r\xe9J\n\x02|\x11\xa0֒\xf6\xe8\x1fn\xf3\x8a\x19\xb0\xe5vM0\xb1(\xa8\x97\xe5\n\x05\xc0'\xfc\xe0\xed\xe7\x1a}g˸\xfc[I\xd4z\xc0\xc3ܪ\x90\xb7f\x96\x87\xb0j`:\x04\x03Y\x0e 7G\xc4\xcaR\xfd\x18\x8f\x05M\xce7´\r\x8fG\xfc\xf0\x8a\x1dS\xf66He\x02\xa5\x10\x9d\xc8\x14l!\xb0\x0f\x81\xb5\xe0\xca\xf6\xf7!ň\x18R\t\xd2\xdcm\x11y1\xbe\xf7AD\x14%]q\xe3\t\xb9N\xf4vh \xb1<\x04ƀN^$\x0f8\x0f\x90\x03\xf6\xa8\xba\xa5L\xfb\x9b\x82\xce\xf57\x15\x9e\x93\xdd+\xbc \xa8\xb2p0\xfc\f\xcf7\x1bQ\xf7~\x9a\x0e\x8f\x80\xb9Y\xb8\x92\xa9\xc6Ɨ\a\xb0\x91ŮO\x04\"!U\xdeύd\\\x19Hf\xf1\u0f6d\xcc\xc0\x12\x03`\xe3o!)1\x12\x10\xc9\x01\xf8\x88\x12괬~\xe7\x8c\x18\xbd\x01D6\xccB8\\\x83h\x0e\x00\xfb]\x03ˊ,\x83\xeb\x88a\x14m>\xe4\x02\xf4\x04\xd6\xe5\xd4[C\xba\xb1w\xd5b\fj\xb5\xa1\xb3!$m\xf7\xe9\r=ڸ|\x94\x18\x1c}p.\v\x01\x037gUу\xdbb\x9b7\xa0W%ukU[\xf9\x86A\x87\xb3.\xc0\x19AEtF\x1c\a`\n\xb8\x14\xd54\xb6\x16#\x17!ե\x1c\xbe\xdd\x1fU\x18w\xda7k2\xf0\xecK'\x8a\x90\x1d\\\xc15_l_'H\xc3p\x910\xa2\xa8\x05\b\xa2\xa1\xec\xba\xe5\xc9\x1bnyZ\xe3S\x80)\x94\x17\xc6\a\x98\x87a\xdfO\xf2\xe0u\xdc\xc8s\x11³\xb4\xd2\x0e\v\x89 |\xbf\x06\xc2\xea#eS\x92\x94^\x1f\x01\x8fx\xbf\t\xea\x1e\x063\xe9\xc4k\x161\x8e\x83CF\xa3\x8c\xf7EL\xc0\xfc\xf8<ؗ\x95\xb4̉6\xfbX\xebŘ6\xc5\x06X\x99\x11\u05f6<Qy\x16\xc9t\a\xf0\xcaD\xbb\xa8t\x93\x9d\x9a\xf6\xd9\xd6\xf3~l\xdb\xfb[\xd1K\xf8\\қ\xd0\fJ\x14\xcc\xcf\xe0BaP\xd6\r\xe4\xea\a\xf7F\xa0\x8d\xc7\x00@o\xd7\x14\xe3e\xb5\x02 \x942\xe0q\\\x1d\xc91q\x95\x15\xd8`\x05\"\x9c\xa9L'؆_\x81\xb5h\xec\xe0\xcd\u07bdr\xc4foa\x95}_Ֆ\xcb\x0eP_%\x13ߨ\xed\xcd(\x1bD\x84=\x16`\xf7\xfd\x8a\xbaϬ\xcbb\x97\xc9\xfd\xb8Ⱚ\xd5l\x144\xf1\x95\x1a\x8b\xbd\x16\\\x01\x99\xa0\x133\x9c\xc8\x1b맩H_\xd0\x18w\x1eI\x1a\x90\x8f\xac\x85N +0\x18PE\v$\xb0\x19\x1f\x8e\x18}õP\x1e8H\x824(\x8f\xb9C\x02\x17\x16Qx\"\x85,\x9c\x90\x02p\f\xd7\xc9\x0f\xac\x17\xf1\x1ab\x1c>\x83ǭ\xf1\x12\x14hȏS\x91Wy\xdc\b\xb6\xa5T\x9d.\v\xa8\x86\xb2ğ\x11\xaa\x01JC\xe4\xb1\xe6:.~\xf4Z\xee\x017\x01\xeb\xda\"\xb12\xfa\x05\xf9\x84\x9a\x11&Z\xf4f\xf8%\xf4\r\"\a\f\x84N\xf2!\x17\xb1\xdbP\xc9W\xa332\t۰'\xeeXB\xef\xec\b\xdfQq\xd9\\`\x99hY\xdd\xf6\x1a\x06=\xa8\xa5\xc47\x93\xd9\xfe\x05\x83!x\x1eQc\xf1u\xdeC3jY~\x90\xdd\xf5M\v<\"\x14\xd2>\xa3\xa2+\x90\xcf7\xfa\r8@\x87\x90\x1c \x0e\xfb\xfb\xf6l\x15\x17:\xea0+*\x84\xd0\xceD;\x99\n\xf3odkio\xbbP\xbc\x19\x95=;2\xbeE\x02\xb0f\x04sc\xdfق\n\x8e\xa2\xe1\x15.\xbc\x1c\x88\x81\xb7\xc9Q\x14\xfe\x93\x88\xb2GtfS;w}\x01S\xf0\x19Q\xa9\xce\xe4Z\xf0\x1a\xea2\xee\x93MZ\x1d}\x1d\xa2`\x02\xd0\x0f+\x98pܬ\x15\x8d\x05j\xbd\x00A\xb2:\x8ef\xb9^\xa4;\f\x88\xee \xe0\xf6(\x11A\xdc@W\xe8\x8b\xcf\xc2Z\x01\xb3÷B\xe9\xfa\x0f\xa4\xf6к!aRBe\x04B\xf4Ak_Rj3%\x86\x1d\x88\"γ\x139ªG8\xc3\xc1\xf6M\xbe\xb4}\x13v\xde\n\xa2ɀV\x98\xef\x16m\x1b\x82\x90d\u007f]u+YO\x81\x0e\x01\x87Ƈ\x91\xfc\xb6QJI:\xe2\xdb\n\xf1\xb4(\x19\x8a2\xfa\xe1\x9a\xf9\xc4\xc6\a`C\xad\xbf\x95^/\xab>D\u007f\xb1\x8a\xb3\x89vt\xec:ȍOC\xd7\xf8\xdf|>4z>\xc6\xc7\xd3\xd8ȁ\xc7ޔ2\x02\xf0.\x97\x8d\x81\x83\xc8/l[\n\xc4P\xdd\xfcK\xab\x17\x88\x86H0\xdb\x00\x91\x15\xb0\x8bz_\xee\x06u\x92\x91S\xec\x05w\nZ\x96\xcd\x15e\xe4\x90\xc5\x18\b\fk\xb9a\x8fʖ\xe5\xd9A0\xfc\x18*F\xf8\x9f\x1dXh}\x17\x00\xfea/\x10\xd3\xf6\x9bnD6\xb7\x14P\xcb $\xf2\x00\xaf6lt\xacf\xb5\x0f\xda\xc66\xdez\x80;\xf2\x05\xdbz\n\x19\x89\xe3\xc8@u\xb1ІF\xadV\x9a,\xab#\xbf\xdf~}\x95\xed}ct\x80\x8f\x00\x04\xd7\x05\xa0ڄ\xfeRvq\x97\xc8&uOD\xa7}ʑ\xacE\xfeqE\x18\x9d\xbe\xa0T\x12Ᾰ\x83\xc7p\x05\x16\xd3\xe2\xb3 ,8\x92\x17\xaf\x8aႵ\xb9\x84\xcf-\x13R\xf7\x83\U000703d2`\xb7\x0f\x1e\xdc%L\xedBh-\xf6\x8c\xe4F\xd4\x0ft\x90uNhT\x93\xac\x8d\xbb\x81\xa6e\x81n\xdd\t\xd0%b\x17\xb4\xfcn̷-\x16\xe2\v\xf1\x15\xd8\xc3'\xb1\xd8(\x9fa;\xe9k\x84\x8c\x02z\xc0D\x1bi\r\x16b\xe0Iz\xb2\xa9\xbc͎\x0e\xe0\xf7\b[\x98w5\x1e$\x82|\xe7\xe3\xdfs\xb1\xee{\xf5\x8a\xeb)9ݤ\xbe*\x17#\r\x99*\xdb1X5\xed\xe7Qww\xd8\x01p<\xb7R\x8ep\xddXql\x15\xea\xce5\xea\nX\xc4>\xec\x98\xecU\xe1\xfc\xb0\xd9d\xde\x1e\xa3'ˑ\xed\xa0\xdb\x1eT)!ۢ~\r\x95}\xe00P\v\xe8\xeb\xa0\ayvY\xef\x1d\x90#kMT$\xd1h[\xde\xea \x10\x01\xc1\x95\xc1\\\xbb $j\"Ks\x8a/2w\xbdӅM\xb8\x04s\x98x\xbf\x80*{\x85\x87X\xec@D\x8d\t\x16c\x93\xb4\x06ǘ\x00\x1d\xd7W\xfem\x1b\xf3u\x93\xd5G\xa3\r\xfc\xc3\xe5`\x0f\xef\xd4d\\\xfb\xb3T\xac\xfcs\x93__-\xfb&\xbf\xc9:\xeb3\x01U\x8a\xb4\xbf\x11\x06\xce\a\xf0\xbe\a/&\xa5m\xf2<\xc0o+/\x11\x1a\x82\xbc-\xb3p\xef\xe1%{^\xf6Dw1\x15KOER\xb4x\xb0\x1c\xb0\xb3\xf3\x0e2\xcf=\xcc\x00~\x94\xc2\x13\x84U\xb8\x97\x94\xbcXIH\x94<\xc01Dl\x1d\xf6\xb0H'uYx$4\xb65\xfe\xfeJ\x89\xb0(\x12\xbd\xa6\xaf\x94\x13`d+lOH\x91\xbf\xb6Ȃ\x96<(\x9b\xa8\x18#G\x00\x06E\x17.\xf0\t_\xb9WuwP\xf1/t\x80d\xac\xb5\x89G8qR\x1dY]\xb9\xbb\xaf\x12\x81\xb08\x19:\xb7x\xa2p\xb8_\"\xfb=g\x90\x85,֍\x1b\xf6np[\x93M\x1b6\xa7\xc7\xe6\xa6;\x9f\xaf\x94\xe1\xff\xe7i\x178\xcd\xcaᥪ\xad\xbdO\x93\xe1\x83\xc7\x03H%\xd8\x10\xe3\x06p\x00!\x00\xa9Ο\xeb\xf5\xd6@\"\x1d7\xf2#5\r\v:\x1b\x8d\x80\xceD\x9aaDl\xe7\x064\xd5\xc2E\x12\x89,2<\xa5xll*\x97\b\xdbf\x84\xf4q\xbdm\x99\aQ\t\x1f\x06\xb8\x90\"\x13\x15\xcc֔\xb1m\x96ق?$X\x04ݭP\x8e\xfa\xf5e\"\x98\xc8u\x17\x9dx\xbb-\xd2\xd4H\x885R\x13\xbcD\xbf\u038b\x84\fv\xc1\xc1\x1a\xc0\xe0\xbd\xed\xd9W\x95-\xb4\x84JG\xddr\xc1\x8b\xbcg\x8fo6\xc1\xbe.\xefY7\xbe\rZe\x97\xdfz\xb0\xb9\x17\x97\x0f\xa6\x10e\xf7\xb2\x0e\x81\xb0`͟\xda-˨\x8f\x10\x9fd?\x16s\x03t\xb8\xfcxP\xfb\xe4\xea\x03U\xe0w\x1e`\xa3\x1f\x93\xb6\xa2\xfc\xacf\xb0\xc2d\x15K?왢\xb5\xec\xf7\xe1\xb07\x1bg˼\x00܈g\x18\xfc\xac\x17\xc7\xc6e\x8c\xb9ˋ\x1d\xb1(9\xae\xa0\xaf@\x15\xbfm\xf6\xaf!\x12\x82\x15#D\xc2}&!\x89\x1a>\vZn\xc4x\xb5\xa9\x89N-P\xe1\xad<\xd7z\xd9K#9\xae\x97\xccۿ\x8a\x18\xc8{\xa4]\r@`:\xaa6\xc5\f+\x82\xeḅϬ\x83ᇩ}5pԭ\x1dnH\\̴s\x8c߫`\xcb\x12\xe2P\x9e\x1f%\x8e\xae\xe1\xc7\\\xe1B\xe1C<$_\x1b\xe6\v\xc3\"\x16\x93\x9d\nM\xcc\x1d G\xfe\xf3\xbc\x17W\x18H{p\x14M2\xb1\x19\xafu\xbc\x84\xe5#\xf0\xb3\x16\x15\x94,v\xa57\xdbj\xe4t\xa1\x16\xdd\xec\xe0DT\x92\xaa_\xf4k\xa3&\xee.AT\xf0[\xbfQJ[ݺ\x13^\x13\xc9D\xe27\x9b\x8bZ\x13\xb8\x9d\x85\xc4\xf6\x9e\aO\xf4\xdd`\x003\xdf~?\xbf\x89\xd1s\x9fH>72\xdf^\xe0\xb0\xe9\r\xba|\x12\"W|\x02\x17\xc6\xf6\x8c\x89N{\xed!<\rn\x87`\x10g2\xed2\xfb-\xe9\xb5\xcfd\x8c\x9a\x8f \xf6u\x8e狇\xe9\xc42\xb2Ӓ\xfb\xc3\xd6&\xb1\xc0^\xb09^\xd7\x02op\x03@\xe6\xbe\xd4p\xe9\xb8\xd2-ک\x9f\x9d,\x00;\xa0,V\xb4\xa4\xf4\x00\u05eb\xe1\x19\xf2{\x1e\xdc\x1a\xc8_\bɂd\x04\x96G\xb3\x8e\x03\x96]g;`\x06B\x111\x13]e\xa1\xfa\x05\n\xec\x8b\xe6~:\xc0n\xf5F:d\xe7A\xa4\xf8\x88V\x8fU\xe4G\xdf\x05gj\xcd.!\x03Cl\xeds\xdaي\xec\xa7\xf2\xaf\xd8G\x9a!\a\x05@\xdd\x00\x9f\xfby\x86\xda\xde\xd8\xe5&\xc1wd\f\xfe\xaf>0m#y-Tm\x92\x16\xf5|\x9e\x89\xe4\xc0\x01\x81\x9b\x1b8\x01\xccՃ\x05\xc9Er\"\xdea/$\x1f\xa1\x8b\x8cl7[\xc7Ֆ\xfe}\xd0\x03\xeb\xce\xf0\xb5\x1e\x01k\x8b\xc0\x11\v\xc9vco\xa1\xae\x81d\xb4\xfa\x89\x10q\xa0l\xb1P\xd6{\xd5{\xf0\xa9ce\x9f\x84\ajAL\x9d\xc5\x10\xa9쎑{\x9d\x9c\xdf\xf1nVX\x10䨶\xcar\xbc\xfb<O}0X3\xa3dqA\xa0$w\x8e\xd3\xdc\xf1j\x16B\xb995\x03\xa8\x8f\x88\x9dzU\v\xe4Ht\xe0\x14N\xb0\x0fA\xb8\xa0\nJ\xf9\xba]\x84\f\xb9\x9a\x1fD6\xae\x12\"\r\xf7e[j\x82\x86\x8f\x8d,\x92\x1c\x8a!\xe0#\xd2\x18Z\a-H\xf1\x92\xe3\v,?I\x1d\x1er\xc5\xebG\x85\x05\xee\x9f\xf5B ϐ\xf6\xa5B\t\xc2^z\xc2Q֖\x85\xf8d\xf3Sl\xb7\xc5\x06\xfe\xadPi`[\xc0\xfc\x0e\xeb\xd8\x04\xcbwSd\xee\xf9\x1aC-\\6\x8evx\x88Ra\xc0\xeebDT\x06\xfc8\xb7ڬi@\x12\a\xfbI\xac\x1e\xe47X\x80\x92\rj\xcc2\xa3]qh\x19Uy\x01\x82,E\x01\x05y\xd1nH\xf0\xf9&[*\\\xc08\xd1X\xbeK\x14S\x91\xc78\xb4\x02\x0e \xa7\x1dG\xc2]\xde\xe2\xe6\xe3\x84\t\x82]?\x1f\x10\xa3Dp\xc6J\xe3\xc8a%\xa2\x1f\xc0:\xcf\vz\xb3O\\鄞\xe1\xfa\x8c\u007fn\x8e\xb3\x107\xb683\xaa\xbf\x00\xf6\x97\xea\x06e\r\r\xa5\xf2\xd5\xcb\xec\nbam\x10\xad\xb35\xbeX\bV\x9d\xab\xb0d\xfe\xc6\xf3\x85\xe1*\x93=\x1aD\xfcj\xba\x91\xab=6)Y|@\x15\x06$:\x88\xbac\xef1?\x96*\b\\\x85\xed7\xceФԲ7\xb7}\x12\xbc\x98\b\xf9[\xbe;\xb9_'\a<\x8dUc{3\x01\xa1\xc4_\xbf8i\xa0\xee\xf0nY\x9f\xa0\xe7;\xd2\xd4\xf3\x83{\v!sT\xa6Q\xfb2BG(\u05ebq\xeb\xac\xf6Pڀ\xb6\xebM\xc590D\x9b\xc97\x9d1\x8bZ\x92\x88\xbc\xcc\x03\xda\xe6\xc6\xe4[P6\xc3\xec\x13\xd6\x02\u007f\xefz\xcd\xf2MrBI\xbe(e\x87A\xcc\x1a^\rf\xbd\x8ez\xb7\x88\x00\xc5\x17\x82I\x0e\x1b\xd4\x06ݤ/\xe6\xbac\xa9\x0f\xa8\xbf\xac\x8c>\x16\x85\x1b^\xb8\xaf\xfc\x80\xf2\xad\xb0KO\xf0'\xd8\x01\x80l\x81t\x8d\xc8\xf9f\x8b\x06a\x96m\x03\xber8*\xab;\xb1\xd5\x13\xf2X\xc1d\x89\xab\x12,\xd1Q\x9eB֒\xea\x8fs\xc7z\x064\xac]1\xacDI\xa0\x14<\xfe\f\xfbI\x84\xc8t|\xfe\xb5\x10L\xaeYmAt@\xe4(p\x88\xfcf%\\\x1d{㈪\xed\x04\x10\xc2\r\x82z\xdda\x84\x02\x17\x8e\xf0\xb7\x1e\th@d7.\xf5R\xa3\xf2>X\x98P//2\x968\xd8k\xe3z\x9c\x82\xb3\x05\x18l\x9d\x80\x19p\r~\x87]\xb4\x944\xf6\x8c]#\xc8e\x90U\xf5z\x9dyb\x83\x91\xe3@\xe7\xe7}NoH)\"\x17g\x87\xd0P\x82\v\x1e\x0e\x14\xc0%\xed\xa0\x86\xb9\x0f\x9c\b\x92\x84ū\x84H-\x17\x9e\x05\xa1\x86k\xc2JݎѯG\x05\b\xd6L\x12\x01\x01\t\x8as\xf3_\x01_\xfb\xcc\xee\xbe\xc3i\x9d\xac\x9dp\xfa\x05\xa7\td^\xa4\xf8\x9d1\xce\r \xf9\x9a\xe2\xafSm8\xce\xfaZ\x92B]k\x13\xdf\xd0>\x1d\xed\x10\x0f\x9a\t\tL\xa2\xf7|\x83%` \x9f^\xaf@\xc8\xf2\xadv\xfblV\x1a\x19\x13\xbcq4C\x95\x80\xb1I\xddI\b\v\xee8\xcev\x82\x97\xc1\xea\xd8\xc3\xf9\xc6K\n氡jm\x12\xad\xd1\bNx\x13\x98i\xeeK\xedPm$a\xc8\xd0of\xcc7\x05[\xde~ \x9f\x02|en\xb2\xaa\xc1P\x94\xa5_(\xbe\xeerO{\x0e\x04'\x14B\xb0\x85\xfbuZ\xaf\xe1\xd1\x1b\xfc\v\x8a\"\xfdo\x90\x8a\xf0\x86\xde\f\xbb֕\xb0\xf6\xef:\x9b\xd8.\x94\xe3\xcbm\xbe_\xc1\xf7d\xfc\x955\n\xbe\x15\xa3\xe1\x8a\x16kB\xd9\xeeؼG\x0e\x9a\t\xc5\xf5\xeb\xd4\x1f\x8b\xec\\\x06\xae5\x87\xeb\x90\xf5Ps\x16{\x82&:\xbcC2'\xcb\xf8\xa2ܝ\x97\f\xac\x1a\xe1\x8a\xd7A\x12\xba\xff\xe46\xbe\xcf\x06\xbe\xadp\xac\xe5s_\xb3\u05fdH\x1aDi\x9a\\\xec\xeb\xe0\x0f\x18G|\xb1\x8a\xa8\x1c\xac \x8b\xfc\xb2\x8f\xf9\x1b\\\x023\xa6\xfd\r6\xe2I\xb7潶\x91\xd3Ś\xb2D\x03\xac\x02t\xb6\xaa}\xb3a\xed\xab\x10\x14鸱%\xe4\x1d\xa6\xfc|\xf2kO\xbe\n\xdf\xfd.G1\x90\x80,\x85S\x1a\xcfɇ=\xe0J\xa7\\\r\xf87\xab\xd6\x18.\x0f+D\x80\x1fs\x86\x9d\xb6\x04\b\xd0\f\x8d\x14{S\x1f\x1c\x12{\r\x88=V\xbc\xfa\x15\xa0\x17~\x06\x87\x03\xc1\x84\xe0(\xa4\xea[\a\xff\xebl\x17\xb6.[\xc8#\xe3\t4\xb4\r \x1a\u007f\xa7T\xc4ۃc\x03\x9d\x9e\x8b\x10\xb7\xb1\x02\x84\x03\x94)\xc6\xe5\xd4\x01\x11^Q\x1d\xe3ˢ\xf5͒\xb1sJ>\xfb@\xc8tK\xce;\xb0\r\xa8X\xbb\xf1\xf1\\\xfb\xa7'\x18ʲ\x90\xd6\x1c\xdcY\xcb\xe5\xac\xc1\x02\x955\x93\x00\x18;\x88\x99\x1d\x97(\xef\xba\x11\xbc\xd8c>\x90e\x1a\xb8u\xee>_\xa1\x13\xae\xed\x1b\x1c\xe6¬@n\xb8\x03\r\xf8M\xfcZ(j\x85T\x1c苁\xe5\"x\x90Q腛\xec\xed\xe2@t m\x94\xeb\x1b\vA5\x87\xb4\x94G`Θ\xe8\x19{u\x03\xd1YCfI6\u007f\xf1\xc1d\xe1']\xf9O6Z\a\x8eZ~\x02L\x11aEi\xb9\br\xaa\xa2\x97}aS\x8b\x92__⊃\x8e\xcd\xe1\xab\xd7A\xe0竳\xaf\xf9t+\xec\xc5.\xb3\x04\xa9^\x19\\\x96\t\x0e\xe9ے\xc3y٢\x00\xee\x02\xf8\xbcb\xb1\xe4dY\v\xdccey!\t\x87\xbb\xff\xc6tʙ\xe9\xe5\x96.\xd1\x14\xb0\xd8\xfc\x18\x8d\xd0Y\xa0`\x0f&\xe1\x84O\x8a\xb5\x9a\x87\xa8\x0f;,&\x16\xa2\xb0[}B\x05\xe8AKsV\xa3㟱\xa2p\x0185 \xf5\xaf\x11h+\x19}\x01|X\xb6\xaaNUs\x01\x9d\xa4\xf1`\ap\x87\xf7\xe4Z\xed\xed\xb2\xf0\x8a\x96I\xd4\xcd\xebq|k\xb7\x00\x9ccY\xac\xae\x83N\xd5~\xb8\xb5\a\x14c\"˒b\xc6\"/.\xe0+\xb0\xb3N\xa8ґm\xeaE\"\xed}`\x91\x9a\xe1\x13\xeej<\xd8\xc7\x14U\xaaJ\x96iS\xc6\x11Y\x98\xb7\xd5/n\x1c\x9dE\xab\xe3\xc9\x01\x88\xa4\xddE_\x92y\xdcݹ\x8ea\xc7\x12\x15x\x99\xa5\xe2\xca\xdav\xaa\xdar\x14l\xa9VďΉ\x13q\x04\xe1\r\xb6H\x15Z\x10:\xb9A\xca5\xe7\xc5\xf2\xc5Z\xb0\xbc\x87`\xee\xeax\xf2g\xa2\x0eH\x80\xc1\xfd\"%1\x81\xd5A2.\x18\x8e\x98æ\x97\xbaC\x8d\xda\x1dj\xc0\x8d\xc1\xe6\xe9Z_K\x04\"%aR\xd5\xcfX\xb5\t\x18\x1c\xbc\xb4e(\xa0\x8a|\xc7@\xdc\xc8\x17Q\x176/\x11.\"&\x89\x18\xa4\xfc\xb1S\u007f\xe5έ\x97q\xf2\x8bA}ĥ\xfb\xe6=\r\xab\x01\x17\x97\xb9\xb0\xa1\xf6\x11q/\xa4q\x9ev\xec\x9d4\xe0\xe3,\xddW\xc7kX\xcd\xfbZ(\x00=\x05\x9e\x01\xc3?\x90\t~WaO+JƙV\a}!\xcd\xd6\x15\x06,\xab\xc4\xc72\x832\xd0J\xfe\xfa\x11,\xe2\xf5\"\xcb\x1dA\xb9ωh\x96\x03\x8b\xf27\xc79\x1aD\xbf\xefb}\x1aYr\x00\xcd\xf6\xdb`A\x8b\xe4\xf5;\x11\"\x1f,\xc1\x00\xd7\xf8J\xb7e~\x8d\x02\xa8}K%\xe0\x89\xa2\xf7\xeb!\xf1\xb1\x19W\xf3\x98\x00\xa4\x13j\tPSq\xdc\t\t\xe3\xe9\x18\x87\a\xf6\xc5\xd7\xceo\x8a:ڀ\xd8\x0e\xe1W\xeb\x12~\xecc\xcf\xd86&f\xbazy\t&+v\xda3\xea\x82 M\xf77\f\v\x05\xb5\xcbiZ\xa4?\xd1\xc1\rH\f\xc5Cr\xe7\x05&\x9d{\x9f\x04\xd1!\xb1I\xae~,+\xf2\x1fO6\xed*\xac\xa7\xa5<\xaf\xf7\xdf.\xcb=\xdfQCgD\xb9\x04p\xd3\xc3\tN\xb2\xb1\xf9\x96\b\x9d\x0e\v\xf62KG\x9e\f\x83\xf5\x90fd\xcbs:\xe0\xc8KkۗG\xa3z\xb6R\xb5\xec!h\x87,\xb16D=vӂ8z\xab&\x02\xbe9Aˋ!\xa9`\xb3\x8cϫ\x9d\x88$\x15\x1b\bI\xce|\xf3\xe2\xf3\x1b\xfe\x83\xd4wpX\n\xa4p\xe4\x99\xfb\xbaM\x9e\x8cw9\x0e\xa8'gr@\x12\x16bٙ\xc2-\xbe\xce\xe4~\xcdL\x8b\xb3\xc0\x93=\xfe\x91<\x9f\xf9\x9d\xb1bM\x10R\x8f濠\xa9\x89R|\r\xa0\x99\x1dث\xa80`\aUF\x94\x00\x9e\a\xeeW9\xce\xf8$\xc809\xf3\xde\x10\x90(\x10\xbcq\x1c@\xe1\xfa\x18\x14K8\x973W(;\xd5ǝV\xcd\xed\xdb\x0e\x82\xa3A\xb1\x17R4\x82]\xa1\xa8\xf9\x92Ӥ\xb0\x8c\x87\ngT\x96\x93\x86\x99O\xb1`\xbb\x1d\x83r\xe2\x06\xe2\xc4B\xcet\xb37N\xf1\xc5X\x12\xe6x8qj\xa5\xd9R\x8d\x87\x03H\x91\xa0}rR\x90t\xc2\xc8\x02EU\xb9$\xd6\xe2d\xf0\x91\\\x8f=\xbc\xa2\x93~\x95:\x81\xa4\xcd\xe1\xfd\xaa!p\x14\xdc\xf1ɿ\x8a<_\xc8Qn\x155\x8a\xe3&ٝ\xd1w\xac(iƔf\xf8\xd5ܦrYqr\x918\x98Ql\xa5\xf3LzX\x96\x19\x1c\xc4e\xd6|7\xae\xeaE\xe2{\x96γ5\x0f\x94X-\x05|E\x99\xdc+\xda^\xeb\x03\xd0_\xf9\xdb\xfeW5\xfaS\xa3AĢ\xe1\xef\xafo\x82\x92\xf9\xc6Up\xb9&\xba-\xc1\xd5'\xc0\x187tc7\fev\xeca\xf4\xabt\xa3\b$\x9a~os\xfeb~\xe3&N\xc3=Ϊ\xf5\xef\b\x0eN\x83\xe5y-AYX\x84\xa6a\x8a;/k\x93v\xc0/[@\xc6\xc6\xd0\xde#\xbdX\xdc~\xb8(\xb2\x84h\x87ݪ\xb3\xbc;$\xcck\x02\x88\x8c\"\x84\x0e\xa0Ȏ\x11\xc7\xfa\x85\x97\x10\xbc\xa6\xed/\xec\xa6\xc0\x98\x9d\f\x04߀i\xcciu\xfaܱ\xa5\nq \xe23,\xce\xf5\xc3\xe4\xa5͟\xd9W\xe2spm\xb0\xc6d\xfb\x89\xfc\xaa}\x9e~O%\x1f\xe2\x89l\xa9E\x91\x1b9>\x9d5u\xf3\x92\xe3I\xac\x9a}\x00\x9c T\xe5\xc0 \xe9/\x82\xd7\v\xa0\xf70*\x0e\xe2\x0eO\x00L\x11Q(i\xa0\x18h\x1c_\xf3\x1a}1ӊ\x84y\xb1\xbb\xb1\x88r\x125DϷ\xeeۊ-\x1bq\xbd\xa0\x12\x12\a\xe1(?y\xa8\x02\xcduc;\x88\x12\x16R\xdc\xc6\xe7\x83\xf7\x83M\xdb\xcfW\x826\xfd*\t\x00\x96\x93Ɩ\xb6;kD\xa0\xdf@\xfe3\x9e\xcf\xf7C\x85\x89p\x84\xdc\xc0fh\x98\x8dT\xd2{\xaa\xa5X5\xb5\x04A\xd8\xcfy{\nz\x0f\xb0\xc6>H\xbf\xd6\xfd\xb3l\xaf\xb5\x1a\x84\x84\x15\x16L\xeaC\xba\x9fo\x022>a\xdas\xc2hyp\x1b5BP\x03\xd3h2$O\xf6K!\xf1~\xb5\x9cQ}5)rY\xc4>\xb0\xeby\xcc5\x01\xc6\xe5\xe6\xda~\xf0\b\xc3k\x83\xe4\xf3\x83q\u007f^\x01\xa1\xcf.\xaf[\u007fv\x10\xec\xec\b\xa6\x8a\b+s$\xa4\x1e^\"\xcc\xf7\x90n\xa1}Z\xa3Z\xe9D\xf1\xa0c\xdejߨ|\x87kF\xc0\xa75)w-<\xdaut\x89ˎXv@\b\xc4\x1b\xc4\xff\aA?3\xeb\xea[\xebS\xa3\x9b\xd0\\\xd4\xfb\x9dS9\xe0\xae\xf8V\x86\x85̻^\b\x17\xb2\x874\x80\x8c!\xadC\xe8\x92w\xb0\r\xe8a\u007f\xca\"&\xf2\x11\x95\x04_\x04\xb6\x98(\x1eȩc\xd3\"\x85\xe10\xbb\xe9{e\x8f\xa3[Ȝ_\xed\x04A\x82\aK\xe8\x06\xab,\xd5\xc9\xf5v\x1b\xdc#@\xf8\xd0\x0f\xce\xef\a\xef\xac\xfd\x05\x97\xb9q=s\xe2\x8ef'A\x11\xf8\x00\xa2\x9d\x10K\x89\\\xa0\x80ŧB#,a|-'\x15[:X\xfcj9\xa3\xba/\xd6\x1anҘ\\\xbc\f\xeb\x83\xd1\x0f+9\xadU\xe0\x84\xbb\xdbV\x82\xf6\x9e\x88\xb1\x91\x15\xd0ի4\x82vU\xa5\xc3\x12\xcfM\xddh)\xdf\x1e\x16\x01\x89\xb6\xc3~,\xecb\xe4\x8eq\xcd|\x823\xbd\x90\x06vdl\fδ\xac\"\x0eT\xe7*\xf8\xa4\x9c\x91\xfd>V@\x03\xc0\xe5\xab; H\xc2hXѯ`\x9c76\xb8\x04a\xa2\xe3\xa5\x1f\x8b\xc9\xeas\xb6\f8,v\xb5\x9d!y\x1d\x82s걳&\x90~\xf14}\x8bv\x0f\xcc\x01\xec\x86\x14\x81\\\x13\xf1\v\xc2o:%\x96m\xd3{\xe7C\xe7\xe7\xc8\x1ez.#\x14:26\xcdo\xd2\t\xcav\a\x00;b\ar9\xcf\xdbl\xff\xd5n\xa89\xdar\xbe1\xeb\x8d\xd1S\x82\xf9jD\v\u007f\xcd'\x12\xca\x18\xf2:\x1a\x84\xd3/\x13+}#\x1cv\xdb\x11TN\xa4\xbc\x8e\xf5\xd5\xdd\xd9\xe4\xdbs=\xd9\x0e\x1e\xack\x85\xe4\xb3FU\x04\xeb\x9e\xc1hb\xdfD\xf9G\xfb\xbe\xc7~1\n\xa8#,\xef\xfedk\xcfN^\xdb\xcfB\x8d\xb1\x14\xe8OB\xa0=q\x10o\a\xbdE\xf6\xba:\xc3V\aJ\xa7_\xf7,\x00\xc90\xa9\xef\x1dAPG\x17b\xc1y\xa3\xee\xceך\xb9\xe1\x10\x94\x12LS\t\xf9\x81A7\xc1Ѽ\u058bQG\x9f\xb9\xc1ec\rq\tqV{r\xba\x81\x11\x17\xca9z\xf8z{W\xd6\x10\x9ey#O\x8b.\r zcP=\xf5\x81+\xd3i\xe0\x85\xaf\xfd&U\xb9G\x13og̉\x1d[\xe4\xd8\xc6\xee\xec+\xd6.\xbc\x0e\x87!'\x8aO\xcb\xd8v\x80\x9a\xc3\aύ\xf3\x84\xc9>\xf3\xae\xa7ͤ\x81\xa3\x13\x8e\x1d>\x98\x00\xcb\xc46\x05K\xf5@En\x18\x1b\xc7\xf9a,\fײ\xc4lQ\v\x8f\xe8D\xf4\v\x06c\xfd\x9e\x83\x15\x058=;\xb6-\x80\xf8\xed\xc0}\xb3A\xf1\xce\xc9\xf6 \xfd\xecr\xbev@\xee\x83idg\x1e+\x04\xaa9r\x87\x8b\xd9V'.tb]\xc5y]\xfc\vׁ\x15c\x81\x9c\xf6\x84|\x10˰\x10\xacp\xf3\x05\x0e\xf9\xa0F\xa6\xe7\xfbФ\x80%\x9a\xc1>\u05cd\xfaB\xf9c\xb6\x9d\xad\x98\xe1\x81\x17\xa1c̸ғ\v=\xdc*y\x89y:\xfc*\x16\x00\x9a\x0ffP\xaaomt\x04\x90A\xc3\x17\xee\xe5֬\u009d\x82R\xe6KU\xd6\xdd\x13B\xfc`D\x98ex\xf47|\xd5\U00035707¤\xddN\x1d}\xa7\x93Hfϒ\xd9\xe4M״\x04\x85\x01[\xf6\x1eO\xf9\xcaH\x05\x9ef=s\x87J\xfd\nv\u007f\xa8e\x01r0\xd2q\x8c\xc7\"\x9b\x9eF\xcdfc\x05O\x8b\x00\xe5ն\x96\x92ǔ<=\xe9\xb4։\x0e!\xbc\x1eOh\x1e\xfcr\x1c\xe4|\xa3\xa7%zZ\x82\x17%O\xc2:\xc8\xc6ߦ\v`v\xd0\x1d\xfb\x03\x8e~\xb5\xf2`\xc5E\xdd\xc1N\x9b6\x9e\xe3C\xfb\xe3x\x89\x12\xabjDNt$\xbf\xd8@\xde8?b!_zqv\x02En\x01ƶ&\x1e\x0f\xc1>\x15\xf5\xf6\x11\x10\xd4\xe8Zփ\x18\xb1\xa6\xfbX\x91y\x90\xbe\xf6\x86<wfW3\xf5+\xa6\x87\x98m81\xeed:n\xc4W\xb6\xf1\xe9-\xecw\xf4\xf4vy_\x0fT\u007fL9n\a\xbf\x81)\xe0-X'|mfa\xfc\x0e\x10\xb7H\xd3\x01P\x9c\xe5\x90\x13\x80\xb9^\x94\xdavǧ\xf3b֕\x16\xe4\x06\xe2x\x1b\xa4\x92&\x82\x04\xfez#B\x03\xc6\x0f\x8aI=z\x0eҲ\xde\xf8\xa4x\xb4\x06\x80`9(\x94\xec\xd1\x02\x03C\x01ؽ/t$p\x90\x91\x87\xce?\xa0\xe7\x87O\x8d`\xfb\x01-\\\xbf.\xc6\x03\b8\xb9\x03\xa6y\x8a\xdc\xc3\a\x83wx`\xb1N\xebm\xac\bF$U\xf4\x90\xe78O\xfa\x04 \xf8\x1ba\x11\x80\x15\x86\xb1\xf5\xec\xf96\xfck\xd2\v\b\xa4\x05\x9f\xde6\xeaH\xf4\x1eS+\xb4\x1c\x87\xb8\xad\xfa\x82C\xbff\xdaT\xe50\xed\xa9\xe7x\x90\a\xbd\xc0\xea9\xf6m\x1d\x1f\xe7Ld\x82\x056-1\xe5\x84\xcd\xf08q\xf8\x0ey\x03g,Q\x16\xe1\xd6\xf0\xf89\x06\xf3\u008fy*\xe6a\x8b\xb8\x1ep\x12\xbd\xbf\xac\xe5\xec\xcf;\x96\\\xbeS\xbe\x1e\x97\xbc\xbf\x13\x16\xfbI\xcet\x00\x166\x05\b\xb3\xdeC\xf2\xfc\x1aB){\xb3\xc4k\x9f l\xf2\xacL\x1b\x95 \xaa\x0em\x8f\x1c}\x06E\xe0R\xb1\xa0Fb\xc5\xf3\x89X\xdd.\xf2y\xf1\x94+?p\xbf\xb3\xd26̜S\xe3ˀy\xb4\xbbG\xaa\x15\xc7\xdf\xd8Z\xf7l5b\x93xqj\xb2\x19\xce\xdcUoXԕ\x9d//\xef\x95m\xdflЙ\xebjsy\x16\xaahHa\xb0\xee\xd3+\x9c8\a\u0378B˰w\xbfy\xf9q\xb6f\xdb\xe0&\xb1\xedT\xee\x19\xaf\xc7\xe0wй~\xcd\xc2m)\xc6\x12\x12\xde\xdb\x19\a\xbe\x8b\xfb\xf2\x8c\x15\x1a\xcaA\x9f\\]{\xe4.\xea\xcdi\xe1\xe3\x19Ir[<z\x8fGt\x9b\x92*x\xa5d\xd6F\xf9\x04\x94\xbf=\x85b,\xa9\xdd\xf0.>\xa9d?\xa4B\xfa9\x83X\x1d\xe4۞m\xbc\x80\xf7~?\x1e\x81Oә\x02k\xf6\xb0\x93\xc3M\x80\r\x10BX!\xfcN\x8e֏\a,\xf5M\x92}^\nIK\"O\xa7u\xfb\xe5a\x12\x94T\xc7[\x83\xfe\r\v\x01\x1c\xa8\xe1\xe1\x18\x95#\xb0_Cx\"\xb7\x89\x8a\x9dw\xd8_KlGg\xdeVV,8^[\xae\x1e\xedZ\xd8\x1e\n\xa8\xb8`\xb9\x014t\x06\x12\x05O\xeaM\a\f\xd0\xdb_-\x19\xa9\xe5\xe9\x12\x9c\x89$\xc4\x164\xc4\xeav\xb6\xb0]\xdf90ؾKw\x10Σ\xba\xfb\x86\x8f\x1e5?\x92\x93`4\xcdo\xae\xb7?\x1eԹ=\x83\x81Y \x03\x03\xf9P|\xc0J\xbc\xc0\x8e\x1c\xbe\xfe\x87\x89a\x9c-{\x02p\xe0\x02\xee\x00od*\x96\xe6l\a\x9d\x90\x94\xf02\xece\x85\xd3\nλ\xee_e\xcd\vc\x15\xec\xb1YA\v\x9f\xd6M\xf3\xb3\xfc\x843\x8a\x91\xcc\xf7PN\x96U\x97\x8bAjY\xf4\xa9\x1eµ\xdceݜ\x85\vW\xffZ˸\xf7\xf6ڻI_\x91\xcf\n;z{@\xb3H\xb5\xc0\xaa/\x8b\x8fB\x12Y\r\x1e\xa1\xaeȆi\xa7>\x18\x98\xb5^\xb8\x00\xa7t\x90:\t\x0e\xb0\x01\xe4)\x04\x0f-s\u007f\b\t\x14\x86\xcdb\xa4\xc0p\x94\r\xf9a-(t\x8bV\xb6\xedQ_\xd3\xc2\xfd\x85S\xf0,;\b\xb221\x11ѕ\x16\xee\xde\xfc\xe5\a\xda\xce.=\x9bqH\u007f\xcf\xfb;\x18\x8af\xb1\xb8\xe5ivR\xf8}\x90\u007f>\x93\x051\xb3=@\xceeo\x1f'\x13\x86\x9c1-^4\xf6\xf3+ӏ\x82\xf3GF\x18\xf4\xd1҅e\"O\vc\xee_d\xf7DDD@hx\xea\x96E\u007f\x15o\x80)\x8c\xe6C\x84\xf0\x8a\xb8\x8c\\=\x89\xcf2\xaa\xa2\xe6;!\x93\xbb\xa8#\xec\xfbp\xd5\xf0\xf4\xf6\xf1\x0e\x1bʮ\xdcU\xdb>\x83!8\xc84\xde\x17\x02\xf3hzqV\xcb\xd3[\x90\x895\x83ChF\xc7\x13B\x0e\x1e\\\xfbp\xe9\x1bÿ\xe0\xdd\xee(\x91\xad\xcd\xc5\xe7(n\xac\xc7/O_c\xdd\x03 \x82\xa9\xb4#\xe7A\xe8o:\x16\x82\"<\xbesȱ䏃\x80\x11\xde\x01ȩ\xc7\xce\xf6\x00\x9e\x8dt\xac\x98\xadzG\x13\xecL\xefu\xde\xe4s\x04b\xd1\xccN\xc24\xe7\x9c\xecU\x1d\xf5\xe0\xb9=\n\xa3+D\xf9\f\t\xeeֺ\x8fǾ\x91\xe7՛\xc7o\xcb/.\x06\x01\x80~Ϛ\x8c\f\x90\xbd@\x12\xb9lk\xdel% \xefv|\x1c\x8a\xfe>}\xa6\x94\xddEq\x12 >}\xe1xR\xc3oԾX\xa3\x00\x10\xa5\xa3\xaf\"\xe73I\x96l\xba\xb9\"\xb4r\r\xa9\x83\xe2\xb8\u007f;\x82\x03\xaaޭq\x05\xd9\x1a\x99\x0f/\xf1\x9c\xd1Ę\xdf\xce\xee\x81Ӟh\xb3\x92ʆ\x01\x85\x88\xb6\xf4\xde\x17\xca\xe34k,\xf5\x90\xe7>\xc9\t\xd4V\x83[E\xf5\xfc\xfcM\xba\xcc\xe17\xb1\xfc\xb7\xa7\x89c\xf3\xa8E\x0e\x92V\x1bDY\x98w\xc3\x1c\u007f\xa4\f\xcc\x02\xe8+\x93r\xf7\xeaH\xa1\xcfa\x99-\\>^\xc3'\xb0\xbd\xe4\xb7ǃ\x8e\xe3\xb7\x19-\x18\xd4\x12\xbcJ%}9o\x1b\x13\xdeo\xeb\xf4_\x809\xd9a\xb38\xe8!\x13$\xb9m\xb7\xd3\xd1!\xa4W\x99\x91\x90\x8a\xb1:z\xec|\x06\x12\x16\x15\x80:\xb9\xd6W\b\xf5\x11lֹn4hSr\x93A\xce\x03\xbe\xdf\xc1{\x84X\xb7\x12@p\xe9%\xe3\xcfQC\x9cC\x8e~\x96b\x1bw\xc4k\xb1\xc76\x9b:Ҭ\x1d[\xfc\xdft=\x16\b\xd7k\x8f\xd2A\x86\x05\xb4Zrr\xe8\xf7;\x98\xd4Q\xb6\xdb\a]\x14\xcfߎ\xab\u007f\x87~\x1d\xbb\xb5\x85\xe9Yu5*\x80\x8bP.\x1e/\xab\x0e\v4G\x13l\xbe\x92\xf2\xc4\xc61\xac\xbeG\x88x\x80\xab\xb2\xa2\x0e1\xbf\xd1q\xc3b\xc7ħG|禑\xf5\x98\x17O\x02\xbe>\xc5\xe1\xb5\tL\xeeG' P/0\t\xa6\x86k\xfe\x0e\xc2\xc2Xs]\xe1v\xca\xe2\x1c\xb4\x1f̢>\aBBq\x8e\x1c\xf52\xb7\xe7e<\x03\xfc~5S\x0f\x9a\xf4\b7寞긓\x99\x82#CDX\xd9#}\x91\xa8No\x99\xb29D)\xd29/\xc76\x88\x00\xfb\x02\xa0\x99gU\xac\xd9>w\xca\x0e\xab\xe7֊ǻ\xef\xdd H\x14\x84\r\xf7{\xe2;u\xec\xcei\x13I\xe1\a\"\x1d\x8d\xf0\x91\x05\xd6f\xb7\xca\x1c\xfe\xb3ߦ\xa3\xaa\x9er\x82ǫ\xa6\xe6\x1b\x15\xad\xc4\xe83\x96\xd2\xdf\xc7\x10\xc0wU\xee.\xca\n\x14i\xf2\xbc^\xae/\v=\x00\x18\xb7\xf1g\xe0\xdb\xc3\xe4\x15\x18G\r\\\x05\x1d\xbb\x93\x9b\xf6E\xf7\x16\x82\xf1\x04\xad\f\x9f\x88\xa1d:Ł/\xbeɣ\xc3\xe8S>Ԥ\x8d@\x94\x13J\x88b\x95\xe5\xe5\x90|\xf3\xd8\xcf\xf6\xd1 \xcbb\xe4[\xb9\x8b\x8e,*H\xdd\xc1\x82\x02\xb5)\xf9(\tG\xc6\xc8RB\xe48\xc3\"\x14b\xa1\xf0\xe9\xe9\xbe\xc0n\xd8\x04\xea\xe9ߚ\xa54\xeaSG\xf5\x9cxM\x9e\xf8R\xc3 4\xc5\xfcp\xd6\xed\x83m\x02\x12\xc3*\xe4N\xdf3\t\xec\xce\x11\x90\xb0Zw\x80l~\x0f\x83\xcc/8\x85<\xf2d\xc1HZT\x92\xc9g\x03\xa9Ӏ|\x1bF\xe1\xe7\f\xd2\xf8Ο\x06\x1f,\x02d\\\xac\xe9@[T\x9f\xe7\xf3\xf0]\\\xa6n\xb7}\x9d,\xc1\u0601\a\xb7i\x9e\xac\xfaF\xd68\x1aj\x82*\x1e-Mg\x9f\x85a!*\xfap\x0f\x1f\U000873d0/\xb2\x82主'z2j;S\x8b-\x88|}_\x11\x9e`\xb9\xa0\x03\xee\xe4\x1b\x1a\x1f\x9e\xd6\xf5\xfc\xda\xed\xd3.\xec+ \x1et\x1d\x18*\x82\xf0\xf1\xd9\u007f\x0eb9\x06\x02\xa8\xf6\x0fU\xc3\xf4\x04\x16\xbe'\x1b\x0e3\xb2\x1fh\x80n\x19\xdaS\xbc\xef\xed\xe1\x8bG\xde\xc1e\xe0\xb8oO\xea\xda*\"\xd6oă\x8fc\x80\xe2u\xd2\x18\xce\xf8u\xdc1\xc0\xf8\xbb\xecc\x04\xea}_H\xc7\xef\xc1\x19\x9el/\x1e\x8c\x06b7\f\xcfMd\xaf\x1b\xc7\x04\x864\x17l\xa6\xd3`\xaau\xf7\aq\xcf\r\xb5;\x04[v\xe4\xdb}\xf1\xbe\x1c\x81\xe5\x8ea\v>@\xc3\n\xa3\aS븝\xb1\x18\xe1\xe6\xd2Mۇ+\u009e;\xeb\x05)@U@\x00ۚN\xc6/\xec˳\xd4H#\x85\xcd\xe3\x80哾S\xa0\x92F\xb0\x16\x8d\xb1\x06\x9f\xa7b\xa9*\x1dPF\xfcS\x9d\xbe\xd7Qy\xa2\xa8\xfbP\xcb˹c\xe9\x0f\x1bѧCm9\x060E\x97\x89\x1d\x80\xf5,ns\xbd\x8f\xb3\xf0\xa0\xf0\xcfC\xca~&\xc2Jz\x16\xd6\xf8\xcd\xf9{֘\xa3\x1c\xa2\x11[퓿|\x9e\a\xfa \xc2\r\xe0\t\"\xa5f\xe9\xf1\xe7\xe9b>\xf6\a\xf1\x836}m\xb2hQ\xf8*B\xeb\\\x16@\xe1\x88\xf5\xf3\xdc\n\xae\x9dߜ\xb6\xb1\x96vo\xd2\x04\x87\xe679\xb3\x02\fM2\xb7žl\t@Y\xdby<\xbc\xc6z/\xb3e\x80937\xf008vÚ\xadjýn\x1b\xf2\xe6<\xdf\xfas3ͪ\x86\xec\xea\xf0\xd1k\x88\xef\xba\xdff\xe7\xf8\xfd\x19\xc5$W=\x05\x83\x9cFض\xef|\xc7\xc8\xce\xc1\xb3Z\x9eN\xe0\xfe\x9c\xa8\xb0\x8eb\x19\xf9}=1\xe4\x81kn\tI}\xaeⴇrY^\x84\xe3|Ύ\x8f\xa0y\xb1\x8f\xa6+,\x82\x84\xb9\xbf\a!z\xa4\x8dMp|$\xb5\x17\xbesn\xb5>\x10\x86C\x83\xc9\x014=\x03\xe4\x8e\x18\xfcf\u0093\xd5\xe2\xe1\xd07\xb4P\bՃ>\xb6\xf9\v\x9f\xb81\xd1\xe3\xc6\xf9\x83\xf6G\xefM\xd0+؛\xa7J|֔~\xdda\x1c\x8c-\xb42qL\xdbSP\xe7\xe7Q]\x0f\x99\x8c\xbc\x1d\b\x81\xf0\xdc\x103\xb2\xa3:\xc0\x8a\xbf\xac\xf1\xfa\x9e\xce0\xb4|l\x0ez\x99\x14\buzn\xe0x~\\\xda\x01U|\x02Lj\xf6$}\x9e\"l\xa9ܜ\x83\xcbJ>\xee\xe0\xccz9y0k\t\xb7gh\x92\x93\x13\xdf\x13\xb0\x1c\f#.\x88F\x1c\xb1\a\x8fl\xd3\x05L\xdd\xf7\x84\x19\x04\x9b\x15U\x04\xb5CE[v'\xb2\x97\xde\x1a\xceI>\xe4\xc9i\x06e\xd80w\xb10\xec\xd9\xd7\tϊ\xe2\xdbӒ\xec\x81\xf3a\x9e\xc2s\xdc#\r\xe5i.\xd7ͭ\xf3\xd5%[(\u007f\x96c\x16l\xfb\xf4\x9c\xe0\xdb\x00D\xec\x14\x91\xe9\t=\x02\xa5XS\xf6\xd8E\xf3\xb1\xb1\x0e?\x17 g\xa1\\\xef\v\x05\xe1\xfa\xb8_N\x83\x11,(\xb9\xed3S\x9a\x87\x116\x9a5\xea\xcb\xda\xf7<\x03\x8f*[\fj\x1a\xd7\xed\xb4\xa1'\xf7\xa0\x82v\xddm\xad\x1b=\x00\xffG\\\x83\xa7\xd7 $9\xb2T\xee$\xe1\xb3\xef\x9dlab\xab\xeeᨚ\xe6:\r\x1b\x80\x9a\xe8\xe1\xa3hj\xba\x1e\x9f\xe7\xa0\xf2\xfc\xfc\x9d\x03\xca>\x86\x05<~\x14U\r\xa5\x88q\xbb-\x90\x9co\xae\xefh\x85\xe50\xfb\xf6\xa8\xf6\xefIz'\xa4\x8b\x1f\xa9\x89샤\xa0Ͻ\xado\x04\x92\x14?bC\xbeG=&ه\xf4\xe3F>\x83\xe0\xb3\b\x13x\xfaXqZ/\xbb\x8a\x83D\x99\x00\x8b[k\x1b\xf5W?\x1dm\x92#X\x97\xe0\xde\xd8N>\xb39\xae\x89M\xf8\x0e\xf5\xc0\x95\xdfi\x85\xf0\xdaC\x93\xa2\x8bg\x8eI\xf1\xe4\xc4L\xb0\x8aP1\xbd\xb7\xa7\xca\xc3ׄ\xd7\x13\x0f\xcf\x15\xa3\xa5!\x02\xf50\xd8\xd9\x16hd48\x92`'r\xc4\v\xed\xc4U{\x8a\xb2\x05\x1b\xef\x1e\xe4\xf71\xa9\xbe\xa4\x01\xb98V\xfc\xf3c\xcd\xc0\xa1\x8f\xf8\r\xc9A\xd3H\xb2\x00\xc2\x13N\x003\xc1t\xa9\x99\x91\xf9A\xd7\xeb\x8d8\x10o'A\aX\xe6W\x85\a\xf4m\xc8\xe2%\xdbw`\xc7\x12\xeag\xbc|\xe2\xc1q\x9c\x11\xe7\x8a\x18\xd5Q{\x9eó\xbd\xc4\xfb\xf3\x83\xaadlPVyJ<\xe1\xf6\xb9\xd2\xf6\xc1R\xb0[\xbeIi\xc3\xf7\xa9\x19A\x8a\xae\xb6-\x16wǊF\x8b\x1c>u\xe8\xb1\xc5\x17\xc6\rsan`\xfe\xae5(͂ 6%y\x02vk\xddl\x19\x03\x0e\x055\xf08\xc92\xecY\xf9\xe0\x9c!c\xdd\xd6\xcdX\x04\f\x84e\a\xe7>J@\xf7\xc1\f\xdf\xec\xcc7\x8d\xe6!,\x1f\xb2L<\x00u\xc1\tn\xb8\xbf\x9a\x14 \x9f\x87N\xb0\x18\xda\xcch\xc5\x1e\a\xe8\xa4$8\xde\xed\xf9\xb4\xea|-;V<b\xb3n\x94$\x82\x14\xcc\xf3\xb0\x92O\x88#J\xe7w\xa4\x87oCa\xf5\x84Z[i\"k\x06\v¦\xd9\xdf\xd4/s\x0f8\x13\xa0(G\v\x1e\x16@q\xc8\xdf\xe1B\xb4\x04\xb2\xe6\xde#:6}\u007f\xd3g\xab\xfd\xf4UЇ{>>\x96\xc6\xc7\a\r\xd3\x1f\x80~H\xaf\xf2=\x0fS$\x98\x88\x0e\x96x|\x85\xcd\xcbc.\xc1\x1c\xb9M~a\x86\xedA\xe4\xa9K\xc5\x1b\x960:6\xbd|\xba\b\xa0\xfe\xaei\bg\xcf:\xb9\xceߘn\xaf\xedһ{\x18\xa8\x00\xe5,\xafO\x98\xd9v\tS\xb5\x8bf\x89\xc7\xd3&\xecŋ\xcdưG\x9f\xf9X|Po'\xf9\x86\xbd\x13>\a1\xbaB\xfbf\x13\xaa\x1d^Ñ\x85\xb2\x98Y\xa5p\\=\n'\xf4b\xe1/\"\x9a\x1c^\xd3\xfaX8*\x84\xd4\xcf\xc3\x04\x8fbT\x92>\xc7٫\xe6\xe96\x0f=\xed\xd4<\xa0p\x8e\x8f\xc5!\x13\x10\x95\xf3\x15V\xda\xf7T\x03\xa2\xf7\x9dϧ\xfe{\xab\x0e:魯\x0fCt\xebKTP\x89*V\xdf\xec\x81,7x\xe0儾\x046:{\xe3\x93\x0e\x87\xe7\x8eD\xb0\xe6\x1c\x12\xe1A\xdc]\x10\xd0\xf0\x00\xb0]\xf5\x87\xa5I*\xba\xbcq\xe7\xe3\xab\xec9\xe7\xbfO\x9f\xf8\xed\xea\xc3\xfd\xe0C\x00U\xa4\xf3\xe1\xa5v6\xd9.\x96\xfbzǍru7\x95\x90\xa4\xbcg\xe0\xc1\xdda\x18yz͡\x01<ܫ\t\xf4\xb0'\x91\xed\x81\x06\x81\x9f\x8f\x8f\n\xad0\x1c\x86\xf7\x94F\xe4\xc5>\x98')\xba\xbfɂ\xf2=/\xc9\xe7\x05\xbdՊ8\xabW\x88\"+\x18\x0e\x10\xae\x8c\xd5\x06\xf3\xbe\x19\x8dEh\x8c\ve䡒\xe28\xcb x\xc6\xfdY\xc6b\x0f#%\xb4\x84&\xb2:\xcb}~\x86\x9c\xb7\f\xe4\xe9^\x1f\x04ս5Ϩ\xef\xab\xfa4\xbfET܊\x1d\xae\xd7ިG\x14\x9d\x82\v:\v\xc0\x9f@\xd7\u0091\xcc\b\x9b\xac.\xb9Ő\b\xee\x18\xd9\x1eؿ\xb0T%Y\xbbL\xdf,\v\xf8\xa1\x10\xf0l\x17\x04\xf4\xd5||\xc6`\xf1i\xc7\xe2$\xf4<~|\x90\x8f\xd0A\xd9\b\xba\x9ea\xbd<}\x18\xaa\xa3Ƕ,\xa1c\x9f^j\xb7\xddsh\xa3xPw\x9a4\x0e\xea>\xf6\x8c\x87\a\x89pi\x1eq\xbf\xa3\x8fi\xfd\x0e\xa3\\\x1ei\xf4yS5\x00(,ݱ<\xa7\xec\xbc\xe7k\xdf\xd2\xc7Dpib\u0080\xab\xc9c\xc4>_(h.\x9fb\rV\x10\xa1\xf9\xf2\x8c4k\x8fl}\x9drx\x8bOIu\x9e\x1d\xf9\xa7\xff\xe8Ň\x9e:\xce\xe2\x93\xe5\x89O\x0f\xddi\x91\x04\xa6\aa\x0f\x86\x01J\xfb\xeaߑ'\xfc4\xc0\x034\x8d\xefy\xaa\xf7\x9c\xf5+\\<\xe9\x9b;]\xee\b{P\xd1&\x98B˅\xfdS\xb6ߩwիslȤ\xd1-2\xf8\x8c\x8a\xfd͈x\x1cg\x8cj\x0fw\xea\x94\xf6\x9co\x0e\xcaE\x98\xba\xef\x80*\xb9\xad\xa6;\x06z<\xc0\xf0=\xfc.\xa9 \x90\x11\xd3Ge\x04\\t\xf6Y`\xd7\u007f\x01v \x17*\x90\\v\xf3\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x03\x10,\xfe\x06\xb3\x00\x00 \x00IDATx\xda\xec\xbdy\x94\xad\xd9Y\xde\xf7\xdb\xc37\x9d\xb9NM\xb7\xaa\xee|\xbbo\xdf\x1en\xcf-\xa95 \xb5\x14!d\x84\x96\x04(`e\x11\v\xb0\x97\x84MHpl04\x0e,{\x0598\xd8\x06G8\x10\xc8\n\x01\xbcX6\x18\x13F!\x90\xd0\xecVϭ\x96\xd4ӝ\xea֭\xb9\xea\x9c:\xc3w\xbei\xef\x9d?\xf6\xb9\xd5\xdd@\x12D\x10H\xb2\xce\xeaZ\xabn\xf7\xad:\xdf\xd9\xcf~\xa7\xe7}\u07b7\x85s\x8e\xaf\xbd\xbe|^\xf2kG\xf05@\xbe\xf6\xfa\x1a _\x03\xe4k\xaf\xbf\xe0K\xf7z\xbd\xaf\xca\x0f\xf6\x9a\xfb_\xc9\xde\xe6U\x19'u7\x1f\xa6\xcdʒ\u007f\xe4\xa9k\xf9\x17\xf3;\x94R\xb4Z\xad\xbf\xd2\xe7\x16_m@ܵ\x12\a3u\xf5c\xf5P|\xf3\xf1n\xb0P\x8f$\xc6R+\x8d\x1bT\xc6}\xe7\xcf|\xbc\xf7\xbb\u007f\xde\xdf\xf5\xdd\xdf\xfd\xdd\xfc\xfc\xcf\xff\xfc_\xad\x85|\xb5\x00\xf1mw\xc5\xdd\xd3s\xc17\x18\xab\xeeIK\xf1\xf7\xa5\x80̓\x8a\xb5\xca\xe1p\xb4\x12\x15\xaft\xc2\xdf\xfa\xef\xdf8\xfb\xc1an\xfe\xd1\xcf}\xb2\xffԗ\xa5\xcb\xfaJ:\xf4V,99\x1b\b\x80F\xe0\xa2nM\xbe&P\xe2M'\xbb\xea\xf5\xc7g\xd5=\xf5HE\xa3\f\x9e\\\xcb9\x18\x1b\xac\x15\x04Z\"\x04\x8c&\x86\xab&\x97\v\xad୳u\xfd\xe6\x1f|\xf3\xecϮ\xed\x97\xef\xff\xdcfv\xed\x89k\xc5\xd7\x00\xf9b^\xc7g4\xf7\x9fJ\x8e\x1d\xed\x04\xef3\xf0m\xa3I\x15\f'EK\b\xd19\xbb\xa8Yj)\xa2@\xd1O\r\xcfoU\x94\x15tj\x9a(T\x18\vin\xd1\xcea-l\xf6Kf\x1aJ\xb7\x13\xf5\xf7N\xcc\x06\u007f\a\xf8\xa3#M\xfd\xe0\xe6\xd0<\xfe\xc4\x17\x17b\xfe\xf3\x04\xe4\a\xdfܽ\xb5\x15\xab\u007f\x1ci\xf1N%\t\x8b\xca\xf1ك\t\xcdHp\xebr\xc4\xf1#\x12\x81\xe0\xf1\x8b%\x97w\r\x0eE3\x96\xe4\x95\xc19\xa8E\x92ن\xa6\xa8\x1c{\xa3\x92\xbct\xec\rK$\x90\x842<5\x17\xbeU+ޠ\xa4\xf8\xfbO\\\xcb\xff\u05ef\x01\xf2g\xbc:\x89\xe4{\xbf\xaes\xc7lC\xff`\xa8ķJA`\x1c\x8cr\xcbAf\xa9G\x92{N\x05\xdczZѨ\xc1\xc6.̷\"\x9a\xb1\x05\x04\x93\x12\x06\x13\xc9uR\xa8\xa8\x1c\x95u,uB\xf2ʲ3(\xd8\x1e\x94\xb4\x13E=V\x9c\x98\r\x13-˟z\xdb-\xf5\v\xbf\xfd\xf9\xf1\x87\xbe\x06\xc8K^\xef{M\xe7\xd8\xd1\x19\xfd\xa3\x9d\x9a\xfa[\x81\x12Z\x00\x93\xd2\x031\xc8,ô\xe4\x81;\x02^q\xb3B)(2\xc1B\x1d\xc2\x15\xc7\xda>\xf4SGi ԂPK\xacs\x94\x95#\x91\x92\xd2X\"-9֍\xd9\x1f\x97\xf4\xc6\x15\xd69\x94\x84n]\x87\xce\xf1so=W\xbb\xf3\xf7\x9eI\x0f\xfe\xb3\x06d\xb9\xady\xed\xe9d\xe1h'x_\xb7&\xff\xe1\\]5\x94\x12\x94\xc61)\x1d\x83\xcc0\xcc-{\x83\x82{oT\xbc\xfa6E^\xc2\xe5k\x8e+\x9b\x86\xd5\x1d\xc7\xeeБ\x95P\x19(\xad\xa32\x10\a\x82f\xac\b\xb4\xa0\xa6\xfc\xf7\x00y\xe5\x98k\x06\xd4B\xcd֠\xa4\x18T\xac̄D\x818ي\xd5\x0f,//?\xf8\xb6\xb7\xbd\x8dS\xa7N\xfd\x95\x9f\xc5_k\x1d\xf2\xae\xbb\x1a\x9df\xac\xbe3\x90\xe2\xdd\xf5P\x9e\xd7JD\x81\x84NMҊ%\x85\x81~f\xd8\x1a\x94\xf4\x86\x05\x02\xc7[\xee\xa8qjE\xf0ȳ\x86K[\x96Q\xee\xc8\n\x0f\x82\xb1\xe0\x9cC\b\xf1\xb2\x0f\xe8pH!\xa8E\x92#\xed\x80P\v\x84\x00)\x04\xfd\u0530uPҌ\x15\xb5H\xb2uP\xe6\xdb\a\xd9\xff\xb4;\xac\xfe\xc7\xdf{\xf2Z\xb1\xb4\xb4\xf4\xd5\x0f\xc8\xdbn\xadG\vM\xfd}\xb5P\xfe\xc0|C\xcd%\x81\xa4\xb2\xde\xe3k\xe5@\bj\x81d\u007fl\xb9\xd6\xcb\xe8\x8d\v\x9c\x03%\x05\xe7\x8fF\x94F\xf0\u0096aR:\xacs\xe0\xffa\xbe)\xe9\xd6%\x93\xd21\xca,\xa3\xcca܋\x1fU\x00\xce\xc1|Kӈ%J\n\x92P\xb2;,\xd9\x1b\x19\x8eφ\xac\xf7J\xa4\xb0\xee\xf2\xfa\xe8\xbf\x16\x9d\x93\xbf\xfc\x91\xcf]\xfa\xea\x06\xe4;\xeek\xdf\x1ak\xf1o\xdb5y\xfb\x89n@ \xfdA\xd7\x128\xb6h\x89B\xc7\xd6\x01|\xfa\U000ceafd\x92q:A+1\xa52$\xd6)\xaaʟ\xb2\x03\xa4\x80\x9b\x8e(^y\x93\xe6\xe4\x92 \x8e@*\xb0V0\xc9`\xa7\x0f\xd7v\xe0\xea\x9ec\xeb\xc02\x9cX&\x85\xa5\x16\n\x9a\x89\"+-˝\x90k\xbd\x02\x01\xd4cE\x9a[z\xa3\xa2o\x1d\xffK\xe9\xf8\xdf^\xf5\xf6\xbf\xbb\xfaC?\xfe\xcfY]]塇\x1e\xfa\xea\x00\xe4\xad7\xd7Yl\xe9w\aJ\xfcl-\x14\x8d\x1b\x17B\"\xed\u07fe\x199\x92\x18\x92\x86c\xb3oy~\x1d\xd6\xf7\vz\xa3\x1c%\xfdCJ!\xb1Nb\xac@\xe0\xb0\x0e\xbau\xc9\x03\xe7\x02\xee\xbdM\xd2h8\x10P\x94\x90\xe5\x82\xc1X`,h\xe9\x18\xa5\x82\xca\xc0s\xd7\x1c\xbb\a\x82\xb4\xb0l\xf6K\x84\x80\xac\xb4t\xea\x9aŖ慭\x9ccݐ\xedA\x89s0\xca*@\x8c\x11<(u\xe3\xa7\u007f\xf5\x91\r\xf7\xfe\xf7\xbf\x9f\a\x1f|\xf0+\x1b\x90\xff\xe2\xa6\x1a\xcbm\xfd\x03\xa1\x92\xef\x9fo(y\xbc\x1b\xa0\x15h)H\x02\xc3 \xb3\xac\xee[\xf6SC\x14A\xa8ac\xbf\xa4(\x1d8\x87\x03\x9c\x934c\xc5\xcd\xcb\x01\xe3\xccr\xb5gx\xf3-!\x8bm\xc1\xfc\x02\xf4S\xc1ڶ`s_PT/~0) \x8e Ԏ\xf1\x04F\x99ws\xc6\xc1ΠdoT\xe2\x10\x9c\x9a\x8f\x19\xe7\x16k!\xaf,R\xc0V/#\xcb+\x92z\xe4\x94\x10\xdf\xf3kO\x8d~v{{\x9b\xf3\xe7ϳ\xbd\xbd\xfd\x95\vȻ\xefm\xbd\xa3\x15\xcb_\xef֔\\\xe9\x04\xc4ZЛT\xec\x8f+\xf6Ɔ\xf9\x198\u007fJ\xb2\xd4\x15\x04Jpu\xc7\xf0\xa9ϕ\xac\xef\x19@\xa0\xa4侓!'\xe6\x02\x9e\xddrl\x0f*\x92@qz\x0e\xa4p\xf43\xcd0s\x8c3Ca\x1cE\xe5\b\xb5\xa4\x99x\x10\xa5\xf0\xc0\xe0\xc08o]\xd3?\x92\xe6\x15\x97vs\x02\xa5X\x99\t\xb9\xb4\x933\xd7Ԥ\x85\xa5?\xaa\xe8\xf5S\xa4V\xd4j\xd1PIn\xfa\xf7O\x8e6>\xf0\x81\x0f\xf0\xbd\xdf\xfb\xbd_\x99i\xefw\xdc\xd7:ݩ\xa9\u007f\x11*!g\x1b\n!\xe0\x85ݜ\xbdqI-\x16\xbc\xea\x16\xc9]g$Q X\xdf7<\xf2|\xceӗ+\xb6\xf7-I\xa8\xd0J\xf0\xd6\xdbb\x96ڊ\xcfm\b6\xfb%\xc3\xccR\x04\xf0\xd8*TƱ4\xa3\xb0\xd6\xdfz%\x05\xed\xc4'\t\xeb\xfb\x05R\n\x8et\x02\x9a\xb1\xc29\x87\x92\x82N쨇0\xcc\x05\xa0Yl9\xd6\xf6K\xa4\x14h)PBPU\x8e8T\xcc\xcf$\x8crä\xa8\x9a\xf5X\xff]\xe0\x1f\xbf\xeeu\xaf\xfb\xcatYﾧ\xb5R\x8f\xe4'\x03ŉ$\x904\"\xc1\u07b8\xa20\x96\x9b\x96\x14w\x9cV̶`\xbd'\xf8\xf8\xe73\xbep\xa5\xc0\xe1\x0fYK\b\x94\xe0\xadw%\xdcs2䱋\x82\x9d\xa1\xe3\xd9͂Qf\x88\x02oM\xce\xc1B;`\xae\xa9\x91B0\x9a\x18\x06\x99\xa7M\x8cu\xe4\xa5%\xaf\x1c\xed\x9ab\xa9\x13\x00\x10i\xd0\x12\xba5\x98\x94\x82́\xe5\xd2n\xc1lC3)\xbc\xbb\xda\x1f\x1b\xb4\x04k,\xfd\xb4b\\X\xda\xf5\xe0\xf3AP\xbf\xed\xff\xf8\xe4\xaa{\xe0\x81\a\xf8\xf4\xa7?\xfd\x97~f_Ҏa\x14\x88\x9f\x02NL\n\xf7#\xbd\xb4\xda\xdd\x18\x944#\xc1\x9bn\n\xb9\xefd@\x9e\v>\xf8\xa8\xe57>\x99\xf2\xccj\xc9\xdb\xef\x8fy˽\x91\u007f0)\x90\x12^y\x8b\xa65g\x19d\x90\x1b\x87\xb1>\xa6\x14\xa5cRX\x8aʱ?\xaa\x18N\f\x83\x89!\n%\xf3\xad\xe00\xa5\x15B\x10\a\x82QfX\xdd\xf3\x99T^\xc1\xa4\x84\xbd\x14Z\xb1\xa3\x9dHfj\x8a\xbc\xb4ā`RX\xb4\xf4>M\b\x815\x96Z()*w\x93\xad\xc6ǣ(\xe2\x96[n\xf9\x92\x9cٗ\f\x90\xb7\x9c\xabw\x81o,\x8d{xR\x99\x81\x94\xcc\x1d\xedh^\u007f6d\xa9\xa5\xb8\xb0\xe5\xf8O\xcfV<qi\xc2\xdeȰ\xd0\x0e\xb9\xfdt\xc8\xca܋\x8f\xb4\xd4V\xd4\x12_;8\a\xd6\t\xa4\xf4\xf5\x84\x9d\xfe;\x00;etk\xa1d81\f3C\xab\xae\xc9\r4\x135\x8dC\x82\xac\xb0\\\xeb\x95H!\xb0\xce\a\xff\x9d\x11,\xb5\x1d\xedD\xa1\xa4\x00|\x16\xe6\xbf\xf7\uf8e4 P\x92P\v\x05\xcc\x02\xdc{\xef\xbd_Y\x808P\xd6\x17ȧ\x9b\x91\xfc\x177\xcci\xee>\x16\x10kX\xed\vV\xf7\r\x97vr\x8a\xca1\xd7\f\xb9y\xb9F\xab\x06/)\xb2\x11\xc2\xfbT!\x04Q\x00\x91\xf6>>\n<Ge\xa7n\xa94\x96^j\xe8\xa5\x15Q\xa8\b\x94\xe4`\\!\xa5b\\8:u\x8d\x10\x10ho){\xc3\x12%\xa1\xb2\xfeK\x00\xf5H\xa2\x95\xe0\xbaN\xcd:\xef\xd0K\xe3Hb\x1fj\x95\x00k\xdd7\x03\xbc\xfb\xdd\xef\xe6\xbd\xef}\xefW\x0e \xaf8\x11s\xaa\x1b\xf4B\xcdl#\x92\xfa\xeec\x9a$p\xec\x8c$W{\x15\xcfo\xe6L\nG\x12*\xce.\xd5\b\x03\x81V\x8eI\xf1\xa2po0\xf1\x9cT\xa0\x1cݚ ւ\x99\xba\xa2\xdbЄZx+\xc1\x1fZ^Z\xb6\xfb\x15\xfb\xa3\x92\xac\xb4\x14\x95%/*\xa4\x84~Z1\xdb\f\xa8\x8cCJ\xc1\uec22\xac\x1c\x95\xf1\xa0\x1ed0[\xf7\a\xae\xa4@Oy4련,RL-\xd3ߖ\xff\xee\xdb\xeel\xdc\xdfj\xb5\xf8\x89\x9f\xf8\t~\xf4G\u007f\xf4\xcb\x1f\x90o\xbf\xbb\xa9\x8fw\x83_oDb\xd1Z\xc7RK\x13j(\x8d`gdxv##+-\xce\xc1\xa9\x85\x04-\x05\x8d\x9a\xa3\xaa\x04\xab[\xc6[\x98\x83I\xe9H3\x87Tp\xa4\rI \x99oz\xca\xfc\xd8\\\x84V\x02)\xc44\x80\xfb\xfa\xa17\xaa\xd8\xe8\x15\x8cs;\xa5\xdf%RJ\xfa㊙\xba\xa6\x9c\x82\xb27\xaa0\x0eJ\x03\xa3܃.\x04(\t\xd6:\x0f\x88u8\xeb\xeb\x16!\xc4u\x9e\xac\x9e\x15\xe6\xb7\xdezC\xf4\x86\xf7}\xcb7\xf1C?\xf4C\xfc\xe2/\xfe\"Z\xff\xe5$\xac_\x92\xb4\xf7\x95'\x93w\x84J\xbcnsX\xa1\xa5\xe0ԜDK\x18d\x82\xab\xfb\x05\x93\u0083\x11\a\x82N\xdd?B\xb7\xe5\xc8rx\xf2b9u\x19\xde:\xfa\x03\xc7܌\xa0ݴ\x84;\x92\xa6\xf0\xd1v\x90\xc1\xa9\xf9\x80qn\x99\xe4\x96q\xe1\x0e\xa9v\x84\xa0\x9aZ\xcd\xf5X \x04\x94\x95C\n\xff\xfd034bI^\xf9\x02\xd5M/\x81\xb7\x02\xcf\x068@i\xdfW\xf1?o)J\x8b\xd6j6\x89\xe5\xefn?\xff\xa9\xef{\xfb\xdd\xc7\u007fჟ\xdbr\x93Ʉ\xf7\xbd\xef}_\x9e\x16\x92\x04\xf2M\xce\xc1(7>0\x87\x82q!\x18\x17\x15[C{\x98kw\x1b!r\x1a4\"\x05\xab[\x86\xde\xd0\xe1\x9c\xc3\x18\x8bu\xb0\xb3\xef0\x95 N`\xa6\xe6\b\x95\xa0\x16\xfa/!%\xf5H\xd3m\x04\xcc7\x15I \x90R\x1e\x16}\x95q\x14\x95w_E\xe9\x18\xe7\x16c +\x1c\xcey\x8br\xce\xc7\x11cA\tGZ\x18\x02-<\xb8\xc6\xd7-n\xfa\xc4\x02\xc8\xf2\x8a\xc1\xa8\xa026\x11B\xfc\x1bU\r\xbf\xbe\xaa*\xde\xf3\x9e\xf7\xf0}\xdf\xf7}_~\x80|\xf7\x1b\xe2Z\x10\xd9\xfb\xaeWċMA\xa0\xfc\xed}~\xdbP\x19\xff\xf1\x040\xd3\xd0Ӡ\xed\xa3\xe83k\xde:\x8cu\x87\xec\xefv\xdf2\x1c@\x1c;\x1a\x89\xa3\x16:\"\r\x8dHҌ$Bz~$\f\x14s̀ٺ \f$Q \xa7\x02\a\xffnnjuye\xc9+KZ\xd8\xc3L\xed\xba\xcbS\x12\x06\x133\u03788L\xb1\xbd\vu\b))\x8d\xa3,\r\x81\x96D\xa1\xd4\xd6\xd8\xefy\xe0\r\xaf'\x8a\"\xbe\xff\xfb\xbf\xff\xcb\v\x10\x01\xdc{:x\xb0ݮ\xee\xb1x\x9f\x1c(A\xac\x1d\xfd\x89ckh\xd0\xd2\x1f\xb6V\x82V\xa2\x0f\xf9\xa6tR\xf1\u0605rz@\x0e\x87\xc0X\xc7\xe7\xd6\v\xfa}\u007f\xa3\xe7f,\x91\xf6Uv\x12@3\x92\xb4cI\xa8!\xd4\x1e\x80z\xac9\xd2\xf2\xa9q\x14H\x92H\xa1\x84\xff3\xee\xc5J\xb82\x8e\xb2:\xcc\b1\x16\x02\x05/\\K\xd9\x1b\x144#\x05L\xeb\x9ei\fQR\x10\xc7\x01\xd6:\xaa\xca\x12G\nc\xed\xed/<\xf5\x90\\[[\xe3\xe4ɓ\xfcʯ\xfcʗ\x0f \xff\xf4]\xf5\x85\x13s\xfa\xbfI3AQ\xfa\x06\x90\xff\x82K\xfb\xee%\xb7\x1ff\x9a\xd1a\u007f\xe2H\xd3\xf0\xd0\xf39\a\xa9\x05\x1cq4U\x8c8\xd8\xe8[^ج\x18\x1e@-t\xd4c\x88\x02\xa8\x87\xfe\xab\x15K:\xb1\xa2\x1eA\x14\xf8\x94x\xb1\xa59\xb7\xa4\b\x95\x0f\xcaR]\x8f#\xe2e\xdcĤ\xf4V\x82\x83\xac\x848\x90\x8cR\xc3`\\qugB-\x90\fF%\xbb\a\x05\xa3I5\x05G\x80\x90\x18k\x91Rb\x8d\xed\x9ei鷾\xf9\xf6\x13\x01\xc0;\xdf\xf9N\xfe\xc9?\xf9'_\x1e\x80\x9c\\\xd0\xf7E\x01ͫ;\x8e\xbcr(\x01\xcd\x18ƅ\xa0\x9f\tb\xed\v8)`\xae\xa1\xa8\x8ce\xb1i\x18LJ>w\xadB\b\x88#M\x12j\x1aID\xa0%ye\xf9\xbdϦ\xec\xf6,E&hE\x8e\xa2\xf2YQ\x1c@mj)\xadHь\x05\xb3\r\b\x02\xc1ʌ\xe2\xfe3\x9an\xddS6I\xe4y\xfc\x97\x8eä\xb99\xac;J뭹U\x93\xac\xed\x15ġ\xe2\xeaN\x86\x14\x02c\xa07(\xe9\r\x8a\xa9E\xf9:h\xfa\xb3홆\xfc\xad\x1b\x8f\xc4\x17\xdfy[\xfd\xf5I\x92\xf0\xe0\x83\x0f\xf2\x9b\xbf\xf9\x9b\u007f\xfd\x80\xe4\xa5;m\x1dD\x91c{\xe4k\x80N\x02;c\x9f\xf5\f\xd2\n\x10\x1c\x9f\x8dhD\x92\x85&T\xa6\xe2\x0f?\x9fa\x9cgh\x1b\x91\xa6>\xfd\x9ai\xd6\xe9ζ\b\x1a-\x1eY\xd7\xe4\xb9\x0f\xbc\xadؑ\x96\x9eZ\xa9\x85P\x8f\xbc\x1bk\x84\n-\x15\x8dP\x10)\x81C\xf1\xfa\xb3\x9a[W4sM\xcdr'\xa0\x95\xa8\xc3置FALݖ\x83\xe5\xf9\x04\xe7\xe0\xf2fF#\x96\x9c\x98\x0f9\xbd譹?\xac\xc8\n\x8b\x90\xe20\x19\x91R\xa0\x94\x10JrTI~\xe7[\xce\xd7\xff\xf6\xbb\xee>\"\xde\xfe\xf6\xb7\xf3\xa9O}\x8a\x1bn\xb8\xe1\xaf\x0f\x904'\xaa\x8c\xcf\xe3/\xec\xe4DJb\x11\x14\x95c0\xca\x10\x02\x8eφ,w\x02\xe6ꎼ,\xf8\xf7\x0f\x8f\x18f\x06-%\xed$$Ԛ0L\x98\x9f_b~n\x81V\xbdA\xab\x99pe\xa4Y=\xf0 \xcc5\x1csu\x9f\x16W\x0e\"\xedhƎ$pD\n\x8c\x95\fsI-\x10\x8cr\xc1\xd1\x19ɫNKV\xba\x9a\x93s\x117\x1c\x89\x89\x021uU>\xaa\v\xbc@B\v_\u007f\x00\\\xdb-y\xf8\xb9\x11\xd6:\xce,ž6\x9a\x18\x04\x8e@+O\xe7\x1b7\x05S \x85\xa8\aZ\xfc\x9c3\xe3\x9f{ǭ\x8d\xda\xfd\xf7\xdf\xcf/\xfc\xc2/|Qg\xa8\xfe\xb2\xc0\xf8\xb6\xbb\x9b]\x89\xfc\xa9v],<\xb7\x06I\xa085\xa7\xc8+ɣ\x97'h)\x98ozVv\xb1)\x18d\x86?xz\f׃e\x18\x82\fh\xb4f9w\xf39n?\u007f;ݙY\xa2 @L]\xc4\xdeD\xb0T7$\x81\xf3]\xc6\xc0\x13\x85\xa5\xf1\xa9i\x12zI\x8f\x9e~\xaa\xac\xe2\x90\xc7\n\x95\xa3[\xf7\x16U\x1aI\x12*\xf2i\xb5\x8e\xf0\t\x00\b\x06\x13\xc3\xfa~\xf12\xa1\xc4`Rq\xeeh\x9d\x83II\x9aY\x92HR\xafi\xf2\xd21\x18\x97l\xa7\x82\xfd\xb1\xa5?\xb1\xdcyZ\x8b8\xe4\xee\xd1\xc4\xdd\xf9\xab?\xf3\xcf~\xed\x1f\xbe\xffߘ\xb2,\xf9\xc4'>\xf1W\x03\xc8\xebo\xa8\xc97\xdeX\xfb\xf6ٺ\xfae\x9c\xbcysO \x9c\xe0D7@KǓk\x05q\xe0Ճݺ\xa4\x15\t.\xee\x16<ta\x02x\xd3W*@\xaa\x84#+'x\xe0\x817r\xff\xab_Ǒ\xa5\xa3\xd4k5\xb0\x8ez\x1c3?ӥV\x9b!w\t\r5\"\x90\x968\xf4l\xad\xb1\x82\xbc\x12\x94Ƨ\xb8ZB=\xf4\xa0\x01\xa4\xa5\xa0\xb4\x82Z8\xa5\xdd\x1bם\x83\xe0 5\x8c2K\xa7\xa6\x90\xd2W\xfd\x177\xb3\x97}Fc\xe1\xe4BD\xa8\x05۽\x92fC#\xa4d\x98V\x94\xa5cT\t\n\xe3\xe5E\a\xa9\xe5\x9d\xf7G\xec\x0f\xdd\xd9q\xea\xae\xfe\xcd\xef\xfd\x91\xc7N\x9e<\xc9O\xff\xf4O\u007f\xe9\x01\xf9\xaf\xeem\xcd,\xb5\xf5\xbfmF\xeaA\xadĬs\xac\x96\xc6\xfd\x02\xb8\xd5\xca\xd8\xdbvF\xc6\xd7\v\xb1b\xa6&\xc8J\xcb\xe3W&\\\xde-\x0f\xb3\x1eG\x80P\x11\xb7\xdev;\xdf\xfc\x8e\xb7s\xee曉\xe3\x04\x1d\x84$\xb5:\x8dF\xc3\x1fTUQ\x8bc\x9cn\x90\xd9\x04\xed&(Q\x11(\x1fS\xea\xa1'\x04K\xeb\x81)\xad'\"\xe3\x00\xea\x11\x84\xca[L\xac\x05\x16A3v\xb4bAa$\xc3\xcc\x12(_\xbb\xc4Z\xb2\xb6\x97\x91\x97/F\xffF]r|>\xc6:\xb8\xb6[P\xaf)B%HӒ\xc2\n\x8c\x93\x1ci\xf9\xe6\xdb\xdeȲؑ\xdcwVsq\xc3\xdd\xf9+\xff\xfa\xc7\u007f\xf6\xbd?\xfc\x13e\xaf\xd7\xe33\x9f\xf9̗\x0e\x90︯=\xdfJ\xd4G#-\xbeNKAe\xddC\xfb\xa9\xf9\xbav3\x12Z\xd8\xf7jI+\t\x05\xadDR\v\xe1\xe2N\xc1\x93\xab\x13ƅ\xafԕ\x92\x18\x17 \xa4\xe6\x96[n\xe5[\xdf\xf9M\x1cY^F\xca\x17ÚT\x8a\xb8\xd6 \x8ab\u007f\xd3\xd31J)\x9c\xaa3rmle\xb0\xb6D\v_\x9ftj\x8ef\x04\xa1\xf2\xc5c\xa4=YX\x19AV\n\xf2R\x92\x96>\x9b\x12\x80A\xb0\xd0\x12\xa4\x85d\xb3_ҮyQ]=V\xac\xeex\xe1u\x10\b\x16:!\xf3\xad\x9047l\xec\x17\b\x01ya(\r8\xbce\rrwXˬ\xeeZ\xfeƽ!\xbb\a\xae\xdd\x1b\xf2\xcc\xdf\xfc{\x0f>u\xee\xdc9>\xf6\xb1\x8f\xb1\xb9\xb9\xf9\x97\x0f\xc8[n\xae\xab\xa5\x96\xfewJ\xf0\xea@\t\x1c\\\xdd\x1d\x99\xd7\u07b8\x94\xfc\x9d\xf9\x9a\xf8\xb9VL\xbbS\x93\xact$Eix\xe8ℍ\x83r*\xdb\x11\x04ZQ\x98\x00!\x15\x8b\xf3]\xde\xf9\xf6od\xf9\xe81\x94\xfaӏ#\x84 Nj$\xb5:QR\xc3ZKU\x968\xa1\x99\xc8\x19\nW'/R\xac\xa9\xd0\xd2\x11\xa8\x173\xafpJ\x18F\xda\xd7,\xb5\xd0a\x8cdw,)\xac\x98\xf6ؙ\xc6\"\xafR\x89\x02I#Vt\x9b\x1a\x8bc\xbe\x13\xd2m\x044\"\xc9\xf6AAoT\x91\x1b\x9f\x00ة\xdb3\x0e&\x95O\x9d\xbd\xda\xdeq\xcbQ\x8dV\x82\xb5\x1dw\xfeW?\xf0\xe3\xbf\xf2=\x0f\xfe\xf3ɷ\u007f\xfb\xb7\xd3l6\xf9\xf0\x87?\xfc\x97\v\xc8ן\xab\xbf'\xd0\xe2\x1fX\xc7\x1f))N\x19\xeb~\xef\xe4l\xe4\x16\xea\xee_\x86\xca\x06\xadXЈ\xe0ɫ\x19\x0f_\xce\xc9Jϰ^Oi\v\xa3\xb0N\x11\x85\x01\xaf\xb8\xf3V\xee\xbc\xf7\x15$I\xf2\xf2fȟdA\x83\x908\xa9Q\xab5\x10R\x91N\xc6H\x04\x95\x88\x19\xbb.\x93\\\x90\xe5\x05\xceyZ\x1e\xa6\x01^\x82\x94\xe0\x10h\x05\x8d\xc81[\xf3-b\xef\xf7\xe5T\x0e\xe49\x9c\xac\xb2$\xa1\aey&d\xa6\x1eЈ\x14Eiyv=\xa5401\x02%<-#\xa6L\x83\xb9\xde[\x99\xa6гu\x89\xb3\x82\xfd\x81\x9b\xb5\x96\xd7\xfc\xd2\a\xfe\xe7\x8f\xdd\xf3\xc6o\xea}\xeb\xbb\xde\xc5{\xdf\xfb^N\x9f>\xcd\xef\xfe\xee\xef\xfe\xff\xef\xa9/6\x14\xff\xe5ݭO*\xc9\x1d\xe3\u009d\x91\x92\x8fukz\xe5\xce\x15USʈQ\xae\xb8\xd67<ue\xc88\xb7(%\xe8\xd4\x03\x96gb\x86i\xc5`b\xd8\x1d\x83\x92\x9a3Ǘx\xedk\xee\xe7\xae\xfb^E\x9c$\x9eU\x9d>\x92\x9b\xfe\xe9:\x15/\x05\x18c\xc09\x8a<㠷\xcb\xd6\xfa\x1a\xe3\xe1\x01\xb62\x94e\xc9\xee\xce\x0e2ߣ\x19U\xd8*\xc7V)\x81\xb6\xa8i\x16\x15jA=\x14\x84\xda\xff>\xe7\xfc\xad\x1ee\x82K\xfb\x96\xbd\x91\x9b\xcaL\x85\x17ML\x1b.y\xe5xvc\xc4\xf6~Nf\x15\xc3B\xd0\f\xc4\xe1\xfd\xb1S\x82r\\\x81\x9d\xf2^\xb7\x1f\v8\xd2\xd6\xec\x0f-y\xe1\xd8\x1c\xab\xb1u\xe2'\x11\xfc\xcb\x1f\xf9W\xff{\xff\x1d\xdf\xfc-lll\xf0\xb1\x8f}\x8c\x1f\xfe\xe1\x1f\xe6\xe2ŋ\u007f1@\xdeq{\xa3sj6\\\xc7\xf1\xe8\xff\xf5\xf4\xe8u\xf7\x9c\xac\xbf\xf9-7\xc5\u007fpˑ\x8aK{\x8a\xa7\xb7,\xcf^\x1dP\x1a\x8b\x92pz^sj\xb1IV\n.ogl\x0f\nƅFk\xc5+\xce\xdfȹ[\xcfs\xcf+_M\x10\x86Ӄ\xb7dy\x06H\x94T^B\xaa\xb5\x8f-\xceQU\x15\xd6\x18\xf2,e<\x1a\xb2\xbd\xbe\xcad<\xc6\x1aK\x9a\x8e\xb9\xb6\xb6FQ\x14\x87@\xe6y\xceno\xc4\xe6\xf6>Q\"i5c:\x89\xe0Ԝ\xe0\xf8\x8c\xa0[\xf7\ak-\\\xdes\xac\xf5\x05J\t&\xa5\xe3\xf2^I\x9a\xdbi\x9bرsP\xe2lI\x9a\xbf\xd8\xde5\x0e\x86\xa5#\xd1>\xd3*\xa75̙y\xcd|SSV^p\xb7\x9b\x85\u05cf\xb0\x0f|\x00\xf8\xd7Go\xba{\xeb?~\xe8#\x8cF#>\xf9\xc9O\xf2\x93?\xf9\x93_|?d\xa1\xa1\xebB\x10[\xe8]\xd8-x\xcf+\x9bgO\xcd\x1avG\x92\x17\xf6\x04\x176Fd\x95\x01\a\xf5HQ\x0f\x03\x8e4\f\xab}M-\x92h\xe9\x1bF\x81\xd6D\x81\xa2\xc8S\x8c)X}\xee\n\x83\xf1\x98\xbd\xed\r\x16\x17g\x19\xf6G\x9c\xbe\xf1,\xdd\xd9YpjJ\xf0\x81s\x16\xeb,A\x18\x11'\x86\xce\xec\x02e~\x95\xd2\x16(\xa5PZ\xc3\x14\x10! \fC\x16\xe7;\xd4k1\x1b\xdb\a\xac\xad\x0f\xe9\xb7b\xae\xec\xfaC=='\xb9\xef\x94b\xb9-9=/h\xc4\xf0\xfc\x8e\xa7cV:\x9a\x87/g\x9c]\xf0\x84\xe2ޠb\x9c\x8b\x97\xdd\xe2\xd2\xfa^\xbf\x02\"\xe9\xa8\xdca#\x8b4w\x87\x96\xf8b\x87\x85\x8e\x80\a\x1d\xfc\xb7k\xcf>\xf6K\xf7\x1eo\xff\x8c\vj\x9f{\xf4\u0086{\xcdk^\xf3\xc5\x032\xc8lY\x8fe\x05,\xbd\xf9\\M\x1e\x9b\xd1\xff Ԏ\xe7v$WvSҼ\xf2f^µԒ\xe8\x9c̓\x827\xdcܠ\x9f\xaaÆQ\x14\x06\b!0&\xe3\xe3\x1f\xf9}\x1e\xf9\xe8G\x99\x8c\x0f<U>\xfd@\xcd\xeeQ\xde\xf8\x8d\xef\xe0\xb6\xdboC\a\xc1\xb4\x83g\xa7\xb2RK\x14'4\x9am\x0e\xa2]\xaa\xb2Dk\x85\xd6\xfaeE\x9d\x94 \xa5\xa2Q\x8bX\x9cmrp0\xe2`?\xa5ю\xd1Zqq\xd7ri\xcfr\xc7Q\xcd\xfdg\x14\vM_ü\xb0+\x98\xad)n^\f)\x8d\xa5\x15K\xee8\x1eqq[\xb0\xb1_\x1c\xf6\xd8C\t\x13\x1c\xc6\t&\x9e\x19B+A=\x94\x94\x95#\x90\x9e\r\xe8\rS\x02\xadH\"\x8d\xf2\x99d\x03\xf8\x1e\xe0o\x8b2\xfd\xe0\xbd\xc7\xdb?\x1ač\xc7\xff\"A=;=\x17~\x97\x14\xdcp\xaa\x1b\xac\x9e]\x94\xefQ\x12>\xbbnY\xdd\x1dc\f\xa49\x8cR\xdf\xe0\xb9\xee:n]\t8ȼ\xf8`RIZ\xf5\x84\xc5\xd96yex\xf8\x8f\xff\b\x84\xe6ر\xb3\x1c]:A\x12\xb7ѺF\x96\x8ex\xfaя\xb3\xbaާ\xd9n\x91\xc4ᴕj\xa7\xa4\xb9\xb7\x98\xb2(\x98\x8cG^\x8f;\x1a\x91\xe7\xf9˲4\x0f\x8cd\x94\xe6\fFct 0\x95o\xf1*\xe5\xd3\xec\xed\xa1e\x90y\x9a\xa5\x1eA;q\f2\xdf[\xd9\x1b\xf9\xb6r#R̷4R\nƥ\xef\xaf\\\xef/N\x8c\xc3MۼKmM#\x92\x9e\x98t\x8e\x8d\x81!+-\x95\xb1dE\x05B\xa0\xd5\xf5^\r\n8\v|\x97\xab\x8aW\u007fQ\x80\x04\x12\xfac\xe3ίD3\x81\x12o\x9c\xad\xab\xbf\xb1\xdc\x11\xaa4\xf0\xf8\x95\x82\x83\xd4\xebq\a\xe9KB\xb2\x83c]ũ\xf9\x80^*\xd9\x1bU\x14V\xd3i֘i7Hs\xc3Lk\x9e\x95\x85e\x1aq\x8d@i_\xa1KM^X\x1c!{\x9bWy\xfa\x91O\xd2\xef\xf5p\xb6\x9a*\xe1}\xbf\xa2*Kz;[\x94ENQ\x14\xfe\xef8\xeb3 !\x0e{\x1a\xc34c\x90N\x90\xca\xd3\xf1A\xa0\t\x03\xcf8\xcbi\v7\xaf|\u007f\xfdXW\x12i\b4\xec\x8d=3\xfd\xc2Nɸ\xb0\xb4\xa6\x1a\xaeٖbR\xc2pb|w\xd2\xfa.c+\x96\x1c\xed\xe8æV\u007f\xec\xd8O\xed\xcbX\xe6\xb22\x94\xa5AIyx!\xa6\xc0\xdc\xf0\xe7\x06D\v\x88$\xcc5\xc2Ha\x8bV\xa2\xbes\xa9\x1d\xa8\x99:\xf4'\xf0\x85\xf5\x8247\x8c3\xdf\xf8\t\x94\xef=\x9c\x98S\x9c\x98K8\xd2Vl\x0f`\xf3\xc0`\x85d\xaeӢQ\x8b\t\x84\xa0\x1eD(\xe4\xcb|sQUL\xf2\x9c\xaa2S\xba[\xb1\xbd\xb9\xc9`p\x80\xb0\x15\xd6Y\xf2Ʉ\xfe\xde\x0e\xe9\xe0\x80\xc9$e\xed\xc2%\x8a\xb2\x80C0`s\xf7\x80\xb5\xad\x1d\x86\xa31\xc3Q\xc1\xfe^I\xff\xa0`8\xccIӂ\xca9\x94\x92h\xad\xb0\x16\xc6\x05\x8cs\xe8\xd4\x04\xed\x04\xb6\x86\x82\xd2\xc2bK\xb1\xd5/\xd8\x19\x14()hF\x8a\x85\xb6\xa60\x8eaj\xd1JЈ$\xc7f\x02\x84\xf0\xa4\xe3Aj\xd9K-\xb9\xb1\u007f\xea<\xads\x14\x95\xc1Y\xff\xfe\xd7\xd9\xe3?w\f9\xd1M\xe4\\#~\xb7\x14\xfc3+\xdcB?5(\xe9\xd3Ǘ\xba&c\xe1\xd8\\\xc8r7\xe2\xd2fʱو(T\xf4'\x82aV\x91U\x02\xad!\x0e5\x81R\x84Zcs\x87\xb1\x16c,\x8f\xbc\xb0\x86\x03^Xߦ\x16\xc5$\x1a\x9a\xa1Bስ\xe6\xda\xea\x06\x9b\xd768\xb2\xb2\xc2\xd2\xf2\x12A\x10\x90\x0e\xfa\x8c\xf7\xf7\xb1\xa6\xf2ŧVLJ\xc3\xe5͔\xd1(È\xca\xf7\xd4'\x86IY\x91U\xc6\x0f\xfa\x94\x0e\x99\x97\xd0/9\xba\x98Ј\x03\xb2\x12>\xbfap8^\u007fVs\xac\x03\x17\xf7|\\\xb8\xf1H̥\x9d\x8c\x87_\x18p\xfeD\x83\x85V\xc8-˱\x9f\xe0\xaa,Q\u0e70\xbcp\xa4\xb9\x17}\x8f*\xfb\xa7SY\xaf\xa6\xc09GV\x94\x94\x95\xa1\x16\x87\x84\xa1\xfa\xf3\x01\xf2\rw\x1e\x9b\x8d\xb5\xf8\xd94\x9d|\x8bs\x0e\xe1\f\xc3l*&\xc3\vЮ\ak%\xa1SWtj\x8a3Gj\xe4V0\xd7\x14\xec\x8e\x1d\xeb}\x8b\xc3\x11G\x01I\x14\xa0\xa773K\v\x8c\xb1<ue\x93\xcf_\xddd\x90\x96\xa4\x85\xc3\xda\xfc\xb0\xc5\x1a*\xc1BCs\xb4\x131[\x0f\xb9ve\x9dk\xab\xeb\xd4\xe2\x88v- \n\xf5\xa1\xc0\xc1\x94\x86\xfe\xa0\xc2\x10PQ\xb0\xbf\x97S\x02\xa3\xb2dR\xfa\xa1\xd0\xeb\nx-=k|m;\xe5\xd4r\x13-\x15\x16\xb8\xd6s\xf4RG\x1c\bƅ\xf3R\xa5Xѭ+\x16;\x01\x17\xb6R\x9a\x89&\t%\xa7\x17C\x9e\xdḑnҟA=\x16ԜD(\xd8\x1c\x1a\xec\xf5Q\xae\xe9\xc4\xd74\xf5\xc2\tAe-\xe3IAe\xd4\xffw\xa5\xfe]o\xba\xe1\xfcѹ\xf8\x0fάį\xed\x0fR\x06\xa3\x8cQZQU\x15Gg4Gڒ8\x80͡\xa4?.\xc9\nG\xac%\xf3\xed\x90V\xa2\x98\xa9\xfb\xff\xfe\x85uCQzK\xe8\xb6j̶[Ԓ\x98\xb0\xdd\xc5$M.\xacn\xf0\xdc\xda\x16[\xbd\x11\xad#\xc7\xf8\x1f~\xe4\a8vl\x997\xbe\xf1u\xfc\xe1\u007fz\x84X\v\x92X#\u0098\xb5\xdd\x11\x9d$d\xb6Ӡݬ\x11\x05\x8a@kf\xe7\x96Ȳ\tii\xe9\xe7\x8aIV\xb0\xb7\xbbϨ(\xd9\xcf*\x86\x85\xe4\xaeW\xbd\x12\x11'\xf4\xf7\xfa\xdcy\xfbM\xdcq\xe7y\xf6\xc6\x05\xd9h\x88\xa9\f\x9df\x88\x98\xd2!ݚ`\xae)\xd9\x19\n\x86\xb9\xd7\xfbv늃\x89a}\xbf\xa4]\xd3\xd4#\xcf\u007f\xf5\xc7\xe6P\x18q}\xcc\xee:c \xa58\xbc\x04\u007f\xaa\xfasLE\x18>\xd6\xfd?Z\xc8\x1bo[\x96\xe7\x8e5\xde3\xd7\n\xfeU\xab\xae\x9aZBQ\x1aFiIY9\nḸSp\xebJ@=\x84Ss\x01\xbdQDo4a\xeb\xa0\"\t3\x16ۚF(y\xf2\x8a\xf31H\v\xf2\xca\x10\x06\x8aZ\x12\xd3n\xb79~\xe3i\n\xe38\xb24O8\xf7\x05\xea\x17\xaeR\xef,q\xfe\xa6\x1b\xe86j\x04\x81\xe6\xe6\xd3\xc7\xc9\xf6\xd6Y\x9am\xb1\xb20Å5I\xa54a\x1c\x13\xd5j\xdc\u007f\xef\xdd$Q\x9d(H\xb8\xbc\xb1Ň\x1fz\x9c\xb8\x13@3a8N\xd9O{\xbc\xfaMo \x0eCj\x8d&\xcbK\x8b\xec\\\xbe\xc4\xf6\xf6\x0ego<\xcd\x1d7\x9f\xe1\xe1\xfe\xb6o6\x19\vJa\x9cc\xfd\xc0qf\xc1\xb7\xa1wǎ́\xa5\x93\b\xda5\u007f\x8fǹ\xc1ة\x88\x0e\xd8\x1fW\xf4\xc6\x06) \t\x04\x9d\x9a\x9a~fhƒ~\xe6\a\x82\xc4K#\xfcuk\xc1W\xf9\u007f\xa6\x85\xbc\xe3\xbe\x13\xb37\xac\xd4\xff\xcf\xf9v\xf0\x8f:u\x15š\xc0Y\xc7sW\xfa\xec\x1f\x14\xfe\x16X(\x8d\xe5\xc6ŘN\xcd7\x85\xe20`oT1\xca\f[\a\x15EU\xb1\u07b3\xb4b\xaf\xadU\x02\x86y\xc1\\g\x86\xd9v\x8b#KG8~l\x85f=f\xb1\xdb\xe2\xd4\xca\x02\v\xdd\x16\xb6\x18\xf3ǟz\x8c\xb3\xe7n\xe2\xe2\xe5U6\xae\xae҈CV\xe6\xda()\xd9\xed\x1d0\xdbN\x98\x9dis\xe4\xc8\x11\xce\xdf|\x96PE\x14\x85c\xaf7d}g\a\x1b)\xc2ZDg\xbe\x83NBN\x9d:\xcd}w\xdf\xc9\x13O>\xcd\xc3\x1f\xff8K\xc7W\xb8\xf5\x96\x9b(\xf2\x9c+/<ϱ\x85\x16s\xdd6Ζ\xfeV\vpNpÂ$-\x05\x1b\x03\xcb\xe772\xce.F\bg\xb8\xb0Up\xa4\x13Ү\x05\xa4\xa5噍\x8cQ\xe6\xd52R\xf8b\xf1\xba(\"\t\xc4!\xcfU\x18\xa6I\xc7a\xe6\xfe\xff\xae\\|\xd7\xfd\xa7N\x1c\x9d\x8f~k\xae\xa5\xcf\xd7\x13I\x1c\xf8nܤ0 \x04\xa5\xb1^^i\x1d{C\xc7\xfaA\xc5L=\xa0\x93X&\xa5\xe4\xfe\x1b\x9b\xbc\xb0\x95\xb1;(\xd8\xecU8Qb\xac\xf0[\x14\x8c\xa1\xb2\x0e!\xbc\x1a\xb0\xc83\xb4p4\x9a\r\xb4V\xccw\x9bt\xdbuZ\x8d\x84\xbdހ\x0f\xffο\xc3D\xb3\xdcw\xff\xfd8Sq\xf9\xd9\xcf1I\x0f\xb8\xed\xc4<\xb3\xed\x06\x9d\x99\x0e7\x9f=C\x1c\x86\x8c\xb3\x92\xa24\xa4YNi\f\xf9AN}\xbeMT\x8b9yr\x89\xdd͋|d\xfd*gO\x9c\xe0\xe8l\x932\xcf\xd8\xdd\xded2\x1apt\xae\x8e\xd2\n\x01\xec\xa5\a\x84\x81\xf7*\xa5q\xa4\x85\xaf3\xfa\x13˕^\x85\xb1\x9e\xde\x0fԋq\xd3X\xc7(\xf7\u008eV8\x95\xa3:/\xc6p\u03a2\xa6}~\x87\xc49CZ\x82\xc5\xdfPQٗY\xcb\xcb\x00y\xe7+N\x9e<:\x17~pe.8ۮ{\xb1\xd8u!Y\u007fX\xb0\xb9\x97c\x8d=Ժ\x1a'xb-g\xae\xa1)\xadd\xb1a\t\x95@\x8a\x98Z\xaci\xd6\n\x9e_Ϲ\xd6+\b\x94\xa0\xb0\x86\xb2\xf2\xc5ZYV\x8cGc\xac)\x11\xce\x12\x871R\t\x8e/\xcf\xd1\xed4\xd8\xd8\xde\xe7\xa6\xd3G\xd9\xeb\x0f\xd9\xeb\x0f\x19\x1c\f\xb9\xedX\va\x1bdi\xca\xfc\xc2\x02\xaf\xb8\xef\x0ef\x1auܤ\xc4N/\x89\xb1\x96\xca\xf8t\x12!\x10EN;\x8e\x98Y\x9a#\xb0\x10\xbe\x00\x00 \x00IDATc\u007f\xaf\xcf\xe6\v\x9f%\x8eB\x9a\xcd:G\xce,1\xdb=G\xa7\u0560,*\xf6\xf7\x0f\xf8\xec\xb3\xd0\xefoa\x9c?\xb4q>-p\x9d#T\x9e\xca\x1f\xa5\x86cs\x11R\xf8х\xebͬV$\xe8\xd6$y\xe5gW\x98\x82j\x9dC\vA;\x12\x04R\x11\a^\x824.\x1cNI\x84\xb5\x87\xd6r\bțϯ,\x9eX\x8c~\xe7\xf8b8\x05CL\x15\xe9\x82Ǟ\x1b\xf0\x87\x0fo3N\xcbÂϛ\xb4\xe3\xc2VI\x18d\xdcw\"b\x94\xf9\x00~\xb2\v\x9dZ\xc0\xeaA@=\x89x\xe8\xd9\x01/l\xe7ġ\xbb..\xa3(K&\x93\t\xfb\xfb=\x92$\xa6V\x8b\x91·F;\xcd\x1aq\x18\xb0\\\x94T\xc60\x18\xa5\\\xb8\xb8\xc6\xea\xd5M\xa2(\xe4\xf4\xe9{YY>\x82\x96\x12\x97e\x9e\x05\xc6\xcbr*c\x0f\xa5=R\n:R\xb1\xb2\xd0eaq\x8eZ\x123\x1c\xa5Da\x80\x92\x12\xe7\x1c\xf5Z\x82V\x92I\xe6\x87y\xc2@M\xc9\xc4i\xbf\xc4\xc20\xf3\xc2\xef\xdbW\"\x9a\x11<?\xf6#՝Z\xc0h:\x97ҭ\xfb\x9f\xebO\xaa?劼$\x15\x94\x104#A\x1d\x81\xb1\x92\x83̰;\xb68)\x10\xd3X\xa2\x01^{nq\xfe\x96\x93\xf5\xdf;\xbe\x10\xddҪ\xf9\x81\xfaZ,\x99m)>\xf8\x99}\xfe\xe8\xe1\x1d\xf6\xf6\x87\x87T\x84\x9c\xca3\xf3\xd2QV\x96'.\x16\xec\f-\xc7\xe6\x14\xcbm\xcd-K\x8a$\xf4\u007fo\xa6\xa19{\xb4\xc6\xe3\x17F\x94\x06n\xb9\xf5<RM\xa8*CYVl\xaco\xd2j5I\x92\xd8\xcf\xf8M\xb9\xa8$\x89\b\x02?;ب%4\xeb\rn\xba\xf14Q\x14\xa1\x94\xc6Z\x81\xab\fY^x\xab\x9d*\f\xad\xf5\xceZ*\x890\x86F\xa3N\xbd\x163\xdbn\xb2\xb8\xd8%I\"pP\x94\x15\xa3ф\xb2\xac0\xc6\"E駫\xca\x02\x81 P\xde\xf5\x94V\xd0\xcf,\xfd\x89\xe1\xc6\xf9\x80\xd28\x8cS\x04JPYG\x1cH\xb6\x06\x15\x91\x16\\\xeb\x95\xd4\x03\xcf\x16\x83\xb7X\xf1':\x1d\x9eѷDZ1_\xd7\f\xf3\x12O\xffy\x8b\x96\xb7\x1e\x9d\xa9\xddy\xa6\xf9\xeb'\x16\xe2\xbb\xdau\x0fF\xab.Y\xeaj>\xf4\xc8\x14\x8c\xde\xc0\xcb;\xa7\x8c\xab\x90\xc2\u007fM\x95\x80\xb5\x04\x96\x174\xa5\x10\\\x19\x18\x06\xb6bvְ\xdc\xf2\xb4\xc4\xd1و@\v\xb2J2?\xd3&N\x1a\x9en\xa8\f\x83\xe1\x88\xf1p\xc4\xe0`H:\x9e`\xac9\xcc\u05f5RS\x8aC\xd3j&\xccu[\xd4k\x91灬\xa5\x18\xa7\x14Y\x8e\xb1\x96tRb\x8cCN\x1bGQ-A\x02EQ\x12G\x91\xb7ʢ\xc4M\xb3\xa2\xaa\xf2Ve*CQ\x94\x8cF\x13\x06Ô\xd1h\xe8\xeb\x88\b\x92\xa9H|\xf3\xa0\xe2\xf8L\xc0bSry7\xc39/\xe26\x16\xb6\xfa\x05Ee\xd9<\xa8\b\xa4\x0f\x04\xc6X\x8c\xf1g\xe5\xe7J8Ti\xfaމ\xc3:\x8b\x10p\xa4\xa1^2\x9d$\x90q\xa8~p\xa6\x11\xbc\xaeU\x93Sm\x92E \xf8\xa3\xc7z\xfc\xfe'\xb7\xe8\x1f\x8c\xbcnI0-\xe4$q\xa8\xfd\xa1 h\xd7\x15\xb7\x1d\x8dh]'\xd3,\\\xdcu\xa0`\xa1k\x99\x89-a 96\x1fa-t\xdb\r*[Q\x19Ce}\x82\x90\xa6\x13\xc6\xe31\xc3ш\xd1p\x8c1\x9e\xfb\xb9\x1e\x17|\xebW\xbe\xa4\xf0\xb3\x14\xa31\xe3~\x9f,˰\xc6Q\x96\xdeU)\xad\x11Zљ\x99!P!\xfd\x83\x01yQRU\x15\x83aJQT\fG)y^\x90\xe7^\x1e:\x1eg\x1c\f\xc7\\Y\xdf\xc0\x9a\xc2\v#B?\x1cti\xb7\"\x0e\x04\v\r\xaf\xff\xfa\xe8\xe7\x87<yy\xc8\xea\ue13c\xb2~\x1c\xa2\xb0\x94\xd3AU\xf0\xe3\r\xb5P\x12*I-\x94\x04\xd2g^\xc69\x86\x99\xa5\xac|\" \x85\x9f\xdcR/a\xa7\xf5\\+9\xbf\xd0\x0e\xa8E\x92\x8f<\xb6ə\x95\x16;\xbd\x9c\xdf\xf8\xf0e\x06#\x1f,=x\x82 P(\xe5\x19Pk\x01gx\xed\x8d\x01\xaf\xba1\xa0\x16\xfa\xf9\x8fG\xd6,k{\x86\xad\x81\xe5\xf6\x13\x02\xa9\f\xf9\x15\xc1b'\xe4\xf9\xf5\x82\xb9N\x1ba\v6\xd7.Ҭ\xd5(ˊ4\x9d\xd0h\xd4\x11\b\x94TH)I\x92\xe4\xf0v\xb9)%\uf723\xc8J\xc6\xfb\x03&\xa31;{\xfb4ku\xac\x12\xdcx\xee$RJ\x86UA\xb2\xde \fc\xf2\xaa\xc4:\xc7AoH\x18\x04\xe0`\xdb9\x94\xd2\u07bd\x19\xcb`\x98\xb2\xd7\x1b\xb0\xdf\x1f\xb0\xb5\xbd\x89\x96^\xe3[\x8f$\xfd\xd4P\x8f\xe0\xf8\x8c\xe0\xea\xbe\xe17\x1f\x1d\x1d\x92\x84\xb5ȟ\x81V\x82\xdeؼ,f\x84ZL{?\x82q\xe1y.\x048+\xe8O\f\xc6\xc1\xf1\x19\xc54Ѣ\x11\t\x0e\xb2鮗#3\xfa\x9d\xed\x86\"\n\x05\xeb;)[\xfb\x19s31×\x80\xe1\xd5\x17r:\xa7\xa7PJ\xa2\x94\xe3\xc4l\xc0\xb9\xa5\x90\xad\x91:L\aO\xcfj\x1c\x82g7\fw\x9c\x82\x13\xcb\xd0;p쎂\xebU\x10\xf5Z\x9dq\x96OSFC\x96\xe5L&\x19BJ\xc6\xe31Ry1s\x18\x84\x84Q\x80\xb5>F\xe4Y\xc9ho@\x95\x17\fGc\x96\xe6扂\x900\xa93\xbf\xd0\x05\xe0\xb5\xf7\xdf\xc1c\x17.x\xf5{\\c\x92\xf6I'\x13\x86\x831JJ\x8a\xa2:\x8cWYV\xb2\xb7\u007f\xc0(\x9d0\x1c\xf4\beI\x18Kډd\xe3\xc0P\x8b\x1c\x81\x13|\xf0\xf39\x17\xb72\xe6j\x82z\xa8\xe9\x8f+\x1c\xeap\xfc\xed\xfa\x8c\xfc\x8b\nN{X0&\xa1@N\xdd\xd50\xb7\xe4\xbez`TX\x1a\x91\xc2!\xa8\x05\x82\x83\xa9\x14L߸\x92\xf8Y\xba\xd21ۉy\xfa\x85}\xea5=Mm_\xec)()\t\x03\x85s\xbe.i$\x8af\xcds1\xc5t\xc4xcP\x91\x04\x82z$\xd9\xdc\x17|\xee\xaa\xe3Ug\x05\xad\xba\xa3\x16Jf\x9b\x8aO<\xfe\f\xb7\x9cZb\x98\xa6\x94\xc6 \x85`2\x99P\x95%UYQH\xc9x\x94R\x95\x15UTQ\x99\x10\xa5\x03\xaa\xc20ꍨ\x8a\x92\xc1`H\xa7\xde \x89\"\xacu\xd4[M\xf4T\xae87\xd3\xe6\xa6S\xc7ynu\x9d(\x8a \x880ƒN2\xa4\x944[>\xaeH\xa9\x18\x8d'\f\xc6)\xe3\xf1\b[\x0ei\x06%\x83\xcaqy\xb7\u008a\x80\xbd\xccR\xecL\xd8<(I\v8\xb2\x1c\xd1m\x04\xf4Ӓ\xf5~yh\xc1\x91\x16\x87\x93YJ\xfa\x82\xb00\x8ef,\xa7\x13\xbf>\xd3\xeaM\xfe\f\xd6\xd7z\xa5̡˪G\xdaSŹ\xf5]\xaf\xdc\xd0;\xc8_\xdar\x9cJw< y\x01\x93\xccҬK&N\xa254\xa4cTH\xa4\x80qeɦ|NQ\x1e\xc6*\x1cP\x8b%\u007f\xf0\xb1\x87\xf9\xc2\v\xf3$2\xe5Hw\xcc\xfcL\x8br\x9a\x02Gq\x84\xb3\x9a\xb2(`\xeas+\xe3P\xa2$O\v\xaa\xd20\x9cL\xe8\x1d\x1c0I3\xa4R\fGc\x16\xb2\x9c;Ο\a!\xb8\xb8\xbaƳO?\xc98/\x11\xb3\x8bT\b\x8c5\x18c\xc8\xf2\x1c\x06\x10\x04\x01e\xe5\xd3\xe94\xcf\x18\xa7)\x97\xd6\xf6\x18\x8eJ\xb4\xd2X\x19 T\x8c\xac*\xb6\xfa\a\x1cd^\xbb\xfb\xd4z\xc1\xabN)\xe6\x9b!I\xa0\xc8\n\x83\x80\xa9\x80\xbbB\nA\xa8\xfd彾\xceÃ\x03\xbd\xd4p\xbd\x06\xd4\x12\xba\xd3٘\xf2O`\xa4+\x03\a#\xcb#\xcf\xec\xf0\xd0S[\x18cy\xf6\xd2\xcb7\xdc\x05\x81\aC+\x89і2\x83\xfd\x03C-\x12\\سܱ\xec+\xc8\xd2\x04\xa4\x95\xef\xe6\xb5\xebp\xfb\tG\x9aAo\xe0\xa7Z\x8bҫF\xd6ַIB\xc9L\xab\xc7ͧ\x96\x01\x18\x8dSj\xb5\x1aA\x18\"\xacE\x19\x8b\x95\x96\x92\x8a\xbc\xb48\xe3H\xcb\x02\xa7\x14\v+\xcb<\xf6\xd8c|\xfcSO0;\xd3\xe4̩\xe3l\xee챳\xd7\xe3\xc9ǟ`o\x90\x12G\x9a2O\xc9\x04D\xaeI-I(\x8a\xcag?\xb2\xa02\xbe\x16\xf2*\x96\x02\xad\x02Z\xcd\x0e\x88\x80\xa2\x9aN\xe7\x169\xbd\x89\xa7C\x040*\x1c\x9f\xbe\x98r\xff\xe9\x1a\x9d\x9a&\xcd\ry\xe59\xab\x95v\xc5վ!,}\xdb6\xd2~\xd3D>\xddl\xd7mhƽ\n%a\xa1\xa1(\xadCY\u007f\xe9\xcbi\bJT\x80z\xd3\xed\v?\xf6\xfc\xb5\x9cO=qmZ`\xbd\x98\x85\xbd4\x85\xae\xd7B_'\\\xa7\f\x1c\x8c&\x96~\x06\xa5\xf3\x81o\xb1\xe1\ah\xea\xa1\xe4\x9e\x1b\x1c\x8d\x18\x9e\xbb\"Yݓ\\\xde)x\xea\xe2\xe8\xb0hr8Ɠ\x82\xfbo;\x89\xd2\x1eP)\x15a\x18\xa2\x95:\\Jf\x8d\xc3 \xa6\x8d\xa4\x80\xb8V\xa3=;\xcb\xc2\xf2QdP\xe3\xd9\xe7\xd7i\xc4%;\xbb\xbb\\\xbbr\x95k\xbb\x03\xb6{#\xf2\xd2aQ\b\xebPB2\xd3i\x1d\xaa\"+\xe3\x85\x12\xd6x\xd6\xda\x18\xe3\x19^;MV\xa6\x00l\x1f\x8c\xe9\x8d3\x9f\xd0(\xdft\xcb*\xd8\x1c\x94\xbeM\x1b+ƹ_\x903\xdb\bXl\xea\xe9\xfc\xa3d\xa6\xa6\xe9\xd45\xf5PR\x8b$\x97v+/\x9chk\xb2\xca\x1d\xd2.Z\t\xb6G\x86Z\x92Нi\xa3n?\xde\xfd\xb1\xb5\x9d\x8c\x8d\xed\xfe\xcbڌ/\x05\xc4\x18G\xad\x16\xbc\xc8\xf7+A\xa0=8a 駖\v\xbb^Z9א4\"\x18\x8e\x05\x177\x14\xab{\x82\x17\xb6s>\xf1t\x9fZ]qb\xb9\xc6L+$\xcd\f\xe9\xc4\xf0\xaa[O\x92\xc4\x1a\xa5\x94W\x93\x04\x9a0\x8c\xa6\xef?\x1d\xb8\x14\x12!$:\xf4z_\x15\x04\xd4\xebuΜ>Ι\xb3\xa7\xb8x\xe9*\x83\xde.\xc3\xd1\xc8\x17uAB\x12\x86H\xe1\xf5_q\x1c\x12\x85\x01A\x18\x1c\xae\xfc\xb3\xc6PU\x06SU\xbe\x17?)\x98L\xd3\xe0\xeb5å\xed}*\xeb\a\x8c\x94\x10\xcc\xd6$R\x8a\xe9\xeaA\xcbm\xc7\x02\xea\xb1\xe4\vk)JJꑚ\xee`\xf1\xc3A\x95q$\x81d\x9cY\xbau\xc9\\C\x93\x96v\x9a\x02K\x9a\x89b\x94\x19\x06\x05\xac,t8}4A_\xdaHY\xdf\x1a\xa2\xb5\xa6*\xfd\x96N\xa6y\xbf\xf1\xd7\x05!\x04y\x0e\x81v(\xc5\xe1\x1c\xe0l[\xa04乧,\x1e\xbeT\xf1\xd95\xc1b;\xa0Yӌ2Å\x8d1k[\x05\xf3\xb3\x01\xcb\v\t\xd686v3?\xb4o\x1d;\xfd\x94N\xd3\xefϰƐ\xa6)q\x92\x10\x89\b\xe7*t\xa8\x11B\"\x95&\x8cb\x10/\n\x17\x94V\x1c?\xb6B\x944ؾ\xb6F\x18F\xc4I@\xd5\xcbA)T\xa0\xd1Z\x13\xc5!\xa5\xa9\xa8\xca\x121\xd5w\x19c<\xf1\xa7\x14ٔ\xa2q\xee\xc5\xeeޤ(\x18e\xe5Kƥ\x1d\x81\x96t\x03\xd8\x1dU\xd4#ǹ\x93\x8e<\x17\xbc\xb0\xa1ytu\xc2bK3\xd7Ї\x8a\xf9\xca\xc2pd\x88\xb5\x17\xdbMJ\xbf>j\x94[Nt}6\x1a4g8\xb7\x00\xe7N4\xe965z}{HQV4\x9bɴ04\xd3t\xd7?\xb8_\xa3*\xb1֑\x15~6BL\xa5\x93\xfd\xa1e\xae㕀\xa6rh\xe1I\xb5\xa7.\x15\x8c3?q\xeb\xac#\f%\x8b\xb3\xb1\x97\xee\xa7%\xbb=_U\x03\\\xd9\xeas\xe3\xca\xdca\xadS\xe4\x05\x93tB\xa0\x83)E\xe3\x9f%\fc\x9c\xb38\xeb7\xf4\b\xe1i\x95kk;\x1c?z\x82\x95\xb99n8y\x8a\xe1h\xcc\u007f\xf8\xed\x0fa\xa4\xf0{\xae\x92\x808\n\x0e?\x9b\x9c^\xb2\xeb|\x97\xb1^pPMI\xd3\xeb\n\xaa\xbd\xd1\xe4\xc5\t\\\xfc\x8c\xfb\xea~\xc9\\Cш$y\x0ey\xe9\x98\xef\xf8f\x9cu\x05\xeb\a\x86\xb5\xbe\xf1\xa3\xd4\xd3)d)\xfc\x1eb-!-\x1d\x81\x14\x1c\xebh\xc0\x0f\u009e<\xa1\xb9\xf1h\x9d\xb9\xf6\xb4\x83\xda\xe9\x86h\x15\xbdL\xcb\xf4R\xb7u\xfd\xc1\x85\x10\x14\x85\xa1\xdfϱ\x95\u007fӢ\x12\x1c\x8c\x1dq\xe8\xe5\xfe\xce\t\xa2HRK\x14\xc3q\xc5\xfeA\x89֒N+ \b$\aÒՍ\xec\x10\f\x80G\x9f_\xe5\xf5\xe7O{\xffn\x1dNZ\xf2,\xa3L\x12\x82@c\xadA\vO\xd5x\xf7\xe2\x18\x0e{<\xfa\xd83<\xf2\xa9\xcf0\xdc\xddB\a!\xf7\xdew\x1f\x1fz\xe6\x0fټv\x05\xac\xaf\xd8u\xa3\x8d\x92\x01\x951\xde%Z\x87\x13\xee\x10\x10c\x1dyQ\x91\xe69\xc5t$W\xe0\xadg\xb3?|y\xb1\x17Hf\xeb\nk-\x8d\xd8+*/o\x1b\x8e\xcf+f\xdb>o\xad\aP9AV\xb9\xeb\x1dZ\xc2\xe9\n\xa9P\vf\xeb\x1a-\xfd\xf6\x88~.\xb8\xe9\xf4\fgV\xea\xccu\xc2\x17c\xca\xdf\xfa\xfaE\x06i\xc5\xc6~\xc9\v\xeb\x13F\u007f\"W\x16\xd3\xd5\x15B@\x92h\x92D\x93e\x15\xfd~\xcedTb\xad\xafJ\xad\x10\xd4\x129\x05\x11\xfaÒZ\xac0\xc6Ѩi\x02%\xd8\xda\xf7`\\\x8fUB\xc0\xeeޘ\xa7\xaflq\xd7\r\xcb\b+\xc1BQ\x14L\xd2\x14Q\xab!e\x85\v-e\x91c\xaa\x8a\xe7^\xb8\xca\xef\xff\xc6\xef3\x1a\x1cPT\x16!$\x82\x92'\x1e\xfe4J\xb8\xe9\"\x00K`$E1\xc1\x8cc\u0099YL\xe2?\x84\x12b\xba%\u0090\xe5^`\x90\xe5%֘C\xa0\xf6\x86c\xc6y\xf5\xa2\xd8N\xc0l]q\x90N\xe3Mf9:\x13pu\xdb\xc1\xad0ߑh嗰ݲ\x10\x12\x87\x9aO<?B\t\xc1\xb1n@\xac8\x1c\x06J\vǸ\x82[o\x9c\xe1\xcc\xd1\x06\xb3\xad\x90\xeb\xa2\xff\xb2r\xe8\x1b\x96\x93C먌c\u007fX\xb0\xba\x9d\xf3\xb9+)\xd7\xf6\x8aC0\xae\xf3\x95\x0e\x88c͑#\x9a^?gwg\x82u>\x8e8\xe7\x88C\xc7^/\xc7:A=Ql\xed\x16D\x81\x1f\xb8\x1f\x8f\xed\xb4\x9b\xf6\xb2\xce%\xbf\xf5ɧ8\xb68\xc3\xfcL\v\xac\xa5\xaa*\x8ab*\x1a\x90\n]\x16T\xc6p\xf5\xea\x06\xbf\xfeK\xbf\xc6hR\xb2\xb8\xb8\x04\x16>\xff\xfc\x05\xafb\f\xbd\x05T\xc6RTP\x8f%\xb3͐Vb\xd8\xe9\xa7ظ\xce\xc9SK~\xb3\xa9u\x94\xd3`\xee\x9c\xf3D\xa3\xb5\xd3%\x9a\x15\x97v\a\x87\xe2\x8d\x1b\x96jl\xefg\x8csK\xac \xcd\f:Ҥ\xb9em\u05cb\x02#\xed\xc5\x10>ŕ4\"I\xa8\xbc\xa5L\x8c\x00g\t\xa6ۇ\x9c\xd2\xdc}v\x86\xd3G\xebt\x1a\xc1\xb4p\xf4\xf5\xd6B'@?\xfà\x93K\t\v3!\x8dXшcVfcn;\xd9\xe0\xf2ք\xe7\xafMx~=?t3\xd5t\xe5\x05\xc0L'BJ\xc1h\x9c\xd3H|\x06\x96\x15~\x0e=\xcf\r\x1a/\xb7QJ\xd0\x1f\x96~\xef\xe1t\x05R\x18JZ\r\xc5^\xafb\x94\x16|\xe4\xb1\x17\xf8\xd6\a\xee\xf4\xc2g\xe7\xc8\xf3\x02\xa9\x14EQ U\xc6\xc1p\xc4\u007f\xfc\xed?\xe6\xe4-\xe781\xd3A\xe6\x96\xee\xec\x1c\xdf\xfa\x967\xf1\xf8\xb3\x97\xe8\xa7\x13\x92Z\x9d8\n)\xab\x92\x8f=\xf20k\xfd=\xf4\xb8\xc4\x1aKw1\xa0\xaa\x8c\x8f\x91\xceW\x16\xce9&YA\x99g>\xd0[\xcb\xc5\xdd\xfe\xa1u4\x13ū\xce\xcd\xf0\xf8\xf3=V\xb7&\xe4\x95 \b\xe5\xe1\f\xe3 \xb5\xec\xf4\xfd\xd6S!<\xdb\xe1/\x85#\xab\xfcn\xaaD\v\xf4\xf5\x8b\x9c\x84\xdcqs\x97\xb3Ǜ\x87B\xbe\xca:\xaa\x12\x96\xba!\x8dD\xa1\x9f[Myn5\xf5\xfb\xcf\xdb\x01\xc7\x17c\x8e.\xc4\xcc4\x03\uefa1ɝg\x9a\xec\rJ~\xf9C[\x14\x95\xa5R^\xedw}\xb5Q\xbb\x15\"\x80\xad\xdd\t\xb5X0ۖ\x04\xa1b0(\xf0\xcb\x10|\xaa\x98\x97\x86 \xf0\xf4~j\rE\xe1\xa8'\x9a\xc1\xd0PU\xf0\xc8\x17.s\xe6\xe8<\xf7ܼ\x82\x9b\xf6+tY\xa2d\x8e1\x82I:\xe1\x1b\x1e\xb8\x87\xf9\xee,ピ\xed+[\xec\xef\xef\xd1\xdb\xdfc\xa1\x15\xb3\xb8tdju\x96\x83ј \f\x89j1\x8dF\x1d\xa5%sݶg\x97\xa7\x81\xd6:G\x9a\x15d\x93\x147\xa5\xfc\xb7\x86\x136\x0f&/\xe3\xa4\xf6\a\x05Ea\xa8\x9c\xdf\xcb%\xac!\xd6\xd3e5\xc0\xb5]\xc7\xc1دq2\xce\x1f\xb0W\xfe\vZ\xb1DO[zNi\xee\xbfk\x81\x1b\x8fՙ\xe4\uec04\b\x94`\xb6\xa5\xa9Eʻ\xacb\xba1\xc7X\xd8\xda+\xd8\xda+x\xf4\x99!Ks!\xe7N\xd49\xb5\x9cP\x8f$iZ\xb2\xb6\x9dQ\xafkV\x16kt\x9b\x8aK[9e\xe5h4\x02\xaaʲ\xb7\x97Ѩy\x9ek\xc3\b\xc2P\"'nZ\xf4\xf9\xba\xa5\x96(Ʃ9\xdc\x04\xb70\x17\xb2\xb1U\xe0\xac\xe37?\xfa8s\x9d\x1a'\x97:\x80#\xcbr\x9c\x05\x1d\x80V\x8a\xb0^\xc7XKc\xa6\x815\x96\xde\xc6>\xc3\xe1\x98\xf1p\x84\x1bg\x18\x14\x83IʣO?EQ\xe5DQ4\x1d\xaa\x11\x14\xa5\xc1\xba\xec\xc5Mq֑M2p\xbe/\xb2?ι\xb0u\xe0\xc7\x1f\x94`\xb9\x1b\xb2\xb6\x93\xf3\xf1\xcf\xeez\xf7SzUH=V\x84\xd3\xfe\xbbs\xb0\xba\x05\x955~\v\x11/\xba\xfez h\x84\x82Ieɍ\xe0m_\xb7\xc0\xf9\xd3M\xf6Gf\xbaVj:\xbf8\xfd?4\x94\x95\xe3Ï\xf7P\xdd8\xfe1\xf0C\xfb/ͳz\x83\x8aK\xd72fZ\x01\xddV\xc8G\x9f\xd8g\x90\x1a\xf2ܒ\x97\x96{\xce6\xe9\x8d*&\x85\xd7ц\x91B*A\xaf_\xd1m+\xb4\x16\x84\xd2Q:A\xb7\x1db\x1d\xec\xf7K\xe2DR\x96\x96\xca@QZ\x8e-\xd6\xe8\x8fJ\xbfr\xc3X\xae\xed\x1ep\xc3\xd1yjq8̈́\xac\xdfy\xa5\xf4\xb49\xe6\t\xbb\xb8\x16Q`\xf9\x0f\u007f\xfci\x9e\xbe\xba\xc6\x17.^\xe4\xf9+\x97X]_\xc3XC\x14\x87\xd4\xea\tQ\x14\x12'\x11\b\xb0\xa6\xc2T\x15UQR\x95%LE\xdb\xfb\xa3\x8cg\xb7\x06~;\x03pd&\xe4\xebn\x9b\xe3\xe2V\xca|3\xc0YǨ\xb0ā\xa2\x11*\xae\x8fA\x06J0\xdf\n\xd8\x1fU\xac\xf7\xfdA\x1f\x9b\t\x88\xb5d\u007fT0)\f\x83I\xc5\x03\xafX䁻\xe6\xe8\x8f\xed\xb4\xe3:\xa5\xf0cE\xab\xee\x99\xf2\x0f~f\x8fg.\x8dQ+\xad\xe4\xc7F\x99c\x90\x9aC\"\x90\xe9\xa8V^:\xb6\xf6s:MM\x18J\x9e\xb9\x92\xa2\x95 \b$\xc3\xcc\xf1\xda[[\f'\x15\xa3\x89ߺ\x16E^ϔe\x86$\x12\x94\x0e\xe2H\x11\x06\x8a(Tl\xef\xe7\x14\x85#\n$y\xe9\x95\x1b\xf3]\xbf\xf4r8\xf6~{0\x9a0\xceK\x8e/v\x89\x025\r\xbc\x06)5R)\x9c\xb5\x94\xd6P:\x8b\x8e57\x9f;\xc9\xed7\x9f!\x88cvv\xfb\x98\xaaB\a\x9a(\nIj\x11A\xa0\xbd\xa0\xd9Z\x9c\xf1\xab4\x9c\x9d\xaa\xe7\x9dcw4\xe1\xf9\xed\xc1T\xe06]\x1b\xd5\xd4\xdc|\xb4I\xbb\xaeY\xeeDL\x8a\n\x89\xa3\x16\x88\x97\x81\xb1\xd4\tHB\xc1S\xd7r\xf2ʱ\xdc\xd6tj\x8a$\x94\\\xde\xcd)\x8c\xe0\xd8R\x83\xf7\xbc\xf5\x18\xf5D3\x9c\xf8\xa4&\xd0ދt\x1a\x1e\x8c?~\xbc\xc7\xd3\x17\xc6\xde:o<\xd2\xfe1!\x04ee\x19\xa5\x96\xd1\xc4R\x96\x9e\xcfWʳ\xbf\xce\xc1+ni\xf3\xd0\x17\x86H%\x89#\x0f\xd0\xf2l\xc4\xf2l\xc8\xf3\xeb\xd9a\x8a\x9c$\x9a\x83\x91_\xb1\x1ahA\x12i҉\xa5Q\xd3\xe4\xc6\xd3%e\xe5\t;\xdf\xecR\xf4\x06%\x81\x92\x87\xaa\xbf\xcd\xdd\x01\x95s\x1c鶈B\xed\xb7[;\x8b\x14\xca[\x88\xd64\xdb-:3]\x8e\x1cY\xe2ȑ\x05Ν=\xc5]\xe7o`iy\x81F\xa3\xc6\xc1p\f\b\xa2(\xf4`X;u3/J:w\x87\x13\x9e\xdf\x19`\xac\x9f\x84r/\x91ޞ;\xd6 \x0e\x14\a\xa3\x9cP\t\xca\xca[k\xa4\x05q(Y\x9e\ti&\x8a\xd5\xfd\x8c\xbd\x83\x92\x99\x86\xe2D7 P\x9e齺\x9bc\x90|\xe7\xdbNp۩\x06R\b\x06\x13K\xab\xae\xfe\xef\xc2\xce\xedG\x8e\xe3:㿪\xae\xee\xe9\xee\xb9\xedE\xe4rIQ\x14eJbl\x05\x8ab\xd9N\x00\xc7@\x12\xd9A\xde\xf2\x14\x040\x90\xbfE\u007fR^\x938H\x9eb\xd8r\x1c9\x8e,\xdb\x14E\xf1\xba\xf7\xcb\xdc\xfa\xdeUy8\xd5=\xb3\xa4\x02/@\x10X\ffg\xba\xea\x9c:\xe7;\xdf\xf7\x15ק!Q\xa4\xa9\x1b\xf8\xc5o\xe7\xfc\xec\xb3\x05\xce{q\x05\xdf}\xe7\xc6ǁ^k\xea\x9aV\x88\u00ad\xb5\x02%\a\x8aٲ\xe1\xad[\t\x93q\xc8\xf9\xb2%\n5{;\x11\xdf\u007fo\xcath\xf8\u0557+\xd1\xe3\xf9EI\x93\x90\x93\xb3\x8a\xac\x10\a\xb6eְ=5l\x8d\"\xd02g\x19z\\\xa8\xb5\x0e\xe54\x03\x13P\xfbb\x01\xe0\xe9\xe1\x05ek\x99\fc\xd28D\x03\xadm\xd0Z3\x9cL\x99L\xb7H\x87C\xc20\x14xd0 I\x12\xc6\xc3\x01\xbb\xdb#\xc2($/*lSKD\xb8\x8e\xe2)c\xe3\xa3y\xc6\x17'\x8b\xde\xe9\xdam\xf4\x1ce\xe3x\xeb\x86PT?\xf9\xfd\x05o\xecF\x14V\x91\x1aōi\xc8\xce\xd00\x1chfy\xcb\x17\xc7\rI\xa4\xb9w-\xc2\x04\x8a8\x94I\xe3\x8bY\xc37\xeeL\xf8\xf1G\xb7z\xdcogl\x98\xa4\x01U\xeb\xc8*\xc7\xe7\x8f3\xfe\xfd\xbf.p\x0e\x8a\xa2\xe5|Vb\xa2PR\xca0\rY,K\x16\xab\x12k\x1d\xab\x02Vyō\x1d\xc3(1\xfc\xcb\xcf\xce\xf8\xcbo\xefp\xfbڀ8\xd2\xdcً1Z\x91\x97\x96t\xa0(\xeb\rio\xa0\xd8\xdbKy\xf6lIQ\xcad\xbfj,il\xb8\xf9ZB\xbb\x03M\xe3xqRP\x96\x96PK\xe5\xd6ګ\xfc\x99\x9f\xff\xfa\x11\xb3e\xce\xf7\xbey\x87\xfbw\xae3@Q\xe4\x19qY\xe0p\xaf\xa0\vA\xa0\tÐ(\n\xd9\xde\x1a\x11\x1d\x044\xa50Q\x9c_\x8c\xa6\xb1<=_\xf0|\x96_\x89\n\x80$\xd2\xfc\xe9\xbd\t\xff\xf9\xf9%\x9f=\x9e\x13\x1a\xcd\xf3\xf3\x9a4\xcc؛\x84\x10\x88;\x91E\x9c#\x14\xf0\xee^\xe4\xb9h]\x9f\xa6X\x95\x96\x9a\x80\xbf\xfb\xfe\r\xe2H_i0W\xa5cQ8\x1e\x1f\x95\xfcG\xb7\x18e\xc3\xd9EN\xddX\x82\xf7\xdf|\xed\xe3\xd10`{\x12\xb25\x16\x88\xbd\xaaZ\xe9;\x94b\x91[a\xb7+\xc5ӣ\x82\xbc\x94\xa1\x8c,D@\x1a\aܻ\x99\xf0\xe9\x97K6e\x10\xc6\b!b\xb5\x92]\xad\x95c22\x80DcV\xb4\x1c\x9e\x16\xb4\"(b\x9e\x89%\xd2(\t{\xeb=\x80\xb3\xcb%\x0f\x9e\x1d\xa3\x8da\u007fw\x82\t\x02ڦƘ5*\xdcz\xe0\xb0n\x1a\xb2lE\x96g\x9c^\xcc988\xf1\xe3_\x8bs\x96E^\xf3\xfb\xa3K\x8e\x96\x15\x9b\xbc\xe7nY?|{\xca;7G\x1c^\x16<?-\xd9\x1a\x85\xbc\xb5\x9f\xf2\xf9\xb3\x9c'\xe7Rx\xc4F\xf1\xf8\xacfYZvG\x02\x85h\xcf.\t\x03-6\xb4\xab\x96\xe9Nʏ?\xbaI\xecG\xdf\x1d\x05\xe8r\xe5x~Z\xf1\xcf?=#+\xe5x8\xbdȩk\x8b\t4\xc1\xf7\u07bd\xfe\xf1\xb8'\xc6\x05L\xc7\x11a\x18P\xd5-uc=\xff\xca\xdf2\x10i\x8a\xd2r|^\xf1\xec\xb8\xe4\xe0\xacd:2\\ߊ\xd8\x19\x19>{\x92]\xc5\u007f\xa2@\xd4B\x95t\xdfij8\x9fU\x1c\x9d\x16\x1c\x1c\xcb\x01o\xad\xa3lZ&iH:\be\xc2\xd6X\xdc\xc6ޭ\x1b˃'\xc7<xq\xc68\x1d0\x19\xc6Ty&\x8c\x13ki\x9a\x96\xb2\xaaX,\xe6\xcc\xe7sa\x90<?&[\xe6\xe4y\xc1\xe5\xaa\xe0\xd1\xe9\x82Gg\vr?\xb6\xeb\x8aԎ\x12j\x81\x0f\xbe1a\x9c\x18\xf6\xb7c\xe2P\xf1֍\x11o\\K\x99\xa4\x01\x8f\x0e\x85U\u007fs\x1aR4\x8e\xb3UKd\x14\xa3A Ψ\xde\xecf\x997|u\xd1\xf27\u007f\xfe:\x1f\xde\x1b\xae\v%o{\xfb\xfc\xa2\xe5_\u007f~\xca\xe5\xa2\xc1Z\xc7\xe9ENY\xb6\x04\x81f\x10\x19\x82\x1f}{\xff\xe3aj|5$\xdd\xe30\x11G\xb7\xa2j\xa5~\xb7\"\xd9\x02\x88\xa3\xb55k^\xb6\x1c\x9eW\xec\xedDܺ\x16s\xb9\xac9\xba\xac\xaf\x80\x93ql\x98\xcfr\x16Y\xcdl^3[4\xac\xb2\x968\n\xc4\u007fQ\xc1\xd60\xea\xe5e\xf3\xac&4\x8aI\x1aa-W\xd2\xd8|\x91\xf3\xab\a\xcfx\xf8\xe2\x8cd\x10\x11iG\xb6\x9csz~\xc1\xf1\xd9\x19g\x173\x8eN/\xf9\xe2\xd1s\x8e\x8f\xcfyvt\xce\xc3\xe3\x19\xcf/3VUse\xdec\xb4\xdcI\xf5\xf6\xfe\x90\xfd\x9d\x01G\x97\x15\x93\u0530\xb75`\x10j\xb6\xc7\x11_\x1de\xecL\"\xaa\xc6\xf2\xe0E\x86u\x90F\xa2Sي\xe5\xcc3Z̙\xb3\xd2R\xb7\x96Å%\x19G\xfc\xe0\x83}\xa6\xa9\x96[~\xbc\xc3ËK\xcb/\x1f,\xf8\xea\x99l܋Y\xc92\xab\xd1Z\n%\xa1W\x05\x8a\xd0SF\xb5\xd2\xc4\x03\xa8k\x8b\xd61\x81V<~\xb1\xe4r^RT\x96U\xa1\x88#\xcb0\x96Z\xdcZ1\xf5\xfa\xc9'\xe7|\xe7\x9b\x13\xfe\xe2\xbd)\x8f\x0eK\x16y{%u\xed\xbc\x96\xb0zZ\x89f\xd09\xb6F\xdd\x02\x94\xbea\xd4\xc2\xcaȥ\xf4\x1dš8?$\xa1\xaf\xf4\x9a\r[qǗO\x8fyzpʍ\xd7&\xfc\xd1\xdd}4b\x0f\x9b\x97\x15yQrr\xbe`\x96\x97d\x8d\xa3u\xae\xd7\xc8t)*P0\f\x157\xb6\x06\xec\x8c\ril\b\x1e/\xf9\x9fG\v\xee\xdeH\x19ņ\x83\xb3\x82\xdf>]\x82\xf2.x\x1eR\xff\xea\xac&\f\xa4\xf9k\x1d\x9c,\x9b\x9e\x03}\xb4h\x00\xc7\x0f\xbey\v\xa55\x8fNZ\x92P\\\xb9A\xf1\xe2\xbc\xe6\U000c7093典\x9b*\xa5\x18Dr\x8ek\xad1\"\x9awh\xe3kl\x05\x81ք\xa1\"\xd01EٲXU\xb4\xadc\x99[\"\xd3I\x0f\x82u(\xae\x1a~\xfdŒ\x1f~w\x97\x8f>\x98\xf2O?=\xbf\xb2\x1b'\xe3\x18c\x16\xd4U\xdb\xe7Ҳn\xa9\x1a!\x19w%o\xd38F\x89\xa8\\;\x85\xd3\xc0\x04\xb2\x90~\x14\xd0[\xf25\x96gG\x97<?\x9e\xf7~\xf0jC\fS;猪\xd6\x16\xaf\xda9\xb4\x87N\x8a\xa2\xe5\xab\x17+\x1e\x1f\xacĹ\xc19\x1a\xa5\xf8\xc9/Ox}7&/Ek\xff\xc9\xeff(\xed\xd3Z#)\xf6\xc1a\xc5\xce8``4\x0e\vNq\xb2j\xa9-|\xf8\xee.w\xf6\xc7\xe0\x91ݺ\x05\xeb\x1d\xba\u007f\xfdŜ\xa6q\xb4\xad\xe3|V\xe0\x1c^l\x14`\x82\x80x`0\x81ޔ\xa3)\xbcO1Z\xc3x\x14\x10\x86k5\xab1\x8a\x8b\xa5\xf5e\x9c#4\xeb*\xe7\xe4\xb2\xe2\xc9Q\xce\xfd\xdb)\xef\x1f\x16|\xfae\xb6\xa1\x15W\xec\xee\xa6\x1c\x1d.\xb1α\xc8\xe4P\x1d'!eݲ\xc8k\xbf\x80\x92\xc2FIH\xddH\xd55\xef\b\xde\x1e\x8c3& I\f\xc3$b\x98\x84đ\x90/\xac\x93\x88\x15~\xaf\xe0Ie\xd3\xd24\x96\xaan(\xcbZ\x8a\x15\xeb<O\xca\xf5\x88\xb3u\xf2;\v̳\x96GU\xc6\xf6H\xa4k\xf9A#;ȊÖ\x03Z\xe0de\x99ƝdA\xde\xeb\xed7\xa6\xbcqsB\x1a\x87\xfd\bw\x995ă\x80\x87O\x16\x9c\x9c\x16\xbe\xf9-\xa9*\x99\xd1\x18#\v2L#\xa6\xa3\x04\x93Ě\xd8\x1b\xd1w\xf8\xbaS\x10\xb8N\xfb`{\xa4\xf7\xaf?\x9c\xa2\x14\xfc\xdb'\x97\x8c\x12M\xb0\x81\xc98\a\xbf\xf8|έk1\xdf{w¯\x1eeW\xa2d<\x1ept\xb4\xec\xa5^\xa1\xd1}\xba*{.\x8cb\x9e5\xb4\xae`<\x1a0\x9a\xc6\xdc\u007fw\x87\xddI\xc82\xb3\x14\x95\xa4U\xe3\x87>Λ%v\a\xa7\xda0\xb9\xac\x9aM\xb7\x14\x99\xa3;\xe7\b\xbd0\xb3\xa8\x1a\xeaFt\xe3\x8be\xcd\xe5\xbc`\x95I\xc9_U\x967\xf7\xa7\x8c\x13\xc3*o8>\xaf\xc0K\xa7\xadR8\xff\x9c.7fGI\x1cp\xf3Z\xca\xfe\xb5a\xff<\x9e\xbcXqzQq\xf3z\xc2\xe1I\x01\xde\xd6i\xb6\x90T\x15yj\xd5x\x143\x19\xc5h\xa50\xa1\x91/\xa8\xf5\xdaB\xd5\xf9\xdd\x16\x04\x125Z\xc3?\xfe\xedu\xae\xef\x8e8_\xb6(=\xe3b\xd1r}[\xa1\x82\xb5\xd5D^Z~\xf3Ւ\x0fޞp\xff\xf5\x84ϟ\xe6\x1b\x13\xb7\x00\x13j\xaar\xed\xed^V\x92\xb6\xb4V\\{m\xc8\xd6$!\x8d#\xd2AD\x12\x1b\xc2P\x86_Um\xfb\xf2\xb5\xf5\xa43\xfc\x9c\xbb/]}\xdaq~\f\xdbE@\xc7 Q\x1a\x14\x9a\xa6\x96\xefV\x15\x01\xcei\"e\xd8\x19\xc5l\rG\x02\x9d[KVT<=\xad\x18\x0e*\xce\xe7u?\xb8r\xbd\xecI]\x99\x0f\x01\xdc\xd9\x1fsc7\x15\xc5\xf1\xb2\xe2\xe4\xa2\xc0Yhj\xcb*\x13\xceV\xdd8\xce.\n?A\x9445J\aLG\xb1`\\ZaFC\xd1\xc1u\xfa\xf5֊\x12\xa8A\x9a\xb7\xa6q\xfc\xe8\xbb\xdb|\xe7\xdd)\xbf;h\x99\xe7\x8e\xed\xe9\x80\xe3ӂ\xe9Ȓ(\x8d\xd2\xeb\xd4\xf5ٗ+\uef5e\xf2\xed{\xa3+\v\x12\x04\x9ak\xd7F<\u007f6\xeb\xff\x8eC1\x1a\r\xb8y}\xca\xf6$%4\x12u\x81\xc7\xcb\xc0q\xb9l\xe4\x8b5\xeb9L\xff\x14,\x1b\xceAn]b\xba\xf5T\x12\xe5\xb0N\xe1\x1ay\x8d\xb3\x02\x95\xfby\xd4F\xd3&\x9b+Њ\xc1(\x01\x12\x8a\xbaa8q\x9c\xcfV(\x9f9\xbe\xceNIiōݔ\xbd݄\xf9\xaa\xe6\xf9\xb1 \x17o\xee\x8fi\x1b\xc7l!=\xcc|YQ\x94MOZOb\xc3\xf6$\xed\xa1x\x00\xbd;Rl\r\xe5f\xb4\x8e\n9\x8a5\xb6m\xf9\xf47\x17\xa4!\xdc\xda\x1b\xf2\xcb\xc7\r'\xf3\x96\xf3YM^\xc8\xc39\x9b\xb5W.V骠Ͼ\\q\xfb\xbaT0\x9b?;[\tqbzs\xe5\xd0h\xea\xaa%\xcbkf\x8b\xbc\xbf\x12\xc2Z\x01(\x17ˆժ\x11\xd5o\xbb\xeeL֬xw\xa5_\xe9\x16˱\xf1ϭ\xf1\xab@+\x82@06\xad\x1c\x0e+\xdc\xe2\xaa!/j\x8aB\x1eXYI\x0ff\xb4\xe6\xee\xf5\x1d\u07bd\xbdG\x92\f\xc4:c\xa3H\xe8~n]O\xd9\xdbI\xb8\\\xd6\x1c\x9ef2\xd7\b4Q(\xf7\xef\x9e\xcdJfˊټ\xec\xcfAc4ۓt-W\xf0v\x1bzo\xa2\xd9J4\xe3X\xf5\x8b\xd24\xf0\xbb/\x97$\x91\xe2\x1f>\xda\xc5\x06Fh\x90\xbeD\x1b\xa5\xa1\xef\xae\x1dY\xb9V,u?\x0f\x9fe\x14\xa5\xe5÷GW=i\xb5\xe2ƍ1(\x88\"\xd3;\x1a,\xb3\x92Ū\xe2\xf0d\xc9\xc9y\xce\xc1q\xce\xc1q\xc6\xd9EIY\xb7^g\xb1n\xb0\xe4:\xa4\xf5?\x89*\x91+\xe8@\xec*Ԇ\x9b\x83\xf2\x860k\xae2\xe8@Ѷ\x12&Z\xbb\xf5\u00945YQ\xb1\xcaK?C\xb1\x8c\xd3\x01\xf7o_c\u007f{\x82Q\xfa%\xcf\t\xb8}c\x84R\x8a\x8bY)硃t\x100[T\x9c\x9e˹\xb4\\IQ\x11haŏ\xd3A\xefݢ\x94\xf2\x04v\x8d\x89#\xb1\xab\xd3Z\x98\x11gK\xc7\x17OVdY\xc3\xdf\xff\xd5\x0eE\xa3\x84\xca\xe9\xa0(-O\x9e\xcb͛\x91\x91n\xfe|ޒD\xaa\x0f\xbb\xee2\xae'G\x05\xf7o\xa7\xfc\xe4\xd3K\xcf\x06\xf4\x87\xfbp\xc0x\x1cQ\x15֗\xb1\xb0X\x96\xb4\xad\xfc?Lc\x99\x85x}\xb7B\xaf\xaf\x9c\xf0\xbb\xde\x18OF\xf3\xbf\xec\xee\xb5EI%\x14hX\x15\xd6{\xea\xca\xc1o\x82n\x96/z?\xe7\x87fA \u007f\x03\u007f\xb5QV4~\xa1\x14u]\x92\x17\x9aa\x1a1\b\r\xdb\xe3\x84\u058b\xfc\xf3\xaa\xc6\xe1\x18\x8f\f{;)e\xdd\xf6\x82\xd8\xee<88)DB^[\x96Y\xed\x1f\xbc\xc8:\xa2\"\x92\xfe\x00\x00\x05xIDAT\xbaTՓٽ\x1d\xba\t\xb5\x98\xcaD\x81\xf0LG\x03ŭ]ß\xdd\xdf\xe5p\x01\xcd\xcc\xdfx\xd6\n\xfeT\xb7\x96 \xd0\xc4\x03Y\x90U.\xe8\xf0\xf8%\x87\xbe\xdf>^\xf1\xce\xed!\xef\xdf\x1d\xf2\xdf\x0fWW\xa2\xe4\xb5\xdd\x11Ϟ\xcdz*\x0e@Y\xd5\fB\xc3*+$\x9d\x85fM\xf5\xb7\x16\xeb\x14J\xc9,<4k\xb8\xa2\x03\xed\x8c\xd1h-\x1dx<P8%8\x91cݿh\x87\xf7n\xa7?\xabz\x96\xa6W\x86\x05\x81\xa2n\xf03\x13\xe1m]\xcesB\x13\xa0\xfc\xeb\x938$\xaf\xa5T\xbf{s\x82\t4Y\xd1\xf4\xe2\"\xe3\xe1\xfa\xcby\x85s\x8e,\xaf{\xb3\x19\x13h\xb6&)\xa1\xa7\xbc+\x9f\xba\xe5\x02M\x87\x0e\x8d\xf2N\x9cb\u007f\x97F\xf0\xad7\x13\x16\x95\x16\xe7hK_bF\xa1\xee\r\xf6\aQ \x04:\xe7\xb8\\\xb6\xb4v\xc3\xf1\xc6I\xb3x|Q\xf2ޛ\xe9+\aa\x1c\x1b\u0081\xf6:n\xfa\x19z\xdd\xc8\x00iUTԍ\xbcg\xe3\xb5!\"\xea\xa4\x17w^-i\xd7焃+p\xfa+V\xde~!\x957XV\x1b\xaeE8!&\x04\xfa\xd5ӻnZ\x96\xab\x92\xb2\x92\xcf)\xaf\x85\xfdk\xc3\x1e\xa9\xb6ި,4\x9ae\xd6\xf8ɨe\x95\xd7b\xe8\x1ch\xa2\xd00\x1e\x0e\xfa\x8c\x92\f\x02n_7ܻ%\"\"-\xf7\x05\xe2\x95C\x92\xb6\x96\xa5\x9f\x89\\9\xb0\x9d?\x14}\x93\x18\xac\x0f\xa3Un\xc9\xcbu\xa3\xd5=\xa9\x87\xcf3\xf6\xb6\"\x86\x83\xab\x8e\xe6:PL'\xf1\xfaj\n\xdf̕UCY\v\xf99/*\x11p:w\x85\xcb\xd5\xf1\x9b\xecKP\xfdf\x01\xe6E\xb9_k`\xd8E\xb1\xdb\xf0\xd2\xda<\xf8\xff\x90\xf1a\xd3Z\xb2R\x1a\xdb\xe98b:\x8a\xa8\x1bK\xddX\xac\xc7\xe6B\xa3\xa9*Y\xa4\xb2l\xa9=o\xc1\x04\x9a\xf1p \xd1\xe6iF\xc3D\xf3\xfe7B\u07bb;\xe0O\xee\x89Ժo\xee6\xe7\xbdI$tz\xd6>)\x9e\xbc\xbcq5\x90\xcfߍ\x85\xbcZ\x0f\x97\xba\xd7?>\x14b\xdc\x1f\xdfM_٥I\x12\xf5)c\xed -\xc8mQJ\x88\x17\x9e\x8e#)\xcb\xf5\x15\x96\xeb\x1a\xbd\xafy`\xce\xe1?\xc7\xcb\xf6\x15\xaf\xbc\xb2?\xf3\xec\xc6bl\xdcz\xf0\xea{\xe3hlۧ\xd9;\xfb\xe3\xfe\xefU\xb5\xf5\xb4[E2\b(|\xbfU\x94R\xb6ˬ&`<\x1c\xf4\x8b\x9e\xc6\x01w\xf7\r\u05f6\x04ؽ}ݠ\xbb\x8e\xb6k~\xac\x0fE\xa5\xe9Q\xdd\xfe\x03m6\x8e\x1e\xb1\xebT\xaf\xabܒ\x15릯#\x1c\xbf8+ys/~\xe5\xcbE\xa1f\xba\x15\u007f\xad\xa2H\xa2\xa5b\xb1*\xa9\x1b\xdbk\xcfea\xe4<\xc3\xf1\xcaS\xeev\xfa\x95~\xe5\xa5\xdd\xefX\x87q\x1f\xa1vM/\x95h\xfd\xfa\xe8\xb0\xfe\xf9t\xef\xb73\x89\x05\xe2\xf1\x11\x1ch\x8d\xf1\xceHy!\vW\x94m\xdfNđa0\b\xfb(J\x06\x9ao\xdd\t\x85\x8d3\xf0\x00\xef\xb2\x10_'\x99C\x88\xa2G\x01U\x83\xaf\xaed/Z'\x84\xea\xa2\x12_\xab\xda_\x00\xd8\x01o\xab\xc2Q\x94\x1bQ\xe2\xbfģ\x179\xc38\xe8\xefD\xdf\xfc\x99\x8e\xe3\u07b8k\xf3K\x8b\x1b\x83\xa3\xaa\x1b\xce.3\x99y\xf4\x96\x1e\x96\xaan{\xbf\x95\r\xc1\t\xce\xfda\xab\xa9.\xc26\x17\xaa\x8b\x90\xa6u\xaf\xa6\xaf+\xa6jm\xaf%T \xa4\x8ev]\x98ti\xa9C\xb5;n\x82\x0e4&\b\x98\x8c\x12i@\x91*\xf1\x9d\xd7\r\xc3D\xaf\xa1\x1e\a\xfa\xe1\xb1cU\b\x1aYxX\xa1\xb6\xf4g\x824jP\u05ce\xc5\xca7M^J\xa0=;E\\\x11\xc4ѹ\xaa\xed\x95(\xb9\x98\xd5<>*\xfd\x8d\x9b/\xeff\xf9R\xeakҎu\x96\xd6Z\x8a\xa2f\xbe((\x8a\xa6_\xac\xd6Z\x8a\xb2\xa5\xac\u05cdi\x97\xc6\xd6\xd7\x1amܦ\xa36v\xb8\x95n\xbd\x8b$\xbb\x01\xcfw&j\xff\xdf\xd9Q6m\x0f\xd3\xe8@\x13\x19\xdd_\x89\xa4}\xe9:L\r\x97\x8b\xca\x17*b\xf1a\xb4\xc2\x18M\x9aD\xfd9\x18\x86\x8a\u05ef\x05r\xc3Bf\xa9j8\x9d\xb5\x98U\xe58^(&\r>R\x84\"\xd9\xd8u\xe5߶\x8e\xbcl98\xc9i|\xd4\xc8pI\xf5g\x821Z\xae\xcfn\x1c\xc9K\x15Йw\x10\xbaR\xb5Ԗ\xb2h|ʻ\xb2\xd1{<\xaam-\xad\x96\x1a>0\x81\x17\n\x05\xe0,\xca\xc8%16\x97\x19\xf0xhpV႗\x81\x8d\xf5\xdfm\x9auqХ\x9e\xee\u2bf6\x95\x88kگ\x8f\x8e\xa2iz\xd3K\xe7\xef\xb7\nÀ\xaa\xa9}\xba\x92\xf3\xd7h\xc5\xe5\xac\xf6\xfca\xdb\xc3FI\x1cz\xe3K\x8f~O\xc4F6\x8e\xa0n\xa4o\xfa\xdfG\x15\xa6\xae\x1dE\xdd\xd1罶.\xdb\xfc\xe0\"\xf2<8\xce%/:\xd7cKݛ\x83\x98J6\xad\x9c#\xa3THs\x02\xe3+.\xe65\xab\xc2n@슶u\xcc\xe7%\u03811\x81|x\xb7\x06\v\xbbE\xa9\xaa\x06\xa5\x14\xf3E)\x95]\",K\xeb \xe9\x94J~\xc6@\x00\xca{\xe8n.\x87Dx\xbb\x06\x1c\x9dZ\x9b\xd5\xf8\xf3\xa8i\x85\x00q%\x82\xfb\xcfj\xa9\x9a\xe6J$\x8f\x86!\x91\xd1,;\xd3\x18#\x92\x89\xa2\xf4\x15\x97uX\u007f\x89eh\x02\x928\xea7o\xc7+8<o\xc9K\x993}uذ\xc8\xe1\xff\x006\x19\x01NYq\x96'\x00\x00\x00\x00IEND\xaeB`\x82")
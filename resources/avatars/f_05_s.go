@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_05_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x003\xbezTXtRaw profile type exif\x00\x00xڵ\x9ci\x92\x1c9\x8e\x85\xff\xf3\x14}\x04\xae y\x1c\xaefs\x839\xfe|\x8f\x91J\xa9U\xea\xee\x1a\xb3\x99RUIʌ\x8cp'\x81\xb7\x00\xa0\xbb\xf3\xdf\xffu\xdd?\xfe\xf1\x8f\x10Z\x8c.\x97ڬ\x9by\xfe\xc9=\xf78\xf8C\xf3?\xff9_\xbf\u007f\xbe\x16|~\xff\xff\xf1O\xf8\xfa\u007fp\u007f\xfcF\xe4\xf7\xc4\xef\xe9\xf3Ś\xbf\xbe\x9b\xbe\xbe\xfe\xe3\xf5\xf6\xfd;o\xf4\x87o\x84\xf2\xdb\x0f\xa4\uf3c9\xbf~p\x1d__\x8f>\xfe\xd3\x15\x8d\x13\xb7\xff\xf5\x9f\xf6\xf3\xbf{w\xbb\xf7|\xeendc\x19\xecsS\x9f\x8fp?ކ\x17NV)\xbd\x1f3~U\xfe+\xfc\xb9\xbe_\x9d_\xcd\x0f\xbfB\xf6\xdb/?\xf9\xb5B\x0f1$\u007fC\x0eۅ\x11n8a\xf3\xfb\n\x8bk\xcc\xf1\xc4\xca\xef1\xae\x98\xde\xd7Z\xaa\xb1Ǖ|\n)\xebW\xb8\xb1\xa6\x9evj)\xa6\x15OJ)\xbb\x14\xbf\xaf%\xbc\xcf\xed\xef\xf3Vh|\xf2\x0e\xbc4\x06\xde,\xf0#\xff\xf6\x97\xfbO/\xf8;\xbf\xee]\x9e5\n\x81\xbb\x0f_k\xc5uŨ}\bZƤ\xff\xf326$ܯ}+o\x81\u007f\xfc\xfa\xfe\xc7\xfd\xb2\xb1\x89\x1d,o\x99\x1b78\xfc\xfc\xbc\xc5,\xe1gl\xa5\x17\x00\x89\xd7\x15~\xff\xc4W\xa8[\xbb\x16_\x94d>\xbbp1!\xb1\x05\xdeB*\xc1\x82\xaf1\xd6\x10r\x8a\x8d\r\x1a\\yL9Nv \x94\x127\x17\x19sJ\xc6\xde4\xe2\x88\xcf\xe6gjx\xaf\x8d%~\xbeN\xaa\xb0?%Y\xaa\xecMO\x83\xcdʹ\x10?57bh\x94Tr)\xc5J-\xad\xf42\x9c%\xcbV̬\x9arn\xd4Ts-\xd5j\xad\xad\xf6:Zj\xb9\x95f\xad\xb6\xd6z\x1b=\xf6DJ\x96n\xbd\xf6\xd6{\x1f\x83\xcf\x1cٍ2\xf8\xe9\xc1+Ƙq\xa6\x99g\x996\xebl\xb3ϱ\b\x9f\x95WY\xb6\xeaj\xab\xaf\xb1\xe3N;\xef\xb2m\xd7\xddv\xdf\xe3\x84C(\xb9\x93O9v\xeai\xa7\x9fq\x89\xb5\x9bn\xbe\xe5ڭ\xb7\xdd~\xc7\xf7\xae}\xed\xea_~\xfd/v-|\xedZ|;\xa5\xd7\xd5\xef]㫵\xea\x8d\xde[\x04\xe1Lў\xb1c1\av\xbcj\a\b\xe8\xa8=\xf3-\xe4\x1c\xb5s\xda3\xdf#YQ\"\x17Y\xb47;\xf8Ⴑ\x85\xf9\x84Xn\xf8\u07bb\x9f;\xf7\xb7\xf7ͱ\xd6\xffi\xdf\xe2\xdf\xd99\xa7\xad\xfb?ع\xe8N\xfam\xdf\xfe\xb0k[H\xb8ގ}\xb2Pk\xea\x13\xd9\xc7kFl\xfc\xcbb\xeb\x0f\xc7f\xb97l.\xfe\x04.\xa2̺\xf39`KL\xdc\xf195\x97u\xf4\xb3\\Z\xba\x13\x8c\xf2\x89\xfb\x88\xb3\xa5{[Y\xe18\xa0\x91w\x1e\xa4Q\xbd\xfb\x9eV\xf9\xc6\x1c\xe9\x9e\x000\xeae\xa7E\xdf\xf5\x1a><\xe8w\u008f\xb7\x99\xeb\u05f7\xf1\xd7\xfd\u07fcͽ\xee\xafo\xc3\x12\xfa\xb9j\x1f\x91M:׳\x94\xbdǙ7\xe1\xb8O\xb5\x19\x81\x8b\xd9=\x1f\xd0K\xbb\xa1Gݨc\xbf\"\xdf*\xfb\x86t\x1a\x97\xb2f\xecv\b\xb7ygeoA\x120\xde[\xef\x01\xea\x98ƾ\x11?\x16\x93\xc2D\x94\xd4Ù\xe5\xb8z\xea\xb5F&\r.\xb0\xb3ؓ\v\xae\xc7\xc0ϣ`\x99\xb9UK\xbb\x9cƆ6\xdd\x1c\xf1DL\x0fn\x82\xc5/l\xea\xbc)ԷFa\x8dy\x87\xd5e\xdc\t\x97H4\xdc5k\xb7I`\xb0`\\j\xe6-g꧳e\xa7\x8ez\xd8\xecc9\x10\xc6#\x97P\x96\xeb\x90K\x88lhO\xad$\b%X\r\\%\xaf.;\xe7\xc4\n\xb7[x\xf3\xc1\xfa͕z\x9d\xbcY\xb2\xb9\x87A\x94\xb5x\xa2;L\xae(\x12y\x83[6k\xc4v\x9c\x83KY\xd0ނ\xf7\xa0ǲ\x9bv%\x0e}}\x00\t|'\xef^\xce\x1a\xec$/j\xb3V[\xd7\rH\xba\x10\x9e\xb3\xb3\xa7\xb7\xb5:\xd2\xe9\xab\x02\xe8\xa1\xec2\x8e\x0f\x04\xe0-9\x1f\x10\xbc\x8eY\x8c\xbd\x85\x14\xfd\xe5}X\x96\r\xdcs\xf7\xc4QfqW\xe1\xedj\xedh\x9cϷ=A\xb2^\xdc\\\xb8 kA\xf7't\x03\x19\xfd\xf7ވ\x17,\xc2Ï\xb2ւrS\x1d\xec\x17\x99\xe2I\xe8Ţ\xc3л\x9f9\x1b\x19\xf8\xf2\xec\xfd\xee~\xfc\xe1_\xfc>\x8f5\x92\x96\x88=\xd9\xd2䪦\xf5O6\x00F7\xcf]\x17\xbc4\xb3\xe3\xb2\xc1\x06\xe0\xf0^\xdbI/ɞU\xf7i)t~{\xd7ѯG\xe1m\xf6\xeaz;\xa3Fֱ\u05f5vt\xcboc\x1d\xd7H$9\xf8\xb6ؠ\x9aָy\x9c2f6\xe0\xc8\x13\xed\x90_\xb13w2\ueb4f\xbc7(\b$\xf4\xc9&\x9e\xb8\xdcV\x1c&Ba\xed\x01~\xee8V\xe97\xf6\x9c\x8d\xf7\xb6\f<\xae1\x1aQh\x8dT\xe8;X\x1f\xeb\x00ɽ\x91\xa33\xf03BB\xe7\x1by\x05\xa0\xa3x\xce-\x15\xf0\x03\xf5^\xf6\xe5\xb6R\x18)đ[\xf7\x97ka\x9b\xca\x19#_K\xa0\xa6r\x96U\xb9D\x9a\xcd\xe1\xe0q\x16\x84U9\xa5\xc1\x00\xb0DGf\x91\xe9\xd0\x05\xd7n\xfc\xa4o\x97P%\x82\xc2P\xf6t[\xa0.Y\x14\x9bt\xd4홍\xad\x0eN\x06s\xa6\x8d\x93 \b~\x1e\xfa\x00\x88\xbdR\x8c(H\xbb\xcdh@\xeb\xfc$\xac\xefl\xd7\x0e\\8kLJ\x05\x91\x02\x994ܴ\x00B\x87K\x86\xd4=wI{Ln9\t\xa8\x8d{\xc8^\xa9\x11\xf9\xebl1\x10\x97\xb1\x97\x94\x16\x1b^\xc9\x00ma(1\r\xe2(\x0f\xb6o\x15\xe8j\x03.\x06\x98Un\x17\x92c\xe1\xc9\xef\x1b@\xb6\x02/\x14\xd2\xcdV\x86\xfb\xc6\xe8\x1eT\xb7\x92\x054\xac{\xa9\x17`;\x99\r\x9c\u05f7\x9a\t/^W+\U0007f34f\x9f\xed\x04\xb1\xcd\xd9l%\x1b\xceg\x87\f~\x16hne;\xbc$\b\x1b\xb80\b\xb2\xa1\xa6&\xd7N\xba+\xd2xK\x94\x11\vF\x80\xdc\x18H\xaaQI\bb\x8a\x18i\x01\xd6\r\xddJj\xe0\x12K<v\x89\xfd\x92\xcd\xdde\xae\b\xf5\xc5v\xb3UVS(\x17ݴ\xc0\xa5\xbd\xc0\xbbS\x17\xd4~ΒP\xd6\xed\xa7\x9bػr^\x9aT\"}\x93\xa9sz\u05c9'BflB\x99\xfb\x01i\x94\xa7)\x16\x88\xc4f\xe3>\x89h\xb6\x8f\xc0\xbcG\xa1\xea\x01\xbe9\xa7\x11G\\\x17\x90\xc6\xcbw\xaen\x11|\xad\x1bqy\u038dk\x9f,~\x80IB\aA\xc1\x90\x00S\xae\x8e\xe0֒\xb1\xaac\x874{D\xfd\xb4\xf2R\xb9L\xf2\xb5\xb9 \xee\xf2\xbbܚ\no΅\x18\x04r\"\xdc[\f\xac\xf1\x10\x97\xaf|n\xd3k\xe4U\x02\x18\x9d\xcb\ufffb?|\xa3\xdcD\x90\xf0\xc1Z\alĆ\xf0\xb6>V0\x16\xe3\xb8\xc4\xf7_\xe0\xc5\xfd\x8a/\xd9#\a\xef/\xefKx\xa2K:K\x15\xb5,\xc4{\xea\x8b\xd0E\xa4\xe1j즊'\xf1\x81\xc8Y\x8e\xd8\x030\xc6!\xe8\xd1d\x03\x06\xe5\xbby\x9d\xb4\xcf\xe8\xbb!\x14\xda\x06\xae\x88\xc6\v$)\xee!\x8a\x02t\x8e\x05A\x01\x04\xa54\x04\\a\xb1w\x06vv s\xb6\xc1\xa6(\x1fv\xb1\xc8\xfdq\x89k\xa3\xf23\xa2\xa8\xad\x12e/\nD\x9e\x00\x1b\xa4\xdcD\xe8`~F\x82'\xb3\x03O\x12z\x93\xa0\xe4\xe3\x043\xd0\xcb\xc6rU\xf6\x85\xbc'm\x007\xbe#\xbeË\xc1ҋ\xd4^\xfe\xdcU\x15\xc16P\xa7;\x9b\xb3\xa1[\xbc}\\;\xb9L\xa1J\x0f\xf2]\xd8\x03\x0f\x9c\xb5\x17z\x05\x15\x9ag\r\xa9\xf5S\xf1n\x04i\"y\x89\x9f\x02\x00\x91\xeb\xcb\x11\xb9\xda|\xf6\x1a\xc8\xe8\xacI3\x94\x84\x1f\x8d\f\xdb]i\x00.\"\t\"YU\xca\xe0\xa2\r\x8a\x85\x9e\xad\xb7\xfcd\"A\xca\xd5;$\t\x19>WG\x96\x01\xd7|`\xeb\x80+\xab\x1d7\xd8U!|И\xad\x05\xf8@\xdeu\xb6\xf4\t\x80\x8c\x98Cmg\xc0V\xf8\x8e>\nu\xf17\x14Ɓ\x12\x92\xc8\xe3\"\x95\x01\xf5\x1a\x89D2\x92<\xdc\xdc\xcb4H\x00\x90!\x01\t\xed\xa6\xec\x03\xf0 u\xa1R\a\xd8v\xcf\x12K\x01%\x8d\x8c\x1dM\x02\x8b+gC*\x18YB\x1f\a\xcc qo\xad\xfb\xa2\xd5Ai\xb2\xd2\xf6d\x9f\xf1!\x93\x1d\x8a\xeb\x00#s-r\x96\xd5D\xaf\xa2\xe7\xea\x06\xbdV\xb7\xd0\x04\xee\x95DG\x1c\x81/$bů\xe1\x9c\xf7\xb9\xad\xe2\xafY ^G\x8e\xda.\xc15B\xf1V\xbb8\x8e\x8a?'tD\xfb\\\x0e\x01\x82fJ\x80\x9d\xb1\xab\xba\x84.\\\xbf\x80w\xc2\xd7\x018\xddv[\xb3\x99\x04\x96AG\xe4\x12W\x84\x18\xe9\x1d\xa6\x00\x9d\x03\xa0\x06'\x19\x1b7\xcf\xcc\xc4\xcd\xef_Bdd,\"j\xbc\f\xb9S\x99\x1a\xd2Y\xaf#\xbf\x88\x96\x1f\xbf\u007f\xf1\xf9\x9f\xe9|\x1e|\x05\x81\x97\x90\x8c\xa4)\bs\xd0\xde\x0e<&\x83\x1b.\x15=\xd2\xe6\x8f\xf7\x1cC\x88\x8f\xde\xd7ZG¡c\x94\xb0\xbb\x89(\t\x9de\xc3ڜ\t(\xb1V\xd8,\xcbX\x88\x8d\x86\xaf\x19\x83\x02G\x11S'\x94\xbe\xfaUA\x820NB\xf9I\xdaC\x15\xb8'\xafUL\x10\x1d\xd2\x15\xde\xce\xf0v\xf2\x87l\xecn\x04\x04\xeeb\xa9Qj\a\xdb\xd7O \xa7=\xfa\x90U\x06\x9c\x01{\x18$c\xb4d\x8a\x10\xecP\x1a\x86\x06\xb1\x94f@\x8f#\x1072:@G\x11H\xde\xc8Ō\xfe\x84\xffP\x9c$\x12?zv&\xaa\xcfʤ\xfe\x99\x18\x1d\xb11\x04HHc\xdd\xc0\xe4\x8b=\x9b\x99\xd0E\xfen\aP@fDn\x85.缝\xb8\xe5\x16a\xb6݃\xb8W\xb9v@\xf5\x80\x96\xdf\xc4j\xe7f\x97\xed\x91\xe4%N\xd9D-\xe8P\x1d\x19\xec\xf5Yu\x16\"\x9c\x98\xe6\xd6\xc8N\xd2\x1d\x10\x00\xd9\xf8{\x0e\x01\x18\x00X\x03\xef>..\xa1H\x14\x11\x98Q\x8e?U\xa2\xe78\xe09\"\xbf\xc6Bv\xee\x89\b\xdb6\xd3\bJU\xd2L[\xbe`\x15p\xb1\x89Rw\x85\x17\xd9\x1c\x84\x022\xa4\xc2}\xd0s\x92\xb3E\x8d`az\xe8*\xb6\b\xc5v\xedB1\x9c\x89(3W\x98\x05xڍlKȯ\xabTd\xbb\xccKų\xaa\x1b\xa6\xcf@\x9a\x13\x8aE\x0f\xfbA\xbd\x97\xe50xs\xe3?mr\xaf\xe0\x11\xef\x8cR\x94\xeb]\x03\x8f^\x0fƣ\x8f\xd2\xf1\xa6a`t\xd6$\xd3.B\x8b\r\x04+ɮ\xb1\xb0V\x86\x97'%wz\x94\x80b\xcd\xc9`\xa4\xcc\xc2\x14\xa4h\x84\xe0\x95l&})\x8b\x85E`i\xf88({\xa3C\xa7\xb4\x1an\xf6\xad\t\xb9O\x94 \xb7\x85p\x18\xaa\x00\xfa\x02\x1ae#\x18v\x87\x85\t\x01!!$L0'\x10i\x13\x1cn\fD\xa2-\x03\x87g&\x17\x10\x84\xe8F^\x9dQA0l\xc0\x10\xb1ì\x0f`Y*\x9fݑM\x04$\xa4\x86\x80\\\x050\x03q\x02jd\xf6\xc6\xfe\xb0\b\xac\x11\xf4\xf5\x8cC#:yͳ\x10\xc4\t\x86\xe8\x92\v\xfd\x99\x86\x86\xe0\x92MA̭P\x1a1O\xf2\x91\xfd\xe0\xf0\xf6\xbc\u05fc\x81\xf0\x88\xd0\vZX\xa2t\xbe|\xe7V\xaa\x02U\xa0O\xd0\xf2%\v\xf1\xe3J\xcas%\xb5`s?\x9e6\xa0X\u05cb\x98в*+\x92\x0f\xf057m\xefG\xbe_\xfd\xe3\xa5_/\xe4;\xa5T\ue07f;\x92N\xb2\x91\xb0n\xf2\x9c\xc4>\xaa\x99\r\xda{Odh\x9f\xb2\xd3ps\xcchX\xbe\xeeQH]\xea\x13s\xc4B\x05B=\xa3\xf5\x82c!b\xc1\xe6\xbe\xf5\xf8!(\xc8c\x88\xfeJ\xe1\xff]\x93\xe3~w9\xff\xce\xe4@3\x19w{q\\dn\x83\xb8Ĳ\xd8\x14\xd6\u0081\xf8\x18\xcc.\x9dB\xea!Vj\xe1\xd3=\xb8\xf6\xb4;\x86\x02\xa0\x81[\x81S\xd9\x1c\xe8\x0e\v\x82H\x82!b\x83$\x04<\xb9\x97\t\xf7\xe3\x8d\x10\xa7\xe8\xc1&\xf2n\xe82\x82\x9c\xb4k\xa3\x04\xf4\x89G`c\x8f\x80*\\}\xaf\xf6\x82v\x17?\b\x866@\x9d\v\xef`!\xb8\xb8\x1c\xb8\\\xe5D\aOkb%\x88\x91W\x90\x00۶|\x1b\xec\x83\xf1\xe0\xe2\xb8+\xc3rmp\x86\xcbďp[X\x95\x94\xa6C\x96\x0e\x11\x92\xee\"\x12\xdf\xf9\x11\xb2\x88\xe0\x14Q\xce\x04u\xa0\xd8(\xa3\xc0=\x17A;:\x83\xe8RmY\x89\a(\xf7\xba]\xad\x18\xb0m(\x18\xb45&\x8dh@\x19\f\xd8\xee\xccu\xc6ި\xd6\v*\xf6\x847o\x05\x87r\xc8\xe8\x05\xbb!5Y\r\x90r!\xa0Q\xfe&\u007f9\x12\xf2o\xa1xؠ\x88\xd4ӻ\x81\xf6h\x14cw\n\xa6\x8fČܿ\x10\xc8NAY\x0e\xb2\x11\xf0DE\xc1\x93c\xbb\x98!\xe7\x1c\x1eᨶ\xa2z\x13\\\x1ds\x17\n\x81\x9e\xac\\ǝ\x04}\x9b=e\xad\bN\xd4\x1b\x06p\"6#\x17XR?\xce\x17\xd0(\xf0\t\x98\xa2\x85\xbe#\x01I!H\x1c\x13\x82\xcbB\r\x85@\xfe#7\xa1\x8bT\xb8\xecJ2\xb2\xfb7\x01\xbc\x800\xba\tp\xbb`67^\x91\xb2\x98\x02\xaedCD@\x8dVq\x11/\x03\x11,\xb6\xc1NMI2\xe9\x13\xac\x84\xaegÝ\xb2\x94,\n\x00\x9by#\x90=%\x9c\"Z\x8f\x17\xc6#\xaa\xdd*v\x86\x86\xa4\xbb2\x01]\x8c\x8d\x00\vBk\xecf\xcd\x11\x12&\x1a=\xf8{\xf0\x06\xf58\xb6\x19\x9e,\x88\xb7HF\xb12\xe9`\xd9\x13ڨ&\t\x9e(\x06\x8c +\x9b\x8c\xc5\t\xfe\xbe>\x02\xe2\ufe6c\fH\xa0(\x92w\x93\x8d&\x14\a\xf7I\x8c\x81\x8f@\xad\xc0L\xb4Ip\xdaXD\xa3jK,\x19\xef\x01\x1a\xd4\xfbm( \x16X\x87\xeb\x1f\xe4\xda{\x05\xf8\xf5\xf5\x1a\xbe\xadW\xbd\xd7(h\xff.\x02\xb8\xdf\xeb\x1c\x80\x0e\x9e\x19\xcb\x15Ts\x801-\u007f\xc0\xf7|\xbc\nK\x8ah/2\\I\x05(\xa8\xebd\xfc\xaf[\x8a!<\xc8\x1e\xaa\xe6Û\xa8\x0f\x9cZ\xbfk\x8c\xf5D'\"\x80\xb0\xc2_\xc0k\xeb\x19\f\x15\x11\xbb\x8c\x06\x96\x04\x12\x9dh\x84\v\x8b@\x03\x12\xaa\x13\xf9\xb3!z2}\x12L\xb8\r\xd0\x16-\tޠ\xa9\xba2\x9b\x8fS\xb9\ad\xc87CQXKD2\xd1\a\xff\xbb\x9c\xceC8\xe1N\x92\xb7\xbd\xc9N\bb\x0f\xe2\x10M\x8bρ\xd8\xf2NH\xf5L\xae\xf0?U\x1cT\x8cP\x99:\xb3q\x95`r\\\xae,\x99J\x01\xeb\x90\xc9H\x16\x82\x97PW)\x12\u007f\x8daW\xfd\xd3*\x9f\aKA\vh\x98\xab\xda\xdeB\x1b\x00$Hx@\xee Fq\xa6ь[3K\xfeY\x0f\xb6\xb4\xca2_5\xaaXLv_w\xb1\x93\xa0\x1c\xe17\xf6B\xab5h\x1c\x89\x87\xd6\"d\x94k\xe0\xb3o\xacP\x93uk\xfc$q\x06\x98\x1e̿\x8a@\xd9F\xec\x82>{M\xb2Q\v\x1e\x92=_@J$\xb7-\x9d\x9a\xad9\xc4\a\u007f[^\x15\xbd\x88<\x94,\xb5ySg\xed$\\\xb4\xa4\x97\x90\x92B\x8c\xc8\x02\x12^%\xeeg\xbd:\xf7\x86\x1dP\x85 ;Sy\n+\xd7\xf4\xe1\xa0\x1e\x0e\x94ŚD\x00;y\x86\xaa\x03\x034\xc2$\xa2a\xa0\v\xd6\xd9G\x88\x06j\x9b\xe9#:{#\x9f\x1cyf\xe0#\xa8C\xbay\x16*\xa7\x16\x95\x99hA\xfeE\xea\xf4\x04\x9bXd/\v\xa2\x91eC\x99\xb6\x80\xc8R>a!\xcb)\x84\xa5KH\x1c;\xb7\xcb٬\x83\x86UN{nQ?[V\x87s\x80\\\x16\x04)\"\xe7\vf\xb3\xcbA\xbc\xf4D\x06j\xe8hG\x1d\xf6\v\x88\x0fJY\xc8\n\x12l\xd0>\x9f\x8c\x1cI\x18\xfcj\v\x9bˇt\"\x8a\x00\x88ȉ\f\u007f\xbc\xd8\xfb\xa6м\xa6+\x87\xbd\xfd\xfd5|\xf3\xbdF\xafКV9\xa4\xbf\x96\x19\xdaU_\x02 $c\xdc\x06\xd2\xfcR\xc4)\x9b\xe1\vLKc\x91q\x03'\xf1\x11(\xca%\x8fb\x9bmA#\xf0\aIͪ[\xac\x90D\x10\x9c\xe6\x849\x1e%v[\x89\xe8\x06\xd1\xe6\xab}\\\x89?\x13\x98b\xa2ź\\\xc4\x19d\x1b\xd4\x0fJc@%\x88#\xefpď8\xbb\x99\\\x80\xa7H\xa3\fJ\xe9{\xe0\xae\x1a\xc8E\r[@D57/\x9f\xc2\xc7\x1d\x95\xdc\xe4\xf6\xbb\xecp|5\x1a\x9cV\xab\xc4\xe5\x13Z\x119H8H\x97e\x01;j\xea\x80g\xed\x98\u007f\x95>H[\xd9+\xf8eI\nD\x9c\x00?\x840\x1b\xa9j$\xc2\xf6\xb2k\xb7\x9f\xf7rX\x9c\xdc\x11\u007fN\x15\xfe\x8b\\\x02Ɗ|\x94\b!rn\xd5\xe2\x96\x0e\u007f\x14\xe2p\xbdz?ٹ\xa5\u05c8\xa3\x19\tm\xa4)\x1e\x05\xc0a\xd31\x16\x19\xc8i\xa8:\xcf\xfb\xc1լS\xebK\xdd\x1c\x0f\xb6`ڢb\x1d<S\xe9\x1a\x9fċ\xaew\x11]>W\x9aR\xbe\xbc\xbf\xfa\x03\x19p\xc6\xc8*%1\x82E\xaa\x1br\xaf\xea\xbdU3\xec?i[\xc9\xfd&\xbf?\xb8]X\xa5;l|G\x15zp\x13\x12S\xba\xf33\x83\xc0T.!\xeb\xd1\xc4m\v\x8dwTw\x1cC\x8c*Q\x89\x96\xcb\xdf\x03\x82F\xb2\x1b\xa2\xc0\xc9\xe5\xb3\xc9pi\xeb\rߏ\x1fEs\"ιF\xae\x87\x0f\xc6b<\xa1q\x0f\xa1\x04P\x02\x0f\xa4ŉ\x1b\xbc{\xe5B\xe4kL\x12\x11S\xd6\x113\u007f!\x83\x8c\xd4&F\x87\xe8\x00\xf9\x8fU@ѐ{\xb6\x83zD\x89\xcb\xee\x17\xf4\xab%\x9c\xbd\xd5g\x87\r\x88\xdd\xe1\f\t\xb26w\x8a\xa4\xc0\x84\x95\x8d\x04\xac$Dg\xe1\xd8(\xd15\x16\xcdjJ\xbas\xc5\x0e\xf9\xec1;\xaa\x83\xdaT\xd1\x10\xa8\xdfŝe\xb9ox\x87o\xa0\x11O\xea\x11\xa7\xcc*Ԥ\x12\x01\xaf\xbd\x92\x91J\x896+v\x99\x05W\xb8p\xd5X=\x94\x1c,\x87|\xf1\x0e\xf0\xc13\"\xcd\xe4\b!\x80)\x13C\x02\xa7\bJ\x14u\x90\x8e\xac?\x91\xad\xa2\n\xa4\x18eCR\x9c\x18\xa3#\xbc_=o\x12\xdcݯ\nBG\xbc\xacu~\xff\xbe:j?_\x00\xc7\xfc\v\x12/\x0ea(\xef\x13K\x9e\xa5\x8b\xc5×\xf6\x97Zj\xaa\xf6\"ˉ\xb0\xab\x0e\xdd\xf9\x18\x1dr\xfcA\f\xeb\x1a\xb7\x8a\xf3\xa7\x06g\xb1CR\x8f\x81\x83\xa9\x0fճ\xc1\x86\xfc\x95\xc8=\xea\xce\x01{ģg\x8b\x81lDB#&\xb3\x01\xfb~{^\xa7O\xc1\xc2aןI&\x83\xc8$\x10\xe0\\\xf9\xb6\xc9B\f$j\x99y\x14\x1fO`ӳ\xac\xd6\xe4\x1f\u007f,>Y\xa3\xee\x0f\x99\x11\"\x82\xd8Ano\x17\xec\xf3ś\xe4b\x8f\xaab\x90w\xd4\x14\x88\xd7&\xc3\xcf\t\x15'4E87\xfdu!\xac\xb1\x8eRØ\x13\x87\xeam͇\b\x13\r\x18\xed\x12\x95\x8d\xb4\x898\x18{z\xd3p\xa2\xdck=;\r\x94\xb3*ְ\xc7h\b\xec\x8a]<*\x96\xb6\n\x1e\x1d\x12\xff\xa57\t\v\xdaBQ\x88a\xc4>\xfc\x8a\u007f\xe3m\xe7ƾ\x1a\x8b\xa5\x88\v\v{2\x86da\x97\n\xc2!\x03\x86\x80\xab\x83N\xd7\x01Sp\x19p\xf4@JAE\xa2\x0f\xdc*\x8c\xc8\xfa+j\xc8\x1cbP\xa6Z͗\x8a|0i.\xd8\x13^BV\xde\xe3\xc8\x14\xf0%wP\v8cuw\x83\x15\x1b\xfb\x00SZS\x01\t\x82\xe4b\xa1ke\x9b\x8a\xd0\x15\x95\xa2(\xc1\x0e\x8d\x04\xaa̵\xbb\x83\xb5\xc0\x90\xbb\x16⎭1\xf5J[R\x9f\xb0\xa2^\x89L\x11<\xf2]\xad\x99\x15A\xfb0UfE \x1exj\x0eT6\xfaD\xb35-\xa1\x12\x17r\x1f\n(\xb8\xf6\xb1\xb9\xe5\xceG\x82\x02~\x01[\xd0&\x84\x80 \n\xc0\xf5\xc4P\xaa\x1f\x85\xbf\x84&*\bw\x03ể#sq\x8e[R\xf1\x1cu\r\xf8\x80\xc1\x8f\xb1v2\xfd&r\x9c\v?\x94\x11$`§\xb4\x81,C\x88)\x937+\\\xa27Ǌ]\xf5\xf9\v\xd9*L\xacl\x9a\xca\xc9'>-\xdb\xf81\"\x81\x1bE`\xa0 \x91lx5\xb6\x92\x05°\xac\x81t\x15\xba^\a\xb2\xa3B\xa0\x0fH\x04\xe1\xca\xffQ},\x83af\xf0)I\x8dHT32\x87(\x80\x85\n;\x15\x9e?\\p\xb3\n\x9c@\x16\xa6\xd9i\xc1\xc0\x1bޔ\x97\xb3k\x0fR\a\x9b\x98\xf6n\xc4\x18\x9b\x1cM\x95F\x96C*y 1\xfdR\xbb\xc3 z\x14GjjW\x1dx\r3\x19\x0eH\xc7:\xf0E>3t\xe8{\x12ij\xab\xdd\\\v\x06E-\xf3^\xbb\x9cES7\xfa\x06,\x86\x8c\xe0-R\xacm\x03\xb57\x94\xb3AD\x84\r\vl\xaf$E\xac\xf4\x8b\u0380\xc7N\xc0\xdc\x12\xcb\xe2XSw\x00\xf5#\xff\xcdv\xa0ÞF\x02\x1a\x93C~\t\x10\xa3F4\x8a,\x0f\xec\x8a|\xd8bF\x82\x11#\x03\xce\xd9\xeb?\x1e\x80\x00\xadF\x96$\xf5\x95\xd0xq\r\U000563f9H\x8d\xfc;\xe7CT\xe0\x98%h\x02\x06\fӥ\x9b\x85\xa5\xc2\xef\xa5\x10\xf7]\v\xe1#\xc7\xc0W}\x8b\xaa\xe4\xfd\xd7\x04\x06\x9f\xc3ƈ\xc9@`\xe5\x19x\xa9\xf6\r\xf8+\fEu\xdc\xeeZ\xe2\x05\x0f\xa2\xcf\xd7\v\x1eN\xe3\x14\x06(\xbdJ\x86\xde\x1bKT0K_(Z\xf9\x16\x96\x98\xef\xa1\x16\x17Fs@$\xae5R\xa5\x03\x93\x84\xee%xq\x04U\xb3((U\x00h\x10\f\x1aN`\x916\xdf\xe1\x9a\xebA#\x11(\x82\xea\xbd,z\xe9hĐc\xab\xa4\xa4\xd0\xe6\x12r\x84\x02+?Ac;+`Z\xd8`\xe8ן\x0e\xff\xe1\x97g\x8eʚ\xc1\x1a\xe1\x89LV\xa2\xaa\x8ai\xcb\xe1r\xa1\xa0\x8d`\xe6c\x03\xb6\f\x86\x03\xc6\br\x02\x8d\x9f\xec`\xf0\x80\x84\xb7\x1d0Rs8\b\xba\xf4H[\xeaˢz\xa0\xd8\x16\x17ۄ\x96\x10\v\x15\x93qɤ%\xec\x82.30On\x16\x99\xe5\xa6)\x19h@\xfb@B\xe2ƃ\x17\xbb\x11\x04\xb8\xc0\xcc5!F\x139\xaa:\xc8ZAo\xdf?\x84\x9c\xa4\x8a\xe1\xfd\x91P\xb8\xa2\xfaH\x1a\xa9\xb8\xca\n\x814,\x19\xc6\xe3\xa2\xe5\x10q\xa4\xe4\rn\xc4\x105\xec\xa1\xfc\f^\xf7X>ui\x16o\xa13\xa0\b\xb2'\xbd.\x10Vcq\x1f\xa4\xabZ\xf6\xac\x9d\xc0E\xd5c0\xd0qmv\xe4\x1bczE0\xb4]鬳\x87\xbe>\xc30HÛ{)jl\x0f\x10\xe8\xdc\rG-\xc0Dw-\f\x9f\xd84\x97\xb1j`{\x12\xf4\xa9\xf9\t\x98-\x10WJWNc\xf2\xa1h\x12\r^\f+}O \x11\xa7E\x1a\xebe\x1b\x83\x8d\xd2\v\x88zw5=Ҥ\x8d\x97\xed:\xc7-\x1ba\x9e\xaa\xa4\x8e\x1c\b\xca\x1d\xb5\x0elb\xfaT\xa5A\xeb\x15M_]d\xda\xd5\xcc\x06\u007f\x03\x04\xb7\x03`\vf֫;\x05S\xb0\x9a\x10\x9eD\x18\";.\x15\xabG\x93C\x01\xfd+/%\x83$\x96|¤@\xff\xdc\x03@\xa0V\x95\xe3s\xd1\x02\vdǴb25\x9f\x80pM\t5\tGW\x94\x1d@\v\xab4\x96\x12^\xcb\xe8?$Yњi4J\xfc\xa0\xb2\x88\x13\x9b\x01\x06\xe8q^7U\x83ó\xc5\xd7\xf0V3(h\xea\xe3p\xd9HA\xeb\xeaS\xe6MJ`\aй+\xc65\xa3i\xceT\xdd,{<\x9dާ\"\xb4\tA}\n\xc4\a\xcaOu\xbdԅ\xb8\xdc܌\x88\x937\x9b\x11z\x06?;9\\5^\x8a\t\x0e\xee\x90Ԅ\xf8\xda\x17\xbf\x1c\x05a,\r$zX\xcf\xc1\a\v\xa1\x87\xca\x15i\x04qܚ\\mQ9\xef$\xa2\t\xa5\x898G&;\xfc\x8b\xb1\x0e\xa8ٙ_\xf7d\xe4g\xfb\xd0\xc0\xec\xba\xe62\xc4L\x93P6@\rW\xb1[\xbcExS\xb8\xc8\x0e\xf0\x1e\x1c^\x18.`\x92A\xce\xd0\x0e\xf1\x02D\xfb\xe7\x80+F\f*XET=\x03\x1a{\x0eM\xe5Am\x1d\x02\x9c\xe1\xb0\x01[\xddFr\xf0Ht8\xa4ʑ`.\x17+\xdd\xf4\xd9y\xe0h*\x91\x86\x10(\x92T\x13\x19\x0eY\xa3\a1ǁ,\x06P\xd3<G\xb9'\x83\x86[L\xaf\x12\xf1g\x05ے\x80\xa6\x11\xb5\xf9uv\xebO\xdb\xfb\xdd%\xff\x00;\xd7\x0e\x8b\x00J\xe7\x1b\xa6\xf1\x95\xbf֭\x02z6?=[\xb9\xbf\x8f\x9e\xadҳ\xc2O\x88\xe6\xaaF*-\fq\xb8\x89{\xd0@Շ94\xcb\xf1\xadx\x83\xf8+\x1f\xc2o\xa8HT\xbc\xe1\xac\n\xe0\xe8\x81p\x8cN\x0e}i\f\x8a\xbc\x0f\x1e<\xd2\xd4F,j\xaeA\x99\vS\x87\x11$\x87=\x96[\xb8L\x88U`\x14\x00\xb9\xea>\"\x03\xb2:z\xa8jH\n\xae\x81\x06:\xd0\xe2\xf0K\xdc\xce$\xe0\xd4\xe3\tZ`\x04\xeb\x92\xc9\x13\xb8\f\x83&\x9b:%\x1a\xdbeـ\xff\xd0P7H\x06\rc\xaa\x9a\x93\x0f\x89\xeb*\x8as^\x15\xb3y\u007f\xb8\xbdi\x12\x88\x85\xe1Ul\xa3\x1a\xe1\xa1v\xc9^oA\xb4N|\xc9m\"vC\xd6\xe4\x11r\x94\x80\xf5\xd5]xKČ^\xc1\xa9\x82\x84\x04\x1c\t\x1e;w\x8e>\xb9\xa4\x98j9K#j@OUӢ\xa3\xb1[\xca\x16\xc2V\xb5A\xd3\x16\b\xf6\xf0&^\xac \x8a\x81\xa97J\xb1=\xfc\x8eK\x86HP\x93\x00\xe6 \xa0Q\xbe\xe0=9\x19\xdbf1\xed\xd3=\xc7t!3\x80\x06\x97\xd4\xde\xe6\x85`\x06\x90\xccƢ8Yh\x9c2\xaa\x9f\xa0\xa8O\xdd\r\xa9\xf9\x89\xccIdB5\xe0\x9c뎵ˮs\xdf\x15\x16y\x03\xebW*-\xc4\xdb%e\xd7y\x95\xf0\xf0\xc6\xf3x)\x1ar\x88\x0f4\\\xab\xfd\xc5\xdbk\x19\xaf\xda\tl\x90\x86.4\xc5Jh\x01Qj\xff\xfbC\xd4/5պ\xdc唃\x05\xfa@~\xb5\x02\xd9\x1aM\x13\xa1\xec`\x03\xf5\xe6Y\xcfa\xe3\xf5\x98\xd8\xe2\xed\xf0\xe2^\xb5p\x80\x02Aw_\x1f*\xc1h\x04AT\xdb~\xa92\xba\x83\x140i\xb6\xb0\xd8U5\xc3\x1a\xb1\t\a3ߔn\xa3\f\xa7\x89\xc1N\xf8\xa81F\x02\x14d\x90L\xf0f\xc5\xd3\x10V\x96\xed\x91l\x10_ǿjRj\x0f$\x1e\xf1\xbcbȪ\xe4\x05\xe2$\x05\x97\xdeČ\xba\xad3\xcbĨ1Z\x11a6\"\xbc\x9bXW\xe3\x93X\xc7\nC\x9c\x85\x8f'&\xd0\xe6\xfc\x01[&\b\xc1\xf4\xa0\xae\x1c$\xcfŌ<mE\xf6\x1f\xf8\x03\xfc`z\xf4I\x97\x94\xf0\xd80\xf2\x82/\x1d\x8dr\xe2\x8d3\x9f\n\xce\xf3\x16\xf0\xec+\x1a@\xe2\xd5i@Bu\xbcB\xc0@\xad\xfc\xa1\x12\xcf\x06\xaaφ\xe1\xecp\x80Uud\x11\xb2\xaf-\x1c\xba\xb2\x94;\x95\xa5\x84-` \x85\x853\xcd\xe9\xa2\x11pc(Ud\v21 \x9f?\x9c\xc0\xea\x02&\x9a\xc1\x06\bT\xd2U\xcf\x05\b'\x0e\t\x85\xb2\xf1M\xec\xccd\xc7\x1c\xc8\xc9ژ\xc6]ԅ\xaf*\xe8\xc2Ͻq\xd5\x19\xf0\x90J\x93;\xca\x03|\xc0\x0ft\xf5\a\xd4&?j\xd9ȧ\x80)d\x80۪\xafe\x00\x18t@\xf1\x93\xa4j\x86\xaa\xef\xc1\x8b\xfa\x8cMZ\x9bo\x01\x80\x17\x1f\x18G\xdf]\xd3L\x1d\xed\x15\xa3\xa9F\x06m\x16(\x1bq\xbcÄ\x1e1\x04,iҨ*\xd1\x06\x84\x06\xdc4\xe0F\x88\x1e\xd6Đ\xaa\xe0\x89n\x9d˷\xaez\x1f\xa8AN\x8f[\xa3w\x80\xbbZ\x18X\x1b\x81,\x1a\xf1\x9f\x9a\x04(j\x81\xa7\x145\xd8~UHxrZCS\xc8rr\n*x\x8dP\a\xf0\xa3Q?\xc0\xafs\x1c\x1f]|\xbet1$\x04\x94\xc7m\xa0\xecA\xc4\x11\x92ǆ\xda?y\xb0\x90\xfc\xe6\xd5\xf5\xb6\x93\x1c4\a\x85+\x80\t\bS=\x0e\x8b\xa9y\x9b\xcc\xf6h<\av\xed\x0f\x8d1\xc6\x13W\xea5\x87j6T\x82\xc0<\x1c\xe4\x1b\xd0w\x9c:\x0e1\x94\x8aؾ\xc6\xf6Wvi\xedS\xf6l\x91l!E'\xd8\xc8oh\v\x15\xc4^7\x9a[\x9f3*\xf9CT\x8b\f\x19\xe3\xc0M<\xd9\x1b\xf0\xf0\x9ak\x9f\xe4HF\xce\x00\x15\xbc\xd9\xe9\xf2\xd1i`@1\xde\x1a\x8bI\xb8\x01\xafB\x84v\x17~V\xb7\x93LBC\xae\xb3^\x9d\x13\b\x9b\x9aZ\xd5\xd9\a\xf0\x1e)\x88\xe8\xd5\xe8\xed\xd8\x15w\x1d5\xf7\x8c)PM\xb1\x99\xf8\xda\xcb騕\x185\xff\xe84j\x05u\xa0\xd7\xdf\xf4\x81\xea6\x188\xb2j#v5\xe6\x9eG\xc0_\x91 \xec>\x06\xab\xae\xa9\n3\x19\xa3~\xd7\vٶ:P[\x04\xbe\xab\x92\x9c\x057\x88V\xed\xa5I\x9bbz\x91\x9a\x9e\x8cX\"\x0e\x04\x8b\xa9\u008cJ\x94\x80\xcf0\x81\x84\x1fb3>i9\xd0GZ60\xa0k@\x1f\xe0\xd5T?\xe4\x8e\xc7 \xf7\xcf\xf6\xc8I\x1c\xf3\x90\xf1\xde)\x1ek\xf2\x860\x18 \x95d\xe8\xc6\xdb\xcc\xe9&&j\xe5\xacq\x97\xa61\xf0\xab!\x9d\x04$Oe/\xe9\xeb5v\xb5^\x1bu\x93D^7\xdev\x01QV\xa8\x89\xec&c\x10\aγb\x9e\xd5\xe2\xea\xd4&\"\xd1g\xd5a\x03\x18\x1fge\x9a\x191\x04\xc3\xd5D\xc8}b\xa3kZQ\xee\x13\xefc`\xe8\x14C\x1eW\xdf\xf8XƬ\xa0N\xed\x8a\xc0\x14Q[=\xa6Q4m\xf1\xa6 H\xb0:\x10o\x80\x92\xa6c\x01\xf7Ċ \x1a\xc18\fsj\x8e\x8d\x98$\aF\x10\xfcP\xe3\\\x9d7\xa8\xc0oX{\xf1\x13\x82\x02\x145i\xa0\x80\x86\xfd\x90\x101\xe1F\xb8=%\x9a8\xfa\"F[k\x1aue\xd75Q\xcaz\xae\xa4I&\x82\r\v\x92\xd47V\x11\xb2\x82\x92l\x0e\xdaTeѮ~\x8bƺ\xe0\xa9E\b\x87\x11\xccݤ\xd3fl\xe2QGgh2\u007f\xb2\x02\xbc[`S\x12Ɇ\x8b!o\x913|xW\x8d)\xbd\xb6\xcb\xd62\x8f>\x04\xc3v\n\x0eR\x91#\xe9\v\xb2\x16/\xb2\u0091\xcd\bHs\x01P(\x98n\b9b_\xf5<4\x1aۍ\xb6<p\xc8\x14kr=\axw\xf2\xc0M\xed\xe4\xfd\xcan\a\xab\xcb\xf5\x0fl\xce\xeb\\T\xa2\x98m'\xf44\xf0\xa9\x91z\x90\xb9\xa8RAڨ\x9cD\\q+\xb39,4I\xd6\xd5\xc2\x0e\xb7\xbe\x8a\xa5\x0e=Ն\x03\xe0\xdd3Y\xafr\x96\x1a\xc1\xd6T\x82\xaf\xaa\xd0K\x17ɱC\x88\xe3\xf0\xf6\xa5;\f\x81\xe6\xa7;\x8b W\x8d\xbc\x1a9! 4O\x8f\x06Qxq[\b\x16\x15\xadg{\xf3\x1d\xe09ڗ\xb0\xe0U\x87h\xe1\xea\x8a˵\xabs\x0e\x1a\xac\xf0*p\xc0\x14\x1e\xe8M\x85L\xcd-\ft\xdb@\x91\xe6l\x12\xf5\x8aҠ-\xceR\x06\a\xa4\xf1\x97;\xae\x91-\xbc0\x9dK\xde(?\xb9\x05\xc1\xf9\x91Q`\xe3\x81l\xf4\xfe\x1b \x9d\xa1\xb3YH\xe0=p\xd0\xeb>\xac\x86\x13\x9f\x9df\x8dBU{\xb1tiR\xb68\x12\x93\xe7Sv\xf5\xe3¶SdZ>\x13\xadEe:u\xfb\u07b4\xbeJ\x1f\xa6\xa3\x19\xe74\xc7\xe5\xc2j\x89/\xbd>7\x84\xc8J\xde\x1b\xb4\u05f8\xec\xbf\x10\x83\x1a1\xeb\xd5\xdfn\x8c\x18\x85\xcf\xf0\xd5k\xf9\x80\xc3x\xe1\xfe\x9c_\b\x9f\xe3\x02\xb1\xbe\x98\xba缹\x1c\xafpe\xe1\t \tA\x95\xa2b\xd7&h\x04\x1d\xe9\x14-\xba\xae\xd3z\xd84\x05\r\xde[%Ҡ\x9e\x14R\t\xae\xd1<\xa3i~,jF\xa1h\r$\x03uV\tf#\x8d4\x15i\xc8a<-\xfa\x0e3-\x85B\xa8\xa8\x97u\xfb\xe2\x12\xfc\xd6a?1\xd4֤\b~:e^\vM\x13?0u\xceE\xc3c\x97\xe01\x96\xbf\xbb\x827ڸ\x01\xb5\x84\xa1\x1b\xf2K\xf4\x01\x18\xcb'\xa9\x98\x1e\x1aK\xc1թ\xf6\xdbe\xf9-jt\x89\x98ûu\x8d\xd1h\xea\xa5:\xcc>\x81+\bGmh65\xaa\xb0\x8c~~\xc2\x12,\x8dG3(\x1e\xbf\xa1\xb6\xb5\xaeBӑKg#\xb2|\xfbί\xb4\xe7p\xe5\x1b\x83\x9e\x0f9\xde0\xc8=\x9b\xf0\x03r\xd1TXF\xb7\"QfFL\"D\x90'W0\x14EMM\xd3sCl\xac1<\x17\xe1\xea\xe3\xa1\r\x16\xdd$\xfet@A3<\x9b\xb0\x88\x1a\xac\u007f\xa5\xfe\x81x\x11?]\x90\x04\x89֕\xf2H\xb77\xed\xc1\r\x8f\x04B\x0e\xd43\xf7\x8e\x19\xf7XGȢh\x95\x96\xfa\U000ea6c0\x13y\xb1{\x9a\xaf\xd3$,\xaf\xac\xe0K\xfa\x14`\xc8\x12t)y\a\xd3\u0086}\xfb\xad\x8aDNj\x95\x97\x8c\xa0ynޫ\x9f/\x94\xe0\xfe\xf3\x82\x0e >0\xe2\x8dԢ!U\xb8n\xb2J\xb8Gg\x83o\x9b\x12\t\x9e\"\u007fΛzO\xdax\x83\xc7߄e\x92\x15\xf3:\xdaĿ*L\xf4a~\xe8|\x86\n\x85k\x10\x1eج\xa0IP\xfc\x0f&$\xc16\xeaX\xf3\x16\x1dfϺ\x8c\x03F\x10\xd2h\v/\x99\x85#X\x1a\xcbՄ\x87:μ\nt\x86;!H\xb05\xa3\xe1\x91=\xe8 \xa0\t潲P\xe5\xf5\xed\x91\xff:Δ\x82\xe6\xfcԵ\x9c\x81\xb7ѱ\f\r3@\xb7\x1d\x9d\xd4\xebQE+c\x8f\x91iU'i@wD\x92\xcca\xad\x1a\xf8 \x87a\x90\x10\xf4yHD\x98L\xfdr\xf0`\xa2!p\xa3\xe8\xd3\x03ܠؒʹ\x88F\x94\a(\x1d\xd5>!'Z\xd61.\x8dlTBP\f\xce:\xfb\xb4\xd0} :<\f\x1c\x02\xa6\x01rR\u007f\x1d\xa8t\xd8KbC\xe2:7>\x0e\t\x94\xe4\xec\x88f\x8d\xeee\x14<\xaaOuf\xcd,\xe0\xab.\xbbÇ\xaa\xaa0q\xcf]\xc7Y\x94\x8en\xe0.\x90\xde=I\x94\x14\x1d\xa3\xeadMRC{\x06\x14\x03\xa1\x88{\xd1QO\x02\x1eL\xf1:\x04E\x1c\xc22\x9aZC@\x1f\xf8\xa2+ \x95u\xf0J\xd0P\x18\x9axi\x84\xa4\ru\xfb\x90AC3\xedj\x83.\xb9\xcb\xc8~\x85\xa62\xe3\xc0\xb0\xb4\xabQ\xb5\x9b\b\xe8\x95\x1cɒ\xe5\xadچ\xeeK\x92JJp\x11\xabbSs@\xb8\x8b\x91\xb18\x1axBRi\xb6b\xaa\xc3A\xd0\x06\x040b\x0f\xa5\xd3nu\xac\x84\x8eg\xb0Jȯ&\x83\xae%A䱿8\xab\xf4ƈQ\r\x88V\x9d|\x89\x17\x97\xe3\xd1\xd7\xfc\x01\x17\xb3\x10\xb5\xf6\x0e踅]P\xe1\a\x1d\x1a4z\xa5\x83i\xcb\xc3^\xc8)(\xed\x89\x1c4'\x1b\xdb3|C\xbe\x83\xef2sU\xe57\xccw.\xec\xc7LN\x93<]\xb5x\x8d\x14\xf47\xf9\x83\xea\x05\xe1\xba<\x0e\xf7Wul\x92ue\xa9\x92fD\x04\xdf\xec\x96<\x8fJ\r\x1aBj\xa8/\x87\rm\xe9Us\xbc\xb7\xce2l\xa2\xf9Uٹ\xd72T)\xaa\a\x97wߐ\x12\x90\x80\xad\xc20\x83 x8Mg\xb3\xc6\xfc0\xe6\xf8\x1d6Թ\xecP\b\xee\xaf\xef#Ӿ^!r{/\xe1\x05\x90\x01aT\x9b\xba\x94\x199\xcf\xef\xed\x1d\x13A\xdd9\xa1\xb4\n\x9c\x9f.\xc4o\xb5$\x94\xfc\xfd\xe3\x8f\x1d-\xfc\x848\xd3\x05\xb8u\xc8\xca\xc9-\x15\xc2p\x92\xfd*\x91C\xbc\x88\xa2\xb4\x90:\xa3\xa9\xd7\xcez\xe3\xbd \xe4ٱ\xfaoHQ1\xfcjU\x9a\xa4Ǧ\x99ί\x1dL~\x95\a\xb4yd\x9cH\n\f\xa2F3p\xa0\x80t\x03xttI\xb3\xc9]J\xaa{\x04E\x12\xbf\x982\x98\xdf4\xb9\x18\x1d\x98\fM\x83BWz\x03\u074bܩ\x12\xf7\x02@\xd28˧\xb2-DvѸ\xa4EX\b\x9f\x1d4%KZ\xeaP1\xef\xe8\x1dL\x88!OjV\x98\x8e\xb2)\xaa\x8b:l\x01\xb4\xc7\xf5\xaa=[UR%\xddu(3\xaa\f\\\xaaZ)\x04\xff%\x8d\a\xee\x82\\\v\xac\r\x1b\x92\x04z\xb8&^v\xa7\u007f3\x04\x05]C\xa2\xa2\x8d\xb2$mdK4\xba\x9b\xa5(t\x1a\xc14E\x9f\x10\x1a\xb7\xa3>\x1c\xc2\a?k\x10\v\xd9OJ\xe9l\xad\x8e\x99\xb1M\v\xf1H*j8p騉\xe6\xa1T\v\a\x8cT\x90ҴKՙZ\xee&\a\x97\x01\xa7%2\x93ϩf:\x05\xa0Y\x80\x132\f\xa8A\xb37\x90\xa2HC\xea\xf6 \x1b\t]\xab\xe0,[P\xc94\x9d\r\t\xaelQ<0\v\x1fr\x8f:\x93Uq2\xa6b\x82\x86\xd2\xd8\xde(\x99\xf6\xca\f8\xb7-\x8fQǰ\xce\xcbs\xd3\xe1L\xdcΒ\x85\xa8q\x0e\x94\xa7\x844\xc2E\xd4҅\x8c|A\xb9\xcfm]\x14\xfa\x0e\xdctf\xf1ut\x83\f\x9bm\xaa\xbf\x8b\x10\xf1\xe0\xc0g\x88\x19\xe2\x9a\xea\x84\x13\xd9״Gw\xaa\x9f\xaaY[\xcdaǪ\x16\xe2\x91P\xd3\tdU\xaa=\xa6\xcf_\xcd\xcd+0N|cոA8@\r\xe87ŮA\x1e\xac\xfb3\xf0I\xae\x9f\xafUd\x06K\n\xca\xee\xbe\x05\tI\xbd}\xe4\x8e\xca\x1e\x90Ǜ\xc8ty\xea\xec5\fJh\x03\xbf\xec\xf6U#;\xeaH\x1d*\b\x9bL\n\xe8\x04\xa3\x06\x02\xb1%\xe24D\x86l\xa6\xf4\\RCq\x90\xc8.T\xf0\xf0\x9d3\x03\xd1\xd2\xe7H\xe0\x9a\xa0\xf9;r\xb0Tc\x1f\x98{lo\x94\xed&\xfe\xa0\xe2\xabJ\xc9\xd6\xe1#\xd5\xe4j \x02\x9c\x868\xa1eu\x0f..\fc6u\xa0)\xa9nW\xc1\xe2i\xf2|j\xefq\x89f\xe5\x9á\x06/6WF\xbeCX\xb7'\xf0\b\x9cd\xe74\xb6\xafA4\xf5\xbd\x8eʕRX\xb0\x12\x02Q\xe7\x8c҆\x16\xe4\x9a6D\xc1\xdaB\x15P;\x9f\x8c\xebn\xc0v\x1fN9\x84\xadQ:k\xa0\xf1\xf0\xa6\xect\xb4>\x11\xcd\xe4T\xc8\\\x0eZ\xa2\x1f\x1d\x97\xee\xef\xb1!Q]>\x9c\xe7\xd6\xd82k\rhU\x97\x88\x1e\xcd!\x13\x81\x9a(G\xfe\xe9\x98b\x10+&HSr\xa8\xa9\x1b\x88\x1cF\xdd\f8\xb3iԥi\xc2\x02}\x84\x9e\r\\W\xf6Ț\xd4ԙP]1\xa9\x00C\x8e\x01r\x86s\xd2pC\x00\x19\xd8\x1c\x94\xfb\x8e\xf0:\x000\xf9\xb1\xbdc\x91\x88\"7\xd4\xf0ǌ\x0f\x16\xbbf\xb5\x95\x0f\xdf'\xd7խG&\x81M\x93\xd0\x04\x86\xc0C\xac\xd0\xd4i+K\x9f)\xc5w\x8a\r?x1\x04\x8d \xa9\xecFv:2gr8`\xcb(\x1a\xb3ʑ\x1c\xac\x9e\x85\x01\xeb4\xd1\b\xabU\x9d\x19\x05j4\x93&\x17\xb04\x95\x96\xf1\x12jGE\xdc\xcbq\xec\xb7\x0ef\x03\xe2\x19,ˀ\xfb\xee\xeah\xc0ְ\xfaՁ1a\xff\xd8jGpajb\xbc\x83~\xfc\x1d|\"\x97\x8e\xa8%;h\x8a;\xe7\x1f\x9dt\xd6h`\xed\x1fb\xfb\x8d־\xa6ow\x06\x8e\xfe\u007fy\xad\xa9\x1f\xb1eM\x83JV^)\xa8N\xeb\x99:p\f\xf4\xb0g\x91T\x9bS\x9di\xa42\xdaZRZ\xf5\xcc\xf8\xea\b\xe7\xea\x89\x12\xeeQ\xb3\x06\xa8\xe4\xd3\xd2\xe7\xac#\x9b\"\xea\xc3<i8b'0\xe8u\xc8\xe0\x89\bf\xa9{\x11\xd48A)\x80\x1d\xe4/\xf6\xc3\xe9\xa4\x17\x1aVS[c\xca\x0e\xa9\x1e\x8a\xc2\x027\xfa\xcc\\5\xbaD#\xc1\xef\xc4h\x1b\x86i\x94\xb4En\x98N(\x90\xba\x9aPD\xfaq\xf5\x10*l\x184\xe9\x1a\xb1h:h\x97\xb3\x8eŒ\xa9\x13\xda#_l\x93aW\xeaXǳ\xfa\x8cj5\xd9\xd1\xd1!\xf1g:\xac\x91\x8e\x04B\xcf]\x17,\xed\xab\x0e\x01>@\xa7\xa1\xce~SҺ\xd6\xf7^\xfc\xaf\x00y\\\xb6\xd2\x15\x83\xc9\"B\xe9S}\r\xa7S\xb2:\xa3\x8b$\x94\x96\xea\x9a\x15\x82\xa8\xcf;\x9e\xa7zMK~\xe8\xe0\xa2:\xf4=\xcb\xc5D\xf3\n\xec\xf8\x9e@\xa1\x19\xa3\x89\x93u\xb5\xe8h\xbb\x8a\x9fU\xa7\x86\xd9`\xf2%\xaa5\x84-\xdb\xc00\x06\xb2G\xa0\v\xef\x87}ʵr\vW\x05!\xf0\x1f\x16ȚJ\xc4Q\xba\xc9V\x10\x92\xa6y~v\v\xbd\x1e\xa13Ӝ\u007fS\"\xf0\x13\x00H\xd7s(\xa2*cH\x11\r\xd9+`\x82\x9a8\xe0\xdd1\x96K\x8f\tQ`t9>\x85ʉ:V\x8b\x85\xb8\xe0F\xd6\x13\n\"~r\xe9i\x04\x8a|\xd3B\x0e\x8dI\xeay\x17z\x88FR\xe9:g\xa7C\xd6J\xe9+n\xc1\xc0\x89\xd5\xc1G\x18|\xc8,\xe2}\xa0\x04\xde\x04\n\x12\xe2\xe8I#\xcd\x02\x1a?\x0e|\"@\xa5\x88(~;\x91\x00\xa2\x80\xbdN\xf5D̝\xe2\x11k\x9f\xc9-䩊\x9az$\x87\x06\a\xd5sW\x8dn\xea|\xba\xfe\x905\r\x8b\x83\x14\xc99nDg݊\xe9\xb4\x1bQ\xa1\x87N\xe0\x82\xea|\xa8\xd9M\x93\x91:d\tFa\u0603-OP\xab\xbe\a\xd8w\xb5\x12\xd9k\x88\f\xe5\x1fe\xe4\x0fr\x1b8'\x8e\xa1\x02\x1c\x92\x9c\xc6ԉ\xfc7D\x81\xa4V\xa1\x96d)\xfe\x8d\x81\xe8@\xc8b\x19<ڟ\xb5E\xbd\xa8\xbb\xae\xa7\x9d\b\xf2 ъ\x0e\x88p\xa4Nn\x16,gҠ!\"\x88H\xc3<@eCp\xa9\xc6?7\xb35\x90\x80\x92\"\xff@\x1a\xb7\xc0!\x9d\xa4R\x13\xed\r\x8f\xd5\xe2\x81\xc2A0\x01۞ \x89\xe3\x8d\v\xfb\f\x03 K\xde\x11\xd3ې\xfd\x9a\xab\xd6S\x06\xb0\x11-8\x82\x8b\x84\xae\xdc+\xe1\xb257\xf1\x06\xeeuʋ\x88\xb8\x9a{\xc2_,\x10\x988'&U]\\\xd8\bMug=*D\x15\vԗcQ\xbdJ\x90\x9aw7^\xa0\x03\x10z\x18\xc2EȒ\x81\xec\x1b\xde\xfd=>C\xb3\xe8=+\xf0Հ\xe5?\xc0}ߦ'I\x14\x99\x9a\xada<\x8dU\x00\x0e3FRU\xdd\x0f\xd2S&[\nS\x8f\x85\xf2I\x05\xa1\xac(\xecu\xc5\xc0\x8f \xb80\x96\a\xc0\xd4\x11c\xae\bq\a\x14ި\x83\xbf\x88f\xd4ݜ\x1a\xd4\xd1\x19\x868$\x8f\xd5\xe5\xfcy.\b\x8a\x05\xb6\xce\xd2i\xf7p=Y\xa7fpt\x1b\xe4k:7\x1fCl\x15vѣr\x92$\f;\xd9%\xceJ\xaa\xf3\xea\x98\x17\xb1\xcbO,\xb9ǪBwU\xcb\x16\x17\xd6T\xc9q\xa5\x9f#\xcd\xefC\t\x9an\x00.4\xb1\x92\x8ey\x11)\xee}\xe0\x1c\x88>Le+\x01\xae\x1e\x9a\x96\xd7\xc9Ȩ\xb5\xf6H\xf0\t\xc2;\x9d7\x1bj\xf6\x1f\xec6\xeb\xadiA2\x06\x1cGB\xe8\x99,S\"\x1f\xf3ł\"\r4|IJ͋8i\xc5.\x1aZ'\x14\x90\xc7\xe4\xdf'Q\xa1\xd1y\x81\x84y\xd5\r\x02\xf4T\x01T\xf7\xaev\r\xd3\xe3\xdaU\xd2C\x95\x1f\xb5\xd4B\xbaU'\xea^'7\xeejđ\xda|o\n\xbe}M\xca\xcfϤ\xbcT\x8b\xb5#\xb5\xc3'\\\xcd\x17\x80\xc1\x04\xb0\xba\xa1\"cMn\xf3\a\x18\v\xa2tAc\xd7|S/\xfb>\xc3;\xcf?\xfd\xa4\x9eB\xf6ן\x15\x89~~X$\xea\xde\xcf\u007f\x9du;\x84\x85\xce0\x91Vj\xb4N5\x86!\x16\xf3\x1a\xf4yG\x94\xabu\xf9\b%~\xd1\xccU\xd2\xc30T\xbe\xbd\x8etR\xec\xeb\xec>8\xfff\xd2\xf9\x98\xa5fߝ\xc0\xb5NWa\xb3Ƀ\xad\xe3P:\x96N\xf6tMY\xbcf\x10w\x1d0{ۡ\f!\xc0\x0eG\xe1\xc4APU?T\xffF\t\aSa\au\r\x84\x00\x1e\xe1\xf6-\xde@\xc6\a\x9c;\xe8\x8c4\xaa\xa83\x85Up:\x9c\xa4i\xe6\xa3\xf9S\x9c\xe3\r*d\xab\xfa\xb1p\x90K\x93\xe9h\b\x04j\x11m`\xf0\x93\xe6\\\x05\xf0Q\xa2\xca`\xa8\x8e-\xf1N\xa3\xeeK\xcf\xd0\b:\xd9\x05&J\xee\xf9\xa8\xf3\"\xc87\x04\xb3\x1e>b\xa1\xe4\xa5y[\xff\x8eG\x142ai\xce5\x16\rD\x80\xc3\x1e\x16!\r\x10$\xa6\xe4\xe5\xbd4\x99\x8e\x141I\x10\x1d8\x89\xbb\xe9\xf1\x0e\x00\xa5\xfa\xd8]\x1dJ\xcd\xcb\x1f\x9d\x8f\xcdA\x1cҐ\x88\x18.\x1d<1\x9d\x81\xe5\xfa\xfd\xd0(\xbd\xa6\x14tH\u007fkF\xb2k,\x19\xd8K\x1a\xc9\xf5`,\x17\x8aw|G \xb2\x94a\x16\xe1\xe1c\x86\x03\xed\xa1/\xf5\xd8\xd9!\xdc+\t5\xf4\xa44\x9c3>\x8a\x1d\xe2\xee\x92\x1e\xee\xa1\x13\xff)\x86\x95\xfc*\x1a\x1a\x8bxeM\a\xe5\xc8\xe2\xabX74\xa5rpe\xc1\x8af0U-\xb2\x15\xcfԓ\u05fa\x9a3Xj\x98\x17,\x19\xb2\xbb\xaa/\xa1\xfe\xf3ڑ\xfb\xdf\xf2\xdcIL\vnf$6n\x9e\xc0S\xa7\"\xac\xe9u\x14C\t\bj\xce:+\u0382p\xf0z\xe8\x00\xe6\xc2\x13\xf11\x14n@mi\xc2A\xa1t\xd0GI\xcfLQ\xec܉%\xc6\xdd]\xd4x\x92\x1f\xeez\x06\x10\xfe蕇\xd9X\x1dX&\x04\xf0\x12\x98F\xf6\"\xe8\xfc\xdf\xd1$g\xdf\xf8\xb5\xac\xdeS\x18Y\xe7nq%y\xe9\x14^`\x9de8\xd8J9-\x9d\xfag\xc3A\xfe\xc5mq\t}\x0f=_&\xeb\xb1\x00\xe2\x17a\xb6\xe91=];\x95\x9b\x8ep\xe3`e8*\xf2VC^\xaa\x06\x1f͎\xc8&\xeb0\xc4n\x1a\x92\xf6M\xcf\xda\xf3:\xe1\xa29\x98\xe9F\xc0\xe6\xea\x11\r@yh:\x18Ƨ`a\x11\x14 \xef\xd6\xf3#p\x93\x9e\xf7\x92\x9d\xe2=PwYJ\x9b\xbd\xd4\xc1`\x8dުzゞD\x81\tSs«\x87\xa8\xc1\x10I\x0e\xfe#\xf0\x88n\xc2.n\xfc\xb5Nly5\xc8Z\xd14\x818\xcf4\x9eB\x16\xcdU\x9c\x0e\xf8\xf9\xa2SǄ\x1a\xeeH\xe3,\x98ƫ\xc7\x10@\x98\x1a\x8cV\xc7\x06\xae\xf2\x1a\xd2\x1d\xc14ó\xd52Yj\xb0 :7\xc0~\x1d\x89\x9a`\xf9\xa0\xc9,M[\xb4wZ\x1aD0=1Ġ%\x14\x81\xce\x12/ĳ\xcaq\xeb\xe6\xdd5h\xab\xa7) Ʒ\xd7!\xbc\xebPX:\x1d\xfb\xe0\x95ս⢮\xc7a\x8c\xa5wW\u007f\xab\xeeW y\xc3_C\xcfǐ\x04\\\xf2o\xa9DX\"\xea\x03\x1c`\x00\xb2kJ\x1d\xad\xaa\xb6\xb2\x06\x8e5|\x9fS\xe5\x9eqD\x1dӥs\xa2DƝ\x95?\x80\x84ć\xc6\vI\xc6\b\x1c\xe3\xe5\xa2c\xcfN\x83\xac\xc0T\x13\xb3Bڄ\x95\xe9\xdc!!\xbca\xf0w\x90ث\f\x8e\xa8\x04\x14\xf8\x8f\u007f+\x11C\xceh\x8cBO\x05\xcb\xee\xd5|\xb1\xf9\xe8\xf1\x01\xf0\xbd\x83s\\\xd3;\xbe\xba\xf0\x10\x13\x05\xa0\xa7\x04\xe9\xf4\x19\f@\xccq\x93h\x94\xf7\x04\x8b\x89C.*\xb9\xcd\xe5\xf4\xa43\xe2\x1a\x11\xa630E\xa3ꬆ\x86\x80U\xedG\xe4\xa3\u05f6\x0e\xb6\xb5%\x00\xf2\xf0`#刍\xd1\a\xd1\x0e\x00\x93\xec:\a\t\"\xe0M\xeb\xd1S\x9d\x82Z\v\xe2\xfc\xa9z\x16\xb7\xf4zi\xfe\xf5˫&6:\xef\x10\x92\xce]\xa9\xa2\x05D\x17\"C\x0f\xdc\t\x8e\xd8D\x1a\xa9\x05\x95\xe3U\x8f\b\xf4\x8b\x98\xea\xf1:\u07b3\xa2d\xd4\u007fƈ \xe0\xebQ\x8f\x83\\\xd9:\xe4K\xbe\x05Y\x0e\xf8\xbd-\xa7Ç*\xd7\xe8\xb1G\x9f\xdeZ\x92\xb4\x8bz䝆\u007f\xb1A*XI\uf1cb\xea\x19ln\xd119aV\xcc2\xed\xaa{\x15\x87ʎ\xfd \x9d\xa4\xed\x12\x1a\f\xf7\x8eG\xbf\xea\xb86\x9dm\x00n\xbc\x1e\xec\x82\xfd\xcaꃣaȧ\xac\xe7\beb\x9e\x90x\xcf\x11p\xf3ѥT\xccұ1\x15\x1b\xb7\x1e\xcdC\xd0\x01f\xf55\x86\xa6\x9e4\x95_e\x95\xfc\xd7!\xb4\xa1\xee\xf1\x02dգչ\xd3ˮ\xf57k\x80\n\x9b\a\x8aҩ\x14u\xe0Q\x93z\xcc\v\xb4h\xcazżj\xeb\xb0\r\xef\xf1J\x03\xc2\x16D\xc0\xab\xaa\"\x8f\xaf\x1eKW\x90\xb0\x12\xb9Pw\x16\x03Ƭi\x17@\r\t\xa53\xa4z$\x87\xd0\x1b8F\x16u=+\x828\xea\xbao\xc4<\x01u\x9a#\xb5\x82\x1e\xc7\t!\xbd\xcd\xd3\xd3q +\x9dx\xe8\xdc%\x1b\xc8-_@F\xa7\t\xee-W\x87J\xdecELC52\xf5\x19MfNE`\x16\x03\xe8A]\\=\x9f\xe8hr\xeeucq\x10\xe0'!%%\xf0\x9e<\x90Rҹ\xb1\xadC\xe3G\xc1\xa1\xba*\xd9=\xbb\x83\x87Y\u007f\xe9U\x9d_P\xa7\"a:X\x02Tm\az\xa3F\xf8\xbaiP\xa8K\xf7\x80[\x1a\x12+C}\x1cuҳz\x04\xa3\xe3E\x8a\xdc%\x01~P\xd9M\xb6-h\xa6+\x91F0\x8a\a8P\xa6z\x02\x13\x84\xc5\"f\xad\x0f*Q\xfd\x86\xad\x94\"ʤ(]V\xcbP\xfdУ\xd9&P\x17\xa0\xd5\x13~\x02q\xa52\x02o\x8d\x92\xd4Yp\x9d\xed\x87\x1fT\xf7Z:\x03\x87\xa45H\n\xc6\xc6\xf8D(\x1b\xbb\xc2½G\x10\xbd\xc6XQ[\xa4\xcaM\x02\xb1\xacP\xe0\x1e5殂\x0f\x1f;\x83\xda`2H:ЅFo*\xbc\x17\x175\x19R\xaa\xf0T\x9a\v\xca\xd0Q\r\xa4am#\xaf\xa0D\xc1j\xe90\xb7J\xd4\x10@\xc2N\xeb\xa0+\b6t6X\x8d{\xac\x97#\xa4\x8a\xd40\x82^'\xc6H\x12u\xaa\xbc\xd5!\t\xa9b\x1a\x90\x83-!\x8a\xb1\xefK\a&\x82\xa6\xc7P\xa2\xed\x9d\x18\x11:\x11\x1dN\x155D9\x805}\xc7r\xf6\xa3A\xa5\xc8\xff\xa7@]#.\x1a\xedL_C\x059\xbd\xca\xe6\xb4\b.\x02\xb6:\xaa\xac9\x0e\xe8蝾\xee:\xeaοX =)\x8c\x8b\x82Z\xf5\x94\x85\xa1\xf2\x94fq4LD\xb8\x9e\xab\xaa\xe0\xec\x9a{\xd0\x03\x84\xf0q\xecd\xf4\xc3a\xbbTg\x96N\xbcR\x16]\xfc\x02\xeb\xcc\xea\x1b7\xa0\xc9#-\x8fN\xd7W=MMO\xfbR\xa3\x89P<\x9a1\xfc\x84\x8a\xbf\xd8,\r\x0f\xb3W(H\xdc\xed\x011\x92F\xe7\x86\xc6\x12_\xef\xee\xc9x\xed\xad\xde]Z(\xa3\"\xd5e\"8\x91\x80\xef\xcc\xf7\xf5\xef\x98\xc7\xc2\xe0\x91(\x9a\xa2\xca:\xbe\x13X^ \xad\x116Ic^\xa7\xbe\xf8\xbb\xf2\xc9*$@w$\r1\xa4\xb3i\x90\x83\x0e~;=\x9c\xe0\xe8h&\x1cp5ߪ'\x92\x11$\xfcG\x16\xa0\x15\xc8I\x1dÒ\xfb?\xe5)\xc9\xfa\xc6\xf7E\x94:\xc2\xf2\xfa\xe5=\x93k\xb8o̓\x0ek`2\x10o\n\x8e\xca2AM\x995\"G\x9bב\x0f\x8bok\xf5\x14\x90\xe8\xf5\xac\xb2ŝ\u009f|\fH\xc2\x15\x15=\xf3a!b\x87\xceR \xa3\xb0ƀ\xbc\x06\x94I\x86\x82\xdb\x12\rw=@\x15\x9aDv\xe9\xb1^\x8d\xffw\x9d\xf8\x14<`\xe7\xf5\x00\x1cuV\xf4TI\x85\x1c胂\xd5cJU\x9dW\x8e\xe6\x90x_=\xab\xc1\u007f\x8e\xe3m\xb5\x87\xcb{\xaa\x11jO>\xdc'\x9d\xa6\aX\xfa!\x8e\x00\xc0\xd6\x1a\xc2\a \xf0K\xf5\xb8\xf9\xc6\xfa\xf4|\a8\x80X\xaa\nK\x8f\x12\xc1\x86FSdV\xcd\x10\xae\xfc\xcaJ\x8e5\x96,\aE\xbb\x9e\xc0\xb0\xea\xa7\xf7\xabÊ\xbcIG\x01ib\xb7.=\xfb\xe4\xbe\xc9j.N\xc7 \xb6\xeej|\x9e\xf6\x97\xebq@\x1f\xb0\x9c?\xd3![y\xa0\x19Õ\xb8_nq\xe8\xcc\x05v\\o\x0f\x9fN9O=\x9b\xe0\x8ax\x96\xe6\xb6s\xfe\xbc\xa9\xcb:\xe9\xde\xd8\x13\x80\x90/$\xcd\x1e-=\x81\xc57=\x99@\xe7\n\xbb\x9e`\xb4j~g\xbctz\fIs\xc1\xe1\xa5:\x85ڝo\xb6\xa6h\x92tn\x02<\x89\xb9JG\xff)\xa5\xfd\xa7\x99\xb3Fm:\xb1\xb7\xf4\xe8Y\xd3\xc9$\xb6(WvZ\xadvVB\xd5\xe7\xad\x03L!\x8a\xe1q\x87\xaaFl\xcfM$\xf3o\xb6\xceT\x971\xcd\xeaA0:\xf5\xf6\xc4\nz0\x82%z4\x95N9(\xa9eҲS\xa5\rC\x9b\x86\xce\"\x9b\x86\xad\x11\xf7$v\x15%p\x81\xe8)\x04\x14:\x13M\xa2\xe7\x96L\xb6fWX\xe7u\xb14@\xfc\x99\vrKf\x9f\x14\xdez\xa6$\xdex\xbf\x83\xe7:a\x95\xa4\"䨫\x9eI\xc7U\xd5\x10\u0557\xf6:\xba\xad#.\xaa\xde\x15\x95\x1a\xa4ÝT\xaey\x84\xa4\x9e\x95\xea5\xc2\xd6Ub8\b堧F\xb1.U\xe3H\x80\xd1;\xeat\b\xfaWl\xf7\\\xae\x9e\xb9\x839\xc1\x91rEh|\x1dR\xd0\t_$\t\x8c\x9b\x86\xd7x`\xd0\xf3\x1f\xd5Ib\vtza넇\xd7\xe3(\xd4\\\xc5V\x0e\x94\xdbI*F\xf23\x1d\xc5֤\xd3tZP\x12\x980\x90vWm}w\xf7?\x9f[r\xa9\xac\x00D\xe1\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x037\x89\xf4\xb3\xd8\x00\x00 \x00IDATx\xda\xed\xbdy\x9c\x9cWy\xe7\xfb=\xe7\xbc[\xad\xbdo\xea־Z\xb2eˋ\xbc\xe0\x1d\f\x84\xb0\x13\b0\xe4&!\xc9gr\xc9p\xc9Mn&3In\xc8\r\x99\x990!\xcb,\f\x81\x04\x12HpX\x03!6\x18ۀWYX^$˖\xac]-uK\xbd\xaf\xb5\xbd\xdb9\xf7\x8f\xf7\xad\xea\xaaV\vlP\xc8L\x86\xfa|J\xd5]\xaa\xeaz\xeb\xfcγ\xff\x9e\xe7\bc\f?\xbe\xfd\xcfs\x93?^\x82\x1f\x03\xf2\xe3ۏ\x01\xf91 ?\xbe\xfd\x807륾\xf0\xe1\x87\x1f\xe6\xd9g\x9f\xbdd\x1f\xfc\xda\u05fe\x96m۶5~\xdf8Xt\xb4\xd67\x18c6\x1ac6\x00\xc2\x183\v<\v<\xf7\xeb\xbf\xfd\x9f\xa6\xa4\x94l\u07b4\x99\xb6\xf6v\xfa\xfa\xfa\xe8\xe8\xe8\xa0P(`Y\xad_\xa3\\.\xf3\xe2\x8b/r\xf8\xf0a\xa6\xa6\xa6~\xe0k\xec\xee\xee\xe6=\xefyϏ\x14\x10\xf1R\xbd\xacO\u007f\xfa\xd3\xfc\xdc\xcf\xfd\xdc%\xf9\xd0\xed۷\xf3\xcc3\xcf`Y\x16\a\x0e\x1c\xe0m\xaf\xbb\xd52\x98\xcf\x1bc\xde\n\xb0\xc25\x05\xc0)`\x1f\xf0T\xfa\xf8\\-\x88K\xdb.\xbf\x8e;^\xf9*v\xee\xdcɮ]\xbb\x18\x1c\x1c\xc4u]\x00\xb4֜;w\x8e\x87\x1ez\x88\xbbﾛo|\xe3\x1b/\xeb:\u007f\xe1\x17~\x81\xbf\xfc˿\xfc\x9fSB.\xe5\xedСC|\xecc\x1f\xe3\xe0\xc1\x83<p\xcf\xdd\b\xf8\x13cx+\x80e\xd9H)\x10F\xa3\xb5\xc6`0\x06G\x1b\xb3\xd5\x18\xb6\x02\xf5-\xeb{\x8e:|\xfa\xe83O\xfd\xd5\xd1g\xf6\xa5 =\xdfֳ6\xfcٟ\xffEn\xba\xe9&\xb6o\xdf\xce\xd0\xd0\x10\xefy\xcf{x\xfb\xdb\xdf\xce\u07bd{\xf9\xf0\x87?\xfc\xb2\x81\xf9\x17/!\xf5\xdb\xea\x1e\x0f\xe07\x80\xff\x9c\xcb\x15\xe8\xe9\xeaƒ\x12\fX\x96\x85\xe7\xb9T\xab5f\xe6fXX\x9c'\x8a\"\xe2\xef}\xbdU`?\xb0O\bQ\xbf\x1f\xbf\xe9\xf6\xd7\xc5\u007f\xfd\x99\xcfb\xdb6\xb5Z\x8d{ｗ\x0f}\xe8C\x1c8p\xe0\xc7\x12\xd2\xd8\t\x02\x80w油?,\xe4\U000b4d75!\x85B\n\x81mYض\x8dm\xdbd<\x8f\xf6b\x91J\xa5\xca\xc4\xf4$\xb5\xa0F\xcd\xf7\t\xc3\x10m\xf4\xf2?\x9b\x01n\x04nl\xdah\xb3O<r߳\x97m\xe8yҒ\xd6\xd7\xdcLn\xef\x81\x17\x87\xcd\x1dw\xdc\xc1\x97\xbe\xf4%>\xf0\x81\x0fP\xab\xd5\xfe\xd73\xea\x97\xfa6\xd4\xed\xdd\xd6^l\xffdG[\x9b,\xe4\vؖ\x8d\x92\x12!%R\b\xa4\x94H)\x11Ba\x94\xc1\xb6l\\סT)Q\xa9V\t\u00900\f)U\xca\x04a\xb0\x92\xdd\xc1\x18\x831\xa6\xc3\x18s\xa7\x96\xf2N#\xcdo\xe8\xca\xe2\xdfm[\xd7\xfd\xc1W\xdc\U0007a4df\xfc\xab\xcfp뭷\xf2\xde\xf7\xbe\x97'\x9ex\xe2\u007f_\xb7wu\x8f\xb7#\xe3e\xbf\xd4\xddٕ-\x16\x8a\xb8\xb6\x93H\x85e\xe1X\x16\x8emc)\x85\x94\xb2\xb1\xb0a\x1c\x91\xf1<\x06z\xfa\x19\x1a\x18\xa4\xbd؆\xeb8\xe4\xb2Y<\xd7E)u\xd1\xcf3Ơ\xe3\x98(\nU\x10\xf8\xef\t\xc3`\xffcߺ\xe7C\x97\xad\xef\xedضm\x1b\xdf\xfc\xe67\xf9\xe3?\xfe\xe3\xff=\x01\xd94ض*\xe3f\xfea\xa8\u007f\xa0;\x9b\xc9b)\x85\xa5T\"\x1dB$w@ \x1a\x92\"\x84\xc0\xb3m,\xcb\xc2u\x1d\xda\xf2\x05V\xf5\r0ؿ\x8a\xc1\xbe\x01\xfa\xba\xfbȸ\x19l\xcbF\xa4\xba\xf0\xa2\xc0hM\x14E\x85 \f\u007f'\x8a\xc2G\xb7\xac\xe9\xda\xf5ݽO\xf0\xab\xbf\xfa\xab\xecٳ\x87\x1bn\xb8\xe1\u007f\x1f\x95\xb5nU[QH\xf9\x95\x81\xbe\x81\x8d\x99L\x06!\xc0\x92K\x92 \x85h\x18\x170\x80\xc0V\x8a\x9c\xe7\x11\x04\x01\xb1\xd6\x04A\x80c\xdb\xe4<\x97\xb6|\x0eKY\x04a\xc8|\xa9\xc4\xf9\x89q\xa6g\xa7\x89\xe2\x880\x8a\b\u0080(\x8a.Pg\t01QdvH\xa9\x1e\xfb\xe5\x9f\u007fǯtt\xaf\xfa\xeb}\xfb\x0fq\xef\xbd\xf7r\xf7\xddw\xf3\xfe\xf7\xbf\xff_6 \x83\xbdm\xcaV\xeao\xba::w\x17\xb29\x10\xa0\xa4B)\x95H\x84\x10\x98&\x91\x15)H\"\xbd[\xca\"\xd6A\xe3\xef9\xb6\x8d\x92\x89\x9ar\x1d\x9b\xee\xf6D\x85\xf5u\xf70=7\xcb\xf4\xdc4Q\x14\xe1\a>Q\x14\xe3\a>Z\xeb\v\xa4\xc5\x18\x93\x15B|rz\xe2\xec֍C\xed\xbfsbd.\xfe\x95_\xf9\x15\xde\xf0\x867\xf0\xf8\xe3\x8f\xff\xcb\x04DII\u07b3~1\xe3yo\xeclk\x03\x91,\xb2mY\x89\x8aiR3\"u\xc1\x04 \xa5D\x1bC\xac5JI\\\xe1\x10\xc71\xaem\xa72dH^\x99\xbc\xb6\x98\xcb\"\x85\xc0u\x1c\xfa\xbb{\xa8\xd6jL\xceL3<z\x06K)\x02\xadW2\xfa\xa9p\x8a\u007f'\xb4ް\xb6/\xfb\xf3\x8f?}\xb4\xb2v\xedZ֮]\xfb/φ\b!ز\xa6sȲ\xac?\xec\xea\xe8Ll\x02\x02KY\r\xbba\x8c\xa1Y\xa9\xd4%\xa6\xfe\xb3L\u007fVR\xe2\xb9.R\xca\xe4=ڴ\xa8#!\x04\xf9l\x06\xa5\x12\x0f-\x97Ͳz\xd5 \x1d\xed\x9dDq\xfc=\xaf3\x95\x98w\x18c\x1e\xb8\xe9\xeaͽ\u007f\xfe\xb1\x8fq\xeaԩ\u007fy\x12\xb2mm\xb7\x00\xfeG\xb1Ph\xf7\\\xaf\x11\x83Xu\xe9H\xf7\xba\x10+\xef\r\x93\xde\xeb\xa0\xd4\xed\x8d6\x06\xd0(i]\xb0\x01\xb2\x9e\xc7b\xb9\x92\x04&\x8e\xe4g^\xb7\x89\x81Mo\xa6\xb3\xa3\xc0w\xbf\xfb0\u007f\xff\x8fO\xb2X\x81X'wm\xa0\tכ\x80\xc7\xff\xe3\a\u007f\xed\x8d\xfb\xf7?s\xf8\xcf?\xfe\x17\xffr\x00)d\x1d\x80we\xbc\xcc\x1b\n\xd9<\xa2\xa1\u0092\x00p\t\x10\xd1\xf4\xefҎ\xad/\xb0\xd6\x1a)\x04J)\xb41\x98T\xf5\xc4\xe9\xebZ\xc1M\xc0\xf3\xec\x98\x1b\xaft\xd9}\xeb\x1b\xe8[w9\x96S\x00!\xb9\xfe\xd67s\xd3mO\xf0\x89\x8f~\x84\xd9\xd9sT|A\x18A\x18A\xc5\x17\x94\xaa`\f\x9b\x80G\xbf\xfe\x95\xbf}\xfb\xfbm\xfb;\u007f\xfc'\u007fvI\xd7\xc5q\x9c\x1f}\xea\xc4R\x92ͫ\xbbzm\xcb>\xd8\xde\xd6\xd6[\xcc\xe5\xd3\x1d.pm'1\xe6B$[S$n\xae\x90\x02)dcq\xeb\xc1a\xdd-nVm\"5\xfc\xa2n\xe0\xd38ĵb._7\xcd\xd5\xd7\xdf\xc4\xc0\xf67\xa2\x9c|\x03\xea0\f\x99\x9b\x9bga~\x8e\xa7\x9f}\x8eO~\xec\x8f(d\"jAL\xb9\x06\x96\x04\r\x94\xaa\x82\xc52\x98$\x15\xf3\u007f\x02\x9f.W#f\xcb1?l\xc9\xfb\xaa\xab\xae\xfa\x9eY\xf3\u007f2\tټ\xbaKH)\xff4\x93\xc9\xf4f\xbdL\x93\x81W\xa9\x1di\xb1\xe2\x8d_\xcc2I\xa9\xab-\xbdd\x80\x1b\x8e\x801&yL߳\xa9\u007f\x86\xeb\xafigp\xfb/\xe0umGH\a\x13\a@\xccsϟ\xe4\x81\a\xbeñcǙ\x9a8O\xb5R%4\x9d\x8c\xceԨT+\x04a\b\x18\xa4\x84b\x16\xfa:\r\xf3%\x91\xa9\x06|\nX\x9f\xcbX\xbf/\xa5\xd03\x8bQ\xaa.\xff\x17RY\xddm\x19\x807d\xbc\xcc;3\xae\x8bm\xa9Ƃ[u\xc9hE\xa4\xc5\xd3j\x06\x02\xc0h\x8d\x91\xf2\x02\xef\xa8\x1e4\xf6\xb5\aܰ\xfd\x1c\xeb6]C\xe7\xd6w!\xed<\xe8\x90\xca\xfcY\x8e\x1c=ΓO\x1f\xe2\xfe\a\xf6Q\xab\x05\b!\xc9e\xb2\xf4\xf7tP\xa9U\x98\x9a\x99\xc1R\x920\x8e\b\x82\x00\xdf\xf7\x99+\x19\x1cK\xd0\xd3n\xf0C\xe4̢\xf8\xa0֬ϸ\xea_w\tjӋQz\r\xff\v\x00\xe2X\x92\x9e\x8e|\x87\xeb\xb8\xffͶ-\x99\xcd,I\x87\x94\x82Z\x10 \x048i\xba\xa4Yﳂt\xd4\xc12\xad.\x11B\b,%\xb8|\xf547^q\x8eB\xefu\x14\xd7܊\xd1>\v\x13gx\xe6ɇ\xf8ƃ\xfb8>\x1c\x10k\v˲ٸz-=\x9d\x9d\xe4sY\xa4\x14\x1c=y\x82j\xad\x9a$)E\xe2\xc5)\xa5\xf0}\x9fXk\xa6\x174\xddm\x86\xfe\x0e\xc3Լ\xf8?\x82\x885\x9e\xa3\xde\xdeU`jz!\x02\x01\x97\x9a\xb5s\xc9\x01\xd90ԅ\x94\xf2?\xb9\xae\xbb\xc6s\\T\xba\xb3\xa38&\xf0\xfd\xc6\xeb*\xb5\x1am\xf9\x02N=\xa6\xa8\u007f\xb1e\xc0\xd4S)˷\xa3\xe7\xc0͗\x9df\xc7\xe6\n^\xdb\x15(\xb7\x80?\u007f\x8c\xe1\x13\xf7\xf0կ\xef\xe5\xb9\x136al\x93\xcfv\xd0\xdd\xd9A_W\x17^Z\xb8RR\xe28\x0e\xebW\xafe\xa1T\xa2\\\xadb[I\xc4\xef\xd86\x18\xa8\xf95\xfcP09\a\xdb\xd6\b\x06{\xe0\x85S\xe6\xf6\x8aϣ\x9e\xa3\xde\xd8U\xe0\xd8t)J\xd4\xec%\x04\xe5\x92\x02\xd2Yp\x10p\x87\xe7y\xbf(\x04x\xae\x8b1\x10\xc6Q\x12\a\x98%ed\x84\xa0R\xab5TX\xddN\xa05\x96e%\x06]\x88\x86䴀aǼb\xdb\x19\xb6\xad\x9d\xc5\xc9\xed@\xdaY\x82\xea,\xdf\xfa\xf6\xe3\xdc\xf3\x88\xcfB5\x8bm\xb9\xac\x1f裫\xbd\x03\xd7u\x1a\x1bC\b\x81eY(%)\xe4\xf3\xf4tu\xb1P.\xe1\x87!Q\x1c\xa3\xb5\xc6ql\f\x86J\xb5\x8a\x1fBl\x04\xfd\x9d\x02mb\x8e\x9de\xdbB\x85\xc7<W\xbd\xadK\xf0\xd8\xf4b\x84Hmߥ\x00\xe6\x92\x01b+\xc1@OgN)\xf51\xa5,\xe5X\x0eR\xc8D2\xc2\x10)d#?U7\x10Q\x1c\x11k\x8d\x95zHuwVk\x8d\x922\xa9\x95/\xfb\x92\x96\xd2\xec\xda0ʶu3H\xe5\xa0u\xc0\xdc\xf4(_\xbe\xef,\xdf=\xe4\x81\xc8\xd3\xd9\xde\xc6`_\x1fY\xcf\xc3q\x9c4\xea\x17ıFJ\x89\xeb\xd8\ru\xd9\xd3\xd9\xc5\xf9\x89\t\xa28F\n\x81NAsl\x1bm\f\xd5j\x95cg5\x1d\x05Ś>\xc5\xf4BD\xa4Eo\xa5\xc6\xfd\x9e\xa3\xde;\xd4m}nd\xaa\xd6p\xdc\u007fXP.Y\xa4\xbeu]?B\x88\x0f:\xb6\xb3\xd5\x18\x83e[\xc4Z\xe3\x87A\xea=\x99\x96\xc7:(ͩDVJ\xa3,+j\xad\xeb\x99\xe1\xf2u\xe3`b\xe2\xb0\xcc\xc4\xc8\v|쳧\xd9{(\x8bey\xac]5Ⱥ\xc1\xa1\x04\f\xdbnD\xfd\xf5\xbb\xe7:x\x8e\x83\x94\xc9_/\xe6\xf3d\xbcLK\xb6٤6ʶ,\x94T\xf8!<{4\x06\x04kz%Ŭ\xc1\xb6\xc8\x00\u007f\v\xfc\xfb\xd5=\x19)\xfegJ\x9d\x143\x16B\xb0۶\xec\x0f\b!\xb1T\xb2\xb3\x830Lw\xb8Yzl\x8a\xbf\x85l\x02\xa0ɥ\xad/\xe2\xf2\x8a`{\xb6\xc2\xce\xf5\xe7q\xec\x10\xa3#j\xbeϗ\xbf\x93\xe7\xe4X\x11\xcf\xf1X7\xb8\x8a\x9e\xce\x0e,+IZֽ0\xa5\x92\x94Kwg;m\x85<\x96m5T\xa5\x92\x8a\xb6B\x01\xd7q\x11M\xe9\xfe\xba\xa3a\xa76\xae\\\x83C\xa7cz:\x14\x19\x17r\x1e\b\x812\xc6\xfcG\xe0\xcfW\xf7f]0\xff\xfc\x80\b\x01\xeb\x86\xfa\\)\xd5Ǖ\xb2\x1c\x83\xc1R\x8aXkb\xad[v\xbc\xb9 x\xb4Z2\xbd\xcd;\x99ԭ4\x8d\xf8%f]\xdf\x1c\xfd\x9d\xa5T\xadE<\xfe\\\x81\x83g\n8\x8e\xcd`\u007f\x1f\x85|!Y\xd4\xd4N \x92`3\x97ɐ\xcdxɎW*U\x9fKqL!\x97ñ\xed\x86\xddj\xe4ѤĶR\x1bg\xe0\xf8\x88a\xa1l\x18\xec\x91\xe4<\x83\x92\r7\xfc\x97\x80\xaf\xae\xebϻ\xff\xec\x80l\x1e\xea\x06\xc4o(e]\x95䜒?\x19Ǻ5\xb4Xf\x9c\r\xe0X+\x9b0Yws\x8dI\x811\x1435\xb6\rM#D\xf2\xfb\xb9\t\xc1}Ow\xa0\x94bUo/\x85\\\xbe\x11\xfd\xd7\x17\x16cpR\x03n5IL\x93\xc6D\b\xc8f2XJ\xe1:N\x03кM\x13u[\x06h\r\xc7G4\xab\xba\x15RB[\xce D#1\xf9Zc\xcc\xef\x8a\u007fN@,)p]g\x87R\xea\xb7Ej\xb4\xa5\x94hm\xd2]&.\x9a0\xac\xa7FL]}5\xd5>V\xaa\xfam\x1c\x98\xa3\xa3\xb0\x801\x86(\xac\xf1\xadg;\x89\xb5\xa2\xbb\xbd\x9dl&\x9b\xe4\xb3\xd2\xf7*)\xd1&q\x16l\xdbn\\ϒ䙆\xab\xdd\xdb\xd9\xc9\xe6\xb5k\xe8\xeb\ue990\xcd5\xae\xab\x9e\rH\xe2\x1dո\xee\xb3\x13\x86Ŋ!\x9f\x11d=p\xac\x96`\xf5',K\xfe\xf3\x01\xb2iu\xb7\x12B\xfe\x85\x94\xcak\xa44\xd2j\\s=|\xe5\\\x97\xaas\xae\x12\x13\xd3\x14w\x98eR\x95qB\xb6\x0e\x8ea\x8cF\xc7>cӊ\xe7\x873d\\\x97b\xa1\x80\xdbT\x83_\x02T`;\xa9\xfb\xac\x92\x94\xbf@4R0&\x95\x9e\x8eb\x81ޮN֮ZE.\x9bI\xd4h\x93\x94Փ\x9a\xf5M\x12k\x18\x1e3\xf4w\t\x84\x80B\xd6,9&\xc6\xec\x18\xea\xc9\xe4\xfeY\x00\xb1\x95\x00!\xde/\x95\xba\xb1a\xa4\xd3/\\_HS\xdf\xffM\x8b\xd0\f\x88\xd6&}U\xb3\ni\xf5\xb2\f\xb0u\xa8DW\x97\x8b\xedu\xa0\xec\x02\x87\x87\v\xc4Z%\xb5\x0f\xa9Z\xa8\xa4\x89\xd4%\xc9M+\xad(:\x96\x05\x02\xb4\xd1-א\xcfe\x93\u0097\xe7\xb2m\xf3FV\xaf\x1a\xa4X\xc87\xa4L4\xd5a\x966\x18\x1c\x1f\xd5\xf4u$\x95Nρ\xa6\xac\x8e\xa3\xb5\xd9\xf5\xcf\x02\xc8\xda\xfe\x8e\xbc\xa5\xacߑ\xa9\xc1\xa3Q\xbb3\x89?_\xff\xf2\xe9\xee_\x0eH]\xc5%\x0eXk\x91\xa9)|\xc4h\xc3e\xeb\x16\x10\xd2BHE\x18\t\x9e9\x91,d\xc6s\xb1\xd3 \x92e\xa5ߺ\x97պ\x98\xa6\xb1\xd8Y\xcf\xc3K\xd3\xe0RJ:;\xdaټ~-\x1dmņz\x93B6\xd4V\xf3g\x84\x11X\x16\xf4uJ\x84H@iR[\xbbŏ\x1a\x90\u03a2\x87\x97ɼ۲\xec\xaed-M\x8b\xa7d\x8c!\bB\x02\xdf'\b|\xe2(n\xc4w\xcd\xfa{%0Z\x001\xe0ؚ\xee\x8e(\x01DXL\xcfE\xcc,\xdad\\\x17Y\xaf\xc9\u05fd\xa2\xd4eVR4Tb\xa2\xff\x97b\v˲p\x1d\a\xdbN\xbd0!Q\x96\x85e[l\\\xb7\x96\xad\x9b6\xd2V(,\xb9\xc0\xe9\xdfmv\x871\xb0\xff\xa8\xe6\xe6+\xad\x15b%\xb1\xdbu\xe4\x8f\x0e\x10!`\xa0\xa7C*\xa5ޗ\\\x9fi\x92\x8e\xfa\x0e\x8a\x1b\xd9Pc\fQ\x14b\x8cn)˚4Ƹ\x98t\xd4\vS=\xed1\x8ec#\xa5\x8d\x90\x16\x93\xb3\x02m\x12\x8fH.{}\xb3\xdaRR\"E]:\f\xda\x18l\xcbj\xd8\x1a;\r\x1a-\xdbj,v.\x97e\xebƍl\\\xb7\xb6\x91\fm\x90/\x84l1\xee\xc7F5\xfd]\n\xa5\x12iY\n\xa7\xcc\ue06e\xec\x8f\x0e\x90\xaeb\x06)\xd5mB\x88\x9d\xcb3\xb5\xf5\xb4\xc7R]\x9c\xa6\xe7[\x17ޤ\x85)\xb3\xcc\xdd].-\xedy\xdd\xd8\xc9BH\xe6\xabv\x92Ʒ\x14R\xaa\x86\x8a\xaaK@]%I)QJ\"d\x02\xacm\xa9\x86W\x97\x80\x97\xaa/\xa5Ҭm\xa2\xe6\xfaz\xbaٶq#\xae\xe36R\xfc\t?L$\x05\xb1\xf4\x1a\xc3\b\xa2\x18.ߠpmCS\xa8\xbe\x1a\x18\xf8\x91\x00\"\x80B\xceC\n\xf9+\"]\xeeV5c.Vڸ๕\\\xdb\xe5\x06\xddR\x8alF'\xbf\x89\x84f\xbaPuA\b,\xa9\x1a\x8b\xdf\xfc\xfe\x04\x88zrR6\xea_u\xc3/\x9a6\x88Tu\x0f,͟Y\x8a\x9e\xden6n\\G1\x97k\xb9\xa6\xfa\xe74j\xfa\x1a\xa6\xe75;\xd6[8\x16͞\x96\xa5\xb5\xbeZ\xfe\x80ɔ\x97\x05H\xc6Ud3\xdez!\xc4\xeb\xeb:^\bْ\xe94\x17\x89>䲅SR\xb5\x88\x87\\\x06F}\xf7f\xec\x908\xac\xa4*N2\xb3h%z?\xdd\xfd\xcd\xf6c\xc9M]\xe2\a\x93҂D\xaaV\xeb\x01\xa36\x06\xd7sQK\xe16R)l\xc7\xc6q]2\x9e\xd7BGj\x96\xbe\xfagΗ\f\xfd]\x89ao\xfe\xd2Ƙ\xddR\xfc\b\x00q\x1d\x85\x10\xf2\x97\x10\xb8u\xd1\x17\r\x17wYBPp\xc1\xcem\x8e3\x9aw\xf6r\xc0\x96\xde#\xb0U\x9cF\xec)\xb1A\xcb\v\xa4\xb69\xff%\xa4h\x10\xf0djܓ:\xfdR|\x82\x00۶\xc8f\xbc\x847\x1cED\xb1Nԛ1\x94\x16Kd3\x89\x17\xd6\\6^\xce|\x99\x9eO\x02D\xa5Z\\_\x80ݞ\xfd#\x00\xa4\xb7\xab=\v\xfc|#S[\aØ\x96\x82\xd2\xf2L\xadj\x8a\x13\xea\x01\x9alf&.\xf3bLS\xac\x92\xa4J\xf4\x123EȖǆ.L%ʶ,2\xae\xd3 H\xc8\x06_xI\x92\x94R\x14\vy\x8c\x818\xe1\xfa29=C\xb5R\xe5\xe4\xd1S\xec\u007f\xf6 \x13ӳ\x89jJ\x89z\xa6\t\x88:0\a\x8e\xc7I\x06\xd9\x11تŰ_\xddQ\xb0\u007f\xa0҆\xf5r\xbc+\xe0\x06!D?\xa6i\xf1\x1b\xf5\xedV*(\x175\xd6+HǊ\xe2\x9d\x18T\xa3\x9bk\f\x02\xd7Y\n:\xc52\xefN\xca$e.U\x92\xbe\xa9{TR4\x83\x91\x10\xe8,\xcbB\x1bM\x1cŜ\x19=\x8f\xd6\x1a\x1d\x06\x9c\x19=͚U\xc3\xecږ\xe1\xd9\xe7\xa6\xf8\xdc\x03e\x94\xb2\x97\xae\xb3I\x1aO\x9f7,V4Ŭ\xc0\xb1\r\x95\xa5\x82h7\xb0\x018\xfaOZ\xa0RR\xdd)\x85l\x05\xa3\x853ra\xbeJ4\xed,\xd1D\x11\xaa?aVPW\xcd\u007fM\xeb\b\xa3\x83F\x8c\xd3ߝ\\r\x14E\xa9\xee\x16\ruR\x974c\xb8\x00\x8c\xba\xc4x\xaeK6\xeb\xa5\x15\xc1\x1aG\x8e\x9d\x04\f\x9e\xa3X\xd0'x˻\xdeJ\xf7\xe0.d4\xc3+n}\x9c\xdbw\u007f\x9eO\xff\xfd\x8b\xec}\xd1m\xa8F\xd3T7\x99\x983\x14s\x02%u#+\x9c\xee\xcb\xeb\x84\xe0\xe8˭W\xbdd@>\xf2\x1f~\x17)\xe5\x9d\xcdF\xd4\\ăZn?\x84he\x96$\xfe\xbci\xd2\xfbr\x05'`i\xff\xa7\x1e2\x02X\xd3o\x03!A\x98ֳ댔&/H)\x89\xad\xd4\x05`\xb8\xaeC\xa1\x90\xf0\xb4ffgy\xe1\xc8\t\x94\x14\x14\xf3\x0e[7-\xb2\xeb\xb6\xf7\xe3v\xee@H\x8f\xb9\xf9v\xbe\xfe\xf8㌽P\xe1m\xb7Uٸ*\xe4S\xdfH\xba\xbab\xbd\xb4\xf8\xe5\xaa!\xeb\t\x94l]\a!\xc4n\x81\xf8\xacy\x995\x92\x97\fH\x1c\x85\x1dJ\xa9]+\xbb\xc3\x17g\x8c\x88\x15\xbc')U\xaa\x86L\v#\xbd\xf5}\xc9\xc2&TQӨ(\x16\v\x12)#\xe28\t>\xeb\xc9B!H\x89\xd6Iy\xb6Yb\xa4\x148\x8eC[1!\xeb\r\x8f\x8crrx$\xa9_\xea\x05ny\xc5f\xd6\xef|\x0f*\xbb\x1a\xb4ϩS\xa7\xf8؟\xfe\u007f\x9c>\xf2m\xa6\xe64\xb3\x97+\xderk\x88\xa5\f\u007f}\xbfӒI\xa8\xfauj\xec\x05\xdf\u007f\xb7\xa5\x04A\xf4O\x04\b\x82\xcb\x04\xc2[)\x86h\x10\x10\x97\xf9\xde\xe2b\xeen\xd3+\x12\x82\xda\n\xeaQ%\x94\x1c\x83\xdbH\xb3\x80\xa4\xb7\xd3!\x9f\r\xa9\xd6\xfc\xc4ا:\xc1\xb1m\x8a\xf9\xdc20D\x1a\x95[\xb4\x15\xf3Dq\xcc\xe1\xa3'\x99\x9c\x9ea\xb14\xcfP\xef\x18?\xf5\xae\x9f\xa5k\xed\x9d\b\x95!\x8e|\x1e}\xe8\x9b|\xfa\xe3\xff\x89Ņ)F\xc6\r3s\xf0\xe5)ɫw\vn\xd9\x19\xf1챈gNȴ̠)U\x93\xa0\xd0Vb\xb9\x84\xec\x18\xec\xc9\xe6O\x9d/\x95\xfei\x8c:\xf4,q\xda.^\xf7^\x8e\xd4\xc5\xec\x871\x86X\xc7IU\xd1$,\x94\x16\xcf,\xf5\xbaj\xa1\xdbXt)-\xb2\x8e\xc3\x15[`\xef\xfe25?\xa0\x90\x87\xac\xe7Q\xcc\xe5P\xea\xc2ҭeY\xb4\xb7\x15\x98[X\xe4Б\x13,\x96\x16\x89\xfca\xde\xfe\x13\x83l\xbf\xe9\x83\u0605- ,\xfc\xda\"_\xfc\xdcg\xb8\xf7\xcb\x1f\xa5\\\x8d97\x06\xa5\nH\x01q\x04\xc7F$\xd7m\x8by\xe3+\xaa<{\"߸\xce\xf9\x92\xc1R\xe0\xd8\xc9kŒ\xfd,\x00\xdb\x04<e\xfe\x89\x8c\xfaz\xb1\xd2\xf2\v.NJh\x06\xaf\x9e.ibf\xf8A\x80m\xd9+\xbe\xb7N\xa0\xf6C\x1bcb\xa4\x95\xc3\xc9\xf7\xa3,\x8f\xcd\x1bJ\xec\xdd_\xa1T)\xb3fp\x15n\xea\xe6f\\\xb7ъ\xa0T\"\x19\xc5B\x9e\xb3\xe7\xc69~\xea4\xd5j\x99m\xab\x87yի\xef\xa2s\xe3[\x10n\x17 \x18\x1f=\xcc'?\xfe_\xd9\xff\xf4CL\xcdj\xc6'Z\"o\x04\x82\xef<\x93\x00\xb2y(\xe6\xa6\xed\x01\x8f\x1e\x94i\"\x13\x16\xca\x1am\x92Z\x89i\xcaT\x03\xbb\x85\x10O\xbd\x1c&\xcaK\x06DJUL\f\xf4\x85\x8b\xdeT\x02\xf9\x9e\x11z\xfdB\xe3\x94\xcd^\xf3\x032i\x8bB\xbd\xbe-\x969\x02\x95\xc0J\xfb@\x04\x8e\xd7\x01\xc0\x96\r6\xb9\xec9\xaa5\x9f(\x8a\xb0\x94J\x8aKi\xb9V\xa6D\xb8|>˱\x93Ü9w\x9e\x82;Ǜ\xef\xf0Yw\xc5/cw߂\x90.F\x87<\xf7\xccw\xf8\xf8\u007f\xff#&\xc6F\x18\x1d\xd7\xcc\xcc\xd5\x03ޥ\xddn\f\xec}Np\xecVɖ՚ۮ\n\xf8\xce~\x1bKA_\xa7`j\x0e\xc2hY\x8e\"\xc1\xe0zǒ\xff\xa3\x16Ɨ>0\x14\x88\xfe\x8b\xcb\xc1ʂ\xb3R\xf4]O@j\xa3\t\xa3\xb0Ѩ)Ċ\xf2\xc7b5C\xac\x15au2\xa5\tIz;=.\xdb\\\x04\x01\v\xa5\x12\xc5|\x0e\xd7I&@$){\x1b\xcfsy\xee\x85#\f\x8f\x8cr\xd9\xe0y\xde\xfd&\x9b\x8d\u05fe\x0f\xa7\xf7N\x84\xf4\xa8V\x16\xf8\xd2\xdf}\x9c?\xfd\xc3\u007fϹѳ\f\x8fj\xe6\x17\xea\xe9\x91\xe4.e\x92\xca\x17\"\xb1\x13_\xf8\xb6\"\x8cವ17_\xae\xe9n\x03\xcf\x11)\ruY\x14\x958\x1b\xd7\rtg^V\x12\xe5e\x18uaV\\\xfe\x8b\xe1SϤ\xae -\x06C\x18&^\x92}\x91\x80\xb6\xee憱E5(\xe2z5\"\xbf\x84\xed\xb5\xe18\x82[\xae\xeb\xe7\xf0\xb1\nS\xb3\xb3\x04aH.\x9bMU\x9d\"\x8cc\x9e\xdf\xff<\xd5\xca,\xb7\xef<\xcf\xce][\xf0\x86ޅ\xcao\x04\xa1\x98\x9f\x1d\xe7/>\xfaa\x0e<\xf5 S\xb3>#\xe7\ryǡ-\a[\a\xbbض\xba\x93\xac\xad\x98Y(q~z\x9e\xe1\xa9\n\xe3\v\x01\a\x8eh\x1eٯx\xe5\xb51?\xf3\x9a\x90\xdf\xfa\x84\xc3\xc8D\x88\xa3b\xf2\x99t\xdb6e/\x8c1\x1b\x81.\x04S/\xd5\xfb}9\x91z|\xd1T\xeeEpi\xce_ђz\x90T\x83Z\xa3\xef\xe3\x82\x18=\xe5t\xd5Y csC\xb4\xe5_\xc4/O`\xb9\x05\xa4\xb4ؾ\xb9\x9d\x1d[\x8a\xbcx\xbc\xca\xd8\xe4\x14\xc5B\x1e\xcfu)W\xaa\x1c;5\x8cԳ\xdcu\xd5Q\xb6\\\xfb\x0e2Co@\xba\x9d`bN\x9f<\xcc_|\xf4Ü9q\x80\xf1Ɉ\xc9iAg\xd6ec\u007f\x1b\xbb6\x0e\xb0k\xf3 \x96\x12\xe8( \x8e\x02JՐ\xd3\xe7'\xf9\xee\xb1\t\x8e\x8fW\xf8\xd4=\xb0y\xb5fM\x9fὯ\x8b\xd8wD\xe09\xa0\xb5h--$\xdf\xc3\x01\xae\x12\xf0\xa0\xb9\xf4^\x96дz\x11\xad\xfar\x85\xf4\xfar\xf78ɴ\xd6Y\xf0~\xc2,\xbc\b\xc0͜\xaeӓ=l\xe8{\x01\xbf<\x8e\x9b\xeb\xc6\xc9t\xe18p\xd7-\xfd\x9c:s\x86\x85R\x89\xe1\x91s\x04a\xc8b\xa9Lgf\x82W\xdf4\xcb\xea]\xff\x0f\xd9\xfe\x9b\x11\xca#\x0e\xcb|w\xcfC|\xfa\x13\u007fH\xa5Rfn\xc6\"/]V\xad\xb1Y\u06dd'6\x82\xf5\x03\x9d(\xc7\xc5\xcd\xe6\x93\x06\xa18\"S+\xd3^,\xb0\xae7\xcf\xd7\xf6\rsr\xa2\u0097\x1f\xd2\xfc_o\x0fٲZ\xf3\xc2iE\xac\xd3ں\xa8\x87\xbb-%\xebݶ%\x1f\xf4C}\xc9\xe3\x90xŜ\x93\xb9\x10\x99\x8bُz\x8a#\x8c\xa2\xb4hd]T\xe2Lں\x96\xd4@2\x8cͭg\xc8>\x8b_\x9e@YY\x94\x93c\xf3\xfa<\xaf\xba\xb9\xc03\xfbϑ\xb7\xca\x1c\x1e)sݦQn\xbcn==W|\b\xa7}+B:,Ώ\xf3\xb5\xaf|\x9e\a\xef\xf9$\xa5\xb2$\\\xb4\xc8Z\x8a\xab\xb7\xadeUg\x81ѱq\xfc ď\x04\xc2-\xe0\xa4\x03\r0\x067\x9b\xa3V\x9aGH\xc1\x9dW\xc4L\xed9ɉS\x1eG\x86cV\xf5\x18.[kx\xfe\x94@k0ȔOв(\xbb3\x8e$\b\xf5K\xd2Z/٨+!\xa3倈\x8b\x9a\xf5\v\xed\x87\x12ɔ\x1f)$\xd5t\xd8K\x9d\xb9x1@\xaai\xfb\x82\x10p\xe4\xdcZ\x02?\xa2V\x1a'\xac̀I\x88ӯ\xb9}5\xefxS?~4\xccO\\\xfd\x047\xdfr\x1b}\xd7\xfe.v\xdbF\x8c\x8e8\xfe\xe2w\xf9\xef\u007f\xf2\a\xdc\xf7\xd5O2>\xa9\x88K\x0ek{\xf2\xbc\xee\xe6\xabx\xcbkng\xdd\xfa\r\x18aS\xaeE\x1c>u\x8e(NFD5xY\xd2\xc2r2(;Cog;\xdb\xfa\xf3\x14=\x8b{\x9fP\x14\xb2\x86\xed뒝\u007fnZ&˹,\x15d\x8c\xb9\xba\x90\xb5,\xf1\x12M\xfbˉC\xe2\xe5\xf4\x9c֜U\xeb\xcf+ُ\xba~\xad\x05\xc9Bۖ\xf5\xbdTd:/+\xb9\xcdU2\fOmg\xb3\xf3\"~e\ne簼.@\xd2\xd7[\xe45\xb7o\xc0rn\xa5\xfb\x8a\x9fE\xd9Ej\xa5i\xee\xbf\xe7\xd3<\xf0\xcdo0\xb7\x102z\xceb\xa8\xe0\xb2iU\x1b\xb7]\xbf\x8b\x8d\x1b7\xd0\xd1\u074b\x9d+\xa2\xf7=\xcfBe\x8a(^\xe4\xf4\xd9s\xf4v\xb77\xb8\xc8u\x82S\xf2(\xe9ms\x89\xcf\xc0Ĥ\xc3\xf4|Do\x87aӠat\xd20\xbd\xd0\xdaג\xaa\xac!`PJ\x86u|iUVԌ\x86\xf8\x1e\x16].\x93\x0e\xd1\xc4m\xf2\x03\xbfa\xf4\xac\x8b\x01\x92$\xa6\x1aRV\u007f\xfd\v#\xab\xe9\xccN\xe0\xe6JD\xc1\"1y\xc2ذ8?\x89\xe5vҵ\xe9\xcdH\xe512|\x88O\xffş0r\xf683s\n\xdbwx\xf5e\x05\xf2\xae\xc55\xd7\\ͦM\x1b)\x14\x8aX\xaeG\xb6M\xe1\xe5ۉ\xcd9\xe28\xe6\xec\xe8y.ߺ\x9e\x8c\xe7`\x8ch$\xfa\xe3\xd8\x10\xc6\x06ϲ\xb0\x94\xa0\x1aڼpZ\xd1\xd5\x16\xb1s\xa3\xe6\xf8\xa8\xe0\xe4\x18\x84\xb1hݴɏ\xd7\n\xc1\xf0K\x91\x92\x97S\xa0\x8a\x1a\x92\xd0\xe0Ǯ\x9cT\\\xae\xdadZ\xff\x96BP\xad\xf9-d9.R?\xb1\x9a\xfbCR\x03Y\v4O\x1e\xdf\xca\xcc\xd4\fa\xe0\x13\xf8s,\u038d#\xad\"\x9dk߀\x9b\xeb\xe5\x99}\x8f\xf1G\x1f\xfa\xbf9q\xfc(G\x8fG\xf4\n\x9b[6w\xd2ז\xa1\xb7\xbb\x8b5kV\xe3\xba.R)\x94\xad\xc8\x14\x12\xb2\xb6\xeb8hcX,U\x1897F\x1ck\x8c\xd1\xc4q\x8c\xd61Z\x1b\xe6\xe7\xcbL\x95|l%\x99\xf7av\xc1\xa2T\x81\xae\xa2a\xfbZ\xc3\xea\x1e\xbdD\x87m\xb5\xa1\xd7+\xf1\xd2zG^\x8e\xdb\x1b-U\xed\xc0\x98$\x9a\x95+\xe4}\x97\xab\xab$k\x9b4\xef\x84Qؔ@T\x17\xa9\xbb$`\xc8&\x9f\xbe\x16\x04\x84a\xc8\xe4t\x95\x99\xd9N\xae\xdd9Ŧ\x8d\n\xe5\xb4ӱ\xfa'\xc8\x14\xd7\xf0\x8d\xaf}\x96\xaf\xfd\xfd_\xb1P\xd2\xccM\bn]WdUW\x91B\xb1\x9d\xfeU\xabhk+\xe28\x0e\x8e\xe3$\xf5\xf3L\x8e6'CWO\x17\xf2\x88\xc4\xc4P\xf5}\x86G\xc6\xe8\xeehK\xea&Z\xe3W\xab\xcc\xcf\xce11\xbb\xc0\xd4b@-\xd4TCÙ\t\xc1BEP\xc8\x1a\xaeڬ\xd9w$\xe6Ԙ\xb5\x12\x89㦞v\x87\x91\xa9ڥ\xb5!Ku\xe9\xb4\xc4]OS5\tgݛ\xaa\xa7\xd5\xeb}\xe6B\bju#\x9d\ue815\nS\t\x99-)Ŋ\xd4\xfd\xad\xfa5\xa2(\xa6\\\xa90\xb70O1\xa7\xe8\xeb\xc9!\xad<\x9dC\xaf\xc5+\xae\xe1\xef\xfe\xfa\xcfx\xe4\xa1\xfb\x18\x9f\x8c\xe8\xb6r\\\xbf.C_G\x81\xee\x9e>\x06W\xaf\xa1\xab\xab\x8bl\xd6K\xaeǶQ\xb6\x83\x9dɢ\x85\xa4\xadXh0[\xc2(flr\x86\x89\xc9i\xba\xdb\vHa\x98\x9e\x98\xa0\xb4\xb8\xc8l\xd9g\xae\x1a\xe1GI\xee\xea\xf1\xe7\x04\xb7\xef\x12t\xb7\x1br\x9eᵻ#\xf6\xbd\xe8$I\xd5&\xcf\xd2\x18s\xb51\xa6 \x84X\xbc\x94\x80Db\x05/K6\xf7\x99/\x03\xa3\x9e&\x89\xa3\x18eK\xaa~\xad\xf1:\xbbI\x02\x96ߜ4 \xd4\xc6\x10\xc51Q\x14353M\x10F\\\xb1\x05^yC\x91\\>K\xfb\xc0\xedh\xab\x9b\x8f\xfe\xd9\u007f\xe0\x99\xa7\xf623)\xd8\xd2S\xa4;\xef\xd1\xdd^``\xd5\x00\xab\x06\a)\x14\x8b8\xae\x8bm;x\x99\\\xe2\xedY6\"\xad.z\x197-\xb8%\xa9\x97\xc5r\x85\xb3\xe7'PF#\xa2\x1a\x8b\xf3\xf3\x04Q\xccT\xc9'\x88\r\xa1I\xb6`\xa4\r\x8f\x1eP\xac\xeb7d\x1cö5\x9a[wj\x1e~N\xa5\xadsN\xbd\xb2\x9a\xd1\xc6\xecV\xd2\xff\xd6%TY\"j\xb1\xe1\x82\x15\x13\x8d+\x05\x83a\x94\xf4\x126\x03Ug\x99_\xf09R\xe0Xvc|R\x18\x86L\xcd\xccP\xf5}n\xbaJq\xf3\xd5\x19\x1cס\xd0u\x15\x93\v\x16\x9f\xf9/\xbfǑ\x17\x8f\xe0\x84\x19\xae\x18\xc8Жs\x19\xec頿\xbf\x9f\x9e\xde>\xda\xdaۓ\x0e\xdb \xa0\xd0ֆr\x9c\x06\x8bR\b\x89\x12\xe0y\x1e\x120)\x133\b#\xc6'&Qa\x15G\x1a\x94\x14\xccUCJ~\x88\xd6:%\xedi\x8c\x81o\xef\x17\xdct\xb9\xe4\xaa\xcd\x06%57\xee\xd0<~\xc8mZ\xa7T\xcd\xc7\xf1m\x19G]:@\xa4\x10\xf1R\xf96!\x9a-O\a\xae\x18\x9d\xa7T\xceJ\xad\xda*\x05i\xab\xd8\xf2\xf7'\x93\x1e\x12\x03\xe8\a\x01\xe7'&\xf0\x03\x9f;o\xb0ؽ\xd3ö\x1d2\x1dWsr<\xcb\xe7>\xfb'\x8c\x9c\x1e\xa3\xc7-\xd0\xdef\xd3YȰ~\xa8\x8f\xae\xaen\xba{\xfb\xe8\xe8\xec\xc2q\\\x82Z\x95ήn:\xfb\x06P\xae\x8b?7\x9dH\x88R\xe80b\xf3\xa6\xb58\xb6E`\xac\xb4V/\xa8\x051a\x18\"\x94@X\x8a\x85j\x881\xa0\x11\x84:\xd98\t\x132澧\\n\xbeq;\xb5\xd9gY\xd7\x1f\x90\xf3\xb2\xf8\xa1larJ)o]\xd5]\xbc\x84^\x96HTV=\xa3\xbaܽZ\x89\xa9W\xb7\x11\xb1N\xbc\x95\x16ҝ\xe7\xad\bf=6\xa9\xd4j\x8c\x8e\x8fQ\v|^y\x83\xcd\xf5Wf\xb0m\x97l\xd7\xf5\x1c\x1e\x16|\xfaS\u007f͙\x13\xe3\xac\xca\x16h\xcf\xda\xf4\xb6gشf\x80\u07be~\x06\x86V\xd3\xd3\u05cf\x97\xcd\x02\x06˶\xe9\xe8\xe9\xc1mkO\x88\xd5n\x06\xa1\xea\x9d^1\xf9\\\x96\xde\xfe>\x8cQT\xfc\b\x03T\x82\x88\x92\x1f\xa1\rT\u0098R-LiC\xe0G\x89\xcb^_\x87g\x8eƔY\x85\x93\xdb@!\xa7ٽ5n\xcdp'\xc1\xe2n\x04\xd9\xef\xe7\xf9\xbet@\fQ\xf38\x89\xa5n\xa7\xefQ\vIk\xbbQ\x14]P|\xcaz^\v\t\xad\xb9K\xb6\\\xad2r\xfe\x1cQ\x1c\xf2ڛ\x1dv_\xe9a\xd96V\xe1j\x9ey\xb1§?\xf57L\x8f\xcc2Tȓw\x15}m.\x1bV\x0f\xd0\xd1\xd9\xc5\xc0\xe0j\xba\xbaz\xc8\xe6\xf2X\xca\"\f|\\\xcf%\xa8\u0558<?\xce\xd4\xd4\f\x81\xef\x13\xfb>\x91_CI\xc9\xe2\xc2\"&\x8e\xd90\xd4G&\x93'\x8a5Z\x1b\x16\xaa\x01\xb1\x81\x92\x1fQ\nBbc\xf0#M`\x92\x8d\x13\xa4\x9bLr\xe8\xa3\xe8\x00\x00\x1a\xa0IDATk\xc3\xfe\x17\xe7)toE ؽ\xcdo\xddh\xc9Ze\xa4\x10\xd7}?F\xe3˱!q\x8b\x1f}\x11\xe6\xf9\xf2րX\xeb\v\x86\x87u\x14\x8b--cI\x14O\x1a8\x06\x8c\x8e\x9dGJx\xdd-.;\xb7yضGh_\xce3\xcf\xcf\U000c5effB\xb0PcmW\x96\x9c+\xe9)8\xac\xee\xef%_(\xb0jh\r]==x\x99,R)\xe2H\x93\x93\x12\xcfq8\xf8\xdc\xf3,\x96\xaaD\xda0\xd0\xdbɚ\xa1U\x14\x10\x9c\x9fY\xe4+_\xba\x87\xd1s\x13d\\\x9b\x8c\xe30:7G>cS\r$\xf3Ր\xf9j@\x18\x1b\x82HS\x0e\r\xb6\xed`\x10\x04\x91ƶ\x93\x8d\xf9\xe5\xfb\x8er\xe7\x8dwb{Cl\x18\x1c\xa1\xb7=\xcb\xc4\xdc\x05\xf9\xbcۤ\x14\x0f_\x12@\x94\x94Q\xd4\x14\xfb\x8b\xefks$\xb1ք˥C)\xf2\xe9\xccE\xd9\x04F\x9dk5>9\x89R\x867\xbf\xcac\xdbz\x17eg\xa8\xc8\x1d<wh\x8e\xcf\xfd\xed\x97\t\xcb!\x1bzr\x14<EO\u03a2\xa7\xb3\x9d|\xa1\xc0\xc0\xe0\x1a\xba\xfb\xfa\xf0\xb2Y\xac\xb4,\x8c-Q\xae\x83\t\x03\x86G'\xf9\xe6#O\xb3\xebګY\xf0\xe1\xc8\xc9\x11:\x8ay\x1e\u007f\xea\x05\x0e\x9d\x18\xe5\xcc\xf9)\xba;\x8ad\x1c\x8bZ\x18\x93q-\xca~\x84\x9e\xaf\xe2G1\xda\x18\xfcPS\t\x05\xcaRԂ\x00\xdd\xe8i\x87CǦ\xd9wp\x82\xdd[.#\xac\x9d宫}>\xfb\xed\xcc\xf24\xca펒\xbf\u007fI\x001\x98x\xa5\x9aޅ\f\x94\xba\xef\xad\x1b\x13A\x9b_\xd1^lk\xb0A\x04K%\xe10\x8c\x98]\x98\xc3s\x05o\xbb\xcbc\xc3j\a\xa1\x1cf\xfcu<\xf5\xdc0\xf7~\xe5\x9bāfmW\x866O\xd1Wth\xcf\xe7h\xef褣\xab\x87\x9e\xfe~\x1c\xd7Ų\xeddԅ\xa0\xd1\x1fb\xbbY^\xff\x93\xaf\xe2Uw݁\x14\x12?\x8c852\xc6w\xf6<\xc3\xf1\xd1\x19j5\x9f\xbe\xae\"\v\xa5*\xbe/)\xe6l4\x86\x92\x1f\x11\x9b\x84N*\xa4\xa0\x12\xc6\xf8\xc6\u0093\x92J- 6\x92 ָ*\x01\xe5\v\xf7\x1e\xe1\xda\xed\xd7#\xa4\xcd5[\xab|\xe5q\x97\x8a\xdf\"%7\xccM\x9c\xf6\x80\xda\x0f\xcfˊu\x946 |O\xe9\xa8\xd7\x01Z\x8cx\xba\xea\xb6eS\xc8e\xd36\x81%FJ\x18%\x13\xaa\U000d921f~]\x91U}\x16\xdaX\x9c\x1cmc\xef\xb3/\xf0\xc4\xc3\xfbP(\x86:<ڳ6\xddE\x97\xae\xf66\x8amm\xe4\xf2y\xfa\a\x87\xb0,\x1bU\xf7\xdcR\xe3f\x9a4\xab\xb4\x14^\xaa\xc6d\f\x85b\x9e\x9f\xb8\xfdz._\xd3ó\a\x0e129KW[\x8e \x8cp,Ad4\xd2$\xc6ܶ$qd(\a4Fn,Tj\xc4qL)\x16T\xa5 ci\x9e\xdc?\xc6\xfe#sl\xef\xddF\xbb>\xc8k\xae\xa9\xf1\x95=\xd9\xe6u\xc8\x00\xd7\x01\x8f^\x92\\\x96YFU\xbcX\xb2L\xa7\xd4\x1e\xb1,F\xe9\xee\xe8h\"\xaf\xd5\xc1H&2t\x17\x17y\xf7O*\x06\xfb,|߰\xf7`\xccW\xef}\x9aǿ\xbd\x0fi\x14\x03\xed\x1e\x1dY\x9b\xf6\x8cEW[\x9e\\\xbe@&\x9b\xa3\xab\xb7\x8fl6\x87T\x89ד\x8c~M\xfa7\xea\x8fQd\xd0ڤ\xbfǌ\x8d\xcd`b\x8d\xeb8\xb4\xe5\xf2\xac\xed\xeb`\xd3`\x0f\xbd\x9dE\xfa\xbb\x8a\xb4\x15\xb2xN2H\xc0\x8fb\xfc(\xa6T\x8b\b\x84\x83R\x16\x8b\xe5\n\x15? \x8eb\xa20\xa4\xe6\a\xcc\xd7\f\xf3U\xcd\x03\x0f\x1d\xc2\xce\xf6\x00\xb0k\xb3\xdf\xea\xb8$\xebp\xdb%b\x9d\x88(\x89=.\xc6\x1f]Ɔ\xaf\x1b\xed\x96i\t\x16\x96ZjҌ\xa2dh\xd85\x97U\xb8\xe1\xca<\xd9l\x86\xc5r\xc0W\xee;\xc1\xa1\x17\x17\x98\x9d\x95XR\xd2Wt\xe8\xc8\xda\x143\x16]\x85,\xb9l\x8el6K6\x97\xa7\xa3\xb3\x1b!\x14\xd2RIV@'\xe5\xff\x04 C\\o\x9d\x8bc\xfcR\x85\x85\xb3SH\xa1p\xdas\xc4q\xccԹ)\xca3\xf3tf<,\x01\xe3\xb3\v\x84Z7To\xa0\r&2,\xfa\x06a)\x820\xe2\xdc\xcc|\xa3\x1c\xd7(\xd7ƚ\x10\xc5\xdf\u007fk\x9cw\xfc\xe46\xba\xdd\x01Vu\x8f\xb1}M\xc4\xe1\xb3-1\xd7m\xc0\x1f\\\x14\x90\xfb\xef\xbf\u007f\xc5\xffX\xbdz5\x97]vY\xb31\x8e\x03\x1d]\xb4!gy=\x19\xd1J\xffo\xcb\xe7S\xbe\x94j\x80\xe1\a>\xaf\xd8\x15p\xcd\x0e\x0f\xc7v\x99[\xf4\xf9\xd2\u05cfs\xecx\x89\xb9Y\x89\x12\x82\x8e\xacMW\xde!cK\xba\v\x19\xf2\xb9,^6\x8b㺴uva\xd9.Z\b\xb4\x01b\x8dIY#q\x9c\xb0\x1d\x83J\x95\x99ӣL\xbep\x92\xa8\x9c\xb8\xb9ʲPmyN.,p\xe8\xe01\x82\xc0'\xb2\xa1\xbd;Oֵ\t\xa3\xb8\xd1nᇚ\x85(\"\x10.\xaeT̕\xcbD\xb1^^\xa6M6\xa1\x14\x04\x91`\xdf\xc1\t~\xfa\xd5W2sv\x8c;\xae\x0e\x97\x03r\xfd\x95\x9b\xfb\xdc\x03\xc7\xc6}\x80G\x1ey\x84Z\xadƎ\x1d;\x18\x1c\x1c\xc4z\xcdk^\xb3\xe2\xe2~\xe63\x9fi\x01Ķ\xed(\x8c\xe2\x96\x02\xbaX\xa1'0\x8e\xe3\x96\x1e\x11\xa5,z\xbb:A@[\xa1H\x18%4P߯\xf2\xca\xeb}\xae\xbc,\x87eYLNW\xf8\xdc?\x1e\xe5Љ*\xb5\x05\x89-\xc1q%}\x05\x17[J:r.\xb9\xac\x87\xebe\xf0\xbc\f\x99l\x8e\\\xa1\x1dm\x92\xf6\xe58\xd2h!\x10\xca\x02\xa3\xa9\xce\xce3u\xe2\fs'G)\x97}\"\x04Q\x14\x92Q\x92l\x1cS>_\xe1䩳\x84~\x80@`\a0vz\x8e\t+bUO!)Ú$\xab\x1dh\x89\xe5%\xb9\xaf\x85r\xb5\xa5\xc4ܢ\xaacM$\x15\x9f\xfb\xc60\xefx\xfd\x0e\xbc\xc26\xb6\xaf\x1b&\x9f1\x94\xaa\x8d\xb5\xca\x1bm\xb6\x00\a\x01>\xfaя\xf2\x85/|\x81={\xf6$\x80\xbcT\x95\xe59nT\xa9T[&\xbe%\xbbbi\xa0\xe5\xf2h\\JI_W'R):\x8aE\n\xb9,\x13\xd3\xd3\xcc/L\xf3\xc6;`\xe7\xb6v\xa4\x94\x9c9\xb7\xc0\xdd_=\xc2ѳ\x11'\xc6\x05\xdd.\xf4f\xa1\xe8Yx\xb6ĵ%Ŭ\x83m\xd9x\x9e\x87\xe3\xb8\xe4\x8b\x1d(e\xa53w\x05Q\xa4\t\u0098\xef<\xf94\x19\xa3\x99:v\x9a\xe1s\x93d=\x8f\xael\x06UY$\xae\x86LT|f\xb4!\x8e\x02F\xcb5l\xa9\x88uD\x88$k\xdb\f/,21_bM_;Zk\x82X#\xac$]\xaf\x11\x94j\xc1R\x8d\xa6ބ/Z]\x9d\x93\xe7\x03\x8e\r\xcfsŦ+1z\x9ew\xde\x05\xff\xfd\x8b%<ש/]\xcf\xc54\xffK\x06d\xa1\\\xd6+\x0e\xaaԺQ\xd4\x17\xcb\x02\xc3\xee\x8e\x0e,\xcb²,\n\xb9\x1cc\x13\x93,,\x8e\xf3ӯ\x91l\xdd\xd4\t\b\x0e\x1f\x9f\xe6\xf3\xffx\x94Scpj2y\xef\\hh7\x12\xcfN\xc0\xce:*\x19\x05k%\xb3\x13]/\x8b\xe3e\xd1\x06\x84\x81X'\xbb\xf5\xfc\xd84\x96\x90\x04F\xb2\xf1\xba븵\xab\x93l\xb5\x06\x8bU\x04\x12\x11F\x14\xbb:\xc9vuc,\va4\xd3\xc3\xc3|\xf5\xc1\xfb\xf9\xee\xe93ԔMoo\x1bNƢ\x12F\x04A\x9cD嶍\x94\x8aZ\xa4[z)\x97\xaa\x0eM\xbd\xf5Bb\x8c\xe0\xe1\xbd\xc3\\{\xe5\xadt\xad\xbb\x83\r\xd3/\x10\xc5s\x80\x030g\x92\xe3\x99~8\xa3\x1eFъ\xb6\xa3\xa5ϼ\xa90ՖOxRB\b:\x8aE\xa6fgY,\x8d\xf3\x8e\xd7J6\xad\xef\x02\xe0\xc0\xe1I\xbex\xcf1F\xa6\x04\xa7&\x9b%L1\xe7\x1b\xe2d\xbc\x1b\x9e\x93\x1cU\xa1\xa4D\xd9V\x12)\xa7@H\x91d\x85u\xac\xe9\xec\xec\xe0\xe6\x81\x01\x84N\x17)2\x04Z\x13/T\xd1a\x80??O\x18\x85,D1v>\xcf\xdc\xd4$gO\x9e\xe4\xcc\xec<\xb6eQ5\x10\"\x91Z\x13\v\xc1\\-@(\x17\xa9,,\xdba|r\xfc\x02c~a\xb6:Y\x83\xa7^\x98I\xbd\xb0\x98=\xfb\x8e\x92[\x1a\x06\xfaՃ'&\x16\x9b[\x1a~ @t:\x8a\xafaȖQ?\x9b%\xc3R\x8aB\xdaV\x9c\xf5<\x820dn\xfe<?u\x97`\xcb\xc6\x1e\xb4\xd6\xec?4\xc1\xe7\xef9\xce\xf9i\xc1\x89q\x83_\xf3\x1b$\xe1H\nf\x84\xcb\\-\xa6\xb7h\x1a\x05.!$*-\a\xc7Z#\x8dI\xbc\xb8\xb4\x93K\n\x88\xfc*Fkbߧ<9\x8b\xbf\xb0Hit\f\x99\x8e\xac\x0e\xe2D\xa2\xfd8fx~\x91\xd3\xf3\v\x94È\xaa\xed1\xa7\r\xd5\xd2\x1cs\x15\x93\xd6c \x93O\xba~ccX,W\x97\xe8NM+i\x1a\xec|\xd9hdz\xfd+\n\b\xa1y\xf2\xc9\xe7\xb9\xefI\xb7\xbe>\x06\xf8إ\x89ԍ\xd1K\xbb\"\x99ABz\x01\xb2\x89\xe1.\x85 \x9f˵\x14h&\xa7\xce\xf0\ue7f4Y\xb7\xa6\x83(6\xec;0\xc6\x17\xee=\xc9\xc91\xc1\xe4\xa2!\xf4\xfd%\xe6D:d?\x0e\x03N\xceJ\xba\xf36\xfdi\xea\xa2\xfe1\x1a\x81\x8eu:\x82\xbc\u07b4\x93\xd2\n\x8cF\xc4U\xa4\tȶYh,T\x98c6*Q\x9d)\x11F\x02WY\x84Z3^\xad2\x16\x04\x94\x8d\xa0\xe6\xd7\b+\x8bDʂt\x88\x8e\xb2\x1c\x94\xb2q]\x97\x13\xe7&V\x9c>\xb1\x92t\\\xb7\xc9p\xc3\x15]\x9c\x1d\x9d\xe6\xbf\xdd=B\xa4\x1b\xcb\xfc\x95\xbe\xa1\x8dO^\xaa\x8a\xe1~\f\xd3\b\xba\xea\xd7\xe3(uA\xc6\xd6u\x1c\xda\xf2y\\\xc7!\x8acj\xa51~\xf6\xad\x05\xfa\xba]j\xb5\x90'\xf7\x9f\xe7\xcb\xdf<ˑ\x11\x98+\x87\xc4\r\xcfmY\x92\xcchja\xc0\x91I\x9f\x8e\\\x95\x8e\xbc\x87\xc9&\xaa3\nC\x94\x1d\xa2b\x17-5q\xacA$\xa4\x05\x85\xc0 \x89\xb4D\n\x8b\\1\x8b\x9b\xb1\xe9\x19\xea\xa3R\xa90\xbdP\xe6\xd0\xe91\xf6\x9f8\xc7\xcc\xc2\x02:\x8e\x93v蚏Ig,\xc6q\fFb\xd9.ʲ\x12fK\xb5\x96l\xc2tìĥMz\x1a\xe1\x1d\xb7Ǆ\xc6\xe6#\x1f{\x92\xd9rc\x89_\x10B\xfc\xe2\x83\x0f?qi\xb2\xbd\xfb\x9e?9\u007f\xc3\xceM\x9f\x14\x88\u007f[\xaf[x^B\x02\xa87\xddX\x96Ո7\xfc \xa0Z\x9b\xe2\xe7\xde\xd6GO\xa7df\xaeģ\xdf=\xc3#\xfb\xa69|\xd60\xb7\x18\xa4y\xae\x95\xbb\xf0\\\x05\x02\xcd\xd8b\x95gG\x05\xbdm9\n\x85\x98\xd0\xf7\xa9U*(\xe5`\xbb\x9aX\xc4\t\x99\xcd\"\x1dZ\tq\x1c\xa0\xa3\b#\x13\xfb\xe5e\xb3(\xcb&\b#V\xfb!W\\\x15\xf3\x86j\x95\xf1\xb1)\x16\x16\x16\x98\x9d\x9a\xe4\xd8\xd9\xf3\x9c\x1e\x9f\xe1\xcc\\\x8d8Y]\x94\xed\xe0\xb8\x1e\x87\x87GZ\x87\xe6\xac\x18\x83%\x83\f\xde~\xb3\xe6\xb2\r\x05\xfe\xe6\x1fOq\xf4\x94_\x97\xb6\xf10\x8a\xdft\xf8\xd4\xe4쥬\xa9\xb3X\xf5?\xac\x84xw\xc6s\x87\x1c\xdb\xc6V\nU\xaf\x1b\xa7l\x11!%\xe5j\x95R\xa5\xca@w\x8c\xd11\ve\xc1?\xdc\u007f\x82C\xc7\x168q^\xb0X\x8d^\x02%\xc6 1\x18\x13sj\xbaʞ\x933\xb4\xe738\x8eK\xadZƲ=\xdc\\\x84T\x12cb@\xa5n\x9e\xc2\xf1<\x1c\xd7\xc1\xb2\x93M\xa3\x94\xc2v\x1c,\x99$3\xa3\xd8P\xae\xd4\xc8g\xb3LML\x92\x951\xca\x04\x1436cϟ\xa1\x1a\x1a\xa4mc;\x0e\xe3\xb3sԂ0\xf5&\xf5E\xaf[\nA_\x9b\xe0\xf57F\x9c\x1a\x8d\xd8w\xb0\x8cA\xa1\x8d\xa9\x94*\xc1O\xdd\xf7\x9d\xbd'\x94RT*\x15\xc20\xa4\xad\xad\xed\x87\xcee\xf1±\xb33Q\xac\xdf7_\xaa\x04Ss\v\xf8a\xd8\x12\x91kc\xa8\xd4|\xe6\x16\xcb\x18c(\x95\x1d\x1e\xd9s\x9ao\u007f\xfb1\x86\xcf\xce\x13F\x82H\x8b\xc6 \x1aq\x112\xbd\x00j\x11H\x12\x1b\xa1\x88xv\xb4\xc4C\x87Ǚ]('\xf1\x81_!\xa8\xa5\x06<LR\xfcq\x14%\x83+3yr\xc56\xf2\x85BBnpl\x94\x14\r\x16\xa2\xa5$ٌ\x8b\xeb\xb9خ\x8b\x97\xcbc\xdb6\xf9\x8c\x8b%\x15ڀ\xedxT\xfc\x90sS\xb3\x8d\"\x941+K\x88\x10\x02\xa1$\xff\xe6͚\xacg\xf8·!\x8a\x15Ƙ\xb8\xe6G\xbf41[ylhh\x88s\xe7\xce\xf1\xa67\xbd\x89\xe1\xe1\xe1\x1f\xbebx\xf8\xf0a*\x95\n\xbd\xfdC\xffh\f\xef](Wó\xe3\xd3L\xce\xccQ\xf5\x03\x820\"\b\x93\x9d\xef\xa5ĳ v\x18\x9e(\x92u-\xb6\xaf\xd7t\xb7i\x06:\x97\xc8du\xb7o%Pb\r\x91\x06K\xc4\b\x12P\x1e;=Ͻ\xfb\xcfr>]\xa4\xb0V%\x8eB\x8c\x8e\x89\xa3d*u\x14\x06\xc9H\r\xdbF\xaa\xa5\x8e\xaa\vTC\xfa\xbcH\xb2\x10\x8d\x9e\xc4HH\x94\xe3a9.\xc3\x13S-\x9e\xd4\xf7J\x19\xbd\xf3V\xc1\xd5[b\x1e;\xe82:m\xa7\xdc\xe4\xe8\xf7˵\xf0n?\x8c\xb9\xe1\x86\x1b\xb8\xe1\x86\x1bx\xf0\xc1\a/\x8dʚ\x9e\x9e\xe6\x81\a\x1e\xe0\x81\x87\x1e\xe5\x8do|\xc3g\x9f\xd8\xf3\xd8\x1c\x86O\xcc,\x94Wy\xaeMW1O{!G\xc6u\x137U\xa6L\xf78\xc3\xf3g\x87\xc8٣\xac\x1d\bP\xca\x10G\x92C\xc3q\x13\xb9\xeeB;bL2\xfe\xc8V`\xc9\x18\xa3\x93\x94\xf8\x9e\xd3\xf3\x8cϿ\xc8Ͽ\xd2e\xeb\x86\x1c~\xa5\x82\xcc+\xa2\xd0G\xa8$\x86\xf0\x83\b\xd7u\x96B6\xb3D\x95\xa9\x1fn\x11kM\x18\x06\xe88\xa6V\xab%\xa4\x8a\xd8P\x8d%Ŷ\"#S\xb3K#\xa5\xcc\nD\xff&0\x86\xba\x04o\xbb]snJ\xf1\xb5=^B\x11\x8a\xf5ߔk\xe1\x1fL\xccV\x1a\x1b\xfa\x922\x17\x01>\xf0\x81\x0f`\xdb6\xf7\xdcs/J\xa9{3\x9e{\x85\x1f\x86\u007fR\xa9ʟY(Ud>\xeb\xd1\xd5V\xa0\xb3\xadH>\xe36\xe6K\xf9Q\x91\xd0XT\xe29\xd6\xf4O\x12k\xc9Լbb\xae\xb5\x02i\x96\xd1{c\xbd$AJh\x1c\"\xaa\xc6\xe6Ĭ\xcfG\xbe\xf6\x14ﾥ\xc4-\xbb.CZ\xc9\x06\xc0OU\x8aT8A\x88g[\xe9\x84 \x10H\xa4\x84 \x8c\xf1\x83\x88j-`an\x01\xad5\v\xf3\xb3D\xb1\xe6\xfc\\\x057\x93\xa5\x12D̕\xab\xcdŹF\xbaD\x88\xd63N\x84\x10\xfc\xfc\xeb$\xb6\x82\xbf\xbd?\x8b6\x02\xad\xcd#\xd5Z\xf4\xaf'f\xcb\xfab\x01\xe0%\x01\x04\xe0}\xef{\x1f\xe7ϟ\xe7\xd7~\xedר\xd6\xfc\x99\xdbo\xbf\xfd\xe7\xf6\xee\xdd\xf39\xad\xf5_\xccE\xf1\xd0B\xa9\xca\xd8\xf4\x1c=\x1dE\x06\xba;ɺ.R\nt\x9c\xa5\xb3'Ϯ+\xd6\xd0\xd1~\x92\xf9\xf2,S\v\xba\xa1\x9b\xeb\xbd\xee\xad\f\xfa\xe4|(\x95\x02\x12\x1b\x89%4\x91\x91\xcc\x05\x9a\xff\xf1\xadC<wz\x9c\xb7\xddz%k\a\xfb\xf1\xbc,Ҫ\x12\xd6*āO.\x9b\xa1\x98\xf7pl\vMLP\r\x98\x99\x9d'\n\xa3\xe4@\x17?\xa0\\Z\xa4Z\xa90S\xae\xf1\xcc\xc8\x02~,9y~₠\xb8\xb9磙\x1bx\xc3v\x9b\x1bwf\xf9ƞ\x98\x91)\x85\x81cq\xac\xdf~vb\xa1\xca\x0fp\xfb\x81&\xd6|\xe8C\x1f⡇\x1e\xe2\xd7\u007f\xfd\xd7y\xf3\x9b\xdf\xcc\xc3\x0f?|\x9fm\xa9\xddR\xea'\x94Rk\xab\xb5\x80\xb3cS\x8cM\xcd1\xd8\xdbEog\x1b\x19\xd7\xe1ܘ\xe6H\xde\xe1uw\xecfӖ\x19\xe2\xbf{\x81=\a\xe6\x1a\x89\xa1\xa53\xaa\xc0Q\x02)\x96\x9d\xde&\f2\x9d\x9bU\am\xa4<\xcf\a\xef\xfe\x0ew\\6\xc8\x1d\xd7\\\xc6\xdaU\xfd\xc4aHP\xabRY\xb0Yp]\\\xc7\xc1\xb2\x14q\x14\xe1\xd7j\x84AD\x1ck|?`dd\x84\xb1\xf92{OL22[f\xbaT\xb9 5\xa4cM\xf3\\ɺ,{\x8e\xe4\x97\xde\xda\xcb\xf1\x91\x05\x1e|\xc6\xc6\xc0\x8c6\xe6M\xc7Ff'\xf8\x01o?\xf0\xe9\b\x8f>\xfa(\x8f>\xfahS\xae+>o[\xea_i\x1d=\bxR&S\x1a\x86\xcfO0\xb7X\xa6\xbd\x90#\x9f\xf58xT31\x13\xf1\xce\xd7\xf7\xf2g\xbf\xfbJ\xbe\xb3w\x94\xff\xf2\xe9\xfd\x8cMVQ\xc2$6C\x81\x14i:b\xa5\xe6E\x93<\xff3oX\xc7kn\x1e\xe0\x13_<\xce?\xec\x1b\xe1\xc1\x17Fy\xd5\xe5C\xdcr\xe5V\xd6\xf4w7ʆ\x8e\xe35\xc8\xdbZkj\xb5\x1a\xd5J\x85\xb1\x89\tN\x8d\xcf\xf2\xdc\xd9i\xf6\x0e\xcf4\xea\x1c+\xb7\x89\t\x10\x86F뫀\xf7\xbfs\x15\xeb\x86:\xf9\u007f\xff[\x19mDl\x8cy\xd7\xd13Ӈ\xf9!n\x17\x05\xe4\xc0\x81\x03\xf4\xf4\xf4,\xb9\xbc/\xbc\xf0\x12\x88\x10<.\xe0\x8f\xc30\xfam\xdbVi\xf3?,\x94+\x94\xaa5\nY\x8f\xb9\x852U\xbf\x83\xbf\xfc\xbc\xe6=o\xe9\xe3\xa7^\xbb\x99ۯ\x1f\xe2\xeb\x0f\x9f\xe6/\xffn?\xb5jH\x9c\x1aS%E\x03\x18\x8d$6\x12md\xca|4\xdcpU\x1f\x83}E6\xac\xf2x\\\xc6Tc\xc5?<{\x96\xaf\x1f\x18a\xc7@\x81\x9dk{\xe8k\xcf\xe3Z*9K7%|W\xfd\x803\xd3%\x8e\x8c\xcdst\xb2D)\x88\xbe\x0f\x05J\"\x85n\x1c\xe1'\x80;vy\xbc啫\xf9\xe27\x86\x99^\x14`\xf8#\xad\xcd\xfd/\xc5V<\xfe\xf8\xe3\x9c;w.\xe9\xbcJ\x1f_*\x9b\xe7\xa5\x13\x1b\x85\xc0R\xb2CI\xb17N\n08\xae\xdd\xd2%\x95\x9c\xcbaa+E_g;\xbd]E~\xfa\xf5}ܶ\xbb\a)%\x13\xd3U\xee\u007f\xec4\x9f\xf8\xec\xb3\xf8\xb50i\x941\x89\x93\x1c\x1bAl$\xa1Q\b\x01\xae\x05\xff\xf5\xb7\xafc\xdd`\x81\xff\xfc\x97\ay\xec\xe91<W$\x8e\x80\xd6\x10'\x9f\x17\x19A\xa4%\xf5\x9eKY\xb7U\xf5\xd4\xd7K\xd8e\xf5\xbezҍR\xc8J>\xf9\xc1m\xf8\x81\xe6#\u007f5\xcabM\xd4*\xb5\xcak\xc7g\xaa\x0f\a\xcbF\xb7<\xfc\xf0Í\x13C'''\xb9\xee\xba\xebV\xfc\x98={\xf6p\xe3\x8d7~\u007f\x95\xf5\xe1\u007f{Sq\xb03\xbc\xf5\xc9}\aw\x1c9\x9b\xed>=i\xcdV}3[\xf3É\xd8\xe83Q\x14\x9f],U\x83\xb6b\xf6\xad\x81\x1f\xfc\xf2\x9a\x01o\xcbĴO\xa9\x12\x13\x86\xf1\xb2C\xc0Lz\x0ez\xc4ى)\xceM\xcdpjt\x82\x03\x87zx˫W\xb3f\xb0\x83\u007f\xf5\xa6\xcbx\xcd-\xeb\xb8\xff\xb1a>\xf2\xa9\x83T\x03\xb3,\"N'\x80\n\xc1\xb9\x91aff;8v\xa6\x8c\xd1\x02\x11kd\xea\x11ɤ\t\vW\xc2\xf6\xedy\xde\xfb\xd3\xd7\xf2\xd4\xf3\x93|\xecsG\xd3\x00Lc+M5\xb6\xbf\x1f\x85\xb6AY22\xb1#\xef}};]\x1dy\xfe\xfc\xee\x17\xa9\x04\x8a\xacg\xbc\xab7\xabo\xaf\xebS\x9f\x98\x9e7\xbf\xf6\x89{tà\x0f\x0e\x0e6\x00q]\x97\xdf\xfa\xad\xdfbǎ\x1d\x9c={\x96\x99\x99\x19\xbe\xfc\xe5/s\xe2ĉ\x97lC2\xab{\xad\xaf\xb7\xe7\xe4+\xe6+9&\x17\x1d\\'\x19#!d\x041(\xa5\xb4\xe7\xdaH)\xe5O\xdc2\xc0\xbb~r-\xa3\xe3\x15\xbe\xf2\xe0\b\xfb\x9e\x9f%\x8abl[5\x15q\x12\xc3\x18F\x11\xb1\x90L\xce-\xf2\xb9oTx\xe8\xbb\x13\xbc\xfe\x96<w\u07bc\x81\xb5\xab\xbby\xe7\xeb\xb7p\xd3ի\xf8\xf27\x8f\xf3\xa9\xbf?^ߜ\xe4\xac\b!\x05\x83=\x92\xe9\xc9\xf3\x1czv\x91\x91\xf1*9\x19\x836h\xa1QF\xb6\xc4\r7\xee\xeagͪ\x02\xa5r@\xc6\xf2\xd3\xf9[\xf5l\x81\xfd\xfd\xa5\xa4~\u03a2\x10\\>(y˫7\xf1\xad\xefN\xf3\xfc)\x85AP\xf2%\a\x87-y\xcd\x16\xf3\xcb\x1d\x05\xff\xfa\x9e6\xf1s\xbev\x9e\xabVk\x17\x04\xbd\xbf\xf9\x9b\xbfI\xb1\xb8D\xba\xfe\xbd\xdf\xfb=\x1e~\xf8a\xfa\xfb\xfb/\x0eȚU\xbdm\x19Ϲk\xed*\xf7}\xab\xfb3\xaf\x98\x9cZ\xe0\xd0\x19\x870\x96D\xb1\xc0\xb6!c\f\x8b\x95*B 3\x99\x84~\xbf\xffH\x95\xdd\xdb'\xb9\xe5\xfa5\xec\xdc\xd6\xc3\x17\xbf9\xc2\xe7\xbf1L\x14\xc5-s\xdd\xeb\xfc.\x83A\xa6\xe0\x9c\x9d(\xf1W\xff\xe8\xf3ȾI\ueea9\x93\xbbn\xdf\xc1\x9a\x81\"\xff\xe6=;y\xd5Mk\xf8\xf8\xe7\x0e\xf2\xf0S\x13h#\x88c\xc9\xea\x1eM\xa9\x1as\xf0x\x05\x8b\xa4P\xe5\xc7\xc91\x12bY\x80\x19\xfa%\xe6\x16*\xbcpl\x9ae罥3\x1d\xc5KT\xcb\xf0\xbew\fR\r-\xbe\xbb\u007f\x920NT\xa91P\v\x05\xe7\xa7]^q\xb9\xbfk\xc7z\xeb\xc0s\xc3ցB!\xff\x0f\xd7]\xbbˈ\xe4\x8b\xcf\x02\x9d\xc040\x0f\x1c\x03F\xda\xdb;\x87\x8f\x9f8\xb5\xb2\x84\xf4\xf7tJ\xc7V\xff\xces\xed\xdf\x012\xd7_ц\xeb(\xc6&+TCI\x18\x89\x96v\x02%\x93\xe3\xe5\x96\xd2\x1d\x92\xbf\xbbo\x06Kjnڽ\x9e\xf7\xbca\x1d\x1bV\xb7\xf3\xe7\x9f;\xc2\xdcb-a\x82\x88\v\xfd\x17\x956\xceT\x83\x80\xc3#\x92\x17\xbf8Şg\xbe\xc5\xeb\xef\xdc\xc05W\xaec\xeb\xfa\x02\xbf\xfd\xcb\xd7pŷN\xf1\xe7_8J\x10%\xbd\xe1\xe7\xa6%\x93\xf3\x82\x8c\xd2\rR\\-\xb2\b\x00K\x1aT\xaa\xdeFF\xc6\xf8\xd3OL\xf0\xdc\xf1\x18md㠘ب\x97u\x16\xe1[n\xed\xe4\xa6[\xae\xe6\x1f\xbe\xfe\x1c\xb3\x8b\x86\xd8(tڼ#R\x96J\xc6\x15lY\xedr\xf0\fW\x02W\x02\xd8J\xe2ڪ1\xc6\xd6\x00a\x1cS\r\xe2hnn\xe6S\xdd]m\xbf1>1\xb3\xa0\x94\xa2\xd1\xe4\xb7v\xb0\xd7\xf2\\\xfb#\x8ec\xff\x96\x10\xc2\x06x\xeb\xab\a\xe8\xedr\xf9\xce\xe3g8r\xd6\\\xb0[\x12\xd76jiI\x88\x8dŋ\xa7j\xe4\xadi\x06\a\xdaؼ\xaeȮ\xed=\x9c\x9f\xac\xb1X\xd6\x18mZ\x83\xac\x94:d[\xe99\xb7iP<2-\xd8{`\x96\x91\xe1\xd3X\"b\xcd`;;\xb7ur\xe7\xee\x01\xb2.\x1c?[\xe1\xecTbgC#\xf1\xb5\u0098$*\xd7\bB#\x88\x8c$В\x91iũ1I-RDF\x11\x1b\x99.\xa6|\xc9~Mރ\x0f\xffƍ\xcc\xce\a<\xf8\xf0\xf3\x1c?\xef\xa0\x114g\xb8^w\x9dOO{\xc8\xf1Q\x87\xe7O\xb7\xe6\xcd2\x8e\xd5\x18].\x13\a\b\xd7VR\x1bsM\xac\xcd[>\xf2G\x1f\xdew\xe2ĩQ\x05\xb0~\xa8ϵm\xfb\x93J\xc9\u007f\xdd0\xbf\x06\xde\xfe\x9aAl\xa5\xb9\xf7\xdbg\x99\\P+$\xe8\x12\xe2X}\x81\xa3897$6\x8a\xf3\x13\x11V8L{[\x91u\xab\x8b\\wE7Z\x1b\x86\xcf\xfb8\x96\x85Y\x96*\xa9K\x9a1K\a\xbdD\xb1\xe0\xc4y\xd8\u007fx\x96Ņ9z\xbb2\xac\xea\xcdpնNvl\xcca\x19\x9fJM!\x84\x95p\xb1\fD)\v\xc54\x16K\xe0G\x82X/=W\x8f\xb4Ͳ\x05\xfd\x9e\xc1\xf0\xfbwq\xed\xce\xd5\xec\xd9\xf3\fS3\x8b\x9c\x9dr\x89t\xeb{\xdfu\xc7\x02\x96\x05\xfb\x8f[\xbcxv\x19kg\x85!;\"\xed6\x8e\xb5\xe9\xd6\xc6\xfc\xcc\xe1\xc3/\x04jÚ\x81\xbc\x94\xe2\x8bJɟjm\xa8\x91\xbc\xf5\xaeA\xaa\x95\nO<3\xc5\xf4\xe2E:f\x95\xc2o\xf2\xf5\xc2(\x01\xa5\x1a*f\x16\x14Vx\x12\x8c\xcd\xe0@\x1b\xbb\xb6w\xb2yM\x86c\xc3\v\x04Q2\x86\"\xbe\xe0Lt\x1a\xa7\x1aԽ\xd3R\xcdp\xf0D\x8d\a\xf7\x9c\xa7Z\xa9\xd0ݕa\xb07\xc3έ\x1dl\x1e2td\xaa\xd8\xd2\"\xd4\x1eY\xcfI\xb3\xcd\x16\xaem\xe18\x16\xae\xa5p,\v\xd7V\xb8\xb6\x95\xfc\x9f\xe3\xe0\xb96\x9ec\xb5\xdc3\xaeC>\xe3\x90˸\xe4\xbd\xf41\xebR^\x98\xe7\xe4\x91\xe7\xf0\xab\xb3,\x94\x1dNN\xb4zgk{4\xaf\xdaUA\x1b\xc1\x03OY\x8cN/?\xbdںh\xb8`[\x12\xc7RV\x18\xeb\xbbTG1\u007f\xbf\xa5\xd4]\xcby\xbakWe\xb8\xeb\xa6\x1e\xe6\xe7K<}p\x86\xa9ŋ\xb70\x9b\xb49\xb3\x19\x14\xa5$\xa5\x9aE\xa9␓g\x99\x99\xa9\xd0\xdd\xdd\xc1\xba\xa1\"\xb7]\xdbM\xd6\xf195\x12 \x84Z:Tr\x991\xb6\xad\xa4\xe8T\x9f\xe8\x10D\xf0\xc2\xc9\n\xf7=:\xce\xf4|@Og\x8eU\xbd9֯)\xb0m\x9d͆\xfe\x80\xa2[ó%~d\xa1\x94\x85c'\xf7\x04\x84\x04\xa8\xfas\xb6\xb5\xd2]5ڸ\x95\x92l\x1d\x88\xb9ak\x955=e\x94\x8c\x19\x99ʰ\xf7H\x86\xe5U\xdc;\xaf\f\xd92\xe4S\xaeI>\xf5M\x9b\xa8\x89\xa2fI\x85\xad\x12\xdb%W\x98\vV\xefLsm\x85\xea\xeel\xfb\xab\x95\xdaѶ\xac\xcbs\xc3U\x1d\xcc\xce\xccs\xe8\xd8\x1c\xe3\xf3\xea\xa2\xe2l[\x8a0%\"4\x83bY\x8a\xf9\xaa\xa2Tu)ff\x18\x1b\x1d\xc1qrt\xf7\x14پ\xa9\x8bk.\xcb@0\xc7\\I\x12\xe9f\xffG\xa4'\xe2\xa4\xf6\xa6\ue565\x06F\x1b\xc3\xc9\xd1*\x8f=;\xc5lI \xa4KG{\x96\xc1\x81\x02[ַ\xb1m\x9dˎu\xb0\xba\xb3L_[HW\xdeP\xf0\fv:\x87`\xb9\xaa\x11\x02\\ېs\xa1\xaf]\xb3\xbe7b˪\x90\xab6TX\xd3[\xc3u4\xb3\x8b\x0eO\x1d\xcbshľ\x00\fK\xc1\xdbn\xaeЖ\x8b8=n\xf1ͧ[\xd7\xcaNg\xd1/\rF\x13\xd8J^\x10\x98\n!\xb8\xe8\xf4\x97\\\xceB\x1bA\x18i\xf2^\xfc}\xa3\xf4B6\xc3|\xa9\xdc\x02J\xb9Z#\x9f\xc9pb\\1[n\xe7\xe6\xed%\x82\x03O32\xba\x8a\x9d;\xb7\xb2nM\x17\xbf\xf8\xeev\xee<=\xc6#{O\xb1\xefE\x8bs3\x86(\xd6\xc4\xda4\xe6\xe3\xd6g\xe4.\x1d\x9d\x04F\x1b\xaa\xb5\x98\x87\x9e\x1c㑧&ش\xa6\xc0\x8eM\x1dl\x18\xf2\xe8i\xf7\x18XUd\xed\x9a^\xc20\xa0\\\xf1YX\xa8\xb0\xb8\xb0H\xb5ZFkѲ\xa8ɴmsA\n#҂\xf33\x1eGF]&\x17$+M\xb4\x15\xc0\xd5\x1b\"\xd6\xf4&g\xe7>}\xc4Z\xb9\xb5\x0f\xd32\xc8\x1f\x03\x9e-\x89b\x83ߔC\xfb\xff\x01˸\xdal\xdfS\xbcX\x00\x00\x00\x00IEND\xaeB`\x82")
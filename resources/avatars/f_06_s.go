@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_06_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x13:\td\xf3\x89\xef\x00\x00 \x00IDATx\xda\xec\xbdy\xb0e\xd7u\xde\xf7\xdb\xd3\x19\xee\xfc\xc6~=\xa1э\xc6@\x8c\x8d\x06\t\x92\x00\x88&)\x0e\")\x91\xe0,\x89\x96\xa5XR,ɪ\x8a\xedXJ\xaaR\x95*\xa5\xec\xb2Sq⸜*\xcbqY\x91\x94\x84\x16\t\x8a\"5\x91\xe2 \x12\x00A\x80\x04\xd1@cl\fDO\xe8\xe9\xcdw:\xf3\xde;\u007f\x9cӯ\x1b\xa2\x18\xb2\\\"\x1a\xb2u\xab^\xdd\u05ef\xef\xbb\xef\x9e\xfd\x9d\xbd\x86o}km\xc1k\xf4\xf1\xa17\xdd t\x18\x0e\x807\t\xc4mBp3p+\xb0\x1f8\xe1\xbd_\x13B<\xe9=g\xbc\xf7\xa7\x84\x10ǽ\xf7\x87=r\xf9\xde\xfb\xbf\xe3?v\xf7A\xf0\x9eO?\xf0\x18\u007f\x93\x1e\xe2\xb5\xf2A~\xfaЭ\xfc\xc7\xfb\xea\xc5\xfb\xa9\xb7\u07b6\x03\xf8\x98\xf7\xfeW\x11\xe2j)$R\t\xa4\x94\b!\x01\x8fs\x0e\xef=\xdey\xbc\xaf\xff\r\xe2e\xe0_:\xe7~\xff\xde\a\x1e;\x0f\xf0\xb1C\a\xf9\xf4}\x87\xff\x16\x90\x1f\xf6\xf13o\u007f\x03\x9f\xfc\x8bG\x00\xd8w\b\xde\xc8\xc1\xff\xce\xc3/x\xb8Z PF\x11\x04\x06m4RI\xf0\x1e\x10\b!\xb6\x80q\xae~\xaeJKUV\xd8\xca>\xe3\xe1\xdf|\xfa\xfeÿ\x05\xf0\xf1\xb7\x1c\xe4S\x0f\x1c\xfe[@~ \x18?\xf6\x06>\xf9\xd5G\x9a\x1dr\xf0c\x02~\v\x98\x11R\x12\xc5!Adp\xceS\xe4%Ei\xf1ޣ\x94D)\x85\x90\x02\xa5$RJ\x10 \x10H)\xc0Ci-\xd94\xa3*\xabǼ\xf7\u007f\xe7S\xf7\x1f~\xe6\x1do\xde/;2\n?\xf7\xe0S\xe9\xdf\x02r)\b\x87n响i\xfaġ[Z\x1eyP\xc0?\x93Rޭ\xb4\xc2\x04\x06\xa5\x15eQQ\x94\x15BJ\xb4\xd1h\xadA\x80w\x1ek-\xd69\x9c\xf5H%PZ!\x84\xc0V\x16!\x04\xc6(\x84\x94\x14iN\x9a\xe6TΣe}\xa9\xde\xf3\x12\xf0\x05\xf0_\a\x1e\xff\xd4}\x87_\x04\xf8\xe8\xdd\a\xb9\xf7\xfe\xc3\xffe\x01r\xe9\x8e\xf8\x99\xbb\x0f\xbc\x13\xf8e\xa5\xe4\x87Zq\x88T\x92\xaar8\xef\xeb;]H\x9c\a\x8f\xafw\x84\x108_\u007f`!\xeb\xdd \x84D*\x89\x14\x02\xefk\xa0\x8a\xbc\xa4\xb2\xb5\u007f1\x81\xc6U\x8es\xab\x1b\x84\x81!2\xfa/\u007f\xa4\xb3\xde\U000db7fa\xef\xd1\u007f\a\xf0\xb1\xbb\x0f\xf2\xe9\xcb\fʫ\x06\xc8Ͻ\xf3v~\xf7\xcb߮w\xc6\xdd\a~Ox\xffѸ\x15F\xadvH:ͱ\xce\x13\xc5\x01\x1eH\xd3\x12\xeb<ah0aP\x03 \x04\b\x81\xa8\xed\x13\xd6\xfbڝ4\x17!\xa5@I\x85\xf7\x9e\xb2\xaa\x10BR\x96\x15B\n67\xc7l\x8e\x13\xa2@\x13\a\xa6\xfe%\xbfu\xf5\x05\xf0{iR\xfe\xea\xe7\xbf\xfdD\xf9\xd1C\xb7r\xef}\x8f\xfd\x97\xb1C~\xee]o\xec\xda,\xff\v%\xe5\xeb;\xbd\b\xe1\x05\xd3iBԊPJ\x90%\x05\x17\xfc\x876\n\x10xd\xfd\xdc|\xd2\v x@\b\xf0^\xe0\x84\xc7\xdb\xfaj\xb4\x14\x17\xfd\x8a\xa8\u007fi:\xcd8yv\x85\xa2\xa8\xe8\xb7#\xc2\xef\xdd)\x00_\xf6\x9e\x9f\xf9\xd4}\x8f\xae\x02|\xe4Э|\xe62\x00\xf3#\a\xe4\x13\x87n\xe5\xff\xbd\xef1\xfeΡ[n\x15^\xfc\xc7(6\xd7F\xa1\xa1\xaa,\xce9\xb4VT\x95E\nAܪMW\xbd\xe0\x02\xef\x9bgjse\xbd\xc7:\x87oL\x97mns\xd1D]R\n\x94R()\xb1UEV\x94\f\xc7\t\xe3iJ\x14\x06\xe4y\x81\xf7\x9e\x99N\v_\xbf\xf9+V\xc0{N\b\xf8\x85\u07ff\xefѯ\xfeg\xb7C~\xfa-\xb7b$\xe2\xf7\xee{\xcc\xff̡[߯\x05\xff\xaeӉ\x96\xbcwl9\x03W\xdbys!\xa4\x05\x84\xf7\xb8\xfa\xbf\xeaE\x12\x02먣,\xea\xbb_*\x85\x14 \x85DH\xd9\xec\x16\x81\x90\x82\xaa\xacH\x92\x8c\xd1tJ\x92\x96\xb4[1\xb33\x1dBc8{~\x9d\x8dɔ\xb9N\v\xff\xfd\x17a\xea\xe1_\x9c\x1bO\xfeٶNۿ\xda9\x8c\xfa\xd1$y\xb7\x10\n\xcf\xef\xde\xf78?{\xe8\xd6_W\x82\xdf\xeeu㎷\x0e\xad$\xcez\x8c\x96\xb4\xda\x11F\xcbzq\xbd\xdfZ$\x01(\t\xceyʢ\xc29O`\fQ`\xd0J\xa1d\xbd#\x84\xa01MP\x96\x96\xf1x\xcap4as\x9c\xa2\xb5f~\xae\xcf\xec\xa0K`\f\xe0\x19O\x12\x924\xa7\x1d\x06\xff\u007f\xb7b \xe0\xed\x9d\xc0\xcc|\xfa\xbe\xc3_\xfc衃<s\xe2\xec\u007f\x1e&\xeb\x13w\x1f\xf8o\xb4\x94\xff{\xa7\x1b!\x04H\x01\xdeZ\xc2\xd0 \x95\xd82=\xa2\xf1\t\xb2\xf9=\xeb\x1ce\xe5\x11R`\x8c\xaeCZ/\x80z7\xd0D\\\xd69\x8a\xa2$\xcdr\U000a2932\x1e!\x14\xedvL\xab\x15\x12\x04\x1a\xfc\x05\xc0\x1c\xa7\xce,3NSf\xbb\x1dT\xe3_\xfe\xca\x15h\xee\f\x87\xbf\xe5\xde\xfb\x0e?\xf1\x91C\xb7\xf1\x99\xfb\x1e}U\x00\x91\u007f\xed\xa1\xed[\x0f\x8a&\xdf\xf8%\xa5\xd5\xff\xdc\xeeDu\x14$\x04ZJ\x02cj\xaf\xe0<\xd2_\xf0\x10\xb5\xa9\xaa\xac\xa3r\x1e!$\xad\xc8\xd0\n\x14\xaa1GZ\t\x84\xf0H!(\x8a\x82\xc9x\xc2\xc6Ɛ\xe1pRGSBъ#f\x06m\xba\x9d\x88\xc0\xd4`\xf8f\xd5\xf3,'/K\x80:\n\xfbK\x8b\xff=6L\x80\x14\xe2\x93\x00\x9f\xb9\xefQ>\xf0\x96\x83\u007f\xf3\x00\xf9\xf8[\x0e\xf0ɯ\x1f\xf6?\xf3փ?-\xa5\xf8\xb7\xad8\b\xbd\xf7h\xa3\b\x8d\xc6Y\x8bV\xa0\xa5DI\x81n\xfcHQ\xd6f)ԚHkB\xad\x90B\"\xa5&\xd0\n\xdfd\xeb\x1e\xcf4\x992\x1eO\x98\xa6\x19\xb6r\x18m\b\x82\x90\x99~\x9b\xb9A\x87\xd0\xe8:\x8fi\x80\x06\x88\x02S\xbf\xde:\xf0\x90\x95\xd5_\xf2\xe6\x97<\xfbW\\\xd2\r\x1f{\xebm\xbf\x0e\x10\x8b\xbf\xa1&\xeb\xe3w\x1d\xf8\x90\xd4\xe2\x0f\xe2\xd0\x10\x18M\x14\x1a\x02)I\xb3\x8cVX/\xb4\x125!\x98[\x87\xb5\x8e(\xd0\xe8\x868\x14\xa2\xce9\x84\x94$Y\xc1x\x9a\x13\x84\x1ae4\x9b\xc3)yi\x91H\xa4\xd6H)\xd1F\xd1i\x87H\xa5\xea̾y\x0fD\xbd\xf7\xe20\xc0Y\xcbS/\x9c\"/K\xb4\x92\x94\x95e\xd0n\xa1\x95\xfcaV\xe8yk\xfd\x8f}\xe6\x81\xc3/\xff\x8d\x01\xe4\xc3w\x1f\xa0\x9c@\xd4\xe1\x1e\x81\xf8d\xa0e\x1c\xc7!q\xa4\x91^P\x949\xed(@7\x8e\xb8\xaa,\xa5u()\bu\xed\xa4\x95\xac\x17\xb0\xb2\x9e,/H\xb2\x12\xa9$q\x1c\x90\xe5\x15\xa3I\xde\x00\xa0\xd1J#\xb5$\f5Fi*\xe7\xb1\xde6`\xca:Lnv\x86ъ\xa3/\xbe\xcc8\xcd\xe9u[\b\xe7Y\x19\x8ei\x85\x01\xad0\xf8\xa1\xae\xcf\xc3\xff\xe0\xfa\xdd\u007f.^:\xe9\xef}\ua97f\x19;\xe4#w\x1f\xb8B£R\xca\xf9\xc8h:\x9d\b\xacC\xe0\tC\x85\xa9YsҼD\nI\xa0\x1b\x9f\xa2\x15J+\x92\xb4 I\n\xf2\xa2\"\x884q\x14\x92\x97\x15Y^a\x1d(\xa5\b#C\xd0\xf0T\x88:4v\xce\xe1\x1d\xd0P)B\xd4\xc6J\bɠ\xd7\xe6\xc5cg\x18N\x12r\xebسk\x91\xf5\xd5!I\x9e\x93\xe4%3\x9dV\xcd\x00\xfc`@6\xfcxr㽇\x9f;\xf3\x9a\xf7!\x1f~ˁ&\u007f\xe0\xdf\bļ\x92\x928\x0ekZ\x1c\x87\x0e\x14BH*뙦e\x9d\x89\x1b\x89Q\x8a8\n\x90R\xb2\xba>fs\x98Py\xc7`\xa6M'\x8e\x98&\x05\x95\x05\x13\x18\xfa\xbd\x16\vsmZq\x80P\x02\x8f\xaf\xc1\xf0\xae\x8eΤD\t\xb9\x15\x0e{\xef\x19t\"VV7\xc8\xf2\x82i^0\xd3\xeb\x90g\x05RI\x02\xa5p\xceQ\x94\xd5\x0f{\xd7Έn\xe7\x9f\x03\xfc\xd4ݷ\xbdv\x01\xf9\x95\x1bo\xe0\x0f\x1ex\x9c\x0f\xbf\xe5\xc0\a\x81\xf7\v!\b\xc3\x00\xa9\xc0Z\x8b\xd1u\xc8Z\x96\x96$\xad\x90\xba\xde\x11Z)\xc2\xc00\x9e\xe6\x9c>?\xa4\xb2\x9eV'dn\xa6\x8bs\x9ei^\x12\xc5!\x9dv\xc4\xfcl\x87^'F\xcaz'I\xa9\x1a\xff\xd0D_R֔\xbc\xbc\x18@\xf7\u242a\xb2,\xafn\x92\x97\x15B\b\x06\xdd\x16\xc94\xa3\xd7k\xe3\x01%\x05Ӽ\xc05\x05\xae\x1f\x02\x94\xbf\xfb\xf1\xbbo\xbb\xfd\xf7\xef\u007f\x94\x8f\xbd\xed\xf5\xaf=\x93\xf5\xa1\xbbn\xe1\xb3\xdf8\xc2\a\xef:\xb0M\v\x8e\x00\xdb\xc2@\xd3n\xc7ت\"\x0ek0\xaa\xb2\xa2*\xcaڹ\a\x9avh\xb0\x95e4\xae\xcb\x12Qc\x86<\x82\xaar\b)\x89\xa3\x10\xa3\x15J\xd7a\xb2\xf7\x9e\xb4\xa8\xa9\x10\x8b\xc79\xdf\x10\x8a\x12Q\aN\xd8ƶ\xf4:1\x02x\xee\xa53$y\xc9\xc64\xe1ʝ\xdbp\xceQ\x96\x15AhX[\x1b\xd1\x139\x1b\xb9\xa3@\xd3kE\xaf\\\x94\xefc\xc6<<\xf6\xe9\xfb\x1e=\xf8\x9a\xdc!\x9f\xfdƑ\vo\xf0?9\xef\xb7\t\x01Q\x1c5\x17\xad\xf1\x1e\xf2\xbc$KK\xb4\xd1(\xa5hE\x86\xc94cucB\x18\x1a\xbaݸ\xc9\vj?\xd0n\x85l\x9b\xedю\x03\xba\x9d\x88\xa5\xf9\x01F+\xf2\xd2b\x9dG+\x85\x16\x8a\xc8\x04\x84Jס\xb1Vu\xc6\xee=3\xbd\x16J\t^8~\x96\xb2\xaa\x18\xa7\x19\x8b\xb3}\x8c\xd1\xe4E\xc1`\xd0#Ir\xbc\x80\xa5n\xc0\x87\xaej1\xc9K\xa6y\xf1ʅ\xf7\xfe\x15_\x97ܽ\xfb>z\xe8\xe0\xde\u05ec\xc9\xfa\xf0]\a\xee\x04~\x1ajN\n@\xeb\xfa-\xf3Ғg\xb5\x83\x96B\x10\x1a\xc5p\x98\x92$\x05s3\x1d\xa4\x12L\xa6\x19RJBc\x98鷙\x1ft\x11Z\xb10\xd7g\xe7\xd2\x02\xe3$'\xc9+\x94\xd4\x18\xad1\xc6\x10G\x11Z+\x84Q\x18\xa3\x1b\xfaW\xb2}~@;\n9v\xf2<\x95u\f\xb3\x9c^'fa\xae\xcfpsL\xbf\u05edY\x80\xca\xe2\x9dg03Ǐ\xdfu\a\xf7ܲ\x87Q\x92QZ\xfb}\xed\xc5%\xc0\x04\x02~\xa4;D\xff\xa7\xfc\xd2=w\x1dDRI\x0f\xbf,\xa0\v\xd0n\xc5XkQZS\x16\x15yV\x10\x04\x1a\x01\x84a@YY\xbc\x80\xc1L\x9b\xd14\xc3YO\xbb\x15\x12G!\xfdNL`4&0l\x9f\uf8d4\xe4\xfc\xf2\b\x8f \n\x83\x86\xf5\xf5x/(\x9dE!q\xd6QT\x15q\x14\xb2m\xa1Oe-\xcf\x1c=E\x9e\x97\x8cӜN\x1c\xb1k\xdb<+\xebC:\x9d\x16Qh\x98LRDS\x87\xef/\xee\xa0s\xdd\xed\xfc\xec\xe2\x15\x1c]\xff\x1c/\x9dYg\xb6\xd7~e\xd4\xe5\xbf\a\x98\x108\x00\xfc\xc1k\x8a\\<z\xf2,\xd7^\xb1kQ\xe0\u007f\a\xefE\xb7۪I?\xa5\xb0Β&\x05JK\xb4\x92\xb4\xe3\x10\xbc\xaf\xed\xb2\xf7\x8c\xc6\t\xc2IZq@\xbfۢ\u05c9\xd1Z3;\xdbeq\xdb\x00\xe7acc\x8as\x1ecj\xbf\xa3\xb4F*\x85P\xa2\xf6-B \x05\xcc\r\xba,\xcc\xf5\xd8\x18&\xbcx\xec\f\xd3$c\x98\xe6tZ\x11\xbb\xb7/0\x1c'H\xad\xe8\xf5;\xe0=\xe3IJYUTU\xc9\xdb\uef03ko}\x13\xaa\xdbg\xff|\x9fG\x9e~\x81q\x9a}o\x01\xebү\x9a\xeb_ݷ{\xf1sϟ<o_\x13&\xeb\x03w\xdcԄ\xb9կ\x80\x97&ЄQ\xd0\xdcL\x9e,-\x10\xa2\x16 DQ\xbd3\x94\x92$i\xc6p\x9c\x80\x17\x04\xa1\xa1ߋ\t\x03\x8dw\x9em\x8b3\ff\xba$i\xc9pc\x8a\x14\x82N'\xa6\xd3\x0e\t\x82\x808\x8e\x88\xe3\x80A\xa7\xc5L\xb7\xcd\xe2\u0080\xfdW\xed \x8eBN\x9c^\xe3\xe53+\x14e\xc5f\x923?\xd3\xe3ʝ\v\xa4YAQ\x94\xf4\xba-pu\x1d\xa5r\x0e\x81\xc0hþ=W\xa1\xdb}\xc2\xf6<7\x1d\xbc\x8bO\xfc\xe4{\xb0\xce1\xce\xf2z\xed\xc5\xf7\r\x81\xf6\x05ȅ\u05cc\xc9\xfa\xfc7\x9f\xbc\xf0\xedox\x0f\xad8\xc4U\x0e\xad%iZଯ\xa9\x0e\xadjB\xd1hƓ\x84\xe94\xc3(Eh\x02z\xddx\xcb4\xec\xdc1O\x18\x19F\xa3\x84,+\xd0Z\x13F!\xce\xd5\u007f\xa4\x1dj<u\xc0 \x84@*A\x9a\xe4\x9c8\xbe\xc2p2%\xcf2\nk\x99\xa6%{\xb6\xcf1;\xd3a<\xcd\xd9\u061c0;\xdbCI\x89s\x1e[9\xbcs\xe4e\xc9\xe2\xdc\x1c\xd7^\u007f3B*d\x10bM\xc4\a~\xe2\xc3<x\xe4\x19\x1e}\xfai\xb4\x94D\x17v\xca\xf7\xb2\xc0{\x95`\x018}\xd9w\xc8=w\xdd\x02\xc0\a\xef\xbc\xf9\x17\x80\xd8h\x85\xd65\x8dQU\x8e\xb2\xa8Ц\x8ezB\xa30Z2M2\xa6Iƅ\x1c\xa5\u05cd\x10\xd4r\x9e\xa5\x85\x01\x1eX_\x9f2\x1e\xe7h\x1d\x10\x84!BH\x82\xc0\xd0\xee\xb4\xe9v\xdb\xcc/\xcc\xd0n\x858\xeb8wf\x8d\xe3'\xceSV\x16\xe1-i^\x11j\xcd\r\xfbwp\xe5\xceyҴ`ye\xc8\xdcL\x87~'\"P5e_T\x15\xb6\xb2L\xf3\x827\xde|\x13\xaa?\ah\xbc\x935\xa5#\xe1\x9f\xfc\xf2/\xb2\xb4m\x1b\xa3iJe\xed\xf7K\x14\xfa\x0e\xbf\xf7]\a\xaf\xbb\xfcQ\xd6\xe7\x9aP\x17\xc4\u007f\r\xbeI\x02\xeb\vβ\xa2\x11#H\xa4\xa8\xef\xb04-\x18M\x12\x04\x92(\b\xe8wbJ\xe7@\bz\xed\x16e\xe58\xb72$/*LP\xbb3\xa9\xeaD\xafՎ\xe9\r\xdat\xfa\x1dl\xe98{f\x9d\x97O\xaf2MKZ\xa1\xa1,J\xf2\xdcqŎyn\xbav7۷\r\x18\x8f\x13\xd6\xd7\xc7,-\x0e\x98\xe9\xb5\b\xb5\xa2\xf2\x1e\xe7,i\x9a1\xcd\vf[\x11\xef:t\bJ\v(\x94\t\x10\xd2\xe3\x10\xcc\xef\xda\xc1?\xfe\xa5_&\x8e#&Y~\x91\xfd\xf5\xaf\xccڤ\x94o\x1et[\xe25\xe1\xd4\xef\xb9\xf3\xe67\tį\x81h\xb5;-\x94\x92\x94\x85\xa5ȋ\x9a*\xf7~+9[\x1f\x8e\x10\x1e\xb4\xd1\xf4\xbaQ-B0\x8avT+I\x86\xa3\x84(\f\xf1\xde#\x95\"nE\xe0\xa1?\xe8\xd1\xee\xb6kZ\xe5\xfc\x06\xe7ί3M\xab:Q\x94\xb09Ni\xc5\x01\xd7]\xbd\x93ٹ\x1eZJN\x9dZaemȎm\xb3\xe8\xa0N6\xcbʒ\xe6\xb6.\xe9NR6\xc7\t\xbf\xf0\xf67s\xdb\xdb\u007f\x02\x81n*\x8e%(\xa8\x9c\xc2+\xc9\xce\xfe\x02V:\x0e?\xf9\x14R\n\x02\xa5^Yz\x17[\xeb\xf6ۭ0\xf4g\xd66/{\x1er\x00\x18\b\x01\xc6(\x9c\xf7\xe4y\xcdĂ \b\xea\x1a\xc6h\x92ԅ#\xa5\x88ºf\xee\x9a\xeb\xb1\xd61Nr\x941dy\xcdoI%)r\xcb\xec\\\x9f\xb8\x1d1\x1d'\xbc\xf4\xe2i\xce-\x0f)-\x84\xa1B\xe2)\x9d\xe7\x9a\xfd;8p\xcb~\xba\xdd6\xe94\xe3\xd9\xe7N2\x99f\\s\xe5\x12\xed8\xac\xb92)I\x8b\x8a\xbc(\x18\x8e&\xa4E\xc9\xf53!\a\xee|\x1b\xca\x18\xbc\x10xY\x82p\xe0@j\x87t\x12b\xc1\xbb\xef>Į\xa5%\xf2\xd2\xe2\x85xE\xfc\xdb\xe4#\xb7'\x85\r\x1fy\xfe\xf8\xe55Y\xef\xbf\xe3\x16-\x848\xe0AIY\xcb8\xab\xa2\xc2\xda\xda' \x04\xad\xb8\x8e\xacF\x93)F\xd6u\x8eV+\xc2\xdaF\x14\xed\xa1hT\x87eY\xa1\x8d\xa6\xb2\x16\x01l\xdf>\x8bs\x9eS'\xces\xe2\xc4y\xd2\xc2\x12F\x01F\v\x02cX\xda>ύ7\xece۶\x19\xaa\xb2\xe4\xf8\x89\xb3\x1c;~\x96\xf9\x99\x0e\xd7\xee[\xc2\x18\x85V\x82\x96ьҚ9\x1e\x8e\xa6\xb5\xba\xa5*x\xeb-7\xb0}\xdf\xf58\xe7Az\x84s\xe0\x1d\xdey\x84\xf7\b_a\xbdgi\xdbv\xeex\xfd\xedX[]\x10qs\xb1\xf6(\xc0{\x19+\xf9\xfa\xcb\xeaC\xde\u007f\xf7\xadH\xe1g\xbc\xf7\xb7\xd5\x19yS\xc9+\xaa-Mm\x18(\x8c\xd6l\x8c\xc6[ۻ\u05cb\xa8\xac\xc5\xe3\x1b2P4w\x1e(\xad\x98&\x19\xa1\xd1\xcc\f:\x9c[\xde\xe4\xa5\xe3\xe7\x18\x8e3Lh\xe8\xb4\x03\x84\xf0,-\xcdr\xe5\x9em\xcc\xf4[\b\xef\xd8X\x1d\xf2ؑ\xef⊊k\xf7\xef`q\xae[\xeb~\x1b:\u007f\x98d\x14e\xc54\xc9(ˊQ\x9a\xf3\xba\xb96o8\xf4nL\x10\xd6\xd5DW\u0b45\v\xe2\n\x0f\b\x8bp\x8e\xb2*\xb9\xe7\xdd\xef\xacE{E\xb9\x05\x87\xc7_\x02\x8b\u007f\xdfe\x05\xe4\x8f\xee\u007f\f`\x00\xdc\xe0\xbdC*\xd5\xe8k\x1dJJ\xa4\x14DQH\x9e\x97$I\x86ъ \xac\xabz\xde\vDC\xabL\xf3\xb2Q\x95H&ӜV\x14\x10E!\xa7\xcfm\xb0\xb19EKɠ\x17\xd5ūаo\xcf\x12q`\xa8\x8a\x82\"/x\xf9\xf4\n/\x1e;\xcbUW,\xb0\xef\xaa\xedh\xa5\xa8\xacC4\xca\xf8\xe1$%\xcb+\xa6Ӕ\xf1$e\x94\xe4\xecj\x19>\xfc\xe3?Ύ\xebn\xabw\x86pxoq\xde\xe2\xbcoh\xfc\x1a\x1c\x81\xc7y\xcbҶE\xee\xbc\xedV\xb2\"\xdf\x02\xe1\xd2\xf8\xd7\v\xf1\xde\xcb\x1ee\xf9\xda\u007fĈ\xba\xeePU\xae\xb9k|SɓlN&u\xb4\x85$\n\x03\xacs\xd0\bⲬB+E\xa05\x93\xac \x0e5\xaa\xa9\x87H \n\x15B\v\xa6iA\xb7\x13\xd1\n\f\xd3\xe1\x94\"-Xߘp\xec\xf8y\xb2\xac\xe0u\xd7\xec\xa2\xddk\x93\xe5\x05eek\x05Ji\x19\xa5\x05I^2Is\xd66&L\xf2\x92\x96/\xf8ٷ\xdf\xc9\ro\xff0*Ԁ\xc7:\x8bs\xb6\xe9-q\xe0+\x84\xf7\xf8\xfa\xa3\"\x10T\xd6\xf2\xd1\xf7\xbd\x8f\xb2\xaa\x85ݗzt_\xcbYo:\xb0\xef*}Y\x00\xf9\xc0\x9d\xb7\\\xf8\xf6\xbd\x1e\x81E \x95\xa4\xb4\x15\xaeQ\x15F\xa1!\xcds\xa6I\x8eQ\x1a/<Z\xd7\x11\x981\x92<+1Ja\x94b\x9a獐\xa1\xbe\xf0v\x1c\xb0\xb29a}4\xc1U\x8e\xc8H\xf24#MS\xa6I\u0089\xd3+\f\x87\x13fg:\xec\xdc1\x87\xb5\x96,\xc9)\xf2\x8a\xb2()\x8a\x8aQ\x923IrF\x93\x9c\xb5\xcd1\xa3,\xa7mS>\xf1\xd67s\xdbO\xfc\x1c\xa6\xd3\xc2kp\u0083sx\xeb\x9ag\x0f\xb6\x06¹\x86\xab\xf4`\xbd\xe4ʽ{\xb9f\xef^\x8a\xb2\xfc+\x95\x10\xfbw\xf6w^\x16@>\xff\xe0\x91\x86.\xf1\xef\xf2\x0e\x84\x13H\x1a\xf9\xbfw(Q\x9b\xa0\xd18\xa1(j\x81\xa71\x9a\xaar\x98@ST\x16)$FK\x924'2\x86P)\"\xa3\x19M3\x1ey\xf6$JK\xdaQ@\x99\x17$iN\x9a\x95\xac\xac\x8d8}~\x83n\xcb0?\xd3&\x8c\x02\x92iJYTdYF\x9ef\xe4E\xc5\xe6$e\x92\xe4\xacmNY\x1bNX\x1b%\xccj\xcf\xdf{\xe7[\xf8\xb1\x8f\xfc\"\xa6\xd5\xc5K\x85wu\xdd\xd7{O#\xad\xa7\x96I\x82w\x17u\xc3uM\xc4ct\xc4]o\xba\x9d4/.1Y\x17\xc9-\xa1h]6\xea\xe4\xfdo\xbei\x97\xf7~\x87\xf3\x0e\xa3%^x\\\xd3R\x16\xc7!\xc3ɔ\xd14EiIYU\xf4\xbbu.b\xcb:\nk\xb75i^\x12\x87\x9aN`@\n^xy\x85iVr\xd5\xee\x05\xbc\xadHҜPk\x8a\xaa\xa2(\x1d\xadȰ8\xd7!\f4\x95s\xe4\xc3\x11BB\xd9Ȇ\x8a\xd22\x9c\xd4\x1c\xd9\xeaƘ\xacr\x8c\xa6\t\xd7\x0f\f\xef\xb9\xfd \a\xef\xf9E¨\x8b\x10\n_\xc9\xe6\xe6\xae\xc1\x10\x17\xb6B\xa3\f\xf3\u007f\x85\xb6TH\xc9\r\xd7\\C\x14E\xf8\v\x04\xe9%\x06\xdcZ\xbf\x13x\xf6\xb2\x00\"\xf0\x1f\xae/\xc1!\x85\u07ba\xa3\xbc\x92\x04\x81fmcLe\x1d\xa1\xae\x9bk\x94\x92\xd8\xcaaˊV+\xac\xe5\xa0Z0h\xc7X\xebx\xe1\xe4\nZ+v-\xf6ɲ\x9c0\xd08\xe7ɲ\x02\xa35\xedVD\xa7\x15R9G\x99dx\xeb0\xba\xbe3K\xebH\xf2\x92i\x92q~m\xc4p\x9a\xa1\x94bFU\xbcqO\x87\xab\xf7^\xc5M\xef\xfbyLܧ,+\xa4(\x91\xae@\xd4\xf5F\xc0֎\xdc{\x90 \xd1\xdf\xcb&6\xe0-\xce-\xb0ma\x81\x8d\xf5\xb5\xbaO\xe5\x92\x15\x11\xc2w^u\x93\xf5\xbe7\xddt\xe1۟pMyӋZ`\xe0\x1c\x04\xdaPY\xb7\xa5\n\xf4\xceъ#\xca\xd2Q\x14\x15:\xd0H]_\xdcl\xafM^\x94\x1c\xf9\xeei\x8cQ\xb4#\xc3t\x9ac\xad'KK\xb24G\n\b\x02Ih\x14EY\x92LRlQ\"\xa5\xc7:\xcfh\x9a3\x1cgl\f\xa7\xbcpr\x99\xb5q\x8a\x90\n\xb3\xbaJ\xbc\xbcƩ\x97\xd68\xfc\xc4I\x1e\xff\xca\x17Y=\xf1\x02\x0e\x8f\x17\x0e\xefJ\xac\xad\xf0\x0e*[\xe2\xbc\xc5\xfb\x12|uI\xd2w\xc9\x17\xd4\xf5\x9bA\x9f\xa5\xf99Jk\x9b<\xaa\xa9$\xd6\xda\x16\U000ea6ec?}x\x8bݽ\xb1\xeen\xaa;\x9b\\U\u007f0m$Y^nu\xc3j\xa3\x90R4\xa25Ѵ\x1b8f\xdb-&ӌg\x8f\x9f\xa7\xdd\n\x89\x8c\xa6(,\x91\x91\x14E\x81ъ0\fQJ\x10j\rޡ\xa5@(\x89\xc33\x9c\xe4\xe4y\x89\x03V6Ƽ\xbc2$\b\x14]\xadi\xad-#\x92\x82\xcd\xcaQ\x94\x19gW\x9e\xe3駎\x12\xff?\xbfõ\xaf\xbb\x96\xb7\xbc\xe7\xfd\xec\xbc\xeez\xe2v\x1b/%\xde;\xbcr\xe0\n$\x1a\xaf<[\r([f\xac\xfe64\x9a\xddK\v<\xfe̳\xaf$}\xfd_\x8fj\xe7?\xc9d}\xf4\xcd\aEF\xd1u\x17R\xa2&I\xba\xf0\xa9\xd2,\xe7B`\x184\x99\xb7u\x9e8\xd2Xg\x19\xb4\"\xa6Y\u038b\xa7V\x88BC\xbf\x13\x93$\x05Qd(\xcb\x02c\x02\xe2\xc8Ў\r\xed8B\x02\xa5\xad\xc8\xcaZ\x87[\xb7\xa8\tҼ`y}L\x99\x17,\xb4Cf\x02ɞ\x18\xf6\xde\xf6fZsWPa\x18mn\xf2\xf4\xe3\x8fs\xe2\x85\xe7H\x93\x82G\x1f=\xc2铧\xb8\xe7\xa3\xf7p\xcd[ޅ\x0ec\xbc\xb0TU\x068$\x9ePy<\x15x\xc1d4f2\x1ec˂,͐\xc2QNS\x9cu\xdfkƅ\x10\x97\x05\x90\x8c\xf2J\xe71\x17#\xbfz\xcbZ\xe7)KW7\xc2P\v\xaa\xa5\xaa\xa3+)\xc0yO7\x8e\x99L3N\x9c\xdf \n\r\xddv\xc4h\x9cԦ\xae\xac\x98\x1ft\x98i\xa49Y^R\x96\tZK\xf2\xaa\xa64\xa4\x14\xa4y\xc9\xf9Q\x8a\xc8s\xe6\x03\x89굘\x93\x15\xf3\xa2@\x8b6\xa3\x89\xa7\xb2+\xb4\xfa}v\xed\xdaƕ\xfb\u007f\x92cG\xaf\xe4ȷ\x1f\xe1ıe\x86\x93\x84\xcd\xd53\fϞ\xa0\xbf\xe3J,\x05h\x87\x94`mIYd\xb8\xbc\xe0\xfe?\xff*\x87\xbfs\x98\xc9x\x88-\n\xf2\"C\v\xcf\x10\x1a\xb6!؊~\u007f\x04X\xfc\xf0\x80()wk%E҄\u007f\x17\x8a>\xce{\x9cuT\xcdfQZ\"\x10X\xebPF\x12\x19\xcd\xdap\xc2\xf2\xfa\xb8Q\x95D\x8c&\x19\xad \xa0\x1d\a\xecZ\x9c!\xaf*6\xa7)IVҍ\x03\xbc\x82\xe14\xc3\x18EUyVFS&i\xc1\xf5\x91\x87( 3!\xfd\xf1:g7\x12\x9e\xc9-\x9bI\xc1\xa4x\x8a\x9e\x91\xecl\a\xccD\x8a\xed\xbbf\xb9\xf1\x8e;\xb9\xf5\r\xafc\xf7\xae>E\x06AX2\xd9x\x99`0[\xf7*J\x8f4M\xb7\x96\x9cp\xe4\xa1o\xf0ǟ\xfd\x1cYV\x00u\x95\xd3{\x8f\xb7\x15\xa5\x11x\x19n\xb5O\\B2^\x1e\x93Պ\xc2k\xf3\xcaJ\x1a\xb1\x81\x94\xa2\xce^\xbd\xa7rU\xc3\xf5x\x94\xd2[jBc4\xce{V6\xa6\x94\x95en\xd0!I\v\xdaa\xc8\xfc\xa0\x85\x92\x9aS\xabC\xc2P\x91g\x15\xad8 )J\x92,#\f%\x1b\x1b5]\x1e\v\xcf\xcd\x1dæ\x8aikI\u007f}\x85'\xceOynmʴr$\x16\x96\x16\x17yi\xf9\x1c\xcf\x0e\v\xae\xec\xc6\\\x9f/3^\xfb3\xae}\xe3\r,,-\x10\xb7\xdaH\x1dP\x14Sl\x99#M\x88w\x16\x9fU\x94yƋO?\xce\xd1o}\x93\x1d\x83\x90 \xe8\xb3s\xfb6vm\xdfI\xab\x1d#\x85\xe2\xd1\xe7\x9f\xe7\x0f\x9fx\x96\xbf\xd4\x03\x97WN|\xf1\xb2$\x86A`\x06Z\xa9:tw\x8d\xf7h8 \xdb\x14\x9c\xbc\xaf\x85kֹ-\x92omsB^V\x84A\x80\x94\x8a^+d\xa6\x17\xb31\xcd\x19\xe79\b\xcfx\x92#\x94`\x9c\xe6L\xb3\x12\xeb+έ\x8eY\xdf\x1c3\x10\x8e\x1d\xed\x90,l\xb1=\x80%Wr2\xd3\x1c]ϱ\x83\x19ҙ\x80Ӆ\xe6m\x1f\xbc\x87\xab\xdep=\xef\xfc\xf8ߣ{Ս\xbc\x98i\xacj\xb1\xb9\xbcB\xd4\xee\x11\xf4\xe6h\xcd,a:s\x8d\xefs \x15\xa5-x\xf6\xe1\xfby\xe6\xc1\x87\xd8\x1c\x97L\x11\x9cKR\x1e>\xfa]\xbe\xfa\xe0\xc3<\xfa\xf8\x11\xa6\xd3I\x9d\xd1_\xdc\x15\x17\xa2\xde\u007f\xff\xf9\a\x0f\xaf\xffܻ\xde\xfc\xea\xed\x90\u007f\xf4\xd1;\xf8W\xf7~\x13\xa5\x956\xce\x02\xb5s\xf5\r\xb7s\x81N\xf7M\x97\x12\x88\xda\xee\x1b\xc94+\x19Ms\x10\x92^\xa7E/\x0e\xa8ʊ\xb3\xeb\x13\x82\xc8`\xf3\x12\xef=\xad8`\x9a\xe5H\x14YU2\x9ed\b\xe7\x18\xc4!\x83~\x9b\xc5\x10\xb6\xf7[\xf4\x17vқ[b\xee\xdcy^:\xf3g\xf4\x17\x97X\x0f\x03\xae\xbd>\xe6\xd4\xe8\xdb\xec۷\x87\u007f\xf0\xab\xff-\xcb'\x9e\xe2\x0f\xff\xf5\xbf\xa4b\x83\xd6`\x9e\xce\xe2.\x82\xa8\x836\x01&\x1e\xa0[1&\nq\xaeⱯ\xddϋO<\xc9s'\xcfrd\xb5b\xbd,\xd0\xe1\x1ci2a`\xa7\\q\xfc\xbb,/\xafr\xae\xacpx\xa4\x90\x97f!\xff\x14\xe0\x8f\x1f:\xf2\xea\x01\xf2\xaf\xee\xfd\xe6\x05\x83\xb9C\n\xb5\xb5W\x9dk\xea\x1fM\xdeqAg{!Oq\u07b31\x1cS9\xcbܠ\xcbι.\xab\xc3\t\x1b\xe3\x940\f\xa9J\x87\xf7\x8eN;b4\xc9QJ2\xc9S&Ӝ80,\xce\xf4\xd8\xd1\x0e\xd8.s\xe6f\xba\xec\xb9\xedv\x16\xf6\xee'\xcbK:\xf3s\xfcZ\xdc\xe3k_\u007f\x84\xf9\ue55cN'\f\x8a\xab\xf9\xaf~\xee\x1f\xa0|I>\x1a\xf1\xde;\ue82a\x96\xd9}\xcb5(S'}&j!u\x8c\xee\xceҙ\x9ba\xf9\xbbϲv\xfa\f/\xbc|\x8e\xcf>{\x9a\xdd7\xdd\xc1\x87\xee<@Y\b\x16\xb7m\xe7w\xfe\xc3oq2]\xa3\xb3\xb2ª\x8e\x90&آ\xea\x85`\n\xac\x02\xac\x8f\x93W߇X\xe7g\xeb\x10Oਝ\xb9B\xd4=\xe2\re]7\xf5׀lN\xa6\x94\xceю#\xf6-\xcdrfe\xc8(\xc9Ц\xf61\x95u\xc4a\xc8h\x92\xa2\x94\xaa\xf3\x18/\xb9ra\xc0\xf6X1/\x1d=\x9d\xd3\xedw\xb8\xe2\xfak\xd9q\xfd\xb5\x98ր\x18Io\xa6O\xbbۥ݊y\xf1\xf9c\xdc$\x97\b\x06}\x9e\xf9\xc2\xef\xe3\xf2\t\xb2,\xb9ꚝ\xdc\xfc\xfaw\x93W\x15\xb6L\xc1\xfbZr\xaa5:\x8c\x91\x18\xac\xb5\x04\x9d\x16C\x11\xd2\xed\xcdpb\xf9$\x8f\x1fϸ\xf5\xeaw\xf0\xbe\x1f\xffI\xbe}\xffWx\xec\x19˙\xbc\xc0iA\x1c\xa8K\x82L_!\xfd勲\xac\xb5\x81Ru\x04E#n\x96\x92\x86Ϫ\xc3`)k@J穜#\x0e\f{\x97fyyeH\x92\x15\xb8f\u007f\xe5EE\x14\x06\xa4y\xd14\xeaHt\xa4Y\xa4\xe4\n\x9d\xd1A\x11h\xc3\xcc܀\x99\xf99\xe6\xb6\xedB\t\x8d\xd2!F\x85\xb8\xc0\x00\x11ݹm\xec\xbej\x0f\x1b+\xab\x14y\x86\xaf\xc0\x04ۘ۱\xc4\xdc\xee\x9dȨE,\xc0\x16\t\xae,\x91\xa2\xceؕ\xacwv\x10\xb58\xf0ַ\xb2\xb6aYڶ\xce\x19rf{\x03\xee~\xc3\x1b9\xfd\xc2\xd3,T\x05\x87\xf6ϳ\xbc\xbe\xca\xc4Ԋ\xfdK\x1c\xc8\x19o\xf1\x1fy\xc7\xcd|\xe6+O\xbc\xfa\x80\b!\x8e\t\x84WJ\x89\xb2\xaa˚\x17.\xcc_\x12\x97{!jɨ\x15\xec\xdd5ǹ\xcd)\xde:\xf2\xaa\xc2hM^Z\xe2А\x15\x05\xa1\xd6\xf4;-FI\x86\x98\x8c\xd9\xd5W\xb4\x82\x90\xb0\x15\xd1i\xb7\xe8\xf5\x06\xb4\xfa\xb3\b\x1d\x80\xab\x138eB\x94\xee\"c\xa8\xf2\x123\x180ؓ\xe3\xcb\ng=*\b\xd0a\x80\x89\"T`@J|\x19P%\te2AT\xd5\xd6\x10\x1a\xad\x03\xa2\xb8\xcd\xdd?~\x88\xde\xd7\x1fa\xe9\xec\x1ak'F\xdc\xff\x1f\xfe\x0f\xf2,gN\xc1\xf6\xdds<P\xa6d\x85|\x05\xd3%\xf0G\x01\xff\xd7\r\xc6\x0fO.z\x9ep\xde[%\xd1em\u009a\x10M`\xbd\xdbr\xe84\xfa\xa7\x9d\v]6'\x19EQQ9\x87\x17\xb26s\xaa\x0e\x97{q\x84R\x8as\xebC\xaa\xb2䎞\xa0\x13\x1b\xc2(h\xfaB\xfa\x04\xed\x0eF\x19\xa4\xad\xa0\xa8\xf0e\t\x91G4uzm\x02|\x15a\xab\xaa\xe6\xa5\x1c\xf5\xb8&!\x91\x81\xae5W\xbe\xa8\xa3\r\xe7\xb1E\x8e\xf2\x1e\xd9\x04\xe9\xb2ə\x06\xf3s\xbc\xf1\xddw\xb0yn\x9d\xe1\xf2:\xae*\x11F\x80\xaa\xd8X;\x87?v\x1e!^\xa9\xd1\xf2B\xfc\xc8\xfa\xda~(@\xa4\x94G\xbc\xf7\xaer\xae\xce8\xfcE\xbe\xc7o\r\x14\xab\xd5$\x00\xdb\xfa\x1d\x1e\xfd\xee\x19\x06qDa-ژF\x85\x18\xa0\x94\"\xc9s\x86\x9b\x13\xa2\xc0pCK\xb0\xd8\x0e\x91\xda\x10D!Qآ\xd5\xee\x10\x061\xc6\x04\b/\xf1\xa5Ŗ%\xd2U(\xa1\x10\xb2n\xfc\x17\xca#\x83\xa6\x82\xa7j\xfeK\xf8\xe63\xda\n\xaa\nWdT\xc9\x18W\x16\xa0L\xa3\"\x01)\xeaKo\rz\x98Р\xb5bn\xfb,BBUV\x8c66ȋ\xa4\x99b\xe7j\xaa\xabI\f\xbd\xe7\t\xe7\xf9\x918\x91\x1f*\x0fy\xebm\x1fzJH\x91E\xc64l\xa8\xbfD\u007f|\x81\xd7\x12\x94\xd62\xdfmqvc\x8c\x91\x8a\xd29\x84\x10\x04\xb2naKK\xcb\xcah\xc2\xe68A)\xc5nUqe[#\xb4\xaaK\xc0F\x13\x85\x11a\x14\xa1\xb5A\x99\xb0^\xfc\xca\xe3\x8b\x1c_\xe4`\xab\xfa\xae\x97\n!T-\aU\xba\x19\xad\xa1\xeb\x9fy\x01\xae\xc2W%\xc5h\x93*M\xea\x92m\x9d,\x81\x17(\f Ё!\xeav\xe9-.ҝ\x9d\xa5\xd5\xeb\xd3\xeav\xe9\xf4\a\xb5*s\xeb\xea.\xd6J\x84\xe0\x8c2\x01\x97\r\x90\x9f\xff\xa7\xff\xd8\v!\x0eGQp\x91\xed\xac\x83\xaeK\xe8O\x87s\x8evd\u061c\xe6\x18\xad\xea\x9e\vY\x97l\xa7EI\x92\x17\x94e\x85\x17\x92X\t\xf6F\xa0\x8cD\x05\xaa\xeee\x97\x01Q\xd4Bk\x83T\x1ad\x00B#\x9c\xc4\xe7\x15.\xcfpU\x82\xb7\x05\xb8KF\xcf\xf8F\xc6\xd3\x10\x9e\xceW\xf8\"\xa3\x9a\x8c)ӔҖuxް\xd5 \xd0:\x84\xa6\xfc,\x84 \x0eBb\x13ct\x80\xd1\x01\x9dv\x97(j\xd5=\xed\xfebRX\xb3\xef>\xfd\xc3\xfb\x1e\xb9\xbcRR\xef\xfd\x17T\xd3\xf0\xef݅\x1dr\xb1\xd2\xe6=[?wΡ\x95\xc0z\x8f\xb3U\xfd:\xe7\xa9*\xbb\xf5\xfa\xbe\x91uo\xba6(U\xcfU\x8c\xc2Z\xed.\x95A\x9a\xa0\x1eL\xe6\x1a\xc0\v\x8f\xcfK\\\x9e\xe2\xcb\x14g\v\xfc\x05ݔ\xbbP\x1f\xaf\ua7d7)\xe5d\x93\xe9\xeay\x8a<\xbbdN\xa3\xbc\x00!J\x05\rWe\x91\x80\xf4\xa0B\x8dQ\x06\xad\f&0\xc4\xed\x0eӢΙ.\xa9Z\xad\x80\xd8\xfcQ\xf9\x90\x1f\x1a\x10)xD \xb6z\xfeh\xd8\xdd\v\xf4\xa7o\x92\xa6\xac\xac\xe8\xc6\xd1E\xf2\xb11\xb6\xb5\xe4\xa6\x0e\x00\xb4\x12\xb4\x95E\xebZ\x90\xad\x95\xc2h\x83\nL\xdd\v\"5Ji\x84\x90\xb8\xb2\xc4U\xb5^\xcag9\xbe\xcc\xf1\xf9\x14Q\xe5\xe0J\xbc+p\xbe\xc0\xbb\f_ex\x9ba\xa7\x1b\xac\xbf\xf4,\x93\xb5s\xb8\xaa\xbcXu\xb2uşqA%\x00\x00 \x00IDATPz\x89\tc\x04\x12g\xed\x05\x15ɖ~A)E\xa0\x03\x9c\x17\x14\xce\xf3\x97\x88\xdd\xd4{_^v@\xb4\xd1G\xebŔ[\xa5\xe9\v-\x05\x17:\x9c\x040Jr\xe6\xba1e3\xc6B4`\xd5\x13\x1b\fR\b\x94\x10\xb4\xb4\xc4(\xd9\x14\xb14F*\x94P\xf5'\x92MC'\x02\xef\x1d\xb6j\n`E\x89O3|\x9e\xe2\xb2)\x94\t\xa2\xca\xf0U\x8e/3('\xf8d\x83\xd1\xf1\xa3\xa4k\xabh\xa5\xf1i\x82\x174\xbb\xa7\x84\xca#D\x80T!\xc6\x04\xf8\xaa\xbch\x82/\xf1\x8dJJV7'\xb5ٽP\xb0\xaa\x1fg\x95T\x1b\x97\x1d\x90\xff\xf3s_?+\xa0\xa2\xe9\x05תn\xde\xdf\"ܚ]=Jr\xfa\xad\x90Һ\x8bJ?_\x1b\x8aV\x18Ճ_$D\xb2\x0e\x8c@\xa0U\xed\x9cA6\xf4\x84x\x85\x8f\xb2\xd6\xd6\xe5W\xeb\xf1Y\x01\x95\xc3\xe7ym\xbe\x8a\x14_L\xf1\xe9\x90r:f\xba\xbe\xce\xca\vϢ\x82\b\xd3\xea\xd5\xc4gYR\x159\xf9t\x84\xc8*\x90\n\xfa}ڝ\x19ʪ \b\xea\x81\x03B\x88\xba\x9d\xb7\x19\xd8ujeckn\xca\xc5:\b\x0f\u007f\xf6\x1b\x8f\xad\\v@\x00\xac\xf7\x1b\xcey\xb4\xaa\xc5Ѯ\xd9\x05p1\x06\xac\xac\xa3\xb0\x8e(P\xf5\x85y\xb0\xce\x12\x18MYU5\xd5\xe2\x05EQm\x8dN\xba8\xfb\xaa\xb6\xf5\xf5t\x06\x8f\x17j\xcbvo\rN.-.\xcf\x1a\xd3U\xe0\xca\x1c_\x168[\xe1\x9ce\x9a\xa4\f7\xc6\x14\xa3M\xca\xe9\x18\x9be$g_f\xf8\xd2Q\xa6\xa7_f\xb2|\xa6\x8eԪ\n\x83A{\x85+=N\np\x0e!\x1d\xdeZ\\\x91s\xf4\xd8I\x02\xad\x1b=\xaf\x03\xef\x13<_\x04x\xef\x1bo\xbc|y\xc8\xc1\xa5\xed\x1c>w\x16%\xa5\xf5\xce\x11\x87u嬨\x1au\xf8%\x83\x10\x95\x14\xbc\xbc:d\xe7\\\x8f\xa3\xa7V\xb90\xfb8\f\fEQ\xd6\x00!\x18\xe7%\xce\a\x17M\x81\x17\x97\x14\x86ku\xa1\xc1ⅾ\x18\xcc9_\uf4bc\xbc\x18I\xa0/\x8eSҒ\xa8ӥ\nۜ=\xf2\bsW\x9ca:MY>\xbfB\xac\x14\xed\xb8C>ܤ\x18\x8d\xe8,\xee$]?\xcbf\xbaFk\xd0E\xd8\n\xef,\xce\xd6\xf4\xfc\xca\xda\n/\x9c=\x8fn&\xd65\u007fp5n\xcd\u007f\x15\xe0Ͼ\xf5\xd4\xe5\x03\xe4\xf0\xb9\xb3|\xf0M7\xc7B\xb2$\x85\xa4\x1dG\x94\xd66\xd3?\xc5\xd6@\xca\xdaa\b&iA'\x0e\xe8\xc4\x01I^n\x89\x1dҬ\x1er\xe9\xbcc#\xaf\xe7\xa0\xf8:\xa6\xdc2}\xaeq<\xd6\xd5\x12\xcez\x16\xa6hj\x18\xa2\x0e\f*\x87T\x0e\xa1=8[\xefsm\x90J\x12\xc6\x11\x83+\xf6\xf0͇\xbfA~\xece\x8e\x9d\x1c\xb3\xb2\x96\xa2pܼo\x89\xeb\xaf\xcdIV\x97QQ\x9bi\x10r\xf5\xddw\xe0|\x85\xb4\xe0\x9cŹ:\x87y\xf0\xc8Ӕ֡\x03\x89\xba(!\xfd\x93O~\xf9k\x15?\xc2\xc7\x0f4Y?\xfdcw\xd6a\xea\\\xef\xe3\xdeC\x10h\xb4\x96u\x03\xa7x\xe5\x04\xc9\v\xbe\xb1\xb0\x96\xf3\x9bSf:\xf1\x96+P\x8dڃ\xcab+G\xe2\xeaqJ\x92\xa6=\xc1WXW\xd7\xe9]#\xb7\xb1U\x85\xc55\xc3fd\x03Z\x1d\xaazW+\xe8\xeb?\xa0\xeaqu\xa2\x1e״\xb4o?\xd7\xdc\xfd6\xa2\xedWp\xe3\x9bo\xe1Mo\xbb\x85=;\xb6\xb30\xbfDf+\x96G˜\x19\x9f'\xd86C\xab߫\xe9\x14\v\xbe\xf48W2\xda\xdc\xe4\xfe'\x9eEI\xc1%,V)\xd1\xff\x82\x1f\xf1\xe3\a\x02\x12\xb6\x92\v\xe4\xe1\xdf\xc5{\xc2\xc0\x90e\x05EY\xa2\x1a\xca\x1djAÅů*K\x9a\x17$yA'\xaai\x0e)\x05\xa2*ћk\xf5\x18\x8c8\xe6\xec\xfa\x98\x96єeIe+\xaa\"\xc7\xda\x12\xeb-\x0eO\xe5,UY6UI\xb9\xe5\xe4=\xfe\x12\x10\x04h\xd5L\x00\xaa\x87dFq\x8b\xd7\xdd~\a\u05ff\xe1 \xb3;\xe7X\xdc5\xcbͯ\xbf\n9P$\x1dE9\xbfH\xbcg\x1f\xf3\xbbv\x11F\x01\xa2̩\xb2\x02k=\xae*x⅗X\x1f\x8d\xeb\xba\xfaE\xfe\xea\x1f\xfe\xe17\x1f;u\xcf\x1d\xb7\xfcH\x01\xf9\x81&\xebw\xfe\xf81\xde{\xfb\x8d\xb1\x10\xbcAJY\xab\xcc'i\x93\x00Z\xe2(`\xa6ߦ\x15\x858\xea~\x91\xb2\xb2\xa4Y\xc9\xc6$\xc54c[\x85\x90\b\xeb\x10\x93M\xfc\xec<E\x10p\"\xf3\x1c\xacJ\xbc\xac\xa5\xa2e\x95S\x15\x05\xb6*с\xc39\x8b\xb5\x05e\x15 \x83\x80\xa0a\x94\x85R\x10\xd6\xf3\xdf늀G\xaa\vjJ\x89҆\xee\xdc\x027\x1f\xfa1\x8e>\xfa\x10\xeb˧\xb1\xf3\x15AYb\xa2\x98 \bرc\x0fW\xecۇ\xd4\x0e\x97\xe7X[\xb75Lƛ<\xf9\xe21\xf2\xa2\xdc\x1ap\xe6\x11\xf7\xe3\xc5\xff\r\xf0\xb9o\x1e\xb9\xbc\x80\x00\xcc\xcf\xf5\xfe>\x10;\xeb\x19O\x13*[\x8b\x12\xae\xbdj\x17W^\xb1\xd4L\xfd\xac\xc9ŕ\xd5u\xa6I=X\xa6\xaa*N\x9f\xdfd}sڌ\b\x97\xe0\x1dj\xed<\xa9\xda\xc1\xaa\xe9\xf0\u0099\r\xf6^\xb1@^\x95\x18%\xa9\x8a\x94\xaa\x8a16\xc6J\x8dp\x16Q\x15\xe4\xa5A\x18\x8dF\x80\xa8\xc7+_(\x1f\xd76^n\xd5\xf8\xa5\xaeKi\x84\x11\u05fd\xfen\x9e\xff\xce\x03\f\xd7\xcfC\xabM\x10\xb5\x98_\\b\xd7\xfek\xa8\xa4A\x96\x16k\x03\xbc\xcd)ʌ\xe5\xf3\xe7\xf9\xce\xf3/5\xe1.\x00\x89\xc7\xff/\u007f\xfcГc^\x85\xc7\x0f\x04\xe4\xa7\xdeq\xfb\x8cR\xeagq\xa8$\xcbI\xb2\x9c\x1d\xdbfy\xe3\xc1k\x99\x9b\xed\xa3\x84$\xcb\v&IF\x96\x17DqD\x10\x04XW1\x99$\\\xb9s\xben\xaa\xa9,:\x8e\xa9\x10\xc8\xe9\x18?\x19\x91\xb6{\x1c\xb1\x9e\xddi\x86o\xb5(mE\x91\xe5\x984\xa349R\x058簶\x80J\x93\x15\xcd\x10\xcd2\xa7\xf2\x1e\xd9\xe9\xa0\x02\x83\xc5#\xaa\xbae\xc0K\tR\xd5\xe7\x8dT\x16\xe9\x1d\xaf\xbb\xe5\x0e\xb2\xf5M\xac\xf0\xc4\xdbf1J\xe0\xc7)\xbe\xb2T8\\&)\xf2\x8al\xbcɃO\x1cec\x92\xd0\x0e\xb7T\xa2_Z\xb8qϟ\xf2\xd0S\xbc&\x00\x89\xc2\xe0\xddBpkYZF\xd3)\xbb\xb7\xcfq\xeb\xcdW\xb3\uf29d\fG\x13\x8e<w\x8cs+\x1b\fGSҼ\xd6\xe9\x06F\x13\x06\x9an'd~\xa6\xcb\xf6\xc5>\xe7Wǔ>\"\xd3\x01\x949j}\x05\x1f\xb58/\x14O/O\xb8q\xa7!W\x12U\xe4\x984E\x871:\x8c\x10h\xf0\x16Y\x158\xab)\xa5GxG\xba\xbe\x81\xddT\x8cӒVw@\u007fv\x96j:\xc5')*\xb3\xb8фj}\x8cOk-Yw\xff>\xa2n\x87\xcd\xc7\x1e\"\xdc6Cp\xfd\x95\xa4\xbe@\x17\x15\xaa\xb4\xd82a}s\xc8\u05cf\x1c\xa5ՀὟz\xa5~\xfd\xb7\xff\xfd\x9f\xfa\x0f\xdcu3\x9f\xff\xc6\x13\x97\x0f\x90\x8f\xbd\xf56\xda\xed8B\xf0\xab\xde{\xb19\x1a\x13G\x01W_\xb5\x93\xa5\xf9\x19\xbe\xfa\xc0a\x9e<z\x9c\xb2\xaa\xe7\xf0\xee\\\x9aa[\xd0i\x14\x88\x15I\x92\xf3\xf2\xd9\rN\x9f\xdd`a\xa1\xc7L\xb7MQM\xb1\xed.z3\x87\xb2@\x9d;I\xb5s\x1fO\x8e*\xb6w\xa6\xf4\x84 \x97\x12\x93N1Q\x846!\xca\x04x$\xd6VP\x16\b%j\x8a\xdd\x04$k\xeb\xfcɧ\xbf\xc8\xe9\xe7O\xf1\x86];\xb9rn\x96A\xa7G\x1cE\x80\xd8*{\v\xe0ܩS\x9c=w\x8cd:B\x87\x11\xfb?\xf4\x01\xda\xd7\xed\xa1,kZ\xc7\x16\x19\u007f\xf4\xe0\xa3T\xd66\xd5POw~\xdbӟ\xfc\x93\xbfx\x11xU\xc0\x80\xefӧ\xfe\x91C\a\xb9\xf7\xbe\xc3\xdcp\xe5\xf6\xbb\xb4\xd6\xff\xe3t\x9a1\x9ef\xecݽ\x88֒\xaf?\xf4$/\x9d<O\x1c\x87,-\xf6\xb9\xf9\xba=\xcc\xcd\xf6\xe9w۴㈙^\x9b\x99~\x9b\xc0(\x86Ӕ\xcd\xcd\x04\xad\xea\x16\xb7Q\xe1('CL\x13\x02\xcbtJҞa2M\xd9\xdd\xd2HS\xf7\x8fK\x04\xca\x04\xe8 @\xea\xa0\x11Qx\x84\xa8\xf3\r\x13\xb7\x98\xdfw5\xb7\xbf\xe3m\xec\x9b\xdb\xc6\xea\xf1UΝ\x9f\xb0<Iy\xe6\xd4Y\x1e\u007f\xe9$\x8f\xbdt\x9a\xc3\xc7N\xf1\xc8\xf3\xc79\xfc\xdc\t\x92$\xc1HA^䬟\xfc.\x9d\xed\xb3\x98 ƕ9\xcf\x1d?\xc9\x1f?\xfc\xf8V\x01\xab3\x98\xc3D\xd1o\x1ey\xf6\xc5\xc3?\xff\x91\x9f\xe0\xf1g\x9e\xbf|&\xeb3ͼsc\xf4\xbf\xad\xace4I\b\x03M\xa7\x1d\xf2\xd2\xc9e\xc6ӌ\x9b\xaf\xdf\xc3\xde+\xb6#d\x9dcl\xf5\x82q\x91P\xec\xb5c\xba\x9d\x88\xa3\xdf=˱\x97W\xb8r\xe7\"\xedn\x87\xf3\xad>\xad\xc9z]e\xccR\x82\xb5s\x1c\x9f[\xa2sj\x9d7\b\x8f\x99\x1b\x90&c\x946\xe8(@j\x03a\xd4\xd0\xec\x05\xc2Il\x91\x93O'\x98V\x87\xb9\xb9\x98;\u07bc\x9f\xf6$\xa4\xbdm'j\xf7\"\xab\xe3\t\xe7Ϝe\xf9\xe4qVO/\x13\xe4\x8e\xdc\n\xc6\xc2㫔\x85\x99\x90\xaa\x9c\x90M6\xc8,|\xe9ѧ\xb6z\t\x83\xb8\x85\x0e\xc3쾯|\xedw\x01~\xe73\u007f«\xf5\xf8\x1e@\xee\xb9\xfb\x00\x9f\xbb\xffq>\xf1\xce7\xfe\x9aT\xf2\xba͍1yQ\xb2ki\x96\xaa\xb2\x8c'\x19\xb7\xddt\x15;w.`\xb4\xa9\xa3\x1aQ\xf3\xa1\xae\xe97\xb7e\xddiۊ\fWl_ 4\x86\xfb\x1fy\x96\x8d\xcd1\xb3\xb3}\x96[m\x8atD\x1bG)\xea\x1d\x13\x98\x80\xe7\x06s\x98\xe3+ܢ4b\xd0#\x99\fQ\xc6`L\x88P\x1a\xa7\r\xc2VX\xeb\x10\xd2P\xa6\x05֥\xe4q\xcc2CZ\x931\vIN4\x99\xb2p\xed^v\xbd\xfdm\xe8\xd9\x01\x94\x15ny\x85\xec\xd9gp\xe31\x1b\x1b\xcbLwF\xf8\xb9\x9d\x14\xd3M\x9e9\xb7\xc9s'\xcfԭ\xd5Zӝ\x99\xc1;\xff\x9d\x13\xa3\xbcx\xf7\x9b\x0f\xf0\xe7\x0f=~\xf9\x00\xf9\xdc\xfd\x8f\U000d66ef\xea\x05\xa1\xf9\x8d<+\x19M\x92\xb1\xf3~s\xd0o\xed\x1a%\x99\xe8vbf\x06]\xe2(\"\x8e#\xb4\x10\xf4\xda\x11\x9b\xe3\ty\x96\xb1\xb6\xba\xce\xe6Ƹɶ!\x8e\x03\xba\xad\x88\x1d\x8b3\xbctj\x99^\xbbE\u007f0`<ܠk\x13:F1-\x1d\xe5\xfa\n\xd6\x04<\x1bE\x14O\x1f\xe3M\xb7]\x8b\x12\x11\x93\xe1\x06*\f\xe9\x98\x10\xad\x14ފ:S\x0f\x9bڸT\xb4\x17\x17\xe8\x8e\xf6\xb3\x92|\x87\xe5\xd3O\xb3\xb7\xccHWρpD\xdd>&\n\xb1EN\x95NH\x931\xa9KQKo@\xc4]\x98\xe6|\xe5\xc1\xbf\xa0\xb2\x0e\xa5\x15\xfd\xf9EVV\xd6\x18\f\x06\v\xc0\xab\n\xc6\xf7\x00\xf2\xa1w\xdd\xc2g\xbft\x84]\xdb\xe6\u007fE\b\xb1cuc\x88\xf7\xfe\xb7\x95\x947\x05\x81\xd9}\xe2\xcc\x1a\xbb\x96扢\x80\xc0(\x06\x9d\x16\nx\xec\xe9\xe79v\xf2,gέ3\x9ef\x14\x95\xa5(뾎^'f~Цߩ\xfb#ϭm27\xe8\xb2\xd9\xeeP\x8dR\f\x82v \x99\x16\x1eΟ\xc6.\xed\xe6\x85\xd4\xd3z\xfa\x18\xb7ݼ\x1f!`\xb2\xbe\x821!R\x99\x9a;sU\r\x8a\xf7\xf5\x19UQ\xcc`\xf7\x95\x94\xf9\x985\x9e\xe3\xdb\xcf\x1df\xb6\x8a\x98\xeb\xcfӚ\xae\xa1E\x1d\x14\x94\xae\"\xb79\xed[\xaeG\xcfΠʂ/>\xfa$\xc7ί\xd1i\xb7h\xf5\xfa\x1c\u007f\xe98y\x9e\xd1\xef\xf5\xaf}\xcf\x1d\xb7\xbc\xee\v\xdf<\xf2\xec\xab\t\xc8+\x9c\xfaޅ9\xae\xbbb\xfbR\xbb\x15\xfe\xafiZl\x1bM\x92/K!\u007fS\b\xf1K\vs݅\x13gָf\xdf\x0e\x16\xe6f\x88\xa2\x88d2叾\xfcM\xbe\xf5\xf8\v\x9c8\xb3V\x0f\xc0T\x92V`\xe8D\x01\xddV=\xbfw}\x940\x9cf8癤\xf5\xdc,\xb4\xc1M\xc6DX\x94T\x98\v%\xdf,\xc1\xcf,\xb0~n\x99d<e\xf7\xd2,8\x8b+KL\xdcj\x84\x0f\xa2.\xf3\x06\xb5\u007f\xd1Q\x84\x94\n\x1d\xb6(\x93\x92|Z\xb02\x19szs\x853\xe35V\x92!+\xf9\x98\xcd\xc8\x13\xddx-3\xd7_\x83\x91p\xf8ɣ\xdc{\xff\xc3\xc4q\x84\x13\x1aas\x06\x9d\bk\x1d&\n)\xf3r\xfdŗ\xcf}\xed\xb2\xed\x90?{\xf8\x19>\xfa\xf6\xdb\xde!\xa5\xbaac\xbcI\xac\xcdGӪ\xbc)\n\x83]g\xceo\x80\x10\xcc\xf4;DQȩ\x97\xcf\xf2\x95\a\x1egy}H\xaf\x151\u05cd\xeb\xf6\xe8-\x99M=\x83ʨ\x80n\x14R:\xc7fUONX\x1f\x8d\xe9\xb6[L:3t\x86\xe7Q\xb2\x162w\x02\xc1f^\xe2\xa6\x13\xa2\xb9y\x8e\x9f=\x87\xfd\xf63\x1c\xba\xedu\xc0\x18y\xee\xe5z\x00\xa6\xecc\xcb\fY\x85u\x89\x96\x98\xb87@*ŞN\x8f\xa5\xeboe\xba\xb1A\xba\xbe\xcede\x85t\xbcAk\xb6\xc7\xee\xdbne\xb0\xb8\x8bdu\x99s\xabg\xf8\xa3o|\xbb\xeeg\xf1\x92};\a\xb4\xa3\xba\xf6\x11\x85\x9aI\xe1(\x8aB\xf1*?^\x01\x88P\xa10Z\xffF\x92eX\xeb\xffї\x0e?;<t\xf3\xfe}@\xf7\xfc\xea\be\x14\xa11\x8cF\x13\xbe\xf0\xf5\xef\xb09L\xea\xb38\x94@4\xca\f\xeb=J4\xa7\x81\\(\x176\xed\t\xdbg\xbb,o&\x8c\x92\x944\xcbȥ\"\x9b\xddF4ZA\x1bEYZZZ1N\xa7T\xed\x88]\xfd\x16\xa7\xcfo\xf0\xf0cG\xb9\xf3\xe6\xabI$\xa8s\x9a\xfev՜Ж \x95\xc1E1N\a\x84\x9d\x1e:\x8c1\xad\x9c\xf6\xdc,J_\x87n\xca\xc6R\xf9zvV\xe5ȓ\t\u007f~\xff\xb7yyy\x9d\xac\xacx\xdfۯgϮE\x9e\u007f\xe18\xd6:Bcȝ\xc0h\xf9\xe8e\x01\xe4m\a_\xc7\xd7\x0e?\xcb{n\xbf\xf6=F\xeb\x9bƓ\xf4\x85,\xcb\u007f\xb7YΤ\xaaܖ\xdcFJ\xc5\xe3O}\x97\xb5\x8d)\xb3\xbdv]\xf7\xb6n\xab\x81G\xd4s@\x10\xc2n鷐\x12\xa5\x15\x1e\xcd\u00a0\x9e\xad;M\v\x9cu\x8c[-\xa2\xee,&\x1f\xa2\xa4\xc0x\x81.s\xbc\a\xa3%\xbbgۼxf\rg-w\x1f\xb8\x86BJ\xc6x:KW\x80\x94\b\xa5)L\x00B\x12\xc6mt\x10\xa0\x8c\xa9#\xbe\x86\xd4\xf4\xa29\xafʕ\xb8\xf1\x90o\x1dy\x8a\xaf<v\x14\xa35\x1f|\xf7\x9bػg;++\xeb[B\x8d$+\x10*\xa2\xd3\xe9\xac_\x16@\xbev\xb8\xf6[\xbdv\xf4\xbfY\xe7\x18'\xd9\x1f\b뇍\xda\xe4Le\xab\r\xa5\xe4L^\x96\x8c\xc6\x13^8~\x86v+jN\xbaq\x04Z\xd2\xeb\xc6\xcc\xf7\xdbt\x9b\x0e[\xefa4I\xea\xfe\xbeQB\x9aW5\x9f\x15\x18f\xbb-z\xed\x16gV7H\xb3\x9ciw\x06\xed-\xad|ZS/\u0091\x95\x16e$F\b\xaeZ\xe8szm\xcc\x1f<\xf0\x04\xef\xbau?\xbb\x9dgTUtw\\Q\xf3WM\xfe \x84@\x87Q=\v\xbe\xa1\xe4/\xd4j\xac\xadPy\xc6C\xdf\xfa\x0e\xbf\xfd\x85\aPJ\xf1\xa6\x03Wc\x94\xe4\xc8\x13ϓ\x17e}R\\V\xb21\x9c0\x98o\xa3\x82\xa0\xf7\xaa\x03\xf2\xae;o\xe0K\x0f>\xcd\xdd7\xed\u007fo\x1c\x85Wo\x8e&\xa3\xbc,\xbf\xfe\xd0s\xc7]C=\x9f\xf7ί(#f\xca\xd2r\xf2\xec*YQ\x12\x9a\xda\x14t[1Z\n\x8a\xb2\xe4Թ\xf5\xba\xbfPx\x8cVD\xa1\xa1\x15\x06\xec\xdd5OV\x94\xacn&\x8c\x93\x8c\xaa\xb4Dq\xcc\xd2܀S\xe7\xd7\xc9+\xcbFw\x01\xa4$\xf2C\x94\x95lS9\x17\xaa-\x81\x91\xec]\xe8\xb1:N\xf9\xd4\x03Or\xd7uW\xf0\xfa\x1b\xf7Q\xe5\x19\xdd\x1d9\xad\x99\x05\xaa\"\xc5\xe5\xb3\x04\xdd\x19T\x10ni\xb0<\x0e[\x14P\xe4<\xfc\xf0C\xfc\xeb{\xff\x14\xeb\x1d\xd7\xed\xd9\xce\xcbgVx\xea\xb9S\x94\xd6\"\xa5$0\x8a\xac\xa8\xf0\xae\xceG\x8a<\x0f_u@\xbe\xf4\xe0ӵ\xee\xb6\x1d\xfd\xaa\x14\xc8I\x92\x1dw\xf0\x8d\xad\x02\x956\xe7ҢX\xb5\xd6]\xe3\xbd祓\xe7\xb7\x04qZk\xc6I=0R\x01Z\xd45\xf5\xcayʲ`<\xcdpM\x17~\x18hz\x9d\x98\xa5\xf9>\xe3\xa4`\x92f\xf5\xb8\xd8vD\xd6\xf4\xa9\xafv\xe6\x98E\x10\xda\r\xe6\x95#\x0e5Eq\xb1\x1bw\xa1ߢ\x1d\x19\x1ez\xee$Ǘ7\xb8\xfb\xc0~\x8ad\x8a[\xdaA4\xb3H9\xde\xc0D1*\xee \xa4AH\xa0\xaa\xc86\xd7x쩧\xf9\xbf\xbe\xfe\x18\xca\x04욟ayuH\xb9u:\x82\xc4ZGRVu\x198\x8aQJ2\x1d\x0f\x9f\xbf,&\xeb\xc0\xbe\x1d;z\xedx\u007fi-\xe3$\xfdⷞ9>\xbd\xf3\xd6\xfd<\xf8؋|\xe9\xd1g\xa6o\xb9i\xff\xd9\xca:\x8c\xd6l\x8e\xa6x \xaf,\xd6Փ\x17:qH7\n\x99\xedFh)\x9a\xd3r\xea\xfaH\x92\x97\f'\t\xa3i\xca\xeap\x8aߘb\x02\u074c\xf4+\x89\x03CV\x96T\xcd|\xdfͨǂ\xab\x9as@`\xd0\x0f\xd8\x1c֧\x18\b\x01\xed\xc0\xf0\xba\xed3,\x8f3>\xf7\x8d'\xd9>\xd7\xe7\xba]\xe7\xb8a\xefN\xfa\xb3\x03*\x04^\xaa\xe6 \x80\x82\x13+\x1b<|b\x99o\x9d\xdc@\x04!\xfd\xc0\xb0\xbe9Ai\x83\t\xea\xdc&jŵ\x0eL\xc9f\"jH\xe5\xdcW\xedd\xf5\x89\xcb\x02\xc8\xe2|?nš^\x1fN\x01\xbe\x04\x10\xbd2Ey\x01pB\bY\x96U=\xda\xcf{\x82(\xa2ߎ\xc0y6'\t\xa3\xc9\x04\xe1i\xce\x18\x04\xad\x14\xad(`\xbe\xdfa\xae\xdbb\x92\xe6L\xb2\xba\xb93++\x90\x12\xd7\x1cqTXKG+\x82(`\x85Y\x16\xfd\x10m\x1dA \x99\x1d\x04\xacoևB^h\x9b\xdb\u058b\x99\xabB6\x92\x84??\xfc\x02\x0f>}\x9c\xbd\x8b\x03\xf6.\xcdb\x8c\xe2\xf4(\xe3\xe9\xd5\t'\nIR\xfa:\\.JΦ9J\n\xe2 \xc0\xe8\xba+j<\x1ec\x9b\x96\t)\x15\xdeّ\xd6\xf2\xbf\xff\xeaS\xe7\xfc\a\xder\x80\xcf?\xf0*S'ݨ5#\x84h'IF[\x88\xef\x00|\xf5\xb1\xe7.\xbeH\xc8\xdf-\xbd\xfd\x87\b\"\xadU3ԫV\x17Nӂ\xb2\xaa\xb9+\xe9=R4\xae\xd4{\x04%\xa3$\xe3\xdc\xfapK\xe9\x18\x18M+4\xc8F\x1d\x9f\xe4\x15eӗ\xb8{\xb6\x83\x10p\xdcz\xd6lD\xdb'\xa4iŎ\x9d]\x84\x94\xac\xadg[\xea\x14\x81@\x1b\xc9b\x10\xb3؍Y\x9dd<{z\x95\xc3\xc7\xcem\r\xdb\x1c\xb6\x06\xa4a\xab\xee\xec\xaa,\x81\x92\xccv\xe2\xfa\xa8\xa3K\x94.B\xd4fvs\x9a0.,JʖQ\xba\x02^U0\xb6D\x0e\x9dN\xd4\x01\xe2\xbc,\x97\xbf\xfa\xf4\xb1\xe1\xa5/\xf8\xfb?y'_{\xe2\xf9\xa3B\xf0Ն\x01F\xca\xfa\x14\x9b\xa2,ɫ\xea\xa2Բi\x8f\xae\a\xb4\xb8-\x95\x88lΘ\x82\xba\xa5m\x9cdlN\x12\xa6i\x86\xf7\x0e%\x04\xed\xa0~_)\x04\xbdаI\xad\xb4/JGe\x05۶\xf7پ\x14\xa3\xb5ؒ\xaenͻ\x12\xb0\xd8kqîY\x0e^\xb9\xc0\xadW\xccq\xcd\xd2\x00\xe5/\x8e\xc3\xe8\xc6!s\xbd\x0e\x81\xd18\xfc\xd6$\xd4ZrTk\x8e\xe7\xba\x1d\xe6\xbbm\x94\x10Z)q\x03\xc0\x81+\xb7\xbf\xfa&KJ\xd1)K\xdb\xf5\x9e\xaf\x03\xbc\xf3\xb6\xeb\xf9\xf2\xa3\xcf\x00\xf0\xcd'\x8f\xd7w\x91\xd4\xff\x04[\xbd\x0fQ\v\xaeS\x9bc]sr'\x17\x8fL\r\xb5\xa2ߊ\x98\x1ft\xe8\xb4\xeaA\xfcgW6\xb0\xb6\xce\xd2+WO\u007f\xc8+Kik\x02R^\xd2\xd6 \x04(\xe1I\x1b\x93\xe9\xa9\x0f\x85\x8c\xbb1\xb3\v\x8a0\x9e\xf2\xff\xf5v\xee1v\x16\xe7\x1d~\xe6\x9b\xefz.\xbbg\xaf\xde\xf5\r\x13_\xb0\xf1\xa51&\x10\x88۪\x10\xe4\xb44\x14\x85\xb4\xb4\xa4\x85\xaaXm\xda@\xda\xc6T\tmC\x95\x8bj5uA\x81\xa6iR\x88JP\x94D\xc1\x80\b\t\xb8\x8a\xd2\xd0\x06Tq3\x06\x8c!\x06ߍw\xed\xbd\x9c\xdds\xcew\x9f\xf9\xfa\xc7|g\xbd+\xe3\xb4\u007fe\xa5\xd5\xd9]\xad\x8e\x8e\xbe\x99y\xe7\x9dw\xde\xdf\xef\x19\x1b\xeb\x10v2#\xf5(\xdbX\v4Z\x1b֡\xd6\x05\x9e\x14\xf4:\x82vQ\xe086U\xdf#\u05caz%\xc0u\f\xf2\x9b\x12NI\xc9\xd8Ms\x8d\xebX\xd4\x02\x8fV\x14\xff\x11\xf0\xad_4p^\x02l^s\xc1\xd6$˯o\xb7\xc3o\x1c==\xfd\xec\xa1Sg[WO7\xcd\xdd\xfeѱɉ\x15#\x03\x83\x05\\&e\xe9\xb7X\x98\xf4\x16\xa0\xea\xbb,\x19l\xb0l\xb8\x9fс\x1e\xaa\x15\x8f\xd9V\xc8;g\xa6\x8duF\x9a\x11\xe7\x8a43\x846\xa5\x8byR\x06M\xaa\v\x06\xea\x01B\b\xdaIN\x94\xe5,'B\b\x81\xef\t\x82Z\x15\xe9\xfa\x04\xb5\x1a\xbdu\x97\xa2Є\x9dt\x81\xe7H1\x0f\xe9\xed\xd8\x12\x8df<\xb7MUZ\x9aէ\xb5\"\x8e3\x92$#\xc9r\xfa\x02\x87\x81\x9aK\x8f\xef\x94P1\xb3\xaf$iv\xe1P\xbdz|\xef\x91S{\u07fbb\x94\xb1f\xfb\x17\xb9BHU\xae\x10\xd2\xfa\xe9\xf9\xfe\xf1\x83[V\x92\xe6\xe2\v\x02~\xbd(\x8a\x95NIy\x9eS\x17\tA\xb3\x131\xd1l\xa1T\x8eʌ}\xacc\xdbx\x8e\x83\x94\x02Kvg\xb3\xd93\x94\xd6\x06<\x9c+\x84\xd2\x1c\x9f\x9c\xa5\xea9\xccD)z\x9e\xd3[\x9e+\xf2,Ǖ6n\xa5\aۯ\xb0\xcc\xf3\x19\x18N\x98\x18\x9f\xa1ݎ\r}\xba\xeb\xd8#\xc0\xf3mFe\xc1\x1b\xa96\x10\x97\xd2 M\nA\u0ef8\xb6\xc9\xc4\x1cG\xce\xf5R\a\x8e$U\x05\x81\xe7ШU\x18O[\xf7o\xba`d\xcf\xcbGN-0\u07bfo\xd7g\xb9\xfd\x8e/\xfc\x9f\x0f\xf7\x85\x1f=ƥ\x1f\xbc~\xc1\xdf\xee\xfd\x87O\xdb\x17\xbd\xe7=C\xb6\xb4D\xa7\x95\x8c]w\xcbm\xfa\x9c\x01)4\xd3\bb߱ϛ\xe6͆\x92\xe7\x0e\xfc\xec\xf4/oZ\xf59\xe0\x01ۖN\xb7yZ\n\xc3\x1f\x14(S\xfe\x106\x81\xe7\x10\xb86\xbec\xe3\x97,\x91\xee>\xa2\xb4\t+\xaa0ab&Li\xc7)i\xae\x98*Qަ=\xd5<\xa9,դa\x88\xeb\xfb\xe4I\x84W\xad#\xa5\x83\xf4#j\xbd5\xd2$\xa5ӎI\xa3\x14\xa5\f\xcf0\b,f\xa6;\xf4Ϥ\x8c\x97Υ\x81\xebP\xf5\x8doo\xae\x14\xaem\xe1\xcdk\x86\xf3lQ\x9a\xf7\v*\xbeG\xa3\xae\xc4d\xb3\xbd\x13\xb8y\xfe\xb3\xf8\xff\f\x06\xb0`0μ\xf1\x93\xe5q\x92l\x0e\xa3d[\xbbݹ\\J\xb9\xf3\xea\x8f\xfc\xce\xc3\xef\xbaB\x94\xd6M\xdfs_\xed海ۄŞ\xe7\xf6\x9f\xf3\xe6\xcf\x1d0g\xa4\xff~孇\xb6nZ\xf5\x11\x01\xd7K)\r\x11!p\r\xf9F\xda\xd8¢\x1e\xb8\xf8\xae\xa4\xe6\xd9\xe8\x02\xe24g:L\x88rE\x92)r}vRؖ\x98\x03\xbf\xd4\n\x98\xeaD\xe82ۘ\xc2e\xa8HH҂N+\xa2ҫQY\x86\xca2l\xc7î5Py\x86\xf4R\\\xdfG\xeb\xb2\u05f8\x80\xb4\xdd\"\t\x14k\xfb\xe1\xe4\x84)\xaf\xd8\xd2\xf4\x17K)\f?\xb14c\xeb\x02\xdeta(\xd5B\x99P<\xd8[#\xcd\xf2\x8f\xee\xfa\xecm\x03\xd7]{\x8d-\xa5\xdd\xeb\xb8\xf6\x91\x8a\x1f\x1c\xb3\xa58:\xdb\t\xf7Y\xc2*\xa20Β\\\xe9\xc1FO\xaf\xe3\xda\xc1\xc4d\xd3\xee\xc4Ѳ\x8a\x1f\f\f\xf4\xf7^\x04l̲\xbcW\b\xab\xef\xe0[oW\x1d\xdbٿ\xedwo{\x18\xe0\xbb\xff\xba\x8b\x1b?~\xc7\xc2\x01\xd1JOJ[\x0ë́\xb1\xb3\xe7\xb9\xfd\xe9\xf9F|\xdb%\x17\xb3\xe7\xa5\xd7q\\\xfbO\xb2$\xbb\xde\xf7\\\xe2$%\xcd\x14=\xd5\x00\xad5\xcd$a&JL\xbc.\x89j\x81kS\x0f\\\xaa\xbeǢ>\x17߱\f\xadS\b:I\xc6T+\xe6\xccl\x1b]\x14\xf8\xaeK\x9c\x1a\xee\xed\x9bE\x05a\xc1\xb0\xcaH\xb3\x9c4\x8e\xf0\xab\x153\b\xb6\x83@b\xfb\x016\xc1ٚU\x12\xa3r\x85JR\x82 g\xc8M\x19r\x15\x9d4\xc7ur\x02\xd7\xc1wLk]\xae\v&;&ewm\v\xdf\x16\xf8\xb6Ķ\x04\x9dT\x91[\x82F5\b\xf6\xbfr\xe076nX\x87\xe7{x\xae{\xb9eI\xe3\tY\xab\"%\xf4\xf6\u0590\x96D\x17\xda\x18\x80\xf6\xd4\xe8\xa9WȔ\xa6ٜ%S&Ӝ\x98\x9cd\xfc\xf4\x19j\x95\xea\xd7\x00&^|\x8a\xc1-\x1f:w\x85\xb4ڝS\x8dFO!-\xb1\x1a\xd8\u007f\xbe\x01\xd9\xf3\x92ɼ~\xfc\xfc\x8137\xff\xd6U\xad#G\x8e\xd5\u05ecZ\xc1\x9b\a\x0f3\xd3\xeeP\xf5=z\x03\x83\xbc\xb3-\v϶\xa8z6n\xa9\t/\xca\xf6S\x95j\x12\x9d\x92\x97,\\W\x14\x8c\xd6=ZqN+\xcdM\x9f.\xa6\xbf\xeb\x84\xddG\xa4;\\,s\xd28'\xa8\x9d=\xb0\x16Z/\xb4\xae\x14\x02\xe9z R\x9cJ\x15\xad\x15\xb6\x97\xb0\xbe\xc7\u2fe6r\xaaJ!\x84k\xc4DZ\x91\xa6\x19yY>)\xc0\xb4\xa6\"\x90\x16x\xae\x91p۶\xc5+\xfb\xf6\xb3r\xd5\n.ٲ\x89$\x8eQ\xba`b\xcahZ,˴\xb4\xda%\x16\x96\xae\xea\xab4W\xa8\xf8\x01\x9e\xe7\",\x8b\x13'N\x02\xb4\x9dj\xff\xd7\x00~\xf0\xf8\x8f\xde=\xcbz\xe3\xf8x\xb2f\xd9\xf0\r\x81덿|\xf0\xd8y\x9bW\x1f\xf9ʽ|\xf7\x87O\xb2eM\xff\a\x1a\xbd\xf5?}\xfe\xb9\x97\xe9\xefop\xc9\xe6\xf5\x1c=v\x8aV\xbbC^\x98\xf8\x9c\xe59I\x9aщ\x12\x9a\xb3!ӭ\x0e3\xad\x90\xe9\xd9\x0e\x13\xb3m&f\xdbL\xcdvh\xb6#f;\x11\xad0&\xcb3\xec\xf2\x90\x98\x170T7\x87\xbe\xa3\x9d\x82\xc5^A\xc53\xf4\x1d\u05ef`I\x03\x98\xb4\xa44\xf1f\x8e\x19e\x847Z+t\x9e\x02\x1aK\tƣ\x9c07\x1bf\x96\x19j\x82*{\xb0\x9c\xee\rg\xe0R\xf1\x1d\xa4\x10\x86\x9b\x98\xa4dy\x0e\xc2\xe2\xe0\x9b\x878v\xfc\x04C\xfd\x8d9\f_\x9e\xe7\xa8<\x9f3\xe0\xd1\xda\b\x8b\xba\xe2\"\xad5q\x92P\xafי\x9c\x9cdll\f\x84\xb8\xedc\x1f\xff\xcc\xf3\xdf\xdeug\xf5\xf7\xff\xe6K\xd9y\xbb\xdfO\x9d\x9e:\x98f\xd9\xd6\xee\xef\xadݻ\x17\xbc\x02\xf4]`\x00\x8cB\x17\xbf\xb9v\xedE|`\xeb\xfb9\xf8\xd6a\x82\xc0\xe3\x0fn\xba\x8e\r\x17\xaf!\x8c\x12&\x9bm\xa6\xdb!\xcd(1>XIFT~KK2\xdc\xd7\xc3\xda\xe5#\xbcw\xd5r~i\xf52֭\x18e\xf9\xa2>\xea\x15s}*\xb4FR\x10&)Ҳ\xa8\xba\x92c\x99\x8dc[ƼYt\xf3e}\x168f\x9d5:\x16\x8eѠ\va\x11\xf8&\xb5[]\x95\xa4i\xc2t+\xa4\x15&\bKR\xabUh\xf4TY\xd2_e\xa4Q\xa1\xbf\xe63P\xf5\x18mTY6X\xa7\xb7^\xc1\xb1$\x15צ\x16\xb8\x1c?z\x9c\a\x1fz\x98c\xc7N\x9a{\x1f\xa5Ȳ\x8c(\x8ah\xb7Z\xcc4\x9b\xb4Z-\xe2(.ex\x8a\xa1\xc1\x01\xb4\xd6\x1c:t\x18!\xc43\xc0\xb7\x1e\xfc\xf2]#\xa9\xeb\\\xf9s\xefԗ\x0e\xf6\x16a\x94\xdcrd|\xea\xabEQ\xf0?\a\x0e\xb0\xeb\xc6\x1bE\xdf\r7\x00\xf0ڳ\xdf\xe7\xf2\xabo\xe2\xa9\xef|u\xd5\xe8\x92\xd1{\x1b}\xfd\xb5\x81\x81>^zq\x1fSSM6o^\xcf\xea\xd5\x17r٥\x1b\xe9o\xf4\xf0ځ\xb7\xb1m\v)\rU\xadV\xf1Y>\xdc\xc7\xe2\xc1\x1e\x16\xf5\xf7\xe2y&\xb49\x8eĵ%\x9em\xd1[\xf3\xa9\x06\x1e\x960\x16\x1cI\x96\x992\xba\x80\x890cC\x9fQ\x84\xba\xbe\x87\xb0\xed\xf2\xdc`\x99\x8b\xa8n\xbeەD$\x89\x11\x8b\xaa\x8cV;\xa3*\x05g\x92\x8207\xdd%}\xe5\xa9]Z\x02\xdf6{F+ə\x893\xa6\xa3\x94N\x9a\x1bs\x1c\xc76\x92C\xad\xb1-\x89V\x8a\x17^|\x95\x91\x91a\x1a\x8d\x1e\x83\x82\x9d\xf7e\x88\xa2):W\f\x0e\x0e\xe2\xba./\xef\u074bR*\x15B\xfc\xf9\xf6\x1d;\xf7\x8b\xa7\x9en\xef|\xf2\xe9C\x0f\xde{\x17\x8f=\xf9\xf4\xc2=\xa4\xeb\xd8\xfc\xec\xebG\xbe\u007f\xc5\xc5+\xb6=\xfd\xcc7\x84\x10\xa2x~\xf7n\xf1\x8c\xd6EQ\xbc\x86\x10\x1b\xd8p\xe5\x87y\xed\x99Ǘ\x14\xb0˲\xac\x11\xad\x14K\x96.ᢵ\xabyy\xef+DQ\xc2\xcaUk\x988=\xc6ҥ#&\x14H\xc3O\xef\xef\xad\xe1;\x16\xb3aL+\x8cqdk\xce\xce)S9Q\x92\xcfy\xe5R\x18(\xcc@O\x15\xa5\v\x9a\x9d\x88(Sh\x04\x93q\xc1\x80\x95\x93\xa5\t\xb6\xebS*\xfa\xe7o!\xa5$݄2Q\x9a\x048\x8e\x85(\x14\x15)\x98\x12\x82 \xf0\xc8rM\x92\xe5\x14E\xc1T\xc7T\x18\xbc2\r\xae8\xe6!geU\xc1sLu:Lr\xa4-\xa8U\x04\xbb\x1f~\x82\r\x1b\xd7qŕ\x97\xd2\xd7\xd70+\xb4\xfc(\x9e\xe72::J\xe0\xfb\xbc\xfa\xda\xfe\xae\xd2\xec\x9ez[=\x01\xf0(\xf0\xcf\u007fq+\xb7|\xf2\xf3\xe7\xac\x10\xd1=\xe1\x02\xe2C\xef\xdfTEz\xe1\x9eg_8\xc7\x13\xf5\xed\x17\x9e\xba=U\xea/uQ\\h\x95\x92\x00\n\x8b\xff\xfc\xf1O\xf8\xf6w\x1e\xe1\xca+.\xe1\xc3\xd7]K\xbb\xd3\xe1\x9d\xe3Gy\xf4\xb1\xff\xe0\xf4\xe9Iz*\xbe\xb9AԊ\xbe\x9aO_\xd55\xd2\xeaR\xccoK1gz\x96e\x8a\xe9N\xcc\xe4LH\x9a+<ץ^\xf5\x892\xc5T+d \xb0\xb8fԡ\xd6\x13P\xeb\x1bDH\x1b\xcbv\xb0\xca\x105_|\x9aE!I\xbbE\x16\xce0=\x1d\x13vr\xde\xeeh\xf6Mg\xd4{\xaa\xd4|\xdf\xc0\x01,#&\xb2\xe6\x8cx\xcfZM\xe9\xc2\x14\xca\x1ciJ-\x91\xd2t\x12E\x98\x98ju\x9e\xe5\xf8\x81\xcf\xef\xddt=}\xfd\xfdh\xad\t\x82\x80e˖\xe28\x0e\x87\x0f\x1d\xe6\xd4\xd8\x18\xc0\x1d\xe0|y\xfb\x8e\xcf\xe7\x0f캓[\xef\xd8y\xfe\x93\xfa<O\xf3\x02h\x1f\xdc\xfbSVo\xde\xca\xf7\xbe\xfe\x8f\xac\xbbd#\x83\x15o\x8bR\xfa\xc1N\x92\xae\xef\xd6Y\xa5\xec\xba\"\xc0e\x97m\xe1\x91G\u007f\xc0\xf1\xe3\xa7PJ\xd1\xd7\xd7`\xfc\xd4I\u07b7e#?|\xeai\xe2<ǲ`\xb0^#\xceR\xde\x1ek\x9a\xb4v\x9e\xf0\xdbd#6uߥ\xbf'`Q\xa3\xcat;\xe6\xd4T\x9b\xd3\xd3-|\xd7a\xa8\xb7\xca\xc4lȁ\xa6b\xb3\x9b\x91g\t\xae\xb4\xe7ϫ\x05?Y\x96,m4,\xa44\x16\x1a\x17T-^i\x1aFo\xcd7:\xc9v\x9c\x93\xe6\xe6\xfe\xc5\x16\xa2\xbc\xf65\x1f\xce)'N\xb7\xa2\x00E\x89ې(%\xb0\\\x87$\x8ey蛻\xf9\xc4\xed\u007f\x88m\xbb\x8c\x8e\x8c\xe08\x0eq\x14355\x05\xf0\xc9\xed;v\xdew\xff?\xdd\t\xf0s\a\xa3\xec\xd6YX>[\xbd\xd9\xec\xeb\xbf\xfd\xc7\u007f\xc5\xfa-۬\\\xa9\xbf\x93\xd2Z\x1fx.\x96\xb0Jʦ5WCZ\xb4h\x98\r\x1b\xd61;۞\x9b\xf5\x8bF\x16\xb3x\xf10\xeb\u05efF\xeb\x82$\xcd\x19\x9bl2\x1b\xc6\x06]4\xd0˅#\x03\xac\x1c\x1dd\xe5\xc8 ˇ\x1a\xf4\xf8>I\xaay\xeb\xe44\xfb\x8fM2\x1b\xa6,\xea\xab\xd1_\x0f\xc8UN\xab\x1dR\x0f<ޜQ\x9c\x9cMѥ\x14z\x0e\xfa1\xdf\"F\b\xb3\xaf\x94\xdaĮ0\u0557\x82\x15UI\x9cf\xcctBf:!\xa2(\xe8\xab\x06\fT\b\xd3\xdb=\x00\x00\x02\fIDAT|\xea\xbeC\xcd\x11T,\bD\x81](<!\xe8\xf1l\x06\xab.\xfdU\x8f\xc0\xb1H\xb3\f\xad5\xa2+\xf1\v#\x1e\u007fl\x0f\x03\xfd\r\xfc\xc0\xdc\xe97g\x9a\xb4\xc3\xe8\xee\xed;v\xde\xf7\xc0\xdd\u007f\xcd\xf6\x1df \xee\xff\x97\xbf]\xf0\xfa\xae'\xf5o~\xe5\x1e\x06\x1a\t\xd7~\xec3\xe64\xfe\xc4\x03\xfe\xd4\xd4\xcc5\xdf\xfb\xb7\x9dWXB\xf4Tk\xd5b\xe9\xe2Q\xb1h\xd10\x9d()\x99Q&ԤiƯ\xfeʕ\xbc\xfa\xea\xfe\xd2JC\xd3\xdbh\xd0i\x0f\xf2\xbeK7\x12G\t\x87\x0f\x1dŶ%RX\xa4J\xa1#M(\x8cnc\xce{\xabD^\xd4*>\x02\xd3<ъRD9+\x934'\x89\x13\xf2B\xf0\xecx\xce\xe2\xc1\f\xdbK\xb1\x94\rv1\x17\xb2\xbaBTS^\xb7\x11X\xe5\xf9\x02T!\xd8\xd8p\x18K\x12\xe24'\xf0\x1c\x92,3\xc9\x03\x05V\xd95#\xe6i'\x8b\xd2\x1b^tu\x92´<\xc9B\xa3\x85\x05\x1a\xaaU\x9f\x9f\x1d<́\xd7\x0f\xf2kW\x8d\"\x84`rrj,\xcb\xf3\xfb\x01n\xfd\xd4ߛA\xb8\xe7\xd3l\xff\xb3/\x9a\xee\xfa\xf4\xdd݄\x16,\x8fG\xff\xfdK\x17d\x99\xba=ϲ\x8f\xaa,\x1b*\x8a\"(\x8aB\x14E\x81㺌.]\xcc\xda\xd5+\x01A\x9cfe\xdem\xc84w\xdd\xf5E>\xb5\xe3\x13\xa6\x02\xec\x9a\xca\xe9\xd8;'\x18\x1f;\xc5\x1b\a\xdeb\u07fe\xd7\tø,\x97@\xdd\xf7\xa0\x1c\x04c\xa1Q\x9c-8\x16\x05J\x19\xab@GZHa\xca/\xdd\xf0\x9aj\xd84\x1c\xb0m\xfd0\xcar\x90\xae\x8fp\xdc\x05\xaa`\x9d+\xb2\xa8C4=Ik\xb6\xcd\xe4t\x8a\xb4\xa5\xc1\"\xa5\x9ag\xced(\xdbP\xa8\xbb\x81\xaa\x14\xc6u\xfd\vΆ?L\xd3\xdf\xfc\x8a\xf2Y\xbc\x8b\x11\x9e΄1\x9e\xebr\xc5\a.\xe7꫶r❓\xfb\xb7\xef\xd8y\x8e\xbb\xc0\xd7\xef\xbe\xc3\xf5\x9d꺛o\xffܻ\x9e\xf7\xfe\x17\xdd8[I`\x92A\xc6\x00\x00\x00\x00IEND\xaeB`\x82")
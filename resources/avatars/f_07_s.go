@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_07_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x167\x99\xec\xe9o\x00\x00 \x00IDATx\xda\xec\x9dw\x9c\x9cWy\xef\xbf\xe7\xbceީۻv%\xadU-\xc9Mr\xc7\x05\x1b7l0\x06\f\x04cB15\x84\x80\xaf\x13\x8aIB\x0f\t\x04L'@h\xc6\xf4\xee\x0e\xd8\xc6\xddF\x92-\x17Y]Zi{\xdf\xe9\xf3\x96s\xce\xfd\xe3\x9d]I.\x14CȽ7w>\x9f\xfd\xac4;3;\xf3\xfe\xce\xd3~\xcf\xefy\x16\xfe/\xb9\x1d\xbb\xfat\xfe'\xdc\xc4\xffIo\xe6\xe8\xb6\xf5<2\xb9\xf9\xf7>\uef17_\xe1\xb6\xc9\xf6\xb6\xca\xf0XkirZ\xe802:\f\xf3Sŉ\xd1Gg\xb6\xf8\xff\x1f\x90?\xe1v\xe1\x85\xff\x8b\x1bo\xfc\xf7\xc3\xeeK@\xe6M\xef\xfa\xd2\xda\xe6\x9eE\xab\"?`\xf8\xb7\x9b\xbbL\x10\xad\xb5,\xab\xd9\x18\xac\xda\\\xa1\xa167\x97\v\xcaլ\x0eC\x8c\xd1he*`\xf2\x18&\x8d0\x9b\x8d1w\x0eW\a\xee~bz\xfba\x00\xado;\x9e͓\x1b\xff? O\xbemX\u007f9\x9b6_\v\x801\x86\x8b\xcez\xdb\x19\xe1\xc0\x81w\xb4.]\xf2\xbc#\xce:=\x9dji43\xfb\x0e\x88ٽ\xfb0J\xa3\xa3H\x94\xa6f\xa8\x8cO\xa1\xa3\bc\xcc\xefz\xf9\xf9\x1fjc\xf4C\x06\xbe\xe7\xeb\xda\rw\x8eܱs\xfe\x01\xe7\xf7^\b\xc0-\x837\xfe\xcf\x03\xe4\x1bߟ\xe15/o>\xec\xbe\u007f\xfc\xc8O<_\x05\xbd\xf7]\u007fӹ\xb2\xe6\xbf'\xa3L\xcf\xf23N\xa1{ñ\xf8\xf9\x02c\x8f=Aer\x9a\xb0Z\xa54:A-\x9f\a\x04B\xcagFA\x1b\x8c\xd1\b!\x10B>\xe5\xd3\x19\f\x18\xee\x17B|\x13\xb8\x05\x18\xbee\xf0\xc6\xe8\xfc\xde\v)\x86E\xee\x1d\xbb\xeb\xff}@\xce<\xfb5\xfc\xe6\xb6opʩ\x97Y\xf7\xdd{\x9d\xb9\xf8y\u007fk\x9f\xf0\xe2\x17\\\xec$\x12/\xd9r\xc7]g\x0e>\xba\xb5\xa3\xc1\xb2Y}\xcesi[\xb9\x8c\xe2\xe88c\x8f=\x81\x9f\xcfS\x1a\x9b\xa423\x87\xd1\xf1E\x060B\x00\x06q\x88\x81\x18c\xd0Jѱ\xb4\x8f\xb6\xa5}̍M2\xbe{\x1f\xb5r\x05\xc7K,<\xf7I\xb7A\xe0.\x83\xf9\xf7[\aoz\xf8\x92\xfe\x97R\xf0\v\xdc6\xfc\xcb\xffw\x01y\xf1ˮ\xe2'?\xf8\x04kV\x9f\x93hm[\x94;\uf56f:ײ\xac\xf7\bK.\x1d\x1b؟\xda\xfc\xeb\xdbar\x96\xf5\x17\x9eG\xe7\xba#\xf1Ke\x0e\xdc\xfb Q\xad\xc6\xdc\xc0 ՙ9X\x00\"~\xbb\x06\x90\x87\xb8+\xad\x14N\xc2\xe5\xc2w\xbe\x81\x8e\xfe%8\t\x17\x1d)\xfcj\x95\x03\x8fo\xe7\xeek\u007fL\xadTA\xda\xd6a\xfe\xec\x90\x0f>i0W\xde:xӷ\xffG\xb8\xac\xab>xm\xa2\xb9\xads\x83\xe5\xba\xd7`\xcc\x06\x80\xd0\xf7\xd9\xfc\xab;8\xb0m\a\xabV\xad\xe0\x98\x17\xbd\x80\xc8\xf7\x19\xdd\xf2\x18\xa5\xb1q\x82b\x99\xd9}\xfbQa\xf4\xd47,\xc4B\xfcp\xbc\x04+NZϩ\x97]\x82\xb0,\xaa\x95*\xfb\x06\x86\x18\x1e\x1e\xa3\xab\xab\x8d5kV\"\f\xdcs\xed\x8f\xd8~\xefoQ\x91:\xf8BR\x806\a\xbd\x1d\xe6Je\xd4\x17n\x1b\xba5\xb8\xb0\xefbƪ#\xff-\xc1\xff\xcf\x0e\xc8\xdb\xdf\xfbe>\xf3\xd17\x02\xf0ϟ\xfa\xf9\xcad&\xf7>)嫌1\x94\v\x05\xaa\xc52s\x13\x93\x1cض\x83\xa9\xbd\xfb8\xf7\xf2W\x92\xed\xec \xf2}\x867n&\xaa\xf9\x14G\xc6)\x8c\x8c\x1d\x0e\x801H\xdbF8\x0e٦\x1c\xad}=\x1csޙ\xb4/\xefg\xdf\xee}l~\xf8qv\xeeڇRj\xe19\x1d\xed\xad\x9cx\xc21,^\xba\x98\xc2\xc8\x18\xf7~\xff\x17\xcc\xeeݿ\xf0\xbaBJ\x8cј:0\x06\xf3\x15\xad\xf5\xfb\u007f5|\xcb\xc8\u007f\x97\x85\xd8\u007f\xce\x17{\xc7\a\xaf\xe3\x9a\u007f\xba\f\x80\x0f\u007f\xfe\x96\xb3\x1c7\xf1IiYG\a\xbe\xcf\xd0\xce=\x14gf\xf0\xab5\x82j\x8d\xc2\xf44\xcah\xac\x84\vB\x10V\xabqP\x868fH\tZ\xc7G\xc6\b\x92\x8d9\x1a{\xbbY\xbe~\x1d\xbdkV\xd2\xd8ن\x94\x16\xc3ã\xdcy׃\x14\xcb\x152\xe94\xb9\x86\fɤG\xa5\\abb\x9a\x9f\xfe\xfcVZZ\x9a\xc854P\xedh\xc3\x0f\x02\xbc\xfd\xc3\x18Kb\xb4\xaa\a\u007f\x03\x06\x04\xe2\rR\xca\x13\xce\xed\xb9\xe0\x9d\xbf\x1c\xbe\xf9\x8e\xf3{/\xfc\x8bga֟\xeb\x85^\xff\xf7_\xe1\x8b\x1fy\r\x00\xff\xfa\xe5ۯ\xb4\x1c\xe7\xebҶzf\xc7'عi\v\x95B\x91\xa0V\xa383\xc3\xd4\xd00I\xd7C\x05\x01\x8bV\xaf$\x95͢\u0090Z>\x8f\x8e\",\xd7A\xda\x16n&M\xaa\xa5\x89\xc6\xden\x1a\x16\xf7Ѱf%mK{ilm\xc1\xb1m\xb4\xd6d2\x19\x1a\x1a3\xec\xd9{\x00˲X\xba\xa4\x97\xe5G,\xe19\xa7\x1e\xcfs\xcf<\x855G.G)þ\xbd\xfb\xf1\x83\x00\xe5%\xc0\xb6\xb0\vE\xe6\xf35!%\xd4ݠ@t\n!\x9e\xbf\xaca\xc5\xed\xb7\f\xde8z~\xef\x85\xec.\xec\xfa\xbf\xcbe\xad;\xe92\x1e{\xe0:.\xb9\xe2\xe3ކ\xf5\xc7~\xcbq\xecK\xb51\x8c\x0f\f2\xbc{\x0f*RTKEJӳ\xd8\x06\xba;{H'S\f\x0e\x1f@$]N\xb8\xe8\xf9خ\xc3잽T\xe7\xe6\b+\xb5\xf8\x8d\t\x81\x02B\xdbf`z\x86\xfc\xf0\bڀ\x93\xcdp\xc1E\xe7\xb0\xee\xa8դRI\xa4\x94\x04A\xc8\r7\xdd\xc6\xc6M\x8f\xa0\x94\xc6\x18C[[\v+W\xf4s\xecѫq\\\x97;\xef~\x90\x03\a\x86\t\u0088\x89\xc9<h\x8d\x17\x854\xd6j\xa4\xfd\xdaa\xc9B\xfdv\xc9-\x837\xfel\xben\xf9KX˟\xc5B\xc6\a\x1f\xe5'\xb7\x8e\xc93\xcf>\xfd5n\xc2}'B\u0603;v1\xbc{\x0f\xa5\xd9Y\xe6\xc6'\x10AH{S\x1b]\x9d\xdd\b!\xa8T\xab\xcc\x15\xf2̌\x8d19:\x8a\xb2mD*\x85\x9dN\xe366\xa0=\x8f|\xb5\xca\xd0\xf4\f\xfb\xf7\x0e\xe0ON\xe1*\x85\x1bED\xb3\xb3lݼ\x85\xdd;v\xa3#EKG+ن,ݝ\xed\xec\xda=@\xb5Z\xc3ql\xaaU\x9f\xc1\xc1a\x1e\xdc\xf8\b\xc3#\xe3\xf4\xf6t\xe0%=\n\xc52\xf9\x8a\x8f\x11\x82@\xda\x14]\x0f\xdfuH\x85\x01\xb6\xd6\xf5\xd4\x1a\x80W,kX\xb1owa\xd7#\u007f)+\xf9\x93-\xe4\xf8s\xdf\xc6\xc6_~\x8eW\xbe\xf5\xf3\xfdG\x1ds\xe4\u007f:\xaes\xe6\xeeG\x1eg\xf2\xc0\x10c\xfb\aPAH_\xc7\"\xb2\xe9\f\x81V\xcc\xe5\xe7(\x95˄Q\x80_\xa9`\xb4F74 \x9b\x9b\xb0\xa4\x85\x04D\xad\x8a)\x95\xf0\x8b%\xa4\x10t/\xe9\xc5\bI\xff\x8a\xa5445\x02P.\x94\x98\x1a\x9b`h\xdf~\x82\x9aϱ\xcf9\x91ӟ\u007f\x0e~\x14r\xddw~\xc6\xc0\xfe!\\\xd79\xacV\x01H\xa7S\xe8z\x8c*\x94}J\x15\xbf\x1e\xe4\xc1֊\xd6b\x91\\\xb5\x824f\x1e\x98Y\xe0\xe2[\x06o\xbc\xfb/a%\u007f\x16\x97\xe5\xb6?\xc7\xfe\xe7\x0f~\xe0\xed\x8e\xeb\xfc\xfb\xcc\xe8\x18{\x1fy\x9c\xf1\x03\a\xb0B͒\xde%\b\x04cS\x13\xcc\x15\xf2q\xb5\f\xe8(B\x05A=\x05\x95\b\xc7\x01cH'\x134\xb44a;.\xb9\xc6\x1c'\x9cu\x1a\x8bW\x1c\x81\xd6\n\xad\xf5AR\xa4\xee\xfb\xa30\xa4R,q\xfb\xcfnaߎ]\xac?\xed$6\x9cq\nw߷\x89\xfb\x1ex(~\xce3\xdc\x1a\x1b\xb2\x04a\xc4L\xa1\x82\x1fF\v/\xed\x84!M\x952\r\xb5\x1a2~\xfe\bp\xf2-\x837\x1e\xf8\xaf\x06\xe5Y\x03\xb2\xe1\xac׳\xe9\xf6\xff\x04\xe0\x83\x9f\xff\xe5y\x9e\xe7\xde\x12\xfa\x01;6mad\xd7n\x9cP\xd3\xdd\xde\r\x02\xa4%\xb1\\\a%\x055\xdf'2\x8a\xd0\xf7\x19ܵ\x1b\xad5\xb6m\xb3\xfa\xf8\xa3Y\xbaz%m]\xedض\x8d\xd1\x1a\xdbqH\xe7\xb2X\x96\x85\xe3:\xf11~\x1a\x0eK\b\x81\xe3\xbaL\x8e\x8c\xf1\xc0\xedw1>4F[w'\x91m\xf3\xf0\xd6\x1d(\x13\x83~\xe8s\x8d1H!\xc9fR\x04Q\x84\x1f\x84̖\xfc\xb8h\xac_\x15Gi\xda\vsdj5\x80=\xc0q\xb7\f\xdeX\xf8\xaf\x04\xe5O\xb6\x90\xab>\xfa\xe3Em\xed-\xf7\x02}\x93\x83\xc3l\xbd\xef\x01\x92\x91\xa4\xa3\xb3\x93LW\x1b\x89l\x1a\xdbsqRI\x9cd\x02aY`\xc0(\xc5\xce\xcd\x0f\xb3\xe5\xd7w\xd2\xd8\xd6\xcaY\x97\\@:\x9b\xc1I$\xe8\xe8\xe9B)\x85V\xfa\x90\x8b\x18\xd7!\xc9T\xf2\x19\x89EY\xe7\xb9&\xc7&\x18\x1f\x1ectt\x9cݻ\xf6224J\x88\xc0N%c`\x9e\x04\xa6m[\xa8(\u008f\f\xd5P\xc5\xf5\xa2\x00Yg\x05\x1a*\x15\xda\ny\x80_\x03/\xbde\xf0\xc6\xfcy}\x17q\xeb\x81\x1b\xfe\x8f\xabC\xac\\C\xeeJ`Q\x18\x04\x8c\x0f\x0e\xe2a\xd3\xd6\xdeJ׆\xb5\xa4ښ\xea.\xc6<\x85\x8b\x95\x8eMSW' \xa8\x96JT\x8ae\xbcT\x12\x19Eh\xa3\x90R\xc6.)R\bc\xb0l\x1b\xa3\r\x81\xef\xe3\xb8\xeeӾ\x99y\xf7\xd4\xd2\xdeJK{++֭\xe2\xf8眈_\xf3ٲ\xe9\x116>\xb0\x89\x8a2H\xcb:\xccR\xc20\x8a\xad\xcc\x02KڄJ\x11i\bU\x1cG\xe6\xd2i\x02\xdbf\xd1\xf4\xd4\xf3\x8c\x10\xdf:w\xf1\v.\xbbu\xff\xf5\xa5\xff\n\v\x91\xcf\xe6I\x97\xfdï\x01x\xd3\xd5\xdf:Ͷ\xad\x97\x01\xb2V\xae\x10\x14+\xb4\xb6\xb6\xd3}\xe2Qd:Z\xb0\x84 幤\x121\xc1\xa7U\x1c\a4\x86@\x19\xb4\xed ]\ac\f\x95R\x19L\xccM\x85~\x18\x9fP)p]\x1b˱b\xb7g[p\bu\xf2{O\x9bm\x93\xcbe\xc8沜~\xf6i\xfc\xaf\xab\xdf\xc9\xd9g\x9fF*\x95<,\xd0\xcf\xff[\xd4\x19\x15)\x04\x8e\x14$m\x89\x004PI$\x18li\xc5\b\xf1B\xa9\xf5\xdf\xfdU\xebY\v\xd7n\xf1\xba\x8b\xff\xfb\xd2\xde\xf3^u\r?\xfd\xe2+9\xf3\xd2\u007fm\xe9\xee\xea\xf8zcCz\xb5њ\xd9\xf1\tt\xa9F\xcfѫɴ\xb7\x92tm\xd2^\x02\xa55\x15? \x8a\x14\xa16\x94+\x01~\x10sJ\xca\x18\x0e<\xb1\r\xa9\r\xed\x8b:I\xa6S؎\rƐL\xa7\xea4\xba\xc0\x92\x16BJ\xa4%\x0fZ\x98\xfc\xc3ϒm[D\x91B)ŒŋX\xb5j\x19\xb5\x9aO\x10\x84D*\x8e\x1fR\x88\x85\xe0aIA\x10\x19\"\xa3Iز\xfe^\xe3zȷ\x1d2~\xed\xac\xa2\xeb\x8e\xed\xcb\xef\xdc\xd4s\xf4K\xc5\xe0\xa3?\xfd\xef\x01\xe4\xec\x97\u007f\x8c_}\xf7\xaa8\xa8\x9fq鯏X\xdau\xb2m\xdb \x04s#c\xb4\xf6\xf5\xd2\xda\xd7EK6\x85\xe7:\xe4K\x15f\vU\x8a\xa5*\xb3\xf9\n\xa5R\x8d(ҤS\t\xa2H\xb1\xed\x91ǩNL`\xb4\xa2\xa3\xa7\v/\x9d\x8c\xe3K=\xa0'\x92\x1eB\x88\x05 \xb4\xd6X\x96\x85\x9e\xaf\xaa\xc5\x1f\x1e\x02-\xcbBkM\x14)\x12\t\x97\xfe\xa5}\x1cѿ\x98\x8e\xf6V\x1a\x1bsLM\xcf\x12E\xd1\xc2k:\x96 \xd2\x06?Ҹ\xb6D\b\x812&\x06\xc5q\xa9:\uee5d\xadk'v=\xfe\x83ͫ6\xbc\x85\xa9\x91M\u007fy@\xf6m\x8d]Յ\u007f\xfdٯ\xac[\xb3\xe4\xe2t*\x89\xc1\xa0\x94\"̗\xe8?z\x15-\x99\x14A\xa8\x18\x99\x9aef\xb6D\xbeX!\bB\xbcd\x82\\6E*\x99\xa0T(\xf1\xd0}\x0fS.\x17\xd1\xd5*k\x8e]\x8bQ\x8a\\s\x13\b\xb0m\x97j\xd5'\x99Jb;\ue0a5\xcc[\x85eI\xb46q_\xe4\x19@1\xc6\x10E\n)E\xfd\xf9\xb1\v\x9b\xaf\xe2\xa5\x14$\x93\x1e--MضͱG\x1fI\xb9Reff\xee\x10P$\xca@-4x\xb6D\xd4\t\xe2ж1BXڒ\xc77vo\xb8m\xe7\xe6/\x8f-=\xe1\r\xcc\r?\xf4\x97\x03\xe4\xd4\x17\xbe\x8f\xc1\x1dwq\xd1k?w\xd1\xeaU}\x1fll\xcc8\x88:\xbdQ\xf3\xe9]\xbe\x84\x86\xb4\xc7\xe4l\x81\xfd#S\x14K5\xa4\x94\xa4R\x1e\xb9\\\n!%ssEj\x95*\x8fmz\x8c\xe2\xcc4\xc5\xd1!\xd0\x11k\xd7\x1f\x1d\xbf\x94%pݘl\xb4l\x9bj\xb9B2UwcOʌ\xe6/\xf43\xa6\x8fB`\xd5-\xeb\xd0\xc79\x8e\x8d\xd6f!~H)I\xa7S\xecݷ\x9f\xc5}=\x1cѿ\x98J\xb5F\x18F\x04A\x80kYh\xc0\x8f4\t\xeb (FJl\x15e\x84\x10ݩ%'\xfd\\\x1a\x13\xfd\xc5\x00\xe9[\xf9\x1cn\xb8\xf3Z\xf6\x8f\xb7\xe7\xfa\xfb\xbb\xff\xb3\xa5\xa5a\x89U?\xad\xc6\x18r\xb9,-\rY\x86\xc7g80:\x8dւt\xda#\x9d\xf6\x00\xc1\xf4l\x91|\xa1\fZ1\xb0c\x1fsSS\xcc\xee߉e4\xeb\xcf}>\xbd\xcbW\xb0\xf6\xa8\xe5̌O\x11\x06a|\x01\x8dƲ-\xfcJ\x15'\xe1\xe2&ܧ\xbd\xe8\xbf7\xaf\u007f\x9a\xc7XVL\xbb\xeb:\xedn\xdb\x16mm-<\xf6\xf8v&&\xa79\xf1\xf8cI\xa7\x92d2\x19&\xa7\xa6\xb1e|\x00\xaaA\xec\xbe\xc0\xa0M\x9c\x11%\x82`\xa5\x91\xb2\xb0w\xe3W\xef]r\xfc\x15̍<\xf4_\v\xc8\xd25\xe7\xf0\xe1\xcf\xfc\x803\x96gx\xe1\xcb\xde\xfcᦦ̥N\xbd\xfb6\u007f\nۛ\x1a\x98\xce\x17906K&\x9d$\x9d\xf6\x90R01U`pd\x9at2A&\x95`h\xf7~\xa6'&ɏ\fпj%\x97\xbc\xf9\u0378\x89\x14?\xffʗhmm\xe1\xa8\x13\xd7\xd3\xd8\xd2D\x18\x86h\xad@H\xb4\xd6T\xcbel\xdb\xc6K>\xb5\x06\xf9c\xe2ȓk\x8fC-E\bA\xff\xd2\xc5<\xb1m'\x13\x13S$S\x1e\xd5J\x95\xa3\x8e:\x92\x91\xd11\x841H\x01\xb5P\xe39\x16\x911h!\xb1\x95\u0097\xd69\x8d\x8b\xd6_?\xb0\xf1\xab\xa3\xcbOy+3\x83\x1b\xffk\x00y\xd7G\xbf\xcd\r\xdf\xfb\x04?\xbd\xf6\xe3\xbc\xf7\xdfo\xb8\xa2\xa1!\xf31\xfb\x90\x1c\u07b6,R\x89\x04a\xa4\xd938I6\x93DZ\x92\xb9|\x85}\x83\x13\x04ADOG\x13\x96%\x19\xde{\x80\x91\xc1\x11\xaa\x13C<\xe7\xec3\xb8\xf8\xd5\u007fE\xa1Xa\xcb\xed\xb7\xe3\x0e\x0e\xb1c\xe3\x16\x94m\xd1\u007f\xe4J\x9a\xdb[\xb1-\x1bc\f\xbe\x1f\xa2\x95\xa6R*\xa3\x94ZȾ\xfe\x14@\xe6kb)%QtxWr\xc5\xf2~\xb6o\xdfM:\x95Ʋ%\x03\x03\x83\x1c{\xf4\x1a&\xa7f\xd0Q\x88\x04\"\x15[J\xa8\xe3:\xc5\x00F\xc8\xe7\xf7\xac8\xfd\v\xdb\xef\xfe\xacZ\xbc\xe1\n\xf2\xcf\xd2R~' \xf7\xde\xf6\x13\x00\xde\xfd\xaf?|i\xae\xa1\xe1\xdaCSM۲\xb1-\v\xd7v\x18\x9e\x9c#\x99t)\x95}\x0e\fNQ,Win\xc8\xd0\xdeڀ\x1fD\f\r\f3\xb0s\x0f\r\x9e\xe4\xfc\x17\x9eˆ\xe7\x9eɁ\xb1\x19\x1e\xfcō$\x9ex\x82\x9et\x86\xb6\\\x9a\xa1\xed\xbb\x18\x1e\x1a\xa6\xado\x11\xadݝ\xa4\xd2)\x12\xc98#\xab\xd5|\xfcj\x950\fq]\x17\xdbq\xfe @\xe6\x03\xf8\xd3\xff\x8c\x05@\xe6_G\b\xc1\xb2eK\xd8\xfc\xd0c46\xe6\xf0\x12\t\xf6\xec\xddϱ\xc7\x1cI\xa9\\\xa5R)\xa3\xb5F\n\x81 \xae\x89\x8c\x94hC.\b\x82D~\xe4\xa1_\xe7\xff\x04\xb7\xf5\x8c\x80\x9cp\xee;\x18\xde\xf3\x00\xef\xfc\xe0uG565\u007f˶\xed\xdc\x02\x99\xe88X\x96=m[2%-\v?\f\x99\x9c.226KKS\x9a\x8e\xb6F\\\xc7btl\x9a\xe9\xb9\x12\x13\x83C\xb45gx\xc9\xcb.\xa0\xb1g\tC\x93E\xee\xfe\xd9ϰ\xb7>AW*I[F\xd2\xecٴf3D\xf9\x02C\xbb\xf622;G\xe7\xe2\x1erMM\xa4\xd2I\x12^\x82R\xa9La\xae@\x14\x84x)/\xe6\xb7~\xcf-\f#\xa2HaY\xf2I\xe0\x99zƥ1\xe6  \xc6\x18l\xdbb\xd9\x11Kxl\xeb\x0eV\xad<\x82\xb9\xb9\x02\xdbw\xeea\xc3qG\x91/\x14\t\xfc\x800\x8c\xb0m\x8b\xd0\xc4J\x18!\x840\x86e\x8d\xdd\xc7ݜ\x1fyh\xf2\xcf\x0e\xc8\xf0\x9e\a\xb8\xea\xa3?\xf6r\xb9Ư\xbb\t\xf7\u0605T\xd0q\xb0\xa5\x1c\x8fj\x95ے\xc9\xd4Zm4c\x93s\f\x8dΰxQ\x1b\x99t\x92\xb9|\x99m\x8fl'$v\t\xba\\䥗\x9e\x8f\x95if\xb2\xa4\x18ݻ\x97\xfd7\xdd\xc0\xea\xc6\x1c\xbd\x8d6i\xcf&\x99\xb0Ⱥ\x82\\\xd2\xc1T\x03\xf6l\xdbɍ\xbf\xb8\x95t6Mϒ>܄KK[\vZJ\x1e߶\x83\xe9\xc9\x19\x9a\x9a\x1a\xf1\xbc\xc4\xef\xa9?$\xbe\x1f\xa1u\xdc;\x8f\"\x05\x18|?\"\x8a\"\xac\xba\v>4\x96ضE\"\xe1\xb2j\xc5\x11\f\r\x8f\xb2j\xe52v\xee\xda\xcb\xe0\xd0(\xeb\x8fY\xc7\xd8\xc4$\x18\x83\x8a\xe2\xde\xc9\xc1\xb0&2\xc0DS\xcf\xfa\xbb\x9b\x16m0sÛ\xff<\x80\xbc\xe5\xea\xef\xb1\xe9\xee\x1fq\xda\xf9\x97_\x99L%\xde4\x9f>J!ql뎻~\xf5\xb3\x97,_\xb9\xf6\x1d\x96m\xb5U*\x01\x03\xc3S\xf4\xf5\xb4\"\x80\xb1\xc9<\x83\xbb\a\xd0J\x91\xc8f\t\xcbe.8\xfb\x04\xda{\x161V\bA\b6\xff\xf0\xbb\xac\x14\x86\xfe\xa6\x04\x9e#I\xa6\x12\xd8\xc9\x04µ\xc9$mҮ\xa4\xd9\xf3Ha\xf1\xe0}\x9b\x98\x9c\x9a\xa6\xb5\xab\x83lC\x8e\xa6\xa6\x06\xfa\xfa\x16142\xc1\xa6\x87\x1e#\bCҩ$\x9e\xe7=\x83\xfb\x12؎$\f\xd5B=\x13\x04\a\x95\x8fZ\x9bC@9\xf8\x9c8\x13\x8b\x85\x12*Rtww\xf2\xe8cۈ\xb4\xa6oQ7c\xe3\x93u;\x8b\v\xc8C\x04F\xc7\v!\xbe:\xb0\xf1\xcb\xe5?\x9b\x85l\xba\xfbG\x1c}\xf6\xfb\x16\x1d\xb5\xae\xff\xc6D\xc2\x11\x02\x81\xeb8\xb8\x8es\xf5\xdc\xcc\xf4[\x16\xf5\xf5\x1d\x99Ne\xfe\u07b2l\xa6\xf3e\xd2)\x8fZ\x1022>G0W`nb\x9cTk+\x916lX\xd7\xcfڵ+80\xe3c\xa4\xcd\xf0\x8emL\xdcy;\x1b:\x1bI{6\xa9\x86\x14\xc9l\n\xd7s\xf0\x126\xb6\xeb\x92I\xb94\xa4\x1c\xa4\xb4hM\xa6\x99ܷ\x9f\xed\x8fne\xaeP$\xdb\xdaL\xae\xb1\x81\xa5K\x16\xd1\xd5\xd5\xce\xc4\xe44;w\xeccll\x92\\.C:\x9dz\nG%\x85Ĳ$a\x18\x03aY\xd6a,\xbe\xd6\x06\xd7q\x16\x00\xd3\v¼\xb8\xa0\xf4\xbc\x04\x99t\x8ad2\xc9\xc6M\x8fл\xa8\v۶\x99\x9d\x8duc\x91҇ҧ.h;?\xf2\xf0\xadKNx\x13\r\xad\xbd\xe4Ƿ?{@N9\xef\x8d\f\xee\xd9̥\x97\xbf\xf9\xea\xd6֦\xe7`\xc0u\x1cm[\xd6i\xaf\xbd\xec\xc4\xef\x9cs\xd2J}\xe9\xe5\xef\xf8\xba\xed\xb8K#\xa5\xa8\xf8!\xc5r\x95\xa1\x91\x19D\x14Q\x9d\x9e$\xd0\x1a\xaf\xb1\x89\x94\xe7r\xd6\xc9G2U6T\xa2\xb8R\xbe\xfe\xf3\x9f\xe6\xb8L\x86\xde\x06\x97d.E\xae-\x87\xeb:ض\x85\xe3XX\x96\xc0\xb1mR)\x97\xa6\xac\x8b2\xd0\xde܄\x17(\xa6\x0f\f\xf2\xf8\xa6-\xb8M\r\xb4v\xb6\x93\xf4\\\x16uw\xd2\xd7\xd7M\xc2K\xb0u\xebN\xe6\xf2\x05:;\xda\x0e\v\xd2q`\x97\xf5\xa2P\xa1\xd4|\x85\x1f\u007f\xf7\\\x1bK\blǮ[\x06DJaI\x81\xd1\x06!\x05\x89\x84KcC\x16!\x04\xa5R\x85\xfe\xfe>&&g\xf0\xfd\x80P?9\x15\x97\xc77\xf6\x1c\xfb\xb5\x81\x8d_)<\x13\x18\x8bO|\v\xdf\xf8\xfa\xb7\xf9\xfe\xb5\x9f\xfb\xc3\xfa!\xfft͍[ҙ\xf4\xd1\x02\xa1SI\xef\xa5o\xbb\xecğ\x02|\xf1\xba\xfb\xcfr\xdcď\x81\xc6r\xd5g`t\x9a\xa1\x91\xe9\xf8\x94\xf95\xa6FG!\x95&\xd9\xd4Ī\xa5]\x9ct\xecr\xf6\xcc\x1al\xc7\xe1\xa1[o\xe2\xc0on\xe7\x85=-\xb46$\xc8v4\x92\xf0\x12H)p\x1c\v7\xe9a\xb4\xc6h\x8d\x14qC)\fBF\xf3\x01\xa12LL\x95\x98*\x9690=Kf\xd5\n\xce~\xf9%\xa42阪\x17\x02KJ\xf2\xf9\x02C\xc3ctt\xb4\xd2\xd4\xd4\xf8\xb4̰֚0\x88\xea4M\f\x02\xfa`\xefE+\x8d\x1f\xc5\x1dD˲PJ\xe186B\b\x06\aGٱc\x0f--M\x14\x8a%~s׃\x94\xfc\bmX\xe0\xd8\xea\a\xe1G\x03\x1b\xbfr)\xc0\xfb?y\x93\xd7\xd3պF\n\xf1\xf2\x1d\xbb\x87\n+W\xf4\xdc\xdb\xd9\xd6\xf4\x80\xd2\xd4\xc204/9\xff\xc8\xdf\xdd\x0fq[֧S\xe9t[\xad\x16\x98\xa6\x86\xec\xb7\xdb;Z~\x06p\xf5G\xae\xb5\x91\xf2\xa5\x06\xd3h4L\xcc\x14\x99\x99+Ŝ\x91\xd6Ԋ\x05\xa2(\"\xe9y\x18cH\xa7SLW\xe3\xd7\xf4\xcbe\x06\xb6>\xc6\xf2d\x8a\x9c'qS\x1e\x8e\xe3\xc4\x1c\x93c\x93L\xc7\r,c\f\x96\x1d\xbb\x14!\x05N¡1\x88\x98\x9c)\x93J:d\xa6\x1c\xb2\x9e\xcb\xc4\xd00?\xfb\xf8g9\xe2\xd4\x13Y\xb5\xe1\x18ڻ\xbbPQD&\x9baժe\xf8\xbeO\xad\xe6?\xa5\xaf.\xa5DJI\xc2s\x9f\xdc݊\x01\xd1:> \xb6E\x10*\x94\xd2X2\xe6\xcel[\xd2\xdb\xdb\xcd\xdc\\\x81\xbd{\xf7\xb3|y?\xabV\xf6\xb3m\xc7^\"e(\aѡ\x80\x9c\xb7\xfc\x94\xbf\xb9\xe8\xe2\v\x9e\xeb\xe72\xde\tB\x98\xd2\xe3\xdbv|\xe0S\x1f\xb8\xbc\xfc\xf9\xaf\xff\xaa\xa3\x98L\xfc\x9bm۟\u007f\xe9\x05k\xb6\xff\xde\x06Օ\xff\xf0\xae%a\x18\xba\xae\xe3\xd6\x12\t\xf7c/{\xde2\x03\x90kn\xeb1ƼD (T+L\xe7˄\x81\x02\x04ʯ\xe2ת`[X\x89\x04mm-466\xc6~\x18\xc8\xcfL\x93\t}:<\a\u05f5p\x93.\xb2\x9e\x8a\xa6\xb2Iҍ\x19\x9cd\x9c1\x05\xd5\x00\xad\fN\xd2%\xd3\xdc\x00\x182\xad\x8d\x94\xe6J\xe423\xa4&\xe6ȥ\x134\xe4\xcb\f\xdcq7{7o\xa1\xfd\xc8U\xac:~=K\xfb{\xd1Q\x84\xeb:hm\x0e\x89\a1 \xe6w1\xc5B\x80e\x81\xd6\xf1\x85\xb1!\x88\x14Z\x83c˅\xc0\xbdf\xcd\n\\\xd7a\xf3\xe6\xc78\xf6ص\xec?0L\xbeT;\x9c\xf2\x17d\xfb\xbaڮmjH~\xa7\xbb\xb3\xe1_^\xf1\xc2\xf5C\x007\xfe\xeaa.<\xe7\xd8\xf1\xff\xb8\xf6\xf6O7\xb7\xb4\xbf\x15\xb8\xf2G7o\xe5\xa5\x17\xacyf@\x92\xe9t\x87\x1f\x84^s{nۛ^\xb6~\xdb\xc2!\xb2\xacW\ti\xb5ׂ\x90\x91\x89<hC\xcd\x0f\xc0h\x82b\x9eHk\xbc\xa6\x16\xda;;hk\xccҚ\xb5\x99S\x06\x04T\ny\x92\xa1\"\x95q\x102n6Y\x96$\x95M\x91k\xc9a%\xeb\xae+\xe9\x91ni\xc0rmܔ\x8b\xed\xd8\xf1\xc1\x8d4ٶ,\r\xed\r4\xee\x9fdhp\x9aL:As&\xc5\xe8\\\x91\xa1\xdfn\xe2\xe1;\xef\xa3\xf7\xa8\xa38\xe7\x85\xe7\xd1\xde\xde\\o-\x1dLi\x8d1\x87\x89\x1e\xe6]\xdd\xd3\xf4\x82AJl\x11\xb3\xc5a\xa4\x88\x94B\x12\xd7(J\x19\xfa\xfb\xfb\b\x82\x88\x81\xfdC\x9cr\xf2\x06~\xf9\xab\xbbH\xb96\xb5@\x91LHR^\x92\xf3\xcf>\xc9;\xa2\xbf\xf73/>\u007f\xcdЖ݊o~\xfd\x8b\\xαqs\xef\xf2\xb3v\u007f\xf7\x17[\x8e\xf9\xd1\xcd[\x17\xbf\xf4\x825\xfb\u007f\xa7\x85\x04Պkd\xca\x11\x88\xad\xf3\xf7\xbd䯯\xceڮw\xb510S\xa8`\x8c!_,\a\xda\x18WW\xcbhcH45\xd3\xd4\xd9EkK33C\x03\xc8#ZQ\xda \x10TJ%2R\x90\xae\xb3\xb4\xb6k\x93H\xb8\xa4s)\x84c#\xa4\x8c\xef˦H7gq<\x1bc\x04B\x98\x98a\x8d4Z\xb9$\xb2I\x12\xe9\x04m=\x8d\x8c\xec\x9ffxh\x9al*Ak!EG\xa1̮G\x1e\xe6s\xf7\xdd\xcfʓO\xe2\xa43O\xa1\xbd\xb3\x9dT*\x81eYH\x19sc\xf3\xa0\xcc\u007f\x17B.\x18\xc8a\x00ɸ\u05ee\xeanL\x18\x88\"]\xc7\xcbb͚\xe5\x04Q@Ks\x13'\x1c\u007f4\x0fn|\x84\xa4+\xb1\x10\xac\\\xb1\x84\xb5kW\f\xcc\xce\xce\x15\xde\xfb\x81/\xf0\xa5\xff\xf8\x0f\xbe\xf4\xf1\xb7\x1dv\x9d\xfd \xda\xe9\xba\xee\x05\xc0\x97\x9e\x02\xc8I\x17\xfc\x1d\x0f\xdc\xfci\x00\xa6F\xb7ߛm[;h0\xd9\xf9\x9f\x1fw\xe2\xe9W\ni'KU\x9f\x9a\x1fR\xab\x05{ʕZ^Z\xd6q:\fq\xb3\x8d؞GWW\a\xfb\xb7o'\xe5\n\xa4ma\x828;WA\x80\f\x02\xa4\xb4A\n܄K\"\xeda\xbb6\x8e\x97\xc0M'H\xb74\xe0\xa6܅\xb4T\x1eB\xbb[6\xd8\xc2`+\x83\x9bMb\xfc\x1c\xd9\xf6\x06Z:r\x8c\x1f\x98fz\xbaDnƣ#\x9bd8_f\xe0\xd1-|\xe7ѭ\xb4\xf5\xf7ӿz\x05\x8b\x96\xf4\xb2\xb8\xaf\x93\\&ETo%\x1f\xb4\x1eC\xac\xe7>\xbc\xbfb\xea\xda,i\xc9zQ\x19\x81\xb4\xb0\xeb\xeeKJ\xc1\xb1G\x1f\xc9\xc8\xc8\x04'\x1e\u007f\f\x85B\x89\x1d\xbb\xf6ř[\xc2\xc5h\xd3\x13\x06\xbe\xf3\xd1\u007f~\xeb\xe1!\xe1\xfd\xdf\xe6\x93\xef\u007f\x15A\x10\xee\x13B,zJ\fy\xfd\xbb\xbf\xc1\u007f~\xec5\x00\x1eP\xfb\x8fO\xfec\xf1\xd27}\xfc\xb5\xbd=\x9d\xd7\x03|\xf1{\x0f\xa6\xfd@\xbd-P\x11\xe5ZH\xa8\"\xc6'\xe7\xbe \xa5|\x0f\xc6 \xd3\x19\xa8UiH%(\xcd\xce292\xcaڣV\xa1\"\x85m9\x84\xa1!\f|\x12\x98zZ'Hf\x92$R\t\xec\x84K\"\xe3\xe1\xe5\xd2x\xd9$ƀ\x8a\fV\xd2Yx\xf4|\xd1f\t\x90V\xbd\x83\xe8\xd8ؙ$\xe9\xf6f\x9a{\xe7\x98\xd8=L\xebX\x9e\xc1\x91Y\x1a3I\xba2i&k\x01\xbb\x86\xf6s\xff\x9e\xdd8\x99,\x8d=\xbd\xac=v\x1d'\x9f\xb4\x0e/\xe1\x10E\xea\xb0,\xecp\x90\x0ev\x1a\xe3\x03b\xea\x1d\xc7\b\xa5X(\x1cA\xd0\xd2\xd2D\xa9\\ᔓ\xd637W`jz\x86\x03\x83c\x14K\xd5l&\x93\xfd\x02p\xd1\x0f\xaeߜ\xa9\xf8\xfctϾ\xfd\xff\xf4\xa1\xbf\u007f\xf1\xfd\x00^\xc2i5\xc6\xdc\xf3\xb4i\xef\xeb\xdf\xf5\xb5S\x13\xc9ܝQX\xb8\xf8\xcb\x1fyݍ\x00\x1f\xfaܭ\xb7+#\x1fMy\x89\xe7`\x8b\xf5\x91\xd2T\xab!c\x93\xf9\xf2\xc4\xc4\xcc\xc9R\xcaG\x11\x02\u007fz\x02ג\xf4\xaf]\xcb\xc0\x8e\xddT\x8ayV\x1c\xb9\x92c\xd6.Ey\r\xccV\x14\x8f\xfc\xfa\x162\x1b\xefgm[\x9a\xb6\xce&\x96oXI\"\xed\xe1f\x93\xb8)\x8ftK\x8eTSf\xe1\x94\x1a\xf1ԚՒ\xe6\x99\xc5L\x06\xe6\x06'ٷe\x1fA\xc5G+\xcd\xd0\xd0\f3\xf9\n\xe3\xa5*\a\xf2%\xa6\xaa5\xaa\xd2E4\xb6r\xf4\x89\xc7q\xfc\tG\x91\xcd&\x11B\xd6%_f\xe1K<\x8d\x98\"V\xa8\x84\v@\xcdw\"}?\xa4T\xaaR,\x95\xd0Z\xf3\x83\x1f݀\xef\x87\xf4-\xee\xe3\x05\xcf\u007f.-M\xd9\xfc\xe4\xd4\xf4x\xa9\x1a<\xa0\x14o|\xd7[\x9e\xe7\x03|\xe7\x17[\xeeOy\xde\xe9/:wUx\x98\x85\xbc\xe8\xd5W:\xe9L\xc3\xc7&'g~\x96J\xd9\x1b\x80\x1b\x01\xf6\x1f8\xf0\xe9%K\xfa\u007f&l\x816\xa0\x94&TʏT\xf8:)\xc5\xe5\xb1\x021Ą!V\"\x8d\xd6\x06\xbfREZ\x16\x916Tj\x01ٴX \x15\x1c\v\x841\xe8J\x85\xfc\xd0\x04M\x8b;p\xd2\x1e*\x8c\b\xab>*\x93@Z\x16F\xd6]\x95\xd1\xf1\xd5\x16\x02\xf9\xbbH\xdd\xfauk^\xd2ACg33\x03c\xe4\xc7\xf3d\xb2I\xf2\xb3ez\x8bUV\x95jL\x17\xaa̖k\xccU\x8bL\xde\xf1k\xbew\xcf\xfd\xb4-_\xc6\xd25\xcb\xe9^\xd4EG[#\x96%\x17ڼ\x87fg\xf3U\xbcmۄaH\x14Eض\r\xc4]N!*\xb1L)\b\xb9\xe4\xe2\xf3\xb8\xe9\x96߰g\xf7\xee\xda5\x9f\xdb\u007f\xcb导\xf8\x8b\xefy\xdb\x05\x87\xcd\xca}\xee\x1bw\xbeܶ\xec\x89\x17\x9d\xbb*\xfc\xf1-O0_\x8b\xd8\x00KV>\xe7ĩɩ\xed\xdf\xfd\xcc\x1b\xdf\x00p\xfa\x85W\x88cN\xba\xc0\xdc\xfc\xcb\xcd7\xfa\x91{\xf7\x11G\xf4\x9c\x96\xf0\x1cj~\x18FQ\xf4\xbao\u007f\xe4\x15?=\xeb\xd5\x1f\xbf&\x9dm\x89O\x97\xed\x90H\xa5)\xe6\v\x04\x81\x8f\x9bp\xe2|_H\xe6Ga\x88Bl)\xb0\x84!*W\x19\u07fe\x8f\xc2\xf08\xa9\x96\x06\xd2M9R\rij]ͤ\x9b\xb3\xe4\xbaZ\x90\xc9$\x91\x9a\xf7\xef\x02SO\x9f\x9f\xa9\x94\x15\x18\xb4\xd2H[ж\xb8\x9dƖ,~\xb1Ja\xaa@\xadT%\xac\x05\xe4g+\f웤\\\v\b4H\xa3\x98\x9b:\xc0\xc4mC\x1cH\xe5Htw\xd1\u007f\xd4*V-_\x8c \x8e\x19O\x8e+\xf3\x96\x11\xbb.U\a\xc5\xd0ؘ#\x91H0=3\vFp\xf1E\xcf\xe3λ\x1e\x1c\xbf\xfe\xfa[^\xf3\x9e\xfb\xbe\x94?\xf4\xbd~\xf2+\xbf\xba\xb0\xb9\xa9\xf1\xab\x96%?\n\xf0\xd4\xc2P\xe8\xbeJ\xd5_\x01p\xfe\xe5\xff\xceQǟc\xfe\xed\xedGq\xc9\x1b\xbf\xd0\xd9Ԝ\xd9\xe0&l\xa2H\x85\xe5r\xe5\xd2k\xde\U000e27df\xf5\xf2\x0f\xbc[\xab\xb0\xcb\x00&\b\x90\x8eC:\x9bcnz\x9a\b\x81\xedz1\x1b\xaab\xb9O\x14\x86\xe8j\x05G\bjv\x82G\xbc&\x94\x80\xf6@\xd12^!\x1c\xadP\xb2]\xa2\xcc\f\xb9l\x92\x92\x94,\xedi\xe4\xec\x13\x96\xd1ԘD\x98\xb8\x85\x8a\x94Hi\x0ej=\x9fl%Za\xaa>:\x8c\x90\x18\xd2\xe9\x04i\xb7\x81\x99\x19\x9b\xc1\xa92\x9b\xaa\x15F\xbc\x1c\xb3~\x99\x8a\xd6$\xa5 \x9b\x8a\xb9\xac%§2\xb0\x8b\xbd;w\xb0\xa5\xa9\x99\xe3\x9e{*\xabW\xf7\xc7\nJ\xad\x9fT\xc3H\\\xd7=\f0!\x04\xa9\x94\x87\xe3\xb4Q.WQJq\xc1\xf9g.\xee\xe9\xee\xfc\xc4X\xfeU\xdfnki\xaa\x02g._\xda\xf9\xf6\xbeE\x1d\xadB\x10\t!nxZ)\xe9;?\xfa\x83\xe3gf\xfd\x9b\xf3s\xd3םz\xca\xd1\x1f\n\x03\xc5\xf8d\xbe\xc7\xc0=\xdd\xddm\x19\xad\xf50p\xec{\xaf8s\xf2\xfc\xcb\xff\xadOH\xfb>\xcbqz\x1c7EX\xccc\x8c\xe1\x88\x15+\xd8\xf5\xe8c\xf8\xda\xe0d\xb2\xac\\\xdc\xc5\xcaeݤ\x9a\xdb\x19\x9e*\xf2\xd0\x0f\xafc\xdd\xf4\b\xa6\xb5\x95m\xa9&\x12R \x1d\x8bH\x19B\xa5\x89\x94F\xabxtM\x00\xa168\x96`\xc5\xe2\x16\x16\xb5\xe7x\xf1sW\xd1ܐ\x8cݗ|\x8a\x162\xbe\xa3VC\x97}\x84\x84 \xd4<\xb8u\x84;\x1e\x1ba4_c\xb2\xe8c\tHX\xb1\bN\x8a\x83L\xad6\x06\x8d\xc0\xb3$\x9eV\xa8r\x99ɹ<Eaq\xcci'q\xcc\xfau\xe4\x1a\xb2q\xe6d\xccB\xebW\b\x88\"\x85\xd6jA\x113OJ\xce[\x90\uf1ccO\xce\xd2\xd1\xdeB&\xedaY\xf3\xa4\xa5\xf8\x8e1\xe6u/>o\xf5a\x8b\r\xec7\xbe\xff\xa7|꽗l\xfc\xf8\xd7\xef\xbahf\xae\xf5M\xa5\xb2\u007f\xa7\xeb\xbaA[{s\xa7m[[\xb4ַ\x03_}\xef\x15gN\x02\xd8n\xf2\x15Ҳz,;\x81\xd1\x1a\x8cF\bk!\xe0I\xdb\"T1͐p\x1d\x02U\xb7\x94R\x89\x84-IY\x9a\xf3\xbb\x13lX\xd5A\xfb\xd2\x0e\U00095009\xd9\nS3e*e\x1f\"EP\xab\xb2\xbf\xa0\xd99\x1b\xb2\xe88\xb2\r\x00\x00 \x00IDATu\xdf\x14[v\x8e3W\xac\xf1\xf7\xaf:\x19L\x9c\xdd\by\xc8\x1c\x87Ҡ\r\xaa\x16\xa0\xfc\x80\x00ɏ\xee\xd9\xcdM\x9b\a\t5\xa4]IO.A\x93\r=\x8e\xa2\xd16$\xa5\xc0N\xa5\x98\xad\xf8LO\x17(\x95\xab\x14T\x82\t+\x89\xced\xc8&Ө\xe1!\xee\xfc\xfe\x8fx\xe0\xd7w\xb3b\xc3zV\xac\\Jww\x1b\x9d\x9d\xadq\xac\xd1:\xce\x04U,\x02\x8f\x85\x13z\xa1\xa7bY\xb1%\xe5r\xe98\x8d\xaf\xd7`\x91R\xd1\xccL\xfego\xba씧\xac\x01\xb1SV\x85o\xfdz\x92W?\xaf큯\xfeb\xcb橩\xc2b\xaduR\nY\xab\xf9\x95\xd9\xf7\xbf\xe5\xfc\xa9\xf9\a_t\xc5\x172\x96\xed\x9c\"\x0em\xca\x18\xb0\x1c\x8br\xb1\x10\xcb.\x8d@\xd8q{7\x91p\x99\x8b\xe2t\xd1\n}\x12\xb6\xa4?-9\xa2ӣgQ\x13\x89\xb4KS\xd2fIS\x12\xbaӨٹz\xb8\xc9R\xadE\x8c\x96B~3T\xe5\xb7\xc3\x15\x1e\xdc:¶\xbdS\xac]։\xd2\x06\xa3\x04B*\f\"\x9e1\xa9\x05\x04\x15\x1f\x11)\xf6\f\x8eq\xe3CC$\x1d\x8b3\xba2\xacnMҐtHgR\xc8:K e\xdc#\t\x03\x9f\xc2\xd4,#\xbb\x06(\xe6\xf3\xf4\xa82\x01\x92!;C\xd4چ77K87\xc9\xeeM\x9b\x18\x1a\x9d\"\x97I\x93J'\xe9\xeb\xebd\xc9\xe2.z{;q\x9dD܈Ӧ\xce\x0e\x1c\x9e\f\x1c\xda\xf8*\x16\xab<\xb0\xf1\xd1\xeb>\xfd\xa1\xcb~\xf8\xb4*\xcbk\xfe\xf1\xb2\x85\xff\\\xf1\xc2cB`7\xc0\xe2\xe5ǲ\u007f\xd7\xc3\v\xdf\x01\x84Q\x1dR\xb8\xa7ic&s\x99d\xb9T\xaa,1\xf5*\xb7Z*#\xa4@#H\xa7<\x1c\xd7&\x91\xf4\b\x8a\x06\x1d\x06dl\x87\x8c;_PH\x84kc\xea\xd3\x05F\xa9\xb8\x02nlDON\xc7\xf4M\xc2f\xa9k\xd1\xd7\xe8qbw\x8aol\x99f\xfb\xc0\x14G\xaf\xecB\x1b\x81\x11\x12\xa4@\xa8\b\xa54*\x88\xd0~\x88V\x86\xe9\xd9\nG\xb6\xa6\xb8`i\x03M\x9e\x15W֖E\xa44՚\xa2R.\x10 \xe8\xeel\"ې#ې\xa5Z\xacR\xce\xef!kB\x946\xe4t\x80e70\xe1\xbaDJ\x11\x15稕\x8b\f\x8f\x0e\xd3\xdc\xd8\xcc\xd8\xd8$\x1b7>\x8em[\xac[\xbb\x9cc\x8e]E:塔F\xcf\x0f\x93\x02\xa5Re\xe1khd\x82\xdd{\x06>\xf4\xeb\x9f|\xe0\x9f\xfeh\xf5\xfb<\b\xfbw=\xcc\x05\xaf\xfe\x047\u007f\xeb*\x84\xb4NG\x88\xe60\b\xce\xcd%\xbd\x1b\xa3  \x94\x02\xb4\xa6V\xa9\x82\x10\x04\bZ=\x8f\\.\x83\x966Z\xf9\x98(\xa2ѵH\xb8\xf1驅\x8ar5$m`z\xa6\xc4\xc4\xe0$\x03\xbbG)\x94}\x94\x05}\x1d\x8d\x1c\xb7\xa8\x91lC\x12iKֵ{\\ur\x1bƫ\xa0\xa2\xfaЎ\x10\x18-A\xc7=\v\xe5\a\xe8(\"*\x94\xe8\xf7l\x16\x1dل@`IA͏\xd8;0ʁ\xc9\"Ӿf\xb2\xecӑM\x91v$\xadM\x19\x8e\xe8\xefB&Rx-턥\"v\xe8ctĪp\x8eٌ\xc7\xf6Z\r\xa3\x15\xa5\xa9\t\x06\x86\x86ث\x14M\xcd-ttt\x91\xcb\xe5ش\xf9\t~\xbb\xf1qR)\x8fd\xcañm|?\xa4Z\xadR\xab\x05h\xad\xa9\x05~\xb5R)\xbfy\xc7\xc6/\u007f\xeb\xf2\xb7]\xc7\xe6\x8d7\xf0ă\xdf\xfd\xe3\xc7\x11\x9e\xff\xdaOr\xd3\u05ef\xe4ܿ\xfa\xc8:i9\x1f\xa9T*\xaf\xec\xeb\xed\xaa\x85a\xe0\x84~\x10\xf3=A\r\xa4\x8d@\x10\x18hmn\xa2gQ\ae\xbf^ن>\r\xae\x8d-#\f\x86\x1d;F\xf8\xe6}\xfb\xd0J1\x98\xf7\xd9=0Ɍ\x1f\x92nm\xe0\x98\x93\x8f\xe3\xbe\x1fn\xe2\x84\x16\x9b\xb7\x9e\xb6\x82\xe3\xd7vb9.\x9di\x1b\x84&\x9a\x9eF6\xb7 \xcc\xfc\b\xb5\x89-,\x88\x88\xcaU\xca\xf92\x81\x1fgwҖ\x94\x8a\x15n\xbbg\x1b7\xef\x9fA\xe4R\xec\x1a\x99cŒEܱe?Iע\x1a*^\xb0r\x8a\x13\xfaZؓ\x0fX\xddڂ\x8aBdi\x16Q.\xb3<mq`\u03a2\x12FX\xe5\x026\x10JI\xa1X\xa0P*\x92J\xa5\xc9d\xb2\xa4\xd3\x19\x9c\xa2\xb3@\xefc\xe2d!\b\x03\x820d\xae\x90\x1f\x99\x9d\x9e\xbe\x1d\xe0\xfb7o\xc6-\xefxv\xf3!7}\xfdJι\xecý\xc9l\xeb\xf5ɤ{\xdd\xfa\xe3\x8e<\xb3\x16\x88\v\x03\xdf\xc7\xcb62\xb2\u007f?\xe5R\x91Ȅ(\x04\x96\xed\xd2\xdbۍ\xe7\xda\x14\x8b!Fk\x82r\x99LX\xc58\x86J\xa9\x86e\xca\f\f\x14y`*\xa0\xa6\f\xebV\xf4\xd2\xdf\xe6\x10J\x97\xab\xff\xe1\xe5\x9cv\xc3M\xf8G\x9d\xc4\xfb\xef\xda\xcb\x1b\xc6\r/9\xb7\x17\xdcD,\x9c+\x97\x91\x8dMuF6\xaeOt\xa41*\xc2ϗ\xf0\xeb`\b!\x98\x9d.\xf0\xd5\x1b\x1f\xe2\xfe\x12\xbc\xeem\x97s\xeaqKx٥\u007fK\xe7\U00093c72Y\x9eغ\x87b\xa9\xca\x0f\xb7\x0e\xf3íü\xe3\xa4~\x1c\xa1\x90\xb6D\xaa\x80\x16\x110g\xa7hJ8\x94\xc3\b\xcbh\x1cKb\x9c\x04\x96\x1d_\xbaPkf\nyfKź\xb2\xf6pe\x8b1\xa6\x8c\x11i\x839\"\x95\xc9|\x81\xf4\xf9\u007f]\xd9\xf3\xc9Y\xbb\xf7b\xe0\xa1?N\x97\xb5\xf6ԗ[ǟ\xf3\xe6cR\xd9\xe67.[\xb1\xe2\xc7\xfd\x8b:\xde=\xb2{\xf7\xa9s\xb3s\xb9T\xae\x01\xd7\xf3\xd0*\xa20=\x03\x18\x02a\xb1|\xcdj\x8e\\ڎ%\x04Ӆ*5? ?\xb4\x9f\x86};bَ6$\x92.\xad\t\x8b\xf1J\x84\x8d榏\xff5\xd3Z2:\x13\xf1\xc9\xcf|\x8fd:êeKYڑଓ\x96S\x1c\x18\xa7\xb9\xbb\x19cY\b)\x90\xe9\f¶\x11B\xa2\x85\x88S\xe7\xa1aJ\x85\x1aQ\x9d]\x8f\xfc\x80_\xdc\xf9\x04\xa7\xbe\xf8|\x8e>j9\x8f\x0f\xcd\xf0\x9f_\xbe\x0e\xa54+֬\xe6\xc4\xe3Wq\xd5\xdb/\xe1\xd1\xc7\xf6166\xc3YGtp\\w\x03\xadI+\x16hT\xf2\xb8(\\\t3\xcaa\xb4\xe2#\x89\x15\xf0e\xcd\xc1\xa1\x9fz\xa7\x920\xc0Hy(\xfdR6*zYe覿\x0e\v;?\xe0䎸\x03!\xcfB\xc8\xffprˆ*C7<\xfcG[\x88\x8aT\xa6\xb9\xb9\xc1\xeb]\xb2\xac۱\xad+gƆ\xa6\a\xf7\x0f\xfdc\x10F\xeb\xc7\x06\x0f\xbc\xa8k\xf1R\x92\x99,BJ\xb4\nɴv\xb0\xa4\xaf\x8b\xacg\x93\xafE\xd4\xfc\x00\xbfZ\xc5\x1f\xd8G\x83\xeb \r\xa4S\x0eK\xbb\x1aY\xbc\xd8B$'\xf9́\x02\xd7\xfe\xf8\x0ez\x8f\\ͯ\xa6\x1e\xe7\xc8e\x8bql\x8b\xad\x9b7s\xd1ɫ)\xb8\x92J٧\x0f+\xd6\xf7*\x8d\xf1+\bϋ\x17\xd1H\x89\x15ԈJ%\"\x15\xd70\xb6e\xb1o\xd7\x01J\xe5*G\xb8\x11K\x1dɖ}\xfb8i\xc3\x06JҦ\x12\nFǦ\xb9\xe9\x97\x05\xfa\x936/>e9'/m\aB\xd05,G\x13H\x83\xb0,<\xadII\x8b\x84e\x11(M\xab\x97\xa0X\xacPSV<\xd5\x05\xa80 \xf2}\x1c\xc7=T\xba\x926\x88\xf7%\xbbϽ\xbf:\xf2ˉ\xca\xd0\xcdw\x01\xe7\xa6z\xce\xebAڗ\xfeQ\xaa\x93W\xbd\xe3s<\xfa\xc0M\xbc\xe9=_\xcd64\xb5\xfc@H\xf9<S+\xeb\xca\xec\xc4Y\xdf\xfa\xd2ۿ\xbb\xf2\x98sF\xb56\xaf\x8d\x82\x80\x86\xd6V\xf2\x93\xe3\xf8¡{\xe9R\x8eZ\xd6\tڐ\xafFD\xc2bfd\x98\xe8\xb7\xf7\xb0$\xeb\x91\xf1,\x96\xf76\xd2\xd4ӎ\xd7\xda\xc4\xe2\xc6\x04Gw\xa6)\xe7+T\xf7\x8f\xd2\x1fE\xacq4\xabeȺ\xb4Ew\x14┫446Ѿz)\x96k!,\v\xe1:\x90L\xd73?\x10\x859*\x93\xb3D\x1a\x12\xb6\xa0)k\x93V6\x1b\x96,\xa2S@c\x10p|S\x8aֹIZg'i\x9c\x1a\xa7\xb9\\a\xad\xf2y\xc9\xca\x1eN\xec\xef\xa61\x9b\"\xe5\nt\xe4cT\x84\xf6\xabH!\x88\x84dWQ3\xedGx\xb6\x85\xaf5)K\x92\xaf\xf9\xf1\xa0O\x14\x92\x12\x06_\x1b,7\xf1d\xb1C\xb7\x10\xa2',\xec\xfaI\xb2\xe7\x1c\xbc\x96\xe3(\x0f^_\f\v\xbb\x1eHv\x9d-\xa2Ҿ?\xccB\xbe}M\xdcH\xd1F\xdf,\x85u\xb4@\xa3\xab\x85\xab?\xfd\xb1\xd7\xfe\x16\xc0(\x93\x12B`;\x0eRH\"\r\x81\x93bIG\x03\xe9\x84Þ}ä[;1a\x95\xedw\xff\x86\r\t\vǒt5\xa5\x10\x89$\xba\xb1\x01;\x9bA\xa4St6Uh\xeb\x8e\bÈ0P\xf1\xa9\xb7$N\xda#\xdd\xd4H\xaa\xb5\x85tk+R*\x88\x02P\x1a\xe9J\f\xba>\x91\x17\xf3]a\xa4Hؒ\xa6\xb4E\xdaJѶ\xa2\x191\u007f\xde\x04t\x188\xa2\u007fQ\\\xcci\x13\xf7Z\xea\xbd\x10\x88\xd7?\xcd\xccj\x82\xa8\x8a\x8aB\x02\xdb!\x8cj\bm\bUDBH\x9a]\vO\xc2h\xd5ПM\x12\x18\xf0\x84`O\xa1\xb4@\x17=\x95d\x93\xafLv\x9f\xf5\x8e\xea\xf0\xaf&\x93=\xe7\xe1u\x9eIm\xec7TGo3^\xc7i\xd4\xc6\xef\xfe\xc3\\\xd6\x1b\xae\xbe\xeet!\xe4\x06\x00\xa9\x15\u0096\x0f\x1e\xd2\xca}\x9e\x00\xbct\x9a\xa9\x91!\x02\r\xeb\xd7\xf5\xb3\xaa\xbf\x8bZ\x10\x92lh\xa2\x16\x84\f=\xb1\x95\xfc\xf0 \x8d\x9d\rx\x9e\x85\xe3\xd8X\xc9\x04\xb9\x8eVhi\x8e\xbbwU\x1f\xed\a\xe80\xac/\x9b\x91H[b\xbb\t\x12\x99\fN:\x85LH\b\xc1`#\x1cQ_\x16\x13?\xf6P\x0eȵc\x85zdB\x1c\xe9\x81\x04c\x89\xfab\x19\x10\x96\x8d-\xc1\xd8\x02#\xeb\x1b\xe6\"\x83P\x06,H\x9b4\xe5\xe2,D\xe1\xc1V\xaf\x8eP*\"e\t$\xe0G\x8a\x81j@\xa3%\x89\xb4f\xd0\x0fѶ\x83\xe5:O\x0f\b \xac\xd4v\xaf\xfb\x9c\x15\xd5\xe1[\xa7\x0f\xbd\xff\xc9`\xfcN@\x1c7ѵ\x900X6\x8em}\xf6\xef\xde{\xed\x17v\xec\xdc\x1f\x1a\xa5^c\x10䧧\t\x83\x80\x13NX\xc7\xd1G\xaf$\x88\x14\xa1\x16DF03>\xce\x13w\xdfE\xc2\x18\x12R\xe0X\x12\xdbsH6dI\xd4\xfb\xe7:\x9d\xc5\xcaf\xd0u\xa1\xd9\xfc\x9a\xa4\xf9\x01O9\xdf\x042\x80m#\xac\xd8\"\x8c\xf2\x11Jal'f\xe7\xa3(\x9e\xe1\x10\x00\ne\x1b|+\xc0N\xa5\xc1s\xa1>\xd3!#\xb5pь\x8cG\xe7\xe6\x17\x10\x10id$\xd1a\r\x1dF\x18\x15\xd7;R+0\x8a\x9ce\x81\x88\xa5\xa8E?\xa4dYH!\x11^*\x0e\xea\xbf{\x10\xb5YZ\xceۀ\x0f$\xdaNƟ\xbc\xff\x8f\x1f\x8b\xae\x96\xf3\x93\xb9\xc66,;\x81\xd2`{\xd95MM\xea\xd3G\xad[\xae\xf7\xee\x1dt\x05\xd0\xda\xdeª\xb5+ijib\xb2\x18\xd0\xe4Y\x04\x91\xa6\\\xa9\xb1\xed\xfe\xfb\x98\x1d\x1f\xa3ݖH!H&\x1c\x1c/\x81ט\x8e\x83\xa11X2\x1e\t8t\xdbۓ\x1bBO\x16\x1f\b\x1dB\xe4\x83\xed\x80\x13{\x1ca4Ү\xeb[\x8c@\xa3\xd0\x16h\x87آdL\xe8i\f\xe2\x10\x91\x83\xb0\x0e\xdf2\xa7\x85\xa9\xf7\xcbE}ㄡ\x16i\\\x01\x9e\xad))IM\x1b\x90\x92d2\x9e\xe4U\x1c\xba\a\xad\xee\xfd\x84\x10\x96\x94\xbb\xc3(J\t!\xba\xeb\xbf\xed\x04\xe0w\x82\xf1\xbb\xb3,m6\x96\x8b\xb3\xe4\x1a;\x10\x02B\x12\x98d\xabݔS\x9c\xbe\xa4\x8fd2\x89\x9dHP\xf5\x15\xe3%\x8d\x14\x06K\b\x8a\x95\x1a\xb3S\x93\xcc=\xb1\x85\xcc<\x18\x8e\x85\x97\xb0\xb0]\x87D*Y'\xe2̳\x98\xc9\xd6\b\xa3\xea'[\x1dr\x05$\x8e\x14D\x91\xc6 \x11J\xa1\x8cB\xaa\b[\xc7\x1b \x8c\x88\xe9{\x9eiՆ0\xa8(@\b\x851z\xa1o\x1ch\x83\x14\x02W\x80%\fA=\x918\xac\xb7|x\x93F\x80y}a\xe0\xe7_K\xf5>\u007f\x05\x88ہ\x1e\x109\xaf\xfdT\xaf6qo폞S\u007fק~\xc3\xd7?qE1\xf4\xab\xffbL\xa8;\x9aS,[\x94\xa5\xa7-\x85\x9bL\x93\x0flF\xf2!\x03\xe3%\xc6\xe7jT\xaa>\x0e\xb1گ\x16*vo\xdbIw\xd2A\xd6)j\xd7\x16u)\xa7Į;tK\xccw\x04\xff\xf0\x9d\x13\xb1G\x8au`\v\xc72\x9e\xe6\xc4s$&R\xf5=\x05\x06\x82\x00\xa3T\xbcR6\x8a\xe2C \x04Ʋ\x9e\xdaO1\x10\xd5|\xa2\xc0\x8f\x9f\x13\x06\x88\xbah\xae\x12i\x04\x06W\xc6=\x91R\x10\x1e&%z\x9a\x95\xb5?-\x1f\xb8\xe1k\xa9\xbe\x17\x8a\xca\xe0M;\x81\xef\xd4\u007fIV\xd8\xe9\xc6g\xb58\xa0\xbb!~\xc3ل\xff\x8dE͞4a\x85\xed\xdb\xf7\xb2g\xcf~\xaa\xc5\x19\x1aӒ|\xa5\u0081\xe1\x116?\xbe\x9d\xe9\xa9Yr\x9eE9P\xccLNSٻ\x9d\xa4-q\xea\xaeUη?-\v\xa3T|\xe1\xb4~\xcai=\xb4\xa7\xfd\x14\x9f,\f\xe8\xa8~z\xe7\x03n\xfdt\xba\t,\xdb\xc26\x1a\x15\xe9\xd89\xa9\b]\a\xc5h]\a2\x1e\xd6Զ}؊\r\x15\x04\x84\xd5\n:\b\x88j\x01&\x8a\xe2\x83c\x14\xf9@\xe3I\x83\x85A\bC!\x88PQH\x14\xc6,\x84\xd6\xfa\xc9M\xcc\xef\xa4\x16]t0 \xc2\xd7\x0e\x96\x18\xe6\xf72#O\xfb\x80\xbf{\xdd\x19|\xfc\x8b\xbf\xecjji\xd91863\xbe}\xf7\x81W\xf8ŉ\x8d\xberV\xf4tw~\xab9_Y;8:\x85\x97n\xc2\x02V\xf45cY\x92\xa9|\x99\x1d\x0f\xdcG\x83\xf1ɩ0\xa6\xe3\x05\v\xc3\xf8\xd2\x12hc\x88\xaaUl\xcbB8\x01\xd4'\xa1\x0e\x03`~5\xd2<}-\xea?\xd7\xf5\xa0,\x04\xa8(N\f\x8c\x067\x810\xe0:\xf5\xb6\xab\xe3\"\x85B\x85\x012J`\xd5+\xe8\xf9Ξ\x91r\xc1Jt\x10\x12VJ\xa80@U\x03\xa20\xfe\x1d\x8e#\t|\xc3pM\xe3\xd4)\x1a\xcb(f\xeb\x8b4\xfdJ%\xbe\x80\xc9\xe4\xa1\x167\x04\xec\xa8\f\x1dl\x04V\x06o؞꽨~\x8a\x84zֻN:\xba\xbbV\x04AD!\x94\x9f\xfd\xfeg^\xff\x9b\xfa\xdd\x0f_\xfa\xe6\xcf~*\xe59\xbd\x85By.\f\xcc\xfbO:nuc[C\x8a\xf1\xbc\xcf\xfd\xf7?\xc0\xce={yA* \xab\x03<\f\x91\xb0\xb0\xe4A\xa5\xb9\x0e#T\x10b)\x05A\x00\xa9\xd43\xb4d\x0f\xb6j\x85\x04\xc20\x16H\xa8\x10i;\xe0e\xb0\\\x17\xc7v\xf0\v%Heq\x82*\"_\x03/\x96\x10\x19\x15ĠXqAi\xc20\xa6\\\x8c\x89\xad4\f\t\xabUT\x18b\x82\x00\x13\x04\xa8(\x02\x13\x8f\xacEJ1\xe6\x1bVd$)\x11\xb1\xaff\xf0\x83\xf8  \x04n\"\x81t\x13\x87\x8a\xac\x87\x80\xd1\xf9\xff\xa4z/\xa22x\x03\xc0\x83\x80\x12\xe1\xcc\xe4\xb3rY\x00\xa3\xe3\xb39\xc7u\x18\x9f\x98\x9a\x02\xf8ȗ\ueb4f\xb3Y\x8fNL\x8c_s\xeb7\xdf\xfe\xe9\x93NX\xf3\xc4\xd2\xdeV\xfcаm\xef0\x8fl\xda\xc4D\xa1Ĭpq\x8d\xa2A\x1a\"!\x16\\W\xbc\xcb$v\x1fB)t\x18-\xb8\x92g\x06e\xfe\xb9\x1a\x1dT\xe3M\xa2\xa9,\"\x91\xc4r\x13\b\xc7A(\x8dlmG:6vʆ\xc9مݼ*\xf4\t\xfc*:\x8a@kL\x10\xa0+5T\xa5JX\xad\xa1\xa3\x80\xa8RA\x97˘(@\xeb\xfa肄\xa1r\x88#\f\xaeФm\xcd\xddS\x01\bp\\\x97T6\x8b\xb4\x9d\xc3\x14\xef\xc0xe\xf0\x86\xa9C\xacc^=\xb3\r\xd8]\x99\xdc\x14-\xbf\xe4\x9eg\a\xc8\r\xb7\xfdv\u007f\xb1\x12pܲ\xaeK\xed%\u007f\xe5]\xfd\xe6S\x01\xb8\xee\xb3o\xdd\xf4\xbd/|\xa3\xf4\xf9\xeb6\xff\xb4\xb7\xa3\xf1\x14\x83\xe0\xa1=c\f\x8eO1;5\x89\x8a\x14[jq\x8a\xdaj\x19j\xd2\xc2Iz\xb1\xee\x89x\x03\xb5\xa9\x8b\x06P\n\x13\x06O\x0fƓ4\xd0&\f\xb0\x8c\xc6\x12\"\x1eQN\xb8\a\x15\xeb\x8eM\xb0}\x0f\xa5{\x1e\x83\x899d\xbe\b\xd3s\xf5x\xa4\xd0*\"\b\xaa\x84~\x8d\xb0Z!\xacU\x89B\x1f\xa5\x02\xc2Z\x15]\xadb*\x15\x82J\x05\x15\x04ؖ\x04\xa5\xb8o\xb2Ʋ\xb4&gE\xdc1g\x18,\xfa\xf1\xba_\xad\xf1k5\xa2\xa7&%#\xcf\xf0\x01\xf6c\xccM\x00\a\xee\xb9\xea\x8fwY\x17\\\xf1En\xfe\xea[\x1e=\xf1\xe4;\xdfsDO\xf3\xbf|\xe6\xc3\xefxb\xf7\xc0\xcb\u07fe\xf5\xd1\xdf\xeeX\xb5z\xf5\xab\x8fZ\xb7\xee\xaaL:酑ҿ\xdd9\xc4\xd8\\EZ\u00a0\xa2\x88T\xdac\u007f\xbe\xcc@\x8bG\x93\xac\xe0G\x9aRC3ݔ@\x9b\x85\x80\xae\xb5Fj\x8d\xa9\xf8\x88\x84\xf7\x94=V\x87P\x9c\xb1(@ژ\xa9I\xcc\\1\xb6\xb2\x96fĢ^\xb0\x13\x94~y\x17\xe5\xfb6Ƣ\x85\x899\x92}m\x18[`7\xe5Ж\x04\x1d-LJ\x89\xbajD\x19\x85R\x01F\xab\xf8`(\x8d\xef\ȧ,\xa5\x14\x0f\xe7\x03\x8eo\xf1\x98\v\xe1֑\x1aR\x80\x9bLa\xb9\x89C\xf72\x1e:\xcf6\xfdL\xb3\xc0ڟ\xbe1\xaeC\x1e\xf8\xe3\x01\xb9\xf9\xabo\xe1\x15\xaf\xfb[\xbe\xf9\xc5O|\xfao\xde\xfd\xa1b6\xe1}`ժ\xfe\xebW,\xeb\xa51\x97E\b\x83_\xab\xce\x1c\x18\xdc\xffŁq\xff9\t\xd7;c|h\b\xc7q\x91\xd2\x02c\xb8#\xafX\xeb\xb9\xe4\xab\x01\xd3n\x06;*a\x94BEq\x15l\xc20\xcex\xa2\x00Q\xad@2y\x18\x15r\xf0\xa3\xc4\xea\xc08]\x95\x8c\xdf\xf30s\xdb\xf6\xd2{\xc1sH\xe7\v\x14\xb7줺c\x80\xe1\x1d\xfb\x10\x96\xa4\xa3\xbf\x1b\x19U\xb0B\a\x17\x8d2\x02\xadL\x9cy\x89\x98\xff\x12&\xc2h\x85\xa9\xf7Q\x8c\x0e\xd1\xf5\xd17ۑ\x98H3X\xac\xb1\xa3\xa4\xf9恀ݥ\x88bhp\x93I\xac\x84\xf7d\xc5\xcb,q\x89\x9a^(\xfd\x9f\xfc\x19t4Z\x9b|\xb0\x92\xecz.\xd5\xd1;\xfex\x97ճ\xfc\x14\xbe\xf7\xb5\xcf\xd2\xd2\xd2\xea\xbf\xff\xb5\xc7|~\xbaX{\xdex1|\xaf\x97L\xfe\xb8V\xadl\x99\x9d\x99\xfc\xc2\xd4\xc4\xe8E\xef{\xfb\xc5\xefK&\xd3SB\bj\xb5\x1a\x96m\xa3\x94B:.SZr\xeb\\Ę\x1fp\xa0\xaa\x88\x84\x85\x9e\xb7\x8eP\xa1Cu0\xb8\x06\x01\x84\xe13\xaf\xc60\x02\x1c\x1b\x99\xc9кa\rK_~\xfe\x82\xee\xd7\xd4j\bGв\xac\x87\xe5g\xad'\xbd\xa4\x1d\xe18\xc8\\\x0ecۘ\xfaȁ\x98\xa7`\x8c\x8a\xc1\xd0:\x06\"\bQ~\x88Fc92\xce\xfe\xb4\xe6щ\x12UmxpF1\x1bI,\xc7AZO{~\xbf\x0f썑&\x05ൟ\xf2\xa4z6\xba\x19\xf8\xbd`<\xa3\x85\fﺏ\r翃M\xb7\\\xa3\x01>\xfa\xa6\x13\xb7\\\xf5\xe9\xbb\x1emJڎ\x8c*\xd6\xc8\xfe]\xc1\x87\xaf~mtهn^\xa1\x94:NG\x11\xe3\x83\a\xf0\xbcx\x85\x9eR\x8aD\xc2C\x8aX|\xb0ut\x92ʲ\f\xb9\xbau\xa80\x8c\v*\xa5@JL\x18!\xa3\x10#$\"\u07b4\xb3\x90e\x19\x15\xbb\x14,\x01\xb9$\x89U}\xe8j@2\x880\xa5\"\xe9\x15]X\x19\x17\xa7PBeS\x90Ib\xe7\xd2X\rY\xd4\xc2\xfc\xc7!1\xaa^\xc8\x19\xa50a\x84\xf1}\xa8/\xd84\xf5M\xdaa\xcd\xe7\xfe\x99\x00\tX\t\a\xa34\x96\xe3\x1c\x1c\xfe9<\x90o\x06&\x81\xa3A\xb6\x03\xd4&\xee;\x9c\x86\x1a\xbd}\xf8\x0f\xad\u007f\xed\xa7㏄\x10l\xba\xe5\x9a\xc3\xee\xff\xc4ߝ\xae\x81y\x1d\x91\xb8\xf8\xcao4i\xadޯU\xb4t\xdbC\x9b\b\xaa5ҙ,\x91\x8e)\x88Zq$\x9e\x15\xb4<\x1e۵\x95\xa1e\xa7\xd1\x1aUp\xc2\b]w]Z)\xa4\xe3\xc4><\x88\xabc\xa3\x1d\x84\xe3\xd6E\f\x11R\x87\x18\x01\"Rh4\xdaI\x82\x93\x04\xa5q\x12\x16\x18M\xeaȥ\x04\xc5*Q\xb1\x06V\xfd\xef\x86D\n-\xed\xc3姪\xaevױ\x12F\xfb~\xcc2S\x17Jh\x85ц\xd9b\x95ݥ\xb0\xae\xff\x92\bi\xc5\x1dº;~\xd2-\v|\x05\xf8'0\x8b\x93]ge\xaa\xa3\xb7?\xeb\xf5\u007f\xf2\xe9\\Ć\x8b\xde\xda\x00p\xc9?\x1c\xfe\x17\x1c\xfe\xe6+\xf18\xdcK\xde\xfd\xbdl*\xd7\xf2i\xa3\xf5_\xed\xdd\xfa\x18\xa3\x03\x03\xa4\xb2Y\xbcT\x8ad2\x9e\x1dDG`\xe2U}\x85Z\xc4M\x8f>J9P\x98\xbae\xe8 \x8c\xd3^\xad\x17\x82\xbd\x8e\"\x84\x8a \xf4!\xa8\"\xb4\x1f\xd7S:\xc2訾\x1b\xdeԋ`\x83\xb0mtW\v\x91c!<\x17S\xff4\xd2\x18\xb0\xad\x85\xcc.\x06:V\x18\x9az-\xa3k>\xa6\xe6\x83\xd2h\xa5ё\x02ePa\xc4\xe8l\x85\xb9\xb0>\x9a\xa0t<z'\xad\xa7\x9d\xb62ƜX\x19\xbca\bx\x1f\x883\x84\x95<\x1f \xd9}\xf6\x9fo\x93ò\r\x17\\\xb6\xe6\x8cW4\\\xff\xa9\xd7\x0f\x1cz\xff\xc6\xeb?\xc7+\xde\u007f\xfd\"\xc7\xf5~d\xb4y\xe1\xfe\xed\xdb\x19ڽ\a\xc7q\x11ƌv\xf5\xf5=\x18E\xd1\x12\xbfV\x13\x91_B\xeb\xb0N\xfeٔT\xc8q\rIZsIl;\x9e\x98\xb2\x9cx\xc7{\xfc\x97\n\xea\x1c\x93%댬\x8eYZ\xa3\x0eR%b\x81cD\xd4圊\xa8N\x89\bL5\x88\xa9\xf4\x85\x93,\x0e\xfd\xe3Gu7\x18\xab\xefM\x10\xf7`L\x14[k\x14\x84DAD\xe0\x87<\xbcw\x92;\xf3\x115S_~&\xc53\x02⺉ކ\xcec\xbf\x15\xf8\xd5;\x80\xad\b\x8e\xb33\x8b\xf7UGn\x9b}6\x80<m\f\xb9\xeb;\x1f\xfc\xf6\x8b\xae\xfa\xd6Ɨ\xbc\xfb\xbbӵr\xfe\x93\x96\xed\x0e\xa6\x1a\xda\xe6@\xbcDH\xf1ρ\xef\xe76\xdf~;A\xadV״\x9a\xfb\x06\x1f\xfaꩧ\xbd\xe0\x17o\u07b6i\xe3\xf3b\t\x8e\x8bP5\x8c\xae!\x9d$C\xf9*\xdbI\xb1\xb4P%\x91\xf4\xea\x16R\xb7\nێ\x97\xf0;\x16(\x13\xbb\xa8z\xfc\x10\x87\xfd)\x9dyP\xe6{\x1a\x16\x16\x16\xc2XD\t\x01\xad\x82(_Ƅz\xc1U\xd5\xc7\xd1\xeb\x8a<0\xb5X\x8cm\xea֩\"\x85\nb\xf6@)E\xa5XaW!\xa4d\xea\xbd\x17tL\xfb?CZ\x1e\x86A2\f\x83\x86\xca\xd0M#\xc0w\xeb_$\xbbϦ:r۟\xee\xb2N|\xd1;\x00\x82\x03\x8f\xdf\xfd:\xbfZjN\xa4r_I\xa4\x1bn5F\xffV\xeb\xe8\xed\x93CCf\x1e\f!D\xc1\x18\xfd\xfd\xc2ض\xd3\xea\xfe.w\x90\xeep8(M\fQ\n\xee\xa9\x1a\xf2!\x04~\x80\xd2\x11Q\xad\x86\xfe\xdf͝i\x90\x9dWy\xe7\u007f\xe7\xbc\xdbݺo\xafRK\xea\x96Բd˖el#\xdb\xd8\xc26\x04H\xe2\x90\x05\x9c\x8a53&3̄0\x84\x18\x18\x18\xaaR\xe0\x1a\x1c%SL\xf8\x90\x18\x92ʐ\"P\x93J%\x94E\x12L\x12p\x12`2\xd4`ad\x18Kv[\xfb\xd6-\xf5\xde}\xfbv\xdf\xe5\xbd\xefr\x96\xf9p\xaeZV\x15\x9b\x13\xe3\xe4\xfd\xd4*\xf5\xa7\xf7\xe9s\xde\xe7\xf9oO\x96\xaf\x03\x8d\xae\x00\xee\x05\xdak\xfcS\xee\xe5J\xdf\xc3\xe6\x8a\xf6\xdc\"\xabS\xd3$\xabM\xf2X\xb3:[cy\xe2<\xcd\xe9e\xac\uebba\xf0\x02w\xd5\xf8\x1e\x8eݰ\xa0\x14:M\xdd\xc7\xdc\xdan!Ժ0\xda䚩\xe9\x1a\xe73\xfb\x920\x00\u181a+^\x98k;\xdb\x16\xd6<\x18_\xfe\xf2\xc9\xe2\xe8\x03\xd7~\xc8\xff\t\xc5\xf8\x9e'\xe4ȗ>\xc9\xeb\x0f|\x94\xa7\x0f}\xfc\xff=\xff\xd5?\xb9c\xdf\xcf\xfd\xfa\x16/\f\xb5\x10Qyqva\xa8\xdd\xcc\x1e\x10\xd2\xdf!\x848\x87\xb5_\xab\xfb}ߌg\x0f\xdb_\xfa\xc8_yRȍZ]a\xe1\xbaN\a\xe1at\x8a'}&N\x9d\xe5\xe4k\xde\xceP\xed$A!Bu\x12\xfcb\xd1y\x0f\xa3\b\xd3I\x1c\xee\xd4\xe5\xbbE\x977\x17\x81\x8f\x14\x92\x85\xe7&X\xf8\xce1\x9a\x933\xe8,\xa5<4\x88\xf4%\x8d\xe9yT\x9a\x81\xef\xd1;\xb6\x99M\xb7\xefe`\xd7\xf6u\xb8\xc48\xb7\x11&M\xb0]\xaa\xd8\xe6\n\x9d+\x8cV\xae\xe33\x86\xb5\xd5&\x97\x97\xdb\\\x10\x05 ^?b⥄\xd65\xdft{4\x9e~\xea+\x00\x9d\xe9\xbf\xe3\x95x\xbe\xe7\x95\xf5\xf4\xa1\x8f\xf3\xba\xb7\u007f\x88o?\xf9{\xf9\x91\xbf\xfe\xe4$\x80W\xba.\xe8\x1d\xb9\xf1|\xa12\xf8])\x00c\xf2\xe9c\x9f\xd3\x00?\xf3\xeb\xff\v)e\xafV\xea\xf5y\x96\x01\x16Ϗ\xba\xdf\x00\t\xd6\x01v\xf5\x95\x84s\x1d\xc1he3{:udPE\xa5)\u0097n{\x8e\x14\x98f\v\xd9\xd7\vR`\x84!\xf0}\xd6\xceMq\xfeo\xbfN<\xbf\x80\xcar.\xd6\xea\xd4[-\xc4\xe4\x14\xb9ִ\xb3\x9c\xc8\xf3\xd9\xd2\xd7\xc7\x18s\xb4\x16\x96\x18\xbe\xb0\x83]?y?J\x1b\xd0\x19:˰y\x97#\xe9\x02\x9cF)we\xe5nR\xbf4\xb9D\xdd/\xb0\xa0%\xa2\xbb\x11Nt\xbd\x87\xd2\xf3\x9c9\xa9\xcbrv\xbd/\x17\xdd\xf5\xf4\x16:\xb3_\xfb\xf1\x15\x04\xe0\xdbO\xfe\xde\xfa\xcf\xd5\xcdw\xb16{$\xaf_8\xcf\xc6\xdd\x0f\xe6s/|\xf1Zu\xe3\x1f\xfeG\x0e<\xfad\u007f\x9atnSy\x8e\xb56E\x88H\b\x1fPX+\xd6U\xe13\xabmN\x97%\x1b\x82\x80\x11\x95\xa3\xb2\f\xe1yx\x81\x8f_\x88\x1c\x9cގ\x11\x95\x10\u007fu\x8d\xcf\u007f\xee\xf3\x98\xf9\x1a\xedv\xc2Z\x9a\xa1\x8c\xa5\xe0\x87\xdc\xf8\xc0[)\f\x0e \xbd\x10\x1fI-\xebp\xf4\x89C\x9ch\xcer϶1\xd4\xe9I\x9ey\xf6y\xc6_\xbb\x9b;\xf7݂N2\xc8\\:\x9d\xcest\ue291'\x99\xb3x\xd7[4\xd6bf\xcb\x1bQ\xb6\xb1.\x84\x13\x9ewM\x12\xf6\xb5s\x8dx\r :\xb3_\xb3\xbcBϏ\x145\xbe6{\xe4*\x9cy\xea\xdab<\xf4\xd1/\xf2\x85\x8f?\x88\xe7\xfbA}iIei\x12\b!\x05\xc8sB\xfa;mw'\x94;ꂅ\xe5e.\x1d?\xcf\xc4]\xb7SMc\x8a\xd2]S\xaa\xe3ᅮ\xfb\xb2y\x06\x1dKZ\fy\xf0?\xbf\x83\xc8\n\xc4\xf1\vX\x03\xba\xb7\x82\xb2\x90\xae6\xb0\xe5\"y\xabM\x1e\xc7\xdc\xd2W\xe6\x17\xfeۇ\xf0\xdbmXm\x90\xa5)i9 +\x05d\xad\xb8\x8b\xa1\xd9u\x82\xcc(\x8d\xca\xf2\xae\xd0M17['\xb5\x96\x9aW\xc0\xda5\x17)\x9b\xe7\xdd\xee\xef*b%\x84\xa0T.\x13\xb7[\x18c\xa2h\xd3\x1b\xab\xe9\xdc\xffY}U\v\xf2\xc3\xc9Ul\x9e\xa6\xefY\xabՊ*\xcf\x11B\x1c2\xd6j\xe9\x85;\xb5Nֻ#!}\x92\x95\x1a\x8d\x95:\xa7\x8f\x9f\xa6\xef\xb6\xddܑ6Q\xd2)LDۣP)\xb9\x8fz\xa6@\xbbY#\x17\x96\xbc/\xa4\x92\t\xfcrDp\xf3n\x8a\xbd\x15\xfc\r\xfd\xa8\x85\x15L\xa6\xa0\x93 \xa6\xa6QK\xf3\x9c\xb94\x8d\xac\x16\xe9\xe9\xad\"\xb5Fh\xe30\xb4n\xa7\xa5s\xd7\xe6^\x99Η\x97֘\xac\xb5iX\xc1\x9a\x01\xab\x14^\xa1\x84\xca\xd2u\x1b\xf7\x95\x8a\xf8~@oO\x95R\xb1Dme\xf9:?\xe8{s\n\u007f\x19\r\xddJ\xba|\xec\x9f\xfd2\u007fh*\xe9m?\xf1>\xe6/>\xbb\xfe\xef[\u007f\xfa\xbf2\u007f\xee\x99kN\xc7C\x1f\xfd\xe2{\xd3N\xe7\xb7\xcf\x1f\u007f\x11kmf\xad\xbd\xdf\x18\xfb>k\xd46\x93' \x8cc\xfbd\xc8XO/{:\x8b\xa4\xabu:2Dn\x18a\x84ĉ\x13\x84#\xdb=\xdfGvC\xfcm\xe0\xf4\xbc\xda\x1a\x92\xb8\x81_o\x10\x9c\x9f\xc2\xce\xcc#Zm\xf4\xec\x12\xde\xcc<\xe2\xb9cd\x97g\xf8\xbfG\x8e#*\x11\xc5\xde\"\xbe'0J\xb9\x84\xd3+\xc8I\x9e\xa3:\x19*W\x18mh\xad\xb68~q\x89\xc5N\x8e\xb2\x96c~\x1fKKK\xf8Q\xa1Kr94\xe1\xca\xf6\xd0JO/\x85B\x11\xcf\xf7\x96\xa20|\xb6\xd3i\xc7a\xef\x8ec\xf1\xdc\xd3\xe9\xabrB\x8e\xfe\xe3\x1fp\xeb\x1b\xdf{\x93\xf0+oR\x82\v\x0f\xbd\xff\u05fez\xec\xef\u007f7\aPYk\xf0\xc0\xa3O\xfed\xda\xe9|\xeaҙ\xd3\xe4ij\xa5罻\xbd6\xd5\t˛o\xb7Fc\xadrĔш(\xa0\xa2s\xca(\xcaB2\xfd\xe2\x04\x9e\xef\xd3w\xc3\bc2E{\x12\xd5-\x88\x10.\xda\x02\xe9\x02łj\x1f\x9d\xa4\xc3\\{\x19ۈ)\xad\xae!/L\xb9\xbfxc\x98_\xaa39\xb3Lu\xb8\x97\x9e\x81\n~7}\xe1\n?o\xbbá\x8a\xdd\x1cb\x8c\xa1\xbd\x16s\xe1R\x8dZ#\xc1\n\x81B\xb0\x96k\xb0\x06\x95\xc6Ƞ\x80\xd7]4Cד\xee\"\xcb\xd5_\x9c}\xe6\xd3\x0f\xbd\xf4=\xbdj'\x04`~\xf2;K\x1b\xb6\xdf\xfdp\x1c\xa7\x9fZY\xa8\xed\xb8\xebg\u007fu|\xcf}\a\xde\xden\xb4\x1e]\x98\x99y\xef䩓\xfej\xad\x86\xf4\xbc\xdf\xf1\x83\xdeOA\xba\x1d\x11}X\xe71Fu\xaeV\xbf\xd8\xcfn\x99\xb3UŔ\xd0\xf4H\xcb\xdcb\x8d\f\x8f\xcd\x03\x15\x02q\xd5\x02&\x83\xc0\xa5%\x04.\x8a\xc3\n\x81,\x96\xf0KE\x92v\x93\x85\xe55f\x16j,\xac\xac1\xbf\xda$Q\x9a\xe1\xd1A\xaa\x03=\x84aH\x10\xfaW'\xebn\b\x80\xead\xe84G+C\xdc\xecpqj\x91\x99Z\v)=\x12kId\xc0w\xf2\x80\xb4\xddBx\x12?*\"}\xff\x9a\xe0\xff(\x8a\xac\x14\xfc\xfb\xfa\xecѹ\xb1\xbd\xbfHc\xd1e\xf3\xe8x\xfe\xd5\xf9\x86\xec\xba\xfd\xed\x9c}\xeeI\x92x\xeeCYZ\xdc6u\xea\xcc\xc3\xcbs\v\x0f\xe7YJ'\x8e\x1d\x1fm\xed\t)\xe5\a\x80oL\x1e\xf9]=|\xe3Cې]\x98\xdbj\xacV\xe0E\x18m)\xa0\xbaӅ\xa5b\x15\xa3t\x98<y\x96\x89P\xb0\xef\xfa\x91+\xe9\xe5]\xa4\xb5\a\x91\x1bl\xe0\xdaM/,@\xd9\xd27\xba\x99\xea\xd6QDP\x00?\"[\xad\xa3[\r\xd0\nϗ\xe8f\xbc\x1e\x84F7u\xd4d\n\x95e\x18\xed\xdc^\x93\x17\x17\x98_i#\x85\xc0\x17\x16\xb4\xa5\xe9\x87tZ\xb1\x83J\x90\xeb\xddՕ \u007fc\fi\x96\x89 \bF\x86\xb7\xde\xcd剿\xe2\x95~~hA\xce>\xe7V1\x84\x03\xf7\xea\xd6\xf4\xe1_\xc9\x12\x16Ӥ\x8d\xc0\xb6\xa20\x9a\f|\xfb\xc4\xd9#\u007f\xbcx\xad\x965\xfa\xb0\xd6\nL\xbe\xbe\xd0\xc5u8\ni=\xaedh\x15<\x814\x1ak:\x9c}\xf6(Cr/\xe3㛮\xb6\x95\xd6\x12\r\xf79\t\xa9\xef!\x83\x80\xb0:\x00#[\xb1\xd2Q{\xc2Z\x10\xe3n\xc5w\xbb\x89j\xac\x91-.\x90,.`s\xdd\x05\n\x1467\xa0-I\xbb\xc3\xd9ӳ\xd4\xeam|)\xf0\xa5pQ\xaf@\xd3J\xca\xe3\x15\xf2%A^\xef\xa2\xcc]\x04<\bC\xb24%\xe9\xc4\x04\xbe\xff\t\xaf2\xfe\x8f\xf0LR\x1a\xfd\x19\xe2\xe9\xa7xջ\xac\x99\x17\xff\x8c\xfa\xa5\xa7k\xc0\xbb\xbf\xdf\xef\x8c\xde\xfe\xcb=\xc2+~\"\x89\x93\a\xac\xd1\x18\x9dw\xb5\xba!*ssH\x86\xc4\b\xf0\x10\x84\x05\x1f_\x1bD\xaa(\xf8\x823\x87\xbf\x83]\xdb\xc5\xf8-7\xac\xe3\x82vi\x95\xc2P\x1fA\xb9\x88\f}0\xee\x84!$^T\xc0/\x94\xc9\xd6V\x1c\xb4R*\xe3\x19\x83\x97$\xf8\x9d\x0e\xe9\xd2\x126W\x98ܝ\xd6\xe6Z\x9b\xb3'\xa7i4:x\xf2*p\xa8\x8c{\xe9K]5\x86\x8c|d\xea=p_\x00\x00\f\vIDATh\xe9\xdf6FRo\xa0\xe2\x18!%Q\xa1\x80\xca\x15\xadVs\x8f\x94\xf2σ\x8do~g<\xfdT\xf3Ga\x02_\xd1o\b@\xb2v\x89-{\u007f\x91\xe6\xe2ɫ\x82\xba\x1b\u007f\x9aM{\xdeFm\xea[\\\u007fϻ\x86\xbd\xa8\xfa\xb7:7\x0f^\x11D\xebl\rk4R\xf8h%\xb0Vr]\xa5\xc0F\x9bPF\x13\x06\x1e~\xe4a\x8d\xc1\xb3\x0e\x83ZY\xac\x93\xb4cF\xc6F\x9c\xdeրI3\xb0\x16\xaf\xe4\x14\xed\xc2\x1a\x04.\u007f\xc5\xe4\xd9U|\xc9EU;\xc1[\xabM\xb6\xba\x8a\xedb`\xf335NO\\\"\x8e\x1do\x8e\x10x\x9e\x8bZJ\xb5\xa1\xa9-\xa7\xc32\xf5\xc0E\xc4\n\xeb\xb3\xe9\xd6\xdb(\x0f\x0f\xe1KI\xe6\xae2\x13E\xe1\xc14\xe9|Diu\xde\xf3\xe4&\xaf08\x95,<m^\xa9\x13\xf2\xb2\xf6\x874\x17O\xb2\xe9Ɵ\rZ\xcbg\f@s\xf9\x1c\xb5\xa9oq\xdd=\xef\xb9\x1f\xaf\xf4\rk٭\xb2\xack|\x84<\x8d\x112\xc0\x88\"\x85\xc1A\xe2F\x83\x1b+\x11\xc3dT\xa4\x05m(\x95\x02'n6\xb6\xcb\xfd\b\xd6V\x1a\xccOͲm\xfbf\x82(t\xdbq\x92\f\x13\xa7\xc8(@\x06>\xc2j\xe7Jx)\x02i\f&IЭ&\xf1\xe5KX\xad\xf1\u0080\xcb\xe7\xe79\xf1\xdd\xf3hmօ\xb9ғ\x04\xa1K\x01j+KSÄ_!\xadV\xc0*\xb2\xb5\x98\xe1\x9b\xf6\x02\xea\u007fG\x03\x83\xb3\xe5-#[[ss\x9f]:\xf1ć\xf3\xc6\xd9\x19\xd58{:\xe8\xddy\xd1\x1a\xa3U\xfb\x12\xff\"\x05\x01ذ\xebͯ\xed\xdbz\xe7\xefWG︥ot\xdfOUG\xef\xf8\x18\"x\u007f\x10\x84\xa9Q\xcaX\xa3\v\x9e\xe7!d\x80\xd6\n\x95[\xcac\x9b)\xf6UQyN9Ϲ\xce7T\x84&\b$XA\xa57r\xad\xf1KظN\xbbù\x17\xcf\x12\n\xa8T+N~\x93\xe7\x98ĭ'\x12\xdd\xcd>X\x9c\xbc4W\xe8f\x83\xe4\xd2$\xcd\v\x17\xd0YN\xda\xc98s\xf4\"g\x9f\x9f\xbc&\xff\xdd\xf3%a\xe8\xe3{\x0e<\x8csC]YN\x0eo\xc5\f\x8f@\x1a\xa3\xdamJ}\x1blP\xaa<>\xf1\xb9\x0f\xfe\xdaȾ\a^\xe8\xbf~\u05cb\xf3\xcf~\xe5\xdc:\xf4\xde8g_\xc9b\xc0\xcb\\\x9b\xb7\xe3\xee\xf7r\xe1\x99\xffɶ;\xdfU\x16^\xb8\x15D\xbf\xb0v\xb9T\x8c\xb2RX\xa4\xd9n܌\b\xfeT\x1b\xd5\xdfn\xaeҨ\xcdP\x18\xdeH\xb4}\x1b\xac\xaeb\xb5&=?ɯ\x0eJ6\x930P\x0e\b\v>\xfdCeT\xa6\xe9\xc4\x19y\xaei\xb72\xd2N\x8e\xce\rZk\xfa\x87\xaa\x8c\xed\x1ccd|\x84\xbe\xa1>\xa7>\f\x02\xbcB\xc1\xb5\x9c\xb9\xc2d)&\x89\xc9ZM\x1a+\r\x16./s\xf9\xdc\x1ci\x9c\"}\a\x16J\xe9\x8a\xe1B\xc9D7O\xd10\xd7\xca9\xd7Q\xfc\xfd\xae}\xe8\xe1!tc\x11\xb5V\xc3\xcb\vl\xd9w\xe7\x9f\x1f\xfb\xf4#\xef\xb8\xf2\x0e\xf6\xbe\xebq&>\xfbA~\\\xcf\xcb\xde\xf5\xb0\xfdu\xefa\xf2\xdb\u007f\xf4}\xff\xff\xa6\xfb>\xf01\x83<\xb80}\x12#<J\xbbv \x82\b\xd1l\xb9\x1c\xdef\x8b\xeb\x97\xe7\xf8\xb9*l,\n\xca\xe5\x90\xfe\xc12\xa5rD\xab\x95\x90\xa59I''K\x14\x9dvN\x9e\xb9\x89\xdaXK\xa9Rbp\xa4\x9f\x91\xad\x1b\x18\x18\xae\x12\x16B7\x18\xaa\x9cv#fqv\x99\xa5\x99\x1aq\xb3C\xd6\xc9\x11\xdd\x02`-\x9e\xe7\xfc\xe3~wu\x91\x94\x12\xe1A\xdcΙm\xe7\x1co+\xbeq\xf3~r\x11cZu09&\x81\xd1\xdb\xee\x9b\x10\xbe\xf8\xa9\x17>\xf3_\xe6x\x15\x9e\x97\x8de}\xbfb\x8c\xdf\xfaK\f\x8f\xdf˳O\xbe\xff\xb7\xc6n\u007f\xe7;u\xd6\x19\x0f7\x8ca+=.g\xb1\xd5\x06k\t\xcb%\xce7+\x9c\xcdbz<C\xb9\xec\xfc\x81\xc5rHu\xcb\b\xedvN}v\x81N;&\x88|\xf2T\x91\xc69\xc6\x1a\xf24enr\x9e\xf9\xc99\xac\x90\x14\x8a\x81\xcbB\xcc]4\x87\xc0\xa5P\xfb\xbe\xa4T\t\xf1\xfcn(\x8ca}\xca\xf6|7$\xfa\x81K\x175Ƣ,\xaci0\xbe\x8f\xd0\x10n\x18'[8\x8f\b\x15\xf5\x8b\xe7\xc67\xec\xd9{+0\xf7\x9aw\xff\x0f\x9e\xff\xccG~\xac\x05y\xc5\xf6\xa9\xafΟ\xa0\xbdz\x99\xa4\xb5HX\xe8\xdbm\xbd\xe2\xbep\xcb\b\"\n)\xf4\x0fR)\xf7\x905\x1b\x8e\x1c*\x14\x99\xaa5\xd8\xe5+\xfaCI\x18z\fl\xea\xa7<6N\xdf\xd61\x06v\x8c\xbb`0\x9d\xe3IK\xa1\x12\xe2\xf9>\xbe/\xf1<\x81\xf0e7ܸ\x9b\xfc\xe6\t\xc2ȧP\f(W\"\x8aŀ \xf2\t\x02\x8f \xf2\xdd.D\xcf#\x8c|\xa4\x14n\x18\f\x9c\x1f=I5\xf5\xccp:5ԯ\xdbM00\x82\x8cʨ\xc6\x12Ve\xe8,\v\xab\xa3\xdb\xcc\xc6\xdb^\xff\xe5\xe7?\xf3\x11s\xd3;\x1ee\xe9\x85o\xfe\xeb/\b@\xd2r\xf3\xa1,n\xb3\x85\xa1\x91\x87\xfbv\x8c\x93\xe6.]\xba\u007f\xf3\x18\xe9\xd2\x12:\xed\n\xea\x866pbz\x89\xfbK\x16\xe9K*\x03U*\x1bG\xf0\xa2\x02\x95\x8d#TGǨn\xd9D\xa1\x1c\x821.\x8a<\xf4(\xf6\x84\x94\xca\x11\xa5\x9e\x90\x9ej\x81ro\x91J\xb5@\xa9\x12R\xaaD\x14\xcb\x01Q)\xa4X\x0e)\x95C\x02_vc\x92<'\x8c\xec\xaei\xf2|\x89\xca4\xedT\xb3\x96i\xce*\x8f\xe6\uef48 \x02/@\xd7\x17\xe9\xad\f\x13\x15{(T\xfb_S\x18\x18\xaa\xcc<\xfd\xa5\u007fXz\xe1\x9b\xdc\xfe\xc8\xe3\xcc=\xfb\x0f\xff\xfa\vR\xde\xfc\x16\xf2\xe6\x05\x82\xde\xeb\xffh`\xef\x9e\xf1\xea֭tV\xeb\xa4iB{\xad\x8en4\xa8l\xd8D\xcf\xe8\x18\x83;wR\xeb\xe4\\\xbc4\xc7\xfe\xaaG\xa9\xbfB\xcf\xc8\bBz\xf8Q\t\x81%\xea\xa9гy#\x03۷R\xec-;\x0fG\xae\x9cK\xd7\xf7\xba1K\x02)$\xa5J\xc4\xc0\x86*\xfd\x83=\xf4\rU\xa8\x0e\xf6R\xae\x96\t\x8a\x11Q\xe4!\xb8\x1a\xc2\u007f\x85d\xcaҜv\xa2h\xe6\x863^\x99֎\xeb\x9d\x0f\xbe\xd0Æ\xd11v\xdd\xf9zN~\xf3K\x14\xabUJ\x83#wo\xd9\xff\vwU\x867}\xe5\xc5?\xfd\xef붴\xfd\a\x0fq\xf9\x1b\u007f\xf9/\xf7\r\xf9^\xcf\r\x0f}\x90\xd3_x\x9cv\x97\xc6\x14\x9e\xf7\x13a\xb5\x97N\xa3\xe1ZY\x01J\xbb\xe0\x99\x8d7\xdc\xc8-\xf7\xbd\x91\xa9S'\x88\xdeP\xe2\xe8\x85\xf3\x1ck漡\x95:}\xadqS\xb5\x90\u03a2\x86'\xf0\u0090\xfe\xed\xdb\xe8\xdb2\x02i\x9b\xb4\x15\xa3:\xb9\x93\x82\xaa\xc4\xf9\xe5\xa5\x0fF\xa3\xb3\f\xdd\r\xd6\x17\xd6R\xacZ\xf28fm\xce\xd0n5\xd6#_\xdd\xf7\x03\xa4\x85\xcc\n\xd2r\xc5!\xba\xd2G\x06!ig\x95\x81\xad\xbb\xd8|\xfdͬL\x9d\xa640B\xd47\xf0\xc0\xf0\xedoZ\x1a\xba\xe5ާ,\xf6\xef\x84\xf4\xbe\x8a5\x97\xc0i\x91\xf6\x1f<\xc4\xe1\xc7\x0e\xfc\xb3\xde\xe5?i\x17\xee\ue1eem\xfbN\u007f\xe1q\x00n\u007f\xe4\xf1mo\xfa\x83\xbf\xd9nT>ў_\xa0\xb9\xbc\x80\xc6:\xedn'A\x94\x8b,\x9e9\xc5\xf4\xcc4\xb7\xed\xbf\x8f=\xb7\xbe\x16\xe9\t.\xa5\x90\xb6\x93\xae\xa0\xcd`T~\x8d\xf8݅`\x02A\x80\xac\xf4R\x18\x1a\xa68\xb4\x81\xe2\xe00\x85\x81ad\xb1\x82\xf1\x02\a\u05cb\xae\xae\xeb\n\xecn,\xd2wç\xe3\xc2]J\xf5\xfa\x1eCkQ@\x1a\x15\x1d'\xe3\a $Y\xda\x01a\xb9\xebm\xbf\xcc\xe0\xe88\xbd\xa5\x90\xc6\xc5I\x00?\xe8\xa9\xfe|\xd4\xd3\xf7i\x19\x04G\x11\xde\xe3\xfb\u007f\xf3\x89;\x00\x0e?v\x80\xfd\a\x0f\xb1\xff\xe0\xa1W\xf7\x84\x9c\xea\x16`\xdf\a~\u007fo\xd03\xb0ϋ\xa2\xb7J?\xdc\t\xb6W'2\x18\xbb\xf7ޞ\x99cG)\xed\x1a\xa7\xd8\xd7\xc7\r\xaf\xbb\x83\xa5c\xcf1ߪ\xd3Z\x9c\xa7Y_ai~\x16\x95\xa5\xee%\x1aC܌\x1dgb\f*M\x90~\xb0\xae\x85\x12\xb6\x9bn*\f\x06\x0f\x02\x0f\xcf\xfa\x18\"\x84\x8a0*\xc7h\ry\a\x11\x06\xe8f\xd39\xa1\xd6\xd5\xe7\x06\x95+\x87\xe2\xaeg&\xb2\xae\x82\x8c\x11\xa4\x85\xa2\x13\xc3\xf9!\xc2Z\xf2$\xc1\x02a\xa9\x87\xea\xc8Vl\x10r\xfek_\xa7>u\x89\xb0RA\xa7\x19C{v\xf7\xf6\x8f\x8f?\xa2\xb2\xe4\xc0\xfe\xdf|\xe2)\xe0Ç\x1f;\xb0\xfcc\xbf\xb2\xee\xfa\x8d\xcfr\xe4\x13\xef⺷\xfe\x8a\x1c\xba\xf9\x9e7\xf8\xa5\xcaǼ \xba\x1f)\xb1Z\x93\xaf\xad\xe1\x15\x9c\x036\x9e\x9d\xa548\x800\x16\xd5nS\x18\x1d#3\x86\xbeM\x9bY8\xb1\x84\t<\x1a\x97&Y\x1b\xddJ\xed\xf8\x84k\x85\x05d\xb9\"i4\xa9\fEN!R\xb2]$Wt\xe7\x06\x0fk\x15B\xe6W\xc6mP]\r\x96\x13\xeb!\xac\x8f\fCL\x9e#\xe2\xd8\t\xb5\x85\xc0d\x19\xd2\xf3\t\x03\x8f\xb4k\f\xbd\x82}\x19\x01\r!I\xa2\x12\x91\xe79\x1f\x8a\xb5\xe8<\xc3Z\xc3\xdc\xc5s\xe4iBc~\x81\xbc\xd3a\xf1\xc5\xe3H\xdf\t2\x96Ϝ!\xec)3v\xf7\xddÃ\xbbv\xfe\a\xa3\xb2\a\xf7\x1f<\xf4\x9f\x80/\x1e~쀹rR^\xce5\xf6\x03\vr\xe3\xbf\xfb\rn{\xe4w\xf8\xfc=\x82\u05fe\xef\xf1jah\xd3oyA\xe1\xfd\x00\xaa\xd3!o\xb7Э6F;\xbdn\xeb\xf2e\x92\xe5e\x90\x92(\x8c\xc8V\x1at\xea\xab\xd8m\x9a\xceZ\xd3\xf9?\xa2\x90\xc6\xcc4Y\xa7\xcd\xe4\xf3\xcfa\xb3\x9c\xc1\x1e\a\xf4\xc5\xcb+\x94\x87\x87\\kl\xbb\x9e\xf3uE\xa8\a2\xe8.\xbd\a+46\x00\xab\xac\x93\x91\"\xb1~\xc1\x81\x8e\xd5aD6\xd3\x15V\x1b\xac\xce(\x94C\x84\xb0\xc8&\xb4\xe3\xdciz\x8d\xd3¬z\x01y\xa1L!(8\xee\\\b\xb4qz\xdf\xfa\xec%\x10\x82\xcbG\xbe뼇\xbe\x8f5\x86\xbc\x1d#\x84\xa0\x93$\x9cz\xf2\xaf\x19ص\x93\xb1{\xee\xee)\x0e\xf6\xfd\x85\xf4\xbc\u07fe\xe7c\x9f\xff\xe4\xe1\xc7\x0e\xac\xbc\xdc\xeb\xeb\avY\xcb\x13\x87\x99\xf8\xdcA^\xf7\xe8\x9f\xdcQ\xe8\xdf\xf8\xc7X\xf1ot\x96\x93\xae\xacP\u007f\xfe\x18\xed\xe9i\xd7\xc6Fn\x11K\xba\xbc\x8c\xe9\xaaL\x845N/+\x1c\xb1\xb3\xba8\x87\x0e}\xac\xef#\xdb1\xda\xc0\xea\xe5I\xda\xd3s\xbcmc\xc0\xc8`\x89\xdeސhp#\xd2\xf7\xf1\xa2\xa2c\xea\xbaB5\x81\x03\x12\x85\xf6\xb1Ft\x93P\x05V\xbbTPk\xba\x89\xd8\xd2C\x16\a\x9c\xca$i:\x95{\x96\xb9\x95JQ@\x18\b\xb4\xb2d\x99\"\xe9(2e\xf8\xba(\x13\x8fl#\xea\x1f\xeaJ\x19\x04&iR\xb09q\xa3F\xed\xcc9jg.\xb8\x02w\xb9\xf5\x97\xda\xf0\xa4\x94tV\xea,\x9f<E\xbc\\#(\x97\xee/o\x18\xbek\xcb=?\xff\xf4\xb7\x0e\xfe\xdb\xfa\xcb\xe9\xc4~`A\xf6}\xf0\x0f\xbd\xedoy\xf8\x03^X\xfa\xb4Iӛt\x9a\xe8֥)S\x9fx\xc1d\x8d\x86\xd1Ib\xf2fӨ86AO\x8f\xd1ijt\x1c\x1b\x840\u0097&O:\xc6z\xbeɍ2\xb6\x18\x19\x840\bal;6\xad\xd9\x19\x93\xb5[&X]1\a\xb6\x95̦\xd1>#<i(\xf6\x98\xb0T0A\xa1h\x84\x94\x06\x84\x11N¨\xad\xf5\x8c\x15\x81\xb1Bj+\xb4\xb6\x16e\xacUV\xa2\xac\x10\xb9\xf5\nJ\x06\xc5\\ r\x13\x849YG\x99VSY\xa3\x15Rj!\x84\x13c\tL\xb3\x91\xea,Q&ƷOz}\xba\xb0e\xdcz\xa5\x8aSy\vaHۦv\xe2\x19\xe3\a\x91\x99\x9f8i\xb2V\xbb\xab\x00\xb7FH\xcf\xfd\x8e[\x82\xe8~\x06c\x8d1\x9d嚩\x9d>k\x8a\x03\x03\xdb\xcb\x1b\x87o\x1d\xbb\xef\xc1/\x1f~\xec@\xfc\xa3\x9e\x90\xff\x0fek\xfb\xc4\xc0\x11\x81[\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_08_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x17\x1e\xc2E@B\x00\x00 \x00IDATx\xda\xec\xbdy\xb0n\xd9Y\xde\xf7[\xd3\x1e\xbe\xf1\xcc\xe7\x9e;\xf6<Ij\xf5$\xb5\x04j\x81\x80b0\x05\x16\x908\x81`2U\xd9N\xecr\\\xa92$\xc1\xff8\xaeJ\f6\xd8e*\t\x94\x83)\xaaRTlC\b\xb1,d\f\"\x91\x90d\tMݭ\x9e\xbbo\xf7\xbd}\xe7{\xa6o\xdcÚ\xf2\xc7\xda\xe7\xb4p\x12\x15\x91\xd4\xdd\x17\xe3\xaf\xea\xd4=\xe7ֽ\xdf\xd9\xdf~\xf6Z\xef\xfb>\xef\xf3>K\xf0'\xec\xf5\xdf|\xff\xbb\xe5k7\x0fʪi\xb3\xd8\xfd\x9d\xd1*\f\x8a\xac\xfa\x95O\xbd\xda\xfe\xeb\xff\xfe\xc7\x1e=˯}\xe1⟘\xcf'n\xb5\vzh5\xe7\xcb\a\xcd\x1f\xb9\xc6\xc76{\xa3\xf5\xb5\xf2\xde\x10\xe3\x9d\x02qw\xbf\x97}\v1\xde\x17\xd3\xf5\v%š\x14\xe2\xd3\b\xf1\x8cD\\\x10\x82\xd7\xcfm\xae\xbe\xfc3\xbf\xfd\xf4\xec\xe8M\xbe\xe3\xdc\n\x1f\xbfp\xf8o\x01\xf9z_\x0f\x8f\xb2\xfb\x95\x91?\xa4\xa4|<\u0096T\xdc^\x14f+\nD\x96i\xd6\xfa9.F\xac\x0f\xc4\b\xf1\x8d\x0f\xd4\xc4\xc8^\b\xf1\xda|V\u007f\xc9\xc7\xf0\xd1GΝ\xf9\xc8/|\xea\xb9?\xb2z\xfe\xe2\a\xef\xe6\x97>\xf1ҿ\x05\xe4\xff\xeb\xf5ޭ\x1e\x9f\xbb\xb1\xe4\x91բ/%\u007f\x1b!~\\I1^Y)E\xbf4L\x97-\xc3Ұ\xa8-\x8du\fz9JJZ\x1f\xfe_\xdf/\x84H\xbdhi\x9d\x8b!D\x9bg\xfa\xff\xca3\xfd\xf1\xb5q\xff\xfcɵ\xd1\xef\xfd\xfd\xdf}v\x0f\xe0\xfb\xee\xdd\xe0\xb7_\xd8\xfd\xd3\r\xc8\x03ケ\x16|f\xaf\xe2\xe1Q6\x92J\xac \x84\x92J\xfc0\x91\xbf\xeeC\xdc\x1e\x8drVF%Z\t\xa4\x80\xd6G|\b4\xad#\xf8\x80\x8f\x91^n(2Ce=!\xc6\xe3\x0f\x13\x01b\xa4\xaa,\xd6:\x9c\v()\xd1F\x92\xe7\x06c\x14m힉1\xfe:!\xfe\xca;\xee\xbf\xe3\xe2/|\xecK\xf1\x87߱\xcd\xff\xf6\xcc\xf5?}\x80\xbc\u007f\xab\xe437*\x1e[->\x18\x05?\x15\x89w\t\xc4*\xc4\xcd\xd8]\xd6p\x98\xb12*1Z\"\x84@\x12\x99\xb7\x9e\xd6:z\x99a^5h%\x19\xf5rB\x8c,\x1a\x8f\xf8\xd7>M][l\xe3h\x9cG\b\x81Q\x92\xa20\x98LSU-\xdeyB\x88\xafF\xf8\x9b\x9f\xb9\xb2\xf8\xd5[a\x85\xa8\xb7\xe3\x97^Z8\u07b3^ލ\xe47\x8aҼw4.\xd7\a\x83\xbc\xbf\xb1\xdac8\xc8\t@];\xf2\\S\x1a\x8d\xf3\x81\xda\a\x8c\x94\xb8\x18\xe9)\x18\x18\xc9Ae!\xc2=\xab\x86\xa5\a%\x05!F\" \x84 \x84@p\x01\x17\"\x02A\x8c\xa0\xb5\xc4\x18E\xf4\x11\xef\x02\xd6\xc7\xd5\x18\xe2\xc9;\u05cb\u007fza\xd26\u007f\xaa\x00yl\xad\xe0J\xe5x|\xa3\x1c#\xc5/\xe5\xb9~|u\xadDH\x81ъ\xdc(\xb4\x92(-\t!\xe2\\`\xab\xaf\x90RиH\xae%D\xc8\x04\x8c{\x19K\x1bi\xbd\xa7\x97\x19\x84\x90,m \x00\xc1G\xea\xaa\xc5[\x0f\x02\xbcO{\x81\x10\x02b\xa4(\f\x90\xde\xdf\xf9@\x8c\xf1\x94\x14\xf2\xf0\x8e\xd5\xf2\xa9\x0fܷc\x9f\xbe|\xc8wܶΫ\x87տ\xb9\x80<\xb2R\xf0\x85\x83\x1a\x80S}\xf3w\x94\x92\u007f~k\xa3O?7dJb}\xc0\x87\x88\x10\x82\xdc(\x8a\xc2д\x8e\xc3ڱ^\x1a\x06\x99\xe4\xa0vdZ2o\x03\xce\x05N\f4\xbb\v\xcb~\xedp\x11\xa4\x108\x17\x12\x18G\xc1^\xa4\x00/\x01!\xc0GP\x02\xb4Q4\x8d\xc7yO\f\x10\x88\xdf\x15B\xfc\xd1\xe9\xac\xda\u007f}\xd6>\xf9v\x80\xf1\x96\x01\xf2X\x99\xf1\x85y\xda\r\x1eY\xcd\xff\xc7\x10\xe2_\x8e1\xb2\xac\x1c\xd6:\x94\x14(\xad(L\xba\x9c\xc6G\x8c\x94dFQ[O\xe3\"\x9b=C\x1d D\x88\x11\x96ցT()\xb1Σ\xa5`\xd4˩[\x87\xb5>m[\xdd\xef\xf7\xa1ˋ\x8f\n\x17\xc0\xba@];\xbc\xed\x00\x89\x11\xefÊ\xf7\xe1C\xb7\x0f\xcb\u007f\xf1\xfa\xa2\xbd\xf6o\x1c \x0f\x9dT\\\x9bE\xae8\x0f\xc0\xa3\xab\xf9\u007f*\x04\u007f3ϵ\xe8\r32\xa3X֖\xcay\x8c\x12\xe42\xad\x8e\xa1\x91\xb8\x10\x88\xa4\xa7~\xd9Zv\x17\x96\x13\xa3\x9c\xd5L`\xa3\xc4\xc7Hc=\xb9Q\xb4֓g\x9a\\+\x96\x8d\xa5\xb1\x9e\x18bZ\x12\xa4\xf8\x11\x8e\xc0H\xbb\x16m\x1bp\xd6\x13<D\xf1F~\x13#\x85\v\xa1w\xd7Z\xef\x9fog2\\\xa9ܟl@\x1e^\u0379V'\x00\xce\xf5\xcbщL\x9d9Q蓧\xfb\xfa1!\xc5ߕȕ\xd1jI\x9ekF\xfd\x8c\xa20x\x17\x80Ȭ\xf5(\"'z\x92\x13=E\x13$R)\xa4\x104βl=\xfdL\x81\x90\x80\xa0n-JI\xac\xf7\xb4\xd6S5\x96\xba\xb1(u\x94\xfa\x82R\x82\x18S\xea\x1c\xe3\x1b\x80x\x17\x88D\x94V\xc4\xd0\xe1\xd1%\x041\xc6w{\x17d\xaf0_\xbc8ok\x80o;5\xe6\xc2\xec͏\xf9\xdfԴ\xf7\xd1͂/ܬypd\nc\xd4OD\xf8a\"ߖiY\xc4\x18\xb0.\"\x04\xf4G\x05\xc3A\x9e\x824\x02-\xc5q\xc1G\x8c\x18)8\xbbR\xa0\x95`\xe1\x05\v\x1b\x98U\r\xde\a\x1e\xd8\x1e\"\x80\xc3e\xcb\xeb\x875\x99\x96)ŵ\x01%\x05\xadul\x0er\x0e\xeb\xb4R\xca,C\x88H\xeb<\xceE\xda\xd6#\xa5\xc0\xbb\x80\xf7)fy\x1f\x12P\x02\xf0odiZ\xc9\xdf9\xb1=\xf8\x0f>\xf2\xdc\xee\ue7f8\x15\xf2}w\xad\xf1\x89K\x89::=\xc8>.\xa5\xf8\v+\xfd\xfc\x9e\x9d\x8d\xbe\x1e\x8f\nV\x879\xfdR\xb3\xa8\x1cm\xeb\x18\x95\x9aq\xa1\x13\xd7\xe1\x02RJ\xfa\xb9&ӊ\\\v\x04pu\xde2.3\x94\x14\x18mX6\x96\xc3ʡ\x88\x9c\x1ah\x9a\x10\xd9\xecg\x80`i=ò Fp>\xa0e\x02jX\x16\xf8\x18\xf1!\xa2\x94@\x1b\x89\xd1\x12\xef\"\xc1Gb\x88H)P*=\x1cRI\xa4\x92\b\"!\xc6;\xe7\x8b\xf6\xc7v\n}\U0003eef6\x9f|\xfe\xc6\xecO\x06 \x8f\xac\xe6|\xf2\xca\x1c\x80o\xd9\xe9\xff<\x91\u007f\xafȴ*s\x852\x1a\x8b \nA\x91\x1b\x8a2c:m\xb06Pf\x92R\tƅ\xa4T\x12)\xc0H\xc1 O\xe9\xefa\xe5\x88!p\xb2\xaf\xb82\xad\x8fo\x9eP\x86e\xd44\xad#ׂ\x83ʑ\x1b\xc5='7h\xbcǇ\xc8\xe6\xc0 \x95bs\xb5O\xeb<\x8du\x88.&!\x041\x80\xebb\x9b\x14\xe2\xb8nA|\xd5\xcf1\x12#\xa3\x10\xe3\xde\xe1d\xf9\xb1kM\xf0\xb7< \xef\xdd\xee\xf1\xf9ݚGV\xf3{vz\xe6\x97b\xe4?Ɍ\xa4\x97k\xfa\xfd\f!\xd3\xd3ֆ\xb4]\x19\xa5(K\xc3l\xd1RYO\x14\x82L\b\xb6z\x92q.Y\xcd%.D\x0e\xbb\xa2υȍ\xa5Ç\xc8#;=b\x14L\x1b\xcfJ\xaf@)\xc3\xeb\x93%\xdb\xfd\f\x80ic\xd9Y\x19\xb0h\x1d\xb3ʢ\xb5\xe2\xf4\xfa\x88\xbdY\x85\v\x01)\xc0\a\xd8Y\x19\xb03\x1e\xb0{\xb8L)\xb1\x96(%\xd1R\xe2C$\xa4=\v%S\xbc\x89\x91\x8b\"\xf2\xebۅ\xf2כp\xeb\x02\xf2\xf8N\x9f\xcf][\xf2\xbe\x9d\xfe\xed\xceǏ\xf6\n\xf5\xc4\xcez\xc9\xda(\xa7\xdf3(\x01FB\xa6\x04\x99\x92\xf8(\x10\b\x94\x12\xf4z&\xddĥef={K\x87\x94\x92\xc6%\x10ƙĆH\x88\x92(\x04\xab\xfd\x1em\x14\x1cT\x96E\xeb\xd8\x1a\xf7y\U0010e4ec\xf6\v.\xefϙ\xd4\x16\x84\xe0\x91;Nq\xf7\xce:\xfb\xf3\x8a\xdbO\xac\xf1\xde;O1,s^\xbf9\xc1hŻ\xcel\xf3\xde;N\xd1:ǫ7\x0eS\xdc\x10\x82s\x9bCN\xad\r؟Vo\x84V! %\x01g\x81\xcf<9\xb5\xe7\x1f^˸V\xf9[/\xa8\xbfg\xbd\xe4\x0f\xf7*\xdewj\xf0\x90o\xfd\xc72-\xb7\xcfn\x0f\xd02\xdd\xf0\xf5\\\xb0\xf4\x91E\x13\x90\xc0\xc2\x05\x02i\xafVR\x12c\xc4\x05\xf0\xdes\xb8h\x8e\xd3\x1f! W\x92{\xd7\v|\x84A&9\u007f\xd82k#wl\xad\xa0\x94\"\x86Ƞ\x97s\xd7\xc9M>\xf4\xee{x\xfe\xf2M~\xef\xc9\x17\xa9\xeb\x96\xef{\xec\x01\x1e\xba\xeb4\xb3eM\xa6\x15\"\x06&\xd3\x05\xbb\x939\x8d\xb5D\x1f\t1\xd0Xύ\xc39\xce\a\xae\xed\xcf\xd8\x1c\xf7\x98.[>\xfe\xe4y\\\b\x8c\a\x05\xd3ڲ\xacZ\x10\xe0lh\xf3\x81>\xfd\xf9k\xf5\xcd[6\xcbzl\xad\xbcMk\xf1/\xb5\x16w\xed\xac\xf7\x18\x96\x06\xef#\xad\x0f\xb4\x11r-\x18\x1bA\xa6\x04\xfbMd3\x17\b\x01\x95\x83R\v\xe66 ;\xe2\x10`w\x99*s\xdf\x15\u007fR\xc0\xc9A\xc6~\xe5hB\xe4\x81\xd3[\xbc\xfb\xf6\x93\x9c\xdc\\\xa5\r\x81w\xdfq\x86\x95Q\x1f%R\xb5qe\xf7\x80\xb5\xe1\x00\xd5}\xaa\x18#!\x04b\x88\xd4M\xc3l\xb6d:_\xb2\xa8\x1a\xacs\x04R\xa6\xd5X\xcf|Y\xd3:O\xd5Z\xbe\xf2\xea\rF}C \xf2\xf9W\xae\xb3l,!D\xbc\r\x1fyj\xee~\xe0\xf1\xad\x92\xcfިn-@\x1e^˅\x92\xe2?\x96R\xfe\xb2\xc9$en\xe8\xe7\x12\x17\x05\xab\x03\x83\x8a\x91LI\xb4\x80\xdaG\xb4\x04-\x04m\x88\xec\xf4\xbaT\xd5EVr\xcdԦ'\xf6\xb0\xf6L\x9a\x80R\x8a2\xd3 \x14JI\x06E\xc6\xf6x\xc8m'\xd6x\xec\xfe\xdb9\xbb\xb3\x812\x86\xd8eR\xd19\b\x01\xd1\xf5A\x82\xf7\x84\xc4Q\x1d7\xae\xa4\x94X\xe7XΗ,\x17\x15u۲\xa8\x9b\x0e\x1c\x8f\xf5\x81\xaa\xb1TM\x8b\x0f\x81颦\xb1\x96\xfdE\xcdS\x97vٟ\xd48\xe7/<9\xb1\xb7\x01<\xb8\xde㩽\xe5\xad\x13CN\xf5L\x06\xfcb\b\xe1d\f\x82\xbaq̖\x96e\x95\n\xb8\xdaG\x1c\xb0\xb4\xe9\xc3\x0er\x8d\x10\x82B\v*\x1b\x98\xb4\xe9V\xdd\\xZ\x1fX\xc9$\xb5\x8b\xac\x97\x9aa&\xb0>@\fl\r{|\xefc\x0f\xf0\xc1w\xde\xc1\x03w\x9fec}\x8c\xcas\x841)aP\natWׅ\x94\x85\x89\xb45J)\x91Z\"H+S*\x851\x86\x18!ӊ^\x91Q\xe6\x19R\b\xbc\x8f(\xd9e`\x1d\xefb}z\xa8<\x91\xbdE\x8d\xf7\x98Ӄ\xec\x13W\x96\xee\xe2\xf5\xca\xdeZA\xfd\xcc(S\xc0\u007f!\x95\xdc\xd8\xd8쳶R`\x8cJ\x95/1Q\xe6\xadg\xd9z\x16\xadg\xe9\"K\x1f\xa9]@k\xc5Z\xae\x88Rr\xfe\xa0f\xb3\x94\xf4\x8cb\xb7\n\xec\f4\x8d\x87\x9bKG\x88\x91y\xddry\u007f\u009d\xa7Op\xee\xe4&\xc2\x18d\x96!\xa4@H\x89Ԫk\xac+\x84JU\xbc\x90\x02\xa1\x15:ϐڠ\xde\xd8\xc3\x10R\xa2\x15D\x1fQJ\x92\x19M\x9e\x19\xb4\x92\x04@\xa4T7q`>\x91\x8f\x99R\\\x9b.p.d1\xf2\xfd'r\xf5/\xefYϯ_\x98}\xf3A\xd1_7\x92\x99\x04\x1b\xa6\xc1\xa7\xa2\xcb\xf9Ƞ\x9fa]\x02\xc3HA\x99)\xa6˖\x10#!F\xa6\x8b\xd4\xd6>\xac<7\x8cd\xde8\x9c\xf3<\xbf\x17\xc8u\xa04\x8aW\xe7\x82A\xd9\xe3\xde3\x05\xad\v\fˌ۶\xd79\xb9\xb3A\x88\x11)%H\x81P\xa9q\x05\x80\xd1\b\x11\x89р\xf3D\xeb\x10R\x10\xbb\n\\\xc8,U\xe46\x15\x95J\x15\b\x04\xb6\xb6\x84\x18\x10]\xdd\x11I\x85\xa2\x8f\x91\x18#m\xe6h\xacgX\bVz9\xb3\xa5\x85\xc8v\b\xe1\xefNf\xe1{\x80p\xcb\x00\x92\x88\xb9\xa8\xa3\x8f8\xe7Y\x1dd\x04\x1f\xc8\x15\x80D\bh}\xa0_\x98\xb4\xb7\x13ѽ\x8c\xbe\xa6\xdb\x1e \xf8@\x91+F\x85\xa1\x8d\x12c\fF)\x94\x84ٲ\xc6\xf9@\xa6\x15\xa7\xb6\xd6X\x1b\xf7A\xaa\x04\x84\x14ie\b\x81\x102m3\xbe\xeb\x18\x1a\x91R\xd9\x10\x89!\x80\x0f\b)A\x1b\x14\x91\xe0\x03RBV\x16()\xf1\xce!\xa5 \x84H\xbfWbm\xc4\xfa\xb4\xcd\xe6F\xa7\x95\x13#\xe7\xd6\xc7\\ڛ\x1fq]\xef\u007fj\xe6\xc2c\xeb%\x9f߫n\r@\"x\xe0\x8b1\xf2\xf0r\xd1R\x17\xe9\xc6j\x11\xe9k\x81떽\x91\xa9a$dʦr%\x11\xdd\x16\xb2^jFE\xdar\xe6m\xc0\xb5\r\xfb\x8de\xd18\xac\v\x8c\x87%\x0f\xdcv\x8a\xdbNm#\xa5B\x1a\x83\x90\n!$R*\x84\xd6\x1d \x80\xd6\x04\xef\x89\xce\"\x94$\x8a\x80\xf0\x92(\x120B%PDl\x111\x82\x92\xc4L\x1f\u007f\x1a\x1f\x14.D\x06\xfd\x02\x1bRe\x9f\x19\x83ъ\xd6y\xb6G}\x06\xa5\xe1`ڀ\x10\xfd\xc7\xd6˻?\xbfW\xbdt\xcbĐ+s\x17O\x0f\xcd\xd5\x18\xf9s\xde\xc5º\xc0ae\xe9\x88[\xaa\xd63\xaf\x1d\xb3\xc6S\xb5\x8e\xc6\x05\x04)x\xba\x10\xf1!\t\x17\x96m`ֆ\xe3\x1e\x87\x14\xb06\x1e\xf2#\xdf\xfa \u007f\xe9\xfb\x9f\xe0\xf1\xfbog\xbc2H\xac\xaf\xd6\xc7\\\x93\xec\x82z\xd7\n\x04)\x11] \x87ąq\x14\x0fB\x12@\b\xf9F靤)$\x10\x83 \x1eeh!\x89)\xaa\xba\x01\x04\xad\xebHO\xc0E\xb8\xba?\xa7\xc841\x84\xd3W*\xff\x8f\xff܇r\x9ey\xed\x9bW(\xcao,i\x96\x17\x05\xe2\xa5\xd8\xed\xb9\x84Ȳ\xf1\x1cV\x8e\xda\a\xda\x10\xf1@\xbf_P\xf6r\x82HEc eZ\xd7fM\xeaw\x8b\xc8\xcdeK\xae\x04\x99\x94\xf4\x94\xe1\x9d\xe7N\xd1+2\xbcL[\x92\x90\xf28\x90\v%A)\xd0\n2\x9d\xbe\x8c\x02\xa3!ϐE\x91\xb2\xb0\xcc \xb5\x02)\xd2\xf5\t@\xa6\x9f\x13\x88t\xdf'\xd6W\t\x90R\x90\x1b\x8d\x94\n$dZ#;J\xe5\xcc\xea\x90\xcch|\x8c\x14\xbd\xec\a~\xe2\xfd\xe7F\xff\xe4\xf7\x1b>\xfc\xae\x9d[\x03\x10!c%\x14\x15\x02\xda֧\x1b\x17#\xca{\x94\x8f\xf4\xb4\xa6\xd0\n\xe1=\xb1\xf5̗-7\x0ekv\x0fk\x0e\xe6-\xad\vؐ*y\xeb#\x93\xa5'Fͷ\xbd\xebN6W\x06Ď}\x8d1v\x01\\\x80\x14Hm\x10ZC\x9eC\x9e% \x8cJ\xbdY%A+D\x96!\x94N\xa0\xe4\x06!\x05\x1d\xaf\xfe\xc6\xc6  &V7-\x1a\x95\xd2c%%E\x96u4Ob\x16\x00d\x84Q\x99㬧\xaa\xac\xber}\xf6\x93\x00\xff\xfb\xd3W\xdf\xfe\x18\x02\xf0\xb9+\xcb\xe9\xe3;\xfd?\x8c\x91\x0fx\x17\x84m<2\xd3X!\x10>PW\x89\x17Zt\x1f\\\n\x92\x80\xa1#\x88\x88p\xb0\xb4L\xa4d5˹kg\x9d\x1f\xfc\xc0C\x9c\xd9\x18!UZ\x19)\x8f\xe9\xbay!\xd1\xf4B\xe9\xb4:\xb4\x82\xa3m\xebx+\x8a\x10|ڦ$DO\x02/@\b>\x81\"ez\x16\xbd\xef\xfe[$ұ\x06]\x92\x90\x1b\x8d\x96\x1c\x93\x8e\x89\x01\x86\xb5~\xce\xdetI\b\x01\xa9\xc4\xfb\xe3W~^\x88w\xfe\x97\xf1m_!\x8fo\xf7\x01x\xf0ѳ?\xa9\x8d\xf8Y\xa9\xe5\x8b\xd6y;[6L\x16m*\x12kKU[f\x8dcZ9*\x1b\xa8l\xc0\xf9H\x14\x02)\xd3n?2\x19\xb7m\x8c\xd9_\xd4<\xfd\xeae\x10\x02\xef}\xca\xcebH\x81\xfb\x88:?J)\x8eV\xccQ\f\xc94\xf4J(K\xd0Y\x02\xab\xfbx\x11\x01]3썤$\xa5\xb9Đ\xfa\xf4\xa48\"\xba\xedM)\x81V\xa9?\x93e\x1a)\x12#\xbc\xd2/P\x12\xa4\x92\xf4\x8b\xec\xb3\xdfL0\xbe!@>{}\xc1\a\u038d\xf8\x87\x1fy\xce\u007f\xf6\xca\U000bfaa3\xf8\xb6Ƴ/\xba7\xb5Γ)Ia$J\n\x84H\xf5ʲ\xb2L\x97\rU\xebғ\xe9\x02\x93E\xc5\x1f\x9e\xbf¥\x1b\aܱ\xb3\x81u\x81\x10\x92\n\xe5\xf8*\xc5\x1b\xc1\xfb\x18\x94\x10\xd2j\x902\x01Q|\xd5\x16֭\xa8\xe3/\xbax\x11S\xf0\x8e1\xa6~z\xb7\xa8\x8e\x17W\x88\x1d\x05\x1f\x91J\xa0\x95$7\xa9\x8d,\x10\xe4:\xad\x96a\xbf \xcb\xf4/\x01\xdf\xd4\x18\xf2\rmY\u007fpa\x9aHƭ\xe2\x8e\xc9\xc2\xfe\xc3\xca\xc7\xf5\x9e\x96\x18\x1dRߢ\xf6Iv#\x13e\xe2b\xa4T\x02#\x05\"\n\x84\x17\x84N\x1f\xd2\xcb\r\xef\xb9\xe7,\xb7m\xaf%\xad\x14\x02\xed\x1cJ\xab\xd4\xd9S\xa4\xa7\\(\xa2\xf30\x9b\x83Px\xa9i\x96\xbbTU\x83\x8f\xe9\x0e\xeb\x18QM\x8dF\xa0\x8cFh\x8d4\x86H\xec\x88KO\xf4\x9e\x18\xbejˊG\x05!\xc7\xcd)\x90h\xa5\xc8uJ\u007f\xad\xf38\x1f@\b\x8a\\\u007f\xf1\x1f\u007f\xe1\xf5\v\xb7T\f9.\x12\xdb\xf0\xabZ\xf0\x01-ER\x834iK)3E&\x05\xcb\xc61i\x12W\xa4\xa5`\x94\xeb㭟((\xb4\xe1\xf4\xfa\x88G\xee9\x93\xf4T\x02\b\x12k-JK\x9cm\xd1E\x06F\x11\xbcc>\x9d\xf1ܳ/\xf3\x95\x97_go\xbe`\xd9\x1c\xad:\x8b\xf5)\x05͍a{<\xe4\xde3\x9b\xbc\xe7\x9eslmo\xa4\xd8\x13:0bJq\xf9\xaa\r'\x04\bB`\xb4\xa6\xc8rZg\x01Mf<\xc6h\xb4\xf3G-\x12\xf2L\xff\xec\x9b\xc1e}݀\xdcVh^\xab]\xf7A\xe2;\n-(H\xd5b\x13%\xdf\xfe\xce\xdb\xf9\x9e\x87\xefB\v\xf8\xf5O?ͧ^\xb8\x9a\x1aNA :\r\x95\x96\x02\xefa}P\xf0\xc0\xc9\rN\xad\x8c\xb0\xcew\xfd퀵 D\v!e[\xdeZ.\\\xb9\xc1\x97\x9f~\x91\xe9\xb2fu\xd8\xe3\xee\x93\xe7\x90\xdap\xb0X\xf2\xea\xd5]\xae\x1f̘,+\xaa\xa6\xe5\xe9\u05ee\xf0\xc5\xf3\x97x\xea\xfce~\xe2\xdb\x1feeu@\x96\x17H!\x8fe\xa6\xb1{\x00B\x8cD\xd1\t\xb2\xb3\f\xa5\x15ZK\xe6\xcb%U\xddv}}\x95VH\xaat\x9e\xbd\xa5\x00\xc9u\n\x90w\xf4̿\x13\xa2苘T\x81\v\x1f\xf9\xf0{\xee枝\x15\xf6\x0e&\xdcw\xfa\x04\u007f\xe9{\xdeǰ\xf7e>\xf2\x85\xf3)\xc3\x12\xb0\xa8[\xd6\xfb%\xe3A\xc6\x03;\xebܶ6\xa4Z\xd6h\xa3\xde\bo\"ж\x8e\x18\x03\xf1\xd0\xe1C`\xbe\u007f\xc8\xc3w\x9feum\x8c)r\xb2\xa2 \xcb\x13\x83\xfbʅ\xab\xbc\xf4\xf2%n\x1eL\x98\xd7\x15\xf3\xba\xe1\xfa\xc1\x8cW\xaf\xef\xf1ԫ\x97y\\\x9f%X\x8fV&qW\xddV\x95z\xe9\x82,\xd7dyNQ\xe6Dgi놛\xbb\xfb\x1c\x1c.PR e\x92\x1ei%\x89\xb0\xbc\xa5\x00yan\xb9\xbboND\xc4O)IF\x8c\xd8\x00\xb7o\xaer߉\x15\xfe\xf0\x95\x8bH!y\xfe\xd2u\xbe\xfd\xc1{\xf8\x91\xc7\xdf\xc1\v\x97\xf7\xb9\xb0;\xc5\x14\x06[Y\xa6U\xcb\xf6ʀs\xebcL\xa6ѹ\xee\x04\x86]c)\n\x82L{\xbb\x8b\x02\x9d)N\x9d\xd8H<T\xa6\x88H&\xb3\x05\a\x97\xe7,\x1b\xcb|\xbed\xd16I\xdf\x1bA\n\xc9ɵ\x11\xc3^\xce\u07bcb\xd1Xr\x04B:d\x97qu\xe1\x1e\xad4\x8d\xady\xe9\xd2\r\xae\xec\xee3oR\xd1\xda4-+\xb9!v\xa9pk\x1dJK\x84\xe0\xc3\xc0\xcf}\xf8];\xb7N\f\xb1A<\\*\x1e#\xa6t\xd1\xfb\xc0\xed\x9b+̺\xee[n\x04k\x83>_z\xf9u\x9ex\xe7\x9d\xfc\xe8\a\x1e\xe0g~\xeb\xb3X\xeb\x81H\x99)6z%Z+ze\x1a\xc0\x91\x02\xb4R\x89\xe6\bi\x0e\xe4h\xcf\xf7ާ\xbe{\xdd2Y\xd6\\\xb8\xb6\xc73\xe7/\xf1ʵ\x9bT\xb5%\x8a\xb4\xad\x18\x9d\b\xca2\xcf\xd8Z\x192,\n\xbc\xf7ܘ\xcc9\xb1\xb1\x8a\x8c)1KTI\x12?\xecMf\xfc\x1f\x9f{\x8e\x17\xaf\xee\x12|<f\xa8E\x174\x04pju\x84\x8bФ\f\xf1\xa7\u007f\xe4\xa1S\xff\xe07\xbe|\xd9\xde\x12+\xe4d\xae\x85\x8f\xf1\xfeDk\xa4\x94\xd2\xf9\xc8ڠ\xa0\xb2\x16-\x15;\xe3![\xc3\x01\x8ds|\xf9\x95K\xdcwf\x9b۷\x87L\x975\xc3LQf\x86\x8da\x0f%\x15Yf\xd0R\x90\x17\x19e\xafL\x04\xa1\x0fX\xebh\x1b\x8bm\x1dJI\xda\xd6\xf2\xd2\xd5\x1b|\xfa\xb9\xf3\xbcp\xe9&\xd3&\xa5\xcf֧\xde\vB\x90+ɩ\xb5\x01\xa3\x9e\xc7h\xcd갤\xb2\x0e\xeb=Uے)M\x14\xa4\xd4ڧ\xcc\xec\xc5˻\xecϖ\xe4F\"\x8dD+\x8d\x92\x92\\\xebԗi\x1a^\xb9\xbe\x87\xad*|\x8cԍ]\xed+\xf9\xbd\xc0?\xbb5\xd8ވ\x8a\xf0x\x88$\x1dm\x97\xb0\b\"\x8du\x8c{9[\xc3!\xce{B\b\xecN\xe7ܜ\xf4\xb8}kĥ}AhSϢ0\x1a\xa5\x93x-\xcb$\xb9\xd1\x14\xfd\x1e(\x81\x8c\xe0\x9c#\xb7\x9efY3;\x9c\xb1\xa8\x96\xbczm\x97\xab{3T\xd1\xe7\xb1\xdb7\xf8\xf4\xb3/\xd3\xcf3v\xd6JVVVy\xf6\xc2\x15\x9e\xbbr\xc0\x9d[c\xb4\x92\f{9EOS\x18\rQ`\x83\xefDۑ\xd6:\xf6'\v\xbe\xf8\xca%\xf6\xe6sBH|V_&\xf1\xf7J\xafG\x8c0,3t\x8c\xbc6[\"u\x12x\x8f\a\xbdO\xdc2l\xef(I\x03?,\x04\xefҀT\x82\xd6\xc3\xd9\xf5\x11\xbdLa\x94d\xb5\xdf\xc3yϼj\xb8\xb0w@\xae%\xfd\"\xa3\xb2\x16\xdb&\x85\xfa\xe6\xb0dke@\xbf\x97\x93e\x06\x93\x9b\xaeW\xa1Й\xa6\x1c\x8f\xe8\xaf\f\x19n\xacQ\x16\x86h\x1d\x9b\xe3!gNlpew\x8f\x83\xc9\x04\xa3\x14O<t\x0fgvN\xf17\xfe\xb3\xbf\xc8{ￓ\x83\xe9\x8c\xf3W\xae\xb31\xeaqbu\xc4ư\xc7\xd6x@\x96g(\xa5\xd3ꈁ\xc9l\xc9\xef~\xf9%\x9e\xb9x\x95\xfdyòi;\xee,b\xbb\xb6\xae\x14\xe0\x89\xccg\v\xa6\xcb\x06'!Sz\xff7\x9f\xba\xf2\xdf~\xf8];<\u007fc\xfe\xf6\x032H\xbc\xc7\xedR\xf0]B\b\xa1\x95\xa0\t\x91\xd3+\x03V\xfay'\t5L\x97K\x96m\xcbk\xfb\x13\x06E\xc6m\x9b\xab\\ܛ`\xad'7\x1a\x17`gu\xc0갇6:}\x15\xa9\xc3'\xa4\"땘A\x9fl<b\xb0\xb5\xce`\xd4'\x17\xb0\u007f\xb8`P\x16ܘ\xce1Zp}o\xcaw\u007f\xe0\x03\xf4ʂ,\xcb\t\xb6\xe6\xc6\xeeM\xcem\x8e\xd9\x19\x0f\xd8Y\x1bs\xf6\xf46\xfd\xf1\nG\x8a\xf8\xb6i\xf9\xe2˯\xf3\xf1\xa7^\xe6ƴf\xde$\xcd\xd7\xe1\xb2\xe1`\x91\xc0\xb1\xc1\xd18Gն\xb8e\xc3ac\x11J\xb16\xea\u007f\xe9\xf9\xeb\xb3\u007f\xf4\xcd\x04\xe3\x1bڲ\xaeZ\x17v2\xfd1\x1b\xf8\xcb\x12\xce\xe94\xacĢ\xb5\x18\xd5\xcdy8Km\x1d\xb3\xaaa\x90\xe7\xac\xf7\xfa\xe4ZS\x1a\x83-\x92<gTd\xf4\xb4IOe\x9a\xae!X\x97X^\x1f\b> \xba^\bFQ\x9e=\xc5vYrG\xd5\x10\x05\xe4\xb9\xe6\xb9\vW\x98-\x1a~\xf3_|\f\x1fA\v(2\xcd='\xd68\xb7\xb9¹\xedU\x1e\xb8\xefN\x86\xe3!\xb6\xf5\x10\x03Z\x19.\xde\xd8\xe3\xd3ϾƵ\xc9\x12\x17\"\xbdL\xb3:\x1ap8\x9dq\xe7\xce&\x9b\xeb\x1b|\xeeٗ\xe8\x175\xa7\x06%E\b\xb8\x18Q\xa9\x92\xff\xefo\xa9\xb4\xb7c\x93\xae\x01\xe7k\x1f\xcf\x05\x92\xd4\xe7p\xd9P\xe6\x86Y\xd5\xe0\xd2P%\x11\xd8\x18\xf6ɴ\xc6ZO\x99\x19T\x84ymi}\xd2\xdcF \xca\x14\x8f\xbc\r\xa8,\x10D 8O\xf4\x81\x18\x03B\xa5j\xddl\xads\ue07b\xd0\xc60\xbay\xc0\xed\xa7\xb7YT5\x8b\xaa\xed2\xb6\x8c\xd0X\xaa\xaaJ+\xe3\xf6S\x8cV\xc7x\x1fȄ&\x04\x87V\x92\xe7.^\xe3\xfa\xc1\x94\x95\xc2\xf0нw\xf2\xca\xc5Kh#Y9\xb1\xc2\x13\x8f>\xc4\x0f}\xe7w\xf0\a_z\x92_\xfc'\xbf\x954\xa8G\x9c\x18\xf1Rk\xed\xc7n9@\x94\x92\x15>4B\x80\xf5\xa9\xa3v}\xba\xa00\x9a\xe9\xb2F)\x85\xecd\xa4\xae\xdb&j\xeb\xd9\x19\x0fY,j\xeav\xca8\xcfq\xce\x11|jp\xc5\x18\x89\xc1\x11\x9cF\xcaNc\xe5l\xaa:\x8f梌\xa1\xb7\xb1\xce)!@)\xf6\x0e'\x94e\xce\t\xadRl\xa8Zdn\x18\x9f\xdadm{\x8b\xbcH\x85cj\xe5f\xe8,g9\x99\xf1\xf2\xe5\x9bdR0\xcc\x157\xae^\x83\xe0i\x1b\xcf\x03\xb7\xdd\xcb\xfb\x1e|\x17Ͻ\xf0<O?\xf5E\xee\x1a\x97\xa9xtI\x10a\xb4\xfe\x15\xad\x95\xbf\xe5\x00)D\xdcl\x04\xb7\xe52\xe2\x11\xd8\x00{\xf3\x96i\xd5\x1c\xa7\x8b㲤0\x86Ek\t]\xc1\xa7\x10\x88\x00\xabE\xc9\xf6\xa8G\xe3<\xad\xb3\xf8\x90w\xa3\x03\x1e\xe5=\x91\xd4'wu\x8b\xcc[tk\xba\xc1M@I\xf2\xf1\x903；\xcd\xc3)\a{\ax\xeb\b֑\xaf\xae\x90\x97\x05&7I֓(\xb3D\xabKA1\xe819\x98\xd0Xˠ\xc8ɤ\xa0m-9\xa9\xb5\xfc\xea\xf9\xf3\xfc\xbd_\xfc%Z\xe7Ȕ!W\n\x81@v\xf3\x8fY\xa6\xff\xcf\xf7?|[\xfcg_\xf9\xe6ϴ\u007fCb끒\x0fg\x92\xbf֓H\rx!\xf01\xa5\xbe\xf7\xec\xac\xd1X\x97\xd8Rc(\xb3\x8c\xd2\x18\xea\xd61\x9b\xa7т\xb5^Nf$\xa6㉲L\xa3\xb4:\xee=H\xa5җ\x94 \xd3\xcfB\xa4\x821\x86\x00Q\xa0\xb4&\xef\x15\f\xc7#\x06\xfd\x92\xfe`@\x96'\x9d\x151\x1ck\xb6\xbcK\xb1C\xe9\f\x95\xe7\xd4ˊ\xa7^x\x8d\x18#e')\xd2J\x91iI\f\x91\x18\x05\x851\xe4J\xb36\xea\xa54\xd9Zj!\x9e\xafC\xfc\x85_\xfe\xfd\x97'\xb7\xcc\nyx5c\xafFj\xc2\xe3\x19A\xf5\x06%m\xdb⚀\x17\x92g/\xed\xf1Ľ\xa7\x92\xf5\x85s()\x11\"\xa9M\\\xf0,\xeb\x962\xd7I_\x1d\x92_ɲn\xd03\x89\xea\x069\x9dUH\x13\x90\xce\xe3\xbdC4\rV\xc9\xe3\v\x16R\xa4\xc6S\xec\xfa\x1d1\x10\xa5DH\xd9\xd1걋;\x1a\xe7,\xc1\xda\xc4\xf6JID\x90\x979\xa76VX.j\xb4V\x94yJ,B\xd7\x13\x91BPd\x9aQ\xbfH\x8c\xb6\x8f\xd4\xc0j\x9e\u007f\xfeS\x95\xbb\xfcf\x89\xad\xbf.@\xbet\xd0r\xef\xc0\x18\x02\u007f\xde\x1cM\x1b\xb9H&\xa0\x11\x91\xa5\x8d\xbcpu\x9f\xfbOn \xbbjW\x88\xa4\x04q.\x1e\xa7\x9dB\xa6^\xba\vi\xde|Y7dz\x99\xe4\xa0R\xa13\x87\xf7\n\xef\x1c\xc2{D\xdd\x12\x11\xa8L'\xc6+\xa4\xb8\xe3\xbdO\xc1?xp\x1e\xa95\xbeM\x02=o\x1bB7n-\xe3\x91\xc21i\xc0N\xae\xaf\xf0\xfc\xf9\xcbdJR\xe69\xe3aٵq\x05RJ\xca\xc2\xd04\x8e\xc9\"]\x93\x84f\xac\xf8\xf8\x85W\xf6\xdc-\x05\b@\xa1\xb8\xc7E\x1e\b>b\xadGiI\xbf04Ӗ\xca\a\x9e\xbd\xbc\xc7\xfd\xa7\xd6\xe9\xe7ٱH`Q7\xd4\xd6\xe2B@\x88\xf44\xfa\x18q!\xe0l\xc0\x99\xc0\xb2i\xd0Z\xa7\x9b\x96i\xa4RxmQ\xda\xe0\x8fbAH\\Xt!\xfd\xec\xbbL,\x04|ӦD &\xb1\xf51\xf8\b\xa4\xc9QeR\xa4Hc8\xb3\xb3\x89\x91\x92(\xa0Wdl\x8d\x87\x14eA\xec\xa6@\xdbֲX&9\x90\x12 cl\xfa\xde\u007f\x11\xe0\xdf\u007f\xfc\x14\xff\xebg\xbf\xf9\v\xe5\xebn\xe1\x86\xc0;\x05\x90\x17\x86,ׄ\x90ڬ\x85\x82\x18\xe0\xd2\xc1\x92\x17\xae\xec\x13b \x10\xb1\xde1[6<w\xf9\x80\xda\xfac\rT\x8c\xe18\x90'\xee\xcaS7\rmki\xaa\x16g-\xce:\\k\xf1\xadŷ\r\xaenp\xad\xc3\xdb\x16\xb7\\b\xeb\x1a״ت\xa6\x9d/if\vܲ\xc6\xd6-\x8b\x83Ej\xc1K\x85*K\xf4x\x84Y[E\xf5z\x9c\xd8^cuP\xe2\\\xea\xdfW\xad\xc59\x9b\xb6W\"u\xdbr\xd4\xf9\xad[\x87\x14\u00ad*\xb9\v\xbc)`|\x83YV\xdc,\xca$\\\x9b\xcf\x1a\x8aҰ\x985\x10\x93\x9c\xb4n\x03\x9fx\xe12\x92\xc8ٍ\x11U\xedx\xf9\xea\x84/\xbez\x83\xc7\xef\xdcfcX\xe2c \x84ԗH\xfa\xe0\x80\n\x91\xd6zڦ\xc5h\x8d\xaa4\xca\x18\x9c\xb4\xc4\x10P\xde#\x94=\x169\xa4\xf8\x91$\xa2\xaei\xf0m\x93\xe6\xd0\x1b\x8fҊ\xc1x\x88\x122\x05\xe5\x10\xba-Kc\x06}z\x83\x1eۛ+\\?\x98\xa6)]\xef\x92\xccU\x857\xb4t]\x8f\xbd\xb5\x8e\xbe\xc0c\xc4>o\xe2\xeb\xeb\x06D\bїJ\xd0\xd4\x16HAPH(\xf2\f\xe3\x03\xbbs\xcf\xc1\xc2\xf2\x91'/r\xd7ƀ\xdc\v.\xec/\xd1Rr\xe5`\xcemk\x03\x82\x12\x04%\t>\x10\x94Hӳ!\xe0|r\xfd1֢\x9a\x06]k\x84\x96H\x92\xaaPj݉\xdf8\x8e%\xaem\t\xad%\xf8H\xdb4\xecM\x16\xdcqߝ\xf4Ol\xd1\xee\xeeb\x17\x15\x919j2%\x17\x02\x95\xe7(\x931\x1e\xf6\x11@k\x13\x18>8bTG\x1e(\xb4.]\v\xde\xe3c\xdc\xfb\xe9\xcf_\xafn)@\x1e]/\xf8\xc2^\x8d\x80{\x04\x02c\x14y\xa1q\xd6'~\xaaH~T\xa5\xb4T1\rp\xbex}N\xe6!\nA\xbfT4.\x05\xf0~^\x12|Z\x19>\b\xac\v\x98Nmb\x9d\xc7Z\x87V\x8e\xb6j\x92t\xb4\x90H\xd2\x1c\x88\U000324caz\xbcu)v\x84\x88w\x96jYq0\x9d\xd3\xccg\xb8\v-{\xbb{\xec\x1d\xcc\xf112|\xfd\nk\x1b\xeb\xe4\xb9ars\x1f\xa34Efh\x9d;6\xbci\x84\xc59Ǣn\t]VhB`\xd2؏\xf2&\xbf\xfe\u007f\x01\xf2\xc0\xc8\xf0\x85\xbd\x9aG֊\xefVJ\xfcGJK\xea\xda\xd2\xcfrb\x10H\xe1\xa8\x16m\xaaۄ@\xc445%\x118\x11\xba\xa1\x9aHn$\xb3\xaa\xe1\xc40'\x06\x8f\x0f\x92$T\x8f\t\x14\x95\\zR\xb2\xe0P\xce\"\xeb\n!\x05Z\x1b\x10\x1e)\xbb\xf8c\x1d\xae\xb1x\xdb&\xdať\x80\xbf?Y\xf0\xf4\xb3\xaf\x11bd\u007f\xba\xe0\xea\xeeA\x12>\xac\xafp\xe9\xe2u\xb4J\xf6\x1dU\xdd\xd2+s\xa6\xb3%Uk\xc9s\x8d\x90\x92\xaauTuK\xd3:\x96U\x03\xdesq\xde\xfe&\xc0\u007fx\xf7\n\xbf\xfaқ\xe3\xdf\xf8\xc7.\f?pjH\x88A\xed\x14\xfa\xbf\xce2\xf5+BJfӊ\xa2\xccR㨶\x84\x98\x94~1DlL\x19Q\x1a\x82\x11\xc7C\x83\x12\xc9\xf6JN\b\xb0\xdd\xcfP\xaa\x1b+\x90\x12\xa5R\xba\xa9\xbab0\xf5\xb1\x93\xb8Z\x8b\xae`\xe3\xd8\x05\x83\xe0<\xbeui\xbbr6\t\x17\xba\xad\xc6\xf9\xc0\x85\xeb\xfb\xecM\x97<\xfd\xd2E\xae\xef\x1dr\xe9\xda.\xc6hB\x84ɼ\xe2\xfc\xa5\x1b\xdcܟ\xb0\xac\x9b4\x98\xa35y7\x8d5[\xd4,\xeb\x86em\xb1˚\xddE\xdd\xfeε\xe6/\x00<\xb9_\xbfi+\xe4\x8f\x05\xc8\x13gG\xfc\xc1\xa5\x19w\xac\x16?\xa6\xb4\xfc\x1fl멫6\xc9ar\x93RV\x17\xe8\x95\x19\x83AβjpHF=\x93:r!\x15qQD\xb4\x14\xec\xac\x15\xb4>\xb2Rd\xf4\x8d\xecT\x8c\x12-\x8f\x00Ir!)\x92\xdaDt\xc3\xfct,\x80 5̓\xf3\x04\xef\x88\xdeu\x99T\x9a\xa8\xd2Z\xb12\x1a\xd0\xef\x17L\x175\x93\xf9\x12!\x15\xfd^\x81\xf7\x81\xe9rɵ\x9b\x87\\\xbe\xb6G\xd5X\x9aƽal\x06\xb4\xceS\xb7-Uc\x99W5\xbei\xb8\xb6\xb0?\xf7\xda\xd2\xff\xee\xbbƆ\x1bo\xe2\xac\xfa\x1fk\xcb\xfa\xe4\xc5)O\x9c\x1d}k\x8c\xfcj\xb3li\xdb\xe4I\"\xa5L\xb2P\x1f({\x19\x83^F\xb2\v\x11(\x12\r\x9eIA\xd5=ע\x93o\x86\x18\xe9皫\x8b\x96\xb5R#\xbb@\xee\xbcDy\x8f\xf5\x8a\xcc{\x9cW8\x1bP*\xd0J\x97\xd3~\xf5\x1a\x00\x00\x1e>IDAT\xc6\xd5D\x92\x11)\x1f\xbbI\xea\xae\xe8\xd3\x12!\x93R]\x9aD\xb9\f\xd7\xd78yb\x8bEcy楋x癲L\xf5J'x\xf3\xcew\xd3X\x12k=\xad\xf2\b\x1c\x8b\xaa\xa5j[\x82\xf5T\xad\xbf\x02\xf1\xef\x01<=\xb1\xb7L\f\xf9\xcf!\xaa#\xe7\x1e\x80\xb2\x97\xa5j\xb8{`\xac\x0f,\x16\r\x16X)\x14\xa3\x95\x92\xb0\xbfd\xde\xfa\xb4\x14\xa38\xd6Ўz\x86+\a5\x13\xdbcMz\x82\x97X\x171:\xa6\x04AIT\xf0X\xef\xd0N\xe2\xa4@)\x85\x97\x02\xe5\x12\r#\xa5\xecVF* \x95\x96H\x9d\x8a>\xa9\xd3@\xcfh\xd0\xe3\xfb>\xf4\x18u\xdbr\xf5\xc6\x01\x93i\x9aM\x97R`D\x9a\x9c\xd2]W\xf0H\xf1b\xad\xc7:\x87\v\x11\xe9\x1c\xd3\xc6\xfeⳇ\xed\rނ\xd7\xd7ܲ~\xecD\xc6hm\xc0듆\xadL<\x1a\xa3x\"\xc4dڒtL\x86\xa6N\xbd\xf1^i\x18\x0f\n\xf6g\r\xc2\a\x86\xa3\x82\b,g5m\x14\xe4A\x10D\x1a7\x1b\xf54;\xe3\x1e\x8ds\xd4\x16V\v\x8d\x16\x91 \xbb\xc9Y\x99T\xe8Zu\xdfw\u007f\xafT⪔T\xc7f1\x10\x91Z\x1d\x0f\xf4\xa8\"G\x979\"K5\x92Њ^\xbf$\x17\x82\xc9dΩ\xad5\xea\xa6\xf9\xaa\x16\x82B\x9b\xa4\xd75Ju\xe6f\x1e\x17\"\xa6\xb5\xecΫ\v/N\x9a\x9f\xbcPǽ\xb7\x02\x90\xafY\xa9\xff\xda\xca)>uq\xc2w\u07b5\xfa\x0e׆\x1f\\\xce\x1b\x9cK\xcbA\xca#\x065\x05r\xa5\x04\x93E\x93\x1c\xe2\x04\xe4\xa5\xc1\xb5\x1e\xd7\t\x99\x8dL7\xcf\xc7H۽ǉq\xc9\u07b2b\xb7\xee\fǜ\xa5\xed|\x10\xad\xf74\xce\xe3\\\x1a\fu>\xa4\xbeɱ\x80\xba\x9bA\x97\x12i4\xc2hT/G\xe6:i\xec\x00\xa9%\xa6\xc8P\x99\xa1Wd\x9c\xd8\x18s\xc7\xc95V\x86\x05e\xa6\x93\\H\xa5\x8cP)\x8d\x94\x82\xd6\xfa\xe4\x8b\xe2\x1c\x8b\xaa\xe6\xfc\xbc\xfd\xc8\xf3\x8b\xf02o\xd1\xebkoYϿ\n@\xdb\xfa\xbfj2\xf5\x0e\xe1Dg6\x96\xbcJ\xea\xda\x12EĘ\x94\xb6\xb6\xadCE\xe8\xf5r\x84\x148\xe7i\x82`\x9c+\x8aB3?l\b1\xd9\xeb\xb5\xce3,3\xc6}Ņ\xc3\x05\xa5\x1e\xb2&\x05\xdeZ\xac\x12(\xe5qV\xe2\x94\xc4{G\b\n\xe7$Z\aB\xec\xe4\x9cJq\xf9\xe6\x1e\xd7.]c}eD\xd1\xef#\xb4J\xd4<\x02k\x1d>&\x9a\xde\xd6\x15gw\xd6ȴ\xe2\xfe;Os\xfe\xe2u\xae\xefͰޥ9 \xc0\xdaā\x05\xeb\xa0myzw鲁\xfe\x05\x0e,\x0f\xae\x18\x9e:\xb4o3 \xc0\x13g\xc7\xc3\xe0\xfd\x03&\x93Bi\xc9r\xd1b\x8cF\xaaT)K)\xe9\x97\x19En\x8eg\xf1\xf2\xc2\x10\\\xa0\xb5\x1e)\x04\x9bゥ\x80(\x1bD\x80Ƨ-A\xa94n|\xb1Z\xf0\xfa\xac\xc1\xa8\x92\xbe\fX\x1b\xd0J`e@\xb9\x80R\x1e\xe3}W\xd5'Y\x11DD\x88\x94y\xce\xe6\xce\t\xae^\xbc\xcc\xc5O~\x89Ų\xc6:\xdf\xdd\xe4$\xff$\xa6\xa2t{{\x15\xd7Zv\xf7\xe68\xe7(2\x03\x99\xc6!\xf0\xa4\xf7\r1\xa0\x9d噽\x05\xba0\x9f\xfc\xec\xa5\xe5\v\xef\xed\xc3\xe7\xde\x020\xfeX\x804\x8d\xfd^\x01\xdf\"\x95\xa4\xaal\xe7Y\x95\xfa\x03\xc1\a\x06eN\x99\x19n\x1e.X\xcc\x1b\xb2\\\xa3\xb4\xa0i,\xd6w~'\xab%\xed\xa4!F\x81\x91\x81ƦΡ\x10\x922ӌJ\xcd\xd5\xe9\x9cBKN(EOz\xa4\x15\xc8\xeeO\xa3%\xcey\xb46\xf8\x10:R2\xc9@\xd7\xc7\x03\xb6\xd6W9\xb9\xb1\u009d'Op\xe5\xd2u^\xbd|\x8d\xc3E\xc5rY\xb3\\\xd6dQP(ɥW\xaf\xe1C\xc0\x91\x1c\x87Dc\x93\xd1A\x9e\xd1d\x1a\x8f \x8f\x9e\x9b\xb3\x9ay\x80\x95\xd5\xf2\u007f\xe2\xda\x12\xb6\xc7p~\xf2\xf6\x02\xf2\xe8v\t>\x16\xc0_i\x1a'\x83O\xfdn\xa9\xd2\x00d[\xd9d\x95\x17\"\xcb\xd6Rw\xfeVEi\x12\x05\xd1\x19Q\x96\xa5NSi!B\x80~\xa1i|Ro\xc4\x10\xd0Z\xb2\xd2\xcb؟\xb7\x9cߛ\xa0\xa5dkTv\x85a@JO\xab=\x99\xf1\xe41\x1c\x8b&\xe8bz\xf0\x81\x18Z\x9c\x0f\xec\xd6\x15MU\xf1\xd0\xd66\xb2WB?g\u007f\xb9\xe4ŋW8\xff\xda\x15\x1a\xef\x18\xf4K6\xfa=z\x99\xc2\x05G\xed\x02WkG\xeb\x02\n\b\xa1\xe5\xa5Ê\xfe\xa0D#>\x03\xf0\xb9\xb7\b\x8c\xaf\t\xc8\x17\xaeW<\xb6\xd9\x1b\x12\xf9`\x8c\x91\xde \xa3\xaa\xda\xd4I\xcb\r\x8beC\x14)\x85]V-\xce\x06ʞ\xa1\xd7ϰ6\xb9\xba)\"\xa3aάn\x93\x9c'DNo\x8ex\xfeڴ\xe3\xaf\"F%㚍a\xce\xcb7f\xbc|\xf3\x00!\"\x9b\xa4f\x91P\x02\xd5:2\xa3\xbb\xe1̣\x80\x9e\xd8^!\x93\xf0\xd6\xe4\x9a\xc1x\xc0\x85\x1b\xfb\\\xba|\x99\xdb\xfbCJS\xb01\x1ar\xfa\xae\xbb\xf9\xce\xfb\xee\xc5\xfb@]\xd7\xecM\xa7\xdc88\xa0\xb2\x92\xeb\xceRǤ\xb0\x1f\x88\xc0\x93{\vfֳb\x14\xbdQ6\xe5-~}\xcd-\xcb{\x9f\x05\xc1\xf1$lp\x11\xa1R\x11G\x00m\x04\xc3^\xc6\xfe$u\xd4\xfa\x83β\xa2M\xfd\x8e\"7\xac\x8eJ&ˆ\x18\x03\xe3\xa8\x19\x14\x19RF\x9a΄Ҙ\x88V\x92~\xa9\xd9\x1c\xe4\\\x9dԜߝҍ\xff%\xf1\xb5\x14ԍM\xabNk\\p\xa9ga̱\xb5S\b\x81\xad\xb5U\xea\xd3-/\x86\xc8\xd37\x0f\x18/\x96\xf4\x0e\x0f(\xb4BK\x89\x8b\x81\xdaY*\x17\x988\xcf\xe5\xdaRw\x99[!\xe0\xfc\xa4\xe2ʲ%\xef\f\x99\xa3\x8d\x1f\x02~\xebϼs\x93\x8f~\xe5\xe6-\x00\x88\xf3s!D\xa5\xb4*\x8f\xe6\xf1B\xe0\xb8\x1e0Za\xbd\xc7\xfb@\u007fP\x90\xc8Ɩ\xb6\xb5\xc4\x00\xfd\x81A\n\xc1\xa0\xc8h\x16\x0e\x13\x04ZH2\x95\xc8;\x1b\x02yHR!#\x15\xab\x83\x8c\xday\xf6\x96u\xf2\x1d\x11ݸ@\xe7\xee\xb3lZ\xf2<ö\x9e\xb6\xb5\xf4\xfb\xbd4\x95\x1b\x03\x02\x85Q\x8a\xb3'7i\x9d%\u05caK\xd7\x0fx}\xba\xc0;\v>\r\x90*)\xb1\x02\x16\xee\x8d&\x99\x8c\x91IcyeZ\xd1ӆ`=\a\a\v\xd6V{?\a\xfc\xd6[\x05\xc6\xd7,\f\x1f\xd9\xca\t6\x12C|o\b\xf1\x9e\xd8\r`\xc6\x181FA7\xe2\xec;Vv<*\x891\xd246\x05|\x17)\xfbY'\xa4VԵ%L\"\x1b\x1b=v\x97\v2#\x19\x96\x86\xd2$дNSM\x02\x81\r\x81Y\xe3\x98T-e'\xc1Q\"q]Y\xa6ȴFHA\xd9+1y\x8e\xd4:\xa9ճ\x8c<\xcf\x18\x0fz\xe4Z\xb3:\xea\x91\xf7r\xa41\xe82\xa3?\xe8Q\fzD\xad\x93B\xc69\\gH\xf3\xd2\xe1\x92\xda&\x1fy\xd7:@\x90ez\xe5\xb1\xdb\xd7>\xf5\xcc\xd5٫o; W\x17\x9eS\xe50\xf8\xd8\x0eb\x88?P\x0e\xb247\x11B\x02$\xa6͢\xad\xd3\x00K\u007f\x90c:\uf436I\x8d\xa2\xf1\xa8dP\x1aB\x8c̦\r˹cuT`\xa3\xc3\xf9@\x99kz:U\xddZ%\xe3\\)\x13\xdf\xd5\xd8@\xed\x1c\aU\x8b\x16\x02-\x12\xed\xa2T\xd2FI@g\x86\xac,Q\x9d\xab\x83\xeaL\x02\xf2\xb2d\xbc:b\xd8/Y\x1f\x0f\x18\xf5K\xc6\xfd\x1e\xbd.#\xb4\xceQ5\x8e\xa6m!\x04&\xb5\xe5\xb5yMf4\xda'o\x16\xa5\x15\xce\x05\xd6Vz\xb3g\xaeN\u007f\xfbm\a\x04\xe0jӰ]\xa8\xab1\xc6\xefRR\x9c\x902\xf9\xdd*\xad\xd2\xc0$\xe9ȇ\xa2g\xc82\x95\x0e\\il\xe7\x89\v\xc3aN\x9e\x99\xe4\f\xb4\xf4Lg-cS\x90\x8dt\xf2D\xcc\r\xa5QiԸ\xa3FBL\xa7 h-\xa8m\xa0q\x9ei\xe7\xf2\x96\xab#\xcd\x14\xe4\x99A\x02y\xbf\x87\xd2i\xcc\xe0\xc8:C\x9a\xb4Z\xcaA\x9f\xf1x\xc8\xd6ꈵQ\x1f%\x04˦aV\xd5,\xaa\x86\xaan\xc8\"|e\u007f\x81\xf5\x9eqY4\xcd\xd2\xea#\x02\x13\x10+\xe3\xd2>{m\xf6\x8fn\t@\x00n4a\xb6\x9d\xcb?\x88\x91\x9f\xc8\v\x9d9\x17\xd0\xe6\x8d\xe3$\x90\xd0\xef\xe7\xc4\x18\xa9+\x9b2\xac6M:\r\x869ZK$\x82\xf9\xa4e\xb9p\fЌN\x94\x1c\xcc*L\x96\x82m/ט#\a\xb8n$YK\x811\x92\xc6%\xdd֬\xb5Lk\x8b\x12\x02ٹ2h%1J\x92\xf5RF\x16C'\r:\x12n\xabdd&\xe3\x1b\xe4\xe1\xe1t\xce\xdeᜃ\xd9\x12\x9c\xe3\xea\xbc\xe6\xf2\xa2\xc6 Y\xed\x17\xff\xbci\xfc}B\xa6\xf1\n\xa2`c}0\u007f\xc7\xc9\xd1o<su\xba\xb8%\n\xc3w\x8d2\x9e\x9e\xb6_yh\xdd<\xe6C|\xfe\xa8\xfb\x1fb\xfa\xf0:\xd7\xe4\x85&F\x91\nǘ\xfc\xb0\xcaR%\x87\xb9\x8e\xdd\x05\x81F2Y\xb6\x9c0#2\xa9i]\xba\xd1\xfdZS\xe6\x06\xa3S6Wf&\r\xf1\xc7H\x18\xc1\xcdI\x8d\xf5\x81ú\xe6\xc9+-Ӧ\x93\x13\xb9T]G`\xb4\xb1\x9a\xe2I\x00\xdf\x06\xbcHq \xf5Q\x02>\n\x96ˊ\xaajX66\x99`z\xc7\xf9\xc9\x12\x8d\xa0_\x18\xa4\xe0\xa3R\xf1g\x8f\xae\xb7;sD\x05BvkpY\xc0\xd3\xd3$\x85\xf9;\xbb\x97_\xf8\x1b'\xce\xfca\x84\xf7\xd8\xd6\x1f\xbb\xeb\b\x99\x8c\xed]7\x97\xe7m\x92\xd4\x14}C\xddZ2)\xe8iI\x13<\x19\x8aet\xd8Y\xe0\xe4j\x8f+\xd7\xe7\xd4Fq\xb0l\x19\x16Yz\xe2\xb5$\xc4@\xdeţ\xc4I\x16ܘ\xd6\xc9\xe1\xc1{^\xbcq\xc8A\xd5pX7X\x9f\nк\xae\x19\xad\xad\xd2\x1b\xf4\x93\xaeKH\x901%\x1dM\xcb\ued5b\\\xbb\xb6\xc7\xf5\x83)\x93y\x8dk[^9Xv\xf5\x12\f\x8b\xfcŶ\xf5\xaf\b\x04\xc9\xd06\x1eYy̌\x117o\x19@\x8e^\u007f\xeb\x8e;\xb6\x94\x92M\xf2OW4\xb5Eiq\\\xb0%\x020\xe0\x9d\xef\xbcu\x03\xce%\x17\x87\x81Jމ}\f\x0e\xb8y~\xc1\xf6==\xee\\\x1dp\xf9\xc02_w\\\x9d.(2Š(\x8e\x92\xee\xa4\xf5\x026z)ú6\xad\xa9\x1b\x0f>rc\xb2d^[\x0e\xeb\x96Yc9=\xafXٛ\xd0\x1b\xf4(\xfb%Y\x96\x81\x90x\xeb\x98\xcf\xe6\xec\xeeOx\xe5\xda\x1e\xaf_?`\xf7pʥɂ\x1b\xb5%'\xcd\x14*)\x9eRF]\xb3\xad\xc3\xd9.$i\x81 6\xbf\xf6\x99K\xf5-\aH\xf4Q\"(\x10\x02g=\x10P*K\xb3ݙ\xa6\xb5\xe9\x00.H\xa7\xd7\xe4\x9dU_\x88P\x87\x984XD\n4\xcb\xd6r\xf8B\xcd\xf8\xfe\x1e\xa73\xc1\xeb\a\r\xfb\xb1E\xef/x\xe0TIn\f|ՙkB\n\xd6\x04dJr\xe5\xb0fѤ\xfe\xfd\xb4ny\xe9\xfa!\xbb\x8b\x8a\xdb\x0eg\x9c[\x1d\xb16\xe8Qv\x89\x04\x02Z\x178XT\\ݛr\xedp\xc6\xcd\xf9\x92\v\x93%\xb3\xd6\xd2\xcb5\xb2\x89\x14Z\xa3\x94\xbc\xe8e\xbc\x92e\x9a\xaa\xb1\x84\xce\tH\b\xf1\xdc-S\xa9\x1f\xf5\xd3?yq\nB\x8a\x18\xc3C\xc6(\xacM\x95\xb2w\x9e\xe5\xa2!D\xcfb\xda\x1e\xa7\x8b\xbe\x8e\x84A\x92\x8a\xa6\x01\xdd4\xaa\xe0\t\f1\x1c\xd22\xb4\x86\xebOM\xd8~p\xccm\x1b\x92k{-7\\M\xa6\x0f\xb8s{%\xa5\xc1\xe8d\x04c#Q)\xfa&rj\xb5\xe4\xeaD0\xafZ\x88\xa9\xff}m\xb2doQ\xf3\xe2\x8dC\xb6\a%\xc3<CvΨ\x8d\xf5,\x9a\x86\xa5u\xdcX6\xecW-\x04(\xbd\xc6\xfb\xe4B*\xd38\xf5\x8d\xdf{q\xf7\xe0\x83\xa7G5\x88B\xbc\xe1\xb9\x11\x01\xfe\xddGN\xf1O\xbfx\xf9\xed\a\xe4\x93\x17\x13\x9d\x13\x83\xff\t!\xd0އ\xe4\xa1\xd8)E\xca2ö\xe9\xe8 ! \xba@\xbb\x8c\xb8\xf5\x80A\xa6yp\x92\xe5\xdf\x00\x8d@0\xc0\xb0\xc4\xd1Cq\xfd+S\xb6\xee\xedsz3c\xff\x9a\xe5\xf5EEppn{\x90\\\x97\x94\xc4\xfb\x94\xd5!$=\x1d8\xb3RpS\v\xf6\x17-Χ\xf1\xdfdI\xbb\xe0`Q'\xbb\xf3n\x81\xf9\x98\x066\x9dO7>\xcf\x14c\x91\xd38\xcb$\xd8D\x01%c\xcd\x19@Ӻ\xa7\xa4\x10\xef\x85t\xecE\x88l\x01o\t\x18\u007flm\xef\x13g\x87\x1f\x04~\xdaِ\x1aT\x1d\xa98\x1e\x17\x94\xa5\xf9*\x1bä\xfbicre\vQ\xa0\xbbZ\xa5v\x01K@#\x18\x92Q\xe3\xf0\x80\t\x92\x1b/.\x98T\x92\xf5s9g\n\xc7\xe5\xf35ϼ|\xc0\xac\xb1\xc7\n\x94L\xa7\xf9\x904\x87.89*8\xb3R\xd2\xcb4Z\b\x8c\x90dB\x13]\xc4\xdaHӂu\x80\x17H/ȅB\x93\x9e\x8e\xad\xb2OոnWL\x04f\x88\xd1t\x80|B)y|^U\xf0a\xf4VnY_\x13\x90\xc76\x8a\x8e.\xe1\xbb\x11\xf4[\xebSc*\x04B\xf04\x8dc\u007fo\xce|R\xa5FP\x80\xe0\x92\xe5R\xd3z\xb4\x16L\xdb@m\x93\xc8Av\xebߠئ\xc7U\xe6\xe4\x182/\xb9\xf9\xf2\x8c\x9bW<rg\xc4\xfdg\x02\xd5M\xcf3OM\xb8\xba_\xa5ɫ\x18;\x0f2y|t\xd1J\xcfpn\xadd\xdcK'\xbd\xa9ΞO\x1e\x99`\x06\xf0\x9d\x122\x86\xa4\xb2\u007f\xf7\xed[\xdc|}\x81\xfbjǿt\xe3o\x1b%\f\x9e<\x1a\xd2\xf21\x10\x05\xd9[\t\xc8\xd7,\f\xaf,\x1d\x0f\xaff'\xa5\x94\xff]\x8cq\xcb\xf9p|^\x94V\ngSF\xe5\xbb\x1e\xb9\xf6\x01\xe7\x92'Uo\xa0(r\xc3\xf6 \xe3p\xdap\xf5\xa0\xa6\x8f\xa1\x8f&G\xa3\x10\fȸȄ\xb3\x8c\x19\x90\xb1;[R\xcf\x02z\xa3\xcf=\xe7$\xc2in^\xb7\xec\ufdddwe2B\x837ܧ\x8d\x14\x8cJ\x93NB\xe0\xc8\xdb2\x19[\x1e\x01w\xd42\xb8\xeb\xd4\n\x83&\xe3\xc5ˇ\x04\r\x98\xe4~\x90\x15\x9a<\xd3\aCů7m\x98eF\xfd\xd54\xa8\x15蕙z\xf8\xdc\xfa\xef>suz\xfdm_!1F\x94V\x1fr>>PU\x16oS!\xd6\xeb笭\xf7\xd3Sg\xd31g\xc2F\x86\x19\xc8\x18\xd1] ϴ\xa4PIax4\xa3\xe1H\x87q\x01\x18\x04\xa7\x19\xf2\x12\a(\x14w\xb2J3q\\zf\xc6s\xe7=\xe339\xefxp\xcc=w\xad\xd2V\x86\xd7^\xaaٻ\x1ah\x9bd\x0fx\xe4\xb9%\x05\xac\x96\x9as\xeb=\xeeX\x1f\xb0\xd2\xcbȍ\xc2\x18\x89\xb4\x9a\xedb\xc4\a\xee=\xc1I\xadx\xf2\x99]\x04\x12\xe1Ӷk:\xcaG*\xf9\xc4\xda\xda\xc0\x04\xe7\xf7\xf3L\u007fy\xd0\xcf\t\x1e&\xd3\xfaT\x8c\xe1{\u007f\xf8\xe1\x1d\xf1\xb6\x02\xf2-'\x86\xbcg\xabgb\x88?DLq F\xe8\xf5s\x86\xe3\x92ż!\xf8@[y\xdaʳ^\b\x9c\x8b\x9c\x1d\tL7\x19\x15#\f\x8c`:\x0f\x98\xee\x97\xd9D\xac'\xefc\x14\x05\x8a\x1dz<\xc3M\xf6\xa8y\x84\x13\f\x1a\xc3\xc1\xeb\x15\xcf~\xe6\x80W__\xb00\x81\xfb\x1eZ廾\xef,\xc3q\xce\xe5\xd7,\x17^\xf1\xdc\xdc\xf5,]gP\xd3m3F\vF\xa5f 3\xf4\x9e\xe6\xe1\xd3\x1b|\xeb\xbbW\xb9\xf1\xd2\r\xfe\xd5\xe7\xa6TmW\xf4\xf9\xa4=VYb\xa3]\b\x03\xa5\xc4\x13\xcfVa\u007f^\xb5\x1f\x19\r\v\x8c\x91\xdd\xc9o\xeeǍ1\xbd\x1fz\xf0\xc4[\xbfe}\xd7m\xab\x9c?\xacy}\xder\xaag\x1e\xf3!\xfe\xbc\xf7\xa9u*\xa5@kES9\x96\a-m\x03\xf8@\x06l\xafH\xf6'\x91\xbbO\xe5\\;\f\x94\xa3\x8c\x13\xab\x05\x99\x96\xec]\t\xccۖ\f\x85A\x92\x93\x02\xac\xeaV\x8dF2&\xe7\"S\x0ehx\x94\x1d\x14\x82\x89\xad\x99\xed5L\xaf\xd4\xec/Z\x0e\x1b\xcb\xedw\x8dy\xf7C\x1b4\x8b\x86\xdd+\x8e\xf9aD\xf6\x04\xa5\xeeF\xe4Z\xa8\xf7%\xa5\x93|\xf0\x83'ȃ\xe7\xc9O\xec\xf3\xfa\rC\xf4\xd0\xd0\xe2\x92kW\xea4\x16i\xe04\nȌ~ߋ7\x16\xff`\xe4\xfdb8*\xfe\xacѺ\xbfX6\x94\xa5\xd9\xd6B<\xfb\x9bO]{\xfao\xfd\xe0\xfd\xfc\xfe\x9bx\xdc\xf7\xff\x03\x90\xf3\x87\xa9(}l\xbd\xf8q\xeb\xc2G\x93ܲ;\x9b\xa3\x8d\xd4sG\xb3\xf0\xa8\x10\x19\x8a乻9V\xd8\x10\xa8*\xb8\xe7L\x8f\u05ee[\xf2\xb1a\xd4\xcfi|\xa4=\x84I]\xa3\x90\xf4IE_\x81:\xf6\xac\xa2\xe3\x8e6(\x98\xd0p\x95\x05g\x19s\x961\x8e\xc0ҷ\xcc\x0ej\x16\xd7\x1a\xae][p}\xbf\xe2\xd4\x1d+\xdcs\uf41b\xafՄ&\xd2_I6\xae\xaf\xbf\xe8\xf8\xb6\xef\xd8a\xd4\xcfx\xe6\xd3{<\xf3B\x85\xb3\x82\x93\x94\x18$\x13j,\x1e\x89@\xbbnڷ'PR\x92)\xb5\xb6i\x84\xfa\xf2\xa4\xfd_Vexl}\xb5\xff\xce\xf9\xb2\xc5zOYd\u007f\xe6\xb6q\xf1\xf3\xff\xf3g.\xba\xf7\xdf9\xe4\xd2A\xfb\xe6oY\x8fn\xe5\xc7߷\xd6\xff\xed#*\x04\"\xae\x8e\xe4Bp\xff\x99\x82\xad,\xb2&\xd3ʐB0\x1eI\x96\xf3Hn\xa0\xeciB\xecγ5\x92k\x97'\xdcu\xb2eU\xe8\xe3\xf8!\x121\xf2G.D\x10\tD\xb6顁\xcfr\x99\x97\xd8\xe7.Vy\x90-N2\xc4z\xcf|\xb7f\xef\x859_\xfa\xbd˼\xf6\xea\fU$\x05\f\x02\xbc\x83\xb2\x10\xb46\xe3ӿ\xbb˵C\xc7\b\xcd\x1d\xac\xd0\xe0X\xd0vW\x90t[[\xa3\x82\xa13T{\x1e\x8ddZ\u05ec\xae\xf4\xfe\xcaC+\xd9;n\xec7\u007f\xcd\x06\x1f\x86\xfd\x02\xe7\x03\xf3\xaa)\xca\xd2\xfc\f\xc0\x03\x9b\x9bo~\fyp5\xe3\v7\x1a\x1e\xde\xc8{\xef\x1e\x9b\x9f\r>n\xc6t\xa6\nn)\xc8\nð\x17\xb8}g\xc0\xda0Փ.\xc2\xfaZN]{\xbc\x87aO\x12:\xd9ࠟ!\xac%\xcc*\x06e\xe0ĺK\xe7\x8a\x10\xd1Hگ\x02$v\x83\x99G\xc1~\x85\x8c3\f\xd9c\xc9\xef\xf0*\v\x1c\x0f\xb0\xc9\a9\xc7)\x86\x10\"\xcd\xc2\xd3,\xd2QDS\xd7\"\x048\x97\xceD\x9c\x1f\xa4S\fng\xc8}\xacs\x93%7\xa9\x18\x92\x1f;\xc8E\"\xeb+\x19\xcb\xc6\x13\xa7\x82\xbdW\x1a\xda\x1b\x91ɲY\xddX\xeb\xfd2\x81\xe5t\xd9\xfe\xd4p\x90\x0e|\x99\xd7\r\xad\xf5?\xfaÏ\x9c\\\xff\xe5\u007fu\xfe\xcd\a$\xeb)\xfd\xae\xb1y̵\xe1\x13\xdeǿ\x0edB\b\f\x92\xb5՜\xbbo\x1brvk\xc4|^sb;yHE)\xfe\xef\xda\xce$Ʋ\xeb\xac\xe3\xbf3\xdcs\xe77\xd5\xd0U\xdd\xe5\x1e\xcaqܶ#\xf0\x10w\x1c2X\f\xc2H@\x12\xc1\x02X\xa1\x88e[HH\xc0\xda\x1b\"\x14\x81\x84@\xcd\n\x01ْ\r2B\x90\x05Qp\xb0\xa2tD\x14)\x8a:\x8b\xc4\xc6\xedN\xa7\x87\xea\xee\xaaz\xc3\x1d\xcf9,έ\xd7ݰ\xb1\x84)\xa9\xeaՓ\xde{U:\xffo:\xdf\xf0\xff\x18\x8f\x14}\x1d\x8e2\x8d\x15\x8d\x03\x19K\xcaD\xd1,*\x16\x95\xe7\xe0ޒ\xf3\xa7\x13\x8c\x0e\xc7\xe1\x86^x7h\x8b{DO\xdc \xbf\n\xc1\x13\x14\xecQ\xf0=ns\x8d\x03Jb~\x99}.0\t\xde'\x0e\xa3\xce\xf3\xa6Cx\x81\xed<\xbd\xb5X\xe70(v\xc8y\x8fc\xee\xb2d\x87bЍ!]\x9f\xc7X'X\r\x03\x9f\xd2\xc3T\x18\x16\xd7;ڊO\xecn\xa6\u007f\xf2\xad\xeb\xf3?\xeb\xbd[\xc6:\xecr\x9dWu\xe9:\xf7k\xff\xefQ\u058b\x9bf\xb7Y\xf4_\xf2ο\xe5\xad\u007f\xc9Y\x8f\x8a$\xa7\xb73.>9\xe1\xf9\xa7&\\\x98\x15\x9cޞQ-Z\xb6O\xe5x\xa0\x18\x19\xb4\xf2\xb86\xa4/\xb2$\xa2\x17\x82\xb8\x8c\xf0^\xf0\xfeaϱ\x85U\xe50\xa2\xe7\xc2T\x87\x89\\B*\xbe\xa2\x0f\xcc\xdfC\xcd\xe4D:\xdc`\xbe\x1c\x9e\fͳ̸O\xc51\r\x1d\x8e\x86\x1e\xa5\x04&Sx\x1b6\xf8H\t]\xe7QR\xd1\xd7\r\nA\x83\xe56Kv)\aAx8\xf0\xb31\x89\xa9;p\x84\f\xc2\xe9S9[\x9b\t\xde\xc1\xeaV\x0fs\xf1\xfa\xf3d\x9f\xc1\xf3\x17E\x1e\x87Z\xbfuq\xd3u\u007f\xf0\xf9\x8f\xed\x9c\x06\xf8\xdcs\x1f~\xd4%\x9f\x9f\x99W\xbb\xc6\u007f\xdd[\xff\x87\xce\xfa\xd4y\xcf\xeef\xc6\v\x17f\xeco\x16\x9c\x9d\x16\x9c\x9e\x8eٙ\x8d\xd9\xdd\x18\x05>۶c:Ҝ\x9aFH\xef\x19X\xf2H\x92\x88\xde\v\xf22\"Q\x04\xd2c\xe0p\x0e\xab\xaa\xe7\xc9]I*\x1c\r\x16\x8d\xa0\x1f\xc0\x91k#\xe2\xd7\xd9<;<;\xf9\xb9C\xce\r\xe6\xb4X*z\"-\x88\"A\xdf:\x9a\xde\xe1,4\x95'N5m\x13\x18\x86\x1ePc\x86X\xee\xe1gz\xb4\x94\xe4q\xc4\xe1\xbcA#\x99\x18\xcd\xf9s%\xef\xfc4p_\t/\x10\x8d\u00a0\xff\xa1:\xb0\xb34\x8d\x16\xa1u\xd6Su\xf6g\x9dwo~\xe1\xb9\xd3ٛ?\xf8\xf07|K۹\u007f\xf6\xde_4\x89\x16O\x9e\x1d\xf3\xca\xc5-\x9e93b\x94\x1av\xa7#v6ƌ\xf3\x944\x8a\x88\x8dac6\xe2\xe6\x8dC67\x136\xa61\xf5\xaaC\f\xb7\xe68\xd6\xf4.P\x8cGJ\xa0\x86\xbcD\xd5\xc0\xc1\x83\x9e$՜\xdf\xe9\xd1x\x0ei\x99\x91rL\xb7֒G\xbfN4\x84\xe1 \r\x82\x9a\x9e\xf79fI\x87^\xd3h\x84\xae\xfa\xbe\r\x80\xe4EDW\a#\xd8\x0e`\x9f\x98\xc6n0\x8e\x91\xd6H\xabp\xad\xc4\b\xd8?[\xd07=\xf4\x96\\\x1b\xc6q\x1c\xd8'`'\xe9\xc4\xef\xc4Z\xf9\x93\xf5JM\xd7c\x9d\u007f\xa9\xa7\xff[\x80_\u007ff\xf3\xc3\x05DG*=\xbbS\xf0\xf1\xfd\x19\xe7&)E\x1c\x91\xc51{\x1b\x13\xb6\xc6%E\x920\x1d\x15\x8c˜q\x91q\xf6\xf4\x16U\xed0\x91%M\r\xcby\xbf\xe6[\x8c\"\x85\xf0\x82\xc4D,\x9a\x9ee\x13L\x92\xf3AKnܘs\xf6B\xce\xe6ȳ\"\xcch\xe4\x18\x96tk8\x1e}<\xd1\x12\xf7p\xb2p\xad5\x9a0'\xd2\xf7\x1e\x8d\xa2\uf82a\x1cy\xa9CX>\xf4\x9a>\xba辡G \x99\xc4\x19\xbd\x85\x18C\x99\x96\x9c\xffȌ\xbbw\x17\b/\xc8tD\x99\x87\x1e3%\xe1\xe9\xfd\xe9\xe4\xd9\xedI\xf9\xdcΌ\xed2\xc3(I\xe7,\x1e>\r\xf0O\xd7\x1e\xdeI._\xda\xfb\xbf\xa7ߟ}b\xec'I4HN\xc4$\x0f\xed\x97y\x1a\x93\x18\x831\x1a\xad\xc2\xdc^jL\xa85(\x85\xf4a\xc0\xb2\xefYGHJ)\f\x9e\xc4H\x12\xe9iZ\x87%\xac:\xaa[\xb8w\x04\xd1O\x0e\xf9\xe8\xc5-\xfa\x1f,\xb9\xb9\x9cs\x9e\t\xb7X\f\xd2;|\xcep\x84\x16\x87F\xaeM\x99|\x04\x14\x1d)\"%h\xdb\xf0\x9a\xa6\xf1Ե#\x8a`\xb9\f\xf7&\xf9\x98\xe9\v\x1a\xa3\x90\x8c3\xc3jnq\xbd\xe7#O\x8d\xe9\xfb\x9e\xfb\xf7Z\xb28\xa4K\xe6Uünx\xee\xe9\t\x9b\xbb)x8\xbf5\xe6̴\xe4pYq\xb4\xaa\xe9\x9c?\xf3\xc5\x17v\xfe\xa80\xfaM\xe7y\xef\xca\xd5\x1b\xf5\x95\xab7\x1e\x03\xe7\xd1\xe7\x1f\x18\x90I\x128\xa8\xf24a\xa3\xc8ٜ\x14\x01\x88(\"M\x82\x99\x8aM\x14\xba=\x04\xe036\x8a\x88r\x92\xb2X68\xbb^\x1a\x81\x94\xa1M'\x96a\x93g\x1c\t\xe6\x04\xfaq\x01\x1c/!Ҟ4\x9b\xf3\xe2+\x13\xbe\xf7\xedC\xde_\xcc\xd9\"\xe5\x98z\xa8\x93Xr\xa2u(\xec\xf0H\xe4P\xe7\x16k/#\x8d \x02:\x17\xfezW\x87\x8e\xf6HCW9\xec\x1a\x86uA\x98\x06\x8b\x11\x11I.9\xbc\xd7ab\xc53Ϗ\xf8\xe6\xd7\u007f\x8c\xd1\tIjh\x1a\xcb\xdd\xf9\x92S\x9b\x11\xc5\x18\x0e\x8f\x97\xf4\x9dC*\xb1f\xc0\xce#u\xc2\xc3\xf5%\xe7y\x03\xa8/_\xda;\xf4\x9ek\xe0\xbf\xea\x9c\xffꕫ7V\x00o\xfc\xe2E\xde\xf8\xb7\x1f~p@\x84\x94\xccʜ\xedqɤ\xc8\xd0*P\x15%\xb1!Mb\x92\u0604\x86\x86\x81V5M\x12\xb6g\x13\x8e\xba\x15m\x13\xea\xde\xf2$|\xf5\x9e\xc4(\u07bb\xd7q(A$\xc1ηޒ\f\xa8\xdd?\x82HUL6F\xfc̋%o\xffǂ\xb9Sh\x145\x96\f=\x80\xc0cG\xda\xe3QH\xfa\x13?P(\\c\xe9\x86T\xfbj\xee\xb18\xe2\b\xfa6h\x9aDb\x874I\x83\xa5\xc72\xcdr\xda\xd6a{\xcf\xc7^\x1cs\xeb\xe6]\x0enwLF)\xb6\xf3ܛ/\x18\x17\x86W^>Ù\xed\xe9\xb0%!\xf0r\xf5\xbdeY\x85\x11\xea\xb6m\x99/+\xdd\xf6\xbd\x96RfR\x8a\x99\x94b\xdf;\xff\xabM\xdb\xfd\xfd\xeb\x9fx\xe2\xcb}\xdb\xfd\xf5;w\x0e\xae\x03\xfe\x83j\x8c~bk\xca$\xcf\xc8\xd38\xb0\x1aĆ<5dIBl\x86\x15\x0f\xfdCI\x13R\xb0wa\x8f\xea\xddwY\xad\x16\xeb\x03S\b\xba\xb6g\x9cGH\xe9\xd8)4u\xddq\x8f\x9a\xda\x13\x00\x19\x9c\xc3\xc1\x11\xf0\xc3ۼ\xf0\xf29~\xee\xd3\x19\xdf|\xeb\x0ecFx<\vz\f\x12\x85D?\xe2\x03Z,\x9b$,h\x10\b\xb2\x8d\x98\xe5QK?\xa4ܛ\xfaD\xc2\x1c\xdeK\xdc`\xfaNBݐ\xbf\x92\x14\xa5fqd\xc9K\xc3l\v\xde\xfa\xf7\x03\xb4\xc9\x10Bpo\xb1Dk\xc5/}v\x9f\xfds\xd3\xf5\xea\x8a\xf1\xa8 \x89c<\xd0u\xc1/VU\xc3\xd1r\xc5|\xbe\xa2\xee\xda\xf5\x02\xb2\xb6\x0fF\xb2\xaa\x9a?^V\xf5\x17e\xdb\xff\xf9\xef\u007f\xf2\xec\x97\xff\xf2[\xd7\xff\x17(\xaf\x95\xf0\xb5y\xf8\xfdw\v\xf8\xca\x02\xe4\xac(\xc8\xd3\x18\xa3\xf5\xb0\x8a4\"K\x13\xb2,A\x1b\x8d1QX?\x04k6h\xef\x05\x91\xd1\xf4\x9d\v\xfdS\"\x00\xb2\xaaZ\xca\"A\n\xd8\xce$e\x12x\xb2*\xc4c\x89\x12\xeb\x02(\xdf\xff\xee{\x98\xd4\xf3\x99W\xf7Xr\x8cB\x90\x13Q\x13\x16\as2\x93\x0e\xb4xF\xc4\x1c\xd2 \x11\x14\x9b)\xc7\xf7\x1a\xc0\x05\x936l\xee\xf4} I\x0eB\x124\xe4\xe42\xaaИ\x04\x9a\x95gw/\xe5\xc6\xfb\xf78\\hb\xa3Y\xd45+\xdb\xf1\xca\v\xa7\xb9pv\x82?iĖA\xb5{\xe7\xb0΅\x06\x0ec\x98LJFeN\x96%\xa4\x03\x17\xb0\x1f\xfa\xc0\xb4\x94\x94y\xca\xd6l\xb2\x95\xa7\xf1\x9f\xf6\xd6\xfd\xcb\xeb\x97\xf6\xf2+Wo<\xe6\xf8\xbf6\x87\xd7J\xc4\xef\x15\x88\xaf,\xe0\v\x80\x8c\x87\xe6\xe5,\x89I\x93\x98Q\x99S\x14\x19qlȋ\x9c4\xcbH\x12\xb3&\x91\xb4\u07b3X\xd6Lf9uկ\xed\x8a\x16\x9eŢ\xa1,s\x94\x14\xf4=tޣ\xb5\xc4\x02\x9d\u007f|\x13\xb2\xb5p\xf3\x00\xae}\xff:I\xde\xf3\xf2'\xb7Y\x8a\x05\x1aI\x8e\xa1\xc1\xad\xa3-7\xdc\x1f\"$\x15\x1d\x11\x82bbX\x1c\xd6\xeb\v\xa5=\xa9m4\xed\x00\xe3Ip \xb08Zል\xa4\xef<Q\xa4IKǏ\xff\xab&\xd5!l8n\x1a>\xba7\xe1\xe5\x97\xce\x04Z\xc2\xd80\x1d\x17\x8c˂,\x8d\xc9ӄ\"K\x88\x8dA\xeb\xc0z\xb71\x1dqjk\x8a\xd6\xd1\xf0\x1f\x86\x8a\xd8I+j\xa4%\xb3q\x89V\xea5\x0f߸|io\xe7QP^+\xe1_+\xfc\xc2\xc2\xe7\x81\u007f\x04\xb4\xd1\x11i\x123.3\xca2\x00\x10\xc5\x0fw\xcdڦ%2\x06m:\xea*p\x8b\xf6͒t\xd3\xf0\xc8t1R\xc0\xf1\xbcAG\x8a\"3ܬ-\x89Vd\xb9氱\xd4xb\xf1\x98\x9f\xc5{\xf8\xc9\x1d\x8f\xff\xee\xbb\\z\xf5\x19>\x95n\xf1\x9fo\xdf&k&x4KzR4\x0e\xc1\x94\x94\x05-\x1d\x9e\xc4DD\xf4,Wr\x88\xa6$\xde9\"\r\xb6\x13Cd\xe7֡\xb2\x05Z\x1cy&Y-B\x81\xadj;\x16K\xd8\xccb\x16u\x8d\x10\x9e\xcf~\xea\x1c\x02H\xe3\x84<K\xd0\xc3\xde*\xa5T\xa8HJ9\xacr\r\x8fx\x98\x94\x19[\x93\x11?\xba~\x93\xfb\x87s\xaa\xa6\rD\x9f24f(%\x19\x17\x19\x0f\x8e\x17\x1f\xf7\xde\xff\xdd\xe5K{\xbf}\xe5ꍣ\x13\xf3%N\x1c$\xf0[\tR\xc6q$f\x93\x92\xe9tL^\xe4\x98<E\xc5\x06\x15'\xe88A\r\x12\xa1\x95Z3\xc0U\xf5\n\x84\xa4\xb7\x0f\xfd\x82\xc7SW\x8e\xa6\xea\x19g\x86\x91\x91\\܈\x99M\"$\x82\xc6?^\xc3^_\x00\x1d\xfc\xf4\xc0\xf3\xedo\\#)5\xbf\xf0\xb9}\xfa|\x81F\xb0IF\x84dI\xcf\x16\x19\a,\x11\xc0\xf8l\xc6\xe2\xd6!\xbd\x8d\xf08\xd4\x10\x1ak\xad\xe8\xbba\xd2g\x80#B\xb1\x90\x1d\x1d\x0e\x93H\xda\x15\x8cf\x86[w*\xf2\xc8 \xbccյ\xec\x9f\x1bcb\x85\x89B9XJHM\x18m\x18\x17\x19\x891\xc4:\x00\x13\r\x84:\x82@vc\"\xcd\xd9\xddm\xb67&lM\xc7\xe4i\xb2f\x98\x10èE\x9a\x18\x84\xe0W\x80\xbf\x01\xf8\x9f\xe6k\xc8Ph)\x85\xe8\xb34q&\x8e\xad\x94\xd2J!\xadT\xdaJ)-`u\x1c[\xa9\x94\x05\xacu\xce6uc\x97ug\xfb\xb6\xb7\x83\xa5\xb0\x046\r\xeb-\xf6\xc1\x83\x85\x9d\x8d\x8d]\xb6\xceJ!l\x12K\x1b'\xca\xf6\b[9\xac\x16a4\xe3\xd1\xf7Z\x87\xbdy\xd7\xdb\xef\xbc\xfd#\xfb\xe0\xfe\xa1\xfd\xf9\xdfxڎN\u05f6\xd2G6\xc7\xd8]\n\x1b!\xed\x8a\xceJ\xb0\x9bO\x95\xf6\xf6;\xf7m\x8a\xb4\x16o\x95\xc0⽍\x94\xb0}\x87ux\xab\x91V\x80-\x88m\xed\xad\x8d\xb4\xb0\xc2\v띰&\x13\xf6\xfe\x9d\xda\x16\x89\xb2˦\xb5Za\xf7\xcfOm\xa4\x95͓\xc4\"\xb0\xb1\x8e\xac\x92\xd2*)\xac\x94\xc2*\x89UB\x86o%m\xa4\xa4\x8d\x94\xb2Fk+\xf0\xd6he\xa5\b\xaf\x1d\x97\xb9\x8d\"m\x11X\xad\x94\x8d\xb4\xb6JI\xab\xa4\xb2\xc0o^\xbe\xb4\xf7W'\xa0<&\x9c\x9e\xf6\xbf\x012lv3S\x0flj\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_09_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x18\x06V\xb1\xc4\xdb\x00\x00 \x00IDATx\xda\xec\xbdy\x90\xa5\xd9Y\xde\xf9;\xe7|\xfbw\xf7\xbc\xb9V\xd6^]\xbd\xab\xb5\xb4\x1a\x89ֆ$,\x10\x01\x92Yd<cð\x1a\x82\x89a\xec?\xb0c\x96\xc0\x9e\xb1g\xc2`O\xcc\xd8L\xd8D\x80!<\xc6 @\xc8\bd\xb4!a\xb40R/ꭺ\xba\xba\xf6\xcc\xca\xcc\xca\xfd\xee\xdfzΙ?\xbe\x9bY%\r3\x0e\xa0\x97R\x87\xbe\x8a\x8a\xbc7\xf3\xe6\xcd\xcc\xf3|\xef{\xde\xe5y\x9f\x03\u07fc\xbey}\xf3z\x8d_\xdf\xfd\xae{\xbe\xb9\b\xaf\xe6\xf5\xfe\xb7\xff\xf9\x00\xfc\xf0w?$\u007f\xfaCo\x16\xff\u007f\xdf\xfbm\x8f\x9c\xbc\xa3\xff6q\xa7/\xfe\xd2\\\x93\xf5\xad\xfe\xe1\xf37\xbf\xf1\xb8\x93&\xe5\xbc\x10t]\xa5\xeeQJ\xbcSI\xf1(\x96\xbb\x01\xdf\x02\xc6\xda},=k\xed\x8e\x10\xaca\xed\x15\x8b\xfd2\xd6>{\xacS\xdb\xf8\xc8\u007f\xba\xd0\xfb& \u007f\xc1\xeb\xc4\xf2\f\xd7n\xec\x1e>\xaf\xd7\xc2\x13\xcdz\xf0^\xa9\xe4\xdb\x1d%ߠ\x94\xbc\x17\x81\xe3:\nWI\x14\x02!\x05`\xb1\x16\xf2R3\xc9J\x02\xcf\xc5UPj\x03\xd8~\xcdS\x8fE\x81\xfb\xf1\x8b7\xf6\xff\xaf\x1b7\xfb\x87?`&\xf6\xd8\x1d\xe7\xdf\x04\xe4?w=pv\xf1=J\x8a\x9fs\x1d\xf9\x90\x10\xa2m-\x9e\x00\x94\x92\x04\xae\xa2\x19\xbax\xaeB\bA\xec\xbb\xf8\x9e\x03\xc6\xe0H\vB\xb2\xd5O\x18\xa5%Ia\x98\xe4\x05\x93$!\xf4\x1c\xedH\xb9yeu\xf7\x93YV\xfc\xda\xfe\xa4\xfc\xfc\x9d\x02̫\x0eH\xa7.\xd9\x1b\x1a\x00ڍН\xef\xd4B\xc7U\xf7\xfb\xae\xf3!\xa5\xe4\xdf4\xc6̗\xa5\xc1X\v\x02\x1c)\xf1\\\a)\xaa\xdf^I\x81\xb1\x02,8\x8e\xa0V\x8b\x88\x83\x10\xd7UH[\xd2\xf6\x05\xb1/\xc9\vÅ\x8d\x01\xc6\xc2\xd6\xfe\x00W\trm\xd9\xd8\x1a\x92\xa7\xc5\x04\xc1\x87\xb1\xf6\xff4\x86\xe7\xb16\xdfK\n}\xf0;\x1e_\x9a\xe5\xfa\xfa\xf6k\x1f\x90N3b\xaf?\x01p\x8e/\xb6\xdf\x11\x85\xee\u007f\t|\xafR\xb2e\x8c\xc5\x1aK\xe0;đG\xe898\xae\x8b\x10\x02!\x04S|\xb0X\xb0\x16c-Z\x1b\x8c\xb1H)\x01*P\xa4\x831\x86\x9a\xa7Xl\xf9\xbc\xb8\xb1O\xec\xfb\xacl\xeeR\v=v\x87\x19;;C\xa4#0ڒ$\xc5e\xe5\xc8\xdfn\xb4\xe2\xcfδ\xa3s_yju㕴\x9cW\r\x90v#b\u007f0\xc1\xf7\x9d\xc6R\xb7\xf1\xab\xbe缿,u\x14\x85\x1eq\xe8R\x8f}|W!\xa5@)E\x18F\x87\v\xed9.BJ\x84\x10(\xe5 \xa6\xfb\x86\x90\x12\x84\xc0\x91\n\xe5\xb8x\x9e\x8b5\x864\xcd\xd8\xed\xf7\xf1$,\x84\x9ag\xae\xef\x12E1\xdb\xfb=\x845\xdc\xd8\xeac\x11\xb4\xdbu\xf6\xf6\x86\x8c\xc7)\xedN<\xf1\\\xe7\xd2\xf6\xf6䓣\xc1\xf0\u007f\x1a\x96\x8c^\tPԫ\x03F\xc8\xfe \x01\x10\xcbs\x8dg\xa4\x94ow\x1c\xe9.\xcc\xd4h5B\xe2\xd0E\x1b\x8b\x05|\xd7\xc1\x02F\x1b\x84\x00%\x15\xd6Z\x8c5h]V\x9fS\xd5犢\xa0,\v\xb4.\x90\x02\xba\xddYjqL\xab\xd5\xe4\xf8\xf2Q\x8aR\xb3\xbe;\xa0\x1b)\xb6\a\x13\xfeڻ\xde\xc1\x8d\xb55<\xcf\xc1Z\xc3\xf2\xe2\x02\x16K\x91\x178\nW(5?\x19e\x8f\x82x8\xf2\xd4\xef\x01\xc5m\x9e\xec\xb5\x03H\x9a\x95\x00,t\xeb\x1f\xf7\\\xe7-͚O\x18\xb8\x8c\xc69\xfb\x83\x84\xfd~JQj\x8c\x91d\x85\xc5\x0fcfgg\x99\xe9t\xe9tf\x98\x9b\x9fg~v\x8e\xd9\xd99\x1a\x8d&\xb5\xb8\xc6\xdc\xec<'\x8e\x9d`aa\t\xcf\x0fѦ@II\xe0\a\x04\xbeO\x14\x86\x9c=u\x02\xa4dc\xaf\xcf`4\"R\x86\xa5\xa3'p\x04\xf8A\xc0p\xd8\xe7\xc4ѣ\xf4\x87#\x8a\xa2`v\xa6\xc3^o\x80\xd6\xf6\xb4\x00v\xc7\xf9g_\xb3.\xeb\xe4r\xe7\xa7\\%\u007f\xa9ۮ\xa9\xc0\xf7\x89|\x87(\xf0p]\a\xd7\xf5\x88\xc2\b\x83\xa5Ԛ,\xcb\x19\x8d'dY\x86\xef\ax\x9eO\x10x\xb4\x9b\r\x96\x16\x17\x89\xa3\x00\xad-qT\xb9\xb5z\xa3\x85\xef)\xd6\xd7V\xb9r\xfd:\xf5Z\x8dcG\x8e\xd2\xed4q]œϽ\xc0\xf3\x17.\x93\x8ez\x9c:y\x92\xd1xD\x18\xd6\xd9\xed\xf7PBP\x94\x86g_8ϻ\x1f}\x1b\xbf\xf7\xf1\xcf2\x18\x8d\x01A\x91\xeb\a\xf7\x93\u2e57\xd3u9\xaf4\x103\xb1\x87E\xce\xe9B\xff\xcc\xf1\x85\xb6Z\x98\xa9\xd1\f=BO\xe1*\x81\xb1\xa0\xb5Ƒ)\xca\xf5\x91\xd2#^\x9c\x057\xa0,K\x8a\xa2\xc4\x18K\xe0\xfb(%\xb9q\xe3\x06\xa7N\x9c\xe0\x9e3'\xe8\x8dR|\xcf\x05a\t}\x97\xfb\xef>Mwf\x86\v\x97\xae\xb0\xbd\xbb\xc5\xecL\x1b\x10\xdcw\xe6\x14R(\xbe\xf2\xf49l>\xe1\xd8\xf21\x8aRs\xd7\xe9\xd3<s\xeey\x96\x97fXY[a\xa6ݢ\xd3jP\x8b\x15\xcaU\\\xbb\xbe\xffO\x80\x0f|û\xac\x85\x99:G\x17\xdb\xec\xec\x8fI\n-BO\xfe\xfdB\xf3\x03Y\xa6\x19MR\xf6\xfa\x13\xf2B#\x04\xf8\xaeB)\x81\xb4\x06%\x05\xbe\xef\x93LF\x90\x8fi7[,,,rt\xf9\bs\xb3\x1d\x1a\xf5\x06w\x9d>\xc5\xfa\xc6:\x1b\xdbۜ9\xb1\x8c\xe7\xbaD\x81\x8b\x94\x12)%\x8d8`qa\x8e\xe1h\xc0$\xc9\b}\x9f8\nPR\x90\xa497\xb7\xb6X\x9e\xefR\"h\xb5\x9al\xed\xec0\xd3n\xe3{>\x9dV\x9bK\xd7V8\xbe<\xcb$\xcb\xd9\xde\x1a\xccƾ\xf3ov\xc7\xf9\xe4\x1b\x1a\x90Q\x92\xb3\xb3?\xc6\x0f\xd4̽\xc7\xe6\u007f\xa4\x1e\x05\xff\xf4\xc4\xd2,\xf3\xdd&\xa1\xefc\x11\x8c&\x05\x9b\xfb\x13V\xb7\x86\x8c\xc6\x19B\bBG\xa2$\xb8^@Tk\xe0\b\x8d+4\x8eT\x04A@\x14\x06X\vǏ\x1d%\x0e\x03ο\xf0\x02\xa1\xef\x11\xc51\xc6\x18Jm0@\xe8;x^ȹ\xf3\xcf3\xeem\x13\x06\x01JX\\Qru}\x9bv\xe41\x9e\xa44j\x11\x9bۻ\xd4∭\x9d]\x84\x80\x8b\x97\xafr\xf2\xf8\"7\xd6w\xe8\xf5'FX\xbe\x90\x14\xfa\xd2L\xec\xf1rl\xf0/; \xf7\x9d\x9eg{\u007f\xcc\xd9c\xb3\xef\xbc\xfb\xd8\xfc\xaf,\xcev\xfeα\xc5.\xadFL\xbd\x16\xb28\xdb\xe1\xe8B\x87\xf9\xd9\x16\x9e4\xb8\x8ed\xa7\x9fr\xedf\x8fqVR\xf3%\x9e\x844K\xd1\b\xf24\xc5\xf7\x1c\x82 @\n\x81\xe79\x84\xbeC-\x8eh6Z\\\xbf\xb1\xc6ͭ-f:]Ɠ\x14m\xa0\xdf\xeb\xe3y\x8a\"\x99\xf0\xe2\xa5K\xe8I\x9f\x99\xb9E\xd2\xd1\x00\xdf\xf7\xd8\xdb\xdb!/r<J6nn\xe0\xba\x0e\xc6h.^\xbd\xc6\xdcl\x9b0\nx\xe6\xdc5\x1a\xed\xc8\x19\x8f\xb2\xebI\xa1?\xf7rE[/+ o\xbc\xf7\bϼ\xb8\xc1\xfdg\x16\xbeo\xa1\xd3\xfc\x83\xf9v\xeb\xb8뺘*\x97\xc3\"\xb0\xa6@\xd9\x02Iɑ#K\xd4B\x8fn+\xc6\xf5\x03\xae\xadﲶ3BXC;r\xc9\xd3\x04\xe5\x05\xf4\xf6v\xd9\\_\xc7\r||\xcfc<\x1c0\xec\xf7\x91JQ\xab\xd5IҜ\xaf>\xf34\xddV\x83\xfe\xee\x16\xd7_|\x964I\x98_\\f\xb8\xbf\xcd\xee\xfe>\xa1\xef\xe3x\x01\xba\xc8\x18\x0f\aH\xd7Ó\x86\xdd\xfe\x10\xd7\v\x10h\x9e}\xf1\"oy\xf8aF\xa3\x11RY,F\xec\xefMT\xe8ʏ%\x85\x99|\xc3\x00\xf2\xf0}ˬo\x0f\xd84C\xdex\xf2ȏ4\xe2\xf07f\xdb-\xe9(\x17\xcfup\x94\x83\x10\x02]\x96\x14\x16\xc2 \"\xf0]l\x99s\xe4\xe82a\x14ь\x03\xba\x9d\x16\xdb{}VwF\x8c\x92\x9cn#\xa0\xcc&\xb8\xae\x8b\xe3\x05l\xae\xdd`sc\x9d4+\xd0e\xce\xd6\xcdu\n]\xe2\n\x81\x92\x92/=\xf68\xae\xebc\xf3\x8c\xfe\xee\x163s\xf3H!\xd8\xdd\xddGXCk\xa6\x8b֖l\xd4Cx!\xb2H\xd8O\f\xf58\xe4\xfa\xea\rJ\voz\xe8!F\xa3\x11y\x99\xb3\xbe\xd9c4J\x17\x84\x10\u007f\x18yj\xf5\x1b\xc6e\xado\x0f89ߔg\x16\xe6~\xce\xf7\x9c\u007f9\xdbj\xe1\xbb\x1eR\x80\x90\x02GU\x1bn\x18\x05\xd4\xc2\x10\xdf\xf7P\xaeK\x1c\x05\x14y\x86\xef\xb9tgg\x99\x9d\xed\xb04?C6\x99\xb0\xb97\xe4\xc6\xd6\bm\fi\x92\x92O*`\x00\x86\xbd=\x06\x83>XK2\x19\xa3\xb5&\x8aB\x16f\xe7x\xe1\xcaU\xfaI\xceh4\xa2\x98\f9r\xea,\xfd\x9dM6\xb6\xf7\xa9E!n\x100\xea\xf5\xc8\r\xe8|\xc2\xd88\xa4\x93!\x1b[\xbb\x84a\xc8B\xb7\x836\x16m\f\x97W\xb6\x89CO\x8d\xc7\xd9M!\xc4\xe7\"O\xbd\xe4\xfb\xc8K\x0e\xc8|\xa7\xce8ɹ\xfb\xe4\xfc[\x94\x92\xbf֮7\x9d\xc0\xf3QBTw\xb6R\xd5cG\xe2I\x8b\x12\x06\x89\xc1\x11\xa6\xaaSI\x89\xe7\xfbX[\xf9\xb5f\xb3\xc1\xd1#\v`\n\xfa\xc3\x11\xfb\xa3\x9c\xdda\xcav?e}\xa7\xcf\xce~\xc2v?a}\xa7O\x99'\xf8BS\xa6c\x92\xd1\x10)`\xae\xdd$\xf4\x1cƅacs\x8b\x86'\t\xe3\x1akk\x1b4\xdbsL\xfaی&\t\xc6\xc0\xeaV\x0fG\xc1p\x94prv\x91\xed\xfe.3\x8d\x90\xfd~\x9fz\xbd\x89\xe3)z\xfd\x01\xe3Qz\x9f\x10\xe2\x9f\xef\x8es{G[ȃw-pem\x8f3G\xbbA\xbd\xe6\u007f\xb6\x11ǳ\xb50@I\x89T\n%%\xa1+PB\xe39\x10\x84U\"\x18\xd7\"\xba\xb3]\xba\xb3]\x8e\x9d<\x89\xe7\xfbH\xa9@T\x99\xabR\x92\x85\xb9.\x9e\xeb\x92OR\xb4\xb1x\x8e\xcbL\xad\xc1\xfd'N\xf1Љ3ܽt\x9c\xfdaι\x95-V\xb7\xfa\x8c\x93\x8ct<\x822G\x98\x92ر\xf4\x13Õ\x95\x9b,\xce6\x19\x8c30\x053\xb3Klm\xded\u007f\x902\x98\x14\x8c\xc7\x05w\xcd\x1e\xe5m\xf7\xdc\xcb\xe3W.\x10\x05.h\x8d\x90\x12\rl\xef\xec3\x1c&\x91\x10\xe2\x93I\xa1W\xef\xd8\xc4\xf0\xae\xa3]\x9e\xbdx\x13\x80V=\xf8p\xe0\xba'\x1bQL\x1c\x05\xc4QH\x14\xfa\xd5\x0fT\xa0˂\xa2\xd44\xea1\xb58$\x8a\"ʢ \x1b\xf7\xd9\xca&x~\x80\xe7\xfb\xb8\x8e\x8fц\xd1h\xcc8\xcdX\xdd\xd8dc\xbf\x8f@\xf2\x93\xef{\x17\x8f\xdcs?\xb58\xa2Ԛ$\xcb\xf8\xd6\a\x1e\xc4w]V\xb7\xb7\xb8v\xf3&\xe34e}w\x87$\xcf\xf0\x1d\x8f\xf9\xb8\xcd\xc4K9wa\x8d(\f\xd9\xdc\xee\x13\x86M\x92\xd4!M5o:q\x96\a\x8f\x9d`\xb6\xd9\"/\n\xbe\xeb\xf5\xdf\u0093\xab\x17\xa8\xd7B\xf6{{\xf8\xb5\x16Q\x18b\xab\x12Ƿ\x00_\xbac\x01\xb9\xb8\xba\x03\xc0\x1b\xee9\xf2?\x04\x9e\xfb=\xedF\x83\xc5\xf9\x19\x8e,\xcd!\x95$Is&iFYj\n\xc0\tBTXǸ\x1e\xb9Uxq\x84(\v\xa4\x148\xae\v\xa6\xa4Ȳ\xaa\x90\xa8\v\xb6v\xf6i6\xeb\xa8\xed}\x1e>u7\xdf\xfa\xe0CDa\x88\xeb:\xf8X\x82\xc0\xc7\x02JJ\uebdd\xe4\x81S\xa70\x16\x8a\xbc\xa0\xd4%eQR\x96\x05\xb9.H\xb2\x94\xb4(I\xb3\x9cQ\x9a\xf2\xee\xbb\xdf@\xe0\xfbԣ\b\xa5\x1c\xcaR3\x1c\x8dx\xd3\xc9\xfbiEu.\xed]\xa37N\x18\x8c\xc6DQ@\x10\xb8\xe4Yٹ\xe3K'\xf7\x9d\x9a\xbf/\xf4\x9d\x9fl5\xea\x1c\x99ﲸ8\xcbŕ\x9b|\xf5\xd9\x17\xe9\r\x87ӲGՌ:\xd8/\x1c\xa5\x0e{\x1c p\x1cE=\x8eY\x9c\x9fay\xa1\xcb\xd9\xd3G\xf1j!a\x9csye\x93\xc8\v\xf9з\xbd\x8b0\b\b\xa3\x10a\x05\b\x8b\xefK\x84RX,\xe2\xb6\x12]\x10\x83\xces\xb41\x18c\xb0\xd6b\xb5\xae\xf6()q\x9c*\xe23Ơ\x8dA\x00\x8e\xe3`\xac\xa1,5K\xedE<\xc7\xc7h\xcd\xd3k\x9bD\x91\xcf\xec\\\x8b\xb5՝\xa3w< \xb5\xc8\xfbn\xd7q\x8e\xb6\xeb\r\xe6\xe7;\xfc\xd9W_\xe0K\x8f?\x03B \x85\x00!\x10\b\x94\x92(!\xb0Ƣ\x85E\b\xb0ր\xb5\xe8\"#K\x12vv\xb6\xf9\xea3\x06\xad-J)Zq\xc4;\x1ez\x1d\u007f\xfb\a\xde\xcb\\\xbb\x85\xe7yx\x9e\aӈM\b\x89p\x1d\xaa7\xa3\xfa\x99\xaaJ\xf0\xca$E\xe6\xd9!\x10\xd6N\xf7bQA'\x04\x18#\x11F\xa3K\x8d\xd6\x1a)\x04\xf5z\x8c\x05:\xa6\xc5ݳgI\x8a\x94'W\xb61\xc6\xe0\xf9\xeeY\xc6\xf9K\u07b8zI\x00\xe9\xd4|\x16\xe7[5\xe0\x1d\x8d8\xe6ıy.\xadl\xf2gO<\x87\x10\xf2\xf0uq\xe0\xd1nDD\xbe\x8b\xab\x14\xbe\xe7\xe2\xfb>Q\xe8\xe3(\x891\x964M(\xf2\x82I\x92\xd2\x1f%Ԣ&\x1f|\xdb\xdby\xd3ٳ\x1c\x9f\x9f\xab\x9aR\x8e\x83\x1f\x06(\xcf\xc5\xf1}\x84rP\x9e\x87\x13\xd7\x10A\x84p\xaap\xd8\x1a\x83NS\xcaI\x82I\xc6\xe8t\x82Ϋ\x9c\xc5j\x83\xc5\"\x0f\xacsz\xb3\x18\xadɒ\x84\xd2u\xc8\xf2\x02;-vvt\x87\x93\xed\xe3\\\xb8\xb9GO\xc2\xec\\\xfd\x9e\x8d\xbd\xf1K^\xf5}I\x00\xd9\x1be\x1c]T\xdd\xc0\xf3\xdf{da\x16Ǒ|\xfa\xf3Ob\xacA\bI#\xf6Y\x9ci`,\f&9\xfd\xf1\x18),\xf50`a\xd6!\b<\\ǡ,\v\xb0.Y\x96Ѭ\xf9XS\xf2\xc8\xfd\xf7\xf37\xde\xfbn\xb468\x8e\x83r\xabV\xaer]\x9c\xc0G:.\xd2\xf7\x91\xae\x8f\bB\x84\xba\xf5'\t)q\xe2\x1aNT\x83\xac\x01\xe9\x04S\xa4ز\xc0\x16E\xe5\xc2\xca\x12\xa4@k\x03Fcte\xa9\xa4\xe9\xe1\xcddtL\x9e\x17\xcc\xd5\x178\xd3]f\xbb\u007f\x11]juG\xbb\xac\xdep\xfc\xc1S\xc7\x16\xbd\xc5\xf9\x19>\U000c59d8$\tʑ\xb4j!\xb3͈\xb5\xdd!y\xa1\t<\x87#sMj\xbe7\xedu\xa4\\\xba\xb2J#\xf6\xd9\x1be\xe4E\xc1\xeb\xefZb0\x1c#U\x8d\x0f\xbe\xeb]8\x8e\x8b\xe3\x80\xe3{(\xc7Az\x15 R9H\xcf\x03\xd7\x05\xc7=\xb4\x8c\xafi\xf7X\vzZ\xabq\x1c\x84\x96\b\xc7\xc1\n\x814\x06++\xebp\x1c(\x8b\x1c)\f\xc6\xf7Х\x06\x9b\xa3\xa4 \xf0}j\xb5\x98\xe1d\xc2\xdd\xdd\x13\xbcpc\x93K{\x1b\xe5\x1d\r\x88\x92\xea\xc7f;\r\x92$\xe3\xe2\xca\x06\x9e\xa7\xe8\xd4C\xa4\x90\\\xba\xd9'p\x15\x9dZ\xd5'_\xdf\xec\x91\x14U\"\xe8*I+\x0e\xb0Rqr\xa9\xc3l3\xe6\xfa\xda\x16\x93\x14\xee9q\x82N\xa3Ve\xf7\x9e\x83\xe3:\b\xc7A\xba.R)\xa43\x05\xc2\xf5\x91~\xf0\xe7\xf7ތ\xa9\xfe\v\x01B\"\xa6\t'\x80\x95\xa2\x02\xd1\x18\x90\x02J\t\xc2V\xa0;\n\xa1%R*\xac-\x88|\x8f8\f\xa9\x85\t\xedZL\xbd\x11Dw, 5\xc5l\x18\xf8\x0f,\xcevx\xec\xd9˔\xbad\xbe\x15\x93\x15\x9aA\x96S\x0f\\\"O2\x9c\xe4l\xe6\x1a\xd7Q\xd4#\x8fV-d\xbeӠ\xdb\b\b}\x97͝\x1e\xe7\xb6\xf7IsM\xbb\x1e3\xd7j\xb3\u061d%/\n\x94R\b)\x91\x8e\x83\x94\n!U\xb5\xa1\xbb.\xc2\xf3\xff\xfc\xe6\xa7\x05\xb4\x06c\xa1,\xc1j\xac8\x00\xc4\xde\xc2MTQ\x9f\x14\x02-@J\x89r\x1ctQ\xa0\x94\x9cb)i\xd4bF\xe3\tG[s|\xf1\xb9\v\x17\xefX@\x1cW\xbd\xad\x16\x85\xe4\x85\xe6\xea\xda&q\xe0\x82\x80aVҭ\x05(e\xd9\xee'8\x8e\xcbl3dy&\xa6ը\xe1J(\x8b\x92\xcd\xed]\xaen\xf48u|\t\xe9\xb8\xd4]\x87v\xb3\xc3\x0f\xbe\xef\xfddy\x8e\xe3TY\xbeP\n\xa9\x9c\x8a\xa1(%B9\x95\x9f\xd7\x06T\xe5z\x0e\x81\xb0\x06J]\x01r\xb0\xf0\a\xfb\x838x\r\xb7\xa22,R\ntY\x81\xe38\x0e\xb9\x14\x15\xf8U\x18\x88\xe7:x\xaeC\xb7V\xa7\xe5G\xa7\xd6\x18ܙ\x80X8\x11\x87>\xab\x1b;\xf4\a#:\xf5\x80\xddaJ\xbb\xe6\xa3$\x8c\x12\xcdr\xb7\xcel\xa3*\xa3\xe4\xa5fk\xb7G^j\xf6\xc6\x05\xe3$\xe7\x81SKdI\x82\xeb*\x16\xbb\v\x1c\x9b[DH;e\x9aT\xf4\x1e!%\x1c<\x16\xa2Z\u007fSN\x17W\x83T\xb7,\xa5\x9cZƁ5X@\x97\xb7}\xee\xe0\x97\xb7\xb7@\x14\nlq\x18\x10H!AP}\x9c\xe6N\xae\xebR\xf3\x03\xba\x8dV\f7\x8f\x02\xabw\xe2\x1e\xa2\x04\xb0\xb5\xdb#\xcbK\xd2\xd2\x10\x05.Ͱ\xea}\xd4B\x97\xa24\\\xdb\x1aR\xe8*\xd4<\xb8k\x1d!X\x9e\x89\xe8\xf5\xf6\x99i\xd51V2\x1aMx\xba\xf7,?\xf1\x81\x0f\x1e\x86\xa5\xa2\xe2\xfbL\xf3\x86\x83\xfd\xe1\xa0\xd2j\xc0\xc8\n\x10\xa1*\xd0\xect\xb1\xedt\x0f\xd1%V\xe7Sp\x0e@\x11\xb7=\xbe\xe5\xf4\xa6;̡\x1b\x13\xb7\x19\x9f\x10\x828\fi\xd5b$\x9c5w( V\x1b\xc3\xe6v\x1fϓ$Y\xc1\xb1n\r\xdfS\xdc\xdc\x1f3Nr\xa4\x14x\x8e\xc4u*\x17\xa0\x04\x04\xae\"\xf2\xabE\xb6\x166\xb6\xfb\x80 :\xaax\xdbC\x0f\xe2\xbb\xde-K\x98.\x10\xd3\xc4NX\x83\xd5զ-\x943\x05`\xba!h\vf\xea\xb6l\x15\u03a2\x8b[\x96b\xed\xd4U݊\xc0\x0e\x18\x90\aY\xbb5\xa6JV\xa7 X\v\xc6\xdc\nDڵ\x1a\x81+\xe2Ia\xef<\x97%\x10z4I\xd8\xed\x8d\x10Bҩ\xf9ľ\xe2\xf2F\x8f\xde0\xa3\x1e:($\x93\xa4`\x94Mp\xa4\xa4ی\xf0\x1c\xc9\xda\xee\x98R\x1b\x1a\xa1K#pyݽ\xa7\x18\f\xc7\x1c\xe9v\xa7\vt\xe0\xeeE\x05\xc4\x01z\xd6b\x8d9|,\xa4\x03\xce\xc1\x1d\x0f\x88ʵ\xa1\xbf\x0e\fq\x9b-\x1c\xb83S-\xaa\xb6S\x10\x8c\xae\xacj\n\x82堚0u\xa1J\xe2;\x8a\xe3\xb3s{\xe7\xd77\xefȰww\x92d\f\xc7\t\xadz@;v\xb9\xb8\xb6O\xe4*\x1e9=\x8b\xab\xa6\xa3\x02\xa2\xfa\xdb\xf7\x87)\xfd\xa4`\xbb7A \b\xbd\x8a\xc9\xfe\xd0}\xa7\xb8z\xe3&g\x16\xbb\x9c^>9\xbd+-\xd2\xda*,\xb5\xe6\xd0\n\xac6՞\xa2n\xdb7\xcc\xf4\xce\x17\xb7\xe5lR\x82U\x95%\x00\x94\x06D\x05\xceA\blmE\xe6\xb6\xc6`t\x95\x1c\x96EY\x156\x8d\xc1NY\x94\xc6\x1c\xb8\xb6\x8a\xde\x1a\x05\xe1\xf6\x1d\xb9\xa9\v!F\xe3I\x86T\x92\x99\x9aϥ\xb5\x1e\xc7g\"b\xdfe\x94\x16\xec\x8eR\xb2rJ\xebq\x1df\x9a\x01g;1\xbe\x92$Y\x89\x94\x820ty\xf1\xea\n\v\xed\x1a\x0f\x9e\xb9\x9b\xd9\xd6\xec\xb4\xe6T\x11\xa9\xa5\xb5\xd5:\x1a\x10b\n\xd0tq\x0e<\x95\xc0\x1c֨\x10\x12\x90\x80\xfe\x9a$\xd1겲(5\x8dδ9\xaco\x19\xad\xabzWYV\xd67\x05\xa1\x02\xab\xea\x1a\x16Eyhe\xe3,\xd7w( \xac\xa5YF\xe8Hv\x06\tw\xcd\xd7IsM\x96k\xd6v\x06\xa0\xa1\x11\xfbx\x9e\xa0\x16z\f'9\xbdQF\xa7\x1e0\xd7\n(\xb4eeg\xc4|-\xe0\xf8\xdc\"\x8f\xbe\xfe-DA\xf85A\x90\xb0\a\x8eK#\x90\xd5\x1e!\xa6nK\x88\xca\xcdL\x9bZ\x95{2ӏ\xf2\xd6\xdeb\x05\xa6\x9c\x02bd\xf5Rc\xb0FW\x160\x05\u0094\xba\xaa\xfej\x8d1\x1a\xad\r\xbaԔeI^TQ\x9d6\x86\x17\xd6֯\xbcԀȗ\xc8B\xae\x97\xa5&/4\xb3\xb1\x87\xd1\xd5\x1a\xf5&\x86\x9f\xff\x89\x9f\xe0\xdc\xc7>\u0093\u007f\xf01~\xf4\x03?\xc0\u07b8\xe0H+\xe2X'f\x9c\x16\x9c\xbf\xd1\xe3\xdaf\x9f\x86\xef\x10\a>?\xf4\xdd\u007f\x83\x85\xee<J\xa9\xaa\x8a+\x05\xaeRH\xc0\x16Ղ٢\xc0\x9496/0y\x86I\x12L\x9ab\x8a\xb2Z\xfc\x83\xfdc\xeab\x90N\x95\xa5+\xaf\x1aY\xd0z\nL\xc5\xdbB\xca\xca2\x8c\xc1\xe49\xc6\xea\xc3\x16\xb2.\rEY\x92뒢,)\xb5\xa6\xd4\x1a]\x96\x1b\x80Yj\xb7\xee<\v\xd9\x1d\xe7\x9b3\xb17\x8e\\\x15\xb7c\x9f\x8d\xfd\tR8\xfc\xc2\xcf\xfe\xd7<rb\x89\xd5ǾD\xad;\xcbO\xff\x9d\x1f\xa797\xcf\xdf\xffg\xff\x8c\xb7\x9e\x9d\xa7\x1b\xfb\xf4Ҝ\xd8u\x88=\x87\x1f|\xff\aY^X\xa2<\xe8W\xc0\xb4\xb4~\x10\x19YlQ\x1cv<\xac4\b\\\x84R\b\xc7G8\x01x18.\x94y\x95\x9b\x18S\x85Ŷ\n\x9b\x95\x17\x90\x0f\xf6\x91JR\xe6\xd5\xfb[\xa6=\x93<\xc7\nQu\xb6\xa5\xa9\x02\t)\xb1\xc6R\x94\x9a\xac\xa8\x00\xc9KMi\xcc\v\x00\xeb\xfb\xbd;\v\x90\xdb\xfa\x01Ou\x1b\xc1\xa3\xbb\x83\x94\xfe$\xe7\xd4B\x973\xb1ϟ\xfc\xdb_E\x17\x86\xee\x91e\xc6{{|\xf0\xd1Gx\xfc\xdcw\xf2\xc4\xe3\u007f\xca\\\xbb\x86\x12>Gfg\xa8\xc71\xa7\x8f\x1e\x9fF2\xd3\xdc\x03\xa6s\x83ܪKQ5\xa4\x10\x02\x19\xd6Qq\v\x0eJ'BVQ\xd5A\x94Ey\xabD\xa2$\xd6Z\x94\x1f\"\x9d!\xf9xTm֢\n8\xac\xb6\b)Qq\x1d%\x15Jkd\x9ea\x94\xc3\xee~\x8fI\x92\x91d9\x93\xbc`kԧ/\xc8\x01\xbau\x9f\x9dav\xe7\x00r\xd0\x0fp\x94<\xaf\xa4xtk\x98\x91\x97\x86\xd8sp\x8a\x8c2\xd7X)p[\x1dVϟ\xa3\xccR~蝏\xb2r\xf5\x02\xa6\x1cө\xc7()y\xeb\xeb\x1ffavnZ\xd9\x10H%\x0eӅ\xc3Dp\n\x90㇈\xb8\t\xa6\xa4\xbfv\x9e\xb5\xb5\xab(\xa5\b\xdc\x10\x15\x84\xb4Z]jQ\v\xfc\xda\xd4\x1d\x19\xac\xad\xf6\t+%\xd6\r\xb0v\x801\a\xa3rU\x11Q\x06\x11rZ5v\xa5\x9c~\x1dFI\xc2V\u007f\x97g\xaf]\xe4\xca\xfa5\x8c1d\xa5~\x04xI\xc1xI\xab\xbdq\xe0|\x16\xec\x8f\xe7e\x15x\xe4e\xc1h2A(E\xbd\xd5\xe1\x9d?\xf9\xb3<\xfb\x89?`\xb8\xb1B'\x8e\xb9\xf7\xf81\x9ey\xf19ʢ$ɲ\xe9(\x81\xaa\xc0\x98\x86ȇ\xc9\xe0a\x9cdɆ=\xce?\xff\x1876\xd7\xe9\x8f\x06\xe4\xd3\x0e_9\xcd\x1d\xf2\xa2@\x9b\x928\bYZ8\xcabw\x89\xf9\xe5S\x84\xf5\x19\\\xcfCZA2\xda\xc3j\x8d<\xa8gI\x01\x87\xc9e\xe5\x1e'\x93\x11\xd7o\\\xe7\xfc\xc5\xf3\x9c\xbfz\x81\x1bۛ\xec\r\x06\x14\xa5\xc5\xf3#\x9cؿ\x0e\xd7\xef<\v9\xb8\xce,\xd4\x1e\xdf\x1dfhS\x95F\xd2\"gw4AJ\xc9\xf1\a\x1f\x82 \xe2\xc1\xbf\xfe\x83\\\xfd\xd3\xcfPܼη\x9c:\xce\xd5\x1b\x971\xda\xe2H\x85\xab\x9c\xa9':\xd8!D\xb57O+\x81f\x1a\xe5LFc\xea\xb5\x05\x1e^8K\x18\xd7\x11\xc0d<\xe4\xe6\xcdU\x06\xa3}v\xb67Y\xbd\xb9\xc1\xc6\xd6>\xcf]Y\x03aq\x90ĵ&\x9dv\x97F\xad\xce{\xdf\xf8&\x94\xe3⢦u*\x8b1%E:f\xb8\xb7Ʌ\x95+<\u007f\xf9\x056w7\xe9\x0f\x87\f\x93\fm%\x8e\xdf h4\x90~\xcc8/>\u007fG[\x88\xb5\xd6\x1aS%\\\xf5\xc8\xc5w\xa1\x9fU\x19r<3\a\xfd}&W\xce\xc1\xfa\x8b\xecnlq\xefٻy\xd3\xe9S\xfc\xd9\v/P\xafט\xeb\xceV\x11\xd4\xd4UH\xa5\xaaJ\xae\x10\x94\xba$\xd7\x06\xa4\x83\xdf\xec\x12\b\x85\x9c\x8e\xba\x95e\x89P\x0e\xbe\x1f\xe0L\x1c\x14\x82\xd9z\x03\xf2\x12Ǉ\xbd$aX\x14쏷X\xdbܢ(5\xf7\x1f?Ƒ\xf9\xa5*²\x06\x8c +2ʲ\xe4w>\xfdQ\xd6\xf7\xb6\xc8\U000dcd30\xa4\x05\x14\xb2\x8e\x15.\xd2\xf1(\xa4\xa2(5y\x9e\xbfxǖߛ\x91\x8b\x92r\\\x1a\xb6\x11\xcc\xfa\x8e\"\xcds6\x06\x03\x16|\x9fA\u007f\x9f\xc9\xda5.\u007f\xe6?\xd2\xdf\xef\xd1}\xf8]\b$\xaf;}\x86\xa7\xae\\akg\x9bՍ5\x8e\xce-\xdcb\xa4(\x89\x10\n#\x05\xc6zxu\x0f\x8c\xa5\x18\x8f\xab\xccژ\xc3\xfa\xd2x\xd8c8곺\xba\xca\x13O\x9f#IS\xe6\xdau\x84\x14\x84V\x12\xf9!ۣ1ɴ6\xd5\xdf\xdfc\xa1;\x87UU\xb2h\x8a\x92</x\xe6\x85g\xb8xc\x05c`w\x90\x91\xe3\x81\xe3\xe3D\xf14\x17\x12آ\xc48\x1e[\x99}\xee\x8e\x05\xa4?)\x00\x86ژ\xafH!\xbe++K\xd2Brqc\x9b{\x8f.\xd1[[\xc5>\xf0:LQp\xf2}ߋלa\xb0v\x95ӧ\xef\xe1\xcc\xc5\xcb<\xf7\xe2y\x84\x05c4\xea \x878\xa8\x15\x1aP\xaeW\xf9x\xa11\x12\xcad\x82\xb2\x1eEYr\xf9\xea5\xfe\xef\xe7/\xf3{_|\x8a\xebk7\xa9\xdb\x1ce4\xb1Z\xa7[\xf78\xb54C=\n\x98\x8bB\x94\xabXZ\x98'\xf2\x14\xc9dD\\o#\x10dYJ\xbf\xbf\xcf\xce\xce\x167n\x8e\x19\x8c\x13\xb2Ґ\x1bAi\xaa\xfd\xcc\x0f\x02\xa2Z\x8d0\f\xf1#\xc9\xee\xfa\xcaS/\a \xf2\xa5z\xa3O<\xbd1\xc2\xf2\x05)\xa0(-\xc6X\x9e\xb8t\x95ٓ\xa7\x18\xednSX\xf0☠\xde$j6\x91*\xc0\x8b\x1at;]\x02\xcf#\xf2\x03\xac\xa9\xa6k\xb1\x06{P7\x14\x02\t\bQ\xe5\vE\x9e\xf1'_~\x9c_\xff\xf0G\xf8\xdf~\xf9\xd7\xf9{\xbf\xfcQ\xfe\xf0j\xc2W\xd7\x06<\xf8\xf6\xf7`\x8f\xde\xc3\xe4ă\x04G\xee\xe6\xf4\x91\xe3\xacl\xecQhM\xa9mU\x1d.\v\x86\xa31\xdaZ\xa2(\"p=\x14\xb0\xba\xb9\xc9'\x9e:\xcf\xfav\x9f\xddL\xb1҇\xb5\xb1 \xa9/r\xf4\xa1w\x90Z\x9f\x8b\xcf_\xe0\xfa\x95k\xe4\xe9\xe4\xf3\xff\xf4g~\xa8\xbf|\xec\u061d\v\bU\xd4\xff\xbbB\x8a\vZ\x1b\x8a\xd2\xf0\xcc\xca*7\x06#Z\x9d\x0e\xa34\xa3~\xe2.\xf6\x9f{\f\xab\x14(\x85\x1bƼ\xfd\xcdo\xe5\xbe\xd3g\x99m\xb5\xaaysc0\xda`t\t\xa6Jܐ\x02\xa9\xa6\xdc+mXl\xcc\xf0\xd9\v\xdb\xfc\xbb?\xf9*\xf7>\xf40\xefx\xf4QtY\"\xa5$\x9d䜾\xef,\x8d\xd3w\xf37\u007f\xf8\xc79\xda\xee0I*\xa2\\\x92\x17\xe4eI\x9a&\b\xa5\b\x82\x10W)\x9aa\xccs\xd7VY\xdb\xdc%u\x1b\x04s'\x89[\xb3ȰA<\xbb\xc0}ox\vo\xfc\x8e\xef'\fCf\xdb\r\x1a\xae\xfa\x17\xbf\xf9\xc9?\xb17VV\xeel@^\\\x1f\\r\xa4\xfc\xd9\xc2\xd8$+5\x9e\x92\xfc\xe6\x17\xbf\xc2\xfc\x91en<\xfb$s\xdf\xf6](? \xd9\\\xaf*\xb5ZSo6y\xf3\x03\xaf\xa7\x16F\xd3J\xfam\x1d\xbc\xaaKq\xd8\tt<\x8f\xa8\xde\xe0\xe4\xd2<\xff\xfa'\xbf\x9f\x9fz\xc7\xeb\xd0\x17\x1f\xe3\r\xf7\xdfÑ#Gx\xec\xd9\xe7p\x1a1\xad\x99\x0e\xf7\x9c9\x8d\x0e\"\xdeu\xdfY6v\xaaVk#\x0e\x89\x02\x1fk\xc1\xf5\xa3*\xa37\xe0J\aO\xb9āϷ\u007f\xc7\xf7\xb0\xb7\xb7Ǩ(\xe8\x9c:I\xddqiw\xda\xec\xbc\xf0\x14\xadz\xc4]\xcb\v;G\x1a\xf5\x17>\xf6\xb9/\xbd\x1c\x1e\xeb\xa5c\xbf/\xb5B\x86i\xc9 -.\x87\xaeڐR|\xc0U\x8a\x17\xd77\xf9з\xbd\x83lc\x85,\xcbY|\xeb\xbbpÐl8\xc4\np\xa3\x00\x17A\xe4\xfb8\x8es\xb8\xa9K\xa9\xa6\x91\x96BxSv\x89T(\xdfG\b\xc8\xc6\tG\x16\x17\xb8r\xe9\x02\xe7\xbe\xf2\x05\x1ey\xf3#\f\a\x03\xee\xba\xeb4\xc7\xea\r\xbe\xf5\xde\xd3l\x9f\xff*7\xae^\xa63ۡ\x1e\xfb,\xcdw\t\x83\x80\x99\xee<\vGOR\xab\xb7\xd0\xc9\x04\x8ceso\x8f\x8b\xebkԕ\xe4\xbecG\x89\x1c\xc1\x89z\x9d\xb7\xde\u007f\x0fٍ\v\xe4[+\xb4[1\x0f\x9e8\xf6\xd4L#\xfe\x95O?\xf9ܝ=A5LK\xe6\x1a\x01\xe3\xac$\xf6\xd5\xd3\xdap\xb7\xb1\xf6\x81BkV\xf7\xf6\xf9\xc1\xef\xfcN\xf6\xd7\xd7\x18\xde\\#\x9a\x9b\xc7\rB\x8a~\x0f\xbf\xd3\"\x1d\xf6\xf1}\xff\x16\xcfw*\xb5$\x94B(\x89t=\xa4\xe7#d\x05\x90\xe3:(%q\x85\xe0\xccɓ\xf4v6\xb9\xf9\xc2ӴL\x82?\xd8F\xec\xacr\xe9\x89/\xb2\xbd\xb1\xc2\xe2\xd1%\xee=s\x8c#\x8b\v\xc4q\x8dF\xabÑc\xa7\xe9.\x1d\xc7o4\xd1\xe3\t&͘\xe4\x19\xa3lB\xec\xc2\xc6\xc6uL6D\x96c\xb6\xd7/\xd3\xdbݠU\x0fY\x9e\xeb\x12\xfaާ\xfe\xc1\xaf~\xf8\xb7x\x99\xae\x97\x94ۻ5H\x99\x89=vF\xb9\x99\x89\xbd\x9f7\x96\xb7\x808\xf1\xa5\xf3\x17\xf9\xddǞ\xe4Co\u007f\x1b\x93\xf1\x88\xfe\xd5\x17i-\x1e\xa5\xde\xed`\x84\x9d\x96\xc1\xed\xd7\xd2&\x8c\x9dr\xaa*\x02\x83Ă\xf2\x10NE@\xf0\x1a\x96\x9a\xa8\x98(?\xf8\x81\x0f\x80\x10l\xed\xec\xd0\xdbߣ(K\xc2(\xa0\xd3nb1$\xc9\x04\x8bA)\x87\xee\xfc\x12\xed\xb9%|?@\x86u\xbcv\x97\xa4\xd7\xe7Hg\x86\xc0\v8\xb18ǉ\xc5\x05v\x87cJ\xadq\x94\x9a\xf6\xb5\fV\b\xedx\xdee^\xc6\xeb%\x17\x0eؽE@~q&\xf6\xfe\x9e\x10\xfcJ\x96\xe7\x9d\u007f\xf3\xa9\xcf\xf2\xa6\xfb\xefg\xb9\x1ec\xb5\xa6\xcc\x12\x82\x99n\xc5\xeeP\nc\xf4a\x8b\xd4\x18\x8b\x10US\xcaZ\x83\xd0U\u007fC`\x11\xcaE)\x85;\xedw(\xc7!\x1fO0\xc6p\xac\x16s\xec\xd8Q\x8a\xb2 /ҪN\x85\xa0\xdel#\x95C\x18\xd7\b\xeb\xcdJUH)\x84tp\x9a3\by\x9dv\xbdF\x18\xf8x\x8eC3\x8e\x99i4\x18\xa7)y\xa9)\xb4&\xab\x82\x06m\xac\xdd\xf9\x86\x02\xe4\xeb\xc0\xf9\xe8L\xecM\x84\x10\xff\xfb\xda\xd6\xf6=\xff\xebo|\x98\xdf\xfa'\xff\x882K\x91Ra\xb4F9\x0e\xa3\xd1\x00\xb7Ѯ\x16PW\xbc)1\xadMY\xa31\xbaD\x01\xe2\x80A\"@\x05!BT\x846\xc7\x0f\xd0E\x8e.\n\xac18\xd6\xe0\x16!Zk\x84\x04\xa9\\\xdc\xc0\xc7\v\xa2Cz\x8fr\\(JD\xad\x8e\x13\xc5\xe8a\xc1\xd2\xcc\f\xee\x94\x03\x16\xb8\x0e\xcd(B\x9b\xaaz<\xces\xfa\x93I\xb9\xdb\x1f\xddx9\xd7\xece!\f'\x85\xe6`\xb0>)\xf4\xe5\xd0S\x1f\xf1\x1cU\xdb\xed\xed?|\xf9\xc6*\x1f|\xcf;\x89jMĔ\x9f{\xf1\xfc3\xd4\xe2\x1a\xeetVC\x1e\x8c\x13\xc8J\x9eIH5\xa5\x90z\xb7h\x15P\x8dɹ.\x8e\xe7\xe1z>\xd2Q`L\xc5<t=\xbc0ď\xeb\x04q\r/\x8a+I'\xa9P\x9e\x8f\xf2|\x90\n\x11\xc5\xd8ɘ\xbc\xb7\xcf0I)tA#\f\xe9\xd6\xeb\xd4ÐZ\x10P\x8bB\x84\x10\f&I\xb27\x1c\xff\xd2\x1f?\xf5\xdc\xcdo(@\x0e@\xb9\xed\xf1h\x94\x95\x1f?3_o<\u007fu\xe5-\xbf\xfe\a\u007f$fb\x9fN\xa3\xc6\xfa\xd5\x17\xb8\xber\x85\xb9\xb9E\x1cǙVz\x05R\xdc6\xf7!\x9d\x8a\\}\x00\xc8A]\xfe\x80?E\xd5\xcbp|\x1f7\x8c\xf1\x1bM\xbcz\x037\xaa\xe1Fa\xc5\x03V\n\xe5y(\xb7\x1a]@*p\x1c\xf0\x03l\x96\x91mo\xa1\xad\xa57\x1e\xd0m4\b\\\xf7\xa0\xf6\x8f\xa0\xd2l\xdc\x1e\fǻ\xfd\xd1/\xfeɳ\xcf\x0f\xbf!]\xd6\xd77\xb1F\x99\xf9G\xad\x9a\xffߦy&~\xea\x17\xfe\x0f\x1a\xa1Ǳn\x9b\xd3ǎq\xe2\xe4\xdd\xf8\xaeW\r\x88J\xb0VN\xc9\a\xd3^\x86\xd6\x15KQ:\xd3\x00@\xdcj>1-\xa1#\x11\x8e8$\xbd\t5՜s\xddi5\xbf\xa2\xf6T\xfdwQ\r<\n\x90\xf5:\xca\xf3iŵj@h\n\xc2\x01w\xcbXK^\x96dy\x99\xfe\xc3\xdf\xf8\xdd\xf5\x97s\xad\xe4+\x01\xc8A\x13\xebō\xde\xc0SR\xbaJ|.\xb2l\xd2\x00\x00\x15\x8aIDATx\xaeYCJ\x87\x1b\xfbC\xce]\xbf\xc1\xc6\xce\x0ee\x91W\x99\xba\xb5\x15)\x9a\x8aiBY\x1e\xf6\xbcoq\xa9\xccm\xfc\xdc)\xc3D|\x1dHӂ\x98\xb0\x06\x8a\f\xf2\f\x9b\xa7\xd5k\xa4\xac\xeac\x80\x88b\xdcV\x8b\xc0uiF\xf14\x80\xa8\x88\x15\x020\xd62\x98$\xa4Yv\xed\xe5^\xabW\x04\x90\xdb-RI\xb1'\x85\xf8\x88\xe7H\\%\x91\bz\x83!\x9b\xfb\xbdj@\xb3,\xb0\xe6\x80\xf2s\xc0\x872\x98<\xbbm\xb4\x80\xaf#\xbb\xdf\xc6\xf5\xbc\xed\v\x15!\"Ŧ\tf2\xa4ܽ\x89\xc8\v(ʊϥ\x9c\xea[\x1d\a\xaf٬\x14\x80\xea\x8dC\xaa\x90\xc5b\xa8&\xa8vG#&yq\t\xe0\x17~\xec\xbfxm\x00\xf2\xf0\xa9\x99\xef5\xf0i)\x85\b=\x17%\x05J\n,\x96k7\xb7(u\x895\xf6k\xeer;\xbdK\xad.\xd1\xc9\x04\xab\v\xec\x01\x8bݚ[\xb4\x9f[\xdfPEgy\x86Mǘ\xc9\b=\xdc'\xbb\xb9\x8aP^eMy^\xe57RVϕ\x83\xaa\xd5\t\xe2\x80V\xa3qh|LK9\xe3,'M\v\xf6\x86\x93\xaf\x00\xfcܯ\xfe{\xbe\xa1\xf7\x10\x80\x13\xb3\xb5\xa3\xa56o)J\xfb\xdf8\x8a\u007f\xae\xa4\"\x0e|ʩ\x8c\xd2\xf9\xeb+\xe8\xe9\x9d\xc9a9\xab\"\xa8!\xa7;F\x96a\x10\bπu\x10Rb+\t\xe5\xea\xd5\a\x13\xb6e\x81\xd59\xb6ȱ\x93!6\xa9\xaa\x1c*\x880i%\xbd\xa1\\\a\xd9lW\x96b\f6OQR\x12y>7\xc6#B\xdf\xc3J\x85\xb1\x86\xfex\xcc(Ky\xf6\xda\xcaG_v\x17\xf2J\x01\xa2\x84\xd8\x1a&\xe5\u007f\xb7;N\x9dc\x9d\xda\xeb\xad2\xbf2S\x8f~</5\x93\xbc\xe0\xe6\xee.\x93<\xa3e\f\xc6\xdeb\x13Zk\xb0FT{\x8b\xd4P\x14\x95Y\xeb\x12\xab\x1c\x84R\x98\xa9Z)\xba\xc4\x149hS\xcd\x12f\t\xb2̰yN\x99\x8e\xd9\xfa\xf2\x1fc\x92\tZW\x84\a\xb7ަ\xf5\xce\xef\xc2_>\x85\x1d\xf40\xc6r\xf9\xfa\x15\xb2<\xa1\xc8s\x84㒖%\xfdQB\x92\x15_\xfe\xc4\xe3O\xad\xbff\x00\xb9\xbcU5\x9f\x97ZQkk\x98\xfe\xed\xa3\x9d\xe0\x1d\xb5\xd0\u05fb\x83\x89\xea\xd4\"J\xa3Y\xb9\xb9\xc6|k\xe6\x16\xf3ܪC~\xad4\x06\xb4\xc0\xd8\x1c0HSI^ \x05L\x95J\xad.\xb1\xba\xc0d)\xb6ȐF#]\x0f5\xd7er\xe19F\x1b\xeb\xbcx\xf5\x1a~\xe0sti\x89r<b\xf2[\xff\nu\xfa\x01\xc6Y\xc9\xe5\x95\x15\xce]\xb9\xc4Cw\x9d!\xcf3\\)\x19M\x12vF#Fi\xf2\xab\xaf\xc8&\xfbJ\xee!K\xad\x88\xf5ޤ\a<uvq\xf6Ѽ\xd0f\xb9\xdbT\xc6X\xf2Rsae\x95\x87\xce܍S8ӤPMY\xef\xa2r3SGfr\x83%\xaf\xa6\xa8\x848\xf4\xf7X\x8b-S0%\xd2j\xa4r \xaa#\xdb\xf3\xb4\xe7\x8e\x10\x1c\xbf\v\xb5v\x1d+%I2&ݾ\xc9`g\x8b\xcf|\xf4\xa3L\xb4Ey\x01\x8dF\x93aVR\v\rE\x92\xb2\xbe\xdfg\x98L\xc6ږ\xbf\xff\x8ax\x92W\x12\x90aZ\x1c>>\xbbԙ\x9bm\xd6\xfe\xfaL\xbd\xe6\xb5j\x11\xae\x14\x8c\xb2\x8c\x85N\x93v\\\xc7Z\x8b\xe3\xb8Ubx[\x82\x06\xe6\xd6D\x9a\xb5\x15/\xf7 $.S\x84\xce+\x1e\xb0t\x90q\x03\xc2:2\bA\b\xdcN\x87\xd6\xf21Z\vG\xf0ku\x06E\xc9X\xba<}}\r'\xa817ۥ\x1eF\x14ڒ\x95\x9a\xde8ew4a\x98\xa5\x1f\xefMƿ\xf9\xd0\xc9\xe3\xe5W/]\u007f\xedX\xc8\xed\x97먤S\x8fM-\f\xf1\x1cE\xe8y\xf4\xc6\x13\xbex\xeey\x96:]\"[\xc3q2\xfc\x03@,U҆D\xa3\x0fA9\fv\xadA\x98\xe9\x10\xbf\x92\x88Z\x13\xeb\x87(?\u009aj^\x9e\xb2\x9c\xd6\xc7\f\nA\xab\xd6\xc0U\x0e\x8f\xbc\xeeA6v\xf6p\x1du\x18ٍ\x92\f\x83%-\v\xf6'\xa3\xcf\xff\xeb\x8f}.y%\xd6E\xbeZ\x80$\xb9Q\xa1\xe7\xe19\x95\xc2\\\xe4{t\x1buz\xfd\x11O\\|\x91\"Oɳ\x94<O)\x0f\x12\xc3\xe9~\xc24Y\xb3\xc6`L\x95\xc1KST\xa9\x88R\x10\xd4\x10~\x84\f\xe3[\xf3#Z\xdf6Oh ψ\u0080N\xab͑\xb9٪d\xf3\xb5\r\x00\ncI\xcab\xef\xc6\xfe\xeec\xafX\xa2\xf6j\x01R\xf3}\xcfu\\\x19x\x95f\x16XTQҊ#\x9e\xber\x8d\xf9V\x93Ӌˇ\r+\\\x0f\xa5@\xa0\xa6\n\x11U\xe4%\xb0Ha\xa7\x03AU\xaf^8nU<T\x0eVW\xd6ĴFf\xc7C\x8aI\x82\xab\x14\xf8!Eo\x9f\xb8и\xd6R\nA9\x9dG)\x8d!-5\x1b\xbd\xfd\xab\xcf\\\xbb\xfc\xe4k\x1e\x10!\x85\x17\x05\xbe\b}\x8f0\xf0\x98i\xb7\xe8\x0fG\b)0#˧\x9e~\x86\x0fz>\vm\xf3\xb5\x199\xb7\xb4ޥ\x14\b\xa3\xa7\x1b\xfb\x94\xa0\xad\\\\G\x92\x959\x02\r\bl\x9aѿ|\x85틗\xb1\xfb\x03\x14\x02\xcf\xf3\xa8G5\xae]\xbc\xc8z\xbf\x8f\x97\xa5\x14\xbe\"\xeb\xb6p\xa3\x88a\x96\xb17\x19\xdb\xd5\xdd\xedO^\xb96\x98\xbc\xf6-$\xf4=\xc7Q\xd2q\x14\xbe\x1f\xd0l\xd4\xe94\x1bU\xe9\\H\xc4x\xcc\xef|\xf91\xbe\xf7\xcdod^W\xe3e\xbe\xa5*\x14\n\x81\x94\x12c\xab\xf3CJk\x90\b\xf2,c\xf5\xf2%\xfe\xc3\xef}\x9c#g\xee\"\xf6<\xa2A\x9frw\x8f\xc0\t\x98\xa9\xb5h\xc7\xcdj\xe6\xbd(\xd8\xda^幕U\xf4tND\x8e4/\xbcp\x99\x17H9q\xe6\x18\xc3$3e\xa9\u007f\xf9\x15\xad-\xbdZ\x80h\xad\x1by\xa9UCJ\x94\x14\x84\x9e\x87\xa3$s\x9d6RTB\x99b8\xe6\x13Ϟ\xe3\xbd\xf7\xdf˒\xb5\x18\xa3\t\xc2\x18,\xb8\x9e\x87\x90\x92\x12\x811\xa2҉/\f\x17.]\xe1\xd1w\xbf\r'\x97dW6\xc9\x06\x86R\xd4\xd9\xcd4W6\xaf\xb2\xd7둗\x05\x8e\xe3\xa0\xf3\x9c\x8d\xf1\x04\xab\\\x84벒&\xa8V\xc4\xec\xfc\x1c\xa3,%I\xb3\x8f}\xea\xcbϯ\xbc\xff[\x1f\xe4?~\xe9\xd9\xd76 \x9e\xe7xX+\x0e\xce\xfb\b|\x1f߫\x1aT\x95\xae{Ex\xd8\x1a\f\xf9\xf4\xb9\xf3|\xfb\xfd\xf7\xb2<\xcd9Ĵ\xaa\xeb\xb8\xee!_\v\xa3Q\x9e\xc7{\xbe\xfd\xbd\xd3L\xdf`O\x9dB<\xb7J\xbd\xd3\xc5}\xf7{ȶ\xb7\xe9=\xf34\xbdkW譯3\x1e\x0eq\x85\xa0\xd9l\xe0G5~\xed\xdcS\\oI\x8c+\x19\x8fS\xb4\xb1\xff`\x1a½b\xeb\xa2^-@\xde|f\xf9m\xedZ\xfc\xbe(\xf0e\x1c\x85,\xccv\xa9\xd7b\\GQ\x8bBjQD\x92\xa48R`u\xc9\x13\xab\xeb,\xb7[D\xcet\xf0\xc6q\xa7\x15٪\x99%*\x9a\x1dz\x1aMYk)\\C\xcf-\xe9_\xb8\x86\xb3\xb9M\xb4\xb0@\xfd\x81\a\xe9\xbc\xe1Mԥ\xa2i\f\xcdf\x93\xd2\xf5\xb8\xd2\xef\xf1\xd5\xde&\xe3z\xa5\x04\x94\xa4\xf9/}\xe6\xb1\xf3\xff\x0e\xe0\xe2\xea\xd6k\a\x90\xe3GZ\xf4\x87\xe9\xc1c\xa7\xd5\b\xa2Ҙ\xfa\\=\xfe\x99#\xdd\xd6\xfd\xb50\xa4\x16\x87\xcc\xcd\xcc\x10\x06aU\x01V\n\xe584\xea5\x86\xe3\x11\x0e\x95\xdc\xf8\x13\xab7\xe8\xd6cB\xa5\xe0\x10\x94j\b\xb4J \xbf\xb6me\xb1\xc8\xd0e\xdb\xd7l]\xbf\xca\xde\xe3O\xb0\xff\xd8\xe3\xec>\xf68{\x1b\x1b\xf4\x8b\x92\xdd<\xe7\xdc\xce&\x9f[\xbb\xc2N\u06dd\x16\x83\xcb\v\xe3q\xf6\x13\xa7\x8et\x87\xd7n\xee\xf2\x9a\xd8CN.W\xa4\x85\xebk=\x8e\x1fiՀw\x03o\x03\xde<\u05ce\xe3a\x9a\xdeu \xb1\xe1(\x85\xe3\x1c\xf0\xad\x04y\x91\x13\x06\x1e\x02\xcbٓǹp\xe5Z5_\b|\xe6\xdcy\x1e9u\x92{\x97\x96\x00\b\xa2\x1a\x16\x81+ܩP\x8c\xac\xdc\x15U\x12)\x1c\x97\xf9\x859.%c\xd6\xc5M\xcc`\x1f\x99iƙ&)JV\x87}.\x8f{\x94\xed\xa0b\xdcC1I\xb3\x9f\xff³\x97\xd6_\rϡ^\x1e0Z\x80\xe0Z\x05\xc6;\x84\x10\x1f\x16\x82\x9f\x16B\xbcSJq\"\xcf\xcb#5\xcf\r\xce.\xcdS\v\xab\bk\xbe;C\x18\x04x^\xa5\nj\xad\xc1u\x1c\\\xc7\xc1\xf3\\\xf6{}\x02\xb7\xfa\xdaS\xab딦d\xae\x11#\xad\xc5q=\x84PU\x87q\xfa\x0f\x01fz6\x95\xebz\xd4\xe3\x06\xa95\xec\xe6c\xd2@!\x1b\x01^;&\x9e\xa9\x93z\x82QYL\x8b\x89\xe9o}\xfa+\xe7\xff\xe7W˕\xbf,\x80\xccw\x1b\\\xba\xbe\xcb\xc9\xe5\xf6\xfb\x10\xfc\x87\xd18?\x9e\x17\xdaI\xb2\x92\xfd^B\x9a\x95,\xb4\xea\x9c=2O\x1c\x05\xb4\x1au\xba\xed\x16~\x10\xe0{~5\xac?U\x10u\x94\x83\xefyH%\xe9\xf5\xfb\xd4B\x9f$/x~c\x93A2a\xbe^\xc3\xe8Jת\x12\x1b3 $JT\x9aZB)\xa4P\xf8~\xc8\xc2\xdc\"3\xed.\x82\xea`1\xdf\xf1h\x841\xd6Zn\xf4z\x18\xcb\xf6\xef\xff\xe9So\x03\xec\xdb\x1e:\xc3\xca\xe6\xde7> \xa7\x8e\xb6\xb9t}\x97SG\xdbo\x14\x82\xdfI\xd3bfkoB\x92\x16h]VJ{Jr\xb4\xdb\xe0\x9e\xa3K\x95R[\x1c\xd1n6\x88\xc3\x10\u05ed\x88p\xc6\xe8\xc32\x89\x94\x928\bɋ\x9c,˨\xf9>\xbdI\xca\xca~\x8f\xddѐv\xe0U\x8a\xa2\xba\xac\xf4\xb4\x0e\xf6\x0fY\x81!\x84\x9c~\x14\xd5yUs\xf3\x84~@2N0ư=\x1ar\xa3\xd7\x1b\x1bk\xdfz\xfe\xea\xc6\xf6;\xdep\x96\xcf?u\xf1\xb5a!\xfb\x83\x94SG\xdb\x12\xf8\x03!\xb8+K\xb3\xaa\u007f!n\x95\x95\\\xe9\xb0ԩs\xf7\xf2\x02\x81\xef\x13\xfa\x01\xadz\r߯\xa4_\xa5\x94\xa8\xe9h\x9b\xb5\xa6\xa2\x04\tI\xe8\a\x8c'\x13$\x16\xdfQ\xec\x8c&l\rF\\\xdd\xde\xc1\x16\x19\x91\x04\xa3s\xf2<\x9fr\xb3\xdc)@\x95\v;\x10\xb09(J\xf6z=\x8a\xa2\xe0\xabk\xab\xf4\x93\xe4\xef~\xe4sO\xfe\xd1\xf7\xbd\xfbM|\xe2Ϟ\xe35\u1c8ĕ\f'%\xedf\xf8\xb3\xc0\x8f\x008\x8e\xa2\xcc\xd3\xdbJ\x1f\x96F\x140ۈ\xb8gy\xb1\"\x80(A\xabQ\xc3\xf3*uR\xc7q\x0eg\fʹ\x80(\xa5\xc4q\\\x94\x94\xf4G#B\xa7\"(\fӌTk\xae\xef\xf5\xb8\xbc\xb5\x85kJ\x9a\xae\"\x99\fɳ\x04\xa5\\\x94\xe3NE\xe6\xf4\xe1\\\xe2\xa0\xd7g\xd4\x1f\xf0\xf8\xcaU.mm\xff\xabq\x91\xff\xe3K+[\xe6\xfc\xd5\r^\xcd\xeb/\rȉ#MZ\x8d\x80\xde0\xe3{\x1e<Ʌ\xad\x1e\xc3I\xc9lC.\xf9A\xf0G\a\x95\xe4\xea\xf0GEY\x96\x87j:\x91\xefҩ\xc7,u[\x84\x9eK\x9a\xe5x\xaeC\xa7٤\xd4%\x9e\xebN\x01PS\x18\x05Z\x97()\xf1\\\x17c\f\x93ɘF\x10T\x038E%\x10:.4/l\xeep~}\xbdꧧ\t\xfd\xddm\x06\x83>E^\x92\xa5)\x83ހ͛\x9blܼ\xc9ӫ\xd7\xf5\xe3+\xd7\u007f\xf1#\x9f{\xe2\xef^Z\xd92\xdf\xff\xee\x87y\xfe\xea\xfa\xab\n\xc8_\xf9ؼ\x93˭{˲|4KS\vԁ\x9f\x89\xe2\xf8\xcc\xc1\xa9\x9c\x87\xe5\xf6$a\x92d(!\t=\x8f\x13\xf33\x9c^\x98\xe5u'\x97q\x95\xa2Q\xaf\xf1\xe0\xd93\xb4\x9bM|ߧY\xaf\xa3T\x159\xe5yN\x9e\xa6\x14\xd3\x12\xfc`8\xe2\xfa\xda\x1a{\xfd\x1eI^\xf2\xfc\xc6\x16\x834\xc3b\xc9JC\x9a\x97\xe82\xa7\xee(\x96\x9auf\xe2:\x9d\xb8\x81\x92\x0e\x93B\x93h=\xee%\x93\xcf>\xb3\xb6\xf2\x8b\xbf\xff\x9f\x9e\xfe\xfc\xdf\xfa\x8e\xb7\x90d\x05\x1f\xf9\xdc\x13\xbc\xda\xd7_)\x0f9q\xa4y\xc2Z\xfbﭵ\xaf?\x90\xc4\x00H\x93\x04?\b\xa6\vZ]a\x18\xe28.\xf9tTz\x92\xe6\xac\xed\xf6\x10B\xf0Щe\xc6\xe3\t\x1bە\x8cw\xa7٬ƫ\xeb\x15\xdf\xd7u\xddJj/I\xc9uA\xbd\x163ם!+rJ=b\xb9\xd3\xe4\xfan\x8fI\x96\x139\x95B\xdd\x04\xcb\xc8\x1a.\xed\xf5\xb9\xb6\xd7\xc3S\x0e ؛\x94\xe4\xf0c\x9f\xfa\xd2ӿ\a\x14\u007f\xeb}\x8f\xd0\x1b%\xfc\xe1\x17\x9e\xe6N\xb8\xfe*.K\x00?\n\xfc\xb0\x94\x12\xa5\x14\xba\xac\\\x871\xa6\x9a\x1f\x9fVe\x0f\x80RJ\xe2OE\x90o\xee\x8f\xc9\vM\xa9KҬ\xa0۬1N\x12|ϛ\x9e\xfc\xac(\x8a\xaa\b\xe88\x95\x12\xa9\x94\x12[VI_\xe0\xfb\xe4yA\x9aU\xa7\x82\xfa\xaeb\x9c\xe5\x18\v\x9e\x92\x95\x14\x96\x05!\x15F*\nkIu\xd5\t\xfc\u0093\x17~\xa24f\xf2\xc1w\xbe\x91\xdf\xfe\xe3\xc7yqe\x93;\xe5\xfaK\x03\xd2j\x04\x12\xf8.\xe0\xed\a\xa1\xa93\xf5\xef\a\xe2_EQ\x1c\x0ec\xde:\x01\xa1\"\x19\xd6c\x8faZ\xb07H\x18&\x19yY2ӈI\xd2\xf4\xb0\xb0\xe89Ρf\xaf\x9a\x9e[%dEx@@\xe0\xfb\fǓ\xea<\x92\xe9Y\xb9IQTj\x19R\x90M_\xc7\xf49B\x90\x15%\x17W7\xff1P\xbcp}\x83;\xed\xfaK\x03\x12\x05R\x00o\x91B\xbc\x9bÅ\x16\x87w\xb3\x9dn\xe0\a֢\xa7\xa7\xd4\x1c|N\x1bC\xe0+\x1cW1\xca\nV6{\xec\r\xc6\xd4B\x1fW\t|ϯ\xe4a\xa7\xadZ9\x95h\x95R\x82\xad\xbe_M#\xb1\xf1dLQ\x94X8\x94P\x92R\xa0\xad\x9d\x8aZ\xdf:\xf2;/5Wnl\xfd\xf7\x00\xa7\x96\xdb\xec\x0f\xd2o|@\xe6Z\x0e7\xf7r\x1b8\xe6G\xad\xb5\xaf\x97\xd3;\xfapcR\n\u05edF\v\xca)\xed\xd3ZK9ui\a\x1b~u\x84\x87\xc5\xf7$\xcaS\xacl\xf7y\xe6\xea\x06Y^P\xf7\x15Q\x18\xe0\xb9.Y\x91\x1f\xb6m]\xb7b\xa2\xd8\xe9ĕ縤yF\x9a\xa5\xa4e\x15\xd6&\ad:\x04y\xa9\x0f\xa5e\r\x96</Y\xdd\xdc\xdd\xd3\xc6~\xe5N\x03\xe3/\fH\xb7\xa5\xa8\x05\xd5N\x1d\a\xf2\xfb\x80\xff\xc5\x18\x83.\xcb[bǷ\x91\x9e\x1d\xa5\b\x83\xcaՔ\xbarc\xba\xac\x0eu\xa9\xac\xa5bM\x1bcP\x12\x9a\r\x1f!\x04O\\\xbc\xc1\xb9\xeb7\xa9\xf9\x8a\xc0s\t=\xaf\xb2\x00[Y[\x9a&\x95\xd5\x19\rX\x94\x90\xf4\x87CJc\xaa\xf1\xb3\xa2D\x1f\xb8McoO\x81HҜ\xdex\xfc\x9dK\xb3\xf5\xb3\xedF\xf0\xb9\xfdA\x9a\x02\x9c>\xda98R\xfc\xd5\x0f{\xe7\xebnC \x1a@\xe0H\x11K!b)\x84\x03\x90\x1b\x83F\xbbFP\x13UX\xfbz\xe0=ӏ\xb7\xbd\x93\x98Vm\xab\xa4\xee\xd0Z\x1c\x89++K\x18\x8dSҬ\xa4,\xf5\xe1f]i\xba\xdf\x12,\xf6\xbdJ\xe3}g/ao\x90\xf2\xc8]\xc7\xf9k\x0f?\xc0\x03\xa7\x8e2?ӦU\xaf\xe1VUY\xb2,\xc5\xf7<\xd2,gem\x9d\xdeh\xcc\xced\xc2\xf6`\xc4 I\xd1\xc6\xd0O\nJ[)\x14\x95ư\xb9;\xe0\xb9K+̴B\xac\xb5/\x82\xf8\xb1˫{_\xa8\xca>\x1d\xae\xac\uef7a\x80\x1ck\x05\xbf-\xa4\x98\x17ВB\x84B\x88z\xb5\xf0\xd6\x01\x84\xb1\xd6Z\xf0\xb4\xb1B\x1f\x9c* ,\xe5\xb4\xc4-\xbe.\x95\x11\xa2:C\xea\xe0\x8cA`\xaaF*\x89\x02\acJ\xf6\xfa\t\xe3I~\x984\xba\xd3>yE\x88\xb3()\xa9\xc7. \xb9\xb19\xa4\xc8\xe1\xae#\xf3\xbc\xf5\xbe\xd3\xdcwj\x99\xb3ˋ4\xa2\xa0\x9a\xd11\x95\x14\xc7h<a\u007f0`o4a\xbd7`o<Fk\xc3~\x92Q\x9a[\xc2ɗo\xec\xf0\xf4\xc5\xebt\xdb\x11\x81\xe7`-=\u0ffa\xbc\xba\xf7\xfb'\x16Z\\\xbb\xd9{u\x019;W\xb3\xe26{\x99\xaa\xe7!\xa7Bbjꂪ\xa1\xa5j\x93Զ\xd2$)\x8c!\xd5%\xe5m\xf5\xa1\x83KN\xcf#\xac\xf8N\a}\x0fI-\x94ľCZhzÔѸ:8\xc5\xf5\xbc\xc3c\x91\xaa\xd7B\x188\xcc4#\xf2\xc2p\xe5F\x9f\xfe0g\xaeݤY\vy\xe3ٓ|\xfb\xc3\x0f\xb2\xd0n\xe0K\x8bѕ+\x1bN&l\x0f\xc7\\\xdb\xda\xc5Z\xc30/Hr\x8b\x9eNO=uq\x95\v\xd7n\xd2j\xf84j\xd5\xc9q\xd6p\xc9X\xfb\xfe\xabk\xfb\x17_\xf5=d\xa9\x11\xfc\xc3\xc0\x95\x84\xaeC4\x15\xa3\x8c\xbd\xea|\xf3\xc0Q\xf8\x8e\xc2s\x14\x81#\t\x1c\x87\xc0\x95D\xaeC\xe4\xba\xd4<\x97\x86\xe7\x11\xbb\x95$\xde\xed\x87\x0eX[\x1dN/\xa6\xb3\x82\x15\xa8\x96,7\x95\x04`\xe827\x13S\xaf\x05L\x92\x9c,+\xa6\x13\xb2\x15(Ƃ.\ry^R\x8f\\\x8e.6p]\xc5\xd6ސ\xf5\x9d\x1e_\xbd\xb8\u0093\x17\xaf\xa1-\xe4\x06\xc6Y\xc6\xfepD\xa9+y\xd7b:k\x128.\x93\xa2$+J6v\xfb\\\xbf\xb9K\x96\x97đC\xe09S\xc1k\xdbI\xf3R9R~*ե\x99kU\x8a\xa9\xaf\x8a\x85\xbc\xfb̜U\a'c}\xfd4\xd8m\xad\xd0\x03B\xb39\x10\xa9\x84J\xb1ZH\x04\x95\x9b\xd1\xc62)J\x86yΨ(\x19\xe5\x05\xda\xdaj\xafp\x1cn/\xa7\xb8JЬy4j\x95\xbb\xba\xb93b\xaf7A\x88*\x9f9\b\x0e\xaaQ@A-\xf2h7*\xb1\xcb\xcd݄\x1b[#\x86\xe3\x1c,,v[\xcc4\xeb\xcc5c\x1a\xa1C-\xacN\n͊\x92\xfe(\xe5\xca\xd6>\xfb\xc3\t\x83Qr\xe8\x16\x8f/Չ\x02\x97\xa2\xb0\xa4y\xc9hR\xa0\xb5\xf9\xd0No\xf2;\u007f\xdeB\x9d>\xda\xe1\xf2+\xb0\xbf\x88\xef{hٺR\xe2\xbb\x0e\x8es+J*\xca*\xd6/JCZh\x8a\xd2\x1c&u\a\x05\xbf\xdb}\xd4\xed'\v\x8c\U000d2d12Re/\xcb\x18\x15eE\xed\x99\x02s\xe8ք \xf0$\x9d\xa6O-\xf2\x18\x8c3ַ\x06\xa4Y9\xcd9\xd4\xe1{;J๊z\xecЪ\x85hkY\xdd\x1c\xb2\xb5\x9b0\x18\xe5\x87g\x18\nQMe\x1d\x10\xb4K]\x89\x9d\x1d\x84\xdeXK\x10\xb8\xdc\u007f\xa6\x83\x92\x82Ib\x18\xa7\x05\xa3qN\xa9\xcd\xd0q\xc4\xff\x18\xb8ο\xbd\xb6\xd1\xdf\xff\xffZ\xb4{Ov\xb1\x16^\xb8\xf6\xd2k\b\x88\xef~h\xd9\xf6\x93\x92IY\rȻR\x108\xd0\n\x1dj\xbeK\xe09S\x12\xb2\xa0(*\xb5\xea\xbc4L\xb2\xf2\x96rϟsM\n\xcd0+\x91\x02&e\xc1n\x96W\x92\xe1\xf2\xffm\x01J\n\x1a\xb1K\xb7\x1dbmu\xb8\xf1\xde~RɶNg\xd7\x0f\x82\x03\xd7\x11\xf8\xae\xa2Y\xf3\x98\xed\xc4\xf4G\x19\x1b\xbbcn\xeeL\x18\x8e\v\xac\xb9\xdd\xc2\xed\xd7\x1c\x93w@K\x9di\a\xdcs\xa2\r\b\x06\xe3\x82Ѥ`\x92\xe4\xe4E%\x87\xee\xb9\x0e\x81\xaf\f\x95N\xf9\x8e\x92→\xe2\x8b\x06>\x87\xe5\x8b\xc6\xd8=\x80R[{\xf9\xc6-\xab9s\xb4å\xbf\xa2\x15\t\x90\xb6\xba\xd9\xc5m\u007f\x868<\xba\xc1w\xaa\xdaP+p\x98\xad\xf94#\x97V\xe43\xd7\f(JK\x92jҢ$/\fB|M\x14L\xa9\r\xfd\xb4\xa04\x96Lk\xfayNj\xf4T\x81A\x1dZ\x80\x14\x029\rygZ\x01Q\xe0\xb0\xd7O\xd8\xe9\x8d\xc92\x8dR\xeem\xf3\xeb\x02\xa5\xc0u\xaa\xa8\xad۬\x8eB\xcdJ\xcd$-\xd9\xddO\xd9\x1b\xa4dy%\x11n*\x154\xec\xd4\xca\x02Oq|\xa9\xc1l'\xa4(,\xfb\x83\x8cI\xaaI҂\xbc\xa8\x92H\xdfs\b}\xe7\xd0\xec=Wш\xab\x9eJ\x96\x1b\xb2\xbc|\xbe(\xcdc\xd6\xf2e\xe0)\xe0\xe2\xa5ս\x9d\x03P\x80\xbf40\xa2\x16y\xf6\xf6,\xbb*mX\xb4\xb1\x94\xa5\xfd\x9a}\x04\v\x81+\x89]E\xecI\x16\x9a!˭\x90n\xbd\xba\xb3\xf7\x86\x19IV\xf0\xf5\xef\x97kCVjFy\xc10/\x19\x16\x95\x8b\x91J\x1d֭\xc44\xa2s\x1cI\x1c\xb8\xb4\x1a\x1eR\nz\x83\x84\x9d\xfd\tyi+\xb5\xa0i\xf0 \xa7\xd6\x12L\x17\xaf\x16\xb9\x04\x81\x83\xa3$\xa56$YYYsa(\xcbj\x8e\xd1XK\xe0)Z\xf5\x00\x04$I\xc9\xde \xa7(\r\xe3Iu>\xef\x01\x00\x9df@^\xeaC\x8b3\xd6\x12\a\x0e\xddv\x88\xebH\xfa\xa3\x9c\xc1\xa8 \xcd\xcbR \x1e\x13\x82O\x02\xbfriuo\xad\x02\xa6ͥ\xd5\xfd\xbf8 \xa7\x96\xeb\xf6\xeb\xdb\"\xe6@\xffV۪j\xaa-\x85\x86\xb2\xac\x9e\u07fe\aH\x01\xf5\xff\xa7\xb3\xb3\xc7A\x18\x86\xa1\xf0\xd74\xa1\x95`\x02\x84\xc4\xc0\xc4\x04\xe2\x8c܅{\xb1\xb1\xf1\x13\xa4\x02!\xa4i̐\xc2\xc2\xc6\rl?\xcb\xcfzO\xb2\xeb\x92\xd5l\xc4f1F\x15\n{\xf34.\xfc,\t]\x12n!\xe2Bľ^\xf8.\xe6\xb1\xf4\x05%\x17\xdbhE]i\x86u\xfe\xa4\x90\x10.W\xc7\xd1:b\xea9B\x15}7\x97T\xa6\xc4h\x85\xd1\n]*\xaaJ30\xaa\xb7\x82s\x8c\"\xe4k\xa21\x03\x14\xda\x0e\xe737\x866\xd1<\x021~<|X/'H\x82\xa3}~U\x86O\x0e\xb3q\xcd|:$\x89\xe0|\xe4d=w\xd7&\xe0,®(d\xbb?\\\xff\xd2e\xdel\xdeTcg]\x91\x9e\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var F_10_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14\x185\xe9a\xa5\xcd\x00\x00 \x00IDATx\xda\xed\xbdy\xb4]\xd9]\xdf\xf9\xd9\xc3\x19\xef\xf0\xe6\xa7\xe9I*\x95\xaa\\s\x95\xcb&\xd8a\\\x84\xac4\xb8\tS\x02\x1d\x87`\x1c\x1cC\x02\x84\x00\x81\xa4\xd3q*\x1dB\x12zu\xf7\n\xe9Nz\x01+\x10\xd3\xe0t\x02\xdd,b\x86\x10B `<\x94]\xb6U*\x95j\xd0P\xa5Yz\xf3\xbb\xf3\x19\xf6\xde\xfd\xc7>\xe7\xdc\xfb$\x91tCK\x18:w--\xbd'\xbd\xe1\x9e\xf3;\xbf\xe9\xfb\xfd\xfe~[\xf09\xf4Z\xe8α\xd3\xdb\x03\xe0ч\x9fd0ލ\x84@\x06:\xc0!\x10\u0095B\b{\xe1\xc2Es\xfb\xf7\x1e>t\x90\xeb7n\xf2\x87\xfd%\xee\xf7/|\xe6\xd9gx\xf1\xb3/\xfe\xae\xff\xbf\xb2\xb8\x14O&\x93?\x1e\x84\xc1ۣ(zBH9\xa7\x03m\x94\xd2B)y]\b\xd1\a. \xc4u\x9c\xdbt\xce\xdd8\u007f\xfeµٟ\xf1\xe8c\x8f\xf2\xea+\xaf\xfe\x17\x83\xfc?}9\xe7x\xf6\xed\xcf\xfeW\xd9$;T\x16\xe5\xa5<\xcf\xdb\xce\xf1p\xa7\xd3:\xf1\xe6\x857\xbePJ\xf9\xa0Tr.\bB\x04\x02\xeb\f8PZ#\xb5$К0\x8a\x87:P}\x81\xd8u\xb8mg\xddǕ\x94?\xf1ʫ\xaf\xbd\x02\xf0ؓ\x8f\x82\x81W\xfe\x90\x19\xe6\x9e\x1a䫿\xf1\xab\xf9\xf0\xcf~\x18\x80w}\xfd\xbb\x1e\x98L&_\u007f\xf3\xea\xcdw\x0f\xf6\x06o\xb3Ɗ0\f\x91R:c\x8c\x10B0\x1a\fQJ\x8a\xc9$#\x8c#\x16\x16\x16@\bF\xc3!E\x9eS\x94%\xbd^\x0f)%\x02A\xa04Q\x1c\x11'1q\x12;!\xa5\x10p\n\xc1\a^9\xfb\xea/\xff\x17\x0f\x01\xbe\xe6\x1b\xbf\x86\u007f\xf3\xb3\xff\x06\x80\xf7\u007f\xcf\xfb\x83\xadͭ\xc7v\xb7v\xbfwog\xef\xbd\xeb7\xd6)\xb2\x828\x8e\t\x82\x00!\x04B\x88\xc6k\xea7t\xeb\xc6M\x82(\xe2Б\xc3h\xad\xe9\xed\xed\x91e\x19\xce9&\x93\tA\x100\x99L\xb0\xd6\xe2\x8c\xc1\x018\b\x82\x80V\xbbE\x9c\xc6(\xa5/K%\xffW\x1c?~\xf6峽\xa7\x9fy\x9aҔ\x9c=s\xf6\xff?\x06\xf9\xf3\xdf\xfe\xe7\xf9Џ~\b!\x04\u007f\xe1\xdb\xff\xc2Ól\xf2\x03ۛ\xdb\xdf|\xf9\xfc\xe5x\xb87DkM\x1c\xc7H)\x9b_n+C\x00(\xa5\x18\x8dF\xecn\xef\x10\xc71G\x8e\x1d\x05`4\x1a1\x1c\f\xfc\xf7\b\xd1\x18\xaf6\xa41\x06k-eQ\x90O2\x94R\x84qL\xdaIi\xb5Z\xbf\x88\xe3\xfb^>\xf3\xf2y\x80'\x9e|\x82\x97ϼ\xfcG\xdf \xdf\xfc\x1d\xdf\xccO\xffo?\r\xc0{\xbe\xeb=\u007fs4\x1a\xfd\x8d\x1b\x97o,^>w\x19g\x1cA\x104^1{3\xebWm\xa4\xbd\xdd]\xb2\xf1\x04\x84\xe0\xe0\x91\xc3\xc4q\x8c\xb5\x96\x9d\x9d\x1dlY\x82\xd8\xff\x96\x87\xc3!\xceM\u007f\xbe)\r\xd9xLi\f\x0eG\xbb\xdbaee\xe5\xa6s\xee\x9fN\x86\x93\u007fx\xfe\xe2y\xf7\xec۟峟\xfe\xec\x1fM\x83|\xe3\xb7~#\xed\x856?\xf9?\xff$\xef\xf9\x8e\xf7\xb4\x9dt\xff\xba\xdf\xeb\xbf\xeb\xda\xc5k\\\u007f\xe3:A\x10\xa0\xb5nn\xfa\xedO\xf8\xec\xbf\x0f\a\x03\xb2I\x861%\xc3\xe1\x88\xe3\x0f\x9e M\x12\x8cu\x94e\xc1d<&˲}F\xb5\xd62\x1c\x0eɲ\f)%q\x1c\x13E\x11\x93\xf1\x84\xb2(0\xd6 \xa4d\xf5\xc0*I\x92\\Ձ\xfe\x86$I>\xf9\x89\x8f}\xc2>\xfd֧9}\xea\xf4\xe7\x94A\xd4\xef\xe7\x9b\xdf\xfd\xed\xeff~y\x9e\u007f\xfe?\xfes\xbe廿\xe5\x88u\xf6C\xdb\x1b\xdb_y\xf1\xecE֯\xac\x13\xc71Z\xeb&WH)\xef0Fm\xa0\"\xcf\xc9\xf2\x8c\"/(\x8a\x9c\xd2\x1aVWWɲ\x8c\xf1pH\x9ee8k\xb1\xce\xed3\b@\x14E$I\x02@\x96eL&c\xc20D)\x05\x0ep\x96\xbd\xbd=\x8c1\xdd4M\xff\x92\xb5\xb6\xb5vt\xed\xa3\x9f\xfd\xf4g\x8b\xcf5\x0f\xf9=\x1b䛾㛘_\x9a\xe7G\xffя\xf2\xbe\xef{\xdf\xf1\xbc\xc8\u007f\xfe\xc6\xe5\x1b_\xf2\xfa\x8b\xaf\x93\r3\xe2$n\x9e\xfc\xdf\xcd\x18\x00\xc6\x18ʢ`2\x9e4O58\x94R\x98\xa2\xa4\xdf\xdb\xc3\x18\x83\x94\x92 \f\xb1\xce\xdeQB\xf7z=\x9cs$IB\x1c\xc7(\xa5Ȳ\t\xa51DQ\x8cs\x16\x81d2\x1eS\x14\x05\x9dv\xfb\v\x8c1\xf3GO\x1c\xfd\xd5\xe5\xa5ew\xeb֭?:!\xeb/\xfd\xf5\xbf\xf4\x98\xc1\xfc\xea\x853\x17\x8e\x9d;}\x8e(\x8a\xf6y\xc5\xdd\x12q}#ˢ\xa4,K&\xe31eY\"\x84\xc0\x18\x83\xb3\xb6\te:\xd0\x1c8t\xc8\x1b\xc3Z\xb6\xb7\xb6\xee\xf0\x90,\xcb\x18\x8f\xc7\b!\x9a\x90\x05\x8e,\xcb\x18\f\x06\xa4i\v\x93\x17\xde\xf8\u0590\xa4\t\x87\x0e\x1fFJ\xf9\x0fN\xbfx\xfa\x03O>\xfd$gN\x9f\xf9\x9c0\x88\xfc\xbd|\xd3\xfb\xbe\xff}\xbe\xac\xfd\x9b\xef\u007f\xda\n\xfb\v\xaf\x9dz\xed\xd8+\x9fye_9{\xc7/\x92\xd3_U\x96%Y\x96\x91\xe5\x19\xa3\xf1\b\x8bC*\xd5$l1\x93\x1f@ \x84\xf7\xb4\xc9dr\x87Q\xf3<G)E\xb7\xdb%\fC\xfa\xfd>;;;\x14EI\x14\xc5,..Q\x149(\xe9\r,\x15\xe3ш[\xeb\xb7(M\xf9\xb7\x9fz\xe6\xa9/<s\xfa\fO=\xfd\xd4\x1fΐ\xf5\x9e\xef~\x0f\x1f\xfc\xc7\x1f\xe4}\xdf\xff\xbe\xc7ˢ\xfcW\xaf|\xe6\x95\xc7\xdex\xe5\r\xd24%\b\x82\xca#\xa8<\xc4\x1bBI\x85u֗\xa6e\xc9d2!\xcfs\xac\xb5H)\xa7\xa1\r\x81\x98\xa9\xbe\xa4\x94(\xa5\b\x02M\x18E\x94eY\x85\xb4i\xfe\xc9\xf3\x9c,\xcb\b\x82\x800\fq\xce{F\x96M(\xcb\x12\xad5\xadV\x1bk\ryY\x80\x03)\x04\x93\xf1\x84\xa2(h\xb5Z_y\xe4\xf0\x91\x9f;\xfd\xe2齧\x9ey\x8a\xf5[\xeb\u007fx\f\xf2\xde\xefy/?\xf5O~\x8ao\xfa+\xdft\\\x06\xf27\xde|\xed͇.\x9c\xb9@\x14E\x84a8s\xa3j\xafPH))MI\x9e\xe7\xe4yNQ\x14\xfb\f!\xa5$\fC\xc20Dk\x8d\xb5\x06S\x1a\xa8\x8c\x19\xc61\xdd\xf9\xf9\xe6k\xcb\xea\xfb\xebW\xfd\x10\f\x87C\x94RDQT\x19\xca\u007f]\x96eXkH\x92\x967J\x91#\xabp\x9a\xe5\x13\xb2I\xde\xe9\xcew\x9f:p\xf0\xc0\xbfy\xe9ŗ\xb2?4\x1e\xf2\xeeo\u007f7?\xf3\xcf~\x86\xf7\xfe\xb5\xf7\x1e\b\xe3\xf0?n\xado\x9d8\xfd\xb1\xd3\x04AP\xc5\xec\xfdƨ\x13yY\x96\x14E\xd1<\xc9J)\xb4Vh\xadI\x92\xa4\xc99Zk\x8c1\x14E\x81\xb3\xceWG\x80\xb3\x96<ˉ\xa2\bc-\xa6,\xf7\x19\x04@kM\x18\x86\f\x06\x83\xc6S\xa4\x94>T\xe1\xaa\xf7\x90\x93\xa6i\xf3@\b$\x0eGQ\x94\x00\x0f\xa6i\xdaZ]]\xfd\xf5\xf5[\xeb\xf6s\xde \u007f\xf6\xbd\u007f\x96\x9f\xfd\x89\x9f\xe5=\u007f\xf5=\x9d0\x0e\u007f\xaa\xb7\xd7{穏\x9c\u0094\xa6\xaaj$BȦN\xf0\xe1\xcaWUeY6F\tÀ0\x8c\x1a\x8f\xf2\x1ea\x99L|R\xaeC\x95\xaf\x8a<\x1e\"\x84\xf0\x18\xd6\xee.\x83^\x9f|\x92cL\xe5E\xce\xe1\xdc\xd4\xf8RJF\xa3\x11Z\xeb\xcaS$eY4՜s\x8e0\f)\xcbr\xa6\xc8\x10\xe4Y\x8e\xd6\xfa\x1da\x18~d\xfd\xd6\xfaŧ\x9e\xfe\x83\v]\xfa?\xf7\x05_\xf7\xcd_\xc7C\xcf>\x04\x1f\x84 \n\xfe\xc1d<\xf9\xaaW^x\x85\xf1`L\x9c\xf8\x12\xd3\xe7k\x89\x10>\x11\xd7\xd7Z\x14\x05EQP\x96%i\x9a\x10\x86a\x93\xf0\xa3(\xa2(J\xfa\xfdA\x13j\x94R\f\x87C\xd2V\v%aoǗ\xbc\xaeJ\xf45L\"J\xb1/\x8f(\xadс&\xa8<#\xcfs\x9cs\xc4q\x8c\x10\xd0\xef\xf7\x9b\xa2\xa0\xd5j\x11\x04\x01y\x9eS\x83`Ɣl\xaco\x10E\xd1O\x01G^:\xfd\xd2\xe7n\x95\xb5vr\x8d\x1f\xfek?\xcc{\xbf\xf7\xbd\xdf\xe1p\u007f\xf5\xf2\xb9\xcbl\xdd\xda\"\b\x83\xa6\xbc\x95R\xa3\xaa*\xc6{\b\x8dgXkI\x92\xa4iܜs\xa4i\vk\x1d\xdb\xdb\xdbU\b\xd3\x04A@\xbfߧ\xd5j\x11\xc71\xce\t¨\xaa\u061c\xc3\xd5\u007f\xaa\xea\xcbTM\xa2u\x1e\xc3\xca\xc6c\x86\xfd>E^\x90\xc4I\xd3\xf7DQL\xa7\xd3\xc1U\r\xe5h4\xaa\x1e\xa2\xfd\x97^z$\xf9\xf03\xcf>\xf3]\x00O>\xfd\xe4\xe7^\x1f\xf2\x81\u007f\xf6\x01~\xe8;\u007f\x88\xbf\xf8\xbd\u007f\xf1\xe10\t\xcfܺr+<\xfb\xe9\xb3\f\xf7\x86U\xd8\tPJUO\xbdh.\xacFe\x8d1$\x89\xef\vj\x00\xb0\xd3\xe9R\x14\x05\xb7n\xddBk\x8dR\xaa\xb9YuU\x050\x1c\xf4(\x8a\x02\x9c\xefM$\x10\a\x9a\xb9H\xd3\x0e\x03\"-)-\f\xb2\x82\xdc\x1a\xb2\xc22.\r\x85u\b)IZ\xe9\xbe\xfeg8\x1c2\x99\x8c\x9b\xea\xad\xf6\x92\xd9\xfe(IS\x0e\x1c<p-I\x92'>\xf3\xc2g\xf6>\xe7B\xd6\x0f}\xe7\x0f\xf1\xbe\xef{_\x10\xb5\xa3\u007f\xb8\xbb\xb1\x1bn\xdeڤ\xb7\xddk\x12\xb1R\x1a)Es\x91eY\x02\xde\x18\xceYZ\xad\x940\f\x9b\xf8\xddnw0ư\xb1\xb1A\x10h\x1f\xbfs\x9f\xb0\xb3,\xa3\xdb\xedVU\x91\x05\a\xce8$\x8e\xf9(\xe4\xe4r\x87c\x8b\x1dZqH\x14\x06$qH\x12\x068k\xd9\xed\x8f\xd9\x1dM\xd8\x1a\x8cY\xef\x8f\xd9\x1a\xe7\xec\x95\x06\xd9<,\x90\xa6)\xce\xd9\x06Ư\xab@c\xca\xe6z\x8b<\xa7ȋ\xc3J\xa9\xbf\xf1\v?\xf7\v\u007f\xfbk\xbf\xe1k?w\x92\xfa\xb7|\xf7\xb7\xf0\xe2\xf3/\xf2E\xef\xfa\xa2\xaf\x18\xf7\xc7\u007fwogO\x9d{\xf1\x1c\xd6\xd8\n\xa3R\x8dw\xf8\xc6\xcd'\xce,\xf3\xfdEݗԡ\xc6\xe7\b\xcd\xee\xee\x0eBxO\xf0\xde\xe4\x8d\x19E\x11\xa3шV\xab\x85\x10\x82\xf1h\x88t\x8en\x14\xf0\xa5\x8f\x1f\xe3m'\x8fpbm\x95\xc3+\v\x1c?\xb2\xcaڡ%\x0e,/\xd0i%ā\xa6\x13\x87\x1c\x9c\xebp|\xb9\xcb\xda\\\x8bT)\xb6&\x05\xc6y\xdf\x15B\xa0\x94\xc2V@e퉳\x15\x9b5\x86$M\x84Rj\xe5\xb7?\xf6\xdb?\xbf\xb2\xba2\xb8\xdf\xc9\xfd\xae\x06\xf9ֿ\xfe\xad|\xf0G>\xc8w\xfd\xbd\xefJMa\xfe\x97\xc1\xee\xe0\xe1+\x17\xae\xb0~u\xbd\xe9\xc6}\xfe\x90M\x13\xe8\x93x\xc9x<ޗ\xc0\xad\xb5\b!H\xd3\x16\xbd\xde\x1e\xd6Z\xe2\xd8\x1bg4\x1a\xb3\xb0\xb0\xc0\xde\xde\x1e\xf3\xf3\xf3\x8cF#\xd6\xd6\xd6\x18\x8fǌ\x87C\x1e\\\xec𥏟\xe0\xf3\x1f?\xc1\xc9c\x878\xb8\xba\xc0\xb1#\aY;r\x88\xa5\xa5\x05\xe6\xbbm\xe6;-\xa20\xa2\xddi\xf9\xf7\xe2\x04\x9d4f%\rX\b\x15\xd7\a\x19\xa5uM\x01\xe0\xc3\x18\x94eA\x14y\uf74d\xe0J+\xe2(^\x16B\xbc\xf2\xf2K/\u007f\xf6~7\x8bw\rY\x9d\xa5N\xf5\xc8\xf0e\xf9$\xff\xf2\xe1`ȕ\xf3W|%\x13\x043\xa0!\xfb\x18\xbf,ˈ\xe3i\x93X\x87\x868N\x98L\xc6M\xe7\xec\x9fT\xcb\xdc\xdc\x1c\xe3\xf1\xb8I\xe4I\x92\xe0\x9cÖ%\xdd8\xe4\xf3\x8e\x1f\xe4ѵ\x15\x1eX[\xa5\x95ƴ\xda]\xba\x8b\vDq\x82\xd4\x1a!}g\u007f$\xcf\xd9\xdd\xdcbgg\x97\x9b7\xd6\xd9\xda\xee\x91\xc4!\x81\xd6tÀ\x8f^\xdf\xe1Z\u007f\x8c\x14\xa2*\x89\x03\x9c\xb3U\xde\x134M\x8f\xc0\xc3\xff֠\x9c\xfa\xe1\xa7\xdf\xfa\xf4\xbf<}\xea\xf4\xf8?'̸\xe7\x1e\xf2\xfc\u007fx\x9ew\xfd\xc0\xbbDGv\xfeð7\xec^<{\x91\xdd\xcd\xdd}\xfd\x83R\xb2y\xea\xead\x9e\xe7\x05i\x9a\xee\xab`\x84\x90H\xa9\x9a\xfe\xa06\xa8\x87-\xda\xe4y\xce\xdc\xdc\x1ceY6\x1d{\xbf\xdf\xe7-\x8b-\x1e9\xbcı\xb5\x15\x0e\xac,\x92v:,\xac\xae\x12\xa5-t\x18\xa2\xc3\x10\x15D\xfe\xe3(!Jb\xa2(\xa0\xdbN\xe9\xb4b\x82\xd0w\xf0e^\xb0\x1ak\x06Y\xc9nV\xa2\xa4\xc49\xdf\xebXk<\xaalʦ(q\xce\x11'1A\x10\xa4B\x88C\xeb\xb7\xd6?\xbc\xb0\xb8\xc0\xe6\xc6\xe6\x1fL\xd9\xfbm\u007f\xeb\xdb\x008\x91\x9c\xf8\xef\xf3q\xbe\xd6\xdb\xe9\xb1yc\x13!D\x13\x86n\xc7\x0e\x85\x10\x8c\xc7\xe3}\xf4lݵ\a\x81f2\x19\x11E\x11Q\x147F\x01A\x10hʲ\xa4\xd5j5=\x82\xb5\x96@\xc2\xe1\xb96\xddV\xc2\xc2\\\x970\x8e\xe9,,\xa0\xa3\x88 \x8e\b\x92\x84 \x8c\b\xe3\xd8\xff[\x14\x12%\tQڢ\xbd\xb8\xc0\xfc\xf2\"\x87\x0e.\xb1\xb6v\x90C\a\x97\xe9\xa4\x11ϮtX\x8a\x14\xa2\x82[\xb4\x0e\x9ap5\vD;\xe7\xbd\xc4\xf7S\xee=O\xbf\xf5\xe9\xa7_=\xfb*\xcf<\xfb\xcc\x1f\x8c\x87|\xfaw>\xcd\xfb\xff\xdb\xf7\xcfK%?8\xec\x0f\xdbW/\\mrG\rI\xf8\xce\\4q\xd7\x18CY\x9a\x06G\x9a\"\xbc\xbaJڪ\xaa\xfd\x05R\xaa\xea{J\xd24eoo\x8f\x95\x95\x15\xfa\xfd>\xddn\x97\xd1pHKZ\x9e>\xbc\xc8\xcaB\x97\a\x8e\x1f\xa6=\xbf@{~\x9e(M\t\x82\x90 \x8c\xaa\x90%\xfdsm\x1dS\x89\x84\xef[\xac\xb5DQ@\x14\x85\xe4Y\x8e-\n\xda\xd6rs\\P8\x87\x94\x92,\x9bT\xa1k\x1f\x86\xeca|\xdf\xf4J \\\xbf\xb5\xfe\xe1[7o\xfd\xc1x\b@\x9c\xc6\xef-\xb2b)\xcb2\xae^\xb8\x8a\xd2j_\x97};\xcfT\x14\x05A\xe0K\xe0)\aR7\x8a\x0e\xad\xbd1j\x98Ç\n\xdd\xc0->\xf7\xc4>\xc4\x14\x19\xcbi\xccB;eeu\x81\xd6\\\x87\xb4ө\x8c\x11\xa0\xc3\x10!%\xb2j\xee\xa4T\b\xa9\xc09\xc28&L\"\xc2$!m\xb5\bÐťy\x8e?p\x84\xe5\xe5\x05\x0e.uy\xfb\\DG\xf9\xef\xebt\xba\xb5'쿞\n\x04\xadBؗ?\xf3\xec3\x0f\xfc\x81TY\xdf\xf67\xbf\x8dw\xfe\xc9w.:\xe7~0\x1bg\x0f\xbcy\xf6M6\xaeo\xd0\xee\xb6QR\xcd\xe4\f\x87\xb5nJ4\x95\xe6\x8e&O)\r\xb8\xc6#\xeakVJ\x92\xe7E\xd3\x04fYF\x92$\xbe\x84v\x96l2ቕ\x0e\x87\x16\xbb<\xf5\xe4\xc3t\xe6\xe6h\xcdͣ\x03\x8d\x0e}u&\x95nP\x81\xea\xa1n\xdeK\xdd{X\xebp8\x9c\xb5\x1e\xfc\x140\x1eg\x98\xa2@\x95%\xbb\x85\xc1J\x85\xb3\x8e\xa2\xc8\xef\x10_h\xad\x89\x92\x18)\xe4<\xf0\xc6\xe1#\x87?y\xf3>HU\xf7yȏ\xff\x0f?\x0e\x82\xb7\x99\xd2|\xd1x8\xe6\xe2+\x17iw\xdb\x04Qp\x1b\xedj1\xa6l\xf8\r\x1f\x8a\xe4\xbe\xd2\x12\xa6\xc0\x9f\xafƨ\xfa\x16\xb9\x0f\xe4[\\\\\xf4\t=\x8a\x18\xf6\xfbtB\xcdR\x1aq\xe8\xe0\x12\xadV\x9b\xb8\xddA)\x8d\x0ecT\x10\xfaP\xa5\x14\x84!2\x8e\x91\xca\u007f^{\xa7@\x10\x84\x11q\x9a\x10\xc5\tA\x18\x12\xc5\x11\a\x0e,\xb3vd\x95N\xa7\xc5r\x1a\xb0\x1a*\x84s\xa4i\xda\x14(\xb3\xaf\xd1h\x8c3\x8d\xe7\xbc\xdb\x18\xd3~\xeb\xdb\xdez\xff\f\xf2\xf7\xff\xf7\xbf_\u007f\xf8\x03eY\xf2\xeag_E)E\xab\xdb\xc2\x16\xb6y\xf2]\x15\x9fg=\xa4\x0e=\xb7\xeb\xa5<\f\xe5\x9a\x10\xe6\x9b1\xd7@\xe6\xd6\xda)\xa9\xe5<\xf4q(\ri%\x11kG\x0e\x92t:\x04aH\x10\x85\x04q\x8c\xd4\x1a\xb4FD1:m!\xc3\x00\x11\x84 \x04B\x87H\xa5\xa1z8t\x18\x12F!a\x92 \x95\"M\x13\x0e\x1cX\xe6\xf8у\xb4℃\xa1\"\xac\x8c\x98$\xe9\x1d7&\xcf2\x0f@\xfaky\xa7\x10b\xe5\xd4gN\xdd?\x83\xfc\x9d\xf7\xfc\x1d\xfe\xf2\a\xfe\xf21\xe0Om\xdd\xd8b\xf3\xc6&\xf3K\xf3\bD\x83O\xcd\xfe\x99\xe6\x121\xf3\xb9\x9b\xe1Ч\x95\xd6\xd4SD\xcd?\x004\f_\xbb\xddf4\x1a\x93\x04\x9a\xa5Ds\xe4\xc02݅y\xa2\xc4?\xe1a\x92\"\x85B\n\x81\f#T\xdaF\xe8\x00\xa1\x03d\xa0\xbc\x87(\x85\x8a\"\xa4T\xb8J$\x11Ʊ\xff\xfe(BU\x9cɁ\x03\xcb\x1c9\xb2\xccB\x1c\xb0\x12H\x1c\x10\x04aӹ\xcfV\x8e\xfd^o\xf6\x01\xfb\xe2\xfb\x96\xd4\xff\xe2\xf7\xbe\xd7?\xb5A\xf0\x9d\xf9$\xe7\xeaū$턤\x9d0\x19O\x9a\x1b\xed=\xc3\xee3\x88\xef\xc4\xc1\xda:\xaf\x88\xeakle<\ue40b\xd6\xdcE\x14E\x8d\x87dE\xc1r\xa4Y\x9dks`u\x91\xee\xc2B\xe5\x1d1R\a\b)@kd\x94 \x84\xf6p\xbf\x03'\x142\xf4^\"\x95F\x05!\xd4\"\t\xa5\x88\xd3\x14\xa54*\xd0\xc4I\x84֊#G\x0e\xb0\xb8\xd8\xe5D\x1a\xa2\x84@)\x0f6\xca\xdb\xea\xf9q%\xbe\xa8\xde\xf7W\x01<\xfb\xf6g\xef\xbdA\xfe\xc5?\xfe`\xfd4\u007f\xe7\xce\xfa\x0e\xbd\x9d\x1e\xf3\xcb\xf3`!\x1bg\xfb\xc2P\xfd\xc7\xc7l\x87\x10\xaei\xb4\xfc\x1bw\x95q,Ή\xaa\x8c\x9cI\xbaU2\x9fEx\xf3<\xa74\x86\x03\x89\xe6\xe8\xea\"Kˋ\x04Q\x88\n\x02\x828\xf1\x06\x95\x12\xb4\xf6U\xad\xf5\xca\x14g\x8d\x87o*oA*\x84\xd2H\xad}r\x97\x12\x15\x04\xc4i\x82V\x9e\x97\x8f\xa2\x90v+fee\x81\xa5V̑P\xe2\x10DQ\xe4\x85\x16\xb7\x89(ƣQ-w\xfdZ\xe0\x9e+\x1e\xe5\xb7\xff\xado\a\xe0\xaf\xfd\xe0w\u007fC\x91\x17\xad[Wn\xf927\n\x19\xec\r\xee.\x88n0!\x87s\xbe\x8c-K\xd3\x10T5IU\x87\xabٰ6\xcb?\xd4\xc0\xdex<&Ʊ\xb6ء\x95\xc6,,/\xfa~#\x8aQ\xb5\x8aEI\x84V8k0&ǖ\xb9\xf7HQ\xb1\x94J#\x94Dj\x8dNZU%\xe6\xcb\xe1(I\xd0A\x80\x94\x8av\xa7\x85\x94\x92\xe5\xe5y\xa24\xe2\xc1vD \x9c\xefm\xa4l\xd0\xeb\xfaz'\x93\xac\xeek\xf4\xd3\xcf>\xf3\xa5\xf7<d\xfd\xd8?\xfa1\u007f\x93\x95\xfcs\x83\xbd\x017/ߤ3ߡȼxm\x16\x1e\xd9\xff\xb7hB\x961\xb6\xf1\x9c\x9a!,\xcbҳ{\xb7\x95\x93\xb7K\x84\x8a\xa2`<\x19s\xa8\x1dqx\xb1C\xabݢ;\xd7E\x85!a\xda\xf2\x86\xac\x12\xb5\xb3\x05\xc2\x15H\n0\x19\x02\xb7/\xe6\xd7n.\x94B\xea\xc0Wd\b\x84\x94>t\x05\x8a \f\x89\xe3\x884\x89X\x98\xef0\x97F,j_&\xd7$\xda\xed\xef\xaf~Ȁ\xff\x06\xe0^V[\x12\xe0\xbb\xfe\xeew\xae\xe1\xdc\x13\x97^\xbb\x84\x0e4a\x1c2\xd8\x19TyE\x13F\xe1\x1d\t\xaf\xae\xb6\xa6U\x97\xc1\x18KY\x9a\x86\x8c*\x8ab&\x94q[Y\xec_\xbd^\x8fHk\x8e-\xb4\b\xb4\xe2\xe0\xa1\x15T\x18\x10\xa5iU\xc6\xfa\n\xcd\xe1\x10Ƈ)\x1f\"\x9d\xef\xb2m\x15\x12g\xc4\x15\xc2:d\xcd\xd7(\t\xce\xf9\xe4\x1eFH!I\x93\x18)\x05\xab\xab\v(\xa98\x94DXS\x12V}ξ\x12\xbf,)\x8b\xb2\n\x91\xf6K\x00\xeee\xb5%\x01t\x18<\xd3\xdb\xed\x1f\xbd\xfe\xc6u\x16\x0f,RL|\xe3֙\xef\x10\xa7q\x85F\xd4\xd5\xd5\x14˚M\xf2\xf5M\x9a~\xee?\xce\xf3r\xdf\x13\\\x1b\xa7n\n\xb3,c.\x0e9\xd8I\t\x83\x80#k\x87P*h\x1aKj\U00044ad4(U\bDȊڵ\xd5\xff\xf9\xff\xf7\x14o\x05\x16*\x85\n\x02\\\x95\xe0\xc38B*I\x10\x06\xc4QD\x1c\x85\xccϷ\x98\x8f\x14\x11e#G\x9d}Yk)\xca\xc2\xd3\xc5\xd6\x1e|\xf6\xed\xcfv\xefi\xc8z\xff\xf7\xbf_\x82x\xc7\xd5sWӴ\x93\x12%\x11\xd9$#nń\xb1\xa7hMi\xf6a=\xb33\x19\xf5\x9fY\xba\xb4~zg\xff\xbe\xddä\x14\xf4\xfb}\xa20d)\x89\xe8\xc4\x01G\xd6\x0eV\x95R=\xe4)f\x1bq\xff\x81qH\xe3\x90H\x84\x9b\x1a\xd9\xdb\xca5ϙ3%\x12|b\xaf\xe4Da%z\x10\x15\xb0\x19\x04\x9a\xc5\xc59\x92  \xaa\xae\xcb{\x89\xdag\x10S6\xa5\xba6Ƭ\xddS\x83\xa4\xf3i\xd2\xdf\xe9\xbdcws\x97\xe5\xc3\xcb\xfe\xe69\x0fq\x14YQ\xb9\xeb\xfe\x86o\xd6\x10\xb5Gܩ\xdf\x15w4\x8b\xb3\xb0DQx\xadT\xbb\xdd\xe2@+ P\x92\xa3\xc7\u05c8\x93\xa8\xa6\xefp\b\xef\x9d\xc2\xf9\xb2\xd79\x9c\xf57N\xb8J\xe9\xe8l\xa5\x05v\r\xc8&\x95\xc4U\xe5\xaa@\xf8|\"\x04\x12A\x9c\xa4\b\tZI\x82@\x93\xc4!\xad0 \x124@c]\x8a\xd7\x06)\x8b\xa6\x0f\x13ιֽ\xcc#\xd2Y\x97\xf4w\xfa\xef\f\x82\xc0+\xd6\xd5Th0\x1e\x8d\xb1\xc6\xde\xe5\xa6\xee\xbf\xc1S\xc8\xdd\xdd]IQ1\x87vFD\x9d\xe7\x99\xd7h\x05\x01GZ!\xedv\xca\xfc\xc2\x1cց5E5EP\xe2\x04\b\xa9\x11\xce7\x80Bz\xcf\x10\xceS\xc7X\a\xc6T\xbd\x87\xdb\xff\x1e\x9c\x17<\b\x01R+\x84\x14\xb4;m\xa2($\b\x03\x02\xed\u007f^\xa05\x91\x92\x98J\xc3u;\xbdP\x94%\xd6X\x80\xc8\xe1\x1e\xbd\x97yD\x0ev\a\x0f\x0ev\xfaݤ\x9dT\f\x9c\x0f'\xd9\xd8\x0f\xc0\x18kfB\x81\xbb\xeb͞\xa5F\xef\x96/\xea2\xd7\x18\x0fBz\x91tA\x9c$,(K,\x05++KL\xc6#L\x99\xa3u\x80\x92\xbeĵ\xb6DX\x9a\xfc%\x85\xacJ\xdf\x02g\xacW\x03WɽJ \xd3~Ȕآ\xf4\xa9hF7\xdc\xeetH\xe2\x10\xad\x15A\xa0\xb0\xce\x11J\x81)\x8bF\x8f<{}^\xbej\x00\"\x1c_rO)ܲ,\xdf5\xec\ri-\xb4\x91R\xfa'A\bF\xc31:P\x94㼊\x06\xae\xc1\xa7n\xaf\xb2\xa6=\xc9\xdd\rV+\ak\xbe\xa4\xae\xcat\x10rHd\xec\xee\xe5\xbc\xfc\xd2K\\~\xf3\x02\xedn\x9b\x87\x1f{\x9c\xb7}\xfe\x1f#IC\x9cո\xb2\x84P{\xf9\xa75\xd8\"\xf7\x8fF\x85\xf8\xba\x9ah\xaaxg[\x16~\x18\xd4XLQ\xe0\xac\xf1\x19Ɣ\b,\n\xcb\\+\xa6\xb7\xab\xc8\xc7\x13\xb2IF\x1a\x06X;\xd8'ڨ/'\xaf\x04\u007f\xb1\xbfև\x9f~\xeb\xd3\xf3\xa7O\x9d\u07bd'\x06\x99\xf4\xc7\u007f\xc2\x18K\x18\xf9a\x98\xa2\xc8)\v\x9f;\xbcT\xe7\xceW\x9d\xac\xebd>[\xc6\xde\xcd;j\x19P\xcd\xc5\x17E\x81\xd6\x01sZ\x10ۂ[\xbd\x82\xd7nܬ\xd8B\xc1\xa7^8ͯ\xff\xf2\xafr\xfc\xe4\t\x1e\u007f\xeai?k\x98\xc4\x1c8z\x14[A3\xa2\xf2\xba\xa6\x03\xad\xa0\xf6\xb2\xf22\x10\xd8\xdcRd\x19\xa1\x86\xc0\x1a\xa4)\x19\x0e\x86\xbcv\xfe\"\u05ee\xde\xe4ƍ\x9b\\\xbd\xb5\xc5z\xaf\x8f\x90\x02[\x1al\x85B\xec\xe3\xda\xf1\rb\xabcq\xceu\x85\x10)po\f2\xea\r\x9f\x8a\xd3\b\xa5\x15\x18(&%\x93Q6\x83\f\xden\f\xbb\x8f߸[\xd3W{Am\x80z\x18\xa7\xfeaY6!N[\xb4m\xc6fV\xe00D\x81\xa6\x1b\x87$Q\x80\x13\x92\xf5\xbd!7\x9f\xff\f\x9f\xfc\xd8\xf3\x94\xa5\x0fOG\x8f\x1f\xe3\xcf}\xeb{9~\xf2$(\xe1=\xc69\xb0\x06k\f\xf9h\x80)r\x84)\xc8\xfb=֯\\akg\x873\xaf\xbd\xc1\xd9\v7\x18\fG\xe4Y\x0e\x15\x1d\xad\xa4\xc4IE\x14\x86t\x9c\x04ᰦ\xf0\x05\x80\x14\x18S\xf3\x90\xc2\xeb\xc5J\x8bS.\xb5ֆ\xf7,d\x8d\a\x93nk\xb1\x8d\n\x14\x93\xd1\xd8K\xf6\xf3\x1c\xa1\xe4\xbe\xeaJ4\xbd\b՛5\xfb;\xe4\xdf%\x99\xd7\x1eRs\x0e\xbe\x8b7$Q\x88\xca{\b)\xbc7*\x81\x92\x82\xb98\xe0\x91ë,\xb4S\x9c\x10l\xec\xf6\xd9\xee\r\xb8\xb5\xb7\xc7\xc6\xfa-~\xf9g>ğ\xfeƯgymmڙ[\x8b-r\xca|\x821%\xa60\xfc\xc4\a\xff/>\xf9\x99\x97\t\xb5bi.e\xbe\x15r\xe4P\x97\xf9vJ\xbb\xd3a\xa1\xdb%\fcJ\xa9\x18\x17%\x9f\xb9p\x95\u07fe|\x83\xb2\xf4\xde{\xbb\xc0\xd3T\xe8C\x18\x85B\xfc\xa7.\xfa\xf7k\x90<\xcb\xedJ'\x95B\nF\xfd1J+\xac\xb1h\xad\xee(c\x9d\xdb_\xfa\xfen\x06\xb1U\xc53\x15_[\x9c\x93h\x1d\xb0\xbb\xbb\x8b\x0e4-\xe5\xf3ը\xb4\x9c_\xdfC\v\rv\x17\xa5\x15\xcf_\xda\xe6\xf1\x03s<zh\x81'\x8e\x1f\xc6\x1dZfR\x94d\x93\x9c@+\xc2b\b\x93>\x85\f\x10\xce!\x8d\xe7̝1\x14\xc3\x01\xbf\xf6\x9b\xcfs\xea\xa5\xd7x\xe4\xf0\x02\xc7W\xbat[\x11\xad8D)\xc9`\x94\xb3\xb5\xb9\xcd\xd5\xeb\x1b8\x1d\xd0\xeet\x19\xe697om!\xf0\xfdU\x14F\rJ\xe0\x9a\xdeK\xd4\xfd\x88\xbb\xa7I=L\"t\xa01eIV\r\xdd\v)f\xc6\v\xf6\v\x00jb\xcaZ۠\xb6ӄ/nk\x10k\x14\x98f\x18'\xcb&\xcc/,\x10ؒ\xc2\x18n\xf5Ɯ߳\x946ǖ\x05\x88\x12\xb5-\xf8\xf7\xe7\xf6x\xeb\xa1-\u07b5\xdd\xe7\x8b\x1f?A7MX\x9a\xef\xd0Y\x98c~\xa1\x83\xb2\xb9\xefĕD\xc5)\xc5x\xc2`k\x93k\xe7\xcf\xd3\xc9{\xfc\xd7ϬyN$\n\xc9Jǧ\xce\xdf\xe4\xec\xf5\x1d.\xf5'얎~V\x90\x17%\xa9\x96\xac%\x01\x0f\xcewQ\xe0\x05\x10J\xf9\x9a\xac\xca\xecM\x11\xe3\xfb\x94m)\xe5\xe0\x9e\x19$m\xfb\xdec<̑RP\x96\xc6WZ\xdaU\x85\xe2\xec\x93\xeff\xbc\xc3\xfd.Ĕ\x9b\xe9M\\\xe5U^\xe80\x1c\x8e\x10B\xd0N[\x846\xe3z\u007f\xcc\xc60\xe3\x8b\xdf\xfe\x18\xafm\xec2\xe8Ox\xf4\xe0\x12_\xfb\xa7\xfe$7n\xdc\xe4\xab\xdf\xf1V~\xfc\xe7~\x81k\x1f;û\xbf\xf8\x19\x16\x97\xe6h\xcfw\xabyDH\xa2\b\xa7t%cu\x8c\x06\x03\xae]\xbe\xcax<\"Ibz\xe3\x9c\xdf:s\x85\x976Ɯ\xdf\x1b0q\x8a\xa5\xf9\x05\xbe\xecK\xdfɥ˗8\xf5ҋ\xe4\xc67\xbe]\x01\xda\xf9d.\xab\x87\x12þ\xeb\xabu\xdb֖\xf7l\xd2J\xc6i\x8c\x14\x82\xf1p\x82Ԫ\x19\x86\x91UC\xe5pw$u[\x01|S\xc1\x83\xbdÓ\xeb\xbe\xc4Wb>\xb9gل0\x8c\b\x94\xa4\xad\xe0P;\xa10%\xaf_\xb8Bd,\xabGָ\x99\v\xfe\x8f\xdf\xf8\x18o\u007f\xf88\v\xed\x94?\xf7%\x9f\xcf\xc6\u0588_\xf8\x9dSX\xe9q(\xa4D\x06\x112NQa\x8c\b\"\x9c\x90^uXz\xb1\xde\xf6(\xe7\x97\xce\xf7\xf8\xcd-Gx\xf2i\x16W\x8f\xb0z`\x8dG\x1e{\x9c\xaf\xfc\x8a\xaf\xe2O|\xf1\x97\xb1\x18\x85\xa4R\xb0\x1c\x85\xc4R5=\x8d\x92\x02}\x17n\xa4\xf2\x90QQ\x98{f\x10\xadB\xafo\xca+qXYxtS\b\x81T\xbe+nTO\xd5M\xf6I\xba\x02\xff\xd8/\r\x9a\xedMj#)%1\xc6\x1b2\x8a\xdaH ²\xb4\x90\xf2\xc4\xda\xc3l\ue3b8\xb9\xd3c\xb4y\xd1\xff\x90\xa1\xe4\x97~iȯ\xc71ٰ\xcfR;b\xb1ա\xb50G\x90x\xc2JF\t\xd4ĕT\xa80\xc62m\x1e\xb6w\xc7\x1ch\xa5\x8c\x06\x03\xae\x9c;C\x94t(\a\xdb,\x05\x87\xf9W\xff\xe2\xc78\xfb\xf2KLF#\x92(\xe0H+&\xac\x10ekJ\x9c\xf3\xfa\xe3\xf1$\xbb=b\x83c\xebճ\xaf\xdeC\x83h\x855\x86\xa2Be\xc7ñ\x97\xcf ЁƉ\xe9\xbc_=\x043M\xee4\x8c\xe1~\x98\xc4\xeck\x18\x95Ҿr\x13~\xbeP\xe0\b$\xac̷9yd\xa5\xfa\xb9\xbe\xb7\x90\x95\xf8M+\xd9p\x17s픃+\x8b\xa4\xed\x18\x11\xc6H\xad\xbcءb\n\xad\x05U\xf1\xf3\xf5\xfb|\xfc\x81\x15\x1e\xc8\fOχ\x9c\xbf\xb1\xc9\xfa\xde\x0e\xa2e\xc9^\xfa\x14\aӐx^a\x17\x16Y\b\"4\x0eU\xa9\x8a\xbc\x13\b\xe28\xda\x17\x86\xef*H\xbb\x17\x06с\xa6\xc8\n\xbf\xb6\xc2Z\xb2q\xe6a\x86J\xbe/\x10\xfb\xc2\xd6l\x85U{\x84\xac\xf4\xb2u^\xb9\xdd@BP͓\xcb\x06}m\x05\x8a\x83Ks<\xb0v\x80\xb4\x9aS\xf4\xc0\x9e\x17\xc0\t\xe9{\x85N\xa7\xe5\x85\fRB\xe0\xbd\x19\x15x\x8c*\x8c}\xd9+\x1c*JqRb\x8d!\f\x02\xa28\xa2ӑ,\xcd\xc1[\x8e\x1d`\xb4ף\x18\r\x18\x15\x05\x81t\x98j\xc8gsXrso\x02\x164\n'\xfc\x8cK\x8d\x06ϊ2\xac\xb3\xecc\xc5\ue347Hz[c\x8c\xb5\xe4\xe3\x1cSx\xecJ\xd6!K\x8aY}\xd2\x1d\xf9b\x9a'T\x95\xf4\xed>,\xa8\xf6*?\xf4\x19\xa2\x95B\tG7\t\x99k\xa7,\xceuh\xb7\x12\x02\xadqU\x1e\x95Z\xa1C/H\x90\x80\f4*\xed \xb4\xc69\x03R!\xc3\xc4\x1b\xa7\x91\x91:\xac\xb1H\xe9=\xbb\xddj\xd1\xe9v\tt\x80\xb3\x8e\xc9d\x89\xfen\x8f\xc9hD\x96e\x94ENj-\xadd\x84\xb1\x96\xdd^A %\xb6\x9c\x96\xf8\xedV\xca\xf6\xce^\x03^W\x15\xa6\xbc\xa7\x06qN\x90M\xb2i7:\xf3\xf4K%\x91B`\xef\x80M\xf6\x97\xbd\xde\x13\xdc\f\x9a;\x15\xcaI)\x19\x8f\xc7Ms\xa9\x94&Q\x92\xf94\xa0\xd3NI[1A\xcb3u*\f\xa7\x88\xae\xd2\xe8 \x01W\"\xc2\x04\x19E\b\x1d\x83\x92~+C\x98\xe0L\xe9\xb9vcqXT\xd5}w;]\x96WV\b\xc3\xc8\xc3\xf58\xc2(\"m\xb5(\xb2\t\x93ф\xd1pD\x96\xe7\xf4\x10,\xb53\xf2\xcc\xfa;\xed\x04\xc6X\xa4\x10\xb4Z\t;\xbb{>4#j\x80\xb1ux\xed\xb0\xba~\xf5\xba\xb9G\x06qd\x93\x1cg\x1d\xf9$\xaf\xb2G\xa5\xbf\xd5j\x1f\x16=Ůf\x19D\xb7\x9fR\xad\t\"\a\xc6\xf8y\x90\xe1pD\x9a&\xbe\xa4\x94\x92H\v\xd28$\b\x14F\b\x94\x0e\x90\x81\xf6\x9a+\xe5CR\x10\xb7=+(\xa5W*\x06\x112mU$bE\xe9J\rJ\xe1\xec\x04!%Q\x92\x92$\tݹ.Z\x05\x84QRyg\x8e\x13\x86HE~_c\x18\x11G\x11Y\xe6\x1b\xcd\xfe^\x8fV\xa4)*џ)Kt\xa5\x86\xac\xb9\x9b&:\b\xb1\xd0\xedv[\u05f9\u07bb'\x06\xb1\xc6\xe7\rSoP\xa8ܳ,m\xe3%\x94SE\xb9\xf7\x92\xe9d\xd4~\xb0\xd1\xf7\x1eJMy\tc\fy\x9e\xd1n\xb7\x1a\xb9\xe7|\x1c\xf8\x918)\xbd\x1aDkt\x94 u\x88\nBT\xd2F\xa8j\xa8F*D\x1c!\xe2\xb6oVMY\x91Q\x16\xa4\x174\xb8\n`\x8c;\x1dT\xb5\x9b\xab\xa6\x9dUŭ\x9b\xb2Ę\xb2\x1a\xa1\x0e\t\x82\x90\xb8(\x11\x02\xd28B\xc8\t=\xebCu\xfd\xa0\xf9\x91\x89\xa0\xaa\x10\xad\x87\x8b\x9c[\x12B\xb4\x81{c\x90\xba\xcc5\xce\x1bDT\x80bY\x14\xcd\x05\x89\xachƑgu\xbbw\x82\x8b4\x9a\xac\xdaX\xb3\x1fk\xadq\xc0J\x1a\xf8\t^\xadP\x15I$U\x80\x8e\x12D\x9c\xfa\x9b\xaf<!\x85\x90\x10E\r_^\x8fI\v'<\xc0(\x15Ri\x9c\xb5\xb4:\x9dF\x90!\xaa\x12Xx\xcc\x10QτX\x83)\v\xdf\\:\x87\xa8T\x8e;\x85\xa5\xb0\x0eYAF\xc6X\xa4T\x04Za\x82\x80,\xcb\xeag,q\xce\xdd3pQ\x96E\xd94\x83\x15+V\x8d\x05\xf8\x0eVku\xc7\xf0t\xb3F\xa9\x19\v\xbb\x93E\x9c~\xdd\x14\xa2WJ#\x85 V\x9eӮ\x99I\xa952\x8cAjD\x94\"\xc2\b\xa2\x18\x94F\xc4\t\x88Jy\xdf\b\x1d\x84'\xa4\xa4\u007fo*I\x91\x81\xa65\xe7eD\xb6\xe6?\xea\xdeA\x8a\xe6\x1a\x94T\x8d@Ϙҋ\xed\xa4d#/}\xae\xbc-\xfc*\xa5\x88\xe3\xa0)P\xac\xb5\xca9w\xcf\xc0E\x99g\xb9/y\x8d\x9d4\xebbH\x00\x00\x19\xf3IDAT.\a\x13S\x86Oi\xd9@(\xb3x\xd5\xf4\xe9\xdf\xdf5\xdd.\xb8\xae\xc7\xc6\xea\x8b\xf3_Y\xaf\xc2\x00\xa5\x04Bjd\x10\"\xe3\x14\xa1C\xafPt\x02d\x00R\xd7\xcd\x01\x98\x8a\x19\xb4 \xb4\xf69\x04\x01\xa6D\nEk~\x91\xb5G\x1e\xf27\xdayU\xe5T\x1e4}\x9f\xa2R\xce\x14y^\xedN1\\\x19嘻h\x02\x10\x90\xc4\xf1̼\v(y\xef\n-9\x19N\xbc\x98Ø\x06n\x17\b\x9cudc\x0f6\xdem\xbd@\xfd\xf4\xcfzDY\x9a;\x16\xc3̪\xe3\xeb^C\n\x10\xd2\xf79R\xc8j\xa4 @\x04\xbe\xbf\xa8e>BW\xc6pU\xce0\x16\x91\x97\ba!\f\xaa\x1f$\xa0\xb5\b\xcbǠ\xbb\xcaʃ\x8f\x92\xb6\x13\xaf\xc9\xc5\xff\x1e\xa4\x98\xc9+\xe0\x9c\xa9rJAi\f;\xa3\x8c\xf5q9U\x18͠\f\xb5BE\b\xeaݑN\xdcS\x83\x8c&\xbe\xbb6\xf66\x06@0\xe8\x0f\xabj\xe6\xee\x1ez\xe7ͷ\xfbF\xddj\x18\xa5f\xe0d5\xf4\x13V#qq\x1c\xe1\x84\x00\x1d\xf8|\xa1\xb4\x87B*#\n\x19L\adm\r\x1f\v\b\xe2\xe9/\rSH\xe7A\x87\x10\xfa\xe6p\xe9\xd0\xeat!@\xfdޕ@\x04\xe0\xf0\xac`\x91\xe78g\x99\x8c\xc7l\x0e3\xb6\xb2\x92\x19\xbd\xdd\x1dh\xb7\xac\n\x90{ɅT!+C*\xb9\u007f\x97\xa1\xf0\xedϨ?\xa2,ʦs\xbe\x9d\xff\xb8\xdb8\xd8TX=\xd5j\t!|\x93\xa7\x14\x81\x14t\x03\x0f\xde%QР\x02T\\\xb6\xcf\v\x12\xa1\xa2*1\xf9\xc4\xeb\x9b\x1f/+\xf5s_\x0eP\x10$\xd3\x0eՔ\x94\xfd\xbe_z\x16\xfa\xf9\x13\xe1h\x06\x86\x9a\x8a\xd0\x18\xca\"\xc7\x19\xc3$\xcb\xd9\x1c\x17lO\xca}:\x8eYцs\x0eU\xad\xe3\x00r[5$\xf7\xa6\xca\xcaKT\xa0qw\xd9\x12e\xaca4\x185\x89p\xd6 U\x82k\xf8\x91\xd9\xd05%\xaf\xeae4\xa2\x11\xa0\x05¡\x85 \f\xfd4n\xad\xb7\xaa\x04\xc6\xde0\x95\x97`\x1cH\xb7/Gas\x18\x18\x8a\x9b7ٹ~\x93\xce\xda1\x92\x13\x0fBg\x8e\xecʛ\xec\x9c;O>\x1aP\xe6%a\xec@\xf8\x9c\xe1\x84\xcf=\xceZLY`m\x891~5\xd4\xeb\xbb\x13\xac\x03%htķ\xd3\v\xd3\x10+\xb6\x8du\xf7\x8e\x0f1\xc6\x11&\xea.Ө>l\x8dG\x13Z\xedVS\x99ܮ\xef\x9d\xf5\x047\xa3N\xf1\x9d\xfcL,\xae+\xad*\xa1&q\x88\xd2\xd5V\x1eg\xa7\xfd\x85\xb5\x95\x9a\xa42\x90-\xaaJIB\xa8\xa1߃\xeb;\xdc<\xfb:\xa3\xf1\x88\xcb/\x9d\xe5\xe9/x\a\xc1\x81U\xb6\xce|\x86\xab\xe7\xaf\x12\x06\x8a\xb8\x1b\"C\x0fǈ*\x91;\xe7\x15)e\x91c\x8d\xf5\x9b'F\x13^\xde\x19\xa3+C\xc8}\xca~WE\x0f7\xb3Y\x95\xbd\xac\xc8\xc6\xf7\xcc \xd6X\xdf\xfc\xdd%s\xd7K&\xe32\xf6\xf8V\xe5\x15\xb3\xa1\xa8\x11}\xcc\xf8\xbb7\x88\x9b\x19\x816\xbe?1\x16\x1d*$\x82V2\x9dǨg=0\xc6\xcfy \xa8P-\xa8`q)\x14H\xb0I\v\xe6a\xed\x1d\x9f\x87\x18\x8f\x99l\uf81d\xc3\\\xbf\xce\xf0\xd6\r\xe6Z\x01*P\x10F\b]A\xeax\tj\x91e\x18S`\x8d\xf1ځɄ\u05f6\xfb\\\x1f䤡\xae6=\xc8}\x0f\x9e\x92\xbe/\xf3{\xb9\x14\x0e\xb7u\xf1\xdc\xc5{f\x10\xe9,\r\u007f|\xbbw \xc0\x1aK^䕀Nޱ\x83\xb7.1\xefD\xa7\xddm\xaaw\xefM--}\xaf\x17\x86\x1e\xb8\xacfG\xbc\xaa\xddT%\xae\xadB\x95\x05\xe1\x87p\xfc\xb0\x8eFF]\xe4\xca2\xac\xce\xe3\x0e\x1f$<\xbaF\xa6a\xfd\xd6\x15\xccd\x84\x14\x16!\x1cZ:(Jl^b'9\xc5h\x84)&زĚ\x92<+\xc8Fc~\xe1\xfc6\xa1\x92>5iI\x1c'\x95\x80\xa3\x12\x95\v\xd1,\xf0TJ\x95\xc0g\x00N>|\xf2\xdexH=\xf0\u008c\x00n6\xb1Yg)\xf2\x82@\x05\xbe\xe47\x86 \b\x1ai\xcf\xed\x95\xd6~ќ\xdb7\xdb.\x9ck\x12z\x10\xa8&\xa17\x13Q\xa6\xac\xf4\xbb\x05\xa2\xf2\b!\x84\xaf\xa0\x84\x05a\xa0P@\x81\x90\x11\x84 \x16\x03t\x04j\xe32X\x8bJ#\x82\x96\xdf\x17_\x8e\xc6\x15[9ՑYk0\xd6`\xf3\x9cO^\xd9\xe4\xc2ބ\xb0*\xc7[i\n\xcea\xac%\x10ө^\xeb\x9c/ϥ\x1c\x1bc\x9e\a\xb8p\xee\xc2=\x1aGp\xd3fi\xdfM\xc55\xb3\xe9\xd6X\xf2<\xaf\xba[\xd3\xecr\x9f\x05\x1a\xeb\nk?\xbe5c()\xb1\x0e\x16\x02\xe9I*!f\x94\xb8^[E\x91\xf9\xce\xd9تҳ8%}\xe82\x1al\b\xae\xacz\x93ʛ\x1c\xe88f\xee\xf0\x01\x9c\x03\x15\x063b\x8b\x12ks\x8c)0\xd6\x1b\xdb\x1a\x8b)\f\xa6\xc8\xf9\xf0\xeb\xebh\xe9\xdfG\xd4l/2\xd31\x87\xdaӭ%\xf4\x84\xd5\xe0\x95\x97_y\xfe\x9e\x96\xbde5o\xbe\xaf|m\xe6\xf1\xa7yc\xba\xa3\x90\xdb\xc6\xdc\xd8Wi\xed\xff!n\xff\x8f4%s\x81\x98\xae\xed3\xa6Z\x8dQ5\u007f\xd6 *(\xc3I\x83U\xe0d\x95\xe8\x05`2\x9f\xe4mU\x02;\x83\xb1%\xb6\x9c\x10\xccϑ\xccu\xa6\x15\x9e\xdc?\xaa]\xbf\x1bS\x96\x94y\xce\vW\xb6\xb8\xdc\x1b5y\"\x8aC\x9c\xf5\vtfQ\b\x8fZ[Z\xed6\xd6\xda\x0f\x01<\xf4\x96\x87\xee\x9dAL\x15zno\xfej\x8f\x99\xed;\xb2l\xd2\xc0*~\xc1\xa4h\fv\xbb:~:\x1fҐ\xed(\x1c\xed@V\xf7\xd3\x03x\xf5ZqgJ\xc0\xe2\xcc\x04\xacE\x94\x02Y\bd\x89\xf7\x84\xbc\x0fv\xe2\x91B\xadp{[\x94\x17^\xc7\\8\x83\xcdG\xa8N\x87œ'H\xd2VUnOCf#\xd8+\n\xf2,c0\x9e\xf0k\x17\xd6\xe9e~\xe9A\x10\x04())\nCYi\x9b\x1b/á\xb4\xaa\x8e\xc2(\xff\t\xc0\xf9\xd7\xcf\xdf;\x82\xaa6\xc6\x1d\xddx\xf5H\xcdr\xca\xf5\x06\xb8\xd9iZ)\xeb\xd9s\xb7\x8fޭ\x87\x9a\xea0&\x05\xb4\xa3\x00)\xa0(=L\xe3*H;pΏ\x1e\u0602\xd2\x16h;Dڪ\x1c\xc6T!\x8d\xc6\x10\x93_\xfbw\x14\xaf\x9fÍ\x86 $\xfa\xc4Q\xec\xda\x1a\xeec/\x10\x97%\xee\xc9G\x18/\xb4)\xab\x82\xc3\xe4%\xa51\xe4\x93\x1ck\f\x9b\xfd1/\xdf\xda\xf3l\xa4\x90\x84a\x80\xb1\x96,/<\x98\xea\xea\xc8\xe0\xf3i\x18%\x00/\xbe\xf6\xea\xebW\xef9\xa7.*>\xfc\xf6\xc1\xf9i\xec\xf7\x14\ued2a2͓\xefwM\xf9\xe0V\x96\xb6\t_Ӽ2\xedA\xb4\x94\xa4ZP\x1aKQ\x96\xe4\xd5\x14\xae\xa97]\x1b\x83+2\x02\xddFX\r\xe1\x00\xb4\xf3\xf9Cy\xf8\xbd\xfc\xc4G\xc9~\xe7#\x98\x9d]l\u007fL\x99\xe5~\x03\xea\xd6\x0e\xeew>\x8d\x99xn\\\\\xbeF\xf8\xd8I\xcc#\xc7\xc9ʒ\xb20\xcd\xf0f\x9e\x15\xbcpe\x9b\xeb}\xbf\xa8@\b\b\x83\x80q\xb5 S+]M\x14\x8b\xe6\x1a\xb5\xd68\xeb\x9e\xe3>\xbc\xb4\x9f\xb7p\xfb\f\xe2f\xf0\x1b)$\xa64\xfb\xd5\xec\xb6D\xcap\xfa5R\xed\xebO\xea%\xfa\xb5&\xab\x1e7N\x84ǎ\x92(`8\xca\xe8\xb4Rʢ\xf4\xaas\xadq\xc6`\xcd\b\xa9\xfd\xe83\x16\xa4\t\xb17/3\xfe\xe8o\x91ݼI6\x1c\x93\xef\xee1\xec\r\x98\xe4\x06W\x96t\x94\xa2\x18e\x94\xd6\x10+E\x18\x84\x84g^\xa7T\x96\xf2\xc8ALY\xcd\xce\x1b\xc3\xde`\xc4ϟ\xbdJ\xa0f\x8f\xd2\x10\x94\x85\x99\xd9\xd72\xd52K\xadA\x88KR\xca\xdf|\xe4\xd1Gx\xed\xd5\xd7\xee\xb1A\x94\xa2,\nO\xd7ކ\xe1\xf8\x1d\x84\x1a\xf2\xbc\xd9\"-\xa5\xdf$*\x85\xaa\xdc\xdaT\xe2\xeb\xba\xf7\xb0\xcdEM\xf5[P8\xc1\x9c\xf2\x9b\xaeǓ\x8c\xc1pL\xb9\xd8E\x15\x05E\x9e#\x03\x8d\xb5\x1ac2\xac\x03\x9c\x17\xe3\x99\xddm\xf2\xb3g\xd8[ߤ,$.\xee\xc23\x8f\xd0)\v\x96\u05ce1\xce\f\xd9\xf9s\x84\xe7\xcf\x13\vp\xc6 \xb1\\&'T\x8a\xd8x,\xccYK\x99\xe5|\xe4\xcdu.\xed\fI\x02\x1f\x9a\xb4\xf6KԌ\xb5\xa4IL\x96\x17Da\xd0lY\rt\x80\xb1\xf6g\x8c1\xa3s\xaf\x9d\xbb\xf7\x1e⌥\xc8J\xaf0i:\xf0\xfd\xfbI\xa4\xf0\xb5x\xb3}A\xf8\x8d\xa2\xb3;ߥ\xac\xa5\xa6bF-?S\x06[\xcb\xf6\xf5\x1b\x1c}\xe8\b\xe3\xf1\x84\xed\x9d>Ǐ\xacb\x8a\x82\"\xcbС\x87ޕ\x96\xc8Bbc\x89p\x0e1\xdf%y\xe7;I\x1ez\x14u\xe5&\xc2\x1aHR\b\x05\x1c~\bN\xbe\x05^}\r~\xe6C\x10hp\xb0\xab\f\xeb\xab\x11:\xf4\xfb\xe2q\x16S\x1a\xb6\xfbc~\xfa3\x97\x88\x03ռ\xc7 Ѝ\x18\xa3\x96\xca6\xbc\x87\xd2X\xeb֝u\xbfx\xee\xf5s澄,c\v\x8a\xdc\x11\xb7\x92\xbb\x8c\x12\xb8\nF\xda_I\xd5\xfd\x88\xadW[\x88\xe9\x80KmP\xaf\x96\xa91 \x852\x05\xfd\x9d=.]\x12<\xf0\xc0\x116\xb7w\xd9\xdc\xeeqpu\x81\"˪\x15P\xa2ڸ`\x10Z\xe0*\xe0\xd1\xe0`i\x8e2\x89\x10\xeb\x9b\xe8\xdd\x01b\xe2\x10\x1b\x9fB|\xf4\xb7\xc1X\xec\xd1\x03LF#\u058b\x11\x1b\x8b-\xb4\x9c>\b\xc2A\x91\x15|\xf0\x85\v\xecM\xf2\nf\xf7\xff\x1fh\xcdp4&\f4Y\x9e{\x9e_\xd7Lf\x801\xf6bo88\xc5}zi!4E^\x12\xb7kt\xd6T\x89Y4\xa7\x14\b)\xa7\xbdIuÕ\x92\x95HNM\x998\xc1\x8c\xfcG4\xde\xe2p\xa8\xb2@c\xb8~\xfd\x16Q\x14p\xf0\xe0*\x97\xaf\xaf\xb34\xdf\xf1K\xcd&\x19R\x05U>\t\x90\x13\x10\x91hJcg,.P\xd8\xc3+\f\xe2\x80rc\xd3s$-\x8d\xb0%\x93\\\xb0\x1bH\n\xd5A\x99\x8aɬboQ\x94\x9c\xba\xbc\xc1o\xbf\xb9\xd1\xe4\xc7\xfa\x81\xabU\xf9\xb6R\xb2H\xa5f\xceX\x94\xceZ\xf3\xe1[\xd7nf\x0f>|\x92\x8b\xf7\xa8;\xbf-\xa9\v\xca\xd2S\xb8JK\xca\xc2\xceNE`+4W\n\x89\xc5\xde6v@C\xcd\xfa\xad\r4^ዄ\xca \xc6\xd0\x16\x16a<\x9d{\xe5\xca\r\xa4T\x84\x81\xe2\xd2B\x97\x87\x8e\x1d\x04r\xb4\x9eP\x04\x01*\b<\xf0\x98O\x97\x02\xd4\xfe\xaa\x84\x17\\\x8f\x15\x14\x94\x14\xc6\x1f\xb1W\xba\x12\xb4D\xd6SÕh\xcfYG\xbf7\xe6\x97_\xbb\xce\xee\xb8\xf0\x9e\xe3\xa6P\x91\xacF/\xfc\x98\x9c \x8a\x02\xffo\xfe\x10\x1a1\x1eM~\x14`2\x1a\xdd\x1f\x0f)\x8dA\nI\x99\x97\xdeCn?;ʚ;5\xae\r\xcc2]N&\xc4\xf4ߧP}u\x12B\x1c\xe1f\x05\xe3\x0e.\xbdy\x954\x8dy\xe3\xd25\xdai\xcc\xe1\xd5E\xb2\xf1\x04\xa9$:\n+\x91\x9ej:l\x19\xa6\x04\xed\x05T\xd2%)2\x92\x03k\x14\x83\x1eEo\x1bWZ\xb2A\x9f\xbd\x9b7ȳ\x8c\xd2Zl\xe91\xab\"7\x9c\xb9\xb1ï\xbe~\xa3J\xe4\xb3܍\x0f^J\xfar\xdc9G\x1cE\r\xed\xec\x8c}\xf1\xfa\xf5k;\x00\u05efݸ?\x06\xf1\x92\x18I^\xd5\xf4J\xca}d\xff,&UWU\xd3\xc6\xcf!e\rË\x86\x1b\xf1\xeb\xbc\xfd\ue432,i\xb5;\\˶x \fH\x8cAK\x89\xb1\x8e\xb3g\xcf㜣\x95&$Q\xc8\xc2\\\x9bl8FVcѢ\xda-\xaf\xbb\xcb\xc8\xf6\u0094\x14\x0fb\x82\xc55\x82\xb9\f\x0e\x1c\xc3\f\xb6\b\xf7v<\v\xb8\xb5\xd5\x00\xa2e\xe9\x18g\x05\xff\xd3G^%\xd2\xf2.\xb3O\x95\fHk\xb2|L\xbbբ4\xc6\xcbZ\x01c\xedOr\x9f_\x9a\x8aO7\xce\v\x14\x84T\x88;Ɲ\xa7\x12Qk\xa7B2\x9f'\\\x05W\xbb\xc6\x18\xb3\xb3\x85B\b\xa20\xa6\x10-6#\xc9ڵ[\xa0\x14\x9d$f\x9c\xe7\x9c}\xe5<Y\xe6\x81\xcbGN\x1ec\xa1\xdb\"\x98L(\xe3\x04\x19\x04H\xa1qe\x8e\x9b\f\x11aR\xed>\x992\x8cN\x18D\xd4F\xea\x01a\xbbK8\x1aSd9\xc684\x8e\x9f\xfe\xec\x9b\xdc\xea\x8f\t\xf6\t\x13\xeaEkPG\bU\x9d\b\xe77\xac\x06\xf5)r\xbfv\xdf\r\xe2\x13\xb9\xdf\xe7Q'r\xa9T\U000f961dĝYpY\x13\xef\xb51j\x8fi\x88\x9djc\xf4\xc2\xc2\x02:м\xf5\x81\x93\xbc\xb2}\x85\xf5\xa2\xe0\xd8\xf6\x9eW\x97k\xc5(\x97\x9c;\xf7&{{}\xf2\xa2\xe4\xf8\x91\x03\x1ce\x05\x1d\xc7M.q\xd6w\xf1XS\t\"\x02_\xc59\a\xc6ϡK\xa5\xab\x85e~\xf4\x01ky\xfe\xd2\x06\xbf\xfa\xfa\xf5ی1\xe3\x1dB\x90\xe5\x05J\t\x92$b<\x1a\x13\x84\xbaY\xe1\x91e\xf9\x85\xfbm\x10Y\xc7~giJY)\xf6s\xe8n\x1f\r;+f\xb8\xfb>\x93iilI\x13\xbfz\xfcO~\xc9;X\n;\x88G\x8f\xb3\xa5\x14\x93\xdc\x0f\xff\xa7QL\xbb\x95\xb0\xbe\xbeů\xfc\xdb\xdf\xe2\x13\x9f~\x99Sgγ~s\x9dr2\xc1d\x13\xc8F\xb8b\x823\x85\xff;\xaf>φ\xd8|\f\xa6\xc0\x98\x12\x81\xdf\x00Wd\x05\xd7w\xfa\xfc\xe4\v\x17\xe9M\x8a}\xefiV\xd8W\x0f\x1f\x05\x81\xdf>d\x8c_1\xe5\xe5\xd9v\xf3\xe6\x8d\x1b\xc5\xdaѵ\xfbk\x90\xd8\x03g\xc8j\xfaֺz\xa4එ\xae\x99\xe5.\xd3$oPj\xaaϚ\xbd\xf0f\x92\xaa\xaa\xeb\x17\x16:<s\xf2!\x84\xb5\xf4\x9f:\xc9F5\x8c/%\xccwS\xbaݔ</\xf9ԧN\xf3\x1b\xbf\xf5)\xfe\xed\xbf\xff8\xaf\xber\x8e\xde\xf6\x0ee\x96a\xb3\x11v2\xc0\xe5\x19.\x9b`'\x03l>ƕ\x05ŰO1\x1c1\xee\x0f\x18l\xef1\x1c\x8e\xf8\xa9O\xbf\xc1電\xfb\xf6(\xd6\r\xe0T5\xe3(r/\b\x97\xb2\x9e\xa0\xaaa\x1fw\x03\xe0ꕫ\xf7\xd5 \xea\xd1ǟxn4\x18JY\x1f\xa0\xe5\xeafЇ\x9c\xe6\xe6\xda\xe9\x18\x82O檙\x05\xf1\x06\x99&\u007fO`\x89f\x95F\x10\x86<\xf1\x96c\x1c>\xb0ʫ\xaf\xbd\x81\x98\vȻmF;}\xa2\xf1\x848\x8dXY\xedНk\xb1\xbb;\xa0\xd7\x1bp\xeb\xd6\x16o^\xba\xcex<!P\x828Ph)qe^\xed0\xc90\x93\x11\xf9\xa0\xc7pk\x93\xdd\xebWٹz\x8d\x9d\xed=~\xe4#\xaf\xf2k\xe7o\x12\xd6\xf8\x9c\xa8\xa5\xa4\xfb\xf7}\xd5\x05c\x1c\xfbE\xcb\xfe\x01\xf1\x83Bβ\xb7\xb3\xb3\xfbO\xefw\xc8RG\x8f?\xf8\x1c\xc2ɲ(\x1a\xeeX0\xe53\xea\x1c2\xbb=n\xea\xfe5fe\xf7Uda\xe8\x15\xe3\xddn\x97^\xafG\x9cv\x99\x9fks\xe4\xe02ݤ\xc5\vg^\xa6\xbd\xdc%_\x9a\xe3\xe6(Gm\xec\x10E\x01Ǐ\x1f\xe0\xf0\x91ev\xb6\xfb\x8c\xc79{\xbd>\xe7\xce_\xe2\xe2\x1bט\f\xc7Lz{\x94\xfd=\xb2\xad\r\x06\x1b\xeb\f7\xd6ٹ~\x9d\xde\xcd\x1bl^\xbd\xc1\xa97o\xf0\x81_=͋7w\xff\x13rO7Ղͼ\x928nԎA\xa0\xb0\xd6\r\xf7\xf6z?r\xdf\r\xd2j%\xcf%IK\xda\xca\x00\xf5H\xc2l\x93'\x10w\xac\x88m\xa6t\x9d\xdb'\x01\x02\xd7\x1cs\xd4\xedv\x19\x0e\x87\xb4:\xf3\xe4Y\xc1\xc3'\x0e\xb1\xbc\xb4\xc0\xdeF\x9f\xedI\x8f0\n\xe9\x01\x9ba\xc8p}\x97\x9b\x97\xd6Q\xceq\xf8\xf0\"\xd9$'\xcf=D\xbf\xbd\xbd\xcb\xe9\x97\xcfq\xfe\xe2U\xb6\xb6v\xd8\xd9\xd8b\xfb\xdau6n\xdcd\xe3\xc6-~\xf3\xf2:\xbf\xd97\xf0\xf8\x172\u007f`\x95Ç\x0e2\x1c\x0e\xfd\x01\x93EYm\x15\x9d\xf5\fќ\xba\x03~T-К0\n(\x8a\x92\xc0τ\xb4\xfb\xfd\xc1\x0f\xdeo\x83\x88\xaf\xf9\xba?S\xbcr\xf6\xb4\xeeί0\x99\x8c\x18\xf4{\rq4\xbb\xaa\xa8\xa8ض\xd9M\xd5\xf5\x82\xfdz\xb7m\xed=\xf5\t<\xddN\x97\x9d\xbd\x1d\x0e\x1e~\x00\xe7\x1c_\xf1eo\xe3\xd9'O2\x1eO\xf8\xd7\xff\xee\xdf\xe3\x165\xe1\\\xc2pwH\u007fk\x007wH6\xf7H\x8a\x9c\x96\x92\xa8\xd24\x8a\xf7\xda\xf8\xa51Ղ5E\xa1\x15\x03\xa5\xb8vp\x85\xa8\xd3\xe2\a\xbe\xf5\xaf\x10\x85\xa1g5\x8b\x927/]\xe1\xf5s\x17\xb9v\xed\x06\xeb\x1b[\x9c?\u007f\x11\xacݗW\xean])I\xb7\xd3\xf6#\x15\xd5\x10\xd3`8:0\x1c\x8e\xee\xeb\x99G\xea\xaf~\xcf\xdfx.\xcf3y\xfe\xb5\xb3\xb4Z\x9d\xea\xb4\xcdl\x1f\xb9Ts\xcc\xe5t\xd5\xdd>cդ\xd5l\xc9\x1b\xc71B\xfa1\xb9\xa4\xdd&\x8aS6v\v\x9ez\xcb\x11\xd24F\x95\x82\u05ee\\\xa6s\xa0K\x98\x84̭\xce1\xf7\xf0\x01\xd4\x03\xab\x8c\x17\xbb\xecε\xd9LS\xb6\xa2\x88\xbd(\xa4\x17\x85\xec%\t\xbd4e\xa7\xd3fk\xb1\xcb\xf8\xe8*\xe9\xdbN\x12\xb6\"F\xbb#ʉ\xc1\x95\x8e0\b\bC\xcd\xfc\xdc\x1c\x0f\x9e8\xce㏽\x85g\xdf\xfa$kG\x0e\xf1楫\f\xfa\x83\x06@\x9c\n5\xfc)\rJJ\xc2(\xf4\x9b-\xf2\xe2LQ\x14/\xdeW\x0f\xf9\xb1\x9f\xf8Pa\x8dѿ\xf2\xcb?ϋ\xa7>\x8dR\xbe9\x9a]\x18%\x84\xa0\xc8\xf3\xc6\x13j#\x05\x81\xc6T\n\xc0\xd9\xf9t\xad5ss\xddj\x05l\xc6\xd2\xeaa\xd2V\x9b\xb4\xb5\xc0\xf1\xb5e\xbe\xe6\xcb\x1fGI\xcdO\xfd\xcb_do\xb1d\xee\xd0\x1cQ;\xba\x93\u05ef\x1aSS\x18lYK\xde\x04*P\x1e\xa6\xaf\xe6T.|\xfc\x02ý1]ա\x15\xb5i\xb7S\x0e\x1f>̉\a\xd68tpeFG\xe6ф\x9f\xfd??\xcc\u007f\xfc͏z5\xfe>U\xa2\aH[\xad\x980\f\xd8\xd8\xd8\xf9\xc5\xe1p\xf4\xd5\xf7\xd5C\xfe\xf4\xd7\xfc\x99\xe7\xa4R\xf2\xc1\a\x1f\xa6\xd7\xdf\xe3\xd6\xcd\xebw݃55ƴ\xff\xa8\xf7i\xcdzH\xbdF|~~\x81\xbd\x9e?\x91-\xae\x86\xfd\xa3(\xa67\xc89\xb8ܡ݊x\xe6\xa9Gx\xf1\xe3g\x19\xeb\x92\xd6B\xea\xb9}%\xa6\xe2\xee\x1a\x00\f\x14:T\x8d!D5\xce\x10ub\xd6\xcf\xddb\xfbڮ\xcfc\xb9#\nB&\x93\t\x93I\xc6\xc6\xe6vU\xf19Ɠ\ti\xea\xdfǣ\x8f<\x8c\x90\xf0ƥ+ͲMWI\x8a\xaa-ִҘl\x92\x9f\xb0\xb8\x1f3ƌZ\xad\xb49S\xe4\x9e\x1a\xe4k\xbe\xee\x1b\x9fs\xceJ\xa9\x14G֎\x91$)\u05ee^\xda\x17\x9e\xee\fK\xa2Q\xb4\xfb\xf3f;\xa9\xa90\fi\xb5\x12\xfa\xbd=\x04\x828i\xf9\x03\xea\x8d!I\xdb\xec\xec\x8d9qd\x11)\x05o9q\x9cS\x9f<K\xa1,\xad\xf9\x14\x1dy\xfcJ*\xe9\xffhY\xedM\xac\x1e\x10\xe9w\x91$\xf3\t\xbd\xf5\x1e\x97_\xbcBY\x1a\x8a\xbc\xc0\x19G\xac\xe3\xe6$\xcf<\xcfy쑇\xf8\xf5\xff\xf0\x11^x\xe1\xb3H)9|\xf8 J)\x1e~\xf8\x04\a\x0e\xac\xf2\xfa\xb97\x98\xe4\xb9g6\xa5DV#\xd1q\x1c\x12\x06\x81\x1a\x8d\xc6sei~\xe9~\x18\x03@}\xe7\xf7}\xe0\xb9퍛RJ\xcf\x03\x1c<t\x18\x1d\x04\\z\xf3\xe2>IPQ\x16\xfbEB\xf5\x88\x81\x94\xcdPdm\x90\xa5\xa5EF\xa3\xb1W\bڒ$\xed\x90$m\x8a\"'\f#ƹ\xf7\xc0C+]\xc2PӉS>\xfe\xb1SLF9\v\x87\xe7ё&\x88C\x82$@ho\x14\x1dht\xa4С\xa6\xbd\xdc\xc2\xe4\x86ӿ\xf2\x12E\xe6\xc3\xe5d8A:\x890SQ\xb8֚\x87\x1f>\xc1\xa9Sg\xb8q\xe3&\x93,\xe7\xc9\xc7\x1f!Ib\x92$bue\x85/\xf8\x82\xcf\xe3\xf5s\x17\xd9\xd9\xdd\xf3\xdf'e\xa3\xbeO\x93\b!\xc4ɢ,?n\x8c\xb9t_\f\xf2M\xdf\xf2\x97\x9f\v\x02-'\xe3\x81?\xe3Ck\x1ex\xe0$\xab\xab\a\xb9x\xf1\x1ce\x997!k\x16z\xf7\x95\x89\xae\x0e\xf62\x8d\xb4TJ\xc1\xca\xca*\xdb;;DIBY\x81uQ\xecϏ\xca&C\x92\xa4ˍ\xcd\x1e\a\x96;\xccw\x12VW\x96X\xbf\xba\xc9\xc5\xcb\xd7غ\xbaE{\xb1\xcd\xfc\xda<\xedն?b\xaf\x12\x84+-\x89\xdb\x11\xbb\xd7\xf7\xf8\xd4Ͻ\xd0lL\x9d\f\xfd\t\x0e\xcaI\x84\x15\xcd\x1ez\x10\xf4\a#\x1e~\xe8\x04\xbdހ\xc5\xc5E\xae\xdf\xd8`ii\x9e\x93\x0f\x1e!\x8e\x03L\t\x8f?\xfa\x16\xce_x\x93^\u007f:ePT\xa8E+\x89c\x1c\x0f+\xa5~%ϋ\xe1\xe2\xd2b3w\u007fO\f\x92\xa6\xfa\xb9G\x1e{\xabTJ\x93MF\xcd\xfe\xc2Nw\x8e\xa3ǎ\xb3\xb1q\x8bݝ\x9d\xbbhx\xeb\xc3\xe9\x03&\x93\xbcb\x0f\x05Z\a\xfe<\x90\xf1\x88$\xf5\x83\xfa\xd6\x14\x04AL\xd2\xeaV\xc7\v9\x82 \xe6\x8d+\x9b,/\xb6XYlc\x8c\xe5ŗ_E\x04\x92[\xe7oѻ\xd9#jEDm\xbf$\xbf\xccJ\xfa\x9b}.<\u007f\x91s\x1f=\a\xc2\xe1,^w\x1ciLa\xc0\x82\xc9J\x8aj\x90UJ\xc1`0$\xcb\v\x0e\x1d:\xd8(,\xaf\\\xbdI\xbf?\xe4\xc9'\x1e\xacVyhVV\x96\xf9\xf4g_\xa2(\x8a&\xc1\x97ƒ\x97\x16\xa5\xd51\x1c\x9f\xd7n\xa7\xbf\xbe\xb5\xb5ݟ[Z\"NS\xb2{`\x18\xf5\xc4\x13O<w\xe5\xf2E\xf9\xc43\x9fOY\x16~\xa3g\xa5GJҔ\x93'\xdf\xc2\xc6\xfa-67\xd6\xf7\x95\xc1\xf51G\xf5I9E\xb5A\xa8\xd5jᬥ\xb0\x86(\xf2\xa76\x17\xb9g\xf5\xa4\x94t:K\x8cG\x03\xa28\xc1:\xc1\x95\x1b;t҈\xdf\xf9\xc4\v\x98\xa2d{s\x97\xb8\x9b2\xda\x1d\xb1~~\x9d\x1b\xaf\xde\xe0\xfa\xcb\u05f9~\xf6:\xd7^\xbe\xc6`s\x80T\x92\xb2(\xc9'9\a\x8f.\x13\xa5\x11\xfd\xed\x01J*ƽq3%\xecg\x1e\rE\xa5\xcb\xd2Z\x93e\x19A\x10\xb0\xb1\xb9\xcb\xdeހw\xbe\xf3I66z,\xcc\xcfq\xf0\xc0\n\x1b\x9b[\xf4\aCtu\xa4\x9f?\x91ځ\x90\x0f\x18\xc4W\xc4i\xfa\xfc\xde\xd6֍\xec\x1ey\x89Z;\xba\xf6\x9c\x14\xc80\x8cY=\xb8Ơ\xb7CPm\xd6QJ\x13\xc7\tO<\xf14\xaf\xbc\xf2\x12\x83\xea\\\xf2i\xa7.\xaaz^0\x99xN\xa3\xdb\xed2\x9a\f\xfc\xacE\x10\xa2\x83\x90\xa2:\x9eNJE\x18ńQR\r\xf1\x87,tc\u07bc|\x99cG\x0e\xf3\xe7\xff\xccWЉSμ\xfc:a\x1ayC\x8f\v\xf2qN\x99\x95\xcd\xc8\xf2x4\x06\xebX{\xf0\x10sK]\xac\x16l\xdf\xd8&\n#\xccĐM\xb2f^\xbd(LuȽh\x1e\"k-a\x18p\xf3\xe6&_\xf4\x85oe8\xccȲ\x82\x83\aW\xe8\xb4[|\xfc\x13\x9f\xe6\xc1\x13\x0f\xd0\xedt\xc82\u007f\xb6I5#\xbf\x02\xfc٤\xd5\xfa\xf4SO?u\xe9\x91G\x1fqo^|\x03\x80\xb9\xa5\xa5\xffO<F\x1d>t\xe89!\xa4|\xf8\xd1'y\xfe\xa3\xbf\xc1\xa9\xcf|\x82\xe3\x0f<4U^T\n\x93G\x1e}\x9c\xf3\xaf\xbf\xc2`\xd0kJ\xdbz\xf5\x84\x97\xfaW\xfd\x89p\xbe\xb1\x14\xa0\x83\xa8:\x13\xb0\xcdh\xb8G\x18&\x94eN\xab=\xefo\x92\x19\xb3\xd7\xeb#d\xc8ѣ\x87YYH9~\xec\x10\x01\x8a\vo^\xc6)\xd1\x18\xc1TB\a\xa9\x04\xcb\a\x16X;q\b\x1d(\x92\xf9\x94\x8d\x1b[\x8cvFt\xd2\x0e\xb6\xb0\x8c\x86\xfe\xcc\xf4\xf1x<3#/\x1b̭>\xbe[\b\xc1\xdc\\\x9b\x83\a\x96\x19\f\xc6X\xebh\xb7R>\xf9\xc2)\x8a\xb2dii\x91\xc5\xc5y\u007f$l\xb5\xa8\xcd\x18\x93,//\xbdgn\xae\xfb\x941f#\x88\xe2\xd1\xce\xd6ְ6\xc6\xef\xd70\xea\xd8\xf1c\u007f\xb7(r\x99MF\xb4\xbbs\\\xbd\xfc\x06\x0f>\xf4\x18i\xd2j\xf2FYz\xbe\xe1\xe8\xb1\a\xb8p\xeeu\xb2lR\x1d.\xe9\xa1\xf50\x8c\x00Y\x81s\x15{\b(\x15 \xa4\"\bBp\x90g#\xc2(\xf1\a\x14\xa7m\x9c\x13\xc4\xe9\x1c\x0eŵ[\xbb\x8c\xb2\x82\aז9v\xf4\x10\xa9\x8c8u\xeaU\x8c\xf0\tz\xf5\xd0\"\v+s,\xad.\x90\xa4\x11\xd6:ߗ\xb4C\xde<}\t[Z\x96\xe7\x97\x18\xec\r\x99L\xbc(<I\x12t\xb5\xa0\xa0.\x85\xeb]^\xfeȥ\x10\x81\xe0\x1d\x9f\xff$\x1b\x1b{\xcd~\xc8\vo\\\xe2\xda\xf5\x9b,//U']\x87\xcc\xcdui\xb5R\xd2$aqq\x01\xa5\xd4ceY~˭[\xeb_\x16D\xd1\xe3q\x9aN\xb2\xf1\xf8Rm\x8c?\xfeE_\xc0\xd5\xcbW\xfe\xdf\x1b\xe4\xf8\xf1\xe3\xff\x9d\xb5V\x18k\xad\x00\xdbnw\xeccO\xbe\xdd\x1e;\xf1\xb0\xdd\xd9Z\xb7 \xacs\xd6\xe6YfӴeӴe/_\xbah\xaduVJa\xa5\x94\xb6\u06dd\xb7\xeb\xeb\xebV)i\x85\xacG\xa0\xb0RJ\xabu`A\xda8N\xed\xa0\xbfm\xb5\x0e\xad\x90\xc2&IˆQ:\xfdZ!\xec\xf6\xee\xc8\xee\r&\xf6\xc4ڢ=r\xe4\xa0=\xb1vȾ\xf0\xc2\x19k\xa5\xb3\xc6X\x9b\xb4\x12\xebp\xb6,\xadՑ\xb6A+\xb2\xd7.ܰ\xbd\x8d\x9e\x15Bؕ\xb9\x15\xbb\xb9\xb1i\x8b\xa2\xb08l\x1c\xc7V*i;\x9dN\xf5{\x9c\r\x82\xc0\x02Vkm\xe38\xb6\xa3qf\xffė\xbd\xddnm\xf5l\x9e\x97V)iwv{\xf6\x95W_\xb7\xf3\xf3sV)e\x01뜳J)\x1bǱUJY!\x84\xdd\xde\xdeu\xbb{{\x87\x85\x10\u007f\f\xf8\xfa8M\xbf!i\xb5v\x0e\x1e:\xf4ڙ\x17O\xff\x9e\xf6j\xfd\xdfއ]\x88o\xe9\xb0\x13\x00\x00\x00\x00IEND\xaeB`\x82")
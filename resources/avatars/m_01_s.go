@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_01_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00,azTXtRaw profile type exif\x00\x00xڭ\x9cY\x92\x1c\xb7\x92E\xff\xb1\n-\x01\xf3\xb0\x1c\xc0\x01\x98\xf5\x0ez\xf9}.\xb28\x88\xa2\xf4\xf4̚\x94XdUf\x04\x02p\xbf\x83Ñ\xee\xfc\xef\xff\\\xf7\xc7\x1f\u007f\x04\xefKw\xb9\xb4^G\xad\x9e_y\xe4\x11'\u007f\xe9\xfeǯ\xf3\xf5\xf5\xf3\xbd\xe0\xf3\xfb\xf3ۯ\xf0\xf5gp\xbf\xfdA\xe4k\xe2k\xfa|\xb3察\xa6\xaf\xef\u007f{}\xfd\xfe\x95\v\xfd\xe6\a\xa1\xfc\xf2\x86\xf4\xfd6\xf1\xe7\x1b\xb7\xf9\xf5\xfd\xe8\xe3\x9fFd3V\xff\xf3\xaf\xfe\xe3\xff{w\xbf\xf7|\x9en\xe6\xca4\xd4\xcfC}n\xe1\xbe]\x86\x17.f)\xbd\xb7U~7\xfe/\xfc\xbd\xbd߃\xdf\xddOo!\xfb\xed\xcd/~[\x18!\x86\xe4o\xc8a\xbb0\xc3\r'l\xbeZ0Ƙ㉍\xaf1ZL\xef{=\xb58\xa2%\x9fB\xca\xfa\x1dnli\xa4\x9dz\x8a\xc9\xe2I)e\x97\xe2\xf7\xb1\x84w\xdf\xf1\xeeg\xa1s\xe7\x1dxi\f\\,\xf0\x96\u007f\xfc\xed\xfe\xd3\v\xfe\xcd\xef{\xcd3G!\xf0\xf4\xe1k\xae\x18W\x8cZ\x87\xa0iL\xfa\x93\x97\xb1 \xe1~\xad[y\x13\xfc\xed\xf7\xf7_\ue9c5M\xac`y\xd3\xdcy\xc0\xe9\xd7\xe7\x12\xab\x84\x1f\xb1\x95^\x00$^W\xf8\xfa\x89\xafжV-\xbe(\xc9ܻ0\x98\x90X\x02_C*\xa1\x06\xdfbl!\xe4\x14;\v4\x19yL9.V \x94\x127\x83\x8c9\xa5\xca\xdat\xe2\x88{\xf3\x9e\x16\xdekc\x89\x9f\xef\x93*\xacOI55\xd6f\xa4\xc9b\xe5\\\x88\x9f\x96;14K*\xb9\x94RK+\xbd\x8c2]M5\xd7RkmU97[j\xb9\x95V[k\xbd\x8d6{깗^{뽏>G\x1c\x89\x94,\xa3\x8e6\xfa\x18cN\xee9\xb3\x9be\xf2\xee\xc9+\xe6\\q\xa5\x95WYu\xb5\xd5\xd7X\xd3\b\x1f\xcbV\xacZ\xb3n\xc3\xe6\x8e;\xed\xbcˮ\xbb\xed\xbeǞ'\x1cBɝ|ʩ\xa7\x9d~ƙ\x97X\xbb\xe9\xe6[n\xbd\xed\xf6;\xee\xfc\xbej_\xab\xfa\x97\xdf\xffŪ\x85\xafU\x8bo\xa5\xf4\xba\xf6}\xd5\xf8nk\xbaлD\x10\xce\x14\xad\x19+\x16s`śV\x80\x80\x8eZ3\xdfC\xceQ+\xa75\xf3#\x92\x15%2Ȣ\xb5\xd9\xc1O\x17*K\x98O\x88\xe5\x86\xefk\xf7c\xe5\xfe\xf5\xba9\xe6\xfa?\xad[\xfc7+\xe7\xb4t\xff\x0f+\x17\xddI\xbf\xac\xdboVm\v\t\xed\xad\xd8'\v5\xa7>\x91}\xbcf\xc6\xce\u007fL\xf6\xd7_\xde\xd7;f:q\xdd>[ᾩ\x9c8\x98\xa3qJ\\e\x17#E\ft<\\\xd3Z\xf1m\xd7\xee\xd3\xe1\xf1\xe3r\xa0ؽL\x00\xef\x01J\xb9\x9e\x9fힼ\xac\xe8_\x96\xc9(\xe5=\x88h\x85\xbf\xe6\x90\xdf\xf7G\xebDG\x1f\x8c\xf5\xae\xca\x18\x1c\xff\x00\x8aC\xf5\f#\xdd\xc3Z\xe9\x85#\xde\xc0\r@\xc4v\x99#@\x94\xbfl_ҽ\x91[\xf2\x8e\xf8\x06\xb3y\x9aur\xde\xde]\xee\xd3\xfb>10m\xb3\xadb6BZ@Ab\x18\xbcu\xc4\xcc\x02\xfan\\\x9f0\xb8\xb3\xb4Yo\xaf\xcd\xe28q3\x9cX\xb9\x8f;+MB\xa9\xc4\xc4\f\xb5`\xb31lS\xb4vk\xa3\xae\xb35\xc2]'\x88\xb7\x8e\xb5\xd5J(\x96\xcb$\x14x\xd0\x1dc\xa9\x1a\x96\xcbk\b\x96\x19N\xccpnn\x0f<\xfd\xa8\x87 \xb2RӶ\xcbt\xf0\xc6e\x04̞\xd7ϲ\xc6\b\xdd\xea\xdc!\xd6RZbE\x9d\x85\xb3\v\xb11\x99\xcb\x05S\xec\x12ˎ\xd6fہ\xd9 _|\xac\\\xa3\x1e\x96n\xd66ʞ\xb9U;)ڶ\x91\x88\x99}\xc65癷r\x8d'd\xace\x87@\x042Guڭ\xa6\x99lk\xdd\xe1W\xb6eo\xfd\xee%7&/أ\x876\x88\xb8\xd3J>n\x01o\x87eaA\x12d@\x1c\xf2n\x9bu'M\xc4ܝ?\n\x81N\x18[/)\xad\xd2l\x95\xa4\b\x1f'w]u\x91\x05\xc7Ų\xc2\xce\xd1jX\x04}\xa8eDF\xda2W\"\xa7;\xe4\x18\x98\xfaL(*\xbak\xebg\xe7Ð\xa2\xb1.$+\x81Y\xe6\xd8\xe6\xdeL\xac\x135\x89\xad\xcfs\xf2\xe5\xa1\x03\x17\xbd\x99k\x11\xc6Vs\x87LO#l\b\x05\xa8\xf3\xb2\xea\xac\xeeg=\x1bY5vu\xad\f\xe2\x88\b\xcd+\xceCLV%B\xdf{$c~|)\x1d\"na\x80\x0f,\x94V\xf5\xa6\xc0 \xccvY\\ܼf2\xb9uɼ~\x9ae`c\x9e\xbdrH\\\x8f\x1fU.V.\xc0\x16\x06\xb1\x97\xb6\x82\xca29\xf1\x92\xf3\u05ef\xee\xef~\xa0\xaf\xeb\x142g'=\x02\xd7\xf3/\x17\xefZ\x02\x04bt\x81\x15D1\x89\x95Js\x17\x88a\xb4g\x9dsHC\xb8Ly\x98\xbe\x81\x029\x06\x06\xac\x840\"\xb5At\xd2`+\xad\b\xb0\x11\xcb\xd8}\xcd\x05BoǊ\x11\x9f\x8b\xf0\x1aLƺ-^b\xb0\x92\x9c|\xb3\x91\xcd\xcd\x00\xb1\x1b\xc6\xdeJC\x92>\x12\xde\xc6|\x81߄I\\\v\xcd\xc4\x1c\xa1\xa7\x82\x1dV\x82՛A\x81ƚ Ύ\xdd\xd5\x14J\x15d\x84\xb9\xa43\xee\xe2\x1d\xe3\x8e\x15X\tn\xd3\x0ea\x14G\x13+\xbb\xd9\xf26\xdeI\x04\x10=\xe7z\x00w+YB=#lnw[=1\xb1Js\x9e\xb2N\xb5k\f\x1d\x9e\xaf\\'\xcf\v\xf6\xef\x9c\\\x81s\x14\x91\x87\xd5\xe6\xcd`\xbe\xf5\xb9:\xffH\x9by\x81\x99F\xef\xe8\x87\x12\a\xe2o\x9e<G\tF⟾\xc1\x96V\x89\x84\x04^\xb8\x92\xb2\x1e\x82\xa5I\xb3\xb7\x96\x12\xb13y<hc\x8057\xbc\\ \x91b\x06ܭ\xb3*`|i\xc8G\xdb\xcdl\x91\x87\xbd\xa6\xe3Px`E+i\xa1\xa6K#\xaaX\xc1R\x88'\xb8\xe2\x1c\xc0\x19Qh\xe3.\xc2\x12P\xab\vh\\\x95\x05n\xbb\x81\xc95\xdd\t\x9d\x1c\xf4\x11\x8b\t\x1c\x0e[u{\x90\xa2\xdcĚ\x02\xbd\xc6\xc4j~f\xc9k-\x9e\x8f\\\x1b\xe6\x15\xb2\xd0\v\xac\xc8\f\xb7\xc5\x04ԇ\xea\x0e\x18\\ת +\xec\xb1\xfcAΦ\xcd\xca\x11f\xf9*\xd05\x19k\xf7|װ\x93ۄ\a\x93\xa8\x12\xde;-\xdcMf\xe5Ԝ\xdc\xc7a\x01\x012\x1e\x10H\xe8<\x1dX\x19kbr\x0f8\xbcᙹ\x89C\x16+\xaf\x8aD\xc3\"\x84mD_7\xe1\xa0\aע\xab\xb0\xbc\x91\xab,]\xac@;4\x04\x11\x9f\n\x85\xc2K@\x93Y}\xbc\xc2M\x92\x16\x13\xe9u 滯\x8d\x19ɠ\n'\xe4\xe9\x80\x01\xe4E$=ǄiO\x19\xba_GF\xf8\xc5lĒ\xc9|b\x81\xb0\u07b3\x98\x1f,z\xea\xf2LGW\"<\x03\x8a\xe2\x14\x87|Iab,B\xda;\x18\xa9X@&\x12\v\xa0ΙW2\xbe\x98w\v\x13I\x94\xe2DVT\x03\xdfs\x1a\xb5x\xe5\x14\x89\x81\xc4v\x06}\x1b\x89\x8cn\xb9\x91a\vN\x90\x03YjĀ\xb2R\xf3$\x1e\x8d\x19\x1b\xa3\xc0\x89pD\xf7\x90\x1c\x82K\x8b7\xbb\x11\xd3\xde܁\xac\x03\x94\xcf\b\x83Ճ\xe5\x81\x0fq\x19\x80\\\xcf[\x93\xcb#-\x04K\xdeg\xf2\x10\x85\x14\x03FP<\a<\x16\xf4\xb38v\xa63\xebP^F`\xe5\xcbU\xda\r\xb3\xfc\x13F\xfd\xc0*D@\xdf\xe5\x1bV\xb9\xbf\x80\x15\x19\xf2\r\xac\x84U\xf9wX%\x9a4\xe8t\xded%1ã:D`\x02\x8cpd\xe3e\"jq\x93\x93\f\x98H\x00\x01\xe1=\x04Ho\xfc\x1f/1>6H\a!\xf5\x0e\xc8\x1b\xe4W\x99%\xe8յ:Xa\xdfZ\xe4\xb6Le2D\n\x8f\a\xd1\x12Y\x87Hd@\xacN*\x9b@5\xa2\t\xb6\xad94\xd4Z\xa83A\x15x\x8e\x92\x1c\x8ec\xedv\x0eB\x83\xcb\akH\x84\x83?b\x82\xd1\x14hI\xe0 v\xc8\x0ey\a\xb2\x9a'\x95\xb8MD8s]\"x\x88\xc7\xc7v+\x10\xff(\\\bא|\xfc\xf4\xd84@\x844\x1f\x06\x8b\xaf\xb9Q\xb6Y\x19RvG\f\x98\x16\xe4Hמ\xc6\xed\xe0\xfe\x01=9f\x80\t\xbaS\x80\x98a\xc0\xc3\xf0v\xc0iF_Qk\f\x87\x04\a\x02ѩ\xf3X\xe9\xb9&\xee}\xa09L\x0f\xa1\x84be!\xe2Eh1\r\xe9p\x895\x13o?\x15ܮug4\xbbd5q\xd7C?\xbe\xa2&\x19uݨt\xd4\xcfP\xa8F\xa0\xbe\xfb\xbcy\xfdt\xf1\xe4b\x92\x8f\x90\xe8%\xa5ȿ\xc1\xa2.4\f\x82\x84wv\xe8\xe8\xf0P\xc4\xea)\x17\tO\x90\x92\xa0D|)\xca3\x8f\"\x1b\xd5\xdc\xe59\xa1\n\x18\x86\x94ʆ0!X\t\t\xb2x\xa2\xebAs\x8fTo5\xce\xecq\x05\xc0\x1b\xb9IVdV=\x10\x95X\n)\xa7\xe2`\x02\x03\xb0\xe3\xae%\xfb#\xcb\u007fAZ\f\xcdN\xa5\x82\xa4\xa4\f\x0f\x85\xb8\xf2\x00\xc1[\xd4\x06\n\xdb\xda#\xd6\f\xd1\x16\xad\x1b\xb2åy\xeaȄF\x81\xf0H3\x93\xfa1\xd4-\xff\x82{<(i\xb5\xa2\x9e0\x06,OH\x18}\xf0\x0e1\x9c\x03h\xc8\x02\xe7 Nu\xd2\b\xa8\xc6\xd3|ڠ>+\x06\x04\x16\u007f\xa4\x8b\x89\xc2\vc0\x1f{dH\t)\x01\xc5\t\xea\xaa\xe2\xbe,揧\x81|\x8a9\xees\x80b\x049\xe1/͚b\x18\xbd`;\xcc2`\x1f\x16\n\v<\xc8\xe7\xa5ƙ\r\xeb\x05\x18\xf0\rf\v\x1eC\xefvV\f\xccּ'\xa2`\xe0\x8b*\x14\x0f \"\xcfΘ\x10\x14K\x0f\xb3\xf9\x1b6(\x8e\xf3\ue554i\xa6(e\x0e\b\xef\x85\x00\x06\xb5vd\xf9\xbd\xea\x1e\x18\xb5H\x9c1\xf5\xf02\t\x1f\x12j\xa0>DHs\xabLc\xf1A\x06\xdan\x1d(%l@\x14\xaa\x81\x19@\x81\xb0\x1c\x8a`U\x84!\xf2Wv\xa3\x8b\xdf0k\x80\x0e|\f\x18\"U\x13|\xde/\x981F\x95\xd4\x15O-Ȉqu&r\x8c\x14\xd6p-\xfa\xf3*\x1c\x83\xb5\xcdh\x99\xb5\x13\x92\x87\xb4\x8e\xc0\xa1\x95=\x14\xc4 +\xfe\x16EW\xe5\xe9\x8ah$Lp1A\xa0,\t\n\xd1a?\xc1\x1a\x95CPS\x10F\xe4\xd5^ \x02\t$\x8f\x99x\x0f\x04\xc1\xbf\xaf\x17\x9e^\xcf\x1fm\x8f\"@\xff\xa2\x90\x9e(s߬\xdao\xbf\xfe\rT\xaaH$г\x9f\x00\xd7\xfdV\x1d\xe2\xa0\xf0\x1f\x18\x12B\xef\x8d\x04\xae\x1ag\x00\x85f\x91)\xe6\xb1\x19\x1d\xaaf3\xe5z\xf2\xdc\xf1k\x97LǃB[\xdd<\x11\xb2BG\n'\xe2U\xb4Ŋ\xb6*yl\xc8u\xb8\x0e\x94\x81g\x90P\r\x19\x87:ܤӀR\x1d\xeb2\x13\xa6\x95\xa4\x81\xce\xef\xa3\xe7\xba`\x01 \xd8G\x9c\xac\xe9\xe1X\f\xc4>\xeb$@\xe1\a-\xe5\x9e0\xec\b\x16\x83\xbd\xeb\xea\xf2k\t\xc1\xa4\xd8b\xe1\xc3\x11\xfe\xb0(0\x19F\n?\x00=\xe2\x00+&\xfb\x12\xb0\x10\x17I\xc7\xcc̈\xf6\xc0\x825\xdd\x18\xd9\xeb\x1d`\x80\"\xebm\x868\xb0\xf8@\xe1E\xfb0Vr\xff d\xd0\x13\x88\xbe\x19\x8c\xfb\xc0\xa4^\x92\x8b\xd9\xf0Z}\xfe\xa8\x04\x11^\x05`î\xe4\x84Ǐ\v\xd9 \x18f&1\x1a#Jv\xa9\xb4G\xd44\xcc\x04\xb4\x8c\x05˥*r<\xce\x1b\x9d\xec\xe1f\x1f\x85$;;\xf0\xa3\xc9ڵ\x0eC\x80\xde)=XD\x8fVI\x8a\x94\x0f\x03\x89\x89@\f\xa7B\xc5{m\xdc\x0e\xf1\rhu\x12\xcdPx\xfc\xbdr\xa1K\xba\x01ɰ%8\xcf\xd4\x06H\x1e\x11\x1a\xb8\"<\x1f\xf8{b\xe1\xc3i\x9a:\xf1IB\xef,\x00\xe2\x16@\xebE\x15K\xe1H\xb2\x191\xb6#>\x1d\x01\xa6\xe0^H=\xc0*bj\xd2\fa\xc8#\x82EGz\x1dx\x02Z\x83\x97z\x8b\x15\xb9DNn,\xa6#\xd9+\x9e\x9b\xcbaӍ\xf9\x8f'\x1c\xe0\x1a\xf7\x86!%\xa8\xb9ȺUՁ\x10\xa1Qȇ\b\xbb\x11\x1e\x05J`\x13@\r@\xa9\x8e\xcc\x05\x81Y\x9b\xca%\x01\x04=\u007fC\x04\xf7س\xf7\xcc\x19rM\x84Ȭ\x01\xd5\x13\xcc9\xc8\xea\\\xc9!4%\x80G&\xc0uޡX\x81\xae\x1b䏑u\x06\xdc\x1f\xf0\x00}$\x8eS\xcc\xf3͞T\xcfB\x88\b\xae\x90\x83\x81wC:\r9ư\x1b\x96\x10s\x8c\xdbX2|g\x90\xd8\f\xba\xe2\xb5\x13\x83\xea[\x86\x0f-\x06\xbe\x82\xfe\x8d\xd9`-\x88\x9dSU8Aʟ\x0e\x86#\xae\x83\x87\xec\x1c\xb3\xcc\xec\x12E\\\xb6\x80KH3\xb2b\xf0`\xd7'\x1c\x0f\x81\x04\x98\xd7\x11\x06\xda\r/\xda\xc4\x113\xab\"D\x94\x8e6\x0eZ\x84\x18u3\x17\x81\xb8ID`\xa1N`\xa2\xe0IM*4\xa8\xdaN\x83\xe6\t\u007f̖\x8a$G|\x03m\xab\xa4\x137\x19\xa2\x14\xd9g;|f\x06\xccH\xa4#\x1a\x83˗֤\xad\xf7\x92yP\xdd\x17\x89A>B< \x03\xbe\x1eY\xc0\xdd\xfd\x9f\xbf\xba\x9f\xbe\x81\x13\x96\xc2\x05\x93\xa0\xe6\xde@ý\xb9'\xab\x04<\x16\x14\xac\xbd\x92\x10\xee\x90Ѡ .\xc9\xc1x\xdf\r\x83\x9b\xb82f\xf9.\x84q\x94\xe1\xdb)\x03b$.\xf2\x14\xefs\x9e\x03@߷\x91Hh\x1c\x00z\xf8\xea\x81\aDvb. \xd2\xf1\xc8\x1a\x83\xee'\xb1\x90\xfbQ\xf9\x06\x02a\x16⸐\v\x1a\xbf\xf8<\xb6Bʞ\x96\xc3H\x04\xfc\x01\x9aM\xf2\xe8l@\x06C\x12@\x16װ\x1a\x11S9\xe0\x96\xd9\xf1h\xaa\xf0#\xed$ˠ\xdfz\xcc\xf3\x84re\xe4\x03\xb8f\x8d\xbb¤(\x1d\x18i\xf8\t%\xe2QH\x91\n\xe2V\xdc\xdc\xc5\x18\xa0,\x17\xe9|.\xd7d\xc0[+\x97'xA \x12\x1a\x10\x8eJU(\x1c\xb4C\xab\f\x04\xdcL\x15\xfc\xf3ӡ\n\xb2B;KJ\x92\x8c*\x1eu^\xd9Y\xa7E|\x97\x88 \bڅ\xc0\xce\x1b\x16\x8d\xe4(\xfd\x94\xac\xc2\\\x92\xe7\x85\xd703$-j\x01\xcdX\xd2\x0e\xe2E4\rF\xa4!\x12*\xeb\x06\x9d\xbcr\x91J\xe6\x1b=b1\xa2\xbc\b#\x98\x16\xf3^\xd7\x10M\x17\xec\x84\xc3\xdf\xc1L\x1ew7T\x97\r8r(\xe9\xf2f\xdc\xe0)Ge\xb0\xa2\u007f3;\x85@\x9e\xd7\x00Tx\x03\x97\xc3r\x1ci\x15\x90k;\xf4+Vj\tӑ\x1e+\xa8l\x91\xf5\xf3\x06^/\x14'\x0f\xc0\xbaw\x80\x18\xb6\tK\xa0_\x10\x8b\xa6\x9d\x1c\x9c\xfb\x02\xf6\x98\xda\xeaz&Y b\x94\xad,\xbd\x82(\x90\x8a0[@8#\x8e\xdb>\x8apŵ\x90m\b\x1d7S\x87\x82\xdf\xf6L\xfc\xcb\x01\xa7J\x10\xb2\x010\xb3\x86\xcfE\xa5\xf7y\xdb\x03n^\x9b\x0f\u007f\x9f\x01a\xc4\x18Pp1\xe2s\xed\xa2HT\xb1\x06\xd31.\x99\xc4\xc9\xd8u\x8d\x1f\xec\x91\xe9$\xe4\xf1'C\x15\x85\x0eC\x88\x19\x03\x82\xee uF\x94\x1e\x86\x0f\x14~GU\xbc\x88]erQ\xd4K\xf5d\x874_$?\x92\x02\xa4\xe0\xbdW\xe6}\xf5>\x82\xea-\xe04\xe8\xc4\x14\x92\x04\xe6}\x1f\x1dr_@\t)\xb8\x17y\x04\u0081\x8ax\x01\xa7\xb2\x01\xa2\xc2\x13\x1e\xd2?0\x01\x8fy\xf6$|\xb2\x89#\x19/\xa07Q&\xd8kXL%_\x1e\x94\x1b\x1e\xb4\x94*\xc4]\x8f\u243f\r\b\xaf\xbe<\x14\xf0\u007f\xff\xb5\x19\uf49b\xc0\x8e7cڽ\xc1Y\xac\a\x83\xdeۑxX\x96\x05\x8b\xa5\bu3\xb8\x89,\xc0ޡ8\xbb\x8aAm#P1\t\x19Ȩ\xd7\xf3\x1e\x12\xb3\xbf\xe0?85L#@\x9a\xaeC\xc2\xe2\xf3\xf2^\xac4! q\x040\x822\xc4R!Ny:D\x01<\xa5\x99\xaf\xa0%\b\x8bZD\xb0\x85\xa0\ng\x87\x19V\xee\x06A\x02\xecؗ\x8ecf\xbej\xdc,\xf1\xc88\x17\x90\x9c\xf9$\x16Q\xe1\vY\xa0M\x89\xbbL\x05\x1d\xe4\x01\t\x99\x1ad\x8b\x96\xc8\x1b#\xe6\x98\xc7\aa$\xff4I\x9e\xee\xe3\x94p\xf7&s\x82\xea\xc4~\x13\x1c(\x1c\f\x1d9+C\xbe\xa6'\x99\xc5M\x00\x12t\x1a\xce\u008aƀ}\x84Z7|\x9e=\x02\n\xa9\x8e\xfa<*\x8fV=9.\x00\xfe~Vlt?\t\x1a\x94\x18\xf0\x83\n\"\x8eyJ\xe6d\xba$\xb7\x96D\x8f\xe1yK\xe2\x02r*U\v\xacj\xa0v-/D5\x18\u007fBπ\xe7`@8\t\x81\x1e\x9aʝ\x17\x86,\x11}\xb4O<\xaa\xad7\xb9\xd2\n\xe7n\xd0duP\x1c\aY\b\xc5p5\x9b\xafR\x84\xbbBq\x81\x89H\xb9\v\x86`qb\xf6\xed\x14(\xfb\xed=4&,f\x18M\xef\u007f\x15*\x95\xcc\xfa\x86[3R\x8b5W\xfdI\x01\x80f\xc3\xedJ\x93\x94\xd6u\x13)\xf6\x869\x06\xf2q\xa0\x98i\xc0\x0f\xa4\xaa\x04\x86\x1c\xb56\bU\xfdX0\x13f\x01p4UF\x98\xae\x04\x11/\xa4%K\r\x86?\xd1\xd0Ŵ[\xf6\xa1\xeeX&z\t-\x891\x921\\\xfaΐE\xc8\x19\xa9\xb9\xe0\x92\x1c\x94\xd2M\xf5\n\xa8iB\x18\x17U\x8a\xcdB*\xa5\xea*a\x83\x01\x04gk\x9b\xb2PUˊ\xc0\xc2\x03\x91\xb6\xe7\xbe*\xa5\xf5\xdc\xc7.\x126*h\x11\x86R\u007fR\xc98\xe2\x0e_\xb0j \xe6\xc0o\x87[\xf2\xf3\v\xf9<eƅϫ\xfc\xddM8\xa0TX\x92\x1d\xbd\x12\x0e\x89\x1e\xc88\xa6\xbciK\xebJ\xba#\xd8\xd3\xe2\xd1s\xc0՚\xdeՁ\xe8\xd9Ѱ\x04U\x05\x8b\x8a6\xf7x\xbd\xaa\b\xf8\xf3\xa7ҳ⺈g+V\x1d\xe5\x00\xea:\x84\xb28\x01\x1b\xd1YZ|T\x81\xe7\xd6)\x80\x12\x96\xef~d=\xb2\x0fy\x81\xca#\xbc\x91b't\xd8;Lt>\xbe\x9f\x80\x82W\x9c\x16#\x8c\xbdP4\xe9I\x9f\xa1\"\x12ܓ\x12S/\x18\x18\x90\nP\xdd\xf0^s'\xd5؈\x8a,a\x1e\x81\x81\x85pRǁ\xf3\xe4\f؎Q c\x88u\b\x9c\xe7\x0e\xc8\x10\xac\x1aSV\x9f\x00\xbe_\xfaF\xba\xe3Kሐ\xb1J\xa0\x04\xa3\xef\xcd\xc1\xffdR\x1f\x8d\x88\x9d\x03DE\xc0\xa1\xd0a\xa6\x8a\xbfN]ک(\xdd\xc1*$)\xe2\x8f\xf5\x9e\xe9/\xde\xce\xfd\xd5\xecq\xff\x80\x06\xcb0=*\xa9Ċ\xb5\xcd\x10\xad\x99\xec\x0f\x008B9\xd2F\x88\x81\x8e/0U\x13\x96+\xa5\x0f\xa06\x92zp\xe2\x1b|EY\x85\xa9\x8a\x0f(^d\r\xa0\xacY/q\x9e\xe3e\xa2v\x9659\x8a\x94\xccD\u0085a\xb8~\x91\x142G\xfbN\xcd^P1]5̦*\x19\xc2pn\xa8\xffmLa{\xa0\xa6!tPqu\x94ō\xf0{`\xc5l.\fF_\xc0B\xb4Q!\xdeC\xdc\xcfD\xa2J\t#\xf2ڈq\xe5\x11*;\xe7L&\x0e)!P\x94''\xdb\xcd<d\x89\x18͈\xec\x89\xc7\xcc\xdc\x17\xe4<\xcfA2zӾ\xe4})a\xb8ؤ\xd2S\u007fȊ\x04D\"\x82\xc0gHn\xf5+\xa2\xbb`6^\xad-SA\x80\x9b\x8fO\x01U\xd9\x12H\xad\xa5Z\x18x\x1dkn\xfb\x15\xafAq|\x16\xc6\x02Q\xd1\xdamR\x92\x178t\xd2i\x93\x9cD\xa8\xbe\xaa\xbbʭK\x1b\xc4$\b&\x17Ap\x18_\xc7,A\xbaP2\x13\xae\xaa6\xff^\x03\xfa@\xe7\xf8*\x97\xe7\xf6\r\xbb+\xda%1aU\xf4::\x03\x00o\b\x9d\xca3\x04\xdc\x17)\x9a\xf4\\\x13\xc8\xdfXA&\x1cD\xd3\xe4\xc4sP\x8d\xf8.\aB\xacc\x01\xfd{\x1b\xd78z\x86\f|nݑ\x17\xa8\xf8\x85\t.xGt#~\xacu|JH\xda2'id\b\xc7\xdca\xbb\b\xae\\L\xa1\xf6K\xb5-\x101z^\xdb\x0f\xfbhC!\x82\xfa$\\8\x13\x9c\xe3!\xb1\x11r\xa9\x92EM\xfcϔ\x01\bu&\xb7\xb0\xe5\b\xb1w%\x14'Č\x18\xe3\x9f\xc0\nܰ\x1bq\xb7@\x12\xe8öj\x1b\xcb\x13\xee\xfcI@\xa6\xf9)fC\xd2\xda\xf0\xado\xcb\xfd\xa6\x90\x9b6#T\xab\x8ahj\x82\xe8j\xab\a\x94 \x12!\x06m:\x9d\x83\xe6\x0f8#\xf9F\xc6,\xe0'\xce\xf9\xcbp\x88\xb7B\x184^\x0f\xba,\xe06#O\xf6|[\x0e*\xc9\x02|\xda$\x96\xc4i<\xf5[\xac\xb1\x12y\x84\xf4\"\xa5\t\x1bq\x90#\x9bD#(\xe9\x8b\x19\xd4f4\x94\xb3\xb4\x9b]\xf0i\xbcPfs\x11\x03\xac\"\xde\x0eYw!\x04\x13\xb0\x03\x06^\xf5B\xf2\xb8&L\x8d\xc42\xe0\xb9\nj\xee@\xf3\xc8\x1d\xc1.\x14\x8f\x94\u124fj\xf8\xf8\x96\xf6\x16\xaf\x1b$\x85\xb4\xaf%#Z?5Ŗ\x1a0\xc2j\xeb\x06@k\xbd\n\xdfxy,\x95\xb23\x03>y\xc4@\x14\xe3\x1e6^P\xc5^m\x11`\xb1\"1\a\x11\x8bI\x1b\x10{\\\\Yj%j\xb7\xeeSG2\xe6\x81\xf9\a\xe0\xb1K-Ա\x0fj\x16\xa9H\xbe5\xf9\xec\x8c[\xd2 \xb1\xc5W\xc2f#\xb7\xab\xbb\xaaӂ\xbd\x0f˧\xae$N\x1a\xc8\xf6M\xb4h\xe7i\x8e\xab\x9d |A\rXcm\xbb\xcb\xf8q\xb3&\xb3Q\x03\xacs\x933\x82\xef#\xb4T\xd3\xc3\x1f\xa0%d\xb9\xb8\xaf\x14`Tn0\xfb/_\xd1a\\\xf0j\xdb\u0083\xf4\xa1v\\\x19O\x89l\x05\xb3\xb1.I\xd5\x03d\xef\"\x830\n\x06\x19\x1c\xf4\x1bi\u07be\x1c,\xb9\xfc\xcf*\x959\xba\xcc$<M\xa2\xb2\xd6\x00\x1b\x92^3\xb5^\rN\xed%̦ak<\x90\x02\xf8CW8\\\xe2l5\x88\xe2́\xc0bU\xba\x83{\xe4'\xab\xea\xa1*ۘ\xbf\xe8\xf6b\xc8\x15\x93\xe8\x06T\xd2\xe3\xf8\x8aaѮM$g\xcfA\xb4 \xb46\x10=\xf7]ޖ\xebU\x9b\x8c\x8f\xbb\xd4Kpw0\xc8\fS\xad\n,\xcc\xd8\x04\x03@\x02\x94\x80a\x03>\xe4Q\xa1\xea2\t|(3\x8d=\xb1ø#0\xbe\xa8\x11 m&\xf0m[2\xd7\x00\x84\n\xa6\x17\x83\x81\b\x12\xecd5\x1b̬~\x85\x02\xee\x1cDLɠ\xe7\x94Z9<\x9a\f\x14Y\x9e<Y\xc8R\xa5\xe0s\xe5\a\xeaf\xe1\xe1\x04\x04ܵ\xaa\xfb\xed\x15(\xb9En*\x9c\uf05f\x03\x92T\xc2 :\xd1\xd9S\x9b\x87\x90l5\t\x85Ok\x80\xd0\xf0\xacj +j\xd4\x13\xcf,\xa5\xcf\x05c\x9b\xa6\xeaw\x80Y\xe8\x18>\xb0\x1b\xd5@>\x12\x90\x15\x9b\xc4\xdc5T\x11\xf8\xa0\xbd\tu\xbc\x10\xb2\x8f,y\x10\x9eҴ\v@\xd2\xf7}UW.\x8b\x9c\x1f\xaf\x1f\x84yǛ\x9b7\xac\xe8\xabR/%/\xbf\x0f.\xcf\u007fZT\x98\xe3;\x13\x13\ts]\xf4.I\xa9\xe6\x19\xc8p\x8fg\x12\xb6\xe7a\x1a\x86hJ\x1e\xa7\t\x98\x12\x0f\t7\xb7\xc1$\xddQ\xbbBR\xab$-/\vx\x1b\xf5Z4\x1c\x1d^\x8a%9\x12#\xef\xf6ch\xefc\xb5sqG\x8b;\x18\f\x8e\xe4ȯ\xa2\x82\xa9dMG<\xf2\x13K=8\xe8\xdbRLx\x82\x9cU\xdd$-\x89\v\xe8s\xbd\x9e\b\x16\xc2\xe1hX(\xb2\x96\xb1\xabH\xc6l\xec\x9c2\xde\x16\x11\xabRB\xe8\xb05\x00p\xe0E\xed\x0e=\xe1\x8cIjI5\xcfF\xe22\xb3v\xf0\"\xbbD\xbco\xd4~\xeaM\xda\x10\x06\xa6\xfc\x95]f\x9e\x12\xd3\xdd1>\xa8\x82\x0e\xd7\\\xf5\x88\x90\x91\x12\xa4\xa4Xh\xaf\vd#\xa6]\x87:\bM\xb5A\x92{Cv~z\x15\xec\x96vU\xa1B\x82\xaf6А)\x92F\x82\xe4\x16(\xac\x1d\xedW\x04\x88\xe8\x9d=zu\xd6\xdf\xee\x11\xe8\x93L\xc2\x12\u007f1\xb3\xb6\xa2\xdbB\xe9\xe2E䧁GmZ\xc8'\x8f\x86Hd\xed\xa2\x89\v\x98\x1c\xc0\x03\u007f\x81<^\x01\xd6;\xf8\xe7\x86\xd6B3h\xa3\xb8\x11\v\xa9\x96>\x9f\x8b\xe4]\xb0Y\x0ej\xa6\x98E\xdd(\x195`K\x9b\xdajj\xf0\xe8\xff\xe6r\x98=\xdb\x1a\xaa\x8d1\x89G9ix0\x026\x88\xd6\xc1\x94\x89\xe6~\x92TK*\xcf@p\xec\r\xb0\xf2\x94\xd7\x02֍\u007f\xb9\x0e\"&\x84\x14\x8e\xe3\xa2K\x87\xc2\x17\x8b\vI\xa3$\xba\xac\x8cj<\xc8\\\xf51,!\xc1\x96\x882ćj\xb9\xf3\xb3K\x81\x88\xc0\x9d\x90\xa3SV\xbf\x92qS\xe5.tN\x93\xd7k\t\xbb\xd3?H\x889\xf8m\xb5OE>\x95G^կ\xa6\x15\xd1\xf6\xe8\x8c˲\x1e\xad?\x17\x99\x1e\xa9\x03\xfc)\x17 i\xedi\xab\xb3\x04L4^-\xd6\xc7|\xabf\xae\x18\xcbNh\x84) F\x10\xecd\x83\xfa}\x12jr\x93\x91'nf\x8b\xb4\b( \x95V`7fKa\xca\n\xb2\x16=\v\xf4f&f\x1c\xec\xa6K\x03\xdf\xea)$\xe9C\xd1>V{;\xb4P9\x1eo\x94H\xec*\xf3\xbb6W\x17`<\xae\xc9\x1e,P9@\xb6\bnw$ԷZN$9\x19[\xa9\xa6\xca\xf0P5\x1d\xad˂\xa2RE\x96*J\x98\f*k\xa8zJ\xd0~Ԑ9\xd5&\xa5\xe3\xf9\x88\xb4\xe9W\x9c\x9f*\u007f\xd6E#@\x88\xa6\x8f\xa8\xa8\xeaoS\x9f\xd3ֶ#\xff\x84\x02\x94\xc3]\xa1\xe6#f\xa2-L`qC\n8\x88\xe9\xa4\xc1\xd0_r\xba\xaa\xdc\x10\x00bJ\x98;\x11\n\u0086\xb1\xae\xd8\xd9`\x90y\fq=\xdb\x17\xf8\x11#\xeex\xf4E.2\x05ڙ\xad<\x93:$\xb8.\xc0\xb7GC\xa0\xa9\xe4X:1\x84\xf4\n\x03\f\x00\x1d\xd1P\x01eL.]\x92\x86\xfcr\xd8N\x80̓\xc6x?5{\x82tۇ\xc4_^4ː`F\x8e\x12\x02\xa3\x1e\x19\xd5\x01\xbd\xb1k\x04\xb4\xc6\xc0\x1b\xd4\xe2\xe0\x062\xa4\xf1\xfcS<\x04\x9fa\x16\x10\xa0\xea\xcd8\xe3\xab9\x0f\xe6\xaf\x17\x9fC\xc8\x0fu\x83\xa8Џ\xe7f\xc4䢶\x11ڱ\xec\b\xc3,H{\x05\xe2:K`\xce\x10\xbfvQb\x1d-\x88\xfbSc\xeb\x86y\xb6\xba\x03%\x0f\x01uU{TN-\ts\x8f\xdcc\xb2\xb5\x19z\x90\xa3Ds\x8f2_\xe1Ӧ\x13TuB)\xc3\xe9,L\xfd\xa4-\x13\vS\xab'\xe6\xa0\xec\xc8\xcf\xd4\xc9<\xe0\xd7T\xab\x1dڎ\xac\x9d\x19\xf7\x1b\xdbCȅ\x0f\xb4\xabe\a\x89\xba\x19}d\xda${\xa0\xfa\xa8\xd2XD\v\x8e\xb7G\x8c\x15\t\xa4\x1bV\x14\xe5[\xd5\xc6\x00@!.\xa0W\x1c\xd8yE\x8c@b\x01p>hWo\xbeN?4\xfbAA\xf2f\xa2\x13\xbad\x10\x99ɬ\xdd\xd5;\x90G\xbe\x82=[\xfd\xeeHN\xa0\xa8\xbe\xb6\x84g\xb9F\xd7.MQ\x9d]\xdd\xd4K{\xb8\\'\n+\xc1\xaf\x02\xe1 ٳ\x93\xe0T\xbd\x92EX\x88\x82\x82\xf2\x81\x1e\xd4<w\xc6۪:Z\xe8!Y\xa1\xca\x16Q\xd1\xc8\x17V\r\xf1\x02\xfc\x15\u0590U\x8b\xe6\x0eҢp\xa7\x80.\x86\x0eИ\\D\xef\xcfj\xea\xc4\x1c\xe0\x11ǅ\x0e\x10ԪnB\xf3\x88\xc4\xd5r\x17V\x83e<.\xf3\x829~}G[\xc2\xfbo\x91\xeb\a\x825\xc0\xc7#\x1bq~\x16\xd5\x18q\x0e\x93HF\f\xa7\xf2\xd3E|\xa3\x83\r`\xaf\b\xe34ToK\b\x1f\x88\xa1.\xf5\xcbu\xb8\xf3\xe0\xa9\xf0:\x88\xfb\xa4q\x0fa\x88\xb6\x1e\xf0\x14\xe1\x16\x87\x82\a`\xf0\x1a\xa0\xcf$\x96\x80,\b5hO\x1b\xccC.\xf2\xc0I\x05\xa9\b\x12\xaf#\xd9\xc7J\x007M\x85V\xf4T\xa8\xfc\xba\xd8u0P݇`S(f\x19\x05\x96u\xe1\x1eY&\x90M\xdc\xd4\xd4B\rh\x03$\xcc-\xf2\x1b\xbb\x06\xe1Ud\"\xe1D\xfaL\x95\xeaMv\x15\xc7\f\xbb\x107D\x9e\xf66\xc85\x8c\x92WQV\xe4Ȥ\x03\xc8$*ߔ\xaa\x9d+Ǥ\xea\x11B\x049\xdaw\xbb\xccQn\xe8\xf8\xa05\xca\t?\xea+\vR:ɬ\xfc\x93\aiP\x8c\x8an\xe7q'Ⱥa\\\xedu\tu\xbc:\x9a\xc8{\xa7J\x8fl\xffR\x1d}\xe2[p\xad\xaf3ė\xa4\x04\xe7g\x05\xaa\x12#t\xd9L\x86ʣ\x12O¥\x05M\xd9Ю\x9d\xf0\b2\xf7\x03\xdb\neC\x88=i\xf75]U@\x99\xfe\xa1\x8el\x95\x05U\xa6Dw\xc6\xc2\xfa˪\xa9\x1d\xf2\x80)\xe0\x94\xb6\xbe\x82\x83\x0eY\xa6*\xe6\x06\xee\xf1T\x05&\xb6\xae\x0eS\xc8-\xa8(\x8e?_H\xd3P\xb7U\xb3W\xab@G\xab\x9f\xa0\ax\x8c\xe7le8\b\b=\x02\xc6\xe1\x0e\x88\xca`\x12F*hn\xf5Y\x91\x80[\xbd4M\xfd\x86,\x87\nIj\x16\x9b\xd2XW\xdd/\x98?\x94\x16\xc6O\xe5,\x99\x01M\x02&\t-\xe3-\xf7X\xb0\x12\xccq\xcb\xd2^\xc1W\xed_ޤ\x02 \xee_\xbe\x98\xd5E*\x98\x87\xdeT\xaa\xaa.<:\xda]m\xe2<\xa66\xfd\xd7\xeb\xc1\xbf\x9f\x9e\x01U\x00Y\x8b\xd4\xd4C6\n\xe1h\xc8\r\xc0'\x84\x18\x91\xc5 \xca\xe8\x10\xacS\x9dD\xfd\xb2^\xa0uй\xda\x03\xb8ڜ\x85\xb7[M\xc9o\x82\x1eE\x93\xb6\x1a\xbf\xf1(,\xfaR\xbb7\xfa\x1a\xcfQĝ\x90\x8c\v\xda\b'\xb8\x01NB.`c 5\xb42\x14\x1a\"&?\xc8\xd0\xe0\xf2y\x81DWR\xcft\xce\x00\xaa\xb6\xb21\xd4p\xc6T\xf3\x90\xceԘj\x03\xb8\xa4\v\x9c\xa8\x05\x84\xf9\x10\x1c\xec\xdc\xe73\x97F,\xaf\xdc\ab5\x92@\x18\x99\xa3\x86\x95\xac\xfa\x11\xa1\xa6zmc\x8e\xee\xd2.rXH\x93\xad\xb6:\x90\x8d\xa0\x01ex Y\x12\xcfT!|\xc1<H\x9c\xdc#\xa2Y%\xf2k\xb7\xa5\xfe,\xd4<\xf2h\xe0i\x9bڽ\xb2\x05b2\xcb\x1ba\x80\xbd\xea\xa1E*\x06\xd7o\x10'\xf9)\x01=\x98\xc3\f\x89\x0eQ.\xab\xcd\xfa}z\x89Gu\u0084\xbcB\xd4i\nDHW\x9d\xee\xe47v\xb5ũ\xc9\x15n\x19+ڂ\xbcm\fɻtU\xc9\xd0I\x84D\x8c]\xb4\xfcq\x87A\xc4\xe7\xea\xb4\xcf^y\x83\f\xc2\x06\x98\xae\xe6\x1è\xab\xc6t\x17\xe2\xa2\xf10\xccZP \t\xa6\xf0\xe4*<\xaa\x92Q\x9c왶\x0e\xfaR\x035D\xaf}D#\xb7\x9b\x9a.\xa4\xb7\x99\x8c\xad\x89J\x04\xa6\xde\xccڡd}'?\x8b\x16\xb1B\xab\xe3:,\x80Nc\xa8\xfe\n\xb2\xe0\xab@\x83\xc4\xfb\x00\xa0\b\x99c.Q0\xb1\xa6W\x0ec\xa9Q)\x1f\f'\xbfH\xc9\xef\xb0\xee~\xc5w\xf3\xea8\x06\x13\xb8\x1d\xe8\f \"KR~\xc5\xc9BZ\xc3'\x17r\x82\xc9\xf1VD\x9a\a\xbf\aP\x88\xa7\xbdڍM )I\vɪ;誟\x998\xd1.R\x03J\x89Ý\x91\xbb*\xb3\xa9rKDJ05m\x1f \xecT\xf0\x04\xb3\t\xc0\xa2B\xd3Tg\\Y\t\x9aQV\xa5\x11\ao\x03\x11\xd2\x11߀fE\xb2Y}\xba\x10M\xa9\x98\xfa\x01\xb5\x92B(\xb2\xec\x90\x04\xe8\rޫ\xc6*\x92\x0fu\x80\x1eFo\xab\xe9\x9ayc\x99K\xb9\xea\x04\x1c\xe1\b\u007fԉ\x85n\xe5\xe1X\xdeQ#\xaa\v\x92\xef\xda\x16K*\xf9h7\x96k\f\x15/\x99\x95\xae\x93k\x10\x86<-\x82\x1c\xc6y;\xbf8\a\xa0\x9dXSu\x94I\xe3\xb9\xc1j\x1ef\xba\xad\x02\x92ji\xb2\xf6\xa8\x8b\xb9M\x9b\xd0\xdbF\xe7\x19>\xf2f\xab\xde\xf3\x14\x89j%1\x86\xd7G\xa4\xa0\x05\xc0\x14{\xa8b\x87pY\xc8G\x04\rd\x14&\x18g:K\xc12\x01^\xa6\xb3\x13\xda6\xca*\xacK\xd8u\xb5\xf0\xd9\xfe\xb4\xfe\xf4\xd4\a\xe6\x06\xb4+:0\xd0T\x9b\r\x19\x15}P\x1e\xc2M\xf8H\x9c@\xca]A\xfa\x00*6\x9aG\x15\xb0\xf5\xca\f*\x91\x8d\xf6\x9a7\xd5Mɢ\r'\xe7\x16\xf0\xd1:k Z\xc3<\x17\xed\xfa\x8b\xa6\x10DH\xfd\x902\xfe\x87$i\xda\xfb\x83毚ˆp\x1e\x1b\x12\xd0y\xea\x84r\xbdj\xfb\xc5^۵\x8e\xe4\x942$\x06Fd9Ty.\x00\"\xfa\x1e\xf0\xc5\x1b\x10\x11\v\x1bv \x1b|\xaa\xf6A\xdaa\"T\x1eq\xef\xbc\x1c\x8f\xc9\x10\xdb;\x1ctx:\\RR\xabj\a`\x92m2\vm\f\xd8\"7\xe3T\xf10js\x03$\xd5a\x16\x91\xa9\x0f\xae \v\v\x8a\x17\xe9\xad\xd2\t\xfeC\xe7\x1a\xb5+>\xbb\x9a;0^\xa8=c->\xf2\x9dw\xe5\xc8k\x93\xb4=S\xb6\xb5\x9d\x10\xbc\xe6\b\xd9ν&\x8c\x03H\xd4!\xde\xd6&\xf9\x00\xae\xa7\x8e1\xa9d5\x98\xeaă\x8a\x02\x00ut\x02\x0f\x95\xb5A}@;\xf8#:$\u007f'\xc4\x02\\\x88\x97@\xee<O\x8e\b\xd3\xf2\v\xaa\xd4\xf3\vuG\xc0\xa5I\xed-2\a\xd0\xcaPeKڄZ\x05\x85\x9e]\x03\x960ˀ\x8e\x9a/;nL\xc1\xa9\xfe\xcd3\x89\x94\xd5Yu\xed\xe9\x1ex\x17l\xccj\xdc\xf2\nb\x91\x9d:]\nc\x80\x9bt\xca'\xab\x84!m\xa3z\x9az\xd6yQْ\x82\xcb\x16b?\x91\xee(?\xb4\xd5\xee*w5\x04\xa4^ځ$5X\x16\xf2+;H\x9a@֮Ь6\xb4h($\x15\x82\x16lȰ\xd5>Y\xd4A%'\xc2z\xa3\xe4\xa6:\xc4U\xfc\x9dC\x8dJj\fCh\xa1\f\x130\xccs/\xf5\x92Ba\xe4\x9c\xf62\xb4\xeb\xaa#_M\x9d$U[\x98\x00F\x99\xda\t\x06\x93\x8eJ\xdfW\x90u\xf3;$\x8c\x1aQI+\xc8\x1axB\x19L\xe0\xd5H\xda1#\xf2l\xbd6\x17\xaf\xd2m\x06,\"\xa6\xb6\x02\t\xda<A\xeb\xa8~4\x91P\x81Pw\xaaY\xaaj\xc4\xeb\x11\xa4\xf1[Iu|G_L,A\xa0Χ\xab\xca?\xb7\x95z\xbe\xea\x0e\x96\xfeHj\aC\xe28Xx\xbd#\t\x16\xc1Sm\x85\xaa鰠\xef0\x14j5]\x19e\xa4\xbdKt\x88\"\x8c\xffbP\xe7\xf8|\xa7|\x96\xfauŴw|\x9a\x13\xd4H\x8a\x11\x03\x8f@K\xdc4\x86\xd4j\x19\x1d\x8b@\x06o\xd9Q\f\xeb\xe74\x1bˋ-\x81\xf8\xd4\xd5H\x18\x81]\xa8\x11 \xa7\xa0}\x18+ \n\xb2\x83\x87K\x9d083\x8dU\x04\ta\x00\x97\\NͅR\x89\xa6\xa6~\xec\xe4H^\x13\x95\xb1\xf8\\H\xfd\xb5\xb0\x81B\x96\xf9\xfc\xb4P\xc2\xcb%\xc8\xe5\xb0\xe6U\xb5\xd1\xe7\xf3_\xcd/kCM\xe1s\x88\xdf\x15;hK*\x1f\x87\xfe\xc5\xf7!U0\x95>\xcb\x1c\xeb\xf4\xd5\xd5>\xe6\xed\xf0\xac\xb6\xe2u\xc0\xc60 vT\xfb\x898\x06\x16~\x95^\vcHں\x89Ӂ\xa3\x10\x87\x8a\x12I\xed\xb0M\xa2\x9b\x81\xab\xfd\x1b\x15#[\xc5[!\x18\x00*\x0fu\xab\x1f5\xcb\xf8\x18\xf0\xe9C\xdb\u007f\xdaw\\\xda9\x06 Գ\\A\x8aY\xd4\x04\xd5y\xedҖ \x03=\xb1h\xef\a\x81\xb7\xd4G\xd3\xc1ݱ\xfb\xd0߇v(P\xef\xd8ᙁ\x11\x95\x1f[\x93\x9d@$\x8d\x9cծŕ\b\x91^Ue\x85\x843\xe1\xa5\xee\u007f\f\x17\xe2\xd7|\u007f\x87\xe8\x86\xe8Im\xd8\xc4\x19\xf8\xe3\xec\xed\x1e\xa8;qC\x88j\xc0\x86\x8f\xb8\xdc\xd1\x16\x12\x14Q\xa2\x81\xda\x15\x11>t\xe2X\x1a\xf9L\x02~\xa8|\xb2B\xf7\xaf#j-\x87\xf9P\x15v\xaa\x1f\b\xb2!\tU\xbf\xf6\x05\x8b\x15u$\x94\x1f\xbe^ȒS\x87\xbe\x91z#l\x1d\x15\xbb)\xeb,\xab\x16\x1c\x10\xabΓ\xcd\xfdȣ\xa9W\x94)\x81\xa0\x8b譜\xc9\xc4\xe5\x85\xd4Oaw=\x05rav\xd4\xceL:\a\x9bqt\xa7n\ttt+L[\xbaW\xabQa\xec\\\xac\xa8%\x1b(E\xd77ՙu`\xe4\x9a\xfa\t\v\xf8\x84\x00a\xae\x1btW\xa0̍d\xe1\x1f\x06\xe7\x0f\x97\xc1S\x00\xa0%\x9f\xb3\x01\xf3GGpu\xe2V\xaa\x1dG\x92\xf4Q\x01\x13I\xae\x1d\xdf9\x9e+ƒ2\x93O\xe2ϣ^\xcfZ\x96\xe3-\xdcg\u007f*\x1f\x97w\xa3&ԇK\x1a\x1ctH\xd7v/\xfcY1\xe8\x86\x1dR\x9d\x1d4A-\x9c\x0e\x13\xbdҠ\xe1\xa1А\xb7\xe80\xe0\n(\x9d\xdap`Ls\xd4^\xbe6'\xb9\x83/<\xa4\xceo\x90\x1f\b\xe1NDݭ\x96\xc9\x18;\xa1i\x8c\xa7\x8b\xca\xdd\xd0\xf9\x82\x8c\xd7$4\x10\x8cFd\xabC\x9c\x9fKl\xebLIT\v\xaazz\x81Q\xc0\x1f^PS\x9e\x1aߖ\x1a\xd9=\x1c\xc9{\x1d\xa6\xdeX\x01\x9dQ\xd3Z@\xcf\xda\vPg\x11q\x88\x89S\xd4x|<\xa3\u007f5\b8\x00\xccU\v\v\x91\x87\xed&\x9d\xc9\xc9\x03f\xa7\xb8\xf1\x85K\xbb\x11\xda4W>\x1d\x15\xaeM=)\xaa\xc96u\xbc \xddPH`\x04a\a\xad`s\x13A\xbc\x99\x01\x96UMdX\b1^\nڄ\xc3\x1cj\xa13\x1e\xa2{\x9d6\x87\x0eA\u007f\x0fU\xe1\x17\xee\xebm\x90H\xf1:;\xc7\x03\xe9\x9c\x1c\xe3\xe8\x05\xc9\x04\xaf\xb5\xa53hyF\xed\xd8 \r.\xa0\x920Y$\x80\xac\xf1A\x84\xe8,M \xfe0\x02\xdaa\xfa\x1d\x86\xbb_A\xfc;\x86\v\xc6\xf3;\x11Uۜ|\x8d\xa0&*\x06a\x06\x10K\xa1\xa8\x99✃\xf5\xef!\xe1\xd7t\xfe\x1fJ\x1b\x92\x89m\\C\xb6\x90ߦm_\f{|3\x8fI\xc6ԩ0\x9aE9$\x1c\x90\xf5\xa7\x91\xb9\xbf\xa3\x97\x9f\xd9E\xac\x04O\xc2}\x97\x84%@u\x04\xb9\xeb\xd0+RSF\xf6\xe2\x8e\xc87އ\x91R\xa2\x0f\xb9\x0e4\x95DE-\x8fn\xac\xe9m\x15A\xd2TyV{\x1f\u007f7\x10\x89G\x8b\xa7 \xde\xf4\xe1\x12\xe6t\x88Le\tu:\x02@\xea%\u007fUG,\xcfޱ\a\xe5\xf3\x9149\xea\a\xe2!\x924\x16*1[\xc1\x03\x9e\xcf\xcb;\x01I&\x8f\xa4\xd2\b:\xb2g\x9c\x99\nw\b1\f,A\x15\xc3\x06\x1dq\xe9\f\x17A\x88\xdcW\xe3\xc0\xd4\xfe֨\x01\xa1\xf3\x1a\xae U\xfc\x9a\x04b\xaf:\xe0\xa63\x93\xdagNq\xaa\x91\f\xfb\x93Ti\xedҕ^\x85E5\xa8C\xb2\xb3\xebL\x1a,\x8b\xaf\x00\x13e\x97\xbd\x83\xf5\xb5I\xa6c\x94Y\xc6/\xe9\xd0Tf}\xb5%\x8c\xc6\x19z\vӇ\x12\xb8j\xbe\xb8@6\xb4\xa4\x96\xf8\xa1-b\x1d\x9e[Ĝӹ^\xac\"\xac\bt\x12\xc0\x1d\xa3\x04\x84@F\x91'\x18\xa3\xab2\x1e\xe4\xb8*F0\x0f\xb8\xfd\xa1\xf2R\rI+\x18UQM\xcd\x11n\xaa\x00\x9b\xb8\x19\r\x1d\x10\xeb\x98:\x92\x8fi#˲/S\x9b=\xea\\#\xfc\xb7\xce尲\xa6s\x1c\x95Ԝ*{k\xc7\xcc!\xea\x16\xcaZ\xafx\xe2P\xbbH8\xa4\xac3\x9dp\xa5\xef\xfc\f\xa4\x00bn\x92\xed\xd5\xf9\x00\x95\xb5N/\x92\x98\xbc\f\x16\x86\xd3P\xb5(\xf1\x1b\x97:\xcb\x0f3,\x1e\xb7\xaa\x9a\x91\x10#\x91\xdb\xe5\xc6w\x80\xf9\xea\xa4\x16x\x0e/\x88\xb5\xd4d\xa90Y::\xb9\xf0\xb4\x0f\xba\xf7;\xc4\xf9v\xb6\xc0\x17\x80[Ǡ\xd4&\x8a\xa3\x1d\"\x9e\x03\xf4\xe3\xe2\xdb\xdb*(\xc8[K\xaf_8\x00ۣ1N\xf79\xd5\xdf\xf4\xbc \x12\xe3z\x8d\xb9y\x93\x17\t'\x88A\x8f\x81\xc4\xefD1\x96D\xe0=\xf0|b\x11\x15c\x11\xb4\xa8\xa1\xe9\xeb\xd6.D\xb3T\xdf'M\xe8D\x906Q\xf1\xa4\x02Q\xbc\xb4\x8a\f\xab\x80\xc5H\xf8\x1a\x96O:O0\xaa_*\x16\xb1L\xaaK\xe0m\xc1\t\x17\x05\x14W-bȗ\xa0\xae\xf7\x96\x98\xc3\xfd\xac\xba:8\xb4\xf9\x0eܦ\x04#\xc8\xcf7\x95\xcd\xce#\xff\xaa\xe3\xf1SRǮ#\x85n?\x90 \x18\x8d'\xe2\x86\xea\xd3\x00C\x8f\xda\xe3nE\x86\xe9\x93\x02\x06\x11\x98.\xb3\x01 Z\rc\xe1\xf2\xd4@B\xe8f\x12\xa2 k`\xe1\x83\xe6\xd5aq\x9dj\x1f\xf2\x10\x84\x86\xf6\xca\x1e\xf8$\xf5y\xd9Zo\xb3W\x9fJ\x81\x91\xbe*x\x029ȭ\xcdb\xe7^\xb6+\x88I5\xb0w\x9d<\xe4\xe9Qs:\x17r.\xec\xae\x1d\x13\xd8\x1b\x17q=Q\xd3tVA'\xa1Q\x92đd3\x80\bҫ\x85\x06s\x1c\xab':\x86יPӧ\x9b\x98:\xbc\x99\x94\xa5\x8f\x9f\xe1\xbd\x1bf\xb8\xaf\x99%\xaa\xd3\b\xed\x8c\xc4\xe5\x9b\x1d\xde\xdc[f\xc0\xe4i;\xca/\xf6\xcfY\x95\xfehRv\x1e\xdeb!S\xf4*\"@v#x5kj\xf3W=\x16]\xe9C4\xe8lUF0\x9e\xea\x88\xfd\x85\xcc\xe6\xc5J\xe5\"\\\x16\x0f\xf9\xc5䛥\xd7q\x92\xf5Y\x1dX\x05\x1d\x1f#\xef\x18KҧR\xa8\xa9@\xe2\x9d\x10\x1dש䃮\xe0\xed:\x8a\x87 \x1f\x92\xffju\xd6\x0e<\x12\x06O\x98\xb5'\x00!\xa8\x1d+\xbd\xa6\x1aX\x19\xd4!\xb4\xbcju<$\xc0\xa6\x9d\x16\xb2\x95711\x98\xf3\xb7\x84>\xaf\x1cޖ3\x98G**\xd7\xd4\x14\x13d\x0f\xd4~0\xf5y\x02$=\xeb\u07fbf\xc4\x11C\x835\xbd:ދ\x1e\x05\x95tX\xfe\xe8<\f)!\xab\x9e\xb7Te\xb6ܫ\xfa\x13\x04\xa8\xd3d̪\x1a\xc1tz\xb1B\xba\xae\xc4s\x0f\"\x91)oh&5_-}\x84\x85\x86\a\xbf\x14\x9db\xcc\xc8=m\x00\xe5v5\x132\xed\xeaY\xebk\xa8ƩO\xf8\xb0\xa2\xad\xc3wf\x8a\x95\xd6~\xf8z\xadY\n\x1f\xa9\xc1\xb4Ց\x8f\xb2V;\x9fv\xf8\aA\xaa\x0fW\xf1\xaa\xd9M\x9c%yB\x00\xa9\xfbp\x9c\xae\xf4mU\x9f\x89\xa3\xf3\x00\xb8\x91R\x03\x99\xb0\x1a\x92\x82\xf8\x99Y\xc7]Iz\x86Bƒ\xbe\xea\xef\xd8:\x0eVu\x04\xc5+g\xbd\xfb\x90\xa6\x1av\xb5;\x9e\x90\x8e+\ue17c\x1a\xda\xe4\"\x1b\x810.\xa8\xe3\xe6K\x9f\x84\xc0ˏ|ae\x16\x15\x11:pC&\x10\x902\xa2:\x01\x1a\xdf\xc6\xe1\xd6\t\xaf\xc4\xfb \x86\xab\xb6\xe8\\\xb4!\x83\xe4*\x18\x85\xb7\xa7V=`\xdbq\xecI|\xbb\x91\x96\xaa\x00;\x1e$G\xd4\x13Do<\xa5>\x9e&f\xaf\x1d\x9a\xa6\x8f\xd28\xc2a5@\x03\xe2j\x88\x80Ӑ\xdb\xe8Tu#\xeb\xb0\x05\xafD=\x92k]\xbe\xfe\xac\xa4B\x8e\x84ٰwPr\xd7\x16\xf4\x91\x1f~\x17\x1c\xc8V\x87\xf0\xd4\a\xcf\f\xf5Z\xcb\xce\xe9\f\x10B\xb1\xd7\xf3ZL\x19\x11r_\x15\x8b\xc4C+\xb3\xe4,\x88\xbc\xfe>\x84䨄\xa6\xaa\xf6V#\xcd+\xefis\x14<(\nE\xa4a\xd1\xc7{hoB\xb5ڡ\"\xffT;l \x96d\x16\xa6Bm\x01T\xadzr\xae\x92\x16\x88\xa7\x98\xd41Ժ\xdaQ\b\xad\x11\xaa\xa4j\x8eI\xbbIn\a5Ҩ\x84\x17\xa2\xb6\xb8\x81\xfdwct2^\xbfhs\x8f\xe0\xd1YVu\x1bC(\xb2(:.\xf2\xe22GCqjtN\xb50x\x01WegA\xf1\xafnM\xa8yd\x19뙢\xce]\x9b>\xa3\xc0\xbf\x93\xec`\x01\\\x8f\xca\x03*\xa2ZN\x86\x1a\x8f\x82\x84\x16\xe6\xfcu\xbdA\x1f^\xfb\xae\xfaL\x8a\x80\xbdP\t\x99\xc4\x10\xe7\x83\xdb\xe4\xb1\aw\x06\xac\x8d}\x03\xd1\xd7.\xfa\x14\x055eK\x01\xbb\xa9\xa6\a\b\xa0\x8c\xd5 6\xfccW\xf0z\xa4l!\x9aq\\6\xd41$\xa6Fkd\x1eS\x1b\xbdG\xbb[\xdab\x04\xb5t\xb8\x19^SR\xf2\xfc\xeb\xb5\xc7\xeb\xf32\xae\x8a\x90ʻ\x00,\xaa<4\x8a>%B\x9b\xdc\x04O\xd4\u007f$\xf4\xeb\x87\x0f\x02B\x1d\xf7\x90;\xc2\xc2\fBB\x1e\x86L\xf1I\x19\xbb\xdf\xc7K\x90\xe2\xb2N\x86\xc6&h!\x8d\xf1>\x84'Jކì\x15\x9d\x1b\xb0ח\xdb\x1cӚ\x19\xed\xd4\xc6\xf5\x06J\x93\x84pVO\xe0I\x18{\x9d\xc7\xeb\xc7\xe0q\xf5\xdajf\x92\xd6h\xc1\xe2j~\xc1E\x80\xa5\x1a\xaa\xd3\tzS\xdd87\x1d\x1b\x92\x8c\xa8r\x91G-R]ǥx\u00ad\xc3Ԧ\xe60\xdczzݨ\xf8\xe8\xacOD\x8a<\xfa\x95\xa7\x1dE\x9f\xff#\x86\"Y.\x12C\rYSGP\x12Y\x14T\xc0\xc0d\xa9]\xa0i߭\xcf\xeeI\x82\xa9}]\xe1\xe9\xea\xfaȪ\xa0C\x158,\xf9zՎ\x06\xb0\xf6\x16w+[+B@mXS\xdb.IG\x92\xc9\x1bU8\xb1\u007f\x01\xe3X\xf4\x91<*\xabÄ;;ن\x84\xab\xb5\xe2\xf5\xd1P\xd0\x1d\x82\xad\xa9ո\xeaГd\xb0\x1a\x8e\x184P9\xd4=\x83\xf3i\xda\v\x93R\x02\xe9\xb0c*\x9c\xb9\xc2u\xb43\x8b.>G\x1d+\x01#\x8a\f\x8d\x10\vj\x0e\xaa\ar\x86>\xb5\x8a8\x9b\xfa@$\xb2\xb1茶\x0e]O\xe98\xed\x1fU\xc7\x02\xe4\xd7\xc3I8U\x90\x90\xb1\x13\x0f\b\xbd\xbb\x87\xfb?u=\x8e\x1d\x98\x02P!\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x04%5\fTW\x00\x00 \x00IDATx\xda\xec\xbdy\xb4eW]\xef\xfb\x99\xcd\xea\xf6ާ\xad:\xa7\xeaT\xaa*}\x02\x81\x10\x92\x90D\xefx\xf7\xa2Fȃ\xe0\xc3\x06\xb8\x8f!*\x17A:\xb9\t\xa1\xb9F\xf0I\xab\x04\xc4\x0e\xae\\Q\x1fb\xae\x82Oy ^A1\x01\x91F\x9aЇ@\xa8\"\xa9\xbe;\xfdn\xd7Z\xb3{\u007f̵w\x9d\x8aA\xaf\x83ܗ\xc2\xe1\x1ec\x8fs\xaa\xcen֚\xbf\xf9뾿\xef\xef7E\b\x81\u007f{\x9c=\x0f\xf9oK\xf0o\x02\xf9\xb7\xc7?\xf1\xd0\xdf\xed\a|\xe8C\x1f\xe2\xe8ѣ\x0f\xf9\x85\xcd\xcf\xcfs\xcd5װg\xcf\x1e\x84\x10\x93\xff\u007fɋ^ȥnC]\xbcsa>O\x92]\x81\xb0W\b\xb1D\x10\xe9\xc1ý\xcc\xdaP\xce\xcfe\xcb۶\x17\xfbB\xe0p@\xae>\xfe\xd67M\xecr]\xd7\xdc}\xf7\xdd|\xe1\v_\xf8g\xafann\x8e\xa7=\xedi\xff\xbf\nD|\xb7>\xe4\x97\u007f\xf9\x97y\xfd\xeb_\xff\x90]Э\xb7\xde\xcaS\x9f\xfaT\xae\xbc\xf2J\x92$\x01\xe0\u007f\xbc\xfa\xc5Z\nq\x81\x14\xf2\a\x10\xfc\x90\x14\xe2qJ\x8a\xddR\x88TH)\x040\x1c9\x8e\x1c\xe9\x13\x02\xcc̦\xec\xdc\xd1\x0eB\x88\n\xb8;\xc0\xa7\b܉\xe0\xce\xc7\xdf\xfa\xe6a\b\x81\xfd\xfb\xf7\xf3\xfe\xf7\xbf\x9f_\xf8\x85_\xf8\x8e\xd7\xf2\x9c\xe7<\x87\xdf\xff\xfd\xdf\xff\xdeҐ\a>\x1eL\xc0[w\xf8\x83=\xae\xb8\xe2\n\x9e\xff\xfc\xe7\xf3\xa4'=\x89\xbd{\xf7\"\x84\xe0\xaf~\xe9\xc5Z\"~P+\xf9\x8c<\xd1O\xd0R\xeeVJ*%@\n\x81\x10@\x80\xd0|\xe7\xd0y\xc6_-\x01I\x10R\x84\x1c\xc4\xe3|\b\x8f\vpS\b\xe2\xc8\xc7\u007f啿\x03\xbc\xe3\xf1\xbf\xf8\xe6\x8dW\xbc\xe2\x15<\xebY\xcf\xe2\x83\x1f\xfc 7\xdf|3u]\u007f\uf6ec\xef\xf6\xf1\xaew\xbd\x8b\x1bn\xb8\x81\x9d;w\x8e\xb5a\xba\x93韚J\xf5\xcf\x11\xc2\xe5\x01!\x84\x94(%\x11\x01\xa4\xf7\x04k\xf1\xd6\x11\xac\x05\xef\t\x802@\xf0\x80\x00kQ\xe5\x10\x94B$\x1a)%\x1e\xb0\xde\xef\x06~\x05\xc4K>\xfe+\xafx\x1b\xf0\xce\xc7\xff\xe2[V_\xf0\x82\x17\xf0ԧ>\x95\xb7\xbe\xf5\xad\xfc\xfa\xaf\xff\xfa\xbf\x1e\x81|'\xf3\x17B\x98h\xc9\xf8\xe7\xcf\xfe\xec\xcf\xf2җ\xbe\x94G>\xf2\x91|\xf2/?\xc8_\u007f\xe2ν3ھd:\x15?-|X\fĵ\r!ą\xb7 \x9c\xc3\xd55\xd29\x82u\fj\xd84\x82\x81\x15\xd4V\xe0|T\x9b\xd5MK\xafoH\x15\xe4\x99d\xba\x93\xd0\ue920\x14\xcd\x15.\x11ܯ \xe4+>\xfe+\xafx[\x80\xdf\xfc\x81_|\xcb\xfa\x9b\xdf\xfcfn\xbc\xf1Fn\xbe\xf9f\xbe\xf6\xb5\xaf\xfd\xeb\xd7\x10!\x04J)^\xf3\x9a\xd7p\xcb-\xb7\x90\xe79\xbf\xf7\xf2\x9b\xa6R\x19^3\xad\u074bZ2\xe4\x12I\bཇ\x10 \x84(\x00\xe7\x90\xd6\"\xacee$X)%6\b\xa4TH)\xd1Z\x10\xac\x8f\x9bBJ\xea\x00\xa5\tl\xd4p\xbcg\x90\xc20Ւl\x9fOi\x17\x9a %!\xf89\x1f\xc4\xff%\x84x\xf1\xc7\xde\xf8\xf2[C\xe0\x0f~\xe8տ\xe6\xef\xbc\xf3N\xde\xf4\xa67\xb1\xb1\xb1\xf1\xbd+\x90\u007f*8\x18k\x85R\x8a\xdbn\xbb\x8d\x97\xbc\xe4%\xfc\xf1k^\x8d\x19\x8d\x9e\x98)\xff\xceYm\xcfͤ@ p. \xf0\xe0\xa3 \x845(cH\xbd\xa7o5GF)A*\x8av\x8aT\xaa\xf9^A\xaa\x04\x92\x80\xf5\x01\x13$\xd6{|\xfc\x98\xc6\xcfxF\x06\xee;\xe6\x98k[\xce٦\x11\x89\xc6\v\x8d\x0f~\x9b@\xbc\x13\xc1O\xde\xf9\x86\x97=\xff\xfaW\xbf\xf5\xde7\xbc\xe1\r\xfc\xd1\x1f\xfdѿ^\rQJq\xcb-\xb7\xf0\x82\x17\xbc\x80w\xbf\xea\xbf\x10\xac}v*\xfd\u007f\x9bQ6K\x85\x80\x00\xceYB\b(@9\x8b\xack\x12\xefH\x95\xe2X\x99\xb2n5I\x96\x90\xa6)J)\x16ۊ\x9d\x1d\xc5\\\xaeȴD\xc8(|\x17\xc0zOi<k#ǑM\xcb\xca\xd0Q\x99@\xa25\xbd\xa1\xe1~\xe3\xb8dI@\x9e`] \x10\x10A<\x1e\xc1?\xdc\xf1\x86\x97=-ݶ\xf3\xa3?\xfbs\xb7\xfc\xeb\x13\xc8X;n\xbc\xf1F^\xfe\xf2\x97\xf3\xd1?\xbe\x1do\xedOd2\xfc\xee\xb4vi*\x05\xc1\a\x82\xf7\x88\x10\x90ޡL\x8d\xb6\x96L\n2\xad94\xd4l\xf8\x94\xa2\xc8H\x12ͮ\x99\x94\v\xe7Sک\x8c\xdb_\x80\x90\x12!\x05B\b$\x90\x10(r\x98\x9f\x82\v\x17\xa0\xb6\x8e\xfbVk\xbev\xa2\xc2ZGY\a\x0e\x9d\n\\\xbc7\xd0\r\x81 \x04!x\xf0\xcc!\xc5_\x8cN\x1d\xfb鏼\xf5\xb5\xef\u007f\xd2+_\xf7\xbd'\x90\u007f.\x97i\xb5Z\xbc\xf6\xb5\xafe\xfb\xf6\xed\x1c\xfb\xc6\xd7\x1f\x93\xa9\xf0\u007fO)\x97&R\x10\xbc\a\xef\x11\xc1\xa3\x9d%\xa9J2 U\x92D)zV\xb2\xe1s\xf2<%M4\x8f\xd9Y\xb0k&E*\x89 \x86\xbeH\x11\xb5#\xee\x00\x84T \xa39\xf3\u0382wd\x89\xe6\x11\x8b\x92Ŷ\xe2c\xfb=\xfd\x12zU`\xff\xa1\x8aG\xedTl*\x89\x11\xe0\x11\x10\xe8\b\xc1\u007f\x17\xc3\xee\xd3?\xfbǿ\xff\xa1\xeb~\xf2\xb9\f\x87C\x9cs\xff\xe4}\xa6iJ\x96eg?t\xf2\xa67\xbd\x89+\xae\xb8\x82\xdf{\xd9M3R\xf0\x9e\x8e\xf6Ӊ\b\xe0<\xc19D\xf0\xa4uM6\x1a\xd1\x12\x82\\+R\xadPRq\xb4\xcaI\xb3\x14\xa5\x15\x8f\xdaQ\xb0{6G\xc9\x18\x02C@&\x1a\x9d\xe5\fl\xc2\xfd\x9b\x19\xf7\xacu\xb8g}\x8ac\xa3\x0e!\x9b%\xe9l#mϢ\xb3\x02\x95\xe4\xcc\x16\t\xff\xfe\xbc\x82N\x9e\"\xa4d\xb3\nԥ`ge\x981\x96$x\x04\xa0\x84hI)\u07bb\xf6\xad\xaf]\r\xf0\xf5\xaf\u007f\x9d\x99\x99\x993\x9e\xd3\xd3\xd3g<?\xf8\xc1\x0f\x9e\xfdX֓\x9f\xfcd\x9e\xfd\xecg\xf3\a\xbf\xf0r\x80פ\x92\xcb\x12b\xf4\x14\x9c\x83\x10H\xaa\x8a\xac.)\x94\x8c\x9a!%\xa9\xd2l\xf8\f\xab\x12\xa4\x10,\xb4SΝϛ\x840 \x00\x9d\xe6T\xb2ŷ73\xeeݜb\xd5v\x18\xb8\x94n%9\xb8\x0e_=\xe61(Hrd\xd6F\x17\x05\xc5\xf4\f;\xb7M\xf3\xfd\xe7\x16h\xadQJ\xb1RB;K؎`\xa7\xb1d\xc3\x11\xd2yd`JJ\xf9\xfb\u007f\xfd\x9a\x9b\x8bk\xae\xb9\x86w\xbf\xfb\xdd\xdf\xdb\u0894\x92W\xbf\xfa\xd5t:\x1d\x9c1W\x03/J\xa5\x8ff\xc6FaȺ&7\x15\xb9R$J\xa1\x85$\xd5\tE\x91\xd3\r)RH@\xf0\x88m)\xc2\a\x84\x0fH!\xd1y\x8eO[ܿ\xaeY\xaf4\b\x89\x10\x12!%H\tR1\xb2\x92\x03\xeb\x02Ҽy\xb6@i\x92\xa2Ů\x85\x19\xd2D\xa1\xb4f\xb9\x84\xbc\xc8i\x159\xd3E\xc16@\xadmBU#\xe1\xb1R\x8a7\xfc\xed\x1b_ɏ\xfd؏q\xc3\r7|\xef\n\xe4\x05/x\x01\xd7]w\x1d\xbf\xfb\xb2\x9bu@\xfc\xb6\"\xa4\xde\a\xbc\xb5с\x1aC\xc7D\xcdH\xa4D\vA\xa25Y\x9aP\xb4Z\f\xachBZ\xc9T\x02\xc1y\x84\x90\xa84\x85\xb4\xc5ȧ\xf4j\x85uљ\xef]\xd0<\xee\u009c+\xce\xcb\xd0J\x82\x10,w=\xc6\vP\n\x84\x84$\a\xa9\xc8\xf2\x9cř\x1c!\x045\x12#4:MH\x13M;ϑ\xa3\x12\xb7\xb2\x81\x1f\x8e \xf0\x9f\x835?\xd0\xe9t\xb8\xed\xb6\xdb\xfe\xa7!\xa1\xb3J 333\xdcz\xeb\xad|\xfe\xce;\xf0B<W\b\xfe\x9d\x96\xd1LUeEU\x1aZ\xa6$\x97\x02-$ZJ\xb4R\xe4YJ\xab\xd5\" q!\xdep\xa1\xc0;\x1f\x1dv\x92B\xde\x06\x9db\xbd\xc0x\xa8\x1d\xb42\xc1\xce\xd9\x04\x95h\xdaS\x05\xdb\xe6R\x82\x90x!\x19Z\x01I\x06Y\x1e\u007f\xa6-\x90\x8a\x99v\x8aP\n!\x04^H\xb4V(%\xe35d)\xcaZ\xcc\xf2\x06\xae7\xd0\x01~\xefo^\xf7\xd2\xd6\xe5\x97_\xce/\xfd\xd2/}\xefi\xc8\xdb\xde\xf66v\xef\xde͗?\xfcW\xbb\xbc\x90oT\x04$\x01\xe9\f\x84@\x11,y\bH!\xd0J\x92hE\x9a$dY\x8e\xd6\tZJTLO\x18\x19\x8f\x94\x12)%b\xbcӅ@)\x81k\x92\xbfA-\bZGM \xe0\xdd$#\x8c7\x19|4eB\x82J ɩ\x9d\x88P\x8a\x94(%ЉF\b\x89T\x92N\xa7E*\x04x\x8f\xdd\xe8\xe3\xaa\xfa\"_\xdb\x17\b!\xb8\xf9\xe6\x9b'(\xf1C\xad%\xffK\x04\xb2\xb4\xb4\xc4\x13\x9f\xf8Dn\xff\xd57\xe0\x85x\x9dG\xcc\a$\xc2{\x84wH\x02S\xc1!\x04\b\x04\xa9N\xd0Z\x93\xa5\x19Z\xeb\xa8\t\x02\n\x1d\x17dd=#\x17`\xeb\xcdKI\xa2 \u00860\xa8\xe1\xfe\x935ݡ\xe3\xe0ɚ\xe5M\x1b\x13R\x01\xad\x94(5\xeb\xc0\xbb\x18\x1f\xab\x04\x13$.\xc4?\xa5it\xf01\x97\x91\xb4[-\xb4\x00-\x04X\x87\xdb\xecck\xf3\xca\x0f\xff\xe2\x8bg\xe6\xe6\xe6x\xe63\x9f\xf9\xbd\xa3!/|\xe1\vٱc\a\x83\xb5\xf5\xbdN\xc8g\x81\xc0\a\x81\xf0\x0e\x11\x02y\xf0\xa8&R\xca\xd3\x04\xa5\x14Y\x92\x92e\x11\x0e\xa1\xc9/v\xb4\xe4$\xc795p\x11\xdf\x12\xcdn\x0f\x81L8\xe6[b\"\xa8\x83\xa7,_\xd87\xe2\xfe\x135\xd6\x05 \xb0\xb4-AI\xd1\xe0b\xfe\f\x81V.\x10\x90\xf8\x10PJ\x82\x8cX\x9b\x14\x02\xad\x15i\x96\x926Q]\xa8\f\xde\xda\x1dι\xff\x13\xe0\x9cs\xce\xf9\xde\x10\x88\x10\x82'=\xe9I\x00X\xc4OxDF\x00\x8dCz\a\x04\xf2\xe0b\xd8*\x05Z)\xa4R\xe8$\x89QR\xb3\xb8.4u\x0f\xa9\x00\xc1\xd1n4u\xde\x18p\x16\x9cAH\xc1y\x8b)\xd3\xf9XsB\f\x16\x88\xc2\xde1\x97\xb0g!\x8d\x02\xf3n\x02V\xe2=\xceZ\xd6z5B\xc4kHU\x14\xda8\xdb\a\xd0J\x91\b\x81\x14\x11i\xc6X\xbc\x0f/\xbe\xfd\xe6\xff$gff\xf8\xb9\x9f\xfb\xb9\x87\xdcl=\xe4\x029\xef\xbc\xf3x\xe4#\x1f\xc9\xeb_\xfcB\x01\xfc\xa7\x06EG\x13\x85!\x03$\x8dv$J\xc7E\a\x94\x8c\x97ү\x03\xfb\xd6,\x9f8R\xf3\xadu\x87\x10q\x81V\a\x96n\x15\b\xce\x13\xea\n\x8c\x01\xa0SH.ߛ\xf2\xe8\xbd)\xda\x0f\xa9\a\x1b\x98\xe1:W]\x94s\xf1\xae\f5N\xe7\xe5X\xf3\"20\x18Ռ\x8c\x03\x04\x1e\xc5'\x0f\x8c\xa8],\x13H\xd5@02\nC\xd1\b\xcb8\xbcu\x8f\x92\x82˕R<\xe3\x19\xcf8\xfb\xb1\xac'>\xf1\x89\xb4\xdbm\xe6;\x9d\xbdއG\xc6:\xb1G\x8ah.t\xf04\xaet\"\x84@`ha\xff\xa6c\xb5\xb2\xf8\aAb\x02\x82o\x9c\x1a\xf1}\xe7%x\xe7\x91u\x19wf\x93\xb7,\xe4\x9a/\xf7O1\xec\x97H)I\xd5.&%D\x04H\xb1\x15\xeb\xe1\xbeS\xbd3>\xff\xc0\x86ec\xe4\xf9\xbe\xa5\x84)%к\t\"\x80D\x82q \xac\x05\x9fʍn\xffF\xe0+\x97]v\xd9\xd9o\xb2.\xbf\xfc\xf2\xf1=_<\x16\xb8\b.B\x1dB \x1a;.\x1a͈I{\xe0\xfeM\xc7j\x15\xd8\n\x8bi\t\xe7O+2\x15_{\xbcg\xb8\u007f\xad\x02\x1f5\x05SC]B]\x12\xac\xa5,Mc\x95\x02gZ\x91\x10MVc\xb6\x86\xb5\xe5\xeeC\x1b\x8d\xc0N\xbfv\xa3\xf2\xfc\xddᚕ\xb2A,\x9b\xf7&cy\xfa\x00Α+\xfd\xef\x9ds\xecܹ\x93\x9f\xff\xf9\x9f?\xbb\x05r\xc9%\x97\x8c\x01Ǹ}\xbcG\xe21!\xfa\x05\x028\x04\x9e@\x80\xf8\xd3{\xda\xd25\x18\x12,\x15pՂ\xe2?\x9c\x93pɬ\xe6Q\xf3z\xbcϹ\xfbĐ\xe5AM\b\x9e\xd0\xf8\x12\x9c\x85z\x84n\xb4\xc0\xfb@]V\xe0\\|6\xa5^\xbcgX\xd6|\xf4\xabG\xa9L\x14Υ\xdb4\u07ff\xb7@\x89&^\bP\xfb\xe8WƵ\x16)D4}! \xac#\xd5\xea\xda\x1b\x1e\xfb\xe8\x1c\xe0G\u007f\xf4G\x1fR?\U000906ec\xed۷\x8f\x05\xf2HѨJ\x00\f\x12\x15\x02\x8e\x80\x03\\\b\xf8\x10\xf0\xce32\x96\xb60\\\xa8\xe3\x8dgR\"JE\xe9S|\x922\x9fi\xce\xed\xc0\x81^\x04\xfe>s\xb0ǵ{:\xec\x9c\xc9\x01K\xb0\x1e!\xa3c\x1e6\xd7Qv\xbb\xb4)\xe2\xd6\xf6\x01k,\xfbO\xf5\xf9܁M*\x1b\x85_hx\xecRAF`fO\u0091\xae\xa5\xa5`1qX\xe3(Ҕ\xd0\x0e\x8c\xaa\n\xe9\x02\"\x04\x84\xf7di2{\xcdy\xbb\x97\x80\xfb\xaf\xb9\xe6\x1a.\xbb\xec2\xee\xb9瞳S \xadVk\xfc\xeb\xb9c\xff\x10\x1am\xf7\xce\xe1\x11\x98\x002\x046\xcb\n\x1d\x02\xce:\x9co\x92?!\xa8\xd3\x04\xad\x14\xc6\x1a\xb4\xaa\xc8Ҕ\xbd\xed\x8c^\x15X\xa9,!(>s\xa8\xc7#\x16,\xe7\xcf\xe7$\x89F\xe83\xcd\xd4jw\x84w\x0ec\x1c'6Fܻ<\xa2W\xb9f\xd7\a\n%x\xfcRN\x18\x8e(\xbd\xa7\x03\\2\x15\x93I\x01T&\xe61\x89\xd6x@\x1a\a.DH\xdf{\xb947\xb3\x13\xb8\xff\xe0\xc1\x83\xac\xac\xac\x9c\xbdN=\xcfs>\xfc\x9e\xf7\x00\x14\x84\xd0\xf8\x8eh\u05cdu\xd4!f\xec6\x00\xb6F\xd5\x06\xe1=y\x96\xa1\xa5\x8aк\x901Ik,jm\f\xd6Z.\x9eJ H\x96\xab\x80\x0f\x92o\x9c\x1c\xb2\u007f\xa5\xa4\x9dH\xa4\x14t\xcb\xd3\xf5\x8a{\x0f\xafけ\x89\xdfe\x9c\xc775\xf7\vf4W\xee\xc8H\xa5\xa7\x1a\x19l\xb3!\xc4\xc4\xffK\xea\xda\xe2}\xc0\xf9\x18\x11\xca-\x01AY\xd5\xf4L=\ap\xc7\x1dwp\xeaԩ\xb3W sss\xdc\xf7\x95/\x03l\v\x13\x87)\xf0H,\t\x95\xf7\xc8\x18\x003\xa54Y&\xc9\xd3t\x12uI)\x11\xe3<\x0e\xf0! \xc7>\xc7\xd4\\\xd0VdRqx\xe0\x1b\x18^\xd3k\x02\x01\xbf%\"\xa8m\xa4\a\xc5(7\x06\x01KY\xe0\xa2i\xc1|\xe6p\xa3\x01}\xe7\xf1\xde\xe3iH\x15\x8d6\x87 \xb0\xceS\x9b\x1a\xe7=\xc6\x18dS\x9a\x1c\x130zu\xf5\xbdS\xc2UR\xe2h\xe8#[v\x96S\x9a\x1a\x8f\xb4\x8e\xb9D\xd2\x0e\x90e\r2\x1b\x9a0X\x88\xb8H>P\x1b\x8bV\x12%C\xc3X\x91\x10<K\xa9c>՜\xaa\x04]k\xf1^MB\xd4&n\x98\x90\xe8Z*\xb0\xb3\x13X\xccc\xc8\x1d\xbc\xa7\xaa\x89\xc0c\x00\x1b\x9a\xd2m\b8SG\f\x8c\x98\xfdWU\x8dq.\xd6۷\xdcGm\x1d\xc3\xda\xfc\x8b\xaa\xa6\x0f\x9b@\xac\xb5\xb8IV<\x8e\x1e\x9b\x10F@*%\xdb$\xb4%$D\x98\"OR2\x9d\xa0d\x84J\xacsT\xd6D\x13g,JJ|\x88лV\x92Tk2i9\xaf\xe5\x91JS{O\xed%\xed,FK\xbe\t細\xc8f\xd7W\xc6R\x03A(BS\xde\r\xe3\x90\x18\x11\xa1\xcf\x10@\xf8-\xc4\f\x1dK7\xc1\x13\xbc\x9b\b\xbct\x16\xe3\xbd\x00X]]\xe5\xa1l\xe9xH\x052\x0e\xfd\x8c\xf3\b\x82\x1d\xdb\xdd \xa24\xa4\xf7\xb4LI[\v\x12\x02\x02\xc9Tޢ\x9df\xcd\x0e\x8c\xa0b&\x13\xb4Ј\\\xa2\x13\x1d\xa31\xef\xb0\xcebmd\xa6DbD \x18\x1b\x85]\x1b\n-H\x92\x04+\x1c\xb5\xb5\x04!0RE\x0eV\x9aą\xf7\x11\xc6WR\xc7bV\x80\xe0\x1d\xc1\a|ݝ8\xfd@@J\x8d\xf0!\xa6\x1fDP\xd2\xfb\xc0\xc0Z\xac\xf3\xe2\xc6\x1bo\xe4c\x1f\xfb\xd8\xd9m\xb2666\xb8\xf9-\xbf\xc6o\xdd\xf2ғ\xa7w_\x03\x9fT#\xb2`b\x8c\xef\xa1\xd5*\x98\xca\vT\x88\v#\x04\xe8,ChE\xe6\x1cA\b\x84\x8e\xf5\x8a\x10b\x84㜉t!\xad\t\x04\xaaш\xba\x1c\x91\xa4\t+\xbd5R\x9dE\x8d\x92\x8a 5RI\x10\n\x9d\xb7\x91J\xa3\xb3\x14\x95\xa4H\xadQi\xac\x9f\xb8\xba\xc6U\x15\xf5p\x86\xe1\xca\n\x83n\x9f@\xc0\xfbhڜ\xa2I,=\x95wt\xeb\x9a@\xb0w\xdeyg\xe3{\xceB\x81\xbc\xeaU\xaf\xe2\xb2\xcb.\x9b0ֽ\xf7\x87\xc6\xf0:B\xa0\xac!\xb1\x15!Xj\x1cEg\x8a\xf9V\x9bL%1\x91\xf6\x1e\xa95\xbaq\xf0R\b\xd0z\x82\xfe\n\x19\x81G\x95f\xc8,\xc1\vAY\x8eȆ}\xea\xaad\xd4\xdd$\xe8@e+,\n\x95\xe4h\xadi\xb5\xa6\xd1Y\x86NRT\x9e\xa1\x8b\x82lj\x8a\xa4\xd5B*I\xb9\xbeN \xa0\xd2\x14\x91$غ\xa66\x86zTR\xe3\xa8\x1a\x93'B@\x10\xe8׆ͪ\n>\x84\x13\x911\xa9\x11B`\xad=\xbb\x04\xf23?\xf33\\|\xf1œ\u007f\x9b\xba\xae\xa5V\b\x1dm\xb3\x1ev\x11\xce\xe2\xbcň\x84\xb9\xa2M&\x93\x06\xbdh@=)'\x88\x85\x10\xb1\x18%ed!\x8a\x10P:A\x17\x19\xa2Y\x88d\xef\x05\fO\x1eC\x9c<\x86\x94\x8a\xc1\xc6Z\xa4\xea\xc8\x14\x95f$iJ1;K\xda鐴\xdb\xe84C\x179R\xab\xe8\xc4\xcb\x12\xbcG)M9\x18\xd0[Yf4\x18P\x1aK\x19\x1c\x86\x18\xa9I\xe2\xf7Y,&x|\b\xce\xfb\xd0\xd7:\x12\xb9\x95R\x94e\xf9\x90d\xeb\x0f\x89@\xb4\xd6\xdcv\xdbm<\xe79\xcf\xe1\xba뮋9\x84w=\xef\x05\xc2{\xb4\xad\x10u\x8d\xaf+L\xf0輠\x93\x158\x1f\xf3\x02IDW\x8d\xb1\xacU\x15G\xbb=\xbe|\xf8(G{=\x1e\xb3\xfb\x1c>\u007f\xffA:YF\x1d<\xff\xfb\xd5Wr\xd5\x05瑷\xdb\xc8\xee:\x95\xf3\x84$%ix\xc3\x00\xa1I0\x85wH-\xc9gfȦ:x\x1f\xa8\xaa\n]Com\x8d\xb2,\xe9\x0f\x06\xf8ڐ\x98\x9a\xb2ף.K\x8c5\x98\xe0N\xb77\b\x81\xd2\x19Y\x80*\x04<Եs})\x04UU\xf1\xe4'?\xf9\xbb\xe6c=\xa4\x02\xf9\xcb'\xfc\x10\x8b\xcb'\x1e\xd3\xf9\x8d\xb7\xbe\xecD\x9a\x1d\xff\xd2\xe6\xfao\xf9\x9dK\xdf\xdf\x10\x9aѣ\x01\xd2TH\xefqB\x90%I\f\x1f\x8da}4\xe2p\xb7\xc7\u05ce\x9d\xe4\xae#G\xb9wy\x05\xe3b5QK\xc5?\xec\xfb6i\x92\x10\x80\xcdሯ\x1f9\xc69\xf3\xf3Tޑ\xa4)\xcb\x1b\x9b\xfc\xc8\xd5W\xf0\x94G\\\x80w\x1e\x1b\x02\xab\xdd\x1e\xbb\xe6\x15\x0e\xcf`\xf9\x04*/\xf8\xfc=ߠ\xacJ~\xfdO\xff\x9cs\x16\xb6\xd3IS\xf6\x1d>\xccZ\xb7\x8bq\x8e\xbd\xf3\xf3\\\xbdw7\xdbZ-\x96\xbb=\x8a<\xe1\xdcm\xf3tҔ\\k\x84T\xd4B\xd1w\x8e\x10\x82\xaa\x8d\x13\xaf\xbf\xea\xaa|og걻\x12\xf5\xd9\v\xdf\xfe\xf6\xf0я~\xf4\xe1\x17H\x9ee\xb4\b\x97\xfb\xe0?RZ\xb3\x03\xe0\x11\xd33ϸ'\xf8\xed\xde{\xb4\xa9Q\xd5\x10\xe1L\xf4\x01Rb\x90|\xfe\xd0!>{\xe0\x10\x9f=r\x94\xd5\xc1\x88A]\xe3C\xc0\x85\xd31\u007f\x8d\xa5v\x16-\x15>\xc4\xc8g\xb0\xbc\u0081\x95Ut\xa3\rE\x9a\xb2\xbc\xbeA\xd9\xebb\xaca\xa3?\xe4\xdb+]\xe6[9\xb9\x96\x04g8\xb9\xbe\xceM\u007f\xf8\x1e.\xbf\xe4\x12:Y\xca]_\xff:B\b\xb6u:X\x17\xb3\xf4\x03++ܷ\xbc<\t\x84ǉ\xa6V\x92\x9f\xba\xe6q\\0?O/XB\x00\xe7Á\x9f(f\xaf\xbdb~\xfb\x9bf\x8bl\xc9K\xb14???:+L\xd6u\x9f\xf8X&\x84x\xcfʨ\xdc1\x9b\xe5\x80 \x17\xe2\xfc\x99\x00]\xef\x90\xc6 \xcc\xe9\xac6\x91\x8a#k\x1b|\xfc[\xfbi\xab\x84Kg\xe7);\x16\x02$*\x9a\x1a\x80\xday6ʒ\xf5\xb2\xe4H\xaf\x8b\xf1фx!Ț<B\b\xc1\xa8\xaa\x98\xc6Q\xd7\x15\xdd\xc1\x80}+k,o\xf48\xbe\x9c\xb1kf\x9a$Mh'){\xe6f\xf8ʽ\xf7N\xec|\b\x81\x95^o\xb2\xf0\xe3,_IIm-Z\tR\xa5\xb1\xce\xf3\xdf?\u007f\x17\x17/,Й\xe9\xb0sa\x91\x994\xbf\xf4\xe2b\xfa\x03\x19\x81n\xbfo\xbf\xb2z\xaa\xf5\x1fa\xd4\xe9t\x1e~\x81\xa4R\xfe\xbb\x93\xa3ѣ>wr\x99\xa5V\x8b\x1f\xdc}\x0e\x951\\X\x95|I\xb5P\xb6&\xd8X~\x952&u+\xc3!\xe7M\xcf \x11\xe8\xa6H\x95i\x85\x12\x11T1\xdec\x9b\xe7\xa06\xb4\x12\xcdʨ\x87\xf1\x81\x8d\xd20t\x96,Dv\xa3\xf5\x9eP\x95\xdc\u007fr\x99\xfb\xd768\xbe\xd9#W\x8a\xe5n\x8fDIf\x8a\x82\x95\x955ʺF\n\x81k\x16>\x84\x80m:\xaef\xf3\x8c\x99,%Q\x8a<Q(\x11\xa1\x13\xe3=Y\xa2\xd1J\"D`\xb1]\x10\b,\xa69-\x1d\x97\xee\xf3+'\xf5]\xab\xc7\xcf\xff\x8f\xb0:F\xba\x1fV\x81\xb8\x10.\u07b7\xb1I\xe9,\xfb7ֹjq\x81\xd94\xa3S\x8e0X\xdc(&[A\b2\xa5\x91J3\xddj\xb3\xbe\xd1%\x13\x8a,\xd1M=P\xc4\x16\x02g\xa9\x9c\xc5\xf9\xc0Z\x19MYߌ\"-H\x06v\xb43\xfa\xb5g\xb5,1\r.\xf6\xf1o\x1f\xe6\xfe\xb5M\x16\xdb9\x17\xcdO1\x9b\xa5$Jb\xca!_]^\xe6hw\xc0\x9e\xa9\x82\x8d\xb2\xc2\x1a\x8b\x87觤`&\xcb(\xb4&U\x91O\x9c)E\xae\x14\xedVB\x91&\x14y\xa4$\xa5Z\x83\xd6l\x00\xed\xa6E\xce\x05\xcf\xc1Q\x97\"M\xf21\x8e\xf7\xb0\v\xe4\xe8`\xb0\xf7Ԩ\xc4\a\xa8\xac\xe3p\xaf\xcf̶\x94\xbe1\xb8\xba\x87 \x10\x9a\xf0UK\x89u\x16|\xcdt+Az\x89\xb5\x9e\xd2Z\x8e\xf6zl\xd4%\xc6;Jc9\xd5\xef325i\x96\x90\x16-\x96\x96vs\u07fd\xf7\xa0\x95D\xcbX}\xd4R\xd2N\x12vLuH\x95\xe2\x92m3\x14\x89\x9a\xf8\xa0TI抜\xb5\xda3\xe3\xe1\x12$G6\xba\x94\xd6\xe2\x83&\x10q\xb3\xb5aɩ\xfe\x80\\k\xb6\xb5\v拜\x96\xd2h)H\xa4\x9c@7\xb5\xb5ȴ \x91\x822\x04*c\x18\x98:\x00\xc7\x00\xda\xed\xf6\xc3/\x90\x03\xdd\xfe\x8c\xf5\xbeqȞϜ8\x86J<\a]\x05I\x8a,r\x04\x90*\x15\xb3_\x1b\x91\xd34є\x95\xe5\xeb˧8\xd6\xef\xb16\x1a\xd0i\xe7$iB\xd7\x0e\x18\xaa\r(\xb6\xf3\xea\x17?\x97\xcf\xdc\xf3-\x9e~\xe3\x8d|\xf0O\xdf\xcd\xff\xfb\xe1ϰ\xe1<\x17l\x9fg\xcf\xf44\xd3Y\xc6B\xbb`g\xa7 ק\x85!\x1a\xaa\x0fIN\x92\x18f[\x02\xa54\x1b\xc3\x11J\bj\xe7\xe9V\x86b\xba\xc5\xfa\xfa:/~\xf1M\x1c:\xb0\x9f\xbf\xfa\xd0\xff`a\xe9<\x0e\rJܑM\x96\xa6ۤ\xa9f*\xcfPI\x8an\vJ\x118\x19,\xf5\xa0\x8b\xf3\xde\"\xd8\x1c\x87\xff\x0f\xbb@V\xabr\xf5\f\xe8d8\xe4\xee\x95c\x98\xbc`.I&%P\xdd\x00\x87!\x80$2:N\x96\x03N\xd6\x03\xa6\xdb\x19\x89\f\x88\x14\x96\xe6;ܷb\xb8\u007f=`\xdc2\xbf\xf9\u07bf\xe0\x11\x97<\x02\x9d\xa4\xec^\x98a\xbeS\xb0\x94\x14\x9c\xb7\xb0\x8dV\x92\xd0j\x8aY\xed4%\xa0@\xc4\x0e,\xad\x15\x16I*\x04y\x92`\x038\xefɓ\x84\xca:\x94\x10\\\xff\xd8Ky\xf4Տ\xe3\x8b\a\x0e\xf3\xd7\x1f\xfb;\x8e\x1e9\xccT\xa1\xb9\xe6ҽ\x94\xc6\xf1\xd9/\xecc`*\xdaEJ\"%\x85N\"\x1a\x9d(\x0e\xd45\xdd\xde*!\x84\x93B\xc8\xcd1\xb0\xfa\xb0\vdd\xdd>9)\xd0\v\x94\x90\x8c\xc6\xccv\x11\x851\xae\x04\x06\x1fyS\x89\x94t\xb2\f-$\xbb\xa6\xa6q\xde3\x93\x15\xf4\xab\x11\xcb\xeb}\x16;m\x1e\xb1\xb8\xc8\xd1\xcd.'\x8e\xdcϥ\xdbZ\xfc\xe1\x1f\xbc\x93c\xfb\x0fs\xc1\xc2\x0eZY\x86\x14\"\xda|\xadɵ\xa2\x95$H\xa1\x98\x9d\xea\xd0i\x15x\xe7\xd8,+F\xa3\x8a\xa5\x99\x19\xee[]#Q\x8a\x1dSm\x06uM\"\xe0\xd4\xd1e\xd6w\x9d\xe0Ǯ\xff!\x0e\x9d8\xc9}\xf7\xde\x03\xdd\x15F\xbd\x11\xf7\x1f<\xce9\x89f\xe7\xfc6h\x84\xbagv\x9e\r\t\x03\x02C\x19X\x8f5\x91\xfb\x92v\xe7\xa1\xc1M\x1e\n\x81\xf8\x10>\x17\x88UN)\x05*H\x9c\x8f%\xda\x10\"\xd9LK9ax\x00\xe4I\xc2|\x9a\xe2\\`X\x1fgh,Z\b\xb6\xb7;\xd4\xceѫJ\xe6U\xca\xd4\xec\x1c>\x04\xd6\x0f\xaf\xe0\x8b\x16\xdbZS\x11b\x11Ѷ\xb7ӔT+v\xb4[\xa4*b]K\xf3ۙj\x15\x18k\x11\xfd>\x9b\xd5\x1aS\xa9f\xf7\xdc,\x87\xd6֙\xc93vN\xc5\xf0t6\xd5\xec\xbb\xeb.\xee\xbf\xfb\xeb\xec\xbe\xf8\x12\xea\x95e\x8e\xec\xff\x16\xc19\xf6\x9es\x0e3\xdb\xdb\b!\xc8u\xca\xd2\xcc,\x89\xd6$8\x84wh!b\xb8L\xf8\xec\x1b?q\x17\x00\xddn\x97\x1d;v<\xbc\x02QI\xb2\xdf\x1b\xf3%\xe0j!$\x02G\b\xa2Ah#4\"\xc6\x05\x9c&\xe4\x1ckΞ\xb9YzUͽ\xcb+\xb4ӌV\x9aR\xd6\x153Y\x8e\U000de84d\xd1V\xa2\xf4$<\x0e!\x90(E;K\xc9ӄ]\xd3SL)\x01\xcdw\xcdOu\xc8Ӕ\xb2\xaei\xd5\xf5\x84\xfb\xb5kf:blֱc*\x96f!\xb0c\xfb\x02!x6\x0e܇1\x86]\v\x8b\xcctڨ&\xd7I\xb5\xe6\x9c\xd99\xb6\xb5\xa7(Ҕ̔T\x83\x1e\x95\x8f\xc4\n\x9c\xfb\xbb\xf1Z\xec۷\xef\f<\xefa\x11\xc8\u007f\xbd\xe7\x9b\xe1\x85\x17_\xf8>\xe0j)%^\b\x02\x02-E,\u007f:K[\xeb3\x8a8\xde\a\xac\xf7t\xb2\x8cK\x17\xb7\xa3\x84d\xbd\xaa\xd12\xa1\x95\x168\xef\x18V\x15E\xea\xb1>\xa0\x9aHG\b\x81s\x0e%%\xf3\xed\x9c\xf3\xb6\xcd\xd3֒\xdep\x10\xeb_\x8dy\x14R\x92\xb7\n\xa6\t\xe8\x95U\x9c\x8bAǥ;\x16)Ҕo\x1c;N\xbf\xaapރ\x88\r@y\x9eS\x149\xaa\xf1y\x84@;\xcb\xd8;;\xcf\xd2\xfc\x1c{\x16\x169\xd9\xddd4\xec!\xc3d\xbc\xc7@\xc0g\x00\xf6\xef\xdf\xcf`08;\xb0,\x0f\x1f\x90\xf0\x06@J\xa5\xa9*K\xee\x1c\xc6Z*\xeb\xb0i@m!\xc6\x19\xef\xc8B,<Ͷ\n.Y\x98g\xb9?d\xa3\xb2T>\xa0\x83B\xcbxi\xba!?\x17Mn\xa1%̵2f\xf3\f\x81`\xbd\xbbI\xf0\xa1\xa9\xc37ķD#RMG\n\xa6Z-\xd6{\xfd\b\xefK\xc9#w\xee`if\x9a\x8dQ\xc9\xf1\x8d\r6\x06Cjk1.\x92\xb9\xb5\x92Le9\x8b\x9d)\x16Zm\xdaE\xc1\xae\x1d\vdI\xc2\xc9~\x8f\xcdrĨ\xae\xb1B\x10\xbc\xff\xa4\xd4\xd9\x1a\xc0]w\xdd5\x017\x1fv\x81\x9cD|s\a\xe1\xc3\nn\x94Rb\x1d\x98\xcabRKe\fu\b$M\xff\xf9\x98\x80`\x13Om\x1d\x89RL\x159ZJv%\t*M\xb1@m\x1c\xeb\xdd\x1e\xf8@\x91e\x14\xa9\xa6\x93\xa7t\xf2\fB`T\xd5\xf4G#\xacs\xe3\xea\xf0D\x93\x90\x12Ҕ$\xd1l\x9b\x9b\x89\x02\t`\x9d#\xc9s\xf6l\xdf\xc6\x0e\xef9\u007f\xc7\x02\xcb\x1b]ֺ=\xaa\xa6\x12Y$\tSi\xd6$\x83\t\xbbv.\x92\xa7)\xbdr\xc4\xdapȰ\xae\xe9\x95\xe5\xb8n\xffG\xe1\xd1Wb\xad\xe5]\xefz\x17\xcf}\xees\x1f~\x81\x1c<x\x90\x0f\xec\xdb\x1f\x9ewхo\x96\x82'\x13\x82\x10RR\r\r\xba\x88;\xcf:\x87\x132\xb2\xc8\x03\x04\xd1\bC:\x8csdI\xc2\xcc\xf4\x14\xadvA\x9a\xa6h\xad\x9a%\xdeI\b\x1e\xe7\x03\xd6\x18\xac\x89\xbc*k#\xddgT\x8eNk\x05\x82\xb9N\xe7t\xfd^)\x84P\xecܱ\xc0\xfeCG\xf0\xdec\x9c\x83\x06m\x9e\xceR<\x81\xf9\xe9)\x86UE\xb7;\xa0\xb6&\xd6n\xb4\xa6\xd3n13\xd5A\xa3p\xd6rbs\x93a]3\xac\xeb\xe8\u007fL\xbd\x16\xa4\xf8Я\xbd\xfbv\xee\xb9\xe7\x1e>\U000913dc\x1d\x02\xf9\xc0\a>\xc0\v_\xf8B\xac\xe0\xd32\x84\xfb\x95\x10\x17\b!\xa9\xac#\xab\r=9b\xa6ha\x94B\x8f)\a>NZ\xa8\x9d#u\x0e-%I\x12\xfbDҦ\xf6\x8d\x88$\xb51\xbf\xd3\x18\xcdp8\xa2\xaej\x02\x91M\xe8\\Ļt\xc3\xe0jeY\xc3X\xf1\x13nuQ\xe4\xccuZ\xac\xf7\x86Q\x9b\x9a\x88O\x02:I\xc8\xf3\x8c\xe9N\x9b\x9d;\x17bdִ'H!\xf1.0\xec\r(\x8d\xe1\xd8\xc6\x06êfP\xd7\b!\x19V\xd5g\xbe\xbc>\xd8\x1c\x9b\xab\x87\xea\xf1]s{\xd7\xd6ָ\xe3\x8e;x\xe6\u007f}\x87\xadC\xf8]\xd98W-5\xce\t\x06ֲQ\x8e(\xad\xc3x\x8fw>2\n\x1b\xbbm\xbd\xc75\x8bX\xd7\xf5\x84(\xad\x1a~֘\xe91\x1e\\C\x00c\f\xa3\xb2\x9c@\xe7\xb6\xf1\x0f\xbd\xc1\x80\xf5n\x97\xee\xda:e\xaf\x8f3\x86j0\xa0-\xe3\x1c\x14c-\xbe\x99\x87R\x1b\x1b[\x1b\x1a\xff#\x03\x14EA^\xc4vi\x01\x98*b_\xeb\xfd>\x9b\xc3\x11\x9b\xa3\x11\xceG\x8d\xad\xac\xbf\xf3%o\xfd\xed0\x1a\x8d\xf8\x93?\xf9\x93\xb3\xab\xa6\xfe#?\xf2#\x84\x10\xf8\x89=\xe7\xfcv\x9e$ϐB\\\xbd{z\x06\xad%\xf7\xb9\x92~U3\x93;j E D\xc0Z\x8bQ\x92\xda(\x12)\xa9\xea\x9a$M(ˊ<\xcfh\xda\xfb\x1arZ$d{稭\xa17\x1cR6\xa6\xa3\xb2\x8e\xb4\xe9\xe0u\xc0fYa\x84b\xed\xd0q\xcarD\xbf\xdf\a<\xb9\xf7\xac\x8dF1\xd0P\xa6\x89\xc8,\x91\xe8\x13\xd9%\xc1y\x84\xd68\xe7\xa9F5uYR\x1a\xc3\xdap\xc0\xe6hD\xedb\xab\x84\xc4\a\xe3\xddgn\xba\xe9&\xce=\xf7\\>\xf1\x89O\x9c}$\a!\x04\x1f<q\xaa|\xd2\xdc\xec\xf3\x1e93\xf3\xf9\xbd\x9d\x8e\x12Bro\xaffP[\xd6G#TQ \xb5\x8a7Nd\x95$\x13\xfa\xa8`0\x18\"\xa5@i\x85\x94\xe9$\xef\xf0\xdeᜣ\xac*z\x83!\xdd\xc1\x00\xef}\xfc\xbf\xcd!Ai\xa6\x16\xb7q\xde\xde\xf3\xe3ԇ\xa69'\x84@Y\x96\xf4\xfa}\x0e\x1f:\x84\xdb\x18r\xb4X炥E\xea\xc6<\t\x1a*\x96\x10\x04!p\x83\nc\"9.\x04\xd8\x1c\x0eY\x1f\x0e\xd9\x18\x0e\xa9\xacm6\t\x83\xc3\xdd\xe1W\x8f\x1f?ΡC\x87\xce>\xd6ɇ?\xfca\xf6\xec\xd9\xc3S\x9e\xf2\x14\xbc8\xb9qL\xe9\x8f|\xe3\xd8\xf0I\x8f\x99\xd9A\xdf{\x9c\x85\xb2;\xa0v\x9e\x85<#iLQ\xed#\xbc\xa2\xa4\x98\xb4\x12t\xbb=\x9c\xf3\xccLO\xa1\x12\r!j\xd3pT\xb2\xb6\xd1e\xbd\u05cb4O\xe7\xe8\xad\xf6\x98\x9f\x9a\xa6\xdb\xeb\x93\xe6m\x8aV\xd1\f\x1a8\xfdh\xb5Z\xe4E\x8e\vpr\xff\xe7X\xf50\xd3i\xb1}z\n\xe5$%\x90\xf8\xc8A\x92B\xe0}\xd3$\x11\x02ú\xa2W\x8e8\xb6\xb1A\xb7\xac\xb8oe\x1d%\x04\xb9N\xee\xdd9ݿ\xfc\xff\xf9\xc9\xe9\v\x0fo\xc8/\xde\xf4\x17+\xf7\x9c5\xcc\xc5\x1f\xff\xf1\x1f\xe7\x8a+\xae\xe0yWϪW_\xed\xdfpѢ~\x99u\xc3\xe4\xd3ߖ\xdcq\xef)\xa6;\xadHr\xb3\x96\xe3\xdd\x1e\x8f^\x98g>\xcf\xd0R\x90\x11\xe8U\xe5$l\xedH\x89\xb0\xd0\xef\xf5\xe9\xf7\xfa\x04A\x93\xf1CYV\fMͰ6\x94\xc6r\xfc\xf8*\x17-,2==EY\x1b\x0e\x9fX\xe6\xfcs\xcfE/.\"tB\x18\r\xf1\xddM\x84\xf7(\xa1\xd0:aqi\a\xa3\xfe\x90{\xf7\x1d\xa2s\xf9%h\xa5\x10>~>\r\xf7KI\x81s\x9e\xd2\x18V\a}\x0e\xae\xae\xb2\xda\x1fpl\xb3G\xbf\xaaPBr\xa2?\xba\xfa\xd1K\xfe\xd3{\xe6\x02ל\xe7\x87ۊ\xd9_\xfe\x93ϋ\xb7nA\x0f\x9bF\x00\x00\x1a\xddIDAT\xa9\xfe>|\x02\xb9\xe2\x8a+x\xd1\u007f\xd8-^\xfa\xf4\xa9w\xec\x99U\xcf\xfb\xf4\x97\xfb\xfc\xc1\xdf\v\xceK\xe7\xb9n\x9bd\xbf\xf1\f\x8d\xa5?\x1aa\x9c\xe7\xe4`\xc4T\x9a \x90\xd4x\x12\xe9\xe9\x96#\x04\xb1$\xdbn\xd8\x1bn\x1c.{\x8fk襽\xaa\xe6h\xb7ˡ\x95.\xd3\xfd\x8aU\x15\xc9\x0f˫\xabܿz\x82\xf9ms<fz\x86da\n\xe1̤<;\x18\x0e\xf9\xd2\xdd\xdfd\xbbLɳ\f\x0e\x9f\xe0k\xad\x83\\y\xf1\xf9\xe4i\x86\x90!\x12\xb2\th\xa90\xceqbs\x93o\x9e8\xcer\xaf\xcfƨ\xe4D\xb7\x8fq\x8eD*\xb6\xe7\t\xf7\x9c\xa8y\xe7'\x05o\xfaQ\xdf\xfa\xbe\v\xc2[>z\x8f?\t\xdc~V\x98\xac\xd7=So\xdb6\x93>\xfb\xf0\xb2\xe1\xed\x1f\x15|\xe5DIg1\xb2\v\t\x9eͪ\xa22\x16\x11\xa0_U\xd4M2\xe7C\xec\xa7ɤ\xa2[\x96X\xe7\tΓ\xe8\x18M\xf9\x10˨\x955\fj\xc3\xf2p\xc8F\xbf\xe2\x8b\a\x8e0\x15\xa0o\f3+\xab\xacW#\xa6f\xa68p\xe48\xa9\xfe\x1a{w\xedD4B\xadkÁ\xa3'8\xba\xff0U^\x10ld\xae\x1f\xf9\xe6}\xec]\x98\xa3ӞB\xa8\xc8\xfd\xea\x8eF\x91\x9b\x1c\x02\xc76\xd6Y\x1f\x0e)\xade\xad?ĸ\x18%j)y\xd4\xf4<\xbf\xf1\xb5\x13l\x94\x05\xdf8\xa9\xb8\xf6<\xd8=\x1fn\xba\xfd5\xb7\xdc\xfe\xf4\xa7?\xfd\xe1\x17\xc8\xe6jy\xfe\x8c\xf2ɧ\xbfTr\xbc\xefXu\x9e?9|\x84\x8b\xa6\xa7ؖ猼\xa5)\xf212\x16\xe3=\xa2!^\xd7\xce#\x88ݮ#cX\xeb\xf7I\xb5BK\x85#0\xa8kzUI\xaf\xae\xe9\xe4\x05\xa9\x8c\xfd\x19\xeb\xces\xb0<\x81\x92\x82\x19%\xb9\xcc;\x8e-\xaf\x90&\x9a\xf5n\x97\xe9v\x1b%%\xab\x1b]\xbe\xf5\xed\x83l\xba\x9a\xe5\xd5\x01\a\xca!\xa1\x9d1\x93\x15\x9c\xd8\xdcd\xda9\xd0I\xac\xcd{\x8fs\x96nY22\x86\xdaF-\xed\xd7&\x16༧\xad4#k\tRprX\xb3:h\xa1D`q\x9a\xab\xc5\xd1J|\xb7f\xeb!\x11\xc8p\xb3R]Y3\x18JJ\x1b\xe7\x92\b)90\x1c\x91\xe4\x86m\xad\x82\x95ͨ\x15\x9beŽ\xcbk\\\xb2m\x0e\x95\n\xa4\x0f8\xe9\xb1!\"\xb6\xa5\x8b\xc0\xa3\x10\x86\x915\x8c\xac\xa5v\x8ev\x911\xd7)\x98\x9d*x\xc4\xda\x12\xf7\x1c9Em\x1d>\xc0\xb2\xf1|i\xbd\x8b\x94G\x11\x02\x16\xca966\xfb\x8cF%'W\xd6X\xe9\xf5\xd9\xd7\xed\xb1I`z\xaa`\xcf\xf6Y\xceٹ\x9d|a\x1ec<Κ\xc8r\xb7\x16\xeb\x1c\xa5\xb5\x11߲\x16\xe3<\xbd\xb2jr\xa5\xc0}\xbd\x1e\xffp\xb2a\xbc\a\x98\xca㬕c\x1b\xf4j'ώ(K\b\x0e\x1eY\xc7\xd56\xa8\xed\x85\xe0\xbeuO\x1d\x1cI\x96\xf2\xb4\xc7(>\u007f\xc0sĘfX\x80d\xb3\xac9\xde\x1fp\xc1\xdc\f\x8e@\xed\x02\x89\x8cT\x9c\xd8\xcd\x1e\x13Ȫa~H)i\xe7\x19.\x04:\xad\x9c\xeb\x1f}\x116\x04\xbe}|\x95\xd2XT\bt\x8d\xe3Kk\x9b\x94\xd62\xbf\xbcJ\x9e\x15\x18\xeb8\xd4\xedr\xccX\xdaE\xc6|\x91\xf3\xbf]u\t\xe7\xefX\xa0\x9dg\xd8\xda`\xacm:wc\xc2g|\xac\x9dGa\a\x8ew\xfb\x94\xc6B\xd34\xb4\xd0\xf2\f-\xf4\x06\x9e\xed\xad\x82\xa5\xe9\xc0\u05cf\x05\xd6\xfa|\xf1\xca\x1f\xfd\xa9pV\b\xe4\x8eoʓ\x17/\xb8{\xbfyL^v\xc1\xbcD\xcb\x19.\xd9\xe9\xb9\xee\xfc\xc0\xf1Mž\x93\x0e\xd7\xc0\x15>\x04\xa6\xf3\x94v\x96FR\x9c\x880J\xe5\x9a\x0eZ\x11\xa7\x03\x8dM\x04@\x96\xe8\xd8+b-\xce{\xd2$\xe1\x8a\xf3\x97\x18:\xc7\xd1\xd5M\x8c\xb1`\x1c\x1b\xc6\xf1\xb1\xe5u:\xeb\x9bd:ah\x1d\xf3\xd3S̵\vڭ\x9c\xa7<\xfe1,NM\x91\xa1\xf1\xb5\xa3\xea\x971c\x0f\xe3\xf0[bj\x1b\x9bT\x9d\xa3v\x8e\xa3\xeb]j\xe7\xd0R02\x81v\x02W\xef\xcc\xe8\xacf\xfc\xecu\n\x82\xe3/\xbe\x18\x96\x8d\x137]y\xed\xb5\x0f\xbf\x86\x94e\xc9M\u007f\xb1\xe2\x9f\xfdةg\x15\xa9\xfeH\"پ4\x15X\xef\n\xde\xff\x05ɑMǨ\x1cF\"uS\xca\xf5!Ыjf\xb3t\xc2J\xac\\ԍT*\x82pHb\xa3\x8c\x94b\xd2pS:Ǒ\x93k\x1c\xd8\xe8\xb11\xaa\x18\x19\x8bK\x142Q$ޓ\x00Y\x84\xbe\xb0\x02\xdaE\x06J\xb0ik\xf2$\xe7\xc4z\x97\xa3'7\xb0Ʊ\xb3Ӧ\xa5\xf4\xe4\xf3E\x108\xe9q.\xe6 \xd6;N\xf6\x06\xd4\r\xbcc\x9d\xc7yGe%\xe7Ly\x9er)lo\x05\xfe\xf4s\x81Ck\xe1\xf9\xef\xfaJ\xf7+O8\x1b\xf2\x90O}\xeaS\\\u007f\xfd\xf5<\uee7f\xfa\xa5\xf7\xbf\xf9\x17\x1e\xdb\xcaԫ\xb4\x14\xd7\xd76\xe4\xdd*\xec\xed\x0eM\x9c[\x12B\x8c\x9a\x9ccd,KS\x1dj\xefI|Ĭ\xf0P7\x93}r\xa9\xa8\x83k\xf2\x02\x85\xf1\x9e}\xab\x9bܻ\xb2Nm\xe3k\x86\xc6\"\x85\xa0\x95\xe8&\xc9\xf4h!\xa8\x9c\xa3\x93\xa6\xa8\x86\xc2c\x1b\xacjm\xbdǇ>\xf5U\x84\x10\f\xad\xa5\x95hvLOq\xf9\xee\x9d왛\x8dQ\x9f\x8d\x82\xb0\xcea\x9dgT7\xe8\xaf\x10T\x8dP\x8eoz\xe6\x8b\f%\xc3\xc1O\u007f;\x1c\x1c\x19\xff\xce^\xed?p\xd6d\xea\u007f\xff\xf7\u007f\xcf{\xdf\xfb^n\xbf\xfdv\xb4\xd6G\xcba\xffE\xbb[:#\xc8\xdf\xd4B\xbc\xa0r\x9eLk\x94\x92H\x11['\xeb\x06\xa5\xad\xac#\x93\xb1Mͅ\xa62O\\X\xe3<=cY\xeb\r8\xb0ޣ[\xc5\xc5q\xde30\x96\\+\xdai\x82\xe0\xf4\b\xf3\x91\xb5\xcc\xe4\x19\xa9R$R\xe1\x83'\xe0Q\r\xf4\xaf\xa4\xa4v\x8e9\x9d12\x96\xe3\x1b]V\xfbC.X\x98\xe71\xe7\xec\x88eb\x11͕\v\x81\xbaіqKNdS\x06>\u007fh\x00B\xfc̡a\xf5\xf1\x87\xfa\x84\xa2\x87ć\xbc\xff\xfd\xef\xe7\xcf\xff\xfcϹ\xfe\xfa\xeb\xb9x۬4.\xfc\x16\xf8\xe7+\xa5\"$!\xe3\xb2y\xefq^02\x96\x83\x1b]\xbc\x0f\\\xbc}\x8eN\x9a\x90\xa9X\xfe\xad\xac\xe7\xc8f\x8f\xc3\xdd>\x03cc\x17\xae\x90\x8c\x8c%4-e\xf3\r\xd7kc\x18\xc3S\xeb=Y\x92\xa0\x84`\xc3G\x01\x04B\xf3^A\xa2\x14\xfd\xba&S\x91&*\xa5d:\xcb\xf0!045\xdf:\xb1\x8cs.\nEkj먌e\xb97 \xc0ėy\xdfp\x80C@K\xf1\xbe\xf3\xda\xd9\x13^\xf5\x9bo\xff\xd2\xf6\xed\xdby\xc9K^rv\bdvv\x96O}\xeaS\\z\xe9\xa5\xec\x9dj\xa9\x10\xc2+\x81\xe7\x8ew\x94\x14c`\x84\xc9d\x9f\xca9\xd6G%3Y\xc6\xf1ހ\xb9\"c:K\xe9\x96\x15G\xbb\xfdhf\x9a\x00@I\xc9FUM\x80\xc0\xf8\xba\x92nY\x91\vɮ<G%\x8a:\x04L\b\x94\xce3\xb21K\x1fV\x86v\x96\xa2jǂT\xf4j\x8b\xce\x12V\x87\x91\x9a\x9a*E;Iجj\xbe\xbd\xbcF;M\xb8hq\x1b\xc6ZV\a\xa3\xc81vnBжM[]\xa2$\x89T\xdb|\b\xb7\xbe\xf1\x96\xff\xfc\x93\xf7m\x0e\xccUW]\xc5\xddw\xdf\xfd\xddG\xac߭\xca\xd5uM\x9a\xa6\\<ݞ-\xad\xfdo>\x84g4\x85\xc1\xb8\x1b\x85 պ)\x18\x89\xa6\xcdYN\xa6\xc9i)\xe9d\t\xa3\xda\xe0C$]k\x19\xa3\xacD)6ʊ \xc0\xb9غ\xdc++D\b,\xe69\vY6a\xa3\x8cي\x85R,ʔ\xbb{].kO\xc7\u0095\xd2\x13\xda\x0e\xc0\xd1j\xc4ׇ=j-\xd8\xd6nQYK\xed<\x9d4\xe1\xba\xf3w\xa3\xa5\xe4\xaeC\xc7\x18\xd45\x83:j`\b\x81\xd5\xc1h\x8b@$JH\x9c\xf7\u007f:\xb4\xf6\xa5\x87\x87\xd5q\xe7\xdcw]W\xff\xae\x05\xf2\xbc\x9f\xfei\xfe\xee\xfd\u007f~e\xe9ܻ}\b\x97Oh\x9c͜\xa91IN5}\xe4j\xb2\x80͔\x04!\xb0\xce1UdM-[`\xbc'U\x8a\xf5QI\x10PYKY\x1b\xbc\xf7t\x10,\xe69\x9d4\x8d\x91\xdb֛\x01\x12!\xb84\x9b\u0087\xc0F]a\xbcg\xd4\xcc\aF\x80\x12\x12%\x04]Ss\xdc\x19F\xa9d\xb1\xdd\xe6\xd4`\xc8l\x9e\x91j\xcd\xce\xe96\xfb\x96\xd7\"\xb0\t\f\xaa\n\xe7\x03\x9b\xa3\x12\x17\"ac|\u007fyd\xd4\xec+\x9d\xbb\xf1\x93\xf7\xee\xdf\xf7\xddN\x9a\x13\u007f\xf37\u007f\xf3/z\xc3UW]5\x190\xf3ș\xa9V\xed\xec->\x84[m\b-\x1fB$T7c*\xc6к\x12q\xf4E#\a|\x88ٸ\x96\x12\xe3=y\xa2Y\xec\xb41\xceOLI\xdf\xd4qH\x8c\x80\xf5\xc1\x90\x9diʞ\x99N3\x12PRՆjh\x91M\x01+\x96Wc\x151mvi\xdd\x04\x14{\xa6\xe78ov\x9eV\x92PZǱa\x97c\xbd\rr\x14ߨ\x87\xccM\xb5\xf1\x81\xc8\r\x16\x91\x85\xbf\xdc\x1f\x90j\xc5t\x9614\x11K\xebWu\xac\xdf7\x87\a\x88F\xc0\x9d$\xc1\x85\xb0^;\xfb\xbc\xce9{\xdf\xf7\xe5o\xde\xcbɓ'\xf9\xcaW\xbe\xf2/\x17\xc8\xff̋\xe6\xe6\xe6x\xf3\x9b\xdf\xcc\r7\xdc\xc0\xeeݻ\xf9\x91\x1f\xfcA\x0e~\xe5\x8b\xd7\xf8\x10\xdeY[\xfbX\x9a\xb0S\xc8\beǹ\x91\xbea\xa0\xabI\xee!\x85\xa0v1\x03\x9e\x1ca!\x05\xe7LOc\x83\xc78O\xa7\xe9\xc2ݬ*lÄ?w~\x9aG/\xceǹ\xbe\xcd\xc0\xe5L)Z*A#\x99\xef\xb4I\x94f\xe4,\xb5w\xd4\xc6!}`\nM\x8eBIAe,\x15\x8e\x9e0X\xe2T\xa2^U\xf3\x85\xa3'ط\xba\xc9\xd2t\a\x0f$\x8d֭\f\x86\x94Ɛ6B\xea\x95\x15ƍg}\x9d^:%\xe2\\\xe1LiB\b\xcez\xff\xca*I\u007f\xe3\xfe\x95\xb5\xf0\xb9\xcf}\x8e[n\xb9\x85O\u007f\xfa\xd3\x0f\x8dS\u007f\xe63\x9f\xc93\x9f\xf9L\xae\xbd\xf6\xda\tE\xf2Q\xb3Sۥ\x10\xaf\a\x9e#\x85H\x9bM\x8f\a\xda:ad\fE\x92\xd0uդ\x1bj\xdc$3\x16\xc6\x18\x16WB\xb0\xd0n\xa3\xa4`X;\x8a$AK\xc1갌\xccEcP\x02\xaeٻ\x13\xd9XV\u0558?+\x02=_#\x11\xb4\xc8AHZE\xcat3\x9e\xa3\x99ڃt\xd0\x1f\x8c\xa8\\M\xad\x02#ᨼ\x8b\x93!4l\xeb\x14|\xe3\xd4\xda\xe4,\xab\xf1\x84\x87\xe9<c}8\x8a\x89c\x00\xeb\xc3w\xa2\xd2b}\x84~\x00\xa5\xa5|\x8b\xb0梽\x9d\xe2e\x87\a\xe5\xe8}\xef{\x1f\xaf{\xdd\xebx\xc7;\xde\xf1/\x17\xc8\x0f\xff\xf0\x0fs\xc3\r7p\xed\xb5\xd7r\xe1\x85\x17\xb2\xb4\xb4\x84\x94\x92\xdb\xff\xf0\x0f\xf9՛_\xb2[\xc0K\xa5\x10\xcf\x06\xe6\xc7\x17\x13\x80\xca{\x12%q\xde1\x9dg\x8c\x1a܊-\xadb[\xdbƚz\x10\xad4v-\xf9\x00J\xc6\x0e\xaa\xaaAX+\xe7\xa2\xc6\x14\x19\xdda\x15\xdb\xdd\x1a\x81D\xe7\x17?_x\x18l\fIE<M!\xcfҦ\x9f#`kK]\xd6\xf8:\xb63\xf75\xf4\xbc\xc3\xe3\x99n\x15\xa4Z3\xdfj\x11D<\xceB\bA\xa2bx\x9b*E+є\xc6\"ř\xb3L\xc4dH\xe1\x19x\x1e\x04(\xad\x95\x99\xd6/l)u͞V\xf6\x93\x87\x87շn\xbb\xed6666xO\x9c\x92\xf4O\v\xe4\v_\xf8\x02\xbbv\xedbaa\xe1\x8c\b\xe1iO\xfd?\xf8\xc6\xc7?\x96\x85\x10\x9e\x00<=\xc03\x94\x94yhl\x92$\x12\xcf\xea&\x02\xd1RR\x1a\xcbt\x96\xb1\xd1\xf0\xa6B\xa3\x1d\xe3\x10v<\x91!\xeetI\x9e\xe8X\xa1\xf3\xa1\x89\xc8`\xb3\xaa'\xedl\x84@\xa1\x15\xc3\xcaDNUc(|3\x1d\x0e\x0fUU\x93\x10\x1d\xb5%\x10\xb4lXZ \x9d\xc7[\x17M\x94\x88\xb3\xe8\xd3T3\xdbna\xac\xc7{;i5\xa8\x9c#\x91\x12\x1f\xe4d\x80\xcdt\x91sl\xb37\xd1\xf4\xd3\xe6\xea4\x1b\x86\xc6\xf1\xd3\xd0K\x13)\x19\xd45\xed4}\\\xa1\xf5ߝS\xa4O>:\xaa\xbf\xfc\xda\u05fe\x96\xbf\xfdۿ\xfdggk髮\xba\x8ak.\u007f4\xdd\xc3\aU\xf0\xbe%\xe1\x12!\xc4\xf7\v\xf8!\xe0\xfb|\bK\xbeQ\xe5\t\xd9Y\xc6̺jL\x90\xdc\xd2'\xe8\x1a_1\x1e\xad\xc4\x03\x841\x1e\xb3\xe1C\x88\xed\xc6[\xccWm]S\b\x8a\xce<\x82|\x9e\x811\xa7\xc3[\x1f&x\x93\xf7\x9e:x\x86u$&\x94\x80O\x92\xe6\xba@\v(\x04\xb4\xc6\x13F\xa5d\xdbt\xe7tWo\x80\x13\xddA̗\x9a\xbcih\xec$\x12k7\x10\xcc?\x8eDä\xb4,\b\x11\x15h8UJF\x81\xf6\xeb\x9av\x92,i!\xffrg\x9e^}\xa2\xacO\xdd~\xfb\xed\x93\x11\xba\xdfQ \xe7\xb5\xf2?\x12\x82\xbd\xa9R\xe7&B.\x00\xedq\x96\xeb\x9b#\xe9\xc6\x0f\xeb\xdcd\xb1+簍\xb6\x8c\x8f\x98\x10BPYG\xae\x15\x95\xb1\x93\xf7j)'\xd9\xee\xf8\x11\xfb\xf9\xe4\x04\x96@@i\"\x907f\xc8\x03\xac\x97\x15\xbd\xb2f\xb6\xc8&\v3N0\x85\x90$\t\xf4k˚\xb5\xa4\xad\x16I\xa3\xe5\x928\xf4\xa6\x140\xb2\x96y\x9d\xb083M\xa7(H\x13\x1d1\xb5\xcapl\xb3\x87\xf3\x9e\xcaZD\xb3\x98(\xf0!\xe6K\x9d,\xa3;*\xcf0Wc\xed\x97\x02Z:\x1e\xa7\xb1u\x9c\xdd8z\x1cZ\x8b\x10\xec\x06\xdevN;\u007f\xd6\xd1Ai\xbe\xfaկr\xcb-\xb7p\xc7\x1dw<8QN\t~J\t\xf1x\xef\xfdyƻ\xb6q\x91\xc0\xe6\x1e \x8c\xadt\x9fq\xd8:\x9e\x01\xa2E\x1c\x1e\x934\xf5h-UC>\x14l\xf55a\x8b\xaa\xcf\xe4Y\xdcU\x8d\xf1\xf5!Dx=\xf8\xd3\x10E\xa3!'z\x83ɐ\x17\x19D\xc3<\x8f\f\xc3v\xab@d)kΓk\x1d\xf3\x9d\xe6I#\xb42\xc0\xaau\xecݱ\x9d\xe9N+\x0e.\x10\x92o\xafmp\xdf\xdaf\x1c?\xd8ܭ\v\x01\xd3`m>\x04\x8aD\x13\xbeC8:\x99\xac\n8\x1f\xf1\xaf\xa15\x91\x10ج\x91\x89,\xcag8\xe7\u07bb\x98'\xb3\x97_~9\u007f\xf6g\u007f\xc6\xdb\xdf\xfe\xf6\a\x17\x88\xdc\xf2\xa1\xbe\x01Ҽ\x10M[\xc1w\x88*B\x88\x8c\x90-\x17\x15\xe2\x871\x9e\"\x97)Eh\x9c\xa1\v\xa7綏3\xf8V\x92\xb0\x05T\xc1\xb9Hf\xf0M\xb2\xe8\x9cG5S\xa6W\x87%G6\a\xc88\x84\x03\x10t\xf2\x82\x1d\xf3\xb3\xec\xda>\xcf\xf6\xe9)抜L\xab\x89\x86ma\xe6\x83\x10\xac\x0eF,\x0fF\xa4\x8d\xd0F\xc6p\xa4\x1bk\x1d\xb21?[\xefq<\xa4s\x9cӄ-\xe6\xea\x81\xd2\x19\v\xcf\aߴ\xec\xc5\xf0YK1a_*!~\\\v\xf1\xc9]Ez\xd5\xec\xec,/zыطo\x1f\xbf\xf3;\xbfÕW^yZȋ\x8bۂ\x93\x92\xa2\xaeI\x1f\xe4\xe8\xd1q\xb44v^~\x8c\xebx\x0f\xcd͌Q\xd7<I\xe8V%\x85\u058c\x8c\xa5jPS\xb1E\xb3\x00:iʎ\xa9\xf6D\x90\x83\xdaP7Q\xd5\xc8\x18\x94\x14X\x1f\x99\xe8ZIzeI*%\xd7\xec\xd9\xc5t\x9e\x9f>\xe3C\n\xf6\x9fZ\xe5\x1b'N1\xdf*\xd0R\xd23\xf6\f\xfcil.7G#Jcy\xc2#.BI\xc1]\a\x8fp\xaa?\x9c\xe4J\xed4\x89\x9bk\x8b?LUl\"=\xb0\xb6\xb1\xc5\\n݈\x82L\xa9I\xf1\xcd5Z\xe1&\x81\x8b\x98\x8c+\xdcb)\x06\xc0o#\xc4[\x8e\x0e\xabu\x80\xaa\xaa8~\xfc8\xfb\xf7\xefGL\xed\xda\x11\xb6\xe0(\xa4ޣ|\x1c\x96?NG=b\xa25\xaeyfUE2\x1c\xa2\x84`*M#ǩ!1dJ2\xac\rusq\x0fT\xf7\xb9V\xc1l\x91\xc7\n\"Я\xeaIɴnF\xea\xb9\x10h\xa7)\x89֔\xcd8=%\xe0Q;\x17\x99.r\\\b\xac\r\x87ܿ\xb2\xce\\\x91\x936\x93\xe5F\xd6N\x90\x82\xb1\xafɤd}\v/\xd76S!\\\x13\xach)\x99\xca3h\x9aQ\t\xd1\xc7%J\x92JɁ\xb5\x8d34O4\xe7\xf1\xc6IA\xa7'C\xb8\u007f\x06\x86Je,\x03\x18\x1fp!\x9c\x02\xfe\x00!\xde+\x94\xba\xe7Hoh\xcf\xc8C\x02\x10\xa4\xa0\x94*z\xb5\xad\u007fh\xec\xbc\xe0t\xc4\x11\xb6\xe0H~ˢO\x1c\xdc\x16S\xf8\xc0\x80}\x9cK\xf8\xad\xef\xd9\xe2\x10\xc74\xa11\xfe\x95%\x1a\x02T\xce\xf2\xa5\xa3'\xd83;\xcd\xc8\x18\xbaU\xcd\\\x9eG.\x95w\xb8\xc6\xf7l=\xeauL~k\xa5i\x14v\b\x94&~\xa7qn\xb2\x11&\xfe-\x9c\xbe\xcf\xf1UE\x8d=m1\xb6\xe6!\xbe)C\xffS\xc2P\"23}S\xf2I\xa4D\x86\xb0\xe8B\xb85\xc0\u007f\tΝ\xd8\xdd\xca>\x05|R\x87-h\xa0L\xe2\"{\xf3\x80\xe3\xe1B\xd82\xc7*^\x8c۲\xdc~Kd$\xc76S\n\xa4\xd0Xc\x1e\xd4\x19n\xb5\xbfj\xfc\xa6\xadv2p\x86\xd9\t\x848\xa3\x11\xc1\xa1\x8dM\x9c\xf7\xb4\xd2\x14\xad\xe2\xdfj\x17\xa7\x8b\xe6Jᥤ\xf6\xa7\xef\xc15\x02RR⽟4\xf6\x8cO\xed\xd9*\xc4\xc8b\fM\x14u\xfa\xfb+\xdcdC\x8d5d|\xad\xe3\r9\x99\x9c'\x05\x89\x90[\xa7\x95O4\xbc\xc9gQM\xdb_,\xa6\x86]&\x84\xa7\ax\xba\x96\x99F\x15)*O\x11j,\x10\x8b\xe9\x8e\xf0\x95=\xfdi\x81\xc6aoɌƝ\xa8\xcdSn\t\t\x05\xa7\x1d\xda\x03\x85;~\xfd8L\x9e\x1c\\\x8c\xc0\a?1\a\x9c\x91L\x86\xe8$\x91(ٌ\xcep\xf1\xb5\x95\xf3dJQ:G\xa2b\x82\x9a\x8c9_\xcd\"\x8f\xb141\x1e\x93\xd1l\f\t\x91\xe9\xf2\x80P*4\xb9Qx\xc0\x06\x1a\xb7\xe5ż\xa8y\x93\x04!\x1e\xe0\xecÃ3\xb4Ė\xe4r\f\xd7lEet\xbe0ݼⴌu\x91\xa1\xf3\x94zs\x88\xe9\x8e\xd8\x1a\x82\x84-\x11\x93O\x12d3V\xc9z7\x81\xd6'QWc\xc3\xfd\x03\xfcHe-\x9d,\x9d\xf4\x82\x8c;kk\xeb\x9a FL\xec\xbfn̖\x8b\x93\xdc&y\xc6x\xa6\x96o\x86\xef{\u208f\xcdVic\xc0!\x1b\f\x8d-;8i\xf2\x84\b\x95H\x9cu\x84-\xab\xa7\x9a1 \x88ظ\xfa\xc0\xb1K\x01\b\r\xf5R\xc8Ț\x17\x93\x93\x05\x1e\xdcl\x05\xadp\xa9Ƨ\x1a?^\xa7\x10\x90\xc6\"\xfb%\xbd\xca\xd2s\x1e\xcd\xf8\x8fR\"\x9aV2\xd1\b \x9b\x9fBhE\xbd\xd6\xe7\x813x\x83\x14\xd8$!5&\x9eU\xee<\xa9\x8aٯi|\x80\x14\x0f\x1e\xbf\x8f\x9a1\xdec\b~X\x9b\xc9M\xcb\xf1\"4\x87\x15\x8f\xb3\xf9@`hL\xf3\x9b\x98h\xd38\f\x1d\x87\x9eBH\x12\x15\xa1\x90Є\xe8c\a?\xe6_\x8d\a틭\x89ޖ\xa9\xb6\xe3J\xe5x\xf2\x84\u007f\xc0\x86\x1c\x87\xfa.ē\xe3\xb4\x14ߑ\xb0f\xa6[\xd4\xed\f\x97&Q\x10\x13\xf5h\x8e\x00\xb4\x96\"\xc0\x1c\xd0v\x1e\r\x02\xa9\x152I\x10\r\xfd\u007f\xeb#\xcf2d\xa2)On\x9c\xf17\xa7\x14\xba\xaa\xb1YFRU\x13\x02\x82\xdb2!Z\x04y\xc6H$\xb1\x05\xdf\x1a\x19C\xb2\x05\x9a\x1f\xc3ު\x81e\xa2\xd3\x0f[\xfcQ</ʸq\x9d\x1d*\xe3&\vZY\x87n8\xba[w\xa9ز\x90!\x04*k\x9b\xe9\xd9\xe3\x93t\xe2\xe2\xcb\xc64\x8a&N\x1d\x9b\xd5\xd0\fN~\xe0\xc35\x9bɉ\xe8C\xc5$\xa0\x01\x97\xa7\xf84\xa1\xea\x14\xd8<\x89\x01\xd0\x03\x87\x9b\x05\b2\xe0\xb5d\xb0}\x86\xa4\xd3\"\x19\x95h\x95&\xc8,E&q\x8emت\xbeM\x84\x95o\x9fEf\t\xc3\xc3ˌ\r^@P\x15\x05\xca{\xb2\xaa\x9a\x98\xad1\x19\xc0\x11O\u007f~\xa0\x80Ǒ\xccfY\xb1\xa3\x99\x838\xa9\x97\xc8؟\x91H\x89\r~\x82\x16\x8c\x17L\n\x17\x01\xc2ƶ\x97\xc6\xc4\x10ٟ\x8e\x92\xc60\xf9\xb8P\xf6\x8f\xb0\xa2f@\x99l\x9cn,LE(%\xd5z\xe23R\x15a\x94\xca\xda34\xe4t\xa0\x00Z\x9c\x9e\x179\u0588 \xa0\x9a\x9b\xa2j\x17\xff\xc8<\x88q\xf4)\x04\"\x89yO\xa8\r\xde\xd7T\xb9\xa0,R\xb4j\x17\xe8,\x9b\x9c\xb8\x1c\xb4\xc3;\x17\x0fM\xf1\xa7\xa70\xa4Sm8'D\xa1\x8c\x85,\x04V)l\x9a\xa2\x8c\xc18ߔ7E\x1c\xad\xf1\x1d\xe0\x86\xf1\xae\x1bՆ<ѤZ1\xaa\r\xa9֔\xceNv\x9b\xf3\x9e\xdaY\x8aDO\xa6?\x10@\xc8\xe8\x1b\xdaY\x16\xb3\xfa\x06q\xae\x9b\x8acm-\xa9\xd6\x0f\"\x90اX\xd6\x06\x82\x8b\xcdl\x8dO\xb2Α\xe9h!R\xa5'\xc3\xc5{\x8d\xf6\xff\xa3\b1\x04\x8c\x0f(!O[!)\x18혧jeg\bCH\x89L\xd3h\x81Ǝ}<9(KQ\x9d\x16\xc2{\xacuh\xd9\x1c\x157\xfeT\xd1@\v\xa1\xc1\x94\x83\x8dS\x9f\x83\x94\xa4\xb3Sxc)O\xac\x9f\xe9\x13\x8a\x82|\x9c\x90Iy\xe6\x8e\x19\xdfL\U000fb718\xae\xc0\xfah\xc4\x0e=E\xaa\x14\xa5\x88\xef\x1fGO\x93\x00b\x02\x96l\xa9\x85\x8c\x13\xad\xc6\x17\xa8\xa6\xec\xca\xd8\xf45BRR\x9e\x91\xb5\x8f/+Q2\xb6G\x8cY0\xc6L\xb4C5L\xc6@\xdc4\x95ug\xf8\x8d3a$\x9ap\\\x80\x96\f\x96\xb6a\xf2\xf4\f\xf3\xa4\xb2\f\xddn\xc5\x13\xad\x1f\x90I\xb0e\xd3\x06)QZ\xc4c\xfa\xe2\xe9d\x12\x99&\xf1\xe0\xc6\xc6Å\xe0\xf1\xd6\xe2\x8d\xc5\xdbH\xf5̶\xcf\x12\x9c\xa7Z\xde<\x9dKH\x89\xc92\xc4h4\xf9\x02!\x9a\xb1\xde[\xa2\x9b3\xb0\xaf\x06\xb7\xda\x18\x0e\x99k\xb5(\x12͠6\x9c\xc6\xd6b\xe4R\x1a\x8bM\x93x\x94E\x93K\xb8&Ė\"B,i\x03\xfb\a┈\xa4I.\x13\xf9\xe0lt%\x15BDA\x99->e\xec\x1bBs\xccƠ\xaa\xffQ\x11j\xab\xf5\x1a\x87\xc5\b\x18n\x9f\xc5d\xc9\xe9\x8d-%\xba(Нv\x9c\x13\xdcH\xc3\x1b;9\\9Ĉ!\xfa\xdf\xd1(\x9a\xd4\x10\x02\"M\xd0\xed\x16R\xca\xd8*<\x1e\x14\xe3@6\x93\x9b\x85\x94\xfc\u007f\x8d]\xd9n#9\f,\x92\x92\xdam;\xc9d\x81]\xec\xfe\xff\a\xee13>Z\a\xf7\x81\x94Z\xf6L\x06\t\x90\xb7\x00v$Q\xac*\x96\xc8V\xec\xca8\xfc\xf1\x8e\x96+\xf2?\xdf\xf6'\t)\xe1\xd5\xdb\xee\xa5.c?\xfd\a:1\xf3\x0eӷR\xf1\xef\xed\x86S\x8ac!\xd5I!\xbb9\xe2\xeb=\xe3\x10\x8ds$a\xdcJ\x19dQ\x9d\x13X\xed\x1e\xd6\x1a\xca\xf3N\xfe\xa8\r8\xd9ɮ\xd5rT\x1a\xe3\x8dtx\x00.9[\xffx'\xc5\xe3\x1a\x9d\xaa\x9e=Z\xb7\xb7\x13r\n\x0fD\x86\x97\x84\xf0z\x86\xc48\xf8\x1d\x00;\xf0\xad\xd9ƴ\x06\x12k[E\xc7\x15\xe5\xdb\x05A\x96\x05\xe9\xed\xc5\x06\xfeb\xdf\xc5V\nH,\xc97U\xb0\xf8\"\x89 \u007foX\xff\xfa\rm˨\x17\xeb8\xba1C\x83\xf5Q\xef\x11\xc1\xfeF\x10S'\xa0\xf9tu$c\xd3\x10h\xf8\xb4\xf2t(lz\x828\x96P\xb7\x85Z\x92/\xbeX\xb5\xe9\xe0+\x91\r\xed\xb5\x0f\xa4\x8cN\xa3\xa2\xfb\x87\xa3\xc8`\xef\xbd>\xae\xa8\xaey\xb5\x87\x92\xed\xcc\xd6fUi;\xadC.\xea\xa1\x14\xcf'\x84C\x02\xa7\x04u\xb5B\x15\xd6:=\bH\x04\xe5r\xf5h\"@قby\u007f{\xd8\f\x8e\xc1 \xd8\xf9h\xf9@\x1c\x12\xfb\xf4e\x12\xb1\xab\x8d\x19\xeb\x9f\xef\xc6]\xfc\x8bܓճ\xf7d\xba\u007f\xfdY+\xf2N:\xfeK\xc3\xe7\xdbu%\xcf^#\x82r\xb5\x11b\xc5\xdf\x012р\xc4\x04\x1ap\xbbW\xf7\xa2\xf0/FH\xb8L\xd2\xeb\x1f\x8e\fgFo\xc4sGW]q\xa8ӝ?\xdce\x87\x84*\f\x95]*\x89瓵4_\xd2C\xddw&\x8fČx:Z\xc4t\xd5@\x18,K\x9a\x12P4\xf8\x1b,\x9fķ3d\xb1&\xf6\x9c\xd2\xf8@\x896\xaf\x96S\xc4\xf2\xfb\xeb\x00\x05\x97\x18A>\xb3I\xf1h\f\xe8WX\x9dF\xda\xf5<@0suu\xa8\xdbO}\x97W\x8a\xbf^\xaa\xdaF\x93\x98\xee\xe1b\xdaU\x8a\xaegm\x0f\xa0\xe0\x17?\xaac\xccR\x9b\xb9\xd2\xc4=\x82\x03\x1cz\x94\xf4\xc6\xdf\xd6u\xe9\xe2\x9d/,#\xbd\x9eA\xc2h[\xb6q\x1a P\b\x86\xacf>B\x04\x8e\xe1\x01\xf8\x84\x81\x8b\x83 \xac\x87\xbd\x90\xe4\xf7\x1c\x9dW\x94\xeb\x1dT*X\x04\xadT\x8b\x92`MYd\x89\x88_\x8e\xc8\u007f\u007fG#\xc2\xe5p\xc0\xc9k\xed\xd2\v\xe3sE\xcc\x17\xd8\xea\xd0v\xe2;t\xeczR\xd7{r\xad8\xc4`nA\xa8;@\b\xa7\xc8\xce\xda\xe1\x9d\xe4\x8c\xfd?3\xeag!\xf3g\xf2\x0f\xb9M\x95\x85=/\xf9\xbc*\x98JLOp}Fy\x04@c\xf0)\xd4.D\xbe\x1c!\xcbb\x1b\xd4\x14$v\xb8\xb9\xc3\\\aKh\x8a揅ºZ\xb3\xb7R|C\x98\r\t\xe0\x19;G\xd4-#\xac\ah)h\x9b\x80\x9a\xf5\a\xe1\x18\x90\xbf_Lh;\xad\xa8\xd7\r\xed\x96q\r\x01iY\x90\xee\xf7\xa1\xb2b2;t߮>Xj\xec\x1d\bOU>!Ƶ\xe6!\xc7\xf7\xae\xd7\xfde\xd51FT\xb5\xe1\xf5\xcd\xc7%\xb5Z?\xe5}j>\xd4e,\U000934e4\xa9\x1d\x98\x9e\x8bf\t\x88\x9e\x0eWc\x86N\xd2I<\x1dm\x03\xfc\xe6\xf9\xe1@\x90E\x11\x18ֱbIh9CK\x81\xb6\xb4\x0f$՟\x18\xc1\x88\b\xe2\x1dy\xc2q\x1d9\x04\xaa`\x8f\x12\xf5\xc1\x90rJc\xb0\xef\u007f˂\x16~\xacE\xebd\x9a+\xb5B\x84!bWB.\x15\xbaC \xfbLf\xdcr\xc6\x12\xdc\x1f\xe5W\xe3\xd5\x1ff\xdeK\x1dW\xd8g=ʭ\xed\x8e\x15\xf2\xe7\r}cz\xc1\xaa\aP\xd56*\x83s\xa4\xf49\xb9D@\x13\x86z\xf7\bN\t\x1c'\xe8\xfb\x89^\xf0D\x80\xa4\x84p\\-5T\x0f\x9bz\xbb\x1b\x14{, [ȉ\t\x8fC^q\x16/\x8b\x8d*R\x97H\xe4\xbc\x00l\x8c\xf5\xeb\xe1\x008g\xe827\xd1nx\xa8ꒇN\x8fa\xdc\\P\xd5\xfai\x19\xe2\xb2E\x1f\xe5l_\xb4~=\x95f\x8d\x98\xcb'&\xdd蓝\x89}:u{\"~\xc5{k\xf5\x12B\xaf`ʴ\xc0\xbd\xd4\xd0\xc2\xce\xdbҗ\x17\x103\xea\xf5\x8a\xb6\xe5\x0f\xf3\xd6\xc3\xef\xfc\xbd\x88\xf0?\x10\x81ڔ\xe5vg\x98\x00\x00\x00\x00IEND\xaeB`\x82")
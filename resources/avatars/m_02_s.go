@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_02_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00.TzTXtRaw profile type exif\x00\x00xڭ\x9ci\x96\x1c\xb9\x91\xad\xffc\x15Z\x02\x06ô\x1c\x8c\xe7\xbc\x1d\xf4\xf2\xfb\xbb\x88L\x16\xab\x8a,I\xeftQb2#<<\xdc\x01\xb3;\x18\f\xee\xce\xff\xfc\xbf\xeb\xfe\xf5\xaf\u007f\x05\u07fcw\x96k+\xbd\x14\xcf\u007f֭\xc7\xc1?\x9a\xff\xe3\xbf\xf3\xf5\xf3\xf3Z\xf0\xf6\xfe\xfe\xfe/|\xfd\x1d\xdc/߈\xfcL\xfcL\x9f\x17\xab}\xbd\x9b\xbe^\xff>\xbe\xfc\xf8ɉ~\xf1F\xc8\u007f\xf9@\xfa\xf15\xf1\xe7/\xae\xe3\xeb\xf5\xe8㟮h\xdc\xd8\xfd\xcf\xff\xb5?\xfe\u007f\xefn\xf7\x9e\xcf\xdd\r+\fC\xf9\xdc\xd4\xe7+\xdc\xf7i8p2J\xe9}\xac\xf0\xa7\xf2\xff̿\xeb\xfb\xd3\xf9\xd3\xfc\xf0+\x98\xdf~\xf9ɟ\x15z\x88!\xf9\x1b,l\x17F\xb8\xe1\x84\xcd\xcf\x15\x16\xd7h\xf1\xc4\xca\xcf\x18WLﵖj\xecq%\x9fB2\xfd\t7\xd6\xd4\xd3N-Ŵ\xe2I)\x99K\xf1ǵ\x84\xf7\xbd\xfd}\xdf\n\x8doށCc\xe0d\x81\x8f\xfc\xe3\x1f\xf7\xef\x0e\xf8O\xfeܻ<c\x14\x14P\xe1k\xac\xb8\xae\x185\x0fAØ\xf47\x871!\xe1~\xcd[~\x03\xfc\xfd\xe7\xc7\u007f\ue9c9M\xcc`~\xc3ܸ\xc1\xe1\xe7\xe7\x143\x87?b+\xbd\x00H\x1c\x97\xf9\xf9\x89\xafP\xb7f-\xbe(1\xbe;s1!1\x05\xbe\x84\x94C\t\xbe\xc6XC\xb0\x14\x1b\x134\xb8\xf2\x98,Nf \xe4\x1c7\x17\x19-\xa5\xc2\xdc4\xe2\x88\xef\xe635\xbccc\x8e\x9f\xd7I\x15\xe6'\xa7\x92*s\xd3\xd3`\xb2\xcc2\xf1S\xad\x11C#\xa7l9\xe7\x92kn\xb9\xe7\xe1J*Vr)\xa5\x16\xe5ܨ\xa9Z͵\xd4Z[\xedu\xb4Ԭ\xe5VZm\xad\xf56z쉔̽\xf4\xda[\xef}\f\xbes\x98\x1by\xf0\xe9\xc1\x11c\xcc8Ӵ\x99g\x99u\xb6\xd9\xe7X\x84ϲ\x95WYu\xb5\xd5\xd7\xd8q\xa7m;\xef\xb2\xebn\xbb\xefq\xc2!\x94ܱ\x93O9\xf5\xb4\xd3ϸ\xc4\xdaM\xd7n\xbe\xe5\xd6\xdbn\xbf\xe3Ǭ}\xcd\xea\xdf\xfe\xfc\x17\xb3\x16\xbef-\xbe\x99\xd2q\xf5Ǭ\xf1j\xad:\xd1;E\x10\xced\xcd\x193\x16-0\xe3U3@@G͙o\xc1,j\xe64g\xbeG\xb2\"G.2knv\xf0Å\xc2\x14\xda\t1\xdf\xf0c\xee\xfe\x98\xb9\xffx\xde\x1cc\xfd\xef\xe6-\xfe'3\xe74u\xff\a3\x17\xddI\u007f\x99\xb7_\xcc\xda\x16\x12\xae7c\x9f,Ԙ\xfaD\xf6q̈\x8d\xff1\xd8_\xff\xf0c\xec\xd1\xf2\xb5<j\xe6\xbakNqN+\xbd\xad\xc6\xe4\xf4c-\xec{&c0\x17P\x99\xca\xe5D\x8ba\xdf5ݳ]\xab\xf7\xb4\xdbN\xf3I/\x84Tv\xe2\xa5\xda\xf2\xe6R\ue2c1\x9c\x81\x00\xdfg\xe9\xfc&$\xe3D\x97/\xbd'\x8e\t\f\xfaط3B\x8d\xe1\xb8+\x96=>\xdf§\xf9\xc0<\xa1\xde\xdb\xc6\xf8\xfd\xe9\xebʉϯ\r\x0f\xb8=\xf6o\xbf\r\xb4o\xb6\xa7qD\xe7\xb4U_S\x178v\xc54\xc4\v#q\x9a\xe5\xd4\xcaq\t,\xe1>[\xe6\x98=\xf2\xff\xf7I]\x80\x9c⩅p\xb1;\xb2\xcd[j_\x8cb+\xb6\xea8;\xbd\x9b\xcf\xef\xa6\xff\xe1\xf6\xdd\xef￦rF\x9b\xe4X\x8a\x9d\xdfz\xd8i\xe6\xac\xd8\xe2b\xfc.\xa7\xf7|Z\x85\xe9O!\xb2\x97'T\xe6\xef\a4\xceQ9\xab]\xbf\x88\xda:C\x1e\x8b\xbc\x19D*s/\xcc=\xa1\x01\xfe\xfcF~BRg\x1e\x82\x978UR$\x02\xa6\xc6`\xb9\x87\xb9\xe6\xaa\xfb(\v9\xa8\xb7ps\xba\xad%@\xa84\x18qݙ 4\x17\xd7ͱ\x12͵\xec\x1bKj\x8bX\x83\xf2\xfa\x9a\xed\xe6@f\x0e.̶?\f\u007f\"h\xf7\xaae\x8e\xd6f\xc9m(y\x93ir\xc0\xec\xben\x987\xf4\xdb\xed\xd6q{\xe2\xabv1\xe6\x8eۯk\xee\xc6\xc9\xe38\xc0\bo\xf0K\x85\x91\xb8\nRnr}Ff\xdd\x1bܮ+\x85\xd1\xf3\x8eyr\xef\x81{\x9f\xf3\xd4w\xe7\xcd\x13%W\xf7=f\x8d\xccQdpw\t\xc5\xc7\x1b\x95ַ\xb45ZYъ\xe3\xc8D\x8c\x94\xf9\x06\x89\x89!\x16\xce\xcaJ\xe1\vL\xce\xce\x04\x11f\xfc\x96G>\xd1s)\xdc ?\x97Ł\xc8\xe0p0%L7\x19\xf2\r\xa2u\xc6p\x8f\xda\x0e\x182\xf7Dƕv8\x9cO2\x1b\xd9/\xa6\x1d\xa1\xb2\x18͔\xab\xe0\x81S4p\x92\xf9]d\xba\xe3\xdcFH\x10\xc6\xe7\x90\xf1\x9e\t\x89\xe0\xd7\t\xa2\xee1\x1bS\x94\x1a\x1f\xe12\x99\xceSw:=\xb6\x99/\xfa\x85\xe3\xd6\xf4@\x0f\xf0쬄Yε\xd9\r \xde\\\x1aBl\x038\xccF\xce\x1e\x80\x98;\n!bWx\x03\xdf\x1d\f\xb1z\v\xb1\x90\x14\xe5\x16l\xc6\xeb\x10\x12\f\xd3\r`\u05fc\xff\xf4Q\xf0\x9b\u05cd\xf4\xd9v\x16C\xc5\xdd}ށ\xfcGs\x80l\x1a\xab\xb5q\x9a\x14X+\x19X;H\x813@\x19 -\x13\x96\xe1W?k\u007fYW\x80\xb9\x1e\xcdU\xc1\bJK/\"\v\xc2&\x89\xdf1\xef\b\xa01\xda;\x84wu\xd0;Dy\xcfPv\x02\xc7@\x8c\aH\xce\xda\f\x89\x18\xdf\xde\xee\xf6\xa5\f#\xe4\xadq\x95\xdcp&\xd9;\x83Qnl|̓+eB\x1eg\x04\xb4h\xe8\xb9\x06\x80fǢ\\\x9b\xedln\t\x199\vǑ\x04\x99\xe0X\\\xee\x01\xfcn\xf1\xfa\x1a^n\xb0\x8a\xe9\xaeϻ\xbey\xf47\xf9\x9e\xd3\xc8\xe4\xab\vw\xf6n\xfe\x1a\x18\xa4\xb7\b\xbb\x1av\x13\x88ܸ\xe7\x1a\xe8\u0530:כ\be\x8b\x8b8͛ȩ\x91˂Q\x0f!~Rp\x99\xac\b]\x17zaP\x94j!\xaf|\x99\xb1\x91\xc3\x16Cc\x0e\xb9\fb\x16\x8e\xef\xc4\\\x1b\xb9\\r\xa9\x1c\x85'\xd0pҞc\xbb\b\xe7\xf3A\x92\xd2\xf7L$-]\f\xe3\x97+Q\x05\x84\xc1F\xf1\x06\u007f\x19\x06\x00zfƐiC\x15\x00\u008b4\v\xab\x04\x13\xb0\x01\xb8\x00\x84\x11˅\x18\xf2\x05\x89\xcdW\x01W\x93\xf4.@Ļ\xe0\x8a\xd2\xf6\xd9\bz\xb47\t\xbe\xc7b\xaa\x0exy\xf3\xa9\xa4\xc2p-\xe5\xb2cg>\x13\xea\x04g\xc0\r i\x88;\x86rޙ}o{\xc40\x05@sq\x8eH\xe6qb\xf2Y\xb9s\xd75q\xab\xcbV\xe7D\xbe\x902\f9\xdf\x03\xbe\xb52\xa2R\x97\xf1:\x1bH\xe8\xe8\x9d'\x0ePB\x95|B\x89\xa2\x0f\xee\f\x8c>\x87{C\x15\xb8v\x87\xa0j\x11\x10\x93`\xe7מ\xa7\xd0AN\xa6\x85\xd9IWF\xa6\x97u\xa0\xf4\xa2\xeb&\xc5%BJ̌\xca~\x84\xc4`\xdb\"\x96\x89!.\xb5\xf8\x1bת\x0f`\xe5\x06}bȉ\x80ø\xccT\x03S:Oꤔ\x9f|\x1d\xe9\xd7H\xdd\xd5\xf2!\xbd\bH\xe1מ(\x03f\x83\xe1g\x0eێ\b\x120\"\xc3?Kb\x84\xc3W\x02v\xa7.\x87\x90\x05_\a\xe1z\n\x97\n\xa1\xaeQ\x1c\xf8T\x90\x84-\x13\x97\x92)\x01\x8e\xccƛ@.\xc07\x11\x17\x83\xc8\xee\x80~8Kx\xc55\x1a\xa8\x80\xae\u007fIے\x14\x86w\x17\xeb\xd4\x1fQ\x03SS\xe0\x01@t\xaf\xbfO\x84\u007f\xf3\xca}\xc4\b*\x80W}\x15nmo\xc2S\x99Y\x89\x11\x14'\xcc\x1f\x8e\xf3\x1b[p\xe5\x11b\xec\xa3F\x9c]\x8b\xba\xbb\xb3\xd60\xf0\xbb\xe8\x94c\x8c\x10\xfa\xa9\xabp\xfa\ag\x1c\xc2릿\xf9\xadu7&\x90\xc9\xf5օ\xe58\x03\xce\n\xf3%w\xed=dD$W\x1e}gR\xd76X\xb1o\\\xe3\x04m\xa2dA\xf7\x85\xc9\x06\xb6d!\x98a\v\xb5m\xe2\x9dx\xf6\xbb+\xb6\x81\xb6\x03=\x0f\x80#\xf9\x92`\xfc\xf8\xae\xe3\x9c\ag\x18\x9c\xa1\x80\xad\"\xcd\xc3\xdc4W\xaa\x8d\xce\xf83\x12렕\xeb\x06+\xe0w\x80\xb2\x9e\x80\x80\xe4\xbaP\xb9\x17\xc0\x88\xed\x89\x19\xa2\x8d\xb8\xfa+f\xba߃'\x9a\xa6\xfe[\xec\xfc>\x04s\xfc\r\x9d8k\xdb\x044,\xeb\x91\ahRD@_\xc8Z4X\x1c\vk!͂F]\x92\xc0\xe0\x1aº\xe5*~<\xe8\xa3\f\xf7\x104Y\b\x00\xbd\x83xDrO\x85\xf1\xb5\x13CD\xe3r2\x14\xcd\x1a\x99y\xd4\xc4p\x87\v\x11\x8c\\ \x12\xd7Blsu\x0e\xa4\x85\xe8\x99ѱ3\xf8\tt\x14\x10\xa7d\xa4\x18\x9f\x8f\xa8\xf9I\xa8\xa4\xd1\xf8\x1b\xd8Z\xf5pq\x12(5\xc1_\xfb\x10\x9b\x8cqG\xb1uf$1\xe1 +\x8e?\xf1\x81įS\xa3@\xf8\xb4\x1e\x11<\x1d\xd4C\x14i\f\f^F\xbb\x01\xcc#I-^\u007f\xb8\xceV\x1cY\x00\xc6LBhO\xec\x06\xea~1L\x17XǯL\xe6\x10$\x82\xd6\xf9\x1dѲ\xc9\xfe\f\xa1t\xb8\xa75ι\x8e\xe2mi\x8c\xa0&D\xe2B%T|H\x87\x8bf\xc3\xe3\x00\xb9\x1e-!31@\x91\f\th\x88W\a\xd8\xd1\\\x01\xa4)\xb77D+\xa1\x93\x0eW4\x9aU\xe61\xac\t\xd6B\xb9\b\x1a\xc0\xc9@\xf2\x8a\x9d\x04L\xc1\xcdY\x11\xb4\x8c<\xb3B~נ\xb2\xce\x05\xb4\r\xf0\fص\xb3wtr>\xfeHyD_\xc87&\x01ZT\x1dD\x93\x1a0Ǹ\xb1\xdc\xdf5_\x84$\x83fhO\xa8\x80\t\xd1mz2#KCF\xcbe\xcdW4A\x1d\x95\xdeI(\x03f\xd1%\xf02\xf1\x15+\x12\x94A\xc5\xf9\xa5@J\xe2\x12\xb9\x021\r\x91\xc9\t\x95\xd6\v\xbfvs߭\x93.+\xf5\x9a\x90\x1b\xd0O\x1d]\x92,A\u007f\r\x8e \xedAH`\n\xd2D硴\xac\x17\x00-`\x99\x98\xfav\x0e\x8a\x8d\x88>\x8cPF\rB:p\x134\xa3\xeaM\x90nb\x88\x8fx\x90\xa8\x0ex\tٴ\f\xa7$\xce\b\xf5p\x14\xfa\xbf\\\x12ۼCv\x8b\\\x01gЂ\x11F\t\ny\x00\xc8 \x8c\xcc\xc52\x90\x87+\x8f\x8c1W\x98\xd2\a\x812\xd7ww_\b;H\xeex\x87\xf4\xad\x92\xd8\x1b=\x87o]p\b\x11Y\xb31\xb0\xc2eR\x81\xf7\t)9\t\x9c,\x9a\x82\x9b\x03\x196_\x10\x99\x830\xd0̫\xb9\xa4\x13\x89\x86V$*1\x96\xa8\x16\xe4'֕\x81\x81\xdc\xcb(~-.\x9bl\x8dH\xe4\xa8\x11\x9e\xcc?0\x84\n%\xcb\xe1\xda8\xba3\xab\x88\"\f6/\x17d\b\xb4\xc9U\x8b0\xc8a\x10\xa1\xcaǠ\x91\xe4JLn&`\x91g\x96`\xf9\x00\x8c\xb2\xb3\x9e누\x9d\x1e\x0e1\x10c\x18s\x8cBd\xb2\t\xf1\xda\xe4\xf5I-L\x8c\U001047f1Tql/0L\x97\xf4\xbe\xdf\xf8\xe7\x00\xa8/\b|\xe5C\x81\xe0\x17\x04.\xffl3\x90\xfa\xb7\x9f\x90\x14)\xfc\xbe\x9e\U0003c409N4\x91F\xa4\xe4\x01|\xa1폃\x06q䟟\xc9;\xfb'\x03\rl \a\xb6!\x80\xf7\xe6\x92\xd0i\x19\xd8\x19\xd0\x11R^\x18\x88\x94Bf\x9cٹ\xa7Hȓ~\xb5KuK\xcc1+\t\xbb\tv\xca=\xc1\x96]E\x01U$<\x02\xbe\xacV\xabK\xfcWQ(\x99\xcc%\xd7\f\xb1\xc7\x01i\x82N\xa0\x1f\x19<\nуn\x94\x89\xda\x00\x1d\xc7\x10Ԫ\xa7\x15\xb1!F\xc9Z\xc0Ԅ\xbe\t\x94J.\xa3\rӈ\x13\x94\xd9P\xbd\xd0\x11j.\xdc\x11\n\x8e\x04\x05oPu\xe0_A!\xe0\x8fb1\x86\x99\xef\xe1[\x99(\x87\xcb@\x82H\xfbˤ\\\xae\xc7۬=\xa2\xc5\x1a\xdfX\xe5C1C8\x9c\r<\xc1\xeaA\xc9\x02\xd4A]\xa9C\xf4\x85\v\x03۱\xeb\x92\xceQP\x8d\xc2\x02\xb6\x0ew)v\x05\x80&\xd3\x0e\xba\x01\x15%\x95ũ\a\xa3\xda\x12\x029\x91\xe8Q\xf3A\x88b\x85\xf7M\x0e\xe1ƽ`*\aQM\f\xc18\xd5\x10\xb0-\x83\xfd\x83T2\xe54\xe8C\xa0\xa2\x1e\x13#\xff\xfc\xeaA\xea$\xd88\xa1\xaf\x98\xf6\xe2r%\x05\x10\xb4{0O\x8d\x97\vN\xe6\b\xbe\xf6\x02\x16\xc1U\x8f*\xc3\xe4\f/]\x05t\x9c\x8c\xe8\xeb\xb7\xe0\xd5Hv\xddr&\xa9\x1c\xa0\xe3\x01\xb1\xb0\x02\x13\f\x14i\xe2\"4\x83MP\x85\xa8MП\xa0\xca\xcc(c\xe4\xe3~$\xf9)' \x03\xd7m\xc4\xe4\xacجy\xf0\xc8\vIӗ\xc2\x00r\xa9\xe4\xd12r\x80\xfc\xbe\xcc[\x1d\xb1H\xd71\xd3\x02\xb3\xbdv\xba\x92\xd2\xcc7w7\x01JĿ\x8b\xd0L\xd7\x11\xe9\xb1s\xc5 \x16\xa5'\t2\x90\x1b\xc4'\xc0\x8cӆ\x82\"\xa9ʈ\x18L4,\b\x9eϒ\xbcb \xd3p=B\xa2\x8dkA\f\xe1\xb4\x11\xf6\b1νt\x926\x985\xe2Ń\x0e\x84\x16\x03\xbe\x91`H\x04\xa4\x8fG\x1c\x1d8/\x11\xa5\xe3,n-m\xec\xf6\x84V\x96\xac\x1f\xba\xa8\x8b\xf61\x92\x86\xec!\x9f\x8f\x16\t\xc8@<\xc9x\xc4\x18\xc4\t\x86y8\xa8\x11\x94ל>_7\xb1\xd7\u008bB\xe0\xd7E\x00\"\xfd\x0e\xe1/\x17LX4\x19x\x84\xd6\xd8\xe8\xa7t\xb5N\x91p\xde'\\h\x88\x1bKek\n\xefpG\x15>0ÞH\"{\xff\xf63/T\x0f\xa3\xad\t\xed\u007f\xf3\x9bL\xc7{߭_\x1f\xf0$\x93L\xa8\x1d]L\xf3;C;$rb6\xb9V\xd3\x12\x0e\xe9W\x18?ɁƉj\x00]\x9a\x90U:\x9c\xf1\xcaQ\x83\xa9!P-\x03\xf3Hz\x9c\xdc\x02\x84\x82\x83BN\x00\"\x03\xe7\x19#\x0e\x01\xeeC$\xe4\xe5ꕚT\xf9\x81ٴU\xb8\f/\x8c\xeax\xac\\\x84\u074cR\xab\xa4P\x01\x14\x9eq\x1d\x15\xa6ˆـ\x99\x81\x88\xea\xd1Ү\xf19\x1c\r/M\\c\xe2\x84\xc8S\xee\x8b!D\xdfHT\xe1}{\xc0\xff4\x9c\n\x8a\xf1\x1ayrq\x12hi\x8eGR\xe0\xc2\xf6rH&\xc4#\xaa\x1f.]\x12N-\x97q\xfa\x96\x98쩢]:j\xa9\\4\xdfL\xa4\xcc\x06q\xb7'Tp\xa4\n7\xf2\x01ov\xba\x03U\x89B\xe0E\xcbF\x90\x05\xcc\xc0Ȭ\x8eR#\v\xc0Ft,L\xd1P\x9c\x9a\x009it\x18\x0e\x10\xe9t\v\x84\f\xd8\\\xee\t\xa6\x15i\xa3\xe3\x80)\xdc\xffT\x1e\xce6\x0e\x86\u007f\xbc\x12a\x19d\x123\xc2(\xa5\t\xfd\xd6,\xa1z\x02\xbcU\x11\x94ؕ\x85u\x1b\x8e\t\xd4M\x1dUZ\xc0\xf1(M4&9\x8f\xdc\x02\\\x19\xfd\xc9-\xa0\x1c\x9a\n\x1d\xaa\x00\xc0\x11 fj\xcc&Ȧ\xbc\xa9\f\x95[\xd8p\x94\x9dMcJ\xb0\x8bX\xaf\t7\x10\x11\xc1\xe6(\xa4H\a\xa6p\x93\xb2\xc0\x18p8\x9f9fh\x02ôЉ\xa0\x1e\xeei;BDU\x8e\xac:w_ƍ\xaat\xcb;\xa7lI\xc7r\x11\xcf\x03\x14SM\xcbTT@L\x82D\x05\xda\xc1\xaa\xd7\xfeNM\xae\xe1V6\x142I$\xeeƐ\xfc@k5\xa4\aJF~\xb1$\x8c\x19H\x00m\x826\x90\x03\xb0\x0f\xf0\xa10\x03\x9c\x8a\xc4\x04^j\x86א\xf3\x8c]\xee\x924=K\x1c\x92\xc3\xe4\x02\"\r\x9cC$\xe1m\x9f\xdaO\f\x9d\fS^h8!\x12\t\x8cr\xe9\a\xed;\x96C2\x134\alb(\x13\x14\x95=\xb8\x82\xa0\xd6yo\xe9\x17\x0f\x06P\xe2\xd4\xf1b\xf7\xf0\xcaF'?֗\xee\xdeZ\x86\xc0\x8d\x067\x88\x97\xa4\x12\x1a\xc0\x8d\xdf\x14.pn\xa6;@Q{\xa7\xf0ϕ\xa8\x1fN\xc9\xfd\xbd\xcc\xf4ܔ\xe7_(\x92Y\x17B\x00G\b\x1eޭ\x8a\xedEj|\xc0\xa1#\xf4$\x1a\xf1C\xe84Ԉ\xca\xf78\x9a\xe9\t8\xa6\xa2\xc8\n\xa3\u05c9\xa3\xf8J\xdd\xd0IA\x143\x18&\x01\x1f\xf1>\xb2ޤ\xe0Y \v\x87\x830\xd5\x01\xa0\xe4-X\x8f7\xe5\xd6T\xc7H\x17w\x8b\xea\xdc\r\xa7.p\xb8\xe0-\x02M\x83\xd7\xee\xc1w\x112kx\xe2\x8e\xf9\xe7\xc7\xc21;\x92\x1eT\xbd0\xe7\x1d\x9fB\x14\x02\x04\xe0\xc7^\x84P0\x1cR\xf9\x9f\xc5],S9\x00/Z\x13\xbc\x15^d\x80V\xa5\xe6\x0e\x1e-\x92k\n\x94W\xf0\xb5\x13\xee\x9bыP<\x98\rG\xb7T\x804\xd4ޑ\x8c*\vc}PF;F8\x8b\xbb\xce\x1d\xf3\x9d\xe3B\xb0{d\x1fℓHgB\xb7\x80J\x15\xe3C\xd3\x12\x95\xe2\xf9\x14+_\x00\x82\xa8\n\x84r\xc5\x1f\x91x| \xe3_:\xff\xf3\xd3a\xc0\xb3\xd2\x0e\xf11nR\xe2uU\xace>\xb5\xce\xd2;\x0e\x1f\x99LHq\xcd`\x13\xa8\xcd\xe9&ʡzlo\xfb`ij\x0e\xed-\fb\x9cIS\xb9\xbd\x8e\aDV\x01R\rm!}\x80y=\x9a\x8d-\x15\x1b$\xe2\xc5\x00*\rhi\xb9\xe9U\xa4\x1f\xe8g\xc6\xedfT\x88Vo\x171\xfc\x96(4\xe6\xe8\x98*٠\xe5\bۂ\xf2\bwB\xf2\x80\xa9\xdf5o\xf1\u0a45ls\x98\xa0\x80\xac\x8b\xa8\x9e\x8d\bd\xc2'\x19\xd0\t\x17 \x9cX,\x1b\xd5\f\x88\xa9\x9c\x1f26\x85pc\xdcH?\x15\xf0\xb58'?l\xe6T99o\xd5\x03Z\xcf\xe50\xab\xb0,\xe0\xf0\x0fU\x88o\x91\xfe\xb3Fw\u007f\x12\xe9\xd9\xe2\x1f\xab[\u007f\xfd\x89\x94;8?\x10\x1a\x16xb\xfd'\x85~\xdcPE\x12\xddx\x80\x19\xec>\b?ǫjrY\bm\xa8\x03\a\x17\x04K\\*茘?\xe6\x15\xa5\x8asF\x1e\xcc`ܽ\x93\r\xd5\xe2%\x923\xe0\x8f#1\xbd\x17\xb1\x82\xda\x06\xcc\x00\t\x06\xd6\xe2\xac\u009f\x8f\x13\x90 \xc2\x14\xe2+1?b\xaf\x8c9\x8f\xae\x93]\xc3\x0ef\xa5r\xa2,;S=?\xd1T\x103\xf9s\xc5\x13A\xa2\x8ct\xb8\x93\x00Av2jI\xcb\xd9[+z\xa5\x90#\x0e\xfd\x00!\x9a\r\"\xf2\x88\xea\xb0\x12Q\xd5Z\xbe-\xc8\xf9\x92\x8ca\xc6Д\x84h\x04\x99\n\xad\xbc\xa8dYs(C\x8b\x115f\aƽ\x04\xed\x99!\xdd\xe4\x96\xca6\xc0\x89\xef\xb2f\\\x0ej*\xcf\x03c\f\xa1\x02\xe1@\xee\xb6\x0e\xa0\x10\x88k\xab\xfe\x02.\xf9\x8e]\xd72\xbc>\xd5\xf6\xca̟\xca\b\x89\x19&\x14\x01\xf3\xdd\xfb\x01\xc10:\xcdT\xb6\xb9\xf25\xa0\x17\xba\xcf:6\xbav\x93 \xb7\xe4\x10w\x1eK\xc3\xd4#\xbc&$\xa4\n\xac*\xee\xaaM\xaa\x18\xd9I\x88\x12\x11\x87\xaa\x13\u0090Z\xbb\xd4\xd4ǝ\xc7\xe4=\x02\x1bJ8\x0e\x8c\x98\xc1\x04&@\x1b\xd7Q\x18a-'ʚ\xd9Ȃ^U\x14{\xd3\xd0\xfb\xf2F\x98\xfbEL5\x8c\x12C\x80\xe1Z\x88\x0eG\xd6A\xe8\xcc\x11\x93\x01C\x16\r~\x9e\xc2?n\xf5Js\xc1\x920\r\xc6\x001\x841\xdf\f\nB\xb6\xf4\xd6ȩ&\b\xab\xc5\x06L\xabbGx\xf5\x12ƥƓ\xb5<\f\xb810\xa3`\xd6\x02:\xc76\n\x98\xd31\n\x1d\x16\xc3jtR\xbf\xa8\xdc'L\xc6M\xb8D\xd6'\x9d\xeb(\xbf.Y6\xf3Y7A\x8bh#b\xb9+\x03\xc8\xcfژ\xad[\xbc\xd6\xe5\xda<W\x95\x14-KxėN\x84)\xb2\xb4Py\x1dKG\xf0\x12DP*9\x05\x96B!S\xe5\f\xc9m\xf4\n\x96\x0e\xecB\xadI\xe1\xec\xf2\xd1o\xfc\xfb\x80\xa3\xc3m\xb5\xc20\x83\x90\xac\xe4\x17\x012\xba\xaa*x\x93\xc4eh\xdd\x11\xbfx!\xaf\xaf\x95\xa2\x18T\xa7\x19\xea1\xd04h\xbd\xefS\xf4%hg\xfak\xd5so\x0eFqe]앿=\xed\xad\x83 o\xe5\x02\xb0D\x04\xedT\x89\xe7AP\x8c\xee\x1d!\x1azG@\x97\x9fc\xd0U\xbfW\xf0\a\xe75\x99\u007fD\x0e*\x86\xf8\xc5b\xb8iߒ #^\x88NBg(\xb9\xd1\x1cH2Rz\xb5\x9fd|c\x02\xb8e\x06\x0eQ\x8eN\xe4\xa2/\x81R\xaeS\x88\x93O~\x81\xd7E\xc4t\x984,>\x00\x8a\xfea0\xaf\x12\x81O!\xad\xb4\xdcGp\x1d\x95\xdd\x11FI\xb57B\xbd\xa0S\xbaCY1j\xc8\x05>S\x84\x93M\xf5\xa7|\xb4\bz_\n\x00\x89\xdc\u007fWm\xae\x10\a(JP\x83\x8c\x8a\x9ed\xae\xf0\x82f\x951B\x8a\xa3\x88\xa7jTx\xbe\x11\xb2|\x95E-\x9frFSYիW\t\xa0\xc6\xe3s\x1fu+\xe5\x10\xc6\x1e\xb5\xd0*'\xd5l\xbb\xb1\xa0\xbc[\x91\xad\x1d\\c>\x83V\x99\x90\x02\xd8[\xe6Q\xb6\x85i\xd8+\xe0>:\xe2\x0fE\x1a\xf0]v\b\x97\xac\x10b\xd8\xc9\xf4\xe2\xf0\x11C]S\xc4>\x14*\x97\x88\x1d\x9bZ?\x8dj\x9aH\xcam\xdd^\x97#E\x04p8\xa9\x00_\xfa\x8eQ\xa8o\"\xb4\xb0\xe5\x00\xabMjk\xb5\x11I\xa9\x86\x12R\x87\x1b\x9b\xe4p\xd5\x1d\x13\xe2\\\x184\x8b\x93\x85\xa2\xa5\xba'N\xa0o\xd9`\x84\x18/\xaa\x9c氭\xa4\x03\xca\x10\x9b\xd3=c4\xa4\xf4\x01\xb7#m\xf6\x82\xf7\xad\xd6\tH\xca\xd3c\x84\xc1\x192\x03\x9eHV\xa9rj\r\xd1i\x11\xa0\xc9L\xc2\x1b8 \xdcD\"\xabq\x14(\x13l\xfbk#\t\xbe\x99\xe1\xa8\x19\x9e\xd5\xf9)\xad4\x98C\x0f\x9f\x16\xafN\xb1;\xdd'\xf0\xc7\xc0\x8b\xcb\xfd1\xf7\xa8\x18{\xec\x0fJ\x19^\x91/E--\x95\xfe\x02\xbc?/htV\x02\xca\xe17\xad\x13\xc1\x8a\xb2\x10${$\x02\xd52\x83-Y\xcaCyp\x94\vHJ,YlZS\xa8\xf9U9\x00\xaec\xaf\xe0\n\xf9\x891\x998\x19\xcd\xed@\x87\xa4j\xa7־b#P\xb5\x8c\r\xdd \xa5\xb0\xbd\b\x8b\x0392:5\x12\xe92&\v\xacC\xe1\xcbl\xccEl^\x14\xe5\x96\x1aav\x16Xf;L\u007fb\xe8\x02\xe9s\xd7G\xeaq\x11\xe0*\x1fH}\x92'p\xaea\x02\x13\xa2\x87\xc9\xc4\x1cK\x04z\xd4X6\xc7\x045\x14\x94i\xd9\xf6dy\x8c3烓0\xe4\x12\xb7\x16\xbe\xc0\xddS\x8e*\xfaZw{\x8d1\xc9\xec\xfas>-\t\xf8\x01\x87\xb9\x9e*\x01\x12\xea\\N\xd7\xc52.\x92wA\\:G\x80Q\x1a9\x82\x89E\xcb \xbb\xc1Wu9\xc8.5-\x1b\xe2~\xa3\x8au\f03\xa0\x10\xab\xb6U\xb5@\x05\xc0{D\t\xfc\tY\xc3\xe4\bI,U\xc36\xcd)\x19G\x06'\xb4\x15\xa7\v\xc2\n\xf1\x92\x81G\xf6mQ23ZQ\x17\xdb8\x0f\xf45Cb\xf2s\x13\xbco\x84\x8fJ\x1fS\xbd\n\xc4\x1e.2\xad(7\xc5\xd4㗚#f\xd10$\xb3\xa9#(\xe5iZA\xb3\x8d\xff\xaf\xe8\xed!\x91MVp;~\xb4\xaa\\ez&!\xa2EK\x84\x8c\x8c2A2А\x8b\x84\x1a\x000h\x81\xd2L\xef\xf2\xa5\x984X z\xf9;\xa2#\x8aI\x98\xa9n\x8c/\xc4\x1eP\xf6\xaf\x10\xfb\x1b\xd5\u007f\x03\xfap\xf3\xdf<\x97\xfb\xbfX\xc9\xd2\x01\xee\x95e\b\xc6n\xc2m\xee_`\x12\x8a\xd6\xd3E\xb2ЀdH\x06\x99\x9b*\xea@\x91\xb2\x05\x80Ko\xe9\x18+w@\xb3\x9dH\x11\x12\xa4h-\xe6\x80\x06\xa6\xf6\x14\xc9\xfeMګ<\xda_\xe5]\x95\xacE\f\x00\xa1\"sn\xf5<]\xb9\x11\xe1\xd6\fո\\\xad\x00\x11\x06\x1fJ\x0e\xa9\xaap\xc1d\xa9ކh\x05;\xf1|\xea/S\xf3@\xfe6b魊\xab+\xa9\x86/\xb5\xb4+\x04Ye\x9d\x8a\n\xebD\x94:J\xbc\xaaFxY\x82!\x9d\b\vA\x89\x84':\x8a\xeb\xdaM\n\xf4\x95\x1d\x06\xa6\x1az\u007f\xeb\xab\xd5\xc5\xfa\x8a\xd3d\x15z\xa4\xe3B+\xc6_k:\\\xbd\x98\x02\x93\xcf\b\x99\xa9-\x98\xe0Q\xc9OK;ܢ\xc4\x01\x9c\xdb\x1a\xe1w\x9b\v\xaa\xe7I0#h0%\x98\x9eϺkP\x05t_S9\xe6\x18鍯\xd3\xe8\xf9\xb0\x13\n\xfa\x12}Qm6\xa8\xac\x86\x90\xa8Z\x83\xe4Z\x18\f\x04\xd6\x15{\xab\x91\x82TPn\x9c$\xb8\x94\xc6\xff4R\b킪\xb38\x80\x8c6D\u007f\x11\xffXV\x04<'Ҫw\x9b\xb0O4\\a\x84Ϙ\x05\xd5ؚV\x11\xb9\xdc\xcdW3q\x15ឱ\x98\xa3\xceB\xb2\x89\x83\xabj\x03\x8b(P\xb7\x0fу\xba#\x97IUX\x99\xc4C\xe7\x11ɨ\xad\a`L\xbbJ\xad\x02IB\x84{\x06\x890!Z\x15\xad*\x86\xa8\xa0\x01u\x82GC\x9fۈ4\x90f\x1d\x95ye\x8d\xcf\xc2JB\xef\x18#\xfc\xbd\x97:\xdc*|gu\x016\xb9z\x04dEVZGE\x95\xe3\x00\xa3\xae\xea\x8bz!\xe0k\xb0C\xf5\x9c\x91=\x8e\x162J}\xdf)\x8b\xabR_\xc5C\xe8K\xd4\x1b\xa4\xbe\x8a\xa5\x95\xf1\x82\xcdƹ\xe0\xb2s^P\x93\xaak\x04\x94dC0\xad{\x03\x86K\x95\xa6\xc3\xc4\xdb\xd0Ң\x80\xe7jue\xa9}C\xf0X\xaeG\xe7t\x95\x9e\x9c\xaa\xab\xdc9\xc0o\xe7(\x178\x16\x93p B+\xc4\x1e\xb8\xa6\xa6<\xee\x1d\xc0\x1eK6\x04^\x85\xe0\xd0m\xdc\xec)\xb3\xaby\xa28\xec\x10\x8a\x9a\xa8R%\x1cŶ\x80\xbc\x82\x94e\xec\xeas\xc7$\xe6\xdcR¥\xc8\xf4\xe0\xce\xcf[\x8c#R1\x1e\xd7>h\xe0\xa1#l\"B\x1dr\x95\xa9U\x85\xeaFn\xea`\xfa0\x16h\xf0 \xca\xf8:\xfc/G\xe3\xb3T\x90\x1f\x04\xaf\xfb\xef,🗩\xfc\xfacy\xca\xfdu\x9d\xeaO\x8bP\xaf\x89\xf3{\tj\xee\xcfۯP\xf4\xb7\x03\xdc\xf7\"UiZ}\x17\x92^)\x87\xb7d\x8fq\xb7\xa3\x0e\x87\x84oLRa\xd2a\x03Qt\x99\xdeO7\f\xb34\xe1\xf1\xed \x0f\xfc\x049\x82D\xdaa\xe3\x92҂|\xa5\x05\xe0b-sl\xdc\xe6\xf4Xu.\x02\xa1\x88<&\x15s,*d\x11ƪV\x01\xaaZ]\xefIM\xa3`')ƌ\xab\x142\x92\u007fxM\xb8#\x10rd\xe8\xe7\x980\xa1\x1cP\xc13\t\x00\x05\xa6\x04W(L\xc9pح\x80\x9d\xdaqiq2\x89\xc8\x01\xda\xdbd\xb9\x1f\xe8]H_]2\xe4\x13\xf9\x15aǜ\x91\x81g\x13VL><\x8a\x9aT\xf9\xb0}\x94}\x84\xd8l\xa2C\x95\xe7䊺hAJ\xadAc(\x96W\xa5P7\xafƭjR\u007f9Ey\x16-G!\b\xdcn\xaf\x87\x13\x14B\xb6#!\xe0\x8c+6\x00h2s\r\x9b`\xdbG\xedd\x17\xb9\x16\x88Ǭn-S\x8f)!\xc0D\xb4\xa3\xaa*:\x1b@E!\xa9Sz\xd5O\x95Mm`j\x9a\xc0\xabH\x95\xa8\xb3\x0f\xc5\xca`ȅ\xf2\xe1Ȍj\x11\x0f\xbb\x01\xb2\xbc\x86\x9c\x81]\xcf\xdc4R\x9a[V\x87\x00P\xc0I\xfb\xfc\x00.\x18\xaa\xda\"\xe8UG\xb8^5f\xd4\xf0\x17\x89\x038\xc8X\xedJ z\x1c_0\xb5`\xab&\xaf\xfbY\xafU\x85\x01\x14;`\xf0\xf2\xaa\x11Ǧ\x85\x1bA1\x88\xc2\xc40\xc8\x1a*/\x1d\xa8EG\xf0\x88\xecg\x16\xacy<mKj\xc9\"\xfe\f\xaa\\\xb0\x0f\xda\xfcUə֢\x15\x06\\}\xee\xa0)J_a\x95\xc1&Y\r\x95N|u*\xb0Y\xd2\x06\n\xcf\xfd\xf2\x16I\xaf\x1e\xf6\xa7\x04\xea\xcb\xcb\x03\x8c\xb7\x01冩\xad\x1a\xedun\xe7tm\xc3ŀ\xb8\x96\xb3\xafS/z/\xf6\xee:-#\x91\x87\x8az\xea\xb8'\xc1ddS[\xc0\xa8JN\xc8\xea\x8c\xdf\xc0M\x05$\xa5l\x18\x90>F\x1a\x99\xc1\x16\x1bC\xe4_M\xc1\x88\x85\x1f\x16X}U\xeas\xf9\xdd\xfb\x18u΅:\xe1\x12\xefq\x96\xf3~$\xb9F\xf8T\xda\xe0jx7\u007f\xde\xffz\x9b\x8c\xff\x1c\xf0\xf5\xb6O\u007f-\x9d\xfd\xd41~\xd3gΤӴ\xd2\rb\xbc\"\x1ao\xa9\x8c\x06\b\x8d\x03N\xfc\xbc\xd0\xfd\xd32\xb7\x13\x84p\xebY!\f\xa1\xeav%\xbd\x99&u\x9f*\xaae\x10Un\x86V\xda\x13\x05etB\x03ჸ\xdc\xe1\x15\xf1\xdbu\xea)\x96$~\xbd%\xc0',¯\x993jOL\xb89\xbe\"*\xf1\x1a<l\xafU\xca4\x92\xa9-\xecj}Gd\x86\x17\x9cNq\x12\x03\x98zd67\xc6\x1a\xa3\xc5?\x10\x1dM+\xe1\xa4:P\xa5~1L:V\b\xff9\xc8|.3h\xcd k\xbd\x06mQTdQ\x03\xae\xd6jL\xfd\x16\x9c\\}\xccC\x19$\x03\x85\xecF#\x00\aQA\x8aP\x86\x82\x12vh\xa8\xbb\xa5\xf4\x91\x11\x0eX\x140\x9b\xc1\x95\xef\xfa\xaaR\x95N\x8e\x02\xb1H\x8avN\xc1L`\xadWi\xea`\xe8\f\u007f5\x99 b\r\x99\x05=\xf7\x94L\x9b\x85F\xd3\xc6\x13\x12\xcf \x95\xf4Y\xdfS9l\xc3\x1e\x1b\xc2~\x9dbW-\xd0L|\xe7Z\x11\x807\x13\x11\xb9h\x93\x01!\xee\xfb\\\x04{\xdc\x0ey\xae\x8e!\x12\x99\x9f\xe0\x1d\xfa\x13\xcb\x17\x81e\xed\xcb\x00\xba\xb1\xa9M\xedU\xea'\xc4ނ\xaf\xf0e\xc5\x00s\xd9Y:*\\\xfcar(\xe3\a\x85\xa0,4;zcN\xd0\xde|\r\xac\x80\x1a̕Q\x06^pf[;@\x8bW\xc1Z\xfa)\xc8\xe8\xe2b\xf1\xa0\xdbU5\xaac\x13p8\xabj6\x9bx~\xaaA\xb6\xbe\xc2\xdbN\xf1\xab\x8f\x1e\x90\xc8\x15]\x8e\xe2\xdc\x1c\n\xc8bF\x81W\xd2O\v,\xdagEޔ\xdeEK\xb1\xd9:\x18Cm\xeebt4Ž\xa3$\x10\x91\x86\x88\xadL\x1b@\xb5\x11M\xa6\x85\r\xf8\x81YG\x1a\x98ӯZ\xcb\xd3B\x87\x86]\xbd\xbcp}\x9a\xdc\xc6\xedR\f\xd8\xf4\x16\x19\xaaBƢҐP\xeao\x02\x8cֆ\xf9Q\t8]\xb8?\xa9\xe6;q\n`\xc9l\xafZ\x83\vK\x8d \tr\x8a*\xf22\x8f\x00\\\x8cu\x05a\xbe\xaa#\xaaB\xa09\x93\x10q\xa89ɩw\x060\x14\x8b\x80W)$\xf5C \xdf#\xf8\xd0\xebk\xd8>\xb8\x88>\xae\xa8l\b\x05\ao\xe2\x0f\xd5˞ʭ22M\x1d\xe39[d\xb8\x96\x96=\xc1m\x94\a\xc1,\xec\xcaXZ.o\x8bU\xb3\x9cᗴ\xd9\u007f\x16BF\x82\\\xac\xa8\xfa1\u007fz\xef\xab\xf6\xbfԇ8\xff\x8bʿ\xfbgݳD\x1f糵\xe1\xbb\x16\xa7e;\x98r\xcf\x1f\x1b,$\x85\x9c\xbd=-\x8c\x00\xf9\xf2z%9N\r\xd4\xda\xce\xf1\xa1'H[\x1d\x15j[\xbf\x9f\r \x1f\b\xec~\xab\x99b\x1eu\x16z\xf7>\xfc.\xf0\x97\x1f\xc7@\xe3\x1aUrF>\xf1\x19\xf2+ᬓB\xea\x12ߩ$\x89\xd5\x02\xb0u\xc4,\xd2W^\x1b=@\xf2ʸ\xe2&\xb5]*\x9b\xbaH\xa3&\x0f;\x03$\x8d\x05\x93\xab\x97\xaa[x+\xd9rڀa\x9aN\x9d=eK\xedT\xc0\x85\x04\x05P_\x830\x19L\xbc\xac\xfci\xa7R}\x83\x83\x8e\xeaQ\xfb\x15ʊ\xa1s9հr\xb0\xa9NWGH\xf5\xe7\xa2e\x0ey\xf7r\xe5\xdaPr\x1f\x05\x81\u007f\xa4ݨ\xeb\x14\x8f\x1d-I\xa5\x8f*\x00\xd6~)x\xb8I\xf6\xb8\xaa\xd8|\x15\xca\x12\x00\n\xe5\x1b\xdad\xe2dPco\xb99\xa2\xa6U~zL\xa4ƺ\xb9d\xb1\x803\xccC\x81U\x889\xf3.\x8d\x0f\xe1\xe1\f\x90\xf9\xaa%q\xf9(Ѷ\x19݁h\xcc@\xf8\xb9ZLO\x11\xf7{\xe3\t\x1e\x05\xfa\x9a\xa4\xf1]\v\xbeUۑ;\x9c\x98\x18F\xa0r\x01e1\x18A\x1d\xcd\xea\xcf\x06\u007f_\xef\xf6\xf3\x92\xe09\xd1\xcf}\xa0\xa6\x98\xc4\xde128\xefȕ淘\x19\xb5\xb4\xdf\xe4q\xa3VVҖ\x8fG5jӂ6\xc0\xccڑ[\xa7d%\x1e'\xf6ooC\xe3䝹\xe6V\xd6|a\xe9P\xb8\xaf\xd6*w\xb2\xb5\xb7\xb3\xc9?%\x00-.\xf4\x02H\xe3A\xb9\x8d\x18\x93Λ\xaa\x02XVK},Q}JH\xe820\x8d(\u007f1\xbdVo3\x01\xa9\xed\xa6ڜ2\xd5~\xa5\x02d@\x9b,\x10E\x8d\xc0oC\xec\xf1ܰ,q\xbaZ\x16\xf1\x83\xf8\xc0\x13e\x1c\xa4(S\x05\x02>\n?s\x9b\x13\xbb\xa8\xde!u\x1b %\n\fߓ\xfa\xf3\x8a\xfa\r\x8b\xf6je\x89)4\x13\xf3QUK\xe2\x8a\xc2V^oձj\x8a@\x1d\xceT\xec\xbf\xd5Di\xf1\xa8\xd9\x1bK\xfd6\xbcDU\xaa\x88[\xf5F\xa8\xc3?\"\x83\xaf\x9a\xb2\x99zg`0\xe0\x9a\x03(\tW\xb4\xa2%V,~Es\x99\x88\x9b\xab\xc3>c\xcd_\xee\x0fx\xf6\xa9k\x8b\x19\tp\x99cC\xd7\xf9\xe3:Ӣ\xaa!>wNI\xefS\xb5\xf2\xfc6\x81\x99\xaa\xc4R\xd6\xf5mH\xa8\xb2C@%\xf1\t\xb4\v\xb3ǅ\xffƕ\x18p܋a\x1f\xc9@y۲\xbd\x19\xb3\xa0\xa2A\x1a\xc0V\xb5ǭ\xea\xf1\xe6˰\x03\x01\xef\xa7\x06\xa1\x06\xc3!U|\xa8\x87\xb9\xe8\xdba\xac\x10\xd3\xfc\v\vz\xaa\xe0\x8d{\xc2W\xc8O\xab\xdfGv\x9as\xa8\xf3d/\x93CX\x87\xb7\x03T4\xb5q\xb5\xe1dBz݇J/\"!k\x13%\x94{0_x\x99\xf3vu!%\xf6\xebL\x98\x10^\xd08^<ƫ\xe2\xaa;H\x9b\x06z\xf0\aG\xea\xe0_2\xd0o-\x8b\x95\xc9\xcdbȥ\xedҹ^=I\xa4\xb6\xe6\xd5r\xdc\x04\xc9\xf8V\x8a\tŮ\u007f~\xeajϊ\xe6\xf1v\x9b\x04-\x82\x18`\xa2}<\xa1k\xefN\x1a\x93\b\xe3\xeb\r\x88L\xfeuq\xbc\xdel\xbb_\x9e\x85X\xbaZ\x13\x80\xe7\x1d\xaeM\xe8J\x04\xc2:\x01[\xa6\xe6\x19P\xb8\x81\xc8L\xd3(\x86M'\xc6Au<LWk\x8a\xfa\xe0\xa1!\xc4]\x99`\xef\x91Jo.cA\x97\x84\x85\xfa\xc0\xb0aS\x8b\xe0\x97Y\x82\a_\x89\x95\x8c\xd0x\x82y\xb0\xa4\xdc\x10*\xe7\xd5:0\xbf\xb7j\x15\x0eש\x9dPY\xfb\x12\xd4gsz$\x82\xa2\xb6aaD\xc3\xdbʲՁHԑ\x0eM{4vF\xb1\x19R6j\r\tK1\xde\xea\x1c\x04\x8a\x18\xedj_\x18h\x05% pʫZ\xdc\xd7\x06\x19D\xc2\rj\xe9$\x14\x989\xfc\xc55\xd9\x190\xe8\xe1@\xb1\xf0\xfa\x14 \x03\x17T4\xc5\xfb\xc1\x82\x87\xa4)\xb0\xb6\x8a\xb4\x9f\x05ZՍ\x93\x85C,\xe0\x9d\xcb\xed\x18\x0f\xbf\xa5\x9c>\xb4\n\xa6\x1d\x06f\xcbfI\xc9y\x89\xbc\xb7\xb1\x10\x9eV,M\xb5\x89\x06\xb48\x11\x80χ\xcf\xc3\xf3\x97\xa8Tu&k\xe8\xef\xfc,R\"I\x97P\xc81\nH@\x83\xaa\xb4\xfa\xa1n\xaag$\xe0^\xfd\xc4\xdb^\xceR Z\xd9\x05UE\xa1g\u038d\v_>\x1f\xdc$Êe\xbf\x0eDDW\xbe\x16\xe5\xa8.BpVv\xef\x00j\x06\xf7\xe2\xc4\tgumU\x10B\xd6\xfa\xa0\xab\x17\xb2\v\xe3\x17\x96\xbe3\t\xb2+Wԯ\xba\xda`\xe8\x06E\x90\x0f\xd2pڪk\xe2\xe6B^ w\xb4줕L\xb5\xad\xed\n\xd4\xf1\xee\xc9Z\x91SG+\x889\x1caV\xaeV\t\xac\x9a\xdaGa\xaa5\xb4!\xf0\x90qZ\x03T\xc9\x02٬\x15\"\xa6\xf2h\t@\xbb\xa8\x86\n\x148Dt2\x03<\x86\vU-\xaa\xf81\x19\xd5\xe7tO\t\x00\xf3\x94\xd6UuO\x15G\xf9\x1cmK\x96쫟\xa5\xf1\x8dT\xd0%h7\xd4(j@)\v.\n\xa6][F\xa0E&\xf9\xad\xc8\xe2qU\xccO\x80\xdaQ\xcd\u0082\xb6Kr\xb3j\x10'5T\xc84\xb5%tf\x8eY#\xdaO\xb8ow\x90ʊ \r\xa2\v\x93\xf8Z\x12\xad3\x80\xf0\xdb\xeb\xa6\xc4\xea\x10\xccڥ\xa3\xe5\b\xd5\xd3[A\xbd\"eԕ\xea~\xc6\f.\xf8\v5 qpCF!_m\x8d\n2\xb6]\xdf\xf7l\xe9\n\xb9<EI䨈\xb0\u007f\x94\xea\xdf\xcet\xbeM\xf5\xd3\xd80\xe0\x19A\xad=˜O\xe6\x110\xdf\x10\t\x8e\x19\xfd\xdcČ\xa6M\xb8\xdav\x9a\xb8Ǧ~H\x9d>\x98\n\xa3M\xbb\x9a\xc7~2y\xabuO[k\xb0\xabo9#+\x9f1\r\xbb\x0fX\xbai\xe5?\xa8\x92\xa9=\xad\xfe\x86\xee\x0482L#\x90\x96\x15\xe6\xaaZ\xbd\x16\x17\xd8<j\xf9A\xa5L\x0f\xe5 \t\x86\x14V\xd5Fࡪ\x97\xf6\x01GB\xbc\xa1\xce15\xf3-\x9fo\xcd<\xe8\x12\xb4f\xa5\xa8\x84\"\xd7V\x1b=\xac\x93R\xf7V\xbcZ$`<\x14\x19l>\x94a51\fiv\xa4\xb6;[\xfdhH\x01D:\xc6YҴ\xa1#\xd5\xf6\xe7\xc9bm\x8d\x99\xa8-\xe8\x19\xfb5\x9bzD'\x1e^\xabTj\xbcīA\xe3\x15\v\xb1\xb4UA}VY-\x9c\xdav\x06:\x92\xc3\xc2a8\x1dPP\x06\x11\xd3W\t)\xe1\x8a\xde#\xd4\t$rg#j\xbd\xb6\u007f\a<-\x03\r\x147\x95\xbc\xb2\nOd1\x84\xa8\x8e\x00\x9c\x01\xa3\x89\xe6\x84\xc2`\xe4\x01\x13\xbc\x92\x9b\xeamx}f\x03\b\xe62\x04\x86\xee2*\xeaZ_Xc\u009fs\x15\x8c\x10\xa3\x98q\xc3\xf3\xa0B\x92\xf8\xa9\x15\x84\xe2T\xc1\x8e\t\xd5ֆ\xb7\x1a\xc3e\xabz\x1d\xd0[n\xbe\x85\f\xec\x0fٵa\xcfא\xbb%\x1b\xd4\xfer\xd4Z\x06\x90lm\xdb9\xf6\xb6y\xc3\x03e|\xca^\\\x02\xe0\x88\xb8\xed\xb8\xa3\xf0\x96.\xb2D\x8f\x06M\xab\x8e\xa3\x1d\x95+nҎ\xc5\xc6\x1c\x0e=3\x04Y\xdb\x12LN|\n\xaa1\xee\x89;\x01X\x04O\xd31\x12\xde'\x91\x05\f\x1fq\xbb*ϙz\xed\x8aj)\xbeI©\xa2\x14\xd5T\x15^\x93\n\xbeA\xb5q\xd2@RW\xfbn\xe2qK\xbbx\x96\x82>m\xb5\xc6z\x15\x19\xd5<\x84\xefS\x9d\xd6Z\x96eU\xc9?\xf9\xa9VJP4I\u008c\v\xfc \x02\xbd\x9aS\x8fS\xffC\x1c\xea\x97O*\xb5\xe6RU\xa6[j\x8f=\xa1v\x03T\xa6\x064k\xed\x8d϶\xa8\xdd\xeeGu \x1c\x81a-d\xabA\xc8\nxM\xed0\x85%ԝVL\xab\xe8C-\fQ\x16\x15\xad\xf5vO5\x10\xdck5ð6z \xcaԓU\xb0\xcaC\x1d\x04c:\x8f\xab[h\xabP$\xad\xe3j\xb0;w\x14\xb4!j\xab\u007f\x0f\x02E\x10\x8d\xa9\x8e*)'2z\xa87Qf\xb5\xbe\xe5\xd4X\x19B\x97\x90\xf1\xeaE\xd5\xd2*\xb7\x96\x1a73\xc0h5L\xcb\xd5(2\xe0[\xf5\x00ݠ\a1\xc8x{\xb2$\x84\xd3ߎ?\x95\xdd\xfaR\x1d2\x1alǧ\xba\x9a\xf2ЎQ\xe2_tyEv\x8c\xfaծ\x95H\x92\x0e \xe35\xea\x01T\xea\x1a!G\xb5\xf7\xb7\x10W\xb8#9{\x8c*D\x9fT#-y\xbd\xce$\x94\x8d:\xa8\xb3\xb6*A\x9f\xdao\x99\x00̔\x97V\x9dsWٚT\x82b\x82j\xf0\x0e\xff\x1cɘ\xf2\x9am\xbdd\xba\x9e'3<W\x86\xcd\x06+\xed\xb3x\xa9\x9d\xf9z\xc6\x00\xee~|\xd6OA%\xb5\x1f\x01\xd9\x1d\x95\xe7>O{\xf8\x95\xd0SD}u\xc1\xc0\xf8\xe5\xb5\xc2\xe1gFT'\x90\x94\x92\x1e\x9d\xa2\xed\xb2AOPpŸ\x19\xd2[D\x8a\xc6\x1a*``[\xa6\x9a\x97\xc7P\xe7\x81\xd6\a\x98$\xe6\x88{I\xe0X\xd5\xc6\xef\xb7\x03\xf4\xf2=!\xbc\xaeKgX5Җy\xc0\xf4j\xf5Y;R\x90\xc5\x10~\xf0Ziޟ\x1d$Zu\x01\uf8f6\x8d\x84\r1\xd5\x0e568\f\xa6\x00\xb8\x9c\xda96T\a>\x9c\xd2\xe12\xf8.j)\xbec\xb4\xaf\xaa\xfe90\x87DΑ\xa5\xc0Ih\xed\xb8h\x13+\ue3d4\x1eQ\xcfK\xe8\xaei{\x956\tkK\x831\xd1\b\x19\xee\x88\xdb\xc4`#\xba\xd1*I-m\t\xa3\x81\xdc\xd7~\xb3\xacf\x92\xc4\x17¾\xber\xf7D-\xc0ֲ\x96\x97U\x02\xdej̻\xd2\xc0\xea\xc6 \xfeLO\xf7X\xd8Оe\x99\xd5\xed\n\xcb2\x9b\xe4,$\xacf\xb2l\u008e5\xf4(\x15hEڡs\v]\xcb\x14\xdap\xad\x87!d\x80\x95\xe0\xe0\xd5(\xd5\v\xea\xebq\x1fu\xa9sx0\xe6\xb9h\xc7\xe1T\x176\x9c逸\x84\xffR\x0f\b\xea\x1c1:d\xb9\xfa\x91c\x96\xc7F\xca\xc3`c\xca~\xa1\x95%ۏ\xda*`8X\xa7KM\xa3A\xf3b\xb0\x87\xfc\x8b\x96\x83v\x11\xb1-}9\x04\xabݽ\x871\x01l\xb4\x98\xb4\xf54\x10h5\x069\xd2bocgW)\a\xbe\x8a\r\x84\x94\v\x8e\xea(S?\xb1ZY\x90\\\xef\xe9\th^uEv\x03\x9e\x02\xf3W\xb4ᘼ0m\xf6j\xc8>\xa8b\"ɖv\x0f4\a\xbcd\xed\x03$\x001\xadC\x0f\xa5a(Ԗ\x89Ҿ|\xeej\x01\xffe\xf0\xcdZ\x01O\xaa\xee\xe8\xb1\rZ\x0f\x05f\xd1)\x93\x93\xb9\xb7\u007fT!\x010I\xf6\x81/\xe8Cb\xe2\x95\xc4\xf1\x9e\x8cw٤\xaa\xb6\xc3\xfb\xaa}X\x92w[\x1d\xef\xcc0\xc2_\xcd\x04z\xba\x87\xd7n/\xb5e \x04\xb5t\x81\xca\xd7#3\x10j\x04l\xcb|P\xfbZ\xbbL\xf3\xc6\x113ZL\xc3k\xfc\xc1\x04\xe9I\x18Z\xab'\x8e\xb6\xe5\x82\xe4EM{\xed\xf0M\xb2Ըq\xec\xccV\xac\xa5\x98\xd4s)\xd6\x04\x02\x89\xf5\xa4\x05ni\xb9D\xa2h\x99\xbc]\x15\x93\xdf\xee\fm\x86(\x8a\x02\xc6Uk\xf3\x98S\xad\xa8i\xbfD\xd6R6>\v\x1b\xa2\xe0Ү\xc7-\xaf\xc3m\xa1\xa2\xa0!\xb8\x14\xce\aY\x1d\xb7\xae\x06\xfaӵC]\xddO+\xab\xf3E\xa1\xae\xc6{\x95\xf8\xb1\xd6\x189f۠\x00\xaf\xad\x86@\xae\xca\xf8ju\xbbY\xab\xb5SO@\x01\xdd\xf1\xdec!|&\x1e\x18Q\xb4\xf5\x8c\aPਿӯ\x88CB\x04I\xa8\x03\xd1\xe8̂Z\xd2>\xafU\x1b\xb3p\v\xf0\xb6\xb4,\x06K\xc0\xb4A\xbd6HY\xd8^\x9b\x12\x86\xc4\xf8\xb0\x95d@-13\xa4\x9b\xf6\xb0'\xe9\xff\x80\x1e@\xd4`V\xd5r\n\xfc \xb4\xf4\xd4\x13\x86\x0f\xfe\xac+7\xf5>\xa7\xacMIz$\xc0J\x19\xa5\x00С\xb6K\xe9LZo)\xe9\xa9_\xaai\a\xb9\xc3v\xa1u\x94\xb3Kr\xf8\xe0\xac\xea\xa2H\xe6\xd7S\x844\xe8`\x89\xe4\x16\x98\x8f\xb4\xd13\xc2\xce\x18\xeaS\xc5\xf70`g\x1c\xe6}<<\xdab\xfd\xe3\xd4'\xb6\x10\xcbZ{\xdc\xf8brZ\x0f/\xe0\n5\x02\x84\xb8'\xf3\x82\x88Q,\x85\xd2\x04E\xc1)4\x1b\x90\x8d\x89\tC\xfd\xaa[\xb5\x11ང\xc1@1EJf=jE\x1b\x04+\xf4w\xd0H\xa4\xac߀\x14\x10\b\xb2\xbe\x92\xb7isp+\x183fZM\v\xfd\xb8\x10U6h\x10\xcao\xf9\x84\xb1\xd3\xfe`l\x9d*\xf4[\xfdAz\xb2\v\x04\x9c\x04\xc5\xed=\xc7\xc1\xa9,\x8a2\xd4y֧+\xf4Wf≝\xef֞\x80Y\x01дn\x9c\x00e<\x12\xc8\xe0\x9eRӶ5fT\xc5\x13\xe2\xc6^\xf3\xdc\xe4<*\x12\x89\xb7Uw\x988ڥ\xc6o\x95\xfd\x826\xf6\x814\n\xa8\xb0P>\xcdi\x91\xa0\xa8̩\x96\t䪪\xfa\xc0\x12\\\xa3\x86\xffì\xcf\x02^\xeeY4\xbc\x8d;G\xffi\xd9#\x1e\x95I\x83\xb6\xban_\x10\xa3\xea5T\xa3\xe3m\x04\xb0\xf6\x1e\x89\x02\xab\xf6.J\xe3{\xb5XU\xe0\"vBH\x1b\a\x8f\x1e\x8f\xa3>\xe7\xa65ۀ\x82[|\x9f\x1a\xe2\xb4)\xa0-UR\xd0\x05Q\xed\x18RbG\xcb\x00\xe2TTBB\x89\xa4ˀo\xf5\x9cI\x00\xa9O\x83\u007fx9X=b\xc0\xb4\xf3\xc0\x9e\xbf!\x96\xa7\x1e¡\xde\b\xf5\xea\xe64\xaf\xa5\xad\xad\xd7|\xa1\x9eHS\rU\xab\x95n\xf5xa'\x90\xd2Zpң\x94\n.;j\a\x8du\x90\x85a\xc9z`BTY4k\xb7\xffU\xba%m\x95\x03\x98\xc4\xe0\x80\xc4\n\xaa\x81\x014\xcaa\xadEGmB\xf5\xc1\x19@\xd7D\x9ajl\x94U\xc9e\x1dm\xcaM\xeaּ|f\xf1\xdeѓ\x83\xae\x1a(\xb5c\x02\x1f\x81\x1eE\xbe\x93=wom\x96Se\x14\xf3\xa1\xdeRA2\xb8\xd30\xea\xc7#\xaa\x93\x8a\x1cZBT\xd3\xff\x8a\xbcҕ\xfb\x8c\xd2D\xfc\xab9\x19\xe7\xdbDaCL\x8c`\x9fz\x98\x02VG\x8b=\x04g\xd4c\xb4&\xdc\x14\xb5v\x19\r\x96EޝW79M\xcf\xf8\x82\x9f\xca۶Ԁ\x0e\x98窝\xc9iǕ\x12\xa9\xe9H\xa9\x16bQ\x10\xad\xab\xae\xb6\xb4\r\x81\xaf\xae\xa8\xfb\x9c\xb5\x10P\xb6f\u007f)F\xf8b\xc4F\x9d\xb6\xc61\xf7zma\x8e\t\x97p\xfdA\x8f2`\xa8^C\x13\xf4Z\xd4T]\x13\x81nb\xabӐ\xec\xd0&䢞\x85\xae=\x0frP!:Y\x165\xa5aQ\xb4\xea\xe0ӧY\x95PA\x9b\xea\xa9=\x13}\xa7g\x14jE\xf6\xa9x\x95\x10\xf5\x00\xa4\xa5V#c\u00906\xb7\xbb\xfd)\xf3@\t%H\xdad=\x03\xaa\xa19\xaeדN\x8e.I\x1bi\xe0\xaeB\xba\x12n\b\x87\x0e\xb2!\n2\xa1\xda\xf1\xaf\x10\x10\xe6\xf8\xe0\x97!\x0e\xed\x9b\x05X\x99\b4CR[\x82\faҖy5\x81\xaa\xae\x1d\xb5`$\xfd\x0f\f\xebA\x11\xf1\xb5\xbf\x12n\x8c\xdcpI\xbb\rcA\x1f\x96\xa1^\x01L\x10ܶ^\xc3Y\x9e\xb2U\x1b\xa2\x8d\xf0!_^nHZ\x99+z\xfa\x80\x1a>\x87\xfa2\x06\xc8\xd2\x1d\xf1\xccG\xdbw\xcdl\xaa\xcd\x19a\x8e\"Uߒ\x1a\x8f?k\xeb\xc8k\xc2\ra\xf2\x16FP\x18$\x96\r=\x83\xe1d=\x18Ͻ\x85\x92\xf2j\xd3Q\x9d\x82\xdc\xf8Qk\x83v\x13\xdfg5Q\x84M\xcb\rxM\xe0(\xfe\xf1\x95h|\xf2\xc3+H\x03~M\x8a\x06\x8f\x87T\xac\xda*;՛\xa0-W\xd0\x1b\x93\xfc\xca_\xa6\x02\xddy\x8bʧI\xd3\f\xc6\x05m1\xf5\xe81Sg\xdcQ\xbbGb\x800X\xe4\xf0!\a\xa6J2{\xa3U^\xc1A\x8bOG\x94\b\xad\x01<zz\x04C\xaf\xe2\xe0e\x98\x98UhDq\xb0\\\x95\x05\xd4$a\xa4\xd5\t\x03\xa3.\xed\x0e8S-\x806:\xa4\xecU1\">\xf5\xe0\x9c\xf3\x1eV\xa5\xf2\xd9\xd0\xc6Bu.\x92\x89\xb6\x9d\xd6\xe2{\xd4}\x84\x89\xaa\xdcr\x91#h\x8b\xdaV\xff\x87\x1eŢ:\x04\xa9\x8f\x92R\xf7f\xbd\xcf\xddx\xff\xe5o>\ue9bb3\xf5\x10\x1dN\x19ԮLN\xf6!\xb9\x14\xb6\x9e\xbbu\xb5wb\x15\xdc\x1e\xbc\v9\x93\xff]\xfb0%\x9c\x8bZ\ue979+9<e\x8e\xa7\x82x\xb7\xb7\x10R\x82WM'q\xd3\xc7'4\x1f\xe7\x16%\xbe\xad\xacE=\xc07\xe8i\x12\xe8\xe2\xfcqN*\x8cl\xa0\xde\xfd/\xb1\x84+\xa0_$Ob\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x05\v\xf0\xc1h\xd9\x00\x00 \x00IDATx\xda\xec\xbdy\x90\xa5\xe9U\xde\xf9{\x97o\xbbKޛ{fe\xd6ޛ\x96n\t\xb5\x1a\x90Ģ\xb1\x91\x91\x8d\x04B3\x03b<\x86`7\f6\x1e\x8c\x87\xf1\f\x9e\xf1,Ș!\xc6\xfc\xa1`@\x80eK\x81\x01I \x14\x136\x8c0\b\xac\x96Dk\x81VK\xdd굺\xba\xb6\xac\xca\xf5\xee\xdf\xf6.\xf3\xc7\xfbeVu\xab[\x02\xa9L\xb5#\x9c\x157\xb2*\xf3\xd6͛\xef\xf9\xce9\xcfy\xces\xce'\xbc\xf7\xfc\xe7\x8f\x17χ\xfc\xcfG\xf0\xe2\xfa\xd07\xe3E\x9cs\xbc\xfb\xdd\xef~\xd1\xfcR\xff\xf0'\u007fBi\xad;\xb7\xdf~\xc7\xf0\a\xbe\xff\a\x00\xf8G?\xf5?PW\xd5\xe2/\xfc\x8b_\xd8\x03\xf8\xf1\xff\xfe\x1f\xc4I\x1c-tڭݷ|\xeb\x9b\xcd=\xaf|5\x97.]\xe2\xf7~\xef\xf7\xf8\xc1\x1f\xfcA\x00\xfa\xfd>oy\xcb[\xfeJ\u07fb\xb8\x19!\xcbZK\x1c\xc7\xd7_T\b\x94R8\xef\x10\b\xbe\xd8ϐR\"\xa4DJ\t\"\xfc\xdf/x\x93Bབྷ\x80\x16B\xb4\x95\x92J)5\xaa*S{\xef\x9e\xf5\xe2\x02\xde\x10\xc7\xd1O\xc5q\xfc\x1a!\xf8W\xa3\xf1\xf4\x9f\x01\x97\x00\xd1J\x93Ϭ./\xfc\x9bYQ\xfc\xc2\xee\xde\xf0[\x93$\xfe\x9f\xe7\xfb\xbd\xe1\u05fe\xea\xeeO\x9f\xbbp\xe9g>\xfd\xe0#\xcbB\n\xbe\xeb;\xdf\xf6\xf9\xed\xedm\xd6\xd6\xd6\xf8\x95_\xf9\x95\xff\xf4<\xe4\vO\x10\xdc\rFh\x0e\xf4\xe8\xf3\xe1\xd7\x0e\x8d\xe0\xbd\xc7Y\x1b\xbe'\x04R5\x06\x02\x01H\xef\xfd\x92\x10\xe2e\xc0\x86\x10\xbc2M\xe2\xfe\xa9\x8dաu\xee\xd2\xc5+;\x8f\xe4e\xf5Q\xe7\xdc\x04\xfc\u007f\x05\xe2=q\xac\x13)\x04s\xdd\xf6\x8f\xe2\xfd\xdf\x19Mf\xef\x02\xee\xafj\xb3\xa2\xb5\xfcgZ\xa9o\x8d\"=\xacks\xcfh4f:˿~\xa1\xdf\xfb\xae,Mvkc\x16\xfe\xe4\xc3\xff\xdf\x1b\x1e|\xe8ч?\xf9\xc9O\xfe\x95{\xf7M\xf7\x10!\xc4\xd1\xe3\x8b\xffd\x81R\x12!$\x1e\x8fw\x1eg\fR\xa9\xb6s\xae%\xa5܌\x93\xf8k=\xbc\xd99\x97\tȄ\x14ǥ\x90\xc7\xe28\xa2\x95&\xac\xad,P\x94\xa5\xdd\xdd\x1f]\u038br\xcb9\xf7\x12\xef\xfd\\\x92\xc4x\xef\xe9\xb6\xdbl\xac.r\xfe\xc2e\x06\x93\x19JJ6\xd6W\x88c\xcd\xfe\xc1\x98\xd1d\x8a\x94\x92\xa5\x85>o\xf8\xc6\xd7\xf0\xe0g?\xcf\xe3O\x9d\xa7\xd3\xce\xfe\\k\xf9\xd7\xee{ս\x83_\u007f\xef\xef\xfe\xa7i\x90$I\x8e\f\x82h.\xee\xc6+^\xd8&\xe1{\xde{!\x84X\x8c\x93\xe4%i\x9a\xbc\xc6\xd4\xf5\x9d\xb3Y\xfe:\xad\xd5\xe9\x93\xc7\xd7\xe3\xf9~\x8f\xaa\xac\x98\xccr\x06\xa31eU\x13\xc7\x11\xed,e}e\x11\xefa{\xef\x80\xc1hB]\x1b\x94\x12()\x89\xa2\x88\x13\x1bk$Z\xf1\xd4SO3*j\x96\x97\xe6\x99\xefu\x99Ls\x0e\x86c\xca*<\xff\r\xdf\xf8\x1a\xf0\x9e\u007f\xfb\a\x1fa\xbe\xdf!\x89\xf4?\xbf\xeb\xf6\xb3?=-\xad\xf9\x87\xff\xe8\x1f\xf3\xb9\xcf}\xee/}.\x8b\x8b\x8b|\xff\xf7\u007f\xff\xad\vY\xd7\x0f?|\x96Z!<\xf8p\xea\xd7C\x95V\b!R`\xc5{\xff_h\xa5\xbf\v\xfc\xeb$\xaeU\x15\xb9t\x1e\xb2,\xa5\xae\r;\xbb\x03\xa6\xb3\x9c;Ξb}m\x85+W\xb7\xd9\x1f\f)\xab\x9a\xaa6\xec\xed\x0f8\xb6\xb6\xc2\xed\xa7Opqk\x9b\xab\xd7v\xa9MM\x1ci\x8c\xb58\xe7Y\\\\`gg\x97Iy\x80\xb5\x16)%Ǐ\xada\xade\xef`\x04\bΝ\xbfȽ\xaf|9JI\xacu\xa4\xdd\xf4Ǽs\x1fL#\xf5\xc0\xb1c\xc7\xdcw\xbc\xed;\xf1އ\x87s\xcf\u038b\x9e\xe0\xe5\x87_j\xfe\U000b6dfd\xed\xd6\x19\xe4y=\xc1{@\\7\x02\x02!\x05\xde\xfb\xd3B\x88\xff\x03xC\x12G+\xddv\x8b\xb9N\x8b$\x89p\xd6RV5\xc3ф\xd1\xc4QT%\x1e\xcf\xe7>\xff$\xa7On\xb2\xbe\xb6B\x9a&\x18c\x99\xcef\xcc\xf2\x82\x83\xc1\x88\xe3\x1bk\xdcq\xfa$Y\x92p\xe1\xf2\x16\xc6X\xb4\xd6l\xef\xees\xfa\xc4&+\xab+\xec\x0eF\x87\x1e\xc9|o\x8e\x9d\xfd\x03\xf6\x87\x13\x04p\xe5\xea6\xf7\t\xc1\x99\x13\x1b\\\xbe\xb6\x8d\x94\xb2-\x95\xfa\xbf\xeb\xaa~ӷ\xbf\xf5-\xfb4\xd7\x15\x80\x90\x12\x01\xd7\r#@x\x01\xe2\x06\xa3\xbcЙܒ\xa4\xde$g\xef<\x9e\xf0\xf7\xb9n[t;\xadv;K\x16\x84\x10'gyqoQV+EY3\x18M\x18\x8c&\xcc\xf7:\xb4҄,Kh\xb7[\xacY\xcbh<ew\u007fHa-\xe7.\\f<\x99\xb2\xb9\xbe\x8a1\x86(\xd2$IB\x9e\xe7\xec\x1f\fYZ\\\xe0̩\xe3DqĹ\xf3\x17\x01OYU\x8c&S\xd6VW\xb8p\xe92\xae\x81\xe9\x9dv\x8bV\x96\xa2T(\xc5jcɋ\x82\x85\xf9\x1e\xe7/m\x1d\"\xc0\xaf\x8d\xe3\xf8o\xa7q\xf4\xff\xac\xad\xae\xd6[W\xaf>\x1b\x98\xdch\x98\xe0&\xe1\xdf/\xea\xc20$\xf8\xce4/\xbe:/\x8a\xb7\xc6Q\xf4\x8f\x93$~\xcf\xc2|\xef\xae\xf5\xd5%Nn\xaeq\xf6\xe41N\x1d_#Mb\x8a\xaa\xe6ʵ=\xaen\xef\x91\x17\x15\xbd\xb9.\xb7\x9d\xde\xe4\xd8\xea\"\xc2;\xb6w\xf7y\xe2\xe9\v\b)\x99\xebthe)\xddN\x87i^\x90\xcfrʢ\xe0\xe4\xc6:\x9b\xc7\xd60\xd6\xe1\x9cg{w\x9f;Ϟf}m\xf5\bjK)I\x93\x848\xd2H\x11\x8e\xb1,\n\xd6V\x96\xf0ޓe\x19i\x1c\x93\x17\xe5O!\xb87Ғ\x93\xc7\xd6hg)Y\x9a\xf2\x9e\u007f\x1dꮯ{\xdd\xeb\xf8\xd1\x1f\xf9Q\xa4RH\xa9\x0e\xa3\xf5\x8b\f\xf6\xdehq!\"!\xe5\x8f8\xe7\xdeV\x16\xe5\xddW\xb7w\xa28\x8aH\x92\x988\x8eQJ\x11iM'\x89\x91=\x89\xf7\x01$TuM^\x14\f\xc6\x13\x928\xa2\x95e\xb4[\x19\xc3\xf1\x94\x83ф\xc7\xcf=\xc3ٓ\xc7Y\x9cﳷ?@)\xc9h:EHA\x12Ǽ\xfc\xae\xdb\xc9\xf3\x82\xdd\xfd\x03\xf2\xbc\xa06\x86;o;˥\xadk\xe1*\xf6\x9e$I\x88\xb4\xc69G]{\xdaY\x8a\xf5\xa0\x94b}y\x11\xa9\x14ֺcRȟ\xbe\xba\xbd\xfb}\xcb\xf3\xbdxeq\xa1smo\xff\xe0\a\u007f\xe8\avV\x96\x97\xdd\xf7|\xf7ws\xf6\xecm\xfc\xd2;\u007f\x19$H\xa1pX\x9eS\x1e\xfd\xd5\x1b\xe4Ѝ\x0f\xeb\x8dð弫mYO\xa4\x94\xaf\x1aU5Ӽ$\x8e\"b-\xd1Z\x11E\x9a8\x8aHӄ$\x8eɚ+\xb0\xdb\xed`j\x83q\x96\xa2((\xcb\n!\x04\xbd\xb9\x0ei\x12\xb37\x18\xf1\xe4\xf9\v\xdcq\xe6\x14\xf3\xfd\x1e\xc3ј\xba\xae\x18\x8c\xc6\b)i\xb7Z\xdc\xf7Uw\xf3\x1f>\xfeI\x8a\xaad8\x9ap\xd7m\xa7\xb8\xff\x81O\xa1\xb5\xc2{O\u007f\xae\x83V\x8a\xaa\x89\xb2k+\xcb\xfc\x87\a\xfe\x9c4I\xf0x\xa4T\xd4\xc6\x00\xfc-\xad\xd4\xc3sݎ2\xc6f\xad$1e]\xff\xe9x<\xfa\xc4\x0f\xfe\xf0\x0f\xff\xaa\x94\xf2\xfc\x0f|\xdf\xf7\xfb\x83\xc1\x80\xf7\xff\xf6\xfb\x91J\xe1p\xb7\xdeC\x8e\x8c\xd2\xc4S\xef}Hv\xf0N\xa5\xd5wH\xa5^/\x84\xc0zϬ2P\x1a\xa4\xachg\t\xd6yf\xb3\x9c\xd1xB\x1c\a\x03\xc5QD\x14Et;\x1d\x16\x17Bm1\x18\fqα\xbc\xd0\xe7`8\xe6\xf1s\xe79}|\x93\xb5\x95e\xf6\x0e\x0e\xd8\xdd\xdbg2\x9e\xb0\xa7#^\xf1\xf2\xbbx\xd37}=\u007f\xf4\xb1O2\x1cMX\xe8ϱПk\x80\x86$\x8e\"\x9c\xb7x`\xae\x93\xb1\xb48\x8f\xb5\x96~\xbfK\x92\xa4\xd4\xc6༧\xaakq\xf6\xd4\xe6\x92D \x85`cm\x85$\x89\xbeig\xef\xe0\x9b\x94R\xffe\x96\xa5?\xf4\xc0\xc7\xfe䣳Y\xce\xda\xd2\x02Um\xe2\xbd\xc1\xb0\x02\xec-\xcb!7\xc2@)\x15:\x8eP*\\\x89i+{\x85\xd2\xea\xee\x1bQ\x87\x94\x12\xa5\xc2\xc3\x19\xc3t<f2\x992\x9d\xe6L&3\x06\x83\x11\x83\xc1\x90\xf1x\xc2`0`8\x18PW\x15\xebk\xab\x9c:q\x92\xf9~\x9f~\xaf\x83V\x82\xa7/\\dgo\x9f\x13\x9b\x1b\xbc\xfc\xaeۙΦ\x8c\xc6c\xce_\xb8̝gO1\xd7i3\x99\xcd(ʚ,\xcb\x10@\x9a&\x8c\xc6\xd3#\x88\xfa\xb5\xaf\xba\x87\xb2\xaa\xd8\xd9;`my\x99n\xa7\x83\xb5\x16c,\xcb\v}\xdaY\x8a\xf7\x8e$\x89\xe9\xcdu\x98\xef\xf7\xb8\xed\xf4\t\xd6W\x97\x97ʢ\xcc\xce_\xb8\xbc\xbew0TZk\xe5\x9cS@\xefw~\xf7\x03\xb7\xceC\x8e\xe8\x11)PZ\xe1\x8c\xc5\x1aK\x9c%+\xc07\x80X\x10\x02\xa4\x90d\xb1\"Ѫ\xa9X\x04\x1e\u007f\x94\v\x9d\xf7\x98\xba\xa2\xaa\x046\x89\xb1\xcec\x8c\xa1\xae\reU1\xcbs\xd2$ayq\x91S'\x8e\xb3\xb3\xbb\xc7cO=Ź\v\x17\x01\xc1+_v'\xfae\x8a\xcf?\xfe\x04\xbb\xfb\xfb\\\xb8\xb2\xcd\xc9\xcd\r\xac\xb5lm\xef\x93&\t\xa6\n`\xe1\xa1G\x1eG)I\x1a\xc7\xdc\xfb\x8a\x97\xf1\xd1O}\x86\x85\xf9>gOm\xa2\x95\xc6#H\xe2\x88n;c:\xcd\xe9\xb4[$iB\x96\xa6Tu\xc5x:\xe5\xa9\xf3\x97\x16\x8a\xb2\xfa{\x951?\xe3\xaaz\xcf8[K\xc4\x14\x98\xbc\xf5-\xdf~듺\x94\x12k\xcc!O\xa5\x85\x10\xca{\xff\x1d\xe0E\xa44\x9d4BK\x89\a\xa28faa\x81\xde\\\x97,M\x9b+\xd2\xe0\x9c\a<;\xbb{\xec\x1f\f\xa8\x9a\xca<\x8a4QYQ\x14\x05\xb3<'\x8ec6\xd6\xd7\xf9\xc6\u05fe\x86\xed\x9d]\xce=s\x91\xcf=\xa68\xb1\xb1α\xb5u\xf6\x0f\xf6x\xe6\xe2e\x96\x16\x17\xb9\xb2\xb5\xc5x2%\x89cl\xabE^\x94\fF\x03\xe28\xe6կ\xb8\x8b\xd1d\xc2\xee\xde\x01gOn\xb2\xba\xb4\xc8\xf6\xee>eU\"\x85`~\xae\vΓ\xb52\x96\x17\xe7\xb9ru\x9b\xa2(\xb1Ƒ\xa5\x89\xf0\xf0-Z+\xbc\xf3ߕ\x97eU\xd5F*)\xed\x8b\x02e9\xe7QJ\x06\x14\x93\xa5\xd2;\xe7Mm\x9el\xb7\xb3\xd7\xcce1R\b\x94\xd6,,.2\xd7\xed\xa2\xa4`4\x1a\xf3ԓOQ\x1b\x13\xd0\xc9s\xc0\xbc\xf7\x9e|6\x03!I\x92\x984\r\xe8(I\x13ʲ$I\x12\x16\xe7\xe7\xb9\xf3\xec)v\xf7\x0f\xb8xy\x8b\x13\x9bǈ\xe3\x88\xfd\xfd}\xe6\xfb}\xa2(\xa2\xaa*\xa4\x14\xcc\xf7zl\xef\xec\x81\xf7\xac\xaf\xacr|c\x8d\x8f}\xea!\x92$a\xa1\xdfgw\u007f\xc0\xd6\xf6.\x93Ɍ\xbbΞd2\x9b\xb1ql\x15\xad5[\xd7v\x00\xc8\xd2\xf4\b%\x0e\x86#\xeaڼA)\xf9\xba\xe1x\xf2\x91\xc1hR\x94u}듺h\x98Z\xef=:\x8ap\xceuLm^\x16\xc7\xd1\xd7\v\xa0\xb6\x0e)%s\xed\x0eI\x1c3\x99L\xb8|\xf9\nEQ\xde\xe0\x19\xf0\xac\x02\xd7_'\"\xa5\x14\x94ޅ \x17\xc7x<\xd6\xd4\x14E\xc1x<&\x8ecڭ\x16\xe3\xf1\x98+W\xaf\xb2\xbe\xba\x8a\x94\x92\xdd\xfd=\x94R\x8c\xa7S\x8c1\xf4{}\x9e~\xe6\x19\xfass\x9c<~\x8cg.^\x0e9h\xe3\x18\xd7v\xf7\xa9\xea\x9a\xe9,\xe7\xd4\xf1c \x05\xf3\xfd\x1e\x83\xe1\x98\xc1`\a\x87\xa7\xae\rB\n\xea\xca0\x9eL\x0f\xdfs<͋\u007fi\xac\xfb\xb6\x85\xdeܧ\x8d\u007f\x11T\xeaR)\xf0`\xad\xc3\xd92\x12R\xbc4\x8a\xa2\x9f\x11R\x9e\xaa\xacCYG\xbbAN\xbb{\xfbl]\xb9\xca!B\xbe\xd1%\x0e\xf1\x81hB \x84\xda \xcbR\xd2,x\x87o\xbe\xb60\xbf@\xbb\xdd\"i\xd8\xe6\xde\xdc\x1ci\x9aR\x96\x15Zk\xd24\xe1\xda\xf6\x90\xe9,'\x8e\"\x16\xe6\xe7)\x8a\x9c\xd9l©\x93\xa7\xd8\xdb?`\u007f8\xa67\xd7E \x98\x15\x05\xe3ɔN+\xa3\xac*\xaa\xbaf\x96\x17G\xad\x01k,R\blm\x11\x1e:\xad\x8cڄ\xba\t!֥\x10\xffj2\xcb\xff\xbaR\xf2\xea\x97C\xdc\xde4\xb67MS\x84\x948k\x03\xa5 DW\b~NG\xd1ߕ\x02\xdaI\x84\x92\x92,\xcd0\xc60\x1a\x8dp.T\xd2B\x80\x92\n%%\xce9ڝ6\xfd~\x0f\xef<u]Q\x95\x15Q\x12\x11\xc71\xb2\xa1:\xd24\xe5\xec\xe9\xd3D\x91f0\x180\x1a\x0e)\xf2\x1c)%\xdd\xc6(k\xeb\xebdi\xca\xde\xc1>[[[ \x04\x91\x8e\x98\xe53\xfa\xbd>K\x8b\v\x8c&\x13\xa6\xb3\x19J*\xaa\x06\x88(%\xd1B`\x9d\xc3y\xb0B\x91W\x06\xa5$Y\xbb\x83\xf3\x1e[\x96\x98\xaa\xa4*s\\\xa8U\xd0:\\\x90yQ|*/\xcb7M\vs\xed\xe2\x85\v\xb7\beI\x81\xb3\x16\xa5\x15\xd6X\x10\"\x8b\xd2\xe4Q-\x05\xb1V8\uf265\xa4(f\x14E\x85s\x0e)\x04Y\x96\xa0\x95\xc2XK\x92\xa4,--\xe0\x9d\xe7`p@]\xd5H%I\x92\x04\xad5B\t<\xb0\xbc\xb8H\xbf\xd7\xe3\xf2\x95\xcbLgS\xbcsDJ\xd3\xeb\xf5\x88\xa2\b)C\xa8\xd8\xd9\xde&M\x1366\x02jz\xf0\xc1\a\xf1\xc0\xb1\x8dc$ILQ\x96x\xe7H\xe3\x18k\x1d\xaa\t\x97\xce\v*\x14NFX\x0fUQ\x90\x8f\x87X\xe7\x99L\xa6$qB\xd6\xee\xd0]졣\x88\xd9d\xccd\u007f\x1b)\xa0\xaaj\x10\xe2UJ\xa97/\xcfg\xff\x1a\xa8o\x89\x87$Ir\x14^\xac\xb5H\xa5\x12)\xe5O\xc6q\xf4\u007f&Z\xa1\x05()(\xab\nk\x1dI\x1c\x13\x05j\x02\x1dG,/-!\x80\xc1`@UU\xe1j\x894q\x12\xa3\xa3\b\xa9\x04J\xaa\xa6>0\x94y\x8e\x90\x12\xa5\x14\xbd\xde\x1c\xf3\xfdy\xb4\xd6TU\x855\x06\xa5\x15Y\x96\xb1\xbc\xbc\x1c8&\xe0\xe0\xe0\x80\xc1h\xc8p8\xa4\xaek\x94R$I\x82w\x0e!\x15\x06EQ\xd6L'\x13\xca\xd9\x14S\xd7G\x94\xbbs\x81\xca\x17R\xa2\xa28<\x94Bi\xcd\xdc\xfc\"q\x961\x1e\r\xf1\xe5\x8cDK\x8a\xb2|\xec\u0095k\xaf\xbdvu{\xff\xd6\xe4\x10\xc1\x8d\xc6\x00(\xb5V\xf7\xa6\x91B\x01i\x163\x99\x04\xa4\xa4\xb4\xc09\x87\x15\x92\x95\xd5e\x84\x10L\xc6c\x10\x04\xa8\xd94\x97\xa2$j\x0eӣ\xa4\"R\x92\xe9t\x8c\xc0\xa3uD\xab\xdd&\xcb2\xaa\xb2\xe4\xc9'\x1e?\neY\xab\xc5\xf2\xca\n\x9b\x9b\xc7\xd1\xf5\f\xb7\u007f\x91\x85n\x9b\xb5\xf9\x8cq\xda\xe1\x89\xf15\x1e\xdb\xdfê\x94~\xaf\x17\x8aT\xedȋ)J)\xba\xb1\xa4\x1a\xd7\xd4U\x15\xb8\xb6(\xa2\xaa<\xc6T\x18WCQ \x84 R\x1a\x1di\xf2\xc9\x18/$q\xabM\xd6\xe90\xccs\xa4\xb1\xbdӛǺ\xc0\xfe\xad\xf1\x904\t\x85ac\x99$K\x11p>\x96\xe2\xa4T\x12\xd3\xd4&\xce\x06\x8e'Ғv\x92\x86\xab\xcf;t\x93\xa8\x03\xbf\x15\xa1#\x1d\x90\x95\bt\x85\x94\xa1\a\x9f$\t\xf3\xf3\xf3x\xe7\x19\x8f\xc7\f\a\a\xe1p\xe2\x98V\xabE\x92$\xac\xad\xads\xfa\xd8*\x8bLi\xfb\x02-\x03U\xe2\x9c\xc3\xf9\xf0\xb0\xd6QZ\x8bS1Y\x9c\xa0\xa4B*E;\xd2D\xceR\x1aǟ|\xf6q>\xf5\xf4\x162iѝ\v9m\x9a\xe7\x8c\xc6c\x06\xc31\xb51\x01\xc6Kٴ\x19$*N\x98[X\xa4\xdd\xed\xfa\x9d\xad\xcb\xdf\xff\xd4\x13O\xbd\xeb\x96x\x88\x14\x12\xe7\xddQ\xdd \U00059015\xdayp\x0e\xadd\xa8Ǖ\fH\xcc\vʦ\xdd*D\xa8\xee\xb5\xd6D\x91FkE\xbb\xd5\"Nb\xb24E+\xfd,zf\xfb\xda6\xf9,\\\xcd\xedN\x80\xd0i\xd6bme\x99\x93\x8b]\xd62A\xea\a\xc4Q\xc4\xfa\xe6\x1d\xa4QD9\x9ba\x8d\xa1\xb6\x86\xda\xd4ԦF\xe0\x89\xa4 \x92\x12!A\"1e\x8d\xf5\x9eX\v^\u007f\xd7\t\x16R͇\x1f\xbbĵk9\xebk\xeb\xccu;\xf4\xe7\xba,/\x96\\\xdb\xdd\xe3`0dT\x14h!\x838\xc3\x18\xac1\x98\xba\x16\xc7O\xdf\xf6\xcd\xc0\xbbn\x19\xec\xf57\xf4\xd0M\x1d\x12<B\xa0\xa4\xe0\xc6F\x81\a\xac\xf3\x14\u0093E\x9a$\n\x86Ȓ\x98Ö\xbc\xa9J\xea\xb2`\xb8\xbf\x1f\x98W!\x11B\xa0\xb5\xa6\x95\xb5\xe8v\xda\xc4q\xcc\xca|\x8f3\xcb]\x96\xda\tmiQT(\xa5Y\xdb<\xc3\xd9\xdb\xef\xc4Y\x8b\xa9J&\a\xfbXcq\x1cR1%\xde\x1a\xb0\x06W\x978g\xf1ւs(!0>|>\xb3\xd2gy\xae\xc5\x03\xe7\xae\xf2\xc8\xce\x0e\xf3\xfd~\x93{b\x8e5\xe1V\x8e\xc6L\xf2\"0\n\x91\xc6W%\x93\xe1\x01J\xeb\xfe-/\f\x0f\x8db\xbd+\xa5c \x95Δ8\xac2\xfcQ\xf1w\x18\xeaf\xb9\xa5,\xa0ݎy\xedj\v\xb4F8\x8b\xd71VE\xd4\x1eD\xa4\x89\xd26Y\xabE+\x89I\xa4 \xa1f\xa9\x1b\xa8\x8c\xee\xc2\x02\xed\xb9\x1e\xadV\x8b(\x8e\xe9t\xba\xa4i\x8a5\x16k\fx\x8f\xd21J\xd4 cj\xedQ\xaaę*\x18E\xc6!?P\x83\xb7\rKm\xc2\x05\xa0\x02\xef\xf6\xdaۏ\xb1zL\xf0\xdb\u007f\xfc\x00\xc777\xf1\xde\x13E\x11\xc7V\x97\xf1\xcdE6\xcds\xca\xda\x10k\reE>\x19\xdfz\xea\xe4\xd0(\xce:g\xe1\xedZ\xf3v!e\x17\x1f\xfaͪ\xe9\xd6))\xb1\xd6\xe2\x9cCHA\xbf\x9d\xb1\x98\xa9\x069\xe9FJ\xe4\x9a\x04\x0f0\x85b\x86\xac$Y+c\xf3\xc4IN\x9c9\xc3ұ\r\xd2N\a\xa5\xf5\xf3\xb4\xf3E\xe8\xe4)\x85\x92*\xfc,\x15\xa1\xf0!\x97)\x81'\x84P\xe9A!𘀺\x9c=z\x9f\xaa\xa9\xca_u\xfbm\xecՒ\x0f\xdf\xff1N\x1c?\xcep0\xa0\xdd鰱\xbaBU\xd7\x18cq\xae\fE\"\x8cǣѿ|Qu\f\xb3,\xfb\x13!\xd8\xf7\xdewU\xf3\xcb\xd5\xd6\x11i\x85\x96\x02\x9dDx\xe7\xb1\xde\xd0M\x93p\x80Rrle\x8d\xc5\xfe\\(\xb4\x00\x1dE!\x14ʠ\xe3\xca:mZ\xdd.\xfd\xa5%\xe2,;Du\xcf\xed\x1c\xe3\x9b\xfe\xb9@ \x95\xc6[\x87T\x11\xba\xa9gl%\xf1R\xe2\x85@\"p\x80\xb0\xa1?\xaeu\x84\xd6\x16\x01h\xa5\x80\x9a\xe1\xd5\v|\xf3\xd7\xdd\xc7\x1f}\xe4\xa3\\\xba|\x85\xb5\xd5\x15&\x93\tu]sj\xe3\x18\x8f\x9f\xbf\x80u\x96\xaa6T\xc6\xfc\xef\b\xf1\xff\xde\xf2\x8eᡗ\xcc\xcdu\xa4w\xee\xe7\xbc\xe7d\xac\xf5\x91,'jB\x80nh\x96\x99\x99\xa1\x95f)\t\x87\xddjuH\xa4$\x02\xdaiڈ\xe9\x042\x8a\xc3՞$DiF{a\xe1\xc8\x18/\xc4\x19))q\x1e\xbc\xf1\b\x14H\r\xce \x11D\x02T\xa40\xc6\xe2\xb5>B{ʃ\xaf\n\x84\xf4\xe1=6(O\b\x81)K\x96\xbb-\xfa\xfd>\a\x83 O\xaa\xea\x8a$I\xd8\xdb\xdfgsu\x85'/\\$r\x9eʘ\x83ӧN\xe7/\n\x91\x83\x94\x02%ď\xc4Z\xbf1\x8bc\xd28\xf4\xad\xb5R\xb4\x92\b\xad\x14\x02\xa8LE\xac#\xe2(b>\t\xbft\x16'Hg\x03\x10\xb0\x16\xac\xc5;\axD\xf3\xff\x00\xa2$E\xea&\xb4\xbdp?\x9f`\x91\xeb\xe1\x8bF\xd0pط\t\xde\x15\xbe'\xa4B\xe9\b\x15%\x8d\xe0/\xa8JD\x03\xbb\xbdw\xd8\"\xe7k\xee}%\xf8\xc0&\xc4q\u0095k\xdb(%\x99ΦM\xfb\x17\x9fF\xd1\xde\xddw\xdf\xfd\xe2P\x9d\xac-ί\xc5Z\xfdt\xa4\x15J\n\xbc\x878\xd2$Q\xd4T\xbd\x8e\xaa\xae\x91B\xe0\x1c\xb4\xa4\xa7\x1d)\xe24\v$b\x9a4\a雐s\xc8$79F\xabP\xbd7\xc8\xcb\xfb\xeb\x12\x9c\x1b=\xd6\x18\x8b1\x0e\x8b\xc3\t\x81?TJ\x1e\xc64)\xd1Q\x8c\x90\xaaQ\xa2(\x84P\xe1k\xc8\x10\xf6\x84\xe0\xe8\x8f\x10\x8c\xf6v\xf8\xaa\x97\xbf\xf4(QUuM^\xd5X\xe7\x18\x8dFt\xda-\x94\x92\xd6z\u007f\xee˩\xf1nz\v\xf7\xe4\xfa\x8a\x14B\xbcCJ\xb1\xe6\x1aĥ\x95\nu\x8a5Xg\x8f\x9ek}\xc0'\xbd4\xd0(ZG()B\xb7\xce9\x9c5\xe0ܡ\x94(\x90\x96R\"u\x84\x17A\x17E\x93'|\x03\x1a\xbc\x0fF\xce\U000dacacq>\xd0\xe5\xd6Z\xbc\x90G\x88\xe8F6YGQ\xf0\x92@\x8a\"\x84Bܐ\x97\x0e\xc5\x18R\b\xa6\xc3}n?u\x82\xb8\x91\xce\x16\xb3\x19\xfdn\x87\xab;\xfb\xccu;\x81W\xd3\xda{\xfc\xf4\x96벚\xab\xf5\xbf\xf5\xf8\xb7\x1a\xebP2(KB\x0f\xa1\"\xafJ\xaa\xda\x04b\xb11R\xa45i\x1c7r`\x89\xc0c\x8d\xc1\x19\x83\xab\r\xce\xda\xeb\xfa\xae\xe6\xc0Tt]\xd8\xed\x9c\xc3Z\x8bu\xe1\x11\xfe\xed\xb0\xd6S\x19GYՔU}$l\xa3Ap\bqd\x1c!\xc3k\xdf(w\x15R\xe2\xbco*qE\xa4\x82\x17\xd5E\xceR\u007f\x8ec\x1b\x9bX\x1fj\x1a\xad\x14\xd3|\x86\a\x8a\xa2 M\x12\xab\xa42\xb7\xdc \xa7\xd6W6\x9cs?o\x8c\x13\x00I\x1c\x81\x94\x94e\x11ԁ\xb5!\x89\x0e\xc5\x0f\x0e\xef\x1c:\x8a\xe8\xb4\xdbA\x9b-B\xe5lM\r\xde\xe1\x9b\xca\xff(i\v\x81\x8c\"d\x93\x84\xad\xf7\x14\x95\xa5\xac-um\xb1\xd6Q\x1b\xc3`<i\x8c_Q\x99 %\xf2Z!\xb5FF\x1a\xa1u0\x80\fU\xa8oz9G5Rp\xb5&w\x05\x83\x1dz\x8fs\x8e\xf9\xb9.+++H!\xa8\x9b\xd0\x1ak\xcd4/)\xcb\x02!E\xad\x94\xbc\xb5\x06\x89\xb4\xc2X\xfbV\xe7\xfdrm\r\xad4\xc1\v\x98N'8\xe7\xb1.\xa0\x16Մ\x82\xb2\xa8QZS\x19\x83\xb05\x1e\x81\xe5\xfa\\\x89lp\xeb\xe1\xd5|x@RGM\x88\x02cC\xa1\xe9\xbd\xc0:Om\x1cE\x1d\xfa1\xc6Z\x9cwM>\x97\x87\x8ao\x9a\xceV\xa0p\x10\x87g߄3\u007fC+\xda\x1d\xe5/\x8e\xe4\x18\xe1\xfd$I\xcc\xd9\x13\x9b\r\xfa\x02c\fi\x123-J\xac\xf38\xe7\xce+\xf9\xe5\x19\xe4\xa6\xc1\xde\xf5\xc5y<\x1c\xb7\xce\x11kM\x14\xc7\xec\x1f\x1c\x80s\x81-\xad+ځpd<\x9d\x91e)yU1\xdf\xed\x12K\x81\x93\x12\xd9\xc4u\xd1hm\xa5\x92GW)\x9e@m4\xf9\xaa\xae\r\xd2\x04\xaf8<H\xa9\x14RG(\x01q\xd3\x13\xc1x\xbcUxc\xf1\xd6\x1d\xf5\x87\xbd\b\xf5\x87sA\x97\xe5\x1ba\xf8\xa1`\xda;\x13Z\xd1Z\xe3|\xa3\x8aiBY\x1cEl\xac\xad\x84\x03\x94\x82\xbc,I\x93\x84rV`\x9d\xf5\xa2(\u007f\t\xef\xb7o\xa9\x87\x18\xe72!x\x93\xf3\x9eV\xbb\xc5d:E\x00J\xe9@\xe4\t\x81\xf7\x82\xbc(I\x92\x98\xd2\xd4\b\x01\x91\xd6dZ\"\x8e\xaasqt\x85Z\xd3\x1c\x96\v\xe1\xcb[\x1b\xbas\"4Ì1\x8d)\x04\x02\x19\x8a\xcc\xda\xe0\x8cE8p\xb5\xc5\xd65\xce\x18\xea\xaa\xc0ԡ!%\x1a\xe4\xe4l\x93\xa3<8g\x1b\x1eMPWA)i\x9b\xe7\x1eʓ\xbc\x87$k\x93f-\x96\x16\xe6\x03\xa3мW%\x05\x12\x87u\xde[k\x1ew\xd6\xfa[j\x10%\xe5W\x19\xeb\ue335\xc2T\x15eY\x06v\xb5\xaa\x9b\x1e\xbbo\x92\xb9\xa4v\xe1J\x8f\x94&n\xe2\xb9h*f\x81\xc0\x12\xc4\x12!T\x05\xb4\xe5L\xa04\x9c\xa9\xb1U\x89\xb35\xa6*\x82\a\xf9\xeb\xc1\xc67s\x8d\xc0\x11(\xa8\xab\nS\xd7TE\x81\xa9\x82Q\xbc\xb58k\xf0\xf8#bQHIm*\xea\xaa\b\xa8\xac\t\x9f\xb5\xb5!\x94\n\xc1\xfc\xca\x1ai\xab\xc5\xd2|\x8f4\xcd\xc2\xef.\x02bTR\x80\xa7v\xde_\xa9엥\x02\xbay!K)\xf9-\xce;)\x10Lg9\xf3qD7nqPT\ff%\xce\xdbУn\x06[\x84\b\x95x'VMO]\x120\x16\xcdC\\GQe\x19\x02\xbd\x94\xa8:\xa1\xceg8k\x90Ɛt\x04*N\x10\xa2\x19\x8b\xb3\x0e!\x02Ov8\xbf\x11\xe6\x17\xc3\xfb,g\x13\xb4\x8eB2w\x1eS\x95௳\xd1֚\xd0ǯ\xab\xc0\xb5y\x8f\xf3\xc1(Q\xbbé\x97\xbc\x1c\xa15\v\xfd\xfe\x17h\x99+\xe3P\x92Qm\xec\xb9\xda\xdcb\x83\xe0\xb9[\x8a\x10O3%yű\x05\x16Z)\b\xc1\xd5I\xc5\x03\xe7\xb7(\xca\n\xa55\x1eG\x9aġ\x8d+E\xe8e7=\x15\x108\x0f\xb9q\xd4B\x908\x87\xabk|]\x13\xe79qY0\xb7\xba\x1a<\xc1\x86\x90\xa4Z\x1d\xa28iD\xd4a\xfa\xb76\xe6(\t\xdf\x18\x06\xeb2\xc7\xd4-t\x94\x1c\x85\"|\xf0\xb2\xa2\xcc)\x8a\x19y\x91\aH{8\xe7\x82@(\xcd\xf2\xe6IN\xdeqg\x93\xd8\x13\x8a|\xf6,\x83ؠ)\xfb5c]\xf1\xe5\x1e\xe3M3\x88uv5@[\xcb٥\x9e\xef%\xb1\x18\x1f\f0uM\u007f\xae\xc7\xd7\x1c_\xe1\xa3O_\xa1\xae*\x924\xa6\x95\xa4XgP\"&\x92M%.\x02Z*\xbdG*\xc9Tx\xf2錝\x83!\x17\xael\xb3\xbd\xb3\x83\x90\x8a\xb9~\x9f\xbbΜa\xa9?G\xb7\xd7\xe3\xf8\xc9M\xe6\xe7\xe7qQ\x8cR1B\xe9pȇCC\rB3U\x895\xf5\xf5BR4\xce\x01X\xef\xf0J\xe2\x94\"\xea\xf6P\xd6@Q`\x9dCG\x82v\xb7\xc7+_\xf7z\xda\xdd9\xbc\x0fb\xc0\xc3\xc2\xf7Г\xb5\x14\xb5\xf1\xeeWo\xd06\xdd\x1a\x83\xbc\xe4\xeci\xe1\x9c[\xb4\xcec\x9dc\xb9\x9d\b)\xa0\x98N\xa9\xab\x92\xe1dJwq\x81\xb3\xf3\x19\x17\xc6\x05q\x1cc\x8c%\x91\xa0\x1a\xa4\"\x90\xe0$\xa5\xf1\xa4\xb1\xc2h\xc9\xe3[;<\xfa\xf8S\x1c\x1c\x1c\x80\x14h\x11ƨ\xa7\xd3)[\x97\xaf4\xc2\x03G\x96e|\xcd뾎W\xbe\xfc.Ξ\xda\b\xb4\v\x02%\xa3\x00\x8f\xeb\x90\xc7\xea2\ak\xa9\x8b\x19\xb1\xd6HS!\xca\x1c\x19i\x92(\xa1*KFӂY^\x10\xc5\x11\x91N\xb0X\x86eN\xec\x05[\xfb\xfb\x10E,/\xccS\xd5e\xf0R!i\xf7晎\x87()\x9epVl\xe1\x1d\xb7\xd4C\xea\xbaR\xd6\xf99c,JH\xe6\xd2(\xc4p<\xdd\xc5%\xc0\xb3;\x9e\xa0\xf0t\"ȍ\xc5\v\xc7\xdc\\\x86h \xaa55>\xf2\x94@U\x95쎦<\xf2\xe8\xe3\xb4Z\x19\xb7\x9d=M\xe5=\xc6]ov8c\xc1;\xaa\xb2\xa2\xc8g\xdc\xff\xe1?\xe4\xfe\x0f\xff!\xeb\x9b\xc79}b\x93\x13\xc7ָ\xe3\xccI\xb2$\x03\xc2՜\x8f\x0e\x88\xd3\x16\xf9d\x88)\vp\x06\x84\xe4`<\xe23O<\xc3\x1f\xfd\xe9'\x98LGDR\x11\xc7)I\x14\x87\x99Ga\xd9\x1f\xeeS\x165\x91\x92\xfc\x8bw\xbc\x93\xb8\xd59\xaa\x93\x16\x96V\x18\x0e\aH)~%S*\xcfk\xfb\x1c\xf9\xe5_\xb1A<8cl^\xd65\xb2)\xea\xac\r\xa2\x86v\xa7\xdd\x10\x82\x9a\xd9\xf6\x1e\xd2y\xeab\xc6\xfa\xf2\x02\xb1\xd6(!\xd02\xc0μ\x9ab\xbd\xa3\xacK\x9cs\xbc\xe4\xc4&\x95\xf7\x8c\xaa:h~\xbd\xa3(\xaa\xa0\xfbr\x1e%@\bE\xb7ۣ7\xd7'/fl]\xba\xc4\xd5K\x17\xf9t\xa4ɲ6\xaf\xbd\xf7\x15\x1c_[\xa6\xdbnQW%\x991\xa8(\xa2\x8a\x82|\xf5\x99+[|\xf8S\u007f\xce\xf9\xcbW\x10QD\xbb\xdd!\xcbR\xa2\xa6\xe1UՂr\x9c#\x85\xe4\xe4\x89\x13\x8c'\x13~\xe7\x03\x1f\xe0\xcdoy\v\xbd\xf9yT\x94\x90d\x19\x93\xca>\x81\xf7\xef\xb2_&ܽ\xa9\x06YZYu\x97.^\x18Z\xeb0\xc0\xa42\xb4\xe5\xf5\xf8*\xa5$k\xb7\xd9\\\xf2\xec]\xdd%U\x9e8\nu\x87jr\x87\xc7S\x99\x12\xa1$q'\xc1\xd4\x06\x9bה\xa5\xa1\xb6\xa1\x10\x1c\x0e'8\x13\nAc\x1dy\xf3\xbdV\xac\xe9\xb5\x13\x16z}\xe6\xfb\xf3\x1c\f\x86\f\a\xfb\x18;\xe2\xdf\u007f䣼\xea\x9e{\xb8\xef\xa5g\x11B0\x9b\x85\xa9[\xa1\x14\x95\xb5\\\xdb\xde\xc6T\x15\xc7O\x9e`~\xbe\xdf\x00\x80F=\xd3\xe4\x02\xb9\xb1F;MI\xb3\fS\x1b6\xcel2\x9e\x8c\xa9\xeb\x1a\x9ddXks\x01\xdf\xe6\xbc\x1f\xf2\x15\xaaxn\x8aA\x1e\xf8ԟ\xb1\xb9\xba\xf4\x8bR\xca\x1f\xae\xad}bT\x94on\xa5:\r,\xad$Nbʲb\xae\xdba~<\xa3r9I\x1c5\x06\v8^HI\x94\xc5$\xfd\x16^@YV\x14\xe5\bS\x1b\xeai\xc1tVPW\x86\xbav\f\x9c\x84\xb4Ÿ\x18p\xfc\xf8\x06Y\xa4\x19Վ\xab\x97\xaeqǱy\xd6VWX\x98\xef3\x9b\xe5\xd4U\x89N\x12d\xdaBK\x81m\xea\x10\xe7\x1c{\x83\x11\xbb{\x03\xfa\xbd>\xb2\x93\xe1\xbdg2\xc9\x19Or\x8cu$q\x84֚\xc5~P\xb6\xc4Z3\xd7\xed\xf2\uabfa\x87\x8f=r\x91\xf1pHw~\x91V\xab\xf5\xf3B\x88\xcf\x1f\xb2\n_\x89\xb4ꦡ\xac\xda\xd8_RR\xfcrY{_\x1a\xf7\x1b\xde\xf3\xb6Ct3=\x18PNg\xa8,c\xb1\x95\xb2\x9f\x97G<P\xd4|\x96J\x90.\xb4Q\x91\xc2;O\xac[\x14I\xc1\\\xe6\xe8g-\xa6yɥ\xbd\x11\xc3z\xcaٳgY]]\xe6\x13\x1f\xff8\v\xf3}ά-\xf3\xa6\xbf\xf97y\xf0\x91G\xf9\x8d\xdf\xf8-^\xb6\xa1\xe8\xb4Ðh\xac5gn;\xc3闼\x9cv\xab\x8dR\x8a|6\xe5ܓ\x8fs\xe9\xe1ǩ+K+M\xd8\x1d眻\xb4˵\xc1\x94\xba\xb6\xf4\x13\xc5\xf1\x13\xa7\xb8\xb43\u0cdf{\x92W\xbf\xfc,w\xdeu\x1bg︃\xac\xd3\xe1\xf1\xf3\x97\xe8-.\xd1\xeet\x1eo\xb5Z?k\x8d9R9\xder\x0f\x01\x18Ng\x80\xf0\x00\xb1V\xef\xd0q\xf4\xb6\x90\xf0k\xecd\x02ƒ\x9b\x1a\x11'\xa8\x86\xca\x16\bR\x1d\xaas\x15\xa9\xa3\xbaAkM7k\xb1r\xe7\"W\xaf\\\xa3\xcaK\xe6:\x1d\xfass\xec\x8e&l\x0f\xb61\xad\x84\xe5n\v\u007fp\x8dh}\x8d\xaa,\xe9$1wm.\xd1m\x85\xa9\xdd\xc5^\x8f8\x8a\x90֓\xc51\xb1\x8e@\b\xda\xed.\x9d\xfe\x12\xc3є4\x8a\xd9\x1f\xcfx\xe4\xd2\x0e_{\xdf}<\xf4\x99\aYZ?\xce\xf7|盘_Z\xe0\xb1\xc7\xce\xf1\xdew\xff\x16\x83\x9d\x03\xe4K\x04go\xbf\x8dѬ\xe2\xdc\xd3\x17X9\xb6\xc1\xf2\xca\xea\xff\xfa\xe0C\x9f\x99\xdd\fc\xdc\xe4\x9e\xfau`\xf1\xe4\xee\xe8\xf2\xc9\xf9\xae\xc3#\xbd\xb5h)!\x12t\xda\x19[\xe3\xe9\xb3\x00H\xdc4\x99$\x02\xa5\x02\xfc\xf4އ\xa50\xbdy\x12\x15F\x17\xa6\xa3)I\x1c\xd1i\xb7X[\x9c\xc78G\xff\xd4F\x18\x05\x18n\xf3\xe1\u007f\xfb\x01\x847\x9c^[\xa0\xd7n\xd1\xefv\x88\xe3\x04)\xe3\x10\xf6\x8a\x82,m\x85\xf7\x89\xa4?\xd7'\x8b3ZILwq\x91\xd5\xf55t\xac8\xb3\xb1\xce`<\xe4ɧ/p\xef|\x8f\xf3O\x9c㶍E^z\xfb\x06\xf3\xfd\x16\xf5l\x86#\xc6\xd5\x05\xb7\xbd\xf4\x95Dq|~\xf6\xc0\x9fr\xb36\xf3\xdd\\\xa1\\\x93\f\xaf\x8ef\x17u\x92\\\xcc\xda\xed\x93\xc2\aڽ\xd3\xed0\xd7\xebB\x9a\xf2\xc4\xd3W\x8eZ\xa9J6!K@\x16\xc7a\x14ن\xe9\xd7$I\x90=\x89\x8e4\a\xe9\x90\xd9dF\x90\x86\x84\xe1\x1d\xad\x14\xd6;\xea\x867J\xa2\x88$\x8eȚ\xc1\x1d\xad#\x8a\xd22-K\xf2YI\xb7w\xfd\xeaig-\xe6\xe6\xe6\x98\xeff\x1c\xdf\xdc\xc4z\xc3t6a>Yeo0\xe1\xf2g?\xc3\xd6ße\xa1\xdf\u196f\xbe\x93,\x8dP\n\xae<\xfci\x8e\xbf\xeau\xbcꞗ2\xc9:v\xed\xf4\x89K\xc5o\xe6_`\x10\xef\xfd\xad7\xc8\r̯UJ\xfdq\xaf\xdf\xff\x9e\xc9\xfe>:\n2Q\x10\xccw\xda\u0378\xc0a\x1b70\x9cB\blU\aO\x11\x02-\x15^@\xb7\xdbA\x00\xb1\xd6\xcc:\xed\xb0\x04\xa0\xaa\xf1a?\xfeG\x00\x00 \x00IDAT\r\xba\xd42:R\xa7$QD\x1cG\xb4\xb2\x94$N\x9a^G\x89\x17\xd9u\xf0\xe3<\x0e\u007f\xa4!n\xb5\x12\xe28\xc2:\tY\x9b\x93\xc7\"\x8e\xaf\xcd\x1fu\ves\xc1\x88\xe6\xfdNGCv/^\xe2ew\x9e\xe1R{\xf9\xa9\u007f\xf7\xfe\x0f\\)\xf3⋪pn\x9dr\xb1!\xe8\x04P:\xff\xe1~\xbb\xf5=\xf5x\x82V\x81Z\b\x92P\x81\x94\x1c\xf50\\\xa37P\x80\xb4\x16\xe9<Q\x1c\x91\xe9\b%\x04Y\xd6\"\xee-2\xdd\xdfEOƴ\xd3\x14g]\xa0\xde=h\xadC\xab\xb8\xc9Ki\x12\x13E\xa1B\xb7\xc6\x06\x115\x16Sۣ-\x11B\xd0P\x1d\x9eHGd\xad\f!\x05\xa3\xa1\xc7\x1a\x89\xa5Fb\x82!\x1a\x95{P\x95̘\xcdJ\x84\xd0\x18\xa1\x10\x82\xdf~\xf4\xcf?\xe3\xc2k\x8a\x17_ȺQ\xa5\xf6\xa7\xe7\xb6\xf8\x1b/=I\u007fy\x91z0\xc0\xbb@oW\xb5i\x16\x969\xbc\xf4\x18\xe7\x89e\x80\xbeA\xd8\xe0\xd1\xd6\x13KE]V(!H[m\xd2\xde<\xf9\xc1\x1ef<\u0096\xc5\x11\xa9'\x1a\xbe\x8a\xa6\xb7\x12e-t\x9cbʂ|<\xc69\x8f\xf4a\xde\xdcZ\x8f\t\xa3g\xccf\xd3\xc0\x18KA\x9a\xa5t\xba\x1dZ\xed\x16\x83\xfd\x01eY\x82\x0fR\xa4Hk\xb2,\r}\x9d\xfa\x1a\xc6L1\x1e\xe6\xd66\xea\xb1\xe3]\xbf\xfaK\xbf\xccw~\xd7۞\xa5k~V\xdb\xf9\xc5`\x10!\x04Oo\xef\x1d\xdf=\xb1\xc2m\xcb\xf3\x8c\x8a\x19\xdaA\xec%\x85\x17\r\xb9\xea\x1b\x15|S\x87\xf8\xa0\x92\xb7\xb6\xc6\x18\xb0\xb5\xc1\xd6\x15uY\xa0\x8b\x19I\xda\"]]\xc7/\xae`ʚz6\xc1T\x05Κ \xfb\xd4\x11Q\xda\n}\x15[#\x9c\xa16\xf6\x86\x96\xb0\xc4Z\x1ff\x0f\x95\xa4l\xe6\x06\x9d\xf3\xd4Uh\xa0u\xbb]:\xed\x0eeUb\xaa\x1ak\xeafʫ\x85w\x16}\xb0\x0f^`\x1d\xa8$\xf9|\xa6㧾\xe9\x1b\xbe\x893\xa7O\xf3\xf4\xf9\xf3_q\xb8\xfa\x8ff\x90&\xe1\x1e\xffܥk\x9c]Y \xe9\xf7\x99l\xef\x10\x15\x05\xa32$l\xe7\fJ\xa6T4b4\xef\x11ւvXkȋ\x9cv\x9a\x91Ogh\x1d\xa3\x92\x16\xaa;\x8fh͑t4\xe9\"\xd4\xf9\x043\x1b#\\\xdd̢9\\\x99\xe3\xf20\xfdT\x96a\xccZx\x8f45\xb3\xdd]\x9c\xb3\x90\xc4\xf8\xda\xe0\x1d\x18k\x18\x8f\xc6\xcc\xf5爢\x18\xa5%q\x94\xe0\xb2 \xd4v.\xf4Uʺ\xc2\xd9\xc0\x12GI\x8aA\xfc\xfe\x0f\xbc\xf1\xdbܧ?\xfdi\xce=\xfd\xf4\x8b;d))\x89\xb4\xda<\xb7}\xc0\xa3\x97\xaf\xf1\xb2\xe3\xeb\x94s]\x9e\xbc\xb6\xc3\xf9\xd1uу\x92\x12㡶\x9eػ\xa0T\xf4\x0eo\xc3\xe8[Q\x16\xc4Q\xc4l:FGMg\xb1\x05V\xea\xd0\xf1\xcbGG*ug\r\xbe\xccq\xe5\f[\x97\fǳ \x13m:\x8auYR\x1f6\xa6\b\xfb\x1d\xb5\x8e\xa8\xaa\x9a\xd9l\xc6d<AIE\x9a\xa4\xc1\xb6ƆƖ\xb3\xc1\xb8E\xd1h\x85=\xad^\x1f\x13G\x0f\x03loo?+\\ݸ\x84\xe7Ec\x90X+\x94R\x9b\xde9\xfe\xf8\x91\xf3ت䞳\xa7H\xb3\x94\xf6p\xc0g.\\\xc1\x98Ї\x164\x89։ t\xb6\x16\xaf%\xb5\xad\x99\x159I\x92\xa2\xaa\x8a\xd9xL\x1b\x81\xb2\x16\xa4\xc6\xd9:<\xd7\xd6\xf8\xba\xc27\xd2!k\r\xe3iNU\x05j\x9e\xc3\x16\xaf\x0f\x03\x99J\a\r\xb1D07\xd7e\x9a\x8f\xe9V\x15\x93ј4\x89\xb1U\xd9l\xbe\x93G\xfd\xf2\xaa\nDdQ\x968\xefI\xbb]\xce\xcf\n\a0\x1a\x8dn\xea\xd9\xfdG1H+Ke]\x9bcRJ\xc6yο\u007f\xe4<\xc3\xe9\x94W\xdfy;K\xfd\x05\x92\xad\x1d\xc6\xd5u\xb5\x87\xb5\x0ek\x1c\xdaZ\xb0\x0e\x84\xc5!(\xaa\x92\xe9tB\xa45\x85\b\xd025U#\xeb\t\xd0\xd9\xfb\xc3\x03\x0f\xa2\xb5</(K\x17\xc2K3\xae\x8cs\x18S\xa3\x9b>\x8c\xf7A#\x96&)\xc3\xf1\x01\x93ل,K\x99M3Zi\x18^U\xbe\x11\xf8U%\xc6\xd4a\xd7J]\x83TDi\xcax0\f\x17\xdf\r\xfb\x8a_T\xd4ɳ\xea\x10c\xfb\xde\xfb\xce\xe1P\x8b\x97\x92O\\>\xe0\xea\xde\x03tb\xc5\x04\x89\x95q\xe8k\xf8`\x03k-\xd6\xd4h\x13f\r\xbd\x90TeA\xa94\x93\xc9\x04\xd5S\xe4E\x81u\x96\xa4\x99\t<\x145\x1c\xeeH)\x8b\x82\xb24X+\xd08T\xa2\xa9KC\xed\xfd\xd1\xf3\x85\x10a\xe3C\xa7K\xb2\xbb\rx\xaa\xba$/fL\xa6)Z\t\xe2(\xc2\x1f*\"m\xe80\x16eAU\x96\xc88Eꨑ\xc1\xc2d2y\xded\xfe\xa2\nY\x1e\x96\x81TɰfC*\x85W\x92s\xe3\x9cV\fK\xdd($\xe0F-Ry\x82\f\xd4J|m\x82\aP\xe3#MQ\xe4\xa8f\xe1r\xa7\xdd\t\xf1\xdd:\x10ͪ>\x04\xd6Z\xf2Y\x1eB\x14\x92XA\xaf7\x1f\x86L\x9dgoo\x9f\xf9\xc5%6\x8e\x1f'\x89c\xb4\xd6Xk\xb9\xb4u\x99(\x8ap\xceRU%E\x91S$qPTj}D\t͊\x82\xc9lFU\xd7dK+(\xa5\x91\xcd\xf0ꡇ\xbch+u\xad\x14J\x88M\x9aU\xe3\xcaˣ^y\x9ae\x80\xc5I\x05ֆ\xdc\x11EX\xdfhi\x9d\x0f\xb5\x81\xd7a\xf1\x99\xf7\x18k\xa9\xaa\n\xad#\xf2<?\x9a\xe6=$'\xf1\x1ek- \x88\xa3\x988N\xb0e\x85\x94ͺ@\x0f*\x8aё&KST3O\"\x84`a~\x81\x85\xde\x12\xc3\xd1.eU\x91\xe73\xa4Tt[\x19Z\x19\x9cw\x94eI^\x96\xc1Cꚥ\x85\xc5\xd0\xe5\x14~|\xa3\x87<\xcb;\xdc\xf5i\xe4[n\x90$\xd2DZ\x1d\xf7\xdec]\xc0\xffއ\xd9\x10!\x05\xd8P\f:\xeb\x82J#\xc02t\xac\x8e\xd4\x1f\xd4\x06\x1a\xf1\xb3o\xc4\xd7\xc6\x18\x9c\xcfC.PQ\xb3MT4\x13N\x11\x9dN\x97\xf9\x85%\xa4\x94|\xe2c\x1fgj\x05\xdb\xfbC\xfa\xbd9\xd6\x17\x17\xf0\u05ee\xb2\xbc\xb2\xc2\\#R\x18O'\x14e\xc9\xe2\xc2\n\xd7\xf6\xf7p\xbel*\xfc\xb0\xaf+IBH\xad\x9bݏyQ⽧\xb7\xbc\x8aW\xca%\xed\xecQ\x80\v\x17/>/m\xf2\xa2)\f\xa3\xb0$\xf9ՇT\x8a\x90P\xd7A\xa5!\bK\xfa\x8d\t\nu\xd74t\x1cA\x14\xa7\xe28$\x14\x1f\x12\xb2\x10\xe1\xff8\x82PN4\x8aE\x87\xc0yI\xac\x83G\xcc\xcf/\xb2\xb4\xbcJ\xabբ,Kf\xc6s\xee\xc2%fE\xc9\xf6\xee\x1e\xcf\\\xde\xe2\x8e3\xa7\x88\xdb\x1dn;}\x06!%O>\xf5\x14O_\xbc\xc8\xe7\x1e~\x84\x9d\xc1\x0e\xcb\xcb}nof\xed]\x03\x02D\xb3\xef$\xaf*\x10\x10\xb7\xbal\x9c9\x8b\x93\xaa\xda\x1a\x0ew\xad\xb5|\xe2\x81\a\x9em\f翢\x9a\xe4\xa6n\x94\v30*\xf2\xf0\xa6ÕJ\xb6Y\xc9t\xf8\x1c)\xc04ޑW\x15=\xda\xd4M\xe8\xd1q\x82p\x1eo*0\x16\x1f\xd6S\"\xf1\xf8\xba\x04\xad\x90R#\xa5\"\x8e\x13ZY\x9b\xe5\xd55:s\xa1\xef\x01\x90$\t\xaf}\xed\xd7r\xe5\xea\a\xd9ٺ\xcc\xe8`\x9f\xb4;\x1f\x84\x13\aC\xae\xee\xecҟ\xeb\xf2\xd9\xcf?ʃ\x0f>\xc8t:&i\xa5L\xf3\xe0}\x87y\xc3\x1e\xeemi\x8a\xc1\xba6\xdc\xf37\xbe\x85\xb4\xddfw:\xbd\xf2\xf8\xe7\x1f?\xa8뚽\xbd\xbd\x9b\xe6\x1d7\xddC\"\xadQJ\xdc\xeb\x9c;y8\x97\x17\xb6{^\x1f\rSRbL\xf8%G\xd3\x19k\v\xf3\x14\x96f\x14@ \xe3\bk\r\xf8f\x9c\xadQ0\xd2\xd4\x14\u0381N4\xddn\x8f\x8d\xe3'\x89\x9a\xc5\xfbyQ\x04z\xc4{\x86E\x89\xedv)\x96\xd7h-,S^\xbd\xc2\xde\xd6e\xa4R\xec\x1f\f\x00\xcf\xd6\xd6e\xf6v\xae\x92\xb5;X\ts\xdd.\xf5\xa1f+\xf28'\x11\xd6 \x10\x14e\xc9\xe6ݯfi\xfd\x18\xa3\x9dm\x0ef\xe5\xc7\u007f\xf9\xed?\xef\x9e~\xfai\x1e\xfa\xdcgo\x9a1n\xbaA\x92H#\xa5|km\xc2\x1c\x88h \x97\x94\x1c%\xedCoq\x1e\xf2\xb2\f\xc2\x03\xeb\xafW\xd5\xcd\xe8\x9ao\x14\x85\xdeY\xbcSGb\xb7\xb07\xab\xcd\xe2\xea*\x97\xf6v\xd9\xda\x1brq\xf7\x80\xdd\xc1\x88\x83\xc1\x80\xd1lFY5\xfb\xad|\xf01\xb9\xbe\x0e\xb3\x19uU@\fӢd\xaf\xac(\xfb\x8b\x18\xa5P\xce1(j\xd2iN'\x89\x9a\x83\x95G\xca\xc6tn\x9e3/\xbf\x87\xabO|\x9e\xf6Ҫ\x1f\r'\xbf\t\xf0\xf0\xc3\x0f?\xabB\xbfq\xb5ȋ\"dI\xa5bk\xdd[\x9d\xf3T\xb5i\xe6A\x82&JIIe\xeb\xa0Ro*\xe7\xdaZ\xf0\x9e\xa9\x15\x94N\xd02\x06\x9d$\b\xa9@Z\xbc\xe5HH-\x10H\x1d\x91\xa6\x19*M\xf9\xa3O\xff9\x0f>u\x81iQS\xd5U\xb3\ueec97\xcfY\xf6m\x9c\xa3\x8a\xe2\xb0ʣ\x1c\xb3\x93\x97ԇ\xb0V\x85-@\xe7\xf6'\\\x9d\x14\x9c\x98o\xb1\xd9\xef\x92\xc5q@}\xdes\xe2%/g\xba\xb7\xcb\xf4`\x9f\xd6\xf2\xfa\x85?\xf8\xe3\xfb?\xf4\xd6\xef\xf91\xde\xfb\xbe\xf7\xdd\xf4\x92A\xdf\xccd\xee\x9c\xfb\xeeZ\x88\xb3\xce\xda0\x02\xad\x9b1\xb0\xaa\n#\x04\x88f\x1fUӜj\nB-#\x0e\x8c\xa4[\xd5$\xed0h\x89\x94M\xd5\xde\xc8q\xa4 M3\n\xe3\xf9\xd0G?ŵ\x83!U#,x\xa1\x0f\xe7\x1cyQR6C\x99\x87 \xe2Yϱaq\x81s0-k\x1eߝ\xb0?+\xb9c\xb9\xcf\\\x96\x86\x85h\xed\x0e\xfbW.\xa1\xa2\x18\xaf\xa2\xf7\xbc\xf3\xdd\xef\xab\x1e{\xec1\xde\xfb\xbe\xf7^\xf7\x90C\xc5\t/\x92J=R\xaa\xe3\xe1\u007f)k\x83\xc0oEB\xacGZ\x93\x97%J\xeb0\xeb\x87\xc7w[\xb8\xb1?\n[\a\xa31\xabˋ\xecX\xc9jU\x91TU\xb3]'\x8c\x9b\x05]\xbaD\xe9\x18\x8f\xe0㏞\xe3\xd2\xeeA`mo\xec=4\x037J\x85\xc1\x9fH)\xfai\xca\xc1xL\x95\x18\xfa\xed6\xc7\x17\x17ɢ\x88\xdd\xc1\x88\xa7\xb7\xafqm2\xc68\xdf\xf4S\x04B\x87\xdd\xed\xfb\xa5\xe5\xa1kC^s\"&I\x92fqMMgi\xcd}\xe6\xd1'\xdf\xf3\x9a7\xc3\a?\xf8\xc1g勛u\xaf;\xfd\xa1\x0f}\xe8+~\x11g-ֹ\x1f\x93\x82\xb6\x87\x9f\xd0Jmfq\xf4\x13E\x15f\xfc\xd2f}\x1eQ\x98\x01\xb9\xbe\x01\x1b\xae\x1c\fX\xec\xf7\x98\t;U\xc4\xd3\x19Y\xbb\x15\xb6.p8}\x1b\xc4vO_\xdd\xe5\xfc\xeeA\x98\xce\x15\"\x8c\xb8!P\xb5e.\x16̷\x13\x16:\x1dz\x9d6K\xf3Kas\xb5\xf3X\xef\xe8\xb4\xdaDQ\x04\xde3\x1eN\xd8he\xec\x8cG\xec\xe5SvfS&eŤ\xb6\xd4\xd6\x11\xa7\x11\xb5L\u061dV\x9c\xec\xf6)\x8b\x1c<Lj\xfbg\xbf\xf8\xee\xf7=\xfe}?\xfe?\xf1;\x1f\xf8\x9d\x1b\xa0\xae{^\xba\xe2ҥK\xdcx\xbeox\xc3\x1b\xbed\xd2\xd7o|\xe3\x1b\xff\x12\xb0\xf6\xf9oe\xd4ﴔ\x87\x8b\xdes[+\x89QR<1-+꺦\xd7i3\xab*\x8csh\xa5\xb1\xea\xd93Bye\x18L\xa7,'}.U\x12!=\xeb\xaa8\x12?\xd0\xcc\xfe\x8d\xa7\x13\x1e\xba<\xa0*\n\x84\n\xe3\xcd\xccf\xac\t\x8d\x9f\x96\xe4\x93\x19[\xb5᪔DYB\x94$H\x15\x86\x87\x9cu\xf4\x97\x16X\xdb\\'K\x12\xb6.\\a\xe7\xda6y\x9ec\x9dE\xe2\xe9\tIK\xc2A\x16\xe1\"\x85\xa9rv\xaa\x94ے\x14kj\xd2\xee\x1c\x9f\xdf\xday\xc7C\x9f}\x98G\x1f}\x94\xcf<\xf4\xd0\xf5\v\xf2\x05\xc2\xe6G\uefdf\x8f\xdc\u007f\xff\x11\xf9Y6 榄\xac\x17z!!\x04Umle̯\x83 I\xe2\x9f,\x8d],jsa.\xcbTQ\xd5\x1b\xc6:\xe8e\x88\xcd\x05\xcc`\x86\xd0\x12o\x1c\xb2\xc9'W\xf7\x0eX\x99\xef\x93[x\xa6\x94$Z\xb0\x18;\x84V\r\x05\xee\xb92+\x18\x17͘\x19Atp\xcf\xc6\nw\xad\xac!\xbdB\xf9\x88\xe1x\xca\xce\xde\x1e\xfb{\xbb̦3\xcab\x1a\nP\xef\xb8:\x9ar\xf5܅g\x85\x16!\x04I;\xa3\xdd\xeb\"\x84\x0f{\xb4\x8a)\x03\xebq\xca2vM\xbf\xa6,\xf0\xed\xfeS\xbf\xfe\xef\xfe\xf0\xdf\xfc\xfd\u007f\xeay\xef{\xdf\xfb\xc59+\xff\xe5\v\x1e\xfeB\x06\x91\rI\xf8|F\x89\xb4\xa2\xa8\rx\x98\xeb\xb63\xe7\xfc+\xca\xda|K7K>e\xac}\xb84\x16ߊ\xf1gV\x90\x9d\f7\x9c\x85xM\x90\xfeH`\x94\x17\xec\x0f\xc7\xf4{]*\xeby\xa6\x94\x88$e\xc9\xd7\b\x04\x93\xcapa\x1avl\xc9f\x16\xb1\x9bj\xdam\x89\x8bJ\xaa\xdacjK\x92\xb6\xb8\xfb\x8e;H\xa2\xbb\xa9\xeb\xb0{\x11$yU\x87{\x89\x1cR8\"\xac\xc9\xf0\xc25\xd3Æ\xbd\xf1\x1e\xd7f\a\x88\x99f<\t\x14\xfd\xca\xea*\xb5J\x02\t\x9ad\xff\xdb'>\xf9`}\xf1\xe2E\xfe\xd9?\xffٿ\xf8a\xff%IF\xfd\x175\xc6\vKHMH\xbbA\xdaSX\xe7\xfeN\x16E\xe4e\xfdV\aK$\x1a\u007f\xfb\x1at3de\x8e\xea\x83Cu\x8a\x04\x8c\x87\xcb\xdb\xdbt\xdb-\x84\x92L*\xc33\x13ɸ\xd3B:؛\xe6L\x8b\x8a\xa3\xdb'II'MY]^D+\x81J \x15P\x16\x15\x8f\xed=\xc5ިf\xbd\xb3\xc0\xda\\\x8fn\xabC\xa7\xdbi\x12\xff\xf5\v\xd8X\xc3h:\xe2\xea\xe0\x80k\xa3\x1d\xa2\xc8 \x94'K#\xc4Ԃ\x80\xc9h@t\xfa\x14\xb2\xd3~dk0\xfe\r\x80\xdf\xff\xfd\xdf\xff\x92T\xf7W\xc2\xfa\xea/\x15\xa6\x9e\xcb\xf1?\xd7K\x0e\x97 \v!\x99\x95\x95\x8f\xb4\xa6\bӱ\x9f\xf5\xeb\xbd)+\xbd\xb6\xcf\"\x84s\xc8\xca\x04(\xfbl\x81\n\xdeø\xa8\xb8\xb6\xb3\xc3\xfa\xda*\xd6z\xc6yIm-&l\xd6i8H\x15\xba}2(\xe6\xb5\x02\x1d+:s\x19\xa6v\xc4i\x8dЊ(\xc9yfw\x87\x87\xae<M\x0f\x98\x8bSz\xddy\xe2(P\xeby\x95s0\x1erq2Ff\x11gVz\xa4Q\xf0\x84\x9d\xbc\xc4\v\x88\xa3\x18!\x04\xf3\xabk\xb4\x17\x16\xff\xe9\xdf}\xdb\x0f\x98k\u05ee\xf1\xf7~\xfc\xef_/noL\xe6\xcf\x17\xb9\xbe\f\n^\xffes\xc6\v\x85.\xdb \x9e\x1a\x83\xd0\n\u007f\xdf\xd9\xdb\x11\x84\xd9p\xe7\xf18\xd4\xe1\xac\xf8a\xef]@\"C?\xc4\v\xc1\xb5\xfd\xfdp\x1b\xa3n\x1b\xebaR\xd6Gkॸ\xa1\x00\x95\n-\x03n\x96J\x82\x848\x8b\xc8\xda\t\xadN\xca\xe1-)?>-xb0#\xb69\xc9\xd5m\xe6\x148\t5\x82}+h\xb7\x12^\xbd\xda#\x8b#\"-)M\xcd^\x1e\x10\\\xb77\xcf\x1dg\xce\xd2\xed\xf5\x1f\xf7R}\x00\xe0\xfd\xef\u007f\xff\xf3{\x84\xbf9\xe1\xea\x05\r\xf2\xa5\x90\xc0s-\u007f\xb8\x04\xc6\vp\x91$\xfa\xf6\xaf\xfe\xebn\x9c\xff\xb6;\x98\xa4G\xa1\x0f\x81\x1c硹\xe4\x0f\xbf\x16\xa4\xa4m!0M\xf5\xfe\xcc勜>q\x82\xb4\xd5j\xb8\xaf\x10\xa2l\xd3?Q\x87#\xd4>l\xc5\x0e\x903\xb0\xc8q\x14#\x95\xa6\xb7\xe00\xc61\x97Dl+\xc1c\a9e\x1d$Aq\xa4\x90Bpb\xa1ëW{\x1c[\x99G)I\x91\x97\\\x1d\x8e\xd8/,\"\nwx8~\xea\x14Q\x92\xbe\xe3\x8e\xfb^o\xc6\xe31\xef|\xe7;\xbf@\xcc\xf0Bw\x01\xfb+iP=\xaf\xa1n\xb8w\xa1\x17\xa0\xee=\xb3\xe4k\xf3k\"R\xa9h%\xf8Y\x89\x90\x82hZBm\xaf\xbf\xfbf\x12Y\n\xc1F[\xe3\x10\xecL+jg\xb9|\xe5\n\x1b\x9b\x9bt\xbb\x1d\xb4\x92\x88F\xc7[\x1f\x1aD\brc)\xea\x9a\xd4DA\xc9(\x1d\xce\a\x1d\x95\x8e\x14Q\xa4\xd0\xcd\xf2\x9b\xba\x19\xc7V2,\x92I\"\xcdr+\xe6\xec\xda<ssm\xea\xda0\x9a̸t\x90\xe3\x9a\v\xa5\xbf\xb0\xc4\xfa\xc6\xf1\xfd\xc1h\xfc\x9e\xb7\xbf\xfd\xedh\xady\xf4\xf1Ǯ\x8b\xf3nXT\xe3\x8fn\xd6\xf8\x95y\xc7\xf3\x1a\xe4\x85n\x0e\xfc\x02\xda\xd1\xebw\xf6\x94л\xf36i|\xf4\x0e\xa7\xf5Iy\xbc\x8f\x1dL\xb1\xd7\x06\xf8\xfd\tjk\x80\xc35T\xd3u]\xafj(\x95\xb9D\x01\x11\xfb\xb3\xd0 \xda\xdd٥\xdd\xca\xd0\xc9\xf5\xd5\xe1\x91\x0f\xfa`\xef=\xa5\x17\\\x1d\x15a\x93\xe9\xb0@ǆ\xa4\x95\xa2\x95\xc4\x1aGe\x1cU3eu4\x8b\xa2\x15i\xac\x99ke\xd4eI\x9a\x86<Q\u05ce\xab{#v\nK\x98\xcc\x16\xac\xae\xaeQV\xf5\xbb~\xe2\x9f\xfc\xcc\xe0\xea\xce~\x13\t\xc4\xf3G\x88\xe7\xe4Zn\x96P\xee\xf0\xe0E\xb3\xba\xe8\x8b\x06.\xc1\x11#\n\x10/.К_\xf8!\x1b\x8b\xefȧ\x16\x1fGķoPI\x89\xad\f\xc6{\xa2\xc3$(\x04\xc2{\x9c\x0f\xdbtJ\xe3\x10\xa9\xa6\x97\xc5x`\x92W\xcc&#vv\xf6X__\xa1\x95\xa5\xa1\x81\xe8\x0e\xe7\xc6%\xde\t\xaeL\x1d\xa9\x9e\xb0\xd2m\x11U5\xa6\xb6\xc4ID1+\xd9\x1eL\x99\x19Ka\x83\xdc(\x8dS\xd28:*\xe4\x16\xda)\xd3Y\tB0\x18L\xb88\x98b\x9cC\xa8Щ<vl\x83\x87\x1f{\xe2\xfe\xfd\xd1\xf8\v\x0e\xe2K\x86\xa3fE\xc7W\x94\xd4\x0f\xb7\xee<\xfb\xe6\xa9_,|ɣ'\xc6\xcb\xfd(\xe9\xcf\xfd\xac\x90\xf2\x1fD^\x89\xaa\xa8\xa9\aS\xa2\xbb6Q\xbd\x163k1{\x13ܕ=\xa4\xb1G\v*=PXOִu\x93H\xd2\xcbb\xac\xb5\x14\x95c\u007fg\v%\x05\x1b\x9b\xeb$\x91j<\xa5Y\x02\xa0\x04\xb9\x85\x8b\x85d;\x9fя%si\x98\xcc\xda\x1a̸<\xcc!\x8a)\xac\xe3\xec\xda\"\xe7\xae\xedSV\x10k\x891pq∟\xb9F7\x898\xa8,\xbb\x95on9\xee\xc1x\x16\x16\x16\xf8\xd3?\xf9\xd87\x18c\u007f\xf7\xf0\xea\xbc1L=\xd78\u05fd\xc4\u007fEF\xd1ב\x8b\xfc\xd2\xd6\x17\xcf1\x06\xd09\xb1\xb9\xea\xbc\xf9M\xef\xdd돊E+\xa9\xb6\x86\xd8\xc1\x94hc\x91\xf4\xeeS\x14\xc6Q\f\xa6\xb4\xcc\x14)\x05\xce\x06\xe3W\x0ej\xe7)jK\xa2\x15I\xa4\xe8\xb7S\x86x\\]s\xb0}\x85\xda\x18\x8eo\xac\x85\xbc\xa0u\xa36\t\xf7\xda-\x8c\xc3*E^z.M\n\xac\xb1\x81֏\x13b)yɉ5\x9c\xf7d\xb1f8\xcdq\xa6&\x8d5J\n.O+t\x11v\x9ah\xa5\x82\xa2\xde:N\xde~\x17\xe9\xda\x12\xb3\xaf\u007f\xe9\x8fd\u007f\xf6\xa9sӧ\xaf\xfc\"\xe0\xbe\xfa\xbe\xfb\x90B\xf2\xc0'?qÑ4\xfa0\xf1<\xa1\xaa\t\xe9\u007f\x99{\x1a*!DXq\xf7\x17p\xc3\xc3\xdb\xcd\x1d~\xac\xde\xf7\xaa\xd7\t\xad\xfe\xc0\x14\xf9=q\xabM\xdc\xe9\x86!\x1c/\xb08\x8c\xb0\xe8\xcd%\xf4\\\x1b\xb4\xc48\x87\xdb\x19\x13\x19Gt\x98O\x0e\x8d( \x8b\x0fG\n\"\xd2H#\xf0(\xef\x98Ng\f\xf3\x8aN;;\xba\x11\xb0\x94\xb2Y\xc5\x11*x\xeb=NHPaQ\xd9\xe1\x1e\xdfH+\x928\n\xaf\x19kL]\x12+\xd90\xfa\u05ef\xf4\xba\xaeq֡t\xc4\xd7\u007f\xf3\x1b\xf9\xa0\xbe\xc6tsA\xdf\xfd\xfa\xd7\xfe\xad*/^s\xf0\xf4\xe5\x0f\xff\xe1\x87\xfe`\xfc\xbd\xdf\xfb\xbd\x14y\xc1'\x0e\xef\xb5.n\xac\xc5n\x8c/\xd7\x1f\x87_\xff'?\xfd\xd3_\x12\xc1\x8a\xc3%\xfa\xee\x86\xfa\xc0?71\x1d\x19#\xbc\xd8\xeaW\xbfJJ\x1d\xfdw\b\xfe\xaf\xe1勉3\x86\xee\xda:*M0UI\x9c\xb5q\xb1d\xda\xf1D\u007f\xed\xa5\xe8c\x8b\xf8\xbc\xa4<w\x95\xfa\xfc5\xf4\xfd\x8fҾ6\x84f\x13\x83\x12Џ%+mM\xa7\x19\xa0QZQ\x14%\xe3\xc94\xa0\xa0\xd2\xe3\xe3\x94\xe3k˴\xdba/|\x1c\xc7G\x9b\u07be(\x1b\xed\xc2<IU\u05cc\x86\xc3뻱\x0e\x87\x86\x9a\x15M\x1e\xb8\xede\xaf\xe4\x89\xe1\x88\a^\xb1\x808\xbe\xc4\xe9\xde</\xdf8\xc3\xd6\xfd\x0f]z\xe0\xd7~\xfb\xbf\x1e=y\xe9O\xbd\xf7<\xfc\xf0\xc3\xfc\xdc\xcf\xfd\x1c\xef}\xff\xfb\x9e\xc5>\xf0E\xc2\xd4\xe1\rg\xbe\xb8\x8cJk\xeaff\xe2FJ\xc3\xdf\x00io\xfc\x85W\xef\xfd\xaa9\x15%\xbf(\x94\xfcoF[\x97\x85\xadJp\xee\xe8\xee:\xe3\xc1\x0e\xd1lLwi\x8d(\xf7\x98sۨ~\aъ\x89N,\x87\x9d#_/(?\xfa(\xed\xab\xa3\xb0\xde\x1bGi\x1d\xa5qD\x95Ak\x85F\xd3j\x06i&\x93\x19=Q3\xa9\n\x9e\xba\xb8\xc5J\xbfK\x9aƤiFw\xae{4\r\xf5\\\xc4s\xe3\xfb\x0e\xf3!\xf6Y\x8bh\x9csͮ,G\x9c$X\xeby\xea\xf2U>ue\x8b\xd9x\x97\xe4\x9bS\x9e\x16p\xb5\xfc,\xaf\xfe\x9a\xdb7_\u007f\xe6\xc7>\xb4\xf1\xcd_\xf3?\x0e\x1e\xbf\xf0\xae\xe9\xd3[\xf9/\xfc\xc2/\x00\xf0[\xef{\xef\x17G]\u007f\x89\x0f\xe9\x9bY\xf2F\x85\xc0\xe16|q\x830\xe1\xf0\x87\xac\xdew\xef\xcbT\x96\xdeo\xeb\xeao\x0f.\x9e\x17\xe5h\x00\bZK+\xc8F\x01蜥\xcc'L'\x03T\xe5\xe1\xc2>n8\x85ڢZ\t\xf1\xc9U\xd4\xda<\xf5k\xef\xc2\xcee 4\x9e\xb0\xf0xV;\x8a\xca\xf0\xffWv.1reg\x1d\xff\x9d{\xce}֫\xbb\xaa\xab_v\xdb\xed\xc7\xc4\xf8\xc1$\x9e\xf0\nL@\"r\x902#\x05\x12i6Y&R\x84\x80\rR@\"\x93h\x00\r\x99\r\x1b\xa4\x91\x06\x18\x10\x9a\x91\x10\v\x14\x16\b+\x91\xc8L,\xbc\b\x89B\xf0$3\u074c۞ر\xdb\uedbb\xaa\xab\xaa\xab\xee\xfb\x1c\x16\xa7\xaa\xba{\xe20\xa6\x96\xb5(ս\u07fd\xdf\xf7\x9d\xef\xf1\xfb\xa7Y1\xddt\xaaD!\xd5j\x85 \xf0h\x84\x8a\xaaS\xb2\xd5\xe9\xb37J\x18\x8d\x86lom\xb1\xbd\xb5M\xaf\xd7ۿ\xc9\aތ\xc9ӛ\xe7\xf9\xf4F\x99\xf1p\xdd\xc4+\xc81\xbb\xb7_J\xd67n\x91\xf5\x06ȭ]\x86o\xac\x93\xfd\xa4CZ\x96|\xe7\xce\x06ݪ\xa8]\xf8\xdc3/W\x8e\xb4\xafE'\x97>\xdcl6y\xe5\x95W\xf8\xfbW\xff\x8e(\x8c\x0e\xa5B?\xabU\xf1\x811\xc4\r\xfc1\x93\xdb\xfcT\xf4\x9e\x80\xc5T\xe0\xb7fΜ\xfe\xa2\xc1\xbc\x1eww\x8e\x8d:\x0f(\xd3\xd4\xe2\xeejU*\xed\x05\x8c\xb1*\x04Y\x1e\x03\x86\"\x89\x91~`3\x8d\xc8ř\xad\x82\x92\bW\xe1T\x02\x8a4\xa3\x98o\xe0>\xe8cF\xf9\x18\xf7mp\x1d\xdbV\xf5<e]\x97#\t\x02\u007fz\x83\x950\xb8\xa6dw\x94Qh\xf0\\\x89њ,\xcb\xc8\xc7\x02\x94\x13\x85\x9e\xd1(\x06\x04\xa3\x91E.%IJY\x16\xd3=\x0f\xab;\xe23ۚ\xa7\xb9\xb4\xc2ڏ\xef\xd0\x1f\x8eȓ\f\x91dHcH:\tZ\x18d\xbbF'\x1d!k\x01g>\xf6\xd1\x16\xb9\xfe\xf4\x1f\u007f\xe9K?|\xed\xf5\xd7n\xbc\xfa\xf2+<\xfb\xec\xb3<\xd8\xdef}}\xfd\xd1\xe7f\xe0\xf9ǉ!a\xbdf\x9f\x9a\xb1\x86\xe0\x04W$\xab\x81\x10J\xfe\xaa\xa3\xd4\x17\x1c\xd7\xfd\xac\x90\xb2f\x11{\xf9T\xb1L\x85!Ac\x86p\xb6E\x9e\x8cHF\x03\xe2t\x8f1\xa1\x12\x11x\x84\xad6\xba]\xc1\xfb\xc4\x05\x9c\xd9\xca\xf4-,\x1e\xf6H7\xee!\a1\xd1\x1b?\x82\x87{\x04\x8e\xa1\xaeJ\x02\t\x81\xab\x98\x9fk\xd0hT\xadfz\x9a\xd0\xed\xf4\x18\x0eGdYN\x9e\xe5\xf4s\xc8QԪ\x11\x95П\nV\" \fC\xd2$yD\xe6h\x89\xd6\xcb+\xab\bS\xd2j5\xf1\xfc\x80o\\\xfd>w\xb7w(\xb4!/\vҼ\x00_\xa1\x17Z\xa4\x8bM\xdcsKT\x9eZE(\x87vP\xe5d}\x9e\x9d\xef\xad\xe5k\xff\xf4Ϳ-Gɟ\fon\xf6vvv\xb8t\xe9\x12o\xbf\xf3\xce#\xcf-\x8f\x13CDX\xaf!|\xe5\xe1\xc9\x15\x9d\x16\xab8\xe2\x84P\xce)\xe1\xc8O9J\xfd\xfc\x18\x065ƺ\x8e\xf3\xec1\xaeկ\xd5\xf1\xa2\nn\xb5J\x16\xef\x11\x0f\xfbd:\x9bP\xf0ml\xa9Dx\xadY\x9c\x0f\xaf\xe0_<\t\xca\x01\xe1\x80\xd1\xe4[]қ\xf7\x91yI\xf5ͷa\xb3\x87\xefh\xaaNI(5\xa1\xefqbu\x99F\xbdBQ\x14\x8c\xe2\x84^\u007f\xc0\xde`H>\xe6\xf1&\x85F\x1bAll̩\x86\x1e\xcaq\x0e\x9c\xaa\x0f\xe6%\x82\xb3\xe7\xcf\xd1\xd9\xe9rdu\x95Q\xbfǕ\xab\xdfe\xa0\x1dJl\xc5 \xcb\v\n\xad\xa7\xc2\xc4x\n\xbd\xdc\"\x99o\x12\xfc\xc2q³\xcbxJ\xd1\nk\x1c\xab\xb5(7;\xbc\xf5꿬\xf57\xb7?=\xbay\xef\xfa\xc6\xc6\x06\xcf=\xf7\xdc#\x8d\xf2X\x06\x89\x16\x9a\xdf\xc5\x11O\"\x1dϺ>\xc72\xd6\xc7Cn\x13<\xebdf\n\xc0Q\n\xbf\xde\x00!\x88f\x9b\b\xa9H\x86=\xe2р\\j\x84\x1d굵+\x03\xa2\x1a\xa2ڳ\x04\x9f\xb8\x80<:g\u007f#\xf2\x01C~\u007f\x97\xf8\xed[(\xe9\x10]]C\\\xdf\xc6\x13\x86YU\xe0\n+\x06|ju\x99z-\xa2(K\xbb\xed4\x8c\xe9\xf5\x06diF\x9e\xe5V\xb0\x12ȌC\\\xdaa9\v\x98QV\x97\xd7⊬\x8eHXa\x98i\xb4\xd8\u007f\x9b&J\xbc\xc6\x18ұA\xf4x\xd0\x1b\x80ZH9?K\xb6Ԣ\xf2\xf4i\xdc#\xb3\x84\x9eGӯ\xb0X\x9d\xc5\xef\xa7\\\xfb믯un\xdc\xfa\xf5\xe1{\xf7\x1f^\xbdz\x95K\x9f\xfc\xe4\xa1x\xf6\xf8\x069\xda>\x94\xdf:J\x1e\x9aS5\a`\\B\bܨJ\xd4l\x92\x8d\x868\xca%j\xb6\xecN\xe0^\x8f$٣\xf4%f\xbcD\x89\x14\x90\xda^\x86\xa8Gx\x8bm\xfcg.\xe24B\x9c(\xc0\t\xec\xae_\xd9\x1b\x92\xac\xdfA\xa79\xd1{[\xb8o\xac\xe1\x95%\x15\x91\xa1tF\x18\x86\x9c}b\x85J\xc5Jj\xdb\xf5\xe4\x84\xdd^\x9f,\xb1FٿxA\x89\xc3\xc8Hrm\x9fz\xedȱ\x01\x04%rʀ\xff\xa9f[Y\x92\x17\x96\r9\x91\xba\xd0\xc6؇k\xaeA6?\x8b^h\x10=\xfd\x04^\xbbNP\xc0\\u\x86\xb9\xc6\f\xd5X\xb3\xf1\xcfo\xfc\xfb\xe6\xb5\xf5g\xba?\xba\x91]\xbe|\x99\xdf\xfe\xcc\xef\x1c\x1a\x9c\x8bG\xa3\x0f4\x88t\x1b\xd5\x17\xde\u007f\x127\akNB \x95K83Ku~\x91J\xabM\x9eČ\xfa]\xaa\xed\x05K\x84.\v\xb24&/R\xf0](K;\xa3\x1bx\xe0JDQ\xc2x\xab\xb6\xcc\vܕ9ܹ\x86\xad\x1bI\x89\xf0=\xd4lՖR\"\x0f\xfd\x91\xe3\x88\xdd=\xf2\x8eUC+Ә\xdd\xfe\x90J%$\xf4\xbd)\xcbJ\xb9\xee\x14\x96<ͨ\xc0&\aB\xef\x97\xee\x1di1\xe6c \xbfyDa\xc8\x18C^j\x8b\xfa3\xfb\x0f\xa0\x1eo\v\xa3$NQR\xb8\x1eEg\x88Z\xacS\xba\x82R\x97(\xd7\xc5\v\x03V?r\xeed\x18\x84K_}\xe9\xc5\xcb/\xff\xe9\u05cc\xab\\\xbe}\xe5\xdb\xd3\x1b\xfb\xfc\x97\xbf\xfc\x81A]\xfaO\x9dz\xc1x\x12\\\t\xe58\xa8\x8fg\x94T\x14\xe1\xd5\xeax\xb5\x1a\xd2\xf3\xd1eA\xdc\xeb\x90\xee\xf5\xf1+5\x82j}|1\x9aA\xff\xa1-C\xfb\xee$\xe7\xb4.\xae\xea#\xea\x11\"/!\xc9у\x98r\x10\xa3\x16fp\x17f\xa7\x86w<\x17Y\x0f\x91\xb5\x88\xc2\x18\xd2\x13\xf38\xbe\xa2\xbc\xbd\x8bF\xa2ӄ\xceήUc\v}|\u007f\xffPh\xc6%\x8a\x83hW\x01(\f\x1e%\n\xab&\xea`Ph\xdc\x03\xe3l\x13\xe3\x14cf\xbc1\x87a\xfdӉ\x12G |\x97\xb2\x16\xa1\xb5\xa0\xd8Kp\x17\x1b\x14\x9eM\xa5=\xe9\xa2<\x97\xc5\xe3+\x17\xb3\xc10\xfb\u05ffy\xed?^\xfa\x8b\xaf\xb1\xb1\xb1\xc1\xda\xda\xdacgY*\xf8\xcc\xc71I\x86\xee\r\xd1\xdb]\x8a{;\x98;\x0fѣ\x94,\x19\x91%\xa3iuvz\xb1FP_\xb0\xe0\x90l8@U\xaa\bGbL19n2\xddU\xd3\x06*\x01&\xf2\xe1n\a\xfa1Żw\xe9\xed\x0e\xa9\\\xbaH\xf5\x97\xcf`J\x83N\xac\x92\xb3\xeb)T\xb3J\xd1\x1d2\x8a|\xd4\xf16\xe6\x1b\xff\x8d\xde\x12\x94e\xc6\xfa\xbb\xb7\x19,\xb79\xbaشe\x16ϣ\xd4\xe5\x94\"\x94\xc4\tY\x9a\x1d*\xf4y\xe4x&߯>\t\xbb2\xf7\x90*\x89\x91\x94ƌټ\xfb\xed\x81\xf7\x17\x10\x8d\xab\xc8\x17Zh\xdfnL\x95\x0f\x06\xe4\x9d!A5\xa0\x9f'\x04\xe9\x90\xc8\xf5p=)N\xff\xc6/>\xff\x83{\x97\xbfU\xaf\xd7\xff\xf3+\xcf\u007f\x857\xaf\\\xa1\xbb\xd3\xf9\u007f\x14\x17\x03\x1f\x19\xfa\xc8\xc5&\ue4e7\xecv\xd3\xc3\x1e\xc5\xcd{\x94\xef\xdc\xc2\f\x12\xfbG\x1d\aa\f~T\xc3Q.\xfd\xedM\xca<c\xa6R\xc5Q\n\x9dY܅p\x15(\x89\x19\xc4\xf6\xad\xd3\x1aQ\r\xe0\xe7\x96\xe1\xfa}\xe8\xc7\x18!\x18^y\x8br\x18S\xfb\xb5\xf38\x91\x87\xc9%&\xcd1E\x89۪\xe1\x04.y\xa3\u00a0Y#x\xeb\x16\xc5\u007f\xbd\x87\xea\x0e\xb9\xbd١\xd3\xed\xb1\xbcآ\x12Z\xb0\x98\xd1\xc6\xf6ڥ\x85դIj\xc9\xd8\xefS\xfe\x99\xf4o\xb4\x90d\xa5\r\xec\x13P\xf2\xd4UY \xf2\xf8;\x83iTH\xcf\x1cǸj\xbf\xd5\x19y\xd6\xe5\x8e+\xd7;\xe9\x1eJ\bB屴\xd8\x0e\xcf\xfd\xd6\xc7_\x0fW\x17\u007f)\xfe\xf1\xfd\xdd\x17\xff\xec\xcf\xf9\xbd?\xf8\xfd\xc73\x88\x89\xed`\x00f\x9c\x19I\x89l\xd6PK\xb3xgW\xd0O_ \xbf~\x97\xfc\xda\r\xf4\xfd.&\xb3=\xe7a\xe7\x01\xe9p\x80T.\xc6h\xa4T\x14\x93\x8ag\xe4[\x89\xedab3\xad$C\xd4B\b}8\u007f\x14\xf3\xf6\x1d\x18Ę\xcd.ɻw)\x87\t3\xbfy\x11\xa7\x1a`\x94\xc4d\x05:N\x11\x9e\u009d\xab#<\x97\xb8\x16\x92\x9cY&\xb8~\x9f\xe2\xdam\x8a\xed.\xa3\x9b\xf7\xa8\xd5\x02\x16\xdb3(\xe9L\xbb\t\x8e\f\x90J\x92\xa79Y\xb6\xbf\xf0?\x9dP\x17\x82>>\x1aa]\x95և\x0e\xc6bl\fm\f\xba=Cvb\xf9\x901D#$<\xbb\x84\xbf<sh\xa0{+\x1ep\xac\xd6B h\x9f>\xf6D\xfb\xe4\xcaKsgV\u007fw\xf3\x87\xef\x9a\xef\xbco\xb1\xe7gƐ0S/\xe4w6\xed\r\x98̷\x96\x1a\xe1)\x84\xef\xe2T|\xd4\xca<\xfeS\xa7\x11\xed\x19\xf4`\x84ً\xc9\xf6\xf6\xec(\xa7\xe3\x10\xd6g(ui\xc7\xfd\x95©\x87\b\xdf\xc5\xec\xc5\bm\xac\v\xf3\x15B:\xc8\xe5&\xcc\xd51;\x03\x883\x18\xc4h_\x91\xdc\xde\u009b\x9bA\xb5\xea\b\xe9\xe0\x84\xdeX\xd3\x03\x1c_!+\x01&\xf0H\xe7\x1bd\x1fZ\xa481\x0fZRnv\xe8\xf7z\x88\xb1\xfb\x92\x8e\x9d\xcfu\x94}S\x94T\bGLW\x05\x84\x10\x94¥Cp\xc0U\x1dn\xc1\x1ac(\xeb\x11\xc9\xe9\xa3\xe4\xcbm\x90\x0e\"pQ\xcb\rԑ\x19*\x17\x8e\xe0-6\xec@\xf8\x81Oˏ8\xd7Z\xa1\xf3\x93\xfbD\xf5\x1a\xddۛ\x17\xefݸ\xf5\xfd\xaf\xfe\xe1\x1f]?\u007f\xfe<\xcdf\xf3\x83\x83\xfa\x89/|\xfe\x85\xea\xfc\n~\xe9#\xee\xf6ɾ\xf7?dw\xeeQ\xf6-\x9bJH\x89\xac\x058\xb5\x10\xef\xd4\x02\xfe\xc5SЬY\xc3\xf4G袰\xbaM\xbeO\x92\x8e\xac\fD\xc5G.\xce`v\x06\x984\xb7\x81^:\bW\"\x94\xc4;\xb5\x84\t=Lo\x04{\ttG\x10\xb8$\x9b;\b\f\xfeJ\x1b\xc7wq\x02ϺI\xc7\xc1Q\x12U\tp|\x97B\x1b\xd2J@\xf2\xe4*\xf1\xc7\xceR<q\x8cg\xdfͰ\x00\x00\x00wIDAT\xbd(\xa4\xe8\f\x88\x8ar\\\x9a\xb7\xcbAr\xac\x8e\xe3*ׂ/\vC\xb7pɄ\"/\xcd\xd4-1\x91\xfb\xabG$'\x97H\x8e\xcec\"\x1fw\xb5\x85\xff\xa1y\xbc\x13s\xf8Gf\xf1\x96gp\"ߺ\xab\x03\x9f\xaa\xf2\xf9\x95\xc5S\xe8~\xc2\xe5\u007f\xf8:Y\u007f\x8f\xfaLݹ\xf3\xd6\xfa\xd3/\xfe\xd5_\xfe\xe3\x9b\xff\xf6\xcd\xe1G/>ő#G\xfeO\x83\xfc/\xa7<\xb1Q\xe7\xba{y\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_03_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00/szTXtRaw profile type exif\x00\x00xڭ\x9ci\x92d7\x8e\xad\xffs\x15\xb5\x04\xce\xc3r\b\x0efo\a\xbd\xfc\xfe\x0e=\"\x95R\xa6\xa4j\xb3\xa7,)\xa3\"<\xfc\xdeK\x02g\x00@w\xe7\u007f\xfe\xdfu\xff\xf9\xcf\u007f\x82ﭻ\\Z\xaf\xa3V\xcf?y\xe4\x11'_t\xff\xc7?\xe7\xeb\xef\xcf\xf7\x82\xcf\xef\xbf\xdf\xff\x84\xaf\xff\x06\xf7\xdb\x1fD\xfeN\xfc\x9d>\xdfl\xf9\xeb\xa7\xe9\xeb\xfb߯\xaf?\xfe\xe6\x8d~\xf3\x83P\xfe\xf2\v\xe9\xc7e\xe2\xcf\x17n\xf3\xeb\xfb\xd1\xc7?\xdd\xd1=q\xf8\x9f\xff\xe9\u007f\xfc{\xef\xee\xf7\x9e\xcf\xd3\xcd\\Y\x86\xfay\xa8\xcf%\xdc\xf7\xdb\xf0Bc\x95\xd2\xfb\xb5ʟƿ\x85\xaf\xdb\xfb3\xf8\xd3\xfd\xf4+d\xbf\xfd\xf2Ɵ\x15F\x88!\xf9\x1br\xd8.\xccp\xc3\t\x9b\xbfWX\xdcc\x8e'6\xfe\x8eq\xc5\xf4\xbe\xd7S\x8b#\xae\xe4SHY\u007f\u008d-\x8d\xb4SO1\xadxRJ٥\xf8\xe3^»\xeex\xd7[\xa1s\xe5\x1dxi\f\xbcY\xe0W\xfe\xf1\x8f\xfb\xb7\x17\xfc7\u007f\xee]\x9e5\n\x04\xd4[\xa7\xf0\xd9\xe0\x18\xb5\x0fA˘\xf4_^Ɔ\x84\xfb\xb5o\xe5-\xf0\xf7\x9f\x1f\xff\xb8\x9f66\xb1\x83\xe5-s\xe7\x01\xa7\xb7\xcf[X\t\u007f\xc4Vz\x01\x90x]\xe1\xefO|\x85\xb6\xb5k\xf1EI\xe6څ\x9b\t\x89-\xf05\xa4\x12j\xf0-\xc6\x16BN\xb1\xb3A\x93;\x8f)Gc\aB)qs\x931\xa7TٛN\x1cqm~\xa7\x85\xf7\xdaX\xe2\xe7\xfb\xa4\n\xfbSRM\x8d\xbd\x19i\xb2Y9\x17\xe2\xa7\xe5N\f͒J.\xa5\xd4\xd2J/\xa3LWS͵\xd4Z[U\xce͖Zn\xa5\xd5\xd6Zo\xa3͞z\xee\xa5W\xf2\xb2\xf7\xd1\xe7\x88#\x91\x92e\xd4\xd1F\x1fc\xcc\xc95gv\xb3L~{\xf2\x8a9-Z\xb2lŪ5\xeb6l.\xc2g\xe5UV]m\xf55\xd6\xdcq\xa7\x9dw\xd9u\xb7\xdd\xf7\xd8\xf3\x84C(\xb9\x93O9\xf5\xb4\xd3\xcf8\xf3\x12k7\xdd|˭\xb7\xdd~ǝ?v\xedkW\u007f\xf9\xf3\u007fص\xf0\xb5k\xf1\xed\x94^\xd7~\xec\x1a\xdfmMo\xf4\xde\"\bg\x8a\xf6\x8c\x1d\x8b9\xb0\xe3M;@@G\xed\x99\xef!稝Ӟ\xf9\x11Ɋ\x12\xb9ɢ\xbd\xd9\xc1O\x17*[\x98O\x88\xe5\x86\x1f{\xf7\xc7\xce\xfd\xd7\xfb\xe6X\xeb\u007f۷\xf8\xdf\xec\x9c\xd3\xd6\xfd\u007fع\xe8N\xfa˾\xfdf\u05f6\x90p\xbd\x1d\xfbd\xa1\xd6\xd4'\xb2\x8f\xd7\xcc\xd8\xf9\x1f\x8b\xfd\xf5\x85\x9f\xa7\xb6U\xf7I\xde\xda\xf5\xe5\xf4\xd5J\xb4\xd1+O\x90ʙ\x16f\xaf\xe9\xb6Q\x9a\x19`z\xdb܍\xdb:%\x114\xcdݓs\x18\xfc*\x17fK\n\xc0\x9d.\xd7Z)\xb3fD\x00\x88\xb8s\xe2\x17Wl\xf7\x86=\xf9:L\x1e\xbf\xdc;}=\x00\xe3m'8~\xab\x9f\xdd\x16\xaf\xe6Mڎ\xefm\x80\xb4=\xa3\xbe\xda>\xb5\xe2\xf5\x95]?\xde%\xb8\x80\xfef\xf1\xcf\x0e\xed\x9e\x1elv\xd7\xee\xbe=\xeb\a'Y\xb9\xfcV+@ﹽ\xed\xa3\x98\x88e\x13 u\xccZVN\xc1\xfa\xealB\xef1\x97n\x87EH\x17TIn/\u07b2v\x9f\xf6l\u007f\xdc3K\xf6u\xd7\xdc\xe5\t\xef.u\xe3\xc5N\b\x97\x1d\x0f\xad\xaf]\x86\xe5k\x95\xa0\x88\xe6b\x1c\xd7r&\x8c\x0fW\x87\v[\xba{\xa7\xb2xi\xb3\x15\xd2\t\xf5\xfaT\xf8e[\xa5\xa53\xac\x85i{\xb2j<=QrȅS\\\x1eM\xe0i\xc4r\xc9\xf3\xb0\xd4c\x9eF\x92\xed\x1bxֲa\x95åx\b.\x90o%\x16\xcaZ\xed\xe4\xb9\xc7\xdaU\xf9\xb0\xe7\xea0m\x8f\x8d\x05\x1c5\xee\xbbʾ\x06\xb6\xedHv\x84\x16\x13\xf7\xb6\xca=\xfa\xbb-\b\xac\x8cT'\xbf\xdd (\x05\x81\xb5\xb3\b\xe4\rB\x9aAQ{\xadtm\xa5P\x96Ͷ\x1a\x1bD|{\xc1\xed\\\x95U\x0f\n\x84~\x10\x1ce\xb3\xa2\xab%;\xa7\xf0\b03\xe9\x19\xfdu\xdca)7ν\xc9\xf59\nwx2\x81C\xee66&쳹\xd3\xdaX\xc0lm\x00\xd1\xc3j\x99\x19\x06_\xa6o\xdcJ\xf6\xe5\xeb\xf8Im\xa7\xec\xcdއ\xb1j\xe2\x8dn\xb6\xd0Va\xf5[X,P\xda^\xb4\x0f/\b\xa2\xcanC\x0f\xbbx\xb8S\x90\x00\xe4\nP\x1bڈ\x8d\xf8\u007f9\xc3*\x92\x98\xd3,\x9c\xc1/\x93\xa8\xac\xa5\x1f\x99\x94\xabm\x96;\x9a\xb5~\x01V\xeel\xf0\xe3;K\xcb\xc4\x1e\xd7wl\x86\xc2,\xf7\xcdKa\x9c\xe9G\xea}\xbe\xc8\x01\x8bm\xc5{\xb6\x16ᄡD\x19!\U000ee9ed\x8b0\x98\xb9]\x1bD\x8c\x05\au\x11\x1b\xfbz\x02|TmR\x991\xb79\xce@e\xec\xb0J\xf4uwk\\\x02\xe0J\x10\xb4\xf6\xd9x\xc1\x05\x97\xadͻ\xd7\xe9\xc3\xf95\x92^\x9f/\xc8\xc8\r\v\xafS/\\~\xe7>\xef\xe0\xc1;\xe1p}\xad\x80\x9b\xb5\xeaY\xe4@F\xb2I\x93\xc7/l\x83\xbf\xbd k\x8eR#\xacMt[\xca6\xb6\x1d\xa2$\x97\xe1\xadr\xb1t\xee\xb4\xca\u007f\x01\xce[&a\x963؛\x88\xb5\xa6\xd8n\xb9r\x87\xc3\xc5\xc0\xf3̻,\xd7U\xf2\x80\nj\x8a$\xf0=\xe0\rKp\xbf\x81\xe0\x1b\x06> \x00\x04\x8cM¤Ŗ\xd5y\xa7\xf3\x1b\xa0%\xedn.\xdc+x\x98\xd6ȡ%\xae\xdba\xf8SV\x1c\x10\x8a\xd5\\\x8etS?`\x8d'\xe9l\x02Q\t\x98i+\x87:\\\x05[;I\xd6\xf2\x04\xa4\t쒈Ιw;g\xa7\xe3\x0f\xef1\xf2\xb2\xbb\x14\x16\x16\x93)q\xf3a}-\xa59w\xb2[;\xd2/\nP\x81k\x1e\x99\u07fb\xdcC^\\:G\xa9G=\x04\xdbw\x00\xd45\x00+ϯ_\x9f\t\xd66[%\x1d\tz\x12W\x9b\xe5b\x11\xf7%\xdd\xff\x9a#\f! \xef'\xfcOR:d\t|\xfa\xef\u007f\xbb\xaf/\x1a\xe1\xbb\xdaV\x18\xb2\xe0\xb5\xf0\xf8\xfaZ;\x00\xf0\xff\x80\xf8_\x11\x9eD\xd8\x05Tt{\x14e\xe5\xac\xe23\x00\xf5\xc4\xec\xcdH\xc3\xc4/\x00t@T\xab'\x97H\xd4\xccM\x02\xee\x15\v\xb8VX\xcf\x06b\x1d\x9e\xa4\xa1\x92]\xabu\x11\x94ˇ5\x8eG\xa9\x9d\x17`\xd5\x10\xc1`9$\xccN\xd6ԉ\xbc]*\x97\xb9`\x02\xf1\xc6Ҙ\x14\xf4JZ\xeds\x1c+\xc3\x0e\xc0\xa0'\x11\xf5\xa0)\xec\xd3=9\x0f\xbd\xad\xb07(\x87\x1f\xa8DEق\xb0\xc1\r\xf5UH\x9dfA\x84\f\x8c\xa3>\xba\xe3\t\x88\x9aU\xc1..|\x06\xf2\xe2\xa6Åv?]\x90\xc8\xddݼ\xc8\x1f[B\x01\xf2I4\xb2\xd0\xf7#Zo\x04BFc\\W\xe7\xe1q\x89\xb6\xd4w{\xb1\xc5^\x82\xee\x85\x1c\xe6A\x9a?\xdc(\x1a\x04ʕ\xb9hqN\xf1~\x8b\x90B\xb6^\x97\x1d\xf4\xcei.\xf4\xb96\x8fW\a\xb7ď_>CD\xfa\x8f\x00\x1ah\x8f\x97l\x90\xbeY\x04}\x9c\x9dg/~\x92\xd9π\x118\xb9[u\x17lп>\x9f]\x8b\xb2,\xbc Pv\x8bT\x11n\x92\x9b`'\xde\x06\xbc\x8c\xc6Ƒ#\x93\r,Sر\x1f\xbd:\xe4\x06w\xbav\\e\x8ez\xa0\xc1\x90\xfb\xc1G\xa6S\xd7E\x01N\x02\xb4K\x9f\U00058b39\rP\xf3\xf0\x82R\x89\x92\xb8FK\xe1\xacZ\xdd\xe9\xda`E\x8d\x87\xfb\xc0\x99H^\xda-\x88\xec\xc6j\x10L\xb6\xee\x87\xeb\x9f\x02\xb8嘈+\a\xf0-G 5\x9d\xc9O\x10\xa3y\xf2:\x10\x8b\xacȠ\xe9cl\xae\x17`r0\x04]\t\xa2\xd8{+\xa2\x0f\x05r3{\xd5\u061c\xf1\x11\x15\xec\xc0\xbd\xc5\x1d\xf3\"h\x03YPC\x04\xc0\x02\xaf\xf92\xcf\x05\x86\xed\f0_ݩ'\xfd\x94\xc8(+\xe8\xaeB,-\x03\x156\x01θ\xdau\x98\xcb:\xe7\xe8\x02\xda\x05ҏ\v\xed\xcf\r\x1e\xc0\xf8/a\x12\xf7^,\xe4\xc3v\x03\x9b\r\xa6\xe9\xdc%oT\xae!C\x1b\xeb\x82\x1aa'\bp\xb0\xa2K\x19T\xe8\n0\xe27y\xfb\x02V\xf6\xceO\tv\x00n`\x05\xfb^\x9e\xb8\xf2\ai\v\x03\x14h\x1dE\nF\x1em\u007f\xe2\xce\xec-\xe4n04\x02\x94\xbd^\xda\xfa\x84\xb4@\xf1\x12\xbd\x13\xb6';\xe1\xfd\x0e\x91\x96\x8a\x98\x801\x89V\xf3\xdc\xfe\x9a˝\xd2uӥ\xf3{h\x91\xd0H\xdc\xc0\xd2\x1c\x18\xdfD\x14\x91\xf8H\xb8d\xc8C\x92ZRD\xc8; \x97\xecI\xf3#\xe9u\xae[\xfd\xae\xa3\xbcc\xe9\x1aA\xc4;\xdc\xc8\x12\xed\x1b\xc1\x14\x12\x17\x82${r\x02\xa0\xe0F\xddJ$\x81\xc0\x1cR\x14b\x1f\xb7-\x14\xa09C\x16\r\"\x8eT\xe1\xadW\x1f\x85\x8d\x03\xde\x15\xa2\x88op\x80(\xb1\x81LH\xe2\x12\x044{\xce\xcf\xf1?\xc4\xd7&\x1fe\xd7[w\xef\xf7\x10\xbe\x1d\xf6JB,\xb4\x01܊<\\\x03\xc1\x18!\xe7\x85tB'\x13\xc0\xe8A@\xa0=:g\x11\bT\xb6\xaf\x03H\x17^\x1b\xfe\xfd\x00w9\x03\xd0^\xf9τ\xfbp\x99Y\fH\xc6\x10\x83\xe3\xc5\x16\xab\xcc> a\x1br)gL\x02\x8bok\xc9#8\xbb\x1d\x19\xa9\xfb2\xcf沸ؙ\xf4|~\a\x8a\xed\x10\x98\x8d[\"\xcb\xd9\xc26\b\xf3\x8c\x13\xff\x05\xfd\xdd_\xbf\x01\x0e\xa3\xa9Xc\xc4\x1e\xca,\xf7\x0e\aw\x14\xaf%\x82u\xc0>F>o\xc9F\xf4\xa0\xd4\xc26\x92\xb38\x04\x16\xe8Z \x1fr%@\xef\x03\xf7\xb4\xf19\xc1\xf3#2\a\xe6,\x1e\xc2؋\x14!\x0fPS\x03Y\x87=\x91Z%U\xec\x11\x8ek3\xb21\xc0\x84\x89\xc1\xb5'\x967\xb28\x12\xfb\xc0\n\x1b\x8b\xb2\u0097\xa3\xb2\x16\x0e\xb0\xb7$\xc1\x96%~\xaf\x02\x0eR\xb0m,\xf6@k\x02$\xf5\xc0\xdd\x15\xe7\x8er_\xe8Je)\xb6\x89\xd0\x06ϳ\xa4\xde!E/\x1a\x01<\xef0\x81\x89\x12\x8c@\xeb\r-Yͱ\xef\xacs@\xa4\xb20\xe8RR\x01\x1f\f\x82\xda\xc0\xd8@\xe5\x05\x91R\t\xa7m\xbb7Y\x1e\xdf\xc6D\xab\x9d\x03#ŎրY\xa0\xa3\xd1\xc8\xd7\xc4[t\xa0Ӡ\x90\xc2- ~\x06\xdc\xc72,\xe9]\xd6jx\xc4ZC\xcd\xe21-\xb0\x9dBZ\xe20\tCH\xe3\xe3\xe2\\\x874\xf7ah;T/8J\xea!\x1d\xc0M\x94\xcb{r\xf3\r\x00\xc5+\xf4E\xfc\xac\xd3\x02\xe8\x9eb%\"X\xc2\x01N.ץԃ\x12\xb3\x02\x87\xfcLΟ\xc7_\x92\x8a\t_\x02\xe7\"U6q\xbad0xX\x92m\x0fC\xf4L$\xfe\xd3Cf\xb8\xec\x80Vf\xdb\xe0e\xb4.<\x92Aur\t\xcb\xee\vz\xe2B&xR\xe0#b\x8e\x91\xf9\v\x9a\x957#\"\xb2LǨr\xe7\xc1\x11\xef\x84ݑ\x1eF\xd0K\x97ŉ4\xe1\xb1j\xe0顴g\xff\x04s2\xa2l\xa0\xb4Hm\xe1p)d\b\xd7\xf7\x81\xfbv\x96\u0095 C\xb1\"\\Y\x89\x8dM\xbe\x82\xf3\xc3[\x89\x83\x83\xc5\xc3R\xf3\x84\x8d\xe8\xd6\xdbB\\\x99\x8d\xaf\x88\x002{^\xc8VV\x94՜\x15Y\xd1\xc9r\xcc^,(\xc3&#a\xec'0Ŏ\xd5\xc0ہZ\xb5\xd45\xb4jV\x9f\x92\xaa\xd8\xedK\f\xb4GG]n\x1f3\x87M!߉\x01\x94?\xf4\x85\xf5x\x14\xc4:\x93\xb4\xdc\x15Z\x8b\xbc[\x86\b4\x16cm-n;\x95M\xeaw\xbb\nąV\xde\xfa \x13$\x17\xd0\xee\xa8v\xcb#\xb2\x05(#\x80\x9c\xbb\xc1\x95t\f\xca$\xf7'\xca\xf7\xcc\xf9\x12\xe5j\xf1\xe0\x0fW\x1b\xde\x12bLy\xf2\x9b\x80\x15\xba\x1f\xf0\x1d\x1b^\n\xf0\r\x17A\xb8\x906]\xcf\xd5\vF\x00\x99\x00\\M\f\x0e\x8e+\r\xe8\u007f\xac\xe6\xd0\n\xcf߷\xa2\x9c\x86l\x1ab\x90\xdbO\x04\x1cx\x98y\xe7\x16\xee\x90\xf2 \x9a\xe5Px\xe7D@@'\x04\x9c\xfcx\xe5\xf7\x8b\x83\x02p((\x06\xd8)B\xd3\xdex1\xab\x88\v\x99JxI\xe7K\xd4F\\\xedT9d\xe0\x00\xfb\xa9\x00y2\x1c\x06\xc052Y\xe4&|͖\x0f\xeeNQ\x83\xa5\xc5R\fT\xc0\x86\xa5\f9\x87@'\xd7\x01\xc7\xd0Y\x17\xe4\x0eό.e\xf1\xfb!\x93P\xed0L\xc0\xaf\xb13\x18\xad\xdbau\u07be\xd5\x17\x80\xbc=\x18\xc1\x85\xde6\xb3\xde\xe8\xe5&\xbd%m\xf7d<Zy\xa5G\xab\x15|\xabס\x97\xa5\xf3\x800ä\xf3\x94\xd0\x18\x8b=\xfb@\x10\u007f\xd7_\xfe\xee\xef\xab\xc5Zw\xe2\xad]\xe5\n\xb0*ٗK\x19V\x9es7+\xb8@\x96\x12\xb0\xe9\x12_*E\x1b\x84\xae\xc2>\xa4\xc9\xcd\x0f-\xb3\xe2\f>\xc0m\x15\\6\xb9q\x10b'lɱ\xb3\xd8\x1e\x16Si\x87q\xec\xc4%V.bap\xdd\r\x89Q\x84\x8aWd\x87\x9eZ\x85\xe8;\x03\x86s\xd1/\x00\x19\x1e\xbf\xc6\xf2b\xf7\x91O \x89\u07b9g\xec'\x96\x8d\x8d:\x9d\x8d\x13\xfc\x91A\xbcC\x90\xbb\x04jf\x88\xd1Z\xc1U$0\xbb^\x14=\xc4\xd1!\xe9&n;h\xa2\x9d=\xc2p\xc2\xd4S\x188J.\n\xbb\xf6\xd2F\xa2匨U\x1e\xad\xe9Zf\x98\x1a\xb2\xb7\xaf\xed\a\nxL\xccXA\x98\xa3QP\x98\t\xbf\x94`+\xa2)*cǩ8\x8f\x9b\xab`OL\x88\x8c`\xc7!\x8a\x8b\x88`\xa5x^\xe4h\x03\xf7\x8fj\xfa(\x1c\xde[\xef\xca5\xf3\"<7\xe1@\x14t\xb4x\x9b\xa6\x02\x01\x92\"`m\x03ji\"\x10cuY\xb6\x1d\r%\xb1\xc5\xefְ\v\xa4}\x91VHO\xf4\xd9\xd3\x05djA0d#Y\x92\xb4\x1c\xa8\xc2\xf3G\xf4+\xe9O\xc6es\x82y\v\xc0\x8a\xcaѹ\xf2\xfd\xf5\x14l\x02v\x10\x83\t\x8d\n\x14b\x90\x8fR\xbd\xaa\x16\xa8\xe2\x14\xbb*I\xd5R\x91\xb5\xc7E\xb9\x84\u2bbb\xa9(z-.\xaf\xdc\xeb\x91[Ar\x17e\x97\xa9\bh\xa4U<z`\xa4\xc5QYLn\x14\xb5S{\x8ddv(\x0e\x8d\xd3\x00P@Yθ\xb1O\xf87\xc3+@\xcd/YT\x00\x9b\xb09\xcf\x1c`9\xd4$V\xf16\fu\x90\xeb\"\xfe\xc0,d\x8d\xf2\x9d\x97\xe1\xb1^!\x12\x94A\x8db߁a\xaf\xab\xaa\x8eb^\xe4\xd9\xe5f\xd0\x10\xa0\x96\xb2N\x90ˣF\x15\xb5Pv,6<\x95\xe4Y\xf6\x03\xf6\x89*\x00\x1e\xb1\x0f\t\xf4ͪ\xeb\xa2\x03!\x862 t\x04 \xf9\x82Ȏ\xbc\xce\x16j\x01\xa1\x03n\x85\xec\xf0\x8f\xa8\xe2P`.va\xa0b\xf4\xa60\x84!6\x90\x82\xa4!ɧ=\xc75=,\xc0\xec7r\t)\x8b\xbf\x0f\xf7\xc0\x8ed\xa0\x13ڄ$\x83\x86\xf5\xbd{L\xb2\x13*\x04e\x91\xc3p\xb5\xf0\x1c=\x8c\x87\xd9\xf0\xd7\xd2J^y#\xec\x97ꈂ\x0e\xf3yw\x17\x04\xe4\xdcoJl\xffـ\x16\xc0ud\x14I9B\xa1\xa2\xf5\x9e\x0f\x87]\x01\x16\xe4\x12)\x90Q\xeb\xf8E\xd2\x03\xaf\xb0\xc8\xcc\xdb\x1c\x19Mp\u007fPo(-\x106$,\xa2\xf2\xe3}~ \xd8\a\xbf\x94i\xbf\x050\x87`B\x90a\x02\x89C;\x1f\xf7h~\xe8U\xa8v%\"\xa0i\xaf\x82\xd8n\xd0\xed\xd5J Kq\x925\xe1\xc7\xdf\xee/߀\xfa\r\xf1\xe7\x03ɡj5*\xf0\xa9j\x14\b\x012\xefK\x99MP\x01!\xb89\xe0\x11\xca*\\\xd4\xf1\nlY\a\xcb\x0e*v\xe5$\x06A:N\x84\f*\x8f\xa4[\xe1$\xf4t\x13\xe2\x05\x9e\x1ck\x14\xab\xf0M\x85Q\x04\xa8j=\xa9\xbb}`Gֻ,/y\x8chl/\xd7\xfc\xb4\xe0\xc13Dij\x82;\x90\t0B\x85\x91f\xc4:\x16\x19iX=\xc1\xb3tO.\x13\x995B\xef2\xf7\x84AS\xa9\x02\xb1\xb1-@X\xa4\xd5F\"\"Ŧ\xe7\xbad\x12!p\xbfR\x87Ȗ\t\x02m\xd3\x18Nu\x97[\xbe4\x10\xbb\x06\x9a\xf3LAYPP\x1dYFX\xbbb\xb1\x95\x97uD\x1d\xde\x10\xbb\x8b?mB<r\x82\xddpJ\xf0|\xca\xf5\xe0b\a2\xec\x81\xe9\xf2\xe3\xe3\xacɶ\x8d$\xe4\xaa0\x94\x89~A\xbf\x9e\x81T\xe0\xffc\x99\x05Ds:\xa4\x12\xae\x92U\x05\xce\xc0\x9e\x92\x88\x16\x84\xf5\xc0\xbfs\xe3_\xf5\xff\xbc[&3[\x97\xf1!\xa4\x03j*\x13f\x88A\f\x8d\x00e9~\x84U\xc6\xeb\x05\xf0\xf9\xc0\xde|[\xcdS\xf8\v\x9d\xf4A\xc8\x1e\x82P\x84TA\xaa\x01&\xf0\n\x110\x10ą\xcdG\xe1 \xb1\x1d\xbf\xc9\x15\xc0\x87\x9e\x9b\xdf\xd8)B\xbc\x16\x89\x0f\xfcP\xdfᕂ^\x87\xa7\x82\x96+c?Ţȋ\xd46\xae\x1e\xfc\xc4\xc4\xf3FE\xae\xec`\\K\xac\x88\x86ր\x94\x9d\x91\xff\xaa\xe8L\x96\xa1c\x10\xb3\xaf\r\xc1\xa8\x8e\x16\x92[\xb0\x00\xf2\xc2\xdaE\xf69Ƚou\xd7S\"\xa7cѽ\xe1\xa0\x1a\x8c\xab\x97N\x02\x11.\x90\x18BbG\x88\x14\x95+\xf7\x84\xe7\x9dv\xe3\x9a\\8K\xc1\xf9̚\"\"Ht\xec\x17{~BG]\xb2\x97\x04\xed\x14\a\x91)]r\f\t\t\xc7\x11\x1e\xec\xd4\xc1<\x9dz\x96\xfaB<\xe4A\xb9'\xd8+\x83ل\x15P\xca\xc6n\x11#\x8c\n\xd3\x1d\x01\xa7\brB\u007f!a\xe4\xbdz\xce$\x1d\x18#\xe3\xd3eu\x8e\xaex\xd5g\x03F:k\x81\xba\xe7͐\xa9\xa8mn\v\xa1\n\xe5-\xb8\x11'\xc6^\xaa\x0e\x8d;&\":\x8f\x87`gG\xb1f\xact\x0fpyZj{\xa8\xe5\xf3W\x9d\xf5\xb3\xca\xfa\x93\xc6\x1a\\\r_\xaa\xff\x0f\xe0\x94V\b\x80\x84\xf5\x04\xff\xa2C\x9c\x8b\x8b\xc0f\xfbRk\x81;\xfeT\x8cHz\xbf\x10J\x92UX\xcf\xf8w\u007f\x1b\xe4\xe3\x90FG\xa2\xaa\x81jQ\xd5\xc6\x11e\xbb\x90\xd9d\xf5(p0v\xfc\x10\xeb!\xa2F\x92J\x8b7\xa7\xab\xdc!\xa4\x87n\x97\xac\x19Ǳ\x88y\x0ea\xcewI5\x93\xbe\x00\x16f\x8e\xa7k\x9f\x9e\x96꾍\u007fI\f̙\"1*\x12\xc8H(\x01\x14z\x16«\x94\x8c8\xb9pZ}\r\xbf\xf9\x00\v\xccA\xb3B\xd6(\x91\x00\xe9\x03Gg\x88\xe4$\x13\xc1Re\n+\x80\xb1\xdcߥ\xb1\xc3\xd6c\x1b\xf8\x19\xf7Ν\xf3\xb8\xa1Fd\fW)\xdc]z\xd6\x1d\x95\xa2\xf2\x96<M\x95\xe9\xe6G\x19\x8d\xc2=\xf99\xba\x83\x97\xc9{)\xfe\x92\xf8N\x93\x80>\x88\xc3\xd9\xe0I4\xc8@\xa3`uQ\x85\x05\xe5\xb1\xe3\r\xb2\xaa\x96\xd4')cĈS\xe5^\xa7#?QH\x02\x97\f\xf7\x11\xf80-qY\xca,\x9dD2\xc5(\xc1\xba\xc2\xe8\xaa\xceB\x99\x878\x98\aA\x13\xe4(!\x17P\x83žh\xd7M\x96!\u007f\xd2+1\xe2\xcf\xc1\xcc,\xd0&\xbd z\xf0\x00LCۢ\x92\x88F\x12\ne\xca>vC\xa4\xbe*;\xb7j\xcegbq Gq4|g\x83.\xa1B\x98)\xe4.-D\xb8\xcb\xf9\x1f\xd2px\xb5\x9f\x85Ĭy$\x87\x02\xba\x92\xc7E\x1bb\u05fb\\P\x00ꇤ\xcfQ\xf5\a_\xb3q\xffY\xd3\"\xe4\x83M\xcf\xef\xa9)º\x17\x00\x06\xa98\xb8Y\xf2\al@Db\xbb\x92\x87\x8e \xedBxU\xf5\x0fj\xf8x\x8f\xd5TzY\xe8Ãd\xbe\"\x9a\x84\xa7\xeek̬\xf6oVo\xfay:\xec\xf8\x01\x96\x92\xdb\x02\x03\x88\xbd\xfc\x89\xc9\r\xf2GM\xb3\uf425\a\xc5;>\x030\xde\xd8W\xd2\xe2U\x95\xfe\x94\x89\xd5\xc9\n\xfc\xca\xe4\xbf\x10\xf9\xeb\x1d\xa4\x9fz\a\x12\x13<\xda'Q\xf2]\xee\xf7\x9d\a\"\x175\x8c]\x8c\xc0\x16\x16\x18\xd93e\xe5x\n\xf8ʴ\x02\xea9m\xc9Dމ\x8cqS\xfdr\xd5vڞ\x06F{\xf1%\xb1\f\xcfb\xb3g\xc7,`\x18\x87\xe2\x14\x1d?\x8b\xbaT[\xcf$q\x89\x8b\x95\xcd\xe4\xd9\x14G,)R\x14d7S\x81f\r@[\xc5\u007fD-\x80\xa0\xaeU\x1c\xc87\xe0\xb4C3\x1aAI\xf5\xa3\x0e\x0e\n\x9f\\\xc4\xdbWv-\xe20eQ\"\xceFğ\xa3\x1fC\xc3\x0e[\xc1˽W\xd5?\v\x91\xf5\xec\xe2\x97\xc0\xf0\xaa8\x93Z\xc4_RM{\xa0\xd8*\xfb\xd51\xfek\nx\n\x9b>3\x14\xb3:\xe9\xc7{U\x91Վ \x12\xab\x87\xb3G\xeeg\xcd\x10\xa8ꍡ\x03\x95\xd1zj\x1e\xa8.\xac.\xd5k}\x8a\xedc\x05\f07\x80}7=\x8f\xe6\xa4\xf4@QM^\xa5r~63\x91\xed\x91x\x97\x8d\x8b͙=\xb0#z\xb8\xdb1\xda>D\x1eZ \x84\x9e\x8d\x90\x12\x01\xa0\x00f\x95)#\u007fՍU\x05*\xcd|\xb0\x90\x01YD\xf6.sD\xc1\x95h\x05\xc3cFu\xec\xba%54\x88\x13U\xaa\xbbx*nB\r\x03T\xc4Z0\xfae\xe7X\xef\xcc#\xf3\x03\xf1\xf6\xc9\xd5!\xaeP\xfep\xfc\xd6\xdb\xdd\xe0\xa1\x06@\x98͗\xd4:\x16P\xf7\xc4\xd49Zv\x1eC\xa5\xe8\xfal\xb1\xa8\xb0\xaa\xf8\x82\xabH́\xa5\x87\x1d\x84\x80$1\x16\xd2\f\x1f\x88u=a\xa0\"\xf0\xe0(K\xb4p\u007f\xf5;UI\xb9\x13\x8c\x8fDL\x15\x19\x8e\x86\x05?\xe5\xc5\x11\xde\xd5T\x97\"\x1aTD#\x8cH\xbf\x8d\xf8b%IW%\xe3j\xaa\x04\x98\xca\x16xp}GE\xb9Z:\x1a\x03\aK\x04\xba\xab\u00888\x9c\xd5\xceO\nc\xc1\xb9-l\x11O\x02\x85\x9c\x0e\xe26\xe8\tŋ&&/\x90+B\xd7\x15\xc9\x158:\xb3\x80\xb5\xb9\xb1#\x0f\xabb\xbdrq\xb3U\xe4\n\xf0E\x0e\x91\xa1w\\\x91t\x80\x96Zx\xf6\x80MI\x80\b\xc1\xa8\xb5\xc0䎣y\x8c\xe1\x12\xa0\x80\xee\x97\xd8\u007f\x10\x01Y\xe3M\xffB֨jl\xa6\x17+\x88\x84y\bT\xb5\xb2\xa6\x1bbꬻ\x86kX\a~\x11\x82ůx\bu\x8d\xd7˜\u007f\xb6\x1a\x1a/X\xffD\xde\xee\x0f\xf6V\xb3\x19G\x02w\x90\xac\x92\x12U\xee\x0e\xcd\f1F\xb5\xca\xc1@.\xb9q\xe4\x99 \xde{\xc9\xde~fJ\xf2\x84 \xb9\xd7)\x87\xd4>\x17\aL\x96z\xf7l1\x9a\x86mL7\xf2V\xa8\x98\xad\xb4\x03\xb8\xb5\x95v_\xe3~\x00y\xa2\xba\xd7\xf1c\xe3\x11S\xb7\xa1\x1c\xa7J2cܞ\xa5\xf25\x88\x01\x89\xc9&\x91=\x8b\xe7[\t\xacIdYH\x1a\x0e\xa8\x8f(\x8b\x8c\xd8p@\xc7T\xe5V\xd3\x04hA鞪\xf7\x18Xv\x85\x10\xf0\xe1\x1f:\x98\x80\v\x8b\x0f\xd5!Wld.\fU\xc6<\xd4B\bN\x9dG\x92Vm\x8e\xd7ͼ\x85mD\xb8\x90\xa5\x84\xef\xea\x86\x0f$G!\xaf%\xddڒ5\xfcM\x8a\xc2\x1d~\xa6NBIha\xa72\x13a\x14\xa6\x16'H\x00$.\x02\xbc\x02\x99\x84\xbe*=\x00&H\x04\x14\x12c\xac\xd0\xeb昊\xf82\xb1G\x1d%\xe8̡\xcao\xfa\xf4\x97\x16|\x8a\x90'\a3K\xd0[\x04$5\xb0\x03R\x10\xb4\x91\x90\xc0J \xa6_\x99\x91\x1b\x06\xc1\xc0\xbdPu\a\xdb\x01\xd8\b\x13\x84,dN\xd8o\x89\xab5_\xb5\xaa\x12;\xaa\x91\xe0\x88Q\xf5\xc8\x1dUJ?E_46ļ\x80G\x16\xe9p\xfd\xe2\x0e\u05eb\xa8\x8a\x042\x13\xcc/\xdb:\xdf:j]\x13+\xcfI`c\xfdU\x99\x8c\xaf\xc2\xd2ך\xe8܃\xdcF\xc4A\xb6\x9e5*^ױ\b>I\x8d\x1f\x94Ex\x05\xcdO\xebr\xf2\xac(n\x1c\x96\x127-\x83;\x8a\xaa2\xaa\xe7\xc6\xe7T5\xe7r\\:\xc1.\xea(\x1d\xc5\x1fh\b<\xaa\xa1\x01;\xacsA\xb8bwJ\xa3\x1d֩E6!\x02iW\xaa\vU\x83\xb7𪝲\xd8\x15\xfc\x06\b\xaf&R\xb6A\x92o\xc4k\r\xf6\u007f\x86y\x8eXc\xc0\x9b(=\x96\x97T$Ҽ\xd8\x01\xf1\xb64E\xe4#\xd6DuH\x12\xb8\xcbL\x17\xd3h\x1a\xff\xc3\x15\xf5\x99\xe58\xeb\xba]\xa2ȣEL\xb5)\x1e\xbf\xadp5td\x1a\xa1`\u007f\xed\x06\x82#E703\xd2}%\x00L\x02\xf0\u008e\xf0\xaegj&L\xab7\x86\x94\xd7\xcc\v\x06\x94\xdaR\x1b$\xabL\x04\x88\xe3\xe2\xf5/\x98\xec\x88|\xc43(\xf2F\x86\x89\xdb\xfcv\\}BU9x?\x15\"y\v\xfe)\x12\xb4\xa3h\xbe3x\xe9ku\xbeq\xacH\x03s\x1a\xb7\x18\x1a\t\x04\x8a\xc0\x9b\xeb;\xf1\xad*\x89\xe8\u007f\xe4\xa56\x1f\x80E\xd2C¤1\xc2«\xb0\xb1\xd4I\xc2\x1a\xb4\xa3zG\xd9\xe4\xda\xce<\x17\xbf3\xd8\x03x\xaaL\xc4\x10\x12zwh\x898\xe2\x1b\x910\x00\xb7\v\x0e\x10\xd0AN\r\xcdx\x99\x9a\xe8j\x11!\xabst\x9a\xa1\xf4D\xf4@\xa2\x11\x96\xbb\xa3%\xc1.v\xc4\x04*\x9a\x80 g\xb3J\x86=d\xc2\x1f\x85ѵ{j\x0e\xbdޘ:\xa4\xbd\xb9B\x94y,8\x99̪\xabW*Ǫ\xea-q\x12\vѸ\xd5\xc0檊\xa1\xbe\x94\xfb\xb2\xe8\x88h\x10l\xc1\x01M[՜&*H4\xe8\b2\x8c\xc02a\x18ў\x05SӰ;đ\x87\xa8shqqm\xac\az\xa2\xf5\xad\x86\xec\xe2\x05\xe7V\xc8yx\xa7\xcaU'?\x90\xbbK\xb3\xcd@\xae\x14\x88&\xa8\xe6\x8d|\x03\xc27\xd9h\xa0\xf6jΡh\xca\xe2\x84U0;\xc8ӦI78\xc0u\xac,\xf9\xf6\xb7Et8\x1f\x81\xa1\x9e+\\t\xb0\xf9\\\x04\xd3:.\xd1q\b\v\xb9\x85h>;\xb5\xb7ʐ\x11\")1^ӫM\x99\xd8\x1dK\x84\xae`\x80' \xa8\xba:Ú\xd0c=M\xbe\xbe\xfa\x186\xf1\x82DO';D\xbf\x9a\xb6\x9a\xea\xe2EA\x05\xf3=ĝ9@F\xfe\r\b\xe2\xd9\xcb@\x11\xe4П\xfe\v\xf1t\xf3M\x05+\xb56\xb1Zu;x\xe0F\rj\xa8\xf4\x12C\xe9\xea\xe6\x00bM-\xea\x19\aZ\x1c=\xc1\x02 6\x83\xa6\x175\xbf\xd1XT\xc0o\xa8\xf7\xc3\x17\b\x9d\xe4\xaefQ\x12\xfe\xf9\xaa\x98k6{\x06\xf2=\xae\xbd\xa90\a\xee\xe1J\x06\xd6\xd6\x10\x96ꎃ?BytAS!\xa9u\x8f\x9aN\xc1i\xac\xd6^\xb9\"c<'\xef\xa2^\x03lƝ\xa3\x17Tz\x90\xe9y\xe3\x03\x92\xe2<=j*\f\x00,_`4\xf7}\xc2,\xc3\xc9m`7\x90.dxG\x94\x13o\xaa\xb1#\x8d\xc04D\xa3z*M\x9cZ\x82L\x01\xc6\u007f\xe3i\x90\x9a$\x87ұ\xab\xf2\xeb\xab\xf3j\x8b\x04\t\a\xc0-\xd5\x1c\xd5$U\xfb\xa1\x86ޏ쵌\x9f\x1a\xd1^ӧ\xc43!\\\x13!\x992I\x0e\xb3A3I\xcd\x03M\x8c\xed\xb7KX\xb5\xa6y\x90\xf2&EP\xab\xad\xe7\x14\xf7\xcc\xf1\b\xdd^_%\xa96\x8b\f\ue40c\xba\x82%D\x1c\xe8LjB!\x89\xc8'I\x06\x1b:\f\x80\x1eQ\x85\r\xb8\xd5\xcd\xf78;\xe0\x891I\xb7u\xcdZV\x99\x86\xbc\xd0\xef\xa1\xe1\u007f\xb8mn\xe9:\xf2\xa8\x10J\x9b5\x9cj\xa5\xab |Go\xbc\x8f\x86[&\b\x83\xdagMUZ_\x04\xb3?\xcbkd\xe0\xaa\xd6\xf8]H\x8c\xf2\"؉@\xa4\xf2\xde$\x16̨rEy\t\x8b\xcf\xd78k\x87-\bɴ\x90\x8aQ]@B\x8eԑ\x04f\xa1\xc4\xc2XD\xd7\x01\xbfx\xf0R\x06\xcbMUS\x05\xee\x8dK\xc7}4\xc6\xddb#m\x9a`\xa9\xa3cL[\x06E@\x84,\x8aj\xaa\x00\xf4\xae\xddե1\x1a\x95Ǹ\xc0\xc5j$m\x14\v\xd1M\x1d\x92\xa5nŧx\xa5JW\x88\x9aGD\xb6%Y\xc2\xf0\x82\\\xf00\x8a+Z\xfb7\xe7\x9bY{\xc8\x02\x11\xa6\xe1\a\x90\x9e\xa5\xe2\xf9\xe0ɣ!ވ\x8c̼y\xf6\xca:Sw6\x05\x00\x05\xb5\xdc\xd0{Xѣ\x1d]\x158\xe4f\x84\xc6\t\xb6\xf5\xc8D\xd1\xc1\xb7\x16\xb0\b\xda$M\xb1N9\xf0\x19\xb9A\x94\xd0Pw\xfb\xe9+\xa7\x83\x19\x04\xa4gSO#\xe4 \xdarH\xe7\n\xcdaCzdIT\xdf\xe1\xba\xec\x17\x1a\x00\x84\xd3T\x91&\x03\x8bAm\xc1\xd0\x11*\xb1r\xef\xc8\x06\x04\xe1\" bӔ\x10|\xdc\fA]Uf\xebRT\x8a\x9e\xaa\xd1\v\xbe&R.\xaf\b#\xaaȋs\x05\xca\xd1G]\xd3\n-kx\x17\u007f\xd5B\x17F(\xb3\xcby\"\xfah\x91\xfe\x01@\x9fv\xc6\xc98\t\x10\xa9\xe7\xa6\x01;n\x8d\x95}C\xbe(\xc0\xe2Q\x82\xe2\xb9\xf3\xb7:\x1c\xe7\x02\xf9c\x17\x11\xecWN\x0e\xe9ū!n\\c\xd5XކDJ\x80\v\xeb\xa73]\xba\u007f\xfd\xbe\xd1\xc5d;\xc2\xe9x\x12\x1e\x9bdQ\xcf\xea\xe2i\xd9o\xf8Y\x9c\xf2Z\xcb$o\x1d\x1e\xeaR\xff ̜48\xcd\xc2\xe7h\xa7\xc0\xec{\xcd\x1e\xd9_\xd1\xd1\r\x05\xf3\xe81/\xc3\xedï\xde\xf1\x8eᨏ^g4\r\x8c\x91\x0e\x02\xc8\xf5B:\x05\x02!\xe04&j\t\xe2\xe8\x84z\x90݉ɛ\xa6\x8cS\xc1\x1cW\r\xd6š\xea\xd1\x02\xf65\xad\n\ax\xa9\xf8\x85Yk$7ןM\xa3v\xb0\xfe\x96a\n\x9a\x04\xdfF\x16v\x00'\x9a\n\x9a*\xca\xc2\xe6jK^\xb8INh\x90\x97\x830\xd9F\xee\x14bc=\x14\x00\xe7\xc7^\x9f\x85Z\x05U\xb0D\xf5\xa8\x9b+\x84\x8c=ed\x13\xfb\x8f.\x8bS;\xa7i\xb3\xaf\xa9u\xcc\x01\xcav\xfa\xf8lfW\x97Y#M\x12u\x84+Z\x8a%LC*\xce\xc1&6\xbd\xa9\x8d\x85\xba\x01\x8d\xa4q\x11\xf6\xc0\xb5\xaaJWF\x1b\xfdX:V=\xc1E\x99\xcda\xc7I㚳\xea\xd6Xz^\x934\xe8\x8dAaS2\x8ai\xebf2\xf8\xa5r?!˓\xc2k\r$L(5(\xe6T\xf5\xba\xc0\v/\xf0\x9d\x15\xfc\xed\x98\xfb\xa0Q\xcf\xd9\x17k\x84\xa1%\xd2H\xd8Vx\xeeD.I\\\x90\xbaܚ\x86\xbaOQ\x99\xb2k\xcc\r\xb5e\xaf7\x80\x16C\x86jV\xa95Ĩ\x86\xe0\x1f\xd6\xc7\x02\x1e\xa2\x9f\xb4\x1aHV\xafF\x1fD\xaaq\xed\xd5v\xc5\x1d\xe9\xbdѰ0\xaf\xf9\xe85\xbeZ\x9e\x1b\x87\x05\x83C\xd3xH]\xad\xdbi\xeaL\x1eT\x95ꑠ\xfe\xd9]\xad\xe8QT\xa9ј\xab̧\xbd\xa2\x864[\xb1\x11\bmb\x8e\xbbp\x1b\xb8\x82u\xfa2\rO%M\x85\xa3\x1eU4\xbc\xa1k<\x98U\xc6c\x91\x18\x9a\x04ïH\xd7jv\xa1\xae\xf0\xe9\xf8'|\x99U\x97\x92\xc6\x1ePg\xc6SkR\x9dP\x97e8\xc0\x0f\x81:P\xf8e\u007f\xd9\xe9\x8dj\xfc; p\x1e]6\xe4\xe5\xd1\"Q\xb9\xdc_\xabO\xb3\x88\xac\xd4w\xd2{\xffG\xdakb\xe2%\xfe\x1bd\b\xed\xc8\xf2e\x87\x84=\xbb\xe9\x99\xf7\x9b\xe4%Ά\x8aO\xb2\xfd\x9f\xb1R\xc2N\xb40\n\xeb\x01\t\xc2iA(Y\xe4Ct,G\x0e&g\xb7\xab\xe4]\xea:\xef\xa6\xee\x11_\x02d\x040\xb9\xaffj\x12<N\xe0\xa1\aM\xe0V\xb2u\xa6$\xc9i\x9eݩB\xe7\b\x808\xfb\fS\x01+\uf200\x06ք\xf02\xa1Y\x9d(\x15^o!\xa4\x13\xf2u\x03B\x04\x1dw۔\xf3\x9a\x9aR\x15\t\x81\xb9\x1d?\xf4pT\x99]\r}\xdc(N\x16\"Pu;^\xc9\x0f\xa4\x95'\xdaS|\xd3Lf\xacwR\xf5\aV\xb0\x14Иh\x1d0\xd2\x11_\x03\u074b=%8\x8a'\xe1\xadkj\xb4kfF'#\xc1\x110j\x91\x87\x1a\x05CQ\xda8Mc\xb9\xea\xe4\\U\x95\x1a\xab\xb3]\x90\xe1_\xaa\xc3h\x92p@\xa4]%\u007f\xd4\xe7\x05\x96\x90\xb6\xb7\xa0\xf2q\xbf\xde\xef7}\x89-ۑeމ\xc8Kak`\x15\x06r\n\xc1(\xb9bjR\x80\x8ax\xf4Kb\xcf\x1d\x17\xfc\xae\xd6<DN\x8eH\xf7\r\x95\x9e\xc2\xc9\rC\x02\x96B\x0e\xa7\xab\x89K\b:\x81\xe9\xb8\xfe\xe09\xdeI\x15\x88T̈́\xa3\n\x10\xc1\xaf\xe1<D\rA\x8d\xe8&\x93y\x99\xea\xfd\x17E\xa3\x01WUo\xb9*\xe0\xea\xc8!P\x16\x1e\xdd\xcf#[\xda\x01|O\x19a\xa5\x11pȝ\xe4\xc5-\x81\xf3dܣl\b4HCta\xf01\x85\xfdN\xce4d\x1e\x92t\x19\xff\xa8\x87\x18\x14\x0e\xdcoS;\x14\xe9\x87y\xe3\t\xc0\xbc._\x8ep\xd7)\x80\xa9\xbe#\x81\x8b\xe0M}\x82IȚ84\xce\xcc\xf6#3\xb3f{7\x8f\vx\xb0\xf1\x84^\xaa\xdca\xbe1\xa9K\xa1oH\x84c\x1bKL\xe2\x17\x10\x80}\tm\xbb\xa1\xba\xdag:\xe4\r\xd0\x01\x83\x1aí\x1a\xef\xa8~\xa9\x8d\x8b`G\x1d\x13\x01G\xb5T\x80\xb7\xabd\x86ꎳ\xe2\xb2\xd5O\x19\x9d\xc5&\x86\xa0\x10\x95\rp\x80UD]\xcf!\xd0^i\xbb\aշ\x89\v\x1c(\xfa\x13\x01\vw\xf2&\xf8\xd2\xc7\xf5S\xbdB핓*?\xf2\xeep\x15A.\xa9\v\x96i\xb6d\xabIC\xb4\x1e5\x94\x01+r\"\x1f\r\xfb\xa9e\xb8\xca\x1b\xceXk\x1d\xcd\xeb\xee\xe2T\x1a\x02\xbc\x88\xf9\x95\xeeP\xc7\xf7\x96N\x94\xb0`\xd5n\x8a+j\xa8\x16\x92\xc3\x15\x12C7M\r5\xe3Ra\xf8\xadV\x8b(\v\x05\xe7\xd4 E;\x00\x80ZAlc\xf9h\xb4\x89\x16\x1a\xefx\x8eN@E)\xa5:\xc7\xd4h\xc2\xd0d\r\xb7\xa2\n\x02(U\xdf\xe9T\x87\xb4<\xaa\x99j\x9cm\xdf\xfb\xdd7\xe4\xc228\x18%LX\x93\xa8\xe4)\xbe\xea\x90:\x13\xf3\xe7)\xda\xe0\xb7Û\u0096\xb8\x9e\xa9>D\xc2Cg\xf4\xb0v\x84ୗ\xf5|\xb8\xac\x8a?\xbe\xc04\xf0+\xf3\x0f쁨jH\xbc\xc1\xa6\xbe\x01\u007f\x90\x15l\x1f\x04(\xfe\x9249\x1bc\xc8w\x9b&\xd6cx\x1d\x816T&E^G\xe5\xf7\"Rq\x86\x19l\xbc]\x8d\x93\xf0:~\xaa3\xbd\xb9\xbe\xc4\xcfU'\xcdp\x15\xa2d_\x1c\x96l\xa6\xb2\xac!\xf8q`^\x04.\xd48\x04U\xce\xe0H\x02YU\xf8r\xd8Dpn\xcc7\xf1\x00;\xa3\xa6\xee\xd1A\xbd\x94/\xfeP9A\xac\xab\xd8\xd1WF\xc1\xa1\xb2\xab:b\xb8\xb8\xf9\xc4\x1d1\x1e\xd8q\xfcZ\xc5\x14JΒt\xf824\x96N\x8b\xe88Cd\x0f\xfaQ\x1b\x1c\xa3X\x9a\xe7\xfe4i\xc8\ue4ddD{\x90f\x85䀲nN'\xc4t\xb2D\xf5\x8f\xa6\xd6W\xdc*\x14CWx\x0f\x9d#\x00'\xb3lMA\xef\xca\x02fY\xb5>\xf9I\x84*\xbe\xa7Ȃ\x8b\xaf-\x87\xc8\b~\x01\xacx\x9aO᷾\x91\x13\xd6\xfd6?4\x01C\x04\x9c\xa3v#\xb2\x91;\x88\x1f\x96\xed\x98SVem\x8d2\x10\xda\xc0q\x00Ypl\x9ag] \x1e\x8b\xd00\xd1\x05\xcbzt \"E\x93\x96\xd5\x1c<I\x17yI|\x83\xed\xaa.!\xe6A\xc8\xcfd\xe8\n\xaa\xfb\xf2\xcb\xeaQ!\x18u\xd8AL2\x84\xc18\xa56\xdeᤠVq\xdd,\x15b\x1fc\x89\x9eZ\xaa\xfa\xc7\xea\xb0Ն\x17\x8a:\xcf1\xb4\x94\x92j\xaf\x8bXP\xc7=\x86\x0f\xdb\xda\x04\xefo\x04\xf1\xaaڬ\xa3j<\x16:\b\xea\xf9\xe8P\xad\xab:\x8a1u\x98\xc3{\xe4ߌ\x1a&\x03\xab\xd2TO\nS\xa5c4\xe4\x87\u007f\xfb\xa9\xc1]\x80\xaa,\"\xb1\xeb\\\x00\xee/\x03t\xbd\xb9\xf5\x8a\xe7\x99m\x88Ò?\xaa\xf0A/>\x91\xf3s\x9aN'.\x90\x15\xf8\xb5\x03\xaf\x903\xecAU\xf1\x0e\x03\x1b\xe5\xb5:p\x13\x87\x83\xf1\xfbg8\x95mМ(\xfa\x96\x9c\x9c\xb9 H\xd3\x11\x93\xb1\xbc\x98e\x00\xfdQ\x91\xd7i2\xb8\xb0\xe5 \x12@\v!_\xfbts}\xf9\x9b%36\x9e,\xd69o{\xe5p\x96醆\xf68\xf0\x1b\xd7&j5\x99\x9d\x00E\xfc\r\x1a\x8a@\x01\xb7\xa1\x1cͱ\xe57*U\xb7I\x99A\xae\x90$a\xb3%~\xd0\xf9\x04\xceE>\xeb\x18\x00\xb7\xa1V\x95t\xcc\xeb\xfc-M\xfe\x9c\xfc:\x89N6\xa7Y\xfe\xf4FU2^\x1d\\:\xe40\xe8&\xa8\xc1\xc8\xfd\xc0\x93\xf4\xe9\x1f~\xe1I\xff\x19(>S\xac_X\xb1D1:T{\xefW\x87\xb6}N\xd0\x16\x1dR\b\xf9|l\xb4\xa9\x84\xf3\xba!H\xa0\xa5I\xb5\xf2`\xe4_^\xf6\xd3i\x02S\xeeN\rY\xad͢\x0f\xf8\\a\xae\xa9O\x80m\x92\x1fUϫs~\x9am\u0560\x94\xaa<D\x83\xa1\xf8\xb6T\\\xd4a\v\x04\x1b\xfaofU\x89\b\a5n\xf4\xe8*\xe3\u05cc]G:%\x9d\rSE-\xeb\\\x1a\xae\xb7\x05\x8d۩Uq |\x1d\rU\xc9\fW\xac\xa9\xbb\x1d\x89\xf8S7\"zm\x1d\xc4%\xbc\x97\x9bХ?>BV\x885\x95 \x16\x92\x83\xff\x97\xd0O\xa1\xa8z\x8d\xd8F\x8c\xa4O\xa1eJGtl`T\xf5zj\x84\x1e\xc4\xda\b\xad\xa1\xa1\x03A\x9a\x86pS\xf9L\x8bc\xa9x\xb0\x02\xf5#\xc0\x892\x19\u007f\xa5u\x82\xa7\xc0Ė_6\xa7\xaa\x9aՑ\x96 E\x10R\xf0\xceE\x8a\xe9X\xb2\xd6\x11\x90\xceop \xa9\\ص\xb2C\x96\xae\xc0\xbeEG\xdbIG\x84\x8e\xf9\xcf\xe6\x00\xc5w\x9a\x931\xafS\x05=}\u0085zPG〶u|O\xf5\xf57\xbd9Bլ*\xb4\x8a\x11韺Q\x99\xa3E\x1dh/\x03\xc9\xec\x96N,\x8f\xae\x16\xb9}\xced\xab\x8e\xa9\xeag{G\xd6ؘ\x84\x16P\xb1\xcfб:G\xb5\xc9d\xeeT@6Uke\u007fRuU\xa7\n\xbcf\x02qZ#!\x1bQ\xc2[\x80Z\xeeș\xdf\xe5-\xf7\xb9\xd0\xf5\x1bi\xdd\x1a\xe5\xb2\xdeu\xae\xe89\xed\b\x05c'܊\x13\x80G\xd6!\xff\xf4\x10\x10\xf5&X\xa3\xe0\xae<\x1f\x17\xeaܲ\x00\x1a\xfb.\x1a\xd8Ӵ{\xe8\xea\xc2\a\x9dr\x18\x12\x85\xc5a_\nz]\xed\x0fȀ\xbc\xd5!]\x9d\xe2@\xf9\x02\x8c'\xeal\xfe\xd1\x11ӕ\xdeG\u007fh\x96\x90(E\xf2\xee\xa3S\x12l\x9dŌ<\xd6)\x89\x06\xbe\xea\x90j:j;\xa9\x8b\x8aE`\x9d\x86\x8e\xa8\xac\xf4\x8e\xe8v\x9df,\xc8y\u009d\xf5\x06\xa9\xa0?\x8d]d\u07bcdĨ\x8e\xa4\x80\xc2A\xa7\x8d\xebG!\xd8l\xbc\xa6iQu\x14\xa5\xa2\x8b3\x19\r\x12*\xd5\xd95\xe8\x95`\xc4\xd0\f\xe5u\x05\x0e_\xf6kVM0\xc1\xef-\x95\x11\x01%v@N\a%\xad\x1eN\x81>\x91\x862\x12\x13\xe6\x8b5\xe5\bн\x03>{\xbd\xa1xw\x06k\x84\xcc\xc0\xd2`\xcbAg\x1dڻil-\xf8FqT5z\x14\x9f<\x9f\nx<-a\xb5a\xc5\xf9,x\xef\xcfB\xba\x9fڶ<\xfb\xf9\xfe\xf2S\x89\xf8E\x05\xfd$\x82\xec\x036߯q\xbf}ѯ\x96\x15\x06֙);A\x033X\b\x1d\xfe\x05($+\xd4?\v\xee\xa2\x06\x14z\xef\x8c:\xf2B:\t\x0f\x83W\xae:÷\xa1VS\v\xe6\x1d\xaf\"\xf5D\xd9J\x0e\xf5\x19\xbd\x0e\x96V\x16\x05\xab倫g\xbd\xc4S\x84Q\xc9+K\xbeh\x98bN\x9d\xa76}\bE\x04\xbd@\x98\xf4\x9a%\xec\x83N\x88\x13[5\xb5e,\x1f\xf7\xa2\xe3B]\xb2\x1c\x04\x99qDU\x03\xb2\x8a\xf4:5\x8b\xb8\xc6\x03d\x0f\xd5\xe9l\xad\xe4;\xb0\xaci\x00\xf4=\x8e\xf2`\xbd\x91\xd4\xfa,\n\x9d\xce\x00\xd70\xb8;Mb;\fӀ\xd4R|\n\xbf\nP\xe8u(\b\x8c\x83\x8f\xc8\x0fu\xfd\xd8ǂ@\x06\x125\xf7W\xc5-Չ\xe6KM\xb3$\x94\x81>\xf9!@\xea\x88\\\xf2\x9dpS\a\xf4\r\xb6\xe6\xa3\x19ΤA\xbf\xb84\x1a\xa2^\x98\xbc\x9a\xd4FY'9\r\x98\xf3\x06\xc1\x87ܲ\x1ag\xa45\xb2D\a>\xc7\xeb\xa4\x02\xb4\xc7#s\x94\xba\xaa\xb3\xa0\x92\x9aJV\x1e-\x97\xb3\xe7}Qog:Pt\aa3ˌ\x99\xfaX#\f\xd1\xd6i\xfd\x9a\x8e\xa9<\a-\x99\xde]\xb5\r\x9f\x8f\xfaR\xed5Na\xfe\x900&\xc4Q\aO\xa2\xce\xfb\x84#\x8dR\x05t\xac\x1a\xd0\r<a\xab\xab\x8e\x11\xa8\xc1dr!\xa4,ʷjt\x1b\x99\x8a\x91&א\x18\x91\xa4ퟳ\xdf\xe4\xf5$ \xa0憑Q\xfb}\tX\xf6\x1b\xbc\x18:\xae\xa76\xeeb\xb3\x83\x90,\xe3íi\xecA5\xbfr4Z\x95\xe5̢\u0383KHm.W\xf3x\xa3 \\\xa2Z\xbc|U4\xbb\xdf߰\xa0\x061p\xbb\x9ay֧\x19h\x80\x17o\x18\x93\xfa\xfdd\xafFd\xe0R\x958-\x02\xe0\xd8\xc6\r\x1aoUk\xac\xb3\x14\xfb\xe0\xf4џ\xf0*\xfaW\x1d\xad\x1e\xaa>\x94\xe4\xf6v\xa4T]\x92\xc0#?੨\xa2\xf2}\x9f\b\xa3C\x19\x93\x00\x10\xe0+\xe3^q\r\x1b\nAU}\x84\x8bOj\xa7\x1d\xa9\xafQ\xf4\xe9\x10\xc7\xe9\xa3U\xa2N8m\x88A\xe3,G\xcd\xc3\xe7\x91+ٙ\x10x\xab\xab\x8a\xab\x8f\xa40\xd9D\x1dn\nO[\xf4\xa4\x86\xb8\x17o\x10٪1\xe3`\xa3F#К\x12\xb7\x1dG\xa6\x18\xa8\xa7밹D\xb6\xfex\xc9\v\x14d7\xcdH!\xc3\xc9F\x14'B{ \"P\nj;\xa0\xe1\xd8~\x96Y#_\xef9\x83\x98WE\x1d0괅\xdbA\x88\x05}\xce\x04\x1c\x915)!Wc\xa1\xbd\xa9\x9e\xa5\xc5F\xa4\x0e͉\xab\xd17\xc0\x0e|\xccR[\x05\x8a\x84:TJ\"\xadT\x1d \x97\x96\x0e\xa3\x98f\x0e`d\xae\xa0\x11\xa8p=\uea28~\x94\xcf\x1c\xbe\x90kI\x80\x8f\x17'\x96\x10#\a\xac,\x11\xc7\xc1J\xa8\xab9<\x12\x17\x97`^\xf3\xe0\x9ak\x02\xabt\xec\n\x93\xee\x88\\\xa9\x0f\xb4\x18,\x8ei:\xbf\xc7݇\xa8\xc4\xdb\aw\u007f\x83\xba\xee\x9f_\xf2\x85\xb5;m\x99;UDT\xa3\xc1\x83괼>\xfc`\xe0-\x8bf\x9e\x9d\x8aN8\x18\xb4TP+`\xcdC`\xe9\xccm\xd1\a\xb2\x14x\x82\xdcb9\xf8\xff$\x85NGL\x8dn\xb2\xb1\x9aD\b\xaa\x13#\xecst:\xbe\x00\x92\xbc\xb1\xb64@\x11\x84\xccY\xe7\xcd\xc0\x93$\xc3P=\xaa\xc2\x02=W\xa7\t4\x02\x05$_\xdft\x8aXӁ\x19\xea\xdd把iM\x1f\x03U\xf3NCg\xd7L=\xc5\xf4\xba\xa9K\xfd\xad\x94\x87\xc0\x04\x1e3ɕ\xa4\x16\xe1\xd4\xecI\xebV\x8aqg87\x17\x17\xfe\x82\xb0`\t\x0e\xfbU\x8f\x0e\xe9j\x1e\xf6$ӂe\xb5\xa0Io\r\xfe\xd9݅\x1d\x8f\xc4\x01RYǮ\x87\x0eu\xeaDaw\xaawW\xcd\xd3\x1c\xb6<\xa3\xb7\xa0w\x1d-Atx\x9d\xf0n\x8d\x9bб\x80\x1d4\x1a\a\x04\xb5\x8c\xf3\xeb\xf9\xf54\x80{\x82\x1b\x1f\x85\x85\xc0\b\xa1x\x83\\&\x11<\xbc\xfa\xa2\xc1\xf4QK\x124:\xa3\xbe\xd5&\xe6\t\x1a\xf8\x00AG\r\x01\x93\xb5\xe9\x15,\xd0p\xaaFv\x87tBQ\xeb܉<.r#\xbc\xc6\x13\xf2S\x1f9\xa1\x93\xb3\xba\xfb\xa9)0\x94\xa0f\xea\xfb\x8fQF\x14z\xb4\xaf\xcf\x02rA%\x15\xb9+\xd5-\x80Zt\x1bo-\x1f\xa0C\xea\xc6=\xb25d\xc2EѪ\x16\x9a+\xa9\xa9\xb3_\x98i\xbd\x03^@\xe7\x14\aV\x94\x17\xc57ݸp\x89\xb83a\xe5\x14\x8ea\xf1\t\x8eܱ\xc4\xfa\x9c\x88U\xa4x\xa3\xe2U\x17\x13\xb3\xe8\xf3\xa84ľJu]\x82?\xae\xad\x91\xbf\xa5\xaa\x1cI\xf8>މ}\xd6p\xb9\x06\u007f\x1a\"Qe\xbf\x03\xa5\xaa\x99\x9fQ\x91\x9f\xe7\xdc\x1a\xf3>:\xaf\xe9\x10\x9dUt\x8c\x10\x065\xb2\x06/\x1a(\x8c\xb0\xae\xd8}\\\xbd>\xef'\xa0\x0fTP#-\xbb\xba\v\xfe}\x00\x10\x12s܆\xdcd\x15a\x91\x95\x8ax\xb5L\xd8\a\x03A\x92\xe8p\x86\xa4u\x1e5d\\\x9b>ҁ\xb7+^\x10\xa7#\xc2\xfa,\xa4\xcekY\x9a:\xa3Z\xe0:P\xa9\xa3r!\x13\xa2z\x9f\x90\xb8\xafH\"j\x12I\x1f\xf3\xd3\xda\xf4:\xdaO*\xf0\x85\xb4\xa4\xd7\xe8@\x9f]\x03\n<?dB\xc8k\x1cvh\xb0\xbc\x81h\x9e\xdb\xf4\x91\x85\x0e\x189\x1dë\xf7}\x8a\x97\x8ek¬\x81\xf5U\f\xe2\x9cP\x05\xbaZg\xcdvO\xacWW\xae\xa18X#\xe2t\x06<t\xa9\xea\x8e\xf5\xa6\x0fgKj\x87\xe6\xaa\x0f\x1d`פ\x184\x03\vc\xa82\xbc4\xf6\xacy\xe1\x14ᑱ\x9dJV\x80yEJt}$\xd0<\xefc\x89\xb8q\xafso\xf8T\xb3\x15\xb3\xac\x91NE\xe8\xf3\x10\xd4b'\x86\x81)\xee\xe1Dn/\xdbr\x99\aɉ{ѱ\x02\xf2q\xcdm\xfa\x14\x8b\xa3c\x11\xa6\xb1᠐\x8cq\x8d5\xf49O\xe9}DK\xd3\\s\x86\x8fx<\"ʿ\x0fv\x1b7\xea3\aX-\xac\x88\x18\"\xae\xab\xd3P\xa4?q\xc8%\x86\x0e\x13\xc8'\x0e\xa2(\x857\x19;c\xfa\xfe\\\x80u\xf0an\x8a\x06\xf5\xa9i`k戴\xb2@|\xe3\x95\v\x92j\xd1lĒ\xc9%\xca5\xa9\xd0څ7@\x12$Ĭ\x8b\x88\xf0\"3\xc7^irU\xa3\xf6\x03\xdd\x0fD̂\vdK_\xbbW\xa7\xd1\x13&D\x10r\xa3\xe6\xa0\ttkj`\xcb\xd6\xf0Z\x9e\x1an\\\xceD\xbe\xaa\xd8\x1d\xb8\x97\xa0\r2\u05308R\x86\x05\xe8\xfa(\x10XY\xc3\xf98H}\x8c\x93\x0e\xb6\xa8\xc8O0\xa9\xa9/\xfc\x8b\xf7\x1d\xf3@_M}\nπ\x875\x915\x1a\xbb\x95u\xa6\x87\f\xd4A\xf9*\x88WG6\xe8\xb0Vߚ\xcd\u058b\xe0an\t\xf1JX\x0e\xa7ϐH*7\x81\xfb,\x8dNsj^\xd2@p\x8d\xb3\xd5\x057\xc7\xf9\x1a\xaf:M\n\x13\x97\xac\xcfmCn\x90\xfe\tӏ .\xb78D z\x04AL2\x8f0\xc03\x94#Z4\xa1\xc5\xc1N@\xa8F\x1d\xbe\x0e\x85HW\xab\r\xbd\"\x952=bf\xebh\b\xc40\x94k%\x84\xa9\xfb8\x83[\xe5e\xcbK\xddA\xdc\xe3\xd5\xfcJ\xf8t\xf8\xa1\bŸ\x8eD\x100\x92F0zB\xe5\xa9#]^\vZ\x83\xde\xfa\xc4\x19Poy\xd9,\xaf\x03X2\x86\x1e4\x04\xa0\x92\x9a(\x00\x87>\x17\xeaq욅ߒ\xaf#\xcf_y\xf6\x1e\xf7\x9c]a\xc9\xc4)\x88\x18(E+\x9c4\x11y\x9e\xf0\xce\xf2\xdaH\\\xf6\xfbj\xdeRg\x00\x8d\xf4[\x86\xdezm(\xcc\xf2uj\xed V\xba\xa6(\xde\v\xf1\xa3[C\x11\"j\r \x1d\xd9h\xf2\b\xf8\x9c\xec4\xdeR\x9d\xa9\tfh\x10\x848\x99H\xa4\x1a\x9c\n\xaf(\x03\xc8\x020(\xa0\xa7\x0es\xcf\xfe܈L\xa2>\xcbK2\xbbMM\xaa\xc0\b\xfb\x19\xad\x8dٛQ\xe7\x93\xe4\xaeA/\xb7\xe4\x87^\xa7\x8b\xbcO\xec\xb9\x0f\x9ab\x92\x10\xed靵\x99Z\xed\xc8M\xe2\xe0Q\x96\xf1ʇqM\x92\x92\xe8\xe8\xeb\xbc\x0f\xb4q`F\xd7\xf1ξ\xf4\xc1\x86R\xf9u\xbf*\xeaM\x98\xaa\x1a4E\xa4\xd3\xe0d\x00\xa9\x00\xa1L\x1c\t\xf8\x81\xc2:\xafk\xfb\xe61\xabw\xbcLMs~\x8e\x17\xd11\x01\xb9\xea۪Nrʗ\x14\xcd6h\xd3s\xacb\x02\x10\xb8\xe8\xf3\xaa\xd44;:\xd5\x11\xd4)\xf6Ǒ\xe1\xaf/\x9d\xa5\n\xe1\x15\x1d\xdf\xc8\x04\x92>r\r\xb4xE|\xc0\x15\n\xca:\x91 z\xc3^!\"\xf2Qs\xfc\rptu\x8e\x8bF\xbf\xdf\xc7ͱ\x05\xfa|5\xf9M\x04:\x9aR\xf6\xe9\xe0\xeaf.\x1a\x1b\xd3\xcc\xe0\xd6\xe9o\x82ܫF\xa2y$\xf92%\x12\x8a\r\x13\xff\x8e&\x037\xee\u007f\x01\xab΄\x97GW2K\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x0516ͱk\x00\x00 \x00IDATx\xda\xec\xbdy\x9c\xefw]\xdf\xfb\xfc,\xdf\xf5\xb7\xcd>s\xce\xc9\xc99I\xc8F d\x03R\x10\x8bB\x80\x88-ի\x88\x97+\x97VA*\xb5Z\xefu\xe1\x16[\x97\xb6\x82^\xb5\x0f\x95 \x85\xe2ú\x14\x02H\x11A\x10\xaaA\xbch \x84ld9Iξ\xcd>\xf3[\xbf\xcbg\xb9\u007f|\xbe3\xe7D\xa9\xcb%贽\xdfG&3眙\xf9\xcd|\xdf\xdf\xf7\xfaz\xbd_\x1f\xe1\xbd\xe7\xff\xbf\xf6Υ\x9f\x8eor\xec\xd81Ο?\xff\xb4\xfdP\xbd^\x8fk\xae\xb9\x06)\xe5_\xf8\xb7\x97\xde|3\xdb\xe7\xceJgm\x1a\xc5\xf1t\x9c\xa5Iw\xa6\xd7O\xf3l\xbc\xb5\xbeY<\xf7%/w?\xf3\v\xbf\xf0\x15\xbf\xefѣGYYY\xe1\xaf\xfb\x10\xa6iʍ7\xde\xf8\xb7j\x10\xf1tx\xc8\xfb\xde\xf7>\xbe\xf3;\xbf\xf3\xab\xfe>/|\xe1\vy\xdb\xdb\xde\xc6\v^\xf0\x02\xa4\x94|ݵ\xd70Y_\x9f\x05\x9e/\x94|v{f꺩\xb9\x99K\xb3<\xbbDk\xb5\xa0u\xd4\xd2Z\v)\x85\x13BL\xacsg\xf0\xfe~!\xf8\x92\xb5\xe6\xf3ea\xbex\xef\xa3O\xf4?\xf0\xbb\x1f\xf5\xd7]\xf7,\x00VWW\xb9\xe7\x9e{x\xc7;\xde\xc1\xc7>\xf6\xb1\xbf\xf4\xe7\xf9\xee\xef\xfen\xde\xf3\x9e\xf7\xfc\xf7\xe7!_\xedu\xd5UW\xf1\x13?\xf1\x13\xfc\x83\u007f\xf0\x0fh\xb7\xdbܰ\xb8\x10I\xef_)\xe0\x9fDY\xfa\x8dK\x87/iMMO\x91\xa41 \x00Ox\x8e|\xf8\xcf{\xe5\xbdk\x03WK)\xae\x06\xf1j%5Y\xa6\x87\xb7\\w\xe5\xe3?\xf0ݯ{\xf8\xf8\xd9s\x9f\xea\x0fǟJ\xf2\xf6\xd9S\xa7\xcfp\xdbm\xb7\xf1\xf8\xe3\x8f\xf3\x89O|\x82w\xbf\xfb\xdd<\xf2\xc8#\xffㄬ\xaf\xe6\xfa\xf9\x9f\xffy\xbe\xfdۿ\x9d\x83\a\x0f\xf2\xbc\xcb\x0f\v3\x1c\xbdP\t~VHy\xeb¡\x03b\xe9\x92%\x94R\xd4U\xc5x4\xa2,\v\xaa\xb2\xc2{\x87\x94\n\xa5\x15RH\x84\x10\x8dσ\x10\x02\xe7\x1cZ\xe9v\x9c$7\x1e:\xb0\xef\xc6}\vs\xaf]\xdf\xdc.\xce.\xaf\xfcс\xf9\xd9_\x8dR\xf5\a\xc7O\xad\x14\xd7^{-\xdf\xf3=\xdfß\xfcɟ\xf0+\xbf\xf2+|\xfc\xe3\x1f\xff\x9f\xd3 ?\xf5S?\xc5\xeb^\xf7:\x0e\x1d:\xc4#\x8f<\xc2-\x97,\x1d\xf4\xc6\xfd\x94\x90\xe2\xb5I\x96E\x97^u\x19\x9d^\x17g-\x83\xed-\x84h\xf2\x89\x87H+\xac\x05c*\xea\xd2\x12\x9c\xc5\xe3\xbd\xc3Z\x8bs\x0e\xe7\\\xb0\x8f\x90$iF\x96\xb7\x98\xee\xb6҅٫o\xaf\xea\xfa\x15\xa3\xf1\xe4\xe8\xcb^p\xf3{\xcfnn\xffv\x9cd\xc7\xef\xbd\xefAn\xbb\xed6\xee\xbe\xfbn666\xb8\xfe\xfa\xeb\xb9\xff\xfe\xfb\xff\xd6\xef\xcb\xdfz\x0ey\xcdk^\xc3[\xdf\xfaV\xae\xbb\xee:\x00^t\xfd\x153֘\x1f*'\xd5\xf7\x17\xe3\xba\x1b\xa5\tϹ\xe1Y\xc4q\x8cs\x16k-uUb\xade<\x1cP\xd7\x15B\x80R\x1a\xa5\x14BHt\x14!\xa4D6Fs\xde#\x04x\xef\xf1\xde\xe3\\0\x96w\xe1\xcfJk\xb2\xbcE\x9a$8O\xe9\xbc\xfbTU\f\xff\xef\xf3k˟\xfd\xc3/<\xee\xfe\xa7\xf0\x90\xc5\xc5E~\xf1\x17\u007f\x91o\xfb\xb6o#\x8a\"^|\xe3U\xb9\xf7\xee\xcdƘ\x1f\x1e\f'\xf3\xe3q\x85V\x8aK\xf6/\x12EQ\x13\x9aJ\x9c\xadq\xceQ\x96\x05xO\x14\xc7h\x1d\xed\x86()\x15Z+\xb4֍\x17\x89\xa6\x8a\n\x0f\x9a5\x06+\x1c\xdeKd\x14\x8c\xa7\x94\xc69\xc7d<AJ\x99di\xfa\xcdYg\xe6\x95y\xd2\xfaқ\xbe\xed\x9b\xef\x10\x82\xdf|\xe7\a~\xaf\xfc\x1f\xd6 o|\xe3\x1by\xcb[\xde\xc2\xe1Çy\xf1-\xcf\x14X\xf3\xcd\xd6\xda\u007f?\x1c\x15\x97\xf7\ac\xbc\xf3\xe4R\x11e)\xad<\xc3{GU\x16XSc\x8c\xc1\x98\x1a\xa54B\b\xa2(\xa6\x9d\xe7tZ9\x9dVF\x1aEDZ!wr\b\xc13*c\xa9\x1dX\xe7\xa8\xea\x9aqQ2\x18\x8d\xa8\xaa\x8a\xb2(\x10B\x90g-\xa4R\x80@\b!\xb2\xbcu\x93w\xfe=B\x88\u007f\xf1\xcf_\xfb\xbf\xbc\xe1\xe03\xae\xf9\xd3\x1f\xfe\xc9\u007f\xfb?\x96A\xee\xb8\xe3\x0e\xde\xf0\x867p\xec\xd8Q\xbeᦫ\x17q\xee\xedEU\u007f\xd7\xe6\xd6PV\x95\xa1\x95%\xb4\x84\xa26\x96\xe9\xd9):\xad\x9c\xf1h\x88\xa9J\x8c1x\xefɳ\x9c\xf9\xe9)ڭ\x16q\x14\x13E\x1a\xe7\x82\x17\xd4\xd6c\xac\xc1y\x87\x90\x92H*b-\xe8d\tR\b\xac\xf7\x18\x97R\x9b\x9cq\xb7CU\xd5\f'\x05Um\x9a\xe4Ox\x1d\xc09OU\x16dY~\x9d\xa9\xeaO\x1f\xb9\xff\x8b\xff\xdb?\xfd\x8e\u007f\xf4\xe1w\xbe\xff\xbf\x00PU\x15+++\u007fe$\x88\xa2h\xef\x19dii\x89;\uee03o\xf9\x96o\xe1%/\xb8\x89r\xd4\u007f\x95\x84_\x1d\x8d\x8b\xa5ͭ!Z+\xe6\xa6;\xa4JcJC\xdeɘ\x9f\x9b\xa1,\v\xcab\x82w\x0e\xa9\x14\xad\xbcE\xaf\xdbA\xab\x88ai\x18n\xf4)&\x05eYQ\xd7\x06\xef\x1d\xce:\xbc\xf7$i\x12rN]\x93\xc5\x11\xb33]\x16g\xa6\x99\x9d\xee\xd1J\"\x12\xad0i\xccL\xa7Ť2\x94u\x89\xa9\x1d\xce;ƕe}}\x95\xd5\xf3\xa7iwz,.\xee\xcb\xe3(\xfaϦ.\u007f\xe1ͯ~\xd5/~\xf3k_\xbf\xfa\x82\x17\u007f#\xb7\xdez+gΜ\xf9o\xfe\xde\xcb\xcb\xcb,,,\xec=\x83|\xf4\xa3\x1f\xe5\x96[n\xe1\x85\xcfy\x86\x12\xf0\x16\x01\xffz{0҃\xc1\x84V\x16\xd3m\xe7(\xa9\x10\xd6\xe3\xb5diq\x0e\xef\x1ce1AJI\x9a\xe5dYF\x14\xc5\xd4Ʊ\xbe\xbe\xca\xc6\xc6\x16ָ\xc6s\\\x93+\x1cBH<\x9e\xf1h\x88\x10 \x95$\xcf3D\x94\xb3]\xc1`u@+\x8d\xe8d1\x91\x92DZ\x10\xeb\x88\xca(\x06E\xcdf\u007f\xc8\xda\xday67\xd7\xd1Z\x93h\x89)\x06\xac\xadM\x92^o\xfa-\"\x93o\xfc\xe8o\xfd\xdaso\u007fշ\x1c\xfb\x99\x9f\xf9\x19^\xf7\xba\xd7\xfd\xf7\x15\xb2>\xf6\xb1\x8fq\xf3\xcd7\xf3\x82믈\x81\xf7H\xf8\xae\xed\xfe\x88Ѹd\xba\x9b\x91\xa7\x19\x12\x81\x96\x8a\xe1x\xcc\xd2\xfe\x05\xb4R\x14\xe5\x988NPJ\x13'\tZk\x06\x83>\xab+\xeb!\xc1;C]W8g\x10R\xa2\x95B*\x85\x14\x8a\xaa\xaaPJ1?\xb7\x8fno\x8a$\x89\x1b\xcfqX\xeb\xe8\x8f\f\xfd\xf1\xa4)\x8f=x\x8f\xb5\x96\xcd\xcd\r\xd6ז1u\xcd\xc2\xc2\x02K\xb3\xd3x<\xc7O\x9f\xa5(&8\xefQ\xfd\xad٩\xe9\xd9\x1f\x04~\xe0\xc5/~\xf1\u007f_9\xe4\xce;\xef\xe4\xf6\xdbo\xe7\x85ϹR\tx\x97\x14|\xd7\xe6\u0590\xa2\xa8\x98뵉\xa3\x18a=2\x92\x8c\x87\x13f\xe6\xa7\xc9\U000d4898\x90\xa69\xc6\x18Zi\x8a\xb5\x96S\xa7N\xb1\xb1ѧ\xaa\v\xdaYB\x9eg\xe4\xf9\x14y\x96\x12'1y\x9a\x12i\r\bƕa8\x9a\xb0\xb5\xb9ű\xa3\xcb\xc8\xc6kZ\xad.\x9dN\xb7)\x87-\x02\x81\xf3\x8e\xd1h\xc8h\xd0'\xcdZt{\xd3\\\xb28G;O\x99\x94%\x8f>y\x9c\xaa\xaaH\x93\x9c\xa2\x18\x13G\tE1y\xcdw}\xd37\xfe\x9f\xbf\xfe{\x9f\xae_\xf5\xaaW\xf1\x91\x8f|d\xef\x1aD\b\xc1C\x0f=D\xbb\xdd\xe6\xe0\xc1\x83\xdcz\xfd\x15R\t\xf1o\xa5\xe0\u007f\xdf\xee\x8f(˚\xb9^\x87Xk\xb0\x0e\xa5\x15\xc5pB\xdek\xd1\xed\xb4(\x8b\tI\x92S\xd75\x9dv\x9b\xa2\x1cs\xfe\xfc*U]35\x95\xd3m͒\xa6\tI\x12\x93\xa7\x19Y\x92\x10E\x11i\x12\f\xa2\x95D\x00\xe3\xa2dsn\x8a\xed\xc1\x98\xfep\x8c\xb1!\xbc\x8dFC\xac\xb3M\xd5V#p(\xa5\xe8u\xdbt;\x1d\x0e,\xce\x11E\x11\x9b\xfd\x01\xf7=\xf2\x04uU\xd1\xcd\x13\x9c\v\x06\xb4\xceRL\xc6\v\x9d8\xb9QJ\xf9\xf9\xdbn\xbbmo\x1b\xe4\xdak\xaf\xddm\xf4\x9ew\xfd\x95B\xc1O)\xc1\x8f\x8cF\x13QL\x82gh\xa5\xc18d\xa4(\xc7\x05Q\x9e\xd0\xee䘺B\xaa\b\xe7\x1c\x9dv\x87\x95\xb55\xaa\xb2\xa0\xd7\xc9H\x93\x1ei\x92\x10iM\x9e\xa5\xe4iJ\x96\xa6dIL\xa4#\x94\f\x86@\x84ޣ\x93gLw;\x14U\xcd\xda\xd6\x00\xeb\x1c\xa3\xa2\xa0\xae+\xeaj\xc2p4\"\x8d{\xa4iB\x9a\xa4Lu\xdah\xa5\x18M\n\x1e?u\x86\xfb\x1f9F\xa4%s\xdd\x1c/\x05\xe3Ʉ,\xcb\x19\x8f\v\xa4\x94\xf4\xb7\xb7_\n|\xfe\xd6[o\xdd\u06ddz]\u05fb\xa5\xde\xf3\x9fu\xf9\xff\xaa\xa5\xf8\r[\x1b\xb9\xb6\xdegn\xba\x13\xc2J\xed\x11J`\xcb\x1a\x95Ft\xba-\xd2$B\xe9\b\xa94JE\xac\xac\xae\x90'\x9a$\x8ep\x16z\xbd\x16Z+:y\x8b^\xbbE+˂\x11\x84@\n\x81q.TcR\x12G\xc1@\x9b\x83\x01I\x14\xa1\x95\x02!\x10\bh\xbav)\x04\xce{&eI]\xd7l\xf6\a\x9c]]\xe3\xf1\x13\xe7X\xdb\xe83\xdd\xcb\xe8\xb4R\xb4R8g\xa9*Cm,\xce\xc1\xfc\xcc4\xce\xd9\xcf\xdc\xf2u/y\xf1?\xff\xbf\xfe\x15\x8b\x8b\x8b{\xb7\xca\xda1\xc6\xf3\xae\xbbl\xbf\x92\xe2W\xf0Nnl\r\xe8ur\xa2(\n\x95\x94\x00[\x19d\xac\xc9\xdb\x19i\x12\xed6z\x93Ұ~\xfe\f\xfb\xe6{H!)JC\xb7\x93\xa3\x94d\xaa\xd3a~z\nk\x1c[\xfd!\x83ј\xed\xc1\x88\xf5\xad>\xe7V\xd60\xc60=\xd5e\xdf\xfc\fQ\x1c\xe1\x1c\xe4Y\xf0\xa2,\x89\xb1α\xd32\x0eFc\xd67\xb71\xd6R\xd4\x15\xfd\xc1\x98\xd5\xcd>R\n\xf6-\xf4\xa8k\x83l\x8a\x05\xaf$\xc6\x18\x9c\x87\x03Ks(\xa9\x88Tt\xe3\x83\xf7\xfcY:;;[|\xad\xf2\xc8Ӗ\xd4_x\xf3\xf5\bx\xbb\x14bz\xd8\x1f\"\x85$K\x12\x84\a\x9c\xc7\x1aK\x92\xc6$yB\x96\xc6a\xec\xa14\xe7V7\xc1V\x1cX\x98F)\x85u\x8e\xa9^\x8b4\x89\x99\xedM\x01\xf0Ň\x8ep\xfc\xd4Y&E\x85u\x0e\xeb<R\n\xf2,a~~\x8aV\x9a\xd0\x1f\x0eqޣ\xb5b8\x1eR\x1bä\xa8\xa8j\x8bǇr7\xd2\xd4Ʋ\xb15`0\x9c\x84\xe6R\x80\x12\x82ղ\xa2\x95'8\xebЩB+\x85\xa9k\x8a\xd22\xdd\xed\xa0\x95&Һs\xf4\xf4\xb9\xab\x94R\x0f\xdcp\xc3\r{\xdb \xe5d\xfb:-\xd5kʢdR\xd4t\xf2\x1c!AX\x8f\xb1\x8e$\x8d\xc9\xdb)R\xc9\x10:\x10\x9c=\xb7\xc6L7czv\x06\xa9$\xd6X\xd2$\xa6\x95gdQ\xc2#O\x9e\xe0\xf8\xa9\xf3L\x8a\x02\x8f\xa0\x9d\xa7t\xda\x19\xad<%\x8a\xc2(\xc5ZKm\x1d\b\x90Rb\xadcR\x84\x12\xb8\xd3ʈ#\x8d\a\x8c\xb1\x9c<\xb7\xc6\xfaf\x9fT+\xa6\xb2\x04\xeb<㪦\xb2\x0e\xf0\xa1<\x1e\x8ch\xe7)B\b\xe6f\xa6\xd1z\xc8`\\\xd0\xc93z\xed\xb6PR=\x1bx\xe0\xd9\xcf~\xf6\xdeM\xeaw\xddu\x17R\xc8\x1f\x04\xaf\xc7\xe3\tJ\xe90_\xf2\r.\x11\xa9]cDZ3)k\xb6\a#\x0e.N\x93&qsc\x1dI\x12#\xa5dmm\x8b\xb5\xb5Mj\xef\x99\xea\xe6,\xcdO\xa1\xb4\xc2\x13z\x88\xb2\xaa1֢\x1b\x8f\nO\xb3\xa5,\xeb\xd07\bA\xac\x15ZJ\xbc\xf3l\xf5\x87l\xaeo\xb3\xd8i\xf1\xfcg_ž\xe9.\xad8F+A\u007fR\xf2\xd9G\x8frlm\x93\xb2\xb6H\x191\x1a\x97\xf4\xba\x11Y\x9a!\x85`}k\xccl\xaf\x8bu\x96\xb9\xe9\xde\xdf\x03~\xeb\xb2\xcb.ۻ\x06\xf9\xe17\xffc\xad\xa5\xf8\x96\xb2(\xb0\x16\x12\xadБ\xde\x1dw\xe7\xed\x9c(\xd2H)\x18\x8c'H!8\xb4\u007f\x0e\xadU\x03\xfay\x94\x92\xd4u\xcd\xda\xea6\xdb\xe3\t\xd6ZR\xa5\xc8Z\x1d\xfa\xc31\xe0\xc9ӄ,KH[\x11eY3\x19N(\x8a\n\xeb,Zk\xe28\xa2\x95ƤY\x82\x17\x82\x8d\xed!\x99\xf5\\\xbb0\xcb⡃\xb4\xb3\x84^\x9e\x92\xc71\xce9<0\xdd\xca\xe1\xea\xcb\xd8\xec\xf7٬\fZ\xa7,\xafo\xd1j\xa5H)\x98\x9d\x9abc{\x8c\x10PV5i\x12\xff\xbdo|\xdeM|\xe4\xbf~\x06)E3S\xdbc\x06\x91\x82˽\xf7\xb3eQ5\xb9#B\"p֒\xe5\tI\x93\xc0W7\xfbLw[tZ9\xe0q\xd6!\xa4@IEU\x1a\x96ϯ3\xa8*p\xa0\x94\"\xcaSt\xa4Y\\\x98\xc6\x18\x1b\xdejCi\x1c\x1eO\x9cF\xe4\xed\x10\x96\xa4\n\xe1j\\V\x9c_\xd9dZE<\xa3\xd7\xe1\xf0\xc2\f\xfbf{t\xf3\x94<\x8aɢ\x98,I\x18\x8e'\xaco\x0f\x88\xa2\x98\xa5\xa9\x1e\x9d,ch\x86\xa4\x91b0\xf4\x8c\xc6\x05\xccB\x1cE\xccMw\x19\x17%JJ\x8c1W\x9f;}\xb2\xdbn\xb7\xfb\xdf\xfe\xed\xaf\xe6\xfd\xef\u007f\xff^4\x88\x983u\x8d\xf7\x82XJ\xa2$\x02\x17\x9e\xfa\xbc\x95\x82\x80\xcd\xfe\x90\xc5\xd9\x1ei\x12\xe3\xbdG\x00J+\x04\x82\xed\xb5\x01\x1b\xdb\x03\xac\x14t\xb3\x948\xd2DJa\x8ceku\x9b\xad\xfe\x90~Y\x91\xb7S\xf0\xd0Nb:\xed\x8c,Np\xc0\xdaր\xed\xad!Ÿd\xae\xdd\xe2\xa6K\xf7sxq\x86\x83\xf3\xd3\xccv\xda$:\x02/hg\x19\xed,E+I\x96d \x14\x9b\x83mZqµ\x97\xecgr\xfc$UU\xd3i\xa7\x8c\xc6\x05\x93\xa2\xa4\x9d紳\x94sk\x9b,\xccLqfm-\xf7\xf8k\x85\x10w_s\xcd5{3dyG[\n\x89w\x90dQ@\xeb\x9c'o\xa7\b)\x18\f',\xcdM\xa1\xa4\x04\x1f\x88\t\x83Q\x817\x96\xc1p¤\xaa\x90֣=\xd8ȱ5\x19RW5\bA{*\xa7\xd7Ι궈\xd3\x18\x15\x05C\x8d\xc6%\xe7\x977\xa9+\x03@7\x8dy\xd6\xe1\x03<\xf7\x19\x97r\xc9\xdc43\x9d6\x9d,'O3\xd28\xf4;\xc2C('<y\x12\x91\xc5\x11\xdbR\xa1\xbdenj\x8av|\x9es\x83!S3]ַ\x86\xf4\x87C:\xadP~\x0f\xc7\x13\xf6/\xccR\xd4Fx\xef_\b\xdc\xfd\r\xdf\xf0\r\xfc\xe4O\xfe\xe4^\x1c\x9d\xf8\xfd\x0e\x90\x80l\xf2\x82Ҋ4M\x18\f'\xcc\xcft\x91RPLJ6\xb7\x87l\xac\xf7I\xa3\x88N\x1cӋ#\x96Zm\x12\xadA\x80s0\x88J\xc6E\xc5xRR\x8f*\x90\x02\xefa\xb25\xc1T\x01\xc7PRЋ\x14s\xf3=\xae\xb9d\x81\xcb\x16g\x99\xed\xb5\x99\xeet\xc8҄v\x963\xd5j\xa1\x84\xc4K\x85\x13\x12\xef-҅\a\xa2\xb26\xf4'\xce\xe3\x81^\x9e\x87y\x97\xf3x\xe7\xc8Ҙ\xfepL+\x1b\xd0m\xb7(\xabz\x878\x81\xf3\xfe\xeb\xef\xb9\xe7\v\xbfp饗\xee\xd1Y\x96\xa7嚎YH\x01\x1e\xb2VBQU\xe4Y\xa8\xed\xcf\x1c]\xa10\x86}\xdd\x0e\x87/\xd9O\x1eG\xe4qD\x12i\xd2H\x13i\x89Rj\x17\x13\x9fT5em\xd8\x1a\x15l\x8d\xc6l\x0e'\x14\xa2F$1\xd3팃s=\x0e\xccN1\xd7k\xd1\xce3Z\xad\x9c\xa9N\x87<M\xe9\xe69i\x9c\xe2\xbd\xc0\v\xb9\v\xe9\n\xe7\x11\xd8\x06g\x0f\xfd\x8cw\x1ec-RH\x92$#\x8f\v\xeaʐƚ\xda8\xd66\xb7H\x92\x18pLʪ\x81\x8e\xc5-\xff\xf8\xdb\xfe\x91\xbe\xfb\xe1\xc7͕W^\xc9\xe3\x8f?\xbe\xb7\f\xe2\xacK\xf1\x10Ej\xf7\uf514\x98ڐwbΜX\xa12\x96g--2\x9dg\xb4ӘN\x96\x10)I\x9a\x04\xa3đ\"\x8et3\x8a\xb18\xef\xf1x\xca\xdab\x9c\xc3:\x87s!\xf7\xb4\x92\x98$\xd6DQSY\xb5r\xba\xdd\x0e\xfb\xf6-\xd1\xce\xf3\xd0\xfbx\x81wM\xe1 \xc0\x1a\x87-\xcb\v$\b\x04Z+\xa4\x94H/\x90\x02\xda\xed\x0e\at\xccѕst\"E\xa4%\xde\xc3p<!K\x13&E\x89\xf7\x10Ez\xa9\xac\xaaCy\x9e?\xf9\x9a\u05fc\x86\x9f\xfe\xe9\x9f\xdec\x061n\xe8\x85\x0f\x1e\x02h\xad('%\xadVJ\u007f{D\x8c\xe4\xaa}\x8b̶2\xe6:9ӭ\x8c$\xd6\xe4YB\x9c\x84RUk\xb9;\xe2\xf0\x1ejc)\xaa\x1ac\\0\x8e\xf7\x17\x11\x1b$Y\x96\x90\xa5)\xadV\x8b\xee\xd4\x14SS\x1d\x924\r_/\x1c\xc2x\xa4\x12H)\xa0\x81wq\n\f\b\xc2\\+֡/*MM\xae\x15\xfb\xa7\xa7X\xde\xee\x93*\x8d\xf7\xe0j\x83\x92\x92\xc1pD\xa7\xddb8\x9eP\x94%\x02\x14\x9e\x9b\x81'\xaf\xbaꪽ\x17\xb2\x06\xa3ɓ:\xd6M5\x13\xbcC6C\xbdz\\qhz\x8a\xc5^\x9b\x85v\xcet'\vs\xa64\"\xcb\x12\xa2H\xa1\x95DI\x19\x9eX!\x90Z\xecRz\x84T8\vHI\xa4#\x84\x14\xf4:-\"\xad\x11*BE\x11:\x89\xc3\xc4WH\x9cT8\xe1p\xde\"\xbd\v\x04\x06\x0f\xa2\xf1\x94\vD\b\x17Bk\x121\x1c\x8f\x89\x85`\xdfT\x87\x93+\xab\\2;\xcfɭ\xb5]*\x91\xb5\x0e!\x04\x83\xd1\x18!B\xf5h\xac\xbd\x19\xb8\xf3\x8a+\xae\xd8{\x06\xa9\x8c=\x82\x14.\x89\"\x89\x10\x18c\xc9\xf3\x94\xf1\xb8\xa4%4\af\xbaL\xb7Rf\xbb9ӽ\x9c8\x8a\x90J\x12G\n\xa5$i\x1c\xa1u0\x88\x8eT\x98\xdcjM\x12%\b\xa5\xb1\x0e\xe2\xa6\xf1{\n\xbb\x04\x81\x10\xe0\xf08$^JP2\x8cgb\r\xc6\xe0k\x8b\xb0\x1eaw\xbe&\xe07R\n\x84\x10\xc4Z\x87a\xa2\xb3$J2ӛbmc\x9d\xd4\tl\xa2(ʚ,\x8d)\x8b\x92$\x89\xf1\xde\x10זѸ\xba\xe9\x15/{\x19\xbf\xfe\x1b\xbf\xf1\xb4\x1aD>-!\xcbq\xc6ZwJ\np6\x90\x0e\xeaʠ\x1c\xe4QD'\x8d\xc9\"M;O\xd0J\x93$\x11i\xa2\x11R\x10G\x9a$\t7;\xcd\x12\xf2,'\x8e\x13\xe2(FiM\xac5y\x9a\x90&1R\b\xc4\xc5\xc5D\xe0\x8a\xe0,\xf8\x1d:\xa9s\x88\xe6\xcd\xfb\x06+\x11\x82\x9d~\xda\xf9\xc0\x83\vH#h%\xc9\xd3\x04\xef<\x91\xf7tZ-\x90\x92<N\xa8'e\xe8ƽ\xa7\xack\xb24\xc1{\xc2C\xe0\xfd\xb5\x0f\u007f\xf1\xf3\xd1\xd4\xd4\x147\xdcp\xc3\xde2\xc8\xf3\xbf\xf1\xa5\xd6Zw\xafo\xa89\xde;\xaa\xaaFxȚ\xa7:֊H+\xb4\x96()B\x88R\x8a$\xd1(%\x89#M\x1c\x05\x12\x9cR\x1a)u`'J\x15\xfa\x17|C\xb3\xe6\xa9&\x11\x12\xb4\x02-A\x8a\x8b\xf2P\xa8\xa0.6\x1dM2\a\xd0\xcd\xeb\v!Ȓ\x18-%\x91\x87LJ.Y\xdaG\x9c\xc4$Raʪa>z\xe2(\xccΔR\bX\xd0Z\xce'I\u0095W^\xb9\xb7\f\xf2\x9f\xef\xbc\x13kݟ\x14U\x8d@\x04rtm0\xb5!R\x12\x84@\xed\x84\x12)\x91R\xa0#E\x9a4\xf0\xab\x14(\xadv\x8d\xa1d\x18\u007fk\xa5v\v\x85\x9d\x99\xd7SM\xe2\x91:\x86,i\x92\xb6\u007f\x8a1Dc\x04\xef|cN@\x06b\x1c@\x96\xc4\b\x11\xf2W+ˈ\xa5\xa4\xad%\x91R\x1c\\\xdaO'\xc9\x10ֳ\xdd\x1f\xb3\xf3\xd2\xcey\"\xad\x10RDI\xac\xaf\x00\xb8\xe5\x96[\xf6\x96A>\xfc\xe1\x0fc\xac\xfd\xb5\xa2\xaa\xb7w0v<\x94EE\x039\xec\x86\x1b)\x83q\xa2\xc6c\x84\b\xb1\\\xc8`\b)$B\xee\xf4$\x17\x18\xed!#\x8b\v\u007f\xd7\x18\xc9I\x89\xb7\x0eo\xec.\x97w'\xbf\x84~\x03\\\x03\xf3\xa2u\xf0\xa6\xe6g\xd4Z\x05\xd4R)\xd28\"\x8d5m\xadH\xa4$MS\xf2V\x8bv\x94\x06\\dRb\x9d\xa3\x89x$\x91Fk\xf5\x8c\x1d\b{O\x19\xa4\xaa*\xce\x0fƛ\xb5q\u007ff\x9ck\b͒\xba2̓\xe51MG\x1c\x88\xd2r\xf7\xfe\n)v\xfb\x16\xd14\x96R\x8a\xf0\xb1\xb888}š\r\xb66`\xdd\xc5\x19\x1b'e(\x95\x9b\xc6\xcf\v\x89P\xe1A\xd0I\x84\x8a\x15*R(\xa5h\xe5a\xb6\xa5\x94\xa4\x9det\xa2\xe0%Z\nz\x9d.\xb1R\xb4\xa3\x84\xaa\xac\xa8\xea\x1a\x01\x94eM\xa4%Y\x12]\n\x90e\xd9\xde2\xc8ν\xf0\xf8\xf7\x95u\x1d\u0085\x90\x18\x13J\x1b\xebB\xe9\xe8vCJ\xf8\x02q\x917ɋ\xb0\xf2\x9d}\x8fݝ\x0f\xbf\x13\xa8\x9e\x9aA\xbc\x94<%͋PjS\x1b\x84u\xe0=\n\x8b\xd6\x10e\t:\x8d\x88\x92\x88(ψ\x9a\xb9X\x9a&\xb4[9\xba\xf1\xdaN\x92\xd0ђ\\I\xday\x8e\xd2\x1a-%\xc69\xea\xba\xc6{GQ\x1a\x94\x92(\xc9,\xf0Ua\xe8_3\xc4ЇU\xa6\xff:)\xebI/'S;\x11\xdfCiB\xe7m\xac\xbd\xb0\x1a\xe0Cn\xf1\xce#\xa4\xb8\xb0\xf7'\x82w<\xd55\xc4W\xf4\x13\xe1=\xc2\x19\x84\x91x\xa5\xc0;|m\xc0\vd3\xab\x12I\x84\x88\xe3\xd0\x1c\xa61\xbe\xf1N\x11i\xbc\xaa\xf1EI\x1c59K;\xd2$\xa2Wij\xe7\x19\x9a\x88n\xa7ø\xaa0\xb5m~F\x81u\x8eD)jS\xef۳\x10\xae\xf70)\xebS\bq\u007fm\xed\xad\xaa!\xaaU\xd6P5\x06\t4\xd0\xf0\x16E4c\r\x81FbLM\x12\xa7\b\xc4n\xbe\xf1\xfe\xcf\xd9\xe3/\xbc\xa6\x0f\xd3[c\xc0\x18p6\xd4S\xc6\xe0u\x8cHSD\x14\x85\x89\xa5\xb3!\xb1k\rR\"\x9cC\x89@d\xa8m@\x1d\xe3\b\x84\xf3tʊ\xca:Z\xb5enz\x86\xc9x\x8c\x92\x84\x06\xb1\x19tj%\xa9L\x1d=\xdd\x06\x91O\xe77\xdb**\xbc\xf7\xef-\x8a\ncC\xb8\xaa\xad\xa5\xaa-\x93\xaa\xa6\xaam\xa8\xbeL\xe8Uv\x8dd\xc3bΤ\x18S5\xcb9\u007f\x93ٿ\xb4\x15\xb2.\x90\xa6BZ\x83\x8842K\x11q\x847\x06\xbfӓ\x84\xb9\vD\x11\xc41蘺rTE\x15\xf0\x19)\xd1J\x12kEK+f\"E\x9e$Lw\xbb\xa4*\n\xa1\x94\v\b\xa71\x86=m\x10\x13\b\xd0\x1f*\xeazB\x83g+!\xa8\x9c\xc7z\x81G\xa0\x90x\xeb\xb1v\xa7O\xb8\xc0\xb3\xad\xaa\x92I1\xa6(\v\xac\xb3;\x81\xf0\xbf\xe9\x1d\xbb%n\xb31\xd5\xcc: N@\xa9&\xd9\xfb`\x848\x81(\xc6+\x8dA0\x1a\x97\x9c=}\x9e\xe5\x8du\xccN\x01`-HI\x12E\xc4*$w)<\xdd<\v\xe5:`m\xd3\x0fy\x87\xb5O\xbfA\x9e^n\xaf\x87\xe5\xfeh\xe3\xd2(z\xd0;\xf7<\xa9\x15\xcey\xd28F)\xc5L\x9e1\xdf\xee\x84\xdbl@\xa80\xfa\xf0x\x8c\xb0(\xe5\x03\x8fJ\x86\nH5\xef\x05\x02\xd94q\x17\x1bc'V\xee\x84\x11|sS\xad\x85\b\xbc\xb3\xa14\xf6PO&\f\a#\x96W\xd7\x18\x8f&غ\xc2\x1aC;II\xa4B\x8b\xb0\x9f\x88\fM\xaa\x02R\t\x89\x80\xa2)\xd7\x05\x82\xdaX\xe2H1\x1a\x8f\xfef\x9e\xfcwa\x90\x9d$^U5\x93qI\x1a\xe9fn\xa5\xd8?ݥ\x93\x05zMi-\xdez\x94\xd48/\x11*LVk<^\t\x8cqTe\xd9\xc4\xf5hw\x8d\xed\xe2U\xb6\x10\xad\xc2n\a^a=8\x04H\x85\x18\x8d\x98lm\xb39\x9cpzm\x83\x87\x8e\x9d\xe6\xc8\xe9\xb3<qv\x99Q\x98\xd6\xe2\x9b\x105\x13G,\xb4ۼ\xec\x86\xe7p\xc5\xdc4օb@7\xb4\xa4\x04\x1f\x98\x90\xb1\x86\x89a<.\xe9v\x13\xfa\xa3\x1a\x01\xbd\x97\xbf\xf0\xf9\xfc\xce\x1f\xfc!O\x17.\xf2\xb4\xb3\xdf= \xbcx\xa8\xae\xcc\xf3\xa24\xdc\xd0V\x1a\xd3\xcbs6'\x05\xff\xe5\xc1Ǹ\xef\xf4\x19\x94\x80g\xcc\xcf1\xdd\xca\xe9d)\xb9\xd6$R`$<p\xe6\x1cۓ\ty\x12q\xf3\xe1}<c~\x86K槙\x9e\x9d'IR\xb4\x8e\x80\xb09U\x95%>\xc9\xd8\x18\x15\x14EIU\x96\x9c>\xbf\xca\x17\x1e?\xc6\x03\xc7O\xb39\x1c\xa2\x1a\f\xbf\xddjs\xf5\xbe}\\67O\xb7\xdda\xb5\xbf\xcd=G\x8epv{\x8b\x8f\xdc\xfdg\xbc鶗\x10y\x8fp\xae\tO\x0e\xd9lq%:\xc2\xdb1\xbe\x81\x80\u007f\xe4\x15\xaf⊅\x85\xe7\v!\xaei\xb5Z\x8f\xdeq\xc7\x1d\xbc\xece/\xdb{\x06\x01H\xa5\xfc\x98u\ue7c8\xda\xd1NRb\x1dqlc\x9b\xdf\u007f\xf0!\xfaE\x81\xb1\x16%%\x8f\x9c=\x8f\xbb\xa8\x88\xf2\x80qn\xb7 \x10B\xf0\xc8\xe9U\xb2X\xf3m\xb7\\\xcb\xf3\xae\x98\xd0j\xb5\x89\x93\x14\x10L\xc6#\x8a\xa2\xe0\x8b\xcb\x03~\xf7O泌*\xaa\xda\xe0\xac%25\xda;\xf6i\xc5T\xac\x98Kc\x0e\x1f8\xc0\xfe\xfd\a\x98\x99\x9d'ku\x90J\xf1\xd2\xeb\xaf\xe7\xc9S'9{\xfa8\xdbkk\xcc\xf6z\xa1\xb9\xb4nwd\xe3\x9c#\x92\n\xbc\xa7\x93E|\xd7\U000ff397<\xf3\x99()3\x8f\u007f\xe7џ\xfb闿\xf4\x87\u007f\xbcz\xeb[ߺ\x17\r\x12\x92\xb4D\x10II'Ni\xa5\x19\xf7\x1c?\x8as\x8e\xe7_q9\xf3\xbd\x1e\xb1\xd6\xd4\xd6p~s\x8b\xb3\x1b\x1b\f\x8b\x02\xe7<\xae\xaa\xa9\t\x15\x98\x01,\x1eS\x1966\xfbl\xf5\xbbl\x0f\x86\b.\xf4-\xd6\xc1\x91ǎ\xd0\xef\x0f\xa8\x9d'\xb7\x15-S\x11㉥d*\x8a\xf1R\xb22.y䡇YX^g\xb6\xdb\xe5\xc0\xcc\f\x87\x17\x16\x98\x9b\x9e\xe6\xaa\x03\a8\xd4N\x99\xf47\xf1\xd6\"\x1a|_K\x81\x16 \t\x90\xaf\xf7\x9eós\xbc\xe2\xd9\xcffP\x14\x1c[[a&I_|`v\xee\aϿ\xf7\x9d?\xfbC?\xf4C_u^\xf9\x1a\x18D\xa0<H\x01i\x1c\xd3\xcd[\xa0\x15\xfb\xa6\xa7\xb8z\xff\x123\xdd\xc0\x86\xf7@Q\x96̶ZTeE9\xa9puM]\xd4D\rx\x94\x12hE\x1a\xc7\xd9ӫ\x9c\x9f\xe9\xd2k\xa5 A\"0\xd62\x1e\x8fY\xa2\xe2e\a\xa6y\xfc\xdc*\xb55x\x15\x98\x90\xfd\xaa\xe6\xe8h\x83\xa1\xb1覡Y\xde\xde&\x17\x10\xa5)\xe3\xdap\xf5\xfe\x03|\xfd3.\xe3\xf0\xd2b\xb3\xcc\xe3Q\x80\x14a\xc8(\x05\xe8\x86/\x00\x82\xd7<\xff\x05<z\xf64\xef\xfb\xdcg(\xab\x82\xe7\x1d\xbc\x82\xe7]}\xed[\x17M\xf5ѥ\xa9\xa9\xafZ\x9fC\xfd\xc4O\xfc\xc4Wm\x82\x87\x1ez\x88\xf1x̝w\xde\xc9\xec\xdc\x1c\x0f}\xfe\xee\x97\xc6Bݾ؛\xe2\x19\a\x96\x90\xb1\xc2;G+KCHq\x8e\xd3\xcb+\xdc\u007f\xf48\x0f\x9d8\xc9C'Np~c\x8b\xcd\xe1\x10\xe9A\xf9\x9d\xa6ϓH\x89\x02Ɠ\xb0\xe49?\xdd!\xd6\x11J+\xa2(\"S\x11\xfb\xdaS\xe4Q\xcc\xf2\xdajXS\x9b\x14\x9c\x1a\xd7\x14YƵϹ\x9aҀ\xf4\x82A\xad\xb8\xe6\xda+\xb9檫y\xe9?\xfcf^\xf6\xca\xdb\xf9\xe3\xbb\xfe\x98\xcd~\x9f\xcb\xe7\xa7\x03\xe1N\x8659\xe7\x1c\xb5\xb5\x18c\x18V\x15\xc3ڰ=\x19\xb3\xd4k\xf3\xb9Ǿ\xcc\xd6hH\xa45\xb1\x90\x98\xb2J\xe2X\x1d\xba\xf3?\xbe\xf7?\xbf\xf0\xdb_\xb37<\xe4E/z\x117\xdex#\x9b\x9b\x9b|\xe8\x97\u007fiQJA'\xcb\xd0Z\xa3\xb5\xc6{\xcfp<a4\x1esn}\x83\xfb\x8e\x1fge\xbb\x8f\x14z\x178j\xb7۬\xd6%-\x91\x12YC\xa2\x14\xce{zq\x8c\xf0\x9eɨ\xc2\x19A\x1e\xb7\x90\xad\x94n\xabKV\x94T\xfd>g\u05f7PZ\xb14\xdb#\xcdS\xc6\xeb}\x92\x99)\xae\xba\xec\x12\xa2(\xe1\xdcz\xc9\xe4\xf49j/9p\xe9\xa5<\xff\xef\u007f=\x89\x82\xb9\xa5\x05\x0e\xe7-ƣ\x11ZJ\x92,G6U\x96\x92M\x93\x18i\x8c1X\xe7\xf9\xec\xa3GH\x94g\xa13\xc57?\xe7Yl\x8fǬn\fX\xdeظ\xfd`7y6\xf0\xe0\x9e0\xc8\xfc\xfc\xfc\x85o*\xc4U\x12A\x1a\x8525lAE\x94\xe5\x84GN\x9c\xe0˧Βf\x19\x97\x1d\u070f\xd09\x951\x9c;\xbf\xc2+^\xf0\xf7\x18\x8cG\xbc\xe4\x9b^\x86\x99\x8c\xf9\xf5\xff\xf0kH\xe3\xb0>\xe4\x83HI\"\"\x96\xe6\xf6Ӟ\x9b\t}\xc1\xfa\n\xe81\x85\xa9\x03\xe3Qk\xe6;\x9aN+\xe7\xb1A\xc9cO\x9c\xe4\xb2C\xfb\xd8\xd8>\xc7\xdc\xdc\f\xe3\xd2pjc\x8b\xb2\xbf\xc9\xfb\xde\xff!\x9e}\xe8\x007\x1c\xbc\x82L:zS=\xb4\x96\x8cVװ\xde!\x85 ՚X)4!L\xa6R!\x84c\xb1\xdb#\x8d\"\xd2^\x8f\x8d\xfe\x88\xfe`\xa4\xf6-\xcd~Ǟ1\xc8\xceF\xd1d<&\x8f\xf5\xd7\t\x1fF\xda\b\x88\x94\xa6\x95\xa4\xacn\xacq\xe4\xd4Y\x16\U000d46de\xff,\x90\x9a#'\xce\xe3D\x86\xb5\x8e{\x8e<\xc1\x95\x97\x1d\xe6\xfak\xaf\xe4\xd1/?\xc6\xc1v\x86-C\x924֡\x9b\xcd\xdd4ϐ\r\x96*Uh\x1egZ9\xb2\xc1ص\xd6$Jq\xeb\x81}\x8ck\xc3\xe4\xf4y\xae\x9cϩf`\xba\xdd%K<\x1f\xfb\xcd\xffD\xea,\x97\x1f\xbc\x92\xde\xcc4\xddVF;\x0fԤ\\\bΝ9\x17\xc03!\x88\xa5\xa0\x1d\x05F\xe6T\xdefT\x8dX\xde\xee\xe3\x9c\vh\xa6\x14T\xc6`k\xf3\xa2/\xff\xc9g\xb9\xee\xeb^\xf4wo\x90\xfd\xfb\xf7\x03\xf0\xef\xbe\xef\xb5\xddg^zy\xfbع\x15tC\x1d\x8d\xb4dav\x06\x8f\xe3\xf6g_\x854\x15G\x1ez\x8c|i\x91\xcb\xf6Ͳ=\x9a\xd0J\xa6\xe9u\xdb\x1c\x9e\xe9\xf0\xa9\x0f~\x84c_~\x88\xae\x8eh\xb5Zx\xef\x19\x14\x15\xa5\xa9\xb1Ɔ\x04\xeb\x9bzYi\x04\xb0o\xaa\xc7|\xaf˸\x0ed\xb6V\x9a\x92\x00\xadVΤ\xc1+\xac4\xf8rHQB/\xebrha?\xfb\xf7_B\x9c\xa4\xb4:9i\xa4\x91\xc2c\xdamT\x9c\x10\xe9r7tu\x92\x88H)\x12\x1d\xa1e\xceڨϑ\xf3\xab\\\xbdo\x81\x93[\xdb\xec\xcfZ\x94\x959x\xff\xaf\xfd\xea\xde0\xc8\xceZ\xdbU\xfb\x9e\xf1\xb2K\xe6\x97:\xa7ϯ\xd0M3\xacq\xbbP\xc5\xd2\xdc\x1c\xf3S\xcfg\xe5\xcci:YJejF\xab+t\xe2\x888\x89\x90\xe5\x10sb\x8bcÂqi\xb9t\xa6K;\x89\xa9\x8cE\t\xc1hRP\xd6\xd5S0\x90P\xa2\nZi\xc6-Ͼ\x11՛\xe3\xc1\a\xee&\x8ec\xd2,#\x8a\x13Ҽ\rQ\x84\x01\xbcPdI\xccT\xabG\x9c\xa4DQ\x82R\xa2\xc1\u0603\xa1U\x94\xd0\xca\x12&c\x15\xb8\x00R\x92i\xcdl\x96\xe2\x9cc*k\xb36\x1a\xf0gG\x8f\x91'\x11\xdb\xe3\t\vi\x8e\xc7/εR\r\x98=S\xf6\xee\x9f_\xb8%\xd2:\xc0\xa0Q\x84w\x0e\x9c\xdb\r_2\x8a\xd8\u007f\xf82\xa6\xe7\xe6\xd9X9K{4\xc0{\xd7\xc0\xb8\x8a\xb1\xb4\xe8Ҳ?\xcf\xe8\xa41\xba!9\x18\xe7)\x8a\x12k,\\4\xcb\x12R!\xa4$O\x12\xe28\xe3\xf0\r7s`\xff\x01\x1e\xfe\xf2\xbdT\xb6F\xe9\x88N\xa7\x1b\x16K\xb5&N2t\xc3hQ\r\xbe\x9e)I\x1aI\x84\x0e\x98J\x14GX\xe1Cr\x97\x01\xb8\xca\"\xcdt\x9a\"\xbc'\xd5\x11օ\xc1\xe5\x1f\x1fy\x92\xce\xce2*\x82\xcdb\xe8\xf7D\x0eٹ\xa6\xdb\xdd~YU\x94u\x85u\xbe\x01\x91\x1cq\x1cx\xe7Y\x9a\xa0\xa4\xa4L\"\xf2V\xc0\xab\xf1\xa1+v\u0381\xb7\x94\xa3\x01\xe3\x8d-l\x15\xc6\x16\xa1@\xd0$J`\x1aDr\a\xaf\x12*\x90\xeb\xbcT\xb8\xba\xa0\xaeJ\x96\x16\xf733=˙\xe53\xf4\x87\xdb\x01\ait\xb2\xa2(\"\x8a\xa2\xdd\xe5\xce<ִZ\x19*\x8eA+|m0\xdb\x03\xaa\xa2a\x9b \x88T\xd8\xc6\xea$1\xb5\x85DGL\x8c\xa5\xdbJɲ$을9ۙ3\xe3\xc2\xee\t\x83\xec`\x984:b\x00\x00\x1a\xefIDAT\x03i\x92|ɹ09\xb5\xce\"\xf1xS!}D\x9e&t\xf2$\xb0RRG7O)\xeb:$b)Ȓ\x84$RTe\xc9\xc3\x0f<H\u007f\xb3\x8foF\xe8Z\n\"<\xc6\x18$2x\x86\x908\x15h9\x12\xa8˂q\u007f\x8bx>#\x8e\x13.;x9EY0)F\f'c\x8arB7KIҔ$\xd2\r\x8e\xaf@Iv\xe0\x82b0`<\x18`w\xf8\\\xde\x13\xeb\xb0\xfdՎ#\x8af\xfd\xc1:\x87\xd6a\"ݎ\xe2\xc0M\x8e\xf5\xc9\u007f\xf1\x1f\xef\xdc\x1b}ș3g\xb8馛\x10\xf0\xa8Vʩ(\x92UUbM\xc5`\xab\x0f.E\xe0\xc3\xc2L\xa4\x89u\x8cH\x04\x88\x1c)\x9e\x8as$qB\xab\xddb\xb85\xc0\xed\x92\xf1\x1c8\x03B\x92$-\xa2\xb8\x8d\xf5\x86\xda{|\x92!\x8b\x82Hi\xca\xcd5\x98\x99\x03!\x11J\x93\bO\x12Ǵ\x92\bl\x16n~\x12#\x8c\xbb\x00I\xca\xf0\xb9\xc5\xc6\x06\xe5\xd6\x16UU\x12\x01\x85\f0s\xa4\x14\x89R\xc4R`\xa4\xa4\xb4\x06\xb3Sa\x01\xb9ִ[\x19Z\xa9G\xf7\xcc,\xebĉ\x13;wtYI\xb9\xd5\xedvg\x8a\xaa\xc4;\x8b3\x06[\u058c\xfc\x904\x8aPR\x11ǁ\xbc\x16JՐ\a\xf0!D\x94Մ(\x8aٱ\x94\a\xaa\xb2\f\xf1;k\x91\xa4\xdd\xe6\x89\x0e\x9a\x8a\"\xcb\x11eI\x12\xc5T\xfd\r\xecd\x8cJ3\xbc\xb3\xf8\xc6s%\x02\xafc|\xa2\xa0)4P\n\x1a\x01\x1bSL(\xb76)\xc6c\xea\xaa\n2\x1cu\xb5[\x90\xc4Z\xef\x02Sò\f\xab\xd6Z\x13K\x19z\xa4@/\xfa\xf2\x9eA\f\x1fx\xe0\x01\x00NO&\xe3\xda\xd4\x0fNw\xbb\x94Uu\x11\x1aX#\x9cǔ%\xc3~\x9f\xa2,\xa8Mئ\xb5\x8dh\xa5\xf3\x9e\xcaT\x18S\xe3\x9c\xdd\xe5\xf1Zc\xa8\x8a\x02\x0f\xa4I\x8ai\xc8QR*\x94\nH`x\x925\x91\xd2L\xd6\xce\xe3M\x857\xd5.\xddT\b\x81P\na\\\xc8kJ\x857)qEI\xb5\xb1\xc1d\x1c \xe4\xba,1u\x15\x80\xaf&_))\xa8\xad\xc3X\xc7\xea\xa8O\x12i\xc0\xd3\xd1\xd1n\xbf\xb2=\x9a|a\xcf\x18\xe4\xdd\xef~7+++\xfc\xcc\a>Ȱ\x98\xfc\x97\xb9\xde\x14\xc6\x06,ݘ\x80\xa5W\x95\t\v2u\xc5d<\xa6\xae+ʪ`\\\x8c\x99\x14\x13\x8a\xb2\xc0\xd45\xd5dL]\x95\xbb쓲(\xc2\xd3ڄ\x98\xdaTЬ\xa2H\xa1\x90H\xa4\x0e8z\x1c\xc5\xd4\xc3>v<\n䆆\xc5\"\x85\bH\xa2\xb1ME\xd7p\x81\x8b\x92z\xd0g<\xec\a}Gc\xb0\xa6\xe6\xcf\x13\x8fvvS*g\xa90L\xb5s\xb4\x90!\\\xe5\x19\xe3\xaa\x1e/o\x0e\xf6\x8e\x87\x00\xdcw\xdf}\x00\xacl\x0f~#O\xd3e\xad\x14em\x80\xa0\fZ\x965uU\xe3\x9dÔe\xc0\xce\xed\x8ew\x84\xe4]\x8e\x86\x14\x93\xc9\xee\n@1\x990\x19\x0ehg\x19I\x1c!\xb4@'\x1a7\xa5\xf03\n\x11)\x84w\xa8\x99i\xd4\xd4\x142O\x91\xceQl\xac`\xab\"@\xba\xce\x01.\x18\xc47\x18;\xe0\x8b\x123\x193\x19\x8f\xb0\xb5\xc1\x19\x03\xde\xe1\xac\x05\xe71\xc6\ue8a0\xae\x01\xb9\x10\x92N\x920\x95\xe7\xcc\xc6\t\x99\x8eh\xe7)\xa3Iy\xcff\xcddO\x19\xe4w~\xe7w\xf0\xdes\xc7]\x9fY\xaf\xea\xf2_gi\x1a\x02\x86\xf7\r\xf3\xc31\x99T\xc1\b\xd6\u1a9aI1\xa2\xa8&TU\xc9x0\xa0.\x8af{\n\xac\xb5\x8c\xfa\xdbLu:t\xf3\x9c<I\x99\x8cF\f\xb6\aP\x85\xa7\x1f)\xf0U\x89\x88bD\x92\xa0Z-D\x12c&c\xcch\x88\xb75\xde\x1a\x9c\r7\x1b\x11\xf8\\\xdeX\x9c\xb5\x14\x931\xa6\xae\xa8\xeb2\xf4C\r\x93\xc5{\xb7[l\xf8f\xf8i\x9cc:Oٟ\xe5\xecO2\xa6㔹^\x1b\xa5$+[\xc3O\xfd\xd4\xc7>\xb9\xb7X'\xefz\u05fb8v\xec\x18\x00g\xcf-\xff\x1a\xce\x1d\xd9)\x11]\xa3\x9fk\x8d\xa5*\xc2\x02\xa5\xb7\x1ea=\xae\xac\xa9\xc6cLU6\v2\x81\x946\x1a\f\x89\xa3\x98<I\x80\x90X\xd7V\xce\xf3\xe8\xc3\xf7s\xfe\xb1\x93l=\xb9\xcc\xf9\x13'9\u007f\xfc\t\xce?\xfe(\xa7N\x9dbu\xf9<\xc6\xd6A\x99\xb4\xbf\x85\xab\xaa\xdd\xe6\x14O\xc8\x19\xce\u1aca\xba,\xa8˂\xaa*\xc3\xe7@\xc0ԛE\x9d\x8b\xf1{c\x83\xd0@'K\x98me\xec\x9b\xea0\xd7\r\xfb\x8d[\xc3Iq~\xbd\xff[{\x8fuB\x90\xf7\xfb\xfe\xef\xff~\xde{\xdf\xfdտ\xb8广a\x9c\xfb\xe9\xda:b\xed0\x96]\x01\x00Q\xd6H!Ѳ!?7K\x99\xd6{&E\xc9x\x14\xf4M\x92HS\xd6&\xac\x01H\x81\xa9J\x1ey\xe4\xcbTŘn\xb7K5\xdcf\xbcq6\xc0\xae\x8d\xacF]T\\\xf9\x8ckX\\\x98\xa7\x1e\x0f\x89u\x17!\xc2p\xd0U5B\x86\xb5\x82r4\xb8\xc8\x18\x81Q\xe9\x8c\xd9M\xe4\xb21L\x90t\xaa\x9a\x9dD\xc1\xbe\xf9ޮq\x8d\xf3\x9cZ\xdd\xfaO\xaa\xdb=\xb6\xb5\xb5\x851\x86\xb9\xb9\xb9\xbd\xe1!\x00\x1f\xf8\xc0\a\xa8\xaa\xaay\xaa\xcc'\xaa\xda\xf8\x90'v\xf4t\r\xd6:L\x1d\xc0\x9f\x1dI\xf0\x9d\xd0`\x9d\xa3,ʐk\xbc\xa0\xf6\x82\xa3+\x9b<pb\x99\x87Ϭqr}\xc0\xca\xca2G\x1e}\x90\xd5\x13\x8f2\\?\xcbڨ\xe0\xf7\xee;\xceG\xbex\x8c\xf5QA\xe9-\xbeY\x830\xe5\x04[\xd5\x17\xe8\x95\xd6⪒jا\xaa/6F\xb3l\xe4<\xae\xa1\x0f\xed,\xe7\x88摉\x94n\xe6]\x81\x16{ju\x8b\x87O\x9c?qfu\xeb\xc7\xff\xfd\xa7\xff\x88\x0f\u007f\xf8\xc3O\xf9}\xf6\x84A>\xfb\xd9\xcf\xf2\xe0\x83\x01\x12X6\x93\xfb+[o\xd66l\xd2:\x0f\xb5\t{#\xd6X\xaa2Lo/\xc4iOU\x99f^\x15\x12\xa8\x8c\x12Z\xad\x9c\xc2\xc0\xfa\xa0\xe4\xd4ڈ\xb3[%um\x18\x8d'l\x8c\n\xeez\xf0\f[\x83\x82\xc5VB/\x8b\xe9\xcc\xce2;3\x1bh\xaai\x8eP:\xf4$\x8dh\x81\xb3\x96\xb2*B\xf2n\xe0b\xef\x1dvG\xed\xd4y\x1c`܅\x05\x9f(\xd2\x14\xc6PT\x96\xc7N\xae\xf1\xc7\x0f\x1d\xe7\x81'\xcf\x1e;\xb9\xbc\xf1-\xef\xf8½+'N\x9c\xe0-oy\xcb\xded\x9d|\xfaӟ\xe6\xe6\x9bo\xe6\xce\a\x1f\xad\xbf\xff\x96\x1b\xbeP\xd4\xd1˳8\"R\x12렪\x03{\xdcԆ\xba\xd6AYT\n\xac\xf3TUՈ\x8e\x85%M'\x04\xedV\x8b\x83KP\xd5\x16\xe3l\xc0\xdeM(\xa9;\xdd)\xde\xfc\xeaW04%\xf3Y\x0e\b\xa6\x1a\xdc^&\tq\xa7\xb7+\x01\xb8#\ahl\x8d\xb5\xa6\xa9\xee\x02Q\xcf]$\xe2\xdfH|\xedj\xc8;\x02;\u007fys@Y\x96l\x15\xc6W\xc6\xfe\x96\x10\xe2\a\xdeyϗ6VVVx\xe3\x1b\xdf\xc8\xf2\xf2\xf2\xde4ȏ\xfd؏\xf1\xfa\u05ff\x9e\xc5\xc5E<\xdcW\xd5\xf6\xe5;\xc2cB\x05\xf6\xb8\xb5A`\xb2\xae갷\x11)Lm(&eX\xea7\x96HI\x9c\xf3H\tӝ<\x90\xb6\x1b\xf1\xb2\xd9v\xc6\xecT\x9bC\x97\x1d\xe2\xf0e\x87\x90:\x84(\xacÎ'8\xe3H:]\xb8\xe8\x18\x8b\x1dr\x9d\xb5MHjVռu\x98\x86>\xe4\x1a\u0588\xf3\xbe1V\xf8X\nA1\xecs\xae\xefpi\xeb\xdfm]z\xf9[?\xf8\xd1\xdf\xe5\xf1\xc7\x1f\xe7\xc7\u007f\xfc\xc7\xf9\x83?\xf8\x03\xf6dR߹\x8e\x1d;\xc6\xe2\xe2\"cc?\x9fhGe,I\xa4\x82\xb4\xb7\r\x04\x02%\x15ָF\xa1\xdaS\x96U\xc8+\x8dR\xb5V\x02\x87\xa26a|\xbf\xa3\xa7\xd5k\xe7\x1c\x9a\x9b\"\x8ec\xe6\xe6g\x91\xad\x1c_\x87fND\x1a\xd5m#\x9c\xc3\n\x8f\xa6\t;\x1e\xbc\xf08\x1bn\xbcgg\xf5-\xb0$M\x1d\x94\x86\xdc\xee\xca\xc1\x05^\xb1\a\x8c\xb5xk@\x88\x91V\xf2\x17?\xf0\xbb\x1f\xe1\x13\x9f\xf8\x04\xdf\xfb\xbd\xdf\xcbɓ'\x9f\xb6\xfb\xf6\xb4\xe5\x90?\xbf1p\xe4\xc8\x11\x00\xb6\xcb\xeaO\xad\xf7\xae4\x16۬\x97\xd5͞\x88\xb1\xc1\x10\xa62LF\x93f\x05\xce7\xde\x03Rk\xb2,\xa5\x95\xa7\xc4qL\x92Ĵ\xf2\x94<\r\x13\xe3H\a\xf8\x16!\x10q\x84h\x04\xfbw\xbas\xeb\r\x93zLm+<n\xb7\x9f\xb0~'y\a\x19ٲ(\x9b\x10fC\xbd\xd7\xf0\x81w\x16\x85\xc2|\xad\xde\xe1\x82=\x92\xb4\xbb뫫\xab\xdc~\xfb\xedO\xab1\xbe&I}\xe7\xfa\xdc\xe7>\x87\xf7\x9eza\xe9\x1cp\xc4X\xd7\xec\xe8\x85\xd26̅l8N\xc2X\xacq\x17\xce\xfb\xb0\x0e\xa1usrAX\x81K\xe2\xa6\xc2A\x90\xa7\xe9nr\xae\xca\x12\x94Dġ1$\x8e\x11Z\xefn`9\xef\xa8l\x89ua\u007f$x\xa0\xc59\xbb{\x00\xcc\xeen\xe2\xee^J(u\x1b\xc7\x02`0\x99\x04\xdcF\xe9\xfe\xf6\xa0\xcf;\xde\xf1\x8e\xaf\xc9}\xfb+C֑#G8~\xfc\xf8_\xfa9;'\xd1\\\xbc\xb6\xfc\xaew\xbd\x8b\x9f\xfb\xb9\x9f\xe3w?\xf3\x19~\xe8\xd6\xe7ޅ\xf7\xd7T\xc6\xee¤em\xd02\xa666\x94\x97\xbb\x83\xbcF>C\xe9\xddI\xeb\x05\x840,\xf3d:!\xd2\x02c\f'\x8f\x9e䉵m\xaez\xd6\xd5L5\xc2h@\bY\r/\xd7:\x87\xb0\x16'\xc4\xee\xf1\x17\xae\xa9\xba|C\x19\xf5\x17\xadL\xef\xecCz\xbf\xb30\n\x9b\xfd~x\xfd$K\ncx\xf8ᇿ⽸뮻\x98\x9a\x9a\xfa\xda\x19\xe4\xfe\xfb\xef\xe7կ~\xf5\u007f\xdb\xc5v\x93\xa6\xb8P\xeb7\xd7\x13O<\xc1\x8d7ވ\xf3\xfe\x93\xc0\x9b*cH\xa2\xa0\xd5nl\xc8+\xb2\xf9\x1a!\xe5nY*\xa4\xc2\xef\xecz\xf8\x9d\xed\xac\x8a\xb5\x8d-\xb6\xb6\x06\xdc\xeb\x1ecq~\x9aV\x9a\xb0\xb2\xb6\xc9p4\xe1\xae\xdf\xff#\x9e\xfb\xdc\xe7p\xfdM\xcfbv\xa6\x17\xe6U\xcd·5&\x00h\xceA\xa3ɻ#L\x13\xc2ԅ\x9f;\xe4\v\u007f!\\y\x98T5\x93\xa2h&\xc4z\xf3ů\xff\x1en\xfd\xfb\u007f\x9f\x0f~\xf0\x83\u007f\xe1~|\xc7w|\xc7\xdfmR\xdf\xe9\x8e/\x9e\x8c\xee\\gϞ\xe5\xc6\x1bo\xa4v\xee3)\x14ֺ\xd4\xd8\xc0w\xd2RR\xd4\x06)\x05\x11\x12\xd5̙h\xb0\xf5\x9d\xdd\x0ec-\xcbk\x1b\xac,\xaf?E\xdf\xf0\xec\xb9է\xbc\xd6`4\xe1\x0f\xef\xfa3\xfe\xe4\xff\xb9\x87k\x9eu\x157?\xf79\\~p?vRP\x8cƔe\x15\xf6\xe3uSY\xf9\v\x1e\xe4\x1aL? \x9fa\xdb\xcaz\xb0̓\xb6\xb6\xdd\xc7\x1a\x83P\x11\xc0\xe97\xfd\xc0\x0f\xf0\xc5/~\xf1\xef&d\xfdu\x8dr\xb1\xa7\xec\x10\xa1?\xfe\xf1\x8f\xf3\xcaW\xbe\x12\xb4\xda\xf2\xde\xdf\a\xf2\xd6\xdaڠ\x16*\xfc\xae.\x96\x88\xc3\x19RA\xe3\x97]O)\xab\x8a\xd3\xe7V\xd9\xda\xec\xef6gI\x96\x10\xa7\t\xa6\xaa\x91Jљ\xea0\xb70\x83\xb3\x96\x8d\xf5m\x96O\x9e\xe3K\xf7<\xc8=\x9f\xbf\x8f|~\x96\xdb_|+W,΅}\x8f\x9d0\xd6\xc4֝S\x12\xb8h\x1b\xab\xb6A\x16ʄ\x9d\"*c8u\xe6̮\x17\x03\x03\x80~\xbf\xbfw\r\xb2\xa3\xaf \xfe\xdc\xfb;\uee03\xb7\xbf\xfd\xed\xbc\xe3O\xbf\xe0\xff\x8f[\x9f\xfbI\xadԭ\xa1\x9c\f{\xebZ5͠\xb1\xa0\x02\xc3\xc3y\x18MJ\xd6\xd67\xd9\xdc\xdcF)\xcd\x15\x97]\xca%\a\xe69|\xc5%\xb4;\xad\xa7\x9c\x00\x1a\xf0\xfbз\x98\xaa\xa6X\x1f1\x9e\x8c\xb0xJcY=\xbb\x8c\xae+.YZ@I\x81\x94a\x19\xd5p!w\\`҇\x8fkGXsCp\xf4\xf4Yƣqc4\x01\xb0\xc1\xd7\xf0z\xda\xfa\x90\x1d/\x91\xb0K\x0e\u0601v\xaf\xbb\xee:\x8c\xf7\x1f7\xd6\xfd\xabHKa\xac\xdb\x15\a\x03\x1f\x8cB\x83\x106B1q\x12q\xc5\xe1\x03\xa4R\x05-,\xa5X=\xbb\x86\x993\xb4:yS\x9e:Le\xd8\xde\xec3\x1e\x8e\xb1\xb5%oJcc\x1dIdY\xe8uh\xb7\x03_wGI\xc2\xed\x1aA\xec\xbew><$\x0e\x9a\x9dH\xcf\xd6`\xc8\xc9S\xa7/Z\xa1\xf3x\xe8B8\x96uO\x1b\xe4\xe2|\xb2#\xa5\xe1\xbc\xe7\xbe\xfb\xee\xe3\xba\xeb\xaecT\xd7\xf7j!\x1e\xac\f\xd7KA#\x82\xecQ2\x84\x12)\x14\xb5\xb5DJ\xd1k\xb7\xd0J2)\v&e\x89\xd8\xf4\xc4qD\x14GL\x86c\xb2v\x1e\x14M\x05\x94\x93\x92\xaa\b\x18K]ל=\xbf\x82uA\x1e*\x8a\x82\x92P\xb7lq\xf9\x81\xfdDJ7\xcb8!\xa1\awVxAh\x18=T6p\x89\u05f6\xfa<\xf8ؑ\xa7h\xc7;S!\xbc\u007f\xc1+_\xf4u\xdc\xf9\x89Or\xfd\xf5\xd7\xefB\xd7{\xb6\x0f\xb1;5|s휜\xf9\x9e{\xef7\xd6\xfb\xb7\x97\xb5\xf5\xb5u\xd4\xc6\x00\xe1\x84\x03\xe3\x82\x0eo\xdd\xf4\a\xc6\x18LUR\x8eǜ__\xe5\xf8\xf9\xf3\x9c^]cu}\x93ՕuΞ<\xc7\xe9cg8\xfe\xc4)N\x9d:Ǳ\x93gx\xe4\xc9c<r\xf4(\xe7\xd6V\x18\x17c\x8c\xb5\xc4qD\xbb\xddb\xba\xdbmd\x9a\x02\xde\xe1\x9c\xc5\x19{\xd1!\x946\x18\xc2y\n\aGϯ\xf0\xc5/?L]UH RA~Px\x8f/'/\xd8?\x1c\\\xd3j\xb5x\xc3\x1b\xde\xf0\xb4{\xc8\xff'\x83\b\xf1W\xe7\x94\x1d\xa3\xfc\xf6o\xff6\xf7\xde{o\x88\xf5\u07bd\xdf{>^6\xc7@\xd4\r\v\xd17\xe3lo-E\x19\xb6c\x95RDJ\x91\xc51\x1e\xcbf\u007f\x9b\x13\xcb\xe79z\xee\x1cO\x9c>ͣ'N\xf0\xf8\xa9S\x1c;{\x96\x95\xad\r*[\x93\xe79\vs\xb3,-.r\xc9\xfe%.ݿā\xf99\xa6:\x01\xd5\xf3\xc2\xef\n`:\x17\xe6W\xa66T\x8d\x8c\xe0\xa8v<t\xf24\x0f?\xfeD\xd0݂]M\xaf4\x8a\x88\xa4D\xd4ED5~#\xc0\xb7~뷲\xb4\xb4\xf4wo\x90\xbf\xe9\x91#7\xdf|3\x9f\xfaԧ\xf8\xe5\xcf\xdfkW\x86\x83_\x9a\xd4u\x10\xc1ov.D\x83\x83xB\t\xea|\xd0oL\x92\x84<\xcfi\xb7Z\xe4Y8\xd7#|\xceο\xc7\xf4\xba\x1d\x16\xe7\xe7ؿ\xb8\xc0\xc2\xdc\f\xfb\x16\x17\xb8d\xdf\x02\xfb\x16\xe7X\x98\x9b\xa1\xddm\x05ag\xc5.4\xecl@.\xeb\xda\x04\xaf\xf4\x9eqm\xf9\xe3G\x8f\xf0\xf8\xd1c\xa8f\x8b*֊\xd9N\x9b\xd9N\x9b\xe9v\x8bn+\v%~U\xfc\x937\\y\xe9%\xfb\xf7\xef\xe7\x9d\xef|\xe7\xde\xcd!\u007f\xd9\xf5\xc9O~\x92\x97\xbf\xfc\xe5<\xb1|\xee\x89n\x96\xbbk\x97\xf6KA\x1dD*\x9b_\xde67\"\xac:\xfb\xb0\x06\x9d\t\x12R\xda\xed `\xb33\x14l\xb52\xb2,%\x8e\xa2]5\xbav+'\xcfS\xd24%Mb\x92,C8\x87\xabK\xac\xf1\bO\x18\xd3\xd8\xe0\x9dƇ\x9d\xda\xc2X>\xf9࣬,/Ӎ\"\xb4\b\n۽V\xde\x1cZ٬U4\x1fo\x8fƽ\xdaڷ\xbd\xf1\xda˿\xeb\x1d\x0f<\xe6\u007f\xe4G~\x84\x9f\xfdٟ\xfd\x8b\xb3=\xf17\u007fx\xffV\f\xd2\xe9tx\xe9K_\n\xc0\x81v\xab\xb3:\x99\x88GW\x96y\xe6\xd2>DQ\xd1Jc<\x90\xa8F9\xceӜ\x8c\x13j\xff\x1d\x81L\xd5\b\xa0M\xcf\xf4hwr\x928F\xaa\x9d\xd3\xdadX\f\x8a#t\x14\x05<D\x06\xb59\x81C\xb9\xc0\xa92\xb5\xa1\xae\x1a\xa4RH\x8c\xb3\xfc\xfe\x03\x0fs\xfa\xecY\xe6Ӕ4\xd2\xccu\xdaA<\xa0y\xed\xb8Y<\xaa\xea\x9a^\x9e\xa1\x95d0\x9e|\xa7q\xee\xe3Q\x14\xfd\xf6\x8f\xfe\xe8\x8f\xf2\xe4\x93O\xf2\xa1\x0f}\xe8)a\x1b\u007fQx\xf7\xe0\xf7\x8aA\xde\xf4\xa67\xf1M\xdf\xf4M\x00T\xd6\x1e\x1cT\xb58=XA\b\xb8va\tDM\x1ay\x9c\x93\xa4:(\x9a\xeeH\xb9\xee\xdc\xdc8R\xc4I\xcc\xe2\xbe9\xb2F\x80 tޚ(\x8eH\x92\x94(\x8eQ\x8d\xb2vPu0a܁\xc0z\x98\x8cK겦\xaa\r\x1eI\xe5\f\x1f\xfa\xfc}\x9c9\u007f\x9e\xa5,#\x8fcf\xda9q\x14$\x99\xday\xcet\xb7\x83\x92\x92IYR\xd65\xc3\xf1\x84<\x89\x11 'U\xf5\xab?r\xe3\xd5O\xfc\xcb\xff\xfa\xa7\x9f\u007f\xef{\xdf\xcb\xf4\xf44\xefy\xcf{\xbebx\x17\"\xb0'\xdd_\xe12\u007f+\x069|\xf8\xf0\x85\x11GU_?j0\xee\xc7VV(\x8d\xe1YK\xfb\x9b<\xa2\xa8\x8c\xa3\x15\x05]F|h\x16\x83<\xa0bi\xdf\x1c\xbd\xa9nP\x80\xcbs\xe28FE\x11R\x8aFA\xaea\x97촪\x8d(\xa61\x96ɤ\nƨ\f\xd6\v\xd6\ac\xde\xffg\xf70\xdc\xdeb_\x96\xd1Jb\xa6\x9a\xa7?O\x12f\xba\x1d\xdaYF\x96&ࡕ\xa6\x14UM\x16'l\f\x06\x818'D\xa72\xe6C?\xf3\xd2\x17\xfc\xc3g~\xc7\xeb\xbf\xf4K\xbf\xf4K\xdcp\xc3\r\xfc\xb3\u007f\xf6Ͼb\xde\xf5\u007f\r\x1f\x91\u007f\x9b\x06\xb9\xfd\x86\xeb\x19\x1b\xf3\xf5\xc6{\xa4\x80X\n\xcelm\xf2\xb9\xe3G9\xbb\xddgR\x1b\x8aڰ9\xa91\x0e\x9c\x90\xd4^\xe2!xA\x9a\xa0\xb5\xa2\xdd\xeb\x92w;DY\x8a\x8c\x1a\xb9%\xf9\xe7t\xb6\x9a0a\xaa\x9aj\\P\x965E\xed\xa8\x1c|\xf9\xf49~\xf5S\u007fȸ\xbf\xcdb\x96\xd1N\x13f\xda-\x928\xa2\x93\xe7,LO\xd1\xcds\xb2F\t\x15\xc1\xaeX\xe6T\xa7\xcd\xe2\xcc4\xed<\x10\xf7\x92(\xbaD \xfe\xe8\xcb\xef\xff\xf5oz۫\xbf\x95\xef\xfb\xbe\xef\xe3\xbe\xfb\xee\xe3\xcdo~\xf3\xde\xc2C.\xbevJ\xc3\xf5\xd3\xc7;\xa3\xba~\x9e\x92\xd0Ғv\xa4ț\x06\xf0\xee\x13\xc7xly\x85\xca:<\x92\x89\xf1XDC6\b\x94\x9b\x9d\x84\xec\x1a6\xa2\xbfh\x0e՜.|a.e\x02\xf6^\rF\f\x06\x05\xa3\xd2џ\xd4\xfc\xde\x17\xef烟\xbb\x9b\x9e\x94,d\x19Sy\xc6\\\xa7M\x96Ĵ\xb3\x94\xa9vN\xb7\xd5\x1cZ\xd6\x18\xf8b~\x96\x92\x92n\x9espa\x81\xd9n\x8f$\x8e\x88\xb4\xea\t\xf8\x9d\xedc\x8f\xff\xf8?\xbd\xf6\xf2\xf6s\x9e\xf3\x1c~\xf9\x97\u007f\x99\x87\x1f~\x98\x1f\xfd\xd1\x1f\xfd\x9b\xb5\x14\u007fձy\x1f\xf8\xc0\a\xfe\xd2\xf1\xfb_\xe7:~\xfc8\x87\x0e\x1d⦙\xf6\xcbGu\xfd\t-\x04\x99n\u03a2j\\yl\x1c\xb5\xf7,\xb6\xbb<si\x1f\v\x9d\x0eY\x1c\x91ŊXK\xb2X\xb3\u007f\xdf\x1cSSm\x92$\xa1\xd3\xeb\x12\xa7Is\x86\xba\u007f\x8a,\xac\xab\r\x93\xfe\x90\xd1`̨\xa8\xc1\xc3\xea\xf6\x80߹\xfb\v\f\xb6\xb7\x98K\x12\xf2(b\xba\x95\xd1\xcd2\xd2$&mvS\xe6z]\xf4E\xc8#O\x19\xb1\\4\xbbk\xb2\xf6z\u007f\xc0\xca\xe6\x16\x9b\x83!\xa3\xb2\xa22\xf6Xm\xed\xbf\x11B\xbc\xef\xddGN\x8c\x9ds\x9c:u\x8a\xbbﾛ/}\xe9K\xbc\xedmo\xfb\xbb\xcd!\xafx\xc5+8x\xf0 ?\xff\xf6\xb7QY\xf7*\x80H\nT#\x06&E\x18ugJ\"\x9dcu\xd8\xe7s\xc7\xc6<g\xffA\x0e\xcf\xcd\xeej\xfc\x1a\xe7\xd9\xdc\x1c \xa5$\xcf,Ť\xc0\v\x85'h4\xeeL\x9bMYQW\x81X罣4\x86\aO\x9c\xe6\xd3_\xba\x9fi\xadٗe$Z3\xdb\xcei\xa5)y\x962\xd5\xed\xd0m\xb7\x02uԺ]\xaf\xb8x\x86%.*a\xc5E\xff\x9b\xebv\x88\x94\n\x0f\x04\x80//\xc3\xfb\xff\xe8\xbc\xff\x97o\xbc\xea\xd0\xdb\x10\xbc\xef?<vbp\xe8\xd0!^\xfd\xeaW\xf3c?\xf6c\xac\xad\xadQ\x96%+++\x9c?\u007f\x9e\x8d\x8d\r\xee\xbf\xff~VVV\xbe\xf6\x1e\xf2\x9b\xbf\xf9\x9b\xbc\xf6\xb5\xaf\xe5ƙN21\xf5\xe3\x12\u007f0\xd7\n\xbd\xa3D\xbd3rq\x0e\xe3=u\xf3\xde{\x98iu\xb9zq\x89C\xb3\xd3L\xb72ZiL\x12\aU\xec$\x8e\xc2ZtS\x9a\xaaf|\xbfӧ\x94u\xcd\xdap\xc8]\x0f=\xc2ɳ\xe7X\xcas:QD\xaa5S\xad\x8cn\x9e\xd1n\xe5Lu;\xa4i\xb2+\xaal\xaa\x9a\xf8\"m\xae\xbf\xac)\xf6\x17ճ\xc3I\xc1\xb1s\xe7\x99T\x15\xa3\xa2\xa2\xa8띾i\xcd8\xf7{\x1bE\xf5\xfb\xdbU\xfd\x99daq\xf9\xe3\x0f~e\xb4\xd1Z\xfb\xb5\xf5\x90\x9bn\xba\x89\xdbn\xbb\xad)w\xcdK\x9cs\a\x95j\x84\xfai\x96h\x9a\x19\xaa\x92\x12\xd9TU\xc69j\xe7\xd9\x1c\x0f\xf8\xdc\xd1>_:\x95p`j\x86n\x9a\x00\x9e\xda\x05\xc9VOP?\xcd\x1a\x81\xb4,\x8e\x89\xb4f{<\xe1\xeczx\n\xbbQġv\x9bT+\xf28\xa6\x9b\xa5t[\x19\xb3\xbd.3ӽ\x06w\xbf(O\b\x11\xaa\xa9$\xfe\x1b\xfd\xae\xad,e\xff\xfc\x1c\xe7\xd6\xd6QR\x92\xbb\x98QQ1)\xab9-\xc5\xebg\x92\xf8\xf5\x9dH\x95~\xb0u\xe4u\x87\xf7\x1f\x11\xb0\n\x9c\x93B\x9c\xf6pzP\x9b\xe3wo\xf4\xcf~M\f\"\xa5\xe4{\xbf\xf7{\xf9\xc1\x1f\xfcA\x16\x16\x16x\xf1\xd5\xcf\xc08\xff\x06ь\xe7ES\xb5\x84?\xb9\xa6\xebءm\n\x84\x04)<\xba\x19T\x1a[qb}y7\xc4I!\x88\x1aY\xf1\x8bq|%@5\xe2\x01\x02XL\x12沔DGM>\x8a\xe8\xe6)s\xbd\xce\xee\t\v·F\xf4⧾\xa8+\xbc\xf7\x8d\xf2\xb5\xb80xo\f\xe6\\\xf8\xb9\xac\x0fD\x8dT+\xac5\xe4\xb1Dk\xc9ְ\xc2XG\xac\x14I+\xa3\xac\r\x93\xaaB\xfc\xbf\xad]\xcbn\\E\x10=Տ\xfb\x98\x99dl\x87\xd88&Jp$\x12!\x12)ī,\x90\x10x\x01\x06e\xcb\x02\x94\b\x81l\x96\xfe\x00X{Î\x1d\xbf\xc2߰J\xa48N\x9c\xcc}\xf4\xed.\x16\xd5\xdd3c\x12B\x94X\xba\xb24\xd2\xcchnݮǩ\xaas<\xca\x01\xfe&3n&\xaf\x97\xa8rW\n\xcb_l\xac\xb9wj\x90\x9d\x9d\x1d\x1c\x1e\x1e\xe2\xeeݻK\xb5\xc7j\xf7b\xe7X\xabo\\\x98\xb7J\x15\xa2\xdf'\xbd\x90\xa7\v4\xa2HC'܉\xe5\xd5\xd4\xd8Jо!\x9d\r\xc9\x1c\xa0\tX1\x84J\x11lܦ*l\x81IU\xa10Ru\xaf\x9c\x9b\xe0ʥ\r\xac\xadNa\xac\x85\xebztΥ\xb1\b\x00\x9c\x8b\xcd\xd3\xd9\fMס\xb06N\xbbȾH;xA\xaa\xa3\xef\xaa\n\x83\xa6\xf5xtr\x8a\xbf\x1f=A\xdb\xf6QoQ\xb6\xae\nkPZ\x83IU.m\x01\xe4m\x80\xd8`\v\xccd\x88\x8a\xb76\b\x11\xe1\xe0\xe0\x00\xf7\xef\xdf\xc7\xed۷Q\x14\xf3\xa3\xfe\xcb'\xd7*K\xb4\xd7{\xff\xfb\xd8Z\xf34\xd2\xeb!\t\x06\x03\x02\x93\x90PfhR\xf0qn\x8ac@\xe0\xf4\xf8*\xa1L\"&ٚ\x8aA\xb7T\xc09\xabP(\xe1\xb5R$n\xcch\x8dsu\x15\xf1'\xc2\xda\xca\x14\xdb[\x1bX_\xbf\x98\x95\x11\x00\xa0s\xee\xcc\xef\x99\xc34n\x10\xf01\xb5we%\x81\xf3S\xedC\xc0\xf1\xe3\x17xx|\x82g\xb3v\t\r\x9f\x8ekTVx\xb7\xb4\x8a\x92\x1cQ\xe5\x94\xcep\xdf2\xb3\xb8鷉!\xb7n\xdd\xc2\xfe\xfe>vww\x97\xd4\x01\x8e~\xfb\x15\u007f\xfd\xf9Ǻ\"\xfa\xa92\xfa\xe7B\xeb\xab\x0f_\x9c\xc2C\xe0t\xb0_\x1a\xac\xe3%\x87@\xd0\x14\xf9ߡ\xb2/\n\x14@,$\x94\x89\xa2\\\x810.\bc\xb3\x9c*\x12I\xffbRK\xa0V\x04\xbc\xb7\xb6\x8a\x0f\xe3\xc9(\xcbra'~1H\xf3Rk\xc1\x18#\xccܹ\xbd\x1b\xf2\xac/3\xd0\x0f\x0e\xa7\xb3V\xae\xa6\xcd\xcdk\xa3\x15&u\x81鸎\xc9\x06\xcd9\xee\xcf\u008f4\xbf\x0f\x16\x1a5\xec\x9b\x1bdoo\x0f\x0f\x1e<\xc0\xee\xee.\xa6\xd3i~\xfd\xf3\xaduj\xba\xd9u\x05\x1c\x00\xf8\xdes\xb8p\xdcp\xf6\xe7\xc4\x1e\x80\x12\xb2\xca\xc8l\xbd89\xc4\b\xd9}\xd1Rw\x1eP\xacr\x8c\xa8\fAA4\xa3L\xbc\x16I\x97\x931\x8ax\n.^\x10c\xd4U\x89\xd1X\u218f<\xc1\xa4\beU\xa0i\xfa|\xa3\xe5\xfe\x13\xb41\x00Dsj\b\x1e\x83\x975h\x0e\x92\xc1ͺ\x1e\xcff\r\x9e>\x9f\xa1\x8e\x12|\xa55\x99\xb2\xdc\xea$4\x10\x85\b^\xd7D\x8a\xa7\xee\xb5\x06\xb1\xd6\xe2\xe8\xe8\bw\xee\xdc\xc1\x8d\x1b7\xb0\xb9\xb9\x99\xd5Ծ\xbcz\x89f\xcfO\xb7<\x87{\xcc\xfc\x03\x83?\x1d\x98\xed\xe2S\x90\xf5:\bP\xcch\x83\xb8\x15MAV\x9a1\x0f\x9a\x9c\xe6p_\xa2\x14\x02\x00\x95&\x98\x85\x1f\x96\xaa\xf84gm\x94¸\x12c(\"\xac\xae\x9c\xc7\xf6\xd6\x06\xaa(\x1c\xa6\xb5\x86\xb1\x16C\x1c\xff\x99'\x16q(.\xc68\xa5\b:R\xa1;\xe7\"u\xad\xcaZ\xec\x9d\x1b\xd0;\a\xa3\bﯞ\x97D#\x0ePh\xad\x04\u007f;#&\xf0\xce*u\xef}6\x00\x00|{}\x1b\xdd\xe9\xc9\xc7\xcd0|\xe7\xbc\xff*p\xb8Ɍr\x198Kt\xe1\xf4/@\xcd3\xd0\xf8\x80\xb1ѰJC\x93\xce>\xd9j\x03b\xbd<\xbd\xb2\xf0\xf6\x89U\xd0I\x06$\xfe/\x95\\J\x11\xc6U\x19\x91X\xc2d2\xc2GW>\x90\xecj4\x821\x1a\xa3\xd1\b\xc6Ztm\v?Hײi\x05\xe7\x12\xb7$\xe4\x9c\x1c\x03\xee\xe3''\xe8{\x91\x93\r`\xcc\xda^\xd2\xeea@?\f2ԡ(\x17\xaf)^\xbdI\xfc]\x8c#\xff\xabRO\xc6\xf8\xfa\xf2Ţq\xee^\xef\xfd\x81\x0f\xe13\xcf\xc1\x9c5&\x91\x82!\x9b\x05\xbd\x84\x14@\xc1\xf3\x02\xfdG\xe806\n.\x84l4\x1d3&\xa9\x88\x19\xcc\xf4\x8a!\x8a\x98\xf6.B\r1\x85\xae\n\x8b\xba\x904\xb5\xae*\\\xbb|\t\x955\xa8\xc7#\x10\x802\xee)\x0e\xce-$\x9c,md\xe2\xb8A='\xfd\xe7\xb8\x15\xac\xa2\x81\xba\xdeA+B\xd3\xf7\xc2z\x1a\x03\xb4\x8a1B\xab\xff\x86\x05u\x14\xb2I''\xa9B$c\xa4\xac\xeb\xb5\x06\xb9wec4s\xee\xc7n\xf0\x87\x9d\x1f\xb6Ӈh\xd29\x02*R\xd0d`\x95}\x05>3\xff\x1a\xab,\xfa\xd0\x01p2\x89\x1e\xdf/+\x03\x04\xa5\t!,cG\xe9o`\x86I5\v\x80Z\x8bA\n\xa31.K\xa9O\x8c\xc5\xf6\xe5M\xd4֠\xaa+x\xe7PG\xb6\x9e,~I\xe9\U00107e02F\x00B~\xbaC\b\xe8\xfa>\x16\x8d,\x04\b \xf4.r\xc4\xc7\x05\xa3D\xe9\xf4\xb2\xf8@Ds\x9a\xc0\xa2\x8056ϓ)%jC\xd9]3\xa3\xebZ\xb4m\x8b\u007f\x00\x02JB$\xc0$\xf4\xe6\x00\x00\x00\x00IEND\xaeB`\x82")
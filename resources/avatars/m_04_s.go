@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_04_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00.\x9czTXtRaw profile type exif\x00\x00xڭ\x9cg\xb2d7\x8e\x85\xffs\x15\xbd\x04:\xd0,\x876bv0˟\xef0\xb3Jj\xa9\xa4VG\x8cJ\xaa\xf7\x94\xe6\x1a\x128\x06\x04\xaf;\xff\xfb?\xd7\xfd\xeb_\xff\n\xbe\xfa\xe8\xb2\xd5Vz)\x9e\u007fr\xcf=\x0e~i\xfe\xb7\u007f\xce\xf7\xe7\xe7\xb5\xe0\xf3\xfb\xfb\xc7?\xe1\xfbwp\xbf|#\xf23\xf13}^\xac\xf9\xfbn\xfa\xbe\xfe\xe3\xf3\xe5\xe7O\x0e\xf4\x8b7\x82\xfd\xe1\v\xe9\xe7i\xe2\xefO\\\xc7\xf7\xf5ȭ\xfd\xfe\x8d\xbcc\xf7\xbf\xff\xa7\xfd\xf6\u07fd\xbb\xdd{>w7ra\x18\xca\xe7\xa6>\xa7p?\x0e\xc3\a'\x87J\xefk\x85?\x95\xff\x8c\xdf\xeb\xfb\xd3\xf9\xd3\xfc\xf0+d\xbf\xfd\xf2\x93?+\xf4\x10C\xf27\xe4\xb0]\x18\xe1\x86\x136?WX\\c\x8e'V~Ƹbz\xaf\xb5Tc\x8f+\xf9\x14R֟pcM=\xed\xd4RL+\x9e\x94Rv)\xfe\xbc\x96\xf0\xce\xdb\xdf\xf9Vh\x9cy\a>\x1a\x03\a\v|\xe5o\xff\xb8\xff\xf4\x81\u007f\xf2\xe7\xde\xe5\x19\xa3\x10\xb8\xfb\xf0\x1d+\xae+F\xcdC\xd00&\xfd\xcdǘ\x90p\xbf\xf3fo\x80\u007f\xfc\xf9\xf9\x8f\xfb\xdd\xc4&f\xd0\xde07np\xf8\xf99Ĵ\xf0[l\xa5\x17\x00\x89\xcf\x19??\xf1\x15\xea֬\xc5\x17%\x99s\x1b\x17\x13\x12S\xe0KH\x16\n\xa1\x1fc\r!\xa7ؘ\xa0\xc1\x95ǔ\xe3d\x06\x82Y\xdc\\d\xcc)\x15\xe6\xa6\x11G\x9c\x9b\xef\xd4\xf0>\x1b-~^'U\x98\x1fK%U榧\xc1d\xe5l\xc4O͍\x18\x1a\x96,\x9bY\xb1jͺ\rWR\xc9\xc5J)\xb5(\xe7FM5W\xab\xa5\xd6\xdaj\xaf\xa3\xa5\x96\x9b\xb5\xd2jk\xad\xb7\xd1cO\xa4\xa4\xf5\xd2ko\xbd\xf718\xe7\xc8n\xd8\xe0ۃO\x8c1\xe3L3O\x9be\xd6\xd9f\x9fc\x11>+/[e\xd5\xd5V_cǝv\u07b6ˮ\xbb\xed\xbe\xc7\t\x87Pr'\x1f;\xe5\xd4\xd3N?\xe3\x12k7\xdd|\xed\x96[o\xbb\xfd\x8e\x9f\xb3\xf6\x9d\xd5?\xfd\xf9/f-|g-\xbe\x99\xd2\xe7\xea\xcfY\xe3\xd5Zu\xa0w\x88 \x9c1\xcd\x193\x16s`ƫf\x80\x80\x8e\x9a3\xdfB\xceQ3\xa79\xf3=\x92\x15\x16\xb9H\xd3\xdc\xec\xe0\x87\v\x85)\xcc'D\xbb\xe1\xe7\xdc\xfd6s\xffx\xde\x1cc\xfd\x9f\xe6-\xfe\x93\x99s\x9a\xba\xff\x87\x99\x8b\xee\xa4?\xcc\xdb/fm\v\tכ\xb1O\x16jL}\"\xfb\xf8̈\x8d\u007f\x19\xec\xef/\x1e\xd4+~nnn\x9ez\xf9\xd0\x1ee\xde\xc3\x10\xdc\xcb\xc5}^Iهs\xe7)\xf1\x8e0\xd2Zv\x89\u007f\x06\x1d`\xe3\xad[\x0f\xf7:\x0f\xa3p\x93Rp\xd7\x05\xe2\x1b\xa8\xbco\xff\xe3\xb7B8-\x1d\u007f\xb6U\xa2\x83\xff\xf3\x96gu-\xec\x16G\xbe5\x06&\xb5\xac\x1e\x05m!7\xe2&\xac;\xac\x8eΠO\x12u\xf8\xd3BI1ۘ{\xc2cs\xccŤ\xf2[-\x8e`:\xc4A\xe5\b\x8c\xb7Rk\r݆\xbf%ݶ\xd6 $\xceY\xf9\xe4\xce8\xeePH\x899J\xe9\xdb\x0e\xa7\xde{3\r9\x05\b2\xae>{\x8c\xb69\x8a\x18\xa9\x06\xc8\"\x8e\xb0G\x9c\xbe\xb6n;\x04\xc2h\xcd\x19Θ\xf5\x9ed\x9b˸\x93o\xeda-\x1e\xe6-8EEk#W\x9f\xdb=\xb7N3\x8e\x14\xfc\xcc=\xe9ʢ^\xbb\xb7\xaeȠ\x03C\x8c~\x1b\xa5[I\\J)W\xc1\x9f\xf6X\xae\xdd2v\xa8\xdc6AQ\xd6>gl\x8bF\x103T%gf>Av\x81\xbb\xbbc\x97\xd6\x13\xc1?\x98\xa7\x14\n\xc3\x17n\xdfqY\xf4\x8e3\x9f\xa4\u007f\x83\xc5L\xd8&\x8eX\a\xe3\xdc\xd2\xda6\xf8:\x83\x19\aCq\xeb\xf8\xcei\xf8N\xe4\xf5\x8c\x8a\xedFș˄p\xe3\x1b\xc7bm'v\xb3\xbe\x18!\xc2\xfd\x90V\\T\x9a\x9e+\x99\x9b\xf0\b\xcc\xdf2\"a\xae6\xc0]c\xea\xe0?ޜ\xc7%\xbd\xb0\xea\xf5'\xe6S\xc3̥B|c\x0f\xae\x90\xbb\xcfm\xed\x94v\xad\x9f(\xca\xdfx\x9b\ar-\x95\t\x9c\x99\x1b\xdc;\xb9B\x12\x9cҘ\xed\xc67:\xf0\xdeε\xbe;\xa3\xb9/\xf9zc?\x9b\b\xa8\x84T\xd4`\xa6}67D8ܓg\x1e\xab\x1d\xeeЁ\xf7\xa4\xc6ndae`H\xc8c\xfd\xd6r\x19.\x86#\x1d\xee\x88\xc1 \xf6\xb8u\x0eiag~\xef \x1bW+\xd8JV\xf7\x9a\x0e\x90\xb3\x90\xf9\xf7\xbf\xf8Y\x97\x02\xe3μ\xeb]\x8a_\xfe\xcf)\xc9y\x89\xbbFl\x90\xecz\xb10\xbf\xb7w\xd3\xefg\x9f\xcfkm\x93z\xed\x1d\x8d)\x0e'\x03R\xe49\xe3\xe7\x87D\x04!\xc7ϾbdT8Xa\x0eb]\x89\xe1\xbfE\xc1]4\x1eUc\x12\x99\x88\xa2\x14\xe0\xeesiķ\xdf[\xe1\x16Za\xfa\xeb\xa8\x04\xf2\xe0*=\xc0E\x18\xf9\x1dˎy\xdeͬ\xd4\t\x00\xf6\xb5t\x9aH\ue49a\xb3\xf9\x9cg\xf7\x9b$'\xd3+\x10\xdb\xcft\xcb*\xb7\x8a\xf0\xe9@,\x83\xbb\x98\x12\xa0i\x15\x9b\xa1\xad㉣\xbaI\xba1\r\xdc\xcdƁ\xf3\x19DP\xd6\xe4\x18\xf0\xb5}\xae\x85Y\xe3\xfaQu\x87{[\xb7'\xa6\xb4\x90\xa2\xa5\x00\x9b\xe0W\x01>\xf9s\x99<2\xa4\x1c\x06\x8b\t]\xa7s3\v\ty\x97\xc2y\xd6X\x1d \x9fH\xb39'q\xd9.\xe8\u074bO\xb3\x8d\x91j\x18\r\x9d֔\xa7\xab\u07fc\x810\xb4ŶR\xc91\x10ܧ\xf8 g\xdd\xec]%w\xe74\x10w7\x14\x1e\xf3g:\x0eg\x1d\xf0\xd1ah\xc2M\f\xcfe\xf0\x06\xe30O\xdf\xf9\x00\xd8}컙I\xe8iZ\"E\xa0\x8c9II\xb2\x18\xb2\xe0\xcaK\x036\x80-\x00eq\xad\xcc\xc0н\x8d\xb3\xa0I?j\x9f\ah\x9a颁ш\x89\x19h\xb9\xba\xde\xd6\rqV\xb8\x9a\xf3\xef1\x18\xec\xd2;\x13=\xbb҃@\xb90\xee\xdd@\xa3\a%\x02)-|\x02k\xe6\x00z\xeb\x11\xd8L\x98\x96K\xe6\xb4\xe4Ek\x9c)\xeb\x9e\xdb.`(\xd6bs\x87\x91@^®\x94Ng\f\x0f\xb3\xd4C\x17Pj\xd4+ q\x0fxD\x10\xc1\x8eOU\xc5\xd2t\xf33\x9d\x03\x9ds\xed\xe07S\xbcj>\xdcJk)sǰ\xff\xda9\xae\xcc\bM$ھo\xa2\x9b\x9b\x1c\x12\xc6\xe3wX4D\u007fO\x00\xbd\xf7\xda O\x1az\xb1k\x8e\xfb%\xbc\xf3\x84\"O@9C/\x81\xab\xdb1\xa0\x00$\xb4\x91\xc7\x1b\x98J68+\x03\xa4q\x1cyFH\xa2F\xd8{\x8c\x02\xab\x1c\xe6\xbbTD\n\x11\x12\xe2B\x91\xb4\x9a\x16\xea\x05\x96N\a>\x86\xed\x1d\x10\xc5\xf4\x81\x12Le\xb5\x927\xe8u{^ \x8f?\xe7F\xa2\x11\xee'?\xfc\xe8\a\xb1\x12\xb6'\xa53\xa1\xc9\x10A͓\xcf\n\xe2\\$\xaf;\x9f\xee\x03\xbe\x9c\xbd\x802\x99\xec5\xaf\xf1\x054\x12b\v\xbf\xb0v%QkM\x85\xd9\x12,\xc9\xe7%;\x17R$\xc1Gq\xccH\x98\x1c_\xec2\x96ثE\x00\u007fX`\x16IW\xeeb\xe0j.\xf4IT<\xc0)(\x98\x01\x97\xb5\x17\xd51\xeecw\x93k\xe8\xa6\n&!\x8ej9\x87\xfcH\xed\xea\xac\x13\r\x15\xb8;\xd4T\x029\xee\xe4(`Y\x1b箉hYk\xf1˜I\x93\x94\xdc\xd04\xd5'G~Ȓ\xbf\xfa\xf9\xb7\xb0\xe7\xb6\xf4\xbf\xdd\xf4y9\xbd\xb7\x93bw\xcf\xfdy\r\xf1\u0bceC\xccxT\x1c\xae\xf7z\x92\x12l\x82Ę(;\xe0\x9b#&\xb8kc6\a\xc1\x0f\xe1C\xb1S\x135\xa0Qb}\x1d\x82H\xb2\xf3\x82\xac\xeb\x1eC4UR\x8c\xdb\xed\xeb\x99#\xb0I\xd3\u007f&&\x0f\xf5 \xd4\x19\xb3)\xacP2a\x91}0/\xb10\xc9\xe5\x06qEH\xbb\x83d\xc1\x06\xb2\xee\xb4YS\x1a\x1d\bBV\x16I?\xf4\n\x87\xde\xc4<_\\^\x83=QA\x8dX!uȇ\uee42k\x108a\x89\xfcM\xc4\x18i\x81\x96M\x03\xaa$f\xd1&M\xfaH3\x86*\x84w\xbb-\x04*\xd7|\x04\x92\x10>\x9a\xb2\xf0.LF\x00ݰS\u007f\xdai\xac\x93\x10\xbd\\\x1b/0m\x90\xbd\x839\xd0\x0e\x90\xca\x06\xcd\xc8\\\x8e\x80¹c\xa0b\x99\x13h\xeb\xc5\x16:\x9d\x91>\xf3\xa2\t\x1a\xe2f\u07fc\x1aw\x0e\xd0 \x95\xb9Q\xd7@\xae\xd1t\xa5PG\x03\x8cz-\xb95+\x00Ȍ\xe8\x0e\x88\xa8I\xc1\x0fT\xe5\x82Y\xf8S:c.\"\xf1\x99_\xa1\x01\xb2ۙ\xb8\xba\x1ctC#\xd1ɼ\x14\xd7Y\xbc\x02\xd1\x13\xf2\x80\x02\x02\x8aoUR\xdd_\xe9\x1b\xd9?2y\x96\xd86\x14\xd9DU\xb78\xf4\x9e\x90\x12\xdc\x01\x85\xe6\xea\xd2N\xcc\x17\x14\xbf*\xbe\xfd\xae\b\x03@\x00\x8b8A\xe9\x04n\x9fa_\xc86_\x1e\x10\x02\x95\b~s\x1c\x14\x862\x06TÈ\n{\xb19\x04\x97h\\/xEʿ\x03\xde\x00\xb0\x18\xffG\x80A\xf3\xa5\x10\x9a\xa2:\xa8ɢ\v\xd6\x03\xa2\xedD\xcc,\x06\x16\xb3\xdf\x1a\xf3\xe5S%v.j\x81#`ilN\x19\x11^{\x85\x02\x9c\x01\x99\x05\ue985\x88c$\x81\xda6\xaea\xd7aXȘ/\x9e\x04F\x8cS\xb3Tڍ\xe83\x04\x1fp\xd2\v\xe1\xdeD\x02\xa8\xd7\t\xd9\x1a.\x8a\x14\xb9\x9cx[s7\xa4\xc9\xd93\xa0\xd2t\x83L!\x10\x8a\xb9\\\v<A`\xc1E\xcc\xf9%@\xa6\xefkv\xdc\xf8\x02\x16I\x05\x83\xb4!\xc0\x8a\xf3Η\xc1>}\xa1\xecp\n\xdd\xc2*u\x81\x12\t\x01\vbs\xcb|'\xdb\xf6\xfcğ\x93H`\xdfI\x1e\r\x89\x19\x02d\xa6)}\f\xf0\x94\x83$!\x87\xe2\xa6s!\u0588\v\xa6\x84\x11\x826\xe1\xa8I:\f\xf0\x1dt\xc3g\x11>\xe8i\x025T\xcfج\x8b\x19Dkê\xb8#\xf4\x01\xb4\x8bBh\xf6\\\x11\xd7NB\xd7\x17\x8a#0\x0e\x930E\x8f\xa0\x8f.\x12)\xd45ה\xe6CW\x12\xa1\a\xd6\xe0\x9c\xae\x92̀\a\x00n\xb0\xca&\xe2\xce\txJ\xac\xcc&R\xb8t\xa2or\xed[\xe4\xd8@\x94\u0098a\b9F\"\x13\x0f\xf1[9\xa7k\x8aڎV-q\xa2\x93\xba)l[XPKe\x98\xc6m\xd5P\xb9\x88\x97\x90\x16\xf1\x86w@b!k\t\xf8\xee\xe3\x95\xdc\xf2\xb0\xb4\xeb\xe6\xf92\xa9\x1cd\xcc\xc8j\xe8\x96{A\xa9\xac\xb0\xe1[\x86\x1a6D\b\xc5'@#\x16\xe6#e\xfc\xfb\xff\x9c\b\xba\x8aDv\xf7\xd9\xfb6ъ\xbcCT\xa3\x89Qg\aF\xf9\a(\x1e\xcam(p\xa4\x8fc\xd4Z\xc3\xd6M\f\x93?\xf9\t_\x86\x11^\x8e\xfd\xeaF\v\x13\x06*\x98\x00\xfd\xd4t\xe1\xa7\xccm\xde&\x9f\x9e\x01Ĵ\xc5?\x8e\x18nin\xb4\x85\xac\x8d\xc8\x17:\xc5\xe1!\x1a1\x05\x05\xb0\xec\x00\xcf\xf4\x11\x14\x8b\xe4\x1e9\x1d\xe6\xeeY\xe5\x000\x03}\x8c[Jg;S\x8c`ǚy\xe0\x03\xfdu\x00\x97!\x8b\x04\xd9s\x90Ix\xfb*w\xbb\x19*N{\x98\xb6L\xceaa\xf0<\nU\x85\xa59\xc4\x01\xa0(\xae0&\xc0H\x89\x1e\x17,M\x124C_\x81\xe4x\f#ϓy\xe0\ni\x15\xf9\xc5C\xcc(\xc0\x83& \xbe\x89\f7D\x17\x88\x05\xe5\xf05\x86\xa2o4;)\xd9<\xa4\xdcK\x97\xf3($5\xb9\x1d\xc1c\xa42@\xc8`\xef\xc3\x1d\xeb\xa6K\x0f\xa5\x01#\xef\xfeq\xb2\x1dYL\xec⳥|\x90\xda\x06g\x85\xce H\xfe\xa1T|\n\xb0C\x8ebk\xe2\xadsh\x00\xf8\xc01\x80\xefp\xdc\xd7\xf0\x98\xe8@.\x03J\xf7rf\xe6we\x81\x04逤M\x01yL\xa0\x19Ћ\x940\x80\x19[I\x80\x10ۧ@\x00\xfa\xb2\xc3ɂ<H^h\x8aH\xb0\fR\x93\x11aW\x945\x9a\x030\xf5m\x95\xb1P\x88\xbdC=X\xf0\"\x9b\x8cd\x13\x827Y\xbbS\x92[\x13%\x89\x94Y\xa41s\r\x01\xc1F\x95\x9b#\xd6\b\t8\xeaB\x8c\xe0\x0e\xc9\xd9%n\xc1iD3(;K\xe6\xda\x19\xdd%\x93\xec\x8eN\xacD@\x99Ç\x15C\xc4(\x02Բ\xfb*\xd20\xf2W\xb3ET\x05\x0e\xba\x9f\xfa\x98q\xcc\x1b\xc0\f\xb9\xad\x98!n\x87\x8aE\xfcH\x1f\x9140!\xca\xd8\xcf\n9\xe2\x9f\x14\x97\xa4\x94\u008a9N\xa4:SSHB\x14\xc2Z\xc8\n\xec#@\x00\x89\xb5\xe0\x84[\x1eTe\xee\x11\xb7\b\x8d\x10Ur\xb9\xd2Y^7\x8a\xba]>\x93\xd2\x1bg?P\xc0d\xf1\x04\x87\v\xa7 \xa9\n\xde+\xa1\x03\x1c\xa3\x15\x01}\xc0\x1f\x9f\xb6=c\x03a\x97T\x10\x1b\x01\xack\x84Ū\xd8h41\xca\x17S7\x16xC\xa2\xcd\xc4\xcd\x00\x86\xe1\xe0\xfe\x036\v(\xaf\b\b\xf0\x14\xe9\xc8\xe0\rKI\x9eq\xc2S\x9c\x04G\xaeTR\x91jB\x9c\xdcf4D\xb6m\xd1&\xcae=T\xb5\xeb\x10\xd7\xdb@\x94 u\xb00!\xf0RQ@\xa5\xe2\xe3\"\xca|A\xe4\x80Ku\x02e\x02\x8eQ#\xbe\x15~\xd9\x1c\xa8\uf151%\x94\\\x05\xe2\x99\x01\xdd\t\xca\xfd\x86\x0e\x9fL\x15)\x10&c\xc3\xee\xe1\xd92p\x8c\xe8VU\n\xd34:\x0e\n\xb4\xb0\x12\x01\x95\x98\x06\x80\xe2\xecz)\x91\xd6Q;\xf7\xac\xcea/\xe1;\xa5\b\xd0\x12\xa1 IJe2\xd1\xca\x1b\xb6 \x1b\xb3\x8a~\xe5\x02\xbf\x18\xa3\x8b\x14\xc0\xe7\x16\xb7\xe3\xedE\xa2K\x9c\xa6<\xa8\xf8\xd9\v\x9aG\b\x98\xc8\xcbã\xd4uǀ;\x9c-\x131\xac{f\xcd\xcbt\x89\x80\xd0\x11ۅ\x8ex\x06R\b\x99\xb3!ěѭ\x1e=0\xb0cGl\x1b\x8ej6\xe5\x15}6\xbe\xf5x\xdc4q\u038bL\x18\x80R`\x96\x04\x8b\x90\x06$BJ@x\xf4-\x87\xa9\xfa&\xb3\x9d\xd1q\x04/\xd1\xdb\xc0\xb9\x94\xff\x1e\xc2\xdd\a\xc3\xd7U\x19Ea\xc2\x1f\xbb\xf2\u007f\x87|\xe2LC\x8c@\x9cA\xc2:9$\x05\x9d-R\x1dݏ\b=\xcaXн\x92\xfd\xa4\x03\x8c\x01\xa3\x13G\xe87\x86\t\xa2l\xdcv;\xa1HdG\xd8\x13\a\xbaB6&-\xc6JP\xa8\xecJ2\x94\xa1B\ta\xdf\x1c`\xd3U\xddI@3\x98%\xc5ʧ\xd6\xe6\xf3[e8Uq\x01<\xad\xbbp\xe9\v\x12M\x80\xecD{\x10\x80(p\xc8\xd4+\xb4\x9dbX\U000b2062\xbc!\xe7Δ\xce։\x9e\xa8\xea\x90\xfe\x9c\x85\xdfϐJB;2\x91\xaaat)\x92\x84M\xc0\xc2ϐ\\\x04\x1f\x80h\xf2\xb1q\x91D'\x0e\x87\xc0\x17\xae\xabf\x8b\xb7\x1e\xf2\xb2\t3FR\x97\x89\x1b@I&\x88z>\x11\xa3\xa1\xe1\xc5\xee\xb0\x1d\xb0\x06\x82}ˍ\xe0\x9a\xa1\x88\x86\x01\\\xa4>\xaeB\xcbI\x17\xa8&\xda\x06ҰB\x85\x068\xe3\x1fQ\xfc\xc6=3T\x89T\xb9\x8e\xe3%IAƍ\xc80\x1c\xfem\xe1\xc3\xf3\xc6\xf5_\xbc5yR$\xc0\xd1\xc4\x00\xfa\x05\x96\x9b\x8e\x8dXM\x00\xd4֔\x1e\xd3r\x06D\x00\xc3\x00\x94\xe8\xf0\x167j\x1b*;V\xd1\xe6\xf8b \x14\xc4F\xa6\xa2%Z\xe2@\xd2gD\x98x5\x17\xb0\xee\x04\x8f\xa5t\xe0M\x16\xa5\xb7v_\xe8a\xd5\x19dr\xcf\f!\x06{\x1f\r\x14\xba\xc7\x1a\x01\xc4la\x891OX?Ơ3\xcc\x00\x06\xf4i\x0e\xd8AU\xe5\x1e;\xf3m\bj\x10\x8f\xdbl(\x00\xb9ar\x98 \xf2\tq\x86\x9fA\xf8%\xe1\u007f@\xe6\x81=\x84\x86*\x9f\vዧ5\xbb\x9aO\xdc\xf3+\xbe\xae\x83\x8a\xechx\x00D\x1a\x1b3\x80\x99P\xb0\x84e `\"~\xb2\xb8\x1d\x82\x16^\x0e\x92\x90\xab(\xee\x04\x95旉\\\xd7P\xc9\aы\xc8\xe9P\xd1\xf4*fqw)\vH\na\x91\x19up\x13e\x8a\xd6y\xc0+\xa1.\xe3\xc7\xfc5\x92E\xde{\xc2ɐ\xce\xc5\xef\xe3?p[\xa8Af\x16\t{\xc5\x05\x86N\xc7\xedGqW\x87\x87\x99?\x93\xa5\xdf*\xd3\x1c\xc7|\xab\xf2\x90F\xd9\x05\xd7D\xcc\xe2;ı\xbeL\xa0\xa2^\xf1ׁ2w\x8d\xdc\x13\xa1\x17\x18\xba\x01y\x01\x93\t\xae\xe8R!\x98\x1al\x19Z\xa3\xa9\xf8\x00S\xad3\x00\x1d\xfc\x85\x16\x18\x0etZ\x9b\x06\xe9t\xc1%:\xba\a]\x80D\xb1\a~\a\x03\x86\xf63\\1\x01\x990\xb3\xa2A.Ia\xf8\xf2\b\"\xc5b\xa1\xff\xa6D\"\xac\xa15P.\xfc\xfb\t\x95\x96%4\x8d\xb7g\xf9\xe2\x11\xf1\xfa\xf0\ts\xff\xa7\x9f\x13\x05}\xc9\x17\xd4\x06\x89\x88.\xc4\xc3\x14\x94'\xd0\xc8\x1d`\xb1\xf7Z\x8b\xd3z\xc2\xdbq\xe7Lj\x96\xa4C\x05\x10\xf97\x83\xa48\an\xa5\xc0\xf1\x88N98-\xb9\x88\xf3\xb3R\f\x17 \n\xbe\xa4\nd\x12\x05\xda\xd9!%7$\xb5\x9eM\x01'H\xf5\xd9*\xc3T\xfcQ\xee\f\x15.\xb1\x11D\xe8y\xc5z\fo\xbc*\xbaCW\xaa7x\xd5dHZ\x84B\x1c\x90\x19?\x98*-\x1d\x10%\xe8*\x15\xf5P)\xf0\xf9B\xe8\xd4.+\x80\xe8\xe9\x80\x00&\x94\x83\x00:\xaa\xc7\x00\xf32\xa8\xc3\xc9B\xf1\x91\xa8\xf2&w\x02𠀰{|i \x88P;\xb8\x93\x89\xb6 \xa6W\xf0\xd2|HQ\xbe[$(\x93*tY\xd2\xc3\xd9\x00\"q1P9\x8a\x99ЉzAEK\x98D\xb9t0\x17\xa4'yj\x9fP\xde\xfb\xd8i\x92~\xf2\xba\x1a}4!\x04\xb9\xa2\x847\xb68{\xe0\"\x1bA\rt/\xb0\xac\u007f\x06\xf8\x1a.ͦ5C\xa8ÊkA\xac\x1e\x15.}/ueD\x1f\xa6\x06\x11\x0e\xfe#\x9d\xcemB$\xfc\"\xc1\nLɯu\xad\xf9u\xd9gU\x1e\x88\x024\x02#7\xb4`\xc6\xf0 \xf4\x9f\x83M{\xbb2\xbdIs\x89kU\x06c\x8c\x81>ң#x\x10\xfc^\xd5\xf2-\xd6\xee\x95\xf1\xb8@\x88q\xb0\x1eG\U0004de2d\xcc9\xa01\x1d~nI-h\x19\x04\xfb\xab\x18'VPfiK5\xe6\x16\x95\xea\x96jU\xb5b\xab\x16\xa4B\xe1nZ\x8a#\xa66tz\xd7R\x97\x06c\xc9ǀ\xa0+}C\x04\x1avHNT\xc5f\xc68\xf8.\x1dٴr\xa8Rd\xef\x10\xad\x97\xdffr\x1axr\xb4N\xe5\xa0G4,\xaf \x16\n\xa8\x904\x88\xbe\x94\xf0*3\r\x01\x83JB\xf4\xc0rZ\xea\x84t\x93xS\xea\f\xac@P\xa2\xb6\x99\xab\x02\xd4\"\x87ѩrl\xf8p$\xe0\xc5j.\xe8\x15\x02\xc7[m\xa6\x15억GYՅ\x8d\a*\xae\b\xddߏ\x19\xc8\aZp(\xe8M\xb0q\xa98'\xe0\x8b\xc4>;\x18\x0e\x80\xc3sc`\x11r\xa6\xa9y\xe4ܵS1\xfc\x18W\f\xae i\xa3\xea\xa7\x03\xbfo\x8e\x81\rx2R\xc0$\xb2\xb4z$\xd8W\x99\xcd\n\x16U\xe0\xddq\x11\xf3\x02\xe5\xf5\xbc\"\xa6\xea\u007f\xfa\x99kfx\xe0l\f\xa5\xf3\xef\xad\u007f\xfb\xd0\xfc\xfd\x87\x8a\xb4\x11ח\xdf\xca\xca_\xfb[\xa7!\x01\xa5\xa3\x11\x8d\xfc*\x19\xae\xba\xd5G\x1e\x811߷繵i\xb9\x95ă\x96d̿`\xc25Ǻ\xa6#\x1f\x9e\x18YK\f\xc8u\x04X\x16\x89\x9c\x17ʋ\xb8\"\xe4ѷSu\xac\xa3\x12>|/\x9c\xf0$\xb5U-\xb5/9\xa5T\x9dU\xcdvlM%\xac&\xf6\xd2`ItE\xf5\xaeL\x82\x05i\xc6_\x96\xc4\xc29G\xa6\\\xab\x99\x883\x88\x06\xb5__\t\xcb)%\x8b<h\x8fw\xcag\xcd'\xe7|\x03q\n(\f\xdeps\t\xd0@\xb1\v\xa6;\xf2\x99KgD\xd7\fS\xc6\x16\xe3k\xaa\xb0\xdfI\U0008dd76\x96\x85\xc56*\xd5iy6\xbe\xe5\xfc\xf9\xa9O\x1e\xad\xc7.9G\xe6\x85\\\x9d\x93\xf8=V4\x8e\xf0\xc7r(\xdeI\xb8BD\x8b\xa8\x93_\u007f\x15\x9b\xf0\xa3pɸ\f-\xff3\xae(\xcb>FS\xc1Sv\x90@\xf7{.\xe0\x15\xc51\x1dst\x92\x96\xff\x81\xca\x01EY\x80\xf5\xb8\xf4\x85\xdcBd切#ȡrrZ\x99\xc1-i\x85\xe3\x92!D\x13\t\xfcL\xcd\x00\xb3\x91\xba\x16U\xfb\x84iф\xa4m\rQ\x15W,\xd0\xc1\xd2\x1d`8L\xadz\x91\xbcUl\xa8\x92\x82\x8a\x1b\xdc V\x86l\xef=:2lD\xad\x1c\xed\xdeѶ\xb0Ϩ\xcfA\xa2K\x00\vpk\v\xce\xe4\x90\x11\xb4\xafe\xa2\xc9\xf9\xc05I\xeb\x1c3!\xe5\x88.\x87\xd4\b\x13\xe1\xeb\x91Y\xa8\x91\xa6\xc5i\xa4h\x98hʍ\xfc\xaeǐi\x83T\x1c\xc8O\x12\xaa>\xd3\xee\x11\x9b\xcc\x17\xce/_\xad\r/\xa7\xe27:\x1b\r\xd2\xe5\\\x0fT\x88\x10\x03\xa3\x90\xa8H\xdf\t\xb9$\xad\xa6p\x1e\x04\xba\x8a5\xa8`\x94\xb7\x16\xac\xd07dέ\n'\abd\xcf\xe4\"H\xf1\xe57h\x01\b1H\x9e\v0\"\x98\xd7\x1b\xf8O\x84\x83\xc3Xn_\x13\x83\x8e>m9\x95\f+\x89-z\xd9.2\xf1\xb8 \xb9S\x06\x817\x91\x89\xcc4\x81\x0e\xb9r\xb10\xc3JD\u05f6\xa9\xaa\x8fJ3\x95\x14\x82\vTc\x01H\x92\xa8pl\xe4\xf1\xa9\x1e1orI\x18\xf8\x8em\xd58\x13\xd0O\xbe\xc3.\xe4/㟟t\x92\x1aٯ\xebD\xcb\xdc*D\x12C\xa0Br\x1e]\x8ce\xa9\xfb\xcdA\xc2ݿ\xf5\x82\x8c\x95\b:\x92\x96\xb7\xb8.\x82w\at\x01í\nX\xde\x13\x8b\xc1L*\xef\x16\x1f\xad\x8e\x80\x9b\xaa(B\xa6\v\xa6PY\x87\xd0_$\xf4\xe9\xf96\xa4ڍ\x19\x11\xa5e\xe1\xf2\xef\xfaH\x1dc̃\x04\xcb\\\x0e=+s\x00\xa1\xe0_\xaf\xd6\xf2\x1a\xb7\x8d\u007f%g\xb4\xf6P\x99\x95)\x9fvd\b\xc7\a\xe0ȡ?\xfet?~\xb1\x03!\x84%[N\f]\xe5<\xe0\x05\xfe\x10\x01\xfb\xb3\xfc\xc2\xf1\xe19\xb0\x04{\xdb\xc3Ѝ\xa4\t\xee\xc0\x94w\xc1\xb4$\xcd\\\x13\xc5\bB\x17\xad\x17\xd7\x15\xb5\xdc\u007f+\xda\x15\xa5\xc2P!`\x111嵎D-\xb0\xd7\xd2\x13s\xa8\xc0\x81\xc6 *\xef*jT\xdcE\xa0\x95\x1b\xedi\x04\xa8\a\xe3\x85\xf2\x06\x9c\xa0\r\xa6\t\xb45E\xe9R\x11\x1a\x9b\a]e\xe6;\x0ed\a\aKm\xb9\xe3\xb1M\x11\xf4PQ.\x83J\x024l\xcaR!\x1fg\x8f\xef\x9eCN\taί$=\x80\xb4T\xf69\xd06\xc2\x01mA\xf0F72o\x1d_\x96i\x89\x15HûC\xde@9\x9a\x9f\xec\\XK\x90|)\xd4\xf8\x8f,;\xa5V\xe4IR\xea\x10\xe0\xa8E\"︈\xe5\x83\x02GQ\x19$\\nmߥ\x85\x0e\x155\xb6Vqr\xed\xeaϒ\x0e\x00\\\b\x03n5D\xb1y\x9a'hɍ\xc1\x8a\x8e\v\xe9\xa1,D\xf8\x92\xd5d\xee\xd1W\xd8_ҧě\xf0T\xb8x!P\xf6\x90\xf7\xd4Zy\x84\x86\f\t\x9f7\xa3\x96;\u0604t\x81i\x931i\xb0\b\xf7\x1dc\xbc\xdc牤2Z\x16A\xd3\xdf\xc2\x17½\x03\xbb٬\x87\xe4\x9f|Q\x85\xc2\xcb\x13F\x8c3\xee\xc1\x15\xee\x1f\xd8-\xa9i\x01\vׇH\x83(\x0e\x00\xb6x\xd5k}\x80\x9b`\xb4?\x90:\xd1}\x1cN\xf1B\x8e\xa5\xa1Z\x99\xad\xd8\x1d&\xbdk\xb51Jq5\xadLf\xf0%a\xf1\x91w\x8cz2\xa4%\xaa\b{EB\x1c\xf0\x16h\x01\x1fp\xcb\xc8T-\xfa2\x82L\xb3\xdba\xed\xa0\xf5\xd3^T\xcbί\xae\t\x83\x81\xa1*Ӿ\xd7 r\xa0UJ9\n\xc7\xc0\x8b\xbe\x95\xc3QU(\xe95,\xaf\x03}\t>\xae'\xcaɨ\x9c*\x8bVP\x94\xd06\xbfs_\xdd4:6\xe5B\xb8\xe6\x8cV=*1\x92\xef\t\x80\xad\\Z2\xc7T\"\xf5\x111U\xf1\f#\xee\x9b\t\xca\xc3Hy\x147.\xf3\xc9e-\xca\r\vC6+\x95\xa0&*&X\xa6\xfdjѯ\xcd7\xfdE\xbds\xa0\x80\u007f~\u007f,\x8e\x1aޢ\xdd\xd4*z\\=.R\x01\xe3\x1e\xb4`'\xb2=E\xb6C\x8b\x97\xe0B,\xe8/\xb8\x9fY\x04\x01\xa0 \xd8I\xce]\xda2\x87\xbaՕt\x8f\x9c\x9b\xe0'f5\xd7\x00\x9f\x00Y\xc7\xf8\x175\xfa\xa1M\xab\b\x13\x94G\x1fq\xecd1,u۠\xf40\x92\xea\x10\x80~\xc16ߵ\xf8\x01\xf6_\x8eڈ\xd2\xc0\xa0\x11=\x9e \x99Z\xab\xbc\xc8N\x86\xad2F\x8b3\x04q\t\x93\xdeUZf\xaa0\x8d\xea\xf0\x90\x88n0\xb3\x16\x9dSP\xe5\xed\xa8p\xb8\x12\x1a\x19ӂ\x02\xc3\x14\xc4H\xae\xb7\x81\xa7\xbdm\x01\xc0\x92\x9b\xb0\t\xe3\xda\x19\xe8&\xb1 \xf4\xc3\xdcq\xab\xf0s\x91\x10U\xe9\xaa\x1c\"OS\xb5\xb2JQj\v\x10\xe4\xba\xd7\xd0\xf5Y5њ\x89\xd4\xe3g\xcdd\xb7\xed\xffҤ\xfe4\xab\xfa\x0e\xf9\u07bb\x1bX\x18\x92\x119\x8e'\xa9G\xab\x9e\bE\x89\x9d\xf3\xcb\xf6\xa0\x1f\xddAD\x1b\x94\x9f\xb5\xec\x0e\x95\x1c\x14\x1b8\x8a\\'\xe62\xf89\xd4\xd4\xc0\xcc\x10\xed\xe4P\x06\u05faV\x96\x02ɓ`\xedļBpKm_\xd8~\xb0U\xebh*WDW!\x9aW\xc2\x19>7\xfeF(] u\xa0M\xc3U\xb3\x86\x16\x99\xea\xeb,\x18\"\xfd\x8a\xafnZPP\xa40c\x95L\xce(iR\xa4\xa8F\xac\xde\x1f5\xf2\xca\x13\xa9[\xad3\xc2W\x1dg\xad\x1c\xc0\xa9\x83kE\xeb\xd0\xc8\u0089\x8e\xbe\xca\"\x15\x03<\xb69\x17\xce\xcb\x15u-\xe6\xe1\xe5a\xb4z\xd3P\xefK;\xc1#\x15T\x11\xe1D]K\xbd\x9dyBgt\xf4\x01ǈ\xea\xea\"\x912WٷV\x01\x1c\xa8u\xc8\x01\x82\x18;-\xaf\xdb\xfc\xc1#u\x11з/\x92t8j_R\xbb\x0e\xc6\x0e\xd5%\x93C\xa0\x92D\xdc\xca\xc2\xf8d\xc4hQo\xd8 \xb2\xa6\n\x05\xaa\xd0'\x15P\xd0\xc8WlS\xacI\xde\xde>9\x89\x843邧(\x91t\xed\x04IM\x183e\xffe\x00$\xb5\x17ꢖ\xd7Y\x83\x13h\x11N\f\x00%W\x92\x8a\xcex\xbb\x16D0\xf8P\xa0\xd6E\x8b\x9aE_x\xc0\xa1\x04\xae\xbbDCͦ\xf5\x05\xa6[\x19\x8a\xea\n\xea\x82cZ\x81\xb9ɷvRϣ\xc09k\xb5\xc4\a\xb4\x1fl\xa7ek\xa8\xef<\xb0s\xe0\x90\x01C#2`\xa3\xaazQe\xe9P\xc2\x137|\x02'\xe2r\xb5|]\xd1Z_}\xe4M\xb8\xa6\x05\u007f\xadJ>\xe6\x95\xf2\xdf\x1a\x99\t\xe9\x1d4g\xf7h\xc4\x0ec5\x92\x00\xa9\x97\xb0V\xca\\\xd0|\xa2\x9c\x14\xecs=Q\x81zo\xaf̖aHTm\x02\xb8\b\xd5\xe4?Z\xff\x8c0d\x0e\x91b\x88@\xa2n\x8dHt\x89\x16\xfd@\xa7\xc1?\n\x84\xa1\x9a\"\xd31\x87z\x9dfŊ\x8aV\xb8\xff\x81\xceP\x115\xa8¤\xa5U\xc4Q\x1eU\x05!\xa9c\f\x14\xbeLMP\\\xad:Z\xd5\xff\xa7\x9a\xea\xf52r\xe7\xba\xc9\x04\xab ȤC:\b\xfc\xb8\xa0E5\x02oE\xb7\x0f\xc4\x15)\x9e\x18\xe31P\xde\xd09\xaa\x1eII\x98\x9e\xa5\x86g\"\tzs\xba\xf3\x88/V1%\xa9\x84F\xc8\xceJ6\x00\xab;\x05%\n\xcaq3\x05\xaf7c\a\x9c\x10@>ԥ$S\x9e <\x94TpU\x15`\xf0\bMK\x1e \xcfK\x1d2\xd4\xdca\xdbϹ_r9\u007f\xf4\xecU}\x06Z\bZ\xea\"r\xbbʔ\xe0\x11\x90\xea>\xd6X6\xf8\xcdĳWG\xad\x00\xe3\xc2\xcc'\xf0\xc5\x1ed|\x88G)\x11\xbc^\x91\xd8\xfdce\xce\xfd\xb2D\xa7\xa2\xe6\xcf\xc6c\xef_\xeb1|f7{\xab\x84!!\xbbG\xd5r\xf6\xc7ׁ\x83n#Ĉg\xdcV\xdd\xcaǞBJZ\xcb\ue99eAf\xd1_\x80\x84lKHo\x84\xca[\xa1\bA^骐3\xde\xee\x0flֽZ\x8f}\xc7Uc\xcfCm<\xf4gT\xd4Q\x04oi]\xa1\x11%\xeaA\x93\x05\x031Ur\x99\xea)\xc9j\xe6E\x8c\xca\xee)\xa9\x02\x97\xaa\x8e\xf0\x88o\x86p\v\xf9\x94\xd4II\x14\xdcR\xd4l\x10\xb4\xb0/\u007f\fc\xa9)\t\xf2~}ύ!\x8eZ<X\xaf5E\x14\r\x03\x02\xc1h1Y%|!\x16\xc1_\x90\xe7\xc1\x99\xd2%|\xe0L:\xb7\xa9=\xe9\xe2\xec1\x0e8^sʊ\xf2\xfaM\xd4\xf9\xc0t\r\x95x+\x00\x05$5\xadJ\xf5Ӥ\xd4\t\xe2f㨐\x87\xbf\x92`\"\xf4I\xfd:\x8bT)\x82\xbdħ\v\xc4z\xf8\xaf.\x9d\u0088\xc3?\xdc\x06\xceZ\v\xf5D\xd7\xc0\x0e\xa82^\x97\x99\xca\x16Y\xab\"Su\x9a\xe7ʇ\x83\xbf\x8e\xba\u007f\x98\x135C\x01\x0fybaR\xe6J`q\x10e\x18>D\x8d'\xb1\xabݶ\xf3V\x8b\xe4z\xadM\x89\x89\x97Zd\x8bÚc\xbd\x98i\x15\x9a.\xdent\xecM}E\xea\x8c<(2\xee`\x00\xd6\x11VA\xc4\xef\xf2*nb\xc1\x9a\xc5O\xa6\xf6(\xdcQ\x82M\x10\x01*\xc2kM?\xabYβ\x9a5\xb4N\xcf;c\xab\x17\x0e\x0f\x8f`擷z\xc0K먪\xfb~o\f\xbb\x9e[[QB\xe4\xea\xee!*u@2\xa4ҲZ\xbb@u\x9c;\xaaV\xe9\xc3\xebaҎ#\x026\xc8Pl@4\x04\x02\xb3\x0e\x87\x82JR~\xf7\f\x02b.\x06\xfbCh\\T\xd5R(n\x8b\x99P\xab\xa8\xba\x17j\x8dZxP\xef\x12q\xc8ua\u007fI\x00\xac\xe8\x92\x1dO8u\x12S0\x84\x04K\xafM\x1d\xb7%\xde\x15r`\xcdw\xd5\a\x00\xb70$\t\xb3MU\xf1`\xc89\xb4\x0ey\x83\xebd\x0f\xe6\x13 = \xd7U\x95\r\x03\x88\xf8\xd4*\n\xce\x14\xfb3\x90\x14\v\xad\xbd_-5LXI\x8b\xb3\xeb\xc9\xecťI\xac8u\x8b\xab[\xa4\x12\xda\x0fs\x02\x03?\xb1=\x9f\xf7\xbfo{\xff\xfd\x80ޖ\xe2ͪ\xad\xff\xbe)\xc5\xfd\xaa+寚R\xfe\xae\x17\xc5\xfd\xf8\x85\x18\x8bo\xf5\xf9\x16\x81\x91\x12\x12\xd3\xfa\x1a\xbe\x9b<\r\xaa\x03\xcfv\xe5\u007f\r+\x81\xbf\xe7r\x0eo\x15`\xa2\x1e\xa0V(\xf1\x8b7^{\x17\x00\x06\x12e\x1c\x05b\x92\xabS\x8d\xee\x01\xcd~zտ\xde\xc6&;\xbc\xb4\x989\x85\xef\xb83\xd5N\x95B\x10S\xf5Mh\x89/\xee\xed\xaa\x983ƙ\xab\xa5#\xfd\x84U\r\xb5\xc9\xe6Xlgql\xec\xbf\x03\xc2b\xc2\xf2\x1c\t\x8c\x00q\xa9\xa1F\xc1+\xac9\xa4٧\xba\x92\xd5$\x9aK\xcf\x1bx\x00mM\xc1\x86\xaf\xa8e\x01\x00au'JM^\x86\x8d߰\x19\x04-y\xba\xab\xc4\xc4lx\xf9\xdd\x1b!\xd1 \xbc\xae\x9e\xa5\xf9\xea,\x86\xba\xe6\xa6\x00\x02\xa0\x02kr\xabSC\xceP\xdf\xd4\xd6\xeaJژ\x80\xaaڷZ}\x80\xd6L\xaa\x80\xaa\\\x1a\x17\x83w\xc1\x8b\b7!v\x9c\t\x06\xc8<\xe4+\xcf\xea\xfc\x81\x0f\xbb\x16\xc3\xea(\xd2$\xbdOP\x18\x1f\x017\x83\x9d`\xf7L\\|A\x81@$\xbd/\x15ش\xed\xa4\xc9G\xa0\x02Q\x04$\x84\x8bwǢn\x1cIE-\x96\xa9\xf1um\xd5\xf0\xb4\xd2\bĿ>\r\xb2V\xdbP\x82\x1a\x80[ͪ\t\x93\x9bE\v\x97\x98i\xa6с\xf4\x04M\a5:N\xba\xd6\xc4\xc0E\x1d\x02n~d\xa9V\x9e\x8dy\"o0C\r\xe3\xd0\x0fú\xb4\x95E\xeb\x03\xb8\xf5\x88\xd9v\x87I\b\xda\xdd\x10\xa1\xad\xd40d\x02\x05r\x0f\xb5[\x1aY_\xc0\xab\x96Գż\x9e\u05cf&\xa5\xa0\n\x1a_\x81ߠq@\xdb\xe1#\xde\x12@S5\xec\xee\xc30O\xdc\xeeUS\aV\x03\r\xf5\x16\xd7_A\x14\x1e\xe1\xd5\x05\xae\xdbz\x9d\x8c\xaf\xef\x10\xc3\x0f\x8f8\xd5?\xb4RV\xb5V\x80\xe2LZGSqI\x1boT\x8a#U\x06\xe3\xed\xd1r\x8f\xbe\xcfk\xf2U\u07fb\xba\xbd\x8fVA\xf8[\x9d\xbe\x18\v\x90\x01\xc0\x18\xd0qZ[\xeb\xcc\xcc\xf2\xfet\x16\xa3<U\xb8\x1d\x81\xa8gL@\xb2\xfc\x92\x03\x88J'^Q\xb0\xe1\x03\xbbÕp\x03U\xb4\n\xe8\x06\xad_\xad\x18\xb5\xf5B\r\xe0$-\xe7@\rF\xd8+\xaa\xee\x03IΕg\x90\x04fDw\xb0\xa0\x0e\xae\xe3\xf0\x0e\xbf\xf6b\xf0\xfa.Z\x84 \x05\xd53C*\xa3\xe9W\xecI\x1b\xa34\xa5\xfd\xbe\x04^\xeau\xe7@v\xc3\xe7RMJ\x881\xfdL\xfa\xb6'\xa9\xd4\x18$o\xc60\xa8\u07bf\x13:\xa9~\xacE\f\xb3>\x01\xb2'\xb8\xf3\x016\xb5trj\t\xff!-\xac+R1\xe2\x97\xe7\x0e\x04;\xfe\x96ɕ\xb2\xd4\xeb\xaak;\xcd3\xc3)t\xfb\xf3i\xff\xfd\xa4Z\x9fX\x1f\x85\xf7\xce\xe9\u007f\u007fV\xf7\xdbi9\xb2N\xfc=\xad\x01\xc8+K\xb3j\xb1Z11\xb6\x96*\xd5C\x0f;Վ\xadj9g\x98\xb9\xc8j;쵪\xb2\x10 \x18\x81\x1eWa\xb5\xea\vd\x1d\xc72\xf5\xb7E\x02Mҽ\xb5\xaaU\xc2T\f?pU\xa5\xc7l!G\xb5\xffǩQݫ\xdb\b\xc1A\xa6\xa0\xab\x90\xbf\x1b|\xc6\xc0\t\x87 G 4\xa8\x95\xa00\x06\xe5\xed/\b\xeat&\xe4\x10\xe6\xba8Q\x97SK\x96\x1a\x9e\xa0ȥ}\xc2\xf8\x11-\xa4f\xa1\xef\xbb:-\x93m\xf4\xb7\x9a.\xaf!\xeb\rbQ\x95\xaes\xfd\xc5\xf3\xb6U>\x89\x834\x02-շ\xde\xce\xfb\x11\xf7\x0egv\xe4U\x11\u007f3DK\xab)\r\xf0\x86>\x87\x9a\xf2C\xf2\xe5\xbbש=\x02$\xe9\x9c=1\xa1\xfd0[J\xea~ܽ\tl\x8a\x90\xd6 o\x1c\x19\xe2B\x8aWK\x00\x98 \x99Y`#\xc4\x0e\x1f\xf0\xc6*\x1d\x84옡\xbb$\x1bC\xfazX\x94\xf4£\xc0\xec|\u007f~D\xbc \xe3\xc8\xf1\x97\xd8\x17\x0e\x01\x03V\xc0\x98\xb6\xa1\x19\xee\xc4\x15!\x9a6\a\xe0\xee\xa5R\x8cAa\x1e\x180\x1c\x82J\x8eQ\x9bD\x82\x8a\x9bB\xce\x1a\xdf\x1a\x13\xf6bp1\xaa\x93\xc8\"\x83\x1d\xaej\xfdg\x86\x8f#\xd3\xf6\xcb\xc9\x15\xabY\xef³ Q|m\x95\xd8A5\xc3\x14\x9c|\u007f\xfd6\x885\xed\v;@\x11\x98\xa9B\x14\xd2\x16\xb9\xbd\x90\xfa\t\xb28\x9fTP\x17\x03h\x82\x9c\\\xda\xf7\x91\x99d\xc6F\x1d2E\x96\xf5\xa8\u0085l\x1f\xafL\tF\x02\\N\x00\xc7<&\xb8ik5@\x8d\x9ew \x1f\xd4Z\xb0\xb4\xa3\xed\x9cO\xf5ۺ\xff\xab\xb28b\xcfm\xed5\xbd\xba\xaa\xf9\xb5R\xf6\x80\v\x01\xa2vC\x98z\x9e\x18?)\xbb\xb8\xe17\xa7g|lrP\x9c\xa5\xb7\xff\xcb\xfdՆ\xb0\x8aY;2\xa3\xf2\x1fRl\xd1\b2\xf0\x90\xfb\xdfZ\x9b\x86\xe0Hw\xe8\xad+\x1d\xc95x\x8f\x0f\x94\xae\xe6\x19\xed\x9ey\vΪ\\k\xe3\xbfj\x96\x88i2\xe4\xa4Z\xfc\x11\xe1\x10\x1fhL\x8c\x96\xb2U\r\x18q\xe3\x83\xdc\xf5(g1fU\x8f.lo\xaa\xec4#ô\x185D\xf6P\xe9+\xcc\xe3\xbf7\xc8G4\x01Ƶj\t(\n\t<\x92ƩiEk\"\xb0T\xd2\x1eA\x84_\xd0F3\x02\x17\xa2\xaf\xd2ۜ\xc5kA\\\x9bc\x19\xf0\xa5&\x1b\xed\xd7\xd5<@\xcbx\xec<\x82;\xb9\x03A\xaa\xfa\x0e\xa4\xef&H\x00\xfc\xa21\xf4\xa0\v\x00\x17L\x1b<\x92\x16\xf6\x10\xd7(V\xbf\xf3۩\x8aXRרG\xb5k\xa5\xe6\xbee\xde\x0e\xa5\x1cm\xcc\x02\x06\x88\xf2Ӓ\xd6%\xc7MZ\xb2\x1f\xea\xae\x00麖ڈ+\xfcz\xf7XU\x80Bf\x91|B\xe9;m\xa3\xa9\xa6\xd2wMj%.\xebU\x99\xab\xd0dt\xc2U\xb8`*\xa3\x02'\xa7&\\x\xe3<\xb8/\xabZ#O)xm$v\xa7A_0c35/D`M\x8d\xdd\xda_\xab\r\xe8\x06\x93\x06\xf9\x8f1\xd4\b\xac\xe2\xc2T\x9eBZo\x8b\x1dx2Ug\x19L\u007f\x82\x0f\xb6*\x9d\xa6\xea\x04\x97VU\x19\xd5NO\x14\u058cW>O\x91\xa7\xcd\x003\x8b\x85\xb4\xad\x05<Q\xcb\x15\x9c8\b\xd8u\xbdۦ\x9d\r])\xb1U\xf4\xb93f\xa8\xa0}nQ\x9d\xba2&0\xcb \x11\x00-+A-7\x84\xcc\x05h\x91\xd0\t\xfeU_\xad\xf6\xe3\xc7pU#\x1c\xaf\x91\x119\x84\xbbD3\"\xf4\b̡}]\x04C\x8d\x9f\x96\xf8\xb7s\to\x01\x8es\xddً\xbfabî\x83\xf7 NT32>\xacw=\xba\x01\xf1Ib˧D\xb5\x16\x8f\xa0*\x87f\xf7h.\xf1\xf9\xe9c8pfZ;\xc6ݺ\xf5֚\xd4{\xfe\x1f\xbe\xf0\xa9\rl\xc4g\x95\x97L۾\x95\x81O5\xc2\x11\x9b\xaf\x0f\v\u0094\xbeR}\xe4\xed\x95丵\u007f\xb3\\\x0fhxnE\xad\t\x90e\x96\u0604\xb0\xd7\x17)\x9660qXܦ:\xa9\xfeta\xdf\xcb\xd2s9ޅ}/\xeb\x8f\x17\xf5.\xe9\xb8wA\v\xed%\xd0\xf9B\xce\xeb\xf3\xd3\xc5|\x01\xe7\xb9\xc0\xbfU+\xbfsG\xfcD\xc0.\x06\x18H\xd2\xc2Q\x85\xb1p\xefګ\xec\x15}`\x0eB\x82o\xa9J\r\f\x87\xfeTjS\x17\x1a\"\xd4U\xed\xf3\xe8\xe0\x83V(\xd7\xf7\x87\xd6A\xb2D\xbe\xb6\xa0\xa9\x97~\x91\x86\x00\x9d=kK\xa0\xef\x13ՐdU\x1dE\n\x83\xe3p\xd7\bKȈ\xd3΄\fh糑\x14^\xe1\"\x1b4\x8cpD\xf8\x13D\xda\xe1\x95T\x10\xca5~\xf6\xb2k\xb1Wk\xb8c9S\xe3\xc1\xd4F\xd7>;\x99rO\xb9rE@\x98?\f\xef窮\u007fҚ3\x11\x90DN\x86>Q\xed\xe2Ƃo\x1f\f6\x13\xc0\xadkO\xb0X\xaa\xda+\xddC\b\xea\xff%۹ڪJw\"\x00\xe4z\x16J\xa2r\xeb\xda\xfc/\xf8\xd8z~F˫\x11\x90\xea><\xea\xeeY\xb8\xa4\xd7\x0e\xdbr\x04\xfdk)!\xab'\xf4v½'u\xfa\xa2p\xe0{\x85\v)\a+\x0f\b{i_,\b\xb9\xb7*\x1d(\x83\x82\x18y\x9d\xa9j\xa5jp\x8d\xd6\xf2Im-Wg\x0e\x93\xec b\xaa\xf6\"\xbc*\xbfi\xfd\x1f\b.(/\xa6_;)\xf0iڭ\tB'5\x13\x89\xfd3RGɯZ\x8d\xca2\xad\x96W\x97%i;\x82[\x8f3H8ި\x00DHYt\x15w\xa5\x02:G\xc8#jc.#u\f\xf9\x84\xe1\xe8\f&\xf2QL\x98eT#\xf1\x9a:\xdaN\n\x14\xaf=\xd40\xc9\xff\x1c\xd4\b\xf8\xa6F\bP\x1c\x97\x84\xd4˖\xb4/\x1e\xc7\x03\x13h\xb3l\x18\xb2\xa9\xa6}OZ<.oU\xa9i\xb3'<\xe3cb6\xd5\xd5\xe5\xf0!Ĩ:9\xbat\x15\xfaRK|\x11HG\x89Fu\vi\v&\xdcYT\xbd\x03y\x18\x99\xa46-\xd54L\\/]\fe3\r\x19\a\\\xf7\xeb\xb8KVz\xec\xd8\n4O\xea\b\x1e,\xb7!\xd0\xc0cS\xe7\xaez\xdb\x12G\xdcEf)h\aa$\xaa\x90\x8a\xae\xbee\x11ܭ\x8aB@&\xe2\xcf\xe6\x8c\xcfTO\xbc\xa481s\x19M\xfb\xec\x98aS_\xddkѩ\xf35\xd7δ\xd5 \xec\xdeސ\xf3\xf6nb\xbc7Ν\xbfq\xd7\vu\x8e\xd0_\xda`SU7\xd2\xca\x15\xa7\xae\xf1m\x1e=\xd2p\x88I\xed\t@|us \x83\xf6\xb4\x9b\x9a\x92\xf95\xab)\x18\xbfw\x84\x14^K\xa7\x8dĩz쌖C֪oy&\xa1\x80A\vm\n\x1fjT\xca\xc1\xa5\xd7\xfbG|ue\xab\xfa\x13\xf1\xd3(DiZl@\xd7CWNT\xf31\xba\x00\x86S\xdf\x12ѯRA\x9a\xaaY3{$Ou\x91\x80a\xb6\xb4\x98\x12\xd5\xd8FL2\xe7(\x81\xb7\xad\x8b(\xf2o;\x1e\xa3\xae&ٯ~\n?\x90\x0et\xff`\xddq\xf3\v\xe2_\xb0\x9b*\x80\xb6\x87\xd40\x94\xd6/\xf6\x87\x06Z\xb9\xa6\xe7\xf1 \x83\xd4M\x12\xa1\xea\xab.\xfev\xb5\x8aX\xb2C\xe4j\v\v\x83ʵk\x118M\xed\xda\xf6\x92\xf0\\]\x13-\xaa\xb7\xb2\x17B\x17\x06\x8bL\x11\xd8:U\x86>E}\xb7z\x10\x02\xa6&\x15\xa4<\xdeERK۾IR\xec\t1\x95\xeb\t\xe8\xb6\xf7(\bS\xeb\x10F\xaeKX]\x95\xef\xb5\xf5\x99\x1c'\xe6\xcb\x15uN\xa7\x87&\xe1\xd0\xc8Q-W\x1f\xed\x9eA\x84\x83\xd5\xc0\xc2Q\xa7\xeaV\x9b\xb4zR{\xaf\xc8\x04T`W\xf9\x93l\xf1\xa8 [>z\"E\xe5C5\xe6 \xc37\xb7ݑ\n\f\xe2\x0e\x157\xcd\x05\x91*ܡ4\x8dVj\xd4\xc2\xc85s\xb4\xb8\x9b6ZhU\xd0\xe4\xaebFD\xc8d\x01C2\x0f$\xd7\xd4>{|{\xdb\xefI\x19\xda\\\x82qy\x9dئ\xedt\xc0u~O19p\x12\x11\x06tqym]\xc7\xdc$\xb5e2\x9e\xea\xb9䗌s\x01ۓ4Zz\xcf_ɘ.\xb5|O\xacd\xd4\x0e\u009a\xb4\x9b6h\x87\xfb\"\x85\xc5\x18nJ\x9b\xaa\x95\x95\xbbj\xda\xeadzZ\x89\x1a#\xc6V\xf5\x9d\xf9\xf1|D\xcf\x04B?G$\x15\xba\xc2\xf4\xec\xa7\x1c$\xa3\x91v\xea\x8cԣ\x9d\xf48\x1a.\xb7,\xeeT\x1d\xa3Y\x8f\x1c\x90\x8e \xb9\bꍬ\xe6(\xdaL\x05?\x10\x80\xea\xe5Q\x8fI\xbf\xe0\x90oj\xd5\xecX\b\xb2\xf3\x92\x04\x8c\x80ͷ\xc1\xecM\xdb~\xcf8A2w_U\xd0\x04ϳ\xa9w\xc5k-\x1a9\xa6\x8e\x87\t\xcd\x0e\xb9@+e\xb8\xac\xae\x1f=߂ e\xd2%2C:\xf9\x15ַ\x1e\xb3!\xa3vԔ\xb5CS'p\xce\x03\xab\x19\xd3[\xa1\xbcz\xbeDV\t\xce1Z{\xa9!T\x9bxT\x16\xde\xda\xe0\xcaA?\xf6ł8\x8c\xe3#\xd1\x01\xa0\b\xbe\x98\xb6\xde[W\x94N\xc9\xd3ש\xeb\x1d\xf7\xe0W\x06\x84\x01:\x81\xbe\xd4\xf8%\x1d\x9a\xf6\x17\x83\xbc\x80\x13\x10\x17\xf5\x1c\x16\x1f\x19\xe6u\xf7b\x82\xba\xd7Sa:z\x9d1\x90\xb3s\xcc\x1d\x1aF\x05\xf6\xf1\x84l\x97\v\xbaZ\xf2S\xf7\xa2j\x8eP\x13\xf7\xfb\xfap\xb5\x16\xa2ݻ\x8d\xc3\x02\x86j\xe8_\x80\xa86\x15@٣j\v\u0600\x9b\xb6\x8a\x95\xea\x9aS\xbb\xbeVo\xd5W\x16y\x03\x0eV{\xebf\xf2\xb4\xc1Bk\xeaL\x8cWw\x8b\xd6%\x98\xae\xe48\xf8\xd2ٗ\x9ei\xa1]t\xc1Wu\xf1\xa0'\xa2\x1e\xf6\x13\x10\xee\xf9=:&j\rb\x92\x98\xad\xe3H\xb5F\x12T\xeb$Nգ\xe2@\x83QM\xa6\x00;\x00O2<\tO5\xb9b\x83\xf2q\x17%\xa8\xcfS\x01\xa4\x87\x92\xa8\xd3!\xaeܵ-\xb5\xebµ\nK\x1a\xb9\x83\xbcaPM+\xd0\xf2-\b\xb0\xa9\xf6g\\cU\x91\x9d\x1c\x868\xc5h$\x1cj{\xea\xc9D\x81`X\x03\xe2\x9d!\xb6\xb6\xb5\xf1ٙ\x97K\xe4+\xb2*\x03ɄR8E\xebti\x8aP\x80=\x80d\xe5\x83\xf8{[Y\xf5\xe8'훝\xaa\xc9.\xed\xb9\xd0Fk\x04;\xb76LE\x04-\xaaa\x02\x17P\xc7\xd1\xf5\xbc\x1au\xc7\x00\x14S{\xd4\xc9s&צWύ6Ǆ\x03\"J\x1fG\x98\xfct\xb2?'\xe2w\xa2\xbc\xb5\xa2\xca\a\x976\x8a2\x0eU\x1b\xfc\x8e\xb6\xa4k\xfbÜZ\xdc\xc0/vL\xc1P\xb9\x8f\xd9\xe8Z,;\xda\b0\xb5\xcb'\xe3\tu;$'F\xb8\x04\xed\xb3\x05\x8e2\x89R\xf4\f\x03/\xcb9\xb5\x1d%\x89\x10Ǆ\xed\xd4N\x80\xaaD\x99Y\xbe\x01\x81\xed\xee\xdbR\xbc'H\f\x1e6f\\\xeba෩\xf8ٴ\xc7S͈K\xedu\xda\t\x88\xa1#D\xd5\xe5\b@oU\xf7q\x9ck&=\x91\t\xa1\xc4!\xb5KH\xad\xde(_\xf4\x1fv\v\xdbS1\xe5\a]\x17\xd49\x06\xf0\xe3\xeb=\xb8\xf2\x9e0\xe4\xb5-\\K`E\x8e\xbf:\xf5\xc6\xf0q\xe8-7\xa1Q+\xc3J\x8dU\x8fR9Z\x03\x93~\x19|\xa2\xa9[\x06\xd1{\xb4\xa1\b\xe6V\xf3\x84\xe0Ҋ\xba\xe3\xbac\x9c\xc1\xf9\xd7\x0f\xad\xfdH\b\xa5\xa5g\x8a\xbcr\x91\xc4܈Z\xf3+X\x82\xd6\x1e%V\x94\xa2\xfa\xffl_\x8c\x9d\xea\xbc0S\x1c\x0e]\x8a\xaa\xd9b:܇v\x16\xa9\xa5\xaf\xa9\xab\xa5\xab}Uc\xb6UkK$\x86VMPWYO\xcf\x19\x01\xd9\\TR'\xb7jw\t\xa0\x9b\xf5\xadgi\x05\x022\x9cK\xd33l\xa8\aAk\xfd\xa8ݭ\xf4׃=T\xec>\xf5m`\xef\v\xb1\x19@X=\xb6Kbt$mP\xd0.+\xd5\x13\x83\x1c/ɣ\x14\\A\xa4W\xd4\xfb\xb5d\xb6d\xa4\xa2^\u0091r\xb6\xa1\xa5\x00\x98$\x05,\xa7\xfb\x00\x94\x9a\x85\xf4h\a\xc1\x8f\x16\xe40\xa9*A\xe3C\xf4\\\x8d\xdd4\xee\x17\xf1fEp\xeaax\xd8K[\x97\x91\xa2@9\xb2\xca\xf5$\x9b\xe2\xf1L\x0f\xb8QI\xea!\xdc\xe5\x9d\x106\x9fگ\xa6N\xd3\xfc\x84\xdb\xd4\xccB\xb7\xe8\x0e\x9cQS\x1b\x9dz\a\x98\xfe\xc7wD\x1e\x93\x88\xde&\xd7\n\x16M\x9b\x8f\x92\xa9\xf0\x80j\xe3[It@f\x02\xe8\xa7Ȏ\xab1%(i\x82\x9ekB\xa4d=\xb4lgU\xfb\xd5k\xa6\x1d\x1d\xf0\x8f\t\xb8\xf4\x8c\x9f\b\x81k{ǖ\v՞BE\x18\xb6\xcb\xcbh-\x80\x87\xc9\xe8\xcc \xce\xd5)Tn\xc8\x19\xb7\xe7\xd1Ka\xa9+]ˢz\x10\x16\x00\xa2\xad\xf9z\x16X\x86v\x99\x98\x8c0\x04\xd9\x03\xb0\x8c\x98\xf5̵=\x1f\x8b\xa9Q\xa1a\xcbev\xedn\xd32 \nF\x8b\xaf\xda\n\x8fVԦ\xc9\xe9\x87\xcf\xf6j\xb9A+\xd3\xefz\x9a\xc2XmU*!n\xa7\xa7(\x98\xda6d\xbd\xa6\x9e\xf2\x05B\a=F#\x88ء/\x8f4Ѻ\x8c6p\x98\xac\x178W\xb5ߏ\\g<\x92ڤ\xac8\xa4\xa4蛡W1uL\xad\x98A*\xaf\xe7\x00\xb3\x8a\xbf/\xd5k\x9b\x0en1\x87D\"\x8b\u007fH\xee\x10\xb9\x1a)\x19l\n\x1a\xccA\xafKO\bP?N\x82\xf5\xa1\xcd)ER\xf6ۃ\x01\x05o\x02:.\x86\vo\xa8\xa0\x12\xfd\x9d\xb7\xddV@\xde\xd5\xf4\xc7\xd8j\xb3`\x0f\xda\xd1j2\xfd\xebEm\xe7\xbaPc\\(\xa8\x13\xb4\x1f\x00=\xa0\xfdvD\xb1\xf6\xae\xeb\xf9uBl=\xa3\x05\u05ed\xd6Rl\xd6~\xeb\xf1\xe8\xaa\xd7\xff\xb2\xa7\x1e\xa7\xa4\xe7:\xa4\x1a\xd4\xdcLp\x0ffX\r\x01L^\fU\x0f\xf8\xbaI+\xdbI\xfb\xa7\x03\xe8d\xc8[\x17\xbc\x1e\x85\x06\xee'\x14\x92\x9e\xa7\x91\xa3|yP\xeb\x94\x0eT\xb5}\xa6ae\b2\x98V\x0fx\xf0zh\xd7|bGp\xab\x8dٹ\xb8\x8a\x94\x87\xc1\xd5⬞\x84\xab\x1dA\xee\xff\x00\xa1\x01\x15M08?\x8d\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\x06\x18_Rz\xc4\x00\x00 \x00IDATx\xda\xec\xbdy\xb8\xe5WY\xef\xf9Yk\xfd\xa6=\x9f\xf9TU\xaa\x92T%$!\x03s0jPP\xaf\xd8\xf0\x88\xda\x0e<\xdd^\xbdv\x8b^\x91AhD\x94{\xaf4\xa0\rr[qhҢ\x88\xe0\x05d\x1eàȽ\x82`\x00\x19\x03$\x152א:Ug\xde\xc3oZS\xff\xb1~{\x9f*\x1c\xda{IB!\xee\xe7\xa9\xe79\x19j\xef}\xd6\xf7\xb7\xde\xe1\xfb~\xdf\xf7\x15\xde{\xfe\xf5u\xfe\xbc\xe4\xbf\x1e\xc1\xf9\xf5\x8a\xee\x8f7)\xcb\xf2\x01\xf9rI\x92 \xe5\xb9\xcf\xcc\xeb?\xf4\x87|\xf9\xe4\xdf\xe1\xbd\x13\xdey\x04 c\x89)\xbc\xff\x9d_\xfc\xd3s\xfe_\xef=UU\xfd\x0f\u007f\xbe\x10\x824M\x1fT@\xc4\xd7k\xb2\xbc\xf7\u007f\xefо\x9e\xd7\xca\xca\nO\u007f\xfa\xd3y\xcaS\x9e\xc2;\xbe\xf8\x1a\xf2j4g\xad\xbf\x1ax\x94R\xe2\x10B\x1c\x06\x8e\x00\xd9\xf4\xefX\xe3\xb0\xdanX\xeb\xbeꝿ\xd7{n\x05>)\x848\xf9\x92\x9f\xfe}\u007f\xf3\xcd7\xf3\xbe\xf7\xbd\x8f+\xaf\xbc\x928\x8ey\xdaӞ\xf6\xcf\xfa.?\xfb\xb3?\xcbk^\xf3\x9ao]@^\xf9\xcaW\xf2\xe3?\xfe\xe3\xfcֻ_\xa0\x84\xe0ۄ\x92\xbf \x85\xf8!\xa0\x87@|\xed\xe7Z\xe3\xf0\xce㚛\xb2\xf7[\x81@T\b>-\x04oq\xce\u007f@xq\xcf+\u007f\xf1\xf5^)\xc5\xee\xee.\x1f\xff\xf8\xc7\xf9\xc1\x1f\xfc\xc1\u007f\x05\xe4\x1fz=\xf7\xb9\xcf\xe5y\xcf{\x1e\xaf\xfc\xd0\u007f\x88\x9c\xf3O\x06^\b<\xe6\xeb\xf1q\xde{\x9c\xf58\xeb\xf0\xceW\u07b9\xcfi\xedޱ~b\xfb\x9d\xaf{\xc9\xdb\uf3a2\x88\xdbo\xbf\x9d/~\xf1\x8blll\xf0\x9b/\u007f\t;[#\xbc\xf3\xdfڀ\xbc\xf3\x9d\xef\xe4\x13\x1b\xef\xc5\xe1\xfe\r\xf0r\xe0\x91\xe1\x19\xff\xef\xf9\x12{?\x1am1ڢ\xb5\xa1\xca5\xe3\xad\t\xa3\xad\t\xd6X\x8aq\xc5pc\\\x18moWJ~\xb0\xd5\xcb>\x92d\xf1\xad\v\xfb\ak\x93{\x9d\x19\xef\x14\\r\xf8\x12\xde\xfaַ~\xc3\x00\xf9\x86EY\xcf}\xees9y\xf2$\u007fu\xec\xad\xfd\xba\xaa\xff\x04\xf8 \xf0\xa8\u007f\x16\x18\x9e`\xaa\x8c\xc3i\x8bՖ\xbaԌw\v\xc6;\x05\xeb'\xb69\xf6\x95\xfbX\xbb\xf3\f\xba\xd4\xf4\xe6۬^\xb8HwТ\xdd\xcdZ\x9d~\xebaY'}\x81\xa9\xcd_Vy}\xcf\xfa\U0006dd51\xddz\x93i\x8d.}\xdd\xeb^Ǜ\xde\xf4\xa6oX\x94\xf5\xa0ߐ(\x8ax\xf3\x9b\xdf\xccG\xeey'(\xf7}I\x16\xbdZHqD\b\xf1O~\xc6̔8\x8f\xf3\x1e?\x05\xc5:\x8c\xb6\x94\x93\x9a\xed3C\xc6\xdb9B\nV\x0e\xce3\xbfڧ\xdboa\x8c\xc3Ԇ\xba\xd4l\x9f\x19\xa2+\x8b\xa9\re^\xa3k\x03\x1e\x8c6Lv\x8b\xb1\xa9\xed+Le^\xfe\xd6?}\x8f~\xe3\x1b\xdf\xc8\xcb_\xfe\xf2\u007f\xb9\x80\x1c9r\x84\xb7\xbd\xedm\xbc棯H\xa3X\xfd\xa6\x8c\xe4s\xa4\x94\xea\x1f\xba\x13Sg\xed\x9dû\xc6*5\xc0\xe8\xca\xe0\xbd\a!(\xf3\x8a\x9d\xd3CF\xdb9I\x16\xb3\xef\xa2E\x16\xf6\rH\xb38\xdc5\u007f\x8eE\xc3Y\x87s!\x18ȇ%\xbb\x9bcF[y\x03j\xc5d'\xa7*\xf4Gu\xa5\xff\xedM\x1f\xfe܉\xf9\xf9\xf9\u007f\x99\x80\\u\xd5U\xbc\xf9\xcdo\xe6\x8f?\xf6\xf2E\xe0\x8dR\xc9'\"B\xac\u007f\xceM\x98:b\xef\xf1\x01\x03\xbcu\x14\xa3\x92\xd1\xf6\x84\xd1vNo\xa1K\x9cF\xecn\x8c(\x86%RI\xf6]\xbc\xc8ꅋ$i<\x8b\xb4\xf6~71s4\xce56Q\x04p\xaa\xa2&\x1fU\xe4Â\x9d3#&\xbb\x05\xe3\xed\x1c\xa3ͽ\xba2?\xb9\xd0[\xf9\xc4\xc7?r\x13u]\xff\xa3\xbf\x9bR\x8a(\x8a\xbey|ȏ\xfcȏ\xf0\xe1\x0f\u007f\x98?\xf9\xc4+\x0eI%\xffR*\xf9\xc4\xe9-\bѐ\xc3T\x06S\x19\xac\xb6\x8d)\xf2\x94\xe3\x8a3\xf7nr\xeb\xa7\xef\xe6\x8e/\x1c\xc7Z\xcf\xe2\x819\xaa\xbcb\xed\xee\r\x9cq\x1c\xbcl\x95\xab\xbf\xf3R.\xb8d\x85(\x89\xb0\xd6Q\x95\x9a2\xaf\xa9K\x83\xb3~\x96\xe4\t)QJ\"\x95@JI\x9cDt\xfa-ڽ\x94\xac\x932\xb7ң;ߦ=h\x91d\xf1EI\x16\u007fxcx\xfa\xe9\x97=\xe2\xb0\xfc\xe0\a?H\x96e\xff\xe0\x9f?\xff\xf3??\xbf2\xf5\u007f\xeau\xc5\x15W\xf0\xcaW\xbe\x92W\xbc\xefW/\x15\xf0\x01\xe0!\x9es\xed?\x80\x94b\x96\xe4\x8dwr6\xee\xdbe\xe7\xcc.RI\x0e\\\xb2B\u007f\xa9\xc7hs̩;\xd7I\xb2\x98\x83\x0fYa~u\x80\x8a$\xdey\x8a\xbc\x0e\x11Vmq\xd6\xed]\x8c\xe6\a\xa9\x04*\x92D\x91BE\x8a8\x89P\x91D\b\xe8\x0e\xdad\xed\x94\xd1N\x8ew\x1e\xa9$;\x00T-\x04\xffO1\xaa.\xf9\xe5_\u007f\xf6\xaf}\xe0\x03\x1f\xd0Ozғ\xce\u007f\xea\xe4\x1f{]y啼\xf3\x9d\xef\xe4w\xff\xe2\xd7\x0f\x82\xbf\x11x\x88\xf7\xe1V\x18\xed\xb0g\x81a\xb5cgcęc[\f7\xc7d\x9d\x84C\x97\xefc\xb0\xd4\xc3h\xcb}w\x9c\xa6.5+\x87\x16\x98\xdf7 N#\x9cs\x98\u0084\x04\xd13\xf3+2Rg\x85c{9\x89՚\xd2V!\x91\x14\x828\x89\x88\xb3\x88\xac\x9d\x10Ŋ\xfe|\x9b8V\xc4i\x04\u07b3\xed=B\n\t\xe2y\x93a\xbe\xf8\xac_\xf9\xf7O{\xdd\xeb^g\u007f\xe6g~\xe6\x9b\x0f\x90\xef\xf9\x9e'\xf0\xfa\xd7\xff\x19\xbf\xfd\x81\x17\xee\x03\xf1\x97x.\xf7\xdeSW\x06\xa3-\xb2\xf1\x1d\xde{\xb6ό9u\xd7:í1\xadn\xc6\xe1\x87\x1dd\xb0\xd8EH\xc9\xce\xfa\x90\xf5c[\f\x96z3\x1f\xe1\x9dg\xbc\x93\xa3k\v\xce\xed\xf9\x1a\xe7q\xce57D \xa5h\xfcȞ\x0f\x11R\x80\a\x15I\xac\xb1ԕ\xa6\x18\x95\xa4\xad\x84v?#m'\xf4\x17\xbb\xcd\u007fwl\x9f\x19\x91\x00\x9e\xd6\xcf\xe4\xbb\xc5\xf1\xff\xfb\x86\x97\xfd\xfa\x8b_\xfcb^\xf4\xa2\x17}s\x01\xf2җ\xfe\x06\xbf\xf3\x81\x17\xf6\x81\xf7\xe1\xfdC\xebR\xa3K\x83\x90{\aU\x8c+\xee\xbb\xf3\f\xdbkC\xacu̯\xf6\xb9\xe8\xca\xfdčc^\xbb{\x9d|Xr\xe0\x92\x15\xb2N\x8a5\x8e\xb2\xa8\xa9\v\x8d\xae\xf4\xcc\xc4UyMUj\x84\x10x\xe70&8k\xef<Q\xac\x88\x92\x888\x89\x10\x02ʼfw}D]j\xe24\xa23h\x91\xb6\x13:\xfd6\xed~\xc6\xc2j\x9fv\xbfE\xab\x9b\xb1\xef\xe2ELm\x18\xed\xe4$>F\f\xf8\x0f\xa3\xad\xc9]\xcf\u007f\xfe\xf3_w\xfb\xed\xb7\xf3\x867\xbc\xe1\x9b\x03\x90w\xbf\xfbݼ\xf9s\xaf\x8aU$_\xef\xe11\xe5\xa4nls\xb8\x15ui\xd8<\xb5é;\xd7ѵ\xc1\x1a\xcb\xf2\xc1y.\xba\xea\x00*\n\aw\xec\xd6Sx\xef9p\xe9\nR\b\x86\x9bc\xb6O\x0f\xc9:)q\x12|@\xdaI\x91R\xa0\xe2\xf0\xcf\xd6Xteе\xc1Ԗb\\\xb2\xb31bkm\x87\xc9n\xc1\xdcR\x8f8\x8bi\xf7[\b%\x19n\x8c\xd8\\\xdb\x05 m%\f\x16\xbb\f\x96z̯\xf6Y\xba`\x8e(\x89\xd8wx\x89\xe2\xcb\xf7\x05\xb0\xbd\x97\xad~v\xc3\x15\x8f\xba\xe4\xe6O\xfd\xb7\xcf}\uecdf\xfd,\xb7\xdez\xeb\xf9\r\xc8\v_\xf8B>r\xefۅ\xc3\xff\x967\xee\x87u\xa9g\xfe\xd5j\xc7dX\xb0v\xcf\x06\xbb\xeb#\xbc\x0f9\xc5\xfc\xbe\x01\x17_s\x01RJ\x8aq\xc9ɯ\x9e\xa63h1X\xeeQM*\xc6\xdb9\xc3\xed\txhuSڃ\x16q\x12\x91d1RIT$Q\xd1^:#\x84\xc0\x8b`Ǭ\xb6\xe8\x06\x9c\xe1\xe6\x98\xcdS\xbb\f7ƴ{\x19\xf3+\xbd\xbd\x84r'\xe7̉-6\xee\xdbf~e\xc0\xee\xfa\x88\x95\v\x17\xc8:\t\v\xfb\xfblݷ\x8b\x94\x824\x8b[e\x1c\xbd\xe6۟\xf0\xd8G\xdf\xf0;\xaf\xf6\xf7\xb7\x93\xbf_\x01\xb9\xfe\xfa\xeby\xccw=\x82\x8f\x1c\xbd\xeb)\b\xf1l]\x1a\xbco\xe2\xfd\xbcfws\xcc\xe9{6\xa9K\x8dw\x8e|T\x91v\x12.y\xf8A\xa4\x14\xac\x9f\xd8f\xfd\xf86s\xcb=\x92,f\xb4\x95S\x8eKʼ\xa2\xd3kљk\x91\xb4b\xbc\xf5ԥ\xa6.5JIT\xa2\x88\"\x85\x90\x02\x15)\x94\nѓ\x8c$Q\x1c\x11\xc5\xd1\xcc7\xec?\xbcLUh\xce\x1c\xdf\xe4\xd4]\x1bdݔK\x1fq!\xa6\x0e\xb7v\xe3\xe4\xf6\f\xa0|T\xb0\xff\xc8r0wJ\xa2∺,I\xb3葹\xa9\x9e\xfc\x84'<\xe1Ɵ\xfa\xa9\x9f:\u007f\x13ÿ\xf9\x9b\xbf\xe1\xb5\u007f\xfd;\a\xb2N\xf2yk܊5\xc1\xc1\x16㒭S\xbblܷ\x83w\x1e\xa3\r\xa3\xad\x9c\xb4\x1ds\xed\x0f\\M\xab\x9b2\xda\xca9~\xf44\x83\xa5\x0e*R\xe1\xefM*\xbc\xf7\xf4\x17:d\x9d\x14\xa9\xc2\xe7Li\x8f*\xaf)'\x15Ÿ\xa2\xcak\xbc\a\xa9$I\x16\xd1[\xe8\xd0_\xecқk\x93\xb4b\xd2V\x82\x8a%\xde3\xa3\xedu\xa5Y\xbbg\x83\x13\xb7\x9f\xa17ߙ9\xf3ݍ\x11\xc7o[C\x97\x86\xc5\x03s\xac\\\xb8@]jte\x18n\x8e\x11\x02\xf2Q\xf5Y]\x9b\xef|\xff[\xff\xa2\xfa\xfc\xe7?\xcf\xfd\x05\xcc\xfd\x06ȳ\x9e\xf5,\x86\xf3'\x882\xf9GRɟ\xab\xf2P\xa9ˇ%g\x8eo1\xda\x1a\x03\x82*\xaf\x18o\x17$\xad\x98\xc7\xfc\xc0U\xf4\xe6ی\xb6sN\xdcv\x9av\xafE\x92F\x98\x86\x99\xcd\xda\t\xfd\xc5\x0e\bA1\n&gw}L>*\xc17\xc9\xdeY\xb4\x8bT\x92(\ra\xab\x8a\x14B\b\xa4\x92\xc1\xcc\xf52Z\u074c\xb4\x93\xd0\xeaf\x01\\\xef\x01\xcfh;瞯\xdc\xc7\xee\xfa\x88\xfeR\xaf\xb9\xa1\x11\xf7|\xe5>6N\uec30\xafOw\x10\xfcN1*\xb1ơ+M\x95\xeb_\xbd\xf9\x93\xb7\xfe\xd6G?\xfaQ\x9e\xfc\xe4'\x9f_\x80\xdct\xd3M\xbc\xea\xfd\xff\xd7Ui;\xf9\xac3.uΑ\x8fJ\xee\xbb\xf3\f\xf9\xb0\x00\x0fŸb\xb2[\x04*\xe5\xfaK9t\xd9*\xa3\xed\x9c㷭\xd1\xeef\xa8H\xa1+\x8d1\x96v\xaf\x85w\x9e\xedӻ\f7'ԥ9\xe7\xf0U\xa4h\xf73z\v\x1dz\x8b\x1dz\xf3m:\x8do\x99\x86\xb6\xc6X\xea\xd24Ŭ\xc0\x00Xm\xd993\xc2\x03+\x87\x16H\xb2\x18\xf0X\xeb9y\xfbi\x8e\x1d=EwЦ\xd5\r\xd9\xfb\xc6\xc9m\xce\x1c\xdbB*\xc9\xd2\x05s$iL>*\x11\x02&\xc3r\xd3Yw\xe9\x97>ut\xa7\xd7\xeb\x9d?\x80\xfc\xc4O\xfc\x04\x05#\x06\x0f\x89^\x9duҟ\xb7\xd6RM\xeaYn\xe1=ԅf\xb45\xc1{\xcf\xcaE\x8b<\xfc\xbb/\xa3*4'\xbfz\x1a!\x05θ\x86\xcc\r\xb4\xfahkB9\xa9g|\x94T\x92\xdeb\x87\x85}}\xe6V\xfa\xf4\xe6ۤ\xed\x848\x8d\xc2\xd3ޤ\x1aӌ\xc3\xd9\x00\x82.C\xd4U\x155\xd6\x04\x96RH\xc1x;\xe7\xc4\xed\xa79x\xd9*s\xcb=hB\xe2bTr\xfc\xe8\x1aF\x1b:\xfd\x16*R\xecn\x8e\xd9>\xb5K\x14+V/^\xa4\xcak\x84\x94\xe4\xc3\x02\xe7\xfcs\xef\xfa\xd2\xf1\xdf=\xaf|ȇ?\xfca\x9e\xff\xd2g\x1d\xd8\u007f\xc9\xf2\xd1Ջ\x17{\xcez\x8e\xdfv\x8a\xe1\xe68<\x9d:\x1c\xb01\x8e\xac\x93\xf0\xd8']\x83R\x8a\x93\xb7\x9fƚ@\x9dۆ\"\xaf\xf2\x1aS[\x9a\xa4\x9b\xc1r\x97Ջ\x17\x99\xdf?\xa07\xdf&Ic\x84\x12\u007f\xbf@5M\n]\b\"\xc6;9;\xeb#\xc6\xdb9JI\xba\xf3m\x92VB\x14\xa9\xc0\"{\xcfx{\xc2\xed\x9f?\xc6\xd2\x05\xf3,\x1f\x9cG\xc5*\xe4:\x93\x8a\xf5\xe3[l\x9cئ;\xd7&\xeb\xa6쮏\x18\xef\x14t\x06\x19\xfd\x85.\xd6XF\xdb9Y;\xf9r1\xae\x1f~\xf7W\x8e\xbb\xf3\"\xca\x12B\xf0\xe8G?\x1a!\xc5\xff\x16gq\xcf;ϙc\x9b\xe164\x14zUh\x8c\xb6\b!\xb8\xfcڋ\x89\x93\x88\xfb\xee83s\xc8Ÿ\xc2Tf\xf6\x9eq\x1aq\xc1e\xab\xac^\xbcHo\xbeC\x94H\x94\nQ\xd44\xb6\xf5.\xd4ԝqԕ\xa1\x18\x97\xecn\x8c\xd9]\x1f\xb2{f\xc4\xf2\xa1\x05V.Z`\xe5\xc2\x05\xe24BH\x89\x98\x86\xdfƱ\xbd>\xa2;\xdf\xe1!\x8f\xba\x88[?u\x17\x9b\xa7v8x\xd9*I\x96\x00\xd0_\xea\x92\x0fKv\xd6Gt\xb4aa\xff\x1cF\a\xdf&\x10\xb4z)Q$Q\xb1\xba\xca9{\x19p\xf4\xbc\t{\xbf\xffG\x1f\x8f\x8aՓ\x95\x92\x8cw\v6O\xed\x86\x1aF\x13є\x93\n\x10\fVzt\xe7ڜ\xb8m\x8d\x9d\xf5q\xe3\x1b4JJ\x84\x14\f\x96\xbb\x1c\xbc|\x95\x95C\v\xa4\xed\x04\xa9$R\xecQ\xe9\xb6v\xd4eM1\xaa\x98\x8cJ&;9\xf9\xb0dkm\aS\x87\x88\xe8\xc0\xa5+<\xf4\xbaK\x88\x12\xb5G\xed\xfb\x86Z9\xab\xd8\x15E\n\xef<\xbd\xf9\x0eW<\xf60w\xdd|\x82{oYc\xf9\xe0\x1cY'E׆\xce|+\xd0\xf1[9\x9dA\x8b}\x87\x978v\xcb)\x8aqI\x9cEDi\x84\x10B\xb4{ف\xf3\n\x10\xad\xab$M\x92\x87\x16\xe3\xb2\t?\xa7\xe6#P\x18\xce:\xe2,\xe6\xd0e\xab\xacݽ\xc1\xf6ڐ͵!R\n\xa2\xc69\x1f~\xf8A\xe6\x96{!ы嬘TU\x86\xc9n\xc1\xeeƘ\xf1NN5\xa9\xa8rM]i\x8c6d\xed\x84#\x0f?\xc4\xf2\xc1yZ\xdd\x14\xbe\xa6\xf2\xe8gH\x04\xbf\x12\xb2x\x83\x8a\xe4\x8c\x0e^\xda?G\xa7\xd7b\xf3\xd4\x0e\xc5$\x84\xd0\xce{\xe24f\xb0\xda\xe3\xf4\xdd!g\xd9\u007fd\x99\xee|\x9br\\R\x17\x9a\xac\x93`\xb5\xa1\xd5\xcb\xf6\x9fW\x89\xa1\xf3~Q\bѳ&\xe4\x0eS\xfa\xdb\x1aG]h\x84\x14\x1c8\xb2Ĥ)\x02m\x9d\x1e!\x00\xa5$+\x17-\x04\xfe*\x8bgռ\xf1\xb6!\x1f\x95\x8c\xb6&\xe1\x16\x155\xa668\x1fX\xda(V,\xec\x1f\xb0\xff\xc8\x12\xf3\xfb\xfa(\xa5\xfe\x89J\xbchr\xa1\x8a)k0\x05{\x0f\xb4\x104,\xac\xf6\xa9\xab\xc0\x1e\x8fv&\xe8\xdaЛk\xe3.t\xac\x1f\xdb\xe6\xd4]\xeb̯\xf61ơbGUh\xa4\x94$\xce\x0f\xce+@<\\%\x95P\xba\xd2x\xebg\xbeC\x97\x1a\xef<\xed~\x86\x10\x82\xe1Ƅ\xcd\xfbvA\b\x96\x0f\xceq\xe1\x15\xfb\xe9\fZ\b)0\xb5%\x1f\x16\f\xb7&Lv\vLeѵF\x97\x1a\xa3\x1dBB\u007f\xa1\xc3\xfeKVX\xb9pa\xf6\x9e\xff(\x10\r-3\xd9-\x19o\xe7\x94y\x85.\x9b\"\x98u\b\x118\xb0\xa8\xe1\xc1\xe24\xfc\t\x85,A\xd6N\xb16\x94\bڽ\x8c\xf9}\xfdYE1\xc9b\xac\t\xef!\xa5@\x97\xba:\xaf\x00\xb1\xda\\\x1bHÐCL\x0f\xa4.\rRI\xbasm\x8aq\xcd\xfa};\xa4혇<\xf2\"\xe6Vz \x04\xe3݂\xd1\xe6\x98ɰ\xc4\xea >\xa8\x1aZD\bA\x92F,,u\xd9wd\x99\xf9\x95>\x9dA\x86\x8a\xd5?n>+C>,\x19\xae\x8f\x18m\xe5\xc1\x84\xba`\xb6\xac\xb5\x18c\xf1\xd6C\x93\xab\x04\xf3\xeag7FŒi\x01-\xce↴t3\xeae\xb49\xa63hQ\x17\x9a$\r9\xcfxX\x1c;o\x00yֳ\x9fE]\xdb\xeb\xbc\aS\xd9  \xf0\xc1V{\xef\xe9ε\x11Bp\xfa\xc4\x16\xf3+=\x8e<\xec\x10R\xc2\xc6\xc9mF\xdb9\xa6\xb6X\x13\xb4Te^\xe3\xad#\xc9b\x06\x8b\x1dV.\\`\xf1\x82yz\xf3m\xa2$\u009fUY<\xfbz\x1am\xa9\v\x1dB\xdd3#&\xdby\xa3R\t\x87m\xb5\xc3h\x83n\x00\x0f\f\xb3\v\xc9b\x93dZc\x9b$WL+'\b)X\xda?G\x94*А\xb4bZ\xfd\f\xa3-*V!r\x94\x12S[{\xde\x00\xf2\xb77}\x02o\xfd\x05\xd64\xa5ӦPT\x97\x86$\x8dH[\tgNl\xb1\xef\xc2E\xe6V\xfa\xec\xac\r\x83\xf9\xa8\x02\xed^\x97\x9a\xaa\b\x02\x82v?\xa3;h\xb3\xef\xc8\x12\v\xfb\x06d\xedtf\x92\x04\xe7\xfakS[t\xa9\xa9J\x13h\x95\xf5\x11\xf9\xb0\x9cɅ\xac\xb1\xa1\xb6>\xa9(\x8b\x9ar\x9a\x18\n\x90B\x04\xb2r\xd0\xe6\xe0\xe5\xab̯\xf6\xa9&5ۧ\x87\b\tq\x12\x05\xa1v\xa1\x99\x8cʳ\xea\xf2\x90uRF\x1b\xe3\x10\x89U\x1a\xf0D\xf1\xfd'M\xf8\xba\x01y\xdc\xf5\xdf\xc5{\xffꝧ\xeaB\x13%\x11\xe0\xd1ڂ\xf3\x88X\xb1qj\x87\xb9\xe5n\xf8E\xb6&3\x92n\x1a\x91A\xa0\xd4\xe7Vz\xac^\xbc\xc4\xe2\x819\xa2D\xe1\xacg2*\xf1օ|\xc3M\xeb\xe4M\x81\xab\xc9ov׃\xf2\xe4l F\xbb9\xe3\xdd\"DxS=\x978\x17P\xad\r\xab\v\x1d\x06\x8b]ڽ\x8cv/\xa3;\xd7\xe6\xf8\xd1S\xa4\xed\x04!\x04\xadnFo\xbe\xcd\xceƘqC\xf9  i'\xa1\fܐ\xaa\xf7\xa7\xd8\\\xdc\x1f\r;\x97>\xfc\xa2\xdfmu\xd3_\x8a\x12\x85\xb5\x8er\\Β\xc1\xb9\xe5\x1eY'EHA]h\xf2QA9\t\xc9U\x94(\xbasmV.Zd\xf9\xd0\x02Q\xacf\xc1\xc04\xfc,\xc6\x15\xed^\xe0\xacT,QR5Y\xf2\x84\xd1Ƥ\xb1\xfd!I\x1c\xed\x16\xecl\x8c\x82\xf8\xad)\xd7J\x11(\xf9}\x17/q\xe8\xf2U\xac\xb1!\x81<3\xa2\xae\fi\x16s\xf0!\xab\xf4\x16;\xe8Ұ\xbb>\xc2\xe1\xe9\xf4[\xb3\x04Tk\xc3\xf6\x99\x11\x93\x06x]k\xcaQ\x8d\x90\x10\xc5\no\xdd\xf7\xde\xf5\xe5\x13\xff\xf5<\x8a\xb2\xfc\xddB\ue153S0\xfa\x8b]\xd2V\x12@\x1a\x06\xed\x93k\"\x9c$\x8b9p\xc9\xca\f\bS\x1b\xc6\xdb9\xa7\xeeZgwc\x8cT\x82\xde|\x87\xfdG\x96gO\xacՎ</\x99\xecL\xa8r\xbdG\xcb\xecL\xd8\xd9\x1c\xcf\x14,\xa2\xd1{\xa5\xad\x84\xc5}\x03\x96/\x98g\xf1\x82y:\xf3-\xa4\x14\xec\xbbx\t\xa3\xed\xcc\xe7\x9c9\xb1\xcdpkB\u007f\xa1K\xab\x9bQ\x16\xf5\x8c\xba\x11\xd3\\\xa9\x9b\x05\xa6\xf7\x1c9\x8b\xc0y\x87\x90\u009dWQ\x96\x87;\xf6l{\b+\xd3v\xe0\x8d\xeaJSNj\xea\xa6\xc6\x1dLAʑ\x87\x1f\xa2\xbf\xd0\xc39\x87\xae\r\x93\x9d\x9c\x8d\x93;\x8c\xb6'D\xb1b\xe9`\xc3/5\xb5\x91\xba\xaaɇE\x93\xe7xLe\x98\f\vv\xb7&\xb3\x1bqv\b,#\xc9`\xa1\x83\xae\fw\u007f\xe5$A\n1\x1f\xbeW\xaaH\xb2\x88\xf9\xd5>\xed^\x86\x10\x92:\xafѵ&m%d\xed\xe4\x1c\xb5#M\xee\xa3b\x851!\xfa\x93Qx\x02\xbdu\b%ί\xb0\xd7[\xb7\xe1\xacG\x88 R\x8b\xe2\x88$\x8dѕ\xa6\xae\f\xba2!\xfb\x12Лos\xe0\xd2U:\xfd6\xba6\xe8R3ڞ\xb0\xb5\xb6K9\xaeh\x0fZ\x1c\xb8t\x85v/\x9b\x15\x91\xf2݂\xd1N\x1e\x9cx\x11T\"ը\xc4{\x8fp\x8e\xb4q\x0e\xc2\xef\xe5 \xae\xb2lܳ\x81Jc\xbc\x14\xdcrӝ\xc4i\xccʡy\xe6W\a\xf4\xe6;\xc4i\bi\xe3X!;)R\x05\xf1\x85\x10M3J#X\x11Ј$D\x93\xf1;\xa4\n\f\xb5\xb7\xde\v\xc5\xd6y\x05HU\xe8\x93*RN\xc5Rz\xebI\xdbi\xc3\xdeZ\x8c\xd9\x03\xa3\xd5M\xd9\u007f\xc9\n\x9d~\x8brRQ\x95\x9a\xe1Ƙ\x9d\xf5З\xb1\xef\xc8\x12K\a\xe7\x91R\x86\xaa\xe0\xb8b\xb85\xa1\x18\x97\x8cwr\xccvNRk2\xefh7\x00\x88)\xe7~\x8e$\xb51\x9f\x8d\xbd׀\x96\x92\xb2\xd4\xdc3.\xb9\xf7\xe8\x1a\x83\xc5\x0eK\x17\xcc3X\xe8\x06\xceL\xed\t\xf5\xa2\xe8k2\u007f!\xb0֢\xa2\xb3\xc4\xc2BL?\xc7\x03\xf5\xf9E\x9dX\xbf9\x19\x16\xa5\x8ad\xbb\xddɰ:\xe4\x16Z\a0D\xe3XW/^\xa2\xdd˂\x16j\\\xb1\xbd\xb6K\x99\xd7D\x89\xe2\xd0\xe5\xabt\xe7:8\xeb(\xc7\x15\xe3\xed\t\xc3\xcd\xc0_\xd9\xed\tYmh[3\xd3\xe5\"$J\t\x8487\u0099F<Q\xa4\xa8kC\xdcpj\xce\aUc-%y\x12\xb3\xb31f\xb8\x95\xb3t`\xc0\xca\xc1\x85\x10x4\xa0:\xe7f\xe5\xe2)\xde\xd68T\xa4\x02\x100\xd3 ǩ\xb2B\x88\xe1y\x06\x88\xb3\x12a\xbc\xa3\xb1\xe7\x02\xad-\xde7\x825!X80\xa0\xd3oQ\xe5\x9a|T\xb2}f\x88Ֆ\xee\\\x9b\v.[%\x8e\xa3&\xcb.\xd8Z\v\xca\x10\xb3[ЪkZ\xce!\x01\xa1$I\x1c\x11G\x8a4I\xe9vZtZ\x19\xbdv\v\xa9\x14\x93\xa2$\xcf\vZ\xad\x94}\xf3}\xe6:-jm8\xb1\xb1É\xf5-F㜪\xd6dU\xcd$R\xe4Q\xc4\xfa};8\xeb\xe8\xcduh\xf73Z\x9d,\xf8\x0fu\x0e\x1d\x86s\x1e5\x05i&\xdcvx'\xb4\x97\xb28\xbf|\x88s\xde7Of]\x87\xa7x&딂\xfeR\x97\xf9\xd5AS\xb7\xa8\x82\x04\xc89\x16\xf6\x0fX\xbdx1\x94wG\x05\x9b\xa7v8}\xef\x16հ@\x15\x15\xa9\xb5\x18\xc0\t\x88\xe3\x884\x8eI\xe2\x94Ç\xf6s\xcde\x17\x91%\t]%PJ\x92׆\xcdфS\x1b۴cŕ\x87\xf61h\x87\x0eګ.\xda\xcffi\xd9\xcd\v\xee8\xbe\xc6\xcdG\xef$\xaa5\x896\xec\xc4\x11\x1b\xa7vg\xb9\x8e\xb3\x8e\xee\\'\x04 g\xb1\x02~&\x9b\x9fY1\xa4\x108\xcbq%Dy^\x01\x82\x14\x91T2e\xaf\xfc0#\xfe\xd2V\xc2\xf2\xc1\x05\\S\x17\xd993\x04\x0f+\x17/\xb2\xb0\u007f\x0eg,\xbb\xeb#N\xdeq\x86\xf1\xc6\b\xb7\x9bC^\xe3\x05x%p\xcec\xacGP!\xa4\xa0\xdbΘT\x96\x8d\xdd\t\x8f\xbd\xfaR\x16\xf6-Џ`\x90*\xfa\xad\x04m\f-%\xc8\"\x89\x9a:{%\xe8\xb4\x14Q\x1c\x01\x82\xedф\x9bo\xbd+\x98\xb4V\x8cngl\xaf\x8f\xa8+͢\x1f\x041]';\xb7\"ٰ\xd3S*?\x94\x95\x15\u07bb;\x1fz\xe95\xe7W\xd8k\x8d\xedG\xb1\x8a\xa6\x1eu\nF\x9cD,\x1e\x98\xc3{O9\xa9f\xe2\xb8}\x87\x97\x99[\xe9\x91\xef\xe6\xacݽ\xc1\xc6\xc9\x1d̨@\fs2%H\xba\tY\xac\x82\xfe׃\U000e0361֎\xaa\xac8\x95W\x9c\xd9\xdc\xe5\xee\x13k\xfcĿ\xf9v\xae9\xb4LKA/\x12\\\xb8\xb2\x88\xb1\x96,I0\x1er\xeb9\xb3\x9bsǩun\xfa\xc2m\xac\x9d\xdeBx\xdf\xc4\x00\x1e\xb4!\xa9\fz\xd0a2,qΓ\xa41I\x1a\x135$fP\xb7\x84v\x86\xd9\xcdq4N^\xdd\xf1\xbew\xbd\xff\xfc\x12\xcaE\x91\x1a\nA\x0e\xf4\xa6\xb1\xa2\x8ad\xa3\x9bM\xa7͖A\xe0p\xe1\"\xfd\xa5.\xebǶ8q{\xe8\x01t\xa3\x82\xd4\x18\xd2,BIA\xa4\x04\x91\x943`#¿\x8b#Gj\x15\xb5q\xe4\xa5fsk\xc4\x1b\xde\xfd\x11\xbe\xe3\x11\x97rͥ\x17\xb1\xb00\x8fA\xe2\x85➝\t\xc3ᐵ\x8dm>\xf4\xb7_\xa6ֆH*\xbai\x1c(\x0f\x0f\x1e\x8f1\x06_[\xc4\xce\x047\xe8P\x8e+6\xd7vIZ\t\xadN:{\xb0\x10!k?\xbb\xc5\x01<κOMC\xe5\xf3\x06\x90~\xaf_\xe6\xd5\xe4+\xc0u!\n\xf1$i4\xcbn\xc7\xdb\x13\x9c\xf3,^0\xc7\xdcJ\x8f\xe3GO\xb1~l;\xf0SeM\xa65\xb1\x00%i\xc0P\xb8\x861\xd6\xda\x06\xa9\xe8Y,\xac\x12\x82D)\x84\n\xbd\x86'\xd7ΐ\tC\xebT\a\x15ETe\x81\xae\x83\xc8\xee\xc4\xfa\x90,\x8e\x89UtVh,@x\x84\x90$Ja\x8cAz0y\x89\xed\xb6\xd8\xd9\x18\x93d\x11\xab\x17.\x85RoS\xf6\xb5ƞ\xcbO\x803\xda\xfe\xdd\xda\xda\x1a\xfb\xf7\xdf?E\xc3\xfb\x85\x15\xbb\xe5\v\xb7y\xef\xfcM4\xd2\xff\xe9\xf5\xaeK\xcdps\x8c5\x8ev/c\xe9\xc0\x1c'\xbez\x9a\xf5\xe3\x01\fQ֤\x93\x02\x85G\n\x88#\x85\x12\x92\xa2\xacQ\xed6\xfd\x95E\xaaڒ\r\x96\x99\xdbw\x11\x83\xe5\x83t\x16\xf6Q6\x81C\xac$\x83v\x8c\xaf5\xd6Z\x8a|L1\x1eb\x1a0*c\x19U\xae\xf9Z{O\xb0s\x16m,NH\xe6\x97WH[-p\x8eH;\"m\xc1{6\xee\xdbe\xbc\x9bc\x9b\xf6\xba\xa9v\xeb\xec:\xbd\xb3n\xcdj{\xecĉ\x13\xf7\x9b\xc9\xfa\xba\x01\xf1\xde\xf3\xb9\xcf}\x0e<o\xf4\xe0 \xd8gg=y\xa3\xf2\x93J\xb0zx\x89\x8d\x93;\x9c\xbeg#\xf0Y\x95&\x19\xe5\b\u00ad\x98\xeb\xb6\xd87\xdfG (*Á\x83\xcb,-\xcf\x11\xa5\x11\xfd\xc1\x80^\xb7ǑÇy\xdc\xf5\xd7\xd3]ڇ\x92\x82,\x96,v\"Z\xb1`2\x1as`a\x9e\xc7]s9\xd7]\xf9\x10\xf6//\xb1\x95\xd7\x18\x1f\xea\x1dJJT\x92\xa2\xda]6&\x15\xfd\x03\a\x89zs\\y\xf5U<\xe2Q\x8f\"\xc9Rp\x9e\xc48\"\xeb\xf0\xd6q\xfa\xd8\x16u\xa5\x03mߌ\x92\x10g\x15\xb6\xbcs7\xdeu\xeb\xb1\xea\xce;\xef<\u007f\x00\x01\xf8\xe0\a?\x88\x94\xf2\vx\xff\xa5&k\v\x85\xa1\xa6\x8eН\xef`jñ[\xee\x03\x0fI\xad\x89\x87\x93\xd0Ť\x04˃.G\xf6/\xb0\u007f\xb1\xc7\x15\x17.sp\xb9\xc3ډ5\x00\xae|\xd8Ci%\x11\xed\xa6\x9f\xef\xd4\xda\x1a\xd4\x05\x83N\xcc\x05\v)YS\xe1\xb3\xc6pxe\x89\xe5A\x9f}\vs\x1cZ\x1a \x10\xccuS\x0e\xae\xb6ie0\xbfo\x95#W=\x94\xa8\x1d\xf2\x93\xb9A\x9f\xfd\xfbVh\xa5\t\xb1\xd3t\a]\x12/PΡ\x9c\xa7\x18\x16\xa1\xb1t\x1c\x9c}\x94\x04\x0e\xac\x11Nx\x81\xf8\xd3cǎ\xa1\xb5>\xbf\x9c\xfa\r7\xdc\xc0\x97\xbe\xf4%\xfb\xc8o\xbf\xe6\x06!y\xf5\xb4\x91SFAO\x15\xea\fk8\xe7I\xb5\x81\xdd\x00\x86\x92\x82\xf9^\x8b\vW\xe6Y\xec\xb7\xe9f\x19\xa6\xae\x99K\x05\xa7\xb7F\x1c\xbf\xe5vL\xd4\"\xeb\xf6\x19\uf3b8\xfd˟'±\x90\x85\x107\x8b\x05\xadD\x01\x82\xaa68 M\"\x841\x94u\x8dT\x82D\t\"%9\xb8o\xc0\xce\xcei\xf2X\xf1\x88\xab.\xa7\xaa*:\xed6_\xfe\xca-\x9c\xba\xfd6\xda\n\xb2\b\xc6\xd6c\f\x14ҡ\x9c`\xfd\xc4\x0e\x9d^\x8b8\x8b\x98N\x1frƂ\xf7\x1fw\xd6\xff\xdd{\xde\xf3\x9e\xfb\xcd\u007f\xdco\x80lll\xf0\x91\x8f|\x04\xefy\x8b\xf7\xfe?\x8284u\x84Y;f\xbc\x933ښ\x90\x1a\x8bܝ\xe0\x10H\x01\xbdV\xc2\xca\\\x8fN\x16\xd3\xcb\x12\xfa\xed\x16݅>\xa6\xee\xd1o\xad\xb3Ѝ\xc9K\u0378\x9aP֎\xc1|L\x96HZ\xb1\"\x8d%\x91\x92(\x19\xdcæ\xb1\x1c_;\xcdC\x0f\x1f\xc4\x03\xc69\xa4\bz\xaf$V\b<\xbe\xdbb{\xf3$\xc32\xd4c*/H\xa4\xa4\x9fD\xa8\xb4ۤT1\xae\xa8\xb1\xc8\xc0\x81i\xc3p{\xc2\xd2\x05\xf38\x1b\x1a\x81\xbc\xf3\x06!^\xf8\x91\xf7\u007f\xd4>\xeca\x0f\xe3\x8f\xff\xf8\x8f\xcf/@\x00~\xe37~\x83/\u007f\xe6\xd6\xddk\x1e{\xe5\xaf\v%^\xeb\x9d\x17\"\n\x89\xe1\xe6\xa9]\x94\xf7\x88a\x90\x93\xaaH\x92ƊA\xa7E\x1a\xc7x\xef\xc9bE\x1a\vz\xdd6\xd2g\xf4;-&\x93\x9cIY\x84\x00@H\xb41\x94\xba\xc65%l\a\x18]S\xd5\x15i,ɋ\x9c4Iq\x02\"\xa5\x90\x12\xe2Hrhq\x9eC\xcb\xf3\x14\x85f{\x943\x1c\x17T\xb5f4)0Ƒ$Aq_Ն$\x95\xb4{-D\x9e\xb3n@x\xcf\xd6\xe9\xe1\xac9\xa8*4\xc0\xab;Y\xf7\xe3\xafzի\xfe\xc9\xfe\xf5o( G\x8f\x1e\xe5-oy\v\xc0\x1b\xbcs?\x8b\x94\xd7GqSA\x9cT\xc4yE\x99k\x92X\xa1$dID\x96\xc4L\xab\x9f\xb1\x80T:\x8c\xae\xe9\xb6\xdb\xf4Z\x19\x83n\x87\xaa\xae\xa9\xea\x1a9%\xf5\xbc\xc7#\x02\xb9\x98\xc4\xdcr\xdbQ\xaa\xaa\"\x8e\x14s\xdd6BIZQ\xf8\xfbB\x04s5\xdfkq\xc1\xe2\x80v\x1c\xb3;)\x98\x145ֹ`~\x9a\xf7t\x1e\xac\x83\x89q\x1c\xdfX\xa7\xbeϰ\xb5[c\x9b\xb1\x1bŨ\x9c\x16Ҏz\xc1\v\u007f\xf7\xe5\u007f\xc0\x13\x9f\xf8D\xee\xef\x11\x89\xf7k\a\xd53\x9f\xf9L\x8e\x1e=j\x1e\xff\x03\xd7\xff\x92\xf7\xeeo\x84\x10\xed\xf1v\x8e\xf2\x1e\xbd3i\xe8\x06PR\x92DQSs\xf0\xa8\x86ޖx\x9c\xd1TF\x93D)\xad4\xa1\x9d&\r7v\x96\xc0\x01Oa-\xbb\x93\xbc\xb9=\x82n\xab\xd5\x00\xa2h\xb5\xda\xf4\xdb-\x92H\x92D*\x84\xd4J\x12G\x92\xe5\xb9.+\x83)\xa8g\xb1\r\xd61\xaa\r\xa7v&\x18\xab\xc1\x1bR\xef1\x80\xf0\x82bR\xa1\x94*=<\xed\x93\u007f\xf5\x99\xe1\xe3\x1f\xffx\x1e\x88y\x95\xf7\xfb$\x87\x97\xbd\xece\xbc\xfb\xcd7~\xceÕB\x8a\x9b\xcbIEl,·\\C\bP\xd3\xd0\x11\x8f\xc0\xa3D`N\xad\r\x82`\xa35\x856X|8\xc8\xe60\x95\x12 \xa1v\x8e\xd2:\xf2<\xc7\xd9 7Z\x1ct\xe9\xb5[\xb4Zm\x06\xf3\xf3\xf4;\x1d\xba\xad\x94DI\x9c\x87\xd2\x18\xb4sap\x8d\bY\xb68\xab\xa0QyOi-e\x9d3)\xc6(\xe9\x88\x05\xc4\xde\x13k\x83\x1eW\xe8Z\xff\x9fOz\xc2\x0f~\xe2\x15\xafx\x05\xf7g\xa8\xfb\x80\x02\xf2\xfa\u05ff\x9e뮻\x8e\x93\xb7\x9f\xbew\xb81~\x86\xd1v\x14{\x8f\x9a&\x8c\x04\x8d\x9au\x16k\xc3\x01\x19\x1b\x921c\x1d\xce\x1a\x9c\xb1hc\x18\x95\x9a\xb168<\xc6;\xb4s\x8c\xb5eb,eQ\xb0\xbd\xb5\x05ޑD\x82}\v\x03\x06\xdd6\xedn\x97\xac\xd5beu\x95\x8bW\x16\xe8\xb7\x13\x9c\xb5l\x8d&T\xc6PYC\xe9=\x15P\x00\xb9\xf7\x8c\x8ceX\xd6\x14UAUNH\xa2\x10\x01&J\x90xOf=\x14\xe6\x8bN\xfbW>\xe6\x91\xd7\xf2\a\u007f\xf0\aL\a \xdc߯\at\x92é\xbb\xd7?~\xf0\xf0\xeaO+\xefߕ$\x12g\x83y\x9a\x02\xa2\x8dm\xd8\\\x87\xb1\x8e\xba\xb6\xc4J\x82\xa8\x11\xf5\x9eZ\xbd26<\xd5\x1e\x8c\xf7Te\xc9\xd6\xe6\x06\x93\xf1\x10\xef\x1dˋ\xf3,\xf4{t\xda\x1d\x924EHI\xbb\xdb\xe5\xe2}K\x1c;\x13n\xe58/؎#z\xed\x8c\xc8{D\x14\x82\tc-\xd6y\xac5\xe4\xf9\x04g5\x91\x94H!\x89U\xc8ҥ\x10D\xc6\xfe\xe9'>\xfa\x99\xfa\xf0\xe1óZ\xc8\x03\xf1z\xc0g\x9d\x9c>\xbe\xf1\xee\xfer\xe7\x93i\xa2\xae\xab\xb5=Ky\xe8\xa9M\x00\xc58G^մbEUC\xe2\x1d\xceZ\x94\xb1\xd88FJ\x85lz\x06\xad\xf3\x8cv\xb7\x19\xeel\xa1\xa4\xe0\xc2\xc3G\xe8\xb7Sb\x15\xfa?F\xdb\xdbDQ\x8cц\xb9n\x87l\xbe\xcf\xceΐ\xad\x8d-ʺBHH\x93\x98\xd8:D\x145\x9cY\x8d65\xce\x05\xe5\xa5q\xb6\xe9\x01\njF\x81\xf0\x91\xe4/\xee\xb8㎽\xc2\xd4\x034\xef\xf8\x01\aD\x1b\x8b\x10\xfe\xe9s\xf3s\x9f\xda\xdd\xdaId\xe3H\xa4\x908o\xa9\x8c\v\xc0XGQ\xd5H<\xc2Y\x84\x80\xc8Z\xac\x0e\xedcR\x86:\xb7\xb1\x86|\xb4\x8b\xb7\x9a\v.\xbe\x88\x03\x17\x1e\xa0\x1e\x8d\xb1\xb5\xa5,\vΜ:\xc9d2\xa6\xa8+\x9c\x80$\xcb8p\xa8OUk\xca\xd1(\xd44\x84\xc08O\xd28\xf3Z\xd7\x18c\xd0\xda`\x9d\xc3\xda0\x1a*\xaf-\x9dV\x9b\xbc\xa8\x86\xceq\xf7'?\xf1\x9c\x04a\x00\x00\x16\xceIDAT\xf9\xc9Y\x9b\xc5\x03\xf5z\xc0\x01\x01h\xb5\xba_X^\x9c\xbf\x01k\x9eSך$\x8e\xf06\xe8\xaaJm\xb06\xa62\x16k\x15U\xad\x91\"&R\xa0\xab\x02\xa9TS\xbeU\xe0=y\x99\x93\xe7c\xc0s\xe0\xd0\x01\x8a<\xa7(J\x86\xe3\x9c\xc5^\x1fvw(uMe\f\x9b;\xdbt\x94\xa2\xdfj\xb1\xff\xe0\x01\xee\xf8\xd2W\xa8\xca\x12\xef,q\x92bm\x90\xbfZk(\xab\x92\xb2*\xa9\x8cE[\x8b\xb6\x1eDH>\x85\x10\u007f\x9btz\xd5m\xb7\xdd\xf6\x80\x82\xf1\xa0\x01\xf2\x99;O\xf1\xfdss\xbf\xba\xbc\xb2r\x89\xb3\xf6\a\xab\xb2d<\f\x03\x90\xacw\xd4\xd6R\x18\x8bv\x9e\xd8{\xeaZ#\"\x81\x94\xe0\x9dAH\x8935\xd6Y\xca*4\xd3\b\xe0\xf4}k\xdc\xfa\xe5[\xd8\x1c\x16d\x91`\xdf|\x9f\xd5\xe5\x15\xbc\bͣ'\xd6NS;X^Z\xe4\xc8\x15\x97\xe1L\x89\x93\x0e\xed5\xcejT\x94\xe0\x9c\xa5,Kj\x1d\u07b7\xd4:\x80b,\xed,\v\x89\xa6\xf7\x9f\xfe\xf8\x97\xef\xa0\xd3\xe9<\xe0g\xf5\xa0\x00\x02𗟽\xb5\xfa\xc1o\xbb\xfa\xdf\xf6[\xd9\xdbv\x8c\xf9\xfe \xe0\xf1x\x1c\x856\xb4\x8c\xa2\xb4\x96X\x05Q\x84s\x1e\xbc#\x92͔\x1f舘\xa3B\xe0\x85\xc0[\xc3=\xb7\u007f\x95\x9d\x89\xe6\xee\xd3\x1a\xa7\r\xc2[\xb4\xce\xc3\x14\xa1\x89\xe1\xab\xc7'\xc8\u0380#ʒ\x1d;N\x9cdH\xd9tw\xd5\x15Ƅ\xfe\x15k-\xd69\xb4\xb1\x14\xb5i\xfc\x88'R\x11q$I\xe2\xe8C_\xfd\xeaW\x1f\x94sz\xd0\x00\x01xߧ\xbe<|\xeau\x97?IW\xc5_9\xef\x1f/DH\xf8\x9cp\x94\xd60\xa9\r\x99RD\xc2\xe3\x1a`\xbcw\x98&\xba*\x9c\x0f>\xc59|C\x87\x1fX谲\xd0A[\x8b\xacFM3\xa1\xa4E\xce\xc3.\xce8p\xf95\xcc\r\xfa$Q\b\xbb\x8b\xf16\xa6,f\xbd\xebև\xe0\xa2n\x82\x8bJ\xdbP\xc0jj(ƺ\x9dI^\x1d\xbd\xf9替9\x00\x11Bp\xc3\r7\xfcw\x95\xe0_\xf3\x9b\xbf\xfa1\xe5\xdd㥐3\xc1\x80\xf5\x96\x89\xd6t\x92\b)A\x1aA\x9c\b,\x02\xe3\x1d\x95\xb1\x94Z\x83s()A*\x9c\x10H\xa9H\xa3\x04/%\xb6h\x93F\x92vg\xc0h\xb7K\xdc\xee\xb2oe\x01)\xe5,oH\xb2.N\xebp;\x1a\xa7n\xec^\xf8]\xe9 K\xf5\x02\x86E\x8e\xady\xdd\xc1\x87>|\xe7U\xafzՃ\x02\x88\xf8F\xac\xabxľ\xceSc%߬d\x98\xe4\xd3jEd\x89 \x8b\x14\x9d$e1\xcbhE\x92^\"qX*c(\xb4%\xf2\x16\xa5\x14^)\xa4\x8c\x10Q\x8c\x92\x11JE\b!\x11xb\xd14G5\xb4\xc7\xca\x05\x17\x9c\x03\bx\xea|D\x95\x8f\xd1\xc6P\xd4\x1a\xe3\x1c\xcey\xb6\xc6\x05k\xbb\x13jk9=,8\xb1U\xbc\xc0\xd7\xf2wO\x8c\xeb\xfa\x9fZ8\x10E\x11I\x92|\U000d9b334M\x9f£=>\x16M˲\xf3\x1e\xe7-\xdaYF\xb5\xc1{\x85\x94\x1e0\x94\xda\x04\x1aC)|\x94\xa2\xa2\x04\xa9\xa2\x90\x9f\b\x19\xd4)B\x90%)\xde\xd6\xe0,\xf3\xf3+\xe4\xa6\xc4\x18C\x1c\xc7!\xafh\xa4\tq\xd6\xc1\xe8\x10$DR6D\xa3h\xe4F`\x9c\xc7\x18\x87u\xfe\x8bk\xb9\xa9\x01\xda\xed\xf6\xbf<\x1f2\xfbP)O\xe0\xfd\x86w\xec\xf7\n\xac\xf587e]\x1d\xda;F\xda\xe3\x90$\xca\x12K\x88U\x84S\t\xa8\b\xa4\x9aIH+\xa3\x19\x17\x15[\xa31\xdd4\xe3\xf0\xfeUڝ.^E\x9c\xde\x1cѵ\x8e\xe5\xb9~P\xac7z\\!\x15qڦ\xaek\x94r\xc4^1\xb1\x1ac\xa7\x90I\xac\xf7\x85\x94|\xe1A?\x9bo\x04 \x9f<\xb6c\x1e{\xb0{\xab@\xee\xf7M\x1f\xa0\xf72\xc8j\xbcE\x88@;\x16ƒI@)\xb4\x8a\x03}\xe2\x1c\xb91L*\xcdx\x923\x9e\f\xf18\x10\x82͡\xe3\xee3\xc7CaJ\b\xac38\xe79\xb8\xef\"\xae8|\x88A\xb7\x83\x94a\x96\x96P\x11nJ\x81\b\xd0&\xf8\x8f)\xbdo\xad\xffL\xa7\xbf|\xfa[\x02\x10\xa5\x14\xe0o\x06\xff=\x01\fh\xf8E\xbc7X\xa7\xf1\xb213B\"U\x82\xf5\xb0\xb6\xb3\xcb0/к$\x96\x82(\x92D\x91@[\x81\xf7\x12\xa1bb\x11fb\xd5u\x18`\xa0\x94\xe4\xbe\xd3\xc79q\xea^\xba\x9d\x01ˋ\x8b\x1c\\Y\xa2\xdfm\x11\xa7\x1dʪ\xa6\xd6z\xc6\x168/p\x1e\nm?\xf5\xa5;\xef\xe5[\x02\x90P\x06\x11\x9f\x912t y\xe7\xb0N`,đ\xc7y\x83\xf4\x11\bI\xee<-\xef\u061dL\x18\xe5c$\x82N\x9a\x91D)Y\xab\xcf\xca\xf2>\x96\x17\x16\xe9\xb42\x94\x8a\x9a1\x1d\x01\x94IQ\xb0\xb5\xb3\xcd\xe6\xc6:\xf7\x9d>\x8e\xf7\x9e\x8d\x8d3lo\x9daa\xaeO\xbf\xd3\"\xf2\x1e\xeb<e\xad\xf1\x1e\f\x90׆\xda\xd8/\xe4yN\xab\xd5\xfa\x97\x0f\xc8d2\x01\xc4_z\xefr%e\xdby\x8bw\x92\xda\nڢ!\xf508/\x18ז\xd2T\xe0\xe1\xc8\xea\nR*leHl\x84\x8ac\x92\xba\xc6N&\xd4\x1e\xe28\xc66&\xc9\x19\x83\xad*R\xa3\x99\x93й\xe0\x10^\x8a\x90\xed\xeb\x8aa1ĕЋ\xe2\xc0g\x19\x17\x94\x8c\x166\x87\x13\xa4\x94\x9f<y\xf2$\x8b\x8b\x8b\xff\xf2\x01\xb9\xf5\xd6[\xf9䱝\xf5\xeb.\xea}\xde;\xf7\x9d^ʙc7\x16\x94\xf4XW#\x85\xc2c(\x8d\xa5\x9d\xb6\xc3h٪d\\L\x88E\x04\xb5 .\x86$;gPș\xef\x10\b|\xd3\xc4f\xbcC\vG\xe9\x03/\x90\xc51\xa5\xd6\xd4֑zǤ\xd2h\x1d\xa8\x12ma\x98W\x94\xc68!d\xfd\xf5\xec\xaf\xfa\x1f\x06\xe4ӟ\xfe4;;;\x0f\xea\x87~\xf6\xb3\x9f墋.\xc2{\xdeg\xad\xfdN\xa9\x02\xf3k\x8c\xa2\xa8\x04\x91\xf0\x88hJq\x87\xb1NE]P\xe9\n\xe7\x83\x136\x98\x10\x915\x9b\x12\x84\x14(\xa1P2\xfc\t\x01B\xc8\xe8\xa5HBx,%\xe3\xb2Ƹ\x00N^j\xa4\x11MBhɍec\x94#\xbc\x1c#\xd89v\xec\x18\xd7^{\xed\x83\v\xc8{\xdf\xfb^~\xf37\u007f\xf3A\u007f\x12\xae\xbb\xee:r#\xdf\u070e܋\xa5\xb1\xa9\x88\xc3\xc1\x94Z\x91\xc6 \xa5G)\xf0.Lo\x90͜\xa6v\x94\x924y\x85q\xa19\xc85ӭ\xe5\xecvLU\xc0\x80Ph\xc3lh\x99\x17>Dq\xdeS\x15v6\xba)\xb7\x9e\x8dq\x89\xa9=\n\xb1#\xb3\xf6\xf8\xb6\xdbn\xfb\xd6q\xeaox\xc3\x1b\xf8\xcc\xddg\xee}\xe4ū_\x1e\b\xfbh!\x04\"\x02c\x05\xe3R\x12\x9a\\=J\x86CVR\xd1NS\xd28n$>\xb3\x1e?\xa4\x94DJ\x91WUST\xa2\x11\xe2I\x928F\b\x89\xf5\x81\xc4\xdc\xcd\v\xb0\x0e[\xb9Y\xbb`\xed<\xbbE\xc9\xf6nA\x14Z\xa7v;\xbdyo\x8c\xf9\xd6\x01\xc4\xda`\x8ar#\x94\x12\x8a.f\xd6_^k\xcfn.\x99넩\xa3\x12O\xac\x14q\x14!\xa5\xa4\x95\xa6\xb4\xd2\x14\x8f'\x96\x8a$\x8eg\f\xf1\xd9\x02wc\x1d\xb5\xd1\x18g)\x8d\xc1\xf9\x90\xed\x9bf\xbe#\xcd\xc8\xf3IU\xb1\xb69&&\x9aRk'\xfe\xf6K\xb7\xf2k\xbf\xf6k\xdf:\x80<\xe5)O\xe1\xf2\x83\xfb2\t\x97MLh\xde\xec`\x9a\xdc!\xf8\x80\xdd\\1\xd7\t\n\x11m]\x03X\xe8d\x8aT\xb8\x15\xbd\xb4M\x1cō\xa2\xa5i\xdal\x06\xa8Y\xe7\xc8\xeb\x8aQ\x99\aj\xc4\xd9ٔ;\xd7\xd05yUs|}\x8ct\xe7L\x18\xf2\xce9\xbe!N\xfd\x1b\x05\xc8\x15W\\\x81\xc4}\u007f\xa2|[⩍\xc0yI\xcbYb\xe5p.\xc25ݗ\xfd\x96@\bCY[ڙ$o\x88\xbeN\x9aa\x9c%\x15\tR\xd08\xf3@\xc1\xe4&8} \x14\x9dj\x1d\x98]mCDg\x1d\x93\xa2\xe2ލ\t\xce\x04\xb3\xb8\xb7C!\x84\xd0\xf7\xd7\xe8\xd7\xf3\x1e\x90G>\xf2\x91\\r\xc9%D\xc2?YyװG\x1ek\x05\xbbV\xd1R\x9e\x96\v=\x1f\x10㜢\x9b\x01\x14\x18ki\xa51 \xb0.P\xe7\xdeC\x1a\xc5H\xe9\x1ar00\xc4e]\xb15\x19S\xd65\xda9\xcaJS\x05m.\x93Rsϙ\tV\x8bF\v\xe5I\x84\xa3\xf4\n ~\xddk\xff\x848\x8e\xbf5\x00\xf9\xde\xef\xfd^\x1erp\xbf\x88\xe0q\xc1\xdc\a\xfeH\xe1\x91X\xb4\x15h'iE\x1e\xe7k\xbc\x8b\xb1^\xa1m\x90\xedT\xdaPiK\x1aGL⊭\xf1\x98DE(\x15\xfa@\x00\xb45{@hKY\x1b\xca\xda`\xadewRs\xef\xe91X\x81D \xf1$\xd26\x8b\xc7\x00\xb8\xe4\x95/\xfe\x8f\xe2\xe5\u007f\xf8Z\xff-\x01\xc8\xf5\xd7_\xcf\x1b\xff\xe8\x86\xfdJr\x04 \x12\x1e%<\xda\v\x8c\x0f\a佧0\x82\xcaBm4-\xeb06\xa2\xb6P\xd65EeH\x1a\x05|\xac\x14Q3wq:\xf3R\x00\xd6\xf9\x19Ge\x9dG[\xcf\xdaf\xc1\xe6N\x85\xf4A%(\x85#\x91\x9eHJj\xeb\xa6fkY\xe7å\xe7}\xecW\xb7\x9f\xf7\xf1\x17\\\x14\xcb\xf8\xae\x0f\xfd\xe2\x8d\xfe\xe8ѣ\xffr\x00y\xccc\x1e\xc3\xf3\x9f\xff|\xae\xbe\xfaj\x9e\xfb\xecg\x01\xfc\x88\x80t\x9a/(\xe1\xc9\"\x81v\x9e҂\xf5!\x02r\b\n\x03\xe5\xd8\x12+K;UTYDTI\x92H\x10I\xc1\xb4\x9f_I9\x13\xd7\xd1\x002\x15\xac\x8e\v\xcb\xc6N\x89\xd1\x1e\x89'\x12\x9eX8\"%Xꥌ+\x83.\x1c\x91ph/{>\x13\x1f\x11\xd6y\"\xf9P#\xec\xaf\xfe\xf0\x1f\xff\xd8\xef<\xf3\xf0/\xf0\xb4\x9f\u007f\xdal\n\xf77- /y\xc9Kx\xe63\x9f\xc95\xff\xfe\xe1\x03\xfbg\xe6\x89\xf6\xbe\xfcɑ\xe0ǧ\x83\x8dC\xd1J\xd2J#\x16RŤ4\x8c\v\x13\x94\xe7\x1e\xac\x978@[\xc1Nn\x19\x97\x8e4\x16$q\xa3\xf9\x95\xa2i5\xb3\x01\x80f\x06\x8a\xb5\x8e\xa2v\xe4\xa5%$\xf8\x1e\x85'\x96\x01\x94$\x96,tS\")H\x1aT\xa7\xdfGE\xe2\x9a\xe9\xc8?\xe0\xb7r?\xa9>^~\xe2UK\u07fd*\xf2\x13c?\xbem\xf4\xcd\t\xc8\x1b\xdf\xf8F^\xf6\xa6\x97\xf3\x877\xffя\t!^\xe5\x8c_\xb1i\xa0ͽm\xf6P5\x11\x8ekN\xa4ۊh%\x8aI\xa9\xc9+\x8b\xf3\x96\xda\a\xd1\xf4t\xae{a!\xaf\xdcYU\xc8\xe9\x04\x1fw\xce&\xc9i\xe4$\x1b\xa7\x1d\x11\xe8\x94v\xaa\x18t\x92Y\x9b\xc3t\xe7\xc8t\x00\x9d\xaf<\x94\x16:Q8')~\xef\x93\xdb\u007f\xb7&jޥ\xdaQ\x86\xa4\xe0\x01\xb8(\x0f\xe8\x1e\xc3\u05fe\xf6\xb5\xfc\xda\u007f\xf9\x0fb\xddo\xbcPH\xf1\x16$+\xberx!\x10\xd1^\x90\xe9\xf78\xf9f<\x92'Q\x82~;ae.\xa3\x93*R\xe9H\xa5%\x15\x8eD8b\xe1\x89qH\xef\x91͘&\xe1\\3!\xa8I&E\x00\xa1%-mi\x03G&\x05\xf3݄\xb9n\xdaP-\xd3\x1c\xb1Y\x166\xfd.\xda\xc1h\xafwPH\xa1D,߸\xfcC\xfb\xff݅?t\xb8\xbc\xf1\xbd7\xd2\x1b\xf4\xbf9\x009t\xe8\x10\x1f\xfe\xf0\x87\xf9O\xef|\x91(}\xf9b\x99\xca\xdf@ \x85\x14\xd8\u0086a.\x99\x98\x81\xe1\x05_30\f\xa2\bb\x19\x0ej\xae\x93\xb0\xdc\xcf\xe8\xa4\x11\x91df\xff\x93\xe6\x8f$\x98 %\x1c\xb1pdҒ\xc9\xf0\xdf\"\xe1P\xa1\x1b$\xf8\x8b~F+\x8d\x98ε\x9e\xb6GL\xfd\x90<\xeb\x9b\xc8a\x8d\xb4\xa1\x8dB&\x12\x99\xa8\xd4{\xff\x9abR\xfc\xf1/\xfd\xd5/\xcf\xddv\xebQ\xae~\xf8\xd5\\r\xe9%\xe7\x97\xc9J\x92\x84\x17\xbd\xe8E|\xdf\xf7}\x1f\a\x0f\x1e\xa4\xdf\xefs\xd1\xf7\x1e\xceD\"~[&\xf2\xe92\x91B6\v \xddTH\xd0Q\xf8]\x1dDo\r5>\x05\xc69\x88\x92\xc0mI\xed\xd1\x16b%X\xe8\xc6\xd46bR\x1a\x8a*\xf4\x9c\xa8&B\v\xc0\n\xd4t\xbd\x85\xf7\xb3yZBB\x96E\xf4ZI\xe8M\x99\x82!\x05q\xa4\x88\"\xc9hR5\xa6oze\x05\xaa\xd4\bmC\x1d?k\x8e\xca#\xbd\xf1?\x8b\xe0\xfaǽ\xe2{^(\xbe\x87\xf7\xbf\xfa\xc7^]\x1d\xd9\u007f\x84\xad\xad-\xbe\xf0\x85/\xf0\xf6\xb7\xbf\x9d\x0f}\xe8C\xdf\x18@\xa4\x94\xdc\xf8\xfe\x1b\xf9\xf9\xb7<]\xfd\xbf\xbf\xffG\x8f3C\xfd\xedzK\x0f\xbcs\xff\x13\xb5x\x985\x8e8\xeb\x10wb\xaaQ\x85P\x02o=6\x93\xe8\x96B\x95\x81\xb1m\x14:\xb3\x9d\x84\xd6A\x1a\tT\n\xb1\ru\x92\xda\x04S\x16wbzYDm\x1c\x85\xb6\xd4\xdaa\x1d\xb3\xa7^\x12nWP\x1dJ\x92$\"R{\xc6@\x8a\xb0\xf93\x89\x14\xedvJU\x9b\x86%n\x06\xa2M\x1f4\x0f\xae\xb4\x98^\x8c\x8c\x04X\x8f\xd7n\n\xcc\xe5\xc0;\xbc㎟\xfb/\xbfp\xa33\xfeD\xeb@\xeb\x96x1\xfd\xf8'\xde\xfa\xdfF\u007f\xf8\x87\u007fȯ\xfcʯ<Ȁ\b\xf8\x8b\xbf\xf8\v~\xee-\xbf\xd0\xf2\xf0g\"\x92?j\v+\\ig\xde4\xea\xc5\xc8T\x86M\x02RP\xf4\v\xf4z\rBP\r\"\x92\xc2\x06\xba\xbc\xf1\x1fA\xc9\x18\x0e?\x8d\x04JH\xb2,\x88笃\xbc\xb2\x14\x95\xc5KHcE\x9a4\x93L\x9b)\v\x92\x10\x19E\x92s|\xc4\xf4\x15G\x8a~'\xa1\x9dF\x8d\n\x1e\n\x1bx\xafi\x8015]\xf1vI\x1eIh\x87\x8c\xdd[\x8f-\xedמ\xc1\xa5B\x89\xe7H)\x88z1H\xb1\xf1\x1d\xafy\xfc3nz\xfaG\xdf\xfa\x95\xaf|\x85\u05ff\xfe\xf5\x0f\x9e\x0fy\xff\x8d\xef\xe7\x99\x1f\xf8\xa58j\xc5\u007f*\xe0\xc7\xea\x8dJ譽\xaeT\x11K\xa2~4[\xb1\x1a%\x11\xed\xfdm\x88\xc2sh[\x8a\xaa\x13\xe1\x11\x18\x0f\xf5Y\xf1\xbdq\x01\x14?{\xaaC\xe7\xee\\'ae.e\xbe\x97\xd0J\x15Q3\xac&\x8d%i$\x88#I,\xc3<\x94\xb3\xc1PR0\xe8&\xacη\x99\xeb$\xa4\xb1\x9a\xcdO1\xd6Q7\xa3\xc6\xddY\xb7\xacT\n݉\x91\x99\x9a\x81a&a\xff:vo\xeb\x82\x10\x82\xb8\x17#\x94\x04\xfc\x92\xd9\xd5\u007f\xf2\x88\xfft\xedc\x9e\xfd\xecg?\xf0N\xfd9\xcfy\x0e\xefx\xc7;8u\xea\x14\xcfx\xf7/\xa5B\x8a\x1b\xe24z\xaa+\x1d\xd5Zy\xce\xed\x89\xe7\xe3\x99\xe3\xaeC;1\xd9 #]IC\xd2'<\xc5bD\x1dI\x9c\x17h\xed\xc2$\xba\x86\xb1\xadL\x10Y[g\xcf\noC\x88\xdaN\x15\v\xbd\x84\xc5~\xc2|/a\xa1\x13\xd1o)\xba\xa9\xa2\x95(\"մ\xa5E\x92n\x16\xb34ע\xdbJ\x88\xa2)#\xecf\xac\xb01\x8e\xaa1E\x02\x88\xa5G\xac\xa6\x8c\xae^$Y\ts\xb3\xecXSoU\xb3m>͛@\xa3\x9a\x91\xad0\xfe\x0f'\xb0\xa5\xed\xe2y\xfe\xff\xfe\xf6\x9f\xe7\xa9O}\xea\x03\x03ț\xde\xf4&\xb6\xb7\xb7\xf9\xe0m\x1f\xe2\xd9o{\xeeҷ\xbd\xf0;~\x0e\xc9\xe7U\xa2\x9e\xa6\x12Ey\xaah\xbeh\xd0r\xcaT!⦹ӆ\xe9\xa2\xd3\xe9\x0e\xbd\x83=T[\x85\x01.JP\xcc'X\x19@\xc9\xcb\xd0{\x182m(j\x8fq{&e\xe6\x90\x1bp\"%g\x92\xa04\x96dI\x00\xa4\x93\xc5\xf4\xda1\xbdvD+\x8b\x88\xd4^\x8f\xa3hj'\xd6yj\x1dX\xe1\xa2\n\xa0g\u0093.\xc6L\xae\x9c\xa3}\xd9\x00\x99\x83\x1fY\xf4\xaef&\u007f<;$\x9c\x0e7kG \xc3>xo=\b~h<\x1a_\xf5{\xbf\xf7{|\xecc\x1f\xe3\x86\x1bn ˲\xfb\a\x90w\xbd\xeb]<\xf7O\x9e\x97<\xe4\u007f\xbe\xe2\xff\xd8,\xb6\xbf\xe0\n{\x02\xc4\x1f\xc5I\xf4P)%\u0083\x197\"e<\"\x12D\xfdxV\x9f\x98:\xc2:\xafQ\xb1\xa2\xb5\xd4f\uecb9\xb0K\xcaCّLz\tNH\xb4q\x8d\x93\xf6A\x99n=\xb5\xf6\xd4Z\xe3\xbeF\x87|6({\xf9\x84\x9f\x95\u007f\xa3(T\r\xa7\xb7B\xccj&\xe1}\xf2RS\x14\x9aQ\xa1\x9b^Bp\xcb\x19\xbb\x0f\x19\x90^=G\xe4%\xd4\x1e;\xd2x׀\xe1\xce\xfd\x0e\x1eO\xb2\x9c\"S\x89\xb0\x029v\b'\x00R[۷<\xfaY\x8f\xfd\xe1\xff\xf5\xd5?}\xed\u007f\xbe\xf9\x95\xe9=\xc7\xee\xe1\xffό\xfd\xa3\x80\\p\xc1\x05\xfc\xe4O\xfe$\x1f\xfb\xd8\xc7x\xfa\u007f~\x86\xf0\xc2\xff\xae\x10\xfc6\x82\x87#E\x8a\xa5\xb1\x99\r_\xeb\xf6F3}M\v8\xae\x0e\xbd\xe4F\xdbf\xa3\x00\xf4\x0e\xf6i_\xd8\t\xcah`2\x88\x18\xb7\x13,\x92\xbav\xb3\xb1H\xd6yJ\x13\x9cv\xad\xcd9\x99\xf9٠$\xb1jv\xa5\x87\x9d\xe9q3\x01H\xaa\xbdZ\xc7t߇s\x8eQ^\x93\x17\x9a\xa26\f\xf3\xa0\x847\a:l<d\x0eyـ\xac\x93B\x15\x9ev]\xdbp]\xdd\xdf'\u007f\x93\xa5\x8cx>E8\x81\x9cx0M\xfd߃\xd3\xfe*\xef\xfc\xbb\x10|Z\xb6\xe4\xed\xdf\xfd\a\xdf\xf7\xa4t_Ƴ\x9e\xf5\xac\u007f> \xcfx\xc63\xb8馛\xb8\xe5\x96[\xf8\xec\xe2\x17\xc5S_\xf1\xbf\\lb\xf3'\xc0/\xcclx\xaa\x88Z\xcd`\xe1f\x01\x8aJ\xd5\xde\xfdm\xe6\x9c\xece~\xe0\xaa M,\x87Ͷ\x01\x01+W/\xd3:\xd0\n\x89\xa2\x84\xe1|\xc4$M\xa8\xac@[0\x8dS7\xceSj\xa8\xb5\x9d\xb5\vLM\xf8ٗFI1\x9bor\xce\xf0}\x11\xb82\xd5l\x93ޝԌ\xf3@\xc7o\f\xab\xf0\x19\xfb\xdb\xec\x1c\x19\x90\\>G{\xb5\x83(\u008d\xa8\x8a\x1ao\x1c\xff\x10M\x92,g\xc4\xf3\t8\x10\x13\x8f7\x1eg,V\xdb\xf0sc\x06\x85\x14H%\x0f\xc9X\xbe\xfd=\x93\xf7\u007f\xffK_\xfaR^\xf0\x82\x17\xf0\xb0\x87?,X\t\xf1\x0f\x84\xbd\x97]v\x19\xbf\xff\xfb\xbf\xcfs>\xf8\xcb\xe2Co\xfa\xabk\xbc\xf7?Uo\xd5Op\xda]\xa5R\x95M\x0f\xd8i\a\xaa\x01`\x8f\x19$j\xc5\xe8\xb1ދ\xe6\x9bM;\xd3ɞ\xdeL\xb7\x17\x18ʝ\x82\xf6b\a\x19+\xf6_\xbb\x9f\xd3r\x8dɱ\tV\tv\x17c\xc4\x06\xc8ʠZ\x02a\x1cQ$\xa9\xadGj@X\x04\x90\xc4\xd1Y\x8c\xee\x9e9\x12g\xad\xb7\x98\xde$ٌ\xd8@\xc0֨\"/\r\xb5\xb1l\f+\x8c\xf5\x98\xd5\x16Ç\xceӾr\x9e8\x8b\x11U0Eu\xa5ѵٛ\x98}\x96\xadLV\xb3\x10\xe6ZP\x13\x8f\xd7͚\x8e*\x98?WY\\\x1d\xc2\u007f\xb9\xb7\u03a2\xa5\x12\xf9\xe7\x8f}\xf9w<E\x96\xe2o?\xff\xc9\xcf\xf8\x9d\x9d\x1dN\x9c8\xc1-\xb7\xde\xc2_\xff\xf5_\a@\xae\xba\xea*\xde\xf2\x96\xb7\xf0C\u007f\xf4\xa3\x03\xe0e\b\x9ef'6\xd6;{\xdbi\xa6\x87\xea\x8d?g\x90W\b1\x04Qk\xaf\x1e1\x8d\xd9g\x804;E\x84\x10\xe8\xa1\x01\t*Vd\x83\x8c(\x8b\xb9\xe0;\x0f\xb2\xde9\xc3\xf6\xadۘH\xb0\xbd\x1cæ@֖v\x02\xc2:\xbc\x94T\x06\x84p B\xf4\x954\xab\xbe\xf7(\xc4&\xa8h\xa6DL\x97\xdc˦\x9dzg\\Q\xd5\x1ac=\xa5\x0e\xbd\xefՁ\x16\x93G-ѻb\x0e\xa1AT\x8d\xd6\u0604\xfd$_\v\x86\x88%\xe9\xbe\f\x99)p '\x1e\xaf}\xb35!̽\xf7\xc6a&!\b\x10\xaa\xe1\xed\xa6\xbb\x17\x95XP\x9d\xe8\xbf\xfa\xcc\u007f\xf1\xb2_x\xe8\x1d\x93\xaf\x8eޣPo;\xf3\xb7\xa7\xdd\xe7?\xffy\xa2V\xab\xc5{\xde\xf3\x1e\x9e\xf8\xfbO^\x02ށ\xe0\xbb\x00̰\x0e\xce؟]\xf9\xf7{\x83 \xa7\x94GS\xe8\x89;q\xb3`~zC\x1cS\xaa\xce\xcf\xecK\xf8\x82\xf5f=\xdb\xf5\x04\x82\xa4\x93\xb0\xff\xb1\a\xc8\xe62N\u007f\xe64\x16\xc7\xd6r\x8c\xd9\x15\xf8\xda\xe2\xf0d\xc2a\x9c\xa4\xd0\xd3i\xa0\xa1\x87|&\t\x9a\xfa\x95\xaf9@)\x04\xda8\x86y\x8d1\xa1\x9e^\x1b\x87\x89\x15\xa3\x03m\xaa\x87-\xd0=\xd2C\xe4\x1e\xe9%\x1e?[vو\xbdf\xbeC\xa6\x8a\xf4@+\x1c\xb0\x039\xf6\xa0C\xe8\xeblؙ\xe5\x8dǖ\x0eW7a\xb4\n\xbf\xa3\xca\xd4\xd9f4Aqm\xef\xd2\u07b52UO\x1d\u007fe\xf7qK߱\xfc\xfc\xd1ѡV7\xdex#\xdf\xfe\xb2\xef: <\x1f\x01\xaeE\t\x84\x12\x94\xf7\x95!\xf99;\xa2\xb0\xe1\x86$\x8b\x19I7\x0e\xfb\x00c\x15\xbadKKq\xa6hn\x05\b)\xf7\xcc\xda4\xdcT\x02\x95H\xea\x9d\x10\xb5\xf8\xe6\xf1\x13\xcd\n\xeeξ\x0e\x9d}\x1d\xccDS\x8dk\xaa\x96\xc4\"\x10e8\x13%\xc3\xc3`\\(,\xf9f\x84\xec9\xe3\xdf\xfd\xb9`T\xb5cT\xe8i\x03\x0eU\xed\x98(\xc1\xda%]̷-\xd3:\xd0EL<\x92\xd0eet\xd86\xea\xa7\x1e\xb6y\xb0\xa3~L\xb2?\x9bE\x86\xe7\x80\xe1\xc2\x12\x02g\x02\xb5\xa2G\xf5\x9eϑ\x82x\x10\x13\xf7\x9b\xdb,\xce\nJ\xa4 \x99KD\xba\x9c>V\x8f\xf4\x13\xa2n\xf4Q\xf5g\xf7\xbe1\x8a\xfb\xc9_b\xfd#\x11 3\x85\xab,z\xa3\xfa{O\x9b\xb7\xc1\x8b\xa6+\x19I;\x99e\xa9*\x89\xf0\xd6391nf\x11\x82L\x14B\x85+.\x85\b\xc3g\"\x19\xae0\xa0w4\xa8\xa6\x13\xd7\x06\xd3\x16%\x11\xe9|F{\xb5M\xda˨v+\n\x11\xe8\fS\x80u\x828\xb4\x91\xa0\xado\x88B?+\xd9\xce~jnJQ9\xca\xda\xccr\x8e\xbc\xb2\x8cc\xc1\xe6\xa3\x17\x91\xd7-\x93\xb6\x12D\xeef`\xe8\xdaP\x95\xf5^\xa2C`y\x93\xd5\x16\xf1\\2\xbb\x81r4\x05#\xf4\x97ԕ\xc6\xd4\x0e\x8cG\x0f\xeb\xe6\x9c\x1a\v\x95JZ\xfb[\u007f\xcfy\x9f\xfd\xb3\xca\x14\xad\x83\xedC\xf1\\\xf2\xef\xa2Α\ue2dd\xf6\x8f\xb56\xe4\x0f*S蝚\u007f\xa8\xf5P\b\x81\x97\xe1\x06y\x15\xa2\x10k,1\x10\xb5\xe3\xe0\xc0\xa4\xc0\xe90D\xd2\xeb\xa6H\xdbT\x9f\xbc\r\x05\a\xd5Q\x88\x91\xa1ެ\x03i\xe7\teQ\x1f2\xf9d\x90л\xa8Gk!ctr\xc4\xd6\xd1M\x1c\x027\xb4\xe8\xc21\x97\x06\x9ekT\x1a\xb2X\x92D\x8dO\xf12$g@U\x87\xbeA\xe7\x02%\x93ז\xa2\xa5\x18?\xf1\x00\xd1\xe1>\xe4\x0eQ\xf9 q\U0001eaa8\xc3\xdc\xfa\xb3\x80\x88\xe6\x12\xa2\xce\xff7\x16D\xe0\xc1\"\xe3\x17\xb4\x81\x03=\xe0\xff\xf7\x0f\xc8\x15O\u007f\xbe\xfe\x864z\xfe!\x8dV\b\xb130\xb20a4\n0\u0096\x89\x91\x81C\x9c\x83\x0f\x00I\xa7\xa0{sc\x93a\x00\x00\x00\x00IEND\xaeB`\x82")
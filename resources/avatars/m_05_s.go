@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_05_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00+MzTXtRaw profile type exif\x00\x00xڭ\x9ci\x92\xe46\x92\x85\xff\xe3\x14}\x04\xecp\x1c\a\xab\xd9\xdc`\x8e?\xdfCd-\x92R\xddj\xb3\xa9\xac\xaa\xdc\x18$\b\xb8\xbf\xc5\xe1\fw\xfe\xf7\u007f\xae\xfb\u05ff\xfe\x15\xbc\x0f\xd1\xe5Ҭ\xf6Z=\u007fr\xcf=\x0e\xbe0\xff\xeb\xcf\xf9\xfa\xfc\xf9Y\xf0\xf9\xfd\xff\xe3O\xf8\xfa?\xb8o\u007f\x11\xf9\x9c\xf8\x9c>?l\xf9\xeb\xb7\xe9\xeb\xe7?\x8e\xaf??s\xa2o~\x11ʟ^\x90~^&\xfe~\xe16\xbe~\x1e}\xfcÈ\xfa\x8c\xd5\xff\xfe\xc7~\xfd\xbbw۽\xe7sw#W\xa6\xa1~n\xeas\t\xf7\xe34\x1c8\x99\xa5\xf4^V\xf9h\xfc+|\xdd\xdeG\xe7\xc3\xfc\xf0+d\xbf\xfd\xf2\x93\x8f\x15z\x88!\xf9\x1br\xd8.\x8cp\xc3\t\x9b\xcf+,Ƙ㉍\xcf1\xae\x98\xde\xcf,\xb5\xd8\xe3J>\x85\x94\xf5\x11nl\xa9\xa7\x9d,Ŵ\xe2I)e\x97\xe2ϱ\x84w\xdd\xfe\xae\xb7\x82q\xe5\x1d84\x06N\x16xɿ\xfdp\xff\xe9\x80\u007f\xf2q\xef\xf2\xccQ\b\xdc}\xf8\x9a+\xc6\x15\xa3\xd6!h\x1a\x93\xfe\xe70\x16$ܯu+o\x82\u007f|\xfc\xfc\xe3~[\xd8\xc4\n\x967\xcd\xc6\r\x0e??\xa7\x98%\xfc\x8a\xad\xf4\x02 q\\\xe1\xf3'\xbeB\xdbZ\xb5\xf8\xa2$s\xed\xc2`Bb\t|\r\xa9\x84\x1a|\x8b\xb1\x85\x90S4\x16h0\xf2\x98r\x9c\xac@(%n\x06\x19sJ\x95\xb51\xe2\x88k\xf3\x9a\x16ޱ\xb1\xc4\xcf\xcfI\x15֧\xa4\x9a\x1ak\xd3\xd3`\xb1r.\xc4O\xcbF\f\x8d\x92J.\xa5\xd4Ҋ\x95^\x86\xab\xa9\xe6Zj\xad\xad*\xe7FK-\xb7\xd2jk\xcdZoÒe+V\xad\x99Y\xb7\xd1cO\xa4d鵷n\xbd\xf71\xb8\xe6\xc8n\x94\xc1\xab\aG\x8c1\xe3L3\xcf2\xebl\xd3f\x9fc\x11>+\xaf\xb2\xeaj\xcbV_cǝv\xdee\xd7ݶ\xed\xbe\xc7\t\x87Pr'\x9fr\xeai\xc7N?\xe3\x12k7\xdd|˭\xb7]\xbb\xfd\x8e\x9f\xab\xf6\xb5\xaa\u007f\xf9\xf8/V-|\xadZ|+\xa5\xe3\xda\xcfU㧭\xe9D\xef\x14A8S\xb4f\xacX́\x15oZ\x01\x02:jͼ\x85\x9c\xa3VNk\xe6{$+Jd\x90Ek\xb3\x83\x1f.T\x960\x9f\x10\xcb\r?\xd7\xee\xd7\xca\xfd\xe3us\xcc\xf5\u007fZ\xb7\xf8OV\xcei\xe9\xfe\x1fV.\xba\x93\xfe\xb4n߬\xda\x16\x12\xae\xb7b\x9f,Ԝ\xfaD\xf6\x9d\x9a8\xc1\xe5+\xb7춚\xf4e\xf5|\xcd\xe7\x9b\xeb\x9a\xe5\x94\xdcv\x9d\xbb|\x8e\xfcq\xd8\xe7 \x8e\xfa:\xa8g`p\x87<\x9d\x8d\xbc*\xebb%d\xfe\xb2ڹ\xc4\xf7\x05\x13\x16m4\x00m\xae\x1b\xc7j\x81\xc9\xc9}\xb5|z^9f\xa6\x94\x89\xaa)ؚ\x82\xda\xda\xf6\x99m\xac\xb1\xf2\x1e#\xec\xb5{\xabgZ\xe2\xa5%m+c\xb0,\x93H\xa8\xa0\xf5J\xa7F\xdfO\v\x9c\xb2ܱS\xad}\xad\xee\"\x99\x1e\xdb\xf5\xa3\xe6\xd4Zl~[\x9a\xdc\xd48e\x0e\xa6\xa92\xff\x8c\xfd\xaeůKL\x9d\xa9\xb6\xde@d\x1bլ\x965\t\x0fXdpɽK!\xf1\x173\xc9d\xfbu\xf3\x989ێ\xbd\x83\x17\xfd$f\xdab\x9d\xb93M\xdc\xf6n6\xd3\x06v\x18\xe2b\x95\xce\xd8\xde\x05\x16\xf2\x9eVB! ZfvǺ%2\n۩\f\xe6#\xad\nM\xe5\xd1\t7\xce\x18VMƌ\x97\xb1w\xaf R\b\x04Jq\xb5\xadY\x0f\x13\xd4\x0e/\x83Lb/\xebrl[\xf9\x8eB\xb6\xd5\xeb\xe7^+\xb4\x1bʁ\x8cj\x0f+\xdd\xca\"\xc4A\xb4\x9e\x05\xab\xecM\xf6\xcf\x16f\xf7\xb70\u007fe\x85:\x89\x8f\xfcnx\x85a{\xec\x9b\xe7\xdd}\xa6\xd0zmDs\t\x87$ݤ\x10\xaf\fg\xaf\x93o\xea\xee\xae8\xbb\x11\xcf{.\xe6\x14J\x9c\xc5\xef\xc4Xb\xbc1\xec}ʲ\x92I\xb9\xe8G?B\xdb\xc1t\x8c\tE\xce\x1b\xda:\x93\xe48Õ\x13V\x8fE\vU\x89\xa4\xbasfR\xecFn\x83qyV!\xa7I\xfe\xfb\xdb-\x1f\x1f\x0f\xb4\xb3\x9aR\x98\xc4j)\x8c٠\xe0䮷k\xb3\xe5\x91\xc8R\xbf9e\x9e\x15\xb6\r\x93\xf5X\xf3\x142v\xf9\xa5\x90=\xbe\x9dY.\x17k\xddo҉\xb8\xb13\x99\xffT\xb6\x9b3eru\xf9\x9af\xe9\xa1fn\xee\xceM>$\xdb6\xef\x81\xd0y\xd1<\xbd\x9c\xb9\xb3\u0557\x00\x05@\xfb\xe3g\xf7\xf3\a\\\x1e\x15pyin\x93/ɧ\xe9\x01\x9f{\x19l!\xbe\x88\xcfR\xc8\xe3\x91\xee\xe1\x1b\x1d\xf2u\x80\u05ed\xbdc\xc6\x02\x1d\r\x98\xb5\x11a]\x82\x96\xcb\xe7\xfa\xf9:\xe5\xc9@\x98%p.\xf5\x15#ayH\xd5\xca,\xb3H\xc1Ȯ\x18\\\x02\x00I\"\xe1\x8c-\x96Q\x00\x02\a1*\x0f\xac\x92\xdeD(\xaf\xe7$\x8d\x05#\xd8/\x80Ww\xe4\n\xd3\xfcd\x1d\x99q\x03\x8f:\xf3\xc6\x1f\x02+\x12\x91\xb5\xc3ʃ\xfc&\xa7\x1a\x01\x91\x13K>I\xa0@X\x91\x89cW\x83k\x05\x15ۧ\xb8\x88\x1d#\xfc\xb8y\xb7\xe6\xea\x80o\r\xe1\xa6R{\xce\xc3G[,`\x1c= \xe1\x90Lk\xfaA\xc8\xfa\x03(\x16\xf2\x94O˦\xe04\x12\x9f;@\xecV\x1d\x18\x9a\x18\xfb*|\x01Ƀ\tD\x15\xb3\xbb6\xa7\x050\xf6\x06\xe17\xdf\x13\xe6\x83\xd0\xf1\x1c@\x16.\x12\x8cs\x93q]\xd3p\xcc%®\x1c\xb0\vxN\x97\x10J\u173d=\xa8^\x13iY\"\xebI\xa8D\xd8\xec\bnfI\x8c\xdf`\x8b\xbd2\x81̤\x91\xcf\xe66\xb1\x9f\xa6\x96\xd0\xcf:P\x1b\xfd\x06\xf0\x9c\x014\xaezs\x02l\xd7ڄ\xe8ތ\"7\x92\x91[\x80\x91;\xd8\x1a\bw\x92!\xec\xe2 \x0fM\xbc\x16c\xdc\x0ef\x84\xcd\b\x98.VnM\xe3\f\xd0\n\xb9\a[5b\xb2\xa0]\xd0=\xd0\x13S\xcb\\\x02\x00i\xc0%\xc95R\x94\xdb\xff 6H\xa7H\x824\xfe\xfc\xf9\x92\xf9_|\xf1=]8QA+gpG\x00\xeb\xdfG5bux\xce\xcat\x10`\xc4(\xc8%*\a7/`\x9a\x1c\xe4ƫ\x9bP\xa6\x88d\x0eb\xe1\xf6igK\x94Ep\x92\xc5\x1e9\x86\xc9\"\x12\xb1Dm]\x1b(%\x8eYD\xe0\x8fu`a\x1d\xe9\x0e!3\x8d\xb77\xe2\x16\xde\a\x18\x99\x97J(W\"/\x9b\xb2\x9e\xc09\t\x9a{\xe86\x02\x00&\xf0\xc9\x17\xb8\\\b\x03؋\x13\x91\xf0srw\x8c\xa4\r\"\"\x99\x88\x83\t\x01\xc7[H\x84\xcf\x00\xa3\x88bo-\x15\xe6ux0\x98+\x903q\xec\x81\xce\x1a\xd6IZR\xa6\x85k\x9b/^\xb8Ϊ9j@41\x83\xbez!:\xc8z\xe2-\x97\xb5`\xf2\xc2\xc2\x1c\xd0\xdb \x82\x83\bb\u061c\b0'،\xc0\xf6\xa7Ch\xcc\x1d\xa8[\x1a\x19w\xb3'\xd6[b\xba\x99\x06\x80\xd2n\xdam*\xe1\x16w\xc1Uw\x8a\xcc17\ue412,A%\xc0\x8d\xa0\x1e\x97q\xe7x\xad\xa3\xb8\x18vL\a(Es]\xd1z%ۦ\xbf\xfb\x86\x9e\xe2\x9e0j-x- |N\x87\x81\x8cy1\xfa\xac\xc1@\xcd\xe4e]\xe3T\xc18T\x14N\x9aDSY\x998\xee$\xd9^p\vg\x81\xe5 \x13[\a\xa2`(\x8eCk\x9bQ\xb8}\"\xac\xd8,U\bDg9{ր\xca\xf3g\xb4\x066!\x01\x94l\xe1\x9e\xd3\xc7i\x00e\x18$b*w\xafK\xaee\xfc\\\x82\xb0\xf8\xdf.\xf9\xbc\x14\x1b\xd7\x1f\xe4B\x91Q\xaa\x83\xe0\xdf\xe0\xec\x9eP?p\x94X\a\xceo\xbc|'\xa2\xbd\xdcT\xb7\xcb\x01\x1d\x94\v\v\x05Rq\t.\aWć\xc4a\x12\xadi%؟\x84\xbb\x81q3+\xf0֎/E\xa4&\xc9o\x00\xafo\x04\xbb1h\xa6X\xf9߉g\x8eW\x12\xbe\uf6d2\x06\x03\xf7\xa4U9\x9b\xb1\x1c\x05\x13\xdf\xc0\x90\x10\x01\x96\xf1 \x15\xa1\x19n\xad\xf0=$H\xa0\xc3\xe7-wP\x01xxY\xba˻\xc0J\bk#\xbc\x0e(\xf1\x19.\x83\xbd]\xda27\x02\xb2\x01#\xd0ϼ\x91\x83p\xaa\f\x1a\x89B6O^\xca\r\x8f\bZ\x91\xc6\b\x96\xe1\x83\x19\xcc\x1c2PC\xa8\xb1\ue2e0\x0f\x8f\xf0ȥΉ\xec\xcd\a!wY\x81\xf9\x81\x87\xad\xf0\x964,p\xef\xe3<n\x19\x95J~\x8f\xef\xd0a\rwKz\xe2\xf5\xc25\xe5\xa1˖\xe5\xd5i&\x93\x85\xf8\x90w\xe0tBD\x10[\xb8U\xd0\xd3\x04\xa4\x11\x04d/\n\t\x85\xe5\x06\xab\x15\x11H\x15*\x82\x12<:\x86\xd4Z\x01\x8b\xb8L\x8e\x1c\xb0#\xf8<\xf2kΉ@E\xedD\x96\n\xf0\x061\xb0\n\xc4+s1p\x90\x8c\x17I6 8\x0e$c\x18\xd7!\xee\x8cH\xcf\xfa\x92S\xe2\u007f\xd1(\x85\xf0>\xfbJ\x9c\xb2\xbe\xf9\t\xcdѪ\xb29&\xc7=\x1eT\x03X\x04\xf3\"\x1eqZ\xfa\x1d\x89\x92aƜ'\xa8\xcfo\xd7e\xcc\xe0\\!s\xaeV\x1e\xb0\xe0\x96\x16z\x90\x93\xd9خ\xdaf\x84Rt9\x12!\xb3\t\xd1p\xfd\xa8\xbf\xbb\x8eHr!\xb4P\xc2CZ\x1c\xa1\xb9ꮢ@\x12\x11\xd4\xc5\xe5p+x\\\x97\xf7N8\"\xe6/\xc2وa@D2\x91\x19\xeb\t\xc2\fH\x82F\x9a\x18J\x171\x01c\xcc7V\xf2W7\xd0\xd1\x12$k-L6z\xf3\b\x82XF\x90gOΉ\xf1\xb9\x1b̩ϝ-\xa8\x12MŴ3\xb39T =\xa0\xd8\xd1ǘ'\x12\x13\x19\x00\xf8g\x0f\x12\x12h\x151M\x96\"v\x89<\xe46\x9a\xa0Vp\x18\xb1ٸ\xe5\xc8\f\xa1\xc0\xa2ȻC\xc0~\x9em\xa7\xceٯi\x00\xd5!\x9a\xfc\xf58\x05~\x00\x8a0ذY\x1a\xb2\xcf@>\x1f\x93֮o8t\xe2\xc4\xfa\xb0\x8a#\x03N?\xe9\xf8+\x19\x9d\x84\xc0\xafl\x14ɢX\xf1[\xd7>Q\x1e\x11Y\x8a\xe0[\xfb\xfb>#x\x98]))SA\txc\x01\t\xc8y\xeb\x03\xfbO\xaa\x01\xe0\xef\xf7\xd20_G|\x0e\xf8\xfa5\xd9B0\x80ZR\x88̓iHh\x04\xf0\xe8)@\xf8\x13.\x05_\x01=\xf8\x16Z_ \x9b\x8aX@\x16\x03\xc1y\xc1\x89=3\x12\xbe\x00\xf9\xf4\xaa\x0e\xfc\xacw\x9e\x1eޭ\x1d\xc0\xa9\"k\xd0\xec\xe4LB\xbb\xc8i|o\x06\xe5\xf3P\xd8Xc\x999\u038b\xf9\xde\x10\a\x91\xad\x9cLGY\x8eq\x02\xfa\x96\x85dddi\xe0\x03n5\xee\xc1z\x9bx\x95\x8c+\n\xc7\xd4=\u007fYFb7\x19\xbe&!\xc0\x1dF=ݎx\x92\x12\xda&\xf1\xd6\x0f^h\x81G\x1b,E\xed \xd3\xc0!4=@\rTa\xbe\xd0\rG\xfa\x17GS\xf6'\xe9\\\x94\xc3\x02Ы\x00*\x06\xd6D\x04\xcdh\xf92\x88S?\x1c\vA\x8fv\x02q\x15_\n\u008b\xa4\x94\x17e\x97\x82k\x83i!x讉3\xb3jN\x1c\aM\x82\xa9]\xe0&\\\xed\x8aBi\xab\xa4T\xc2\x1f\xb3V(\x84\x83lc,\xca\xc6[\x83C\xa9aq0x0\xd7X\xe7H\xedN\xc4,\xa0\x000\xe0pIH\xf2:<ӌ\xc7\x05\x18P\xddQ\xabЧ8\x9b,\x10j\xbaBV\xa6\xf8\xe1m\u0091\x84\x05\x9b\xe0E/eS\x0ef!E\x80(I\x81H\xf3\x06\xb4@@@`\r\x02\x91\x85\xa6~|\xe3\x1d\b\x81B\xc5\xc1\x82{\x89\xdc\xcdҍ\xc0'7\x8e\xb0\x80VH[\x86@\x9e\xed#w\x8b\xa89\xeda\x1e>\x1c\x9c\xf3\xb1M\xc4\xf0u\x80\x13@FTTE\x0f\xa7\x04\x1e\xb0\x94J\x02\\G\xe8L+p\xfe(ގ\xe0/\x80\xf7Y\xd2M3y\x8f\xff\xe8J\xecz\x00\xf81P\xe3+Q\x0eR\",\xe4\b\xc7\x18P\x88\x8fG\xf4\x01\x1f\fM\x85\x97\xde\xefL\xa4\x02\x86]\xf8\x86oۤg\x99Nь\xe9%\xd6\xff\x96W\t\xe4&W.na\x8cD\xbd\x92x\xffH\xe2T\xba\x8au\x9f\xdf+s>G\x90y\x9fc\xde\x11C\xa2\nM\x80\x86\xb0\xb6?hpӣ\xbf/\xf2\xe3.\x1e\xfd\xa1![R:\u007f2^\xf0\xc1\xe4}\x12\x9a\xeb~e\xf3O\x9d\x8db\xb0s~\xa5\xea\xcfL\x1d\x0e\xafp\v2㥦\xae\x88\x01\xb1\x97\xf7\x9f\x91\xfe\xe0I?\x90\x81,DbD\x91\xd0f\xf6[\xc2~\x82^\x04ƞ\xcec\xcc\x18\n\xdaZ\xa9\x8dBj\x83\x88\xdf\xcc~\\\xaa#qz\xbe\vd\xec\r\xd0\x04\xa4G^\xd7\xdc\x03\xcbQ\x12Nm\x10\xd8=\x17g\x9f\n\x05\xf8E\xa2\x8eQU\xd0A\x90p\xe3G\xb9\xda0C]\xff!\xa8'x\x84\x95'\x8b\xc9n\x94\x02~\xcdH\xd7\x15\x1bJ\x1a\xbf&\x14\xe6\x1b\xcc\xe6\x01\xf5O\x16\x98\x0e\xe6\x1d\xab\x05Q `313\t\x13\x92\xe1Q\x00\xa9\t/q\x16\xc47\a\x80\x0e\xb6\xa6\vJ\x1e\xd2u\x87\x05\xb2\xa0\x9cf\x81\xa86\u007fq\xfb\xd8Jbl\x8c\x80\xe9\n\x1baY\xb9cO\xf8\xf4\x13>\xee\x1a\x02GU\x10\x9fd?\xe3b\xbd\x89: (\xc2\xd0p,\xea\x83t\x18\x92\xe6-\xa3S\x91\xb8\xb5\xa2| :|\x1a6\x11\xfc\x84*\x99\"+\xe2\xee\xd2<\xab\x16\xabu֜yΪ.c\x84Y\v\xa8\xab\v\xb7u6<\x19\xff\x02\xd7C\xfc\f-\xe2\xacs\x83\xfc\x84V\xd5k\xc4o\x8eK1\a\x98\x99t\xc9\x02\x82tb\x84!P\x95\xe0g\xc0P!5\xb4S\x00\xf4\xc2\xf5#\xe3\xcd\x1b`\x92\x13\xd7$q+&\\\xb8\x00\xd3f\x96\x05l\xf4\xa8n\xae:\xe5\x9fN4\xcc`\x12\xa1\x81<H\x15\x15<z\xab^\xd5)\x18\xd8\x1f8\xb5a{W\xf1*\xc6e8\xcf\x01\x858\xe0W\x9d\xc2\xe7\x14p\xf1\xca\xe7@ǪH\xca>l\x92\xc1\x9eS\xc3\x06\x915\xab\xf4\xc3Q\x01\xb9\x90\xb8\xcf\xcdDb\x15\x1c\xe0]\x8f\xc0\x15\x92\x821$%\x89\x17k\xaa\a\x80\x88|1\x88X\xc50\x91I\x84\xfd\x9eۿ\xb3\xb4\xc3\x063W\xbb\x9d\xb6\xb0.\xbes'*\xda\x10/\x88\xfa\xbe\x89\xbe\x8a\x1e!N\x83\x1f\xa0\r\xe7L}\xc3\xe9\x1b\x84\x84\r\xbc\xecJ[1;\xd4N\x0f$\xe0bz\xb8G\x1c=\x8e\b\x03\x85)\xcf|\x01\x05j?\x83[\xc3%]\xae\xb7\x1e\x06`\"\xc7\x12ܢ\x81>\xf0\xe2\xbe\xc5\x17q\xd6\x17v\xfc&\x9d\xf77\xd2\xf9\v:\xc0\xec\xfb\x85\x1d\xed'v\xfc\xd2\x02\x0f=\xd2I\xf7\xb1x\xff\xaeV[㧜{]\xbb\u007f_\xf6ų\xb0ʞ\x99\xc5\x1e\xa7\x02\x0ew\xb8\xab'\x04\xd86~\xe6AW\xb8\xbf\x86=2\x1a2n|\xaa\x8c\x9c̴j\x9b\xca|\f\xf3\x06O\x02f\x1d\x81E\xa8\a\xb8\x15\xb2\x03\xa0\x0f\x88\xc3\xf5x\x05D\x80ؐg\f\xd9y\xd6T\xcaB\xf5\xe2\xc3\x19\xca ~\v\x8eج\x97\xacbk\xc3.\x10Pb\xeaN\x9c\xa3\xd3Q%M\xdc\x1fRjq+B\xd2q\x16g\xd6>ڳ\xe0\xe4\xb740\xc6v\x10F\xc2\x11\xd4\xf7R\xc5$ήb@$(\x99T\xe1}\x95\xca\xe4JK\xb7T\xf1\xb4^\xbbk\"P\xd2ha\xfe\xe3T\xb5\x95\xd1\x02\xacи\xac\x13L\xd6Uk\xe6\xcb%\xd8\xec\x19\xb2ʘ\x05\x84\vG%\x9c\xe0q\vA\xcb\xf8\xb8\xa4G>a+Q\x1fL\xac\x85p:_W\xbc\xca\x1c\xe8\f$\vq\x19T\xd5A\x06\xbd\x02#\xb9\tp \x17\xad\xc6\xe6XT\xc3\x01a\x91:\xf8\xa1#TY\x82\xb77sK\xfe\x16\xd0\x11)\x87ͅF\xa2$S\\\x84/Q\x98 \x13 L\x01wp٫^\x840f\x89\x05b`\x19-~\xf3V\r\n\x11:\x04-L\b?f~\x18S\x9b\xa0\x04'\xc0\xb3LUh\x03\xc4A\xb4\xb3\xb0\xee\x8a\x04\x9f\xdc-RB\x88\x06\x13\x140\xb8\x9d;\xe1\f\xdcs\x8f\vk\rN\xed\r\xad!FF\x92r\u009d\xdc%\x80\b\xfe\x89\xd1\xc7\xd7\xf1\xb9j\x8d\xa0|B\x1e\xf9\u007fd\nHo \xe4\xfd\xf6\xa5\x1d\xbf\x81\xe5Cc\xedK\x9b\xa2\xa23\xea\xe7D\b\x1a\U00091d71z\xb45Q\nD\xf3\x94\xa4*͠\x1aF\xd0\f\x9cm\x1c\x1f ,\"\x9e\xf0\x95\xb9eY:\v\x84\xa5\xbd\x0e\x85\xc6\x10}֪5\xc4(\xe2\x05\xeb\xbf\xcb\x02\xe4\x18xBV\x03m6>5\x18|u\xe5Gw\x8f\x01&\x1fc\xd5\x12~-f\"\x1b#\x05\x1d\x14\xe2\xe8v\xf1\x02K\x8d\x10\x94\fJ>\xe3\xf0\x98[\x1c\xa8\xf4\xc8\xd8\xe4e\u007fp\x1d6<\bH+\x14\x91ì.0\x82Gca\xaeW\xe0\xe1\xca'4\x8fk\xc6D\xa7(v\x8b妼\x90\xb4\xe7\xa8\xcc'\xc3Ȃ\x17\xf4#w\x1cO\x87\xe2\xa4I\x1d\xf0T\xd0\x04\xadJ\x02\xcak@\xb6M5+\x04(\xf3nȣ\t\xcd\x1dRM\x9bS\xd8b\"\x89\xc0\x81\x04P\xc7u)\xaa7\xa0\xe0\xech\xff\x157|&ǲ\xb0pU\xbf*\xe0l\xb0<\x98\xe7\v\xec\x9f\xf7\xdaى\b\xdf}\x10u0$\xc9\xf1;z\xba\u007f\a\x9fߣ\xa7\x9c*\xd0\x0fI\x9e\x17\x0e\xf2@\x9b\xe5\a\xfe~\x98 \x0e\xfdؠ©WzH\xd8Q\xad'\x9cWM\x97\xc3:\x80\xcc8\xfda1\xb0\x84\xd8\xfd\xa8*\xf7\xad\xac*\xb0\xc7\xc0N\x9d/;\xf5\xadF;]\xa5\xf3!\xda`\x9d\x1dn\x0ex\x98\xa6\x99\xe7\xafd\ra\x15T\xbf\xbd\xdd\x13-\xaaB\xcf!\xf3G\x8e\x8by\x11\x0ew\x1e\xcc\u007fӎ\x1ep\xd2{\x98ˑ܈\xfe\x12\xb2>\xfc\xc0GUS\xb5\xcfK\xab%~\x81N\a,\x03\xe2\x8d)\xc5ĳb\xaaT\b\x93KH*\xb9\x97\x11\xb1\xeb\x02M\x00\x06\xfd\x04\xd6\x1dC+\x81!\xd2%\rk\x85PL\x9be\xc0Ŧ\x1a\xb86\xbf\xc8LPN\xf8㉗@\x0e\x1c\x94\x1a\xf1\xe0HI\xe9G\xb0Sz`\xeav\xfb<:\xca\xe2z&\b\x85\xd9\n\"\x06\xecؠ\x8c\x9a\x1eLFL\x9b\x9a\xa9\xe5p\xea\t\xd3\xdc\xc1U\xe3ӓD\x1a\xbf2uE\x90\xd0Md\x17\x8a\xea\xf5\bz\x14,\xd2o\x00l\xaf#\x05\x91\xbb%T\xb9߬zjQ\x85\x1d!:Up'\xd8M\x1e\x01(@1\xe0b*P\x01\x02\x06\xd2a\x0fq=\x990\f\xc7\xe5qvLtG\xd2\b\x12KZ;!\x8fq܌\x03\f\xf3\xf0/\x0e\x05\xd3ѹ\xa3\xad\x1d\x81\x84\x14\x842W\xbe\xaa\x17\xe2\xddIީѯ.\xa6]\tǆ\x00b\tJ$ \x81\xbd3a\x18\xfc\x8cp\x9a\xd0\xc0\x9c\xceN\xbcG\x86\t\x8aZ`\x86\xf2\x921\xa8\xb02\xf9\x82Kd\x05P\xec\\\v\xdb\xc3\xcd\\w\xa0\x8c\xb32\x01<\x1a\x81\x86\x02!!\xb4Oz\xc0\x14\xd8\x18[\xc0\x15\x05g\x85\xe5\x00\x10\xb4\xfd>\xdaC\x80\xe4c@\\Of\xb0\xba\x863\xb6\x85\x82'\x19\v\x026\xe1\x8dq\xf0\xcc\x1b\xc6\xfd\x89\xa9Y$ޮy2\x91@4\xa6dUk\x8av\xe8\t\x96`\xeabq\xf7YI \x84\xc8x[\xb8\xac\x8c\xea\x8f\xeb6\xb8|a\xc1 \x80\xa5\x8a*j\x1d\x15\x93Q\x86^w>l\xbf\x8a\xf7%l\xe1WwG%\xeat\xb2\xa4\xcdD\xe5\x12\x86\x1cZ\xc4\x18$E\xbaʜ8\x88\x89@Ŕ\xc2\uf315ycv\x96h\xd2p\x8f\xf0\xbbw\x93\xc1E\xac%D\xaf\xc2\x19f\x11\xdc\x03X\xc1T\x8dt\xfb\xe7/\xc3\xe8\xf2D \x0f\xa9\xdb^\xf5\x16վQ!h\x9bQS[\x0e\xf4\x9amkiv:\x95\x81\xd6\U00076970\xbd̾*\xe5\xb8u\xc8?\xf8\xd8\xd5&Рe\x96\x86{C\xce\xca\u007f\x91~\xe3`\xfc*\xe2\x1c\xe4\xe0.2RCU\xb0\x88\xafF\x9fthU\x02\xa0\x90\xc1\x1c|X \x80\x05lR\x83\x83\xf8y\xafnD\x04\x8a\xa3\x80\x12\x8eXB\xde\xcc\x19d\xf1sf-\x98\xab\x88>X\xc6\n`k\x80ԭM\x11-屔\x8c\tE\xab\x80\x8f\xd6wU\xd5\x15\xcbg\x0fjg~\xf31\xf8\x01\xbcy6\xa1b@\xf2\x9a\b\x85\xa36\x83\n;1\xb25\xa5\xaf\x91Nog]~]r\xbeD\xbf+s\x14C\xc3ѣ\xefȹ\xf0I\x00\xa4\x11\x04m\xd5c\xf9\x93\x01\xb5\x06\x05Fm\x8f\x95\xf9\xb7V\xda=<\x17>\x91a\xbf\xd7\xcc\xfeX2SrFȯB\xbc\xbfc\xf9\xfe\x89\xe5\xee\a\x98\xcf\xfeO\v\xd2\xdfoº\x1f\x15iR\xa0\xaa\xf8\xa1\xc2\xd9\xdb!>㏯\xf8mOV/H\xf0f\x8eP\x10\xb3\x80:.\x0e+\x88\v\xf3\xeb\x18c&]\xf3\xc2\xc8\x01\x94\x92\x10\xdc`1\xa6\xbc^\x80c\x80K\xa5\xcf\n$\x01\xbfIbk\x017p\x01\u007f<\xee\xc8K\x1a\x9f\x82c\xe7\xbc\xe8H\xfc\xe3\xd1\xfe/\xaa'\x01\xec\xe7\x19n\x11\x83\xdcaY\bK\\\x15@\xa7\rफ़\x00u%\x8c嘨\x97\xa7\xf8w\xe8ޔH(k\x84\xf3MHx\xcf%\xdfVY^ \x04\x84Ww\x17\x80\u07b4\xe6g7w\xdf\x1d\x81\xdd\xe0$\x97S@$h?\x8d\x1ft\x89s\xc4\x16H9ճ\x93\x12A\x00\xf3i/B\xf2\xe2\x03-\xaa\x1c#1\x96\x96\xb3.\x14Jr\xc3|6\xc0B\xa5#D0!\xa3N\n!\xfff\xb6\xb4\x8b\x94YUr\aծM\x9e\xa9\xba\x92\xb1\xcer\x9b\xa6\v@H\x18^\a[\x90\xd4h_\xd5*\xda\n`\v\x8b\x83\x1a\x91\x82V\x91\xe3\xca2\x03\x92xN\x12\xf9\xb48\xa1\xe6\x06\x18I!\xab\xf6\xb5\x01\"\x03\xfc\tc\xae\x10\x80K(c!\x16\x91\x108?\xb0\t\x96\xc4?io\x11\xbd?\xa5rᖄ\x96\xd3\x06:8-\x10V){.P\xd21*\x1c6\xfc wAJ\x91\xe4D\r\xafP\xc7S\x02~\x11\x9a\xc0\xa1\xe2\x9e(\xc5\xf5\x820\x19\xc0ױX\x93\xc6u\"\x8b\xb0\x9c\f\fӥ\xddP\x02\xac\x96\xfc\xa2\x15\xad\x9e\xa0\x1c\x928\xaa\xb2\v;\x1d\xb5\x81lY\t\xd2)\x05)mb\xec\x96W\x99\xe4z\xc4\x11\xd8\xda1\xb2\xaa\xe7\x11\x02I\xfb\xfdy3t֛\xf31\xab\xa42\xebF>\x11.L'\x11\xa6U\xbc\x03#\r\\\xa9f7\x0e\xb7V5&}\xad\x06\x9e\xac@f\xf2t\x04r\x05\xb2/\ro\xe7\t\xb1Lp_ule\xe0\xc1\x8a6@\xb1\x05\xe2\xf3\xcb,$\xe2\b\x9f\x04\x88\xbeV\xbe\xa4\xad\xdc2\xf0\x86\xbd!\x00\x99;\xcc\xf6\x84\xcbTx\xca~\xa3&\xf7\x01\x8acT\xab\x950G{\x14\x18\x93S]\xf2\xac5\x13\x87#\x98ڔ\xf4\x05]\xdb\xe4}Ԧ\x85\x0ebD$>\xdf&\x02f\xf1zҤL\x99\x84\x16p\xd0\xe8\x94!\xa1\xe2\xb4C\x198c\xf2py\x92\x8c\xc1\xa2\x19w\x95T\xff\u009dve\x9a\x82\t͕\r\xbc\t\x88\x1e2j\v\xc8*\xf4\x02֣\x060~8T\xa9\xbfTe\x1f\x98sI\xec\x01\xacp\x16m!4\xff\xd6q\xc8\xe8\t\x99\xba\xc4\x06Ґ\xd58\xe3\xd5i\x11HQM:\x84\xa4\nqS\xdb\xfb\f\xd8\xe3ؒ\xb8\x89\fmXݣ\x92 6\x01K\x95Y\xd8:\xe7\xd0.\xcc\\\xd8\x02ճ%\xba\xf0\x80\x8es\v\xaf\xd7\x05\x9cA\xd5\x0e\xe6CN\"\xf8y\xb3\xb6L\x9a\x1a\xe5~/j\xe4_\xaa\x9c\x89G;\x014\v\xa8\xc5:\u0095\xd6Փ\x15\xcbV\xed\x0fJ\x8e\x1e\x9f\x91\xd1o\xaa3\xf8\xd7:\x00\xcdK\xa1\xbf\x12\xaf\xd4\xcc\x1fw)\xdc\xef\xfa\\%\x8c\x1f\xfa\xfca\xf1_\x14z\u007f-1Ȣ\xbf\xb4\x178\xc1\xef\x957\xc7G)\xfc\x0e>\x85\xf5\xbbj\xbe\xc2\b\x10\x19\xe8?\xb4\xc4Ui\x95Ÿ\xa7\xfeA\xb8\xf7\al\x88\xd1A@\xafW\xd5\x0f\x04@S{+\x12\xa1\xc3<\b\xefD~犔\x82\xd2v\x95忯5\x99[\x03\xd0\x10b \xbbT\xb5oށ+>ΨV\x12O\xa4\x18Y\bp\xa9F\x00\xaa\xa3?`\x94\xf3\x80:EӶ\xcah\xc2s\xf5\xf3\xaa|J\xec\x181;L\xb5\x91\xadͼ\x968W=\x15R*\x82q?\xd5\xfc@\xb4\a4ɐ\xfe\x96\xde\"\x1fU# ֖8\x00\xec\xcd\"\xe8\x01\xfc\xb9\xd7^LfΡ}Þ\xa4j\xd4]\xb7%+>\x9b\x15؆\xa2\r\xfc\xac\"E\xe0\xba\xd09I{X}u7\x90\r\x00\x98\xeb\xabfD+Ȍ\x81%b֛\a\xb5&\"\xeaU\x14\xb1\xfb\xa66H\xa9\xd8\xe1^ [pg\x99\x90D\xa9\x8f.ٱ\xbb\x03\x14x5;j\x0f\xa3!E\x84\xb8\x89\xfc?ĺ\xaa\xd0G\xddK\xa8!\xf5Vi\xe2P\x92\x18k\xecB\xe1U\x8b\x10\xdeWj\xdcI\xedb%X\a\x93\\h\xaa\xb1\xf6\x9d\x90\xf1\x055\xd1a\xcc+\b\xea2\xe1\x03\xeb\x1bYZ\xc4r?\x98\xaa\x89P.\t#\xb4\xfcR\x93\x0eH\x1a\x8a\xe8\xc3wU\x19 ab\x1a>\x89\xb2)hxF\xd1$J[\x80-\xe1[\x11\x1dp\u007f\x18B\x86\xbf\x80W\xe8ߍ\x81\xeaa\u0557j\xefOZJl\xb1\x9e\xb8[C\xc3⫵\xb9\xc2\xd4u\xc5Q\xd4\x16(I\x8d^\xb0\f䑲\xafV<\x9d`\v\xa9B\x02\xecƒr\rB\xb6\xa8iDm\x19|\x8fL\xed\t\xdc.()\xed\x9b\b\x93\"X\"\x02\xac\xc8$V\x04Ź]\xb4\xb7\xb3\xd6\t'\xec\xb5\xdfd\xf5T\x824uf!\"T\x10\x1fW\x95;\xdb$\x9f\xaa\x80\x12\xf4\xa5\xab0\x8f\xf2\xc5q\xe6\x91\xd6P\xa7/\xeb\xc1⳪&T0m\x8e\xc3J@\xe8\x87呻\xaa\xcb?H-i\v\xef\xa3LW\xbc\xdcQ\xeaAݭ\xc4Q\x91\x8c\xf1\xad\xecװJ\x9e\x03\x16\x11ɵ\x81\xf2\x8b\xf3;ځ@&\x96<\xe0du\xcf@G\xb8\xb8\xa9{\xbe\xeaPMB\xbc꺏\aP!\x94Q@\xe0\x9a\x82\xe1\x06m\xd4\x16\x81b\r\xb3\x95\xd0\xd4PŠ\xb0\xe4\xbd!CW\x14\xad^u\xc0\x11\xcbD@\x18.sh1\x81)\"I\x1b\x16\u007f\x11\x9c\u007f-\x1e|\xa77ݷ\x82\x93\xac\bR+\b\xdb\xf0z?#ޛ\x95H\x18tٛ\xb4ng\xedE\xe1\xab\x10,\xc7'\x87r\xc1Ϋ\xc7\x11ם^\x03\x81\x8a(/\x12\xb5(\x1c\xdb\x00q\x9c\x9eW=\x88$Q\x9b2\xe0\x10\x91\xedb\x8d\xfeʲK\xbb\x10\xb9\u007fu7a\x10\xf6f\xba\x12(\x80g\xa9*~l\x800w\xb0\x0e;\xfc\xa9\xa9s\xa2\x14\xb5O\xbb\xd4d\xaa\xa6Am(8f\xb7\f\x94\x1f,\x1bA\x02Bk\xde(\xd6a\xd1%\xd3k\a\"\xe3'|\xf1\xffo\xa7\xa65B/ak\xab\r\xf4\v\xec\xa7\xda\bK\xce\xc8\xd59\xc7*d\xa0G\x1d\x8b\x1brMڗ\xd5]OD\x8f@N\x15\x8c\xa3\xae$5xbD\xb89\b\xeb)\x16\x1c\xa4h\xf2x\x82\x11]U\xc9\xe3\xabB\xbax\vY\xcd2\xa5Ѧ\xba\x13WՀ\xf0\xfaK]\x83\xe2Q\x1f\x15B>\xa9\x0f̒w\xcc%T\x00#v5R'\xb5l\xa0\\\x91>#\xab\xb7\x87\x15AEY\xc4\x01\xd7X\xb1\xa3\x13\xf2k\x15\r\xa1yƎjo\xabB\x9fnǃ\xff7,\x1aT\tؽ\xae\x12\x02R\xbb\x10\xa8\xdb%\xb3\x1f\xb9?\xd5H<\xe0\xbe\x00\x8f\xabk\x87\xd7i&ZP/\xb3*\x11\xdc8ևk)\x91b\xbb\xb3\x12\x1d\b\xf45Tĳ\x8d\x18\tk\n\xb5\xf2\xc5-\xae\x997\xfc\x84\x1c˛o7\xe1\x88+.N\xf0\x18^=F\u07b4\xf5\xa8\x8d8\xb8B\xf1\x0e\xd4\x15U\x8dp\x86\xc4\x1cߡ\xe5@\xbb\x85m%\x03aL,\x01J\xc3k\x0f\xd8\xf5-\x9f\xcb\xd4 \xabj\xa8\xa4^\a\xca\x16\xc1\xc5\b\x00\x1cpgcF\x01͊\xa2\x808B\xeci\xaa\r\x00\x00kQ\xfdHR\xf4\xda]\x97\x15\x9c\x909?\xd4\xf6b}O\x84\xa8?I}5\xc8t\xff0Hs\x11\x89 Rlw\x82=\t/\xf0\xe5\x81\xf9㮦\x83\\\xc1\x04\x95\x19\xc8{F\x06\vM\xfcX\x95\x04Bc\x17\xb5\xe4\x06\xfc\x84F\u05c8\tcu\xf8\x06M\v=3c\xaa\xeck\x01\x1cr\x96\xac\x9c\xabbNPo\xcc\"\xaf˯\xf7\x0e\x1b\xaf\x16_\x9b\x8c\x85#\xd4\xe86\x87\xa9z\x8e\x8f`\xf9u\xa41\xc5U\x1d~\xae\xa4ٙB\xcd\xf0\x1cU-\xef\xc4\b\xa8\xe8q<d\x00a\x01\x0f\xfb\xa8z;A\x93=7\x17\xf8\x8c\xa1\x01\xcf\xcf>\xdaf\x8c\xb8I\xe7IN4\xc3\x1a\x03\x9cA\xf4Ho5\xeek\x01Y\x96\x8aL9\x87\xb3D\xbb\xdb$xe\xba\x97\xdaQ\t7\xb5\x10\x8e\"o\x9e\xb4\tժ\x80\x04\x87\xd2\xf5$\x04 \x04\xe5DӮ\u007fzm\r,\xe6HY5\x16\xeeE\xf7\x8aw 9\xb5\x03\x98\b\xfe\xb0\x1b\xda{8\xc1\x8f\xf6)\xbb\xf8\xb7s)\xd0\\\xed\xdb\x1c\xb0$N\x10\x19Ee\xc4HF\x93+\x04\x10\x97C\xa40\xee\x88\xef\x03\xa3\xd4\xfa\x82ή,H\xd1\xfc]\xc1\"a\xa9\x0e\xba\xa1\x0e10?\x92`h\x0f\xf1\xbc\x10\x8b\xb0\aa\xd5&\x1c\xa5\xf1\xf8\xc9!\x9fQ1\xf5z\x17U\xcf̯+벪f,aP\xcf\fv\x9b\x94\x85\xcc\x17l\x81\xf0S\r2 9X\xd6I\xbcዹ5L\xacz\x12v\x9c\xae[f\xa2\xc13\x04\x82\xfa\xb8P\f\xe45\x82\xc0\xbf]k\x95\x90>\xb2\xfc\xe7F~\xfb}3\xeeg[\x8e\xfbs_\xce\xebc\xfbcQZ\xd4\x12\x1a\xc6\x00\x99\x1d\x94c\x1d\xfd\xf0z\xcd\u0098\x84+y\xd3\xf0\xfd\xf5 L\xe2D\xf2J\xb3\xda\xeb5\x02\xab\xd1t\xa6\x166e\v\xbaR%\xd5J\x9cb\x85\xb3\xf6ZA\xf6\xac\x0epƍ\x84ԭ%\xf5\t\xe3S8\x15\xa1kJ\x18\u007f\xb7/\xd0\xdcվ\xe0\"#z\xe7s\x87\x8bF\x17\xd4n\x15\xfc\xd2+\xc1T\x15+\xf2\xe8 d\x96JR\xdbuV\xdf%\a_\xf1\xba\xcc\xc1\x01dQ<\x89LE\xab\xe1\x1f\xf5d\x05\x93\x86\x1e\x97.\x02\x9eՂ\x0f\x83\x99\f\xaac\xc1\xa2\xf4\xe5Q#OO\xe2\x17\xe8\b/\x1aՋjڑS3\n\x9a\xb1\x82\xfa}5\xafN-\xed&I\xcfvl\xbc\xba\xb1'\xca\x1f\x18o3Ċ5R'>\x14\xbaY\xb5-9\x8a\xf9\xc1\xeb5m\xe7\a\x96\xceԌ@\xf4&5\xdfH\xe1\x90\xc7h*=\xf4\x83\xcd\"\xad%\xb3<\xc6E\xa0\x8eB\a~RxdH\xa2\x9d\xc5\"s\x83\x18̬\x1e\xbc)\x02'ܴ7\x88>\xad\nu2\xfe&g\x0f:o\xecS\x0f i/DO\xfd\xb0\xe4Ju,:y\x88\b\x0fǞHF\xb00\xb0\xab\a\xd4\xda\xf4P\xc5Ԇ\xa3W\xa7/\xc1\xa2\xbb>\xfcE\xf9\"\xad!َ\xebg\xe5\xba\xee\u05eb\xff{\xab\x17C5\xfd=\xa61\xf5Hda\xaf!i\xb2W\xd5'\x1f\xa7[R\v\x01\x9ax2\xa4\x83\x98\xd2n\x956sB~\x15\xd0\xf3\xf6ې\x14\xb0\x01\xb3\x8f\x9f\x00\b\xb4ߧdSi\x87Y\xcc\xddigQ\x8d\x90\xa0vM\x86\x80U\u007f\xc1I\x90\x87o69\x9ado$9\xe2 \xe4\x97&\x88\x00/\xcaAD\xbc\xdddNy\xb3\xb9\xc9\x1c\x065\xa333+\xaal\xa9\xaa(\x17\xe1.\xd0r\x05\x0e\xa9\xc50\xe0\xea\x14K\x9e\xa4\xd8\xe0\xc7UKG!F\x8a\xe1\xf0\"\xc1\xebzѣ\x1a\"\x8c\n\x96\xa0\xaf\x83\xb6\"\t6\xb4\xeb\xf2a\u007f\xb6\x14\xcbyj\x9c\xe0{Ր\x8e#\x19\xdcSƚA\x9b\x11m+\vqU*S\xa5L\xfd%'\x13\x14\xa1\x82\xc7V\x81s|\xf6A\xc5\xe1\xe1\x8f\xcc\x1e6>\xb0\x1a\xa4m\xd0Y\x99\xf3\x8a\xef\t\a\xa1u\xb4W\xa2\x0e\xb0c\xa4\x81vhM~8F\x95zT\xb7\x02.&\x18{2\xf3\xae\x06a\x99\xd8Y\x80'\xf5R )\x1a̳\xf6p\x03\x0eƌ\x06ص\xbf\x87\xa6:\xbfԎ\x82\x8c\xd4Pםt\x1c\n0\xa2\xe3\x1a!\x01\aFS\x9fu\xbb\x9e\xb9ER\xc1\x96s;F5\xd7Vq\x16\xbf\x123F\xf7L\xf1\xf0k\x0f\x85\xf9\xf5\xecU\xcfL\x1aW\x91\xd5\x1a8(\xc8\xf4\x04\xe8\x96\xf3L=\xb0Q\xf6X\xc8\xe3\xa3\x0e\x94\xa8\x87^Yy\xbf\xb4\xcc\b2\xcc\x10\xcb5\xb1\rKO| $.2\xc2XK\xe0I}\xb8zV\xf6h\xe2\x05z\x15\a\x19ոNv\xa3׳\xda+\xeem\x16\xc3\xf37\rٻe\xb4C+m\"$\xb9Et\x92\x94\x03i\x860\xab$\x19\x92[\x8fݹ\xc0\x8d\x04=ed\xd2\xf3\xbf\x92\x1d0\x12\x17F^\x89\x86\x95\x81\xed\xe4I\x11;\x9b\x9a\x06\xb2\xb6S\xa76&kUyĉD\f\x81\x1f\xb5\vUЄ̃g\bC7~0K\x93\f\x0e\xaf\x9d\xb6\xeb\x19\x03uw\x1f\xa5\x9dz\xa3A\"\xee\xf9Ś\xebj`\x16j\xa9\v\xedr\x01\xa2\x9flV\xab\xb1*Γ\x95\x03\xffP\xfb\"z=;5\"a\x8e\xa7\xe8\xc8\xfb`\b\x9b\xad-\xfc㴿\xa5E\xc2H\x90\x95\x01i\x00\xac3\x8f\xe0\x84\x87^\x11\xbd\\H{\x8a03+$y\x9ae\xfb\xb0\x87\xb0\v\xb3P\xc4\xcc%:\x80\a{Q\xb9\x17q\xf1Ҷ!\x12/\xe2\x1e<1\xc2\xe2E\xbd\x90\xb4\x95v\xf59\xbd\x96إ\x16>\x96\a\xacA\xb4\x12\x17;\xba!\xcc\xdf7\xa5q\xb7\x8aY\x10\x8f\xa9\xef\x19+o~\xe2\xbdX\xf6\x86%\xc3?B0\xab \x99\xe2}\xcdIz\xfcG\xf5\x82\x06\xfd\r,\x04i\x86\x86\xec\t\xc4\xeb\xdc \xe3\x94\xca\x12\x98\x81\xbb\xa5c\xd6T\xc4\xeczV\x12ݩ\xe6]\xc4B-\xc2\xe1\rp\xc0_]\x9bqN4\x93\xbb\x17W\x91\xca\xe8\x15\xdc\xe2\x8aM\xa8\xc4_\x94r`\xca3\xf3w\xaar\x84\x1fM\x8cw\x05\xf7R\x03\xc0.ZGʣA\x90\x981R\xc0ޮ\x16\xee\xf0\xc1\xbeZ\xa9H\x8c\xd9Wԓ\xe5\n\xa9\xae\xe7\b\xa1Ԭf\xa7\xa4T\xb9juR\x8f\x11aZ\xdd\xd8\x19\x1d\xa8\r\xf8\x01\xbb\xaa\aS\xc9ѳ\x9a Y\xaa\x80ʹ\xd7\xfaz\x82Z\xde\x1e\xbe\xe1\xc01\xed\xaa\xde$\xeef\xa2\xa2z\xc7f\x1d\xf5\xb5\x82\tK\x84+}\x18\xbd\xa4\x83J\x8cĦZ\xd5\xf5`\f\xfaB\xce\x19Z\xdbS\xe6\x10N\xcbd}\xd0\x13a=\xee\xec\x80.`h\x1e\xe9ac\x96\xb4\x8b\xa1\x8e\x10\x941\xd8\x02\x17\x160]\xe69\xe0!\xfc|\x9b\x0f\xea\x9d\xcd\byL\x93:x\x10r\xdb\x1c\xff\xd4X1\x17\xc1\x80\xb9\xea]-]\x93\xec\xc1\xe5`\xd4AAx<\xab-\x02\xd3\xc8p$DL\x9e\x04#\f\xef\xaaٚ\xbbl,\u007f\x91nj\xea\xecM\x11\x85\xabG\x19\xd5\xeb9\x80A\x94\xb0\xbc\xc2\xd8\xf0%\n\x1d\xb0\xf3\xa4\x8f\x1e\x85\x9f\x13\xf4\nK\x9d\x870\x85GZ9m;\xaa\xa4\xa1\xd2\x1b^\xef\xed\xe6A¸d\xed\x89`̑\t\xb8ͤ겚\xc4>x\xbc\x80\xe2\xa3\xe2\x00 t\xb4\xa1<\xb0\x10\xda\xc2\x18ڳV\x03\x9f\xe2T\x15]\xb5\xf8!\xb9s\x88\xda\xdc\xd5F/n\x9c\xd9h\x1e\x93\xa0\x8a\x02\xd0;\xf4\xb4\x82x\x19\x02\xcb\xceF\xd4\xc3:\x15\x97\xab\xb6[\xa4\xd6\xf4\x92c\xd0DU\x1f19\x82\xfea\xee\xb2\xe9\xd94\x169\xab\xf2\x00k\xa3\x90\xea\n\xaa<ԭF&\xd8\x1a\xd0EА\xdc\xeaL\xc2N@\u007f\xd8\xc1\xf7l\x80\xca\xcah\xba/v\xab\xaa\x0ej{F\x8f\xf30\xf3Ȉ\x9e\xaa\xc6\x1d\x9c\xa8{|\x1d\xb8\xc0&$-I\xb1\xb1v^5M\x92\xa6w@\x9c\xf4T\xb7\u007f\xd3\xe3oP5\x93.e\x19t\x9f\x9eAݎ\xa9Qs2fO\r\xfcR\x85\x03\xb8:\xea\b\x84X\xbb\x1e\xdbeU\xd4S3\x82\xfc\x0e2ڿ\xea'\x9a\rm\x823\xc0\x8f\xab\xfa\xec\xa6\xfa\x03P\fayD\xa2\x9c>K!5\x0e,\xbdvȬǨ$^\xab\x92T[V\x84\x9e\xcaF\x04G\x91K\xedz\xcef8,\x9f\x94\x0f\xcc0T\xca\xdf\x1b(\x1e\x12OrlR\xac[\xbb\x98D(2\xf8\x89T/\x89\xa96rL\x00N\x00\xf3\x98Ն\xe40\x1c\xf0DWW\xceTM\v\u007f\x89\xa6\xd63\xb8\xe41\xe91UhJI\xd2\a]r\x04\u009eXL`\x13؋1Vs\x12s\xe9F\xd0\xe6\x0f8\x17\xbc\x81k\x86\x93V\xf8V\xed\xe3\xa6-\xebK\xc4\x15\xc1q\xc02o\x93\xd7E<\x01\x1d\xaf\x9a\xcf9\xe7a\xda\"\xfa\b\xe5%\xf7\x8e\x8ax2\x9d\x18\xe2\f(\x95\xa8n\x82\x02\t\xe0j\x8a\x1e\xbe\x84^ʒ\x8a\xf3;\xdb\ad\xb8\xf7\x03\bA\xaen\x12\xa6Q\x05\x04~\xa3\xad٥\r\n\xf5p\x92\xa2U\x9d\x8d\xc4\xf8\xf8$\xbd\xa9a\x9e)諃\x16\xea\xb0\x1f*͟\t(\xa3j\xcbP{zc\xa6\x19@E\\\x98\x9e\xdaU\x9b\x15j\xa3\t\x8c\xe4R\xfb\xc1`@敻#g\xa3\x9e\xfc\x92\xa1cY\xa1\t\x90\xdaA\xc0$\x16\xa9\xaf]\x16U0@74\xb4\x00\x91\xe8Tk-LI\x94\"\xa90\xe8h\x1c\b0\x04\x18\x1f}\xa2w\xe6\xc0\xb1\x19\x14\xd7\xdd\x05\x8bYW\f\x85B_)\x1f\xae\"\xbf\xb6\xe2g\xc7\xd5m\xb5\xa1f\x18\x86E\xc5\u007f\x93\xf4WM\\\xa0\x90\x04\x95:\xb4\x88\xbc\xd6]\xd3\xd6*\xf4\x1a\xf4d\x1b\x86%\xe9}J\x8e\xb6ȸר|P\x8d\x85\xa5f~sW\xbd\xab\x9b)a\xa4!\vܩ=I\x06庞\xe6\x04z\x91\xa1\x00\xb4N0\xc2\xdb\xfc\x93c\xd2\x06K\xed\xc8-,\xdc\x02\x16\xa0f\xe8\xf6\xa8\x89'q\x03\xdaf\xe3\xb5^\xa6Ήk<9\xa1\xeeg\x16\xa4B\x85Rkh\xfa\x85\xe1\x1a\xb5\xab5a\xab\xdcq\xb4\xf5\xa2\"\x9cW\x8c\x89\faOR\x05\xa4Ǆ\xb8#\x99*\xf1\xae\xe7\x1e\xe0\x8em\xf6ii\"{\xe1\x89\x0e\x86!\xbb\xf4\x14l`=\xf4\x98\x8d\x1at\xdfcy\xaa\xc1\x89\xe2\xa6J\x92\xa8\xdaɺ\xf2\x9bl1\xc9V\xa8\xa3\xa4\x15\xc9o\xdc1lq\xb4e\f\x9f\x91G\xaa`!QzE$\xa9\x13\xaa\xbd\xa7k1i(7WU\xbaB\xee\x8fW J\xe4\xc0P\xfb\xb9\xf41(\xbb\x82\x9a\xbcA\xf1\xbdߦ\xc2\xd4\x16sfl\xac\xe4\xee\xd8\x149\xe2\x05\x90W\xf5\xd5ꑎ\tV\xe9Q\xc3F\x94aЬ\x83\n\xe4PUIHم\xa5AL\xcf\x1c!\xb1\x06\x9f\xbd\x8euU\n&y\xa7\xe6%\x96\u007f=\xb9\xb7\xb4\xbd\xad\xa7\xa3$_\xd0\xf2\xc8\x0e=ׄ\u05f5\xb9\x8f\x9e\x18\x0e5A\x8b^\x8f;\xe1\xc0\xf5T3ڶ'u[B\xdfNg\xd4Vb\xb0\xe6\xb9\x1a^\x12\x9bͪ.\x00\x97 \xcc:\x1fб\xb2\x1e\x023\xe4Bz-tM\xac\xd75Dn\x84uZ\x0e\xa6\x82@\x05E\b\bU\x95\xf2mG=\xc7@\x1c\xf3\xbe%\xa2\x11&\xe0\xbbڲA\xb9\xa9\xcae\xc2x*\x9b\xda\xd4C%z\xe0\xdf\xe1\xe8\x10\x9aj\x1b\x9fe\x9a\"\xe6\xf5\xdcl\x95ǳT\x84D\xb3\x9a\xbe\x83\nBR\xbaj'\x88\xf9ӫQ\x06\x94\x06F\xf6\xe56\x80XM\xd4\xcf\U000bcb601\x18\x19gT#fj\x11\t(ٗ\x8f\xc4dQ7:\\\xe6\xd1\"z˂\b\x1c\xe7+턬\xe9(z\xe1\xe90\x959ʖ\xd6\xe8\x9fΣ,\xef\xc6\xd0\xfb|}\vKZSO\xddj\x99\xdf{;\xe8\xf1\xff(\x18\xc6Ӷ\xf2\xe9&\xb0\x03\xac\xe8\xf9_\xfc7\x0e\x87\xd34P\xfd\xbc\xde \xf5@-\x8f5A\x85]\xbd\xb3\x80W\xaf\xfdU\x1b/4s\x19\xbcwh\xa3fzZ\x93,\x92\xe4@)T\x86\xd3\xf2V\x85\xb9\xb5\xa5=u\xaeI\x1aj?{\x96\x854\xc1\xca\xc1\xe4\x80\xcb\xd2\xf3\xffM\xfd\x15\x0e\x19\xe6CȬ\xbf\xde}f0 n\xa4\x80\xd77!3\n\xda<\x0e\ue315\x91\x88 ȥ\xdc0:+b0\xa7*\xda A\x85\xb2U\xad\xbe3\xac\xfa\xe2$\xaa\xd7_o\xee\xa3~\xdcW\xc0D&\x912X\b1\x1d\xab\xab1\x03\x8e\xaa\xc8\xf9\xf7dA\xb9z^\xd6Kg\u007f\xca\xe5\xafu\x9b\xd4.z\xc6=pKRB\xa6\xeb\xa3\x15@'\xd6\x16\xd7\xea1\x0fL \xd8\x1cm\x82\x97\xdav\x96\x97\u05fb{H\x1c\xb0\x80\x88;r\x00{\x9e%\x83\xaf\xde\xf6\x82|R\xd7\xe2\xd6\\\x00\x16eGf\xec\xe89S\x90\x9f\x99\xe1\xac\x01\xed\xa7\xee\x18\x14\x9b\xb6q՜Z\xf4\\\xf6\xb8\xc7@\xbe\x971\xb7\a\xbc\x1d\x11\\ap\xec\x1b* !\xf6\x92z7\xd4\x13\x12\xf2\x83\x1fN\xa4gĜv\xd4Zԛc@\xa66\x9f\xd2R\xe7\xeb\xd5\xfb\xf7dS\xab\x1d\x1aRo\t \x03\xa2\xb6\xff\xf8С4|\xf8\xde3\xe2\xb3UkPa<\xcc\xf8Z<\"\xd7\xc4P2\x06\x84\xec\xf2*O\xa1y\xa0B\xa4\xfaְ*&_5\n=M\x8d\x94W'\xc7^\xe7\x81\xf7tܺ\xf6\x1fl\x05E\x9d\xaa\x9e0\n\xeeJ\xef\x05\xa5\a\xf9\xb5\x8d9Tp\x82\r=\x9a\t\xf3\xd4\x14@\xe1tկ\xa5\x94 뙜\xeeEF\xa4\x8b\xd8^\xbfa\xf7\xa4\xef\x84B\x11\x84\x9dE\x82Q\xea\x16J\xcf\xcfN\xcdk\xc3.j\xdc\x12\xa6\xc0*z\xe6\xf8\xba5\xd4s*\xb8D\xeb\xef\r \x94\xa3=\xfc\xae\xba\x0f\xde\xe2=\xf3(\xbf\x8d\xbb\xc23u\xf5\t\x15\x8c\x1f8\x80f1\xe1n߫L\x97P\xa1\xaa0e\xb5\xcf\xeb-<\xaaz\x11\x8f&\x10z\xa9{ܡ\xb7sXb\xe6q\xf1\xb0\f㽷@\x06\x97#9\rB\xa82\xe5T\xf5\xd1\xfb\x98,\xb2\xc4$\xab\xc04n_{\xa5\xb8HSU\x96i4đ\xb6L\xe5j\a\x9e[o~\xc3أ\xbaWXrD\xb8CM\x94\xadF&\xbd\xe3\xc0\xe0j\b\xb3^\xb5\x8d\x00v\xaaAC5\x11\u007f\x9a\xa4\x04\xf8\x1a\xb9p=Moւ'\x92\xa7\x87\xfa\xd4\xf6\xe2T\x0fj5j+\x00\x17\xc7©\x84ݰ[][3\xb0\xc0Ү!\xa7\xa8$~\xd0\x0e6\xeb\xe1\xd52\xc1\f\xe3ݖ\xca\xe2\xb0\tv\x9d\x97'\x02Nţ\xf4\xf6\x95\xb15\xda\xe3M\xfb\x98va\x01I\f6\xb8\xab\xa2\x8a\xbc\x9f\xfaZ\x99'X^\xe0\x88A\x93\ar1\x95\xf4\x90q%$\xd1U\n\xe4BH\"\xaeZ#\xdc\xf6\xb3xz*WOh1\xa1\xf0\x95v\xa7\xb6ޯ\b\xfc\x87\xe5\x8e6\x02\xdc\xdbQj\xaa\xd8!\x86\xb9,V\xe3=\xee\x05\x84\xde^\v\x94\xc0w\xe8\x0f\xe2L\xea \x81\f\xf3N\x14\xb2\xdae\xb9\x9d\xa0\x87d`\f\xb7U\xad\x96\xc7QEY;\xb4z\x12G\xfb\xbe\xea\xd3\xe5\x0e\xd7{\xe6b\xeb\xd1]\x82Co\xf4r\x96\xfa\xc8+\xb6\xaa\xaa;\xf3=\xe9\xd9\xf4\xaeU\xa6\x86\aO\n\x9a6\xe7\xf4\xf6\x16P\xe3RE\xbb\xbdg\x8b\x83\xf6\u007fY\x11\xb9h|\x8e\xd73\x170W\x81\xcc\xd1\x10\xe4#VZ\xad\fudU\xcd\xf2ָJ\xc08Ǭ7fY\xa6\xda!\xc3HM=\xc6\xeaܽ\xba\xfa\xf3\xe2\xf8[B\x13.Yz\a)\xee48\xbdm\x10\xe1\x8cw]6\xb5A\x82\xae\xf3j\xfe@\xe4\xa8\xe4\xa0=h\xbd\xbd\v\xbf\x81\x86\xd0N\xef}F\xb88q\n1\x13\x11\"\xd2\x11\x1c\x0e\v\xf1\x00\xc2\xfd\x1f^\xa1[\x8fQ\x15\x82:\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x01\x0f\a\x01\"\"\xe3E\x00\x00 \x00IDATx\xdaͽy\xb4mW]\xe7\xfb\x99s\xaev\xf7\xfb\xf4\xe7\xf6}\xeeMnn:\x12B\x12\xe9A@\xc4X\x88\x16v\x05\xa2h\xd9=땅ְ\u07b3A\x87R\x8e\xa75\xac'>\x14\xa5\x15\x03H\x81\x1a\x05bI\xa9\x04\x93\x90\x82\x84\x84\xdcK\xb8ɽ\xb9}\u007f\xda}v\xb3\x9a9\xdf\x1fs\xad\xb5\xd7>\xe7\xdc&H\x15\xee1v\xee\xc99{\xaf\xbd\xf6\xfc\xcd_\xf7\xfd}\u007f\xbf)N\x9d:Ŷm\x9b)\x1e\x9ao\xe8!\x84\xc1\x00\xa6\xfcK\x03\b\x01\x06\xb4\xd1\x18\x03 F\xdeg\x8c@\xc8\xfc\xe5\x86\xed\xfb\xf6\"\x1d\xf5\x06%\xe5\xfb\x95\xeb\xb6M\x9a\"\xdc\x10\xcf\x0f\xf0\x83\x10\xe58T*5|\xcf\xcf\xdeo\x10\x80r\x1c\x04\x90\x0e\x06\x18c0Z3\xe8\xf7I\x06\x03\xe2~\x9f\xce\xf22\x838\xea&i\xfa\x8e8\x1a\xfc\xc1\xf1g\x9f\xd6\x00\x0f?\xfc0\xbf\xf4K\xbf\xc4\xe7>\xf7\xb95\xdf\xe9mo{\x1b\xef}\xef{\xf9\xdf\xf9ȖB\x94\x16\xf0\x1b\xbb\x901\xa2\xb8Rq\t\x01\xc2\u0605\x1e\x95\xd4\xe8{0`\fl߷W)\xc7\xf9\x8fRȏJ\xa9\xdaI\x14\xa3\xb5D\t\x89\x00\x8c\xd6(\xa5\xacp1 \x04R\xca\xe2)\xa4\xc4\t\x02\x84\x10H\xa5\b\xaaU*\xf5:a\xadF\xb3\xd9dbl\xbc\xd2n6\xffk\xa3\xd1\xfcО\xfd7W\x17\x16\x16ؾ};\x9f\xfaԧ\xf8\xe0\a?\b\xc0{\xde\xf3\x1e>\xfb\xd9\xcf\xf2\xadz\xc8\xd2\x1eg\xed\x8a~#\x92\x11\xd9sT\xc0\x86\xb5\xda!\x84)\xfevݭ\a\xea^\x10|HJ\xf9\xebJ\xa9 \x89c\xd2X#\x84D\b\x81\xe7\xf9(%\xd1i\x8a\xc1\x10\xa71\x00\x8e\xeb\xe2x\x1eB\b\xfbT\n麐\xfd\xbf\xe3yxa\x88\x1b\x048\x8eC%\xac\x88v\xa3\xf9\xfd\x13\xad\xb1\xff\xf1\xf2W\xbev\x93\x10\x82O|\xe2\x13\xbc\xeeu\xaf\xe3k_\xfb\x1a\x95J\x85\x17\xbf\xf8ż\xeeu\xaf\xfb\xd6\tD\x98Q\x99\x14B\x11߀0V\x99,\xb3F\x18f\x8dP\xf6\xdf\xf9\x82ɠZ\xf9\x1b\x01oN\xe3XF\xbd\x1e\xf1 F9\x0e\xcaq\b°x_\xae\r\x06\x10JZ\x93(\x04BZ\xc1I)q\x02\x1f\xe5\xfb(\xcfC\xb8.N\xa5\x82W\xa9 =\x0f\xe98(\xd7\xc5\xf5\xfd\xdbð\xf2\x0f/\u007f\xe5kv\u007f\xd7w}\x17\xbf\xfa\xab\xbfʙ3g\xb8\xf7\xde{\t\x82\x80\xb7\xbe\xf5\xad\xdf\x12\x818\xc5\xc2\x180B\xacQ\x18.cn\xae\xeeS\xec5\fd\xbecͅ\x01\xd8s\xeb\xcd\x1b\\\xdf\xfft\xd4\xeb\x1d\x88\xfb}\x06\xbd>Z\x1b\xc2z\x03\xe5\xb8\x04\x95\n\"\xbb/!\x04\xae\xe7\"\x01\x95\t\xc0\x18\x83\xcc4\xc3q\xdd\xe2w\xcaII\xa2\b\xa35\xc6\x18\xdcj\x15\xad5q\xbf\x8f\xd1\x1aa\fn\x10\xech\xa9\xf1\a^\xf2\xb2W\xbf\xee\u007f\xfc\xddg\x0e\xbd\xf9\xcdo\xe6̙3\xbc\xeb]\xef\xe2\xf6\xdbo\xe73\x9f\xf9̷\xd2d\r\x05\xc3\xea\xf5\x93\xeb\xbd\xf2y\x98\xae\xcb<v\x1e\xd8?[\xa9W\xff:\xea\xf5\x0et\x97\x96\xe9\xadt\x89\xfa\x03\xbc\xb0\x82T\x0eBJ\x94r\xacD\x8d\xc1\xf3\x03\x84TY\xdc!1\xc6\xfa\x91\xb20rS\xa5\x1c\a\xafR\xc1\xf1\xfdB+\xfcZ\r7\xacX?\xa3Tf\xf2\xbcm\xcd\xf6\xd8g^\xf7\xfa\u007f5\xfb\x9b\xbf\xf9\x9b\x1c<x\x88\xdf\xfe\xed\xdffzz\x9a\xdbo\xbf\xfd\u007f\xbb@ĩS\xa7ؾu\xcb5Y \xf4\xf3W\x15mL\xf16#\f\xc2\b\x10\xb0\xfd\x86}3\x8d\xb1\xf6\xfdI\x14\xdf֙\x9bg\xd0\xeb\x93&)~\xa5JP\xad\xa22\x81T\xea\rk\x86\\\x87\xb0V\xcfL\xa9\xc4u\\\x94rP\xae\x83\xef\a(\xd7EJ\xbb\xc8F\x8f\x86\x8a:MI\x93\x04c\f\xc9`@\xd4\xeb\x91\xc61I\xbf\x8f\x8e\xad/2i\xfaI\x8c\xf9\x9eW\xbf\xf2\xc5\xfa\xff\xfe\xe5_\xe6\xe1\x87\x1e\xa2\xddn\xb3k\u05eeo\xad\x86\xac\xd6\x16a\xbe\xf1\x8b\x1b\xd6\t\x85\x81m\xfb\xae\x9b\xae\xb7[\u007f\xa5\x93\xe4\xb6\xe5Ks\xf4\xbb=\xd28\xc1\v\x02\xbc0DH\x85\x90\x12\xa9Ta\xae\xdc,\xccŀ0\x06\x99E^\x18\x10\xd2~\r\xa9$\xcaqm\b\x9c\x99\xb4<\xe2R\x8ec5\xc7\xf7\xf1\xabU\x1c\xdf\xc7\rCD\x16\x00 Ľ\x06~\xe8g~\xe6g\xf8\x9e7\xbe\x91\xf7\xbe\xf7\xbdl\u07fe\xfd[o\xb2\xae(\x18q-\x8e\x83\xc2\xd1\xe6o(~m\x04[\xae\xdb=Yo\xb7\xeeǘ\x17,\x9c\xbfĠ\xdb#\x89b\\?@9\x1eJ9\xd6'H\x89\xc8\x12\x14k\x82T\xe9cDf\x9e\xac\x93\xcfmk!H\xc7A)\x85\x90\xc2*\x94\x10\x99\xb0\x1c\x1b\x148\x0e^\x18\"=\xaf0_BJ!\xe0\xbf|\xc7w\xbeq\xf7;\xdf\xf9N>\xfc\xe1?\xe5\xe8ѣ\xff2\x052\xe2P\x8a\xc5^_'\xec\u007f\xc5Ht%2\xc1lܹݫ\xb5\x9b\x1f\x92J\xbd`\xee\xf4Y\xa2n\x978\x8aQ\xae\x8bp\x14\xcas3\x8b4\xdc\xf1\xd6A;\x14\xd9c\x16i!(i\x90\xb1B\xcanQfљT\x0eH9\x14\x8a\xa3\x90y\xee\xa2\x14^`M]\xe1S\xa4l!\xc4\xfb\xde\xf6c?\x15|\xf0\x83\x1f\xe0\xfe\xfb\xef\xff\x17.\x90r8,Ś\xdf\x0f\xc3[\xc3H\xc0+\fS[6\x89\xc6\xc4\xd8/\x05\x95\xf0\xdb/\x9d:K\xd4\x1f\x10G\xb15'\xae\x8b\x14\xc2:\xf0\\;\xb2E\x93R\xe2z\xdeH\xa8fs\x93\xa1\x06\xad\xa7\xba\xca\xf32\xa1䡱D \x90\x99\xe9\x12R\"\x94\xc2\rC\x1c߆\xc9\xd8Ͻ\x1b\xf8\xad\xff\xfa\xfb\u007f$|\xdf\xff\x16\tD\x8ak\xca;r\xd3U\x980)2\xc1ا\xfd]9א\x16R1013\xfd\xbaj\xbd\xfeK\x97N\x9d%\xeaY3\x95\xdby\xa5\x14\xcaq\xedΗ\xb2d\x96\x86\xb9\xc5j\xd3(eɇ\b\x01Ɔ\xb7yD&\x84\x15t\xeeCD\xf9}\xa5\x9cEe\x89\xa4\x93\xe5-\x99\x05\xf8\xa94M_\xf6\xa67\xbd\xe9[\xa8!\xb9)\x92\xab\x9e\xe2yhNIk\n(E\b\xae\xbb\xed\x96M\xd5f\xe3\x0f\x17\xce_T\xbd\xe5\x0eI\x14[\xeb'\xadV\ba\x9dq\xb1`\x99\xf9\xc9um\xb5\x89\x14R dn\x16\xcd0\xe5\xd4z\xa8I\x02+\x90<8P\xf6\xbadBȃ\x05\x99m\b\xe9\xba(ϳ\xaf\x95\xd2\x01~\xeb\xbb\xdf\xf8f\xef[\x9b\xa9\xaf\x17]\x89\xcb\vf\xdd(\xcc\f3p!\f\xb3۷\xa9Z\xbb\xf9'\xbd\xe5Ά勗H\xa2\x18ct\xe6p3\x13\x92-Z\x9eC\x98\x92\xbf\x92Y\xa2W\xec\xfeL\xc8P\x16\x9a\xbd9\x9d\x85\xbbB\xca,\xd2R8\xae\x0f\x99ॲZ%U\xa6%\xd9\xf7S\xd9gK\xd7:\xf9L\x8bn\a~\xe0[\x9c\xa9\x8f.\xb00\xa5|D\xe4N\xc2\\\x05-1#\xa6ez\xeb\x96_4\xa9~ե\xd3gI\xe2\xc4\n#3GH\x89TΈ0\n\x85S\x16\"Q\xaek\x11\xddLP\x82\xec\xbdY\xe0`\u007f6 $\xc6h\xeb+J\xd7W\x9eG\x9a\xc4\b%1\x18$`\xb4@*\x8dISR\xad-(\xe98\xa4I\x82\xf4}D\x14!\xec&xǋ_\xfa\xea\x8f\xfe\xe7\xdf\xfa\xb5\xee\xf8\xf88Zk\xfe\xea\xaf\xfeꪋ\xfa\x92\x97\xbc\x84\xdbo\xbf\x9d\a\x1ex\x80'\x9exb\xdd\xd7\xfc\xc4O\xfc\x04\xb5Z\xed\xf2\x02Y7K_%\xacL\x9f\xec\x02\xe8\xab+ߍ\xf7\xbc\xe85R\xaa_9\u007f\xfc\x04\xf1``w\xb0!\xf3\x13y\x8e \x86\xc0`nF\x1c\a'\xcb'(\xc1&C\xcb:\xd4\x0e\x1bd\x99a>\x92\xc35\xb9Օ\n\xe5y\x18\x9d\xa2pHM\x02R\xa32\x01\bc\xb7\x90\xcdS$H\x83\xf2\xfc\xdc\x17\xed\xd5i\xfa\xa3\xd7_\u007f\xfd\xef\xfd\xc6o\xfc\x06\xbf\xf6k\xbf\xc6\xdf\xfd\xdd\xdf]\x15RɅ\xf6\xec\xb3\xcf\xf2\v\xefxGa\xc9ˮ\xf0mo{\xdb7#\xec-\x87\xbf\xebd\xf2f\x18\x96\xde\xf0\xa2;\xb6\xba\x9e\xf7\xc1\xe5\xf9ygea\x894I\vsR,|\xee/\xa4\x1c\xf1\x13b\xb5\xb6\xac\xf9tQ\x98\x9b\xfc\xafy\xa9\xc5\xe4\xc2)\xf9\x1b\xc7\xf1\xacyT\xaa\b\x95\xad\xff*\xe56R\xe2z.\x18\x83\xf4\\\x1bq)\a\xe0\x17_\xf7\xfa\xefn\x1e8p\x80Ç\x0f\xf3\xcew\xbe\xb3\xb8\xb7kO\x8e-\xc4c\n\xff\xfaM\b{Wk\x8f\xb9\x8c\x9c\x94R\xec\xbc\xf9\x80\xe7\x05\xc1G\x92(\x9a\\8{\x1e\x9d\xa6\xa3\x8b\x9d\xff[DT\xb2\x14\xbc\x96\xb4\xa5\x145\x95}Ha\x1a\vy\x98\xa1<3\xf36\x94\x88\xc8\x12?U$\x98\"C\x8c\x95\xe3\f\xb3\u007f(`|\x84\xb0H\xb1\x14\b)g\x05\xf2\xadccc|\xe0\x03\x1f\xe0\xc0\x81\x03\xbc\xfd\xedo\u007f\x1eu\"H5$\xa9&N\fqj\xae\x88@\xc9+\xe6\x1a\xe22B1\xeb%\xe9\x02\x95-p\xb5\xd1\xf8\xbf\x84\x12w͝\xb6\xf9\x861\xa3~e\xc4\f\x99\x92r\x94~/FvT\x16Oe\xe6E\x88ћ5\xa5oh\x8c.L\xe2\xc8\x17u\x9c\xc2\xe7\xe40\x8aT\xd6w\x14aDV?\xc1\x98\"\xd8\x10J\x81\xe0\xe7\u007f\xf3]\xbf\x1b\xdc|\xf3\xcd<\xf4\xd0C\xfc\xdc\xcf\xfd\xdc7\x0e'\x19H\xaf \x11Y\xfc7\u007f\x8a\xf5\x85bFL\x94YW\x8eZk\xf6\xbd\xf0\x8e\xdb\x1c\xcf\xfd\x0fK\x17.\xd1]\xee\x8c\bcD\xd53\xc1\xe8l\xf7\x8b\xb5a\xda\xc8o\x8c\xb1\xd7\x17f(\x88r\xaca\xb4\xbd\x86Iu)t\x1e~\x81\"\xab\x17\x14\v-\xb2\xa0A(U|#\xc7\xf5\n\x13*}?\x0f86\x1ac\xbe\xf7U\xafz\x15\xefz\xd7\u007ff\xf3\xe6\xcd\xfc\xf8\x8f\xff\xf8\xb76S/\".c\u0588#7/[o\xb8\xde\xf7\xc2\xe0\xf7\xbb\x8b\xcb\xfe\xca\xe2\x12i\x9c\xacź\x8a\xc4m\xe8'l\xcdB\x8f\xd8Cq\x05\xab\x9c\xef\x805\x11\xb7\x19j\x91t\xdc\"_\xb17o2\x13\x99\xc1\xf3Y\xfe#\x95\xc2\xcb\x16\xdeV\x1c\xa5\xd5\x12\xb0\x11\xa0S\xa0\xc8\xff\xeeM\xdf\xf7C\xe2\xc9'\xbf\xca\xe1Ç\xf9\xbe\xef\xfb\xbe\u007f\x01\xd0\xc9e4M\n\x81\x06j\xad\xc6\xff\xa1\xd3\xf4\x8e\xe5\xb9y\xfa\x9d\uea29*\xa2\"Q\xc2\xc4.w\xedUZU\n\x02ֳ\xaey\xe4g\x85a\t\x0eʵ\xd5A\x81\xb0\vj\x8c\x15N\xc9OIG\x15P\x8d\x9bEY9Tcs\x11\x81\xf4\\\xa4\xa3\x10B\x1c\xd0Z\xdf\x14ǉ\xf3\xda\u05fe^\xdcr\xcb-lٲe}\xed\xff\xe6\x90\x1c\xd6y\xeaU\xdb\xf02\xe1\x816\x86\xed\xfbo\xd8\xeb\xf9\xc1/-]\xb8$\xe2\xc1\x804M\xd6hQq\xd3b\x94\b\xb1\x96\x8cr\x99D4\x13\x8e)\x17\x85\v\xc1\x9a\x91 \x00\x01\xca\x0f23,l\x95\x101\x14J\x16\x12\x97\x81J\xa9\xf2\xa8\xcf\xc2.\xf9gJ\xcf\x03)\xa5\x10\xe2G<\xcfM\xb5\xd6a\x9a\xa6\xbc\xe5-oY{\u007f\xffK5D\x8c\x9a\x83\xf5\x11w\xc1\xcc\xf6\xed\xb2Ҩ\xffޠ\xd7k\xf4W\xba\fz}ֱk\xebV\x87\x87&jm\xf9X\xae\x83,\v!\xd6p\x00\x04e\xc4D\x14e[\xe5\xf9\b\xa9\x8apX\xa7\x89\xad\xa3䙾\x14(\xd7)\xa2='w\xf6RX\x81\x94\xca\x00ֹ\x8b\xefݺcwU\b\x91\x1c;v\x8c\xbb\xee\xba뛪\x1d\xd7h\xb2\xc4\xe5+\x88\x99\xd6\x04\x95\xf0^\xd7\xf7^\xb5x\xfe\"\xf1 \"Y\xed;\xd6\xc3-\xc50\xab/\xfc\x87))b\x16N\x8d@&\xd9\u0095\x83=Q\x8a\xd6\x00\xa4\xeb\xa0u\nF#\xa5\xc0\r+\x85&\x19\xad\xd1Fg\x8b\x9c\xe7Lj\x882g\xa5_J\x10=B\x82,\x10\xe8i!\xe4\x1b\xa5\x92\xf1\xeb\xbf\xf3\rb\xf7\xee\xddWܬ\xffk4D\\9\x91\x99ݹ3\b\xeb\xb5\xdf\xe8wV\x88\x06\x11\xd1`\xb0\xee\x1b\xd6\xec\"SN\xe9\fF\x18t\xe1\xd8\xf3\xf0V\x14_v$\nϢ\xb2U \x8e5;B\"\x8c\xb6\x01\x85\xb0Q\x94\xf2,\x92k\xf2\x00\xa2\x88\xb5\xad\xb9S\x8e\xb2\xfe\x84!\xabEdU\xc6<*\xcbSm!\xe5\x0f_\xb7o\xbf1\x1agÆ\r\xdcz\xeb\xad\xdfT\xa1ȫ\xfbq\xb3~V\x9e=\xbc\xc0\u007f\x8b\xeby{\x97.ΑD\x11:MG\x16\u007f\xc4w\x8cD[\xda\xee\xca<\xef.L\x8e\x85\xeb\xcbu\xf1\x9c\xcc0\x92\xfd\x1a\x83\xc8~o\xff.K8\x96 \x8d\x06Y4'q\xc3*2\xdb\xf9\xc6\x18L\x96\xa8\x8a,n\x16RY\xc7_Ғ\x1c\xb6\al\x88\\\x14\xc0\xc4=ژ\x9dB\b\xee\xbe\xfb\x1e^\xff\xfa\xd7_լ\xff\xb3\xd1ޫ\xdb\x1b\xfbظgw%\xac\xd7~\xa1\xbf\xb2B\x12'\x16V_O+ֱ\xb1\xa6\\l/ \x0f\x93'4\xe8|'\x1b\xb3F(Z\xa7\x19 !\ne\x94R\x8c,\xb0\x15\xa8\xb1\x15A\xdf\xc3\xf5\xc3\x02*)\x87\xc7E\x951s\xea\xb9\x00TV\x1c+'\x96\xd9\xe7{\x18\xbe\xdfqTr\xee\xdc\x05\xf9\x8aW\xbc\xe2\xf9\x81NW\xf17\xf2J\xc0\xa2)/\xc6:\x0f\xd7\xf3\xde\xe2\a\xc1\xb6\xe5\x8b\xf3$\x83\xc8&n\xab\xc0\xc0\xcb\xcb\xd3`2\x84Rk\x83Iu\xa1\x15E\xa6cF\xe1\x96\xfc\x91$I\xf6Yd\xb0\x8a\x15b\x19v\x91\xca)\xd5\xf4\x05^\x18\x8eܗIӑ\xf0NH\x91\x97q\x87!q\xa9,\x90C)YB\xfb\xc3\xdbw\xedu\x10\x98;\uee03\x1bo\xbc\xf1y\t\xa4\x8c\xa1]\xd1d=\x1f\x86ɦ=\xbb[\xd5F\xfd\x17{\xcb\x1d\x928\"\x8e\xa3\xe7\t\xba\xd9\xecZ\xeb\xd4:[\x9dڟ\r#pJ\x01Ε\x04k\xb4&Ib\xfb\x1e\xa3\xad\x99Ӻ\xa8\x06\x165\x9c\x1c̔*\x83\xe2\x83a$f\fF\xa7#Ȁ\x94*\xfb\\\x83\xc0BA\x8e3\xac\xf3\xcb\xecg\x10;\x81{@\x88\x1b\xf6\xdf$~\xf6g\u007f\xf6\x9b\xa6%\xeb\x10\xe5lXy5\xed\b\xaa\x95\x9f\xf6\x02\u007f\xf3\xf2\xa5y\xe2A\xb4~\xd2(\xaeP\x1a\xceACm\x8a0U\x17Zb\x8a0XdX\xd5\xea\xcaa\x19\r\xb6f\xc6\x19\xa1\xfbȌu\"e\x0e\x91\xf84g7\xe2\x06!\xc8\xcc?\x18c\x81\xcf\x02=\xb0QW\xf9\xf3\x84,\x95\x05\\K\x9a\xc8n\xee\a\x9a\xad\xa6\x1e\f\x06\xe2\xee\xbb\xef\xe6\x81\a\x1e\xc0\xf3\xbc\xab\n\xc2\\\x85\x06*YEI0W}\vl\xd9{\xddxX\xab\xfel\xaf\xb3B\x1c\xad\x13\xe6\xe6\xb6\xfc2yH\xe1\xbe3A\x1834Wy\x8d\\\x96B\xd32\xf6\x95\xd3|\x8a\xa4-{\x91\xe3yY\xc9\xd6\xe6\x10^\xa5:ļ\xf2\xeb)E\xd0h\x15%ܜ\xf0\x90o\x10\x1b\x1b\x88l\xa3\xe8\x11В\xac\xe8U\xd4h\x84x\xd3\xc4\xe4\xec\x94\x10\xc2\xec۷\x8fW\xbd\xeaUT\xabի\x00\x8b\xe6\xaa\xcc\\9tr:{\x9aR~\xb0\xfe[\xc3Z\xf5-\xae\xe7M._\x9c\xb3\xdaqm1\xc0Z\x93\x95;\u05fce!7P\xda\x14,TQ\"RSr\xecŽe\xda\xe0\xfa~&\x10\x85[\xad\r\xc9r\xcaj\x89\xcchAB\b\xdc \xc4\xf5\x83\xa2>2\xacV\x1a\x1bL\x18C\x9a\xa4$qL\x9a\xa6#\x9cg\x99\xd5\xe9\x81\x06\xf0\xbd\x95Jhޒ\x11\xb3\xef\xbd\xf7\xdek\x87|\xaf.\x10\x8a\x85\xb9R\xf8\xb6i\xcf\xee\x8a\x17\x06?5\xe8\xf6\x88z\x03\x92$\xb9\x06\xe8K\\I*v\xd7hS8i\xebW̨S\x17\x02S\xaa\xa5\x14u\x14!p\\\xb7 \xc7I\xa5l]C9Y=]\xa2\\K\tR\x19\xfbD9\x96\xd0\xe0\xf8>\x8e\xe7\xe1z\x96\xff\x9bGUi\x9a\xd0\xefv\x8b@\xa5\xc0\xfc\xb3\xa7t\xac\xe92B\xbcuf\xd36\xf5\xfe\xf7\xbd\x0f\x80\x9bo\xbe\xf9\x9f\x03\t\x0e\x05\xa2\x8d\r3\xf3\xddQ\x14\x84\xd6\xd9\xfb\x95Z\xed^\xc7\xf5\xb6w\xe6\x17\xad#\u007f>q\xf2\xaaW\x98<J*\xa7\xdd\x196\xa6Ky\x88(\xd1~D\x89&$\xa5D\nk\xaer\x93$\x1d\a\xafR\xc3\v\xab\xb6\xbe\xe1x\x84\x13\x93\xf8\xadqt\x9aZ\x9e\xb0\xe7\xe3\xb8\x1eR9VX\xae\x8b\xeb\a\x96\x98-%\xae\xef\xe3z.B\x95\x806\x91\x05\fR\x94\xb5\xe4fcL!\x85뮻\ue6e5!\xa3\x00\xdf\xe5^ޚ\x9c\x90\xae\xef\xfd\xa8N\x12z\x9d\x95\xb5\xf0\xfae\xe5a.\xbbU,lb\n\xf2\xc3p#\x98\xd10\xb1Ta\x042?`\xb5D:\xceH}\xa3\xe0c\xb9.A\xab\x8dt\\±q\xc2\xf1\x89<\xdb\xceJ\xfb*#l\xbb\x85I\xb3\xa4m\x89\xe3\xb9\xf8aX\xf8\x1b\x91\t\xc5b`Y\x1dŮ_Aޚ\x9a\x9a\xfa梽W\v[+\x8d\xfa\xddR\xa9\x17w\x16\x97\xd6\xf5\x1dW\\\xff+e\xb19ln\xd6\xe9\xb3\xca\xc2\xd8ь?\x87ԇ\xe6,79y\xeb\x9bt]\xfcz\xc3B\xeb՚%\xc3\x05!BJz\xcbK\xf4;\xcb$qD\x92&ă\x01I\x1c\xa3Ӕ$\xb2\xffZj\x92į\x048\x9e\xa5\x10\x95\xc9\xdf\xf9&\x00\xee}\xc9+^\xeb\x00lݺ\xf5\x9bH\x03\x12W\x8e\x93\x1bcm*\xf5\xfa\xbf\x05Twq\x894\x8eG\xd6_\\\x06\xd5-\x9b#\xb3\xdad\x992\xb8h\xb3딤p\xbeev\xc9\bMH0\xc2T\xb1Z\x92i\x88\xe3b\x8cƭV1\xc0`\xa5\xc3\xca\xe2\x02\xfd\xa5E\xe6\x9e}\x9a\xe5\x8b\x17\x88\a}\xd2$a\xd0\xef3\xe8\xaeXd8\xd30\xb2HK9.\xae\xef\xe3y>~\xe0\xd3\xef\x0e\x00\x8dt\x1c\xd28.4P\xa7\xe9n\x03\xd7\x03O\xb4\xdbm\x9eo\xe6~M\x02Y7\xb2\xaa7f\xbd \xf8\xceA\xb7\xb7\xb6N>\x82Q\xad5Yk~5\x82MI\x8cI\xc1\xb8Y\x9d،\x90\x1d\xca\x15I\x93q\xa8d\xa9\xd5@\xb9.\xca\xf7\x8a\xea\x9e\xf2|\x90\x8a\xfe\xe2\x02\xbd\xce2\xe7\x0e\x1fb\xe9\xcci\xfa\xbd.\x83^\xcf:\xeb8\xb2\xf7\x94݇\x14\xb2\b\x97\v\u0085\x94H!p=\x8fZ\xb3\x8d㹖\x8b,\x15(\x8dεD\bi\x8cy-\xf0\x84\x10\x827\xbc\xe1\rא\x18\xca+\v\xe4Z\x12\xecJ\xbd\xf6f\xc7qj˗\xe6F\xb4c\xad\xcb0YZ7B\xf2\xc4\\\x01@\x11\x80N#\xb4v\x10XB\x9b(E5#O@\nK\aU\xaec9\xb9\x8e[\x04\aZH.\x1ey\x96\x93O}\x85\x95\xc5y\xdb$j\f^X\xa515\xc3\xec\xf8\x04\x95f\v\xa9T\x91\x8c&\x83\x1eI\x14\x91\xf4{D\xfd>\x83n\x87\xfe\xd2\x12I\x1c\x13\x0f\x06,^\xbc@P\xab\xe1\x87\xd5̜)ۣ\x92QP\xd3(z\xf9+_\xf3\x86w\xfd\xf7\xcf\xfc%\a\x0e\x1c\xa0\xd3\xe9\\џK)\xae\xae!Wz4\xad3\xff\x81$I\x88\xa3h$\x02Z+\x95\xe7\xd9b\x9d#\xb6Z\x93&Qƅ\x12#Z\xc0*\xc4XJ\x89\x92\x96\xa0\xedd\xa4\xb6\xa8\xdb\xe5\xd2\xd9g9\xfd\xeca\xfa\x9de\x1cץ>1Ͷ\x17\xdc\xc9\xe4\x8e]4\xa6\xa6Q\x8eC\xd4\xeb\x92F1i4\xa0\xdfY\xb2\x9f;\x18\x90F\x03\x06\x9de\xd28\"\x8d\x13\xfaˋ,\x9d=\xc3\xd2\xfc\x1cZk\xba\x9de\x8c1\xf8A\xc5\x06\x06F\x821(\xcfC\xc7\xf1]Q\x1cׁ\xe5-[\xb6p\xf0\xe0\xc1+h\a\x97ݜ\xd7$\x10c\f\xb5fs\x9f㹷\xc6\xfd\x01\xf1`}s%\x10\xa3\xd5ޒI\xb8L\x93\xba\xdd\xd5B\"\xb4\xcd1LV%\xcc\x1bvʉa\xf9\xa1\x94e#ڨL\xb3<?ǱCO\xb1t\xf1\x02\x8e\xe7Ӟ\x9ee\xdb\v^Ħ\x037\xd3ڰ\xb9x]\x1a\xc7\xc8h@b\x06\xd6li\x83N\x12\xd28\"\x19\f\xd0Q\x84I\x12H\x12*~H8\xbb\x91V{\x9c\vgO\xd3\xeb\xae\xd0[\xe9\xd8\b\xcc\xf1\xad\xd9Q\xa0\x84 \x8d\xa2Z\x1aE\xb7\x00\xff\xb8e\xcb\x16\x9ey\xe6\x99o8\xecu\xae\xb4\x9d\xf3\x85\x0f\xeb\xb57*\xc7eye\x81x\x10\xaf\xf1\xceb\x15[\xa1,\x9c2\x19z\x18\x81\x95\x04UF\x13M)3\x97Cv\xa2X\x85\aI%-ܮ\x14\v\x17/r\xec\xd0W\x89\xfa}Z\x13SLn\xd9ʆ\xfd7S\x9b\x98F9n\x11\x18$IB\xf7\xc29\xce~\xed)\xba\x17/`\x06}|)\xf0]\x87Z\xb5\xc2x\xab\xc9\xfe\x9bn\xa5\xddj\x11'1\x97\xe6\x168u\xf6<\xa7\xce]\xa0V\xabq\xe1\xfc9\x96\x16\xe6\xe9wW\xa8\xd42\xe2]j\xd9\xf4\x8e\xef\x831\xf7\x00\xff\xe88\x0ey%\xf1r\x1b\\\x1b}u\r\xc9\t͐\xe7\x02v\x19\xc67lp\x94\xe3\xbc\xd9hM\xd4\xeb\xad\xc3$Y\x1dU\x89ˤ\x86f\xc8\x0e\xc9_\x93\xcb#CXs\"\x82-\f\r)B\xa3°\xb9C<\xe8s\xea\xe8\x11Ο8NP\xad\xb1\xe3\xc0-\xb4\xa77\xe0\x04\x01:\x8a\xd0ID\xd4\xe90\xd7?\x8a\xe8\xad0\x96\xf4\xd8\x1d\xba\xdcq\xd3\x1e&\xc6\xee\xa4\xddj\x12\x86\x01\xd50\xc4\x0f}T\x0eD\x02i\x96$\xe7f2NR\x16\x16\x978\xfa\xdc1>y\xffgx\xec\xabO\xe1\x85\xd5\"\x8c\x94\x9e\x87\x03w\xff\xe8\x8f\xff$\xef}ϻ\x99\x9d\x9d\xbd:\xa6u\xed&k41\xab\xb7\x9b\xb7\xba\x9e\xbb7\x19DD\xfd\xc1\x15\xd5m\x9dQ&#|\xd62\xd6)̰\x80\xae\x01\xa1\ri\x9afٴm=\xa3̳\xca\xc7e\x04\x01i\x9ap\xfc\xebO\xb3<?\xcf\xec\xf6\x9dlس\x97\xa0Z\xb7\xa6)\xf3\t\xfd\v\xe7\xd8\xe0+vL\xb5ٰu\x860\f\xa8ժ\xa8\xec\x1a*\xeb\x13Q\x8eB l9\xc5h\x928\xa6\xdb\xed\x12\r\"\x82J\x88\x1f\x04\xf8\xbe\xc7\xec\xd4\x04\xb3S\x13\xecޱ\x8d\xbfy\xe0s|\xf2\xfe\xcf\x10\vU\xb4\xcc\x017\x9f\xb80'\x01\x1d\x04\xc17R\xef[\x1d\xf6\xae\xff2\xd7\xf7ߤ\x94\xc3JoH\x98^s\xe5\x82\x1e\u007f\x8d\x18\x8e֘\xbcnQ\xd4\xc7\r\xaa`\x83d\xcc\xc2<#\xc7V\xf4|\xdf\xc7h͑\x83O\x11\r\x06\xec\xbe테\xcdn\xb0Q\x16\xa0\xd3\x04\xb3\xd2a\xef\x96\rܲ\u007f\x17ccm\x94\x92ق\xa7Hcp\x85`\xf1\xe2E\xba\x9d\x0e\xfd^\x9f3\xe7γ\xbc\xb4\xc8\xe2\xfc\"\xa7\x8f\x1e\xe5\xec\xb3GY\x9e_\xc0h\x8d\xe384\xa7&\xf8\xb6\xefx\r\x9b6o\xe6\xfa\x03\xfb\xa9\x8f\xb5y\xe5K\xef!\xf4\\>\xf4\xf1\xbfDgt!\xe5\xb83\xa9\xd6[\x81k\xe8\x14\xfd\x06\x9dz\xb5\xd9\xc4qݗ\t),\xb5\x87˙\xab\xac\ue86f-\xb22e\r\x19Iʝ\xac\x9af\xb2\xdet\xb7\xe87\xf4<\x0f\x93\xa6\x1c9\xf8\x14B9\xec\xbf\xe7eT\x1a\xcd\xc2a\xf7\xe6\x17\x98\xf2\x04\xaf|\xd9=\xccLOṞ\xado\x00K\xf3s\x1c{\xe6Y\x8e<\xfdu\x1e\u007f䋜?\xf2\x1c\x9er\n\xf8\x85\xac\x9e\x9e\xf1U\x8b\x80#\x02V\xe6\xe7\xf9\xd8Ӈ\xf1\x1a\rf\xf7\xee\xe1M?\xf2oػ\xef:^x\xc7mt:+\xfc\xc5\x03\xff@*$\b\xa4\x1b\x06\xb7^\x8b@\xae\x94f\\Q c\xd3S\xb3^\x10ܜ&)\x83no\x84v\xbb\x96\x93h\xbe\xa1\xa15\xc5x\f9l\xb4)2\xe7L;<\xcf\x03\xady\xf6\xabO\xe2Uk\xec\xbd\xfdN\xbcЎ\xdc\xd0iJ\x98\xf4\xb9\xeb\xc0ulߺ\x85v\xbb\x85\xe38\xe84\xe1\xa9'\x9e\xe4\x91\a?\xcfӏ|\x11\xbaQ1\xf8\xc0\xb7m\x06\x05+ߐ\x16\x1c0\x91o\x169,\x8ci`an\x9e\xa7?\xfdw\xfc\xf5\xa7>\xcb\xf7\xfd\xd8\x0f\xf2\xd6\x1f}\v/}\xc9ݜ<u\x86G\x0f=\x93\x97\x13n\x02>\xf1ϩ\xa9;WR\xa1\xa0Z\xfdv\xc7uUwy\x998\x8a\xd6DRk3\xef!gwu\xfb\xc0e\x9d[\xb6\b9\x0f*\x1f\xa7\x91$IFﴥԣ\a\x9f\"\xa85\xd8u\xcbmx\xbe\xb5\xd1i\xbf\xcb\xde\xe9q\xae\xdf}\x13a\xe8\xd3n\xb7\xf0=\x8f\xe3ǎ\xf1g\x1f\xf9\b\x1f\xf9\xc8}\xf8°\u007ff\x03\xf50D\x96\xfbJ\x8a&\x1f\xdb4\x94W+\x95\xb4\x1b!\xd6)\xddh\xc0R\x1cӉ\x12\x96\x96\x17\xe9\xc41\x9et\xf8\x83\xff\xf7\xdd\x1c;u\x92\xff\xf4\x9f\xfe#\xdf\xf1\x9aWr\xe6\xc2%N^\x9aG\x18\xf6\xfes\x84\xb1J k\x17\xcf\r\xfc\x97\n!\x18t\xfbY\x84jF\t[\xebXE)\xe50ٻ\x1a-\xa6\x94\xf0\xd9Q\x1a\n\xc7\xf5p<\x0fc\f\xf1`\x80\xefy\x9c8\xfcu\xdc d\xe7M\xb7\xe08\x8e\x05\x0f\xe3\x01/ڳ\x9d-\x1b7\x10\x06\x01\x13\xe3c,/.\xf1\xb1\x0f\xff)\xef\xfe\xff\xde\xc3J\xb7\x8b\x01z\x06\x9e\x9b\x9bcC\xb3E\xe0\xba\x04\xae\x8b+T^\x9c'\xa8TQ\x9e\xe2\xfc\xe2\"\x87N\x9d\xa2\xeayt\x06\x11+q\\\f]\xcb\xcb!\xaeR(O\x91\xc41\x9f\xbe\xff\xd3l\u07bc\x99\x1f\xfc\xc17\xf3ڗ\xbf\x98\x0f~\xf4\x93`\xd8\xf1\xef\xff\xcf_\xe0\xff\xf9\x9dw\xad_\xcd\x16Cb\xcd\xf36Y\xcd\xc9\t\xe5\xb8\xee=\xdah\xfa\xdd\xeeZ\xee\xedz\xa9a\xa9\xbeQ\xb0\x05W\xe78%\x92[n&\xf2\xe9\nB\b\x82Z\xad`\x9fc\f\xe7O\x9e@J\xc9u\xb7\xdcn\x85a\f5\x05w쿞ɱ6S\x93\x934\x1a5\x1e{\xecq~\xf5W~\x9d\xa7\x0f=5\xbc\xc3\xec^.\xf6\xfb\xcc\r\xce1Y\xa90U\xa9Ru=\x1c!\xf1\xa4\xc4\xf5\x1d\x84\x92\xf8\xbeG?MY^\ue41a\xf5\xcbرN1\x99\xa5\xf0\x94\xe2\xc3\x1f\xbc\x8f;_t\a\x1bg7Ps\x04KK\xbd\xeb\xbe\xf2\xe5'\x02\xa0\xff\xcf*P\x89u\xe8&a\xa5\xba\xd3\xf3\xfd\xed\xbdN\x97^g\xe5\xaa#\x9aD\x06\x9a\x99U\x04\xb9\xb5$\xb9ҏ9\xc5\xc6q-\x14\xa2\x14\x95z\xbd\xf8\xfb\xe2\xc5\vh\xadٓ\t\x03c\x98\xac\x86\xbc\xec\xb6\x03lۼ\x89m[\xb7P\xafU\xf9\xc4\xc7?\xc1\xf7\xff\xeb\xef\xe7\xeb_;H\xe8y\xbc\xfdu\xdf\xc1L\xbb=\xeceϊ^\xa91t\x92\x98\xa5~\x8f8\x1a\x90F\xfdl\xbc\x93\xa4\x16\x04Yup\xf8E\x1da\x9fJ\x80\x02$\x82$\xc3\xc6\xf2\x80\xe0\xc1\xcf\u007f\x01!\xe0\xa5/\xbd\x9b\xa5\x85\xc5j\xaf\xdf۱\xbe\a\x17Ŧ}\x9eyH\xc6H\xac\x84/R\xae#\x97\xe7\xe6I\x93tm\x9f_\xa9\xcbiurX\x9eyR\x8c\xf8[\xb5=d\xa9\xf2\xe7dakP\xab[\xc8\xc4\x18z\x9d\x0e\xfd\xce\n\xd7\xdfq'\xaeo5f\xbcV\xe1ŷ\xdeȎm[\xa9\x86!gϜ\xe1w~\xe7w\xb9\xff/\xefG\"\x98i\xb7\xf8\xa9ﺗ\xdb\xf7\xee\xe5\xb9\xf3\xe78~\xfe<_;~|h\x82\x95B\t\xbb(\x1a\x8d\xc2\xe6;B\b\\\xc7\xc1\x11\x82\bp\x1d\xc5\xdd\xd7_\x8f4\xf0\xc8\xc1\xa7H\x8daۦY<\xd7\xe5\xe4\xd9\xf3t\xfaq\xd1\xc8\xf9\xe7\x1f\xfb\x14\xf7\xdc}\x17{\xf7\xed\xe5\xb6\x17\xdc(\xfe\xf1\xc1\x87\xae\a\x0e\xae)A\x14$\x1c\x89\xbeZ\xc5p\xbdG\xad\xd9؛D1Q\xaf\xbf\x8aT`J\xd1\x11#ݯk\x10\x92+iT&\x10\x95\x87\x9fR\xe2\x05\x81\x1d\xa1\x14Ǭ,̳u\xdf\xf5T\x1bM@\x10\xba\x0e\xaf~\xd1\vطg7\xb5j\x85'\x1e\xff\n\xff\xf6G\xdf\xce\x03\xf7\xff\rU\xd7e\xb6\xd9`\xfb\xf44\u007f\xff\xf8c\xfc\xf2\xfb\xfe\x84\xe7N\x9e\xa0\xdbYf\xbcRa\xbaVcc\xa3A\xe888\xd8\xdd.\xc8\xeb\x1e\xaa`\xaf\x84\x19\x81m\xbaQg\xcf\xe6M\xfc\xf4w\u007f7\x93\xcd&\xb3\x13cL\xb6[TÀV\xad\x8eS\"\xba\xa5iʟ~\xe4>L\x9ar\xd7]/D\b\xfd\x82\xcb:\xf4kw\xea\xeb\xfc\xc1\xf3\xf6\xc6QD\x9a\xf1\x96F\xdb\xceFW=\xdf\x059\x0eeJ5ys9a\xacb\x9b\xablvb\x9a$t\xe6.ў\x9afbæ\"\n\xfb\x8e{\xee`\xf7\xce\xedD\xbd>\x1f\xfb\xf0G\xf8\xe8\x1f\xff\tI\x1c1ݨ\xa32M=s\xfe|V\x0e\xce@H\x04\x8d`H\x8eSX\xf3\xe3(\x85\x83\xc0q\x9d\x11\x93:\x11\xfa\x84\x02T\x92\xf2\xa5C\a\xf9\xea\xb3\xcf\xe0\x18\x8dH\rˋKDqJ\xe0y4\xc2\nqa\b\x04\x0f\xffӣ\x1c?~\x82m۶\xd2lԿs\xfb\x8e\x1d\xff\xe9\xe8\x91#\xc9\xeaŒ\"\xeb\x95\u007f>D\xb9\"!t\xd4\xee\xb8?(\xea\tk\xaa\x18\xab\xb8\xb9b\x1d\x12\xdbմ#o-\xcbi<`M\x95\x10\x82\x99m;\n\xb3\xf6\xa2\x1b\xf6\xb0\u007f\xef\x1e\xfa+]>\xf0\xc7\xef\xe3\xcf\xfe\xf0\x0fA\xa7\x84\x8eK\xcb\xf7\xa9{>\x81\xe3\xe0K\x85/\x15nV\\ʕV!P\x18\x1c\x01.\x02i\f\x8e\xa4ı\xb2\xf7\x12x\x1e5\xdf\xc7W\x92\xe5\xf9y\x16\xcf_\"t\\B\xe5\x92v\xba\x98^\x8fz%\xa4\x1a\x86\xc3JeV0{\xe6\xc8\x11\x84\x94\xec\xbf\xf1\x86}\x06\xbe\xfb\xe0\xc1\x83\x9c>}\xbaT\x15\x1dFX\xe2\xf9f\xeaJ)GJ\xb5%M\x92!Yl\xa4\xca'F\x04\xb1.\xbb\xfd2\xb0s\xd9\xd9K9$5\xbb\x9eG\x1a'D\xdd.3۶\x11Vk`\fc\xf5*\xaf\xb8\xfbN\x06\xfd\x01\x1f\xf9\xc0\x87x\xe0\xa3\x1f\xa3\xedy4\xf2L<c\xbck\xa3I\xb5!\xce'3\bA7\x8e\x99\xef\x0f\x13ZO\b\\!PB\xe0`\xf9\xbb\x82a\xbd%'\xe7)\f\xbe\x17\x10\xf8\x01\xae\xebg\x00cĠ\xdf\xc31\x9aZ\xe0\xb1<\x18d\x85&\xfb]\x1f\xfb\xf2\xe3\xbc\xeaU\xafd\xd7\xce\x1d\x02\xcc=7\xdex\xe3\xc7\xf5j\x06\u007f\xcey\xbb\xd6L\xbd\xb4\xb0\xc6\xf1ܾ\x81\xbaY\x03\x15\x8bur\x89\xac\fKi\xd4ŕp\x83\x9cm\x98u\xc0\xe6#4z+\x1dj\xad\x16\x13\x1b6\x17\x1fu\xef\xcb\xef\xa1\x12\x06|\xfc\xbf}\x92\a\xff\xfc\xcf\xd9T\t\xa9\xba\x9eEeGl\xa7̢)H\xb3\x88\xaa\xe1\xfbDiB/\x8a1@])\x1c!Py\xc9@\x882\x81\xba\x10\x8c\xef\x05\xf8~H\xbd\xd6(\xc6\x06Ɖ\r,\x06+]\x12\x99\xcf\xe1\x1a~\x97\x87\xff\xe9Qz\xdd\x1e3\xb3\xd3hcn\x1e\x9f\x9a\xe0\xc2\xd9\xf3#_]\x1b\x93\x05\x03\xd7\xe0\xd4\xf3Eq<\x8f\xed7\xddp\xa7\xe3y\xad\xa8\xd7\x1f\xbe\xf52S\x1bD\x89\xd8|MPI9\xba\xca(72\x1fs\x01Lmڂ\x93\x01v['ǹ~\xcfn\x9e\xfe\xeaS|\xea}罹\x14\xb5L\x18V\x04vT\x9a\x04da\x132\rȴ\xc4W\xb6\xe9\xa6\"%\xbe\x10v\a\x9a\xd1\r\x98O\xc2v\xa4\x83\xa3\x1c\xaa\xd5:\x8dz\v7\x1b\x19%\xa5\xc2q\\\x02?DII\xa2͚\x10\x1f\xe0\xe2\xa5KLLL016v\x8b\x9b\xab\xd6:TR\xaeep\x80m\x94\xb7\xf64\xac\xd6~F:\xcaM\x92x\xd4\x04\x89\xb5\xf6o\xd8\x0f^.ߚ\xcb\xfa\x91Qs\xe5\x14\x14\xce\xce\xfc<\xd5z\x9dj\xb3eͦ\x10\xbc\xf4\x85\xb72\u007f\xe9\x12\x1f{\xdf\xfb\t\xfb=j\x8e[0\x90\x950\xc5\xc2+;\xd4\xc46k\xe6\xc3\xcd\xf2ڌ\xb1\x02k*U8S\x89\xadk+G\x8d\f'PJR\xaf7\xa9U\xeb8\x8eB)\x89\xa3$C6\xbc\xc2\xf5\\\xfaI\xba\x86\xa8 \x84\xe0\xf0\xe1ø\xae\xcbK_\xfa⪒j\xdbz\x021\\\x83@\x9c\x8c\xa4\fpÝ\xb77\xa5\x92\xaf\xf1|?\x9brpe\xbe\xd6(v\xa5G\bnW\n\xfd\x1c\xd7-z1\xa2~\x1f\xcf\xf7hM\xcd\x14M3\x9b'\xdbl۴\x91\xbf\xff\xec\x03|\ud7fe\x80\xca\x11\xd9|AM\x96\xb0ɬ\x03*g\xbb\vp\xa4\x15\x14@?\x89q\n\b\xb8\x00\x00\x1d\rIDATi+\x85\xca\xc8\xdd\xe5yZnF\x8ev\x1c\x87\xc0\x0f\xa8T*L\xb6\xc7\x18k\xd4\b=\xb7h\x02\xca\xffUJ\x82t\x88R=2r0\u007f|\xe5\xf1'\x10\b\xb6m\xdb&\x11\xbc\xd8q\u05fah}\x158\xc9)\x9b\x91}\xb7\xdf&\xab\x8d\xc6\xefH\xa9\x9a\x83\x95.a\xad\xca\u0085\xb9+;\xea\xbc!\xb2\xd4+^NIF؈#\xe6\xca:e\xadS\xe2\xc1\x80\xf6\xc4\x14\xf5\xf6\x98\xad\xc0I\xb8\xeb\xe6\x1b\x99;\u007f\x9e\xbf\xbe\xef\xbe\x02\xce4\x99\x86\xaa\xa2\x1d.ۻB\xa1\x04\xb8\x18\x84\xb6\x05/m\f\xfd8\xa6\x02\x84\xca\xc1\x95\x92J\x18\xe0V*8\xae\xc2\xf3\x1d\xa8T\xe9\xa7)\x9e\xeb\xb1u\xc3f\xcb\\L\r\xbegMUo\x10\x11'\x89\x9dٕ\x99\xae\x95nj5o\x9d\xee\xe0\xaf<\xfe$i\x9a291\x0e\xf0\xaf\\\xcf\xfd\xa3՝\x01Ƙс\xd5\xeb:u\x01\x9e\xefSk5\xdf!\x1d\xe7G\U0009e360RA)I\x92\xa4C\xf6\xf9\x88\x1f\xb5\x9c\xa6\xbc\xd7;\xb7\x8fF\xac\xd6\x103\xac\x973\x1c0\xa6\x1c\x87~\xa7\x83\x1f\x86\xb4\xa6\xa6\xb3\xa9r\xb0\xa1\xddd\xc3\xe48\x1f{\xdf\xfbY8u\nWH<\xa5\xc8{pM\x16\xaaj\xac&\xe4ͣ\"\x8f\xe2\x10\xa4:A$1\xf5J\x8d\x9d;w\xb3k\xd3\x16\xdc\xc0'U\x8aj5\xa41=E\xadZ\xc5\xf5|;\xffqy\x81v\xad\xcacO>E\xe0:8Rf\x03c\xd2,\x9a3D:\xe5\xdcJ\x0f\x93\x95zWG\x98\x8b\vK,--1>>\x81@nm4[\xb2\xd7\xed\xebը\xb7\xbe\x16\xe8䆻\xee|\xa5t\x9c_\xefuVX8w\x01!$\xf5v\x13/\fH;+v'\xae\xd3{n\x1bZ\xf2\xd0s\xc8*\\\xa3\x1d\xa5\x840\x1f\x92\x9c&\tI\x1cӚ\x9c\xa2\xdahZ\\\xc9\xc0\x8bn\xde\xcf\xd9\x13'x賟-\xaa\x86N\xde\xffg\x89M\x99\x89R\x19\xc6dw\x95\x11\x02\x94\xc4U\n\x91\b\x82p\x8a\x17\xdc|\x1b;6l\xa4R\xad\xd2\xef\xf58s\xfe\fc\xf5i\xb6lކ\xef(z\xa9F\xd4\x05\x17\xa2\x01\xd3\x13\x93\x16\xab\x02\xab%%\xa7\xafI9>\xbfH\xacE\xc6n\x15#\xf0\x0f\x19\x91\xe2ܹ\xf3\xdcp\xc3\xf5l\xd80\xb3\xe3ĉ\x93\x93R\x88s\xab\x05pU,k\xe7M7z\xcaq~\xb7\xdf\xed\xa9\xd3\xcf\x1c\xa5\xbb\xb8\x8c\x90\x92\xa8ߧ1\xdef\xd0\xed\x17\x8d\x96#\f\x90\xbc\x9e\x915Z\xa2\x13{\x1e\x88\x18\x92&F\xa6\xc1\x95\xbb\\\x81\xa8\xdf\xc3\xf1\\\xea\xed1T\x06\xabo\x98\x18g\xdb\xc6\r|\xe0\xdd\xef&\xeetPY\xd4d\x9d\xf5\x90B\xa3\xa4D\xe5w\x94M\x84\xf3Ð\xa9\xd9\x19j~\xc0\xd9\xe3\xc7پ}\x0f\xdb7n\xc2u]\\\xe5\x10K\x81N\x12\xea\xb5\x1a\xdb\x1bUBϥ\x13E\x9c\xec\xf4\xf1\xc3\x10\xaf\xe7Ѭ\xd7H\xd3\x14\xd7\x01'\x1bS\xab\x8df\xa1\xd7e~\x90\xd8\x16j!ט,\xe9(d,\x89\xa3\b)\x15a\x10\xfai\x9a\xbeQ\b\xf1\xee\xcbT7./\x90\xd6\xc4\xf8[\x85`\xff\xd9#\xc7\xe8e\x13|L\x9a\xd2YX\xa41\xd6\xc2\xf1\x1c\xe2\xc8d=\x1bk˸6\x97P\xa4\x16P(J\xbaB\bD\u058cY&\xd2\xd9in1q\x14\xd1\x18\x1b\xa7\xd6j\x17\xad\xd2\xf7\xdcv\x13\xa7O\x9e\xe4\xcb\x0f=l\xc9\x0ey\xb6\x9d\a\x03\xca\xda\xf2\xfc\xf0\x80\xe6\xf88[w\xee`br\x8aZ\xbd\x86#%\vss\x9cy\xf6Y\xa6&&\x8b\"\x98\xc9Z\xa4\x01\xc6B\x9fF\xddvX\xb5}\x8f\xa58e\xde\x18\xb4\xb6a\xb4֚\xde f\x9014S\x9dri\x90Pk\xd4\xe9\xf7\aV 9#&\xef\xea\xca\xccp^;\x99\x18\x9f\xc4p\xe8?LLM\xfe\xf1\x85\xf3\x17\x06\xe5\x99aWd\xbf\xbf\xfc\xf5\xaf\x11R\xa9\xb7\x9f;v\x92\xe5K\xf3\xe8t\x98\b\xa6qBgq\x89Z\xabY\xf0\xa1V\xab\x9dr\x1c\x1c\xd7E\x15\x11\x85.\x9c{9\xd9\x1a\x99\x12'\xa5\xedLҚzk\xccV\x00\rL4\xeb\xec\u07be\x8d\xc7\x1e{\f\x1dT\x88\x11ha\x13=c4\x9eRx\x99\x83\xae7\x1a\xbc\xe0\x9e\xbb\xb9\xfbe/e\xfbΝԛ\r\x94\xcc\xce\x17њ\xb0^GII\xaf\xd7c\xd0\xef\x13\xc7Q\x81\xc9\x1d:x\x88\x85\x85\xc5\xe1\x06\x11\x10G}\xba\xdd\x15˂7\x96\n\x14%\x166Z\xee\xf51\xd9ئ\xdc_Z`\xd2\xc1u\xddbছ\xd7q2sf\f\xdb@|\xbb\xca\xdfw-\x02iNO\xba\xf1 \xbau\xf1¥uK\xacK\x97\xe6\xf1\xc3\xc0\x86|B\xac\xc3\x14\xcar\x8a\fB/\xc3\x04eb\xb1\x05\x1d\xb3K\xa4\x1a\x9d$\x84\xb5:~\xb5Z4\xe8\u07fe\u007f\x1f\x17ϝ\xa3\xd1l\xf2\xd3?\xff\xef\xd8\xff\xf2\x97\x11!饚\xb3+\x1dV\xa2\x01\xda\x18<\xcfc\xf7\xbe}\xccl\xd8P\x14\xad\x8c֖Oe\f\xdd^\x17\xe1;|\xee\xb3\xf7\xf3\x17\x1f\xfd\x10\x9f\xfc\xf8\x9fr\xf4\xd8QN\x9e:Awi\x89Ng\x85/>\xf4\b\xd1`\xc0\xe2\xca\ng.\\\xa0\xdf]\xe1\xec\xb93$Z\xa3\xa4\xb0\xce\x1cPiB\xa7?\xc0\xf1<\x9bigm\t*k0Un\xb6!\x9dao\x89\xe7y\f\xf2\x92\xb70\xefhOM\x04v\x92\xdd5\xa0\xbd\x83^\u007f\xab\xe7\xfb\x97m\xbeI\xa2\x98$\x8a\tkU:\v\xcbYb\xb3\x8a\xa9\x98\x15\x99Dy\xa4kN\xbe\x93¦'9SQ\xd8z@\x12\xc7\xd4Ǭvhm\xf0\\صu\v_|\xf0A\xee\xbb\xefc\xfc\xe0\xbf\xf9!~\xe0\xado\xe1\xc4+_\xc1\xdf\xff\xed\u007f\xe7\xd0\xe7\x1f\xe4⠏\xc1\x06\x0f\xe7Ν\xa551A\x18\x06E\xe7m\xee`]\xcf\xc7\xf1}b\x93 \xa4\xa0\xdfY\xe6\xef\xff\xfaSH\xa5h\x8eM\xe0\x87\x15N\x1e\u007f\x8eC\xcf<\x8d\x1b\x04\xccw\x97\xb3\xce\\ɦ\r\xb3\xf4\a\x11\xc7O\x9d\xc1O\x12\x16\xa2\b1>\x8e\x8ec\x8c6\xf9\xce\xcff4ڎ+/\xf0\xf1\xc3\x10\x04\x04\x95\n \xb8x\xe9B\xbe\u007f\xef\x16\xc6\xfc\xde\xd8\xc4\xf8\xbb\x8c\xe1腳\xe7\xf4\x15\x9d\xba\xe38\xf54N\xd6\x1c\xf1P~̟=\xcf\xd8\xec4\xdd\xe5\x15t\"\xd6\xd2TsG-\x15\xa64\x96\xc9h]T\x05s\x943'\xb3\x19\x9d\x16\xc7\x15a43㓸R\xf0\xb9\a\xfe\x96\xe5\xe5\x0e\u007f\xf8\a\u007fĝ\xdfv\x17\xaf~\xf5+y\xfbO\xfd$g\xdf\xf8F\x8e\x1ey\x96\xaf?\xf9\x14'\x1e\xfd\x9f\xf4\x0f?ùS\xa7ٶg7\xb3\x1b7\xd2h4\n\x1b\xee\xf9>\x8e\xebҘ\x99\xb6\xd3P\xbby\xdbu\xca\xc2\xdc\x05\x9bH^r8\xbf\xdc\x06\xd7ajf\x86V\xb3\xcd\xec\xec\f\x81\xe7\xf3\xa5ǟ\x84\xa5%\xba\xb5\x1aޖ͈S\xa7\x89\xbb\xdd\"\xb1\xd5Ql\xdbߔ\xa4\xd6lRm\xd8*g4\x88\xa8\xd5\xeb,--q\xfa̙\xf22\xfd\x18\xf0c\b\xf1\x17[\xb6n\xfd\xe1c\xcf=\xb7t\x95<d\x1dBB\xa9\xcb5\x89\x13\xe6\xcf^\x18\x995\x95\xb7\xf7\xe6<U\xe9(\\\xd7cP\x9e\x93\xa55\xe4\x8d0%2i\x12G\xa5\xceZK\xc7ٻ}+\xe7O\x9f\xe1\xe8\xc9\xd3V\xb0\x02\x0e}\xed0GN\x9cbϮ\x9d\xdcv\xcbM\xec\u07bb\x97;\xefz\x11\xfd\x1f\xfeAΜ>\xc3\xc9\xe3\xc79\xf7\xdc1\x8e\x1d<\x88\xdbYazf\x06\xdfwQR\xe2\xf9\x01\xcd\xf1q\xa67l@`X^X\xe2\xd9\xc7\x1f'MR\x8cH\t\x9b-R)\x99\x99\x99a\xd7\xee]\b\x04\xa7\x8e\x9f\xe2\xd9g\x8e2\xefz\x8c\xdf\xf5\"\xc2T\xf3\xf5\xc3ϰ\xb8\xb4<\xa4?e\xeb\"\x95\xa2R\xafQm6l\xe2\xa85\x8eRLOOq\xe2\xd8\xf1,@\x18%\xa0K!\xbeK)\xe7\xd1=\xd7\xed\xfd\x1e\xe0\xc9u\x05\x12Gљ \xac\\\x95\xad\x9dD\xd1:S\xe0\xec\xc8M\x93\xf5l8A\xc0\xa0\xdf\x1b\x8d\xf12\x8e\xac\x8dH,]\x14\x1d\xdb03\xb0gK\x85\x81\xc7\xd6\r3<\xf5\xc5/2\x18\f\n\xf3\xd3YZB\x1b\xc33G\x9f\xe3\x99c\xc7\xc1\x18Z\xcd\x067\xee\xdb\xcb\xd6-\x9b\xb9\U000566f9\xeb\xdb\xeeAI\xc5`\xd0gya\x9e\x95\x85E\xe6N\x9fa\xee\xcc\x19\x12cXI\x12\x06\v\x8b\x88\xc1\x80\xfa\xc6M\\8w\x0e\x11*\x1a3\x13Dq\xc2J\xbf\xcf#_~\x92\xfa\xe6\xcdLﻁۿ\xed%\xac\xac\xacp\xf8\xf03|\xe9\v\x0f1\xbf\xb0X\xf0\xc3\x00\x928F\n\x89\xe7\xfbT\x1b\x8d\xac\xf1T`\xa4\xe0\xba\xdd;h\xd4\xea\x1c<t\b\x99\r'H\xb3\xe8J\xc9|\x04\x94\xbbGk\xfe\xf6\xe6[o\xff\x9e?zϻ\x1f\\#\x10\xa5\x9c\v\xae\xef-#\xa8\x8f\xb2\xda\xc5h\x8d\\\x94&+\x94\xfa\xd8M\x91!\x83\xe7\xf9tKP|>\x0eI*5$5f\xa6\xd1q=\\?\x00!\x98\x99\x9a\"P\x8a\a\xff\xe1\x1f\xac\xe0\xb3\xcf\x1c\xf4{Yi\xd7\xc7\xcbN/XZZ\xe6\v\x8f<ʃ\x8f<\nB\xe0\xbb.c\xad&\x1bgg\x98\x1a\x1f\xa3\xddj1\xbek'\xd3\xfb\xf6\xa2\\\x87F\xad\x86\xe7X\x9e\x97\xef\xfbD\x83\x01\v\v\x8b,/-\xe19\x0e\x13S\x934\x9a-\x8c\x80#\xcf\x1e\xe5\xe0\xc1C<\xfcȣ\x1c9\xf2\x1cZ\xa7\xa5Q\xb26\x1cN\x93\x04'\f\xa9\xd4\xeb\x19Z-\n\x82ǁ\x1b\xf7#\xa4\xe0K_\xfarɟ\xe6c\xd7e֩%\x01\xa6\x95t\xfe\xea-?\xf2c\xff:\x8e\xe3\xcf\xce]<WD\xa1\xce\xc9g\x9eIo\xb8\xf3\x85窭f}e~q\x9d\xfe\xa6\xb5\xed\x06#\x89\xcepژ\xed\xfdv\x1d\xe2(]\x13\x1e\x17Ib&e\xc7uq2\x88bvb\x9c\x85\v\x17y\xea\xa9C\x16\xb3\xd2\x1a\xe9\xa8,\x93\x8f\xe8uV\x8a\xf2@\xf9D6\x80(\x8e9{\xe1\"\xe7.\xcd\xe18\x8a0\b\x10R\x10\x06\x01\xed\xb6\x85зl\x9ce\xac\xd5&l\xb5P^@c|\x9c\xb1\xc9\t\xa4\x10|\xf9ˏ\xf3\x95'\x9e\xe4С\xaf\xb3\xb4\xbcl\x11\a\xadQ\xae\x83L\x87d*c\x8cM\xfa\x84\xc0\x0fC\xc2Z\x15\xa5\xa4\x1d\x8d+\x04\xedf\x9b\x9b\xf6\xef\xa7\xd3\xe9\xf0\xf4\xe1\xc3\xf4z=t\xaa\v\xdf)\xb3P\xb9D\xech\xa98\xfeTL\xfc\xef\xb7n\xdby\xdf\xc9\x13\xcf\xcdi\x03Ng~\xd1\x18c\x8e6'\xc6w\xad\x15\xc8z)f\x96\x89g\xbf\xb7c\x94\x86\x00\x9b_\xa9\x12G\x83\xd1\xd2eV\xc5+\x12\xa9bv\xae\xb4Q\x92\x80#\xcf>K/Im\xb462\xe9Z\x90\xa4\t\xa9֨\x9c\xa4\xad\xb5\x15\x8e\x19\x9d\x81\xe2\xe6\\`\x01\x83(\xe2\xec\xb9\xf3H)\xb987\x87\xeb\xf94\xb6\xef\xc6\tB\x1a\x1b\xb7Pi4\xa9J\xf8\xf4\x17\xbeșg\x0e\x8fL\xe8\x19\x01C\xb3\x9f\xd34E'\tA\xb5Jkb\xc2\xc2\xf3\xd94\xecz\xadƭ\xfbod|\xac\xc5W\x9ex\x92\x95\x95\x0eI\x92\x145\xf7|\xde\xca\bd/\x04\xbe\x1f\x04\xa9N\u007f\xdf\x0f\xfcw\xee\xbd\xfeƟ<\xf8\xd5'?\xeal\xddw\x9d@\xb0\xa591\xc6\xd9#\xc7X\x9bQ\x8a\xa2kp\x94\x84ee\x95\xc61\xb2\x94\x83xa\x05\xb9\xb4P\xd8N06~/i\x8a\x94\x12/\fI\xa3\x88\xb1F\x1dߑ<\xf2\xf8W\x86'\xb5\x9aa\x01\xcb\xf5\xfd\xa2j\x97\x9fd\x90\xe7-yҩ3x&\x8a\xa2aª\xe4H\xedE:.I\xbf\x8f\xd7h1\xe8\xf50\xaeO\xdfuI\x95[\fL\x10b\xf4\x94\x85\xb2\xa9J\xe2\x18a\x04AX\xa1ڨ\xe3:\x0e\xf5F\x8dJ\xb5\xc2\xe4\xd4\x14\xfb\xf7\xef\xa5\xdf\xefs\xff\xfd\xf7\x13Ƕ94\xe7,+\xe9 \x85*\u007f\xb9,7\x13\x84a\x15%\x9d1\u0fbd\xfb\xf6\xbf\xc0iN\x8e\xef3Z_\x871l\xbea\x0fǞ8\xb46{Tjt\x10K\xe9\x91&i1!!\xcf\xdc\xfd\xb0JoeyX\x90\xc9vv\x1e6J\xa5p=\x1fc4\xe3\xcd\x1aF\xa7\x1c9q|dvo>\"Þ\x1d\xa5\x8a\x85\x92\xab\x8a_BJ\xd28A\xeb\x94$\xd6$Ij\x1d\xaa\x94\xb8\xaeM\x1a\xd3Զ\x16t/\x9e'\x8d#±\t\xe2\x95\x0e\xc1\xe44KgOgc\xff\x18\x19+XNnu\xaa1q\x8c\xe3\xf94\xc6\xda8J\xe1\xfb\x1e\xf5z\x8d\xb0Ra\xacݢ\xddjp\xf8\x99\xc3|:?\xb653\xe5\xe5\xd9\xf6C\xb3=\xe4\x16+)1ڞt \x95\xf8y\xe9\xb8\xee/ǃ\x98\x13O?K\xaf\xd3c\xfb-7\x12\xd4*\xa3#]\x85(A#kK\xbaɠ?\x9cU(\x04\x95,\xfa(\xf7\x1e擧\xb3\x9e\x13\v\xc6II%\f\x19\f\x06\x9c>q\xbc\xe0њlBC\xb9\x12i\x17\xd6\x1e\u007f\xb7\xba\xe0\x95\x8fw-\x06\xd3hC\x1c\xc5\f\x06\xb1\r\x04ӄ$I\x88W:\xf4\xe7.\xd19s\x8axe\x99\xe5\xd3'\x19,/\x17\x02\x10%F<%\x93\x9c\xc46\xc2t\x03\x9fJ\xad\x86T\x92F\xab\x81T\x92\xb0Ra\xf3\xec\fi\x12s\xdf}\x1f+A\xec\xe5\xe9Fr\xb4\\\\\x82\x95\x10\x82D\xa7ĩ\xddT\x8eI\xcd\xf7\x9e=r\x9c\xf93\x17\x98?{\x81\xdaD\x9b\xd9];X<\u007f\x81\xc5\xf3\x17\xd1ijۻ\xb0\a\xa1\x94\xb1\xae|\xbd\xd24ů\x84$QD\x9a\xa4v\x94E\xbdIganx@\xb1NA\xba\x99i\xb6\xe44\u05f5XPo\xa5\xc3\xd2\xfc\x1c^ذ\x05\xaa|2O6\x9d(\x9f\xce`2\x87\xabӴ\x18\xb7\x81ɳgck#b8Z3MS\xebx\xb3\xf0S)M2\xb0\xf4\xd1\xf4\xf8Q\xbaKK\xe8t\xb4\xc5{h\xae\x86Ӂt\x9a\xda2\xf0\xf88\x8e\xeb\xd0h\xd4p\xb2\rըWپi\x13\x0f?\xfc\x10\xff\xf0\xf9ϯ\x81\x9eR\x9d\xe0خ\xf6RmH\x83P#\x9a\xae\x8dFj\x81<\u007f\xe24\x97N\x9d-\xfeй8\xcf\xe1G\x1e'M4\xb5\xb11\xfc\xec\xe8\xd3|tS\x01\xe6\x96\xc2\xe0\xb8߷0\x82RE\x04\x14Vk\x05E47\a\xa3\x03n\xc0s=\xa4\x14vd_\xaa\xb3\xf5\xb5\xc3\xf4\xb56\xc5a\x90\x06Sl\x04\xa3\xcdp\x02\x91\x19\x9d\x87R\x86OT\x8e7)e\xfbE\xb2\xa9u\x18\x83I\x13t\x1c\xa3\x93d$f1\xab\x86\xee\x18\xad1\xe9\xf0Ԟj\xbd\x86\xeb:\x84a\x88֚J\xb5\xc2M\xfb\xf6\xd2Y^\xe2\xdd\u007f\xf0\x9e˶\\\x14܄|-J\xa5\xee՟-/\x1c;5Z\x05\xccF&]<~\x9aK\xc7O\x13\xf5\x06vlw6im8,wh6\x92(\u0098!-4\x9f\xccSm\xb6G\xae\x9d\x9b\x83$\x1b\aX\xb0=2,Jgsu\xf3\xa9\rd\x98W\x9a$6\xaa\xd3Vk\x928&I,ܓ\xa3\xbby\x0fbN\x90s\x94վ\x9c\xfb5\xbc\xfep\x82]\x92\xc4#\xbbt\xb50\xd2Ԏ\x1d\xb4\xbcc\xeb/*\x95\x10m\fA\x18\xb2m\xcbf\xa6'\xc6\xf9\xb3\xfb>\xc6\xfc\xc2\x02\xae\x1b\xa0\x94\xbb\x86\xa2\x93\xa6\tif\x92\xd24\xce\x06\xb6\x995\x9f\x9bj\x83\xbc\\91\xbfd\xd4\xed\xd3]X\xa6\xdf\xe9\x13\rb\x92(A'\xa3\x03\xf1\xd38\xa1\xdf]\xc1\xabT\xf337p\\\x17\xbfZ\xb5\xc9_I\xda\xc5\x17\xcdL\xa1\xfdY\xdb.)3\xac\x9d\x98|dT\x92\x14\x82\xcc\xedr\x9e\x13\xe8l\x12C>\xa6O\xe5L\xc2l\xc2h1ɧ\x98\xa7(\xb3\xf9\x8e\x1a\x9dĤQt\x9999\xa6\xd8,Zk$\x82Z\xb3i'\x04\x05>\x8e\xeb0>\xde\xe6\xc0\xde\xebx\xfakO\xf3\x97\xf7ߏ\xeb\x06T\xab\x8db\x03\x8c^O3\x88\xfa$IL\xaa\x13\xe2$B\x9bt\x9df\x1e\x83,OK\x18\xc95J\xc7˕\xc8\xf4hm\xacPr\x13\x92M\x8a\xee\xcc\xcd\xe1\xf9\x01\xae\xe7\xdb\xe9nJ\xe1\xba.\xb5V{\r\xe1!M\x12\xe28\"Nb\x06\x83\b\xa3Ӭ\x960<'$פb\xf2\\:l`\xcc\x1d\xbc\x9d*a\x85\x9bf笋R\xedad8\x8d\x14E\xaak\x8cA'\xa9\xbd\xe6:\x1b2\a\"M\xd6s(\x04\x04a\x88\xefy\xb8\x9eK\xa3Q\xe7\xc6}\xfbPR\xf2'\xef\xfb\x00B\bj\xb5Faڅ\x10\x84a\xbd\xd8\x04\xf6\x9aV;0\x96ؑ$\x11kH\x886=0ŗ.\x93\xe6\x8a\x11\xc7bxL\x10\xd9Q\xd9\xe4\x03+͐\xbc\xd0Y\x98C딉M[\tk5[ET\n?\xac\xe0\xf8A\xa9\a\xdeB\xfa\x83nς\x96\v\x8b\xf8aH\xb5\xd1(\xb4\xc1\xaa\xb3\xb0\xa6*\x1f\x90\xa9Ӓ\x1f\x13H\xb0\x8bZ\xb2\xfb:MWAmf\x04)\xc8}\x90u\xd4I\x114\xac6\x1d\xa6\x98\xb9h\xc3a\xc7\xf7\t\xab\x15\xaa\xf5\n\x8e\xeb\xb0y\xe3F\xb6\xcc\xce\xf2\xa7\x1f\xfe\bO|\xf5\xab\x04A\r\xa5\xdcB\x88AP\xa3\xd5hS\t\xeb#\xf6\xdd\xd2]\x13\f\x90\xa41q4X\xd3\xde's\xc7\xe7\xf9>^\x10\xe0z\x1eʱ\xfd\xdb9\u05f5|\xc8/#<\xac<2II㔥\xb9K\xf8a\x85Zk\x1c/\b\x10\x8e\xc2\xf5=\xea\xedq0\xa24\xad\xce\xce\x0eI\x92\x84\xa5N\x87J\xa5\xc2\xd4\xec,&MH\xb3\x99\xbc\xf9\xe2\xc7Y\xb2\xa7u6><7'\xa5\x19\x89\xb9\xcf\xc9\xcd\xe0\x88S\xce\xccNy\xa6\xefЇ$뛫|~p&`\xd7\x0f\xa87j\xf8\xbeO\xab\xd5\xe2\xc0\u07bd<\xf8\x8f\x0f\xf2\xf1O\xfc\xb7\fm\xb0\xa7\xad%ib\x9b\x8e\xb2\xff\xafכ\x04Ae\xc4\xf8\xd8q\xb8:{}D\x14\x8d\x82\xb1\xceƝ;\xf1\x83\xa0 \x1e\x98\xd2M\xe5\xc3f:\v\vt\x97\x96,,\x9e\x9d2\x90\xef\x06\xfb\x05\rFh.\x1e?\xce\xf4\x96\xed\x84\xf5:\xf5d\x9c\xa5K\x17HeJ\xa5Q\xa7\xbfҠ\xbb\xbcT\x8cn\x88\xa3\x88~\xbf\xc7r\xa7C\xaa5;\xae\xdb\xc3\xc1/\u007f\x99d\xd0\xc7q]\xb4\xb6f\xd3N=\x8d\xb3ߥ\xe8$E\xbaY\x82\x95A\x1bZ\x1bHS{\xf4]\x9a\xb1\n\xc5h\xa6\xbd\xde\xc2G\xfd\xc1\x1aB_\xb1i\xf4P\x1b]ϣ\xd9n\xa2\x1cž]\xbb\x88\xfb}\xfe\xcb\xef\xfd\x9e\xed\x14\xa86\n\x9f\x11E=j\xd5FA\xea\x90B\xd2j\x8e3\xa7\x13\x06\x83^I(\tB\xd8\xf3}\x93Ğ^\xe7\xfb\xa1\xb5F\x8dv\x1b\xbf\x12\xe2x\xb6\x1c\xa9\xb2\xc3\xe2]\xdf'\xacVi\xb4\xdbLn\xda\xc4\xf8\xa6\xcd\x04\xf5Z6\x8f0\xc8F\"e_\"\xbb\x81~w\x85sǎ\x821\x84\xb5:\xb5\xf6\x98\xa5c:.\x8d\xf1\xf1\xe1\x19\xb6@\x12E\xf4V\xba\xf4z}\x06\x83\x88\xed\xbbvexWJ\xd4\xef\x17\x027\xc6\xd0\xef\xf5\x8aݟ$\xf1\xc8b\xe5\xb3L\x8b\b\xca\x18\xd2\xec59\xceV\xbc~\x95)\xeb\xf7z\xa3QV\xa6\x15\x94\xfeU\xaeK\xa3\xddD*Ɏ͛9\xb0o/\x0f<\xf0\xb7\xf4\a\x03\x94r\b\x82\x9a\xdd`q\x04X\xa2\xb6(\xf5U\n!\x18\x1b\x9bf5\xd57M\x87EA\x9d&\f\xfa+v\xb8\x81[q\xf1*.n\xc5ŭ:\xb85\x17Ǘ\x052\x99\x9b\rK\xd7i\x136\x1b\xd9\xd0H?;\xf4\xd1ҁD\x06Q\x9c{\xee\b\x17O\x9e\xc4\r\x02\xeac\xe3\xf8\x95\xaa\x9d\xa3\x1bX\x945\x8f\x10t\xaa\x8b\xfa\xf6J\xb7K{l\x8c=7\x1d\xb0\xac\xf6x`\xa72d\xbb5\x1e\f2\xc0R\x17\xb8R\xfe7\xb1\xda\x11\xeb\xe1@\xcfr\xae\xb2Z i\xa6\xfdk\xf2\x8eR\xc8-\x00\xd7\xf5h\xb4\x9aH!\xb9\xf1\xfa},//\xf3\xb1?\xffs\xdbeVk\x154\xd3~\u007f\x850\b\xcbŨ\xd2ϒ\x8d\x9b\xb6\x17yY!\x14\x9d\x90fa\xb5֚~\u007f\x059z\xcas6\xa5ە\xb8\x15\x85\x13(\x90\fk\xd6\x19\xf4\\\x1dk\xe1UB\x1c/\x17\x8a(xZ\xfdn\x97cO=\xc1\xa0\xdb\xc5q}\xc6f6\x14̔\xfa\xd8\x18\xd5V\xabȂ\a\xbd\x1e\x9d\xce2\xf3\x8bKH)ٻ\u007f\xbfͺ\xb5\xb6c\xa0r$\xd9\x18z+]\xd2T\x17\x99s\x1a\xc7#ST)'\x9e\xb9U^%\xb0\xf2\xbfQi\xeeW\x01*fZYnR\xaa\xb7\x9a\x84\xd5\n\xf5j\x8d\r\xb33<\xf6\xd8\xe3\xf4\a\x03<\u05f6,\xe4\x9f\x1b\xc7\x11\x81\x1f^\xb6}\xadR\xa9\xb1k\xcf\xfe5&R\xeb\xd4\x1ev\x99ݛ\xbcRC\x95t\x04~ե\xd2\b\xa9T*\x04A`Ig\x9eGX\xafSi6p}\x1f\xc7\xf7\x86\xe4cmX^X\xe0\xc8\x13\x8f[\xb2\x81\xeb36\xbb\xc9\x0e\x1bs\\\x9a\x13\x13\x84\xf5\x06RڃW\xe6/^\xe4\xd2\xdc<Zk6nڜ\xcd61\xe88\xb20\x87\xb6L\xc28\x8a\x18t\xbbł\xa5IB\x1a'\xc3\b\xb1\x947\x14N9s\xfe9\x8cR\x8e\xba\xa2\xc1`dLRy<nq\x9c\x85rh\xb4\x9a\xf8\xbeϞ\x1d;H\x93\x94?\xfb3\xcb5\xaeT\x9bE\x04\x15E\x03\xdc,y\xce\xd2\xfau\xfb\xf1'&f\x98\x98\x9c]_(:.\x8e\x86-\x92\x92\xfc<\xd5\xd5\x10\x82r\x14~\xd5\xc3q\xec\x00\x98J\xa5B\x10\x86\xb8A@\xa5\xd5\xc4\xcb\xce$GJ\xab-\xc6p\xfa\xf0\xd79\u007f\xf28~X!\xa8Ti\xcfn\xc4\xf1,]\xa69>\x81\xf2l\x16\xbd\xb2\xb8ș3g\xe8\xf5\xfb\x8cM\x8c3\xb3u+JY\x946\xee\xf7\x8as@\x8c1\xf4\xbaݢ֒&Y\x1e\x92\xa4\xc5<f\x91-f.\x942\xa4\xbe\xfa;\r\x06\x03\x1b\fd\xdf_\xeba\"\x9ak\\\xb5Y'\b\x03*\x95\x80\xad[\xb7\xf0\xf9\xcf\u007f\x81#\xcf=\x87\xe7\x05\x94\xcfZ\xef\xf7W\xa8\x04Ց$\xcfq]\xdb\xca]\x8aP\x85\x94lٺ\x87j\xb5\xb1\xa6\xadC\x1bM\x9aFH\xd7\xf3\xf1\xc3*A\xa5^J\xfbG\xbf\x84M\xb8\x04n\xe8\x12g\xe3\xb7]ץ^\xaf\x13T*T\x1a\r\xfcJ\x05/\bQ\x19䭵\xe6\xe0?=H\x92\xc4xA@X\xabӘ\x98\xb6C\x8c=\x97\xe6\xf8D\x16VKN\x1e}\x8e\xb9\xf9\x05\\\xd7e߁\x1b\xb3I\xa0!J\b\xa2\xeeP(i\x92\xd0\xef\xf6lvo,65\xe8\xf7\xf2*X\xc1z/P\xe1LRk0*c\x88\x06ш&\xe9\x1c7\xcb\xfd\x88\x104Z-\\סV\xad\xa2\xa4\xe0}\xef\xff@\x16Y5\x8b|2I\"\xd24\xc1\xf3\xfc\x82\x87\xe6dS\xb4ǧ\xa6G\xcemϱ\xba\xadۯ\xc3\x0f\xc2u#<\x99\x93\xbb\x94r\b\xaau\xbc\xa0\xb2\xce\xd4L{ևt\x04ʑ\x99ʹ\x82\xf1}\x9fJ\xadJ\xa5٠\xd2l\x10Ԇp\xc9\xca\xc2<'\x9e\xfeZ6\xce;\xa0\xde\x1e\xa7\xdal\xe1x\x9e\xf5Eͦ\xa5\xfdw\xbb\x1c=b\x87\xe8lߵ\v\xd7u\xa9\xd4\x1b4ǧ\xf0|\x9fAw\x854c\x1e\x0e\xfa\x96\x89\x98;\xe64I\x19\xf4zE\xd2gJ\xc9_1\xe4\xdf\f\xf3\x95\x1c\xaeI\xf3\xa0!\xf3\x01iI\xb3\x00<ߣR\xad\xd2l5\xd903×\xbe\xf4e.^\xba\x84\xef\x87#\x11S\xbf\xdf\xc5\xf3\xfc5\x87\xd7H\xa9\b\xc2JA,/\u05cbt\xaaٰi;\x9e\xb7Z(\x02it~\\\x90\x8d\x16<\xdf'\xa8T.ۤ\xe3x\xaa\xb8y\x9d\xe5*\x16*\b\xf1+\x15*\xcd&\xf5\xc9\t\u008c\xab\xf4\xcc\xe3_b\xd0\xeb\xe1\x87!BIZS3\x99&\xb9T\x9b\xd6\xdcI!x\xe2\U0006fc34\xbc\xcc\xcc\xec\x06&6\xcc\xe2\xfb6h\xa85[Tj\r\xa2\ue28d\xbcR;\xeac\xd0\xefg\xda`\xa3\xa5(\x8a\x8a\xd3yrA\x8c\xe4\x1f%\xff\x92DѰ(\x95\t\x16c\xec\xbc\xc5LH\x8dv\v\xe5Hv\xef\xda\xc9\xec\xf44\u007f\xf37\x9f!\x8a\x06T*\x8d\xc2_k\x9d\xd2\xefw\vg^\x0e\x10\xc2l\r\xf3h5'K\x84a\x85\xeeJ\x87J\xa5N{l\x12\xdf\x0fK4S\x83lMm\xa491C}l\x12\xbfR/\x18!A\xa5\xb6\xaeP\x94\xab\n\x13\x90\x96\x01Bm\x01Bǵ#\xba\xeb\xe3\x13\x04\xb5\x1a\xfd\xe5eN?{\x18\x93\xe5&R*\xc6f7Z\xb5v]\x1accHץ\xbb\xd2\xe5\xe0W\x0f\xa2\x1c\xc5\xcd/\xbc\x03\xc7Qlں\x83j\xbdI\xa5Z\xa3\xdel\x13\xadX\xa1$р\xa8߳$\xb8Ԟb\xd0\xefv-\xd4R@;iq\x8f\x94\xe0\u007f\x8c!\x8e\xe3\x12\x16g_\x93dQ[\xbe\xa8\xd5z\x9dz\xbd\xc6u\xbbvs\xe1\xdcyN\x9c<\x81\x94\xaa0M6R\xebgM\xa2\xfe\bm\x16!\b+\xd5\"\xe45\x19\xfa\\̑i\x8f1w\xf1<\x13\x133T*5</\x18\x1e\xa1\xe1\x875\xaa\xedq\x1a\x13ӌo\xdcNsj\x13\x8e\x17\xe0\x05\x15\xc2Zs\x189\x94\x1c\xbc\x17x\xa5\xa3&\xcc\xd0!\x96\xa0t\xc7uiLN\xe2U*\x1cy\xe21\xbaK\x8bV\x8d\xabU\xbc \xccƶ\xda1\xaf\x8d\xf1q\x8c1\xfc\xcfG\xbf\xc4ܥ9v\xeeكIc\xb6\xed\xd8\xc1\xc6\xcd[iOL\xd1\x1e\x9ffz\xd36\xd2,\\\x8dzV I6\xdc \x8e\"z\x9d\x8e\x15D\tqȑ傐\x91\x1d\"\x93G]Z\xa7$\x83\x81\x05\x113\xed\xf0*!\xae\xe71;3\x83@\xf2\x99\xcf<@\x1c\xc7T\xb2\rk\xb2\xc9H\xdd\xee2\x9e\xeb\x97\xf8\xcb٘\x0e\xd7\xc3\xcd&\x1a\xa5\xa9=\xb3\xbd\x8c\x00\xd7\xeau\x8c1\x9c?{\x86ٍ\xd6t9\x8eg\xcb\x177\xdd\xf3\xb2_\xa9\xb6ǘ\xdes=\x8d\x99Y\x82z\x13%]\x94r\x91\xd2\x0e\xa8\xb7\xf8\xd2\x10.v=\x87Aw0,}\xae:\xd3#\xcf%\xf2\x13o\xba\x8b\xcb8\x9eGkj\n\xc7\xf3\xd1i\x8a\xeb\xfađ\x1d\xf9-\xb3\xd6\xe4\xa8\xdf#I\x12\xae\xbf\xe1z\x16\x97\x96h\xb7ǩ\xd5\x1b\xf4{}\xb4\xd6\x04a\x85Z\xb3Mga\xdez5\xa3\x87\xbb23=\xabOY\x13\xab\x8emUR\x12E\x11+\xcbKv\xdcx\xb7W\x14\xc2\xf2G\xbdդ\xd1j\xb0y\xf3v\x0e\x1d\xfa\x1a_|\xf4a\xe28\xa2\xd1\x18+R\x838\x1e\xd0\xef\xafP\xad\xd4q\x1d\xb7`\xd3H)i\xb5\xc7\xf0|\x9f4MY\\\x98\xb7\xe1~\xbb]\x00\xacR*\xfa\xdd\x1e\xfd^\x97\xde\xca\nS3\x1b\xe8,/a\x8c\xb6ho\xd0h\xa1<\x0f\xe9\xb8\xd4'\xa7\x99ڳ\x97\xa0V\xa7>>Ic|\x9a\xc6\xf8\xf4\x88\xa6\b)\xa8\xb5jŎ+\xce\xd8(W\xbe\xb2#\x85\xbc \xa0Ҩs\xec\xe0S,^8\x0f\xc6\xe0W\xecQ\x12\xed\xe9\r6\x8fq=*\xf5:\xca\xf5x\xe6\xf03\x9c;{\x8e\x1bo\xb9\x85\xa3\x87\x9ff\xef\r\xfbټm\x1b~\x18\xa2u\x8a\xe7\xfal\u07be\x1b\xcf\xf1\xd0IB4\x18\x90\f\x06\xa4Y!\xab\xbb\xb4Ġ\xd7\x1b:\xe9\x12\xc1-O\x1a\xf39-I\x14\r\xb3\xf5<q\x14\x02?\fh\xb5\xdaH\xa9x䑇H\xd3\x04߯\x8c\xac\xc1`е\x9b\xd3\xf1\xb2>GY\b>7W9\xbc\xeed\xa75\x94\vW\xb5\x86\xad\x9d\f\x06}\x16\xe6癜ڈ\xe7\x05H\x8cf\xe1ԉ!c\x10\xf0\xab5\xfc\xccއ\xb5\x06\xb5\xd68\xed\xa9M\xf6\x80\x94\x82\xa8\xe0PmT\x86a\xe3*\xbc\xa8\xdcL\xea\x06\x01\b8\xfa\xc4\x13D\xbd.R\b\x82j\r\xa5\xac?\xb1\xfd\xf1>\xd5f\v\r<\xfa\xc5G\x19\x9f\x9c@\xba\x82\x95N\x87\xeb\xf7\x1f`\xe7\xeeݸ\x9e\x87\xd6\t\xcaqذe'\xad\xd6\x04:\x8e\xedH\xf0(\xb2\x87\x93E\x11\x9d\xc5E\x06\xdd\xee\b4\x92\x96\xd0\xe0\\\x83\xa3~\u007f\x94ǜ-\x9e\xeb:L\x8c\x8ds\xf8\xebO\xb3\xb08O\x1c\xc7\x04A\xa5xY\x9aZ\xb0\xb0\\\x8d\xcc5\xb1\xd6h\x164%{H\x8d\x9d\xa9\xb2\xce\xf8\xab\xc2\xe1\xf7\xba+\f\xfa}Z\xad\t\xd4\xf8\xd4\xf4\xafx\xbeO\xe7\xd2\x05t\x9a\xe2\xf8>:MX:{\x1a\x9d&EǬt\x1c\x1c\xd7#\x89#ҬF\xecx.B@\x12\xa7\xa5\x16u\xb3f|\x93\xd1\xdafڝ\x0e\xbe_\xa111\x9e5x\xda\xc8\xceq=\x06\xbd\x95\xfc\xe4s\xba\xcb\x1d\xda\xed\x16;v\xef\xe2\xd0\x13O1\xbbq\x13\x93SSD\x83\x01sss\xf6>\x95\xa2Zk\xe0\a\x15\xba+K# c^\xf6\x1d\x19\xa4\x99\xd1q\xf2)\xa7\x97Ο\xb7\xe1r>\xf82s\xc6A%d\xeb\xf6\xed4\xdbc<\xf2\xf0\x83\xf4\xfb=\xb46\x99\xb9\xca\x17p\x99$\xb1\xf0I\x10T\v\xb6\x8d\x94\x92\xf1\xc9\xe9B Q4\xa0\xd7]\xa1Vo\x10\x04ሆ(\xa5\xe8,-ړQ\x818\x8e\xf0<\x9f\xff\x1f\xc9q\xe0\a8\x94f\x1b\x00\x00\x00\x00IEND\xaeB`\x82")
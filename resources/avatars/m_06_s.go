@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_06_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14*\"\x87\xc2t{\x00\x00 \x00IDATx\xdaܽy\xb0\xa7\xe7U\xdf\xf9y\x96w\xfd\xadw\xed{{S\xef\xb2dm\x96%\xdb,6\xab\x19\xc0\x80\x038L`\x1c\xe2\f$\x93\x90q\x92\xa9a\x18p\xd5TRE\xd5\x14\x93Ie\x86\x84*&\x90\xe0!8\x86d\x9c@\x98\x18\x0f\x0exŖcْ,YkKݭ\xdeo\xf7\xdd~\xeb\xbb=\xcb\xfc\xf1\xbc\xf7J2\x8e1\xb6d0\xaf\xaaK-\xe9\xea\xf6\xfd=\xe79\xe7|\xcf\xf7|\xcfy\x05_\xc1\xb38\xe8\xbem2\xe1}\x8d\x9b\xb28\xe8\xb2=\x9arǩC+^\xf0\t\xe0\xb4֊ۏ\x1e\xe1\xc6ΈK\x1b[\x18\xebi\x1aG\x14I\x94\x12\xa4I\xc4Ƀk\xdcu\xea\x18\x9f}\xecI.\\\xbaB\x9c\xc48cXYY\x06\x0fW\xb7\xb6)LM\xe3\x1di\x1c\x01\x82\xda\x18\x8a\xaaB 8\xb2\xb2L\x1cG̊\x12\xf0L˒\xda\x18\"\xa5X\xec\xf5\xd0J!\xa5\xe4\xea\xe6\x16\xc6Y\xf0\xd0\xeb\xe4DJ!\x95$Obn9p\x80\xa3\aV(\xea\x86ɼੋ\x97p\xce1\x99\x15\xcc\xcb\n\xeb,\xdeC\x1ck\xf28\x01!\x90\x02\x1ac\x99W\x15ucp\xcec\xad\xc3Z\x00\x81\x14\x82,\x89\xa6\xab\x8b\xddo~\xf8\xa9˟\xfb³\xfb\xb6\xdbO\xf3\xe1'\xce\xfe\x17\xcfV}%\x06I\x93\xf8\xe7㘕\xb2\xaa\x1f,\xaa\x9a\xa5a\x8fn'V\x1e\xbeS Ny\x0fI\x1c\xb1<\xe8\xb1;\x9d\xd1X\x8b\xf7\x1e\x01h\xadh\x8c\xa51\x86\xa5A\x8f\xc5A\x8fg/\\$\x8a\"\x9c\xb5\xa4i\x86\x10\x82YQ`\xad\xc3\t\x8f\x94\n!\xa0\xb1\x0ec,JI\x16\xba=\x8c\xb34\xd6\xd2XCUՀ'OS\x92(\xc2{\xcf\xcexB\xd9\xd4\x00dI\x82\x14\xa0\x94\xa2\x97e\xdc}\xea\x04\xb7\xac\xadr}{\x97\xba1<{\xf9\n\x9b\xa31\xd3yAYWx\xef\x01A\x1aG\xe4IB\xa45\x00\xd3y\xc1\xac\xacp.|\x1e)\x04\xce\t\x94\x90(\t\xdd,\xa1\x9b'qc̛O\x1eZ\xe9\xbf\xfe\xae\x93\x97\x9e<\u007fm\xf7\xdeC\xebg\x8e\xad.\xa6\x1f\u007f\xe6\xfc\xe4K\x9d\xad\xfcJ\f⬜\x00\xff\xc7\xe2\xb0\xf7\xf3\x00[\xbb\x13\xa4\xd2S\x81\xf8\f\x80\x10\xb0;\x9d\x11k\xcd\xda\xd2\x00)\x83g\x18\xebA\b\x9c\xf7̫\x8ag/]\xa7\xd7\xc9\xe9\xf7\xbaXkAJ\x1ac\xf0\xde\xe3\x01\xbcG \xf0\xde\x01\xd04\x06\x80$\xd28\x1c\xd6\x18\xac5\xc1K\x84@JI\xac5\xceyƳ9\xf3*\x1c\\\x96$H\x04RH\xd2$\xe1\x8e\xe3\xc78r\xe0\x00\x17\xae\xdfd</\xb8\xb6\xb5\xc5\xc6\xf6\x0eUYa\x8cE\n\x81\x94\x82~'\xa3\xdf\xcdI\xa2\x88\xbai\xd8\x1e\x8f\xa9\x1a\x83\x14\x12!\x04\x10>S\xac#:y\u00a0\x9b\x93%\x11\xc6\x18\x04\x9ct^\xfc\xfc\xb9\xf3\x1b\x1f<\xb64\xf8\xc3IU\xbcw:-\x8e\xfcIg\xfb\x95yH\x9c|\x1b\xf0\x8d\b\xffMy\x16O\x92H\u007f\xfa\xe2\xd5M\u007f`\xa9\xdf\x00o\x15B\xe4\xd69\xbay\x87^\x962\x99\x97ԍ\xc5y\x8fs\x9e$\xd2ԍAJ\x81V\x8aXknn\xee\x10\xc51B\b\"\x1dB\x91u\xe1\xffA\n\x04\x82\xb2\xaaA\b\xbaY\x8aV\x9a\xda\x18\xaa\xa6\xa1l\x1a\xb4\x92DZ\x93F1\xe3\xf9\x9cyY\xe2\x804\x8e\x89\xb4\xc2yO\x9e$\xdc{\xfa\x14\xc7\x0f\xad\xf3ԅK\\\xdb\xdc\x01oy\xfc\xfc\x05\xbc\a\xa5$JJ\x84\x94\f:]\xbaY\x8a\xf70-J&\xb3\x19Jk\x84\x90\xe1\xc28\x8fu\x1e%5y\x12\xa1\xb5D\x02\xc6X\xd28\xa1\x97\xe64\x8dg<\x9e,z\xef\x8f{\x98Z\xe7~u\xbb(w^v\x0f\x01\x9e\xf4^\x90DZ\xe5i\xf4Oz\xdd\xf4\x9dG\x0f..\xdds\xdb\xda'\xf1\xfe\xbc\a\xa4\x94l\x8eGdiʡ\xe5\x05\xe2H\xa1\xa4\ba\xc89\xbc\x87\xb2\xaa\xb9p\xfd&\xdd^\a\xa5$\xceZ\x8c\xb1\x98\x10\x90\x01\x81\xf0\x02<4ւ\x00\x84GI\x85\xb5\x96\xda4ԍAI\t\x0eb\xad\x99\x97\x05U]\xe3\xbcG\tA\x14i\xacu\xe4I\xc2\x1d'\x8eq\xf8\xc0*O\x9c\xbf\xc4\xe3\x17\x9eg^\x15<\xfa\xdcy\xb4\xd2H\x15\x0eZ\b\xc9b\xbfO'K1\xce1/+\xaa\xa6!Mb\xbc\xf7\xe1gw\x1e\xe7=\xdeB\x16+j[3+\nfe\x19\xbc\xc68\xb6\xc7Sv\xc6c\x8c\v\xde\x1d!6\xfbQ|\xfd\x95\xf2\x90\x83\xc0\x8f\n\x01y\x16\x93&\xf1w7M\xf3\xa6'\x9f\xbb\xf6|7Kς\xfc\x01)E\xfb\x01<G\x0f\xac`=L\x8a\x02c\x1c\xce\x13B\x98\xb1$\x91FJ\x89i\x1aʲF*E\xa4\x15eSc\xad\x03<^\x88\xfd<$\x85$O\x13\x9c\x87\xb2j\xa8L\xf0\x0e)%\x02AQ\xd7\x18\xe7\x10B\x90\xc6\t\xf8pxw\x1c?\xc6\xf1\xf5u\x9e<\u007f\x91G\x9e=˼,\x19Ϧ(\xa5\xc0\x83o=h\xa1\xd7EI\x89s\x9eyY\xd28\x83\xc7S\xd7/\x84Rc\x82\x87\xa4\xa9\xc6X\xd3~\x1e\x15\xbe\x97\x80\xa2\xaeh\x8cA8\x8f\xf0\x1e)\x84\x8f\xa5\xfc\xdd\xc7nl\xfd\x87?\xe9l\xbfR\x0f\xb9\b\xe0\xda[\xd9\xcbR\xa4\x94o\x18t;\xbf\xbb=\x9e\xdfY7\xcd\x05k\x1c\xd6y\xb6\xc7cn\xec\x8eX\x19\xf6\xe8\xe79q\xacp\xd6!\x10Ե\xa1\xa8\x1bF\xf3\x82\xbc\xdb\xc59\x87u\x16c\xddK\xfe0\xef\xc2\xcd\xdc\xffg\x0f\xd6\xda\xfd|\x03!\xa9\x96M\x83m\xbfNK\xd9\x1eNͫn9\xca\xea\xe2\x02O_\xba\xc2\xc3g\x9feV\x94Tu\x1d\xc0E\xdd \x80^\x9e\xd1I3\xa4\x94t\xb3\x8c\xa2j0\xceQ׆\xa61x\x04\x1ep\xd6#%$\xb1\xa4\xb1\x06!$Z*\xa4\x10()(\xca\x1ac-\xd6[\xbc\x00!\x04\xd2c\x81\xf7\x02\xbc\xee\xd8\xfa+b\x90s{\ac\xac%M\xe26\xc9\xc9t\xd0\xed\xfe\xbd\xc6\xda#e]a\xadE\b\xb8rs\x13g\x1d\x9d4&\x89\x14*\x924Ƣ#\xcddZ2\x9d\x17\xc4q\x8c\x8ac\xeaƄ\x0f\x1a\x8e\xbeE\x11\xfe\xa5\xa0\xc2{\xaa\xa6\xc1z\xd7~\x9d\xa0\xb6\x06c\x02\f\x15B\x10E1e\xddp\xe6\xf0a\x0e,,\xf0\xfc\xf5\r\x1e}\xf6,\xd5>\x82\nO\x96e\xf4\xbb\x1db\x1d\x91e)k\x8b\x8blO\xa64\xae\xa1\xac\xab\xf6\"\b<\x1e\x1cH\tJ\x81\xb5\x9eXi\xa4\x10\b\x19\xe0\xeel^\xe2i/\x84\xd0\x01\xc0\x84({\xfdɛ;\x0f\x00|\xfaµ\x97\xdf R\x9a\xc2{G\xa4C\x98\xe8\xa6)\xfdNN\x92j\x94\x92,\xf7\x86J\bIQ5T&$\xe6k;;\xe4iB\x12E\xa4q\x88\x94B\x80\xc3QV\x86\xad\xf1\x94\xc5A\x9f\xdaX\x1acۏ%س\x8b\xd8\xfb}\x9b8\x8b\xbaƷ\x87\x85w/ 3\x01\xb1\x8e\xa8\xea\x9aCKK\xac/-\xf1ܕk<y\xe1\x12\xf3\xaa\xdew\xb1Hk\x06\xdd.y\x12\x13iM\x14E\xe0=\u05f6\xb6\xa9\x9b\x86\xe9\xac\xc0Y\x87k\xaf\x85l\xf3\x97\x10\x02\xad4i\x1a#D\xf8\xb9\x9c\xb5̋\xb2=PE,\xa3\xf6\x82\x86\x1f\xde\xc1\xbf\x03\xb8ua\xc8+\x92Cʺq\v\xfd\xee\xdf\xef\xa4I\x1a\xb7\x87\xbb\xb6\xb4\x80\xf7\x8eyUQ\xcck\x96\a}\x1ak\xa9\x9a\x1a\xeb|{\xaa\x01\xf2\x02\b\t\xc68\xac\xf5\xe0=\xb51\f\xfa=\xaa\xaaĸ\x17\x0eط\xf1\x1d\x0f\x06\xdf\xe2\xfeP\x8c\x05\xf0 PRb[\xa0\xa0\xa4\xc4XG?\xefp\xea\xd0!.ݸ\xc1\xa5\x1b7\xa8\x9a\xa6\xbdL!$%q\x1c\x0e\xa0\x05\b{`cg2a2\x9b\xe1ۻ\xae\xa5\n\x87j=J)\xd2$\x06\x17\"\x83\x10\xa1Xm\x8c\t^)T\xfb\xf5-$q\x1e\xed\x05\x12\xff\xae\xed\xb2\xba\xb0U\x96\xbc\xac9\xe4\xd8\xfa\xca\xfe\xef\x97\x06]\x9b\xc61\xc2\v6\xc7\x13\xd2(b\xb1ף\x93%8\x01\x97\xafo\xb3\xdc롥\xa2n\f;\x93\x82\xa2\xaa1\xce\"\x10\xc4Z\x93F\x8aHK\x8csDJsus\x9b\xd5\xe5%\x1acBB\xf7\x9e\xbd\xbf\x04\xa0\t@\xc1zK\xeb\x0f\xc8\xd6\x18 \x10\"\x180R\x8a\x13\xebk\xdc\xd8\xd9\xe1\xca\xcd-\x8c\r\xb5E\x16\xc7\f\xba\x1d\x9c\xf7L\x8b\x92iQ2\x9eΐRa\xac\xe3\xca\xcdM\xa6E\x89u\x1e\xef!\x8bb\xc0\x81\x878\x8eH㈪\n9B+\x89\xc7\x13kE7\xcf\xc9\xe2,\xa0\xbd\xbd\v\xe7A;\xd0\x0e\xf3\xec\xce\xf8\xc3_v\xf4\xf9\xd3\x18\xe4µ\x9b\x1c?\xb8\x1a\x1d\\Y\xf8\xe1~'\xcd\xf6nzY\x94\xccʒXG\xf4\xb2\x9c,U\xa8\x04\xaeo\xef\xb02\x1c\xd2\xcb3$\x9e\xcdш8\x8a\xb0>\xdc.ڂ1\xd2!!k\xad\x98\x955\x83n7\xd4 \u007f̝e[0\xb6\x99C\x04H\xec\xbc\xc7y\xd7~\x1c\xc1\xa1\x95\x15feɥ\x9b7q\xde\x12+M\x96$\b!\u061dL\x99\x14\x05\xdezb\xa9Y\xe8\xf4\x18M\xa6\xdc\xdc\xde\xc6[\x0fΓ(M/\xcb^H\xdaZ\xe1\xacg^Vm\x9d\xd2\xc2p\xa0\x9b\xe7h\x14ޅ\b\x10\x8cሜG\a\xdb\xfc3\x803\xc3\xc1\x97u\xc6\u007f\xaa\x90uz}\x85\xe7\xae\xddt\x83n\xe7\xd7{yvl/\x14\x95u\x83\x17\xd0\xcdSR\x1d1\x99\x17\xd4MMm,Ӣ \xcfRzy\x86@\xb23\x99\xd1\xcb\xd3P?(\x89V\xb2=̐8\x85\x84N\x9a2/\x8a\xb6.\x10\xfb\xe1K\xb4\xf1܋\x10\xc6T[1\xef%i)\x14\xc3n\x97HKn\xee\xecP5\rJJ\xa4\x924\x8d\xa1\xa8k$\x92N\x9c\x06~L\b\xc6\xf39\x1eO\x9a\xa6\xa4IH\xecR\n\xaa\xa6!R\xc1\x10ֺ\xf6\xfb\b\xacwmA\xa8HtLYՔM\x83@ |\xf8\x19\x13+P\xe1G\xaa\x81\x9f\xda*\xab\x1b[e\xf5\xf2\x1b\xa4\xd7\xeb0\x9a\xce\x19t\xb2\xbb\xb34y]\xa4\x14\xd69ʺ\xc6\x18\x1b\u0095w\xa4IBٺ\xb6k\x8b\xab\xda\x18:i\xcab\xaf\xc3d^\xa0\xb5f^VH\x04i\x1c\a\xa8\xe8\\\x1b\x9b%\x1eG]7\xc1\x04\x1e<\x9eƇ$+ۛ\x18\xeb(\xd4)\x80\xd6\x11\xb1\x8e\x89\"I\xd5\xd4\xcc\xca2@N)\xa9j\x83\xb3\x8en\x96\xa1\xa5\xa42\xe1\x10\x8d5dIB\x96\xa5\xfb\x80\xa0\xaa\x9bP\xd3\bAU[\xb4\x96\xa4ILe\x1aLc\xc1{zYN\x1ci\x1a\xd3P\xd5\rB\x06c\x00H\uf25c\x000\xc0\xcf\x03\xff~)M\xfd+b\x90\xd1t\u07bai\u058b#\xfd\xb6N\x96`\\@<\xd6:\xb4R\xed-\x0e7\xa8n\x1a\\[\xd5\x1a\xe3\x98W5UcX\x1e\xf6[\xdaD3icv\x9e&X\xe7Z:$\x00\x01)\xa0v\x16#<\r\x0e\xd7\xe6\x8d=\xa8\x9bF\x11\x8d\xb5(\xa9QR\xa1\xdbb\xb4n\x1a\x9cuH/\xa8\xaa\x1a%d \x1c\xf7\x12\xb3\x8e\x88#ݒ\x90И`0\xe7=i\x1c\xe3](@\xd38B)Ŭ([RS\xb1\xd0\xef\x91&\x11u[\x03\xc5Ja\x8d\x03\xe1\x89,\xc4n\x1f\r~\xf8\x99\xdd\xd1On\x95\xd5K\x8c\xb1\xd6\xc988\xccI\x95\x12\x93\xbayy`\xef՛\xdb\xef\x9b\xce˹֪eA\x03\xdeޝͱ\x0e\xb6\xc73\xac\xb3(\xe9\x11\xed\xd5q\xde\x139O\xe4\fWnlR\xd5\rY\x1a\xb3\xdc\xef\a\xca{^\x90D\x81YURa\xbd\xa7\x11\x0e\xa1d@f-[,\x84\xc0\xb5\x89\xdbz\x87\x94\x81\vSBPU\x15\xde9\x9a\xda \x114\xa6!R\x12\xe5=\x18\x83\xb05\xe5t\xc4l\xbcK\xd4\x18TmЍ\xa1+5y\xd4~\x8f:|\x0fߖ?\xd3y\x81\xf3\x90&\ty\x92P\x96\x15\xdb\xe3)\xdey\"\xa91\xb5CyObŋ\x8d\x01\xf0\xab_\x98;\xd6\xf2\x94볂IѬ\x83?\xfa\xb2\xc0ރˋL\xe6\x85O\xa2\xf8D'O\ue363\x88qQ\x84pc,q\x14\xa1\xa4`2\x9d\xe2\x81,R-t\x85\xda{\\\xe5\xa8j\xc3hV0)k\x84Vdq\x8c\x94\x92\xa2\xae\xa9\x9a\x9a,\xd6\xe4i¬(\x02\xfct{PY\xee'\xf2~\xa7\x83\xb7\x8eTEh)\xf1\xce\"\x9c\xa7\x93\xa5Xc\xc06d\nR\xe9\xc85t5\fcXL5\x8byD\xac\x1c\xcdlJSU\xec\x96\x15\x93y\x81kj\x12\xad\xe9d\t\xc2\xdb@pZO\x1aE\b<U݄H %\xce\x06\xf6Yy\x88\xbd@\xfa\x96\x95\x16\xa0\xa4|\xf6\xa9\x9d\xd1\u007f\a\xb0UV\xac\xe5)\xddHs}^\xb2\x96\xa7\xaf\xc3\U000cf552\xef\x1eW-}\xfd\xa2G\xff\xa9\xbdcs\xbb-\xce\xdc{\xc6\xd3\xe2'\x86\xbdN\b\x1d&\x90|7wG\x9c<\xb4\xc6l6\xa3il\xa8A\x1c`<\xc6XJ\xebpΣ]\xc3\xf7\x1f\xf4d9<\xb2\xeb\x18\xe9\x9caw\xc0h:\xe1\xc6\xee\x0e\x91֡p\xb4\xa1\"\x10\x88\xfd\x04\xae\x95\"Q\n!5\x12\x8155\xb6\xa9I\xe3\x98ؖ\xacŖaW1H$\xc3X\xd1K4\x9d$\"\xcf\"\xd2(B\v\x85\x10\x02S\x1b@\xd0\b\x18\x1b\xc1\xbc1\\\xbc\xb8ɩ\xa3\x8b<\xb5=\xe7\xc1YI\x1ci\x8a\xba\xc2y\x1f<Q)\xaa\xb2!iY\xe4YY\xe2\x1aC*4\xb1\x94\b\xe1\x9d\xd6\xf2{\x00^\xb50\u0a5d\x11\xc0\x9e1^\x0f|PJ\xf9\xf3\x97\xc7\xf3/Z\x94\x88\xaf\x90:a\xa1\xdb\xcb\x16\xfa\x9d\xdd~'\x8fu\xa4\xb8\xb1\xbbͰ\xdbaw:CK\xc5b\xaf\xcb\xd6\xd6.uc1\x0e\x84\xf0\x98\xf6\xa6/w\x13\xfe\xfa\xeb\x8frǉ\x83\xe88\xa2\xda\xddd\xe3\xea5>ui\xc4\xef_\xab_\xd4 b\x1fފ\x16Q\t!H\x94&S\x1ak\x1b\xbc\xa9\xd1x\x96R\xc1\xd1a\xc6\xe1\xaef\x90ŤiD'\xcbI\xd3\x04\xdd\xd2\xea\b\xd1\x12\x87\x0eӘ\x00\x97\xad\xc3\x1a\x8b\xf7\xc1蓢\xe2\x13\xcfO\x99\xaa\x9c\x8bӂ\xe9t\x0e\xc2\x13G\x1a\xeb,Mm\xc8ӌ~'g{4\tů1t,DB\"\xe0_6\xde\xfd\xf7\xe7ǳ\xf2E\xa1*\x01\xbe\x1b\xf8-\x04z\xb5\x13Ǐ\xde\x18\xfb/v\xae\xfa+1ưӣn\x9a\xdaX\xf7\xaf\x1bk\xffz\x9a\xc6\b$Mcɢ\x84\xc9|\xceX\x06$Uԡ\x883\xd6\xef\xc1t\xdex\xc71\xbe\xf1\x9eS\xc8,'M\x12\xcc\xd2\x12\xcbG\x8f\xb3~\xec\n\vgo\xf0o\x1e:\xcf\xee\xbc n\xbbt\xc18\x0e)\x02h\x90\xceS6\x05\x1de9ޗ\x1c\xe9j\x8e\xae.\xb0\xb8\xb2B\xacU0\x82\x94H\xa9\x88\x92\b\xad\xa3\xfd\xae\xa3ijlc\x98\x8dGؖ\x15\xd6Zc\xac\xa5\xae\x1b\x928\xa265\x9f\xbb2Bk\x8dP\x90%)\xde{\x8cwt\xf2\x1c\x0fT\xc6`\xbd\v\x90YH\xb4\be\x88q\xfe'*\xeb\xe4Z\x9e\xfe#`\a\xb8\x0fx\x1b\xf0\xe3\x80\xc4\xf3\x8b\x8f\xde\x18\xfb\x83\u074c\xab\xd3\xe2\xe5\xf3\x10\x80\x85N\xf7M\x87ח\xdf\xdf\x18\xdb\xcd\x12͵\xed]zY\x1a\xb8\xa6\xaaF\xf8@\xb1\xb3\x0fNCr\xff\x85\x1f\xfbfn;\xbaJ\xb7\xdf\rl]\xdb\x1c\xaa\x8d\xc5\x1aϹ\xcd1\xff\xf6\x13\x9f\xe7C\x0f?\x8eV\xf2\x8f\xfdȹ\xb4\xach\xb8\xef`\x97W\x1f=\xc0\xda\xfa\x1a\x83\xc1\x02\xfd\x85A\xa0A\x9a\x06\x84@G\x11J\xc9@\x8b\x18\x83\xb5\x96\xb2,pM\xe84\x9a\xa6f>\x9dQW\x01\xea:\xe0\xf2\xb5m~\xff\xd1+<o#|\xeb\x15\b\x10B\x92\xc6q\xb8\x10Ra\x1aC\x1d:\x83\xc4ƣ\x9c\xa7\xf6\x8e\xc6\xeeaA.\x03\x05p\x10\xe8\xbc\xc8\xddO^\x9f\x15\xe7\xfeKg\xaa\xbf\x1a\x83\xec̦\x1f[\xa9\x87\xbf\xa6\xb5z\xa7VJH!\x98\xce+\x9cw\xf86W\x88\x96\xb6\x16\b\xac\xf7\xac\xe6\x11\xf7\x1c?\x80\x8bb\x90\x12\xad\x14\x9d4EJ\tRR\xd7\rw\xe49\xb7\x1f=H\xac\x15\u007f\xf8\xd0\xe7Ca\xee\xf7xA\xc7w\x9eY\xe7-\xf7\xdf\xce\xe1C\xeb\xe1Х$N\x12\xb2<\a!0m\x1f\xdd\x1a\x8bq\x0e)\x04\xc6Z\xe6\xd3\xd0\xffp\xceQ\x95%\xd6Xz\x83!\xb3ɘb:G\xc7\x11N*\xb6KG\x94J\x10\x92Z\x04\xd8\x1ei\x1dЗ\x80,JB\v\xc1\x87+/!\xf4l̾1\x00\x0e\u007f\xe1yei\xf4۽nz%I\"\x9e\xdf\x1e\xbf|\xe4\"\xc0\xa0ӥjj\xb6F\xe3\xcf\xdcr`\xe5G\xe7U5\x88\xb5\xa6\xd8cT[4D˔z\xa0\xb1\x86\x9f\xf8\xc6c\x9c:~\x98$Kɳ\x94~\xb7\x8bR!\xbe\v\x11<\x05۠\xbd\xe1\r\xa7\x0fqlu\x81\xc3K\x03\x8cq\f\xbb)?\xf7C\xdf\xc2ۿ\xf7M\xac\xad\xaf\x93\xe7\x19\x83\xc1\x80\xc1pH\xb7? \xebvI\xb3\x9c8N\xc1Y\xa4\x94\xe4YF\x1c\xc7$IB\x92\xa6\b)\xb1Ơ\xb5&\xebt\xc09\x9a\xba\xc2\xda\x00x:\xb1\xa6_M\xa9\x11\x88\xac\x8b\xb5\x90g9\xbd,\xa7\xb1\xe1{\x16eMc\xc2\xd7+/\x90>p_n\x8fq\xfe\xe2ϼ\x93'?+\x12\xbdu\xf6\xea\xf6\xfce\xf7\x90\xd1l\xca\xda\xe2\x02\u05f7w6\x8b\xaa\xf9\xb1(\xd2\u007f`\xadK\"\xad[\xf63XbO\x9d!\xa4`)\x16\xdc{\xe60\b\x11\xd4!q\x82\x90\x12\xf0\x886\x9e\xe3=q\x1ca\xea\x9a$R\xdcy\xfc0+\x83.\xdf}\xef\xad\f\xfb=\x8e\x1eX\f\x1e'\x04Rk\x94\x8aPZ\ajCG\xad\xba\xc5㳌j>ExK\x92fؖ\xd2O\xf3<P#EIY\x14\xc4i\x8a\x8ab\x84,\x82\xf8\xa2ixl\xb7\xc1J\xc5\xe2B\x87\xa5\xa5Un\xec\xec\xd0XCc,֙=\xfe\x10%\x04\xc2\x06ڧq\x0e\xeb\xfd\x97hY\x88K\vKݿ\xb4\xb5;?\xbcW\xa3\xbc\xec!\xab\xaa\r\xdfp\xef\xabxࡧ\xfe起\x9d\xfc\xcbӢ\xfc\xdd\x17\xfa\xe1!C\t\x1f\xb8'\xa94\xafZ\x8a\x18\f\a\xe8(\"\x8a\xa2\xb6\x9f\x10>\x84Vj\x9f\xcf\xd2B\xe0\x85\xc0\x1a\xc7\xea\xa2\xe6\xf0\xea\x90N\x96\x91$A\x1b\xe5[:Ŷ\x8d(\xad\x14Q\x14\xef\xe7\xa8\xd0\x13\x91\xa4Q\x84w\x16)\x05F)J\xe7\x88\xe2\x18\\@V\xb2\xaei\xeaz\x1fN\v\x04Y$Y\x89-\x8f\xcf%\x8bUA\x9af\xa1\xdfQUA\xdf\xe5^\xf8l\xa1o\x0f\u0081\x96\x82\xda\xfa\x17\xf5A\xbe\xc0 \x91:\xb5\xb15\xb9\xd5\x18\xf7\u007f\xbd\x12\x1dC\xee\xbd\xf5\b;\xd3\t\x0f<\xf4\x14\xdfz\xef\x9d\xf4;\xf9\u007f\x8a\x94\xfc7/\xfeq\x02\xa3ۦt\xe78\xb9\xda\x03\xa9\xda\x1aC\x84.\xa1\n=\xf5\xf0K\xb4\xadPI\x96\xe5\fz]\x16\xfb=\x16\x06\x03\xb2,m\xfb\xd6\x12)d\xa0@\xa2\x88X\x87J]*\x15\xfa\xf1Q\x84\x8ec\x94R\xe8H\xa1\xe3\x18\xa94\xd6;j\xeb\xf0B\xa0\xe2\x18\xa5\xdbn_\xdb\xfa\n_\xa7\x88\"͉包D\x80\xa9\xa8\xca9E]#\x80Tk\xe28\"Ib\x9c\a\xe1\xfd><\xd7B\xd0K\xa3/~XB\xe0AՍ\xdd4\xce?sh\xa9\xcf\xcb\xee!\x0f=}\x89{o=\xa2\x81\xe4#\x0f=6\x03\xca3\xc7\x0e\xbe\a!\xbe\x1f\xef\xf3}\x8b\xb7D\xa1\xf7\x8e\xe5\x85~\xf8\x00m\xae\xd0Z\xa3\x95ƹ@(\x065\x8a#\x8eӶ9\xe5\xf7C\x90\x90/t\x0f\x83\xce+\x88\x13p>|\n\xef\x90q\x02\xce\xe1\x9dA*\x1d\xbc\xb3\x85\x14\xb9\x10LʚyU\x91H\x89ԁ\x05\x8eӄ8\x891M\x83Q\x0e)\x05G\x8f\xacp\x8b\x9fqqb\x99O'\xfb\xcd(A\xb8,J)ʪ\xc6!\xf6\x93p\xedB\xb3-\x92\x92ƽ\xb4q dH\xfax\xfe@@sek\xfc\xf2{Hk\x14\x83\xe7G\xee=s\xe4w\xee=s\xe4\x1d\xdeٳ\xceً_\xecO\x90x\x16\xfa=\xbc\x0f\xc2\x01\xad\x14J\x86\x1b\x1dG\tR\x06\x03%i\x86l\x15\x1cJ)\xb4\xd6\xe1p\xdbo$D\x104\xd0\xe6%)[\x14\xe7[\xd4#\x15J\xc7\xe1\xefQ\xb4\x8f/t\x141\xc8s\xd2(\xc6X\x03\xbe\"\xeb&\xa4yN\x94\xe6DiJ\x92$DQD\u007fq\xc8j?\x01/\xb0\xa6\t\xa8\xb1Շ)))[\x99\x91\xf1\x0e\x85\xa0v\x8e\xcaZ$\x82D)b%\xf7\x93\xbb\x88$B\xef\xdf\xfb\x8f\xb6\x94<\xafH\x0e\x01x\xe8\x99K\xef~͙#\xf7zϻ\xbbil\x04R\x8df\xc5\x17\xdc\x10A?K\x18\xf4;\xa1\x15[ͩ\xeb\x9a4\xcb\x10B\"\xb5@\xfa\x00G\x85\x10\xfb*DkmH\xf8\x84\x9c\"_t\u007f\xa4\x90$y\u07b2\xcb\x0e\xa5\"P\x12\xa4\x06'\xf1m\xeb\xd5#\x98O'\xd86oDZa\x1bIm\x837$\x99\"\xefF\x18+\xb1.\xb0\xd2RF\x1c\xecFĺD6\x02%@\xb6\xf5\x90R\x92\\F$q\x84\xf4\xd0\xccJ\xeaz\x0fY\x063\xc4m\x1b\xd7(\xf5\xe2+\xbf\r<zu\xfbK*I\xbf:\x83\xbc\xe6\xf4\x11\x10\xf0\xf03\x97\xdey\xcf\xe9#\xbb\xde\xf3\xb3\x9d4\x12\x8d1̪慪S\b\xd6\x16z\f\xfb9q\xd4\xf6\x1a\xca\n\xd7uX\x1bh\xed=\xe4$d\xa0I\xa4\x94\xad\x04(\x10vއ\x9e9\"x\x81\x94\ng-:\xcd\xc39d]P\xbauI\x8f\xa8K\xb05B\x06\x19\x8f\x8e4Q\x1c$\xa8\"\x89\xa9\xcb\bk\xe7A\x0f\xdcK\x10\"b>m\xf0i\x8ei<\x8b\xdd\x19\xbdxƴrh\xe1ZH\x1b\xe4\xa8q\x92\xa0\x84dgwļ\xae^R\xb4z\xc0:\x17\xf2\x1c\xf0\"\x883i\x8d\xf2\xf2\x8b\x1c\xf6\x9e\xeb\xdbc\xd6\x16\abmi\xf0\xad\xc0S\xc0\xdd\xc0\xa1$\xd6T\xad2\x9c\xb6\xa1tbm\x81o\xbf\xf3(K\x8bK\xf4\xba]\xf2no\x1f\xbbk\xad\xd1Z\xe3\xf6\x10\x9a\b\x89vOI(\x94\xdc'\x17\xb5V\xfb\xcav\x15'\xc88Ed\x1d\x10\n\\h !\x82&\x8b\xa6ĻЙ\x14R\xa0\xa4\x82V:䜣n\xca\xfd\xbe\xbc\x17P\x97\x9e\xa6\xf2\xad'\xc0t:\xe1\xfa\xcc!\x1cT\x06lcqBS45e9g>)\x10\x1e\x92H\xb6B\x0eh\x9c\xa3q.\x88\xf5\x82\nc\x8f\x0f\xf9\xe7W\xb7'\xbf\xf5J\t\xe5\xf6\x9fG\xce^\xf2\xc0/\x02\xbf\xd7\x1a\x04\x01\xe4\xc9\v\xf1{O\xec\xd6\xe6s\xd2<\x0fb\x05\x1b\n\xb44ω\x928\xa0\x11\x1f\x10\x99k+l^\xf4K*\x15 s\xab\x01n\xaa\x12[L\xc0\x1ap\x06l\r\xf5\f\xca6i\xc69Jk\x12\xe1H\xa4@\xbb\x9a\xc85\x98b\x86\x162\x18h\xaf-l\xc1T\xbe\xf5DG\x94\xe6\x1c\x1dD,\xa4\x92N\xa2\x18v\xd2`\x98\xc20\x9f\x16\xccG3\xbcs,v#\x06\xb9\x0e\x9eѪ$}+ԋ\xe4~\x90=\x0f\xfc\x1c\xc0\xc1\xc5\xde+g\x90{N\uf2f9\xff\x10\x18\x02\xc9\u07bf\x88\xb5BJ\xb1\u07ff\xb0\b\x1a\x024\x1d./\xd1\x1f\x0e\xe9\xf4\xfb,\xac\xad\xa1\xd3\x14\xa94q\x1c#\xb5\xc2\x18\x13d=\xad\xccG\x8aV5\xe8\x1c\x01\xc0H\xea\xba\xc6\xd65\xce\x1a0\x06\xaa\t\x98\x1a\xa4\x82\xb4\aQ\nq\n\xe9\x00\x99\xf7\x89\x94\"\xd1\x12mJr\xe5\xf0֠U\x10S\xe3=M\xe9i\x9af_@\x01\x82^oH?\x0e\xba\xaf\xa0x\aikDݴZ1ؚ\xd4l\xec\xd6\xfb\xe1Y\bAGkR\xa5\xda\v\x05\xc0\xd3Bp\x17\xc0\x9f\x94C\xbe\xea\x90\x154Y\x03\x80\xb7\u007f!\tش\xf2\x1b\x01,\fzt:\x11\xeb\x83\x1e\xce9\x86\xc3!\xbdA?\xb84\xb2\x85\xb5\xa1uZ\x95\xe5~\x82\x97-߅\x10`\x1d\xa2m\xd3Ve\x89\x17\x10\xa7\x192\x8aC\x98\xd2)D1$]\x88;\xa0c\xa0f\xb6y\x9d\x8f\u007f\xecc|\xea\xe1\xcf\xf3G\x9f{\x86\x0f|\xfc\xb3\\\xb8v\x13\xdb\x18\xa4\x00c\x1d\xb3\xd1\x14S\x870\xbb\xdf(\x16pmg\xc6ƴ\xa12\x0e\xa5\x13f\xc5\xec%\xad\x01\xe7\xa1\x13K\x162M\x14I\x1a\xe3\xd1m#\xcd\x03&\\\xf9Sq\xa4\xbe\xe3\xe8\xda\xf0\x0f7v\xa67_Q\x94\xd5>\x1f\x04\xdeٺ\xe5\xc1\xbd\xbc\x11k\x1dHEi\x89\xa4\xe03\x9f\u007f\x92\xd9\xf6M4\xb0\xba<\xe4\x8e3'y\xf5\xe9\x93\fWWA\xc8P\xb0)K\x92\xa6\xd4U\xb9\x9f\xf0\x9b\xba\x0e\x89R\xca\x10\xff\xad\xc5Z\x8b3\x8e\\\x04\x12pO\x88G\x94\x05\x83H\x0f;\xd7\xf9\xf4\xc7?ƿ~\xdf\u007f\xe4ѧ\xcfS5\xad\nQB7\xbf\xccG\xd4\xe3\xdc}l\x953\xb7\x1c\xa0\xdf\xe9\xed\x8b'\\P\x03a\xbd`\xb9\x9f#6\n\xcayIwa\x05\xbb\xb5\xd5V\xf6\xe0\xf0\x1c\xe8F\x9c\\\x88\xc9\"\xc9\xf9݆\xd1\xfc\x854\xfeb\"%\x89\xf4#wܺ\xf2ԣ\xcf}i\x01\xbc\xf8j-q\xcf\xe9#<r\xf6\x12\xf7\x9c>\"\x80\x14\xf8;\xc0?\x02D\xdd\x04A\x995\x96לXû)O=\xf3lP\x04*\xc9B'fe\xd0\xe7\rw\xdeƷ\xbe\xfe\xb5\x1c;\xbcN\xde\xe9\x82w\x14\xb3\x19\x8d\xb1H\xa1@x\xea\xbaF\xe9\x88$N@@]\x16\xa4iB\xac%2\u03827\xa8\b\x94Ɩs~\xe3\xbd\xff\x0f\xbf\xf3\xfb\x1fe<\xaf06\x8c\xa6I\t\t\x02\x1aO\xdd\xc0\x81\xa5\x94AGr\xc7mg8r`\x11D\x18\x89p.\x18\xa4n\f\u05f7v\xf8\xc837\xb8\xb4㨜\xa7l\x1a\x9a\xa6\xa1\x13K\x8e\rc\x96\xf3Уq\x1e\x9eڪ٘\xd4t\xdb\xfa\xc7\n\xa8$đj\x86\xbd\xec\r\x8f<{\xed\xa1;O\x1c\xe0\xb1s\x1b\xaf\x9cA\xbe\xd00\xed\xef\x15\xf0n\xe0\xaf\x02\xe4\x91\xe6\xbec+\xa4\x99\xe6\x91\a?\xcd\xcc8\n\xeb1x\"-\xd0@\xa4\x04'\x0e\x1d\xe0-\xdft?o\xbc\xff^\x92(\xb4p\xeb\xaaFhMU\x164M\x83\x17\x8a,\xcb\xe9u\xf3\xc0\x87y\x87\xa9\xe64\xf3\x82\xad\xca\xf0\xf9\xe7/\xf2\xc1\x0f\u007f\x86\x87\x1e:\x8b\x8a\x83\xf8\"W\x8aC\x9d\x8c\xc5$&ђD8\x04\x8e~?!\x1dtY>r\x14ժ#\xbd\xf7X'p\bʲdw2\xe1\xc3Oo\xf0\xfc\xb6'\xef\xf6\x98\x15\x13\x8ew\x1d\xbd8\x88/\xf6\xa4\xae^H\x1e\xbcZRԆN[\b\x1a\x01\x8d\x84n'\xfd\xf8ӗn\xbe\xe9\xcb9Ǘ+d\xedy\xc9\"\xf0\xed\xc0w\xb6\x9d2<\x10G\x8aN\xa2\x19v:ܹ\xb0\xc0\xb4\xac\xd8)*.\x96\r3\x1f(\x8e\xd2X\x9e\xbcx\x8d\v\xd7~\x8f\x8f~\xe6\x11\xee\xbb\xf5$\xf7\x9c9\x8e\x8a#F\xe3)q\x9c\x80\xb5\x14\xb3)7w\xe6\xd88ewZ2\x9eL\xd9\xdc\xdcbkg\x97\xcb\x1b7\x19M\x1b\x1a\v\xb5L(kE\x8d$\xee\x0ey\xae\x04\xbb9e\xa0-\xdf{8a\xb5/\x11\x91@+I\xaa\u0084U\xa0o,\xd6;\x9c\xf5\x94\x8d\tz1c\xa8\x1bG\x86GKO\xd6\xc2\\!\x04Y\x1a\xd3\xcd\x12\xae\x8fKf\xd5\x04\xfdRr\xb1\x96R\xfcV\x96\xa8\x9f\x018\xb22\xc0Yϕ\xed\xf1+o\x90\xf6\x99z\xcf\x1f)%N:\xe7\xbfi\xcf\x05\x8d\xf3$q\x8405\x91\x94\f\xb3\x14\x99tx\xe3\x9b\xde\xc4'\xaf\x14<\xf5\xf0è\xe9\x06U\xed\xa8\xb5\xe7\xd3O_\xe6\x99+7\xf8\xec\x13Os\xf7\xda\x02\x83\xbc\x8bh\xb9\xa9\xba,x\xfe\xecs<pe\xcavi1>\x14ȕ\x85\x99\x8c\x88\xd3.gn=\xce'\x9f\xbc\x84\x93\x1aWWt\x97\xd6X[\x18\xf0\xe3\xf7\x1f\xa7y\xee)\xa6\xd7\xcf\xe3\xa4%\xcb2\x16\x0f\xac#\xa4\"V\x1a\xef,\x8d3 \xd8\x1f(\x9dV\x0ec\x03\x17%\x84\xa41`\x1d\xfb-\xdbŅ\x01\xb7\x1cX\xe4\xa1\a\x9eik \xe1\xdbl\xf61\t\u007f\xf7\xc2\xe6\xf8s\x8d\xf7ˇ\x96\xfa\xb7_\xba9z\xe2\x15-\f\xbf\b\xea\xb2\x1b\xdb\xe3\xe9\xf2\xa0\xfb\x80\x10\xe2W\x85`\rx\xad\x10\x82\xdb\x0e-\x12+O\xb1\xbd\x8bҊ\x8e\x8a9v\xd7]\x9c8\xbc\xc6\x03W\xa6\xe8\xde\x12ɱ\xbbI\xf0t\xa5\x84\xb9c4\xad\xb8\xb81\xe2\xf8ʀH\xaa0A\xa5\x14G\x8e\x9f\x82\xb2bA\t:\x04\xd5\xf9B\xb7\xc3w\x1c_\xe1\xaf\xfc\xf0\xf7\xf1\xd3?\xfd\xb3\xbc\xfa\xcc)\x9e\xbd9Bu\x87\xdcv\xfc\x10\u007f\xfb\xbf\xba\x9f\xa3\xae\xa0~\xf6I\xb6\xfd\x9c,\x89\x18\f\x17\x19.\xad U\x14\u0095sX\xdb༠\xb2\x96\xb22\x94u\xc3\xe6\xb4d{\xee\xe8\xf5\xba8oX\xc9\x04Z\xc2\xfa\xda\n\x87V\x86h-\xf9\xc0#\x97\x82\x92_\b\xe1\xbc\xff\x053L\xdf\xd1\x18\xfbM\xbd,\xf9\x17\x12\xdeve{\xf2\xbf\x03\x1cZ\xec3)\xaa\xaf\x8dA^\xd0n\r\xdf!\x04\u007f\x04\xbc~\xaf8\xbce\xb9G_\vh\fM\xd3\xe0j\xc3\xe8\xc6\x16\a\x8f\x1f\xe5\xe0BJ\xac\xe0Ԃ䍷\x1e\xe6\xd6<a\xa9*9\x98\xa4dJ\xd1\xed'\xac\xac,!\xb5\"Ms\x16\x17W\xb9\xeb\x8e۸\xed֓,\xf5\xbb\xc4M\xc1\xf1Dp2O\x88\xaa\x92\x1aý\xf7\xbd\x96\x1f\xfb\xeeo\xe1\xbb\xee<\xc2[\xee>F\xb6u\x8d\xe7\xff\xf0\x83\x8c\xea\x19\xd9Ґ\xe1\xf2\x01\xfa\vk$\x9d\x1c\x15\xe9\xd0#\xb1\xa6\rY\x82\xb26\x94Uͼj؞5\\\x9f4h)Q\xc2\xf3\x8d\x87cV\xfb)I\x96\xb3\xbe\xd4\xe7\xcaN\xc1\x03O_\r0]\x88\x1d\x81\x90\xda\xfaw[\xc1\x8f\b\xf8ȕ\xed\xc9[\x831z\\y%\xb9\xac/\x91\xdc\xdf}\xcf\xe9#\xb3\xb63ַγ=-8>\\`\xf9Uǩ\xe6%\xa3\xcd\x1dv.m\xf3\xe4\x1f|\x88ţ\x87\xb9o\xa8\x99o\xed\xb0{\xfe9\x94\xb7$\x91\xc2\x19KOi\xa4NX;z\x18)\x04uah\xe6\x86\xed\xed\x1dLc9\xb8v\x00\x19i\x1e~\xe8Q\xb66wY\x9c̨&#\xc6\x0f?H\xbe\xb8\x84\xa9J.\u07fc\xc9\xf5˗\xb9Y7\xac\xdc\xfe*\x0e\x1f?\xc2\xfa\xfa2\x8d\x8c\x18\x8d'\xf8r\x8e7Mh:\xb1\x97\xa8\x1d\xd69\x8a\xc6r}\\\x83\x178\xefȔ'\x92\x90\xf8\x9a\x83\xeb\x03\x92Drasƞ\x1a\xdc\xe3\x17\x94\x92\xdfa\xa1\x04\xfeg\v\xbf\fpp\xa9ϗ\xa2\xdd_1\x83\xbc(\xc9\xff\xdb{N\x1f\xb9\x04\xfc\xa6\xf7\xfe\x96\xedyM\x94\xc4\b%8t\xfc\b+\aV\xd8H\xafp\xfd\xdc\x15n<\xfe\x14BJ\xb2,aemH\x92&\xec^\xdfd\xba=ByO\x9av\xc8[a\xdan5\xa7\xb4\x96\xb2\xa8PJ\xd2\xe9\xe5ܾ\xb6\xc4]\xf7\xdd\xc9'?\xfa\x00\xe7\x9f>\xc7g/^%\xbb\xb2A\xb4\xd7\x1e\x96\x92\xe1\xfa:\xf7\u007f\xc3\xfd\x9c>y\x90n\xaah\x9a\x9aY\xe3\xa8kMфQh\xa5#|]\x87\x89\x94\xbd>\xb9\xf3\xccj\x13\xf2\x86\xd4He\x89\xa2\x84յe\x92<\xe7FU\xf0\xf8\x95\x1b\xfb\x9dB%$N\x89_\xf3\xf0\x0fn\xa4\xd1esu\x9b\x83\x8b=\xae~\x19\xc6xE\f\xf2\"\xe8\xcb#g/=p\xcf\xe9#g<<\xba3\xabn5\x0e\x841Teá\xc3\a\xc8\xe3\x98\xfe\xb0\x87\xa9\x1b\xf2^\x87\x83G\xd7p\xce1ڝ\xb2\xb8\xb2\xc0\xe3\x0f|\x0e[VA\x82\x93\xa5DB |\xb1?\x9a,\xb5$N\"\xa4\xf7,/.\xf0\xfd\u007f\xf9\a8\u007f\xe9&\xd7.]f\xf3\xfa\x06\xa6i\xe8\r\x06\x1c9y\x94{ￗ\xde\xe2\x00\xaa\x92z\xbc\x83\xa8JJ3\xa1\x1bG̭\xc5;\xdb*\xe0%\xce\x19\xbc\xf34\xc6`\x9c\xa76{\xeaI\x18\xcf+\xb2\xa5ct\a=\xb6\x9c\xe5ӗ\xb7\xb9\xb1=o\xdb\xd1|\xd8\v\xf1\xb7.\xefL\x9f\x018B\x97K_\x06]\xf2\xb5\xf2\x90=\xae\xbb\xabd\x00\x00 \x00IDAT\xa3\xd4w\x9d:\xfc\x96I\xd9|hZ\x99\xa3\xc3\b\xea\xb2\xc2[\xcfڡ\x03,\xae.፣\x93'(\xad\xb0\xd6\xd1\x1b\xf4\xd8\xd9ޥ\xbb4`\xb4\xb1\x8d\xab\fI\x92\x84q1?\n\x9c\x93\x80(\x8e\x88\x93\x18\xa5\x15uYr\xf0\xe4q\x0e\xac\xaf\xe2^s*\x14\x83B\x92\xf4\x17\xa13\f\xb4J\xd3@\xbd\x81\x8abb \xa9j\xd2\xc6\xe2l\x83\x97r\xbf\x01i];[\xe8=\xb5q\xcc\xeb\x10\xbe\xe6U\x89P\x9a\xc6\u0096\xb3<]\x16<\xf4\xc4u\xca\xda \xe0\xc1k\xf3\xf2\xdb_|\x06\x97v\xa6\u007f\xfa\xf9M^\xc1g\xcf(\x8f>{\xf9\xb9\xcd\xf1\xfc\x1fn\xce\x1b#\xa4\xc04\rMU\xe3\xade\xd8\xcbY]]\xa0\xd3\xed\xb4\xdd\xc10\xa1\v\x82\xa4\x93\x91/\xf4\x89\"I\xde롓\x80\x86\xc4~E\x1b\u0091Ԛ\xb4\x932\xdb\xdd\xc19\x8f\x8cs\xe2$#\xe9\f\xa0\xb3\x04ɰ-\xbdg\xd8r\xbe\xef\tQ\x14\x859\x96Ƅ6\x97w\x01i9\x1b\xd8i\x04\xd3\xda\xee/:\x18M\xe7h,Wl\xc9\xf3\xbe\xe6\xf3O\\\xe7ڵq Q\xa5\xf8\x99=\x85\xfbW\xf3\xbc\xa2\x06yq\b;{\xf1\xfao<wc\xfcq\x8f\x00)\x98\x95%\xde{\x8a\xa2\xa4\xa9\x1b\x8c\xb1Tu\xd8\xcc\xe0\xfc\vs\xe9*\xd6D\x91&\xc9:\xe8(i\xe3{\x80nνП\x97R`\x8da>\xda\xc1UE\xe8\x18z\v\xa3\xebp\xe3,l]\xc4\xeen`\xea\x12g-MSS\xd7\r\xf3y\xf1°o\xdb\"pևi\x14\uf655f\x9f\x932\xd62.k\xae\xcd\x1b\x9e|v\x93\xcf~\xf6b{!\xd4\u007f\xbc6->\xb2\xde˹>/\xff|\x1b\xe4E4\xbd\xf9ȓ\x97~\xae4\xbe\x12\b\xe6\xf3\n\xeb=\xd69f\xf3\x82\xd1xLYVTU\x1d\x94\x85E\x10\\+\xa5\x18,\xf4Qq\x8c\xd4Q`{[C\xecMΪ(\xa0\xf7\xc64\x98\xc60\x9bM\xa9&\xa3\xe0\rU\x81\xaf\v\\U`\x9b:\x84\xa1\xaa\xc2Tu\x90\x82\x8a@\x84z\xefZ\xa6ׇ\xf9F\x17\xb4ȓʼdL\xbe)k>\xfc\xa1\xa7y\xe8\xc1K\xc1\x18JVZ\xa9\xbf\vpm2\xff\xaa\xcf\xeakb\x90G\xce^\xe2m\xdfz/W\xafn\xfc\xe7\xf3;\xf3\xf7\x89v\xb2v:/\xd9\x1d\xcf(\xaa\x8a\xa2\xa8\xa8\xea:l\xe4i\fuU\xe1\xa4C)I\xbf\x97\xb7\xc2\xe9\x04\x19\t\xbci\xb0U\xe8\x99\b)ёޏ\xff\x8d\t\v\x04\x8c1\xd4eI9\x9bR\xcd\xe74M\x8d\xf3.\x88\x16\xda=)ƴ5Q\xbb\x02\xc4ذEb\x8f\x8c\x04\xc1\xbczA\x18\a\xe0\xad!O#\xfa\xfd\f\xa5\x15\xddN\xf2\u007f\xf6\xbb\xe9\xc5c˃\x97嬾&\x06\x01x\xee\xea&\x00\xdd^\xe7ݾ\x85\x88E\x19F\xd7\xca*\xf4!\x1a\xe3\x98\x17UX\xbb\xe4\x1c\xc6\x1b$\x92$IQq\x1af\xc1\xd3(\xc0Y\x17Ʀђ4\r\x9a-\x81\xc7XC]ט\xbaƚ\x10n\xec\xde\xc1\x1b\xdb\xee_\xb1\x94UM\xdd\x18Lc\xc2\xd4U\xcb\xe26\xa6aO\xc5c\x8caR\x9a\x970\xb0Jk^}\xd7A\xf2^\u0081\x95\xde\xee\x81\xe5\xde\xef=\xf6\xfc\x86}\xf3\x1bo\xe5ug\x0e}\xfd\x18\xe4է\x97\x82b~)\xfat#d{\x8b-R\xb4C\xffRP7\x868\x0e\xdb{\xe6\xe5\x1c\xef ҒN'C\xe805\xab\"\x85\x8a4\x89TH\xef\xa9\xdb\xd0&\x85@\xeb(\x84\x9dv\xf5FY\x96\x81!\xf6>\xa8\xdf\xcd\x1e\x9c\xb5\xd4Ƅ\x0e\xa4\x0fƨ\xea\xba\xfdw>\xb4b\x9de{V\xb13\xb7\xfb2\xe58V\xac\xac\xf5\xe9\xf43泊\xa5\x85\xee\xf0\xd0\xda\xf0\xb7\xdf\xfa\xc6\xdb\xff\xd6C\x8f]Z\x11\x82\xe8\xeb\xc6 \xefy\xff\xc3\x00|\xf2\xf1\x8b߶UT\xdeڰ\x80\xacjod\xd3X\xa4\b-ۢ,\x18\x8dG\x01]%1i\xa7\x03m\x17NH\x89\x88\xc3\xd6\x1d\xa1\x04\xc5lJ\xd9\x0eO*\x15Dlu\xd3\x04廵\xd4uM]\xd78\xeb06x\x8au.\xac\xe5\xf3v\xdf+\x9a&\x18\xc8\x18\x83w\x96\xba6<umJ\xa4\x82X\xbc\x1b\tzY\xcc\xe1\xa3K\b+X^豶\xd4\xc3\x18\xb7xck\xf2\xcbR\xc9O\x89\xb69\xf7ua\x90\x175`\xdezqw$D;\xa4\xe9\xaco{\x106L)Y\xc7l<\xc2T\r\xebY\x9fn\x9a\x90\xf5\x06\b\x15\x83\xf7$Q\x84\xcc\"t\x1eq\xf8\xe4\x11\xa8\x1c\xb3v\x92I\bA\x92\xc4(\xa9B>j;\x8d\x8duA\xfeӢ3c-\xbe\x05\x0e\U000e2829M;\xc7\xe2q>\xecR\x99\xce+R\xe9\xc8\"\xc1]\xab1o>\x9eq\xc7m\xab\x8c&\x15\xd3IE\xa4%\xb3\xa2fg4\xc3X\xf7[\xd6\xfb\xef\xfc\xd4\xd3W\x9e\xff\xa6\xd3\xeb_\x1f\x06y\xfb[^\xd3V\x0e\xfeo\x16\xc6>85\xa6E3\x0e\xeb\x1dZ\aQ\x805\x96\xcd\xedm\xa6[S\x9ay\x83\x96\x82$\xcbA(T\x9c\x04IO$YX_fay\xc0\xea\xfa\x12;\x177\x98\xccf8\x17z\xf8i\x92\x90$\t\xb51L\xa6\xb3\x90\xe0\x1b\x1b\x14\xea\xceQV\x15\x1e\xcfh4\x0e \xc2\x05cXk1\xd6PT\r\x93\xa2\xa2\x13\v^\xbb\x9ep\xefj\x8c\xcd\x12n9\xb1\xcaƍ\t\xd77&\\\xbb1\xe6\xe2\x95m?\x9d\xd7\xff\xd09\xffW\x1fz\xe6\xea\xf9{O\xafS\xab\xaf\x8e\xaf\xd5_ː\xf5\xf6\xb7\xdc\xc3{\xde\xff\x88\xfd\xd9w|\xfb\u007fS9\xff\xd1\f\xb7\xee\x9cC\xa3\x11\"4\x89\xaa\xb2d6\x9bs\xed\xfa\x15\xd2F\xd2\xebw\x88\xd3\x14\xa2\b\x11\x85\xc5/Ԗ\xb2.x\xec\xe3\x8f\xf2\xfcs\x97\x19.t\x88\x96:̊\x82\xa5\xc1\x804I\x88\xe3\x10\xceǓ\t;\xe31q\xa4I\xd3\x04kBΘL\xa6\x8cF\xa3\xb6\b\f\x82\xbd\xfd\xffVT\xd4Ƣ\x84`9\x934\xb5a\xb7\x9bs0\x8e\xc9\xd2(l\xbc\xf3`\xf1\xe70\xf6_~\xf6\xecU\x03\xf0\xd0\xd9k|]\x85\xac\xf7\xbc\xff\x11\xde\xfe}\xaf\xe1\x17\xfe\xef\x0f\x9dUq\xf4/\xac\xb3\xaei\xea\x80\xe7\xdbu\x19UU\x05o\xc8b\xael^cw{\xcatk\x1b\\C1\x9ep\xf3\xd2&\x97\x9f\xbc\xc2G\u007f\xff\x13|\xf4\x81\ayn\xeb*\x1b\xf31Ŵd:\x9fs}s\x8b\xba]\xa9\x91g)\x8b\v\xc3\xfd%\x96\xa3є\xd9|NQ\x14lomcm\xa8ȭu\x01\ueeb0\x86\xb6\xac\r\x95\xb1H\x1c\xbdN\xc2\xd3;\x15e\x15\xb6\xa1\x02ԍ\xf5-w5\xf6p\xf7\xb7\xbe\xfa\x96\x97\xad\x15\xae\xbe\xd69\xe4\xd1g\x82\xea\xe2\x8d\xf7\x9f\x99\xa5\xce\xfeH$E\x9a\xa4\x19I\xa4\xf1\xde1\x9a̘\xcdf\xc4i\xca\xd6h\x8b\xf1hBTY\xb6\xce]\xe0\xd9G\x9e\xe4\xf1\xcf=\xc3ٍKLd\x05\x99\xa6\xb7<\xe4\x9e\xfb\xef&\xed\xe5A_e\x82t\xb4\x9b\xe7\xa8VB\xa4\xb4\x04/(\xab\xb0Tm6\t\x86\xb1\xd6bL\xe8{X먚\x9a\x9dI\xc1\xbc\xb2\xccʆa'&\xedf|\xe6\xec\r\x06\x8b=V\x0e.\xf2\xf8\xd3\x1b\x18\xe3\x85T\xe2\x1f\x80\xfc\x1b\xael\x9e\xf8ԳW\xfd\xcbu>\x9a?\xa3\xe7\u007f\xfa\xa7\xff\xe1ӿ\xf4S\xdf{)\xad\xeb\xa1i\x1ad\x9e`l \xf6T;\xe7q\xe4\xf8\t.\x9e\xbf\xc0\x87?\xf9IzI\x17/\x05\x13]\x11uSֆ\xcb,\xad\xads\xf2\xe41\x94\xb7a\xedS;6=/\vn\xee찲\xb0\xd0\xc2`\x82\xb2>\x8e\x98\xcf\xe6\x98vc\x9ds\x8e\xa6m\x98\x19k\xa9\xaa\x92ia(\x02Y\xc8\xf2\xa1%.<{\x8dy\x19&\xbc\x9c\x80^7\xa11\x96\xc5~\xbe\xf8{\x0f<3{\xb9\xcf\xe5\xcf\xc4 \xff\xeb\xdf\xfc\x1e\xde\xf5+\x1f\xa0V\xea}E]\xde٩\xeb}\t\xa9\x90\xadnWJ\x88#\x0e\x1e9̕˗\x98\x947\xd0Y\xcaJ\u007f\x89\xfb\xee{\r\xb7\x9d>\x81\x90\x92\xd9|N٘\xfd\x05e\x04!\"\xa3\xc94\xac\x15lG\xd8\\+O\xad\xebz\x9f'\xb3\xd6\xee{M]\xd7L\xe7\r\xf32@\xe0Ņ\x1ca\f\x8f\x9e\xdb\"\xd1aٚPp\xf8\xe0\x90\xc68\x06\xdd\xf4\x13\xaf\xc4\xd9\xc8?\v\x83\xbc\xebW>\x00@\xb6\xba\xf8K5\x9e\xba.C\x1cw\x1e\x81$\xcb;$i\x1a\xe6-҄\xc3G\x8fr\xe4\xf0!\x96\x16\x978\xb2\xb8\xc0\x99\x13\xc7X\x1c\xf4\xe9\xe5\x19\xa9\xd6\xf4\xe2\x18\xdd\xce\x17\xeemЎ\xa2\x88\xaai\xb8\xb9\xbd\xcd\xe6\xce\x0e\xa3\xf1\x84\xc9x\x12\x96\x048ߎHW\xa1\x1eq\x8e\xaan\x18\xcd\x1b\xac\xf7()XZ\xe8\xf0\xe0\xa3\x17i\x1c\xc4\x12擰>$\xd2\x02\U0003ffbbݼ\xef\x1d?y\xe4/\x86A\xf6\x9e\xbf\xfd\xbf\xfc\xfa\xb6\x17\xe2seQ\xb6\x8b\x95\xc3^\xabL\vb\xa5\xe8u\xbbt;9'\xd6ָ\xf7\x8e;8\xd4\xed2\xe8\xf6\xe9\xe4Y;\x96\x04\x91\x0eh*m\x13\xae\xf7aKC1\x9bQ\x17\x054\x86rV1\xda\x1dQ\x16%εy\xa3,\xf7\xd7?\xd5u\x1d\xf6C6!\xb9\x0f\x069\xd76F\\ܜ\xd3\xd1aD\xc2Ԇ\xaa6d\xfdh3I\xe5?\xf9w\x1f}ĳq\x80\xbf\x10!\xeb\xc5\xcf\xf2j\xf7\u007f\x9b^\x1f\xbdw^\x14t\xbb=\xa6\x931\xe5\xf6\x16\xbdn\x16\xe8\x10\xe7\xa1r\x1c\xbau\x8d4Ih\x9c#N\x92\x10\xf3\xcb\n\x9cE\x11&\xb4|\xdd`\xad\xa5*jvv&<{\xf1\x06\xfda\x97\xdbN\xac\xa3u\xd8\xe4`\xad\v\x13\xb5\xb5i\tưnv^Y\xca\xc6\x10E\x9a|\x90\xf3Ѓ\xe7\xc9u@\u007f{\xf9\xa6\x18\x15H\xad\x96{\x83\xf4;\x81G\xcf]\xdex%\n\xe7?\xdb\xe7\xa3\xff\xf4\xafu\xaf^\xb8qN\xf9xeiy\x85\xeb\xd7n\xf2\xd8\xc7>˅K7i\xbc\xc3۰\xd5\xf4ȡen?u\x88\x95ë\xd0x\xae\\\xbcN]UDQ\xbb\"J\x85)\xd8\xcb[#f\x9b\x05\xcb+K\xbc\xe6\x1bΰ0̱\xd6\x04\xf2Q\bʪd:\x9d2\x9eL\x99\xce\xc2\x16\xec\xc9lε\x9d\x92\xd2X\x0e\x1e]\xe1\xec\xf9\x1b\\\xbe\xbc\xcdb/\xdd_،֜\xb8\xfb\x18.O\xb9vm4>xh\xb0\xfe\xaf\xfe\xfd#\xf3\xdb\x0e\x0ey\xf2\xea\xee_\f\x0f\xf9\xfco\xff4\xe7\x9e8_\x19k>P\x97\xe6ǽ\xf3t{]\x8e\x9f>\x82\x1aO(j\x13Z\xa9\xc6s\xf9\xdc\x16\x0f=s\x93\x95\xa1\xa6(\fϗa\xf6\xa1/ÇPJ\xb1\x9au\xb8g\xfd\x10\xa7\xbe\xfd>\xa2\x01\xe8X\x84\x85\x00>\f\x8d\x9a\xa6\t \xa0\xed\xbb4\xa6aV\x94lM*\x8aڰrp\x89\xab7Ɯ\xbd\xb0\xc5J/\xcc=\xe2\x025\xef\x8de6.8th\x91ͭi\xff\x93\u007ft\xee\xbd\xc0_z\xf2\xea.g\x0e\fxfc\xf4\xf5o\x90;~\xf0\x1f\x034\xbf\xfaS\xdf\xf6!\xdf\xd8\x1f\xaf\xeb\x9a$I\xc8\xd7W\x89\x87\x17\x90\xdbS\"!Y]Y\xe7\xbb\xee=I4\xb3<|\xfd\x12\x85k\xf8k\xfd\x05\x86Y\x87$N\x88\xf2\x9c$\xcf\xf0ZP\xaeG\xdc,6\xb9\xf8ܳ\xac\xae\xac\xb0r`\xa5mj\xb9Ю5\x86\xb2\xaa\xc2\x1a\xc0\xa2`2\x0fzܼ\x97a\x9c\xe3sO\\a\xa9\x97\x90%\xba-\x02\x03\x03쬥\xa9\x1a\x9c\xf5\x1c;\xb6\b\xf0V\xd3\xd8\xdf\xcd;\xc9۞\xb8\xbc]\x9fZ\xe9\xf1\xec\xcd\xc9\xd7\u007f\x0e\x01\xf8\xfcF\xf3\xf0\xd1\xd4_\x19\x8fw\x0e--\xaf\x91\xa6)\x9d[\x0era\xf6,\xab%\xac6\x11K\v\xab\f\uee45\x93\xfd\xefB&A\xe7\xeb\x8d\xc55\x96\xf9d\x97\xedk\x17\xb9\xb2y\x91\v\xd7nrs|\x8d\xa3\x87\xd7\x10\x12\x9c\xb3D:j\xa1m\xf0\x8c\xb2\xaa)ʊɬb^\x1bК\xb4\x93\xf0\xd9G\xce3Ȃ\x0eY+\xb5\xbf\xe8_\b\xb1o\x90\xb2\xa8\x89;\x11w\xdeu\x90\xf1\xa8\xf8\xfe\xab\x97G\xef}\xd5\xfa\xe0\x1dO]\x1bMO.\xf7xns\xf2\xf5o\x90_\xfc\x9d\x87\x9ex\xd7[\xefy\xa6\xb3\xb3s\xa8?XD)\xc5py\x99lx\x85k\xa3\x021\xbfʵ\a?H\xf4x\x97d\xd0'\xe9\xf6ȳ.\xc28\x8a\xe9\x88\xed\x9d\x1b<?\xdd\xe1\x86l\xc8R\xcf\xdaR\x8fa\xbf\xdbv\x13C۷\x9a\xd74Mü()\xab\x8aYYS\x1bGe\x05i?\xe3\xfc\xf37\x10\xce\xd2\xeb\x84\x05\x97Y\x1a\xe1g\x8e\xa2\n+5|\xcb\x02{gq6T\xff\xf7\xdc{\x84\xf1n\xf9\xc3\xf3y\xcd\xc9\xe5\xde;\x9eۜL\xffBx\bvn\xceoN\xff\xdfլ\xf7\xa6ݭ-5X\\&N\x13\x96\x0e\xac0/.\xf0\x14\x06\xfc\fQΑ\xc5\x061\x82̅\x99\x9cZx\xc6R\xe0\x14\xacd\x9e\x95A\xcepaH\xda\xc9\xe8\xf7{\xe1\x8d>\x8d\xa1\xaa+\xe6EEQ\x96\xcc˒\xa2l\x98T\x96\xa4\xd7a2\x9e\xb1\xbd5\xa1\x93%\xc4ID7O\xf6\xc7\xe8\xb4\n#u\xd6{\xbcu,\xf72t'\xc6\tA\xaf\x9f\xb0\xba\xd6\xe3\xfcs[?,\x047\x80\x9f\xfaj\xbdD\xf2\xe7\xe4\xf9͏=\xfa˛V\x16M]\xa2$\xf4{=\x96\x0f\x1c N#be\xb1\xd2Q\t(\xa4`W\xc25\rW\"\xd8\xd4 e\xc3jܰЉI\xd2\x14\x1d)\xf2,#\xef\x84\xe1Ҫ\xae\x82W\xcc\xe7̋\xd6\x18\xa5Ag\x19UUr\xee\xdc\r\xf2$\"K\x13:YD\x12\x85Wf\x84\x97\xb7\xb0\xbf\x1e\xdd;G\xaf\x9b\x91u\xb3\xb0\x8c@K\xe2d\x9f\x0e\xfcoO.\xf7^\xf3Ն\xac?\x17\x06\xf9\xbe\xfbO\x02\x94\xe77Fﯜ\xa3\x9a\xcf\xe8w3\x0e\x1f:ĩ\xd3gX\xecĬ$\x8eT\x18D\xbb$F\vA\xac$\x99\xf4,D\x96N\x1a\xa3\xa5$\xcbS\x0e\xac\x1e`8\\@\x00eY1\x9eL\xd8\xdc\xdeew2\xa1j\x1aƅ\x818\xa665O\x9f\xbdN\x12k\xb2,\xa5\x9b%D\xed\xfbK\u0094p\x10=x\x0f\xde\xc1p\xb9O\u007fy\x88\x8f^\xd8*\xd1\xef\xa7(% \x80\xbe_;\xb9\xdc[}\xf1g\xfb\xd1\xef=\xfc'~\xfeӫ\xfd?_!\xeb\xd1'\xc36\x8eO?\xfa\xecCgV\xf2\xff\xba\x13\x8dI\xf3\x0ei\x12q\xfc\xc4\tF\xbb;l\xed\uec14:\x9c\x17DR\x10\xb7\xbbO\x9c\x85XEDZ\xb1\xb0\xb8\xc8\xc9S\xa7\x19\x0e\x17(\x8a9eQ\xb2\xb3;b4\x9d0\x99\xce(\xab\x9a\xf1\xdc0\xb7\x10łs\xcf\xdd@)E\xa7\x93\x92\xa7q\xd8Ґ\x86\x91뺩\xb0-5\x0f\x10g\x11\xaf\xbd\xfb\x04\x9d\xb5E\xb6\xce_\f\x9b\x1d\xbc'\xef$-W\xe6\x01\xee\x01\xdeuj\xa5\xf7?<{s\xe2\x01~\xf3\xf7.\xef\x19\xe6\xd5\xc0\xfd\x846o\xde4~\xb6\xbd\xddd\x1f\xfa썟?{c\xdc\xfc\xb90ȉŜs\xdbs.N\xc3\xcf\xe3<\xddG\x1f{\x86\xf5\xfbo#\x9fM\x89\xa3!\xc3\xc1\x80\xef\xf9\x9e7\xb3\xbd\xb9\xc53Ϟc>\x9b\x85\xa1\xcfvQf\xa4\x15\v\xc3!\xb7\xdd\xf1j\x86\xc3\x01\xf3y\xc1d<\xa2\xaa*\xc6\xd3)\xe3ٌ\xddɔiQ1\xad\f\x93ڱ|h\x91\xcf=\xf4\x1c\xde\u00a0\x97\x91\xa7A\xc8\xdd\xcd\x12\xb4\x0eoW\xa8\x1a\xdb\x12\x90\xa1\xad\xfbͯ?͡W\x1f\xc3*I\xa7ץ\x98\xcd\xd9{\x8b\xd0\x17<\u007f\xcf{~\xe5\xc7\xdfzd\xa3\xae\xfd\x8f!\xf8+\x12^\xe7<\x9av\xb1\x83\xb5H\xef\x11\v\v\x9a7\xbfnu\xfd?}\xfa\xc6\xdf\xf8\x9a\x1a\xe4\x9do?\xc1?{Ϲ?f\x88s\xdbsN.\xe5\x99\xd2갳\xfe\x9bO\xdfv\xcb\xffx\xe2p\x87'Ϟ\xc7\x1a\x87G\xb0\xb80\xa4\x93\xc7\x1c9r\x98;\xef\xb8\x1dg-U\x15\xb4[{K\x1b\xe28ƺ@\xa5TU\x80\xb6\xb3\xf9\x9c\xddј\xdd\xdd\x11\xe3Y\xc1\xb80\x14\x0e\xfaK=\x1e\xfb\xdcyf\xf3\x9a\xc5~\x87<\v\xc6Ȓ\x888\x92ذ\xa4\xba\x85\xba\xa1\x1f\u007fۉ\x03\xdc~\xcfI\x18vqUM\xa7\x1f\xb6\xe0UE\x19\xb6\x12\xbd\xe8\xf1\x1e\xb2L\xfd\xc1ŋeT\x96n\xb9(\x1cUݾ\x96C\xa0\xd6\x0f$\xac\xad%(%PJ\xd0\xefG?\xf9\x86\xdb\x16\xdf\xf5\xa9'\xb7o\xfe\x99yȹv\xc3\xdd-\x83\xec\a\xbd\xe7'\xbd\xf7w!8\xfc}?\xfcF\x86\x1d\xcd\xff\xf7K\xcf\x11_|\x9e\xa6\xb1\xe4i\x8a\x18t)\xab\x86\x9dф^/gai\xb1\xfd\xf0\xa1\xc3XW\x15ռb2\x193\x9fϘ\xcef\xdc\xd8\xdefsg\x97b^1-\x1a\x8c\x8a\x90\x1a\x1e}\xec\x02UeX\xec\xe5\xe4YX!\x9b'1i\x12\xb5\xe3\xce\x16\x87\xa31\x96\xaa\xb6\f\xbb)\xf7\xbd\xf6\x14\xea\xe8\x81\xf6\xa5\x00\xa1M \xdbu\x1d\xde\xf1\x12!\x9d\x10P\x96v\xfd\xe2E\xdbj~_XF\xe1=\\\xbdZ2\x9bY\x96\x97#\x96\x97#\xa2H\xf8\xe9\xd4\xfd\x10\xf0Ͽ&\x06\xd9\xf3\x8ew\xbe\xfd\xc4]\xc0\xdf\a\xb6\x84\xe0\xe8tj\x0f=\xf8\x99\x9d{\xa6S\xd3q\xe1\xf6\x90tR\x0e\x9d\\\xe7\xc0\xedg8\xf7\x9f\x1f\t\xb3\x16\xdeQU\xc7\xe9vC}p}c\x93A\xbfK\xa7\x9b\x93&1eYS\x97%\xe3\xd1.\x1b\x1b\x1b\xccf\x057wv\xb9\xb1\xb5Mc\f\x93\xa2\xc1\xc5\t2\x89x\xe2\xf1\x8b\x18cY\xec\xe5t\xf2\x14\xad%\xdd,!K\xa3\xfd\x19u\xad\x14\x8dq\xa1\xf3h\x1d\xdfp\xdfiV_}\f\x9bD4u\xd3R\xfca\xb3\x90\xf3\x9e\xb2j\xbeX\xd8jW\x8ax\xbc\xfbcolbw\xb7\xa1\xdf\x0f\x9e\xa5Tht\xbe\xe2!k\xcf\x10\xad1^\x0f\xbc\x1f\xc8\x007\x1a5\xf1\x13ON\xe3[n\xe9p\xe1\xfc\x94Y+F\xebt3\xd6nY\xe3\a\xff\xce\xdb\xf8ų\x17y~c\x17\x8bc>\x9f\xefo\x95VJ\xefo}\xb06\xccl(\xa5h\x9a\xf0\x12ɺ1L\xe6S\x8a\xaaa\\Y\xe2n\a\xd34|\xfe\x91\xf3\b!X\xeewȳ\x848\xd2,\xf42\xb4z\xe9+/\x10\xa1\xf6p\xd6sxm\x81\xbb\xef=\x85\x19v\xb0\xc62\x1dO\xf6\x17\xa9\x19\x13B\xe6xT\xec/\xd9\xf9\xc2\xe7K\xac`\f/\xd9l\xbf\xac,\xedo\xbf\xa2\xb0\xf7\x9do?\xf1\xe2\u07ff\x99\xb0\veIJ\xa1.]*\xd4'>\xb1\x1d\u07fcY\xf1\xd8c#z\xfd\xf0\x9e\xdbvH\x1cc\f+G\xd6x\xc7ϼ\x9d*\u0379\xb21Ƙ\xb6\xc5Z\xd7̋9\xf3Y\x10*TU\xa8/\xa6\xb3\x19e\x15\x88\xc2i1\x0f\xcb,-T\b\x9e\xbb\xb0\xc1Ï]!\x8e\x14\xabÀܲ$b\xb1\x1f<\xae\x9b\xa7,\xf4;\xf4:\x19q\xa4\xc3;\x13\x8d#\xcf\x13\xbe\xfb[\xee\xa0Y\x19`\xa5`6\x9d\xb5\xb5\x88o\x97F\x04\xc9\xd2d\\~Q\x0f\xf9riv\xef\xe1\a~`e\xf2\x8a{H\xeb\x19o\x00~\x03\xe8(%\xfc\xf9s\xf3\xf8\xf3OL\x84sA\xa1\b\xb0\xb1Q!\x84Gk\xc5\xf6\xcd\x11\x93ќ\x81R\x1c\xb9\xe34o\xfe\xa17\xf1;\xff\xea\xf79\u007fu\x8b\xc3\a\x86\xed\x0e-\xbf_\x03\xb4\xbb0\u06ddZ\xed2|︶5\xe1\xeaN\xc1h^\xe1=\xac\f2\xbay\xa81\x06\xbd\x8c,\t;\x1f\a\u074c~7k\x97hj66w\xa9\x9a@\xd5\u007f\xf3='IO\x1db\x9ah\xaa\xdd\xf1\xfe\xee\x95\xfd\x97K\xfa\xf0n\xc3\xf9\xac\xfa\xb2\xbb\x18/\x82\xc7_\x90wD\x0e\x8c_1\x83\xb4\xc6X\a~\t8\x00p\xe3f%\x9ezf\xd2\xee\xc0z\x89c\x13h\xf7\x0euc\xa8\xab\x06\xbcGŚ\xdb\xdf\xf8Z\xae>\u007f\x9d\x0f\xfc\xce'\xb8\xb89a}\xa9K\x16k\"%\xf6\x173\xa7qX\x058\x9a\x94̫\x8a\x9b\xa3\x82Qi\xc0\x87\x15Q\v\xbd\x0eQ\xa4H#͠\rQR\n:Y\xf2\xff\xd7v&1r\\\xe7\x1d\xff\xbdW\xaf\xaa\xbaz\x9b\x95\xfbnR\x94dR\x96e[6e!\x11,\t\xb1|\x88\x1cHQdEtLEB\x12@\x81rp|Ȃ\x00\xce%\tr\xc8!\xbe\x04\x90l \x8ei9\x81\f\xc7v\x16\x03\x96\xe1H\x82%\x8b\x14%\x9aC\x0e\x97!g_83\xdd\xd3{wuUW\xe5𪫻g\xe38\xa1\v\x18\x90\a\x0e\xbb\xab\xbe\xfa\x96\xf7}\xef\xfd\u007fd\"cض\x85\xdb\xf4\xa8\xd4\x1a\x88\x10\xf6l\xebc\xfb\xbdG\xb0\x0f\xeef\xf1\xfad$\xaa\xd6E\x89\x8b\xf6\v7\xea\x1e\xb5\x9a\x87\xd8\xe2T\xa9;\xb4uKۿ\xf5\xd6ʗ\x0f\r\xa6\xaf\x1a\x86\x1cQ\xbf\xa2\x04n\x02\u007f\x03|\x1c\xa0\xe1\x06\\\xbcX\xa6\xd9\\k\x8c\xb6\xff\x0e\fe\xa8W\x1b\xf1i%\u0090\x81\xbd;8\xf6\xc9c\x8c\x8e\x8cs\xfd\xea,\xf9\xe9\x95ޛ\x89\x14\xaf\x8d\x8e\xa2\x12Jj\xd0KƱɦ\x1c,S\x97\xb4\x8e\xad\x10Rb\x9b\x8a\xb4c\x93J%4\x87\xd7Ҹ\xbc|\xa1\x82\xdf\n\xb0\x12\x16\x87O\x1cg\xfb'\xee\xe6\xf2\xa5+\xb1!\x84\x10\xd1\xd9\xc6 \xde$qs\xa1@\xb5\xdaĐ\x9b[Dc]\x89<\x98\x18\xdf\xd1\xfe\xd6a\xc8_\x11\xedK\xfbU\x85\xac\xbf\x04\xbe\xa4\xab\b\xc1\xc8H\x89B\xc1k\xb7\x18:g\xd24L\ne*\xfa\xfa3$\x12\x16\xca4\xba]\x99\xfd\xf7\xdc\xc1'\x1e8\xc6\xe2l\x8er\xd7\xe9\xa4X}<\x04C\nLC\xa2\xa4&\xe9\xa4S\xb6\xeeME?\x86\x11\x19\"\xe9\x90t,\x94!\xe30%\x00\xd7Ӭ\x900\b\xb9\xf7s\x9ff\xf7}G\x18\xbf1\x85\xe7z\xb1DT;O\xb4\xb7\x1a\xb5Z-\xa6'\xf2\xb7\fVBJ\x84\xa9\xb4\xf0`\x10\xe8#\x11\xad0ޞ\x04\x90N\x1b,,\x86(u\x1bCV\x97w<\x06\xfcE\xdb\x18\x13\x13u\x16\x16\u070e1\x800\xd2\x1e\xd7\xfb\x14\x04\xca6\xe9\x1fʒΦ\x90m\x0e`$PagR\x1c>~\x98{\xc6\xe6y\xfb\x9dQ,Z\x88\x88U+\xa4&\xed\x18҈\xf0\xa96\xe9\x94M\xc2\xd2\rBeh\xc2\xda`6Mұ;\xec*SK\n\x12q\x11WJ\x15\x1au\x97\x8f<\xf6\x00\x87N\x1ccrb\x86R\xa1\x14?|\xad\xf6\x13v|Z\x80[kR)\xbb\xb74\x88a\x1a\x91f\xa46\x8eaIp\xbd\xf8x\x8b\x10\x90\xce(\f\xa5\x8fe\xa8\xdbl\x8c\x9dhL\x9c!\x04\xac\xac4\xb94Z\xea\rS\xb2\xe3%m\xaf\xb5l\x93\xa1m\xfd$\x92Vd(\xbd\xe0\xd2=$\x1b;\xedp\u05fd\x87\x19}\u007f\fe\xd8Z\\ZW\x06\bt\xfb\xa4?\xed\x90I鱫!\x04ʔ\xa4\x9c\x04C\xfd鞰cG\xc4\x04!\xc0\x94\x8a0\x84r\xb5\xc1\xfe{\x8fr\xfc\xd1O\xb1\xb0\xb8\xc8\xcar.\xa2\x88j=\xc6^\x0e\xaf\xfe{n\xb9D\xad\xd6d\xb3\x04\"\x94\x11\xd3\x1fD[\xe2[\x80\x91\xb0\b#\xcd-\xdd5\x16X\xa6\xa0\xe1\xde^\x0f1\x81\xbf\x05\x8e\x004\x9b\x01#\x17+\xf8~\xd8㾁X[\"f\xfb\xd2d2I\x8d\xd1V\xb2G\xf9KH\x03\xa7/\x8b\x93N`H\x814\x04\n\x810\rlS1\x90\xd5aHD1L\nI:i\x91M'\xb1,-\xa0֖\x1al+j\x1b\x11\xc7\xdd0\x04\xd3\xf3y\x92\xc3}\xdc\xff\xe4\xc3\xe4\x8a+,-,\xeac\xd7\xedJ\x0ez\xca\xda0\f\x99\x1a_\xe6\xddw&i\xba\xfe\x86\xf6\b%\xf1^`\xedF\xa2\xa7\x18\v\xd0\xd4S\x89@\x19\x0232\x88\xbc]\xde\x01<\xd9\xce\x1bR\n\xae^\xab\x92\xcf7{\x8c!M\xb9\xa6D\x94R08\x94Ŵ\xb4ۮM\x90!v2\x81\xed\xd8$\xd3Z0;\x95\xb4ɤ\x13\fd\x1dR\x8e\x15\tǄ8\xb6Ŏ\xe1>\x86\a\xb2X\xa6\xc2\x10\x12\xcb4\xe3\x1f)\x85\x96&7\x15JJ\xaaU\x97\x92\xebr\xe2\xe9\xdf \xb4\r\x16\xa6\xe7iD\xb9\x84h\xc1\xd9\xeaֲ\a&Ǘx\xe3\xf5\xcb\x14\x8b\xf5؋7Zh\b)7\\\x814\xbd0>:'\xa5\xf6\x92\xff\xf7\xc2\xf07\x1f~\x80\u007f\xfc\x97\x1b\xbct\xf2C\xfd\xc0?\x83N\xb07ƫܸQ\x8d\xd7\x1a\xed$\xde\xe6\xa3\xf7\xb6\x18\x04\xfd\x83Y\fe \r\x19\v\x16w\x17b\x89t\n!%\xdbv\r \x11\x98\xa6F*\r\xf5\xa7b\xfe\xed\xf6\x81,;\x86\xfaHX\xba'\xe5\xd86v\xd4R\x97R`J\xcdaOXZ/>$d.\xb7\xc2\xfdO=ʶ#{\x99\x19\x9f\xa6^\xadӊ\x004\x1d!\xe7\xceU*\xd6\xf8\xf1\u007f\\\xa0^k\xd2n\xf7l\xe8\x1d\xca\xdc\xd0\x18BH\xf4\u007f-\xd6x\xd8\xff)d}\xf1\x89\xdf\xe2\x9b\xdf\xfd\x1e?\xf8\xc9\xdb<\xf8\xb1\x8f\x1eh\x85\xe5\u007f\x95\"4\xa5\x14\xe4W\x9a\\\xb8P\xd6I<\xd2\xca\xd5\xdb7\xf59\xc2\xf5\xbe\xa4\xa9\f\fehm\xf55\xdd\xd3\x10;\xe5 \xa4`\xef\xc1\xdd,O-A\b\x83})>~\xf7\x01r\x85\x8a&*t\x19\xb2\rq\x91\x80T\x12\xc7\xd6MĶ\xecl\xd3\xf3\xa9\x84-\x1e\xf9\xf2I\x86\xf6\xefbf|\x9a\x95\\\x9e\xa0\x15\xf4|n\\YIA\xa3\xea\xf2ڷ~N\xa3\xa1i<\x1b\xf5E\x84!1L=m\\}\x9f\x1a\xf3-\x11Bb\x9a\x1a\x06 E\x80\xe7\x05\xd4\x1b\xc1/\xef!/\xfc\xee\x17\x00\xf8\xe6w\xbf\xc7W\xff\xf4ϒ\xf7\x1f;\xfa\xe2p\u007f\xe1\rA\xf8I\x00\xcf\v\x18\xb9\xa8\x8d!\xa4Ԃ\xfb\xb6\x854eG}u\xf58\xbd\xd5b\xf4\xe2x\xfc0\xa4\x94\xacƢ(\xdb\xc4N%\x19\xdc5\x10W-\xa9\x84\x85\x940\x98M1\x90I\x92\xb0#X\xa4e\x92v\x12\xa4\x9d\x04\xd9t\x92\x81l\x1a\xdbR\x11\xc3V\x1f6-\xbaM\x8e|\xfe\xd7\x19ܷ\x93z\xb5\xc6\xec\xc4t|~\xb1\xdb m\x0fv\x1b\x1e\xdf\u007f\xed=J\xc5j\x94W\xc2u\xdb%RE\xc6X\xe5\xe2\xca0\xb1m\x1b\xcbL`[\tl\xdbA\x99\x0e\xca\xd0d\xd2\b\v\xfc\xcb{\xc8˧\xb50\xf3K/\xbc\xf0\xc8O\xdf\xfc\xef\u007f\x90\xa2r\xf7\xf6![\xb5[\xcb\x13\x135J\x95\x96V\xa6\x96\x11pE)\xbc\xe6\xe6\xd5H\xb5ڈ'sƪ\xa4\u07be\xfav\x0e\x93\x9b\xbeI\xdfP\x06\xaf\xd6@\x99Z\x9e\x1c\xa9\xab%\xd3T\xb1\xd0e;4\xc8h1\xd7~\xf5\xbc\xa6O\xa5\xeer\xe7S\x8f2xp7R\bF?\xb8H\xb9Tѿ\xb1\xceC\xf6\xfd\x16o\xfed\x94\x85\xb9\x958\x9c\xae\xe7\x1cR\x19\b%cfowQbk\x8es\xdc\x03\x13R/\x14\re2\xd0\x0f\xa5\xb2\x17\xe7\xceM\r\xf2\xfc3O\x03\xf0ʷ\xbf\xc3\x1f\xfd\u07b3\xc2\xf7[\x19\xcf\xf3\xfe\xfa\xfc\xf93\u007f\x92\xcb\xdd\xe4\xd8Q\x8b\xa4#\b\x02\xa8T}\xae\x8e\xd5\"\xdeI\xa8\a2\xb2\xa5?\xe0\x16\xbd\x05?:\x01+\x8d\x88v\xb0\xea\x86\xc3 dp\xcf\x0e\xa6\xce_ax\xf70sWghFg:\x94켑z\xf6 b\x05\xed\xae^\x00\xbe\x17P\xf5}\xee8\xf99\xfavo\x870d\xe4\xdc\bK\vK\xba\xb4\r\xd7\u007f\xebK\xc5\x1as3\xf9[\xaeĥ\x92=m\xf6\xb6\x86\xb2m%\xba\x8c#\xba\xf2\x93\xce%\xb6\x92\x18F'\xa9oj\x90W\xbe\xfd\x1d\x00^|\xeeԶz\xbd\xf6x\xb1\x98\xff\xfb\x99\x99\xa9\x81R\xa9Ȟ]&;\xb7+\xbd\xc0\x01F\xaf5ј\xc6\xce\xd3h\x9f\x11_\xbf\xda\xe8\\\xf5\xba\x8b\xe7\xf9\xc8h\xff\xed\xea\a\x13\x86!v&Iv\xdb\x00\x83;\x06X\xb81O\xd2QT\x1bu\xf6n\x1f\"\x9dt(W4\xaf\xd6k\x05Q\t\xace\xbf5\xd8E\x9f\x84:\xf8\xd0}\xd8\xdb\x06\bZ-\xc6.]\x8dCU\x10\x04\xeb\xcc3t\xa8\xfaŹ)ʥ\xfa\xe6\xab\xf1.<E\xbc\xa6\r\xd0\xe1Z\xaa(y\xb7\xbdVv\xcdK$\x86\x12\x18\x86\xc4471H;W\xbc|\xfaU^|\xeeԃ\xa5R\xe1獵'\x1e\xcc\xe7\x97\xf1<\x8f\xbe\xac\xe4\xe8!\r\xf65\f\x18\x9b\f\xa9{&\xe9l\x8aF\xb5\x8e\xef\xfb=-\x06\x11\x04\x9bސ[oR*T\xa2\x90 X/f\x85AȎ;\x0e\xb02\xbfL\xa6?\xcd\xfcb\x89\xfeL\x12\xdb2ٻs\b\x02\xf0\xfdP\x1f\x8f\x96\x92\x96PH;\x81\xb2\x924m\x93\xea\x9e\x14\xe6\xa0\x06\xca\\z\u007f\x84\xb9\xa9\xd9M\xbb\xb2\xae\xeb\xf3\xa3\x1f~\xc0\xf8\xd8\xcdu\xab\xa1\x9e\xa6a+@\b#\xfa\xdd6\xf7Ċ6x\xafJ\xecFoA \x11H\t\x8e\xa3\xff\xad\xda\xcc\x18\u007fp\xf2\xd9S\xb9\xdc\xe2\u05ef_\xbf\"\xeau=v\xb5m\xc9\xd1\x0f\xe9d\x04\x90+\x84,\xe4\x02\x94)\xc1Դ\x9aF\xddŭ\xe9\xb3\x18\xab\x17V\xeb\xf7{\x04\xe3\xd7\xe7xXʍo<\f\xc9\f\xf5ӷm\x80\xddGv3v\xe62;\x86\xd2\x10!~\x13\x96\x89\xb04g\x04a \x9c4\xc2th؊\xf2\x9e\x14\xa62\xf0=\x8f\xf7\xdez\x97\xe2\xca\xc6\x1b\xa3\x85\x10Ԫ.?x\xed,\vs\x85\r\x99R=_\xad\xa5\x01\x93\xa6\xadAg:\x97\xf5v\x88\xb5\x80g\xf4\xc2\xc5\x1c\xad\x00\xbf\xa5\x9fc_Vi\x9d\xfb\xd5\xc6x\xf9\xf4\xab\xa4\xd3\xfd\x89S\xbf\xf3\xe4鉉k\xdf8\u007f\xfe\xac\xa8ի t\xa7v\xffn\xc5`\xbf\xb6\xa3\xe7\x87\xcc\xdc\f\xe8q\x00!H\xa4\x12$\xb3)\xbd\xea\xde\xc2e\x18\x92ё\xf1h\uef31\xf1\fˤo\xe7\x10\xbb\x0e\xee\xc4v,\xe6\x16\x8b\xa4\x13\x0e\tӊHR\xd1\x1bk۔\xdd\x06oO\x8f3\xb73\x81P\x06\x95r\x853\xff\xf3\x0e\x85\xfc\xe6G\aʥ:\xff\xfeog\x98\x9bY\xd9\xfa\xb0I\bl;\x89\x13a3\xa4!\xbb\xca^\xd1Ք\x94\xf1\xcb\xd5\x0e\x93!z\x9d\x94\xec\xf6\x90\xe7\x9fy\x1a\xd7m\xf0\xf2\xe9W\xf9\xe3\xdf\u007f\xee\xc3SSc_\x9b\x9d\x9d\xfcL\xa1\x90ǲ\xf5\xbe#\xa4d\xb8_r\xfc\xce\x04M/\xc0m\xb6Xʇ\x94*\xeb<\xc0\x10\xadqe۸\x81\xbbn\x8c^}C\xf9\xe5\"S\x13\v\x1c\xbes_\xecY\xeby\xd2\xe0\xbe]\xe4f\x97I\x0f\xf6qs|\x8eѱ\x19\xf6\r\x0f\xe0;6\xad\x84\x85\x97NR\x1b\xea\xa7&`\x8f}\b\xd3T\xccM\xcdrm\xe4\n\xd5J5\x06Ŭ7\x03\xaf\xd7<Ʈ\xcc33\x99ò\xb7V\x80\x1a\xca\xc4\xc9d4\x94&\fc\xcfл\x1eC\xda\xea\x98\xed\x10\x1eM\x80\xe2\x1c\x12\x06\x02S\t\xfc\xa8\xa0Q\xcf?\xf34\x93\x93\xd7\xf9\xf1\xcf\xce\xf2\x87_<\xf9ĕ+#_[\x98\x9f\xdd\xe5\xbau\x9ct\n\xa5\xb4\b\xb7e\x86|\xe6Si\x12\xb6\xe4\xeaD\x89\xf1\x19(W\xd7V3ݓ\x0e!\xb5j\x9b뺷\xbc\xb1D\xc2\xe2[\xaf\xfc'\x9f}\xfc\xd31\xaeu=C;\xd94\xf7<z\x82\x03\x1f\xbd\x8b\xf7\xff\xebg\x04})\x96\xee<@\x98J\x10(\x83@\xe9XnE\xdf\xecҹ\v\xccN\xcd\xe2GG\xe66\xba*e\x97R\xb1ƥ\x91ٞ\x11\xc0\xc6q*D\xd96N:\xd3S\x88t\u007fF'd\x89\xb8\xd2\f#L\x90.\x04\x04\xad@b*\x89k\x04\x88\xeev\xd7\x13\x8f=rj|\xfc\xda7\x8a\x85\x02R\n\xb2\x03\xfdq\x99fȐ\xcf>\x98bǐ\xc9\xe5\x1b%Ʀ[,\xad\xc8\x18^\x1fu\xcb\bW\x19\xa6\xe5\xfb\xf8M\x8fz\xb5\xbe\xa5\xb9s\xd0\nx\xe9ϟ\xe5\xf1\xdf~(:\xffw\xcbXA<\xc7]uyM\x8f\x91\xb3\xe7\xc9-\xe56\xfd\xec0\b\xa9כ\x94\x8bu\xdex}\x94\xf9\xb9\xfc\x16\xf2F\x88i;$3\x995s\x92\x8d\xda%\x86\xa1\xe2ֽ\x964\x87\x0f\xba\xe2w\xda\x00\x00\x01\xcbIDAT\x1f6ٹMR\xa9\x16(W}\xde}\xaf\x88\x1a\xb4\x10{\xf7\xef=u\xe1\x17\xe7\xfe)\x86,\n\rU\xb1l\x93\xc1l\xc8}w'\x19\xeaW\\\x9f\xaa25ﳔo\xf3\tu\xf5\x11\x06Z\x12\x8f \x12\x90\fBZ\x9e\x8f\x1f\xa9\xf2l\xf5\x92\x86\xe4\xa7?:\xc3\xe7\x9fzhk\xbf\xb0\x06\xf1-\xb44F\xa1\xc4\xc5s\x17(\x97\xcakZ1=]\x02\xbf\x85\xeb\xb6X\x98/r\xee\xe7\u05f7d\f!\xb4\xf6J\"\x99\x8ag$k=\xaf\xb3\x9a\x0f\x02?\x82Q\n\fe\xc6T9\"I)\x19\x853)!\x91\x90\xa8\xed;\x86殺K_\x8f\tih>Gy\xa5\xc4\xde})\x0e\xedq\x98\x9b\xaf\xf1\xce\xd9\x1aҔ\xe4W\xa0ZuI$\x13(\xd3\xc4s]|\xdf'h\xe9\xc1\u007f\xd0\n\"\x01\xc9pK\x15\xca\xeak\xfc\xfa\x1c#\x1f\x8cq\xd7\xf1C\xf1\xea}\xb3\x87c(Mn\xce--SXZ\xa1X,\x92_\xcc\xc5쑵6l?(=\x13\xbf\xf0\xc1$\x97/\xceQ*\xd4:\xcd\xd0\r\x8c/\f\x03'\x95´\xec\x0eĬ\xcb3\x82@KN\xb5U\xebZ\x11\xa1:\f\x02\xa44\xb4\x88\x8e\xd4\xe5\x87T6\xf5zH+\xb0\xf1<\x98\x9fwi6BıC;?֨վ\x12\x04\xc1\x17V\x85\xeb\xc0\xb1%A\x80\xf4\xfc\x80\xf6N\x91\xce\xfe\xa5\x0e\xb8\xebv^A\x10r\xe2\xd7>\xc2W\xbe\xfa%\x1c\xc7\xde\xd4\x18\x85\xfc\n\xb3\x133\xe4\x97r\x91\x90\xa6\xbf\xc1\xb3\f\xe3?[\x91\x1c\xd4\xf2b\x997_\xbf\xc4\xf2R\x89V+\\\xb7\xdc\x0eE\x1b\xbc\a\xa6\xb2t\xbe\x90\x9d&%\xd1X\xd6s]\x9a\x8dz\xdc\xcd\xde\xca3\x11Bo%m?S\xdf\xd7{\t\xfe\x17!\x9d\xb7\x8d|\b\t\xcf\x00\x00\x00\x00IEND\xaeB`\x82")
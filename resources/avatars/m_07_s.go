@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_07_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14+\fB\x0fH\xf5\x00\x00 \x00IDATx\xda\xec\xbdi\x8c\xa5\xd9y\xdf\xf7;ۻݥ֮^f\xa6\xa7g'g(.\xe2P\x94EE\xbbl!\x82lˊ$۱`\x1b\x92\x13\x03V\x02\xc1p\x108q\x1c _\xfc!AV\b\t\f\a\x8ab\x05\x91\xb2@v\x10I\x16\xa3\x84\x14%\xd1\xdc\xd7\x19\x923\xe4\xac\xdd=\xdd]յ\xdd\xe5\xddΖ\x0f罷z\x86#J\x1ar\x9a\x92\xa0;\xb8\xa8\x9e\xaa\xbb\xbe\xcf9\xcf\xf2\u007f\xfe\xcf\xff\xc0\x9f\xa0ۃ\xf7\\|\xdd\xdf_\xf9\xcb\x17\xe0\xbf\xfa\xeaߏ*͟\xb4\x9b\xf8\xe3\xfe\x017\xa6\x15'\xa7K\x848\xfb\xa8\x97\xef\xdd='\xe0>\xe0<\xc4KDQ \b\bڮ\xed??_\xd6'\x02^^Ԯ_=g254\x8d\xc7\xd9\xf0g\x06y#\xb7bT\xd2.\x9b\xf5\xff\xdf\u007fy\xf7\x8a\x88\xfcU\x81\xf8>\xe0\"\xb0\rl\x00\xa3\x18#\x00·\xd84ͭ\xb6\xb75\xf0\x82\v\xf1S!\xc6\f\xf8\xdfl\x17>\xfcg;\xe4\r\xdc67rNN;\x00\xaa\xc9HOK\U000f7352?\x17\x89\x8f\x85\x18e\fQ\x84\x10\xf0!\xe0}\x04\x02\xa3\xaaDJ\x99vQ\x8c\xd4MG\xd3u\x04\x88\xa3\"\xa3\xe9l\xe8\xad\xff\xff\x84\xe0\xe7\xba\xd6\u007f\x01 /\x14]\xeb\xff\xcc \xafw;\xb7\xbb\x89\xb3\rǧ\x1d\xf7\x8c\x14\xc7Q\xbc\xd5\x18\xfd\xb7\xa4\x90?\x17CȬs\x84\x18\xbfƇ\x15h\xad(\xf3\x8c\"ϐRҴ\x1d\xf3\xba\xa1\xcc\fFK\xac\v4\xbd%\x84\xf8/\"\xfcgm\xe7\x9e&\xd0\xffq3\xcc7\xdd \x17\xcfM\xb8q0\a`o\xbbz|\xd9ٟ\r!\xfe\x84\x80\x1d)AJ\x85V\x12%\x15R\n \x12c$F\xd6;\"\xc4Ho\x1d\xd6{\xf2\xcc0\x1d\x8d\x90R\xb0\xa8[\x96m\xc7F\x95\x93\x9e\t\xce\a|\x88\xb6w\xee\x17{\xe7\xff#ۅ\x83?NF\xf9\xa6\x1a\xe4\xe2\xf9)7n\xcd\xf8\xc9\xef{\x8f\xf8ؗ_\xf8On\x1d\xcd~\x96\x10\xce\x19\xa3(\x8b\x9cLk\x10\x02\x01\xaf\n\xea\xafw\v!\xb2l;ڶ\xc5(\xc5\xe6\xe6\x14b\xe4\xf0tN\xa6\x15F\xc9W=\xbe\xb3\x9e\xba\xebo\x00?е\xfe\v\xe3J㜣\xed\xbf\xb9\x06Qߌ7\xbd\xb0;eQwlT\xe7E\x9eu\x8f>\xff\xca\xfe\xfbO\xeb\xfe\xaf\x8f\xcb|4\x19\x97Te\x89Vj0\xc2\xea\x9e\x02w\xfa\xdd\x1d;d\xb5\xb2\x84@+Eg\x1d\xde{\x88\x91<\xcbPR\xd2[\x8bR\xf2U\xabOJ\x81u~\x12\xe1\xeff\x8a\xa3>\xc6\xcf\xf6=i\x9b\x8c\n\xf0\x01\x86d\xe1O\xb5A\xb6\xa79\a\xc75\x8f?z\xbf\x9c͏~6\xc6\xf0\xeb:3\x17\xa7\xe3\x8a\xdch\x84\x10x\xef\xb1\xd6\xd15\x1d]\xd7\xd3\xf5\x96\xbe\xeb\xe9ڞz\xd9Ѵ=\xd6:B\fĐ\\\x96\x92\x02\xad$\xd6G\x9cs\x04\x1f0F\x93\x19C\xdf[\x94\x14\x88;LR[Ok\x03B \x03\xe2\xdf\fQ\xbc/J\xf5\"J\x1e\xd0\xf6\x96\x18\xc1h\b\xe1O\xaf˺\xb47\xe5\x95\xfd\x19\x93\x92\xac,G?o\x8c\xf9i\xad\x95\x90R\x12c\xc4\xf6\x96\xae\xebq\xce\xe3}\x18v\xc1\xab?\xea\xeby.!RP\x1fO*\x84\x10\xdc<\x9a\xa1\b\x14y\xc6dT\xd1\xf5\x16\x1f\x1cr0Ic='\xb5E\x8a\x94(\xf8\bD\x01\x82\x13\"\x9f\a>\x00\xfc\x02ν\b\x80\xd6\xe0ܟ.\x83\\\xbe\xb8\xcd\xcb7\x8e\xb8\xb47\xddp\xce\xffvY\x16\xefX\xb9\x9c\xbe\xeb\xa9\xeb\x8e0\xacF\x81Di\x81\x10\x12%\x05R\nb\x84\x10<>$+y\x97R_ęSˋ\x8cѨ\xc49\xcf\xfe\xf1\f\xa3\x04ۛS\x04\xb0\xa8k2\xa3\xb1.p\xb8\xe8\xc9t$\xd3 \x11(!\xcf\xdc_\x04\xebCX\xdaH\x88\xfc\"\x9e\xbfGp\r\x99\x81\xde\xfe\xe90H\xf9HE\xf3\xe5\x9a˗\xb6w\x80O*\xa9\xee\xf7!\xd0\xf7=m\xdbC\x8ch\xad\xd0Ja\x8cB)\x81ҫ\xac\xea\xec\x83\xc6\b\xce{\xbc\v\x98LQ\xd7=\x8be\xf7\xaa/1\x1a\x97\xe4y\xc6\xd1l\x81\xb3\x96\xaa*\x18\x95%G'32\xa39ZX\x8c\x86I&\xbf\xe6E\x89\xc0\xa2\v\xf4!ގ\x91\x9fº\u007f\xf5\xdeﻏ\x8f~\xf0\xfa\x9b\xea\xc6\xde\xf4\x18R\xe5\x8af\xbf\xe7ҹ\xe99m\xf4\xbf\x8c1~K\xdbt4u\x87\x88\x91\xcch\x94\x96h%\xc9rM\x9ekL\xa6\x891b\xb4B\xa2\x06G\x93b\x80\x90\x12\xad\xd3\xcf,S\b)\xb0\xf6̝\xd8ޡ\x8d\xa6\xc84]\xefp>P\x15\x19\x8b\xa6\xa3\xe9=B\b&\xb9\xfcC\xad\xc4\xdc\b\x8c\x14\x95\x14\xfc8R\xee_\xfd\xca\xc9'\xc9\r\x84\xf8\xa6\x05\xfc7\x15}\xdbݬ\xb8}R\xa3\x059B\xfeJ\xdbt\xdfѶ=\xc6(\xaa2\x1b\xdcN$\xcf4y\xa1Ү\x00\x8a,\xa7\xd0\x19Fk։\x16b\xa8?\xd2\x1d\"\xadu(Րg\x8a\xc3\xc3\xe5\xfa}\x97\x8b\x86ͭ\ty\xa6i\xfb\x9e\xce:\x84\x84\xe8\xf9}\x8d\x11c\xda\x15RDBLI\x96\x10\x02%\x05\xa5\x14y$\xfc\xbc3z\x83\xb6\xffϓ\xfb\n\u007fr\\\xd6F\xa59\xadӪ\xdd\x1c\xe7\xa6,\x8b\x8f\xf7\xbd}G\f\x81\xb2\xcc B\xdf;\xf2\\S\x94\x19Z\v\x88\x82qQ0*+\x88\xb0h{\xf6Of,۞\xb6\xb3C&%\x19\x979\xa32\xa3\xca3v'\x15\x00G\xcb9\xf3e\xc3\xed\xdb\xf3u\xaa<\x1a\x17\b)\x98-\x96\x18m\xe8}D\xc4@\xae_\xfd\x95\xa5\x10h\xad\x11B\x11bD+\x81\x94\x92\x10\"\x9du\xf4\xceC\f,{\x8f\vt\xc0Obݿ\xfc\x13\x19C\xf6\xb6\xc7\x17\xa5\x14\xff\x97\xb3\xeeI)e\n\xaa\xd6\x13\x89\x94eFYf\x18%)tƨ(i\xba\x9e\xfdقWn\x9f\xe2BdZ\x15\xecn\x8c؞\x8e\x18\x159m\xddr\xf3脛\xa7K\xea\xa6'/2\x1e\xba\xb8\xc3\xf9\xcd\t\x9d\xedy\xe1\xda>\xcb&\xe1`J)\xc6㒓\xc5\x12b\xf22F\r\xf5\x87\x8f\b!\xa9\xf2\x8cbp\x8f\xaf{q\x04H!霣\xb5\x1e\xef#\xads\xbf\xdd.\xfb\x1f\x818\xff\x13a\x902W4\x9dg\x9c\xebKڨ_5F\u007f\x9b\x96\x02!\x04\xce\x05\xa4\x14\x8c\xc7\x05\xa32\xa7\xccr\xca,\xa3\xe9-/\xdc:\xe2p\xb6$F\xb8ra\x9b\x9dɘ\xc9\x00y\x84\x18\a\x97\x15pmO\xef\x1c\xfb\xb3\x9a\x17\xf6\x8f\x10B\xb09)\xb9rn\x9b*7|\xe9\x85kX\x97b\xc5hTRw-\xce\az\xbf*-#Ӳ\xa0\xca\rR\xb06\x86\x14r\x9dXh\x95B\xab\x0f\x0ek\x03.\xf8u1\xda;\xdf\xd4m\xffC\xa7\xf3\xf6C\x1b\xa3\x9c\xd3e\xf7\xc7\xdb \x17'%7\xe6\r\xd3\xd2|<\xcf͓J\xa9\x847\x85\x80֊\xe9\xb4bs4B\nA\x99g\xdc<\x9a\xf3ū\xb7\b\xc1s\xef\xf6\x16\x0f^\xdcA\x19\xf5\xd5AS\b\b\xe0\x86\xd4\xd3y\xcfK\xfbG\xbc2K\xb1#ӊ\xf7<z\x99\xa3\xd39\xd7o\x1d\"\x80\xac\xc8\xf01\xd0[G\xeb\x12\x8eunZQ\xe5\xab\xd8$(M\xc6dT\xa2\x94\xa2\xef\x1d\x9d\xb54}\x8fu\x1e\xe7=\xd6\x052-\x13\xf4\"S\x82圿&dv\xe5\xfa\xad\xdb>\x93\xdf\xd8p\xf2\r\v\xea[ӂ\xe3Yˍy\xc3F\x95\xfd\xb26\xeaI\xa5$1\x82\x1f@\xbf\x8dIɸ*\xf1\xc1S\x16%_\xbev\xc0˷\x8eȋ\x8c\xc7\xef\xbb\xc4\xf6\xb4\"\x84H\xf0\xfe\x8e\x00>\xe4\xa0+@\x11\x881`\xfb\x1e\x15-##X\xdaH\xef<\xcf\xdd8\xe4\xa1\vۼr\xeb\x90\bx\x17Й\xa2\xed- (rC\x95k\xb4VTy\x8e1\x9a\xc3y\xcd\xf5k\a,\xdb\x0e!$\xb9Q()Yv\x1d1D\"bp]\x02%\x04\x99\x8cTEv\xefrv\xfc[\xc0\xf7\xf7\x81`\x8c\xc2Z\xff\xc7\xcb ǳv\x15\xc4\xff\x811\xea'%2\x06\x1f\x85\x0f!]\x882#\xcf\x124\x9e)\xcd\xd3/\xddd\xffx\xc6d\\\xf2\xf8\xe5\v\x8cr\xc3|\xb1\xa4i[ڮ\xc5\xf5\x0e\xe7}\x82\xddeB{\x95\x94i\xa3xOg{z\x17ɉtB\xe0\xa2\xe0\xe0t\xc1\x95\xbd-\x8a\"\xa7i;b\b\xc4;\xbe\xe2$7\x18\xa5\xa8\xf2\x82W\x8eg\xcc\x1b\xcb֤dg2fos\n\x04\\pXgɌI\vbH\xbaW\x1b6\x12\xe9\xbd\x03%\xbfǘ\xf8O\xad\r?\xf3\x8d2\xc67\xccemNrN\xe6\x1d[\x93\xe2\xdf\xd5J\xfe\xd7R\xca\xc2[\x8f\x90\x82<K+r2.\xd8\x18\x8d\x91B\xf2\xe9\xe7\xae1_4lm\x8cy\xdb\xfd\x17\xa8\xeb%\xc7''\xf4\xd6\x0e\xd58t.\xe0\x86\xaa|UE\v1T#\"\xb9\x9f\xb0vk\x82&J\xa2\x87\a.\xed2\xc95\xcf_\xbd\x95\xb0-\xa9\b\xc1\xe2\xa2\xe4\xfc4%\b\xcf\xdfL\xb1gR\x16̚\x96\xdez|\xf0\x88!\xa6\f!\v-\x15\x91Ts\xc8;\xae\x98\x10\x82<SH\x81\xb7\xd6\xff{\x8bE\xff\xdfooW\x1c\x1d\xd5\u007f|b\xc8\xd6$\xffsR\xca\x0fgZS7=EaPR`\xb4\xa2,\r;\xd3) \xf9\xd83/a]\xe0\xfc\xf6\x84Kӂ\x83\xc3\xc35d\xe2C\xa4\xb6\xa96\x91R\xa2\x06\xc8<\xc4tQVA9\x84\xb8\xfe\xf0}T\xd8(0\"`\xa3DK\xc9w>\xf1\x00\x9f\xfc\xe2\xf3H\x04m\x84@ 3\x19\x8f\\\xdc\xe6\xe6\xf1\x92\xe3y\xcd\xd6ṱ\x93\x191F\x8aL3*r\n\xa30CQY\x18Co-G\xf3%m\xd3&\xcc˧d\xc1\x85\x84\u007f\t\x11\xa9\xaa\x8c\x18\xf9\xa9\xa3\xa3\xfa\x97\xd6\xd7b\xab\xe2\xf8\xf8\x8d\x19G}};\xa3\xa0\xed\x1dۓ\xe2\xb2\x10⣙QY\xdf9\xf2\xdc$\xf8;OU\xf8\xc6d\x84\x91\x19\x9f~\xee:\x9duL\n\xcdT\xc3\xe1l\x06\x11\\\x88\xd4.P\xf7)\v3Ƭ\x03\xb7u\x01A$\u05caܤ\xe0Z\x18\xcdθ\xe0[\xef\xdf\xe3\xdb\x1f<\x8f\r\x91\xdb\xcb>!\xbf!\xa0\xb5fRf̗\rQ\n\xfa\x10\x19\x979Un\xb8\xba\u007f\x82\x94\x92Y\xd3\x12}@\x1bEn\f>\x04\x16\x9d\xa5\xe9\x1c)+\x04\xa35\x1b\xa3\x04\xbd\x88\x01\x00\r!\"\x05()\xe8\xfb\x80u\x01\xad\xc5_\x19\x8d\xb2wW\x95\xf9\x9d\xa6\xb1\xb3\xb6\xb5\xdf\xdc\x1d\xb2=->\"\x85xof4!DR\xdc\xc8\xd0Z\x92e\x9a\x8b\xdb\xdb<\u007f㐗\xf7\x8f\x90R\xb2W\x82$⣠\xb5\x9e\xd6E\x1c\x82I\x95\xb39Ι/\x1bN\x17\x1d\xe7'\x05\x8f]\xdcbw\\2-L\x82Y\xa4d:*y\xf4\xbe=&E\xc1\xf5\x83C\x8eO\x17|\xf6\xfa\x11\xbf\xf6\xf9k\xa9z\u05caw^\xb9\xc8\xf3Wo\xd2\xf9@\x13\xa0\xca\r\x91H\xdd\xf4H)\xb8\u007fk\xc4\xf6\xb8 \xc6\xc8Ѳ\xe3pٲ\xec=\xc1\xa5\xccA\x1bŨ\xc88\xb71bTd\xb4vȺ\xace\xb9\xacq\xce\x0f\x9dJO\x04\xb2\xdcP\x14\xe6\xe31ƿtxX\xdf\xf8\xa6\x05\xf5\xedi\xf1\x03\xc0;\x8cY\xe5\xee\x81<3(%\x88D\xb6\xc7\x13\xacs\\=8\x06!\xd8\xc9#j\x80\xbcOۀ\x8d\x02%\x15{\x9b\x15\xd3Q\xce\xd1ɜ\xae\xed\xf9\xfe\xc7\xef\xe5\xc9+{\x8c\x8a\x9c\xfb/\x9eg{c¨*\x19\x95\x05UY\x92\x19\x8d\x90\xf0X\xd3\xf2\x99/>\x87\x0f\x81In\xf8\xe5O<O\xdb{^\xdc?a{c̍\xa3䖖\x9d\x05\xef\xb9w{\xcc\x0f\xbf\xed2\x176\n\x8c\x928\xe7i\xac\xa5\xb5\x96\xa3EْͭO]_p{a9]\xb64\xbd\xe3\xca\xf9\xadu\x01)\xb2\f\xad5GG'H Ӓ\xde\x05\xbaΡ\x94|\x8f\xd6\xf2\xefmoW\xff\xb8\xeb|X\xbe\x81\x1aE|\x03\f\xf2\x1fH!\xfeIf\xb4\n!\"\x84\xc0\x98\x84Ԏʂ\xf3\x9b\x1b<\xf5\xd2M\xf6\x8f\xe7l\x16\x82J\x83\xf5\x91\xd3>\xed\x10\xa3\x15\x17\xcfMȍf\xff\xe0\x18\x11\x03?\xf2·x\xec\xe26\x97Ν㝏?\xc4d<Ak\x8d҆\xcc\xe4\x18m\x88\x04\xac\xebq\xb6\xc5[\xc7SϽ\xc0S\xcf<\xcf\xcd\xe39O\xbfr\xc2\xc7^<\xa0\xee=\x93L\xad\xeb\x87w_\xd9\xe5\xfb\x1f\xbb\x87IU\xb2\xbd1\xc2(Ik{N\x17Kn\x1dθ}:\xa7\xb6=\xcb\xce\xf2\xccA\xc3\x17\x0fjz\x1b(\x8a\x8c\a/l\xa3\x95\xa4\xb5\x8e\x10\"\xd6Z\x8e\x8fO\x11B`\xbd\xa7w\fEo\xeec\x8c\x17\x8f\x8f\x9b\x83\xaaRԵ\xbf\xbb;$\xc6h\x8c\x19\xe0\a!PJ&J\x0e\xb05\x1eq\xb4h\xd8?\x9eQe\x92J\xa7\x9d\xb1p\x11\x17\x12\x12|\xf1\xdc\x14)%\a\xb7O\xf0\xce\xf1W\xde\xfd0\x8f\xdf{\x8e\xc7\x1e\xb8\xcc[\x1ey\x80\xb2\x18\xa1M\x8e1\x06\xad\x14RH\x84\x94H\xc0d9\xd6\x19\xfa\xae\xe1\x9do}\x94\xcb\x17\xce\xf3\xe2\xd5Wx\xe2\xca\x11?\xf2\xae\x96\xdb\xf3\x1a%\xa0\xb5\x9eIU\xf0\xc0\x85]\xa6Ո\"\x1fR\xda\x18h\xbb\x9eB\x1b\x8c\x90X\xd7\xe3\x97)p?q^p~l\xf8\xbd\x17\xe7,\x9b\x8e\x97n\x1ds\xe5\xfc\x16F)\xba\xe0Ȳ\x8c\xa2\xc8\xe9\xba~\x9d\xf9E \x84\xa0\x94\x92\xff\x04\xf8\x99\xad\xad\r\xea\xfa\xe8\xee\x1aDJYF\x10J\xca\x01\x9c\x93\x84\x10\x98V%Zk\x9e\xb9~\x1d\xa9$S\x93\xd2\xd6ց\xf5\x82Q\xae\xd9\xdb\x19#\xa5\xe4\xf8d\xc1\xe9\xb2\xe3/\xbe\xe3\n\x0f\xeemrqo\x87\a\xaf܃1\x19\xdad\x18c\x92\x91\xa5D\f\r+\x89\x04\x11Ad\x10\x03\xde{\xce\xed\uec33\xb5A\xbd\xacY\xd6\r\xbd\xb58\xe7\x91RRf\x06\xa5u\xaa*\x06\xb6J\xd3uԋ\x1a%%\x11\x91\xd2\xec\xfd\x03N\xeb\x96\x18#\x93\xdc\xf3\xde\xcbc>y}\xc1i\xd3\xf1\xd2\xfe1\x0f^\xdcAJA\b\x91\xb2,\xe9\xbaԯ\x97xB\x888\x1fPJ\xfe\xf4dR\xfe\xdd\xeb\u05cf\xdc]\x8f!Z\xab\t \x9c\vdِ\xb7\x03;\x1b\x13\xf6\x8f\x17\xb4\xbdcÀ\x14\tҮmD)ɹ\x9d1\xc6(\xea\xba\xe3xV\xf3\xae\xcb\xdb<vq\x8b\x8d\xf1\x98G\x1e\xb8\x8f\xb2\xac\xd0Y\x862\n\xa9\x12\x81AH\x81\x14\x129\xa4éd\x93\b\xad\xd0J\x10\xbc$\x04\xc5XkF\xe3qJ\xa7\x83\x1f\xaa\xfcxGO=\xbdN\u07b7w0Z\x04\xde\az\xe7\xf1\xf18\xb5t\xa3 Ɩo\xbf<\xe1\x03ϝ\xb2l{\x0egK\xa6UI\xc0\xa3\xb5\"\xcf\r]ף\xa4\x18\xc8{\x1e\xd0\x18#\x1e\a>w\xd7\r\xa2\xa4\xc8b\x8c\"\f_\xd8\xfb\xc0\xd6d\x8cR\x8a\xc3\xc5\x12Id\xc5y\x9e\xdbTq]\xd8\x19\x93g\x9a\xae\xb3\xdc>:\xe5\xe2F\xc1\x93\x97\xf7\xd8\xdd\x18\xf3\xce\xc7\x1ffsk\x83,\xcf1\x99I\xbbA\nP)\xf8\v\xf9jZ\x90\x16\n\xd0x\xe7\x91*\x15\x91B\b\x82p\b\x17\x89H\u242a\xa6:2\xbd\x9e@0*+d\x94\x04\x9fj\x9d(\"!\x06\x9cs\xe9\xe2\x02\x95\xf7H\xe9x\xfb\x85\x11\x9f\xb8:\xe7t\xd92\xa9\n\xa4\x80\x80 \xcfs\xfa\xdeb\x90\xf4x\xe2\xaaF\x12\xf1\xc2]7\xc8\x03\x97v\xb2\xba\xeb\xae()\xc82\tD\x84\x14L\xc7\x15Mo\x997=\x133\xf0\xa0<\xf4\x1e\xcem\x8f\xa8ʌ\xb6\xb5\xdc>:!S\x92\xef|\xe8\x02\x97v6x\xe4ʽL7&(\x95܋R\n\xa9\x14R'\xb2\x9c\x94b])\xb3\x864$2*Б\x10\x04Q\f.\xc9{\x82\x10\b\xa9\x92k\x03\bg\xcd.!Dj\x86\x95\x05\x13\xeb\b!\xf5=\xacu\xecm\xb9\x949\xd9\x13\x94R\x04븲\x95\xf3\xf4\xad\x9aE\xdb\xd3[Of\x14\xd1\a\xb2,C\x88\xd4<SB\xaeӤ\x18)\xdfP\b\xf8\xfa\x02z\xb8\x10C\xbcO\x92\xaa\xea\bL\xca\x12\xa35\x8b\xb6\xa7\xeb:r\x95\x82]\xed\"{\xdb\x13&U\x8es\x9e\xa3\x93\x19m\x1f\xf8\x8e\a\xf7\xb8\xb01\xe2\xdc\xce\x06EY \xa5N\xc6Щ\x83\xa8\x87\xbbT\xab\xf81\x04u\x99>\xbd\x94\x02\xa5u2\x9cZ\xfdM\"\x94\x1a\xaa\xfddT!d2Κ\xbd\"\x10B\xa1\xa5f2\xaa\x18UUz_\xa9Ү.4e\x9e%T8F\"\x91+[9\xf8\xc8\xc1ɂ\x18\x19\x12\x18A\x9eg\x00\x18%\x90R}]\t\xec\xd7e\x10\xe7\xfd=\xc0\xfd\xc83\x172\x1e\x95\x10\xe1\xea\xfe1F&W\xe1\x02\x8c\xc7\x15\xe3QF\x8c\x91\xfd\xdb'\xb4\x9d\xe3\xad\xe7\xc7ܷ9b2\x1eQ\xe5\xe5\xe0\x92\xe4@\x1d\x95\b9|\xe9ዋ\xf5\x9d!\x9e\f\xff/$R\xa6\f,\x01\x90+\xa3\f\x89\xc0\xe0Ƅ\x1a\x1e\x8bL\xb1h\xf5{\xa1\xd0F#\xa5\xc2hM\x14\x8a\xde:\x8cQ)\x19\x18\x82~\xber\xbd\xcb\x16\xef#Z\xa6\x9d\x91e\xd9\x1d\xeep}y\xdePq\xf8\x86\\\xd6\xd68c\xeb\x9e-\xeaۋ\xb7\n\xc1D\x88䓍\xd1\xe4\xdaPw-'\xb3%\xd3\"\xa5\xbf\xd2\x1867*\xfa\xcerxxJ\xef=\xe7\xc6\x19O\x9c\xdf@\x19\xc5t<JnE\n\xa4Z\xed\x02\xd2jU\xa9\xa6\x11B\x90\fϪӴ\xa6\x87\x88!\xeb\x8a\x03\xde%c$\xca\xc1\x95\xc5\xe4\xdc\x02!\xa5\xa7\x99\x1c\x88X\xc3\xf3\a\x9f\x1fB \x0e\xff\xa5\xaebr_Zi\x8a̰l;vG&-\xe1\x00\xd6;*Y e \xcb\xcc\xfa\xe5D\xb2H<:\xaa?v\xd7v\xc8\xf1\xa2g\xf6\xcaI\x19B\xfc\x0fW\\\xa6\xb4e\x15F+\x0e\x8f\x8eA\n\x94\x04\xa5\x15\x1b\x1bcl\xef8\xbc=\xc3yOe$o\xbf0%ӊ\xed\xc9\x04\xad䰺V.f\x80\xdb\ac0\xec\x84\xf5\x8a^\xed\x14qǎY\xed$\x91\x18)r\xe8\xfaIy沤\x14(d\n\xfeR I\xaf\x99\xea&Ao-]o!\x82\x91\"]\xf9\x18)3\x9d\\\x93\x96\xe4\x83GZ%1+>\xa4V\n\x01\xa8\xe4)>\x9b@\xc6\xf2\xee\xb9,/\xc4ߎ\xf0\xe8\xfa\xa2\bA\xa65\xce{\x96\xcb%#\xe5\xc9%\x14\xe31\x00\x87\x873\x9cw \xe0\xe2\xa4`\xb3\xcc\x18W%yn\xd2EQ\x123\xb8\a9\\\xd0\xd5Ŗ\x12\x84$\xe5\xce\"\xfd[\xa4\x8c\xf7\x8eD6\xb9#\x86X\xc3p\xa1\xd3}x-\xa1\x12\x84/$b\xd8nbx\xef\xb2,ȴ\xc6{\x87\x8f\x812\xcbȤL}x\x91z81\x82Z\xed\xce\b\xadM\x14\xd5\x10\x02.\x84\xb5k\x04>\rp|\xdc\xdc=\x83\b\xf8;\xeb\xd4W\f\xe8\xa8\xd1\xccf3\x96\xbdK\xf4\x99Q\x85T\x8a\xa3\xa3\x05}o\x89\x02F\x99\u245d\x11RJFe\x8eV\x89\x18\x97\x9b\x8c\xccd(\xa5\xd6\xe9\xedjG\fW1\xfd\x90\xac\xa9\x8ak\xa3\x88\xb3\xec+\xad\xf5!n\f1\t\x91\xea\x8e\xf4\xfc;v\x96\x90븓g\x05\x9b\x1b\x13ƣ\x025<\xce\x183\xfc=}\x96U\x8b`՛Y6\xfd`|\x89\xf5\xeeN\xf2\xf7So\xb8\xd0~\xa3净w\xa6:$\xb1I\x9c\xf3dZ\xb3h\x1a|\x00c2\xf2\xaad\xb1h\x98/\x1a\x82\x10\x8c\nÓ\x976(\x8c\xa2\xc83\xb2,K\xa4\x02\xa9ɋl0\x8ez\x95\xebY\x19\xe2\xccI\x0fNb`5\nΌ#\x06\xa3\xac.\xfaj\xaaJ\nֻee\xcd\xd5\xe3\x19\xdeG+IU\x8c\x19\x95\x13\xa6\xe3)\xa3\xb2\xa0\xccW;6%\x04\x9d\r\x84(\xd6\x04\xe3eۧ\xbf+A\x14gͫ\x18\xf9\xe0]E{\xb7\xa7ŃCSm\xf0\xc1b \x95I\x96C/`:\xad\b!pzZs~d\x98T\x86\xcd\xc20\xcatr\x11yNf4\xc6h\xb4Qh\x99X\x1fJ\xa9W\x11\xaa\xcf.\xdaYm\xbeBM\xe2\x9d\x11>\x8a\xb5\xc1ֆ\x922\x95 C\xfc\x881B\f\xac\x1f\xb1\xaaI\bH%\xa9\xaa\x82ݝ-\x9cs4]KU\xb7\x9c\xa8\x06\xe9\x04\n\x985\x0e\x1b@h\x81\x8f\x91\xa6\xb7\xc4\x18\xcfv\xf2\xf0\xb21\xc4g\xef\xaaA|dO\xadV\xc3*\xd3\x11ɗZ\xe71\xc6P\x969''Kb\f<yy\x97ι\xd4\xd8Q)\xb5\xac\x8a\x8c\xdc\x182\xad1Z\x93e\x89\xa9(\xef\x18\xac\xf9*\xa6\xbb\xf8\x03p\xebxG\xe1\xb72FH\x99\x13B V]Gq\x16\a\x84X%x)\xae\x8c\xca4\x9fR\xe6%\xdah\x8c\x96\x88.\xbd\xf4\xf12\x05\xfcL'\x86\n\x88\x81\xed(\xef4\xca5)\x85UJ\xe2}\xb8;.K\r\xabr5\xf7\x17BJ\x15\x9d\xf7\x84\x10\x18\x8dr\xbc\x0f\xd4M\xcf\xfdcM\x88\x9e\x9d\xe9\x98\"3\x18\xad(\xb2\x8c\xaa,\xd2\x0e\xd1\xc9(y\x9ea\x8c^\x93\xda\xe2k-0\xb0O\"w\xfe\x8cg)\xf0kls\xe7,\xc8ʽ\xc5;\xf3\xe5x\xc6\xc9Z\x8dƥM\xa3R\x80\xcf5\xb9JF\xf2!aa\a\xf3\x0eH@e\xddY\x8c\x92\x88;\xe9J\xc9 W\x89\x04\xa3\xefba\x18\x858X}\x11)\x92!\xbcO\xbb#\x84HY\x148\xe7qγ\x91\vڮg2\x1a\r\x03\x98\x9a\xaa\xcc)\xf2\x9c\xcc\x18\x8cщ\xf1>d+>\x04b\x8c\x03\x13^\xa3\xb2\f\x99\x99\x94A\xadz\xeb1\xae\xeb\x87x\x87\xb1\xd6F\x8b+2\xf4و\xc1ʊ\"\xf9\xbe5?\xf8U\xeeBJ\x94V\x94EIn2\x9c\x8f\xd4}\x87\v\x81e\xe7\xa8\xfb\x94\x06\x17\xf9`\x10\x9d\xe8\xa7b]\x0fApᝮw\xba\xed\xfc\xddsY\x10\uf173,h\xf5\xb5\x9cK\xedL\xade\x82\xa5c@J\x85u\x9eQ\x91\xe3l\x0f\x02\x8a\xa2\x18 \x11\x8d1)-\x9d/\x1aB<\xe6\xa1\a'(\xa5\xf8\xf0\xe7\x9e\xe5s/\\\xa7\xb7\x8e\xaa\xca\xf9\x8b\xdf\xf5n\xee\xbb\xe7<8G\x8c\xe1U\xbb\xe5\xabv\xc9\xca5\xc58\xc0Xq\xed\x9eV\xbe\xedl\\\xeel\xa7\xa4\xcf>,\x04\xa58^.\x985\xa9W\u007f\xeb\xb4%\x00Y\xa6p6!\xc8F+\x9c\x0fh\xa5\xd7q4\xc6P\xfa\x10.\x00/\xdc5\x83\bD\xfe\x9a+\x80R\x12\x1f\u009a)✧0\x8a\x8b\xbb\xdb\xcc\x16\v\x040\xaa\n\xacsdF\xa3\xb5\x19\xe2\x85\u0085\xc0\xacn8\xb5\x9e_\xf8\x1f\u007f\x95\x0f\u007f\xfe+\xb4Υ\xcb\x15S&\xf7\xdf\xfd\xca\xfby\xfb\xc3\xf7\xf1\xedo\u007f\x94\x1f\xfd\xeewsncJUd\xeb\xd0\x11ψSw\xb8\xb3īZm\x06\x81X\xd3zV\xb7\x10\".\x04\xbcs\xb4\xd6R7-\xb7ON\xb9v4\xe7\xa4\xedq.\xe0\xbc㴱\b%Ȍ\xc2ZKd :X\x87\xd1\t\u007f\x8b\x83+TB\xbc\xf3\xae\x1a\x04x\x1ep1F\x1db\xa44\xc3p\xe5\x1dc_!\xa4\xb9\xbf<\xd3ܳ\xb7\x83\x8f\x91IUq\xb2\\\xa2\xb5\x19\x18)\x1a\x04\xdc<]\xf2{\xcf\\\xe5\x99Wn\xf3\x96\xcb\x17\xf8\xceoy\x84\xcdq\x89\xb5\x8e[\xb7O\xb8q<\xe3\xd6ɜ\x8f<\xfd\x1c\x1fy\xfa9\xfe\x8f\xdf\xfa\b\xefx\xe4~\xde\xf9\xc8}\\>\xbf˕\x8b\xe7x\xebC\x97qm\xcaz\xe2\xc0|LA=\xcd!\xae\x03z\xaaRPFr\xeb\xf8\x94O<\xfb\"/\x1f\x1cs2\xaf\xb9=_p\xeb\xe8\x94/\xbd|\x8b\x93\xbaEK\xc1=\x13\xcd\xceHaC\xc4G\xd0\n\xacO\xf4\xd2D_\x92D\xe2\x1a{\xf3i\xd3\xfd \xf0\xabw\xcd G\xb3\xf6\x99\xedi\xf1\\\b\xf1\xb1\xf5v\x17$:\xe5ඳL#b\xc0h\x83Q)\xef\x9fT%\xc7m\x93\xb2*c\x86i)E\xae3\xfe\x8d\xb7=\xca\xdf\xfc\xf3\xef\xe3ҹ-\x8a2g\\\x95h%\xb9\xf6\xf2\r\x9e{\xe1:\x9f\xfc\xf8\xa7i]\xe0F\xd3\xf3\xd9\xfd\x19\xbf\xf1{\x9f\xe6\xff\xf9\xc8g\xa9\xf2\x8cq\x99\xf3\xef\xfc\xe8\x0f\xf0\xb7~\xf8{h\x9a\x1e\xe7=\x84\x94\x8e\x12#\xd1\ab\b\x84\x10\x90B\xf0\xe2\xc11\xbf\xf2\xc1\x8f\xf3\xe1/<\xc7\xc1\xe9\x82Eӥ\xe7\xbc\xc6\x0f\xd8\x18yyf9n#\xce*\x18\xea\x99\xe8<\xe3\"\xa3\xb3=Հ8\n!Ȍ\xa1\xe9=\xc0wܵ\xb4\xf7Ѳ\xe0٦%\xc2\u007f\x1bb\xfcy\x81 \xc4H\xa15\xcb\xd8\x12ú\x93\x88\x0e*Q\x81\x8cN\x99Tf@(\x8a\"17\x92\x18\x80\xe4\xfe\xbdm\xf6\xce\xed\x90\x15\x05\xcf~\xe9\x19\x96\xf5\x92L+\xbe\xf4\xc2u>\xf0ś\\\xbby\xc0\xb7\xbd\xe7I\x0e\x0f\xae\xb2%\x1c?\xf1\xf0y\xf6[˧\x0ef\x1cw\x96\xb6\xb3\xbc\xff\xf7>\xc5O|ϓ\xf4\xbd\xc3[\x9b\xe2[\b(\xa9\b\xdea\xad\xc5\x05\xf8\xf5\x8f?\xc5\u007f\xfa?\xff\x1aJ\xbe:\x8bS\x03\x0f`T\xe4\xecm$\xa9\x8e\xd3yMg;\x1a\xef\xb12\x81\x93r\x98\xd8\xda\xde\x1cs<;E\xa9ɐ_D\xb4^c9\x0f\xdf5\x83<\xdb$\x0eo\x95g\x1f\xe9\xac\xc3\x05\xbf\x0e\xdaG\xa7\xf3\x84W\x01RI\xfcP,晡*sFE\x81R\x92\"\xcb֠^\xa6\x15E\x993\x99nQ\x8c*\xa6\x9b\x9b\x9c\x9e\x1csp\xf3\x88\x10\"\xff\xe7/\xfd\x12\xff\xfe?\xfcGl\xec\x9e\xe3+\xd7n\xf2\xf4\x8b71{\xf7\xb3W,\xf8\xd1\aK\xae/Z\xaaQ\xc1\xbb\x9e\xb8\xc2\a?\xf4Q\x1e\u007f\xcb\xc3\xeb\xe6\x91\x12\x029\xa8<<\xf5\x95\xe7\xf9\x17\x1f\xf9<\x1f\xfb\xc2Wx|KQf\na*>umF\xf4\x8e\v\xdbc\xderi\x97\a/l1\xa9*ھ\xe5\xe6+78:\xf1,\xa2\xe4s7\xdaW\x95A\x02\x12\xd1NI\b\x03\x9b2\xa6B9\xc0\xe8\rw`\xdf0kqZ|\x0f\x91\x1f[\xc5ǭɄ٢fQ7L\xc6\x15JJfu\xc7孊{v79\xb7\xbd\xc1\xde\xee&\xd7\x0e\x8e\xb9wo\a\x060rR\x95lL\xa7\x8cFS\x8c\xc9\xd8\xda\xdeb<\x9e\x90g9\xa6\x9c2\xaaF\xbc\xe3\x1d\xdf\xca\x17\xbf\xf8,;\xd3)\u007f\xe3G\xff2\u007f\xed\xc7~\x1c\x05\x84\xf9!F\u0083\x0f\\Be\x19u\xdd \x04\x8c\xab\n\xdb[z\x9b\xe6ٟ{\xe9E>\xf8\xa1\x0fQ\x1f\xef\xf3\xe0\xf9m~\xf8\x87~\x98E\x9fs\x9a\xefq\xbc\xac9\xbeu\xc0\xb7=\xfe6\xf6.]\xe6\xfd\x1f\xfd,\xd25\x9c\x1c\x1d\x12]\xca\n}\x80\xab\xb7j\xea\bE\x9e\xads\x02\x11\x03[\x1b\x13|\x84\xd9bIfL\"\n:\x8fT\xbc߹x\xed.\xb6p\xc5[S\x1e\x9e2\x9c\xde9\xb4N\xea\vm\xd73\xaa\n\xf2\"\xe3ţ%o\xbb\xff\x02FK\x9aަ\x8cD\xa6\xad]\x18C\x95\xe7(!p\xceRPRTcΝ\x17\x14Uɹ\xba\xa5>\xba\x81\xd1\x19\u007f\xe7\xc7\xfe<\xceA\xb3Xp\xf3\xb9\xcfP\xc4\x1a+`sg\x8b\xbc*\x13\x82\xab\x04\xf3\xf9\x82QY!$\xdc\u07bf\xc53_\xf9\n\x1f\xfd\xe4'\t!\xb2\xbb\xb5\xc3\xf4\xdc\x152Y\xf2\xdd\xef}\x0f\xff\xec\u007f\xffM\xda\xdb\xfb<t\xe1\x12\xdf\xf5\xde\xf7\xf2\xf0\x03\x97Q\xf3\x03\x8e\x8f\xae\xb35\x1d#\x82\xc5\xf7-\x9d\xb7\xb4.bL\x92\xdf(\x8b\x8ce\xd31\xcau\xc2\xc7\xfc0\xa2\x1dS-ӧZ\xedo\x02\xff:\xcb%}\x17\xde<\x83\xbc\xed\x81=\x9eza\x1f)x_\x88g͐\xbe\xb7\xe4\x99F)\xc9\xectɨJ\xe0\xdc\xf3\xb7\x17\xc3T\x92\xe6h\xbeL$:\x950\xd92K\xb1\x85\x10\b\xb6\xc7;\x8f\xce\x04\xe3\xe96YYR-\xe6\xc4\xe0\x13\xa0\x17gܸy\x8b٬\xc5ٞ\xf9\xec\x90\xed\xf3\xbbT\xe3\x12e\f\xd1GL&\xb9\xf7\xe2\x05\xa6\xd5\bS\x94\\}\xf1E\xae^{e]hf\x06\xa6\xaafq\xf3\xcb\xf46\xf2\x97\xde\xfb Wv\n\x1ey\xf8!6T\xc7\xf3\x9fx?W\xb65W\xf6\x1e\xc4(\xc9\xf5\xfd\x9b\xf4\xc1\"BK\xef\x03eUR7-e\x91c\xad\xa3\x98N\t!\x12IL\xfc\x10\xe3\x00`\x02\x82\xef\x01\xfeH\xc6xC\x06y\xea\x85\xfdU-\xf2$D\xfc\xe0G\x97]\x97\xe6͕\xa6\xed:ڶ\xa7(2\xbc\x94\xb4\x03)\xe0\xf8\xf0$Q@Y\xb5g\x87n \x91\xe8-\xc1v\x04\x97\xa1\xb4\xa1\x1am0\x1eo\xb0\\̰]\x83\xf7\x8eK\x97.\xb0\xb3\xd3am\x8f\xbb\xef\xdc\x00D%\xa8\xfe\xf8\xe0\x00\xa3\x05E\x9e\xa3eF\xb0\x91\x18S\xd3)\x8dR\x1b6\xc6S\xac\xed\x89q\x8e\xd1\x19\xca\xcf\xf8s\x0fo\x12\xe2\x01r~\xc4\xdeF\x85\x90#\x84\x94\x9c.\x17L\xf2\x82Y۰h,=\x8aQ\xa6\xa8[\xb1\x1e\xce)\xf2l`\xe6\xa7\x06U\b\x01\xa3\xd5*I8\x9f\xe5\xfe{\xfb\x8e\x0f\xdc-\xd6\xc9t\x85g\xb9\x90ڝe\x9e\x91\x1bE\xdb\xc1b\xd9P\x14\x19\xa32\xe3ּ\xe3=\xd3\t_\xba~\xc0\xb4*ΚDw\x80r\x91H\xf0\x0e\x82;\x83@\x84d4\xd9ėc\xbc\xb7\xc4\x18\x88>\x12\x82\x1f\x1aK\xa9\x1b\xd8\xccO\xb0͜\xe0\x02F\x19\x94H\x8b\xc2\xf6\x1e-\x15\x12\xc1\xee\xe6&ۛ[\xb8\x10ɔF\x994\xff\xaed\"S\xacܨ\x92\x1am4\xce;Jc\x98\xc5\xc8\xc1\xa2Ǫ\xd4\x11\x95B`]2HY\xe4X?\x90\xad\a\xc6\xfe\n5\x10Bl\xe6&\xfb\x8fC\xec?\xae\x95\\\xb4Mxs\r\xa2\x95<\xf6!l\x01X\xe7)\xb2\x88\x0f\x9e,ϑuK\xd7\xf5\xf46Ō\x0f?{\x9d\xf7=~\x99eݲ\xb3\xb1\x952,\x91\x18#R\xac |\xfd*zϚ\x98 \x04z`0\xae\xc6\xd9V\xf0G\x8c\x10m\x8b\xb7-J$\x92\x9eVj\x18\x1b\b\xeb\xb9F\xa35\x17v\xcea\xb2D\x9f\xccL\x96P\x82uo#\x0e\xdfI3\xae\x06\xad\xad\xc5<т\xbcg\xdey$\x11\xeb\x129\xce9ϨL\v+\x84\xf8\xea)ށ\xa1\x12C$\xcb\xf2\xef\xf7\xc1\xff[u\xed\xff\xa77\xbdc\xa8\x94\xf8\xdd\x15\xb8\xd8;\x97\x86\xed}*\xc6FU\x89s\x81n\xe8\x8d\xec/\x96\xfc\xa3_\xfc\r\xe6mO\xa6\xe5\xf0\xf5\xe5\xd0\xf1;c\x86H\xa5\x91J\x0f\x00\xe0\xd0L\x8aC\xdfz\xdd5\x94\xab\xa66\xd1u\xb8\xe6\x94h\xfb\x01u\x17D?\xf4O\xe2\xd0V\xcer\xc6Ո<\xcf1&cT\x8e\x18\x8f\xc6l\x8d7\xd9\xd9\xdcbs2es2eog\x97\v\xbb{Lʒ\xe0\x03\xbd\xb5x\xe7\xe9]\xe4\xc4\x05\x94J$@-%\xd69\xb66F\x03\xa9\"\x0e\x93\\g\x05\xb2\x8f\xc9sTy\x8e\xd1\xe6\xbfP\x06s\x17\f\"\xff\xa9\x92\x89\xe9\x1ec$\x0eB0\xa9\x83h\x10\x02\xea&qd\xb3\"\xc3\xc6\xc4\n\xd1k\xe6\xe1j\x1cyhn\xa9\x046\xca;:~+\x1en£\x86;\"\xe5\xfd\xce\x11\xbb\x96h\x1d\f\xfdl%\x04Q$\xf2\x816:\xf5e\x8a\x82\"\xcf\xd3\xce\xc8rƣ\x11\x1b\xa3q\x1am(J\xa6\xa3\x11\x1b\xe3\teV %\x84\x18\xe8mR$\xealG\xef=\x8d\xf3d\xda\xe0c@\xea\xd4稊l\x80\xe5\xd3dU\fq\x85\xea\xe3cB\xac\x85\x10\x8c\xcarG+\xf1\xd3o\xbaA\xa2\x8b\xbf\x19c\xfc\x05\tlM*|\b\x03\r&\xc1$y\x96؉!\xa4\v\xa4t\xea+\xac\b\b\x90\b˫\tW\xb1\xe60\f\xbd\x89\x10\x12*\xfb\xda\xf1\xe8\x10\x89\x89\xfbI\b\x9e\x18\x02\"F\xa4NzYZJ\x90\x02\x93\xe5TEɨ\x1a\xa1U\"Yk\xa5(\xf3\x02\xa3\xcd\x1dć;\xbaU1\x0e̓\x1e\xebzz\xe7Yv=\xed\xb0\U000e5429A\x95\xa5v\x81_M\n\x87\xb0V\x9aX\x8d\xe7E\xc0:Ǩ(\x89\xf0\xd7\x01\xf2B\xbey\x06y\xf1\xd6m\u007fx\xb2x\xc6z\xcfƨDI\xe8\x9d\x1f\x15G\xdbf\x00\x00\x1c|IDAT\b\x0fb\xe8k@Ӵk\x88\xde9\xbf\x16\xd2QR\f\x90\x83X\xabĥ\xd9\xc1@\f\x9e8\x04\xf7x'\xc01\xb4`W\xa3\x04ɨ\x01D\x9a31J\x9c\x05W \xcf\n\x8a,\xa3\xb5}\xca\xe8\x86D\x00\xf1\xd5\xdd\xc8\x18#\xd6:z\xe7\xe8\xfa\x9e\xbe\xb78\xe78i:\xea~`HJI?$/\x00\xc1\xa7i\xb1UʫT\x82\xe3W\x95|\xef,&\xf1\x04\x1e\x03\xe8\xda\xf0\xe6\x18\xe4\xbe\xf3\xbb\x94y&\xb5\x92\xef!\n\xa4\xd0,\x16\xed\x998%P\xe6\x19R\xc2l\xde %\t\xd9E\x10\tk\xecABBbW\xc10\xba\x84\xd4zG\b.\xa9Ŭ\xfa\xe01$\xe46\x0e\x8c\xf6\xe0\t>=^D0ڐg\xa9O\x8f\xf7\x03\xb6\x94(\xa9\xb3\xbaF\ny\xd6?\xb9\x13\x04\x19\xfa\xf0!\x84\xa1\xd1\xe6\x93A\xac\xc5z\xc7\xcdE\x9f\xe8?\x83\b\xa7s\x8e\xe9\xa8L\xb3 1%\x0e1\xa6ݠ\xa4ć3\x90\xd2\xfb@L|\xb5ћ겮\u07baM\x99\x17\xd2y\xff\xbd\xe3\xaa\xe0\xe0\xe8\x14\xa5$\xc1{\xfc\x90\x8b\x1b\x93\xc0D\xe7<m\xdb#\xa4\xc4\xc70\x14R\x11\x1f#n\x98GD\xa4\x15\x1f\x9c#\xf8\x9e\xe8,\xd19\x82\xb3\x10úK\x18\xe3`,\xdf\xe3mK\xec\x1b\xe2\xb0\"3\xa9)s\x83Q\x1a\xb2\x02LF\x88\x01bʪ궁\x90.⫬\x11\x93\xa4\xe0\xea\xde[K۷\xd8\xc1u\xbd\xb2p\xac\x1a3Y\x96*\xf3l\x18\x12\r\xc3\xf8\xc1*\xb3\x93B\xac\xd9\xef\xdcI\xb0\x94\xa2{\xd3c\xc8|\xb9\xfc\xc1\x18\xe3vn4uہ\x10\xb4\x9dM\xbd\x81A\xc02\t\x1c\v\x16\xcb\x06\x1b\"\x8dM\x83\x92!D\xa2\x8ftÔm\f\x83\x1f\xf6\x8e\xe8\x1d\xd1[\xf0.\x19&\xb8$\x000\xfc-\xd8\x1e\xd7ք\xbe!X\x9b*y\xeb\x111b\x94\xc1\x8c\xa6`r09[\xbb\x17\x90Rr\xe5\xd2=\\ۿ\x99\xd2a\xefֽ\xf5\x18\x03\xde;\xacu8ﰮg^/\xa9ۆ\xb6\xefh\xba\x96\x9bˈ\x1a\x18*1D2\xadQJ\xe0\x86\xd5?LM\r\x17^\xde1;\u007ff\x14\x89|\xfe\xcd'[\xc7\xf8\x0f'U9Ha\x84\xa1`r\xf4\xbd#\x840T\xe1\x89\xda#\x90\xb8\x108Y\xb6\xa9\xef>\\\x92\xe0\x03v\x90\xed[]\x9c\xe8S\xfc\xf0\xbe'\xba\x9e`{\x82K\xbb\xc6\xf7\r\xbe[\x12m\x87\xef:\xbcut]O\x9e\x17\xe4ڠ\x8dAe\xc5:\xdell\xefb\xf2\x82Q5\"\xc4Hӵt\xd6\xe2}\x9a\x13\xf4ާY\x10\x9f4N\xe6\xcb%\xb3\xf9\x8c\xe52\x19\xe5\xfa\xac\xa1w\x89\xc01)\v\xbc\x0f\x18#\xd1J\xa5Y\xfa!\x19\xb1ޯ\x92\xf4!\xb6q\x96A\x02!\xfa\u07fd\x1b\x95\xfa\xfb\xaa2_\xaf\x88Մ\xd2\xc9邲\xd8\x19H\v\xac\xe9\xa5JH\xae\x1dΙ\xd7-\xbb\x9b\x935\xc9\xc0y\x8f\v\x81lȠ\xbc\xeb\x11\"\"cH\xea\n\xc1!\xa4&x\xbb\xde=\xc1Z\xa2\x8f8\xe7\xd9\xdc\xdca2\x99Ҷ\r\xbd\xb7w\x8c\x03\xa4\xd8`\x86~\xf7\xd6\xc6&\xb7O\x8eȳĖ4:`\x9dù$\xe1\xd1u\x1d\xf3\xa6fY\xd7̗5\x8b\xe5\x92\x17N\x12է,r\x84H\x9d\xc1\"\xcb\xd6l\x1b?П\xbc\xb7)-\x0ea-j\x10Is\xeei!\xf4\xff\xea\xcd7\x88\x10\xf4\xd6'\x9f\rIzII\xba\xderpxʹ\x9d)\x16O\x96\x19\x9a\xf9\x12!\xe0\xe6ђ\xc3ٜ\xfb/\x9c#\f$\xd9\xd5(\xd8ʥ\x87\xe0\x88v`\xa3\a\x8fp\xa9p\x8c\x03\x1b%\xd8>ł\x90\xc6ϔ\xd6\xdcڿIo[F\xa3\x8a\xe8\x1dBg \x04\xb6YR75!D\xa6\xa3\t\xaf\xdcz\x85\xf92\xc9\\eZ\xa5\xdd9\xe8\xc77]K\xdd6,ꚺ\xa9\xe9l\xcb\xd5y\xca\x1a\xc7EAgSp/\x8b\x8cմ\xb1\xf7\x0e!%\xb6\xb3\x14E\x9ehP\xeeL\x983\xd3&i\xb2\xb4\xf1Co\x9aA\xc6F\xb2\xb0\x81\x18\xe3o\xce\xeb\xe6/\x10#\xa3Q\xc9|\xb1dT\xe6\x03[=r2[R\x959\f,\xf4\xe0\x03\xd6\a\x9e\xbfy\xc8\xdb\x1f\xba\x9f\xcc(\xb4L\x05\x9c\x92\t\xcb\x12C\x90\r\x04\x84\x8f\x88\xe0W\xb4\x84\xc1Z\x11\xa2\xc4{\xcf\xfe\xc1!RIn\x1e\xdc\xe4\xf6\xe1\x11>Dƣ\x92\xb7=\x119w\xe9A\xbcs<\xf5\xf9O\xd3\xd4\xf5\xbaFh\xba\x9e\xbe\xef\x991\xc3h\x83V)\xf3\v\xd1\xd1\xf5\x1d]۱\xa8k\x96mC\xdd\af]\x8a\x19\xc6(\x9a>\xcd\x11\xaeƠ\x01zk\xd7\xc8\xef\xd6\xd6\x06M\xd7\x13\xa2\x1f\x98\x92)\xf8[g?\x0f4\xda\b\x9c\x8d\xdfx\x83,\xce\xce\xdf\xf8\xc7ι\xbfpx2\xe7\xc1\xfb\xce3_\xd4\xf8\x10ו\xfa\xb2n\x13\xa3]+2\xa3\xe9zKf4\u05cf\x16\x9c.\x96\x8c\xaa\x9c(\x04Z\bV\x02g\"u\xdb\xd6\xfa\xba1\x0eڽ\x03M\xd4\xdbH\xd3\xd54MG5\x99\xa2\x95\xc2\xf5\x81\xf9\xbc\xe7ڭ[T\xa5\xa6iZ\xb66\x9f\xa5\xeb\xed\xa0,\xd4\xf1\xf4\x97\x9e\xe3\xcbϿ\x84\xa3\xc7>ay\xf0\xde{\xef [\v\x9c\xb7X\xe7\xa8ۖ\xa6k\xe9]ϋ\xb3T\xe8\x9aA\xdc9\xb9]}\xf6\x99\x04t}OU\x16k\x94\xa1\x9b/\x88C+X\n\x89њe\xdb\xfc\x1a\xf0\x872\xc6\x1bvY\x9b#\xcd\xc9\xd2}l\\\xa8\xff\x12\x11\xff\xfeɢawk\xc2ɼ&\xcf\xcd@+M>^\r\x81ݹ\xa4\x99u\xe3p\xc1\xf1|\xc1\xc5s[\xf80\xc8/)qF\x9a^\x05D\xa9\x06N\xaf\x82\x10i\xea\x0e\xb4\xa6ܨغPR\x8d\xc6蠹t>\xf2\xb6\xb7}\x1b\xd7n\\\xe7\xfd\x1f\xfc\u007f\xf9\x9d\x8f~\x8a\xcb\xf7\\$\xcb\f'\xa73\x9e\u007f\xf1*7\x87ݔ\x15\x86\x17\xae_\x03A2J\x9ef:\xba\xbe\xa7\xeb;\x16uCg{\xbc\x0f\\\x9bY\x04\xc9]\xf9axgT\xe4X\x97Z֙\xc9ֺ^\xa3Q9Ċ3\xc9\xdaܘU\x9d\xf2\x9bo:\xfc\xbeh\xfc*m\xfc\x80V\xf2\xef\xd7m\xda\xceeY\xd0w\x1dY6T\xde\xde\x13B\x1a\xc2\t!\x8d\x88\x1d\x1d\u05fc\xb8\u007f\xcc\x03\x97Γ\x19\x83\xf3>\xe1Sw0܅\x94\xc8,C)\x8dP9B(\xb2I\xea\xc1\xa7\"\xce\xf3\xf4\xb3_\xe6s_x\x96\xc3\xc3c\x1e\xbb\xf2 [\x1bS\x9e|\xf7\x93\x94\x1b\x86O\u007f\xe6)NN\xe6\xcc\xea\x96FFr\xa3S\x16$\xc0\a\xcf\xcb7o\x10\x83gs\xba\x91\xfa\x18x\xfa\xde\xd2v-}o\xa9m\xe4x\xa8\xaa'\xa3\x8a\xd3ł\xb6\xb3\x94{\xd9Zy[\xebT\x93\xc4\x10)˂\xae\xeb\xf1\x03\xab%\x12\x99\x8e\xc6X\xeb\x0eھ\u007f\xe1M7\x88\v\x91\xbd\xcdB4m\u007f\xc5\xfbH\x8c=q\x10\xfcr\x83D\xf8j\xa7H\x99\xc08\xa5\xd3j\xccs\xc3S/\xed\xf3\xeeG.3*\v:\x9b\xf0\xa8U\x19-x\xcd\x00\x88\x00\xa1\x129\xed\x95Wn\xf0\x99/=\xc3g\x9e}\x9e\xa7_\xb8\xc6Q\xe7ȵ@}\xe6\xf3\x14Jr\xef\xb4\xe2{\xbf\xe3]\xfc\xc0\x0f\xfd \x9f\xfe\xfc\xb3|\xe0ӟB\n\x81\x97\x8a\xd2:\xa4Op\x87u\x96\x97n\xde\xe4\xe0\xf8\x98QU\x90e\x19!\x04:\xdba\x9d\xe5\xf62\xb0\xecÚ\xaa\x94\xbeS\xa4\xeb\xdd0'\"Vx5J\xa5\x05\xb78\x9d\xadה\x12\x8a2\xcf9<=}\xb6\xb7\xfd\xfcM7\b@\xe3z\x11\x05\xf7\xaef\"\x04\xd0v\x89&\xee\xbd?K\x85\x85 Đ\x86)IYʍ\xa39\xd7\x0eN\xd8٘\xd0\xf4Ø\xb1\xf6\x84\xa0\x18\xe2\xec\xd0\xef\b(\x02\xb7o\x1f\xf0\xa1\x8f~\x8a\x0f\u007f\xf6\v<{\xfb\x84\xb9\xf5\x89\x8e\x83@F\xf0$\x01\x98\x83\xba\xa7\xeb<\xce:\x1c\x1e\xeb\\r\x1dB\xb0\xcc\f\xd6{B\x1d\x18W\x10Ud\x11\x02\x9ds\x94Y\x1a\x14\xb2\xd6bC\xe0\xc6\xc2a=\x8c\xaa4\xa4\xba\xaa\ue6f6g\\\xe5\xc4h\xd7C\xa5\f\xa2gM\u05ed\xbf\xeb\xce\xc6&\xce9\xea\xae\xf9\xad\xbe\xe3\xe8\xae\x18D*%C\f\xf7\x89\xd5(\xc2\x1d\x18\x95\x10\x82\xbe\xb7\x84\bUU\f#\xc4\ngӔQk=_\xbcz\x93\xfb/\xecR\x969m\xdfSf\x1a%\x031ʵh\xa6\xb5\x96\x8f=\xf34\xbf\xfb\xa9\xa7\xf9\xdcK78n{\x1a\x0fJ\x82\x16I\x90@!0\x83HrF\xaai\x00\x1e\xbe|\x89\xa3\xe3c\x9ey\xf9\x1a\xce'y\x8d^)\x1c\x11\xdf\xc1\xa4\x00#Hl\xcbHJ<\x9c\xa3\xf7pm\xe1\x89\"b\xb4\xbe\x03\xff\x10\xd4m\xc7dT\xac\x17\x9cRi\x02\xabi\xbb\xf5\xe2\xd3R3)+\xe6M}Z\xb7\xfd\xef\xfeQ\xaf\xeb\x1b2\xc8\xdeFA\xed\xac\x88!^\x92\xa4\xfe\x85\x1fVm\x02\xd4b\x1a\xb0\xf1qݽK\xb2|\x1e\xa3\r6\x04^>8噫\xd7ٜ\x8cX\xb4=\xa3<G+O\xeb<\xcaY\x8c\xb6\xbc|\xeb6\xbf\xf8\x1b\xbf͵\xd3\x1a\x1b\"J\xc0\xc8\b\xee\xdf\xda\xe4\x81\xcd)\x93\xa2\xa4\xcc\x13\xdf\xeb\xed\x0f_AI\x85'r\xad>eR\x8cx\xe2\xca\x03\\\xb9p\x91\xcf?\xff\x02W\xf7\xf7\x87\x85#XFh{\xc9V.\xc9cĉ@\xb4\x96\x10\x03\xa7\x8d\xe7\xa4\xf7\xa9e0쀕\x98\x8e\xb3~\x88\x87\xebN\x00R\n\xeaaw\xc4\x18٘\x8c\x11Bp\xb2\x98\x1f\x86\xc0\xc7\xee\x8aA:\xd7\x13]\x04xt%\x01\xbeN\x93\xce\b\xd9\xc84\xbe\x942.\xeb\xb16\x90\x99D\bx\xe9\xd6\t\xd7/\x9e\xf2\xdc\xf5\x1b\x94\xb9\xa1*\fR\t\x94\x8cX\xef8n{\xfeٯ\xff\x0e_<X\xe0\xa3d+\x17ܿ;\xe5~\x93\xf3\xd8\xf66\x81\xa4\x03L\xdbc\xba\x9eg?\xf9y\xbc\xf3\x94U\xc9\xe8\xfc6Ͼ\xf4\x02\x8b\xd3\x19QI\x9e8\xb7\xcb\xc3[[<\xf5\xd25\x0e\xbb\x16\xa9\xa0\x17\x8a}\xabؒ\x81\r\x15\x10!!\a_\xbe\xed\x10Q\r\x04r5P{\xd4\xda\x05w]\xc7hT\f`bBt\xbb\xbe\x1fZ\xc0\x8aqYq\xba\x98c\x9d\xab\xa3gvW\fr\xba\fl\x8d\xf5\xc3\xd6\xfaK+pM\xbcf\xb6f\xc5wMs\xdf\x12\xef\xceN\xac\x11R\xb0\xec=\xff\xfa\x8b\xd7ٞTl\x8cF\x03\xcc\x00\x95\x91Dg\xf9\xbf?\xf64O_?\xc4#y\xe4\u0098\xef|\xcb}<q\xe52\xd1\x05d\x10\x88^\x92/\x13,\xb3h\x16,\xda%K\xbb\xe4\xf8t\xc1\xe2\xd6\x11\xad\xebٝ\xeeRd9\x8d\xed8\x9e\x1dpis\x8b\x9d\xd8 \x94\xe3\xc6\xd2s\xd0\x06N\xa3@:\xc1D\a\x8e\x1axq\x91\xa6~C`ȘX\x17\x84!F\x16u\xcb\xd6F\x82~\xe4pzO\x1c\xb6L\x99\x17\xa8\x81\xb1\"\x85x\xfdi\xa27+\x86x\x1f\u007f\x8a\xa1\xa7\xee\x83_\x8f!\xbc\x16^I\x8d\xb8@\x14\xeal\xb0\x9fDn8Z\xb4\xbc\xff\x93_N\xb5\x8a\x10\xdc{\xf1\x1c\xbe\xc8y\xe9\xe6>\x1f~\xe6eb\x94<\xb47\xe6\xaf~\xf7;x\xfc\x81\xfb\x90Z#\x85\xe6\xe4\xe5c^\xfc\xdcW\xb8t\xf9^\xceo\xef\xf0p\xf1\x00J&ͮ0T\xfc\x9d\xed8==\xe6\xf0p\x9f\x1b7_\x81>\xa3\xde2\xf4\x1a\xbco\xf1\bNzK\xeb\x05\xc7NR\a\xc9sG5}T\x18\x11\x06\x18']h3̠GX\x83\xa71\xa6Zc\xc5:\x11B\xb01\x1a3\xab\x97gP\xd0\xdd\xe0\xf6\x8es\xc1\xa2\x8b\xf8\x10\xde!\xa5\xbacL\xec\x0e;\xc4a\xf6n\x18s3Ƥ!M^}\xf4\x9d\x10\xb0?k\xf9\xe83W\x11\b\x16u\xc3\xe3\x0f]散\xa4\xa9\x9bi\xc9\xe3\x97\xf7\x98nl\xa2s\x9dz\xbc\xceS]2\x14Ǌ\x17_z\x96[7'L6\xa6H$\xedi3h\".\x99\xcfg8g\x89B\xe0\xa4`\x91[\x04KD\bH!)\xd4\x19\x8f\xb6\r\xd0\x06\xc9\xcdY\x9f\x8c18\xdf\xceZ\xc61\x9d\x87\xb5\xb2H\xea$\xa6\x05(\xa4\xc4[\x9b\x86wLF\x9e\x19n\x9f\x1e\xaf\xbe^V\x94R\xb5M\xf0o\xaaA\x16\xdd\nY\x12\xf7q\x87B\x051\x11\x1eB\b\x84a\xd2uE\x1eˍ\x19Z\x9bb\x98\x1f|\xb5\x11\xbfr\xe3\x84Q\x99Q\xb7\x1dR)\x96\xad\xa3\xf7\x82\x8d\\\xf3н\x97\x18\x8f\x8a\x94b\x92$\x94\x8a\xa2\xe2\xca\x13\x8fpu\xfc\x15\xea\xfd\x86\xa7\xbf|\x9b\xd0y\xa4\x0fI5C\x80\x97\xd0f\x91FzZ\xe9ؚ*v\xb778==\xc5Y\x87wi\x95w^\xa2%\x9c\xcc\x1b:\xebP\"\xa5\xd1@:\x97d n(!\b\x03äm[\xa6\xd3\xc9:\x1b\f1\xb21\x1a%\xac\xeclF\xa6\x02.\x00\xd7\xd7-\xe5B\xae۸Y.\xe8\xbb\xf8\xf5\x19d\x94\v\x96]d\x94\xf3^!ķh\xad\x123\x10\xd0f\x98\xcf\x1b\xd8\xee!\xa4\x8c\xc4\x0fD\x868\x8c\x91\xc5\x10\xd6'\xac\x895\x19 \xf0\xf4\x8b\aT\xb9a\xf1̋\x1c\xd5\x1e\x1f\x03\x8f\u07bb\xcb\xde\xd6d-ʟ\xe4BS\xa3\xa8\xc8+vv/\xa0\xc5-\xbe\xf4\xfc-^\x9c\xcd\xd1b\xe8\xd3\vpDFZpoex\xf8\xde-\xae\xdcw\x9e\xa2,x饗\xb9yc\x1f)\x02K+\xa9\x9d\xa0\x10=\a'Mb\x90ܡ\x16\xd4\xf6\x83|\x9f\x14\xe4Zc}\x0fB\xb0\xac[&\x93qb\xba\x87\xb4\xbcFe\xc9\xc9|>\xc4S\x01\xb0\x05\xbc\v\xb8^\x94\x92\xb6\ttm /\xa4\x8c1ƾ{\xfd#\x19\xfeH\r\xaa\xe5`\xd1\x18\xf8\aƘ$\xc94\xf0\u058c\xd6\x18\x95\xe6\xce\xdb.\x9d\xf9q6їB\xbd\x1f\xa6tc\x8c\t@\x1cD\x01\xa4\x84\xa6w<\xfd\xd2m\xae\x1f/\xb9\xbdh\xd1\"\xb03\x9d\xa4\xccK\xbff\x10`(拲B\bť\x9d\x92\xe3\xae\xe3\xa8\xef8\xb1='}\xc7\xdc\xf6(\x11\xb9\xbcW\xf0\x96G.S\x96)\xe0Ve\xd2\x1f\x99\xf7\x92\x85K1\xeet\xb6d\xd9Z\xce:\x19\xe9\xfd\x92 \u007f\x00!ɵZ\xa3\xce~\xd0\a^\x91+RZ,i\xba\xf6N\xf6\xc4\x18x_Q\xa6K\xbc\xfaIR\xed\x8a_W\xc7p\x94\x8b;\xff\xfd.!\xc5w\x89\x15d.V\x19I*\xe8\xda6\x1ds\xb7\x02\x18W\xc22\xab\xc1\xd8\xd5.I\xaa?g\x83\xa3JJ\x0e\xe75\xb7\x8ef)\x9d\x8c\x06\xa3$M\xe3h\x1aK\x88\xaf\x99r\x14\xa0\xb5AH\xc5}\xdbc\xca\xdc\xd0G\x954\u007f\x84@F\xc1V\x11\xb9\xe7\x9es\xaf9\x94R`=\xbc\xb4\x14\xd8 \x11\xbe\xe7\xf6\xac\x1b\xd0}\xb1\x16\xb4\\\xddڮ\x1b4\x1b\xb3\xb5\xbcF\x14\x92z\x98\x93I\xf3\xedɐ\xdd\xe0-\xee\xb8\xfd\xcc0\xdev/\xf0XQ\xcao\x15\x82\x8b}\x17\xef4\xd0\x1f\xcd +7\x05\x90)~<\xc6\xf8\xbf\x96e\xb1g{;\xe0Ng\x8b\")Q\x0f-\\\xb9Ү\x1atB\xe2\x99\xf4E\f\x11-%f8VU\xcbt\xb4\x90\x91\x8a\xa3Y\x83w\x16\x17\xe1\xc5\xfdC\xe6M\xc3\xf5\xfdS\xea\xa6\x1b2(ΰ\xae\x81\x9b\xb5l-\xb9\x94\xa8\xb8\x1a\xe5\a\xad\x04\x93B\x93\r\x14\xd4\xd5\xf9\x88\xc7\xc7\xc7\\_\x06\xae/@\x89\xc8\xf1\xc9\f\xebÙ\xee\x80T\xeb\"/\x01\xa9M\"\xfbU\x15\xe5\xb0KB\x88\x9cΖIEN%Y\x8e\x10\xe3\xebeW\xbbì\xe1\xfb\x81\x0f\x03\x9f\x04\xde_\x94\xf2\u07b6\t\xafk\x14\xf9\x875Ƹ\x90\xbft\xcf\xde\xe6?\x17\x88ǲ,\x1bN.\b+m\x8fA\xd8E\xaek\x8d\x15\xe8\xb6&\x02\f\x14\xa1\x18\xc3@HH\x87z\x95Y\x86\x91\xe9hT\xad\x12\x164[\xd4H<O\xbd|\xc4Ǿ\xf8<\x87\xa7\v\xae\xef\x9f2_\xb4X\x1f\xd6H\xf2\xf1\xf1m\xac\xb5dR\x90)y\xc7\xe83\x14J0\xc9\x15}߭U\xa8\x0f\x0f\x8f8Y\xb4|\xfa \x90\x89@}zʼ\xb1\x89\xf18@\x9bB\xaa\xb3Yx\xa0\xed\xfb\xb5\xb2\xe9\xa4*\u05ff\xf71QhWq\x04~\xdfÓG\xc0[IǍ\x03\xbc\r\xf8\x1f\x00^\x8f\x80\xad\xff01c{\x9c\xff7\x8f\\\xb9\xf4oK\x91ƀW,Eۻ\x01\xc3a\xe8\x11\x9c\x9d]\x9bg\xd9\xfa\xd8\a\xbf\x16\a\v\xf4}\x92\xd8\xc8t\xd2\xd6\xcar\x83\x1d\x0eR\t!\x11\x9ag\x8b\x96\xaa0\x84(\xf9賯\xe0\x02\xbc\xeb\x91\xfbX6\x96\xaa\xcc(r\x8dp-\x8b\x93Cbt\x044\x0eA\x0f\x141\xbd\u007fi@\x11\xb8z\xed\x06\xb7\xf6\x0fa\x90p\xfdԍ\x1a끺f^\xd7+@\x19\x11\x12UU\x8a\x88\xcc\f\xbeM\xb1\xce:O\xdb\xf7\x8cTAY\x96\xe8\xd9b\xcd#\xd0R\xb1l\x1b\xacO\"\t\xe2u\xb4@\xd4p\xc8\xd8k\x82\xc6\x0f\x15\xa5\xfc\xe7\xc0\xff\x02\xb8a\xcbo\x03\x0f\xfd\x81Y\x96\x82\x9d\x8b{\xdbߥ\aQ\x98\x9d\xcd)/\xdf<X\xa3\x9cb\x10u\xb1\xd6\r\n?+\x18>\xad\xaa\xc4}u\xeb\x0fl\xbd'\ar\x9dvȸ,P\xa3\x94\xb9t\x83\xce\uecb3\xdc>^\xb0\xbb%\x10\xa2\xe4\x13_\xbe\xce\xf1b\xc9\x03\xe7w؞\x8e\xd1J1\x9b\x9fP\x88\x1e\xeb\x02\xd7O:f\x9d[\xc9i\rch\x82\xe36P\xb9\x1eQ\xf7\x1c\xb5\x9eg\x8f\"GmD\xb9\x9eӺF\xa9!\xa3\b\x11\xbbB\x1b\xa2O\xb3##\xcdr\xb9$\x84\xc0\xa2i\xa8\x8a\x1c\xa9\xd2A0\xf3\xb6]\x1f\xc5Td\x19ZJf\xf5\x92Չ\xa5wb\x16>\xfe\xbe\xa3\x84\u007fc\xb8\xff\xd1\xd2ު\x94{Wo\xeeo/\xeb\t\xf7]\xdcc:\x1e\xb19\xae\xd9?:A\f9z\x1a\xaeOD\xb3*\xcf\b\xf1\xac\xcau\xde\x0f\xa4\xeb3ʦ\x1f\xb2\x962\xcb\x18\x15\x9aIYrn:\xe2\xf6\xf1\x8c\xd6:\n\xa3X4\x1d''\v\xaa\x91e:\x19\xf3\xfc\x8dc\xaeߞS\xe6\x9aL\n:k\x91\x84D\xb8\xf3\x91ޟI4\x81ซ|\xf6\xc0\xa2\x87xp\xd2\t\xfa\x00\x9a@=?E\x10\xb8\xb81\xa1q\x96Y\xdd\xd1{\xc10k\x83\x94\x92<\xcb\x11B0\x9f\xcfY\xd4\r;\x1b\xa9e\x9c\xe7\x19\xf3\xa6Y\xfbF;\xa8W,\x9a\xfa\x8e#\xfdXǡ?\xe8\x94\xeb\u05f6\x9a\xd4\u05ca\x1f鸌\xf8\x931ƿV\xb7ݺ\x83\xb61\xae8]\xd4+\x15\xe7\x81\\8\xc0\xcfZ'68\xe0\x82\xa7\xed\xfa\xaf:\x05-\x8aȴ0lM*\n\xad9\xb7=asR\xb25\x19\xe1\xad%ךLI\xa4\x88\xb4MG۴k\x85\xb8\xc6\x06Z\xeb\x06\xd6#k\x19\rA\x12\xf9w\xa4\v\xdf9A\xed\x04\x8b\x1ej;\x10ւ\xa7>9F\xc4\xc8\xf6\xb8\xe2[\x1f{\x90\xc5b\xc1\xb2\xebq\b\x1c\xa0\x94&\xcb˵\xbeV۶\xeb88.K|\b\xd4\xc3Ip\x9b\xd3\x11m\u05ed\xc1E\x80\xc9d\x83ݝ\xf3L\xc6\x1bXo\xf1C\xa1\xb8\x92/\f_\x1bV\t\xafk\x90I\xa9X\xb4\x81\x8d\x91\xfe\xbe\xfb/\xee\xfd\xf2\xbd\x17v\xe9:\xcb\xf1l\x81Q\x9a\"\xcf؞N8],q\xd6SV\x05}o\xf11\f\x8ap\x82\xb6M\xbd\xe9ըAX\xad\x16!\x88\x01r%\xb9\xb4\x9dD\xf87\xc7#6\xc6\x05ۓ\x11\xd3*O\x8d)!0R\x92kI\xb0\x0e\xd7utM\x83Ɠ\x13\xe8]\xc4F\x92\xbceX\v\xf2\x12\x02h\x01\x92\xd4\xdc2ѡ\\\x87o\x96\xf4\xcb\x1a#\x04\xd3\"\xe7}\xef|\x9c\x9dI\xceK7n\xd1ZO\xed\x02.B\x96\x17h}&\x06\xd04I\xa6\xaf\xe9:6\a\xb9\xc2\xe5\U0003bb4d1u\xd3\xe1\xbc#\xcf\vv\xb6\xcfQU\xe3\xf5s\xbdw\xf4}2^\x9e\xe7\x94eI\xdf\xf7\xaf\x8e1\xd2\f\x86\x8a\b!_\xdf }\x82\xd6)r\xf5\x89\xfb/\x9d/\x95R\xecnM\xe9\xadc\xff\xe8\x18%%\xe9\xdc\xf3\x02\xeb=]kɋ\f\xdb\xf5X\xef\xd7i\xe6ZS\xd7h\x8a2i\xc1\xeb,K3\x82\xde\xf3\xc0\xb9\r\xaa<cTdlo\x8c\xa9r\xcd\xf6Ƙ\xadI\x95\x14\x95\x92n\bU\xae)\xb4\xc2HA\xb4\x8e\xbe\xe9\xa0k\x11\xce\x12{K\xb4.͋8\x87t\x16a;D\x97\x1e\x13\xbb\x8e`\x1d\x1a(3Ť\xca\xf9\xb6\xb7=\xc2{\xbf\xe5Q\xae^\xbfʭ\xa3S\xe6\xadc\xe1\xc2p\xf4\xf7\xa0\xf8\xb0:\xc1\xda&\x16\xbc\x18\xa0\x93IU2\xaf\x9bt:uU2_6\x94e\xc9\xf6ֹ\x81\xa4w沜\xb3\xb4]2\xdex<&\xcb2\x9a!\x8d^\xddʲ\xc4\x18C\f\x02c\xf2\xf05c\x88\xd1z\xd3hͲi\xb8~\xeb6Mg\xf1>p\xfd\xd6mN\x175\xf7\xec\xedp\xcf\xee\x167\x8fN\x99/j\xb2<\xc3\xf6\x96\xb6K\x13MYY\x90\x95\xe5Z!t\xbd/\x9dg\xb9\xac\xa9۞\xbd\xcd1JB\x95\x19\xca\"#S\x8a\xadq\xc9\xee昫7ns\xe3\xf6\t\xa7\xa7s\xac\xd3x\x1f\xd2q\xad\xd1'\xd4\xd5'l\xc9\x0f\xb2P\xacg\x17\x05\x99I\xa2\x9a\xab9H\xa3%\xbb\x9bc\x1e\xb8t\x91\xf7}\xeb\xdbiێvY3oz<\x91>\x80\x1c\x84.뺦\xeb:\xa6\xd3)Ƙ\xf5\xaa^\xd6\r\xe767\x87\xd7\xd7CW\xd31\x9en|\xb5[\x8e1͠\xbc&\x96d&\xa3\xedڵ\xd1\xcc\xf0:Zk\x94\xd2_;\xa8w\xbd=x\xe1\xfa\xcd\xf3G\xa7\xf3T\x1dk\x83\xc9\x13\xe5\xa5\xee,/\\\xdf\xe7\xca\xc5s\x9c\xdf\xda\x18\x14\u007f\x92\x94^\x00\xcaш\xac,^\xf7u\xb3\xaa\xa0i\x1b^:8\xe1\x89\xcb\xe7\x91\xc0\xff_ٹ\xed8r\x94q\xfcW]U}\xb4=cof \x9b\xa0M\x02\x11 !P$.\x10\x17\x81\x1b\xb8\xe3!\xe0\r\xe0\x01x\x1bnx\x11\xb8\x8b\x84\xb8\t\xac\xa2h\xd1\x1e\xd8\x1d\x8f=>\xf4\xa9\xaa\xbb\xb8\xa8\xeev{mg\x83oF#k\xc6\xf2W]U\xdf\xe1\u007f\x88\xa4`\x1a\x87\x1e9\xaf$ד\x84۫\t\xab͎g/\xeex\xf9f\xc9z\x9bc\xad\xc59\x85\vu'\x89u\xf0\xa7\xea\xeb\x01١S\\\xeb\xa9\fa\xa4y|sœ\xf7o\xf8\xe1G\x1fs=\xc9\xf8z\xb5\xe6~\xbb\xa7\xb6>-\x95:\"\x8e\x93\xae\xa9h\xd8n\xb7\xac\xd7k\x92$\x19q\x85<@\xc2۵z\xbcrm,o\xb5\x11\x0eE^\x10\x8c\f\xcd\xfcO\x1dj\x8a\xb28\xb9\xec\xfb\x84@]\xbaC\xb6\x85\xe7f,\x1fv(\x9d \x94\x1e\x92\xe9^&ĵ-\xeb\xbc\xe2\xf6:#\xd4\xde\fL*\xc5t:A\xe9˖\xa6RJ\xe28\xe6ն\xa0\xaa\xfc\xc0\xa7\xb5\r\xd3$\"\x8et\xd7\xdf\xf2>\xd1\xf3I\xca\xf7n\x1fq\xb7\xda\xf0\xdf劗\xaf\xd7ܭ7\x14\xa5w\xf0\x1cK-\xf5(\x17\xad\x94\xaf'\xa4`\x12\x87<\xbe\xbd\xf6\v\xbcx\xc4|\x96\x11*EU\x96\xac\xf3\x8a\x16X\xd6\x10\xc6\a\xc5)\xef\r\x12S\x14\x05y\x9e\x0f\xc1\xf3\xc8\x13\x0fp\xa8\xeb\x06纖\v\xe7;콈'\xdd0\xcb97\xf8k\x9d\x8b\x89R\x17vȶ\xc7]\xc1#\xa9B\x84T\xa7Λ]_ꡰ\xb4\xe4dI\x82i!\x99dg\xf2\xf1\xd3W\x94&\x94e\xc5W/\x96\xfc\xfa;\xef\xb1\xcf\v&\x89&\x8d\xe3\xc1\x1e\xaf7\xb6\x17\x02泔O>\xb8aWTܯ\xb7<\u007f}\xcf\xddzKѵT\xa4\xf2-\x98\xab4\xe4\xbd+/'h[G\x14z\xe4\xa4\x03f\x93\x94H{\xa5Ѣ((jòjh\b\x88F\x1a\xe1\xce9\xd2ԋx\x96ey\xb4 \xb5\xb5\x04\x817\xa9\xdc\x17%\x02\xc1~\xb7#K\xa7'߹\xd7blFipO\xd58\xddM\x0e\x114\xa7\v\xd2\xef\x8ei\x12\xfcI\x04R\x05*|\a-\x01\xb6\x85\xe7sDI|P2x\xd7 F)\xe28\xe2\xdf\xcb-\x9f\x9b\x1a\x1d\x86\xd4U\xcd\xcd\xf5l\x98^\x89\x91m\xb7\x00D\xa8ɒ\x98\x9b\xf9\x8cO\x9f<\xeej\x10\x8bm\f\xad58[\xa3\x9c\xa7\xad\x89ΠŶ-EmA8\xe2(BK\x85ñ\xd9\xef\xa8m\xcbִH\xad\x8f\xfaWC\xea\x9fe\xd4\xf5q\xda\xde#N\x9aN\xf0S\bA^\xd4\x17\x8e,1\xa2\xec\x1d\u007fwk\xed[\xbbɳ\xb3\x14\xc0b1\xe7\xfe~5\xec\x8eI\x1c\xfcB\x04\xea\xcf*\x9e\xbc\xd3\xc8\xdduD\xc0\xd690\x06\xa5\xf5\xffQ\a9V\x85\xf5w\xc9G\xef\xf3f\xf9\xc0\x93\x0fn\x0f\"\x96\x83\xe2i\xc0A*\xee\x902:Z\x84\x13\x98\xba\xc1\nh\x83\xd0\xd3c\xf0R\xd8!\xbe]ҧۡRh)\xa9\x8d\xe3a\xb7go|\xaa\x1b\xf5\xaas\xa3\x1d2\xe6ɏ\x17\xa4\xef\xc9\x05A\x801~r\xa8\x02qvAE/\xde<Z\x10\xe7<\xd5\xce\x18st\xbf\xf4c\t\x05\f\x8b\xb1X\xcc\u007f\xd56\xf672h\u007f\xaf\xc3\xe8\xaaq\xbefh\xdbw\x0f\xebE\xa00UN\x18\xb5GR\xafg\x97\xa1m\xa9ʊ\xd66\b)\xf9\xe7\xf3;>\xfd\xf0\x86\xd5f\xcbf\xbbg>\x9b\xe0Ƃ\xfb\xc3\x116\xfc\x03Z[a\xeb\x1ak\xac/L\x91]\x87Yy>a\x87\x15\x13A\x03]\xa2\xd1\xfbX\xe5e\xc9v\x9f\xb35~Xf\x9b\x86H\x1c\x9e\xbd>P=\xcdm\x1c\xd0NW\x91ݾ\x18\x02\x1c\xc7\xe9\xd9#\xba\xcf\xf8Ν\x0e\xdf8\xc2],\xe6\xbf\x03\xfe\b\xfcL*\xb5\xc8&^ת'qZ\xd3P\\ؖc\xee\xa4\xc7`5\x17\x17D\b\x81\xa9k\xca\xdd\x1e\x15\x86\xa4\xb3)\b\xc1\xb3\xfb\rw\x0f{\xbe;\x9f\xf1\xf4\xd9+~\xfe\x93\x1f\x1c\xe8\t0\xa0\xc9G\xcdd\\\xebS`\xef\xa4 \x91\xb2\x97\x05\x97\x88\xde\x14\xb9m1\xc2 \x02\xafZ\x14*\x8dR\x8a\xa2,yؗ\xec\xea\x16\xd3\xf9\xa4G\x91E)u\xd4\xfa\xd8\xedv'\x01\x15\x1d\x99\xb32\xa6\x9b\x05\xb5\xd8\xc6\\\xa2\xd0\x10\x04j8\xe6\xbeՂ,\x16\xf3\xa7\xc0\xf7\xc7\xcc\xe3\xc6\xfa\x96H?\xd1\v#\x85\x0e%E^_vE\xee\x90\xeaͥc\xcb9\x8a\xbd\xef\xf7dW\xb3\xde\xd6\xc1\xe7\xf7N\xf1\xc5W\xaf\xf8\xedg\x136\x9b=\xf7\xeb-\x8b\xeb\xc9\xc0\xcajq\x9d\xa7\x88\xec\xccs\fޝ\xd6u\xc2\xcc\tQ\x14\x12\b?\xbd#\x104\x0e\xac1\xde\x02\xc368!\xd0J#\x03\xc9\xfd\xe6\x81uQ\xb13\xedp\xe7\xad\xd7k\xa2(\"\xcb2\xff\xe0t\x05a\x9f\xbe\xf6\xa3Y\xd7\xf5\xe7\xc6\xf1\xaa\xab\xfa\xec\x91ի\x10Uo)\x02}\xd3=\xab\x80ONp\xbb\x85g\vE\xb1:z\xba\xd3I\x84\xad\x1b\xaa\xcax\x90\xdaɱ%1\xb5!JGE`\xd3\xd2XCc,:\x8eP\x1d({\xfc\x8aӄ/\xfe\xb3\xe6\xa7\x1f\xef\xf9\xf0Q\xc0\xcb7+\xaegـ\xeb\xf2\xdeV\r\x91\xf6t\xb4\xb2\xa9qM\x83\x94\xbe\x98\nÐ(\xf2\x9d\x00\x02\xe1'\u007fu\x8b\x93\x10\t\x85\x11\u07be[+\xefN\xf6\xe2\xf5\x1d\xaf\xf7\x86\x06\x98\xcf\xe78\xe7\xd8\xedv\x94eIUU\xa4i:\x14\x83\xb2ӣ\xef\u007f\xaf\xea\xfah\x10\xe5\x1f\x90\xcb\xc0\x92#\x8a\xdd(\x96\xfd\x02\x9edf\u074c\xf7\xe4U\x165y^\x1f\x84\x82\xbb\x8cJ\x85\x924\x8bI\xb3\x8e-\xf5և7\xb6\xa1\xca\v\xac1\x94yN]\x14\b\x11\x10O2/\xa5z欕2\xc0%S\xfe\xfa\xf7\xa7<\x145\xaf\xeeVl\xf7\xe5Q&\xe7ё\x0e\xa1\x02D\xe0\xb5\x1b\xa5\xd4\xe80&\xd0\x1a\xa9\x15Rkt\x18\xa2\xa3\x90@K\x94\x8a\xd0:&\x8aR\xc2\xd0\x1bR\xee\xf2\x82\x17wK\x9eo+\x924\x1b\x024\x9dN\x89c\x8fH\xcc\xf3|\xd8\x1d\xbd\xc7\xd4!\xed=\r\xbe\xb7˳\x17\x8b\xc3\xfeo\xdf^\x943\xb1\x10\xaa\x1b\x90p2x\x17~\x00\xd5؆8\t\xe9\xadU\xfb\xa9\x9cW\x14\x8d\xc8\xf3ڣ\x12\xfb\xf1g\x10P\x15\x05\xa2\x14DI\x82\u03a2oт\x16\xa4\x89f\xb3\xa9\xf9\xcb\xdf\xfe\xc5\x1f>\xff1\xff\xf8\xf2k~\xf9ُ\xfc\xeep^\x85\xa7m\x1aT \bÐZ\x85\x9dʨ\xef\n\xf7\xf3}\xd1\xdb\x1e\x05\x87,FII\x8f\x8cx\xb3\\\xf1\xe5\xcb5\xbbVy\x13\xe5q\xca?\x9dv\xac\xdcf\bZ\x92$l6\x9bÓ}r\x12\xfbϭ\xaa\x924\xc9\xce\x06~\x9c\xb9\x9d{ot=\x99\xff\x01\xf58\x88\x18\x8e\x1f!\x0f\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_08_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14+(~\f\xac$\x00\x00 \x00IDATx\xda\xec\xbdyp]\xd7}\xe7\xf99\xe7\xdc\xf5\xed\xefa\a\b\x12\x04w\x91\x12\xb5o\x96\xedx\x91\x1dK\x967Y\xb1\xb3:IO:\xc9L\xaa\xb3դ\xbb2=\xd3\xe9\x99?R\x9d\xc4\xe9$e\xc7]\xedL\xdc\xddN\x97\xe3\xf6\x92xbɶbK\xb6l˖\xb5\x92\x92(\xee\x04\x01\x02Ď\xb7\xbf\xbb\x9e3\u007f\xdc\a\x88\xb2\xa3ĝ\x0e\x15ʝS\x85\x02\b\x02\x17\xf7\x9e\xef\xfdm\xdf\xdfr\xe0U\xba\xde\xfa\xd67}\xcf\xf7j\x03\x15\xfei]\xa1kt\xf8\xd5\t\x8ex\x95\xee\xf7\xb4\x10\xe2\xaaj\xb5\xf2\xb68\x8e\x0fu:\x1dlK\x9e\xb6-kF)\xf9\xec\xe8h\xedK'N\xcd\xf7\x00\xa6\xa7&8;3\xffOo\xe8\xdfg\xdd4Y\xf8\x9e\xefݹ\xb7⺖\xb8\x19\xf8\x1d\xe01\xa0\t$B\b-\x85Ѐ\x01\x8cc\xd9F\t\xa1m%u\xa5\x94\v\xb6M\f\xff?\xbbvN\x8el^\xc7S\xff$!\xdf\x17\x00\x8fϵ/\x05c\f\xd8\a\xbc\vxK\x98\xea\xfd\x9dP\x8bT\x1b,%\xb0\xa4@\b\x90B`K\x81c\t\x04\x82\x95v\xcc\xd9\xf5\x00!\x04\x18\x83\x01\x04\x02\xa5D\xaa0\xff\xaf-\xcd\xe7\xa5\x12\xcf6\x033\x030RrYj\x86\xff\x04\xc8\xdf\x02\xc6\xcd\xc0\x8f\x01\xaf\a\xaeݼ1!\x05\xaa\u007f\x87\xc6@\xac\r\xa96\x00\xa4\xda\x10\xa5\xd9G/\xd6\\lF\xdf\xf3XB\x80-A\n\xb0\xa4\xb8\xe0\xb9\xd6\x1f\x8b4\xfd\xfd\x95n\x1aN\xd6\ṋ\xb7\xff\t\x90K\xc1\xb8i\xb2P\x02\xfe\x04x\x1bP\x80l\xf3\xb4\x81F\x90\xd2\fR:Q\x8a6\x19\x00\xba\xff\xf6o\x02d\x00c\fڼ\xf4\xfa\x9e%\xa9\xe5l:QJ#H\x00P\x02<[\xeaR\xce=Wp\xbc7\x9fZޘ\x19(\xb8\xac\xb5\xc3\xffy\x01\xb9\x04\b\t\xbc\x1f\xf8\x18\xe0lnp\x98j\x16\x9a\x11\xeb\xdd\x04c\xbe\xff\a\x10\x02\x94\x14\x94=\x8b\xa1\xbc\x8dc\t6z)\xb3\x1b\xc1K~V\np\x95\xc0uԒ@\u07b3э\x1e\ap\x05X\x16t\xe2\xff\x89\x00\xb9\x04\f\x1b\xf8-\x01\xbfi\x800ѴC\xcdJ'\xa6\x15\xa6/\xf9\x1dK\nl%2\x8b 2\xf5%\x84A\x90\xd9\x13%\x05\x8e\x12\xf8\xb6$\xef(\x84\x80(ɮ\xb5\u070e\xbf\xe7Q\x05\x06\x83\x00\fJ\xd2\x14\x86_\xcdI\xfe[3\xa5\r\xb0\xbd\x94c\xb6\xd9\xfd\xc1\a\xe4\xbb\xecŧ\x85\xe0\xden\xacYhDt#M\x90譟\xb5\x95\xa0\xea[\x14=\x85\xabd\x1f\x90\xecF\xa5\x14\x99\r1\x99T\xd0w\xb1\xd8T_\xc0\xdcFH\xbd\xaf\xa62;\"\xb6T\x1c\x98\x97<\xba\x14\xa4\x02\xf3\xadj\xce\xf9\xed\xd5N\xf4\xc0\x95\xa0\xb2^\x11gp\xa1opo\x9a,|\x02\xb8\xaf\x15\xa6\xe2\xc4J@7\xd2$}\x03P\xcbYL\x0fxl+\xbb\x94}\x8b\x9c-3\xaf\n\x01\"\xdbJ\xfd\"n[\x00l.m\xa0\x19$\xactb\xfe&mg[\x92\xf1\xa2C/\xd1}\x9b#0\x18i\x04\xdbѼ\xa7\xea\xfb\xe7~\xfa\xbe\xbb\x9f=q\xf2\x14aj~0%\xe4\x96\xedE\xb41<>\xd7\xe6\xe6\xed\x85\u007f\x91j\xfe`\xbd\x1b\x8bs\xeba_\xa7\v\x8a\xaed\xb2\xe2\x92wTf\xb4\r\xa4}#\xfe\xdd7\xfarۤ\x81V\x90p\xa1\x11a\fH\tJ\b\x1c%)\xba\x92\xa2\xa7\xc8ۊF\x90rv\xad\x97\xb9ł\x97\xd8)[\n\xa4\xe0\xee05\x0f\x00\x94\xf26\xcd\u007f\x04\xa3r\xd9\x00\xb9u{\x91^\xa29\xb2\xd0\xe1\xe6\xc9\xc2\xfb\x13m\xfe\xd3\xf9\x8d\xd0]\xebf\xea$gK\xc6\xcb.\x039\x85\x01R\x9d\xbd\xe5fӓ2/\x0f\xc0w\xafT\x1b\x1aA\x8a\x92\x99q\xb7\xa5\xc0\xb5$R\xbe\x18\x97\x04\xb1\xe6\xdczH\x94\xea\xbfy\x1b\x04\bc\xc8\xe5\xec_\xeet\xe3?\xfa\x81\x92\x90[\xb6\x17I\x8c\xe1ɹ6\u05ce\xe7\u007f\xccV\xe2OϬ\x05\xeez\x1f\x8c\x92g\xb1k\xc0ŵ$\x89\x86v\x94\xd2\x0e\xd2L*D\xdfP[\x12ߑ\x19@\xdf\a8\x97>\xc8K,\x85\x8085\x9cY\v\b\x13\xfd\xf2\xbf/\x04BI\x84ё\xef\xa8\u007f\xdd\xeaƿ[\xf0$\x9d\xd0\xfcਬ\x1b'\v?,\x04\u007fq\xa1\x1ey\x9b\x81\xdbX\xc9a{ť\x19\xa4\xcc7CڡF\xbf\x8c\x8f+\xa4\xa0\xe4(\xaa9\x8b\x82\xa3\xb6\\\xdc\xff\x9eU\xef%\xccֿ7\u0590r\x13l\xf3\xe2F\xf4\xf5\x98\x92\xc2XJ\xbc1\x88\xf5Wo\xdc7\xc9\x13'\xe6^\xfd\x80\xdc8Y\xc8)!>\xbb\xd1M\xdezr\xb5\x87\xab$;j.9G\xb2Ԋ\xbf+\xb2\xfe\xfeV\xdeQ\xe4\x1cI\xceV\x99Η\x99k,Efa\xb4\x01m\f\x89\xceT\xd4j'~\x89\a\xf7\xdd\x12A?\xb0\xfc\x9b6\xa2Zp\x9f\xdb16t\xfbӧ.\xb4\xb6U\x1c.ԣW\x04\x10\xebr\x11\x84Bpw\xaa\xcd[f\xea\x01yG\xb1\xb3\xe6\xe2(\xc1j'\xa4\xdeM_\xfe\x86$\xf86\xb8*\v䔀\xc4@\x98@\x90\xa4\xac\xb4S \x06\xb2\x18\xc5\xea\x1b\xf0ͨ=5\x90\\B\xb1\xbc\xdc2\u007fK\xe4\x99yp\xeaP\u07b5?\x01\xbc\xfdB=b[\xc5\xe6B=~uJH?\x12_\x98oD#B\xc0h\xc9A\x01\xeb݈\xd9zL\xf4]n\xe5XA0\x987\x14,\xb0\xad\xec\xed\x95B\"\xa4@\xf6\xefR\x1b0ڠ\x8d\xa6\x19\x18\x16;\xb0\xd8\xe6\xfb\x8a\xe8\xff6\x0f\xed\xe5l\x90\xefڼ\xf6\xf0\x1e3;7\xf7[\xc7/\xb6\xfe\xef\x8a\x03\xaf\x84\x90X\x97麿\x91\x1a32R\xb2\xb1\xa5 \x8c5\x17\xdb\x11\x8b\xed\xa4\xcfĂk\t\xa6\al\xf6\fY\x14s\x0e\x85\xbcO\xb9\x98#N4B\x80cI@\x10\xc61\x1b\xf5\x0e\xcdN@\xa7\x17\x91j\xc9p\x11\x86\n\x9a=\xb5\x94\xb9&,\xb4 N\xe1o\x13\x8a\xbf\v\x14\x83@\t\x816\x1a\x01\\\xbbg\x12c\x8cp\x1c\xf7\x17r\xb4\xfe\xaa\x1e\xf1\xf4\xab*0\xdc;h\xb3\xd6\xd5\\;\x9e\xbfII\xf1Ic@\x02\x8d f\xb6\x1e\xb2\xd6ә[*`\xbc$\xd9?\xea\xb2{\xbc@\xbeP@X\x16\xdd(\xa5\x1bD\x19Ck[\xe4r\x1e\x13#\x15\x0e\xef\x9b\xe4\xf0\x81mL\x8eU)\xe5}\x84\x84f;\x002\xb7\xb6\xe2\t\x86\xf3\x19\xab\x9b\x1a\bӿ\xff3\xb8\x8e\xc5P\xa5\x88\xe7ڌ\x0e\x941Z\x13\x87Q\xb1\xdb\xeb\f[\x82\xcfb\xd0\xe9\xabIeIp\xae\x9d\xc8?\xa4\xa4xM\x9c\xa6\xacwc.\xb6\x12\xe2\xb4\x1f\xac\x01՜$L\f\xba\xaf\x1bRm\xb6\xa8v\x01\xf8\x96\xa1\xe6\xc3`^\xe2{6\xa5\x82ρ\xe9Qn:\xb8\x9d\x9ck\xd3\xe9E\xcc^\\\xe7\xc1o\x1e#\x8a\x13\x84\x10\x18\x9dyK\xddXӉ\f\x8bm\xc3b\xfb\xbf\u007f#\fp\xcd\xceQ\x86\x06*\xa05sss\xc4QH\x92\x1a6z\xe6\x8d픇_\x15\x12r\xf5\xa8\xc7r;a\xff\xb0\xfb\xcfm%~^k#\xd6:\x11\x17[)\x89\xce\xf8)\x99q\x84\x04\x89!\u0590\xf4?2j=S9\x06I\xa4\x05\xad\xd8b\xa9\r\xdd0%\x8d\x03\xceέ\xf2\x8dg\xce\xd2\ue18c\r\x94\x18\x1f\xaep\xfd\xc1\xedH!\xa87\xbahm\x90B\xe0X\x92\xbc#\x19)H\xc6K\x06\x05\x04I\xf6w\xfe\x8e\x17i\x8b\xa2\x1f\xc9\tJ\xd5\x1a\xb333\x84A\x00\x06z)\x80\xb8*H\xf9\xe8\xab¨_5\xec\x90hj\xbe\xa3\xbe&\x10\x876\xba!\x17[)Q\n%On%\x93^$\xf92\xe6uSJ\xa4\x14\b!\xbfG\xcbk\r\xae\x05\x93eC\xd11\x98>\x80\xd3۪\\\xb3g\x9c\xe9m\x83\xd8Jqvn\x99s\x17V\xe9\x06\x11a\x14\x13D\tI\x9c\x02\x06)\f\xbd\x18:\xb1\xa1\x13A;\x82Ndh\x85\x9aM\x0er3\a\xe3H\xf8\xa1\x03\xa3\xa4~\x95\xd9sg\x89\xa2̊G\xfdD\xf1Z\xd7\x1c\x88\xe0xтVr\x85\xab\xack\xc7s\xb7\v\xc17뽈\xf9FJ\x94\x1aj\xbe\xc4R\xb0\xde\xd5}\x8a\x03\n\xaeķ\xa0\xde\xd3D\xba\x1fGH\xd1O4\x99-\xafI\x88\xcc\xdb2&۰\x92'\x18\xf05U\xaf\x9f=L\xc0w\x05#\x03%j\xe5<9\xdf\xee\xd3\xf2\x12! \x8c\x126\x9a\x1dV\xd6\xdb\x04q\xcaz\xd7\xd0\b\f\x9d\xc8\xd0\f\xcd\xdf(5W\x0f\bƧv\xb1\xb2\xd1dcm\r\xddg3\xbbI\xe6\x867\xbb桦\xe6M\xaf\n/+I\xf5\xbb\xb51,\xb620r\x8e\xa0\xe4\n\x16\xdb)Bdo\xfbPAR\xf5-.\xd4\x13b\r\x96\x94H\tZ\x1b\n\xbe\xcb\xe4P\x85Z)\x8f\xa3,\xb41\xf4\xa2\x88f'`q\xa3I\xbdӣ\x1dI\x96:0\x943\fx\x19\x80\xf3\xcb\r.,7\xb6\x92TJJ\x04\x02\r4\x02\xc3ZG\xb3\xde3\xc4IFB\n`ԇ\x9a+P\x12,\x95\xc50\xddH3Q\xf6Xk\xf5X[Y\xd9z[C\r+=C\xc5\x15\xe4}u\xad\x88ґF\xcc\xd2\x15\x0f\x88\x10\xe2εNB/Ό\xf4\x80/\t\xd3l\x13\xa4\x14\x8c\x15\x15c%\x97\xe7\x97\x02:\xb1\xce6O\t\xf2\x9e\xc3\xc1\x1dcL\f\x94HӔ\xf5z\v\x92.y;\xa37\xc89\xe4\xdcA\xea\xed.\xb3+\x1b\x04\xb1a\xb6!\x98m\b\x8an\x16H\x8a>\xfd\x1e\xa7\x82(̀\x8a\x12M\xda\xf7\xf4l%(\xba\x82\xa1\xbc\xc5\x1bv\xfa\xf8\xae\x85m[\b\x01k\x8d\x1e+\x1bmR_\xd23\x0eI\x1c\x811t\x12A\x90f6\x0f\xa0\x19\x19,i\x9c\xb1\xbc\xd8ߨ\x9b+\x17\x90\x9dUŹ\x8d\x940I\x0fnd֏\xb2'q\x14\xb4\"\x8d\xa3\x04\xa3\x05\xc5\xd4P\x81#sm\xdaa\x8a\x14\xe09\x16\xfb\xb6\x8d0X\xca㋐\xb0>\xcfF\xbb\xdb\xcf\xf8i\x96\x9a1F\x1b\xf2\xaeĳ\x04\xbe\xad\x18\xb0%\xbe+\xb1-\xc9|3B\xa7\x99\xedq$\x8c\xb9\x06i\fa\x9a\xe5\x05A!\xa5 \x9f\xb7\x19\xaa\xe6\x18\xadx\x8c\x94\x1cl%I\x8c\xc1h\rBP,\xf8\xb4\xba\x11g\xd6%]m\xb0\xec\x8c\xf7r\x14\xac\x06\xe6%\xf9\x96Vh\xeca\xc7\xda\t\xf1\u05eeX@\xcemd t\xa2ԊR\x83\x92P\xf1\x04Q\n\x12\xc1PNr\xf5d\x95#s\rV:\tR\b\xf2\x9e\xcd\xc1\xa91\x16\xd6Z$\xbd:S\x15\xc9ѥ\x90\x8dvB\xd1U\xec\x1e\xf2\xb9eG\x99j\xce\x06!\x88\x12\xc3\x13g֙\xda^d\xe7p\x9e\xbf:\xbaDޑ\xf8\x96$/\f\x87\n\x86AG`)\x85\x92\n۱\xb0]\x1b'g\x93+\xe7pr\x1eB\n\x8c\x11\xd9g\xad\x89\xd3\xccRwz\x11傋X\x8fh\x861N\xacAd\x06\xde\xea\xd36[j\xd9\x18;&\xdd\xf1\xaa\xb0!\xdd\u061c\x00\xf6\x95\\\x89kK\x82X3\x90Sܺ{\x90\xc7ϭ3\xb7\x11!\xc8tv\xd1\xf78zv\x9e\x83#.\xabݔ/\x9f\x8c\xb9eG\x99\x9fy\xed\b\x13\xd5\x1cqj8\xb1\xd0\xe0\xb9\xf3\r.\xacu\x88\x92\x947\\=\xc6\xd4p\x9e\x8f>2C\x94\xa4\x94\x1cI\xc9hn\xa9(\xcay\x9b\x81r\x0e?\xef\xe2\xe6]\xa4\xe7\x90*\x89\x90\n\xdbRX\x96B\b\x99qTZ\xa3\x81(N\x88\x92\x04\x81\xa0\\\xf0\xb0TL+\x8a\x01͐'\xe8&\x90~/\xc7%\x1a\xb1v^\x15\x80l\xf4\xf4׀}C\x05\x1b%\r\xbe\xad\xb8f{\x95\xd3KMV\xdbq߈g9\xf1\xe5z\x93m\x15\x97\x13\xcb!\xbbj\x1e?\xf5\x96I&\a|\xeaݘ\xaf\x1f\xbb\xc8\xd33\x1bD\x89F \xa9\x16\x1cn\xdd7D\xa45\u007f\xf0ק!\xd5L\x16\x14Ӿd\u007f\xd9\xc1\xf6\x1dj\xd5\x02\xb9R\x0e\xe1:HKa;6\xbek\xe3\xbb\x0eVF\x8ee\xee\xa4ɼ\xa6$Ii\x87\x11\x8dv\x80\xd6\x06ױ(\xfb\x12\x81!1}7\xb7\xef\x0e\v)H\xd2\x17\x19\u1ca7X\xee\xa6W> \xc0\xb4\x14P\xf6m,\tc\x95\x1cJ\bV[!\x8d \xe3\x87Rc\x90\x02l%\x11Fp\xdfu\xa3\\5Q\"њ\xe7\xe7\x9a,l\xf4\xe8E)\xc3%\x9f\xbck1\\\xf1餆\a\x8f\xaf2\xbb\xdcb\xba\x90\x810\xe1+*9\x87\\\xc9'W\xca\xe3\xe6]ܜ\x87\xef{x\x9e\x8b\xe7\xf98\xae\x87\xb2],\xdbΤ#M\x88\x836:\x8e\x88\x93\x18m4\xed>;\xe08\x169W\xa2\xa4 N\f\x89\x16X\xc2`[\x92\xa2o\xb1\u058c\xb3\x9c\x8d\x00\x94Ԑr\u05cd\x83<\xf0\xc4\xea\x15\r\xc8\u007f+\xb8\xf2\xcdeߢ\xe0Z\f\x14\x1cN-6iE\x9a\xbeZFJ\x81B0\x91\xb7\xd99\xe0sn\xa9\xcd\x13g\xd6\xd1\xdaP-8\x8cV}v\x8e\x96\xf0]\x8b\x85z\xc0\xd7\xcfn\x90t\x03\xa6=\xc3\x1d\xdb\x1d\xca9\a϶\xa8\x94\xf3\x94\x06\vع\f\x80\\\xce\xc5u\\\x1c\xcf\xc7-V\xf1\x8a\x15\x94\xb2\x10\b\x84RY\x10\x1aGX\xb6E\xd2ic\xdb\ti\x92\xe0Z\x16\x1d\x01\x8eeQ\xc9[\xf8\x96\xa2\x97h\x12\r\x96\x02\xa5\x04ՂE\xbb\x97Ћ\fB\b\xa1\x95\x1c7\xc6l\xe5S\xaed@\xbe\xee\xdb\x12\xc7R8\x96\xcaTS+`\xa3g\xb6lG\xd5ST\x1dE5\xefPλl\x1b\xccQ\xcd;\xf4\x92\x94\xe5z\xc0\x85\x95\x16\xa7.\xacciͨ\v\xaf\xf1\x14\xb5\x8a\x8d\xe7\xbb؎M>\xe70<P\xa6P-`\xfb\x1e\x8e\xef\xe0x>^\xa1\x82_\xa8\xe0\x14j\xd8~\x0e\xcb\xf1\xb0l\x8f\xb0\xdb \x0e\xba\b\xa5HU\x80\x91\x19\xefE\xdcòl\n\xbeC\xa3\x9bٙ\xa1\xb2\x8bo\x05\x18\x03\x1a\x816\x06[\n<[\xe2ؒ^\xa41\x06<Ǿ\xe6\xdeۇG\x81\xc5+\x1d\x90\x8e\xea\xbf5J\t\xbaQ\xc2R3&N25uh8\xcfՓe*E\x97Dkf\x96;<~j\x85\xa0\x1b\xe0\xa2)[\x82\x11۰\xab \xf1\x1d\v\xdfs\xc8\xe7<|ߥX\xf4)\x95r\x14\n.\x96kc\xe7K\xf8\x95\x1a~\xa9\x86_\xa8\xe2\x16\xca(\xc7A\b\x95\x81\xe1\xfa\bi\xe1\x16\xabD\xdd\x16q\xd4#\xe9\xf5\x93'\xb9\x14\xd3N\x10B\xe29\x169ϡ\x1b\x84\x94\xf3\x0e%O\"ڙ\x8b\x9b\x98Lņ\x97\xb8Y\xc6\x18\x16\xd6\x02\u007ff\xa9\xeb\\\xf16\xc4UhKҕ\x82\x9c\xad\x04/,4\t\xfa\xd1\xf1k\xa6*ܶg\x90\xa7f\xd6y|f\x03\x99\xc4\xec\xf0`\x8f#\xf0K\x82\xd4H\x1c%\xf1]\x9bZ\xa5\xc0\xd0P\x91B\xde\xc7r\x14\xaecc;6\xcaR\b\xc7%W\xa9Q\x1c\x9c W\x19\xc2\xf1\v(\xdb\xc9\n\x14\x00\xa4B9.BYd\xa9-\x83\xed\xe7\x11\xcaBI\v\x9d\xc6\x18\x93\xa2\xc2\x00˲\x88CA\xceu\xb0\x94BJ\xc5x\xc5\xe2\xe4\x9a\xc0\x18M\xa8\x05aj\b\xe3t\x8bΑB\x90\xf7\x95\xdf\\M\xae|@\x06s2\xb6\x15\x8b\x896\xd3\x02\xc1r3$ц\x9c\xa3\xb8\xe7\xfa\t>\xfe\xf5\xb3D\xb1f\x97o\x98\xca\xf7려\xc0um\xaa\xa5\x1c\x95R\x81J\xb5@\xb1\xe8\xa1,\x89\xb0\x14Ҷ\x10R\"-\x1b'_\xa0P\x19\xa580\x8c[\xac`\xd9>B\xaa>\x18\x12,\ve;\xc8M\x80\fY\xb2I*\x84\x04\xa1\x14ʲ\x89\x85ʨ~\x95\x85\xf8J\x8a> \x92}\xe3y\x1e>\xd5&\x12P\xca)\x94\x86v7ŵ3\xc9/\xe6,l)\xa4e\t\x91&\xe6\xca\x06\xc4R\x84\x18=o\x8c\x99\x8eSM\x98h\xa2\x14n\xdfY\xe1\v\xcf\xcc\xe3h\xcdkjP\x10\xa0l\x87b1\xc7\xc0@\x89j9\x8f\xeb\xd9x\x9eB)\tH\x84k\xa3l\vi۸~\x81\\e\x90Be\x04\xaf<\x80\xe5\x15\x90\x96\x8d\x14\n!-\xa4\xe5 \x94B*\x85\x14Y\x9c\x91m\x95Fh\x8d\xc1`R\xdd\a\xd6\xc9>\xdb.b\xab\xeaD\xa0\x94ı-\xaa9\x1bυ^\"\xf0<\x85\t5A\x98R\xc8e4ˎa\x8fV/ix\x8e\xec\x86Iz\x85\x1buC/5\x9c6\xf0\xdaf/\xde\xcaM\x17\\\xc5\xc2b\x9b\xd7\x0eZ\xb8iL\xb1T`|\xa2F\xb1\x98\xc3u,\x1c\xdb²$\u0092\xd9\xc6:6N\xae\x80W\xaa\x92+\r\x92+\x0f\xe2\xe6K(\xaf\xd0\a\xc3\xcbԗTHe\xf5\x13Ti\xbf\xceTg\xa5\xa7&3\xc0i\x12a҄M\xc2_(\v\xb4F\xa7\tʲ1B\xa0\xfb\xb5`\x9e\xe3\xd0\xed\x85\xec\x1f\xf5y~\xa9\x87c\t\xc2\x10,K\xb2\xb8\x11R\xf0-\xaa\x05\x9bN\x90\xb6\xcby+lt\xafp@\xce7t4Q\x14\x8fc\xf8\x99 N\xfb\xc1\x98av\xb5î\xa2E\x0eM\xa9Zb\xc7\xd40\x8ec\xe1\xb96\xae\x93\x95\x96H\xcfA(\x89\xe58\x94\x86')\x8f\xee\xc0\xcdU\xb0\x1c\x17\xa9,\xa4\xedb\xf9U\x94\xe3e H\xb6l\x84\xd6\t\x9b\x89U\xb3\x15O\v\x84\x94\x99\x14\xe8M\x1a\xdf \x95\x8dIc\x92\xa0K\x1a\xc5\x18\xa9\xfa\xb1Q_J,\xc5\xde\xe1<ǖ\xb36\x06\xdfS\x10\xa6(!\b\xa2\x94\x8b\xeb\x01\xda\x18۶\xa4\xba\xe2m\b@\x94\x98o$Z/(!\xc77s\x1a\xad^L\xa9\x9a\xa7\x90\x83\x9d;\x87\xf1|\x1b\xd7v\xb1\\\x85rm,\xd7\xc1r}\xca\xe3S\x94\x87&q\xfdr_\xefg\x1bjyE\xac\\\x05eY \xd4%\x89,\x93\xe5Յ@\x8b~\x13\x9b\x00#T\x16\x91\x1b\x8d\xe5\x15\xd1ID\xdcm`0H)\x90R\xa1\xe3\x1eI\x9a\x10\x05\xf1V\t+F\xe08\x0e\x83\x05\x8b\xe1\xa2M/J\xf1\x9c,XtlI7L\xe9F)\xbec\x89\x9cm\x03\xbd+\x1f\x90\x95\x1e\xcf\xd6;\xf13\x83Ew\\^\xe2*R\xaa\xb0}ܡX\xf4\xb1\x1c\x1b\xdbw\x91\x96\xc4)\x94\xa8L\xeefp\xf7!\x1c;\x87\xee\x05\x984{ە\xe3ᔆP\xb6\x9f\x05aB\xf6\xc1\xe8WIo\x06f\x12\x94T\x98,\xa3\x85Ib\x8c\x8e\xfb4\x89A)\ac\fQ\xbbN\xd8퀴\xfa\xea,&\x15\x1aat\x86\xad0XJ\xe1\xbb.\xb5\x9c\xe2\xd8B\x84g\xcb~\xbdq\xf6\xa7\x06rE~掃7\x1d\x1c\x1f\xdc\xf7\xa6\xdf\xf9\xcc\xd2\x15\x0f\b\xc0B\xbd\xfbŁ\x92{\x97\xea\xf7\a\x86q\x8a\xac\x0e16\xe9aH\xb0=\x17$Tw\xece\xec\x9a[(\x0e\x8ebҔ\xb8\x13\xa2\x1c\x17\xa3\r\xca\xf6p\xf3\x15\xa4\xed\xf7\x1bl\xc4w'_\xb6\fצ\x11\x97Be\xc5<\x96\v\xb8(iH\x826i\xd0\xc1\x16\x06\x99\xcb#\x88\xb1tDSZ\xc4Q\x8c\xd1 U&Y\x18H\xf4\x8b\xbd(B@\xb3\x97\xf6\xa3t\xcd[\x0f\xed\xe0\x9f\xbd\xee \x83E\u007f-J\xf4ƫBe\xddQs\xef\x9c\x1e\x1f\xfeW3\xcd:e\xdff\xb9\x93҈4Ҷ)LL\xd0[]\xc0`\xd8~\xc3\xeb\x18\xbf\xe6V\x8e=\u007f\x82\xe7\x1e\xfc&\x95R\x91[n\xb8\x8e|\xb9\x86\xb0s\xb8\xd8\be\x83R`\xc4K\x1bC0`\x04\x9a\x04\x1dG\x18\x9d\xa0\x8d\xc6v\n(\xcb\xc1萤\xb5\xc8W\x1e\xfa*\xdf~\xf2(\xab\xebu.,.362H\xa7\x1b\xe2:\x16\xbf\xf9\xb3o\xa7\xd3X\x87 D)\x89\xeb(zAVc\x1c\x1b\xe8\xc6\x1a\xdb\x124;\tIj\xf8\xf1\xdb\xf6\xf3\xbe\x9b\xf7\xe1ي(\xd1\xddԘ\xe8U\x01\xc83\xeb\xe1\xe3w^7\\\xdf7>:\xfe\x85'\x8e2\xe2Hf\xbb\x1aw`\x04g|\x0f\x8d\xb5\x15\xd4\xe8^>\xf9\x17\x8f\xf0\xc4\xff\xfe;4[\x01JA=\x04!\xe1_\xfe\xe2\a\xb8\xee\xc6\xeb(\x8em\xc3\xf3s\xc8\xc4\x03\xe9\xf4k\xa0u\xe6M\x19M\x9a\xc6\xc4a\x1b\x8cF*\x1b\x9d&t\xd6\xe7Y\x9e\x9b\xe5\xcb\x0f\u007f\x95\xbf\xfc\xf2c,\xactq\xb2t\nR\xc0\x99\xf3\x17\xe9;`\xfc\xca\xd22\xbf\xf8\xce[\x91J!\xb4Ƶl\xb4\xad\xe9E)\x896\x84\xa9!M3\xd9\xfc\xc9ۯ\xe2\x03w\x1c\xa0\xd1\tx\xee\xf42#\x83\xa5\xde\xf8P\xe9\xb2\x01\xf2\x0f\xe6-\xbck\xd7 G7\xba\x81\xd5k\xde\x01\\}Þ)\xd2n\x8f^\x18\x82\x9f\xe7\xaa\xfd{\xf8/\x1f\xfb\v\x1e~\xf0\xeb\xcc\xcf\xccdd\xa3m\x13\x1b\x89\x96`0<\xfc\xad#|\xfd[\xdf \f\xbal\x1f\x1b\x00\x12\x92\xb8K\x146\x89\x82&q\xd8&\x8e;h\x1d!\x8c&l7i-ϳv\xf6\x04_\xfa\xfc\x97\xf8\xfd?\xfd\x14_\xf9\xceI\x94\xd0Tr\x0eyϦRp(\xf86\x03\x95\"\x87\xf7M\xb3md\x80\xa5\x8d&\xe7\x17V\xd939\x80\xd1)RJ\x84\x10\xb4z!\xad \xe1\xc4r\x9b\x8dv\xc4\x1d{&\xf9\x95{n橓\xf3<\xf2\xd4y.l\x84\\\xb3{t\xc1\x12\xfa\xe3\x1f\u007f\xf4x\U000ca590\xd9\xc55\x00\xc289qja\x89r>\xc7\r{w\xb2\xdey\x1e?\xea1s\xe2$\x9df\x03\u05f5ȕ\xcb\xe4J%\x1a\xbd\x88\xc6F\x87\x8dV\a\x9df\xf9\x92ŵ\x80\xff\xfc\xf1\xcf\xf2\x9do?\xceO\xbd\xfbn\xa6\xa6&\xf1\xaa5,?\x8f\x10\x864\x8a\b\xdbm:+\xcbD\x9d&\v\xf3\x8b|\xfc\xc1GY\xad\xb7\xf0\x94\xe0ƩA<\xdbb\xb8R@(\x8bf\xd0#NB|\xdff\xa8b\xb3sr\x0fS#e\x16\x16/\xd2솔}\xd5\x0flU\xdf\xdb2$\xda0R\xca\xf3Ko\xbe\x96\x99z\x97Ǐ-2<2F\xa5\xe4\xe3\x88DΟ_\x92W\xbc\xcaz\xaac6\x8b\x1d>e\x8c\xf9\xad\xe3s\v\xbc\xf6\xd0>\x86\xcb%\xa4\x82\xc1\x89q\x94TT\xc7\xc7\xf1\xf2\x85\xacR\xbd\x13\x11\xc4\tJ\xd98\x8eO\xbb\xdbe\xdfd\x8d\xdbv\x0fq\xf4\xcc2\x1f\xfe\xd8'\xf9\xb7\xbf\xf2\xcf\xe85\x1a[q\x8d\xe9\x17]w\x9b\xeb4\x1bM\xee\xff\xe63\xe4\x05\xd4\x06\xcb \x04\xd3#U\xf6M\f\x92\x18C\x92\x1a\xe6[\x11\x9d(fay\x83\x85\x8b+\xac\xafodE\xdcB\xd3\xedv)\xf9Ŭ|He\x9d\xbeZkza\u008f\u07b4\x8f\xc2@\x89/}\xf9(#cc\xb8\x8e\v\xc2`\x92\xa4\xb7p\xee«Æ\xbcn\xd0\xe3\x91\xd5\xe0\xd8\x1d\x83\uedda\xbd\xe0\xb6\vk\x1b\x1c\xd8>\xce\xd1V\x97bm\x80}7\xdeH\xb3\xd5\"\f#\x1a\x9d\x90\v\x1b=\xf6\xec=H\xaeTB \xe8uڜ>{\x86g/\xd4y\xedՓ\\\\\xeb\xf2\x85\a\x1f\xe1Mo\xb8\x1d\xd7u\xfa\xbd\x81\x82TǤq\xc2\v\xa7g\xf1tJ\xb5\\$\x8c\x13\xa6G+\f\x96\xf3<\xbbP\xe7\xeb'/\x12\xfb%Z\xed\x80A_296\xc2\xe2\xd2*\x03QD\xce\xd1Ԫ\x1e\xbem\xa1\x84\xcc\xe8\x95>y\x18\xf4\x9bBw\x0eWx\xee\xdc\x12Ƹ8\xb6C\xaa5\xbee\x93D\xd1\xc2\xcc\xe9\xc5\xcb\xe6e]\x16\xd1K\x92\xf4_i\xadY\xa97(\xf8.\xc5|\x81\xd6\xda\x06\x02\x8dc[\x04\xb1\xa0\x9d:\xbc\xf5\x9d\xef\xa0\xe1\xd8<yq\x8do\x9f9\xc7C\xc7ΰ܍8\xb6\x18r\xe4\xfc\x1a\xa35\x1f\xd26\xdfy\xf2(Z뾮\x97\xe8(\xe2\xe2\xca\x1a3\x17\x96)\xe4|\f0V+\xe0\xbb.\x17\a\xf6\xe0\xdd\xf2&\x9eX\x0f9\u05cdI\x87\xc7\x19:t5W\xbd\xed\xad\x84\t\xd4\x03\xc3D-ǁ\x1dÌ\x0e\rR(V\xb6\xd8bm\f\xbd8%\xefX\f\rW9\xf2\xdcY\x1a\x1bM\x92$&\fBʾ\xa4\xddj\x9f\xf8h\x9d\xf0U!!\x8f\xacf\x94\x83\xd6\xe6Q\xd0Ϸz\xc1\xc1\xd3\xf3\x8b\xbc\xee\xbd\xf7\xb1}\xc76\xaa\xfa\x06N\x9e<ϣ'\x9f!\x88\x12n{\xe3\xeb(\x8c\x0e\xf3\xf1O\u007f\x11\xbfXe[\xb9\xc6\xd4P\x89\xf7\xddv#\x1f\xfa\xa3\x8f\xb0k\xa4\xc4\xd0@\x81\x8dV\x1d\x94Eid;q\x1c\x13\xf6\xda\xf4:\x01c\xb5\n\x95R\x9eN\x10R\xf0\f3\x17\x1b\\\xf5\xb6ÌOM\xf2k\x06\x1e\xfe\xca#Ln\x1f\xe35oz\x1d\xcf}\xf1+\xdc4\xe63R\xb2\x19\x19\x1f`۶)<\xcf'Nb¨G\x12\x85\xa4Z\x93$\x06[)\xdaq\xc2\xd2\xc2:\xedv\x16dV\x06j\fx&\x9c=\xb7r\x14\xe0\u07ffa7\xbf\xfa\xf0\xe9+\xd7˺t\x8d;B+\xa9\xe2m\x83\xb5{:q\xcc[\xdf\xf7.\n\x16\f\xa9\x18\xe2\x1e\xa2\xdb\xe4\xcc\xfc\n\xe5r\x99\x1bn\xbd\x89\xfa\xfa:\x17\x17\x17\x99\x18\xaa\xf0\xeb\xbf\xf4s\xac/\xcc\xd2<u\x92]S5\x0e\\w\x03\xd7]{\x80\xda\xd8\x14C\xbb\xaf\xa50<A\xd8X\xe7\u00993\f\xd6JL\xee\x18cx\xa8F\x9aj\xf2n̓\x0f?Ω\x13\xa7\xc9{\x1e\x87\xf6\xef!Y_\xe3\xfc\xb7\x1e嚪`\xac\x94P\xac\x15\x18\x1d\xdbF\xa1PB*\x85R\x16q\x18\x11\x84=V\xea]\x96Z=V:\t\xd7\xee\xdeΓ\x8f\x1dg\xdb\xe46\x8a\xe5\x12\x8e\x0e\x19\xf1\x92\x8d\xc5\v\x8b\xbf\xfeե^\xe7K3\xebW\xbe\x84l\xae\xc7[\x9a\xddD_9\xb8Úٿsr\xca\xc9\xfb\xa4\x9d&J*Ɔ\a\xd97^dOm?sG\xbf\xce\xd3Kg\xb8il\x82;\uee8d8\xe8\xf1\x8d\x8f\xfd\aTc\x85\xbbn\x9df\xf2\x86\x1b\xd8u\xe8\x1a6.\x9c\x05\f\x96\xe3\x02\x02'W\xc0\xb6,*\xd5\x12ՑalǥX-\xb24ksk\xb1\x8d  X;N\xba!\xb9\xbeda\x17=\xb4\x0e(TF\xa8\x94kx\xb9\x1cʲQ\xca\"IB\xa4\x14\xe8tӒ\x80N5K\xcb\x1b\x84A\x8c1\x86\x8d\x95Un\xdbUbui\xedӿudm\xf9\u07ffa\x17\xbf\xfa\xf0\x99W\x0f \x00\xa7a\xee.\xd7}\xda\x17Lя\x80c\xa3)W\xcaL\xef\xde\xcd\xf1cǙ\x18̓\x97\x1d\xac\x95S\xf4\xe6\x13\\\tS\xbe\xc7\xe0\xc4\x14c;\xa7\x18ܵ\x97$I0\x18t\x92П\xbe\x80\xe5\xe6pl\x9b|!Oa`\x10?_\xa6<<N\xce\xf7Y\xbbx\x91V\xb3\x85m\xc5h\xadQB\x90+\xd6(\x14\xf3\xe4\xf29l\xdb\xc3\xf2},\xdb!\x0ez\x90d\xd7̈\xfb~\x15\x8aT̝_Ʋ,VWV\xd9?V\x80\xb0\xd3\xd5R\xfe\x06\x97yY\x97\xf1\xdaq'\fO\b\x83\x89\x9b-!\x94\xa4\x9b\xa48v\xca\xd4\xce)ry\x9f\xb9\xf3s\xb4Z\x1d\x824\xc5ɻ\x14\x8bE\x06F\x06\x18\x19\xc92\x83\xb6\xe7\x92FQ\xd6[\x98&hc\xb2\xc8[)lGa{.N\xbeH\xa1:\x80R\x16\xb5\xb1q\x86\x97\x16h,_ \bB\x8cN\x90\xcaƲ\x1dl\xcf\xc1r\xf28\x9e\x87\xb4m\xc2N\x9b\xa8\xdb\xc9\xc8O!H\xfb\xbe\x96\xad$^\x9a\xb0\xba\xd8 MS\xf2\x8edǠ\x8f@\xff\xe8\xaf=t\xba\r\\6\xe9\xb8l\x80\xdc\\R|\xa7\x992\xbf\xba\xf1\xc9\xed\x03՟k5\x9b\x03\xc5\xc1\x01z\x89a\xa0X\xc0r}\xa6\x8ae&\xb7O\x11\xf4\xba\xd4\xebu\xa4\x14\x14+U\xbc|\x1e/_\xc4)\x940\x06\x82f\x834\xceT\x87\x8e\x02,\xd7\xefsW\x0e\xb6\xefc\xbb\x1eʲ3\x1a\x04(\x8d\xef\xa0<>\x95\xcdFI\xe2\x8cr1\x9b$qV\x1f\x16\x06=:\x8d\xb5,\x97\xc2fCi\xdf\xedԆ\xaaR\xd4\xd7[\x94\x8b>7\xef,c\v\xfd'\xc6\xf0\xc0\xe5TU\x97\x15\x90\xef4S~\xee\xbai>\xfa\xf4\xd9gV7\x9e\xfe\u05edᑏ\xbc\xeb'\xee#D\x81\x90\xe4˃x\xb9<&\xd5\xc4a\x97\x81m\xd9\xdbiy>\x96\xe5 \xa5\"5)A\xafM\xd0id\x1b)\x04\xba\x9f\xfds\xf3yl\xc7\xc2\xc9\x17\x10\xfd\\\xd1f\xe3\xff\x8b)\xdc>e+\xfa\x03\a\x8c!՚8\x8eI\u0080$̺\xa3\xb4\xd6D\x06R\x93\xb5%\xa4H\xa2H3Xt\xb9}\xef\x10+a\xb0\xf0\xf0R\xf7\x8f\xbe|z=\xe1\x15X\x97Me=y\xf2\x1c\x00Ot\xf9\x0f\xf9S\xa7>r\x9f\xe3\x12\xa0HR\x93\xe5<\x84\xc8\xdep?\x0f\xc2pI\xca\x0fc4\xba\xbfqQ\xb7\x85\xed\xe4\xfa\x85i\x19p\x8e\x9f\xa7T\xad\x12\xb4Z\b\x9d\xbe4?r\xe9T\x99\xad\x12\xd2\xcc\\'q\x821\x1aL\xe6 D\xdd6Q\x92d\xc3n\xfa\xd2c\x80Z\xcd喡2\x17\x83\x80\xbf\x9c][m\x85\xf1+6\xd6\xf4\xb2\x01\xb2I\xa5L\x8f\xfa\xa3\xab\xeb묬\xad\xe1(\x8b8\xd1$Q\x88I5Z\x19\x942/\xe6;\xb2\xdd\xcb\x1a\xdeRM{}\x19\x9d\xa6h\xe1`\xd9.Ҳ\xb7@\xab\x8cncc\xe1\x1cQ{\x1d\xc7v\xc0xH\xdb\xc9\xe8\xfa\xcd4n\xffK\xad5q\x14f@\xeb\x14\xad\xd3,\xbfn\fA\x12\xa1uJ\x9cd\xb3VR\xad1\xb6\xe4\xb33k\xcc\a\x01J\x88\x8d\x95\x8dd\xfd\x95\x02D^\xee?`\x8c\xb1\x93$\xe5\xfc\xdc\x05p\\\x924%IB\xd24\xcez4\xb6^f\xd1\xff\x90\x18\x04\xbd\xd6\x06Q\xa7I\xab\x1d\xd2\vR\n\x95*\xd2s\xb3kjMqx\x04!\x15\xbd\xc6\x06\x8d\xe5\xf3\xf4Z\xebD\x9d&I\xd4#\x89Ct\x12\x93&\ta\xd0#\n\x83>\x18\tq\xd8E\x9b\x84$\xec\x91&)a\x94ycI\x1c\x13&\x86 Jxn\xb1\u0379v\x8f~\xe1\xdf_\x01\xec\x1c\xf1~0\x00\x01\x1aI\x92\xb0\xb0\xb0\x88\xb4]\x828%Ib\x92(@\xa7\xf1V\x1fߥ\xa3.\xe2\xa0Gwc\x19\xa3\rssk\f\x0e\r\xe0TjH\xc7\xcaBY%\xb1\x1c\x97\xe1\xa9]$QJ\x9aĴ\xd7\x17io,\xd1\xd9X\xa2\xdb\\\xa3\xd7^'\xe84\x89\xc3.I\x1c\xf4\xedF\x17\x9d&\xa4IL\x12\xf6\x88ӄ(MI\x93\x848N\b\x93\x94n'\xa2\xd3Mq-\x891,!\xcc\x1f\x00\x9c[\n~0\x009\xb7\x144\xd34e~~!\xcb\xc6E\tI\x9a\x10\x06mt\x1a\x93\xa6I?\xf1\x94\x01\x92\xc41\xad\xd5y\xd6VV\xb8\xff\xfe\xc7\xd9\u007f\xcdՔ\xabE\x84\x9fC(\x81p-\xac\x9c\x0fH\x8a\xb5A\xaa#\xdbY\x9e]C\xa7\x9a8\xe8\x90\x04m\xe2^\x93\xb0\xdb$\xecd\xb5\xbdq\x18\x10\xc7\x01i\x9c\x95\x05\xa5a\x8f4\x8e\t\xfb^X\x9a&\xa4:%\x88\x13\xc2N\n\xfdѲB\xf0\xef\xce-\x86鍻\x8b\xfc\xc0\xa8\xac\xfe\xdaX]]#\x88\"ZAB/\xc8\xde\xd28̊\x1a\xd2${K\x938&\xea\xb5Y\xba0\xcb\x03\x9f\u007f\x8c{\uef5b=\xfb\xa6\xd1[\xa5!\x02!\xc1\xc9\x17\x91JbKImt\be\xf9,ͮ \xa4\xec\x1bu\x8dIbҨK\x12t\xb6>\xa2^\x93\xb8\xd7$\xeeu\xd0I\x92\x01\x92jt\xacѩ&\x8cS\xd2 \xc5R[9\xfc\xaf\x01<q\xba\xf5\x83\x01\xc8%z\xf7\xc1ťeb\x03\xad0\xa1\x1bƤiJ\x18v\x89\x83\xbeJ\x89z\xf4Z\x1b\xf4Z\xeb̞\xbf\x88H\ry\xdf\x05!\xb2\xe6\xcf$\xcaJ@\x85\xc4$1\x16\x06۶\b\xbb\x01\xddV\x8b\xb9\x8bkX\x96\x8d뗱\x94\x87\x94V֓\x12u\x89\xbb\r\xa2N\x83\xa8\xdb&\xec\xb4\t\xbb\x1d\xe2$!\x8a\x93,/\x93fs\x1a\xc30!N4ы\xbb\xf2,\xaf\xf0\xb2.\xb3\xba\xda4\xd8\x1f[Z^y_\xab\xd3\xc5w=Z\xbd\x88j\x94\xa2TD`\x9a\xc8\xd8B\x18H\xe20\x93\x9cDS\xaf79}\xf4\x18+\v\x8b\x8cL\x8eQ\xf3rX\x852:\x8eI7\x96\x88ZMfΜ\xa3\xd7l\xd1nux\xe6\xd8\fo\xbe\xeb\x0eP.R9\x18\x93\x92F=\xd20 \r\x82\xcc[\x03\xd24!I\x12\xdaA@\x94d-lI\x9c\xf4mWJ=\xd5(G\x00\\8\xb7\x14\xc4;G\xbcW\xcc~\\v@.YG\x8d6<ď>L\x00\x00\x1b\xc6IDAT\xfa跹\xef\x1dwљ\x9b!\b#\x1c\xc7\xeaG\xc7i?|0\b\xa9p\xfd\x1cA/\xe6\x81/~\x85\xe9ݻ\x19=}\x9e\x837\x1cb,Ȋ\xd3\xe6Ϝ\xe7\xe4\xd1\x17\xf8\xcbǞ\xe7\xf93\x17\xa8Z\x8a\x1fy\xc7m\x18d\xbf\xe6\x17\f\n\x1cPV\x17!zh\xa3щ\xc6\x18M\x92\xa4\x04qFɐ\xa6\xc4IB\xa25\xbdF\xc4r\xa2)J\v\xe0\xc8+i\xcc_1@\xfaj\xabgY\xeaԗ\x1f\xfeƞ\x1f}\xff}\xc4Ҧ\xde\x0e\xb0\x94\x85\xebZ8\x8e\x8d\x94\x92\xd4d\xc3\xc8\x06G\x06\x19\x1a\x19\xe0\xf8\x99y\xbe\xf8\xdcW\x99\xde>\xc6\xdb\xeb-\xf6\xef\x9eǲ\x14g\xce]\xe0Ͼ\xf6\x14\xc7g.r\xed\xd8 o\xb8\xed\x10o~\xd3\xcd\b\xe5\xb3\x19|\b@*\x1b'W\"\xeau0a\x04R`R\b\xe2\x88(I\xb2@3\n\xe8\x06\x01\x1b\xed\x90f;\xa2m\f\xa5,\xb6<\xc1?º\xecs{띄j\xc1\x12B\x88\xd7\x04apU\xb1<\xc0\xa1\x03\xbbi\xaf\xad\x01\x02Kd\xddJ\xd2R\b\x14Y\xa1c\x818Ii\xae\xd6Q\x06N/\xad\xf3\xec\xdc2'\xcf/\xf2\xf4\xf1\xb3<\xf0\xe4\t\xea\x1bM\x0e\x8f\x0e\xf2\x81\x1f\xbb\x87\xb7\xbf\xe3Nl\xbf\x88\x94\xea\xc5ى\x9bA\xbb\xce\xe8\x994\x8a\x00C\x94\xa6l\xb4\xda$:E\xe8\x84\xfaF\x9df/\xe4\xdcl\x83\xc6FLN\n\xa2,\xfe\xfc\xb3z'y\xfc\a\x0e\x10\x80j\xc1J\x80\x1d\x8e\xad\xee|\xea\xe8\x19~\xe2\xfd\uf8bd\xbe\x06F\xe39\x16F\x1b\xe24\xa6\xbd\xb6\x8eHSt\x02Õ\x12\x96%H\x93\x04\xcf@\xd2m\xb2\xb8\xbe\xceR\xbdGE)\xae\x9e\x1c\xe6\xfd\xef{\x1b\xaf{\xd3\xeb\x10\xd2B)\vc\xd2Kx-C\x9aD\xa4q\x905z\xc6!\x02E/I\xe8t\xbb\x19\xe5\x1etYZk\xd0hE\x9c<\xb5\x81N\f9!QF\x10I\xf3\x89\xf5N\xb2e\xd4\x0f\x1f\xd8\xc3\xd2\xea\xfa\x0f\x06 })\x19\x11B\xbc\xa7\xde\xea\xa9\xda\xc0\x00\xbbw\x8c\x13w;H!pm\x85D\xb08\xbf\xc0\xbf\xf9\xdd\xff\x8an\xb7\xd9>\\a\xa8Vfz\xc7\x18\xb5J\x01%lj\xb9<\xfbƇ\xb8\xee\xc0\x14\xef}\xff;\xb9\xf1\xf6\x9bI\x93\xb4\xef\xeef\xb4\x8bR\x924\x86\xb9\xb9\vxv?\xe8\xd4)i\xbfG$NS\xbaA\x17\x13\xf7h\xd4\xebtz\x11gfꬭ\x04[\x8d\x9c\x9e\x118F}\xad\xb4}be\xdb\xc8\xf0X\xb9\x98\xf7\x8c\xa1\xbb\xb2^ח\x1b\x18\xeb\x15\x94\xc6\r\xa0\xe3ز\xf2\xc0\xfd_\xe1\xf6C{\x88Rh\a\x11\xae\xa3\xf0\x1d\x9b\x9d\xfbv\xf1/\u007f\xf5\xa7\xb9\xff\x93_\xe0T\xee,\xe3\xbb'\xf0m\xc9u{\xb6s\xf8\xc0.\xeak]\xa4\x81\x89靌^\xb5\x8bv\xbdE\x12\xc7Y\xf1t\x1cѮ\xb7X\xbd\xb0\xcc\vϟ\xe6Tc\x91\x9f\xff\xd9w\xe0y\x1eBH\x94\xb4HI\x11ƠӘ\xa4ץ\xdd\rhu\"fg[\xd9\xd0\xe5\xfe\xd2Z381\xf9{;\xf7\xec\xfaCK)\x1a\xad\xb69?\xbf\xf0\xab\xc0\x1f\xfe\xa0xY\x00] T\x12\x8e\x9d8Ǒ#ǹj\xef$Q\xa7I\xbb\x17\xe1X\n!l\x0e_\xb7\x8f\xa1Z\x85\xcf}\xecs\x1c{\xe2$\xf9\x9c\x8b\x92\x12'\xe71:2@\xa1\\\xe4\xc8\xdcc|\xe5S_\xa0\xdd\xea`t֢\xdc\t\x02\xea\xdd\x00\xb7\xe4\xf1\xda;\xaf\xe5\r\a\xae\xc5\xf3r@\xc6\xee\xa2\x04:I\t\xa2\x10\x1d\xb6iu\xba$\xda\xf0\xcc\xd1խkl\xae4Iٵ\u007f\xaf\xbd\xfd\xc0\xfe\xac\xdd\xc1\x18\xf1\xe5G\xbf\xfd\xc1^\x10<{\xe4\x85S\x0f\x1d>\xb0\x87#/\x9cz\xd5\x03\xa2\x8d1\xa6T,\xf0\xfe\x1f}/gN\x9f\xe3\xf0M\x87\xe94\xea\xf4\xa2\x84(β\x89\xddv\x9br%\xc7/\xfe\xe6\xff\xc2\xf3ϝ\xe2\xc9\a\xbf\xcd\xcc\xcc<zu\x83\x13\xb3\x17\xb1,\x8bR\xa9\xc0\xc0\xe8\bζ\x02yߣQoqhx\x80\xeb\xf6L!\x87$ڷQ^1k\xd4I5F(\x8c6DQH\xb7]'\xee\xf6h\a!\xc7O\xae\xd3jE[`l\x0eW\x16J\xe2\x17\x8b\xbc\xfe\xce7\xf3\xd0\x17\xbf\x84\x94\x927\xdfv\x8bj\xb4Z\x9fUR\xddy\xe4\x85S\x8f\xbfj%\xe4\xf8ӏ\xb2\xff\xbaۉ\xe38\u07bbw\x9f\xfe\xdd\xdf\xfb\x03\x0e\x1f\xbe\x96G\x1f~\x84g\x1f\xfb\x1a{\x0f\xee\xa437K+\x88\xf0\x1c\x8b$\x89\x89\x93\x94G\xef\u007f\x98\xc3^\x99\x9f\xbf\xf7\xad\x88\x89\x91\xac\xc7<M\x11Je\xad\x03Q\fQ\x8c\xe9\x06\xc8F\x87f\xa3řs\xa7(\x9a\x01\x86\xf7\xecF)\xa7\x9f?\x01\xc8x\xacN\xb7K\xd0nR\xef\x06\xac\xac\xf58?\xdb\xec\x0fJ\xeb\xa7T\xa4\xc4h\x8d\x9b\xcb121\xce\xe8\xc48\x13\xdb'Y\x98\xbb\x00Bp\xcf\x1b~\xa8\xfc\u05cf~\xeb\x13\xc0;\x8e\x9d>w\xecrH\xcae\x03\xe4\xc1\xcf}\x92\xb7\xbc\xf3}\xec\xbf\xeev\x00\x1ez\xf8\x1b?\xec\xd8N\xb5R\xab\x11\x84\x01S{\xf7\xf0\xc8\xfd_dm\xb5A\xb56@\xaf\xb1N7\x8c\xf1\xdc\x04\xd7s\x98\xbe\xf1\x00\x9f\xffˇ\x98>u\x96[\x0e\xec\xa7X)\xa3\x1d\x85\x895$)\x04!2\x88X[\xdf\xe0\xe8\xfa\x12V\xce\"\xbfs\x82\xe1\xddW\xe1\x16\x8a\x99\xaf\xa5S\xa2\xa0M\x14\x04\x04a@\xab]\xa7\xdd\xed\xd0h\a\x9c=\xd7 \x8eu\u007f\xbc`VB\xaa,\v\x1dE\xb8\xbeǶ\x1d;p\x1c\x87\xf1m\xdb\xd8X['\xe8\xf5pl\x9bۯ\xbbv\xd7#\x8f?\xf9\xe9öu\xfd\x91\x17N\x05\xffР\\\x16/ke\xf6\x04\xd7\xdc\xf2z>\xf2\xfb\xbf]\xfd7\xbf\xf9\x1b\xf7\xfa\xb6\xfa\xa3\xc5ť\x9f\xb9\xfa\x9a\xab\x1d\xdf\xf3\x10B\xb0\xb2\xb4B\xa3^\xe7\xcf\xff\xf0#\xbc\xfe=w\x11\xb5Z\xa4q\x8cg+lۢ\\.\x91+\xf8|\xfb\xf9\xe3<v\xee\x1c\xa7Ϝ\xa5\xb1\xb0\xcc\xe2\x85\x05..,pn~\x81\xaf\x9e;\xc3\xc3+\vX9\x8b\x81\xf1A\x0e\xbe\xe9\r\xf8\xd5A\x84\xe5\xf4[\x0f\fA{\x9d\xa0ۡѪS_[ay\xad\xce\xe9su\xce\xcd4P*kY\x13\"\xabZ\xf4|\x9f8IȕJ\xbc\xe5G\xeeehd\x84v\xabM\x1cE4\xeb\r\x00r\xbeO\xce\xf7\x86\xce\xcc\xceݲ\xbc\xb6\xf1\xf1\u007fh\x8fK]\x0e0\x86\xb6\xefce\xf6\xc4ȍ7\xde\xf0\xf9O}\xea3\xffbf\xe6\xfcT\xaf\x17\xc8C\x87\x0eR\xa9\x941ư\xb6\xb2\xca\xfc\xccy\xbe\xfa\xc5/\xa1\x84d\xefu\x87\b[M\x04`+\x85c\xdbT\x86\x06\x18\xcc{4\xd66XJ#\x9e[[\xe1\x85\xe6:'\xda\rf\xa3\x1e\xa9\xef\xb0c\xb0\xc2\xf8P\x8d\x83o~#ű\t6\x8f\x9e\xc0\x18ҰC\xafU\xa7٪\xb3\xb2\xb2\xcc\xca\xea\ng\xcf\xd7y\xe6\xc8\n\x96\xa5\xb0\xad\xac\ras\xe6\xa3[(\x10\x85\x11\xb5\xd1a\xee\xbc\xf7=\x14JE\x82\xa0\aB\xb0\xba\xb4\x84\xd6\x1ac\f\x03\xd5*\xb6e\xed\xea\x85A\xbc\xba^\xff\xfa\xabBeI)\xff\xaf\xb5\xb5\xb5[Μ9\x8bR\x8a8\xce\x18\xde$I\xb0,\xab\x9f\x06\x97$\xa9\xe6\xb1\a\x1fa\xefuW3R\xa9Ҫ\xafc[\x12˶(\x97+\xec\xbe\xf9\x06l\xa9x\xe1\xa9gi\xe5s\x18\x91\xf5\xdf\xfa\xaeM\xb5Rat\xe7\x0ev\xdcp\x03\xb9Z\xad?%\xae\x9f\x18\xd7)Q\x14\xd0l5X\xdbXaeu\x89م\x06G\x9e]\xc1q$\xb6%\xb0,\x89N\xfb-q*c\t\xa4RL\xed\xddKu\xa0\x06\x80m;\xa8~\x1f<I\x9f\x84\x8cc\x0e\xed\xdd\xc3ť\x95_\x8e\xa2\xf8\x8bgf\xe7\x9f\xfa\x87R]\xd6e\x92\x8e;\x8c1\xff\xabm\xdb[\x1eL\xabզ\xdb\xede\x9cU\x9a\x12\xc5\x11\x1a\x83\x11\x82\xa8\xd3\xe5s\xff\xf1\xcf\xf8\xe5\xdf\xfb?\xe9\xc4\t\xcdn\x1bǶp\\\x9fby\x90\x9d\xb7\xdeJm\xc7v\xba\xf5\x06q\x18c{>\x85\x81A\xbcb\t\xbfT\xcc\f\xbd~\xb1H\x01m\xb2\xf9\x8d\xabK\xaco\xac\xb2x\xf1\"\v\x17\xeb\x1c9\xba\x821\xe0:\x12ױ\x11J\x11\a\x11\xa9ָ^\x8e\xc4\x18\xbc\\\x8e]\a\x0e\x90+\x14H\x93$\xabيc\x8a\xa5\"\xab\xcb/\x06\x8fI\x920>:<<\xbb\xb8\xf8\x89C\xfbv\xbd\x06X\xbd\xe2$dh\xfb\xbe\xcd/\xff\xd8\x18C\xa1P\xc0u]z\xbd\x1e\x9b\xad\xccR\xcàJ\x95\xe5:\x84\xc1q$\x8b\xe7\xe7\xf9ܟ\xfe9\xf7\xfd\xc2OR?s\x92f7\xc0\xf3z\xe4\n\x1a\xdb\xcd1\xb0c7\x83;%\xdf;\xd9v\xb3M\xf6\xc5FP\xad\r\xcb\x17\xcfs\xfe\xfc)\x96\x16\x17\xa87Z<yd\x99n/\xa1\x98\xb7QB\xe0\x17rDaH\xaa\xb2\xd3\x14lץ\x1b\x86\x8c\x8f\x8er\xe0\x86k3i\x03\x928\"I\x12\xfc\\\xee%\u007fU\xf4\xf34\x03\xb5\xea\xdev\xbb\xf3\x9bO\x1c=\xf6kWT\x82\xaa\xbd2\x9b\x85\xe3\vg\xde\x0f\\\xbd\xe9\xd7\xd7jխSr\x92$K\bY\x96\x85\x9f\xf3\x19\x1a\x1dE\x00\x9e#(\x16\\\x1e\xbd\xff!\xbe\xfe\xc0C\xe4\xc6'\xe9\x1a\xc5F\xabM\x14u\xb3\x99%\xe2\xe5n\xb5ߥ+\x04\b\x83NR\x96\xe6O\xf3ܳ\x8fq\xe1\xc2yVV\xea|\xe3[\xf34\x9b\x11傍gK\x8a\x95\x02\x85\xbc\xdf\x1f+\x9b\xcd\xfaU\x8e\x83T\x8a\xf1\xa9\x1d\xec;|\x18\xad5Zkڭv_\x82\xfc\xef9\xe2\"\xe7y\xf8\x9e\x87\x94\xe2\xedW\\ư0\xb4\x1dczض\xfd\vRf\x97MӔ\x91\x91\xe1,\xd8\x12\xd0\xeb\xf5\xb6\x1e*\x97\xf3)W*Tj\x15\xa40\fU=\xfc\x9c˗\xfe\xec3\x9c8r\x8c\xdc\xd86\x9aA\xc2\xea\xda\nI\xd4C\x9b\xf4e\x8e7\x10[\xe34\x82n\x8b\xd3ǟ\xe4\xf1o?\xcc\xca\xf2\x12\xe7g7\xf8\xf6\xe3\v\xb4Z\x11\x95\xa2C\u07b3\xa8\rV\xa9V\xcbx\x9e\x83\xa5\xfa\xb6D\tbc\xc8\xe5\xf3\xdc\xfdS?\x81\x92\x12)%\x8dz\x838\x8aH\x93\x04\xcbV\xdf#\x9b\xae\xb3\xa9\x92\xc5\xf8\x15\x99\xc2\xed\xae\xadޞ\xa6\xe9\xc1\xcdJ\x12\xad5\x83\x83\x83}@$kk\xeb[U&\x96m!\x95b\xd7\xde=\b\f\x05\xdff\xdbp\x9e0\x8c\xf9\xcc\x1f\xffg\x16\xe7\x97ɍogy\xa3\xc1\xe2\xd2\x02\xddN\x1d#^zX\x9e1\x1a\x9dFDA\x93\x8b\xf3'\xf9\xd6#\x0f\xf0\xf4c_%\xd4\xf0\xc4\xd3+<s\xe4\"\xedNB\u07b7\xa9\x16l\x06\x86j\xd4\x06k\xe4s\x1e\xa5R\x01\u05f6\xb0-\x89\xedش\xbb]n~\xe3\x0fq\xe8\x86\xebI\x92\x84$MY_]%M\x12\xb4\xceJ\xb1=\xff\xa5R\"6\x0f\xbc\x94\xd2\xdfd\x84\xaf(\x1b\xa2\xb5\xbe5\x8e\xe3\xc1KI\xba\x91\x91\xe1쁄\xa0^ol\x01\x92/d'\xf1\xec\x98\xde\xc9\xda\xf1'\xb0\x94\xa4\x94w\x18\x1bȱ\xb4\xd1\xe5#\xff\xc7o\xf3\xb3\xff\xfaW\x18(\xfb\x9c>u\x92ru\x15\u05f6\xa9ֆ(\x15KH)\tzm\x1a\x1b\xcb̟?\xcd\xc2\xc2<\x89\x11\xf4\xd4\b\x0f\xfd\xc57\x89\xc3\x10c$y\xcffb(\x87\xe3y\f\x8f\x0e!e\xe61\teaY\v\xa4\x89b\xbd\x131\xb2{\x9a\xbb\xee{oV\xd9\b\xac,.\xa2uև\xb89+%_,\x12\xf4z[\xf9\x96\xb4\x0f\x941F\x02W\x96\x97\xd5Y\x9ds\x8c1\xfb7o~\xf3\r\x9e\x98\x18Gk\x8deY\xac\xad\xado\xfd\x9f\xe7gS}&\xf7\xee穿J\xb7F~\x8f\r\xfa\x04QJ\xb3\x1b\xf2'\xbf\xf5{\xdc|\xe7\xebٹ{\x84Vo\x16ג\\8w\x1cKI\x12\x9d5\xf5wz!\x9d^J$\xaa\x9cxv\x86ٓO\xf6\x99[I9\xef26䣤b\xdb\xf6\tr\xf9\x02\x1a\x8d\xeb\x17\xd8X]C\x98\x948N\x89\x84\xc5\xcdoz#ۦv`\xb4\xc9h\xf9v\x87n\xb7K\x92$[\x80tZ\xadK\x92_\x82s\xf3\xf3\xe4r>\xaaaqp\xcf\u0381\xe7O\x9d[\xbbb\x00I\xd3\x14)\xa5\xb4,k\xebT\x01\xad\r\x83\x83\x03\x8c\x8d\x8d\xb0\xb2\xb2\xc6\xca\xca\xca\xd6\xc3\x19c\xa8֪\f\x8cNP\xac\r\x00\x1a[)@25\x9a\xe7삡\x91\xc2\x13\x0f}\x93\xe3O\x95\x19\x9a\x18\xa1Z-\xe0\xe7\xdd~\x05{H\xabѦۍ\xe8\xb4{l,\xad`\xfa}\x88\xda\xc0h-\xc7p\xd5\xc5\x18ö\xa9m\f\x8e\fe\x83/}\x1f\xa9l\x16f\x9a\xd8J\xd0\xe8F\xec\xb8\xf1V^\xf7\xa67\"\x84 \b\x02VWV\x88\x82\x90n\xa7\xb3\xe5M-.,\x10\x04\xc1V\x85e\xbb\xdbeqe\x95}{\xf6\xb0\xb8\xb4\x8cRj/\xf0\xad\u007ft@^\xff\x96\xb7\xf0\xb5\a\x1f\xa442\x15\xc5\xcd\xc5#a\xf8Ҏ\xe1$I\xb9\xe1\x86\x1b\xb9\xff\xfe\aX\\\\dee\x95m\xdb&\xd0Z3<:¹Sg\x18\xdf}\x00\xd6\xcf\xf3\xfa;\u007f\bc\fǎ<Gγ81\xdbd\xa5\x11\xd2iv\xe84\xcfr^d'\xb3\x99\xfe\x00\x9a\xadV\x83,\x83\x9e\x9d\xbf.%\a&K\xe4<\x8bTk\x06GF\x99\x9c\x9aDcP\xd2%W(\xd3\xedd\xe5G\xadN\x80\x1e\xdd\xce[\xdf\xf1v&\xa7v \x84\xa0\xb1Q'\x8ebZ\xcd&\xf4\xa7\x8f\xd6\xd77\xe8\xb6;[1\x88\xa5\x14\xcf\x1c;\xce\xf0\xd0\x10\x8df\x93v\xa7\x83m\xdb\xef\x06\xbe\xf5?\x1a \xfe\xbd\xa8\x93\x0f}\xf8\x83<\xf0\xc0\x83\x00\xfcٟ\xfcA\xee\xc4\xdc\xf2;\x87\xc6ƿ\xfa\xec\xf3\xc7\xdf{\xc7-7b[\xd6KJD\x87\x86\x06\xf8\xda\xd7\x1eA\b\xc1\xd0\xd0 \xbbw\xef\xc6\x18\x83\xe7{,\xce/\xd2m\xb70\x8d\x05\xf6^\xb5\a\xdfw\x19\xdf6Fs\xa3N\xc9\xcbȿf'\xde*h7\x9b\xa7\x81\x9a\x17]\xde\xcdS\x0fJy\x87\x83\xd3\x15|/\x1b\xfdW\xadU\xd9{\xf5U؎\x8d\xebؔ*U\x84\x80\xd3\xcf\x1fcaa\x91\ro\x90;\xde\xf6üힷ\xe3y\x1eQ\x14\xb1|q\x89^\xafK\x14f\x8d\xb6A\xafG\xbb\xd5\"\x8a\xa2-[\xd8\v\x02\xbes\xf49r\xbe\xcfٙ\xf3Y\xef\x8aֻF\x87\x06\xfeӑ\x17N\xf5^Q/\xebC\x1f\xfe \xbf\xf4\xbf\xfd:\x00\x1f\xfe\xe3\xdf\u007f\xeb\xb1Sg?\x93$ɧ\x8c6C/\x9c<C\xbd\xdeĲ^*x\x9e\xe7\xb1c\xc7\x0e\xa4T<\xfe\xf8\x13l\xb9\xc5:eb\xfb6\xca#\x13\x84)8\xb6\xc2s\x1d\xf2\x05\x9f[\uee19|!\xc7\xd4h\x8eC\xd3\x15\xaa%\x9bD\x1b\x92T\xf7\x0f\xbb\xcf\x0e6N\xb4\xa1\x94\xb3\x98\x9e(phW\x05\xdf\xcd8*\xdfw\xd8\u007f\xcd\x01\n\x85\x1c\xf9~\xcc\xe3\xba\x0e\xcf?}\x94g\x9f?EP\x19g\xef-\xb7\xf2\xee\xf7\xbe\x87r\xb5J\x1c\xc7,\xce/\x90\xa6\tA\xaf\x87\x10\x820\b\xd8X_\xa7R\xad\xf6OS\x10(Kq\xe4\xc4I4p~\xee\x02r\xabH\\\x8c\x02\u007fy\xf8\xc0\x9e\xa1\xff\x11\x8fK\xfe}\xc1\xf8Ї?\xf8_\x8c1\x9f5\x86\x1f\x96\xfd\xd2\xff\x95\xd55Ξ\x9f\xcbn\\\xbd(|\xb6m\xb3w\xef^\xc0p\xf1\xe2\"\x17.\xcco\x9d\x1d5<6\xccĮ=\xc4v\x89\x8bs\xf3x\x9e\x83mY\x14J\x05\xeex\xe3k\xc9\xe5s\fU\\\xae\xd9]嶃\x83L\x8f\x17\xa9\x95\x1c\xca\x05\x97\x9dc\x05n:0\xc0\xb5{j\xec\x18-\xe0\xda\n\xab\u007f\xfe\xedޫ\xf6304\x88\xe7\xd8\xe4\v>Q\x94\xf0\x89\xff\xfay\x1e\xfe\xc6QF\xae\xbb\x95\xdc\xc4\x14\xef\xbe\xf7=\f\x0e\x0f\x13\x85!ˋK\xc4q\xa6\xaa\x8c1t;]f\xcf\xcd05\xbd\v\xa4\xe8\xf7\x96\x18ڝ.\vK+ٹ\xb9\x9e\x87m\xdbX\xaa?\x8cSp\a\xf0\xb9\xc3\a\xf6\x8c\xbe\x9cں\xf7\xee\xb7\xfcé\xacM5\xf5\xa1\x0f\u007f\xf0\xff\x03\xee\x03i\xa7I\xa2\x9f{\xfe\x84Ȋ\xdc NR\xde\xfc\xba\xd7l\xe5\x18.\xcd\xc4=\xff\xfc\v\xa4i6\x1b\xf1ꫯ\xc6\x18\x83R\n\xdbu\xb8\xb8\xb4\xc1\xf3\xdf\xfc\x1a\xd7^\u007fp\xab\xae\xca\xf5\\\xaa\x83U\x16\xe6\xe6\xb3\u007f;\x8a\xc1\x8a\xcb\xc4P\x8emC9\x06\xcb.\xbe\xa32\n\x9dͣ\x8b\fS\xbb\xa6\xd9w\xe8*\x84\xc8\xe2\x9dc\xc7\xcf\xf3\x1f?\xfai\x02\r\xb7\xbe\xf3\x1e\x9aZp\xcf;\xee\xe1\xe0\xe1k\xb2\"\x8c\xf5uZ\xcd\x16\x8dz\x9d(\f\tz=\xe6\xce\xcfp\xcd\xf5ד\xa6\tq\x10\xd1j6\xd1Zsfv\x8e\xd9\xc5E\n\xbe\xff\xe2x\xdaK\x9b\x85`\x12\xf8\x85ѡ\x81\xe1\xf1\x91\xa1w\xed\x9e\xda\xfe#\xd3;&\u007fh\xef\xf4\x14\xdb'F\x17/,\\L\x97V\xd7\xcd\xcbU\xb2|߀\xfc\xd2?\xffi\xbe\xf3\xe43|\xe8\xc3\x1f\xfc\x05\xe0W\xe8\xcf\xd4\xeb4\x9b\xe2\xf4\xb9\xf3h\x9dQ\"g\xcf_\xe0}\xef\xba\x1b\xd7q\xb6\xe8j\x80R\xa9ĉ\x13'i4\x1a\xd4\xeb\r\xf6\xef\xdfG\xb1\x98U\x95ێ\x8dW\xac\xf0\xe5\xbf\xfa\x12\x05+ar\xc7\xf8%\xf1J\x9eB\xb1\xc8\xea\xd2\nI\xbf\x13\x17.9O\xbdoxE\x9fr\xdf6\xb5\x83\xc37_O\xaf\x17p\xf6\xec<_\xf8\xf2\xe3|\xe7\xc8Y\xf6\xddt=\xfbo\xbb\x95\xf5n\xc4]w\xdf\xc5M\xb7߆\x94\x92n\xbb\xcd\xf2\xd22\xedv\x9b^\xb7K\x1cE\\\x9c_`\xd7\u07bdL\xed\xde\xc5\x13\x8f~\x9bR\xb5\xc2\xc6\xda\x1aq\x92\xf0ؑg\xf1\x1c\x1b\xdbv(\x14\x8bh\x9dbtF\xed\x88\xfe\x89\xa3Zk\xa7R.ߺkz\xe7\x8d\xd5\xda\xc0aǱo\r\xe3\xe8'\xa20\xfaU%\xe5\xeer1\x1fVK\xc5\xe5c\xa7υ\xdf\r\xca\xdf\t\xc8\a~\xe4\xdd\x1c\xbej?\x1f\xfd\xf8\x9f\xf3\x17\xf7\u007f\xa6Ъ\xd7\xff\x04\x18\xde\xd4x\xedz\x9d\xd9\v\x8b\xa4\xda`KE\xa7\xd7\xc3s=n\xbf\xe9z\xd24%\xed\x8f\xec\xf3<\x8f4\xd5<\xff\xfc1\xc20\xc4u\x1dv\xefޕI\x88\x93\x9d\x1f\xe5W\x06\xf9\xc2'?\xcd\xcd7\x1d쏌\xcdV\xb9V\xa1X)\xb1\xb4\xb0\xb8\x15\x17lΙ\xbb\xf4L\xdb\xdd\xfb\xf7\xb2\xfb\xaa\xfd<\xf6\xd8s<\xfa\xe4if\x9b)\xf9\xd1\t\xf6^\u007f\x98\xdc@\x8dF;\xe0mw\xdf͵7߄\x00\xba\x9d\x0e\x8b\x17/\xd2n\xb5\xe8u\xba\xc4Qȅ\xf3\xb3l۱\x9d\xdb_\xffz>\xff\xe9\xcf204\x88\xad,\xea\x1b\x1b\xcc\xcc_d\xe6\xc2\x05\x06*e\xaa\x83\x83\x14\xcb\x15\xc2^@\x9a&\xfd\xf7S\x10\xc71\xa5b\x91k\xae\xb9\x86C\xd7_\xcb\xf6]ӌMnc۶\t\x86GFm\u05f6\xae\xb5\xa4\xfcqm\xcc\xcd\xe5Bn\uee53gg.\x95\x96\x97\x05䚛oei\xfe\x02G\x9e?Αc\xc7y\xd7[\xde\xf0\xb6(\xe8\xfdy\xa5V;h\xcc\xe6\xd0I\xc9\xea\xe2E\x96V\xd6Hu\x16G\x18\xe0\xd4\xcc,?~\xef=\x80 M\xd3M/\x84\xe9\xe9\x9d<\xfa\xe8\xb7\t\x82\x80\xf5\xf5\rn\xb8\xe1z|?\xab\x90/\x96\x8at{!3\xa7gh._d\u05ee\x89-\xcfJ\x1bC\xae\x90gl\xdb\x18\xadF\x8bN\xbb\xfd\x12jQ*\xc5\xfek\x0e2\xb7\xdc᯿y\f]\x1dc`z7\xc5Z\x8d\xd1\xedۙص\x873\xe7\xe6x\xf7}\xefa\xff\xa1\x83[\xf1\xc6\xd2\xe2\"\xedV\x9bv\xabE\x1c\x85\xccΜ\xa7T.s\xf7\xbd\xef\xe6\xa1/|\x91\xf5\xd5U\x0e\xdfp=\xe7Ϟ#\x8e\"\xfe\xfa\x9b\xdfbr|\x94b\xa1\xc8\xf6\xe9]\x00\xf4:\x1d\x928F\nH\xfb\xb9\x95\xdd;\xa7\xb8\xe6\xe6\x1b\xb1\x9cl\x00v5\xe72P*P\xa9\x96\xa9\x8dO008\x884z:M\x93\x9f,\xe5s\x83\xcbk\x1b_XZ]\xe7\xf0\x81=/\x02r\xa9+\xfb\x87\u007f\xf8\xef*n\x14\xd4j\xc3#\xc37_\u007fx\xfc\xde{\xee\xfa؏}\xe0\x03\xff\xb6:40Ro46\t5\x00V\x17\x97h4\x9b\xc4I\x8amYDiJ\x9c\xa6\x1cػ\x97\xed\xe3\xa3\b!\xfa\xaa&{\x9b\xf3\xf9<O=\xf54\x9dN\a\xd7uػwϖ\xd7U\xa9\x961\x96\xc3\xc3\xf7\u007f\x89\xe9\xedÔ\x8a\xf9K*\x11\xc1\xf5=\xa6\xf7\xeebz\xff.\x928F(\x8bD8,\xb6\xe1\xe9\xb3\r\xbcm\xd3\xec<|\x98\xf2\xc0 \xa3\x13\xdbx\xed[ކ[\xad\xf1\xd0_\u007f\x85\xf7\xff\xe8\xfb\xfe\xff\xb2\xce\xf57\x8a\xeb\f\xe3\xbf3gfvg\xed\xb5\xd7\xf6ڻ\xde]c\x1b\xe3\xbb\x13JIJ!ꅄ\xd0R\x02q@\x16\xa2*\x82&\x91ڦ\x91\x1a)\x12R\xfe\x84^\x90\xaa\x96\x8bJE\x85Z%\xa2\x86&\x8a\xaa\xb6THM\x95\xa8Q\b\x97\xa4u\x04X\x01b\xb0\x8dw\xd7\xde\xfb\xae\xf76\xb3\xd3\x0f\xb3^\x11\xf1i?\xce\xcey\xe7}\xcf{\x9e\xf79\xcf\xc3\xf0\xd8\x18\xb6mcV\xabģQ\xf2\xb9\x1c\xd9t\x9aj\xa5\xc2\xc2\xfdyTU\xe5\xc0\xe1Cܾ9\xcb\u007f\xaf]\xc7\xdf\xd5\xc9\xc4ƍ|z\xe5*7n\xdf!\xbfZ\xa4/\xd4MwO\x0f\xad\xed\x1d\b \x97IcV\xab \x04\xab\xa5\x12\x9d\x1d\x1d\x8cLL\xd0\xda\xd1\xde\xf0\xbfj\xd2\x15\x84\xed\xd8t\xb8\xa4D\xf54\xe1\x0fvah\xba\xa0foi\xf6\xb8vu\xf8Z/\xcd\xcc\xdeɨ\xc7O\x1cs\xf6\x88\x9f\xbe\xce\xf1\x13\xc7|\x8a\xa2\x1cNė\x0f\x9a\x969b\xb8\xb4֞\x81A^\xf9٫\xb8\r\x83\xb3\u007f:\xfb\xa5\xeeɮ{9\xb95\x95\x82\xac\xa2J\x05U*\x14J\x15>\x99\xb9\xc1\xf8\xd0\x00M\x1e\x03MӜ\xebȦ\xc9\xe6͛x\xef\xbd\u007f3?\xbf\xc8ŋ\x97\x18\x1f\x1fgxh\x90\x9amc4{شu\v\x8bw_\xe0\xec\x9b\xe7x\xf1\xd0w\xe9\xed\rQX-\x93\xcf\xe5YYI9VJ\x9a\v\xd3\x1b\"]\xceQti\x8co\x1d&\x18\n\xe1kk\xa3\xad\xc3O0\x12!\x95\xcdr\xfe\xdcyV\xb3Y\x0e\x1f9\xc4\xfa\xc1A\a4\xac\x9aD\x97\x96Ȧ3\x8d}#\xba\xf8\x00\xa9\xaa\xec\x9d\xdaO\xbeP\xe0\xc3\xf7?@(\n;\xf7<\xc7\xff\xae]Ǵ,nݝcbx\x10\xc3\xe5\xa6o\xfd\x00BUQ\x10\xb8t\x1d\x97\xaecZ\x16\xba\xae\xd1\x19\xe8\xa4+\x1cBSl\xbc\x9a\xac{\xa5\xd8\xd8ҹs\xa4H\x81O\b\xb2\xc2\xc3\xe0c\x13\xf8::\xf0\u07ba\xb5\xe5\xee\x17s\xffxlx\xe0\a\xeaCm\xecn\xe0\x17\x95re4\xb6\xb0(l\xab\x86P5^~\xe9\b\x86\xc7C\xac>S~$ \xb6\x8dKUQ\x15\x05UJ\xa7l\xd96\xf1D\x92ٻsl\x1a\x1fAJ\x89eY\x8dM~\u07feIN\x9d:\x8deY\xbc\xf5\xd69\xdex\xe3(\xba\xa6c\xd7l\x02\xc1\x00\xcfL\xee%z\xff\v~{\xf2<\x9b\xbf\xf6\x15\xe2\xc9\x1c-\x9d\x9d\x18\xbe6jB\xa1F\x85\xbe\xa1!\x9e~\xe6qB==\x18\x1e\x0f\x86\xe1A\xd54\f\xaf\x97w.\xbc\xcd\xf4\xb9i\xb6?\xf5u\x9e=0\x85\xaf\xbd\r\xd34\xb1,\x93\xa5\xc5E\xf2\xf9<\x85|\x9et\"A,\x1aC*\n;v\xef\"\x10\n\xf1\xe7\xb3\u007f$\x97\xc9\xf0\xec\xee\xdd4{\xbdܜ\xf9\x8c\xe5d\nUS\xe9\xf6\xfbQ]n\x82\xddݘV\r\xdb4Ѥ\xc4c\x18D\x97W\b\x06\x03tG\"H)iV%\x1eUA\x11`\xda\x02\xb3f7\xf4\x1f\x85\x10\xb4\nI\xaaT\xa53ԍ\xbbɃ\xa6i\xa37gg\xdfV\x8f\x9f8&\x81W\x81c\x80\xccg\xb3d\x92i\x8a\xd5\n\xdf?t\xd8\x0e\a\x02ª\xd5H\xa6\x92\x8d\xd2\xf2p@jv\r\xad\x9e\x19ί\x13\xb0b\xb1ȭ\xdbs\f\xf5\xf7\xd2\xe41x\x18\xe3\xea\xeb\xeb\xe5\xc9'7\xf3\xd1G\x1f\xb3\xb2\x92\xe0\xddw\xff\xca\xfe\xfd/\xa08\x92\xd5D\xfa\"\xec\xfd\xe1\x8b\xf4\x8e\f\x93\xcd&\xe9\x1dp\x9e\xeb\x0f\x04\x98ش\x99\xc1\xb114]\u007fd`d\xdb6\xbf\xfc\xf9\xaf\xb8\xfc\x9f\x0f9\xb8\u007f\x92o\xee\u0601\"\x9d\t\xa5eYD\x17\x1fP(\x14ȤR\xe4s9bKQ\x14!\xd83\xb5\x9f\x9e\xde^.\xfd\xed\xef,-.ҿa\x03Cc#|r\xe5*\xd5J\x85\xf9h\x8c\xfeH\x04\x97\xae\xe1\x0f\x06\t\x85#\x14\n\x05*\xa5R\xe3\xdcc\vA{[\x1b\xad\xed\xed\xb8U\x85f]A\x15\x82\x88W\a\x04\xf3\xb92ź\xb3\x8dR7\x1d\xf3\xb94\x92\xa5*\xde\xd6V\xd6m\x18\xa0fYa\x15x\t\xf8\xf5\xdaK=\x98\xbb\x8f\x10\xe0\xf1u\xb0g\xd7w\xecb\xb1(\xa4\x94d\xb3\x99/\xd1-\x1b\x10F\xdd3\xdb\xc9\x12\xc7\xcc\x05`y%\x89\xe16\xb8>s\x8bol\xd9\xe4\x10\x05\xd6ƷR\xb2}\xfb\xb7\x98\x9d\xfd\x9cT*\xc5\xe5\xcbW\x18\x19\x19f||\fEQ\xd04\x8dщQ\xc7R\xa2Z%\xdc\x13\xc6\xdb\xd2BsK\xb3\xc3&\xa97\nk@_\xadV#\x1e_\xe6\xcc\x1fΒ\x8e/\xf3㗏\xf0Ķm\xd4\xea\x1d^\xb9\\&\xbe\x14%\x93N\x93\xcbf\xc9e\xb2<\x98_\xc0\xddd\xf0\xbd\xc9I\xc2=\x11\xee|~\x9b\x9b3\x9f\xe16\f\x9e\xd8\xea(\x96^\xbf\xfc1\x96m\x93\xc9\xe5\x18Yߏ\xa6it\x87\xc3t\x05\x82d2iR\xcbqtUe!\x16c]8\x8c\xeev\xa3\xbb\r\xbc\xf5`\xb8\xa4\x82\xae(\xa8\x02\x06}n\x96\n\x15\xd2eG\xf9\x14!pK\x9b6\xb7J\xbal\x12\b\x87IŗQ\x81߬-\xf2\x1a\xff\xa8\\\xad\xb0wr\x1f\xd5jEY\x9b\xf6\xad\x16\x1f\x85h\xd6\x16\xc4\x06\\\xaaD\x91\n\xae:l\x92Jgp\xb9]\x14+&\xf7\x16\xa3\xf4\xf7\x84\x1aYR\xab\xd5\b\x85B<\xff\xfcs\x9c>}\x86Z\xad\xc6\xf4\xf4_x\xed\xb5n\xda\xdb\xdb\x1bg\x93\xa1\xb1!\xe6n\xcfqs\xe6\x06\xba\xae\xd3\xd2\xdaB\x8b\xaf\x855\xf2\x84\xa3Pm\x12\x8d\xc58\xf5\xfb3\xf4\x87\x82\xfc\xe4\x95\x1f\x11\xe9]\x87i\x9a(\x8aB!_ \x1e\x8d\x92\\Y\xa1T*\xb1\x1c\x8b\x91\\Y\xa13\x10\xe4\xdb;w\xd0\x19\f\x92\xcdd\xb8\xfc\xfe\aX\x96\xc5\xd0\xd8(\xeb\x87\x06\xf9\xd7\xc5\u007fbV\xab\xac\x16Kh\x9a\x86\xe1r!\xa5J0\x1c\xc6\xebmFQ\x04V\xb5B\xa5ZE\x91\x12\x8f\xc7\xc0e\x18躎\xa1:\x19\xad)k\xea\x13\x8e\x19\x9aWs\x846\xb3\x15\xcb\xf1RD\xe0\x92\x82\x16\x97$W\xa9\x11Y\xdf/\xd6@'K\ba\x17\v\xab\b\x01\xf9b\x99\xf1\xd1Q\x87\xc2/@JI\xb9T~\xc4wI\xd4E\xef\x014\xb5\xaec\xe2vZ\xbd\xc2j\x81\xc2\xea*\xa1@\x80x2\x83\xaf\xa5\x99Vos\x03~\xafT*l\xdc\xf88SS\xfb\xb8p\xe1\x1d\x12\x89\x04'O\xfe\x8e\xa3G_o,\xb8\x94\x92\x81\xe1\x01t\x97\xce½y\x92\x89$\xa9d\xaa\xf1?\x84\x10Ė\x97ysz\x9am_\xdd\xc8\xd4\xc1\x03\xf8\xbb\xba\x1a]]:\x99be9Nb%A\xb9TrJV>O\xa4\xb7\x8f\x9d{v\xa3\xeb:\x96i\xf2\xe9\xd5kD\x97\x96\x90\x8a䩧\xb7\xb3p\xef>wn\xcd:0{\xa1\x80\xdf\xefG\xab\xdf\xf2\xea\n\x86P\x14\x85\xa6\xe6fR\xf1(\x89t\x06\u007f\xfd#j\xefꬣ\x06u\xa7\xa1:Eմ\x1d-`\x80\x16ͩ\x12٪\xcd\xda1\xd7-\x15ʊ-\x8c\xa6\xa6\xea\xff\x01Ϭ\xa6\xf8!\x821\xf5\x00\x00\x00\x00IEND\xaeB`\x82")
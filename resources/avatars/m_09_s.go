@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_09_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14,\x1f\x89\xf0\x9f\xec\x00\x00 \x00IDATx\xda\xec\xbdw\x98\xa5gy\xe6\xf9{\xc3\x17N\xacS\xb9\xba\xab\x93\xd4-\xa9\x95A(\x90\r\x02c4\xce\x03f\xb1\x8d\x91\xc9Q\xc66\x8e\xb3;\xeb\xf5\xee\\\xb6\xd9\xc16\x06\x83-L\xb0\r\x98`\xec\xc1ƀ\x892B$Y(\xc7\xee\x96Z\x9d\xab\xbb+\x9c:\xf1\vo\xd8?ޯJ\x1a_3\xb3\u05eeA\xa85>}\xa9\xab\xaa\xabt\xea\x9c\xef\xfe\xde\xe7y\xde\xfb\xb9\x9f\xfb\x15\x9c!\x8fW\xbf썼\xef\xc3\xef\x01\xe0-\xaf~\x8b̍\x95\n)\n[JcJ\xe7}l\x92DI\xe1]\xec\xbd\xd7\xd7\u007f\xe8=\xfd\xff\xb7\xe7\xbc\xee\xba\xebx\u05fb\xde\xf5\xb8z\x9f\xe2\xf1\x0e\xc4\x1b\xae}\v\xe3\xf11\xfe\xfc\x13\x9f\xe4w\xfe\xd3\xef\x88#\xfb\x8f]Ἳ\xca#\xb6+!s睶֍\xa4d\xd9y\")\xc4\xf9B\b\a\xdc)\x95\xfaǴ\x9e,\xfd\xe1\xbbߞ\xbf\xe6g_\xdfRR\xcaK\xe7\u007f\xbe\xf7\x86\xb7_\xe9\x1f\xfd;^\xf1\x8a\xeb\xf8\xe0\a\xdf\xf5o\x80\xfc\x8f\x1eo~\xe5/\xf2\xc7\x1fx\xc7\xe6\u05ef\u007f\xf9u\xcf7\xa6\xfc\t\xa9\xe4K\xbcw\xd3R\n\t\xc2\x03\x05\x1e\xe1\xbc\xd3\b\x91\v\x84\xf6\xceI\xa9\xa4r\xde\xed\xb7Ʈ\n\xc12B\xa4@! \xf2\xf8OJ\xa2\u007ftw\xcd\x1e~\xff\x9d\xbf\xbd\tΫ\u007f\xf6u\xbc\xef#\xd7\xff\x1b \xff\x03PfU\xa4\xa7\x8c)歱os\xde?\xd59\x8bs\x96\xa2\xc8\x11\x80\xc7!\x84$Ij\xe0=\xceZ\x84\x92\b!\xf1\xde#\x85\xc49\x8b\x10\n)\xe5\xc6S;\x04\a\x04,\t\xe4\x17<|\xe4\xfa\xbf|\xf7\xc3\xff\xb6B\xaa\xc7\xeb^\xf1&\xae\xff\xe0\xbb\x03\b\xaf\xfa\x85)\xe7\xb8\xda{\x9bH)\u007f\xd29\xff\"\xe7,e\x913.\x86\x94yFY\xe6 \x04\xce[\x8c5H\x04B\n\xe2\xa8F\xad\xd6D\xa9\b<x\xef0\xd6`L\x8e\x14\x12\x1d%h\x15\x11\xc5)RJ\x9c\xb5H\xa9\xf0\xf8%\xe0\xfdJ\xca\xf7\xfe\xe9_\xbc\xe7\xf0\xe6\xeb\xba\xf6\x8d\\\xff\x17\xef\xf9\x9f\v\x90\xdfx\xddo\xf1{\xd7\xffv\x05\xc6/]\xee\xbd\xf9=k\xdd\xf9Ɣ\xf5\xb2\xcc:\xa5)\xc9\xf3!\xe3Q\x0f\xeb\x1d\x00\xb2\xba\xc5\xf1\x1e\x10x\xef\x10R\xa2\x94B\n0Ơ\x95F\xea\x88l<$\xcb2\xf2\xbc \x89S\xe2$ezz\x9e8N\xc1y\x94\xd6H\xa9\xd0:\xc29w\xb7w\xee/\xa5\xd2=\xa9\xe4\xbe\xeb\xff\xe2=7\xbc\xe1\xda7\xf3'\u007f\xf1\xc7\xffs\x00\xf2\xa6W\xfd\x02\xef~\xff;C\xe5\xf4\x9a_\xfa\t\xebܻ\x8a\"\xdf\xd6\xeb\xad\xd2\x1f\xac\xe0\xbd\rA\xc9Y\x9csH\xa9\xc0K\xac\xb5H-PR\xa2T\x84w\x16cJ\xf2|LY\x96\xe4y\x8e\xb5\x1e\xf0D\x91b\xd0\x1f\xb3\xba\xb2N\x9c\xc4,,navj\x1a\x0f(\xa9\x10R\x90֚\xc4q\r\xadb\xa2(\xb5\xd69'\x85̞\xbe\xf7E\x13\xaf\xf8ߞ\xef\u007f嵿\xce\xdb\xdf\xfb\xb6'. \u05fd\xfa\x97y\xd7\xfb\xfe`\x03\x94gJ!_iL\xf13E1NV\xd7N3\x18\xae\xa2\xa4BJ\x81\xf7\x1ek\x1d\xe0ɳ\x82\x93ǖ\x11\x12\xe6\x16\xa6\x90J0\x1af(-\xd0ZS\xe4c\xca\xd2\x06\xc0\x84DkE\x14G\b!\x90J\xe1\xadC)\x8d\xd2\x1ak\f\xde[\x9c\x87\xe1 \xa7\xdel\xd0\xe9L\x91\xa4M\xda\xed)\x94\xd4H)\xfe\xbe\x99\xb6\u007f\xe6\x0f\xfe\xec?\x0f\x01~\xf3\x97\u007f\x93\xdf\xfd\x83\xdf\xfd\x9e^\x1b\xf5\xfd\x00\xe4\xd2\v/\xe1\xd6;\xbf\xc3u\xaf\xfc\xc5\x05\xa1\xc4\xe7\x8d)\xae:~\xe2`\xda흦(\xc6\b\tRJ\xbcu8\xe7\xabU\xe2\xe8\xaev\x8944\xdb)\xc6\x14\x94y\x8eǡ$\x18k\xb0Σ#M\x9aDD\xb1Fk\x85\xf3\x1e\x8f\xc7{\x8f\xd2\x12\xef\x1d\xd6Y\x10\x1e)\x05\xc3aFo}\xc0\xeaZ\x8f\xeeZ\x97\x93'\x96\xd0Z\xd1nw\x90J\x9f7\xcc\xfa?sœ/\xbf\xf7\xd6;o}\xe8\xa6o\xde\xc4\xeb\xaf}=\xb7\xdcq\xcb\x13\v\x90[\xef\xfc\x0eo~\xd5[\xae\x92J\xbe\xbd(\xb2\xa7,\x9d|8\xca\xf2!ֹP\x11\x01\x84\xf4\x80\x92\xe1\"\xe6\xa3\x11\xce\x1a\x84\x92(%\x91J\"\xa5\xa0(\f\xa64h\xad\x89\"\x85\x10a\xd1K)A\b\x10\xe1s)\x05\xc6XJ\x13\u00a0w\x9e\xe3'\x96\xe9\xad\x0f1e\b\x81\xab\xbd\x1eeQb\xad!ˆt:S(\xa5;ֺ\x9f\xbc\xf4\x82K\xa6\xee\xb8\xe7\x8e/\xder\xc7-\xbc\xee\xda\xd7\xf1\x9d;\xbe\xf3\xc4\bYo\xfa\xf97!\x94\x9e\xc0\xf3Q)\xe55Ǘ\x0e\xb2\xde[FJ\x89\x00\x9c\xf7\x80C\xabpw[c\xc8\xc6c\xbc3\x14\xa5\xa14aEx\xeb\x19\fFt\xbb\x03\xa6\xa7'\x88bM\x9aFx\x0feYb\x8cGHH\x93\x18\xa5\x15\xb1V\x94eXE\xc6XF\xa3\x8c\xe3ǖ)\x9d%M\x12ji\x8c֒$I\x18e9\xde\xc1\xae\x9dgQ\x96\x96\xd1p@Y\x96\x9c\xb5{\xcf?\xbd\xec\xdaW\xfe\xf0\xcb^\xf9\xd2\xd1\x13b\x85\xfc\xd2+~\x8dw\xfe\xf9;\xb8\xf2\xb2\xa7\xfe\xb8\x10\xe27{\xc3U\xbak'qU\xf5\x84\xf0\xe0A)\x85\xf7\x8e\xd2\x18\xc6YF6\xceX\xef\r\x18\x8fr\xa2X3\xecetW\adY\x89V\x9a\xf1\xa8`\xbd;\xa27\x18a\xaca<2\x8c\x86\x19\xfd\xf51\xab\xcb=Li8\xbe\xb4\xca\xf1\x13ˌ\x86c\"\xa5\x89bM\x1ci\xa4\x97\x98\xd2\xe0\xbc#\xcb\n\x06\xfd\x11&7\b!\x18\r\xc7\xe4y\x8es\x86\xb20d\xd9hש\xa5\x93\x17\x9e\xb7s\xf7\xed;f\xe7W\x1f<v\x98\xab\x9f\xf2\f\x0e\x9e8r\x06\xaf\x90W\xbdeZ\tq_Q\xe6\xb3G\x8e\xed\xa3\xc8\xf3\xf0*\x04h\xa5\xf0\xcea\xadCJIY\x16t\xbb]\x9cu\f\x06#\xf0\x8e\xa4V#\x1b\xe5\x94Y\x89s\x1e\xe3,J\b\nk\xf1\xde㜧\x16'!Z\t\x89\xc0c\x9dcm\xb0\x8e\x12\n%%i\x1a\xa3#\xcd\xf4T\x9b\xc2\x18\xbc\x05\xa5%R\b\xa4\x90Xo)rG\x91[\xe28FH\xc0\vT\xa48\xeb\xacspEy\"\x1b\x0f\xaf\x89\xb4\xbe\xef\v7\xdfX|7\xaf\x8f|\xccV\xc7\xeb\u007f\x05\x80H\xa9\x97\x00\xb3e\x99\x83\a\xa9$Z\xc7h\x15\xe1\x9d\a!\x902\xe4\t\x84\xd8\xd8j`\x8d\xc1\x14\x16g\\\xc8+\x1biF\b\xb2\xb2D\tI\xa25\xb1\xd6H!(\x8d\xa1?\x1e\xb2>\x1a\xb2:\xeca\x9c\xc3\xd9\x10\xae\xca\xcc\xd0_\x1fq\xf8\xe8)N\x9cX\xe1\xd8\xe9\xd3\xf4\ac\x8cs\xacu\xfb\x8c\xb3\x9cz\xa3\x89\x8a\x14R\t\x8a\xa2\xc0:K\x9e\x17\xe4\xd9\b\x1d\xab-ƺ\x0f\x16\xc6<\r\xe0Y\x97>\xed\xcc\nYoyï\xf3\x8e?\xfd\xcf\xfc\x87_\xf9\xad8\x1fg_4\xd6&y1b8\xeaAE\u007f\x18\xeb\xc8\xf3\x02\xad$B\x84\x04\xdc]\xebb\x9d!R\x1a\xa9\x14x\x81\x14\x82<+\xb0\xd6\x02\x02%\xc2\xcfk\xa5@\b\x84\x80\xd2Yzِ\xac\xcc\xf1\x16\x9c\xb7h4F\x18\xa4\x97\x14\xce`\xbcŚ\xb0\xd3\xc7\xc0`4b<Ȱ\xd6\xd1[\x1fa\xf2\x12\x00k-B\b\"\xad)M\x81\x8eb\xb4PXk\xe7=\xfe补\xa37\x1c>y\xf4\xcc\x02\xe4۷|\x1d\x80\xcb/~\xca\u007ft\xf8\x17XS`MIQ\xe6\xa4i\x9dN{\x06k\v\x9c\xb7\x94\xa6\xc4:˩\xa5\x93\x8cFc\xb4VH\xa5\x90J\x02\x9e^w\x88\xb3\x81\x0ft\xde?RU\t\x89\xf5\x8ea\x9e\xd1\x1f\x0f\xf1\xde#\x10\b@\xa1\U00038c22\x94 B\xc3\xe6wA\"\xd0(\xac\xf3X\xe3p\xde\x11E\x11Q\x14m>\xbf\xf7\x0e\xe7\x1c\xa3\xe1\x80ZZC\n%\x94\x92\xfd\x1d\v\x8bw\x1fZ:z\xeay\x97=\x93\x83'\x0e\x9fYI\xfd\xaa˞\xfa\xab֙s\xbc\xb7H\xa5\xa8\xa7\r\x1a\xf56\xbaz\xe3y>\"\xcb\xc7\xc4QD\xa3Q\xc7\vH\xe2\x88\xd2XLi)\xc69\xa60\x80\xc0y\x8f\x12\n_\xa5\xc2Җ\f\xb2\x11\x99)p\x81XA\xa2\x90H\x1c~3\xc4)\xa1B\xae\xf0\x02\x1dX0rJD\x05\n@\xac\"\xe2$\x0e{\x17%C\x05\xb8\t\x8c\xc7Z\xcbD\xbb\x85s\xec\xf20q\xd6\xc2\xf6\u007f<\xde_1+\xab+gN\x0e\xf9\xf4\xf5\x9f\x17\xe0\v|\xc8\v\xce\x06\x96V\xaa\xf0F\xa3\xb8F\xbb9\x89\xb3\x8e\x87\x8f\x1cc\xdf\xc1Ì\xc79^JN,\xaf\xb0\xda\xed\xe1l\xb8\xb0\xce{\xb4\fw=\x80s\x96\xfeh\x8c3 \xbd@U\u007f\xc0Sb\xaaU\x12\xe0I\xeau2W\xe0DX3R<\x02JN\x81ǅ?Ρ\x94\u0094\x06!$ֺMPʲ\f\xaf_\x8aX\n^\xe4\xe1\xed\x0f\x1cx\xe0\xbbr\x9d\xf4\xf7\xbc\xc1\xf4\xf27\xf0'\u007f\xf9'|\xf1\xe6Oo3ƞ_8\xcb\xca\xca\t\xd6\u05fb\xb4\xdbSH\x04\xf5F#\x94\xb8\xb9erb\x9e\xe18\xe3\xd8\xd2A\xd6{\x1a\xbf\x04\xa3qF\xa3V\xa3\xd1N\x1e\xd91V\x8fQ\x91\xd3\xcf\x06H/p\x9b\x81\t<b\xf3\xf3\x12C\xaa#\xea\xbaFw\xb0\x8e\x10\x8e\xb1\x0f\x1bP\xe1%\x11\x11\x02\x83\xc5!\x91\xc4B\xa1c\x1d\x9eE\x80w\x0e)\xc2M$e(:\xbc\x10\b\xe7\x11B֭7\xbbΘ\x1cr\xcb\x1d\xb7\xf0\xc6kߤ=\xe2\xf9Y>\xfe\x99\"\x1b\xa7GN\x1c\xa6(rV\xbb]\xf2\xbcduu\x85\xbc,\x19\x0eǌGcji\x9dV\xa3\xc1\xb0?@\xf8\x10r\xc6yF6\xca1\xc23*2\xa4\a\xeb=Y\x9e#\x9dxT\x9b\xa3\xca/\x8f\nS\x00\xc6Y2\x93W%\xc4#\xff\xee\xf1X,\x02\x8fBbq\b-\xa8\xa5\xb5\x90\xbf\xa4BT\xcf\x1av\xfc\x928\x8ei֚\xe1\xf9\xbdC \xfe\xe6\xd0\xd2\xd1/?\xf7\xf2g\xf2\xf0\xf1Ï\xef\x15\x02`\x85m\n+.\x12\x82TiE\x1a%\f\xca\x01\xdd\xf5U\x8e\x0f\x8f\xb3c\xfb.\xb2,C+\x8d\x8a\"\x9c\xb3\xb4\x9aS\xb4\xf4**\x8a\x19\xd8\x1c\xe7\x1d\xa3шQY\xe0\xbd\xc7d#\x9c\xb7\xa1g\x88\xc7\xe2\xff\xabM\x95\xa8\xe2\xb1\x17\x81\xa0\x94\x80}\xf4\x1b\x97\x02\xef\x02h\x16H\xb5\xa6\xf4\x0e렰\x06\xe7\x1c(\x15\xd8\x03\x1c\xa1A\x19\x00\x94R\x05\xf6Y\xc9\xf0[D\xb8\x8e7\xdcr\xd3\xe3\u007f\x85\xbc\xfe\x95\xafG\xfb\xc8XW>S\xc7\xfa\xb9\xde\"o\xbb\xe3f\x0e\x1d?A\x91\x8dY\xe9\xad3\xea-\xb3\xa3\xa5\xb0*\xc1UiM\bAsr\x92\xb4ѠVkP\xaf5X\x98[`0\x1cP\x94\x05\x02\x87t\x0e\x8b\xc7>\x1a\x80\xeas\x1d\b\xad\x10Z\x1e\xb5\"\x1aI\xccl\xb3\xc9B\xbbM\xe9\x02E\xb3wa\x9e\xab\xf6\xecfab\x82\xa9F\x9d\x95\xd1\x10\xeb,\x1e\xcfh\x9c\x85>\x8b\x94\xe0A QRQO\xeb\b!p\xce\xe1\xbc}\xf0\x82\xb3\xcf\xfb\xf6\x81#\a\xfb\xff\xda\xeb\xf5\x98\xed\xd4_\u007f\xedk\xaf\x14R\xfd\xfdhԟ\xff\xd2\r_`mm\x99\x99V\xcal#a\xb1S\xa3\x95h\xb2ҳ:.\x19\x95\xa0[\xf3\x90\xb6(\xb2\x02\xa4\xc09K\x9a\xa4\x1c:z\x84\xe1hHY\x96x\xe7\x18g\xfd\x90\v\xaa\xb2w\xa3y%\x95D\n\x89\xb1\x06\xef=\xb5(\xe2\xa2\xc5E\xb6v\xda4\x92$\xe4\x01\uf255\xa6\xb4e(0\x84 7%\xeb\xc3!ˣ!3\xcd\t\xbe\xb9\xff\x00Jƴ\x9b-\xca<'\xa9\xd5\x10B0;5G\x1c\xc5\x15k,\x0f\xdf\xf0\x9d\xaf\xef<c\xf6!o~\xd5[\xa41\xe5\xf3\xac-~|\xad{:\xb9o\xdf}\x9c3\xdb\n\xff-L\xb38Ӧ\x11)\x1a\x11\xcc\xd6\x14\xf3uA\xea\x868\xe7\xb1\xf9\x90\xe3\xab=\x9c\x87f\xbd\x8e\x94\x92V\xa3IZ\xabaM\xc18\x1b\x85\xae\xa1\x00Q\x95\xa7\x1b\x1f\xad\v\x95ѓ\xb6m\xe3\x92\xed\xdb\xd9\xd2i\x13\xeb\bO\xa0H\x94\x924#h'!\x17x\x1f\x9a^\xf5H3\xd3j P\xa4\x91\xe6\xc4\xea*8\x18fC\xeai\rQ\x85\xafF\xad\x81\xf3\x9e(\xd2\xdfZ\x98\x9c\xfd̑Sǳ\xc7}\x95\xf5\xda\x1f}-Y>\x10\xd6\xdas\xa4\xa2\xb5\xbc\xb2\xceb;\xe5\xbc-\x93̵\"f\xb7\x9eE\x91\x8d\xb0eNd\f\xa3\xfe\x1aZ@G\n\x9an\b\xb1gB\xe4ܿ2\xa0\xab\x15\x8dF\x13\xad\x14\r\x132\xc2p<`\x9c\x87$/+JE\n\xaa}\x8a\xe0\x8a];\xd8=;\x87\x14\x82F\xbd\x86\xb5\x96X\x05Ƹ.-5mP6\xa3\xe5K\xba$D\xbe\xc4\bA\xcf*\x94\xd0L4ꀧ?\xeccD\xc5&D\x11Zi<~\x83U\xb8\n\xf9\xdd\t6\xdfs@\xde\xfb\xe9\xf7\xf2\x8a\x97^\xdbTZ\xff\xd08\x1b\xb2\xb2\xb6\xc2l\xabF'\x86\xb3/\xba\x92\xfe\xf22\x8d\xf64\xb6,p\xb6$\x8aS\xfa\xddS\xf8\xb2$\x12\x0e\xef=\xdb'\"\xea\xb1\xe2Xw\x89#+\x8a\xf6\xe4\f\xf5z\x9d\x85\xd99\xb4\x14<\xf8\xf0\x01\x12\xad\xd0J2\xddj\xb1gf\x86F\x92\x90h\x8d\x10\xa0\xb5d\xba\xddf\xb6Ӣ̺\x94\xf9\x10\xe1=\x91pH\x1cBB3r$\f\xb0\xc6Q \x88\x05\x9c\x16\x13\x14\x06\x1aqL\u05cc\xf0\x1e\xfa\xc3\x1e\xed\xe6\x04Y>\xa6Qk \x95\xc4Z\x93H)\xfd\x19\x13\xb2.\xbb\xf4\x92\x06\xb8_:\xbd|\xbas\xef\xbdw\xb2w\xcb\fss\xf3h,q\xda\xc0\x98\x02\xbc\raÅ\x98o\x8a\f\xbc\xc7\xe6#\xa4\x14L$\x9a\xb9v\x9dm\xed\x94|4\xa0\xd7\xef\x81\xd44\x92\x84\xd9Zʓ\xb6o圹Yv\xcf\xcc2\xd5jROcڍ:\x13\xcd\x06S\xad\x16\xd3\xed\x06\xa6\x1cR\x8c{\xe0\x1c\n\x87\x12\xe1\x0e\x97\x81\xd3\xc4\xf9P\x00S\x85\xbdHZj\xd2P:\xc1Ro\x8c\xc4\xe3=\xa4i\n^\x90\xc41I\x1c\x83 r\xce\x1d\xbf\xe8\xec\xf3n?p\xf4\xa0}\\\xaf\x90W\xbe\xf4\x95870EQ\xac\xed{h߮\xb9N\x9b2j\xb0\xeb\xc2˱\x835\xb2a\x0f\xbc\x0f`X\x1bj~ﱶ\b\x17\xca\v\x9c))\x1d\xe8H1\x99*\xda\xf3\t\x83a\x86\xaeY\x8c\xd4\xd8\xd6,҇\xceb\xb3Qg\xb2ݠ\x91\xa4\xc4q\x1cT(e\x8e\xb39\xb6\xcc\xf1Ρ\x85\x0fM._\x11/\xc2\xe3E\x84\x13\x05\x1b[\x1a\x89 Q\x16a\x1d\xbb\xa7R\x96\x87\x13<\xbc\xbc\x8a\xf3\x0e-\x14\xa5)Q\xea\x91\xcb\x17i\xf5\xcb\xfd\xd1\xe8\xa3\xc0\xf2\xe3\x9a:\xf9\xc0\xc7>@\x9ah\xbf\xba\xb6:ʲ1YQ\xb2\xb0e+\x13\xcd&E6\xaa.JU\xac\n@H\xa8\xc0\xb1\xc6\xe2+\xb5\x8f\xf7\x1e\x9cǚ\x12%#:\x13\x1d&R\xcdTliiK#\x95\xcctj,\xcet\x98l6I\x93\x00\x86u\x16\xbcŚ\x12cB\xef%\x10\xc3U\x13\xc6;<\x81\xea\x97BT,X\xd5\xfa\x05\"%H\xb4\xe0\x92\xc5)\xa6\x1aM,\x9e,\xcf\x18\xe5\x19yY\xe0\xdc&\xc1\x99\x81;\xe7\x8c\xe0\xb2\xfa\xfd\xa1\xef\xae\xf7}+\x8e\x99\xa8'\\u\xd5\xd3\x19vW\xf1\xce!\xfeE.\x14\xa1\x8f\v^\x84M\x98\x8e\x11B\xa1\xa4\n{0\xa1\xaaƓ\xc4\xf9\xa0\x1c\xa9k\xc7d*\xe8\xd4Sl\x99\xe1\x9c{\x04h\xefp\xd6bm\x01ޣ\xaaZ\xdfo\x10\x90\x02\xa47\u1acd\x86\x96|dO\xa0\x84\xa4\xaea\xb6\xee\xd9\xd5Q\x81=\x13\x80\xb7\x9c<u\xa2\"i\x04Q\x14\x1f\xc3s\xf4\x8c\x00\xe4\xe0\xe1\xa3n0\x18\xaek\xe1ٺe\x91-33\x8c\x86뀫6\\\x8f&9D\xe8{l\x94\xb0\b\x94\xd2H\x19\x01\x12)#\x84\f[@\xef}\xd5\x19\f\x04\xa3\xad\xba\x86֚\x8a8\x118k\xb0&\xc7;\x03x\xb4\xf2\x8f\xda}9\xbcT\x18\xaf\x10ޣ7^\x86\xafV\xa5\x10\b\t\xb5X17\xd5\xe6\x82\xc5I\xda\n\x8aJ\x98WX\xc3j\xaf[\x893\xdc\xe4Mw\xde|\xe4\xd9O\xbe\xe2\xf1\x0fȁ\x83\x0fGK\xa7N\x9e\xdd\xef\xf7\xb8\xe6\x9a\x1fa\xb8\xbe\x82\xaf\x1aL\b\xbfIIx\xffH\xc7P\x10\xf4\xb9!vT\xcd'\x14R\xea\xcd\xfd\xac\x94j\x13\xa8\xea\u007f\rܔ-\xaaU\x10\xae\xac'h~\x15\xe0\x9d\xddT<\n\xa1\xc0y\xbcP\xe0-\x91ph\x1f\xfa\xfaRn\xb6\xf87\xdb\xcb\xdb\xe6f\x99o\xe9JJ\xe4\x11Rbʲ\n{\x1c\a\xb8\xf1\xb6\u007f~\xfc\x03\x12ǉ\xc1sbjj\x9a'=\xe92\xc6\xe3\x01B>Bh\x88\x8d\xb8\xbdQ\xfa\xc5quET\b#\"\\p)7~̇\xb0%6V\xd7\x06MX\x01\xe0B\x93i#i;\xe7\xc2j\xc4\"\xab\xb6p\b\x88\x02\xe7AV\xe4\"J\x92*_Q0b\xb3\xd7/D\x90\r\xa5I\xc2\xe4\xe4\x1cxБ\x0e\xaf\xa1\"\x1c\xa5\x10\xe3+/|\xd2\xd63\"d\xb5\x1buש'\xdd\x1fx\xf6s\xc8F\x03\xbcsxg\x11R \x90 %Z=\x12\x8at\x9c\x92\xb6:H\xa9\xab\xfdV(C\x11\x1b\x19@ \xa4\xaa`\xe0Q\xf9bcE\x04\x10D\xb5K\xf4\xd6lf\x0e*\xaeW\xe0\x11X\xa47\x10\xea\x85j\x15\x85r\xf8\x91\x9e}\xf8\xcb\x1a\x83R\x8a\xfa\xc4L%Or\x95\x94UU\xbd\u007f\xdf2ލ\xcf\b@\xa28fj\xa2m\xcf=\xe7\xbc J\xe8\xad!\xa4 \x8a\x13\x84\x12\x81\xbcS\n\xa5c6\x92B\x94\xd4\xd0Q\x14.\xbe\x17H\x04\x88 \x84\x13\xe2\xd1ۧ\x10W\x9c\r\xfa_k\xcd#et\xa5,r\xbe\x02D\xc8Ц\x12\xe0\xbc#\x96%\xa9,\x88\x19\xa3m\x89+\f\xce@\xec\f\xb2\x8a\x81\xa2z\x8e\x90\u007f\x14\x8b\v\v\x95~\xccVa3\x94\xe9Ƙӷ\xde{\xe7\xda\xe3~\xa7\x0e`\xc6\xebf\xfb\xe2\x8e\xd3\xcdf\x03!\x15*R!\xee:B\b\x11\x02c\xc2E\xd3Q\x1c\xd8\\)\xd1q\x8c)\x8b\x80\x91\x94\b_\xf5\xc1ſ$\xda=\u038bp\x87+\xb5Y\xf9\b\x01\xa5)B!\xe0-\xa3Bp\xe8d\x9fa\x96\x13IX\x1f\x8c\x99\xaeA+\x95$J0\x99*R-\x91\x0e\",\x85Ta_\xe2@\xcb\xc0w\xedܺ\x95F\xbd\xce8ϫ\\\xe2@@\xa7\xd5\xf9\r\x80\xa7]r9\u07fc\xf3\x96\xc79 \xbd5\xcezƳM=M\xc1{:\xf3\xdbɆAns\u05fe\xc3|\xeb\xf6\xfbh5j\xd4\xe2\x88\xd9N\x8b-\x93\r\xea\xb1\xc0\x19\x8f\xf0>ԦȠ<\xd9\x04\xe3_rG\xa1(\xf0\xbe\ni\xc2c\xac\xc5\b\xc5ʰ\xe0\x9f\x1f8\xc0\xc3\xc7\xd6\xc0K<\x1e%\x15q\xa49^KI\xd3\b-\x05x\xcb\xd6f\xc1bK3U\x13\xf8HU\x112\xecQ\xf0\x9ev#!M\x13\xfa\xe3qśI\xf0\x92_\xfd\xf5\xeb\x86\u007f\xf7\xd5\xcf\xf1\x8a\x17\xbd\xea\xf1\x0f\xc8\x03ˣ\xf2\xba\xbd\x17ޯ\xa3\x18\x8fezq7\a\x8f\xaf\xf07\u007f\xffI\x0e\x1c9\x86\xc7\xd1\xed\x0f\x91(\xf6\x1f^\xc2\xe1i\xc6\x113\xad\x94\xbd\v\r\xe6;qUM\xa9\xff&\x18\xbeR\x9f\x84\xee\x9dG\xeb\x88#\xa7V\xb8\xe7\xe0Q\x8e\xaf\xacplu\r\a\xb4TDM\v\xea\x89b\xa2\x910ݮQOc\x94\x94d\xc6sb\x1d\x8e\f\x14\xa7ưk\n\xb6Mxt\x95ܝ\x0f\x15\x9a\xf6%\xd3\xf5\x88\xb5\xb5 v\x10\u07b2д\xec\xfb\xce7\xffw୷|\xfc\xad\x8f\xff\x90\x050=\xb7uԚ\x9c\xc1\x15#\x8e\x9d8\xc5\xfb?\xf0\x11\xfa\xebk$R\xb3m\xcasтb\x90{\x1e^\xb3\xec_6\xf4\x8b\x92\xd1J\x89w\x86V\xadN\xbb\xa1\xaa\x84\x1d\xee\xfeG\x83\"6\xab+\x8fT\x92;\x1f:\xca\xcd\xf7\xeece\xd8\xdflX-6\x12\x16'b\xa6\x9b1s\x9d\x06\xd3\xed\x06\x93\xad:J\n\xac-QZ\x91\x8d3\xee<\xda\xe7\xc0\xb2\xe4\xe80f\xf7\\\x823Y\x05\x88\v\x80[\x83\x94\x8a\xba\xf6\xf8(a\xb6\xee\xd931`\xfd\x81\xaf\xbd\xe6\x17\u007f\xf0\xf9\x9fy\xc7\x17\xbf\xf4\x95w^w\r\xbf\xf0\xae\xcf=\xbe\x01\x99\x99]\x88\xdbSs\xc4q\xc2\xe7\xbf\xfcut\xb1\xc6\xd3\xf7N3\x11\x97\xc4nH\x1a\xc1tC2[\x17\xec\x9dV\xdcq\xc2r\xbcg8\xd1\x1d\xf3\x9d\x83\xcb\\\xb1'\xa5U\x8b\x11\xa2R\xaf\x13=\xb2V\x84@\b\x89\x92\x82\xe1(\xe7\xa6\xdb\xef&/\x8b\x8a\xfa\x80]-͎\xf9\x06\v\x9d\x06\xdbf'\xa8\xc51\xcdf\x8d$\x8ap\xd6`l\xd8[\xd4\xd3\x06\x97\xab!s͌uW\x0f\xb3\x8a6\xfc\x0eSd\xf8\xb4N\x12)\xa6\xda\r\x06k\x9a\x99\xe9&Wl5\xd4\xc7}\\y\xaa\xb9\xb3V\xbf\x06\xf8\xca\xff_0\x1eS@ꍦ\vs~\x9a{n\xbb\x99\x99:ȢO^\x14\x8c\x11\xac8(-\x14\x06\x8c\xf5\xcc$\x82\x81\x00\x03\xe4\xf9\x98\xe3++\xec\xdd\xd2\xc6\xcb(\xf0].\xabʟ0\xba\x86/)\x06kxoiE \x1d4c\xc9|[\xb30\xd5b\xebL\x9bř\t굔f\xadF\x1c\xc5a\x06Q\x04\xf1\\Q\x16`=q\xdc`K{\xc8<k\x8c\xec\xf4&\xd9\ta\x85\xc4J\x12W:\xb2\xf3\xe7$5\x9bs财Y\xd3b\xe7\xd6t挨\xb2\x00\x84\x94C\xc0g\xe3\x91\xe8\x9d:\x8e\xcfK\xf2\f\xf2\xea~w\x06\xb2\xc2S\x14\x1e\xab%N\xd5پ\xb5\xc1\xd1c\xc7)\U000d2575UV\xeb\x9eN+ţ\xab\xd1\x0f\x81\xa7\xc0y\x855c\xca\"\xe7twD'ʩ\vHb\xc5d\xbbF\xab\x9eR\x8b5\xeb\xfd\x11\xd6\x1a\x9c\xb1()\x91Ҡ\xd1$\xb5\x04oM\xa5\xb8\x1fcl%}\xb0c\x84ו\x8e\xa1*ǵfjz\x86\xa5\xa3\a\xd9҉\x19\x9d\xee!\xa4\xa6p1I}\xfb\b\xee?3\x00\xd1Q<\xd2q\x94\u007f\xf6c\xefM\x95\xcd)-\x8cF\x16c\x05\xae\xf4\xf42Ǻ\x83\xae\x00Q\xab\xd1jwH\xc7]v\xee\xda\xc1\x89\xa3G)\n\xcbC\xa7z\\\x98h\x92(T_BVT\xb0u\xe4yI\u007fT\xb06\xc8C\x1b6\x86(\x96\x18c9\xb6\xb2\xc6M\xf7\x1fc\\\b,\x02\x1d'`2\"\xe9\xa9I\xc9dM3ۄ-\xd31S\x9d\x84Fs\n\xef\x05\xc5(\b\xf5\x02#\xb0Q4\xc0e\x17^\xc0T~\x98ȏ\xe8Z\x19x0\x1d1\xb3\xfd\x02\x05_|\xfco\f\xab\x90\x95\xad\x9d>U>\xfc\xc0]\xd4\xea1\xc6K\xfa#G\xbfg94r\xa4\x17]J}\xcf9\xf4=,\x9cu\x11\x97<\xfd\xc7p\x93\xd3<\xff\xe7_ƥW^\x89\x95\x11\xfdQ\xc1\xc9\xd55$9\x93\x13-&[\r\xd2$\xc2\xd90\xc20\xceK\x9a\xf5\x98=\xdb'i7c\xac\xb5\x1c;=\xc0M\xed᧯\xfb\x15\x98\x9e\xe3d\xe9\xb8\xe4\x87_\x8f\x9c\xd9ͅϺ\x9a\xc9s.\xe6\xd6\xe5\x82ۖ\nNts\xac\x98$\xaeO\x11\xd7\xda\xd4\xd2\x18Q\xed\x97B5\x17\x92\xfb\xfc\x96\x05j\xcd&\xbe()M\xa0_\xf0\xc2OL\xcd\xfd\xab\ay\x1e3@\x92\xb4^\x1e;\xb4\xdf\n<i\xa4\xd0iB\x14k\x9a\x9d\x16ͅ\x19\xc6\xfd\x15\xa6\xda\r\x9a\x8d\x16\a\ueed9\x1b>s=\x17\x9c\xbf\x97\x85\xc5m<\xe3\x9a\xe7\xd1\xe9L\xd0h\xb6\x18\x96\x92Z\xad\xc1\xfc\xec,s3s4\xeb\r\xe2$A*I\xa3\x96\xb0ma\x82-s\x93\xec={+\xf5F\x8d\xa9\x9a\xe6\xa7~\xfa\xc5\xfc\xc0\v^\xc0%W\\\x8e\x15\x92O}\xe2\x0f\xb1\xb2\xe0\x92˯ಋ/\xe0IS\xf0\xfc\xf3뜷s\x9e\xce\xf4,:\xae\xa3\xe3\x94(I\x91\xc2V\xf2\x1f\x11Jk\xe7I\xea\x13`KL\xe9\x02\x11\xe3aaqg\xb1eq\xfb?\x01|\xeb\x9d\xef|\xfc\x87\xac\xb4\xde\x18\xad,\x1d-\x14\x8e\x89z\xc4J\x92\x80\x94l\xbf\xe8)\\\xba0\xc7\xe1\x83ws\xea\xe4~\xf6\xcc\xc4\b\xb5@ZK\xf0K\a\xf8\xfc\xfb\xff\x88D)\xe6\xa6'\xc8\xc69&\x1f\x92\xd4Zh\xa9\x89\xb4\xa2ը3\xca2\"\xa5\x98hj\x928\"/J\xd2Z\x8d\xdd\xdbfX>=\xe4+\x1f\xf9\x10_\xfb\xfb\u007f@\f\xc6\xecl$\xb4\xe2\x88\x1du\xc1雾D\xeaG<u\xef$iRgbz\x91(J\xc2\xf8\xb5\x14\xe8(%\x8aF\xb82\xa8\x1d\x85\x108[\xa2㔬7&\x1b\x1b\x8aR\xb1u\xdbY\\x\xd1\xc5\xc5\xc3\xfb\xfe\xf9\xcb\x00O\xfd\x85_x\xfc\x03\xa2\"e\xb2\xf1\xd0z NR\x92z\x93\xd9\xc96\v\x8b[p*a\u05ee\x8b\xd86\xbf\x8b\xdeɇ\xb1\xd9(\f\xdeH\x89B\xd1hv\xe8\xfb>e^\xa0\xd3\x1a\xd3\xd3\xf3\b\xe1\xc8F\xebh\xe1i\xd4j\fGc\x9cw\xd5|:\x14EA#\x8d\x903u\x86\xc3>j8\xe2\xbc\xce\f\x97>\xe3\xe2@-n4)\xf31Qm\x92zk\x8a(N\xd1Q\x8c\x8cb\xbcɑ2\"NZ\xe4\xe5\bI \x18\xf1\x9e(\xae\xd1_\x1f!\xbcd\xe7\xce\x1d\\~\xc5\x15\x9c|\xe8[\xf6\xe7\xff\xf2k\xc33&d\x99b\xa4\xbd\xb5\xca#h6\x12\xe6g:\xecX\x9cA\x8fW\x106G눸1\xc1\xe4ֳh\xb5[\xc4IJZ\xabӚ\x9c\"MӰ+\x96\x92v\xbbM\xa3\xd1\xc4yC\x91\x0f0\xf9\x90z\f\xedV\x03]\x8d\x0eP\x91\x81I\x92\xb2\xb0\xb8\x8d-\x8b\xdbh4\xdbH%0\xb6Ě\fo\xc7$*\xa3\xd9\xe9\xd0\xe8̑6Zĵ:q\x92\"\x01\xa9\x13\x94JP:\xa9z\"b3\x87\x04:ߣtDR\x1cf\xe5\xc8\x1dl;\xff9\xeak\xef~G\xfb\x8c){{+\xa7\xea\xc6\x141x\xe2H\xb0ؑ\xb4c\xcbzaQ\x83\x93\b\xa9\xf1R\xe3\xbd%nu\xf0B\x12U\xab\x84|\x95Hڠ>i5\x11ޢ\xa5\xc4\x14a,ND\x924\u058c3\tRPx\x81wA\x9b%\x85\xe4\xbc\v\x9fDY\x16\f\x87=\\\x99a\xf3\x8c(\xd2Dq\n\b\x94\x12\b\xef\x90JWE\xf8\xa3\x1a`Z\x87P\xe5\x03\v\xe0\x9d%\x1b\x86Rwq\xe7\xb9h\xc2P\xa9T\x91\x12ޟ\x03|\xe7\x8c\x00d\xd0]\x91\xa3aO*\x1fT\x1f͆\xa6\x93\x182\xe7\x18\x95\n\xe1\x1c\u0097 \x04*\xa9\xa3\xaa^\x85\x1a\x1d\xc5\xe5\xebĲ\x89\xc4љ\x98`bb\x82\xc1j\x86D\"\x94\xc49\x83\xf0\x96\r\xddB\xa45\x1e\x81R\x11Q\x92\xe0\xb04\xea1\x8b\xd3\xf3\b`T8\xf22\x94ʦȐ\x01\xc7\xc0:\x17Y 0\x8d\xa9\xf6G\xa6\xe2\xc9<e^ \x95\u0095\x05Q\x94p\xc1\xc5O\xe1\xe1{\xbe\x86\xb5\x86\xb2\x18\xfa\xb4==<cV\bR\xe0\x8c\xc5\xfbН\x8b#\x89G\xb0\x90f\xac+\x8d\xf3\x81B\x97>\x88\xd7|1F\x96\xcb\xe4\xa352ݡ(K\xcaဉ\x89&\xedV\x93|\xb0\x86\x8c4\xcey\x94\x8a\xd1.D`g\r*JQ:frj\x9a\xb4\xdeB\n\x81\x12\x90*I\xa2\xa1\x1e\tVG\x9e\xb2p\xc8H\x13\xa9\xc0$\x97yF5\x03\x84s\xa1\xbar\xa6\f\xab\xc8{\x9cpx\x0f*\x8a\xb8\xe2iϥ\xd1hR\x94\x19\"M\xf0\x1e;\xb3\xf3\xfcCg\f \xcdv\xc7h-\xad\xad\xd4\"R\x8aj\xb7홊\xf2\xa0\\,\xc68\x13D\xd4\xd6\x15\x8c\x8b\x11^F\b\x11\x93\x8f\xc78WPo6I\xd2:\xadf\r7\xb3@\x96\rp\b\x8c\xcf\xd1*'\xcbK\xa4,iv\xa6H\x92:\xb5\xb4\x8e\xcdG\x94\xa4\xacg0Y\xd3x\xa0\xb0\x1e\x15G\xb8\xa2\xa4\xc8\v\xa4Ҹ\xb2\f\xc4U\x95#\x9c\xb5\xe8(&\x8a,\xa5\xcd\x11>pZ\x91\xd6\xec>\xf7Bn\xbd\xf9F\xc6\xc3\x11\xb2\x9d\xa2\xa4^;\xf7\x9a\xab\xc7gLR\x9f\x9a\x9d_\x89\x92t\xb01\x97\xb1)\xf9\x01\xcalD\xb1\xbeF9\x1abM\x81\xa3\xa4t\x06\xeb\x1cRװ\x16\xf2\xc2`MIZK\xb1eN\xb3Vgrr\x92\x89v\x87$\xaeQ\xab7X\x98\x9df\xeb\xdc\x1c\xcdz\x8a-\x86U\x0f\xc3\xe2\xad\xc1\x18C^z\xba\x99e-\xb38/\xf0\xbe⩄\f\x9e(\x1b=xk LG!uT\xf5aª1E\x86s\x96\xcf\u007f\xfa\xa3\xdcs\xc7\xcdP\x19\xa5Ei\xe3\x1e\x80\x1b\xfe\xe8\xf7ό\x15\xb2e\xe7\xb6c\xed\xce\xcc\xda\xe9\xc3\x0fm\xf7\x801\x0e\x1f\xa9p\xb1FCP\x1e\x94\xa7(L\xd5h\xb2 \x15JF\f\xc69\xf9x\xc8\xd9\xe7_L\x12\xa7\xe0\r\xadf\x03%\x04\x12Oa\xfbԅ\xa2.[DZ3\x1e\x0f9\xdd\xed2\xe8\x9e$Nv\x04I\xaa))\x94D\xaa\x18!\x04\x85q\x18k\xc1\x85n\xa4+\xc6!L9\x877%\xf8\xe0\xa7\xe2\xbc\x0ey\xca{\xbc\r9\x05o9}\xf2\x18\x91\x16\xb4\x1b)i\xa3\x83C\xfc3\xc0s\xdf\xf2\xd63c\x85\b!\xd6ړS=\v\x18\xe71\x0e\xac\r\xce<A\xbe\xe3(L\xb0\xb8p֑\x19\x0f2\xc5X\xc1`0\xa2\xdeh\xf2\x82\x1f}1\xed\xc9Y\x928\n;\xf3F\x9d8N*\xeb>O\xa3\xd1b\xa23\xc3\xd4\xd4\x1c\xb3\x93\x93D\xde`\x8b\xa2\xca\x05\xc1=(\xcb\v\x86Y\x19F\x15*)O\x10]\xb8О\xb7\x16/T\xf8w/\x10J=\xa2\x8aq\x8e\xf1\xa0Gi,\xd3S-vl\x9d\xa6\xd3JhN\xef`\xb0~\xea\vߕT\xcbc\xf8hON\xaf9\x04\xa5\x97\xe4&\\\x04[䡚\xf1\xe1k\a\x8c\x8d\xc7\xf9 \x8a\x1b\x17%ü\xe4e\xaf\u007f+\xf3[\xb7\xd3L4\xf5z=\xdcٛ>XAvz\xe2\xc4\x12\xf7>\xb0\x8fq^\xd2jL\xd0i51\xa3n\xf0R\xf1>h\x84\xf3\xbc\xf2\xdfbC\xc8U1\xbd\x1eW\t\xec\xc4\xe6\xf7,XK\x1c\xa7(\x15t*x\x8f+s\x16f[\xccL4\x89\x92:Isj\xfd\xc7\xfe\xcf?\xfc\xc6\x19\x03\xc8ݷ\x84\x1e\xf3\xfc\xb6\x1d\x87\xa4T\xbet\x01\x90q^bm\x81\xf5\x16k\x03\x18\x83R\x90;M\x14\xa5\xe0ae\xadϮ\xf3\x9fL\xbb\xdd\xe4\xab\x1fy;\x0f\xde\xfdMt\x14Wm[I\x9a\x04\xff\xc4S˫\xec{\xf0\x10\x87\x0f\x1d\xe1\xae{\xee\xa34\x0e\xadc\"\n\xca\xf1\bgC.q\xd6\xe0\xcb,H\x83\xbc\v\x12SgC\x98\xb2vCWT\xa9!\x1d֙`\xf3Q\xcd\u007f\b)(\xf3\fc\x83x\xae6\xb1\x95Ѩ\xff\xbf\x02|\xf8\xb5?\xf1\xafg\xc5\x1f\v@.\xba\xfc\xf2\xd05\x9c\xdfz\u007fsb\x8a\xf5\xa5.Vzr\xe3\xa9y\xc8L\x10\xb1\x8dJȝ\xa4\xa6#\x94\x10d\x85\xa1?.\xd899\xcdW?\xfavl\x913\xe8\xadqޮ\xb3\x98I+IP\x1c\xd3\x1ff\x1c;\xb9J\xe9$^x\xd6\xfb\x03n\xbf\xfb^\xb6.LQ\xaf\xa5\b\x93\xe1d\xc8\x03\xda:d\x1cW\xa2\b\xc0\x05!\xb65E\xd0z\x95%\x8e\x10\xaa\x10\x82\xb2(\x10J\x87\x15\x89\xc7\xf9\x12\xe7݉\xfe\xfa\xf2!\xc9\xc49K\xbd{\xdfy\"s\xd7\u007f\xf4\xad\xaf\xe0\xa7\u007f\xff\x83g\x06 \x9b\x04c\x9a\u07b9m\xf7y\x9c<\xbc\x9f\xba\x94\f\x8a\x02)aTZ\xb4+\x18\x98\x04\xad#\xd2(\xc6y\x83u\x92H\xc7\x1c\xbc\xfd\x06\xb6L5\xb8\xf7t\xceR\xaf\xc7ᵿ\xe6\xe5/x&ӝ\x0e\xf7\x1d<\xca\xfd\x0f\x1e\xe5֥!\xdf>\xde\xc78\xcf\xde\xe9:W\xef\xea0\x1c\x8cIt̸\xf4\xa4\xda\x06\x9d/\x0e\x97YT\x14:\x8f\xde9\xbc5\x01\fc\xaa\x1c\"\xf0hd\xa4ɻ9\x82\x12\xa1<\xc2\b\xa4\xd44[\x9d\xaf\xff\xc7\xcf\xef\xff\xa9\xfb\xbd\x17{C\x83\x1f>\xf3\xdd14{L\x01\x99\x9d\xdfr\xeb\xee\xbd\x17\xfa\x9b\xbf\xf2i\x91;\x89s\x9e\x01\x1e\x89's\x11N\x040\"\x1da\x1d$\xb1f\xfb\x8e\xb38r\xec!n|p\x8d<Ɨ\xdf\x00\x00\x19\x0fIDAT\xbb\x97\v\xa6Rɑ\aN\xd1\x1b\u007f\x99\xa7\x9e5\xc7r7\xe3\xf0ꀡ\xaa\xf3\x86\x1f<\x8f\xac4\x1c:\xbdF\xab\x1d\x91YG?\x0f\x03\x9d!\\\x95Uޑ\x98\xa2\b\tۻ\xb0\xf9s\xbej\t\x87\xefW]\xdb`Pc\n<\xc1\x9fQE\r\xe2z\xe3\xd8\xed\xb7\x1c\xd8\x04\xe3O^\xf7\x13\xbc\xe1\xfaO\x9dy\x804&[\xc3[\xbf\xf6\xb5\xbb\x92Z\xeb\xd2Q6\xa0.\x82\x0e7q\x92܅$^\x8b4B\xc7D\xb2F\xad1\xc3l{\a\x93[σ\xfbn\xa3\x13\x1f\xa5\x95H\x14\x82\xe5\xf5\x01\x83a\x8d\xd2(&\x1a\x9ak\xaf\xb8\x98$\xd2\b\xe0\xe2m3\x8c\xc6cL^`\x9dGU=s\xad#p\x0e+\x82\x8d,\xcemNm9\xef\x11>\xa8\xe2|Y\xa2\x95\f\"9!\xf0\xd6⪜Rkw\xa8ON\xf5\aK\xfb6\xdf\xd7w\v\x8cǼ\xca\n\x94\x84\xbb9Jj\xe4\xa5el\xc0z(\xbd\xc0\"\xa9E\x12-\x05BiT\xda&n\xcc\x02\x8efk\x82g\\\xf9\x1c\x16\xa7&\xd1\"X\xf7\xcdwbNt\x87\xe46c\xfb\xe2\x0e\xd2\n\f\x00\xadt\xb0\xed\xab\u05c8\xa2\xb8r\xb7\x0e2!G\xb8\xc0e\x9e\x11\xa4\x93\x12]k\xa0\xa3$\x84+\xe7\x03\x05c\f\u008b\x90\xd07\xdc!\x84\xa795K\xbd3ٱ\xce|oZ\xdd<\xf6\x8f\xbbj\x8d&\xab\xa7\x1c#[\xcdk\xf8  \xa8\xebJ\xe5.\x14Qm\x12\xa9\x920\u007f\xee,\xa5/iNoa4\xee\xe1\x9d\xc5K\x89\xf5\x92sv\x9d\xc3\xe2\x96y\xb2\xbc\xa04n\xf3\x97DJ\a' \xa5Be\xe5*\xef+\xe7PZ\xe3LIV\x86\x89ZA\xf8\x19Wd\xb8\x8aH\x14V#d\x817&\x88\xad\xad\xc5ZC\x9c$\xd4Z\x13k\xce\xf3\xc4\x00$Iӻ\xeb\x8dfգ\x96\x94&\xa8\x0e\x13%\xaa\t)\x89\x8cR\xa4\n\x03\xfa\xc6[\x06E\x0f\xef<\x9d\x85m4\xa6f\xc9G=\xfa\xab\xa7Pv\xcc\xc2\xfc,I\xa4\x19\x8d\xf3\xff\x8a\x8e\x11\x95#\x9d\xf4\xb2r{p8*{\xf1j\xb6\xc1f\x19\xae(\xc2H\xdaF\xafc\xa3\x87\xee\xd8\\\x19\b\x8f\xf5\x16\xef\x1cq\xb3Ekz\xe6pRo\xf8'\x04 \xdf\xf9\xfa\x8d+i\xa3\x85T\x1a\xe32\xac\xf3xU\x8d\x10Xp\xb2\x9a\xeb\x90a\xd5\x14e\x0evc\x1eD\x10'\x8a8iМ\x9cg\xbc|\x8c\xb24dBP\x9aj`\x14_\x19\al(\xd3\xc1\xc9@\xd1\xf8\x8a\x9f2\xc6l\xec\t\xc3\x0e\x9dJ\xc1\x02\xd5(\xdd\x06\"a\x904\xc8H\x1d:\x8eI[\x13H!\x0e\xe3\x9e +\xe4\x8e[\xbe\xbd\xbc\xb0m1̣\xaf\xaf\"#G,\xc19OfL0\x85\xb1\xc1;7w#JS\xe0\x9dg<\\c\xb8\xbeL\u007f\xf5\x14\xd2;\x92\xb4N\xa3\xd1\xe0\xc1\x87N\x80\x93\f\x86\xe3\xaa\xd9$\xa9\xd7\x13Z\xed\x94Z-&I\x823\x9c\xb3%\xae\xcc+Z$\xc8R\x85\x8e\xf1\xb6\"h}H\xda\xc6٪wn\x91\xc2c}0q.\vC\xa3ա61\xb32\xe8uO\xec\xbd\xf8\xa2'\x06 Kǎ\x9cܶ\xf3\xac\xa5Z\xa3\xb9\xc02\x8c\x8b\x92\x9a\x94DH2\xeb\xa8{\x8f\xcd\a\x14\xe5\x18##\x9c\xb5\x9c<\xfc\x00맏\xe1L\xb99=;\x1a\xf5X_O\x98hl\tGQ\b\x81\x17\xc1\xe4\xb2\xdb\x1d\xb2\xde\x1d\x12W^\xbeӳm\x9a\xcd:Z)F\x83\x01\xd9h@\x9c\xa4P\xe9\xac\u0088IX\x99\xce{\"!\xb1\xcea\tv\x1bY\x1en\x8a\xe6\xf4\x1c*J\xf6\xc5͉\x13߳\xb6\xd1c\t\xc6[_\xf6\x12>\xfa\xd5oۉɩo5Z\x1d\xe2\xb8Fn<ݱ\xa1t\x96\xfe8\xa7\x9f\x178S`\x86\xcb\b)Y]z\x98ީ\xa3( \x8e#\xb4\xd6(%Ȍ\xc0;\x81\x94\xa1\x05[\x18\xc30\xcb\xe9\r\xc7\x14e\x89\xf3\x8e\xc1(cem\xc0\xc1\x87\x96\xf8\xfa?\xef\xe3\x96\xfb\x0e\x91\x8dF\xf4\xbb]\x06\xeb\xeb\x14\xe31\xd6\x18\xac\xb7\x94\xd6\x06\xc21\xb0\xf1\b\x1f\xc8\xcf\xd2Z\ncAi&\x17\xb6\x936[\xb7\x9f}\xd6\xf6\xe5\xef\xd55zLW\xc8\xef\u007f\xf8\x13\xa1727\xf7\xb9\xa9\xb9\xf9\x9f8y\xec \xa3A\x97Qi\xe9\xe7 \x8cc\x9c\x95\x94m\xcb\x14\xab\xf4\x87%\xb7\u07fd\x8fZ\x1cἤ\xb0\x02\xebT0ZF0\xee\xe6\xb4\a]T5\xb1\xebq(!)\xbc\v\xb9\xa5,\xc3(\xb4V\xa4I\x8d\x8bwv\x18\x8dGXg\x19\x8f\x87X\x97\x10k\x1d\x1cM\xbd\xc7W\xe3s\xd6\x04\x13\xe7\xdcX\x06yNn\x1c\v\xf3\xd34g\xb6\x8cv_\xf6\x8c\xcf\x00\xdc\xf6\xcdo\xf3\xe4\xa7]\xf5\x84({\xb1e~S\xbd^\xefOLͶVO\x1f\xc7\x19\xcf\u0604A?e,\xc7V\x06\x18\xeb(\xcc*\x85\x97\\\xb9\xe3\x02vNMS\x8f\x13\"\xadh\xa75\xb6LM\x93F\x81\x932ƒ\x95\x05\xe3,'7\x95\xbd\xab3d\xa6\xe4Pw\x85\aWNR\xda\x02\xe9-e\x9e\x87\xf9\xc3j\x00'\xcbs\xa2H\xa3\xa5\xc2\xe1)\x8cEKEi\f\x85\xb5\xe4YI\x1ck&\xe7\xe6\xd9u\xd9ӏ\xcf\xccv>\a|O\xc0\xf8\xbe\x00rˍ7q\xf2\xd0\xfe\xd3I\x92\xdcמ\x9c\xbdR\xca0\xc0\x99\x95\x06]\x8d\x15\x14\xcer\xb2;\xa2\x96(\x9auϽ'\xf6\x93h\xc1\x05[\xb6W\x9b?A\xa4#\x94Va\xcf\xe0\\\x98\r\x91\x82X+\xa4\x17\x94\x12\xeeZ:\xc2=\xc7\xf6\x93e\xa3\xe0\xea\x10)\x9a\xb5\xe0n-\b\a\xbf\xe0 \xb79\"M)\x9d\xa5(,FZ\x8a\xd2\xe0m\x18Wk\xb7Z\xec\xb8\xe8\n\xba\xa5\xba\xa3:p\x8c'D\xc8\x02\xb8\xfc\xd9\xcf\x048\xfd\xd1?\xfe\x83\xbf:\xbd\xb4t\xd9\xc4Ԃ>\xbdt\bK\xe5T\xaaCc\xc8ZK6\xb4X\xe7\xe9\x95C\xbe|\xe0\x0e\xba\xe3>\x17\xcc\xef \xd6\x11+\xfd.\xb5$\xa5QK\xd1:(؋Ah\xfb\x1e\x1b\xf6\xf8\xc2}\xb7\xb2\xdc=M+RDR\xa2E5\u007fU\xd9t\x84Q4\xf0\xc2\xe3\xad#\xcfrl%\xf7q&\xd0\xf0\x06O\xbd\xd1`jf\x96\xc9\xf3\xae\xe4\x8b_\xfa\xc2\xe7\xbf\xe7\x8d<\xbeO\x8f\xa5\xfb\xef\xad\u007f\xecC\u007f\xfe\x8d\x03\xf7\xdfs\xe9\x81{n\t\x061\x80\x14Aٮ\x9c\xdb\xf49\xb1.\xd8\xf8\xe5\xd6!\xbc`\xa19\xc1bs\x8a\xf9\x89I\x12\xad)LA/\x1b\xb1>\x1es|}\x85\x13\xfd5&ӄ\x99ZB\xac$Z\bt\x18\xc2E+\x89\xd6\x12\xad$J\x86Q\x83\xe06\xaa\xf0RT\xc7Y@\xe9<Rif\xe7f\xd8y\xc5\xf38\xb0\x92s\xed\x1b\xae\xbb\xee\xecsw\xff\xf1\x85g\x9f\xcd=\x0f=\xf4\x84\xa1N\xf8\xdb\xf7\xfd)\v{/\x18}\xf2\xcf\xde\xf3[+˧?59=\xcfʩ\xa3l\xca\xccE\xe8k\b\uf408\xa0\x9b\U00081175\x1e\x96\x86]\x8etW\xb1\x87\x823\x0f\x95\xbdx\xac%5-\x99k֙\x8c\x83\a\xbc\x16\x95\xe6\xaa\xda\xc5[\xef\x88*\x03f\xe3,\x12\x89\xa5r\xae\xb6\x02\xe7\xc0\t\x88ℴ\x9e2w\xf6^n\xdf\u007f\x8c\x1bo\xfc\x1a\xc6\xf9K\x00\xee\xba\u007f?2\xfe\xde8[\xc9\xef\a E\x16\xf4d/~\xcd\x1b\xff\xae5\xd1\xfe\xf8\xd4\xfc\":nlxń3D\xaa\x81\x1c-!\x12\x92D\x82\xf6\x1e\x8d#Q\x92Z\xa2\xa9\xa7\x11q\"\xa9\xa5\x8a\xc9fB'\xd6t\"E\xbb\xe2\xc4\xc4\u007f\xbb\xb7\x8f\xd8p\x18\x92\x02\xe3\x1d\xce{\x8c\xf7\x18\xeb\xb0ޓ\xd4j\xc4i\xc4ֳ\xf6`f\xf7\xf2\xc5/|\x89</\xf8\x91\x17\xbd\xf8G\xbc\xf7\xcd\xef\x15\x18\xdf7@^\xfa\xe6\xb7\xf2wo\v\x87l\rz\xfd_\x9c\x9c\x9e[\xedL\xcf\x06)\xa7\x10\b\x1f^؆\xa3\x82\x14\x90\bA+\x92\xd4\x04Լ\xa1\xa5`\xa1\x91\xb2\xb3\xd3b\xc7D\x8b\x1d\xed\x16ۦ&\x98i\xb7\xa9\xd7\x1bDq\x8c\xd0\xd1f\xa7\xb02y \xd2\xc1\x9f\xc3!1\x16\xac\v2,c\x03\xff\xd5l\xb7\xa8\xd5Rv_r\x15\xe9\xf9\xcf\xe1\xedo\xfb\xbf)ʒ\x85\x85\x05v\xed\xde3{\xe2\xc8\xf1\xd7>!s\b\xc0o\xbf\xe95\xe8|,h\xb4\u07bc\xb6\xb2\xfc\xce\x03\xf7\xdc\xcah\xb4\x8e1\x86\x04G\xec\fF\x86\xbb=\x11\x82\xb426V:&M\x12\x84\x10()B\xef\xc2\xd9\xca\xf1GⅦ(\x8b\xaa\xcfAp\x90\xf3\x8eD\t\x92$\x80n\xbd\xc0V\x84b\x1cEDq\x1c\xba\x95\xf5\x1a\xdb/}:\x0f\xac\x18\xfe\xfa\xaf>B\x9c$4k5\xf6\xec9\x87W^w\x1dOy泿=\x1c\xf4\xaeٱk\xd7\xda\x13\x0e\x90\x8d\xc7\xf5\xff鷧Wז\xff\xe1\xe8\xc1\xfdO=\xf2\xd0\xfd\x14E\x86p\x96$\xef\xe1d\x84\xf3\x10U\x80\xa4i\r\xa5\"jqB\x92h\x84wX[\x06\x0fw)q>\xf4S\x9c\xf3\x14E\x86)\x82\x88\xc29\xa8)\x88\"\x8d\xadΠrB\x90\xa6)q\x12\x81wlݹ\x9bQk\a_\xfd\xf6\xed<\xb8\u007f?\x91\xd6L\xb6'\x98\x98h\xd3\xe9Lq\xc1\xc5\x17\xf2\xea_\xfb\r\x9c\xe35\v[\xe7\xdfw\xfc\xf8q\xb6n\xdd\xfa\xc4\x03\x04\xe0\xfd\xbf\xff\xf6+N\x1e;tӁ{n\x8f\x97O\x1dE (\x87\xab\xa4U\xbf;\xd6\x118K\xad\xde$֒XK\x94\xf7\xa8(\xc2\xfb\xe0\xbbe7\x8c˄\xae\x84\t\x0eSd\x18k(\xad'\xd1A\xe3k\xaa\x83d\x1a\x8dF\xc5 \xa7L\xed\xbe\x88;\x8e\xf4\xf8\xfa\u05fe\x16L\x9ck)\v\xf3\v\xa4i\r)%\x8dF\x8bV\xbbū\xdf\xfa\xcb,lߵf\x9cپu\xeb\x96\xff\xae\xb8\xfa\xbcs\xcf\xe5\x81}\xfb\xce\\@\x00~\xef\xff\xf8\xbf\x8e,?x\u05f6\x93\xc7\x1e\xa6\xbf\xbe\x8a\xc93|>\xa4.\xa1\x16'D*\xc2yO\x12\xc7DJ \\I\x14Gx\xa0\xac,ȕ\f\xc2m!UHަ\xc4W\x86\xcaJ\x84\x92+IR\xd28\"JS|\xda\xe2\x94k\xf2\x8d[\xeeb\xd4\xef\x91$\t\x9df\x8dř\tڳ;7\x8f\xed\x13B\xa2#\xc5\xd5\u05fc\x80=\x17]\xcc\xfc\xe2οݺc\xdboZc[J+\xee\xbd\xfb\x9e\xb3\xf6\xef\xdfwU\xbf\xdf\u05edV\xeb\xf3/x\xe1\v\xbfQ\xaf\xd7{\u007f\xfd\xb1\x8f\xf1S/}\xe9\xe3\xbb\xec\xfd\x97\x8f\xf7\xfc\xf1{y\xe3\x9b_KTo\x1d\xbe\xf1\x96۶=\xfd\xb2K\x90B\xd0\xefu)\x84d\x9c\x0f\xd0eA\xa4#de\xd1\x14\xdc~,Yn\xc3\xc5R\x1ak\xed\xe6\xb1{κʀ\xcc\xe3\xbc!\x8dc\xa4VD\x91&\x8eb\xe2\xc9y\xfa\xb2\xc5m\xfb\x0eq\xf8\xe1\xfb\x90\xc23\xd1l0Ӯ15QGk\x8d\xf5~\xd35bán\xed\xf4in\xfb\xf67x\xf2\xb3\xd2\u007f\xff\xb9\xcf\u007f\xf6ߝ8z4N\xa3X>\xf0\xc0\xfd\xdcy\xf7]d\xd6q\xf5\xd5\xcf\xfb\xc5+\xae\xbc\xe2\xc3\xc0\xcf\xfd\u007f\x01\xe3q\x03\xc8\x1b\xdf\x1c\n\x97vg\xf2\x98q\x82O\xfc\xe3\r\xbc\xf8\x85\xcfG\bIמ©6\xfd|L9\xeaSS\x12!ZH\x14\xa0Q>\x18e\x06eH%^\xdf\xf8\xe8\x05Jk\xd2$&\x8e4J\x05\x89蒟`\xdf\xdd'X\xeb\xdeG\x91\xe7$Z1US\xb4ĐV{\x02)\x839\xe6\xa6M]\xb5\xc3_ܱ\xc8'?\xf7Y\x1e\xda\u007f\x80\x89w\xbe\x8bQ\xbf\x9fn\x9cZm\xaa9\x92nnx\xc7]w\xd3\xeb\x0f~\xe4\xd4\xd2\xd2\x0f\xcd-,|\xfe\xe4ɓ\xcc\xcfϟ9\x80l<\x9c\xb5\xa7\xce>\xf7\x02n}\xe0S\\\xff\xf1\xbf姮\xf9!\xa6\xe6c\xba\xa7\x97\xf0*\n\xa7\x16\x94\x19ŸG#m\xa0\xa4\xa6\xf4\x82\xc8;\x94܀\xa3\xb2f\x92\x8az\xbd\x86\xd6\x1a\x90Dq\xc2j\xee\xb8\xf1\xaec\x8c\xf3#h\xa5\x88\x94`&\x15L$\x101$ntP:\xa9\x94\x8b\n\x9c\xc1ˈ\xb4\x9e\x12'\x8a\x8f\u007f\xfc\xa3\xf4\u05fbh\xa5\xe8\x0e\x1f\xf1ݗ2\xb8;H\x01\xed(B\x18˖\x86j\xed\xbf\xf7\xaeO\x9d<y\xf2?\x8c\x86\xfd?=|䈏u\x94/lY\xf0g\f \xe0\x97\x17\xb7\xef$\x06\xb4\xb3|\xfcӟᒽ\xe7\xb1}n\n\x8a!Z\xe4\xa0#rߢ\xf4\x96\xd8;\xb0\x0ee\xcb`y\xa1$\xb5$!\x8eb\xa2H\x11\xc7\tFŌ|\xc4\x03k\x19w?x\x04\xac#Ղf\xec餒H:\xa4\x1d!\xb5&\xaa\xb77\xc9K\xbc\xa5\xc83N\xaf\x9d\xe0\xf0\x91\x87\xe9\xf7zDQ\x18M0.\xb8ɵ\x9au\x9a\x8d\x94\xcb.\xb9\x88ՕSđ\xa2\xd5j\x04\xaem\xff\xed\xea\x03o\xbbC\xfd\xd0K\xae\xfd\x83=\x17=\xe9\x8d;\xf6\xec=X\xe4\xd9[\x80\xfbN\x9d:\xc5\xdc\xdc\xdc\x19\xb0B\x9c;653K+\x8e\xc3i\b\x12\x1eܷ\x8fC\x0fG4\xea\xf50\n\x17+:\xb1\xa7\x9ejl\x1c\x13E1\"N\xf1:\xcc\x03\xd6\x1b)\x91V,\xad\x0e8t\xf44'{\x19c\xaf\xe8\xf7\xfb$Zӈ\xa0\x13A;\xd5(\xb1a\v\xe8@\xaaM\xc2Q\bAw0\xe0\xa1\xe3\x87\xe8\x0f\x06\x00\xc4q\x8csaϲun\x8a\x99\xc9\x16[\xb7γ\xb8e\x9e\x1d\xdb\x16Y\xef\xf5\xa9\xd7kD:\"\xad\xd50\xa6\xe4\xf0\xe1#\xdc\xf6\xa5\xbf\xe7\xbe[n\xdas\xf9s\xffݞ˟\xf3\x82?Z^]\xfd\xb1\xdb>\xfb\xd9\xec\fY!\xdcUKS\xda\x13\x13\xac\xad.\xe3\xaa\x13\f\xb0\x96~\xaf\x8f\x10\xb0\x8e\xe0h\xa5\x06\xd1RPSPS\x02+\xc2`\u007fa\x05\xe3҅\xb3\xd7+%\x83\x92\x82Z\xa4i\xeb\x82V,\x89\x95\xc0\xb9\xca/\xb1\xb2\x0eT*\xf4V@\x90\x17%\a\x8f\x9fb0\x1co\x9e;e\x9dc\xfb\xd69~\xe0iOf\xdb\xe2Vv\xed\xdcA\xa3^\x0f\xf4\xbf\x14l\xd7Qe\x8e\x19oR4g\x9d{\x01\"\x8a8x`?7\xfc퇙\x9e\xdf\xf2\x83\xbb\xce9\xff\xe7~\xf0e/\xfb\xb3\xc7- \xf7\xff\xe3\x87\xd9\xfb\u0097\x01\xb0\xcd\x1fO\x1eL&\x99\x9d\x9b\xa7\xbb\xb2\x8c\x92\x10\xa9ph\x97\xc3\x13\v\xc1\xd0:Ԇ\xab\x82\xf7\fJ\xcfz\xf1\xc8\xe9:\x1b\xe6غ\x9a\xe2M#M-\x82\x94\x9cX\x86\x9f\xb3VQ\x9d\x9c\x87\xf0\xa0tDTkm^\xf8\xe3+\xab\xf4\x86㐑\xbc\xa7\xddj\xf0\xdcg^\xc9s\x9fu\x15S\x93\x93(\xad\xc39\xeeJam\xf0\xfe\x15Q\x82\x17\x9aA\x96!#M\xbd\xd1F\xd6jDI\x9d\xa7칔+~\xf4g鯯\xe1\xf0\xcf\x01\x1e\x9f\x80\xec\xff\xec\x9fqN\x05\xc6=\x9f\xfd\xd03O\x9f^\xf9\xd3(\xb7LLN!\xf0DJ\x92H\x19\xaa&\x19\xc6fj\xc1\x9a\a-E8h\xd8yT\xa5ZSR\xa0\x84@K\x89֚4\x8e\x88\xa5%\xf2\x19\x02\x1bT\xc4\xde!\xa4\u07bc\xf3\xc1\x914f\xd0Q\x02B\xb2\xd2\xedq\xf4\xd4\n\x02(\x8a\x92'_r>?\xfd\xa2\x1ff\u05cem\xc1\xa83\xa9\xb1\xb6>\xe0\xf4\xea\x12\xabk]\xd6\xd7\xd7Y_[\xa1\xb7\xb6\xca`\xbd\x1b\xcc\x05\xf0\xe8(\x0e\xb4Kg\x8a\x85\xed;9\xffIWr\xd5\xf3\xaeAG\xd5\x12\x02\x9eZ\x83o\x8d\x1f'\x80<\xf8\x95O\xb0\xfb\xea\x97l\xac\x92\xdf\x18\x8d\xb2_\xd5JNiQО\xe8\xe0\x11\xc1\a\xb1\xba\xd0և\xbb;R\x82\x8d\xa3\xf7\x94\x00\xa5\x04(_\xad\x9a \xb4\x8b\xb4F\xe9\x88T9\x94\x1b\au\x89\xdc8\xd8+t\f\xa5\b\xf6\xb1\xb5f\a\x95\x06Q^\u007f\x9c\xb1\xff\xe8\t\x9cu\x14E\xc9K~\xf2\x85\xfc\xf0\x0f>\x87z\xbdF\xad\xd1\xe2\xe0\xf1\x93\xdcq\xdbW9\xb5t\x82l<\n\x82m\xf1\x88)\xa7֚Hk\x84\f\x1e\x8d\x02O\u007f\xe54\xbd\xe5\x93\xf4\xd7Vx\xd23\x9e˰\xbb|\xffF9\xb8\x01\xc6W\xfe\xe1\xbfp\xf5\x8f\xfc\xe4\xf7g\xa7~\xe4\x1b\xdf`\xfbӟ\xbe\x01J\x13\xef?\x96g\xf9\x0f\x17\xc6\xf0\xb5\x1b\xff\x89}\xcb%\xfdR\xf3\x0f\u007f\xfdW4bͦ\afE\x99G\x95S\x83\xab&\x9d±\xab\xa1\xd9\xe47̓\x05\xa4Z\x90\x88,\xd0*\x95ݫ\x94\xb2\xda\xe5+\x92H\x91$5\x92\xe64RJ\nc\xf8\xce\x03\a1\xc6 \xa5\xe4\xb5\u05fe\x84\xa7]\xf9d\x10\x82Q\xe1\xf8\xd2W\xbe\xccұ#\xc8M3\xdfGՆ\x95s\x04\xc08/9\xb5\xdagi\xb5\xc7\xe9\xb5\x01g-tH\x84\xa07v\xfc\xfa\xef\xfe\x1eW\\u%H\xc5\xc1{n\xbfo~\xe7\xd9\xefO\x1b\xcd?\x9b\x9c\x9e\x19\xeb81\xb333\xfe1_!\xc5\xf8a\x00\x0e\xde\xf0\x89\xf3\xbc\xe7\xc3ƺ\xcbAp\xf3\xb7\xbe\xc1\xdf}\xea\x93\\v͵\x8c\xd6Gᤂ\xea\x94\x1c!\xc2\xf1\x11J\x85\x95!\x95\"\x962\xa8L\xa4\n\a\x1bWW\xc6yC,=\x9a\n\x8cG\xdduR\x85!\x9f8\xd2h\x1d\x91\xd4'\x10\x95\xea\xf1\xfeC\xc7ɋ\x82N\xbb\xc5Ͻ\xe4\xc7x\xe6Ӯ\xa07\x1cr\u07fe\x03\xdcv\xebwpƄ\xa9\xdeJ\x11i\x9dg0\xca\x19\x8cr\xd6\xfaC\xfa\xfd\x11\x83\xc1\x88\xa2\xb0\x9b\x87=$\n\x0e\x1f[!\x92\x82=\x8b\xb3\xdc\xfc\xd7\xef\xe3\x8e\xff\xf2\x01fw\xecbq\xf7\xf9\xe7\xa7i\xf2v9\xbf\xf8\xf6\x95\"\xbbq\xfb\xb9\x17}\xe2\xe8\xe1\xc3\u007f\xfb\x98\x02\xf2\xd0W>\xc1\xd9W\xbf\x84\x87\xbe\xf2\x89gy\xcf\a\x9ds\xbb\xa5\x94\xdcr˷\xf9\xca\x17>ˑu\xc7\x0f\xcc\xee`\xbd\xbf\x9fXkĆ^Ӈ\xd0\x14\xeb\b%%\x9b\x96\r\x95(B\x8a\xd0\xe1\x10\u0087^\x8a\x1b#\xfc\xa3\xe6\b\xab\x1c$\x85\b\x96\x1aZQkM!u\x8c\aN\xaev9\xb5\xb2\xc6\xce\xed[y\xcd\xcb\u007f\x8a\v/8\x8f\x87\x8f\x1c\xe7\x96\xdbn\xe5\xf8ѣ\xd5M\x114`\x00G\x97\xd6X^^\xa3;\x18S\xe6\x05\xa9\x84T\xc1|\x042~\xd4ʩ>6\x1au\xce\xdd9O\xbb\xddB\b\xc1\xfa\xa9\x93\xac\x9e8Ɲ7~\x81Fg\x8a\xf6\xf4\xec\xb3w_\xf2\x94g_\xf1\xc2\u007f\xff\xf3\x8f\x19 _\xff\xf0\x1fq\xf6\xd5/\xe1\xd0W\xff\xe69\u07b9/9甖\x8a\xdbn\xbf\x8d\xbf\xf9\xe4G9\xd6\xcd\xe9\xeb\x0e۶m\xe3\xe8\xc3\x0f\x12i\x851a\xeeP\vA\x14Ť\x91\xaa\x9aK\xc1\x9f7TTձE\xe1Xh\x84\xcfC\xce\xf0\x1e/\x1e\xed\\*\xd0Z\x91(I\xbd5\x8d\xd4\t\x1e\xcf8/8\xb1\xb2ƥ\x17\xed\xe5\xf5\xaf|)\x93\x9d\x0e\x0f\x1e;\xc9\u05ff~\x13\x83\xeez $\x01\xa5$G\x97V\xb9\xf7\xc1\xe34|\xc9D왖@\xed\xbf\x1f\xf7e\xb5\xba]\x99m\x9e:\x8d\x00\x1dGh\xc2q\xe5\xc5hĩ\xe1AN\x1f>\xc8\xc1{\xeex\xf2c\x06\xc83^\xf6\x16>\u007f\xfd\xef<\xf5\xe8\xd1c7\xac\xac\xaep\xce\xd9{\x18\f\xfa|\xe0\x03ד[\xb8\xf3\xe8\x80\xe7\xbd\xe0YXkhw:\xa4\xf5:\xa3\xde\xfa\xc6\f&\x89\x96\xe8*d\xc5J`-a\xc7\\\xe5\x16\xc1\x06\x18\x1b\xae\xa5<\xaa\x92\n\x8fHIj\xad\x0eR'\xe1\xf4Ь\xe0\xa1\xe3K\x9c\xb3\xe7l^\xf3\xf2\x17\xd3jwX)\xe0\xcb_\xfc\x02&\x1bo\x1a\t\xf4\x06c\xee|\xe0\b\xddވ\xc9\x18\xda\xf1\xa3J\xecʳ_G\xc1\xbb\xdeY\x87s\xb6\xfa\xe8+wm\xc7x<\xc6T\xfe\xf1\xd6\xd9`\xe0\xaf\x15J\xeb\xcd\xc3\x03N\x1e\xdc\xef\x1f\xb3Ӣ\xdf\xfdk\xaf\xde\xeb\xf0\x9f~\xf0\xc1\a\xdbeQ\xd2n\xb7\xf8Ї\xfe\x9c~\xbf\xc7\xfd'\x87\xace\x9e\xa7_u\x15\xf3\v[H\x92\x18S\x96\x9c>~4\xe4\x00!\xa8\xc5!\xfe\xd7\xe3@\xab\xc7JP\x86v \xda\xe5(\x9f\x85a\x9bG\x9f\"Y\x05\x0e)%\x8dZ\xca\xe4\xe4\fq\xd2\x04\x01\xab\xeb\x03\x0e\x1c=\xc13\x9fz\x05/\xff_~\x8c\xe9\xad\xdb\xd9w\xe4\x04_\xf8\xbbOb˼:\xb2Br\xf0\xc8in\xbd\xe7\x10\xae(\x98K\xa1\x19\x1a\x8e\xd4k1i,\xa8\xa5\x11I\xac\xd9s\xee\xb9\\z\xf9el߱\x9d\xad۶2\xb70\x8f֊\xfez\x8f\xb2\x84\xb5\xb5.\xddn\x17k\xc2I\xd9I\x12\x87\xf9F@(\x15\xce\xd8U\xca=&+\xe4w_\xf9\xe3*\x89\xa3\x9f\xe9\xae\xfd?\xb5\x9d\xcboTe\x18\xc6\u007f\xe7~\x9d\v\x9d\xb6Ci\xe9@[.\xe5R.J\x00\t\x1a\x13\tƕ\x89.\\`B\xe2\xc2\xc4\x18\r\xc6\xc4\xff\x82\xb80ƭ\x06\x17\x1a\xa3\x1b\xa3\xb0P#&\x8aх\x82\xd2\x0e`\ve:\xed\xd0δ\xc3\xdcΙ9\xf3\x1e\x17\xa7\x18\xff\x01\xbe\u007f\xe1\xcd\xf7\xbd\xef\xf3|\xef\xf3<\xebcQ\x14aY\x16\xc5\xe2\x1c\xe5r\x89f\xb7\xcfB5 \xefٔ\x8a\u007fq\xfc\xc4I\x8c\xb0\xc1\xf4\x81\x19\xd6\xca%\xee\x15o\x92\xf2=\fUE\xd3\x15L]G\xe2$,؈\x12\xc1\xa6\x15\x87\x84\xb1\n\xff\xd3\b<\xa2\x1a]\xdb\xc65u\xfc\xec\x10\xa6\xe5яa\xb5V\xa7\\\xad\xf1\xea+/rdf\x9a\xf4P\x9e\xb9\xf9E~\xfe\xeerb\xab\x11\x83\xa1k\\\x9f[d\xa1\xb4J\xc6\x00O\x83\\\xd6c\xac0\xce\xd8\xf8v<\xcf\xe1\xce\xecMj\x0f*\xa0@\xbdVa\xdb\xf6mL\xee\x9e\xfao3\xb2\xd5lR{\xb0J\xb5Zca\xfe.\xcb\xe5U\xaa\xd5\r\\\xd7\"\x9bɰ{\xefnL+!#u\xd3H\xb2\x15\x1fg!\xde\u007f\xeb\x1c\x97\u007f\xbd\xce\xcb\xcf?\x93\x8d\xfa\xfd\x0f\xbb\xddn\x16`\xdf\xf44?]\xfd\x81ju\x95\x1bK-\xc2^\x9f\x11[\xc17\x14\x0e\x9e|\x86\xa8\xd3\xc4\x1f̓\xc9\r\xd2\xeb4\xe8<\xdc\xc02tr)\x9b^$d<\v\xcf2\b\xba\xbd\xe4\xf7/N\xa4\x03\x8fhwM\x05\xd7\xd6\xc9x6[2)\xfcl\x1eMw\x88\x04\xcak5V\xd6\xeb\xbc~\xfe\x15\x8e\xce\xec\xc7t}:\x9aÕ/?'\xec\xb4\xff\xa3=\xae\xcf-r\xaf\xb4Ɛ\x03\xbe\x06\x93S\x05\x9e{\xe1\f\xe3;\xc6\xc9l\xc9\xe2y)F\xc7\vX\xaeK\xf5A\x85^\x18\xb0\xb6\xb2B67\x88\xed\xb8t\xc3\x10\x89\x04?\x9dbj\xcf\x1e\xf6\xcf\x1cd{a\x940\fX\xaf\xae\xd3h6)\x95\x96\xc8dҸ\xaeC?\xa1\xef\x1f\xef\rim\xbe\xb5a\x10\xbc\xa4\xaa\xea\x8e(\x8a(\x14\n\x10\v\xb7n\x17iu\x85r=`\xc8ֱ4\x95F\xed\x01A\xbbEԪ\xa3\xd8>#\xe3;h\x1d:F\xab^\xc7\xe8w\b\xba}b\x92~\xa2*\n#\x03)\xaa\x8d6A'@\x91\x1e\xae\x9e\xe0\f\xd7q\xb1-\a\xd3t\x885\x1b!َ\xffg\xa9B\xd8\xefs\xe1\x8d\xf3\xcc\xecۃ\xa0\xa0m\xd9\xca\x17\x1f}@\xbb\xd5\xd8̶\x8d\xb9y\xfb>+\xcbUF\xbd\x04|\x0e\x0f\x0fr\xea\xd9\xd3X\xa6\x990\x06\x8f&7Ea\xc7\xd4.\\\xcf\xe5\x8fk\xbf\xd0j\xd4\xf9\xf3\xb7_9}\xe6,\xa9T\x9a8\x8e\xf1S)\x06\x86\x86\xb0m\x9b\xc2\xc4N\x0e\x1c>D\xab\xd1\xe4\xf6\xdc-\x8a\xb3\xb3\x14g\xe7\x18\xc8嘜\x9aش4|L\xe7\xe2\x85s\xbcw\xf1\x12\x17\xdf>w$\x86\xcfD\xc4T\x14\x85\xa7O\x9d\xe2\x9bo\xbf\xe6\uef7b,n\xf4x\xd8\xee\xb1\xd55\xd0T\x05[W\x18\xcc\xe5\xf0-\x9d\xdeF\x05kp\x8c\xcc\xc0\x10Z,,.\xcc'\xaenj\x82\xe0}\xdb\xc0\xd45\x1c\xd3@\xd7\r<\xc7!\x9bʒ\xf6\xb38\xb6\x97$:+:\xb1\xaa\xd1\x0e#\xe6\x16\xcb\xf4$\xe6\xdd7_\xe3\xe0\xf4.\x04\xd0\xdc4_}\xfe\x19\x95\xe5\xa5\xc4SKU\xb9Q\xbcOe\xa5ʰ\x9bDT\xe8\xbaʁ#3\f\xe7\xf3\x9b8F\xddTb)\x9b\xa00\xc6O\xa7\xc9\r\xe7i6\x1e\xb2\xb6R&\xe8t\x98>|\x98^\xb7\x87\xa6\xaa\x84A@\xbb\xd5\"\x06FFG\x19\xc8\r2\xb1{\x8a'\x8e\x1f\xa30>\xc6\xf2R\x99ٿ\x8b\xf8)\xff\xf15\xf5+\u05ee\x03p\xf6\xc4\xcc'\xc0^\x11\xe9O\xec\xdc)\xa6\xa1˥O?\x16T]n\xad\xb4\xc4WUI\x9b\xaa\xa8\nb\x1b\xaad}G\xf2\x93\xd3\x12V\x16\xa4\x1f\xb4\xc4\xc9\x17\x04\x90j\xb9$\xf5z]|\xdb\x10\x89\x11MS\xc4\xd0T\xd1TEl\xd3\x14ǲ\xc5\xd0\r\x01$\x8a\"\xe9+\xa6ı*\xb5fG\x8a\x8b\xcbb\xbb\x8e\xbc\xf3\xc6y\xd95Q\x90\x18\xc4p<\xb9|\xf9\x8aܟ\xbf\xf3H\xbf&\xbfߘ\x97\xeaں\f;\x88\xae\"\x8a\x82\x98\x8e)\x9a\xa6\xca֑\x11\xd14M\x12\xbd[,\"\"\xaa\xaa\n q\x1c\x8b\xe58\xb2uۘ4\xeau\xa9,\x95\xa4\xddn\xcb\xfe\xa3OJ\x18\x86\x12v\x02\xe9\xf7\xfb\x12t\xdaRߨK\xbb\xdd\x12˶%\x93\xc9\xc8\xf6\xc9\t9v\xea)1\f#\xbe\xfa\xfd\x8fѿ=\x01\u007f\xd1\x1fӑ*\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package avatars
-
-var M_10_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14-\td?\x1b\xfc\x00\x00 \x00IDATx\xda\xec\xbdy\x90e\xe9Y\xde\xf9\xfb\xb6\xb3\xdc-o\xee\xb5\xefU\xbd\xa9K\xdd]\xbdHB\x02\t\xb1\x88\xb6\x8dA0\x80\x97068\xfc\x97=\x1e{\xc6\x133\x0e{l\x8f\x99\x8d\xb1\x8dm\x86\xb1\x19\x9b\bf0\f`\xe1\x05\xb0\xd0\x00B\x92A\xb4Z\xadVk\xe9V\xef\xd5KmYU\x99y\xf7{\xcf\xf2-\xf3\xc7w2\xab\x1a\x0161\x83ݍ\xfbD\xdc\xc8\xccʬ{\xef9\xefy\xb7\xe7y\xde\xf7\xc2\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7\xdb\xc7W\x1dG\xee|ǿ\xf3o\x1ex\xe4=o\x99\xf3\x11o\x857y\xe2\xbe\a1\xda\xf0\xe2\xe7\x1e\x8bF\xb8\xeb\x1d\xa4y\xdb\x00ZH%\x03A\xf9\xba\xeex\xe7\x96}\xf0\x9bI\x92\xf6\x85TdJ[!\xc4Wf5W\x85P\xc5\xcbO~\xc2\x03\x9cz\xe4C\\|\xfcco\x1b\xe4\xf7z\x9c~\xe0\x11\x84\x82\x97\x9ex<\xfe|\xff\xc3}!Ž\bq7p\u007f\xf0\xe1.!\xe5A\x848N\b\t\x80\x10\x02\x10\x84\x10\xf0\x16\xa4T\xb8\xc0\x97\x83P?)\x94\xf9\xb5W\x9e\xf8\x95'\xf6\x9e\xff컿\x99\x17\x1f\xfb\u007f\xde6ȿ\xcfq\xe6\xc1w\xf1\xd2\xe7>\x13\xbf\u007f\xf8\xe16N\xfcE!\xe5\xb7\a\xef\xcf\xd9\xda\xf6\xbc\xf7x\xe7\b\x01t\x92 \xa5A(\x85J2\x84J\x11B\x11\x10xg\xb1e\x81-\xe7\xa8$\xdf\x16*yLR\xfe\xf0\xc5'>\xf1+\x00\xc7\x1f\xfa\x10\xaf=\xf1\xb1\xb7\r\xf2\xbb\x1dg\x1fz7/>\x11\xc3ә\v\x8f\xfc9\x10\u007f[\b֊\xa2\x90\xb6\xaa\xf1\xce\xe3\xack\u07bd@*M\x92u\x91:!i/\x91t\xfa\b\x95!\xb4AHI\xf0\x8ej:b\xbe{\x05\xa942\xc9kA\xf8\x15B\xf8\xdeW\x1e\xff\xe8\xf6\xb9w?\xca\v\x8f}\xf4m\x83\xfcN\xc7ɇ\xdf#\xb4\xf7\xf7\x05\xf8\xc7\xde\xfb\x87m]SL\x17غ\xc2\xf9\x80@ \x94l\xbe*\xb4NHZ]T\xd2\x02 鮒\xb4W\x90\xda\xc4'\fD\xc3\x04O1\xbaA1ݍ\xffW*k\xa4\xf83\xbd~\xfb\x9f?\xf9K?S\xbe\x19\xce]\xbei\xf2ŅG8\xf3\xe0\xbb\x00\xd0!\xfc\xf9\x10\xc2o\x96\xf3\xf9\xc3\xe3\xdd\x01\x83\x1b\xdb\xcc&c\xaa\xaa$x\axD\x00\xa9\x14J'贅4y<!e\x10B\x82T\x84\x10\xe2]\xa7\xa21\x10\x82|\xf9\x00\x9d\xf5\x13謍w\xb5\xae}\xf8\x89\xf1\xa4\xfa\xb1w|\xe0;\xd7\x01\xce}\xdd\u007f\xf6\xb6\x87\x9c\xbe\xf0\b\x80x\xf9\xc9\xc7Ù\x87\xde\xfdW\\]\xff/\xd3\xe1H,\xe6s\x82\xf7\xb7ެ\x10 $\xaa1D\xd6\ue852\f\xa9\x12\x84\xd2 $:m\x91tV\x91&EH\x89\x10\x92\xe0\x03B\x8ax\xb6B\xc6Dok\xe6\xbbW\x99\xed\\%i/\xa1t\xfax\x92\x9a\x0f>\xff\x89\x9f\x9d\x9d\xf8\x9a\xef\xe0\xd5O\xff\xdc\xdb!\xeb\xf4\x85G\xfe\x96\xb3\xf6\xbf\x9b\xec\x0e(\x16\x8b\xaf~\xb3B \xa4BiM\x92vH\xdb=\x84TH\x93\x00\x12\x99\xb6PI\v\x9d\xe4\xe84G\xc8\x18\x00\x84\xd4xo\x91RC\x13\xe6\x82\xf7\x04g\x19_\xbfHp\x8e\xa4\xbb\x8a]L\x9f\"k=\xf2ʯ\xfd\xb3\xfa?\xd65P\xff\xb1=cp\xed\n\xa7\xef\u007f(_9t\xe4\xef:k\xff\xeb\xf1\xee Te)\xbe\xfaΉyC)\x83I2\xb4Ic\x82\xd6\n)\r*I\xf7s\x884\tʤH\x9d\xa0\x92\x1c\xa9\fJ'He\x90J\xc5\xdb0\x80P\x1a\x93u\x10:!\xedo`\xb2\xf6A_\x15\x1fho\x1e\xfd\xe8\xf8\xf2\x8b\xb3\v\xef\xfb&\xae\xbd\xfe\xf2\u007f\x1a\x069\xf3лy\xf9s\x9f\xe1\xe4}\x0f\xaa\x10\u008f{\xef\xff\xecxw@U\x96b/\xf6\xdfn\x0e)%R)\xb46H\xa3\xd1&\x8b\x89]\x19\x84\xd4較R\tRJt\x96#\xb5\x89\xa1L\x88\xfdĎ\x10\b\xa1\xa0\xf97!%R\x1at\xd6\x02)I{+H\xa5\x8f\xb9\xf9\xf4\xe1\xfe\xca\xea/\xbc67\x8b\xfa\xc6\u007fX\x83\xfcGK\xea\xe5|\xbf\xa8\xf9\xbfC\b\u007fl2\x1cQ\x95%\x81[Ƹe\x17\x81\x10\x02\xa5b\x19+\x9b\xaf\xf1\x02\v\xa461\xd7\b0Y+\xfe\xbd\xd4\xfb\x17\xfe\r9(\x9a\x17D<\xf5 bH\x93*AH\x83i\xf7\xc9V\x0e}\xad\xcc:?9{\xfa\x979\xfb\xd0\a\xff\xd3\xf0\x90\xf1\xcdk\x9c\xbc\xff\xe1\xff6x\xf7\x9f/fs\x16\xb3\x19\xb7{\xc6\u07b7B\b\x04\x02i4Ji\xa4\x94\x98$\x8b\xb9D\xea\xe8\t:\x8bޓ\xb5\x90:\xc1{\x87T\x1a!խ$\xb9\xf7|\x8d!\x91\"\xbe\x88h\xaa\xb5\xb4\x15\xc3\\\x92\xa1\xb4\xa1,\xab3\xcbk\xeb7_z\xe2W\x9f\x00x\xe8\x03\x1f\xe2\xea\xab/\xfd\xc1\xf3\x90\xb3Mi{\xf6\xa1\xf7|3\x84\xbf^\x95\x15\xf3\xc9\xe4\xb77\xc6m\xa1F\nM\xf0\x1e!U\xbc\xbb\x9b\x8aK4\x89Z\xea\x04!U,o\x01o+\\]`\xab\xc5\xfe\xc3٪9k\x89\xce\xda$\xdd\x15t\xdeE$-T\x92\x13\xf6\x9eG%\xb4֎@g\xe3\x87\xce\xdc\xff\xbe\xfb\x00\x06\xf3\xf0\a\xd3C6\x8e\x1fg\xe5б\x03\x10~\xcaVա\xf1\xee\x00\xef\xdco\xf9\xab\x80\x10\x82\x00H!\x90RD\x1bH\x852\x06\xa9S\x94\xd2\x11*\xd1)\xca$\xe8V/\x86\xa4}ϊ%\xefm\xf1j?\xbc\xa9$Ǵz\xc84\x867\x93wc\xa5&\x04\xca\x18t\x9e!\x10\xa0\x12U[{\xcf\xe1\xbe\xfe\xc8sO~\xba\xfa\x03i\x90\xed˗X=|\xf4\xbf\xf7\xde}\xebhw\x80\xad\xdeXa\x86\xa6\x13\u07fb\x1f\x85\x94\b)\x10M\xff\xa1u\xac\xa0\x84P\xb1\xe4\xd5\t&\xeb u\n{\xf6\b\x10\x82C*C >ߞg(\x93 M\x8aiuQ&A\xa59Bi\x84\xd4\b)H\xfb=L\xa7\r>\x10\xbc\xc7Y{|^\xbaѹc\x9b\x8f]\xb9\xfcz\xf8\x03\x13\xb2\xce<\xf8\xee\xe6\xeb{\x1e\x04\xfeR1\x9bS\x17\xe5o1\x86'\x84@\x10\x01Bhrr\x93\xe6\x1b\xdcJH\x15\xef\xfc\xe0\t\xdeE\x83)\r\xc4\xff\x8b\x0f\x04\x02\xce\xd6\xf1\xe7\xdb\v\x83\xb0\x87}\x99\x98{\x8ci\xf2G\x8aP\x8al\xb9\x8f\xca2t\x96\x91o\xae\x93\xf6\xfa\xb1\x1c\xee\xf4\xff\x87\xebuv\x16\xe0\xf8\xd7|\xe8\xado\x90\x13\xe7/\xf0R\xc3eH\xc9O8gYLc\x12\xbf\x95;Ḇ{=B\x03\xa3\xef\x85\x1e\xa9t\f]B\x10\x1a\xa4w/\x14yW\x11\xbc\x85\xe0\xf1\xae\x8eI(\x84\xf8={\x1dz4\xad\x90\n!u\x93\xa7\x04Rktj0\xad\fa4BI\x90\x02\x95\x18\xf2\xd5e\xd2n\x9fl\xed\xa8\"\xcd\u007f\b`\xe3h\xfb\xado\x90$M\xf7\xbc\xe4\xbf\x02\xee\xa8\x16%UU\xbd\xb1\xc4\xf5\xd1#\xf6\xe3\xbeh\x8c#@)\x8d\x12\x02\xa9\x12|\bxo\t\xc1\x13| X\x8b\xaf+\b\x81\xe0,\xdeǟCp\xd4Ŕ\xfd\xdav\xaf\xb9\x94\n\x1f<\xdeY\x90\x02\xa9\x15BKT\xa6\x91M\a/\x9aاҔ\xa4\xd5&\xebm\x92v\u05fe\xe5\xec\xd7\u007f\xf3\x87\x9e\xf8\xe9\x9f\xe3\xd4\xfb\x1f}k\x1b\xe4\x85'~\x93\xd3\x0f<\xb2*\x84\xf8^@\xcc&\xe37\x94\xa2\x847\xf6\t\xb7jU\x81\x1426\x85:Eh\x03\xc8\x06\xf6p\xd8b\x8a\xb3E\xbc\xb8\x82\xc6;<!\xd4\xf8\xba\xa4\x9a\xecRNv\xf1\xce\x12\x9c\x8bU\x96\x88\x1e\x13\x9c\x8d%\xb5\x10\x04\x173\r\x10;\u007f\xa3\xf6\xf3\x97\xca\x12d\x92\xa0\xb3\x15|\xd0\u007f\a\xe0\xe2'?ʩ\xf7\xff\xe1\xb7v\x0e\x11R~\xa3\x90\xe2\xceb>\xa7.\xabۻ\xbe\xfdR\xf5\xf6\n\x8b&\x15\v\x11\xf9\x0e\xa9t\xecG\x1acyo\x1b\x93\x05\xa4\x92\xe0,\xc1\xd7\x04[\xe2\xeb\x1a\xe7j\xa4RT\xf3\x01\xb3\xedK̆ר\xe6c\xbc\xad\x11!\x10\x9c\x8f\x17^J\xf0\xb7r\x8f4\x11ZQ\x89\xc6\xd65\xf5l\x814\x86|e\x93r\xe6\xcf\xdc\xfb\xed\x1f\xfe\x0e\x80\xa3\xf7\xad\xff\xbe\\'\xfd\x1f&\x99\xfb\x04\xf8\x06B\xd0\xd3Ѹ\xa9\x82Bc\x8c\xd0\xe4\n\xde\xd0Y\xc76#z\x87\xd8K,!\xe0\x01\x11<y\xbbC]W\xf8\xba\xa0\x9e\x05\x94R1ly\xc7^\x9d\x16\xf6\x01I\xd9\xdc\xff\x9ej>D&i,\xa9\x8dB\x18\x83\x00\x9c\xf7\xa84v\xf7R+ \xa0\xd3\x14\x95\xa6آ@'\x19\xf9\xd2\xc1\xb4\x98\xde\xfc\xa3\xdf\xff3\u007f\xf9_\xfe\xd8w\xff=\xff\x96\xf4\x90\x98\xcceW\b\xf1h1\x8f$\xd3\xed\xb9c?D\xbd\x01\xe6h\x92\xb9l\x12\x89w\xe0-\x02G\x9ejV\xd6\xd7q\xe5\x94\xd9\xce\x16ӝ-&7/S\xcd'\x10\\S\x14x\xf0\x1e\xb1\x17\x88B\xcc\v\x02\x81\xaf\n\xb01\x84\x05\xe7\xf61\xb2\xa4\x93\x91\xf4Z$\xbd\f\x95\x1b\x84V\xa8Ԑ\xad.\xa3\x8ci\xb0\xae5\x82\xd7\x0f>\xfd\xcb\u05cf\xbe\xa5\xfb\x90\x95CG\x1e\x81\xf0\xe7g\xa31\xb6\xaeoUV{\x04\xd2\xed\x9eAl\x04\xa5T\b)PJc\x8c\"3\x86n\xb7E\x9ahn^~\x85L\v\xde\xff\x81\a9\xff\xce{\xc94\xcc\xc7\x03\x84\xd6x\xef\x11b/\xc1;\x94TH%\xd0\xc6D\xd4W\x04l\xb5\x00$:\xedĒW\bdnbro%1\xd9\xcbX\x95\x85\xca\x11\x9c\xc7U\x15\xc1:\xbc\x1d\xaf\vi?\x9a\xad\x1f\xbd8\xfc}\x80R~_C֙\v\xef\xe2\xa5'?C\b\xfcqgk\xea\xbaz#D\xf2\xdb\xe6\x1a\xb1\xdfU\x1b\xad\xc9RC\xaf\xd7F\xe0\x99\x8c\xb6\xa9\xcb\x05w\xdey\x86?\xf5]_\xcf=w\x1c\xe2\xe2\xa5\x05\x83;\x8f0\x9dN\xb8z}\xc8`Z2Y\xd4\f\xc7s\xa6\x85eVA]{B=\xe7\xc4f\x9b3G\x96(˒_\xff\xe2\xd3\x14E\xc5\x06\xf7\x90--E\x83\xa4\x11\xe27+\x19vT \xad\a)\x91Z\xa2\x12\xd3t\xf2mlq\xfdCK\x1b\xdd_\xfd\x1dN\xe1\xcdOP\x9dz\xe0\xe1\xeb\xd5b\xb11\x19\f\xf1{͟\xf7\xfb1~?~6\x84\x92\x94\x12c\f\x9dvN\xbf\xd7b>\x1d\x11\\\xc9\xfdw\x1f\xe7\xf4\xe9c\xdcq\xef=\xdc\u007fn\x95\xe7^\x9d\xb3(\\ӿ\xf8F\xfc`q\xde3/,Um)k\xcb\xce\xee\bm\x12N\x9f8L\x9a\x1a\xa4T(Y\xf3\xc5\xe7.\xf1\x91O]a\xf5\xd4y\x0e\xdc\xf7 \xba\x9fc\xfa\x19f\xa5C\xf0\x9ejk\xcc\xe2Ƙz2\xa7\x9eL\x99]\xbfB9\xb9\x8e\x12W^\\:u\xe2\x8e\xed\xcf?\x1e\x9e\xfd\xb5O\xbf\xb5\x92\xfaه\xdes\xdc\xd6\xd5F]\xdb\xdf\xd6;b\xba\x16\xb7\x95\xbc\x02\xad5\xbdNN\x9e\n\xca\xe9\x0e\xf7\x9e9\xc4\xfd\xe7O\x91\xa6\x91d\xead\x06\xad\xc1\xb9\xd0D\xbd\x00A\xa0\x95D\xab\x84\x00\xe4Yl:\x85\x80\x93\x877pH|\xf3\xfa\xad\x14\xee>\xd9\xe5]︗\x0f\u007f\xf0\f\u007f\xf3G~\x9d\xd7>\xb5ő\xf7|\x13\xaa\x93\xe2k\x87\ue920\x15\x04\x90J\"M\xa4\x88\xf1\x02O8;\xf8\xf2\xe77\x9f\xfd\xb5Oo\xbde\x92zÓ\xe3\x9d\xfb#\xde{\xdcm\xe1j/\xa9\xdf\xea\xc6ٯ\xb2\x8cVt\xda\t\xb8\x82^\x16\xf8\xd0\xfb\xcf\xf3ȅ;PR\x91$\x86\xe3\xc7\x0fr\xec`\x1b)\x15kˑ\x80\x92R\xc6\x0e\x9b\xdfR.K\xd9и1\xc1k%\xe9\xb5\x15'\x0feh%\xa9\xadge)\xe3\x87\xff\xea7\xf2'\xdf\xd7E\\z\x86\xd9\xeb\xdb\xd8\xed)\xd5\xf51\xbe\xb2\xec\xbf9!#R\xa0\x14\xb6rd\xdd\u07b7\x03\xdc\xff\xcd\xdf\xf8\xd60\xc8\xcbO>\xbeg\x90o\v!|\x15\x88\xf8[QX\x00%\x15F\t\x8a\xf1\x90\xf3\xe76\xf9\xd0\a\xee\xe7\xc0\xc6\n\xce\x05N\x9e8\xc4\xc3\x0f\xdd\xc5=w\x1e\"5\xf0\x85/\xbc\xc8tt\x83~ۑ'\x81\xccx\xb2,\xc1h}\xabl\x16\x11\xbf\n!P\xce\xe7LG\x03\x8e\x1e\xcc\xe9\xb4\xcd^\xf3\x8e\xf7\x01\xef\x03\xdf\xf1\xc1\xb3\xfc\x95o]\"\xd9z\x9e\xc5\xcd1\x8b\xab#\xeaA#\xb2\b\x91>\x11B\"\xa5\xa6^8\xa4V\u007f\x16`\xfd\xe1so\xad\x1cr\xf4\x9e\xfb\xaexk\x0fM\x86Ø;\x00|\xc0\a\u007f[\x8fѠ\xba\xb6&\x91\x8e\x0f\xbe\xef\x1e\xce\xdfsb\xdf+\xce\xdf{\x9a\xf5\xf5\x1e\x00\x1f\xfb\x95'\xf8\x91\u007f\xf4\xaf\xd8\x1dL\xf7\xa8q\xd24\xa1\xd5\xcaɲ\x8c\xcd\xcd5\xd6\xd7W\x90J\xa0\x94B\b\xc1p8\xe1\xa5\x17_a\xfb\xe6\x0ek\xab+|Ƿ\xbd\x8fG\x1f}\x17k\xab\xed7\x84Q!\xe0\x8b/\r\xf9\xc1\x8f\x15\x1c8\xff\x00x\xf0\xc1\xe3\xea\x1a\xbf(\x98oߤع\xc6\xf0\xf2\x17\xd9<т\xe9|鳿\xf0\xb1\xf1[&\x87\x9c~\xe0]wT\xe5\xa2UU\xe5\xad3\xf6\xfe\xb7\xe9C$\xbe\xaeP\xc1\xf1\xbe\xf7\xdcŅw\x9e\xc6\xfb@\xbf\xdf\xe6\x81\xfb\xef I$O<\xf9<\u007f\xe3\a\xfeO._\xdb!\xd3\xfa\rŀ\xb5\v\xa6\xd39!\xc0\xab\xaf^\xc2\xfb\xf0Uԭ\x941\xe4\\\xbez\x9d\xbf\xf3\x0f\u007f\x9a\x1f\xfd\xb1\u007f\xcdw}\xe7\xd7\xf3\xed\xdf\xf6^\x0e\x1f\\A)I\b\x81c\x9b9\a\xf5e\xaa\xe9\x18\xdd\xe9A}\xdb\r\xd4p0\x04pUM\xd2m=\n\xfc\xf4\x9d\xf7\xdd\xcfs_x\xea\xcdۇ\x9c}\xf8kؽr\x89\xa5̓\xdf\x10\xbc\xfb\xcer>\xd7λۊ\xc4[9$v\xd1\x02[W<|\xdf)\xbe\xfe\xbd\xf7\xe0}\xe0\xe4\x89\x03<\xf2\xd0]l\xef\f\xf9\xe1\u007f\xf4\xaf\xf9\xc1\xbf\xf7\xb3,\xe6\x05\xb91\x11h\x94ot\xef=\\JJ\x89Ro|\xbc\xc1\x13E\xf4\x9c\xda:\x9ex\xeay>\xfe\xf1\xa7\x90Bp\xee\xec\x11\xb4V䩦\x97T|\xe5\xb2EtV\"\xa4b-\xbe\xac\xf0uI=\x9fP\x8eo\x10\xec\x9c$Oݕg\x9e\xfb\xc8\xf6\xd6֛\xdbC^\xfc\xec\xa7\x1b\x1a\xb5~0\xf8\x909\xe7b\x97\xbc\x87Y\x857FK!\x04\xbdN\xc6\xd7<t\x06\xe7\x1cw\x9c;\xc6\xf9{O\xf1\xb1_y\x82\x1f\xf8\x9f\u007f\x8a\xc1`L\xa2$\xa9\x12(\x11\xa1'\x1b$6\xf8\xdfS# \xf7\x00\x15!\xd0B \x8da8\x1c\xf3\x8f\xff\x8f\x9fg\xb9\xdf\xe1\x1b\xbf\xe1\x02ZK6V\x12\xb2\xe1\v\x14\xf9:\xb4:\r\x1cӼe\xe7 \ble\xa9\xe6\xe5\xbd\aZ\xab\xbd\xad\xf9\xce\xf8M\x9f\xd4\xcf\u007f\xfd7e\xde\xfb;mU\xe2\xac\xc3ߦ@\x8c\xd4lؿ\xab\xbdw\xac/\xb7\xc9SC\xaf\x9bq\xc7\x1dG\xf8\x99\x8f|\x8a\xbf\xf0\x97\u007f\x84\xc9p\x82\x11\x82\xb6\x16dR\xa2\xa4\"\b\x89V\x92\xd4h\xb4\xfc\xedOA\t\x11\x1fR\x92*E'1\xf4RC7MXJ\f\x1d\xa3\x91M\x05UT\x96\x1f\xf9G\xff\x8a\xab\xd7v\bH\xf2Ds\xfe\x88g\xbeu\x11?\x9b\xe1k\v!`g\x93\xc8\xe9#p\xd6\xe3\xbd_\xe9\x9c>t\xf6-Qe\x95\x8bŦs\xeeTU\xd5o\x88\xf7\xb7a\xba(\xa5\x90\x04p5\xf7\xdcq\x98,՜>u\x84\xf1d\xc1\x8f\xfe\x93_\xa4\x9dF\xe0\xcfH\x81\x96\n\x1a\x81\x83Q\nըQ\x8c\x92$J\x91jE\xcbh:IB7Mȍ\xa6e4]\xa3i\x1bM*%R\bLc(-%-\xad\x10\x02\xb4\x14\\\xb9\xb1\xcb?\xf8៣\xaekڭ\x9c\xf3wn\xe2w^\xc2\x15s\x82\xb3\x80\xc7We\x83\x8fI\xbc\vز^J\xd7VN\xbc%\f\xe2\xbd_\x03N:k\xbf\xfa\x97!4\u0085H\xc5\xf6ۆs'7\xe8\xb4R\x8e\x1d\xdf\xe4_\xfe\xebO\xb3\xb33ܯ\x80\xea\x00\v\xef)\x9c\xa3n\x1eR\b\x12\x19/p\xae\x14mcȌ&Q{\x9e!0M\x95\x15\xf6\xa3\xa4\xc0\x86@\xe1\x1cӺfa-\xb2\xa95\xf2\xc4\xf0\xe9\xdf|\x86\x9f\xf8\x89_\xa6\xd7i\xb1\xbe\xdc\xe1\xf8\xba\xa7ؽ\n\xde\x11\xea:\xd2\xc4\xde\"\xb5\xc1Y\x8fs>Wyr\xf4Mo\x90S\x0f>\x82\xab\xed)BȽsx\xe7~K}\x1d9\x8cx\xb7y\x8e\x1fYa\xad\xdf\xe6\xf8\x89\x83\f\x873>\xf1ɧ\xb0.\xe6\a\a\xb8\x10\xa8\x9d\xa7r\x8e\xd29*\xe7\x98U\x15\xd6{\x94\x94h-\x11\x02T\x80T\x04R\x19{\x8f\xaay])%6\x04\xa6UŴ\xaa(m\fAFH\x8c\x90\xa8\x86\xe5\x95J\xf0\v\xbf\xf0i\xbe\xf8\x85\x17X\xe9\xf7x\xe7\x99e\x8a\x9dK\b\xe7\xf0Ղ\xe0j\x82wH\xa9\xf1\xb5C\t0F\x1e4zE\xbe\xa9\r\"\x82\x10\xde\xfb\xfb\x9cu_U\xe2\xee\xf1\x1fR*\x82\xab\x11\xae\xe2]\x0f\x9c\xa1\xd7m\xb1\xbe\xb1\xca\xe7\xbf\xf0\"O>\xf5\"\xa9\x12t\xb5`\xd5(\xfaF\x91\xe9H\xe3\xde\x1e\xfe\x16\xd62o.n\vO&\x1cI\xf0\xa4\xc1a\x9aߏ\xab\x8aqQ0\xaf* \x90HA[+Nt\fw.\x19\xee\xea\x1bNu\x13zF\"\x81\xddь\xcf|\xf6Y&ӂS\x87{\xe4b\x82\x9b\xed\xe2\x8bY\xa4\x01\x82Ci\x8d\xf7\x91C1F\x1c\xbd\xf0G\x1fL\xdf\xdc}\x88\x94\x04k\xcf\xd5e\xd9\b\xdd\xf6A+\xbc\xf7h%\x11\"\xb0\x98N8{\xf2\x00w\x9e>ȡ#\x9b\fGS\xfe\xee\xdf\xff\bm\xad\xe8\xa9X\xaa\x06\xa9\xd0J!C\xc0(\x89\xf3\x01\xdb\x00\x94\x0ep>*L\xb2T\xd2\x15\x1e\xe9=NJ\x94\x938\x91Dn\\\xc6\nO\x10(\x9c\xc7\x01s\xebq^\xd01\x8a\xbe\x91\x8c+ϸ\xf6\xd4\xd6\xf2\xcc\xf3\x97\xb9vc\x00Br|M\xf0\xca\xf8\x06\xaa\xb5\n!\n+T\x92Ī\xd1z\b\xa1\xbd\xb4\x9a\xbf\xb9=D\n!\x04\x9csM\xb8\xba\x1d\x14\x10B\xa0\xb4\xc2U\x05uU\xf1އϑ\xb7r\xd6\xd6\xfa\xfc_\xff\xecW\xb8\xf4\xfau\xdaIL\xb6h\x83\x17q\x803&d\x89\n\x01\xe7=>\x80\x12\x12%%AH\x8ev3\x8em\xae\xf0-_\xfb\x00\x0f\xdfy\x82s\a\x96\x90\b\xb4\x8a\xd8֡\xe5%\xee<\xb8Ϊ\xf4dZ\x92\xe6mV6\xd6\x19ׁ\xeb\xa5c\xe6nQ47n\x8c\x98N\v\x84\x10\x1cYU\xf8\xc5.\x82\b\xa1\x88\x103\x92\xd2\t\xd6z\xb4\x11\xbd\xe5\x8dD\xbf\xa9\r\xe2\x9c\x13\x01\xce{\xef\xa2vjOX\x10\xf65\x06\xd8bA\xab\x95p\xfe\xeect{m&\xd3\x05\xbf\xf8\xd1\xc7\xe8f\x06#A(\x85\b\x9e\xf7?\xf2n\xbe\xe1k\xbe\x16Q{j\xa9x\xe0\xeew\xf0\xc1\a\x1f掓'q\xde#\x80\xc2\x05:Y\xca{/\xdcã_\xf7\x10\xdf\xfb\xe1\xaf\xe7\xc3\x1f|\x04%\xe3k&Z\xf0\xee\xbbNq\xfe\x8e3$\x04\x8cT\x9c;z\x94oz\xf8A\x96\xf2\x14#\x05\xb9\x96\xfb%\xf4p0\xa3\\X\xa4\x94\xac\xf53Z\f\xc0\xd7 <\xbe.\xc19\xa4N\xf1\xb5'\x84\xb0\xeeko\x8e\xdf{\xff\x9b\xd7 \xb3\xe1\xe0\xb0T\n|\xd8\xef\xc6\x1b\x10\v\xa5$\xdeY\xea\xb2\xe4\xfd﹛D+66\x96\xf9\xe8/=\x8et\x9e\xa5,\xf2\x15\xb9Q\x1ch\xa7\xec\u07b8±C\ai\xb5[\xb4\xa4d\xbeX\xf0\xf0\x85\v\xac\xaf\xac`\b\xe42z\x80\x96\xd0me\x18\xa3H\x13\xcd\xfaJ\x97,1\xb4S\xcd\xf1\x8e\xe6\xb5\xd7_\xa7\xd7isϝg9\x98+\xea\xc9\x0e\x8f=\xfe\x19Dp$J\xa3\xa4\xa2\x97&$Z\xb1\xbd\xbd\xcb\xcd\x1bC\x00\xfaKmz\xba\x80z\x86\x14\x02\xefk\xbc\xb7(\xa3\xf7\xb8\x9d#u\xe5\x93\u05fe\xfcԛ7\x87\xa4\xad\xd6q!o\x93\x83\x8a\xdbuQ\x02\xb7(\xd9\xd8\xe8\xf3\xc8\x03\xe7hwZT\xb5\xe33\x8f=\xc3R\x9e\x90)\x81\xf3\x81\xb3\x1b\xab\xbc\xe3\xf0:Ӳ\xe6\xeasOr\xff\xf1M\x86\xd3\x19\xed\x96\xe2\xe3\x1f\xffe\xae]~\x95{\xfa\x19\xa9\x96<;,\xa9k\xcbh4E\x8b\x88\xa9\x18)y\xe7\xf15\xdey\xfa\x10k+}\x9e\xf8\xe2\v|ⓟ\xc2\x18\x83\x160\x1c\x0e\xa3 N+,\x92\x8dVD\x91\xf2\xa9\xe6\xe2\xcd\x11\xff\xf6S\x9f\xe7\x1d\xf7\x1d#\xcfS\xfa\x1d\xc9\xf6x\x1b\x99\xac\x13\x82#x\xdf\x14%\x10\\XZ\x8c\xab\xfcM\x9d\xd4\xd3v\xa7e\xcb\xea\r\xac\xe0\x1eK\x88\x03[W\xbc\xf3\xee3\xf4\xda\x19\a\x0e\xad\xf3\xdcs\xafssk\x87<1h)8\xb1\xd4\xe2\xf4Z\a-\x05\xbd<\xa1\a\x84\x0e\xecj\xcf\xeex\xc0\np\xf6\xee\x93,wZ\x8c\xc6\x13\b[8\x17(\x8a\x92\xba\xae0\x89\xa2\x95j\xde{\xd7aN\x9c<Foy\x99;\xee8\xc5\xf6\xee\x88\xcb[ۈ\x00++=\x8c\u058c\xa6s\xbe\xf4\xdcE.]\xbd\x8e\xf3\x81\xa5\xdc0\xafk~\xfd7\xbf̟\xfa\xbeoB\nI\xbf\xdf\xc1^\xbb\x89\xcaכ*\xb2\x11\xd3!\t\x01f\xa3\xe2\x00\xf0\xf2\x9b\xd6 J\xebN\xb9XD}S3\r\x1b\x82o\x04$5\xfd^\x8b\xfb\xef9\xc9\xd2R\x9b45|\xe9K/Q\xcd\x17\xf4\x96:\x9c\xd9\xec\xb3\xde\xc9XO\rH(|\xac\xac\x94\x82͵e\x0e\xad\xaf\"\x88\xcfe\xad\xa3\xd3\xca9\xba\xbe\xc2`0\xc0\x87\x80\xb5\x01\x93\x88\x98;\x8c&\x88x\xe9\x92\xc4p\xe4\xd0\x06G\x0emP\xd55\xbe\t\xa7\x9b\xeb+(\t\xa3јEQ\xa0\xa5\xe4\xecz\x8fѬ\xe4\xd7>\xfe\x14\x8f>\xfa\x10(\x8d/\xa7\x18\xe1\xd1J\xe3Bh\xf4\xc4\xf1\xfc\x16\xf3\xea8\xf0\xe97\xadAB\b\xebuY\xc6Q\x02\xad\xf7\r\x82\x04_՜>w\x8c\xc3\a\x969pp\x8d\xc1`\xc2o|\xf2\x8b\xf4\xda\x19\x87\x96;\x9c\xda\xecs`\xb9\xcbF\x9eb\x85@$)ֺ\xd8(:Oe=y\x96\x10\x90\\\xbbz\x83:\xb1\xac-\xf7\x98\xcf&\x84\xe0\xb1\xd6\x11||M[[|\x83\xa39\xefH\xb4j\xa4\xa8a\x9fU\x14\"\xd0j\xe5\x9c<\xb2\xc9뗮\x81\b\xacw3\xd6z9\x9f\xfa\xd4\x17\xf9\x96?\xf4\x10\xbdv\x02\xbe$\x94\xb3\x86\xf7\x0f\xa8fN>\x04\xf0B\xae\xbf)\xab\xacs\xefz\xef\x9eENU\x8b\"\xea\x99\xf6Z\x10\x1f\xb5RI\xa2\xb9p\xfe$\xedNN\xde\xcax\xe2\xb3\xcf2\xd8\x1e\xd1og\x1c\xe8\xb7Y]\xea\xd0\xef\xb4X9\xb8\xc1\xe1\x93G9pp\x8d͍e\x0e\xae\xf79x`\x85\x93'\x0eq\xc7\xddgY][i\xaa\xac(\x1b2Za\xb4&I\x93(\xf9\x11\xb1\xe7\x19\xee\x0e\xa2\x91Bh\xbc\xc6`\x8cFk\x85l\xf2\\\x9a$l\xac.\xd3o'\xa4J\x91j\xc1Z'cww\xc2ŋ[,\xf7R\x8cV\xd4\xf3\x01\x88\xf0\x86\x19Ek=>\xa8\xc3\x00g\xde\xfdЛ\xc7 y\u007f\x95\x17>\xf3\x1b\xb1\xec\xad\xebo(\xa6\x13\x94\x8e3\x17{3\x82\x92\xc0\xe6z\x8fc\x87V\xe8.u\x90B\xf2\xb1\x8f>\xceR'\xa3\x9d&$\x89&5\x9a,Kh-ui\xf5\xba\xf4\xd6VY\xde\\\xa7\xbf\xb1\xca\xf2\xc6\x1a\x9b\xc7\x0e\x90v2&\xd3)\xcey\xbc\xf7\xccfs\x92$\xe1\xc0\xfa\n\xfd\xe5%\xb2\xbcM\xa7\xddf%O\xc0Y\xae]\xba\xc4|:\xa3,\n\x00z\x9d6\xedV\x8eѺ1f\xc4\xd42-1*\x8a%R\xadH\xb4\xe4ڕ\x1dک\xa2\x95\x1b\xdcbH\xb0\xb1\xe3\x8f\x10\x8a\xc2Y(\xcb\xfa\xdb\x00^z\xec\t\xce~\xe0\xfd\xbf\xff\x069\xf5\xaeo\xf8\x1d\u007fg\xb2H\xad.\x86; t\xef\xccC\xef\xf9\x1b\xf3\xc9\xe4~[WȽ\xf9@% x:\x9d\x84w\x9c;Lb4y\x9eq\xe5\xf26W.m\xd3J\r\xa9Qh!\xd0Z\xa1\x95\xc4Y\x8bw\x0e\xa5$:MȺ\x1d\xb2^\a\xa4\xa4.Kf\x93)\xce;jk\x99\xcd\xe7l\xae\xf5\xb8\xfb\xecqV\x0f\x1d\xe2\xe0\x89\x13l\x1e:L\xaf\xd3\xc5[GU\x96\xdcܺ\xced<f:\x9d\xe2\x03\x18c\xa2\x87\xf8@jT\xd4\xfc\n\x19\xc9/\x115ĩ\xd2\xccg\v\xa4\x14\xf4:)\xbe*\b\xb6\xd9&!\"\xfcc\x9d\x00)\xce\xde\xff]\xdf\xfa\xf8;\xbf\xebÇ^\xfc\xc4'\xff\xff5ȩ\x87\xdf\xcf\xd9\xf7\xbdQn\u007f\xf13\xbf\n\xc0\x9d\xef\xff\xd6Ωw}`\xf3\xec\xd7}h\xed\xefް\x12\xa0.\"7\xb3y\xee\u07bf\xd4][\xff%[W\u007f\xb3\x9c\xcf\"\x91#\xa2\x84\x86\x10P2pp\xbdK\x9ej\xaa\xda\xd2\xcaS\x9ez\xf2\x85\xa8V\a|\x88\x80\xa3j\xfa\x8aX\"\xcbf\xe8&ܚ\t\xf1\x9e\xd9h\xc2t2\xc3ZKQ\x96$\x89枳Gqͼ\x89T\x9a\xfe\xca\x1a\xfdn\x8f\x8d~\x1f-\x04UQ0\xd8\xdef\xb8\xbd\xc3x8\xa0\xae\xca8\xf7\xd9h\x86\xb5\x94\xb1\x19\x95b\xbfy\r!p}k\xb7a\x1e\x03\xc1\xd7\b\xe1c\x93h+\b\x1e\xe75\xe5\xb4dvc\xfba\xa5¯>\xf2g\xfe\xd8=\x00\x0f}\xefw\xff\u007fK\xea\xc7\xee{7\xaf\u007f\xe11.~\xf6\x96\x85\xefx\xff\x1f~D'\xe9\xfd\xce֏T\xd3\xf1;gÛ\x1buQ\xaa\xaa(\xc2\xdf~\xe0\xaez\xe3\xec\xf9*i\xb5\xbfR\x17\x8b\xa3ƨ\xf3\xe5|\xaalU\xe1\x1b\x19g\xf0\xbe\x19\xb0q\xb4\xf2\x14\xe7#\xd8(\xa5\xc0;ϗ\xbf\xfc2i\x12\x13\xb0\xf3\xb7ؿ\x10\xc0\xb9z\xbf\\\x86\xc8a\v)\xa8ʊ\xd7.^a\xb1\xa8\xa8\xaa\x9a\xda\xd6<|\xffݴ;=.]\xbbA\u007fy\x99CG\x0e\xf3\xdcs/\xf2\xe2\xa5k\xdcs\xe68\xadVƥ\x9b7\xf1ֲ\x98͑R`\xad\xa5\xdb\xeb\xd2\xce\x12\\U\x92eI\x14E49\xcf6\x84\xdal\xba \x84@j4\xc1\xd5\xe0\xea8\xda\xe0,\x02\xf0N\xe2\xacb\xf7\x95רf\xe5]kw\x9e\xfa\xd4\xc3\u007f\xfa{\xbe\xf5\xb3?\xfeӿy\xe1\x8f\u007f'O\xfe\xd4G~\xef\x06Y=vF.\x1d:\x9e\xbec\xf3Hۇ\xf0\xa86\xc9\xf7#\xc4\xd7\xdaŌɍ\xab̆\x03\xaabA]\x16\x11JotJ\xc5tD{e\xf5\x8c6) \xb0E\x11\xbbW\xe7p6v\xe3&\xcb\bΑ\xa6I\x9c鰖N\xaf\xcd\xd5+\xdbܸ\xbe\x1b\xb1\xa8\x10Eo1AF\xe0\xb0^\x14\xf8NDVmUR-\n\xcałݝ\x117\xb7\a\x00TUE\xaa\x15\x83\xc1\x90'>\xf7y\x16EA]\x96\xbc\xfe\xdae>\xfe\xc4\xd3\b\x01e\xedhg\x86+[[\x1c8\xb4\x11\xf9\xf4:e2\x9eR\x15\x05\xadN\a|@k\x1d'\x1b\x1b}X\xe5BS\x8c4\xea|\x9ai\xaf\x06\x82\x17D\f-\xdet\x1a|`z}H\b/\xad\xae\x9f;\xf5\xe9\v\u007f\xfc;\xff\xe4\x93?\xf5\x91\x9f\xfc={ȅ\x0f\u007f\xdf\xd7%Y\xeb}\xc5l\xf2-\xc1\x84\xf7x[S\x8ew\x19\\\xbb\xc4lw\x9br>\x8bw\xebm\xb3\xca{cf\xa6\xd5E)E9\x9b\"\xda\x1d\xa4N\b\xdeE\xd5G\b\xb8\xbaF\xa7)x\x8bQ\x12\xa5\x04΅\x06\x9e\x183\x99\x964\x88]C<y\xe6E\x8dk{\xbc\x0f\x14\xe31\xa2\xdbe>\x1aS\x96%eQr\xfd\xc6\x00k=J)z\xdd6W\xb7\xb6\xf8\xe4\x13\xd7P\xc0ZG\xf3я\xff:\a\x8e\x1cG)\xc5\xf3\xaf^\xe3s_~\x99s\xa7\x0es\xe6\xe8\n\xe5l\x16\xe3\u007f\xf0\xa4\xad6\xce\x19\x8a\xa2\x8c!\xd1V\xb1\xe1\v\xe0\x9c\xa7\xb0\x91GO\x12ӈ\xbfec\x88\x10\xd5\xf5\r\x14\x14\x9cC\xa8=TBR\xecι\xf9\x95\x97Y>s\xec'\x1e\xf8\x9e\x0f\xb7>\xff\xd3?\xffO\xde\xfdg\xbf\x9b\xc7\xfe鿟m\xf4\xc9w\xbe\xfb\xe7\xcb\xf9\xb47\xbcq\x85\x9d+\x17\xd9}\xf5E&\xdb\xd7)\xa6\x938\xb7\xb7'\U000fc746\r L\x9c\xb1(&c|\bL\x87\x15:oS\xce\xe6\xfb\xfa\x85\xa8X\xacQZ\"E,;\xd7VzlmmS\xccc\x98\x12!\x8a\xd5j穭cw\xba`\xad\x17\xf5R\xb6\xaa\x98\r\x87TeIUT\x8cFc\xbc\xb5\xa4Fㅢ\xd6\x197ɹ)\x03\xce9\x06\xa3\x1a\x13<n\xbc`VV\xbcz}H\xde\xce\x19\xa2)\x8a\x05B@\xedj\xaabA:\x9f\x91d\xf9\xfe\x82\xb3b:#S0\x95\x82\xca\a\x9c\x8f\xe5\xed\xf1\x13\a\xf0>VU\xae\xae\x1b\xd4\xd77\xe9W40\x8a&\xc9[\xa4\xed\x1eR\n\xeay\xc9\xf8\xf5\x9b\xa2\u007f\xea\xc0?x\xe8\xfb\xff\xd83\x8f\xfdӟ\xf8\xcd?\xf2W\xbe\x87_\xf8_\u007f\xfa\xdfm\x90\xaf|\xf2_\xf6\x94IX?y\x17\xfdͣ\x8c\xaf]f1\x19\xc7\x17\x15\x92\xdb\xe6\xcd\xf6\x13\x1dJ\xa2\xb3\x16B\b\xeab\x01R\"T\x1c7\xae\x8a9I\x1e\x97\xc0زĻ\x9a\x95\xd5>\x04\xc7\xc6\xea\n\x9b\xebKT\x95e>\xab\xf7C\x9f\v\x01\x1f\x02\U000f29a8,\xb3\xa2$_\x18\xb24E\x8a\x9ab^0\x1aM\xb8\xb2;\xe6\xcb\xd7&T^\xb0\xb0\x8e\xe9\xbc\xc0\xe3щA\x06\xcd<\xa4TA0\xda]\xd0\xceR\x0e\xddy\x8eՕeN\x9f9NaK^\xfdʗX1\x81\xd5NNV[Ҳ$I3\x9c\xadpU\x85\x92\x82N\xaa\u061d\x05\xd2V\x8a(\x17\x88\x10\x9b@o-u]\xef\x0f\x96J\x9d\"B\xc0[G\xba\xb4\x89\xd6\xd7\xf6\xef٬݅\xca3y};?t'\xff\xfc\xc3\u007f\xfdO\x9e\xfb\x17\u007f\xfb\x9f\xcd\xfe\xd0_\xfe\x1e\xfe\xcd\xdf\xfbݍ\xa2_\xfd\xc2\xe7h\xf5\xfb\f\xae\xbcFw\xfd\x10\xcbGOQ\xce\xc6\xec^ze_\xc4v;\xe7'\x95ƴ\x97PI\x169jW\xa3\x84\xc47\xdbu\xeaEI{i\x99\xd0\fV&Y\x8bDK\x8c\x86v\xa6)\x8b\x82\x13Ǐp\xf3\xfa\xebH-A)\xbc\xf0X\xeb\x99\x16\x15\xf3\xb2f{8\xa5\x9f\x18feMY\x14\xecN\xe6\\\x1d\xcd\xf8\xb7\xaf\f\xe8\xae\xf4IRC(\n\xb4\xb1(\xa9\xc8[9\xd3\xe9\x14\xb1(ȍ\xc6y\xcbѣ\a9p`\x83CG\x0eFm\x96R,o\x1e\xe0\xf2ŗ\xb9~\xed5\x96\x12OV[\x92\xa2$\xd1\n%%\x9dT\xb3;+\xe8\xaeor\xaa+\xb8\xf9\xb9\xa7\x19\f&\x04\xef1Z6\xfbT<\xb2\x99\xab\v\xc1\x83\xf3q\xdd\x13\x01\xa5M\xb3`-.9p\v\xc7\xf0\xda\xe8P\xa7'?\xf5_\xfc\xf0\x9f~\xe4\xef\xff\x85\x1fw\u007f\xe2\u007f\xfcn~\xf2\xaf\xfe\xcc\xefl\x10[[\x86\u05ee\xa1\x8da6\x1c\xd0Y?\xc0ʱ3\b\xa9ٹ\xf4\n\xc1[\x84\x88;\xa9t\xdaFe\xedF\xc0\x1c\x87b\xa46\xb1\x86W\x01\xa1\x14\xce\xfa\xe6\xf7\xf1M\xa7y\x8ew5Y\x92Q\x14\x05w\xdeq\x8ażb:\x9d\xefOI9\xd7p\xe6\xd61-JnJ\xc1Rjh\xa7\x86+\xbbc^\xd8]p\xbd\x96l\x1e=\x8c\x942\x8a\x1b\xba\x1d\x96\x96\x97hw;\fwvپ\xb9\x83R\x92\xd1\xee.\xb3iɑ\xa3\x87ɲ\x94\xc5\"\x12ai\x96\x92\xe7\x19\xc7Μe\xb1y\x90\xf9\xeeM._|\x8eN\xa2ȵ$3\x9a\xcc\x18^ݝr\xf2\x9estFs\x00F\xc3\u0600\xaafP\x14\x17\x85\x11\xceշ\xc8P!\xf0\xceQ\x15s\x8ciXJc \x04\x8a\x89g:,.lm\xcb\x1f\x04\xfe\xcb\xdf\xcd\x18\x00J\x99\xe4o\xd2T\x10\xe5|\xca|w\x87j6\xa1\xbbq\x88\xde\xc6a\x82\x90\xa4\xcb\aho\x1c!\b\xb9?'\x0e\x10\\\x8dR\x9a\xba.\xd1&\x8d\xe1\xc7Y\x94\x04k\xe3\xbcx\xd6J\xc9S\xc1\xdar\xceძ8kY]^\xe2嗯\xb1\xb5\xb5{[i\x1b\xf6\x05\tR\xc60vc<\xe3\x89\xcbcl{\x89N\xb7C\x9a\xa6,\xaf\xf49u\xea\x04GO\x1e\xa3\xd5i3\x1d\x8f\x99N\xc6\b\x01\xa3ш\x8b/\xbd\xc8x8fww\xc0\xf6\xf6\x0e7\xafocL\xc2p8\xa6\xac*\xb2,%\xeb\xb4X\xda\xd8@\xb7\xfbX\x95\xb1=\xaf]gm \x00\x00 \x00IDAT\xb8|s\xc0\xeb7\x87$\x9d\x1e\xef|\xf8=\\\xbd~\x93\x97/\xbeNo\xa9Ž\xe7O0\x9e\xccx\xf6\xa5-t\x92\xe1\x1c\xd4u3ū42\xed0\xdaz\x85b<\xc0\xb9\x9a\xf6\xd2j̛J#\x83\u0089\xc0ڡ\xec\xfc}\xdf|\xfe3_\xfa\xf8ӯ\xfc\xae!+v\x9d\xcd\xe65\x11\x91\xd9\xe9\xceM\xe6\xa3]\xba\x9bGX?q\x0e\x9d\xe6L\x86\x03\x16\x83\xed}\xf6\x8ff\x1d\x92of\xbf]]\x81\xb4\x98V\x8f\xd9\xe0\x06J\x9bf\xe3\x8e#˒8\xe7'\x04;ۻ\x9c;y\x82\xc5|\xf1\x06L\xa8\xf6\x81iY\x13\x8632\xa3xa\xba\xe0\xb9\x1bS\xee\xb8\xebL,I\xa5\xa2\xd3is\xf4\xc8aZ\xed\x16\xd7._\xe1\xc6\xd6\x16\xd3Ʉ\xb2(ٺz\x8d\xe1`\x80\x14\x02\x9d$\xecl\xefr\xe3\xc66\xae\xaa\xf9\xdc\xe3O\xa2\xa4d\xe3\xc0\x06w\x9f\xbf\x9b\xd5\xd5\x15\x0e\x1d9@\xab\xd3\xc5\x18C\xd6\xe9\xb2v\xf8(\xb3ɘ\xac\xbd\xd4̑\b|\x80\xb2\xb48\xe7\xe9u[\xe8\x06%@\xb7\x10\r\x03J\x13\x9a\xa5\x88\xb4s\xb5\x98SN\x87\xb4\x97VceF\xc0N\x03U\xe9\xdb.\x84?\xf7\xc1\xef\xffC\xbf\xf1\xb9_\xfaJ\xd5]6\\~\xe6\x85ߡ1\xf4\x1enӇ\xc4Y=\xc7\xf0\xf2\xabL\a;,\x1d:I\xb7\xbf\x8a_\x8e\xa5\xa3u6\x96\xb6B5\n\x12\x1d\x87\xf5]\x8dI\xdbT\x01l1\xa7\xd5\xe9D!\x9a\x10\xfb\x9b\x13\x94\f\x18\xad\x98Mg\x11\x92\x97*2qBP:K\xbd\xa8Y\\\x1bR[ω3\xa7Q\xda\xe0| \xcd\f\xdd^\x97^\u007f\x89\x8f\xfd\xfc\xbf\xe1\xe9\xcf?\x85\x94\x92n\xb7C\xaf\xd7\xe3\x81\v\x0fq\xf2\xcc\x19677pֱ;\x18\xb2u\xf5\n\xaf\xbd\xfa*O~\xf6I\x14PN\xa6<\xf5\xf8\x93\xdc\xfb\xc0y\x9c\xf7\x1c8\xb0N\x92$1$i\xc5R\u007f\x85\xbc\xd3\xe5ҕ-\xa4\x882%\xeb\x02u\xe5\xe8\xf52\x8c\x8e\xa1J'\x92\x9af\x96Q\b\x82\xf3\xfb\xad\x80\xb3\x15\x93\xc1M\xda\xfd\x95\xb8\x94\x80\xf8\xfbrf\xa9n\x16\xdfU\xec\x16?:\xba\xfa\xca'FW\xe1\a>\xfa\xd7\xf8k\x8f\xfe\xc0o\xf1\x90pK\xfcLsg\xa8\xa4\x85\xe9\xaePMw\xf1\xe5\x82\xc1\xabϱXZ\xa7w\xe0\b-\xad\xa9\x8a\x12g+\xca\xf9\x14W\x15\xb7I\xfa\x05n1Ŵ\x97\xb1\x93\x9b\x98$\xc1(\x8f\xb7\x16)SZy\x8afNf\fEYD\r\xa0\x8as\xe2R\b\x9c\x97\x04\xad\x98֖\xcd\xcd\r\xd2,\xc5ڀ֊V+\xe7\xe0\xa1\x03|\xfe\xf1\xcf\xf1\xd23_a\xad\xdb\xc6U%n<\xa2\xd5\xedp\xfd\xf5K\\{\xfd2IV\x93\xb6\xdb(\x952\x9b\xcc\xd1\xces\xe1\xcc\t\x0e\xad\xad\xb1\xbc\xd4\xe1\xf1/?\xcb\xf6\x8dm\xd2,%1\x86\x13\xa7\x8e\"\x1b\nW\x9b\x04\xa9\x14\xadVF\xdd\b\xfclmY\xccKZ\xbd\x8c奜\xebC\x8b\xc65\v\n\x04J\xe8\xb8U\x82\x80\xad\xe29\xd5U\xc9\xe0\xfa\x15\xd6\x0e\x1fG'y\x94\x04W\x82Ÿ\x16\xd5\xc2\xfe\xec\x87\xff\xd2w\xfc\xad\u007f\xf1C?\xf7\xbf\xfd\xb5G\u007f\x80\x0f\xff\xc5\xef\xe0_\xfc\x83[\v7\xb5P:ꍚ\x05\x93:o\x93\xad\x1c\xc6\xd5\x15\xa6\xbd\x84O۸ňb\xb8\x85\xb5%\xdd\xcdc\x98,EY\x15q\xa5Ŭ\xe1:\x048\x8f\xaf\x8b\xb8*I)\x8c\xd1\x18\xedX\xea\xb7H\x8df4\xd8\xe5\xec\xd1Uj\xebX\xcc+\xb2<#8\x8f\x17\x1e\t\x84&\u007fh\x04i\x9e\xee\x8b\"\x92$\xa1\xd7k\xe3\x9d\xe7\xa5g\x9e\x8e\x9a\xad<\xe5\xbe;N\xb2\xd4\xee`L\xce\xca\xf22w\xddy\f\xa9\x03Ӣd8\x9c\x92\xb7ڼx\xf1\x1a\xdeEBk2\x9d\x93\xf6:$\x9dNs\xa1\xe7\xd8\xda\xd2\xeeuIt\x12\xf9\x13\xef\x18\x8c\xc7\fG#\x10\x82\xb2\xacY,*\xdaݔ\x83\x9bK\\ݾ\x81\b\xbe\xc9y\xb1\xa9\r>\x8e\xeby\x17\xaf\xa3\x0f\x81\xc5d\xc4|4\xa0\xb7\xac\x91i\x8a\xb3\x9e\xbat\x8cw\xa6k\xc5t\xed\x87>\xf4}\xdf\xfa\xcdK+\xc9\xf7\xfd\xcc\xdf\xf9\xc8\xcds\xdf\xf8A\xceݵ\xca/\xfeßE\xae\x1c?\x87nu\xe2F\xb5\xacE\xbez\x04\xa4\xc2U\x8bxA\xb4\xc1tWѭ>v:d\xb2\xf5\x1aUY#\xa4$i\xb5H\xdbݦ\xfb\xbd%hpU\x1c\xb4\x97R\x90\xa5\x8a\xaa\xb4MG\xech\xb7\xdb\xd4eA\xed\x1cJ\x88\x18\xb6\x00\xef<\xbe\x8eJ\x0f%%R(\xaa\xda\x12\x80\x8d\xcdU\x8e\x1e?\xce\xf3_\xfa\x12\xa2\xaaI\x8df0]\xf0\xebϼ\xccK\xd7w)\xac\xa3\xdbI9}\xe2 \xe7N\x1c\xe3\xa1{\xee\xe0\xbd\x17\xee\xe5@\xafK7\xd1\xccf3\xbe\xf8\xfcK|\xec\xb1\xc7q\x04\x924\xee\xc62I\x9cG\x9442\xa3D\xa3\x8c\xe6\xe4\xb1#\x8d\xb2RP\x955u\xe5@\n\xfaK\x1d\xf0\x11\x81\xbe%8\x83`\xed-\x9a\xba!\xe3\xea\xaa`:\xd8Ƈxm\xac\x83b\x16Q\x8c\xf1N\xa9/\xbd0\xfe\xc3/??ݺ\xf0m\xdf\xf2\xdf\xf4z\xf9\xca/\xfeß\x8d\x1eROG,m\x1e\xc59K\xb5X\x80ԸŤI\xf2My\x1b\x022\xef`\x84\xa4\x9e\xee2v\x96\xce\xda\xe1XJ.\xad\xe0\xea\x92r1E\xca\xe85\xd8\ne\x04\xda(\x82\xafX[m\xb3\xb9\xd6C\x85\x9av\xa7\xc7l6\xa3\xac<\x99\n\xe4\xad\x1ck=UQ\x10\x80\xaa(\xe9t#\xcc\x1e5^\x92v\xbb\xcdl2a\xe7\xc6M\xb24\xa1\x9f\x1ajk\x19Lf|\xe9⫼tu\x8b\xa7^~\x99\xcf>\xfb\"\xbdv\v)\x05\xa3\xe9\x8c\xed\xc1\x98\x9b\xc3\x117\xc7#\x96\xda-\xbe\xf6\xfe\xbbX^\xeapyZ1\xb7\x16k\xeb\x06۲\xa4\xa9\xa0\xae\xea\xe6\x82\xc6;=\x10X,JʢF\vA\xbf\x1fG\x13\xf0\x16%\x88`\xa9\xf7 c\xc9\xff\x06\xd6\xd4{\x16\xb3I㝑\xa5\xac\xab\x1a\x11$UiIL\x8a\x9b\"\xa7\x8b\xf9\xff\x94\xb4\xcd\xf7\xdf\xf7G\x1f\xfd\xc1\xcdG\xee\xf9q=\x1b\x0f(f\x13\xb2n\x9f\xee\xfa\x01\xbcu\xd43\xbb\x0f\u007f\xdc.R\x90&\xc5tW\xb0\x8b\t\x93k\xaf\xe07\x8f\x93\xe79\xed\xd5\r\xdc\xf5\x1a[\x15H!\b\xc1\xa1U\x82\x16\x81\xa5^Nb\x14Y\x9e\x93\xa9\x94\xe5\x95U^{\xf9\"\x95\x03U[ڭ\x16\xd6VqTY\x1b\xaa\xa2\x88aK\xeb\xa6\xd2\tH)\xd9\xdd\xdde1\x9fc\xb4b\xad\x95\xd3mg\f\xc6S^\xber\x9diYry\xbb\xe2\xea`\x10\xdfp\b\x04\x11!\x99^\x9eq߹S\x9c<\xb8\xc1r\xb7Ӭx\x9a\xf1\xec\xced\xff\x86s\xae1\x8eT\x8c\x1b*\xb8\xd3\xce\xf7g\x10\x87\x83)ޯ\xd3\xcaSDh\x04\xd7B\xe1\xe3\x95G\xe9\x04\xa5\xb3[^\xd3\xf0@\x84Ь\x15l!D\xa0^T\r\x82\x119\xf9\xfeJF\xb8\x11\x98\x0efg\\a\xff\xf7\xad\xcf|\xe5OȤ\x15\xef\xc6\xf9h\x97\x9d\x8b_\xa1^L魮\x93\xa4y\xb3\x92bO\xc0\x03\x81\xf8\xb3\xca\xda\b!\x98]\u007f\x8d\xb2\x98#\xa5\xa6\xb3z\x10\xa5b\x82#\x04\x96\xfb=\x8c\x96$&nb\x98\xcf\x178\x1f\xe8tz\x8c\x86SB\x00\xeb,\xb6Yo\x11\x02hc\"\x04\xae\xd4\xfe\xb8\xb3\xf7\x9e\xf9|\xc1\xce\xf6`\xbfR\xab\x9b\x10\xd1\xef\xb69\xb4\xbe\xccr\xb7M\xaa5\xdezj\x0fu\x10x\"\xd1t\xfc\xc0:\xef8y\x8c\xe5n\xa7\x99\xa8\x8b\x8b\xfcg\x93Y\xdc\xe1\xbb/\xee\xf38\xe7\x18O\xa7\x14E\xc9\xc9\xe3\x87\xc9\xf2\x94@`0\x18㝣\x95\x1b\x8c\x8e\xfb\x1c\xa5\f\xcd\x125O\xd8\x1bw\xdb[-\x15\x02J\xeb\xc8\xf38\x87\x90q<\xbb\xae\xfc>.\x16\x02\x91\xadL\rRi\xec\xbc\xd6\xc5\xf5\xe9\xd7i\xa5\x14Rf8Y\xe1\xbdgz\xf3*\xc5x@gm\x93\xb4զ\\\xcc\"\xec\xbe\xc7K\xd8\xc8wĭn\x8e\xe9\xf5\xd7\xe9\xac\x1f\xc6d9\xed\xd5M\x16\xa3]\xdcbB\u007f\xa9C]\xcd\t\x04f\xf3\n\xa54\x85\x96\xa4Y\xcah2k\xdeX\xc0\xdaz\u007f\xbf\xaeR\n\xa9\xe2r1\xef#D\x11B`<\x9e\xa0\xb4!\xeb\xb4\tcG\xed=\xa3\xe9\x9cDK\xf2$ce\xadMZ{n\xcc-\xfd~\x97s\xe7N\xd0\xca\x12\xa8k\x16[ט\x945\xa6v$*\x1a\xe9\xda\xcd\x01\xc3\xf1\x94N\xa7u\xcb \xb6\xa6\xb6\x8eV\x9aQ\x94\x11\x01\xce\xf3\x8c\xd1h\xca\xce\xee\x94\xe0\x1dy\xaaX\xe9\xb7\x19\xce\v\x92NN\x15\xb5\xbdqǊ\xd2\rP\xe9\xf7\x89\xb5\xbdQ:\xa9=\xde\vl\xedPZ6\xe7n\xa3Q\x9b}`\x10p\x95EO\xb7\xaf\xc5\xd5wB \x94Aj\x8d-\x17\f\xaf\xbcJ\xd2\xe9\x91\xf7\xd7H\xb2\x9cr>\xa7^L\xf7sK\x88&\xc6{\xcf\xe4\xfa%\xd2\xee2Yo\x99\xb4\xdd#Ȁ1\x1aoC,'\x95\xc4\xe8\xb8o=IRn\xdc\x1cbkK\xaa%ue\xc9\xf2$\x0e\xe6\aPFc\xadmdC\x1aB\xa0,+ڭ\x9c\xfe\xda&S穧\x13켢V\x86\x85ɰ*!\xa4\x9a\x95\x9e\xe4\xae;Oq\xff\xf9\xb3\xb4Z9\xc1{^~\xfeE\xae\xbd~\x99r<\xc5\xcd\xe7Գ)3/8w\xf7\x1dt\xbb\x1d|\xc3\x16Vu\xcdt:\xa7\xd3\xed0\x99N\x99Φ\x9c=y\x8c\xcbWn\xb0\xb33F\x05\x8b6)\xeb\xeb=\xae?\xb7E\xdem\x16u6\xcbqT\x03\x99\xec\xed\xf8\xdaS\xf8K\xa50\x99\x8c\t\xbd\xb6\xd4>\x92s\xdeZ\x02\x81$\x89нR\x86r>E\xa7K\x1b\xc8f-\xb7\x9d\r\xa9\x17\xe3H\xbf*C1\x1aPM'd\xfd\x15\xf2\xa5ULb\x989\x8b-\xe6M^\xb9\xb5 \xbf\x18\xef\xe0\xbdǤ)Jk\x94\x12\x18-\xe8\xb4\xd2\xc8Q\x03\x1b\xab\xfd\bqL\xa2\xc7)\xa9X\xcc\xe6\x98$\x8d\xf3\x1dRc\xda\t\xd6Y\x9c\vh\x1d'H\xe6\xf3\x05\xadV\x8eIS\x92\xde2eQR9\xc7<\xef\xc7R=1\xb1\xb9\f0\x18M\x9a;>\xf6\x11\x87\x8e\x1d\xa1\xaej\x06ZS\xa4)\xaaۣ\xdf\xceY^[\xc5$\xbaA\xb0\xe3\xee\xde$M!@Q\xd4Lgs\xda\xed\x1cB`\xb0;C\vH\x13\xcdr/û\x9a\xe0k\xa4L\xf1\x84&d\x99\bx4\x93{Z7km\x91$-\x98^\xad\b\xb5\x8f\x9a.\x11\xab\xca\x10@\xe9[Zt\xa14Z%9B\x19\xb2\xe5Mԁ\x93\x94\xc3\xeb\xcco^\xc6\xce\xc7x<\xde)\xa67\xae2\x1fl\xd3Y;Ho\xf3\x10\xc5x\xc0b4ܯF\b\x01\xa9\fv1\xc1[\xcbjG\"\x05h%\xe8\xb6S\xc0\x93g\x8a\x13'\x8e!\x10\x8c&%\xa5\xb5\r\x1e\x04墈\xcb]t@(I\xa2\x12\xac\xb5h\x13\xa5:Ue\x99\x8c'8\xefq\xcaP\x9a\x9c`\xe2\n\x0e\xf6&le\x9c\xa0\xbd\xe7\xcc!\xd24\x89\x89=\x04\x924\xe5\xf0\xc9c,\xad,s\xed\xd2eꪢ\xdd\xe9\xa0u\xfcԄ\xbd\xb0Rב՜\xce\x16\x8c'3\xb2\xb4ŕ\xab\xdb\b)Y\x14\x15\xceB\xaa$\xeb\xcb\x1d\xbcs\xd4e\x810\xed\xdb&\xbb\xc5~U\xb8\xb7E\xd5{\x8b\x90\x01e\x02帊\x9b9\xac\xc7\xd6\x0e\xefb\xde\xe2\xb6\xd5 \x84\x80v\xc5\x14\x9dwqռ\xb1\x9c\xc5t\xfa\xa84\xa7\x9a\xec\xe2\xcaE\xb3\x91\xa1bt\xe5%\xd2\xde*\xad\xfe\x1a\xbd\xcd6\xf3\xd1\x0e\xd5|\xd6|$\x84#x\x8b\xafk\x96\x8f\x1eǻ\x8aĨ\xa8\"\x91\x82v+\xa3\xd5j\x11\xbc\xe3\xc6Δ\xc9l\x81 \xb0\xd6\xedP\x15\x05\x99\xd1\xd8:\xe6\xa68\x10\x13\"r\x8c%I\x04\xf3EAUV\xa4iJ\xa9\x12\x942MA#\x9a\x92SPV\x16\xa1\x93\xf8~\x9bFMiE\x9eedY\x86\x14\x82\xe1\xce\x0e\xc1G\xc61\xe6Ͻ\xbcUc\x1b\xc8eO^4\x1eO\xe3*\r\x0f\xd3i\xc9\xfa\xda\x12\xfd^F\x96i\x8a\xf9\x9cty\x05\xe7\xf7x\"\x1f\x87H\x95F\xe9\x98\x13\xd2V\x9b\xceJ\xc6l\xb7\xa2*\x16\xd8F\xd9b\xeb\xd8\xe5\xdb\xd2#\xf4\xadyd\x93dh\xd5h\x93$\x02W\xccp\xe5|χ1\x9de\x84NpŌ`+\x82\x80bx\x83z:\"\xe9\xf6\xc9{˘,g\xbe{\xf3\x16\xf4\x12\x02\xbd^\x8e+',\xf7s\x8e\x1cވ\x9e\x10\xe20\xe5\xcepʰ2\xa8L0Y,0R\xd1M\x93\x18_\xab\n\xa5\x15*\xa8Fm\xe8\xc1\xc6\xfdp2S1\xbf8\x87ɲ\x88\xa3\xed\xedL\x04\xb4\x8a^)\x9a\xbc\x16\xbc\xa7\xaeJ\x84Ȱu\x85\x90\x92v;\xa7*r\xbcu\xe8D7\x9bN%eY\xa2\x94d0\x18\xa1\xa4b:\x9d\xe2\xbc\xe7\xe2kWA'\x04\x0fW\xb7F\x9c9u\x80\xb5\xe5\x0e\x9dN\xca`T!q\xfb}Z\xac\xac\x14*\x89\b\x83\xab-Y\xbbG{\xd9p\xf5\x85\xddF\xcb\x15\x90\n\\\xed\x10\"P\x96\x9ev\"\x9bb9\x862\t>~\x0e\x87\xabq\x8dTro\\Y(\x85Ns\x92\xa5UL\xa7ߔu\x02[\x17,\x067\x18]{\x15\x01t6\x0eŅcBF堖\xf4:\t\xddN\xc2`w\x9b\xb3gNP;O\xbb\x95\xf1o\x9f|\x99\x90\xa6\xe8V\x1b\xa9\r\xc3EAeo\xa9T\xea\xaa\xc6\xd65\xd6\xd6Ե\xc5\a(\xaa\x9a\xa2\xac\b>0\x9f\x97\xd1\xd5ᶑ\xe8\x80Q\x82#\aV1Z\xb2X,pΑd9\xd3\xc9\x14\xeb\x1cUQR\x16s\xb4\x8a9Gk\xb3\xdf\xeb\x80`:\x99\xc7\xc4>\x9b1_\x94\xec\x0eF\xcc*\x8bW\x06m4\x97.G\xaa`\xa9\x9bsp\xbdG]\xd5\xe0\xaa\xdb8v\xbfϪ\x12<\xdahV\x0enPMkl\xbd7\xda\x17\x9a\xf1\xf0H9\xd7E\xcd\xed\xcbrB\x00\xd9\xea\xf4I\x8cB\n\x87\xf4v\x9f\xd0ߛ#\xa71\x82J[\xa4\xfdMt\xd6B%\x19!x\\\xb9`\xbau\t_\xcci-\xaf6\xf3\x141>\xe7iD:\x95\x92\x8cG#\xd2$A\x1b\xcd`8\x8eaɤ\xe8V\x8f\x00L\x8a26\x81\xdeS\x97%U]SU\x15\xce9ʲ\xc2\xd9h\x88Q\xa3\xc5ru\x1d\x97%7\x03\xfd\x11\xa2IX]\xe9\xe1\xeb\x9a\xe7\x9f{\x85\xaa,(f3\xbc\xad\xa9ˊ\xba\xaa\x9a\xed\xd8\xf1\x11\x85r\r}\xec=\x93\x06\xc5\xde\xd9\x1d\x11B\xe0\xe2\xa5k\x04\xa5\xd1y\x1b\x95\xb6\xb8\xb25BIAf\x12ΜX\xc7ZGU\x16\xb7\x95\xcd\xd5\xfe\u0605\xf7\x9evo\x89\xa5\xb5.\x93\xc1\x1c!B\xe3!~\u007fY\xb4\xab\xabF{\x1cn\x13\xde\td\x96\x19ZyJoi\x89$\xd1h\x01Z7\x9f@\x10|\x83\xe9\xdc\x1aJ3\xad%\xd2\xee*&\xdd\xe3\x04,\xb3\xed\xab\x14\x83\x9b\b\x01+\xcb=4Q\xd46\x9b\x17x\x1f\xe8v۱\xea2)-QG\x0eE\x1bt\xab\x8b\xcaZ,\xac\xa7(\xcb\x18\x92\xaa\n[WTE\x94\xfd8\xe7\xa2\xf7\x04\xbf\xbf\x80\xa0\xb65\xae\x8e'\xa1\xa4 ё\xc7\x19\x0e\xc7Tu\xcc\x05e\x11\x8d@\x83\x93\xed\xe5\x15!%I\x92\x92&)\xce{lm\x19\x8c\xc6\xd4\xd61\x1eOQJ\xf2\xea\xe5-\xaeo\x0f \xc9\x10:\xc1#x\xf9\xf5\xed\xfd\xa4}Ǚ\x03M\x1e\x99\xe1\x1bʶ\x9a\x8d\"\xd4\xee\x03\xdeY֏\x1c\xc3يjn\xf77n\xef\xef{i\x8cf\x9b\xd27\x86<\x8fI\x12\xe4\xea\xfa\x06\a\x0f\x1e\xe4\xf4]w\x93\xa7\x86T\t\xb2\xc4\xd0\xe9t\xc8\xf2\x9c$I\xc0[|\x15U\x1b\xca$\xe0k\xf2n\x9f\xbc\xdbG\xc9X:\xc6Q/\xcb\xc6\xc6J\xa3\xf0\x8b\x1a\xd9,K\x19\x8f\xa7d\x8d\x87\xec\xde\xdcF\xb9\x1ai2d\x92b:}\x82\xd6\\\x1f\x8d\x9bR0\xdeIUY\xb1XD46\xce*\xc6N\xda\xf9\x10\xbf\xf76Ϊ\x1b\xdd\xe4\x91@UW\xe0\x1d\xab\xcb]\xaa\xaan\xf6/\xc6\xe6\xb2(+\x16\xf3\x02m\x12\xd24\xa5\xb66J\x8b\xaa\x8a\xc5b\xc1\xce\ue022\xa8\xd8\x19\x8ey\xe6\xc5W\xa8\xad#\xed\xad\x92\xf4V\xf0\xce2\x1c\xcf\x185\xb4\xeej\xaf\xcb\xe9\x13kTE\x19w\x02\x87\x80+g\b\xa9\xa8\xca\x05+\x1b\a\xc8\xdb)\xc54\xdeP\x12\x81\xb5\x15\xb7\xcf\xec\v!p\xb5\xc5\xfb\x98\xbf%q\x81\x81>}\xeeN:KK\b\xa1\xa8Ϟ\x03\xa5\xd1Y\x87\xed\xadk\x14\xd52\xd3\xf1\x18%\x04I{\t\xef,\xc5h\ao\xe3\x10}\x92$\bWSW\x15\xc6Ī'\xcd3\xec|J\xb7\x93\x92g\x9a\xa5^\x87g\x9f}\x91\xf3\xef8GU,\x18\x8f\x17\x84b\x16;\xf3\xa4Ep5:\xefR\xcfG\\\xde\x1d\xb2\xd1m!k\x8b1\x86\xba(\xa9L\xacƔ1\xfb\x10\xb7\x90\x91ö\x8d\xc4(K4\xaa\xe1\xf1\xab\xb2Bk\x13O\xd89\xaa\xaaD)M\xa2$\xc2\xc4J\xab,˘\x93\x02\xec\f\x86ԕe8\x9apc{\xc8\xe3_\xf8\nR)\xd2\xf5\xa3\xa4\xcb\x1b\xf1FkP\x84\xe7_\xda\xe2\xc2}'i\x99\x84\xfb\xcf\x1f\xe5\x8b\xcfl\xe1l\x85\xf4\x9e\xba\x9cc\x8c!\xeft\xd98v\x1c\xa1K&\x83\xd9\xfe\xf8q1\x9b6\xfb\x89C\xe3!\x16[\x95\x94\x8bЬ\x92\x92\xac\xac\xf4ч\x8f\x1f\xc5\xd75\xab+ˬ.u0y\xce\xce\xf66\xfd\xa5\x1e\xd6\xc1\x95\xd7^\xc1\x1d:\x8c\xf3\x9e\xe1\xd6e\xd6N\x9f\xa5\x18\rp՜\xd9x\x04\xc6DH\xbd\xae\xe9/u\x1a\xf1\xb2D\x9b\xb8\x97䅗.#\xa5 \xcfS\xaa\xaa\xa2\xac*\xea\xbaD\xce\xc7較i\xf7\t..ַ\x8b)\xd7'3\x96sG\xaf\xc9euQ\xe2\x9d'\xcd3\x94\xd2x\x11\x10!~\x82\xa7o\x96\xed+%\xc9R\xbd\xb7\xdaw\u007f\t\\\bͧ\xf2\b\xa8\xaa\x1a%%UU\xe1C\xa0\xae,\xf3\xa2\xa0,K\x9e\xfa\xf2\xf3\\\xbf\xb9\xcb+Wn`\xb2\x16\xba\xbbL\xbev(^\xb8\xba\xc4U\x05J+\xbe\xf0\xf4\xeb<|\xe1\x14u\xed9\u007f\xe6(i\xfay\\UQ\x97\xd7c\x15\xaa4\aO\x9c$k\xc3εQ\xe3\t\x81\xe0\xc19\xbb\x9f\xf4\xf7\x80\xc7b1\xc5Y\x87֒v+\xa7\xdbj\xa1\xb5\x94d\x9d6y\x9aP\xcfg`K\xecb\xca\xcaR\x974k\xd1\xeb\xb4\x18\rwq\xd6qp\xb5\x8f\xd1\x06)<FIF;\xd7\x11\xaefum\x95\x17\x9f{\x16\xad#\x14Mm\x99L\x1cSQP\x16\x15\xab+]\xa4\x8c\xf4'RP;\x8f\x9c\x8eH{k\xa4\xfd\xb58\xf3ݸ\xbe\xaf\x16\f\xe6%\xc1\aZY\x1a\xb7(8G]\x96\xb4z]\xf2\xbc\x15\xb74\x94%I\x96ὧ(kV{yS\x06\a\x8cQ1TH\x81\xab#x9[,\b\xde\xd3\xedvx\xfd\xd25\x94\x92\x94e\xcd\xd3\xcf_\xe4\xc9/=\x8b6\t\xf9\xd2\n2\xcdѝ~\xac\x88\xaa\x92j2\xc2U\x11\x81~\xfe\xc5-\xcaE\x81P\x86N\x96r\xf6\xc4\x1aϼ2\xa2.\v\x9cu\x1c\xbd\xe38\xbd\xb5\x0e\xc3\x1bCl\xe5\xf6'\x8f#\xa0YGCH\xd1 \xd11Ϻ\xbaF\x02K\xbd.\xcb+K觟\xfc,[\xaf\xbfJ\xaf\xdf\xe7\x9d\x0f=B\xbf\xb7Db4*X\xc6\xdb\xd7(\xe6\vzYF\xbb\xb7\xca\xe5W_\xe1\xe8\xe1#\xb4[Y\\\x93t\xf4\x00\x9dVN+K8u\xf4\x00/\xbc\xf0\f\xdb\xc3\x1d\x96Z\x9a<7h%9w\xee<O>\xf94\xd6\xda\xc8\v\x10?0\x18\x02\x8b\xe1\r\x92\xa55\x92\xde*\xae.\xe3f\a!\xf0\xa2`P\x14\f\x8b\x92\xd4hVZ9R\nf\xc3\x11FkL\x9a!B\xa0Z,bؔ\x82,5(\x19)ak-`\xf1\xdeSVu\xa3ύ=ʯ~\xea\tf\xf39W\xb6nrc'nI\x95:%魀Ԩ\xbc\x8b\xca;Q\x9f\\-\xa8&;\x98\xce\x12n>aZX\xae\\\xbdɑ\xa3\a!\x04\xbe\xe6\xc2\x19\xbe\xf0\xf4\xa7\bRr\xee\xfew\xd2]\xe93\x1d\x8e\xa8\x8a\xba\xe9\xbe#\xeeg\xab\xb8\x9f^i\xb3\xff\xb9\x8a\x84H\x94U\xf3)y\x9a\x90%\x9a\x8d\xd5Ut][\xae]\xbeD\x92&|\xf1\x89\xc7QR2\x1e\xecp\xe2\xf49~\xe3\xe3\xbf\xcc\xd2\xca2Z\x1bN\x9d9ˑ\xe3'\xa1^\xb0\x18\xcd9q\xe2\x047\xb6\xae\xb1s\xf3\x06\xfdS'H3C\xab\xd5&\x1d\xdf$K5EQ\xc7т\xe08xp\x9d\xba\xb6H!\xb9tm7\nͤ\xc4.f,\xb6/\xff\xbfe\x9dIo\x1cWb\xc7\u007f\xf5\xeaUU\xd7\xd2\vw6\xa9]\xa6lI\xde\x06\xc9\x04q\x02\x1b9\xce9\xd7\xdc\xf39\xf2ar\xca)\xc7A\x12L\x10\xccd\x10\x03Q\xc6vF\x1a[\x92-\x99\x92\xc8\xde\xd8{\xed\xcb\xcb\xe1UW\xd3\xf1\x8d\x00\xd9\x00\xbb^\xbd\xed\xbf\x12\x9c\xbc\x87\xb7\u007f\x83\xaa\xc8\xc8V3\x84\xdd\xc2\x10\x922\x8b\x88\xb2\x9c\xbc\xac\xd8q[Hap5\x1a\xd3\xdb߫g\xaaA\x12E\xb8\xbe\xc7d\xbe\xe6\xf4\xa0\xa7\x95\xebeI\x1c'\x8c\xeb\x00\x9b4\xcb\xf9\xee\xfb7<\xfd\xee\a\x8aB\xa7_\v\xa1\x11\x01\xab\xe5c\x05]0MM\xc2\xf9]T\xa5(ˌh\xf4\x0e鶱;\xbb\xe4\x06ī)?\xbc]p\xf3\xe6\t\x95R\xdc\xea\xef\xf0\xf8\xd1)N\xf7\x80\xd2\xf2H\xa25\xf1*\xfdId\xa0a@\x12\x855\xe1\xa5)\x86M\u05c9m;\xec\x1e\x1c\xd2\r<\xf6vwh\a>f\x1c\x86\xff\xa0\xaa\x8a,\xcf(\x8a\x82w?\xbe\"\xe8ty\xf5\xe29Y\x1a\xe3\a\x01\xbe\x1f``0\x1e\\p\xe7\xcem\xc6#m~\xc9Ҙ,M0M\x88\xc2%o//\xb0\xd4\x1aӨ\x88\xe2\f\xd7\xd1n\xa8\x93\xfe\x11Y\x96\xd1k\xfb\xfc\xe3?\xfd\xb6V>jҦ\x88C,7\xc0\n\xbaH\xd7C\x159U\x91i\x8c\xaan\xbd\xa9\x14\x84iFZ\xe4\xa4YN\x1c\x86Z\x8eS\x96\x8d\xff\xe4\xfbW\xe7H\xa1\x97\x82\xff\xfa\x9f\xa7\xbc\xf8\xe1\r\x97\xa3+\xa28\xe1\xab?\xbe\xe0\xe5+\xbd\x97IS`\xdb6\xb6\x17\xe8\x86O\xd7ǰ\x1dd{\a\xcb\xebj\xe8\xbc\xc8I&\x17\x94Y\x8c\xe5w\x10\xa6E\x95\xc5\x14\U0005a6e7{|\xf0ޱ\x16\x01Z\x92\xd2PL\x96)\xeb0%Z\xa6[\x1cDѤX,gӺ4@\xe2\xf9m\xa4\xb4\xc8҈N\xa7\xcbQ\xff\x98\xbd\x9d.7N\xfa\x1c\xec\xed\"'\xc3KL\xd3d>\x1dk\xe1\xb0)Y\xceg\xa4I\x8c4M\xf6\x0e\x0fY\xcegLFC\xfe\xea\xf3\xcf\xf9\xf7\u007f\xfb\x17\xd28\xe1/?\xfb\xac\x86(\n^<\u007f\x8e\xefJ\xf24\xa4mi\x12\xc6iI\x84\xa9\t\xaau\x18\xe1X\x92\xe1x\x81\x12\x82\xf6\xad\x0fH\xe6c\xf2\xb8\xa2*b\xc2\xd19\xd2\v\xb0\xdd6\x1c\xdd\xc20M\xd2\xe5\x14U\x9bh\xaa<E8z\x80\x8a\xb2`\xad\x14Q\x9c\xb3,\xd6t\xf3\x12\u05cd9\xbf\x180\x98L\xb1L\xc1d:\xafK\x93\x8cZ{\xa1\xbd\x8d\x8e\xe3b:.HGS\xc4B`\xfa=\xa4ߩ[@5\xf9\x94\xccF\r\xd5 L\v\xe9\xfa\x14Ⴒ\xaa\x98.\xc2\xc6\xc1+\xa5\xc9\xfd\xd3=\x9e~\u007fE\xb2\xccP\xc2\x06\xa3\u03834\x14\xc24I\x13}JԢ\x0f\x1b\xd1@U`\x1a\xe0Y\x92\xa3\x83}\xfa\xc7\xc7XR\"72\xc8MyoY\xe4\xc4yM5*\xc5\xeb\x97/\xb1m\x9bv\xa7\xc3o~\xfdkz;;|\xf4\xe9\xa7\\]M\x18\x0fGHKR\x15\x19\x93阳\xfbG\x98\x06\x14J\xc3\x1a\x96\x14\xf4\xfbG|\xfdͷ<zp\x9f\xe9l\xa5!v\xafC\x99\xa7\x14i\xa8\xf3\xb0\xc2\x05\xd1\xe4\x82\ueb47\xb4\xda;uؤE\xbe\x9e\xe9\n<\xdb\xc1\xa8*La\xfe$h\xb1D\xb0P\x06\xf3\xa8@v\xf7)\x95\xa2@\xe1\x1fx\xcd:\x8dQK\x8d\x9a\xab\xad^GL7@\x06=L\xa9\xa1\u007f\x84\xa0*s\x92\xabK\xd2Ÿ\x110X^\x80t<L\xdbA\b\xc9\xe5`\xcer\x19\xd1\xedj\x85\xfe\xe1N\xc0ю\xc7p\x12SU\xb9\xb6\xef\x99\xdb\xff3Y\x87\x1af7\x05\x96m\xe38-\x8a<\xa5\xd5r\b\xda>T9'\xfd#|\xcf%\fC\xa4\xba\xa6Ǻ^#!\xea5\xaf,rRU\x91\fcʪd\xb1\x9c\xf3\xf6\xcd9Y\x96\xe1y\x1eRZض\xa4\u007f\xf3\xb8\x89\xf1\x13\x02\xa28\xa7\xd3i\xf3\xed\xf3W\x8c\xc63\xfe\xec\x93\x16\x17\x97\x93\x9aq\xccqz\x87d\xab\x19U\x9e\xd5o\xe5\x90Vw\x0fw\xb7\x8f\x1d\xecbZ-\xd2Řt5\xa5\xac7Ū\xe6-\x8c\x8d\xb8\x00\xd50\x99\x9bNBÐ \f\xb6\aL\xcd;\x18u\xa2\xa8\xb0\\LW\xd7\xe4mZ0\x84\xa5\x8bb\xc2\xc1+\x92٨\xb9M\x8b\xfaN&\xec\x96.>v\x03~|7c0\x9a\xd3\xedx\xba}\xbaes\xf7\xb8\xcbW\xcf.Q\xa2\x8el\xae\x93\x1e\xd28&K\xe3:aۤ\xe5z8\x8e\x85\xeb\x98X\x9d\x80\x1b'}\u07bb\u007f\x97v\x10PU\x15\x8b\xe5\xb2V.nJ|7Q\x915z\x892P\x86\xa2\xacmŚ\x17\xce\xc9\xd1<F\x18\x86\x98\xa6IQ\x14|\xf8\x8b\x87dEJ%\xf5tn9\x92\xc1\xf0\x8a\xaa*\t<\aϵI\xd3\x1c*E:\x1f\xe3\x9f\xdc\xc3;\xbaE<yG\xba\xbc\xa2\xca\x12Vo_\x82!pw\xfbX^\x80\xd5\xf2\xb1;{d\xeb\x19E\xbc\xa2\xc8SL\xd3\xd2M:yV+>D\xdd+e6?o\x92\xa81\x8c\xc6hdHK\x0fʆ\xaf0M,\xb7\x8d\xb4[d\xab\x19\x8b7\xcfI\xa6\xdb\xc1@)\xac\xa0[\x97\x8e\x99u\xc5RA\x92d\xfc\xe1\x8fo8\xbbw\x8c0M\x8a\xa2\xe2Ӈ7x\xf9z\u008f\xa3\x90E\xa2\xf3\\\xb4\x8a'Ұ\xbc00M}\x12\xb4\x84b\u007f\xff\x00[\nNO\x8e\xb9\u007f\xef\x1eeY\x11\xa5\x11\xd3\xd9\fi\\W\x18\x19uo\x87ڊ\xbf\x8cFd\xba)\xed\xaa5I51U\x96%\xbe\xefaJI\x1eE\x146X\xa6\x9e\xa2\x9ekS\x16\x15g\xf7oѲm\xb2\\_\x8e\xf2hI\x95%8\xed]\x8d.c\x10φ\xe4\xf1\x8a\xc5맔iBп\x8b\xb0\x1c젇\xed\xb6)Ә$\x9cQ$\x11\x82\x16\xb4j髺\x0e\x82n\x89\xa2f\xcd0D\xfd\x8c7\xd5y\x06\xb6\xdf\xc3\xdb9F\xd8-\xae\x9e}\xc9\xe2\xfc\xd9Ϻ\x14\x95\xaap\xba{\xbaVϨC\x02\x8a\fK\n\xfe\xe3\xcb\x17\xfc\xed\xaf>\xc5v\xcd\xfa}6\xf8\xd5\x17\x8fx\xf2\xec-O\xbe\x1d\xb1\x8a\v\x8a,\xaf=':/X\x18\n\xcfu8:ܣ\xdf?\xa6e[ܽs\x9b\x9d\x9d\x1d&\xe31o\u07fea\xb1X\"7\x16\xb5\xa6\xcbC\xc3]͕_\xfd,ҵ\x9e<\xd5\x16N\xee\xedtɳ\x9c\xb2\x82\xac\xd0qH\x9b\xcf\xee\xef\xf78=9\xa4T\xfaN ,\x1bӔ\x94I\x84\x90\x16\x96ס\xb5sD\x91\xc5\xe4\xe1\x922KX\x9c?#\x8f\x96\xb4O\xdf\xc3\xf2\xbb\xfa\x88\xea\x05\xf8N\x8bt=\xd7KXmM\xdb>x\xb6\xec[\xfd\xe07\xc50\x18\x06\xa6\xdd\xc2\xf6;X-\xad\xadZ]|\xcf\xf4\xf9\x13\xca<\xfbyH\xa7RX~\a\xd3\xf1\x10vMٖE\x83A\xad\u0094/\x9f\xbc\xe0o>\xff\x88\xb2^LځÃ\xdb\xfb|\xf3|@\xb4Z\x91\xe5\x05\xa6\xa9\xc1HǱ\xd9?8\xe0p\xafˣ\x0f\x1ep\xd2?!\xcfS\xee\u07b9\xcbh4\xe4ٳg,V\x11\xeb8En\x83\xacjk\xa3\xaa\xea\a^]\v(\xd6`\xe5\x06\xb5l\x04t\xb5|\xe6\xe8\xf8P\xdf©XE%-Y\xe7\x8cP\xb1\xb7\u05eb9\x03\x88\xeb\x1bxU\x95\x94i\x8c\xb0\x1cL7\xc0n\xf7\b\x8c;\xac\x87?\x92-g\x18Bh\x89\xd1b\x8c\xbb\xdb\xd7\xfbJ\xbb\x8b\x906\xb6\xdfC\xf9Z\xe9Q\x95ڼ\xaf\xcb\xc26>\x8d\xbc\xa9\xe6\xd6=\xb96\xa6eS\xe5\x19\xf1l\xc8\xea\xfc9\xe1\xf05y\xb4n\x92\xaa\xd5ϚF\xa1\xd5=\xc0\xf2:\x9b\x18\xbcmv/\xfa\xbb\xfd\xf3o\xfe\xc4\x17\x9f}\x00\xa6\xd5|\xe6\xe8\xa0\xcd\xc7\x0f\x8ey{9Ǫ\x11\xe8\xeen\x87\x87\x8f\xde\a\fn\xdc8\xe5\xa3Ǐ\x90\xd2b6\xbd\"\x8e\xd6\\\\\xbc%\x8a\x13f\x8b9\xf3\xe5\xba\xdeC\x94\x8e>ݔs\x195L\xad7'Qϒ\xa2\x99'\xd7\x03\x91-K\xe2\xb7}TY\x10X\x05\xa7\xfd=\xc2Պ0\x8e\xf5-{\xa7KY蒮\xc5*\xa6*2\xcdQDKLׇ$B\xb6<,\xbfKp|\x97X\xda\xc4\xd3a-IMY\x0f_\x13\xcd\x06X-\x8fV\xef\x10;ح\xeb\xb9m\xa4\xedj'W\x9dn\x8a\x02!\x1d\x84i\xa0\x94\xa0H\xd6\xc4W\x97\xc4W\x97\xa4\xf31e\x1aR֚.aY\xfa\x10SU?\x9b\x1d\xb2\xe5\xe1\xec\x1e`l\xc4zyZW\xed\x95͡g<]\xf3\xfb'?\xf0\xd9/Ϛ\xe5\xd24\x04_\xfc\xc5}\xfe\xf4r\xc8\xf9`\xc5\xd9\xd9mNN\x8e9<: Mrn\xde8\xe1p\xff\x80u\xb8\xc6\x14&_}\xf5\aڽ\x1e\x93\xab+\xc2(!\fc\xa4\xaa\x94\xf6/6\xd3_muE\xb5\x90\xaciQ\xab\x01\xbb\x86\xb6D\x11\x04\x81~KUF\xafmҲ+>\xf9\xecc\xce\xcf\a\xbc~\xfd#\x83\xc1\x05\xef\u07fbM\x9a\xe6,W\x11\n(b\x8d\x82\x16i\x88%tܟa\xd9X\xbe\x16i\x1b\x86 \x9e\r\x1b\xf2\xbfJ\x13\xb2\"'\x0fW(\xe3{,\xc7Cz\x01V\xab\x8d\xd3\xdd\xc7j\xf9(à\x88\xd7$\xb3\x01\xc9|D\x91FM\xbf\xe1f/\xd9\xc0'\xd7\xe9\xe6\xda\xe4q-\x82P\xe0\xee\x9f\"[A\xf3wU\x96\xa2\x8a\xa2!\x926j\x9b\u007f\xfd\xddw\xfc\xf9\xc7w\x90\x8e\xdd\xf8f\x04\xf0\xf7\u007f\xf7\xd7\xfc\xfe\x9b+\xd2\u00a0\u007f|\x88\xe58<~\xf8\x117o\xddC\b\x13\xa7\xe50\xb9\x9a0\x1c\x0e\x88\xb3\x9c(IH҂\xf9t\x8al\x8c'\x86\x0e\x9dot1:~\xba\xf1i\u05eb\xce\xd6\xcb]\u007f\xd1n\xafC\xa5\x14\x81\xad\xfb9\x96\x8b5_\u007f\xfd\x8c\x0f?|\x9f\xc5b\xc1\xe0\xdd%\x8f\x1f\xdc#\xcb3\x92\xa4\xbe\xdf$!\xa6\xeb\x93\xce\xc6H'\xa0*r-\x152-l\xaf\x83\xbcq\x86\x90\x16\xf1|DU\a\xf9\xabr\xd3\x19a\x92\x85\v\xd2Ռ\x06\xde\xdd,\xa0\x95j\xb2\xe47U\xad\\/(\xbeք\xc0&\xa1Hi\xb9\xb5~\xd9\x14Nw\x17\xf7\xe0\xa4i\xb5TeA\x99\xa7Zk\xf0\xff\xf6\x9a\xd1d\xcd\xf3\x97\x17<zxK\xdf=꡵\x85\xe0\x93\xb3\x1e\xff\xfbj\x8d\x10\x15\xefݻO^\x16\\M\x06t\xba\xbb\xfc\xe7\xef~K\xa1\x14\xa6)Y\xac\xd6\x14eE\x92&:\xf0\x9fk'\x95m\xbd\"(C];\xb1\\\xdb\u05cd\xed)F)\x85\x17\xf8\b\x14\xb6\xd4\u007f\xef\xfb.y\x91s~\xfe\x96\x93\xfe!e\xb5q\x19)}\xecE{\xf5\xaa4\xd1v\xe4\xe9@K\xf8\xf3\xac1L\niӾqF\xef\xcec\xfc\xe3;H7\xd0ojQ\xea4\x05\xb5\xd9۶Gލzd\xb3\x897\x95\xab?\x99\tF\xd3\b\xbaQ\xec7E\x93U\x85\xb0\x1d\x82\x93{M\x16\x96\x01TYJ\x99D\x94i\xbc\xad\x13\xaf\u007f\x1b%9O\x9f_PfɵC\x11\x94eIϷ\xd9\xf5KT\x11\x92\xc4S\xca<\"\x89\u05cc\x86\x17\xbc{\xf7\x8e$N\x98/\x16\x8c\xc7\x13,\xc7%KSf\x93\x11\x92\x9fL\x11\x9a\xa3\xe4u\xf5\x9d\xae:\xdd6\xe2\b\xd3\xc20\xa1(R|ϣ,K\xa4Y\xa1\x94\xe0\xf4F\x9f\xc5|\xc5b1\xe3\xa4\u007fD\x92\x96uVa\xb5\x1d\xf8\xaa\xa4H#LǥH\"\xb2\xfbZP@\x00\x00\x00\x8fIDAT\xe5\x15v{O\u007f1\x9c\xe6![~\x17\xe9\x06\xb4v\x8f(\xa25Y8'_\xcd(\x92X\x0fr\x91a\x18u9\xe4\xf5\xda֟\x960lgD\x99i\x82˔[\xff}=H\x86a\xd0==\xc3t\x83M\xd2\ne\xaa\xf7\xbct>jt\xceBh\x01\xb8\x8e\xba\x85\xff\xfe\xfa\x9c_\xfe\xe2.\xfdc\xbd\x14\x19\xb5?ĨJ><;\xe4j\x953\xbe|Ao\xb7\x0f\xaa\"\x8crl[2\x1aO\x10R2\x1d\f\x19\f\x86,fS\xd6\xf3)\xff\a\xd7\xd0,\x9a68\xb8\\\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package my_img
-
-var BirdSkull = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x96\x00\x00\x00\x96\b\x06\x00\x00\x00<\x01q\xe2\x00\x005$zTXtRaw profile type exif\x00\x00xڭ\x9cW\x96\x1c9\x92E\xff\xb1\x8a^\x02\x94A,\a\xf2\x9c\xd9\xc1,\u007f\xeeC$E\xb1X\xadj\x8a\xddLfd\xa4\x87;`\xf6\x84\xc1\x00w\xfe\xf7\u007f\xae\xfb\xc7?\xfe\x11b\xe8\xdee\xab\xad\xf4R<\xff\xe5\x9e{\x1c\xfc\xa3\xf9\xcf\u007f\x9f\xaf\xc1\xe7\xf7\xf7\xfb\xaf\x97\xaf\x9f\x85?\xbe\xee\xbe\xff \xf2R\xe2k\xfa|[\xce\xd7\xfb\a\xafۏ_\xa8\xf9\xeb\xf5\xf9\xc7\xd7]]_\xd7i_\x17\xfa\xfa\xc1\xb7\v&}r\xe4\x1f_\xefk_\x17J\xf1\xf3z\xc8\xdf\xee\xe8\xeb\xf7F\xfe\xe9q\xbe\xfe\x9f\xdb\xf7\xc7x\xff\xfd\xe9\xfb\xca`l\xe3z)\xbaxRH\x9e\xbf\x8b>%q\a\xa9\xa7\xc1\xd7\xc4\xdf)\x85\xf8\xe3\x15\xe3\xef\x90\xd2\xef\xc7\xce}\xff\xe7/\x83\xf7\xfd_\xbf\x8c\x9d\x1f_\xaf\xa7?\x0e\x85\xf3\xdf\x06\xbb\xfc2F_\xaf\a\xfb\xfdؽ\x11\xfa\xf9\x8e\u008fO\xfe\xc3\x0fZ\x8b\xc7\xff\xfc\xdfOcw\xefn\xf7\x9e\xcfӍ\\\x18\xa9\xe2\xbe\x1e\xeaۣ\xbc\u007f\xf1\xc6\xc9P~F\xa3\xf0\xa7\xf2\u007f\xe3\xdf\xf5\xfd\xe9\xfci<\xe2b\xc66\xb39\xf9\xb3\\\xe8\x04f\xf27\xe4\xb0\xc3\b7\x9c\xf7u\x85\xc5-\xe6xb\xe5k\x8c+\xa6\xf7ZK5\xf6\xb8ޤd\xfd\t7V&c\xbbԘ\xabŬ%^\x8e\xdf\xef%\xbc\xcf\xed\xef\xf3Vh|\xf2\x0e\xbc3\x06.\x16\xf8\x8d?\xfdq\xbf{\xf1\xbf\xf9\xf3\xfdB\xf7*tC\xf0\xed\xfbXq_Q1\xcdmh\xe6\xf47\xefbB\xc2\xfd\x1aS{\xe3\xfb\xfe\xb8\x9f\xe2\xc6\xff4\xb1\x89\x19\xb47̍\a\x1c~~.1-\xfc\x88\xad\xf4\xe69\xf1>\xf3\xd9\xf9Oj\x84\xba\xbf.\xc0\x10\xf1\xd9\xc6̈́\xc4\f\xf8\x12\x92\x85\x12|\x8d\xb1\x86\xc086\xe6gp\xe71\xe58\x99\x81`\x16wp\x97\xb9I\xa909-\xea\xb3\xf9\x9d\x1a\xde{\xa3\xc5\xcf\xcb@\v\x13a\xa9\xa4\xcaԐ.LV\xceF\xfc\xd4܈\xa1aɲ3\xb3b՚u\x1b%\x95\\\xac\x94R\x8b0j\xd4Ts\xb5Zj\xad\xad\xf6:Zj\xb9Y+\xad\xb6\xd6z\x1b=\xf6\x04\x84Y/\xbd\xba\xdez\xefc\xf0\xa1\x83K\x0f~{\xf0\x8e1f\x9ci\xe6i\xb3\xcc:\xdb\xecs,\xc2g\xe5e\xab\xac\xba\xda\xeak\xec\xb8\xd3&\xfdw\xd9\xd5\xed\xb6\xfb\x1e'\x1cB\xe9\xe4c\xa7\x9cz\xda\xe9g\\b\xed\xa6\x9b\xaf\xddr\xebm\xb7\xdf\xf1}־f\xf5\x8f\xb3\x16~\x99\xb9\u007f>k\xe1k\xd64c\xf9\xbd\xaf\xfe\x985^\xae\xf5\xdb%\x82\xe0\xc44g\xccX́\x19\xaf\x9a\x01\x02:j\xce|\v9G͜\xe6\xcc\xf7HRXdւirvЌ1\x83\xf9\x84h7|\x9f\xbb\x1f3\xf7O\xe7\xcdY\xfe\x8f\xe6-\xfe\xd5\xcc9M\xdd\xff\xc7\xcc9M\xdd\xd7\xcc\xfdy\xde~3k{<FIo\x82\x94\x85\x1aS\x9f.\xc0\xc6\x1bN\x1b\xb1\rq\xd2\u007f\xfd\xd5\xfd\xdd\v\xfc\xf5\x85&32v\xcav\u05ccP\xf8j3\xd8س\xf9\x9b\x18\x96=k[woKdm\r\x87\xa8\xb9\xc3\xfc&\xb2C\x9c\xbd_4\xc0\x18\xb7\x85\xb9 \xb4\x91{\x8d\xb3\xee\x13\xee\xccaԽs96\xfc\xce\xe7\xc6\x13\x18\xe50&1\ar+j\xd68\xd9\x01\xc9w\x9f3w\n\xa7\xf7\x9a\x86\x86\xb5\xec\x01L\xd7Ŵ\xfbUgٙ\xb9N\xf7\x12\xe3\xfc\xda\xe1Ɖ\xfau\x15\x0f\xe3Z!ꎛul.S\t\x03\x88e^B\xa0\xccY\x89\xbdٲ\xa5\xdb\xcf\r=\xcd[z\x84?f\xae\xc5\xc2N\xd3\xcaܳ\x10y;\x95\xbe\xcb,\x8e\xf0f\xf6A\xfe1\xab\x81\xacpK\xae7\xbd0\xb7\xbe\xba\x00?\xf1\xe5\xe8wy+wAxtX\xff\x849\xf9\xcd\x1ab\x99\xad\xbb\x1a\x88\xe2\xb3/Ѹ|羗݁\x12\xb8\xbd\xcd\x06}Ծn\x19s\x8f|:cfw\x1a\xe1\xb2\x03\xcf\x13m\xe7\xb8f3Ŭ\xcb\xccV\xf1\xbb\x87\xc6\r\xf4\xbdJ\n\xfd\x80ǣ\x9d\xca\xff6c5W\xacdZk\xa4~\xe90\xe0Xs3\xa1\x81\x19\xb8k\x19CX\x8bcN\x8b\x82s\xb7\xb4\xe3\x98=\xb7S\x92R\x89\xdf>~v\x828\x94vO\x8a`\xca%\xde\xcfZ\xf5\xe6\xd1\xe1l\xe0\xe1z>6AᎯ\xcc\xcf\xdd\xf5\xec΄\x91\xa0\xf1\x16R\x8c\xb9\x87\xac\xc7L{\xe6\xc6\xc0\xa6\\Q\x85\x87t\x8e\x96*S\xb8\xc2,\xa7\xcfc\x9b\x90)ٍy\x98\x12b\x8f\x1f5+u1yw%T\x02S\x95\xf7E\xfa\xe9s\xd22݄g\x10\x98\xd4v\xf7\xcdi\x8dV\b\xdb8b\x9d\xd3\xf1\"?\ue1e79\xe6\xf5Xy\xf6\xed\x19\xf9]\xa7\xcf0\t\xf9LD\x00\n\x90T\xe3\x99F\x83\x82ֱ9\x8a'ۡ\xf8s\xcfԣ\x81B}\x19A\xbf\x12ў\x19\x92ɯ\xa7\xc14\xe5R\x11\x8bVZ\x1f{\xe5\x18\f5\u008cغhOBu\xd7ak\x93Vӭ<oj\xdc(\xc8\xc6`\x06\xe6\xe3\\\"\x19\xd4\xddsr\xa7\x88\aP\"\xadM&$\xeex\xadb\x8d B`H\xf9\x96\xb0+\x94z\xdd&|\xe3\x9ca\xf3\xcc)\x90eq\xe4\x8c\xce\xe1\xad\x002w4\x99<\xb2\xd1\xc050s\x83ey\xfb\xde\x16P\t<\xa2\xc7\xc8\x170\xd6\x01\xc2P\xee\xe2\xaa\\~]\x0f\"\x03\xc2\x1e\xce\xce\x16\xfe\x83\xaf\x8e\xf9F[\xf5\xeb7HQ\x88\xdb3\x85\xbe~G@\x80\x91T2C\x19\xfe B\xb7Mb\xe2\fؠ!syt\xa4\x14#~m\x1c\xee\x88T\xf5\x80ne\xd4\x00V\xf2\xe8\x9eu:\x90\x8fF\xe3:e\xed}@\xfb\xe2'#\xd0C\a\x13\x12\xb8T\a\xa1_\xce\t\x84K\xe8\xdb\x11\xe2\xf5@P;\x96F\x86\x91\x03\xb3XB\xe0\xafzʲй\x9bt|\a\xc7V\xea\xa4n\x8a\x90=d\xd76@o\xbc\x1dкR\xb5D|\x99q\x9fu\a\xc1\xb2j\x05\xfb\xd6\x1c1\xfb\xb6\xd2\xf0@\xff0ho\x00\xec\xf7\xc48\x01\xb9\x99\x89\x99\x93}8\x90\f\xdcչ\t\x17\x1a\xb9\x91ϱ8L\x83\x1c\xc0Β\b\xbbkFxl 2G?\xd6Xd\xe1\xe0\xce\b\xb7\x11\x80\xc9У\x99\xe7\xf5$A\xd5\x11\xecL\xedZ\xa7\x99\xe7\x01/\x8f\rn\x93\x8a\x8d!\xc9\xe5\x01r\x99g\x00\xbd%6\xc4\x189^\x012Ƙ\xa8\x05\xc7D\xb0\x84\xd1t\r\xed\x1a\xe7\xd9K\x93\xb3\xa5\x86\x17\xce+f;#\xe9ٹ3o\xd7`ӍL\xae\x84\x9b\x11\xf0\rȸ\xdbV#\xf0\x003n\xcd\xd5;v\xe5nF\xea{_\x18\xb2_\xe6\x83\x00\x0ea\xf4=\xc9Q\x86\bq\xbc@n\xf0\x98\xf0\x9ds\x90\vr%gN\xb2\x01\x80\x83N\x9d\x10-\xdf>\xce\xded\xd2\xcbo\xb3\xd3\xe0$B\xa5Eҕg\"\xed\x1a(\x89FI\x9b\xf9\b$\u007fG\x9d\x10Q\xc1\x98\xab\xc1\xa8\xbb2WG9!\xf2\xc9\x1089\xf5\xcb\xe7\x17`h\xcd\x13\x81\x00\x00\xa7\x8f\xcal\x82\xd3y\x16\xafp\xc5\x00\x9d\xcb[\t+0\xae\x10\xcb\xd5\x05\xf4\x05\xac\x03p\xf5\x82\x96a\x96fk\x15\xd1\xcd_m\x1b\xaf\xc8\xdei\xf2전\xea\xc0\xeb\x10\xb25\xcf\x01\x16%\xe6-h\xf4\x8f;x\x1b\x06\f\x05`\x92\xc3^\x90\x86\xba:(C\xa4,\x99\x8b3Yr\xa4pO!\xb6\x88%\xf28G\x1e\ue80e;\xdez@C\xe6\xc4t\xa8\x90S\x18\xf4\x18\x01\x1a\xa0\xca|gFy\xa7\x15L\x81\xc6\xed\xa0\x9d\xb9=P\x19\x1e.נ\rx\x94\x01˓\a\xcc\xf7\xc0\xfd\x00\x10Ý\xa5\xed\xc6\x18uF\x1c\xd1Hu\x866\xb8Z\x9a\xd8 \x92\xb2]\x06\x99\xcc8+\xd4JH\xc2\xe0\t|6\xde\xd4\x1aqv\x9c\xe0\x8c\x9cA\tq\xcf\f\x90.\x02\x96\x05\xe2'\xccs\x81\xd1\r\xac3kֽ\xee\xcd\xc3\xfb\x87_\xacQ Y',\x0e!]\x8f\x1a\xb9\xccE\x86\xb5\x18A\xa8\x0eI1\x06\xee\x9e[I0SO\x81I$\xb8\x99\u007fb\xbd-\xa0\x15\xecc\u2bffǀ\b\x94\bQ[]^]Z&Iu\x1d\xb2m0\x83\x8cOc.\x02\x92~\u05fcC`z\x89YF\x18\x9a[w\xed\x8e\xe4IdxA.\x18\x9f\x98А\xc4~\xad|+.g\x92\x03\xc9]놿\x91?\xccK\"\x13\x03ѷI\xbaS\xd0N \xfe`\xa0\x19'\x9b\\\x8c`GcdۤȚ\x8c\x8de<\f\xa2\xc1\x18A\xc6|e\xc4\x014ԯX1\xf3\x14\xf1@\x18\x86\x9d\xf4\xbcT\x06\x12\xe6H\x85\x8c\x9e\xb3\x84Ks\x9b\x90=\x1d)\x0f\x9a_p\xd6x\x14\xd4\xf4ڠ\xc0\xcd\xf9\xc2\x15]D\xb0A\xa0$?uQ\x19p+\xf2f4\xc2j5\xd4W\xc4\x1dm$+R&Uء\x15\xf8\x04\x12\x06\xdatݖl%\xb42P\xfc\xc9]$\x88\x06\xadW\xf4\v\x82\x9f\xa0\xaa\xc2\x1b.\x83\x85\x80\xa6an`\x04\x9d\x0f\x94\x00xhm@\xee\xdf$\x8f\v\x19\xf1<\f61\x05'\x13\x9e2\xd1I>\x9d\x14\xef\x80\xdeY\x882\x94ӹ\x05\x1c\x83\x1c\x88\b\xa4\x1d\x1a\x8c\x98\xdc\xc3\xc8\xeaR\x0e\xd3ƽcp\\\x85\xaf#\xdc\x01\xa2\xc1\xa7ht\xc0y]~A\x8a\x80\x10C\x1a 2\x98\x12\x9e\x9a\x11\xdbF\xaeB+}h\xe0\xe0\x16\"\xf0(\x94\x1dY\x8d\x9fIi\x06\xd5\x160/\x99\xd1\x02\xc5WE~\x10\x1a\xbd\xc1pH.l\xc5\xd8LW)\x90\x13\xa8\xbd\xc7F#\x8f\x89Gʛ\x17ݩ\xfa,\x8fn\xac\xa7\v;k\xcb\x11'\xc0\x8c/\b\x12\xd5\xd16е,\xa9$E\xca\xf5z`A\x9b\xc0B5\x12\xb5\b\xf5\xe7p$\xac\xde\n\xa3\xa0亰͔\x94\x04\x19\x11@\xf4&n\x91\xe1\a\x8a\xa1TD\x1b\xb2N\xecV\x0e\xc1^\xfcBf\x00\x8d\b,\x87\xd9)%\x16\x04m\xd9듖]\x06\xf2\"\"\xc1$n\xf5Dy\xbe\x83ъCw\xcdg\x80Q\xc0\x8e\xa0x\xee\xd6v#@\xddA?L\x89Yɇ'\x9b<\"\x83+#\xb0\xa3l\x16\xf7\vZ\x1d\xbc\x1d\xf25\xc3\tG\xe6\tI\xa4\xf0\xc5Y2=D\xdbqP.\x9a\x8c\a\xe4Y1\x8f\x8d[\x12\f\x1c\xc0\x824\xf6\x898\bk\xcaJ,\xe6o\xcd\xdb\x01\xc5\x05\x96\xa0\x91\xc0\x94#6\xf5\xfc\xc0\x81\xc8\xe2\b\xd1j\x86\xfb2~\x02k\xa2\x1f\x02\xc8\xe4@\xc0U\x80tp1z\xee\x00\xc4\x1e\xa5\x05\u007f@\xe8dߕ\xc0\x85\xd8\xd6p\xa0&\x8cK\x98\xb6v\xc0\x1e\xfee\f\x87\xc6_\xe6\xd5L\x95-r\xa4/\x98\xad\xaa\xb2\x87WB=0O\xc0\fQ\xcc\xdd.\x06|`\x8e1\xab\v\xea\x02X\xaa\x97ɨ\x90\xed\"2\xc1\xef\f\x1c\x9b\xfcǙ\xc0 3\x88\x82\xe5\xea<\x1c\x11\xc4\xdc\x03\x83\xa8\x9a o\xe5V\x10\xc1  \xb1LMQhċ4\xbc\x11\xf9\x17\xb3[Ey|\x8a\xaee\xb1\xa3NxtDW\xdb\x13\xa4\xf5\xa8x\xacR(\x8eD*h\x0f\xdeI\x84#wFDĐ<\x05߱\xb8RCZvP>\x82+\x8cOY\bҌ\x13T|\xc0\xf1\xbd\xcf\xfb\x04\x9a[\xf8 lQg&\xf6\x8e\xe8\x11\xa4gWd \x87RU\xb6\xd5\x16.wZT\xaf\xc2f\xd7&b\f\xfb\fx\xa6`\x8d\x0e\x19n\xd5\x11\x1e(4\xb4\xad\x00\x96\xe7\xc6%\x12\x8d\x1e$Y\x00\x1a\xa0\xb7\x1f\xbb\x1b\xbf\xe2\x91T]\x96\xe6\x86\xfa\b\x1e\x16\x9fW1\xc5\xc89\x94-0\x02h\x9b\x84\xd7@[\xe0= \xb0\x9d=>\x12Y\x12 \xbdM\xfcb\x1cg\xe3\x01\x19Xb\xbbaw\n\xd2\xcd\xef[y\x8ck\x8e\x10\x00\x151T\x1e\x10@\a`\xb2ȟ\x01\x89\x92\xd7\xcc=*\x06\x92\v\x9d\xfb\x0eBP2\rU\x1b?X<\xf5-\xb8ν\x80G`4\xd3.g\x84j\rW\xb9\x1d%\xc5\u007f\xe7\u0379EYH\x03\xf6\x9f|\xe2*\xd0Vg\x04t!\b\x91\xe8h\x88\u007f\x82\xcb\xc7Z\x9a\x12WS\x8bJ\xf3\x96Pb3\niv\xec\x05\xe0$\xb6\x19}PY\xef\xc66\x02L}9\xc2\x1cv\x80\xb1&\xf2fc\x9cPf\xc9\xdb^\x9d\xa4\at\x96J$D\x9a\x98\xb5\x1e\xa1\x03cA\xc2T`\x06\xe0:\xe8j\x98\xc1\xcfO\x8a\x80\xde\x00[F\a@\x00\xcc\vo\xcbQ\x8a*b\xd3n\xc3\xed\x83o\xc4\v(\x8e\x06B\a2\xb2\aV\x81\x91я\xd8m\xb8\x1f\xd18*H\v\xa2Cg\x1e\xfdvE\xb4\xdd m\xc8գ]\x8aF\x86O\x82@\xe56\x93\xe6\xc2ß\x8c<_\x11čy\xc6\xd3F\xbc\x18\xb30\xd6\xd9\xf2T\xc9P4A\xf4w\xe6+\xac_\xc6\x16\x1b\x8cʂ#\x02ȩl\x87\x1f\x8cᾼie\x82\xdc\x1cx\x8bV\xc0R\xa0s\xaeWZ\xed\x9e\xf8\xf9\x19\x9bH@ZÕRG\xbb\xe4\xbdv\x83\xaf\xba\xca\n\xaf6\x82\xdc\xe8k\xfa\vuUD\x04~\x1c\x99\x9e\xa5\xb1\xe1q<\x80y\xde\xc3'] \xfbb\xf6\xc4Q\xbd\xf0Z\xe7\xe1!\x88\x81\bE\v\x1acY\xb0\x9b\xbb\x9d\x8c_3f\x00\"&#V\xabL\x06X\x0f\x94(}K+\xaf~\xd1n%\xc8j`\xe0\xe6\x03=\xa4\xb6\xea3\x81\xbc+\xd2$dRK\xaeK\xc0\"\x17\xb6\x18=\x1d{\x9c$t\xec\x06\x1f\xfb\xb3\xa3\x15\xc8\x00\x97\x19\x8a\xe4$i\x84\xf9'Sb\x84\xf6Z\xee\xaa\xfa\"\x13\x9c\xb8ba˱\x9f\x90\x06\xf4\x85\xdc\x01\x17v\xc1/\fO\xc8\fd\xa2'\xef-\x91\xb3L\xbbE\x86s_P>\x92\x8f\xa2\xb4\xa8\xe8q\x84Wɐ\xd7b\xb8q\xeb\x13\xbd\x8e\x9aR\xfd\xa82\xbd\xc0\a\xe2\x87xA\xcf\xf0\xf9\x92\\\x043A\xd4\x03\x82\v\x89R\xa6\x1cb\xf4\x17\x11\u19421\xec\xf5)g\xe0\n\xa15>%\xd2\r)\xac\"\xd1\xde\xeb\x13\x95\x19fU\"l\x15\x18\xf1\xcb%i)\bG\x89\xa7E\xf3uA,2z\xa8B@0\xefF·#\xc5\x01\x9d\x92&\xf2\xea\x11\x115\xbd\x19\x9eg\xf1\xe3\x1b\x10MH\x11\xcb\xe9\x02\x11\x1b\x9d\rj\xa1@\x03\x01\x10\xa1\x1c\xe2\v\u007f\x05\xa7\x19!^\xf5l#\x06ܱ\xcaaL\x04\n\x05\x80\"ּ\xc8+\x9d\xf9\xe4<c\xe0\x92T\x10\x9c\xc2\\#\x80\xe4s\xedyT\xcc/(\xb83c\xf8\xb0\x9a\x11\xc7\xf7\t\x88'^\f\xf7ĕU\xc2\xe2\xe2\x13\x15w\x1d\x19\x88P+K\x8b\x16\x8a \xe4\x1e#\xd0\b*BWey@\x9bہ\x1aA\x92B\xc8c :DŅ\x10N\xaaqp\x01\xe2\xd9\xc1x\a\xa9\xcfe%Ue'\x06\xa36^\x90\x10h\x1bJ%\x80\x81\xdc\x1c\x89\x19R\x14\xcb֡D/\x0f\x9a\xf0\xd4k\xcbav\xb7\x1a|\xa2\xc9'\x9f\xfc\x86>Q\xf0(W4\x112\x06ݪ\xe06\xc4\xf7\x91\r*|>x\x82[\x1d\x00\x06\x02Xd\x1cT\rH\xce\x13~\xd9\"\x8f\xb2/w\xdfT\x9f\x06\x8d39\xc4\xc4w\xee\x02}\x14\x14\xfa>\x0f}\xc3u\xe1a%\xc3\r\x88?\x88$\x8c\x03AJ\x81\xc8ܣ\f\v\x92\xcf3\b\xc42\xce\xcdp\xc0\xdf\xe1Z\xfa\xb1eM\x02\xa8\x84\x81c^'j\xe7\xea\x15\x00M%\x8dx@Y\x15\x8e\xdaD\xb0!\xaa\x10\xddx\x12MLƛ\x91\x81\f\x0e\x91\xc9\abf\x911\xf0\x02\x9f\x86\x8d\f\xaa \x90\xe8\xc1#1\x01\u007f\x92I\x05\x86\x89\xd3\x04?P&M\xa5S\x1fƞ ̱X\x031\rKn\xe6\x8e\xc4:<>0)\t\xfa*\xe5b^\xd0\xc2\xe1\xed\bz&h\xa2\x9b`\xb3\t\xc3B\xb8\r\xbbH\xb2a\xfa0O\x00(\xb8@\x14\x81\r*\xe5\x031\xa7-\xcc\xf3N\x9a\x87\xeeQ\x97\x0e|\x8a[Fml\x99L\x82P\x17D\x1b\xa9D\x806\x99\x9b\xb1\xb3\xde[Sl_\xd5 q\xc1\xe59 .\\\x04\x92;\xd5\n\x8c\x1cB\xaeT\x99\x9d\x98Ug_\x10@\xf6\x00\x16\x1f؇J\xbbhǄ\xbe\xe0\xfaJ4\xa4\uf4b7\xc1\xbct\u20c0\xc3\x12'GH\xa0\xec\x1bN\re\x06\xd9`\xafT\xbda\xa6M_HRb;1\x92\xa4\x9a\x15I\x12l\xa4U\xd5\x181\t .\x11wӝ\x8e\x9c\xf0\xc0\xbe`\xbd\xa0\x94\xdbJH\x88\xe9U*Z|\x03?'b\x80\xe8A7\x15\xc1\rй\x0e\x0e8\xc8\xfe\x81\xff(V\xc3L:\x8c\x02\xf1\x88b\x01U\x10\x01H\x1c\xd4\xca$ܢ\x88\x9e`\xb3xzm\b%>\xab4\x99\x16d2/\xc2x\xe7\"\xe0\x18\xef\x05H:\"\xce\xde\xda64\xcfd^\xf4!\xdea-\xf1\xef\xdc^\x0e\x1d\xe6\xe4\xbf\x14IS8o5\x15V\x897\xeeG\xc2\xc7\xfc\x92\x8dv\v\xc5\xc4l\xb4G]\xa8sc\xfe7$_\xebQ)\x1a\xaa\x97\x0fGuH\nފ\xf6\xdd\f\x16\xe4nqF\x86\x01\x01\xd5\x10\xb1\x1b^S\x98\a\xa4\x0eB\xe1\xc1\xd4\x14\x93\x10\\\x85y\x19\x12\x81\xe0X\x84\xbc\xc0\a\xc2\x15\xedVQ}\xe3U\xd4\x18L\f\x89\x00)\xba\x04\x98'\xe9\n\x9e\x86\xecC\x1ab\xe5O\xaa@)2v\xc6\x15\xa03.^y\x18>\xcd\xc3Mo\x9c\xc8p$\xec\u0089\xc8\xfe\x00l\x01\x89\x9f\xc3,\r\x19\x8e\a\xc4\xeecI\xb4\xb4\x95\x86\xe8OZ\x014CM\xa3LJ\xbc\xbb^\xc6\x00\xea\xc3\x18\xdd\x05Z\x17\b\x1a L\x0eB3\uef60\xca\xc1\xad6O \x8a\xa3\x90{\"\b\xae\x98\f\xdcT\xf5k\x8a\xab:a\xc0M\x12Dp`\xed\xcf_\xe4\x05ɑk\xaa\xe8\xe3Lp\xd5\xc0\r\x8a\f\xcb\u007f=\xb2\x8b\xc8G\xb5_\xde6\x8ej\aD0\xf0\xb2\xb5BE\xeel\x11\x1b\x98\xd3VV5f\xb9kA\xc5\xec\xd9y\x06f\xa2L-\xc6\xe0W\x18^b\x9a\f\xf7ZdÑ\xe3\x966\x94\x04\xc6U\\\xab1~\x02\x17\x14$\xde\x01\x15\xe0\xea\xde~\x16\x19],e>\xeb\x94\x12Ԓ@\xeac{\x17(L2\xbf\x99M\x8b\xc0E8\x02膤\"\fq\x0fK\xf5K\xec`w8\x12\xa4\bP6\x17A\a\x1e0\x04\x06!\x83\xd7\x11\x16G\xc7=W\x19U\x9a\xe9o-\x84\xd4o\x84\x9b\xc4\vx~\x91\xe6\xb6Ss\x90`\xca5\xe1F\xd3\xc0BC\xcaS\xaaz\x80\xc7H\x11C\x96\xf2\xa4\x8c\x1aʟ\u007f\x10\xa1b@F\xa3\x834\x97\x80\x1b\x8c.\xe0~\x1c\x8c\xcaT\x86\xf7\xa4\x1b$\x158\x8ax\x944K\xf5\v|\x1e\xb3Ԫ\xea\x9b\xcd\x04\xc7Z\xf7\xd3\xf2\x10Nt\x9b\xaa\xe8y\xcd\xe9\x00\xac\x13\xf0\x15\x04\xe0a\x98\xb4b\x83\xd6R\x1a\xd7>\x0eA\xb0w\x85\xc8y\xca\x1b\x9a,\x1a\x02r\xa2 `\xf3\xa5\xf5\xa6\x86:RESI;\x911'\xe3҂\x8a\xf1\xdc\n\xc9Rȭ`\x04V+\vB\xc6.\xf3\xf1A\xb1\v\xec\xab\\N`\x11\xc0\fe\x10\x00\xee\xec\xf0BC<\xe5\xc7S\xa3\x1e\xfcF\xa0An\x8dg\xd0J\xcbP\xf6@aCŎ\t\xf8\"bÙ\xf2\xba\x18|R\xe5 \xbc\xdbBgW\x81&\x19\x87\xaf'\x0f|#C&\xa3\x8cA\x83z\xb4\xea\x89\vb\x06d܈\x93[I\x8cU?]1\xb9CϾ\x10\x0f氰\f\x18TK:\xabQ\x06\xebP\x10\xe2;\x1dD?\xd2\x0e\x96Ƽ@\xb9F$\x17\xd5\fUo\x1bu'\xc6\x13%\xc1\xbc.T\xfar\xf2\x1c\r̸$~\xc0\xaa\x11R{\xa8\x82\x82\xceK\\\xa2Vy{\xbc\xa5\xbf%\x93\x98\xed\x80n\x9e\x99\xcb2,\v\x19-$\x9c\xf9-As)ܹG\xe63\xca\\\x05\xf2É \xa5\xf0\x13pc\xaa\x88K\xfc\a\xf6\x04\xe5\aʔ[\xf4X\x18Ǝ\x8c\x94\xb8kב\x9b\x90!4C\nB\x17\xb8{\x1c\x17B!\x8e\x06\xeb#0=\xf88\xf0Ϲ\xbcU\x839xn\x12)ڎ\x11)N6\xc14\xf8~\xc2\xe6\xe8\tbFW!\xe1\xe6݆\xa6\x87w\xc2U\x0e\x93\xb89\x19\xcfA\xdcr[\x00_\x92j\x06\x0e\"\xc0\xbbT\xd2b \xc3u\xa3\x9c\xeeUE\x9d\x8c+I\u03781\xe7O\x19&\x18/\xa5J\x9cn\x15\xa3\x89\x83\xceS}\xfbR\xf7\x8c\x88W\xa9\xea\x96\xd4\x11\x85u\x94h?\xf6\x92\xb3`\xf3q)\xa4\xd5\x16S\xac\\\x03J\x02\xac\xc4x \xee\xe4\xd5p\xed\x02m\x80\xbaji\x97\xf7y\x04\xa0\x834\xbb\\\xa0W\xc4\x1en\xca_ \x1f1\xc8D\x17U\x12\xd1V*\xc8\xebc\x10\xfc\xcb\x1e\x88n\x02Ta5y\x84+)\xbb\x1c\xbe\xe3\x8a\xcc\xe0/f\xb8a\x80\x84\xa0\xcc\x17I\r\xb3\xa8S\xe0 \xbcJE\xd8\xe4\xf5\xe4갆\x8d8*\xecH\xc0u9\xc4A\xf6\xcf7\"cj\xddxa\x1a\x1f\xbcg\x9cW\\\xbe\xf4\xa6\x15\xbbځ覺jL=\x87\x8em\xef\r\x8fi\xb2\xc5\xdc\n\x90\xe8\xc0D\x82\xb3!H\xfa\a`|ͪw]\x10\x034\x81A\x96\n<75x;\xe2k\xa6\xdd)\xadN\x1e\xe7(\xe5\xc3\xf0O\x8b\xee%\a\xd9ˇ0\xa6\x17\xed8\xa7V\xd0;\xec\xad~\x97\xdeU\xea\x06\xca\b\xd3\x10\"(\x8d%\xe5;\xc2\x15\x98\xe8ˌ\xe4\v\x191\nW\xb4\x8b\x860\r\vO\xf2\x94\xbb\xf1P\xf5-\xe9\xed\xa1\xd5\xf8\x8a.\xf0\x05\xa8'KM\xcb\xc1JB\f\xcd\x16\xdd4US\x1d\x19\x8a-T\x13\x15\xd6&\x03\x1fV\xb5\x1a\\\xa3\x96\x18P\xa2\xaa)\xf1\f\xe4\xa0\n\xf6\xa8G\xf2\x03+z\xb5\x9c\x82\xf7C\xc4b\xdf\x18\x1bTm \xd5\x02>Ajl\x04\x84TR\"3_\xf6\\[Y\b9S'\x94\x8a\x97\x90\x9e\xaf*\x9fVp81\x99)A\x10\xbc\xe0\x10\xd9\f}Ra\xda*\xfe\x16\xbei\x0f\xdaM\xfa\x05\x9d\x87WO\xe0yf\xe8@\xa5)@>@o\xd2*Wƈ\x81\xb4BF7T\x9c\xc7\xc3\x00\xa4\xe4\xab\x0f\xa9\xa8\x91\x0e\r<\x0ed\x8b\xe6\x0e\x9052\x92ؿ[\xdd1\x95\x91\x17W\xc0\r\xc8a\xb5x4\xa4\t\xee螆\x18n\x88&4\x19\x83\x84\x99\v])ˣA9\b\xb9\xf5\x1c\x04\x1a\x06\x9c \xc2\x10\x9bZ\xa7\b\xc4\x1a\xe6\xc8\xc0\x9d\xabJD\xb34`f\xb8((P\xb1O\xaac\x90?\xf8G\xa0?\xe5.\xe1\x8cW\x86u\x06\n\x11\a\xb1\xb3\n1\xaaA\x10\xee\x86rAH\x98\x03\\ɚ\xb4!\x86\xb8AI\xd8\"\xaa>\xa8\xc5\x02\xb59\x14\x90\xb6I\xedB\xf6\xca\xeb\xad\x05j\xe8Z\xbe\x93\xf9\xab\x88\x91\x19ko.[`\x92\xb4V=\xb5x\x12\xa1[Ҙ\xcfSJw\xbcH\xd3z\xc5\xe5\xf2W\xed\x0e\x92\x99\xaa\xf8\x80\xc2D%\xf3\x9a\x03\fΣ\xb8\xd8p=pYR\x97\xd1\xe17`C8\xf9*%\xb7\x9a\x1b\xfc\x92\x1ax\x03\xc5'\x83\xea\\*\xe1o*\xb6\x14\xe9\xc4\x13L$hw<O\xe4C\f\x94\x87\x06\x81S\xa4\xe6\xd2Z\x02A\x01\xef\x00\xf3\x88Uf\x10E\xa8\x05_\x99\xd2\xf2*\xdeq\xc5Hl\xcb\u007f@e\xcbu\xd5\t\x83\fC\x8a\x04\x99\x98F&\n\xdb7U\xfa\xaf\x90\x90G\xb5\x9c,\xbb-T\x11*\xe3U5\xbf\xf7\x95m\"\"q%\x87\xae\xd4ʥ\x16\xa9\xf8\x04\x84\t\x1a2\xe3\x1c\xf2\xebל\r\xb3\x85\x1dN\xe2\x98WvÁ>◌\x0e\bH\xc8\xe8E3\b\x89\x81_\xaa\xf5\xaa\x15\n\xbb\xf2\x12\x95i\xe2\x9d\a\x01]\xbb8İ\xe1D\xf7&չ\x0ex\x94G$\\\xb6\x16\xb6ga\x94H\xda\xcd\a\xaa\xa4ϸ1\xabꚰ\xf0<V\x82\x83p\xd7L.\u009bP\" PTd\x0e\x8aq\xb5P\x83\xb4\x81\a(6\xf8\xcc\xf4\x93*\xf3܂\xe8D\x84\xf0\xde\x1eU\x1dWE\x8aI\x8a\a\xc7x\x14\"\xaa\xc5 q/z\xaab\xd8\xf5\x12\x84\xceX`\xdb\x12\xa2SqTA0R\xbeD\xcc\x13c\xc8{'\xf3\xa4\x92\x04Ä\x98#\x05\x98n\xb9ᩦ\fI\xbc\x0eŠ\xa8\xd0K\xc0\fЫ\x0e\x04 \xb51^Z\x14\xf0X'5G\xf0\x03_7*\xb8\x9f\xad4|n\x85\x18Sesq)\x8f|\xee\xeaǙ\xaa\xc1O\xf9\xea\xed\x16ύ\xce\x15\x1bᒲ\x96\x1ee\u007f\xb1\x10\x80\x03p\x04|\xa6\x84<\x06\x98\x98\xbc\x8d\xa9;\x80\xa6\xaer\x1b\x8c\a\xa9\x0f\xf5\xc6\f\xd7\xd4\xfe\xb9\xd5\xf6\xca,`\x1eU\xeczE\x1a-\xae\xae\xf9\xd6dz˪-'ԂV\xb0cɸ9\xec\bSHbiqf\x06מ\xfe&\"qcA\xb558\xa8\xd5Z`L4\x1a\xf8\xce\xe3\x0e\x9fTJB0E`\xba`І\xd64.\x8f\x8b\x95\x02{aR\xa0V=BW\xe5z\x81\x9e\x96\x18A\x1cF\x88\x8bb\xd4{ă\xa8a\rn#\x94\xb4\x9e\\\xceL\xcc;\xb3\x9fe(n\xe0\xc3\xf7\xd5B\xddɘu2:\xa8\xefI\x8b\xa6\xe0\xd7TFŭ6\xa1t\xa4\xc0\xad\xa9\u007f\xa4K\t\xa8\xb1\xa0\x81&Z~U\x1e-\xc3᪢\xb5\vOz\xc1;/\x9dF\xb6\xaa\xbbD5[\xf2\x01\x0f\x8d\xf3\x1a\xeaGP\xc3\x0f7\x86\x1b\x01\x8aZ\xceDz\x91\xb1E\xf8\x04\x8f\xef'n\x91\xe3Z\x87\xf5\xd2\xf1\x84? q\x19\xf6A\x98\xcb\a\x10\xe4\xf51\xaa\xa9\xa0\x9e\"\xf6\x03\xe6V\x97\xcaW{O\xd2:\f\xa6\xa6Ղ\xf1!;\x89g\b\x97\xa8\xd0Z\x17P\x17\xf3\xd3 \f\x0eN\x04*\x938\x9e\x05\x81\f\x83\xf2\xfc\x98\xf8\xae.\n\x1esNs*\xe4ިZ\xef\xc4~3\x81\xd53琧<\x00\x91\xfa.Ebe\x1fԝX\xf0\x9eʞsn\x1eC¡@9j\x19ւ:L\xbd$?y\t\x91\xaf>\x90\xaau\xb0vI\x15\xf5?ܨ\xa5۠\x8e\xadƀ\xdbY\x9d\xbb\xf4c\xa8\x89%\xa7\x84$\xcd.\xa2/\x00\x1e\xechT\x9b\x0e\x00>\xb8\"\xfa\x15dkZ\xf1ѷ\xa8S\xf5\\\x00\xb4\\\xfe싩3$\xcd\xc1\xea\xabX\x0e\x16w\x87qE\xbdƷ\xc8K\x80\xa1\xed\xc8\xdb\b\xd2\x1e\x8f\xdc\xc48\xaa\xc0\xae\xb5\x9b\xfeHWunDj6-'y\xe9\x99}\x0eހ1ZU\xcb\xd6\vtj\xb0\xbb\xca\xe5蝩\xb6\xbbUPF\x00\x14JWhʸj\x85\x8fq\x96m$\a\xf7\nZ=\x92\u07fd\xc5!\x1a\x8c\x8b\xed\x99#2\\u\xb6\xa94\x96\xe3\x87\xc5aDSS5\xb0/\xa3\xa9\xc5,\x1e\xb5`\x0e\xbc\x9aq\xc2\xeb\xea8\x12\xc5\x0e\xc4~\xec\t\x01\x88\x9cO\x06\x80\xf1>\x93\t\x97\xf6\x83\xed\xaf\xa4\xd3AA\\\xdd\"\x986y\xa6\xd1}\xdaV\xe0hu\xd6\xe06\x9c?Z\x0f\xe4\xf6\x00\x9e\n\xc2\x12\xa9G\xfd3\xa4\x15L\xb2\xdeZ\xc9\xd6Z\xddD\xb3\xab^\xc9t\xa8\xfa\xc3sy92F\xcf.\xd1\xe2@3U\xd6\x10\xed\xf9.U\x04*\x89@\x80\xe1牨\xea\x11\x86\xfev\xb0\x0f\xf8\xf1\x10\xba?j\x0eh\xb2rj3\x18Kk\xaa\x8c\xb8\xb3\x94[\x16\xb0\xaa\xc3\xef\xf0Q\xb2\xe39ɈA\xfc}\x85%\xe9ڙK\xe8\x85@\xd9*\x81\xa8\xc5EJ\xa3\x00\"\x00Z\xecm\x82\xd9\b1\f46\x15\xa6\xdd\a\x0en\xe2\xf20\xa5\xdfI\xbd\"\x0e\xa8C\xedCA\xbc\xa2b\x8bB\"Ȍ\x99 \x0f7y\a~\r~\x97?\xb2\xa3\xa6\x1eu\uf831/j\x1b\xfaC\x8bG\xeedwDV)\xbb\xa5\xa1x\x95B䑑W\x84\xf0*\xd8Z>\xd71\x8ej\x17\xe1\xad0V\\3Ʀ\x1e%l\xca\xc6\xfd05\xb2v\xd3g\xa4%\x84\x01\xb3l\xaf\xd5\xed\xfaڈ$\x97H(\xa4\xfbrj\x0f\xc6\f/\x14;\xa0\\\xbd2\x99\xac\xd6\xc2\xd4\a<x\xd4aUk\xa0\xe4h\xc886.\xcf\xd01\xaf((\x86h\x14\xb1\x93\x03\x1c1\vM\x10X\xa0\x81\xc3@\xa1a\x04j\x98&\xee']\x9e\x1bkB\xb4'\x89K-\xd97\x95\xcfW\xee8T\x84}\xc6w\xf7\xea\xaeV\x8c\xa7\x9a֪\x8a\xc0h\xda\vk\x1c%\\\xac\xb8%T\xbeJ\xddZ\xab\xcb\xf8\x1e\xc6\x11\xa0\xc4k\x92$\xe4\xfc\x01H\x8e\x1a-\xb9\xa3\xfd&\xa3\xca\xcc\"\xba\xb8PQ\a\xf2V%\xf0\xb5\x9e\x01\x05\x98\xb2\x1e\x06\xaa\x1d\x83R\b?\x8f\x8c\xd9p\xaf\x048\xfe\x1e\xbd\x91\xba+r\xe6\x98T4P\xdf\x18\xf6%\x01\xfb:\"&\x91XdY:\xf0\xfc:\xf67L\xe8\x89\x02)\xa1,\a\x9d2\xaeV{\f2ӟԫ\xed\x85\x0eZ\x94\x82\xdd\x12z\vA+\xb3\x87\xa9\xd5b\x01\x02\u007f\x90I\xaa\ry5\x0e\xa3\f\xe0nL\xc0D\x97\"\xde\x10\x1f\xc5\xc1R\xd8h\x9e\x8bXD\xa8\x91\xc2\x1a\x93\xac\xb5\xa8\x0e\xf2\xec绋Z[Ԣ\x94\xab\xbaɓ\x90\x87$\xacZ\xa4ސq\xf5\xdbݮ^O\xe0\x00\xaf\xab\x1a5\xbeS545\x05\"\xb1:\xb9\x8a\xb3\a\x97&^\x8f\xe8\x04~\x1b9\n[\x14\x14C\x85\x15\xd1դ\x83֎\xb6v\x93\xe0$\xfa\xbd\xfc6\xf0^\xde\xfa\xb7\x16a\tL5\"$\xa9\\\xf1\xacr\x96\x9fV\x85\aڕ\xa1Ǭ\xf8\x1b\xd1\xf3N\xe5(\x8d!o{h(\x8c\xb9\f\xfd\xf0\xa4\x19O\xa8\x9a)t\xab\xea$\xaes\xf9xк\x93\x17\x98\xb2\x9aK\x81\x18f\xc7\xe0\xba\xf3\xdaE\x82ʃ\r\xe2&\xcd\x01\xf1\xb7\xf0\x87\xa3\xdfj\x84F\xe6.\xd4{\xf7\xea\xc5#\xb4\xbd܌\xfa\xe3Q4\x19\xf3\xaeZ\xd9^n\x17Se*\xaa\x016\xa8\xf6\xc5\xd3A\x16\x88\xb7F\xdeB\fZ\x03\xd3F\x91^P'\xf0\xc0\xc4jx>\x16\x8e\xadZ\xd1?\\,T\xe41\x02[\xa2ju\xad\x80\x01\x95\xa6Z(Є\a\xc4\x1d\x15\xa8\x15\xfc\x82\xcc\xf0\x8e\x00\x00쁂_&\xa7K\b.\x11\b \xb0\x93\x9bj\xf1\xcc\x19۟\x0f\x9a<\xa9\r_\xe6\xcd\x13\xec\xe4\x1b\x8a\x9c,U\xdf\x01\x16\xbfu\xf4\xa2\x16\xbaP\x81\x13M\xcbÀI|j\xb9\xc71\x9d'c}\xc7!\xd1\x18\x91\x98Ug\xc5q\xa8\xc4鱐\xc8\x1c\xe6G\xcdPYR\xb3\xa9\xefg\x10\x17\xe47\xe9\xab\xea\xf8E0\x10ٙ\xe9\x82z\x96lpR-\xb4n\x95`\b\x03XI{j>\xad\x06\x9dG>\xfc\xa6\xd6\xf5\x98\xef\r\xd3\twՅ\x9a\x17(\xec\xd4@\xb4\x172\x15%\x86\"\xad\xf95\x1b7\xe92\xac.\xfc\x82\xdb&\x80\x8e\xe4=ʋ\x148\f\x1ay\x81\xa1i\xe5\xbe\"\a\xccz]\x9f\xb2\xb7x\u008bZ@0\xfa\x97\xb0\xfc\x02\xbf\x94Է\x8e\x14AlEY\xe5R\xe5{n\xbcC]ǀ\xe1U\x87\xd7x\xce\xc7q;\x88\x83\xa8~\xf9\xb32T\x00\x9eb\x92\x068\xa5\xa5\x9d+\xc8((1\x14\xbd\xa1ՈdQ\xb1@\xcask*\x13\x95\n\xa3#F\xa1Y\xc2^\xad[\xbf,\x84mu\xffB\xf0\xbb\xab9\x03\xc2gJ\xb1\xdc\v[\xc54\x96\xc7]\xf8\x8b-_X́z\xf0\x8bW\x15L=F\x01\xae\x02O`\x00\xc4G\xc1\xd0\x12\x0e8\x9e1\xc8U\x98T\xc5g\x1c'\xfe\x8bI\x83ȅ\xea0\xab\xcf\v\xbff\x00\x83D\x04\xe1\xc9\xc5\xd0\xff\x10m\x9aj\xe8\xbdx2\x98\x86\xc4`B\fVY\xf1\xb5\xfa\x96\x01\x93!LIш\xbd\xefjtu0/\xa2\x01\n\x8fjq\xe5Z\xfcJ'\xe5\x80\x12ނZ\bZ\xb1\xd5\xca\x14\xaau\xe6YQ\a\xa2n𗫢*T\xe1\x00b\xdc \x840\xac\xaa\x12\x17\xfc\x92\xa4>\x18\x84co\x12\x12\xb9W\xf0\x97\x14\xd6℩G\xa2\x13\xeb@T\xab\x99\x9b\x06-\xf1\x9aL\xe6\xaan\xab\x1f!j\x11-3\xd6AnT\x8e\xf7\xa8\x93\xa6\xa9q\b\xdd~\xd5¥F<d8\xce[\xcb\xdd5`\x0f\x00Y\xafu=f\xb9p!\xe6\x06\x15Hx\xc2\x0e\xb0\xa4:h\xbc\xfa\x8e\xfa\x95\xbcb<\xb72\x1ep\x83\x19\x91\x93\xa8ƫ}\x1cj\xd4d\xbaU\xcc=x>'\x17\xb7|\x02\x01Չ\x17\x18K\x8c\x84\xfa\xac}:$\x8d2K\xdby\xd4p\n\xf5\x0e=|\xc8\xea\x86\xf6\xf1\x89\xfeT\x12\x10\xb8\xae\x93IE\x1b\x81R\xb0z\x86\x13\xc7\xfb\xed\x91\xd5f\xcf\x1f\x12\xad\x80F\xf3\xbc6~X\xf7,-\x83\x9cvfE&{y\x8e\x01\x10\xb9\x01\xec\xc2C\xea\x02x;O\aAT\t\ru\xd9bĲ\xa9\xc3\n\x8e{\v\x1aHK\xad2\x02\x93\x98s\x02\"OC@\x938\x86`G\xb3⪕\xafhˎ\xebU\v\x9c\"\xb1\x1cU\x16\x92y\xed+IZ\xb5\xe1鳶- \"\xb5\xba\x01\xb4\x81\xc0j\xd6\xe3\u007f\x0e\xb4A\x9e\x89\xedՊM\xf23\x14\xe4^\xb8\xdaI\xd3S\xf0\xfc\xa2\xba\x87a\xc9l\x8c!\x88\x82\x8d\xbaj\xf0Qe\xa7M\x1e\x16ϥez\x93v\x00\xa7\x89Ȁ\x97 j\x94\x14i\xab\xa0\x91\x99\xe8\xae.Ϯ\xfe\u007f$\xcf\xd5\xfe\x81W\xd5Uy!K9\bH\xb9#4\x96Ď\xb6(\xa9pYU\x0e\"j\xa7\x1aX\xc1i\x95\xa6\x8f\x1a\xb1^+ckM\xea\x06\xb8b2TM)\xc1\xb4\xc9p4|\xff\x06\xb7r\f<>\xa9O&Z\x91~\x81\xb5\x80N\xafI\x89\xaf\x04\xeb\x9b\\\xcb[\b\xc3^\x806\x80\f\x91\x83\a\xd7hb\xfc\xf8%\xaf\x8e\x02\x84G\xb0<ς\x9a\x86\xaa\xe1\x82\xe2).\x81\x18e\xf66\xf2\x1bcY\xd4\\\x8d\xe0j\xf1\xad0hiϘ\xdd꼚0\x81D\xc0\xa1\x84\xaf\xf5ȭ\xb6-\xac\x85\xba-.\x135\xfc\x82Pϓ'j\n&+\x906\x98Y\xb4YJ\xc8\xd2n\xdd\x1128\xaej\x13\xdf\xeb\x05駶\xced#}\xe7՞\xb1\xa3>*l,)Y\x19\x18S\xb5G\xab-hj\xec\u007fS\x14t\x8b\xc9U\xe4\xc4\xd2\xf2\f)*\xaa\xdde\xa2\xec`\x06\x954\xd5X7\x10:\xd0sQ\xcf\n(\x0eϊ\x8f\x189\xb5\xf9\xf8\xa2UϨ8Rg\x83\x9a\xaa\xd5t\x0f\xa9\xdey{\x16\xeaĳ\xb1)\x81\x88T\xf3C|\xb5}̇\nQYE\x1aK\xc2d\xb0\xfe\xab\xc5\xd6\xf1\x0f\x9b\xaa\n\xa0á\xbcԵjyե\xa4\xd6\x18{m\x06<\x1fbW-U^n\x8b\xb7\x93&\xdcޝ\xfa,F\x00\xc1\xe4\xb4\xd9\xe7\x92\xc9Z\x9bE$i\x1flAN\xa7\t!#\xa9`.\x1e\xfc\xaa\xf82\xd5\xe9V\xa3\xaat\x98F5\xectm\xb8U\xf5\x8f\xc0\xd4N\xf1\x8b\xab\x19\xda\x1f\xb8\xe5d\xfbU'\xa6j\xeeW\xfb\x14*t\x8a\xbaW?\x1a\xa1\x0e\xb9\x1e5\x04iW!\x11\x86\b\x00[\xb9\x9f\x95\xddS\x13{\x02\xd2G\x8bX\x06\xa4o\x8c\x06h\xcf0\xec%ς\x88QC\x9c1\x14DLW\x17\xceD<\xa2@\xb1)\xb7\x80_\xa8\u007f\a\xe2\xc2\\\xdaOK0\xa9\xbc\x96^g-X\x8d\x97\"1\xfbB\xc7k\xd1\xfdu\xdc\x10\xb9\xea7\xc6\xdcz\xb0\xa7\xaa\xa3\xcc35\xe87\x97\xb5K.f5\x06C\xbaZ\xa6V\x99\xe2\xa8\xdd\x12|\xef\xea\x8eQm,\x81\x91\xbaPV\xf3\xdd\xc8J\x12\x10\xf0䬪`:hȕ\xae\xfa\xf1\xbb\xa2\x14\xe0\xd4\xf4!\x95\xd4Z\x80\xc5*P\xa7\x02\\P\x9d\xb5\xb7{V\xb5\xb4\xaa\x19\vg\x0e\x8a\x19\xa1\x94\x8e\\\xb9S\xfb\x06\xf6\x028\x1b\xd2k\xe0\\\xd6V\x14m\x8a\aJ\xd4\xf6:ԥz\xb5Yh)\xbf'\xefx\x1d5=\x1e\xf9b\xaf\xf2h\xcd\x0e\x16\xd3\xca\vp#\x96Q\xff\"\xd4`g%y\x9e~\x10Rd\xc6!e\x10_\x90\x18\t\xaaL\x8a9c\x9bHҡ\x1edP\x01\xa6ղG\xd0n\xa4W\b\xc0<\r\xf5k6\xf5٣\xe3&\x9f#5\x87!\x89\xda$\xf7\x1aMIY\x1c\x9a\xdaB\x93\x14\x186\xa9\x1c\x97\x06\xe2s\xabQ\x01避\x00)\x9a\xf6\v\xf1\x9e\xa0E,\x84\xc5\xd1,\xe5\u05cfBR\xbe\xf8\xe7nή\x16\x9eJg\xb4\xac9\xdct\x14z\xee\xfc\xfa듈\x01\xfb\x06\x0el\x15\xabQ)8\xa0\xf4\xa8oag·\x94\x00\xb8\xa6\xb5\x83\x86\xddHh\x9cۜ\xf6\xfa31\f\xc1<\x01\xce\xc5Ϩz\x12MŰr\xb1]\xbb*\x9a7\xa6\x99OƂ\x13\x8f×\f\xb3 \xb0T\xae?H\x92\xe6\x90x@\x0e\x11\r\x94\x83(8y\xf5\xe9\x10Eb\x9c,\xbb\x8e\x11\xc4%\x81\x90\r\xfbX\xc1\xad\xfc\x94t\x02 \x98T\xb92\xacSt\xf5\xed\xa3\xe1^\xb9s5\x88\xab\xbd\x1d=N$!\xda30\xa5\x9eƨ\xe6Ƞ\xbd\x8d;5ñ\xbf\xf21\xb2F\x8b\xb2\xeak\x98ƣ5rvkcţ\x19u]̓\xae\xa9\x19j\xbbj5\x04ާ\nQ2\x01Zf\xe2yղYԐ\xa5\xe5A \x12\xf0\x17\xf50\x9fZ\x9ff\xa6\x03ц{\xc8Q\x95\xb9V\xb5e\xa2\xaa\x93:\xa2O\x8a6\x1fU\xb55j\x8a\xd3\xeb;G\x95nk\xb2\x81\xae{\x02E;`\x04^](\xe3\xc3W\xf3\x8a6\xfdj\x83\xc9Y\xf1i\x96\xabN=\\\x0el\xb6\tW\xed\xb23FU{V\x16\xe6X\x15\x1d\xe0E˼\x80XQ\v\xf8\xc2\xd8\"\xb3\xb7\xea\xfcG\xbd\b[\xa66 \x1f\x90\xc0\xd8Q\xaf\xb52\xec\xc9Q\xdaj\xd5Y\xdd\xd0<u\x8b\xcf\xc0b\xac$\xf1\x80+\xf4*w`>\xf9\x87˰\xd5/_њ\xb9M\xad\x13\x82\x01\x00X\x898HU\x03\xd5E\x0f\xfa3\xf98\b\x99'B\x92$KSm\xdeaq\xd7Iu\xff\xebO5x \x12SQ\xebg\xfe\xed\xbf!V\xf7@\xfa\x8d\xf3\x9aR\xb4\xfd\xe5j\x83\x01\xfcu.p\xa9\xeel5Rk\xcf,\x84T\x83zS\bH\xf9\xad\xd5\xdfJ\xc3ۀ\xa9\x86\n\x98v\xbc}\x9fd\xc0P'\xc3ŷ\x91\xdf\xfc\xa5V]&\x0e\r\r\u007fU\xd5\xe8\xfa\x98\xaahk\xcfu\x0f/U\x88\xa7\xa6\x96&\xf4\xd3tְ\x8f\xf8\x02\xa6\xa3\xf1\x1e\x86\x98\x89\xefo\x83\xdbЊ-\xd9\x01nUm\xac&gUe\xd7.su=oR.\xa8`3\x82\x1eM\xdd\xff\xda(\xa7}\xa8\x92#kq%\xad\xacbL\xaf\x16Y\xa3\x80.\xa8\x05\x89\xa8U\xbf\x8d6\xb6544\x8a\xb7e\xadz\xb6\xbcBsj\xe5\xf5\xea\x10\xf3\x8cmMj\xe8\xafj\x85\x8bU\f\xa1\x92\x92f@A\xb1\xfb\xf5CB^{\x1a<9#\xc1#\x14\xf3*\x94;\x1c\xb2:̰S\xea\xb5숈\xa9.@\xd8#&\xb5\xebU\xed\xbc'\xd0@\xebTTy\xaf\xa8=tEa\xfe\xb4\x8eS\xda\xc23\xa6\xe40JD\x11?#\xfb\xb4\x01g\xcc\xf7\x90\xda\xf7\xe8ߊ\xdfk\x0f\u05ecݪe*D\x95\xf2U\x85\xb97\xe0\xda\x00\x91VD\x1ek]\xefX\xb1\xa2\xccXW\xd2\t\x10`\xe4\x8e\xeap\x95\xf1\xc1\xd2d\x14e\xdc\t7\x0e\xd8p!\xd5\x16\x05\xc30\xf2~%\x17sj\x14\xd0v\xdb\xc7̆\x1exE\x8dtԇε\x91DK\xbdj \x10aI\x90JЀ\a:|\x83\xe7\xef\x00\x1c3\b\xaa\xb8S\x91!|\x1erE\x85X\x9e\xe4$u\xa6ݷ\xab\x8fQ\xd1^HC\xed_<G\x9e*-H\xb7\x87!\x8f\vfj\x8f\x15\x9f\x02\xf7\x9f\xa0\x9dV\xd6\xd7\tؐ\xb4\xa6\xaa\x99W\x1b_\xb7\xb6\xb8\xa1\xa4\xeb.\xab\xaa\xf5Q\x06\xe5j\x13$l\xb5\xb4k\xef`\xca$5Q\x18\xaeUE\x11\x93q\xb5\xa0\xba\x1f1\vSmK\xa93\xffuĨVPm\x8fC\x87\x05\xd5\n\xb1s^\xfdIږe\xd0 \x98\xe8\xa08`\x03}\x85\"Q?/\x90w$\xee\x814\xec\x16\x90r\xd4sS\x80\x19\b\x95\xa0\xd4\xfc\xce=\xb5\xed\xf2\xe2cL\xddad\xbc\x1a\x99`\xa36\x866\xa9Y\x17\x9a\x11\xef\xc4\xc3\xe2\xa7P\x02\x86_\xfc~\x11Fj\xa1ڕ\f^jU\xc3\x04\x91\x0e\x1b\xcb\xd5\x03I]\x9cĞ:\x0f1\x867\f\x9cE\xcd\xea\x86FZ\xe15\f\x82\x9dφ\xae\xd8I,\x93\x14U\x132\x06\x14\x17\x85m\xd66\xce\x16\x12B\v%>\xa0)\xb5\xa6\xf2\xe8o\x83R\xcc^\x8br|\x83)E;k#\x96\xaa4\xda)\x02\x87\xa0\xac\x8b/\x00%\x128\xcb\f\xbf\x9d7Z`\xd9\xf0\xb7ύl\xac\x00\x9an\xcc\xd4\xfbpe+\xfa+\xf5v=\xf8Uc\x9c\x1a\xa6\xef\xee'\xb4\xa9\x85\xc3\xdb\x01.\xe0\x12\x18AA\x14&\x19ރ2JТy\xd3\x1e\xaf\xac\ue668搉8\xe8j\xf3[jC\x97\xe9#\xf6\xb4ʑ\x19\xafg\a\xe7\xebѪ\xc2\x17ծ1\x14\x1e&};w\xb5i\b\x89c\x11Yf\xaaOE\"!\xac\xa9\x16\xbe\xa5\u07ba\xea\x8b\xdaL/\xa21'\x91<ʟY\xf6\xa1\x99\xdc= \xa3\x05\x00FUk\xf1Y\x1d\xa1\xed\x9a*\x04Rs\f\xaf&=&\xee\xcck]`j\a\x06\xf2\xf5 \xa8\x1d\x9c܄b\x92\x82\xda|JR\x16\xe0\x181\\R\\\x00.\x0fsH\x01\f\x98ʺ\x12q\x8c\x1e\xce\x1bY\xbcU\xca\xd1\xed\xf1YN\xbb~ۍ]\xfbTj&\x93\x10\xc3\xe8\xf2\xa5-Eĭ\xda \x89\x1a-\xf0\xa8[4q\x1f\xb8\x81]Pr\xa63.\xca\xe4\xd5X>\xe7D 8\x9e\x9d0\x1d\x8b\xf4\xcbW\xb1\xd0\xf1\x00:Z\x10\x84%\xa5U\b\x99*\xc5&-\xbc3\x03\xdc\x10\xfe\xd3qYq\x01\x06\x9eTf\xaa\xf7\xf6B/\x1eXgg\xa8]\xf9\xbcml|\x1c(\xc5\x10 \xa2\xe0ҷ\b/߄\x8eҖxs\x9d@\xce\xe8#m\"R\xcb@`\x94\xf0\xef\x87\x14\x1bo\x0f\xf4ۜI^b\xfe\xb1\x06b\xb6\xac\xadb^i\xcf\xcd\x01\xed\f\x92:4ǘW=\t[\xcd\t \x99J@\x8d\x18,g\xcf\xe0+\x0e\b\x8b\xfa\xa4!Jm`ָ\xe6\xd5\xf8\xa9p\x9b+ٲR\xd4Z\xb6\x00ܿ\x8d\xad\x18IuO\x9f3\xd5h \xdc6\x03\x9b\xa52E.<!\xf9\x8e\xfdjo\x83\xaeV\xddȡ\xa6N\x8bٓSw\x17\x82\x06\xb7+\x19^\x8b\xdaLF\xd4F\x8dܴ\u007f'\xab\xf2<\xa7@:\xc0p\xe8\xb0r4\x9a\\\xabh\xbbxhA\xfb\x9f\x10\x11jE;\xeb\xed\xedZAmqj\x17:\xea\xb0\xe7ύ\xda\x00\x18\xdeA\t\xb2%S;F1 B4\xaf3M\x06\xc6a\xab\x15F\xbb\xc5*`\xd1\x1an\x89\xf1'?\xb0\x8f\xbb%\xb5p\xa0\v@\x80F\x8a\xe0T\xaa*\xb5K\xab/\x04\xbd\xb6(\xa0Z\xb5\xa6\xc3@i\xe3\x86\xc3=\xd5\xf5\x1a&\xd4܈\xc6\rZ\xcb\xc5 \xe3\x143d\xce-j\r\x1c\x18&\xc91\x00\vW>\xb5\xe7W\x1d\x9dg\xa9\xfa\x83\xaf\xb3趖/\xde\xce\xfaJD\x04\xb5\xd1\xccŕ\xd5\xfb\xa2\xc3C\xb4\x02(\xb5y\x8c\\&\xb2\xc3C\u007ft\xa2\xa9y@\xad\xd2`g~]\xacK\xa5\xa7\xf3Nv\xd0\x1e\xf6\xb1u\x1c\xd5UD\"\x1f\x89QP\x15Y{p\xf8\x99\xdb!i\x80j\xf8\xfb4\x92\x98\xc0.\xa4XL\x17\v\x81\x1a\xf7\x06쐨E\x8d\v\x8d@\xc7\xf3\x1f\x12\b\xba9\xda\\\xb7\xf6\xebW\xdcQ\xfb\xf3\xb5`\xa9u\x05ug&-i\x89G\x06\xa6Fk\x8c\x04\xbe\u03a2X*X\xa8X\xc9\x1cc\x05\xb4\xb7\xec\x95\xefO\xfe\x18U\xe0\xb3\fD,io\xea\xfaWQR\xc1\ng\xba\xb7\xdd\x14\x0f\xe4\v\x1ezd\x95\xf6\xaavP\xc76\xa7\xb4)L\x84<\xd8\x1e\xe1h\xe2za\fp?_\x01l1\xee\xe8\x11-q8T\xb4\x9a\x01N\xd7\xda\x17a*/ߵM5\xbf\xc2\xd0\xf3ufW\xa7hi_\x81̿d\x87\xea\xbes\xae\x9c\xbd\xda\xfbx\x18'\x89\x89\xe8\x1f\x10:_\x9a\xce\xcf\xe0\xbbI\x9e<,%\xef\xed\xd1\xf6T\x9f\x04\"\xf6Ȱ]\xf5\x10kY\x99{?\xf5=\xbd\x83?\xcb\xd8\xda\xef\xcdoD\x9dD2j\x01\xee\xb3\x1a\xff\xa6\x96\xc1\x960C\x8dOгv6\x1b曄\x15iz\xa8_B\x91\x10qh0\xe5s\x1f\xb8]\xaf\xa3\x83\xc2[\x14\x99:\x9d\x81\x9fW\x1d\xd6\x11\xd0\xc5x]\xd3\xca\x03\x97G\xa5\x12G0TQ\xc7\xd8)O-\xbb\xc4\xdc,u\xc4k$-^՜a\x1c$\xe6\x816!\"m\x99\x19\x13o\xd9\a\xe4\xce\xef}l\x19d\x80\u007f\x02\xe4\xfbķ\x9f\xa5v\x0f\xed2\xe6\xadQ\xbb\f\xc0D\xd5fޢQ\x96\x84\xcf:\xb7\xe8\xaa\xefI\x9b\x15\xce\xc7\xf3t\xb9w\x04\xcaR\x01\x01\xda\x06\x13\x9ci\x83\xdfRw\"\x9c\x86\x14S\xb7r\xc7\"r\x019J\xed\xc6\vv\xb5\xe3BTČ\xb7\xa0S\x1e\xb4\x1a\xa5&\xb3\xa6\xaebt\xc6\"iO\xa8\xf5\x95\x97!\xcd\f\xf7#|\xe4\x9e_\xd5G#x\xf0|\xa1h5F\xe5\xca\"\xa6$\x8b\x81@\xc0\xb7iӅ\xceh\xf3\x8e0O:5AT\x0f\xb2rU\xad~7f\xf6ԣ\xd3\x18У\xf7\x9d\x89\xe1\xd5q\x8a\\\xd0\xea\f`\xa16\xe7g\x96\x88\xef\x9e\x01\xb6\u007f~\xb2\xd1> \x1a\xcf.c\x8d\xe6Q\xf91i\x83\xc9\x02\x88T\xae\xbe\xe4\xa9\xf6|\xe0\xb21\xc3=\n\xd2\x1b\xff\xf1\x04\xf2\x98\x9e\b\xc2ؓ\x0f\xdc-!\x89\\L\xc4\u007f\xe8=/\xfcw\x03\xaa\xf8\x96\x1b۟\xf6\"\x9dZu\x8a\xce\t@\x98\x8e\x1a\x91W\xfd\xad\xf8\xa8]\x03\x80E\xcbo\xf4\x0e6\x02₼\xf9\xb76f\x145\xcb\xe3B\x8f\xdad\x83\xb6\x8b\xc30N\x9b'\xba\x8a\x94M'[\xe8Ȟ\xb7\xc0V&\x93\n\xa5\x89\x0f\x88F\x03OխL\x12\xd4ϲ$\x88\x00\xf5\xe0\xa4\xdf\xf6\xe5zܮ\xeb1&â:\x04\xae\xe4\xa2\x16;P\x15\xd5\xcdA\x06\x162\xf32Qx\xf0\xa3\xad_\xdaN\xa1\xe3X\xd0\x10*\xed\xacr\xf1\"\xd9\xe9`\x1a\x15-\x0fz\x11y&\x81&\xa5\x0fq\x15\x1d\x99\x81\xd8\xd0>\xcf\xf6Zc\xd2־{\xfd\xb8{{瘴\x91\x87\xb6\xa1\xaf\xe2\x10\xe8=\xa8\tFG\x1f\x00}dw\xdeF\x90\xbd\x92]Q\xa3\xfb\xe4>tГ\x94@\xe5]S\x02I\xeb\xc8ĺ\xdeλ\xcf\xc6\xf8\xa9&\xb2 0\xb4\xe1M\xc2\x17\xe0\x00\x038\xc5ıh\x87(AeZ\xfd~;v\xc81\xadki\xc5\x01\xac\x03\x13\x11\r9g\a\xfaX\xe1A\x9a\xb6^]2u\xeb$\x17\xe6\xb11\x99\xe0;(\xae#\x19u\x90\x88:\x88\xcekʫr\xe2\xf8\xe8\x88WC\bH]\xba\xa5\x86eu$\xf3\b}\x81\xbfj\xa62U.\xe3P\xef\x01\xd8ݡU\x9d#3\x82V\x8d_\x17\x83\xba\\FB(b\x04@A\xa5\x88\xb67\xedW\xcc\xc7rd\x1dJ\x10T\x87Kb\xff\xa2u\x01Xn\xa8\x8bDG\x82\xbc\x85\x00 \xa0\xe1\x1f\xf9\tʎ\xc0P#%w\x14\xb4s\x10\xe7\xb6$Pd\x80e\xbbu\xaa\xd9\xd2\xce\x02D)\xc1\xa5\x16:\x95\xe3\xb5\xfbi\xea\xcc4uKb\xecx^U\xafգ\xce\xf4\xfb.\xb1\x82\xd0GH\xb7\xc9\xe3\xf4\xb7\xac\xb7\x88l\x90B-X\xe8\x06\xa6\xc1^\xaf/\xef\xe1\xe2\x903\x90\xa9\xf2\x8d\xb6(\xeb\xb88\xef0K*\f\xd62\"\xa0\xb7\xb5\x13\x1a\r\x86E\xc2\xfb\xda;C\xe1\x02V\x84l\xf2`\xbc\x9a\xbf\xc86\x1d\x93Q\xd5\a\xa0\x96اH\x97\x03\x952\xa0\x02\x0f\xe5\xc8\xd3'.\x93\xa3\xc7\x136A\xa6\x96\xb5\xf0!\tI\xbd\xbd6j\xf7\x979ڿ]\xb4KO\x1ba\x18\x1a\xed\xcd҆B$\xe3}\x1b}\xa4\xd6P\xf2\x97wj\a/澾\x1a0!_\xc0\xa5\x85\x92\xa9\xdaL!\xa7\x8eq\x81'T\xa5\xe76\x12\x01\x19\x11\x99\x17Ѥm\x1fg]\x15\xf0\x19\xa6\x85\xd8'9\xd4l\xa1\xd5+\x9d=\xb5\xf2S\x1ar\x05U=\xb0p\xe7(\xb2\x80:B!9I\xc0\x13\xc5G\xdawu\x1a\x12B\x95n&\xf9\xd5+\xf8ͫ\xe6\xf5\xb1\xd4\x05\a\xb5\x12P\xfa\xe6u\x86\xcc!\xe3\xdbH\xeacΖ<\x96/Z\x9b\xaf6\xb4w\x17\xfb\xa9\x9eo\xa0\x11\xc8#\xd3t\xa2\x9d\x8e$\xd22&t\x80\xcd\xf9\xec\xc8\t*\xef\xe3e\x1a\xb42݄%t\x02\xcfPC\x95*\x9aD}\xd2\xc4\x13U\x19e\xab\xee\x80W\xceT\xab\x0fB\x9aGEV\xab9\x03k\xa6\x06j\x8c4\x0f\xb7\x1d\x97PC\xd9h:\x10\x06\x95\xa8s϶\x1a\x1dƑ%\x96\xbb\tj\x9d\xae*\xfd\xe8\xcc6к\x01@ą\x17\xa7@ZC\x1b'\x1d\x19\x11\xe0;\x9fⳠ:ym\xeb\x14/H\x03G\x87wZ\xda'M\xee\x81\xc0\x8f3б\xf6\x9bڛ\xfbm1\xee{Q\xaeh\xd3&>&\b\xbeF\x82\u007f\x8el/6\x85\xa9F\xfa\xa9\xe0\v\x0f\xc3'\xae\x1e\xf5֩\x0f\xecs\xb2\x05\xfa\r\xf9\x8cB\x83FZԦ\xf9\xceD\xd8\xd4\x01Yv\x85L\xccFf\xf2\xb4+\x9a(9h\xe0\x8c\x9ev\x138\xe9jW\xcbj\xd1U\x81\x15\xbb+\x87U\x87\x1a\n\x9a\xda\xf1ӕw:\xaf\xd3K\x9c\xa1\xfd\xc6\xeaI;\x92\xf2%`~!\xc8\x02\x8c\xc1\xaboC\x82\n\xb1\xcci\x13\x99a\x89\xf8\x05~\x88\xa8<U[\xb1t\xb2\xaa\x0e\x99\xad\xda\x1e#\x18\xbe\x82\x93U\xb4s\xf0\xeah'L,\x16hܥr\rD\x84q\xea:\xfd\x06H\xda\xda9?u\xf0\x12w\a\xa4\xa1Ƞ\x8f$\xd7\x02w\x0e\xed\xccH*\xc4\x10\x0fN\xca\xe8\xe8\x10\x9e~U&\x84uԩ\x0e\x9c\xa0\x8bߢ\v\xbeU\xcbC\x1e\xdb\xfe\x96\xf1\xa66I\xcb\xc6.\x1dm4\xc0\x04\x9du\x1aܬ\x9fm/\xaa8\xe1\x80H\xdeO9\xfas\xdds\xc7\xd0Y\x1es\xc8\xc7\xe0p^\xc7\xc4[.\xab?_\x0f\xa8\xfd\\R'5\x06]S;\x17\x16d1\xc7剎N\xdc\xeb\x12\x0f&6\xddZNP\x0f[W\xafJ\xd5~B/\x9b)\xc6\x04\xd8^\x13\\P\xb8I\xf3\x04d\xe4ժ\xd7\xe7\x18\xa3D\xc0\xc03\x13E`\xdc\x06\xdcT\x00\xf6.\xb8d\xde\xe4\xdfT\xf8\xd6\xe9\x1e\xa0\x99\xd6(\xb4\xeb<\xa3z\x8a6\xb3\xb6\xf4\xda\xc7\xd5|8t\xfcE\xed\xa4&\xaaB\xcdkM+\xa4Y\xc4\x00\xa6\xa1\x82\xd5c\xa1\xfdF.\xa9\xe9\xfc^u\xce\x0f\x9d\xa5\xa9}Yk\xab\x85\x0f\xbc\x99'g\x1d%F\xd2\xcd\x18\xf04\xd8q\f\xa6\xbaHQ\x15\xear\x1f\xda\xf1\xa9\xbe#\xad\x8a\x16\xad\x91\xaaC\r&^j\r\x89h\u007f\x95\x9f\xbc\x96ܠ~\x9d\xb5[\xb5\xe4\xaa\xfd\xa3\xea\xf4\x1d\x1b=\x85P\xed:\xca\x10\xc2W\x03\n\x0fcbR\xbft\xe0'i\xa6\xd3\xcf$Ѥ\x02d~Բ\xa5\x82)\x1augm\xd2\xdb:\xf0ӈB\xf5\xa7\xab\x17\x13\x0fZ\x87\x03(@\x83\xae\xe6\xc5\xc6\x14aȆ\xaa=*\x06\xeaL\xe5\x8b\xe1\xe0b$\xae\xce\xeb\xcc\xda\xe8\x04\x8c\x04\x1d\xbfP\xb5G\xfa\xad\xe1hߠ\x13>\x15\xed\x02#\x9e\x11,$#\x9ahfEv\xc6#\x15\xedw\xd7iiS\x11\xa0\xfd0\x8b\xecW\xb3\x17\xd0\xcf\xe4x\xe9^\x1d8\xea\xb4sK\x1b\xb8\x8e\x0e\xa7,Z\xab,:#\xae\"\x18\xe1\v\x1dQ\x85\x14+W۞v\x90\xc210\xbe\xea\x18\xae\xa1\xadd:|\x12\xba\xc9˽\x95s\xac\x9b\xd6\x1f\x9aή\xab\x80\x8fH\x82X,`\x8f$\xc9x~\xa8\xa0H\xd5%\x13\xf0]\x19\xcd,\x95\xd8\x10\x8d\xdc|\xf4\x03ߏq\x11\xb9\xf9*YG\xee\xdfH\xf0蹷v\x98A\x93W\r[jT\xc7y(jV\xd2ґ\xb4O\x90\xd6\xd0\xd9(\xdc\xd1[U-Ѱ\xadYg\xf0\xa9\x97\xefm\xc5\xd1&~%\xbc\xbf\xdaU!1\x99\xb5\xe8\x89\x02\xda:0Ce\xa1\xf4\xf6\x11}t\xf9\xef\x04\xfb\xe4\x97Qy-\x92\x900N\x90\xe8\xbe(\xf0\xb7\x88\xcf\xe8O\xad\xde'd\xefVM\xa6*\x1f\xeevZ\xe5\u007f|\x88\x18\xcb\"VѕN\x86\xf0\x986Aփ\x92\xaf\xa3\x9e\xd2|&\v\x1b\x17\x9b6|\xaa\x83\xd0\u007fNGs\xf69\xd5D\xbb\xf3\x94\xfb\xba\x98\xa43\xbe\xc0\xab\x11\xa5\xeb\f\x1c~\x82^\x14ڟo\xa8\xb4\x1e*1\x18\x88kP)T0\xfb\"q\xf6\xfb\xb1\x8e\x9aD'\xfe\xb8)\xff\xfd\xb6>7\xf5\xb9%}\xf0\xbb%-\"~ݔ\x8eQ㖾nH\x00\xf7W\xb7\xe4\xfd\xcf7\xf5\x16\xd9\xfepS\xce\xebH\x9e\xf7\xa3ύ錭_o\xec\xdf\x19-\xf7c\xb8\xfe\xdeh\xb9\x1f\xc3\xf5\xf7F\xcb\xfd\x18\xae\xbf7Z\xee\xc7p\xfd\xd5h\x91W\x1e(\xd2Q\xa8\x80S1\x95?\x0e\x0eh\xc8\x19\xd9\x1ej\xcc8:\x90\xab\xaa\xff\b\x9b\xa3\x93G\xb5\xb1\x0f\xda\xcc:\x00W\x9bj\xdeAP$3q\x8e<\xd2q~\xda\x19\xb3\xd2\x04H\x9f\xbe}:EgF\x92\"Z7Z0\b\xf4\xd3\xd5\x05\x18C\xd3i\xb8\xe1\xb3Ws\x9a\xda1\xfe\xd5}\x87\xeb\xfe\xd5\xe4\"\xff DrK\xb0\xa1.B\xe4\xf8\xef\xc2\xc2\xfd\xeb,\xfaSX\xac\xfc\x9b\xf9t\xbf\x9dP\xd4\xd0\x15\xce\u007f\xf2_\xfd\xef\xaf\xf9\xa2\xdc\xf3A\x80\xa4:\xce\xe7\xf1\x1e\x06\xf49\x9dZ\x94\xaaλղ\xbb\x81\xc9\xf3\v\x03\xfep\xa4\x1c\x1fwww\xff\aP\xbc\xf3\xfadg\x10\x8f\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\x1c \x00\x00\x1c \x01\xcd\x0f\x9b\x9e\x00\x00\x00\atIME\a\xe3\x03\x1f\x12\x05\x13\xf5\xb5<\x10\x00\x00 \x00IDATx\xda\xec\xbdw\x9ceWu\xe7\xfb\xdd\xfb\xa4\x9b+\x87\xae\xae\xee\xae\xea(u+g\t\t!!!l\x016\xc1\x02\x8c\xc1\x06\x1b\x1b\xcc\xf8\x81\x8d\xc7ix\xd8\x18\r\x0f\x9eq\x9e\xb1\xfd\xfc\x00\xfb\x1933\x06'\x04\x92E\x92\x04\xc8B\x12B(\xb5\xd49Uw\xe5\xbau\xf3\x89{\xef\xf7\xc79\xf7V\x95$0\x1e3\x98\xb1\xfb|\xfavWW\xbe\xf7\xfc\xceZ\xbf\xf5[\xbf\xb5\x8e0\xc6p\xf68{|\xb7\x0fy\xf6%8{\x9c\x05\xd6\xd9\xe3,\xb0\xce\x1eg\x81u\xf68{\x9c\x05\xd6\xd9\xe3,\xb0\xce\x1eg\x81u\xf68{\x9c\x05\xd6\xd9\xe3,\xb0\xce\x1eg\x81u\xf68{\x9c\x05\xd6\xd9\xe3\xfb\xfd\xb0\xff->\xa9N\xa7C\xb1X\xfcN?}\f\xd8\x03\x9c\v\xec\xc8\xfe\xbf\x15\xa8\x00\xc3\xd9\xffE\xf6\xe86V\x03`\x0eh\x00K\xc0\x02p\n\x98\x01\xf6\x03ϼ\xe7=\xef\xa9\u007f\xb7\x9f\xd7\xeeݻy\xe3\x1b\xdfx\x16X\xffZ\x87eY\xbc\xf7\x83\xbf\x8dQ\n\xdbuH\x12\x85\x14\x82\xf7\xfdʻ+\xc0u\xc0\v\x80+\x80\vm\xc7\x19\xbe\xe2\x9ak\xd925\xcd\xd0\xc8\b\xe5J\x1f\x85B\x11۶\xc9\x17\xf2\b!0\xc6\x10G1I\x92`\xdb6\xcdF\xdd]\x9c\x9b\xab̜<I\xb3Q'\f\x02\xc20\xa4\xddj\xb1\xbc\xbcH}\xb5\xca\xed\xb7\xdf~\nx\fx\x14\xf8\n\xf0\xc0\x96-[B)m\xa4%\xd1Ja;\x0e*IPJ\xe18.q\x12\xa7\xef\xb7\x1d\xc0 \xa4`\xfb\xaes\xc8\xe7\v\x84a@\xb9\xd2w6b\xfd\xab\xe7x!\xd0R\xf2\xeb\xff\xf1\xe7\xcf\x01^\x01\xbc\x14\xb8\xfe\xda\x1bn\x92\xe7]t1\xa3\xe3\xe3\x14\x8be\n\xc5\"J)\xc20$\x8e\"\x928!\xf0C\x94\xeaP[\xada\x8c\xc1\x180\xe9_\x18c(\x14\x8bL\xed\xdc\u0379\x17\\\b\xc6\x10\x86!Z)\xa4e\xa1\xb5&\x8e\"\xfcNg\xebjuy\xeb\xec\xe9ӯx\xea\xf1or\xfc\xc8\xe1pff\xe6\xf3\xc0\x9d\xc0\x9d\xe7\xee\xddw\xdaq\\\x928B)\x85\xebxDq\x84R\t\x8e\xe3f\xc0\x92\f\x0e\rS,\x16\xf1\xfd\x0e^.\xff\xbf\xcd\xeb/\xbe\x97\xb6\x99O}\xeaS\xffS_w\xdbm\xb7M\x03W\x01\xd3\xc0\xb6\xec\xdd\v\xc0g?\xf9\xc9O>\xfc<\x9f\u007f\x11\xf0*\xe05۶\xef8\xf7Ɨ\xde\xca\xf4\x8e\x1d\xf4\xf5\r\xa0\x8d\xa1\xd9h\xe0\xfb\x01\"\xfb|cL\x9a\xe3\xb2\xd7b\rL\x1a\xb3\xe1}f\x03\xc0z\xffGc\xb4!I\x12\xb4\xd6h\xa5\x01\x83\xebyx\xb9\x1c\xae\xe7\xe2w\xda\x1c;|\x88\xfd\x8f?ƉcG\x8c\xd6\xfa\xeb\xc0\xdf\x02\u007fU.WNX\x96\x85R\nc4RZ\xe9\xc9\x11\x82\x91\xf1M8\x8eK\x92D\xbc\xe1\xf5\xaf\xe3\xd7\xdf\xfb\u07b3\x11\xeb9Q\xa4u\x9a$\x8a\xb8\xebK\xf7\xb3g\xd7v\xce߷\x87Vu\x01\xa5\x12\x16旘\x9b\x99M?OJ\xfe\xec\x8e{X\xae\xb7o\x02~~j\xcb\xc4\x0f\xbe\xf2\x15?\xc8\xf8@\x01W(\x8cJ83\xb7\xc4\xdf\xdc\xfd\x95\xfft\xdbm\xb7\xfd\x12\xf0ۀ\v\xbc\x06\xf8\xb9\xd1\xf1\x89\xab^\xfe\xea\x1fa\xe7\x9es\xe9\xeb\xef\xa7\xd9l\xd2j6Y^^\xa6\x8b\x1f˲\x80\x14@\xc2\x18\xb41\b\x91A-cS\x06\x89V\x1a\xa5T\nB\x91~\xa0w)\x1a\x03F\xa3\xf5\x1a\x00\x85\x10\b)0\xda\x10\xf8\x01~\xa7\x83\xd6\x1a\x80m\xd3;\x99\u07b9\x8b\x93ǎ\x89\xd9ӧ\xaeX^\\\xbc\xe2ı#\x1fl6\x1b_\x00\xfe\v\xf0Y@\xaf\u007f\xcd\xea\xf5Z\xef\xed$\x8eϦ\xc2\xe7;.\xbbp\x1fQ\x14\xb2\xff\xe0\x11\xceݽ\x83\xcb/\xb9\x80\xfa\xc2I\x92$a\xa6\\\xa4\x98\x9d\xb2\xb7\u007f\xf0#\xbb\x81\x8f\xbd\xe0\xf2\x8b^\xf0\x8e\xb7\xfd\x14\xd7^}\x19\xfd\xc3\xe34\x97f\b:-\xfcF\x95\xa5\xf9Y\xa6&F\xe4o}\xe4S\x1f>\xb5P\xdd\x02\xfc\xe8\xd4\xf6\x9d#\xaf}\xd3O\xb0\xf7\x82\vY\\X\xa4\xddj\xb1\xb4\xb8\x98>Q\xdb\xc2\x00\x02\x81\x94\xe9ɗRbY\x16R\xc8\x14\x10\x19\xae\xb4\xd6$J\x11G1J)T\xa2\x88\xa2\b\xa5t\x8a:c0:{l\b\xf8)\xf0\x04`z\xdfO \xa4\xc4hM\xb3\xd1\xc0\xb2,\xa6w\xeeb\xdb\xf4v\xaa++\xecؽ\x87\xa5\x85\xf9\x9bO\x9d<q\xf3\xea\xca\xf2I\xe0\xf7\x80\x8f\x02ͳU\xe1w\xf1x\xfb\a?\xf2B\xe0\x8e_\xf9\xc9\xd7\xf4]{ե\x9cs\xce(\xaay\x86Z\xb0\x82W\x1a\xa2X.\xd3i\xacR\xa9\x94)\xe5s\\z\xden\x06\xb7\xe8w\xbe\xf8\xa5\xb7r\xee\xf9\xe7#\x84\xc5̩\x19\xb4\xd1H\xdbB\x88\f@݇ma[\x16Ҳ\xb0\xacTm1ix\xea\xa56\xad\x14\xb6\xd28\x8e\x8d\xd1&Mo\xba\x1b\x994*\xd1\xc4qL\x18\x04\xc4Q\x8c\xd6dQ\xe9ٴ\xc2\xf4\xe0\xd6Em\x92$\xac,-\x01\x82B\xb1\xc8\xde\v.$\f\xcea\xe7\x9e\x05N\x9d8\xb6m\xf6\xf4\xe9\xdf=3s\xf2\xbd\xc0\x1fg\x91\xb8z\x16X\xffrPM\x00\u007f\xfb\xbe\xb7\xbd\xb6o\xcf\xf4\x16\xc2V\x83Zu\x95\xc1\xe1\x01\x92\xa8\x83i$$\x89\xc2o\xd6\x10\x90US6?\xfc\xda\xd70\xb6i\x82\x95\xe5\x15\xb4NS\x9am\xd98\xb6\x8de[X\x96\x85c[ض\x9dF+\x91\xaa\a\xc6\x18\x84H\x01\x95\x02\xc7`\xb4F\n\x81eg\x1cK\x1b\f\xa6\a\xb0\xf4s\x15Jy\xe4\xf3\x1ea\x10\x91\xa8\x04\xad\x14J\xa5\xc4=\bBPj\x83B\xb1\x11r\xe9\xcfn\xb7ڴ\x9aM\x84\x90\f\x8d\x8c2:\xbe\x89v\xab\xc9\xfc왁g\x9ez\xf2\xd7fN\x1e\xffY\xe0\xff\x02\xfe\x10\xf0\xcf\x02\xeb\xdb\x1c{\xae\xf9!\xcf\x18s+p%P\x02\x8e\x02\x0f\xde\xf3\x89\x0f?\x00\xfcέW\xec\x1b\x9a\x9a\x18\xed\x11f\xc7uz|F%\x11\x91\x1f\x10\xc71:Qt\xda>BJV\x96\x97\xc9\xe5\xf2\b)\xf1<\a\xc7) -\x89c۸\xae\x9d\xf1\xa9\x8c\xffty\x92a\x1d7ZG\xd6uʷ\xd2\u007f3`e$\xbe\v@c\xac\x14\x1c\xb9\x1c^.\xa6\xd5l\x11\x86!\xc2\x18,+\x8d\x88*Q\x1b@%\x00m\x9e\r\xaf\xf4o\xad\x14\xf5Z\r\f؎\xcd\xe4\xd6)\xb6No\xa7Q\xab\xf5\x1f9|\xf0C\x8f>\xf4\xb5\xb7\x03\xef\xf9Ї>\xf4\xdf\xde\xff\xfe\xf7\x9b\xb3\xc0z\xd61u\xe9-\xb7\x00\x1f{\xc3k_=q\xf3\x8b\xafG\xa8\x90㇟\xe6\x8e\xcf\u007f\x95\x1b\xdf\xf0\x8b\x0f\x0e\x14\xdc+wo\x19#\x8ab<\xcfCd/\xbd\xd6\x1a)%\x18\xf0\xfd\x10\xbf\x93^\xbcJ%,.\xafr\xce\xd0\x10\xfd\x03}\xb8\x9e\x8bm[iʳ$RJ\x1c\xc7NO\xb4ֽj\x0eD\xf6';\xb5\x02\x8c^w\xbe2\x00ve\x06\xad\xbb\x11k-j)\xa5\xf1;mڪͦM\x13ij\fC\x82\xc0\xa7^k\xa0L\x838\x8c֒ⷨ\xbe\xb5֨$\xe9\x81W%\ta\x18\xa6\x1a\x9c\xe3p\xd9\x15W\xb3\xe7\xdc}S\x0f\xde\xff\xe5\xbf<~\xe4\xf0O\t!\xde\xf2\xc9O~\xf2\xf8\xf3}\xaf[n\xb9\x85J\xa5\xf2\xefCn\xf0}\x9f\x0f|\xe0\x03\xdc~\xfb\xed7\xeeھ\xf5\xae?\xf9\xdd۽K.\xbb\x12\x80\xa8]\xa5\xbd2C\x14\xc7<u\xe0\x18\xf7\xdf\xff\bn\xd0aph\x90m\xdb&q\\\x87\x9d\xe7\xee$_ȧ\x91KH\xea\xb56\xb3'O\xa0\x12ő\xa3'\xf9\x8f\xbf\xf7q\xfe\xeb_|\x82\xe1\xe1!J\xa5\x12Z)\xa2(\xc4qS@\xad\x0f\x0f\xa2'0d\x04|]ZJ\xe2\x980HA\xeb\xfbA\xf6v\x87\xc0\x0f\b\x03\x1f\xbf\xe3\xd3l6h6\x9b\xec\u007f\xf2q\x9e|\xf21\x8c\xd68\xae˕\xd7\\\xcb\xc0\xe0\x10\x96\x94 $\xfd\x03\xa9\xac\xe1\xb8\xee\x1ayW\x9a$I\xb2ȗV\x9aZi\xb4N2\t\x83gE\xcfna`\xb0l\x9b\xfe\x81\x01\xe6\xe7\xcep\xd7\xdf\xffMGk\xfd\x9e\x8c\xe4o8ysss\x8c\x8f\x8f\xff\xfb\x00V\xbbݦT*\x95\x81\xfdw\u007f\xf2O\xb7\xec\xdb=\x85\x9d/#\xa5E\x12\xb61*\xc1\x00Ak\x05)\x04\xa7N\x9d\xe1Kw\xde\xc3\xd4\xd4\x16\xfa\xfa*LNO28<\x88e[\b)Y\x9e_b\xe6\xc4\fQ\x14q\xf0\xe8\f\x9f\xb8\xef\t~\xe3\x83\x1fb붩u\xd1A\x91$1Z\xab\xf4IJ\x89 K\x83F\x90(E\xa3Vgey\x99Օ\x15j\xd5U:\xed\x0e\v\xb3\xb3\x80\xc1\xf7}VVV\x98\x9b;ñcGx\xc9\r\xd7p\xe9E\xfbسs\x9a-\x93\x9b\x18\x19\x1aL\x89\xbd1\xc4qB\x18\x04TWk$\x89\xc2rlZm\x9fF\xa3\xc9\xf1\x93\xa7y\xfa\x99\xc3\xfc\xed]\xf7\xb0\xebܽ\f\x8f\x8eS)\xf7Q,\x95R\x11\xd4蔿\xf5\xb4\xb1n!\x91\x81*\x03\u007f\x17`]M\xecч\x1fd\xff\x13\x8f}\x05x\x13p\xf2\xfb\x11X߫T\xf8K?\xf7\x96۶L\xf4\xbb,\x9e>A\xbePĖi\xf4\x90\xb6\x83W\xee\x03!\xd1Z\xb1u\xebf^\xf2\xf2\x9bx\xf0\xcb\x0fR*\x17i5\xdb\f\x0e\x0fb\xb4\xc1\xb2Ҫ\x0ech\xb7:\xac6\xdb\f\xf4\r\x90\xf3r=\r*%\xe4\x16\x96\x05ҲS\xfal \f#\x16\xe7\xe6\x99;3\xcb̉\x13T\x97\x16{'̘4\x1dV\xab\xcb\x1c?~\f\xcfJx\xd3\xeb_ɕ\x97\xfd$\xd3[7S(\xe4\xd1I\x92\xaa\xe4qH\x14\x06$a\x878\x8ai\xd7\x1b\b\x01\x15\xcb\x10%!Ă\x9c#(\xe4%\x9b\xce\xddƕ\xe7l\xe3ͯz\t˫5VV\x1b<}\xf88w\xdf\xf3e\xf2\xfd\xa3l\x9b\xde\xce\xe0\xe0p\x9av\xbbl\xccl ``@`\xd0@\x10\x04\x04\xbe\xcf%\x97_\xc5䶩\x17~\xee3\u007f\xffM\xe0\r\xc0?\xfc\xbb\xe3X{\xf6\xec\x01x\xebM\xd7\\L}u\x15\xd7u{\xa0\x02\xd0ILج\"\xa5\x00\x91\x96\xff\x93[&صo\x0f\xf5\xa5\x15\x1a\xabu\xf4։\x1e\t\xb6m\x1b\xa5\x14+\xd5U:aD\xa1P \x8a\xa2u\xf4(\x03\xac\xb4\x88ㄅ\xb9yN\x1e?α\x03\ah\xb7Zk\x84:\x03U\x10\x84,//23s\x8a\x17\\\xb6\x97_\xfe\xad_a߹\xbb\xb0-A\x12\xfat\x9a\xab\xac\xd6\x17\x90֚\xe6eK\x83t,l[\xe0\xb9\x03\xb8\x9e\x8beIT\xa2H\x924\x1a\xd6V\x96\xa8\xaf\u0588\xa3\x888\x8e\x89\xc3\b\x13E\\\xb6}\x13\xd7_\xfc\xe3,֚|\xf5\x91'\xf9\xe2\xe7\x1eb\xe7\xees\x18\x1a\x1e\xa1X*\xad\xa5\xebg%\x12\xb1\xd6\x18\xa0Q\xaf388\xc4\x1b\xde\xf2\xd3\x03_\xb8뎻\x16\xe7\xe7\xdf\v\xdc\xfe<z\xc7\xf7w*\\XX\xc0s\x1d\x8a\x85\xdc?\xfb\ax\xf9R\xd1\x18\xd3\xfa\xf4\x9f\xdcN\xd8jR,\x16\x98\xda9\x85\xe38\x1b\u007f\x91L\xb4\x14\"%\xd6F\x1b\x1ey\xf0Q$\xb0\xe3\x9c\x1d\x14KE\x1c\xcf#\xe8tx\xea\xd1'9z\xec$3+M\xf6/D\\q\xd5\xd5\xdc\U0008a5d3\xcb\xe7{\x84\xf8\xe0\xd3\xcf\xf0\xe8\x83\x0fҬ\xd7\xd7\x01i-B5\x9b\r\xe6\xe7\xe7x\xf4\xd1Gx\xd5\xcb^\xcc\xdb\xde\xfc:.>\xff\x1ct\x12\x12t\x1a\x18\x95 -\x89\x94\xd6:}K#-\t\"գ\xb4Ҹ\xae\x8b\x94b\x1d/2\xbd`\x13\x04\x01Fk\xb41\x84~@\xb3\xd1dei\x85\x99\xe3'i՛\x14\xfb\xfahG\t'\xe7W\xf8\xfa\xfe#\x1c\x99\xad\xb1\xfbܽ\f\r\x0f\x93\xcb\xe5\xd7\xdaLY \xeb^\f\xddw\xe7\xf2\x1e\xfd\x83\x83|\xe5K_\xe0\xc0\xfe'\xffV\b\xf1c\xb3\xb3\xb3\xffbY\xa2X,R.\x97\xff\xd7\x03\xeb\x86\x1bn\xe0\xfa\v\xa6\xb9\xf1\x9aK\xff\xd9?\xe0\x86\xd7\xff\x9c\xad\x8di\xfe\xe9{ߑ\xeb+\x15\xb0,\x8b|!\xcf\xe4\xb6I\xf2\x85\xe7i\xaa\n\xd2\x13jI\x02?\xe0\xc9o<\xc9\xf6=۩\xf4\x95q=\x0f\xbf\xed\xf3\xf4\x13\xfb9r\xe88\xf5H\xf1\x85\xc7Os\xfe\x05\x17\xd2?8\xc8eW_M\xbe\x90\xe7\xd8\xe1#<\xf6\xf0\xc3\x1bR]7M.//q\xe8\xd0\x01\xe6fg\xf8\x8d_~\a/}\xf1\v\xd9>\xb5\x19TH\x1cu21s-+im\xe8\xb4ڄA\xd4k\xe6ؙ\xf0jYV\x06\xaa\xae\x84\x91\x82\xca\xf5\\D\x16\x95\xbb\xb2E\xb75d۩T\xb1\xb4\xb0\xc4C_y\x80f\xadF\x18\x84\xb8\xf9\x02\xf5N\xc0ɅU\x1e\xda\u007f\x04\xbbo\x9c\xe9\xed;Ӌ\xc5d}\x9eu\xe7\xaa{\xder\xf9<\xa3\xe3\xe3<x\xffWx\xe4\xc1\u007f|\x18\xb8\x15X\xfe\x97\x80\xe2\x8e;\xee\xe0\xe5/\u007f\xf9\xf7&\x15v\xcb\xe9\u007f\xeeq\xf7\x9f\u007f8yɏ\xbf\xfb\x91j\xbdym\xa5\x98Gk\x8d\xdf\xf19s\xea\f\xd3;\xa7\x91\x96\\\xeb\xd1e\x17\xa4NR\xfd\xc8\xf3<\xf6\x9c\xbf\x87|>\xbf\xa6g\x894\x1dڎ\x8d\x1d%\x1c=z\x98\v.\xb8\x88Օ\x15\xee\xfe\xfbOge\xfb\xc6\xe8\x94F18x`?\xb3g\x8e\xf3\xa1_\u007f77\xbd\xe8j*\xa5\"Z\x85訁\x94\x02Ƕ\x81TJPJ\xa1\xb5&\xf0C\xe2(\xc6\xf5\x9cL\xf2XO\xb2S\xe0\x19\xa3\xd7\xf8P\x16Ѻ\x9a\x99\x11 \xb1\x10n\xa6ũ\xf4\xb9\x8dM\x8cq\xedM\xd7\xf3\xd5\xcfߋ\xeb\xa5\xce\n\x8f\x98=\xe3e\xf6m\xbd\x86\xe3\v\xab|\xee\xc1{\x19\x98\xdcŖ\xadSX\xb6\xcd\xfa\x16e\xf7\b|\x9f\x85\xb99\xae\xbe\xeezʕ\xca\x15\xf7~\xfe\x1f\x1e\x00n\x01\x8e\xffoı\xc4\xff\xec\xcf\xf9z#L\xae\xed\xa6)\x80v\xab\xcd\xf1\xa3'\x98ܺ9\xbd\xc2{\xe2%\xbd+]iE\xb1P\x04\xb1\xf6J:\x8eM>\x9f\xa7X,\x10F1{&\aYYY\xa6\\.ox\xc1\x85\x90\xe4\xf2\x15r\xf9\n\x8b\x8b\v\xdcw\xef\x9d\xfc\xe6/\xbd\x85[_r=\x03\xfd}\xa8$\"\xf6kYj\x91 \x05Z%\xc4Q\x84JT*\x92\x1a\x90\x02\xf2\xf9\xdcF\x17ĳ8O\xafp\xc8~o\xa3M\xcf\x1a(\xa5\xec5\xa6\x85\x00\xdbδ+\xa5\xe8\x1f\xec\xe7\x9a\x1b\xae\xc3vl\xe28\xe2\xc4ѓ<\xf9\xf5G\x88\xfc\x16\x9b+\x0e?\xf5\xf2\xeb80\xb3ħ\xbfx7\x97^q5\x03\x83C\x1b\xd2a\xf7\b\x83\x80\xd3'O\xb2\xf7\x82\v)\x14\x8b\xbb\xee\xfc\xbb\xbf\xfe*\xf0\"\xe0ȿ\x16\xb0\xbeck\xf2\xeaJ\x8d \x880R\xfe\xb3\x1f\xc0\u05ce\xcdWQB\xf6zn*+\xf9\x0f<u\x8033\xb3\xc4q\xfc\x1c\x11QdgO\b\xd9;\x8b\x8e\xe3P\xee/\xd3\xdf߇\x14pٹ\xdb9t\xe8`\xef$\xdbN\x8e\xa1\x91i\x06\x86\xa7\b\u0084/\xdfw7\x83\xb9U\xee\xfd\xbb?\xe2\xc7n{\x05\x83\x03}\xe88$j\xd71Z\x93$\x06\x83$\nb\xe2 \xc4h\rB \x85D\xcan\xba\x93=ுD\xf4\xdaCB\blۢP\xc8Q(\xe5q=\x17\xd7sq\\\a۶\xb0\xed\xae`\x9b~\xadeY8\xaeC.\x97\xa3\xdcWFZ\x12\xcf˱\xeb\xdc]LLM!\xad4\xd5\xea\xb0Þ\xf12\xef|\xd5u,\x1d{\x92\xe3ǎd\x12\xcas/\xf0$Q\xcc\x1c?\xc1\xe4\xd6)^\xfe\xea\xdb6\x03\xf7\x01;\xbf\xef#\xd6\xc9\xc7\x0f\xf1`5f\xcb\x05\xbb\xa8\x8c\f=\xe7\xc9\t\t}CEl\xc7z\xbe/\xff\xdc\xff\xf8\xfb\xbbO\xdfp\xf5%\x93\xad\x85YJ\xc5\x02\xc5Bʷ\x94R,\xcd/R]^alb\x9c\xa1\xe1A\\\xc7a\x8d\xec\xa4\u007f'I\x82\xe3\xba\x18\f\xa5r\x89J_\x19۲\x18\x1f(3\xe8Ŭ\xae\xd6ر\xebB\x94Ҝ:y\x80\xa5\xc53<\xfe\xf8c\xfc\xf1o\xfd*/\xff\x81\x1b\xd34g4:\x89\xd3Hb{$\x91\x8f@\x90\x04\x1d\x04\xa9\xe6 \x10Ȭ\u007f(z\xfd\xc4.\x8fb\xadi\x9d\x05\x0e˶p\xbd\x14@\xa9\x84\xdf%ک\x1e\x85\xa0Ƿ\xbabl\xfa\xb1\x14d^>G\x9c$)gð\xf7\xc2}x\xae\xcb\xca\xe2\x12\xb5\xd5*Fk\\B~\xec%W\xb1\xff\xd4\"_y\xf4\xeb\\pѥ\xd8ϓ\x1a\x8d1̝>\xcd\xc8\xe887\xdf\xfa\x8a\xcd_\xb8\xf3\x8e\xfb\x80k\x81\x13ߗU\xe1\r7\xdc@an\x96\x8b\xb6L\xe3Z\x054}\xcf\tv\xc6(\x10\x86\xb1\xddC\x8cN\rP\x19(n\xc0\xdeK\xdf\xfc\xee\x1b\xb7M\x8e\xdf\xf5\x9e\x9f{\x8bW;s\n\x95ĔJEr\x9e\x87\xe3\xd8hc\xd2\xe6\xafe1\xb1e\x82J\xa5L\xae\x90G)\x85\x97\xf3z\x96\x16\x83!\n#\x96\x17\x979q\xe4\x04\xa7O\xcf\xd2N\f\x1f\xff\xe2S\\z\xc9%\xf8\x9d\x06\xbe\xef\xf3\xd8\xe3\x8f\xf2\xd9\xff\xf6_\xb8\xe2\xd2\vүR1BZX\x8e\aB\xa0\xa2\x80\xa0Y\xc3\xe8\xd4\xdf\xd5\x05\xf2\xfaף\x1b\x99\xba)N\b\x81e[\xa9\xf4 \xad\xd4w\x95\xa9\xe5R\xca\r\xae\xf8\xf5\x9e\xadn\xf6JS隲\xae\x94\"\xe8\x04\xf8\xbe߫:k\xd5U\xe2(B+\xcd3O=\xcd\xe2\x993=\xfa\xe0\xe5\v\x1c]lr\xe7#G\xb9\xf8\xb2+{\xe0Z\x9f\x1e\x1d\xc7\xc1\xf5\\\xf2\x85\"\x87\x0e<\xcd?\xde\xf7\xa5cY_\xf6;&\xf4\xdf\r\xf2\xfe\xdd\x05\x16Y\xda\x02\xf2\xfd\x1e\x13\xe7\x0e34\xd1\u05cbb/}\xf3\xbb\xdf`I\xf9\x97\xef|\xcbk\xe9s@\a\x9dT\xbf2i%\xe88\x0e\xc5B>m\xe4:6\xb9\x9c\xc7\xd0\xf0\x10\x95\xfe\n\x85b>\xeb\xe1A\x12'\x84a\xc0\xec\xa9YN\xcf\xccrfn\x91S\xb5\x0eG\x17|\xb6\x96\n\xdc\xf7\xe4\x93|\xe6\xbf\xffW.\xbb\xe4|\xd0\n\xa3\x15\x96\xe3\"m\x1b\x8c!\xf6;\xa8(\xc0\xa0\xd1I\x92\xa9\xff\xeb\x8dz\xb2\xdb\xf5\x81\xac\xb1l9\x99oK\xa6\xbc\xa9gW~\xb6Q&\x03\x8fV:\xe5j\xebL\x80\x06\xd0J\xd3l4\xa9\xad\xd6\xe9\xb4\xda\x18H\xa5\x14ס\xd3l\xf7(A\xb1\\\xc4h\xc3\xc2\xdc<\a\x9fz\x9a\xc6\xeaj\x0f\\\x87\xe6\x1b|\xf9\x99y\xf6\x9e\u007fa\xf6z\xa7\xa0/Uʨ8\xc1\xeftPJ\x91\xcb\xe7YX\x98\xe3\xfe{\xbf\xf8 p\x13\xd0\xfe\xbe\xab\n\xff\xd9=\xc2Z\xc8ѯ\x9dᘜel\xcf\x00\xef\xf8\xfd\xdfq\x81\xb7\xbd\xf5\x9d\xbf\x80/->\xf2\xd1?\xe5-\xaf\xbc\x99\xa9\xe1\x01\x96\xe7\xe7\tZ\r\x04\x82\xaa\x10\xb8\x8eC>\x9f\xc7\xf3\x9c\xd4d\x17\x86xy\xafG\x92\xbd\x9c\x8bҚ\\>G\xa1\x90c\xb0\xbf\x8cW,\xf2\xc8\u05fe@\xe2\xb9\xfc\xd5\xc7>\xcc\x15\x97^\x98\x82]\n,\xdbC\xdav\xaa\x9e\ai\x1b\t\x93F*aL\x16\x99$\u0092\xbd\xa0c\f\xd8N\n\xf0\xee;\xbb\xe6\xc0\xf5ve\xa5\x14Q\x18\xf7\xfcXZ\xa5\xba\x15\xeb\xd2S\xa7ݡ\xddl\xd1j\xb6\xa8\xaf\xd6QJ\x11G\t~\xc7OݩBP\xac\x94QI\x1a9-Kb\x00\xcfs\xc9\xe5sL\xed\xdc\xc13\x8f?I\x1c\x85\x84~\x87\xdd\x13\x03\xd4[\x1d\x0e\x1d;\xc2\xf4\x8e]\b!)\x95K\xb4\x9bM\x928\xd9P1\x0e\x0f\x8fr\xe9\x95W_\xf5\x8d\x87\xbe\xf6\x17\xc0\xab\xff\xcd(\xefF\x1b柩b\f\xbf\xfb\x9a\x1f{ӵ7\xbe\xf4V\x16\x97\x97\xb9\xfd\u008bx\xf2\xa1\xaf\xf2K\xbf\xf3\xffr\xf3\xb5\x972\xd2_a\xa4\xbfB\xa9\x90#\xe7:\x84\x9d\x0e\x8dF\x930\fi6\x9ai\xbf\xd0JO\xaa\xb4\x04\xb9|\x8e8\x8eq\\\a\u05f69q\xe2\x04{/\xda\xc3\xeb\xde\xf0Z^t\xdd\xd5X\x96\x8d\x10\x1eZGH!A\x1bt䣓\b\x9dD\xbd\xe2@H\x99\xa9\xfe\xa2\x1b\xc21\b\xbc\xbc\x8b\xb4\xd6\"\x93\xb4e\x06*\x8d1b-\x95\xf9A\xcf\x19\x91$\t\xcdz3mdw|\x02? \xe8\xf8=Q\xac˽RGj\x9c\x02\x15\x89R\x9aV\xa3\x89\x10\"\xad\x90\x11\xd4VVQI\x92\xd1\x00\x81\x94\x16\xae\x9b#\x8eB\x82V\x83\xab\xf7m\xe7\xc4\x17\x1f\xa2\xddn200H\xb3^\xef\xa5\xcc\r2Q\x1415\xbd\x93F\xbd\xfe\xaa\xc3\a\x9e\xfe5\xe0\x03\xfffz\x85\xbf\xf9\xb9?\xff\x89K\xae\xb8\xeag_\xf3\xfa7\xb2\xb0\xb8\x88e;\x8c\fT\xb8\xf2\xa7\xde\xc2\xcf\xfc\xd4OP\xaf\xae\xd0n48q\xfc\x18_\xfe\xea\x03|\xee\xee\xcf\xf3#\xb7\xbe\x98\x1d\x9bG\xa9\xcd\xcf\xd1j\xb5i\xb5Z\x94\xca%\n\xc5<Q\x18\x91+xXV\xda_\x8c\xe3\x88(\xf0y\xd3\xeb~\x84\x1f\xfe\xe1\x97b\xdb\x0eBZ)p\x84\x8dQ1I蓄\x1d\x8cJG\xc1\xbaiUZi\x83z\x8d\x90\x8b\xd4\x14\xe88]b\x84\xb1\xac^e*\x90=>\xa3\x12\x05\x06:\xed\x0eK\U000cbb2e\xa4-\x9c\xc0\x0fQI\x82\x942\xd3\xe9\xe4\x9akAk\x94֩\x1a\x91U\x9e z)\xb3k\tr]\a˱\t:\x01\xda\x18λ\xe4B\x92$\xe1\xd0\xfeg\b\xfd\x0e\x9dz\x95\x1f\xb9\xf9\x1an\xff\xd8\x1d\xbc\xe0\xfa\x1b\xb3ɞ\xe7?\x02\xdf\xe7\xfc\v/fyq\xe1\xfd\xabՕo\x00\x9f\xfb\xbe\x01\xd6b\xb3ɱ\xa5%l\xcb\xc3\xd0|\x1e\x8e\xa5{\xb9~\xfd\xf1\x89G\xbf\xb8\v\xf8\xa3\x1f\xbe\xedu\x1c>|\ba\xd9\f\r\r2\xdc_±%\x95B\x81ͣ\xa3\xe4\\\x87k\xaf\xbb\x96\xe9]\xe7Q\x19\x9c\xe0\xf8\xd1#\xdcq\xefgy\xe9\x95{\x19\xce;\xb4\xe7\x17)\xb7\xdb\f\r\x0fbY\x16\xed\xc5\x16\xb9\xbc\x87֚f\xab\xc5\xf4\u07bd\xfc\xc0\xad/EZ62#\xd6*\x0eH\x82\x0e:\x0eҫ\xd9\xe8\r\x12A\x97p\xa7Ŝ\xc8\x1cy&mtg'\x1c\xdbJy\xbd\xc9\xe4ocz\xe0\b\xfd\x80c\x87\x8eQ]\xae\x12\xc7\t]\x96.\xa5Dd\xee\x87\xf4\xa1z\xfcMfi\xae\v.L\xcaٞ\xcd\xd3\xc20BDqO\x9a\x88\xc2T\xa4\x9dڹ\x83C\xfb\x9f\x06\xa3\xc9\tŏ\xbd\xf4*\xee?t\x8a\xa9\xed\xdf^Y\b\x83\x90\xab\xaf{\x91\xbc\xeb\xd3\u007f\xf3q\xe0<`\xf1\xfb\x02XK\xcd&\xb6\xb4\x91\xd2\x05\xe3=Gn0\x18\xbe6\xf3t\x19\xd8\a\\\x06\\D:\xaeu\x85#\xad\xfcG\u007f\xf3\x03\xe4]\x8f\x13\xab\xf3\xc4\xc2\xf0\xea\u05fc\x8a];w0\xb9y\x13\xd3ۦ\xc8\x17\n\x9c\x9a\xaf\xf3\xe5{\xbe\xc4\xf2\xe2\"\xb9\\\x8ek\xaey!_}\xfc\t\xa6\x87]F\xf2\x16CA@\xbb\xddfr\xcb\x04\xedvj\xeb\xb5]\x9b0\x8c\xf8\xe9\xb7\xfe\x04\x85R\x19\xcbr\xd0I\x80\x8a\x03T\x94\x8exI\x91\xfa\x03\xd6ے\xad,R\xf5f\x9c{\xc4[\xa0\x13\x8d\xf0\x04\xc2u@\x1bL\x1c\xa3\xa3\x84\xa0\xe3\x93$\t\xedv\x87\x85\xf9%\x96\x97\xaa\xac,.c[\x16[\xa6&\xe9\x1f\xecO\xadжE\x18\xa4\x8a}\xf7\xfbFa\x88J\xd2\xd9\xc3v\xbb\x83\x10\x12\xc7M\x85\xe1v\xab\x93M\xf5\xf8\x04\xbeO\x1cE봳42\n!\x88\xb3\xc2Ŷ\x1d\xe2(\xa0U\xaf\xb1wz3\x1f\xfd\xf4}L\xed\xd8\xf9O\xb6\xa0m\xdb\xe1\xda\x17\xbdx\xe4\xfe\xfb\xbe\xf4\t\xe0%\xff+\x9b\xd6\xdf1\xb0\xf6ML|˪\xf07?\xf7\xb1A\xe0/\x80\x1f\x18\xf2\xcar\xf7\xd8$\x15\xcf%\xefڔ\\\x97\x9c\x93\t\xa3\xc0u;v\x10%\t\xfeӧ\xd8\xff\xe4I\x1eWP\xf3\xdb\x1cX8I5lQ\xb0\x1cF\n}\xb8\xb6C\xb5\xd3\xc0\xb3\x1d\xf6/\xc4\xf8&`j\xb8̵\xe7m'\n\"\x02\xdfO\xb9J-漫^\xc0֩i\xa4e\x83V\xe8$DE>h\xd5#ܘ\x14A룕X\xdfP\xe8V\x81R\"\x8b\x85\xb45\x13D\x84\xad\x0e\a\x9f9̉#'PZ\xd3j\xb4H\x12\xc5\xee\xf3\xf7p\xe9\x95\x17Q*\x17{\x8e\x8b\xae\xa5٘\xae\xbd9\xf5\xd1\xc7q\x8cJ\xd2A\xd8(\f\xd1Y\xca\v:\x1d\xb4I\x1d\x16F\v\xf2\xc5\"\x96m\xe3w|\x92(\xec\xb9W-+M\xa3*\xd1\xc4Qܓ,\x10\x06\x1d\x06\xbc\xec\x05\x17\xa1\xb2\xa8\x97\xce4>\xff\xa1\x92\x84͓[ٱ眛\x8e\x1e<\xf06ҁ\x8d\xef\x1f\x8e%\xa5\x8d`\x83;\xe1\x037\xee\xb8\xf0\xd6\v6o\xa1\xe0\xe6\tb\x83\x109,\xab\x80c\x15\xb1\xac\\\x9aI\xb2\x19\xbcXEt\xc2\x1aAܤ\xd19\xcdXI0Q\xd9\xddӑb\x95do\x8fe>q\x01\xb6a\xc6_\xe4\xd4\xc2\n\x96\x14\xe4r.\x96eQ\xafչ\xf9\anI\xbdW\x02\xb4\x8e\x91\xb6\x83\xad\\t\x12 E*xZ\x8e\x8dR:\xe5UB\xf6$\x85\x9e!]\nD>\x87\xf0\\\b#\x92F\x9b\xa3\a\x8f\xf2\xf8#O\xa4`\xc8\x00c\x80\xe1M#\xbc\xe0\xfa+3\n\x96\xf6&{}D\x91\x92\xf3\xeed\x8e\x10f\xcds/\xa3\xd4Y\xea8\x88\xa2 \xf0S\x97\xaa\x94\x82z\xbdN\xa3\xb6\xca\xe0\xf00\xa5r\x898\xf2贚Y\xdfR#\x84\xe8\r\xc4Z\xd2FY\n\x8c&\f|\xce۵\x95\aN4\xd8:\xbd\x9d\xe5\x9e\xcf\xec\xf9\x8fN\xbb\xcd\xe5W^\xc3у\a>\b|\x068\xfd\xfd\x03,\xea$:\x87\x94=\x1b\x8d\x1c.\r\xd2W\x18e\xdf\xf84\xae%I\xb4!H\fa\xa2\xf1cM3T\xb4#\x03BbI\x87\x827\x84\xe7\xf4\x93w\x87\xa8\xb5O\xd1\x0e\x96\xd0F!\x84\x8b%+\xc4* Q\x11\xda(\xe2$D\xf9\t\x9b\x87\x06\xf9\xfa\xa1\x83\f\x15=\xa4\xacโK\xaf\xbf\x81\xad۶\xa6\xf2A\x12\xa5\x15\x9c\x93C\xa8\x04K\x02:ɪ?\x91F\xa1\xac\x82\x13R\x80e\x81c\x81\xe7\x82\xeb@\x9c@'\xe0̉\x19\x1e\xb9\xff\xeb\x04\x1d\x9f\xbe\xd1Qr\xc5\x02A\xbb\xc5\xe2\xe99J\x952{/\xda\xc7z5T\bA\x18F\xa88Ag\rj\x9dY\x8f\x93\xeeP\x85\x10\x18\x9dF\x1ciYxv*eX\x8e\x85\xdf\xf6\x19\x19\x1b&\x8ab\x94\x8a\x89\xa3l\x8a:\x9fO#s\x1c\xa7_\x9fq6)\xd3\nU%\xa9_~d\xa0\x8f\a\xbf\xfaY.\xbc\xe4R:\xed6\x9dN\xfb[\x82Kk\x8de\xd9\\{Ë+\xf7\xdf\xfb\xa5?\"]?\xf0\xfdR\x15\x1a\x92d\x05\xc7. e\x19\xe0K\a\x17N\xbduzx\x84\x83\x8bs\xec\x1b\x9f\xc0\xb3%Rhr\x96\xa4\xe4J\x86\n\x16\x89rh\x86\t\xf50\xa6\xee\xc7$\x1a,\xe9Q)lõ\xfb\xe8D\xabY\x03\xd7\xcd&\x93m\x94\x06)\x12\xfc\xa8A\xb3\x1a1:0ę\xe5\x1a\xb9\x9c\x8bmY\\v\xf9E\ba0\xb1\x8f\x8aC4\x02\xabXA(\a\xb4\x84\xc4d\f9ժp%\xd8V\n$\xc7&\vs\x10FԗV\xf9\xe6\xfd_\xa7\xd3lr\xe5\xcd72:\xbd\x13)\xd3J\x11\x15\xa1\x13\x85J\f\xb6n\xac\xb1\x93,\x12\v\xc0v\x1d\xb41$\xda&I\"\x90\x828n`\x8cBX.BZ\f\x0e\x0f\x12\xf8\x01\xedV;]V\x92q)c\xd2J0\n#\xa20`\xb5\xbaB\xb9҇m\xdb$Q\x84ֆ(\x8a\b\x83\x007\xe7\x11E!B\bT\xa2\xf0\x9b\r^\xf6\xc2Kx웏r\xd9eWp\xf4\xe8a\xc2u\x83\x1c\xcf>ꫫ\xec\xdcu\x0e\x87\x0e<\xf3\xf2Ź\xd9W\x00w\xfc\xab5\xa1\xbf\x85\x99\x06)\x9a\x00w>>\u007f\xb4]\xf7۬\xb4f8\xba|\x86X\xe9\xec\xea]\xebg\t\x01E\xd7b\xac\xe4\xb2c\xa8\xc0\xd4@\x9e\xc1\x82\x83cٸN\x1fEo\x139o\x14\u05ee`\xc9\x1c`\xf5r\x95c\x97\xd0\xca`\x87\x0eOϬP_\xadS\xab5\x18\x1f\x1b\xeeU\xa3*I\x88;-Ls\t\x84\x06K\xa6\x00r\x9d4*岇m\xa5\x91+\x8c!\b!\x88\x98?~\x9a/\xfd\xf5\x9d,\x9e\x9eer\xdf%\x8c\xed\xb9\x18\xe9\x96\xc0*\x82t\xc1*\"\xdd>\x1cKm\xa8|\xb5\xf0P\"\x87\x91n\x1a\xf0Z\x1d\xfcN\b\xd2\xc6\xf6\nx\xc5\x12\xb9R?\xf9\xbeal\xb7\x88\xb0\xf2X\xb6C\xb1\\\xa2T.\xa5.\x8e,\xc5\x19\x03\x8e\xe7\xe2z\x1e\xf9B\x9e\xb9ӧi\xb7\xdbDqL\x18\x06\xc4q\xd4[\x0f\x90\xcb\xe7{\\\xb1\xb1Zert\x88ju\x85S3\xa7ؾc\x17\x9b7o~N\x85\xbe\xbe\xeal\xd4\xeb\\s\xed\xf5\x90\x0e\xc5\xda\xff\xea\x11\xeb}\x9f\xfb;\xcb\xc0\xa5Y\xff\xe9Z\xd2\xddR\xdbm)\x8bOϝ\xe2ҭS\xcc֗\xe9\xcf\x0fП\xcfcIA\x92\xac\r\rt\x89\xa76\x06ג\f\x16<*\x9eM+\x8c\xa9\a\x165? 2\t\xae]&\xd11\x82\xb4\x9b/\x908V\x91\xa2\vJ\xb9\x9cY^e4N\xc8\xe7\xdcԋ\x82\x03F!0$a\x80\xd3}MsΚt \xba\x96\x89\xb5\x17<j\xf94f\x17\x99;q\x8ar_\x99\xe2\xe0(\x96\xed\xac\xd9+\xbaW\x84\x10`\x14\xebC\x95\x96y\f6:N\b\xe3\x848V,\xce-gd]\xe3\xe4\xf2\xa88\xc0v\\\xbcB\x11\xd7\x067W\xc8\xf47\x81V\x9aR\xa5L\x12'\xb4\x9b-\x84\x95\xba \xbc\\\x8e$N\xf0r.\xa7\x8e\x1ecxl\xb4\a\x92\xee\xaf\xe4\xba\x1eI\xb6Z\xc9`(\xba\x16K\v\xf3\xecܽ\x87cǎ\xe2\xb7[i\xe4\xb0\xeco!?\x04\xf4\x0f\x0epξ\xf3w\x1e\xd8\xff\xe4\xdbI\x87b\xbf\xb7\xc0\xba\xff\xfe\xfbI\x92\xe4G\xef:x\xe8G\x81\x17M\x96\xfa\x8a\xe7\x8cof\xb0\x90'\xe7X\xe4l\x1b\u05f6HTB\xbd}\x1a\xcb*\xf2Ԝ\xe1ܱ\xcd\f\x14\x86\xa8\xe4\\\xea~\xb4\xa1\x01\xdbm\xfew\t\xb1gK\x06\xf2\x1e%צ\xe6\a\xacv4\x9e\xddGhZ\x18\"@\xa1-\x0f\xa9C6\xf7\rsd\xf14#\x95b\xaa\x11\xe9\xb4ߗ\x8eS\xa5\u0558\x89㔠k\x1b\xa4\\\x03G\x8f\xb0\xa7g\xc8\xd6\x1a\xa3\x14\xe3\x9b\xc6\x18\x9f\x18gx\xef\x858\xa5A6\f6\x90\x81J\x85\xd9\xdb釣Ч\xba\xd2`yn6\x8b\xce\x02\xbf\xd5\xe8\x11}:\x9d\x8c\xe0\xb71T\xd1Z\xb3<\xbf\xc8\xf8\xe61F7\x8d\x90/\xe4ѭ\x0eI\xac\xf0r9|\xdfOEX)\x91\x96\x85m;h\xadY\x9a_`dl\xacg $\x1b!\x13R\xa2\x92\x04˲\xf1$̞>E\x12'h\x95\xf4\xdaN\x8e\xeb\x12Gϟ\x16\x1b\xb5:\xfbο\x90\x03\xfb\x9f\xfcO\xc0G\xf8.N[\u007fG\xc0J\x92\xe4\xdd{\x877}\xf8\xaa\xed\xbb\x19,\xe4)zE\xc0ò\n$*F锔G\xb1O+X\xa2\x1d,\x92\xa8\x98Gg\xaa\\0q\x1eC\xc5I\xfa\n.J\x19j~D\xa2\xb3\b\x86!\xd1QZ\x05\x1a';_\x86\x82kc[\x05\xea\x1dAU\xc7\xc4*\x04$R\xa4\x91\xa4\xe4創Kӏh6\x9a\x8c\xfbu\u008e\xcf\xec\xe99\x0e\x1d<\u008e\x9dSl\xdb6\x99\x12\xf40\xc4\x1d\x1eX\x8bZ\xe9\xd8\vq\xc7g\xe1\xe8\tF7o\xa20P\xc68.\x85\xf1Q\xa4S\x01\xe2L\x10\x8dA\xe4\xba\x0e>01\xa8\x84\xd0\x0f\x98?\xb3\xc8\xf2\xc2r\xda\x15h\xb4\xe8\xb4;\xe8D\xa5\xd3\xd3]g)\x06۶p<\x8f|\xa1@\xae\x90\x03\x04\x87\x9e:\xcc̱\x19&\xa7'\x19\xdd4J\xa5\xbfLuy\x15\xc7q\t\x02\x1f\xad\x15\xedf\x9b$N\x18ߜjv\xab++\f\x8f\x8d\xa6·\xec\xc2T*A\xa9\x04!%I\x14p\xd5\xdei|\xbfC>\xf3\xfe\xe7\n\x05\x1c\xc7\xf9\x96\xc0\xd2ZS,\x95ع\xe7ܱ#\a\x9fy\x1b\xf0\xbb\xdf\xebT\xf8#\xb7컜\xcd\x03\x9b\xc89CX\xb2\x88A\xa2\xb4&V1a\x12\xa6J\xb2h\x90hE\x18\xb7\t\xe2&J+\xbe1\xf3\x04\x8d<O\xd3\xdb\x00\x00 \x00IDAT\xb0\xc39cS\x8c\x94\v\xf4\x17=\x16\x1a\x1dVZ>ڐ\x82\xc5(\x82\xb8\x8e\xc6\xc2\x18\xbb7\xc0\x99wl*\x9e \x8c\x124\x12\xa5\xc3t>\x10\xc1\xd4\xd0\x18ǖ\xe7x\xf2\xf1\xa7)\x15\xf2\xcc\xcd.2sr\x86Z\xb5Ʃc'\x99ܺ\x99\x8b/9\x9f\x81\x81>\xa2\x95*v\xb9\x84p\x1cT\x9c\x10\xd4\x1b,\x1c\x9f\xe1\xf8ѓ\x94\xfa\xfb\xe8\x1f\x1dJ\xa3\x9a\xb4\xb3\x88\x14\xa7\xfcN+\x90\xa9b\x0f\x86\xb8\xd3b\xe9\xf4\f+\v+)\xef\tB:\xad\x0eaf\x10\\\x1b\x80ՙ\x86\xa5\t\xb3En\x8dZ\x03KJ\x1c\xcfEkE\xa3\xded\xff7\x9f\xe6\xcc\xc93L\xed\xdcF.\xe7Q\xaf5\x10F`Tj\xbf\xae\xf4\xf7!\xa4\xa0\xdcWf\xb5\xbaJ\xad\xbaJ\xb1XL\xbf\xb7R8\x8eC \x04hC\xd0\xe9\xb0m|\x883\xed\x16\xfd\x03\x038\xae\x87㺩\xd36H;\x0f\xae\xe7\xf5T\xfe \xb3\xea4\x1bM.\xbc\xe4R\x8e\x1c|\xe6׀?\xf9nE-\xfb;'\xf9\x12\xc7.S\xc9U\xf0\x13z\xe4\x1c,l\x99\abl\xabH΅D\xc5DIH\x105pl\xc33sO3S]dS\xa5\xc2\xf4\xc8\x16ʹ29\xc7&R\x11\xc6\b\xb4\xd1\xc4*$Q\nD\x1e\x83\x93Q\x1b\x81k\xe5)\xe7\x8a,6\x16P:\x15\a\x85\x10\x94\x9c\x02'|\vc\xd9DZs\xde5\x97p\xf1M/\xa0\xb5\xb0\xcc\xear\x95G\xee\u007f\x98\xcf\xff\xc3=\xec>g';wMS\xf4S\xbf~\x18\x86$q\xc2\xf2\xe22\xfd#\x83\xf4\x8f\f\xadU\x86&\x06\xb5\x9a\x12{\xe1B\xdcΈ>\xd4fO\xb2p\xfc8Q\x14\x11E1q\x1c\xb3\xb0\xb0\xcc\xea\xea*A\x98ڙ\x85\x14x\x9eG>\x9fˆ& \x89\x12\x94V\xa0Ӧ\xb5\xea\xa4\xfb&T\x98\x0e\xd4./,\xe3w\x02\xa6wn\xc3\xcby\xb4\x9bm\x84\x94؎\x83\xe3\xda\xc4Q\x8c\x97\xcb\xd1\xd7\xdfGuq\x19;[t\"\xa4D\x18\x83m۴\xc36*\x8e\x99\x1c\xe9灇\x8e2\xb1e+\x96\x9d6\xccmǡ\\.\x13\x86!\xbe\xef\x93$1\x96mS.W2\xe7E\x9b\x91\xb11\xf6]p\xd1\xf0\xfe'\x1e{+\xf0\a\xdfK`\xfd\xe3L\xb5z\xf9Hy\x89\xad}C\x94]\x98kA\xdcU\x981=\x80I7\x87\x149\x94\x11\xd4\xdbg脋\xd8\xd2#\x88\xeat\xa2\t\xe6[\x02K8xN\x8eDu\xb0\xa5 \xef\x16Xn\xfb\xc4*\x00\xd3FiC\xa2\x15\x89V\x18#P\xda \x85\xc4\b\a!t/\xddT\xbc\"\xb9\xf1\x11\xb6]\xb4\x17\xdd\tHj\r<\x03\xe3\xa3\xc3\xdcp\xf3\v\xf9\xd2\xdd\xf7p\xe4\xe0\x11N\x1e?ɥ\x97_L\xb1T@\n\xc1\xd8\xe4&\xda\x1d\x9f\xc9m\x93i\xe5س\xb9\x18H\xb24\xa8\u0094\xcbD\x1d\x96gΰr\xe64q\x1c\xe3w\x02\x8e\x1d=\xce7\x1fz\x84f\xad\x96Yd\xd6O,\xd33\r\x0e\x8c\x8c0<:B\xa9T\xa2X\xccc\xb2uG]\x17C\xe8\xfbh\xa5i՛\x1c;|\x82\x9d\xe7l\xa7\xd3ꤤ\\\xa5\xfbN\xbbcr\xf9|\x9e\xfe\xa1A\xea+U\x8a\xe5R\xcf\xf7\x16\xf8\xa9J\x1f\xc7\x11}\x95>T}\x91V\xb3A\xb9\xaf\xbf\xe7\xb8p\xf3\xb9l\xe61\xee)\xf0\xb5\xd5*\x8e\xe3P\xe9\xeb\xa7^[\xe5\xdc\xf3.`\xff\x13\x8f\xfdlF\xe2\xcd\xf7\nX\x1f\xbf\xe7\xd07\xdfu\xde\xc4\x18\x89\xf2)\xb8y\xa6\xfa$\xb5\x00B\x05\xae%\xd1\x1ajA\x94\x82\xc2)\x93s\xf6\x92w7Qm\x9d\xa0\xda|\n!\x12t\x06\xc4D\xf94\x82\x15\xa2\xb8\x9d\xce\xdcō\x9ene\x8cDJ\x87Di@\xa2\x8dμP.\x92\x18[VHt\xc8rk\x99+_z)\xd7^w9F)\x92v\a\xcbu\xd0@\xd4j\xf3\xd4\xe3\xfb\xe9\xb4ڔ\xfb\xca4\xebM\x1e{\xf4\t.\xbc\xf8<*\x95\x12\v\xa7\xe7\x18\x1d\x1b\xc6Xr-Zɴ\x85\x83R\xa9Pj[$\x89\xe2\xcc\xe1C\xbd\x1e\xe1\xfc\xdc\x02_\xbd\xe7\xab,\x9e>M\x18\xc74\xdb\x1d:~\xb0n\xe7\x82\xc1\xb2l\\\xc7%\x97\xf3PJQ]ZB\b\x81\x97\xcb1:>\xce\xe8\xe8(\xb6c#\xa4\xc0\xf1<\xa2 D\xeb\x14\\G\x0f\x1dg˶\xcd,\xcd/\x13\x04\x1d\x928\xa6\xdcWΜ\xab6C\xc3C,\xcd͓S\n\xcbJA\xaa\x8d²-\xa2\x18\x82N\x9bKΙ\xe6\xc4\xe1\xc3\xec\xbd\xe42\xa4%\xf1;~\x9aR\x11\xd4\xea\xab\x1b̉q\x1cS\xab\xa5\xe9\xd5\xf5<\xb6N\xef\xd8s\xea\xf8ї|7\xdc\x0f\xd6o\xfc\xc6o\xfc\x93\x9f\xf4\xe5/\u007fy\ue663\x87^v\xce\xe8\xf4\xc4H)O\xc9+\xe1ْ\xbc#)\xbb\x16\xfd9\x9b\x92'\x91\xc2\xe0X\x82\x9cm\xc8\xdb\x1acb\x96Z\xa7\xb1d\x8e8\xf1i\x87sDqH\x94\xf8\xf8\xd12\xc5\xdc8R\xba\b$a\xdc@\xe9\be\f\x96\xcc#\xb0P&&NZH\xe9 \x90\b$\x96\x95#H\"\x16\xace\xfe\xc7'~\x87ѱa\x84\x90X\xe5\"\xb2X\xc0*\x15I,I^Z\x9c\xb7o\x0f{\xceٍ\xe58\x9c>y\x06\xad\r\x95J\x99\xfaj\x8dF\xa3\xc5\xe8\xd6\xcd\b\xdb\x06\xa5S\x05^iL\xa2 \x8e\x89;>g\x8e\xcd\x10\x05\x11Fk\x0e\x1e8̝\x9f\xfa;V\x96\x96\x98_\\fqq\x81 L[F\x96L痵\xd6DQ\x84\xef\xfb4Z-VVWqs\x05\xf2\x9eK\x1cǬ\xae\xacP\xadV)W*8\x8e\xd3[\xfc\xa6Uj\x99\t\x83\x10\xbf\xedc\xbb6:Ѵ\x9bM\x1c\xd7\xe9\xad\x150\xc6\xd0n\xb7\xd3E\xbaB\x80\x94tZ\xad^k)h\xb7\xf1J\x15\xf6\x9f\x98\xa7o\xa0\x9f|!\x1d:\xb5l\x8b\\\xce#\xe8\xf8\xc4\xcfZ7i\xb4&\fC\xc2 `t|\x13\x87\x0f>\x93\u007f\xfd\xeb_\xff\xc9l\x82\xfd{\xa2c\xfd\xfe\xc3'\x8f||\xdb`\x89\x81\xc2\b H\xb2U\x89\x91R\x84I\x8c\x1f\xc5\xf8q\xc0|c\x95\x9aߡ\x19\xd4\b\xc2U\x12\x1d\xa2MZ\x02w\x823h\xba\xb3\u007f.\x8e]$\x88jDI\x1b\x90xv\x11KzXV\x1e+\t\b\xa2\x1aRj\x04\x92D\xfb$:a\xff\xdca>\xf5\xd9\xdfc\xcb։5\t!\xdb\xf4\x82\xd1\x14\xfa\xfb\xc8_\xb0\x97\xf0\xe4\fR\nv\xec\x98\xe2衣\xcc\xce\xcc\xe2:i\x93wd\xd3\x18\xc2q\xb2\xea/\x8bX\x89\"\xe9\xf8h\xa5X]\xae\x91\xc4\xe9\xaa\xec\a\x1fx\x98\x87\xee\xfb\n\xab\xf5&KKKH)\xa8\x94J鬟\xa1\xf7|,K\xe2\xb9\x0e\xadV;]T\xab\r3\xa7N\xb0T(\xb2mr\x02۲\b\xfd\x80'\xbe\xf1M\xb6LO\xb1ib\x13\x96c#3-\xca(M\xab\xd9BJI\x9c\xa4K\xe6\xea\xd5\x1a\xce\xd8\b\xd2J=[\xb6mӬձ+\xa9_̲m\xe20D\n\x8b$I(\x96mZ\xad&+ss\xf4\r\x0ecgU\xa1\xeb\xba\f\x0e\x0e\xe1\xfb\x9d\xe7\xb5T+\xa5\xe8\xeb\xefG\b\xf1\xaaW\xbc\xe2\x15\x9b\x8c1s\xffb`\xf9\xbe\xcf\xfe\xfd\xfb)\xda9\x8a\xd6s\xc7\xe8\xaf\xea;\x87\xfb\xb8\xef3\x8f\xce\x1e\x8c\xae۱\xd3\x1d--\xd1_\x1cC\x1bP:!Q\t\xb1\x8aXhT9S\xaf\x12\xc4-Z\xfe\"\xadp>Ke\"\x93\v,\xb4\x04i,4\x86Vp2\x9b\x14\xeeR\x1c\x8b$\x89\b\x93\x16\xae\xddO\xac|\x8cI\x88\xe2Z\xd6$\x10\x1c]\x99僿\xff\xf3\xdc\xf0\xa2+\xd7I\bϒ\xf7\x01a[\xb4\x93\x84\xb2\xe3\x90\xcf\xe78\xff\xc2}\xdc\xfb\xf9/\xf3\xf4S\a\x88\u0088\xfe\xd1a\x84eA\x14A\x92\xa4*\xbcք\x9d\x80V\xb3\x8d\xdfI\x9d\xa1O?u\x80\a﹏\xa5j\x8dZ5%\xcf\xc5R\t\xd9\xdbrl6t\x17L\xb6\xbeH$*%\xedB\x10\a>G\x8f\x9d`\xeb\x96Ir\xae\x8bR\t\xc7\x0f\x1f\xa6\xbaRe\xdf\xf9\xfbp\x1c'\xd5\xe0R\r\x80v\xabݳ\xf7\x84aD\xad\xbaJ\xa9\xaf\x8cR\x8a$\x8e\xb3\xa6\xb8FH\x81\xdd\x05Vf\trPԛ\r\xe20dui\x81\xd1͓\x99\xbe\xa7)\x96K\xa9\xe5&~~\xf9!\x8a\"\xae\xba\xeez\xf1\xb5\xaf\xdc\xf7KB\x88/\x00\vB\x88\x83\x0f?\xfcp\xeb;\x05\xd4E\x17]\x94\x0e\x14\x03\xd4\xebu.\xbf\xfcr\xde\xfd\xa2\x1f璭{\x9f\xf3\xc9\xe7\xf7M\xf3\x897}\xa8\xfe\x86\xbf\xf8\xe5\x8f\x1e_\xa9\xbd=g\x1f\xe4\xfc\xcde\xa4\x904\x826\xab\x9d\x06+\xed:͠\x81\x1f\xae\xe2\xc7u¤\x99\x8dPI\x84\xb4\xb1\xad\xb4M#\x85\x9bU\x81\x01A\\Cc\x90FdڌƐ\x10%u\xa2\xa4\x01F\xa6\x80\x12)\xa8\xe6\x9b\r^\xf3\x93?\xc0\x8f\xbf\xe9Ui\x84a\xcdR\xdcS\xd6\xd7iU\xc2uXYXfd|\x84\xa9\xe9\xadlݾ\x8d#\a\x8f\xa0\x94b\xb5\xd1ʀ(\xd3\xf4G\xd8\xf3FuZi\x01Q]\xadqϝ\xff\xc0\xcaj\x8d\xfa\xea2\x96%)\x14RkKZ\xc1v'x\xf4\xc6a٬\xc9\xedH'݅\xeaH\xc2(\xe2\xf8\x89\xe3Lo\x9b\xc2ul\xb4Vԫ\xcb̞\x99eb\xf3\x04\x96c\xf5\f\x84Q\x14!\x10XN\x9a\x02-\xdb\"\n\x82\xb4\x81o\xdb\x18 Ib\x1c\xc7E\b\x99F;\x01ҶplIɵ\x88\xe3\x88\xea\xfc<#\x9b&\x10\x96\x95\xba(\x8a%F\xc7\xc68sz\xe6y\xdb<Ǐ\x1e\xe1\xf0\xa1\x03\xbc\xf5\xcdo|\xd7\xe0\xd0\xf0\xbb\xbe\xf6\xe0C|\xf5\xfe\a\xb8\xfc\xf2\xcb\xef\x00\xde\xf5\u007f\xbc\xf9\xb6\xdet\xb5\xeby8\xae\x85N\"\x928\xe6\xe0\x91\x93|\xf6އi\xb7\xdbk\xc0\xea\xc9\xfc\x89O'\xfe\xb6\xcbz\xdf\xff\x99\xa7\x1fx\xcbd\xff\xcd\xde7N=\x86c\x95\x88\x92\x80H\x05\x04Q\x93v\xb8H\x94\x04=\x01ϲ\x8a\xe4\xdd\x11l\x99덥\x1b\xc0\xc6\xc2u\x06p\xec\x12I\x92\xed\xf04\xa0t\x8c2q\x96\x9d\x12L\xb6\xcf\n\x04\x9d8f\xf8\x9cM\xbc\xe7Wߞ-a[\xdf\a{\x16\xc0\xba\xdd\x1c/\xc7j\xabM\xb3\x9e\xa3X.q͵\x97\xb30\xb7@u\xa5\xca\xfe\xaf\u007f\x93k\xae\xbf\x9a\xfe\xc1\x81\xf4\xeaOR\x83h\x1c\xc5=\x90\u007f\xf3\x1b\x8fS\xaf7\xa8\xd7kHi\xe18.Ҷz\x03\xb7ݻV\xf4\xa6{2\xa0\xa9l\xfbߚ\xa3V\xe3\xd86Q\x14qfn\x8em[&{\xb6\xe4\x99\xe3\xc7\x19\x18\xe8'\x97ˑ\x888\xfd\xfd\xb5\x06\x01*Nz\x13NJi\xb4J\xb2Y\xc7T\xc72v\x1a\xb50鰸\x10\x02\x15'\xec\xde2J;IPqL}u\x95\x81\x91\x91\xb4\x89\x1d\xc7\xe4\xf3\x85\xe7=\xb1gN\x9f\xe2\xba\xcb\xcf\xe7/\xff\xe0\xffdtl\f7_\xc1+\xfe2\xed \xe63\x9f\xb9\xe3\x15?\xf93\xff\xe1\xc6?\xf8\xb3O\xde\xf6\xd0\x1d\u007f\xfe\x0f\x00\xf9R\x91B\xc1E\xc5\x1d\u008e\xcf\x17\xbe\xf20\x9f\xbd\xf7\xe1\xe7oB\xa7*x\xf0-\x1f\u007ft\xdb/\xce\x19\xcc\a\x1f:q\x14?\xaa\xd2\x0eW\b\xe2\x06\x9dp\x85v8O\xa2\xa24B\t\x9bRn\v\xfd\x85)\\\xbb\x98\x8a\x86=W\xb9\xa4\x9c\xdfF\xc9ۄg\x0f\xe1:}\xb8N\x1f\xb6\x9d\xc7s\xca\xe4\x9cA\x8a\xde(\xa5\xfc$y7\xfd\xb8c\x979\xb5Z\xe5\xf6\xf7\xbd\x93\x81\xc1\xbe,\x05\xb2\xd6\xfb\xdb\x00*\x915\xaf%\xad\xc5eJ\x952\a\x9e\xd8\xcf\xd2\xdc\x02\xb9\\\x8e\v/>\x1f\xdbJ\x17ު(\xee.}G%\t\x9dV:6\x85\x81N'`\xff7\x1ee\xb5^O#\x93\x10DJ\x91dV\x18\xb3n\xac\xab\xbb\xd6[\b\x81\xcc&\xa57\xfez\xe9Դ\xb4$a\x18\xd2h\xb6SWC6\xf1\xb30\xbf\x98Yydw\xe6uÆ\x9bt\x85\xb8L\xbf\xaf\xe8:*6\x0e0\x9alc\xa1֚bރ0\xadV\x97g\xcf\xf4lBQ\x14b\xbb\x0e9\xcf\xdb\xe8\x0e^\\`\xdf\xce-\xfc\xc2O\xbf\x8eJ^\x104\x97i.\x1e\xa7>\u007f\x18φ\x97\xddt-_\xf8\x9b\x8f\x96,)>u\xe5+~bH%1\xe2\x9fP$6\x00\xab\x15$T\xdb\xf1\xb7}\b\xb8\xfd\x1b\xb3Gg\x8f\xaf\xb4h\x87K\xb4\xc2\x05\xfc\xa8\x8a6\xa4\x84\xdb*\xd2_܉c\x17\xd1F\xa1\xb5¶\x8aHag[\xf5,\x84\xb0@\xd8xN\x1f\x05o\x9cBn\f\xd7*\xe19\x838v\x01\xcf\x19\xc0\xb3+\xb8v\x05\xc7\xca\xd3\bC^\xf3\xe6\x1f\xe4\xba\xeb.[\xeb\xfb\xa5\x8c}\x1d\x986>\x94\x1f\x92\xb3\x1dl\xdb&\x97˳8;O\x1cEl\x9e\x1c\xc7q\x1d\xa6wngi~1\xf5\xc1[V\xe6\xea\fR\x11Sk\x8e\x1f\x9f\xa1Qo\x10E\xe1\x86\x01\xd4T\x87\x02˶{\x1bgl\xdb\xe9-\xe2M\x87HEo\x02Ȓk\x93\xe1]\xc3_\xad^\xef\x81J\b\xc1\xfc\x993X\xb6E\xb9RZ\xdb\xee\xc7\xda\xdeӮ\x17\x8blU\xb8X\xe7\xcd2\xeb\x06b\xc1d\xbdCI\xb5\xb6\x8aԊ\xb0\xe3Ӫ\xd7z_\xd3h5\x19\x1b[\xdb\xfa\xb70?G\u007fN\xf1+\xefx\x03\xae\x8c3U?&\x8e\x02:\xf5%곇\xa8-\x1c\xc7\xd3\x01\xaf\xbc\xe9\x9a\"\xf0\x9av\xbbI\xfe\x9fXs\xf4\xac\x88e\x13+\xe7[>\xdes\xe7\xefo7\xf0\xb9s\x87\xb7N\x8c\x0f\x8c!\xa5\x87\x14\x1e\xb6UĶ\x8a\bll\xab\x8c%\v\xbd\x13,\x84\xa4\x98\x9b\xc0\xb1\x8b\xe4\xec\x029\xdbE\xd0I\xdb5\xb9~\xca^\x1f\xe5\xdc\x00\x83\xa5\xadT\xf2\xa3T\xf2\x9b\xf0\x9c\"\xae]\xc0s*\x94r\xc3\x1c]\x9e\xe7\xed?\xfd\xfa\xb4\n\xeb^\xd2\x1b\"\xd5\xfah\x95.\xc1\rg\xe7ӝ\n\xae\xc7\xf0\xf8\x18\xf5\xd5:\xedf\x9bb\xa9\xc8\xe8\xf8(\xc5R\x9e\xad\x13ct\x1a\xad\xb4YmR]\xa7k-n\xb7Zt|\u007f\xddw_\xdb\x18\x98\x9a\xed\xd2]\xf1a\x14\x13\x84!Q\x92\xa0\xb5I\xb9W\x96\x02\xd3Ըve\xcb\xec\xf7\x8d\xa3pm\xc1\x9a\x10)\xe7[\xadQ,\x177\xcc#v\x1d!k\vu\x15\x9dvg\x83\x87+[,\x9e\xba-2u\xdfsl\xfc(\xc4R\xe9\xd2\xdc\xea\xc2BoU\xa6R\x8a\\\xa1\x88 \xbd\xeb\xc5HI\xf2\xdb\xef\xfb\x05\xfaKno?\xaaQYD֚\xea\xfc\fO=\xf2\b\x8b\xb3\xb3\x9c\xbf{\n\xe0\a'\xa6w\xf7.\x92\u007f\xb1\xdc\xf0\xbe\xbb?|5p\xf7\xab/xQeK\u007f\x89vXEi\x89\xc0\xce^$\x85\x94\x0e\x96\xb4\xf1\x9c\x01l\xab\x8ck)*\xf9A\\\xcb\xc2*o\xa3\xe8$\xc4*\xc1\xb5]\x1a\xa1G\xa8\fJk\x94\x96$J\xa2\xb4\"\xd1VVIj\xea\x81\xcf\xf1\xe5c\xbc\xf3W~\x94s\xf7\xee\\\x97\x02e\xef*\x16B\xaeK\u007fY\xafOhr\x93\x93\x04\xf3\xf3\xe8z\x9dR\xa5\x8c\xdfnS]^\xa1T)s\xce\xde=(\x95`\xd9\x16F+\xa2N\a?\x13*\xb5\xe9\xf2\xa3t\u007f*ϒ\xa1\xd7oS\x8e⸷\xeeHkC3n\xf5\xc0\xd4\x13M\xf5\xfaŵd\xfb\xb4\f\xc2\x12i_Ф\x11\xb0\xdeh\x90\xcb\xe72\xef\xbd\xe9\xb9N\x11\x10t|,\xdbb\xb5Z\xa5\xd5h\xe0\xe5Ҟ_\x18\xa4\x83\x17B\x885G\x05\x90(\xcd`\xce\xc15\t\x89ִj\xb5t\xf3\xb4%\xb3\x88\xac(\x16K<\xf4\xe0?\xf2\xf1\xdf{\x0f%\xcfZ\xb7Q\x9a\xde\xf3Og83N\x99\xa8\xee\xc7_\xb2\xefڗ-d#\xfb\x9f\a\xfe\xf4\x89\xcf}\xec\x99o\v,#\xea\x18\xb9\xf4ܹ\xc0\xbb\xfe\xbfˀ\xbb\xdet\xf9͕\x81\x9c \x88\x9aYŦ\x11\xc2\xc1\xb2\\\x8a\xce\x18\x96U\xc0\x12!#\xa5\"\x83\xc5\xd1t\xc2$kό\x14rl\xee\xf3\xc89\x92v\x18\x12*\xc3\x13su\x1c\x99vU\xdaa\x84\x14\x0e\xd5N@;\xd4D*\xa6\x1d\xac\xf0\xd4\xfc~\xfe\xea\x8d\x1fJ\xa3ʳ\x17\x91\xf4\"\x96Ez+\x9d\xee\xbf-\xa4\x97\xa3\xb0u+\xfe\xd1Äm\x9f\xc1\xb11\x16g\x17\x18\x19\x1fcxt\x88ٙٵ\xa1\x87\xec\xc4\xc7\xd9\xfa\"0\x18\x91\x9e\x84\xe7\x8c\xcfk\x8d2\x868Iz_\xbb~P\xb4\x9b\x9a\xba)S\xac\x83\xa6֦W1ZR\xa2X\xfb\xfe\x8d\xd5U,K\xa67tJ:=\xe5D\xabt\x9fX\x92\x11\xf1\xee.\xaeN\xab\x99\x92\xf8\f\xb8\xddM\x88Ғ\x04a*\x82:B#\xb29\x83\xa0ӡX\xa9\x00\x8a\xa5\xe5%\x9e\xd9\xff$\xef\xf9\x0fo`\xe7\xd4\x18Ҳz\xdb\x03ͺ\xe7A6\xa6\xa6\xb3\xf7\xef\xda9ͽw|<761\x99\v\xc2x\xf4\xfe\u007f|`\xef\xef\xfd\u17fc\xeb\x82[\xde\xf2q\xe0\x17\u05cf\x94m\x8cX\"\x06\xa2g\x81꿟\v|\xfe\x95\xe7_\xdd_rBbe!\xa5\x83\xd2\x1a\xdb*\xd1_ڃm\x95){\x16\xfd9\vG&\x18,\xb4\x11\xa9{\xc1\x12\f\x17],!pm\vK\x80kٸ6\x9c?\xd6G\xd5\x0fQZ\xd1\t#V;\x1d\x828A鈦\xbf\xc8\xf1\xe5\xa7\xf9\xb5_\xff\x19\xb6nݜF+\xa3{\xd1j]\xf3 5\xf9! \x89\x88\x9b3\x18)q\xcbe0\x06ox\x00\xbf٦\xd4W\xa1\xba\xb8\xc4\xd2\xc2\"\x9b\xb6\xa4;\xb9\xe2l\xa8\xb4;\x98ڽsW\xb7\x01\xbe\x91\xbf\xacE))ef\x8b\x11\x1bNBo\x19[o]d*\x03\xac\xf7\x9e\tD\xbaPD\xac\xf3\x11JA\xb3V[G\xe8\xe99g\x8d\xd1DAB\x14\x87\xbd4\x9c\xc4Ѻ\r6\xa6\xb7\xac\xcbs]lף\xd9\xf1\x19.\x17\xb01XZ\x11kM\xa3\xb6J\xa9\xaf\xc2ju\x85\a\ueec7?\xfcϿ\xcc\xc5{6cI{\xadO*\x04\xc2lܦӬ\xa7\xf7\xff\x99غ\x95-\xd3۱l\a\xadB*\x9e\xcb\x0f\xbf\xe4Z\xae\xbft7\x9f\xbe\xeb\x9e7\xbe\xf7\xb7?rm6\xf6W}\x0e\xb0b\x1d\x11\xe85^\xf1\u007f\xdf\xfd\xf7;\x80/\xbe\xfa\x82k\x06\xc6KN\xdah\xb6\xca\ba\x13\xab*\x05o\x82\xfe\xfc\x00\xe3e\x1b\xcfN_le$\xdd\t$[\xc2h\xc9#o[4Ä(\xd1؎\x85cY\x04\x89b\xa1\x15Љc\xe6\x1aM\xfc(@iE\xacB\x96\x9bgXi\xcdpxu\x917\xbe\xe1\x872P\x99\xd4u`=\x1f\xaf\x12\xd4\x17\xe7\xf8\xda\xdf\xfe\rG\x9ex\x8aB\xa5̭?\xf3f\xc6&Ɛ\x18\x1c7\xad\x02\xfd\x8e\xcf\xea\xd2\nc\x13\x9b\xb0\xad\xf4>9F\xac!g=\x8f\xb2\x1dg\x03?2\x19\x1f2&M\xdf\xeb#U\x17 \xac\x1b\xfaY\x0f\xb4\xee\xa4s\nVM\xb9\xaf\x92\x8ei\x99\xecgf\xc0[SML\x8f?\x19\xad{\\լ\x1f\xa17ϊ\xa4ِ\x85\xe3\xba<yb\x91\x17l\x1bG\n\x83\xd0\n\x8c\xa1^]\xe1\xf4\x99\x19\xa6G+\xfc\xfe{\xdf\xc1d\x9f$\xf4;\xd8V9c\xda\xeb\xf6\xbf\xaa\x94#v\xb7\xe0\xec\xbd\xe8\x02\x8a\xa52ZE\xc4I\x00F\x93\x18\x9fN\xdb'h6\xb8\xe5\xba\xcbh\xb7\x9a\xd3\x1f\xfa\u007f\xfe\xea\x03\xc0۞\x03\xact<+\r\xcf\x1f\xfe\xfcg\x8a\xc0\xa7_y\xfeU\x13\x13\x15\x17\xb0\x91\xa2@\xde\x1bŶJX\xb2\xcc\xf6\xa1aF\xcb\x1e\xda\x18T\x16\xba])q]Iѵ\xb1\xa5\xec\x8d_\x15\\\vK\n:\xb1\"R\x8a\xd3\xf56\xa7jm\xa2\xc4\xcf\b\xaf\xa6\x15Ԙ\xaf\x9f$\x88k,\xb5\x1a|\xf8\xb7\u007f\x95\xc9\xc9Mk\x8db\xd1\xdd\x02\xa3{\xca4@ܩ\xf1g\xb7\u007f\x90\xfd\x0f?D\xaeTbzz+\x9f\xfe\xa3\x8f\xf0\x86w\xbd\x9db\xa9\x88\x14\x02\xd7I\xb9B\xa7\xed\xd3j\xb5p\\\x87V\xb3E\xb1\\\xeeM'K٥\xc108\xf0ܻ\x99vo[\"\x9esb7~\xce\xfa7̺a\x8b.ZJ\xc5\xf4>g=\rJ\v\xca}}\x19\x90\xb2\xd4,\xd6n\xeedY\x82$\x81D%\x99\xc2\xceƕIݐ\x98I\x16\xda\x18\xfc8!\xef\xb9DQ\xc8r\xb3M\xeb\xd4Q\xde\xfa\xfa\x1f\xe2\xaa\vw\xe2\xd9\xd0i\xb5\x11B\xe2\xe5\xf2={͆\xbbs\x00\xf9B\xbe\xb7'6\t;\xbdLatz\x93\xaav\xa3\xd1\x03\xf5\x15\x17\xed\x05x\xed\xf6\xed\xdb\xdf6??\xffm\xc9\xfb\x1f^\xbdmϾ\xa9\x81\nQ\x121P\xdaC'\\B\x1bC\xd9+q\xde\xd8@\x1a\xa5\xd6m\xf5\x15R0Z\xf4\xc8;6J\x1b\x02\xa5iG\tE\xd7\"V\x9aj'd\xae\x11d\xbd\xc5\x00\xadC@\x93\xe8\x90\xd3\xd5\x13\xac\xb6OeW7\x1c\xae\xd5x\xd5+oN\xa3UW\x10R*\x8d\x02J\xa5\xa3[BB\xe8s\xec\xb1'yꡇ\x88\x92\x98\xce\xd2\n\x9e\xe7\xb1\x19\xc1\xbd\u007f\xfd\x19\xae\xbe\xf1:<\xc7\xc6˹\x94*e\xa2(\xa2\xd3\xeaP\x19\xecO\xd7d\xc71\xaet\xb3iiz\xf7\xda\x19\x19\x1e\xa0<8J\xa3\xba\xb8\x91w\xf4D\xd1\xf5\xb7\x88\x16\xcfE\xe0\xba\x16OW>\xc0\x18\n\xc5\x12\x83\x83\xfd\xbd)\xe9n\xb56>\xb99K\xc5*[RB\xef\x1e\x8aҒ\xd9m}\xf5\xdab\xbb\xf5\xd5\xe3:\xe2\x1e\x841\x05Kҟ\xcfq\xa6Z\xa7\xedz\xdcx\xe5\xf9l\xdf4\xc8\xf8p\x81\xa0\xdd$4\xa9\rG)\xcd\xd0\xc8p6\x93\x90\xae\xefL7\xdc\xe4\x9fW\x997\xbd\x9bT\xa5KL\xba\x8e\x0ec\fnj \xec_YY\xb1\x00\xb5An\x90Bb\t\x9b\x0f\u007f\xfe3\xb7l.\r\xbc\xf9\xf2mSh#)涐\xa8TQ\x9f\xe8\x1b\xe5\xdc\x11\x8f\x82#\xd7\x12\x91\x10XB\xd0\xe79\xe9\xf0\x84\xd6DJ\xd3\fb\x1aaL\x94h\xdaQ\x9a\xfaR\x11\xb6\x83\xd61\x06M\xbd\xb3ȁ\xd9\a\xa9\xb6\x8e\x02\x06\xc7\xeec\xb1m\xf8\xd3?\xf9M6m\x1a]\xcb3:}\x98 \x84N@\xfb\xd4,fq\x19\u0080\x87\xee{\x808\x89I\xe2\xb4♟\x9dCi\xc3\xd1\x03\a9\xf6\xd43T\x17\x97QJS\x19\xe8#\n#:\xed\x0e\xb6m\xa56_\x9d\x0e\x84\x1a\f\x8ec\xf7\xee1\x88\x10\\x\xd9\xc5\xcf\xfb\x02w\xefy\xb8~\f~͆\xb5V\xfa'Ij\x1dF\xa7C\x1eR\n&&\xc6I\xe2dc\x95\xa85[\xb7M\xe2\xfb\xe9k\xbc\x86S\xd3K\x95Q\x98\xfaä\x90=Y`\xfdM\xa6\xba@n\xfa\x01\x891|cn\x89\xe9\xdd\xd3\xdc|\xd1N&+6\xc3}\x05\x1c\xdb&\xceV.EQDui\x99\x99\x13\xa7h5[\x1c\xda\xff\f\v\xb3sx\xb9ܷ\xb41w\x9d\xbd\xcdzs\xc3\x02`\xad\x12Zi\x05\xad\x1d\xc7\xd1\xcfI\x85\x03\xde0\x1f\xfa\xdc\xdfy\xc0\x1fߴ\xf7b0\x82\xc1\xf2>l\xabD\xcb_d\xb2\u007f\x98]C\x05Lw\x85b\xf6b\xa6~,\x832\x9aF`\xf0\xb3\x9b{'\xda\xe0Ǌ\x99\xa8\x85\x1f\x87\xc4ٍ\xb5\x8dQ\xb4\x82e\x8e/\xed'\x88\x03<\xdb%J\xa0\x94\x1b\xa5\xe0m\xa65*y\xddm\xb7\xf6\xeeh\x8aҩG\xca\x18t\x18\x934\x9a\x84\xf5&\x85\x9cG\xa7\xde\xe4\xf1\xfb\xeeK[\x1e:\xad\xea\x928aq\xb9\xca\xe6\x891ff\xce\x10\x86![\xa7\xb7\x91+\xe4\x89\xe38\xf5\xa7\x1b\x83ʮt\xa5\x15\x02\x81\xeby\x04AH\x94혺\xf2\xcaKx\xfc\xe1G\xa8\xaf,uK4\x04\x12-\xba\x8d\xf5\x8d\x15\x94\x11\xbd: \x1d\f\xcd\xd4p!%\x1aæ͛\xf1\\g\xc3\xe4\xb4֚\xf1-[\x18\x18\xa8\xb08\xb7\x84ɨH\x97\xf1K\xdb\"\x8a\x02T\x92\xa4\xe6\x8d,\xad\x9au\xd1\x02\x91r8\x84\xe4\xd4R\x9d[^p1e\xa9\x91*`\xb02D\u007f_%\x05S\x18c\xdb\x1a\x81\xdb\x03\xe8\u00993,\xcc\xcea;6\xfd\x83\x83\x1b\x82n\xb7\x03\xa0U\x92\ru,\xe1w:Xٍػ\xcf=\xf0CN\x9c\x9e\x03\xb8syy\xd9<\aX\x96\xb4\x01n\xbbx\xd3\xce\xe9\xe1b\x9ern\x17\xb6UL#U\xff(;\x06\xdd\r\f\xd5\x12\"-\xd4H\x1d\nI\x92ڕc\xa5I\xb4&Q\xa9-7Q\xdd\x10\x1a\xb1Ԝ\xe7\xe4\xca\x01\xea\xfe2\xae3ȩz\xc0\x8e!\x0f\xc7.1P\x18䙅#\xbc\xff?\xbf\x8b|!\xd5tP\n\x1a힘(\xb4!h\xa5\"a\xdc\uec3c\xb4һ\x13jwB'\x11\x86\xb93\xb3Ll\x1ac\xe6\xc4I\xf6\x9ew\x0e\xda\x18\xf2\x85\x1c\x02h\xff\xffܽg\x90e\xe7y\xe7\xf7;\xf9\x9c\x9bC\xe70=9\x00\x18`\x00\f\b0G\x91\x90HQ\xa2⮽\xd6Jk\xb2\\e\x9b[\xdauI\xae\xb2%W\xedZ\xda\xdah\xedZ\xde\xf2\x96%qeR\x94,JbR`\x10E\x10\x04\x01\x02\x18`03\x98<=\xdd\xd3=\x9do\xdf|\xef\xc9\xc1\x1f\xdesO\xf7\x80dՖ\bR\xa2n\xd5|\xc1`:\xdc\xfb\x9c\xf7}\x9e\xff\xf3\x0f\xfd~\x1a\x06\xa00\xe8\x0f(\x96K\xe2\n\x94\xc1\xca\xe7pl\x97 \b\xa9\xd7k|\xe4\u007f\xf80_\xfe\xc2Wy\xf5\xa5\x172W\x1d\x81\xa6\x8b\xc2\xdcG\x18\xcd\xf0*\x99t\xde@\x1c\xb4\xa6a095I\xa5,&UY\x96\t\xc3H\xf8\xbb\x1b&\x0f\x9f}\x88~O\x18\xb3E\xd9\xc90\xea\xe6\x93L`1R\xe8\x88\xc4ֽ\x93*\fC\x9c\xa1M\xa9Ze\xccTim-\xe1\x16\x8a\xcc-\x1c\xc04\f\x91(渄j\x88\xa6i\xd9i\x93\xb9\xed\xa4!U͝\x06;\xb5*\x13S\x93 I\fz}\x1c\xc7&\fB\xec\xc1\x90~\xb7'6`\xb2\x8ck;TjU\x82 \xa0\xb9\xdbb\xe9\xee\x16\xa9d\xff\xdb\xe0X\x02#\xf9o\uf7d9C\x91\xaa\xa8J\x818\x81\xb2!q\xb4\xa6\xefM?\"\x9b\x16YJ\x17\xb7Q\x92\x9e\x001Q2\xa2\x0e\x8b\x10nH\b\"\x97\x95\xe6\x127\xb7\xce\x13\xc4!\xa6^ǋK<u\xeb:\x0fO-\xe0\x05.$*;\xfd&\x95Se\xde\xf6\xd6\xc7\xf6\x10\xf6\xdep\x8f/\x95\x88\xab\xc3,\xe4\x19\xec6q\xa5\x84~\xb7\x97\x9eTd\xc6#\xc9(\xf2\xcdq1\r\x8d\xc1\xc0F7M!3\xcb\v_\xd3~\xaf\x8fn贛m\xf2\xc5B\xf6\xfd|\xcf\x17'L$ܓ\xab\xb5*?\xf9\xb3?\xc6\xd1\x13Gy\xfe\xa9g\xe8\xf7\xfb\xf4\xba\"\xf4t\x94B/*J\xf4h\xa6ib\xe6r\x98\x86\x81i\x99\x18\x86\x81i\xe8\xc8i\x06\xb50k\xf3\xf0\\\x8fك\a8\xf3\xc8C\x14\ny\x96n-\x13\x85\x11Q\x10fM\x9a,K\"2\xc5\x17d\xc3\xd1p\xb1G\xd7\x11\x05\x18\x06B\xb6\xefx>\xad\xc6\x16\xf9b\x85J\xad\x8a\x94\b\xea\xf2\x88ǥi\xaaX^\xa7\xa0\xacn\x18\xd9\xcai\xb4)\xb8{\xfb\x0e\x1b\xabk\xa9\x84?\xe2\xdbi^\xa38µ\x1d6l\x9b\xe1\xc0Əb~\xfb\x8f\xbe\x14\x03\u007f\xf2m\v뷞\xff\xb3\x87*\xba\xf5\xf6\xf1b\x89\xbc9C\x82Lِ8>fd\xd6\xd8{\xa9\rIJ\xf4\xdb+ \xf1\x17\xb1X1$\x11\xad\xe1.˻+,\uef02\xaaX$R\x9e\x96\xe3\xf1\xca\xf2u\x1e\x18\xab\x90Wd\xaa\xb9\x1ca\xd4C\x92b\xaem\xdc\xe6\xe3\xbf\xf9\x1b\x18\x86\x88c\xc3\xf7EQe\xdeU\xe2ۛ\x85\x1c\x83f\x930\x88R\x1d]\xbcWT)\nN$\x9aQS\xaf\xb0\xdbh261\x86\x84\x10;\xb8\x8eK\xbfۧ>1&\xf2\x9f\x03\x81\u008f\xc0\xd1Q\x18T\x90\nB\xc3 \xe4\xccç9\xb00\xcb\xd3_~\n\xdfu\xf1|\x1f75\v\x91$\tMS04-5\xbfU\xee\t3O\x92\x84\xc0\x17\x01\f\x122\xf3\x87\x0f\xb2pp\x9e\xfaX\x8d(\x8aY[\xdd\x10\xdf+cw&\x194\x11\xf8\x9e\xf8\xef\xd2\xfe\xc94\xde\x034ӌ\xc3|\xa9\xcc\xe2\xe22\xa5r\r+g\xa5^\xad\x01.ª[D\x16\xab(\xaa+<Ue\x05Mש\xd4*\x14\n\x85\xcc\x0fB\x92\x10V\x02R\x94\r\b\xf7x\xa7\xa4*\x9f \ni5\xdbضC\xdb\vI\xe0\xb3\xec\x8b\x19~\xedT\xf8O\xdeu\xe2aLm\x12Yґ%8Z\xd7I\x19\xb4\xa99YB\x9c\r\xe5{E\x95$\t\x03o\xc0\xee\xa0\xc9Fg\x93;\xbb7q\xfc!\x8aZď\x8a\xac\xb4\x87,\xf7686V\xe1\xfd\xc7\x17p\x82\x90\xedADN\x93p\x12\x8d\x8ec\xf3\x9e\x9f|\aoz\xe2\xe1\xbd\nJ\x1d\xf3\xee\x99\xefS,\xc7\xcc\xe5\xf0\x1d'[ '\x99mP\x9c!\xdf\xfd\xfe\x90Z\xadB\xa3\xb1\xcb\xf1\xf8\x18\xc8\x12\xbai\xd0\xef\xf6\x19\xf4\xfa\xd4\xc6\xeb(\x8a\x82纘9+\xe5\xac\xcb)G\xdd\xc0O)4\xc3\xc1\x90^\xb7G\xb9V\xe1\xfd?\xf1\x01n\xdfZbeq\x89~\xaf{\x0fn\xb5\xf7D\xef\x99\u007fX\xb9\x1c\xa6ee;ʉ\x89q4M%\bB\x9a\xbb-\xfa\x1d\xe1\x1b\xba\x87s\xed\x85\x0fDa\x98\x86\x9c\x87\xe9\x1aH\xceTJR\xfa\xce\xc7Q\x84\xa4(\f\x86\x0eq\"\\\x9d\xe3\xf4\xfb\x8fZ\xb50\x10@\xb0\xa2*H\xc8\xf8A\x90\x86\x8bB\xa3Ѡ\\\xaeP,\x16\xc8\x15\xf2\xe4Rs\xe1Q\xc3.\xe8:{\x16MQ$v\xa2\xb6\xed\x12\x06\x01F\xb1\xc0\xef\xfe\u007f\u007f\x1c\x00\xff\xfc;\xadtt\xe0\xa7檓\xe8j\x85\x18\x89\xe35\x15]ٗ\xba\x90)r\xc4\aٶ\xfbl\xf7\x9altw\xb8\xbds\x93\x81\xbb\x81\xa6\x96P\xe4\x02\xc3\x00\x9aC\x95+\xbb\xcb\xcc\xcc,`\xd5J<0^\xa1B\x88\xa2\xc0\x9d\xad6\xa7&琤<\x8a\x1c\xb3\xde\xdd\xe2\xff\xfcG?\x9dr\xad\xf6\xa5\x12\xed\xc5?dE%\x10\a\xf1\xcbꆞ5\x91\xa3\xa2\x1a\x01\x9f\x83\xc1\x80$I\xe8\xb6\xda\x04\x81\xf0R\xcf\x17\v46wpSc\xd98IXYZ\xe6\xc0\x91C\xe8\xba\x10@(\x9aL\x18\x82\x993\xf0\xdb^\xea\x1c\x13\xb1uw\x03YQ\x18\x1f\x1bcvv\x860\x169ЅR\x81\xc0\xf3E+\x10\x8aE\xb6a\x1a)\xd3!5\xbc\x8d\x04\xf6\xe38.\xbb;\x03\xe1]\x9a\x8c>\xa8\xd4]y\x04\xb8\xa6\xbfn\x18\x06Bl\x9aZ\x95\u007f\v\x83@\x92\x18\xd8\x0e\x85r\x89\xeb\xd7o\xa3\x1a\xa60\xc8Mi\xd2#\xbfӑ\x05R\xbeXċ\xa1c{\xd8A\x84\xa9*L\xa9:I\xb7\xc3\xf6\xf6\x16\x96\x95\xa3Z\xab\xbd\xc6C~\xef\xaa\xd0R\x1f\xb0$I\xc8\x15\xf2\xd8q\u009f\xfc\xc5\xd3l4:\xff\xf3/\xfd\xfc\x87.\xfe\x9b\xdf\xfd̷-\xacw\x9d\x1c\x9b\xcf\x17\xcd*I\"1WR\xa9\xe7\xd4o\xd9!m\xf7\xda\xdc\xdcY\xe1\xca\xc65l\xaf\x81\xa6\x16\x89b\x89 \x06?\x99b\xb33\xe4Vw\x89\xe3\xf7\x9fF-\xc3[N\x9dDU-ڻ\x9bH\x9e\x83\x94$\xacu\xfaĉA\xc54\xa9\xe4\x0f\xb2\xd1\xd9\xe4\x89\xf7L\xf3\xf0\x83'\xf7\x1d\xba\xe94\xb8\x1fi\x8e\xf7\xaeb\xcf\xf3\x91\xe2\x04\xc3\xd0\xf7v~\xa3\xa2J\xbf\x82\xef\vvc\xa7-\x82\x90\f\xcb\xc00\f\xe24\xec\xd2\xf3<\x928\xa1\xb5+$U\xf5\xc9\t<\xc7\x17\x89\x14\xaa\x82\x1cEX9\v\xd7\x11t\x1a\xcf\xf5\xf0\x1c\awhߣ\xbe\xb7{}TMM\xa7A\xc1u\xefw\xfbi?\xb3\xc7P\x10\xa6hI\x16r\x1exA\xaa;\x8c3Z\xb5\"\x8b!\"\xf0\x84\xb3L\x18\xf8{'i\xfa\xcb\xc7Y\x8e\x8f\x10\xa1\xb6:\xfdT\xfc+\t\xaeX\x92\x10\x04d\xd3\xdc\xd8\xf4\fv$q\xe1\xc6\x1a=)ω\xfb\xee\xa7X\xae0h6\xf9\x83\xaf\u007f\x83\x9c\x12rhn\x92\x83\xb3:\xdbn\x838\x8a)\xe7-\f]ˮ[\xcb4\x84\x9f\xaa\xac\xd0\x19\xba\xfc駿\xc2\xca\xe6\xee9\xe0_\xbe\xe5̉O\x0fl\xe7;.\xa1\u007f\xf2/M\xd2\x00\x00 \x00IDAT\xf8\x81\x99Ä\x91JN\x97\x99/k\xf7l\xf5\x9b\xc3\x0e\xdfX|\x91\xb5\xf6\x16~\xa4\xd2q\x1dڶ\xccjg\x157\x898p\xe8\b\xf5\xe9qf\xebu\x9e8|\x18\xdf\xf5X_\\|\xcdI\a-\xdbeuw\xc0[\x0e\x9fd\xba\xfa\x00\x92\xa4\xf3\xcd;\xe7x\xeac\x1fC\xd7\xf7\x89\x1bĸ\xb3\xc7c\x8fG\x8ad\xa1\xfc\xed\xb7;\xa9[K\xbc\xefĺ\x179\xf6]\x17?u\xc0\xeb\xf6\xfa\x8c\xe9B\x80 %R\xb6\xdc%I\xd8\xd9i\x92/\x95(\x96+\"\x8d>\x14\"\x05Y\x92P4\x15+o\xe1\xda.f\xde±\x9d\xf4tI\xb2\xf9\"\xf0\xfc\x8c\xc9\xf3-\xa0bfζ\a\x0fD\x91\xf0\xb5\x1a\xa9s\xa4tҳ\n\x16\x90\xe0\fm\xbc4\xf4rd\xf3MJ\xf6\x13D\xc4to\n(\x9a\xceڍ%\x14M\xc3s\xbd\x14\xc2\b\xa9M\xcd\x10J*!2\x9f\xfd\xe6e\x12%\xc7\xc4\xe4\x14\xef\xfd\xe1\x1f\xc9xb\xb5B\x91(\x8eSU4\xb4\x94\x12\x9dV\x93(\x82|\xa8\xb0x\xfd*wW\x97c\xe0\x19`ąj\x03\xcf\x01\xbf\x0f\xdc\x04\xf8ƅ\x1b|\xe3\u008d\xefXX\xef\xa8\xe5\x8b(\xb2\xc5|Y\xcb\x18*N\xe0q~\xf52\x17\xd7n\xd0\xf7\x12n\xec\xec\xb2\xdao\xf2\xe6w\xbe\x87Y\xd3\xe4h.\x87iX{n(\xb2\xe0e\xc7\xd1>5\x884\xa2sD\\\xdb\xd8\xe6]\x87O1U>J٪\xf2\xf4\xads\xfc\xef\xbf\xf6Q\x1e=s\xdf=b\b<\u007f\xdf\t\xb5\xafؒ\x04w0\xa4\xb9\xdd\xc0̙\x84~\x985\xdd\xf7\xec\xe8$\x890\b2qk\xafۣR)1\x1c\f\x851Z\x9a!\xa8\xe9Bl\xb1x\xfd\x16\xb5z-\x03\bC\xcfCQU\xec\xfe\x80\xc0\xf3\xb3+\xd7\xcdY\x02\x16H{\x8d$N\xb2\xb8\x142|)\x9b\xb0\xf7\x15\x14\xfb\x96\xdd{\xb4eY\x91\xd1M\xe1\xe7.&\xc0\x10g8\xc4\x1e\x0e\xf6z\xab\x94P\x98$qf\b\x92\xa4|\xfd\x9b7\x96\xc4i\x1d\x04\x8cM\x8d\x13\xab\x1a=/\xe4\xabWVP\x82\x88\xb1b\x9e\xb9Z\x1dr\x15$U\xf8櫺\x92Yc&\x89\x98\x0eEԋ\xc4\xd1\x13'\xe9w\xbb\xd4\xc7'\xf0m\x8f\xbb\xab\xcb\x1e\"\xf0\xe9\xaf-\xff:niyLMf\xba(\xc0\xaf\xe5\xdd5\x9e\xbe\xf5\x12k\x9d>\xe7ח\xe9\x05\xee˩\x9f\xd2\xef\x1cX8h\xbdV\xa36\xda#%q\xf2\x1a'`\xf1how\xba<45\xc7T\xf9 \x13\xc5In5\xd61\x17\x02>\xf2s?\xb1\xb7`NbH\x14\x12\xd7O'L\x99(\f\xd21\\4\xab\xddv\x87A\xbf/\xec\xb0ӂ\xcd\x00\xca}\xe7l\x9c\xc4\x04\x81\xc0n\x1cۡ\xdf\xed\xd3n\tח\xc8\xf3Ŵ\xa4(\x18\x86\xc1\x95\x97^\xe0\xc4}')WA\xd3\r\xe2$&\xf2}tC\xc3\x1e\xdaض\x8da\x9a\x98\xf9\x9c\xb8\x16\x1d7K\x91\x88\x91\xf6aO\xc9ޚ+\x83\x04\xf6\x16˲\"\xa3kJFW6r&\x8a\"\xe3{\xc2Wu\xd0\xeb\xe1\xd8\xc3T\xb5,\x1e,\xc30\x91R\xdcl\x041\xa8\xaa\xca\xd2\xca\x06\xadސ\xb5n\xc0\xf1\xe3\a\xb9\xd6賽\xbeˁz\x99\a\xc7ꂎ\x1c'\f\"\x89(\x19ɼB4IG\x95\x15\x9cэ\xc0(Jƣ\xb1\xbdM}|\\\x18\xee\xa6o\xe3_[\xfe533\x93\x03LI\x929>V$\x88\x03^\\\xbe³\xcbW8\xb7r\x9bN\xe0~\x01\xf8\xf5\x8f<\xfe\xe4\xb3\x00\xbf\xf5\xc2\x17\xff\xef\x84\xc4\x1a\xe16B\xbe\x1d\xee\rn\x19\x8fI\xca0\x12۱\x89\a\x03f\x0eM1U\x9e\xa1m\xb7xn\xf1+\xfc\xc1\x1f\xfd\x069\xd3H\x89ܩY\x9a\uf4c4a\xfa\xc4G\xf8\xa3T\xae\xf4\xc4j6\x9a\xf8\x9e\x8fc;覙\xaed\xf6\a!I)ૠ\xa9J6\xe58\xb6M\x1c\x89\xabh\x84\xea\xc7I\"$\x85A\xc0\xadk78q\xffI\f\xd3\xc2ș\xd9UU(\xe6q]\x850\b\xd14E\xb8\xc6H\xa0\x06b\xba\x13+\x9at\"\x1dYv'{\xf4\x1bI\x91R^\xbc\x18\xe9UMCK\xf3\x0f}\xcfc\xd0\x1f0\xe8\xf6DO\x15\nXbt\xda몎\"\xcb\xf8\xbe\x9b\xae\x88\"4]g\xa7\xd9eck\x97\xf3;\x1eoZx\x82\xb8/qe\xf9\x05\xceNר\x17D\x8eQ\x98\xf6\x9d\x92\x04R\nQ\xb8\x8e#\x18\xa4)\x9c\xf1ګ;\f\x02\xb6760\xf4\xa2\xb0\xa8\xfck\x86j\xaa\xe9\x17\xacKH\xe4t\x9d r\xf8\xdc\xc5\xf3|s\xf9\x16\x97vV/\x02\xbf\xf8\x91ǟ\xfc\xdak\xfeݶ\xa6ieMӱ\a\xc3\fgQ\x145E\x95C\xf6G\x1b˲\xccNc\x87G\xa6gp\xdc\x06\x8d^\x83ŝ\xeb\xfc\xd8\xdf{\x1fO<zz\xcfC]\x06\xa2\x98p0\x84\x14\x1f\n\xfc \xfb\x80G+\x90\x9d\xf5M\xe28a\xd8\x1fbX\x96\b^b\xc8^\xc8RB\x92H\x94\xaa\xd5\f\x98\xd44a\xb0\xe1\xa7\x01Hr\x9a֕$1\xba\xaea\x15\x8a\xac-/\x91\xcf穎\x89+\xd1\xca粢\xd4\r\x1d5\x95ݏ0\xa2\xc0\xf3\x05\x8dw\xdf\xde.\x83\x03d%\x8b\x9b\x93e\x19\xcdЄ[_\x9a`\x16\xf8>\xddf\x9b\xe1`\x80犕M\x92$x\x8e\x93>D\t\xaa\xaa\xa1\xe9\x1aQ\x18\x12Eq\xfa>\xcb\x04q«7\xee\xf0\xea\xaeϱ\xeaalw\x80\xa1\x19\xfc\xd8\x03Oұ\xbb\xfcխ\xe785Y\xa5^\xc8e\x0f\x9c\x9c\xc4D\x92\x84\xef\xba\xd9~7\f\xc3o[\x14\xa2\xad\x91\t\xa3\x00\xa0\xf9\xdd(\xa1=H\xe8;\x1d\xbep\xe5\x06W67\xb9\xb4\xb3\xfa%\xe0C\x1fy\xfc\xc9ogk3\xf0=\x0f\xcfq\xf7ؓ\x89P\xf9H\xb2\x8c\x16\x86(\xb2\x94\n\x14lv\x9b+t\xbb\x1dv4\x8d֠\xcd\xd7V>OD\xc2o\u007f\xf8\xd7\xc5\x144j\xca\x13\x89 \xf5\x99\x92e\x998\x8a\x84\xfd\xf4\xbe\x06\xbd\xb9\x93:\xe6\xc51\xc3ᐂWb\xee\xc8\x11\x9a/\xb5^C!\x86\\\xceBB´,,\xd3\xc4\xf7\x04\xe2-|\xab\xd4,!UQT*\xf5:kK\xb7iln#I\xc2:\xc8s\x85\xaaEӴ4\xa3FL\x8b\x9a\xaeQ\x1d\xab\x90/\xe6\tS\xa3\x90\xd1ϙd\xd27\xd1k\xaa\x9a\x80\x1c\x820\x14J\xa0 \xc0w=z\x9dnzB\x85\x84\x81O\x14\x86\x99+\xf2\xe8\xb45t]\xfc}\x18\x12\x85\x01\xb2\xaa\xa2[y\xbe\xf8\xcc\xcb\xdcn%\xdc7v\x1cK\x13\x1b\x85 \nXo\xad\x91\xd3s|\xf0\x81\xf7\xb3\xd5k\xf0\xcd\xe5s\x1c\x1a\xaf`Y\x854\xf7qo\xba\xd0T\x95\xfe\xe0\xdb\xebPs\xf9\"\x81'\x06\x8c\xbf\xae\xad\x91\nP,\x16\x1b\xfd~?\xec8}u\xb9\xd9\xe6\xe2\xf6\xcas\xc0\x87~\xeeѷ}\xa7/\xba\x1b\x06\x81\xe8q\xee\x19\x85\x12\x86\xfd\x1e\xcdf\x93N\xa7\xc5\xe5\xf3/qߡY\xde}\xfa\x04\x9c\x9ee{c\x9d'&\x8e\x10\xb8\x0e\xbf\xfc\xab\xbf̱Cs\xfb\xd65\xe2\x17\tG\xa6\xacI,\xd2NS\xa9\xba0\xd4\r\xd9\xd9\xd8\xca\x18\x0f\xfdn\x8f\xda\xf8\x18\x13\x13c\xdf\x02S\xaa\x8aJ\xb9T \x01f\xe6f\xd3h\x94@\x98\xbe\xa6\xacM\xa1\x84\x11\xc5P,\x16I\xe2\x84\xf5\xd5;$$\f\xba=J\xb5*\x86i\b\x9f\x85T}\xe3\xed\x13X\x8c\xa0\x18]\xd7д\x9488j\xd0SFF\x1c\x06\x84)^&\xf6m]F\xef]\x18\xf8\xf8\xbe\x97\xfe\x8eq\x86\x17ɲ\x8c\x9e\x16\x95x\xb8<\xfaC\x8f^,q\xee\xc5[DN\x81ӓS\xa8\x8a~\xaf\xf3\xa0$\xe1\x87\x01\xeb\xadu\xaa\x85*\x1fz\xf0\xc7Yl,sm\xfd\nS\xe3\xe3\x14\xf3%TUM٧\xc2>\xea[N+Y\xc60J\xf4;k#w\x9a\xbb\u007f\xed\xc2\xda\xde\xdeNr\xb9\xdc/~\xe2\xe5\xaf\xfe[\xe0\"\xf0\x13\x80\xf3\xf1\x97\xbf\xfe\x9d\xfe\xdd]\xdf\xf3\x91U\x850\x10\x12\xaf\xc6\xf6\x16K\xb7o1_\xb7x\xdb\x1b\x1e\xe4؛\x1fc\xea\xe7\x9f\xc42T\xfa\xbd\x1e\x9dV\x87\xe1\x81q\x86\x83!\xc7\x1f|\x80\x93'\x8e\xa4\x1f\x82\x94\x06#%xCq\rH\x92D\x10Fx\x9e\xbf\xb7\xa2Ib\xb6ַpl;ê\xec\xc1\x10\xd7q\x99\x9a\x9e\xc4\xd04\xdc\x14 \x95%\x89\x85\xc3\v\x18\xa6I.\x9f\xa3V\xab\x12\xf8\x01\x83\xfe\xf0\x1e\x1b\xa10\bP5\x95(\x8eD\x92X\xa9D\xb7\xd9dme\x89\xda\xd8$\xae\xebb\x9a&\xbaa\xe0\xf9>\xb9|\x0eC7D\xc8\xc0H\xe7\x97bg#\x80s\x84\xa2\x85i\x11{\x8eC\xafۥ>5Ak\xa7!\n%\f\x88B?\xb5\xe7\x16\xdeZ\x8a\xaaf\xf9\xd8Z\xea)1z\x18\xbaÀϟ[\xa4\xa4\xd7\x19\xcbϋ\x88\xbd\xfd\xfa\xa1tPڧf\xa4\xef\xf4\xe8\xd9}\xe6*\xd3\x1c\xac\xcfss{\x89\x9b\xb7o2v`\x9eb\xb5\x8a\"IL\xcfͳvGx~\x8d\x9ax\xc30\x89#\x89 \xc8\fD\xda\xe7Ν\xfb/.(]\xd7\xf7\n˲,>\xf5\xa9O\xfdǟ\xf9\x99\x9f\xf9\x8f\xff\x85\xff~Ӷ\x87\x8c\x8dO\xd2\x1a\xeer\xf9\xe2+\xbc\xf7\xf1\x13\xfcO\xff\xf5GX\x98\x9f\xa5T.c\x0f\xfax\x8e-\x1am\xc7\x15\"\x840d8\x18\xf2\xee\x1fzG\xc6Y\x92R\xfa\x88\xef\xf9\x84a\x98\x12\xdf\xc0u]\x92(N\xdd\xf1\x12\xb6\xd66h7[\x19\xba>\xa2Qn\xdc]cff\x92\xc7\xde\xfa\x16^z\xe6\x1bDQD\xa1X\xa4Z\xad`\xe5s\x1c;y\x9c8\x8e\xe9\xb4{\xf8\x9e\xc0\xb4\x14UAN\x93\xe8\xe38\x11?_\x02c\x13\x93\u0603\x01\xaa\xaa\xd2jl\xd1\xda\xdda|rZ\f\x01\x86N\x1c\x85\f%9۫\xedozG\x00h\x1cGYO\x98\xa4\xbf\xa3\xdd\xef\xd1\xdcޠ\\\x1d\x13\xf2\xfc\xc0\xcfv\x80\xa3\xe8\x13yԓ\xa5E\xe5\a\x1eQ\x14\xd3tB\xfe\xfc\xfcm\xe6\xcb\vT\xacJ\x1a(5Z\x1a\xcbihT\xca\xfcH\xfd1\x12b\xa1$O\"v\xfb\r\x92$\xe1\xe8\xf8\x02'&\x8f\xf0\xe2\xd3/\xf1j\xe1%\x0e\x1f9\xc6\xd8\xe4$\xc5\xf28I\xa8\xa4F\xb82\xb2\xac\xb2\xbbs7\xb59\x8a\x00n\x9d={\xf6\xfb\xe5\xf3\xce\xedn\xb7\xc3\xe4\xd4\f\x17Ͽ\xc4?\xfd\x87?\xc2\a\xde\xfb6\n\xa52Q\x14\xd2\xef\xb4q\xed!\xfdn\x9f~\xafO\xb3\xd9f\xd0\x1f\xd0iw\xb8\uf447\xa9T*\xe9\xf26\xc9L\xcf<w\xb4Ѕ8\f3\xb9Q\x12\xc7t[mZ\x8d\xe6\xde\xeej$\xfb\x92%\x9c\xa1\xc3n\xb2˛\xde\xfc\x18ǎ\x1f\xa6\xd9lg\x01ᓓ\xe3tZ\x1dv\x9b\x9d{\f<TU\xcddXq\x10\xa6MlB.o\x89\xe40$L+'\\g\x1a;h\x86\xc1\xe4\xccl\x06\xa7\b\xa7bi\xdfF\xe25\xb1\xaa\xd2\x1e'+\xf0}\x9ca\x9f8\x89\xe9\xb6w\x91U\r\xdf\x15;N]7\x84\xf05\x05,\x15U\x84\x8e\x87\xbe\a\xb2\u00ad\xdd>O\x9f\xbf\xcb}\x93G\xc9\xe9\xf9}\xeb\x1e\x19U\xb1\xd2zR\xb3\xe2\xda\xdfCE\xb1O\x9c\xa4\x10M\x1c\xb0\xdb\xdf%!⍇\x1e\xc5\x0e<\x9ez\xfeY\xe6O\x1e\xa1\\\xaef?\xb8a\x14\x88\xe3\x90 p\xc5C'\xa6\xeb\x95\xef\x8b\x1dw\xfa\xba\xb1\xb5\xbe\xce\xf4\xf4,\x1fx\xebi>\xf0\xe4\xbb1\f\x8d\xc6\xd6\x16\xae\xeb\xe0\xb9\xc2s\xb3\xdb\xe9\xd2n\xb6\xe9v{\fz}\xba\x9d6O\xbc\xf1,\x99\x8b\x83\xd8\x16\x13\x84Q\x86I%\x92\x84\xef\xf9ٔ\x15\xf8>;\x9b\xdb\xc4qJ͉\xf6M`)\x9f|\xd0\xed\xd1\xdcmR\xa9U\x99\x9e\x1a\x17\xa8\xba㰲\xb4\"\xa0\x85}\x12/I\x96PuU\x00\x8ei\xa3,vu\"\x9aD7\f<\xd7A\xd34dU\xcbޡ\xf5\xd5\x15\x82@\x18\x87\x98f\x0eôҥn\xa6\xf1J\xa96\xa4\xe8}\x98҉\x05\xabu\xa4͓\xe3(5\xd40\x84,\u007f\xa4\x96\x96$\xa2\xc0'\xf4}\xec \xe1\x85\xdbw\xd9\xd9\xf2yx\xf68\x9a\xa2\xa6̂T\xc3)ɘZ\x81}y\xc6ߢ\xb0\xd0\x145\xb5\x8e\x8a\x89b\x85(\x0e\x88\x13\x99\xad\xee&I\x12\xf1\xc1\xd3\xef\xe5\xcbW\x9ff\xfcm\xd3\xc8H\xb8\xb6\x8d\xe7\xed5\xf3\x86Yd}m\x19\xe0\xfa\xf7\xb3\xb0\xae\xae\xad\xdeazf\x96\xc7\x1e;ȥ\x97\xce3>YG\x92$|ק\xd7볳\xbdC\xbfۧ\xdb\xe92\x1c\xf4qm\x9b\xea\xf8\x04\a\x0f-\xa48bL\x92n\xe9\xddt\xba\x1c\xc1\va\x90F\xe9\xc6\t\xdb\xeb[\xe9\xf6?\x12b\xcb\xd1x\x9fF\xc4ű\x8c$\x89%mg\xb7\x99\x1a\x8d\xa9\xc2k4\fQdu\xaf\x17J\x12dIE\xd3u\xd4\xd4\xffܱ\x1d<\xdbI\xaf\x97\xbdk\xce\x0f|\x91y\x93Z2\xaa\xaa\x82\"[\x02\x1f\n\x03\xdc\xf60\x8d\xe6\r\xf7\xe9\n\xc5U&I\xa9\aC,~NU\xd5\xd1\r\x13EQ\x90ӓI\xe0Zj*%\x8b\x89\xa2\x10\xd7\xf5\xb8\xbe\xb6\xcdS\u05f68R\xaes|b\x0eU1PeA\x84Ԕ\x1c\t\x11\xaa\xace\xa7S\xbc_\xa2&%\xa9\xf5o\xb2oǛ\x10F)\x1d<\x89\x89\xe2\bC\xb5\xe8\f6y\xc7\xf17\xf2嗾\xc9;\xdf\xf7\xc3h\xbaN\xbf\xd3\xd97U\xab\x04\x81\x17ɲ|\xe3\xfbYX]`\xc5\xf7\xfd\x85\xf3\x97\xae\x12\xb4\xb6X\xaf\xd61,\x938\x11\vX۶\xb1\xfb}\\\xc7\xc6ul\x02\xd7\xe5\xef\u007f\xf8\x1f\n\xf5q\"\x9a\\\t\x11\x99\x16\x04A*kK\xee\x19\xdb\x03\xdf\xcf\xf2\x8d\xc30ܣŤ+\x8d8E\xff$EFMT\xe2TB\xeey\xa2?I\x12AJS$A\x9dVT\x15\xdd\xd00\f\x118>\xec\xf7i\xeelg,\x9d\xfd\x1b\x83QO(\xa7!\x98\xb2$\xa3\xe7\x8c\xcc6\x88T,\xea\xba\x0e\xbek\xa7\xa7\xa1\x82\x92\xfaE\xa8\xaa\x86\xa2\xa8\"\xcc|\xb4\xebKyZ\x82\xf7$gW\xbd\xef\xb9l\xec4y\xee\xc6]\x86\xb6\xc2#Ss\xa8RB\x14uQe\x05S\x1f\xc7\xd0JȒ\xcct\xa9@\xd1ШX\x06\x8a,\xe1\x04\x11Q\x92d\x96\x9aA\x14\x11\xa6\x84\xcb \x8a\xf1\xc30e\xf3\xe6\x89‱|\x01\xdbwXmo\x11\xc6\x01\xcd\xdd\x1d:\xbb\xbbT\xc7\xc7q\x86Ì\x13\x96>+\x17\u007f\xef\xf7~/\xfa~\x16\x16\xc0\xf9\xda\xd8\xd8\xc2ť\xdb\x18\x84\xcc\xf4\xba\xb8\x8e\xc8ዓ\x84\xc0\x13\x18\x8d\x99/19w\x80B\xa5\xcc\xd1c\x87E\xd1HdO\x95\xef\xf9i~\x8e\x88Mˮ\xb98\xa6\xd7\xe9f\xa7T\x14\x89\xa9k\xb4?\x1bMʣb\x1bq\x8f|\xdf\xcf\xe8\xbdb\xbfFFC\x96$0,S\x8c\xfbQH\xbf\xdbɖ\xb9\xc2QY\xf4\x16\xa3\xd1?J'%E\x910,\x03U\xd33\x92\xe3(N\xd7*\x14\x88\xd3 &y\x9fg\u05c8\x97N\xfc\x9a>,\xd5\x18\x8e&\xbef\xab\xc3\xc5\xe5\r.\xdd\xeds\xbc^ga\xaa$N\xa6$$NB\xa2\xb8C߶\x89\x8c\t\x16Ǝs\xb0V\xa6l\x19\x19\xf1\xc3\xd2\xc5i-\xbdf\t\x9e\xec\xeb\xff\x92\xf4\xa1\x1dz.7\xb6\u05f9ݸ\x83\xa9\xe7Ykoq䘰\x84\xec\xb7;T\xc7\xc7ill\xa0\xaa\x06\xb6=\x04x\xe5\xfba\x15\xf9\xda\u05cb\x9dv\xfbCO\xbc\xe5m|\xfd\xaf\xfe\x92\x83e\x89\x03\xf5\"\x9a\xae\xb1\xb9\xb5\x8df\x98\x84\xb2\xc9\xdab\x83k\u007fu\x99GN\x1f\xe7\xa3\xff\xb4\xb2\xb7SK\xf5\x81\x9e/\xf8K\x92L\n\x10\x8a\xe3\xdbwE\x16t\x9c\x16N\x1c\x8b>,\x13M\x841a\x18\xa4\xd7Q\x94\xa9b\x820$IO\xa7\x11\xdba\xa4\xa6\xc9\x15r\xa8\xaa\xf0\x86\xd0d\r+\x9f\x17\xe0dj]\xa4(\xaa0\xe4O\x8f\xb0\x84D\x04$\xa91\x05\xb5$\xaeII\xde+\x91TH+\xab\xca\xde\xfe|Dy\x18\xed\xde\x15\xf9\x1eD;\x8e#\\ǡ\xdb\xedrs\xad\xc1\xd7n5\xf0\xa2\x84\tä1\x18\xe0\x04\x12%+\xa2h\x18\x14\xcd\nI\x12\x11\x84}\xbc`\x93ŭ6=\xe78s\xd59\xa6J\x05*\x96\x81<\x92\x88}\x1b%\xab$\x8d\xae\u007f\xe8\xba\x03\x9e\xbeu\x81\x8e\xbdM)?õ\xcd\x15\xecb\xc2\xe1\x83G\xb35܈3`ZE67\x17\x01\x9e\xff\x9b(\xac\v\xabw\x968\xf3\xc8Y\x1ez\xe4,\x9dv\x9b\x97w\xb6!\xf1\xc8\xe5fQ5\x8dB\xa9ĩ#u\xa4«\xfc쏽5#\x9f\x8d8מ\x17d\x1f*az\r\xa6̀\xf6nK\x14R\x18\x11\xa6\u007fĵ\xe9\x89q~DGN'\xc5(\x8aR\x03\xb7$\x05+EaȊ\x88\x0f1-\x03\xdd02\xfaI\x18\x04\f\xba\x1d\xb1\xd7L\x19\bj\xba\xcb\xdbO\x03NH\x88\x02\x9fNs\x17\xc30\xd1M\x13E\xd5Pd\xf9^\xb3\x9ed\xbf\nz\xc4\xf0L\xb2\x18;\xc1\x13\xb7\xe9\xf7\xfb\xac\xed\xb6y\xee\xe6\x16Rbq\xa26M^W\xd1U\xc1\x10\t\xa3\x98\x8e3\xe4\xf6\xee\x16\xf3\xd5\x05N\xcf\x1e\x87$\xc2\xf1\x9b\xb8\xfe\x0e\u06dd\x97i\x0f\xd6ة>\xc8}SS\xccWr\xe44Eh\r\xe2\x84 \xa5\x8b\v\x93a\x19/\fXl\xac\xf3ԍs\x18z\x95D\xae\xf3\xb9\xabOs\xff\x83\x0fqd|bO\x9cZ\xc8\xd3\xef\xb4\xd3~\xd2\xe4\xf6\xe2\x15\x80\xaf\xffM\x14\xd63\xcd\xc6N\xe0\xf9\x9e&I\x12\xd5Z\x8dj\xad\xb6'SG\xacJJ\x15\xe1\x98\xf7\xc0\xa9\xa3)\xd5CP\x89\xe30Ȯ\xc1$e!D)\x11n\xd8\x1fdʔ0]\x85\x04\xbeO\x18E\xa2\xa0\xa2\x98$=\xa5F\x05\x15\xc5b\xb1l\xee\xd3\xc5%q\x82\xaa\xab\x989\vM\x13\xc6\xfb\xa3\x1e\xaa\xdf\xed\xa6\x8b\xf3=\xa5M\x14'\xf7\xae>\xd2|\x9c$\x89\xf0<\x87\xc0w\x91\x86r\xd6#\ti\x97\x84\xa6\xe9\x99/\xd6\xe8>\x1a\xb9\xfey\xae\xc7ж\xd9\xed\xf6\xd9\xea\xd8,5\x1d4)\xcf}\x93s\xd4\xf2\xa6(f)ASGW\xbaB\x1cW8\x19\x8cs}\xbb\xc1ŵK\x9c\x99;E5\u007f\x00߬ӳ\x97\b\xa3\x06\xab;\u007fN\xab\u007f\x923\xf3\x0f\xf0\xe0\xec\x1c\x15SGUFi!1n\xe8\xe3\x06\x0e\xe7V\xaepc\xa7\x01J\x95\xaf\xddz\x85\x93o9\xcbO\xbeῢ\xb1\xb3}\xcfI*I{[\x05!\xe4\xf56G|\xab\xefwa\r\x81otڭwJ\xfb\xd6)\xf7x\x17$1C[\x043\xceMO\b4Y\x92\b\x03\x01'\x84QH\x12\t\xdf\xcd8\xed\xa5<ץ\xd7\ue9a7\x93`\x0e\x88\t/\x80\x98\xacy\x0f\xc2H\x14ZJ\x9d\x15\xc9\xf5\xd2=\x8b\x1dUWɗ\n)\xa3@\x88Q]\xc7\xc1\xb5E\x92È\x01=R\x1f\r\a\x03aY\x1d\x89\x0fG\x96e\x82 \xc4\xf7\\\xfct\x11\xac\xa6\a\x9a\xa2(Y\x11)\x8a\x8a\xa2ji?\x17b{>\x8e\xe7\xd2w\x02\xb6\xbb.7\xda\x01\x9a,sjl\x92\xc7\x16&9}\xac\x82\xeb\x86\x04~\x84\xaa\x88sQ\x96\xf6d\x94a\x14\xd1\xe9\ryhv\x8aW7\xb7\xb9\xbe\xb5\xc8\x03\xb3'0\xf5\x02\x9ar\x92\xbe\xb3\x8e\x17\xb4\xf0\x83\x15\x9e\xbdu\x83\xe5\xc6\x11\x8eL\x1cg\xbe:\x8b\x1fz\xec\xf4\x1b4\xfa\x1b\xdcܾI\xde:ȝV\x17o\xda\xe4G?\xf2sL\x8cMp\xe3\xd5W\xef\xf9 \xcd\\\x8e~\xb7\x9baY\xbd^\x1b\xe0+\x9b\x9b\x9b\xe4r\xb9\xef{a\x01|a\xb7\xb1\xf3Ή\x89\xe9}\x14\x8c{]X\xba\xad\x16\xb3u\x8b\xb9\x99\t\xd1h\xa7;?\xa1\xaeI\xf6\x11\xffEvr\xbf7\xc8\x1a\xf60\b\xb2Em\x96\xb5\xbc\xbf\x97\x1a\xa5\x8d*\xf2=\xfa>9M\xcfRR\xd5\xc9\xe8k\xb9\xf60\xf3\xa6\x18\xed\xe1F\r\xbem;\\\xb8r\x8bK+;\\\xde\x1a\xa0\xa60\x83\xa5h\xa8\x92\x8c&\xcb(\x92\x8c\x1b\t\xb6\xe8 \xdc\xe3\xa2)\x92DNUQ\xd2\v\xd0TU\f\xd5 \xa7\x9b \xe9\x18r\x9bw\x1e=\xccç\xaaTK\x1a\xd3c9v;\x0e/\xbe\xb2M<\x88PC\t9J\x88Մ\xc8L\xa8\x8e\xe9L\xd6uZ]\x9b\xd3\xd3u\x9e]Za\xb5\x95\xe3\xf8\xe4i\x14U\xa5V8L\x14\x1f\xa0g߁\xa0\xc5j\xf3\x15\xee\xb6.\xa7\xfb\xbf\bY\xd2\xc8\xe9\x93\x18\xda\x047w\x1aT\xce,\xf0\xf8C\x0f#+2q R)F'\xf7(\xe7\xd1wݬ\xbf\xbau\xeb%\x80\xbf\x9a\x9a\x9a\xe2o\xe2\xc4\x02\xf8̫\x17\xce\xff\xeb\x1f\xfe\xd1\x0f\xe1\xa5?\xd8\xfe\x062I\xd9\r\xef}\xe7\x1b3UI\x926\xe1\xbe\xe7\x13\x04AƦL\x92\x84AW\bIG\x85\xe5{\xbe\xf0\xa1J\xfb\xb08\x19\xf5Rqf\n\xb6\x1fm\x96da\x18\vb\xb2\x93S\xaaL\x9c:\xae\x90F\xea\xfa\xa9a\xbe\xa2\n\x9e\xfa\xf2\xc6._|\xf1\x1a\xadV\xc8\\y\x9c\xb7\xce\x1d\xd8sʓFr\xac\x84=ː=oѽi,I\u007fG\xa13T$\xe1\x8c|~c\x93w\x9d\x98\xe5\xc97O\xf1\xc8}\xe3\xf4\a>\x9f\xf9\xf2\x12\xc3M\x8fZ_\xa5\xa6\xe6)\x1b\x16\x92\x9c\xe0\xfa\x01\x9d\xee\x90^\xcbcp0f\xa2\x96c\xb7\x15\xf3\xf6\xa3\xc7\xf8\xd3+\xaf2[=\xc6Dq\x1e\xc7o!K\n\x95\xc2\x11r\xe1$\x03\xadLo\xb8J\x14\xdbH\xb2\xce\x03soE\x96$\xd6:]^\xd9z\x81\x8f\x9e\xf9\x10\x92,\xa1\xab\x1a[\xab\xabb\n\x97\xf7\u07b7a\xafw\x8f-\xd4ʝ\x1b1\xf0\x17\xdfMa|\xb7\x85\xb5\xe8\f\x87\xaf\xba\x8es\xbaX*1\xe8\u007f\xab\xe3\xb2\xeb:\x8c\xd5\xca{\xa2\x82$ʮ\xc1\xcc)\x8e\x91\xd4)H\x91b\xb16\xf1\xbc\x11O\x89Lq\x12\xa5Ͷ\xac\xca\xfbLͤ{\xec\x13!A\xd3S\x85L\x1cg\x83R\x10\x04\xf8\x8eC\x10\x84\xf4\x9c\x80՝\x1d\xbeqi\x91N;f\xbe<\xce\xf4\x94\x85\x92&eH\x12\x84\x91-\xcc\xe5\x10\xe3z\xdaq!Kj\xd6N)\x92&\x144RB\x10\r \x0e\xd1\xd5\x1c\xaal\xb0\xd9\xeb3W-\xf0\xee\xc7fy\xcb#\xd3\x14\xf2*_x\xfa\x0e\xf6\x86\xcb1\xa7\xc4\xc1\xfa\x18\x87\xea\xe3\x14u\x03\x19\xb0]\x97\xf5\xf6.\x8b\xbb\xdbl-\xdatO،U\xf3\xb4:\to?r\x8a\xab\x9bW\x99\xab\x9d$\b{$h蒌\xaa\x98D\xb1\x8b,\xc9t\x06K\x80\xcc\\\xf5 \xbb\xfd\rt\xd5bzn>c3\xc8q\u009d\xe5\xa5\xf4\x1a\xdf#efT\x1dEc0\xe8\x01<\xfd+\xbf\xf2+\x8d\xbf\xc9\xc2\x02\xf8\xe4\x95K\x17\xfe\xe5\x99G\x1f#\x97\xcf\v\x01@z\xe2\b\xe7\xb9\x01\xf5j%\xd3\xfe\xc5\xd1\b2H2S\x89\x04\x84ڗ$s\\\xf1=?\x8b\xb4e\x94\xcd7r\x97\x93\xd2Q^\xdaGL\xdbGK\x96\x159s\x8f\x19Qp\x84\xcep\xc0\xf5\x95-\xce]_ei\xad\xcbL\xa1N՚`n\xba\x80\"i\xe8j\x01M5I\x92\x888\xb6\xf1\bH\x920\xd3N\xaaJ\x0e\x92\x00M-\xa2\xc8j\xda\x17\x89\xe6>\x8c\x1d\x82\xd0G\x96c\x8a\xd6\x01j\x85\xc3\\\xd8\xfc\"\xef==\xc1c\x0fNr\xecP\x95\xa5\xb5.7\x16[\xdc\xe7\x14\xb9o|\x9a\a\xe6\x16\x98\x1a\x1bÐ\x15\x12?d\xe8\xd8TL\x8b\x9c\xac\x10o\xdeeu\xcdF\xba?\xc44$\xc6\n\x05\xbe|\xf3U\xdeu2 gT\xf1BG\x042\xc4!Es\x06\x92\x88rn\x81\xae}\x9bWV\x9e\xe3\x81\xd9\aYj\xb5\x19\x9b\x9c\x02$tUe\xeb\xeeݽf\x9d}k\xa8Q\xafe\x96XZ^\x04\xf8\xc3\xef\xb6(^\x8f\xc2\xfa\xc4ꝥ\u007f911\xc9\xc2\xe1#\xc2\x15e߫\xdf\xef1^\xaf\x90Hj*\x0f\xdf\x13`\x06A(\n I\x8484U%Ga\x94\xf6VQF\x91\x19\xe1W{\xeb\v鞉f\x04\\*\xaa\x92\xc1\x1a\xa3\x01\xc2v}\xce_\xbb\xcdǿ\xf8\x02\xb3\xb9q\xea\xf92\x8f\xccL\xa2*&\x86Z@WM,͠`\xe8\f|\x1f?\xec\x91\xc8\n^\x10\xa1\xc8r\xe6\xde,\x8aiDi\xf6\xd3b3ғ\xca\a)\xc4\xd2&\xa9\x17\x0f\xb2ӻ\x8d\x17\xb49up\x92\xfb\x8e\xd5\xd1u\x99\xa5\xbb]*\x8e±\xe2\x18''\xa69t\xf0 ƃ'`j\f^\xba\x8a~w\x03)\x8e\x89\x82\x80\xcd\xd6.\xfd\x8eO\x12\x85\xd4\xca\x1a\x81\x9f\x90Su\xda\xc3-\xe6\xebGQ\xd5b\xba\xd2\tQ\x12\x8b\x829+\xe8:\xf1,\xcd\xc1mZ\xc3YdI\x16\x94\x18@\x93d\xee,/\xed\xf9\x8aI\x12\xb2\xac\xe2\x85\xee=k\x9c\x9b\xd7_\xf1\x80O\xfdm(\xac\x8d$I\xbeb\xdb\xc3\xf7(\xa9K\x9e$\xdfK\xbaK\x85\xeb$i\x9eN\x9c\xd2a\x82 D\xd7\xd4l}2Z\xdez\x9e\x97\xf6_\xf1\x9e\x85czZ\xed_\xfaf\xceģ\xfe)\x16\x8e,#\u007f\xa9\xcdF\x87\x97\xaf-\xf3٧/0_\x18\xe7\xe1\x89c\x98\x9a\x81&[XF\x89\x82\x9eGU5TYe,o\x12\xc6\tC\xbf\x87&\xabt\x9d-t\xd5\xc4\xd4J(\xb2\x82\x9cRTL-\x87\xaa\xe6p\xfd6\tqzeF\xa9_s\xcc\xfd\xb3O\xa0)*76;\x1c\x1e78s_\x8dJ5Gbj<\xfb\xc2*sI\x9eC\x95:\xe3\x85\"F\xa5\x02\xe35(\x15\xa1ZF\xddmS\xb0\x1d\x06\xbaNŰ\xb0\xec>}'b~Vfc\xc7g\xa1Vg\xa7w\x93\xe9\xf2\x1c\x92d\x90\x90P\xceM\xe1\x87.Q\xec\x907&Q\x14\x03YR\xb8\xba\xf1\x1c\xe5£\xecno\xa1k*ۛ\x1b\xd96B\x80\xc6J\xaaMH\xb2ɶ\xd3m\x93\x16U\xfboCa\x01|lks\xf3=\x0f<\xb4\xcfCJ\x92\xee1\x1bIB\x97$ݏ\x8dD\x9dQ\x14\x11+r\x86\xf9Di\xfe\x8cﺢ\xf7\x1a\xb9\xc7\xec\xa3\xec&\xfb3\xc33\x01\x80\x97I\xa3\xe28\xe2\xee\x8eù+K<\xf3\xd2\"\aʓ\xbca\xe6$\xaa,\xa3*\x06y\xbd\xccxq\x8c\xbca\xa5\xa7\x9fD^W)\x18\x1a\xab\xed]tE\xa7\xef\xef\"\x03\x96^\xa4`\x94\xc9\x19e@\xc2\xd2\xf3\xa8\xb2J\x14\xc7(\x92\x8c$\xc5\xf4\x9c\xbbHRL\x10vPd\x83\xa25\x85\xeb\xefҲ\xbb\x1c\x99\xca1w\xb0\x8e\\\xb6H,\x83\xc0\x8b(\xc9&9ECC\x06ׅ\xe5\rp\xfc\x14H\x13\x0f\x88\xef\xfb蒌\x86D`'\x8c\xd74$\x02tE&\x8a}\xa44\tvd\x1b\xa6\xab\x1a\x8a\xac\xa1\xe8UdYEF\xa13\x14鷥r\x05MRX\xba\xbd\x98\x99\xbc\x89B\xd2q\xed\xbd\xa5\xb3iU\xb8|\xf9Y\x80\xff\xe7\xf5(\x88\u05eb\xb0\xfe\xa4\xb9\xbb\xb3\xd1\xef\xf7f2`1I\xeea\x15\x88ų\x9a\x9eX\xe2\x14R\x15E\x14F\xba\xaa\x19!\xed\x9e\xebeOS\x98\x1a\xfa\xb3\xaf\x98\xa4\xd4\x19V,\xaa]|\xdf\x13t^\xc3\xe0\xd6\xea\x0e\x9f\xfc\xd3\vL\x14\n<4u\bYVPe\x03C\xcd3V\xa83U\xae\xa7\v`Pe\x99\x89\x82\x81*+\xacv\xda\xc2[3\x1a\xe2G\x0ey\xb3N5W\xa3^\x98#I\x97І\xa2\x10%\xe0\x851E\xabL\xcfބ$$\f\x87$xTr\x0fb\a\xe0\xf8\x828h\x1aP\x9d\xad\x82\x1f!\xd5u&\xa7\vD\xab\x91`\xccF\x11\xde\xc6\x16\x86\x17\xc0ʦ\x88\xb5KE\xaa\x9e\xeb\n\x8c\x0fH\xd4\x04-Mx\xc9\x1b\x06Q,b\x85UY\"\x88\xc4{\xab\xc8\x1a\xd5\xc2\x1c~\xe8\"\xa5\x9dH\x99C\xf8\xb1p\xe7\xdb\xd9\xda\x14:\x02e\x0fw\v|\xef\x1e\x95\x8ec;\xec6֯\x01\xdf\xf8\xdbTX>\xf0\x1f\x96\x16o\xfd\xabc\xc7O\xeeA\x0f\xe9\xc95\x92g%\xd9S\xb6G\xe3\x1d\xa1\xddBL\x10\xe0{B\x025beF\xa9=\xe4\xe8i\x1b=qbJ\x14\x82TM7X\xdd\xdc\xe5\xc2\xe2\x1a_ye\x917ΝF\x95\x02TYGU,\xf2F\x85C\xf5i\xcaV>k\xe8\x15Y\xc6R\x15,M\xa3\xed\f\xf1\x02\a\x89\x80\xae\xbd\x85e\x8c3U\xaa3V<\x80,k$H\x14t\r]Q\xf0#a&\xd7w}\x82\xa8\x0fD\x84Q\x17\x05\x89\x89\xd2\x04s%\x99[;\x0e\x053G\xa3ݥ\xb5\xd9b\xfc\xe0\x04ɝ\x06\x86\f\xadģ=\xec3U\xae\x10\xca2rc\x17\xcdʉ\xdc\xc0A\x9f\xfe`\xc0ж\x19\x06>\xb6\x12a\x94\x15\xee\xac;\x90>\x94\x92\xa4\x10F\x1e5\xab(\xac\x02\x82\x88 JPd\tU6\xb0\xf4:a\xe4\"\xcb\nu\xab\x88\xb5\x18q\xe3\xfa\xd5\xf4\x84M\xa7CY\xc3\x1et\xb2\xf7\xc20\xf2,//\x03\xfc\x87ש\x1e\xeeM\xa6\xf8._\xff\xe9\xfa\x95K\xddp\x1fp\x98\xa4^\x97\xbd^\x9f(\xf4\x89\x03[\x10\xf6F\x93a\xb4g\x84\x81$&C\xd7vS\xcaK\x92\xb1>\xb3,\x99,\xc1%\xc9\fn{N\xc0\xa7\x9f~\x85O\u007f\xf1E\xac\xbe\x8f)\xcb\xe4u\x13Y\x92\xd0\x14\x8b\xc9\xd24g\xe6\x8f0Y\xaa`h\x1a\x96\xa6S\xd0\rfK\x05\xa6Ky\xec \xa6\xe7\xba\xc8R\xccv\u007f\x19C+1_\x9d\xe0\xe0\xd8q\nF\x1eKө\xe5,\xc6\xf39j9\x93\xb2e`j*Q\xec#K\xb1h\xf4\x13a:\xab)9\xc6\ne\xf2F\x99\x99\xf2\x18\xab\x8d\x1e\x17^\\\xa6\xdf\xec\x11\xbb>\x0f\x9d\x1a\xa3[\xf2Y\xef\xb4\xd8l4\x18\xda6\x83~\x9f\xce\xce6\xad\x9d-v[Mv\xbb\x1d\x1a\xc3>[\x9eM\xbb\x14r\xe6\xfe\x02\x8b+\x0e\x90\xb0\xdeicjB>\x16%\t\x9a,Q15&\n\x1a%C\xc1\xd4d\x14YX@\x05р\x9e\xb3͛\x8e<̭\x17/\n\x0f2$\x14E\xe4\x17f\x0e\x93IB\x14\xc1\xd2\xed\xcb\r\xe0\xe3\u007f\x1b\v\xab\a\xfc\xe6\xdd\xd5;Yr(@.\x97\xe7\xce\xddMqB\xa5~\x9cQ*(\x18y $\x89\xd0Ё\x94&\xaeG\xf7x1dWꈼ\x96\b0\xf1k\xaf\\\xe7\xdf\xfd\xbf_\xc0o\xf5y\xeb\xe1\x03\x8c\x15r\xb8q\x8c*+\xa8\x8aN\xadP\xe7\xcc\xfca\xcaf\x1eC\xd5\xc8i:9]\xa7l\x99\xe4\r\x1dIR\x18\xf86A\xd8f\xbd}\x03E28<\xbe\xc0B\xfd 9\xdd\xc4\xd24J\x86\xc1Dޢj\x19\x14\f\x83\xaae\n\x86Cl\x13\xc7>^\xb0\x8b$\t\xd7g7\x94\xb0\x83\x98\x84\x88\x85\xda\x04\xab\xfd\x84\xebwZl\xef\b\xe0\xf7\xa1\x93\xe3L\x1f\xcbq+i\xb3\xdcnp\xb7\xb1C\xa3ݦ\xd1\xed\xb0\xb6\xbb˝f\x83[\xbb\xdb,\r\xda4\xf1\xd0\x0fɘ\x96\xcc`\x10\xd1sm\x96:M\n\x86 \xfe%\xd9\t.\xc4#\x96&3\x9eט+\xebLW\xe61\xd4Z\xeaT\xd0\xe1'ϼ\x9fK\xe7^\xa6\xdb\xe9\xa0(\x06\xbeg\xef1E\x8d<k\xeb\xab\x00\xff\x82\xd7)\xc1\xfe\xf5\xbc\nG\xaf߸|\xf1\x95\u007f<=3W\x1a!%\xa6iq\xf9\xc6\xd2\x1e=\x98\xd1\x1aG\xec\u07b4\xd4\xf0L\xd3T\xc20\xc8\xd67\xa3ř\x94\x89\x16\xf6\xd0\xfc\xbe\xed\xf0\x89/<K%\x94\xf8\xc0\xa9#\xb8\x91\xb0(\xb2\x83\x80\xa2\x9aG\x91\x12\x12Y\xe7\xd4\xd4Ar\x9a\x91\xd2J\xc4igh\n5Kg\xe8\x87l\xf6:x\xfe\x0e\xed\xe16H9\x0e\x8d\x1d\xe0\xc8\xd8\x014\xb5\x80,\t\xa3^CQ\x98(\x98\xe8\x8a\xf0\xa7\xdf\x19\xb8Dq\x88\x84\x8f\xed5I\x92\x80\x9c^\xc4\t\x06HD\xf8\x91\x84\xa5\x97\x90\xa5\x90'O\xbe\x81\xa7/\xbf\xcac\xa7\x1aL\x8c\x15)\x97,\xfe\xc1\x8f\xdf\xc7ǃ+\x9c\u007fy\x93͍.\x96\xa6#\xcb\x12n\x100\f|\x9c(d(\x87\f\x0e\xc6\xfc\xf0\xbb\xc7\xf9\xf3/4\x88℻\xad&\xa7\x85}\xf1p\x00\x00 \x00IDAT\xc6ʔ\xadyTEC\x1f\xad\xb1\xa4})B\x12\xa8\xaaāJ\x81\x89\xc2Y\xb6z\x1dZ\xf6\x90\xa1\xbb\xc9/\x9c\xfdI\xbex\xf5\xeb8\xb6C\xadV˸\x1bA\x94\xb0x\xf3\xe2:\xf0\x9f^\xcfB\x90_\xe7\xc2j\x01\xff\xe6\xf6\xad\x1b\xd9.ʴr|\xea\xf3_\xa3\xdd\xed\v\xe2\x9b,\\lF hf\xf3\xbc\x8f\x04\x97\xa4aGYQe\xc9\x0e1\xb6\xe7\xf1[\x9f\xfd\x1a\xf3\xaa\xc9\xd1\xf1Z\x96\x11\x98 \xbc\xcdgJ\x93\x84\xb1\xc7lu\x86\x99r\rMU\xd1\x14\xc1q\xcf\x1b:%\xd3\xc0\r\x13\x1a\x83\x1e\xb6\xbf\x8d\xe3\xf7i;\x03\xc6\n\x93\xdc7u\x90\x9cn\x91\xd3\r\n\x86F\xd1Щ\xe5L4E\xa5l\x19\x8c\xe5M\x9c0D\" N\xc0\xf1\xb7\xd0\x15\x9dJ~\x02UV\xd1e\x97\xf1|\x0eK+bjE\x1e\x9c=\xcen\xb7\xc2s\xafn\xf1\xf47\x96\xe8\xf6\x1c\n9\x8d\x0f\xfe\xd0a\xd4\aun\x1e\x18p%\xd7\xe4j\xd4dI\xea\xb1n:\xecL\xfbďJ\xbc\xe7\xfdu\xbe\xfat\x8bv?\xa0\xe7\xb8\\\xdb\xea\xb0P?F\xadx\x8c\x82\xae`\xaa2ECe\xbah\"IP0T\x0eTr\x18\xaa\xc2D\xc1\xa0bꜘ\x18牅y\xce\x1e8F\xd5\n\xf9\xc0\xe9w 5\\\x9a\xcd\x06\x90`Z%V\xee,\x01\xfc\x1aྞ\x85\xf0z\x9fX\x00\xff\xc7\xd2\xe2\xcd\x0fת\xb5\x85\xf1\xc9Y$Ib~\xe1\x107o\xafr\xf6\xcc)\x92\xb4\x96\xa38\xc6\xf3\\t]\xcd\xf8\xe1#\xf6f\x9cN\x94{\x81G\x02=\xf7Ð\xdf\xff\xd279\x92/1U*d\xffo\x942\x1c\x86\xbeO57A\x92D\x9c\x9e9\x8c\x96\x1a\x88IH\x18\xaaB\xd9\xd4\x18\xfa!-\xbb\x87\x174\xf0C\x87\xe5\xe6]\f\xad\xca}S\xb3\x94\xcc\"\x8ab\xa2)\x1a\x8a$\x93\xd3ULU\xa1d\x8aE\xf4Z\xd7e\xe0K\xa8\xb2\xc2V\xf76$\x01\xe5\xdc\x04\x05\xa3DG1\xd9\xec\\c\xab\xfff\xf2f\x1dK\xcf\xe3G}\xdey\xfcQ>\xfe\xd5\xcfSъ\f{w8v\xac\x8c\xeb\a\x9c}\xa0B\xa1 c\x992́\x87\x1f\xc5ԧt\xc2$\xe1\xee\x92\xcd_|\xb9I\xdf\x0ep\x82\x88s\xab\xbb,Ԋ\x1c\x9fz\x9c\x99R\x81\x99\x92\xc9ݎC=\xa7R\xb3t\x9a\xb6O\x14'\xf4ܐ\xa6\xed\x13D1\xe3y\x03;\x88\x84\x10\xc3\x1a\xa7\xebޢ\xeb\xec\xf0\xae\x13\x8f\xf3\u007f=\xfb\t\xde\xf8Ʒ\xe1z!+w\xae]\a~\xe7\xf5.\x82\xefEa\xd9\xc0\xffr\xf3ƵO\xea\xbaN\xa9<F}|\x8a+7\x978\xfb\xd0I\x92\xc8ɢ<\xa2(\xc6\x1e\f1L\x93$\x11\x8c\xd08\xe5U\xedO\x0eM\x10\x85\xf6\xca\xcd\x154;bf\xbaN2r\x98\x19E\xaf%\tC?@\xd3d\x8af\x89\x89bU\x18\x99I\x12\x9a\xac\xa0*\x12Q\f=gH\x10\xb6\x19zC\xae\xac_C\x96\r\xa6J\x13\xccW'\xb0\xf4\"\x92\xa4\x90 a\xaa\nEC\xa5d\xe8\xe4\r\x91\xb2qy{\x80\xae(\xb4\x86;\f\xddedY\xa6b\x95(\x18E,\xa3\xc0\xc0ݦ1\xec3\x9e\u05c9\x13\x97\x9c^\xe6\xf0\xf8<\xf7Ϟ\xe1\x93\xcf\\\xe1\xdd'N\xb1\xb1\x1539!\x11%!w\xd6|$\x19\x82 \xa6;\b\xe8\x0f\x84'~\xdf\r\U0004360e\x13\xf0\xe2j\x93õ\x02se\x83\x1bk\x9fdi\xb3\x88\xaa\x14S3\xe1\bK\x9f\xc0\x8bd\x86AL\x10I#\xe7kr\x9aL\xd9\x04M\xcdS0\n\x84\x91Gɬ\xb2\xdbߥT\xaaR(\x8d\xf3\xf2\xb9\xe7\x00\xfe1\x10\xfc \x14\x16\xc0\xef\xf7z\xdd\x0fw:\xbdw*\xb2B\xe4\xbb,\xad\xac\xefy\x97\xcbR\x1a\xb7\x96\b\x83\xdap(Ġ\xfd\xc1>~T\n1Ȣa\xdf\xed\x0e\xf8ӯ\x9e\xe7\x1d\xc7\x0f\xe1\x04>*\xa2h\xf6s\xbc۶\xcb|Mf\xa1.\x12\xe3崰LU\xc1\v#\xbaސ \xea\xb2\xddkp\xf1\xee\x05dI%o\xd68=s\x98\x92UA\x95\xf5\xf4\x94\x14\xa0i\xc5\xd415\x05S\x15I\x1b\xaa\fCw\x87\xc5\xed\x17I\b\xa8Xu\x8af\x89\x92Y\xa3hT\xe89\x9b\xf8\xe1\x00E\x99Ðt\x90\x12J\xe6\x14\xef;\xf5\x04\x9f\xbb\x14\xf0\xc7\x17.\xf1\xe8\xf4<ӫ\x15ju\tI\t\x89\x92\x10\xc7\v\b㘜i\x90\xcb+\xa8jĝ\xed>\u007f\xb1\xb8\xc3\xdb\xe6Ǚ,\x8aX\x12\x05\x89 \xea\xe3\x85\x1d\xc6K\x0fR0kl\xf7\x9a<\xbb\xf4\n\x8fΟ`\xb2\\\"\x8c\a\f\xbc.w\x9b˜\x1bT8={\x92\x1b\xdb/\xb3\xd4]\xa3X,311ə\x87\x1f\xa7\xd5j\xd3ln\xfc1\xf0\x97ߋ\x02\xc8\n\xeb}\xef{\x1f\x9b\x9b\x9b\xaf\xdb\x17\x9e\x9e\x9e\xfe\xef\xae]\xbdx\xe9\xed\xefx\xbf\xa9i\x01\u007f\xf0ٯ\xf0\xbf\xfd\x93_\xb8Ǹ#\x1e\xa1\xe5Q\x84c;\x82\x975Z\x90\xa6r&A\xb6\x8b\xf8\xca\vWx`f\\\x00\x83\xb1 \xe1)).\x86\x04Q\x92p\xab;\xe0Ĕ\xc1X\xa1\x9a\xe5\xf8(\x92\x84\x1b\x06\xb8\x81K\x10\xf6\xb8\xb9\xb3̕\xf5W0\xd4\x02\xaa\xa2cj\x16\x87\xc6f\xc8\xe9V\xba\a\x94\xd0\x15\x99\x92\xa9\x93\xd3\x14tU\xc1\tb\x06^@\x10v\xb9\xbds\x19\xc7[G\x02j\xf9\n%\xab\x86\xa9\x1bL\x94f\xd8h_\xc6\xf1\xbb$\x1c\xc0Є;_$Cɪ\xf3\xe3\x0f\xbd\x87s+\xd3|\xed\xd6y\xf2\x8d\xbbL\xef\xe4\xa9\xe6\rt5F\x96\xe0\x91\x93\xf3<\xf2\xe6\x83ػ]6\xee\xbaL\x96j\xd8a\rE\xa92[\x9f'\x8a]\xc2H\xec\xfd\xba\xf6*\xaab\xf1\xdb/|\x11'\xf2\xa9k\x12^p\x03)\xc91Q\xd58=Y\x83\xf8 \xaf\\o\xf1\xcc\xe2st\xe5\x1coz\xd3\xdbSi\x9b\x82\x95\xab\xf2\xf4\xd7~\xbf\x05\xfc\x8f\xdf\xe93\xd74\xed\xf5)\xacR\xa9D\xa9Tz\xdd\n\xebܹs\xb7\x1e{\xec\xb1\xff\xf5\xda\xd5\v\xff\xee\xa13\x8f\xe3\a\x11\x9e\x17\b_\xcb\xd4>(\x89\x93T\xeb\x96\xe0\xb9B\a\x98\x06\xa4\xa6\x02M\xd1c=s\xe1&\xd7\xef4xb~2u㋅\a\x96\xb4W\xa6\x1d\xd7eܬci\x1aE3\x8f\xa6\xc8(\xb2L\x10\x86x\xa1Ý\xe6\x1d\xeev\x06lwn K2\x9a\x9a#oNp\xff\xcc\x11Jf\teT\x88\xb2\x8c\xa9*\xe4u\x15C\x15\xa7^\xd3\xf6\xd8\x19th\xf4\u05f8\xddx9\xa5\xc9(\xe4\xf5\"\x05\xa3\x88,\xcbL\x96&Qd\x9d\xa1\xbbE\x18\x9fF\x91d4E\"\x8c\xf3\xe8\xaaA\xc9Jp#\x93\x13ӏ\xb3Ը\xcaFg\x8b\xcb;wX\xec\xac\xf1\xe1w\x1c\xe1\x03\xff\xe0!N\xbd\xeb>\x9c\xed\x1e\x9f\xff\xf7_\xa7\xd7\xd69PTxi\xb3\xc1{\xef{\a\x8a\xac\xb3\xd9\xddf\xe8\xb5(\x98\xb3\xd8\xde:\xef?\xf5V\xdcS\xe3T\xab5\xce?\xff\x1c[wor\xdc\xcd\xd1\x1bn19U\xe3G\xdfu\x82ى\xbb\xfc\xe1s\xebloo155M\xa92\xc5+\xe7\x9f\a\xf8\xa5\x8f~\xf4\xa3\xdb\xdf\r\x99\xefo\xe2*\xcc\xe0\x87\xed\xed\xb5'wv\x16~hf\xf60\x1b\xdb\r\x0e\xceM\xa1\xc8r6\x19&\x91\x90A\xf9\x9e'D\xa8\x89\xbc\u007fw\xc3n\xa7ϧ\x9f\xb9\xc4\xc9c'\xd8\x0e|&\xa5$3\x11\xd9\xe3\xabJ4\x87\x0es\x95i\x14Y\xc2TS͝\xefps\xfb6\xaf\xae_\xc5\x0e\xf3DI\x8b\xd0\x1b\xa0(\x1a\xa5\xdc<\xba\xa2s||\x0eCUҟIB\x93eJ\xa6\x10KHH\xdc\xed8\xf4\\\x87\xae\xbd\xc1\v\xcbϒ$\x1e\x9aZ\xa4Vx\x90\xb1\xa2I\xce(\xa4!\xe3*\x87\xc6\x1ff\xad}\x197x7ZA\xe2`5\xc7r\xdb\x064$\x12&\nEvm\x85\xe3\x93\x0f0S\xaesv\xe1\x01.\xad]e\xbcܦh\xa4\xe6\xb6\x12H\x89\x82\x14\x9a\x18\x89\xc5\xf5\xdd\x17\x99+\x97\x18+T0\xb5\"m\xc7\xc7\v=.\xdd\xf9C\xaa9\x83\xe7n\xdd\xe4\xbe\x0f\xfc8\xe3c\x93l\xfa!\u007f\xb6\xb4ȩ\x12\x9cp\"\xfcP\xe5\xa1\xd3\xc7(\x14L>\xfeW\xb7ْ\x14\\Of}m\xf1\xf3yM\xfa\xd8\xf7\xf2\x83\xff^\x17V\x02\xfc\xfc\x85W\x9e\xbdR.W+˫\x9b\x1c\x9c\x9bB\x92%\xc1W\x87\x8c\xed\xb0\xc7\xf2\x1cq\xad\x84\x8f\xc37.\xde\xe0ȑ\xe3Ȫ\x8a'\xcblz\x0euE\xa4\x87\x1a\xfb\x82\xbc\xc38\xa6`\x18\xc8R̥\xf5\xab\\\xdfJ\xb8Ӹ\x8e\xac\x94\xe8{\x06_Z|\x9a\x1f9~\nIJP$\x85$\x0e\xa9\x96\x8f1W\x1d\xc3Ԅ\xffy\x9c@\xd1P\xd1\x14\xb1\x1b\xb4\xfd\b/\f\b\xc2&/,\xbd\xc8\xc0\xebrh\xe2\x83Ԋ\x87\xd1\xd5\"G\xc75\x82pG,\x86%\x89\x85\xfa\x02K\x8d\x97p\x83\x1e~d0\x967\xe8\xb8>=W\xe0l\xe3\xc5\t\xbc\xc8f\xe0\xcb\xe4\xcd)\xda\xfdE\xfc\xb0\x8b\x913\xb8\xf9\xfc2\xf6n\x9f~\xcb\xc1s\x15\"\xcf``{\x9c\x18[\xc0\x8b\xc0\x0fcʦ\xc2\xc0\x971U\x8b\xe9\xda\x13\x04\xc1\x1aWΝgz|\x8a8\x8a\x98\x9c\x98d\xac>F\xafo\xf3\xb9+/\xf3x\xd7e{k\x8b\xfbO\x1e\xe0\xa7\xder\x8c\xcf<w\x93\x97\x17\xaf\x02|\xfa\x17\u007f\xfc>z\xdf\xc3\x0f^\xe6{\xff\xda\x00~\xa1\xdbm\xb3rwC8\xcaHR\x9a>!\xae\xb58e6\xbc\xd6[\xa3\xd1\xe9\xf3ܵ\rf\xe7\x17\xc8\x15\x8a$\xb2B\xa0\x9b\xec\x06\x12\xdd0\xb9\xc7\x1cS\x12f\xca$\x89\xc3v\xef&\xab\xcd[(\xea\x14\xcb-\x87+\x9b/S\xd7U\xf4\xd4a\xc62j\x14\xad\t\x0eT\xc7\xc9\xeb\x1a\xba,c\xa4\xb8\x90*\xcb\xc8\xc8DQ\x82\xed\aDQ\x9f\x9b۷\xb8\xb5\xf32S\x95ǩ\x16\x16(\x99UTY\xa5l\xe6)[c\x98\xaa\x8e\xa1h\xccV&\xc8\xe9U\x86n\v;\x88)\x18\n\xb3%\vE\x16'\x91\"\xc1T\xd1D\x91uL\xadF\x14\a\x94\r\x95\xa7.6h\r\n,]opweH\xbb\x93\xe0{\x1a/\xaf/rr\xf20k\x9d>n\xeamQ1E\xeec\xadp\x908Qxǡ7\b\xc5M\xfaPj\x9a\xc1\xd4\xd4\f\xa7\x1fy\x9c+v\x81\xf3w{ܹ\xbbM\x1cz\xbc\xfb\xf4\f\xef=Y\xe5Ĕ\xf9\xbb\xbf\xfeGW\xfe\x19?\xe0\x85\x05\"\x0e㟽x\xe1\x9a\xd8\r&1\xf9\x9c\x95\xed\xab\xc2T\x181R\x18\x8f\xba\xac\xab\xb7\xd79t\xf8(\x8a\"S,\x95\xb1r9\x12E!2\fZ\x91º\x13\x12\xa6UX2\rV[\x1bȒ\x89\xae\x94\x19\x865\xber\xf3<\x96\xba\xcb;\x8f\x1df\xba\x98G\x91\x843\xb0L\x84\xa6\xe8\xd4r\xe2\xcaTd\x19EN\xffN\x92𢘶\xe3\xe2\x85>\x9b\xdd\r\xbet\xe5\xb3H$\x14\xadi,\xbd\x86\xa2\xc8L\x97L\xf2\x86\xcaL\xb9\xceTy\x1c]հ\xb4\x1c\v\xf5\xa3t\x87kxa\xcc\xce\xc0\xc3\rE\x81\x89\xef#\x91\xd3\x15\n\xba\xa0\xbdLT\xce0^\x1a\xc7nK\xfc\xfa'o\xf2\xcc\xd5!\xaf.\r\xd8\xe86\xb9c/\xe2\x1bb\x0f\xd9\xf7l\xeev\x87\xf8QL^W(\x9b\xc2\x03\xc2Ч\x98\xaf\x8e\xd1hlg\x0f\xa9\xa6[\xf8\x9e\x8da\x18<p\xfaQ\x92ɇ\xf8\xec\x85\x06G\x0fN\xf0\xd0\xf1*o\xbd\u007f\x9c\xbf\xff\xf6\xa3\xe44\xf9W~\xf37\u007fs\xe6\a\xae\xb0\x0e\x1d:Ĺs\xe7\xb2?\x92$\xfd\xfa\x97\x9f9\xef4\xdb=a%\xa4\xa9\x98\xa6!\x9c\xf5F\xf9{#9W\xba\xa3x\xe6\xc2\r\xca\xe5J\xfa0J\x14J\x15t\xd3\"VuBӢ\x9bȬ81\xc30\xa1`\xe8\xb4\xddm\xb6\xfb\x11\x976[\x9c[}\x9e7\x1d\x1a\xe3\xd8\xf8\x18\x8a,Q4u\xc28AS\x14$B\fͤ\x963P%!\xc0P\xd2\tҏb\x86\x9eO\x94\x04\xec\xf4\u05f8\xb8~]p\xdbe\x83\xbcQG\x96dr\xaa\xca\\٢\x9e\xd3\xc9\xeb*\xf3\x95\t\nF\x1eCU\x19/\x8c\xb1\xdb;\x8f\x1b\xc68AD^W0U%\xbd^\xc5ڥ\x96\x13\xa0\xb0\xae\x160\xb4\"o<|\x86\a\xe7N\xf3\xb5\x97C>\xf6\xd5\x15\x1c\xcf!a\x87#\x13\x8f\xf0\x95\x1b\xcf\xe1x\x9bl\xf6Zl\xf5mLUa\"\xafs\xac\x9ec\xa28IN\x8bio7R\xf342O\x88Bi\f]7!\tx\xf2\x91S\x1c\xa8\x1fdaj\x82B\xce\xc0T\x15>\xf8\xe6\x05\x19\xb8\xff\a\xae\xb0\xea\xf5:gϞ\xcd\xfe\xc4q\x1c\x00_\xbf\xb9|Wp\xa1\x14\x19\xc3\xd0\x043SJ\x13\xde\x15\x19Y\x91P\x14\x99\xce\xc0F\xca\xd7y\xe0\xc13\x19cTV\x14\f\xc3Jw\x8e2\xb1\xaa\x11#\xd1\feZ\xa1\xc2\x033\x13,5/\x92\xd7[\xbc\xf1\xd0\x1cEsod\xb64\r?\x8a)\x98%\xca\xf9y*\xb9q\xa6K\x16#\xe1\xf3(_1\x8cb d\xa7\xb7\xc9Wn\x9c\xe7\xda\xce\x0e\x05s\x16S\xaf\"I\xb1轂\x88\xbe\x1fdq0\xba\xaaP\xcbW\xd0U\x8d\x82Y\xc0\x0f6q\xfd\x01=7\xc0\xd4dTY\"\xaf)\x98\x9a\x82,A\xc5RR\f.a\xba\xfa\x04\xb2\xa42U\xac\xf2\xc8\xfcQ¨\xc7\xe4\xf4\x18G\x8eThv\xbfʮg\xf3\xd4\xcdg\xb9\xb3\xf3,\x8d\xfem.o\xb5\xd8\x19z\x94L\x85\xb9J\x95\xce\xf0\x0eo?\xfc(\xb6m\xa7\x94\x18\x05+Wb8hc\x0f;t\xbbm\\/\xe6\xfa\xb5\x0e\xabk.;\xcd\x00Ϗ\xb8\xb6\xd2\x04h\xfc\xa0_\x85\xa3\xd7\xe7\xaf\u07bc#\x0e$Y\"\x97\x13X\x8f\xa2\xaaٖ~\x14\x1c\xb9\xd9\xec\x92/\x14\xf0\x02\x9f\xc3G\x8f\xa5{ÄA\xb7\xb3\x97Bf\x98\xc4V\x9eDUq\x13\x89@˱01\xc9T\xa9\x88\"I#u;\x12\x12\x05C\xa79\x1c\x907r\x04a\x8b\x99r\x9e\xb2\xa1\xa5\u007f+\xa4\xedQ\x12\x03\x11\xcb\xcdU\xbet\xed%\xfe\xc5S\x9f'I\x12\f\xad\x88,)\x99Z'N\x12l?\xc4\t\xa2,Y\xb8`\x18\x18\xaa\x99\x8a\xc6b\x1c\xbfM\xcb\t\xa8\x98\x1acy\rY\x06M\x96P\xd3+\xb1b\x8d\xe2\xdc\x14\x14EOi8\nӥ\x1c\x8b+\x01O\xbe\xff\xad\x9c=VaL\x97\xd1O\x1c\xe6f>\xe1\v\x97\xbf\xccf\xf7\n\x97\xd6os\xb7\xd3\xc2\v\xfax\xe1\x80zޤ\xd3\xe9\xa0\xeb\u008cm8h\x93\xc4\x11[[\x1b\xac\xad\xad^\xfa\x83\xe7/o\xdeu\xba\xectc\xb6vv\xb9t+\xe0\xe2roqll\xec\xc2ߕ\xc2z\xea\x8f\xfe\xec\xa9\xd4x\x1f\xac\xbc\x85\xac(\xa8\x9a\x9aj\xfc\x92\xccpu\xa3\xd1\"g\xe6X]^&\b\x02f\xe7\xe7qm;\xa3(\xab\x8a*R$4\x8d\xd8\xca\x11X9\"U\xa3\x13I\xac{\xd0\vE\x84\xf0\b\x96\xd0\x15\x85\x8dn\aK/\xa1)&\xe39?C5\xe2D8\xe0\xc4I\xc4Kw\xaf𧗿\xc9o|\xfd\xcfx\xc3T\x9d\xf5N\x8b\x82\x91#N\xa2\x8cy\x1a&1\x8a\xbc\x17X\x15'\t9M#\xa7\xe705\xf1\xe1\xf6\x9c\x1d:NH\xd7\r\xd2kPB\x91\xc1Pe\xf2\x9aJ=\xb7\x97\xfeQ0\xe7Q\x95<I\x02U\xcb\xe4\xfc\xca\x1aK\x1bm~\xe8\xfd?ě\x0eW\xb8q\xe5U\x16\xe6\x16\b\xc7+\xfc\xee\v\x9fe\xb5u\x81\xcb\xeb\xcfrm\xf3\x9b(\x92A\xd9ʱ\xbe\xbe\x82\xac\xaa\f\xfa\xbb@\xc2\xf6\xf6\x16\x8b\x8b7\xee\x00\xef\x05\xde\xf7\xef\xbf\xfc\xcd\x1b\x9f\xbb\xb0\x83\xa1\x1f\xe2\xe8\\\r\xe0\xe0\xee\xeen\xfe\xefJa][\xd9ؽ~{e\x83$5N\xcb\xe5\x85%\xa3\x94\xba\xbb\x90\x06j^]Z\xa7P\xac\x12G\x11\xab\xcb\xcb\f\xfa=\x9c\xe1\x90\xca\xd8\x18\xc7N\x9ff\xfe\xf0ajc\xe3\xd4\xc7'(\x94\xcaȪFd\x98\x04V\x0e_3h'\n\xabv̶\x13\xe0\xa7\v\xee\x863DW-\x12\x1c\xc62\x83Xq\xfa\r\xdc!\u007f\xf8\xf2\x97\xf9̥\xe7\xf9\xdd\xe7\xff\x92w\x1d\x9ac\xb6R\xe2\x99\xd5[{\xeex\xe9\xf22N\x12\x14IJ5\x86\x10\xa5&'\x96\xa6\x11\xc52\x95£4{W\xe8y!-\xdb\xc3\v\"dI\xfc\xbf\xaa\f\xb2\x94\x88&\xdc\xd2D\x16\xb5\x92'\x8a]$I,\xbc\xdd \xe4\xb7~\xe73\x8cO\x8c\U00056cc7\x99\x89\xfb\xbcr\xfe%j\xd5:'\xcf<\xcc\xd7\x1bw\xf8\xea\x8d\xcb\f\x83\x84\xf9\xfa\xfd\x94\xad\"Q\x14a\x0f\x84\x06b{{\x8b[\xb7\xae\xdd\x02\xde\xfa\xabO\xfe\xd2\xf6\xaf>\xf9K\xaf\x02\x8f\x9d_\xb9\xc5\xd0\xd5(\xe5\x03\x8e\xd7O\xa9\xc0\xa3?\xa88ַ{\xfd\u07b3\xe7^\xfd\xb5\xa3\v3\x90@\xa9\\`k}#\xb3\xffI\x12\xe8\x0f\x1c\xee\xec\xf4\x99;\xae\xa2\xaa\x06Q\xec\xd3i\xb7\x99\x98\x9d\xa36>N\xbe\x90'\n#\xfa\xbd.\xcep\x88n\x98\xe4\vE\xfa\xdd.\xf6p@,\x89\xfe+Jb6\xa2\x88\xcda\x88\x19ExQ\x84*\x1bL\x95f\x19/T3+\xa5\xab[+|\xeeէ\xb9\xd9hrq\xe5\n\xef9\xbc\x80\xaa)xI\xc4\xc1\xbc\x85\x9d1.\xd3\xc0\xa4T\x83\xa6+2\xba\"\xa5\xaah!\xed\xef\xfb&\xfd\xa0BA\xb3\xe9\xd9M6z\x16'\xc6\xf3)G]@$\xb2\x04y]F\x93%r\x9a\x82\xed\xf5\t\xe2!\x92\xa4\x03\x11o>r\x82O\xbc\xfc\r\xfe\xf3o\xff>\xff\xcdO?ɵŻ|\xfc\xc5\x1bT+Ut\xdd\xe0С\xa3\xf4z]~\xef\xf27\x91\xbd\x00'\n\x98\x9b[@\x96\x1567\u05f9}\xfb\xe6m\xe0\xbd\x17\u007f\xeb\xbf_\x83!\x12\n\x0f>\xf4\xcb\xfd\x9f\xfeW\xff\xba\xad\xa8V5\f{L\x95&\xb9ټ\xf6\x10߅\xa3\xcc߶\xc2\xfaϟ\xf8\x93/\xfd\xf3\x9fz\xff\xdb\xe5Q\xa4\xadn\x18\xd8\xc3av\x82t\a6\xd5j\r\xd7\xed\x81\v\x86\x95\xa3Z\x1f\xc7\xee\xf7\xd9^\xbb\x8bf\x18\x94\x8a%\xea\xe3\xe3\xd8\xf9<\xbe\xe7\xe1:\x0e\xbaaP\x0ej8\xf6\x90A\xaf+R\xea%\x19I\xd5\x18Ăҫ*:S\xc5\x12=\xcfa\xbb\xd7\xe2\xf9;\x17\xb8\xb4q\x9b\xab[\xbb\xf8n\x8bw\x1d^\xc0P\x15\xec8\xc2O\x12\x8e\xd4+l\xf6z\x94\f\v7\xecR`\"U=\x8b\xa8\x94Q\xf3/\x01=ף\xed\xfa|\xea\xd2\xe7\xf8\xd9\a?\xc8v\xf7\n\xbb\xf6\x04\x87\"\x13]\x95\bc\x91\xbf\x9c$B;!\x88\x842%\xa3\x84\x1bLa\xbb\xad\x14v\x91\x89$\x85/\xbe\xb2\xc3\xf1\x85\x9790np\xban\xf1\xe2\x8b\xcfQ\xa9\xd48p\xe0 \xa5R\x99\x87ϜEQ\x8d\xd4\x1f,`u\xf5\x0e\xab\xab˗\x80\xf7n}\xe6\x1fm\x8f(VS\x1f\xfa\xd8\a\x80\xbf\aXq\xac2\xb4\x1d4\xd9\x03\xa8\xff]:\xb16v\xbb\xc3\xf3\xd7n,\x9f}\xe8\xfec\xc2{\x14\xa1\xc8u\x1d\x17%PX\xdbnR.\x97\x85\xe8AQQ5\x8d\xed\xff\xbf\xbd3\rn\xe3\xbc\xcf\xf8o\x81]\xdc \b\x10<\xc0\x9b\x94dQ\x97-%\x92\xed\xc6G\\\xdbJb\xc7q\x92:\xa3\xc4\xc94\xad3\x938I\xeb4\x9d\xb49?tz\xa4ISO\xf2\xc19\x9c:\x934\x89ܱ\x9cq\xedږ|ȱdY\xb6#Y\x12%Q\x14E\x8a'\xc0\x03$\x01\x02X`\xb1\v,v\xb7\x1f\x16\xa4\xedX\xbe\x92\xbae\x19>3\xef\x17r\xb9\xcb\x17\xef\x83w\xff\xef\xff\xfd\xbfϓ\x88\xbf\xe4&V\xc8S\xc8fYH\xa7X\xbbn=A\u007f\x10\xb9\x90\xc7\xf0W(\x97Jx|>B\xe10\xba^FS5\n\xb2\x8cf+\xd4\xe1\x10`\"3\xc6ԉy\\N\x89\x13\x89\x11\x1e\x1fꣽ\xb1\x91Mu\xcd\b\x0e;\xe8\xafTc\xbd\xb0\xcf\xcb\xd3\xe7\xcf\xf2g;v\xa0\x95氂k\xab\x1b\xdb\x06\x02\xf6*Rt80-\x93\xa3\xf1i\xa6\xb3#\x04].\xa6e\x99\x90\xbb@*\x9fG.\xf9\xa8\xf3IX\xa6EQ\xb7_\x9b\x86i\xa1\xea:e\xc3B\x12\x038\x05'\x15S\xab\x1e\xdep\xd2յ\x06Y\x969\xdc;\xca;\u05f7\xf0ѫ[\xd9e\xb9Q4\x17\xfbNƙQ\x14b\xb1f\x825\x11\x16R\x93\x8c\x8d\x8d\x90LN\x1f\x10\xe0C3\x0f~j)\xa9\xde\xf4\xe1\x9f~\xf5\xea-\xf5ߺj}=\x9a\xa2a\xe9i2\xd9\"\xe7f\x8e\x03\x1cYI\xc4\x02\xf8\xe1CO<\xfb\xd3\xce\xd6FĪ\xb0\x87eYș\f\x96\x00\x89d\x1a\x9f/l\a֦\x89\"\xe7^u\x03è\xa0\x14\xf2\xf4\xf7\x9d$\x10\xa8\xa1\xb5\xbd\x03oM\x88\xbcR\xa0\\\xb6\x8f\x8f\x95\xcbe\xdc\x1e/\xa1H\x98\x92\xa6\xd1w\xe6\x14a\u007f\x18\xa7P\xc7tv\x9eG\az9\x97Oѱf\x1d\x92$q\x1e{F\x11L\x13\xa7\xe5\xc4i\x19x\xb0(bP2,tc\xc1\x960\xaa\xbe\xd6D\xe7K\x92\x90\xf1L\x8e\xb4\x92\xe5\xc0\xe0\x8b4\xb6u\xf0\xc2\xe8a64\xdc\xccdf\x84\xac\x1a\xa6\xc6\xed\xc4\xefv\xa0U\xedk\x9c\x0e[\xa5\xc60Kd\x8b\xb3\x94*\n\xc5\xd2\x02\xd1P+\xbdSq\x82\x910\xaaZ\xa4\xa3\xb3\x89\xf5]\x01\x1c\x82E:c\xff_\x1fݾ\x83_\x1d\xebôD\xe4\xdc\x02\xfd\xfd}\xc8r\xf6g\x82\xc0g\xe2{n\xab\xe8\xba\xfd\x05l\xdb\xf5\xb3wnh\r}\xeb\xd3;\xd7Q\xe3\xd2(\x96<ħJ\xb8\x9d\x8d4E\f\xa6\x8as\xed+%x_\xc4/\x1e}\xe6\xf8h\xff\xd08e\xdd.\x95q\bv\x9a\xc1\xacTH̦\xf0\a\x1b\x91$\xef\xab\x1c\xaa^\xb9\x13i\xbbU\xe5\xb2\v\x9c\x1b8\xc3\xf0\xe0\x00f\xb9L\xb8\xa6\x96\xa6\xa6\x18\x91H\x1d\xbe@\x10\xc9\xe5\xc6\xeb\xf3\xe3\xf5\xf9\xe9\x9f\x1a\xe7Χ~\xc5\xfd\xd3g\tn\xe9\xe1\xf2+\xaf\xa6\xa1\xb9\x19OU\a\xca\x12\x04L\xa7\x13]\x94\xd0$\x0fY\xc9K(R\x87\xaa\x1bT\xcc\x1c\x16\x15D\x87\xb0\xb4\"\xb4\xb7\x92Lz\xa7\xe6H\xca\t\xe2%\x19\u007f @}S#\x19\xb5\xcc|\xee\x14\vJ\x91|ɮ\xa5s\x8b\x02ZE%\xaf)hz\x01\xb5<G\xb68G\xb9\xa2 \x17\x87@p\xd3;;\x85eY\xf4\xd4\xeb\xdcxy+\xef\xbaj===QZ\x9b\\\xb8<:\x82P\xe6\xfa\r\x17\xd3\xd7w\x9c\xe7\x9f{J\x93\xe5\xec\xe7%\x87\xf0\xa9\xff\xfa\xcam\x95b1\xccb\x03\xfe\xe4=\xdbZ\x88\xf8u.\u07ba\r\xafǉ\xc7%\xe2\x91|\\ڵ\x06\xe0\x96\x956c\x19\xc0\x17\xef\xdf\xfb\xcc\xc3_\xffܭK\xce\xefn\xb7\x1bE\xd5\x18\x9b\xcd\xe5\xc7f\xf7\x96ޱ\xfd\x9ah$\x1cF-\xbe\xfe\x89o˲\xb5B\xb3\vi\xf2r\x8eE\u007f\xc4\xf6\xce.\xea\xa3Q\x04\x87\x83\x9c\x9c\xe3\xda\xf7\xdeH\u007f6˦\xeb\xae\xc1\xeb\xf5-\x89\x89\x84\x84\xba%\x87\f\xbd\\\xb6-z5\x95\x92ZD\xd7\xed\"\xbc\xc1\xb9$\x97w\xc40\f\x19A\xaa\xc7#9\xa9\x98&NA`:W \xab\xa6\xd9}\xfc\t\xae\xbf\xe9fj\xc3\x11\x16\xd2)\x92\xbf\x89\x13\x888\x19K\r\xd3\x12\xba\x98\x8aᴏ\xc3\x1b:\xb2\xaa0\x9d\x9d\xb45\xb4\x00EK\x11\x0e\xb4p.\x99\xa2\xbb{-\xd9l\x86\xdbn\xe8`\xf3;:hٺ\x8e\xbat\x96\xe4\xf4\xb3\xb8\\&ZQ\xa31X\x8fY\x941\f\xe3֯\xbd\xe7\xfa\x87\x8e\x8fOP*\xa9x=\xaf\xf8\"6F\x82v\x0emt\xe4\fEդT\x16\xb0L\x11\xc9\xd6\xff\n\xaf\xb4\x19\v\xe0\x91\xe7z\xcf=\xf9\xec\xb1>[\xac\xdfi\xfb\xf8ed\x85\xeaJe\xeb\x89c\a\x0f\x0e\x0e\x0e\xe0\xf5\xd7\xe3v\a߸\x94\xa2:\x83Ut[\x13k|t\x84\xe3G\x8e0\xd0w\x1a*\x06k\xbb\xd6p饗\xd3\xd9ٍ\xc7\xe7\xc3!\xbe$\x8d\xed\xa8\x9e]t{\xbd\x84\xc2a\x1a\x9b[\xe8X{\x11\xeb6nb\xebe\x97\xf3\x9b\xe98nɏZ\x9a\xc1%\n\x04ݢMDà\u007fv\x96\xfb\x8f\xefe\xfb{w\x12mhDr\xb9\x88D\xa3<\x17\u007f\x01\x87\x18\xe5T|?\x15\xa3DN+\xa1\x1b\x15\x10,\x16\x8a\x05\x94\xb2\x8aRR(W\x14\xd4\xf2\x14%\xc3˩\xec,\x91H\x1d\xc9\xe4\x14\x01\x97\x13yn\x1eC)\xa2\xe7\v\x94\xf4\xaa6\x18\x06`\xb0\xb1\xa9\r \xb5\xa8\x8e\xa1*\x06yE[j\x80&\xab:\x9aQ\xcf\xd0X\x89\x999\x9d|\xc1B@B\xd6t\xf8=\xa4 \x97댵\x88/\xec~\xe8\xd7\x03\x1b;\x9b\x05˲\xab\x16\xed\xaa\a\xea\x80)\xe0\xdaD|賓\x89\xf3߹\xf4\xf2\x9d\x81Ʀ5\x14r\xb3\xafpPx\xadj\x9dE\xe5>\x10P\ny\xce\x0f\x0e\xbc\xb4\xdd\x14\xad\xa7\xa1\xa9\x89p\xb0\x06\xa7$V\xabTu\xb4R\t\xb5z\xaeqi\xa9\x87m\x9a\xb4u\xfb\xa5\xa4\x14\x15\x974\rl$\xea\x0f`a\x91\xc8\xe6\xe9M\x9cƵ\xbe\x83\x8b.\xeaAt\x8a\x18\x95\n\xa9\x82B[{;\xf9\x92\x89`($\xe5\x04M5=\x14\xcb\x15\xcaF\x85\xd9B\x01\xcb2(\x96\vd\x95\x11\xfc\x9e\x18\xf7\x9ez\x9eM\x17oC\x10\x04\x9a\x9bې\x8b:\xa3\xa3\xf3Xz/\xe1h\x1d\x19\xb9BQ\xb5\xfb\xa5\x1b\x15z\xa7\xc6\x01\xc6L\xcb\xc0¤\xa9\xb3\x8e\x8eu]v\xd2\xf5\xea\xafl\a>.g-⓳d3\x1a\x9a\xe6ā\x17\xc3\xe1\xe6\xd0P?\xc0\xcfW*\xb1\x06'\xe72\x0f\xf4\xf6\x0f\u007fdsw\v\b\x02.\xd1\t\xb6\xb1\xf5b\x05͏,\xcbz\xf4\xc8\vO\xfe[}C\xeb\xfb\xb6n\xbb\x82h\xa8\x1197K\xb9\xa4\x00\xd6\x1b\x92li5Y\xdd\xc0I\xa7\xe6I\xa7\xe6\x17\xc34\x04A\xa0&\x14\"XSC(T\x8b(ym\xd3$\x87\xa3\xaa\x12\xe8`˖Kx\xfe\xa1\a\xf9Ď\x1dXV\x11\xb5\x12\xa0\xa8;8\x93\x9c\xe2{\x87\x1f\xe0\x86\xf7\u007f\x90\xf3g\xfa\xc9e3K\xda`\xa1P\x98\xf3s\t\xb66w1\x98\x1c\xa0\xab\xaem)&\x9b\x95eL\xcb \x9d\x1f\xc2\xef\x89\xf0\xf3\xde\xc3l\xdc|\x89mn\x00\xf8\xfd~\xc6SE\xba\xebÜ=\x9f\xa4\xd4?co=U\x1c\x98:ĳI*\x96u\xfc\xfb\x1f\xbbi*\xb1\x90\xa5\xa95Ȏ\xcb\xdal\x8b\xbcw\u007f\xb5\x03x\xf8\xf6\x9d\x97\x84=\xa6Io\xdf\x02\x92$\xe2\x16\x1dXh\xcce\xfb\x19\xcd&-\xe0\xe9\x95J,\x80\xef>r\xf0\xe8G\xba\x9bއ\xaaj$\x16\n\x00}\xbfuM\x02\xb8a~n\xf2\x96\xfdO\xec\xf9\xa7\xae\xeeM=\xeb7l\xa36܌\x9c\x9bES\xdfL\xc9\xdaK\x15\xa7K\x82\xe6/\xd7J\xcdf\xc9e\xb3L\x12\u007f\rr\u009c\x03fd\x85\x90\u007f\x1cM\xaf\xe1\xdc<\xbc0z\x82\xf6\xf6N\x16R\xafv\xb8\r\x04\x02\xf4O\xf4\xd1]\xbe\x8c\xfe\x99\x93l︄\x96p3\vE[\xb9P)\xa5\xf0\xbb]\x1c\x1e\x1db\xedE=x\xbdު\xae\xaaH{\xe7&~\xf1̯\xa9\xf38qh*e\xdd\xc0\xb4\x1c`\xba\xa8\xe8\n''&\x00r_\xb8o\xaf\xf0\xf1m\xd7X\xb1M!Dѱ\xd8\xcf{\xee\xfa҇b\x8e\xa2N\xb4\xa1\x9e}\x8f>\x8d\\(\xd0\xd2\x1c#R\x17b\"5\tp\xfc\x8e;\uea2c\xc4\x18k\x11/\fO\xa5v\u007fo\xf7\xa3|\xf3?\xf6[\x8f\x1c\x1d|\x1a\xf8\xe2k\\\xfb\x00p\xf1\xd8h\xff\x1d\x8f\xefݝ:\xd5\xfb<\x92\xab\x86\x86\xd8E\xf8\x83\xd1%ߛ\vC\xb8@\xb3^\xd6\xccW5\xdbZ\xc5dQ\x83\xb4\xa5\xa5\x95'\a\xfa(\xebi\xa6sc\f\xcd\r\xf3˓O\x11\x8d\xd6_\xf8\x89\x82@GG'\xc7\xe2\x038\x9d\x9d\x1c\x18z\x0e\xb5\xac\xa1\x944\fKè$\x99\x96Kd\xdd\x02\xa1P-N\xa7D\xb8\xae\x1d\u007fM\x13\xc3\xc3\xe7\xc8ff\xa7\xff\xee\xbe>khZ\xc1@ \xaf\x94\x98\xcb\xe4H\xccN\xb0\xb6>̖\x86-ךX\x9f\xf5\xb8אΨ\f\x8e.\x10|\xf7\xd76\xb4D\xfd;w^\xd6CGg\ac\x13I\f\xb3\x84 \x18ttu\"IN\xe6\xe42@\xffJ(\xf4{#\xfc\xe9\xe8lv\x9bn\x981\xe0:^\xdf\xe0Z\a\xbe\x0ft\x8d\x8e\x9e\xfd\xda\xe3\xfb\xeeM\x1d\u007f\xf1 \xban\xd2\x10\xbb\x88p\xa4\rQt\xbd\xc9\xc7^\x88l\xaf$ƒ\x02\x1e\x02>\x9f\x9f\x9c\xdb\xc5щq2\xca<\u007f\xbf\xefn6l܂$\xbd\xf6\xf3\x82\xc1\x1a*A\x93\x8a\xe5\"\x99\x9b\xa0\\Q\x88g\x92\x14\xd4Q\xcaV\x90\x03\x93\x83tu\xaf'\x12\xed\xc0\xe3o``\xe04\x8f\xef\xbdwv\xe8܉/\x03\x9fo\xafq\t\xeb;<\xb6\xe8m^GU-\xbbJ4\x16\xa0\xb9F\x03\xf8˟\xfc\xe6'ұ\xa3sl\xbf\xed_o\x00\xbe\xfe\xb9[\xaeD\xc0\x8d$\xb9H\xcd\xcdQ.\xeb8\x1c\"^\x9f\x9f\x99\x99)\x16\x942\xc0\xe9\xb7s@E\x96\x0f\xdej\tG\x01\xf86\xf0\x83\xf8\xc4\xf9O\xc6'\xce\xdfQSS\xbb\xbeg\xc3;i\x8a\xb5#\x8a\"\x9aV@)\xa4\xa9襷p[\xe1u\u007f\xdb\xde\xde\xc9\x13\xc3C<0p\x9a\r\x1b6\xe1\xf7\xbfq\x81@mm\x98\x9c\x9a\xa63\x1cᑾ\xa7Ȫ\x05\f\xfc<\x16\x1f⊫>\x80\xaa\x169z\xe4\x10\xb3\xc9\xf1~\xe0.\x01~\xfe\xf07>\xad}\xe0\x9b\xf7\xec\xf9Ɵo\xa0\xd6\x13\xa0.\x94f`X\xa5\xa8\n\x94˶;\xeb\xba\x0e\x17g\xa6\x9c\x1b\xa7Tョ\xe7'\xce8\x05a\x9faY\xf4\rM\xf1\x89\x1b=\x8c\x8c\xf6\xe3\xf1\x050-\as\xb2ξ\xfd\x87\x90D\a\xe3\xf6\x8c\xb5\xf7\x0f\x85X\xbf+\xf2\xc0\x0f\x80\x1f\xcar\xf6\xfa\xa3G~\xfdyশ\xf6n\xb1\xadm\x1du\xd1f$I\xa2X\xccQ,d0\xaag\xf3~\xe7\x0fL\x14\xe9\xe9\xd9\xf8\x96\xfe\xc6\xe3\xf1rnr\x8c\xcdM\x97P(\xe5\xd0-?\xff~\xec\x19j#\x8d<\xbe\xef\xde2\xf0 \xf0㶈\xf3\xc0\x8bw_mo\xc5캧Ur\b\x1f\xbe\xe6\xdd\xef%9\x11\xc7\xebRh\xeez\x17\a\x0f<Oj\xbe\x8cYщF\x1bh\xad\x95\x98R\x8d\x9d\xc01òt \xb3\xe7\xc0\x19\xff\xb5;\x8e\xfa\xdd^\xd8\xff\xfcI\x06\xe7\vdus\b2Q \x02\xfc\xe4\xfa\xae\xc8\xe0\x8a!V\"\x91x;ooa\x9f\xea\xdd\xdf\xd6\xd6֘\x88\x8f~\"\x11\x1f}\xbf \b״\xb4t8\xda;z\b\xd7\xc5p\xbb\xdc\x18f\xc5V\x02,\xd9揦\xf1?\x1f\xc3:\x1cN\\\xee\x00n\xb7\x8f\x88\xe8\xa2\xf7\xe4q\fD䲛_\x9e8\xa8\x03\xcfd\x16\x92\xfb\x80\xdd\xc9\xfb\xff\xf8B\x95\x9cw\xfc\xc3\xedWJ\x81`#\x16q*\x86EKS#\x8a\xa2!J\x16\x92\xdbC\xd0\xef%\xec\x93\x00\xedڞp\xed\xed\xe72\xd95\xd8RD;n\xff\u0383\xff\x88]\x16\xf3\x02\xf0\xe5\x8fm\x8e\x1d>42ǌj8vm\x8e\x99i\xa5Ċ!Vkk\xeb\xff\xcas\x14E\x99\xf5\xfb\xfd\xdf\x05\xbekYVdrr\xfc\x86\xc9\xc9\xf1\x9b\x80\xeb\xdcnO}kk7\x91h\x13\xc1`-\xa1p+\x92\xe4\xaa\x1a\u007f\xab\xe8\x15\x8dJ\xb9\x84\xae\xab/˅]\x18N\xa7\x84(\xba\x11E\x17\x0e\xa7\x84(J8\x9cN\fä\\.S(\xc8LMM1>6\x88iT\x98\x96\x15\xf6\x9c:\xac\x03ۧ\xef\xbbf)\xc6Y\xd4\x01\xfb-\xdc\xfa\xbe\xab/\xa3\xa4\xea\xa8\xf9q\xb2y\x9d+;\xdb\xf0\xf9}d3%\x1c\xa2\x84\xdb\xed\xc1\xefu\x01t\x19f\x81On{G\xa2\xa1\xbe\x89o|\xe5\x8a\xc7\x06\x86\xa7\x1e\xbb\xe2\xf6\x1f\n\xb7n\xe9\xb2Lˮr\xb8jM\x03Օ\xc9ێ\x95\xf0*|\x15|>\xdf\xcbsW\v\xc0\xbd\xc0\xbd\x81@@P\x14\xf3\x00͕\x00\x00\x029IDATe\xf3\xc8\xc8\xd9+GF\xce^\x01l\x056H\x92\xe4\x88\xd6Ǩ\xab\x8b\xe1\x0f\xd4\xe0r{qy몧\xb1\xed<\x96\x9d\x06p.\xc9&-jϗJ\x1aż\x82R\xccS\xc8\xcbL&\x86\xd1\xf5\x92QM\x99\x9c\xa8\xce\x18\x87\x80/\xec9\xf9\xec_XXw\x01\xa7\x9b?v\xf0\xf5\xba\xd0 @[KC\x84\xe9\xc4\f\x92(0\x9b\xae\xe0t:\xf1\xfa\xbcȹ\f\xban0\xbf\x90\xa3h\xefj\x17\x86\xb2\xaf\xd4\xf5\xf8\xa3k\xc1\xfc\xcc\x0f\xac\xff\xab1X\x91\xc4z-\f\x0f\x0f[\xb1X\xac\xaf:\xe8?\xaa\xfeإ\xeb\xfaƙ\xe9\xf8\xfa\x99\xe9x\v\xd0\x01\xd4\x02\xcd@\rP\x0fx_\xb6\x826\xabq]\x06[\xc5p\xa6\xba\x8a\x9d\x00&\x81\xf3\xc0Y\xe0\xb7߯\u007fea\xdd\r\x9cy3\x93{w\xa3D\x8d\xcfM\xffB\n\xb5\f\xa1h7\x00\x9aZ \xe0\x171M\x93\x81\x81!^\x8c\xe7\xc1ַZV\xf8\x83\"VSS\xd3+\xb2\xf0U\x94\xab+\xd2\xdf\xe9`A2\x99$\x16\x8b\xbd\x99K\x8d7I*;F\x13\xc0\xaa\x94((2\x87\x8e.о\xa6\x11EUm\x97\xf9\n,\xc8i\x8e\x8d\xa7H\x95\xcd\x1f\x03w.\xb7\xcf\xda\xc1*\x96#&\xce'\xf5\xc2\xee\xc7NqbD\xe5\xc4\xc0$\xc5\n\x14\n\x05<\x1e\x17\x96\xe0at6Ǩ\\9\x0e\xfc\xf5r쀸:\x86\xcb\x12i\xe0\xb2O\xff\xf3\x9e;\x81\x06@j\x99J_\xfc\xc9p-^\x8fHb2ǹ\xb9\"\xc0\x97\xaej\v\xaa\xcf&\xf2ˮ\x03\xab3\xd6\xf2\xc5ٻ\xffv\u05cd\xbb6\xd5n\an\xfe\xcf\xfdG\xc8*%\xfc51R\xf9\x12\v\xba9\xed\x108$,\xd3!\\%\xd62FN\xd1([NN>\xf0/\x13\x05\xad|\xe7=\xf7?IJ\xd6x1\x91\x05\xf8\xea\xaeM\xad\xd6r\x1dB\xe1\x02\xc1\xec*ޞ\xe0\xfd\xf7E=\xf0\x1c\xb0\x06\xf8\x1b\x01\xbeW\xcdz\x00\xb0\xdc\xc6q\x95X\xff\x8fP__/\xa4\xd3i\xef\xf4\xf4t\xf1B+\xdeUb\xadb\xc5c5\xc6Z\xc5*\xb1V\xb1J\xacU\xac\x12k\x15\xabX%\xd6*V\x89\xb5\x8a?d\xfc7bk\x8dEsR\xc8\xdf\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,10 @@
+// Package resources embeds the game's images and sounds. manifest.json maps
+// the asset IDs used by the games to files in this directory, load it with
+// the asset package.
+//
+// Adding a file only needs go generate to be run, which checks the files,
+// makes any sized variants listed in variants.json and rewrites the manifest
+// and embed.go.
+package resources
+
+//go:generate go run ../assetgen
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Bg_page_200 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xc8\x00\x00\x01\t\b\x06\x00\x00\x00ћh;\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14/9p\xd0I\xd2\x00\x00 \x00IDATx\xda\xec\xbd۲$Gv%\xb6\xb6GD\xe69u\xea\x82K7\x1aMv\x8b\"\x87\xe2Pc\x02\x87\xa3\x11\xcd8f\xa3\x0f\x90\x99>@\xd07\xe8]\xf8\x04\xbc\xebUς\xbe@f\xf3\xa47\x9a\xa4\x91\rm\b\x8e\x86\"\x87\xc3V7\xbb\x014\x80B]\xce%3\"ܷ\x1e\xfc\x12\xee\x1e\xee\x1e\x1ey9u\n\xcd$\xab\x01\x9c:\x99\x19ᱯk\xef\xbd6!\xf3\xfa\xf4\xe3\x8f\x1a\x00\xcf\x00\\a\xf9\xc5\x00(\xf1\xcf\xd2\xef\xe6\xfe;\xf7\x995\xdf_z/\x03\xb86\u007f$N\xf3\x12\x00.\x01<\x05\xd0d\xae\xa7\x01\xf0\x18\xc0\x1f\x98\xf3D\xe6\xda\xfc\x17\x01P\x00^\x02\xf8[\x00\xaf\x01\x8c\x15g\xbf\a\xf3+\x12bH|&\x00\xf0\xff\xf8\xbf\xfc\xc5\xd17\xfd\xe9\u007f\xf7_\xe0\x93\xff\xf5/cy\xc1'\x9f}\xbe\xfe\xb3>\xfe\b\xb9\xe7\xfb\xc9g\x9f\xf3Q\xd7y\xe05\xc5\x0f\xc2~\x18\x01\xf8\t\x80?\x02\xf0\xdf\x00\xf8\xcf\x01\xfc\xbeQ\x10\xc6\xdb\xffb\x00\xb7\x00~\r\xe0\x1b\xa3$V\x10s\x8a\x98R|a\xdeӘ\xb3\xf9\xa9\xf9g\x9b9\xa7\x06\xc0#\x00\xdb\x03\xaf{\x00pSP\x10\xefi\xd2\x1e\xc0\v\x02~\x05`\x97\xb8\xf6\x11\xc0\xdf\x00\xf8?\x01|mޥ\x98\xf9;\x00_\x99\xef\xc9\x1b\x8f\x97/\xc7O\xfe\xb7\x9f\xf3\x82P\x929\v_\xc6Z\x00\uf6f3\xb2\x86\xe4\t\x80\x8f\x00\xfc\x89w6\xf19\xf7\x00\xfe\x1d\x80\xff\x1b\xc0\x8bĳ\x1a\xcc}|i\xfe]\x99\xcf\x19\x8fU\xae@A\x8c\x16?\x06\xf0\xaf\x01\xfc!~\xc3^\xcc\xec\xc9\x18=\xfc\v&\x02\x9d\xfe\xfe_\x02\xf8\x99\xf1V)\xe1R \xfa\x06\xcc\u007fi\x04r\x1fy\xc0-\x80\x0f\x00\xfc3\x00?\xf0>\xa35?\xffGg<\x91_@\x1b\x85\xde(\xf8\xd7\x00\xfe\xca\xfcl_\xf0ڿ4\x06c0?\xbb\x05p\xfd\xc9g\x9f\xf7F'\x02\x0f\xf2\x13\xa3 \x1f\x96\x0e\xb2m[l..д-ڶu\x02\xc5̐\xe3\x88q\x18\xd0\xef\xf7\x90R:\xc1\xbbo\xa1\v\x04^\b\x90\x11(Ѷh\x9b\x06\xcc\f\xa5\x14X)\x80\b\x17\x97\x97\xe8\xb6[\x8cÀ\xeb\x17/ \x95\x82\x10\"\x10ș\xcb=Ѕ\x1d\xfb\x19e[\xc7gUJ\x98s5\xe7˹h\xe4\x01F\x0e\xb9\xd7΄\xdc\xd6\xf3\xdc\x00\xf87\x00\xfe\xe7O>\xfb\xfc_!r\x85\xff\x18\xc03\x12\xc2\b\x87Q6O\xc8/\x1e=£'Oд\rڦEӵ \x12\xee\xd0X)\x10\x00\xa9\x14\x86\xbeG\xbf\xdfcw{\x8b\xfdn7\t䉕\xc0*\x1f\x11\x81\x88 \x9a\x06mסi\x1at\x9b\rڶE\xd3uh\xdb\x16 \x02K\t{\x8fM\xdb\xe2\xe2\xd1#l\xb7[\x88\xa6\x81\x94\x12\xbb\xdb[|\xfb\xeb_\xe3\xe5\xf3o\xa1\x14\xdb/sJB+N?\xba\xe0\xb9\x04\x1d`8\x88\xdd\xffh\x83\xb5\xd9\xe0\xdd\xf7\xdf\a5\x02/\xbe}\x8e\xfe\xeeN\x9f\t\x11\xf4?\b\xb1\xbfQJ\x99{3\xd7\xe4\xeb\x96wM\x9c1:FY\xe84zG+Ĝ\x8b\xf2\xc0\xb1g%sw\xcc\x00av\x0e \xba\x04pI\xccP\xd3\xfd\xfd\x9e\xf1\xa2\xff\n\x00\xc8&2\x9f~\xfc\xd1\xff\xa0\xa4\xfc\x9f~\xf0\xe3\x1f\xe3G?\xfe1 \xf4\x19\xb4m\x8b\xae\xeb@\x82@B\xe8/2g\xa3X\x81\x15\xc0`\xc0X\xe5\xdb\xebk(V\x10 \xfd\xa5̐Ja\xdc\xef\xb5w\xe9{\fÀ\xa1ﱻ\xbbӂSyH̬}\xf9v\x8b\xaemAM\x83\xd6(\xc1ţG\xb8\xb8\xbcD۶\x10Bh\xcfa\x8e\x94\x99\xa1\xa4\xc48\x8e\x10\xa4\xafk\xb3\xddb\xb3\xdd&\xbf\x9b\xbd{\xd9\xdd\xdda\xd8\xf7\x18\x86=\xc0p^\xc7\x1a\x0e\b\n\xa4\xc9\xfap{\xef\x04\x80\x89\xd06\r6\xdb-\x9a\xb6\x05\x03\x90\xe3\x80~\u07fb\xdfo\x9a\x06\xa2i\xc0\xac G\t%\xe5\\\x81\xac\x9e\nB#\x1a<y\xe7\x1d\\=\xbeB\xb7\xd9\x00D\x90\xe3\b9\x8e\xd8\x18\x85\xd7^\xbes\x1fC溇q\xc4\xdd\xcd\r^=\xff\x0e\xaf_\xbd\x82b\xf6\x8c\xe2$H\x82\x04 \x00\"\x81\xeb\x97/qss\x03b\x0e\x95%\x16v\xf3\x1dJ)\xb0y\xb6B\x10\xban\x83v\xb3AӶFI%\x86\xdd\x1e\xc38\x06\xcf@\x9fo\xe4\x94\x12\xc2M\x9eΐ \\=y\x82\xb6mq\xf3\xfa\x95\xbe\x03\xd2\x06d\xb3\xdd\xe2ɳg`\x06^\xbd|a\x8c89y\xb6\x86\xf2\xfa\xc5\v\xf4}o?\xfe\xcf\x01\xfc\x8bO>\xfb|\xdfzY\xfe\x1f\x867\tl6\x1b\\^=ҖW\xf1\xe4b\x85\r.\x85\x13\n6V\xe9\xf1\xd3g\xd8\xdd\xddB\x8e#\x849̮i\xb0\xd9l\xb4\x80\x9bPl\xe8{\x8cR\xe2\xfa\xc5\v\xdc^_g\x0f݆I\x82\bۋ\v\\=}\xea\x94b{q\xa1\xbd\x84\xf1\x18\x93\xa1aX\xd3H\xbe\xd0K鄡m\xdb\xe2\x83n\x9a\x06\x17\x17\x17\x00\x11\xb6\x97\x97\x90C\x0f9\x8c\xb8x|\x85\xa6iA\x00\xc6Qb\u007fw\x83\xbe\x1f\xb4\xa0\v\xa1O\xc4X\xb2\xcdf\x83\xed\xe5%ڮ\x03\t\xa13O㍈\b\f@\x8d#\xa4\x94\x90Rb\x18G\xb0R`V\x18\x87\x01\xfb\xdb;(\xdf\xeb\x1a\x19f\xa9\xf0\xf8\xddw\xf1\xf4\xddw\xdc}\x03@\xdbuh\xbb\x0eB\b\x88\xa6\x81R\n\xfd~\xe7DJ{X-\x14O\x9e=\xc3\xd3w\xde\xc18\fx\xf5\xf2%n_\xbf\xc68\x8c\xfa\x1a\x89@\xe6\xf7\x04\xe9\u007f^=~\x8c_\xfc\xed\xdfb\x18\x86\xa2\x01k\x9a\x06W\x8f\x1f\xe3\xe9{\xef\xe2ɳg\xd8^\\@4\x8d;\x13+\xb8J)\xa8q\xc4\xdd\xdd\x0e7\xaf^bw\xb7\x83\x10\x84\xc7\xcf\xde\xc1\xd3w\x9eA\x88\xc6{\x8e\xe5W۶\xe86\x1b(f\xdc\xdd\xdc\xe2\x97?\xfb\x19\x94\x94x\xfa\xce;x\xff\x83\x1fB\xb4\xfay)\xa5ϕ\x01\xdc\xddܠ\xdf\xed0J\t\x02\xe1\xee\xe6\x06\xbc\xdf[e\xfd\x03\x93\x93\xef\xdb\\\fI\x82\xb0}t\xa9\xb5]*\xe7\x8a9\x13^\x90\x10h\x04д\r\xba\xed3(\xa9°\xca\x1c\xca0\x8e\x18\xfb\x1e\xa2i\xb0Q\n\x8f\xae\xae\xd0\xefvx\xf9\xfc9n\xaf\xaf!\xa5\x84\x10\x02\x97WWZ\x01\xb6[l\xba\x0e\xdb\xcbK\\<\xba\x04\x83 L(\xd54Mx\r\xf6&\x12^A[2\xe1\x04\xb3&\xdc\xeb\xb6[\xb4\x9b\x8d\v߬\xa5S\x00\xc8(דw\x9e\xe9\aið\xc8\xcd'\x95\xd0\x0fi\xda\xd6x\xe0)\x8f\xeb\xfb\x1ec;`\xb3\xd9\xe2\xf6\xe6\x06co\xf2aA \x06\xda\xcd\x06O\xdf}W\x9f)3\x04\xa5B\xa8\xf4\xfdI9?\x97GWW\xb8\xbc\xba\xd2\x16߆\xab\xc6(\xd9\xcb\x14B\xe0\xe9\xbb\xef\xe0\x8b_\xfc=\xbe\xfe\xe2\vH\xcf\xf2\xebܴÏ\u007f\xe7\xa7x\xef\x83\x0ft\xc4a\x14!\x17\x1a\v!@]\x87Ǜ\x0eO\x9e=\xcd\xe4\x90T\x05R\x90\xf1\x02-\x116\x9b\r\x9e\xbd\xfb\xc7\xc1\xbb\x99\xc2T\x81\x88\xf0\xee\xfb\xefk\xc51\xff}\xf7g\u007f\x86\xdb\xebk\xdf\x14Q\x9c\x83\b+\xf6\n\x8cM\xa3-\x11\x9b8\xbc\xa6\x18\x11\x14\b\x1a\x11&\xba\xe6\x8a/@\x90J\xa2\xdf\xed\xd0\xef\xf6\x18\xc7\x11\xdb\xcbK\xfc\xf0\xb7~\v\xbb\xdb[\xdc^_c{q\xe1\xbc\xc4f\xbbţ\xc7W\xd8l\xb6\xce\x03\xd9P\xeb\x90̐\v!B>l\x88\xf2\x9e\xe8Aq\xa4\x18\xfeY9!\n\xe0\x93\xd02\x92\x8e!@ 4\xed\x16\x17W\x8f\x9c\xc5{\x8f\x19\xe3(\xc1^\xc8%\x04\x81\x9a\xc6)&\x1f\x81\xc0\xc5\xe7aCR(5\xc3{E\xd3\xe0w\xfe\xb3\xdfǇ?\xf9m\xfc\xeco\xfe\x06\xaf_\xbc\x04\x01\xb8x\xf4\b?\xf9\xdd\xdf\xc5\xd3w\xdfq\x8a\x99T\x0e\xebݣ\x1f\xf2Zp\xc1ܳ\xbd\xdfq\x1cA}\x8f\xedvB҅\x10\xd3\xf3\xf1\xc27\xf6\xf2I9\f\x81w\xf7^\u007f\x0f\xe0.V\x90\xe9w\x14\xa3i\x1b\x13V\x85q\xb9\xf5z$\b \x91\xcf39\xfd3\x06C\x90\xc0f{\x81\xae\xdb`\x94\x12\xc3~\x87~\xdf\xe3\xf2\xd1#\\=y\x025\x8e`\x83.]^^\x02B\xb8\xef?\n2\xb1\a\xc6k\x1f\x88\xbe\xee@\x00\v\xc8\x16\xe50&J\bHB`\xe5\x18\x96<\x9aF\x00\x8d\x98+it\xf8\xf1}\x9d\x03=\x1c\x8dP\xfd\xfe?\xf9'\xd8\xdd\xdcb\x94#\xba\xae\xc3\xe6\xf2r\xe6\xb5V\x9f\xf3\x01\xf0\x94\v۔2\xa1a3\xbbo\xd2n\x15J\b@\xa9\xc0\xdb+\xa5t\b\x18>\xb5\u007fc\x10\xad@A\x82om\xdb\xced@:\xe6\xf5a[\xad~p\xa8\x910\xc9\xfb\xaa\x12\xb4\x10P`\xb4h\xd0^]\xe9\x90\xccX\xb3\xb6\xeb\xc0\x00\xdaM\a\b\xcf\x13\x1d\x8a\x82E\bT\xce\xfd\x1f\\e\xadD_O\x01\xc4>\x84:\x8d=\xbb\x8b\xabG \x03\x0e\xf8H\xdf=^\b@\x84\xb6\xebpqyi\x04=+p \xa5\x00!\xa0\xa4\fr\xd3\xc4kk\v\xc2mV+\x8dPJ\xa9 \xe5\x98\xfc0k\xd5u\xfe!\xdc{\x88\xea\xc4G\x88\x06\x8a%\xe48BY\x18\xd4\b\xafM\xbcI\xe8\xdcAIYW_\xf0r\r\x10\xe9P\xc1\xc0ρr\x9a8}\x8d\xc0\xb90\x89j4\xc7\xc6e\xa6\xf8\xeb\xc1\xae\x87\x84zk\x05\xd87\x02\xe7R*\x1b\xeaZ\xc1\xbc\xef\xba\x17\x99(#\x87F\xa6\xc0\x1e%e\x80\xb6\xb1\rSU\xf0,\xfe\b\xba\xfb\xe1\xba-\x99=f*\n\xe6tP\nR2HJ\xeb\xf3@\x10\x00\xb1\x8e\xaf\x19\xa0F$oB4\r\x86q\xb0о\t)4\x82$\x9a\x16\x8dh\xdc\xcd\xc5\x19&{\xffK\x80\ak\x1a\x8f\xd1\b\x80\xa1\x15PI\x03\xef\t\x88F+\xf40\f\x18\xf6\x03\x14\xabU\x16\xcb\"B\xb6\x00\x19j\rO9\x05\t\xb4\x9dE\xbc\xb4\x11`\x0f\xfe<W(\xe2\u007f\xb6\x05'\xfc\\\xe5\xa4\xdf\xe7\u05c8\x88\xd2\xc5E\x0f\xc1\x8as\x91C\xafł\vm\xd7U+\xe4h\xd0S\xdfH5B@\xb5\rvw\xb7\xfe\xaf~\b\xe0\"\xa3 \x1c\xa4\x94\xbc\b\xb51\xc0\x04&\x0e\xde\xca$A\xe6\xe7\xc4\x00K\r\x01\xa6\xdcp\xd7m\xb0\xa3['蛋-D\xdb\x04\x10&\x11\xa1i\xdb >\x9f\x95\xeeܳ\xe2\tSg\x1d\xc37\x8d0\x97F\xa6\xfe\xc9\xe8\xba\x0em\xb7\x01+\xa5!?%=˟~pmס\xdbn\xd0\xd8$\x902\x19G\x94o\x88\xb6uB\xaa\x94\x02K\t\xc5\nrTPJ\x1e (\x94\x8dh\x1cR\xd44\xe8\xdaօ\xc1\x0e\xdc0^u\x98p\xff\xb3\xd5\xf6\xed\x19\t/\x11\x16\xde\xcf\xed\xf9\xd9\xfc\x81\r\xe4\xed\x92}\x9b\x94{\x1e\x97\x88pyu\x15tr,\xbd\xe48\xa2\xdf\xed\xc2\xcf1\x86\xe3\xc5\xf3\xef0\f}\x88\x9b$P,\x0f\xae\xb2ٿ\xe7\xaa)\x87eQڪ芢\xc9L\xf5Ϥ\x94\xaeP\x14{\x91\x8b\xcbK\xec\xee\xeet\x95\xbe\xeb4J\x93\xc8[\x10)IΨ\tA\xd35XU\"\xcc\xd1\x1a0H\b\xb4\xdb-ƻ\xbbŃ\xde^l˱\xee\x94\xd5\xcf\xdc;\xbc\xa2 \xac\xe5#rE̱\xef!=h\xfc\x90\xa4[\b\x81\xce\x14R\xadP\xa6\x9eZc\xdai\xf6\xfb\xfd\"pp\x84+\x03\x01\xae^\x15\x03\f\x9c*\x12w\x1d\b\xe4\xa0o2\x9e\xf8\xf6\xe6\xda\xd5`6ۭ댨\t\x8b\xd58bo\x94#\b\x05M\xf5\xfd\xe6\xd5+c8i\x061\xcd\xea !Z\xa3C\x12\x96\xd2\xc3\xca\xecUG\xc1?\xe7\xf4\xc4\x13P\x83\xd0̔\x84\x19\xddv\x83a\x18\xb0\xbd\xb8\b<G\xfc\xf0\x85 S\x94T\x19\xab\x1a\xc6\xfa%\xf7\xc7^\x88(j\xab\xf9\x15i6y\xf5\x83\\k\x8a/\xb4\xa2i\xb01\x95v\xfb\xf0\x94RP֚\x1a\x8b\xea`\xca\b괅M]4ݸ/\xf6\xafAD\xc0Dc\xeaHm\xd7a\x1c\x06W\xf9\x1f\xfb\xfed\x1d]\f\xa0\xb5\x1d\x02\xf6yٳ\x89\x855hq\xe1\xf0\x1e\x98\xd1m\xb6\x18\x86\x11\x00\aE\xde%\xa3\xd1\xefv\x0eD\x88Ŕ\x99A\xcc\x18\x871\b\a\xfd\xc76\xf3 \xc4a\xcb@cnN)e~\xc1\xc4\xd9\xec)I\x14\x851ł\x11\x86lR\x8eh\x9a6\x12*\xa1[E\xba\xb4eh\x1a\x13GS>\xb4\b\x15#_\x9b\x9b{:\xad$\xce\xf2\x17\x0f\x9dʟ\\P\x8e\x00I\xcb\xfc\x86\xbd\xfe\xa6i\xd06\x8d\xf3<\x8aY\x87\x1f\xd6\xc3x\xd6X\xb4\xa6f\xe5\xf7\xbbT\x82J\x8diIq\x02ն\xd8\xdf\xdd\x1d\x95\x1bXcf\xfb\xe0\x02\x83\x91A\xbbJ\x02/\x95\xc4~wg\xd2?1+\x8a\xce\xeam\xe6\xf3\xf6\xbb\x9d\xae\x91\x94\xa0g\x03\xf5F(\x9c\xb0\x91`\u0083\xa4\xe2\xee\x16\xe3\xa0[\"|-'?tq\xb2\x15u\u0378(\xcbS\x12\xc5P\x14\xde\x18\x91\x81u\x93\x9e\xc3+\xb6\x15[R\x96\xbd\xc5\xcc\xd23{0,\xb9\xbe\xb2\xe2\a\xe5କ]\xbfD!\x04=K\xa2}$\n\x00\xb5-D\x84PM\xc5S\x95Tb\xfb܋\x02\xcfSt\xddm6\x10M\xa3s\xb2aH\x87A\x89\x10ن<\x16A\x14H\x1b\x9a\xe43\xcc\x00\x16B\b\f}\x1f\x84G`\xc68\x8eؘ\xael\x8aB5\xa5\x18J\xean\x04\a0Q9\xafLD#[\x93\xa8\u007f\x95,\x14R\xa2\x93\xd9v\xbb\xe6ϗ\r\x84&4b5K\x9e\xa3v\b\xd3RRUU\xa8\xa8[\xd80#\v\xcff,\x97+\x1c2\a=\x88K5\x955aU\xd2\xca\x12\xcd\xd0\x1fZ\xb0ʜ@~\x16k+\xb4\xbe;i\x1a\\^]M=sFQ\xf2yمk\x84\x8c\xbd\xe8dy98\xa3\x9aΌ~\xbfG\xbf\xdfϾۂ\v\xd6öm\xa7\xf38)u\xb8\x98@;S\x86Ն\x9e\xae\xab9|\xfd)\x80\u007f\x9b\xe9\xc5\x12Y\x178O<\xa6n\xd6q\x94`\x1e\xb4%!\x9d/\x105\x10\x8dH\xbaO_\xf3s\xb5\x13\x8a,:g\x04\xa23MzY#\xe9\xb7\x1dX\xe8\xd1{\xa0\x1aii\xa0\xd4X\x14l1ej&\xd4\xe4 \x01/)<i\xb8f\x02\x1cNQ\f\x8c\xef˃\xf3\xb8\xce\xfc̍\x85\xf9ܦmѴ-n\xae\xaf\xc1\x19`\xc4v!\xdb\xd6\x18\xa4*\xfd\x91B\xbb\x1a\x84\xa7<\xb1\xe1\xe9\xf7=\x86~\x9f\xbd֡\xef\xa1\xda\x16\x9b\xcd\x06\xfb\xbd\xce3ئ\x01\xb1\x02\xfa\xf2\x13ɒ\x88\xfa\xf9\xbc׳8\xc4\x12Q\x15$LMS.\xdc&\xe1^\xe8\xae\u007f\x8d\xa1X\x82\x15\x01\x90\xc0\xc0 \xa1ݰ\x1f\xefJ9B\bS\x89OL\xbd\xea\xf821=\xc7\xf3Ъi\x9bb8@^\xcfO,\b\x82\x19\xcaTd\xc7\x1cBF\xd0]\xbcDZ9xJ'\xa7+\xa7\xb2\xf4Ѳr\x9c\f`\xe5\x19\x02\xbe\xae\xd9\xc1\b\xad\xf5\xae9\xe5\xbc|\xf4\xc8\x01\x1c\x8d\x892|\xc1\xb4ޒ\xa3\xcf&OI\xfc܄\x99\xb1\xdf\xef!\xfbޝW\xca\x03\xdafʾ蓼߂A\xe0TԱ`\x8cZ\x00\xf8\xf4\xe3\x8f6\x00\xfe\xcb\x00\xc6\xf7<&+NwXR\xea\x8b'h\x97i\x9a/`\x83\xbb+\xa5\x02\x88\x8eY\xb7\xc97M\xebB5\xdd\xf9\xab\x95R\x88v\xb2\x8e\xb9\xdaD\xdb柳b\x874\xfbݝA\x8cl\xff;\x80\x86\xa7\x1b\xb3H\xd1\xf6\xf2\"(N\x86\xc2G3\xb4\xcb\xe5h^n$NYe\x8e\x12[\xf65\x811\x1f2\xaa\xf9\xee\xe0\xc1\x97\x8by]\xd7\xe9\xf9\x16O\xd0m\xbd\xc5\xcfk\x902\u007f̳\xcep5z\xb9C\"\x9fc\xef\f\x85\x10\xae5\xa9\x14\x17S*n\xb6\x13\xa6\xae\xa1\x91\xb2h\x8c\x95\xac\x0e\xc0{\xa1\xa6QP\x1a\x99\xee\xc6G\xa4\x96\x10\x1e\xf8\xa5\x15\x10H\x0fYy\xca\xe6Z\xe9\x19\x1eb\xa3\xbc\x03\xe4\xa0k\x95\x12\xcf\xd9V\xdb\xf3Y5\xf9\x05\x92\xc9Uz^\x04\x00\xc6a\xb4\xa30\xb3\x00_\x98\n|6?@l\xc1B\xad\xa4lQ\xb1V\x178\x9f\x0f1gj0t\x12%\xcc\xe69\xa9v\x19\xca\a\x9a\x81\x92D\x8a\xa8\x9bV\xf7\xe5|ʴ\xf6\xbb:\x94U\x8e\xc8=\x92\xe9B\xa7ȑ\xc6\xddخNT褰O\xfc\x06\x9a9\xc2\x14\xceB\xad\v\x92\x1eNg\x02\x1c$\xb9\xecZ-DӸ\xd6w\x12\x1a\xa2\x1d\xe5\x18\xb4w\xb0Q\n\xf6,\x82R\nr\x1c\x8c'\x89\xe2\xda\x00\xfd\xa2\xac\x1c\xb8\xa96\xfb{f\xca0\u0085\xa7\xdfw\xd7\x14zI\x05=\x83Q\x1f\xe0L\x9e\x83rm\x18\xc7*G\xce\xea\xc7\xf7E\xb6(Lu\xf5\xc0Dq\xb2T\x18\xf5\x9f\xd9\xec\x1a\x12\xe9/E\xefc\xc5\x18\x87\x01þ\x9f\xe7\v\x98\n\xba̬s\x1d\xd3O\x15#O\x04\xedU6ۭ\xae\xa5\xb5:/\xb2\r\x1e\xa9\xae\aۂ\xa3䂂\x98\xa9\xc2\xef\xfc\x9a\x84*\xb5\x98pl\x1b\xd858\xeaQ\xc6\x06M#\\\xfe0;\"f\xa8Qb\x1c\a\xd7f1)\x86\xae*\xcbQ\xa3\x11\xec\xe3\xfe6\xd6\xf5\x05O\xe4-\xb3\xfdܤ\vM\"Z\x94\xa8Q\xe8d\xbe\xed\xea\xda\x1a(\xaa\xda\xebĞN_\xadf.\xff\xcc>?\xe7v\x11z\xc7J(\xdc\xe6\x169\xe0c\xf1\x1a\x8co\xe5\xa8H\n\xffyK\xe9ǳ\xe1\xfb\xcd\xe75qC$\xa6>3\xd16h:M&b'*7\xdb-6\x17\x17\xd8F͌6\xff\xb3p\xb4\x94z\xc4\x19iD:\xd1jb\x1e(K\tѴP\xf0\xe3<\v\xd5q\x00\x9d\xe9\xd9\x10\x82P:\xdc\xe1\b\x98`_\xe48\fy\xe48jŒ\x00Z\x9e,\x86\xb9\x16i\xba/\xd9O\uef19\xe3Y\xec\xef#c \x88\xb6q\x82B\xb5qx\xa4$\x9c\x84\xa5\x91\x98; wF4\x1b\x16\xa3\xd3y\x0f\x0e\xc9$\xac\x01\x99ͫ\xf8ޕ'T\x90\xd7(\x1d\x90l\x0fJ\x97L\xc9\xe1{9\x05\xf2\x95\xc4\xcf]b/\xe8\xe74\xf6\xdcm5^\b1\x8dY\xdb\xf7Y\x0e\x02s\x0edz\xbbt\x9d\xc64\xad\xca\xd1up[\x05QJ\x9a\x90\xbe\x9c\x83\x84\xb7Ib\x9a\xbcR\n\xfe0?\xbb\x18\x97]\v7\xfcd4\nX\xb9\x94\xcc&\xda\xe7c\xa1\x90R\xa2i\x1a(\x836\xc1\xf3\x1cP\xe5\xe2\xa0\xf0\t\x15*\xfb\x98r\x89^\xbf\xdb\x17\x04eʊO\xa9\x1c\xb5aU\\W\x99\xe5\t\x0ex!+\x99\xe9y\xb6\f\n\xe8F\x95\x99\xb3\x1e\x87ʪ\x97\x0e\xcbL\u009d\xfal\xe1\t\xb85\xa9\xc2t\x17\xb4]\x17\xdek\xa1|\xe0\x83&읕\xef\x91\x1c\x11GB\x97ERm\xfc\x9e-\x0e\x1b\xef\\wF\xae\xad#I\x1b39\xd9Y\xf6\x12Ay\xa9\xc3\x1c\xc6\x11`\xf8\xd4,\x93\xab$QWԫ\bq\xda.\x8f\x86)\xbf\x0f*\xf7 \x8eLė\xbc\ay\xa1\x05yh\x8c?3\x9fj9w?\xf7\x14JDI\xf2Ҍ~\xdbuY\x0f\x92U\x8e\x19+\v\a\x15q\x1f\x85t\x15xۢ\xe2\x85ulB\xac\xae۠\xe9کє\xb9J\t\xe3\x02\xad\xef\x95¾R\x9e\xa5\x1f\xa2\x14\x8fr\xe2߭\xc7 \x12\x9e\xf2\xacc&,\xab\xc4\xfcw\x95\x94\xe8\x87\xde%\xef\x15\xc0\xd9A/K\x01\xb3X\xbc\x9bY뉈\xe0d\xde\"\x85\xeb\xfb\x9e\"Ů\xe8:\x02*\xc3H;'2\x9b\x13\x9f\xff,u.\xbc\x10\xb4e9\xc4f\t6\xd0\x18&\x16\xe1\x01)\xb6ҽ\xe9:4f\xce\xc7\a\x9c\xb8\xc2\xf0\x05\xed\xed\xbeqH^Y\xf0\xf3o3!\xd6\x1c\x96s\xb1.\xc2y\n\x8a\xac\xa6\xfe5K]\x8b \x14\x83\xeb\v\xe4U\xc2\xe1`}\xa9\xb0W{l\"\x96\x91\x920\xd0\xca0\x87\x01l\xb6\x1b\xdc%\x8a\x85I\xc5\t\xba\x9eiY\xb1V\x86PT\xf1;\xb9\xe48\tͺ\xbcm~ފ\x01\x96\xa3\xe6\xdc2\xb4F\xfeg6\x1e\xcfX\f\xce\xf0\n%\xb7y\x87\xdfw\xe6*\xf0\x9e\x9c9\x8f\xe2<\t\xcf\xeb\x06\x9e|\xba\x9c\x91\x12\x18\xbd\xf1@\xcaȡ\x10~\xcb\v\xa7\xa6\x13`Q]\xff\xa9\x0fi8.\xf5P\xb8@\xaf\x93~МBci\x19]\x8a;1G3SbaeZ\xe8\xe1Z\x15\xd3\xe7\xe2m\xd2tF\xf1g:\xf5\x10\xf4\xe6y7\x13HYNx9\xc8儆9Y\xcfk\x8b@1\xbd\xc1/\v\xaf\a\xc4\x19\"\xeb=\xb8\"t\xe4(\xb4\xf3C 2\u07b8\xc4(\x9e\xfcV\x9ed4\xf6\x96\r\x89y3jz\xf4y\x00\xf0E\xac ?\x9bWv\b\xcc*@\x060\xd3\xd49\xf93\x99\xf7\xc5\x13JLKÉa\x056\xd52\x1f\xb8\xdfږ\x8d\x85\\\x84\xbc\x90)\x85\x1a\x89\xa6ѹN\xcaX\x9c8\xef8\xb9\xde\xc4\xc9t\xdcTI\x84\xa6k\xc0J\x14\xdbQ(\xa2\xd0YV\x83\xb4b\xd8\\.V\f\x9fO`\xf6\xacR\xb9\fB\xfa\xa5\xe0\x1es\xef#\xaf.\x12\xe4\x12\xb3|f\x84a\xb9\xf7\x15\xe4\xaf\x00\xec\xc1\x1eM\u007fF\x98r\x93nl2xg\xcc\\\u007f\r\xc2\xf6\xad%,>[7\b[+\xec\fD\xb5\xfd.\r\x92\x14^M&\xb7\xf0\xc9-\x1e\x866\xd4\xd5[\xe6\nMA\xb8D\xa5\x9cE\xad\x98ߏ\x94ße\xf1\xdb}\xe8X\x80#R\b\x8eK\n\x85\x8fU\xact)#\x93\x82\xfbO\xd7젠\x99\xd0\xd8ĸt\x036\xe4\x89cs\xff\xc4\tQ\x9b\xd0*\xe5\xf0\xd8\x11\xfd\x99\xe2\x92\x10\xa4\xa0O\xbf\xcd\x1c\x11\xf9\xb5\x10\x8e\x8cۗ\x1da\x87\xbb\xa2\xfb\xb0\xfc\xbf5\xf9\xd0\xd9^~\x15\xdb\xf3\xbeqq\x8e\xbc?i\xe4\x92\x16\xf3\x9eY\x1d\bs\x82\x88\xdc5\xaa\x88\xed߲\xe0P\xc5\xf7\xe6>s&G\x9c\xc1M\v\x88\x97R\nP\xb3\xbf\x130{L\x04\n\xc8~\xf8ŜE\x8d\xfc\x98\x92(\x82^i\xae\x96\xb4\x00g\xfa\xff\xad\xa6\nC\xb23\xf4`\xefac\xeaX\xb0i~\x12\xd9\x04<\xf1`\xaa\x04\xe6\xd4^\xa3F\x9e\x12\x86\xa9\xd8ߕ3:\xf1\xbd\xa6Zi\xcc(\xab\x8d\xef\x9dr0\xd2u\xa2\x8c\x80\x17\xaf3\x83\xb8\"\xc7蘹\x1f\a߇ﻀ\xe9M\xf4\xaf\xf4)\x806\xe5\x05\x02$7s\xa6\xb6.\xc2̮\xb2^\xba:\xca%o\x1c\xad]p\xd6ˋ\xf7}\x8bM\x11\xe6\xcdX,\fҒ\xf0F\x8do\xc2K\xfa\xa8\x94\xdbD\xfc\xaf\x0f\xfd5\xeb\x02\xae\x80\x85g\xdc\xcc\xde \x94o(\xa5\xe1\rfo\xb5\x80\xc8P?\x05\n̜\x86\x883ptRQ\x12\x1ecV\xb97\xf7s\xf3\xfauN\x01\xff$V\x90?\x82]\x85\x15\xa1e\\\xd4\xc2\xf4\xa1S\xa1\x80W\xd2\xf6dr\x18)E\xb1\xf7\n<\xad$H)F\n\x89\x8aB1\xff\xdas\x93\x82\xa2\xc2\n\xbe-\xaf\xb0k\xba\x10bEu\x98\xdc,8\xfb\xab\x1fLkGm\xae\xc69\xcf\x10<\xe3\xf2y\xd7\xca\x171\xe3\xfa\xe5+\r(\xcd\u007f\xf7Ǳ\x82\xfcV\x00\xcbڼ\x03d\x88ᖹ\x9b&\v\x92\xa8\xa3q\xf9&\xfc\xd9p\xdb\x16!\xe2\x10\xc8\xee\xb1\xf0\v?>fn\xa8\xfa\xab\ve\x99\xc4;\xd0|B\xba5#\aQ\xbf\r+܊y\x8c*\x1b\xab(\a\v\xaa\xda\x16\xa5ʀ99\x81\xe5\x15\x10q\xf2w\x12\xddĜ\xfb;\xbf\x8dIIM{T\b\xd3}\x14\xeb]$\x92h6\xb3\xe6S\x98A\x15\xdecEn\x10ܴV\x12\xe1\xa3\x11ޗ\xf8\x94\xa2\x14\xb5\xb4\x1c\x04\xf9\xe6\x80\x00\"\xbd\xe2\xc0o\u007f\x8d\x18\x04\x839\xf2\xb7Q)ⰶ\x02\x01K\x02\x1fqMß\"\xe4\x85B\xa2\xbf\xd6-2P̅A/\xfb\x9e\xd4,\xbf\xf7\x9c\xb8\x90+\xc2\xf4\xd7\xc9q(*\xa4\x00\x80O?\xfe\xe8\t\x80\xffZ\xc3^a\xa5Ւ\xb4\x051\xe0\x12N\xcb\x19ב\xf1$\xc1C\xf2n NxݾA\xef\x00j\xda\r\xc2\xd9\b\xef\x16\xa25\nn\x1bT\xd7M\xeb\xcbl\xb1\xca\v\xf5\xdef\x85\x98!A\xde\xfe\x96%OK^X\x15\xe7q\x8eR\xd5\x16Z-\x1frj\xa8+\x9aW\xf1=S\xf2Zr\xd7\xe6\r\xd2%=J\x01\xa0 @\xe7\x1f\x8a\xdd\x00iVA\xa0w}\xff\x10\x98\n\x83\xf65\x0eC\xe4.Õ\b\xb3\x0e\\\x8a\x86\x98\xb8\xec\x1fc\xc8\x14\x19dhf\xb1#\xf4d5\x8fS&\xb7 @S\x8b6\xad\xebU\xb2\x8c'8\xc1\xf0\xd3\xfd\xe4\x13u\xca\x11\xf4\xb5\xd5(~\x94\aZOo\a\xea\xec3\xf4iCS\v\x97\xe80]\xaeF\xeaj_\xfb\xdd\x1eKT\x80mp\xeddf\xb8\x95\x02\xcc\x00~@\xf5\xc3\xe9\xfdR\x81p\xf2T0\xf4Lu\x1a\xf3\x15\x14&\u007f\v\xee\xbdi\xdbp*0\n\xab\x96*\xc1\xb9\xdcC\xf9\xc9\x18\x11\x1a\xd2\xf3\x06J\xca\xe9\xcaE3+h=\x98\xf0ʿ7^n:\xe7R\xf7\xee\x12\a\x96a\xc6'\xf2gr\"\xafT\xb2\xc6A\x0e\xe9\x8du'<\x8d?^\xaf\x89C2\xb9c\xa1\xe2^\xba/9\x8e\xa5\xad\r\x8f\xe3\x1c\xc4e\xd8\xf6\xa6-ecx\xa1(\uf39b\xf3;f\xaf\x80\x95\xd2=\xff\xa9\x16m\uf074\x86\xfd\xcf\xef\xf4\x9c\xcd\x00\x00\xd9J\xf0\x92\xc5'\xc7\xd8H\x8e\x9ag\xb3\xddb\xe8{\xbd\xf0\xa7i\f\x13$?\xfc\xf0\xca\xdeCBP\xb9\x82\xe4\xa0\x16ؘu7[\xca\xd4\x1cj\x1ey\xfdX\x91S\x01\a\x15\x14\x9c\nӌ\x9c\xebt\xa6U;\x8a\u007f/V\x90\xe0-\f=\xacd'\xd6\xd8c\xae\xb3\xbf0W\xd8B\xacH\x89=\xee\xd6M\xfb\xd6Ïw\rc\x9f\xa8\x1c@\xe2\\\x9fU\x0e\xb1\xf2\xbe\xd7%\xffvΤ\x11\xd8\\l\xa3\xf7R\x1a\xe9z\xc0\x8ab\vuG\u007f\x9cs\xfa\x1a\xc0\xf0\xd9\xda\xed9\xa8D\xadB\xc4\xe1[\xc0\x91e\xd6Yc\x02\tb\xd6Ȝ\x91c\xdf\vQ\"\xb7\x8a\f\xad\xff\U000f89d0\xd3\xda\xf8ǟ~\xfc\xd1F\xa4\"9eC%\xcf\r\xdbU\x02ž?.\x84:\xc1숝&k\xb2=N\xd6jSar\xac\x16\xa5I\xad\xe4\xf2\x93B\xdb9\x9a\x1a\xa9\xa5\xb7<!_\"]X\x1dƙ\xb9\x8d\xc6ۑ8#\xa26^YDIx\xfa\x19r\xf0,B\x05[ޡ\xc2({\xc5܀\x9e~\xee\x9a\xf2'\xf3\x847\x00\xdc\b]c\xfe\x985\xc3\x12\xe8\xc28U\xa9\x98Z\x86\xfc\x82\xf7\xf4ݖP\x8d\n\xb1\xa1Q\x00\xbf/?\xf8\xbb\xb6q\xbb\x1ff\xbd:\x87(\x89\xb7Ij6\x01h\xfeY\v\x13\xd3[\xac$\xc7z\x12K\xef\xda\xf8{\x00}\x98\xd6\xef\xb2\xf5\xcf3\xf6\x1eDI\x88\x97\x99\x8b\x81O\x89\xe0:k\x94\xed\xbfҴa\xc0^O\u05f6\xa5\a\x1aPe\u007f`4fZ\x91\x1co?\xf5P\x0f]\xcdT\xc89\t\xdb\xc9\xcbD\xc9\xc1}j\x1a\xb3y\x8ag\xd6\\\x18\xae\xd5U\xf0E\t\xf2\x98\x11>\xa4\x13ϓ\xa3D\x0fPIN\xe1\rg\x85Xo\xd5]<\n\x1c[\xf1\xf4<P\x86\x9e\xb4p\xdaYoRZk\x97\xf8\xf9\xc5\xd5\xe3\xd9f\x82\x1c\xcc\xfb/\x02T\xc7\xf6\xec[\xc12\xf7\"\xa54\xbb\xdcȱ-r\xb0\xfdg\xd2pf\xcc\xd6\x14\xbb\a\x05\x02\x93\xd6\xea\xc0\xf2\x98y\xe4\xd4\xdc\xf5*\xeb\x9dbY\x8fk*\xf6\xcfCjW?\xf3ˆZAM\xe7T\xf0\xb2m?I\xb5\x03\xa5<C\x10\x16e\xc2$\x8e:,*\x95!\xfbw\x91\xc1\xbf|t9\xf5\x97q2\xc4r9ȿ\f\x14\x84\xd3\xcd]\xb6m\xb9\x88benևK\x93\x87\v8\xd7}lށ̰~`\xf1\x8e!r\xfb\x1e(\x89\x8b!֜C&\xd6\x0f\x1a\f=\x0f\x13\xb4\xa5\xe4\n\x85Z\x00\xe6\xe7k\xfa\xea\b\xe5\xa6\xd7c\xf6-6\x8e\x1c\")\xcf\xef\x00x_|\xfa\xf1G\x8f\x00\xfcWu\"`w\xecI\xa8q\xcen\xa7k(\t>W[l\x13B#\x17\x1e\xf0@\xa65\x9a<\x05\t,\u007fmU5\x81\xe0\xcc>+Q\xa9\xaf'\x99\xf8~\xbcR\x9e\xa3\xe6\f\x96\xf2\x83\xc0\xeb{aU\x89\\n\xfa^\x15\xf6J\x05\xb3-\xbcH'tP\xc8\x05\xbd>\xa1\xddt\xb9\x9bz\x04\xe0\x1d\x01ݵ\xf8\xe3\x92%\x0e\x809\xa5\xd7\x1a+VP\xe3\xa0{Y\x94\xd4\x15x{,\x8a\x1d\x8d'\xf34\x03-\xe2\x9c$\xa0\xf1if\xfdU\xf1ë\xb1\x15T!\x10\xa9֓s(\x89\x9d\xa1\x97R=<%\xa9\xf8Y\x12:-(G\xe9}\x9c\xfd<CT^c\x9a\xf90O\xc2њ\v?ry\xfc\xe4iQ\x01[\x00\xff)\xcc.\x84Y\xfc\x97x\x83RJ\x8f\xa0\x1a KS\x83\x1a\xef`7\xa9\xb2\x9cUj\x85\x103k\xe2[\xf6\xa6\x11!\xaa\x94pŁW)<\xe4xf#F\xb0N\x91\xac\u058c\x0e\x0f\xa3D?\x8c \x10\xb6\x9b\x16m\xdb<\x1c\x05\xa9XJ\xe4\xee\xc5t\x14\x94f9\xa8\x90\v\xfa\xab\rbt\x913\x1d\xb8A7\x86\x13h\xbb|3=\xe0V\xaa\x9b$\xb9\x0e\x94\xc2\xc5\xe5e9$\x05\xf0\xc7\xe9 \xdbo\x11N\xd7<l\x02Ŏlz\xd4+\xb0\x94\xcdU&\xd8V\x90Ȏ}\n!\f\xb2\x15\"\aE\v_Ѡ\x98j}?D9\xd6\xf6\xf8\xd8w)\xa9\xd0\x0f\x12C\"\x1c}\xe3Jb\xe7\xec+鐪\xe9\x95R9K\xc6p1\xd2Ӥ\xcag\xf6\xcfx\x93\xb5a\x17GM\xae\xf6%-\xa5,\xe7=\xc8\x1f\x96\xdc\"1\xcf\xc0:ݣd\xf9\x84B\xe7\xc8,\xa1\xa4\x9e\x1f\x11$\xf5\x12Ǹ\xda9-zЅ\xbc\xa6\t\x8aJ\xf1R\x15\xcaY\xaa`%\x02\x05\xc8W\x12\xe6,(\x1d\xadH\xc2k6\xac\x12\xf4R\x9f\x8d\xa1\xe1?I\xb1.\xf3\x9d|\xec\x90V\xa1՝\xbd\rZ\xab\f\x15ϩ\xa1\xec\xb3R\xf6ٙ導{\x97\xc3J\xb3U\x12\x8a\x93y\x9a\x14%\xe7Mf\xcf)A\xec\xc0\x00\x86\xfd\x0em\xdbe\x9fy\v[ \xf4\xd5`\xe6\xf6\xe6\x83P\xb6a\rv\xf6\x83\xa7=\vJ\x9aQK\x00]J;\xa3\x91\xd6F\x88|l\xbc\x84&\xb9\xb5\xd4!\xa3E2\x1c;Eh\x15A\x85%%i\x1b\x81fہ\t\x8b\x9b\x8c\x0eU\x8e\x13\xc6]\x87՜\x12\xef\xe3\xdcY{͍\xca\xc8\x10\x1bp\x87D\x84V\x95Z\u007f9R\x12\xd4y\xb7\xd8\xc0J\xa5\xa0\xa4\xca.\x8f\xb5\n\x12}`H\xd5X\"9֣\x8a\x14\xac6Sv\xe3*k\xb25!\x9a<\xc14{\xe4Ӷ\xcb3Qd\xca\xfe\xb7\xdfC\x15[\xb5T\xf7.\xadۂ\xbb趙\xcb^\x89\bԜ\x1f\x03\xa3\x15\xf9\xc4\xc1!kF\xf8\xb82\xec\n\xfa\xb6,\xfd\x8f\x93\x19\x05R\xe4\xa6W\x83Oa\xdfqp\xf0s_I\x82܄\xa2\xd0*\xa70J\x03Km\xb7\xc9\u07bf@\xb2B\xaff\xad\x04)\xe8VI\xe5\xdd\x10C\xb2\n\x88\xe6\xda\xcdfq5\xb3\xa3\u007fI\x1dl\xc1\xa3\x14\xe3\xe28\xb6>Ð\x93?\x12\xfcP\x91\xa9\x87\xfabDd\xe0<\xad\xf9\v\x87\x9b8c\xbd\xd2?w\xb9\x89_r\xe1<\xfa\xa5F\xa9\r8̂$\x9eQʩ6\x9dW\x19/\"\x04\xd8[\xca\x18\xb7\f(\xa5  \xc0\x90N\x8b\xad\xbe4m\x93]\xbc\xe2K;\xf9\x85\xa5\x15\x146Y(7Sd<Z|\x12\\\xc4o\xf5\xab\xc2c\x94\xbc\xd2\x1a\xef\x11\xfc\xbe\xc7\xc5\xebs\x1f\xe8\x90G\xea\x06\xd6\x10\xb2Lx\x92(Q)\x85a\xfe\x1e\x19\xffw\x89\xb0\xdf\xeftK\xa1 ם\xec}\xd4\x0e\xc0\xab6y#̑K\x8b\x84\xc4sQҋ\xc3\xd9m\x03\x12\xe8\xcc\xca2\xf6>3\x8d(\xd1j\xeb\x83\x05/\x93\x1c\x9c9EKI<\xf5\xf86\xeb\xc7)\xbc\xd5ʰ\x8c|\xef\x11A\xaf0\xb9\x88\"\xe5vA2\xa2Њ)\xda\xfb\xce\xd9\xe4]\x87G\x91\xe1\r\x89\x9e\xf5Fc\xb3\xda-\xb1\xe7\xf2;\x00ϓ\xec\xee\xd6\xdd\t\x12\x90P\xf3\xfd\t\xc1\xfa\xa8\xb8\xd7\nh\xb7[\x00\"\\ؘ\x8a\xcf\xc9#e[B\x97\n\x8a\x10ǘ\x14\xffi\xa2m\xe2\x00\x00 \x00IDATݑ\x16\u007f֝\xfa\x00\xc3)N\x18\xaa\xc5{\x8aV1\x9f;g\x81\xc9Qݎz\x93w:*Y\xb7v\xda3\xad\xb1B\xb8m@\xb1\xa2\xd0,\xb1\xafY}m\xbb\x9cE;{\xbe=\x80\xbdȹV\x8d,\xf8\xdbDu\xf8ĦK\xb7\xf4\x85:6\xe7\xa2r\xf8\xd0k\xaeȔ\xdb\u007f\x81\n\xa6\xbdR\xab\xfdj\xe5x@!\x15щ\xd6,,\xc0\xde\xe7\x89\xea\xa6\xfd%v{\x94SR\xab8\xa2\xf1r\xbb\x1cO\x17g\xf2\x12^\xe5*'\x0e\xdfl7\x00\x8b\\\n\xc4d\xdb\xc0\xd9\xe8\a\a\xc3)a#\xe3\xd4\u007f\xd5u\x1b\xd8\x1d\x82Ř\xd6\xeb\x85ʅMA\xc2\xe6\xf3\xce\x16V\x81!\x9e!9\xc2J\xd2\xf7\x89\xc1$\af`]\xd3\"\x95\xe6\xbe+\xc2:\xf6F\x1c\xd24NZZ\x1b籧${\xce^\x92ҕ\xf9/\x86\xb4X\x13\x10 \xc7\xd1QFe\x9a\x86)\x89b\xd9O\x13\x82Ll\xc6\x1e#(\aC\xff\xec\xfd\x8f\xdd%.\x96\xf6e\x94\x92\xf2\x05j\xca\xd2vWJ\r\xe6\x1c\xa8\x18oc\"\xbe\xf6\x9a9:'\xaa \xce8ʃ\u0604<\xa2\xfc\xf1\xd7H\xf8Kvf윁B\x84Z0\xff/\x9b\xf3xf6Bd\xd9p\x053\x91\x0e\xfd\xe6\xb2Ţ\x14c\xda\xdd\xd4\x16\xfa\x85\xa9q0\xabpu\xaf\xb9\xa6\xa6\xed\x1cd;Ѥp\xb2\xe5@\xa4\xf0\xe9\xb5̓\xd14[\x11\xbdZCs\xfa\x16+\xc0A\xf7\x90\xdao^\x9bǬTFa\xfa\xec\xe2\x9d \x14E\x00\xc1\xb6\x80D\xf8\x14(\x83g\xfe\xddoΧ\xb3f\x8d\xb2\xac\xa6\xcd\xc5J\xa5\xa7-\xc5\xd2a\v\xd1\x04\x87\xc6J\xafn\xe6\xe8\x03\x89\bݶ\x9b_|\xfc <\"6\x98\xe6Ƶ\"i\x9b\"\xfd\x87\xcb9\xf6\x14\xae߅\xf8\x0f\xaf\xf5\xe7@\x95\xbd\\q\xebI\xb2\xeb\xda'\xf2+\xf6\xe1š\x15%\xf5(\xfe{\x11\x85\uf4c8(7\xa6\u1f7e\x06p'\x96\x8eJ\x88i\xac}jJ\x94\xc1\xd52\x18M׆\xcb\x11\vȕ\x16\xf2#Z\x1bR\xdeθ\xf0\\\xd54\xb9\x00\xf4\x81&\xe2\xa7\bo\xe8\x80\xf5s՟\x97[S\x90K\x95-\x95\x94?\xa7\x93\x98\xd5q\xfdjnJ5\xc6e\xa2op\u0383\xc1ɍ(ӕQ\xf4#\xdb\xe7g{\xc1,A\xa2\xf7\xfa?>\xf9\xecs%\n\xf1\x8b\xfb\x8f\xf0}<\x1bQ\x14$\xd0xs\xe4\x94Y\x88B\x1e\xb5\x8b\xdd%}LH\x93\n\x03\xec<}N\bT\xf4\xf7o;\xb7n\xe9l\xb8\x96\x92u\xe1\fJm\xee\xf6\xbdE\xa0%AoJ\x89\xf7\xd9\xce\n\xfb3\x1d\xf6p\xc9/\x15~\u0089\xcb\tgAl\x9e\xa3\xa4J\x11\xe9}S\f\xb1\xc2\tU1\xcb#\xfck\x10m\x1b\xee\xef(\x1drD_\xb9Ƈ\xa4Жx\x9f\x84\xdfy\x1a\x8f\v[\x05U\x06d\xe0Ck\x00o\x81\a\xa9\t\x97(\x9e\xfa\xac\xf1(\x91\x90\xa7Z\x84\x82\xfcP\xa9\xa9\xd5'j\xcd\xc9\xf49\xe9\xb0\x1e\xfe\xc87\xcfШ\xe0\t\xa7\xdc\x16EW\x14ˍRf\x9d\x1cC\xca1\xd5>?\xdakL\xb1\xad\x05\x1d\x92\x96䜽\xf0\xc8\xe7\xa7n\x1aQח\xe4\xa3%\v\x8b5ׄ\x02\x1c\x15.g\x0e6Bެ\xa2\xf9K%\xbfW\xe1U\xa5g\xe4\n墅\xe7\x94d\xb1\x8a\x06\xb2\x8a\xfd\x12\x89Z\x18\xb9\xd1n߲\xd7y\x12N\xde\v\xcfz\xfc\xc6q\xf4VP#\x95\x83pƃ\xb0\xe3\xa4\xf2ݑ^\xab\x85\xe9\x9f\xe6\x13H\x18\xce\\\xbf\xe0\x93PwJ\xb0\x8a\xf8\x1e\x80\x8f\xb4\x861\xe7\x92/$\xc1^\v\xef{\x1d\xa3{.,\xbb\xbfx\xa8\xaa\x00zʜb\xf6\xdd\xd1Y\xcf\xf6E\xa6\xa8LK\xcf\xc9[\xa2S\x02MR9\xa3\x82\xb7S\x9d\xe7YN\x8d'\x99\xcd\xf7\xf8\x9e\x8b\bc\u07fb\xbc(\xb3\x02\x812\n\xa2\x17\xc9\a{\x02MAe\x8a\xa9& \xb7i\"jP{\xa8\x8e\x1c,\xf4\x18\"\xa2\xd0\x0f\x0e:\xb3\xe8\xa4V \xb2\xa4\xd2)F\x8d\x80a\xef\rv\xe5&H)b:\xa5\xa3`\xdb\x03\u007f/f\xdb\xe7c\xef+\a\xdcd<\x8a\xf2\x00\x97\t\\\t}MRI\x80\xd9:\x83TX>\f\xa3\xfb;)U\xf6\x8c[\xa4\xf8\x86MBc\x91\x04\xcb\xf0\x1e\xeb\xbe\x10\x84n\xbbI\x1cL\xd8\x17C\x1eě\x1anJM\n\xaeA\x99\xb2\u007f\x9f#k\xc8 koT12\x82x\xaek[\x13V2s\xfe\xfa\x90\xa6\xf6L\rM\x11\x00\xb6\xc64\xb5\x11*0\xac\xec8\xab\xfcfÉ\xb0\xd3\xf0\xb3\x05i\xf14\x83H\x85{\x95R\xbaŢJ)\x8c\xe3\x98\x1a\xbar\x1e\xe4\xabrlǮH\x18'em\xd7\xcd\xc71\x17\x045ޔ\xca\x11T\x1b\x80y)`\xa0&Fϡ*\x89݄\xf7\xae\x1cѺ\xe6\x18\"ŉ\x14\xa4\x8a\xe5\xa3&\xaa\xaf\xcdc|\xc3W\xa0j\xa2\f\xd8\xe2G\x0eʐ\xa6\xfb\n\xe8\xb78M\x98m:\xe4\"*oNf\xa5\f\xeb\x8e\xfe\f\xa5d*\x86\xdfY\x05\xf9\xf3\xd4C\fvm+\xccȽ@\x9ax+\xb5*\x8b)\uf505oArnֿ\xe3\xdaQ\xd0\x1ccJB9\x9c\xfd\xb9\xcf^\xab\x84b\x94v\xc6\x1f\xe3\xdd\xf8\xc4\xd0u\xf55\xa4\xc2め\xb3\xc65\xe2\xfc\x9f\xb6\xe2\xfa\x1b\xc5\xfc\x96\x94\x19l\xcb\xf3H\xba䱽J=\xf3\xbc~f^\u007fa\x15\xe4\x8b8\xccb\u007f\xc2KY\xfaP5\x8fQ\xe3\xf5\x05\xfeg\xc4\xfd=\xc1\xea\xe6Ć(ߋ\xa4&\x02K\xe1I\x94\x94/a\xfct\xe6\xf0e)\x9cZR\x8c\xa3ë3\x00\x0eT\x13\x9e\xfa\xf9\xe7\x02\x10\x10\xbf\x87}\xf8\xd5籊\xa1\xfa\xacM\xf4\x94$\x11A\xc4{\xd7ݎte\vȳ\xfb\xfb\x0fVAzD\x05zW\xa0Qƅ%\xa8\x19Ej\xe4t&\xb8\xd1\x12\xce\x18\x8a\xcd\xe5\b%\xefRa\x1dk\x04K\xdc\xc7|G\x81\x825'ln\xbdيm\xbdk\xe0\xdbc\x94\xccg\x8e\xa1̴f\xbc3$e\xb0\x925oo\xa7\xba\x8f0fI!rw\xeem\x15(\x86\x99J\xb9\x19x%U,hw\xe6O*I'\xc8Q:\xe2.\x9e\xdax\x83\x8bk\xda.=\xeb]\xca\x19\u058c\xd6Fl%E\x8b\xecy!Q\x91\xb7\xdc\x1bau\x01\xb5\xa1\x15\x16;\x80\xdc\x1fB\xcd&\xb1\xf0(0\b\x19×\x1c`\xf2\n\xb6\xfe\xbcH\x1c\x1d\xb89\xa4\x85\x94\x94<\x85\v\x86\xdcf9\t@\ra\xbc\x1ds\xde\xcd\xd5Af\xf5|)\a\x83\\i<@\x88h\x04\x92\bm\xd7\xe6c^˷\x1b\x876\xb1UL2z/x\x8fT\xd2\x1f\x85r\xa5\xb9\xf4\xb7\xa1\xa9\x84\x16\xda>J\u007f\xcf\a\xc0\xe3\xa7z\xcdz\xdd\x12\xcfa\x16\xfa\xf8[s\xbdH#\x86\xec)\xb1\x8f\x92SRb\t\xe9\x129g\xe0h\b\xa6ńS3F\xb7\xf0*\xe9\xf3\xebVf\x89\x8e\x05\xcd\x04\xf9\xbe\x13m\xd7\x15\xf3\x02\x8a\xcf\xc7\xe4*\xa2\x82\xff5\x15\x9f\x96~\xcf\xe1\xf5\xb5\xf5\x93\xb7\xa0\xef\x8a\xd66\x0f\x1e\xaa \xa7\xc8a\xfc\x02bl\xf0\x96>\x87ٴ{L\nF\v\xf7\xe8SLQ.\xa1\xf3\xbb\xcf9\xbdf\xd9\u0382\x8crL\xfdο\x06\xf0\xda*ȭ\xc9C\x02\xa1\x13M;\xa1\nR:\r&b\xb3\xd02m\x95)>gӶRB\x8c\xb8\x00u\x96\xf6\xa0\xdbe\x90%\v5K\xe6ߢ\xae\xdd%o\xf1\xc6\xef%\xc5I\xb6\x94\x94\xc7\xca\xe35\x91\xa6\x10\xabd(\x1d\xb4\x16y\xec\x9e\x1c\xf6\x81\xf9\xf9\r%/\x9d0\xec\xf7v\xe3\x93\xff\xd7?\xff\xe4\xb3ϥU\x90\xe7\x00^\xc4\t\xac\xcft\xa7\x14\xbb]TD\xfa\xef\\\xfb2\xe9>\xac\xc6L\x13\x92ei7nѱ/\x12\x959\\S!\x90\u007fc%\x14\xa4\xd2\xea\xbd\r\xcaQ\x93o\xe4~~\x10\xff\xef\xa9\xcedM\xf5\xden0\x8bP\xa5Y2\x9f\xc8g(6\x9a\x11\x93\x89\xcf\xdaK\x99\xbcS4\x02DM\xb8t4\xbc\xfc\xd1\xfd.\x80\x97\x06\xeaի\x9f#Mf\xa8`\xb7\xab]SК\xe5#B\xf83\xe0\xba5\x9e\x84\b\xf7\xd8!\r\xbd\xadEf8\xd3\xdfecN\x9b\v=4\xa2\xe8\xe3\xe4.\x8d&qbE\xde\x03\xd1rǻ\x8bT\xb1.\x15\x82e\x98/s\xc5\xc4,\xe3\xa7]\b\x1aq\x1a\xc4\xef\x17M\x8b\xb6k\xf2\xbb\xe2=\xdd\x12\x9f|\xf6\xf9\x00\xe0s{\x81\xc3Ї\x1a\xcd!\xf1t\xbb\xb1\x8a!\xaa\xa1F\x8a7\x9dVZ\xa0\xd4\x00\x0eW\xc6\xdb\x1cq/\xbdM\xc9y\f\xf9\xd6x\xc35\n\xb2\x04V\xac\x9emG4\xf3a\x05T)\xbd:\xc1\xfc\x93\x12\x1d\x14\xec\x11\x80,\x16F+\x8c\xacRT\x9e\xf71\xdbwݸ\x05\x97\xb9P\xac\x94\xff\xd2\x1e\x8c\xf2\xa6\x05\xc9\x12R\x1b\x86\x93\xed\xc5\x05ڶ[\xbc\x89\\\xac_\n\x19\xa8r\\vV\xe4[\xd8Y\xe1Z\xae\x1f\xf8.\xc2c\xa0\xdc\xd5ޣ\xa2Gm\xd5\xc7\xe9Тȇ\\Z\xb0\x93=\x8bTG\xb0\xb7;s\xfa\x1d/=\xa0\xa8\xf9\xd5\xfb''r^\xb1p\xebVj\xee\xc2$}\xb21J\xe9\x01\x96\xcdv[\xf6\x1aQR\x15/3\xf1\x97\xc3s\x1c6e\x92s^\t\xfb\x8as :o \xf7X2&'\t\xaf\x16\xe6<֎\xec\xce\b\xfe\xe2\xb1\xda\x1c\x1aY\xe9!\x83dۗ\x19\nY\xe1\x99\xc2kp\xf2\xa58\xf0@\fͼhY\x1c\x97\x14$\xb4\xe6\xf0\xba'\x89\xb0\xd9l\x1cD\x9b\xac~\xaf]\xe9\xc5\xf3E\x9f\xb9\xf6\v\x95ò}\x18\x98\xdf浚\xc7!W\xc7\x18\x01:\xd3=\xcc\xc2\xda\x1c\x82\xb9\x10vs\x02\xf6\x9f\xafj\x9boƥd\xb8\xce:\xdcc\xd6ۚ\x99\xa1\xc0\x9a\xb4z\x8d\x82\x90\tG\xac\x17i\x1a\x9dp\xdb\xd8mI1\x8e\r\x01\x96 \xc3\x127o\x917\xeb\x81*Qv\x84\xb8B\x11\xaa\xe6\xcek\xce\xfb\x14HVf\xf53UL\x99&\u007f'J\xf2\xb3\xebB\xcc\xfa\x8di\xa1S\"\xc4\xf2\x8c\xb2ߘH\x80\xe3\xe4\x8dN\xf1I\xac A\x1f\xa4\x85x55\x8f\xed\xd0%\xb0O\x17\xb92F\xce!/>\xedO\xeeSU\xaa\x80\x18\xbb\xe0\x05ay\xa8>fm\xaevR\xe4\xea\xc4+\"(F\xa6j\xf2\xbeL\x98\x15C\xbc\x13\xac$\"^h\x0eQ\xac(\fK\x9d\x93\xe5\xff%\x129\xf0\xe8\x8f?\xfd\xf8\xa3K_A\xc8\xcf̃\x84\x96\xd7\vW\xfcЅ\xcfc\x95z\xd0\x05ԪF\x18(BEj\x93\xc17\xfdJ\xe5t\xe4\x11\xa7\x9d+\xb4J*I\xcc\xdc\u007f\xc8B\x1d!\xaa\x15\x8fJ E\x05O\x80\xcdn\xe2\xe9ANW\x04\xc3Ќ\xb4\xd7QJ\xa2\xddlR\x91\xc7?\x82^\x03\x9d\xf6 \x8dc\xbd\x8b\x1eheQn\xed\xe1\xe6\x1a\xf3\xb2\x9f\xe9\xcdp\xafa&\xe1\xb7(W\xa1\xa8]\xa24\x00\xf4\xd0=\xe2R8\x1dϱ\xe7H\x00Ӎ'\x89\x91\xe5Lx\x1d\x18Q\xe3ED>\xe7\x13\xbe\x82\xf4\xbe\x12hK@Ѯ\x10?\x10\x9c#\f\xb4 \xf0T1\x99F\v\x8d\x85\\\x80}\x1f\xb2\xa7X#H\\Ѫ}\xeaB\xe8I\xb9\xc1\x12\xfdsU\f+'zn!\xf5m^.\xc8 Xʣ\xd7M\x89\x95U\x90\xff\xe8ܬ\xab\x82#`\xc3\xf3Q-\u007f\x9d3e\x14\x85\x0e\xb4&Vx8\xf19>u\xcf\"\xb2\x95\t%\x1e\x8a\xe5M-\x13\xa2\x80\x97\x96\x0e\x0e\xad\x0eY\x85p\xf4gD9\x05\xad@\xcc|Ds\xf5\xfd\xcdF\x90\"\xe2\x8eT_\x97\xe5\x9d&\xd2e\x8cB\x01\xd2*ȿ\xb7\xdf\xd5v\x9dGX}\x18>\x9e\x8a/\xb9\xd0p\xb8dM\x02\x86\x95`\x8fv\xa6Qra\xc8\xe6M+\xc9\xd2\xeev\x1f\xd0X\x13Z\x1dC@\x11+\xe4AgT\xc1\x1f\x90|&\x95\xd4OI\xa5&$\a\xf5j\xaeC)F\xdb5f>\xbd\xac /\x00\xf4̌\xcdv\xab\xfb\x99\xbc\x1d\xe4\xa9~\xfc\xea\x03\x8b\xa9|J\x90\xab\a\r\x06D\xc7\xfe|@\xa4l\x1c%\x98\xb1P\xe5օ\x9d\x02\"=\x95\x82paz/\xf7{q;\n\x9d\b\xaa=ʃD|\xbb\xd5\xf37ւ\xaf}&FN\x84\x10fµ\x99Q\x8c\x96\xbfӬ\xa4\x96ə\xf4\x808Nj\xe3\xcch\xbb6\xdc\xfct\x82ï\xda+\xe8)Ij\xb6\x9c\xe7\xb4\x15\x81\x90\a#\x9c)\x86\x8d\x95\x9d\xb1\xf7\xa1\x1c5\xc2\x1d\xb7\xa0\xd4*Ã\xee\\\x8e\x9f\xc9\n\x86\xffY^\xea\x8ft\x8bL\x93bB\xfe\x1a!@\x10PJ\xa5r\xba\xe7\x00\xf6\xbe\x82x\b\x18e\x19\x11W[\x93\xdau\xcc\xc5鯹U\t\xe2\xcbL\x01-\xb0`\xb9\xf0\x0ea\xbf\xd6}+Hm;\xfbI\xbd\xc4\n\xe1;\x89.Ԁ(+9\x92\x83\\71\x9aM\x15ϳ\xe9Z\x88\x860\xec\xfb\xd4\xef\xff\x19\xbc\x81\xa9\xe0|J\x9f\xbd\xb8\xb0>\u009c\x8f\xb1r\xf1B\x15*\xe47\xb9\xf72ʵ\x95\xea\x1b?\xa3\x00\xd6\xe6y\xf7\xa2\xc4'\xa4A\x8aW\x86\xcf\xc0\x92c\x00\x94h\xedw0h\xe5/\t-\x80\x02]\xd7\x01\xa0i\xe3n\xf8\xfa\xfa\x93\xcf>G\xac \x00\x80փ\xbcr͉\xb3\x1c`\xe1`kn\x9cW\n\x18Ş$B\xc0J\xf0o66>\xa7\x10Vc\xfb\xe9\xf3{\x13\xf9\xd2)<V`\xa0\xfc\xb0\xd9\u007f\x1e+\x93\xfb\xd2\x19\xb3\x058\x16\f\xa3h\x1a\xb4]\x87a\xe8\x8b\x1f\x19h@\xd34\xd8^^̄6v\xf1\xfe\x05\xd0\x19\xfa\xb1\x92\x89\xbe\xa7\f\xbebpm8\x98\xd9ltoJ\x12\x11\x11\xd42\xb0\xbf\x11\xd4\xed\\\xa1\\f\xc5\x02\x13\x9d&ԍ\xd7\xfa\x15r\x1b[ΰ\xab\x16r\xf7\x1c(H۶\x9a\x90a\xea5\x0f\xef\xcf[\x1f\xb0$\x90\xa9\x9e\xa1*\xcaK\xdfuZ\xe5\xf4\xfaol3\xe5\xa2%\xb1U\xd9\xfb\xf2\x10k\xadqe8\xf3&@\x04:\x97\x82%\xf2\x04\x1f\xea\xad2\xb6\tV\x9c\x14}\xedl47\x95\x87\xb4-6\x17ۢ<\t\xffA4]\vѶ\xc1\x10|\xf2\x02W\x92\x9a\xad\xf9ݔ29\"\xb1Bb\x9e\x15\xacC\xd8\xe2\xcf-\x94o\xd1F+:E\x03c\n}\x8a\x81\x92C?;A\xdcA\xb1\x92d^\xdb\xcb-\xbanS\xbc\xc7\x00\xc5jD\xa3\xfb\xadJ\xe8\xca\x01K7O\x15\x9a\x14\xf3\x95\xc4M\xaa\aԿDkH\xf3Nt\xcd\xf7\r\xf5\xa6\xfa\xe5\xaarK!\xd6\xd7@t\x92<\xeb\xe3J+hX\u007fs\xfc[\xa2\xc1\xc5\xe5eJ\xe6g\xad&:\xaf5\xad$\x8a\xf3\xe9\r\xaf\\\x1c_\x15f\xf9\x17\x9f\xe3\xdb:d#\x15pؚ\xb5\x13\v֛P\x8ec\xf3\x84\xa3=ǂQ\xa3S\xb4\xb7Tr\xa0\x11S2\xfc&\x00\xd4\bl./㳞ͤ\x13\xa0K\xefq\x8b\x03\xa5v\xbb\xf9B\x9dcG4\xf4.\xb9\xcav\x8e\x97\x973s\xc88`\xee:X\xbd\xe6\x01\vk\x84\xe4\x14\xf5\az\v\xf8\xb8j\xd1Ó%\xe99C\xb4\x16j\xceph\x85\xf5\xb7\xf2Gl\xb7۬1\x12\xfeC\x1c\x87A\xeffX\xb0\xf8\xc9nҒ\xb2 lH[\xaa\u007f\xd0!\xa4\x02\x05\nSD\x8ar\x8c\x90\xd3\x01Kxα\xe3\xe3,a\xd6\x12\xf7\xd8}\xe4ck\x8da\xa9m\xa9\x02\x1c\"\x00\xddv\x9bա }\x1f\xf6{\xdc\xdd\xdd\xe5\xe3\xfcC\x93\xd8\\3Zj0?\xc7nR\x81\x98q²\xd0\x19\x1er\xae\a*\xd5\x1f\xf56z\x0e:e\xeb{\xad\x80\xfbs \x15(آA\t>'\xf5\\,i\b\xb0\xd9n\xaa`^f\x00\xd7/_\xce\t\xbe\x8e\xb4nNPRP\x9eٙN\t\xa8.\b\bS\xeb\xbaJ\xb1n\xd4\xd3eCC>`\x19\xcfoڋϡ\x1c\xa9\xee\xeaJ\xae\x81\xc5k\x8d\x13\xf0\x98\x1d\x9e\xb2\xb1\nD\xa3e3\x17f\x05\xad&$\x04n__\xeb}\t\xa5\x84\xcbC(\x82bb\xac\xb9\xd1\x0e\x11Fn=\x17\xa7\xe9}|\x8e-\x9f\xc1Ķ\x13$\xfa\x9a\x92\xb9Kf\x97\xc5\x1bAw\x1er\x82^(\xa6\x1e\x94@/uo\xcf\x04y\xa1Vd\x9fq-\xef/&\xae\x82\x1c\x89u\xdbv\x90Ra\xe3\x15\xc8S(\x96\xfb\xe9\xd0\xf7\xb8\xbb\xb9Y$\xfcR\xfeث\xbd\x19oe\x165M\xb2\x00\xe3\xf2\x97\x18Z[ \x82[m\xfdf+\xbb\xe8t\xd6\xf1\x1eB\x967\x16\x9a\x9d\xe89,.9\xca)e)\x97\xcc\xfc|\x86\x96:\xf4\x8a\xcb9\"3ڮӼҡ\xacr҃ؿ\xf9\xfa\x8b_\x05\x8b\xd5Ṡn\xb0\xdenCB\xb8\xa0\xd1\x15\x80\xa2\xf6\n\xbb\xa41Fɂ\x9cz\xc1Eg\xdb\x12j\xac\xee[Ρu\x8fn\xef~ø\\#lB\x0e8\xf1w\xc1\xa8\x83\xcdI+\x1ag\xe3]7\xa5\x10K\xd97\xecv{\xbc|\xfem\xf2\xc3\x02w\xc5\xec\x94#\xb0\xda\x1c\xb8\x8c\xc0eۉ\xc09\xc2P\xb1vm\xa1\x16\u0085\xc94\xf2fH~\xb3\xe4\x9c\x0f\x13\xdeS\x8eᖔce]\x8d\xd7(xŤ\"\xd9\x1cx!Io\x014\xf6Ä\x10\xf8\xee\xf9w.\x1cJ\x910\b\xd1\xe8f\xafx\xd6W\x19\x1a\xaf\x88\xa4\xcb*\a+5y\x90\x95-(\x9c*\x1aVX>>\xd0\"\x9eJ\x99N\x05\xd7\xde\a2ƙ\x86\u0093Fq\xa9\x9f\x05\x84\xe9\xf9\xfc\xa5\x1a\x95\x8c\a\xee\n\xe7\xca`\x10\x89\xa2\x82\xfc6\x80\x80\x83\xb1\xdf\xed\xd0\xef\xfb\xe4\a\n!\xd0v\x9a\xe5\x9d0\xb5\x98\xb3\xbd\x9e\xb8\x03\xd7O\xd0\xcdN\x11\x15[s.\xa3(\xb5Mt\xa9\tB\u007f2\x92W\n\xec\x9bnO)\xd5^\xce}\xedt?7\x19\x018\xf9\x81:\xf6\xf3\xdd\xdc\xf5\xfa\x867\xcasS-\xf0Ip)\xa1 \xff|v\xb8J\xe1\xf6\xf5k7\xc6\x18\xc4l\x9bM\x80N\x11\f]\x10\xf2\x85Š\xb8H\x98U\xb7\xb9\xe6\xc1\xd4&\x90%R\xe47\xe4\x01N\xe15rL(\xf7\t(P\xfd\xa1e\x9fU\x1cb\x85\xc4\u007f\xaa8;RJd\\TR\xe1\x05\xfdq\x8d\xa6k\xb3h\x9bU\x90\x9f\xa5Nb\xbf\xdf\xc1\x0f\x92\b@g\x94\xc3\t\xb6\xbf\n+f\xc4#\x82\x94Z9\x1c\xcdh\f\xcd\xfa9\xc8R\xbf\x95\xcfn\xb2\xf4|r\xdcQo\xa0\xaa}J\x05\xa33\xe6\x06\xa8iF=A\xbf\x14-\x85\xc0>:\x1a\x0fVE\xef\xa4\xcc\xc2\xd68\x0f)\xe55Db\x91\xf6\xe7\xafR\xb6\x82\x99\xc1\xe38\xfd\xa4i\xa6\xb0\xca\xcf\r\x8c\x97p%\r\xd3\xd35\xf4\xbdٹnl\x04O\xdcV\xac\xa6\x9d\xd8\x1c\xb1\xbdg\x931\xdf\r\x16\x12\xeeY\x98\xb5\xb4Y\xf7{\xa0$\xa7P\x8c\x93\xa1^\v\xb9b\x1cHQ\n\x89B\r\xf9\xc62\xa7o\x8dB'\x88\xe3\xdc\xcb\xe2[w\x00v\x00.\xe2;\x91\xe3\xe8(╔\x90R:DH9k3\xef\xbc\xdf\xdd\xdd\x01\xa0 D#\"w1~\x8d\x84\x95Z^\xbf\x95\x11\x92\xdc\xf2\x1d\xf6f\x93S\xd5v:B\xd0\x0f\x11\xd0C\xdf\xf7\xc6\xf2\x1f\x1cQ3\xaa\x98\xd9Im\r\xf3\x97\x1eQ\x02\xc2\xcd&٥\x1d2\x15`P\xd7mЈr\x92>\"\xdatkot\xf46N\x010^Aa\x94\xd2+\xc0Yk\xae\xff\xdc\xdd\xde`\xe8\x87i\x11\xa8\xe1*\xd2H\x81\x177{\x03\xf3A\a\xf1\xa9\xac3s\x91D\x82\x8fP\x92C\xdf\xf7\xbd\x85\x99s\xa1T\xa2\xdb6.\nR\",\x9e\x11\xe7e\xd6Kψ\xf5*\a\xeaB\x0f\"дmR\ueb02\xdc\x00\xf8w)\x05Q\x9c\xee\x80\x15\x1e\x99\x9c_\xb8\xd9\x1b\xf4\xabm\x1b\xcfE\x8a\x88\xab%J\xb6m̩8ə\xb4j\x82p\x8dE\u007f\x03J\xf2\x1b\xf1\xaa\xa1\x0f\xcd\x18*\x95\xcaE\xe2\x9f\x05J@\xf90\xaf\xd2c7\xa4\x15$\x8bb}\xf2\xd9\xe7{\x00\u007f\x9d\x80\x9f\x10\x83\xabv\xb5\xb3\x9d\x02Ӛ\xaf\xff\xb9\xbb\xbd\xc5\xeen\x17\x84R\xfe\x110\xb3\xf3*\"\xd1m\x9bbꮙ\x0f\xa0\xb5u\x91\x9a\xb5ngP\x92\xef\xb3\xf7\xe0%\xe5X\xa0L\xe5B\xbf\x96\xcf\xd5<y\b\x9f0.\\\xbb\x10^K\xc5\x13\x16\xbaY\xb1\xe4A\xac\x17\t^\xfd~?\x13#\x91\x1cr\x01v\xbb\x1d\xf6\xbb\xbdi\xc9\x12\x1e\x83\x87\xf7\u007f~ߍ\xf5>\x9eE\xe0\x18A9p\xee\x9d\x12ێ\xf8H\x14\xeb\x94JrjE9\x97\xe2\xf1\x01\x8a\x92\xf2\x14T\xf1=\xd9\xfd.>{\xa6\x95\x93\x84\x88\x90\xd7\xc1\x11$\xf7\xa8k?궛d\xef\xa0(A\x02}\xdf\xdbn\xf4\xc0\x83ĈR\xbf\xdfc\xbf\xdbM\x1fڵn{OxR伏U4\x0e\xc2-Jl0\xad'\xbd^\xf4&\x11\xc4\xfc\xa6*\xe5̜%\xa7^\xfb}o\x8dWZ\xe1\xe5\xb3l\x8c\x01s<\xcfDd\"\x1b\xe1\xd5\xeb\xbfI\bt\x9b\x8d=O\x99R\x90\xd9u\xc9q\f\x93\xab\x04\x9b\x89T\n\xbb\xbb\x9d\xa7\xbd\x02\x8dh\xa6\x8b\xa5y\xd2\xe6wN\x86\xb1\xa8\xaa<\xeb\x03\xf9i\x13\x89\x1e/\xcc)<\xb4p\xeb\xc1)Gmkʊ\x16\xf5R\xb8\x15t\x8f'd\x8c\x0fܝ\xc2Ri\xca+\xfd\xfa\x16\x00>\xfd\xf8\xa3\xb2\aaf\xb4M\xe3\xca\xf1M\x02/\xeew;()\x9dC\x11M\x03\x12\x14\xe6.4\xb5\xa8\x888\x14\n,\xc4\x19V\x8beB\x85`\x05uBIjր\x9d\xca\xf3p\xc5pک\xf7\x13\x06F\xe6\x1e\xe0\xe7\"\x87Z»/>W\x97_D\x83sK\xde(\xf3\x99\xa2\x15vU\xa0\x84Y\a\xf2\xc9g\x9f\x97=\xc8\xe5\xe5%6\x17\x17\xc9/%#DS\xbf\x96\x0e\x91ڶ\x99'\xf6\xa6\xff\x8a\x84\x97pŽU\xe6;\\#\xe3BR\xb7\xda\xcaU\n\x14\xdd3,\x1b\xec\x8f\xcf,9]\xf2>k\x1a\x19gc§\xcc\xcd\x0e\x9d\xf7O\xfcL\x95>\x8b\xe3\xcaI\xa1\x19\xb1\xe6\x02\x14C\x88\x16J)\x10Qo=H1\a\x11D\xf8\xf0\xa7?q$\x0e1u\xa6\xdd7-\xe5hΖѴ\r\xc8m\xc2\xf5r\x162\x05C\x12\xe9\xdd\xd8Q\xe5S%\xda\f\x8e>\xf4\x9c\x00,L\xbd-\x911Ĕ\xac\x87Z\xd6\xda\xcf:f\xc3T.\xe4\xa4\x03\xafy\xf5\xe2\x9b\x1a\xb2\xf2\xd5QB\x98o\xf0A{M\xbc&[\x9d\u007f\xec\x17s\x90v\xb3\xc1\xf6\xf2\xd2:0\x10\xe6\x16\u007f\x1c\x06\xb3e\xd4\xecTo\xbb\x10\x0109\x8b \x01\b\x9a«\xcc\xce\x0f_8\xec\xb2\xf7\x83,\xf8ʄ0\xd7\xdaB+\x84\xf0\x10%)\xf1e\x1d\xbb\ad\xad\x97=\xc6\u007f\x9c\x82\xaa\x94s\x9e5\xca?\x92\xc2\xea\x01>\xc4+d\xc1\xff>\xc5A\xe9bу4&\xf7\x88o\x82\xcdD\xa0R\nr\x1c݅\x8a\xa6\x810!\x14\x04\x85\t=\x99\x85퉙\xe3\xf4bN\xfd\u007f\xca\xd5Y\xd4\xd9\x1fXR\x19W\n\xdf9FkK\xfbDjé\xa4\xb2\xadh\x05\xb9\x8fD\u007fq\xa7\xa5W\xf8+\xadn#Z\xaf\x1c\xba\xa9V&\xef;\xebA\xc2^\x98\xa9\xc0\xe7\xc7\xca\xe38z\x03T\x13ɴ \n\x92qQӞ\xee\xfd\xbbny\xe6i\xa1\xe5\t\x10\xa1c\u009fs\xbc\xe7\x8dS\x02\x9d\xaa\xad\xbdV\a\x96\x942\xe7M9}\xadlW\x1d\xc0t\xf9\xae\xa0:\xcd)\xa8\xeb3,y\x90\xc9\xedϭ\xfa,,Q\xec*AM\xdbF\akhU\x84\xd0U\xf55\\G\xf1\x04\xe39\xbaWS\xe1\x96\xdfU|\xc4w\x9ez\x13\xed1\x8aT\xf4\"k\fי\x13\xf8\xd4\x1aqNx\x8f\x99`'\x96\xe9\x1c\x04:\x90\xae\xe7\x95\x14\x84\xfd\x8b\xd5}Qy\x93\xc2\xe3\xe8j\x1dB\x84{\x16\xf4EáV\xc5\xebJ\xed\xa9+\x84:t\xa8P&\xf0z.|\xce9\xb7N\x89\x95\vQ\x0f\xdd6\\\xa5l\xf7\xe0Ÿ\xe2gy\xee^^\x06Xr\x06\xb6\x16\xbd$\xc20\x8c.\xcc\xca)\xc8\xd6\xff\v)\xa5S\x00\x8a\x10,\x00\x18͇\xe9\xfa\x88\x98j\x1d>\x97\x15ʻ<('\xac\xf7\x1c~\xf8L,g\xb5\xa2\xf7\x1eE\xd1\xfd*II\x90\x0f\b\xe1\xa8\xf6\x19\x94\x1a\\+\xefm\x1cz\x9b\xeb\x12\f?\x83S\x90O?\xfeh\x03\xe0\x0f\xc37\f\xba\x92\x9e\xd2t\xd3\x06o\xc9},\xb4K\x11\x8c\x1b0\x9e,\x1cl\x8ev\xf4\xde\x16\xe0dک\x1fʋO\xb1X\xb5$\xa0\xa7\xd8\x03RS\xe0[\xa5\xccT\x95\xd7\x04\x04\x86\a\x9e\xadG\x96\xd8\x01x\x1a{\x90\xa7\x00\xfeY\xfc\xe5\x8e\xd5$\xf2\x02\xe4͘\x93\x10z\xa5\xae\r\xab\x96r\x87\f̊{\xa8\xea\xb2\xd7\xcbSBMJ\x9bY\x8f\x15NqȾ\xf93%\xe9\x84\x13'\xe3Ǵ\x9a\xe4\x14\x86\x96\x95$\xd9\xe0J\xf5\xb2\x14\xc50\x1b\x00\u007f\x14+\x88\b\xc2-'\xdci\x01\xd7c\x1bJ'\xe7\x8dp\x94)A\x95\xbcrV8\xfe\x16^y\x88)J\xa2S 3\\\xcb\xf0wfT\xecA\u007fW-\x80\xb2\xb4\xe45\x9bC.\x87I\xee\xbd\xecQ\xf7\x10\x01L\xc7`\xd5\x1f\xc6\n\xd2`\x1a\xbf]\xb0z\xa4\xe7\xccY#[z\xee\x83]\xbb\xb1\xbf*\xadH\x00\xe7\xd7@\xbc\xf5\xd1A:\x1e\xf7je\x04\xc0*\n\xaf\xc1\xf6S\xfb\xdb\xcfaU\x1f\xeak\xa1\n\xcek\x15\xe5\xc0\xdf[\"\xa9\xa3C.\x83\x19k\x1f\xa4R\x9c\xcc\xcd}^\xac6\xbe@\xed\x1dfx\xaf\xab\u007f\xe8b\xa0\x98\xb9\xb5%\xae\xa1\x9c\x95\xa3\\\x12\x9f\xf0F9dg\xf5\"\xc8\x13\x85\x04\xe7D\x9eފ\x96\xf6\xc4\x18\xc4A\xf9_B\x83\xaa\xf8\xd0\x0eͧ\xccw\x0f\xfd>}[\xe6\x9f\xffm\xfc\x17m\xdbB4\xf3һ\x92\xd2%\xefz\xec6\xdcJ[{HT\xd8[\xee\xf67\x14\x06\xa8\xb2D\r\xe7\f9\x1e\x80\xa0\x1e\xd3~r\x16\xefq\xc0٤Z{\xa8\xe2:\xe8\xd4\xca\xe1\xbd\xf6\xbb\xb4\x82X\xaf\xf1'\xf1E\xb4]\aAB\xb3\xb4{P\xac4m&\x1a\f\x9bf\xd2cx\xb7\x88\xba\x18\xe4\xc1\x11\\G,\x16qG-\xad`<)\x0eY\x1e\xb0#\xf0T\xb1\xfc\xdb\xe2=\xf8\x98k:`\xdc\xd6~\x1e\xa7\xc2+\x9aP\x1f\x8aвt\xef\x1c\x05D\x1d\xb5\b\xaaΉ3\x8e\xf1ӏ?\xba\x04\xf0\aa<\xa6\xf0\xe4\xd9\xd3dr\xedC\xbf\xc2\xcf\x13l\xcd\xc4!Zy\xca\xf9\xd4a;h85/\x12\x1d\xde?\x10&\x1c\xa9\x94\x05\x0f_]{8\xc0\x00%\xf3\x8a\xcab\x9e\xaf \xe4\x87\xf1A8\xee\xb2\xf6U\xb9\x8b\xe2|\x1c'\x00\\aƇŸz\xfat&\xc4JJ\x8cFA\xf4\\\xb9\xee\x9et\xd3\xe7\xfe\x9cyn\x1faj\x90)5\x95\x96\xb9\xc9U\x15\xf3J\xebͅ!\x9bS\x12O\x9f\xdb\v\xe5\xe8I\x0f\n\x91V\xc6\xf1K\xe1m\n\xb6MQ>-y6\xf6\x01\xa0\xe4\xe8\xc4i\xaf]\x00\xf8\x11\x80\x1f\xf8\x02\xd1m6ؘ\xf1C?d\x1a\x86A\xb7\x05g\xf6\xff\x91\xc9-r5\x06\x9aK_Ъ\xec\x042\x18\xbaGH\x89y\xe8\xecE\x81֔\n\x05Jz\x80y\xc8Z\xe5\xa9QP^;۱&\xdfH\xb0g:N^fH\xa5V\x817~)\xc1\a\x84\xf8\x90\x1e\xbatD\"b\x0f\xd2\xf9_\xfe\xe8\xf1\xe3YA˶\xb9\xa7\b\x1c\x184k\x1a\v\x14:\xd8D\x85\x00Re/\x0eL\xee\x1bL\x84y\aY\xe4\xda᠅\xfd\xde\xf7\x99\u007f<\xe8\xf0\r\x19$uA\xb8\x03o\x00\xcdi\xc0kx\xb4\xa2\xc8\xc2=\xd5\x14qu\xedg\xce_?\xfd\xf4㏄U\x90w\xe1\xf5\x9e\x00@\xb7\xdd$wq\xf8\f\xed\x8ef\x854\x17\x89\x13\x82\x88\x90\x81\v-\xc8\x1cĔ\"\xcc1\x02\x06\x8bP9\x0e\x94\xd2\x19\x15PɣԮ[8\xb7B\xbc1N\xdf5\f\xf9+\xc33k\x18ա\x04\v\x9eL\x91wAT+\xfe\xde5dd\xea\x9f\x02xl\x15\xe4_\xce\xde\xec\xa1L\xee\xa2\f\x89\x9c\x9b-\xe4\x88\xe8-\xaej{a\x11E\x9f]L\xda\x12V\xe6X\b\xaf(\x041\x82\x824\xb1\xf2[\x99\x8c\xafQ\xb6\x03\nsEq,\x00\x01̜\\Δ\xbd\xb7\f\xd8cgB\xd2\f\x99\xa5\xdd苪\xf4>̾\x1c\x01\xe0'Yk\x9b!\x893q\x93\xfe\"\"0\xa8\xae\xf9\xad8k\xce\xc5\xc3%`\xf5\xf2\x9b\xb5J\x95T\x8c\a\x1e\x1e\x9d\x02>\xe6\xcc(+\xad\xfc\x1c\xaa\tY\xed\n\xbe\x8a{\xa8\x02\x1cR{\xd6\x17\x13\xfeX\xd6DV\x1c\xc4R\xe8\x03?\xc1\xa2\xf4\xe1\x10q:v\xf7\x04\xddO\xdc}\x16<\x10\x85\x83\xf6\x89Ў\x8e\x10\x16\xaa\x14\xf2\xd2\xc3\xe5#r\x12:\xf3\xe0\xd5)\xaf\xe5\x9c\xf0Cʸ\x95\xba\r\xa8r<;yݕ=y\v\x1d\xc8l\x15$\t\xe1\xf0R\x9b\x88\xd1D-\xf8\"1\x81\x98@\xaa\xbc\x8b\xa2T\x9d\x94í\xb8A\xac\xb8\xb0\x96\xbaJ\xa0\xd6\x14\xb2\n\x85˷51_\xba\xf6c\xc0\x8f\x92\xa1\x89!\xde\xd9ʂʮ\b:\xe5܊\x1d\xe7\xa6,\x85\xb9\xf3 ?\\B*\xd8c\xd2&/\xfc\xf2W93'\x90\"\x9eo\x8d\xe2\xc42\x1b\xf2\xbe\x90\nq(-\xa1*\x87\x0e\x02Ŭ\x1a\vc\xc0\x0f\x05\xc1Z\xcd\\R˔\xe2\xdf\xef)\xae?zv\xc7\n?U䕋I\xfe\xc2j\f\xfb\x12\x00\xfe4\xe7\xb4f\xb5\x80\x04 M\x89zH\x1c\x1b\xe6Z\xdb\xe3[\xf6\x95,\x19֜\x00\xc6\xe3\x1a\xebR\xb0\x94\xbf\t\x9d\xbe\xb5y\xc8j\x8a\xa3\n\xee1Z\x88\x14\b\x99\rUި\xc5R\xe4Cq\x98U\xb8K\x01\xe0Y\xeaƓ\xc2\xcc\xd1W\xb0\n\x86\x90R0\xdcb\xac\x18\x11\\\xf3\t\x1ejU<|LB_\xdb\x1eq\xa4\xf5=gK\xcd1dwǄ5@j\xd7\xe0q\xcf{Fx\xbeh6C\x02\xc4D\x94ž\x82\x8c\xb5\xa1:Ej\xcb\xe4+\xcd\xfcͳi\xc1\xac χ\x93('hkG7\x97\x86m\xf0\x0f\xaf#4x\x9d\x8eD P\xe9\xb9\xf1\xda%<\x8c\x894.ǵFa\f\xcf~\xd8)\xd2\x1f-\x00\xfcݒ\xc0\x06\x06\xd3s-\"\xbe\xf1\x94簄sD\x05ű\x96%\x81\x90\xdd\xf3C|S\xf9\xc1\x83|\x1d\xd0\xecX\x95\x1c\xa3\xc4b\xb2p~\xbe\x1c͢\x8f|\b\xc7\xf0)f9܋8\xcf\xcd\x02\x0f\xf2oS\xfaA\xb3\x82>'b8OS\xe2i\xbc\x03x\x98\xfc)I*\xc0\x83'y\xc8o\xc0\x82\x9eR\xe1\xeeE\x01\xcfq\u007f\x89\xf0\xb4\x06\xd5J\x99wru\x0f\x84M\x87\to\x14J\xf3\\\xbaې67\xf0 _V\xc5\xf5q\xfeac,[\xeb\x8fץ\xa5\xbc\xc9R\xec\x9e\xf9\xfb\x1c\xb5\xfd}*\xc3}13\xdeg~\xf2P\xc6\x06\x0e:[D\xdc\xeev\xc58\xcfC\xaby\x82\xce3C|qy\x91\x14\u007f\x01\xe0\u007f\xcf^\xb8i$\x9b43\xfe\xc3\xe1R\x93%\x05\x887\x9e\x064\xf6\\\x88/y\xf5bȓ@\x86\x0fH\xb0\xeesm\xdb1]\x04|\xe0\xef\x1c\u0092\xefJ\x106lS!`\x14\xd4\xea\x82\xef\x9ecWm\xab\a\x04\xe3c\x10\x00\xfe\x02\xc0˹ G\aIs\xfdp\x9e\x03\xe1\xfc\xf8\xa4?<\xfd\xc9\xc1r\x99F\xb7$\xdb\xf7\x9a\x06\xc2T\x9c\x9c\bS\xf8\f\x02x\x8e\xa1\xaeS\x87X5a\f\xf9\xa1\xf2J\xf2\xeb\x92R.\xcd\xf9\xac\xba7WXf\xb7\xc6\xc0\xe6\x15I\xbe5\"\r.E\xdf-\xda&ya\x02\xc0s\x00\xdf\xf9?\xec6\x9b\\\xba\x83d\xa3sܤ\xe8\xf9\x04\x8e\xdbM\x80\xe4x\xa5\u007f\xf0\x9c\xfaL#t\xb5\x8f%n#\xa0\x05A\xf9>\xb6\xa4\x1f\xede\x12Fm\x8d\xa7\xc8)Tnּڃ\xf8\x91\x88]\xfej\x94\xc16A\xfa\xc5\xc0\xd9ۣZ\x1b\x11\xa1mڤ\x94\b\xe8\x85!ҿ\xd8\xed\xc56Y\x03a\xf0\\G\x12\xfd\xf9\x9c\xf0\x06\xfe\xf4\xa0\xf3.\xa5jmb)\nU\b\xfa\xa19I顤B\xbc7\x1d\xbf\xf3\x19XW\x96\xc2Ϛ\xbe\xb6\"\xb1B\xc1\xc0\xdc\xd4\xf1\xef\x00\x00 \x00IDAT\x89c\xa6.-\xd3{\x9c\xec{r\x14l\xd2M\\+5\x8d\xa6\xd0M(Hx\x83B\xa0\xeb\xba\xc4\x18jz\f~Ư\x96\xf2*\x99\xb8\x96VX\x1b\x9fo\xeb\xa4hVn4\xf8PK\xf9\x0f\xaf\x838\x8e\xf9D\xa1cR\xf1}\x00\x89\x13\xca\xc1\fV\nM\xd7\xce\xde?S\x10!ȭ2\x98\u007fq\xb8o\x8a\x12\xcdS\x14\xe7!\xb5\x02\x1bO$\xfa\xad\t\xc7.\xac\xacP\x9c\xe4.\xc0%%\xf9\x9e\x87ek\xb7ne\xdf\xe7\x01=5-\xf1\xebr\x90\xf2up\x14\xbaۤ\xbd\x04\x12 \xaa\x83\x04J\xb0\xd9^\xf8\xebp\x83oK,b\x9b\x1f\x89\x1dB\xf2H\x85\xe3\v]T\x14\xff\xf7\nťs'\xad3\xbc}\xadU\xbc\x87V\x91s\x86g'\xa3O\xbao-\xce]6\x87\x8acߪ\x88 \x1a\x01%\x95\x95oJ*\x88R\n\x8f\x9f>\x99m\x84\xd2\u009e\xc8?H+\f\xb3\xe7+2\x82V\r\x1d\xfa㶕\xf5\x8fEa9\x88Y\xfca\x85Y\xa7fw?Jy3\xb0{Έ\xf9+\xf4r\xdfK\x11\x02\xb8fkX\xae\xb92\b\xcb\x13\rW\x94\x94\xcfB\x0eB\x00.\x1f=JL\xf3\xa5`K\u007f\x108<\x1a\u007f\xa6\x9b21\xe1\xa2GH\xdc\xf8\xb9\x90\xa6\xd4\n\xe6S{\xa8\x93y\xb5{l\x80\\\xdb\x00\xba\x94O\x96\x8c\x15{\xf7&\x84XMՔ\xfaN\xaePHLX\x13\x04\x89r\x0eBv]Z\xf2b&\xd7A\xf0\xdaJ\x14\x87\xa4\xc1K\a\x1bŢ\x9c O\xf0=\x87\xbfG\xbc:\x179`\x82\x90\x96v\x93\xd4\xc6\xe8\x0fh\xfe\xe3P%\xa9m\xe2d\xaco\xf8\xa4Z\xd4kM\xd4\xc0s\xd4j\xf6\xf9\x11\x8263\xf7\x8d\x005\xf3~,\x11&\xe8\x02B\x88Ķ\xd7<\xcd\v#U\xde_\xce1\x18\xf9\x9b\x12\x11v\xb0\x94,ӡ\x8dsk\x1ef*\xa4(x\x9dS+ʹ\xdbVj\xbc\xa8{\x06\x87\xae\xe7\x8eB\x1a*\xdc\x1fU\xec\x1a\x99\xdaM\"\xd43Ev\x9e\xb8\nF8\xe9\xda4m\xe0Ь\x82\x98\xf6\x14F\xb7\xdd\xea\"a\xc4tȜ\xca?`:(+\x99\xd2\xfdM\xa4qgo\x14_3q\xd2]\xd2\xc2\x03^\x95h\x9e\x82\xfca\x8dW;R9N\xb1x\xe7$\xa0\x81R\xf7\x92\x93ъ\x05\xa3\xb6\x19\x91S\x91\xc8L6\xa6\xa6\x13\xf2\x1aO4@\xe5d_؏k\xa1w\x13n\x19\xc0v\xbb\r \u07b6\xeb\xd04\r\x98\x19\xfd\xbe\xc78\x0e3\x91\x13vy\xbb\x97\xfc\x97\xbcC6\xfed\xafg\xbf\xf2\xd08\n\xc98n{Y!\xf0\xc7\x10Us\x86\xcc\xeeX\xf2k?&?G\xb2_\x9d\x90\x9f\x1a\xb0X\x98.\xf4\xc3\xeaŖ\x18\xa5\xa0\xccuR\x82\xfb \xccg\t9\x93\xce\xcc\xfe\xca@\x85h&\xfd]\x00\x10\xde>\x10]0\xdc@4:\xec\xda^^\xa0i[\x0fm\xb0\u007fx\xea\x8d$\xef\xc1\xc65\x8d\x88btn\xe5\xa7]\xec\xb5!A\xee\xc1s\xa1\xf5\xf9\xd4\u058cOT\xab9\xa7\xe78\xb9r\x9d\xd1k\xaeA\xebfu\x0e\x8f\u07b48*\xec\x85WV\xfatj\xe1>O\xf89\xc8\x13\xb8\r\xb7\x14\xb2\xac\x1b\xaf`\xb7\xd7^\\\\\xb8\xdd\x1d3\x8b\x12\x0f;%\x92\"\xceU\xd1k\x89\xcf*,\x0eW\xec\xe3\xa6\x15\x9f\xbf\b1\xfa5\x9f\x13\t\x0e\x9dq\xcb\xef1\xd7x\xb4\xd19\xc5n\x93ڈ \x038\x04\x00\x93\x1f\x98\x85m*A\x0e\xd2$e&q\xa1M\xd3L\v=g\x05\xbd4\xf1ZqYJ-M}\xa6\x1dd\x89P\xa0\x161Y\xfb\xd0\x03d-ʱ\xf8\x88\x9e\xads+Ʊ\xe1\xd5т[Y\xdb\xf0C\xe6\xd95+\x15\xe4~\x13\xc2Z2\x88\xb9\x12\xb7\xe7\xad8\x18\xa3\"߃\xb4X1\x16\xe1\x1a\xba\x98\x1d\x12\xc0\x88\xe9\x81ҡ\xd4\x1a\xe1?\x95E\xaaR\x0e\xff\xe1U\n\x05\xa7\xd8\x01}\xf0\xe1Э\xaeo\bɺ\x97\x04\xdf\u007ff+\xf8͒\b\x1b\x91WP^\x8a\x04R=\x1d<\v\xbb\xf4\xffS\x90\x83\xb4\xf0\x98݃7\xf8\xc4\xc2\x1e\x1a\xd0m6\x18\xc7\xc1T\xcf\xe1&\xb9\xa6\xa4ܠ[1\xb2\x80<\r\x0f\x1d(\xe0KI\x9c\xdf\x06\xed?\x14*\xc1\x97DAkI\xcd\xf5\x05\x15\u007f\x13\x8e\xe2\f\xb9\xc3}t\xf0\x9e\x19\x9a:\xd9\xe70\xd22\x16&\n%)\x8a\xf8\xda\xc0 !L\xaf\x16\x05\xad&\x1d\x92\x84\xddy\x94\x81D\x13j\xa6\xbf!\x94\xc2B`\xb2~R\xc1\xc6w\xccCN\xc6\xca5mڅف\xa5k`O\xc9\xd4[\xb6\x01k\xcd&\xb0c\x14#\x8e2r\xe4\x0fEOY\xb5\x9eb5A\xac\x8e\x8e&\xa3Ʊ\x82\xc4)\xcbbm\x81\xc8\xd7f_(|\x17\x9aF\xb2f\xad\xf0+\x17r\xaeV\xa4څ\xf2\x95\xac|\xa5\x10\xcd\xd2\xfa\xbf\xd5k\xe2N<\xfb\xe2\x1b\x1fq\f\xec\x9dL\xbak\xe2\r*\xc4\rf\x12\xd1\xf7\xfeQ\xb3b\x97\xd2;\xce\xc0z>\xf5(\xc51w0\xe9U\x19\xdfW\xc6\xedk\a\xa2h%\v㡉*%\xa8Ta\xe6\v\x1e\x8e\xbc\xafP\xfa3)\xb6\xff\xa4DE\x9fU\r\xcfr\xdc@oSqG\xf1\x93\xccA\xd2\xdf'\x95\nF\xb0\x93\n\"\x87A\x8f,\x9a\x87\xac\xec\xc2\xce\xc8\x15n\xb6\xdbٍS,$\xf1\x05U\xee5\xf7\x0fg\x89U}\xc9\x16Uo:-8\xe8\xa5\xcf\xc86\x14\xbeE\xb3\"լ1\xa7RX\xefٯA\xee8\xf1\x196\xb1\xa69#\\\"\xc8O{\x16f\x86\x1ce\x92K\xc0\xa2X \"\xdc\xdd\xdeb\xe8{\xf7\x97Rʐ7\xd543v]\x87\xed\xc5E \xfct&r\xe7C'͎\xadb\xa7Ȭc˖S\x0e\x9a*\xb2o\x87\x17Y\b-\x8f\x0e\xb1\x0e\x18\x88Z\xbf\af\xfaüna\x85b\x06XA)9K)\x05\\\x91\x10\x18\xfa\x1e\xfb\xbb\x9d\v\x9f\xc2m\xb5\"(\xe5o6\x1bl\xb6\xdb\xea&\xb7C\xdd2\x1d\x90\xc8\xdf\x17$\x1a\u007f\x87\x10\xe2A*ǒ\xc0\xf1\x99\x9e\xdb!{]r\xd5\xfa\x14\xc8\xe3r\xc6\x04\x81\x02\xa5!\x94莧\x9f3\x00%\x9dQu\x97/\x10\xad\x80\xbe\xbd\xbe\x0e\na\xca\xf5\x19\xcdol{q\x81\xcbG\x8ft\x99~e̽\xba\x8d\xe2\x10\x8e\xa6C\xb6\x9e\x1e\x18\xa2\xd9\x02*\x1dc\x05\xdf\xf2WV)*Bθ#\xa2\xea\xf9\x10\xb2\xe5\xbfy\x83b\n\xe2\x9d~\xae\x81\x15i\x95\xef\x12z\xb9-Z\xf3\x1f\xee!\u07fc~=}\xb8\xe2\xc5P\xa5\xdbl\xd0m\xb7\x90\xe3\x98Fo\xbc=r\xf6\xb0\x02\xbe\xa5\x1a\x81:`\x0f\xc6!^'\x9b\b.\x9c\x01\xa5\xf8\x97|k\xf7\x86\U000d18e7\asϤ\x86V\xc934\x1c\xfd,\x95\x8f\xa6\xe8zr#\xcf\x04J\xf2\xf1.\xf5[\xc4\x1f%\a\tV\x8cq\x1c}\x87\xf4\a\x00~&`\x96\x15ڋ\x1c\xfa\x1er\x1c]\xa2C\vl \xf6ߛ\xa6A\xd34h\xdb\x16\x8d\xff\xa7\xebе-\x9a\xa6\xd1\xf3&+\x133\xact\u05fe@\x9c\xac\xaf\xa7\"\xef\xc8[\xb9\xb7\x9b\xd8\xe1$k\xb0\xe3s-\xc9T*\x1f\xaaXt\x13_\xf44\x17\xc2Yѵ͉R\x0eS\xa2>\x055\x1fY\x0f\xd2\xc4\t\xcb0\f\xd8n/ \x84\xd0\xed\xef\xfe'\xa6\xd8F*\xdb$f\xeb{kÛ\x95V\x98N\xb9\x1diE\xd2O\xbe\x87|\xcbB#>\x02\x16\xa7\x1ae2\x1d\nKӦ\xcaHm0\x04\x95\xc8Q(\xf2$kCs\u007fk\xc70\f`)\xa1\xe4\xe8\u007frks\x90\xcd\xccZڋhĬ\xeb6\xd8\xe2\x93\xdb\x02\xe4-\xe0\xc95\f&\xa9W0gh4\xd9\xef\xfa$<#\xa8t\xa8\xc5LՂ\x12q\xf6\xf7\x8e\b\x88\x9796\xab=\xcdB\xe8KBx%\x820\x9f\xe0\x98:ȝwb9\xe6\nؚ\x99\xd1\xef\xf6P\xac0\f\xb3U9\xe9^,\x9bǧ,Kj\xdd3g\x0eVư\xa8/\xdcl\x92\xac$%%M\x93\xf4\av\xb8\x1e\x03;\xcf\x16\xbd\xd4(cN9ނ\x1c\x84W\xbe\xef\x98\xedT$\x04\x94-\xca%\x86\xb1\xaaֿE{A\x92\x98\x00P\x95?\xb2R\x90r\x84R\x8cq\x18f\xdcX\"\x0e\xb1(e\xb1\x17\xb64\xc5H\x0e\x00\xad\x1cJ\xb9\xdd\r\xfe@\x8b[a\x92u\xb9\x9c\x0e\x18\xb1r\x11\xe5=\n\x1a君\xb7 \a\xa1\x13\x18\x1e\xcaF\x05+rȊ\xe9E\xaa\xf0ҳ\x1d3\x992\x84\xfd9A`\xd8\xef\x1d\xafo\xa0\nq\x88\x85\x13\xcc$H\xa5\f\xec\x9bN\x92(\x05\xcbU\xc4\xf6\xab\x1e\xe2\x81\xd5\xe1C\xda.h%\xa4\xf9\xb6&\xe25\xab\xf0\xa8B)\x0e\x1dX#\x9aƝ\x96ƹ9\"\xcb-\x81+R\x8d\x18\x86!y93\x0f\x12\xe4\x02+,5A\xefg\x18\ayz\xfc\xff\xc0\x10\x8b\xcf\xf0=\xbc\xb4\xff\xe4{\x9a\u007f\xd0\tWc\x1f\x1a\xfa֙S\xfb+\u07b8\xc2\xc2H\x04K\x85q\xe8\xe3g\x9b\x9e\a\xf1\x99\xe8h\xc1\x92\xfap\xaaR\n\xfd~\x0f@\xd38N\xe7G\x87!\x1a\x85d\xbb\x14\x03'C\xb0%\xb4-\xb1\x86x\xb5b=P\xe58\x85\xb1\xaa\x82\xfa\xcf\x18\xea\x05\xa8$\xc7AT\x8eT]\x81\xd0T<O\x82b\xc6\xfen\x9f\xfc\xfe\x16)\x86w\x84IN\xea5\xf4=\x18pUt)\xa5[\xa9\xbb\x98\x97r\xc4\x15\xef-\xd3\tZ\xee\xb1\xdc(\x98\xacQD\xb4BAu\xdb\x1b\x88\x8a\xa1\xdbd\xfd\xe4HZӷ\xe1E\x87*\x92\x10z\x04\xf6@\xa5;\xe4;\xedf\xe5x\x9c-'wEx\x9e\br\x1c0\x8e#F9\xc6o\xdeZ\x05y\x95~\xe8\xa6\x15$\x95ܘ\xaa#\xe0-\x16\xf1\x8c\x8aXB#\xac\x90f\xf2\f\xca\xe5\x13\x95֍\xb0\xac\x9c)\xe1\xae\xee*\xf5\x0e\xfd\xfb\x10T\x1d\xec\a\xe2\xba\xd8\x01\x89x\xee:f\x82\x1d\x8dP\x04\xab\xd42\x19\xc1\xec3\xa2HB\x00\xd8\xedv`V`9S\xf4\u007f\xfc\xe9\xc7\x1fA\x00\xf8YJ\xc2H\x10\x9a\x04\r)\x80(\xa1\x99\xa7e\x8c\x95\x9d\xb4+\xd0!:po\x1eW~g\xaaU\xa6ƃ|\xef\xfa\xae*\x8a\xc0t\xc2\x1d\x86\\x\xfeq\xe3!\xb9Pz9R\t\xc2q\x9f~\xca\x18\xcaa\xdfCI\xe9\xd2\x02\xef\xf5O\x01l\x04\x80\xff\x98\x8a\xf9s\x96\x9b\x99!\xa5\f.\xddG4\x89\xb0ظH\xb5a\x8ai\x1d_\x1d/ׄEGN1\xfa]\xa7k[a\xdeX\xfe\xf1\x86\xc1\x93Ed\xab4P\x17/\xbea\xae\xfa&\x8e\xf8\x9dc\xc8WI\x8d\xb8&v\xe2\\X\x05\xf9\x12\x80?\x04\xe2\x92픠\xfb\x9a[\x92E>\x01\xd1tj\x01\xe3)`\xe8\x12\xab\xdf\xda\xdd\xee\x0f\xdf\x11$\x98@\xee)\xb9_\xe5A\xaaV\xc2-\x8dQ'T0՚\x12\xf4\x03\xb2\x1e\xe5H\x1bb\x16\x00\xbe\x05p\xed\xdf\xc0\xee\xee\x0e \xa0\xdf\xf7!\xc2#D\xa5\x80\x92A\x11\x16\x0ei\xe5ގ\x80N\xf2D\xab\x01\xb8\xe0\x19j\xad.\xbf-\nrf\x0fq4b\x95\xe9\x86\xf6兓V9^*\x8b\xa9\xe5$\x1a\x11\xa7\xd9\"R\x0e:\x89\xe3\x0f\x16\xd0\x1bn_\xf8?\x95\xe3\xe8\xdaM\x985\x1d\x8a\xe5-\x15Dh\x03wD\xc9<\xc4vT\x1e\xbb\xa5\xa8\x94\x83\xac\x1e\xdb\\hb\\=\x85\xb8\x06\xedz\xdb\xf2\x8f\xda\xfb9 \xccZeT\xfc5\xcfH\xb5\xb2W\x92\xa7\x17\xae\xc8R\xde&\x9e=\v\x007\x00~\x1eZi\xf3F@\xf7\xa7D\xefj\xda\xd6\x10mQR?\x1cki!ѫ^8\u007fl\x8dbMHwh\xff\xd6\xdb$\xfc\x15\xb5 >\xa4s\xfa\x80\xe7\x19x9\x0e!\xaaŅI\x9c\x91;J\xa8M\xa9\xeao\x9c\x80\xc8y\x90O>\xfb\\\x01\xf8\u007f\xfd\x0f\x1b\x87A\x17ꅀ\x1c\x86\xf9 \xbb\x10\xdeބ\x85\xf6\x82\x94Վ);\xd7&ngt\xf5kr\x90\xb7\xd6g\xa4\x1aD\x1f@(\xe6\x13\xa3\xcf\xc6\"b^gZ\xf2#\xb4\xa2\a,\xab\x8cl3\x93/\xfd\x9b\xdd\xedvPRf'\xfft,\x88\x82\x8aLl\x11\xc8\x11\x85\xd5<\x90*\x92\xb0\xf3$\x8dߏH\x89\xcbU\xf0܀\xd2\x1b\x84\xac\xe3\xee=ǀ\x82\xb0\x80\x9d6\xc8\xf3\x9f֤\xfe\xa2\x11\xb9\xb3r\xf3S}\x90\x83H\t9\xe8\xe1\x91YO\x13Q\u07b5\xa6\\\xc9\x11,\x8aY\xcfs\x90\x91\xab\xa3\x18\xfb>*ɽx\x80\xb5\\̨\xa3S\x9aw\xef\xd2\xc1\xdd\xc7\xe48\xdd\xed\ns\xadnJ)\xbdz0qVmJј\x19\xa3\x94\xd8D\xf4\xf03M\xa7ea\xe3\x02\x83;\x97\xaaх\x1e\xac\xb3 (\xbfI\xaf\x18\xee\xbdG\x8f\xc1Q>\xe2\x97\x12ȠT6\xff\x10\x19\xe3<\x13\xbc|\xbc\x9c\t\xbc\xa6\xff\xb2\x1eJe\x80\t\xebA\x1ełx\xf3\xea%H\xe4\x13+\xa0\xae\xb5\xd9\u007f\x18\xb4\x06]8\xa4\x8d\xa1\xc6\"\xd5z\x99sp7\x9d\xe8\x1e\xde\xea\xf02V\xcc(\xc4\x13\x91rP\xe9\xa2k\x040\xd71a\xe6\x91\xc6a\xf0z\xbb\x90U\x90?\b~H\x84\xebW\xaf\xc1J\xe1\xa8!\xd2\\x\xe4ó\x19\xef\xe4\xdc\xeb\xa1t?q\xcet\xa8\xe7x`-$k\xfaŖ\x9e\r\x9dJ\xf1k\x8cI\xe6\xb9\xc4\xff\xdd4\x8d\xa3&\xa5TE\xba\"\xb2\xa7(\xb9\x0f\x98\x17\x1d\xe2E\x90r\xc48\x8cV]R\xf7\xcd\xe2ӏ?\xdaB\xf7\x9d\x04\xaf~\xb7Ӆ\xc2\f\xd2d\x8b\x86\xc5?)\xe5O\xed\xc0\x8eܛ\x9f\x98\xf9L\xed\\!\x149n%:\xc1C>7\x8a\xf3\xa6<ɱ\x9f_M\x92\x91\x1b\xdd\xf6\xe4\x85\xc8\xc3ls\x11T\x9e\x80+\x93\xa8{Kh\xbdk\x90\x83\x9c\x94#b\x11\xf5\x93\xf4\xce\xcbE\xdc\xd7(\xa5\xf0\xfa\xe5\v}\xed\t\x05i\x16z\xa4\x82\xcb4\xdb{\x02\xd6\xc1\xdc\xccF\xe6\xe0Jۨ\x96\x98\x1dO.Nц\xa3\xfbfs?\xd5wq\xcd=搮U\xe1L\xc6\b\"\x1c\xd3\x0e\x00!N\x8b\xfb\xdcS$b,\xcfK\xcc\x16\xc3z\x9c\xbdJI=y(m\u007f\xe1\xfc\xd2E\xe9\xe0\xae_\xbe\x02\x93\x1e\xa1\x9d\t\u007f\xa4 \x8b\xe1\xa0Xa\x89\x8f\x1d@:\x84L\xe0@\xef\xe0V\r?\xc0\x95\a5\xfb\x1a\xef\xc5Krz\xec:5_\xee\bC(\"\U00068afa\xad\xb8\x1f3\x13\xa4\xb4\x9b\xb0\xe3\x1b\xf1o\xb5\xa5ø\xdb\xed G\tժ\xd9\\\xae\x10\xa2z\x11'\x910\xad\xc7\x15\x87\xef'\xd3k\x19ArI\xfdʞ/\x173\x03n\xf2\xb1j\x8eZ\xa9t\by\xc6\x10\x88+\x17\xfc\xac\xf6 +\x01\x12\xf2\xe9g\x97ƒ\xe3|4\xa3\xb8\x14\xff\x90\x0f\xd1l\xccV%L\x93\xaez\xb32\x0f\f9\fY\x14\xab\x8dC,\xc7Bb:{9\xe1~\xa8\xa6\xa57\x96\xcfR\xe10~p'ܤzLr\xbej\xf3\xad\tM\xefs7ȩ\x17\u007f\xf2\x1bȱRk\xf2\xc2j\t\x87\xd5\xc1\x15\xe7\xc2\trR\x9fz\x8b\x18\x9a\xf6\x87\xf3(\xd6\a\x00\xdeO{!\x86\x92\xd2-\x179$\x99\vH\x89+\xc55\x97\xa7p*?9\x15\xbd\xceʖ\x12.\xe4E\xe7b\xbb\xe7\xda!\xafS~\xe7\x91\xe1\x14\x97>ǯ^S̕\x18\x8f9Ԅ\xc9S\xceA \xaf\xa705v\xc5N\x814\xdd\x0fgs\x90g\xf0\b\xac\xe7a\x06\xcd,\u007f5ML\xd0E&L\xf5\xb2Ҫ\xf3\x02]\xd0)\xd8E*Z_\x8a\xa4\xcc\x05Z\xcbS\xe7$9t\xee(\xa5\xa9\xdcͲ\xca\xc8\xd4\xf0f\xd5\xe4\x9a\x15i\x06E\xff\x97\xfb\xc2\xdc\xf2%ͩ\xd0@ɬǧ\xec\n\xe8@\x1d\x92Ut\xf2\x94\xa0\xec\x0e\xc8\x0f \xb9R\x80W\x90%\xa4֭\x1dC.\xb7z\xb3U\x06\xdf?z\x89OA\x19|&\xf4c\xba\x90O\xe9{(\n\x8f9\x81~Q\x14F\xcf\f\x8d\xa1W\xa7\xec\xd9/W\xd1\x03\n[Z~\xfeR\xc9\xec#hs\x0225\x85姈\x89=\xfay*x\x91\x04\xfd\x0f\x1f(0\x94\x99*\xac\xa2\xac\xacLF\tzQ\xf6\xc1\xc8W\f\x95\xae\x15\xe0\nJ\xa4s\x85q\xb9\xf0\xf8\xa0\xb3L%\xfa\x85\xf3\b\x95bE\x89\x9a\x8eK:\xc7a\xcc\xed\xaaɠX\xde\x01\xb5m\x97\xd6f6\x01\x1a\x9f\x06\x1a\xa4\x84\x82\xae\x11LJ\xd0\xf8Ty\xa0C\xa8gP\xc1\x9cb\xac\xa3B\x1d\xc2\x1d_\x1b\xa5\xbc\b\x11`(\x96Ƞet\n\xf8\xf5\\;\n\v\x88\xa2_\U000e0641\xa3#\x11\x96\x05Yt\xadO\xdax\xab<\xa8\x92\xae\x83؏\xea\xba\x0em\u05ed\x8eϧ$\x1f\x95\xc9Ue|\u007fh\xeb\xc9!\xb1w%\x9b\xc9\xe2\xe7\x1c\x89h\xf9-9\xa4\x14\xc6qԣ\b\x85\xb3\xa0R\xc7u\xe9\x1eN1\xc6|\x04\x03#\x11U\xe7\x1fu\xf9o&\xcf1?cSY/\xa0\x8eT4nm\xd79M\xa7\xcaD)H\x9a(=\x90\x14\xdc\xff\x8a6\xf4\xa5$\xbd\xba\xa5\xbdP\x95_#\xb4gA\x81J\x9f#\x04\xba\xcd\x06\xa2m\xab\x85\xad<\x95w\x9a\x8d^\x88\xf2\x8a\xd9\xe7/\x16,\xa7$\xfbd\x905Uܣ\tSe\xc1\x88\x15\x15\xe4\xf2\xd1U:\x06-\xeef\xc8k?\xcd\xd0\vJ\xc7\xdb\x05\x01\xbc\x8fZ5\xd5Z\xba\xca\xf0☺H*\xd7X\xb3\xdb\xf1\x10.a^g-N{\xe0G~\x1e\x05u\x94\xf2\xf3!Ӯ\xcek\x93t{\xa1\x17\x97[\x97\x843c\xd6\xeeR!Ie\xa1\"\xbbg.A\xfb\x19侀9\xc6\t\x0fq\xc9\xea\xd31\x8a\xe9qa\xae\xed$^l\x11YQ\u007f\xaaI\xb4㳏w\xb6P\t\x04\x89\x1a\x10\xf9@\xfd\xb0\x13\xaa>-l\xf2\x9eP\xd7xJ&\x0f̊\xa9\t\xaf\x14g?\xb1\a0\xb6\x00v\x00F\xf8,\xef\xce=\xebڅf\xa1\xe3D\xf1&Վ@\xb3\u007f[\\]\xb6\xd0\xceqTL|\"/\xe2\xee\xa5\xc2z\a]\x06\xb5\x8d~o\xf0E\x85\xa9M\x8a\x84\x9f\xaa\x84\x9d\x8a\x8d\x8b\xb4(\xdc\x00*g\xa2\xb2\xbfS\xb9GD\x88l\xb4\xf2g\x9f|\xf6\xf9^\x00\xf8\x1a\x11\xedO|5J)\xa8D\xbb\x89\xa0\xa8\xaf2\"\x95\xabv\x99\x1ew\xd1*\xeb\x1a\t_\xd5\x18\xe7\xda\xfa\x88\xcfv_\xa9\x1c\xb6k\xd9>(^\x11f\x9de\xdf|D\x8fD\xf1<N\xad\xe7\xaa\xf4\x101\xf7\x14/\\\x17#M\xecv\x8a\xbc\x8d\x17\xa0HB\x96b\xf7om\x0e\xf2\x12\xc0\xebd\x82b\xdeX\xc2\xdc\x17;=\x0e\xb5\xa0\x9cf\xd1\xe3\\ghe\\L\xc7\\G\x8dpX%\xf2\xdc{\xad`\x9dZ9\x96r\x15>\xe0^\tU\x1b:fB1{\x0f\xe5\x8b\x17L\xebC\xb5\x80\x1f\x8d+\x9e\xb7Weo\xbb.u\xf6\x12\x00\xc4'\x9f}~\v\xe0/S_\xd8v\x9b\xe9\xcb\x0f\xe8\x92=F\xd0j\n\u007ft\xcc\xfe\xc2\xda>\xae\x15\xc4t\xb9Ş\xa7\x1c\x1d>i\xbe\xe5\xed\x80<\xf5Zn\xa2u\x9bg\xfd\xf9\x0er\xd1I\xa5\x17\xa449\x02U\b\x98h\x1al6\x9b\xac\nY\x13\xf3\xef\xe7֧A#<6\xecU\x82\x98\x9f\n\xab\rm\xce-NE\x02m\xaf\x80F+\xd8:\xf4\"\xfaî\\\xddc\ap\xd6\xe3d\xd8\xfc\xef\xe75ok\xa4\x03\x94w\r\x1aG\x86\"\xb7\xdb&\x15\xe4\xdaW\x90\xeb\xf9a\x89\to/\xd6\x17RԊ\bY\xc0H\x98\x92\xcbr\x05\xa8&\xb1\xe7\x03Z\xd1S#\xbb\xa9\xf9e?\xdfX\xf3`\x94ٖ\x9a\n\x95h\xa1R\x9db\x1d\xbf\x0f\x0f\x92\xecж\xa3ԥX\xba\xfa\\\xe6\xd3|Ů\xf0\xe0\xf3\xe9\xf4F0\xa5B\f4\xdd&\xf5\xbb\xff\x97\xaf 7\xf1\xdf6m\xa39xkr\x88\x83\x86\xbc(\xbb\x19v\xf1c\xd6\f\xf2\xc49\xcc\x01\x96\xa6VAԨ\x1c\xcfk\x8d\x82\xc6\xf9ݹ\x94dM\xdd$\x1b&\x1e\x12^\xd3ʳ\xf6G\x99\xef\x89s\xa5i\x1a\x80\x15\xda0\xcc\xda\xc3\xd0\U00076fb6\xc4\xe2KB\xa4s\x8f\xe8\x10\xf2\xbcV\x86\x98+\xdb#E\xe9*\xb7\xb5b\xb9\xc66O\x91j`\xc3X\x00N~\xf4D\xe8{KpQNɗv\x8f\xdb\xdf95\x9c-\x84\x98\xb5\xcc\x1f\xbd\x90\xb4\xb2\x87\xab\xb6xG GyE\xdeZ\xbe\xc3r^\xef\x1b\xf3\xa3\xac`0ڮ\x8b\x8d\x88rI\xba\xf9\xc1σ\xeb7\x96L4\x02\xec\x91yU\x87Y\x9er\xb8\x03\xe2\xda\xc0\xb0\xdcr@D\xa0\xa6\xd17\xb4\x10\x069\x02\xe4\x13\xad\b\xcb\n\x1f\x11n_\xbf\x86\x94\xea\xad y\xcf\xc5\xf6\xa7VJ]\xa4\xacL\xee\x13CQ4\v\xbbʁK\xf0g\xa9\xcc@~8/4!;\xf3\ft\xf3\xa9G\xf7\xb1\x02:/B\x94I\u089c\x83\xcc~j\xa5\xc0\xac\xe6\xdbrW\xe0\xac\xecy\x0f?F\xb7\xab\x18\xb2\xe8K\xa1zOgP\x0ef\xc60\f\xd8\xef\xf6&\xa6=b/{\xc5\xcfO\xa1\x185\xcar\x8c7\r\xd1\xc2\xe5苲!(\x1dNװ\x94+1\x01\xc4\x10Ԁ\bh\xdb\xd6\xff\xcc\xff'N\xd2\xe71\xf50B)\x86h\x1a\x88\xb6M\x17\xc9\xc8T\"\xbd\x1b\xb5Lu!\x11\x17\xad\n\xf6W%\x96k\xe0\xc4s\x98w\"\xf4\xfb\x1eR\x8e3ښ\xa3q\x9d3A\xc3'\xefFȜsiG9\x1f\xfa\xd9'\x8a\x04\\yR\xe8\xd5\a\xa2i\xfc\xef\xfa\xf3O>\xfb|\xef\xe7 \xb3\xd78\x0e\x18\xfb\x1e\xed\xa6\xcb^\xa4\x8d\xdb,7\x94k\x1d\xee\x0e\xf3\x1a\xa5\x98\xdcN\xa7\xa9\xa6A\x1b)+g\x86\x9ef\av\xa4`\xe4P\xb0~\xbf3\xd7h*\xe6\x953\xd4U۫ΐ\x8f\xe4\x14\xe5\xd4ۨl\xbd\xc9'\xff˒\xc7%Ζ\t\x87\x8fV\xd7|\x8f\t\x01\x19\xa4\xe5|z\xb9-\xb5\"\xf7\xfc\xb5\xd0K\b!\xd0V\xe0\xe3D\x84\xb1\x1f\x82e\x88\a\xe3שC1m\xc9\xfb\xbb\xbb\xa9\xadڮ\xf3Mx\xb7\xb5\xcb?\x8f\xb1\xf2c?\x80\x95>\xafլ\x9d\x95\xdfq\x8e\xf9\xf6\x1c\x1awr%\xcc$\xf3\x9cad\xa4\xdc٬\x82\x9a\xeb\xf6\x83\b\xb2\x9b\xd3\xd2\xf2\"R\x82\xe8\xf2i\xc5\x10\xa2Y, \xd9dlT\xf9A\x9eR\xac\xbb\x14[\xda\aڈF\u007f\x97T\xe14\x9c\x9b\xcf^P\xcc\x1cd\x9d\xf8y\xdc\x1aQ\x12\xcfq\xd4K\x86\xc6a\x04'\x86u\xb9r7\xf8}(J\xf1ZN\x19\xd2U\xd4RBn\x03/G\xb2\xcft!I\xcf\xc9Q\xedɒ\xf1\u03a2\x11`\x95\xf6\xd4b\xe9&\x85\x10U;\x1d\x94\x1cC\x9a\xc75\xe8QM2M\x84\xa6m\xf4\x18+\xab\x8c\xbb\xe4\xec\x8e\xf6Y\xc7j\x05\xa5fMߑU\f\x805\x87\x18\xe7\x1b\x13\xf9@v\x98s{\x0e\xbb\xda\xfbd\xe1\x9c?\x90fe(\xda\x10EH\x8c\x13/\x19\xb2\x82RP1\xbc.\x85Xld+\xc86\\\xf3n\x9bz\x93\xa5\x16\xd5\b\x96Wi(,\xbfT\x89}\x84\xb9\x1a\xc5l\x8bP\xbc\x1e!\xa5\xc9\xee\x90i\x8a\xf3\x13\x9f\xca\a\r\x81\xcfϳ\xb6\xc1PIi:\x9d\t\xc3~\x0f(\x05nD6\xe59\x98e>#\xe8)\x96ŵ\xb9E\x8a[\xd8\xf7\xf6\xfe\xdfU\xadk\x8e\xe608j\xfd\xe78b\x89{\xfd\x12s)\xab<\x15GO\xb0\x84\xe3\b\x01\xa5$\x9a\xc6Չ$Lo\xe2\xa7\x1f\u007f\x94\x17%a\xc6;\xa5ZHnY\x87b\x96\xae\xa5\x96\x8f\x89J\xd5\xd9\xccg\b\x93\xfcr\U00081e8bɶzԆ(k\x06\u007f\xc6qt\xc4\xc7RJ\xec\xfb\xde\xf0,ݏ\u007f\xe0D2\xea\xc3\xe25\xc3R\xbc\xb07^\x18h]T\xb7߄6\x9d\xa0\xc9\xceg\xe3\x06\xa5Щ4*{\"\xb2@\x82Fa\x1b\xd1@\xb1#G\xec\x01\xfc\x1a\x00>\xf9\xecs\xe7A\x9a\xc0\xee\x12a\xb3ݢ\xed\xda\tI\xf1/\xda\xc1x\xac\x95\x83\x8c\x92\x18k$j\xe7&L\x01\x91QAG\xea\xac#te>\x83HLEɰ\x8e\x92\x14\xaa\x14\xdf\xd7\xca\x04t\x18\x06\xf8\x9da\xfb\xdb\xdb\\w\xe8ِ\xa9\\bMG,\x01\xcaqn\xd9\xe7\x96\xfa\xce\xf9\xfc^\xb8\xf4\x99\x84\x00\xa4\xcc7\xbe\xfan\xf7(\xb6\x16hƐ\xcaU\xca$\x82t@«\tZI\xfe-\x00['hJ\xe1\xea\xc9\x13\x97\x14\xe7xx\x9dr\x98؟\x99u\x02\xbdR\x8d\xe3\x01\x9e܄\x1b\x13A;+.\xee\xff`\xce.D\t\x1f\xf4\x11\t\xb3\xfd\x9e\xeb\x97/\x1ds\t3co\xf2\x90\x87PP\xaf\xf1\x96\xa5\xd5\r\xa5\xf7'\x8d`\x16\xde\xf6\x12\xf1\x04\xe0C5\xb0\xed\x9a\xfb\xae\x9a\a\aH4f\x98M\xd7\xfb\xb8\xb0\xa3\xf0Oc\x81\xfc\xc1\x8f?\xcc&\xbcF;B\x81T\n`@A\x937/\xc1\xac\xb1uZZ\xc2\xc2~l\xa9\x14\x143\x9a\x85\xdaF\xca\xfa\xb9ﵱ\xb0GF\xb7VA\x14\xeb\r\\\x83Y\x95MD\x18lE=\xf1\xbd\xe7@\x8cj\xe7\xcdS\xaf\xa1\uf8585\x8c\x9f8˔\x17\t\xf2\x12?w \xca9\x8e\x99\u007fn\x848\nN^\x1c\xa5\xadL\xf4\xdaM\x87\xb6k1\xf6C\x96\xa6\xceJ\xf13\xffP\xde\xfb\xe0\x87x\xf4\xf8\xaa8\x0f\xa18\x11\xc7\x1a\x03\x92YF2ǩk\xda\x1c\xfc\xbcD\xa9\tY\x8a\x0f8J*\x93\x1e\xc2XKe\x14\xcc\xfd\xb7\x15\x84\x95\x0f\x83@x\xefG\x1f\xa0i[\xc8q\xc48\x8e\xb8\xbd\xbd5\xab\xeb*C\xcc3{\x88\xd2k\xd8\xef!\xed^\x8c\xecs\xce\xe7'\xe2\x80p(\xd5_\xb5\x84\x14V\xb7\x98\xb8\xcfE\xf5td\xd7mt\r/\xdc0ű\x824\xbe\xeb\xfc\xc1\x87\x1f\x02<\x9f\xea\xcaƼ̐\xa3t\x9f\xac\x8c\x80\x94'\x01\xd7\xc5\xfaD\x04\xe9\xf1\xd6sĹ4\xf5\xfdL\xb1'Gp.\x17L\x8e\xf5\x82\xc4Qͦp\xa1\x8a\x19M\xdb\xe2\xd9{\xef\xba\xf7\x8f}\x8fW/^̪!\xbeB\xd0\x12\xdb\xcb\x19^\xa9\t\xbc\xbe\xef\xd1\xef\xf7\xcb\x15ic<\x92[\x87\x97\xc8o\xa9\xe0\xdd\xfd\xf2\x01e\xfa\xae\x96\xd6\xfc\xe5\x12\xf6%\xa3\x1b{\xbc)\"\xea\x01|\x1b+\xc8\a\xf606\xdb\r.\x1f=\x8aqذt\x1f\x1f6\x91n\xb70nE,\xb8\xd0C-\x1f\x1b\xcbo\xf9\xb4r\xa1\x98͇\x16\xf3\x8b\x04\xaa\xc2f\xe5\xdc\xec!e\xb0~f\xc6\xf6\xe22P\x9a\x97\xcf_`\xec\xfb\x83\x13\xe7\xfbz\r\xfb=\uebaf\x17\x9f\x87O\xacF\xa7\xd3\xd8Ă\xce3\xac\xb4(|&3\xa3\xef\xf7.\\4\xe7\xf0\x1a\xc0W\x9f~\xfc\x91\x96\xe5O?\xfeH\x00\xf8\xe7\xf6 \x9e<{G\x17Mh\x85p+\x85\xbb\xdb;\x8cC\xef!J\xbcX`+Y\xb7\xe4\x83\x1aG\xdc]\xdfd!\xc1i'\xc4䶫\xa0\xdd\b$\xe0h\x16ainb\xb7\xbb\x9b`U\xa5pw\xfd\x1a\xb777\xf7\xee\x15\xaag\xb6=\x88z\xbf\xdb\xe1\xee\xfazQqc%\xa1\xb5\n\x13%\xce\xe74\x13\x04TQ\xc7\xeew{\x97\x83\xcaQ\xda\xe7\xfc\x1c\xc0\xf8\xc9g\x9f;\x0fr\t\xe0GV\xaa\x9a\xb6\x9d\xc7\xef\xc5=\x0e\xa4g\xb1\xa5ī\x17/!\xc7Q\xf3\x1a\xd9P\x8cO\aw\x8e\xa3\xc4\xf5\xabWi\xe4Ń\x8e\x18\x1c&I5+\xd8\x12\xf14W\xb8i\x96\x12/\xbf}\xee\xa0O\x06cT\x12\xc30\xe0!\xbf\xd8\xe4\x8ǎo\u007f\xfdk\f\x19\x8f\xe7?\xabT>\xc2\xd5\x12\x1b>\x8b7\xbeϑ\x19\xbb\xbb[w\x81\xe38$\x95\xd6*\xc8v\x02\x85\xf3\x89W\x8erGJ\xa9\x0f\x8fY\xefW\x9f\x92\x00(%O\x96h\x8e}o\xd6S\xef\x92\xed\x1c\x0e9c\x80m\xc10S\x98\xf2\x93\xc5Ҿ\xf6b\xf2(\x04\xbe\xfe\xe2K\xdd@ɓ\xebjD\x8b\xd6$\ued5e\xe0M\xbe\x94R\xf8ꗿLr\x9f-\xe5\x9eo\xf2\x95\xf4\x94\x85\x81\xbb\x98\x11R\x8d\xa37y\x98F1\x05\x80\x1f\x00x\xaf\xe6\x81\xe5&\xd0XMU[i\xd8\xc7\x03\xcbS\xc8G\xd6\bG\xbf\xd7.\xf1\xe6\xd5\xeb\xe4V \n\x1e\xa6ޱ8\x9bIYe\xf9\xca\xd7\xf6\xea\xf9s|\xf7\xf5\xd73\xb4\x87H\xe7aR\xcaj%y\x13/a\xe3nf\f\xfb=\xbe\xfe\xf2\xcb\nh;j;\xa9U\x12\x8e\x00\x91#\xd6J;\xc29\xfb9\xcak^\xcd\x140\xe35\xd2RJ\x8c\xa3t\xebts \xbf\x00\xf0d\xf2 uZ+\xa2\xb8\xd6v\xb0\xba\x18\xfc\xf6v\x96\f\x05aQ\xaeϧ\xe0Q\xe48j\x97H\x84~\xb7\xc3\xed\xedM\xba\u007fHD\xdfk{\xa5XM\x88\x8c\xc7Zb\x87\x9c\xfc|\xc5\xfeY*\xa2\xbd\xf8\xf6y\x80\xa6\xf9\xefSR\xb9\x87X\x83\xf9߷\x17\x11\xa6AϞ\x11\x00ܽ~\x8do\xbf\xfajы\xb0\x0f\xcd\xd64\xa5\xc6ss\x1e\xf2Xʙ\xf6\xbb\xbd\x9b3\x8a\xc1\x1a)\xa5\x86\xe9\rp#\x95\x82\x1aGS\x8f\xe3Y\xff\x98{6̸\xbd\xb9\xc6\xcb\xe7\xcfMtc8\x10\nu\x90U\xb3M\xa9\xe4\x8ce\xc8\xcaq{}\xa3\x1b\xf7(\xaa\x95\xa8IH\xfdj\xb7R\xcam\x87\xcd\xf5\x10\xedw{\x97H1\x11n_\xdf$\x85\xb7\x11M\xa4p\x80k\x15\xb3\xf4\xa1'\xeaX\x1d\x87\xc1\xa1\\~>\xa5\x94\u00ad\x97\xf8\xf2\x03\xe5\xe6m\xbbNC\xd3^-\xe4\xe6\xf5k=\x15\xba\xe0E8\x15n\xa5:\xa4\xa3\u007fw5*\xaf\xbeeeC)\x05\x96\x12\xca\xc0\xe5\xfd~\x97e\xff\x8f\xbf\xcb\x0f\x99\xb9\xe0\x85X)\xf4\xbb\xde\xedXѿ\xa2\xd0u]VA\xb0FArh\x88\xbd\x10-\xec\x12/\x9f\u007f\a5J\xc4\x1d\xfaΚfr\x9c\u0602\xdbk\xba}\xfdJ\xa7\xdf\xee0e\xb2 I\x82\xd0m:\xf3g\xe3\xfe]\xb4\xcdIGnه\x86\xbdڌ}\xf8/\xbf\xfb.\xac1<@%\xd9n\xb7&OT\x90\xc3\xe8\x04f\t`XZP\x1axc\x03\x9a(9\xba\xf0[\x1a%\x90f\x83\xb2\xfb<Kbn\x10&=\xe7\x9f\xf1\x80\x89N\x8dY\xc1\x91\xe7\x12\xdd\xef\xf7\x18Go':\x87\xe0Ԣ\x82`\x02L\vu\x06?\xa4\xd4s\x10\xe4i\xb5\x1a5\x8a\xb3\xbb\xdb\xcdx\xa2l\x15\xbc\x96BK)\x85aԟ\xe5\xa2\x19\xa5\x00U\x88cO\x89\xa5\x17\xa4\x80Mcf\xea2\x86}\x8f_\xff\xea\v\xc8~H'\xb8\x0f \xccR\xd0\xf0&\x18\x18\xe5\xa8\v\xbc~\x85|\x85\xb1`\xa4+\xe2\xac\x14\xa4e\x9c\xf4\x8a\x8eH\xc8\x00\x03x\xfd\xfa5~\xf9w\u007f\x87o\xbe\xfc\x12\xfdn\x97\a\x0e\f\xbb\xcdrD0\x85\xc0\xb7\xd7\u05f8~\xf9ң=\x9a\x92\x12K\b2\xf3\xb2\x00\xae|Ear@iU\x12\xdb\xdf\xed0\f\xfd\xfc`\xec\x8d1G\x03\x16\fi\xac\x96\xbd\xa0b\xfd\x83\x009\x8c\x93\xe5\x02CJ\x05E+-\xf2\tf\xd2\x03\x05\xe8\xf7Sh\xe2\xe7!\xe6~\x14\xeb0\xeb믾\u008f~\xfb\xb7Иf\xb8\x9a\xb5hn\xc5Z\x05Pp \xfc\x83\x17\xdf|\x83\xaf~\xf1\xf7.\x0e\ak\xa5\xee\xb6۪\rVA'C\xb4Ֆ\xbd\xd0\xc9\x17Әeq\u008f\xb4\x01\xf9\xe6˯p{}\xed\xfez\xe8{\xf4}\x8fG\x9bM\x9e\x1f\xcb;'r22\x87b\xf6\xfb\xbd\xaeM\xd9\xf7*\x06\t\xd2\x05t\x93\xa4\x13\xfc>\x8cIA\xfed\n\xe1t\x9b{m\xfc}\xfd\xfa\x1a7\xaf^e!!f\xe5\xacR\\\x99g_\x89\xbc\x1bt\x94>\xeeg\x04Ѵa\xc3\x1f+\xa8Q\x96GkK??\x81\xd0=\xff\xf5\xd7PR\xbaqd9\f`\"\xdd\xf8g[9\xf6{ܼz\x85\xfd{\xef\xe1\xea\xc9\xe3*\x05!\x9fe\x11\x99\xfd\xe2k\x91\x9f\xe8}c\xdf\xe3˿\xff\xa5\xb6\xec\xe4ϑ(\xecoo\xb5\xd5\xce\xc4\xe4\xceP\x8d\xa3\x85\xeb\x8eϳ\x88\xf0\xea\xbb\xef\x9crL)\x8d\xc2\xed\xf5\r\x1e]]\xe5\x8d]\x02\xca\x1f\xfb\x1eMӂ\x04\x05r\xb3\xbd\xb8\xc0\xd0\xf7\x10m\x8b\xaem\xd1m6x\xf9\xfc;w\x1f\xe6\xf7(V\x90\xff\xde\xfe\xc7\xe5\xd5\x15../a\xf7>ͦ\xfeL+\xfc\xed\xf55n\xaf\xaf\xb1\xdf\xed\xb5P\xcf\xc8Ĩ\x00\x00\v\x99IDAT\x9bnP?'PJaws\x8b\xcb\xf8\xe6\x10Z\x0e\xe7\xaf\x14\x9b\x1cE;j!\b\xccz^\xb8\xddt ;\xf1e\xf6\xac\x8fð\xf8 \x0f\xf5&\x1407\xb23+\xd6\t~\xfbŗ\xf8\xee\x9bo\xd0\x18\xe1PR\xe2Ջ\x17h\x9a\x06\x97\x8f\x1f\aF\xe6\xee\xf6\x16_\u007f\xf1\x05\xba\xed\xef`\xbb\xddV)\x89\xf0\xe6-\xd8;+\xfb\xa0ő\n~ws\x8bq\xbf7\xf3\x19\xca+\x88\xeap\xf9W?\xfb\xff\xf0\x93\xdf\xfd]l\xb6\x9b\xe4\x8a\x04\xe5\xb1\xc2\a\x1c\x06Уא\fj\xc4\"\x97\x01{9\xec\xf5\xebT\xcb\v\xe3\xe6\xd5+<y\xf6\x14ۋ\x8b\xf43L\x94\x1etnø\xbc\xbcp\x1d\xc7\x17\x97\x97\xb8\xb8\xbc\f\xce_\x88\x06\xaf_\xbd\x82\x1cG\xbf\x92>\xf3 \x1f9\x05y\xf4ȸ\xf6p\x04\xb6\xef{\f\xfd\x00\x12\x9a\xb9d\xbf߹\x04\xca>\xbc\xcdŅN\x80\xa4tVp\xb7\xdb\xe1\xfa\xe5+\\^=r+\x8c\xfb\xbe\xc7\xfe\xf6\x0eR\x8e\x10M\x8b\xcdv\x83\xa6\xebА\x00\x84W\xecaF\xbfﱻ\xbdE\xdbu\x18\xf7}\x00+*\xa5\xf0\xeb_\xfd\n?\xfd\xdd\xdf;\xbaoA+\xa8\xb6X\xe38b\xe8{\xc8QB\xca\x11MӢ\xe9Zݢ-%^}\xf7\x02\xdf}\xf3\x8d\xbbN9\x8e\xb8\xbb\xb91\xb8\xba\x8e\xe3\xc5;\xef\xe8\xed\xc0\xe6\xf5\xe2\xf9s\xdc\xdd\xde\xe0\xbd\x0f>\xc0{?\xfc\xa1y؆&\xc8[+\x17w!\xdbJ\xb7\x10\r\x9aF\x04(R\xd0\xc8\xe7\x92\"/\xc7s\r\xa1!\x85\xab\x94\x12\xaf_\xbc\xf0\x14\x9e\xa1\xa4\x19rcm\xd8n^\xbd\xc2\xcf\xfe\xfa\xaf\xf1\xe1\u007f\xf2S\\=~\x1c\xec\x89\xf1\xa1r8Yѹ\xcc\xf5\xab\x97\xd8\xdf\xed\xc0\xacж\xad1\x16\x17\xda@7\xcdl\xb1\x8e\x95\xb1q\x18u\xefZ\xc2\vI9\xe2\xeb/\xbeď~\xf2\xdb\xe8\xe2P+\xf3z\xf4\xf8q\xe8\xd5\xfcFQx\x9d\xe0\x04\xb4B` `w{k\xcf\u007f\xe6A\x84F\u007fļ\a\xcb\xd4\x1c\xeenn!\xa5fK\x94rD\xbf\xef\x03\xfc\xdb\xde|\xd7u.\x86\x16\x00D\xdbb\xbf\xdba\xbf\xbb\xc30\x8c\xaep\xe7ø\xb7\xd7\x14\xe6!\x82\x1c>\xab\x16В\xd7\u07fd\xc0W\xdd\xdf\xe3\x87.\xc67$\xf2v{\x90\xa1t\x81\a\xbf\x02\f9\x8c.\xbe\x1d\xfa\xc1\xb5}K)1\f\xbdFXF9%\x88&q\xb58\xba=\xa2QJ\x8c}\xaf\xeb>\xe6\x1e\xc6q\xc4\xeb\x97/\xf1\xce\xfb\xef{\x96J`\xe8\a|\xf5\xcb_\xe1\xd5w/\xf0\xc3\x0f?\xc4f\xbb\xc5\xd5S-|V\xa0\xa1\x14n\x8cw\xbe\xbb\xb9E\xbf\xdf\xeb\xb3l\x1b\\=\xba\xc2\xfb?\xfe\x10ۋ\v\r\x83\x0e=\x86}\x8f\xdd~\x8f~w\x87\xb1\x1f\xb5\x82\x0f\xba\xed\x9e\xcc\xf9?z|\x85\xab'O \x84\u0381\xeen\xae\xf1\xe2\xf9s/\xf4 \xb0\xd2h\x92\xdfm\xbc\xdf\xed\xf0\x8b\xff\xf0\xb7xt\xf5\b\x17W\x8f\xd1v-\xd48b{\xf9\b\x8f\x9f>\x814\x14G\xba\xc1Z\xe1Ջ\x97\xb8\xbb\xbe\xc6\xd0\x0f`%\xa1X\x19C\xa2Gu\xb7\x97\x17\xb8z\xfa\x14]\xb7\xc1\xd0ﱻ\xbbӵ\"A\x8e2\xc9\x1f\x02\x9c\xba\xb3u#\xec\x97?\xff\x05\xde\xf9\xe1\x0f\xf0\xf4\xddw\x9dl\xd0R~T\x83\xc0J\x89q\x18\xb0\xbb\xbdK\xd2\x1b\xb6A\xb5QP\xc0\x0f\xd4\xf7=non\x9c5\x05\t\xc81,\xf85B\xb8P\x83\xa1)\x1ca\x93<s#\xc30\x02P\xae\x1d%u3\xee3\xe5\x8a\xd0U\b|\xf7\xed7\x90J\xe1\xe9;\xcf\xdcT\x18\x83!\a\xa9=\xda0`\x18zc٦\xa5\x96lB9+$p\xa3\x04\x02*\x9ek\xf7\xfa\x90\xfc\xf6\x139\x8e\xb8y\xfdz\x067\x8fÀ\xbb\xebk\\^]\x05\xed\xda\x04\xe0\xee\xe6\x06_\xfe\xe2\xe7\x10M\x83\xb6\xdd\xe0\x9d\x1f\xbc\x87n\xb3\xc5\xcd\xf5k'`\x8am=\xc0\x9c͝\xc2\xdd\xebk\xbc|\xf1\x02ݦC\xbf\xd78\xbe-\xa2\xa9\xc2\f\xfe\xf5˗h\x9a\x06\x9b\x8b-X)}\x0e\x01ؗ^\xf0c-\xed\xcd\xebkܾ\x9er\x03\xd14.\xa4\xf6\xc3Of\x05\u007fr\xd05{\x92rI\xf2\xeb\x17/\x1dE\x14#\x9c\xbf\xf9\xffۻ\x96ܸ\x8d \xfa\xd8M\xcep${l+v~@\xe2 \x01\x02d!\xe4\b9A\x0e\xa0\v\xe4\f:\x82n\x90}6\xda\x05Y\xe6\x06\xd99\v!H\xb2\x88\x01;\t\xec\xd8\xd2|\xc4\xe1\xa7\xd9lv\x16]\xddlΐ#\x8e,\x036`\x02\x82\xa0\x81f\x86\xac\ueaaez\xf5\xaa\xca\xc4\v\xaccx+h\xdf\xe5x\xfe\xf4o\xcc\xfe{\x81\x83\xf7\x1f \xde\xdbscʯ\x1b\xf7TR\"\xcf2\x14Y\x0eQ\n\xfbY\xf7\x00\x8c6\x14\xc4\u038b\xb6-\x18k\xa5P\xa4\x19jU\xbb\x8a9\xc0\x9c\"\xad\x99n\x96\xa4ף\xb5\xd6j\xfb\xbe\xbd\x897\xae\x1f\xd4\xf9\x81\x97\xd6\xc0r6C\xb2X\x90\x82\xd4d\x91\xea\xc1\x90j\xab&K\xab\xabc\x14R\x8e\xd5\xe5%DQt\xfe\x8f\x10\x02\xa38\x06\xe3\x1c\x91\xdfa\x90Z\x95\x9a\xbbϱ\xba\\z\xa0\x04\x03\xe7\x81)\x05\xed(-.\x85pt\x9b\xad\xb9+?yF\xf4\x9f|\xd5Q\x18E\xb5\xdb,\bz\v\xa3\f\xb2\xd9l|K\xc8D\x0f\x16\xe0*\r}%A\xbb\xa1\xc4\xfa\b\xf1\x00hwc\xf7\x89\xa7\x06\xcdi\xe4\x9a\xe7x\xf6\xe4)\x18c؟N\xf1\xd1\xc3Ow\f?\x9b\xf8i1\x9bA\n\x81\xe5|\xe6\xcb\xf0\x00\xc0g\xa0\x9a\x10\xe6\xbfQR\xb6\xda,Di\u070eZ5Y\xee\x1e\x16\xedU\x85\xff\x1b\x96)\xb8\xf9\xa4\x99q;\xa4q\x8d\x06\xe4\x1c:).\x03\x8ff\xc69\xd2$\x81Ȳ\xad\xd9\xe6Z)h\xc0\xc4X\x84\xd9o\xb4<\xf2e\xa8\x1b\u05ee\xb6\r\xc0\xa1\x87!t\xd7Lvچi\x8ers\xd5\fȠ\u007f\x80`\xfd\x8a\xcd(ZFS_\xbd\xdeˋ\x19\x16\x17\x17\xd72\xb0\xb2,\x91,\x96ȳܸW\xcd}\xef\x01x\xb8q\x82\x18\xc4%E\x91g\xe0!\x87\xaa\x14u\f\xf4\x8d̀\xfa\bo\x03\xbaL8\xf5\xb3\xe2a\x00]\xe9+Y\xa3WZ\xfe\x1b\xcaNk\x9f\"\xe1\xeeS\xb7J6}+\xc8\x18C<\x99\x80\x87\x1c\xff<\xbe܊\xd2\xd4U\x85\xb2,\xb1?\x1e\x9bS\r\xed\\A\x97\x85\xf6\xd9\b\xb0\x88\x9e\xbd\x0f\xea\x05\xe6&貵\x8d\xba\x86(\x81\\\x1e\xdd#'\xed\xb9Q\x16\x98\x18O&\xdd\x19j\x9f;\xb5\xc5\xcf\x0f\xe8$ڵ\x8b\xbe\xdf\xf0[\xafe\x0e\x03\xf4w\x89g\x9c\xe1\xfc\xd9sD\xa3\x11\xc5Y\xac\xb3PN+\x93\xb86\x8dՍ\x8c\xe6/^\xe2r>\xc3r6_\u007fO\x04\xe0k\x00?n(\x88,%\x96\xb39\xf6\xf6\xf7\tt\xf2\x17\xb3\xdf)ڀ.\x83\x00\xa3\xd1\xc8\xd4\x18T\x86\xc2\xc0B\x8e\xdb\xd3)\x94\xaa\x91,\x97(\x8bbg\xec\xdcG\"\xa2\xf1\xb8\x81z\xaf\xad!\xdb\x1b)ؿ\xc2(\xc4h4F\x14\x1bDF\xe4\xf9\xa0\x8fWU\x85p\x14\x91\x1c\xeb\xa6\nq\xd3\x1c6\xe3\xa25\xb1\x90)\x91\xda\xce\xff\xe8&w\xa4\xba3\xef\x83e\xaa)a\xab\x94\x1b\xfeS\xe49\xa2(2q\x86\x1d3\xb1\xee.\x93\"\xb6殸2\x83\xeb%c}\xb7l\xf3L1\xcf\xcfz&5\xa9\xaa\xc2\xdf\u007f=ƭ\xe9m\xc4{\xfb\x18\xc7cC\u0084i\x0e!\xa5\x84\x14%*Y:\xa4\xb1\xc82\xcc\xce/P\xa4i\x97\f\x15\x80?;O\x10\x160$\x8b9\xee\xdd\u007f\x0f\xe38\x06\xe7ܴ\xf5'\xff\x18\xbaYH\xac)\x85=aX\x18b2\x89\xc1\xc2\xc8\xe0\xe8ZӀ\x12\x0e]k\x84a\x88\xbb\a\a\xc8\xd2\x14E\x96\x996\xa2^\xbb\u0380X\xa6\x8c\x1b\xd8Ѳ6\xfd\x89Svql7<}\xdd\x0e\x19^kUU+hU\x1bV(\x91\xe6\f\xc4\xca 9G\xb6J[Aq\x18EW\xbar\x9c1\x88,w'\xcdff\\\xb7h\xe0\xb6\xf1CE\x9c%Ƙ\x81\xdemב-\xc9?wod1u\xdff\xadk(R4?v\xb4y\x16\xa1\x94\x931\xe7\x1c!\xe7\xc6E\xf4\x94E\xaf\xcf6\xf7\xbe\xcb\x1a\x007R\x80`d_\xb9\xfa\xd8\x13\x8eY\xecρ\xa1\xb3\xb7\xee2^\x9eG\xb3\x9c\xcfq9_\x98S\x8c\\\xdbpd\xc6;+YA\xa9ʉ0OS\b!\xfaf\xc4_\x02\xf8Չ\xf9\xe4\xe80%\xbf\xcb}\xf1\xf4\xce\x1d\xdc\xff\xf0\x03\x04\x8c\xa1\xaa*\xa2\x93H\a\x85\xda\a\xe0\x8c\x81\x8fF\xe0\x9c\x83s\x8e8\x8e1\xb9e\xa1K\xe5\x02\xcb|\x95\"]\xadL\x80\t\x8d(\x1aa<\x99P\x80\xaf\xdc\x06g\x8c\x13U\x9c|wΡ\xa4\x84(Ks\x1aY\x12#\x11\xde\x18c\x18\xc71nM\xa7\xae\xb7\xeaV\xab\xea1\x88-G\xa8\x14\x02\x85\x10-\b\xba\xef4Yo\x896\xc4V\xdbM\xce\tq\xb1\xcf\xd7B\xc8l\xfc\xe4\x15\x9e9%\xe3\xdc 6\x1e\x99N\x91\x02+z\x86\xba\x03>\xbfvf{\xcdݳ\x9d\x15y\x18\xbaQe\xb65m\x13S\x9ad\x9c\xbd\x9fJJ \x008\x0f\xc18\ag&\xd9ˈV\xae\x89\x89Q\x16\x85i\xc4A'\xabcu\x93ܬ\x92\xfa\xa7YM.\xa1\xd6\xda(p\x149\xd9(rk˲\xdc0\x9a]-Z{\xaeG\x00\xbe\x01\xb0\xb2\x9d\x15\u007f#\xba\x89{\xe0d\xb9D\x9eg\x88\u0088 O\x0fm\b\x80@\x9b\xba\v\t \x10\xc2(H\x18B\x96\x02RJ\x8c\xe3\x18i\x92 \xa1đR\xaa\xd3\x15\n,\x13V\xef\xf4\x00-\xab\x99g\x19\x8a<7\v\x18\x86\r\x82\xe2C\xba\x94\xedv<\x9d\x019\x16t\xe6\xff\aǐ\xad{L}\xea\xfb\x8e\xb1\x93R\ni\x92\xb4N\x9f]?\xe3U\xddNE\x06\xc9\x18\xb8\xe1\x97l\xc6l\x18\xd4*\xc0\xe0\xfb\xb75\xf0ۨ\xf7\x95\x94\x10E1\xa8\xac`\x87\xb5\xfe\xe1\xf8\xf4l\xe5\xbbX\u007f\xf8\n\xd2\xf0u$je \xdd\xc0\x83(\xfdA\x8bV\xcb뺆\x96\x12e)\xb08\xbfh5R\x1b\x8c$\xbd\u0082[\x8bT\xbd\xc1u\xe0\xfa\xa6\x00\x85\xb7\xf4ꃆ\xdf\x04\xd9z\xd7/\x00\xbeoy\x00\x00~\xea\xb5~6\xfa\xa7J-K>\xac+C\r\x10E\x81\"\xcfQd\x19\xd2$A\x96\xac\\-A\xf06L\xb3|w\xbd\xbbh\xab\xc3\f\xb2\xfd\xee\xf8\xf4\xac\xb4-\u007f\x9c\xe7prt\xf8\x15\x80o\x01|\t\xd3#\xeb\v\x18\x1a\xbcu\xbd\x19\x80\xbb\x00\xc6&\xcd\aA\xc1L\x85\x86OW\xa3\x19\x9f\xab\xb7\xb8\xeb\xbb\xf6\x88\x1e\xc3t]\x89^\x83`\x14L\x9b\x97\x14\xdbs\xf8\x1a\xc0\xbf\x00\x1e\xd3\xff\xd7[<\xb1\x1a\xed\xc2K;\x90A\xf7\xc8b\xddk듗\xff\xba\xf6>\xb3K\xae\x81\x87\xe9\u007fL\x89\xaf\xdb\xf4Z\t\xe0\tm\x88\xe5\x96\xef\x19\xbaN\x13\x00\x9f\x00\xf8\xdc\xdb3\x83\xd2It\u007f\xf7\xe15.|MW\x05`\x0e3\x98ӮOJh\xd5\xef\x00~>>={\xe4\xbf\xe1\xe4\xe8\x10ǧg\x9b\xc0\xd9\xc9\xd1a\b`J\x1b\xd2g\\\xdf&\x05JH\xb8\x05}\x99\xbfh\xfeO\xb0\xe5\xf7\x06\x1c߱\xd1\xeck\x9c\x84\xf8\xe0\x06\x05f7\xee\x1c\xc0\xb9\xa7\xe8]\xf1\xb8}-=>=+\xdf6\xd3xrt\x18\xd0ƍ\xe8\xb9\x14\x05\xa0\xea\x06\xbf\x03\xb4?\xa2\x1e\\\xa3\xaf[nD\x86\xf7A\x0f\x06\xe2\xff\xae\xb1\x1b-\xd5\xdfC/a\x86┭\x10\tHl\xff+_)|\xd9\xfd\x0f\xbaTv\xcc\xc5\xc7\x12{\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Bg_page_300 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01,\x00\x00\x01\x8d\b\x06\x00\x00\x00\xab=L>\x00\x00\x00\x06bKGD\x00\xff\x00\xff\x00\xff\xa0\xbd\xa7\x93\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x14/\x1f\xa2\xdd\xcc/\x00\x00 \x00IDATx\xda\xec\xbdK\x8f,Iv&\xf6\x1ds\x8f\xc8ǭgw\xb3\xbb\xd9C\xb15\xd2̐#N5%h$@\x10F+m\xf4\x13\xea\x87\b(@\x1b\xad\x84\xfa\x1bZ\xd5@k-\xb4\xd0F\vA\x80 A\x12\x8b\xe4p\b\xbe\xc9a\xb3\x9bͮ\xaeǽ\x99\x19\xe1nG\v7373?\xf6\xf2\xf0\x88̼\x95\xd1Ȯ\xbc\x99\x91\x1e\xee\xf6\xf8\xec\x9c\xef\x9c\xf3\x1d\x82\xf7\xfa\xf4\xe3\x8f\xf0\xc9g\x9f#~}\xfa\xf1G\x00@\xde\xd7˫\xed\xa5\x01\xb0\xfd\x874\xc6[\xbe\xcc|\xd9W\xeb\xbc\xd9\xf7\xf5\x00~\x1d\xc0\xf7\xcc\xf7\xfe\xef9\xba\x1e\x17\xae\xc7\x00\x0e\x00\xfe\x01\xc0\xcf\x00\f\xe6g\xbc\xf2\x11\x19\x0033k\xad\xf1\xdf\xfdO\u007f\xf8V-\x16;\u007f\xe7^'k\xee\xeb\xb1\uf264A\xfa\xf4\xe3\x8f\b@\a`\a\xe0\x16\xc0+\x00?\x02\xf0_\x02\xf8\ra\xc1J\xd7\xd5\xdeF\xd1\xd1\xe7q\xe6{$\xae\xcd\r?\xa7h3pa\xb3\xd5\\7\xf7>\xe5m@\x8a\x80\xea\v\x00\u007f\b\xe0\xcf\x00|i6.WnJ:a^;o\xde~ǀ\xcfM\x04\x14\xf1x\x10\x80k\x00\xdf\x05\xf0\xdb\x00\xfe)\x80\xab\r\xd7\xdb\x03\x80\xbf\x04\xf0G\x00~\x0e\xe0ut?*\xb1V\xfc5u\x0f\xe0/\x00\xfc\x1b\"\xfa\xb9\xf9\xf7P\x18\xc7ь\xfb\x01\xc0\x11\x80v\x1f\xca\fbf\x00\xfa\x93\u007f\xfd\xfbO\x11\xbcȌ\x8b4\xbf{3_\xbd\xb0V\xa4\xf5;\x981x0c\xa2k\x0e\x86O>\xfb\x9c\x9f\n\b\x92p\x81\xf7\x00\xfc\xc7\x00\xfe\x03\x00\xbf\v\xe0\xbf\x00\xf0C\xb3\xe0\xf7/\xc6\xd2\xea\xd7=\x80_\x01\xb8\xf36i\xbcA\xb9\x00\xec\xb9\xf7\xf9\xd7Rf\x11\xbf\x02\xf0\x9d\xb7n$\x89\xec\xc3\u007fi\xc6tȌ\x93\xb5\xee\xfe\x06\xc0\xbf\x01\xf0\xc7\x00\xbe\xf2\xde\xc7\f܃\xf9\x97\x00~\xe1\x1d*\xa3p\xb0\x90p}\r\xe6\x03\x80\xfbO\xfe\xf5\uf3d5\x9bT\x99\xc3\xe3:q\u07fd\xd9k\x1f\x02\xf8\xf7\x00\xbc\x17\x1d*7fO\xfess\x18\xbd\x12\xae\x13\xaf\a\x1f\xec\u007f\x0f\xc0_\x9b\x03C'\f\b6\xc0\xf6+\x00\u007fg\xfe{\x8c\xc6E\x9b\xf7\xdc\u007f\xf2\xd9\xe7\xfa\x12`&\x01\xd6\u007f\x03\xe0\u007f\x04\xf0k\x9b/4f\xb7\xd8^^/\xaf\x14\x18]z\x85\x18Kk0\x1b\xf3\xdf\x19\xeb\xef.\x02\xc2ԟ>\x80\xe8\xa7`\xfe\x13\xe3\xee\xbe\xf1\\\xde\xf8o{\x036\xdf7\x16\xeco\x98\x9f\xf9\xc0\xd8\x01x\xd7\xec\xbf\u007f\x02\xe0\x83G\x9e\x11\x06\xf0S\x03t\xbf0\xe3\xa2=\x00\xfc)\x80?7\xcf\xfe:zv\xcax\x13\a\xf37\xff`\xbe\xb7\xaf\xf1\x93\xcf>\x1fS.r/\\\xe8\xd7\r\xa2\xaf\x02#\"\x02)\x05\xf2\x81\xc9\xfb\x1d3\x83\x99\xa1\xb5\x06km\xd6\xe8[\nb\xcc\xf3\xb1i\x9f\xd1\xfb\x99H\xf6\x10M\xe3\xf5\xb6\x8d\xcb#\x00Q\x93\x9bA\xd4\x1b\x00\xf9\r\x96\xd6v\xc6\xd2s\xdf3\u007fc6\xf1\x98\xb0Z:cU\xbd\xc2\xf3\xe1\x82\xc9\xd0\n?*\xbc\xef\xb5\x01\xb3\xb1\xc2\x1b c\x99\xfd\x91\xf9\xfa\xc6{\xdf\xe1ӏ?\xfa\v\xf3\xf3\xbf\x05\xf0w\x9f|\xf6\xf9`A\x8b\"$\xbb\x05\xf0\xdf\x03\xf8o\xdb\xf6%\xa3\xdf\xed\xb0\xbf\xbaB\xbf\xdbA)\x85\xae렺.\xd8t\xcc\f=\x8e\xd0ZC\x8f#\x86\xe3\x11\xc7\xc3\x01\xa3\x01/\vf\xcfi\xa3\xb2\x05\x17\xbb)\x95\x82\"r\x1bT\x99q\xe8\x94\x02\f`k\xad'\xe0\xd2\x1a \xc2n\xbf\xc7\xf5\xcd\r\xfa\xfd\x1ě\xc3\xfd=^\u007f\xf5\x15\x1e\x1e\x1ed\xe0\x12\xc6\xe7\\#\xc6\x17\xf8\x8c\x9a\x97R\n\x00A\xeb\xf1\tme\n\xc0\x8cs\xe0\xf6\xf2jy\xbd1V\xdb\xdf\x02\xf8\x1f\x00\xfco\x96G\xeb'\xa0\xfa\t>\xf9\xec\xf7\x80)\"\xf4\x91\x03\x17\xb3\xb1J\v\xe9\xfa\xd5+\\\xdf\xdeb\xd7\xf7 \xb39{\xf3\xfd\x02\xb0\xb4\x06\x11A\x99\xcd;\f\x03\xc6a\xc0\xf1x\xc4p<\xe2p\u007f\x8f\xc3\xe1\x00=\x8e\xd1ڠ'\x01L\xce\xfaQj\x02#\xa5&\x80\xee{\xf4}?\x81\x93\x05\xa9\xae\x03\xa9\x89/\xb5֤\x03qft}\x8f\xdd\xd5\x15\xf6WW\xd8\xedvP]\af\xc68\fx\xb8\xbb\xc37_}\x85/\xbf\xf8\x02o\xbe\xfez\x1a7k\xb9\xda{\xb9\xf0\x98\xf0\xd9/\u038b\x1f\x91R\xb8}\xf5\n7\xafnA\xa4p\u007f\u007f\x87\xd7_}=\xaf\x0f\xdfb5\a^\x1ec\bD\n\xa0v\x00f\xf1Z\x94\xb4\x90\x9f\xfei\xdb>\xab|\xbe\r\xe6\xbc0L\x81\xbe\xdf1_\xff\v\x80\xff\xdd\xf0g\xd6%t\xe0\xf0\x01\x80\x1f33^\xbd\xfb.\xbe\xfb\xfd\xef\xa3\xeb:of\xc3\xcdB\x8a\xd0u=\xfa]oN\xc0\xe9=\xcal,\x06\x03L\xe1\xe2 \xc2\xc3\xfd=\x86\xe3\x11\x00\xb0\xbb\xbar@\xa6\xc7\x11\xac\xf5\x04`\x87\x83\xfb:\x1c\x0e\x18\x87!X\x8c\xe7\xb2\xc48:1\x15\xd1\x04@}\x8f\xbe\xeb\xd0_]a\xbf\xdf\a\xa0d\x81\xc9ݏu\x8bS.\x05\xd1\x04R\xfb\xbd\x1b+\xbb\xf1\x88\b\xfdn\x87\xdd~\x8f\x9bw\xde\xc1\a\xdf\xfd.\x0e\x87\a\xe8qěo^\xe3x8L\xee4'\xb2\x02\xa2\x1f1U\xac2f\xf9\x12>0\x9a\xef'{~\xb9\xdb\t\x91\x13@\xf1o\b N\xde\xcb4tӚr\xce#\x01\xd7\xd77x\xf7\xc3\x0f\xb0\xdb\xedA\x8a\xa0\xc7\x11_\u007f\xf9\x15\xee^\u007fc,\xf9\xf9\x90\xd8_O\xe0\xdf\xf5\xbd\xbb\x06y\x96\xcf0\x0ex\xf3\xcd7x\xf3\xcd78<\x1c\x96\xf1Q\x8fn&E\u07bf\xbd5l\t\u007f\x02\xee^\xdf\xe1\xee\xcd\xeb\xf0\x10\xa9\x00+RJ\xa6\x03\x84\xbfe\xe6\xf9I(<\xbc\xc9X\xf2Eww\xe1\x9d9\x97\x005\xcb\xc3E/\xec\xbd\x10\xb95\x10܋\xf9\x9dۛ\xc6\xcbp\xef\xf5.8\xbd\x9f\x1cH)\"\xdc\xdf\xdd\xe1\xe1\xfe>\xde\xd7\xffȸ\xd13`}\xf2\xd9\x1f\xd8_\xfe\x18\xc0\u007fhO\xff\x9b\xdb[\xf4\xbb]\xf0I}ߣ\xdf\xefЩ\xce=\xf4\xf4\xdfi1\x12\xc8-\x10\xcd\xda\xcc\x1fO\xff5\x13\xa2noq\xff\xe6\r\x8e\xc7\x03\x88\x94\x03\x05\xee{\xf7\x1e\xcd\f=\f\x18\xc7qZl\xc6\n\x1b\x87\x01\x83\xb1@\x8e\xc7#\xd8Xl'\x83\x14M϶\xbf\xbaBo\xc0Hu\x1d\xba\xdd\x0e\x9dRP\xe6w\xfb\xfd\x1e]ߣSj\x06\xa5\xe8\xf35\xf3\xf4\xfcZc\x18\x06w\x8fD\x04\xad5v\xfb=\xf6WW\xe1\xdf\n\x1cO\xd7u\xe8noq}{\x8bq\x18\xd0\xf5;\ff\f\x8e\xc7#\x8e\xc7\xc34^칦\x1e\xf8-\x98\x83\xc4)\xe9[\x8f\xcej B\xa7\x14v\xfb\xfd\xe4\xe6\x1b\xcbP3C\x8f\x03\x8e\x87\xe9>\\j\x80\xb14\xddae\x9e\u007f\x1c\x86\x85\xb5\x9c7\xd9\t\x8a\x14n\xdfy\x85w\xde{\x1f\xfb\xab\xbd94\xe7ѹ\xbe\xb9\x01\xf3\xf7\xd1\xef\xf7\xe8\xad\xc5J\xe6\xa0T*چ\xe4\xfe\x94\x01GE\xdc\xdf\xdd\xe1\x9b/\xbf\xc2\xebo\xbe\x99\xe6(8\x00\xbd\xbf\xf5\x10ur\xf5\xe7\r˚q\xf7\xe6u\xbb\xf5\xe1\a\x9f\xcc\\\xd9Ϸk\xaa\xeb\x14\xba~\x87\xdd\xd5\x1eJu\xe8\xfa\xe9Pd\xcd\xd0z\xc4p\x1cp|xp\xf7\x9e<\x84:囫\x01\x8dD\tυ\xec}y@\xdc\xf7=n_\xbd\xc2\xf5\xcd\r\xc6a\xc0\xebo\xbe\xc6\xe1p\x9c\xc6Ļ\xcen\xbf\xc7;ｏ\xeb\x9bk0\x03o\u07bcƛo\xbeN\x82\xbf\"\x05\xea&\f8\x1e\x0e\xd2h\xfd\xa7&\b\xf1\xf5\xa7\x1f\u007f4\x93\xee\x9f~\xfcQo\x00k\xefO\x00\x9b\r\x01\"\xecv=\xaen\xae'k\x8a\xa6\t\xb3@\x05\x10\x98\xbcI#B\x87\xce\x1b;\x06kv\xe0p\xf3\xea\x15\xd4C\x8f\xe1p0ׁ\xb1\xca\xec8On\xa5嵮\x98\xa1\x99݆\xd5ｇ\xe3\xf1\x887_\u007f\x8d\xfb7o\x8a\xae@j\xe1\x90R\xb8\xba\xba\xc2\xcd;\xef`wu\xe56]\xbf\xdfc\xd7\xf7\x138y\xee\x1eU\xb8b\xca\x03\x82\xddn\x87\xd1\xf0vD\x04\xd5u\xe8\xfb~\x15\x87\xb3\xdb휛\xd4\xedv\xd8\r;<\xdcݣS\n\xfb\xdb\x1b\xf4\xfd\xc4\x1f\x92\x01\xcd\xe18\xe0\xf0pg\xac2\x9eC7J\x81\xbcMC\x06\x1c-\x90Z\x90&g)ωf\xbe\x1bd\xadb\xeds\x93\xe6\xcb\r\xb1\x01\xad\xc3\xc3\x03\xc6a\xc8\x1f\xe1Ӎc\xff\xea\n\xef\xbe\xff\x01v\x16\xac\x04\v\x85\xec\xb5\rP\xb19\x1c,\xe8N\xeba\x02+\xf26\x05\x19\xcep\xdaX\xefa8\x1eq\xf7\xe6\r^\u007f\xfd\r\xee\u07fc\xc1h\xac|\x97\x1b`-\x055[\x02\u058b\xd8_]\xe1\xe1\xfe\x1eo\xbe\xfez\xb2\x9a*\xd7 \x1b\xfeR)\x85\xab\xebkܾ\xfb.^\xbd\xf3\x0en^\xddNVwd\xb1\xfbcn\xffv\x1cG\xe8a\xc0\xc3\xc3\x01w\xaf_\xe3\xfe\xcd\x1b\x1c\x1e\x1e\x1c\xd5p\xf3\xce;x\xf7\xfd\xf7pu}=ݛN\xc4\xed(\x1d,\xf2\xad\xb3ݮG\xbf\xdf\x03\fh\xd68\xdc?\xe0\xef\xff\xee\xef\xf0\xab\u007f\xf8\x87\t\xd0v;\xbc\xff\xe1\a\xf8\xe0;\xdf\xc1\xee\xea\xca\xdd\xef\x87\xf8.\x06C\xf7\x90R\x18\xc7\x11\x0fww\xb8\u007fs\a=\x0e\xd0\xcc\xc6\x02#\xd1\xf2\x04\xf0\x9b\x00ޑ\xa2\x84\x84L\x9e\x95R\x84\xabۛiC0\xa0\xc7\xd0%a\xcax'\xd6\xfc\xec\f\xaa\x1bk\xab\xeb{\xe8\x9b\x1b\f\xc7\x03\x8e\x0f\xb3\xabCq\xf4\xc5, E4q=D\x18\xc7\x11\xfdn\x87\xeb\x9b\x1bܿ~\x8d\xaf~\xf5+\f\xc7c\x12\xb8ȋ`\xdaŶ\xdb\xef'\xfe\xed\xfa:\xd8\f\xbb\xddθe\xbb\x89\xef\xa8\v3%9\x91\xae\xef\xdd\xe9o\x17[3\xbf\xab\x14\xf6\xd7\xd7\xe8-h\x19\xab\x94̆M\x11\xbfl7\xb6ր\xb5\xf4\xcc\x18\xd4r\x83U\x87\x819X\xac%<\x81\xe48}\xd6\xd5\x1eݮ\xc7\xe1\xe1\x80\xc3\xfd\xbdl\xf2\x99ü\xeb;\xbc\xff\xe1\x87P}Wt\xfd-\xdfW5\x9aG,\\\x17e\xc6\xe2\xe6\xd5+ܼz\xe5\xaeg-\xc2i\x9c:t\xddl9Z\xfe\xcb\x1e\"\xdf\xfd\xc1\x0f\xf0\xa7\u007f\xf0\a\xf8\xea\xcb/\xab\xe7\xb2\xdf\xed\xf0\xfew\xbe\x83\xef\xfe\xe0\xfb\xb8}\xe7\x1d\x11\x94\xb3\xaf\xae\x9b<\x1f\x00\u05ef\x18\xef\u007f\xe7Cq\xbdW\xcf]\xe2o\x9dᡔ\v\xa6\xf9V\xee\xbb\xef\xbf缜~\xb7s\xeb\xd1y\fѼ\x05|\x9f\x19OK\xf9\xb0f\xfc͟\xfd\x19\xbe\xf9\xf2KP8\x1e=\xbcJ\x8b\xf8\xa8ש\r\xb9\xbf6\x96\x153Fc1\xd1\xca\xf0\x11yf\xb5\xea&K\xea\xfa\xf6\x95\x8b\x14ƛ@\x9bM>\f\x03\xc6\xe3q\xe2\x93lZ\x04\x11\xae\xae\xaf\xf1\xce\xfb\xef\xe3\xf5W\x93\x89\u007f\xb8\xbf\xc78\x8eӦ\xee{\\\xdf\xdc\xe0\xcalv\xfb\xd5\x19+\xea\xea\xea\n\xd4)\xc7?:N\x89\xd2\xfe\xfd\x1a\a\xd4E\x06O dU\x02h\xd8\xe3\x97\xe2\xfb#\xb7\xf1\xd4\xea\xcf\r\x17p\x82:\xa3\t\xb2w\xdd\xc4#Y\xf0r\x16\xb1\xd6\xd8\xef\xf6x\xe8{\xdc\xdf\xdfy\xa0jy+@k\xc6\xcd;\xaf\xe6\x13\xda\x1b\xaf\xad\xf8J\xdf\xfd\x1d\x01@8<\xc8Z\xd2\x00\x985\x86\x91AZ;\x9e\xc9Y\xaaD\xd8_\xed\xf1\xcf~\xf2\x11\xbe\xfc\xe5\x17\xf8\xc5\xcf~\x86\xaf\xbf\xf8b\xb2\xf4$\xa0\xea{\xbc\xff\xdd\xef\xe2\a\xff\xe8G\xb8y\xf5ʳ\x04O\xe3ZW\x1f2\x89\xcd\x19XW̓\xd5Ɍ+\x8b\x01\xf3\x82\x9c\xf8\xc28Z깻\xc9\xfb\xd3\x1a\xaa\xefq}{\x8b\x9bW\xaf\xa0\xb5F\xbf\xebSn5\xa7\x00K\xf9\xef\xb1\xffS4\xbb1\xecE\x16N\xa9\x1d\x11\x9c\xe6E\xd4\xc5\xfen\xaa\x11\"0k\x8cÈ\xe1p\xc0\xf1x\xc0p\x1c\x9c\xfb\xd1\xf5=\xde\xfd\xf0C\\\xdf\xdeNf\xfa7\x13){\xf3\xea\x95\x1bT{\n\xf4\xfb=nno&\"\x97\xa6\xeb2/7\xfe\xe6\xa1|\xc3mm\x13\xe0\xe1\x85MG\t\xab/\xf7<\xb99\x94\v\x06Y\xfc\x83\xf8^\x94R\xb8\xde]\x03\xb77\xc6}\xb3\x9c&\xbby\v\xf2\xf0̍v\xfd\xc4\x1d&7\xe0%\xf2\xb9\xe2\\9!w\xce\xfe\xdbZ\xcb\xef\u007f\xf7;\xf8\xf0\u05fe\x87/\xfe\xfe\x17\xf8\xe9_\xff\x15\xde|\xf3\r\xf4\xa8\xddX\\]_\xe3{?\xfc!\xbe\xf7\xc3\x1f\xa0\xf3\xa8\x8e\xfa`ޅ\"\x8fv\x0fD\xe3<\x8e#\x0e\x87C\xe0\x8d\xc4\x1e\x80\x0f\xe4\xb1\xfb\v\x8fR\xf0\x81\x90\x88\x00\xa5\xdcA \xbc~n\xd2\x1cD\xc0\"iV\x94\xa2\xac\x8f\xee\u007fX\r\xc7\xd3\x14/\x9dCV \x90!\x1f\xaf\xd0\xefw\x13\xf1x88b۞bt{\x8bW\xef\xbe\xebH_K\xa8\xdb\\\xb1k\xe3\xdaN'\xf8t\xb2\xe3\\ \xf5\x18/\xa2\xea\xe7\xa9\x01\xab9\xd2\xc8\r\xeb\x9e#\xd7w\x86\xce~7G\xf1\xd8\xe36\xd9O݈\xac!d\x12oiM\x8e\xc2\xc6/=\x8e\xd0\xe3\x88\x0f\xbe\xfb\x1d\xbcz\xef\x1d\xfc\xe2\xa7?×\xbf\xfc%T\xa7p}{\x8bw?x\x1f\xaf\xde}ϥ\xae<\x19\x80\xaa\t\f\x18\xa0\xb1<\xa5\xcd)\xf4i\x16\U00040c69\n]\x17\xf0\x9a~䙧ZN\x17<\x12^\xff\x0f\xa6R\xaaJ\x97\x90\xe1|\xec\x89\x1c\x8f\xf2\xaa̩9q\xef\x13\xa8L\x04\xa5}\xa8\x13\xc0\n\xf2I\xaeHA\x13\xb07\x11\xacq\x180\x1c\x0f8<\x1c@\xbb\x1d:C\xa6\x03\x00\xefvnpo^\xbd\xc2~\xb7\x03\x13\x99\xb1T\x0e\xe8\xce\r t\xaa\xa9.\x8c\x19\xe3|I\xb6Kw\x9fO\xbf\xe1\xc8B\x9cOg\x13uSiΣdm\xe68\xc4K\xbe\xc6qD\xd7\xf5\xf8я\u007f\x8c_\xfb\xf5\x1fb\xd4#xd(EE\xae\x8a\x9fX\xfeVla\xbbh\xac\x97_X\xa4\x11\xcc\x01\xa3L\x84<8P\xcdϜ\x9b)O\xe0\xdfaʊG\x10%L\xde2M\xee\xd6|'\x06\x9clD\x88uğ2\xa0\x01\"C\xf0v\n\xca#*\xb7\xd9\xff\x04\xa5\xa6\x87%Cbv]\a=\x1ar\x19sΈ\xea\xba9\xe2\xd3)\xe3_3\x94\xea2\xa4\xdd\x16\xbb\x9d.b\xb1\xe5HiZ{\xef\\\xfcљp\x9dN\x1b\xf2\xa7\xb2љ1\f\xc7)\u008c~N\xffxF`\x15[Y\xfdn\xe7\f\x84\xeay\xb2<\xb0\xb5\xb4\xe0\xe7\x95͠Xx\xf2+\x9f\xaaJ\xbb\x84\xecY4\xaa\x9b\x049\f\xb7\xa6\xf5\b=\xeaɜO\x98r\x8e,\xd5\x1aZ)t\x9d\xaaJt\xab_\xdc\n\x8a\x00m\x8a͙\xb5\x030\xdfWf\x00\xc4\fe\xc9A\xb0#\xafϱHⳞb\xebP\xa9\xa5y\xbc\xf1IX\xc3O\xa5]I\xce`0\x05\x1c\xe6S\x01\a߅|Je]\xb6:\xa1\xeb{7\xe7\xf1\x9a{\xeaeh\xa4\x14\xf6&\rĺ\x82k\xbc\v\vZ\vW\xd7R\x01i\x1b\xf970%\x8e~#\x15?\xf3\x82\u007f3\x11\xbd\x19\xac\xb4\x03\xab\x1aO\x81\x99\xc1&\xb7%\x8e\xc0\x05\x19\xaf+\aS\xe9\xf9\x9e\xec\x80\xf8\xd9\xcd B\xb7\xdba\u007f\xb5\xf7B\xfa\xdd\xfc\x80\x15\xa0ŉ\xcdk\x93)\xe7gQ\x80\xd6\x18\xc6a\xfa\xe8\xf8B\nP\xb09l\xda\xe5f\x9dǗ\xab\x1c\xd5\xc8\x1a\xb4(g\x13\x80\xdd\xed\x19\xce/L\xea~Z\xc0\xf5\x14A+\x8er\xfac\xf6\xd4\xc1\xaa\xeb:\x17]?%\xca\xecp\xc0ˀ\xf7\xf7\x9d\xe5\xc5\xc6a\x94\xd6\xec?ǔ8\xfa\v\xc9\xc2\x126)\x19\v\xc6\f\xb8\xd6\x01\xffP\xeb/0\u061d2\xe4\x97CД\x99\xec'ͳAǅ\xeaC\n\xb40g\x97K\x83\xbe7\xc9xJu\xc1\xc0\x93\x97\x1b\x95\x1e\x83\xf0\x9a\x8a\x14T7\x95\x84\xf8\xe57S\xb4cʜU\xba\xc38\x8c`\xe3.\xdb\xfc\x1d\xea&\xfemrg\x19\xc7\xe3\x01\xe3q\x04\x9fj\xb1\x04\x04)\xd5\x10R\xa1-h\xfeo\xaa\x89\xec\\\x9d\xa7\rg[\xf0\xb7\x99\xcf\x14\x85\xbe\x1f\x13\xb8R\xb9>\x8b\x8c\xff'\xe0VeA*N\x05 9\x13}\x91gw\xa6\xb1\xef\xfb\x1eW\xd7\xd7.\x87\xf0T^o8\x1eݞ\xf6\xef[\x99\xe8\xbd\xd6\x1a\x0f\xf7w\x92\x05\xf7\x1dca\x89.\xa1\x8a\x976\x05\xe1s\xcf\x05\\Q9\xca\xc4a9\x19\x13\x88L\xf2\x84\xa7K\xeao\x84X\xf1Az)\xd5Au*\xc8k!c\x15\xd8\f\xf5\xe9=\xddⶕR\x8e\xf7J\x01\x15E\x1b݂\x8e\xb88\xcd5Վ\xa2\xbc\xa8)\x18ဴW\xe8\xfa\x1b\x8c\xbbqR\xac0\x11\xb5S\x16\xa0\xcd\xc6\xee\xfa~Yߘ\x80\xb3 \xc2\xe6\xcaO\xa6qT&\xf2e\x13zY\xeb\xb9\\\xc3lDWqq6\xd0J\anR9Z\x9d\xa9&\xb0\x91m=\x8e\x13q\xe0\x95L]\x12dk\xb6\x8b_\x02\x14\xa4\aD\xd6\by\xefq\xff\xf6\x0f\x13o\xe5\x96\xf6Mj\fT\xd7\xe1\xea\xe6&L\x04]\xf9\x1a\x86\xa9\x84\xc8Y\xc0^R\xae\xdbeJa<\x1e\xf1p\u007f'}\x9e\xf2\x87\xa7\x10%\xe4\x85\xeeo\xfbDG\xd3\x15L\x00۪\x1e\x10\xd3\xd2b3\x8b\xab\xe4;3\x18\xbb\xdd\x1e\a\xba\x0f\xee\xaf\xef:\xf4\xfb\x1dT7\x01\f\x89`\xa7\x80\xbe\x0f\xcaF(\xe3P\xf9\xf9ZA\xa6\u007f\xc4 \x12\xc5\x02@쪘\xfc\r\xae\xba\x0e\u05f7\xb7\x003\x0e\x0f\x0fS\xe6p<f\x15c\xde\x1b\xb7W\xb5,2Z~\x86\u007f\x82\xdb\xc2\xeeج\xb7\t\xbe\xd6\x15\xd7\x1c\xca\x03\x9d\x13\xa4\x92֕\xc9\xfc\xef=\x8b\xc0\xf2\x94Jx\x86\xe1xL&x\x9e\xdbʪ\xf1\xd2Ug,\xdd\x04\x1dAޗo\x8d\xb9r*[\xbe㤛\xbc\xfdfA\x8e9\xe0Sm\xd5\xc4\xf5\xcd\rz3f\xab\x01\xcbX\xe8Ǉ\x87\xb0Tˮ\x0f\x93\xf4\rL)!_\xfe\xeaW\x8b\xf4\"\xdf\x0fK\x01\x16/\xc7yΘ\xb1\x0f\x10XY\\:C\xa8<\x99 \x13\xa2\x9f\xac\xae\xe0jz\xa2\xd5U!$l\x93\xf3\xee\u07fc1\xf5v4\x81\x952!\xd8\xcc\xc0;\xd02\x85\xd6MVc\x00NF\x91\xc0\u007fnZ\xd6\x1f\x87\x83<\x17yu\xbb\x1d\x06\xc3\xf7\xb5\xbaE\xbb\xfd\x0e\xddn\xd7x\xff\\G\x98:w\x98\xac\t\x13\xbc\xc7꜍\xe3\x88\xf1x\x9c]\xc9\x1a\xe5\x82\x13Np\xd5uؙ\b\xb1-}J\x91\xf1\xfeYȞkr1\xd0j \xa8\x89\bWFu\x82\x05\xeb\xaaj\f\r\xd9o\xd3_\x00\xb34i\xae\xd9}\xb8\xbb\xc3!*8\xde\x19%\x12\xf6\xe7{\x05_\xa5M\xa2i\x1c`\x8a9<[\x90\xfe\xf0\xe6Mj=\x06\x81\xc4L\xa6{<\x18\xe4\xdc\x0eh\xab\xbe\xb0\xf2\xb4\xc9b\x17ϊ\x0fv\x91U\x82\xd6\xeejﴴ\xfa\xae7\xc5\xc0T\xe6\xc1Lb)\xb5.`\x82+\xa8\x95\x00\xdaW\xa8\xc8Z\x88\x86\xccV\xbe$\xcd\n;\xf6\x94d\xc3 \xaa\x9a\x02\xd7\xe8߮0\u061c\xcc\xfd~\x0f\\_\a\xa7\xbb\xd6\x1az\x98\x8a\\\xd9\xe3\x19\xd7Zb\xee\xb3L\x98\x1d\xaa\xc3b1F\xfcO\x1c1\xb6u\x98]\xd7\xe1\xc1\x14e\xb3d\x05<\x06\xe5e<\x03\xe5\x82\x1c'\x12\xf3\x84@\xaeg&\x02\xa6\"\xf0\xc1\xb8\xfd\xf6\xe0\xf6S\x98\xd60?6Y\xdb\xd5\xf5\xe6\xcat\xbc\xdf\x0f\xc7!\xb5G8ga\x05n\x16\xc1\xfb@\xab\x13D\xcas\x14\xc3\x0f\xb7\xf5d\xc9z\x10\xae\x9d4\x86o\u05f9\xca|\xa0\x90|7\xe9L\x1d\x0f\a\xa7\xbc\x90Op\x9b\xf2\xb1&/\x95\x03i\x94\xe2IHe-!\x12\xf2\xb1\x92@ആNeJ\xb8\x19\x00\xe26:\xd2m\xc8mvH<\x8cH)\xf4Q\xc6:{J\x0e\xbe\xf2l\xecJ\xa6\xc2\xfeNEc\xb73Dp\xe7eS\v\xf7b%\xb9\xfd\xfb\x8a\x0e\x0e\xa5\x14nnn&əq\x9c\x05#\xb4\xc6 X\a綮\x1c\x8da\x92\x9dc\x12\xbe\xc4E\xb5$\xdaN\x80\xae\x02\xd50\xab\x96\x1b\xd3\x16\xd5\xd7e\x9e\xf8X+\xd7\x13m}\xc95$\xefw\x89\x95\x1bl\xe0$\x87E쓲\xe4\xc8r\xa5&\xc0\xe2\xc1F\x908X\xc9\x13\xb1NN\xfa&\xe0u\xa4\x87LVUDY\xf509]\x849-!\xc1\xe5L\xff\xed\x8bٸ\x13@yHO\x8c\x1a\xc1\xc8@ȭ\xf0>NX'\xf3\x82\x98\a V5m\xcby)\xb9\xe4,n\x90ںI\xaaw\xf2EK\xc5\xd7ʂ\xa0\xed\xef\x13\xfb\xfe\xecO\x81\xe4ι}6\xf1\x97#\xb5\x87S\f\x11\xd5u\xd8\xfb\x96\x859\xbc\x0e\xf7\xf7\x17\x01-;.\x9d\xd1_S>!\xed\xf6c=pՀ̨G\x1c\f\xbf\xe4\xc4\x13\x85\xbf\xa9\x05-\xeb\x02\xfa\\p\xd5\x11j6\\F\xdd8\xcba\xa9ũJ3\xb0\xc0i\x82)\xa0\xe3)\x1f+N\x1c5n\x1d\x83*j\xbc\xb8*\xa4\x12X%\xe3\x14\xa9Jɾ(\xa5L\xb5\xff\x82\xc6]\x8e\x82UGl5l*K\xd7\xea\x94\x1c9\xe2\xbb\xda\xc8\xf6\xf8\x80\xa9\x02\xb1-4\xe1\xa5\xe8\xa3\x1f\xa5\x8d,Ր\xf2\x9c\xdf\xd3u\x1d`\xdc\xf1\x85\x85eI\xfe`<+\xf2\xe6\\\x8eb\x03\x92E\x85\xef\xbb\xfd\x94\xb7\xe76\xe1\x06n\xa2\x1f\xb5U\xa6\xe8W\x99ȬR*\x96U\x91-\xdc\xca\xc3,\a4\xe30,\xc0\xc5qÓ\xf6Y\xeaZ\xec\x19!\xe3h\xf4\xcelڒ@U\x143\xfd\xd3\a\xc35\xa6NC2g\x95\xe4D8\\\x9e\x93D\xb0\x12\xb3Sg\x95Q\xf6\xa4|م\xc3ٗ\x16\x01%\xc9l\xe91'\xf3\x91\xb3{\xa8f\x8d\xaaN\xad\xe2\u007fԉR-\xe2\r3{\xa9\x02'2 |\x01\xb0r\x96\xcf2R\xa5H>\xa9SU\x0e\xbe\x8cL\x9c\xf4\xc9\xf1\xdf\xf8\xe3\x94\x18+\xe6\xc6\xc0Bf,\xac\xde\xda\xd5\xf5\xf5\\O\xbbF_\xca\x00\xa0U\xac\xdd_]MZ\xfe\xa6ԥ\xf3R0\xaa\xef\xd7\x1f\x87\x02p\xf9\xf7<\x1c\x8f\x8e\xb7\x93@c8\x1eg\x90ɉ\x1d\xd8\xde\x03\x0f\xf7\xb87rN\xcb\xfd]?\xfe\fN\xad\xc3\xef\x00\xf8\xf1\xa7\x1f\u007f\xd4e]\xc2y\xb0\xd3M\xa9Hu \xd2\t-j\x99oc\xb6\"}&\x97\x89H>49m\x86\xda|\xa0\xa5\xcbG\xc9\xcb!\"\x8bגԪ\xab\x03,K\xf0\xd6hn\x87<\vaU\x1f\x83Ń/MUjt\x03s\xe4\xbc?\xf617D\r\xd7ɺ5\u07b3\xc92\xc0i\xc4\xe1\x13\xc08>\xa0\xf6WW\xe8M\xea\xcb`d\xbb[\"\xb9d\x92\x97Skn\x91\xba\xe0\x97\xb1\b\\\xe9\xaa4H\x93\xcaq\xccps\xf6=\x80Q=\xb1j\xb3\xaa\vd\xafmD\xd8\x06S\xb2뿰\xf6+\xf7\xe2O\x00\xfc\xcf\x00\xc6B\x940\xbf\xfb\t5\x16\xc1<\xbc\x13G\xc1F\xf2Ś\xc9\x1d\x94\xd1\xf1\x9eKv\xeaT0)\xb1XɤI\x88\xee 3H-7@M\xd0S\xd9n@%s<c\xcd$O\x1e\xf2\xa2\xb0k\x10\x8b\x96\xaa\xaf\xfeaCtz1vPV\x95\x00\xab\x1aPJ\x01T\n\x88)\xe9R,yγ\xf1LF\xe3\xdffe\u07fd~]mmYM\xfa\x9a\x04\xdb\x14\xf8\xc7.w,\xb2\x97_k\xc0\xe1x\x98\xe4\xc8+,\xb2\xe3\xe1\x80\xe1x\x9c\xe8\x15\x93\xe60j\x93\xb2bJ\xed\x8a\xdc^\xe5s\x92R\x13\x1f\x9e\xbf\xda\xf71\xc9\xe2\x95\xd5\x1ar\x12\xc1\xa1E\x9a\n\v\xceq%\x97w\xe1\xff\xa1\xd6\xd0A\"\x9c\n\x12\xe0\x88\xd2d`\xecBąƒ\xc8]va\xa7\x9b\xba̩\x0f\r\xec\xee\xa2\xcf|\xc4?Pbq\x8fÈ\x11c-\x8a8\xadq\xf2\xc4\xd7Z\xac\xbc\x12\x84Y\x85X\x9f\x8b\xb9\xf4\x8b\x92'\xb1\xf0|^,cMm\xe1\xa2X7\x96\xf6m(\xf9\xb1\x16Z\x9c#\x86\x84%\x99\xdd\xf0\x81^}\x1dhY\x00\xf2\x1b<TYh^\x95\x89%\xe7\xf5TL,\xa6\xbaP\xf1r\xf2\x01\xdd)\x05Mc\xe9\nI\xb5\x86\xf4\xd6\x13\\\x8e\xa9V\x8eӋ\x9f1g\xabym\xac\x88\xbc|I\x1f|\x10\xd6\xe7Ln\xa3\x9a\xcb,b\x82P\x8f\xb3k\xb9\b\xa2.\xf9\xb19\"\xd2ΔO\r$\xe6:\xa8\x9aM0?:\x870\x1eY\x87\xb6v\x8fi.\xad\xa9\xd2}\xa79+\xda\x16\x91\xcfb\n,\x82f\xabj\x14y)\xfb\xf4H`\x95p\xa2C/\x80\xd2n\xe1zJP\x8e\xda\xfa\xaa %\xa4\xf7\xf9/\vx~\x869I\x80\x84\xb0!K\n\xb4\x16#\x11ߧi\xca\x1b\xf3U\xc5>\x14\xa6\xb0\x1f\x06\xecJ\x9c\xd6\xca-\xe55\xf5h\xb0tm\vhL\r(~\xad\xf6n\xac{Ww{\xbc\xa4W\x04?,\x0eU\xbb\x8e+\xa6֎\xbd\f0\x17\x12g=u\x84\xe1\xf4\x14\xf8\xbew\xf0\xb9.\x16\xc0\xc5\xccl[\xabX\xb5\xc6\xed:7)\x13~\xb3\x12\xbf\xf3\x8c-\xbe\x8e\xfb\x18ږN\xa5\xd5@\x98\xbb\xc0\xc0\xf6\x81L8\xb7\xcd`E\xd6=\x9d\x17\xd79\xc0\xaa8\xa6^\x84j\x06\xcf耢0\r\"4\xff7TD\xb0\x96MM-\"\xb3\xeb\xce\x13/9\x9b\xfc*z\x0e\x82o\"\x82\x162I\xbd\xccSm\xde\xdd]\xb2S\x11%\xc0\xcb\x1e\xa0\xb6\xf3\x12\v\xc4}͑B5<h\xbd\xdcԜ\xd6\xf0\xc9g\x9f\xdb\xef\xdf\x03\xf0\x9fe\xcd\xf1\x80\xf8\x9e\xeb\x00\x83\xfc\a\x89\x00\x8d\x83\x8d\x84\xa0\t\x81\u007fB\xba2\x02\x9f\x9b0ы\x1dv\x8b\x87\xf4\xa3\x90\xcca\xce\x11O\xc7\xcc<\xe0\x14\xddneTƅ߫\x16\xb5-\x10\x96'\x8d2<\x1d\x11A\x018\x9aҖ䑈\xb0\x0f\\\xbf\xeb\xebܩ\xe4\t\x18\xb9\xce\x14q^g\xd4Q\xcfn\x84\xd4\xef\b\xcbLV$\xd2\x1e\xd6\x13v'\xa52ĥ-\x14\x83\x90\xe5\xe6\x84ϑ\x1e\xaf\xf61\xb4W'Y\n\f,\x12\x99\x99]\xd1<'\xdcת\x03(\xf7~A\xb9\xa2eu\xf9+}g@\xabj19>\x81eO\x96\xb2\xbc\x1bUM8\x91O\x9a3F=\x95\xdc\xcc\xe6\xb0\x1d\x9cq\x06\x89\xc0\n`ǗM\xde)\x85dU\xa4EO\t\xbe\x9bL\xc3\xc7\xeaI\xb3\v\xd1\xdftƂ\xa2\x02\xe9\u0380\xd3\x05b\x92iA\xf28\xb0~\xbf\xab\x06+N\x8a\xf3\xc9`\x95\xe2\x0f/\x02V\xfe\xe2\x167M\xa4ǖ\x896o\x8a\xb6|Z\x96*'6\xafH\xb2\xa7@+^\xa4^\xf3aۥ\xa8\xa9\xa9\xab\xb9v\xd7\xf7\x894\x92̶\xb5\xfb\x8e\xd2\xc3-F~\r\xbf\xe7\xd6emZ\x91\xf7\xfd7\x00>_X\x03\x9elE\xe8\x0ejaї\xb6F8q\xec\u007f\xc731?\xe5:ќ\x1dm4\xe2\x99'Њ}v\xf6\xef\xc9s\uf0b5\xc5\f\xe61p\xff\x96\xf3\x90\xc8\x15R\x94\xc9X\x8b\xc6E\"Rs\xae\x94\x90k\xb4\x88\xc0\b\x94\r\a\xdc\x15\xea\u009c\r`U宝\x13\xac\xa21\x8a\tw\xbf\xff\x1d\xc4Z\xce\xf9x\xe0\x8dk^\x95\xaa\xa3\aµ\xc8I,\xad\xe2C\xe3\xebF\x9f1\x8eڕ\xc5\xd4<\xb4\u007fOd:\x9b\xb3QyH\xba\x80f\x8dY.Ӷ\xcc#E\xab\xf8+\xbf!o\xedz\xf09\xac;\x00\u007f\xbd ڥ\x83\xcb\u007f \xbf\xac\x84c/\\^\xa4\x14\x95\xec\xc0k\xee\xe9\x94\x15\x16\xa9\xea\xec\x1enԦ\x986n\x11\x1f\x97l\x18\xd1@\xadG\x8c\xe30\xf5\xc73ټ\v_\xdb<\xab\xb4x\xa6B\xd4\ns\xdc\x02f@X\x93\xec\xd7'\xfc\xf7e\xc2l:\xad\xbe3m\xca\xdc\xd1C\xcf\x03\xac\xb6\u0e92Y\xecq]!\x9d\xe7^\xaa\x92\x87I\xa8\xb5\xf4\xacE\x12\xce\x19\xced>\x86\xee\x1bܡ<\x15\x1c\x1f][\xb1\x92\x91\xb0(\x99\xb2ɻ\x05\x95\rR\xa6GC\xdfO\t\xb0\xfb\xfd\xd4t\xd86\n&\x822\x87\xbe\x9f\xf4\x1b\x17\xa1\xfb\xa0OD\x93\xa4R%`\xf9\x1c\xd6h\xac\xac\xc8\xf5 \x97\xe8\x19\xaa\xef\xc1g\x96\x05_ni\u0093\x17)!\xa5\x9dEE1P\x88f<\xb9\x99\xe2\x11\x18i\x00\xf1\xdc~ܦ_\xb0f\x90\xf2\xb2qm\x92\x9f\xaf\xc3c\\4\t8\x94\"\xe8\xa0\xc4\x04u\x89\xa2\x91Do\xd6//&\x93RT\x00\x1e\xb3\x1aքW\xae\xfc\x89\x9b3\xba\xd3n\xa0\x1b\xfeU5\x8d\x1bXWż\xa2\xa8\xfd\x17χ\xab\xe4\xbe\x10\xb5y\x86\\\x03X]W\x8c\xe4r\x83\x05g5\xacR\x11\xce8=Əܹ\x94\x83H\xb1B\xd2O\x97,Ņ\xf5\n/Ojb\u10c6\xbc\xbe\n\xa9m\x02î}ٴvm\xdb3\xf6D-\xfd\xfd\xe1\u007f\xf6\xe1\xfe\xa14袼\f#\xcatW&'jj\xc5\xd3y\xb4\xc1\x8c\xf5A\xcd֢\\\"T'%+!L\n\xac:\xcc\xf9Y26\x05n&G+\x91g\x05\x87\t\xb0LѬꠠLP\x80\x17\xcdQ53:\xf3_\xe5I\xfe\xfa\x1b9\xac\x83k\xe0q<9dW\xd4\xed\xe5Gae\"\xa5\x94\xb55\xcb\x16\xe7ʞkT\xf0f\x99\x18\x89'\xb88XI\x9b\xab$w\xbc\x90I\x8e\xa8\xca\x1c\xbf\"\xb9]\x15\xf7X:Ĩ\xe1\xe0`T\x146K^\x8eݴ\x82U\xb4Ȏ\x8f\xdci\v\x181\xfd@\x9e\\\x90m\xe7\xe5\x1a\xde\x1a\xc0\x8a\x9f\x9dL\xef\xc1\xe0\xf6\xbanʚ7.*G\xd1^w\r\x93z\xc1\xc4UÙ\x14\xf0c\x82k!nc\xf5\xaeN\x9e\x91\x8dl0\xc2b\\\n\xb8!v\xfd\n\x9dN|4_\x1cHːlvQ\xb8\xb8fi\x90q\xe6_\x85ڦ\xa9o\\\x17\xe4\x85\x05yU\x02ќJ\\%Zf\xd0+'\x16\x18Y\xa3\x95\x1b\x9f\x1a\x8au\xc7a\x9cD\xfb\x1aw\r\xcdZA\v\xb0\xc2\x19\xc1j\x15w\x147\xdcL\xdd/\x91\xc8V\x13\x87\x11 Nl\xe8\x1a\xcb*p\xc7m\xe9\n\xd7\xe4\xe0/\x0f\x91\xe0\xd0_A\xdcK2<\x1c\xed\x87\x05\xd0\xdb\x04co\x8d\xfb]\x9cH)t\x9e\x05\xe5d\x8cm\xcaM\xdcH#\x13=\xb6\x81*\xed\x1a\x16\xf3\x02,\xed}\x0eñ:R\xd8\xe7\xad\x05\x15H\x81\x10\xcd\x05\x9a\x1cЛ\xe4\xd8#\xf2y,\xd7H\x95\x96$\xf6\x98\b\bE\xd3\xcd\xc1g,\xc9GiqpD\xc6\xfbKT\x1bq9\xab\xaa\bki%\xee/G(\xc6\x0f\xa0\xe2M\xd7R\x8d\xedY\xb55\xad\xc0\x88\b\xc3\xe10\xe5\xf9\xa8\x1a\xd26:R\x9e\"X\xa5ܙ8\x15\xa4\xe0.r<\x97\t\xcb$G\x94\u05c8>\x96\xb4Ӹ\bV\xeb,ԅ\xb5D4o\xa7\xb0\xad\xd1\xec\xe2E\xd2\xd9\xc1\xfe\xf5\x80ʖ\x11U\x1d\xa2ޡ/?\xbf\xb7?\xbd\xb9\x8b\x83\x16\x15\xa4;\x8b\xd6?’\x92\xf2\x9d\xa4Sa\x99\xd20\x1bU\xb4\xa0\xbc\xa4<\xa4e{\xc3pY-\xa8|\xc1ͪt\x83\xa7\x1c\x15\x0fԴ$\x1aG3\x80\xac\xb6\x8cV\x84\xa7\x18\\\x9d\xf3\xa5\x99'\x85\xd4\nN,\x06\x84\xec\x86<wt\xb0f\\\"0u\x89\xab\xa5{3\xfca*\x85$\x9b\xaaQ+\xebl7Oa\x9eH\x98ۓ\xfa\xf8ę\xf7\xf6\xc0UJT\xc9\xf0\x95UU\"\xc0CJa\xb7\xdbag:K\x05\xc1\x81\r\xdc|\xf2(\x15WB\x16\xbb\xd3\xf5C\xbe\x00,N^\xc4㩖\xe0B.1s\xfe\"\xd1\xf0&PV\x89!\x1d\xdb\xd8\xc0\x8d\xf2\x1e\xe58\f.S_>\xb5T\xb3\x8b&\xa6D\xac\x00\xad\xae\xef\xaa\xc3\xe6\x19᳤\xa5@\rE\xe6[\x83\x95\x94\xdd\x1c.\x9beq\xb5\x0f<\xb9\xfa\xd2\xf0\x8f\xd2E\xe4.\x95\xc0\a\x80\x96lns\x8f\xbd\xa7\xe3\xd5\x14\xe4h=(\xfc4\x1c\xe9yL9\x97\x8b\xd4)\x15\x00Uj\xacz\xa3\x89\xafT'\x17\x9f4\x82\x96$(\x10?\x9bU5\x8d\xc8\xd8e\xe3\x95J\x97\x90R\xff(\xe5\xe4\xf9\xd6\x14-\xe4]\x85\xc6\fD\xabz\xa0\xcb\xca\xe3\xf5d\xa7\xfd{=\x8e8\x02\xd8\xf5;\xa7\xde\x10vaieO\xb7\xdb\xfc\xb6?\xe0p\x1c\xaa\xdc\x12ٷ\x96\x19\x9as'\x83ָi\xa1%N\xe9yJ\xe9\x80G\a\xd5Z\x8d*x\x84r\xf2\x1a)\xb7\xc7\xd6\xdbe\xae\xcf\x1e\x0f˕C\\\xa2\xdc\x00\x00 \x00IDATfD\xb6؍\xd3 \xe2\xb4ȼ,\xf5x\xac\x9cX\xa5\x01\xb4@\xb9\xd74\xab\x88%\x88\xa81R\x1c'\x89\xb2\xe3\x11g\xbd\xb4\xf4\x94&\xc7\xe8+\x98\x80`\x9fw\xbb\xa9\xc1\xa2\xa0\xf0\xb9\x82<-\x92\xce8\xf9\xa3\x92\x03\xc4K4-\t\x96fMU@\x8f\x1aG>\xb8ޅ>h\xad)\x1b\xa0\nҵ֦\xdc\xed\xf7\x93\xbb\x97\xabrP\n\x9d\xa2\x90\xbb\xe0\xb4~\xd9\xe4\xe6\xabM\xad\xd5\x16\x8bd\x15\x97\x95\x9bǄ\xbai\x96\x0f\x13~\x1f'A\xb3Q$ /t\xbf\x88Z\x9a͗Oo`КS9\xe3\x06\xc6ԈU\x96\b\"\x85\x82\xd2+0)#P\xd7M\x8a\xc1\x8b\xab\xa4\xd7\xe2\xea\x14\x17O\xdc\x11F\xc9tA\x05\x91C\xcb\xdc\xebO\x00\fE\v+\xc9\x04E\x03\xc2\xc1iɑ:\x01\x89\xa7\xa1\xcdUZ\xb6\xbc\x8f\x8aè\xcd\xd0)-t\x8e\x8c'\xad\x19\x87\xc3\x01\xbb\xdd\x0ed\"n.\xc2A\xba\xfa\x83\xa9\xc1z\xa8\xb9w\xa5\x14:\x95\xcf\xf5Q\xa6Hzn`\x91w\x93\x9ezk\xf4S\x80\xab\x04Z\xb1bF\xca\xe5b\xcd`=\x02D\xe8K\xf9w\x16\xb0\x04\x1e\x91\xb36S\x01\x9cR\xd6\x1a/\xad\n\xed\xf5\x89\x94\x0e\t\v\xbcֲ\x92׀P\xe6$X13p\x11j\xac\x87N)\x97'5\axi\xa10!=W\xf4\xfac\t\xb0\xd8\xfe\xb0H\x1eJ\x03KK\xd2}\xe1\"\xd6\x1c~\x9e\xae9\x97\xc0j\xc5\x06L\xfdŨ5:\u007f\xe1S\x99{\x88\xd3!6ۘ\xf0r[\x12\x80ED\xe8T\x97\x1d\v\x8a\xc0\xea\x99\xc2U\x9d\xb5\x94P0\x10]So,\xc8deO|\xe6\\\xaa\xe2\xc8b^\xd2\x19\xb1\xfb.\xcf=U[\xe7\rLQ`E\xb1g\r.\x0eJ\x9b\xef\xe4Y\x8a~W\xe9\xa2EU\xba\xe3\x00\xdc8\v\xe8\xae7bAr9\xf1\xfa\x05\x80_~\xf2\xd9\xe7\x1c\x03\xd6\b\xe0\x97Ҋ\x0fݬ\x02n\b\x99\x8cb2$\t\x0fK\x8d\xe6^\xe3I\x95\x9e\x1e\x12\x9d\xe1\x16\f\xdaB\x927\x06\xf6\\\xb6\u0094\xf3EU\x00\xf8(\xbc\xd5ca\x1aR\xca\xc9\x14\x14\x93\a\xfd\x15sѾ¸%\xf5\x9c\xa8\r\x8aJ\xbcPЌC\xac\xe5\x8d\xdco\xa9|\xc8\x13x\xa4\x02\xa8K\xa2\x82\xe2\x1a\x17\\e\x99\x8d\xa0,O\x98y\xdd\x03p\xea\x83\xee\x89\f\x82\xfd;\x83h\xc9r,f\x1dd\x90\x87\xa7\x0f5\xecX_\xbcK\x8e.0\xd7\xd5\xf4\xb2T\x82\xc0\xdct\x82\xf9\xe5A\x96aki6\xb1\xacK\x8c\xbej|\xfdj{\xd0l2\xca\x17\x10R\xf6\xda\xcf\xd8\xcaZ\x89dDr\xe7$_\x04\x91<\xeb\xa4굕FX&\x01\xd5\a)\xdb\xdbqq\xd0\xf9*\xbd\x85F)\xd4\xda\x1d|\x85\x9cx\xf5\xf3\xf9\rv\x99\x8bFh\xcca\xfd\f\xc0_\x01\xf8\x9e\x1c\xae\xe0\x8c\x95\x95O\xa2\x93\":\x93\x1c\xf2\xe8\xba\xec\xf8\x9a2\xb1\xf6\xda\xd2T\xe5\"Y\x88\xaa*\xf8\xb0\U0009df1ep\xca5\x8d=\xef\x06[\xc3w\x89\x8a\x01\xbc\xd4\xf9x|\x85\xd0\v\xbb\x86\x05\xe6\x88RY\xf2qt\xab\xd2Z\xaen\xbe\xbb\xc6#\x88z7\x06J\xb5\xbe\x95\x14\xa5\xa9\x10\xd1\xc9k2y\x8f9\x8bK\xe4\xb7*\xacGd\x93y\x83\x1f+\x00\xf0\x14\x1b\xde,\xdcBkBJ\xd5\xf2\xd1\"h\x1d\x87e\xba)E,~\xce\u05ef\x00\xab\xcaEDX*+\x80y*\xfa\xac\x01\x1c\xa22\x97\x94js%\xfdM\xa0\xaeYp\a)}O\xbe\x8a\xe9[\xe3\x16\xfa\xed\xee3_\xab\xb8͕\x8d:V\x1f\nBS\x8f\x05Xy\x05\xc4d\xf6\xa2\xf2\x94M\x16\xbd\x0f\xa8Nk\xae\xe6=\xbc\xca\xe2jK:u\x85ە\xe2yʸ\x83\xee\xef\xe1\x88\xf7tz>G\xb5A\xb5\x03\xe1+ \xb8?S\x82[H\xb2\xbb\x15\xc8qT\xf8\xc3an\xbe|\x8fl\xccz\xeb\x1a\x90O̶np\xa2\xf2\x02Y\x04'\x96\xd9\xdcsw\x1aJ\x9c\xea\x1d\x88:\xf1\x81\xfc\xbc\xddd\xd2\xe8s\x02\xae\xb8\x17a+\ba!\xa8\\\x05\"\xa7p\x8f\xe1<djS\xbd2\xb7\x94e\x15\xdf[\x97j\xcab=\x84\x9a1\xaaQ\xcbX\xe1\xfe-\x92Nk\xe7K\xebܧ\xf4\x98\xc4EC\x0e+\r\xc0\xb4\x14\x19#\u007f\xb0\xb9\xeaH\n\xba\x00\xfb\xc0%t\x16\x93\xcc{\x16\x16a\x96Ǌ\x06\x8d\xa2A\xb5\xe5\xdc\xf1\xc9ı\xd5\bZ\x0fV\xb9:=+\xec\xd7ʙ\xd8\x13\x9d*ݝ\xc4\"\x8d7R\xad\x90ܳᬰl\x1d\xc05kf\xc5\xe7\xa6d\x81%\xa0\x92\xdcx\xe2\xb0\xc9\xf0\"U\x81=\r\xae\xca\n\x88\x92E\xc5\r\xa0%\x02W\xa1\x86\xb2*[\xde\xebu\xc8\xe9\xa4\xd8\x0f\x01\xbco\xbd\xc0\x18\xb0:L\xcd(\f\x98\xa4B\xf6\xe4\xa2\x16\x84:\xc5˘\u007f\x9e\xd5\x0e\xa9(\xab\x11\x9f\x8e9\x8e\x82c\u007fڞX\xc2i\xab\x02+\x84\x90R\x1ce\xe63\xecCZu\xfaM\v\x97\xc2\xd2p\xc1\xadH\xd66\xa6\x80\xeb\x19\xba\x8b\xb4\xd5\x1c\xa4\xa4k*\xf9ǔf>W\xcc7{\x8d\x1e\xfc\xe4\xcf \x8aYIM\xa4\xdcb\xaa0\"Z\xbc\x85Z+\xaad\x9d\x110\xa9\xa4\x1a\xe1\xc1\xc4{\xaf\x00\xfc\x13ci-\x00\xeb=\x00?\xcc?)-\xba\x15\xb7\x1e\x92\x8b\x06\aD\xd9\xe3@|p\xa2*k.9\x90\x11\x82\x92߄%\xe8\xea\xb1t\x83\x177Em\xd9\xf0Յ\xc7\t\r\xed\xb0W$\xb7[\b\xccxyEcż\x92\xfc\xa2d\x8e\x1b\t\t\x98\xbe\x82\b{Z\xec\x81v\x95g]Q\xadU\xd5`e\xc5:Y%\xef\xe4d\xe0\xca\x1c\b\xaf\xbf\xfe\xdau\x83\xcfL\xc0o[\xb70\x06\xac\xdf\x04\xf0\x8f\xe3\x1d\x16'\xb5rC\xdf7\xa2\xb2\x85A\x14\xd9P\\3H\x8d\x1b\xcf\xe7܄\xa8\x8aͼ\xa7\\\x02&\xfc\x9c\x18\xaeN\xd6\n8\x94D\xe1/Ģ`\xb9P\x18\x85\x13\xb4\x8a\xa7Z\xc1\t=G\xb7\xb0\xcd}\xf4C썖Z\xf4\x95\x9c\x03\x1f\xac\xbc\x90\xbe[\a\x9e\xbc\xcd\x1a2\x9fsn`\xce3\x89r\xbdj\x0f7nx\xaf\xc4]ݽ~3\x03t\xfa\xcf?\xb0X\xe5F\xe4ӏ?\xea\x00\xfc\b\xc0\xed\x82C\nP\x98\\\xe6*\xb3\t\xb7\x82\x9b\xd6\x15\a5`\tSb\x05\x1a\x96\xd2*\x82<+\x91_\x88\x80\xcd\xe7\xcc\x04UҚ\xecq:\xe5w$7\xb9\xa4\xd2\x10%@\xee1\x80\xe0Y\xbd<>\xa9\xa5\x93KJ\xbb\x9f\x12\x89\x9b\x01W\xe5\xad-k}\x91И\xa4\xd5M\xa6\x9ceS\tz\x8b\xc3-\x93+ƕ\xef\xf5_\xa3\x1e\xf1\xf0\xf0PS\x9a#v~V\x00^%\xad\x1e\xad\x81\xae3\xe3Ȧ\x8b\xb2\x02)@\xb1\xb7\xc3\xc0U`U\xb5o\x12\xe9\xe3V9Zr\xc9\\\airle\x10\xada\x81$M\xb9i\x14\x9b\xfd\x922Bc1h\bH\x94\xd5/_\x96\xfdx\x12>\xde\xdfU\xf1W/@\x95_\x931\xb7\xd7P\xec+\x1e\\~\x10'ү\n\xa2\xec\xfeR\xf7\xbaAsc;\xb1\x85\x88%r\xd2\xd9'\xac\x8b\x96\"h\xff\xbd\x02w:\x8e\xa3\xe9\xf2å\x03\\L\x1c\xed|\xc0\xf2ᇘ\xa1\xcd\x1b\x80IfxBQ]\xd8pyWp:M\x105\xe0[1A\x8b{\x98\v*\x95\xb7\xe9Y\x04\x81(\tS\xb0\x9c\xd8\xd3aZ\xbd\xf1\xd7\xd4=F\xaa\x96VK\xcc=\xae \x19,E\xff^@*\xbf\xa98\xb3\xb1\xaa-\xach\x9cI(\xd8\xe6\xc8\x1d\f\xd6\x1c3\xb4\xaf\xff\xdf½\xa5h\x82\x84\xfcNR%ԿV)'\xad \xa0I\x15\xae\xe2\xf1p\x84\x1e\a\u05fc\xa2\xe6\xe5\xb7\xf9z\a\xc0\xbfXXB\x11\x18\xb0\xd7\xf2\xdd\xef\xba\xdc\xec\xd3Ն2*/\x95-\xc5\xc9l\xd68\xe9.\xd6\x03\xaa\xb3\x1b\v\x00\xb5&\"h\nG'0\x8d\xf3\xdd\xd8\xefA\x94\xb7\xf8\x9ei\x04p\x95벑u\xd5\xe2z9\xc0\x89\xc8\xf1\x14\x87\xe5:8\xf9\xc0f\xdcC\x9b\x18\xba\x90t\x91\xe63:x\tB\xbeY\xea\xd9j\xad\xa3(A\xb7\x85\v]\xfc\x8d\xf0\x99\x0fo\xee\xa6\xd6d\\\xfc\x94\x19\xb0\xbc\xa4\xd1\x0f\x00\xfc\xae\xff\x81ZP\xac\x1c\xc7H\xca\u0093\x87(\x02O4\xe3\xe4u\xeb%\xb1K[fuF\xc0\xe2\x12\xe72\x84\xb3\xc4[\xf9\xb9-\xa9\xa8\xce*B\xb1r\xb1\xc7C\xe7'\xc8*Ӭҁ\xaaMp\xe5\xc9%\xb6)\x0e\x88\xb3\xf4O!B\xbfMƕ^\xae\xe5\xb5\xee}l\xd5Ƶ|\xec\xe5VqD1,:#\xfbi\x12U\xc5\xc5m\x87\x12\xb5\x80t-p\x95\xec\x12\xa1i\xcd\xddݛe\xe4\xbd\x04X\xde\xf7W\xc6ʚ.\xc7\xda\v7\xfa\x805\x04\xfaC\v\xbb\x86\xa3I\x8aLbN\xe9\xa7S\xc5M\v#\xb6\x90\u05ed\xd4V_\xb8NA\u007f;\x8aR\x1b\xd6+[&\x13J\xa3EI\tP\xebv\xbd\xc9l\xa7 \x8ad\a\xc4Oqx\f\xd9\xe3K\xb9m[[W)\r)\xc9z\xa9\x99c\x12\xba\xd1\xf8\x96\x8e\xdf*˧\x1c\xb4\xcf[!\xddΫ\xca=C[\xf2/\xf3\xba5\x9d\xbaϖ\x976.akX\xa0O\x99\x00̀\x1e\xc7`\x80\xa6\x0e\xb3Ò\xe4^h\xcf\xf0\xd2\xec\x86oIy\xf8ƒ\xc8i\x85X\x8bƔ\xf9\xe9\v\x02\xe6*\xd43\x80\xa5\xa2\xe4\xc9Ed0\xc5m\x14\x88\xd1TiL\x890\xf5_\x9d\xea\xd0\xf5\xbds\xc3gp\x05@*\xec\n#\xf1W/\xdcU\x1dX\x9d\xc89\x06\xe3n\xd5\x12,\xff(\xb5\xc5\xf2\xba\xf7,(\a\xa1\xeb\r\x04\xaaB\xe2\xcdj\xebh\xfd\xf4Ԛ.A\xd2ZOu\xb1\xaa\nT0c\x1c\x06,\x1a\xc6˯\x9d\xfd\xa8\xac\xe2\xa8\x1e\xf5\xdcp\x14\x13\x80\xd9\xc6\r1iY\x8d\xec\x94\xf9\xa1@\x9e\xa7S\x1ele\xf8ܕ\xb6eq\xd9{\xee\xba\x0e\xaa\xef\x97.U\x82`\xdfB\xf7J\x02j훻\xe4?\xd7\xd4`\xc0\xe6\b\xb9\t&@u*\tVo\xd5+\xd1܃\u05ccyK\xb3\x89\x96[T\n\xe4\xd5\xfe\xf9\x91耓\xf2\x83@Z\xe7\xadE\xc1\xca\x0f\xaf\x19B]\xa9Q\x16g\xda(T\x83V\r U\xbc\x8f\xa3\x1c\xb4\xc2\xeb\xfd\x14`q\xda2\x02\x86qt\xf9)\xd9H\x03\xd5\x01\xd7\x02\xfc\xdc\xc3R\x95X\xf3Ԗ^\xa55\x89|\x10\nZ\r)t\xdd\xd4\xd9VI\xf9T\xb9\"f\xeb\x0eg\x80+U\xea\x12[?\v\xa9\xd8(W\xcc\x02\xd7n\xd7\xcf\x1dr,\xffg\x9a\b\x84\xdc\xfe:\xb5\x81\xe7\nb\x94p\x9b$\x97\x8aϘ(\xbb(\x1d\x93\x8a\x9dy\xe9u\x94\xcaV\x8co\xb9\xb4\x98\x1d%\xb3\xfc\x1b\x8e\xd6g\xc0\x19W\x1e\xa2-\xc0\x95\x8d\xef\x15\x81\x8b\x8b\x1e\x86y\xfd\xbaŪ\xbe\xf4\x00>\xb5\xa4\xb5^\x94\xe5X\xa3Uk\x8e\xea\xf2\xb8\xf0\xbc\xec\xbdM\x88\xc99}d\t\x1a8\xe4\x0eb\xb0\x89-5\x8f_\xb0-\x90\xac\xf4\n\x135\xb7f\xf7O#\xae\xe0\x0fR\x122\x12\x11)YL\xa4\x14vW\xfbɄ6 \x9dk\x05\xf6\xadKe\x88\xf8\xbd\x00\f\xce\x18tp\xcb7Z\x87~Ο\x94zb\x93F\x8b\x0e\x88\xe5\xc1rtD\"\x18\x156T\xb54O\x1d 5\x01W\xe1\xf0v\x87\xc6b\x9fF\xdb=\u007f\xca\xfeG\x00\xde\xfb\xf4㏾PE+\xc6\xfbP=\x8e\xcb\x02K^6<\xe5B]\x91ܞ+nI/Yj\xcb\x1e\x87qYDJ\xb7\xd3o*\xb9ȳZ\xa1\xcb.=#7\x16\x16\xfb\xd9\xf2\x01\x87&\x88\xf3\x11\x11\xfa\xdd\x0e\xbb\xeb+\xf4\xbb\xbe\fV\xdfƗ\xcfc^\xb8\xf4\xc8O\x8bQqJ\x8bw\x80J\x8d{cK-\xd62K\xae\x1f\x93$M\xd2!\xb74\xbd\x96\xeeX\x95\v]XaQ\x14\xb1h\x95͛\xaee\xb5\xbe\x8b\xa9l0\xd7\xd68֑\x16\x12д/\u007f\xb1\xac&ࢤB\xea!\xa3vE\x81\x15\xe65\xaf\x17\nCYZ\x04֪\"\x95N\xac܀\x87\xaa9\x9d\xc4|\x19\x1fp\x93\x9dM\xca\x16\xc3\v\xbdn\f\x13cA_\x94[\xf3RO\xacܲ\xdb\x04>\x9f\x15WX8\xe0\t%\xad\x93k$\xb1W\xe2\xed\xbfL\x16]\x02D\rp18\xe0\x9b\x92\x8e\xac \xaa\x98\xe5!\xfd\xfaݺѾ-\xb8\x84\x14t\x15֬\x05?\\\x875\x86\x82G\x9arcc\xaa+\xeb\xd1/C|P\xa4\x92\xe54\x1c\x91\xa1\x9dI\xc0\x84_\xdc|\x89v\xec\x96o\x889\x17\x89\xef\xa8\xe9h\xfc\x02T\xf5\xa0eZL\xc5\xcd\x1a\xceaYY\xea\xc4R\r]\xdc\xd4\"Τ\xe7%w\x13X\xd95\xb2/\xa9\b\xb5odT\xae\x0fnH\x8b\xa0Jnk\x81\x04\xd1\xfd\x12\x01]\xa7Z\xb2\xb2\xbb\x18\xb0\xae\xccW\bH\xa6\x86P\n\x05O\xd59\xf3\xf0h\x8d\xe4`\x06\xcfb\xba\xc1\xc6\xd3ƨ\tœ+\xa5\x91\\\xf88'\xa5\xebM\xb6\xb8\xc0:R\xec\xf3o\x8bZ\xb2\xfev\xe2\xd49E\t\x94_@+\rZ\x8dQ\xc15cI\xa6*\x81R:\xfb>\x98\xf8`e\xf7\x89\xd7G\xc0\xee\x9dl3\xe0\x94\xe2h\xc4/\aݧ\xe9t\x0f\xd9\x0f:\xa1\x12\xb882$\xfc\xd6s}\xbfs\x9d\xb3\xa8\xf2赚\xee\x04\xe0;\x00\xbe\x17\xba|^(X\xcbO\xab}\xed\x1e\x9aE\xe5\xd3]2B\xf5\x8e\xc0մ=\xe2r\x8b\xa3S^.\x17\xcb.\x96\xe5|\xfa~n\xc7Mg\"a\x13\vJ\\|\xa9g*\xb9\x81\xb5\x8b\xe9\xe5%\x8e\xed%\xac\xd5\xe4\x81\xe3s\xacF\r\x82|y#i}x9Z\x9c;\xec\x16\xb2L\x9c\x0e\xe4p]\x9ez-\xb7ŵR\xccq\x16\xffL\xe8\xe0\xea\xf6\x16\xad\xdd2\xedl\xf6\x00~K4/\xb5\r\v\xeb\xe0&\x9cn8l1tH=1\xc2\\\x8b\xa0\x9d\x0f\x04\x13Tзbicc\x064\x0e\"!\xec\xa2\x11\xa0)w)\xc86\xce,L\xda\x10(\xe2BXJ,4\xbfy\xc0f\xd8\xf9\x82O2\x90(\xf5$J\x94tTC\x98\x9d\xffD\xbb\xf9\xc0\xad\x94\xf6K\xee9+kkZH\xf9\xf2\xfbdӎ\x99qu}m\x12l\x91o\xc8:\xbdn|\xc0\xda\x03\xf8\x89<\xc8:\xaf\xadc\xac*\xedw\xa0\xcd\xf4\x05\x8c\u007f:?4/\xc8;Z\x9c`j\x810>h\xb1W\x16\xa1bw\v\xf9\xee5\xa7l\x88j\x00\xf1\xef#\"\x1f_^\xe7u\r\xad~~k\x87\x99\xcdւ\x9f\xde\x10\xad\x03\xcat\xceY\xac\xa5\\\xf3\x95(C^v\x19\xb9\t\xb8꜌R=\x9d\xfc\xba\xbaڛä\xca\xf3\xf9\r\x00\xd4{\xfcտ\x10\x01\x99\xc3Pl\xaa\xf5O@nR\x1d\x17\x10\xd6\x19F\x83\x1f\xf9\xbf\v\xd3\xde\xcfk\x89\xc0\xa9뺼\x86\xd5F\xd6U\x8e\xfc\f6E\x94\xe1|\xce\xd7\v\x9f\x95\x06-\x96\\\x94S{\nF\x87l\xb5\xdb\x19[\xff\ti\x19\xb1\xdeq\xb1i҅\xb8a\xce$\x87.X\xc5b\xa9\xce\xc9Z\xe9\xaewJ\xe18'\xb4\xe5\xde\xfe/\x01tV^\xe6\a\x98t\x93\x85\xa1\xe5\n\xee\xc7\x13\xd2ף\xb3\x98\xb8\xc0cId\xe4\x92\xec\xb2\x05\xbe\xb3\xf6:U\xb8\x00$(@\x8a\xcf!\xe8\x165\xf1\x1d~\b:\xf5\x99\x92\x1aĊn9\x9b\x02\xed\xb7\xd15\xacq㛱\xaaA\xd0\x0er\xd7'G\x9f\x14\xe4\xb9\xd3\xeb\x94\x01\xe8%\xc1\uf0d5\aZ|\x06\xf2\xa0\x15\xf4\x99y.\x8b\xabR>\xc0\xef\x02\xd8[$\xf8\x1dL\xa4{Ҝ\xcb'\x83\x86\xe2dz\x18\xa1\xc7I\xeda9\xc0\x9e\xf0_0\x98\x9c\x01 2r*\xc2b\xb3D\xa6\xf9\xb2\xee`n\b\xe8<;\"\xffo\t\xc0\xd6~\xd4\v\xfe\xac>\xd1Q\xd90\xa2u\x93\xd6lX*Q\x00\x02hq\xa5\v\x9a\xb7'8abԛ\x85\x9b\x92\xf1\xe6\xd5u\x1dv\xfb\x1d*od\a\x13O\xed\x00\xfc\xd7U\x1b2\xa3\x8b3\xbb\x84S\xf3w\xd6#\xf40b\x1c\aӽv\x96\xabqW!\x00\x9a\x17\xae\xa1\x0f\x80\xf6d\x14\xa3\x87\vkI\xa1\xf7s`$1\xbeLHx\x8du\xb5\xb8\x97\x92\xd5T#\xec\xf7H\xc0\xc5\xcc\x18\xb5ƨ\xf5\xb2\x89\xe7[di\xad\xf9]\xceU\xe0ʹ\xaa\xe9\x01`A\xab(\x9a\x87\xb0q\x063/\x1b\a\xd7$\x856rZ\xab\x80+\x13A\xbd}\xf5\x8e\x9c\x9e\x94x\xf5\f\xdc\x10\xf0\xafj&\x87K7\xa9\xb5ӂwç\x8d>&۶ڞ\x19l\x12S%\xdd-\xf7\xa0Jɦ\xb2\xf0\xfe)\x11\x8d\xf2'[\xce\xecn\xd1\xd1N\xe9\xa9'\\@:\xd1\x05l\xe5\xb1Z\x01́\x95\x11h\x9c\n\xc4\xeb{\xe2=+\xc0ڀ\xb7b\xad\x01\xad\x83\x82\xf5M,\xf4\x80\n\xc9H\xca0'A$n\xe3\x85X\x82\x98dk\x8b\x98\xaaN\xc3\xdaDӸ\x16Y\x8a\x98_\xdf\xdc\x18ʧ\xee\x80\xec\t\xf85\x00?\xce\x1e\xe1\x9ci\x1b\xc4as\xd4Y\b\x0f\xc1)\x00\xc3m9\xd0b\x9b)o\x8a\xaa\vj\xa05\xe6\xbeSy\x8c\vQ[,\x9a\n\xd0*\xe6\xdb \xeam\xf8\xc4]\xb9\xa9\xb0\x9d1\f\x1a\xc7a\x84\xd6\x1a]\xa7\xb0\xa7\x1eD\xdd\xdbWC\x9dɢ\xa4J0c\xad\xc3\xdaŊ1\xa6\x06\xe0\xa2\x02\xb7\x1a(P\xa4{wy,\xb4\xdfXX6\xd3\x03R\xbe\xf2\xd4\xcb\xc9\xd28q\x81\x8c\v\xacL)\xd30\x1cQ\x9b8\xfa\xcf\x105\x9f\bG\xc5C\xacT#Qɧ\xb7\xae\x1e\xc35N`f\xe8q\x98t\xb64\a\x96\x19k\xf6D\x18h\x92O1i\f\xa9\xe4P\xffV\x82еD\xb4\xb6\xec\xba\xcaz\xbd\x18\b\x95_Ȝ8ٷ\x02\x98bW\xdd\xc6\xcfb\xd3\ti\x18\xa7\xafq\xb4r6o_vע\x8f\xe0\x1a\xee\xaaѲ\xa2\xdau\x17\xebCe:\xf2\xb8\xfd\x039\xbah\xa3\xfb\x8cP\xb0\xaf\xcaM\\\xc9\xe5\x05?\xcfI\x8e\x9b\xd70\f\xe8\xfb\xbez,{\b\xe9\f\xb2\x83\x91\x8a\x16f\xaa\xc09\xaa\x11t\xbe\xf7\bR\x04\xd6~\xa6\xbc\x06\xf3D\xb0+\xd5Aa\x16\x1c˝F~\x87\\\xc9\x05\xe3\x84\xf5D\xb5\xa0%(x\x92\xa0\xb3U\xe4\xa5\x1a\xa3H\xab\xfa\xc9I\xf2ӵ\xfc\n<\xa5\x01\xa5\f\xf6\xab\xc7\x01\x93F\xb2w\xed\xe7\xac\xe9\x96\x13ԉ\xd67\xe2\xac\xfe}\xaa\xb4'\x90P\xf6\x92\xb1\xb5֡\xb2\x83''\xb7\b\xbc\x1b\xb2&XiI\x8dLv]\x9aj--\xe9\xfeI\xf0Z\xfc\x8f<>\xdcCu\n\x8aT\xd5\\\xf7\x10\xa3\x83\x88@Gz6\x16\x9fv\xae9\x14F\"hs8\xf1W\xe38\xb9 \x935\xc5\xe8v;\x97ǥ\x90\xc4ƹ\xad\xfc\x84V\x93Kh\xcd\xe0\xb8\xf8\xb4p\xda\xe5\xcc\xf5\xa0%\x93o\xbd\xc5`\x96\x11\xec\xa3F\xb0\xf2\x17\xe8j+\xacE\x05\x16\x13W\xd5\xf7\x9d9\x04\xb4\x139\xbc\x14\u007fUj\x1c\xc2\xe7\xceTo,\xb6\xa3\xad\xc0\xca\xfbl1 \xe4\x17\xd0\v\x9c\xb1\xdds\xb3kʞ\xc8\t/\x16wX\xb9kV\x1a\xa7\xb9\xad\x93\\ČЧui\xc7q\x04`Tu+ƴ/\xba\x1dRO3\x84\xb5\x80\xa29j\xb5\xde)\xd4q\xf7S\x18\xfc|,\xcdz\xf2g\x9d\x9eQ~G\xfb\x85\xd2\x13B\xd7%f6\x11\xd1\x16\x00\xfdk\xfb\x95\xe8R\xad`c\x03\xcc\xcdx\xa8D\xd2a\v\xe0t\xaeB\xa0\x9b$\x98/\x14$x2\xa4~-h\xad\xe9G\xc9Y!\xa5bd\x99\x05K˺}d(\x98@ߟ\xf3\a}\xfef\x12\xa0UAƵ\xcc\xe4\xa85\xf4\xa8M>VW\xed\x12r\xdaM\xf0\"\b\x85.\xc5ˍc9\xac)b(+a\x84yZ\xa4\x94\xab\x17l\xb3\x0e<\xb2]\xb8Ϛ\x8e\xb8R\xe7\xdcXq!y\x82\xa6H\xf8\x06\xee*\xd5\xfa\xeb\x94S\x9c=i\x9b\x1a\xd0 j]r\x97\x03+\xda\"#}\vk蒇\x90\x04\xa6\x96\x93\x8a\xd2N4x\xea\xc0\xeeS1\x89\x06\xa5!.1\xc4\xd6\xea\x81\xd7\xe85\xf2]\xf9\x1cJ\x98\xcf\xd1H\xaeO\xd6\xfd\xae\xaa\x89\xac*Q\xbbV\xa5\x81\x12\x1d^%*\x8d\x88\x16\xea\x0e~\xbd\xe6\x14\x19\xf4\v\xa2\x8d\xba\x82!\xdaIQݾ\xe1\b\x10\x04\xff\x99N\xdfQ!\xef\x15\x17\xa5&N\xd0V\x99\xe2\x1a\"\xbdja\xaf<\xed^^\x1b\x03q\xc5\xf87\xa7\x9f\xd8\xfc,/OΗ,\x9fs\xe7\xfc\xfc\xb0hU\x89\xdf&\xfa\xe7\t\xbcV)o\xab\xa4\xb6\"\xb9\x856hV\x13q\xed\xb3\xe3ő\x85%\x11h\xa6\x93K\xd8\xecƀ\xd18\x99\xa9D\x9e\xbd桿\u007fb*\xa5\xa0\xfa>4k+\x1e\xb83\x84c\x9c\x94Wҟ\x8a-$j5\xd3%\xd0Z\xeb6ll\x15\x04\xf2%o\x13\b\\\xc2\xcaz\xea/\xbfKt\xc0\x17\x19KK\xebP\xd1\xc4\xed\x872g\x85\xaa\xf7\by[~\xfaV\xac5\x9f\xeb\xd47\x8c\xb3V\x1d0\xf1\xd7\x05|\xcb7\xa1\xf0\xb2h)곖u\xb3hR+UJM\xe9\r\xb4\xec\xbe\xe6\x8b\xff\x91'\a\xd3~\x8c-\x13BO\xaa\r˹.\x99n:b\xed\xe0\x05O\xf3\xc0%~y\xads\xff\n\x1cVs\xbd\\\xcb\xdc%~\x17\\C\xeb)\xbd\xd2(\xa3PD\xc1\xb8}\x05\r\x82\n\x03S\x19\x870{\x1fU*\x06\xe1~\xac\xfd\xfb\xe3\xf1\bf@\xf5\x1dF=\xa2\xcb\xef\xff/\x01\f}q>M\xfe\x15e8!\x81\x9a3&\xea8\xe9\xa8;6\x8c=\xffz\xbeX\xd7w\x93\xfa\xe0\n\xeec\xa1\xc8pbZA\xb5;\xe5Y1\x8b\x88᥈\xf7\x9a\xf2\x87\xb7\xcd\xd5\xdaH]\x81\xcf\xc8aц\xef\xf3]>\x89\xbb\x82G\xbc\xfb\xde\rhޑL\xad\x06U\x9d\xb3*\xec\xfa\x00\x13(\xef\xbc\xe1x|0׀S\x11μ\xfe-\x80c\xd2%\xa4\xc0o\r\x9b6\xc6Z\xd4H\xc9Kk\x06\x93\x0e\xc8\xdc0\xad\x1fP\xaaC\xbf\xdb\x05%;\xe4\x9dh庼\x13\x9bJ\x10\xe5\x0f\x82\\\xfdW\\K\x18\xff[](\x8f)#T\xf8\xf2Zg\xf9\x9c\x02\xa8k\xac\xb1\"\xb0\x11\x99\x9a\\\xbf1\xccR/\xcbח\xa3\xc8\xe2\x91A\tA\xc2c\x18P\xf4\v\xa4e\xd4\xf3\xa1\xdf_\x8552\xca\xe3\xa8\x1d\xc0*5\xf5\xd9\xcc\x04\xf6\xfe/\xeb\x12r\x96\xc32\xe4\xb82\x1d.8Y\xa4\x13nZ\xf6z\x0e\xa6\xe6\x8e@\x06\xacT9ITp\xcf\\d\xab\x04:\x95\xa7\x1ag\xc0L\x15\x16\xd3\xe2ߗ\xb0v\xdeR\xab*\xf5L~r\xe2Y\xb8\xac\x8cK\xd8\xf4y|\x1e\xf9\x16?1ۖ\xbdP\xe4:\x92\xd1\xfd\x9a\xbe\xa4,\xf9\xc4>)\xa6>ph\x8a\xc5ܕ\x817\xaau.\xacj\xb1R\x80\x9e\x8c\x1a\xd5g\x0f\xf8\xbf\x06\xc0\xaa\xb4\xbdm\xea\xc1d0(q\x02\xa5\xefI\x82\xec\xe8Yծ\x87\xb2I\x9eLK)؊\x9a>jந\xc5M\xdc0\x9f\xeb\\\xbc\xd5\xdb\x00Vq!mK\x9a\xc3)\x80(v\xfc~\u0084\xbe6*\x0e\xbe\xec\xb7\xd4qɶ\x19\xb3\xfd7\x9d\v$\xc0O2J\xc8\xe2O\x97\xd79A\xbb)h@k$\xac)_\x9by\x00\xb2i\rpr\xcc~\xf9\x8b\xf4\xd4\xf6=\xecm\xf4\xe2\x822\xb9\x17\xf3\ueaf3NR\x00U*\xe1ɶ\xd3\xf6J\x1d\xb0\x86X\x8d\xb8\xa4\x8b\x00\xd5[bY\xb5T\x03l\n\xd0>\a\xb9\xd1uk\xf4\xd9W\x83\xba\xdf.\xae\xeb\xc2dfi\xfd\x9a\xf4 E\xca\xd3K\xe7R\xb7\xd3\x10\xadD\\J\xe44\xac\xa8)\xb3\xb82\xa9\xc1\xc25\xe8\xc8\xc2F\xcdG\xcca~\xe5\xc9Z\x84:\xd3\xec\xf5\xe1\xe3ػ\xe5\xe5\x93L=\xdc\xfc\xee7r\xe6\ng\x16\x1b\x01\xe9Jp\xc1\xad[\xe4:-ږeTR\v\xee\xe9%܂\xb7\t\xa8\x9e\bZnf\xb1\x9e\xd3\xdae\xc1c\vjg3\rV\xa7\x8a\x05\x9f\xd0\xe6\x14.e\x17}\xd2F\xcb\xe5d\x052\xf3\xa1^\x97\xd6\x1c䒑R%\xb3C\x95-,\x1fv\xc8h#\xb9\x0e$&\xb1ԓG\xf6eY\x999;\x02}\xbfs\x89\x9f\xd3ͦ\x9f<(R\x8d\xcbdr\x9aA\r\r\"\xb8\x15d*\x14\x19xk\xb0zym;&gt\x01i\xc3\xcf\xf0-\xa7\xb81\x8b\x93`\xb2\x89\x97⺌Ǌ\v\x98Õ\xa0E\x19\xbe-\xbfw\x86\xe3\x010\n-\xdap\xe4~\xef\x88ԖUE\xd0\xe2\xe5\x00\x85-\xfef?\xd9\a+\x9bc\xc5\v\x03\x8bMV\xab\xe7w\xb7.\x82\x9a\x13\xb12\xe93\t\x93\x92Ƽ\xb4Q\xce\xcc{\xd0Kn\xd5\xd9ƃ\xa5\xf5\xb2\xe2\xfat\x89.\xe2\x12]\x92\x10\x8a\fd\x95\xd8+\xacI\xba\xac\x12pq3\xf7E\x81_\xb5\xec\x84忆qt\r\x1a,a?)\x15\xeb\"`qѺr\x1fJ\xe8\x02\x05P\x13>e\x04\xfd\a\x83\x96_\x16h\xbc?Q\xc6\a\xb7.!\xa7\xec\xc8\x04\xf8\x10fr\x8eW\x82U\xebi+Y`t\xe25\xab\xb8\xaa\x17K\xe92 \x91Ys\x8f\x99]\xefGF\x9d\xd3\x14'J\x03A\x1f\x83\x00x\x03\x19\x9d\x8c\x8fA\x19P\x92\xb4\xef \xb4\xec\xcb\x19\x0f\xd1|\xe9qtƋ\xfd\xecqԹ=Uv\ti\x91k\xcbN{\xc7\x11\xedF@\xcc*\x87\x825\xc6qX>\x88\xd73\xbb\xeb{\a8ɦ[\t\x02\xdco\xe0J\x82Յ\x13y\bʴ\t\xaf\x01\xc7S\xb7\xd1\xdbF\xaa\x9f\x1bx\xce\x05\xea\xb4\xd55N\xed\xc8\x13\xb9\x82\v1\xcbH\x8c\x90\x13\xcf1\xa5\x1cP\xda^ʋ\xc8g\x81+]\xd9AY\x8fő\xeeV\v\x8b\xb32\xc9\xf7E\xc0\x8arh]3S\xea\xba9\xd1̋Nh\xad\xa7\n\xec\x8cRe\xd7w\xe8<)\x89\x90\x00ϰw\x11WE\xb6\xad\xbd\xe0۟\xb4\xf8\xe3\x1c\x9f\xe8\xfb\xd4\"L\x82\\\x8b\xc6҉\v\xfc\x85\xc3\xda\xd6\xd2\xda\"r\xb9vNY\xbaF\xa1\xe4ˁX\x94\xc9\x1f˖\xd7\xf2$\\g\x82\t\xf7<\x9b\x12\x8a\xe41\b\xcc \x9a\x94[\xb4N&q\xbd\x01\xf0W(\xe7aI\xb8\xb1\xd4hv\x96\x96\x1e1\x0e\xa38\x02\x93\x03\xaaL\xa2hC\xad\x9d\xcd\xcfp\x0fm\\ɭ\x1b\xa2\xe6\x94.\xe3nA9\x10\x12\xf8\xad\x96\x16P/\x80\xf3h7\x93\xe7\xb8j\xef\xbf\xc2*\xe7\xda͖\xaawL\xad\x15ۓ3\xa2K\xb8\xd8ZT`s)\xc6&\xe1=\x9c\xda},\x98xag\xea\x80c\x1b'\xd7pr\x13\xc5\xd7\xef\x03\xf8\xfbO>\xfb\x1c}\x8b\x81;\x8f\x95\x92\x15\x10\xd93/\x85Tھ\xefd\xd9\xdd\\\xde\xd5\xe2tᩰӳ\xbc\b\x17\xe8xl\xbbQ[3\xd6\x00p\xb0X\xfcU\x11\xa9.\xe66\xe8\x8beu:\xf0m\xc23ERA9\xd5\xd7Z%\x8f\xd4\xcfD\v*\xea \x9d\xec\xba\x1cuE\x97\x1a\xc3R\f\x12d\xabVJ[O\xceh\xcf\xd7\x1a\xb2\bZd\xfe\x97:\f\x82T\v\x00\xe30\xa4\x86\xf2o\x00\x8cE\x970\xf2u\n\x93\x15\x11o\xd1\xc0(\xd5Au}h\x80\xd4D\t#  )\xb5\xe1L\xa7,'\xa4[\x02~\xa1t\nz\xef\x97\x12S_\xc0j\x8b\xb3\x84\x9b\x9bx\xe2\x1c\xe3^\xb0\xbc\xb9\xe2>\\\xa4ݏ\x96\t\xd7U\x99k2f\xb5X\u007f?2kԩ\xaeq\xd1c\xac\xb2\x15)?\x16~\xf4R\x8f:7\u007f_Ë\x126\u007f\xa6\xcb\xeb\b6\xad\x90\xf8\xe9}~\xd7uAiO\x8e\xdcΞd\xe7\xc8PFA\xd3\xfdD\xf0s\xcd`\x8d\x96\x91T\xb4\xfa\xc2Am3\x8fM\xef\x97RW\xca&H5XՈ\xf8\x91\xe0.\xb1\xdf\xe4\xc2\xe4XaM\x93Wo\x8fخH\v\x03#Y\xdcQK\xc8K\x0fiۚ$\\\xed)B\a\xb0v2\xea\xc30\xe4>\xe4X\rX\xee\xe3\xbd\x0f\x9e\x1aFP\xfd\xecXߚ\x12\x11\xb0B\x03\x02\x1f\xa8,َ(\xd7\xe4\x1c\x1cF\fZ\x81\xa5'\xd4<\x89\xe9\x19R\xd9\xc4Z\x8b\xe0\x05\xac6\x03\xab\x96ÂJ\xf7^9\x8f%\x00\x93z(Pf\x8d\xaaҶc\x9e)\x18s]\x9d,\xee\xaex\x8cd\x86|\x9b\xa4\x93_\x9c\xed\xf4\xf6*\xf7\xb1*\xda\u007f\xb4\x1c\x12\xab\xd1\xeeh\xb8 \xe9..\u007f\x99\xccSe\x1a\x1c\xd0\x1a\xc9\x15\xa9\xc8S\x02\x88\xb5\x1b\xa7\x86\x8f\x10\xb8\xa9\xdct\xf9n\x8a\xff\xb7\x14\xb9\x95Z\xebi\x11}ە4\xb7\xb0\x8e\x1f\xa3aFM\xfe^\x02\x00\x17i\t\xde\xc1X-\xef\xed\x05\xb0Do\xc8h?\xb9vz\xccI\ao\x8d{\x98\xfa\r\x81\x93rM\xb6S\x16\xac\xd5g3&4\x17?\xaaX\xc03uR\x11\x86\xdc\a\x91\xf9x\x90Q\xb1\xeb\xa0:\x15\x90ԋ\xc1\xcb\xf0E\vpI$\xf5\xad\xda\xf2\xbc\xb2id\xd4d\x82\xa2\x94\a\xf2\xea\x1d)C\x98\x120)Ij\xfd\x02Z\x1b[\xc6[\xcc\xfb)\acы\x8a\xd71s~3r\xb98&^o\xda{\x864\xd7\x17v\xb2*1W\xcb\a\xe3\xeag'\x00\xe38NZX\xb3\xe3\b=\f\xb9\xf1\xa2:\v\v\f\x95\xe8\x17\xe6\x06\xd8\x01\x95\x95\xf9\x8b\bDZ\xf2Wb\xd9K\xa4E\x94\xea\xf3G\x02\xf7\xc0\xbe\xe8Wk\xde\xd3)\xee\aQ\xd0\xee\xabt2S\xc6\xf6~\xf6Y\xedq\x97\x91K\xb9\x84+A\x9e\xa5\xfb.\xdc\xeb\xa2\xe1HT\xd0{\xf2\xd8\xe5\x00yE@A'Ʃ\xe4z\xe5y\xad%\x86Q\xa3K\xa8\x8fG\xb0\x1e\r\xb52\xb9\x85\xa3\x1e\xab\x1e\xab\xa8\x87թniɰ\x9e\xd4\x0f\x11\x95\xef̙k\xf3\a\xa8nҼJ\xf1V\xfef\xa78\x9cJ\x8bKS\"\x81n\x917\x15qF\xc5\x05\xd1r\x92\x93\xaf2\x81:\xb5\x868#9\x96\xa4y\xae\xd9\xed\xa9\xb1\xf5\xb8;\x8e\xbe\u007f2\xe0\\\v\x02\x89\xb5˗\xb8\x97\x92\xdcR\xc5!95\xa6\b\x95|\xe7\xf5\x97v@\x8b\xa0\x95\xe3\xcf2\xfc\xd5`\x94S\xed\xfb\xb4֓;xJ#U\x81 \b\xa4\"\xfc$/\x17\x9e\xf4\n-\x81\x89\xbb\xeav\xbd,}\x9a\x96!\x95\x89F\xc9\x1dt\x9fD\xe9<\xac\x18\xc8V6\x88\xa8jK\x9fӓ\x8fr\xc6\x16\v빁\xd5\x19\x1a2\\\xee\xd6\xdb\xef\x86\"\xf7\x8d*\xb9*\xae\xbd\x9f\xa2~\x9c\xaa\xc9\x00\x9d\x13\x9bcw\xd3\xf0\xc7\xcb\xc4\xe7Pp\x93]\x8f\xfbڎ\xabm\xebV\x8fS\xf3T\x18\xf5b\xd6\x1aJ\x11F\xad\xab\xae\xa40\x85\f\xb3\xf7\x15g\xba\xfa\xfd\x04\x17o\xf6\xa3\x89\x9dBߧU\x98\xd9\x12\xfaq\x12\xad\xdf\x10\xb5\xe0&r۪[\xcf\u007fT\xean%O\xc8@+\f\x81j\xe4\xb3\x02\xabJ\x97/\xe5*={\xf5\x89\x06\xeb*\xbb\xed+]=\xb1n0Js \xff\x9e*2\xed)J/\x02\x91kT1\xeb\xb7s\xd5t\xcf\r[+\x0e\x05\"h=:w\x90\xade\x05`\x1c\x8eU,\x8c\x02\xf0\xf35\f@\\\x98)I\xbft}\x9f^\x9c\x86\xa8\xae\xdd\xfc\xe4\x83Xf3\x05\"\xfd\xf1W,ձ\x86\xb7\x12\ued7aJ=\xb6\xee\x9e\xd3\xe6\x15V.'\x0e\x0f\xbe\x04w\xd5j\xa1\xac\xb4\xae\xf8\xc4g\xe0\xd4\xe1U\x1a\xabL^W\x15\ra\xddp\x9b{\x15\xad=\x9f\x84w\xef\x8d<\xaa\x98\x8cgn\xb3\"\xa5\x14\r?\x17\x91hRjp\x11\xcc\xf4\xeb\x00/\x0f\xeb\x0fkF=\xdc\xf8Aƿ\xec\xd6)\x82\xea\xfbdc\x87\xdcd\x89=\xfej5\xd6\xe3\x13p\xe3\x94\x01\xda\xc0*\xe3\xe8t{\xae\x16\x15e@\v\x17\xb2\xaex\xc5\xe1s\xf1u\xb0\x86\xffk\xa0(bk\xd6߫\xda7*\xbc\x94\xa0`\xee\xfcuh\x8b\xa4\x13R4\xf1-\xcfa\xb6\xfa\xe7\x98\xf9+Ӳޔ\xe4dF\xf4\xaf\xe1\x95\xe6\xfc%\x80_\xa5\x9dN\x0e\xfb\bzm\x84\x92\x99\f\x8cY\x04_\x1c\x90\xf9\xe7Lu\x06v6\xcaX\xa2\xdfV\x98\xe2H\xf1hXF\x8d\xa8\x01\xac\xf0\\\xac\xab\xc4f\xe2\x06\xf7\xef\x12\xd6\xd5c\xf2n\xd5\r3Z\xee\xa3\xc1\x03 ?\u007f\xcb\xe3Ղ\x84j?\a0ڏ\x14y\a\xcb\xf5\xfa3)\x00\x00 \x00IDAT\x0e\xeaT\x9eyJ\xfb \\9%Z\x17\x93\xa8\xff\xc8RW\n\xc0W\xe6+}\x82\xfa\xdaWN\xac/\xb3T\x89\\7\x9c\x1a!:\x8e\xfcq\x96\xac\xabD\xfd\xe0\xdc\x19\x04K\xa9f\xc9-C\xa1~/\x95\xeb\x12\x97\x12\xf9\uea54L\x98+t\x8e\x01\xf8)\x82Uf\xf3qÂ<;Xm\x94\x17u\x0eK+iQZ>3\xe7\xca6x\x06b\xff\a\x16\xd4;\xe3\x02}\xef\xbdTu\xe6F\xf9ZAM`\xfd\x1c\x90i\x8aa]\xc1\xb9A\xac8\x16_\x03\xf8\xe9'\x9f}\xae-`\x1daı$BmNhd\xef\xbfyW\x80\b\xe8T\aE\x89\x8d)\xf1!\xe4\x1b\x99\x91u\x95\xa8;\xa4B\x97\xe7f\x9d\xf6\xd6Ӳ\xb1\xcb4=\x15Kc\x85\xfb\xc7'n\xfes\x91\xed\xfc\x88c\x12<\xdb\x1a\x80\xce6\x91h\xe0\xe3<7\x0fQ\x85\x05\xc7<s\xc2\xc3\xe0\x92U\x98z77f\xc2{\x01\xbb\x89ǚ\x92FuZ\xaf\xebk\x00\xaf\x1d\x16`J\x86\xcdp\u007fzεr\x86\xd5\xf2O\x16\xf2\xac\x8a\x00Ri\x04\x16O\x1e!\x90\x87t\x12i\x11P\x840pQ\x1d!\xa53\xd4r\xc2V$\x91>'\xab*\xc5WI\xf3$}U\xb4!?mÞ\x97\xac\xaa~\x1fE\xa5W\xb9\xf5\xc39\xf0\xf2)\x93\x047\xe5sw\xec\xe9\xae\xf9ܕ(n\x19\xd33\xd1\xfcr\xeb\xd0\xc4j$\xfe\xef9\x13A4\xc6\f\xeb)\xa7\x93\xd2\x1f\xac-\u007f\x05LyX:\a\x91\xe3`\x93\xbc<}'?\x1d\xc17\xf5\xcc\xf7Sf{7\x01]j\xb3\xd7Fv$\x17\xb0\xe5\xef[\x17`\xc6%\xf4\x17\x9a\xf2\xdd\u0086\xfb\xa0\xa7\xea\n\xd6p&U\xa7o\x19\xa0)U9\xf16\xbc\xe2\\\xc3T4\xaf\xb4n\xc4|\xc3$\t\xb4l_WH\xd0u\x1abv?\x9b\x0fi\x99[\x82\x17\xe9/\x89\x01\b\xbf\x98\b\xf71\xd7)g\xf1\xb2\xa59\xc7\xd4\n\x1d\xc7\xc1\x15*\xdaOR\xb6\x03G\xa2\xb9s\x17\xe5^\xd5j\xa1O\xfc\x15%]\t\xb1\xf0t\x85\xaf\u007f\x12OQZ\x90\t\xf9\xe4o\xab\xeeUK'\xe7V+\xecd\x90;\xe7|d\"d:\x920N\xdeOED\x9d\xa3ʏ8\xbdH\xc5\xdd\xd1\xe1տF\x96/A\xd2\x15\xa5\xac\xc5\u0379\xb4\x9e\xe4\xde7\xbf3d\xfbx<.J\xf3D\xe2\f\x15\x8dT\xb51\xd9\xe2\x81TJ\x89\x82\xf4\x9di\x93-r\x1eD\"ؐ#\xf1mß\xa5uCk\xf4\x80V\xb8>\xc8X\x03\x01W\xf1\x14:\xbd<S\xc0\xe2\x16\xfd\xb3\xc7t\ak\xd6K\xebx\x18\x8b\x88V\xa63H\xefOu\x9d\xaa\xea\x92\x1e\xa9~\x06%<\xb9S^\x85\x9e\x0e\x17R\x18| \x98\xf3\xb14\x88\b\xc30\xe4(\x87\x9fc\xd2tw\x80\x95\xb5ވMF*\v\x9f*\xc0f\x97(\x96\x96&$\xceS#\xe2\x85\xdfOJ5YFY\xa2\xbdf3$j\x00\x17ѕV\x97\xf4\x05\xac\x9a\xfe\xae\x18Y~\xea.d\xc4\x13\x89\x91\xd5-\x9e!\xf20l\xa4\x9c2\xf3Q\x93\x9a\"\xa6bI$WT\x02T,\xac6\x1d\xb6\xec\x1c\x0f\xa6iM\xe6\xaf\xfe?xY\f\x96t\xff:\xe9D\x11\x81T\xb74\x8e4G\x89\x8f\fe\xac+\t\x98K\xff\x0e\xe7\x8f\u009c\x90\x16\x8e\xa8@\x1e\xb6\x86\xe2S\xf9\\\xad%A/\xd6\xd53\xbc\xff-\xf9\xac\xad_\x9e\x1c\x91\x0fD\xae\xb8<S\x83\x9b\xa4,\xa24\x1d\x96\x16:U\x8c\x8f\xef=Q\xba\xc7\x0f\x11a8\x1eE\xe9p\xef\xf5\x17\x00\xee\x00\xe0ӏ?r\x80\xf5gI\x92K\xa9Ej\xc2dq\xe9h\x10\u0600ղf\xce\xdf\xf4\x94xR_\xeb\xcauŉ\x94E\x8b\x96U\v\x90\xac9\xddָ\x81\xf8v\xbe(S^\xd5\xc2k]ܺ\xda\x1ad\f7\x93\fX4\xf2\xb0\x01a\xef\x15\xd3ϹL!\x95\"y\v\xd2=.\xcaע}\xc7\t\xb9dfNZe\xd2\\O\r\x94\xcd\x15\x99\xa1\xbd\xc6\x13,\x8b\xfe\xbd\x86\x89\x12~\xf2\xd9\xe7\x0e\xb0\xfe\xef\xd4\xe0ض\xf2>2h\xe6\x85GD \xd7ŕ\x83\x1bV\xe6&\xd5$\x06h\xbe&\xab\x8dB\xb9\x18\x81 \\\xc35$\xb3\xdccS\xb9\x86K\xa1|3\xc8\x17\xee\xaaޥ+\xeb0\xd5\xc9Fo\nX\x17\x9e\x1bfn^?\v`\xf3\xd2\x17\xfc\xc8 \xc5\xd9\xec-\xdc!\xa2\xd4\x15\xa1\xf6δL\xae\xbew\x12z\x11N).\xddD\xb8C\xd6O\x88mI\xff\x1f6\x9c\xf7\xff\x8a\x16\xcb\x14f0\xcfn\xcdD=#!y\x0fk#\x0e\xccP4\x81\xd3\x1c\u00972ͧva\x8c4\xb1N^\xf9\x00m\xb9\xf0R\\Ṯe\xf2g\xc8\xe3\xcbJ\xe1\xe3\x97W=Е@\xeb\x14\xb0\xaa\x95{9\x8b\xd5&U`\xf8|S.\xda,\xc9ʤ\xbe\xb7{f\xadd8l\x97\xe8\xf9\a\xb5\xc5\xec\xf1>]\x88[\xb2\xa5\x8d\x8ca3\xea\x9a\xfb\f\x06\xc6F\t\xff\n\xc0\xcf\xfc_\x8c\xe3\xe8\x12\xba\bso\xf8\xd0z\x99}]E\nD\n\xaa\xef\xd1\xf5]U\xbe\x11\x97N\xe4\x15\xe4#\x15@JTr(tKYh\xd7G\v.K\xa4\xbeXWM\x80S\xfaۓ\xac\xab\x86\\\xb3\xcd-\xab\xc4z\xb4\x113\x1eǙ\x97r]e\x84\x06&\xa9\xe7\x8a\x128[\x92\xad\xc5\xe4Nq\xbfG\xeea\xee9\xfdu\xc0\x1cRLF\x81\x98+5\xb0\x02\xc0\xfa\xe4\xb3ρ\xa9\xf8\xf9/CNo\xc4\xc3Ã\xa7Yno\x88D}/\xd5w\xe8w\xfd\"\xefc\xd5dF\v\x9fV\xfc}+\xcf%-(\xae\xc8\xfev\x04(Z\xcb\x1b\xbe]\x00\xd6\xc2_\x9d\x93\xbb\x12\xe9\x82KY\xc8Қ\xf2\xb3\xdaM\xfd\x1f\xdb\x1a;\xf3})K\x9e#Y%*(\x9cP\x82Ò\x9e;\x15\xc0r\x18\xcau\n\xa4\xbeAa-@+(XȰ\xe7\xd8\xc2\x02\x80\x01\xc0O\x03\xd3\\3\x86\xc3\xd1\xcb`5\xf9R`\xd3Ol\x1e\x94\xdd~\x8f\xddn\xb7n\x82kBؗ\xd8X\x193\xb7\xc49\x04nbM\xad\xe3\xb7Ⱥڊoڌ\xb7Z\xa98\xbb\xc5\xfa\xe2H&\xbbj\x9d\xac\xa8\xccH\x02n\xa4a\xb7\x00S\u007f\xac\v\xf2\xe2\xb6*\xa7$㔺;m\xc1\xab\xf1pW\x1e\xb1\xf5:~\x00\xadG\xaf\xf0x\xba<kƨ\xb5Sd\xd8\xef\xaf&U\xd1\x16˪UÜ\xb9܌\xa8\xc0%\xe5\xf2\xb3\xa8\x16L\x05\x11\xb4,\xb0\xbd\xf0W\x9b\x01\xd1\xd9\xf3\xae.`e\x05\xd6]I\xfa\x05(wE\xdf\xf0\x90\xf6\xf7\x99\xc8\xf5\x95܇\xa8\xae\xd1\u007f>\x16\xbc\x1b\xdb_T\x95\x15\\I\x02,Ƥ\xea\xb7x/\ty\bZkt]\x87\xddn7\xb5\xefjY\x14\x95\xd1\v\xa9\f'\xe6\x8bb\xfe\xa9\x94{դ\xde\x10\x97\nTD/\xbf\xed\x8dQ\u05f8r\x14q/\x12O\xb5\xf9\xb8\xd64\r9\x03 &K\xbcR?+\xdd\xd3VQj\x9f\xfb\x8d\xdb\xe6\x11E\x1b\x0e\xe9\xdf/\x8a\xb1\xa3\x9f\xfb\xc6\x05h*\xf1+\xe3\x87\xe8\x12.\x8d\x84\x00d\xe6\xe3\x811E\x01w\xbb\xdd\x14\t\xac5k\xb7:\xc1\xa2N,\xad\x9c\x91^\xc1;\xc4>xi\xf1p\xa9\xbf\xdc[\nX\xa7XHT\xe8\x12|I\x8b\xea\xa2\xe3\x94jț\xd935I\xd8'Y\xb3\xb9O\x8c\u00809>\x90\xad\x94\xba\x97\x1f6k`M\x9f2\xd5\x1d\u05ffTi\xa3R\xe4e*\x10\xfa]\xef2\xda\x17\xf9\x1f\x1b/\x8c&q0)?%A\xfeş\xd1Z\x8c\x8a\x8c\x15@+\x84͞\xf3K\xd2<\x8bI\xf6\xa7\xc8\xe7\xd1\xe5ѪJC\xad:\xc8\xd4B\xad\x14Z\xdd\xe5\xe3~\x9cpW \xea\xc8\xfb\xd1Ag5\x9bf\xc1\xbe\x14\x8e\x06\xa3\xebwaÙ\xe5\xb3t\x88\x9aP\xa4\xc7\xd7\xe4V\xcd\vo\xcaB\xef-*\xa6\b\xbc-\xfb\xec\xd5&\x12zzD\xa5I\xd7-\x04a.\xdd\"\u05ee\xde\x16u\xbfpS\xab\xad\xa7\xb3YW\x12h]\bP\xc5\xc3=\x1e\xab\xd6\x06)%\xfe81\x8e\\೦\xff\x92\xeb?\xba\xd0\xc5#y/\xe4<\xa2y\u007f\xceeoV,!q\x85W\x06\xb4\xf2.!0ISLU\xd94ϩ\xc9Z\x9f\x9ee\"\xce\x02-\x9c\x8d&\xde'\xd1k\x12\t)\xfa\xbe\xe8V\xaep\x15\xa8\x91 \xfe6\xc0UN깅P\xe7\xdaVQ\x97\xb2\x84\xce\r\x90\xa9\xee\xe7\xe7<<$\xa9\xf0\x82\xf5Ǒ\xbc\xb0\x03.\u007f\xaf5p\x8eVm\xd4V\xc10\x9b\xee\xf2\xe9\xbf\xf9M\x00\xd7\xc0\\K(\xde?{\x16\x96'\xe8\xb4\xf9\x04\xb7ȉp\xc4\x13\xb1\x90\x99^\xbb \xb9\x94\x88\x97q\x1f\xb8\xa4\xc7\xfd\xd8\x1b\xee\x89\xf0V\xa51\xc8\xfd\xbd\xd6\xfa\xf2\xc0t)++瑴&\xb7J\x91\xc6\x05@p\xbd\x85Y\xd3\xec\xb8\xc2*Ȃ\x96\xf9\x1f\x91\x91\xa3R\xd9\xf6}\xbf\v\xe0=`\xae%L\xdc\x02O\x16V\xa1U\xd597C\xea\xd4-KX\xa0&!mU\x8f\xbaT-\x18\v\x1b\x91*]\xda\xe7\nV5%I5\xe56\x8f\n\xf4\xc2\x06\xa5s\xdeOm\xb4\xb0\xb6\xad]\xee\x10\xa8͔O^\xdb&\x8c\xaf\xeb0%\xa53\xcce:&۠\uf754z\xe2\x1e\xbf\x0f\xe0Fr\t\xf5\xc2B\xed\x94'=\xe1\xfc\xae\xe5\x04\xd4\x10{g\\\x9c5\xaec\xda<\xcd\x17\xa3ֺw\xf4H\xc0\xfe\x1c,/\xca\xe8~?\x05\xcb\xf4I\x91\xef\r\xf7\x94\x94\x1e\xf7S}Zچŗ\xcat\xa2o\xa1=\x16iE̓ڋY\x1b.\x80\x97\xbe-%\x01\xd6\xf2]\xa4\\b\x17%䐩\xe6\xc4\xd8\xc0%\xac\xb6\xcaZ]\xcd܄\xd7\xf4\x8f+\xfd\xee\x02\xc4\xf1Ss\aSyuR\xceՓp\xa3O\xec^\xb3\xe6\xa5j\xd6e\xa3\x0e\\\xfc\f\\\xa3\x1d\x9f\xb3\xd62k7Y6\x17\xb7\xc0K\xec72\x8c=\xb3\x9e\x83x\xe9\xdb\x13\xa3\x84\x87\xf8]]\xd7Y\xa6]\\P\x04\xa3H\xcay\xb0\x8a5\xb1j]\x8d55\x89$\ff\xee\xf3K\xa7\xfe\xdb\xec֝\x9b\xbbZc\xf9>\x16\xb8_:Zȩ\x1e\x81'X}\xa2\xc7p\xa6硜\xde<\xcb\x124\x81\xabid\xab\xb4f\xf3\xbd\xaa\xc2S\xfb\xae\x11\xc0/\xe2\x01\xedw\xbdwc4+7xWa\xf2\xda\xfd\xc4b\xf7\x90\x93ܒ\xbf\xdbbp\xa3\x06\xa7qN\x94\x94\xe8\x9a\x03-\xde\xe2~\xf0v\x11\xf0k\xe6\x89\x05\x9d\xf1\xa7\x02V\xa1\vqA\xad\xf8\x84k\x18\x8a]\xd2*\xb0\xe2\x8ar\xb6\x14\xbf\xc6U\x1b\x80\xd2r=9\x8bե7LZ{ZkL\xc9\b\xc9\\\xb0E\x13\n`*~\xfes\xff\xa2\x04\xa0\xdf\xed\x8d\xc53}\xb6f\rR\xb4$\xf6\n@Tc\xefm\x01V\xe2)YS\xf6S\xb2\xb4re\x14\x89DT\v\xfaOfC\x9e\x19\xac$U\x86\x94\xd4I\xf3\xf8_\x8aRH\xcc\xf9Y\x92^3\x89\xa2\xad\xea#>\xed\xb0\x95\xa2E\xc9\xd4#\x8a\xfc\xb58ʙ\xc9_t\xc6\x03O\x9ax-\xb7\xe4[X\u007f\x05C\xbc3\x00\xd5u\xd8\xed\xfa\x85\xee\x13'T7O\x9eT!E\x81W\xa8\x1a\xa6}\xe6\xfc\xe6He\xc9Sδ\xcd4\xa5|\x92V\xc4#\x80\x9b?\x8f[qW\xadR˧\x82\xc8\xe6s\xc6\xd9◓\x83\x00\xbc\xe18\xa4\x0e刃Y5\x04\x9a\x19]?AP\xa67\xe1\xd2%\xfc\xe4\xb3\xcf\x19\xc0\x17\x00\xbe\xb4\xbf\xe8\xfb~J\xe82\xf7e[\xd6/;<\xabm'R\x02\xc4\xd4W%\xf0Qd[\xa6\xc4\xcdJ\x85\xb7q\xb5}\xf0~\x9f7+\xb8\x17\xfcL\x89\xf8S\n\x9cOu\x05\xcf\x06R\x15\aी\xf1\x94O!\x89\x129\xf5\x1e}\xe9r\x93\x93\xe9z<\xb8\xdf\xd3ʭΦ.\x99\xa0\x8d\xf2(5\xb8\x84\x00\xf0\x00\xdb\xff\x8b\x19\xfdn\a\xe5\xd5\v\xba\x8d\xee\x99|g[<\r\n\x8b9В82*m\"?w%\x03>Iy\xe4\x861\xe1\xb7,\x82x\x8a\x9ahɽ\xbc\x94[\xd8:\x87\x9b\x03f\xe1ߒ\x15e\x9b\x99\x06\x89\xd5\x1b%ޒW\xf2fӛ\x88\xd4IrӖV\"ӕk\x18\x86\xdc~\xd7\xf0R\xae\x94`\xe0\x19\x97pNiHm\xfe3\x1es\xb2:hM&p\x040\v\xe2\x92(\xbf\xc12d|2\x195uͧ\xa2\xf5t&\xebj+5Q\xf6\n\xe8\x1f\xbdH\xfa\x92\x9f\xbf泄\xf5ERK\xad\xd6\xf4\xa2ĿYx\xa3\xcf_5\x17\xf9\x13Mr궼\x8f\x19z\x1cs{\xe0\v\x00\xf7\x12`\x05\xf7G\xb9\xc6\f\xe7\"!\x93\xf5L\r\x93\x9f\x8a\x94\x18S9ǣ\x88'\x9a\xff\xde\f\xf7B-J\x96[t\xfd}&\x16W\xae\x9c\xcaI\xe5>U\xa1çt_\xa7\xe4\x05\xae\x04\xad\u0ea6n\xd8\x05\xc8\x1c5ӆX\x84)!]\xa9IHa<\x0eS\xa40\xfd'\xae\x91j\\K\xb8\xe6qN#\xfaj\x9a<F\xad\xc0N\xb2\xf2\"E\a\xf1\t\x85\xba.\xaaH,\xe4X\x04-\x03r\xf0L\xecE{\xa5'la\x9d\xea\x02\xb6Zc\x8f\xed\x96ѥ<\n\x89\xacY㕜A\xf3>h\xc3\xe7\u007f\x8e\xa91&Q\x14+\xf3\x8cD\xe8\xbb~jB\xc1\x8cq\x1c1\x8ec\xeeO\xfe\xd8RUq-a0G\xf1\xb3+Ey\xd0an\a*\\\xa6)&U\x00\x17\xad8ec\xa2\x90\x85/T\xe6w=\xe5\x04\xd5S\xa2\x9eO6\xef\xaar}^\xc4x\x82\x1c͎\\\x9e\xb2q\xb0Ş\xca\x18\a\x81\xf1\xe0\xe7:6\xf6W\xec\xfaΤ3L\x9f3\x0eCn\xbc\xdf\xc04R\x95\\\xc2\x19\xa0\x84\b\xc0I\xaa\x92\x8d\x1aYk\x06\x9d[\xcd\xf9L+z鴑&1ή_\x98Ӊ\xc4\xc0b\x9b\xef\xa7䆜A\x95\x82_\xaa\a\xea\xd7sl\xe1\xa7\xd2\"N\x8d\x10\xb6\x80tD\xf4s\xd3\xc7\x10T\xdfAu\n\xa3\x1e&\v+\xfd\xd9I\x89d\xf7K\xdb\t\x87\x94\fXqwf\xf2\xfd\\\xbf\xfb\x06\xdak\xa26[\xd85>\xbf\xd4r\xc9\xef\xe6a\xf5\xbe\x04\xa0\xad\xed\x0e\xc3\r\x00\xf8$9-\x89l\x8f\x9f\x89\xe8\xb2s\xdbh\xc9=\xa7\xcf(\xaa\x8c\x14@\x8bϰvr\x1dv\xec=\x13s\xb5KK4\x89\x80*\xd5A\x0f\xe3\x9c.U1\xbe\xa2\x85\xd5u\x1dvW\xfbl\x06\xee\xc2D\xb4\x0f\x12!.]\x18\xacRV\x16\x95\x80ʺ\x881@\t%\x0eT\xdbĠA\xf6\xf6\xa9\xbaF*\x01VA\x86\xf3#\x83\xd5\xd9\xddç`\xe9\x96\xee\xa9\xc6e\xdcj\f\x12\x1dѩ\xb2\x97\xc1T\xf6\xb7\x83\"B\xd7\xef\x9ane\xc1a13\xfa\xbeG\xbf\xdba)\x85\x93\x88\x92yn\xc3\x1a\xa2\xb2\xa8o\xb5&_)\x0e\xf1J\x80$MD\xeb\x02-\x89\xf9\x9dҐ\xe2)\xb9\x86\xa8o\x92\xf06\x80\x15]\xaa\u007fa%u\x91\xb2x\xf8\\\x96\xa0\x8d\xaa\v\xf3E9+\xac\xa6\xf2\x85\x19]\xd7\x19On\xe7\xda\x04\xd6\x18x\xa2K\xd8\xf5\xa6\xc9\x04s\x95\x9d\x1a\x98\xa1\x1b5\x1dX\xa5&\x9a\xf2\xa7\x85l\xf5\xd8:\xcc\xf6\x80\xe3\xac\xfau\xa4\xf3#4\xb8x\xa6|\rI\xc0~\"!\xfdܹ+z\x8a\x16\xd7\x16\a\\\xaaF\xd4\xdfS~\x89\x95\xb0#\xb8a\x9eI)\xf4\xfb=\x88\x14\xf6\xd7\u05f9\xf7s\xd1%\x9c\b\xb1\xae\u07b4\xf4-\x8c\x8dB֫\xb2\x9b\x13\t\x9c\x92_\xcfQ\xeb\xec\xeaI\x975j\xc2Z\xa8\\J\xc3s\xb5\xb26t\x8f^\b\xf7\xba啔DJ\xb8\xe8[\xf3W\xe4\xb9\u007fd\xf68\xb3Lup\xe3=0\x80\xfd\xd5\xceys\xab]B\x00\xe8T\xe7u|榍E\x17Z\xc4')W\x9e\xa2A_\x98\x84\xa5\x17\xfd|7'\xbdt\xae>\x93\x81\x94\xae\x97m2\x12bKx\xe3À\xc5\xee>\x9c\x04W\x06\xeaՇ\x99\xd1\xf5=vWW\x13`\xa5ߟu\t\xb5s\x8fLbW50\x9cac\xc6Yҭ\xd6H\xce\n\x93\x92Q\x8b\x1aB\xb5\xe1\xfdsw.~\x04\xb0\xa2g`]m\r\xb0)!ɓ>\xa7\x10\x9cA\x81\x9fjZ\x87\x1b<?5X\xfd\"\u007f\x1d\x89\x15\x84 G\xe8:\x85\xfdվzL\xc5ZB\xd0l\x0ej\xaei瀪.25fb\xedDP\xa1\xad7eH\xf0Vk\xabU1s\xd3&\xaaO\xc0ʡo\xa3+x\xc1{\xa5\xc6=A@\xd8Z\xef\\\xc0}J\xa0\x8b\xe5\xeb-\x8c\x1b\xa2I\xca\xea\xea*K\x91K\x805\x17d\xfb\xd7\x14꿒\x8b\xd1V\x88\xd7\xea\xa2\xc7/\xaf3ln\x91\xb7\xb6\x97\xa2\v-ʠN\xd1\xc8\xf1\x00\xd8\x04\xc0.]\xd2\xf2\x94;6\u007f\v}\xc8:\x90۲.3\xf66J\xc1\x96\x15\xd1u\xf7\xadf\\\xed\xf7\xa9\x03-\xb8p/\xa1\x99\x1e\a0\xeb\xe6j줦M\x84\xa8Y\xc0\xf0\x06\xca~\xbe\x92\xea\xfa\xa4\x01\x13\\<%\f&\x9f\x92jP9Y\xbc\xd5\x02\x12,W\xba\x90\xec\xf2\xd6`u\t\v\x8b6\xaa\xa9+Y\xfcO\xa2\xfe3\x97\xd9\xde\x14H\x92\xa9\x13*xM\xce%\x13\xff\xben\x80\xa6j\x11F\xb7O\xba\x85\xe5(\xe1p\x1c\u0082\xc4J.\xa9\x8a\xf3j\xb0\xbc\xe2\x88\x03Uh`-\xccŔfv\xad˘;u\n\xc0\xeb\xc6D\xeb\xd9\xfa\xdcxs\xd6\x14\x14\a\xefi\xb8\xee\xb7ݢ\xa1\xa7\xe0\xaa7\x82͂G]\xc1\xfd\xd6\xfenQx\xdc\xca9c\xd2\xed\xda\xef\xf7S^V#\x87\xe5\x1e\xffx8\xe0x8\x04iXէ\xeeV\xa1|/j\xd2zŪ\x0e(\x8d\x9c\x1b'\x9a\xa6\xfa\x9fCqm\xe29\xb3\x8fׂ\x98\x90\x84x\t\x15\x85g\x9d\x8b\xf6\x141\xf5\x14\xeb\xebD\xf0\xe5*\x1e\x98\xaa\xa8\x05+\f\xba\xdb\xed\xaa\xeey\x998j\x14\x00\xef\xee\xee\xa0M\xb3\xc38ZG\x053qK\xc29\xab\xa2 \xd5\xf8\x9d\xe1\x04\xcc\xd5\xd2\xc5\xd6T\xf0\xd9[I՞\x11\xd4p\tI\xe0g\xce\x17]ĵ[\x9b\x90+\xe4\xff\x05\xebp\x8b@XI\xd4@\xf8~\xfa\xe8%h\xcd_\x00\x9bބ\x00\xa1\xdb\xf5\xe8\x94*bGR\xc0\xef\xee\x9bד\xec\x83uG\xb9!\x1fkM\x8b\xa1̦b\xad\xf3\x9b\xc8(K\x90\x04\"\xa5\x89\x88\xf8\xb5\xa2\x1b\x9a\xb2\xfa,\x90K\x8d,\xf1\x92,\xf9l\xf1\xeb\t\x82''~\xcf%@<\x15\xb4\x12\xe9\t\xeb\x0e\xd8\xe9}\xaaS0Ѕ\xfd\xd5U\xb3K\xe8\xfe\xfdpw\x87\xc3Ã\x88\xb29+\xcb\xf7\xa1y\xc5\xc3\xd8\x1c0k]\xb9\xa4\x8aD\xb7\x9e\xf9{\x16\xf3\xc1(1y\x94\xeax\x13\xf54lrk\xfc\xcf\x17z4>/C\x83\x9f\xf4\xf5\x1e\xc5\n:\xb7%\xeasAk\xfad\xae\bL%\xd7\xffZP\xad\xe8\x90\xe4\xdf\xceԦ~J\x9fڭ\x00,'/3\x0e\x03\xee^\xbf\x06\xebtD-\tZ\x1e\xc1\x1c\x8b\x93\x05\xae\x88\xf7\xe5w横MJ\xb5\xd7J)$\xa4&+Jps\x9dujy2\xe93jO\xbeo\x8d\x97\xc5\xcf\v\x98\x845Ժ\aZ7=U\xba\xa4\xc95\xed\xcb\x17\xaf\xa4\x19R\xa55\x94\x9b\xd3(\x1a\xd9*\xa1\xd4\xf5\xbd\xf1\xa2xj\xdc\\\xc0\xa8\xacD\xf2\u05ff\xfa\x12\xc7\xe3!I\xbas\xa6\x8d\x15\"3\x92#_\x9d\xbc\x01v\xad\xaa3\x83\xad\xfd\x1c\xad\x14G\x94\x13\xe1ߪ`TʭJpi\xf4\b\xa4\xfb\xb9x\xbb\xa7r\xad\x8b}\xde\t\r\x1d\xb6\xb0ޚ\x0fK\xe3\xf5p):\x98\xb3\xde\x12\xe9>I^:\xc3\x1f\xd7\x06qT\xd7A)r\xea\r%\xa32)\x91\f\"\x1c\x1e\x1e\xf0\xd5/\u007f\x99l|\x99\xce&7}\f\xfd\a\x902s\xa3\xa8Z)\uf2d9]\u007fD\x89gZX\xa7\x99\xc1\x15\xddGk\xdd\xe5R7J\vGZ\xecOIE\xf4\xe5\xd5\x0e$\x17\x98\xbbՑp\x01\xac\xb9\x96$OYy5@*\xac뺚\x98\b\xb0\xcca\xdf\xf5]\xd5Ш\xd2 \xfe\xea\x97_\xe0\xf8pHބ\u061c\x00S\xee\x91-\xd7QJAa\xd9Ξ\nJ\x9e\x8bF\r\x06\xact\x92\x14g\xf9iS\xfc\xc3ړ\xb3Q\xc3z\x8b\xc2\xd4\x17\xd2\xfee\xacJ\x16\x0f\x9f8\x06\xc1u\x99\xb3\xbf\x97\x02\\k\xd2#\xd8\x01\x97\xaa\x02n\xb1\xf8\xd9\xdf\xd4\xc30\xe0\xab/~\x99\x8d\x9c\xf9]\x93\xe3\x92\x18\xa5T\x92\xf8vm\x82b\xfeK@{\x060\x8e:\x94\xb1\xa8\x98\x98\xe2\x10J\xc0U!(\x88\x8a\xd3$\x16\xec\xff6\x83Υ\xdc\xc2̓\x05\x15\x1b\x91.ܰ\xa2f\xadRC\x19M\xa9\xd7暃\xbcV*YyncM\x95B\\K\xa8\xa4\x8b~\xf5\xd5\xd7Sw\xd6̀\x04\x96\x91\xb1\xaaH\x02\xab\x05\xba\xb2%\xa9\x1cY/\x81\x95\x1eG0\xa6\xa6\x8b\xb5\x88N\xe7\\\xe4\x85\xf0.\xa7\xcc\xec\x17\xcb\xe1\xf9Zjg\x04&:\xf1}qW\xf3\x00@\xd6\xd6\xf6\xd6\x02g\xaaQq\xed3\xa9\x0e\f\x9e:J\x978/\xef\xfb\x1b\x00b\\\xf1\xf8\xf0\x80\xd7_\u007fS}\x92\x92\xea&7\xd0F\xfd(R\xd6\xf2,+6VU`jF\x0f\xad\x1d\x90M\x02b\x9ak\xea\xba+MҸα\xd1UL%\xd6Q\xc3i\xd8\xc27\\\n\xb4.U\xf7\xb7\xa6{\xf4\xb7ݭ$a\x8dpB\xf1\xb6v\xcdQ#0S\xcahh\x8d\x90\x13\xa1\xef\xbbi\x8f\x93h\x19.\xf5\xb0>\xfd\xf8#\x05\xe0G\x00\xbe\x9b\xba\xee\xaf\x12\xe4{la)\xa5\xd0u\n]\xdf;\x11@)b\xe6\xfe\x999\xc5,\x98\xcd`\x92v?[|\xfd\xf8\x9eił\xad\xd1\x00\vL]l\x17)|\xae\xfaZ\xb5\xc0\xb4\x16\xb8\xce^\f\xfe\x848\xac\xf28P\xf5a\xb8萾v\x1c2\xe57IB\xde軻+/\xaf!\x92\xee;\x00?\xc9\xdd\xe4\xc3\xdd\x1d\x0e\xf7\x0f\xf9>}J9=x\x12\xf2Y\x94\a\x10\xa5\x94\u007f\x1b\x11\\\xa4T\x90WP,d\x9d\xb7.Z\x924\xcb[]\xc3S&\xf9\t\x82ֹ\xae\xdd\nD\x8fiq->\xfb\x9c\xf4C\x8b\xdeZ\x8a\x9a\xb0-\xe9\u070f\xb5|\xdf\x02\u007fU\xe2\xa3\xc4{\xa8I-\xaa4$T\u05f9\xe2g.\x88\x04(\xef\xbf\xef\x96\x06\xfa\xfe\xee.93D\x84\xfdn\xb7L_H\xb95\t\xe5E\xc6\xe4\xf2\x8d\x85\x1b\x0f\xdd\xcb\t\xb84\xb8J\x13\xbb49Օ\xe6>\x99\xde\xf87Xq_o\v\xa7\xd5\xea\n>\x9a\x9b\xd8\xda\xcd|\x9b\xc19\xe9~Y\xa2<⪎x\xbd\x8a\xee\xdd\\\xe7\xd74.+\xc0w\xa2\x91\xa8\xaaa\x8bE\x97\x01\xc0\x9f\x96.?\fGh\x96\x17T\xbfۡ3b\xf2\x9cq\xc9XϾ\xb6x\xd8h\xc68j\xef=\x9c\xcc\xf9Jv\x9dm\x89\xfa%·\x9b/\xec5\vqc5\xd4\xe7\xecv^\x14\xb4jj\xe6\xce\xf9\xd99\xf0n\x987\xf2s\xa5\x12\xf5\xbdIm\xb8)!1!\xc4@'\x83UH\x0feM\vJ\x01֟\x008\xe6\x8c^m]1\xc9\x15\xec:9\x85\xc1\xf7_Mn֬\xba\x1c\x96\xc3h\xad\xa1\xf5hj\x99}w2\xa2\xb09\xaaU\xf2\xbe\xb47p\x81ߜ\x13:K\x88\xff5YY\xf1g\xa4@\xf3\xcc\xda\xf7\xcf\x19\xb4\x1eՒ\xda8\x8aV\xb5\xa1S\x9f\x97\x89\x82ה\x8d\xd5\xd2\x1f\xb6\xf9i\xfa^\xa8\xbc6\n.g\xbdŭ\x1c\x96D/\xedo~\x05\x00\x9f|\xf69\x03\xf8%\x80/\xb2W\u058cq\x18\x16\x8f\xe1Hec\t\x05\xa9\x9c\x1e\x0f\x95\x9c\x1b\xad'\r\xae\x87\a\x03Z\x1a\xac\x19\xcc\xda\xfd[\x8fSJ\x83\x1eǠL\x87Q\xd1<\xe2\x12\x1b6:\x19O\xaffo4\xafߒ\x94\x87\xa7(mC\xe7{\xd8\xf5\x9eA\xe2\x1e\xfd\xbd\x10x&\xd4\xea\xda]t\xd2]\xa6\xbb0\xffʟ\x02_\"\xf9h,\xad<f\xf9J\xa4\xdeϴo]\x19\xcbG\xfb<\x12\xfb6O\x98\x9d\xfe\xf0\xf0\x80q\xd4\xe8\xbaN\xbc>\x99\x14\ti^\xc9\x03Ɣ\xack\xeb&\x90\xea%\xb3\x1a`^\x1dW\xa9};o\xa9\xbb}\xe6k\xd7<\xfb[\x82\x92\x8fW:\xb5\xe2\xb3\xe3\xe4i\b\xdfSnM$\x124%\xeb-%\xc7M+]\xda\x14\xd8\xf6\xfdn\xd2\xc3Z\xba\xa8\xc9\xd2\x1c]\xc2U\x060&\x1aE\f\xc3$\xab̆0\xd7ZG\xe9\f,9\x898<<\xe0\xe1\xfe\x1eJ\x91\xa4\x0539\x84QR\xaak\xa3-\x90\x8cZG]\x9e7\x8a\xe0q\x83zcM\x1aæB\x87g\xb6\xb4j۽}\xdb_\x9b\x15]g\xf2\x01\x83\xddTY\xd0\xcf)\x92=\xf7\xb7$\xacS)\x93]\xa2:V\xf4\x18U&\x15\xaa4\x82>`\xdd\x1b\xb70;!<j1q\x93\xb5\x9e\xb2\xd1-I\xae\xd4\xc2R\x89\x17\xfd\xe1p\xc0\xfd\xdd=\x88\x94\xab%\n'\xcdG\x93\xb4\x86U\xf8\xf0\x11\xa0\xd6j\xc7Wl\xfc\x92\x9e}<yT8!\xcfe\xb7\xbc\x80\xcb\x13}\x9dZF\x96y\x1fU\xac]6\xd5$\x9c\xe5\xeb(\xce\x13\x92U|\xe3&\x17'\xa4\x06͕1\x93jCn\x9c|\xc0\xfa\x06\xc0\xbf-\xa1\u007f\x90̙8aTF~\xd7^\xe7x<\xe2\xfe\xcd\x1d\x98y2\x05)&\x05\x05%\xa2xP\xe2As_\x02\xe2Ke\n\x8f\x05\x06\x8d5W\x8f\tZ/\xe0wf\x00;S\x87\x1f]\x91T\x1d\xdf\v\xc1\xe3o8\x03\x8f\x1bwT\x9f:c)(ա\xeb\xfb\xec\x9eP\x9f~\xfc\x91\xfd\xfe\r\x80?\xcf_YgQ\xd22\xfc\x8e\xe9\x0fTJ\xe7\x87?><\xe0\xfe͛\xc9\x14T\x04\xeaR5\x87\x94\xfcV\xec\x00\xe3J\x808\xef_o\xa0u\x9d[\x84ܰ@\xe9Y\xec\xaboI\xb4\xf0\x92\xd6Չ\xddlJ\xd5\x19\xa5\n\x0fK[\xb8\xf7\x13e\xff\x90$\xc9$lT4=Y9\xe8M\xd2y\xf6m\x9f|\xf6\xb9\xf3\xd0\x00\xfc}\xee\xcdz\xd4E\xe5\xc5t\xbe\x94\xe1\xac\x0e\a\xdc\xdf\xddy\\\xd3d\x0e.-\xab0\x99\xdf/\xa3\xf1\a\xbcY\xc3gŦ\xa9ɸ&!BX\x92\x00yN@sI\x1e\xab\xd5\x1d\xbf\b\x88n0w\xbc\x92\xe3\xd9\xd4\xfd\x14\xf8U\xb6\x9cp@X,\x01.\x06\xad\xe0\x9d~-\xe3\x8a\xc7#\"\xec\xae\xf6\xe8\xfa>;>}4\x9eCn\x00\x8e\a\xab\x8bU\xa1o\xedG?\xcc3\x1c\x0e\a<\x18\xb0\xb2o\xb7\x05\xd2\xd6\xfaYV\xf7\xcd\xfe1\t\xee \xfb\xcd\x1f\xcc5\xb6h\x94J\x15\x1a\xdeT\xd1F,\xdbl\xf2B\x9b\u007f\xebV\xf3\xdfƮ:|\x0e\xf0(qQ\x8d\xf7'\xb9\x85\xaa\xa58\x99\x19\x1cy.D\x85\xc1\x908eZ7>J)\xec\xf6\xfb\xac1\xa9jy?\xc6ԯ0\xf5\xae\x98d\x0f\x9f\x961\x1c\x8fxx\xb8\x0f\xa2x \x82\xea'\x11z\xd9\xc5C\bV\x91\xbc\xf2b\xc0\xddIAr\x14\xa5\x92\xbf\xa2\xcaf\x19\r\xe8'Z+\xcfUy\xe1\x9c\xf7.E\xa0\x9f\x02\x8f\xf6(\x10\xddB]\xb4\x96\xc4\xf8)8q_\x04\xf0r˸\xdfSH\xb9\xc4\xea\xbf+\x8d\x03[\xb0\xbd\xdb\xef\xa1B\xb7p\x998Z\v\xea\xa3ѢR$\x9b\x8c\xe2\x06\xa5)\x83\xfd\xf0p\x80\x1et\xf0k\"5\x03\x9d\x9b\x1c\x96\xc1\xc1\x80\x94\xb5\xc8X\xb2\xe4|\xd0:cfy\t\x98\xb84\x81+:\xe4>\x15Њ{T^\x12\xbc.\xee>=\x92+\x9f\x02\x83U\aK\x06|\xd9\xf3\x04&\xcf$\xf3\xc4q\xba\xc3F\xf5\x84\xce\t\xb5-\xeb{C\xbc\xcf\xd78\xe6,,\xe49\xacqR\x80\x88\xd2\xe7E\xebʻ\xf7\xc3\xc3\x01\xc3\xf1\x18=\x0f\xa1\xeb\xa6t\x86`X3\xb9'\x10>G\xb6Xy5\x97u\x8aB\x00\xad\x9c@\xce\xd53n\xccC=U\v+\xbe&?\xc2As10<c\xc0\xa7im-(\x8cXa\x95\x17\xb4\xcc\f\x06z\x9bj\x0e\xff\x16Fmp!\xb0\xb0\xfe\xe1\x93\xcf>\x1f\x9a]B\xfb\xf0Jт`V*\x8d{\xe3\xf1\x88\xc3\xe1\xc1p)~\x90\x8e\xa0\xba>\x9dTb\xdcA\xdbQG\x02\x12\xe7\x1a\xc6VV\xe9d:\xc3f\xa0\x84lG\x8ax\xe7\n\x85\x88\xa0'\xe3\x13L/8W\xea\xc4\xd9u\xad\xa4C\xe9)\x96\x04\x15:\xac\xd7X\x80\xd5r6q\x8a\x90t\xe5\\\xbf\xc3\x13-Q\x06\x005\xa96xx\xf2s\x00\u007f\x93\xb3\xb0\xb84\x80]\xd7\a\x8d!↣\xc1\xa3j\x8d\xe3\xf1\x88q\x18\x17Ϫ\xfanv\r\x13$~\xa0\xa1%\xb5ъ\aXpW.r\xa2\xae\xac\xaeo\xe9\xa6m\v\xcf\xf9\x89\x80L|\xbd\x16\xab\x8b#%\x8eK\x02\xc0\"\xfa\xfb\x8cAK\xa2M\xb6ug\xd3\xde\f\v\xfb\ue519TD蔊\xdb\xd5\xff-\xa2\xfa\xe6&\x97\xf0\xe6\xf6\x16W\xd7\xd7K\t\v\xc9@2\x8bq8\x0e\xde\xfb\xe7R\x9b\xcei\xbe\x93\x00\xe44s\\QH5\xe8o\x98Yh\xac\xa7\x8c\xfc\x16\xebdS\xc1\xb8\x135\xb2\x92\xc1\x99'\x9eș\x03\xa3*\x95\xd8LS\x85S\xa4\x94\xe9\x04ɖ\xc7\xe0̶\xbc\xe6z\r\xb60Ł+\xe0\x8dV\xc2\"k\x06L\xf2\xa8WO\xfc+L\x158hv\t\xfb\xbe\xc7\xf7~\xf8\x83\xf2\xa9\xe5=\xb4\x1eG\x8c\xe30\xd5\tz\x8f\xacT\x17F\x02H8)\x88f\x99\x19/=\xa0\xe87{\xb2\x17k\xfa\xa4m\xca]\xd5\xe4n\xf9i\a\x15\v\x8b6\x02\x95sY\x00\x12\xa8\xd4ZR\xdc\xd8\x01\xa6\x16\xb8\xa8B\xbf\x89\xce\fL|\xda \x9f\xb6\x0eO\xba67qq\xbcv=\xdaRb2\xe05\xbd\xde \"\xdd\xfbځ\u007f\xef;\x1f\xe2\xfa\xf6\xd6\xc3ܼ\u007f\xcd\xccS1\xb4\xb6\xad\xb9\xe6\xf7w}'Hk\x84\x96\x13\xf9ݡ\x81\xbaM\xed\x03\x80\x05M\x8b\xca~\xbe\xd6%\x0fS\x94\xb3\x92\xad&\xd1\"\a\x06\xeb3\x89\xe9\x02\r,\x16E\xe9\x85\xf7\xf1J+\xa0\x94KV\xd3\x1e\xea\"V\xce\xda{\xd9\xe8^\xb3<V\xeb\xbaO\xec7_)B\xba,\x9d4v濊\x92\x8fT\xc7a\x11\xe1\xdd\xf7\xdf\x17\xdfD\x92<\x86\xe1[\xc6q4P5?\x86\xea:\x90\xeaĉV\xfe\xe9l\x04\xe9\x17\x11HA\xfaE~\xeay\xc0\xb5\x13\x0e\xe4\xaaF\x1a\xe78\xfdJ\x1a\xd9U}\xdcNt\u007f\xa84v\x97\xe0YN\xe4\x9d\xceuo\x9b[Y[\x96`\xd5<C\xe1=A\x10\xc8\xdf#\x02u!\x1e\x92\xfe\xda\xc94o9EYw2rt6\x88W\xe5\x12\x12\x11v\xfdN\xfe ?'\xca\x00\x95\x05\b\xab\xde\xe0_\xa7\xf3\xc9vR\x80\x9a\x93A\xe1K\xa5\x92\x97\x05\u007f\x82{C\xe6\u007f\xcc\xeb\b\xe2\xd2gІ\x9b\xa0x_\x1b$\xb4n\xc5ѭN\xfdh\xb0\xfc\xd6\x02\\\x11\xe8V\xe4\t=\x1a'X{\x00\xae\xe1\xa9\x04\xd5иWBj=\x93\xf7\x0f\xb9\xe3N\xbbE\xa7\x8d\x9aDn\xdeU\xf5\"P\xb4\x18E\xff\xba\xdaX2\xb6\x87\xa06\x80%\xd6\xe4\xd9Bi\nk\x03)\xe0\xb9\xe2\x84\xd2z\xb3u\x1ep\xd9U\x85ֳ4\xec9݄\x95\rZSZ\xf5[Y\x005.\xda9\x81\xeb\\\xa5=\xb9z\xd6g\xfd\xaa\xec\x19@\xd80p\x14w\xe1Iy0H\x95\x9e\xb5\xbb\xe8ڪ\f\xcfϻ\xce%\\\xc8\x1e\x9b\xff\x05)\r\x912\x03k\xbd\xe8|C*t\xf1\xc8s\x05s\x9f\xb7~R\xa7\x01\x98\xee\x15y\xb9\xd8\x13\xac\xac-Of\xceYj\x1bn\xc4Sݬ\xa7\xd4\xf4\xb4\xf9yV(b>\x9au\xb5\xb2sOںL\x03O`\xe9G\xf7@\x17нWD`\xcd~\x94\x90\xea]B\x1f4\x041P\x8a\xb8)xD\x1cȖ\xf1\x00~Җ2\x8d*\xe4\r8\xa7<\xd86\xf7\x9b,\xbeT\xe6\xfc)\xfa٧\x9c\xe4\x95Ѳ \x1d\xe3\xd4h\xd8c\x01\xc3\tV\xde\xd9\x01uM\x00\xe6\xd2)%\x8dn\x1e\xb5\xf0\u007f%\"\xde[\u007f\x1c\uf5ea\x9e\x8d\xed\xbd\vH\x11F=b\x18\x8e\xf6\xfaE\xc0\x12?\x96\x12\r5\xc4\xc10?\x1a\x87\xd1C\xb1YUp\xf9Vr\x88h3\xe0W[B5i\x01\xa9ᩍ\b\x00\x00 \x00IDATk7,\xe0\x96\x84O>\x95w8\xf3&\xe1\x15r\xb6[\x80ֹ\x94\x1f\xa8T\xe7\xba\xd2ڢ\v\x83\x15\xb7?x\xb9#t\v\x8ex\xf3\xe3\xef\x99@/>{\xf0\xf3\xaa9\x1b\x0e\a\xe8\xd1yfz\x95K\x98$\x83S\x0f\xaf5\xc6q\b\xad+չV>>J[\x9e\x9d(&߱z!ъ\r\xbfF\xd7}͢\xaa-c\x10\v\xa8\x9f@ҨRj3\xb0J\x01\xe69,\xc5ZТͳ\xc5\x1f\x1f\xccH*\xbb\xa9\x19cA\v\x9e\x90\xa9E\\\xab\xe2k\xeeGk\xc6\xfd\xdd}v[\xaa藝4\x91\xb6m|\x8eg\xf2\x13FG\x8f|\xb7\xbf\x9bj\x10\xe7\xbfU\x1ej\xc7\xe6%\xb5\x00L\xdcg\xedD\xbebsn\xe3Dq\xfeGwK\x9e\xb0[\xd9z]\xdaH\xdc\xf1d\xaa\xa2\x16,\x1a\xd6]s\xb4\xbaFB9\xee\x16\x1d[_'\x02U|?\xe38`\x1c\x02I\xbe.6\xaaf\x89dB\x87\xb8]\xbd\x97\x06px\xb8\xaf.\x18Ն\xbf\xb2<\xd7$#3\xeb^!\xc5\x015\x12\xb9Eq\xfcZ\xae\xa95š\xe1\x1ek\xf3\xab\x96\xd1\xd2KR%\xfc\xa8@\xf36\x80e͚:\xa7\xa5\xcc\xe5\ao\xaf\x9b\x8c2\xf5\xb9\xf2s־\xb4\xcb\xddt\xaf\x9d\x05,\x8bS\xb3D2\xe3\x15\x80\xdfJ-\xe8\xd7_\u007f]=h\xe3\x18\xe6R\x90\xe9\x88\x01\xbf\xd8Y\x908V\xa7l\xd6 H@u'ǆ\x8bq\x13\xee\xa3֢:c\xc6\xfa%7\xfb\xa5\xdc\xc1\xd4\x01qv+\xebT\xf0ٰ^rag\xd5j\x80\xa5\x82\"\xe784\x99\x8dP\x82\xbb\xfa\x87\x00n\x00\xc0\xe2\x94on}\b\xe0?\x11\x81\x80\b\x87\x87c\xb8\xc0R\xd6\x163tЬb\"\xdb\xe7N:\xf0sC\xdd\xe4\xd4$\x89\xa6\x06\x95K\x96֚҄\x8d\xac\xa7\xf8\xfebk\xaa\x04F\xdc\x02no\x83er\x99\x87\xc9\x03ף#V\xbb\xf8$\xd5\xce]\xe3<.Z\x1f\x17K\xe3\xd6=o\xe2\xfa\xff\x18\xc0\x8fR\x1c\xd6\x1e\xc0;\xc9\xc1\xa30c\x9d\x04\xd9_\xfb~\xbf\r\x18)\x93\u007f\xe5u\xe5\xf0\xdd\x1eW'\x18\xab\x88JчByK\xc0\xbb\xf9\xbf\xdb\xd8͢5\xd1\xcbS6|K\xf4\xf3\x05\xb0\xcek\xf5\\\x82ê\xe9\xect\x8a\x06UKD\xdc\xee\xe9x-\xf8Mc\\O\x85\xf5'\x80M\xea&\x15\x94\xfa}\b\xe07?\xfd\xf8#%\x01\x16\xe5\a\xae\xe2<b\x86\xf6J`ly\x8d2\xfc\x95\xbf\xf0\xd9sϚ6\x83\xed\xce\x11\xdd4\x15N\x9f5\x91\x95\xad\x12$\xe9\\\x9b\xe5D+\xeb)\xb9\x82\xa7>Ǔ\x04\xd4-Z\xca5\x00Ky\\O\x88\xe2\x05\x06\x83\x97\x1aD8Y\x12%\xae\x86\x89.\xf5\x8e\x8fS1`\xa9S\x17\b\xebѓ\x8b\xa0\t\xac\x12m8(Jc\xa0\xdaS!J:\x94\x8a\x02\x8a\x1cE\xc6\xecNu\xccq\xed\xc5\n\x9d\x9dS\xf7\x9d\xe5آ\x16\xe5\x95G\xc4[\x115\xdc\x02\x18\xf9T\xaep\xf3m\xbe!?֠<Z\x04\x84\x8d\x1ee\x06\xb1\xd3Of\xf6\xe6pj!\x83\xd8\v$\t\xb0n\x00\\\xe5\x1f:#\xdcg\xb2\xd2F\xad\xe7\xe8\xa0!\xdbɸ\x94N\x85\x81=\xcb\xc8\xdb\xccMQ\x88\x98O\xcb).2--\xb1\xdc\"ؠ\xabN`\xdd\tM2\x9a6\xd4[\xd8}\xf9\xa9t\x94\xbe\xe8]\x9c\xc0%mƋ\x9d\xf8\xe0\xb40.\xf8t2\xd0f#h\x9e\x92FñY\xb6\xf92>\xe2\x8f\x00|\x90Z\\\xfb\xab\xab\xbc\x8bDS\x8d\xa1\x1f\x96\xa49+\x14B\xebf7q\xbe\xd4r\x8b\x9b\x92\x94QI\xac\x13\xca\xf9\xef)NnKk \x91e_\xa3yu\xce\r^\x92\xdc9w6\xfa\xb7\xe6uBu\xc5ɇ\x9a\xcf!\xaf\xc4˒\xec̩\xcfq<\x1e\x16\xf9\x9e\xf1\xc7Z\v\xeb\n\xc0\xbfBF^\xe6\xe6\xf6&\xbb\xb8\xd9J\xcb\f!`\x91\xa2d\xa7\x9b\xa0\x89D\x83\xc5B\r\xa1V\x97M\x9f\x9b\xdc\xd6r\x8eK\xb8\x01\xa7,\xdegh]\x9d*WC+Ɗ+\xeem\xeb\x91]\x9dN!p\xbfU\xd7N<\v\xaf\x06+\xdalm\xc7W8<\x1c\x8ak\xd9\x02\xd6\x1e\xc0Or\x8bb\u007fu5wTNh\xa8\x0f\xc3\x10\xb4\xec\n8\x1f綅\x96F\x1dY\x18\x01c\xb4\xf0(\xd1\xe99\xbeW\xb6U\xe7\x05\xb0\xaa\xbe\x87\xda\t\xd9\x02\xb8\x1e\t\x94\x9e\xb25t\xee{[C\xcf\x14\xfb\xf5\xd5D\xbfS\r\x803\xd7m\xe2\xbd\x03\x8e\xb4\xb1\xb2Ķ\xfc\xaa\xdc\x03\xd5\x00j\xe4\xa8J{ŷ\xb0\xfei\n\xfdT\xd7a\xb7\xdfO?\x13\xf2\xa5,\xe8\f\xc7a\xfec\x85@C+.v\x8d\xad\x98\xd6.*q\x94P\"\u0089h\x01P|\xf9]\xf5ly\x9fs\x00\xc2s\xe1\xae\xf8\xf4\a\xcd\xff\xae\xa6\x15]e\xd9K\xad\x8a\xaeյ\v\xa8\x11\n\xe9\x98\xd4\xc1\x1f\xe4\x12r\xc4=m\xb1?*ׅ\xfa\xf4\xe3\x8f\b\xc0o\x00\xf8qj0nno\xd0\xf5}vp\xf5\xa8\xc1\xec\xd7\x0f\xfa\xd8-\x88\xd1x(_\xbdA$\x85\xc4D#\x01!<\x9a-{\xf9\xb6\xe7\x19}\xabx\xa4\x1a\xa9\x9eS\xadۍ\xd4Ncu\x91dM\xe0\x8a\x96i)\xf74\xbbO\xcc\xff\x99D\x80\xd9\xeb:\xf1\xa5k\x01\xcb\xfc\xf7G\xb9\x81\xbf}睙\x18\x97\xe6@k\x8cz\b\xdd4\xaf\xeb\xcd4\xd6^\ue51f\xce \xa4\v\x14\xc1\xaa\xb2\xbb\f5\xf8\xf8\xbe|\xf2\x16.\x1a]\xb0\xbb\xef\xf3\u008b\xe5<o\xc5\x17\x9em\xcc7t\xe9ה\x86\xa5\xfa&le\x11R\xf4\x85h\xafsfH.}\xcc\xdb\x1c\x87\u007f?5\xc8\x04\xe0\xfa\xf66m\x86\x9a\xee8\xe30.}~c>J\x83\xe1\x9f\n,i\xef\x94LGς\x12{\x0f\xe6Js\x1eiӷv\x8d\x91x\t\xbe \xb0<E\x97\xb0\x94\xcc{\xd2}o\xd98\xe2\xc4g\xa7\n\x91<_y\x8aM\x1f\x855n1cn,\x11x>\xb1\x81\xc2\xeb\xac\xd5* o\xb0\xb0:\x00\xffUꁺ\xae\xc7~\xb7\x13\a\x8eMG\xe2a\x18\xa0G\x93\u007f\xe5\x06\x9a\x92B_~\xa4\xaff\x81\xcd\xd25y]\x1f\x8e]\xc58uB\xe2\b\x84\x85\xb9\x19\xcf\xd2\x12\xfd\xccD}\xaa\bշ0W\xeb\x14\xabu\rp=\xd6\xe1 \x82H\xdc0\xc5Ot\x8e֭݇\xd5c\x16KCEA\xb0\xd8\xf3!o9\xaf-\xcc.\xed\x11^\xa63\x88\xe7\x86\xc2$\xe1\xf0[\xe2\xe9Ό\xeb\xdbk(\x8f\xbf\x926\xb7\xd6z\xe9\x83I\xc9\x1e\xb9\x1a\xc4\xd2\xc9D$}x8\x80\x99\xb6_\xe2i\x82L$\xef\f\x00@-\u05ed\xcc1{\n\xe0\xf0ּ\xb6j|\x1a\xcfUe\x13\xd2x~E\xab;\xaa!d\xaf\x8d]\xf5\x1c\n\x1d\xb9\xed\xf5\x16el^\xe5\x05\xb6l^\xb2\x00\xe9\xe4z\xbbBT\x9a\xd3\x03\xb8N]\xf4\xfa\xe6v\xd1$\xc2\u007fP6\xadyJ\x8b\x9e\xfdZBA\xbc\x8fb\u007fڏ\x92Ħq\xa1\xd4%\xab\x1a\x99)\xda^\xf0k\xa7ZV\x1bv\x16\xa6\v\x9e\xfao\x13X]\xf2Y8Z\xc3\xc5y\xdf\xe0\xdetƺ\xaa\xe5\xaerku&թ\x99G.\x8dU8F\xc9\xeb\xfd\xa6ŧO?\xfe\b\xca \x98X\x92\xc3D\xe8\xf7\xbb$\x17D\xb6\x97X\xea\x04\xe1\xd9\xd2\"D\x9d\x97#\xa9]\xf6,\x10\xaelk\x14<\xb4\xe4\xf3{洓t\xc9pu\xa7\xba\x10)\xab\x8d\xd6Zo>_\xf3\x96\x80\xc8Y\b\xf7K\x81V\x05uA\xad\xfcUE\xd5E\xca2\xb1{\x8f\xb6\x9b\x9cY\xa8\xcf\xdb7\xf1\a\xb7\xa7\xac\xa6\xf7o\x85d\xf3oè\xc8|\xf2\xd9\xe7P\x00~\x1d\xc0\x0fS\x0f\xa0H\xcd'\x87\xedx㙆s\xfeS\xf8?\x80\\\\\xb0\x14\xad\xf3-\xaav\t{a\xc9\x18`\xe2\xcc\tx\x8e\xd3\xee$\x17\xae\x90sCg\xb2\xb2\x9e\x8b5u\x91\xc2\xe8T\x91\xf0\xc6.Pq\xedU\xc8\x17\xeb(X\xb5\x1axS\x89\xac\xc2!\xc9\xd9q\xa9\x14\x0f\xb4\xa4~\xf4\x8c*\xfd\f?\x02p뻄\xff92E\xcf`]\xdcd\xb5jSɎ5\x829\xba\x00\xb5\x82\x8f\x9e[`\x1cqX\x8ba<\xe7\xa6\xcd\xf1\b\x99VJ)\xb5\x06z\xe2\xe0\xf0l\xc1jc\x0eL\xfay\xb5\x1aI\xc9\x05\xb4\x96Պ\xc0\x02%\x14ODk\x8byі>Ĩ\x96\xb0\x90\x19\x03^\xee\xc3\xc2_\xbe\xc2ĳ;\xc0\xfaqՆ+\x8a\x8e\x85_\x04\x03d\x14\x92jTa\x02S\xc2TE\x95\xde\x0f/\xc1(Wl\xbc\xa2<\xe8\xdc\v\xbd\xb4\xb0_R\\\xcf\xe8\xaaV\xbacg\x99\xdb\xc2\xe7scr(Ur\xbbT\xeaO\x18\x81Xp\x9f\x8dM(\xc4\x02j\xe6I\xe9\xb3\xc2Ӷi\ru\xe6l\\Jc\u007f\x9bP\xfa\r\xce\x15\xaa \xc3SE\xd2\b\xc9x\x12\xda\xd0KV\x1bg\"1g\x93\xc7]\xd9S1G\xd2R\xc5\xc4\xf3s\x02\x85\vZ{\xb4\"2\xcd\x1b\x8e'\xaf\x00>[\xa5\xc1\xa8+Y\xabJ\rj\xe4\xca\xd2\xe3\xc1U\xea\"\xe9u\xceK\x14ʏ\x89\xa8\xd6P\xb7\x99$P@Ev:\x1a*\xc9%\xd3؛\xc0\xe9{B\x90\x1cRZ`9\xad\xacS\\\x94RO\xc4\x06\xfe\xa3J]\xf69\x11\xd4/\xaf\x93\x0e\xbdSuۨ\xa6m=P\xae09\xf7\x9a4\xae-W\u0083\xaa\xd9'L%\x01b\xe9ǳz\xe0\x14\x81S\xc9g\x8b\xd1\xd6\xe7s\ba\xc7\xfb\x893\xe3\xb0\x003nyς\x9b\xb9\xa1\xcbw\x16\xf7\xb1V\xcf(1\xea|B\u007f\xb8\xb7\x1d\xa4xE\xfd\xde\xd95\xf2[\x80@\x00\x9f\x96hkQ\xdb\r\x95ʶ\x06\xdcN\x1a\x19\xcfx\b\uf2eb]\xc2]+\x92\x86&*/\b,\xab*jlZ\xcfm\xac\b\x88:\x97\xafT\t.q\x0e4\x17g&>\x83|\x10\xf6R+\xe8Ĭ\xf4\x92{z\xd2IU(W\xe2\x98_xk\f\rzT@;ōj\xb5\xb0[h\x805\xf3̅n7UJ\xbf\xbeq I5U>S\xa0\xfcP\x0e6pla\xfd\xa0\xf6\xc3rH\xbc\xe8W\xe1\xbc]r\xfc\x15G\x93\xb8\x88\xec\x05\xbaY^t\"\xc5GIe\n\xb5<\x81\u007f\x9a6\f\xf89\xbb\a\xafRt\xf4\xb2\x90\xf9\x19\x81ĥ\x81o\xad\xd5t\xf6(r\x8d[X\x00\x1e\xaah\x18\\\xad\xe4[\xf1\xfb \xe2\xde\x02Z\xd6\xfd\xf4\x80\xaf\xf2\x1a\x81\x85\xf5/\xab?,ujP\x9e\x98!\xc1\xb4\f6h\xdc\x06;ro\xea@e\xd9\xec\x90R)\x03\xf1\xe6>Տ\x8f\x87A*\xa9h\xbd\xfe\x8av\xe6/\x19\xeag\xb0\xbeZ]\xb9\r\xa9\x83\x98+\xa5\x95\xe3E\rr:).\x95$K\xab!\xa1y\xa6sN36\x15RJ\r\u00a0S\xe3\xc4q\xdc\x16\x88W\x9eV\x15>xȹ\t>\xfe\xa9\x9c\x82\xb4xk\xd4I#\xc0\xaf2\xbd\xd7Z\x02/\xaf\xc7\x05Õ\x91\xe1\x16+\xab\x16\x04k\xac\xaejK\xdf;\xf4\xb9:\xc5(\xfd\xc4$Z\xb0\x94\x1a\x90E\x94\xb0\xaf\x01\x9d\xa2\x97\xb9Pՙ\xcaq\xe6\x96_B\xf0\x0f^zĚVFq\x03\xd6\xc6I8\xa79_픟N\xc0d\xadӧdմJa_\x02\xb4\x9e:\xef\x17s<t\xc61/\xae\xe1\b\vNJ\xdc1֖+\x01J7\x0f]pX\xfa\x94\x9d\x9e\xec\xc0AQ\n\xa7\x94\x12\xe1W\xc7ǺԂ\xcaB\xbeM\x12'o\x9dj6\xe7\x85\xea\xcdęxI%\xb8\xbc\xabW\xb3\x91O\x05\xb3-\xc0\xb0\xa1\xd9\x04eD\n\x9a?V\xa45\xc2g\xe2Z!ͅ\xd2\xca\xecmP\xa0\x06!^bї\xf0u\xeeÒyV\xb1\x99 \xca\x16\xb6\x99\x13\xee\xd4˴\xc3\n\x8a3\x93\x83\x8d\xa5\xd5q)\xb7b\xeb\b\xce\x056\xcbS侞\xdc==\xe2\xfd\x90玕\xc6l\xabqKqY\x1c\x97\xe2e\xeb_#C\x85\x96\xc6\x05{\xcfGD)\xbb#\xb0\xb0\xfet\x8dc[¡@b\x83\x96\x96\x95\xc4\aqD\xc8\xe7\xac\x0f?z\x13xƜ\xbf\xf55m\xebO^l\xcf\x100^^+A˺p[\x1eBq\x97\xa9s\x80\xbfė\xf9\x86\x03#\x8a\xfe\x97?ӽ/0\x1a8!\xb9L\x8bf\xcd)\x0e\xeb\xf3\x12PQ2+\x84\x03\xb8H\xa5>p\xa9s\xa3\x95\x8aIe\x90g\xe4a\xc5[\xce\xe4a\x9d\x92\xcbR\xbb\xa8\x9e\x84\x85\xf5\xacq\xe1\x89\x02x\v\a\xd7\x12\x90i\xf8\xec\\\xce\xd8\x16\xfd\x1e)v\v\xa3\xceS\v\xef&\u1cba\x04ӘcE\"\x90@\x04\xa5\xba♯\x00\xfc|\xad\xc9\xe8\x11N\t\xfb*\x04\xb3Xz\xd5\xef\xd0\x11\xa4\x1ad\b\xbf\x1c\by\xa3u>\xae\xc1\x94\x12\xe4,\xbe\x8b\xed\x9fKp(o)0Ք\xa4<\xb9ѻ\xd0|\xc69\x8d,\xd0+\xbc\"\x19\x9a\x05\xfb\xcaO\x05\xda\xef\xf7\xa9=D\xab\x00\x8b\xb3\xfem¾\xf2\x93F\x05i֪~\x84\x91*\xe8\x16\x9b\x95\x1a\xfb\xa1U-\xe4KG\xe76\xcen\u007f2\xb9O\x17ؘo\x9bU\xbbZ\x1b\xab\x00Z\x14\xafz\xb6\x9fEI\xde\xca\a\xb8\xc0\xf3J\xe8\xd4Yp\xeb\xf7;\xecv{in\x02\x0e\xeb\xff\xac\x1e\x10O\xc134\v\x13_>\xd2\xfa\xf9\x1b\xb1\x9a\xe1Zw\x8b\x05͂\v,Dz\xa2\xd7z\x92V\xc1\x13\x04\x8d&\x89\x96\x8d\xdc\xfeK̓\x04V\xa7\x8e1/ju\x19в\x14\x8f\x1f\xd7O'{/S4\xec}+\xa5\xb0\xbf\xda\x17]\xc2\xdf\x03\xf0753\x17<\xbc\x973\x90ƫz\xf1\xfd\xb8̆r\xa0\x15ECR\x9f\x95KkX[,\xcckKs*\x1a\x11l\xb2\x81_\xf8\xb0\xcd\x0f\x13\x8ai\x8dK\xcfCc\x87\xe5sYYvwjp\xb8\xef\xbd\xfd\x98\xaa\x86\xe1\x8a=I\xa4\xd0\xf5]\xfc\xa0\f!\xad\xe1\u007fM\x9e\x02Q\x0e\x85s\xdf\xec\xddV\x14YS\x9c|\x1a\xf3W\x90\x9bO\xe4\x147]\xb4dq\x02,\xc1\x80K'mc=a\x13Py\xcf+]\xeb\xb1\xe1\x856*K\xda\xd2\x05=\xbbR©\x1a[@\xbehwce\x90\xda\xfa\xd8-H\xf7\xd4\xdaf\xb37I\xe5\xc50\x93\xb28䵋N\xbcT\xa7\x8c\f{z\xfc\xeco\xff\x8f\xd4\xc4\xf6}_\xb1\x83s\xfe\xa0\x9c\x9e@\xd1\xc9\xe1\u007fA\xc8\xeeM\xea\x99\x17\x16M*5\xc2F@8Z\x14'\xb9u[Վ\xbd\xbc\x1e\r,s.\\\xad\xbcv\x9dX\xf0\x86\xae\xecV5\xa5~}`\xd4\xec\xd8\x1a\x06v/j\xbf\xff\x82\xcfCg\x0e\u007fFT\xdf\xeb\xff\x9c\bJ)\xfc\xff\xed\xbd˖$I\x92\x1dvE\xcd\xdc=\"3\xab2\xab\xba\xba\x87l\x1c\x92\x001\xc3\x01qXkr\xc1\x05V\\\xf0\x0f\xea7\xb8\xcaO\xa8\xaf\xe0!\xb9\xc9\x1d\xff\x80Kr\x05\x80 \xf3\f\xa7A\x02\xe4`f0SU\x9d\xafȈpw{\xa8\n\x17\xaaj\xae\xa6\xa6\xaa\xa6\xf6p\x8fȬ\xf4>\xd1Q\x19\x11nn\xa6\x0fQ\x91+W\xae\x90\xd3C\"\x86a1\x80\x9fB\x83@B`\xb3ݎH\xa8&\x9c\xab`'\b\xee\x9d\x1c!\x03ġ\x05b\xe4,ȓ\xb5H\xf6\xfa\xb3ޗ_L\x9dP#]\xe34\x1a[б\xc55\xb9t%\xf2\xf7\x9f:\xa0\xfc\x18AwJ\x9e\xd7+\xd7\x10\xba\xebW\xa9\xacuG\xe7\x18K\xcfc\xeayOJ\xc53\xf7>\x972bH\xfd;\x13B\xa0,\n\xff\xef\x06zX\x00І\xee\xb7,\n\x94\x9b2i\xacz\xe1a\x10|\xe2\xe0\xc3\xe4\x86MQ\xa3\xe0\xd3\v2\xdds\u007f\x81M\x91K\xbe\x84\xb0\xdb\x17\xaf\xec\xf1\x1a\xc4K\x1b-\x17\xf6\xc8!\x8c\x8a\x05\x05ϩ{p%\x9e8\xe2P$\x95J\x9c\xdfq\x12##\x14\x9b\x8d\u007f\xffA\x89䠙\xd9\xee\xae \xe2\xe2\xf0`\xf0I\xf3\n\x99\xe1|\b@$J `a\x83\x96\xadc\xe5}\x1e\a\x80\xbf\x87\xe8\xfb\x17l`\x99\xc9\r\xe2_\xb9\xe1\xf8D\x1e.\x0f\v[x\x8d\xd8\xce^\xdbhM\x9e\xbb\x80\x04\r#^\xc4M&\xa4\x14B@\x14Et\xc9G\r\x16\x98\xb1\xbdځ\n\x91\xf1$\v\x9b\vx\xd9A\x1a\x9b\xc0\x11\x05ε\x16\xd4b\x83\x94\x91Q\xe4\x85\v'\xf9\xb7D\xd3\xe7\xe2W\xe2\x1d\xf2\x9a\xfc;\xcc'\x0e\xf3\x94\xf5\xf4@ky\xf6s\xb8\xea!\xbar:\xba\xbf\x19\x00+\t\x10 D\xef\xe0\x16\xa1\x900\x18(\xefvi\xfc\x8a\xc1\x03\x9a\xf9\xa0\xa1j*\x15\xe6e\vg\xe3E\x91V\xf6\xbd\xcfx$\x13;0\xd2s1\xac\x15\r\xf2\x97Ҡ\xf15\xc6\x0f\xb0>\xb25\xdd\\\x85\xde\xcc\xd6^\x93N^\xce_\x93A\x82x\x86\x04\x14\x19\fK\f\x9fR\x85<\xac\xc1`\x89\xa2\xc0\xf6\xea\nB\x8c{Xn\xbf\xe7\xc9\xdb\xcb)\x86\x0e}E7`\xae\xb7\xe5\x17\xa2:\x8a\x89\xbc\xe6b\xbctH\xb9\x86B\xea\xaf\f\xff\xe2\x05\x95\x01\xb4\xd2\xdf\xe4\x1e:\x9f\xb2\xdcuJ\xa55\xb6\xbf\x99HS\x1a\x04A)\xe5Z\x93 \xe8.\xfc\x0f\xdc\xedv\xd8\f\x01\xb0\xa1\x875w\x12\xfd,WNט\x85j\xa5\x140bS\xc8x4\x85\x18:\xb5QE\xc6\x02\xa3\\c=\x17\x03y$\x9e\xd6c5\xa4\x94w\xf3\x0f\x16\xb2R`\f\x17\xcf)\x87\xef'\x95\x80\xa0\x015\"\xefc\xec\x1ecň\xc1]\xc1\x90\x90\x99q\xf5\xe4\t\x8a\x04\ak@ \r~Y\xe9S2{\x87\x06\x8c\xf5\xd4\xe0Ӳ\x19\x8fo\xeeK\xe0Wgޤ\x9fC\x10\x17jUu\tcuVÜQA1\x968\tQV\xe6t'_j\xb4N\xc4\xea\t\xeb\x9e({\xb5\x0e\xa4\x92\xc3\a\xf1x\x96\x90\x88puu\xe5\xa3\xf5\x13&\xde17\x83\xdcex\x91\xf6X\xef#\x85Ч\x86\xaa\xcb]U\xc2J'\xfa\x17z\xc1\"\xa3\xfc\x98\xe4\x99\xcfyHd\xc9yǸW3\x92(sǕ'*\x8bƍ*E\xf7\x9d\xff3\r\xbc\xb3f&0\a\xed{\x193X\xa2,3\b\xa3\x94\xf7\xe0\x96\xec\x99\u05f9b\x94SE^\u007f\xb4TQ\xf4`p\x02\x1a?\x0ff\xa8\xdc\xd0t\x06[\xf9s\xc1\xa0>\x85\xe7\xe0K\xbd\x979\xe9\x02LŽh\x81>[\x8ff\x93\x92|\n\xed?r\xd9UN\xd87⡑ _/0\x98%\xec\xe7\xfa\x84\x80\x104\xeb\xc4\x1a\xd6\x06\"\x19\x92\U0005c25c\x82A\xb9\n\x90\x8eb\x04c\x19\b\xbb\x04/\x8a\x91\xec\xc6B\"^q\xa3\xfcZ\t\xa7<\x83\xdb\xc43ƛq\x19\xce\\\x0et\xb28\x94\xf4\x1e\x84F\fkϙ@_%59&\xb6\x14\x90\xe2SP\x0eƚ\xb5Kf\xb3\x83\x1c\x11\xac\x8bw\xe5\x19s\x13O\xe7ĤBb\xb7\xb6ɏ4}\x8f\xab\xfb\x1dA\x04\x12\xa1\xb9Ť\xb37\xf8\f\xc0=wqr\xae7\x8a|\x8d\xa4\xc7X\x00\xfd\x98\x8d\xd8\x18~u\x89\x91\xa4\tcIS\x15&:}u\xfb,\xac;`!\xa3\x13\xf6\xa0\xfc&\x9c3\xe4\x80\xf7\xa5\xdf.\x8c\xf2h\x93İ\x84\xfb[Qh\xb6)\xcd\x1d\xc9\x1e\x034\xa0YeZ\xea,-8\xee=lP\xbe%\xed\t\xad*\xd7qA\xd2*O\xdc@\x8f\xf5\xa5\x8b]/\a\xb2\x9f\xd3(w\x9e\xfb\x99\xc7|N2j\xf2\x18\xbbʢ\xf0\xc5\xd0㆞ܬ #l\xe0\x82\x9dk\xd0#1xɾ\xdeE\xecoz\xe8\xfa\xd6P\x1a\x82\x1e\x96\xcbk\xca\xcb\U000c656a\xa6jG\xd9kE\x18\xe2\xfe}v)R\xf0(Cl\xaaKM#\xd9\xcd\xdcE\xb4x\x03M99\x1fa\r\xe4g\x15\x96*u\xaa.\xf8\x1c\x89\xb8\x8e\x12\x03#\xdd5\x8aG\xe3\x83H\x84e\f\xbe\xb5=1\x83E\x00\xbe\xee\x1b\xac-\x8a\xb2\x1c|pQ\x14]\xe6PI\x85\xa6i\xa0\x94LnW\"\xd28\x16{f4do9G\x98#\xa1\xfa؋\xb3'dTF\xfa\xb9QDho\x89\x8c\a/\b\xe1r6\x04{\x9e\xa4\x9b\xa8H\xf5\xaf\xbb\x84\x11q=\xabs\x87\xa2s\xb2]\x9f\xc3+t Ι_r\xbb\\\x99\xf7\x87\xc0\u007f\x17ע\x84Y\xa21\xf8H\xb1\xd6\xc5r\x8e\x02\xf7m\xd6`\xfd\x93\xbea*\a\fwQ\x96\xd8l\xb6\x1d\x82_\x94\x9a\r_W\x15\xa4\x94io\x83\\cC=\xc6\x03\xfb`]bQ\xb1\x0f\xdc{\xa5\x13\xb6K\x90\x80\x98\xc6\x1d\xf1\xaf\x95\xe9Qu\x9dA\x1c\xf6\xfcҰ0wA\xe5~\x12;\xe3\xbc\x1a\x85c\xa1W\xe5{\xc2\x0f-'3\xe9\x1e\x82\rFyX\xcc\u007f\xaegZx\xed䡘\xb1\x86\xd95ZƘQ\xaa\x15\x1fxR\xbd\xa4\x10\xc2xi\x142\x13\x10&\x1c\xfc/{o\x14C\xafcS\x96\xe6\xe7'\xfbS\x94\x05\xb6\xbb]g\x11\xdd>\x84'\xce(Gӱ\x1c\xf2pB\"b\x18o\x9b\xd4\xcb\\\x88\xf9\x18\x12O\xfc\x9d+\\6\x19\xcbZ\xb2h\xa7\x84\x83\x81&\x1e\x8bq\x8e/a`\x10\x9ax,\xb8aL}49\a\xb1\xc6\xc5\xdc\xef/x\x12\xecKcj\x1c\xf0\xb24|Oᣗ\bEQ@q\xbf\a\xbc\x1f\x12n\x00\xfc\xde\xdf\xfe>\xcd\xff\xe4q\xf5ӃEY`\xcb[\xd4\xc7*\x92G\xe8g\xefH\xf4\xc3!\xf6\xe8\t\x94\xf2\xaaR\xde\xc5L- Θ\xe4XX\xb1\xa8\xe7\xdb\x12\xcfb\x827ǎ\x9b\xce\xe6t\xbe\xa4\xd1\xf8\xec;\xf1\f\x1a\xb2\xac\xe0]Ů1\xe1\xdag\t\xb9\as\xc9>P\x13\xd79\x88\x8a\x9d\a\xe0\x12\xc5\xee\x1a\x1fd\tK\x9c\xc0\xf7\xcc\x1b\xed'\x02˲4Z\xccÿ\xe2\xce%\xd4\u007f\x1d4\n!\xab\x8eyYĩ\xba\x0fK\xb4\xb0xB-$en\xa0\xa5ܝX\xeb4\xf6N\xc8Kl\xec_E۰3\\\x9b\xe6z\xd3\x19\xd1\xc0\xd4g\xa2I\xabzh!htG\x86\x9bU`\xa8:\xda\x19,\x82\x97%\x9c<\xc0\u008a\xc7\xfba\x11[\x9f\xf2\x04_͑\x92\x89\r\x11\xf3\xfc\x9aC^P\xb8\x1d\xc10h\xe1u\xb0pl\xb2\x9f7`\xb4\xd66T\x9f\x15\xbf*\x85_\x9d#\xdc_1\xc4\f%\x8c\x82\xe3bˁ|\xc1K\xe6\x14k2\xe9Ȍ\x1b\xaa@A\xb5\x92\xa0\xbehh\x10Ê\xca\xcc\xe4\xcajhf<{!\v\xf52b\x14\xdb,K\x16\xf79O\xcf5@\xf4\x05Fe\xed\x8dJ\x11\x9cm\xad\x8d\xfd9bT\x93\xf0\xabs\xae\xc3\x1c9\xf0\tk!\xe8\x89\xfb\xa56\xce\xcfc\x8d\xa9x\xd4 \x05\xc5d\xa2\x96\x84A\xa6Q\x17\xb9\u007f\xdc\xcb\x12\x16)\x0f\x8b36\x0f3\xa3,\n\xb4..c\xbb\xc3z]7\x10\x8aw\x99G뤒\x9d8z\xe1\xdd\x02\x8fm\xe5\xc5K\x13\xbc(\xbf\xab\xb6OEX\xeai\xc4t\xcd|\x01\xc1X\xd6眆\xe8ܙ³\xf5{\xbcT&p\xe6:\x98\xf5ܾh\xe1Y\x0e!\n\xee\x1c\x86n|c[\xdaӉ^\xc0y\x06\xcb\x01\xee\x92\x04RhvjQ\x14h\xdb\x16\x03\xe1\x88\x10\xab][\xc2S\x1b{ \xce\xefȘ\x18\x9a\xab\x955R\xdaB3\x1bgRd\x11\xa4\xc2F\xb7Α\"\xc5ݼd\x13\xf8\xcfm:#=\xb4W\xf4E\xf14\xb2\x16R\x1e\xd59\xa8\x13.)\xdbml\x1c%\x89\xe6`Ɣ\xf7\xb4V\xd3}h \a\xc5\xcf\xc5(\x86\x95Y\x1fU\xf4Z\xf4\x9c\\Af\x15l+O#`>\xcd\b\xbd\x1el\xf1\xaf\xa03O!\xf7\xdf\xff\x9b\x11\xc0<\xdaL\xd3\xef\xbc\x12\x00濼\x1e\xa3\xf5J\xc8{\x8f\xad\x95\x15\xea_Ǔ@ӄ0G\x8d\x1a\x01\x85\x10\xd1[\xc8\xca\x12j\x06j^\u007f4\xbfSt'\xe4\xc7qkn7*\xcfиʙ\x98,\r\xa2\xa5\"g\x98\xd0\xe45\xf5,^\xdfŕ]\x99!\xba\xa0\xd4g\xddޞ'\xd2\x00r\xc3\xc1\xb3\x1a{W\xa3=g-\xadd\xb4|U\x85\xb1\xcb\xf3*Њ\xf9T\x97\x86!z^\x16\x87<\xac2\xe9\x92\x02P\x116{\xd4C\xa2\xber\x1f\x83{\xddb{\xa7\x87\rOx\xd8\xce\xde\xefB\x1bjV:Y\xbe\xf8̛bn\a\x95%\xf7\x9cdr'ާ\xbexY\xe99x(\x15\x8b\x00\x94\xb0$t\x1f[\x1fi)NJ\xd8I\x1a\xb1\x04\xf9\xae\x04A\x97\xe6\x10\xf5`\x8aA|X\xc2\xd7t\x0f`\xd7*\xd6\x04½\x98U(\xf5\xb3Qn\xb5\xbcۯ\xac7)\x11\x11>w\xd1P\x86\xbcEdrb\xe2f4'\xcc\x1b3\n\xe7Ą\xe6p\xa8|\xfc\xca\xfb\xe2G\xae\xea\xb0d\x83f\xabe>\xc2\xe7\x0fQ-ib\x86\x90\xe7\xe8\xb4\xc1\xa33\xf8\xed\n\x1cvU|\xed\x8c1*\x87\xb4\x1a\xa9\x14\x84\x18\xd4*\x0e\fր\xd6\xc0\xcaY\xc4nky?,\xf4\x06\xaf\x10\xa2\x97\x8d\naQ\x1c\xdb8\xa1\a\xcb̎\x9c\v4>\x97\xf2\x02\xaf\xfcw\x8bƆ\xe8\xb3jκ\xda8=F\x03\xeeTs\xd0\n\xd4\x06\u007f\x9d\xf9Y{\xf2\x1c%k\xa8z\xe2\xc54&\x93\x1e\U000f41bf\x93m{\"\xa0\x9fl\xcd\x00\xc3\x1a\x80\xee\xb2i =\xe3\xc4JA\xb9?\vpz\x88\b\x9bm\xa0\x97\xa1\x1f\x8f\xaf\xe4Q\xa4&.\xca\xf6>\x83Q\x98j䦌\x03G\xfeM+\x19\xac\x87.\x88~\xb4\xa1\xe0\x99\x0f\x92\xb5\x8c\xech}\xe0\xd85R\xd1H/$\x1df\xba\xb9\v\xc7\\\xecy\x96b\x17\x94\xb1/\x84\xa0|\x14%=\xac\xe3\xf1\x88\xb6i\x06ލJ\x88\xe3[\xb6\xfbf\xb3\xc1f\xbb\x8d.\x82\xb57ǒ\xdaAZ\xb1%S\x96\x06\xfb\xdc뇮\x91рu\xd4X\x999\xa3\xcf\xd6\xee\xf0t#\x95\xf3\x9eK\xe0~\x13\xd5Cg\xdfϤL\"\x06\xe2\x9c̼\x8a9WN\xa4Ŭ\xa2[`\x80a\x11\x80\xfaxDSU\x03a\b%\xe50\xae6\x85\xd1.\x8bz\xbb\xdd\xfa\"\\\x1d\x06F\x0fh\xacb\xb8\xc0'\xb1\xf9b\x1eW\x02\xabII\xe6\xda9\xa3\xc7\x1c\x02]z|\x1f\xd1\x18\xac\xa9*\x9a\xac\x1d\x8d\xfc-\x85x^A\xec \x85_\xe5\x8cz_\xd3A*e\x80w\n~\x80\x80Vk(\\\x8b۶-\xea\xaa\x06\x14\x0f\x98\xae}\xb5\x02\x11eEﮮ\xfa\x9e\xd6#\xc8FMҐ?\xb3\xe1\\#4Y\x12F\xfc\x1aڒ=\xf6\xec'aJ\xed\xddH\xa9q\xaa\xdcj\xa4aL\xe83:.\x81\xab.\x1c\xb9\xc9\xf8\xbds\xe6\xd1k\xc2@\"()\xfd\xdb\x1d\x10G\xb7\x83\xcfcFu<\x0eq,\xcf\x02'\xa9!B`wu\x85\xednw\x92\xba\xb02\xb2kn\xbc%\x1b\u007f\xc5M\xcbg\xb8f\xe83r\x11\x02\x8adT)\xc1\xba\u007f\x88\r~\x8e\xcf<\xfbs0\xafb\xa8FC5\x9a\xa1<\x82\xf5TV\x19\xe3Lv\x1d\xc9\xf1\x84\xa7$\xc4, +e\xae\xd7\xe3\x06^\x99\xaf\xce`]\x87\x06\xeb\xb8\xdfC6m\xff\xee\x95:)\x0eff\xefvWW\xb8~\xf2\x04\xa5i{\xbf\x06)r\xf0\xf9c^Ü\xd0qF\xd3ʳ\xe3\x18\v\xc7N8a\xfb\xe7\xec_=d\x17\xa05C\xbd\xa5|\xbe\x81\xb8\xe4Եdz\"d\x1e\xd5ˀ\x16\xc5\x1ar\"\x8d\x95;W\xfd\r\x80o~\xfc\xe1{\xb2\x06k\x17z\u007fUU\x1ax\x0fXө\x9b\xb4(K\\?}\x8a\xab'O 6\x9b\x13~\x12\xfa\xc2I\xa3\xaa\xf7sC\x99\x10\x16(\xf6\xde3\xea2\x8fx\x1bk\xb9\xf7\x97\xc0Z|OwU\xc3\xfa\x85D\xba||\x92\x92\xc1\xd3\xd6\xd1\x14̕&\x88X\xae\x95\xcd\x1ef\xf5hth\\\x82\x81\xfd\xef\xb6m;\x86\x93\x94\xado\a\xff\x14\x86\xdc^\x9a\x90p\xf0\xe0JJT\xc7#\x9e|\xf5,\\V3\xd2N>\xe4\x1dXCe7\x9b\xc20\xad\xda+Չ\x90\xd2x\x05\xcff5\x95\x06k\xc0\xcf\xe1i1\x03\x11\x95\x05\u007f\x8cǼ\x8a\xdc\xf1b\x00_H\x0e\xd3\xfc\x89\v\xb8\x8c}\xf2\xf4\x98Ѵ\x89\x18\x95\xe8\xb6>\xb3\f.\xe6ő۰8ե\x8a\xfb\xbd\x8d\xedf\x94\xb2\x85\xcd\x10*\xa9\xfcK\xfc94\xd6\xde\b$\x14Џ\x87}\x8f,JD\x9a\xcd>&\xb5\xe1tZ\xf6\u007f\xc7!\x97\xd7\xf9r\xbd&\x11ј^\xcb3Z]4#c1\xf1\x8a\x1b\x873;G\xe7\x90G\xe7JL\u007f1Z\xe7Yks\xc3A\xdb\x1c\x02\xccP\x16\v\xf2\x0e\xfeT)\x0e{\x9eS\xf6S\xf5ܦ\xf4\x03\xb3Uoq\xfeN\x97\xfe\x11ڦ\t\x1d\xbc\xcf\xec_Zy\x99\xe0\"\xae\x8eU\xaf$G\x14\x02EQ\xa4\xf5z2Jx\xe6\x9c.S\x9a\x9er\xcck[\x01\xdb\x1a5\x06D\xeb-܉\xe1^?!\x12\xc7\xf3\xdc\xcd\xf0\xc5<9\x9b\xf8L\xddnh\x89\xc13\xb8/O\xfc\xbcS\x8d(\xb9R&\xa7\xcf\x0fd\x15{Њcղ$dBQU6\n\xc1:$T\xca\x18\xac\xb8Ђ\xa55\x04_\xb2m\xfb\x9f\x1f#\x19\xba\xeee\xa0\xe3M\xec\x19\xc8)\x01\x18K\xedҒ\xd3j\x02G\xe9\xb1xkY\v{B\x83\xd7_\x93\xb1z\f\xf4\x99\xd8z\x9eug\x19zj\x03/\xbb\x13\xc5\xe4\xa8w>\xa8\x06q\xd6\xc7\xe9;\x8d<\xe4\xd0 N}ɶE\xdb4P\xac\x93z\xb2\x8d\v-\x041\xac\xde\xc3\xfb\xdeKd\xe3\xd0Ha2\x8f\xc5\xdb\x11w5\xf4o\xb7\x98\x89\xfd\x8c\x17\x9f\xee\x93\x18]\x13\f\x9a\xe0\xbdL6f\x19\x18\x16\xadh\xac(\x84\xa1%\xee\x9d\x02\xc5\xe64\x062\xfcʌ\x16\xe7_x\x1a\xe6\xb4\x16\x1c\xe0D\x1aI\xbcҭ\r\xf5%\x9c\xc6\xee\xc1\x01\x98\xb2Ǐ\x86\xe3>U\x87\xab\xae\xaa\xceH)\xa9\xbd\xad\xd85\xe2!\xa1\xbb\xf8{\x1dO\xf3\x14\x13\xa2\x13\x95\x98L\xcb\xd3\xea\u007f\x1c\x85\xcbzH\xf78\v\x81\xf6\x9d\xb4\xbcuh\t\x03ŇU<,\xb7\x185sSL-\xa8&\xbfY\xec\x8cP֧\xa2|\xeea\xe0\xb9<\xac^˴\x14\xee41\x84\x9b\vu\xa4\xd6\x19\xcfXsAX\x87\xc6\xed\x95o\f\xa7\x18-2\xe1\xa0\xfdئ\xaeB\xef\xef\x95\xe6\x88\xe8\x8d\x10\x01\xb6\x83\x05\xcd\xc3W8\x03\x9bQ0\xdc\v\x83ƹJ\x11l~\xce\xcc\x1d?\x83\x9df\x17<\xf5\x0e\xdc\xc6\x1a\x81\x1e\x83tFI\x995\xcb-\xe6nP\x1a\xf3\x12\xbf\x80\xee\xb3\xd64ez\xd99l\xf6\\\xe6\xfb\x9a\x10CG)Z\v\xa7˨l\xb1\x126JJ\b\x12P\xb2ES\xd7\xc9\xcb\xdbZ\xc2\xe0ӑ\xe8\x9f%\xb4\u0083\xf8\x1bQ\xb1&\x8ce7\x88\flR\xb7\")\xc7\x03Y\x95\x95>\bI\xd79[\a\x13\xbeB\xbb'\x9a\x19\xce\xfe\x1a^\x8bZυ\x12/Bd\x1b\xa51\x11\x96\xa9N\xc2\xd4p\x94b\xff\x95S\a\x0e@\x81\x17\xd1aXi\u09a9\x9b\x01n\xee\xbf\xe2\xa0;\xd9pjZ\x16l\xca@)f\xcd\x15\x89\"V\xd3=\x8e\x9c\"VZ\x91\xe9\x1d4\x90+\xb6\xcd:\xdf\x0e\xfdb\xa8\xd6\xc0\x97.=\x82\xb4\xf2\xb3t$m'G\x98\xdf\xf2ā\x18\x02\xbd9G\xd5B\xcc>T\xac e\v\xa5\x14Z)C\xef\x1b\x0f\t\x81@Vp\xa1:B7$\fH\xa9\xa0\x14\x83\xf1x\x9aF\xcc\xd6\x14Z\x12\x1eg\xde\xdbҰ\xf0b\x8a\xa8\x9f\xabA\vx\xb8Io\xfe\x13H^P\xb0\xf5\xfc\x94\xa6\x12\xec\x92#\xbb\xf7\xd1\xc4=\xa1\xa44z{\x12*\xeca\x8d\x87\x84\xfd\xf0jf-\x12\xf30vgF\xdd\xd4P\xadD:\x1f\x18\x18\xd4)\xf8\xd2\x1a\xad\xb1\x96\x9cұ\x05\x9ei\xac\xd6\xf6\xae\xf8\x8b\xb1Z\xdf\xcb\x19k\f\xf1\x10\xf4\x8a\xa9M7\x98\x17\xef\x12\xe6\x85\a\xae\xb11MU\x8f\x1e\xbcю9d<,זL\xd1N\xd2\xe4/\xeaQ\x1e\x94Rh\xdbVK\xa1\x12\x01\\\xf4$\xdd\xe3b\xf7^\xa13\x8f\x9f\x02\x1c`\xf6\xda\xefE\x06\xa5\x81s\xc2ʜz\xc4ܓ\xf6̍J\u007f-\xc6\xeaR\x1c\xac\x1c%\xd7s\xdd\xc9ܦ\xc3i\xe3\x1a\xdaQ\xbd|}\xf28\x8c\x8a\"\xe4\xac\u007f\x06\xa4A\b\xee\xd6\x00\x00 \x00IDAT\x94h\xeaf\xf4o\x93\xb4\x06\xff\xb6sEΔ\x94h\xa5\xec\xfa\x14\xb2b\x13\xa7ʮ\xfb\x0e\xb3v\xef&{\xceN\xac\xec\x9et\x1c0\xa6\xfeĲ\u007f\xfa%\xf0\xb9\x94\xe1Jy}1\xf0\x91}\xa5\x8a\x00m!hl\x17b\x88_\xbc\xaa\tF`Mc7(\x98[\x19\xceH]wF?B&\xcb[\x04(\xb2\x9a\xfd\x8f%\x1a\x1a\xa6`\xc3\xe5\xd4vV\n\xb2m!\xa5\x84TQ\xc0\xbdp\rV\x9b\x9aD\xc2t@\xb957\xa0\x1c\x00m\x98\xe6\xd4&p\n\xfc\x1dUep\xa9\n!\x03\x00\xaf\x9d}\fÚy\x92N1\xb4\xbd\xcfN(\x82F\xb9,\t\v\x1fm3\xff\xc8q\x94O\xae\xcd\xd8C\x1d\x003;\x00%yQ]h\x18\x826(\xdbY\x9a\x95%4b\xa1\xb2ժ0\xb2\x951{\xf0\xad5Z\x02\xc0\xfb\xf1\x89\xd1\xdfE\"U{\xf2\xae\xb4'e\aʊ\xcb\x0f\x17%uu\x8a<\x80\xfc&.\x96\x04\x87(\xa8\xaa\x98\xf1\x1c\xabaYk\x87\x1cc'\xd6\x1c\xdc\xecW\x10\xc2-\x9e\xb3Kh\xa6\x9f\xe3\xf3s\ue347\xab\x85\xf2\xb6W\xff@\x8e\xddc\xa0\x00\x1bФѺ\xaeMݣ)\xd4\x0e\u007f\xc6?\x82\xd1\xed\x13\x00\xfenԅ m\xac\xba\xbe\x83\x89\x9bn\x83(\u007f\x84qbnз\xaa<g2'\xf2\xb8\xa6zRS\x17d\xd2\xf8f\xdco\xa8\x80{\xd6\xc2\xcdhV\xf1\x05\xc7\xfa|<\xb1\x1c\"B_˿\xffn\xfb{\xc6\f\xe6\x8b_\x99\xe1V\x97x\x17\xb3\xa4Ѷn\xb4C\xa3\x14\x84\x10\xb1\xb9\xfb3h\x11?\b\x00\u007f\x8d(!\xdd\x04\x85VVf,\x14\x94\x12RIL\xe1\xeb2\xf3:n@\nH\xf7\x8f\x8a\xb5\x8d֜\x054Ҟ\xec\x1c\xf7\xf0\x18\xbd\xad\x8b\xc8\xd9,\x1d\xcf(p3\xefp<\x97\xd7ι\xfb\x849h\xac\xba\xbbv!\x96\x99\x87\x85\xfb=\xf4u°$\x94\xd4\x11X\x11\xb71\xd7\x00~\x0fSw\xf33\x80\x9bЃ1;\x1d-2\x94\x004c=>N\xc1\xee\xf3JMZPsdb\\\r\xad\xb5\xc2\xc1ٽ\xe0.`4\x83\x89\x82s\x86\x16\x8f\xc4\x1bzt\x1e䊺m9ʣ41\xda\x18\xbc\x97\xe6\xe46\x03\x8eɄ\xcfW\x8a!,f[\x88\xd4\xfa\xefB\u008f\xc6h\x05\xe2\xcb\xca\xe0Q\x04\xa58\xeb\x81C\x86i<\U000df1fb\xf0\xc2EA3\x8d\xc8Cx\x1d\x9fD'\xe2O)\x1c\\Ҍ\xe1\x11w\x81\x9e\x03\xa5\xf4\xc9\xdc|\xea\xe5\xdc\x13\b\xa0\f\x03\x15N\n\xa5<\xfa\x1e\x1d\xa8#\x9bZ5b1\xea@\n\x00G\x00\xff.\xe81\xb5\xb2+FdV\xd1:\x1f_[\xfd\\'\xd3@\xd7j\x06Am\xac^+\xb7\xb9\xc6\xecg\x89aS\x8f\x18\x17\xf9\xe4_k\x8d\xd9\x03\x8d}n\x97\xbf\x9e\xfep\xea\xcf\x02\xfau\xecf\xae\x93\x06\x9a\x13\xf6k\xf8٩\xee\xd4\xd6\\\xe9\x8eϺWC\xe2p\xe8\f\x96\x04\xf0\u007f\x05\xffB)c\xb04\x98\xd6\xd4\xcd@\xad\xd0ZJ\x86n61ǝ\xb4e\x0f\xa3a\xcbB\x1dj\xca\xc4)hŐm)\x160\x18\x8f9\xa7=3~-\xfe\xd9ټ\xa1%\xc6j\xe5\xfe\x03\xb4l\x80\xc6\xef3\x18\x1a\x06\xbc*\x1a9\xd43E\bȨ\xa2\x8e<\x97\x004\x0f\x8b\x01\xfc\xab\xd8\x05{^\x95ѕ\"!\x82BpEYB4\x8d\xd7\xd2>\u007fx\xb3\xb9\x1cK\by\x99t\x81U\xc1\xe0\x18Q5\xb1\xe9\xd6\xd4\xeb\xfa\xe2\xa1-\xdc\xe0K\x8d\xd5\x1a\xad\xed\x10\xef\xfe=is\xf5ٞ\xe8\x150O5\x8bΆ\x1d\xd4\fg\x8f\x1bA\x14\x02\xccj\xac_C\xe7a1\x80?@\xcbR\r\a\xc9\x1bl)eT\xb5\x92:/+S\xd1'\xf0'\xd9ِ\x99\xaa\x119\x12\xccgY\xf4\x131\xac\xf5\x1d\x84\xcf[\x9d\x81'Զ^\xd2\xc0\xaf\x92DY\xfa\xfc\xa6\xec&\xe8\xa9eǛ\xc8nUݣ\xe0\xf0\x1cdm\xdc`\xfdd\xbeb\xd6\xc1:n\xda\xe3J\xa4=\x85[\u007f8\xf2\xe5\xfe\xb3\x17\x16\x9eis\xc5\f\xdd\xd9S\xeb3\x9f\x87\x166\xd1\xfc\xf2\xca4<\x89\xb1\xe2Gz\xff\x14{\x0e\xe6\x01\xf8u\xe2\u007fS\x9e\xad\xe0\x88\x81ʴ[\x83nX)\xfbG\xe8T[\x8a4\x86\xa5\x1b\xa9\xbe|\xf5\x1a\x00n\x01\xfcm\xe8\xaft\xed\x1fwWWN=`\xcc`\x11e٫\xf1\x8d\x1d \x9b\xf5Z\x87ML\xe1\xd2CUЯ\x89q=\x10\x0f\xe8\xb3\n\xf1\x1e\xc2(\xad}(R\x9cF\xd0\xd7\xf8\x0f\xf4Dp\x03\xc1P\xa3\x9b\x11\x03\x15s\xbd\x16\xa9\xa4f\xc2 \x16%o\xe1S\x1b\x8c\xb1\xa8\xab\nJ)\x14\x0en\x95\x12\xc0\x1fFܙۈ\x01(\x06\x8a\xb8[N1l`\xf4~\x10\xf5\n\xcf\xed]u\xb5r\tF\xfcC\xdc\xd7\xe3q\x1c֫%\xb4\xd8\x1fg6\x1a\x1d\x18\xabO\xd4+M\xf9$~\xfd\xea\t\a#\b\xaf\x9f\xd7x\xaf\xc2\xf1cpN\xdfP\xcdp\x17Pi\xa3Յ\x840\xf8\xd5\xc7\xd0b\xaa\xeb\xda\x14%\xf6;2'o\x8a&\x06\xbd\xdd\x1d\xf1$\x9d\xad\xa0w\x96rE\x13F\xe3\x93:]\xbf\xbc.\x17\x16\xaea\xc4.<\xff9DS\x11\xae\xcd\xe9)\xa1\x84w.\x9fŝw[\x93%CB\xe7.\x82\xea\xefR\xca^\xa6\xd0\xf2\xad81)I\xe7p,F\x8c,\x90\xc7\x04\x8a\u007fy=戏\x1f\x9dQ\xa15>ÓM\x9a\f\x858\u0090\"\xf1w\x8bZ\xa9\xc4:\x8fw\xbeY\xff\u007f\x1d\x13\x8b\x15X1DQ\x8cbX\xe5\u061dXŅޟ\xb8\xe1XГ\x99?\xb1\xb9\xd4\x06\x1bfeMޘ>ϙ\x16+\x9d\xa1\x93\xce\xe7\xd27\xf0S\xc0\xb6V\xf5\xecf^\u007f\xac\xd3M\x12\x9e\x89i\xc3y|F\n\x81]SW\xa6\x15\x00\x1c$\a\xa8\xf7=\xb4\xf8m\t`Wt\x1d\xfe\x04\xe5\x1b,\x8e\x19\x06\xad\xc0\xe0u\x1f#J:P<w\x83Z }\x82&tv\x0f\xb4\xb5\x8c\xc6\x1a\xa7\xed\x97\xf0\xf2\xe1\xc2\xc2OУ\x1e\bQ\xda\xfe\x87#\x02\x93n\x19\x8cp\xdeK\xa1\x82n\x82V\U0009bf51(\b\xf3\xa4\xfc6f\x86\x10\x05\xa4q\x8c\x12{\xb9\x17\x12\x12\x12\x1d\xa0\x8f\xfb\xfb\uebe6h\x83OC\xb0\xbc\x19\xe1\x8c\xf6b\xb9\x9e\x95\x8b\xb9]p\xb1RN*:\xc73\xfb̍\x14=`\xc9˒\xdf?\xb8\a\xe8f\xca\x03\xf5\xb8\xbd\xb2\x187z\xc9\xc5\xeb(\u007f\x13O\x92O\xb7\xfd\xd8ݶ\xf4<\x1a=\xf4BB\x01\xe0E\xec\xaf\xf6\xf7\xf7P\xcaH\x1e\x9fۓ \xca{sF\xbc\xedj\xbfӅ\xc3\xc1\x87\xf4\xe2\xdcӋ\xbe踏B\x10)#\xf1\x90cȁ\xe7\xe3\x84Ae\x1f\x8f\xf2\xf6\b\xb94\x06\x1f\xd2ao\x95\xceu\xb4\x06X\xf6\xf0\r\xf6\xe7\xac\x18mۜBB!R\x06O\xb9\x1e\xd6\x15\x80\u007f\x1c\xfb\xcb\xfaX\xa1:\x1cϻ\xf5\xdc\xea\xed\x14]!Vh=V\xbdn;ۮ\xb8\xf8\x18i\x8a\xc7,\x1d\xaa\x9c\xa6\x16_\xbc\xaceF\xeb!\f\xd0J\x8d@(a0\\\x19p!|\xe7\x82\xc22\xa2\xb1\x8b\xf1,Gk\xd4X\xb9\xf3ݶ\r\x94\xd4\xed\xbd\xec\x1c\x8eͣ\xf8\xf1\x87\xef\x01-\x8e\xf5\xa7\xd1Š\x14n\u07bf\x87\x15\xf7\xe21¦;9\xb9_c\xa7\xa0馛\f\x0f\x03\xd7b\xcf\x1b\xe3\x99bl\xfe\xe6pǀ\xd7\xcal\xfe\xca\xf1\xa9\xd9\x1ac\x9f@\xb8:\xbb/d\xac]\\H\xb9\xc4\x19?\"\xcfԌ$탖)\x1b\xe8\x19\xbe\x81\xdd6[d\x9f\xa3\xbf1e+\xc1l\x94F\x8d\x9d\xc9\xc1\xb0\b\xc0o\x11h\xf7\xe5\xde\xc6\xdd\xed-\xea\xba2\x85ܜԖ\x12k\x88\xe49\xa2\x88=\xf76e\xe0b\x86t\xc4H\xf1TC\x95q\xc2\xd3\x1a\v{\x05/\x8b?1Cx\xb1\x92\xa29\xca\x1f>ft)\xaf.r\bG\xbd6\x17D\x1fq\x87\xfcR\xc2qO\xcay\a\xf5\xdfD\x0eu\xe2dTy\xd8\xfb\xd0~S\xa6\x03\x16\t\xddH5QA\x03\x8f8\xba\x1d\xdbDm\xd3\xe0pw\xa7\x83I\xa5\xd2n\x1b\x89\xc9%$\xc3A\xd2\xd9\x05\x9aH\x02\xcd\t9\xfd\x05H\x8fi#-\xdf\xf1C9\x1a\x93\x81\xf9R_\xb8Ҙ\xaeq\xbd\x9c\x83&\xa3\x9b\xb7\xab\x9c\x12\xc4\xe6<\xfbB\x93ü\x91\xbf\xa4H(\x98\x8a\xa4\xcc%\x14\xeb\xb2?fm\xbcr֨H\x1d\xec\xec>\xafR8\xec\xf7\xa7\xd6҉0\x88ĬG\x8f\xbca\xa1\f\xf1'\xa4R@S\x9eol#\xb0'm\xcdlځ\xab\xcfخ\xf0y\xc6\xfb!B\xf8ĳ\x9c\xf4\xf2\x86\x14m\xf2\xcb\xd8h\xd8\x1c\x87\xb2\xcd\xd7x\x03\xd4(f\x955|\x02\xcc\x04\xa5Z\x90\xa0\xae\xdbV\xcaV\x89TTD\xde\x00\x1e\xf6{0+\xa8\x11/\x8b\f\xd64\x89\xd2@n\xbcM'\xb9\xd4\\A\xfc\x81X<_j\x97\xac\xb3\x98\x13\u007fϡ\xbf\xcb\fO\x06\xea!=\"\xf0\x17\x1c\xebA\xf0\xb0La\xbb\xde\u007f\x8f\xe9\xb8Q\xbc\x18'\x99\xfc\xa1\xcct\xe0\x98=\xa39[\x87{eӭ\xed\b\x9f\x11\x12\x86=,\xc7\b0\x11\x9a\xa6E۴\xe9\x1ed\xa9E7\x06\xba\xbb\x83 h\xd9f\xf7\xca\x11h\x81\x11\xe3\xa5\xd2\xcc+\x9c\xf6n\xe7m\x9e\xa1 \xca\xde5\x1e\xbb\xa7\x95k\xb8\x96\x84\xb9\xbcd}-\xf5\xc8V^C\xc3\f8\xf7\n`(d]ƄE\x97\xc8/\xf4\xba\xe4\x9c\xe0\xac\xd0g\x91\x10\xa3*0~H\xb8\x1d\x05\x19\x01\xb0\x94h\xea\xa6\v/\xb2b\xeb\xdcN\x14\xfeb\x1d\xc3\x10r\xf5\xab\xd7\xdfIY\xa1\xe6\xaaT\x86\x95\x9f\xc7\xd21t\x97#\xfe$\f\x17]8\xfc\xe2K\x19\xb1)\aوt\xf7\xe0o\aM\x9d{E\xbbaݫ\x05\x9e\xa3.\xad\x89\x1f\xa8\x1c\x1aj\x06\x04\x11\xa4ls ;\b\xf3\xf5g\xb9\x16S_\x98\xc7\xe2\xcd\xd9\v\x8c\"''\xcfd\u007f\xe7\xf4$䩞ॱ+\xe6uu\xbd\x9d\xe7\xfeT\xc0x\x9e\xd8x\xf6\f\x96s\x95Ccj\xc5Enc\xd4Ԟ \x04\"\x98^[\xaeu\x0e\x16\xe6<\x17\xcdz[d\xea\x0f\x95qHF\xe6\xb0\v\t\v\x00\xff,wcY\xcfjl\x81,\xea\xa4\xec\xe9X1\xec\xc3}\"X\xc5\x1a!Cb\xe1N\t\x1fצI\x9cûypct\x8e0r\xc9\xf5\x99\xc7?+Rs;\f\xfch\xb80\x16\x86}=\xcd\x05\"\xa7\xc0y\xc2\x1a%\x17\xf6aH\xa5F\x9f\xd8\x1a\xac\r\x80\u007f\x98\x8f\x83Щ\x80t\xe5\x85\xd4\xcby\x10\xe9>\xafv@h\x81A\xc8\xf15\x13\u007fOs\xf0\xb3\x99\xc6y\rc\xd8\x15\xa0\xe7`@\xc6 \xf0\x03`Zn\xe8\x90\xcbo[\u0560M$p\xae\xb2\xda'0\xde}{B!H\xc2\v\x979\xe4\tR \"\x9cj\x98z\xb20\x91E9\xc5i3\x11\xa9(\n\xcd\xc7R\x9c\xb5\xf4\xad\x87E\xb9\x06kL\xad!\xa78\x9a\x90\xd2\xcc\xe1\x9e \x05\xadq\xae\x11-[\xeck\xf7*\x9c\xe8-\xe5\x1f&Qɍ<\xe3qAO\xc6oe\x1e\x9b\x8bs\xde\x17=T9O\x04\a\xe5\xcc\xf5\xe2\xc2\x1c\xbe\xd6V\x94\x9f4'\x8b\x17\xeaA8\xb0\xa4\x94\x8d\xa6\f\xbe\xcc.\xd7s\x9cw`\x96\xb9\x9b\xc1Ͳф\rH\xa0l\xa3eO\x88\x93\x1d\xf7xE\x97Jw\xc7\x1aUĸg3\xb9bs\x9e\x86\xe7\x1a\xc0\x98J\xabg\x1c\xce\x06n\x8fx(\xae\xbc1y5\xa5t\xcey\xb6\xebj\xaa \xdeB\xc8 IU\x19\x99W\x9e\xf2<\x94Q\xe4\x8d\t<*Z\t\xfep\xe6]\x14\xc5(&n\x1f\xa1\x1c]\xe7\x18V\t\xe5\x9f`4\x99\xab\xd1\xc7\x04/#i\xcc\xfe\xa95AJ\x99\xd6\xda4\xb9\x8b{M\x83\xe8\x1f\x02\x13\xb5\xc8\xce\x19J\xf5<\xabL\x0f\xf7\xdc\x1e\xe2\xd9?#\xb6\x16B\xe3\x90\xe0e\x91\xc5}A8\xfd\xd7b\xbcf>F1f\xb0\x04\xa1mڱ\x83\xa0\v\t\x93\x9d\xc9h\xb0\tĴ\x87\x9c\x04\xec\x19'\x91\xd6_hY]\x9fG\xfe\x8e\xbc\x05\xb3$\xb1@\xfe\x02\xcd%\x8e\xae\x14B\xc6\f\xca\xda\x1b\x923\xe6Ɵ\a\xf5\x10\x8c\xfcKVD\xe4T*\xb8\u007f\xeb\x97\xdfL\xd0Y#,$\x86\x8ei`-X.]\x8f\b\x95\xc5\v\xec\xf4\xb0(\xd7\xfb\x10D\xd8l\xca|\xc3Js\x9e`\xddC\x80\x9dMI+||\x17\xb6\xac\xe4}L\xc1\x99Ί)a\x9aD\xf5\xdc\xf0\x89c\x9b\xd2(\xdb\x12\x91\xe6\xf0\xad\x14~e\x8f\u007f\xa6\xc7{qx\"p\x9f1\xbe'\xfb\xbf\x8b\xfd\xe1\xaa\xc6w\xa2a\xee\ts\x12\x84 \x1ce\x9b\xf3I==,ʹ\x9frS\xa2\xdcl\xb2O\xe1\xc9\xf6ʌ8\xaf8n\x94\x13R\xcc8]\xc766O=]\xd7\xf2\x98\x16x\u007f9\x05\xb7\xabx\xbb\xbe\xa6\x99\x94h\x9b\x06m\xd3t\x18V.Yԗ\x03>\xab\a\x84\x15;h\xa7\f\xe3\x8c\x123\x8e\xdd\xff\x1a\xac\xf5\xd1\xcf\f\x91Vitl;5f\x99\xc5\x05\xec<\xac\xec\x15Z\x94\x9b\xde闚\b\x8ab>4zWc\xc2\xfbY<\x95\x89Ǝ&\x9e\xa0\xb3\xbd\xb59\xc0\xf6\x14@8\xe1\xddL2Z\x97\xf0 \xac\xb6\xb8\x10(|\xa0}be\x84\xfb\xbe\xec\xb0v\xa6\xbe\xfb*ɉ\x98zȜC\xc3\xd5h\xef0,\xc2\xf9Yc\x8e\xf6\x16O8\x90\xed\xb3\xb3m&\x83\x1cZMO\x0f\x8bGO\x1a\"\x94ey\xca\xe0Dcھ\xdd\x1d\xe5pdZ\x01\xbf\x1e\x90s\xbd' \xad4z\xe1\x16\xf2\x171\x02c\ro36\xe4e\"\x1d\ued1d\x84\x10\xd8l6\x10E\xf1`\xa1V\xae\xe7\xbdX\x1d\xf5\f\x91\x98\xaf\xdbp\x99%\x1a\x90n\x9e\xa4\xd5\xc6\xc6\xc1V\x9a\xed\x9e\xf1ab\xca\x00m\xaf\xae\x96\x87V3\x94,(J\x96\x1b\xf1\xb62\x15M\xe7.\xa2Klk\x9a\x18^\xcf\xdaL~\x19\xd4\x19H\xc1=#\x15\xe3V\xad \xfe\xb8\x96T\xf2j\x19AJ\xe4\xd8\x17<\xab_\xf90hvs\x81\x8e\xe6Q\xe7%s\xdc\x04\t\x10t\x1d!2\rV\x9a\xd6`\xdcu=\xb6\x02WWWy\xe1\x82\xcb\f[\x88\x1d\x8cu\x98&>)0.\x02\xc3W\f\x83hE\x83\x12+\xc9\tM\xef\xa2\xcd\xeee+\xd7\xe6d\xf1\x19yN\xe7\xa0\x1b\x84Ȭ4\x16B\xba\xb8S\xa4\x1a\x84fz#ck\xc47Zn\x97\xf6%Fi\x96\xa7J\xe9\xa6\xf6\xfe\xf3g\xb0\xdc\x01@Z\x0f\x8bsn\x86\x84@Y\x16=5\xb0\xe4ܭ\xe1Ud\xc6\xc3\xfeF\x1e\xc8\x02\x8f\x10>?\x89\xe2\xdf\x15\xc77\xc73\xe6\x157>_\x92\x94\xb9T\xa7?\x11\f\xd8l3{kͭ\xdd\xcc\xc1\xa1\xe6v\x82摠e`0\xa6$.ȩ\r\x9c\x92\xb4\xf1\xa5ˉF\xf1}\xb7w\xa9R*\xe7\xa0m\x01\xfc\xd1zX\n\xc01g!w\xa2z8\xf5\x16\x8b2\xd9iZKU\xa2\xb8\x11\xca\x1a\xb7L\xeaB\xb6\x81]\x01x_;,\f\xfd\xbc\xabe\x9d\xe3]\xb9\x8b\xcd~\xef\x8a\xcdy\x15cu\xa9\xd7l\xe0=f\xe82\x18\xe7=\xacy\xe6\x9cr&\xf0\xcf3\xe6V'\xbf(\x9f\xb0M3\xd7&\xcd'\xa6ju\xe2Qo\xfe\x0f\x00\xfe\xee\xe5\xab\xd7,\x004\x00\xfe2\v s\x84\xe6Y\xa9\xb4+G\xe4H%O\x95^\xe5\xc9\xc3\xc6)\x16pn\xe6b\xa5\xb0\xef\x12\xc6*hp\xe6x#\x81\xb1\xea6\xd3\x02\xf2\xe6C{\xad=\xfaD\xa0\x132] \xfc\x9f\xf4\xfeH\xef\xc1\xdcω\x1e֗\xa4\x8baZ\xed\xab\xb5'$\x8a\xb1\xf9\xf8K\x00{\x1b\x12J\x00\xff2ג\xf6\\h%Gݾ\xdc\xe2ȹ\x86\xa3ò\xec\xc9:\xd3\x00\xf2Xgݘ\xfb\x9d\x13\xbe\xadɢ\x1e\xa8\xb4\xce\xdb|\xe4\xf4i\x1c\x1c#s:\xca<\xf0kl\fBF*V\x011G\x1b~\xcafM\x1d\x94\x1cYC\x9cx\xa6\x18\xceIN\x98wI\xc8bRe\x86bl6\x9b\xb1{\xfch\"\xc1.$\xfc\u007fr\xa6ō5s\xb1\x89\xc9c\xe5b\x00kR\x0e\xfc\xebM,\xfc\xa5\t\x0f\x18k\n\xc0k,\x1c\xef9h\r\xef\xca\tK(\xe7\xd4~\xa4\xde\xd5\xd4P\x90\x03\xa1\xdd<\x0f?l\xb4f5\x15\x19\xf1\xb4B\x90\xc9\xe9\xfe\xfb9o\xebŬ5\x1d\xc1\xb5\xe6-\x92)\x9d\xe1\x99\x19\x8a\x15DQ\xa0(\x8aTyN\xf7\v\v\xba\xff\xa5\x01\xb6\x927;\xe0\xc8\xe4\xf0X\xe6\xb8J\v\x16N\xaey\xcfj\x8aq\x8e\xf0aE\x15SZ9\x14\x8c\xb5A\xfb\\\xbc\xac\x94\xf1w\x81\xf5%\xf3;\x87TN+-o#P\x1c\xbe\xa7\x19\x99\xd7XX\x1d\xba\xf99Dz\"\x01!\n\x80\x15\xca\xdd6u\xd8t\x97\x16/_\xbd\x064\x02\x9fıʩ\xa4>\x9a)\x9e\xc2kX\x85\xf9\xb1\xf5d\xa36yQ%¼\t\xe3z67\xdf\x13\x98\x9b\xea-=\x06\xecjI\x98Mk4\x01^\x18\x16\xce\u007f\xbepӉ\x93|\xf1\xb8Q\xbal\xd7\"\ue8b6\xb2(\xb3>\xd7\xceN\x03\xe0ߥ\x86a\xb3\xdd\xe8\xccQD\xddp\x953i\xa4Xr\xaa\xa1\x99\xfaN5\xf5\xb3fnN\u0084\x96K^\xd7\xea\xa5\v\xca\xea\xf1'C\xfa\x99\xfa\xe5\x97\x16\x01<\x9b\xfdx\xa4Fk\x1c\x96\xe8#I\xe41\xdf\xcfi\x94\xe68\x05\x04\x02+\x05Q\x96(\xca\"\xe5!\xd4\xf0\x88\xa3\n\xc0\x87\xf8\xcd0vW\xd7\xd3N\x04\x9e\x15\xcd'\xfb\xabM\xd2\xe2Z\x8a\x0f1\x1b1\xc1e\x8b0\x85\x03\x05\x9f\xccKs\xb3K\xc2[a\x915U\xa5\x17\x88\xe7\x11Q\xac{pn\xf1\xed#1VS\xb8u1̋\xc8\xc9:_\xf8\x99\xa2\xf7\x94=\xfff\xe6\xc8\xc9\xd0\xf3\x9c\xe3{\xe5h$bW\x14+\x10\b\xe5f\x9b\xbaſ\x85C\x1c\xb5OӤ.~\xf5\xf4\xc9\xcc\xd4\xff\x84R\x9d\xf4\x0f\xb1\xa4\xe4<\xf7DYe\xd3\xf9\x9eh\xc6=P,D\xc1\xbaYƺ\xae\xf3\xc6&\x00\xc8\xe7\x18\xac\xc7`\xb8\xd6\xf0\x1e\xb2\x9a\x9e\xae\xdaY)\x91Hɸ.\xb9^֒>\x03\x97\x9c''t\x15E\x11#\x90*\x00\xff\x87\xb5O\xee_\xb4\xa9y\xd9\xedv\xe3\xa5\t\x99\x91\xdf4d\x92\x93\xb1zj!\xf5\x00\xc7\vm\xa2\x18\xc09\xe62_j\x8b\xb7M\xa3\xf9s\x13\xb8ic\xf7\xe6gw\x1e\x83\xe1:\x1bc>\xc70\xcd\xcanO\xdf\x05\xc9\x03\xd3\xed'\n>?\x1dk\xe6x[CŬb\xd2Uo\x00\xfc\xfd\xcbW\xaf\x95\x1b\x12\xb6\x00\xfe*~/Z\xd4A\b\x01\xe5z!9uU\x99)O\xee\x9d\xe6\xec\xf8g\x1495(z\x98\xf8MVi\xeadө3\xd0\xe4\xb00V\xe1?w\xe1\xad\xf8RJA\xb6\xd2\xe0\x1b\xd3\xf4\xe1sC,\xd7`=\xa4\xaa\x05y\x9c\xc1E\xde\xf4\x05\x9f\xe3Ծ}\b+\xe4\xd1L\xec\x8e\xe1\xc1O\xcef\x84\x18im\xaf\xe4\xbaf\x90 \b\x12\xb1\xa4^\r\xa0\xb2\xff\xb0\x06\xab\x01\xf0\x17\xc6\xfd\x12\xb1\xbb\xb2`\xe4\xd4Z\xa8\x9cl[\xe7\x1eҩ\xf5\x14шV\x01%\x06\xda\x18\x1e\x1bNu\xe5&\x99.\xbe[\x89\xbe\x8a\n\xe7C\xbb\xe4Dh\xeaZ\xb7\x04\xcf\xecP\x92\xfb\xdc>\xee\xe2\x1b\x8b\x872\\\xb1\xa6\x16\x97\x1a\xef\xc9\aO\xe0p\xe4\x89\a]g\xde|\x11?\xdb_!R\xa8|Q\x8f\xab;\xd4O\xf3RnJ\x88\xdc,\xa1q\xb7\xfe\x1e\xc0m\xc8\x12ډ\x16B\x80\x1df-M2V\x91\x98\x90\\\xad,\xee\x87Us\xe9\xb3ހM)\x02%!N\u007f?\xf6\xbe\x00PMk\x81\xb5\xab\xf7|\x04\x8e\xfb\x03\xa4\x92\xb9\xd5\U00053f02\xc7\xfa\x8a\x95\xe5\xa4\xd6\x04\xad\xdfT`\xf6\xb4OBP\x12*)\x14\xc3\xddf\"9\xbd/\nH@e\xae\xc9\xce9\x11\x02\xa2,F\xdd\x12כ:\"T\x04\xcd\xdcS\x13\x14\xf6\x86\x84\xc8H\xff\xe6\r\xb5\xadS<Y\xdd@GFZ\xb0X\x02\x8d\x1ez 1t*[\xb8 \xf7\xd8\u009d\x00l>\xa8o\xe54v\xa8\xab\xa3nM\xaf.ۏ\xef1\x82\xf0\xd1\xec\xe8\xb9ync\xf7\x99#\x11>\xbb\a&M2Jg\x8f\"Hkb\t\x12\x00k.V\xe0u놄\xaeő\x88\x00\xefLN\xab !:\x99\xdf\x01\xaa?\xa67\xe3|1\x18R\xb6\xba\xc0\xb6\xd7\xd2\t\x1dƲ\xca\"\xc8i3\xe5H\xe8\x90\xedP2\x91\xe9Nk.\xf2\x157\xb8\xbdR]\xd7h\x1b=\xde$\xd6KB\xf0\x04\xda\xc3c3Z!\xcf\xebb\xf7\x1b\xf0Dȑn\x1a[\xd3\xc1C1#Ir\x16\x89\xf7ٵ\xa7&r+4\a\xcbF7\xde\xeb_\x01\xb8\t\x19\xac\xf8K1\xaa\xe3\xd1P\xe9\xf5\x86\x16E1\xf0\xb0\x06\xf5X\xa4\xe5#BkE\xb6\xed\xa9l\x10\xa1>tS\xfa^\xe7/\x8a\xc9\vz\r\x92\xdd#H)\xb7u\xa3\xc3\xc1\x90B\xeb\x85l\xc9cQpx(\fq\xec\xe7\xdc\xddc\xde!4Ƀ\xa73\xae\xc5\xd9\xf3ڵ\u007f\xd6\xd1M\xd8`\xfd\x05\x80\xbb\x98\xc1\x8a>\xcd\xed\xcd\r\xd8P\xe9\xadw5\x162\x15B\x1b8\xe1\x86[\xe6KI\t!2\xed<\x9du\x17\xf5\x8dS\xe8\x04:c\xab\xfascW\x9d\xc1j\x1a\xb0\xe9NB$\xb2>g\xb4\xb6nf\x03\x87ǆm=\x1a\xbc\u0379/\x1e9p\xa7zI\xbc\xde\xc0\x05q0\x9a\x88\u007f\xf9\xeb\x88Hk\xfa\a\xd6\xd4\xdeD\u007f\x00\x86\xad\xea\xa3\xcfu\xdc\xef\x016\x06K\x88\xbc\xbaBc\x00\xd8v\xf2p\xc3?>\xc5\xec\xe7\xd2\xf1\xa1\x11\f\xa3\xdb<J\x9d\xf4\xc4c\x8c\xefT\xab\xfa\x98Q\xbbP\x86,G?\xa9ij\xcd\xc7a\x1a\xd2\x0e(~\xdds\xdc\xfdCS\x1er\xb0\xad\x8bzY\x81\xdfu\x87\x85C\xb1\x99;7'\xb1\xc0\x89\x87\xccl\xac,\xf3o\xcc\xf5\x95\xb2\xa1\xa1АS\x9f\xd7\xd7\xfbGvє2\xf5g\xd6s*&\xd4[\xf5\f\a\x11dӚ\xb8u\xa5Nʉ\x8d\xc1#\x1e\x82\xad\xad\xab\x8fGH)\xa3\xa7G*\xc1@+\x93\b\x97\x1a+\xff\xe7\xcc\fٴ`\xa5\xab\xf9\x95\x92f\xdd.ߜt\xae\xb9y\xa0\xb0\xf4\xc1d\xb3]y\x1f\xbf/a\xa4aGH\xb4\x92\x13]\xa1)\xd7c\x9a\xcc\xd8\xf7\x14/&\xd9pѣ/mF\xfa\x9e\x8a\xb1\x01\xe4\xc0\xc9X\x14ep\x03\x8f\xaac\x12\x81\xa5\x82\x94\xb2\xa7\x13\xb5\x18s\xe0\xb8˜\xdc0\xce)\xc6l\xdae\x87x*D\xbd\x14\xec$\xa34e\xe1OUF\x98p\xd8H\xd3]\x97\x15\xeb\xc3\a*C\x1d\x88\x1e܀<\xd4g]\x9c\xb3\xe5j\x92%\xbc\xf9\x9e~\xbc5PD\x03\xd3\x11Z\xff\x1c2Pg=63B`\x00E!\xbaބ\xc50Sػ\xc9r\xec6ȝ@\xa3\x11Me1ٛ \xe3۵m\xab]SrN\x93\xa5\xa7\xde\xc4\x06=\xec\x9df\x05\tm\x90L\xca_\x13\xfb\xc32\xcb>C\x82\xa6\x14\x82\xa7\xc4\x053\x97\xc3\xf4\x13\x8cж-\x94:y4Rʮ\x89e\xec\xfe\xd9SY='62\xa7y까\x95Z \v\xbd\xd4S\xb5\xfa\xee]\xf1u\x80\xe8\x1cӔw\xffM!\x81\xf6\x15\r0\xad5\xff|\xe2,\xe9\xf2\x1c\x18\\;\xbe\xc4\xcaI\xee\xbeM\xff\n\x87I\xee\xdf@bѱaYw\xb2\xad~\x81\xe9\x92V[\x11\xc37v5\x8b[\xb1!T\xa6\x1ak\xa0+z\xe0\xee\x1c\x1bK\x97\xaf\xb9h\xe6\xf6\xf2m\x9a\x06J\xca\xee\xddM\xdd\xe8\xff2\xd8]H}u\x809\x05\f.\x9f\xc1\xa8\x9c\x03\x16\x98\x12\xa2.id1w\xd3R \xb4\x8b\xc9}\x93\x1f!\xf8\xef\xf5\xefy\x8557yM\xe6r'\xbd\x04CQ\x16\xa1\xdbM\x82\xeeQp\xac\xeb\x82C\x11\x90y\xc4Sb֧W\x0f\xc4NxtS=\xadn\xb1g\x94\x1e\xb8\xf4\x89.\xb4%\x02\xb3҉\x81\x18^\xe5\x16\x04\xaa)\b\xe0rg{I\xe3\xf1\xa6\xaa\xcdf4\xff\xae\xebS(\x9c\xe9\xb5e\x956=2ok\x8a\xb1\xb2\x9eUTn\xe6\\\xc6k\x10Ν\xe8\r\xbdό5\fv\xeb]C\xc6*\xa3\xf2\x82VX\x9f\x8b.hJŊ\xa2\x00\xf7\xcb\xc6*\x00\u007fm\v\x9f\xd3\x06\xcb[\xccEYjNU*\fL\x84\x17\xec\xf4t[\xb4\x18\x93Fq\x1a\xe1Ԗ\xe2h\xe6\xb7\xf1\x9e\x92\xdcWrj\xbbR\x9e\xd8\x19\u0085\x05\x9b\xb6m\x9a\xde\x15d\xdbBI\x89\xa2(\xb5\x91fzti\xfe\x94\xe1\x1a3jsUR\x93`\xaf\xa1\xf1\xc4\xfe\x96\x17\x889\xc6\xc2C\xbd6U\xf6\xfa\x184\xd6K\x84\x93s\fj\xf0\xd7̙\xa5\xf4\xe1kS\x97\x15\xe4\xd0\xc1\xf0W\x00~\xea\xcdC\xd6`2c\xb3\xdbA\x14\x05T\xc8\x15\x1d3&]\xb7I\xa7\x94s\ue255S\xdf\x178\xb5\x92\xc0_/)\xa3\x92XY\xf70#Y._\xb9`nVl\x89\xb1\xb2\x98\x8c\x94m\x97:\x064\bߴ\xad\x0en\xd5\xf4\xa6\r\x976\\\xbe\xa7A\x810n\xa9\xa4Mn\xf6\xd0&\x82\x84\xcb1\x1c\xe3$&\x0e\xc0Xz\x88`Ȕ\xa9\x1a=\xa7\xb0?9G9c\xb2\xaa\xb6W\xfe\xda&Ҟ\x15AG8\xde8\xbe\x01p\x0f\x00?\xfe\xf0}^Hh\x1f\xe6\xeb\xe7\xcfӛ'!\xe1q:\x95\x1c\xfb\x95\n\xeb2\x16N\xee{\xc6B\x1e\xf6\x8a\xac9ѵgЫ$\x02\\'\xef'#\xe4\xe1\x15\f\x95\xfd\f%\x15T+\aF\xac9\x1e\xb1\xddn\x97c\x87\x17\xf6\xb8\xceq\xdd1\xa0=G.G\x83Ɯ\rڧ{-q\xfc\xba#\xebf\xd4H\xad\x8e\x11\x9aO^\xa89'\x84\x80R\xec\xb3\x0fn\xa1\xe5e`zO\xf4\f\xd6&h\xc0\xcci\xf2\xec\xf9\xd7\x03w؏\x9b}\xed(ݻЩ\x11t\xfeV)\x85b\xc4\x18E\xc3>\xe7\xe4!\xd3+d\xe0\x91d4\x19ef\xe3Қ\xe2k5rBS$\xe1\xe7\xfd\x90s\xfb &X\xcckm϶m \xbbMt2\x85uU\xe1\xe9\xcc\r\xfe\x10\xe1c\xae\atN\x9c+'\f\xd5\x1bOe\x18\xab\xb1f\xeen\xab.-:0\xd6s\xa0'kM\x17\x84+h\x9d\v\x05nY\"D\x1c\xfd\xf1\x87\xef\t\xc0\xb7\x00\xbe\x19Xcf쮮\xb0\xdd\xed:\x19\x18W~ŗ\xf3u\xcd=3\x9f\x8a\x9d\xada\xe0\x93![E\xe6\x84\xfc\xe2U\xea5Ȥ\x11O\xd0.\bfmp\xc7\xc2\n\x124\buO\xd7\xc8{\x9e\xee~\xcfh\xacl߷\xc3\xfd=d\xdb\xc2UVbf\x939T\xabn\xfe\x87\xf6\xc0xn\xa9P\xe6{s\xaf/R$\xe3.\xfc\xca\xf7\xc1\xac\xe8\xc0$\x89\xedK\x8d\xfb\xdc\x1e\xf7z3\x9d\xf6 \xf3Xo\xc2\x1e\x86U\x02\xf8\xa7\x00\xb6!\x0f\xeb\xd9\xf3\xe7'B\x17\xe5{B\x83\x05eo\x86O\x8c볘|\x17Л\xba\b\x13\x03\xe6\xb7Ob\xaf\x0f\xdc\x18\xb6\x16\xec>ܻ\xf6\xba5_u]\xe3\xb8?t\x922\xca\x1c\x12\xcc\fٶh\xdafVg\xe0K\xb3\xc0\xa7\x12>s\x8d\x97l[\xddA(\xc3H\x8f\x01\xf3\xfe܊\x18\xb1\xda\x17\xd6Kk}\xf7\xfcb\x8b\x95\xad\"\x053gK\x85>\x93'\xe0d\xdexn\xb6\x86\x80\xde\xe1\xe2\x83\x1aׁ\xb2x鄃\xffY\xe8\xc2EQ\xe0\x9b\xef~sj\x98\x98E\x94T\xc3V\u06dd\xcc\xcb\tURR\xa1(\xa6\xbb\xaf\xb1\xb0\x84ru\xca\xdd0\xb6\xbb\x96\x95D\xd6\xe1\xaa0\x03\x99U:?\xd6g\xc3\xf5\xbe\xac\xf4\xb2\xe3\xf1\xb9\xe5\x18k\x19-6'\xd6\xd5\xf55\x0e\xfb\xbd.~v\xc2\x16\x02\xa1\xadjГ'\xe3\xf7\xfc\x89bWc\xefm\xea\x1a\f\xddss\x94ܛ\x89?\xfasM^q\xfdtcq\x12<\xb6@\xbc\x1a\xa1(\xf0\n\xc6)\xf7\xe7K^\xa2(Qn6\x1ac5I9ol\a\xf6X8\xbfx:\xb4;\nϿ\xfd\x16\xd7O\x9f\xf6\xbc\x84\xb1W(ҳ\xae\xb7\xb2\xb23\xe6gr&\xb3xI\xf3G\xf2\x9e\xf1\x94y\xe2x\xf9Mb\xe6\xc6ʌX)Ӗ[\x83\xa7\xcaz\x01\xf6\xe7\x9eg@3\x16T,4\xf9\xfa\x9bo\xf0\xf4\xabg\x1dp\xab\xb3\x86R{_\x87\x831^\xd3\xc7\xfe\x12\xa1\xe3Y\r\xa6\xa1{H)'\xa9rLѶ\x17ST83~\x9d*\xad\x99\xd2\x17p\xb1h_\x8f\xf4\x8dE\xfd9\xcb\xf2T\xe6'\xa5\xf4\xa3\"\x8a\x85\x84\xc1\x89)\xcb\x02\xbf\xf9\xddo;\xf76灂1\xa8\xf1*\xdc߱\r\xa9\xccϲ%`\xd7p\x82\x83bm\x1eI/\xd4h\xa3Þ\xc2Z]AR_ -?\x86\xc1\xf8\x9b\xa2g\x943\x8dsg\xfc\n\x81\x17\xdf}\x87\xedn\xd7=\x17+\x05ٶ8\x1e\x0e\x9d\x97\xf1\xb9`Yc\a\x9b;Wm\xd3@Z\xcfs\x92\x8c1\x8fn\xf6\x1e\u038b\x19\x96$\x89vР(\x9a\x12\xa1ۨQJ\x15@'\xa8\x0e\xab`\xed$\xba\x8b)\xa5\xfc\xe1\xda\xc3k?\xe8zX\x1b\u007f\xb3=}\xfe\x1cW&d\xc8\xf1bxd\x92\x95T=\xf7\x8b\x1c\xcf+\xf7T\xec\xc6l\x85\xd3\xd7N\xbc\xea\x85hn\xf8\x1a\x9e\\\xad\xc0ʎO\xe6<\x87\xd7bipr\x8f0\x8f\xbb\x9aM^\xaebj\xbd\xc5\xcdf\x83'Ϟu\x89\x01\xfbUWG\xdc}\xbcMz\xb9\xfe\xbcO\xa1p<\xb4w\x15\x83\r\x88\bRJ4M\x83\xba\xae'w\xfcVj\xc8\xd5\xe3\xcbZ\xe1i?\x1f3N\v\xd6\xd7\xe4P\xd7\xfb\xdc~\xf3\x92\x81\xc3\xf3w\xf0\xfaL\b\xe7\xfb\v\xdf\vx\xfe\xe2\x1b\x14e\t\x84\x80ِ\xa1q(\a\xa1\x93\xadmj(\x96\xbdٵ<\x13\x9e\xbc\x90i\xb5\xc9gg\x01\x06\x8da\xa0\x10\x9836W\xb2\xb21\xb5`\x88\xbaT1ENs\xcaP\x88p\xefUgy\xfb\xf7\xd6T5\xeeo\xef\xd0\xdaR\x9d\fO\xe5sy))\xd16\r\xea\xc3\xc1dQ'\xbe\xdf\xf7\x82qa\xed\xfe\x98\xe1\xc9\xf4\x8e.vO\x89\xcf\xd7U\x18u\x97|\x13B\xa0mzs\xf1\xff\xbe|\xf5\xfa>d\xb0v\x00\xfe#w1ﮮ\xf0\xf4\xeb\xafL\b\xb4\xac\xa0\xd2\xf2\xb5\xaa\xe3\x11m\xd5\f\xb62\xc7ꤒ\xd6=\x1fK\xc891eӤ\t\xf8=Cy\n\vC'm\x1c\aK\xf7q\xa4\x80a\xe0H\x11\xec\xf4\xf2\x13\xe5$t\xb8\xc3Ԏ\x87=\xaa\xe3q\xa0\xa3\xf4\x18C\xb9)x\xe5X\xc6VI\x89\xb6mQ\xd75\x0eww\xb3\xcayxF\xb1\xfd4\xd7\xe53~\x19\x15\x11)\x15:\x126\xb4\x9a\x8b\x99\xbb[\x00\xbf\f0,Cy\u007f\x01\xe0\xcf\xdd\xc9\xd8]_c\xb3\xd9f\xc7\xe1c\x13\xae\xc1^\x85\xfda\xaf\xad\xa8O\xb6\x9c\x00\xbe\xa70\x9e9 \xbcl\x1a\xec\xef\xee\xa1L\xc8\xc9#\xd6׆\xb2\xa7p\xd0Yg\xbc\xac\xec\xa8'9뷻\x9fa\xd8;0\xe0~ߍq\x97\x00\x91\x12\xd5a\x8f\xe3ᐤs<\x16\x03\x963\xe79s\xdf͟y\xe6ۛ\x1bT\x87\xc3\xe4{\x92J\x8d\xaa\xbd.2`\x9d\xdbF\xeb\x1bŵ\xe6Ůˉ\a\x1e\x01\xa8\x8f\x15\xc0JK\xca\x10 [\xe9ځ\x06\xc0`R\x84y\xef\u007f\x02\xe0\xba\xf7\vG\x11\x94F6W\xd6\xe4\xb6\x12`\x86l%\xeeoo\xb5&\x93\xd3ӈ\x1d\xe9\xe4)Fk\x8d0\xa5m\xa5I\xfd\xd7i{\xd3\xeb\xec\x834\aeb\xd7i\xf2ɷ\xa1\xa2\xdfܞ\x87.\x1f\x88\b\xc7\xfb{\xec\xef\xeez\xe3l\x8d\xadT\xca\xe8e\xa9Ռ\xc9c\u007f\xb19@\xed3+)\xf1\xe1\xed\xdby\xa1\xe1\x88\xd1\xe2E\x86*<\xa7\x8f\xb9\x0fd\xf6\x1c\x98\xb6s'\x95\x15@\xca\xd6\u007f\xech\x96\xf0k\u007fF{\xf6(\"f7e\x00\xa5\x94PR\x82\f\xcbz\u007f\u007f\x0f?\x19\xabÔ\xf9\x9e֬\x81\xb3$ʪ\xc2q\u007f\xd0\xf2\x16\x9c\x0fB3\xbc\xd0 \x90n\xce\x0e\x81\xd6\xf6H\x84@Ӷx\xf3\xf3/\x1a\xa7rǌ\xadA+P\x88B\xf7J۫\x00\x00\x1bcIDAT{\v\xfck\x88E\x9c\x03\xd2>3\x11\xaa\xc3\x01\xef\u07fc\x99l\xb8\x93$\xd55\xc6s\xf6%\xd6?Dh\x84\xfc<=\x1c\x94\x83}\xe4\x8ce\x90\xa9a=\xac\xfft8J\x14=\xe9眾\xacd\xaf\xed{}\xac|\x80\xad\x97v_\xc3hem@\xc3\xc7QJ\xa1:\x9a4\u007f\xea\x1e|r\x1b\xdb\f\xa8L\xa8<D:\x8cx-\xbb\x91\xf0\xae\xbaPf\xc2\x1af\xa5\xf0\xee\x97?b\u007f{\x1b\xdc\\\xcc\fQ\b\x14e\tV*\xfd\f\x9f\x99\x97E\xben:\x11\xee>~\xc4\xc7w\xef&\x8fA\xcc\xcbzX\xf3ϫ\x19\xa7\xa0\x91\xf2\xfb1Lq\x16\r\f\xd3A\x1c\xca$\x96H\xb8c\x1f\xbc\xa40_\xff\xd5\x12;\x1dL\x1f{?g\xf66\f\xeb\x02\xdc؉u\xce\xd3\u07bd/e\f\x16A+q\x1e\x0fG(\xa9\xa6%\x00<\"h\x1f\xf0\n\v\x15\x0eJ4r\xceˉF\xe1\xf6Ç\xde\x06\f\x8bӡk\xb7f\xbd\xe0_\x8b\xa7EnՁ9,o\u07bf\xc7\xed\x87\x0f\xf3<6\u007f\x8e\x97\xe0\x99\xbe\xcdq\xe6p\xf6\xfcd\x92^\xc9o\x80\x11\xa2\xead\x14bS\xe0\xdf'ƀ\x95<\"\ai\x19O\xa5\th\xfe՟\xae\xee2\xf6\xbc\x10G\x11\xc1\xb2\xdd\x01TUe\x1a\"čV\xaav\xabo|\xfa\xdfs'U\x9aL\x91\xd6\x15bT\xfb\xbdn\x895\xa6u\x1508\x96M\xce]3\v\x1e.\xbaP\xc7ߕC\t\"BS\u05f8y\xf7^\xab\x8b\x0e 7\xf6\x9dZ\b:\x95\x95\xa8\x05\xd5\a\x9f\x84\xa1\x82.9\x13Btc\xd2)\x8e*\x85\x9b\xb7o\xb1\xbf\xbb\x9b\xf4<n\xa6\x9b}{\xb3\xf4\x00\xa0\xd9\v\xa17/R\xca\xf1\xb95\a\xaf\xadȰ_\xdd\xcf\xcc\xfa\xee]ǣѸ\xe3\xc0\u07bak\x9b\x06\xd5\xfe\x80\xba\xaa:¶(\xb49㡼\xcc\xe0U\x1a\x83u\x953f\x9c\xb1XC\u007fץ\xf9\xb9\xbfqe\xd3\xe0x8\xe0\xc9W_E\x17\xc0IX\x9f\x82\xee\xe7\xa9\x16\x90\xa79\xc3\xc6\x18\x1e\xf7{݅\x1a\f\x01\x82l[\x1c\xee\xf7\xc1{r\x9fU\b\x82\x92'<Ȝ\x15\x9d\xd7fE\xde\xdc\xf0\x9a(\x1ef\xf3\x88\xd1\xe2\x89\v\xbf\xae*\xd4\xc7c\xf4\x9avܔ\x94\x1al\xf6\x0e\x98\xae\x9e\xf23}Y\xe1=@\xf7\x1a`\xa5@\xa6צ\x94\x12\x1f\u07bcAY\x96\xd8]_g+9\xb8c\xe6\xd6\x13Β\x97\xe64^\x96\xdd\xfc\xc4\x1cBmӠ\xad\x1bl\xaev\xfa>#I3Kw\tu\xdc\xe9\xfa\x00\xf8^_h\xdf{c\xa6\x8csp\xb8\xbfGm\x1c\x15x\xc2\x01\x94Q&6\xa28\x9a\x0f\x1e\xd3\xc0\xc7\xf1\x03}\x0e\x0e\xfca\xbfG[7\xbe>\xc60<tBIWn\xb9\xe3\x14Y\xebot\xd9Ǽ4\x064\xcd\xe2\uef87E1\b\xd5\xe1hd\x85\x13\x03G\"xM{\xabʨ#\x9c\x1aw\x88ː\xf7\xecp\xb7\xb2\xab\x93\xb3\xe5\x0f!!:)%*ӓ\xb1\xd7u\xf83\x0f\v\xbbf\xc0\xa6\x9e\xd5g\xfb7u\x8dۛ\x9bI\xe3p:\x98\xfb\x18(\xf9\xeel(\xcc\n\x95\x82u'v\xdfP\x85HܮL\x0e;^\x902\xd8d]U\xba\xd5[\x0e5&r\x8f\xe4F\x17\xa6\xb3OЛd\x0e;\bR\xa1mZ=6\xcesجuY\x96\xa3\xbe\xa5u\x01\xc4\x12/4\x8aa\xe1\xc4yQ\xac\x06\x02~\xda\xf2\xb7\xb8\xff\xf8\x11\xaaUa\xfdnDT!\x9d\x81q\xddq\x150V1\xc3UWG\xb4MmJ\x1d\xcd\"#-{S\x1d\xabQ],\x1bV\xe8/G6\xd7\x00٢,N\x86\xea\xc2/\xe5jcPz\xce\xf6ww\x03\x1e\xd2\\s\xf5)\x84\x85V\xc9\xc2n\x10\x0e\x859@W\x1c\x9d=\u007f\x0e!w\x0e\xfe\xe5~\xef\xaam\xbb\x83\x98\aXi\x17\xaay\x87\xb6\xf2\xb0bV\nMU\xa1m\xe5\xf8\xbc\x86\fb,\"\bbZ\xf1\xb1j\xdbF\xb3\xda}\x95_\xa5C\x14Q\x14~G\xf9h\x96P\x8d\xc5\xcecMI\x11s!I\x87Y\xb2\x1dj_\xe9ƥ\n\xd5\xf1\x88\xfb\xdb[\xb3`\xc2\xe2fl\x16V\x8a\xea?\xda8՛\xc8\xc3\xfd}o\xac\xadn\x94ƀ\xaa\xf1\xb0\xa2\x10(6\xa5\xf9ژ\xafR\x1b\xabB<\xaav\xec\xec\xd1G\xfc\xf0\xf1\xe3\xfb\x0fh\r\x0f\x89f\x90y?\xb9\x90\xb0(\xb01\x12\xd1v]Ȧ\x1d`\x942\xd4\\wl/䪘&\x0e\x06\x9by\x96&\\\xedaH\xae\x91\xf5\"\x107ұƯ:V8ڨ!\xa3Q\v\x8d\x88\x05r\f/\x8b$\xf8\x98\x19UU\xe1x88\xa1\xb2\xf7^3\xe4\xdb\xdd.\xe9(dh\xbaS\x16z\x9f\xfa}S7z3\x10\xbcf\x14Z\x13\x8b\nBu<bw}\x8d\xdd\xd5.z-ŌB\xa9,a\xbeԂ\xb2\xcdE\xabce\xb2\x14j\xb0\xe0XeH]=fo\xc2\xd3\x17J\xed!\xa5\x14\xeeoo\xb1y\xbbŷ\xdf}\x87º\xfc\x13\xbb\x10\xb9F\xf2\xb1g\x1aI\b\x88\xb24!\x93!\x90\xb2&\xd1n6\x9b\xd3\xc6]q:&yi\xa6%\x9e+C\xde\x19\x11\xabp\x12\xeb\xe7I\x84\xa6ip\xff\xf1\xa3Q\x9c\x95f\xddk\x92lQfl{\xfb\xecn\b\x995\xa7ò\xbb\xc3\xfd=\x8e\xfb}\xb4DW\b\x82\x94\xfap(G\xee\xad4c\"R\xc0Ԣ\xcc,\xb3\xd6\x15o\xdbHh˺\xc1\xea\xb0'Yp\x1c\xa4R(SXP\x86\x18>\x1bJ\x85\xab\x80j\xbd\x10%%\xb8(\xf0)\x17t\xb1\xe2N6\x85\xbc6\xe8ݦ\xe8yR\x1a\x94\xbd\xbd\xb9\x01\x81\xf0\xcdo\xbf\xeb\x165/< \x1ekHx\xf7\xfe\x03\xde\xfe\xf4sG\xa8\xed0\xbd\xb6\x85\x10\x02\x9b\xcdFo\x8c\xa2X\xfaaٍ[\\C\x85\xb1\xf7D\xda\xeb1\x80\xc3\xdd=>\xbc}\x8b\xb6\xa9{˸\xa9\x1b\xc8Vb{5aS\x87J\xc5B\xb8p$It\xb8\xbf\xc7a\xbf\x1f\xf0\xffN =\xa1+\xe2\xcdH\xf4\x94\xd0,\xf7\xaf\xa3.\xdeB\xc5Ǧ\xaa\r\x83\x9cGA\xbe>q\x8c\xbc\xfa\x86S\xdd^\xd34=\xb7\xbdӨ\xa2|\f\xc5J\x03w\f[\x93\xea\xb3i\\\x12\v{'FZ\x8d_\xe2ն\r\xf6{]\x1bY\xe4t\x9f&]tZԺ\x02a\xb3\xdd\xe0\xf9\xb7ߞZH\xcdh<AD]\n\xbcWv\xf4\xc0^)3\xe3\xf6\xc3\a\xfc\xfc7\u007f\x8b\xb6;D\xfb\xa2fm\xdd\x18\f\xb2\x9c\x15ֳ\x0f\xa0\xfbe5=\xaf\xc5\x1c\x95<\xccrs\b\x17\x8ct߶X\xf1\xdd\xc7\xdbA\x99\x11\xd1\t\x9a\xa9\xaa\n\xd7ϞF\x00~ʞ\xdb\x18\x14\xe4\xfa:\x8da\x01\fj\u007f\xcd\xc6\x16\x059}\x05\x06\xd8\x19\xc7\f֟\x02\xf8\x13\xff\x86\xcaM9\xbf\x13\x89Rh\x9b\x06\x87\xc3\x01\xfbۻ\xae\x02\x9bG=\x1f夆#u\x85\xcc\xe1\x89wN\x82\x1c\xb1\xbb\xaeVRy\x03\xcf:\xab\"D\x91g\x98\xc6~7sQ,y\xdd\xdf\xde\xe2\xfe\xe6\xe3\x80/fq\x0f\b\xa1]j\xef^ꪂ\x10\x02\x87\xfb\x03\x9e}\xddv\xd2\xc1\xb3\x9a\xdf\xfa\x1b\x90\xb9\xd7\xecs\xf1x0\xcf\n՛\xba\xc6\xdb_\xfe\x88\xca<+;\xf2\xc3\xee:\xac\xabJ\xd3\x1d0-\x01\xc5J\x81mɉ/{=AFiF\x1c\x8e\xe3\xfd=>~\xf80\xa8\x89\xd4\"\xbf:\xf1U\x1f\x8f\x90m\x8b\"\xe49\x86\xbc\xb7ȡKD\x90\xc6+r1/\xfb]\x99\xcc\xeb\xd5\xf55\xaa\xaa\x8246\x80Lb\xaa,\n\x88\xb2\xc4\xfe\xf6N\xd7\x10\x9a\xdf9\x89\x0f\x8a\x19\xac\xffֿ\xe9\xedn\x87\xeb\xeb'&\x14\xc8/\xe3\xd4\xc0\xa5f\x8b\x1f\xf6{\xd4\xc7cW\xabEB\xe8\x96Z\x91\x85f\xf5\x896\xdb\xed@\x01\xf2$Q\xc5\xc1\x93\x05^\xac\xddO\xddSw¸\x86\xccf\xf0\xb8m\xc1\xfd\xe3LO\xf8\xb90\x983{]\xc7\xfb{\xbc\xfb\xe5\x17mt͢\xb4\xa0ru8\xa0i\x1al\xaf\xae\xba,\x99\x1b\xee13\x8e\x87\x03H\b<\xaf\xbe9\xcd\x05\xe6\xb5\xf7\"\x93\xfa\x1e@\x00N\x88A\xce&>\xb7)gfԇ#\xaaá\xef%\xb0ת\x8a\x01Y7\xf8\xf8\xe1\x06O\x9e}\x85\xebgO\xf3U^\x9d\xfaDp\xbf'2{.\bqzg\x8dq\x1f\xdd\xdfI)\xb1\xbf\xbbGSW\x015\x13\x80Y\xe7֪\xe3\x01\xc7\xc3\x01O\x9f=\xcb;\fRϭ\x14\x8e\x87\x036\xdb\x1d\xca\xed\xa6\xdb[\xf6\xf3\xb7\xdb-x\xb3AQ\x96h\x9b\x06\xa2(Pn6(˲\x93\xe6>\xee\x0f\xba߀Y\x15M?D\xa7\x90\xc1\xfao\xfcEv\xf5\xe4\t\xca\xed\xc6y\x17G5\x99\xecBf\xa3wU\x1d4\x8b\xb5\xae\xea\x1ehkoP\x06J?\x98Y\xf3\x81\x0eGl\xaf\xaeP\x16\xe5\xc08\x9dրi'O!\xb2\x1a\x1c\xd6\xf9iJ\xc9Ht\xe8kЉv (\x98\xd5af\x1c\xf6\xf7zӗ%\x1eꕥ\xf0j\xc7\x1f@u\xd8\xe3\xcdO?\xe3p\xbf\xef\x97EI\tٴ8\x18\xb1:V\n\xdb\xebk\x88\xa2\x18\xf4\x86df\xecoo\xf1ǟ~\x82\xe2\xdf\xe1ɳ\xa7(h>\x8e#Lh\xe8o\x00K\xa6tQK&\xc29\xd12%\x15\x8e\xc6\xc3茕\xd2>V\xff\x93\xf5\xcf\x0e\xf7w\xf8\xf9o\xff\x16\xbf\xfd\xfd\xef\xf1\xf4\xabgi,\xcf\xe1\a\"\xa0y\xcf^\x92CI\xa9\x13N\xd0\xec\xeeb\xb3\x196\t\x9e\xe0]\xb5M\x83\xaa\xaa\x82\xad\xf3\x98MKyb\xcd/\xfbp\x83\xedv\xdbeI\xe7x\xaa\x00tV\xbc\xd6ԏ-m\xbb\xf1\xf1=\xfb\x8d۰\xd7\r%I\xa0(\x8b.LTJ{\xb6f\x1c\x82I\xd4\x12\x8e\x0e\x16\x00\x88\xb2\xd4\xea\x94]\x885\xec\x00\xa2\x81\xf4Vg\xfeXs\x92dۢit6\xd0\x16\x10\xfb\x9b\xaa\xdcl \x84@c\x00a\xf6ڎW\xc7#ʻ{\\?{\x8a\xc2\xe5/\x99\xcfk\xea\x1amm\xb8\x1c \x14\xa5\xb6\xd8EQ\x82\n\x01a\xad\x98\x87\x97h\xcfU\xa2\xa9\x1b4U\x05f\x85\xcdv\x87\xb6nNT\t/\x1b\xb3\xbf\xbd\xc3ͻw\xf8\xcd\xef~w\xce#\xdf\ti=\xa2\xae\xe1\xa8)\xa5\xd3\xdaZ\xe8\x8c!D\x01Q\n\xfd\xdd\xd6e\x19\x06\xf1\xcd\xdbw\xb8\xbf퇂\xb6\xe9Du<\x18v1\xb4,\xb01\"W\xd7\xd7A\xde\xcdͻw\xa8\x0e{|\xf5\xe2\x05\xbez\xf1\x02O\x9f=CY\x96\xc12*_\x12\xc7\xfd\x99e\xfc\xbb\xeb\x81\xfc\x90\xc3\xf9o\x95\b\x19\xfd\x9f\x0f\xaa+\x12\xe173\xa3\xa9+\x93\xad\xea{\x8bJ1\x88\x94\xc6-mE\x95\xf1\xbc\xf6ww\xf8\xe9o\xfe\x06\xdf\xfe\xee\xb7\xf8\xfa\x9bo\xf4\x18Ċ}]z\x01\x85\xb99u]iO\xb7n\xb4z\t\x01EYb\xb3\xdd\xe1\xfa\xe9\x93A\x06\x8f3:8\x13tm\x9e[P\x1c>\xdc\xf4\x1f\xef\xef\xeeu7\xac\xdf~\xd7\xef\x184\xe3@}\xf2ճp\xa8\x1ba\xd1\xfb.da+\x03Ls_)\xa5\x05ޣ!\xe1\xce\x1d\xf0\xb2,\x83\xf1-9\xb2$M]\xa3:Vz\xa2\xc1\x1d\xedނ\xecJq\xf0\xe1\xacѲ\x8d<\xfd\xdf\x01\xe8X\xd7eY\x9c\x88q\xadDc2\x8d\xb25\xf2/6\x1e.\x04\n!@f\x03\x93\x10&N6\xa0:kLB\xb6:\xe4\xec\xb8(E\xa1\xb3\x93\xb1\x10U)\xbc\xfd\xf9\x17l\xb7;|\xf5\xe2\xf9:\x1eSdc1\xb3f\xa6\xb7\xad^xm\x83\xeaXi\xa2\x9d\x94h[\xd9e\xfd\x84ah\x93SZ\"\xdb\xe6\xa4~\xe1lbe\xa4sڦѪ\xa2\xce\xe7\xb6m\x8b\x83\xa9\x97\xbb\xba\xbe\x0e\xce\xd7\xf1pDu\xfc\x19\x1f?\xdc\xe0\xeb\x17/\xf0\x9b?\xf9\x1d\xae\x9f<\x19,P\x8e` d\xd6E]\x9dx@Ji|p\xb3\xdd\xe2\xeaZ7\xe8\r6\xa15ݎ;\u007fGq\xaf\xdbP\xdbJ4\xb5Y\xe0D&\xdc(u\xff\xcc\xc0fQJa\u007fw\x87\xfb\xdb[Gt\x0e\xa7&\xbfJA\xb0\xe8\xc3\n6\xcc\xde\xef\xf1˿\xff;\xec\xef\xee\xf0\xf5\x8b\x17\xb8~\xfa\f\xc2x\x06l\xeb\xf3\x88\xba\x10\x9b\x12\xf8\xd9\xe1\xee\x0eu]C\x1aƷ%T3\x03\xb77\x1b\x94F4\xb3ܔ\xb8\xba\xbe\xc6\xee\xfa\xfatM3\xee\x8a\x19\xca\x05Յ\x80\x92\x19\x9af\x9d\x18\xa0\xc2\xed\xc7\x1b\x80\x80\x17\xbf\xf9\x8d\xf6\x80f\x1a\xadI\xf4\x15\xa2h\x97t\xad\x96r\x1c\x85\x05J\xff\x82d6C\x8cuޘ\xf6PJ\xf6\x19\xb8JIHS\x0e\xe2\xa7\xd1m\x19D\xd7\xfe\xa8(\xb01ul]\a\x12ch\x00\xa0\xadkTG\xed\x19p(\x84\xb4U\xf6F]\xa0\r\x85R\xc6c\x89*\x15d\x88\xb5ɦ\xc1۟\u007f\x86\x10\x02\xd7Ϟ:)W\n\xf3[\x9d\x93\xb7WT\xcb'\xb1<i\xbc\xd0\xd6\x18\x92\xb6i\xd16u\xa7\xb6h\x8d\xb4T\xb2\xeb\x90mC\x88d\xb6\x86\xc8\xc3J\xb9S_8\x1c\x0e=\xed!\x17\xf78\xee\xf7\x1d\xae\x10\xbb~]Ux\xff\xe6\rڦ\xc1\xd3g\xcfP\x94%\xae\x9f\\c\xf7\xe4\x1a$\x8a\x81\x97\xd4\xd65\x0e\x87\x03\x8e{-\xbf\xdcT\xb5\xd6?jOe\x19\xa2\x10\xd8n\xb6x\xf6\xe29\x9e\u007f\xfb\xad\xc6\xcb\f1\xb2m\x1b4M\x8b\xb6nP7\xb5\xf1\xac\xebN\x04\xb2{6\x9bD0c_\x94%vW;\\?}\x86\xdd\xd5\xd5\tTW\xba\xe1\xc6\xfb7o\xfb\x18\t\x9f\xb2Sn5\x85\xafMfy{7\xef\xdea\u007f{\x8b\xed\xee\n\xe5v\x8b\xa2,L\"\x83\xb1\xd9\xed\xf0\xf57/L\xabzi$ո\x83&X)\xdc\xdfݣ2=\";B\xa8\xdb-i\u007fB\xf3\x84\x10(\xcb\x12\xdb\xeb+\\]_\xa3,7PJ\xa2\xaejm\xa8-\x11\x9bt\x99\x98\xf6\xa4M\xc4\x13\x88\xa5\xfa\xfbY\uf7fb\x0f7h\xeb\x06O\x9f\u007f\x8dg_\u007f\x8d\xa2(\xfa\xf5\x8akC\x1c\x18\xd6\x1b\xb6\x9d\xa3cj_)؆0b\xb0\xcc_\x10\x85k\x96t\x9ar\x0f%\x95Y0\xb2ÅXq\u007fS8\xbd\xd9\n\xc7X\xb9\xcd<m\xa8@\x01<K\x87@*̫\xca\xe0Y\xe9$\xe3\xd2*y\xc2\xf1\xb0\xc7\x1f\u007f\xfa\tϿ\xf9\x06\xd7O\x9f\x80\nх\f:\xb3A`\xc9h\xa5\xde`RJ\xb4\xad\xf6\xe4\xecF\x15\x0e\x18\xa9k\x1d\xbd\xda/\x17\x94s\x00鮣\xcf\b\xe0ݫ\x01t\xb2U\xf6Ԫ=\xef\xaag\xb7\x9b\x06\xd5\xe1\x00\xf1\xe4\xc9\xe9$\x0ftɑm\x8b\xbb\x9b\x1b\xd4G\xd3ː\x04v\xd7\xd7\xf8\xea\x85\xee\xac\xc4J\xe3~\x87\xfb=\xea\xea\u0605<\x8a\x15\xd89\xdcN\x87\x01\xa39V8\xec\xf7\xb8\xfdp\x83\xcdv\x83\xa6i\x8c\xe7!!\x99\xc1\xad2\x1d\xc2\xf3\xc4\x05\x9b\xbaFu8\xe0\xee\xe6#6\x9b\r6\xbb\xad\x86+\x1a\x89\xfaxDӶ\xa7bz\xc7\xe3 \xa7&\x8e\x12Zd:\xbaЇ\x8c\xffw\xa2(p\xfb\xfe\xbd\xd7(\x97;\x00V\x97\x8d\x19CF\xc3,*9\a\x0f`\xf7\x80DUU\xb8\xffx\xdb\xef\xf0\x143(\xe4\xb4\xcd\x1a$w\xc8\x12\xcaOU\"\xac\xbd\xce\xfd\xfd=>\xbcy\x8b\xe7\xdf|\x83\xa7_\u007f\x85\xd2\xe0ik\x93\u007f\xfd\xabY\xef\xbbij4\xb5\xee`\xe4\x8c\xeb\x13\x04\xe8V~\xc0lZ^\x11\x88D\u007f\xe3\x98\xd3XJ]`lO\b\x98\f\x84r\x8b3=\xfc\xc2\xe5\xf3\xf8n+y\xf8\xd1\xe9\xa4s&š9\x9c\x95A\x1d0\x8e\xcc\xc0\xe1\xfe\x1eMUa{u\xa5OnC,m\x9b\x16M]\xa1iZ\xa8\xb6\xd5c\xc0\x9ct\x97)\xd2%\x87:ɇ\xd3X\xf52N\x19\x84X\x17\x0fT̺\xc1\xc2~\x9f\f\x15\x98\x19\xf5\xf1\x88r\xb3ѡ\x81\xd3\f\xc3\xd7\xdbo\xa5D\xeb\x00\xfa\xf7\xb7\xb7x\xff\xe6\x8d\xe3=\x9f\x8c\xaf\xbd\x86\xad#刞\x93j[\xdc}\xfc\xb8\xda\x06\xd1\a^\v)[TF\xad\"\x14\xbej\xd0\x1f'\xee\xdeĖɾ\xa2\x81m{OY\xf3\xd4緹\xfb%\x04\x19(\x97&\x11x\x16\xb7m<\xb3\x1ab\x84t\xf2\xf6\xf4\xf5\xc9{7k\x18\xe2\xb0G\xf1K\x89\xdd\xee\xaa\xf3\xba\xdcL\xf1j{\xccDT\xfb\xfd\x1e\xd5Q{\x8b\x87\xfdޏ\"\xae\x00\xfc\xf9\x8f?|\u007f\xf5\xf2\xd5\xebc4$\xd4'\xb3\xd4\x00\xa4=-\x98q<\x1aa;\xa5L\xe8\xd7\xda\xf8L\xb7\xa67\xf8JȪ\xd2\x04\x8d\xa5\xf8\xe0P\x97%<\x9b\xbd\xb2c\x10\xf8\x8c\xb6m\xd1\xde\xddu:I\xbc \xe6\x0f\xb6LsY\xc4>\x98=\xf53\x94Bu8`\u007fw\x97\xa5S~\xcaZ\xe9\r\xb7\xd9n\xfbR8v#Y\rt7\xdc7MY\xfbḀ>\xa7Ȅ\x8c\xfd\xe7\x8a{\xc5k\x9f\xe89\xe9y]\xb2\" \xa0\x90\xb9Fc\x06\x83&\xe4\xf6\x02\xeb`\xf5\xb5\x9d\x92n&\xefi\x8c3\xd0\xd6Z\x86\xe6\xfe\xee\x16\xfb\xdb;\xfc\xee\x1f\xfc\x1e\xe5\x02\x8c+\xf6l\xaam\xf1\xf1\xfd{(\xa9!\x91ȡ\xf5\xe7\x00\xbe\x85\xeeO\x18\x8e\x11۶5n\xb9\xf6 lx悄.\xbe\xe2K\xb9\x84\x06g\x8a\xb1\x8a-\\\n-\xc2\az\xf1ʓ\xe7\x02\xbd\x03B\xec\x8cW]U\xb8\xff\xf8Qg\x8d27\xa0r\x8aiu\U00076b9c\xb7\xd9\xda\x18\x1b\x8fC\x1e\f\xeb\xfa<{\xc0u\x05\xbb\xac\xe2O\xf5\x00\xb5\x87ݘ+]\xd9\xd0iYe\xccq\xa8\x932\x81F'\x8d#!~\xae\x9c\xf7dC\x1d\x9a4\xca\x1b\x9c\xbb\x9b\x8f\x93%vr2\x8bB\b|x\xfb\x0e\xf5\xb1\x82\x94\n7\xef?\x041Z\x00\xbf\xf7\xc3\xc2\x01\x86%[\x1d\xe6\xb4\xc6E\xb4\x1eG\xd34\x9a\x8fep\x18\xebV\x0eY\xc2\x1c\x1dı6\v݆\xed\b\x85\x9a]G\xec\xbb\xd3g\x0e\vu\v\xda\a3\x82\xae\xae\x18\a\xa8\x02\xa1\xb5(\f\xe8\\\x94%\u07bdy\xd3ӷ\xca\xf1\xc8,?N\xf8\x1c\xa2\x98\xf1\xecN\xe9\x806\x93罝\xe6\xb6\u007f\xd7\x03Q\xbb\xa8\x13D#\x9bld\x83:\xf7\x15\x02~\xd99\xf5\x91\xd3\".\xc4\xfe\x1fS7\b0\xffg{M\x81а\x87\x81\x05h\x06\xc4NR([-G\xe1\xe6\xdd;\\]_\xe3\xfa\xe9\xd3\xf9\xeb\xda\x00wv\xcc\xeennp{\xa3\xd5A>\xbe\u007f\x8f\xe3\xfd}l\xfc\xfes\xe8~\xa9\u007f\x88\x1a,\x008\xec5\x1b\xf6\xda\x00\xb1ʸ\xfd.\x01\xb3W\x14\x9d\xa3\x15=2J\xca\xf7\xc4:,E\xa0\xd8\x14\xe0V\xa19#\x03\x9d\xbc&\x11\x978\xef\xc3\xdc!\xf7\x0e\b~\x89\x92\xff\x1e\x12\x02EQ`\xbb\xddb{}\x85j\xbfG\xb5\xdfO.Xn\xaaJg\xff6[\x9d\x18`공]\xed\xf3\xfe\xcd\x18\x9bB\x01o\xd81x.p\ue147n\xad\xa1\x9b\xe1\xed}\x10\xf71\xc6^\xa9\xcb\x02uVKih\f\xe5\xa50<\xc4.!\x942D>nk2\xa0\xa9ZB\u007fv\xd7\n\xf5\x86Ԓ\xd4Z\xa3\xe12\x8b\xec\x89j\u007f\xc0ۿ\xff\t\xdf\xfc\xc9\xefpu}\x8d\"R_\xe9\x1aM+\x8d\xd3q2\x95\xf6\xc3m\x06\xfc\xf6\xfd\a\x1c\xee\xefqws\x83\xfd\xfd>e\xec\xbf\x06\xf0ۤ\x87e\xb3-\xf7\x1fo\xf1\xf5\x8b\x17(p\xaaϳ\x84\xcd>\xae\x98\xe7\xef\x04\xdd`\x9c\xf4\xb5\x8b\xa2@\xad\x18\x80\xea\xd5%m\xaf\xaf\xf0\xe4\xc9\x13\r\xcc\x1d\xf68\xee\xe7\xb5\x16\x1f5V΄Z\x9eS\xbb\xf2\xe7d\x85\x00Nv\x93\x03R\x1d\xf6\x94*\n\x81\xb2ԙ0\xcd݁\xe9\xed&g\x95\xfeH#\x95\\lJ\xed\xd9z\x8a\x93\xa3F\x9cy\x10j\x11Q\x97\x88\xe8\xa9C\x04xh\xbeW\x10KN \xd1Pc\xce\x1c\xb0\xe9\xcbh\xe7Z\xb6-j\xe0T>b(>\x94\xc0\xc0\x94\xe2\x1e\xfeB\xa1\xfe\x91\xeeax\x86\xf2\xac.2\x19\r\x16\xc9\xd1\u03a2Q\xabID\xb8\xbf\xbdű\xaa\xf0\xec\xabg\xd8=y\x82\xcdf\xab9oV\xd1\xc3\xd9\xdbҌ\xa54|Bi\xa0\x86\xd6$鎇\x03no>b\u007f{\x8b\xa6\x9f\x15\f\xbd~\x06\xf07\xa3\x06\v\xd0\x19\xa0\xeap\xc0\x13Ss$\xca\x02\xaaVpS\xb5\xa7\xde\xee4aPO\x16^\x10a\xb35\xe9gh\xfa\x83\x94\x9ak\xa3\xcb\x15Jl\xb7\x9a\xf2_n\n<-\n\x94\xe5\x06\x87\xfd\xde0\xd6\xd3\r*F\r\x84w\x92[\xc0Y\x93\xf5\b\xfb\x8f\xb7=\x82\xebe^\xe4\xe0\xef\xdcץ2dJ!tiQQ\x14\xba{s\xb37\x85\xe3\xba\xf4\xa2(\x8aɍ$\x04\x11v\xa6¡\xf3\x86|E\xc9A\xa8aK[\x86\xe3\xee\xf2\xc0,\x86\xb51\xd8\xd8l\x83\x1e\xe8\xf8=\xc5+a\xc7H\xb9\xf2\xc1\xbd\xec.\xe9\xeeAR)MH6ƪ0\x98\x9e\xfdw\xd0\xe8\xfa\x9eW\xe0\xde\xd9\xf5Z]l\xd0\xec\r_\x96\x9cr\xbd\xf3\x88\x177\xb8\x92\xf9\x1c\x86]W\x14<p\xfc\xfbn\xaa\n\xef\x8eG\xc3N/\xb1\xd9m\xb0\xd9l\xed\xe2\xd1\xfb\x94l\xe7%\xd5q\t\xa5\xd4I<\xb06f\xb777\xb8\xbf\xbb\xcbM*\xfd\x855X?\xfe\xf0=^\xbez\x1d6X\x04B\xdb6x\xff\xe6\xad)\x1b\xd8v\x1bE)id`d/=\xeb\xc7\xce\xe4\x84u\xf6t톍ٔ\x00m\xb5X\x1a\t\x80\x15\xb6W;'L\xd7\x1c'\x18\xf0\x9f\f\xc7Ė\r\xd5e\x81\xa6i\r\x9e\x86>\xa7Ɇ\x17&\\\x12\x85\xe8bخs\x88\x1fN\x18\x17\xa5m\x1a\xedƒ\xe1\xa4ٲ\x92s\x84\xa2\xb6 \x1b\xe8iһ\xa4\\\v\x86\xf7\xc2\x0f\x13\uee7f\xb3㭔\x9a\xa5\xb2AB\xe8羽\xd5<\x1c\xb7.,\xe0\x11\x90\x17\xba\xdbMgპ\xb12\xf3\xd2\x16\x05vWW\xba\x1cdl\\\x02Ơ\x9b7k|\xac\xacq\x8e\xc7\xc2}\xa6|(I\xe4sД\xf3\xec\x16,.\x8c\xf0_a\x12\x12\xec\xbd/\xd9)\xc7\xf7\x80\xac\x94\x91a\xea[\x95\x12K\x16\x06s\xb4\xdbw\x8fv\xe2\xfcޕKvI٢G\x06'\x90ўS\xb9P\x85\xddǊ!\xb9E{\xdf\xe0^\xddu\x15.EYv\x92L:\xe1bx\x9a\x9d'\xa7\x89\xe2G#\xc5My\x87\xd4\xff\t\xe0\x17\x00x\xf9\xeau\xe7aUp\xcbs\xcc\xd5d+\xf1\xfe\xad6XϿy\xd1\x15\x01\xdb>~M]\a\x17\xa6\xe5M\xd92\x99\xa2,M\xe9\x8c\xfe*\x04a\xb7\xbb\xc2f\xb7\x83(\n\xbd\xf0\fEB\x18\xbcLw|\xa9t1\xb5\xd3aÖt\xb8iw\xbf\x1f\xa0 \xc3\xfbb\x05նh\xad\xf1\x11\xfat\xb4\xa5*\x16\xb3p\xf1\x06e(\x1d\xb6/aadt\x9f<}ړӝd\x90b\x98\x9d2\xf7\xd7h\xc2\\\xd34=\xcf\xc6Ōx\x04\xb7X\xa3MX\xa7\xd4p<b\xb7\xdbu\xf3CB8\xc4GuJFxbn\x03C\x150\xf2\xb64j\xbb\xdb\xe9C\xd0z,vL\xe0I\xff\x9ad\x80\xf2X\xe1\xec|\xeeZ\xe1a4\xfc\xf4\xab6\xac\xf120\x86\xf5t\x878\\\xbfi.\xdbD\x12\x9f*\x17ڶ\xd5zP\x84\x93\agȻ\xfa\xda\u0588\xf5)F\xd2(\x9b\xb4u}28N\xd8\xeez\xb5v_\xb9\xd2.\xf6\xdf\xc2\xccm\xe7\x11{\x14\x15\xfblv\x0f\xdb9\xea\xf6\x90\xf9\x1e\x14\xf3\xf3\u05fe%N{\x9e\u007fb\xee\x0e\x00\xfe\xc5\xcbW\xaf\xf7~H\xf8\xd7\x00\xfe,\xb8\xa9\xa4Ļ_~\xc1\xf1\xb0\a\x89\x02d\x1e\xaa\xb7 C\x94\x06\x13\xbaP\xaf\xb6O\x97\xe7l6\x1bȶ\xc5\x15X\xb7PjT7\xc0\xc7\xfd\x1e\xf7\xb7w\xa69\x84\x06\xec\xfc\x92\x92\xeeZ\x1e{\x1c\xdc\xf7>\xe0\x88\xf4\x0f6\x98\x0f\x02ǌ\x8a\x01\r\xeb\xe3\xb1\v\x157\xdbm\x8f\xb9\x1f<\xc9\xcdw锣t'\x9f\x99d\xe9t7Y\xda8v\x8d\xed\xean\xfe\xe3\xf1\x88\xa6i:\x01;7\x84B\x82G\x95\xf3\f\xb6E\xf9\xf1x\xec\u0588[W\xe6.\xea\xb3\x1a\xa4\\`;\x12\xfaI)\xd1\xc0\xab\b\xc9\x11)t\x9bI\xf8\t\x14\xef:v\xcf\bǣv{\x04\xe6j\xee\xcbH\x18=P\x93\xf0氓b\"\xea\xb5/S9kv\xb9\xf6\u05ff\x04\xf0\xcf\xddp\xd0\x1a\xac\u007f\x1d3Xv\x81\xdd\xde\xdc`\xbb\xd9v\xcdF=t\xb4\xcb8\x90\xd7\u007f\xcfrr\x88O\xd6U\xb6-\x8el\x8aW\xab\xdad%\xf78\xdcߣ\xaa\x8e\x90\xad\xec\xb9\xfe\x83q0'\xeeeओ\x1aB۶\x9dV\x94p\x8c\xa2\x0f\xb6\xf6\x1ajF\x8c\xe2c\xd7;\xb7j\x1c8Sҡ\x93\xc5Ƨ\xffZ\xb3K\xb9\x9fEUJ\xad>\a<R\x89\x11J\x86Hc\xa0/\xf8\x92\x00\xfe\x97\x97\xaf^\xff\xc1\xffE\t\xe0\xafF\x1fR\xe92\x8f\xc2dNz\x99\xbf\x0eң\x9e\b\x9a\xdb\x05\x9a\x8d2aG]h\x18\xf7\xb5\xce\x12\xa8\xc0\x89\xfa\xa8\x17\xe7%\r\xe6\x97ח\u05ef\xf3\xf5\xbf\x02\xf8\x9f\xec?\xacwe\r\xd6\x1f\xb2Oݦ9ɛ\x90\xc7=7\xffGN\x05/s?c\xa2LzS\x86\xe2\xde/\xaf/\xaf/\xaf//\xe0\r\x80\xff\xf1\xe5\xab\xd7\xff6\xf4\xcb\x12\xc0\xff\f\xe0\xbf\x03\xf0\x1b\x00\xd7\x00\x922\x846\x8e\x16\x8e\ae\x9b9\x00\xa7,ŀݻ\xa2\xeb\xfc\xe5\x15\x84\xb1x\xc6{h\xc2\xcf\xe7\xde\x17]\xe0=\x9dc?\xe1\xbdj\xe5gM\xbdė%\x9a\x9c\x87#4\xc8\xfe\x1e\xc0\xff\xf0\xf2\xd5\xeb\xff\x1e\xe8cW\xee\x04\xe3\xc7\x1f\xbe\xff\x0e\xc0?\x03\xf0_\x03\xf8/\x00|\x05\xe0\x19\x80\x17\xe6\xbfK\xef=\f\xa0p\x8d\x9b\xc3nf\x00\x8d\x89C\xfd:Q\x0e|\x85\x16\xdd\\\x99\xeb\xdc\xc5\xf3\x04Y=\x19/\xf2\xaa͗\x9c\xf8l\n\xc0\x9d\x99\xe47\x00n\x81\x9e4\xd8\xd88*\x9c:\u007f\x87~\xee\xce\x1b%\xe6\xca52\xa1kQb\x0e)\xf3\xf3S\xcfd\u007f\xb61\xf3\xfa\x02\xba`\xf6+\xf337\t\xb9\a\xf0Ό\xd9{\xb3N)\xe3\xde(1\x1ec\xff\xbd3\xf7\xf4\x9d\xf9~\xbd\u009a\xb1\x86y\a?\xc3\xff\xb8^\xb1\xb5\xdd\x02\xf8\x00\xe0\x8f\x00~\x02\xf0\xbf\x01\xf8\x17\x00^\xbf|\xf5\xfaM\xccX!t\xba\xfc\xf8\xc3\xf7d\x8cշ\xd0\xd5\xd2\xff\xd4L>{\xa7\xc5\x15\x80\xff\x18\xc0?4\xbf\xaf\xcc\xc6\xf9{h\x86\xea\xbdy\x8frN3enV\x9a\xef*\xb0 \x84c\xcc\xfc\x05\xe0o\x02\xceXL\xfe\x86\xda\x01\xf8\xc7\x00\xfe\x89yNdz\x1a\x9c\xf8\\\x1ayO\xe8\xe7\f\xcd1\xf9\xb7\xe6\xfbޙ\xd8\xd43\xd8\xff\xae\x00\xfc\x1b\x00\xff\xb7\x99\xfc&4\xc1\xbf\x96\u05cf?|o#\x86\x17\x00\xfe\x91Y\x97ϝ\xf5z\x04\xf0\xef\x01\xfck\xa0\xfc\xf9\xe5\xab\xff\xbd\xb9\xd0}\x919\xd8\xff\xc48\x03\xff \xe0q\x85<\xbd\x94\xf7g\xaf\xf9\x1f@'̾\xf3\x8c\U000d4d4b\xc4\x1a\xcdY\x87\xa1\x03\xa91k\xfa\xff3\xdf\xef<\x83u\a\xe0/\x01\xfc\x9b\x97\xaf^\u007f\fͥ\xbf\x96\xed\xcf(e\xcd2'\xe4?4\x03\xb6\a\xf0\xd3\xcbW\xaf\xef?\x91\x05\xfe\xd4,n\xca<\xf9y\x85\xffv\xff-\x01\xbc}\xf9\xeau\xfd%*\xf8\xf2Z\xb0\x8e\x85\x81sv+\xad\xdd)ޱ\xf2\xc2pk\xc0j\x00o^\xbez-'<Ǩ\r\xfa\xf1\x87\xef\xc5\xff\x0f7Ϻ\xe5\x92\x06\xa8\xba\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Creature_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x06\x00\x00\x00p\xe2\x95T\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x142#r\xdeܴ\x00\x00 \x00IDATx\xda|\xbcY\x93\xa4\xc9u\xa6\xf7\xf8\xf6m\x11\x91\x91{e-\xbdo\x00\xba\t\x02\x04\xc0mh\xb3\x98\x16\x8eƤ\x1b\xc9Lfs!\x99t+\xfd\x05\xfe\x18]H\x97\x92ɤ\x91I3\xa6!\x87\xe4\x80 \b\x82\xd8\x1b\x8d^\xab\xba\x96\xac\xaa\xdc3\xb6oq\xf7\xa3\v\xf7\x88̞\xe1\xa8̲\xb3\xbb:3\">?~\xb6\xf7\xbc\xe7U\xff\xc3\xef\xfd\xd3KAJ\x89\x80\x12\xb4(D\x84B)\xacR(\x14F)\x00\xfa\xe8Y\x8a0\x8f\x1e\xe7\x1cӭ\x06\xad\x14\xa2\x14M鸷;aR9D\xc1\xf1\xe5\x9c\xcf_^\xb3l=U\xe18\xdc\xd9\xe2p{\x82\xd5PY\x85\u0560\x94Bk\x83҆\xfbw\xb6q\x85\xe1\xef>~\xc2\xfe\xfe6[\xa3\x86\xbe\xeb\x98_^szy\xcd\xe5\xb2\xe3\xfcz\xc9\xe3\x17W\\/[@\x81\x80 \x84\x18\xf9\xd6\xd7\xdf\xe0\xbd\awX\xf5\x03\u007f\xf1\x93\x8fX\xadZ&EAa5:}|\x10\x85 \x88D\xa2\b\"\xa0\x158\xadq\xf9Y\x85\x88BQ)C\xa9\x15\x1a\x00\x85\x0f\x01ED\xf2K\xad_\xf2\xf6\xbf\t\x02J12\x16\x80\b\x88\b\x01\b\b\x11\xe8D\x18\x00Tz\xb5(\xb0\xf0\x03A\x84\x18鬏\xbe\x1ck[)\rA \x10\xd1JQj\x85!=\x8cB\xb1\n\x81U\f,\x89\xd4u\xc9dԠTzC\xa3\x14;\xe3\x9aQ\xe5\xb8\xee\x06>yv\xce\xf1傦t\xbcv\xb4\xc3\xfd\x9d\t\xce:\xb4\x04\x9cN\x1fu\xfd\xf8J\x84IU0\x19\xd7|\xf6씥(~\xf6\xc5s\xa6\x0e|\xd7\x13b\xe4\xe5ł\xc7'\x97\xcc\xe7=\xa00F\xa3\xd2\xf9\xe2cd2nx\xf5\xce.\b\xbc<\xbf\xc2w=#g\x10\"}\x10\xb4V(\x01\r\x18\rN+\xac2(\x84Bi\x1c \xf9h\xb5rh\xc0\xa2\x80d\x10\x05\x94\xd6 \xa2\x89@\x90dԵAT:_\f\x1a\xab\x14\x16E@\xd0(\x82\x02\x1f\xc3\xcd\xeb\xe4\xf7\x1a$\xa0T\xfa\xf9B\x1bV\xc1\xa3\x15X\x1f\xa1'2҆B\x01ʢ\xd7^\xa1\x14 \f1\xb2\x14\xcfB\x84QS\xd1\xd4\xf9ec\xba\x15\xd3Q\xc5֨\xe6\xe1Ɍ\x8f\x9e\x9c0D\xe1Ճ)oݙ2mj\x9c\x16B\fĘ\f\xa1\xb5Bi\x8d\xd2\x1a\xad5\xe3ɘ\xa5\x17.\x17-WW3\xbexr\xca\xe1V\x89R\x9a\xe7\xa7ל_-\x89Q\xd0Z\x83\x92\xf4\xb1\xb2A\r\xf0\xf5\xd7\xef\xd2\x14\x05\xbd\xf7\xbc89\xc7\x19\x85\xe4۩\x158\xa5qF\xe1T:\xe0\xf4\x95\xae\x84F\x11Dp\xf9 \xb5(\xb4R(E\xf6\x8et\xb0J\xe9\x8d\a\x88DD\xd4\xc6?\x14`\xf4\xfa\x15\x01I\xbf\x93\xbc\x00P\x06\xa5\x92\xefE\x11\x1c\xb0\x12\xe8\x05\xa2\x12\n\xad\xe8c\xfa\x1c\xf6e۳[Z\x10aj\xec&L\xa57\x13B\x14\xae\xc2\xc0B\x02\xce\x1a\xea\xbaDr\xb8@\x84\xa6t\x8c\x9b\x8a\x9f|\xf1\x9c\xe7g3\x0evF|\xfd\xfe.\a\x93\x1a\xa35ZC\x88ٓ\x9c\xa5\xb4\x16\xe3,\xda\x184P65\xf5ք\xa7/N\xb8\xbc\xb8\xe2ًsNN\xafx\xf9R\x90(\xa8\x1c\xda\x04\x01\x11\x8c6h\x9d\x0fB)\x0e\xf6vx\xe5p\x0fD\xb8\x9c-\xb8\x9a/\x00\xb0FQ\x1bC\xa15\xe6V`I\xc6H\x1e\x16DX\x8a'J2\xafU\xe0P\x94J1ʟO\x03Zi\x14\x11\xd4ڋ4J\xa5S\x92\xf4\xc9\x10\xd4\xc6\xcb\xd8\x18\x13\x04\x83ղ\tYA\xa5P\xe6P\x04Q\\\x13P\x80՚\x10\x03V\x04NV\x03\x83\x8b\xd8B\x98X\a\x92\xde\"Da\x19\x03K\x89(\x81qS\xa3!\xbf\xb4B\x19EԚ\x1f\u007fv\f\x02\xdf~\xeb\x0eo\x1dna\xb5ٜ@\b\x11\xad4eS\xe3\x9cŨ\xf4Q\xb5\xd5\xd4M\x856\x96G\x8f\x9e\xf0\xd9\xd3\x13\xfa\x188\xbd\\0\xf8\x90\xbd\x81M\xae\xd0Zc\xb5\u0098\x9b\x9b9n*\xde~\xe5.F\xa5\x18\xff\xf2\xf4\x02\xa7\x14Ui\xb0J\xa37\xc7t\x13\\D\xa0#\xe0E\b\xf9R\xa5\x03O\u007f<\xa0Dh\xf2-\xb7j\x9dEn~&\x02^\"A\xf2A\xc7\xe4\x89\xc6\x18Fh\x8c\xd2\x1b\x0f]\x8a\xe7\xda\xfb\x94\xeb\x94&*I\x9f][4B\x83\xa2U\n\x87\xd0\x13\xb0o65\xc7]\xc7E\x1f\xf0Q\xd8/\xa0\xd1\n\x97\x1f\xa8\x13AD\xa8\xab\x92\xa2\xb0\xe9\xcdQ\f\"\\-{\x16\xfd\x92W\xf6'\xbc{o\x87\xed\xbaH\xa6J\x91\x85\b\x94U\x89u\x0ec\r\"\xe9\xffUu\x855\x86\x8bق'ǧ<;\xbf\xe6\xf8b\xc1\xe9Ղ\xeb\xf9\x8aҚT,\xe4x\xab\x95ʉ9'b\xad\xb0\xc6p\xb0;eRW(\r\xbe\v\x94Z1qf\xe3\xe1\xfa\xf6\x01\xc6H\x90\x142\xd6\xc6Y\xdfv\xa3\x14v\x93\r\x84R)\n\x95r\xa8\xacs\x1d\x9a@\xa4\x8f\x81(7\x9e\xb1\xf6\x03/P\xa3\xb0h\x10\xc1\x13Y\xc6\xc82\f\xf9Z\bH\x00\f\x11a\x15#\xb5\xd6X\x14\r\xa0\x8d\xa1\r\x01\xfb\xaau\x1c\x18\xcb\xe3\xa1\xe3\xda{^\xf4CJjF\xb3g\r\x1e\xc1\x1aø)\x91\x98\x12\xfe\xcaGNf+\\a\xf9\xde;w\xb9\xb73B#\xc9U\x95\"F\xc1XCY\x148\xe7@iD\"uUP5\x15\x97Ws\x9e\xbe8\xe3b\xb6\xe2\xf3\x97\x97|\xfa\xf8\x8c~\xf08\xa3\xd9*\vt\xaeg\xd6\x15J\xf2\x87\xf8\x95\x98\xbd?\x9dpow\x9b\xba*\xe8\xfa\x81\xd5r\xc9j\xb1LFW\x10\x11\x86(\f1\xddf\xb56\x82Z珜\"\xb3\xe1$\xff]\xa1\x14cm\xd0\x1bs&\xef\x18\b\xb4ރR\x1bO\xb9)\xde\x14ce\x19a\x11\x84%\xc2*\x04:\t\x9bпI\xfe\x12A)\xfc\xe6b\xa4\xaf:G\x00\xeb\x11FJ\xf1\x8d\xa2\xe2\xc4\f<\xf6a\x93;NC`b4\xe3\xaa\xdcܺE\xef9_\xf4lo5\xfc\xce\x1b\x87\xec4%>F\x8c\xd5\x18c\xa8\xeb\x06m4\xc6Z\xb4I\x01ST\xf2\x94\xb6\xeb\xf8\xf0\x93/yyv\xcd\xf1Ւώϙ\xcd;\x8cQ4\xce\xd28\x93\xc2\\v\r\xa5\x92\xa7\xc9\xda\x18\xd9k\x1e\x1c\xeer\u007f\u007f\x9b\xadq\r(b\b,\x97-m7\x00\xd0\xc5H\x1bB\xce\rkC\xdcdF\xb2\x01\x10I\x87\x90\xf3F\xa9\xa0Tp\xe3c駽DV!\xa0\xd4M\x89\xcb-sY\xa5\xa8r\x01\xe4\x11V1\xd0K\xcc\xfe\xfd\x15\xd3m\xbc\xb3R)\bJ\xfe\x9d\xb3a\xa0\x8f\x11kDPZ\x13c\xa4\x15\xb0*=B+\x9e\x91Q\x94\xceP\x15\x96\x10\x85\xb9\x0f\xcc{\xcf\xfd\x83m\xbeq\u007f\x9bI\xed@\"\xa55\x18k\x98\xeclSg\x0f\xe8\xda\x1ek5\xd6\x1aB\x10\x9e>|\xc6ӓ\v\x8e/\x17<zy\xcd\xd9\xd5\x12\xa5\xa0p\x9a\xb1s\xd4֤\x8f+\x82A\xf0Q\xe1C\xdct\x06 8\xa3\xb9\xbb\xb7̓\xc3]Fu\x85\xb56\xf7\"p~uM\x17\x02\x01\xf0\x81\x8d\x01\xd5W\xcf\x0e\xbb\xae\xb4rU5\xd6\x06K\xc4\x02\xe6\xa6a!n<\a\xfa\x18\xd2\xe5\xb8\x15\ncN\xe8\xeb\x1b\x1e\xf2߷\x12\x19\xa2O\x9fX\xd6~-\x9b߳9DV\xc6Ћp\x15#\x97a`\b)\bZ\xa74\x0e\xc5R\x84>\xa67\xe8CD)\xa16\x86\xa6\xae\xf0\",\xbd0\x84\xc0\xab\xfbۼ\xb6?a\xabvh\xad0\xdad\x0f\xa8)J\xcbj\xd9\xf2\xe9\x17O\x99\xad:\xa2\x82IU2\xf8\xc0\xe3\xb3\x19ONg\x9c\xcf[B\x8cX\xab\x19;KcMn<S~H\xcd\rX\r\"\x9a!x\xbc\xc0\x9d\xed\x11\xf7\xf6\xb6\xd9\xd9\x1a\xe1\x8cav=\xa3,\n\xac\xd1t\x83\xe7|\xb6\xca\x0f\xb5\t\x0e\xac\xffk\xec,N\xebt\xe8JS\xa8T\xd5\x14\n\x9c\xb6TF\x11bd\xd6v\xc4\x18)\x8c\xc5\xe6jH\x89`4HLa0y\f\x1bo\x89\"\x94*=\xc3BR\x98R\xb7ZH\x95\x9b@\x8d\xa0\xd1\xd4\xda\x12\x15\x9c\x87\xc0,x\xba\x98ڊ!\xa4\xef\xb6\xce\xf5\xb5\x02\x86\xfc\xbd\x8d\x9e]gh\xca\x02A1\xf8\x88\x84\xc8\xfd\x9d)o\xdc\xd9bk\\`l\xea!\x10E\xd9\xd4(4Zif\xf3%ݐ\x0e\xf17\xcf.\t>\xd0\x0e\x03\xf3e\x8f\x8f\xe9c\x96Z3-\x1d\x85\xcew0E\x8f\xb5-R\x8c\x17\xa1\x93H\uf163\xbd\t\xef\xdc;\xa0\xaeK\x94\x82/\x9e>g\x88\xc2\xe1\xe1\x1e\xc6h\xe6mǲ\x1fp\xc6n^O\xa9t\xf8.\xc7f\xa5R\x85\x85V\x14\xd62.,\x06M\x90\x142*k\x19\x95%WmǼ눢pZ\xa7\xd7\x11\x85Ҋ>F$\u007fP\xc9\x17\xa82\x96F\x1b\x961\xd2ƈ D\xf9jGoP\x94\xda\xe0\x94\xe1*\x06\xcec\xa4\v\x81!F\xfa\x90\x8d\x11\x84\x88`\xb5R\x04\x81\x95$7mC\xa0Кq\xe5P\xd6\xe4fN\xb8\xb3=\xe2\xdd\xfbS&\xa3\x02\x95\x1fP\x1bM\xd94(m\bC@\x89\x10Bȵ\t,W\x1d\xf3EO\x14!Hj\xe8FֲUX4\x1aQ\xb2\t\x11\xb7cvDX\x86H\xeb\x85\a\aS\xdeyp\xc0\xb8\xae\x88Qx\xfa\xfc\x84秗lMF(\x95ҳ\xd1j\xd3j\x1b\xa51:\x1d\xe6:\xb4\xacB\xc0KH\r]\x0f/\xd67WR\x92R\xa4\xe6qj5\aU\xcd\xfe\xa8A\v\x84 \xac\x86.Ub\b\x956\xa9g\xd8\x04\xaa\xf4~\x88\xd0K H$J\xb8\xa9\xbe\x94b\xa2\x1de.;\x9f{\xcfy\b\xb4\x12\xf1^\xe8CL\xf0\t\xa0\xb5\x80(\xacV\x9a!z\xaecL\x16\x8b\x91\xfd\xba\xc0Y\x87͝\xe6θ\xe6\xdd\xd7v\x195E\xae2\x04el\xf2\fmnBM\x0e\x9cJ\xa5\xf8\x1f\xa3\xe03nd\x94bZ\x964F\xe7\xd6Bn\x01\x0f\xf9VK\xba\x85\xb3ރּu\u007f\x9f\xb7\xee\x1f\xb0=iX,\x97|\xf6\xf0\t\xcbU\xcbnY@\x8c\xb4m\x87\x1b\x8f؛N(\v\x97\xf0&\xadSe\x15C\x0e\x83\xb92\xd8\x18!\xe5\x8f\xf5\r\x10Q\xb9\x9f\x88\xf8\b\v\xbf\xa4֊o\xec\xeepgZ\xf1\xf0\xec\x8a\xe50`\x00\x93s\x92E\xdf4\xab\xf9\x9f\x8d\xd2tZ\x98\x85\x04\x9b\x14h\xb6\x8c\xa3P\x9a^\x84\xf3\xc1s\xdc\x0f\xb4!\x12oUgl\x1aτ&Xb\xe0\x85\x1f\x98\xc5\xc0\xdc\a&\xa5c\xabt\xd4\xd6Bn\xb2\xde{c\x9f\xbaq9n*\xd0\xc93D\xa50%\xea&\xfe;\xe7\x10\xa5\xe9C`\xbe\x1a\x88QpV\xb3S\x148\x9d۴\xdc\x19\xa3\xe4Va\t\xb3\xc1s\xdd\x0f\x8c\xeb\x8a\xf7\x1e\x1c\xf0\xfa\xdd\x03v\xb6\x1a\xba\xae\xe3\xe5ӗ\x98>\xb0[\x948\x14\x06\xc5\xe2\xe9\t͛%\xe3\xa6\xe6;\xef\xbe\xc1\x8bG\xc7h}S\xb0\xae\xcb\xdb\x1cH\x88\xa2\xf0\xa4\\\x187Y&\x95\xe9\xbd\bK\x1f\x99\x87\xc8*\u0087W3\xe6ѳ\xdd\xd4\xecI\r\x01|\x88\x84\x10\xf0\x12\xf11l:\xf4Tp\x18T\xd4DI\xb0\x93U\xa9W[ĞY\b\xac\xa206\x8a\xc6&T\xcb9C3\xaa)\xab2\xf5Y\xb9\xf7\xb2\x1d\x8a\xb3\x10\xb8\xf4\x9e\xc2\x19\xb6\nKc-k(\xeb\xbd7\x0e\x98N+\x88\x92,\xaa4\xb6\xaas'\xadQF#\n\xac\xb3h\x9d\x93\x99\xc0\xf9\xbc\xa3\x1b\x06ƅc\xbf)\x11I\x9d\xabڔ\x9d\x82\x92|`J8[\r,\x87\x81\xa3\xdd)\xef\xbf~\x87W\xef\xec1\x1dW\f>r\xf1\xfc\x8a\xed^\xb1\u05cc\x13\x06\xe6\f\xa6r`5a\x18к\xe4\xe8h\x9f\x9dqM\xb7X\x10C F2x\t\x12\x85\xd8{d\b\xc4\x00}\x18X\r\x03C\b\xa9\xa0Щ\xa6\x1a\xac\xe2<\n\xabA8m;N\xdb\x1e\xab`\xaf,\xf9`2a\u007f\\\x13\xa30\f\x9e\xf90\xb0\xea{\x021C\xa5\t\xb4\xdc\xd2.\xa1\xc3\x12!F\x04M\xad\x02\x8dQ\xf9Z\xe8\x9cg\x04ݶh\xa3\x19\x8d\x1b\xb0\x06\xa5\x14\xf6*\x06f!\x10Ql;\xcb4\x1f,\x02ﾱ\xc7\xeeNMȘ\x926\x06\xe5\x1c\xd6hDk\xb4\xb1\x9b\xd0c\x9d\xe5z\xbe\xe2\xf1\xf1\x19\x9f\x9f\\\xf3\xebG\xa7\xd4ư_\x977\r\xd0:\xb1\xdej҆\x18\xb9Xv(c\xf8\xe0\x8d\xfb\xbc\xf7\xca\x01\x87\xbbS\x9a\xaa xO\xbfJ\x87Rn\x8f\xa04\xe8¢r\xbf\x82D\xa2_\x81\x15\x8c\xb2Lw\xc6\xc8\xce\b\t\x01\x89\x81\xf5\x9b\x89\xa4\x922\xfa\x80\xef\x02\xed\xaac\xd4\r\xac\xba\x9e\x10\"\xc1{b\x88\xb8 \x94!\xb04\x91\x99\x0f\xac\x82\xe0Ex\xd9u\xfcY۱\xe3\x1c\xbbU\xc9AY1v\x96ê$\xe6\x04=\xebz\xfaM\xfe\x10\x8cVXm\x91\x10\xb0\x12\xe9bؔʢ\x12b\xd0\x0f\x81\xd5\xe5\x8c\xf9rE=\xaa)\xca\x12\xfb\xb8\xefX\x84\xc0V\xe5\xd8u\x86\"C\x1co\xbc\xba\xc3\xd1\xd1\x04rw\xae\xad\x05m0&\xf5\v\xc6\xda\r\xd6d\x8da\xd5\r<|\xf4\x9c\x8f\x9f\x9d\xf1\xd3O\x8fqZ\xb3?\xae7\xe5\xe1&g\xe4\x8aJ+Xz\xcf\xc5j`o{\xc4\xfb\xaf\xdf\xe3\xc1\xe1.\xbb㊪\xc8\xe8\xa8\x12\xe6\xab\x15Cm\xa8w\x1b\x9c3\x14FQ\x14\x16k4\x85\xb5X\x9bPc\x95\xf3]\x90\x14Z\xc8h\x83R\t\xbd\xb5J\xe5j+\xfd\u007f\xef\x03\xc3\xe0Y\xad:\x16\xab\x8e\xbe\xediہa\x18\x10/\xf4\xbdg\xd6\xf6\\.V\\\x0f\x81\xc1\v\x17\xc3\xc0U\xf0|\xb9\\Q\x1b\xc3NQrP8\xee\x96\x05[U\xc5\xc9|\xc1\"x¦\xe0\x86\xc2\x18\xac\xd6t1\xd0ň'\xd2Ɛ\xc3h\xfe̽\xa7\x1ff\x18\xbb\u009et=e\xa1\xd9-,\x95Ky\xe2p\xb7\xe1\xde\xd1\x04\x15\x05ɠ\x99R)DI\x14LaPZ!\n\x9c6t>\xf0\x9bO\x1e\xf3ѓ\x13~\xf5\xe8\x04\xab\x15w&\rN\xeb\x8dG|\xa5?S0\xeb\a\xae{ϛ\xf7\xf7\xf9ƫG\xec\xecL\xd8\x19\x958\x93J\xcd\xce\vO\xce:\xee\xec\x8cpfDY\x15\x14\xd6\xd2Ga\xd9E\xae\x96\x03W\xe7\v^^\xcf8\x9b\u0379^v,۞\xde{V\xfd@\xf4\x81\xba,QZar\xe9۔\x05\aӆ\xd7\x0f\xa6\xeco\x8d؟\x8e8؛r\xd7h|\x14\xdaU\xc7\xe5匋\xcb9u\xf0쨆#\xbf\xc5|\xd1s:oy9_\xd2\x0f\x1e$2\x8f\x91e\xf0\xbc\xec,\x9f\xcc\x15o65\xaf\x8fF\x94}\xcfU\xd7\x13T\x1a\x9c\x85\x9c\xbc\x9d\xb1X\x9d\x06U&\x0e\taȃ\xabU\xf0\f\x12\x89\x83\xc7*\xad\xd9)\v\xc6\xcea\xad\xa6\xb4\x86\xed*\xc1\xf1\x9b\xf2Vk\xa2DB\xef\xb1UꐣV\x18\xa5\t\x02\x9f~\xfa\x98\x87\xcf\xcf\xf9\xbb\xcf_ !rw2\xa2\xb4\x9a\x10o\x1bA\xe5\x1a\x1ef]\xcf\"D\xde\u007f툷\x1f\xdcao{\xccVSbt\xce\xf8\x12\xf8\xec\xe9\x92\x1f\xfe\xf4\x05_{{\x9f\xf7\xdf\xda\xe2\xfc|\xe0\xb3\xc7g|\xfe\xf8\x9c\xcb\xeb9\xe7\xcb9\x17\xfd\x15C\x1c68\x8b\xcaXں\x19\x93(\xb7|\x13bL\xb1\xdb\x18\x93\xf0\xb9\xaad{k\xc4[G\xbb\xbc~g\x87{{S\xf6\xa6c\xb6\xa6\x13\x16\x8b%\xd7\xd7\vT\xef)\x9cf{Tro\xa7\xe1\xf8\xba\xe5t\xb6\xa0\xed=C\x88\xf8\xd8\x13\x8c\xe5\xa3\xc5\x12'pwҀ\xd6̺\x1e\xa5SH\x8e\x9bz,}\x9aB\xdb\x1cJ\xe1*\xf4\xccC$J2\x8e\x9d\x14\x86\xbd\xd2\xe1\xac\xc1i͝\xed\x8a\xfd\xa3m\x9a\xe9\b\xbfj\xf3K\xa5\x9a\xc4\x16%\xd5x\x9c:O\x01e4\xcf\x1e=\xe7\xe4\U0010a3cf/\x89>r\xb45\xa20f\x83\xaar\v\x11E\xc1Ų\xa3\a\xde\u007f\xf5\x88\xaf\xbfzĽ;\xdb4e\x81\x0f\x01\xad\x04\xad\x85nP\x9c_\x0e\xf4\x83\xe7\xe7\xbf>\xe6\xc7\x1f>\xe6j\xb6d5\xac\x10\x1d\b\xca\x13\xf08\a6w\x05\xb7G\xa9\x9b{`n\xfa\x1b\xad4Z+\x82_\xcf5\x14\xf3U\xcb\xe5|\xc1\xa7O\x9e\x83R\xd4E\xc1\xf6\xb8\xe1\x95\xc3m\xbe\xfd\xe6}\xbe\xf6`\x9f\x91Ѭ\x96+\xe6\x8b\x0e\xb3l\xa9\xab\x82;[5'\xb3\x15O/\xe7\f\x83\xa7\xf5\x03Zk\x1e\x0f=j\x06\xaf\xeeN\x19\xd7\x05O.\xe7X\r\x9dD\x82\x84\x1b\x8cY\xd6\xe3\xdc\xc0U\xf0\\t\x9e\xde\xe7\\\xbc_9Jg\xd3\xcc\xc2\x19Ƶc\xfb\xce\x0e\xf5\xf6\x0e\xedl\x86Ę\xb0.\x01\xb4N\xc1_@[\xcb\xf9\xc55O\x9e\x9d\xf0\xb7\x0fOxq>\xe7\xceVMe\xcc\xdf\x03\xa7\xa5\x1b|\xb1li\xa3\xf0\x8f\xbe\xf9&\x1f\xbc~\xc4\xc1\xde\x16U\x99f\xf01\x06\xba\xc1\xf3\xf1\xa3\x19?\xfd\xf5)_\x1e\x9f\xd3\xf5i\x8e\xd0\xfb\x01cR\xf36H\xa4\xa0@p\x88\x12\xa2\x04\xbc\fD\t\xb7:\xe3\x1b\xf0j\xfd\xfei\xcag\xd6he\u008d։7\xdf\xdc(\x81\xf3\xebkN\xaf\xae\xf9\xc5\x17O\xd9ߚ\xf0;\xef\xbc\xc2\x1f\xbc\xf7\nw\xef\x8e\x19\xfa\x81\x17'\x97\xa0\x93q\xbb\x10y~\xbd\x04\x1f\xf0!r\xbcZ\xd1h\xcd֪\xe5\xde\xf6\x98\xed\xba\xe4b\xd5b\x94\xa2\x8b\x8a^\xe2fD\xaer?t\xb7\xa8\xb1\xb4\\\r\x81Y\xef1\u007f\xfc\xd6\xfb\u007f\xa2\xb5\xb6\xdahb\x14B\xdf\xd3^\xcdh\xc65\xcd\xee\x14cl\x1e[\xb2\xe9C\x941\xf4}\xe0\x93\x8f\xbe\xe0\xe7\x8fO\xf9\xfc\xc5%\a\x93\x8a\x91-n!\xa5j\x83-k\xa5\x98\xf7\x03ם\xe7\x1f~\xf3M\xbe\xf3\xce\x03z*FMɨq\xf4\xde\xf3\xe9\xe39\xff\xe2Ϟ\xf0\xfd\x1f?\xe6\xf4j\x9e\x0fQ\xe8\xa5g`\xa0\rK\x06\x19\x88\x04D\x85Ty\xe5\xf1l\xa5-N\xe5\xfeYI\x9e\x9f\xdc \x01z\xd3Y\xa7\x89㺲P\xff\xce\xc5Y\x17\x00k\x90q\xd9u\xfc\xfa\xd1\v~\xf0\xe1C>z~\x89)\v\u07bc\xbf\x8fQ\xd0\x0f\x1e\x8d\xe2\xba\xf3\x9bW\x18b\xe4\xd2'`\xf1n\xd3Д\x05\x97m\x97\xfa,\x9dz\x16/\x11\xa3\f\xfbE\xcdݢAi\xcd\xd9Ђ\x82E\x1f\xbd\xd5FS9\xc3V\xe3X\xb5\x031\xc2\xe9ْ\x8b\xbf\xfc\x19\xef\xfe\xf6\xdb\x1c\xbeq\x0f\xa3\n\x94\x89\xc4\x18\x91\b\x18\xc3\xc3\xcf\x1f\xf2\xf4|ƣ\x93kv\x9b\x8a\x91u\x1bttc\x0fI\x10s\x1f=\xd7\xed\xc0\xef\xbc\xf3\x80\xef\xbcs\x9fzT\xf3ӟ^\xf2\x83\xbf;c{j\xb1F\xf8\xd1ώY\xb5-\x85\xd3\fq`\xe9;\xda\xd0\xd2\xc7\x1eApJ3ʀdi\x15N+L>\xfcT\xc8\x19\x04K\x94\x84%E\x01\x1f\xa1\x0f\x82\x0f\u0090\xe7#]\x8c\fQ\x88:\xc1\x1e\x92a\x9d5iAn\xf9\x96\x00\xa5KH\xc6/?\u007f\xcc/\xbfx\xc2\xfb\xaf\xdf珿\xf56;\xd3\x11\x02\xdc\x1d\x06\x1e\x9f\xcf\xd1\xd6`E\x18b\xe0\xe3\xf9\x8c\xc9y\xc1\xb7\x0ew9\x9a\x8c9\xbe\x9e\xe3\x94\xc1+\xcf\xc4\x14lےQ\xe9\x10\x11\x9e/\x17\\\xf4\x9e\xabU@)Ih{SZ\x0e\xf7\x1b\xac3<\xfc\xf4\x84!\x0fQ>\xfa\xf9C\xfa\xbeg\xf7\xee\x1ee\xdd$\xec\xa8М\x9d\\przɯ\x9e]@\x14\x9a\xd2\xe41k\x02\xcb\xd7ݫR\x9a(\xc2٢\xe7pw\x8b\xef\xbd\xfb\x80QSq\xb0?\xe6\xf5W=\x8f\xbe\xff\x94G\x8f\xe6\f!\xa0T\x04\xed\xb9\xec紡\xc5Kj\xda&\xce0)\f#\xa7\xa8,\x99>t\xfb\xe8$#\x93\xeb\x89S\xa6>H\xa2\xd8$\x94\x16\xa2$d\xb6\x0f¬\x8f\\u\x1e\xad5\x8d5\x04\x11V>\xb0\xf4\xa9d\xcdQm\xf3\xfaZ)\xaa\xc2\"\x02\x1f=zƧOO\xf8Ͽ\xfb5\xbeu\u007f/\x15\xafJ\xf1\xf0\xf4\x1am\f&\n>F\xfe\xe6\xf4\x94\xba4|{o\x0f\x1f\"\xf3\xb6\xa3P\x16\xad`R\x16\x8c+\xc7/\xce\xcf\xf9t\xb6\xe4\xbaO^5v\x16[Y\xcb\xeev\xc3\xddw^\xa5,\x1cۇ\a\\\\\xcc\xf8\xec\x8bc\x9e^.\xf1\x1f?\xe5\x03g\x90\x1d\xa1\x9en\xd1v\x9e\xa7_\xbe\xe0\x93\xb3\x05\xa7\x97K\xf6\xc651Be\xf5\x8d\xeb\xaf\xe7\xd7J8[tx\x81\xb7\xefﳷ3f:mxv\xba\xe4Ӈ\x97\\\xcf\x17\t\x90S=\x17\xfd9\xab\xb0B\x00\xab\x15\xbb\x85cZ\x18\x9a\x02*G\xa6Ѩ\rd\xa6U2\x8e\xc9| }\xbb\xc2\xca\x1e\x1a\xa2lz\x93\x98\r4\x88fRh\x0e\x1aC\xeba5\xa4\x9f)\x8de\xe2,W\xfd\xc02\xf8͈Wn\xdb^'O\x15\xf1\xfc\x9f?\xfa\x10?\xbc\xcb\awwxe\u007f\v\x1f\xe1\xd1\xe9\x15\xd6\x18$\xa4\x91\xf1_<{\x81\x89\x8ao\x1e\xec1\xef*\xcef-u\xa9\x99\xd6%\xbf\xbe\xb8\xe4\a/\xceX\x85\x80Ӛ\xfb㊣\xb2\xc0n5\x8efdQ\xc1\x83.\x19\uf319\x1en\xf3\xea[w\xf9\xf3?\xff9\x9f\x1f\x9f\xb3\xf8\u0557\xec\xedO\xd9;\xdc\xc5D\xe1\xe9Ŝ\x8f\x9f\x9d3)\x1c\n\xa14\xf6\x16\xc5,#DJ\xb3\x1a<\xab!p\xffp\x9b\xdf~\xfb>ӭ\x86/\x8eg\xfc\x9b\xbfzγ\xe3+\"\x81e\x98s֟\xe3\xc5c\xd0\x18\x05\xfb\xa5e\xa7\xb6\x94\xd9#tFd\xad\x86RC\x91\xbfֱ>\x19c\xc3Lڄ\x9b\x84\xe6\xa6\xe1\x91d\x82\x83\x8f0Dh\x83І\xc8\x10\x14\xad\x87\xe5\x10\xe8\x04\xf6+\xc7\xdc\x1b\xae\xfa>W\x8a)\xaf\x04\xc9\x00\x89\xba\xf1\x9c\xff\xe7g\x9f \xf26\xef\xde\xd9\xe1\xc1\xfe\x16\xf3\xbe\xe7\xfcz\x85\x95\x04Ŵ!\U0002f3dfCT\xbc\xb9=敽\x11F+\x9e\xcdZ\xbe\xff\xfc\x84\xa5\xf7T\xd6\xf2\xdb{[l\x19\x83\x17\xc1N\xc6\x05\x93\xed\x9av>c\xe8\xba͠\xd9\x15\x05\xdf\xf9\xde\xd7x\xf4\xaf\xfe\x96_>9\xc7<\xbf@\x99'\xecOG\xbc\x9c\xb7\xa8>\xe2\x9a\"O\xc0nM\xc6r؈\"\\\xacz\xea\xca\xf1\x9f\xfe\xee{\xbcz\u007f\x97/\x9e\xcd\xf8\xf3\xbfz\xce\xf3\x17\xd7\f\xb1\xe7r8g\x16f)ܑn\xf9Na٭4\xe3B(L>e\x81I\xa1\xa9\r\x94VQ\x99\x04\xaf[\x93=Ũ4DZ\x87\xa6\b>\xa6\xdc\x10\xa2\x10%\r\xa0\x82(B\x14\xba\x10)\xb5bd\r]\x80\xd6F\x1ag\x99w\x81\x85\x8fl\x17\x9aR\x97\x9cw\x03]Lc\x05\xa3t\x0e\xc6B\xccEA\x94\xc0\xbf\xfa\xf9\xa7\xb8o\xbf\xcb\xdb\aۼ\xb2\xb7ż\x1b\xe8D\xb0\x04\xcal\x94\x1f\x9e\x9f\xb1S\x15(\xe0\xe9jş={\xce\xcbUGi\r\xbf\xb53a\xcbh|\xce}v\xf7p\x82\xd2)0G\xef\xf3UStmK9\x1a\xf1\x8f\xbe\xf7\x1e\xff\xe6G\xbfa\xe5\x03W}\xe0\xa3gg\xc4!R8\x83\x01\n\xad\x91\xafv\x1b(\xe0r5\x10P\xfc\x17\xbf\xff\r\xde~\xed\x80'/\x97|\xff\x87/x\xf9r\x86\x8f\x03\xe7\xfd\x19\x8b\xb0HseI\xf1\xf3\xceȲS*\xb6+\xc3\xc8i\xb4\x12\x9cV\x14Z(\x8d\xa2q\x9a\xaa0\x94Va]2\b\x9b\xf0\x95\xdc\xe4v\x85\x17%Qt|Lt\xa4\xd5 \xf4>\xb2\xea#\xd7ˁ\xce\v\xa5\x86\xda*|Tl\x17\x9a\xcb.pݧ0R\x99\x82\xf3v\xe0j\x186c\x05\xa54Z\x12\x82\x01\t\xe6\xff\u007f\u007f\xf99G\u007f\xf4[l7%o\xecOyxr\xc5r\x19p\xda0D\xa1\x8f\x81/\x17K~q~·W\xd7,\aOa4oO\x1a\xa6\xce% \x12\xe8B\xc0\xba\xc2~\x05\x9b'dΥ\b\xfdr\xc5\xd1\xe1\x0e\x1f\xbcs\x9f\xbf\xf8\xe5#\xdan\xa0\xef\x03\x05\xa9\x0ew\xc6\xdcP7n\r\x98Z\x1f\xb8\xecz\xfe\xc9w\xdf\xe5;\xdfx\x85\x8bY\xcf\x0f\u007fr\xc2\xf1\xf3k|\xf4\xcc\xfc\x8cUXn\xfa\x85\xed\xca\xf1`l\x98\x14\x9a\xfd\x91\xa1\xc9|\xdcBkF&R9C]Z\x8aRS8M\xd38\x9a\x89\xa3p\tf\x89A\x18\xba\x98\xf9^\x89\xbb\xa5\x94ɅEN\xecQ\xf0>2\f\x91U\x1b\xb8Z\xf4<\xbf\xe8Y\xf6\x9e\xc1\xa7\x11\xb5\x0f\x8a\xc3\xdaQYͬ\xf7\xf4AQ\xda\x02\xbbԜw]\xf6\nل-\xad\x14֤\x06\xf3_\xfe\xe2\v\xfe\xcb\xdfy\x87\x83\xad\x9a\xca\x19\x8e\xcfg\x9c^.pJ\xe3c\xe4\xe7\x97\xe7\fAh}\xc4\x1aýQ\xc9^YdD+\x85D\x91\x88\x15\x95\x88T\x1aE\x88\x01Q\xb7\b\xc6\xdeS\x02\x1f|\xed\r~\xf1\xf0\x84\xf3\xf9\x8a\xdeG\x82\x16\xf6\xcbT1\xc4\x1c\xb5\xd7\u007f\xbc\x17^\xce[\xbe\xfb\x8d\xd7\xf8\xa3o\xbe\xceբ\xe7\xaf\u007fr\xc6\x17_\x9c3\xf8\xc0\xdcϸ\x18\xce6\xf0\xfbNm\xb9;2\xec5\x96\xa3Q\x1a\xb9\x1a\x84\xca\xc0\xb8\x84\xbap\x94\xa5\xc1ZEY\x18v\xee\xd44#\xb7iɕJ\xd5\x1d@\f\x91\x18\x84\x98g\xeb:O\xea$\x0f\xa2\\\xd4\xd4\xc0h\x1cٞ\x16L\xc7=\x8f_.Yt\x81\x89\xa4\x04\xbf\xe8\x04\xa5\fN\v\xd7}d9\xc0\x9d\x91C+8m\xbb\rya\x93\xe73Q\xe3ыs\x1e_\xceye{LS\x16\xdcۙP\x85ȓْY\xefi\xbd'J\xf2\xf2\xc6\x1a\x8eJ\x8b]G\x97|i\x14yb\xa8\x94\"J\xa4\xeb\x02\xc6\x1a\xb0\n\xa5\x04\xa5\r}\xbb\xc2\xeb\x92\xfb\a\xdb<<\xbdF\xa4\xa3\xb6_\x9d}ĸ\xe9\xc79_v\xfc\xf6{\x0f\xf8\x8f\xbf\xfb\x0eC\x84\xbf\xfd\xf99\x1f\xfd\xe6\x84~\x18\x98\xf9k.\x86\vD\"J4\xfb\x8d\xe3\xceȰSj\x0e\x1bMm4\x85\x11&\xa5\xa6)\x13\xb2k\x8c\xa2(4\xa3\xb1\xa5o-/\xbfp\xbc\xfd\xad\x8a\xad\x83\x84\x1e\x88\xcfI\\ \xf4\x01\xdf'\x88]⚀\xb9\x9e\u007f\vq\xfd\xe0ZaJŝ\xfd\x9a\xc9\xc8q|\xb2\xe2\xe5u\x8b1\x8aq\x05\x97\xab\x88Y\x99\\\xb5\x05\x16\xbdp\xa7q(\x05/\x97\xdd&(\xdc&T(\x1d\xf9\xf5\x93\x13\x1el\x8fPFa\x9ca<\xae\xd8\xea\x06V>\x10c\n\xc1C\x84ݪdd\xdcW¼d\xa8\xc9\xfc\xf7\xff\xf8\x1f\xfc\x89R\xcav\x9d\xffJ\x15a\xac\xc3\x16\x05\xa2\f?\xfe\xcd\x13~\xf9\xe8\x05\xcfϮ)\x8df\\\xe4\x99\xc8\x060K\xbf\xb6\xe8\x06^\u007f\xed\x90\xff\xe4;\xefЌ*~\xfdɜ\x1f\xff\xec9]\xefY\xc5\x19+\xb9Ƙ\x88D\xc5~\x9dr\xc6^\xad\xb9\xd3\x18*\xabh\xacbol\x99\x8e,e\xa1\xa9JM\xd3XƓ\x82\xe9^\xc9\xfc\xcc\xf2ӿ\x84G\xbf\n4\xcepp\xcfR\x8c\r\xda\x1a\\i\xa9G\x05\xae\xb68gSe&7\xd3I\xa5oJ\xe35z\x00$\xf6Kc\x19\x95\x86\xc5\xca\x13b\xa4.\r\x85\xd5H\x04M^'\bBm-A\xd2&\x80\xbe\x15\xa6\u05efy6_qg{\xcc\u07b8B\"\f\x02\x0e\xe1j\xd5ӆ|i\x80\xfd\xaa`\xaf(RC\x9aM\x12b\xc4\xc7\xe8m\nM!QD\xcdڅ4\xae\xae1\xae\xe0\xe4r\xc1\xa7\xcf\xcfy~\x96p\xad\xaa*0kvy\x94M\x0e\tQ\xb8w\xb4\xcb?\xfa\xd6[\x8c\xc65\xcfOz\xfe\xeeg\xcf麁\xce\xcfY\xca\x15B$\x04a\xe24\xf7'\x8eI\xa9ث4#\xab\xa8\xadbo\x92=\xa3Д\x85\xa1l\f\xa3\xb1\xa5\xac\x1dE\xad\xa8JE\xa1\xe0\xe4\xa5\xf0\u007f\xfc/+\xbe\xf9\xab\xc8\xef\xffӊ\xfdw-J\x83D\x85+-4໒n\xdeѷ\x03\xa1\x17\x82\x8f\xc45\x95GGbT\xa9\x19\x8d\xc9(ӱ\xe5\xed\xfb#\xbex\xbeb\xd9y\xc6NQM\x1d\xc5\x02P\x81\x10<C\x14\xee6\x8e!Ffð!Q\xa4\xb9`J\xca?\xfc\xf8)\xf7'\rE\xe9\xa8\xc5a\x18\xb1\xbf\xea\xb9hg\xa9\x1a\xe4\x86\x12\xeb2\x83qM\xf4\xee\x10\xcc\u007f\xf7\x0f\xff\xf0O\xdan\xb0*\xa3\xa1Z\x1bLaqEI@\xf1\x93O\x9e\xf2\xcb/\x9e\xb3X\xb6X\xa3\xa9\xad\xc1lb_NH!r\xb0\xbf\xcd?\xf9\xee\xbb\xec\xef\x8c\xe9E\xf1\xa7\xff\xf6\x98\x17/ftq\xc1<^\x82\x02\x1f\x02\x16xs\xb7d\\(\xf6+M\xed\x14\xd3R\xf3\xca݆\xfd\xc3\x06k\xc0\x19\xc5d\xab\xa0\xaa-Ei\xa8\x1a\x8b\xb3\x86\xee\xd2\xf0\xe2s\xe1`Z\xf3\xf6\xbdWi\xafJ\x9e\xfc*0\xcc\a\xb6\x0e4\xe3=\x03:1(\x8d3\xd8\xd2b\xac\xc1\x18\x8d\xcd\xc9^\xe7S\f>\xe5\x1b\xd9ܩ\xd4o\xecL\nT\x8c\xe9\xa0\x14T.Uu!\n\xab \x9b\x8ez1D\x86LQ%\xb3\xd7\x13\x8bR\xd8W\xd04\x15e]\xa4\xd76\x9a\xcb\xf9\n\xad\x14\x85Ql\x156\xb1\xebU^\xfb\xc8\xfdS\x17\x827\xff\xfc\xf7\u007f\xf7Ob\x14kl\xa6\xd8\x1b\x8d+\v\x8c\xb1\x9c\xcfZ\xfe\xf4\xef>\xe5\xe2zA\b\xa9\xd93Zm\xd8\x17\n\x18B\xe0p\u007f\xca\x1f\xfd\xce\xdbL\xc65[\xdb5\xdf\xff\xd1\t\x9f}zF\x1b\x97,\xe4\x02\xa5!\xc4\x00\x12yc\xbbdR*vJʹ\xd4l\x97\x86\xbb{%\xaf\xbd\xbb\xcd\xdeQ\xcdt\xaf\xa2\x19;\xb4\x80\xb3\x8a\xa244[\x8e\xee\xda\xf1\u007f\xffρ\x8b\vX\xad\"\x124\u007f\xf8[or\xb4s\xc8\xeae\xc3O\xfe\xf2\x9cr+p\xf0[5z\xafB\xb7\x11\xa3\x15\xda\xea\\u\x81u\x06\xebn6\xaa\xfc\x10\x13\x1c\x9f\x81\xc5\x18\x05g\x14Mi!\xa6\x9e\x06\xa0p\xa9\f\x1f|d\xe9#\x06E\xed4W]\xbc\xa9\xba$\xaf\x14 \xdc\x1d\xd5X勺FXc\xa8KǢ\xed\x18\xfa\x01g\x12\xe2\xb7m,N\x9b4\x16P\x8a>\x06\x82Do\x87!`2\xec\x81\"/\xd1\x18\xa2(~\xfe\xf0%/.\xae\tk\xac\xde\x18\x82R\xb4Q\xd0:\xd1av\xb7\xb7\xf8\xce\xd7_\xa3*\v\xa6ӊO\x1f/\xf9\xf2\xe15\x9e\x9eN]`m\xe6|D\xe1\xfe\xa4`R*j\xa3٫\fc\v{#ø\xb1\x94\x95I.]\x1a\n\xa7\xf1\x06\x8a\xb2\xc0Xp\x85f2\x8d\xcc\xcf\x03\xb3\xb9$\xc2\xd9\xc9\t\xd2\xc3\u007f\xf6\a\xef3\xbd{\x87\xc3;\a|\xf8\xbf}\xcag\u007f\xba\xe4\xc1\xefv|\xf0\xdfn\xa3^\xb48\xa51\xb9\xe9\xf4}@\x1b\x8dΆq\xc60\xbb\xea\x88\"X\x978h!\bEa\x98\xd4i*z\xbd\x1cX\xf5¤\xb6ܝ\x04\xa2(\x8e\x17\x9e\xda(\x8eF\x05O\xe7m\xa6\x9e\xcaf\x0fe\xee\x03\xbb>\xe2\x87\x01W\x14\x18c\xb8\xbf\xb7\xc5|\xd9\x01\x89Pw\x15<\a\xda\xe22\x9b$\xadO\x80\xf9\xe7\u007f\xf8{\u007f\xa2\x8d\xb6\xa9D\x04W8\xac+xz\xbe\xe0\xff\xfa\xeb_\xe1\x87@\b\x11k\xd2\x03\xa8D\x8cEkÝ\xfdm~\xef\xfd\xd7\xd9\xd9\x1e3ݪѮ\xe0\a?x\u038b\xd3\x19W\xdd\x19^\xb7\x1b.\xd4N\xa1\xd9\x1fYJ\x05\x0f&\x86\x9d\xdap\xef\xb0f<\xb2\xa8\x98`\x91\xa2\xb2X\x97\\\xbfީ\xa9\xa7%\xc5\xc8RN&4\xaf\xbd\xc2t\xeb\x15>\xf8\x83\xd7\xf8\xde\u007f\xf4\x1a_\xfb\xd6]\x82\x04\xba\xf3\x0e7(\xb6wF<\xb8{\x88\\\x17|\xf2\xc3%G\xdf\x14ꯍPmH\xbd\x89V@Bw%\b\xda\xe6^\xcaZȆ\xb0\xcen\xcaYc`\xe8\x026O>\xd7+\b\xa5\x16\xe6}\xa4\x8dPjE\x1b$1\x1a\xf9*\xb1\xfbnS \x02uS%/\xb3\x9ae7\xb0\xeaӄs\x19\x03\xb5\xd6\xec\x95U\xba0)\x0f{\xabr\xd9+\x8a\x84\xe6\x1a\xcbj\x10\xfe\xe2\x17_\xd0w>\x85*\x12\xfdҘTM\x18cx\xe5p\x97o\xbfs\x9fIS3\x1e\x95T\xb5\xe3/\xff\xe6\x98\xcf\x1f\x9d1\xeb\xae\xf1j\xb9\xa9D&V\xb1W\x1bJ\r\xf7ǖ\xedBstXs\xef\xc1\bc\x15*$\x9a\x8e\x9f\x0f\x14\x95\xa5\x1c9\xeaɄbk\x8cn&\xe8\x9d\xd7a\xb2ŷ\xfe\x1b\x0f\x17\x02V\x811|S`\xfehƓ\xff\xfd)\xbf\xfa\xe1\xe7\xdc}\xf3\x80\xc3\xfd-\n\xab\xf9\xfc_<a\xf7[\x02\x87\xf5\x0f\x8eA\x00\x00 \x00IDAT5\x9c\xb7\xa8\xbc\x1d\x15Cf\rvi\xf0fK\xa8(0}\xa0k=\xc6h\xbc\x04\x8a\xc2RW\x01Y\r\x8cjC\\\xa5Ki5\xbc\xb1\xed\xf8\xf9\xcb\x0e\xa3\x15;\x95e>\xf8\x04\xa9\x88\xa0\xb4p\xbaZ\xb1\f\x13\xc2圢*\x98l\x8d(\x9c\xe1\x8d\xc3\x1dV}ϲ\xed\x11\x81'}\xc7\xeb\x93)\x13\xa7\xb9\\\x06\x9a\xb4\xb5%\x19\xa1Q\x98¡\xb4\xe1\x93\xe3s\x9e\xbc\xbcHˉ>\xd0Ԏ\xedI\xcd֨b:ix\xf3\xde\x01\xdfz\xeb>\x851\xf4~\xe0\xe2r\xc6_\xfd\xf8)?\xf8\xd1S\xae\xdb\x19\xbd\x9a!*\xc5\xe6\xb1\xd5l\x97\x8a\xc2\b\xfb\xb5fZh&\x95fw\xb7\xc4ZM\xe1\fUc\xa8jK5-)\xc7\x05娡\xda\xdb\xc3\xee\x1d\xa1\xef|\x1d\x9aI\xea\xc0&EfH&\xc2\x05Z3~g\x87\xf7\xfeǯ\xb3sT\xf3\xf8\xe3\x17<~tJe\x1c\xcd\xd5\x0e\xdd'-\x8c\x1cܩaR\xa2Ǝ\xa2\xb2T\xa5\xa5\xaaR\xec\xb6\xd6`\xacN|\x82\xd2P\xd8\xdc`F\x18m\x95X\x05\x8d\x01k\x12\x8bŋb\xec\x14w\xc7i\xf62-\xccfJ\x9a\xfa\x89\x88\x0f\x91'\xf3\x15F)\xce^\x9cӵ-J)&Mɤ\xaa\x12\xbe\x16#\xad\x0f\xb4!R\x97\x8e\xca\x1a\xaa\xb4l\x97H\f\xcah\x8cut\x01~\xf3\xe4\x84!D\xfaa@+(\x8d\xa1t\x96\xbd\xad\x11\xefݻ\xc3\x1b\x87\xbbt\xbd\xe7\xfcz\xc9/>~\xca_\xfc\xcd#~\xf4\xa3c\xa2\x04\xa2j\x11\xd2\x14\xadҊ-\x976_\xb7K\xcdQmR9Yj\\\xa1\xb1NS\x14\xa9H(*G3-)+C\xbd\xb3\x83n\x1a\x98>\x00g\xc1\x19p\x0ev\xcat\xc0֦\xff.\v\xd0\x16\xb5S\xf1\xc6\u007f\xf5&\xc6\xc3b\xd62\x9b\xb7l\xc5\x1d\xae\xbfo\x90\xa0\xa0)a\xaf\x86ơG\x0e\xeb\fUU\xe0\xb2\xc7[\x9dx\xca\xdaj\xacQ8\xa3\xe9\xfb\x01g\f\xf5\xc8Q(\xa1.R\xb8\xd3:!\xc5\xd325υ\x81\xdd\xca\"\xa26\x8b?Fk\x9e\xcc\x16\xacB*\xab\xcf^^e,\f\xde<ڡ\xcap\x95\x8f\x91>\f\x8c\xac\xa5q.}\x0em\f\xa2\xc1\x18\x8bҚ/O\xafyrz\xc90\xa4\x15\xb7\xc2Y0\x8a\xba.؛N\xd8\x1aW\x88\x82'gW\xfc\xf9\xcf?\xe3\xaf~\xf9\x05\xbfz\xf2\x8c\xcbxJ\xa7\xae\x89j \"\xd4Z1q)N7VsX\x1b*\v\x95\xd3\x14V\xd3^\xf7\xc4Χ\xd1f\x88TӒzo\x97\xfa\xe0.f\xb2\x05\x93\x03\xb0U\n慁\xb2\x82j\x02\xcd\x18\xb6\xa60*ҵ-,hM\xf3\xc16\x93ݒ\x02\xc3\xd5Ւ\xa6\xae\xe8\u007f\xb3M\xff\xa5\x87\xdaBe\x931G.\x1d\xbcN\x05\x84\x84\x80+M*\x89Q),\x99\xc4XY\xad\xfa\xb4Ƈ0\xb67s\x91\x18\xd3<\xbe\xd0\t\x18ݮ\xf2\xb2l\x1e\x1d\a\x11:\x1f9i;*g\xf1\xab\x9e\xeb\xcb9\xa2\xa0v\x86\xb7\x8fv\xd30O\x84+?P\x1b\xcbH[\xa6\xb6Ģ3\x82i\r\x83h~\xfa\xf91\xab\xb6\xa7\xedz\nkpN\xb33\x19q\u007fo\x9bq]2\xef=\x1f~\xf1\x8cg'W\x89\xe9n\x12^\xd4K\x8b\xa2M\x1d\xb1\b\xa5V\x9bM\xac\xfdڱ]h*\xabpN\xe1\xac\xc6Y\x85\x92Ȱ\x18(\nK\xb33\xa2\xda=D\x8d&\xe9\xe6WӔ+\x9c\x81\xe6\b\x8a\a\xa0J\xf8Z\xceL\xfd5<\xfdM\xda\xd2\x14P\xfb5\xe5A\xc9\xea\xf3%\xc6h\xae\x96=\xfb\a\x87\xcc\xff\xe5#\xca\x0fB\xaa\x1a\xc6\x0e:\x8f\xaa\f\x84tټN[WEi\x18:\x9f\x81bA\x13\x89Aa\x9cNPK\x10\x1a\v3\xad\xe8\x04\x9c\x86\xdaif}B\xa2\xa7\x85\xe5\xac\x1b\xb0&\x8b\x05(8]\r\xbc\xbfmiJ\xc3p\xbd\"\x8c+\x8c6\x1cN\x1a\x86;\xbb|r|\xcaI\xdf\xe1\xd7#jc\xd1\x12\x13\xac\\\x14%\x1f==\xe3\xc5Ō\xe5\xaa߬\xea6U\xc9+\a;\x14\xd6\xf0\xe5\xe9%\u007f\xfd\xf3Ox\xf6\xe2\x12%ɵU\x1e \xe9[36\xa7aZ\xa5>\xe3\xb06\x1cֆ\xc2&L\xaap\x86\xa2\xd24\xa3\xb4\xf0\xe3*G9)\xa8\xa6S\x94+\xc0\x15P\x8c\xc1\x96\xa0\r\x94\x13(_\a5\x06\\\xe6\x94\x18(v\xe0ޛ鯬N\xa1\xe8~M\xd7%\xf2\xc1\xfcjE1\x1e\xc1\xf1.\x1c{p*y\xc9\xd8%\x8f\xc9(\xa16k\xeeY:\xfc\xcd敀\x8a1\xed\xec\x1b\x8d\x97\x98\x11\xe8\x1b(\xb5\xb2j3<۩,\xfa+\b\x97p\xd1\xf5\xac\x82\xc7(M)\x86\xd0\xfatV\n\xeeN\xc7\xecO\x1a.V\x1d\x1f]\\\xd04\x05E\xeaK\x14\xce\x15\x04\xa5\xf9\xf5\xe3\xe7\xb4mO\xd7&dRi\xc3{\xaf\x1cQ\x17\x96G/\xce\xf8\xf0\xb3\xc7,\xda>Weq3=\xff\n\xfbJ\x84\xc6*\xde\x1c\x17\xbc2*xs\xcb\xd1X\x95\xa8\xf6FQX\xc5\xeea\xc3hZ\xd0lU\x94\xb5\xa5ڮ1u\x9d\xf2\x821`\x9b\xf4\xdd\x19(w\x81\x8a\xbf\xf7O\xb1\x03\xa3qJR\xdaP\xde\x1fs=\xef\b\x01V\xf3\x8ea\x10\x9c\xdaa\xf8\xa8L\xa8\xa2\xc9\x1e7vYT\xe0\xc6\x18\x1b\xb6\xfc\x9aybTb\xba\x0f>5n>\xed\xc9\x14:ɂ\b9Z\xe6\xbd\xc7\xdaj*k63\xdf \xa4<\x1cS=\xa2\x844\xaa\xccchk\xa0v\x0e\x01^,[\xb4\xd5\x14\x85E\x1bk)\xeb\x82O\x8eϘ-;\xe6\xb9\xd1\xd9\xdf\x1e\xf1ݯ\xbdʤ.\xf8\xe4\xc9\t\x1f\u007f\xf9\x82\x10\xf2(4[Y\xab\xcd>\xebWXXUfzk\x9d\xa6~F\xa5\xae\xdb(E\xb3\xe5\xd8>l\xa8\xb6\n\xaaI\x81u:Ie\xd8<87.y\x89I0\bv\x87\xff\xe0\x1f\xe5Rh\xcb \xa2\x1b\x95\\-V\\-ZV\xdd\xc0\xecrN\xb5=f\xfeoǰȺ\x1aVCcQ\x85A\x1b\x85+L\x86\xeeS\xf3*!a)\xc6Z\x8cR\f}\xa2\x8eJ\x9e2\xa8lӴ=\x955[Hd\xf4f\xdd`\xafU&\xb4\xa2\xb6I\xbc\xc0i\x8d\xca۬k\xa3ׅ\xc5d\xf2\xfdb\xd9QT\x16\xed\x9cc@\xf3ٳ3泖\xae\x1b\xd8\x1a5|\xed\xb5#\x9a\xb2\xe0\xe3''|y|\x96gђ\xc9\xd5\x1ac\xcdf\x11\xff\x86z#\xb8L\xa6^\x05\xc1\xa8\xdc2\xe8\x1b\x18]Eaq\xde\xd2]\xf5\f\xf3>\x87\n\x8b\xb2E\xbaF\xae\xbc\xd9U6\xf6?\xec\x1d\x1bv\xa2M?\xac4\xd2\a$*^\x9c\xcfX\xb6\x03/\x9f\x9c\x13\x86\x1ei\xc7\xc8\xd3\xe2\xb6\xd8I\x1a\xceg\xf6e\f\x91u\xe8N\x87\x9e\x0f\xcd\x1aB\x88HL϶\x96\xcd(L\xaa\xa8b$O<\x05-P畂\xb5Q\xba\x10X\xf9\x1b\x02\x9f\n1\x13͓W\xee4%\xc6\x18\xba\xe0\x99\xaf\x86\x84 \x18c\xb9\\\xf5\x9c\\ι\x9e\xad0Z\xf1փ\x03FU\xc5\xe3\x93s\xbexz\xbaqK\x05y\xd1\xf3F\xc7c\xaf.\xd9)\r!\x06f~\xa0\x1d\x06D\x84\xd3\xd6S[\x03*IaX\xadp\x85F\a\xf0\xf3\x01\x1d\x85\xb20Xg\x13\x99\xbbjR\x05e\xeb\x8drOB\x02\xcd\xff\xbfA\xfc\x90\x909\xad\xe8/\xfbDX\xf3\xc2\xd3\xd3k\xe6]\x87.\x14\xaf\xbd{\xc0p\xe2(|HaK+(\xd2\x1a^\xd2\xfd`S\x96\xaa\xbc\x84t;\x94e\x18ns\xf7\x8dNv\x8d$o\xe9\xa3\xcaЉ\xda\b\xf2\xac\xcb\xdae\x86\xea\x03\x10\x86\x80\t1-\xe7 \xd4e\xc1\xa8t\f>o\x90Y\x85\xb6e\xc1\x8b\xf3\x19\xa7\xe7sڶ\xe7ho\xca\xd1\ue50bق\x8f\x1f\x1d\xa7\xd9t\xfe Zk\x9c5ؼ\xed\x83(\n\xadٲ\x86\xbd\xc2p\xaf.htR\x80\xa8\xad\xcaIOau\xea9\x8cR)\x89jpVclB_\xb5ѩ\xdf0.\xddx\x93\x9a\xbe\xbf\x97:\xff\x95\x845\xc0j\x9e顑\xfe\xb2KB\x03J\xd1\xf6\x03\xc7W\vNί\x18\x96+\x96\xbfа\xca#g\xa3Q՚٨\xd0\xe6F\xbeIo\x00\xc94\x1e\x96 \xc9Kn\xab\x9aH\xfa\x88\n(\x8d\xa6\xb0),+-\xb7X\x92\xff\xde:;\xe2#\xc3\xe0Qy\x8a\x998q\x8eV\x02\xed\x10\x18\x86\x88-ʒ\xc7/ι\x9e-)\nÛ\x0f\x0eYv=\x1f><\xce\x1a\x1e7\\]k4\xce\x19\xb4\x82\xa0R\xd5q\xd2v,\xfc\x00*\xa0\x05Lv\xe9\xc2\xe8\xc4.\x04LN\xe8\xb7=L\x9b\xf4\xd0\x1b\x9d\x11\xads\xdeȁz\x93\tg\xc0\xee\xdfo\x90\xe0a\xd5Ð\xa2\x96_\x0e\x1b\xe6\x89\xd2i{\xf8\xc5\xf9\x828D\xc2\x13E\xbc\xb4\xe8\xfd\b*@\x91\x9f#J\x1a98\x182aM)0NA\x97\x16\xe2b\xcc乐\xf6\x9f\x94\xbe\xb5\xf1\x92gz\xa5\xd3\xd8A\xbe\xc2z\\\x179\x9b\xddK\xd6\x1b\xbdI\x17\xc0\x9a\x14\xfegٓ\xae\xafV\xe8\xd5\xe0yx|N\xdb\x0e\xdc=\xdc\xc1\x1a\xc3\xe3\x97\xe7\xacVݍ1\xb2\xfb:gp\x85\xc19\x8b5\x16\xa3\f!\xc2b\b\\\xf7\x91\xf9\x10\x18B2ܚȶ^\x11X땘\xcc\f1*\xcf&6\x1a31\x19$C\"\xe9\x17\r\xc4\xe7\xc02\xcb\xc2\xc4\u007f\u007f\xd1DR<\x88]`~\xbc\xdc\xc0\xe1!\xcf7\xbe|9\xe3\xd9\xc9\x05\xd2\x1a\xe2\xa5M!0\xedL\xc3f\u007fe\xcd(\xb9\xa9M\x8c\xd6\xc9\b\x92w\x14\xb5\x10C\xfa\xdc&\xe7I\xd9\xe4\x95\xfcL\x1b\xc1\xb3\x9bI\xa2\xdc\x16\x0e0\x9aBi\xacMM\xb8\xb5i1\xa9\r\x81YL\x04\x12}|vų\x97\x97\x8cF\x05\xaf\x1c\xec\xe2C\xe0\xecr\xce\xed\x97\xd6\xf9\xd9\vg\xa9\nK\xe1L\xdaN\xda(䤉\x99Q\x89\xb4\xb2Fx\x93\x1b\xdfp\xabT6\x82\"\x9d\xb5&5\x91\x8a\b\xa1g#R\xa5\xf5-6K\a\xf21\xf0k\xe0\xf4\xab\x84#\x13\xa0q\xa0#\xfdg\x97|\xfe\xb7/`=\xeb'\x01\xa61\b?\xfe\xf8\t\x17\xe7W\xf4\x0f\xfdmf\x04\xda\xde\x1a\x8e\xaf\x87\x1f\u008d\xe8\xd2F\x8dH\x11#\x84\x98\x96\xd2\xec\x86|\xb7\xde\xea\xbd\xe9\xe0\xf5-\x16N\xd8\xf0\x86\xd3\xfc_[MU䭯\\r\x97E\x99\x84\x19BH\xa2g\x9f=9a\xb1l\xb9w\xb0K\xe5,\xa7\x97\xf3\xd4\x18\xde\x12fQ:\xa9\xa5\x95\xd6P\x16i}\xc1jKҲI\xb0vJ\x889\xe1%\x95\x95\xa4U\xb5&\x16\xa8\xdb\\\xd9\x14\xaa\xd2\xd0Q\xa5\xac\xd9/\x80\x81\xcc\xd3\xdf\x10\xee2\f\r4\xc0\x0e\xd0\x02s\xe0\x02\xb8Jp\x88\xd1\\\xfc\xf8%\x17\xb3\x161\xeaf\x97\x0fAY\xcd\xf3\xb3\x8e/\x8fϙ\u007fإ\x83\x0f\x99\xc3U$\x89\x06\x11\xb0\x85\xbdY\xbf\x93\x94;\xd6\xd4\xd5\xe9n\xc3ݣ)G\x87c\xea\xda%!\xb25\x89\x82,\xcdt\xab\xf0\xb9M\x89Z\xcbk\b`\n\x9b\xd0\x0f\x9bf-1\x0f\xbabV]\x8a\x02\xf6W\x9f\x1fS\x97\x05\x87\xdbc\x86 <?\xbd\xda\xe0\xfa\x90:\xd9\xf5\x8e^a,\x95\xb3(\x11\xfa.\x87xI \xdbF\x8e!\xbf\x91\xd9H*\xa5\xbc\xa1\xd7*p\x99ީ\xb5J\x0f\x1d\x05\xa2\x82\xd0Ah\x81>A$k\xe5\x19\"\x88\x06\xe5\x81\xcb\x1c\xba\x86\x9b\xf0U+\x88\xc2/\xfe\xd7O\x89V\xe7\x19w\x0e3\xa2\x10\thm\x80\x92\x93\x8f\x84\x83KP[\x191v\ti $\xb5:WX\xfa\x95\xdf\xf0\x05$\xeb\xc08gQFqX&Y\xc3\xcbEO\x1fS\xf3\xb7\x1e\x01\xeb5s\xee\xd64UD\xb8\x1ezBL\x1a,\xda\xe8\\\xf2\xa6=\x9b\x10\x02UU\xa0\xb5\xa2\x8d\x81A\"\xfa\xf1\xf19\xa5K\xe8\xe3\xd9\xf5\x9cŲ\xbdaf\xe4q\xadS\x1a\xa7\rM\xe1\xa8\vGU8\x8c\xb6\xd93tf\xf1\xa9\xcd^\xb8\xcf\xd6\xee\xf2`G\xa9\xb5\x9c\xdef\x91*ݾ\xa8\x10\x1fa\xf0\xd0w\xb08\x85a\x062\xe7+\xac\xe9\r\xed\xb9\xcd_\xfd\xe6\xd81%\x17\xff\xfaK~\xfd\xab\x97\xd8\xd2fe\x84\x98ofDT\"\xc8ͻ\x9e\xebs\xf0O%\xc1(*%9\xe3L\x1aZňs\x86(\xe9C\x8b\xa4\xa6Pi\xcd\xd0{\xe6\xf3\x9e\xf9\xbc\xe3\xe2\xaaŋ\xd0\x062i\xe2f=)f\xe5<\xb5\x11&H\rb\"2\xa4J3\x92\xa5\ns\xff\xe2\x8a\xc4\x17 K\x9bh\xdf\a\xa6\xe3\x06m\f\xcfO.7\xed\xa8\xcaI\xc9\xe84+pVS:GS8*gr\x023\x1b\x04K\xa9\xb4s\xa8фp\x13\x8e5\x8a\x18\xe3fk \x85a\xc9\x15W\n\rx\x81\xab\x15t+h/!\xccA\ry\xee\xb1~^\x9f\x8dq\x96\xff}}\x10\x03'\u007f\xf3\x05\xe2\x920X\xc8j\xa31\x8fU%\xf7\x03W\x8b\x96!Df\x1f\xe6\x02\"\xe7)\xedR.\x8c1\xed\x88\xdc\xf4\x11\xc2\xd0\x0fD\x11\x06\x1f\xe9\x87\xc8\xd5|`\x91=\xe8V\xfb\x92\x93bz\x8cx\v\xb3\xd0Z%:Q\xd6\xfc\xb36\xad4\x88\x82\x10\x02!G\x12g\rס\xe7l\xe8\xd1\xda(\xb6\xc65\x97\xb3%\xd7\xf3զR\xd0Y1\xc7\xea\xe4\x1d:\xc3\xcdM\xe1h\x9c\xcd\xf2\xb0\x1aDc\x95\xe6\xb0r\x1c\x8d\x1d\x85M\t\xac\xf3\x91\xd6G\xd6)B\xb2\xd2P\x8c\xb9ɒ\x1b\xe1\x00\xe9\x03\xf4\x1eN\xaf\xe0\xf49\xcc.\xa0?\x03\xfdﲆ\xfb\xac\xeb\xb9&\x99-\x81s\xee\xfdф\xcaꍸM\xbc%˺\xe6\x85\\\xcd;\x06\xf1\xbc\xfcELvu9\x8fd\x80t\xcdDQ:\x13\xa9\xf3X\xb7\xef\x02m;\xb0h=\xf3ŀ \f>\x95˹\x15\xcb\xe3\xddu]p\xa35紦ȹ%5\x9b\xa9\x82L\xbb*\xebp\xae)\x9d\xe5j\xf0\xfctq\x81.\x8b\x82\xa2p\x1c\xbf\xb8̉6ˣ\xaat\xd0Nk\x9c\xd1\x14ZS\x18M\xed,\xa53\x18\"\x1a\vb\x98d\xbd\xab\xebN\xb2VU\x9a@\xf61ݤ\xa8\xc0\a\xd9\b\a\x04\x9f\x93\xe6\xbal\f\x11\xbcOߗ-\xbc<\x86\xb3g0\u007f\x0e\xd8[\xdb3:\x1bd\x05<\a^\x023\xc6\u007f|\xc4\xfd\xa3\"+\xea$\xa9\xdbͱHD\x94b\xbe\x1a\x98\xf7\x1d\xd7'\x92\x9c\xcc\xdc,\x98\x18\x9b\xc2G\xcc\xd0\x10J'\x9a\x90\xc00\x04\xe6\xf3\x81\xebˎ!\xf3}g\x83PY\xcdk\x93\x82WƎ\x83ʢ%\xad\xab%\xb6}\xba\x80e\xe6!\xc4,\x93\x18c \xaa\xec\xc5$^\x02J1\xaa\x8b$S(\x82\x9e\x8c+\xdan\xa0\xed\xfa[\x8a\v9\u007f\x98\xdce\xeb\xb4F촦*\x1c\x85sX\x02\xce\x18\x8c2\xcc\xfb\xc8\xe3y\xc7\xd5\x10\x12\x01,\x83iQR\x82WYYg3\xe0\t\x91\xb8\xa6\xdf\xc4\\i\xf5\x11B\xc8Q\xa8\x83\xeb\vx\xf1\t\\~\x99c\x81\xc0p\r\xb3_\xc2\xf5/\xc0_B\xec\xd2׃\x1d\xde\xfdg\a\f\xbd'\xe4\xb5\xfd\xdb\"\xc6\x11\xe8}L\xfb(\x8b\xc8\xfc\x13\xb9\xa9W\x9dN\xcaw9\xa4\xa6\xaa8\xcd\xde\xd7@A\\\x87\x19\x81\xa5\x17\xfa\xa0\xd8.\xd2\xc0m\\\x18\xee5\x96\xda&\xc2í\xfd1\xaa\xac\x05\xb6\x16L\x0e\xf9\xc2D\x91Mo\x12E\xa8\xac\xc3\xd8D\xb0\xb0\xe3QM\xbb꿲\x15\xa6u\xa2\xa5\x98\x1c\xb2\nc\xb2\x84j\x82\x98C\b\x10=#gY\x0e\x96 \x06\xa5¦{-m\x82\x13t\xf6\x12A\xe1C\xf2\n\xed\xd2\xc3\x0f] 6I\xd4k\x1d&\xf0\xf9\xab\xb1\xb0j\xc1\xf7\xd0\xfd\x14\xb6\x9f\xc1x\x02~~\x13\xbd\xbae\x9a \xda\x12\xca\x19\x0f\xfe\xeb=&\xff\xd3C晽\x1f\xe5F\xfaH\x10\xac\xd1T\x95\xa5\x1f\"'\x1f\n\xe3oo\x94\x93ѕ\x81U@\xb2\xaa[\xc8JF!/\xda'\xe1\xcf4l\xeb|\xc4\a!:MްΊB7\x02\x9b\xeb\x0e\xbe\xb6\x16\x93\x0f]e\xd1\x00l\xceW\xa4\xea\xd4\xfbHa-\xef\xbfz\xc8\xdev\x93\xf4\xb2fk\xef\xc8ݪӆ\xd2ZJ\xa33\xfd's\x9b$\xf5\x16\x8b,\xddZ;\xb0\x94\b\x1d*\t\xf3\x11\x01\xab\rF\xa7j\"\xa2\x18\x92\x0e\v]\x1f)\x8b\xe4\xa61@\xb7\x18(\xacI0źQ釄'\x96E\xba\x9a\xbdO\xe1kV\xc1\xce4c]E\x8a{\xdd*\u007f\x87\xeam\xcd\xeb߬\xf8\xe9\xdf.\xb3\xa0\xcdM\xfd/*-y\x86\b\xc6\t\xa7\x0f\x857\xe69\x01\x94\x1a\x15,\xe6j \xf8\x98=Y6\x1e-\xc2&\f\xb5!\xe5$\xad\xe1t\x15\xe8\xe3zq4yΚ\xab\xbb\xce\xf3M^7\xefB\xa0\x92\xb4裭a\xe8\xd7e{b\x80\x1e\ue378s\xb0E\x91H\xec\xfa\x06\xc3ɐƸ\xb0LJ\xcbvU\xb2UT)l\x99\xb4\xc0Y\xa8\x14\x17ъQ\xa9(U\x95{\x10\x9d\x95\xd6\x14\"j#\xec\x85$4ԋ\xd0{\xc1\x0f)\xcd\xc6 \xe9,\x17\x1e\xdf\x06X\x05X\r\xd9(\x01\xda>7q\xb9\x91[upqI\xc2fJ\xd89\x82\xf1V2\x90\xb6\x98\x89\xe3\xfew\xeb\xe4\r\xb5M\xfd\x90\b\xa2R\xbf\xb03\xc9\nF\x1a\x16\xb3@l\xe5V\x99\x94D\x9bђhB}\xc0\x0f\x01\xad4ui\xd9ڪؚ\x14\xa9\xf7ȪH>\x93\xa9\x97^X\xf8\x94;\x87\xf8U\xd8d\xb7,Ф\xb1E\x8b\x10\xb5\xa0\xadJ\x1e\x96\xab\xb4qUr\xffh\x17\xadSQ\xa2\a\x9fPƵ\xd2A\x9d\xf5\t\vm\xa9\x94N\xa1J%\fF\x85\x88c-h\xacq6R\xd9\x02C\x99{\x8d$\xb5\xd1\a0ʰ\x16gE\xa0\v\xa9\xbb]\xb6\x810\xc8&^\x87!0\xac\x02\xd2\x06\x98{\x98\xf57 X\xcc\xf0\xf8\x10\x92\xb2墅\x97/\xe1\xe5S\x98_\xa4'r.\x8d\xdfL\xc9\xd1?8\xe4\xe0\xee(\r\xc0\x1a\xbb\x11\xdc7Vsgo\x9cD5u\xbe;6c\xe7\x02q\xe97\xda]a\xc8\xc24}\xa0\x1e9\xb6vj\xf6v*Dkz\x0f!&\xef\x8e\xdc\xec\xed\x8b$6g\xc8\x18\xbd\x17\xe1\xfed\xc4A]&e;\xa5Q&\x11\xc1\xb5\xd6\xf8\x90\x94\x8a\n\xab98\xd8B\x9b\xd4^\xcc\xe7+t\xdb\xf6I\x06\xdc(\n\x938F\x89\xbf\xbbv\xf7\xbcϧ\x146*\xaa\xa0h\xacM\xe2\xfat\x94N\xa3c\x955\xd3S\x12\xeabHR\xaf\xe8\r\xf2\x1a\xa2J7)\xc2r\x95\x8c\xe0}\x92r\x1d\xba\x81~\xd6\x11\xaf[\xe4\xe5\x1c\x9e/\xa0\xf57#\xba\xb5\x84\x90\x8f\xd0\x0epv\r\x8f\xbe\x84\x17\xc7\x10\xfbԳD\xcf\xc1\xd7w\xf8\xdd\u007f6e2\xd1\x14UA\xb3U\xe2\x05\x0e\xb6\x1b\xca\xc2\xd0\x0e\x81\xceG\\\x13PU\x84q2\x88\xbf\x1a\xb2̽\x10\xb2\x17K\xa6\x97\x8a(\xa2\x17fKO\xc8ݹ\xcf\xf3\rb2\x88Ն合r\x83D\x0e\xea\x9a\xef\x1d\xec26\x86\xda\x18\xd4\xffW֙\xfcZ~\xa6w\xfd\xf3\x0e\xbf\xe1\f\xf7\xdcs\xc7rͶc\xb7\xbb\x9dN\xab\xe9D!\x90A\xd0\x01\x04\x19Z\xb0Aa\r\x12\x12\v\xc4\";\xfe\x13$X\xb0C(\x11R$D\x16\x91\x10\x8b\x84\xb0\x80\xb4\xc0\x90\xeevl\x97\xab\\\xe5\xbaU\xf7\xde3\xfd\xc6wb\xf1\xbc\xe7Tu\xb2\xb0l\x1d\x95\xed{\xcf\xef\xf7\xbe\xcf\xf3|\x9f\uf404W\\T\xc5A\xb7r4\xaf\xb98[P\xe6\x97b\xb3\xed\xb8\xba\xdea\xbb\xceat\xb6.\xcd]\x81՚\"\xc3\xe2Z\xa9lT/O6\x8c\x81b\x92\xc9en`ZxL\x9a\xe2\xd5V`m\"\xceC\xeb\"3\xa3\xf31\x96\xfa0x\xd8\x10\xc5\xe7\xd6zT\x8aB#\x9d\x15x\x97P\xc1aZ\x85\xde:\xd4\xeb\x16N'p\\Ce\xa0\xb2\xd9\xd6#O\x97m\x0f\xed\x00\xd1\xc3\xc9\x11\x94\x05\xc5]\xcf\xcf\xff\xcbS\x1e\xfd\x97\x1b~\xff\xdfD\xaaZS֖Ţb\x8c\tO@w\x9a\aߌp\xac Z\x86\xa7;bH\x98R3\x8e\x11\xef=\u07bf\xb9V\xfb\xb6g\xd38\xae7#\x1e\x9d\x89p\xc81۫\x8e\x95b\xe7\xc4+\xcb*\xcd/\xbdsΑ5\xa2\x0eT\xb0#`\xad=\xd0W/N\x8f\x98N+\xb4\xd1\f\xdd\xc0\xcb\xd7;Vۆ~\xf0re\xd9|:J\xad\xb3\xb0%w>y\xb8)\x10]\x86Nд=\x15\x89\xb2,\xd1\nf\x95\x17=\tStv&\r(V\xbd\xb4\x9c⦰\u007f\xd9\x15\x83W\xdc\xf6\x91m\x97p\x01\xbaƱ[\xf7\xf8Q4\x1bhP\xa5\xd8y\xb0\xeeᦃ\xcd\x00\xab\x1evN\xf0\x98\xfd\x04\x1f#\xdcl\xe0z\v\xafn!\xb6p\xbf\xe2\xe27\x97\xfc\xbd\u007f,F\a\xb3\x99\xec\xed]\xf4\fC V[\x1e~\xbf\x80R\x13?\xb9\xa5\xbbq\xd8R\xd15\x8en;\x12} \xba\x88w\x89ͪc\xbb\x1dY\xb5^`\xa0\b\x11\x9d\xed\xf3\xd5afi]d3J\x06ȃ\xc5\x11\x97\xb5X\xae[D\xc60zQ\xa6)e\xb8\xb8<a~4\x11'\xa0Վ/\x9f\xdf\xf0\xfavK\xd79\x88At\xabE\x1e\xfa\xf6z\x05\xabŦ\xb5\xc8\xde\xe4\xa5R\x14(\nmhہ\x89\xd2X\x93͓\x8bH\xd2\x1e\x15K\x81R\xf2\x03\x19\x02\xdc\xf4\xb2k\xee\\\"Čg)\xe8\x83b\xd5G\x9a.\x10\xa3\"xhv\x03\xed\xceᆘ\xf1\xad\x90\x03B\x124^\xae\xb0v\x94\xbf6=\f\xf9\"\xefG9-.\xc8\xe7\xab\x16N\v\xde\xfb\x8d9\u007f\xe3\xef\x96y\t\xa5\b\x01\xde\xff8\xf1\xdb\xffz\x86y\xb7\x80\xcf[\xd4vdqQ2\xf6\x91\xf5u\x8f\xcb\\\xe6q\x90\xb7=$\xe8|b;J\r4ZI\xe7y\x18\xa0\xe5\xefםc\b\x81\xc2h\xbeq|\x94!#Y\xf64!\b\xf2]\x15\\^\x1eS\x96\x86\x18\x02\xb7\xb7[\xae^o\xd8l;\x82\x97-\"Jc\v+f\xc8V\xe97\t\x049h\xc4*1\x9b,\x94\xa6\xd2\"\fu\xcec\xc7D\x89\"\x19\xcbL{\xe6EM\xe3\x02\x1a+\x86\x98\x99\xf1\xf2\xba\x8b̬\xe1|\n\xad\x13O\xc2BK'\x17\x93\xa2u\x89\xa2\xf7\x14ڈq\xa6\x0f\xd0D\x82\x8bLj\x8b.\xa2\xdcs\xb3R\xae\xaa\xd2\xe6\x85K\xfe|Z\nתm\xe5\xea\xb2F\xda\xe4f\x84\xa5\xe1;\xbfs\xcc\xc5\xfb-\u007f\xfc{\x03g\x0f\x15\xbf\xfa\xbbG\xd8K\x05_vpӑ\\`\xb7r\xec\xd6\x03\xedvd\xec=C\x96\xb5\x81\u0085\xc4n\x94g\u007f^Y\xacV\x84\xa0h\x9c\x97\x94\x1c4\x8d\v\xac\x06OL\x89\x87\x8b9\xefLky\x91\x928b\xf7>0\xa9\n\x1e\xde?c6\xab\x89>rs\xb3\xe5vӱm\xfa\f)\xe5\xd9L\f\xa6eke\xb2\x05\xf6\xdb\x0fe\x0f\x1d\x1a\xa5\xa9\x8c\xc5\xe4-Y9F\xceꚫn\xa0H\x8eӺ`;xJ*\"\xe1`6\x1fb\xe4Y#\x83\xcfY-]\xad*5\xd9\x1a\x85\x18uV\xbd\x06\x96\x93\x1c\xabd \x0e\x91\x10G\xa6\xa5\xc1\x8eZ\xbe\x91\xda\n\xc1\xad*\x845\x92\x90=|\xf5V\xb1\xaf\xde\xf2>\xd1\x1auQq\ufdce\xf9\x8d\x0f{\xcaw\x15v\xa1\xe1\xeb\x1d|\xbde\xf8j\xc7v5\x8a\xefa;\xb2[w\xd9\xfaO\xbe\xe8\x10\x13\xbb1\xb1\xf5\x89\xa9\xd1,\n\xc3\xe0\xe1\x8b>\xd2\xe5b>\xc6\xc4j\xf0t\xc1\x13\x14||\xb2d\xa65CxcK\x1bB\xe0у;\x9c\x9c\xcdQJ\xf1\xf2\xea\x96\xedn`\x1c=)\x1b4\xefY-?yy\x8bU\a&E.\xe6Z\x1e\x84\xc9\"\x92\xbd?\xed\xc1\xb1!\x05LPܭj^\xa45J\xc1\xe5<\xf0\xd5ڢB\x8dR\x0e\x94˷\x8d\xc1\x84\xc0W\xdbHm\f\xba\x80\xceCLb\x06Щ\x84\xad\x14ݘH\xd13\xaf\fU\xa1Q6ᓖ\xa5\x8d\rؐ\xd0.\xc8ue\x8d<\x9c\xd2@\xebaY\xc3T\xcb\xe71\x891\nZ`\x98]\au\xc9\xf4;V\xae\xbe\x1fݒ^n\xe9\xaf;\xda\xde\x13C\u008f\x81\xedm'\xee\x0f!_o$\x06\x9fX\x0f\x02\x80Z\xa3y\xdd'\xbel\x02\x1b'\xe8BB1\xf8\xc8f\x10\x87\xd2\xef^\x9c\xf3+\xe7\xe7X4O\xbb\x86\x9dw\xb4\xdeqtT\xf3\xee\xe3K\xb41tm\xc7\xcdMC\x8a\x91a\xf4\a\xf7\xa4\x94\x12OW[\xae֭d\xaf\xec\xa7\xf4\"{\x9e\x17Z\\\x1a\xf6^\")߈V\x89\x1b\xa9\x1b=\x8fO\xe7\xfcP\xbd\"D\xc3\xd9l`Q\xcdi\\D\xa7\x9a\xa4\xa3pc\x811&\xb4O<\xdbE\x1e/\x14ր\x8b\x8a\x14Ձ絬T.\x01\x91bLT61\xad\"Fی\vEl\xd2h\x1fa\x8c\xa81\xc8\xf55/\xa4n씠\xb7\x9b\x01&\xf9\x1a\xb3\x06L\x92\x87x\xa3\xa4\x95~\xdd\x10B\x10\x102F\x86ndsۓ\xf6\x1c2-\xad\xb1\x8b\x89\xdb>\xd1e\x10\xfa\xb6\x8b\xbc\x1e#.J\x8b\x1b\xa2\x12g\v\xe7Y\xb9\x91\xbf}\xef.\xbf\xf9\xe0.Sm\t\t\x8e\x94\xe1\x95\xef\xe9B\xe0\xc3;\xe7L\x8f'\xc4\x10\xd9m:\x122\xe7\xec\xe5\xfd\xcey6\x83\xe3\xf9\xcdV\x94T\xb2\xc1\x10ƶ5\x9ab\x0f\xbb\xe7\x95\xd3\xdeP&\xee\x1f\x90Rt.\xf0\x81\xa99+k\\\xd72M=\xf7\x8e\x17|\xfa\xbaDg;;\xa5G\x92\x8a2\xd9k!\x87\xbchd\xbdvReM\x8aR\xec\x9c|\xb6\xac\xf4\xa1\xa5\x8d\x0eB\xd0\xc4\x18\x98U\x862&\x94M\xc2O\x88\x89\x18\x02\x8c\x01C\x92\xf65(H\x06\xdc(G\xb042\\\x1eP{\x0f\x1b\a\n\x86!\xd2mz\xfan\xa4oF\x8a\x04\xd1h\xba1\xd2\x0e\x81\xdeC\xe3\x12\xb7C\xa4w\n\x1f\x13MP\xa23σo\xef#!\xc2n\f\xfc\xe0\xd1\x03~\xed\xf2\xe2 \xdc4(\x02\xb0u#\xd6\x1a\x96\xa7s\x82\x8f\x8c\xe3Hߏ(\x12\xc3\x18\xd0J\xd1;\xc7զ\xe1\xebu\xcb\xe0\xb3\xc1\x8eBg&H\x8e\xcb\xd3Fr\x98\x10\x80Ni1\xfe\ry\x13c\x95\xa1\xf1\x9e\xb6\xe9\xb9W\xcd\xf8\xack\xd1(\xceg\x8eg\xab\x82\x10A#n\x0f0b\x8d\xc1*\xe9\xb6z\aW\xadl\xe3\xce+\xa1PF\xe4\vH)q2\x91舨d\xc0Z\xb5\x81\xc1%f\xb5\xa6*\xa0\xb4\x8a\x89\xd5\xc2k\x8a\xe0\xd6#\xaa\r\x98\xd2\b&U\x9a7\xcb\xc5=*\xb2wwP\x9aq\xf4t\x9d#\xbaH\x1a\"\x856\x8c)\xb2\xe9\x02\xbbQz#\x17\x13\xd7]\xa2wr\x99\xfb(0\tYf0z\x19\x0e_\xb6#\xd3\xc2\xf2\x8b\x97\x179 L\xc8\x03\xb7\xde\xf1\xe3fw\x10\x8bN&\x15\xc3\xe0\xe4D\x8e#\xc3\x10\xf0^\x06\xcfU۳\xeaGڜ{\x02`C\x92\xb8\x9eZ\xedQ\xcal\u088d\xbc\x81\t\x8c\x92)\xd4\xcbv\x02\xad4\x9f\xaf\xd7\x1c\x97%JY\xbcJ\x1cۑe5\xe5\xba\xf7\x90&\x98\x90\b\x05\xc2\xdc3\x81R\t\n2x\xc5u\x97pAq1QTFz\xf5\xed\x98\bѳ\x9cX\x8etv\x87P\xd0\xf9\xc0\xb0\x93@\xb2\xb2\x10\x04\xc1GX֚i\x01\xca\aBG.\x8c\xb2tJ\x99&\x93\x12\x14u\x81)\r\xed\xaec̾&CH\fQ1\xb8ĶO4\xa3\xfc\x9e\x8d\x83W]d\f2\xe78/\x94\xd8!\t\x1e峭ӫv\xe4Y#\xe1c\xff\xf6'\x9f\xf1\xcb\x17\xe7\x9cW\x95\x14\xe6fC\x1f=\x85\x82\xf3\xe3\x19uNt\xf0>\xe2\x06O\xdb9\xb4Rl\xba\x81u7\xb2\xebF\\\x1e\xad\x90\xd9Eނ\x90W\x0e>A2\x1a\x95\x12Ik\xacJ\x98\x14q\xd9\b\xb8T\x86\xa4\xe5\xcf\xcf\x12\x1c\x95%\xde\x0f\x1c\x1bǣe`\xf7\xaad\x8c\x0e\x15\xa7\xa8\x10\t\xa6\xa5\t\x86d\"\x13\xadp^\x04\xf3[\x0fc\x9b8\xab4\xd3BQ\xe8D\xebal\x02}\xa9Y֒\xa5H\x8e\x96\x1b\xbcx[\x014!\xb1j#\xf3Z1-\x14G\xa5Ω\b9\x8cŋ\x85E\bQ̑c\xa2w\xc2F\xef}b\b\xe0|\xa2\xf7b\x06\xd3\a\x19u\xb6}\xcaZZ\xb1\x06l\x034>2\xa6D\xccu\xe3\xbas\\\xf5\"W\x9bۊ\xed\xe8\xf8\xcfϞ3\x86@\xa9\rc\f\\L'ܝ\x14\x9c^\x1c\x1fB̆ad\x18C\xb6\"\tܴ=\xed\xe0\xe8}x۞\x12\xbbמ[\xad\x0f4\xa4}\xe4\xc3[\x12lB\xf0<\xf3#\xf7\xaa)\xe7z\x02JS+͇G'\xfc\xbf\xdb+\nS\xf0p\xbe\xa3\xf3\xa7\xfc\xe4:'\xd2\xc4\x19Z\a\xbcv\xec\xbcfЎcm\x18\x83\x10\x9a\r\x8a\xdb1␤\xcdB\t\x92\xba\x1e#W]bYi\xcekM]\xec\xf9\xac\xea\xe0\x12\xe4#l\xbbH\xef\x12\xa3\xcbƗ\x99\xf8\xe8s\x1eG\"K\x02\xf6_\xac\x97+\xa7\x8fBz\xdb\xc39]\x80\xce+fV31\x86/\x1a\xcf\xd6'\xb6.\xe6\x17U\xb1\x19\x02\xaf\xba\x81\x8d\x13c\xb3EY\xa1ќNJֽ\xe3Ů\xc1jA\xc4CHL'KN\xce\x17(\xad\t!\xb0kZ\x82\x17\xd8\xe8\xba\xed\xd9\x0e\x8ev\xf4r\xc5\xe7\xcdC\xa9\rv?\xa9\x17\xc6d\xba\x8ep\xac\x84{\x10\x98\xa0X\x18\x83V\x96\xf58\xf2lh\x19b\xe4N5\xe1\xd8\x16|\xf3\xf4\x84\xdba\xc0\x87\x9e\x89N|t\xb6\x83\xb4\xe4\xd3\xd5N\xdað\xc0\xb0\xc6 W\xc5\xd3\xc1q\x91\xf7\x04:#\xac-\xa2\x1bi\x10\xb7Q\x83\x92/\xd1Ƀy03\x9c\xd69u3\x81\xd6Ң\f(\xfaQNNUD\x92{c\xbe\x12s\xf4\xc5\x18\x05)\x182<\xee\xbcL\xdd(ii\xa5\x93\xd2,\v\xc1\xef>o\x1c\xaf\x87\x94\xf7\x1bR\xd4׃\xe3\xc9z\x87\x8bAR\x83l\x85Q\x9a\xf7\x97s\x96u\xc1\x9d\x89\x9c\xb4\xf5 R\x8e\xdd0pyyĴ.D\xb7\xdet\xf8Q\x10\xde\xdey\xae\x9b\x91\xce9z\x1fߜ\f-R:+z\x87<\x9dk#\xd3y\x8e\x91+\x13\x1c\x1bͱ\x11A\xa2O\xf0̏l\x83'\xf6-\x95R\x9c\xb45\xbfr\xe7.\x9f\xbczAT\xa0\xc2\xc0\xcf\xdd\xe9\xd1\xfa\x88Oov\xd2\x1e\x84c\x92\xdeQSЧ\x86/ۑcWpo\xa69\xaa$\xf5\xcdG\x89 \r&a\x8c\x96\xddD\x92\xab\xe7E\x9b\xd8:8*\xa5\x86\x94F15\x8aZ+\x02\x8a\xd6GƠ\x0eF1.\xc0\x10\xa28\x90f\xe4^\x8c\xc4R\x0e\xb9\x94\xbd\x8d\x8b\x02\xe9\x94\xda\xd0\a\xc5O:\xcffL\xb2\x8aM\x8a\xdeG^\xb7\x03O\x9b-]\xf0\x94\xcapVM\xb0\xdap\u007f1\xe1{\xa7ǲ\x8e&ѝ.\xf8b\xa5yմ\x98B\xf1\xf8\xde\x19eiq>\xd25\xbd\xbc$\x01\xae\x9b\x96f\x1c醐9]\x1ac\xf2\x9c\xa7\xc1jm\x98\x16\x96\xda\xca\xde\\+\x8d\xc9\xc6(\x92\xf1\xa7\x0f\x9e&wm\xc1\x991\xbc\f\x81!E>\xebv\xd4Ʋ\x9cL\xf8\x99\xe3S^5\xbb\xbc\x89\xed\xf8k\x97\x1a\x17f|\xben0\xaa\"\x04\xa1\xec,tAҷ\\\x0f\x8e\x9d7<\x9a[Φ\xfb\xce)a\xb2\r_e\xe5\a\xf4Q\x9a\x8e\x9dO\\u\x89\xca\xc2\xdcB\xad\x13\xa5\xd1b\x03\xa8\f}ܟ\x02\xb9\x96\\z\xb3\xc7\x0e\xbc\xf1\xd0\x12\xfbA\x19\x00}\x92Z\xb8\xf3\xb0\x1a\x1c\xfd>\x0f\xca\xc3zt\xbchZn\x86\x8e\x90\"\xc7e\xcdI]\xf1\xeer\xceIQ\xd1\a\x8f\x8f\xc2kYyϐ\x19\xf2*%\x96\x8b\x19\x97\xcb\x19\xc6\x1a\\\x90e\x17)э\x8e\xcd0ҍ\x9e1\x93>\xb4Nؤ\x0f\xbf\x8b-\x8c\xa2\xb4\x86\xca\x16\xd4Z\xa0v\x93 \x1eҢ\xdf\xe4\xc6*\x12\x13mx_\x8by\xd9U\xf0|\xd65\x9cm6||~N7\n\x94\u007f\xe5\x126u\xfc\xe2]\bi\xca\xd3M\v\xa9$)\x8dU\x8e\x999\xa3\x0f\xaf\x19B\xa4\r\x8ayx+\x052\xef_\xc7Q\x16\xb0\xe5>Y:\xb7\xb2}\x80\xd6i\xe6V\x8c\x94\x95\x8a\x87\fB\xf1\xfd\x92a4f\x04%E!Z\xf8\x1cܲ\xa7\x81EAڗ\x00\x00\x11`IDAT\xfa$\x83\xdf\xceE\x1a\xe7\xe4ϤĦ\x0f\x87\a\xe1\x92\fp\xef-\x97\xbc\u007fz̼0\xbc?[\xa2I\xac\xfa67\x18\x91]\x10\xccjZX\x86\xb2`ZW\x9c\xccj\xa9a.\x10\x938\xd9m\x87\x91]\xefi\x87\x90yiRG\v\xb3\u007f \x1a[\x17V\x90^-\x19\x1c\x15\x12\x9e\xebs-\xd1Y\xa8\xafs\xee\x9e\xc9R\xb6Bi\xee*\x03n\xe0\x93\xf5\x8a{\xf3\x05w\x17K\xae6+*[\xf0\xac\xdb0\xa3\xe3\xaf\xdf\r\x1c\x95SV}\xe2\xcbm#\x96\xacXN,܆\u05fchGZWpV\x1b\x16\x13\x81\xa9J\x93\x0e\x8eq1\t(i\x8c:\x18\x87{\x1f\xe9\xa3p\x9eJ-\xdb|\xadRf\xb9\xecSs\xf2\xda8\xafZC@jB6\xc6tQ\xbczC\x92\xf5\xeb\xce9^\xb4\x1d\xaf\xdb\x0e\x97<\xfb\xffۣ\xe3c~\xe9\xde;,\x8a\x92eYs\xb7\x9ea\x94\xe2\xb9\xd6\\\xb5;\x9a\x18\xd9x\x8f\v\x8e\xce\x05\x9c\x8f<\xba\\r:\x9f\xe0B\xc0\xf5#D\xe8\aϺ\x1d\xd8v.\xeb\x8b\xd4\x01\xfd(\xb4\x96\t>&\xacRBh\xb0\x88S\xb4Q\x1a\x9b\xdd\u05cc1T{\x9en\x0e\xc0\x9a\x17\x053[2/*:?Rj\xc3\x17C˟\\\xbd\xe0\x1f\xbc\xf7\x01\xe7\xf3\x05\x9b\xb6\xe5^5\xe7\xe5\xd02S\x8e\xef^x\xb4\x9e\xf1_\x9f\xd6\xfc\x9f\xeb\r\xb3\x02*Ss\xacNY\xfb\x1b\xae\x87\x81\xdbAs\xd2[Ϊ\x92i)\xc8Hi\x84\xc8\x1c\xf2ۭ\x94:\xe4\x16\xe2#\xe3\xc1\xc7W\xbd\xd1\x00+1\x91\x89\xb9\xb0\x87\xecN\xea\x83\f\x9b!'=\xbb\x94\xe8\xbd\xc4f\\\xb5\x1d7\xc3 \xedz~\x101%\xbesy\xc1/ܹ\xc3\xc4\x18\x96e\xcdqQ\xe604\xc3qY\xb3\xeaZ\x86\x18h\x9c\xcf\xc5\u007f\xa4\r\x8e\xef\xbc{)\x04\x0f\xef黁\x18a3\x8c\xbcj:\x86 \xb1\xdd{\xa2\u007f\x91\x99=Q\x05A\xd8++\xcb)\x9d\xc0*\x93\xdf6\x85\xc1\xa0S<\xb0\xd9u\x92\xd31\xb7\x053[Ȟ\xbd\xac\x99\xda\x12\xab\x14_\x8e=\u007f\xfc\xec\t\xbf\xf2\xf0]\xea\xb2d\xdev\xec\x82g\xe7\x06,\x10Î\xbf\xff\xa8\xe6\xee\xe2\x82?\xfc\xec%3SP\xa9\tK}\xce\x1a\xf1\xed\xbd\x1d=k\x17\xa8\xb4\xa62b'^\x1bżR\x9c\xd6&\x1b\xa7Iw\x94~\xca\xdbZ4\x82a\u007f\x12\x10\vA\xad \xa4\x88\xcf̑\x94\xc0\xa3x\xd5\x0e\\\xf7=;7\xd2\a\x9f=y\xe5\xad\x1dC䨬\xf9\xb5\a\xf7x\u007f1G+\xcdqU37\xf6\xc00\xf1!p;t4\xc1\xb1\x1aG\xc6 ,M\x15\x13\xef\xbdsʷ\x1e_⼧kz\xc6a$\xc6\xc8\xf5\xaeg\xd7{\xf1\xb4ToB&K#\xbf\x97Jy3\xbb_\xbe\x89\x10%a\x92\xfcs\xc8\x19\xe21\xe7\x89\xebL\x96~\xdb\xe3I\x03\x85-(\xb4\xe1^QҸ\x91/\xaf\xafy\xff\xf2\x0e\x8b\x99\xe6Q\f\xfc\xf9\xfa\x86\x98\"ZY\xfa\xe0\xf8\xd5\xd3\xc0y}\xc4\u007f\xf8\xc9sFo\xa8u\xc9L\x1f\xb1\v\x1b\x02\"\xe6kB`\xe3\x031Gх\x94\xa8\xb4Ἦ8\xafK\x8e+K\x9d%g{\xf2枪#W\x96>Ԓ\x10tN\x86N4.\xf0t\xb7\xe1\xf5Ћ\xb0\xf3\xad\xf0ǐ\x1f\xf4\xc7g\x17\xfc\xda\xfd\xbb\xd9\x19U\xb3\xacjJm\xb3œ8\xb1\xde\xf4[^\xb5;\x9eu\r\xad\xf7tN\xf6\xed\t\xf8\x85o\xdcgR\x1aF\x1fhw\x1dDh{ǫ]\x87\x8bQNX\xfe\xbe\xadV\x92\xb3\x92bn\xf7\xc5\xeeRt\tFa\xf2)\xb0J\xe3\x95B퇱}\xae\xb8\xde\x13\xaa\x05N\xd9\xf3\x0fN\xea\t\xbeO\\\xa0p\xc3\xc8\xfa\xf6\x96\xc5ќ\x8b\xf9\x11\x06\xc5\xf3\xbe\xe1f\xe8\xd0(^\xbbć3Ϳ\xfa\xceC~\xef\xf3g\xfc\xd9\xf5\x8b̌\xd4\xc4\xe8\xa5;\"\x1e|q\xf7\xd1\x0e\x8eȗ펧\xad\xe2N5aZ\x9a\x9c朲\x04[\xa3ӛ\x8c\xcf=\xf1ڇH\x1f\"\x8d\x1bY\xbb\x9e1\x06L\xa6s\xc6<\x8cM\n\xcb\xc5t\xc2Ϟ\x9d\xf2\xc1\xf2X\n\xad\xd6L\x8c=\xd0k\xadVLlE3\xf6<\xdfmy\xd274>\xd09\x198}\x8c\x9c-f|\xef\x1b\xf7\x85\xe6\x13\"\xdeyH\x89W\xbb\x9eM7\x1e\xb8g\xfb\x03PZ#n\xb0$\x8a\xfc\xc2[m4.\x8b\x1c\xb42\xe8\xe8\x85a\x99u~\xe1-;T\xb5?)\xfb\x84\xb6\xfc\x1f9.*\xb4\xd2|\xba[s\xa45\xdb~\xa4T-\x93ل\xd3\xc51\xc7\xd3\x19Wݎ\xcf\xd67D\"\x9b1R\xe8\xc4?\xfd\xf0=~xqɿ\xff\xf4/r)\x16\xa8\x86\x1c]\xba\x8f\xd8\n)\xb2s\x03\xa5\x11w\xe8Ϛ\rl\xdfH\xc8lf\x90\x9b\xb7\xf4\xf21\xe5\x87\x12\x13!\x05\xc6\x10\x0ev\xe1\xb5)8\x9bT\xdc?\x9ar\u007f6嬮9\x9d\xd4L\xb4=\x14Y\xa3\xdf$\xa0\xceL\xc1\xc4\x16l\xfa\x96/\xb6\xd7|\xdenhC\xc0G)\xc4!sM\xbf\xf5\xe8\x9c\xf3Ŕ=%-\x86H\b\x91\xafV\rc\x88\xf9;\x96k\xc9jM\xa5\rQ\xc5CF\xa2N\t\xab\n\x8b\x0fR\xe8Љ\xa4\xc4\x17\xad\x02Fmr\x16y\xa0\xdc\xcb\x19\xf7z\xc1\xb7\n)y\xc1\xf5\xf9\xd0\xf3Pi\x96\xd63z\x87\xed\x14\xc5t\x86)J\xee\xda\x13fE\xcd'\xd7/\xb2\xd4\v^v\r\xdf^L\xf8\xe7\x1f}\x83\xff\xf8\xf9\x131m\t\x81\xdeG\f\x89r\xefNm\x13\xff\xe4\xa3\x0f\xf9\xfe\x9d\v\x94\x82\x1f\xad7\xfch\xdd\xf0d\xd7p\xd3\xf7\xdcv=7CK\x17=6{\u007fEq\xf9\x94\xb7O\x1b.&\x13N&\x15\xef\xcc&ܟO9\xabJ*-Z\xbf\xca\b\x8am\xb5\xa1P{\x82\x1d\x94\xca27\x96\xc2XV}˓\xdd\rO\x9b-\x1b/˭\xd1g\xe2\\JLk\xcb\xcf=\xbe\x10)\xb5\x92\xd5A\xf4\x81f\xf0\xbc\u07b4\x87\xe0ͽڪ0:[\x02\x06\xdel\xe8\x13\xb6\xaa\n\xb6\xeb\x96\xe3B\xc8\xfeb\xf7\xa1(rw\x93\x94\"h\x11\xb4\xec\xb5\u05ca\xbdj\xea\xcdʡR\x86\xa5\xb1t\xc9\vd\x91\x12u\x8a\xb2\xa3@\x9c{\x16\x93)\u07fb\xf3\x90?\xbfy\xc5n쨔\xe1E\xd7\xf2hR\xf3\xbb\xdf\xfe\x16\u007f\xf0\xec9O\x9aF\xd0\xdf\xc1\xe1B\xc4\xe1\xf9\x17\x1f\u007f\xcc?|\xf4@¸ƞ\x93\xe5\x92\xef-\xe6\xb9\xe5t\xb4!\xf2jp<\xd9u\xfc\xafW/\x89)1),GE\xc9Qe9\xad\n\x16e\xc9\xc4\x18Jm2\x94.\xf3K\xa9\x15F\t\x17M%\xd1\x05j%ٵ\xc7E\x8dRp\xdbw<oV<kvܸ\x11\xab\x94\xa8UB8\xf0x\u07fd\\rg1;\x14\xed\xae\xed\x891\xf2b\xd3\xd09'v\xee{\xcdMV)[-]\xa0U\n\x93\xa4\xa6\xd9\x0f\xdf9忯Zv\xdea\xcb\n\x8fƑ(RĨDR\x1a\xaf\f>E\x8a}\xecj楪\xbf\x14\x8b\xfd\xc1l\xc1\x8f\xdb5.\x05B\xd6j\x90\xa2\xf0o\xb5XaTE\xc5\xcfݹϫf\xc3\xf5n\x8b\xefZ|L\xfc\xfc\xf1\x11\x1f}\xf3#\xfe\xdd\x17_\xf0G\xcf^H'\xa5\xe0\x83\xe3\x05\u007f\xf3\xfc\x14B\xe4e\xbb\xe3Oo_3D\xc1\xbcTV\x15h\x14S4\xdf^\xd4|4\u007fL Pka\xe6\xeb\xecvDnT\\\x8c\xb8\x14\xd0\xca\x1eB\x8b\xc9\x0f\"\x90(\x8da\xae-\xb3\xbc5}\xd57|\xb1\xbd\xe5I\xbb\xe1\xcauLlI\xa5\r>v\x99\xb5\x189\xaa\v\xbe\xfd\xe8\x8cbR`\x8cX\x9c\xfb\xc1\x11b\xe4Ū\xcdւY\xb4\xa5r\x90}N$ՙ\xbb\xa5\xb3\xb9\x8d}x\xba\xe4\u007f\x16_s38&\xd6Rj\x85\x03z\x04\x1b2)\xe2\xb5!(\x93\xc3w\xf3\xe0\x95\x9d\xf6\xd4[S\xfc\x9d\xaa\xe2\xd36\xb1\xf1#\x97\xe5$\xabR\x14\x04\xb10W&\x81\x16:\xe5\x9d\xd33\xce\xef_\xf0`\xb3\xa1\xb9ޢT\x81\x8a#O\x9a\x06\x17\xc4\xde\xc9G\xcf?z\xfc\x98\xcbj\x021\xf2\x17͖1:\xac2\x14J3\xb7\x96B\xc95c\x94y\xc3\xdeϱ\xae1\xa5\xdcaI\x1ag\xc8\x11\xa9I\x99\x83, &\x89\xb6S\xc0\xa9-X\x98\x02\x9dC\xea\xbfh\xb7|\xb5\xdb\xf0\xa4\xdbq5t,\x8b\x8a\xf3rB\x1b\x03cV\xdc\xc4\x18\xb9{z\xcc\xe5\xf1\fc-\xdahb\bt\xddH\xef\"\xb7\xbb>\xcfG\xd9+>+\v\n%\x9by\x9d\xbb+\x95ŰV\xab\x84՚\xc6'\xae\xfa\x1e;\x99Qh\x83\xcf@\xddl\xaf\xb3\xd0\x06\x97\xa2\xe4\x85\x1fh\xf7\xea\xad\xe0H\x99\x11NL\xc1&x\xa2\x12\\\xa9\x88Q\xee\xd5\x102\xf30\x8b\xf3\xba\x80A\xb3\xf8\xe5\xbb,\xfaw\x18\xff\xf4)\xbf\xff\xe9S>\xb9Z1-,[7\xf2\x83\xf7\x1e\xf3\xfd\xf3K\x88\x89\x17m\xc3\xeb\xa1粚3\xd3B\xea\x9b\xd9\xeaP\xc0\xf7\xaa]\x9d;\xa8\x90\x12.:R\x96\x14\xe8,\xb9\xf3y.QI\xeel\x9d`\xa2\x14\v+:\xcbW}\xc3ʍ\\\r;V\xe3\xc0\xda;nƞ\xb9\xad\xb87=\x02\x12\xaba\x94\xfc\xdc \xb9\xf0\x8f/\x84oU\x94b\xc1\xbe[\xb7\xf4\xdd@3z\x86\xd1\xff\x94y\xff\xbe~\x98\xcc}\xb3\x88oؾ*ۉ\x91\xfc\xa9\xb6\xedq>\xe2\x92g\xc8\f\xc41\x82A\xba\x00\xaf5%\x8a\x95\xebQ\tN\xaa\x9aJ\xcb!\xb0Y\x1cj\xd0,\x8ce\xebe\x18J)1:GU\x96\xe8\x03\xc5B\x1dbS\xa9k\xb8\xf2$\x1d\xf8\xc3\x17\xcf\xf9O\x9f\xbf\xa0҆1\x04\xbe\xff\xe0\x1d\xfeٷ?\x90\x1d\xe5\b\x17\xb3\x05\u007fg2ό\x10\u007f\x10\xbb\xec\xe9jbE\x1b\xdfx((EL\x05D\x81G\x1c\xe9\xe0Q\xbf\u007f+S\x924\xeb!&\xbe\xee\x1b>o\xb7\xac\xfd(!\xc9~\xa4\t\x9e!xjcy4;\xa2\xd6Zj\x96\x0f\xb4N\xbc\xafN\xe75w\x963lY2\x99֤\x98\xb8\xbd\xdeH\xd4\xd3n8\xf0\xfd\xd4_\x91\tjt\x84\x80ϧ\x83}\x84\xad\xe1\xfe\xe91/o\x1aB\x8cX\xa5\xa9\xb2\x0f\xa1\xcaAZ\xbb\x14\xf0)\xbb\xfa(\xcdڍ\xb49\x14\x05\x12\xa5\xb1L\xb4\x95\xf64\x06\xc6\x18\xf3<\x81$X\x8e\xf2P\xac};Z \xd3\xe4o\"?\xfa\xf4K\xfe\xe0\xc7_3\x06\x91\x81\xfd\xd6\xdds~\xe7\xbbߢ\xfax\t\xbb\x00O#6*l\f870\xd1\U000a64d35J\x9d\xa9\xa7i\xef\xdc#5\xcce92I:\xc5!y\x86\x14\xe9\x82g\xedGn\xbcc\xedFn\xfc\xc0\xebq`\xeb\xbd\xe4P\xe5zb\x94⢞qZ\xd6̬%\xa4\xc468vÈ\xf3\xb2\x84{|~L]\x97\xcc\xe6\x13\x8a°Y\xed\xd8\xed:bR\xbc\\5\x126\xa6\xc4\xd2\xea\xed\xee\xca\xe4\x9f]\xa5t\xe8\xb2TJ\xd8Ie8\x99և\r\xa1\xd69\x93#Fj4(\xf1\xa8]\xa7 2-S\xe0\xf0\xa8\xe8\x85َ\xc0\r+\x06\xber\x03c\f\x9cْr\x9f\x0e\x1a\xc0\xa9\x84\x8e\x16\xe3\x1c\xca\x18\xd0\x12j\xac\xb6-a\xdb\xf2×\xaf\xb8\xea\a\xa6\xa5\xe5\a\x0f\xce\xf8\xe5\x933\xda\xd7k\xd6\xff햛\xa1%\x05\xa1f\xee\xfc \x91\x1a(v\xce\x1d\xe6\x04\xab5\xb52y\x90\xe3\x10w\x14H\xb8\x14\xf2\xa2\xca\v\x02\x10<\xeb\xe0\xd9\xc6@\x13\x03m\b\xf9\xc1EH\x8aYQqd\v\x8e\x8a\x82\xa9\x15\xb8$Dy\x83o\x86\x8e\xd50\xb0\xe9\a\xf1a,\fw\x16S&u\xc9\xd1\xf1\x8c\xe0#\xb77\x1bbL\xec\x06Ǧ\x95\xb4OT:\xa0\xe6V\x8b\xc4\xc3f\x1cN\x1f\xb0\xea\xfc\xbb,g\x05Ǔ\x92\xaa0\xf4\xb9wO\xd9\xd1\a\xc4V\xbbT0\x86\xc0&\n4\u007f)\xa1|\x98\x9c\xc2\x1cR\xe2\x85\x1f\xe9\xa3g\xa6\f]\b4\xc1\t\xe9K\x89H\xafw\x03PPD\x85\xb6\x0e\xadD\x13\xfe\xe9\xf55\x9f\xac7TV\xf3\xdb\x0f\x96|4\xa9\xb8\x19:\xae67ty\x90\x8b\xf9\xa4\x1et\xdf1\xe6\xec\x92̀\f^\xbe\xd4\xfcyJ{8EV\xb0.;%\x8c\xb9\xc8{\x90\\\xf5$o\xf9QQqw2\xcb\xecM\x99\xde\x17EEa\f\x81D\xe3G\x9e\xec6\xfcxuæ\x1f\xf1Q\xe4{\xb3\xbad6\xaf9=?\xa6\xb0\x86\xdb\xdb-ͮ#\xa6D\xd39\xf9.՛\x18\xbe\xbd\xf1\x8e\xcd,Q\x15\xdf \v\a\x0f\xf9iU0\xc9aäD\x17\x02\v[\b&\xa4\xa4\xbd-\x80R\vu\xf2\xca;\x1a\xa58U\xe2\xf4\xd3%\xd8\x05\xd9~\x99\b\xc1$F\x15\xf9\xa2k\xb8\x1f#\xb5\xb1L\xac\xc5\xfb\xc8\xe0\x03\xd6:\xeaP\x10\x80\xd7}ϟ\xde^\xf1e\xd3\xf2\xebw\x8eyT\x96<\x1dz\xd6Q\xe4b\xf10\xa9\xef\xbdE\xf6b\xf77\xdaA\x0e\xf5#\x89\xe1\xcc[\xda\xf4}\xab\xb1\xef\x05K\xa5\xa8r+\x96\x10|\xed\xb8\xac9\xae\xa7\xd4\xd6R\x1a+ݏRl\xdc\xc8˾\xe3i\xb3\xe1Y\xb3\xe5\xa6\xef$\x97=\xc9jת\xc4\xc5b\u009d\x8b%\xf3\xf9\x84\xd1yV7[|\x10\x9a\xcf\x18d\x0f\xf2F?, n\xb1O\xae\xfeK!Q&#\x04\x16\xa5X\xb7\x1d\xce\a\xb4\x82!'\x17\x17\xbc\x99X-0U\n\x9f\x8b\xd3.%v\xde\xe7nKaQ\xfc\xect\xc2ɬ`\xd5;\xaeۑ\xb5\x1bP\t.ʊ\bL\xf3Cn\xbdg;\x8e\xac\xbcc\xe7z>k\a>\x98\xd7\xfc̴\xe2\xd98\xb0͂\xfa\xbdyLH\xb9\x0e\xc5H\xcc\xc13!c[)\xabx\xd3\xc1\xe6LN\xcfyQ\xf2\xe1\xf4\b\xab\x143cdbO1\xd3c\x8d\xfc,*\xb2N\x91\x80bV\x8bi\x9aK\x02\xa4~\xba]\xf3'W_q;\b\xf6\xb5\x0f\xae\xd9'\xe1h\x958\xae\n>xx\x87\xbb\xf7\xce0ְz\xbde\xc8\xc8\xee>\xc5#\xbd\x95\x0fR\xd9\"\xebo$\xc3Jk\x83ґZ[J%\xb2s\x8d\u0086\x98\xb8\xd9491G\xae\xa6>\x06A8S\xeeFP\x94*2ӊU\xc8\u05c8\x92\xb7T%\xf1\x92z\xb8\x9c\xf0\xfe\xfb\x17\x84\xab\xc0j\xdd\xf3\xbco\xf9\xa2ox>\xec8\x8f\x13a\xb5(\x9d%\xcb\xe2\x9a\xf0j\x14ñ_<\x9e\xf2\xca{\xd6>K\x9aUN\xcfI\x91\xa96\\\xd8R\xc07\xa5x\xed\x1d.\x06\xa6\xaa\xa4Rpl\v\xfa\x18\xf8Q\xdf\b\xbe\x94\x12ߜL\xf9[\x97\xf7\xe9\xbcϮ>\x8a\xd1\xfb\x1c\xbe\x95\x0e}E\x1d\x03\xb7\xc4\f\xf0\t\xda\xfdt\xb7揞\u007f\xc1\x10=\x855\x04'\xd0\xfe^\x8e\x98\x12,\xaa\x82\x87\xef\x9c\xf0\xf8\xc1\x05U]Ҷ\x03\xeb[A\x18\xf6P\x8a\xd1\xfa\x10\x80\xa9\x95\x18\xbd\x1d\f\xf7\x11\x82GJ03%\xa4x\xb0%\xb1\xa3\xf3\x84\x18\xa8\x8db\x97\x83Y:\xef2\x9a\n:\x86\f7(&\t\x92ҴD\x9c2\x14D\xba\xec\xf6\xf9\xf5MǱ\xdbP\x96%Ua\xf9\xb0<ᬮ\xf9\xe1\xed\x15O\xba\rQ\xc1Y%B\x95\x90\")\x06Vn\xe0gg%>FV\xc1\xe7\x13!o\xcb{\x939\x1f̎8/kj[\x1c\x96\x1fFI\x04\xdem\xdf\xe0S\xe0\xbao\xe9Ɓ\x93\xd9\x11\x9fv-\xd7\xce\xf1z\xe8i\xbc\xc3(ų͊\xd2X*k\xa9m\x81\v\x91!EF\x12\xbd\x86J[\"\x8a\u038d|\xb2zş\xad\xaeP&qlKPЎ\xfe\xa7R\xe8\xea\xd2\xf0\u07bd3\xee\x9d\x1eq~\xb1\x14\x8a϶\xc5ǈ\xf3A$pY\xf0\xb4\xff\xf7\xf6{\x0f\xab\xd5\xc1x?eu\x80\xe1M\xdaO\"a]\blzG\xe3<Q\tͳ\xf3\x01WH;Vfg\x9dRi<\x81\xa9ʎ>Ɛb\xa0\x8d\xf1\xe0d\xd3\xc5@\x99\xa7b\x97\x12ˢ\xe6\xbb'\x97|\xba\xbd\xe5\xcbf+Z;\xadYy\xc7\xca\r\xd4J\xb1К\xe7\xde\t\xff\tX\x16\x05\xbf~\xe7\x11\xf7\xe6\v\b\x81f\x1ch\x9c\xa3\xb4\xe2\x1e\x1d\x95\xa2(+.KyH\xa7\x93\x19\xaf\xba\x86\xaf\x9b\r\xefW\xe0RÕ\xeb\xb9\xed\x1a\xa6EA\xebG\xb6n\xc0(\xc3\xc3ŒNEz\">\xc3)$\xf8\xba]\xf3?n^\xf2\xack(\xb4f\x96\xf7ۛ\xecu\xf2v\xc4뽳%\xf3I\xc9\xc5露*\x18\xba\x9e\xae\xeb\xc5R6\x84,\x8dN\asДydB\xa9\x95vWi\xc9\xe3\xd5\u007f%nPa\xcb\xc2\x0e}LRC\xb2\x13\x92\x8b\x9168\xac)\xf2C\nxe(\xb4Ƀ\x92\xa2\x16\x1f럂 \xc6\xfc\x03\xa9}\xa1͵\xe3\xdb\xcb\v\x96\xed\x8e\x1fmn\xf0\x84\x83|\xf8\xc8\x18n\x82\xa3˵(\xa5\xc8\xf7\xcf\xefrov\xc4\xedv\xcdU\xbb\xc5\xc7ȴ(%\x89&+\xb0J\xa5\xa9mAi-\x952\x9cM\xa6X\xa3\xf9\xe3\x97\xcf\xd9z\xc7\xfd\xa2b7\x0e\x87\x90\xe2\x94\x12\x8bI\x85֚1xR\x96Y\x84\x18\xf9\xbf\xab\xd7\xfc\xef\xcd5F)\xee\xd7\x13Y\xf3\x92h]\xe0\xba\x1b\x0f\x8dC\f\x89\xb3医\xa7\vfu\xc1\xe5\xe5\t!x\x86\xd1\xe1|d\xc8K\xaa\x98\xef\xb6\x10\x03)_E\x851TY桳\x85\x06\xb9\x95NoG\x9c\xc3\xf0\xff\x01DY\u007f\xe9\x10\xd6g7\x00\x00\x00\x00IEND\xaeB`\x82")
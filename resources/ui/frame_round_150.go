@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Frame_round_150 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x96\x00\x00\x00\x9c\b\x06\x00\x00\x00\x9d\x9aR\x84\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x143\x18\xda\xce\x04\xd1\x00\x00 \x00IDATx\xda\xed\xbdy|\\YZ\xdf\xfd=\xe7\xde\xdaU*\xa9\xb4\x95\xe4M\xb6\xcaKۖ\xad^gg`:\x13\xb1o\xc3\xd6&C \x81\xb0Ä\x90\xb0$$@\x02a2/\xdb\xfb\x86@HX\x86E\x86\x81\xb0'Pa\x18\x18\x86\xe9\xe9\xdde˻,ɶ\xf6\xbd\xf6\xfd\xde\xf3\xfeq\xef-_U\x95\xb7n\xbb\xdbv\xd7\xf9|\xf4\xb1\\U\xbaUu\xce\xef\xfe\x9e\xfdy\x04\x8f\xd8:1>\x06\xc0D\"\xd9\xeaqa\xff\xd7\xf9\xd7\x0fĀ\xbd\xc0N\xfbg\x18\xe8\x04\xba\x800\xa0\x03\xd2\xfe\x1be\xff\x98@\x05H\x01\x19`\r\x98\x03恫\xc0u`\x03\xa8\xd9\xef\xa3\x00\xd5\xf8\x99\x9c\xcf\xd5\xea\xf1\x87}\x89G\x05L794\th\xf6\xf7\xec\x02\x8e\x00O\x03\x8f\x03Gm@\x85\xee\xd3\xc7\xda\x00.\x03\x93\xc0\xcb\xc0+\xc0\xb4\rH\x030&\x12I\xf5\xa8\x82L<\x82\xcc\xe4\x01\xbc@\x048\x0e<\v|\x00\x18\xb5\xd9\xe7\xad\\)\x1bd\u007f\r|\x06\xb8\b\x94\x81\xcaD\"i\xdc\ueef5\x81\xf5&3ԉ\xf11\xaf\xcd<\x03\xc0\xe7\x03_a\xff\xeb\xbf\xd95\x94r$\x14 \x04\x02\x10B \x85@\b\x81\x12\xd6或[\xa4\xea2\x11\xa5P\n\x94R\xf5\x9f\xfa\xe6\x8a\xdbn\xef\n\xf0\x97\xc0\x9f\x01/\xd9b\xb5\xd0\b\xb2\x87\x11`\xe2a\x05ԉ\xf11G\xbc\xf5\xd9 \xfa:\x9b\x99D3\x88\xb6\x1f\xb6\xa6ihR\"\xa5@j\x12\xafǃ\xcf\xeb%\xe0\xf7\xe2\xf7\xf9\xf0\xfb}x<\x1a>\xaf\x17MӰ\xf0!\xea\xd7RJQ\xad\xd6(\x97+\x94\xabUJ\xe5\n\xa5R\x99r\xa9B\xb9Z\xa1V30M\x13\xd3T\x18\x86\x81a\x9aw\x02\xb64\xf0\xa7\xc0\xff\x02^\x03\xb6&\x12\xc9\xfc\xc3\xcab\xe2a\x00\x93{CmQ7\b\x1c\x06\xbe\x1e\xf8\x90\xadd7\x81I)\x85\xa6I<\xba\x8e\xae\xebx=\x1eB\xa1\x00\x91\xce\x0e\xba#a\"\x9da:B\x01\xbc^/\xba&A\b\x17\xf3\xd8l\xe4\xe8\xdeʽc\x02!,6\x13\x02\x84\x94\b\xc04M*\xd5\x1a\xc5R\x99L6G*\x93#\x95ʒ\xcd\xe5)\x95\xcaTk5\xaa5\x83Z\xad\x86R\na\xb3c\x8b5\rL\xd8l6\x05\xac\xbb\xbe\xffC\x010\xf1\x10\x01*`+\xdb\xef\x03\xbe\txw\xe3ߘ\x96L\xc2\xe3\xd1\xf1\xfb\xbc\xf8|>:\xc3!z\xa3]\xf4\xf7F\x89tv\xe0\xf5z\x01\x85i\x98\x98ʬ\x8b\xb1{\xba\xa16`4M\"\xa5\xc44\fr\xf9\x12\x1b[)V\u05f7\xd8J\xa5)\x14K\x94\xcb\x16ۙ\xa6\x89\x94-AV\x06\xfe\x18\xf8}\x9b\xc5\xe6\x1c\x85\xffA\a\x98xPA\xd5\x00\xa8ǀq\xe0\x9f\x03#\x8d\xccd\x9a&\xba\xae\x13\n\x05\xe8\b\x05\x89vE\x88\xf5\xf7\xd0\xd7\xdbM\xc0\xe7\xc3T\x96HR6\x90ޒ\x8d\x16\xc2\x12\xbf\x9a\xc44Mҙ\x1cK+\xeb\xacml\x91\xce\xe4(\x14K\x14\x8b%\x94\x02\xa9\xc9V\a\xf3I\xe0w\x80\xe7'\x12ɩ\a]D\x8a\a\x18P:p\f\xf82\xe0\x9f\xdalu\x83\x9dL\v$\x1d\xb6h\xeb\xe9\x8e\x10\x1b西7\x8a\xcf\xeb\xa5f\x18\xf6k\xd4\x03yGKi\xe9z\x00\x99L\x8eŕuV\xd76I\xa53dr\x05*\xd5*\x9a\x94\xadX\xec\xd3\xc0o\x03\x9f\x9aH$g\x1fT%_<(\x80j\x10{\x8fٖ\xdd7\xd9lU_5\xc3@JI4\x12\xa6'\xdaE\xac\xbf\x97\xc1\x81\x1eB\xa1 \x86ab\x9a\xc6\x1bb%\xe7 \x85\xcbZܶK\xca\xe5\U0006cfd1zC\xef\xe9\x80\xcc4L\xd67\xd3,\xad\xac\xb1\xba\xbe\xc9\xc6f\x8aB\xa9\x8c\x14\x96\xa1Ѱ\xfe\x0fp\x12\xf8?\x13\x89\xe4\xe6\x83\x06.\xf1\x80\xb1T\x1f\xf0\x95\xc0\x87m]\xea\x06\xa0j\x06\x9a&\xe9\xeb\x8d\x12\xeb\xefa\xd0f'M\x93\xd4j\xc6]3\x93\x10²\n\x85D\b0\x15\x96\x05W3\xa8\xd6j\xd4\f\x03\xc30\xebʼi*KQw\xf4')\xd1t\r]\xd3\xd0u\x1d]\xb3\xc4\xdc\r\xf1\xdc\xec~\xb8\x93\xe5\xe8e\xb9\\\x81\xa5\x95u\x96V\xd7Y^Y'W(\xb6\x02X\x11\xf8\x03\xe0\xf7&\x12ɿ|\x90\xc0%\x1e \x96\xfa\x00\xf0\xad\xc0\x97\xe3\xf2\x86\x1b\x86\x01B\x10\xeb\xefe\xe7`?C\xb1>\xa2ݝ(\x1b\bw\xce\n\xd2\x16-P\xad\x19\x14\x8b%\xf2\x85\"\x85b\x99R\xb9l\xb9\x0e*Uj\xd5Z\x1dX5\xc3\x02\xac2\xd5\r\xa3\xd0\xf6uI)\xea\xa0\xf2x\xac\x1f\x9f\u05cb\xdf\xe7%\x10\xf0\x13\f\xf8\t\x05\xfd\xf8}^\x84\x90\x18\xa6yW\xa2\xd9a\xb1b\xa9\xcc\xca\xea&\v˫\xcc/\xac\x90/\x14Ѵ&\x119k+\xf8\xbf9\x91H^z\x10\x00&\x1e\x00\x96\xea\a\xfe\x05\xf0\xcdn\xc5\xdc4M\f\xd3d\xa0\xaf\x87=\xbb\x06\xd9\x11\xeb\xa7+\x12\x06\xa5\xb6\xf9\x85n\xcdH\x12M\x93T\xab52\xd9<[\xa9\f\x99\\\x9e|\xa1H\xb1X\xa2X*S*U\xa8T*\x16C\xd9\xd7u\x84፳\x137vJ\xb9\x9c\xa4j;\xe04i\xfb\xc4|^\x02~\xcb\x1f\x16\n\xfa\xe9\b\x05銄鎄\t\x06\xfc(\xfb\x861MuG\xdfC\xd74\x8a\xe52k\xeb\x9b\\\x9f_\xe6\xea\xdc\"\x95j\r\xdd\xd6\xd1\x1a\xf4\xaf\xff9\x91H\xfe\xce[\r.\xf1\x16\xb3\xd4{\x81\x1f\xc6\n\xbbԽ\xe4\x95j\x95H\xb8\x83\xfd\xfbv\xb1ch\x80h\xa4\x13\x84\xc0\xbc\x03@i\x9aD\xd34\xca\xe5\n\xeb\x9b)\xd6ַ\xd8Jg\xc8狖\xe5U*S3\fP \xa4\xc5>\xf6\x01\xae\x00\xabX\x01\xe55 g{«ر={\xbf4\xac\xd0P\x10\xe8\x00\xbam'm\x1f0\xa8\x94\n\xba}aR\n\xbc^/\xc1\x80\x8f` @g8HO\xb7\xed\xfe\x88t\xd4\xc5\xfc\xed\x98\xccq_\x14\n%V\u05f7\xb82;\xc7\xdc\u008a%η\x8b\xc7\x15\xe0\x0f\x81\xff2\x91H^\u007f\xab\xacF\xf1\x16\xb1\x94\a\xf8N\xe0{\x81\xf86\xc5\\HF\x86w2\xb2w'\xbd\xd1.K\xa9\xbd\r\xa0\x9c\xbb\xdaT\x8a\xb5\xf5M\xe6\x97V\xd9\xd8L٢\xaeD\xa5R\xab\x8b\x17!\x84\xc2\n\x0e\x9f\xb1\xff\x9d\x05\x16m\x10偂\xfdo\xc5\xf6#\x99\xf6\x8fs\xf2\xd2\xfe\xd1\x01\x9f}C\x84\\?=\xc0\x1e\x9b}\x8f\x02G\x94RQ\xa5\x14\xa6RhR\x12\xf0\xdb \xeb\f\x11\xeb\xefe\xc7`?\xe1P\xa0\xae\xd7ݖ\x89\x85 \x9b/\xb0\xb8\xb2\xc6\xd9\xf3\xd3dsyt}\x1b{)\xacX\xe4\xc7&\x12ɿx+\xd8K\xbcE\xa2\xef\xa3\xc0Wc\xa5\xa7\x00P\xad\u0588vG8\xfa\xd8\bC\xb1~\xfc>\xcfm\x9d\x97B\b<\x1e\x9db\xa9\xc4\xd5\xebK\xcc/\xae\x90\xc9\xe6)\x96JT\xab\x16\x98l\xaf\xf8u[L|\x0e+\xf0\xbb\x06l\x01\xe9\x89D2w\x1f\xbe\xaf\xc7\xfen\xddX\xc1\xf0a\xe0\t\xdb y\xb7RJ3\x95B\na\x89\xcb@\x80ޞ.\x86w\x0f\x11\xeb\xebA\x01\xb5Z\xed\xb6:\xa3a\x1ad2y.]\xb9ƥ+Wo\xa6{\xfd\xdaD\"\xf9So6\xb8ě\f\xaa\xa3\xc0\xaf\x02\xcf\xd8\"\xc5b#!\x18\x19\xde\xc9\xe1\x03\xfb\xe8\f\x87\xac \xf0\x1d\x00*\x93\xc9sy\xfa\x1a\xf3K\xab\x14\x8b%ʕJ]\xfc؛\xfa\xa7\xb6c\xf1\n\xb0\t\xa4&\x12\xc9Ꝉ\xe97*\xe6[<\x1f\x06\xa2@\xbf\xfd\xfd\xbf\x04\xf8\xc7J)M)\xd0u\x89\xdf\xe7\xa3;\xd2\xc9\xc8ޝ\xec\xda\x11C\x93\x92\xea\x1d\x00\xac\\\xae\xb0\xbc\xb6\xc1˧Α\xcf\x17\x1b\xd9+k[\x8e?4\x91H\xae\x9f\x18\x1f\x13\x8d\xe9:\x0f\x1d\xb0\x1a@\xf5E\xc0\xaf\x00\xbbݢ/\x18\xf03v\xf4\x00û\x86\xd0u\xfdր\x02<^\x0f\xe9l\x9es\x17\xae\xb0\xb0\xb4J\xb9R\xa5Z\xab9\xd9\t\x15\xac \xeeI\xe04v\"^\xab\xa4\xbf7\xeb\xcem\xf5^'\xc6\xc7|X\x01\xf4\x98\x1dQ\xf80pԉ\x1fz=\x1e\"\x9d\x1d\x1c\x8c\xefaϮ!\xa4\x10\x96^x\x8b\x1b\xcd4\x15\xf9B\x81S\x93\x17\x99\xb9\xba\x80\xd7\xebq\xbf\xc4\x00>\x05\xfc\xc0D\"y\xf6\xcdл\xde,Q\xf8\x1d\xb6\xf8\xab\x8b>\xc34\xe9\xeb\xe9\xe2\xe9Ǐ\x12\xed\x8eX陷\x00\x95%\xf2ʜ97ŵ\xf9%*\x95*\x86a8Կ\x04\xfc\xb2mr/\x03\xb9\x89D\xd2|\xd0\xc2\x1e7\x01\x99\xdf\x06\xd9\xe3\xc0w\x01_\xea\x00L\xd34\xa2ݝ\x1c=\x14g\xc7`_\xdd?v+\x80U\xaa5f\xae\xce\xf3ҩsh\xcd\xf1\xc7׀\x1f\x9cH$\xff\xf6~\xef\x89x\x13\x98\xea\xdf\x03\xff\x0e\xf0\xe0\x02Ϟ]\x83<\xf3\xf8Q\xbc^\xcf-\x01%\xa5D\n\xc1\xf9K3\x9c\xbf<C\xb9R\xc50L\xc7\x15p\xd5\x06\xec\x1f\xd8`*?h`\xbaS\xb1ig\xbb\x06\xedH\xc3G\x80\xafW\xa0\t{\x0f\x86b}<>z\x90Hg\xf8\x96\xfe;\x81\x15\x8c_\xdb\xd8\xe2\xd3ϿF\xb9\\A\xb3\x1d\xb7\xf6\x9a\xb2\xc5\xe2\x1f\xdfO\xf6\x16\xf7\x19T?\x0f|\x9fmEYi,Rr\xe8\xc0>Ǝ\x1e\xb8\xad\x89\xed\xf1謬n\xf0ҩ\xf3\xa4\xd2\x19\xf7\xebW\x81\xff\x00\xfc.P\x9cH$ko\x85\x98\xbb\x1f,f\xe7\x999q\xd2\u007f\v|Uݕ\xa2k\x1c=8\xc2c\a\xf6!\xa5\xb8\xed\xfee\xf3\x05>\xfb\xc2)\xd66R\x8dzׂ\r\xae\u07fd_{&\xee#\xa8\xfe;\xf0m\xce{(\x05\x1e]\xe3\xf1c\x878\x18\x1f\xbe\xe5]'\xa5\xc00\x15\xa7\xce\\\xe4\xca\xcc\x1c\x86Y\u007fm\xc5f\xa8\x9f\x05\xb2\x8d\xe2\xeeaN\xe5m\xe1\xe3s\\\x1b_\x00\xfc\x14\xf0\x0eG\x85\xe8\xe9\x8e𮧏\xd1\x1d鼭\x91S,\x95y\xe9\xb5I\xae\xcd-\xa1\xeb\xdb2\xb3ׁ\u007f5\x91H\xfe\xd6\xfd\xd8?\xed>\x81\xeaWݠ\x02\xf0zt\xde\xf3\xce\xe3\x8c\f\xef\xba%\xa8tM\xb2\xb6\x99\xe6\xef?\xfb\ns\x8b+\xdc\xf0m\xf3\xe7X\x99\x0e\u007f4\x91H\x96G\xe3159\xbd\\\xff;\xf7\xef\x0f㚜^\xde\xf6\x1dF\xe31\xb0*{fG㱏\xdb.\x92'\xa4\x10\x1d\xa5r\x85\xa9\xe9\xeb\xe8\xbaFoO\xd7Mҧo\xb0\xfeP\xac\x8fj\xd5`m}\v)\xebb1\b\xbc\u007f4\x1e\x9b\x9f\x9c^\x9et\xde\xfb\xc4\xf8\xd8=\xd9Kq\x1f@\xf5_m%\xf4\x06\xa8\xbc\x1e\xde\xff\xae'\x89\xf5\xf7\xdcں\x01.N_\xe3̹)*\x95\x8a\xb3\t+\xb6\xce\xf1\t\x87\xa1\x1eՒ\xa9;\xd8۽\xc0/\x02\xff\x18\xf0\xd5j\x06C\x83\xfd\xbc\xe7\x99\xe3\xf8}\xde[2W\xb5Zc\xf2\xc2\x14\xe7.\xce4\xea\\\x1b\xc0\xb7\xb9u\xae{\xa1\x9bj\xf7\xf8\x8b\xff$\xf0/\x1d\x9d\xca\x02\x95\x97g\xdf\xf7\f}\xbdћ2\x95\x10\x82r\xb9\xc2+\xa7\xcfs\xf6´\x9dQ)\xb1]\a\x1f\x9aH$\x9f\x9f\x9c^V\xce\xdd\xf4\xb0\xb3\xd3\xdd2\x99k\x9fS\x93\xd3\xcb'G\xe3\xb1M\xe0q)e8\x9b\xcb3su\x9e\xbe\xden\x82\xc1\xc0M\x99B\xd34\xfaz\xa2\b`em\xc3m-\x06\x81\xf7\x8c\xc6c\x93\x93\xd3\xcb3\x93\xd3ˌ\xc6cox\x8f\xb5{\b\xaa\xef\a~\xe2\x86\xf5\a~\x9f\x87g\xdf\xf7\f=Ѯ[\x82*\x95\xce\xf0«g\x99\x9d[D\xd74\x84\x10\x9b\xc0\x8f\xd8\nf\xea\xc4\xf8\x18\xa3\xf1\xd8ۆ\xa5n\x060\u05cd\xf5\xf2h<\xf6\xd7\xc0q!Āi\x9a\xda\xd4\xccuB\x01?]\x91\xb0[\xdcmW3tIo\xb4\xbbn5:\xfbo\xbb\x81\x9e\x1e\x8d\xc7^\x9a\x9c^^t\xbfכ\x0e\xac\x06P}Ȧ\xe8\xa0c\xfdy}^>\xff\xddO\xd1\xd7\xdb\xdd\x12T\xce\r\xb3\xbc\xba\xc1\v\xafN\xb2\xba\xbe\x89G\xd7\x15V\x81\xe7\xb7L$\x92\xbf?9\xbdl:\xef\xf3vb\xa9;d\xafU[\xf7\xda\x03\xec\x93R\xfa\xaf\xcd/a\x1a&}=\xdd\xf5\xecT\xf7\xb2<\xfc\x1a\xbd\xd1.\xaa\xb5\x1a\x1b[i7\xb8\xfa\x80\x03\xa3\xf1\xd8\xe7&\xa7\x977\xde(\xb8\xb4{\x00\xaaw\x01\xbff\u007f0\vT\x1e\x0f\xefyf\x8c\xc1X\xdfM@%P\n\xae\xcf/\xf1\xe2kg\xc9d\xf2xtݰ\x15\xf4o\x9bH$_{\xbb\xe9R\xaf\x87\xbd&\x12I59\xbd\xfcg\xa3\xf1X\x198\xa6I\x19^]\xdf$\x9b\xcb\x13\xeb\xeb\xc1\xe3՛2[\x95]l\xd2\xd3\x1d\xa1X.\x93Jg\x9d3QX1ͮ\xd1x\xec\xf9\xc9\xe9\xe5\xfc\x1b\x01\xd7\xeb\x02\x96#\x83O\x8c\x8f\xed\x01~\x038\xe4|h]\xd3x\xfa\x89#\xec\xdd5\xd82R/\xec\xf4\x97\xd9\xeb\v\xbct\xea\x1c\xa5R\x05]\xd7J68\u007f`\"\x91\x9ck\x8b\xbe\xbb\x16\x8d/\x8c\xc6cW\x80'\xa5\x94=[\x99,\x1b\xa94\x03}=\xf8}\xde&\x97\x84R\n\x9f\xcfKWg\x98\\.O6W@\xdcP\xba\x8e\x01\x99\xd1x\xec\xe5\xc9\xe9\xe5\xda\xeb\x05\x97\xf6z\xd9\xea\xc4\xf8X\b\xf8\xaf\xc0\a\xddfݱ\xc3\a8\xb4\u007f\xf8\x96\xa0\x9a\xbe:\xc7˧\xce;\xe9\xc6\x19\xe0\xe7\x80\u007f?\x91H\xa6ۢ\xefu\x8b\xc6K\xa3\xf1\xd8\v\xb6Kb(\x97+\xb0\xbe\x99\xa2\xbf/J \xe0k\x02\x97i*BA\xab\xaai+\x95!_,\xb9\x15\xfa/\x00Ώ\xc6cg_\xaf2\xaf\xbd\x1eP٬\xf5o\x80\u007f\x86\xd5'\x01\xc30\xd9?\xb2\x9b\xc7G\x0f\xdd2\x9e5}u\x8eWN\x9dǰ,\xbfMু\x8fN$\x92\xe5\xb6\xe8{âqq4\x1e\xfb;\xe0\x98\x10b8_(\xb2\xb9\x95\xa6\xbf'J0\xe0\xb7\xea.\xb7\x81ˬ\xd7Z\xaeonQ\xa9T\xdd\xe0z/\xf0\xa9\xc9\xe9\xe5\xa5\xfb.\n]wȗb\x85Tz\xc1\xca\x1d\xda1\xd8\xcf;\x9f\x1c\xbd\xa9E\"\x04\xcc\\\x9b\xe7\xe5\xed\xa0\xfa\x8f\x13\x89\xe4Ϲ\x95\xf4\xf6zc\xec59\xbd\xbc1\x1a\x8f}\x1a8\"\x84\xd8W(\x96He\xb2\x96;\"\xe0o\xba\xe9\r\xc3\xf2\xe4+\x05k\x1b[v\x1cV\x80\x95\x1d\xbb\u007f4\x1e\xfbߓ\xd3\xcbŻ\x15\x87\xf2n\xd8\xca\xfew\x1f\xf0\xaf\x80]\xce\a\xeb\xec\f\xf3\xe4\xf1\xc7\xf0譛\xb9H)\xb8>\xbf«\xa7/`X\xe5[\x19\xe0\xa7&\x12\xc9_h+\xe9\xf7֙j;7g\x81o\a>\t\x82\x95\xb5M^=}\x81\\\xae\xd0*O\x9eZ\xcd\xe0`|\x0fû\x86\x90R\xba\xc5\xe6\a\xb0\x9c\xd3\xf7\x87\xb1\\z\x95\a+G\xfd\x1b\x00a\xf5F\xd0x\xfa\xf1#\f\xf4Eo\n\xaa\x95\xd5M^|m\x92b\xb1\x8c\xa6\xc9\x12\xf03\xc0\xc7\x1c\xa7g\x1bT\xf7E\xa1\xdf\x1a\x8d\xc7^\x15\x821`w6WP\x95jM\xf4\xf7v\xb7\xcc{\xd34IwW\x84\xb5\x8d-\x8aŲ\xfb\xa9w\x8e\xc6c/L$\x923w\xc3Z\xda\xdd\xd0\xech<\xf6\x95\xb6\xe32\xec \xfd\xc8\xc1\x11\x0e\x8c\xecn)\x02\x85\x10\xe4r\x05>\xfbr\x92L6\x87\xa6i&\xf0K\xc0ON$\x92\xb56\xa8\xee;\xb8\xd6F\xe3\xb1sB\x88w*\xa5\x062ٜ\x12H\xd1\xdf\xdb\xddTa\xad\x94\"\x10\xf0\x11\f\xf8Y\\Z\xa5V\xab\xe7\xbai\xc0\xf1\xd1x줣\a\xdf\t\xb8\xe4\x9d\xd2\xeb\x89\xf1\xb1a\xac\xde\t1G\xaf\x1a\x1c\xe8e\xff\xbe\xddh\x9a\xd62\xcan\x18&/\xbdv\x96ͭ\x8c\xe3\xb0\xfb\x03\xe0\xc7ۊ\xfa\xfd]\xb6tq~\u007f\x11\xf8!!\xc4|\xadf\x88K\xd3ט\xbd\xb6\x80ւ\b\xaa\xd5\x1aC\xb1~\xf6\x8f\xecA\n\x89\xebD\x8fۄrot,\x97\b\x14\xc0\xd7\x00_Tw\x82z\xbd\x1c>\xb8\x8f\x8e\x8e`KP\xe9\xba\xcekg.\xb0\xb8\xb2\xe6\xe4\x02\xbd\x82\x95\xa6\x91n\x83\xea\xcd\x05\x17\xf0W\xc0OH)\xab\xe5r\x99s\x97fXZYo\xa9\x13\x1b\x86\xc1\xd1C#D\xa3\x91Ƹ\xe3w\x9d\x18\x1f{\xa2\xe1\xbaoXy\u007f\xcav-\b\x80j\xadƁ\x91=\xc4\xfa{Z\xbeX\xd75\xa6f\xaesev\xce\x11\x91\xeb\xc0\xb7O$\x92\vmP\xbd\xf9ಳBN\x02\xff\x8f\x94\x92t&˅\xcb3\xa4\xb3\xb9\xc6L\a\xcbɭ\xeb<y\xfcP\xe3sa\xe0c\xceu_7\xb0\\l\x15\xc6\xea\x96\xf7\x98\x83\xe8\xfe\xde(û\a\xf1x\x9aӊ\xa5\x10d\xb29NM^t\xd7\x03~\xc4\tӴ\xd7[\x06\xb2<V1\xcb\x1f\v!\x98_Ze\xfa꼭K5\xb3V\u007fo\x0f#û\x1a/\xf3\xde\x13\xe3cϹU\xa4\xbb\x06\x96\v\x95O\x01\xdf\xe2vH\x8d\f\xef$\xda\x15iYH*5\xc9\xe7^\x9e\xa4T*;\xca\xdf\u007f\xc7\xeaN\xd7v+\xbc\xf5\xfa\xd6u\xe0\xff\x15B\\R\n._\xb9\xc6\xd2\xca:\x9al\xb6\xe1L\xd3\xe4\xf8\x91\x03\xf8\xb6\xe7yy\x81\u007fwb|L\xbb\xdd9\xca\xdb(\xec=6[\xf58\xca\xdd\xce\xc1\x01v\x0e\xf6Ӫáǣs\xee\xc24k\x1b[\x0e\xa8\xa6\xb1R_\xdan\x85\a\a\\\u007f\a\xfc7)\x85Y,\x95\xb8t\xe5\x9a%\x12e\xb3H\xf4\xf9\xbc\x1c?r\xa0ѩ\x1aǪc\xb8%k\xc9۰\xd51\xac\xa6g\xf57ڳ3Fg\xb8\xa3Ƀ+\x84 \x93\xcds\xee\xf2\x8c[<\xfe\xb3\xb6\xb2\xfe@*\xf3\x13\xc0IM\xd3XXZana\xa5ef\xafa\x18\xc4\xf7\ue8ab\xb3\xa3\x91\xb5\xbe\xfb\xc4\xf8X\xe0Vg*o\xc3V_\v\x04\xc0*.\xdd1\xd8\xcf\xd0`_\xcbn/\x1e\x8f\xce+\xc9sT*\xf5B\xe3_\xc1\xea\x1f\xd0^\x0f\x1e\xc8ց\x8f\x03\x97\x85\x94\\\xb8<\xc3f*Ӥ\xc8;\xeb\x89c\x87\x1a\xcf|\b\xf8\x9e[\xb1\x96\xd6\xca\xc1\x060\x1a\x8f\x1d\x03~\x01\xf0(\xa5\xf0\xfb\xbc\x1c\xdc?\xcc`\u007f3\xb04Mc~a\x99\vS\xb3NV\xc3:\xf0\xd5\x13\x89d\xa1\xcdV\x0f\xcejp\x9eΌ\xc6c\x03B\x88\xcf+\x96\xcaxt\x9d\xdehWc\x99\x18\x00]\x91N\xe6\x17W(\x95+u\x1e\x01v\x8f\xc6c\xbf\xea\xeeI\u007fS\xc6r\xb1U'V=[\xc0\xa2D\x93\xfe\xbe\x1e\x86b}7)\x86P\x9c>?Uo\xc4\x01\xfck\xac^\tmP=\x80\"ѵ~\a\xf8{ݣsy\xfa\x1a\x9b\xa9t˜y\xc30\x18;z\xb01is\xd01\xeaZ\xb1\x96\xbcɛ\x0e5\xeaV\x83\x03\xbd\x84C\xcd\xceP]ט\x9a\x9e#\x9b\xcd;يI\xe0\xe4\x9b\xd1x\xa2\xbd\xde0\xc8.\x01\u007f\" o\x18\x06Wf\xe6(ް淭\xa1X\x1f\xdd]\xdb\xea\x18;\x1d\x8c\xb4\"\x0fق\xad\xbcX\x89^1\xcb\xecT\xf4tG\xd81\xd8G\xadf4(\xecV\xbc\xf0\xf2\xcc5*ժc)\xfe\bV\xb3\xb2\xf6z\x80W\x83\"\xff\xbc\xae\xeb\xcc\\[`+\x9d\xa5e\xa7^\x05G\x0f\xc5\x1bYk\xe4\xc4\xf8\xd8\x17\xb7b-ق\xadz\xb0\xba\x15[\xfa\x93.\xe9\xeb\xed\xa6\xab\xb3\xb3)QL\xd3t\xa6g\xe7\xc9\xe5\v\xceC\u007f\v|z\"\x914\xef\xc4\xed\xdf^o\xbd\x958\x91H\xae`\xb5{J\x01\\\x99\xb9Ny{\u009f\x8d+\xc5\xee\x9d1\x82\xc1\x80\x1bwQ\xac\xe9 M\xacըc\t\xacNt\xeft\x9cd\x91\x8e\x0ev\f\xf67)\xecBX\xb2wvn\xc1\x9dy\xf83X]\xf0ں\xd5åo\x9d\x04N\xe9\xba\xc6\xec\xdc\"\x99\\\xbee\xfcW\b\xc1\xfe}\xbb\xddXЁw\x9e\x18\x1f\xdb\xdd\xc8Z\xb2\xe1\x81 \x96C\xd4e\x11\x84\xe9oQ¥i:\xd7\x17\x96\xc9f\xebs\x84>\v\xbc\xd0f\xab\x87O$\xda}\xe2\xff\x12\xc8*\xa5\x98\xb9:\u007f\xd3:\xd0}{v\xd2\xd0r\xbe\x1fk\x9eQ3c\xb9\x90\x1bq^\xa4\x94\xc2\xef\xf71\xd0\xdf\xd3\xd2\xe5\x0f\x8a\xebsKne\uf5f0\xfaw\xb6\xd9\xea\xe1\\\xbf\a\\\xf6غV\xa1Tn\xf9\xa2\x8e`\x80\xc1\x81^w8\xaf\v+\xd3t۹\xbb\x95w\x89\x95w3\xe4\x00+\x14\fX\r%\x1a\xda\x15j\x9a\xacw#\xb6)s\x1a\xf8{'y\xaf\xbd\x1eJ]k\x0e\xf8\a\xa0R.WXX\\iҩ\x1dB\x19ٻ\x8b\xda\xf6*\xac\xf8\x89\xf1\xb1\xe3n\xe9']@\xf0bM\x85\xb0\x9e\x90\x92Hg\x98H\xb8\xa39\x8dUj\xcc-\xae\x90/\x94\x9c\xb4\x98\xdf\xc4j\x16\xdb^\x0f?k-y<:Wf\xe7Z\x8bC!\xe8\xef\x8b\xe2\xf7y\x1a}Z\xffh\x9b(t\xd1W\x10\xab\xe1\xaa\xe5\xbb\xf2z\x89\xf5G\xddm\x84\xea\n\\\xa1Xd}3E\xb5Z\xc5v-$&\x12ɶ\x18|ȕ\xf8\x89D\xf2\x05\xe0\xa2\x10\x82\xcdT\x86T:\xdb\xd2\xf3\xe0\xf5x\xec*wӭB\xbd\xc3}-\xe9\xb2\x06\x0f\x02;\xea\xc0\xf2y\x19\xec\xefm\xf2]\xe9\x9a\xc6ʚU\xc6-,\xb6\xfak\xac\t\xee\xb4\xc5\xe0#\xe1\xd7\xfa+ #\x84`\xf6\xfa\"Ќ,)\x04{\x86b\xee\x86x\x8eOk\u007f\xa3\x8e\xa5\xe1\xaah\x96R\x12\xee\b\xd2\xd9B\f\"\xac\xfa\xb3B\xa1\xe4Lu\xf8s\xac\x1eKm\xb6z4\\\x0f\u007f\fljRcna\xf9\xa6\x15\xed==]x=\xdbR\x9bcX\xb9{\x9c\x18\x1f\xab\x03K\xb8\x81\xe5\x04$\x1b\x03GB\b\n\x85\x12\xe9LΉ\x19\xe6\x81\xd7n\xd6;\xbd\xbd\x1eJ\x80]\xb3\xc4!f>_$\x95δ\x04\x96\xcf\xeb%\xda\xdd\xe5N\x9f\x1a\xc0\xea\xfc\xccD\"YW\xde;\x81\xa7\x1d1\xa8\xeb\x1a\x03}=\x185\xa3\xc9\x1a\xdcL\xa5\xc9\xe6\n\x0e[}\x06\xab\x15v[\f>Z\xe2\xf0\x93@AJ\xc1\xf5ŕf\xbbP)\xa4\x14\f\x0e\xf4\xba\x15|\r8`\xb7\x17G\xdalu\x18k.\x8c\xa5\x9cy=D\xbb;1\x95\xd9 [%[\xe9,\xf9B\x01!\xa4\x03\xac\xf5\xb6\x18|\xe4\xc4\xe1\xff\x05\x8aRJ\x16\x96V[f<X\x1d\x02\xbb\x1bU\xa5!`\xbf[\xc7z\xaf\x9b\xe6B\xc1 >\x9fw\x9bE \x80J\xadJ&\x9b\xa7R\xad9\x01\xe73\x13\x89d\xb1}$\x8f\x1c\xc0&\x81e!\x04\x19{^u+q\xd8\x11rp\xa2\xdcz\xd6A7c\xbd\xd3m\xf5E\xbb:[^(\x9f/\x92\xbf\x11p\xbe\x82\xd5/\xbc-\x06\x1fMq\xf8\x1aP5\x95bm3\xdd\xf2\xb5\x1e\x8fNWg\xd8\r\xac~`\x9f\x1bX\xa3\x96\xec\xb4,\xc2hwg\x935 5I6_ o\x8f\x90\xc5\x1a˶\xde>\x8aGv=\x0fT\xa5\x94\xac\xac\xad\xb7h\xa1\xa0\x90R\xd2\xdd\xd5\xe9V\xe0}\xd8\xcdb$\xd6\xe8\xb3\xe1\x1b/\x16tE:\x9bJ\xbb\x84\x90\xe4sE\xf2\x85\x92#\x06Ϸ\xdd\f\x8f\xb4\x9e\xf5\"P\x15\b\xd6ֶZ(\xf0\x961\xd7\xd5\x15n\xf4g\r\x9e\x18\x1f\vK\x9b\xba\xea\xfa\x99\xae\xeb\x84B\xfe\x16\x1d\xe0Lk\xa8d\xb5\x9e\"3\xedx\xdb\xdb\xeb\x91\\灢\x10\x90\xcb\x17Z\xceOԤ$\x1c\n6\x0e0\xe8\x01b\x12\xd8\xeb֣\x02~/^\x8f\xa7I\xbf\xaaT\xab\x14K%\x94E{\x05\xac)[\xed\xf5\xe82W\x15\xb8\x06\xa8J\xb5F\xaePl\xa9w\xfb}\xde\xc6Q*\xbd\xc0\xa0\x04\x0e\xb8_\x18\f\x04Z\x8e\xd0(\x97\xcb\xee\xea\xe6\x05\xec\x8cö\xe2\xfeH+\xf0\x17\x01\x13\x14\x99L\xbe\xa9HY\b\xd0=:\x01\xff6\xcb0\n\xf4K\\3\x99\xa5\xb4LȦ~\fRP.W)\x96*\x0e\xb0\x16\x81t[\xbfz\xe4\xf5\xaci\xc0\x14B\x90\xced\x1dߥ[\u007fG\xd34;]\xb9\x8e\x990\x10\x95\xd8\xf9W\x0e\x04\x03\x01_\x93cT\bA\xa5R\xa5\\\xa9 \xe4v`\xb5\xd7#\xbd.\x03\x86\x10\x92L.\xdf\"\x0f\xde\nH\xfb}>\xb7ϳ\x03\x88H\xdb\xf7`\x01\b\b\x05\x03\x8e\x1e\xe5\x86\x16\x95Z\xd5Rܭ\a6l=\xab\xbd\x1e\xed\xb5\b(! \x9f/\xb5\xf6\xc0KI\xc0\xefu\x93\x91\x00\xba\x9b\x80\xe5\xf7y\x9bs\xb0\x80Zհ-\x03\x81\xad_\xb5\x81\xf5\xe8\xaf\x15KǂR\xb9Ԝ@\xa3\x14B\n\xcb\xfb\xbe]\xc8\rH\xec\x18\xa1\xb2E\xa1\xd5\xf3\xaa\xf1\xef\x15՚\x81Q\xab\x8f\xcc\xcdL$\x92\x95\xf6\xbe?\xf2ḱF\xb9RE5\xf86\x1dQhy\x11\xb6=\xd7!\xb1\xcb\xe8\x1dfjU\xbb\xafP\x18\xa6ᦻv|\xf0\xed\xb1\n\x80\x01`\x1af\xcb\x1cxk(\xbald\xb3\xb0\x04\xfc.\xf3\xaf\xd1'\x01X\xd5е\x9a\xe1f\xb2|{\xcf\xdf\x16\xd6a\xd9QyL\xd3lٷC\ba\x91\xd1vduI7[I\x9bښE\xa9\xb2~n<d\xb4\xb7\xfdm\xb3j\x00\xa6R-;8\"\x84\x1d;ކ\x1b\xafl\xf1:Z\xe59[tU\u007f\xdcl\xef\xf7\xdbf)\xe7\xf8[\x15V\b\x9b\xb5\x1aL\xc6\xc6N[\xa2\xe1\xdfV\xcf\xdd\xf4\x05\xed\xf5(/qw\xcf5\xb9R[\xd6\xec\xdbL\xe6\n\xf5\xb4\x81\xf5\xf6Yҍ\x81Vt\xd6\xec\xf7Đu\xaa\xb3u\xa9\x9b\xc9ц\xabz\xda\xfb\xfd\xb6Y\x9e\x1bl\"Z\xaaH\x86i6\xcaɲ\xc4\xee\x0e\x83\xba\xe1\xafjiRJ\xe9\xc6V\xa8\xbdߏ\xfe:1>\x16\xc0vGiR\xb6\xecQ\xaa\x94\xb2\x1c\xe7\xdb1\x97\x968>)a\xb1V\xedf\xc0\xb2\xa6\xcb;\x0f\x05\xdbY\ro\x8b\x15\xc6\xeeS+5y\x93\x01\xe6ʚq\xb8\x1dY\xd9:\xb0\x84\xad\xf9W*\x95\xa6`\xa3\x10\xa0\xebҝ\x9eډ\xdb\xff\xd5^\x8f\xea\xeaq\xf4i]ב\xb2\x19\x17\xa6\xa9\xa8T\xab\x8d\xaaRVb\xa7\x17;\xf2ҕ\x1a\x83[\xb6\xea\xba\xee\x9e\xf2\x15\xc5\xea\xf5\xd0^\x8f\xf6\x1at\x80\xe5\xf7z[\xe8X\x02S\x99v+\xabmO\xacK\xec\x82Sg\x15\xb6\x0f\x9d\xaek\xfe\x1e]w\x97T\xf7\xb4\xf5\xac\xb7\xc5\xda\xedX\x85\xc1\xa0\xaf\xe5\vLSQ*W\x9c\x02\x1b\xb0|\x9c\xeb\x12W\x8a\xb1\xa9\x94]\x85\xd3<$\xd1\xeb\xf1\xb8kȆ\xb0:\x8c\xb4ף\xbd\xe2\x80TJ\x11\x0e\x05\x9bb\x85\x02+\xd4S\xdcNF\x05 #\x81)7\x80\U00085893̷\x1dX^\x0f>\x9fϹx\x1dXm%\xfe\x91\xb4\x06\x9d_\xf7\x03\xd24\x15\x9d\xdb\xeb\a\xeb\xc82\f\xc3\x06V\xfd\xd1\f\xb0)\xb1\xb2\x04\xeb\x00*\x14K\xcd\xe9\x11\xf6d\x8a\xc0\r\xc6څ\xd5\"\xb0\x9d\x9a\xfc\b.י\x1e\x02\xa4B\xd1\xd5\xd9\xdcyH)\xa8Tk\x94\xcaۺ,\xaf\x03\xcb\x12+\xaf\xb9\x0e\xa0r\xb9\xd2Ԏ\xd9\xe9\x97\x15\b\xf8\x9dp\xa1\x06\xecl\x1f\xc1#\xcdZaGyפ$\xdc\x11j\xe9j(\x96ʍY\x0f\x1b\x0e\xb0fq\x05\x95k\x86a\xe77o\xbf\x88\xaek\x04\x03~wZ\xcd~{4J{=\x9a\xeb\x18V\xfbP\x02~\u007f\xe3\xdcB\xc0\x12\x83\xd9l\xbe\xd1V\xac\x03+m\x83\xcb2\x1fM\x93T*\xdb\\R\xad \x18\xf0\xbbK}\x8e`Ր\xb5ף\xb9\xde\tx\x95RD\xbb;[\xf86\x05\x86a\x92J7aei\"\x91\xcc9\xb1\xc2I\xeb\xc5V\xa6\xe0\xc6V\xba\tX\xa62\xe9\xe8\b\x12\n\x06\x9cZ\xfd\xd16\xb0\x1e\xe9\xf5.\xc0k\x9a\x8a\x81\xfe\x9e&\xbd\x1b\xdb\"\xdcLm\xc3J\t\xb8\x8e\xed\xa3PX\r ,z3M\xab\x8b\x9bj\xf6W\x84CAB\xa1\x00\xcaJQ\xdea+\xf1\xed\xf5\xe8\xe9W\x12\xab;\x9f\xa6\x94b\xa07\xda*C\x8fR\xb5B:\x9bw{\xe4W\x81\x19\aX\x00/4Z\x86\x8d\x8eR\xa5\x14\x01\xbf\x8fp(\x84\xbc1P\xe0)[\xc9k\xbb\x1d\x1e-7û\xb0\xc2v\xf8}>\"\xdb'\xac\xd6\xd9*\x9b\xc9S\xadl\xeb\xe9\xb0\x04\\r3\xd6$P\xaf\xba\xa9\xd6\f\xd67SM\xb1!\x04D:;\b\x04|\x8e\x9e\xf5y\xb8\xca\xc7\xda\xeb\x91Y_\b\x84L\xa5\xe8\xef\xebn\x19|6\r\x93\xd5\xf5-4M4\x02k\nn\xf4y/8\xe2P\b\x81Q3\xd8j1\xca\xd50L\xa2ݝ\x84;\x82\x8e\x9e\xf5.\xecJ\xea\xb6?\xeb\xe1_\xae3\xfc\x02 `\x1a&\xbbw\xc4Z\xb4\xb4\x12\x18\xa6\xc9\xf2\xca\x1a\xf2\x06\xe8\f\xe0\x92S\x16\xe8 \xc7\xc4jhZ׳\n\xc5\x12\xa2!\xc1\xd44M\"\x9daۧ\xa1l\x0e\xe3=v\xdeN[\x1c>\x02b\xd0\x1e]2\fV\xaaLl\xa0\xb79vl\xfb\xaf\xb6\xd2Yw\xf8o\x19k\b\xea\xb6v\xdc\x06\xf0)7\x80\n\x85b\xcb4e]\xd7\xe8\xe9\x8e\xd8\xf5\xfa\n\xe0\xcb\xdb\xd6\xe1#\xb5\xbe\x1a\xe82ME\u007fo7~\x9f\xaf\x85~\xa5XY\xdbl\xec\xfa\xb8\x04\xbc\xe20\x9f3\xfdKa5ښw\xfe\xb0P*[M@\x1a\xd0Z\xab\x19\f\xf4\xf5X\xe2P\xd5\xc5\xe1H[\x1c>\xfcbОP\xf2,\x102\f\x83\x91\xe1\x9d-\xab\x1bLer}a\xa9\xb1\x06uz\"\x91\xbc\xe2\xfc\xc7=\xa4\xa9\x8c5e\x02PT\xabU\xf2\xf9b\x93\a\xde4M\xa2]\x9dD\"a7\r~U\xdb:|$\xac\xc1/\xc6nN\xeb\xf5z\x18\x1c\xe8mY5S\xa9TY]\xdbt\x1bw)ǳP\x9f\xfe\xe5b\x99\n\xf0g\x8e\xf9\xa7Le\x8f\x93k\xbe\xb4\x90\x82\xc1\xfe^\x02\xfe\xba8\xfc\x06\xec\x19\xd2m\xd6z\xa8\x95\xf6\xaf\x01\xfak\x86\xc1\xee\x1d1|^o\xcb\xd7\x17Ke\xaa\xd5&7\xc3߸\xaf\xe5\x9e\tm\xda\xca\xd7\x02X]D<\x1e\x9dVū\xb5\x9a\xc1\x8e\xc1~\xc2\x1d!\aX\xfd\xc0\xb8=\xa8\xbc\xcdZ\x0f\xa7\xd2~\x14+\x8c\xa3)S\x11\u07fb\xab\xe54{\xb0Z\xb67<w\xd9\xee\r__\x8d\xa3{\xcb؞S]\xd3lFj\xbe\xb0R\x8a`\xd0\xcf@\u007f\x0f\x1e\x8f\xee\x80\xeb{h\xa7\xd2<\xccl\xf5\x8d\xc0\xb0i\x9a\xf4\xf7v\xd3\x15\xe9lт\xdbZ^\x9f\xc7&\x1d\xc0\x9aS\xf97\x8d\x84\xd2\xf8\x97\x12\xe8\x12B\xe0\xf5z\x1b\xa7\x0e4\xb1\xd6\xde=;\b\x06\xeam\x02\x0fڬ\xa5\xb5Y\xeb\xa1c\xab}\xb6\xd2\xee\xaf\xd5\f\x0eć\xf1x\xb4\x9b\x9e\xbdGӭ\x06}\xd6\xf3\xcb\xc0\x9f4\x12J\xe3Lh\x1d\x18\x92R\x12\n\x05Zz\\\xddJ|w$L\xac\xbf\xc7m\x1d\xfc0V\xab\xc06k=\\l\xf5O\x80QS)\xba\xbb#\xc4\xfa\xa37e+\xb0\x1c\xa4]\x91\xb0\xe3$/au\xfekb(\xf7\xea\x00zt]\xa33\x1c\xdaV:\xdd\\\fm\xb9\xf5\x0f\xc4\xf7X]\x00-\xf4\x1e\x06\xbe\xce6[\xdb\xeb\xe1`\xab\xc7lk\xd0_\xad\xd68\x18߃\xdf\xef\xbf)[9X\x88vE\x1c\x8f\xbc\x0f\xe8\xbb)\xb0\xec\x88\xf6N\xa5\x14\x9a\x94tv\x840\x95\x89\x14\x82l.\xcf\xda\xfaV\x93\x85h\x98&=\xdd\x11\x86b}n\xd7\xfe\x8f\x01\xddmQ\xf8P\xe9VO\x99\xa6IO4\xc2P\xac\x17\xad\x05[\xb9\xc3{B\b\xc2ᐓ\xe5\xe2\xa7E\x96\x8b\xfb\n\x1av\xcfw\xab\u07fb\x1f\xd3T\b))\x96*\\\xbd\xbeH*\x9dmzS\xc308zh\x04\x9f\xd7\xe3·\xffAg:z{=\xd0l\xf5\x1e\xe0+\x00\xadV38\x14\x1f\xa6#ت\x1d\xbbdna\xa5n\tZ\xf8\xf09\",\x84=J\xeef\xc0\x12ة\x12R\n\xbc6P\xacvY\x8a\x85\xe5u\x16W֝9\xd0.]K\xd1\x19\xee`dx\xa7\x1bt\x1f91>\xf6X\x1b\\\x0f&\xa8\xecs\t\xd8lu\xb4f\x18\f\xc5z\x19\x1ch\xcdV\xa6ir\xe6\xfc\xd46\x17\x83Gם\x02V\x8f\x83\x9b[\xe9X6\"%\x1eg쉰\xf2\xe0\x8b\xa5\x12\v\x8b+l\xa5\xd2MY\x0f5\xc3\xe0\xe8cq\x02\xc1z;\xd3\x00\xf0\xcb\xedc|\xa0\xd7W\x02\xcfYG,\xd8?2L\xb8#\xd4T;\xa8\xeb\x1a\x17\xaf\\%\x93\xc9֧\xa9D\xaa\x00\xf3\x00\x00\x1b\xf5IDAT:\xbdj]8\x10w\x04,\x10ۊV\x95\xb2\xbc\xed˫\x1b,.\xaf\xb5\xec\xaa\xec\xd1uƎ\x1ep\xa3\xfa\xf3N\x8c\x8f}w\x9b\xb5\x1eH\xb6:\x04\xfcs\xa0\xabR\xad\xb1ox'\x83\xfd=-\xff\xa6R\xa9r\xee\xe2\xb4\xd5檱M\xbb\xb8\xb9\x8dv\x13`\x99ۦSHi\xb51RJ1{m\x91\x8d\xadt\x93+\xc20\f\xf6\xed\xde\xc1`\u007f\xafc=\n\xe0\xa7N\x8c\x8f\xedm\x83\xeb\x81\x02\x95\xcfv/<\xab\x94\xa2\xb3#\xc8\xc8\xf0N\x82\xc1fK\xd0\xe3\xf1\x90<{\x99j\xb5\x86\x94\x12i\x13\x93\xb2\xc9\xc6\xc5n\xeaV\xc02\xb1\x1b\x84\x98JY\xb5\x85\xf6\x05<\xba\x8e\xcf\xebEJ\xc9\xea\xc6&\v\x8b\xab-ǌ\x19\xa6\xc9;\x9f\x1aE\xd3\xea~\xad\b0\xd1\xf6k=PV\xe0\x97\x02\x1fq\xc8\xe0`|\x0f\x03}Ѧ\xc1\xa7B\b\xb6Ri\xae\xcc\xce\xd5\v\x965W\xab\xf6j\xad\x86i\xfdM\x85\x16\x03Q\xdd\xc0\xaa\x01\xa7\x1d\xffT\xd6N\x92W\xa6I\xc0\xef\xa53\x1c\xc24MtM\xe3\xd2\xf45\xd66\xb6\x9at-\xa5\x14\xc1\x80\x9f\xa7\xc7\x0e\xbb\x1f~\xfa\xc4\xf8\xd8\xc7\x1a-\x92\xf6zs-@\xfb\xf7\xe3\xc0\xbf\x05B\xb5\x9a\xc1\x9e]C\f\xef\xde\xd1\xd2\x19\xaa\xeb\x1a/\x9f:\x87iZmأ]\x91\xba*\xa5\x94\xa2P(8\xee\x86,p\xb6%\xb0\x1c\x9a\x04\x16\x84\x10k\xb5\x9aa\x95\x80i\x1a\xa6R\x84BA\xba\"\x9d\x96\x95(\x04\xf9B\x81+\xb3s\xf6\xb4\xd5f\xdfV|\xef.v\x0e\xc5\xdcn\x8c\xef91>\xf6\x95Ν\xd3\x06כ+\xfe\xec\xdfc\xc0\x0f\x00\x8f\x9bJ\xd1\xd9\xd9\xc1\x81}\xbb넱\xddg\xa5153\xc7\xda\xfa\x96%\xf2L\x93];c\x98\xf6$U\xa5L\xd66RNQMj\"\x91\xbc\xd8\x12X\r\xa93\xc9j\xad\xc6\xc6f\xaa\xeey\xd7u\x8d\xeeH\xb8^D\xe1\xd1u\xa6g\xe7XZYk顭\x19\x06\xef}\xe68\xc1`\xbd7\x9b\x1f\xf8e\xdb\xcb\xdb\x06כ\x0f\xaa \xf0-\xc079\x0e\xf0\x83#{\xd894\xd0\xd4\xc1Q\bA\xa9\\\xe1\xf4\xd9KVoQ\xac\xa1\xe2C\x03\xbdu\xa3M\x99\xb0\xb0\xbc\x86\xa6I\x03k\xe8\xfcm\x95\xf7\x12\xf0wJ)r\xf9\x02\xa9\x8cU\xe5j\x1a&\x03\xfd\xd1\xda\xe0@_Ց\xc5Rj\x9c>7\xc5V:\xd3r耔\x82\xcf{\xd7SH)\x9d\x0f\x14\x03N\x9e\x18\x1f\xebk\x83\xebM\x05\x95\x0e|\x15\xf0ӎ\x18ۻg\a\aFvׁ\xb3\x9d\xad$/\xbfv\x96b\xa9\\\xf7S\xee߷\x1b\xaf\xf7F?\xe3\\\xa1Hj+\x83\x10\"\x87]\x84\xd3x\x96\xad\x80\xf5I)%\xc5R\x99\xd9k\x8b\xe8\xb68\xec\b\x06\xf5\x91=;U\xb8#X5Mk(y:\x93\xe5\xc2\xe5Y\x8a\xe5J\xcbfm=ݝ<\xf3\xf8\x11\x84\xa87\xd5=\x0e\xfc\xb6\x93m\xdaֹ\xee\xbbN%\x81\x0f\x02\xff\xc3\x01\xc9\xe0@/G\x0e\x8e\xd8øT\x93\b\xbc23\xc7\xc2\xf2*\xa6i\xa2\x94\xa2#\x14\xe0\xf8\x91\x03\xf5I\xf5J)K\xa1\xb7\x8e{\x13H\xb42\xced\v\x94\xcf\x00\x9f\xaaT\xaa,,\xad\x90\xc9\xe6\x91RR\xad\xd5\xd81\xd4/G\xf6\xeeRR\n\xd3i\xc665}\x9dk\xf3K-\xdbx\x9b\xb6\xbeu(>\xec\xb6H?\b\xfc\xb7\x13\xe3cA\xd7]\xd5F\xc4=d*\xd7!\xbf\a\xf8u \xa0\x94\xa2+\x12\xe6\xe8cq\xa2]ẳ\xb3\x0e*)Ie\xb2\x9c\xb90U\xcf\x0e\x95\x9a\xe4\x99'\x8el\x1bܕ+\x14\x99\x9a\xb9\x8e\xaei\x06pj\"\x91\x9cj\xf5Y\xea\u007f19m5\xf6\x1b\x8d\xc7*@E\b\xf1\xa1R\xa5\x82i\x9a\xec\x88\xf5;\x8a\xbb쎄E\xb9R\x11[\xe9\xacPJ\xa1i\x92\xe5\xd5\r\x06z\xa3tt4\xb7%UJ1\x14\xeb%\x9d͓Jg\x11\x16\xb5\x1d\x06zF\xe3\xb1OO$\x92\xd5\xd1x\x8c\xd1x\xac\xfe\x19\xda\xeb\xf5-\xf7\xfe\x9d\x18\x1f{\x17\xf0q`\xb7c\xad\x1f?z\x90\xbd\xbb\x87\xa8lO+FJA\xb5Z\xe3s/\x9fa+\x95\xb1\xf4h\x8f\x87\xe3G\xf6\xb3o\xcf\xce:\xb3\x99J\xf1ҫg\xd9Je\x90R\xa6\x80\x1f\x9d\x9c^\x9e>1>\xd6tvZ\vě\xa3\xf1X\x0e\xf8r\xc30\xbb\x8b\xa5\n\xe1p\x88\xeeH\x18\xc34\xf1zu\xd1\xdb\xd3%\f\xc3$\x93\xcda\x18\x06\x86a\xb2\x95\xce\x10\xeb\xefiY.\x0404\xd0\xc7V:M&\x9bGXÅ\x8f\x03\x9d\xa3\xf1\xd8\xf3\x13\x89d\xb9\r\xae7\xceV\xce\xde\xd9\xc1\xe5\xdf\x00\xf6;\xbd͎=\xb6\x9fC\xf1a\xab\xc3q\x83\xb2\x0e\x8a\xe4\xd9)\xae\xcd/Q\xab\x19t\x84\x82\x8c\x1e\x8e\xf3\u0601}uPI)\xb8:\xb7\xc8鳗\x9d\xfc\xbb\xb3\x13\x89\xe4\x0f7\x02\xba%\xb0\x9c\x83\x1d\x8d\xc7ʀ&\x85\xf8`\xb9\\!_(\xd2\x13\xed\"\x18\xf4c\x18&\x1e\x8f\x87\xa1\x81^tM\xa7\\\xa9R\xa9TIes\x94+Ub\xfd\xbdxZ\x8c\xa6\xd34\x8d\xc1\x81^6\xb72\xb6x\x15\x1a\xf0$\xd05\x1a\x8f\xbd<\x91H\x16&\xa7\x97i\x85\xfe\xf6\xba+e\xfd\x1f\xd9:\xd5\x01G]9zh\x84#\x8f\x8d4\x16@ \x84@\b\xc1\xd4\xf4u\xce^\x98B\b\x18\xe8\x8b\xf2\xc4\xe8!\xf6\r\xef\xacw\x98\xd14\xc9\xca\xda&Ͽx\xdaѥ\x8b\xc0\x8fON/'ov^Z#\x95\xda\x1f\xb26\x1a\x8f\xad\x01\xcfJ)\xfa\xf2\xf9\x02\xb9|\x81hW\x84`0Pק\x06\az\x89F#躆\xaei,\xae\xacQ\xad\xd6\xe8덶\x9cb\xe0\xd1u\x06\xfa{\xc8drN\x97\x12\rx\n\x18\x18\x8dǒ\x93\xd3˩6\xb8\xde\x10\xa8\xbe\x16+\xf8\xbf\xd7\x11gG\x0f\x8d0\xfaX\xbc\xe5`\b\x04\xcc-\xaep\xf1\xcaUB\xc1\x00\aF\xf606z\x88hw\xa4\xee\x89\xd75\x8d\xa5\x95u>\xf7\xca\x19\xbb\xed\xb6\x00x\x19\xf8\xfe\xc9\xe9回SS\uec4b\xb5Ҷ\x95\xf8eB\b\x91\xc9\xe6Ig\xf3t\x84\x82tt\x04\x11\x02j\x86I(\xe8g\xc7\xe0\x00=\xdd\x11\xba\"a\xf2\xf9\"\x9d\xe1\x10\x01\u007fk\x91\xe8\xf3z\xe8\xef\xed\xb6L\xd6t\x0e\xeb\xa6\x11c\xc0\xc8h<v~rzy\xa5\r\xae\xbb\x03Չ\xf11}4\x1e\xfbN\xe0\xe7\x81~\x87\xa9\x8e\x1f\xde\xcf\xd1C#7\x1d`Y\xab\x19lne\x88vur\xf4\xf0~v\r\r\xa0i\x12\xd34\x91Ҋ\f\xce\\_\xe4\x95S\xe7\xc8\xe5\v\x8e\x87>\v|\xc7D\"9s\xab3\xd2Z)\x80.]\xeb\x1a0\x00\x8c\t!Hgrl\xa62\b\x01\xe1\x8e\x10^\xaf\x17\xd341M\x93@\xc0O\xac?ʎ\xc1\xfe:;\xb5Z\n\xf0\xf9\xbc\xf4\xf5tS*\x97I\xa5\xb3(\xeb\x8b\x1e\x04\x8e\x8f\xc6c\xf3\x93\xd3\xcb\xd3\xce\xe7h\xeb]\xad\x015\x1a\x8f9\xa0\x8a\x01?\x02\xfc8\x102M\x93\x80\xdf\xcf\xe3\xa3\a9\xb8\u007fo\x93\xf5\u05f8z\xa2]\xf4\xf7F\xd15\x89aZ\xa3K<\x1e\x0f\xe9l\x8eKW\xaer\xfa\xdc%\x8a\xa5\xb2;\xec\xf3щD\xf2\xd7\xddLyG\xc0r\xb3\xd6\xe4\xf4rq4\x1e;\x87\x95!\xb8_JI\xbeXd}#E&\x9bC\x00\x1d\xa1 ^\xaf\xd7\x1e\xd6c}\t\xaf\xe7\xd6\xc3\xc1\x94\xba\x01.ð:ۘ\xa6\x89\x10b\x17\xf0\xee\xd1xL\x8d\xc6c\x93\x13\x89d\xad\xcd^\xadY\xcaޗg\x80\xff\x04\xfc\v@3\f\x83Hg\aO\x8d\x1dfdx\xd7mA\xe5\xf8\x1eM\xd3Dj\x12\x8f\xaeS(\x96\x98\xbe:\xc7\xf9\xcb3L\xcf\xce\xd7\xd9\xcb^\xbf\x02\xfc\xd8\xe4\xf4r\xedv7\xbc\xb8C\xd9\xfd\x18\xf0Q\xe0\xcb\x1c\x17\x82a\x9atv\x84\xe8\xed\xe9b\xa0\xaf\x87\xd8@/\x91p\x875x\xda\x1eOg\x9a\xea\x96I\xf9RJʕ\n\x97\xae\\\xe5\xc2\xe5YJ\xe5\n\xba\x95\x8e\x93\x02\xfe\x10\xf8\xe9\x89Dr\xd6\xed\xebz\xbbfI4~\xff\x13\xe3c\xdf\n|'\xf0\x04@\xb5Zch\xb0\x8f\xe3G\x0e28\xd0\xd3Z\xa7\xb2\x0f\\H\x81\x10Ҟ\xe8&\xa8T\xabll\xa5Y^\xd9`u}\x93\xb5\x8dM\xca\xe5j\xa3\x9e\xfc\x8b\xc0ON$\x92\x9b\xb7c\xab[\x02\xab\x05\xb8\x86\xed;\xe3;\x80n\a\xe9J)\x02\x01\xbf\xa5{\x85\x02\x84;B\xf5\xdf;;B\x84BA4MR\xad\xd6Z\x82LJI\xadV\xe3\xfa\xfc\x12g\xce_!\x95ɺ\xc5\xe8\xe7\x80_\x98H$?\xd1\xea3\xbdM\x15\xf48V\xda\xcb7\x00=\x8e\xa48t`\x98\xc7\xf6\xef\xa5+\xd2ْ\xa9\xa4\xb4\x86\x82W\xab\x06\xb9\\\x81l.O._$\x9bϓ\xcd\x15\xac\xdfsy;\xf7J\xb8#)3\xc0ǀߛH$Sw\xba\xff\xe2.\xbfT\a\xf0\x0e\x1b`_\xe7v\x82\x9a\xa6\xb2\xa7\x84\xe9x<z}\xf6\x8e?\xe0g\xa0\xb7\x9b}\xc3;\t\x05\x03M&/X\x03\xceM\xa5X\xdfLq\xfa\xece\x96V\xd6,\xe5\xd1\xfar\xcbX\xcdJ\xfe\xe3D\"9\xf7v\x05\x94\xfd\xffo\xb6\xf7\xfe\x19@\xab\x19\x06\x1e]\xe7\x89c\x87\x18\xde5\x84\xdf\xefk\x19\x01\x91RR(\x14\xb9<}\x8d\xf5\xcd4\xe5r\x99j͠Z\xabQ\xad֨\xd5\f\xbb\xbco\xdbL\xca\x02\xf0\xffْ㵉DҼ\x9b\x9bZ\xbcN\x1a\xeeê\xf3\xff:\xac~J\xc1Fo\xbbr\xe6\xb6\n\x81\xcf㡳\xb3\x83\xe3G\x0e\xb0k\xc7\x00\x95J\xf5\xa6\xf2\xbeP,q\xe9\xcaU.N]\xa5V3\x1c:\xae\x01\x17m\x19\xffK\xeePУ\xc8^-\xf6\xfbq\xe0\a\x81q\xac\x01Y\x94+\x15\x86\x06\xfa8~\xf4 }\xbd\xdd\xf5\f\xdfV\xfeÍ\xcd\x14/\xbe6I*\x9d\xbb\x91\xa0)čA\xe1\xdb\xd7\"Vr\xe6\x9f\x00g&\x12\xc9\xec\xeb\xd9k\xf1\x06(Y`\x8d\x97\x1b\x02\x9e\xb6e\xfdQ\xac^Y\xfd\xd8\xcd\xe7\xeb@\xb3\x15\xfdc\x87\xf7\xb3\u007f߮\x9b\xeb\x00B`\x18\x06+k\x1b\xbcv\xfa\"\x9b\xa9\x8c=\x84\x13G\xf7\x9a\x04\xfe\xcbD\"\xf9\x17\x8f\x9axl\x01\xa8!\xac\x1c\xaa\xafƚĥ\x19\x86\x81\x10\x82\xd1\xc3q\xe2{w\x11jQ\xae\xe5,\xd34\x99\x9a\x9dc\xf2\xc2\x15\xca\xe5\n\x02\xd1Xt\\\xc4\xea\x896m\xef\xeb\v\xc09\xac^\xed\x997\xb2\xbf\xe2\x1eQ\xb4\a\xab2\xc7o\x03J)Kd\xfe\x1av\xa3\x90Z\xcd \xdc\x11\xe4\xe9Ǐ\xb0gg\xac)\x15\xb6\x11\\JY\xe3\xca.\\\x9e\xe5\xc2\xe5\x19\x94Rn\xebd\xc3v\xd2\xfd\xccD\"\xf9\xe9\x87\x1d`-\xf6\xb3\v\xf8n\xe0\xc3\xc0^\xc0\xab\x80j\xa5Jl\xa0\x97Ǐ\x1e\xa4'\x1aA\xd7\xf5[\x1aG\xa6RL_\x9d\xe3\xd5\xd3\x17)\x97+\xeeF\x1e\xab\xc0\x8fK\xf8\x84\xb2άl\xfb,\vv\xd7!ި\xc1$\xee\xe5\x868\xeb\xb9\xf1\xb1\x0f\x00\xbf\r\xc4\fSI]\x93\x1c98¡\xfd\xc3xt\xed\x96\xd5\x1d\x8d\x003\x95\xc9\xd6V\x96WϜgeuí{\x99XS5^\x06~n\"\x91L<l\x00k\x01\xa8~\x1bP߈5\xab\xc8\aV\x17k\xbf\xcf˱\xc3\xfbٻg\xe8\xa6}\xabn\xc6Z\xf9B\x89\xf3\x97g\x98\x9a\xb9\x8e\x02\xa7v\xf0\xd3\xc0\xf7\x9cL$\xcf\xde鹾i\xc0j\x00\x13'\x13I\x9e\xb3\xe8\xfbe`\xc84Mv\xed\x88\xf1\xce'\x8f\xe2\xf5x\xee\x18P\xad}_\x8a\xb9\x85\x15NM^$\x9b˻\xab\x84L\x9b\xd2/\x03\xbf\x04|\xc2\xd1\v\x1eD\x90\xb5\xfa<'\xc6\xc7ƀ\xef\xc2Jȋ`O\x8e7l\x1fR|x\x17\x87\x0f\xee\xa3#\x14x\xfd\xfb\x87bs+ë\xa7/\xb2\xb4\xba\xe6X\xde'\x81\x1f<\x99H.:\xe7w\xaf\xd6\xfd\x00\xd6\x1f\x02_e\x9aJ\xee\xde\x19\xe3\xfd\xefz\xe2\x96t}\xb7\xcbT\x8a\x99\xabs\x9c>w\x85B\xb1\x84~\xc3ע\xb0\x9a\xf4nbu&\xfcM\xe0y\xbb\xbf\xea[\x0e\xb2\x16\xec\xd4\v|-\xf0O\xb1\x82\xf1\xd2\xfe\xa9[ػv\xc4\x18=\x1c\xa7;\x12\xe6^l\xa1\x94\x82t:\xc7K\xc9s,,\xad9yV\xdf~2\x91\xfc\xd5{\xfd}\xefiW\x98\xe7\xc6ǎ\x01\x9f\x01:C\xc1\x00_\xf1\x85\xef\xbf/\x87\xe4\f\b\x9a\xb96Ϲ\x8bӍ\f\xe6\x06\xdau\xe0O\x81?\x02^\x9aH$\x8b\xb7R\x96\xef'+ُ\xef\x06\xbe\b\xf8\x10\xf0~\xb7\x81㰲\x14\x92\x9d;\xfa턼HK\xf7\xc1\x1bY\xba\xa6\xb1\xb0\xbc\xc6\v\xafN\x92\xcd\x15\x90R\xfc\x15\xf0\xbd'\x13\xc9+\xf7\x92\xb5\xee5\xb0>\n|\xa4f\x98\xde\xf7>s\x8c\x91=;[\xce`\xb1R\x95\xad< G<\xbe\x1eV\x93\xb6\x89=\xbf\xb8\xc2\xf9K3\xaco\xa6n\xf5\xf2-\xac\xfc\xec\xbf\x01\xfe\xc1v_\x14\\ t>\x80\xba\x1b\xa0\xb9\xb2_\x85k?\x9d߇l6\xfa\x80\xfds\xf8f\xd7\xf1z=\xec\xdd=ġ\xf8^:;C\x18\x86q\xd7,U\x0f\xd1(U\xafC0\x9d\xfe\x1b\xae\xbd7M\xc5kg.r\xf6\xd24\x1e]/\x00_\u007f\xd2ee?\x88\xc0J\x02\xc75M\xf25_\xfal\x8bji\x93\xb9\xc5ef\xaf/\x90\xcd\x15\xa8V\rBA?\xa1`\x80\x81\xbe\x1e\xf6\xec\x8c\xe1\xbf\xd10\xf7\xae6TJI:\x9d\xe1\xf2\xcc\x1cs\v\xcb\x14\x8be\x10\xb7\x04l\x11\xb8`\x9bٓX\xd5&W\xb1\x8a/\xd3ܘ\xe1\xe8\x06\x1d-\xc0\xe3ǊD\xec\xb0-\xb8\xc3X\x93ю\xd9\xc0j\xfd\x99\xa5D\nA\xb4\xbb\x93\xfd\xfbv\xb3{G\f\x8f\xc7Ӳ\x10\xf8v\xabT*s~\xea*\xb9|\x9el\xb6@\xa9\\\xc6\xe7\xf5\xd2\xdd\xdd\xc9\xde]C\xec\x18\xec\xdb\x06R\x8fG\xe7\xca\xec</\xbdv\x96J\xb5\x8a\x10\xe2G\x81\x8f\x9dL$k\x0f\x1c\xb0\x9e\x1b\x1f\xeb\x01\xce+\xa5\xfaw\r\r\xf0\xfew?\xe5\x144\xe2(\xa2\xe7/\xcf\xf2ҫ\x93\xf8|>\xb7?\xc5P`\x1a5\xc3\xd3\xdf\xdb\xcd㣇\x18苾.E_\b\x81\xa6I\f\xc3dum\x93ٹ\x05VV7(\x95*Vt@\xa9\xba\xf3\xf66\x97\xcfۺZ\xd6f\xb5\x9c\xed\xa4u\x80ԁվ'b\xfb\xf2\xb4\xd6\x06\auVv\xc2$\x9a\x94tE:ٵc\x80=;\a\xe9\xe8\xb0\xd8\xe9\xf5\x88<\xd3T\\\x9b[\xe4\xe5\xe4yʕJ\xf3(@\xd3D\xd34\x9e8v\x88\xd1\xc7\xe27r٥$\x95\xce\xf2ҩs,\xad\xac\xa1iگ\x03?r2\x91\\\xbdg\"\xf7\x1e\x12V\x0f \xac\xe6\xa8\xd1m\xa0r\xbe\xe4\xfc\xe2\n\xd1\xeeH=\x88m\x18&\x86ad5!\xaeh\x1e=\xba\xb9\x95\x1e\xfe\xe4\u07ff(G\x0f\xc59t`\xaf\xbbS\xe0\x1d[\x8e\x8e\xe3u\xa0\xbf\x87\xa1\xc1>k\xa6\xdeV\x9a\xc5\xe5uV\xd66\xc8d\xadx\x98Y\x0f\x94\x9b\r\xef!\x10\x82\x90\r\x9c;~_7\xb8\x85\x10H\xcd\xeau\xa0i\x92\x80\xdfGO\xb4\x8b\xc1\x81^b\x03\xbd\x04\x03V&\xaei\x9aT\xab\xd5\xd7q\x03A\xbeP\xe2\x85W&YX^E\xd34tM+\x03>S\x81\xae[\x01fGb\xac\xaem\xa1\x0e\xaam\x9f7\x14\xf4\x13\fX\xc3\xe35\xeb\x06\xf1\xddS]\xee~\x88U%Z+\x8dϾ\xefi<\xba\x87j\xad\xc6V*õ\xf9%\xae\xce-vU\xaa\xb5-M\x88\x9f\x95R~5\xf0\x85\xa7&/\x86\xf3\x85\"c\xa3\x87\b\x05\xfd\xafK\xffr@\x03\xd0\x15\xe9\xa4'\xda\xcdqy\x80j\xb5J*\x9dcc+E*\x95!\x9d+P*\x95\xa9V\xab\x98\xa6\xc20\x8cz\xb1\x81S\xf9\xad\xeb\xfa\r\x9d\xc54\xa9\x19F\xfd9\xab\x11\xb0\a]\xd3\xd04\r\x9f\xd7C(\x18\xa0+\x12&\xda\xddI\xb4+B(\x18@H+\xb1N)\xd52\xa4u\xc7z\xa5\x10l\xa5\xb3\xfc\xed\xf3\xaf\x90\xcf\x15\xd05Ma\xf5Y?\xaf\xe0\x03\x01\xbfO\xc6\xf7\xeed\xd7\xd0\x00\x9d\xe1\x0e\xa4\x14\xd4\f\x93j\xad\xd6B\nܿ\x8e\x9e\xf7\x12X5\xebn\x92\xa4S\x99\xba\xf7\xdc}'\xfb\xbc^P\n\x9f\xd7\xc3P\xac\x8f\xa1X/\xe1\x8e\x10\xaf\x9e\xb9\xf0AS\xa9\xbf\xfb\xbd\xff{\xfa\xebN\x8c\x8f}\xab\xdf\xe7\xfd\xf6K\xd3\xd7F\xa5&}cG\x0f\xba\a\x154\xe8l\x06BHt]\xb3E\x9c\xba\x05\x93\xddP\x1f\xba#az\xba#v\x1cRP3j\x94\xcbU\xf2\x85\"\xe7/M3{}\t\x84\x15\x82ꊄ\xe9\x8dv\xe1\xf3zQ(\x8a\xc52\xeb\x9b)R\xe9\f\xc5R\x19]\xd7ٿo7\xfb\xf7\xee\"\x10\xf0\xe3\xf1\xe8hBb(\x8b\x91\x95r\x061ܝ\xf2-nb\xac\xa4\xd2Y>\xfd\xc2k\xe4\xf3E\xa4\x94\x1b\xc0\x8b\xc0\x8f+\xf81!\x84|\xf7S\xc7س{\x90j\xb5Z\x17\xc5^\xa97\x9c\x05\x14\xcb\x15J\xe5z\xaa\xb1\x01\xa8\a\x15X+@U\xda}\xb4Z\x1dr\xfd1e1\x83\xa6i\f\r\xf4r\xb5;\xc2\xda\xfa\xd6\x17<7>\xf6\xfb\x13\x89\xe4\xff\xfc\xc6\xf1\xb1\xff\xed\xf7y\u007f\xe2\xc2\xd4쇥\x94\xfec\x87\xf7۳\xa8\xb7\x8b\x83B\xb1L:\x9b\xa5#h\xa5\xe9xm\x8f\xf4\xad@\xe6\x1c\x9ci\x18\xdbRv}>/\xd7\x17\x96\x99\xbd\xbe\x88\xa6I\x06c\xfd\x1c{,\xce@\x9f5]\xd44\xadÖ\xd2\x1a\xa96\xb7\xb0¹K\xd3,\xafn0{m\x81\xfe\xdenB!+{\xa3zWb\xcdҿj5\x83B\xb1\x88\xa9 \x14\xf07)\x81\x0e\xa8\xfe\xe1\xa5\xd3d2\xf9\x82&E\x12\xf8ՉD\xf2\xe3ύ\x8f\xed\x01\xbe4\xda\xddɞ\x9d1\xca\xe5J\v\xcf\xcb\xf6ke\xb29\xcbMc\xe9e\x1b.\v\xf9\x9e,y\xaf.t2\x91\xccaE\xc6U&_`mc\xeb\xb6\n\xb8\x95\xd2\xec\xa3;҉i\x9a#@\x1c\xe0w\x13\xc9%\x05\xff\xda\xe7\xf5~\xe6\xdc\xc5i\xce^\xb8Ҕ\xb7\xad\x14t\x86\x83\xa4RY>\xf3\xc2)Μ\x9fbna\x99\xadt\x96j\xb5Z\xd71\xee\xc4\b\x90R\xb2\xb6\xb1\xc5˧\xac\xe8\xc6\xc8\xf0.>\xff\xddO\xd2\x13\xed\xaaW!\xd5j5\xaa\xb5\x1a\xe5J\x95Z\xcd`\xf7\xce\x18\xefyf\x8cᝃ\xa4\xd2Y&\xcf_!\x9d\xc9߲\x8d\xb5ۂu\xf4\x9fB\xa1\xc4\xf2\xea&\x97\xa7\xafs\xf6\xc24\xe9\xe6\xe1\xddh\x9aF&\x9b\xe7s\xaf\x9cas+\x85\x94\xe27\x14|\xf1D\"\xf9q\xfb%\x1fTJ\x99;b}T\x8d\xda\x1d\xa8\t\x96\x17>\x9b+8\xfb3\x83\x15\xe0\u007f\xb0\x18\xcb\xe5X\xfb\x14p\\\x93\xd2s\xe6\xdc\x14Ͼ\xef\xe9[\x9b_v\x83\x11\x9fσ\x82\xb0mm9\xd7K?7>\xf6Q\x9fϻ\xff\xfc\xe5\x99\xe1\xaeH\x98\xfd\xfbvoc\"\xa5\xe0ؑ\x03\xd4\f\x83\xe4\xd9\xcb\xe8\xdal=\xa35\xda\xd5Y\x1f\x8e\x1e\xf0\xfb\xd04[\\\x9a&\n\xd5\xc0~\x82Sg.P3L\x86\x06zy扣\xb7\xb5Ҫ\xd5\x1a\x9d\x1d!\x8e\x1d\xd9O\xa1Xb~i\x95\x85\xa5U:B\x81m`\xae+\xf3R\x80\x82r\xa5J\xa1P$W(\x92\xc9\xe6Y\xdb\xd8bye\x9dJ\xb5ʓ\xc7\x1fk*(\x95RR\xa9V9s~\x8a\xf5\xcd\x14B\xc8O\x01?u2\x91L\xbb>ΐi*\xd1\x11\n\xdd\xd6\xf7\xa5i\x1a\xeb\x9b)\xe6\x16W,\u007f\x97\xc5T\x97N\xba\x82\xcf\xf7bi\xf7\xe2\"goTQ/\x00\x1f\x16B\x042\xd9\x1c\xba\xa61\xd8\xdf{S\xb1\xa4\xd9=\"f\xae-\x90\xce\xe6V5)\xff\xe8\xec\xf4\xf2\xf4\xd9\xe9e\a\\\xb3\xa3\xf1XP\b\xf1\xae\xd5\xf5MOo\xb4\x8bHg\xa8\x01\\\x8a\x81\xbe\x1e\x94Rll\xa5\xc9\xe6\xf3\xac\xacm2\xbf\xb8\xc2\xdaF\x8aT:K&\x97\xa3P(R\xa9TQ\xb6!\xe1\xd1u4\xbblms+\xcd\xe4\xf9)\x94R|\xfe\xbb\x9f\xc4绳 \xafi\x9att\x04\xa9Tk\xd6\xfc\xbe\x9a\xc1P\xac\x8f\x8eP\xd0V\xf6\xad\x06v\xd9\\\x9e\x8d\x8d4K\xab\xeb\\\x9f_b\xfa\xea<\x97\xa7\xaf1{}\x91\xad\xad4\xba\xae\xf3\xc4\xe8!\x0e\x1fܷ-\x9d\xc8\x01\xe8\x85˳L\xcd\xcea\x18撔\xe2{O&\x92\xe7\x9c\x1b\xf0\xacUQ\xb5_\b\xbe\xdc\xe7\xf3\x88\xe1\x9dC7\xbd)\xa4\x94\x94Je&/L1\xbf\xb8꤁\xff\x15\xf0\x9bg\xa7\x97\xd3\xce\xf5\x1e(\x1d\xcb\x06\u0085\xe7\xc6\xc7>\x0e|D\xd34N\x9f\x9f\xa2\xb7\xa7\x8b];bM\xa9ɚ\xa6a\x1a\x06W\xaf/\xb2\xbc\xba\x81\xaei\xe7\xb0f&6\xae_\x14B\xfc\x93r\xb9r\xf8\x95\xe4y\xde\xf1\xe4Q\xfa{\xa3\xdb6OJ\xc9\xd8\xe8At]\xe3ҕk\x14\x8b%\xcba\x9aɲ\xb9\x95\xb6u(\x0f\x1d\xa1 \xe1\x8e\x10\xc1\xa0\x9f`\xc0O\xc0\xe7#\x18\xf43\xb7\xb0\x82\xa9Lz\xa3]tuuޕO\xc9\x1a\x18\x19\xa5\xa7;\xc2\xfaf\x8a\xeb\v+l\xa62\xf5\x81\xed\x85b\x89\\\xbe@6W\xa0P(a\x98\x86\x95k.%R\n:;:8z(\u0381\x91\xdd\xf5\xc6\x1b\x0e\xa8\x94R\xcc\\[\xe0\u0095\xabT,\xf1\xfe\xdb\xc0\xab\rR\x02\xe0\x93B\x88\xc2\xf5\xb9\xa5\xd0\xf5\xa1\x18\xbbvX\xbd\xac\xdc\xd7s\xc4\xeb\xcc\xf5\x05f\xae-8#\xe12\xc0ɓ\x89\xe45[\x9dy\xb0\x18\xcbf+\xe7\xeey\x05+\xd9\xef\xb0R\x96,\xf7y\xbd\x84;\x82\xf8\xbc7\xda\rn\xa52\x9c\xbb4\xc3\xd4\xec\x1c\xa5R%+\xa5\xfc\xf9\x93\xae\xdc*\x17kUG\xe31\xaf\x94\xf2\xd9|\xb1\xa8m\xa62\f\xf6\xf74y襔\f\xf4E\t\x06\xfc\xd4j5\xb2\xb9\xbc\xe5\xa3ѤՊIY\x16\xddf*\xcd\xea\xea&\v˫\xcc/\xae\xb2\x99ΐ\xcad)\x97\xab\f\xef\x1ebp\xe0\xee\x87\xc5z=:+k\x9bln\xa5\xd9\xdcJsun\x91\xeb\v\xcb,.\xaf\xb1\xb9\x99&_,a\xd8\xe9\xbfRJLS\xa1I\x8d=;\a\x19=\xbc\x9f\xbd{v4\xb5\x14\xd24\xc9\xdc\xc22\xaf\x9e\xb9@\xa1PDӴy\xe0?\x9f\xb4\x87M:\xccb\xefQj4\x1e\x8b\x1a\x86\xf9\ued4d\x94]&\x1f \x18\xf0\xa3\xe9\x1aRX\x92aj\xf6:\x17\xaf\\\xa5\\\xae\"\xa5,a\x15\xc8\xfc\xd6\xd9\xe9\xe5ʽd\xab{\xcaXvf\x03'\x13ɍ\xe7\xc6\xc7~\x00\x98\x11B\xfc˭tF\u007f)y\x8e\xfe\xf9(\xa1\x80\x1f!\x05\xd5J\x95\xcdT\x86\x8d\xcd4\xa62s\x9a&\u007f\x06\xf8\x83\x16w\xa2\xb3~\x19x\xaf\xaei\x1f\xda\xd8J\xf3\xe2kgy\xcf3\xc7\t\x05\x02\xf5\x03q\xfcJ#\xc3;\xe9펰\xb8\xb2\xce\xea\xda\x06\xd9|\x91j\xadJ\xb1X\xc6\xc4$\x14\f\xe1\xf5z\b\xda\x05 \xb1\x81^\xce^\xbcB.WpO2\xbb+\xa7\xac\xc7\xe3\xc1\xa3\xeb\x18\x86A_o7>\xaf\x97L.G\xb5bP(\x15\xa9Vj\xe8\x1e\x8d` \x80\xa6I\xba:\xc3\xc4\x06z\x88\xf5\xf5\x12\x0e\a\x9b\xb2i5M\xb2\xb4\xb2A\xf2\xdce\xf2\x16\xa8L\xacb\xd4\x17o\xb1G\xffY\bџ\xcd\xe5?\x9c<{\x99\xe5\xb5\r\"\xe1\x0e\xab^\xd00\xc8\xe4\n\xaco\xa4(\x96\xcbh\x9a\x9c\a~\x16\xf8\x8d\x93\x89d\xf6^\xa7\xcc\xdc\x17\x0f\x99+}\xa6\x03kF\xcb7+\xa5\x9e5L\xd3\xebT\xd7Z\x14\xad\xaeJM\xfb\x8c\xb0r\xab?y2\x91̴\xfa\x82\xae\xeb\r\xdb\x01\xe4}\x86i\xb2#\xd6\xc7;\x9f\x1a\xa53\x14j\xb2\x18\x9d\xe0t\xbeP\xa4\\\xae`\x98&\x95J\x15\xd34-g\xa6n\x15{\xf8\xfd^\"\x9d\x1d\xfc\xd5\xdf<\xcf\xdc\xc2\n\xc7\x0e\xc7\x19=\xbc\xff\xae7P\x01Ͽ|\x9a\v\x97g\xf9\xc0\xfb\xde\xc1\x8e\xc1>J\xa5\x12\xb5\x9aI\xa5Z\xa5Z3\xf0\xe8V\xef|)\x05\x01\xbf\xbf>=\xcb-v\x1dF[^\xd9\xe0\xa5S\xe7\xd8Lg\x1cw\xc0o\x01?`ߴ\xb7ڣ\x18\xf0%J\xa9\xaf4L\xf3i)Ā#R\xed\f\xdcM!ğ\xd9\xd7\xfb\xec\xc9D\xb2\xf2\x9c\x1dD\xbf\xd7\xc0\xd2\xef5\xb0\\̕\x03>\xf1\xdc\xf8\xd8\xcbB\x88a]\xd3:\x94\x9dҠi\xb2b\xfbN\xe6O&\x92\xcb\xce\xe6\xdc\xe6zW\x9f\x1b\x1f\xfb~\xe0\x0f5)}\x8b\xcbk|\xf6\xc5$\xcf<1J_\xb4k[\x17\x15\xe7\xb0:\x82\x01¡`K\xcbT)\x13\xa5\xa0V\xad\x11\x0e\x05Ѥd\xd3j\xcfsW:\x96\x90\x92l.O\xa1X£\xeb\x04\xfc\x1e\x82~\x1f~\x9f\xd7~[\xd1\xe4Or<\xfc\xad\xfcY\xf3K\xab\xbc\x92\xbc@:S\x1f/\xf3*\xf0\x8b'\x13ɍ;\xd8\xf3e\xe0מ\x1b\x1f\xfb\x1b]\xd3\x06\x15t\xa1\x94G\b\x89\x94\x18vp}\xead\"\xb9r\v\xe6{0\x19\xab\x85\v\xe2\r\xbf\xd6\xfd\xfcs\xe3c\xdfa\x8bF\x94RD\"a\x8e\x1e\xdcǁ}{\xac\x96Jw\x19̕R\xb2\xb4\xbc\xc6\xdf}\xf6\x15\x94\x80\x0f}ɳ\xee\xdc\xf0;Я<\\\x9e\xb9\xc6˯\x9d#\x10\xf0\xf3\xdew\x8c\x11\xed\xbe\xbb<*'p~a\xea*\x17.\xcfP(\x96\x1d\xe5\xfa*\xf0}'\x13\xc9?\xbf\xd7@\xb8\x9f\xa0\xba\xa7\x0e\xd2Vwѭ\x98\xc8\xfd\xf8\xed\xbe\xa0sG\xda\xebׁo\x06jB\b\xd2\xe9,\xaf\x9e\xbe\xc0\xa7\xfe\xe1e\xb6\xd2Y|>o\xcbN7\xb7ґv\xed\x18 \x12\xe9\xc04LN\x9f\x9f\xc2\xe3\xd1\xee\x18\x94\xd9B\x81\xf9\xc5Ur\xf9\x02\xbbw\xc6\xec\xa9X\xb7\xd7ӄ\xb0\x00\xe5\xf1\xe8,\xad\xac\xf3ɿ\u007f\x89\xc9\xf3SnP]\xc4JW\xfe\x8b\xd7s\xa3\xde\uee53\xf79\x93\xf6\xa1\x9a+\xe8\xd2%<\xc0\xe7\xd9\n\u007f\xb7\xa3\xb8\a\xfc~\xfa{\xbbٿo7\x03}Q\xab!\xabrĞ\xaa\x9f\xa8up\x02\xa3f\x90\xcd\xe7Y]\xdb\xe2\xf4\xf9\xcbV\xfcM\b\xde\xf1\xd4(\aFvS\xa9\xd4n\x01*\x81Rp\xe6\xfc\x14\x93\x17\xac\x01FcG\x0f\xb2\u007f\xefn\x02\x01\x1f\x9am\x89Z\x9d\xa7\x15N\x1cU؎\xd2b\xa9\xcc\xfc\xd2\nWf\xe7\xd8Le,\x1f\x9b\xaa'>>\x0f|\x1f\xf0\xaaK\xcc=LG\xc5C7\xb0\xd2\x05.i\x87\x80~\x18\xab\xd5t\xbdDL\xd74|>\x0f\xa1@\x00\xaf\xcfC(h\xb9:\x84\xb0\xd2j\xb2\xb9\x02\x95j\x95|\xa1D\xa9\\\xc64LK\x84*5\x03\xec\xd3u\x8d\xa3\x87\xe2\x1c;\xb2\x1f\xd3T\xdbRk\x84\x10蚤T\xa9p\xfa\xec\x14\x97\xa7\xaf9\rM\x90V\xfa\n\xa1\x90\x1f\x9f\xd7K \xe0\xa3#\x10\xa8OL+\x96J\xe4\nEj\xd5\x1a\xd9|\xd16(\f;\xc7]\x80\x95\xff\xf5\xf3\xc0\xaf\x9cL$\x97\xde\f\x91\xd5\x06\xd6\xcdu\xae V7\x9co\xc6jZ2\xb2\xed\xcb\xd9!\x15\xe1R\x9f\x1d\x90\xd8\xd6\xd29\x10\xffK\b>!\xac\xe2\xcd\xdf\x00\xbeJJAg\xb8\x83\x83\xf1av\xc4z\t\x04\x02\xa0\x14\xd9|\x81\xeb\xf3\xcbL_\x9d'\x9b\xcb;o\xf5\x9f\x14|R)\xf5\r\xc0\x97\b!v\xb9g\xfb\xddxo+\x94\xd4BT\x9e\xb2\xd9\xf7\x0f\x80\xabN&\xe7\xc3\n\xaa\x87\x16X-\x80&l\vW\az\x81#\xc0>\xb6\x17+(\xd7\xf7]\xb5\x03\xaf3\xb6\xf7\xb9\x06\x18'\x13Iub|\xcc\v\xfc\x10\xf0\x1f\x00M\b\x10H<^\reZ5~\x160\x14XA\xf7\x1f\x05~\xd7\x00C\xde\xf8\f}\xf6\xfb\x8f`\xc5@o\x98\x847<\x14%\xac\xd4\xe8KX\xf9\xf8&Ps\xeb\xa6'\x1f\xe2\xean\xf1\x90\x03\xaa\xa5O\xe7.\xbe\x97:ٺ\x9aFbM\x8a\xfd^\xacZ\xbf\xbd\xaekVm@\xfc6\xf0\xbb\x13\xb6ȺW\x9f\xe1a\a\x94\xb3\xfe\u007f\x87\xd3\xe8݀\xda\xcaG\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Frame_square_150 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x96\x00\x00\x00\x95\b\x06\x00\x00\x00\xba\x95\x03L\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x144\x02h\xedkl\x00\x00 \x00IDATx\xda\xed}it\\\xc7u\xe6WUo\xe9\x1d;\xd0\xd8w\x02 @\x02 )\x8a\xa2v\x89\"HJ\x94\xac%\x96I\xcar\xe68\xf1L\xe2Lf\xe2dΙ$N&\x8b'3\x99}\x9cx\x16'\x8e-˂\xe5Ȳd\x9b\xa2\b\x8a\xa2$S+\t\x12M\x02$H\x90 \xf6}i\x00\xdd\xe8\xee\xb7U͏n@\xe0\x02\x12 \xa5\x8c\x00\xbd\xef\x1c\x9cC\x10\xc0{\xfd\xaa\xbew\xefwoU\xddK`\xe3s\x85\xbd\ruhl\n`oC]\x1a\x80'8\xe7O\v\x81r\x10\bB\xc8iF\xc8\u007f\a!o\v!\x04\x0046\x05n\xea>\xc4\x1e\xea\xcf%\xb9n\x03\xb0_7\x8c\xcc\xf4\xb4\x14d\xa5\xa7´,\f\x0e\x8da&\x1c\x16\xaa\xa2<\xdf\xd8\x14\xf8\xca\x1c\tmb\xd9X\n\xa9|\x80\xb8 \x04ɼ\xf3\xf6Z\xac)-\x9c\xff\x99\xe0\x02g\xcew\xa29p\x16\x00~x+\xe4b\xf6P\u007f~\\`k\xe70֕\xf9\xff\xd20\xf9\xf6\xed\xf7oAan6\fÄeY\xf1/Α\x91\x96\x82\xe4$/:.\xf5\xac\xdfP\x99w\xa2\xb1)\xd0q3\xf7\xa3\x9f\xa7\xc1ݳ\xbd\x16\x00\xf0\xad\xdf\u007f\x06\x8f\u07b9\xa6h\xf7\xd6\xf2\xb2'\ufbd1\xe6\x06~\xa5\x10\xe4f~\xa7\xb1)\x80};\xea\x1dB\x88\u007fST\x90\x8d\x82\xdcl\x98\x96u\xd5\xefq\xceQ^\x94\x8f\xea\x8aRb\x98\xe6\xb7o\xf6s~\xee\\\xe13;\xea\xd7Fc\xb1\x17\xf2r\xb2J\x14E\xa1\xbd\xfd\xc3\xe7\u007fr\xf8\xf4\xa6\x95\xf6\x1c\xfbv\xd4o\x02P\x9c\x98C\x01\x88\x19!\xd0\xd3\xd8\x148w\xa5P_\xf0\xfd6\xce\xc5\x1b\x9b7Ԡ\xa2\xb4\x00<\xaeϯ\xb66\x84\x80Cय़\xbf\x01\xcb\xe2_y\xe1`\xcb\x0f\x97\xfb\xf9\xa4\xcf\x13\xa9\x9ez\xa0\xdaK(=\xfc\xc4\xeem\xd9Y\xe9\xa9 \x84\xe0̹\x8b\x1b-\xcb\xda\xffӷ\xce<\xb2\xac\x89}\xb0\x8e\xce\x1a\x9a\xd7\xe2f\x9a\x10\x10\x10\"t\xfc\xfd\xd9\xf1\x11\f-;B[\xe2\xef*\x84\xe0\x1e\xceſ\xe0\x9c?\xce9\xa7\x92$\x81R\x02\xcb\xe2\xb0,\x01.8\x9e\u07b6>F)i\"\x84\xbc\x00\xe00\x80\xe0\x82\xfb8\t\x01,\xcb\x04\xa1\x04\xb0>&\x16\xa3\x14\\p\x84\xc2\x11LM\x87\xa0\xeb\x06dI\x82\xaeG\xbf\xbcoG\xfdk/\x1cl\x99X\xceg\xfe\\Y\xac/=\xb4~\xeb\xd6\xdbj\xdf+/͇ir\x00\x80,K\xf8\xd1K\aF\"\x91X\xd9\xcf\xde9\x1b\xbe\xd15\x9e\xde^[\xaei\xda7$\xc6\x1et;\x9d\xc9)I^\a\xa1\x14\xb1\x98\xa6O\x85B\x13\x04$@\b\xf9\t\xe7\xfc\x0e!\x84\xbcp\x88\t\x01!\x84\x9c\xa2\x94\x9cf\x8c\x9d{n\u007fsx\xa1\xeb\xbar\xc2\x16\xa4\x06\n\x00\x1c6M\xab\x841ʪ֔\xa0\xac8\x0fn\x97\x13\x04\x04\x16\xb7\xa0\xe9\x06\x82S3\xe8\xed\x1fF\xef\xc0\x10t݄\"K\x13\x02\xf8W\x8dM\x81\x17>\x16\xee\x98\xf6x\\\xf8\xb5\xdd\x0f!\xa6i\x00\x00UUp\xa1\xb3\x17\x1f\x9el\x83\xa1\xeb\xc8HO\x81\xcf\xeb\x81eZ\x98\x98\x9c\xe2\x93S3!Ƥ#\x0e\x87\xfc\xcf~\xf8ډ饐릉\xb5\xf0\xe2{\x1b\xea(\x80\x14\x00\xeag\x95T\x94\x10\x83C\xecy\xe8\xde-\xff3-%\x19\x84\x00\x16\xe7\x18\x19\x9d\xc0\x1b\xef|x\xc2\xe9p\xec\xe2\x9cK\vH\x00!\x00M7HL\xd3\xf3\xddNu\xbb\xcb\xe9x2%ٷ\xbe\xb4(\x0f9\xfeL\xb8\x9c\x0eȲ\x04!\x04&\x82\xd3h\x0e\x9cEo\xff0\\N\a\x92|\x1eH\x12\x83X\xe0n\x84\x00f#Q\x18\x86\x01M3\xa0\x9bF\v\xb7\xf8˲,\x1d\xa0\x94\x8e\x88\xab]\x13\a\x90/\x84x\x13\x84xה\x14`\xc3\xfaJ8\x9d\x0e\xe8\xbaqٵ\t!`\x8c\x821\x06M3\xd0~\xbe\x13m\xe7/A\xd340\xc6~\x97\x10\xf2K\x001!\xc4\x1f\x99\xa6\xf9/\xf3\xf3\xb2q\xe7m\xb5`\x8c\xe2\xed\xf7\x9a161\x85\xcd\xf5\xd5()ʃ\xaa\xc8\x10\trX\x9ccrj\x06\xadg/\xa0\xbbwP\xe3B\xec\xfb\xc9\x1b\xa7_\xbe\x11\xb9ȭ\x90joC]>\x80?\x04\xb0\x13@\x12\x00*\x16\xf1۟\x01\b΅Z\xbf\xae¹\xb1\xb6\n\x86a\xe2R\xcf\x00\xdez\xf78\x84\x80N(\"\x8c22G(\x8bs\b.\xe0q;P\x98\x9f\xe3\\SZ\xa8$\xf9<`\x8cA\x91epn!4\x1bE(\x1c\xc6\xc9\xd3\xe7184\x8a\xca5ŨZS\x8c\x14\x9f\x0f\x82\\op\x05\f\xc3\xc2\xe8\xf8$.v\xf5\xa1\xbboP\x83\x101I\x92\xe6~\f.\x04\b\x81\x88\xbb/\xa2\xeen\xb8\aI>/\xack\b\xeekN,!0-\x13\x1f6\xb7\xe1Bg\x8f\xc9\x18\x8b&>\x10\x03!.\xc1\x05\x14Y\x06\xa5@~\xae\x1fw\xdd^\x0f\xc1\xf95u\x17\x89\x9bZ\x8cON\xe1\xc0\x1b\xefZ\x84\x90g_|\xe3T\xe3\xf5\xc8En\x92P\xd9\x00\xfe\f\xc0\xd7,\x8b\x83\x10\xc0\xedt\xc2\xe3q\xc1\xe1P@\x16^6!-\x17\xbd\xfbR\u007f6\xf7=\xb9l~.\u007f\nqſ\xaf\xbc\x0e\x01\xc6'\x82\xa8\xae,CyI\x01TUF8\x1c\xc5\xd4t\bӡ0\xa6g\xc2\xe0\x9cC\x92\x18<\x1e\x17\xb2\xd2S\x91\x9a\x92\fE\x96\xa0\x1b\x06\"\x91\x18&\x82S\xe8\xec\xea\xc7Lh\x16\x84\x12\xf4\x0f\x8e /'\v\x0f\u07bd\x19^\xaf\x1b\x9a\xa6\xcfk\x96Ş\x89\v\x01\xce9\x18c\x90e\t\xa1\xd0,\xce]\xe8F\xdf\xe00\xa6\xa6C\x10\x00r\xb2\xd2\xe1r8`\t\x8e\xcc\xf4\x14T\x96\x15_3\x8a\xbb\x11TU\xc1\xe0\xf0(\xba{\x87\xe2\xbe8a:)\xa3\xe8\xe8\xecEzj\x12\x1a\x1e\xd8\n]7\xe2\x04\x02 It\xfe\x03s\xcea\xf1\xf8\x00ʒ\x84\xf1\xe0\x14\x0e\xbc\xf1.\b!\xffZ\b\xf16\x80ӍM\x01q%\xc9\xc8M\x90\xaa\x12\xc0\xaf\x00d\xc4b:J\x8b\U000f0c76\n^\x8f\xfb\x8a\x19\xfd\xec\xa29Ўή^ܵe#\x8a\n\xb2A\t\x81\x10\x02\x16\xe7\xf3\x83B)\x05!\x04ӡ0\x06\x87\xc6\xd0v\xae\x13\xban\xa0\xb0 \x1b\xd5kJ\xe1\xf5\xbaq\xf6|'Ξ\xbf\x84\xa7\x1e\xdd\x06Ӵ \x84\x00\xa5\x141Mù\v]\x98\x0e\xcd^v_E\x92\x90\x9a\x9c\x84Ԕ$df\xa4@\x96$h\t\x97F)@\xc00\x1b\x8d\xe2\xe8\a'10<\x86\x92\xc2\x1cl\xddT\v\x8fǅX\x82\xb07\x15\xfa\x93\xb8ՙ\x9b\x1eB)\xa6\xa6C8x\xe4=\xecy|\xc7<a)%`\x84\xe0\xfd\xb6~t\x0f\x05\xe1u\xaa\xd8\\\x93\a\u007f\x8a\x1b\xba\xf9\xf1\xef\x04\xda:\xd0\xd2z\x0e\x8a,k\x00.\x00\xd8\xdd\xd8\x14\xe8^H\xae\xe5Z\xac\x12\x00\xa78\xe7\x1e\x87\xaa\xe2\x9e;\xeaQ\x90\x97\x8d\x98\xa6\xc7M\xf7JP\xf0B@U\x15LNM\xe3X\xcbY\xe8\x9a\x0eY\x91\xe0P\x1dp\xbb\x1d \x89\xb74\x12\x8d!\x12\x89A@\xc0\xe7\xf5\xa0\xa40\x17\xb9\xfeL\x10B\xa0\xe9:\x1c\xaa\x82\x97\xf7\xbf\x89\xba\x9a\n\x14\xe6\xc5sBsDln9\x8b\xee\xbeA\xa4&\xfb\x90\xedψ\xfbV\x10Dc1̄f\x11\x8d\xc5 \x84@zj2*ʋ\x91\xebτ\xa2\xc8\xe0\x96\x05.\x04TE\xc1\xe0\xe8\x18N\xb5\x9eG\xff\xe0(֔\x16\xa2\xa6\xb2\x14II\u07b8\xf0\xb28\xc4-\xbc\xc0\xaa\"\xa3\xe9\xc8\a\xc8\xccHź\xb5\xe5\xb0\x12\x9f},\x18\xc1?\xbeu\x0e\xe9\xfe<dge`6\x12EWW7j\x8b\xbd\xb8\xbf\xbe\x10\x9aaͻ\xc6\x1f\xbf\xfc:\x1c\xaa\x8a\xa8\xa6\xc1\xe2\x1c\x12c\u007f$\x84\xf8\xcf\x00\xccƦ\xc0Ҹ\x90\x88Z\xbc\x00\xcep.\xf2UUƓ\x8f<\bJ)>ÚjI:\x84\x908\x91t\xc3@4\xa6ͻS\xa7\xaaBU\x14\x10J\x12\xcf(\xb0\xf0QUU\xc1˿<\x8c-\x1b\xd7#3#\x05\x9c\u007f\xfcCI\x92\xd0?8\x8c7\x8f\x1e\xc3\xde'v\x82.p\x8bq\xcb!\x10\x9e\x8db`h\x14'O\x9fG,\x16CqA\x0e6\xd6W#%\xc9;\xef\x96(\xa5\x88D\xa3\xf8\xb0\xb9\x15\x17\xbbz\x91\x9b\x9d\x85\x9a\xcaR\xe4\xe5dAUep\x0e\x98\xa69\xff\xc2,u&,\xce\xf1\xc2O\x0f\xe0\xf1\x87\x1f\x80\xd7\xed\x82\x00\xc0(\xc1w~ڌ\xbb\xef\u007f\x10\xe9ii\xc8\xc8Ȁ\xa6i\x18\x1f\x1f\xc7\xc1CGpgU2j˲`Z\x1c\xaa\xaa\xe0\xed\xf7\x9b\x91\x9a\xe4Ciq>\xde:ڌ\xc1\xe1Q(\x8a\xbc\xbf\xb1)\xb0{oCݍ\x97t\xe6\xccۺ2\xff\xaf\x13`\x1fc\f\x8f?|?dY\xbe!\xa9\xe6\x06\x87R\xf2\x99\xfc\x9a\xd3\x14\x94\x10Ȳ\f\xb7\xcb\x01\x97\xcb\x01\x97\xd3\tIb \x94̻\x91\xf8\xb3|\xfc\xa5\xc82.^\xea\x83\xd7\xebBZj\nx\u008d\xceY\x94\x8c\xf4\x14\xb4wt\xa3\xa8 \aN\x87\xba\xe0:\x00\x01\x81á '+\x035Ue\xf0g\xa5arj\x06\xc7N\xb4a&<\v\xaf\xc7\r\x97\xcb\t!\x04\x18\xa5\xa8,+BQ~.\u0091(\xda;\xbap\xaa\xad\x03}\x03\xa3\x18\x1e\x19C$\xa6!\x1c\x8e 4;\v\x8b\v8\x1d\xea5\xa5\x1dc\x14\xd1h\f\xb2,cz&\x8c\x8e\xce^l\xac[\vB\bdF\xd1=<\x8dѨ\x82\xf555(//\xc7ڵk\x91\x93\x93\x03˲ 1\x82\xa3\x1f\x06\xb0\xa9*g\u07b5ꚁ\xb1\xc9iT\x94\x15`MI!$I\xc2\xe0\xf0\xe8\x9a\xda59JcS\xe0\xc8\r\x13\xa4\v\x04ٟk\xba\x81\xad\x9b\xd7CU\xe4\xcb\x06\xf2\xaa\x05HJ!I\x12f#Q̄ga\x9aֲt\xfbgƢ-\xaa\x1a\x05\x18cHKM\xc6\xc9\xd3\xedqa=g9\x12/\x94n\x9a\xd0t\x1d\x13\x93S\x98\x8dD\xb1\xd8;8w\x8f\xaa5E(.\xc8\xc1\x89S\xed8\xdfك\xf4\x94d\xdc{\xc7\x06\xa4\xa5&#\xa6\xe9\xf0y\xddزq\x1d\xd8m\xb5\x98\x8dF161\x85\x99P\x18B\xf0\xc4KB\xe1q9\xaf\xf9r3\xc6\xf0\xdeG\x01TU\x14#I\x91\xe3V\x951\xb0D\xd8)1\x8a\xe1\xc9\b<n7TU\x85\xdf\xefGVV\x168\xe7\b\x06\x83HKK\x83)\x00\xd3\xe4`,n\xc1eY\x06\xb7L@\x10h\xba\x86\xea\xca\x12\xc44\r\xa7\xcft|cߎ\xfa\xe7\xa5%\xba\xc2ۄ\x10~\x8fۉ\xb5\x15\xa50\f\xf3ڄb\f\xba\xae\xa3\xbd\xa3\v\x9d\xdd}\bN\x85`\x9a\x89,\uf2a0\xd2r\xb4\x1a\xc0$\x06\b\xe0\xe0\x91\xf7pǦ\xf5\xf0x\\qWcY8\xfa\xc1I\x98\xa6\x85#\xef\x1e_\x82\\\x88\x8f\r!qK\xa8\xca2\xa6fBx\xe5\xc0[\xc8\xcb\xcdº\xaaRd\xa4\xa5@\x92\x18,\x8bá\xaa(\xca\xcf\x06%\x14\x80\x88\xa7\b\x04`r>\x1fxPJ`\x9a&\xba\xfb\x06\xd1\xdcr\x16\xd1X\f\xe3\x93A8\x9d\x8e8\x99\t`q\x11\xcf\xdcs\x81d\x8f\x8aK\x13Q\x98\xa6\x89p8\x8cX,\x06M\xd3`\x18\x06\"\x91\b\x88\x10`\x8c̓\xd50\fP&A\b\x9exf\x8e\x8d\xb5U8\u007f\xb1\xc7aY֞\xa5.\xe9\xec朣fm\xf9婄\x85kC\x8cad|\x12\x87\xde\xfa\x00\"\xe1\xef\xddngw\x92\xd7\xfdw\xb2,\x1f\xa5\x94\x0e\xaf*b%\x06Xp!\xba\xfb\x87\xfe\xc7K\xbf8\xfcp\x8e?\x03n\xb7\x03\x83Cc\bG\xa2\xc1\x82\xbc\xac{$IҖ\xafC\xe3S/\xb8\x85ށ\x91\u007f>08\xf2\rUUP\x94\x9f\x83\x8a\xd2B\xa4\xa7'\x03\x88[\x0e\x82\x84[O\xfcY,\xa6c\"8\x85\xbe\xc1\x11t\xf7\fB\xd3u\xae\xaa\xea\xb3e%\x05\xc7-\x8bK\xa6ie뺾˴\xacoD\xa2Qx\xdc.\x18\xa6\x85\xb2\xdcd\x1c\xfc\xf0$B\xa1\x10\xfa\xfa\xfa`\x18q\x9d722\x82\xf7?<\x8e\xfa5ِ\x19\x85a\xf1\xf8\\\x8fM\"\xc9\xeb\x9aOE̥#r\xb22\xd0\xd3?X\xb5Tb\x95QJ\x91\xebπa\x1a\xd7$UO\xff\x10\xde<z\f\x8c2(\x8a\xd4\xec\xf3y\xfe\xf8o\u007f\xfcΡ\xcf\xc9j\xd1#\xcf쬿\xb7op\xf8InqYQ\xe4\x8bNU\xfe\xce\u007fy\xeep\xec\x13\xb8\xf6\xef?\xfb\xf0\xa6\xbf2\f\xe3\xb1\xd6\xf6\x8b\rg\xce_\xba\xcb\xe7u\xe78\x9d\x0e\xf8\xdc.p!\x10\x9e\x8d\x00B`6\xaa!\x16\xd3\xc6\r\xc3\x18\x00С\xaaʛ\xaa\xaa\xfe\xe8\xb9\xfd\xc7\x17\xe6=\xce\x02x\xf3K\x0f\xad\xaf\x19\x18\x1a۾\xb6\xa2\x04\x96e\x81Q\x8a\x87n+\xc4\xfe\xd7\x0e\xa0\xae\xae\x1e\xfe\xacL膁\xd6\xd63\xf0\xd0(\xee߸\x16z\"*\xd4\f\x03]\xbd\x03ص\xed\xce\xcb$\x80\x00\xa0\xa82\x04\xa0/\x95X2e\x14N\x87\xe3*\xad@)\xc5\xccl\x04\x87\x8f\x1e\x83D\x19<.\xc7?ff\xa4~\xf9\xaf\xfe\xfeu\x1d\x00\xfe\xfc\xeb_\xc0\xbf\xfbΫ\xab\x9eY?z\xbd\xe5\x9dgwm|G\x00\xe4\xf9\x03'Dbm\x12/\xbeq\xfa\x96\xae\xbb\xb7\xa1\x0e?|\xady\x02\xc0?<\xb3\xa3\xfe\xfb\x96\x10R,\xa6\x15\xc6bZy08S\x01\x02\x13B\xb4\x010\x01\\\"\x94\x8c;\x1d*\a!\xd6\v\a[Ģ\vǄ\xfc^[\xfb\x8535U\xa5\xb0\xacxҶ\xba8\x03E\xfe\x14\x1cn>\x873\xa7\x9b\xe1P$ܾ6\x17\xd5\xc5U\U000e4894\xa2\xa5\xf5\x1c\x1c\xaa\x8a\x94\xa4$p\xc1\x17\xd8Y\x82\x89\xc9)\x008\xb1\xd4t\xc3K\x8c\xb1\xa7\x9ez\xf4\xc1\x84__H,\x82W\x0f\xbc\x8d\x99\xd0,<\x1e\xe7\xfe\xef\xbd\xfa\xd1n\xd8\xf8\xd4p\xa35\xba\xa5,\x10\xefm\xa8\x83\xaa(d&\x14~\xa9\xa80\xf7\xc9m\xf7l\x9e\x0f\xb0\b!P\xe5\xf8\x1a'!\x04\\\x88yR\xc9\x12\xc3xp\x1a\xfb\x0f\x1d\xc5\xc3\xdb\xeeBj\x8ao>\xcd\"1\x86\xee\xbeA\xbcy\xf4\x98\xa6\xc8r\xfd-o\xf4\x1b\x9f\x98B(<\vE\x91\xfb\x93\xbc\u07bd\x00\xf0\x17\xbf\xf3\xb8̀O\t7\"\xcdR\xb6\xb446\x05\xf0\xfd_\x1e\x13.\x97s\xef\xe0\xd0h\xff\xd1\x0fN¡(q\xcd(\x04b\xba\tͰ\x10\xd3\xcdyRI\x8cat<\x88_\x1cx\a\xc5\xf9\xd9\xc8\xcd\xc9Lh<@\x91eL\x04\xa7q\xf4\xc3\x16(\xb2|\x8cR\xda~K\xfb\xb1dYB\xef\xc0\b\xb8\x00\x9c\x0e\xe5\x1f\xfe\xdb\xf3\x87C\x00\xf0\xa7\u007f\xfb\x8à\x15`\xf9\x9e?pB߷\xa3\xbe\xea\xfcŞ\x97\xc6'\xa7w\xd4\xd5T 7;\x03\x8a\xa2ė\xb9 `Y\x1c3\xa10Ξ\xbf\x84\x8e\xce\x1e0\x99Mvv\x0f(\x91\x03oyJ\x8a\xf2A\t\xc1\xf0\xe8\x04:\xbb\xfb@\b9E\b\xd9\xfd\xa3\xd7O\xde\xdaF?F)\xc6'\x82\xa0\x04X\xbf\xb6\xfc\xef\xf1\xb3\x0f\xec\x19[A\x96ooC\x1d^8\xd8\x12\x06\xb0soCݓo\xbdw\xfc\u007fI\x8ce\xa6${\xa1(\n,\xcbBp*\x84H$\x8a\xb2\x92|\xb8\xdd.\xc4bڐ$\xb1\xbb\xc7&\xa6\xfezdlr\xb7\x10\xc2\xcd\x18뢔\xfe-\x80\xef76\x05̽\ru\xb7F,\x01 \x1a\x8b\xc1\xe9P\x87~\xe7[?쳧ke\xbaՄ.{\xf9\xdb\u007f\xf2\xeb\aN\xb4\xb4E\xa2Q\r\x15eE0-\x8e\x9a\n\a\xd2R\x93\xe1\xf3\xba\xf1\xd2\xcf\x0f\x03\x00il\n\x04\x01|\xedz\u05fde\x8d%\xb8\x80\xaa*\xed\xf64\xad|\x82\xfd\xee_\xfe *I҄a\x9a(/)\xc0\x9a\x92\x02\xe4dgBU\x15\x18\xa6\t\xa7S\x85\x10³oG\xbds\x8e\x90\x8b\xe1\x16\x895\x17T\x92\x88==+\x1b\x8f\xddS\x99\xb0\x14bV\x96d\b~y^I\b\xa0\xba\xb2\x14\xba\xae\x17\b!\xfeύ\xaew\x8b\xc4\x12W2\xcc\xc6\n\x15\xf2?\xff\xd59<\xf5`\xcd\x03Lb\x05\x8f?\xfc\xc0U;I\x85\x10(.\xccENv\x168\xe7\xcf\xeem\xa8{\xfaz\x11\xe8'd\xb1\x84=;+\xdc\x15\xee\xd9^\x9b/8\xff\xc5ݷ\xd7\v\x89]\x9b\x16\xban\xe0\x9e-\xf5`\x8c\x81\x00\xffyoC\x9dw1w\xf8\tY,\xdb`\xad\x02|\xaf\xba\xa2̝\x99\x9e*\xacEv\xae\b!\xe0r9Q^R\x00\xc34\xf3\x01ܵ\x98\xd5\xfa\x84NB\xdb\x16k%\xe3˻6\x94\n!\x1eZ[Q\xccuø.'\x84\x10\xa8\xad^3\xb7\xc9\xf3\xb7\x16\x13\xf1\xd4\x1eV\x1b\x86a\xedp9\x1d\xf0\xba]7\xe4\x83\x10\x02\x1e\xb7\v9\xfe\f\b!v/\x8c*mWhc\x1e\xbf\xf1\xe8\xed\xc40\xcd\xcd\xe9\xe9)\xa0,\xbe\xa1x\xfe\xe0\xc5\"\xb0,\v\x85y\xd9\x10\x02\xd8\xdbPW\xfd)\xb8Bj\xbb\xc2\x15\x8e\x98\xa13\xcb\xe2\xf9\xfe\xf4\xb4\xf9y\xd4\r㺦´,\xa4\xa6&\x81R\x02\x00\x85\xb6x\xb7q-\xd7&SJr\xd3R\x93\xc0\xb9\x00!@{G\x17\x14E\xb9\xae;t\xa8\xea\xdc\x119\xe5S\x14\xef6V*,K0\x89\xd1d\xaf\xc7\r!\x04L\x8bcjz憶b\xae \t\x80\x99O\xd1bٮp%boC\x1d\b\x01@\b\x9b\x93UD,m:\x85\x88\x1f\x87\xa3\x94\xb6}\x8a\x1a\xcb\xc6JDcS\x00\x9cs\x97CU\x92\x9cNG\\_\x99\x064݈\x13l\x11\x10B0;\x1b\x81eY\xc1\xe7\x0f\x9c\x18\xfd\x14-\x96\x8d\x95\v\xb2\xc0\x02\xc5\x0fgL\x87\xc20\xafs\xbcOUdtv\xf7\xc14\xad\x1f\xccY>\xdb\xe4ظ\xcc\x15RJ\"\xb1\x98>\x13\x8d\xc6\xe2\x87W\x15\t\xa6aA\xd3\xf4k\xa6\x1d\b\x01f\xa31\x9c\xbbЭQJ\xbe\xb7\xd8Vh\x9bX\x9fsWH\b5\r\xd3\x1c\x9b\t͂\x12\x02\x89IP\x1d\n\xbaz\xfa\xafY1\x87R\x86\xe6\x963\xe0\x9c\xbf\xf5\x93íg>%\x91d\xbb\u0095\x0eƨN)9s\xeeb\xf7|\x9d\x8a\xfc\\?:.\xf5^u\"\x8bQ\x8a\xe0\xd44.\xf5\fp\xa7\xd3\xf1\xa59r~\nĲ\xf3W+\x1d\xcf\x1f8\xc1UEyuph\f\xa6a\x01\x10(+\xca\xc3\xf0\xc8x\xbc*Nb\x9a9\xe78\xd6r\x06\a\x0e\xbf'8\xe7\xbf\xf6\xdc\xfe\xe6\xe9Oq\xa3\x9f\x8d\xd5\x00E\x91_\xd5t\x03\xc1\x99\x10\x84\x0023S\x91\x99\x91\x86\x8eK=P$\x06\x02@\xd3\r\x9c=\u007f\t\x00\x8e\xbc\xf8\xc6\xe9\x9f\xdd蘙M,[\xc0\xe3\a\xbf<\x1e\xa2\x94\xbc|\xf1R\x1fTE\x86iX\xb8ss-N\x9f\xb98\u007f\x84\x9e\x102WS\xf5\x95\xeb\xb9@[c\xd9\xc0B\x82\b\x81o\x9c\xbf\xd8eEbZ\xbc(\\Z2\xdc.\a\x8e\xb7\x9c\x01K,N'\xa6{I\xddLl\x8be\x03{\x1b\xea Kl\xc0\xe2\xfc\xe8Gͭ`\x8c\x82s\x81\xcd\x1b\xaa\xd1\xd6~\x11\xb1\x98\xbe\xec\x02{\x9fP\xe6\xdd\x16\xf1+\xddj=\xff\xfaI˩\xaa\xdf\xec\xec\x19\xc0Lh\x16B\b\x14\xe5\xe7\xc2\xebu\xa3\u007fh\x14\x12c\xcb\xf2O\xf6Z\xa1\x8d\x8f#\xc4\xd7O\xbe\xe7P\x95\x91\v\x97z!\xcb\x12L\xcbĝ\xb7\xd5\xe2\xfd\xe3\xa7\xe6\xeb\x94\xfe\x13\x11\xcb\xc6j\x83Ĩ\xde\xde\xd1\x05˴`Y\x1c9ٙp(2\xce_\xec\x06\xa3\xffdĲ-\xd5j\x84\xa6\xe9x\xffةx\x14\xc89*ˋ\xd1v\xeeҢM\x9d>\x05b\xd9\xdaj5\xc2\xe5r\xa2\xab\u007f\x10\x9af\x80\v\x81Ң<\x98\xa6\xb9,\x01o[,\x1b\x97Ϩ\x10\xf0g\xa4\xc2\xe5p`l\"\b\x00HJ\U000a2f24\x00\x96\xc5\xed\xa8\xd0\xc6̓1\x86\x9a\xaa2\xb4\xb5_\x84\xaa*\xd04\x1dU\x15\xc5X\x86v\xb7\xa3B\x1bWC7\f\xac)-\xc4\xf0\xd8\x04\"\xd1\x18 \x04\x92|\xf1\xd2F\x84\x10\xfeO@,[k\xadN\u007f\b(\x8a\f\xa7\xaa\xa0\xb7\u007f\b\x94QH\x8c\xc2\xe7qA\b\x91n[,\x1b7o'\x04\x90\x95\x99\x86\x9eޡD\tv\x82\xe4d/L\xd3*\xffĈE\b\x04\xf94\r\x9e\x8dϤ\xd9J\xf2y15\x13\xef~!\x84\x80\xdb\xe9\x04\x17\xbc\xfa\x96\x88\xb5p\xaf\x8deq\x85s\x0eU\x96\x17\x8b\nm_\xb8\n\x89\x95\x9e\x9a\x8c\xd9Hd~\xff\xbb\xcf\xe7\x01\xb7D\xfa\xaf\xef\xbe톝t\xa5\xc5H\xd5\xd8\x14\xc0\x13\xf7U=\x9b\x96\x92\xfc߳2\xd2RM\xcb\xc4\xfeC\xef\xe2ޭ\x1b\xe0t:\xae\xc8i\x10\xdb\x17\xaeB(\x8a\f1w\x1c\x8c\\mM\x96M\xacƦ\x00\x1e\xbb\xbbb]^\x8e\xff\xbb\x0fo\xbbS5-\x0eB\bB\xa10^?\xf2>\x9e|\xe4\x81D}o\xdbP\xadN\x89E\xe6Ks\xdfl\xa4\xb6\xa8+t9\x1c\xbfv\xfb\x86j\xd50\xadx\xfbV\xcbBJ\xb2\x0f\xe5\xc5\xf9\xb8\xd4=\x00E\x96\x11\xef\x85mc5\x81\v(\xa1\xf0,\xfa\aG\xe7\xfaw\xde\x14\xa4\xeb\x98\xc1j\x97\xd3\x01\x9e\xe8\x10\x15\x9e\x8d\x80\x10\x82\x8a\xb2\"\xbcz\xf0m\x14\x17\xe4Ba\xb2=\x13\xab\x00{\x1b\xea I\x8c\xc5b\xfaG\x9a\xaeg%\xf9\xdc8\xf4\xd6\a\x90d\t\x8a,]i\xaa\x96\xe4\n\x17\xb5XQM?|\xa9g\x00\x8a\"AQd\xb4\x9c>\x87\xfe\xc1\x11x=n\xa4\xa7$\xa1wpxY\x99X\x1b\x9f]46\x05`\x18桼\x9c̍\xfb\x9e܉\xdd\r\xf7bϓ\xbb\xa0*\n\xdc.'X\xa2t\xa4\xa6\x19 \x94\xa8\xa6izo\xdab1J\xfe\xeeX˙-\xed\x1d]ϒı\x1fE\x91\xb1\xa6\xb4\x10\x99\xe9\xa9\b\x06g\x16\xb4\x98\xb3\xb5\xfbJ\xc63;\xebo\xb7L\xfe\xc0\x1d\xb7\xd5\x02\x00b1\r\x84\x10\xccu\x06\x9bۇ50<\nF\x89K\b\xa4\xedm\xa8\x1b\xbf\xa9\xc3\x14?>t\xca\xfc\xc9\x1b\xa7\xbf\x12\x9c\x9a\xc9\x0e\x87g_\x97$\t\x13\xc1\x19\x00\x02\x19\xe9)\x98\x0e\x85\xed\x19Y%\xb0,\x91\xa3\xa82\x1c\v\xda\xfe\n!P\x90\xebGiQ\x1e,\x8b\x83[\x16\x86G\xc7QSU\xee4\f\xd3\u007fK\x87)\xf6l\xaf\xc5\xcbo\x9f\x1d&\x84\x84%\xc6\x10\x8dŠi\x06R\x92}\x88Fc\xf8\xd8\x17\xda>q%\x83R2\xa3\xeb&\f\xe3\xf2^\x94\xb2,\x811\x06\xc6\x18\x86F'\xe0t8\x90\x9b\x9dAM\xd3ʺi\x8d\x95\xb0Z\x97\xe96nqX\x9c/h-g\xbb\xc0Հ\x17\x0e\xb6\xbc)\x04\xbf\xd8\xd53\bE\x96\xae\x9aUI\xa2\xf8\xe8d+֭-\x87\xa6\xeb\xe0\x82k\xb7D\xac\xab3\x18\xf1\xfc\x06\b\xe6[\x8a\xd9X\xf9\x11a|j\xc9\xd3\xc7N\xb6axl\x12\x0eU\x06\xa5\x14\x8cQ\xa8\x8a\x82#G\x8fC\x8b\xe9\xa8(-\xc4\xe8XP7L\xb3\xf7\xa6\xc5\xfb\xd5~\u0602CU\xa0*2\xa6\xa6C\x90$\tB\xd8\xd4Z\r\x11ab\xa5\xe5\xe4\x9e\xed\xb5\u007f\xf0\xda\x1bG\xff\xba \xd7\xcfR\x92|\xe0B\xa0o`\x18#\xa3\x13x\xe2\x91\a!+\x12\xc6'\xa7b\x8c\xd0\xe0'f\xb18\xe7\xc8HO\x89\xb7\x92\x9b\x9c\x82\xd7\xe3Z\xb0\x92c\xbbĕN\xae\x84\xf4\xf9\xaf\x1e\x97k`rj\x06\xa1H\x04\xd33!TW\x96 ';\x039\xfetLM\x850<:>\xe4\xf4\xbao\xd8\xe9mYm\xe5\n\xf3\xb3aX\x16.\\\xeaE\xfd\xba\x8a\x05\xd5Hl˵Z \x04'\xe9))\xd8\xf5\xc0V覉\x81\xa1Q\x14\xe4\xfa\xc1\x85\xc0\x85K}\xb0,\xeb\xa7?=\xd8b}b\x16\x8bI\f\x19i)\xd04\x1d\xa1p\x04\x19i)\x10\xb6\xa1Z\x95\xe0\x82C\xd3\r\b.\xd0\xd9\xd5\x0f\u007fV:L\xd3DO\xff \x9c\x0e\xc7Ȓ\"ͥ\xde,\xc9\xeb\x81CU0\x13\x9aE\xb2\xcf\x03\xa7C\x85\xbdV\xb8\xba\x11\xd3t\xf4\xf4\r!%\xd9\a\xcb\xe4\b\x87# K<\xb5\xba$b\x19\x86%2\xd3S\xc1(\xc3\xd0\xc8\x18j֖\xc10M\xd8ݿV/\b\x01\x86G'\xe0\xf3z\xe0r:0<6\x81\x98n,\xf9\b\xd8҈e\x1a\x85e%\x05\x90e\t\x83\xc3\xe3\xf0g\xa4\x811\x06E\x911W\xcfٞ\x8aUG-\f\r\x8f\xa10\xdf\x0fYb\xe8\xee\x1b\x04Y\x86\x01\x91n\x94\xe3\x88D\xa3\xb9Y\x19i\xe5\xfe\x8cT\x8cON!\x12\x8d\xa2\xad\xbd\x13\x13\xc1)(\xb2\fM\xd3\xe1q;\r{\"V\x93\x82\x8f\xd7\xc3\x1a\x19\x9f\xc0\xb6{\xb7@7L\f\f\x8d\x81ҥ\xa7=\xe9\x8d\xc2P\xcb\xe2\xa5%EyI\x8c1\\\xe8\xec\xc5\xd0\xc8\x04\x86\xc7Ƒ\x9c\xe4\x85C\x95\xe1\xf5\xba1>9u\u07fe\x1d\xf5\x0f\xda3\xb2\x9a\xa2\xc3x\x83\x00\x97ÁP8\x02]7>9b\x01\x80\xaa*\xa5\xa9\xc9^:>9\x85\x93\xad\xe7p\xdf\xd6\rxx\xdbݨ_W\x89\x8du\xd5xl\xe7\xfd\xd8TW\x9d\xa4iڋ{\x1b\xea|s\x96\xce\xc6J\x8f\f\x05d)\xbeV\x18\n\xcf\xc2\xedr\x80~\x92\xd5f\x14E\xaeMOK\xc1\xb1\x93m\xa8\xa9,\xc5\xfa\xea5\xf1\xf5\"\xce\xc19\x87\xa6i\xd8P[\x85\xe2¼t!ğ\xdaS\xb2Z\x14\x16 \x10o\xda\xc49_\x16\xa9\x96D,\x991W$\x12E\xef\xc0\x10jk*\xe2'c\xaf@4\x1aCMe)\x84\x10\xbf\xff\x1b\x8f\xde\xee\xb8і\n\x1b+\xc5\x1d&,\x97,-\xac4#\x96\xe2\x95nH,&\xb1\xbe\xf6\x8en$y\xbdp\xa8\xf1\x0eb\xb1D\x9dʅp:\x1d\x10 0\xb9嶧d5\xa4\x1b\b\xb8eA\xd7\r$\xf9\xbc\x88\xc64p!`YV\xc6\x17\xb7\xd5x\x1a\x9b\x02\xf8\xd2C\xebo\x9eXѨv\xf2lG'*ʊ \x84\x00c\x14\x1f\x9chE\xf4\x8a\x96\x183\xe10\b\x04/\xcf\xcd\x0f\xdaӲ\n\xfc \xe2\xad\xe3\xc2\xe1Y8U\x15\x8a*\xc34-\xa4\xa5&\xff\x89Cu\x84\xbe\xf4\xd0\xfa\xdf~\xf1\x8dӸ\xa9.\xf6\xf1^+\xb4\x8f\x80h\xb9\xd9\x19qk\xa5\xe9\x18\x18\x1c\xc5\xe9\xb6\x0e\xc8\x12\x83\xaa*\x88Fc\xf8\xe0\xd8i\x10B\xff\xe0\x8f\xff\xef+\xdc\x16\xef\xab\x03.\x97\x03\x13\xc1)H\x12ENV\x06n\xdfP\x83/\xec\xbc\x0f_z\xa2\x01\x05y\xd9\xdf\xf9\xc2=U\x9b\x16\x93=ҍ\xd2\r{\x1a\xeaf\b%1E\x96U!\x04t\xdd\x00c\f=\xfdCx\xf1\x951\xb8]\x0e\f\x8fN\bY\x96\x9e\x97e\xe9\u007fܨ\xb0\xbc\x8d\x15\xa3\xb0PYV\x84w>hAa~.6\xd7Wô,h\x89\xb4Cey\x11\xba{\a6\x00h^6\xb1\x16\x18Fr\xb9\xa8\xe3x\xe2\xe1\x870\x1e\x9c\xc2\xd1\x0fZ\x90\x9a\x92\xf4\xd6w\u007f\xf6\xfeW\xec\xc9X]\xc2=ǟ\x81\xf4\xd4$\f\x8f\xc6W[\xe6\xf2X\xb2$a28#t\xdd\xec\xbai\x8du\xed\x9b\nH\xb2\x84\xe2\x82<8\x14\x19\x94Ҙ=\x15\xab\x0f1Mǖ\x8d\xeb\xf0Qs\x1b\x18\xa3\xa04\xfe\x15\x89\xc6\xd0r\xfaܐ\xac\xb0\x96\xc5d\x8ft\xb37%\t\x82-\fAm\xac>\xa8\x8a\x8c\xcd\x1b\xaa\xf1\xb3\u05ce ;3\x1d\xbaabpd\xecL\xccп\xf2\xf2\x913㟨\xc5\xe2\x9cô\xac\x05\x8b\x926\xafV+\xb8\x10\xc8\xf1\xa7c\u05f6\xbbp\xeeb\x0f\xba\xfa\x06\xff\xe0\xb9\xfd\xcd5/\x1f9s►\u007f1Jf\xb9%\xf4P∽\xaa(\xb08\xc7\xf4Lx\x81\xf2\xb277\xacvr\x19\xa6\x05\xc6(\x18!\xda\xc2\xe0\ue989\xa5\xca\U00094008F#\xb1Dn\x83AU\x14\x84³\xf6I\xe8\xcf_\xa0\b\xb1D/w\xc3_\xfa\xde/\x8e\xe9\x92\xc4\x06\xc7&\xa7@H\xbc\xa2\xae?3\x1d}\xfd#\xf6@۸yb%\xc2\xcb\xc1\xe1\xd1\xf19Ɏ\xf2\x92\x02\f\x8d\x8e\xc7\xcf\x17\xdach\xe3\x16Ļ\x19\n\xcd\xc24MX\x16Ga~6\x04\xe7\x98\f\xce,k\x8f\x8e\r\x9bXWe\x17t\xc3\xc0\xc0\xf0\x18$\x89\x81R\x825eEhk\xbfxY\xfd$\x1b6\x96\x9dn\xa0\x94\xe2\xe4\xa9v\x10Ja\x18&J\nsq\xa9\xa7\x1f\xb3\x91\xe8\x02\x11o\xc3\xc62\x89E\b\xc5\xe4\xd44\xc6\xc6&A\bAr\x92\x17\xaa\xa2\xc6\xf7g\xd9g)l\xdc,\xb1d\x99!ǟ\x89\xb6s\x9d\xa0\x89\xe2 wo\xa9\x87a\x98\x80\x9dȲq\xd3\x16\v\x04\x1b\xd6U\xa2\xb3\xbb\x0f\xb1\xc4\xd6伜L\xf8\xb32\xc0\x13u\xc0\x9f\xbe\xce\xc6/\x1b6\xb1\xae\t\x8bs\xe4\xf83PV\\\x80@\xeby8\x1c*b\xba\x81\xf5k\xcb0=\x1dz\xe0\xc9\xed딟\xbcq\xda\x1eQ\x1b\xcb#\x16\x10\xef\nUU^\x84\xf6\x8eK\b\x87#\x10\x9c#/'\vn\xb7\xcb!\x83\xfe\xa5=\x9c6n\x8aXB\b\xa4\xa7%\xc3\xe7\xf3\xe2\xe4\xa9\xf6\xf9m\x14\x9b\xea\xd7B\x8b\xe9_|f\xe7\x06\x87\xbd{\xd4Ʋ\x89\x15\x97\xe8\x04\xf7ܱ\x01\xe7:\xbb\x13\tS\veEy\xc8\xccH)\xb2,k\xf7\\!/\x1b6\xb1\x96\x05\xcb\xe2\xc8\xceJ\x87?#\rm\xed\x9dP\x14\x19\x86a\xa2\xb2\xac\x18\x02\xf8\xa6=\xa46\x96C,>_\x1f\x99\x00\xba\xae\xa3n]\x05Z\xdb/ĭ\x16\xe7\xc8\xf6\xa7C\b\xb1\xfe\xd9]\x1b\xf3\xec=\xef6nxJ'\x81|M7\xe6W\x9c\x85\x00\xd2R\x93aq\x8e\xc1\xe1q\x10B\xe0q\xbb\xe0r:`\x98\xe6\xd3\xf6\xb0ڸn\xbf\xc2Ʀ\x00\x9e\u07b6\xee7\x1d\xaarG\xc3\x03[\xc1\x16,8\xab\xb2\x8cܬLt\xf5\xf4\x03\x88o\xa7)\xcaˆ\xa6\x19\xf7~y\xd7\x06{e\xda&ֵ\xd1\xd8\x14\xc0\xb3\x0fo̕$\xe9\xbb\x0f?t72\xd3S.\xdb\"#\x00TV\x14apd\x1c\x84\xc6[\x90\xa5\xa6$C\b\x9eŹ\xb0W\xa6mb-\xee\x02\xa3Q\xad\xb1\xbc8\x1f\x0e\x87zՑz!\x04\x92\xbc\x1eX\x96\x05\xc1\x05\x84\xe0HM\xf1\x81P\x9a*\x84`\xf6\xd0~\xbe\xb1\xa8eٳ\xad\xb6Z\x10q{\xdd\xfa\xcak\x96\a\xe4\x9c#\xc9\xe7\x85\xd3\xe1\x88\xdb/A\x90\xe8-`\xc3Ƶ-VcS\x00&\xb7\xf6\x96\x15\xe5\xab\xee\xab\xda\xf4.`%\xa3p:T\x10B\x10\xef\x106\x03\x8b\xf3\tB\x88i\x0f\xadM\xack\xbaA\xc6\xe8SE\x85\xb9\x89\"\xb6\xd7F\x9cP\x1f\x1f\x94\xe6\\\x80\x00\x96=\xac6赬\x15\x00\x10JK\xdd.G\xa2\xf7\xf3\xb5aY\x16\xb23\xd3\xc1E\xbc@Wp:\x04B\xc8$!\xc4&\x97M\xac\xab\xf1̎z?\x84`\xd7#\x15\x00\x18\xa6\x85\xca\xf2\"\xccu\x12\x98\fN\x812v\xf6G\xaf\x9f\xb4\v\xc0\xdbĺ\x1a\x02`B\b\xc4b\x1a(\xbd\xbe\x1c\x9f;LaY\x16\xa6f\u0090%v\xd1\x1eV\x1b\xd7&\x96\x10\x11\x81\xb8k[\xea)\x9cXL\x87\xa6\xe9`\x8c\xbdo\x0f\xab\x8dŢ\xc2 \x01\"\xf1\x1e\xc07&\x16%D\x8cM\x04!\x04&~\xf4\xfa\xc93\xf6\xb0\xdaX4*$\x84|02\x12osq#Ȳ\xc4\x03m\x1d\x00\xf0o\x17^ÆM\xac\xab\xa3BB\xbe\xaf\x1b\x06:\xbbz\xaf\xab\xb3$\xc6\xd0|\xaa\x9d\x8d\x8eO\xb6*\x8a\xf4\x9c]\xd1\xcfƢ\xae0\x81w\x14E\x0e\xb6\xb6_\x84\x10\x8b\x1f\xa5\x9f\x0e\x85\xd0\xde\xd1\x15t:\xd4\a~\xf8\xda\t\xbb\xf5ɪ\x01\xf9t\x88\xd5\xd8\x14\xe8\a\xd0\x18\x0eG\xd0\xda~\x11\xaa,_\xf5;\xaa\"\xe3\xcd_\x1d\x87\xc7\xe5|\xa7\xb1)0n[\xab\xd5\x04\xf1\xe9\x10+A\xae\xdfa\x8cM\x9e\b\x9cEpz\x06W\xb5\xaa#\x04\x10\x02\x02B^\xe8Fmظ\xee~\xac\x04v2\x89\x85N\x9en\xbf\xfa\xc0\xb3\x10\x10\xb0\x8b\xce\xd8\xc6ky\xaepn\xb3\xdf1\x89\xd2C#cAXV<\xa1\xce\x18\x83,I\x90\xe6\xfe\xa1\xb0\x00\x00\x0e\xfbIDAT\x15\x05^\x8f\x1b\x9c\x8bt\x00\xf8\xeac\xb7ۓ\xb0\xc2aY<\xe9*}u\x13r\xeb\xaam3\xcf\xec\xa8/\x11@\t LJ\xd9\x19\x00c\x02\x10\"є\xa9w`\x04}\x03ÈFc\x10\x10\x98\tE\xa0\xebz\xed\x17\x1f\xac\xd9\xf1\xbd\x9f\u007ftp\xce\xda\xd9nq\xe5\xe1\xbb\u007f\xf1Ռ\xb7\xdfm\xf61Fo\xd9\x05Is$\xd8\xdbP\x97\x05`\xbfn\x9a\x9b❞\bLS\xc7ކ\xba\xbf\x05 ,\xce\xf1\xe2+M\x90e\x19Uk\x8aQ\xb5\xa6\x18\x8a,!\xaa\xe9\x18\x1c\x1asvv\xf5\xbd\xfe\xf4C\xeb\a%J\x1f{\xa1)\xd0l\x13l\xe5\xa1\xfdB\xd7.\xd3\xe2\xf0y\\\xf3e\x13n\x9aX\tR\x15r\xceO\xb9\x9d\x8e\xa4\x8du\xd5\xc8\xcaL\x05\x01\xc1\xd8x\x10ͧ\xcf\xfe\xce\xf4̬\xe6r\xaa\xb8{\xcb\x06\x14\xe4e\x81R\x1a\xdf\xf5 \x04\b!(\xc8\xf5c\xc3\xfaJtv\xf7\xe74\xb7\x9c9\xbeg{\xdd\xef\xfe\xf8P\xe0o\x96\xfa!nD@\x9b\xa0\x9f.\xfe\xfc\xeb_\x80\xc7㠧\xcf\\|\x96R\x82\xcc\xf4T\x98\xe6\xadm\xa9#{\x1b\xea(\x80c\x8a\"o|\xfc\xe1\xfb\xc1(\x9b\xdfا*\n\x8e\a\xdap\xa1\xb3\x17O=\xba\r\x00\xb9n\xb3iJ)³Q\xbcz\xe0\b(!\xbf\xfdBS\xe0\u007f_I\x8a\xb9￼k#8\xe7D\bA-\x8b\x83RRB\b\xc9\x02\xe0\x03P\x01\xc0\x00\xd0*\x848G\b\x19'\x84X/\x1cl\xf9Ĉ6w\x8dgvn\x88\xbf \x94\xe0\xf9\x03'?\xb7\xe4\xfa\xfdg\xb7\xdd>42\xfe\xa1\x80\xc0\x17\x1f\xdb\x0e\x89\xb1˂\xffhL\xc7+\xfb\x8f\x00\x04\xff\xaa\xb1)\xf0\xed\xa5\x88\xf72]7\xd6\u07bd\xa5\x1e\x12\x93\xe6\x89C)E\xff\xe0\bN\x9d\xb9\x80Gw\xdc\aB(DbߕC\x910\x1b\xd51<\x11BT3\xe0P\xe2R\x8ds\x0e\x9fׅ\x9d\xdb\xee\x84iY\xffq\xcf\xf6ڴk\x93jC\x89\xa6\xeb\u007f8\x1b\x8d\xbe\xaci\xfa\x19JɄӡv$\xf9\xbcGS\x92}\xaf\x15\xe4\xfa\xff[\xae?\xf3o\x92\xbc\x9e\xb7eY\x1a\x8e\xc5\xf4֘\xa6\xfd\xaf};\xeaw\xff\xf3\xa7\xee\x94n\xf5\xb4\xf5Ǥ\xaa/\xd1u\xfd\xcfg\xa3\xb1\xefh\x9a\xf1\x17_\u07b5\xa1슈xU\xe3\x9b_{\x18\x00\xf0\x87\xbf\xb1\xb3zdl\xf2\xadhL\xc3\xfa\xaa\xf2\xf9\xf6\x81\x97'\x00\x04\xc42D\xbcdY\xfc6\x9f\xcf\xe3\xcc\xcb΄n\x98\x97]\xe8W\x1f\xb6`\xe3\xfa*(\x8a<\xefs9\x17x\xee\xf5\xd3\x18\fj\xf0y}\b\x87fP\x9a\xed\xc1cwW\xc0\xe2\x1c\xa6i!+#\rUkJ|\xe7/v\xff\a\x00_\x9b\xbf\x99\xc4Ȟ\xed\xb5\u007f\xaci\xc6_\xfa|n\x94\x16\xe5#ן\x81\xe4$/\x14E\x9eϓ\t\xc1\x01\x10\x10B\xc1-\v\xfdC\xa3U\x1d\x9d=U\xfdC#\xbf55\x15\n\xed\xd9^\xf7{B\xe09\x00&\x00|\xe9\xa1Z\xbc\xf8Ʃ%?t\x82\x98\x0f\x1a\x86u8=5\x19ii)\x18\x9f\bbbr\xfaO\xf64\xd4\xfd\xfbƦ\xc07W/\x99\x1e\xc1\xb7\xbe\xbb\x1f\x00\xf0\xadﾆ\xdf{\xe6\xc15}\x03\xc3G-\x8b;s\xb23\xb0\xa9n-b\x9a~\xa5c\x83a\x18\x10\\$v\f/)*\x14\x05\x0eU\x01\xa5ln\x9e\x00\x003\xa1Y\xc4b1\xac\xad,\x99\xb7T\x8cR|\xf7\x17\xa7PZY\x83Ǟ\xda\bI\x96199\x89\x93\x81\xd3\xf8\xc1\x81S\xf8\xf5]\xeba\xf2x\x87\xb0\xf2\xd2\x02\xb4wt\xfd\xe6ן\xbc\xff\xb7\xbf\xf3\xf2[&\x00\x84\xc2џ\xfa3Ӟز\xa9\xc6\xccHKe\x94\x12\xc2->OH\xc6輵\x14B\x80[\x16L\xcbBnv&\xf2s\xfd\xd04\r\xfd\x83#\u07b6\xf6ο\x1f\x9d\x98\xfc\xb3=\xdbk\xbf\xedp\xa8\xff\xfb\xfb\xbf8\x16^\xce\xe06\xdcV\xe4\xd0u\xe3\xa7wm\xa9GeY\xd1\\\x9e\x17c\x13A\xbc\xf3\xfe\x89?\xfe\xd2C\xebJ_|\xa3u\xcfj$\xd6\x1c\xa9\xbe\xf9\xb5Gj\a\x87F\xbe>6\x11\xfcj4\xa6ь\xb4\x14<tϖk\x90*^\xdb\u007fbr:\xf1³%\xf5M\x92\b!\xb1\xd9\xd9(L\xeb\xe3e>F)&\x82\xd3\xc8\xf1g\xc0\xa1\xa8\x88i\x1a$J\xf1VK\x0f\\I\xe9x\xf0\xfe\xfb\xe0\xf7\xfb!\xcb2\x82\xc1 (\xa5841\x81\x8b\x03A\xac\xc9O\x85ar\xf8<n8T\x05\xe1X\xe8\xe2\xbfx\xea\xceÚf\xb8\xeb\xd7W<^YVlh\xba.[\x96\x05˚\xd3f\x04\xb1\x98\x8e\xb1\xf1 @\x80\xa9\xe9\x10\x14E\x86\xd7\xedBVf\x1a\b\x00ӌ\xb73+\xcc\xcfAyi!\xba\xba\a\xf2\xde;~\xea?\xcd\xceF\xbf\xf5\xd5/l\xf9;\x8f\xdb5&\x04\xa7sI\x17\xb1H\xfa\x85Pb\x84ó\xb5.\xa73\xb9\xa6\xaa\f\xd1\x05\xad\x88S\x92}\xd8\xf1\xe0V\xbc\xf8\xb3CO\xfe֯\xdd\xf5mI\x96'\t\xc4\x12\xcf\x1e]yG\x81\x9b\xfb\xbbŲ\x93\xe4\x06\u007f{\xe5\xbf\x17&\xa1\xe2?\x13\\P\xc30\x8ac\x9a~\xff\x85\xae\xde\x1c\x02\x02.,T\x94\x15ᾭ\x9b\xa0\x1b\xc655\xb4\xc4(.t\xf5\xcdy\x94\xb3K\"\x16\xa5\xf4\xf8l$\"z\xfa\x87I~\x8e\x1f\x9cs\x10J\x10\x8bŐ\x9c\xe4K\xb04\xde\xf6\xe2\\\xf7\x186o\xbd\x0fIII(**\x82\xcb\xe5\xc2\xe0\xe0 \x06\a\a\x91\xe5\xcf\xc2ٮ^\xac-J\x87arH\x8c\xc1\xe9t +#\xb50-5\xe9\xab\x00AYq>4]\x97?\x16\x85\x04\xb3\x91(N\xb5\x9dGO\xff\xf0|$\xf2\xf1P\x12PJ\xe1\xf18QR\x90\x8b\x8a\xb2\"x\xdc.\xc4b\x1a\n\xf2\xfc\xc8\xcd\xce\xc0\xd0\xe8\x84\xd2\xd6~\xf1\xeb}\xfdÐ$\x06F)\x14U\x86\x10\x02\xa6e\xcd횾lZ8\xe7\xf0g\xa4\xcd?\xdbB\xf7\x9f\xec\xf3\xa2\xac8O\xee\xea\x1d\xfc\x97s\x16t\xd1ȇ\xe0\xb2e.\xce\xc5ui\xf0Y\x01c\fi\xa9I\xc8\xcd\xcaDqa.R\x93}\xd0t}\x91g$\x18\x19\vbpx\x14\xaa\xa2\x98\x8dM\x81w\x97\x9a =\xae\xaaJۇͭ\xeb\xfc;\xd3\x13\xee(\x1e\xfd]\xb9]&\xcef\x01\xd34\xe75\xd7\x1c\x19X\xc2}\xcdWPN\xbc\xe7I^\x0fJ\n\xf3@H|\xfb\xf2\x95o\x9cCQ\xb0us-\xee\xbc}\xc3Uk\a\x84\x001MCW\xcf \xda\xda;q<p\x06\xf55\x95\xa8_W\t.8\x04\x80\xec\xcc4\x14\xe6e\xa3op\x18\x81\xd6s\x18\x19\x0f\xc2\xe5r\xa2\xaez\r\xfc\x99iPU\x05$\xd14\x1b\x10\xa0\x94b<8\x8d\xfdM\xbf\xc2\xe6\r5\x90$鲜\x8deqx\xbd.TW\x96`\xeb\xa6Zh\x86\xb1\x80\xe6b\xfeT\x92\xe0\x1c1M\x8fo\xdff\x14\x84\x108\x1d\x0eȲ\x14w\xad\\,l\xd0\xfd\x99\x02\xa5\x14\x8cQXf\\j\\\xef$\x16%\x04\xc7ZZ\xa1\xc47!\xfc`\xa9Q\xb9\xd4\xd8\x14\xd0\xf66\xd4=\x14\xd3\xf4\xce\xfdM\xbfr߹\xa5\x0e\x05\xb9~8\x1c\x0eLL\x8e̋5J\t\xca\xf3\xd3\xd0q\xf1\x12\xf2\xf2\xf2\xa0(\n\xdcn7\x06\x06\x06\x10\x89D0<<\x82M\xc5i0\x12\xe4\xb18\xc7L8\x02\x97+~.\xf1Zc,\x04@\x13D\xe6\xfc\xda\a{\x14Y\xc1ڊ\x12T\x96\x17adt\x12-\xad\xe7\xf1\xc3\u007f|\r\x95全\xae,CJ\x92\x17\x9a\xa6#;+\x039\xfe\fD\"1\\\xea\xe9G\xcb\xe9s\x98\t\xcdBV$8\x1c*R\x93|\x90$\t\x02b\xbe8\xef\v?=\x80\xaa5%\xa8\xad^\x03E\x96 \x000\x89b6\x12\x83\xd3မ \xdc\\\xa9\xa6\xae\x9e\x01\xf4\r\x8e\"\x1a\x8d\x812\n\x97\xc3\x01\xa7S\x9d\u007f\xdeh$\x8a\x98f\xc0\xb4,\xa4\xa5\xf8\xb0\xa1v-TY\xbe\xee\xc4\xfd\xff\x00\xe7\x1cK\xf9HN\x87\x8aSg.`xd\x02\x8c1\x03\xc0\xb7炟\xa5f\xdeG\xf66\xd4\xdd\x1d\xd1bG\x0e\x1c~7\xb9\xa2\xac\bٙi\x18\x1a\x1d\x87i\xc6'ܴ8\xb6\xddV\x82o\xbfԌ\xee\xee\x1eD\xa3QH\x92\x04\xc30\xd0\xd5ݍ\x99\xc9aT\u07b7\t\xba\xc9A\x00D\"1Ĵ\x18\xd2S\x93o\xac2\xae\xf3f\v!\xe6?CFz\nv\xef\xb8\x1bC\xc3\x13x\xffx\x00\xad\xed\x17QSY\x86\xdb\xea\xd7\xc6\xc5>\xe7P\x14\x19Օ\xa5\xa8\xab\xa9@4\xaaaxl\x0233aL\x04\xa7\xa1\x19\xfa\xbcQ\xcc\xcf\xf5#+#\x15e\xc5\xf9\xf3\xc1\x02%\x04SSa\\\xb8ԋ\xc7v\xde\x0f˲\x10\x9c\x0e\xa1\xa5\xf5\x1cF\xc7&\x90\x9e\x9a\x8c\xb5\x15\xa5\xc8\xcaH\x85\xcb\xe9\x00\x17\x1c\xdc\x12\xf3~\x8fѸ\xe52M\x13\xa7\xcet\xe0'\xaf\x1c\xc2\xd6\xdb֣\xb4(\x0f\xa6\xb5\xb2N\xc4QJ\xd1\xdaމw?\n\xc0\xe9P\x00\xe0\a\x8dM\x81\xd6%'H\x17\x9a\xb6\xbd\ru\x05\x00\xfe\xbdiY\xcfPJa\xe8\x06\xb6ݻ\x19\xf9\xb9\xd9\xf3\xae1\x1c\xd1\xf1\xcb\xf7.b*F\xa0\xa8\x0e\xe8Z\x14~\x1f\xc5\xce;ʠ\xca\xf1<\x98\xc4\x18N\xb7_\xc0\xe0\xf0\x18vm\xbb\v\xba\xfe\xc9\xed\xff\x13\xf3\x13\bLτ\xf1as+F\xc6&\x90\x92\xecCEY!ʋ\v ',\f\xe7\x1c,\xe1\xa6\x16\xbb\x98iY\x89Eu\x86\x91\xb1I4\x1dy\x0f\xbaa\"+#\x1d\x16\xb7\x90\x9e\x9a\x8c\x92\xc2\\$%y\xe1v:\xc0\x18\xc3l4\x86\x91\x91q\x8cLLbv6\n\xce\x05d\x89!9ɋԔ$d\xa4\xa5@QdD\xa21\x1c;\xd1\x06B\t\xb6\xdeV\v\xcb\xe2\x9f\xe9\x92\xf8\x84\x10(\xb2\x8cH4\x8a\x8fN\xb6\xa1\xb3\xbb\u007f.Q\xda\xd8\xd8\x14ط\xack]+q\xb8\xb7\xa1\xae\x01\xc0_\x13\xa0\xcc\xe9t\xba\xbf\xb0\xeb\xbey\xabB\x008U\t\xc1\xb0\x86\xe9p\f)^'\xbcn\x05ј9/f\xb9\x10\xf8\xc7W\x9a\xb0\xbb\xe1^\xf8|\xee\xab֝\x84\xb8\xbe\x95\xba\xd1\xc3ǉ\xf2\xf1\xea\xc0Lx\x16g\xcf_¹\v]\xe0\x1c\xa8(\xcdGQa\x1eR\x93}\x89SFbѨ\x8a\v`l|\x02\x81\xb6\x0e\xf4\r\x8c\xa0\xa6\xb2\x14kJ\v\xe1\xf1\xb8\xe0v9\x01.`X&L\xd3\xc2\xc4\xe4\x14Z\xcfubj*\x84$\x9f\x1b9\xfe\f\xa4$'%\xf4#\xc7\xf8\xc4\x14.\xf5\xf4#\x1c\x89b\xf7\xf6{\x90\x92\xe4\x85\xd3\xe9\xc4\xcf_?\x82{\xef\xdc\x04UU\x80Ϥ\xee\x8ak\xc6p$\x8a\v\x97z\xd1\xd6~\x11 \x04\x8cR\x01\xe0e\x00_\x04 \x96\xb3\xdaA\xae\xb7.\xb7\xaf\xa1\xae\xc0\xb0\xac\xef\x14\xe6e?\xf2н[.\x8b\x1c\xe2:\x05\xe0\vHBH\\\xf4\xbfz\xe0mL\x87BHM\xf6]v\x92:^\xea(\t\x1bk\xab\x90\x92\xe4\x83n,ݒ\t!\xa0\xaa\n\x8e\x9f<\x83\xae\xde\xc1\xcb\xde|B)\x18\xa50L\x13\x91H\x14\x16\x8f\xbbcEU\xe0P\x14\xa8\xaa\f\x97\xd3qYl@\bAL705=\x03]7\xb1\xb6\xa2\x18\xeb\u05ee\x81\xcf\xe3\x06\x17\x1c\x9an <\x1bA\xdf\xc00\xba\xfb\x86\x10\x9c\x9a\x86n\x98\xa0\x94\xc2\xedrB\x96X\"\xc8\xe1\x97]S7L4\xdc\u007f\a\x1c\xaa\nJ\t$\x89\xe1̹N\x9c9\u007f)\x1e\x18\x89\x85)\x80ŦD,\xe3\xff\xc8\"\xe9\x05,\xf1\xfa\xf1g0L\v\xba\xae\x83s\x01Ib\x00\xf0\x01\x80?kl\n\x1c\xba9\xaa\xde`\xe9C\x92$o4\x1a\xfb(۟^\xf5\xc0]\x9b!K,\xfe\xb1\xe66\xf9%\n\xde\x12B\x10\x8dj8x\xe4\xddx\x15\x1aUEg\xef\xc0\xdc\r\xfa\x00\f\x01 \x9c\xf3\x1côr7o\xa8FuE\xe9\x92t\x16!\x04\x94\x12\\\xec\xea\xc3{\x1f\x05\xc0\x18\x9b\x02б\x9c\xa8~\xb1듹\a\x00\xb2\xd2S\x93\xbdLb\xb2\xae\x9b|j&\xa4G\xa3\xd1aJh$\x1e\xe9\xdd\xf8V\x96ŝ\xf9y\xfe\xb5;\x1f\xd8J#\x89\xfc\x98CU\xf1\xf6\xfb\xcd\xe6\xa5\xee\xfe\xd6\x15P,e\x06@3\x80\xe7\x1a\x9b\x02\xed\xb7f\x03\x97\x80\xaf<\xb2\xc9\x13\x8d\xc6\xfeTU\x94\u007f\x93\x9f\xe7GI\xc2\xcdH\x12\x83a\x98\x18\x1d\x9f\xc4\xf9\x8b=\x18\x19\x1dG\xfd\xfa*TW\x96\x82Q\x8a\xb1\x89 ^\u007f\xf3=\x00\x18jl\n\xe4\x00\xc0\xbe\x1d\xf5\x19B\x88C\x96e\xd5y<n\xac-/FQA\x0e<\x1eW܍Z\x1c\\p\x10\x90xH\xcc\x05z\xfa\x87p\xaa\xf5<&\xa7f\xc0\x18m\x06\xf0hcS`\xe8\x93\x1c\xd1\xc7ﮐ4\xc3L\xa7\x8cz)\xa1\xa6$ѱ\x9f\xbdݾ\xac\x8c\xfe\x13\xf7TȊ\xaa\xf6>\xf1\xf0\x03\xfe\xd4\xd4d\b\xce\xd1\xdeх\xa3\x1f\xb6\xbc\xf9ґ\xb6m+I\xbc\xdf\xeaB?Y\xceM\xbe\xbckC\xb1\xae\x1b\u007fa\x18\xd6nIbI\x12\xa30-\x0eE\x91P^\\\x80\xdau\x15p(\xca|x-K\x12\x02m\xe7q\xeaL\a\b!_ll\n\xbc\xb4@\xc7\xfd\x15\x80\xdf\xd4\r3\x95\x10\xd0\xd4d\x1f\xfc\x99\xe9HN\xf2\xc2\xedt\xc2\x12\x1c\x13\x93S\xb8\xd8ՏP(\fEQ\u0084\xe0\a\x9c\xf3ߥ\x94\x8a\xcf\xea6\x9a};6\xac\x15\x82\xbfS\x90\xe7wO\x06g\xf4\x89\xc9\xe9wsrҟ\xfc\x9b\xc6w4|\x8e@n\x86\xc1\xfbv\xd4\xff3\xc6\xd8?<\xba\xe3\x1e\x10B\xa1\xca\x12(c\xe0\x9c_\xe5rF\xc7'q\xe8\xad\x0f!I\xf4\x0f_8\x18\xf8\x8fW\x04\t\x19\x84`\x1d\xe7xP\b~/\x17\xa2\x90\x00\xbex.\x92@@\x8cSB?b\x8c\xbc*\x04\xdeil\n\f\u007f\x96\as\xcf\xf6:\xfc\xf8P\x00O\xdd_\xed\x8diz>cl\xea\xe7G\xcf\r~\x12\x16`\xd5\x12\xeb\xf2\xb7\xb2^5M3zߝ\x9bHQA\xce|\x9e\xe9ZW\xef\x1f\x1c\xc5\xdb\uf740\xc4\xc8\xde\x17\x0e\x06~\xfc\xff\xcb4\xdb\xf8'\u0383\xdd\xcc\x04\xbfp\xb0E\xa3\x94\xfe\xbb\xa3\x1f\xb5 \x16\xd3\x17\x11ˈ\v\xf8\xee>0J \x04\xf6/\xe5ڋ}o\x93\xeas`\xb1\xf66ԁ\x10\x92lY\xd6錴\x94\xfc\xed\xf7o\x01%\xf1\r\xf8\"\xb1]\x991\x8a\xf6\x8e.\xbc\u007f\xec\x14\x14E\xfe\x0f\x8dM\x81?\xb2\xad\x8eM\xac\xa5\xba\xc4\x12˲\xcex\xdc.G\xfd\xbaJ\xe4\xe6dB\x96$\xccF\xa2\b\xb4\x9eGg\xcf\x00\x18%\xe7\x00lll\nD\xecᶉ\xb5dͳoG}\x19\xe7\xfc5\xdd0\xd7H\x12\x83D)4\xc3\x04\xa3Dc\x8c}\x04\xe0\x91Ʀ@\xc8\x1ej\x9bX\xcb&\xd7ކ:\x0f\x80\rB\x88\xdb\x018\b!\xdd\x00\x8e\x01\xe8hl\n\b\xdb\x05~\xfe\xf0\xff\x00\xe5#櫑\xb2g\xf8\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Frame_window_150 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x96\x00\x00\x00\xda\b\x06\x00\x00\x00D\x9b\xbb\xf5\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x144/-27\x19\x00\x00 \x00IDATx\xda\xed}wx\x94ם\xee{\xceWfF\xbd\xa2\x82\n\b!\xc0 i\xa8\xa6w\x18\xc06\x8e\x1d\x1b챝r\x93\x9bM\xf6ɖds\x9dl\xb2\xbbwK\xf6\xb9{7\xbb{\xb3\x9b\xcdfw\xd3\\`(\xeeF6\fͦ\x1al\fcDGBB\b\xa1ޥ)_9\xf7\x8f)\x9a\x19͌\x06\xa1\xce\xf7>Ob13ߔs\xde\xef\xfd\x95\xf3;\xbfC\xa0!\x00f\x93\x11\x16\xab\xcd\xfb\xb7\b`\r\x80\xa7\x00d\x01\xd8\r\xe0\xb0\xc5jk\xd2F*2\x886\x04\xfdȔ\xe8!ӷ\x00l\x0es\xc9%\x00\xff\x01\xe0C\x8b\xd5v;\xf8=4<\xe4\xc4\xf2\x92\xc1\xa3L\x8b\x00\xfc8\x98L\x8c1\xa8*\x03!\x04\x94\x86\x1c\xae\x1b\x00\xfe\x02\xc0\x11\x8b\xd5֪QJ#\x16\xcc&c\x16\x80?\x01\xf0\xc3~db\x00O)\x92\x93\x13`\x9cS\x84\xba{M\xa8\xbeS\x87^\x87\x13`\bG\xb2\xf7\x01\xfc\b\xc05\x8b\xd5\xc6\x1ef\x15#\x0f\x11\x89\xbc\xea\xc4\x01x\x04\xc0.\x00\xb3\x83\t\x05\x10dg\xa6a^\xc9,\xa4\xa7%C\x92d\xa8\xaa\n\x00\x10\x04\x01\x8a\xa2\xe0F\xe5m\x94_\xad@\xaf\xdd\x0e\x02\x02B\xfa\rc\x0f\x80\x17=\xa6\xd2\xf50\x12\x8c<d\x84\xda\x00\xe0]\x00\xba>2\x01\xaa\xaa\"-%\x11\x8b\x17\x94`Rz\n$I\x02c\x91ߗ\xe38\x00\f\xe5W+p\xf1\xf2\r\x9f\xb9\f\x81\x9f\x00\xf8\a\x8bզ>L\x04#\x0f\t\xa9\x9e\x03\xf0\x1a\x00!@\x9d\x180\u007f\xee#\x983\xa3\x00\x8a\xa2B\x1d\x88Ma\xc0\xf3\x1czz\xec8q\xe6\x02\xea\xea\x9b \b|\xa8\x97\xfd\xc2b\xb5\xfd\xf1\xc3B.2\xc1\t\xb5ѣP\x06\xefs\x92,#!.\x16k\x96/DJr\x02\x14E\x1d\xba\xc1$n\xb3\xf8ť\xeb\xb8P~\x1d<\xcf#H\xc4$\x00?\xb3Xm?ш5>\tU\x00\xe0\f\x80t\xefs.\x97\x84\x8c\xf4\x14\xac^\xbe\x001z=\x18c`\xc35\xa8\x84\x80\x10\xa0\xa2\xaa\x16\xa7\xce^\x00\xc7q\xc1f\xb2\v\xc0\xb7,V\xdb\ue26a`\x13\x8dX\x14\x80\x15\xc0z\x9fDH2\xd2Ӓ\xb1z\xe9\x02\xc4\xc6\x1a|\x8e\xf8\x88\f\xae\x87L\xb7n\xdfŉO·2\x91u\x00\x96X\xac\xb6\x9a\x89F0n\"\xa8Tye=\xcc&\xe3\x0f\x00\x1c\x050\x1d\x00\x14E\x81\xc1\xa0\xc7\xe6uKQ:g\x068\x8ez\xa2\xbe\xfb\x83(\nPU\x06\x9d(\x00\f\x83z\x8f\xd4\xe4\x04\x94\xcc.\x02%\x04\xb7k\xeb\xc1\xf3\xbea\x8f\a\xf0\xbd\xe2\xc2\xcc\xe9\x16\xab\xedm\xefo\xd1\x14kl\x10+\x1b\xc0\x01\x00\xc5^\xa7\x9c1\x86\xe5\x8f\xceŴ\xa9\xb9\x90eyPdr9%ܸu\x1b\x95յhm\xeb\x80(\n\xc8\xceH\xc7̢\xa9\xc8\xcaL\x83,)\xf7\xad~\x1c\xa5p\xb8\\8\xfd\xe9\x17\xa8\xae\xa9\x83(\n\xfeO\xb7\x03\xd8f\xb1\xda\x0eM\x04\xf5\"\xe3U\xa5<\xbe\xd4\xf7\x00\xfc\x8b\xbf\x1fU4-\x1fK\x17\x95\x00 \xf7\xa5.\x94Rp\x1cEMm=._\xabĽ\xc6f\x10_Z\xa1/\x92\x94e\x051\x06=\xa6M\xcd\xc1#E\x05\x88\x8f\x8f\x85\xcb%\xdd\xd7\xf7\x17\x04\x1e\xf5\r\xcd\xf8\xe8\xe4gp\xba\xe4\xe0d\xeb\xab\x16\xab\xedkf\x93\x91X\xac6\xa6\x11k\x84\b\xe5A\x1c\x802\x00\xab\x00w\x1eJ\xaf\x13\xb1v\xc5\"O\x1e*z\x95\xe2y\x0eN\xa7\vWoT\xe1\xf2\xf5J8\x9dR\xb8tA\x00\x18c\x90$\x19Y\x19i0Ι\x81\xc9Y\xe9\x90\x15\xf5\xbe\xc8,\n\x02>9\xf7\x05._\xaf\x82\xc0\ax%\xad\x00VZ\xac\xb6˚\x8f5B*U\\\x98\xb9\x00@\x15\x80)\x00 \xcb2\n\v\xf2\xf1\xf8\xc6\x15\x10x\x1e\xaaʢ&To\xaf\x03g?\xbf\x84\xa3'?Csk\xbb{@8\x1a\xb5c\xceq\x1c\xec\x0e'\xaeWV\xe3Z\xc5m\xc4\xc6\x18\x90\x9c\x94\x00J\t\xa2ᗢ\xaaȝ\x9c\x89\xa9\xb9Y\xa8\xae\xbd\aIR\xbc\x0e\xbf\x01\xc0\x1f\x16\x17f\xb6\x96W\xd6\u007f:\x1e}/2\x9eHe6\x19\xbf\x0f\xe0\x9f\xbd\x8aA\b\xc1\xc65K\x90\x91\x9e\x02YV\xa2\xbb\x938\x8a\xde^\a\xce|^\x8e[\xb7\xefB\xaf\x171Ty\aEQA(\xb0t\xa1\x11\x05\xf9\x93}\xca\x16\x8d\xfa\x19\xf4:\x1c>~\x16U5uเ\xfb\xfd0\x80-\x16\xabM҈5\xf4\xc4\xe2<\x0e\xfaz\x00P\x19CRB\x1c\x9eܼ:jBQB \xab\n>9\xfb\x05nV݁^/F\xa3*\n\x80;\x00\xfe\x1d@\a\x80\x1f\x00\x98\n@\x8ct\x91;\x83ϰv\xf9\"LΚ\x14\xb5\x93\xcf\xf3\x1c\xee\xdcm\xc0\x91\xe3\x9f\x06+\xa7\x04 \xcbb\xb5\xb5\x8c\x17Ǟ\x8c\x03R%z&7\xde\xebO\x15\xcf,\xc4|\xe3,H\xf7\xa1R\xb6\xf2\xeb\xf8\xfc\xe25w\xda``t\x02\xf8W\x8b\xd5\xf6W\xfe\xbe\x9d_\xcd\xd6\x1f\x01\xf8[\x00\x89\x91\xc6PUU\x88\x82\x80-\x1bV >\xd6\x10\xf5\x92\x11c\fo\xbc\u007f(Ԫ\xc0t\x8b\xd5V1\x1e\xc8E\xc6(\x99\xbc\xa6/\xc7C*\xb8\xfd)\x05[7\xadBRb|T&\x86\x10\x82ֶ\x0e\x94\x1d<\x0e\x9e\x1f\xd0!W\xe1.\xe0\xfb\xb2\xc5j\xab\x88\xf2\xfb\xa5\x00x\x13\xc0\xcaH\xfe\xaa\xa2(Ș\x94\x8a\xcd\xeb\x96E\xad\xb0\x82\xc0\xe3\xc0\x91Shhj\r\xce\xdao\xb6Xm\a\xc6:\xb9\xc8\x18V\xaaE\x00\xce\xf69\xba\n^z\xf6\xf1\xa8\xa3.\x9e\xe7\xf0\xde\xfe\x8f\xd1\xd1\xd9\x1d\xae\xea\xc0\x1f\x16\x8b\xd5\xf6\x82\x1fa\x06\x9c\xb4\xa0\xaaS\x02\xe0\x1f\x00\xbc\x1c\xe9\x1aYV\xb0b\xc9\\L\x9b\x92\vE\x19\x98`\x94R\\\xbar\x13\xe7˯\a\x9bƗ-V\xdb\xcf4ź\u007fR=\x01w\xd1\x1c\x18\x03\x04\x81\x83\xf9\xe9MQ\x99>\x8e\xe3p\xe7n=\x8e\x1c\xff\xd4?\xc3\x1d\x0e\xbf\x02\xf0\xc7\x16\xabM~\x10\x05\xf0#\xa4\x00\xe0\xcf\x00\xfc\x9fHf.6ƀ/?\xb1.*\xf5\"\x84\xa0\xa1\xa9\x15\a\x8e\x9c\nN\x83\xfc\xcab\xb5\xfd\xa1\x96n\x88~\x92\xbe\x01w\x11\x1e\x18cHJ\x8cǳO\xac\x1f\x90T\x8c\x01\xa2\xc8c\xff\x91Ӹ|\xad\" \xb1\x19\x02\xbb\x00,\xb5Xm\xef\x14\x17f\xaa\xe5\x95\xf5\x0f\x14\xce{\xaf-.\xccT-V\xdb\xc9\xe2\xc2\xcc\u007f\xf4\xa4\f\x96\x84\"\x8a$˰]\xbc\x8e\xccI)HH\x88\x1bP\x85\xe3bc0mJ\x0e.\x05\xfe\xae\x85Ņ\x99\xb3\xca+\xeb\xdf\x1c\x8bĢc\x8cT\xdf\x06\xf0\x1bo\x8e'+#\rOnZ5 \xa9(!\xe8\xb5۱c\xef\ahjn\x05\xa5a\u007f\xd6\x15\x8f\x03l\x06\xd0\xed\xef\x90\x0f\x05\xfc\xde\xcbn\xb1\xda\xfe\f\xeeꊏC~g\x8e\xe2\xe0\xc7gq\xec\xd4\xe7\x10\x05a@g>>.\x16ۿd\n\x1e\x8b\xedf\x93q\x97f\n#\x93\xea\xab\x00^\xf1:\xbbS\xf2&c\xf5\xb2\x05\x03\xae\xf5\x89\"\x8f+\xd7o\xe1\x93s\xe5\xc1\xf9\x9f`l\xb5Xm\xfbF)\bY\xedI\x97\xe8BE\x8e\xb11\x06<\xb9y\rH\x14\xb3\xe1r\xb9\xb0\xf7\xbd\xc3\xc1>\xd7\x0e\x8b\xd5\xf6\x92F\xac\xa0\xc1\a\xb0\t\xc0~7\xa9TL\xcd\xcb\xc6\xea\xe5\v!I\x91s\x82:\x9d\x88\x8fN~\x86ʪ\xdaH\xfe\xd4\xdb\x00\xbeb\xb1\xdazF3\x92\xf2\x94\xf4\xfc;\x80\xef\x84S\xa5\xc76\xac@rR\u0080y/\xa7K\u009b\xef\x1f\x02G\xa9\u007fnwL\xf9\\t\f\x90\xca\xe8%\x95\xaa\xaa\xc8\xcb\xc9\xc0\xea\xe5\v\"\x92\x8ay\xa3\xbe\x0f?F\xd5\xed\xbb\x91H\xb5\xddb\xb5}\x19\xee\xcd\r\xa3\x1d\x9e\xab\x9e\x89_\x0f\xc0\x19ʜ\xbf\xb7\xffcT\xd5\xdc\x1d05\xa2\x13\x05<\xb5e-\x14U\xf5W\x86\xef\x98Mƿ\xd2\x14\xcbM\xact\x00\x8d\xde;6-5\x19\x8fmX\x1e\xd5\"\xf2[\xfb\x8e\xc0\xe9r\x85K%\\\x06\xb0\xdcb\xb5\xb5\x8f\xd5|\x8f\xd9d<\x01`y\xf0\xe3\x92$a\x81q6J\xe7\x14E\x1e\aB\xd0\xdeщ}\a\x8e\a\xdfX\xdb-V\xdbއ6*\xf4\x84\xe6\x1d^r\xc7\x18\fغiՀ!\xb8\xaa\xa8\xd8\xf5\xf6~\xa8,쮘\xd7,V\xdb\xc6\xe2\xc2LǃF{\xc3\xec{\xfd\xbe\xb80S\x04\xb0\"8]r\xf7^\x03\x1cN\x17\xf2r2#.\xaa\x1b\xf4zLJO\xc1\xcd\xca\xdb\xfe\xd1\xe2\xb3Ņ\x99{\x8a\v3\x9bG\xf3\xb7\xd3\xd1\x18T\x0f\xae{I\xc5\x18\xc3ӏ\xaf\x1d0i(+\n^\u007f\xebC\x90\xd0Q\x1f\x03\xf0u\x8b\xd5\xf6\xd51`\xf6\xa2\x8a\x1e=\x9b*\xd6ý&\xe9\x03\xcf\xf3\xb8VQ\x8d㟜\x8f\x98\x8bc\x8car\xd6$,\u007ftn\xf0\ry\x15@\xecC\xe3c\xf9EI\xbf\x82{1\x17\x92$㹧L\x03:\xac\x8a\xaab\xc7\x1b\x1f@\f\xed\u007f\xa8pW\x90\xbe\x82\xf1\x87#\x002\xe0\xae \xedS.JQ]S\x87\x8fN\x9e\x8bXʣ(\n\x8a\n\xa7`V\xd1\xd4\xe0|\xd8=\xb3\xc9H\xfcn\xe4\x89K,\x0f\xa9\xb6\x00\xf8\xb6\x97,OnY3`\r\x94\xaa2\xbc\xbe\xb7\f:1dQ\x81\x03@\x1a\x80\xcb㱜\xd7\xf3\x9d[,V[2\x80\xea@7\x8a\xe0\xce\xddz\x9c<c\x03\x8d\x90\x8b\x90e\x19\x8b\x17\x14#)1\xde\xff\xe1x\x00\xefy\x97\xa8&\xb4\xf3n6\x19\xf3\x00\xdc\xf6\xfe{άi(\x9e5=\xf2E\fx\xed\x8d2\b\xa1\x95\xaa\x1d@&\x00\xe7x\xaf\x11\xf7Ss\x1b\x80\xd2\xe0\xe7g\x15Mż\x92\x99\x11}.B\t^\xdb]\x16l>\x1f\xb7Xm\x1fLtbu\xc1]V\x8c\xb8\x18\x03\xbe\xf4ؚ\x88\x1bF9J\xb1\xf3\xed\x03`\xa1\xcdd\v\x80L\x8b\xd5&c\x82\xc0\x8f\\\xc7\u0b98\b\xf0\xa7\x96,,AA~N\xc4% \x97$a\xd7[\a\x827jd\x02h\x18ɛ\x8f\x8e\xe0\xa0\xfd\xd2K*UQ\xf1\xe5'\xd6E$\x95 \xf0\xd8w\xf0x$ReO$R\xf9\xb9\n\xb0Xm\xab\x00\x1c\v6\x8b'\xcf\xd8\xd0\xda\xde\x11\xb1ZC'\x8aX\xb9t^0\xf9\xae\x8d\xb4\xa2s#q\x17\x16\x17f\xae\x02\xf0K\xb7_\xa5`\xeb\xe6\xd5\x11\x93\x80\x82\xc0\xe3\xe4\x99\v\xb8\xd7\xd0\x1cj\x10\xed\x00&Y\xac6\x17& <{$a\xb1\xda^).\xcc|\x02@\xb6O\x05(\xc5\xd5\x1bU\x98UT\x10\xd1/MMN\xc2\xed\xda{p\xf6\xed\x1e\xd2{\x16ۏO(Sh6\x19\xbb\x01Ī*CA\xfed,{\xb44\xac\xaf@)EEU\rN\x9d\xfd\"\\\xa8\x1dk\xb1\xdaz1\xc1\xe1q\xb8\t\x80Z\u007fry\xcd\xe2\v\xcfl\x89h\x12)\xc7\xe1\x95]\xef\x05\xfb\xa6\xb3\x01\\\x19\t\xf5\xa2#0@\xbf\xf1\xe6TD\x91Ǫe\xf3Ò\x8a1\x86\x8e\xce.\x9c<k\vG\xaa\xf4\x87\x81T^\xb3\xe8\xd9WX\xe0I\xa7\x04\x98E\xeb\xd1\xd3\xe1\x02\x1aw$\xad(0\xadY\x12\x9c\xc694R&\x91\x0e\xe7\x1dg6\x19\xe7\x03\xf8\x867$\u07b8z\t\\\xce\xf0k\x80\xa2(`\xff\x91\xb0\x036\x1b@3\x1e2X\xac6'\x80I\xc1\x8f76\xb7\xe1╛\x11\xeb\xce&g\xa6#%)\xd1_ٲ\xcd&㟏{Sh6\x19o\x01\x98\xca\x18C^N\x16V/\x9b\x1fv\xc9F\x10\x04X\x8f\x9e½ƖP9\x9boY\xac\xb6_\xe3!Fp\xa96\xe0.\xa1y\xfa\xb1u\x88\x8f\x8f\x8d\x18Y\xbf\xb6\xb7,\xb8F-\x15@\xebp\xaa\xd7p*֟\u0093]'\x84`\xd5\xd2y\x11\xd7\x01+\xabjp\xb7\xbe)\x14\xa9\xf6>\xec\xa4\xf2\xe0S\x00\u007f\x1d\x10\x01\xeaD\x1c\xf8蓈\x81\x90\xa2\xaa\x98[23\xd8$\xbe:\xdc&qآ\xc2\xe2\xc2\xccC\x00DYV\xb0dA\t\x92\x93\x12\"\xdeUe\aO\x80\xeb\xbf\x06Xa\xb1\xdaVj\x9crG\x8b\xe5\x95\xf5Ǌ\v3\xb7\xc2\xdds\x1e^\x17\xa3\xbb\xbb\a\xf9\xb9\xd9a\x9d\xf9\xdc\xecL\\\xbdQ\x05\xa5\x8f\\EŅ\x99\x1f\x14\x17f\xd6\r\xd7B5\x1d&\xb5\xfa\x8d7g\x95\x90\x10\x1bj\x1d\xab\x8fT\x1c\x87}\aO\x84{\xab\x05\xa3\xb5\xd65\x86}\xae\xf9p\xef{\xf4E\xd17*k\xd0\xda\xd6\x11\xf6\x1a\xbbÉ\x95\xcb\xe6\aW\xe3\xbe6nL\xa1\x1f\t\xbe\x02\xb8\xb3\xc0+\x1e\x9d\vG\x84n,\rM\xcdh\v\x9d\xf4\xfb\x06\x80\x0e\xad)\u007f\xc81\xde\x18\xe8\x9f\xf28|\xfcl\xb8\xb5T\x10\xe2v\xe4\xd3\xd3R\xfc\x1f\x9ee6\x19\x9f\x1e\xf3\xa6\xd0o9\xe2C\x003\x00 =-\x05\xf3Kf\x85\xad\\\x10\x04\x1eo\xee;\x12\xca\x04\xee\xb3Xm/O\x94&d\xc3`\x12\xef\x16\x17f\x16\xc0oM\xd1\xe5\x92}\x1bcC\xfaZ\x8a{sʥ\xab\x95\xfe\xc9\xd5%\xe5\x95\xf5?\x1fӊ\xe5\xa7,\x1b\x00\xc0锰lQ)\\\x11J\x8c?;\u007f9\x14\xa9\x00\xe0\x19\xcd\x04\x0e8\xde_\x05\xd0\xd0\xe7RP\x94_\xab\x88\xe8\xc8'%\xc6#or\xa6\xffCyf\x93\xf1\xc9\xf1`\n\xdf\x00\xc0\x03\xc0\xe4\xecI\xc1e\x1c\x81\x1fL).^\xbd\x19\xea\xa9m\x16\xabͥ\x99\xc0\xa8\xb0-8wt\xe0ȩp\xa7f@\x92d,\x9a?\xc7\u007f\xa9\a\xf0,\xb5\x8dIb\xf9\xa9\xcbSnY\x96\xb0tAI\xd8\xf4\x02\xa5\x04GO|\x1a*\x11j\xb3Xmohj\x15\xb5j\x1d\x87\xbbw\x04\xbci\x9d\x86\xa6V\xc8R\xf8\xb4NbB\x1c\xf2s\x02Tk\xb2'\x91=\xf6\x88\xe5\xf1\xad~\xe2\xf5\xd9R\x92\x13\x91\x90\x10>i'I2j\xee\x86\xf4\x9f\x9e\r2\xab\x1a\x06\xbe\xa1\xbf\x16|\xd3~p\xe4d8\x17\x03\x92$c~\xe9#p:\x03\xd6\xf0\xdf\x19sλ\xa7z\x81\a\xf0\x11\x00\"+\n֯Z\f}\x98\b\x85R\x8a\xa3'>\x83\xc3\xd1o\a\xd4\u007fZ\xac\xb6\xd75\xbaܷ#/\x15\x17f\xf6z}[ozafᔰ\x15\x10qq1\xa8\xadk\xf0'WBqa濔Wֻƌby\xd4e\xae\xc7ă\xe38\xa4&'\x84m\x92\xe7t\xb9p\xa7\xae!\xd4S?\xd4L\xe0\xa0U\xebgp7gs\xab\x05\xa58\xf8\xf1\x99\xb0u[^Ւ\xfa\xf2Z\x04\xc0α\xe8\xbc\xfb\xf6\xb1-\x9e7'l\xa7<\x8e\xa3\xf8\xec\xfc\xe5P\xcd\xcf\xfe\xc6b\xb5uj&pp7\xb6g\xdc\x02Lbk[\a\x145\xbc\xaf\x95\x95\x91\x1aܒ\xe0\xb1 \u007f\xf9\x81@\x86\xe0nI\x86\xbb\xcb/\\.\t_{~k\xd8,\xbb\xa2\xa8xmo\x19\xf4\xba~f2\x06\xeeF\x1a\x1aS\x06?\x0f\x04\xee\x1d־\xbb6wr\x06V,\x9e\x17r>8JQ~\xb5\x02\xb6\xcb7\xfcI\xb0\xc9b\xb5YG]\xb1\x82\xef\x94\xec\xcc\xf4\x88\xe9\x85\x1b\xb7nCןT?\xb3Xm\x1a\xa9\x1e\\\xb5\x18\xdcg$\xfaPQU\x1b\xf6\x1aEU1}Z\x1e\x9c\xae\x00\xb7\xeawC\xa5Xt\b\xee\x94\u007f\x04\x00\xc6T,\x9e_\x1c\xb1\xaa\xf1\xc2\xc5k\xa1$\xf2'\x9ao5d\xaa\xf5\x9e\xffc:Q@eUmX_K\xaf\xd3!-9\xc9\xff\xa1l\f\xd1F\xd7\a\xf5\xb1\n\xe0I\x88*\nCrr\xf8\n\x86\x86\xa6\x16\xc8\xfdw:\x1f\xb0Xm\x92\xa6VC\xa6ZN\x00\xff\xcb\xff\xf1\xb3\xe7/\x86\xbdFUU\xcc/\x9d\x15,\x06ύ\x05b\xf92\xbf\xf9\xb9Y\x11\x8a\xf8x\x94_\xa9\bN\x882x\xf2V\x1a\x86T\xb5\x02j\xd7$YEO\xaf=\xe4\xeb\x19c\xc8\xcaH\v>\xb2\xe5\x1f\xc7\x02\xb1~\xe4\xfd\x82\xf3Kg\x86}\x91\xc3\xe9\u009d\xba~\t\xd1\x0ex\xba\xeai\x18R\xd5\xea\x80\xdf\xd61\x81\xe7p\xe9jE\u061c\x16\xe58\xa4\xa6\x06\x98\xc3\x14\xb3ɘ<*\xc4\xf2Գ\xe7\xc3\xdd\xe7\xdc\xd3+4\xb4\x19$\x84\xe0\xf6\x9d{\xe0h\xbf\\\xec75\x138l\xf8\x8a\xff?\xaeݬ\x0e\xbf\x83\x9a1\x18\xe7\xcc\bN\x11-\x1e\x15by\b\xb1\xcc\xfb\xefԔĈf\xb0\xe2VM\xf0\x1d\xe3\xb0Xmoi\xf3?l\xe6\xf0n\xe0\xdd\r\xb4wt\x855\x879\xd9\x19\xc1\x8d\xee~4\x9a\xa6\xf0\xeb\xde/6g洰Ѡ\xd3\xe9B}Sk\xf0\xc3\xe5\x1a\x05\x86\xd5\x1c*\x00\xfe\xa9\xcf\x1c\xf2\xb8QY\x1d\xb6G+G)\xc4\xc0%\xb8\x95f\x93Q7*\xa6\x10\xbe\xe3q\x19\xf2r\xb2þ\xb6\xb6\xae\x01!NA\xfaC-\xc50\xec\xf8k\xff\x81\xaf\xac\xaa\r{\xe4\nc\f\x85\x059\xc1\x0fg?\xc8\x1c\xf1\x83$U\x89\x8f\xed<\x0f\x9e\xa7p\xb9\xfaW\x89\xf2<\x8f\xea;u\xc1\xd1`\x97\xc5j;\xa7\xcd\xfb\xb0\x9b\xc3^\xb8\xd7\x0fEo\x00\xd5\xddӋ\x18\x83>$\xb1f\x14\xe4\xe3fe\x8d\u007f\xcek\x85\xc5j\xab\x1a1\xc5\xf2\xf8W\x8b\xbd_hRj\n$)|\xddUCcK\xf0\xc3״\xa9\x1f\x11s\xc8\x00\xbc\xd6\xe7\xeb\n\xb8W\xdf\x1c\xb6\xcfVrrBpG\xc5\x17G\xc3\xc7Z\xed%ִ)\xe1\xdb\xeatu\xf5\xa0\xd7ޯ<\xe6\xdf438b\xf8\x1b\xff\x9b\xfcvm\x1d\xb80\xad'\x19#\xc1G\xaal\x185b\x01@vV\xf8\xf5\xc1;u\r\xc1\xcb\t\xccb\xb5\xed\xd0\xd2\f#c\x0e-V[-\xdc\xddy\x00\x00\rM\xada7\xb60\xa6\"#=5\xf8=\xb2G\x8cXf\x931\x06\x9e\r\x93\x1c\xc7!6\xc6\x10:\xd2\xe08\xd4\xd57\x057\xf7\xb8\xadM\xf9șCo\xfc\xe4\x1f\xa1wu\xf7\x86u\xe0\xa7\xe6M\x0e~x\xcaH*\xd6,\xef\x1f\x06\xbd\x0e,L\xe2\x8d\xe79\xb4\xb6wji\x86\xd1w\xe2-\xfe7{K\x84\x8d\xadٙ\xe9\xc1n\x8dq$\x895\xdb\xcb\xf0\xa4\xc4\xf8\xb0g\xdd(\x8a\x8a\xde^G\xf0\xc3{4\xffj\xc4U\xeb\xb7}Ģhhl\t\xbb\xbc\x13\x13c\b\xce\xd0/\x1fIb\x95\x00\xeeNƓ\xd2R\xc2\xe6F:;\xbbCU0\x96i\xfeՈ\x93\xeb\x8e\xd7\xcf\"\x84\xa0\xa5\xb5#\xd4\xf2\x1a\xdc\xcf#x\xeb\xd8\xe2\x91$V!\x000\xb8\x89\x15\x0e\x8d-\xad\xc1y\xd1v\xcf\x02\xa9\x86\x91\x87o飣\xab+\xec~\x04EQa\b\xccsM\x1dIb\x15\x00\x00\x01Ab\x98-^\x84\x00M-m\xc1=\x99*\xb4\xf9\x1d5\xdc\xf4w\xe0]NWX\a>\xf8\xbcm\xb3ɘ:R\xc4\xcaq\xdbk\x02\xbd>\xf4r\x12%\x14\x9d\x9d=\xc1\xe7\xef\xdd\xd2\xe6w\xd4pʧJ*CO\u007f\xdf\xd7G\xac\xa0\x8aR\xc0}\x98\xe7\x88\x10+\xd9\x1d\xf5\xf1\xe1\x175y\x0e=\xbd\xbd\xc19,m\x19g\xf4p\xa4\xef\xa6'\xe8\xea\xe9\t?\xb9I\xfd\xda\"d\f;\xb1\xfc\v\xc0DA\bk\xab)!\xc1\xfd\x01\x00\xe0\x826\xbf\xa3\x86\U000fee61\x14]\xdd=a\xeb\xe0\x13\x13\xe3\x82k\xb3F\xc4\x14\xfa\x9a\xac\x8a\xa2\x00\xa6\xa8a%5\xc4\xe1\x00\xda\x1a\xe1\xe8E\x86\x1d\xf0\x1c\xbeI)AO\xaf=,\xb1\f:=H\xa0dd\x8c\x04\xb1\x92\xbc\x11\xa1(\naS\r\xb2\xaa\xf6[:\xf0,/h\x18=tzS\x0ev\x873,\xb1t:\x01,\xf0\xb9đ V\x9e[\x92\x00\x9d\x10\x9eX\x92K\n\xce\xe0\xf6h\xf3:\xea\xf0\xed/p8\\a[\x1dQB\x82\xb7\xe8e\x8f\x04\xb1⼦.&\xc6\x10\xb6\xaaA\x92\xe4\xe0皵y\x1du\xf8j\x93%Y\n\xabX\xb4\u007f@&\x8c\x04\xb1|\xaf\x8fx8\xa3\xaa\"h\xf7~\xa76\xaf\xa3\x0e\x9f\xd5PU\x16\xb1\xb7\x02\x19\x82\xf6\xff\x83&\x96\xfb\xc3\xc3\xf7h\b\xba!ڵy\x1du4E\xb8\xf1\x87\x92#\x83\xba\xc8WqO\"\\\xe9rI\xc1!\xab\xa4\xcd\xeb\xa8×n\x97%y؏\xe7\x1a\x94b1\xe6N\x90\x86kӠӋ\xc1_ܥ\xcd\xeb\xa8\xc3\xe1\xf5\x8f3'\xa5\x86\xadJ\t\x016\x12\xc4\xf2}H\xc4#\xcdH?3\xa9E\x85\xa3\bO\xa9R\x8b\xbf\x175\x989\x1fNb\xa9\xbe\xef\x15\xf1lb\x1a\xfcub\xb5\xe9\x1d=xJ\x95V\f\xf2\xf2\x11!\x96\xdc\xc706\xc0\x9b\x92\a\x0eY5\f)\xa2\xee7\xcbH\xc0\xdc\xdaGL\xb1\b\x00\xa62\x84;\x9aXQ\xfbE\x85\x9c6\xaf\xe3\x03\xaa\xaa\x04kT\xddH\x10+*\xe3,Ir\xb0\x9ei\xc4\x1a7\xc4b\xc1\xc4r\x8c\xac\xf3\x1e\x89}\xfd\xa5\xacW\x9b\xb2\xf1\x01\xc6\x18\x18\t\xed\xfe\x8c\x88b\x91\xfb\xf3\xf6\xb4<ָ\x01\x19\x15\xe7=\xaaO#\xda\xec<\xf4\xa0C\xc8'\r\x1a\x86\xd7\x14j\xd00,λ&k\x1a\x86%ݠA\x83\xe6ci\xd0|,\r\x13\x97X\x9ati\x18^bi\x8a\xa5AS,\r\x13\xc3y\xd7\xd4L\x03\x1d\x8e7\xd5\xd4Lð\x10KS,\rÒ \xd5\x14kB\x81\x8c\x04\xb14\xc5z\xf80\xb2\xbbt4hМw\r\x13\xc3\xc7\xd2L\xa1\x06M\xb14\x8c\tbi\x9c\xd10z\xa6\xb0\x1f\xfd4ۨ\x11kH\x14\x8bh:\xa7\x11k8\x14K\x83F\xac\xe1P,\x8d}\x1a\xb4\nR\rc\x82X\xd1ɚ\xe6Si\xc4\x1a\x8e7%\x9a\x9ci\xc4\x1a\xb4*ic\xa7a\xa4\x9dw\r\x1a\x86\xc7y\xd7\xe8\xa7\x11k8L!\xd3|,\x8dX\xda\x10h\x18S\xc4\xd2DIC$\xf0Ѿ\xd0\xd3+\\\x06ܭ k\xef5\xa2\xab\xa7\x17<\xc7!sR*\xf2s\xb3A\b\xe9w\x9c\x9c\x861<\xf9<\a\xa7Ӆ\xea;\xf7\xd0\xd4\xdc\x06\x95\xa9\x10x~HD\x83\xbf\x0fR-\"\xc0/\x1d.\x17x\x8e\x03c\f\xddݽpI\x12\xaeݬ\x86\xca>CJR\"\x9eڲF\x9b\xb11\x0e\x02w\x03\xe2\xb7ˎ\xa2\xab\xa7\a<\xcf#59\x11\x1c\xc7A\x92$Ȋ\x02\xc6\x18DA\x00\x06y\xaaH\xb4\x8a\xf5\x13I\x92\u007fZ4-\x0f\x8f\xce+\x06\xc7{\x0e\b\xf0\x1e@A\x00\xbbÅ\xf7>\xfc\b\xaf\xed-\x83i\xcdRm\xf6\xc6*\xa9\bAK{\av\xbe\xb5\x1fi\xc9Ix\xea\xf1\xb5\x10x.p>\x01\xb8d\x19G\x8e\x9dESK\xfbo\xcd&c\xbd\xc5j;0d\xae\x92G\xa9ָ\\\xd2\xd15+\x16bj\xded(\x8a\x12\x9e\xa5\x1c\x87\xfdGO\xa1\xa9\xb9-\xb8sr\x99\xc5j{B\x9b\xd6у\xd9d\xb4\x01(\x05\x00Y\x961kF\x01\x16\xcf/\x89x\xa6\x8e p8\xfb\xf9e\\\xbeV\t\x9e\xe7\n\x00TyN\xb9x0\xe7\xdd\xf3&\xefΙU\x88\x82\xfcȤ\x02\x00YQ\xf0\xf8\x86\x950\xe8\xf5\xdaL\x8eQ0Ɛ\x97\x93\x85\xa5\vK\a<\xa8I\x92\x14,YX\x82\x8cI) \x84X\xa2%Հ\xc42\x9b\x8cy\x0e\xa73an\xf1L\xc8rhR\xd5\xd57ym1\x00\xc0\xe9r\xe1\xb1\r\xcb\a$\xa1\x86с\xaa\xa8X\xbdl\x01\\\xae\xbe\x0e\xe9\xa2\xc0\xe3\xee\xbdƐ\xa7\xae:\x1c.,\x9a7\aN\xa7k\xb1\xd9d\xd4\rU\xba\xa1X\x10\x04\xf0aNS\xa5\x94\xa2\xaa\xe6.\xf6\x1f9\x05\x9e\xef;|\".6\x06I\x89\t\xda,\x8eAL\x9f\x96\x1fp:.\xa5\x14{\xdf;\x84\xce\xce\xee\x90'\xba\x11\x02$\xc6ǁ\x10\x02B0u\xa8\x88\x05\xb0\xf0YvUU\xb1\xd08\x1bw\xef5\xa2\xba\xa6\xef\xc8\x15\x97$ar\xd6$-\xf50\xc6 \xc9\nrs2|ևR\x8a\v\xe5\xd7\xd0\xd3\xeb@ɜ\x19\x03\x13\x01\x84\r\x15\xb1\xeeH\xb2\x1c\x91 ^\xf9,\xbfZ\x11\xf0xZJ\x92\xfb\\\x16\rc\x06\x8a\xa2 !.6@\x8dnܪ\x01\x10\xf9\x8ch\xbb\xdd\t\x9510ƚ\x86\x84X\x16\xab\xed\"\xcfq\xce\xee^{\x18SHp\xbb\xb6ޝhs\x05\x9e\x8cn0贙\x1c\x83\x10\xfc\xfca\xc6\x00\xc9\xe3k\xd57\xb6\x84\xbd\xe6^c38\xca\xd5[\xac\xb6\xd6!3\x85<\xcf\xdd>q\xfa|\x80\x0f\xe5U*YVp\xe2\xcc\xe7HKMFww\x0f(\xed#\x96^\xaf\x83V\xe60\xb6@\t\xdc9+\xbf(\xde\xe1r!5%\t\a\x82\xfcd\x9fc/\n8\xff\xc55\b\x02_v_\x9f\x15\xc5k\x8a\xdb;\xbb؉3\x17\xc0\xf3\x1c8\x8e\x03\xcfQ46\xb7\xe2\xf57>@ZJ\x12\xa6O\xcd\x05\xc7\xf3`\xfee\rL\xa3\u0558K5 \xb0l\x9c\x12\x02\u0080\x05\xa5\x8f\x80\x11\xe0\xd5=e\xe8\xee\xee\x05\xc7Qp\x9c{\xae\xdf\xfd\xf0#Ȋ\xec\xdcy\xe0\xc2\xff\xbc\x9fϊ&\xf3\xee\"\x84<R]Sw\xf1fe\x8d\xe0\xcd\xceRJP85\x0f\xebV,\xc2[e\x87\x91\x96\x9c\xe8>Dу\xf6\xf6N\x10m\xa9z\xcc1\xcb\xeep .6\x06\x00\xc0Q\x8a\x84\x848\\\xaf\xac\xc6מ{\x02\xfb\xac\xc7\xf1f\xd9\x11ϙ\xden\x1f\x8c\xe7\xf9.\x00S\xcc&#\x86,\x8f\xe5\xf1\xb3`\xb1ڮ\x010\b\x02\xff\xa7\x02σ\xe3(\x8csf`\xc5\xe2R\xb4ut\xa1\xb9\xa5\x1dkW,\f0\x93\xb5\xf5\x8d\x01a\xad\x86\xd1\a\xcfshi\xeb\xe8\x8b\xea\x19ê\xa5\xf3q\xab\xba\x16\x8c1l^\xb7\f\xdf|\xf1K\xe0x\x0e\x82\xc0\x83\xe7y\x00\xf8\xbe\xc5jk\xbd\x1fREk\n\xbd\x04S\xe0w\x1a\xbd\xca\x18\x00\x8a\xb7\xcb\x0e#+3=\xc0)4\xe8Dܩ\xad\x0f\x99p\xd30z\xe08\x0eWoTA'\x8a\x1e\xe7\x9daRZ\n\fz\x1d\xdex\xef\x108\x8e\x86\x8a\xe4\a\xa5\x0e\x83\x96\x14\x9e\xe3p\xf6\xdcE\x10\x10<\xbeq\x85/%\xc1\x18p\xf1\xeaM8]\xdaٗc\x11\r\x8d-\xb8\xd7\xd8\xecK\x86\xaa\xaa\x8ag\x9eX\x8f\xb6\xf6.\\\xbdQ5d\xce\xcb k\xde\t\xea\xea\x9bP~\xbd\x12\x9b7,\x0fX\x1e0\x18t8g\xbb\xaa\x99\xc11\nQ\x14p\xf2\xec\x05\xc4\xf8\xad\xe7\x12J\xb1n\xe5\"\x9c\xfe\xec\vH\xb22*\xc4r_\xc4\x11\xd47\xb6`\xd6\xf4\xa9HMN\xf4S1\x8a\x0f\x0e\x9d\x80\xa2h\x19\xf7\xb1\x8c\xce\xce\x1e\x9c9_\x0eJ\xa9\xcf$\x16L\x99\x8c\xb4\x94$\xbc\xbb\xff#\x9f\xf3>\x92\xc4b\xde\xff\xd7\xebD\xac^\xb6  +\xdf\xd8Ԏ\xdb5uX\xbbb\x81\xb6\x9c3V\x03C\xc6\x10\x17g\x80\xad\xfc:\xec\x0e\xa7/\xfd\xa0(*\x9eܼ\x06\xdd=Cs.\xfc\xa0\x14K\x96\x15<\xbeq\x05\x1c\x0e\xa7\xef1\x81\xe7Qv\xe88Jg\x17!>6V\xcba\x8da\xe4fg /'\ve\xd6c0\xe8E\xbfy\x95\xb1y\xdd\xd0T\xa6\xdc/\xb1\f\x92,c٢R\x18\fz_\xd4\xc7Q\x8a\xf7\xadǠ\x13E<:\xbf\x18*\xd3\xd4j,C\x92d\xac[\xb9\bݽ\x0e\x1c;}\xdeg\x12\x01 3=\x05\xd3\v\xf2\x1f\xd8\xe2DE,\xb3\xc9\b\xb3ɨSU\xf6\xf3\xe9\xd3\xf21\xb3h\xaa/\xaa \x84\xa0\xaa\xa6\x0e\x8dͭx\xf6\xc9\xf5\x9ezi-\xcd0\xb6A@)\xc1\xe3\xeb\x97\xe3ʍ[hm\xef\xcbm)\xaa\x8aUK\xe7#))\xc1;\xc7\xc3\xd7\xe7ݓ\x1c;\x12c\xd0\xd1\xd5K\xe7\aD\x81N\x97\v\x87\x8e\x9d\xc1\xca\xc5\xf3\xfa\x98\xaf\xf1j\\\xf8Z\x93&\xa5\xa0tv\x11\xde?p\f<\u05f7N\xe8p:\xf1\xe5\xc7ւ\x10\n\x003\x87S\xb1\xbe\xe6t\xba\x96m^\xbf\f\x0eGߦ\rB\bv\xbfmE^N\x06\n\v\xf2\x82s\x12\x1a\xc68\x14E\xc5¹\xb3\x11\x17c\xc0\xaew\x0e\x04\x90\xcb\xeepb\xf5\xb2\xf9\x90e\xf9\xfbf\x93q\xfep\xf9X?_8w6bcb|-\x8ax\x8eC\xd9\xc1\xe3\xd0\xebD<\xbeaU\x80ç9\xee\xe3\x89\\\n\x9eٺ\x1e\xbdv\a>>u\xce\xe77\x13B\x90\x9f\x93\x89\xc9Y\x19\x00\xf0\xea\x90\x12\xcb\xe3[\xfd\x87\xa2\xaa\x89ŏL\xf7\x91\x87R\x82\x9bU5hm\xeb\xc0\xf6/m\x84\xd3\xe5\xd2fh\x1cCU\x19\x9eݺ\x01\xb7nע\xb3˷j\a\x97$c͊\x85\x90ey\xb6\xd9d|ɳk\xeb\xc1\x89e\xb1ڠ2\xf6⚥\xf3\x03\xea\xa1%I\xc2\xe1\xe3\x9fbɂ\x12M\x9d&\bb\rz̘>\x05o\x97\x1d\x01\xf5[5\xe1)Ŭ\xa2\x020\xc6~8$\xd5\r\x1e\xb5\xfa\x81,+\xf19\x933|\xc4\x12\x05\x1e\xc7?\xb1!#=\x05\xb3gN\xd3fd\xa28\xf3\x00\x96?:\x17\x1cGa\xbbx\xcd\x17\x88)\xaa\n\xe3\x9c\x19p\xb9UkS\xb4\xaaE\a\x88\x04\xff\xdc8{\xba7:\x00\x00tt\xf5\xe0\xd6\xedZL\xc9͆K\x92\xc2\x04\xb3\x1a\xc6#$I\xc6\xea\xa5\vp\xcev\x15̯\xca!ƠGnV&\b!\xdf{\xe0\r\xabf\x931\xd1%\xc9ɳg\x16\xfa\x92e<\xc7\xe1B\xf95\xe8u\xa26\v\x13\x14\xf9\xb9Y\xd0\xe9\x04TT\xdf\xf1\xa9\x96K\x920{f\x01\\.i\xedP\xf8X\u007f\x19\xa3\xd7\x11Q\x14\xfc\xa4\x88\xe0\xf6\x9d{\xda\xe8Op\x93\bB`\xbbt=\xa0\x9e.;3\x1d\x94R\xdel2\xbe\x18\x8d9\xa4aԊ\x00\xf8\xf6\xecY\x85\x01N{ŭې\x06ؖ\xadab\xa0\xab\xbb\a\xed\x9d]\xbe\u007fs\x94\"?7\v\x84\x90\xefDc\x0e\xc3)\x96 +JLQA\xae\x9f\xd3.\xa0\xa2\xaa\x16\x02\xcfk\xa3\xfe\x10@'\x8a\xa8\xba}\xd7WW'\xc92\x8a\n\xf2 \xc9\xf2#\x0fb\n\xa72\x95\x11\x9dN\xe7'\x91\fM-m\xd1˩\x86q\x8f\x9b\xb7j\x02\x02\xb7\xe4\xe4\x04Ȓ\x92\x14M\x0f\x87p\xc42\xe9t\x02\x98\xdf\nwGGw\x80\x19\xd4\"\xbf\x87\xc3\x1c\xfa\xaf\v\xebD\xc1\xdd\x1b\rX>Xb\xbd\x90\x95\x91\x1e\xe0_\xd575k۹\x1e:\x10tw\xf7\x15\xfeQ\xca!%)\x11\x00\xb6\r\x96X\x85\x93\xd2R\xfa*܉\xbb\x14\x99\xe3\xa8\x1c\x8d\xb9\xd3\xe871 \xf0\x1cZ\xda;\xfa\xd6\x0f\x01\xa4$%\x00\xc0\x8c\xfb&\x96\xd9d\xa4\x00\x92D\x91\xf7\x95\xadr\x94z\xab\x1a\xec\xdap?DzE\b\x1c\x0e\xa7\x8fX\f\xf0\xee5L\x1d\x8cb\t\x8c1\x9a\x9e\x9a\xd2\xf7\x01\x94z? *\xef]s\xde'\x0e\xb1\xba{\xed\xbe\x9e\x1c\x8c\xa9\x98\x94\x96\fƐ>XS\x18Ԅ\x8by\xb3\xb0ܠ̝f\x1b\xc7-8\x8e\xfa\xf5{ `\x8cEu\xba[(b\xb9\b!\xceƦ\xbe\xb66\xaa\xaaB\xaf\x17\xc1\x18K\x8b\x8aGL\x93\xb0\x89\x00\x951\xc4\x1a\xf4\xbeuCJ\b\x1a\x9b[\x01\xa0\uef89e\xb1\xda\x18\x80\xba\xb6\x8e.\x1f3\x15EE\xe6\xa44\xa8\xaa\xaa\x8b\x8a+\x9aBM\bȒ\x8c\xb4Ԕ\xbe\xcd1\x04\xdez\xadۃ5\x85{jj\xeb\x03\xd2\vSr\xb3\xa2\xeeЧ\t\xd4\xc4\x00\x03CJR\xbc\x9f\xe5bhp+֛\x83%\xd6k\xdd=v\x10\xbfFj\x89\t\xf1H\xf1\xdb\xf5\xac\t\xd6\xc4w܋\xa6\xe5C\x10\xfa\x96\xf0\xecv\adI\x06\x80\xb7\a\x93n\x00\x80\x1b\x82\xc0\xa1\xbe\xb1\xc5\x17j:]N\xcc/\x9d\xe5\xcb\xc4F\"\x8fv&\xf4\xf8\xd6)\x00\xe8\xb5;0\xafdV@#\xdc\xca\xeaZ\x88:\xb1\xdab\xb5\xd9\xfd\xb8\x12\xb5\x8f\xe5mY\xf4ٱS\xe7\xfc\"\x00\x82\xfc\x9c,dg\xa6\x87l۬I\xd6ĀKR\xc0T\x15\xc69E\x885\x04\x1e\x04q\xa1\xfc\x1a\\.Iyjլ\xdf|i\xd5\xcc\u007f\x93e\xf5\xb1 A\xf2\x81\xf7\u007f\xc2b\xb5\xc1l2>*\xcb\xca\xee\x9c\xec\x8c)U5u\xe8\xee\xb1#\xc6\xf3\x01.I\x82i\xed\x12\xecy\xf7\xa0\xa7?\x96ƫ\x89\x86ֶv\xa4$'b\xe1\xdc9\xbe\xcd3\x84\x10Tݮ\x05@\xf0\u00977Os:]\xd3\x1c.\x17nު\xf9\xa3\xe7)\x91\x19\xd8K\x16\xab\x1f@\xec\xa1\x00\x00\x10nIDATm\xb7\u007f\xd7?\xeaO\xaa\xe76\x94<\xcb\xf3\xfc\x99\x17\x9f\xdd2e\xdd\xcaG\x915)\x15e\a\x8f\al\xc1V\x14\x86\xe7\x9f\xde\x04\x8e\xe3\xb4Y\x98\x80HL\x88Ó\x9bW\an\xe7c\f\xc7>\xb9\x80Y3\xa6B\x14\x05\xc4\xc5\xc5 -%\tK\x17\x96\xe2k\xe6\xad|jJҮm\xeb\x8a\xff\xc5#LnbyI\xb5}C\xc9/R\x92\x13\xf7~m\xfb\xe3 \x84@\x92$<\xb9y5\x18\x03Μ+\xf7u\xd4%\xc4\x1d\x1dD\xaa\xcb\xd2\\\xac\xf1\v\x9d(B\x92\xfa\xaaXDQ\xc0\xfe#\xa7\x90\x9c\x18\x8f\xa5\vK\xdd\x15.Ľ\x05\x901\x06\x97\xcb͓\xa2\xc2\xfc\xefm__r\xdeK.\xdeb\xb5a\xfb\xfa\xe2oM\xceL\xff\xee\xe6u\xcb\xd0cw\xb8m$\xcf\xe1\xf0\xf1O\xa1\xaajύ\xcajC\x8cAG\x8d\xc53\u009e\xa9\x13`\n5fM\b\x88\x02\x0f\xeb\xd1\xd3hk넱x\x06~\xf9۽\xbd\x1c\xa5w\x18\xd4\xc9\xd9\x19\xe9q\x9b\xd7-\x03\x03\xe0t\xba\xb0dA\t\x92\x12\xe2窪\xba\xdbb\xb5=G\x01@\x10\x84\xffڲ~\x05\x1cN\x17\b!\xe8\xec\xeaƯ_\u007f[\xa9\xa9\xbdg\xb6Xmq\x1c\xc7m\xbcx\xe5&\xf6\x1f9\x15\x10~\x86U,\xa2\xc9\xd6\xf8N5\xb8\x97rv\xbfcECS+RR\x12p\xfeⵝ\xef\x1e\xbf\x1a\xfb\xd6Ǘg\xbe\xfd\xf1\xd5\xf8\xb6\x8e\xae\xa2\xdf\xedzO\xbe^Q\xed;Y\xb7d\xf6t\xa4\xa5$m\u007fq\xf3\xdcG\xe8\xb6u\xc5\xdf)\x9c\x9a\v\x87ӽ\x8a\xdd\xd6މw\xf7\u007f,\xe9u:\x9d\xc5j\xdb\xe51\x95G\b!)\xcd-\xed\x1d\xbf\xdd\xf9.>=\u007f)\xe2z\x11\xd1<\xf8q\vUe8z\xe2\x1c^ٵ\x0fv\x87\vI\x89\xf1hln\xfb\xd7=\x87/\xfa6Q\x98MF\xec<p\xe1\xa6N\x14\xc5s\xb6+\xe7.]\xad\x00\xa5\x04N\xa7\v\xf3Jg\xa1\xa7\xd7\xf12UU\xf5\xd9\xd29E\xbe\xc5\xc5}\a\x8f;\x13\xe3ct\x9e\x94\x83\u007fo\xef6\x00\u007f*\xf0<\xaeݬ\x82\xc3\xe9\xd2|\xac\t\x88\x96\xb6v\xd4\xd57\x82\xe79PJ\xd0\xd8\xd4\n\xc6ض\x177\xcf+\xf2OIy\xfe\xcbv\x1d\xfcb\xe1\x99\xf3\x97ڼ\xedA\xf3s\xb3\x10\x1f\x17\xb3\x95\xea\xf5\xba<o:A\x96\x14H\xb2\xdc\xf4\x9bw?e\xa1\xf2[\x8c\xb1\u007f\xf2\x86\x9fZ\x1akb\"#=\x15^s\xa4\xaa*\x96=jċ\xcf>\x96\xa5\xaa\xeau\x8e\xe3rC%Eu\x02\xff^[G\x97{\xde\x19\x10\x17\x17\x93L\xfd\xf5EQUP\x90\xaeP\x1f\xb8}}\xf1OSS\x92R\x15O\x1d\xbcF\x9e\x87\x03\x8c1\xf0\x1c\x87\xcd뗡\xbbǾ#x\xeb\x97\xd9d\x04\xa5\xf4\xae\xcb%\xf9\bI@@U\xc6|k0\xa2(\x80\x81M\x0e\xf5\x01\x92\xa4LMIJ\xf0\x95PD4w\x9a-\x9cp\x88\x8b\x89\x01\x98\x9a\x1cʒI\x92\xbc59)\xde\xe7N\xa9\xaa\n\xeat\xba*\xed\x9e&\xb5\x84\x10$%&\xc4}g\xdb\xca~\x82\xc4\U00074e7b\xc7\x1e\xddi\x13\x9a\x9cM8Ւd\x19\x8c\xc0\x19\xacV\x1eKW\xa0\xf7l\x15d\x00z{\xed\xcdTQ\xd4+ͭ\x1d\x9e\x93\xa1\x18V.\x99G[[\xdb\x0e\xfb_\xe8I\x9c\xfdŽ\x86&E;\x18\xe0\xe1\x02\xef9\x05\xec\U00031cc83ļ\xec\xcf\t\x8bՆ\x177\xcd\xfdּ\x92\x99\xb1^-q:\\\xe8\xb1;nP\xbdN,\xbbv\xb3\n<ǁ1\x86\xf4\xd4d$\xc4ǭ}a\xd3܍\xfe\xf6TU\xd5.BȟhC\xfd0\xe5\xb3\b\xaeެ\xc2k{\xf6uuu\xf7>\xbf\xe3\xc0\xf9\x8f\xfc9\xf1¦\xb9\x19.Y\xfe\xaf\xf9\xa5\xb3|k\xc77n\xdd\x06S\xd9\at\xf7\xa1\x8bGj\xeb\x1a:\xbd\x1deTU\xc53[\xd7Ce\xccj6\x19\x8b\xbc\xca\xe5yèZ\xf7ie3\x13\x87X\x1d\x9d]?\xd9}\xe8b®\x83\xb6\xdd\xfeϽ\xb0in\x8c$\xc9\xf5\xe6\xa77\xf9N\"1\xe8u(\xbfZ\x81\xb8ؘ\xff\xf6ڵ\xa7?<|җUw\xb9$\xbc\xf4\xeccP\x99z}\xfb\xfa\xe2\r~,\x8d\x8e2\x9a\x8f5\x81\xfc+4\a\xfbT\xcfo,\x9d#\xcbr\xfd\x13\xa6\x95\xbeb\x04J\tN\u007f\xf6\x05\xba\xbb{\xff\xea\xd5\x0f\xce5S\x00\xd8}\xe8⑦\x96\xb6\xdf}q\xe9\x86\xcf9W\x14\x05\xff\xc3\xfc%dg\xa4\x1f|nCI\xdd\v\x9b\xe6\xc6k\xc3\xfcp\xc3S\x01\xf3Al\xac\xa1\xfc\x85g\xb6\xc4'%\xf6Q\xa2\xa3\xb3\x1b\xe5W+\xee\xbc\xf9\xd1\xe5\xbf\x03\xfc\xcafr&'\u007f\xf3\xfc\xc5kv\x87\xb3\xcf\xf1w:]X\xb7j1\x1e۰\"K\x92\xe5N\x00\x85\xda\xf0>\x9c0\x9b\x8c\xc9\xdb\xd6\x157\xce+\x99\xb5\xe5\x99'\xd6\ad\at\xa2\x80\xf7\x0f\x1c\x83^\xa7\x9b\xef+\x9b\xf12\xb1\xa1\xa1\x8b\t\x02\x9f\xbb睃\x01\x171\xa6\")1\x1e_\u007f~+\x04\x9e\xff\xa16\xc4\x0f\x1f^\xd847GQ\x94\xd6g\x9eX\x9f>\xabhj@Y\x8dN'\xe2w\xbb\xde\x03\xa5t\xd1\xeb\x1f~\xde\x14P\xe8\xe7%\u05ce\xfd\xe7[D\x81OyuϾ~g\xa9Ȳ\x82\x05\xc6G\xa2*\x9b\xd10\xa1\xd0\xeep:/<\xfb\xe4\x06\x18\f\x81\u074b\xf4:\x01\xbf\xb7\xbc\x0fJ\xe8b\x8b\xd5\xf6\x99\u007f*\xa2_Rj\xa7\xd5\xd6&\xf0|\xa2\xe5\xad\x03hln\rP/\xffrd\xcd?\u007f8 Iҫ+\x17\xcfK\xd3\xeb\x02I\xa52\x86_\xefx\a\x84`\x86\xc5j;\x1b|\x189\r\xe3\xa4u\x8a\x02\xaf\xb7~t\xa6\xb6\xee^\xa3o\xef~@\xb4\x10)(\xd4\xd2\r\x13\x06Ӧ\xe4\xeagL\x9f\x12\xb0\x81F\x91\x15\xbc\xbe\xb7\f\"/\xa4Z\xac\xb6\x1b\xa1N\xb8\x0f\x9bFWTչ\xf7\xf0\xc5\xdcC\xc7\xcf\xda[Z;\xef\xeb\xe0p\xed\xf0\xaf\x89\x01UU\x91\x99\x91\x16\xe0\xfe\xa8\x8ca\xd7;V\xc4\xe8\xf5\xc9*c\xad^7*\x18\x11\xfb\xbc\x9bMF\xc4\xc5\x18R\xf7Y\x8f9\x9aZ\xda\xc0\xd1\xe8\x96s4^M\x1c\xf8\x8e\x0f\x04 +\n\xf6\xbcc\x85(\n\x85\xb2\xa2\xb4Gjr;\xe0\x91'N\x97d\x8f\x8d1d\x94YO\xd4ܽ\xd7\x18\x9dri\xa6p\xc2\xc1\xeet\xc1\xf2\xd6~\a\xcfq\xb3\x14E\xa9\x1c\xa8s\xf2\x80\x12d\xb1\xda\xe0\x92\xa4θ8ì\xea;uѝN\xafIք\x02\xcfq(\xbfr\x13\xa2 \x98eE\xb9\x1eM;\xee\xa8zk{ި\xd7l2\x9e\a0o\x10\x82\xa5\xe5(ƀ\xcb4\xd8\v)%\xa8\xba}\u05f9\xf3\xc0\x85w\xa2&\xe3@/\xf0\xed;\\_\\\xaa\xaa\xac>T\x84\x18\x85`=n6\x19{\x1e@\xcb\xc6˾\x1fa\x90\xbfm\xb8\xcf\xe5c\x00\U00103f78\xbb\u05ce\xee\x9e\xde\xeag\xd6\xce6&\xc4\xc7^r8\\\xf2@\xaa\xc5\x0fD*\x83N\xa4\xdbח\\͘\x94RD\tE\xbd_C\xb6\xfb\x00\a F\x13\x8d\xb00\x8ce罭\xbd\x13\x93\xb33f\xc4\x18\xf4\x17jj\xef)\xa2 \xa4\x9aMƎH\xe4\xe2\a2\x81\xdb7\x94|\xb1v\xf9¢\xbc\xdcLܨ\xacA]}\x13(%\x03t\x9ba\xbe\xffi\x18[Z\xcc\x18\x03\xbb\xcf\x0f\xccLO\xc5\xe6u\xcb\x00\xc6\xe0t\xba8\xcb;\aj\xdf<z9\xfe\xc1|,Ʀ\xe4L\xce跔\x13髉\xa2\x80\xa2\x82<\x10J\a1j!F\x9aE2\xa2\xc4\xef\x05,\xf45\xbe\xbfI\xf8o\xcf\xe0\xde\f\xc0ؠ\r6\x01\x05\xcfӈ~'\t\xf3s\x14Y\x81\xda\xefUQ\x1a\xb9\xfb\xf9\xbe\fHKM\x8a\xfa\xa6'\x84\xb8\xab\x8b=K|z\x83\x0e\x933'\xc5}\xfd\x89X\xdd\xef\xf7}\xea\x1c4\xb1T\xf7n\x8b\xfe\x0eT\x84/f\xd0\xeb\xf0\xe8\xfcbM\x8dƲ'\x1f\xa6;#cl@\x9eʊ\xc2\x04\x91\x93\x1f(\xdd\xc0\xf3ܩ\xea\x9a{}\x1dg\x98\x9b\xc5v\x87S;\xa9b\x02\xc2nw\x06hFp\u07b2\xb7ׁ\xfaƖ\xe6\xff~\xeb\x13e \xa7:\xa2\xf3\xbe\xeb\xe0\x17;\xe3E\xf5\x9b\x1d]\xdd\t\x1c\xa5hhj\x05\xa5\x14N\x97\x8c\x92\xd9\xd3\x03\xda\xddh\x18\xff\xb8^Q\x8d\x86&w'GB\b\x9a[\xdaa\xd0\xeb\xc0\x18Cŭ;8r\xe2SY\xaf\x133\xe7Lː\xca+\xeb\xef'3П\\\xb1\xb1z\xd2\xd1ѳ\x9eR\xfamJ\xc9Ӏ\xbb\xc2\xf4\xc9M\xab\x11\x17\x1f\xab\xe9\xd6\x04\x81N'\xe2\x95]\xef\a?\xfc}I\x96{\b!\x99\x8c\xe1\xbaA'\xbe'+\x8ac\xa0t\xc3}q\xc2l2\xae\x01pԗ\xb4\xe19\xbc\xf4\xecc\xb0;]ڬ\x8csp\x1c\x87\x93g/\xa0\xaa\xa6.\x98\x14&\x8b\xd5v\xd0_h\xa2ɼ߷ؘMF\x06?\xff]\xaf\x17\xb1մ\n\x06\xbd\bI+\x02\x1cw\xa0\x94\x82\xe7(\x8e}r\x1e\xb7\xaak\x03\xba7z\x90b\xb1\xda\xda\xee\xf7}\as\\\xea/\x00\xfc\x11<ѹ\xd3\xe9®\xb7\xf7#/'\vS\xf2\xb2\x91\x10\x17\xebK3\x10\xbf\xb0\xd9\x1bk\xb8\x1fq?\xc7\x06H\x1a\x10D\xce\x1e0\xcf_\xa1ޓ\x84\xb8&R\xb8\x1f.\x95\xe2\xff\xde\b\xfa\x8e\xc1\xefE\b|\x9d\x0f\x19\xf3\xcb^\x90\xe0ȫ\xffc\xb2\xa2\x06\xb4/\x88\xf4\xdb\x11*\x93\x12\xf0|\xff_\xd8o\xec\x18\x83\xc3\xe9\xc2\xdd{\x8d\xa8\xac\xba\x03IVB\x91\xea7\x83!\xd5`L!\x90\v\x82;\xa8\x06\x90\xd7/\f\x95\x15O\x95\xa9\xe7\xeb\x87\xca\ty\xf3E\xd1\xe4\xaaX\x84\x91\xf1\xa7I\xb8k\xc9\x003\xc1\xa2\x18\x11\xff\xfcV8f\xb2\xc1\xea\xff}\xb0;RΪ\xdf\xe3\x03\x8c\xaf\xe77\x11w\xd4\x1f\xaeb\xa5\x01@>\x00g4\xa6o(L!\x00\x88\x1e_k\x99fL&$.\x00X\b@\x19\f\xa9\x06}\x8f\xf9\xb5\xee^\x04\xe0\xcf\xe1>\x18Q\a C\x14\xc5X\xeaw\a\x10\xe2>\x99ӫ,*SC\xe6V\x19\x18\xecv\a(\xa5Ua\xeeG\n\xf7\n=\vqi\x9eޠ\x13\xdc\x1f\xc1\xe0p\xb8z\tA\xfd \u007f/\v\xf3\xfa\x81\xd2\xe2\xdeǹ\xfbt1b9\x8eKr/\x93\x11H\xb2\x04\xc6p+\xccw\xf0.e\xa8A\x8f+\x8c\xb1BA\x10\xc0\x87\xeafM\xdc\a,\x11_߫\xc0\xe56B\x80^\xbbS%\x04e\x00\xfe\xc9b\xb5\x9d\x88\xd6I\x1fRb\x85\x8b\x10\x9eY7\xe7\xea7\xccO\xce\xe4hߏ\xeb\xea\xee\xc1\xc5+7\xa1Ӊ\x88\x8f\x8bAFZ\n\x92\x93\x12\xfa\x91\x8b\xe3(>\xf9\xbc\x1c\xb6\x8b\xd7\xdey\xf3\xa3\xcbO\xdf\xcf\xf7ؾ\xa1\xa4\xfa[/=\x9d\xaf(*\xec\x0e'~\xbf\xeb\xbd\xd3\xef\x1e\xbf6.\xd4\xf4\xb9\r%/o\\\xbd\xe4\xff\xe6\xe5dB'\x8a\xd8\xf9\xd6~\xfc\xe7\x9b'\xefk^\xb6\xad\x9b\U000c3b0c\xf4\x9f}i\xcbjHR`\x00\xc5Q\x82\xf6\xcen4\xb6\xb4\xa1\xa3\xa3\x13N\x97\x8c\xbc\x9cL\xe4dM\xf2\xcd\x01\xa5\x04\xbf\xfa\xfd\x1b\xae\xb7>\xbe\xa2{PB=\x88\xf3\xeeCP\x83\b8\x9c\xcetEQ\x03\xd6\x15\xe3\xe3b\xd1\xd5݃[յO(*\xe3eE\xde\x06\x86m/>\xbb\x85\xa3\x94\x06\xdc9\xf3Kf\"֠{\x8aRZ\xb9\xf7H\xf9\xb4\x81~\xa4\xd9dD\\\\\x8c^U\xd4|EU\xe1t\xb9`w8@)U1N@\bA\xaf\xdd\x01\x87\xd3\x05I\x96\x91\x92\x94\x80\x176\x19M;\x0fجQ\xddT\xeb\x8b\xf7L\xcd\xcfٶn\xc5Bt\xf7\x04\x1e\x80\xcb\xf3\x1cv\xbfs\x18\x9d\xdd=\aD\x81\xdf\xcbs\xdci\x10\x94/[T*x[Wy\x89\xc5\xf3\x1c1\x9b\x8c\xc4s\xfaۃG\x9bC5@;\x0f\\\x80\xc0\xf3g{{\x1d\xfd\xec\x94g]\xaa\xd2b\xbd\xf0\xee\xde\xc3\xe5fQ\x14R_ݳO\xbeS\xd7\x10PG/\xcb\nfL\x9b\x82\x05s\x1f)ضn\xce\xdb\x03\xdd9\x16\xab\r\xad\xed\x9d\u007f_:\xbb\bNO\xc7疶N\b\xa2\xf0y\xa4s^\xc6T\xfe\x88\xd2\x13w\xeb\x1b\xc1q\x14\xb2\xac\xa0\xf8\x91\xe9\xe8\xe9u\xfce\x14~.\xcc&\xe3\x9fLΚ\xb4m튅\x01=a\t!\xb0;\x9d\xf8\xed\x8ew\x15\x87ӹ\xe6ͣ\x976[\xac\xb6\u07ff\xf6\xe1\xe7\xd7\xc1@\x83\xf7\x8c\x12B +\x8aL\xc8\xd0\xed\xaf\xa2C<H\xe67\xcb\x0e3\xaf\x9d'\x04himG\xcd\xdd\xfa\xab\xaf\xef?\u007f\xd5\xfb:UU;\xf4:\x9dx\xfc\xf4\xe7\xc7\xf7\xbe\u007f\x10ԯ\xdcYQU\x94<2\x1dӦ\xe4>\xb5m]q\x95\xd9d\xe4\x06\xf8\x01\xdf-\x98\x92\xe3\xb9Cy\x94_\xbe\x81\x84\xb8\xb8\x97\x87B\xceG\x02;\x0e\\\xf8\xe4\xe6\xad;\xad\xde\xc9NIN@Fzʲ\x177\xcf3\x86#\x94\xc5j\xc3\xf3\x1bK\xf7\x19\xf4\xfa\x9f\x9b\xd6,\x81ӏT<\xcf\xe1\xf0\xb13x\xeb\xfdí:\x9d\xa8\xb3Xm\x1f\xfb\xbfG\xafù\xe7ҵJ_\x89\xb9(\n\xd8w\xe0\x18t\xa2\xf8՝\a.\f\x9d\x12\x0f\xd5\x1b\xf99\xf4FY\x96/d\xa4\xa7\xc2%\xcbh\xef\xe8\xb2\xf3\x1c\x97\xbd\xf3\xc0\x85\xf6P\xd7=\xbf\xb1\xb4\xc0%\xc9\x15/|y\x13\x11E\xa1\xcf\xee\x13\x82\xae\x1e;\xde\xfe\xe00\xe39\xfe\xd7/\xff\xe8\xc7\u007f`\\\xb3\xcdw\xddK\x9b\xe7\xc5uu\xf7^y\xea\xf15\xb9)I\xee\xe3\xee\x1a\x9aZq\xe0\xe8\xe9\xd3o\x1e\xbd4\xae\xa2U\xb3\xc9\xf8\xf73\xa7O\xf9\xf1B\xe3lȊ\x02EU\xf0\xfb]\xef\xdb\xe3ccJw\xec\xbfp\xd3\xff\xb5/l\x9a\xfb]\xbb\xdd\xf1Ϧ\xb5K\xc5\xdc\xc9\x19\xbe\x16B\x80{%\xe4\x95\xdd\xfb@\b\xfd\n\xc7\xd1\x1d;\xf6\x9fg\xa1\xe6\xe8\xb9\r%\x97b\f\x86ى\t\xb1\xa8\xbb\xd7\x04\x8e\xe7\xfe\xd8b\xb5\xfdb\xa8\xfc\xab\xa1μ\xf4M\xfa\x96\xf9\x82\xc3\xe9L\xa1\x84H\xa2 t\xbc\xbe\xff\xbc\x12\xf9\xf5\xf3b\x1d\x0eW\U000fa54b\xf4Sr\xb3\xdd\xc7j\xf4\xa9 \xee\xdcm\xc0\xa7\x17.\xa9\x1d\x9d\xdd=\x8a\xaaJ\x8c12)-%i\xcd\xf2\x05$>.\x16\x8c\xb9\x8f`yuO\x99\xba렍\x1b\xca\x01\x1a\x01R\xc1b\xb5ᩕ\xb3*_\xdc\xf6X\x81\xe0\xc9+)\x8a\x82㟜GuM]\a\x03dJ\xa8\xa0\u05cb\x86\xb9\xc53\x84Yӧ\x82\x81\xf99\xdf\x14\xbd\xbd\x0e\xbc\xb1\xef\x10x\x8e[d\xb1\xda>\x8b\xf4y:\x9d@z{\x9dI\xaa\xaa\xeau:\xb1}\xc7\xfe\xf3\xf6!\xf7\x1dGbТy\x9dN'\x8a\xbd\xbd\x8eC\x99\x93RWnٰ\x1c\x92$\xf7\xedi#}9|\xefc\x94R_\u007f\t\xbdN\x87\xc3\xc7\xce\xe0vm\xfd\xff\xde}苿\x1d\x8f\x89#\xb3i\xee\x12\x83^<\xfd\xdcӛ`\xb7;@\b\x01\xa5\x14LuW|z\xab\r\xc0X@\xab\x03\x83^\x87ӟ]\xc4\xe5k\x15\xcd:\x9d8\xfd\xb5\x0f>o\x1fʹ\x19\xb4[4\x9c\x83\x15\xa9\xac\"\xf8u\x8fLIWv\x1f\xfa\xe2\x95\xfc\x8c\xf8\xab_\\\xbe\xf1\xb4\xe4\x92\xe8\x94\xfcl_Υ_\xf2\x8a1x\xf3e\xef[\x8f\xa1\xae\xbe\xf9W{\x0e_|y\xbcf$\xcb+\xebk\x8brS\xab\xaeܸ\xf5T\xfe\xe4,\xc4\xc4\xe8!+j\xbf\xdf\xec\x1d\a\x83A\x87k7\xab\xb1\xff\xc8I\xd4\xd57\xfd\xf8\x99m۶^\xb9|\xc5q?c>\xac\xd1\xeeX\x1c\xe4\xefn_\xce\xd77\xb6\xff\x81N'\xfc{\xe1\xd4\\\x14M\xcbGJR\x82\xfb(a\xe6>7\xb1\xa3\xb3\x1b7\xab\xee\xe0FE\xb5\x03\xc0\x96݇.~4\x9eL`8\xbc\xb8in\x81S\x92\xf6\xe7\xe7d\x15͞Q\x80\xa4\xc4\x04\xc4\x18t\x9e\xf2`\x86\xae\x9e^Tݾ\x8b\xab7\xaa\xd0\xd5\xd3\xfb\xff\xe2b\r?}\xb5\xec\\\xebX\xfb\xedc\x92X\xdeA\xfaϟ\xfe\x019\xfa\xd1i\xb3K\x926\xe8ub)\xa54\x9e1\xa6H\xb2\\+K\xcauQ'\x1c\xdf{\xb8|7&\x10\xbc\xbf\xfd\xf9\x8d\xa5\x1b\xed\x0e\xe7&\x8e\xa33DA\x98F\b!\xaaʜN\x97\xeb:\xa5\xf4X\\\xacaǫe\xe7\xda\xc7\xea\xcd\xf4\xff\x01\x1a\xe9C\xefq\xf97\x9a\x00\x00\x00\x00IEND\xaeB`\x82")
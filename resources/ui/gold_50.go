@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Gold_50 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x002\x00\x00\x002\b\x06\x00\x00\x00\x1e?\x88\xb1\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x145\x19\xfb\x93\x93\xc1\x00\x00\x18 IDAThޅ\x9aYld\xe9u\xdf\u007f\xdfrﭽ\xb85\xc9&\xd9\xfb2\xd3\xdbLO\xcf.٣H\x89\xb58\x91\x02\x18\xce\x18\xb2\x03\xc3\x06\x028\x1b\xe2\x97<$H\xfc\x92\x00N\x1e\x12$p\x90\r\bb\xc4\x0fJ\xf2\x90X\x11\xe4@J\x14G3\xf2hF\x9aQ\xf7\xf42\xbd\xb2\x9bM6\xc9b\x91,\xd6^w\xfd\xbe/\x0f\xb7\xc8\xee\xb1d\xe4\x02\x04ȺUu\xcf9\xdf\xff\xfc\xcf\xf9\x9fCq\xf1\xec\xd1H\n\xa9\xe7\xa6k\xce\xf7\x14RJ\xfcœ|\xf8\xfeG\f\xf6Z`\x1d\x85\x999\xbc\xfa\x04Y\x14\x1267qƀ\x10\xe0\x1c\xfe\xe44^\xa5\xc6\xfe%\x84$\xde\xdb&\x1d\xf4\x0fޣ\n\x05\x8as\vH\xa5\x11Bಔ\xc1\xfa*&˨\x1e^d\xb0\xb5\x89P\x8a\xc9\xd3\xcf#\xa4\xa4\xf3\xf0>Y\x142q\xf24\x85\xc9\x19\xa2\xd6\x0e\xdd'+\\8}\x94\x9e\xf3io\xae#\x84\x00\xc09'\x84\x10\x99\x16Bh\xeb\xacr@~K\x90\f\xfa\xd8,;0\xce\xe5\x9f@\xf9\x01^m\x82\xa4\xdd\x02\xe7\xf0J\x15\n\x93\xd3c\a\x04\b\x81\x10\x02o\xf1(݇\xf7\xb0i\x8a\x10\x82\xc9S\xcf#\xb5\x02\xe7\xf6\xbd\x05g鯯\xd1\xdf\\G\a\x05&N\x9e\xc6+\x97\x01\xa8\x1e9Fwe\x99\xde\xea\nR)\xe2n\x1b\x9c#\xb3\x0e\xe1y\x9f\xb6k|I!\x84\xb3\xce!\xc5\xfe\r\x875\x06!\xc7\x1e\xe3PZ\xe3\x15\nh\xdf#\x1b\xf6q\xd6\xe2\xacä1R\n\x82r\x19\xbfT\xc2/\x16\tJeF\xcd\x066Kq\xce\xe1\x80\xd1v\x03\xa9=\xa4R\xf9\x8f\xd6 $\xb8<\x00\xd2\xf3P~\x90\x1b`\x1dA\xadNiv\x1eg-\x9d\x95\x87ĽNn\xac\xf6\x90\x9e\xf7\xd4\x05w\xe0\x8a\x93B\b\x9cu\xc8\xf1Q\x01\xa05B\xaa\xa7\xde\xea\xfc\xe1\xbdǏ0QDyv\x9e\xea\xd2\x11L\x14\xd1\u007f\xb2\n\xce!\xa4Dy\x1e\xbd\xf5U\xe2\xce\x1e\xbaPb\xea\xcc\xf3\b\xa5\bw\xb7\tw\x9b\b)\x01H\xfa=\xfaO\x1e\xe3\x9c\xc5+\x95I\x06}\x06\x8d\r\xd2(\"\x8d\"\xe2~\x1f\xfc\x02Bi\x9c\xb5\xfb\x10B\x97+\xcf\xd8\xfe\xe9K\x03\xd8q\xe4\xf2OX\xa4\xf6?刳\x8e\xf6\xf2}\xb28\xa409Eya\x11)\x15&\x89\x19m7\xe9\xad>b\xe2\xd4Y\x86\xcd\x06\xe1\xee6\xd2\xf7\x998q\n\xaf\\\xa1\x96etW\x1f\xd1\xdfx\x82\xd4>\xce9:+\x0fr\b-\x1dG\x97\xca\xf4\x1e?`\xd8l \xb4\x87,\x141YJ\xdc\xdaƙ\f\xa1u\x9e\x93\x80*UqQ\xe7 \t\x9e\xbd$\xcea\x9d{\x06p\x02\xe1\a\a\xd1\x13R2\xdan\x90\x8e\x06\x04\xd5:\xf5\xa3'PJ\x03P9\xbcDP\x9f \xeavh/\xdfc\xb0\xb9\x8e\xf4<jK\xc7Aj\xe2\xc1\x10Y\xac\x12\x1cZ \x0ec\xf6\xd6Vi\xaf,\xe3\x1c\x94\xe6\x16Q\xc5\x12\xd6\x18\n3s\b\xed1\xdc\xda\xc0D!\xa6\xdf#\x1d\xf4)LN1q\xfc4\xca\xf3\xc09dP\xe4\xcf:\x12m\x9f\xba\xf4\xf4Š\x88\x1dG\x01\xc0\x9a\f\xe5\xfbT\x8f\x9e\xc0Z\x8b\x8d\x13,\x0e\xe1\x1c\xe5\xf9E\xb2pD\xd2\xef!=\x9f\xd2\xc2\x11\x8c\f\x88\x87)\xc2Yfʖ\xd3'kx\x8b\v\xb4\xb7\xdbl\xecYle\x06\xeb\x05\x8c\xc2\x04\xa5\x14~\xb9FuQ\xd2__%\xdcn`\xb3\x8c\xda\xfc\"Sg\xcf!\xa5¥\t\xedՇ\xa8\xa00\x0e\xf0Sjr\x80\xb3\x16\x9d\xff\x96\xc3g\xdf\x19\x13\x8fH\xe3\xf0\x19\u007f\x056IHz]\xfcj\r\xe7\x1c8\x87\x15\x82t\xd0ϝ\x16\x12%,g\x16<\xbe\xf8z\x95+\xa7\x02N-\x05\xd4j\x01:\xf0\x10R0\xeaEl\xaf40\xa9#\xb1\x92\xf5\xc7{\xdc\xdd\x12\xdc\xec\x95XO\x17y\xd8\xde!\x1e\f\t\xaa5\xa6ΞC{>B\xa9\x9c\b\x00\xe9\x05c\xc6{\x8a.7F\x93\xc69\xc6v\x1d\\Y\x14\x83u\ao,LL\x11w;\f6\x9f0q\xea,\x85\xda$\bA\xdcݣ\xbf\xd5\xc0\x97\x8e\xaf~\xe1\bo\u007f\xe5\bo\xbc4\xc5\xc6\xca.;\x8d]\xee~\x98\x82\x10X\v^\xa0\x10\x80\x94\xe0i\xc1̑i>s~\x81\xbf8Y\xe4\xc1\xd5U\xae\xfe\xe8\x16\xff\xb3\x01\xef=\xf0\x18\xf5\xba\f\x1a\x1bL\x9f:{@\xe9\x00\xd2\xf7\x90\xf2)t\xacs\xb9#9\xb4\x9e\xf1\xc0\xe5\x1c/\x95|걵T\xe7\x16\xa8\x1e^\xa4y\xf3c\xfaO\x1eS}i\x0e\x9b%\xb4\x1f?\xa4@\xca\xef\xfe\xd6)\xde\xfe\xb5+\xa8\x82\xc7\xfb\xff\xeb.\x8fnmr\xf2\xcc$g_\\\xa2X\rО:`E\x93\x19Lf\x18\xec\xf5\xb8\xfb'\xb71\xc5:\x9f\xff\xa5+L.̀\xbd\xca\xd1ٔ\xff\xfc~\x8f\xd6\xf2=\x82r\x85\x99\xe7\xces\x10i\xa9\x10J\xf1\xb3\n\x89\x1e#\xe7)\xe6\x9cEy\xfe3\xe5\x11\x94\xd6\xd4\x16\x8f \xa5\xa2q\xfd*\x8d\xeb\x1fa\xe2\x884\xb5|\xfd/\x1d\xe7\xb5s%\x920D%\x11ݭ=Je\xcdɳ\xd3L\x1e\x9d\xc2\x1a\xfb\xa9\xa4\xf4\x84\x8fp\x0e\x89eX\xd9\xe1\xc9\xe6\x0eao\xc8\xec\xf1Y\xfe¯\xbe\xceڿ|\x87\xa3\x17\xce\xf3\xe0\xc6'4o\xdf@\bAg\xed\xd1خ\xe0\xa7\xf8\xca9\x108\xf435\xe5)\x95)\x95cq\\\x10\x85\xcc\v\xd9\xe4\xd1\x13Ľ\x0e\xed\xb5\xc7\b!\x98>q\x9a (0\xe8\xf4\xc9RK\xda\xed\xf1\xd2\xf92\xef}\xd8\xe1\xfa\x87\xeb\\֚\xd2d\x15g-6\xb3dQ\x8c\x89\x13\x92\xc1\x10\x9b$\x1c\xbdx\x94K_;\x86\x139+\x85a\xc6\xf5\x87)\xd5\x13\xf3,].\xb0\xf6\xe3\x1f\xb2~\xedC\x94\xceOAiyPW\x9eZ\xec\x90J\xe5\xc9\xfe)/\x85D\xfa\xc1\x01.s\xc7$J{dqD2\x1c\xe4_&\x05*\x19p\xff\xd6\x1e\xdfX\x1f\xf0\xf9n\xca\xe57/0q\xaa\xce/\x9e\xb0\xb4v\x86\xb8pİ\xdf\xc1e)X\x8b\xf24\xda\xd3\xf8U\x8f\xf2\xf1\xb3\x94\xe6\xe7I\xfa#:\xcd\x1d\xae\xfe\xe81\u007f\xf4\xed\x15\x9a\x03IEBiz\x86\x83궟'\xc6\xfc\xec\x1a\"\x04z?Yܘ\t\x84\x94\xa0<\x9e=(\xa1\x14(\xc5\xea\a?`\xb8\xbb\xc3\xd2˯\xd3\xdfڠ\xb5\xb1Ao\xbe\xc4f\xaa\xb8\xf3\xc9\x1e6\xba\xc9\xe1\xc5I\xe6\x0e\x97)\xf8\x02\xa9\x1d~\xbd\x8e\fr\xd6х\x00!\x156\x1cav\x9aܹz\x9b\x1f~\xd4\xe4\xfa\xbd\x84\xf5m\xcb \x12\xf8Z\xd3m\xac\xf3\xe8\xfe#\x04\x82ٳ\xcfӼ\u007f\x1b\xad$.\x0eA\x8aO\x05y\xdfP\xfd\xf4\x80\xdcA\xb2\x9b,E<CcJk\x1e\xfe\xdf\xef\x12v\xda̝\xbf\xc4\xfc\x85\x179|\xe9%n~\xfb\x0f9\xb6\x90\xe2e\xf0p\xdd13\xe38|\xae\xc6\xdcg^\xc1F#\x86\xcd\x1d6\x1e\xac\xd1\xdb\x19\x12\x8dR\xc2(fw\xafO\x9c\x19\xa2ر\xb2\xe9Xi\xfadF`\x9d@+Ab\f\xc3\xc7\xf7Ɇ\x11\x9f\xfb[\u007f\x17](\xf0\xc1\xef\xff;\xa2n\x8bp\xb7\x89tn|B\x9f>\x15q\xf1\xec\xb14\x8c\x13}|a\x86\x82\xa7\x91\xda\xc3-\x9c\xe2'\xdf\u007f\x87x8\x00\a\xd2\xf3\xc9∩\xe3'9\xfd\xb9_@j\x8ds\x0e\xd3oS\xb9\xf9\x1d\xde8\x9bq\xfah\x85w?\x1a\x11'\x16\x8bb\xea\xf0\x04\xa5z\x89\xac\xd7\xc1\x19K\xd8\v\xc9\f\f\xe3\x8c8r\fCH\x9cB{\x02\xedK\xb4\x97\x17\xe5\x9d\x0e\xac\xed\x8ep\xb3\xc7\xf2\x06\xb4\xddb\xd0\xda!\x1e\x8dx\xe1\xd2yFIJ\xab\xb1\x85\x94\x12\xa94\xc3\xd1\b\xdfә\x96R\xe4\x18\xdb\xe7gkpi\xf2l\xc7B\x96D\x04\xd5\x1ag\xff\xfcW@\b\xb4\xd6(?\xc0\x9f\x9e\x86\xcd%>\xba\xb5\x8c\xb3{|\xf6\xa5I\x8a\x05\x8fJ= \x89\r{\xed\x1e-\xebHR(\xe9\x80\xd4\xc0R\xa1H\xb1 \xf1}E\x98\x18\x9a\xad\x98Qb\x89RC/\x12\x80\xc0/\x04\f\x87}<%\xa9NLP\xa9\xd7I\xa3\x98\xd5\xd5'x\x12\x94\x92\x18\xeb\xf2.\xdc92c\xd0z\xcc\xcb\xf2\xa9P\xc9k\xe13\xd0\x12B\x90\f\a\xf46ָ𥯒\xa5\t\xa3n\x87\xf6V\x93\x93\xb3\x05\xdaۊ\xcdm\xcdK/\x04\x1c95\xc5\xe4\xe2\x1cB8\x86\xcd\x16\xc3^̨\x1ba\x8d%N2ڽ!qb臎Qh\x90\xd2\xe5U?\x14\xf4\xbb\x8e,\x83\xb9\xe3'H\xad&\xda\xdb\xc6\xc5}\x04\x108Gif\x92\xf6(e\xb0\xb7G\x10hl\x96\xe5B\xcdZt\xee\x80\x18\xeb\x0f\aR\xe3\xa4zJn\xd6\xe1U\xaa\xa4\xc3\x01\xb7\xbf\xfbm\x96\xff\xe4\xfbD\xfdn\xae\x1c\x03\x9f\xda)\x9f/\xbcR`\xf9\x89\xe3\x9b\xdfi\xf3\x99\xcfjNv-\x8b/_D\x1e* \xb3\x06\xd2J\x1e|\xbc\x81q\x86Q\x94\x12%\x96(\x11\fc\xc1nW\xd0\xeaJ\x8aE\xc1Դ \xdcv\xf4\x1b뤌\xd5\xe48\xb8\x02p\xd60]\xaf`\xb2\x94~\xbb\x83\x92\xf2\xa0c\xd1y9y\x9a\xe8h\x8f~\u007f@:\x1a\xa1<\x9f\xc2\xc4$^P X:\x82R\n%\x05~\x10\xa0\xb4\xa2P(\x90n\xdf\xe7\xfb\x1f\xee\xf2\xeaEкʽ[{\\\xbd֥\xff\x1f\xef\xa3=\x8dA\x11\xc6\x06Oe\xccL\b<O3\f%q*\x893\x81\xb1\x8eZ\xd5\xe1\x05\x96\xce@\xe0\x10$i\xc6^\x18\xe3\x1c\x18k1\xc6\xe4ͪs\x18\xb3s\x80\x12\xeb\xec3\xac%@\xcb1\v8K\x1cTY\xfd\xe4\x16/~\xf5\x97\xb0\xdd\x16d)Τ\xd8\xd1\x10\xc1X9&}l\x98\x92v\xa1R\xb2\x84#\xcd֎\xe0\x8b?_b\xfe\vS\x1c\xfd\xb9W\x89\xa3\x94\xf6\xc356\xef>\xa1ێ\xd1:O\xe8\xd1(\"J,\xa3\x18\xb6\xdb1Qb釰\xb1#\x18\f\x1d\xc6J\x92\xcc\xd0\x1f\x86?\xb3]\x17\xe2\xa7\xeb\x88\x03\xb4\x04\x94\xcc\x1b:Q\x9b\xe1\xe1\xe3u\xe6Ϟ\x87\xddudk\x8bĘq\a#\xb0\xcea\x8d=\x90\xb0\xd6\xc1\xf4\xa1\x84\xe3\xc7*\xf4\x86\x86\xebwz\xcc\x1d\x9f\xa4{\xef\x0e\x93'\x8f1\xf1\xcas\xcc,\xd6i\xdcY\xe1\xe3\x1f\xaf3\x8cb\xd24\xc3\tA\x9c\n\xfa#Ac[\xd0\x1d\n\xa6\xea\x8aZ]\xb2\xb2i\xfe\xbf\x0e\xec\xdb\x03 \xa5\xc4S\x12]\x99\x9a`\xb4\xd3Ɵ]\xa0\x99hF\xed]ξ\xfa\x1a\xbd[\x1f\x92%1Zʃ\x0e\xd3=3d\xc0\x81r\x19\x8f\x1e'\xf4[]&j\x9a\xb6\v\xf8ַ\xd6y\xfe\xdc$\xe9\xbb\x1b\f\x86\x19\xdaӤ\xa9em\xc52\x8c\x14\x81\xaf\x11R \x95\xa2^\xf5X\x98\x958!Xi\x8chu3\n\xbe\x18k\xf9q\x1f%rV\x95r\xbf\xf7\x13y\xe1&G\x91\x18W}\xbd=H\xe9E\x19\x8fv\x874\xee\xde\xe2\xc8\vW\xf0L\x02Y\x86\x96\n\xeb,\xc6Z\xb2̒f\x964\xcb\xc82Cf\f\x99\xb1\xf8u\x897\xf09y\xc4\xf1\xf2\xc5*\xd5z\t\xbf\xa8\tG\x19\xe1\xc0\x11G\x19^]q\xee\xd8\x14\xf5\xaa\xa6Z\x92X\a\xbdQƃ\xd5\x01\xf7W\x06\xf4#\x18\f-݁ \x8c\xf2\x90k\xed!ǅO\x8c#\xff\xf4 \x9e-\x88\xb9#\xe2\xd0\xccD\x8asz\x9fl\x8b\x95*\xbe\x84p\x14\x91Ys \\\x1c\xb9n\xe1\x19}\x9f\x19x\xfb\x8d2\xf3\x95\x80\xf5fėߪq\xf9\xd5E\xa6N,\x10\x94K(O\xd3\xdb\xdc\xe6ɭ5v\xb6\x06\xc4q\xc6V\xabCf\xc0\xa2\x18\xc5\xd0\x1e@cG\xd2\x1d\n\x12\x03\xb1\x11\x8cR\x8b\xb5\x86ݑ#u\xa0\x95|\xea\xd43\x90z\x06r\x99\xb6\xd6\x1dHG\xe7\x1c\xc3^\x8f\x81\x83\x9f\x91S\a\xd8ܿU\t\x04\xabO2\x0e\x9dMx\xf3\xc5:\xff\xfb\a-\xact\\L\rJI\x8a\x95\x00\x1d\x04\x04Հ\xe1\xa3\x0eI\x9a\x01\x1e\x9bۆզ\xa3=\x12\x8c\xac$\xb6\x0ecR\xeau\x9f\xb2'8Z\vp\x99A+G\xa0\xa1\x1b\xc3^߰\xde2\x18<\xac\x01\xf7\x8c\x1d\x00bj\xb2\x96:\xd0\a\b\xdc\xc7\xdd\xf8\x18\x85ȹZJy\xf0\xfa\x81\xb6\x90\x82sS\x82\x92\x82\x17\xce\xc4\x1c[\x9aa\x10ZF\tx\x9eG\xad\xa2(\x05\xd0駸̰\xbbg\xb9q?bu/\xe1ԥ9^\xf9\xccQ\xce\x1f-pb\xcaQr\x11awH\xbb5\xa2?H\xb16\u007f\xbeR\x92bA1\xbbX\xa76]\xe6\xc6\xe3\x84\xef}\xb0\xc7\x1f~o\x8b\xccJ<\xadPRfz\xbf6\xe4F\x8e\x13il\xf0\xfe\xf1e&\xcf\x0fc,ijH\xd2\f%%բ&J\x14\xa5\x82\xa2\xdd+se\xd2\xe3\xd57f\x98:6O\x98Hv\x9f\xec\x10\x0fFLu#vwB\u07bb\xd9\xe7\xcen\xcc?\xf8\x87o\xf1\x85\x9f?L\xb1\xdfdo\xb3ŵ\xf7w\x18\x85\x96BAQ*J\x02_\xe2\xabq\x1e\b\xc1(6<\xb8\xbf\xcbT\xadåce^x{\x96\xf92\xfc\xab\xff\xb1\x87\xe7)\xa4\x14\xe8\x82\xef\xfd\x99\xfc\x9c\x1a\x831\x86\xcb\xe7&y\xe1\xf9)\xe6\xa6}\x8e-\xd58uv\x86ad\xb8\xf6\x93Mn\xdeh\xb0\xb2\xda\xe7֭!k퐿Q\x0f(\xd5\n\x94f\xa6\xb9\xfc\xe5\xd7h=x\xc4\xee\xda\x16w\xefu\xf9\xd1\xdd\x1e\xdf\xfa\xe6ۜ:Ug\xf7\xea5\x9a\xdd!\u007f\xfc\x83\x0e\x13u\u03793e\xa4\x12\x9fR~\xfb\xf9\xe8\x8ay@\x1b\xdb\t\x95rL\xa5\xb4\xcb\xd7\xff\xe6[\xfcx\xf3G\\\xffx\a\xad\x15\xfaO;`\x1d\x94ˊ\x97\x9f+\xf1\xe57\xa7\xf9\xd2疨\x14`\xd8\vI\xe3\x14k3l\xb8\xc9!\xcf\xe3\xf4[u\xfe\xeaW\xe6)MV\xd9\xde\x1a\xf0\xef\u007f\xff\x13\xfe\xde\xef\xad\xf0\xd9\x17\xb6\xf9\xeb\xbf~\x9ex\xab\xc1\xa0\x17\xd1\xe9DD\xa9A\tIU'\x98$\xc1\xa6)\xcd\xed\x04\xad\x04G\x17\x03\xd4؉}s\xacs\xa4\x16\xacqH\tQd)\x06\x12?P\x94\xe7\xa6i5\xbb\xac\xae\x0fHMF:\x8c\x11ǖ\xe6R\xe7\xc6\x02Ky\xbcve\x96\xbf\xfd\xf6<W\x16-\xcd\xf5\x0e\x8d\xed\x18!\x1cR\n\x94\x14H\x91\xb3\x971\x8e$\xb1H\t\xbd~\xca\xdc\xd2$\xe7./\xb0\xb2:\xe2\x1f\xfd\xb3\xab\xc4\x19\xfc\xf6\xd7\x179q\xa4@\x1c\xa6|p\xad\xcf?\xff\xafM\xfe\xce/O\xf0\xe7\xber\x89\xe9\t\x0f\xb2\x98ǟlR\xf0,\xd6\t\xd2\xd4\x11Ɩ\xcc8<-\b|\x81\xa7%\xda\xcb\xf3\xa4Z+\xa0\xe6\x16x\u007f\xd9\xf1_\xbeq\x97\x8f\x96#L\x9a\x805\x998~d>\x15Bh\x1c\xcc?w\x8a\xff\xf4\xbb\x97\xa8m\xdff}\xbd\xcf՛}f\xa6<\x9e?U\xca\x05\x96\xfaiA\xe3\x1c$\xa9\xe3\xfd\x8f\xba\x9c:V\xe4\xd2kG\xc8ʓ\xfc\x93\u007f\xfc.7\x96C.\x1d+\xf1[\xbfq\x94o~\xf3\t\xff\xfd\x87}^\\\x84W/IΜ<\xcc\xd1\xd3\xd3,\\8\x81\xcb2\x9cu8c\xc9\xe2\x04\x93\xe5\xac\xe7\xf9\x1agR\xb20bg7\xe4Ʀ\xe0\x9d\x1fns\xfbn\x8f\xc8zD\xc2#\x1cE\bg\xf3d\a0~\x95\xdf\xfc\xea\"\x93\x93\x01\xe1j\xcc\xfc\x8cG\xb5\xach\xee$LOhf\x0f\x05\xd8\xf1\xack\xbf\xeaF\x89\xc5ג(6\x14\x8a\x92\xe9)M\xda\xed0u\xe64\xbf\xfa+\xcfq\xe7\x9f\xfe\x84'\x9b!\u05ef푤\x16%\x05Q\xea\x93\xd9\x00\xedK2g\x89\xa2\x84Qs\x9bt8\"\xec\xa7dI\x8a\x92\x92\xd4Xڝ\x01\xe1(\xa6;\xb4\xbc{u\xc8ڎOd5\x06AYK\x12\x1c\xd6\xe6\x13\x19-\xa5\x04g\xf1\xea5n|\xb4\xc1\xf3\x87F\\\xbe|\x8e\xc1\xcac~\xee\rɝ\xfb\x03\xb6wS\xd6\x1b\xc9S\xa1\x95:R\xeb\xd0RP.)\x0e\xcfz|\xee\xb5\x1a^\xe0QZZboc\x8f\x8f\xfe\xf81*\x13즆\xa2\xb2\x14\x83\xbcKx\xf3\xb2\x87R>\x1f\\m\xb3\xb8\x93\xb2\x18V\x99=y\x9c\xb0\xbb\x8a\x17\xc4ll\xc6lovhuC\xc2(%J\x04\xebM\xc9^\xbf\x80\x14\x8a\x8a\xa7\x90h\x06X\x9c\x15\xf4G#0\xd9x\x1c\xe4@8\xcb\xd6N\xc6w\xbfy\x1bןg\xe9\xec\"\x05\xdd煋\x9a\xa8\x1f2\ns\xecZ'\x108\xa4\x12\xf8~\x8e\xddB\xb5\x8c71A\xaf\x9f\xf0\xc1{k\xbc\xfbN\x83\xd5GC\n\x9e\xa49\xccP\x81G\x94\x8c\x00\xb8\xf3\xb0Ǖ\xf3\x11\xa7\x97\xea\x04^F\xb8\xbaBs\xa7Aj`\xd0Oh\xed\x85đ\xc3\xf3K\xf8\x81bZ+\x8e,*\x9c\x13\xaco\x0f\xd8l[\x1a\x9d\x98$SHT>\xea\xb5\x16],\xfa\b \xb3\x19\xd6\t\x9e4R\x1e\xdc\xdc$\xe9\xf6\x99;2Au\xa2D\xf1\xf8<^\x9c\x12\x0eb<O\"\xb5\x04!\x88\x061\x03\xa7\xd8\xd9\ry\xf0\xfd\xdb\xdcy\x18\xf2p5&I,E\x99!\x11\xf8Jp\xf7\xc1\x80ad\x91\x02Zm\x0f(35S`z\xb6Be\"@Z\x8b\x89\x13\xa2\xbeC\x9d\f\xc8\a\x9d\x82Q\x920\x1c\xa5DQ\xc2{W[<Z\x97t#\x9f\xc4\b\x84p\xe3]QN>9\xb4\xacC\xfa\x05^<n)D>\xed~\x8a\xbf\x13\xb1p\xf9\x10\x85\x85C\xa4\xdd\x16&\x89\x10.\xe1\u07b5m:{\xf9\xb1'YF\x9a\x19\x8cu\xb4{\x8e\xbb\x8f$JB5ȨM\x84t\x1b\x01\x81\xe7q\xfdΐ0uԫ\x8aϾR\xe5Σ\x98B9\xa16\xab\xb0^\x99`\xb2\x8a\x9fe\x88\xc1\x90\xe5\xeb\x1b\xecm\x8f\xd8\xed\xf4\x19\x8eb\x1c\x9aƎ`\xa7\xed\xa1|\xc9TE\x80V\xec\f\x04q\xe6\xc6*\xd2!._<\x93:\xebtV\xaa\xf3|%\xe5\xdc\xf4&\xa7\x96\xcal\xed\n6\x9b\t\x99P\x1c;3K\xbd\xe6\x130Ď\"\xac\xc9\xe8\xf4C\x92\xc4䴙I\xc2X\x8ditH\x14Y\xda{\x8a\xb5\x96@\x05\x9a\x8a/I3\xc7F/\xe5\x17_Q\xbct\xae\xce(R\x14\n:_\xa5i\x0f\xa74i\x14\xb3\xdb\xec\xd1\xeb\xc5\a\xed\th\n\x81f\xb2\xa6\x90J\xd2\x1ef\xdc\\\x89Y\xdet\f\x9c\xcfj\xb3\x8bI\xe3L\xbcr\xf9\\*\x85Љ\xd4L\xab\x94#\xde\x16\xe7N\x1a.<\xb7D\xa5Z\xa48U\xa5\xb77\xe2\xc1\x9d\x0e\xeb\x8d\b\xeb`\xb2\xa6\x99\xa8j|ϒ\x99\x98(6\xec\xb4#z\xfd\x8c\xde@\x12\x86\n\f|\xd2ʈ2\x87\x02*e\x8f\xcaT\x99\x89\xba\x0fXL\x92\xa2\x94\xa3\xac-o\x9e-\xf1\xfa\x85\nR\n\xcae\x85\x05\x94\x14h%\xd1Z\x02\x96\x9bww\xf8\xe1\xb5\x01kۂ\xd6@12\x8aP\x15Xm\xf6H\xe3\xe8\x19\xfaM\x1dW.\x04\x94\xa3:{\xed6ʓ\xbc\xfc\xb5ש,.`\u0090\v7>\xa1\xb5\xdeb{\xbdǝ\xfb\rV\x1b]\x86#G\x14\xf9$\x89\xc6X\x81\xb3~\xbeBВk\xad\x94\xcb\xe7\xa6\xf9\x95_~\x9e\xf3\xa7K\x04Q\v\x13E\xa0=\x843\x88,ïVh\xc9\x1a\u007f\xf0G[\xfc\xc1\xbfyī\xc7}>\xf7B\x89JY\xd3\xe9\x0ei\xf7G$\xa9\xa1?p<\xde\xd4t\xfa\x92\x14A\xea\x04R\x80t\xee`i+^\u007f\xf9b*\x04:\xb4\x8a3\xe5\x1e_{\xd5077\xc9J\xa3\xcb\xe9\xa3U\x0e-N#<\x9fp\x10b3\xc3\xfd\xdbMn\xdd\xde\xc4\x18A\xb7W\xa0\\\xd6\x14\x02\x85@R\xabk\x1e\xed\x1az~\x95\xdf\xfe\x8d\xf3\xbc|\xaeH\xf3\xfe\x13\xee}\xb2\xc3\xdaVB\x96\x81\x14ya\xf5}I\xb5,Y\x9a\xf79z\xb4\x8a\xaaO\xf2\xdf\xfeO\x93o}\xaf\xc1\xf1C\x9a\x13\x93\x16E\xca\xf2\x86\xe1I3\x9fD\x96ˊJE3U\x17,?14#\x8f\xd5\xdd!q\x18f╗.\xa4B\b\x9d\x01^\xd8\xe5ʡ\x907^)s\xfa\xb9\xc3\xd4\x0fO\xa3\xa5 \x89\falI\xe2\x14a\x1dal\x90J\x90\fFl=i\xb3\xb9\x9d\x12Ɩ\xfb-\xc1_\xf9\xb5\xcb|\xe9\xad\xc3D\xab\x0fX{\xb8ǽ\x87!B\nf\xa7=\x8a\x05\x89\x94\x02)\xf3&\xd59G\x969*eE\x92X\x96\xe6|\x12\xbfʆ\x9e\xe7\xf7\xfe\xf5O\x10\xbb\x1d.\x9e\xae03\xe3c\xb2\x90\xd6\xee\x88\xeeP\xb2\xd5\x12\xb4\x86\x82\xb6\xf1x\xb8\xdd\xc7ei\xa6_>}\x18\xad\x14\x16\xc7\xea\xa6\xcf;\xcb\xf79~\xacĹ\xe7\f\xd5 %\xa8\xd5(\xcdL\xe12à;\xe2\xe3wn\xd3\xef\x85D\xa3\x88\xc1\xd0\xf0p\xd5\x12\xa7\x8ef\xd7P:1ϗ\xbfx\x82p\xe5!;O\xda|r\u007fĹ3%|O\xfe\xa9\xd6\xe6\xe90\xb0\x10\xe4\u007f\xdd{\x14Q.)j\x131\xaf^\x9e\xe0ko-\xf1;\xffb\x8b\xe7\x8fi\xb6\x1a=\xf6:\x8e\x9d=Il\x1c\x16\xd8\x1e\xa4\xacuG8\x97\v\x17}\xe9\xf8܁\x0e\xbetl\x96\ue953|\xe7\x83[\\\xdbh\xf0\xf5\xbf\xac8u6`\xa2&\xe9,?\xc6\xc61\x12Gkw@f,\xc6B\xe6\x1c\xc3\b\xb6\xba\x8e\xcf\xfb=\x86\xcb\xcb$\xedݼ\xd1\v$;\xad\x94\x99)\x8fB \x0fT\xa8\xdd\xdf@\t\x10N\x90e\x8e\xa5y\x8f\xc0\x97\xc8\xc0'\xcb\x1c\xab\xb7י,H\u07bf\x15\x13(1\x9ec9\x86\xa9e\xb9\x9dҍ,\xea\xd9\x05\xae{\xba\xf6\x01+\xa8W\n|\xfd\x17^a\xbd\xd9\xe0\x1b\xdf\xea\xe2\xf9\x9bT*[\x14\xebeL8`؍\xe9t4a\x9cb\x9d L \x8e\x1c\xa3(\xe6\xe3\x9b]n\xdeo\xf3\xc6\xe7/Rll\xf2֡\x98\xfe #\tc\x94\x12D\x91%6\x02\xe1i\xa4I\x90&\xc5/(\xa6\xe6\xabT'JX\xe5\xd1\x19X\xde\xfd\x0f?`\xe3A\x1b\a\xdc\xdcI\xc6\xda\b\x12c\xb1.\x9f\xc3\xed;\xe1\xc6;P\xcd\xfe\x98>\xdf\xf1\xe4\f\xe6`an\x81\xa5ËX\xeb\xf2)\x9fuP\a7\x9f/ \xad5\b\x04\x9da\xc8\xc3\xc6.\xbdN\x97\x8d\xb5M~\xfc\xde\x066I9~\xe603\x8b\xb3\xcc\x05^\xbe'\xcf7F\xf9ó\x14a\xd2|q\x93eDač\x8f\u05f8s\xa7\xcd\xf2\xf2\x90\xf6\xae\xe5\xd1^\xc6\xee %2b\fE\x97Kn\xe7Ȍ\xc5\x1a\x8bҒz\xa5\xcc\xf4T\x1d\xad\x95\x1c\xeb\xf1\xa7*q\u007ff\xa4\xf6\x13\x13A\x9cٱ\x8e\u007f:\xa2\xb1@\xb9R\xa2Z\xaf1\x88R\xba\xa3\x8c\xf7o\xb496\xbf\xc7\xec\x94\xc70H)NM\xa1LF\xd2\xed\xd3x\xb4C\x189\xa6\x0fO\xd2l\xec\xb2\xf2\xc9\n\xa9ql\xeff\xdc_\x15\x8c\"I\x94:V\xda)ۡ\xc5\n5\x96\x0e\x82r\xa9\xc0\xa1\xa9\x1as\x87&9<;\xc5\xdcL\x9d\x89j\x99\x82\xaf(y\x12\xf1\xfb\xbf\xf3\x9b\xa9\x10\xb9R\x94\"\x1f\xbd(!~j\x86$\x9e\x91ù39\xd6\xc3\xc42J2\x12\xebh\x0fc~p\xfd\x11\xa3\xf66o\xbd(x\xf3\xd5yJ\x93\x15j\xd3U\x8a\xbe$鴹\xf6\xee#\xa28c\xb7\x1bя,͖bu[\x908\xc5\xc8)\x84_`\xa2Zdn\xaa\xce\xf4d\x85\xe9\xc9\x1as\xd35&*E\xa4p\xd4<\x99S\x85u\x144xB\xe0A&\xfe\xed\xdf\xff\xf5\xac\xa0\x95\x12\x02<%)z\nOI\x94\x14\xa4\xe3\xc4\x14\xe34\xca\x1dUt\x06!\x9b\xad\x1e\x99\xb5\x1c\xaa\x95\x99\xa8\x960\xce\x11\xa5\x16'%a\x9c\xb1\xda\xd8#IB|m\x11Ґ\x98\x98(\xcd\xe8\xf5S|ϣ\xe0\x17\x10\"\xa0\x10\x04\x14}E\xbd\\`\xb2Zb\xa2Z\xa4\xe8k<)\t\xb4\xa4\xa0%[{=\x9a\xed\x01\xe7\x8f\x1c\u0093\xe3m5<\xab\x8d\x8cn\x0f\xe3\xec\xe4l\x95\x82V\x0e\x04J\x81\x1e\x8f)q\x82$39\xcb\x00{Øk\xcb\x1b\x80\xe4\xe4\xc24\x87\xebe\xe4x\x82\xaa\x84\xa0\xe4+\x8cu\x14\x8a\x9a\xc9S\xb3H!Pb\xbc{ٟ\x9ak\x81\x94\n5\x0e\x96\x84\x83\xf7\b8\xf8>g-7\x1f6\xf8\xfe\x8d\xc7H)\xf8k_\xba\x82\x12\a\xff\xc7\xf0).\xb7Jf\xff\x0f\xf9U\xdb-\x92\xe4\x85\xf2\x00\x00\x00\x00IEND\xaeB`\x82")
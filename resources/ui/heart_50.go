@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Heart_50 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x002\x00\x00\x002\b\x06\x00\x00\x00\x1e?\x88\xb1\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x146\x0eSmE\xc5\x00\x00\x12\xa0IDAThޅ\x9ay\x8c\xddWu\xc7?\xf7\xde\xdf\xef\xf7\xd6Y\xed\x99ql\xc7q\x12\xdb\x10'\xb2\b\t\xa9\bT\x82\x86PH\xa1\xadԅ\xadR\xff\xaa\x10\xa8\x12\x12\x95\nBT\xb2*!\x95R\x04U\xa5\xa6R+5j\xd5\xd2VEj\x11\xa4\u007f\xd0P -\x81F\x04\x9a\x85\xc4\xce\xe2ݞ\x19{\x967o\xde\xf2[\xee=\xfd\xe3\xdc\u07fc\xe7@\xd5'=\xbd\x99\xb7\xdc{\xcf9\xdf\xf3=\xdb5\x800\xf50\x801\xe0\xcc\xcd\xefa\xf45\x88\xbeZ\x036\xbeo\x81\xc4@+\xd1\xcfS\xab\xef\xd9\xf8\xfbB\xa0\b\x90\a]\xb7\x12\xfd\x1e\xa2\xeb\xa4\x06\x1aN_\x9d\xd1\xfd\xad\x81\xdd\n\xbc@)0\xaa\xa0\nz؛\x0e\x1c\x1fI\xfdG}0\x13\x05\xf0\x02\r\v-\am\a\xdd\x042\xab\x87\xb0q\xa3=\xc1\x05*t\xa3J\xe2\xc1=\x04&\x87\xb3\x80D\x81\x8c\x99\xac]?S\xa7뻨\xb0\xfa\xe1E\x15\xb0[\xeas\xe0a\xecu\x9d\xd7\x1b@\x1a\x162\xa7\x9b\x97\x02\x89\x85\xa5\ff\x12Ք\x9b:\x8c5\xfa\xb9\xb5\xaa\x1a\x89\a\fQ\x90\"\xe8\x1a!\xbe\x0e*\xd8*t\xf3\x96S\xabu\x13\x98M\xd4r\x89Ua\xcd\xd4\x1efJIq\x1b<\xe0\x03\x8c\x03\xec\x94\xfa\xdc*`\xe4\xe3w\x13\x83$\x16\xcah\xb6\xf9\f\x0e4T;I\xdc \xb5\xfa\xff\xf4\x067\xc1.\xbe\x86\xa8\xc1Z\xf3E\xd0u\xf3\x00\xb9W\xa5tR\xc8\xeaCGX\x19\xabV\xfdY\x0f\xf3:(\xd5\xff\x0f=l\x17\xfa\xdc(\xa6\xbe\xd7v\xb0\xbf\x01\xfb2=t\xe6\xa2\xe6\xf9ٸ4\xd3\xefK\xc4\xf5\x94`!~\x16\xa2\x16\x9d\x89\x8a!Zت \xb5\x15\\T\\\xed\x9b>´\x12\xb5\xb4\x8f\x96\xaf\xf75(\x9c/\x0f\xe1\xb5\xdd\xf8~f\xe1\xd66,7U\xfb5Nʹ\xf3\xa3\xd0\xc8\"\x96\xed\x14\x01x\x14\x96yP'.\x82n\x1e\x98\xecj\"!4\x1c4-t\x92)Ǯ\x85\u007f\x9d\xb6j\xd8\u0590\x1d\x05%\x800u\xb6\x8a)A\x8e\xb6\xe1pG\x85\xa8\xbf\x90Zu\xc8̪\xaf\xb4\x13=Hj&\x9fa\x142\x95L\x0e\x92\a\x18V\xea\x1fӦ\xaba4M\x14\x12-\xd9r\xba^탂\xae\x99{\x18\x04\x85\x9d\x89$\xd2+a\xa7R\x8bI\\g\xec\xc1\xdc9\x83\x1cl*\x8c\f\xaa\xf9F\x023\x0e\xe6\x13Xl\xc4g\x06\xddT74S\xf8\n\xa8\x1f\xf4+\x18\x96\x90\v\x94>\xb2L\xa5\x871\xd3Tn\xa0a\xd4\x17\xe72h\xa7\xaa\xa0$\xc2\xcd\x18\x85c\b\xeaoc\x0f\x1b9\xf4\n\xb5L)\xba\xd7Z\xae\x94\x9cG\xa2I\x962]\xa4\xd6F7\xd1C\xef\xcb\xe0\x96\x16,7`!\x85n\x1b\xdam\xe8v!m\xe8\x01G\x95\n1,a1R\xeal\x02N\xe0z\x1f\xd6{p\xa1\a\xdbc\x85\xd1L\x06\xcb]X\x99\x85\xb96d\r\xa5ӝ\b\x97F\xa2Xi&J\b!W\x81\xf2\x12\xfa#\xb8\xb0\t\xeb\xb9\"b&Qan\x14\xca^I\xe6\"N-,\xa6z\xf8\xc5\f\x8e\xb6U\x88\xf9.,.\xc0\xcc,\x9c݀\u007f\xfe\t\xfc\xcf\x06\xac\x8e`\\\xc1\f\x86$:we\xc0d\xc2\xd1yx\xf86\xf8\x85\xe3pO\x05\x83|\x12'\n\x0fg\xb7\xe1\x9b\xe7\xe1\xa5m\xb8\xb6\v[c=p\xcbBj\f\xb3),6\xe1pW8\xb9\x1f\xee\xbf\x05n;\b\xed\f\xb2u\xb82\x86P\xc0Jd\xd7a\x05\xe6\x9d+H\x12M}\xa8\xa5\xf1\xe3X\x17\x96\x1ap\xcb2,\xec\x83\xe77Ꮮ\x82\xef^V?\xb8\xafeyk\xcbq\xa2aHc@\xac\x9d\xbf\x12x\xa9\x10\x9e\x18z6]\xe0\xa3w\xc3\xdd˰6\x82\x1f\\4<\xbd\x0eie8`\r\xb7&\x86\x961\x04#Tb\x18E\xef\xbe\xee\x85\x17\va(\xd0Ʉ\xa5\x86\xf0\xc97Û\x0eù+p\xf1\x06\\\x18)܆\x1eV\xc7`\x1eZA:\x89\nq\xb4\v\xb7\xb5\xe0\xf8\f\x1c<\by\x06\u007f\xfe#\xf8\xe3\xa7\xd5w\xde\xdeq|d6a\xbf\x03\x83\xecE\xf9:\x06\x98\xd7\xd1\xf2\xd5\n\xbe\xd6\x0f\\)\x03\xfb\x9c\xe1D\xd3\xf0\x96\x96e\x9fS\xa6\v\xd1'\x93\xf8ZǢ\x02\xe8yx\xa6\b|o,\xac\x85@#\x11>u\x1f\xbc\xfd\b\\\xb8\x02\x97\xb6\xe0\xea\b\xae\xd7\xd0r\x16fS\xd8׀\xc3M\xb8\xbd\v\xb7\xac@ց?\xf8\x0f\xf8\xab\x9f\xc0Rb\xf8\xc0|\xc2;\x9b\x96\x19\xa0k\x846\n\x85&\x06k\x15V\x0eu\xc6-\x0f\x9b\"\x1ct\xf0\xb1\x05\xcb.v/\x17\xaas\xa7}\xd60o`\xce\x18\xdaV\u007fKd\xab\xbe\bk\x06\xe6\x9d\xe5T\x03\x1e\x1f\x19\x9e\xce+>\xffCxu\a~\xf38\x94\x85\xa2c\xe0a\xec \x99M5\x18.\xa6p\xa0\xa5N8\xbb\x00\u007f\xf8]\xf8\xbb3p05||\xd1q*3\x1c\xb1\xd0Eh9C\xb7\xdb\xc0ͤ\x98\x00\xb2\x9d\x13\x8aj\x8f\x12g\x1d\x1c\xc0p5\b\x17+\xc1\x9aI\x80\\\xb2\x86\xbb\x12C˰\a˽\b\x87\xfe~\xce\x18V,l\x89\xe1\xc5J\xf8p\xdbѱ\x86o\x0eK\xfe\xe9,\x9c\xda\a\xa7\x0e\xc1\xe85%\xa2\xa1\x87\xa4\xeb\x94\x05f3\x15\xa89\v\xffz\x06\x1e{I%\xfeМ\xe3\xc1\x86\xe36\v]gh,vɎ\xedCV:\xe0+\x18U$\xebC\xec\x99\xeb\x04\x01\xef\x85Q\x10Z\x02w:C*p.\b\x1e\xb8\xdd\x19\ue321\xdb\x1aH\x12\x87\xcb,\xceY\x8a\xbc\xa2̽\xc6\x10\x04\x87a\xbf\x81\x93\t\x9c-\x85\x0fv,\xe7K\xc7\xd9\xd2\xf3//ÃG`y\x1eV\xf3ȸ֨\xe7/\xa4\x91\xc33\xf8\xb7\xf3\xb0\x91\x1b\x1ehY\x1ei;n5\xd0H\x84\xd9\xf7\xbf\x97ٿ~\x9c\xd6_|\x9f\xf4\xed\x1f\x81<\xc7\xe4\x1e\xd6w\xa9ʀ\xf7\x82\x00-\xabps\x06\x8e&\x86\x83\x06\uec86;\x9c\xc1\x88\x90t2\x1a\x87f\xc9\x0e\xcd`W\xba\xc8J\x97d\xa1\xb5G\x1a)f/\xddY4\x86%\v\x8d\x00\xbf\xd1uX\xe0\xc77`s\x00\x8b\xb3\xb0?\xd3\x18\x98H\x8c\xe2-\xab\xf1ac\f?Z\x87\x8e\x81G:\x8e\xa3\x16\xb2\xaa`\xe1ÿN\xf3\xf7\x1f\x85\xb4\tUE\xfa\xf0\xef\xe0\x1f\xfb2\x8c<2(c.&Ḧ\x93\xa9|\xeb\xf6D\x93\x10\x1b\x04\xb3\xdc\xc1\xdds\x00\x0e\xdcF\xd9[EL\xa5)L\xe11\xc6 Wv\xf6B\xbf\x89\xeb,YC\xbf\x12\x0eY\xc3\xf1\xcc\xf1R\xeey\xea\"\xbc\xe7N\r\xd6m\x17Y\xb3N\xd6\\\x02\xeb\xbb\xf0\xda\x0e\xdc\xd54ܓ\x192\x1f\xe8\xbc\xe1\x00\xad\xdf\xfd\x02\xd8\x04\xaa\x12\x9c%\xbc\xf2<\\\xdf\x06\x03\xe2\x03\x98\t\x03Ձ\xdc\xd6\xcc\x14ӓ` 9\xb5\x8c\xbd\xfb~\xec[\x1e\xc6=\xf0\x88\xae' \xa9\xc5\xdd1\u007fSf]\xe7[\x8b\u0590\x18X\x10\xe1\x8eT\x13\xb7'\xaf\xc0L\a:\xadZ\x10\x99\xe4>.\x85\xf5\x81\x06\xad#\x89\xe1\x903$\x194\xdf\xfdK\x98\xf6l<\x9dE\xae\x9d\xa3\xf8\xecG\xa0Ձ*`\nOVS\xa9Ѩ\\\x1f\xc8\xc5\xdc)\b\x98v\n\r\x87\xf8\x12B\xa5\xd4#F\x93.\xc0\x88`\x9a\xc9M\xa9v\xcd\x03\x8bV\xe1v\xd8Y\x9a\xd6\xf0j\x1f$hZ\x93ZHBܼN\xe06G0o\r\a\x13\xa3\x9aL\x1c\xe6\xda\x19¥砳@8\xf7\f\xd5\xdf~\t\x86#p\x16\xd3\x1b\xa96^\x97\xe2gF\x93<W\xd7)(|\x10\b\x97\x9eGzk\xd0߀\xc4M\x18k\xae\xa1'\xc3O\xb2\xdf\xf8\xfb\x05k\xb8\xe6\x85\x05gȌ\xa1\bB\\\x8e\xa6\x83\x84X\b\t0\xce5\xafi\x19C\xcb\x1a\x86\x01\\0\x84\xb5\xcb\xe4_\xf9,\xa4\rd\xb0\x89\xbd\xb1\xa1B\x8c\nLQ\xfd̢\xa8N\xdbK\xd1W\x80P\x06\xc2O\xae\xe3\xde|\v\xb2\xbd\xa6\x9a\xab\xa3h\xee\xe1j\x1f\x19\x95\n\xd7=q\x14\xa2Y|\xaf)\x82CȜ\xca\xec\xbdJ\x9bT\xb1\xda\xf213\x9dk(\vd\x066\x82\x10B\xc0l\x8e\x91\xa5\x9enX\x04̰\x82\xdd\x1c\x90\xff\xb3\xb2#B\xad\x9a\xea\t\x04k\x18_\xd8!\xf5\x90\u07b34\x89\x82\x18X\xddş\xd9 \x18\x85\xa1\x9f\xaac\x00\xc6Q\xd9}/\x8c=<rXݫ(c\x1c\xf1\xa2IW\x11K\xdd#\xf3\x90[a\x18\x84\x1b\xc1\xb0\xe1\xe1\xc0\xda.\xee\xd8>\xb5B\xe1c;\xa3\xde\xc9\xf0\xff=d\xba$6\x90_챻\xbaKc\xa5\x8ds\x16\xd9\x1e\x13z\xf9^\x85e\xc4(㉦\xfc\x06\xd8\f\x827p\xd5\v\x15¯\x1c\x87\xed\xdd(H\x88?\x15\xb4I0\x1e\xc3R\x1bV\xba\xf0\xe4P(\x04\xaex\x18o\x17\xc8\xf9m\x8cWf\x90\xf9\xe6^\x8d+\xa9\x16\x11\xe1u\xe5p]\x1cMW}\xb50b\xc1\x96\x9e\xf2\xd2\x0e\xf9\xf9m\xcaޘ`\xf57V\f\xc1\x80G\xf6\xca\xe0]\x81m\x11v\x03|\u007f\x14x\xdf\t\xb8m\x06֮k\x8a2(\xa3 \x95\xc0\x8d\x1c\x8a\x1c\xe6Rx`E\xf8\xc1\xc8\xf3\xc3<\xb0)\xd0\a\x8a\x977\xa0\xa7\x0e.\x8b-\x98i\x80\bb\r\xbe\x95Pyu\xee*\xe6S>\xd6\xdbu\xb9ZwZ\n\x11B\x84Z\xea\f\x893$\x91\x91\x1c\x86\x02\xf0\"Z\xd3\x039\xb0\x16\x84\"\xc0\x8fKAf\x03\x1f>\t\x17\xafAQ\xc0ձ\x16p6\u009e\xcdR\xad\x92\x0fᗏ\xc1\x1b\x16\xe0+۞W\xab\xc0k^\x18\x16B\xf5\xc2u(*H,\xb2ԁ,Reb0M\xbbg\x85\n(\xa7\x9eU\x14\xa0\x8c4k\xb9\xb9/\x10\xf6,(\x9aUǦ^\x89\xfaiO`\x1d\xf8\xfba\xc5÷C\xa7\x80\x8dmب\xe0\xfc@\x91\xeeN\xccp:\xb3\xbaX\xd3\xc2b\x02G\xf7k\x94\u007f\xf2*\\\xc8a)3\x1cp\x86\xee\xd8ø\xc4.u\xa0\x95\x82\aSh;Ј`\xadEd\xca\"Q\xbb\x15\xe01J(u\xaa\x1f\x9dZ\"梌\x94\xd1\n\xce\xc0P`[\xe0\xb9J\xf8\xb3\x9eg\xffb\xe0\xe3\xa7`k]\xcb\xe9Wv\x15I\xbb\x1eܾ\x06\xa7\x17\x1aZ\xcc{`9\xd33\xde{+\xac\x8d\xe1[W\x85^0,d\xb0l-\x8dA\x89\x19\x95ؕ\xae\xd2[)\x18\xef!I0\xceRV\x9e2\xa6\xeay\xac\x1a\xbdу\xd7ݘ\x9bڳS\xbe\xe5\x81q\xa4\xed\xb1\xc0V\x80W*\xe1O\xb6+\xaav\xe03o\x85\xce\x10v\x06\xb0>\x86k#\x15\xe2\xa5\x01\xb8\x95&\xa7\xeb6\xcf8\xf6\x92\xe6\x04\xba-x\xe0Vx\xf6\x06<\xb3\t\xe7+a)5\xec\xb7\x06\xd3/\xb0;c\x92\x03\x9d\xa8N\x83\xa9<\"BU\x04$\xfaA!B\x11\v\xa5\xfaQ\xc5g\xa8\xcb\xe3)h\r\x05\xc6F\x15\xb0\x1d\xe0\xd5J\xf8\xccFI\xd5\x10\xbe\xf8.8ր\x97\xaf\xa8\x83_\x1diexn\x04\x1b%\xb8C-N\x9b\xd8\xedK\x9d\xb6[\x9a\x0e\xec\b\x96f\xe1\xbe\xc3\xf0\xbd+\xf0\xf2\x00\x9e\x1e\a\xba\x89\xe1`jp\xa3\n\xd9\x1a\x93.65\x96X\x8b)\x02\xa1\xa8\x10?\xf1\x85\xc4@\x16\xff\xf6\xb1\xf0\n5\xb4\xa6ڢ#\x81!06\xc2 \x18\x9eͅOo\x96\x90\n\x9f\xb8\x1f\x1e\\\x86\xe7^\xd3F\xc7Z\x0eWF\xb0Z\xc0\xd5\\\x95\xe0f3\xb5\x88\x9b\xea\xf2\xedT\xda\n\x92\x1c\xee:\f'\xf6\xc1w.\xc0z\x01\xcf\xe6Bi\xe0@bi\xe7\x1e\xfac\x92\x994\xf2\xbfҪ\xaf\x02!(x\xfcT6<\xdd߭;\xe86¨\x16\xa2\x14\xf8\xaf\xb1\xf0\xa7=On\x85_\xbd\x03>t\x02^8\x0fk\xbbz\xb6kcm@\\\xceբ\x06p\x9d\x94\xd3\xceN\x1ad\xcej\xb6\xd0\xf7\xb0\x90@9\x82{o\x87w\xde\nO\x9e\xd3\x05\x9e\x1b\v\xab\x01V2CVB2.i\x18\x81\xc4a\x1a\x19\xa6\xf2\x94UP_\xa9\xf3,&]ɺ\xf5\x04ڗ\x1a\x02#\xb4\xf8\xfa\xeePx\xb4\xef\xd9!\xf0\xbe\xa3\xf0{\xf7\xc1\xf9U\xb8\xbc\xadпQ\xc0\xa51\\\x18+\xb3\xd51\xcbͥ\x9c\xae\xfb\xb1\x99\x9b4\x97G\x95\n3g\xa0\x18\xc1\x1bn\xd5\xfc\xff\xa9˰>6\xbcZ\b\xaf\x94\xd0q\xb0O \x93@f\xb5\xd3\xe6\x9a\ta\\Qxe,\x91\b]\x13\x99,n\x9e\v\x8c\x801B!\x86o\r\x85/\xedT`\x85\x0f\x9e\x80O\xbd\x05.o\xc0K\xab\xea\xd4àݓ\x8b#\x15\x8a\xa9\t\x80\x9b\xcdT\x90\x18\x0e\xf6\x92=\x89\x1dġ\x87\xac\x82\xfe\x0e\x1cY\x86w\x1c\x85\xe7\xd6\xe1\xea\xc0p\xad\x12ΖB+\x11\x9a\xc0\\bI\x13\x83T\x81b\xec\t\"J\xc5\xf5\x9c\x04\xad\xd3\x03*@\x1e\x85\x18\x04\xc3\xe3\xa3\xc0\xa3;\x15\x9d\x14>\xf6f\xf8\xe8)8w\x15\x9e\xbb\xaa͌~\x05\x97\xc7pa\xa8=`\xa6ڪ\x01p-\xc7\xe9\x98e`\xea\xacSTӳ\x91\xc9v*-%\xfb=\x8d1\xef>\x06\xafހWz\xd0\x0fp\xb1\x84C\x89\xa1@X\xc8\f\x89@Qx\xcaj\x12'\xecT\xd15\x12\x18\x19\x85S\x11\f_\x1d\x06\xfea\xb7\"\x18\xe1\x13\x0f\xc0o\x9d\x84s\x97\xe0\xd95e\xa4\x9d\xd8\"}m\xa8V!f\rc?!\f\x97ZN\u05ce.\x11b\x01\xad\x85[1P\x0e<\xf4Kh\x00\xfd>\x1c\\\x84_\xbc\x13\xae\xf5ja\x84\xb3\x05\x1c\xb1\x1a\x14\x17[\x96Ff\xc9ǚ\x89\x9a\b/\xacZb\b\f\x11J1|m\x14\xf8\x9b~\x857\xc2'\x1f\x80\xdf>\t\xe7/\xab\x10\x17\x87\n\xa9\x1b\x05\x1ch\u0091\x96\xb2\xd48(\xf4C\xf4\xb7\xdd2\n\"\xc4&\xf6T\xb7|Ti \xeb\xfbIg}P\xa9V\xb7\xb6\xa0ۀ\xf7\x9d\x84\xb5\x01\xbc\xbc\r=/ly\xc3|\x15HSK\xb7\xe3h7\x1d~\x1c\xf6\xa2v\xdd|\x1b\x02;\xc1\xf0\x8dQ\xe0/w*\x9a\x0e>x\x17|\xfc^\xb8\xb0\nO]P\b\xedz\xd8,\x14!\xa7f\xb4\u07fbQ\xc0\xe5\xd1$\x88\x8e<\xec\x16\xe0\x1a\x8e\xd3u\xee\x9fEjqf22(d\xd2u/\xbc\xfeЋ\xfa\xccL\x06\xbfv\x0f\x8c\v\xf8\xc15X\xf5B\xc3Yf}\xc0\x00\v\x1dG\x96X\xaa\x91G\x8cF\xf9\x91@/\xc0\xb7G\x81\xc7\xfa\x15i\"\xbc\xf5\x16\xf8ԃp}\v\x9e8\xab\xcc8\x8c\x1d\xfe~\xa5\xb4/\xe8\xeb\xf3;\x13G/=\xf4\xe3gn\xa1\xc1\xe9z\xc6Q\xcf0\x02uC9\x0e:E\x03X\x1a+\xbe*\x0e]6\xfa:\xb0y\xe4$4\x05\xbes\x05Η\xc2\xc1̐U\x81\xc4\x1a:M\x8b\xb5\x96\xfe80\x02v\x82\xf0B\t\x8f\xeeh\x9c8>\x03\x9f}\x1b\x88\x87o\xbc\x00\xd7\xf3Ɍe\xb7ҽ[\x0e^\x1ejn5\x8c\x90*\x82\n\x11D\xc7y\xee\xe8\f\xa7G\xb1V\xca}\x1cNZ\x98K&C\x97\x1a\x1aw\xb44\xb1\xdc(c \xf3\xb0\xb1\x03\xfb:\xf0\xd0\t\xb0\x95\xb6\x92~<\x12\x8e5\f\xa1\x10:\r\x8b\x17蕁~\x10\xb6\x05>\xb7]\xd2C8>\v_x\b\xe6\x9a\xf0\xf5ga}\x14\x85\x88\xa3\x86\xf5<\xced\x9c&\x87#\xafY\xc7\\\n[\xb9*5\xb5p\xa0\rn\xa5\xcd\xe9F\x12[\xf3\xb1t\xb6F5m\xcd$7\xb2\xa8p\x015\xfbn5\xa1\xc0\xf5MXh\xc1{\xde\b\xc1\xc3\u007f\xaf\xc2\xf3c\xe1Xf\xa0\f\xa4\x06\xf2J\xd8\xf1\xf0\xc5^\xc5E/\xbcq\x1e>\xf7\x0e-\xad\x1f\u007f^\xe7(E\x14\xa2\xe5\xe0H[\x15uu\xacμ]*[5\xe3t\xab\x1e\xb0\x1eh\xeb\xffn\xb9\xc5\xe9$\x0ei\x86\xb1+QD\xdf\x10\xa3x\f1?ډAr\xacm,M2\xe3\xb0suK\x87<\xef\xbfG'Q_?\aW*\xb8\xb7i\xb1^X\xab\x84\xc7\xfa\x81\x17\xaa\xc0\x1d\xb3\xf0韃\x16\xf0\x9d\xb3\xf0ʶ\x1e\xac\bЫT\x90\xa3-u\xf6\x17w4\x9e\x99賽B\x95\xb8\x9d\xab\x10\x9d4\xfa\xf5r\x8b\xd3\xc4nc\xdb)3I\x9c\xcb\xf98s߃W\xac\x1b|\x8c5u\xea\xed\xe3\x00\xf4F\x0f\xd2\x00\xef:\tKM\xf8\xeakp\xa1\x12\xdeֶ\xfc\xe3n\xe0\x99\xc23Ӏ/?\x04M\x03\xff~\x06\xae\rTAuq\u05ef\x94b_܍CN\xa3\xa1`6\xd5\xd4=\x8fc\xbd\x95\xb6\xf6\xabk\xf6r\xcb-\x8d\xec\xc4<\xab\xed\x14\x8b!\xd6\x1455\a`>\xd5\xe8\xec\xe3,O\f\xd8h\xad2v:z;`Jx\xef\xddz9\xe0\x89+\xf0\xa3\\8S\x05f\x1b\xf0\xf9\x9fW\x8b=q\x06V\a\xaa\xb82Zb\xd7k\xccJ,T1%1q\x9al\x80\xcdH\x04\xcb-XhL\x84\x10\xc0Y\xc3\xe9n2u\xa7\xc3\xea,/\x8f\x16)d\xea~I\x8c5\x85\xe8\xe6e\x1cX\xda\xd8I,C\x14f\x17\xf2\x1c>\xf0&\x9d\x19\xfe\xe7\x9a0\x93\xa2\x85\x91\x83o\x9f\x85\xab\xbb\x91\x01\xa35S\xab\x82T腅\xe5\x86\x0eq\xf2\xa00\xda\xc8Uȥ\xa6\n1]\x9c]\x1fA\xb26\xd4\u007f\x96\x9a\x93\xce^ê\xe9n\x8c50\x8e\xfc\xe4\xe0\x9d4\x8e\x8bc\xbc)\xe3<}&.\xbcS\xa8\xc5\x06\x97t6\xf8\xee\x93:\xbf\xbf\xd2\xd7l\xfa{\xaf(\x03\xd5\x1d\x96\x1b\x85*h\xc6Ŵ\xa3\x82\x1b17\xab\a\xadE\xf4\xd3[\xdaJBuWԠM\xf7\xf5\x11\x98V\x82\x8c*8؆}͟\xeeIm暞\xd4=\xe2\xf9L\x99\xa3\xae]\xea\xf4\xa6\x93h\xe4m\xc6[>I\x9c\x10ϥp\xff\x1d\n\x95\xa7/ꄷ&\x90\xd5B\xef\x94\xf8:\x01\x8cq\xa3\xee\xba\xf4\xe3x;5*Djo>۰\x82\v\xbbQ\xb0\xdbg\x90\xcb\x03\x95~\xb9\xa5ګ\xe3G\xfd\xe8\x15\xaa\xe9*\xc2h&r{\x12/\x0f\xd4W1\x9aV\xa3}'^ǰ\xf12\x80\x8bS\xe3QY\xb7\x844J\xf7J\xf6\x9a\x15c?\x11\u008b2S\x19\xa9x\u007f\xf3\xa7\x85\x18\x94pi\xa0\u07fd\xb5\v\xe6\xf8,R\x04\xb86T\x13\xcef*}\xc3\xde,\xcc\xc8k\x10\x1a\x94\x93\xc6q7Q\x82h\xc5\xd1s}c\xa2\x9d\xa8/d\xf1\xf6O2u\xebg\xe8a+ƅ:\xd3\x1eMY\"\x88\xb2R\x15a<\x17\xef\xc6L\xdf\u007f\xd9\xcauJ\x1c\x04\x0ev\x14%\xe6\xf8\xac\x9e7\x88\x16\xf3\x83R7>\xdcU\xcdO\v\x13D\x17\xb9>V?h%\xba\x91\x89\x825\x9d\xb2\x9a\x8bW\xa1ZI\xbc\xeb\x15G\xb6\xa3\xa0\x13\u0601\x9f\b1\x8c\x97\xcb\xea\xd4|\x10-\xd1t\n\xd7v:\xb9\xac\xe3Q\xbf݊\xdd\xd5#\x1d\xdd\x03\x03\xff\v\xe25\xc6\r\xe0\xc0DR\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Human_s = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00d\x00\x00\x00d\b\x02\x00\x00\x00\xff\x80\x02\x03\x00\x00\x00\x04gAMA\x00\x00\xb1\x8f\v\xfca\x05\x00\x00\n:iCCPPhotoshop ICC profile\x00\x00H\x89\x9d\x96wTT\xd7\x16\x87Ͻwz\xa1\xcd0\x14)C\xef\xbd\r \xbd7\xa9\xd2Da\x98\x19`(\x03\x0e34\xb1!\xa2\x02\x11ED\x04\x15A\x82\"\x06\x8c\x86\"\xb1\"\x8a\x85\x80`\xc1\x1e\x90 \xa0\xc4`\x14QQy3\xb2Vt\xe5彗\x97\xdf\x1fg}k\x9f\xbd\xf7=g\xef}ֺ\x00\x90\xbc\xfd\xb9\xbctX\n\x804\x9e\x80\x1f\xe2\xe5J\x8f\x8c\x8a\xa6c\xfb\x01\f\xf0\x00\x03\xcc\x00`\xb223\x02B=ÀH>\x1en\xf4L\x91\x13\xf8\"\b\x807w\xc4+\x007\x8d\xbc\x83\xe8t\xf0\xffI\x9a\x95\xc1\x17\x88\xd2\x04\x89\u0602\xcd\xc9d\x89\xb8Pĩق\f\xb1}F\xc4\xd4\xf8\x141\xc3(1\xf3E\a\x14\xb1\xbc\x98\x13\x17\xd9\xf0\xb3\xcf\";\x8b\x99\x9d\xc6c\x8bX|\xe6\fv\x1a[\xcc=\"ޚ%\xe4\x88\x18\xf1\x17qQ\x16\x97\x93-\xe2[\"\xd6L\x15\xa6qE\xfcV\x1c\x9b\xc6af\x02\x80\"\x89\xed\x02\x0e+IĦ\"&\xf1\xc3B\xdcD\xbc\x14\x00\x1c)\xf1+\x8e\xff\x8a\x05\x9c\x1c\x81\xf8Rn\xe9\x19\xb9|nb\x92\x80\xae\xcbң\x9b\xd9\xda2\xe8ޜ\xecT\x8e@`\x14\xc4d\xa50\xf9l\xba[zZ\x06\x93\x97\v\xc0\xe2\x9d?KF\\[\xba\xa8\xc8\xd6f\xb6\xd6\xd6F\xe6\xc6f_\x15\xea\xbfn\xfeM\x89{\xbbH\xaf\x82?\xf7\f\xa2\xf5}\xb1\xfd\x95_z=\x00\x8cYQmv|\xb1\xc5\xef\x05\xa0c3\x00\xf2\xf7\xbf\xd84\x0f\x02 )\xea[\xfb\xc0W\xf7\xa1\x89\xe7%I Ȱ31\xc9\xce\xce6\xe6rX\xc6\xe2\x82\xfe\xa1\xff\xe9\xf07\xf4\xd5\xf7\x8c\xc5\xe9\xfe(\x0fݝ\x93\xc0\x14\xa6\n\xe8⺱\xd2SӅ|zf\x06\x93š\x1b\xfdy\x88\xffq\xe0_\x9f\xc30\x84\x93\xc0\xe1sx\xa2\x88pєqy\x89\xa2v\xf3\xd8\\\x017\x9dG\xe7\xf2\xfeS\x13\xffa؟\xb48\xd7\"Q\x1a>\x01j\xac1\x90\x1a\xa0\x02\xe4\xd7>\x80\xa2\x10\x01\x12s@\xb4\x03\xfd\xd17\u007f|8\x10\xbf\xbc\bՉŹ\xff,\xe8߳\xc2e\xe2%\x93\x9b\xf89\xce-$\x8c\xce\x12\xf2\xb3\x16\xf7\xc4\xcf\x12\xa0\x01\x01H\x02*P\x00*@\x03\xe8\x02#`\x0el\x80=p\x06\x1e\xc0\x17\x04\x820\x10\x05V\x01\x16H\x02i\x80\x0f\xb2A>\xd8\b\x8a@\t\xd8\x01v\x83jP\v\x1a@\x13h\x01'@\a8\r.\x80\xcb\xe0:\xb8\x01n\x83\a`\x04\x8c\x83\xe7`\x06\xbc\x01\xf3\x10\x04a!2D\x81\x14 UH\v2\x80\xcc!\x06\xe4\by@\xfeP\b\x14\x05\xc5A\x89\x10\x0f\x12B\xf9\xd0&\xa8\x04*\x87\xaa\xa1:\xa8\t\xfa\x1e:\x05]\x80\xaeB\x83\xd0=h\x14\x9a\x82~\x87\xde\xc3\bL\x82\xa9\xb02\xac\r\x9b\xc0\f\xd8\x05\xf6\x83\xc3\xe0\x95p\"\xbc\x1a\u0383\v\xe1\xedp\x15\\\x0f\x1f\x83\xdb\xe1\v\xf0u\xf86<\x02?\x87g\x11\x80\x10\x11\x1a\xa2\x86\x18!\f\xc4\r\tD\xa2\x91\x04\x84\x8f\xacC\x8a\x91J\xa4\x1eiA\xba\x90^\xe4&2\x82L#\xefP\x18\x14\x05EG\x19\xa1\xecQި\xe5(\x16j5j\x1d\xaa\x14U\x8d:\x82jG\xf5\xa0n\xa2FQ3\xa8Oh2Z\tm\x80\xb6C\xfb\xa0#щ\xe8lt\x11\xba\x12݈nC_B\xdfF\x8f\xa3\xdf`0\x18\x1aF\ac\x83\xf1\xc6Da\x921k0\xa5\x98\xfd\x98V\xccy\xcc f\f3\x8b\xc5b\x15\xb0\x06X\al \x96\x89\x15`\x8b\xb0{\xb1ǰ\xe7\xb0C\xd8q\xec[\x1c\x11\xa7\x8a3\xc7y\xe2\xa2q<\\\x01\xae\x12w\x14w\x167\x84\x9b\xc0\xcd\xe3\xa5\xf0Zx;| \x9e\x8d\xcfŗ\xe1\x1b\xf0]\xf8\x01\xfc8~\x9e M\xd0!8\x10\xc2\bɄ\x8d\x84*B\v\xe1\x12\xe1!\xe1\x15\x91HT'\xda\x12\x83\x89\\\xe2\x06b\x15\xf18\xf1\nq\x94\xf8\x8e$C\xd2'\xb9\x91bHB\xd2v\xd2a\xd2y\xd2=\xd2+2\x99\xacMv&G\x93\x05\xe4\xed\xe4&\xf2E\xf2c\xf2[\t\x8a\x84\xb1\x84\x8f\x04[b\xbdD\x8dD\xbbĐ\xc4\vI\xbc\xa4\x96\xa4\x8b\xe4*\xc9<\xc9Jɓ\x92\x03\x92\xd3Rx)m)7)\xa6\xd4:\xa9\x1a\xa9SR\xc3R\xb3\xd2\x14i3\xe9@\xe94\xe9R\xe9\xa3\xd2W\xa5'e\xb02\xda2\x1e2l\x99B\x99C2\x17e\xc6(\bE\x83\xe2FaQ6Q\x1a(\x97(\xe3T\fU\x87\xeaCM\xa6\x96P\xbf\xa3\xf6Sgded-e\xc3esdkd\xcfȎ\xd0\x10\x9a6͇\x96J+\xa3\x9d\xa0ݡ\xbd\x97S\x96s\x91\xe3\xc8m\x93k\x91\x1b\x92\x9b\x93_\"\xef,ϑ/\x96o\x95\xbf-\xff^\x81\xaeࡐ\xa2\xb0S\xa1C\xe1\x91\"JQ_1X1[\xf1\x80\xe2%\xc5\xe9%\xd4%\xf6KXK\x8a\x97\x9cXr_\tV\xd2W\nQZ\xa3tH\xa9OiVYE\xd9K9Cy\xaf\xf2E\xe5i\x15\x9a\x8a\xb3J\xb2J\x85\xcaY\x95)U\x8a\xaa\xa3*W\xb5B\xf5\x9c\xea3\xba,݅\x9eJ\xaf\xa2\xf7\xd0gԔԼՄjuj\xfdj\xf3\xea:\xea\xcb\xd5\v\xd4[\xd5\x1fi\x104\x18\x1a\t\x1a\x15\x1a\xdd\x1a3\x9a\xaa\x9a\x01\x9a\xf9\x9a͚\xf7\xb5\xf0Z\f\xad$\xad=Z\xbdZs\xda:\xda\x11\xda[\xb4;\xb4'u\xe4u|t\xf2t\x9au\x1e\xea\x92u\x9dtW\xeb\xd6\xeb\xde\xd2\xc3\xe81\xf4R\xf4\xf6\xeb\xddЇ\xf5\xad\xf4\x93\xf4k\xf4\a\f`\x03k\x03\xae\xc1~\x83AC\xb4\xa1\xad!ϰ\xdep؈d\xe4b\x94e\xd4l4jL3\xf67.0\xee0~a\xa2i\x12m\xb2Ӥ\xd7䓩\x95i\xaai\x83\xe9\x033\x193_\xb3\x02\xb3.\xb3\xdf\xcd\xf5\xcdY\xe65\xe6\xb7,\xc8\x16\x9e\x16\xeb-:-^Z\x1aXr,\x0fX\u07b5\xa2X\x05Xm\xb1\xea\xb6\xfahmcͷn\xb1\x9e\xb2Ѵ\x89\xb3\xd9g3̠2\x82\x18\xa5\x8c+\xb6h[W\xdb\xf5\xb6\xa7m\xdf\xd9Y\xdb\t\xecN\xd8\xfdfod\x9fb\u007f\xd4~r\xa9\xceR\xce҆\xa5c\x0e\xea\x0eL\x87:\x87\x11G\xbac\x9c\xe3A\xc7\x11'5'\xa6S\xbd\xd3\x13g\rg\xb6s\xa3\U000c42deK\xb2\xcb1\x97\x17\xae\xa6\xae|\xd76\xd797;\xb7\xb5n\xe7\xdd\x11w/\xf7b\xf7~\x0f\x19\x8f\xe5\x1e\xd5\x1e\x8f=\xd5=\x13=\x9b=g\xbc\xac\xbc\xd6x\x9d\xf7F{\xfby\xef\xf4\x1e\xf6Q\xf6a\xf94\xf9\xcc\xf8\xda\xf8\xae\xf5\xed\xf1#\xf9\x85\xfaU\xfb=\xf1\xd7\xf7\xe7\xfbw\x05\xc0\x01\xbe\x01\xbb\x02\x1e.\xd3Z\xc6[\xd6\x11\b\x02}\x02w\x05>\n\xd2\tZ\x1d\xf4c0&8(\xb8&\xf8i\x88YH~Ho(%46\xf4h\xe8\x9b0װ\xb2\xb0\a\xcbu\x97\v\x97w\x87K\x86Ǆ7\x85\xcfE\xb8G\x94G\x8cD\x9aD\xae\x8d\xbc\x1e\xa5\x18ō\xea\x8c\xc6F\x87G7FϮ\xf0X\xb1{\xc5x\x8cULQ̝\x95:+sV^]\xa5\xb8*uՙX\xc9Xf\xec\xc98t\\D\xdcѸ\x0f\xcc@f=s6\xde'~_\xfc\fˍ\xb5\x87\xf5\x9c\xed̮`Oq\x1c8圉\x04\x87\x84\xf2\x84\xc9D\x87\xc4]\x89SINI\x95I\xd3\\7n5\xf7e\xb2wrm\xf2\\J`\xcaᔅԈ\xd4\xd64\\Z\\\xda)\x9e\f/\x85ד\xae\x92\x9e\x93>\x98a\x90Q\x941\xb2\xdan\xf5\xee\xd53|?~c&\x94\xb92\xb3S@\x15\xfdL\xf5\tu\x85\x9b\x85\xa3Y\x8eY5Yo\xb3óO\xe6H\xe7\xf0r\xfar\xf5s\xb7\xe5N\xe4y\xe6}\xbb\x06\xb5\x86\xb5\xa6;_-\u007fc\xfe\xe8Z\x97\xb5u\xeb\xa0u\xf1\xeb\xba\xd7k\xac/\\?\xbe\xc1kÑ\x8d\x84\x8d)\x1b\u007f*0-(/x\xbd)bSW\xa1r\xe1\x86±\xcd^\x9b\x9b\x8b$\x8a\xf8E\xc3[\xec\xb7\xd4nEm\xe5n\xed\xdff\xb1m\xef\xb6O\xc5\xec\xe2k%\xa6%\x95%\x1fJY\xa5\u05fe1\xfb\xa6ꛅ\xed\t\xdb\xfbˬ\xcb\x0e\xec\xc0\xec\xe0\xed\xb8\xb3\xd3i\xe7\x91r\xe9\xf2\xbc\xf2\xb1]\x01\xbb\xda+\xe8\x15\xc5\x15\xafw\xc7\xee\xbeZiYY\xbb\x87\xb0G\xb8g\xa4ʿ\xaas\xaf\xe6\xde\x1d{?T'U߮q\xadiݧ\xb4o۾\xb9\xfd\xec\xfdC\a\x9c\x0f\xb4\xd4*זԾ?\xc8=x\xb7Ϋ\xae\xbd^\xbb\xbe\xf2\x10\xe6P֡\xa7\r\xe1\r\xbd\xdf2\xbemjTl,i\xfcx\x98wx\xe4Hȑ\x9e&\x9b\xa6\xa6\xa3JG˚\xe1fa\xf3Ա\x98c7\xbes\xff\xae\xb3Ũ\xa5\xae\x95\xd6Zr\x1c\x1c\x17\x1e\u007f\xf6}\xdc\xf7wN\xf8\x9d\xe8>\xc98\xd9\xf2\x83\xd6\x0f\xfb\xda(m\xc5\xedP{n\xfbLGR\xc7HgT\xe7\xe0)\xdfS\xdd]\xf6]m?\x1a\xffx\xf8\xb4\xda\xe9\x9a3\xb2g\xca\xce\x12\xce\x16\x9e]8\x97wn\xf6|\xc6\xf9\xe9\v\x89\x17ƺc\xbb\x1f\\\x8c\xbcx\xab'\xb8\xa7\xff\x92ߥ+\x97=/_\xecu\xe9=w\xc5\xe1\xca\xe9\xabvWO]c\\\xeb\xb8n}\xbd\xbdϪ\xaf\xed'\xab\x9f\xda\xfa\xad\xfb\xdb\al\x06:o\xd8\xde\xe8\x1a\\:xv\xc8i\xe8\xc2M\xf7\x9b\x97o\xf9ܺ~{\xd9\xed\xc1;\xcb\xef\xdc\x1d\x8e\x19\x1e\xb9˾;y/\xf5\xde\xcb\xfbY\xf7\xe7\x1flx\x88~X\xfcH\xeaQ\xe5c\xa5\xc7\xf5?\xeb\xfd\xdc:b=rf\xd4}\xb4\xefI\xe8\x93\ac\xac\xb1\xe7\xbfd\xfe\xf2a\xbc\xf0)\xf9i\xe5\x84\xeaDӤ\xf9\xe4\xe9)ϩ\x1b\xcfV<\x1b\u007f\x9e\xf1|~\xba\xe8W\xe9_\xf7\xbd\xd0}\xf1\xc3oο\xf5\xcdDΌ\xbf\xe4\xbf\\\xf8\xbd\xf4\x95«ï-_w\xcf\x06\xcd>~\x93\xf6f~\xae\xf8\xad\xc2\xdb#\xef\x18\xefz\xdfG\xbc\x9f\x98\xcf\xfe\x80\xfdP\xf5Q\xefc\xd7'\xbfO\x0f\x17\xd2\x16\x16\xfe\x05\x03\x98\xf3\xfc%w\x16u\x00\x00\x00 cHRM\x00\x00z&\x00\x00\x80\x84\x00\x00\xfa\x00\x00\x00\x80\xe8\x00\x00u0\x00\x00\xea`\x00\x00:\x98\x00\x00\x17p\x9c\xbaQ<\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x142\aN\xdd8e\x00\x00 \x00IDATx\xdat\xbd[\x8flKr\x1e\x16_D\xe6Z\xab.ݽ\xbb\xf7\xe5\xdc\xe6\x90s?\xc3\xe1pH\x91\x83\x19\x0e!J\xa6G\x94dQ4d\x01\x06\x04\x18\xb0\r\xbf\xe8\xd5o\x06\xfc\x1b\xfc\x03\xfc\xecG\x03\x82\xe1\x17?\x98O\xb2-\x0e%\x0e)R\x94(\r9\xb7s\xdf\xf7\xdd\xdduY\x97̈\xf0C檪\xdeg\xd8\xc0\x9c9\xbbO\xed\xaaZ\xb92#\xbe\xf8\xe2\xfbb\xc1\xff\xe4_\x1031\x13\x119\x91\b\t\x13@D\x04&\x10\xb9\x13\xcd\xff$\x90\x1b\x11\x91\x95?\x12\x81\x8e\xbf\xd1D\x04\xba\xf3\xe3\x04\x90\x9f\xbc\x89;\x99\x119\x11\xc8l\xfe=\x91\xcdoR\xfe\xe5\xe4\rȝ\xbc\xbc\x12dNd\x945kv5'\"'sww3%w3KY\xd34\xa6i\x1a\xfa=\xc0\"\xe2n\xe4\x0ew\a\x99\x9a\xbb\xea\x94\xf6\xfb\xfd˛\x9bi\xcaN\xaefn\x96Tw\xfd\xde\xdd\xeb\xe5\x13\x99\x9b\x99\x99j\x1e3\x19\x85;\xdfL\xf8\xf5\x95\xaa\v\xe4DT/\x1b\xa0\xf2v\xc7\xeb\xa7\xfa_\xc1\xe4vg\xbd\xbc\xfe\x8f\x80\x93\xf5\xc5\xc9r\x83\xdc\xc8\xeb\xab\xea\xea\x1f\x96ψ\xe0\xa4NTV\xd9\xc9\xddMM\xd5\x0f\x9f\xebN\xe4e9\xc8\xc9\xcd]\xb3\xe6<\x8e\xe30\f\xee\x16$\x84\x18\xcc,p\xb9\"\xa7\xf2W\x99@\x04v\xcbFND\x10p\x1b\xe3\x98R\xfdf\xe5#\x000K\x13<Y B]\x05f\x02\x1f\xbf\xf2k[\xa4|\xad\xf2ʺ\xa1\xa8^C\xd9& \x02\xcf\x1b\xb0~\f\xf1\xfc\xfa\xc3z\x9c\xbe\x03\x11\xb9\x91\x83\xc8\xeb\x1f\xcd)\x1b\xa9\x91f2'\x9b\xd7ڽ|mws+k\xe6n\xf5\x16\x98\xbb\xab\x99\x99\xb9\xa7i\x1a\x87\xbe\xdf\xef\xc7q\xc8)\xe54m\xa7\x14\xa2,\x16\v\x8a\r3\x93\xbb\x9a\x9a\x199\x01\xec~\xb89\x0e\x90\xb0\b\x9b\xaa\xd6C\xc1\xc4\xee\n\x80\xe1\x01\xa1^6\x83\x18\xc4\xf3nb\xa6\xc35\xe1\xf4\xe2N~@\xf3ŀ\xc8\xc9\xcb\xd20\xb9\x1e\xd7\xdaO\xb7\xd8\xfc\xc3 w2\"#R\xab\x9b++M\x89\xa6lS\"\x02\x03\x04\x10\xe0d 2rr23su'3s\"/\xc7\xcf]\xcd4gռ\xb9\xbd\xde\xed\xfb)\xa5)MS\x9at\x98\x92\xa6\x9c\xb3\xbb\xaf\u05eb\x8b\xcb\xcb66\fdU2%w\x11v\xd3zZ\x8c\xdc\x1d@\x10QU'\"8\x11\x8c\x85\xa0 \bK '\x12\x90\xf0\xf1\xdc\x01\xf3\xc5\xf9\xbcF\xa8\x9b\bt\xba\xaf\x8e+u8\x95\x98\x97\xd5_\v<w\u007f\x8cȔ\x92\x92\x1aM\xa9\xae\x94֫'\"\x05@ \x90\xbb\x97\xb3gff\x99\xdc\xd5\xdcղ\x9a\x99\xaaZ\xcay\x18\x86~ؿz\xf1\xa2\x1f\xf7<\x9f\x0f5\x1b\xc7i?\x8e9\xa7l\xf6b\xb7y0\f\x97\xf7.\x97mK\x80\xe6D\xee\x8c\xf9\x14X\x89\x05D 0$p\xceJ\x0ebb\x90\xb1\xa8;\xcc\x03q\xbd\x87\x87\xa8v\f\x1b倔\xb5\xb0\x93\xff\x04?\x06\xa3\xb2^NǓ\xf8Z\x84\xfe\xecZ\xb9Sδ\xddSR\xcaZC\x12\x11\x01\xee~x\x8d\xb9\xba\xd5\xc0\xa6\x9a]U\xdd4\xe7a\x1c7\xfb\xddn\xdf\xf7}\xbf\xef\xf7Ӕ\xc74\x0e\xe3\xe8n\x128H\b`#\x9aR\x1a\xa74L\x93\xaa\xba\x9b\xbb\xef\xf7\xd3~\x98\xae\xee]tM\xc3\x04\x11\x02\xb8\xec*-k\x85\x9a\x82\x84%\xc3\xea\xde\"\xf2rV\x99\xc3\x1c\xaapwנ\xfe\xc6鸈5\xf0Ӽ\x88w\x8f\xe4!\xe5\xd50\u007f\xb24\xaf\xed\xc5~\xa4\x9b\r\xe59?\xc0O\x16\x14f\xf5ڈ\xdc\xdd\xd34\xee6\xb7\xe38\xee\xfb\xfev\xb7\xd9\xf6\xfd~\xe8\xb7\xfbaLI\xb3\x9a\xb9\xa1\x86/.\xb1XX\x88\x1d\xeef\xd9T\xb3\x99\x19\xc1\xdd`\x9a\x9e\xbdz\x05\xc2\xe5\xbd\xf3F\x82;\x13@ S\xf7\x1aLݭ&4\x01\xb2\x19\x80c\xc0$\n\x04\xae\x9b\xabl\x16F\xdd)\xb8\x9b\x9bʱ>\xa4º}\xbc\x065?Yk\xe0p:O\xce&\x11\x88Ԩ\x1fh\xb3'\xb5\xbb7\xa6\xe0\x0f\xcfyr/\xf1H\xa7i\xdaon_\xbdz~\xb3\xd9l\xf6\xfd\xb6\x1f\xfa\x94\xa6\x9c\xa7\x94\xb2\xaa\xa9\x19\x91\x95\v\xa9\xf9\xcd\xc9\xc1\fr\x03\xe0\x0e\x90\xa3|7\xae\x19g\x9c\xd2\xd3\xebW,\xbc^.\xa2\x84r?l\xde\xcevL\xb1\x04f\x945$\x12\xa0l\xfe@|\x12\xbc\xef\x1c\xc6\xd3\xdc\u007f\x92\x1c\x8f\xab6È\xb2\xd1\xfc\xe4\xc8\xd5\xdf\xfb\xf1M@4%\xda\xeci\x98J\xe69nR/w\xc8M\xb3\xe5\x94R\x9a\xa6a\xbf\xdd\xf6\xfd>\xe5i\x9f\xd2h>e\x9d\xb2N9MI\xd5TU\xb3\xd70cn%\x10\xb8\x81\xc8I\xe7\xfb\tb\x00^\x93Ĝ\x84m?\x8eO^\xbe\xb4|o\xbdZ0\x98\x98\x9d2\xdc\xcdݬ\xec\xe8r돑\x9b\t\n\x87S \xe2\xe3\xf9:,\x89\xcfx\x92N\x8e!\x15d`\xaf\x03\xceS,\x80rЙ<\x9f,\xaeS?\xd0\xf5\x96\xb2\xce\t\xc1\x8fg\x99a\xd38\xecv\xfb\xddf\xbf\xddfդ\xd9\xcc\xdcI\x1d\f\x11\t\x88\xc1'\xa6\x04\aY\xb9\x18\xa3\xec\xe6N\x80\xa3\xde\x17/Aǜ\xb4\x02V\n\x80\bP\xaf\x8d˙\xda\xee\xfb\x94\xf2C\xbd8?[\x83P@\x87\xa9\x12Jܜ\xf1\xdf!Z\xcd\xeb\x13j\"g\xdc\xd9\x05\xf0\xe3şf=:\x05\xee\x87ߜ^\xff1\xfa\xd4<\xe0N\xc3D\xd7ۊ\x12\xea\v\n\"a\xb7t\xfd\xf8\x93\x9b\xeb\x97\xe38\x8ciR/\xa0\xa1\xbc\x1d)QR57r\x01A\x89܌̳k?j2\x05 @#lN\xd9lʖ\xd5R\xc1n\xee\"\x10\xa0\x89ܰ\x98\xbb\xb9\n#0\x83\xa9\x9f\xa6\x0f\x9f=\xbb\x1a\xc7U\u05f9*y\xc1\x02\xccD\xe6F 7\xa7\xd3xT\x96\xfe\x88\x83Q\x96\x9e~\x0e\xaa*!\xa9\x86\xaa\xf9\xa0\xa1\x80\xc69\xee\x1c\x00}\x85\x11\x05p\x11M\x89\xaeo\xef\xa6\xc8\x1a\xd1u\xd8=\xfb\xe8\xfd\xeb\xeb\xeb\xacӔ\xd2\xcd~\xcc\xeeM`\x10\xcc\xc8@\xd9=\x9b%\xf3a\x1aw\xc30\x8eØ\xc6a\xd4}\xaaH\xc8\xebqwu\xd7\x1a\xa7\x89\tN\xce Ww!K\x96\xd9\x01\xb8\x938\xd4I\n\x04r\u007fq}C\xe7\xb4h\x9bC~\u05f9\xfc23:`\xa5\xf2\x19tZ\xee\xf8\x01\"\x9d\xa0\xa7ӀU\xfenY\xb8\xd3\xc8\xed\xf8\f\xf8\x9a\xff\xd4\x0ft\xb3#\xb5\x93\b@Ĝw\xdb\xfd\xf5\xcb\xcd\xf5\x8b\xfd~\xefd\xea>\xa6\xbc\x1f\xc6\xdba\x9frvsu8\xdc\xc8\xd5\xc9ͳ\xe61\xe7aJc֔M\x9d\xb2ZvR\xb3\xf2\xe5d>\x19p28\b\xe6$\xa5\xc4r\x1fͽ\x96\x90\xc4L\xc2\b\xe0 \x10\xd8\xe3\xebW\x0f\xcf\xcf\x17M\x03\"0f\xe4E%ֿ\x86\xacÝlu\xa8\x01\x0fK\xe08\xa9l\x0eP\x97>[\n\x9d\xfc\xb2\xc2a\xba\xd9Ҿ?\xc9\x03\xf5\xaf\xdf|\xfc\xe1\xc7\x1f\xfdl\x9a&'\xa8\x9b\xb9甶\xe3t;\xeeo\xf6\xfb)g7R7'bfs\x9f\x92\x8d9'3wO٧l\xb9\x00\xf9Z\xb99\x97@s\x80:u\xa3{v\xd2<C\x93Z;\xc0\rD\xe40sb\x80\xcd_n\xb6\x0f\xcf\xd71\x04\x81x\xad\x14\x8e)\x12^\xd2\x1e\xdc=\x1c\xd1\x00pR6\xa3֜\ah\xfes\x81\xf8\xa1\xea\xf1\x93j\xb1,\xf7\xcd-m\xfb\x93,A\x94R\xdam^|\xf2ч\x1f\u007f\xf4\xb2\xdf\xed\xa6\xa49\xab\xba\xc2\xd5t\x98\U00098d6cT2M\xeaNd\xeeS\x01J\x04s\x8cْ\xaa\xcd\xd1|N:t,\xef\xe65:\xc1\x03\xa5\x90\x033\x139\x03<\x87\xa2\xb2\xaaN4j\xdeOS\xe7\x16=T`E^p\xa6\x83\x8c|\u0380\x1e\xea\xe1:\xa6\xbc\xc3=\xbaK-\x1c\xf2\x1a\x9d\x1c\xc3ע?\x88\x9c\x89\x9c6\x1b\xda\xeeK\x15\xed9\xe5\xfdf\xbcy9\xecn\xb7\x9b\xcdvۧ\x9cƔw}?\xe6\xacV\x01\xa8\x99'լ>\xa9NY\x93yR7\xf3\x02\xfbR\xb6\xacn\xeev\xe0lj\xaarG\x8d\x18¬\xe6j^QW\xcd\xe4\x00H\xb8,\xc2\x1cV̽$Q\x02\x9cȨ\xe4Ns\x83\xa3\xec\xa3Z\xa6́gΆ\xb5\x90\xbe\v\xc6}\xe6\x00\xe8\x84r90\nvB?\x9cBK'\xd2L\xb7[\xda\xf5\xc4\xecð{\xf1d\xd8ݤa0\xcdSJӔԝYb\fA¤\xea\xe4\x0e\xa8\xfa~\xcacVU\x1b\xb2\x8e\xe9\b\xaa\xb3\xb9\x1ae\xabE\xa3\x9d\xdc#\x94k\x9a\xabr\xb8i\x01]%v8\x01\xa5\xea\xe5Ӡ\x8b#\xfd\x02\xa0Py0+\xc8\x11\x8e\xe3a\xb8\x8b\a\x0e1\x8bq\xf7\xba\xef\xc2\xd1;\x9fB\xaf\xafё\u007f`\x9aFzuM\xd9\b\xe4\xe3\xf4\xfcßlo\xafSNf\xae\xaaiJ\xa6\x9aM\x95\xdc\xd4\xfblCv'\x9a\x92n\xc7r\xda|H6$=$\xd7\\BZ\r\xb7\xf0\x138ȇP\xe4d vJ\xea\a\xb2\tp\x01\u008c\xb0j2\xa7\x9a\xa5\x89\x1c\f\bw\x81#\x83@Z\xea3\xb8;j\x90'\xbf\x8b1\x9d\x88\x02\x19\x91\x9c^:f\x8a\x8a\xee\x1c\xbd\xf2{\xf8\t\xafpx\x8d\x13\x81\xa6\x89^\xbc\"sr\xca\xfd\xee\xe5G?}y\xfdr\x1c\xc7a\x9a\xfa>M9眒\xe6\x9b!\xbd\xdc\x0f\xfd\x94M5\xa9MYA\xe6\x045\xef\x93f\xb3C\xa6\x9e\xb7\xc9\\\x9c\xcd\a\xa0\x86\x15\x1c\x10H}\r\x83J\xb0\x17\x80\x99Qᥟ2s\xa5\xb4cB\x13d\x11\x83̥\xbb\xbbY\xa9\x15Qk\xc4\xfa1s\xf2*\xbb%\xcc\x01\xcb\xef,\xd3a+\x9dF\xf7\xe32\xd9ݪ\b\xd4\xf7t}K\xee\xae\xf9\xe6\xc9'\xd7\xcf\x1f\xef\xf6\xfb)\xe5\xfd8n\xfa\xe1v\xd7o\xf6\xfb\x9b\xddx;\xe4\x94u\x8e\x1b6&-\x14\x12\x81\x86\xa45p\xcc\x10\xc6\xef\x1e~\x02\x81 8\x81t\xf3\xdddB\bh\x85Q\xb2#\xb9ݭ)\xca\x02\t\xb30\x82 0\x8b\xb0\x00\xee\x05~:C\xd4-8\x97O9\x02\x1d\x9f\xebC\x10Q!\xff\xea'\xdf-n\xfdtg\xfd\xbc|X\xd6+Ot\xbb\xa3\xfd@D>N\x9f~\xf8\x93\x97ϟf\xcd\xc9rζO\xf9\xe9\xed\xee\xf1\x8b\xdbM?\xa6\xacN.`w\x8c\x96U\v\xff\xe2\xa9\xd0XF:G\xd2\n\x9a\x0e\x1cl-\x8a\xc0'\x8c\xd1\xccW\"2\xb5A\x96m\x88A\x86\xacjdj\x0e#G`\x8a\"\x11`!\x063J\x98\x03\x011\x04\x80sJN\x1c\x85\x9a&\x942SX\xbc\x94\xe0\xe5ԞP\x9f\xee\x1e\x8ew\xeat=@w6\xd4\x11(\xdd%mv;z\xb5!UJi\xbc}\xf9\xc9'\x1f\xdd\xeenǤ)\xa7\x94\xf2\xf5\xae\u007f\xff\xe9\xf5\xcb\xcdnL٬\xd0CHnSV\xab\x15\x9c\x17 ~\x8a\xd3Jv\xd7c4$\x00\xc23 F!\xab\x11\x04\x8d\xb0\x80\x02\xb8mc\xdb\b\x93\x87 \xd9\xc8\xc9\x04h\x02\xc3)\xab\xba\xf9\x1c\u007f\xe0\xee\xe6\xc6\fw_,\xdb\x1cd\x9a\xa6\xae\x11\x11\x9c`J\xf7Z\x8d\xf9\xfc\x05jb\tǻ\az\x1d\r\x1c\xfe\xc5|ފ'\x97\xb6\xddыW\xb6\xdb\x0e\xd7/6\xdb\xdb\xcdЏӤjSJ\xd7\xdbݧ/n>}\xb5\x19\xa7\xac\xae\xaa\x95EQ\xb7\x94k\xa6;\x80\x06\xdc\xe5Ҁ; \x0fD\x81\x89\xca\x01\x01\xa2\xf0\"ʲ\x91(L\\\xf6\v{\xe9\xc2\x105\x91/\x96]\xd3\xc4\x10D\r\x9atJ\xd38L)e7+\xe0\xcb+\xf9\x98U\xf3r\xbd\x94}\x85\x98\x05&\x18\x9c_+\xfap\xc4u\xe1\x84\xd2\xf3\xd7\xf9\x19\xba\xcbj\x1d\x82d\x9a\xe8\xc5K\u007f\xf28\xf7\xfb\xa1\xef\xf7\xe30\xe6\xe4NN<\xaa~\xf8\xecՇ\xcf^\xdd\xee\xf6\xa9\x94\xc0N\xe4n\xe4I-\x99\x9a\x1d`\x89\xd3\f\x8e˙c@fh̅\xdee\x96r\"ܣ\xf0\xa2\x95.H\x14\x80A\a@\xe4ff!J\xd75m״m4/\xc1\x1e\x9a\x1d\x84\xb6\x8d\xe6\xa49Se\xf6\x88\nG(\xc2@۶\xeeY\xb3\x02([\xcf\v\xd6\"\"\x87\xd7\u007f\xd4\x1d\x12^\x8fAw\x9a\x14\x9f\xddhN7/\xe8\xc5\v\xdal\xa6\xddnʹ\xcf)\x99M\xd9M\xedz\xd7\xff\xc7\x0f?}\xf2\xe2f\x98Ɣ\xcd\xd4\xd5\xddܜ\x90Բ\x1dP\xb5\x1fv3\u05efE\x02H\xdd\xdf`\xa6e\x13\xdb\xc0S\xae\x80\xbd\r\xbclC`H\xe0\x18\x1b\x0e\xc8ل\x89ܜt\xdd,\xdb&\x82\x99#\xbb۔F3'C\x9a\xd4\xdc܈\xe1\xedz\x11%\xee\xb6;\xcdZ\xce\xca؏\xaa\x1a\xd8C\b\"\\+i?Y\x1d\x9cp\xc0\x15\x94\xfe\xbc:\xef.3s\x88YJ\xcf?\xa5W\xaf|\x98\xc6\xdd.M\xd3~L)O\xfb)\x8d)\xfd\xecɋ\x1f~\xfc\xc9f7dͦ\xaefc.\xed=*\xe4\x14\x01\x80\x17\xf6\x01\xf5\xc4a\xae\xac\vA\v!j\x03_tq\x15\xa3\x81\xb4\x11u\x17\x0e]\x1bc\x1b\xbaղm#\x03\xe4\x96S\x16@M]-\x84 \xcc\x10dJ\xa6\x1eD&\x9b\xdc\xd1v\xad\xbb\x9bR\xd34\x05\xc0\xe6\x94\x06\xebK\xccL\x9a\xce\xe2\n\bn\xce\x02\xf2\xca˔\x00\u007f\xba\x043\xf7\x84\xf0s\xd6\b\x87r\x01\xc7\x0e\xc5\xd0\xd3˧t{c\xe38\xec\xf6Ӕ\xfai쇩\x1f\x87\x9b\xed\xfe\xaf?}\xfa\xe1\xb3\xe7C\xca\xe6njS\xd21k\xf6Z\xeb\xe3\xb3\xc5\xf6\xc9\xe9\x0e@`\x10(0\x96M8_v\x8d0\x11\t\xd0\x05ibӵM\xb3lB\x90\x18\x03\x83M\x15D\xdc͈\x93\x8e\xf4\x9cY.\xb9~Ru3\x80s\xa1\x1b\x99M-%\r1v\xee\x04Ҝ\x01n\x9b\x16p\x86\x98e\xc0O\xfa\xbf\xc7D\f\xae\x9c7\x13\x02\xa9\x12N:\x86\x87\xf2\x14Lj\xa4\x13\xa5\x816[\xda\\Sߧi\x1c\x87a\x18\xa6a\x18\xf7\xfd\xb0\uf1e7\xafn~\xf8\xc9\xe3\xe7\xb7;5\xcb\xe6Sҩ\xf0u~\xca+\xd3̔Ϥ\x19\xc8\x1dL\x14\x03Gf\x87G\xe6\xf3E\xbbj[\xe1\nub\x13\x9a\x18EXB\b,]l\x18p\xb8\x840c!0\x83\b^\x0e\x9b+\x83\xc1p\xa2\x8e#\xc0\xeedѝ\xcc\xd4\xd0r\x9a\xd4ͽm\xa7it\x89\x1cD\x18n\xca\\\xeb\x9f϶\xa1\x8eH\x01\x04 \x90抍E\x88\xe7\x02{\x9ahsM\xd7/)'J\xc95kN9\xe74Y?\x8e\xbb~\xdf\x0f\xfd\xedf\xffɋ\x9b\x1f}\xfcx3\x8d\xaa4\xa5\xdcO\x96\xebF\xae\xf0rNk\xf5\xe8s\xe5\x10\xe1DQ\xd0\n\x97Bw\xd14\xe7ˮk#\x03\ffaf\bK\x10\x06KYh\xd4X\nff0\xf3\f\x80j\xb9\x03\x12!w0\x88،f\xd6\xdd\xdd\x11B\x00K\xb6>\x88眛\x18THD\xcaN\xccY\x81\x13\x8a\x87\x0elޱ\x9fRRt\x98\xe1p&͵l\x1ez\xdamh\x1c\xa8ߓ\xb9y\xca9OI\xc7)\xf5\xfd\xb0\xef\xfb\xdb\xcd\xfev\xb7{\xf2\xe2\xfa\xa7O\x9e隆L\x87Q\xc7\\i\x11\xcc\x17\xf0\x1a\x1c\x99\xfb\x93\x00!0b\xa8ڂU\xdbvm\x8cQ\x9a \"\x82ڐ\x013\x00\x94\xb6\xc44RA\xb1A\xc2\xd9z!\x81\xe7J\x8e\x00\b\xc1\x19D\xa6f\f\x16\x96LȖ\xe1\xa5E\n3K9Mi S\xcay\x9c\xa6\x10\x82S,-E\x14\x00W\x9b\x9f\xb8\xcb\x14\xe3\x90\xec\x9c<\x90i%\x12\n\xffaJ\xfd\x8e\xdciJ\xae9\x9b\xa6<Mc\xeaǴ\xdb\xef\xfb}\u007f\xb3\xd9mv\xfbg77\x1f>\xbfُyJ\xda'M\xe6s\x91u\xa0\xa9\x8f\xb0\xb2\xec&\xb0\xb3\x83\xc0Q\xa8a\xe9ڸl\x9a6\x86\x8a3K\x85\t\x880\b\xe6fj\xc9\\gQ\x03\xb3\bs\xd3\xf88J\x13\xc4̙뉥9,\x8a\b\x13\xbb\x83\xdcj\x02\x011!i\xb2\x9c\xe1\xc4\xc2\xd2D\xa4d\xf5\xed\rN\xa1\x8d\a]\xc9g\x04*T\xd9\t\a\x88\x03\x91\x15uJ\xed܌\x13\xa9\xd24j\xbfK\x9a\x86\x9c\xfb~\xec\xfba\xb7\xdfov\xbb\xedv\xbf\xdd\xf57\xbb\xedG/7C\xb2\xfd\x98\x87\xac\x85@\xaa\xf8\xf2t\xdf֟\x02\x9e\x89\x81\x00\x16\xa6Eۜu]\b\xc2\x02!Rw\x903S\x13\x84\x88\xa6\x9c\xb5\xcad\xacֳN,\xb5\x87cj\x9a\xb2e%\x06\xb9\xb1\x84R\xe4\u0382\x98rd\xadH\x13\n!Š\xcc\x10&F\xccD!bJ\x96\x93\xbb\xe7\x12\xe3\xc8\xdc\xdd\xee\xd4ۄ\xbb\xed\x1aw'\xb0\x9f4,\xdch\x18(O4\xe5ts3\xf6\xfb~\x9a6ø\xdb\r\xdb\xddv\xb3\xdf\xed\xb7}?\x8c\xfba\xfc\xf4f;eݏ\xda'\xad\x85\xae\xdde\xf1k\x8d\x02)\xbd\x1a8\x13\a@\x98\xd7]\xbbZ\xb4\xe5<r\x15\x84I\x1bC\b2&˚\x0fE\xf0LG{\rl\xe6J\x1e\x88\xc0\xecNPRW\xcd\xca!\x801\xa3\x90c\x97\x94\xdcʹ\\\xa4\xe7\xecf\xe6\xe4T:\x84>\xa61\b\xc8L\x84\x9d\xe4\xd8\a<\xe1\xe9\xee$<\x80\b\x81\xccH\x95R\xa6<QΔ\xd2\xf0\xea\xe5f\xfbr\xbf\x9bv\xfdn\xb3\x1dw\xc3v\xb3\xef\x87~\x9cƴO\xe9\xd5~\xe8'\xdbO:LJ\xa8\x97\xf6Z}\xcd\x003Z\x16\x11\xa8\x91\x90\x13\x10\x18g\xcbŢ\x8d\x05\x16\xb0\xb0\bs\xc9\xc2F\xfd\x90ʎ8 ù\xa6\x80;\xb9\x19\x00&\x13\xf2\x00JS\xf2\xb9\x86\x13U\x0e\x01\xc2\f\x90CMݜY\x8e\x8d\a+\x8a\x02\x05 ,\xaa\xd4-\xba~\x9cPU\x14N\x94\xa5\xbc\x9ef>\x15\x87\xeeLM\x94\x05@\x04\xea{\xd2D\xd9ȕr\xbe}\xfa\xf4\xe5\xb3g\xfba\xdc\xf5\xbb\xed\xae\xdf\xf5\xe3~\xdcOcN)\xbdڍ}\xca\xfbIo\x87)\xa9\x1ddUwN9PX\xb7\x8690\x93\x93Ø\xc0\xa0\xf5\xa2[\xb4a\x8e_\x10ff.\xa2\xaa\xb9\xd5T\xd1\xc5\xf1߈\x00tMXv\xb1\x89\xa1\x00\x85B\xc9SY\x04rs\xb0%0\xdci\x9a&7\a \"\xcc \xa2C\x8bU\x98\x95\xe1f\xe4̠(b\x9a\x02\xc4A|R3\x1f\bXbx\x15\\\x94}\x0e\x16\x0e\x05\x1cPΞ\xf2\xed\xf5\xabO>\xfad\xb7\xdd\xf6\xc3~7\x8c\xfbiJ\xd34\x8dyJ\xf9\x93\xcdv?\x99\x9bo\x86<\xaaJM\xb6\xa7\xa9\xd6\x01\x16F+ܔ\xfbl\xe4\xf0\x06 \xa2\x18\xa4m\"\b\\\x80\x01\x83\x88Tk\xbb\xdc\xfc5n\xb2\xa8\x16\xa8\x8d\xe1|\xd5u]CDn\x9e\x9dTUU\x83\b\xb3xA'9\xa3\xc0+\xb5\x9423\xc8i\xa4I\x98\x010ׅ\x03\x83!Y\xadDD\x11\xc9\xd3h\x027%\x92\x19\xdc\x1d\xd3\xe1\x9d\x14I\x0eF\u05f6\x81v[K)O\xa9\xef\xfb\x0f?\xf8\xf0\xe5\xf5\xcd8\f\xe34\x8d\xd34iN)\x8f9\u007fr\xb3\xbb\xe93\xe0\xb7}\x1a\xd5Z9v8\x0ewD\x98\x9b \r#0s\x91\x88\xc2\x19\x88\x02#*;#\x8a\x9c\x12d~\xa4\xae\xedP\x92\x99;@M\xe4E\xd7,\xda&ƠFI5g\xcd)\xa7\x9cR\xce\xe5\x18\x9a:\x81\x82\x84\xd8Ʈ\x8d\x02vUbf0\x00u+\x97\x9b5\v!v-\xcaQSw\xa7\x18X\xa5PW\xe0C\u007f\xeb\x84\xdd/4\t\xe6\xde{\f\xd25M\xd0\xfd~\x1c\xa7~\x9c>\xfd\xf4\x93\xe7\xcf_\f\xd38L)\xa7\x9c4\xe7\x9c\xd5\xf2\xe3\xdb\xfd\x8b\xdd\x04\xa2\xed\x98\xf7Y\x1b\xae\xe1\xf4@\x152\x10\x85#s,\f\x9b\xbb\xb9\xa3һ`\x96.ʪkE\xe4\xa8\xd984i\xfcPO\x15\xa5&7\xc2M#1\x04'\x1ar\x9a\xb2f\xb3a\x18\xf6\xfd0\xec\x87i\x9a\xa6\x9c\xb3\xda\x01\x16\b\x93\x03]\x13E\x02L\x83Df0#\x86\x18c\b1\x880@!gi[w\x03\xc0\xe0\xacʘ5\x12\x98;6\xa8\x04Q\xbd\x91\xb3D!\x88,\xbaE\x13c\xc8)\xed\xfa\xfe\xc9\xe3\xc7\xd7/nRJ%s碪s{\xb9OϷ\x83\x9b\xf7\xd9vS\x0e\x02\x99\xe1\x0091\xb9\xb0\xb4\"\x00\"\x13\x03\x85ya\xb00\aA\x13¢\v\\\xb2\xf8q\xa1N\xb0\xd8\xdc@f\x91\x18\x04\x022\x1f\xb3)\xa9\x9bm\xb6\xbb\xdd0\xa6\\D\x90Y\xb5Ȉ8SQP2\x17A\x85Y\xdfg\xb6)\xba\x06p\x11\t\x96\x84\x1bc\b\fs#\xa0YvN\b\"\x00GF\xb7h܍\xc1\x05۞\xb0Xw4\xb5L\xe8ڮk\x9a \x12no\xae_\xbcx9\xdc\xee\xdcU\x8b\n\x85\xb4t*\xf7Y?\xb9\xdeM\xd9\xcd}\x97\x94\x19\xa1\xea6@䁹\r\x12\nJ\xa8\x9b\xcd\t\xc4`at\xad,\x9b\x86\x80\x94M=\x97v/\x9f\x90f\xeanN\fg\x88\x84 1\x8c\x9aw\xdb!O)\xabNӘ\xc6\xc9\xcc\xf8\xd0\xf2\"\n\x85\xc3q\x17\x90\x99\x13\xa9\x83\x8c$\xd7m)\x93c\xa19\x10\x95b\xc8Ն<\x94}\x9cS\x92]O\x01\xd9=\xa7|\xff\xdey\xd3^\xb9\x9bD\xa9\\\xf3̂\xbc\xa6\xea\x13\t]\x17%\b\x98\xc3\xfe\xf6\xd6s\xe6@\x94k\x1b\x8e\x9d\x1dl\xae\xcfn\x871\x19ȇd\xe5\xb8\x01\x14\x18\f\n\x906H\x00\x9c<\x80\x017\aq%3\xbb \x00\xef\xa7<%\x15ᶉ \xcfIC\b\xa5\xffOp\x9fY\xee\xec\xbeK\xdao\xf6\xdb\xedƧ\x04\xf7\"D\xe3\x03\xdbu\xf2\xdd\x0f\x84Q\x00\xc1\x8d\x9cܵ\xb5\x8a.2a$\x98{t\xaf7\xcdȝB\x00@\xa6\x86\xccM\b\xb9\xaarMX\x0ew\x1ft*\xbb\xc2A%\xb7\\t\xb1\x1ef\n\x02\x12\x01\a\x16\x91\x18\x82e͢\xc1\xb0Iv\xdd''$\xa7l\xce@\x00\x04܈\x04F\xc3\x12\v\xe6\x04\xb9C\xab\x98\x15\xcc`\xf0\xa8\xae)\x9b\x1bX\x9a\x10J\xd86#\xf5$`b\x82#\xa9\x12\xd1>\xd9~\x9c4M0\xe5C@\xadH\x96\xf4н-l\xbd\x93\x91\xabz\x91\"\xf0\x9c\x85\xd9!l\xa8u\x02\xa9\xc3@\xa2.E\xcfƬN\x86\xb8\xcfSR\x93\x86\f퐐\xb35\xcbf\x86Ƶ\xe8\xae2iT\xf8ڵͲk\x82\xd4\x18\x1a\x04\xc4\xc2\x12\xd0\x06\x98\"3\x87\x10\xb2ѳ\xfd\x94\x9d\x88\x90\x92ם\n,\xa34\u0081%2\x05\x86\xb0$\xb5\x03cU\xa2\x99\x9a\x13\xc8\xc9ը\x11r3%)M=7d\x18\x19\x8dSNf\xaa:\x8e#\xdc\xe53B\x8a\xe4\xb4˴W75\xd6\xcc\xe6NUu\xe5T\x95j'\xea\x8c\n\x1e\v㊓vA9\xf8zh\xf7\x83\xbad`JU\x1f\xee8J\xb0\xe7\xf7\xac\xc43\xc1p\xb6Z-\xba.\x84\xc0,1\xc4P\xceD0$@\x98bd\xe2x\xbd\x9f^\xed\xb3@RyG\"&,\xa2,\xa3\bs@)0\xa0~\xe2\x9e\xe0\x03*(\x8a\xb3\n-\xcd\x1c0\a\xdc\xe1d\xa6>\xa5\x9cUU\xcd\xf2\xc4'\x8e\x96\xec\xceF\x93\xfb\xeddS\xcaV\xcbU\x1c\xd0)\x03\xcc\xe4\xea\xc5N\x10\xc8Q\xe4\x14'\xaa\xd2<W\x8a~h\xc3bιu\xbfz\xc3\xfc\xe0b\x15\xa3\x98\x1bc\xeeۺջ=g\xa1\xb6\x89\xcbE\x17\x82,\x17\x8b66\xe7\x17g\x81ԠF\xe6 \x17F\x13C\xf2\xfc\xe1\xabm\x91X\x8e9\xc3I\x98\xbb\xc0\x17m\f\xe0\x82ߎMk\x14\x9d\x05a\xe6ϼ\x06z8YΆ\xc8d\x9e\xd5J\x1b\xca\xcc\xcc)g\xb5<\x95[h\xe4{\xf5>\xe9\x94\xd4Քȼ4\x0e\b\x0e\"\x83\x131\x17\xfe\xa4Y4̴\xedӮ\xd7u\bm\x13\xca⩙[\xd5\xd13\xc1^\x93\x89\xcd+\xb5\x88ru\xd16\xc2\xe7\xab\x16\a\xa1pU\xb2\x91\x14\xd01\xff\xb5\xf5bq\xef\xecl\xd16]\xb7lۖ\x81\x90U\x93\xe5\xac\xeaF\fa\xf8'/\xaeo7\x03)i\xce\xee\xbejB\x17x\x11\x830K\xe9\xf7Z\xb9Q~\xe8 \xfb,u\x9b\xa5+E9Gf6\x8c\xa9\x900R\xac \xe5&\xe8D\x84\fJ\xd96C\x9a\xd4\xcbI!\xa0:\x1d\x88̨w\x1a\x15\xfb)\x11\xec\xc1\xd5\xf9\x83\x87go\u007f\xf1\x813Ə^\xfe\xe4?\xde|\xfd\xfeï}\xe5ݖ,\xa5\fF\x9a\xa6q\x18oon^<{\x96S:\x9c,f\x94\xfe\xf3[\x17\xddŢɰ\xedn\xc8)\xb7Ґ\x13\xb9\x91\x81\x98J\xa5zh\xc31x\xb5Xt1,\x17]Ӷ\xe5\xb7a\xcc9%˵\x91\x80\xed8}\xfc\xeaf\xcaN\xe6Y\xfd\xbc\x8b-s\x14\x0e̵ee\xc7F\x8f\x1e\xb8X?\xe15fG\x8f\x15\xb6\xb7\x06h\x88\xb80\x020Y.\xea\x9c\xe7\xbb<\x8c\x133\f\xc7|\x9d\x19\xbb\xec/2^e\xf4e\x87\xb8\xb4y\xe8\xe0_xc\xbd\xba\\\x9a\xda\xd9պY\xf4\xd2Ħ\xeb\x1e\xac\x96)'5%\xf08\x8eo\xbe\xfd\xf6G\x8b\xc5\a?\xfb\x99\x15\x15\xbb;\xe0\r\xcbW\xdf8;\xeb\x82\x12퇉\xcdS\xd6\xc6\xddTǜ\x04\xdc,Z\x16\x81\x1f\x93o`^vm\xd34!4\x87\xaeM\x98R\xcaY\x1dD\f\xcf\xf6鋛\xed~$\xa8\xb9-\"7̘\xb3^9p\xfeY)M\x81N\xe5\xe0\xcf(QՊs\"[6sf\x02ǳ\xae\x99\xc6iJ:d\xdd\x0f\xda\x1be\x82\x10\xe0t\xff\xed7\u007f\xfdw\xff\x8e/\x16\u007f\xf8\xc7\xff\xfe?\xfc\xe0\x877Ӏ\xb9\x9b\xcf\xe0Q\x96\x9bL\xcbU\v'\x00\xe7\xebn\xbd\b\x00\xa71ۚB\x88\xe2\xa2f\xcbł\xdc\xdfz\xeb\xcd\xc7\x1f\u007f<\x8c\x83\x19\tS\x13\x9a\xaf\xbcq\xefj!jf\xe6Rp\x8b\xea4\xa5\x189\xc6\xc8\fa.\xea,.e5\xa8k\x9be\u05f6M3_:\x91SHɴ\x84g\xa7\xfd0~\xfar\x93\xb3\xb9:\x83Z\x11\xa7\x92\xf50k\xeaOVj\xdeV\xa8b\r\xcbV\xfbI\x85av\xb3)디\x99\xdbF\xaeΗØ\x9eo\x86q\x9c\x8c\x10\x96\v\xdf\r\xea\xd0\x18\xe2\xb2\xfd\xcf\xfe\xfb\u007f\xf6\xbd\xff\xea\xf7@\xf8\xed\u007f\xfc\xf4\u007f\xfe\x9f\xfe\x97\xef\xff\xe1\xbf\x05\xf3\xa9\xbeBC\xdbv\xb1\x1c\xd5\x18d\xb5\fc\xb6\xc9\r\x8c\x18\x1b\x02UcXί^\xbc\x00Q\x94\xf0\xe5w\xdfzp\xef\xa2!]\xf8\xb4\xddm\xdd\x1c\xee\x02\xb0\xdb4\xa5\xa6\r\xe4\x10\x11\x96J\x84\x9cxa\xfc|\xb5\\\xb4\x1dK\xa0\x13&>\x98\xd7\xce6e{z\xbd}\xb5\uf2e42\x86\x12\xec<0\xcfd\xe1Q\xcev\xcaQ\x9b\xbbfW\xb3*\x89)>M\xb3~\x9c\xb2:3\x00:[\xb6/o\xf7/o\xf7\xe6\x1eW\xab\xcb\xfbW)\xfb\x87óM\xd3\\.\xf1\xe0\xd1\xd5\xe7\xbf\xf2E2%\xe6\xf5\xf9\x19D^w\xf0\xb9_\x9d\xb5m۔\xe4&\x01̢9\x05&&\x12\x11D!w\xcf\xf9\xf1\x93'O\x9f>͚\x1fݿ\xf7{\u007f\xf7\xb7D\xa7\x1f\xff\xd5_\xddގp&7&\x04\tg\xabe\x06\xa9\xaa\byŃ\xc9ݭ\tM6%#\x91pu~\xd64q\x86!\x95\xe6\nE5\x9f\x92\xe5i\xfc\xf0\xc5mN\x96\xd5\x19\x168\x92{\x10TO\xcbQ}\x88\x832\xdc\xc8-Y\x91\xc6\xce)\xd2AHf\xfba2\xf3J\x83\xbb?~\xb1qBh\xdb\xee\xec\xec\xec\xfe\xe5\x83w߹z\xef+\u007f\xfc\xbf\xfd\x9f\x186m\x94G\xef\xbcyq\xff\xb2RofM\xc3w\b&\"\x80\xdez\xf3\f\x8c\u00883\xb1\xa9\x83dѴLLL!F\xcd\xe9\xe3\x0f>\xfa\xe8\xfd\xf77\xb7\x1b\x02\xff\x9d\xdf\xf8ՋE\xf3\xef\xff\xe2\x87\xfb\xfd\xde̘ɝ\xcd\x1djQBӅL\xe6n\xa5\xd7\xe1\xae\x02Yt\xd15\xec\xa7\xe9\xf2lu\xb6\\0\xa4tM*\xa4p\vp\xb2l:M\xb7\xc3\xf4r;\xb8\x1b\xdc\x1a\x11\x90Wم{R-\t\x80g]\\\x11\x9be\xd5lVa\x82\xc3\xdd\x05\x184\xef\x87i\xe6\xd0(k\xeeG\xeb\x16]{\xb6Z\x9c\x9f\xbd\xfbޗ\u007f\xf5{\u007f\xf7k\xbf\xf5\xad\x1f\xfe\xf0\xa7\xbb\xff\xf5\u007f_\xb8\x87 o\xbc\xfd\xc6QqAX.\x17s\x13\xb8\x8a5\xef\xdf\xeb\xdey\xb4\xa2\xaa\x8b%3ˮ\x17\xcbn\xd1F\x02\xb1\x04\x04y\xf6\xc1\a\xaf\x9e?\xdfn\xb6)\xe7\xbf\xf5\xf5\xf7~\xebW\xbf\xf1\x17\u007f\xfeo\xf7\xbbݔ\xa6\x82\x00\vݮ\xa69M\xa1ai8\n\x1c\xb5\xb7\x16\x83\x04\x11\x04j\xdb\xf8\xb97\x1e23\x89\x14lw\xa8\xa9\x83\x1b\x91yV}\xb9\xddM\xaaf\x0e\"\x99Q\u007fR\xcdٌ\xbc\xac\xb0\x9d\x18\xb6\xb2\xa9\xcd\xe9м\xa4Il\x86qR=\x04\xfe\x9c\xb3\x93\xaf/.\x9a\xd5\xf2\x8dϿ\xfb\xed\u007f\xfc\xf7\xdf\xfbί\xaf/ﹻe\xcd\x06%oZ\xd9\xde\xde\xfe\xe9\xff\xf3\x87_\xfe\xe6/_>x8L\xd5frLL\x01\xbf\xf2\xd5\a\xe7\xab\xe6\x10*\xa7\x94Ϻ\xe6s\xf7ϻ\x18\x89\x19\xc2/\x1e?\xbd~\xfer\xe8\x87)\xa5\xf7\xbe\xf4\x85\xff\xee\xbf\xfc/^>\u007f\xf2\xf4\xe9\x93q\x1a\xe9\xae\fU\x18\x1c\x84\xc1\x04\xb4\x8b\xae\x8d\xa1\r\x11Nm\xd7\x15z\xf5\xde\xd9\xf9\xaak\x01f\t,r\x00\xb5^\xd5\xcaDf\xf4r;f53o\x02;\xc1\x88R\xd6\"\xc7\xc3\t\x95_͓~\xf0\x02\x92\xba\xbb\x93\x9am\xfb\xd1݅\xab7R\xd5\x00\x9c\u07ff\xba\xf7\xe6\x1b\xdf\xfaG\xff\xf9w\xff\xc9\xef5E|`FDg\xe7\xab\xf3\xb3\xe5\xe6\xf9.\xb2\xff\xf0\xcf\xfe\xfc\x87\u007f\xf6\xe7W\u007fp﹝=\xdb\xe4\xf7\u007f\xf2\x01\xb3\xd0\xdc\xc7\xfbһ\x17\xef}\xfe\xe2 \xe7'\xb3\xed>=X.߹<\x0f\f\x16\xbcz\xf1\xe2\xd5\xe3'\xe30\x02\xf4\xa5\xcf\xff\xc2\xff\xf0\xfb\xff\xa0\v\xfc\xfd\xbf\xfc\x0f\xe38j\xce%?\x97\xb2\xbd\x94\x8a\xe2,̵5\xeb\xeef\xa5\xa1\xcb@\x13\xc3\xd9r\tfi\xda\x10\xe3\\P\x95\xb0`\xa1ȣ\xcdi\x9fr-\xf7Y@\x94\xb3\x1eӟ\x93\xb93ז\xa7\x13\xa9ڡ!\xa0jSλ>1\x13\x80ُ\xeaDt\xf1\xf0\xfe\x17~\xed\x1b\xdf\xfe\xfd\u007f\xf8\x8d\xbf\xfd\xed\x83窬\xf8\xe5\xc5\xf9\xdbo=\xfc\x8b\xc7O\xcfVa\x181\x8e\xba\xdfm|\xbc~\xfa\xc1\xb6\xdfi\x11\x97\x91\xe1\x9d7W\xdf\xfd森\x95\xca\xef\x9aM\xa3\u07bcJo.\xee\x9d-:0\xbf\xba\xb9ݼz\xa5Sn\x9bx\xbez\xf0\x0f\xbe\xf3\x1bo^]~\xff\xfb\xff\xea\xf6\xf6&\xe7\xfcY\x87(33\x05\x0e\xecL\xb3\xac\xa7x\x0e\x98ɻ\xa6\x89ADBh\x16G\xc7x\xe5!\x10f\x16\xbc\x86\x83\u008fg3\x9d킳\xe6f\x06\xeeDvb\x12\x98T\x87!\x8d)S\x9d6\xe0\am̃w\xdf\xfe\xf5\u007f\xf8\xbd\xaf\xffַ\xdf\xfdڗ\xecԻcNf\xeb\xb3\xf5{_\xfd\xe2\x9f\xfd\xe9_\xfe\xa7\x8f\xf7\xdf\xf8\xc5u\xdb\n\x9c\xae.\xda\xfb\xe7\xed\x87\xcf\xf7\xaf^m\xd20]\\\xad\u007f\xe5\x1bo\xdd;\xef\xe6\x0e\xae\x93\xe3f\x9bv;=\xbf\xdf5m\xb8\xb9\xbe\xddn6M\x88Y}\xb5\xea\xbe\xf5ޗ\xbf\xfe\x8b\xef\xfc\xe4\xc7?\xfe\xe9O~\\|\x99\xa7r\x94\"\x1b\x00\xc0\xccN\x16B\x94\x03:q\x13\xe6\xd5ry\xbe:c\x0eq\xb1*ز\x9a\xb5\xe6O\x0f\\xVF\x13D\xc0\x01\x04\xa2l:WX䯹r\xea\xa6!s\x1f\xa64LES\xfb\xba\xafw\xbd\xee\xbe\xfb\x8f\xbe\xf7\xc5\xdf\xfc\xf6\xc3Ͻ\x1d\xdb\xd6\xef\xda4\x8a\x9e\xe1w~\xe7;\xff\xf7\xff\xf5/\u007f\xfc\xf8\xe6\xd1y\xf3\xf6\x83\n\x94\x97\x9d|\xe5\xed\xf5\xf4\xa0\xcd9_\xbdy\u007fy\xbepwMY\x93q\xa0a\xf2\xff𣗍˛\x8f\x1e8˰\x1f.\xd6g\xeb\xe5\x02n_y\xeb\x8d_\xfe\xdc[\x8f?\xfd\xf4O\xff\xf4\ac\x1a\x8b\xb7\xeb\xae\xe0\xfd\xc6\x00\x00\x17\xc9IDATD\x91^\x95DEx<\xb9\xad\xaa\xe0\x0f0\x93\x10\xce\u05eb\xf3պm\x9b\xa6[\xb0D\x142\xed$ޙj(\xd9=\x8a,%\xdc2\x1cȦ8t\xee>kљU\xbc\xfd8\xed\xc7d柕v]-\x9bo|\xf5\x17\u007f\xe5\x9b_{\xf4K_\xe6\x10\xfc\x8e\x84\xb7\x12\xb8\xee\xf6\xcdo\xfd\xf2\xf7\xfe\xdeo\xfe\x8b\xff\xe3\x0f~\xfa\xb4\xbfX\xc7U[\xa3#3\xba\xae\x89\xddz\xb9\\\xb8\xea\xb4ל\x063\xdf\xf4\xf6\x83\x1f]\xefn\xc7\xdf\xff\xee\xd7\x1f<x8\xee\xf6\xe7g\xeb{\xab\xd5\xdbW\xe7\x0f\xceV\x9f\xbf\u007f\xb5\xdd\xde\xfe\x9b\u007f\xf3G\x9b\xcdM\xe5\x17O,\x94~2\x9d\"\xc3\xcc3y\flM\xe0Fګ{\x17\xcbn\xd1\xc4\x10B\fMWz\a\x85\x96š\x85\xe9\x16@\x04!a\\t\xedM?\xf6\xa6\x9a\r\xc0iFzͼcN\xbba\x1a\xc6d\xfe\xfa\x86\n\xc2\xef\\\x9d?\xba\xb7n\x9b\xee\xe6\xe3'\x0f\xbe\x99B\xdb\xd5f\xf0k}^\x80\xc1\xff\xcd\u007f\xfbO\u007f\xf6\xd3\x0f\xfe\xec\xdf\xfd\xf0/?ܾ\xf7\xce\xeab\xc1E\xfe\x18\xbb M\x1c\xfb1O\xc9rNJO\xae\xc7\x1f~\xb4\x85\xd2\xef\xfc\xd2\xdb_\xff\xc5ϧ~\x98n\xb7\xe7\xcb\xe5;\x0f\xae~\xe1\xc1\xbdGg\xeb4\xf4?\xf8\xe3\u007f\xfd\xf4\xe9\x13f\xaeN\x12\xcc\xf6\xca\x13\x11\x03\x98U<ƶ\xeb\xda\xf5zy\xd6t\x17\xeb\xb3жml\x82\x84\xd8v,\xa1\x16\x0f\x98C{\r\xc2.\xff\xfc\xbb\xbf\xe4\xe6S\xca\xc5x\xb5M)\xab\xfd\\\x117ͧo\xca\xda\xf7\x93\xf9\xeb\xdbΜ\xbe\xf6\xb97\u07b8\xbc\bM\xe7,/\x9e<\u007f\xf9\xe4E\xec\xdan\xbd\x8c]\xfbZ3v\xdcM\xa9\x1f\x9a\x18\x1eܿ\xf8\xeb\x1f\xbf\xffӏ^=\xbb\x1d\x96\x8d\xacZq\x90\x1a\x86>\xed\xfba?\xe4\xdb]\xfa\xd1\xe3\xfdG\xcf\xc6w\xafV\xbf\xf1\xb9\xfb_\xfb\xc2\x17\x1b\x84\x90\xec\xd1\xd9\xf9\xbb\x0f\xef\xff\xc2\xd5\xe5\xd5z1\xf6\xfb\xef\u007f\xff\xff\xfd\xe0\xfd\xf7\xf9\xd4\xc2\u007fp\x18\xf81l%P\x8at\xbeZ=|p\xff\xe2\xfc\xde\xfd\x8b\x8bE\xb7h\x9a\xd8\xc4\x10\x9a.tK\x0ea\xeeJ\x1f/\xdb5\x93\x99\xfc\xf3\xdf\xfc\x9a\x13M\x93\xf6\xe3\xb4\x1b\xf3\xcd8\x16\x8d՝f\xff\\}\xa8ZR\u05ec\x05L\x9d\xfe\x8c)\xff\xee\xb7~\xf9\xb7\u007f嗳\xe1\xe2\xfc<\x13?{y\xfd\xf8g\x1f~\xfc\x9f~\xbc\xbb\xbeY_\x9c\xc5&J\b\xcc\xc8c\xee_l6O_N\x9b\xfd\xb0ٮ\xbaţˋ\xf7?|\xfc\xe4\xf9\xee\xf965-\a\xc64\xe9n\xcc/o\xd3_}\xbc\xff\xd9\xe3\xf1\xbck\xbf\xf5\xa5\xab/^\x9e\xbf\xf3\xe8\xede\xe8\ue75d\xbfs\xffỗ\xe7o_\x9d\x9fw\xcd\xd0\xef\xff\xf5\x1f\xfd\xab\x0f?\xf8\xe0\xb3r4\xdc\x1d}\x02恙\x9b\xe6\x8d\aW\xf7\xaf./V\xeb\xb6m\x8b\xa6\x94E\x9a\xc5J\x9a\xa6\xa2Ѓ\x83\xa5\x9c\x99\"%\xfc\x93\xff\xf1\x9f\xa8\xf9v\u07ff\xbc\xd9<\xb9پ\u007f\xb3SӹMU1\x06\x11\x8c<\xabe\xb5\x94\xcd\xcc\xfa)\xf9\x89i] \u007f\xfbW\xbf\xf4\xcf\xfe\xfe\xef\xacVWNnf\x19\xe1g/_\xfd\xe5O?\xf8\xf8\xd9s%\xe3\xb6]]\xdd[^\x9c\xaf./\xba\xe5\x92\xc1e\xfe\x86\xe6ISZ\xac\x96\xcfw\xc3\x1f\xfc\xcb\x1f\xfc\xe4\xfd\x8fr\xde/[I)\r#\xa9R\x1b\xe2\xd7\xdfY\u007f\xf1\xd1E\xcbqݮ\xef-V\x8f..\xde~\xf8\xf0ޢ[6܄0\x8e\xc3\x0f\xfe\xf8\x8f~\xf2㿮\xb9\xcf\u007f\xae\xcfo\x0e\x14»&\xdc\u007fp\xf9\xf6\x83{\xf7\xcfϗAX\x828\x11Q\xec\x96\xcdr\xc5!\x1e\xc4\xdc\xe5\x10\x9b\x99\xbbYJn9\x10\x15\xfbuAG\b\x8c\xc8\x01D\xc9,\xa9\x06f\x06\x841e\x9bL\x0f\b\xea\x10\xfb\xb3\xdaz\xd1\xfe\xeew~\xe5;_\xff\xd2\xfa\xf2Q\xd3-g\x8d\x8e\u007f\xfd\xf2\xea\xbdo\xfc\xda\xd6\xf2\x93W/~\xf8\xd7?z\xfcɧ\x1f\u007f\xfcW\xfdгH\xbbZ\xac\xcf\xef-V\xab\x10\x82\x04\xd1ˋGW\xf7\xfe\xeb\xef}\xe7\xf1\x93/|\xf4\xe4\xe9\xb3盜sdi<-#.W\x8b\xe8\xb4\b\xf2ƽ\xb3w\x1f<x\xe7\xea\xfe\xba\xeb\x98\\D\xb6\xdb\xed\x9f\xfc\xe0\x8f\xde\xff\xe0gM\xdb\x014\x8d\xe3]lu\xa77\x04\x11\r\xbc:_\u07ff\xbcw\xbeX-b\xe0y\n\x06XB\x8c\xa8\xf2\x90CKz\xb6\xbd\xab\x96\xdd\x19\xcc=M\xb9Lt\xc9n\xa5\xf9\xeeT:I\x95\xdc\x11P\xe1\xff\xd2a\x02\nȍƜ\xbf\xfc\xce\xc3\u007f\xfa;\xdf~\xe3\xc1\x15;\x85\xa6=\xaa\xea\xd5-\x1b\x19]\xac\xd7\xe7\x0f\xaf\xbe\xf0\xf5\xaf\x8e\xa6\xdb\xed~\x9f\xc6)%#\xac\xba\x8bn\xb5\x06\xf18\xf4\xe4\x9eƱ\xef\xf7ggW\x8f\xdex;\xbd\xdcڐo\xb7\xb7y\x1a\\\xd3/\xbc\xfbv\xdam\xde8[}\xfe\xe1պm\xdb\x18ݝ\x88\xb7\x9b\xdb\xef\xff\xd1\xff\xf7\xecɓ\xf5\xd9Y\x88Qs\x16\x91)Mc?\xfc\xbcՂ\x884\xab\xd5\xfa\xfe\x83\xcb\xf5\xeal\xd9\x15b\x94\v=#\x01!\xa2\xea8\x9dP\x05uD\xe4\x96g\xf1\x1a\x82&\xd5l\xa6\x9eԲ*\x9f\x18\x88\"Ag}\x9a0E\x91\xfd\x98\xca\xe9T\xa5\xf3e\xf3ݯ\u007f巿\xf5Ͷ[\xa8:\x17\x82\xa2V$n\xcc\xdeo\x87\xa1o\xd3*\xa7\xb5\x9d\xafê\xbd\\vo\"J\x8c\tlΠ`\x19\xf1\xdci\x9a\xa0\x96m\xbf\xd9n7\xdb\xddp\xb6Ť\xa4S4K\xc3~յݛ\x0fλF\x18nVt2\xfba\xffg\u007f\xfe\xa7\u05ef^\xae/Ι\xd9\xddC\b\xe4\x05TK\xbf\u07fd\xbeV̡kWo<xpyv\u07b5Q\x84\x0f\x92m\x82\x84\xc0\x1c\xaa\x06\x9f\x0e\x85\x96\x95\xbe[\xf5Je\n\x0e\"\xc0\x8cvS6\xbfkh-\x98w\xde\xc6\x02\x80QH\xf7\xcf=\\\xff\xbd\xbf\xf5\xa5\xf7\xbe\xf4\xc5vq\xeeN\xc4&\x12ơ\x1f\xa7\xfd0\f\xa6)\xabYJ4\xe6\xb7>\xf7\x8b\xdd~k\xb7\x9d\x9d\xad\\\xc2h0i3XB\f\bd\xa4\u0096'\x1f\a\xca\xfb\xe50.\xf3$\x9d{\xd0\xc0\x81\x01\xd7\b\"\x16&P\xe9E:\xf9v\xb7\xfb\x8b\xbf\xf8w/_\xbdX\x9f_\x14:\xaeė\x10$go\x9b\x86ȇ\xbe?\xc4/\x06\x9a\xae\r\x17g\x97\xf7/\xeeum#\x05D\xd5\xfe$\x9886\x1c\x02*u\xceu:Da\aJ\t`N\xc5\xe8\x04\x82\x91OI\xabs\xfa0\x8e\x8b\x9c\x01/R0'\xe1Ҍ\xe2\xf7\u07be\xf7k_|\xeb\xd1\xfd{\x840\xa5\xd1L͜\x95\x1f\u007f\xfa35%B\xbf\xb9\xd1)\t\v3\xe7i\\.\x16\x942\xbd\xba%\x82\xc3\f\xa2,\x04\xe6b\xbfr\a9\\\x8b\r\xddܙ\xd9\x19\x80\x9b\x1a\x13\xcc\xcc\x05\x90(\xeen\xfa\xecٓ\xbf\xfa\xd1_\xedv\xdb\xe5\xd9\x19\x03f:M\x93\x99\xc1\xe1\xee,\x92-5M\xe3\xeeCߗ\xbb\x1ebl\xef]\xac\x1f\\]\xb4m+\"\xd5\aP7\x86\xc4&4m\xe5\xacJ\x9b\xb5VuZAA9\x8fd\xc1K\x85l\xa4n p\x85\xa3\xc0\xc1\xe9B\x0ep \x87\xd0\xf9\xa2}x\xb9\xf8\xb5/\xbeq\xb6즜\xf7\xfb\xadH0\xf2 \xe2\x1e\xa6<\xa8j\x1e\x86i\x1c\x1b\t,\x1c\x85r\x1e\xdc3\x10*\xc03\x17d)\xa3>\xaa\xaf\xc7gݩ\x13X\xe0\xd5Xȁ}rw\tbY!⪟>\xf9\xe4G?\xfe\x91\x9av\xabUqk\b\x87\xb6\xe5i\x9c\xcc2\x00F 'լ3\xb8\x11\xe1\xee\xe2\xfc\xe2\xd1Ç\x97g\xcbP\xa4<\x06\xa0\xa8\xc4cۅ\xa6c\x96b5/\xa2^?L\xd3\xc3A\xc0\xe2n\x1e\x8a\xba\xd3f\xc7Ty\x97\xb2B%\x83\xf2\xc1\xc7\x06\xba:_~\xf5s\xf7\xcf\xd6˲\xef6ۛ\xd2Te\b9\x98\xbc\xba\xab\xdc\xdcݲ\x137\xec\xb9\xe4\"ͩZi\x80W\xaf^\x16\x19r!\x13\x85e\x16\xcas\xb1\xc7\v\x17\x06\x14\xc2A\x98\x9dh\xba\xbd\xfe\xe4\xf1'\x9f~\xfa\t\x18,B\x95&\xa8\xa2\x9d\xb6ks\x16\xf72\xf5\xcf\xfa1K\b\xaa\xeaf\xedbu\xf1֣\a\xf7\xef\xad+K7;߅c\xd3Ih\x10\x02\x95\x915\b\xe0\xf9\x16\xba\x82\x01繊6\xb8\x85y\xea\x8d\x1fF\xa60`u\n\x0e\xfc`/\x041\xf8\xcd\xcb\xf5\xa3\a\xe7`\xa6\x8a\xf2\xab\xe3DI\x8b\xbeG\x1c\x00i\xb6\x18\x83\x80\x971Z\x1a-O\x06{qs\xadU\x84l\xe34\xdeu_;\x1d\xac\xbc\xc0\xe9\x98\x1b\x06\x9b\xd9n\xb7\xddo\xb7\xfd\xd0c6ҟ\x9a\xca\xcbI\x881j\xce9y\xd1\x1biV3k\xda\xf6\xf2\x9dG\x0f\x1e\\\xaec`\x10\xbb\xc1\xe7\xdc\xd8t\x10\x81\xc8qfE\xb9PG\x91`\xfa\xc9\xe0\x8fb!\f\xb3\\\xe4\x8eH\x9e\xc1V\x1b\x84\xcc\x04fbf\x01\xee_\xae\x97gK@\xc6ݮ\xfa*\xad\x88\xe7\x18\xe5\x851\x10\x11\x8bS\bm\xb7\x88\xddr\xafY\xd2\xe8\xa4fe\xfa\x87g\xd5\x02a^\xf3\xf5\x1e\x1dǇ\xff\x03\xa6i\xdc\xdc\xde\x0eCOD!\x04S\xf3Ϡ\xf4\xa3\xab\x99%i\xeff\xae\x99`\x8be\xb7~t\xff\xe1\xdbo\x9cum\x00\xd8mv\xcf#6\x1dK\x90 \\\xb8c\x0eU\x8bAeX\x1a\xcf\x06DpQ7\x99\x93#\xf8\xd1v~\xd0\xf4\xf8l,\xa4B\xb9\x97\x93\f櫷\x1f]\xbe\xfdp\x1a\xa6\xa6k\xfb\xed^Svw6\x13\x11\xb0 \x04&\x06x\xbd蚦#'e\x98\xfb\x90z\xe6\xc5<\xb2ˁ;\x1e\xe8\xbf\xe9'\xe54\xec\xfbq\x1cT3\x01\xa5\xf3\u007fL@\xf4s\x86\x9d\x99f7Ӝ\x9b\xa6[\x9e\x85\xb0\xee\xee\xbf\xf5\xe6\xd9r\x15g\x99{ٴ\x1c\x1b\tq&\x97\x99c\x03\x16\xaa\xf3T*\xd9RU\x9b\xf5{\x16@塎\x8c#\x13\x86\xcd'\xb5\ncf\x88V\x1c\xfa\xcc薋n\xbd\x8a\x8b\x8e/\xcf\xcfR\x1e\xf6\x03\xa6l\xbb\xbd\x12$\xb4!F\xe1\xa2Tg'7\a\x01F\x96TY\xd3\xd1\xd4\xe33\xa9\xf67\x15%N\xfb\xfd\xb6\xe6\xfe\xa2\r1\x9d\xebv\u007f\xad6>l/W\xb5\x9c\x85\xa8=?\x0fm\xcb\vY_^\\\x9c_\xb4\a\x95Zm\rq\xdbu\xc2\x02f\x92\xc0\xa1e\xc8\xec\x03!\x94\x91\x15\f\x18f\rY\x15\xb8\x10!\xd4\xfaE)\xb2L\xd9\nJ`\x9fm\x00\xb3b\x17N\x00\v\xe0V\x94'\xce1.V\xe2\xa2\b\xad\xb9\xbbK\x9d\x02\xe7\x945\v\xa4N[#2\xf7\x94\a\"9ꑙH\xff\xe6\n\xceMs\x06\x83\xb4d\n;̱*!\xef\xb3V[3\x9b\xc6\xd1\xf2ԝ\xad\xda\xc5҃\xac.Vg\xe7\xe7\r\bE\xd0X{\xdbԴ\xad\x84\bb\x12\tݒE\xc8\xc8\xddȌ!ĮD\xb0\xe2\x81\x14\xb2\xc3d\t\"\xf2\xa0\xe6\xea\xa6\xeeM\x90ݔp'l\x80A\x02X\x99\xe1\x190\x8f`\xaa\xe9\x80\x18\xe6N\x0e\x11\x9e&\xc5\xeco.\xad\x80\x03[h\xb9\x8c\xbf\xb4 R\x0e!\x13\xec5_\xff\xa9\x9d\x81\xb9m\xbb\xbc\xdd\xe6\x9cm\xb6\x9c\xfb\x11Y\xdf\xf1j\x97͗\xd2\xe49w\x17\x17\x8b\xc5\xc2#7\xcb\xc5\xd9\xfa\xa2\r\x8c\x94\xcad,\x98\x13Sl\x9b\xd8.\xc1\xcc!\x86\xe59\x87\x06DeE\xc8r\xf5!\xb8\xbbk\x8d\x99,\xf3\xc8#\"B(\xc3r\x01\n`f\xcaV,Ge*\v¬\xe2\x063\x83]\xd5\xdcS\xb6\xe2Wg\xa2\xb0\x8cy\x93\xb3;\x04d\xb3\xe3\x85奈\xda\xfeqs\xad\x81\xc0\xca\xf0\xdeצ$\xb9\x9by\x9a\x8a\f8\x95ѫG\x9f\xe8\xcf?\xb7p7Se\xc6\xea\xea\xaaYw\n\x0fm8_\xad\xdb&@\x13\x1f\x86\xa1\x88Ħ\x89\xed\x82\x19\x1c\x9a\xb8<\x83\xc4zo\xa4\xe1\xe0f\xe2j\x963\x81\xfd\x8e\xcc{>\x05\xe6\xc1\xcc\xd5\xeaP\x8bE\x88\xdb)\x95\xb9Ie\x90\x88\x13\x18el\tb\fNl\xcaL\x02\x03\x81\xac\xc8\x1f\x9b\x06\xf0\x90-\x8fɝg_\xe0\xd1\u007f\x01r\x06\xd9!\xbf\x821\x1f\xf3:lT5\xe7\\\x1c\xf1\xaaV\x10\xf3\x8c*\xf17\x12/\x00\x13rN̴8;[\x9e\x9dQ\x941\x8f\xeb\xe5\xba[,\xd8r\x85\x04\x8c\x10\xa2H`\t,\xcc\x12\xc3\xfa\x1eK\x04\xa3\xf0\x01 \x80\x01e\"\xa5\xac\x87\x02\x17\x80\xab\x11s\x95\x04\x11\x85\x12q\x99\xd9\xe1m\x94A\xb3\x13\xb2\x1e\f?\x0eB\xb1ttm\f\xed\xc2)\b\x1d\xaaM7\x12\x8fќ\x89\x95\xac\xa7<\x1c\x86m\xf9a\xa4\xf3\xc9\xcc`wϚ\x9c\x1cF\xc38\x16\xdcX\xd4\x16D\x14\x037Q\x8a]\xa2\xef\x87)ۜ\xa7O&\xec\xcd\xfb\x81\x99\xbbn\x91b\bmӮ['\xcb6-\x97\x8b\xc5b) r%'\x88Ħa\x16'\x84\xd8Hl\xa4[slJ\xb8`\x02!2\x8c\xea\xf4V#\x06\xbcb\x8cB\xdbWO>\xb9\x17\xb943\x17\xd9.\x13\xce\xda\xe6\xb6O9\x98\xa9;(@\xc0u\xa4@\xdb4R\x9aG'\xf38Ņ\b\x93\xb2\x13<.\x85 \xb9\x9f\x91\\\x19\x85V\xf2\xf3\x9c\xd6r\xae\xb3\xf5\x84B\x88f\xe2\xb3W\x91\x80j=\xa7\x12\xd3M\xf7C\xd1\xdd\x028\x95\xf0A\x98YVgg\xcb\xe52\x93\x1a\xa5\xa2\xfdkB\xbbZ\x9dE\x0eȓ\x13$\x86\xa6i\t\\\xf8\x99\x10[\xeeV\xd2-\tRF\x10\x80\xcb`dq=i8\x94\xbbS\t\xfc\xaa4+\x83\x91\x03\x01\xc2\xd4H\f\x98\x9c}\x19DX6\x03\xef}r\x1cE\xfemS}Kw\x06\b\x13\x01$䍸:\x99\xc2\x11\x9c\x854\x81\x98\x19\x95\xdf b8\v\x9c\xc8\x1b\xae\x13\xa5ȝ \x86\xb2>\xb5\xb1蘇A!\xc4\x18\x83\xba\xa7#V\xaf\xf0W\x82\xf0\xeal\xbdX\xaf\x93%Mc9\xf416\xcb庉\x81\xd4\xdc-\xc4&6\xcdl\x16\x02\x98\xb9\xe9\xa4]\xccJ&\x80\x8f\nފ\xaaLO\xa6\xad\xf2a\xcex\x95\a\xb9\a&\x18\xa8\tҴ\xd1rV\xf7V$.\xbb(<\xe4l\x05\xa0\v7A\xdc]\xab\xfc\xb0@\x96y\xc8\x12\x1clℜ\xdcR\x99\x0eW\xef\x0f\x1fx\f*\x97\x04\x82\xdd\xf1M\x1f\rɅ\xa8:\xd4n\"\xdc4\xd1\t!ƶm!R\x86\x89\x02ܶm\xe8\xa2\xc3U'\tRL\xd2M\xbb\x8c\xb1)#*\xc3b\xd9Ɩ\xb8Xd\x89ܥYp\xb7\x9cM\xe0%o\v\xcdG\xac\xa8\xf3\xadr\xc0V\xd4^\xe5\xeeXV\x9f\x87%\x86\xaa\x8d\t\xb2l\xc2\xce\f\xb3\xa7\xad\x11):man[)XUǩ\xccC;\xac\x00\xcd\xfck\x11\x8ef\x90;K\x10\x98\x99ڡ5~B\x91\xd1\x1d\xeb\xdd\xc1St\xe8.\xccC\xa8\x00j\xbav\xb9Z\x87\xa6+\x9fRfu\xb3\xb0D&\xf1\x94\x13\x18Ll\xe4!\xc66v\x85Dj\x16\x9d\x84\x80\xfa͈\x9cX\x02w+\x14j\xa1\xb0Ve\xdc\xeb\xcc\x17\xb8\xd3qı\x13f\xf5/3O)\x1d\xb0T8\x1c\xd4ȡm\xac\x1f3\x91\x96>>ܢp\b(\v\xc7^\x06\xa0\xd6\xf1\xde|t\xf9V\x8d\x13\xca\x00g&u)\xc4\x03\x0e\xdfi\xee\a\x9f\x98\xdc\x0f_\x15\xa7\xe3\x95ˊ\x85 \"\x91D\xccP\f\xfd\x06s\x01\apd\"\x98k\xb1\xe8\x93Z\x13b\x88-G\x8e16\xb1\xc1\xb1`+3\xf1YV\xe7\x1c;\x94\xf1<u\xba\xe1Q\xd0W\xca\xcay\xb4\xd0<\x13\x11 +\xa3\x12\xf5P\xe4\x87j\xf7s2P\xe4`\x91\x9c\xdc\xe0\xd1]\x8d\xcd\t\x8eb\x8f\x03\x11\xbb\xb9\x9a\u05f9\xab\x8c\xb9Y[×\b\x98\xd9՜\xdd`N\x81\xb9X/q\xf7\xb9\x03\x9f\xad\fQ\xbdl\x90ȳ\xe0\x9d\xd4\\\xa9j\xa0\f\x14\x84c#\f!'s&qU%榉\xb1i\x9b\xa6\rAp\xf2\x00\tr\x92І\xf3\xab\xb0\\\x83\x03\x83='\xb7t2\x92\xebX4\x14\x9f\xb6fC\x9dbid\xe69\xa3r\xc3DDA\xe7\\Ā\x92\a\xe1\xa02\x992\xa8\x11I\xa6e\xa9\x9b A\x90\xc6!\x8d\xa3\xc8\xca\xeb\xbcK>\x85\x8aN\xe4\u0094\x95A\b\r\xe6q|ŷ9\x8f\xc0\xf7\xc3t\xa6\"&D\xc19e0\xc1\x01?1\xd4]a\x12\xa2\x80\x9c\x05\fA\x10\xae^,&.A&4ҵ\x8b\x18ۂ?\x0f\xbev\xb0\xc8\xe2<\xac\xefI\xbb \t\xb3\x1b\x00\xaeLy\xa2\xbb5\x93k.JF\x00\x87Xk9\xcf\xe5^\xcd\xeda\xb6\xaa\xb8q\x9d\xc0\xd9\x04\xc9\xe6f\xc6\\\xb4\xfae\x8a\xb6\x12G\xdd\xf7\xe3~ۭ\x97\x85\rf&;\xe8oQ\xbea\xb0\x9c˄ H 3\xb7\x842\xb0\xd0N*\xf3\xd9\x1fRG=\b\x1b\x9cCS\t$\x16\x12\b\x88\xad*\xaaj\x15\xe1\xf3@\x03&\"\x12\x0f\xedbQ\xa6\x8a\xccv\x9f\xaa>\xe7\xd8\xc9ٽЮ\xa5[!\xc4\xd9Bad\xb9H\xf8H\xa7#R(\xc3\x1a\xeaؿ\xaa\xe02u2=n<?\x1d\xea\xea\a\x83\x8f\x17\x89\xbd\xcd60\"\xc0A\xea\xca\xd9\xc6<\xed\xb6\xae\x0f\x99ͫi\x1b\x04;N,cA\xd3yNp#\x90\x84֤\x8d\"\xd3\xd4\xef\xf3&\x86\b\b;[\xd1G\af\x11\x04\xb1R\x02\x95\xd1kN^\xa0\xdfQR]\x82-ͳ[˔\a.s@\xe4\xe0\x1d\x9b\x0f\xb9\xc4e\xb8\xb8\xe2f)\x8b58P\xb9W \x88\xb8rE\xb9\xe0\xb2\x16^\x9e\xd6Rb\xd3,\xb7ubJ\x8ay\xe2\xf1\x81`\v\xc5xS\xdc\x1f\xc2P#wo\xa2\xa8\x99\xba\x97'd\x04A\x14.\x03S1\f\x96&^t\xa8\x1c\xcc\x01T\x97\x90\xe9&\x8dK\x8b<0\x9cC\f\xc4p0\xbb\xeb`!\x82#\x10\xc9\f\x04\x16\x96\xc0\xc4\xc4n\xc5\x18\x852\xec\xf9`n\xa9\xf3\xc6@L\xec\xe4\\̆!\xc4PjU>q\x9e\x96\x88\u038bus\xef\r\xee\x96\x1c[\xb0\x9c\x0e24\xcd\xf3\x9c5#\b\x91\x11\xb9\xe7D\xa6^\x9e\xbbQh\xafr\f\xec\xc8\xed\x1d\x86n\a;:\xdfk\xdf̔\x989\x04v\xad^\x93R\x140\x8bg\xc54\xe5\xddF\x82\x90\x04:\xa4;c\x9e\aM2(\xe5\x11\x96E\xa4\xd8LJNX,ϫIè\x04\x11\xe7\xf2\x90\x93bK\x82\xcc\xc3ig\xf6\xad\x1c\xecّY\xa6\xb4\xc5j\x1f.A\xee\xcedQ\xb7\xb0\xba\n\xf7\x1eI\xb7b\x89$|`\x9dݝ4\xa1L\xa8\xe2\xe3\x83\x10\\3̊/\xeb\x0e\xebc\n\xd4\xd9\xd5d\xe5\xe3\xcd݃\xbf\xfeD\xa1J\xa9\x96\xc1\x00\x87\xf1\xb8jT\x1dYS\x9eno\xba\xb3s\xb7\xa4\xe5\xe8\x80c\x88D\xce\x10s\rp\xc0\x11#*\x8b\xe8\b`?\x98 \xa1d$\xd5l\x8by\xaaG=h^t\xcf\xc7\xe7\xf8\xd0|C\x04\x1cb\xc3un\x84\x14\n\xd7\x0e\xf8\xcc,\x9c_5W\x9f㶅4\x85\xf6\x00\x0eV\xa2*Y\x98\a#\x17e\xff@y\xaa\b\xeb\xd0X\xa6y\x00B\xf9.\x98S\x90\x9f\bp\xe9t\xa8\x1f\x83\x8d\xb9\xf8ގ\xd3l\xea#D\xc6I\xb1\xd9ZV\x04\xe1\xf9\xd9\"\xaa\xb9f\r\x90\xab\x06\x91\xc2JV\xa9\x86\x11\x91\x97\xbeq\xe9M\xd5f\xa1S!\xbe\xcaxfX9isYp\xa0v\x19AB\x90z_\xeb\x04\x82:\xac\x9b\xcaf\tg\x0f\x9b\xfb\x9f\xe3\x18Ib\xb5\xc5\xf0\xd1\xdf\xeffd\x86Yi\r\x82{\xf2\x9cp\a\xc6\x1cz\x02:o\x18\xcc\x15\xbeWM\xe9\x11\xfc\xf8\x91\xe6*\xdaI\x9c\xb8\xe3\xcdQ\xacC\xe3\x90Ѥ\xbc\xef\xdb{\xf7\x9c\xac\xf0\x82\xe5keK\"<7\u058c\x8a\xdd\xead\xae\xf5a*\xe5<\xdcҁ\xa3d\x85\x88\xbd\x8c\x9c9\xd9\x13!D\x912\xa1\xd5g\x8b(Ϯfww\x8eMs\xf9\xa6\x9c=@,j!>\xb9\xed\xb3RY3\x15.\ru\xf2\x8c\xe7|\x18rq4\xda{}\x8eVU\xcd\x12L\xb5v\x81ɝ,\xcc\x0fVxm\xf4y\xa5\xa11?(\xca\xc8\xcd\r\x06w\xe8\xa8i\xb7\xeb\xce/\x1c\x88\x12BlJ\xd01\xd5\xec\xa9V\x19\U000fb19a\xaf\x18\xb3\x87\xb3<\x83\xa9>cȈ\x90O\x86\xcb\xd7-\x01'\t\x1cC\x03\x99\xd3l\x9d\x11uX)\"\"\xe9V\xf1\xf2-9\xbbD\x88\xf5\x19/\x9f\x91'\xbb\xea\xdc[\x9e\x01\xb4\xe6\xf9\xe1c\xa5A\u007f\xaam,\xdcHy\xd8@\xa5\xe0P\x12`\x19q~\x98RT L}\"A\xe5\x88K\xf4-o\x01s\x03#\xa5\xacS\xd2i\xe2\xa6\r\xf33\xa5\x88 \x91\x83\x05#\xad\x02N H\xa8\x00\xa8x\x81\xb9\xb2\x89L\xf0\xf2\xac,v\xa72\x8e\xc8Uݴ\x94\xd0\x10\t\"\x01D\xa4%!r\x9d\xea\xc1\xe5\x880B\f\xe7\xf7e}\xc9\xdd\x1a\x87\x19i\x9f\xed\xf88Q\xce\xe51*\aq8\x91\x91j\xd5\xc6\x1c\x9fts\xf8\xa3\x97\xe7=\xb9k\xedC\x95IHuN\xe9]\x18^ۖ\x84\xea\xc6.x\x04s\x8f\xdaɲ\xe51\xe9\xaeG\bu\x00\xf8<>3H D:bU\x9a\x1d\xc0\x98\x1fCt\x9cO\xecsuH\x0e\x12\x8e\x91\xcb3\xb4\xaa\xb5Ju\x16\xf0\xcc\x13ʙ9v\x88-\xbaUX\x9c!D\xc4vfZ\xee>%ȏ۪8\x1a\xeas\xa4\xdcɲ\xbbQm\xaf\xe9]\x86Z\x0f\n\xd2b\xf2\xae-\xf9ٹ\x14\x8e\x1c\xa6\xf9)\x13\x8c\xe3T^\x9e\xeb\xdcjvr\xf5\xa9\x1f\xf3\xbe\x97\x184\x86\xb0X\x1e\xa7 \x96\xe8\x8b2y\xc1\t$\xceN\xae\xf3t\x0e>}\x16H\x9dK'\x1c\x1b\"\xe1\x10\xc0\xa1h\x81\x0e*Ū\xba`\x06\vB\x03\x89\x88\x1d7]yJ\x15D\xe6\xd3w\xf7\x01/s\xd1\xeeV\x1e\vfU\xd5_ԁi\x9a\xf7\x9a\x9ft>\xec8\x1a\xab>\x8b\xccN\x86m\x93\x13\x05w\x87\x1dn\U000690b4\x92K`\x029\x9c\x8c\xc0\xeef\x0e\xf2q\x1c5\xa5\xbc\xef\x99Yc\xc3\xe5Ȕ\xf9e\x98\al\xd7I\x1d\x84BM\u0383\xed\t5\xf0\x83\x01i\xd14\xfc\xff\xd7uF\xcbq\x830\x14\xbdW\xc24ә\xee\xfe\xffg6\xe9$]#\xa4>\b\xb0\xbd\xdb<\xfa\xc93\x80\x85\x8c\xc49\x14'Eʨ\x8cH92,\x80[\xf6Z+@h\x91R\xd3\xc6H\xd5S\xe36\xff_UsC\xf4\xbc\x06\x99\xa1\x1df\xe8~jR\x1fŷ\xf5\x18ֳ\x99\xc7'\x15j\x8dh\x81'x\x1f\xa7n\x83\xab0mq\xb4'\xbf\xc9\x10\xf6hmo\xa5n\xfd\xf3\xef.\xbf\xeb\xfdN\xd5E\xde8\x84\x11\x19\xac\xd6Q\xc9\t\xfd$*\xd4M\xb6*\xa5\xe6A\xf1L\x8b\x95\xd3]&[\xa5V,\xb4\x8e(J\x19\xf4z\xea\x8b\xdc\xecu\xa4<l\xe7\bOc\x80\xbc?F~\xe6\xf1\xac\x13\x1c\x86'\a\x98\xd9\xf4\x05\x91\x1e(\"\x99\x05Ɠma\x82(\x82\x92?6\b\x88\x87!\xbcwZ\xb7\xbdٛ{o-\xde\xff\x10\xac\xb7\x1bT\xa2\xcb\xc1\x89\rR(1\xb2\x8bX\xa7\xb5\xb1\xfa07d=j\x11\xefdV\x1e)\xb2\xfd\x80(\a\xc7F(I\xfeH\x18\x17\xaf\xc0\xffW\x03\x0e\x81\x80\xed\xb2\x02f~\x88\xb6c\x8d\xddE\xd1\xc8\xf4\xc7d\xb3Q̻5\xb9r\xfcɎ\xc2\xe0:9\xc1w\xf3\xc55\x03a\xcd\xed\xd1\xcc<\xc2\xc4#\xde?H\xd9~\xfd\f2th5\xe6\xcb$\x8e\xad\xe8L\xe5\x17\xa8jnd\xa2880\x04\xc8RA\x9dz\x0e\x82\xa0\xa4\xd6l\xa0\xb0@}\xc9\x15\xaewl\xad\x85\xb5\x8bU\xc9,\xf6\xaf\x11\xb9x*W\x1e\x8b*\xc6\x1fu\xae/.\xa6\xcbؾ\xfe\x01v#\xf6o\x84B\xf6\x02\x00\x00\x00\x00IEND\xaeB`\x82")
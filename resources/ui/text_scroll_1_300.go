@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Text_scroll_1_300 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01,\x00\x00\x00B\b\x06\x00\x00\x00\xed\xf4\xeb\xeb\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x1467\fh\xcd\xcd\x00\x00 \x00IDATx\xda\xed}y\x9c\\U\x99\xf6\xf3\x9eso-ݝt\x12\x12B@qt !\xac.3\"\xe88\xe8\aBX\x06\xe4S\x8c\x02\x12\x10Y\x87Q\x96@\x16\x16Y\x02\tAq\xd0a_U\x16\x17dQv\x9cQ\xc7\x0fDg\x10\x94-\xc82\x0e\x10 !KwuU\xdd\xed\x9c\xf3\xfdq\x96{oUuwu\xa7\xb3a\x1d~E\xba\xbb\xb6\xbb\x9c\xf3\x9cwy\xde\xe7%lBc\xd5ﯝ\xe9\xf9ޝ\x8ch&\x00\x95yJ\x01 \xc0\xfd?\xffl\xe3 \xfd<\x91~\xb1RJ)\xfdC\xabW+\x05\xa8\x96\xcf\x11\x81\xf4\xa7\x91\xb2ߪ\x00\xa5$\x18cd?\xbfݡ\xecQ+\xfb\x8f\x82l\xf8^\x02\xe5ޡ\x14 \x95\x82R\xb9\xb7f\x0fq\x98\v\xd1z0F`\xa4ώ\xb2\xaf'\x02#s$\xfa`\b\x9b\xc7X\x97\xe3T\xa3|\xceͯ\xec\xa5\x1d١\xaaƉ\x98\xbf%fH\x99\xf9\x12\xa5 \xa5R\xe6v\x81\x88\xc0\x19\x81\x88\rw\x9c-\x0f\xa4q\x1e+\x05\x99N\xd5\xf4_\xa5\xa0\xa2(\x16D\xf4\xb3z\xadz\x041\x1en\xbb\xd7\xd77\x9b\x9b<\xa6\xe3\xe5G/\xa3b\xb1\xf8\xac\xef{3\x19c \"\x10\x01J\xdaE\xaa\x17\xb7\x10B_@\tH\xc8\xcc\xe2\xd77O)@J\x01)\xf5\xef\xe5r\t\x9cqp\xaeo\xa8\xbe7\nBJ\x90\x99[\x8c\x01\x8cqs\xf3\xb3S)\x83\x8e\x8a\xa0\x94\x84\x94\xfa\x18\x18\xe7\xe0,\xb3\x9ei\xf8\xa9N\xb4\x89\xdcZ\x1a\x9b\x9bOC\xacf{/6\xe8\x19\x1b\xa0UJAJi\xe6\x82\xd2\u007f\xb7\x9b\x97A\x14\xd5x\x1eJ\x838\x11\x811j\x02\x92\xf51Zm\xa2\x06\x18 \xa5\x84\x90\x12q\x9c@\b\xe9\xe6=\x00$I\x82 \b\x9a\x80\x88s\x9enB\x94\xcew\xdf\xf3\xc0=\x0e\xdf\xf3\xe0y\x1c\x8cqw\xae\xf9\x9bEm\xcdQ\xa9\xaf\xef\xf9\x13?|\xec764Nx\x9b\n`\xbd\xb3r5\x8a\xa5\x02\x9f:uK\b\x91\x00\x00\xea\xb5:֮\xed\x83\x10\x02R\xe9\x9b[*\x97\xd0U.\x03JA\xff\x97\x99[\x04\xf8\xbe\x9f\x9bhB\b\x88D\xb4\xdem(\xbf\xbb\xb4\xd8ir\xbf\xd8_\xeb\xb5\x1a\x18\xe7\xe8\xe9\xe9\x86R\n#\xb5\xb4\x1a_O\f 0cٴ\x06\x1d\"\x801f&$\x81H5-\xba\xe6\xe5@\x83\xdb\tf\x8e*\xa9\xcc\x02W\x0e\xa2\x95\x9b\x98ښTz\xebw\u007fWm\xae`{\x9e\x94\xff\x9f5\u007f\a\aL\xbd?4=\xaf2\xa7Ey\xeb\x03a\x185\xbdڂP;М='\xbbY毿^\xe0\xf9?\xeb\xfb\xe0y\x1e<\xaeA@\r\x85N\x94\x01y\x03LBH$B\xb8{ \xa54`\xab\x06\xbd\xceRʖ\xd7YJ\t\xd9\xe2\xf5\xf6\xda(\vJ\xe6\xfa\xe7\u038b\x00(B\"\x04굚\xfb\xbb\xe7y\xe8\xe9\xe9Fww\x19~\xa1\x00( \x11\t\xa00yc\xe0\xc4&\x01X\xdf\xf9\xda!\xd8\xfd\x8b\x17\xab\x97\x1e\xf9\xe6\v\x80\x9an/\xe4\xea5}\x88\xa3\xfcDdD\x99\x1bi}?3\vT\xab\x9b\xab\xf4\"o\xb1\x98\x88\xb1\xa6\x1d%k\x02\x0f\xb6\xbb\x12c\xb9\x1do\xc4;k\xe3\xae*\x00@@\xb4\xf5ޑ[j\rkϝ\xd3h>k4\xe7\xa9\xf2\xffk\xd7\xd3\x1a\xd1`\x8c5YZy\xe0V\xceRo\xb5!\xe5\xafCs\x84@\x03z\xeb\x11\x86\x89\xfb\x8c\x91_Oj\xb2F-X\xa4\xc7)\xc7\xc4\xd2K?\x93\x1a,:\x17\xf0@\x1cE\x88\xe3$\av\x03\x03U(\xa5\xe0\xfb>\xb6\xdez+\xc4q\f\x05<\xf6\xbf\xbf\xfc66\xb4K\xc86\x05\xc0:\xe5_\xef\xc6\x19\a\xef>\xf9\xdf\x1f}\xe2S\x9e\xe7\xb9\x18K\x1c\xc7-w\x8b\x96\x16\r\xb5\xde}\x1a\u007f\x1fƧw\x93\xc5.\x00\xa2\xe6\ac\f0\xd6\xdb\x06\x0fԌ\x02`\x94\x8d\x8f\xa8\xa1\x16\xe9\xe6=\x06\xb7r)\a\f\xa9E\xa1]\xbf\xf4\xc1\xa0C\x11\xd9G\xc6\xfa\x18\xe4^\x10\x01\x9c\x138'\xe7f\x8d\xec\xd1\u07b9e\x8f\x97s\x0e\xdf\xf7Flٷ3D\x8b\xf5b\xbf\xbfT.A*\x89\x15+V\x82\x18\xfdrC\x83\xd5&\x01Xs\x0f\xf9\x18\xe6\u007fnϭ\xfb\xfb\xabo<\xf5\x87e\xe3|\xdf\xcb\xfb,\r#\x8e\xe3\x1c\b5:\x16#\x01\xacᶬ쮝\x1d\x9cs\x10\x11\x92$\x19\x91\x8b\xd4\x19\xebq\"3Zo@H\xc4\x1c(e\x1f\x16@4p4>\xd6\xdfF$\xa5ԁv>\x86\xcb\xd7La\x99\f\xbe\t\x17|\x1f+W\xac\x82\x94j\xcd\a>}\xda\xf2\x8dr\x9f7\xe6$[\xf0\xf9O`ܸ\x9e\xf7\xf6\xf5U\xff\xc4\x18+\xf4\xad\xa9b\xf5\xea\xbe\f\b\xb4\x06\x02\v\x14Y7\xd1\ue430\x99\x16kV\xab\x91\xb9g\x8d\xbb\xf5`\xd6\x1c1j\x19\x1b댍fc\xb5\\\x81y븵\x95\x93\x05\x9a\xe6\xe7\xd6\xe5xF\x0f`\xc3\xcd\xd9FkyL\xae \x03\x92A\xbc\x06\"\xc2ڵ}\x88t\x88\xe6\u038d\xb61m\xcc)V\xea*M\\\xb9r\xf5\x93\x04\x9a\b\x00Œ\x8f\x97\xff\xfc\xfa\x90\x06\x10c\f\x89\x10:\xd0\xe9\xeb\a\xf7<\x9d!\xe1\f\xdc\xe3P\xd2f\x89$\x84\x14Mԁvb\x13\x94\tJ6\xbfI\xc7Ų\xd6[\xc7\xca\xda\xd8.a+P\x1a>\x1e\xb8\xa1n[;\xe0\xd7\xfa%\xf9\x03\x14B\xb6\xe7=\x8cb$\xb1\x18r\x1eg\x9e\xbbgc\xddg\xbe1\xbe\xf4\xbc\xc3\xf7§?\xb4݄\xd5+\xd7,W@\xafR\x8a\x14\x148\xe7\xe8\xea.a\xfa\x8c\xf7\x81\x88\xb0f\xf5\xda܍\xf6}\x1f\x13&\xf6\xc2\xf7<\x10#\xb0\x06މ{\xad\x02\xaa\xd5\x1a\xc20B\x14F\x0e\xd0ZM\x1a\x1b\x9fm\xca\xdc\xe5b\x1d\fI\"\x10\xc71\x92$\xc9\x05\x93\xfd\x82\xef\xdcRz7\x05\x85\xdeUq\xac\xd6`\xd5\xf8\xf2V\xf1\xcaᬳ\xa1\x1eY\x8bm\xccbL\"q@5\xd61\xd4 \b\x86L.X\x9c\x04p\xee\x14\x865\xbfy\xee\xb5w\xbf\x85u\xe1\x97\xf7Ɩ[M\xee\xed_[y\x9eqV&3#,\xf7j\xc5[\xab\x9co\xdex\xa3\xfd\x82\xefPF\ni\xd2\xc4\xcd@\x03ÿ\xf28\a\xa0P\xab\xd6Z\xecU\xc3[Y9\xff\xbd࣫\xab\x8c\xae\xae2\x8a\xa5\"\xb8\xc7\xc1\b\b\xeaa\a)6C\xc0\xcaR\x90F\x97\xdd\x1b\xfbю\xb5\xa7\xc1\x8a\xc6\u03a27T\v!%\x92\xb6B\x1c\xc4\xee\xbd\xeb?\xefY\xfc\x93\xff\x87K\x8e\xf9\xcc\x06\xbfF\x1b\x9c\xd60q\xf2\xb8\xf2_^y\xe3\xbf\x00\xb5U\xea\xe6\x11\xa4\xd2>\xf4\xdb+V\xc3\xf7}DQ\f\xceyn\x17\x89\xa2\b\xe5rɀ\x8f\x82\x92\n\xc4\b\xa4\bYV\x92\x0eHr\x94\xcbe\b)\x10'\x89\xa67\xb0\xc1'\xb5\x94r\xd0 {\xe3\xa2\xe0\x9ck\x8b\xadTj\x8a\xa7u\xc6_\xdfh\x87\x8b\xd7\x0e\xc0\fG\xb6\xcd\xf2\xb3\xd6ɺ\"\x82\x12\xd2x\r\x11\xa2(n\x1b\xf0\xeb\xf5\x00a\x94\xec|֡{>>\xffƇ\xf7xW[X\x97\x1c\xb3o\xd7\xeb\u007fY\xf1\x17\x80\xb6\xb3_͈\x8c{\xa7M\xe7Z5\xc0\xaa\xd5}PJ\xa1P\xf0\x1b|\xec$g&%\x86`j-\xad\xackf\x8bj8\xe3(\x16\n\x8e\x8c:X<\xab\xf1\xcf\xedL0\xa5\x94s5\x89\xa8\x13\xc7\xda̬\xac\xb1s\xd3\x04\x82z\x80(J3\xd8c3\x15tp=\x8e\x13\x84a\x88(\x8aL\xdcM\x8dz\xae\t!P鯠\xaf\xaf\x82Z\xad\x868N\xdav[\x19c\xf8\x9fW\xdf\x04#@)\xf5\xb1\x05\x9f\xffĲ\xcb\xff\xf9 \xef]\aXK\x8f\x9b\x05\xa5\x9e\xa5\x81j\xfdyF4\x05\xd0\xe5\x12\xc4\xc8Ţl\x96\xcf\xf7=\xfc῞\xd7|'\xde\x1cb[\xb3fm\xfe\x06X3֕\x9b4ԝ\x98\xf8\x83\x10\xc2şTS\xfd\x1eZ\xc6\x04F3)\xec\xe77>6\xe6\xee?\x96ǰ\xa9\x9d_+\xc0J9U\x1b\x06\xbc<\xcf\xd3a\x02\xc6\x10G1\x82z\x800\f5Ȅ\x91\xb1\u0095\vW\f\xe5\x12Z\xa6\xbb~42\xdf1*\xeb*I\x12Ԫu\xf4\xf7U0P\xa9B\n9*\x17\x98\x88\xf0\xca+\xcbM\xa5\x05\x00\x85\xe9\xab\xdf\xe9\xff-\x00\\q\xca\xc1\x1b\xe4Zo\x90\xa0\xfb#\xff\xfd\x12\xf0\xe7瞐R\xed(\xa5\xb4e\x95\xf9r\a\x83\x1c\x8c1T*5\xfc\xddGw\xd27=\b\x9b\x16\x8c\xef\xfb.Ε\xa30\xd0\xe0\xbb-\x99\xac\x9e]\\\x8c\xb1A\xe7N\xab\x00\xfc\xba\xc6%ҝ1\xfdYJ\xe5\xe8\x1bYs\xbf\x1d0\xc8\xee\xb4C=\x06\a\x1bj8\x1e\x98\xbf\xc9!>\x8fZ\xba.i<h\xd3J:\f\x16\f\xb7V\xd0X\x1f/1\x9d\xa5\xf6\xb8\x97\x99s\nQ\x18!\xa8\xeb\xb9\x1cG\xba6PI\xc3\xc0W\xae6o\xd0\xeb\x1e\x19\xf6\xb9\x10bD\x99\xc1(\x8c\xb5\v\x17Dc\x92QL\x84\xc0\xd3\u007fx\t\x9e\xc7\\\xa15c\xb4\xf5\xa7?\xf8\x81\xbf?\xeb\xfa\ao{Wİ\xae\x9e\xfbyo\xe5۫\x9fN\x12\xb9#\xa0\x90\x88\xc4YTR\x9a\xb2\x1a\xa6M=\x1bǪ\xf4W\x91D\t\x8a\xc5b\xcb\xf8@\xdf\xda>L\x9e\xb2E\xce\xcce\xba \xcfYY\xca\xf1pRW\xcdNV)%\x928q\x16\x1d\x91\xae\x01\xd3\vQ5}g{\xee!r\x80\xb8\xdeLb6V\x99'\x89\xd6ܥ\xa1J\x9am\xc0\xb7\xf9\xdc7\xc5\x04i+\xc0\x1e)P\xb5{\xef\xd3\xeb\x97n\x88\x00\xc09\x83\xe7\xe9Xl\x9cĐ\x89D\x14\xa5\x00\xa2Y\xeb>\x18g\x83\xc6P\xd9\bK\xc1\x928A\xd5$\x9ar.\xc4:\x8ej%\x80\xefq0b\xdaғ\x12\xbeϡ\x94:\xe0£\xf6~d\x9f\xbd?\xf8\x99\x8f\x1dy\xd9z]\x00\xeb\xcd%\xbc\xee\xac\xc3\x00\x00\xab\xdf\xe9{\x1e\xa0\x1da*\xe6=\xcf3'+\xc09ˑ>\xb9+\x8d\x00\xe2D\xa0T*\xb5\xdc\x19\x88\b\x95\xfeJ\xeeoq\x12\xbb\xcca\xbb\xf7HJ\x89\x81\x81*\xfa+\x15\fT\x06000\x80Z\xad\x8e(\x8atѴydM\xf4\xc1vA!\x84\xab\xf9BN\x92\xa3=\xb7i\xb0\xd74\u007f\x8f\r\x96&H\x92d\x1dwN\xb5^\x01\"{\xfcY\xd7f\xb4\x96jc\x81\xf0p\x8fѺ\xad\xa3suUFi\xc1\xfe\xac\xef\x95e\xa6\x17\xfc\x82.\xde\xef\xeeBWw\x17\xfc\x82\x0f)%\xc20D\xbdVGu\xa0\x8a\xa0ެ\xc20l2\xc8\xe0d\x12'\xe8\xef\xab\xe4\xc1j\fG\xa5R\x05\xe7\x1cR\n((x\x1e7F\x06\x01\n{\xff\xc7/\x9fy\x18\x00n>\xe7\xf0\xcd\xcb%\xfc\xb7\xd3\x0e\xc5\t\x97\xfd\x04K\xbe:\xeb\x17R\xa9\x8fd\xeb\xee\x9cF\x95\xc9\xf21f,#J\xdd\r)\x15\xb6\x9f\xb1-&L\x18\x87\xfe\xfeJ\xcbu%\x84\x04#\x82\xe7\xa7F\xa2&\x8c\xea\xbb\xc7\x06a\xab\xe7~6<\x19\xa7\xfb`ܤ$I\x10\x86\x11\xe28\x06\x81\x9cɮAκr\xd8$\xe28*\xe7N\x8c\xc65\x1b\xe9\xf6Km,\xda\xe1\xaf\xc9`\x16l+\x979}4\xbb\xa1\x1b\xee:\xb7\x0f\xfc)H\x0f}\xfe\xdc\xe3\xf9\n\r\xf3\xbe$\xd2\xee_\x12'\x8e\xfb7\xa4\xeb\x17\x19\u05efI\xb1\xc280\x8c\xad\xf3\xfc\xe4\x9c\xe1\xcf/\xbe\x8e5\xab\xfb\xf3\x99rs#\xb4\xa5\x85\x0f\xec\xf7\xf7\xd3?p\xf2\xe5?\xbd\xfb\xaa3\x0e\xc5}\x8f=\xbfy\x00\xd6\xfd\x8f?\x8f\xa5\xc7\xef\xff\x80Rj\x1f\x02!N\x84\x9bd\xbax\x98\\x\\*\tf\vMY\xfe\x02m?}[\r\x1cQ\xdc\xf2{\xe28F\xa9T\xccO~\xc00\xdc\xe5\xd0lukbR\xc3ͤ\xfc\x82P\xc8\t\x01\xb6܁\x1b\xa5I6v\x80=\xb5d\xd6\x17\xa1\x95\xd6\xd9J\xcb\x03\xd2hX\xe7j\x83]\xeb\xe1\xc0*\x1b\x10\x1f\x89\x15Ɉ!\fC\x9d\xfdn\xd3\xe2n\x04\xaa\x81\x81\xaaVOh\xf1Z\xce\x19\xfcR\x11\xdd]e$I\xd2\x16mg\xb0Q(x\xf8\xddo\x9f\x83R\xc8\x15_\xf3L-\xa5\xefs\x80h\xb7\xfd>:c\xeb\xaf]q\xef\xcf\x17\x1f;\v\x8f>\xf9Ҧ\x0fX\xdf:\xe9\xa0_\x10h\x1f\xed\xaa%ڀb)\xf3\x17\xd0ţ\xd2Ŋ\xf4\xe4\xe5\x8c;Jªw\xd6b\x8fO|\x10a=@\x10\fN\xce\f\x83\x10\xe5\xee\xb2[GYYR)%\xa4\x11?\xcb\xc6}\x1a\xad,\x1dO\x93F\xed\x91\x1a\xa4XTN\xbda8\x00\xd8T\xc0\xab\x11\x18\xacUH\xd4\xfeq\x8dtS&\x1a\xfd{\xd7%\xb0\xbe\xa17\x04\xd5\x14$\x1f}i\x96\xef\xfb:\xf6.\xd3\xe4\x91-1\xb3\xe5g\x9cs\b)\x9a\x8c\xdb$\xd1!\v\xcf\xe3\xf0<\x1f\xc5b\x01\xa5R\t\xa5R\x11\xa5R\t\x85B\xc1\x90\xa7\x81B\xa1\x80BA?\x1fE\xf1\x88\x8f7\x8a\x12\xfc\xe9\xe9W\xe0\xf9\x1a\xac`\xd6\r1\x06Ι\x8e\x1b+\rl\x04\xfaȬ\xddw\xd8r\xee5\xf7\xdd\u007f\xc5)\a\xe3\x81\xdf-\xdb\xf4\x00\xeb\xbay\x87\xe1g\xbfy\x16W\xfc\xcb!?\x02\xe8@\"B,\x92\fH!W\xdfe\xf4\xf3\xf4N\xc3\x19x\x86\xc2@\x04\xd4\xea\x01v\xfb\xe0t\xd4\xeb\xc1\xa0&1\xe3\f\xbd\xe3\xc7g\x84\xda\U000a0555E\xb2J\xa1BHC\"e9\xd0\x1a\xca\xeb\xb11\x88\xc6`75\x89\xa0\xa5\x8c\xfdM\xb1L\xa7\x95H\x9c\x05\xb21\x8dΎ\x10p\x1a\x1f\xa3\x93i\x19\xbd\xacK\xaaV\x9b\xb7\x9a\x1b\xe3e\xeb-.\xc39\xfc\x82\x9f>|\xdf\xd5\xc6Zw\xaeU\xd0\xdd\xf38\x8a\xc5\"\x8a\xc5\"\xbcL\xf9\xd9ps\xafP\xf0\x11\x04\xe1\x88\xe6\xe8\x9b\xcb\xdf\xc1[o\xad\xd2B\x85\x9ce\xa4n\x98\x03Y+`X(x \xa2\xbf?`\x8f\x99\xe5\u007f\xb9\xe2\x9eG79\v\xeb\xe4\xbd\xff\x0e\xe7|\xef\x11\\yڡ7)\x85É\xe0D\xc0\xac$1e\xf4\x88,\x800\xc6\xc0\xbdT\xd7'\xeb\x122\"\xbc\xef}[#\f\x83A\xa5C\xca\xe52\xb8\xe7\xc1\x12\xec\x9a\n^\xa9y\x11j\x95M\xad\xf2\xe8&!i\xf3\xbc\xc9ʢ\x94\xd7\x03\x05p\x8f7\x01S\x16\xa06\xd7Z\xc2\xc6\xc0\xf8PA\xeb\xc1T4\xa8ŵ\xb6\xee\xffp\xc0\xd1\xf8\xc8[ij\x9d\x13\x06JZ\xf5\xd4|\x00\u007f0\x8b\xb29V\xb6qkE\xa5\x94cZ7h\xaf}2\x02\xb5\x91W_~\x13A\x10\x99\xfa]\xa5\x13f\x995\xc0\x18\x81\xf1T!\xc5\xd4\xfb~\xe2\xa0=w\xe2\xf7=\xfe\xfc\u007f\\?\u007f6\xee\xfd\xcd3\x1b\x1f\xb0\xae\x9b7\x1b\xe7|\xefa\\{\xe6\x17\xbe\xaf\x14\xbe\xaccKI\nN\xc6\xc5ktøgO\xd6N\bBF\xb9\x15\x8c\x18&N\x1a\x8f-\xb6\x18\x97\xabP\xcf\aޅ\x8eaY\x1b\xc9\xc8&\xe7\xbf\v\r\x92\xb6z\x91h.\x8cN1\xd7\rѯX,\x1a\xf5\ac\x8e\x9b]\xcb\xeev0\x01\xcca\xe5g\xdeeC\uf8ac\xc1\xfai\x052\xd4\x12\x04\x86\u007f\f\xc6!\x1b#\xb2\xeb\xa0\u007f\xa5&\xa5\xd1M\xc1\xfdl\x04S\xb1\xce\xd9\xe0\xc6u#Q\xab\xd5G\xf4\x9eW^~\x03I\"\f\x15\x88\xbb\xb0\x8e\xb5\xb2l\x96\xdf%ь\x05H\x8c\xfd\xe3g\xf7\xdaU\x1e{\xc9\x1d\xbf\xbe\xe9\xec\xc3qϯ\xff\xb4\xf1\x00\xeb\x8eEG\xe3\xa8\v~\x80\x1b\x17|\xf1\x1a%\xd5щ\x94H\x84\r\xa2#\x17\xb3\xd2\x13\x9b\xe9X\x165\xca\xc0RNG\x9b\f3\x91q\x86\x9dv\xd9n\xd04\xad.\x8dɻ\x93\xee\x86+\x95\x9b\u007f\r{\xbf\xbb\xb0\x03\x95*\xb8\xc7Q\xf0}\xc7-I\xad5j\xa1\xf3\xae\x06u\r\xdf݀\xd5Nfn\xb4\xd7a\xfd]\xbfT\xefj\xe8X];!\x9d\x8du\x9f\xb3\n!\xa3\xbd\x06BH\x04A\x1d\xf5z`5\xadF\xe4\xb2\xfe\xf9\xc5\xd7L\xc0]o^\xdc3\x00\xd5@Mb&\xa6\xc5\x18\x834듁>\xf5ٽv\xab\x1e\xb3\xe8\xb6\xc7\xd6\xf5Z\x8c:mp\xeb\xf9Ga\xf6\u009bp\xcb9G\\\x0e\xe08\xa9\x14\x94\x94\xe6`\xb5Jc\xfe\x048\x18\xa7\f\xdf*\x95\xa6\xd5H\x8d\x9c\x82#1\x86\xe5o\xac\xc0\xf8\xf1=C\x1e\xc7\xc0@\xb5\xed\x02\xe4\xc6\xe9\xc6\x18\xc7\xc4I\x13t\xa0\xb2\\F\xa9\\B\xa9T\x02g\xccĺ\xa4\xe1a\xe536\xeb\xca)\xda\xfc\\\xc6\xd1,\x92\xd1<\xc6&>\x95u{\xb2\x8b\xb6\x95$r\xf61\x16\x804\x929\xd1x̖Rc\xcb\xc8\xe28v\x9c\xc0v-ɬ]j\xe9:\xfd\xfd\xfd\xa8T*\x88\xe3\xd1\x01_\xbd\x1e\"\x8e\xa5s\xfdrk\x97t\x17\x1e\xbb\xee\x19ׁx\xc6\b\x1e\xe7H\x12aH\xdcj\xe9-\xe7\x1eq:\x00\xdcz\xe1\x9cQ_\xdfQ1\xdd\u007fx\xf1W\xf0\x85\x057\xe0\xd6\xf3\x8fZ\"\x84\xfc:\xc0 T\x02\xeey\x9a\v\xc5$\x18\x01R\x02\xb6\x8d\ac\x80\x94\f\x8c$$\xd3\xff*I`L\x9a\x96\\\xda7&\x92 he\x85Z5\xc0ڵ\x03C\x16\x16\x13\x11*\x95\x01\xf4\xf6\xf6\xb6%\x93K-V\x96\xe7i2\x1c\x91\xa7\xbf\x9b3ͼ7\xc1v\xa5\xa4\xe6h\x91\xca\x15:g\x89}\xeb\x922\xde\xf0\x81\xeel\\&\u007fU\xa8E\xe7\x9e1\x95\xe2\xdd\x00VV\xfb\xc0\x93\xaa\x92Z\xab\xba\x1d\x01\xbb\xd12\xe5\x1b\xb3\x8ci\xe2c\xec\x03\xfa*\x136\x91r\xf4\x14\x10\"\u009b\xcbW9\x80j\n\v\xb0\x86\xa4\x131\x1d\xe6\xb1]w|\x0f\x89T\xf0\xc1@\xa0\xcbn\xbdpN\xff\xe1\xe7\xdc|ݏ.\xfe\n\x0e[pÆ\x9b%?\\\xf4\x95\x05B\x88EI\"\x10E\xb1q\xa5t\\\xc0\x05l\xa52\xbd\xfcl\xd3Pi\x02\xa0\xe9\xf3\x8e\x8b#%\x84\x92P¸eR\"N\x04>\xfe\x0f\x1f\u0096S\xc6\xeb\xb4\xee0\x17\xb6w\xc2x\xd0hN)\xf3\x16\x8fsw\xb1\xb59\xae\x19\xec\x1e\xf7\f5#\xbf(\xb2\x13a8\xeaC;\x93\xa6\x91\u007f\x93\xab}\x1c\xe4\xfd\x8dǒ\xb7,\xc6.H\xfb\xee\xb3\x10\x9b;\xe8h\xf0P\x99\x00\xbcj\x02\xabF>^\x16x\x86kѵN\xd6\xd9\b\x9e&\xd2R0Q\x18\xadӵc\x8c\xf0\xd8o\x9eA\x10D\xc6kʪ\xab\xe8\xdfm\xd2*\xf5\x8e\xcc\xf3L\x1b!\xf6\xb9b\xb1`b\xc2\xecs_Xxèd\x96G\xb4u\xdeu\xd9\t\x00\x80;\x17\x1f\xb7\x80\b\x8b\x14\x14\x12!5\x17\x83\xe9\x1a#\xb2\xe67e]CJ\xfb\xeae\xa4dr\"\xfe&8\xaf\x9c\x8a\x83\xae\xf3{\xf5\x95\xd7\xd1\xddSn\xab\xb4\xa5om\xff\xe8v\xab\x9cd\x8d\xc8M8ϰ\x91\xe3$Ab\x9aZJ!\a-Ci\xb5\x9b\x0e\x96\x85\xb3.\xa7u\x01\xac;`\x9f\xf3<\x0f\xbe\xaf\x1f:V\x97>\xb4+m\x12\x02\x19\x97f}\x00\xcbP-\xcf\xde\x1d.o\x96ʠ\xcbi\x92$F\x1cG\xbal\xa6^G\xbd^G\xadVC\xb5Zu\x8fZ\xad\x86z\xbd\xee\xa4_l\xa9\xd4z\x01\xab6,\xcaD$\b\xea\x01\xaa\xd5*\xfa\xd7\xf6\xaf3X\xd9\r\xbb^7`\xe5:\x03\xb1t}\xb34KH\xd6\xdd\xd6f\x97c\xd9s\xe3&ꬤ\x02\b?\xb9s\xc9q\x87\x03\xc0\xdd\x06S\xc6\x1c\xb0\xee\xbd\xfc$|\xf6\x8c\xabq\xd7e'\x9cE\f\x8b\xa4T\x10\x89\x04whJ.K\x90v\x9fM\xf9\x1a\xcc\xf15(W7\xc8\x18\xcb\xc5/\x98}0\x1d\xa0\x1f\xa8\xd4\xd1;\xa1\xb7\xed\x9b\xde\xdf_\x81\x94b\x9d@K\ba\xaa\xe9m\xd0у\xefy\xe6\x1c\xcc.+\xb3\x84L\x13-\x18E\xcd\xdaP\xd6Ws\a\xa0|\xf3\x81\xb1̤\xbd\xdb@\xabyÐ\xc6\xda\x17\ue46d\x15\xd5\xd2É\x11\xb4\x8b\x10\x86\x11\x82 D\x1c\xc7\xee5\xeb\xa3\x04k\xd4֘\xfd\x8f\x14*\x95\x01\xac]ۇj\xa5\xaac^\x89\x18\xb3Y\x11GIN\x06\x8aHw<'Cc`\x94\xaeo\x96\xa9\x05\xb6\u007f\xe7\x06\xbc\xb8\xc1\x81DHk\xd4\xfc\xe0\xee\xcbN8\xec\x903\xae\xc6=\x97\x9f4\xb6\x80u\xffwN\xc1?\x9dz%\xee\xbd\xfc\xa49\x8c\xb1\xc5a\x9c@H\xd9\x10\xb4$\x17lc\xccP\xf6YJm`d@\n\x19\xf02-\xc1-\u06dd2@E\x80\xd6\x17\x8a\x13G\xackw\x02\xf4\xf5UF\xc7[ɂ\x96\x14\x99\xec\x8cr;\teT!\\Y\x86T\xae\xf1\x85]\x04c\x11{\xb1\xa2\x83Y3??\xc1\xb3\xe9\u007f\xd5\"\xec:\xf6\x80\xb5)\x80V+\x8b5[\xacn\t\xc2\xdar\xcd\xff\xde\xf8ZmM%\xe6_\x810\x8cƠ\xa8\xbc} mץUV\x86ƆVT:\xf7\xeczZ\x1f\xd6u\xad\x1e\x02J\xa5$o\x06\x03V\xccU\x898\xcf\n:\xa6ŝ\xd5\xc5A<]7֘I\x12\xad\x02L\x8c\xfd\xf0\xde\xcbO\xfa\xf8\xc1\xa7^\x89\xfb\xbfs\xca\xd8ư\xee\xbf┣\x15pc\xa5RC\x9c\xc4`ڄ\xca\x15z\xba\xb8\x94\x89II\xa9 \xa1\xa0\x84\xd4\u007f\xb3\u007f\xb7uWB\x9a:({cd&#\xa7Kw\x84\x108\xec\v\xfbB\xc8\x18\x03\x95\x81\x11]\xecB\xc1Gww\xb7+\xbd\x19md/;\x19\x9c\xeb\x95+\xe1\xc9kz\xb5\xda=\xa5\x94\x80\x82\xa3S\xb4\x13\xe0%\"\x14\n\x85A\x90U\xbf\x9f\xe5J\x9e\x86\n\xb2\x0f}\xbb\xdbk\xe8\xc9Z\x04\xac\xc7r\x91\xa4ݙ\xb3\xfaa\xd9z\xbd\x14\xa4\xc7N\xd53\xfd,\x85zF-a]\\\xcb\xc6\t\xd5\xeeeR\rt\x9c\xc1CW\xe9_\x938Y\xa7\xe3\x1e<~\xc5\xf0\xe2\xb2\xff\xc5\xf27V\xb9\xe9S,\xf8\xda0qAw\xe6B<\xa4)\f\xb9\x00=e<\xb0\xb4\xf5\x9e\x82\x04P,x\xe8\xee\xee\x02\x91\xda{\xffS\xbe\xfb\x8bu\xb6\xb0\x1e\xb9\xfaT\x00\xc0CW~\xed\x18\xc6\xf9\x8da\x18B)\tΘ\xbb\\\x16a\xb9=pF\xe0\x19\xf6\xabg\x88e\xce]d\\k\xb8K\x898\x89\x9dy\x9bU\x1cH\xddC\x80\x13\xc3\xf3Ͻ\x82q\xe3\xbaGQ\xff\x14c\xf5\xea5X\xb3f-*\x95\x01T\xab5T\xab\xb5\xe1-\xa0\x16r\xc9v'\x8f\xe3\x04q\xa4\xd3\xcdQ\xa8݇8\x8a\x10\xc6\x11\xc2(tJ\x936\xae\x11Ǳs+\x84Нw\xec\xfb\xb31\xab\xc6\x1d\xd7\x02Z\xab\xee\xd7\xd9E\xa13@В\x1fJB\xaa\xc1\x99\xea\xa98\x9fu\x8f\xa4k\x876\x98D\x8b\xb5L\xb45\x12\x0f\x1a\x97\x1bJD0\xfb9֢\xb1\xd7ź_a\x18\"\b\xec\xcf1\xa2(v\x12:\xdaJ\xcaJ\xfc\xac\x1f+o\xb8\xf9%\x92\x04Q\x185\x05ه\x0f\xb6\xab6\xbe\xd7X1j\xe4V\xc5\xfa\xcaPs\xce\xf0֛\xab]\"B\xcb>%\xda\xe2bd\xd6r\x1aO\xf5\xb2|\xac\f\xf5As\xb5l&]\x93\x1c8#$R!\x8acp\xce\x1f}\xe8ʯ\xed\a\x00\x0f_u\xea\xe8,\xacG\xaf>\r{\x9f\xf0-<z\xcdi\xfb\x01x\xa0^\x0fP\xafGfQ\xe8\xf2\x16%\x95q\xf7\xb4\xb2\xa2\x82\xb6\x9a\x14`,)\x05)\xec\x021\xfc%%\x11\x86\x91\v\\kk\xccXWfwIi\x03\xfas\x98\xc7q\xecq\x87`\xd9\v\xaf\x8cI\x8a])\x85\xf1\xbd\xe3]a\xe8H\xafN\xb6\x0erĶ\xaa\x1a6_e\\c\xeev\xe5B\xa1\xd0bR\xea\xeb#\x84t\"q\xf6\xd8\x1a\xad\xc9l\xfdf\xf3g\x8c|\xe57\xba\x1fy\x8bg4\x01\xe7\xd6\xdfa\xeb?끮\x9c(\x15}x\xde\xfaX\x9c\x16\x04%\x82 Ԟ\x81R\b#\x01\x91H\x14\n\x1eJE\x8e0\x8cP\xab\xd61a\xe2\xf8a\xaf[\xe3\xf5i\x95\xc1\xb5\x9f\x91$\x12k\xfaC\xac|\xa7\x0e!\x15v\x99\xb1E\x8bY\x81\x8c|\x90\xf9\x8b\xd2%GB\bT\aj\xb9+\xcf`\xe3\xc8\xcdӴ\xdd;$\xa5\xc4/\x1e\xfdo\x14\x8b\xbe\x13ȴ\xd9?Ι\x9e\x97\xae<\r.\x9cÈ5YV\x8c\bR\xa5J\xaf\xda2\xd3\xd9\xc5rW\t\xa5R\x01Ja\xb7}N\xf8\xd6\x1f\x1f\xbc\xf2k\xd8\xef\xa4\u007f\x1d\xb9K\xf8\xefמ\xb17\xe3\xec\xa1Z=`\x95\xfe\x01\xd7t!\x95t\xd5WQw\xaf\xd1\xccV\x18\xe0\xcaR\x16\xec\xeecu\xa6\xa4}\x9f\x92\x10&\xb8m\x01\x8e3n@,}_\x10D\xf8\xea\t\x9f\xc3\xf2\xe5˵H\xdf\x18\x8dq\xe3z\xe0yޠ\x00\xa2\xec\xae\t\xe4\x99\xf8\x18\xbc#\xf4hAK\xb5z\xd2x\a\xc4\b\xdd\xe5\xb2\xd3\x03\xb5\x9a\xdaA\x98\xe0\x89?\xbc\x81q\xdd>>\xfa\xa1mA\x00\x9ez\xee-\xec:sjS9\xd3X\x83\xd6\xd8Y5-,\x19\xa9\xf0ҫ\xef\xa02\x10h._\xe6\x9aL\xec-c\xc6vS\xc70\xc6D\b\xc2\x18Q\xac\xe99\u007fym-bS \xef,\rF\xd8m\xc7)\x90R\xa1V\x8fu\x8a\xbe\xc0\xb5\xd5(\x15x\xa6\bx\xa4\xc0\xff\xeak}諄90\xda\xee\xbd=\x00\x01\xab\xfbb\xd4\x02\xed\r0\"l1\xb1\b\xa5\x80z(\x10\xc5\x12\xf5 \x81\x10\x998jc\xbc\x13\x00\x99IC\xa4\xcf\xc3\xe3\x04\xdf\x03|\x8f\xa1\xe0\x03\xbeG\r֚\xf6l\x88\x80w\xde\xe9\xc3\x1f\x9f~\x05\x9e\xc9\xf2Y\xe0\xc9\xf2\xaeJ\xa5\xa2+\xbaΒJu\x1c\x9a\x00\x03\\JJS\xe5B\xb9\xc63BH0F\x187\xbe\a\xe5R1I\x84\xf8\xf0\xde\xc7}\xf3Om\xdb\x10\xbf\xbe\xf9,|r\xce\x12\xfc\xfa\xe6y\x87\x10pW=\b1P\xa9\xea\xda;k\xf2\x12@`\x8e[U\xaf\a\x10B\xa1T,\x82qfbV\xd2XH\x1axB\x97\xbd0@f\x00\xcfſ\x8c\xe8^VW\xc8\xc6\xc0d\"q\xd0!{\x01\x10\xa8Uk\xadj\x9a[K\x9b\xa8ᱢ\xab\xab\xec\xe2D\ueef5\xc9g\x8cte\xc0\x15\xb9\xfaH\xed\"\b\xe7R\xe5\x17\x9ej\xf9]\x16lXC\f,\xab\xbde\u007f\xd2&4\xd7%\x96J\xd3+|\xcf\x03\x91\xb6\xa6\xaa\xf5\x04O>\xf3\xa6s\t\xb7\x99\u058b\x1d\xb7\xdf\x12+W\r\xa0\\\xf6\xd1].\xb4\x8cS)\x05\x84\xa1\xc0\xca\xd5\x03\xe8\xab\x04 \x00Ӧ\x8cä\x89%\x18\x83\x19\xedaAs\x1c\x89\x99dIb抖\x06\x96\xc3\x02\x16c:\xeb\x1a\xc5\x02o\xad\xac`\xf9[}\x83\xc6}\x94\x02JE\x0f\xdd]\xbe\x9b\x04\xef\xddf\x02\xba\x1a\xce\xd7n\x96\xf5 \xc1@5D\xef\xf8\x12\xca%\xdf\xdd\a\xa9\x14^[އ\xb7W\x0e\xa0\x1e\xc4\xfal\\L\xb0\xf9;\x8bE\x8e\x1d\xb7\x9b\xa4e\x91\xa4\x1a\xd2%\xb5\xd4\x1d\xa5\x14\xa2D7|(xL\xc7\x1d\x8dE\xf5ڛ\x15\xac\xe9\v\xdd\xfd\xcb\x1d\xbb\vr篁\x05Qۿ\x80\xdaL\x82\xa8V\xbfgBe\xa4\x9a\xe7\t\x01\xa8\xac}\a\x03kV\xc3\x16\xf9f\xe9H\xc8\x00\x94\xe7y(\x14|gY\xa5\xd6\x16\x83\x10ڍ\xf6<\x8eR\xa9\x94\x12\xaf!\x9d7\xa5\xeb\x119zz\xbaQ*\x15\x00\xc2\xc7\xff\xe1\xa8ŏ\xfd\xf2\xc63\xb1\xd71\x97\x0e\x0eX\x8fߺ\x10{\x1c\xbe\b\x8f\xfd`\xc1'\t\xf4\xab \x88P\xa9T!d\x92\t\xaaéz\x12\x11\x82 t\xfc\n\x05B\xc1\xf7A,M\xfbk\x89Xa\b\xa3\xda\xc4v\x80dv\x06\xa7%n\x1bE\xc8Ԃ\xb3\xaf\xdbu\xd7\xed\xf1\xb7ۿ\x17o,_\xa5\xcd`i\xdcGh2\xbd\xfe|eD\xc5\xe0z\x10\xb64\x84H\a\xef\xc8\xec&\x16\x9a\xd2\vBM\xa6\xb82wX\xe5C\xa9\xed[Y\xf63\xa0\xcdx)\xcdC\xa9\x96\x00aSÞ\ap[\x1bn\x803\x8a%V\xac\xd6\x12\xd32ӅZJ\x89\xf7m3\x01\xb5j\r\xdde=a\x94\xf9B\x85\xf4\x1c\xea\x81\xc0ڊ\xd0\r4\x95^@\xbd\xe3\x8a\b\xa2\x04\x13{K\x98\xdc\xeb\x83{\xe4j2u\xbe@宎&њ\x9aQ\x02\x12\xa1\xd0\xdf\x1fbm\u007f\ba\\\xfb-&\x940q\xbc\xaf7)En\x82\xba\xbaR\xe3\xf6\xad\xee\v\xd0\xd7\x17@\x02z7\xa7||FO\x91\xc1\x81O\b\t\xc69&\x8c/\x81\x88!\x8ab\xac\xe9\v\xcc&\xa8\x01D\b\xe9\x82\xc0\x042\x9dc\xc8I\xa2\xb4\xe5\")\x85ޞ\"\xa6N\xe9\x82\xefqp\x06Ӑ\xc1\xf2\xa0$\xc2(\xc1[+j\bc\x810\x14H\x84\xbe\x16\xa5\x12\x87\xef\xe9\xfbQ\xaf'c\xa6\x98\x9aw\x13[\x83\x97jo\xef\xc9\xcf?ư\xea\x8d\xff\x810qK2\xa0\x95-\xcb!\x108\xf7\x8cE\xa5I\xd7\xcc\xe3\u0382RR\"\x8aM\xc7 \x10\n\xbe\x87B\xb1ി\xc0Rp\a#\xf8\x9e\x8fq==(\x16=\xa1\x94\xday\xcf#/~\xe17\xb7\xcc\xc3'\x8eZܼ\xbc\x9e\xb8\xfdl\xec\xfeŋ\xf0\xdb\xdb\xceޙ\x11=\x15E1\x1f\x18\xa8#\x8a\xa34\xb3g\xfeը\xac}\xd20\x8a\\\xf6\xcb\xc9\b[yX\xabCn2d\xceM\x84\x84\x14J\x934-`\x11\x99x\x97r\xd9Bi2\x89J)$q\x82\x8f\xfdÇQ\vT\n\"#qe\xa8\x19\x8c6\xa7\xa1\xddl\xa0\x1e\xa6\xf7\x80\x11P.h\xa0\x8f\x12\x85\x92O\xb9XG˹\xd8\xe2\x92%B!JR+\xb2\\dC/(\xd5\xe6B\x18cWq\xb8E\x9e\x9d\x13\xe9\x16\xa4\xd6\xdby\xd8c䜜\x96\xfbƒn\x1eN<Q\x8d\x10\xb0*\xab\xdeBm\xa0\xe2\x82\xe8JI\xe7\x12rƝڊ\xdd\x04t\x0f\xd0\xfcߘ\xd9\xcdl\x92Mg\xbd}\xf7yY\xf7\x90k\xb3\f\xa5\xa2\x8f\xae\xaen\x14\v<\x14R\xbe\xffc\x87/z\xf3\xf1\xdb\x16b\x8f/-JW\xefc\xdf_\x80=\x8f\xbc\x18\xbf\xbf\xe3\xdcOp\xce\u007f\x1d\xc7\t\xad]ӏD\x1aַ0\xf4\x04\xa7\x1f\xc5r1&\xed\xbe\x01@\x06\xd8dcق-\xd5\xc9\xea]\xeb,\x81\xb5\xa4Hi\xa6\xbb\xa3G\x18\xd7\xd2Z'R*\x14JE\xd3\xc8\x02\xa9+\xa6\x800\xd6\xc0\x19\x84ڝ\xf3\v\xde\xcb\x1f\xfc\xbb\x9dN\x17\x89\xf4\x1a\xef\x0e\x8d5h1\xda\x00H\x98\xae.)\x01\xa1\x14<N.\x88\xda\xd45X\r1IUK@W\xba\xa6s\xe8\u05ebl\xd46\xef\x99P\xf6+\a\xf9\x18\xb2\x1f1\xa8\x153\"Ӡ\x95X\xb3\xb1\x05\xd5\xf02ιBa5r\xdcb\x04\x92\x12\x04R\x8a\xd6uV\xb5v\u007fՐg\xa0\xb2^\x80>a\xc6H=\xf3\xe4\xb3\xdfWJ\x95\t@\xb1\xa8\x89\x9b\x05\xa3l\x9am\xad\x97\xf2\xb7\xf4]!Ë\x14I\x02%\x12\x1d\xb7R\n\xcaV\xa9\x109\x85\xd4F\xaa\x02k\xe81\x9aR\x1e\x90\xa9vI\x15Uu\x10^\x18\xe9)\xe6\x18\xf2D\x80\xe7sL\x9c0\x01\xdccA,\xc4n\x93&\x8e\u007fq\xc5۫\xf0\xf1/_\x02z\xe2\xf6s\xb0\xfb\x17/ē?\xbe\xe0\xa3ܣ\xc7E\"\xd9;\xef\xacƚ\xd5k1n\xfcx\xc3\xe0N\x03ЌqS\x13\xa8\\\x96O[S:\x00iyX\x90ʤس<\xad\xd4\xcd\x13R\"\n\"ǹ\xf2<\x9e\xc9\x1af?[\x9al\x88\xfe,\x91\x88&\xeeW\x14i\xa1\xfeZ \x8d\xf9\x8a?\u007f\xf7\x81\xdfOGgt\xc6_\xe1\xb8\xf3\xf2\xe3K\xff\xfeГ\xff\v\xc2\x14F\x84\xae\x12\x83\xef\xfbZژ\xd2\xf2\x1a\"\x98F\x18\x16LR:B\xceJ\"\xd2 \xa6\x00\xee\xe9\xec 7Dq\vB\xdc\xe9ř\xd2\x1cN\x86\x93\x95\x96\xed8b8RaGmy\x99\x9aD\xd3\xeb\xafR\x19\xc0\xe4)\x931yR/\x88Q_\x92\xc8\x0f}\xe4\xb0\xf3^}\xfcօ\x1aן\xfa\xe9\x85\xdb\xfa\x1e{)I\x94\xbf\xb6\xaf\x1f+W\xae\xd6\x00\x05\xa0\xa7\xa7\xdbd\xf1Ru\xbd\x94\x00\x9a\x82\x99ȹ\x80\xca\x01\x9c%\x91f\xbb\xdaƉ\x80\x885\xbf\x06\x04\x97e\xb0nd֥\xb4\x00f\v\xa2\x1b\xdbm\t!\x10\x06\x11j\x81\xb4\xc1\xca7\xae\xb8\xef\x89\xf7t\xa6mg\xfc5\x8f\x1f\\\xf4\xe5\xe2\x13\x8f/[\x05\xa0\x9bs\x86r\x81P*\x17\x1b\b\x9d\xac`2\xa6!\x00\x00\x0faIDATAj'\x05*\xeb\xfeY\x80K[\xd9)\x14|\x0f\xdc\xf3\\\x9dmޢ\xa2\x9c\niJa\xc8~.\x1c\xd8YSҴ\n\xc3@u@[]\x8c0e\xcb)\x98\xd8\xdb\x03\xceYEH\xb9\xed\xae\x87\x9c\xbd\x96={\xef%;\x94J\x85\x97@\xe4\aA\x88U\xabV\x83\x91!\x86Aa\xa02\x808\x8a\x8c\xd0=wA2\xc6u\xb1\xb3\xe5ap\x9e\x1e\x98\xa5\xe6\x13\xcb\x12Ơ]<)A\xc6w\x11\"q\xefw~\xb8\xf11\x18e}\x1b\t2\xc7D\r\x175\x11\x02a\xac\xdf\xc09\xabL\x9a\xd43\x03\x00\xbeq\xd4>\x9dY\xdb\x19\u007f\x95c\xc1\xec\xbdp\xc4\xd9\xdf\v\xbb{J\xdbY\x92w\x94\x10\x92$\xc9%\x1e\xac\xe5\xa3ӄ2\xa7\xd6k\x83\x1c\x96\xf3\xc5\xdd\x1aN\x9baX\x03\x02\x94\x12\xc8\x1d\xf8\xd9\xdaBƌ\xd6]\x86Lʹ[\xf7\x9e\xa7\v\xf8\xa30\xc4\xc0\xc0\x80\x16\xa11ɰ5kV#\fc0F\xe3\n\x05\xff\x8d?\xdds\xf14Z\xf6\xc0\xd2_H!?]\xab\a\xa8UkX\xb5j\xb5\xf5\x89S+G*'ݢ\b\xb9,\x9e\xfdٹm*\xe3\x12J\x1dX\x17\x89-8U9\x1a\x03\\\xe3I\x99#\x8a6\xba\x85ْ\x1fG8\x95\x9a\x80Z\xad\t\x9d\x95\x02VN\x9e\xd4\xf3\xfe\x857>T\xedL\xd9\xce\xe8\f=\x16~\xf1\x1f\xb7\xaeՒ\x97@({\x8c\xd0\xdd\xc5Q(\x14\xf3J\v\xad\xe2Q\x94J\xc4h\xe3\x85\x1ce\xc1\xf1\xab\x1c\xf0\x19\x15\x11\x8f\xc3c\\\x83 \xcb\b\x198\xbaC\x83\xbb\xc9I\x93ƥD__\xbfv+3\xaf\x01\x80-\xb7\x9c\x82r\xb9\x88\xae\xae2@\xb8\xc1SR\xed\x1eD\x11\x18\xd3&c\x8a\xaeZ\x05TI\xa0\\.\xb8\x86\xa2P\xca\x152B\x01\x92\xb4\x0f*\x95\x02\x93L?/$\x04\x94VMP2\xe5e\x90\x06+b\x86\x01\x0f\x9d.\xb5\xa4S\xa5\x14$\x18\x98TPLB*\x02\x93R7Hg\x04e;;+\xad\x1b_\x0fu@\x90\x83V.\xfd\xe9\u007fN%\"ՙ\xa2\x9d\xd1\x19\xe9Xt\xfb\xaf\x96_8g\u07ff]\xdbW}Y\x02\xe5 \x02\x18\x8bQ(\x16L/\x04[Ȍ\f94\xf3s\x03xih0\r'\x90\x97\x87RRB@+\x8drj]OȈ\x01L\x83\x9eLt\x96\x87{\x1c\xc5b\x01B\b\xc7\xf1\xb2\xe9\xa4b\xa9\x00\"B=\bQ.\x15\xf6b\xb5\xa0\xfe07(\xea\x17\n\x980a|J\xb5\xb74|#\xad\xe2\x02e\xb01,\xd9\x10\x9cC*ħ\x941\ryN\xe7ٞ\x84\xad/\xa4\xac\xebH\xa9\\\x05\xb1\x8c\xbb\xe9\xa8\xff\x00g\x1c\"\x11\b\"\x9d\xefc\x8c\xcbI\x93\xba\xb7'\"u\xf6\x97\xfeOg\x86vFg\xe4\xdc\xc3O\u171b\x1fz\xb3\xbb\xbb\xb8\xab\xee\f\x05\x84\xb1&=;9sd]:\xab\xba\xc24\xe8\x10\xcbd\xfe\xb4\x84\x94e\xf5\xbb5\xcd\xcdk9w$`\xd7\x1a\x8d\xf2%:RJM,\x87\xd2J\x0e\xa6ӎ\xef{\xb9 >c\x84)[na\x1aY\xe8Ϩ\xd5\xeaw\xd1m\x17\x1e>q\xfb\xe9\xdb-+\x94\xfc)0j\xa0o\xbc\xf6\xa6iyei\x03\x84\xae\xee.C\xe2kp\xdfd\xde\x05\xb4$?)\xb3\n\xa3\xc2I\xb0\xb8~oH\xdfӤ\xf8\x90\xa5C\xe4\x82\xee\na\x18`\xa0\xa6\x03\xf7\x04\nK%\xff}\xdf\xf8\xde\xc3ow\xa6fgt\xc6\xd0\xe3\xdc#\xf6\x9e\x19F\xc9s B\xd1g\xe8*龆\x1atR\x89c25~\x94\x11\xe5\xb3|*K\xf2d\xb9vw6뗂\v˔\xe1\xb8,!\xa3\x1c\x1b\xde\x02`\x12\v\xd4j5#G\xa5\xabh8\xf7\xb0\xcd{\xb7r\x01\xf8Z\xb5\xf6\xd4\x1f\x9e|z\x0f\x17\xda\xfe\xd3=\x17\xbf\xa8\x94\xda^\xd7\xee\x05x\xfb\xed\x95p\xe4\x1c\x00BJ\x14\vE\x14\n>`Q\xd4\x00\x92%z\xdaxW.\xeedI\xa7B\x18Y\x19\x03b\xa6\a`*G\xd3\x02\xb4\x9c֔\xb6ڂz\x88z(\x10F\x12\x80Z;qB\xcfvs\xaf\xbawUg*vFg\xb47.\x9c3k\xb7Z\x10\xfe\x8e\x88\n\xe5\"G\xa9\xc8u\xab<FM2\xc7\x16P\x00䋙\x1d]\x01\x00\x19\xae\x15\xcf$\xe42\xa2\x9c\xf92\x1e\x06b)\x18*\xa9\x10\x85!\xa28\x01g\xb67\xa9Vt\xd8f\x9bi\xf0toC\x10\xe8\xf1]\x0e^\xb0\xe7\xef\xee8;\x95\x97\xd9\xe5\xe0\x05\xd3\x19g/2F(\x97\xcb\xe8\xe9\xe9\xc9Ğ\xf4\x81Gq\x84Je\x00\x03\x95\xaa\x16\x0ekH\x8b\xba6\xdbY\x02\x19ㆤ\xc63\xaf%'9a\x11\xdc\"0獝94\xa2\xc7q\x82D(ĉF\xf3q㊻\x06a\xdc\x01\xab\xce\xe8\x8c\x11\f\xa9\xd4\xd3\xe5r\xe1\x93D\x840V\xba\xca!\x8e]#Ԭf;7\xdam̅o̺\xe6\xc6\"cf\xads\x0e\x06\xe4\\\xc7Te8\x95\x95\xb2\xec\x01!\x05\x06\xfa\a4\x03!\xd1`\x95\xb5\xd6z'\xf4\xc2\xf7}\xdb\xf3\xf0\x97\xbb\x1c\xbc`ϧ\xeeY\x84\x8fξH\xc7ן\xb9\xf7b\xec\xfcO\v\xf0\xcc\xcf.)\x10\xe1\xf7R\xaa]!\x81\xd7_\u007f\xc3\xf1\x9f\\9\x8c\xd0ApHibH\x04\xbf\xe0\x1b\xf6\xb9\xb5\x922\xeeb\xb66\x10\x19\xd9\x19\x95Zd\x83e\x04\x95Ծn\xadZG\x10\n\xd4\x03\x01\x80\xe0\xf9l\xfbso|\xe0\xa5\xce\xf4\xeb\x8c\xce\x18ݸ`ά\xdd\x13!~\v\x10\xba\xbb\x18JE\x0f\xa5r9C+\xc8\xf3\xb1\\\xac\xdaXX\xd9F\x13Y\x172\xa7>\xec\x82\xf7Zd0\x8a\"S\x85\xa0\x80\\v\x12\xae\xbe\xb4X(`\xabiS-\x9b\xfe\x9e\xde\x0f\xecpH߫/@\n\x89]\x0e^\xa8-\xac\x9d\xffi\x01\f\x06E;\x1d8\u007f7F\xf8-\x18\xb0\xcd{\xa69\xe2\x97\xe5F\x11\xcf\x1f\x88\x94\x12\xf5Z\x80Z\xb5n\xe2Z\x99\x1a?[\n\xc0\xf3\x9a9.\x95\x8a4\xd0ǚ.\x8cF\xe5$\x8a\x11'@\x18\x01\x8c\xb1\xa0\\\xe43:`\xd5\x19\x9d\xb1\x8e\xf1\xac\x9b\x1fx\xa2P\xe0{1F\xb2\x1e\x02q\xac \x93$ß\xca\x17:\xbb\u007f\xc1r\xc2|\xce\v\xe3\xd4 6\x90\xaa\xc6\x0eT\xaa\xa8\a\x81\xadBɀ\x99\x95\xb2!Ӝ\x95c\xabi[\x1a\xb0\xc2\xdd;\x1d4\xff\x90\xbeW^\xc0N\a\xce\xc7.\a/\x04Р8\xba\xeb\xc1\xf3\xf1\xc7{.\xc2N\a-\u0603\x18\xfb\x15c\f\xefy\xcf֎ŊLʓe\x9bF0B\xa1Xp\xe6$g\xf9\xe7\x18R\xd0r\x818\xdbxѨ*HSi\xe6:\xe8\x10\x10'\x11\xc2HǬ\x88\b\xe3\xc6\x15?\xa6\xc0^\xecL\xb7\xce\xe8\x8c1\x18\x8a~U*\xf9\x87\x10\x11\xea\xa1B\x18\xea\x12\xb7\xc6\xce\xecRɔ\xa2\xc0\x8d\x14\xb2\t\xc4sû\xcaJ#g\xe3`ܰ\x0f\xb2\x06\t\xcb\b\xf8!\x13\xfa\xd1XC \xa2\x1f\xeetЂ\xcf>\xfb\xf3K\xb0\xd3A\xf3s\x87\xdc$\xdfX(\xf8x\xfa\xeeK\xb0\xf3\x81\xf3\xf6\"\xc6\xee\xe5\x1e\xc7ԩS\x8dV\x90\x8dgQ.\xf6\xc4\x18!\x89\xe3<}\x81\xa7\xad\xac\x89\xa71-\xfb^Ni\xa7\x8dz\x10\x18\x89\xdc\x00APG\x18\x04\b\x83\x00\xf5z\x82z\xa4O\xaeT\xf4>|\xfaw\xefyz\xc1\xf5?\xefL\xb4\xce\xe8\x8c1\x18==E̻\xe6g?+\xf8\u07be\xc4\bՐP\x0fb\x84\xf5t-\x06\x81\x96\xfb\x0e\xc2\xc0ɜsk\x11\xb1tmk\x8a\x82\x8dK3G\u007f`\x8cC\x8a$\x87\x159\xa3\x86i]\xbdi[oe\xbd\xb9\xcbw>h\xfe\xecg\xef[\x8c\x9d\x0e\x9c\xdft̃\x16\x98?\xf3\xb3\xc5\xd8\xf9\xa0yx\xee\xbe\xc5w*\x85C\x83 \xc0\x9b\xcb\xdfNU\x01\f\x85\xc1i\x84K\x05\xc69<\xcf\xd3\xd1}hE\x81lSU\x99\x11\xed\xd3\n\xa4:\x9d\xa9\xe3\\\x19\xf1<(Tk\x12A\xa4\x85\x9c<\x8fv\x9f{\xe5ݿ\xefL\xb1\xce茱\x1dW\x9c\xfa9\xfc\xcb\xe5?\xc1\x92\x13\x0f>H\n\xdc\v\x02\xbaJ\f咖\xdcN\xfb]\xea\x9a\xdfnCoj\xac5\xcc\xc9$\x93\x15*Lt\x1f\x00#\xbbc5в\x9d\xa1A\xc06[O\x83_\xf0@D\xdf\xda\xf1\x80y\xa7?\xfb\xf3\xc5\xd8\xe9\xc0y-\x8fwHE\x8c\xe7\xef[\x82\x99\a\x9c\x85\xe7~\xbe\xf8G\x80\xfa|\x14\xc5x\xf3ͷs\xf2\xc8Ҕ\xe746\x1d h\xd3\xd13n\xa0\xee\xf2\x02\anQ\x1c#\b´\xc09Cy\xa8\xd6\x05\x82H#\x98_`\xfb\x9ev\xc5O\x1f\xe9L\xad\xce\xe8\x8c\xf53\xbes\xda\xe7qʷ~\x8c\xa5'\x1fr\x82\x14\xb8\nD\xe8*3\x94\x8b<\x13`O\xc35\xe5rI\xabߺ\x0eR\x80\"\x9dD\xb3\xcdB\xb2\xbdF)C\x1eM\xddM\xdd\xefs\x9bm\xb6Җ\x19\xe8\xdb3\x0f\x9cw\xea\xf3\xf7/\xc1\xcc\xfd\xcf\x1a\xf4X\x87\x95\xf0y\xfe\xbeŘy\xc0<<\u007f\xdf\xe2\u007f\x93R\x9dT\x0f\x02\xacxk\xa5\r\xabi\x1d,i3\x87\x86\x10j\xea\n!MI\xa5T\x19\xbd,\x05\xb8v\xf5\xc8d \xf5\t\xf7W\x05\xc2X\x83\x9a\xe7\xb1}N\xbb\xe2'\x8f\x9ew\xf4\x018\xff\xa6\xfb:3\xab3:c=\x8fo\x9e\xf2\u007f\x8f\x16B\xdd\xc8\x18\xa1Td\xe8.\xf1\\%\ve\x88\xa5`0\"},\xedWHi\xebz\x90i\x86\xc1\xb2\xd6\x18\x9c<\xf8\xd6[o\xa5u\xba8\x9d\xb6\xe3\xfe\xf3.\xb7X3\xd4\x18\xb6\x05\xc9\xcc\x03\xe6\xe1\xf9\xfb\x17c\xe6\x01\xf3Nf\x9c-.\x14\v\x18\xdf;.\x93E\xb0\x99\xc3L\xaa\x93\xf2\x9d3\xd2\xe0\xba\xf5qM\x96Ѽע\xf1@]!\x16\xfa\"\x14\n\xfcS\xa7]\xf1\x93G\xaf\x9c;\xbb\x03V\x9d\xd1\x19\x1b\xc4\xd2:\f\xa7\u007f\xe7Λ|\x9f\u007f\x89\x18!\x8c\x81Z\xa4\\\xf3\b\xbbfY\x86\x00ʜ\x1aC\xc3\xdaoh6A\x99\x04\x1b\x11a\u0084^p\x8f\x838\x1d\xbf\xe3\xfe\xf3.\u007f\xee\xfe%ÂU[\x80\x05\x003\xf7\x9f\x87\x17\xb4\xa96\xbfX(\\άX\x17\xe5%QS\x130o\x0eR\xae\x9e\b\x99\x16?\xa9\xc8W-\x90H\x84\xd1d\xf7\xe8\x8b_\xff\xf6\x8f\u007fy\xe5\xdc\xd98i\xe9\x1d\x9d\x99\xd4\x19\x9d\xb1\x01\xc6)\xdf\xfa\x11\xbe{\xfaa8\xf5_\u007f|;c4\x8f1B\x1c\x03a\xa8\xf2\xd4#\x86&6{\xe3z\xcf\xe9ce\xa5\x93\x8d\xe5\xe5y\x1c\xc5b\xf1\x8c\x99\xfbϻ\xf6\x85\xfb\x97`\xc7!\xdc\xc0\x11\xb9\x84ٱ\xec\xc1\xa5\x98\xb1\xdf\\\xbc\xf8\xe0҅k\xd6\xf4]\xb4f\xcdZ\xed\x1af\xcak\x80ly\r\\\xf9MZ\x86\x03W\xeec_7P\x17\xa8\x87\x02J(x>\x9b\xf3\xcfKo\xbf\xe5\xaa3g\xe3\xc4K;`\xd5\x19\x9d\xb1\xa1ǿ͝\x8d\x93\x97ށ\xef\x9c>\xfbl!Յ\xc4\b\xdd%\x0fݥT\xfe\xc5ѓL3\x89,\x99\x14Ȕ\xe5\xc0ZcV\x82\x19\xd8r\xead\xf4tw\x1f5}\xbf\xb9\xdf{\U00061958\xbe\xefܶ\x8fm\xc42\xd4/<\xb0\x04;\xcc:\v/<\xb0d\xce@\xa5z\xd3ʕ\xab\x9c\xae\xb8\xad+t\x85\xd1@N5\x14\xa6\x91\x82c\xb4C\xa1\u007f A=\x94\x96\x98z\xe8\xc9Ko\xbb\xeb\xd6E_\xc5\xe1\v\xaf\xeb̜\xce茍4n<o\x0e\x8e9\xfff|w\xee\x97NT\nW\x02Zj\xb9\xa7\xdb\x04\xe23\xd6\x165Ʃ\x90\x12\xcbm\xfd\xa0ňiӶD\xa9\\\xdao\x87Yg=\xb4\xec\x81K1c֙#:\xae\x11\xb7\xd15`\x85\x1df\x9du\xf3\xf8\xf1㾶\xe5\xd4)\xba\xad\x03e\xe3TٸVF\xb6\"[;H\x84\xfe\xaaB\x14\x93iDɏ?y\xe9mw]\xb7\xf0\xc8\x0eXuFgl\xe4q\xcc\xf97\xe3\x9a\xf9G\xe0\x9f\x97\xdev\x15gt>c@\x10\x01՚\xca\xd7\xffR\x86\x8be]\xc0L5\x8c\x8d[\x81\bӦMEWw\xd7\xec\x1df\x9d\xf5\xd0\v\x0f,\x191X\x8d\xca\xc2jt\x0f\x97=\xb8\xf4\xf8 \b\xae~\xfb\xad\x95Fy\xd4H\xccd3\x87\xce=4\xed\xed\x95ª~\x818ќ+FXp\xfc\xc5\u07ff\xe4\xc6s\x8f\xc21\x17\xdcҙ-\x9d\xd1\x19\x9bȸf\xfe\x118\xfe\x92\x1f\xe0\xea\xf9G^\xa1\x14N\x01\x11J\x05\x8e\xdeq\xdc\xf5\xa7$\xb2\xa5{\xc6\xd2r\x16\x17\x8c\vɰ\xf56[)\xdf\xf7?;c\xbf\xb9\xf7\x8c\xd4\r\x1c\x13\xc0ʺ\x87/>\xb4\xf43I\x9c<\xf4\xfa\xeb\xcb\r'+OW\xb0T\x06\xdb\xcesU_\x8286\x1dn\x88\x8e?n\xd1\xcd\xd7^\u007f\xf6\x97q\xecE\xdf\xeb̐\xce\xe8\x8cMl\\\xbb\xe0\b\x1cw\xf1\x0fp\xed£\xbe\xa1\x14\xce#\"\x14\x8b\f\x13z<S\xbe\x93zW.\xe1f\x84\x01\xb9\xe7\xe1=\uf666\x18g\x1f\x99\xbe\xef\xdc?,{\xf0R\xcc\xd8\xef\xccQ\x1f\xcb:\xf7\xd2{\xe9\x91˰\xdd>g\xe0\xc5\a\x97\x1e$\x84\xbc\xf7\xed\xb7W \f\xa3&2\xa92M\xd6\xd6\xf4'Z\xcfJ\x17B\x9er\xecE7}\xf7\xe6\xf3\xbf\x829\xe7\xddЙ\x19\x9d\xd1\x19\x9blL\xebh\x1cs\xfeM\xb8\xee\x9c9K\x95\xa23ȴ\x0f\x1bߣ\xabZZe\t\xbb{\xba1y\xf2\xa4\x841\xf6\xf1\xe9\xfb\xcd\xfdݟ\x1f\xbe\f\xdb\u007f\xe6\x8cu:\x8e1i\xfe\xf9\xf2\xa3\xdf\xc4\xdf\xee}:\x96=p\xe9\xa1\x04\xba\xf3\xad\xb7V\xe8\xea\xec\fhiR\xa8D5\x10F\x17\x9e\x96\x1es\xfe\xf5g\xde\xfc\x8dc0\xe7\x1b7vfDgt\xc6f\x02Z7\x9cw̭P\xf4%\x10a\\\x17GO\x17\x03@9\x8aSOw\x17\xa6L\xd9\"T\n\x9f\x9a\xb1\xff\x99\x8f\xff\xcf\u007f|\x1b\u007f\U000e9bef\xf31\x8cy\xb7\xe2e\x0f\\\xfaQ\x00\x8f\xafX\xf1\x0e\xab\xd7\xea\x8e\xc6P\xa9\tT\x03\tH\tbt\xc1\x9cs\xaf;\xef\x96\v\x8e\xc5Q\xe7^ߙ\t\x9d\xd1\x19\x9bɸ傯\xe0\xa8so\xc0M\xe7\x1f{3\x14\x8e\x02c\x18\xdf\xc55\xe5\xc1d\x06Ǐ\xef\xc1\xa4I\x13\x05\x80\xe93f\x9d\xf9\xcaX~?\x1b\xcb\x0f3i\xca\xdf1\xc6>4u\xea\x14\xd13\xbe\a\x8c\x80Z\xa8P\x8f\x00N\x04\xcf\xe7g\xcf9\xf7\xba\xf3\xbe\xbf\xe8\xb8\x0eXuFglf\xe3\xa8so\xc0-\x17~\x15G\x9fw\xfd\x1c\xcf\xe77s\"\f\x04\nA\xac\xf5\xf2&N\xecU[L\x9eT%F\xef\x9f1\xeb\xccW\x96=p\xe9\x98~\xff\x98\x02\u058cYg\xe2\xe5G\xbe\x89\xed\xf7=\xe3\x8fR\xa9ON\x9a4!\xf4\x8b%\xd4#\x05\x8f\x01\x8c\xd35G.\xbcf\xd1\xedKNđ\v\xaf\xed\xdc\xfd\xce\xe8\x8c\xcd\x11\xb4ι\x0e\xb7->\x11G.\xbc\xe6h\xce\xe9\xe7\x1e\x03\x06\x02\x89\xae\x9en\x8c\xef\x1d\xb7F\t\xb5\xdb\xf4}\xe7\xbe\xf6\xca/.\x1f\x15ua\x83\xba\x84\xd9\xf1\xd4O/ؖ\x18\xbf\xef\xe5WWl\x11\xc5\xf1E\xb3ϼ\xf2\xca\x1f^z\"\xbep\xe6U\x9d\xbb\xde\x19\x9d\xb1\x99\x8f;\x96\x9c\x88\xd9g]\x85\xdb/=\xe9\xfc\xaeR\xf1\x84\xbf\xd9v\xf2\xabR&\xfb\x95˥\xbe\x1df\x9d\xb5^z\x84\xfe\u007fa\x17\xf0\x9b\x97\xf5`\x94\x00\x00\x00\x00IEND\xaeB`\x82")
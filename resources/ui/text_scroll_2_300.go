@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package ui
-
-var Text_scroll_2_300 = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01,\x00\x00\x00@\b\x06\x00\x00\x00\xa0<J\xe0\x00\x00\x00\tpHYs\x00\x00\v\x13\x00\x00\v\x13\x01\x00\x9a\x9c\x18\x00\x00\x00\atIME\a\xe3\x04\x04\x147\x1b'\xab\x90o\x00\x00 \x00IDATx\xda\xed\xbdi\x90%\xd9u\xdf\xf7;7\xf3-\xf5j\xe9\xae\xea\xea鞞\x15\x03\f\xd0\xd8\x01\xb6\x00\xb8i\x93\"\xcdE\xb4)\xd9-9lJ\xb6\xa4PP\x92-\x87ô\xdc\xde\xc2!}\xb1\xc3\xf2\aڮ\xb0l˒,\x87B!\xcb!\x85B6K&C\xa6\x10\x92L\x91\x92\x87[\x19\x00!p\x1a\x98\x19\xccLO\xaf\xd3]յ\xd7{/3\xef\U00047f19yo.\xaf\xba\a=\v\xc0\xba\x135U\xfd\x96\\n\xde\xfb\xbf\xe7\xfc\xcf\xff\x9c+\x9c\xb4\x0fd\xbbz\xe5\x12@\x0f\x18\x00\x02\x18\xf7\xd3\a\x86\xb5\xd7\x05P\xef\xebR\xfb\xdb\x00\x91\xfb{\x118\r̹cE\xb5\xf7\xad;\x96\xb6\xfc\x9d\x00c \x05\x0e\x80]\xf7z\xf13\x05\x0e\x81\x89\xfb\xac\xad]W\xfd\xda\xd4;\xa7\x052w\xec\xc8{\xad\xb8\xb6\xd4}\xa7\xe7\xbd\xd7\xf3\xbe/\xde߸\xef\xa5\xee\xa7\xe8\xa3\xd4\xfd\x8eܹtm}C\x1f\xf5\xb9\xac\xado\x9c\f\xd0\xf7\xa9\xc9I\x17\xbc\xa7 \x14;\xc0\x98\a\x16\x80S\xee\xf7G\x80\x8f\x01\xe7\x80\x15\xf7\x99\x9e\xfb\xbd\xe4\x81U\x04\x8c\x1cм\xd3\xe7'\x8f\xe1\xd9k\xed\xb7\xdf,p\xe4\xc0+i\x01\xd2:\xb0\x88\x03\x8f\xcc\x01ݑ\x03\xe3\xcc\x1d\xa3\xef~\x0e\xdcg\x17\x1c\xf0$\xae\x1f\x8d\xfb\xacx\u007f+\x10;p=t}iܱ\x8d\x03\xebC\x0f\xd0~\x05x\xd5\xf5m\x02l\xbas\xdf\x03n\xbb\xefY\xe0\xc1\xda\xfa\xc6\xe4\x04\xd8N\x00\xeb\x83\n0s\x1eP\x14\x96\bn2\f\x1c\xa0\x14\x00\xd4w\xaf\xf7\x813n\xf0\xaf\x02\xcf\x03O\xbb\xbf\v\x00Zt\xc7;\x1e\x194\xc4\x04\xab\x8aZ-_W\x9d\x85'\xee/\x05\xcd\xff\a\x02\x82 \x02\"r,*I\xed:\xf2߂U\xad\x06\x8f;\xa61\xf9\x8fH\xdeM\xf2\b\xa3K\xe4;\x1f\x8a\xaa\x8fv\xceGh\x89\x03\xb6M\xe0.\xf0\xb6\xfb}\xcbY\x99\a\x1e\xc8\x15Ve\xe6\x81r\xea\x81\xe7\x1e\xb0_\xfb\xacu\x9f\xb1@\xb6\xb6\xbe1>\x99}\x1f0\xc0\xaa\xaf@W\xaf\\2-\x93Xj+\xb3\xf1\xdc\x00\xe3\x00\xa2\xe7\xfd]\xfc\xbb\xef\x00e\xe8\x80\xe3\xbc\xe7F\x8d<WH\xdcj\xbb\xe4,\x9bU\a>\xb1\xfb\xdcR\xed\\\xe2\xb9\x1b\xc59\x1e\x19t\xea@#\"\x18\x93Oz\xa4\x02\x13\x11a\xd0\xef9 0\x18#\f\x06}F\xa3\x01\xc3\xc1\x80(\xca_\x17\x91\xdcdq\xc74\"\xe5\x95\x1a\x11\xa2(\"\xee\x19b\x13\x11ǆ\xc1\xa0O\xbf\x1fc\"\x83\x91\xfc\xfbF\x1c\xc2\x14\xe0\xe6\x10˺\v\xb5V\xb1֒\xa6)ifI\x92\x8c,Mɬ%˔$M\x99L\x12vw\x0f9:\x1a\x93d\x19i\x9a\x81j~_\x05\x00z\x88\xa2\xaa\x88@\x96Y2kQk\xb1V\x91Ȁ\xd5\x1c\x14\x05\x8c\x182\xb5\x88B\x1cGX\xf7\xb9\xa8\x17!\bY\x96a\x8c!2B\x96Z\xac*q/\"M\xb3\xfcx\xaeO\xad\xeb\xa0\xc8\x182kA\x15UH3\x8bj\xeeU\x8a\xe4\xf7,e?H\xd1-\xd5`\xfc\xceQq\xe2\x01\x16\x9e;\\\xb8\xba\xdb\xee3\xa9\x03ķ\x81\x1d\xf7\xf7\xb8\x06\x82\x855{\x00\xdcq\xaf\x8d\xddk\x85\x95[\xb8ڙgU\xda\x1aPj\x87\xe5\xac\x0eD\xf5\x83`=\xca\xfb\tVW\xaf\\\xfa\f\xf0\xfb\x1dP<\a<\xebq\x14\x03\x0f\x80\xa2\x0e\xc0\x12\xcf\xea15\xae\xc7x@\xf7\x18Vom\x00\x8e\xaab\xd5\xe6ࣚ\x03\x86j9\xb8\x8d\x11\xe28\xa2?\xe817\xe83??bqa\xc4\xc2\u0080\xd1h\x8eSK\v\f\a=\x86\x83>\x83a\x8f8\x8e\x89\x8d\xc1D\x86(\xca\x01*r\x80TZ,\"\xa5\x85\x14<D\t\x1fk\xed\x9f\xe1+R\x98]\xe2\x8dN\xc5 \xf9\xdf\xfeG\x1d;&\xd2=dr\xcbFтJS\aȢ\xc5?[l\xb7\xfclZ\xa2w\xfe]U\x10#\xa8\x82\xb5\x161\x06\xe3@M\x00\x13\x19\xac\xb5\xa8:\x90W!SEȁɺ\xfe\x8f\xdcߙ\xd5\x1c\xc0\x01\x9bY\x100\xb1\xc1\xa6\xb6|v\xfb\xfbG\x8c\xa7\tqdH\x92\x8c\xfd\xfdCT\xf3\xab\xdb\xdc\xdc\xe6h<\x05U\xeem\xedp\xef\xee6\x93$!\xb3J\x96\xa6Xg\xed\xfa`,\xc5\xdfR\xf5a\xfe\xcc\xfc\x9f\xc7oaz@\xe3\xf3\x8au.\xd2\xe7$뀕z\xd6\xe4\xd8s\xc5_s\xae\xf1\x1d௮\xado\xbc\xfd;\xc6\xc2\xf2\xc0ꇁ\xbf\xef\x00ȳ:\xf4X\xb3\xbf\xfe\x99\xee\xafii\xc9\x14V\x88\x18\t\x06Gn\xdd\xe4\xc0`\n\x90\x10\x838\xeb#2 \xc6\x10EQn\xe98\x8b\xa5\u05cb\x18\f\xfb\xf4\xfb}\xfa\xbd\x98~/\"\x8ec\xa2\xd8Ћb\xfa\x83\x1e\xc3A\x8f\xd1h\xc8h4\xa0ߋ\xf3cx\xe7\xf6\xc1\x0e7}}`\x91:p\xd4\x1e\x97\x14hB\xf5[\xd0\x1c\xaa\xd5s\xcb\n@\x10w\xfc:\x9a\x95\xdfmy\xb9\xfe\x9e\xf8\xaeW\xfd\xfc\xb4\xf0\xff\xb3\x1c֮7\xb4ql\x1f\f\xab\xd7Z\xbe\xacm\xef\xd5\\\xe7½\xf5\xbaT\n\xb0F\x9dK[Xc\x91\x03cP\v\x87Gc&\x93\x844\xb5L\xa6\t\xa9\xcdH\x93\x94\xa3qB\x9a\xe6q\x01M-\x89\xcdJ\xd7=M3\xa6IJ\x9afL&SƓ\xfc\xb3\xb9U\xa9X\xabd\x99\xc5ZKf\xb3\x1c\xac\xb3\x1cPm\xa6d6#\xcb\x1c0z\xaey~M\x1a,\xa8m\xe3\xa4Z\xb4\x9a/\x1e\x8f\x93\x8d\xcf\xdc\x05\xbe\u007fm}\xe3\xdb\xef\a`\xc5\xef\xf5\t=S\xf2\xcf\x03=u\x9d\x1d\x19!\x8eL\xb1\xf8\"\xf9\xff\xdc\xead\x10\x93\xaf\xa8\xbd\xb8G\x1c\x19z\xbd\bc\fq\x1c\xe7\xee@$\xc4QD\x1cGĽ\x98^\x1c1\xe8\xf7\x18\f\xfb\xb9\xe5\x12\x19\xe2(B\"!\x92\x88(\x16L\x14\x11Gq\xe9Ző\xa9\\2\xc7\xc9\xe4\xcf\xccPxL\"\x82D\x82)-\x02A\xc4\xe3\x93\xc4#ST@,\x828\x97*-\x1f\xbe\xf8˯\x83\xab\xd2\xf2\xa9^\xf18\xa4\xfa\xc0q\u0588o\x02\xa9\x83<\x0f\xc1U\xbds\xa9\x14\xf3\xb9\xb2\xcaJD/@\xa1\xb2\xbe\xa4v\\\x1fKʅC\xdcL\x169\x0e\x8e\x1e\x02\xb1\xf0\ue97c˪\x17ʋ\xd7\x0e\xee\xae\x05\xact\x06\xb7WLt\x15Tl\xf9\xa2%+?\x94a\xcb#*J\x14\x19\xe6F9\x13\xb0\xa8s\xddf\x80z][\xf4\xadwk\xaa\x8aʹtK\xd5\xfd\x87-\xfc\xc4|1S\xab$\x99\xa26wo\v\x97<K\xb3\x12\xd0\xd24%\xc9,\xc94e:\x990MS\xd2$#I2\xd2,u\xae1dYF\x9ae\xf9\xefԒ\xa4i\xeeB[w.\xb5\x94\xeb\xa7s\x91\x15\xc5f6\xb7T\r\x80\x9c\x03\xfe,\xf0ӿ#\x00\xcbYY+\xc0\xa7\x00V\x97\xe6xre\x01L\x0e8gή\xb2tz\t\x91\b\x13\xe5\x1e\x9d1\x95\x85\x14\xc5qn\xd6G\xe2&\xa2\x94\x13\xbd\x98\x98\x02\xa8\x0f\x04\x9e\x9dP\xbaU\"\x81\xc9 \xde$,~[-\xb8\xa4|B\x8a:\xa7ɛ\x9f\x12\x80Ne\x0e\x89\x16\xdfq\xc0#ΊRq\xd7W\xe0D>\x8a\x15\xcd?+\x1e\\i\xe5\xa2Iy]\x12X7\x05\t.\xc1dt\xaf\x95F\x87\xd6\xcciiX\xa5\x12\x00\x10\r\v\xa4\xeeM\x96\x9f\xeb\x8c\x17jh\xc4K\vxx\xc0T\x82\xa6\xf5_\v\x15\x10%xiӪҶ\x8bP\xc2\xd7+/\xb8\x15\xbeԳ(\xeb\xc7Sm1\x02\x8b\xcfZ\xed\xfc|1\x9e\xb4~\x1ckCKI5\xbc^\xf7(\xacBd\x04\x89b@\xe9\xf7c\xf7}-\x03)\x8d+֪\xe7J\xd8\xf1,O\xf5\x027Y\x9a9j\xa3\xfa\xb2\xcdrp\xbc\xff\xf6\xdb\xec\xed\ue466\x16A\xf9\xf6\xadm\x0e'\t\xc0\x17\xdf/\x970~\x9f\xce{\xe4Hš\x05\"\xe3\x00F\x95\xd1\xfc\x1cQ\xe4@ɘ\xc6\x14\xb0j\x1d\xd1\xea\xc8Ԁpњ[\xd3tsJw\xc64ca\xa1M\x93\u007f\xd6w\xe1:i\x1c\xadϭj2\xaa\x14\x86L\x014\xdat\xc0J\xd0\xf2\x01\xac\xb2\x82\xa4梕\xd0,\x15D\x17\xa7\x94Ҍ\x97V\xb0љ\x11A\xcfJr'\xcdq6TB\b\x95\v\x1b\xf8\x1b:\x83m\xd0YlD\x05ƕ'\xe8\xa1b9\x91[\xfc\x17\x95\xa6\x03\x18\xe0\x9a\xb6\x02\x94Vfb\r`$\b:\xf8\xa7U\xeaܟ\xb4rt\xf5\xa5\xa3\x95\xc3+\xef\xd3-`\xdaD~\xdbBm\xa8g\x91ak\xeea\x9b\xcb\x1b\xbc\x16\xba\x916\xb3\xa5\xb5o\x02\xb67\"2\xcap8dw{\a\x83b\x8c!\xab:i\xef\xfd\x02,\xf3~\x9ctm}\xe3\b\xf8*\xc0\xd18A\x8c\xb3r\"C܋\x11\xd1rr7(\nՙ\xfe\x85\xb6\xae\xb0\xcdo\xd4\x0fS=t\xed\x9c\xd1\xc5\x1a\x8f\xa5\\5\xabA\x1c~S\xd5w$*w2\x8f\xf6\xd5~\xa2\x88\xc8\bQd\x88\xa2\x98\xa8\xe0\xcc\"\xe3qk\x06c\xa2\xfc\xb3ſ\xc5 \xe2x\xb7\xc8Y\xa2&\x8f\xaa\x89gQ:\x9a>\x88@\x1a\xc7\xdd\x19\xa9~ĸ댤\xbcV1\xf99\xf2\x9f\x82Lv\uee91\xeaG<\x97\xd9\u007f\xdd\xe4|\xa0\x98\x96\x1f)\xfe\xce靖\x84\x8a\x93F\xe4\xd7c\x1c\xf1\xee\xdfsA\\\xe7TAd\xa2\xbco\xdc\xfb\x18\xe3\x05&$\xb0\xf2rK\xd2T\v\x9ao\x89\x9b\"Xb\\\u007f\xe4\xef\xab/\xe1\xa8\xeb^U\x03SS5\xb4P}\x1c*\x025\xa55%M\xfc\xf2i\xf1:_[\x80U\xbb1\xa9\xa1\x1f\xda\x01V\xa1\xcb\xda\x0e\xe9ŵ\f\x06\xfd\x92+\xb5\xd62N\xb2\u20ffQ\xf0\xd1\xdf\xf3\x80\xe5\xdd\xe4[\x00\xd3$ú'gD\x88\xe2\xb8\\\xb9j\xebZ3\xdeA\xd3\xe4\x0f\x17\xf10J\xa5\x9d\x94\x86\x96V\n\xe1\xf8\xc3z\aP\xff\x03\xde\xf8\b\x0f\x13F\x83\n\x17.r\x13\xb2 \xf9\x8b\t^\x80HaŨo\n\xaa7\xe8\xd5\x03\xc6\x068\x16oK\xe0<\x95.\x83h+\xcfS\x82\xae4'K+\xb2\xab\x04\xefi\xf0#\xa8\xb3\xbd\x82>\xe9\xb01\xd0\xf6\xf3\xa9w\x9fX\t\x16\x8a\xfc\xbe\xb5\x114P?TZH4\x02 \x8cJ\xd0\xcb_\x97\x1c\xf8\x8c\x03\xf1\xe2\xb3\xee}\x87\xc6\xf9o\x03\xc6D\xee\xb8\x0e0=\x10\xa7\f\xe2\x98|܊\xe7\xc0j\rP\xa4\xb6\x90j\xb7\xc5g\xdb\\L\xed\xee\xc2|\xccH3\x10\xa5\x1d\xfd>\x83OT\a\xe6\xbd~?\al`\x9aii\x91\x01o\xd4\xf8\xe8\xef]\xc0\xf2n\xf2.@f-\x93$\xf5\xac\x8fn=e>_\xaaHI+NiK\xb0\xa9#ܨMR\xa2\t\x06N\x0fT\xac\x8c6\xb3\xa5&\xc9f\xb6\x11qRmJ\xbbK\xf2\xbe-bU\xf2\x17\x1a\xac\x95\xa5\x1b\xa6t\xd8}\xdep\x15\xad\xb9Qu\xa0\x17\xb4 \xaa\x95\x90\xc8\xf1\x01\xb9\x16q\r8\xa3\xe0\ru\x87\xd0Y^^u>+5\x80\xea\xb0\x02\xc4\xe7\x90\xdc5\x97~\xb5\xd6o\xad3\xc2\x18\x04\bT\xd1\x16\x86K\xa5i~\xab\xcf\xef\xabǏi\xcb\f\x17o\x1cR\x8bd\x14\xee\xb4\xfb\xafpw5S4\xb3\xe5X\xaa\xb8\xa4\x8aӢ\xedj=\xb9LKȳ\x11*\xef\xec\x1e\x8fH+4iZG1\xad\xe8\x14\xd3\xeb\xe5\xc0\f\x1c\x8e\x13\xbfW\xdf\xf8\x1d\xe5\x12\xba\xf6\xcbE\xd7\x1f\x8d\x13T5\x8f\xf8\xf9\xbc\x93\xb6<\x14\x9d\x15\n\xef\x98\b:\x9b\xbbi\v\xa7kͲ\xaa\xd4\xe5\x1a0\x13Vs\x00\v&\x8cG\xa4Vdj\xd3*\n\xac\xbb\x1a0hݲ!$d[\x01F%\xe0b\x82\x1b\xd4\xca\xf6Ҁ\xfd\x95\x0e\x979\x8c^UxS\xb9ťťJ\x17\x99S\xef\xb1\xf08\xfe\xeb\xde\xc4\xd6\x163@\xeb\xa0\xe4\xf5o\xed8\xcd\xe1\xa1\x01XjmU\tH\xf1\xe29\x8b6(\x88r1+\x8f\xa1ՂV\xa7-\xcahj5N\xd4\xdar\xc1\xf5\u007flp\x0ei\xe0\x91v\x8dk\x8fGk5\xbfZǸ\x1f\xb0\xe9\xe22s.5\x92\\\xee\x03pp4-\x16Q\v|\xed\xfdp\a\xdfs\xc0*n\xf2\xea\x95K\xa7\x80?Qṗ\x934\a\xac~/\x1c ]&\xab\xea\xecH\xb9\xb63[\x8dcw\xb0`\x9d\vX處\x04l\x11\x0en\xa1\xdc\x1a\xa3\xa6\x1b\xb4\xb4\x05\x9f5\xb0j\xf0'd`\xadHp\x85\x15`\x12\xba\xb0\r\xc6cV\x98Og\x9a\xab!\x10I\xcdm\xf5,&m\b\t\bS\n\xbb\x9fQ\x9b\xf5\xe6\x83\x06x\xe4w\x00J\xa1eU\xca9D\xdbǉ\x14 dC2\xbf\x06V\x01\xf6ٺ=\xe3z\xa4|\xa3\x00\xbe\\.\x80\xd5rm\xd0\xfa\xf9\xb5n\xe7\xd4h{m\x1f\xaf\xdab\xad6ǡ6\xac\xdf.#\xb7a0G\x86\xa8\x17\xa3\xd6r8)-,\x03\xfc\xfb\x05v\xbc\xd7\xc0e\xdeK\xa0r\x82\xd1g\x80\xaf\x01?\x0e0\xe8ŜY\x9a#MS\xe28n\x1f\xb05s7Ϫ\xe8r\r\xb5\x1d\xb4|\xc1\x9dz\\PkN\x9eo]\xb4\x81\x97\x86\x03FsRR\x83\xd7*\xd5v\x11\xf5ksa\xb5\x05H\x9a\xb0\xa1-\x18\xe2\xe9\xb5\n\xfd\x8c\x0f\x06\x1e\xc0\xa9\v\xfbk\xdd,Ӛ5\x15X\x1e\xd2\x12\xe5\xaaM'\t\x05\xa3ZX\b\xce-+\xfbP}*W:\b\x95f\f\xb3\xb0vr\x97)t\xd3\xc2sW\xae\x98o\xb9\x05\xc1\x12[㔴\x92u\xa1\xe15\xaaOHKHN\xabg\xa5\xa9\x86}\xaa\xb68\xa8\x04\xe7\xb7ֳ\xccZQ\xc7\xcb\xf5\xf4ǯw\x8eʅ\xb4UV\x85\xe8\xcch\xa4\xb6\xac\xa0\xb66\xeeg\x05\xa8D\xab4\xa6\v\xab\v\xbez\xe7?\x00\xfe\xe1\xd5+\x97\xe6\v\x8a\xe7\xbd\x02\xae\xe8\xbd\x00+\xef\xa6\xfeC\xe0o\x01\xe7\xad*\xc3~\x8f\x0f\x9f?Ed\xf24\x8c\x95\xb3\xab\f\x86^z\x9e\xafo\xaai\n\xca(\x914Wa\xa9\xfd\xc3\xd7G\xe1)\xdf\xfdc5\xcfS\xa9\x86\xc3$_Z\xd3`\xc4K\xa1)^+\xa2|\xcdk#\x94\\4\x14\b\x1d\xd7\xd3\xd6/\xa5\xa5\xe2\xab\xd9\xeb\xdf\xd0\xf0\x02\xa4\xa1k\xefnR\xebφ\xb5UW\xb9\xfb@V\xefci\a>\xcfb\xf2\xb5P\xe11\xb5\xe9\xe9RqE:#\xb2۸z\xed\xb2褲\xd6\xfc\x05\xa0\xe6uU\x1a(\t\x80\x1d\x91*%)06\xb5\x853\xf5t\u007f-\xf2\x88fM\x1ei\x89\x94\xeb1\x14I\xdb\xc1\xf2ŵ\x01\x8e\xb4{2\x87\x87\x87\x1c\xee\x1fЋ\x84\xc5ѐ\a\xfb\x93BB\xf3<\xf0G/_\xbc\xf0\xfaK\xd7n_{\xe9\xda\xed\xef~\xc0\xf2\xd2p>r\xf9\xe2\x85_pn\xe0PUY^\x1a\xf1\xfc\xd9E/B\xa7\x9c^Yf8\x1c6\x00+\x9c\xbc\xd5k\xa6\x96f\xd3\x05X\x95կAD\xae\f{+\xb5t\x9d&h\x95\xc2Ti\x01P\xa7\x19\x13\x17U*Fc\x14E\x1d\xa0[$:k; \xd6\xc00\xfc\x9e\xb6\x03z\x80Fu\xe1\xa8\u007f4\r\x01\xe5a*\x1c\xd4\xf5n\xbeeX\x1e\xb2\x05\xb8Z\xad\xaaN֤\xe6\xbeH\xc3\xeaRh!ޥͳ\xac\xee\xbb\xc6\x01\x05z+%pO\x9ba~\t\"tZ\xf2\x96!Ѯm\xbe\x98\x04\xfcu\xc5SI\vX\xb5\xb9\x89\xf5(\xa3ֳ)\xbc\xe0Q]\x80\x8bΤ\xb5Զ\xd9\xcdM\xb0\x12\x11\xf6\xf7\xf69\xd8?\x04\xa0g\x84\x95Ss\x1cN3\xa6\xd3\x04\x11Y\x02\xfe\xe0\xe5\x8b\x17>z\xf9\xe2\x85_~\xe9\xda\xedëW.\xf1n\x82\x97y\x0f\xc0\xea_\x01~\x1b\xb8\\\x10\x8c\x1f\u007f\xf1)>\xfd\xe2S\xa46\v\xba\xccf\xb6Z\x91f\x0e\xf0\x0e\x9a\xbd\x9d\xae\xa9Y\xe0U\xb4/M3\xb2$!\xcb\xd2n²$g\x84 \x9d\xad%\xba\x88\xd4x%\x99!\x9e\x94v\xf2\xbc\xc1\xa7\xd5\bg\x15_\xd8X\xad\xdcZ\x97\xa8k\xa8B\xa8t7R\xd32\x84\xf2\x84\xe6H\x97F\xa0\xa1A\x88@#Р\x1a\xba\x9aڰ\x1ff0\xbe\xe1݇\xee\xac\r#Ym\xdf\x0f\xfbS\x83{,\"\x91%\xbf\xd4\xe0t\xb4\xc9\xf5x\xcf%\x0f\xb0x\f`\x10(\xf1\x8eg\xfd\xe3x\x82X\xdb\xc2g\xaa\xd2\xe1)\x96}\x9b&\xf9X\xb5YJ\xe6G\x1a[:\xb1\x9b\x9e\xd20\xca~\fG\xac\xe4I\xe7E\xb3\x8et\xfe§\x9f\xe7٧\x9e \xad\xde\xfb7\x81\xaf_\xbdr\xe9Sn\xce\u007fw\x01\x96\aV\xff\x15\xf0w\x81^f-g\x96\x17\xf9\xe2\xa7_\xe0\x89\xd3\xf3dYm5S!I\x93\xb0\xfe\x92vG\xf3\xfc\xb5I;]\x01\xadE\x98J\t\xa5\x13\x17:\x8e\xc7jc\xd0h\v\x12\xaaG\x92\x86\xe5arťu\x1c\x83u\x13T\xac\xb6sמ~˿O\x9fЯkxB\xfeIjܖ\x96\xf9~%\u007f\xd46\xfa\x1d\x01\xdc\xcaa\x04\xf7.\x15\x88\xb9\xb4\x0eu\xc2\xeaN\xe5vg(V[\x02\xbd\x12F\aK\x97\x8a\x90\xafj\xd3j\xf9\x84\xbfz\xe9)\xda%\b\xae\xf5\xa5\xf5U\xe3\x05{\x9e#I\xd5w\xeaqU\xb6|\xe6\xd6j\x13s-^>\xa0{Z\xb6\x8a*\x97)a*e \xc0\x87o\xf5\\E\xed\x12O\x95\x01\x01A\xc5\x04\x82\xe0\x10\xb4uƟ^\xb4`\x86+X\x1f\x17i\x9aVLJ\x99\xbac\xf9Ѕ\xd3\xfc\xaeO}\x88\xd1\xdc0/Փ\x97p\xfa\xf5\xabW.\xfd[\xef&h\x99\xc7\rT\x85\xaby\xf5ʥ\xbf\x00\xfc\x99\u0084~\xf1\xb9s|\xf2\xc3\xe7\xe9ņ4\xb5\x8d\x95L\fL\xc7Ӱ^\xd2̪\f~x\xba\v\xb4hp7A\xae\x9e+8W\x92\xe2]sM\xeb1\x1c\xa9\x96\x9c\xc0\xd2\xd7`\xa2\xa5\x85\xb6F\xda#\x99\xa1\x11\xa3\xc7G/\x83\xa5W\x9b\xc0\xe5%s\xf8\xa0\x1f&.\x8b\a^m\x82\x83Z䫖\x9c\xe6G\xad\x02\xd1b+\x99\xeeG\xf2f\xe3Y\xa0\xdbj\x8b\x10J=\x8aV\xb9\xe9:\xd3\x1a\xf7Ǚ\xad\x8d\xa5\xf0\xfa*\xf1\xb0\x84\xd7\xefG&m\x15Pi(\xc8цu.\xee\xc6r\x01f]\x9e\xd2\x11\xb5n\xd1\xe9\x89\x11\x88ĕ\xd1\t]\xfd\x86\x06Kgx%\xc7F\xb2ÖL\xa7\x8d\x87a\x15\xd2L\x99\x1b\xc4\\\xfa\xc4Ӝ_=U\x80\xf9\x1c\xf07\xae^\xb9\xf4\xef\xbc[d\xfcc\xe5\xb0^\xbav\x9b\xabW.\r\x80\u007f\f\xfc\x01\x1c\x19\xfdɏ<\xc9\xea\xa9y\xac\xda\xca\xc5\x10\x18\x1f\x1e\x05\xc5\xdd\xe2^\x8f\xd3gV\xfc4\xf7\xf6\xb2*\x05ںզ\x9d\xcf\xe9 \x8cC\xfcr\xb9~\x12֜j\x94q\xa9{ \x95mW\xa4\xd7\xf8\x00!\x15[TV\xe6\xac\xe7\xf6\xb5\xe5%J\x90K\xc7\xf1d\xbf\xb4\xcc\xea:\x05Ԓ\xe7'm\xfe\x97\x9f\x8c,\xb4\x10\xf7\xa1\xca]\xa4#6ޚ\xb0X/\x82'\x8dck-293\xe6^\xba~\xed\\Y\xbd\x04Kh\xe4\xd5r\b}\x0e\xad\xb0\xaa\xc4#\xccJ\xc3\xc4\xfa)\xa2\x01`\x84\x9c\x93\x97\xebW\xbc\xe9\x84ö\xa4@$X\vT\xc2\xe8t\xfdo\xdf\xea\x11?#B\x1f\x81k/\xa3\x98\xa1\xbb\xda\x16\xa0\xf0\xbf\u007f\xef\xce\xdbe\xb1\xc3\xe2\xda\xe7\xe6G\xf8:ĕ\xa5y\xfa\x83\x1e[\xdb\a\xc5\xf5\xfd\xe4\xe5\x8b\x17>\xfcҵ\xdb?\xf7\xb8\xf9\xac\xc7ma\xfd(\xf0\x8d\x82\xaf\xea\xf5\">\xf3ѧ8\xbd0t\xe1\xd4\xdc\n\x101\x98\xc2=+*\t\x88\x90L'\x8d\xf5\xb2+%\xa15bB\x97\x1eK[]\x93r^\x8b\x84nB\a[\xd94\xd9=צp\x1d\x02\x17\xce\xd5C\xca2O\x9f3\xcbk҆q\xd3\xe5&\x86\xfc\xda,\xdeK}5`8qg`\x82\r\xac\x90Z\xff\x15\xaay\x99}\f\x9f8ײ\xf2h(\x97\xf0\xf5`\xaa\xb6EA_s\xeb\xb5>.\xa4F\x90+\x81\xf8\xb4Qe\xc1\xb6Z\xf0m\x91<\x85R\xd1n5\xb4\xa0\xeb]\xaf\x81TDC\x10(\xc4\xfa\"\rP\xd6N\xb3Hjk\x89\x84\xc9\x0f3\xdc\xeeYf\x93v\x81Y˗\xb3,#I\xd2\xda\xe2-\xe1}8\xf7\xfd\u0099E>\xfe\xe1\v\xfer\xf9G\xae^\xb9\xf4KW\xaf\\\xfa\xf0㴴\xe41\x01\x95\x00\u007f\n\xf8\x9f\x8b\x87?77\xe0\xf3\x1f{\x06\xbc\x89\\Dӌ1X\xabl\xdd۬\"w\b&6\\\xfcԧ0\xa2\xcd(a\x8b\xe5\x13EU\"\xacoQH\xc7\x1d֫nJ\xfdAx\xd5!\xda*@\xb6\x95\x94\x89\xe2\xd8yt\xb6fQ\xb8\xf7\x8d!\xeeE\xb5k\xd0\xe6\xb5\x06\x96U\xbd̂6\"\x8b\x81%\x15H5ڣr\xf5\xcf\xd2Z\xfc\xaf\xc5\xf2\x92\xe3\x86K\xb3\xd8^\xab\x9cbF\xbf\x86A\x81\xe3\t\xe4\xb0\xc3L\x10i.y\"\xad\a7\xea\xee_h\xc9h#\xb5\xc7+\x13\xed\x8a\xea\xb5Z0\x1a\xba\x84\xaa~$.X\x15s\xe0K\xb3\x96\x00\x8bv,FűlK\x04\xb3\x85Fh㭂\x05\xcf)\xf3\xad\x9d\xc9[\x15\xae\xf2\xe1\xc1\x11\xaf}\U000db218\xf23&2\x9c9\xbb\nT\xa5i\xaag\xabL\x12\xe5k\u05ee\x93f\xa5\xebz\x00|qm}\xe3\xb7\xdfw\v\xeb\xea\x95K\xc5N0k\x05X\xa5\x99\xe5\xe9'\xcf\xf0\xb9\x8f^\xc8\x1ft\xa3\x17\x8d\xb7l\x84\xa2M\x9bf\xd8,\xa5\xab\x8cH\xdbKJ\xb3F\xd1\xec\x02n5\xc2QjB\xbf\xae\x87H\xbd&R=\xa4%\rf=\x8a\x84\xa8\x17\xd5Y\x8e\u0590=m${m\x82\xb6\xb9\x01\xf5h\x92\xb6~\x88fR\xb2\u007f3AҴ\x841A\xa5;k<`\xba\x8f\xaf4*5\xb7\xb2\xb1ܗ\x01L\xed\xe8\x03\xaf/\xfc\xd4!U\xac\xcd\xf2\xfcN\xcf5CB!lS\b\xac\r\xe3\xb1\x0eV\xa1@4\xb4\xd7ے\xd0i\xb7w\xc3ڌ3\xc0\xaa- \xa2~\xb4Q[\x1d\x86\x19${K\x0e\xe51\xbcUE\xb8O\x1d7U\xff\x90\xadDѵ\xfb\xe8\xc7\xc2\xf7}\xe29\xce,/\x16\x11\xc6yG\xc6\xffۏ\xc3\xd22\xef\x14\xa8\\\xeb\x01\u007f\x13\xf8\xd3\xc5m}\xfc\x85'y\xee\xc9\xd34\x1c5\x81(\x8e*.C\xf3\x8c\xf8ꦋ:P\xd2P\xb0\x03\xcdp, VB\xae\xa2\xcb:\xae\a\v\xbd\x05\xba\bo\xb7\xda\x0e]\xa0\xa5!\xfd\xae\xaaa$\xcfY\x12Q\x14\x95\x92j/\xfb\xd0s٤]M\xaf5\xd8R\xbfzC-\x19W\xb5\x19\t\xd3\xda\xc0\x17Z\"uU\x140_8\xb4:OW\n\x8e/\xa1hd\xc2h\xa3\xaa\x04\x9e\xea?\xb7D\xaal\x00\x15\aL^-\xa7N\xdcSj\xd5!\xaa\x92\x1d\x85\xf5\xa1A𢲲\x9a\xc1\x81пVG\xc5k\xcb@\t\x82\xac֯\x01\xa6\x81;^\xcb]o\xb8\xf8edR\x04\xab\x1c\x13$h\xb7_\x9b\xca\xfe\xe3,+i\x1d\xcbŜ\xe9t\x055Ԫ\x89\x04\x94r\xee\x85\x14\xd5U\\i$_[\xa6\n\xc6(\x17\x9f?\xcbSO\xae\x14\xd6\xdc<\xf0\x97\xaf^\xb9\xf4g\xbf\xd3\n\x0f\xef\xd8%t\xe4\xfa\xaf\x00_\x00\x88c\xc3\xc7>\xf4$\x8bs\xbd\xd6\a\x12\xc7q\xc8\x15Y˃\xcd\a\xc1Ċ{1/~\xfc\"Q\xe4\xd5#\x97\x16)e\x91\xd4\x14y\x1b4\x98\x9a{\x85tz4uB\xb9\x00MqR\x87\xa6+\xd8\xeeb\x89\x11\xa28j\x90\x98F\x84\xb8\x17\a\xbb\xd7\x04\x82N\x8f\xe8\x95\xd6몹\x88\xe2G\xae\xbc\xc3\xf9\xe4p\x1bY\xdff\xe1<\x84RT:_\x10\xda\xeb\xb8?\xc4(k\xa9\x96ڜT\xd6#\xc5ū\xa8P\xb7fm\xadr\x934ʹ\x10\xa4\xaeH'\x87\t\x04\xc9\xc7m)]Yf\x83h\xa9\x06e\x1dB\xab5\xdcV\xcd+q\xe8\xea\t\x15;\tի\x9cvR\xa7~\xfa\x98\xd5vg\xb9qO\xda\b\xf2\xfaǰ^\xb5\xd3\x06@ڪώ\x0e\x0fx\xed\xda+\x81\xd0:\x8abN\xad\x9c\x0e\x82T\xd6\xe6%\x9b\xeb\x03\xc7\x18\xe1\xed\xadC^\xbd~\xd7\u007f\xeb/\x00?\x03\xd8w\x02^\xf1#\x82T\xf1\xe7\x8a\x03\xabO\x14)6\x9f\xf9\xe8S\xc4q\xd4 \x82sĕVn$\a0\x1bL(\xa9}\xb7\xa9\r\xd4\xf6\xb4\x12\xaf\xba\xa7?X\x83O\xd7*\xecJ[h\xbde\xc2\xfb<[8\x98\xa4*=\xebYVe\x9aF0g<\t\x86\x88\xe7|H\xa3Zp\xa3va\xae\xd8\v\x01\xa7\xb0h\xa4(\xa7\xec\xdfCW\xb99m\xf8.҂\x96\xdd\xe1n\r\xf8\xbbYU0\x1a\x05X\xfd\xa7'~\x1aN\xf8p\xeai'\x95 W\x03\xba\xdaw{\xb4&\xa1\xe8ށ\xa2\xc6OA\x83\x9bj\xf0[u\xb5\xbc\x17\x9di%\xae\xbd\n\xa8\x1a\xd0\x14RV\x84Վh`\xab\x1b\xa8u~o\xb6\x8a\xbd]\x84\xaa\xc7F\x04\x9b7o\xcb:`~ߙ\xc8yE\xfe\x98p\xfco}\xee[\vO\xac\xcc3\x1a>\xcd\u05ffu\xb3\x98G\xff\x1e0Z[\xdf\xf8\xe9w\xb2]\xd8#\xc9\x1a^\xbav\x9b\xcb\x17/\xf4\x81\u007f\x00|^\x81Ѱ\xcfg?\xfe,\x91\x91\xc6\xce$\x12\xc5,\x9e>\xcd©%\xe2(&I&\x9eUcH\x93)i\x92\x95\x96G\x14E\xac\xac\x9e\xf1\"\"\xd2IҖՆ\x8c4,\xafG\xb5\xb4\xa4\x9e\x9b\xd7a\xa5\xf8\xa4vY\x88\x0f\x83j\x16\x98\xd0q\xdc\vj`\xb5I\x12\xa8՜\xaf[r\xd2A~\u05cbh\xd2e\xa1\xd5v\xdf9.A\xa6\x9e\xa78\xd3`\x92NZ\xf1\x98\x16\x12j\xb9\x05e\xabP\xbdj#\xb4\xef\xfb\xb3:\xd3{\x92FtO\x1a@$\xed|S\x17XՊ8\x96\xf4\x81\xb6G\xa35L\x9f\f\xc2\x18\xa0Ν\xb2n\x91\xeb\xe6\xbdfqK\x0f\xbb\x1f\x91v\xa0_\x99\a\xf9\x10\xc7\xceҌ\xad\xfb\x9bޢ#\fGCz\xbd^cl\x15\xa9<us@\x15\xfa\xbd\x98\xe5\xd3\xf3\xdc{\xb0W\xbc\xfd\xf9\xcb\x17/\xb0\xb6\xbe\xf1\x8f\x1fu\x04=4`]\xbdr\x89\xcb\x17/\x18\xe0\x17\x81\x1fP`~\xd8\xe73\x17\x9f\xc9k\xc4xh>\x18\xf6YZ^ae\xf5\f\xc3ш~/f8\x9aCU\x99\x8c\xc7\xd5\xc4Te:\x9d\x06~\xfa\x99\xd5\x15\xe7\x12\x86VY\xdb<\xf2\x81\rS\x9f\xf0ǀV=\x82XV\xfe\f\x11\xa0\xed;\x15PJ\xe5\xc6P\xe56\xc6=\xd3z\xce\x06pI\x05\xb6E\x04\x87\xba\x1b\xd9\x014\x8d\xe8\xa2\xf7w\xa3\xaf\xa4\xc6M\xc9C\xf0\x01\x12\xca\x06DZ\x8e'\xd2\x11\x8eo\xab\xca -. \x81\xfa\xbe\xc9\xc4豳\xaa\xf1\xc9Z\xf4uv$\xce\x01RfK\xadT\xbd\xa2\x82\x8a\x1f\xa5\v74\xa1^&۳\x1c\xc5S\xef\xfbV\x8d\xb6\xd5;kpMB\x8b~#\x94\xbd\xcc\xe0\xb2\x1a\x1f\xb1ի\xf6\x98M}\xfd#L'S\xb6\xee\xdf\x0f6\xf6\x1d-\x8c\xbc\"\x9bڰ\xe2\xca\xc5\xc7\xda`>\xf6∳ˋܺ\xb7]\xbc\xfeC\x97/^\xb8\xfeҵ\xdb_}WHwg\xba\xfdG\xc0\x8f\xa8*\x83^\xcc'_\xbc\x10X\bs\xa3\x11瞺\xc0\xf9\xa7\x9ffaq\xc1qB\x95\"{\xb4\xb0\x18\xa2e\xdcs\x9fqº4!I\x92\x87ZK\x1a=\xad\x1d\xf5\xaeԧ\x8eg\xaf\xfc\x9dJ\xeb\x96\xf0{\xb9S\x8a\xe7\xde\x1d\xb3\x8dLKt\xac*\xc84\x99N\x9c\xb0V\xc3m\xb58\xb6\xc0fs\xbf\x87\xfaܷU:L[\x10\xe2\xf8U[\xc3\x1cA\xeb@\xda\xceZ\xa7[\xeb-ע\x9b\xda\x12\x1d\xf5#\x94R#\xee\x9b\xdd\xe8'i\x15\xd7$-eq\xba\xc0JD\xd8\xdf\xdf+\xb9\xa5f\xad*Z\x85\xffhK\xfeaY\x00V\x1b\xd1k\x15\xaf\x92\xea\xc3ZO\xc7T\x11\xedr\x05\xbb\x02G\xc7Ϩ\xb0\u05ce\x0e\x0e\xdcF\xb1\x15g\x1cy`U\x10\xef\xd5\xfeU\xb9\x974\x1cͱ\xfa\xe49\xe2^X.j؏\xf8\xdc\xc5g\xfd\x13\xfe\x95\xabW.}\xe9Q\xa2\x87\xc7\x02\x96Wt\xef\x8f\x03?[\xb8s\x9f\xfe\xe8S\x18\x91ro\xbc\x95\xb3\xab\xac>y\x8e\xe1\xdc0w\a\x8b\r\x03\\\xfaK\xbe\x87`\x94o\xd3UlR\xe96TP/\xd2e\xb5#y\xb3mei\xd9\x19\xe5\x91Կ\ry@-\xc9Wg?d\x11\xbf\x06R\x1eH\xb2\xea\u05fe\xd6\xe3!\xcc\x15q\x14\x15\x97\xb7\xe5\xaf\xf4\xa1\xe5\xd0V\x17K\xeb\x18Q\x8bؔ\x82Q\xd5F\xce[}\xc3\x03mԐoc\xe1\xd5\xdb{\xb1%\x18H\xc7\xef\xe0\xd85\xe2\xde\x13X\xb6\x97Q\xae\xa4'\xb5L\xbcj{2\x15\x1cZ\x05\x11?Ֆ:X\x1a\xc6\x10\x8e\xf6\x0eپw\x1f\x1aVS蠷\t\x96\x83\xe8\xa8VY\x1c\xc5\xc6\x15\xbea[\xecC\xd8\t<\x1a\x9a\xccŝ\xdaf\xa1\xfcN\a\xb0kς\x87\f\x8f\x04\xf3\xa1n\x05\xf6\xe2\xd8պ\xf7\xac5\x85\xd5\xf3O0\xbf\xb4\xc8`n\xc0\xdc\xdc\x1c\xa3\x85y\xd4Z\x96Wϰ\xb0\xb8X\u07b3U\x18\r\">\xfc\xec\x13\x85\xe4!\x02\xfe\xc1\xd5+\x97\x9e~\xd8\xfc\xc3c]B\x97n\xf3)\xe0\xff\x04zif\xf9\xc4\vO2?7(\x1f\xd8\xd9\xf3\xe7\x99_\x9c/\x89Q\xfc\xc8]Q\xe4\xdf=\xf4\xf1ф4I\xcaԎbsH\xdcd_^]f\xd0\xeb7\xb37Z\\\x13Q\x8f\xc3\xf2\xd2PD\xa4\xdb}\x9a\xc1i\x155\xaa\xa4\xa3\xc8V𪑶l\x91\xb2`[\xb9\x11BG\xec\xadN\xf8\xe7QN\t\xacR\xdf]\xbd{\xeb6\x8bKK\xdd8\"\xfap5\xae\x02/N\x1e\xce=\xec\xdcCB\x82\xc8\xef\xa3\x05\xa7\xeb{\fv琶_\x8b4\xf4\xf0m\xd9Bݹun/H\x11\xf6wv\xd9\xdb\xddc8\x9a#\x1e\f\x9a\x82L\xa9U\x1c-\xd5\xfemI\xca\xce]\xb457P۟A\x98\xd3XO\xc0\xef^\xeaf\x16\xab,\xaf\xd36\xbe\x1dD\x1a\xb5\xfd\x01\x8b\x03\xab\xfd\xfd}\xf6\xf7\xf6\xcb\x1d\x92\x86\xf3#b\x17\x99/\xee)\x8a#N\x9f]a~q\x81\xb8\xd7\v\xef\xcdZz\x83\x1e\vKKL\xc7c\xb24Cİ4?\xc4b\xd8\xde=\xc0\x18\xe9\x03?x\xf9\xe2\x85\xff\xf5a\b\xf8\x87\xb1\xb0\x9e\x04\xfe!0RU>\xfa\xfc\x13\xac\x9c\x9e\xc7Z\x8b\x18\xe1\xdcSO\xb2\xb0\xb4йrH(\x0fw\x16U\xd5Qq\xaf\x17\f\xd6b\x97\xd9.\xb3d\xb69\xdd^N@\xbb\x1fu\xab\x05\xa6\x0fAs\xb6\x81\x83\xef\xcfO\xa7\x19\xc9dJ\x92$\xa1\"X\xa4\xf3\xa8\xd6\xdar\x0fC<N\xe0\xee\x8d;lonsxp聩\x1e\xbf\xa5\x99vm\x00Z\xf7\r\x9bB\xcdc\xb6\x11\xac\xfe\xb0u\x11)\xcd]wB\xe1\x17\x82%\\\x91ڬ\xa7\x16\xcfR\xabX\xa5\xd2\f\xf1+\x0f\x03V\x04\xd1HU-\n.p\xeb\xfa\r\x92\xc94\x04\x12mtU\xb3\x83j\x9bD\x14tA\x17\xf0J#Z\xd1R\xf7Ku\xe6b\xf1p|\xc11UHg\xc7DȲ\xac\x02\n\xb7\xcbzݽ\x8e{1\xc6\xe4\xea\x80\xfe\xdc\x1cs\xa3Q\xae?\xf4\xfa\xc2\x18\xe1\xe9\x17\x9eg\xe5\xfcYL\x14\x91f\x19\x1f\xba\xb0̙\xe5\xc5\xe29\xfc.\xe0o]\xbdr):\xce\xca2ǹ\x82\xe4\n\xf6'\xac*\xab+\v\x9c;\xb3D\x96YLlx\xe6\x85\xe7\x99_X\bŗ\xde\xde{\rK\xc7i6\xfc\xe7\x11\xf7\"\xe2\xa8R\x83\xa7\x99\xcdU\xcb\xfe\x88k\xec\xcf\xd6\xde\xf9A\x9d\xf2\xae\x95Vg\x80\x96\r\xbc\x94Z\x16\xbe\xe7^\x88\xb4\xe6\xf6\x85\x9a\xc4\xdcz\xb4\x99\x92$\x19\xe3\xf1\x98\xc3\xfdCƇG\xa5\xe4\xc1\xbf\x1c\x11\xc3\xf6փ\x8a\xbcwiBwn\xdcf{\xeb\x01\"\u00ad\xeb7\xb0N\xefRg\xcd\xc2ZTM\x0e\xa5U\xedN\x13\\\xfc\n\x0e\xbe\xabަ\xb0\u05fa[\xd8\xd8X\xc2+\x1f#\xeam\x03&\xa1=\xd5Bu\x05n\xa4W\x83\xbe\xcc۬\xd7\xd9R-\xeb\xackG\xe5\a\xad\x97\a\xaeo2b\x95\xdbo\xdd\xc0D\xc6\xfb\xae\xadxX\xad\xb6\xebR\x81,II\x93\xc4\xcb5\xf4ĸh\xeb\x02[\x89\x9c%\xa8\xf8\xd0*g\xa0Y~(ଢ\x03\x9a\xfe\x00\x00\x14\xa8IDAT\xbaD\xd2\xcc\xce\x14\xd0.\xfe\xcd\x13\b\x17\xbbB\x15}\xd5\xef\xf5k\xc6F\x9e\xb2\xd4\x1f\x0e\xbd\x18l\xbe/\xc3\xfc\xd2\x02\xa3\x85\x85|\x8b5\x11L\x9c\xab\xa7N//\xf3\xccG\x9e\xe7\xcc\x13g\xb1\n\x1f\xfb\xd09\x06\xfd\xb8\xb8\x82\u007f\x03\xf8\xe1\xe3\\è\v\xac\xdc\x17\xff\x14\xf0\x1f\x03\xf4\xfb1\x9f~\xf1\xa9\\\xaa/\xf0\xe4SO\xd3\x1f\xf4\xbd\xe8\x80\xdb0sFX\\D\xd8\xdf\xdds.a^?J\x8c\x90e\x96$IP\xab\x8c\x16癛\x9b\xcbWnc\x02 l\x84덷;tMG$\xd2\x1e\xa6o\xba\x825[I]ı\xd3]r\x1b\x96\x96\xa2\x1a\x9dyϥ\x95)\x86\xc9\xd1\x11\xb7o\xdc\xe2\xfe\x9d\xb7Y8\xb5D\u007f0(9\xbbH\x84\xe94\xe5\xf6\x8d\x9bL&\x13v6\xb7y\xfb\xd6\x1d\x0e\xf7\xcb\fx\xac\xb5\xec\xef\xee1\xbf\xb8\xe8i\xdef+\x11\xa43\xc4\xca\xf1i\x83m\xc5\x10J\xcaN\x9a9\xd1]\xc7\x0e+\xb5\x04\x91\xd0b;\xaf\xb2tFÚV\x02\xb9\xb5\xa7\x98\xd5zn\x96о\xc1\xa8\xcevO\x8f\x0e\x8f\x18\x1f\xe6U5\x93|+v\xe6\xe6\xe7\xbc\xf3\x19$6`-\xe3\xa3\t[\xf76\xb9{\xe3\x16I2e07̽\x04\r\xf7\x1e\xd4\x0e\x11hq\xf9V\xedL\xab)\xdfٹ\xdb\x05\x9cEҷ\x16\xc1TZ^׆\x8cC5\x973\x88\x11\x1elm1\x19O\x88\x8ca8?ʣ\xf7Rm.\xabVY^=\xe3x\xe9\x9a%\x14\x19\xfa\xfd>\xbd\xe1\xc0YfU\x1b\xceͱxz\t\x01\xe6\x061\xb7\xee>(d@?v\xf9ⅿ\xba\xb6\xbe1~$\xc0r\xbc\xd5i\xe0\xff\x02\xe6\x14\xe5cϝg8\xec\xa1V9\xb5\xb2\xc2\xe2\xf2R\xb3S:\xf4A\xfe\xc4=:8$\x99N\xab\x1du\x9d\xcf?q\xa6\xf8t2\xe1\xf4ʊ\xf3\xa3m9!\xdbx%Q\aX\xf5\xc9\xe5\xa9p\xcb\"g\xf2\xf0:-\xf1v\x04n\xd3l\x89\xf1\xcbј\xea\xf8m\xd3\xc1\bi\x92p\xe7\xc6M\xee\u07fdO\x96\xa4Xkټ\xbf\xc9h4G\xdc\xeb\xe5\xc7\xd2\xfc\u07b7\xeemq\xb4\u007f\xc8t2\xa9D\x93\x9e\xae'\xcb2\xb67\xb7\x98\x9b\x9f\xa7\xef6\xba\x14\xaf\xcco\xf9\x9f\xbf\x91\xab\xa9\xa4\x18\xc5\xe7\xab\xe2o\xcd\xfd\xecEk7^#\xa9\x83\xea\x01B)\xf1\xa8\xf6;\xf7\x8f-\xd53\x91\xaa\xf0\x8ex5{\x8c\x84\vDH\x8aKK\x85Q\xafv\xba\xbf\xe34\xfe&\xb6U\x9f\x05\x1b\xdbJݰ\xcc\x13\x92\xf7\xb6\xf7\xabEuo\x9f\xfep\xc8p4\x87\xb5\x96\xc3\xc3Cv\xef?\xe0\xed\xdbwټ{\x8f\xf1\xd1\x11\nL\x8e\xc6\xecn\xef\x92&)s\xf3\xf3DQ\x8b\xe5\xed-\x8a\xd55\x84%\xa7\xdb\xd34u&}\x11P\u007f\xc5\xfe\x00\xfeލ\xbe<\xc6{\x86U\x11\xc2f\x84=\xcb,\xea\x02\x03i\x9ar\xe7\xe6\xad\\o\x18\xc7̏F\xe0\xf5e\x96e\xf4z1+O\xacΌJu\xea(E\x98[\x98\xe7\xec\xea\n\xbb\xbb{\xec\xec\x1e \"\x8b\xc0\xe7._\xbc\xf07/_\xbc\xa0m\xa5if)\xdd\xffK`YUy\xf6\xc2*\xa7\x97\xe6Ȭ\xd2\xeb\xf58}f\xb9\"\xeff\x8a\x84\x9a\xad?쳿W]\xb4Zu9\x869\xbfeS\xcb\xcd7\xae\xb3\xb4|\x8a\xe1p@\xbf?\xc4\xf4{\xa5_\\/\x1f\x92wtV&\xc0f\x99s<\xc4\xe4\x8aj-\x06\x89\"b\xaa\xed\xe0]^c=U\xa6\x8c\x82y\x93Hj\xf2\xf7,\xb5$\x93I^+@s\xa7!\a\xdb1\xa7\x96O\x97\xbe\xfed\x92\xf0\xe0\xde}v\x1e\xec\x80f \xa6T\xa1\v\xf0\xfa\xab\xaf\x13\x1b\xb7۰sUDr\v\xaf\x14㩀q\x85\xea<\xc5\xe2\x8d\u05efW+WM\xa8(\x82\xdb\xd2>\xa2\u05cb!\x12L\xb1\x0f{\xb1RE\x11\xbd\xb8G\u070f\x83*\x15A\x96\x8f\xb6\xe2\x19i\x9a\x91L\x93\xbc\xbe\x93\xb6\xf2\xd9!\xfa\xd5j\xa0\xc7q\x8f\xd8{\xa6\x05\x88e\xb5}ރL\x87\x9aԣ\xbe-Z\x96U\x9ca}f\x17\xeec\x14G\f\xe7\xe6\xe8\xf5s9\x8dՌd\x921\x9d$\x88\xa9J̈\xc0ݷn\xb1\xfb`\x87\xc3\xfd\xfd<\xed\xa4\xa8\x1ej*WN\x00\xcd,\x0f\xeeo\xb1\xb7\xbd\xc3\xca\xea\x19\x16\x97\x97\x89{1\x822\x1eO9\xd8ۣ?\x1c\xe0\xeaE\xba\xa4aS\xee\xaa,\x05ظ:\\y\xb0Ӎ(\xf7\x85R\x80\xaa\xd5\x18\xb0YF\x9a%d\xa9\xab\x96\xea\xc6J\x91<\x8e\xd3\xf4\x19#\x18q;_GU*\x981Q\xd9\xc1\xaa\x90N\xf3\xf4\x9a\xf1ј\xe9t\xcc\xd6\xfd-L\x14a3K\\\xeeM\xa0n\xe3\x97\xfczFK\x8b\xb9½\xd8\xd4\xe2\x11\x85\xc4j\xf3\xa8\xee\xf7_\xfe,\xbf\xf0\xf7\xfe)\xfb\x87G\x18\x91\x1f\x05~`m}\xe3\x97xXhqڈ_\x05\x18\x0e\xfa|\xdf'\x9e!\xcbr!\xd8\x13O\x9dg4?\xdfLD\x16\x99\xb1E\x97\xe7\xf7f\x197\xaf\xbfU\r&WRx\u007fg\x9f4K\xcaUp8\x18\xe4\xd6\v\xf9\xe4\xeb\xf5\xe2<\xd1\xd2Ya\x05H\x15\xcaݢ\xd3J\x86\xa0a\x91\xa9\xb7\xbb\xb4\xb8\xb4\x83\x82\xc8+\xaa\x8e\x86\xc5\xf6ʁR\x82V\x1e:W\v\x99+\xc6\x16l\xf6\xabB\u007f\xae\xcfp8$\x99&L\xa7S\xb0\xd6%\x88\x9ap\xd13\xd56\xf6\x81\xdbVZ\n\x04\x05\b\x8dg\xf1\xd57ި,\xa6\xba\xbd\xa8\xcdm!Dj\xc0$M\xc1\xad\xf8\xfa\xb2\x16\x9fQ}\xfc\xf1MP\xbf\x12\xa8\t\xa2q\xbe\xe9T\xa4\xe746\x01\xf57ͨ\xd7\xccG[69\xd6`\xb3\xd6\xca{\xac\x15\xbf+\\Y\xabe\xff\tޖWV\x83\xa8o\x11\xae\xcf4\xc3`\x82\xe3\a5\xb1\xca[\xb2\xa8U2k\x89LDo\xd0#\x8ab\x0e\x0f\x0fɒ4 \xaa\xd5[0r\x85@%4m\\oP\x11U=y@\xbe8k\xe66h\xadWw\xa8\xa7^9\xcb\xdaH\xe5m\xf4\xe3\x1e=\xb7\x0fh\x9aY\xd24A\x1dɞY\xcb\xd4y<\xd6Z\xe6\x1c\x99\xee[\xe8\x18\xb8\xf0\xec39\xdf\xf7\x1d6c\x84\xbd\xbdC~\xfe\xef\xfd\x93b,~\x13\xf84\x90\xd4#\x87]\x16\xd6\u007f\x91\x13\xe0\x19\xcf]8S\x16\xa2\x9f\x1bͅ`\xe5Y!y\xc82\a\x84Y\x9bHDqL\xaf\xd7#\x99&\x14\x9a.\xb2,\xcf䷊Ĺ50\x9d&ί\x15t:e|D\xe0\xe6IT\xed\n#N\xe7U\x10[\xe2\xd5-6b\u0089\x1e\xe4߉W\xd8\xce\xe5Dy\x95&\x8b\x81c\xbc\x1c\x8f\xe2aEQ\\\xe8۽ݭ\x04\xac2>\x1c#\x92\xab{\x95\xb8\x04$q+T\x11N\x17\xf2\xa4\xf0\x82\x174\xbe;\x1b\xc8,\xca\xffy\x1eoXų\x02\xac\xe2@e5\xf1\xcam(,\xb8\x96m\xca\xca\u0098%OUs\x95;S\xa4\x1eM\xcd \xc1n1\x95\xb4!({\xa2~Νx\x9a*\xa9\xf2\tUB\x97)(\xbeW\xe8\xcdl\xcdͪ\x00GE0\x8e\xbc\xc7\xf1E\xc5\x15\x18G\xe6G\x1a{UK\xfdȢ\xd46\xb9\x88P\x03\x91\xb3\xd0\xd2iJ\xaai\xbesr\xbf\x1fJ\"\xc4\x12\\\x96\x9f#\xa9~6\xad-5z\xbe\xf4\xadp\x81#\x89\xf2Z)j\xc2JAe\xa1Hi\xc8\x1aTmY\xbf\xf0p\x9a\xc0\xa1\x12\x14\xf4(\xb8)U\x8c\x1b\xa76\xcd\x1ar!E\x99\x9b\x1b\xe5\x89\xffmd\xdd#ZZ\xd6*\x8b\x8b\xf3<\xf7\xccy\xde|\xeb\x0e\"\xf21\xe0O\xac\xado\xfc\xc5z\xbea\xdcb]\xfd\x04\xf0{\x00V\x97\x179szTN\xa83\xe7\x9e(C\x9ci\x96q\xb4{\xc0\xe1\xc1\x01\x93\xf1\x98ԭ$\x8b\xa7\x96X}\xf2\\{\x19\xd6\x128L\xc0\xf1D\x1a\xe5\x115k\x91L\xd0(7om\xa6H\r\xc0\x8d\bo\xef\x1cro{\x8c\x91\x8e\xe0\xb0̖\x1f\xd4#\x97m\xfc\x80\x141Tm3-\xba\xbf\xaf\xb5\x1d\xf3\xc2|\x1c\x1b\x16\xe2\x9e\x19\x10誆 \x04ێ5k\x1f\x97\x1c\x8f\x88\xd6\x15]\x1d\xc1\xee\xe3\xb7\xe3R\xde\xdd&\xc7J\x19\xdb$\x003*F\x94$\xbft\x84De\x86\xfeeF\xa9\xe6._\xb9Fz\a\xa3Of\xc8r\x84\xd6z\xc7ڔ \x1e\xafw\xd3p۱\xae\xdeQU\x9e9\xb7\xc4\xfc \x0e#\xbf5/%M\xd2\xf2\xf5(\x8e\x83\xca\x11\x01\x8d\xf0\x8eW\xb0P\xd2\xf3\xb9Ͼ\xc8\xf5\x1bwKJ\xea\xea\x95K\u007fcm}c\xaf\xd5\xc2r\xa1\xc4y\xbc\xaa\xa1/<\xb5Jf-\x82\xb0|\xe6\f\x18a\u007fg\x8f\xdd\xed\x1d&㱫Q]Y7\xc6Dlon3\xbf\xb8\xc8p~\xae}\\\xb4\x8d5\x03q\x14\xe5\x9a%g\x96\xe6;&\x83\xd1\xcag6\"\xdc\xdb9\xe2\xd6\xfd\x03\x8c@\xf6.O\xa2w{\x92\xbew'y\x1c\x17)\x9c\xb4\x96nx\xcc]\xf3^\xf5\xf2\xb7on\xf3\xc2S\xcb\xcc\x0f\"o\xd7oW\xb1\xcc\xe6\x00Rp\xaa\x12\x9b2ݨ\x9a\xbb\xb9\xfcH\xc4̎\x8esܶ}U[ZZ\xe0\xd2\xf7]\xe47~\xf3\xb71Ƭ\x02\u007f\x0e\xf8\x19\xdfʒ\x9au\xf5'\x81\xff%\xb3\x96sgN\xf3ⳫX\xf22\xbf\xc6\x18&\x93I\x18A\xf3\xac\x1e\x13E\xbcuw\x8bs+\x8b,\xaf,s\xe6\xdc٪\x14\xab\x867\xb1y\xf7\x1e\a\xfbUTF\x814I8\xd8\xdbgr4i\xe5i\xa2(\xe2\xfeބ{\x0f\x0e}\xc3\xe4\x1b\x8f\xe99\xcbC\xc0\xc8\xc3\x14\x80\xd2Z\xe4\xb5-U\xcf\xd4L%\xfb\x90\xd7_\xff\xac_\x1f\xb8\xeds\xe9#\xc0\xa1\x1es\xcf\xc2{cd\xb5l\xf9\x1cԎy\xd8\xe7lx\xc4\xd2I\xef\xf0z-͌Ji\xb9\xf6\xc79&\xeb}d\x1f\xa1_\xac3J>TYZ\xa7\x19\xf5jz/\xadj[\xcc͏\x18-\xccc\xa2\xa8,V\x89\xc0\xfc\u0088SgΔ4P\x93\x972\xbc\xf1\xe6mv\xf7\x0e\xf8\xfcg?\xe6\xcaLkk\xcaOp\x81\n\xeb\u007f\xf7\x97\x98\xa6\t\x82\x1c\x00\x1f][߸\x15XXκz\x01\xf8\xef!\xe7^^x\xfaL\x99ٝe\x19i\x9a\xe6ķ\b\xd34\xb7m&Ӕ\xc3\xf1\x94݃1\xfb\a\x13v\xf6\x0eY^Z`:\x99tv\xa1\xaa2\x98\x1b\xb0\xbf\xb7\x17\x10\xf5Q\x14\xd1\x1f\xf4\x19\x1f\x8e\x1b\x91\x84\xb8\xdf\xe3\xce\xf6\x84\xad݃\x82|\x9e\x02?\xe5d\x17\xe6\x1d\f\x02}\b?H\x1f\x12\xb0ڶ<>\xee8\xef\x95Y\xf4]a\xbf\xbdK`\xf2^\xf6\xf3q\xcfW\x1frL>\xccqd\xb6_\xfbP\xd7\xfb\x9f\x01\u007fNDx\xeb\xee\x0e\x17\xce.\xb2\xd83d6\v\xe6\xa4\x18Co\xd0\xcf\x01\xa9\x9e\xe3\x9bkeJˬ\x8d\xde\xd8\xda\xda巾\xf1*7n\xdceaq\xc4\xf9'ΰ|z\x91\xa5\xa5\\\"17\x97GOSOQ\x1f\x1b\xc3\xe7>\xfb\"/\xfd\xda\u05c9\xe3x\x1e\xf8k\xc0\x8f\x17V\x96x\xd6\xd5\xdf\x06\xfeuk-\xcf?\xfd\x04\x17V\x17\x9d\x00\xb3\x12\xe9\xdd\xdb\xdegk\xfb\x80݃#D\x84\xc9$q[\xb2\x9bR\b\xfaً\xcfrfy\x91'\x9f{\xbaJ\x02\xaeui\x9a$ܽu;\xb7\xc0\xbc\xf7\xb2,cow\x8f,\xc9I\xf8\xc8D\xcc\xcd\xcf\xf1\xfa\xed\aln\x97`\xf5\x00\xf8\x89\xb5\xf5\x8d_?\xf1ON\xdaI{\xb4\xe6\x89\xc2\xff\x18𗁾U\xe5\x99s˜[\x99\xe7`\xff\x00U\x8bHD\xaf\x1f\xb3\xb8\xb4\xd4\xf0v\x8c\x18\x16\x97\x97XX\\*1\xd0D\xa1\xa432\x86\xdf\xfc\xca˼|\xedͲ\xc0A\x92d\x18#\xf4\xe2\\&qfe\x89g\x9f9\xcfG>\xfct\xb0U^\x1c\xc7\xfc\xe2\x97_\xe2\xfe\xe6N\x81\x83?\xb5\xb6\xbe\xf1\xb7\xaf^\xb9\x94\xbb/\x8eh\xff\xafUan\xd8\xe7\xa3\xcf=\x81\x02\xbd(b\x92d\xbc\xf2\xe6ۼq\xe3>\x9b\x0f\xf6\x18O\xa6e\x88\xb8\xa8\xc2\xe0\xef\x95\xf6\xe4\xea)\xe6\xe6\x06,\x9e^\xf2v\xfa\b\xc3\xe3\x12ELK\xa2\xbejI\x92\x80B\xaf\x17\xd3\x1f\fX^]\xe1\x9b\xd7\xef\xf3`{\xbf\x00\xabm\xe0\a\xd7\xd67\xber\xf5\xca%\x1e\xf7\x9eg'\xed\xa4}\xaf7'\ngm}㫗/^\xf8\x06\xf0S\"\xc2\xce\xde!\xd1`\xc8\xf9\xd5Ӏ\x10Ǒ\xd3-F\x81\xcb'\"\f\xe6\x06\x8c\xe6\xe7\x89zq\xa0\xeb\xf1\x81͈p\xeb\xf6}\xeeo\xee\x94u\xe2\xa2\xc8\x04\xc7:8\x1cs\xeb\xce}\xbe\xf9\xad7\xd9\xda\xdaauu\x99\xd1hH\x9a\xa6\xac\xae\x9e\xe6\xb7_~\xbd\xd0\xea}\xe9\xf2\xc5\v\u007fem}#\x89\xae^\xb9\xf4<y\x05ѡ\b|\xfaŧ\xe8\xf7c\xa6i\xc6\xeb77y\xe5\x8d;\x1c\x8d\x93\xf2bE\xe4\x1e\xb9N\xe2\x1a\xf0s\xc0\u007f\a\xfc\xbf\xc0O(p\xbe\x06X\x95>&\xac>ptxDZ\xdbUv:\x99\x96\xe5\x88M\x1c\xf3\xad7ﱵ\xb5S\xdc\xe4u\xe0'\xd7\xd67\xbe\xf6NJ\xab\x9e\xb4\x93v\xd2\x1a\xa0u\xed\xf2\xc5\v\xbf\x05\xfc\xa4\x88\xf4wv\xf6\x91(fi\xd4#\xb3\x95\xbc\xa1\x9eZ3\x18\x0e\xe9\x0f\x87U\x92\xb3/\xeb\xf1\xe6\xf8\xcd[\xf7\xd8\xdc\xda\xc1\x88\x1c\x01\xff\x02\xf0e\xe0-\xe0.\xb0+\"\v\"2TUvv\xf6\xf9\xd6+\xd7\x19\x1fMY^^de\xe5\x14\x99\xb5ܻ\xf7\x00\x119\x05\xfc\xee\xcb\x17/\xfcu\xb9z\xe5\xd2\u007f\x02\xfc\xac\xb5\x96\vO,\xf3\xc2ӫ\xdc\xd9\xdc\xe5\xb57\xef9ux!\x06\xe1>\xf0\xdf\x02\u007f\xa9\x1ej\xbcz\xe5ҏ\x01_\xb6\n\x9f\xb9\xf8\fg\x97\x179\xff\xec\xd3y\xa4\xc1\x86\xb9v\x05\x88\xdd~\xeb\xa6\xd3x8\xb2-\xb3\xec\xed\xee\xe6\n\xed8\xe6ͻ;ln\xed\x159F\xaf\x01\x9f\xaf\x9f\xf7\xa4\x9d\xb4\x93\xf6X\xdcď\x03\xff\x14X\xce2˳O\xadrvi\xe06߰,\x9e:\xd5`\xc1\x86\x8b#N;U\xbf_m\xb4\x90\xf2\x18c\xf8\xd5_\xfb:\xaf\xbd~\x13c\xcc>\xf0\xcc\xda\xfa\xc6v\xed\xbc\xab\xc0\x1f\x04\xfes\xe0<`\xac\x03\xca/}\xe1\x93\\\xfc\xd8s\xfc\x9d\x9f\xfb\u007f8:\x1a#\"\x16\xf8\xe7\f\xb9\xa2\x94\xc1\xa0\xcf\xe9\xa5y\xfe٫\xb7x\xf5ͻ9\xa7\x96\x03\xcd5\xe0ǀ\xe7\xd6\xd67\xfe\x9b\xb5\xf5\x8d\xbd\x96l\xea4\a\xda\\5l⸥\x9eU\x85\xbc\a\xbb\xfbN\x01\\\xbd\xb6\xbf\xbbG\x96f\x98(\xe2\xd5\x1b\x9bln\xed\x16`\xf5U\xf2\x8d\x18\xf7\x1e\xd7\xee\xb1'\xed\xa4\x9d\xb4\x80\xd3z\x19\xf8!\xe0A\x14\x19\u07bau\x9f\x9b\xf7\xf7\x89\x8ca2N\\\x85\x11\x02\x1ekrpĽ\xdbw8\xda?\f\xa3\x84\xe2S<\x99\x8f\x0fY\xcbyﯭo\xfcO\xc0G\x80\u007f\r\xb8fL\xee:\xfe\xfao|\x83\xff\xfb\xef\xff*O]8[\xe0\x90\x00\x1f6Ν#IR\xbe\xf1\xea\rv\xf6\xca\v\xd8&\x8f&|nm}\xe3\x1f\x01e\xf8\xae\xc5\x1d+};k-q/.S\v\xea\xc2Mk3v\x1fl\ab\xc6$I\x98L\xa6Dq\xcck7\x1f\xb0\xbb\u007fT\\ë\xc0\x8f\xaf\xadol\x9d\xb8\x81'\xed\xa4=\xfeV\x94sY[\xdf\xf8-\xe0ǁ-c\fw\xef\xefp{\xfb\x90\xb8\x17\xb1\xbf\u007f\x90'E\xfbF\x96\xab\xac\xbb\xf9\xf6=\xb67\x1f\x84E\x0eɃ\x86^\xf4\xaf\x01X\xb5\xb9|\xb4\xb6\xbe\xb1\x0e|\x92\xbc\f\xfb\xbe\x89\f\xf7\xee?\xe0[\xaf\xbc\xe9C\u1ac6\xbc\x92\xe8\x1b\x903\xfb\x0e\xcd~\t\xf8\xd4\xda\xfa\xc6Ϯ\xadoL:@\xcao\xf7\n\xd0:\x1a'\xc4q\xdc\x01V\x96\x1bo\\'MS\xaf~\x93eog\x97^\xbf\xcf\xebwv\xd8\xdb?,\b\xf6\xaf97\xf0\xde\tX\x9d\xb4\x93\xf6\x9e\x80\xd6o\x02_\x046\x8d1\xdcy{\x9b\xbb\xdb\x13\xa2H\xd8w\xba\xc9:h\x89\b{\xdb;\xecln\a\xeawk\x95\xc3ã\x02O\x0e\n/\xac\xeb\xfc\xee\xb7][\xdfX\x03\xbe\x1f\xf8rnm\x95\xd1ǿ\x06|Ŭ\xado\xbc\r|Ρ\xebO\x03\x9f!/\xa4u\xf3\x11\\\xb0\xb7\x9cE\xc6\xfd\xed\xbd\x96\x84\xc8<\x87n{s\xb3\xac\xe8X\xdc\xf0\xc1\xfe>\x88\xe1\xb5\xdb\xdb\x1c\x1cM\x8a\x1b\xfcu\xe0G\xd6\xd67\xf6O\xc0ꤝ\xb4\xf7\x14\xb4^\x03~\x14\xb8+\"\xdc{\xb0ǭ\ac\xb2$\xe1\xe8\xe8\xa8=\xd1Z`o{\x87\a\xf7\xee\x97s\u007f<\x9e\xb0\xb3[VȽ\xcdC&\xa6\xb8k\xf8\xfa\xda\xfa\xc6\xef\x01^\x04\xfe%\xe0\xf3\xc0O\xaf\xadod\x8fE\\\xe7v\x81~CU\xcfϏ\x06\xfc\xe8\xbf\xf8EF\xa39/\x9f0/\x01s\xfd\xb57Q\xb5DQ\x9e4\x99N\x13\xc6ӄW\xde\xdab\xe2jd\x01\xff\x1f\xf0C'\x04\xfbI;i\xef+\xb7\xf5\x11\xe0\x9f\x00\xe7T\x95\xe5S\v<{v\x91~?&\xee\xf7[\xd5\xed\xd6ZF\v\xf3\x9c=\xff\x04\xe3I\xc2\xfa\xcf\xffr\xa1\xa3\xfa2\xb9v\xf2;\x162\x9b\xc7t\u007f\n$ L\x934\xd8\\\xb1\x90\xe3\xef\xed\xec\xe5\x85\xfb\x10\x92\xe9\x94\xc9\xe1\x11\xbbG\t/\xbfq\x8f\x89\xab@\n\xfc#\xe0\xf2\t\xc1~\xd2N\xda\xfb\nV\xac\xado\xbcJ^k\xfdM\x11\xe1\xc1\xce\x01/_\xbfOb\xb5,B\xd9\x00\x13c8:8\xe4\u038d[\x1c\x1d\x8d}k\xec\x1a\x8f)\xeb\xe2q\x01V\n|U\x04&\x93\x84$\xc9Hӄ\xa2\"\xc1d<fg{\x1bc\x84Ã\x03\xc6\aGdQ\xcc+o\xde\xcd\xebl\xe5\xc7\xf8\x05\xe0\xf7⸰\x137𤝴\xf7\xdd=\xbc\x01\xfc \xf0\xb2\bL\xa7\t/\xbf\xfe6\x12\xc7\xd8,k\x05-\x11!\x99L\xb8u=\x10u\u007f\xe5q\xcdg\xf3\x98n\xd0:W.'\xdb\xf6\x0fɦ)\x93\xf1\x98{w\xde\xe6\xce\xcd[\xa4\x93)\xe3\xf1\x84\xf1јx4\xcf\xcb\u07fe\xedn\x10\x80?\x0f\xfc\xab\xe4т\x93\x11s\xd2N\xda\a\x00\xb4\xdc\xef\xeb\xc0\x17\x80\x97\x8at\xbcko\xdc\xc1\xf4⼈f[\x19)1$Y\xc0\xb1\xdf|\\\xd7\xf583\xdaw\xf3k5<\xd8\xdcbz\x94\xd7nϲ̡\xee\x94\xe9x\xc2p4\xe2ڷo\x93L\xcbMC\xff\x87\xb5\xf5\x8d?}2DN\xdaI\xfb\xc0\xba\x87\aW\xaf\\\xfaa\xe0WD\xf8\xc2\xf6\xf6\x01/\xbfv\x9bO|\xe8<\x99͈Ą5\xeeD\xbc̈́\x01O\xf6\xf4Aq\t\x01\xf2\x9dU]\xb5\xc74I\xf2\x82|\xae`\xfdd2\x818\xe6\xda\xf5MƓ\x92`\xff;\xc0\xd5\x13\xbeꤝ\xb4\x0f\xb6{\bL\x80\u007f\x19\xf8\xb61\u0083\xed}\xae\xbdq\xa7ڎmvͫ\xc1\a\n\xb0\xae^\xb9d\xc8s\x85\xe8\xc5q\xbe\xd7XYN\xd62\x19O8J\x85o\xbd\xb5\xe5o\xc7\xfee\xe0\x0f\xad\xadod'n\xe0I;i\xdf\x15\xee\xe1}\xe02p\xd3\x18Ã\x9dC\xbe\xf2\xf2[\x8c\xa7i\xb8\x91\xac*\xf3\xa3\x81_q\xe6G\x1e\x97Q\xf2\x1d\x03\x96\xbb\x90\b\xf88\xe0j\xba\xdbҺ\x9aNSnm\x1e\xf2ƭ-_F\xfa\x97\x80\u07fb\xb6\xbe\x91\x9e\f\x87\x93vҾ{,-\xa7\xdb\xfc\x01\xe0+\x00I\x9a\U000555efs\xf3\u07b6ۮ\xcd\x01KX\xbf\xfc\x8b\x8f\xcb82\x8f\xe3F\x1c`\x8dT\x95\xb9A\xaf\xbc\xe8\xed\xbdC\xbey}\x93\xed\xbd\xa3\xe2\x06&\xc0Ϭ\xado\xfc\xbb@r2\fN\xdaI\xfb\xae\x04\xadׁ/\x01\xffG\xb1\xa7\xc9\xebo\xdd\xe7\xeb\xaf\xdc\xe4h\x92 \x02\xfd^\xcfW\xa9\x9f\xf9\xc0\x00V\xbd\t\xb0\xb3\u007f\xc4o}\xeb\x16\xdfz\xf3\x1e\x89\xd3e\x01\xaf\x00\xbf{m}\xe3\u007f\xf4\xcd̓v\xd2N\xdaw%h%\xe4\xdb\xcb\xffa \x8b\"\xc3\xce\xde\x11\x1b\xff\xec\r\xbe\xf6\xf2[L&\xd3w\xa5\xe4\xeb\xe3r\tS`SD\xd8=\x18s\xed\xdbw\x98&i\x91\x13\xb8\x0f\xfcE\xe0\xfb\xd6\xd67~\xed䑟\xb4\x93\xf6\xdd\x0fZ\xaeٵ\xf5\x8d\xff\x1d\xf8\x14\xf0\x8bB\xbey\xcc\xee\xe1\x98\xdf\xfc\xc6\x1bd\x95N\xeb\r\x1eӞ1\x8f\r\x04\xaf^\xb9\xf4I\xf2\x9a:K\xee\xb8\t\xf0\xbf\x01\u007ffm}\xe3\xceIN\xe0I;i\xdf\xdb\xed\xea\x95K\xbf\x0f\xf8O\x81\u007f\x9e\xaa\xde\xfc\xeb\xc0\x97\x1ca\xff\xc1\x00,\xafN\xf4\x93\xc0\x1f\x02\x16\x80\x9f_[\xdf\xf8\x8a\xff\xfeI;i'\xed{\x16\xac\n\f\x88\x81\xdfG\x1eM\xbc\r\xfc\xf5\xb5\xf5\x8d\xcdǅ\x01\xff?\xe6\xea\xe1\x95̡\x95p\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,185 @@
+// Package scene provides a stack of game scenes, such as a title screen or an
+// options screen, and the transitions used to move between them.
+package scene
+
+import (
+	"errors"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// ErrQuit is returned from Manager.Update when a scene asks to quit the game
+var ErrQuit = errors.New("quit")
+
+// Scene is a single screen of a game
+type Scene interface {
+	Enter()                      // called when the scene is added to the stack
+	Exit()                       // called when the scene is removed from the stack
+	Update() (Transition, error) // called once per frame while the scene is on top of the stack
+	Draw(screen *ebiten.Image)   // called once per frame to draw the scene
+}
+
+// Overlay can be implemented by a scene that is drawn over the scene below it
+// instead of replacing it, for example a pause menu
+type Overlay interface {
+	Overlay() bool
+}
+
+type transitionType int
+
+const (
+	none transitionType = iota
+	push
+	pop
+	replace
+	quit
+)
+
+// Transition tells the manager what to do with the stack after an update
+type Transition struct {
+	kind  transitionType
+	scene Scene
+}
+
+// None keeps the current scene on top of the stack
+func None() Transition {
+	return Transition{kind: none}
+}
+
+// Push puts a new scene on top of the current scene
+func Push(s Scene) Transition {
+	return Transition{kind: push, scene: s}
+}
+
+// Pop removes the current scene, returning to the scene below it
+func Pop() Transition {
+	return Transition{kind: pop}
+}
+
+// Replace swaps the current scene for a new scene
+func Replace(s Scene) Transition {
+	return Transition{kind: replace, scene: s}
+}
+
+// Quit ends the game
+func Quit() Transition {
+	return Transition{kind: quit}
+}
+
+// Manager owns the scene stack, only the top scene is updated
+type Manager struct {
+	stack []Scene
+
+	// DrawOver, if set, is called after the stack is drawn to draw things
+	// which sit over every scene, such as a HUD
+	DrawOver func(top Scene, screen *ebiten.Image)
+}
+
+// NewManager constructs a new manager with an initial scene
+func NewManager(initial Scene) *Manager {
+	m := &Manager{}
+	m.push(initial)
+	return m
+}
+
+// Top returns the scene on top of the stack
+func (m *Manager) Top() Scene {
+	if len(m.stack) == 0 {
+		return nil
+	}
+	return m.stack[len(m.stack)-1]
+}
+
+// Len returns the number of scenes on the stack
+func (m *Manager) Len() int {
+	return len(m.stack)
+}
+
+// Update updates the top scene, applies any transition it returns then draws
+// the stack unless Ebiten is skipping drawing this frame. It matches the
+// update function signature expected by ebiten.Run
+func (m *Manager) Update(screen *ebiten.Image) error {
+	top := m.Top()
+	if top == nil {
+		return ErrQuit
+	}
+
+	t, err := top.Update()
+	if err != nil {
+		return err
+	}
+
+	if err := m.apply(t); err != nil {
+		return err
+	}
+
+	if !ebiten.IsDrawingSkipped() {
+		m.Draw(screen)
+	}
+	return nil
+}
+
+// Draw draws the top scene, along with any scenes below it which are visible
+// underneath overlays, then anything drawn over them
+func (m *Manager) Draw(screen *ebiten.Image) {
+	if len(m.stack) == 0 {
+		return
+	}
+
+	first := len(m.stack) - 1
+	for first > 0 {
+		o, ok := m.stack[first].(Overlay)
+		if !ok || !o.Overlay() {
+			break
+		}
+		first--
+	}
+
+	for _, s := range m.stack[first:] {
+		s.Draw(screen)
+	}
+	if m.DrawOver != nil {
+		m.DrawOver(m.Top(), screen)
+	}
+}
+
+func (m *Manager) apply(t Transition) error {
+	switch t.kind {
+	case push:
+		m.push(t.scene)
+	case pop:
+		m.pop()
+		if len(m.stack) == 0 {
+			return ErrQuit
+		}
+	case replace:
+		m.pop()
+		m.push(t.scene)
+	case quit:
+		m.Close()
+		return ErrQuit
+	}
+	return nil
+}
+
+// Close removes every scene from the stack, calling their Exit hooks, it
+// should be called when the game window is closed
+func (m *Manager) Close() {
+	for len(m.stack) > 0 {
+		m.pop()
+	}
+}
+
+func (m *Manager) push(s Scene) {
+	m.stack = append(m.stack, s)
+	s.Enter()
+}
+
+func (m *Manager) pop() {
+	if len(m.stack) == 0 {
+		return
+	}
+	top := m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	top.Exit()
+}
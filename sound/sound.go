@@ -0,0 +1,236 @@
+// Package sound plays sound effects and looping background music on top of
+// Ebiten's audio package. Changing music crossfades between the two tracks.
+package sound
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/vorbis"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+)
+
+// SampleRate is the sample rate of the audio context, sounds at other rates
+// are resampled as they are decoded
+const SampleRate = 44100
+
+// fadeFrames is how many frames a crossfade between music tracks takes
+const fadeFrames = 60
+
+// stream is a decoded wav or ogg file
+type stream interface {
+	audio.ReadSeekCloser
+	Length() int64
+}
+
+// decode decodes wav or ogg bytes, the format is worked out from the header
+func decode(ctx *audio.Context, b []byte) (stream, error) {
+	src := audio.BytesReadSeekCloser(b)
+	switch {
+	case bytes.HasPrefix(b, []byte("RIFF")):
+		return wav.Decode(ctx, src)
+	case bytes.HasPrefix(b, []byte("OggS")):
+		return vorbis.Decode(ctx, src)
+	}
+	return nil, errors.New("sound is neither wav nor ogg")
+}
+
+// track is a music track which is playing, possibly fading in or out
+type track struct {
+	name   string
+	player *audio.Player
+	fade   float64 // 0 is silent, 1 is full volume
+	target float64 // the fade is moving towards this value
+}
+
+// Manager owns the audio context along with every loaded sound. A nil
+// Manager is safe to use and plays nothing, so a game can carry on without
+// sound if the audio device can not be opened
+type Manager struct {
+	ctx     *audio.Context
+	effects map[string][]byte // decoded sound effects, ready to play
+	music   map[string][]byte // encoded music, decoded when it starts to play
+	playing []*audio.Player   // sound effects which are playing
+	tracks  []*track          // music which is playing, the last is current
+
+	master, musicVolume, sfxVolume float64
+}
+
+// NewManager opens the audio device
+func NewManager() (*Manager, error) {
+	ctx, err := audio.NewContext(SampleRate)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		ctx:         ctx,
+		effects:     map[string][]byte{},
+		music:       map[string][]byte{},
+		master:      1,
+		musicVolume: 1,
+		sfxVolume:   1,
+	}, nil
+}
+
+// AddEffect loads a wav or ogg sound effect, effects are decoded once when
+// loaded so they can start playing without delay
+func (m *Manager) AddEffect(name string, b []byte) error {
+	if m == nil {
+		return nil
+	}
+	s, err := decode(m.ctx, b)
+	if err != nil {
+		return fmt.Errorf("sound effect %q: %v", name, err)
+	}
+	pcm, err := io.ReadAll(s)
+	if err != nil {
+		return fmt.Errorf("sound effect %q: %v", name, err)
+	}
+	m.effects[name] = pcm
+	return nil
+}
+
+// AddMusic loads a wav or ogg music track, it loops forever when played
+func (m *Manager) AddMusic(name string, b []byte) error {
+	if m == nil {
+		return nil
+	}
+	if _, err := decode(m.ctx, b); err != nil {
+		return fmt.Errorf("music %q: %v", name, err)
+	}
+	m.music[name] = b
+	return nil
+}
+
+// SetVolume sets the master, music and sound effect volumes, each from 0 to 1.
+// The music and sound effect volumes are scaled by the master volume
+func (m *Manager) SetVolume(master, music, sfx float64) {
+	if m == nil {
+		return
+	}
+	m.master, m.musicVolume, m.sfxVolume = master, music, sfx
+	for _, t := range m.tracks {
+		t.player.SetVolume(m.master * m.musicVolume * t.fade)
+	}
+}
+
+// PlayEffect plays a sound effect, unknown effects are logged and ignored
+func (m *Manager) PlayEffect(name string) {
+	if m == nil {
+		return
+	}
+	pcm, ok := m.effects[name]
+	if !ok {
+		log.Printf("unknown sound effect %q\n", name)
+		return
+	}
+	p, err := audio.NewPlayerFromBytes(m.ctx, pcm)
+	if err != nil {
+		log.Printf("unable to play sound effect %q: %+v\n", name, err)
+		return
+	}
+	p.SetVolume(m.master * m.sfxVolume)
+	if err := p.Play(); err != nil {
+		log.Printf("unable to play sound effect %q: %+v\n", name, err)
+		return
+	}
+	m.playing = append(m.playing, p)
+}
+
+// PlayMusic fades out the current music and fades in the named track. It does
+// nothing if the track is already playing, an empty name fades to silence
+func (m *Manager) PlayMusic(name string) {
+	if m == nil || m.CurrentMusic() == name {
+		return
+	}
+
+	for _, t := range m.tracks {
+		t.target = 0
+	}
+	if name == "" {
+		return
+	}
+
+	// the track may still be fading out, if so fade it back in
+	for i, t := range m.tracks {
+		if t.name == name {
+			t.target = 1
+			m.tracks = append(append(m.tracks[:i:i], m.tracks[i+1:]...), t)
+			return
+		}
+	}
+
+	b, ok := m.music[name]
+	if !ok {
+		log.Printf("unknown music %q\n", name)
+		return
+	}
+	s, err := decode(m.ctx, b)
+	if err != nil {
+		log.Printf("unable to play music %q: %+v\n", name, err)
+		return
+	}
+	p, err := audio.NewPlayer(m.ctx, audio.NewInfiniteLoop(s, s.Length()))
+	if err != nil {
+		log.Printf("unable to play music %q: %+v\n", name, err)
+		return
+	}
+	p.SetVolume(0)
+	if err := p.Play(); err != nil {
+		log.Printf("unable to play music %q: %+v\n", name, err)
+		return
+	}
+	m.tracks = append(m.tracks, &track{name: name, player: p, target: 1})
+}
+
+// CurrentMusic returns the name of the music playing or fading in
+func (m *Manager) CurrentMusic() string {
+	if m == nil || len(m.tracks) == 0 {
+		return ""
+	}
+	t := m.tracks[len(m.tracks)-1]
+	if t.target == 0 {
+		return ""
+	}
+	return t.name
+}
+
+// Update moves crossfades along and tidies up finished sound effects, it must
+// be called once every frame
+func (m *Manager) Update() {
+	if m == nil {
+		return
+	}
+
+	playing := m.playing[:0]
+	for _, p := range m.playing {
+		if p.IsPlaying() {
+			playing = append(playing, p)
+		} else {
+			p.Close()
+		}
+	}
+	m.playing = playing
+
+	tracks := m.tracks[:0]
+	for _, t := range m.tracks {
+		switch {
+		case t.fade < t.target:
+			t.fade = min(t.fade+1.0/fadeFrames, t.target)
+		case t.fade > t.target:
+			t.fade = max(t.fade-1.0/fadeFrames, t.target)
+		}
+		t.player.SetVolume(m.master * m.musicVolume * t.fade)
+
+		if t.fade == 0 && t.target == 0 {
+			t.player.Close()
+			continue
+		}
+		tracks = append(tracks, t)
+	}
+	m.tracks = tracks
+}
@@ -2,28 +2,20 @@ package main
 
 import (
 	"errors"
+	"flag"
 	"image/color"
 	"log"
-	"os"
 
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/locale"
+	"github.com/Rosalita/my-ebiten-examples/scene"
 	"github.com/golang/freetype/truetype"
 	"github.com/hajimehoshi/ebiten"
-	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
 	"github.com/hajimehoshi/ebiten/examples/resources/fonts"
-	"github.com/hajimehoshi/ebiten/inpututil" // required for isKeyJustPressed
 	"github.com/hajimehoshi/ebiten/text"
 	"golang.org/x/image/font"
 )
 
-type gameState int
-
-const (
-	titleScreen gameState = iota
-	options
-	play
-	quit
-)
-
 // define some kind of palette?
 var (
 	green1  = &color.NRGBA{0x00, 0x38, 0x40, 0xff}
@@ -196,6 +188,26 @@ func (m *MenuList) DecrementSelected() {
 	}
 }
 
+//ItemAt returns the index of the item drawn at screen position x, y
+func (m *MenuList) ItemAt(x, y int) (int, bool) {
+	for i := range m.MenuItems {
+		ix := m.Tx + m.Offx*float64(i)
+		iy := m.Ty + m.Offy*float64(i)
+		if float64(x) >= ix && float64(x) < ix+float64(m.Width) &&
+			float64(y) >= iy && float64(y) < iy+float64(m.Height) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+//SetSelected selects the item at index i provided it is in the menu
+func (m *MenuList) SetSelected(i int) {
+	if i >= 0 && i < len(m.MenuItems) {
+		*m.SelectedIndex = i
+	}
+}
+
 //Draw draws the menu to the screen
 func (m *MenuList) Draw(screen *ebiten.Image) {
 
@@ -222,7 +234,6 @@ func (m *MenuList) Draw(screen *ebiten.Image) {
 }
 
 var (
-	state           gameState
 	playImage       *ebiten.Image
 	optionsImage    *ebiten.Image
 	quitImage       *ebiten.Image
@@ -246,93 +257,28 @@ func init() {
 	})
 }
 
-func update(screen *ebiten.Image) error {
-
-	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
-
-	if state == titleScreen {
-
-		ebitenutil.DebugPrint(screen, "Title screen")
-		mainMenu.Draw(screen)
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
-			mainMenu.DecrementSelected()
-		}
-		if inpututil.IsKeyJustPressed(ebiten.KeyDown) {
-			mainMenu.IncrementSelected()
-		}
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
-			switch mainMenu.GetSelectedItem() {
-			case "playButton":
-				state = play
-			case "optionButton":
-				state = options
-			case "quitButton":
-				os.Exit(0)
-			}
-			return nil
-		}
-
-	}
-
-	if state == play {
-		ebitenutil.DebugPrint(screen, "Play screen")
-
-		if square == nil {
-			square, _ = ebiten.NewImage(32, 32, ebiten.FilterNearest)
-		}
-		someColor := &color.NRGBA{0x7f, 0xff, 0x00, 0xff}
-		square.Fill(someColor)
-
-		opts := &ebiten.DrawImageOptions{}
-		opts.GeoM.Translate(64.0, 64.0)
-		screen.DrawImage(square, opts)
-
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-			state = titleScreen
-			return nil
-		}
-
-	}
-
-	if state == options {
-		ebitenutil.DebugPrint(screen, "Options screen")
-
-		if square == nil {
-			square, _ = ebiten.NewImage(32, 32, ebiten.FilterNearest)
-		}
-		someColor := &color.NRGBA{0x8a, 0x2b, 0xe2, 0xff}
-		square.Fill(someColor)
+func main() {
 
-		opts := &ebiten.DrawImageOptions{}
-		opts.GeoM.Translate(64.0, 64.0)
-		screen.DrawImage(square, opts)
+	lang := flag.String("lang", locale.Fallback, "language code of the menu text, for example en or ja")
+	flag.Parse()
 
-		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
-			state = titleScreen
-			return nil
-		}
+	if err := locale.SetLanguage(*lang); err != nil {
+		log.Printf("unable to set language: %+v\n", err)
 	}
 
-	return nil
-}
-
-func main() {
-
 	newMenuItems := []MenuItem{
 		{Name: "playButton",
-			Text:     "PLAY",
+			Text:     locale.T("menu.play"),
 			TxtX: 36,
 			TxtY: 25,
 			BgColour: green1},
 		{Name: "optionButton",
-			Text:     "OPTIONS",
+			Text:     locale.T("menu.options"),
 			TxtX: 12,
 			TxtY: 25,
 			BgColour: green2},
 		{Name: "quitButton",
-			Text:     "QUIT",
+			Text:     locale.T("menu.quit"),
 			TxtX: 36,
 			TxtY: 25,
 			BgColour: green3},
@@ -355,9 +301,14 @@ func main() {
 
 	mainMenu = newMenu
 
-	state = titleScreen
+	scenes := scene.NewManager(&titleScene{})
+
+	update := func(screen *ebiten.Image) error {
+		input.Update()
+		return scenes.Update(screen)
+	}
 
-	if err := ebiten.Run(update, 400, 300, 2, "State!"); err != nil {
+	if err := ebiten.Run(update, 400, 300, 2, "State!"); err != nil && err != scene.ErrQuit {
 		panic(err)
 	}
 }
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/Rosalita/my-ebiten-examples/input"
+	"github.com/Rosalita/my-ebiten-examples/scene"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil" // required for debug text
+)
+
+// titleScene shows the main menu
+type titleScene struct{}
+
+func (s *titleScene) Enter() {}
+
+func (s *titleScene) Exit() {}
+
+func (s *titleScene) Update() (scene.Transition, error) {
+
+	if input.JustPressed(input.MenuUp) {
+		mainMenu.DecrementSelected()
+	}
+	if input.JustPressed(input.MenuDown) {
+		mainMenu.IncrementSelected()
+	}
+
+	// the item under the cursor is selected when the mouse moves, and clicking
+	// an item is the same as confirming it
+	clicked := false
+	if i, ok := mainMenu.ItemAt(input.Cursor().X, input.Cursor().Y); ok {
+		if input.CursorMoved() || input.Clicked() {
+			mainMenu.SetSelected(i)
+		}
+		clicked = input.Clicked()
+	}
+
+	if input.JustPressed(input.Confirm) || clicked {
+		switch mainMenu.GetSelectedItem() {
+		case "playButton":
+			return scene.Push(&playScene{}), nil
+		case "optionButton":
+			return scene.Push(&optionsScene{}), nil
+		case "quitButton":
+			return scene.Quit(), nil
+		}
+	}
+
+	return scene.None(), nil
+}
+
+func (s *titleScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	ebitenutil.DebugPrint(screen, "Title screen")
+	mainMenu.Draw(screen)
+}
+
+// playScene draws a green square
+type playScene struct{}
+
+func (s *playScene) Enter() {
+	if square == nil {
+		square, _ = ebiten.NewImage(32, 32, ebiten.FilterNearest)
+	}
+}
+
+func (s *playScene) Exit() {}
+
+func (s *playScene) Update() (scene.Transition, error) {
+	if input.JustPressed(input.Back) {
+		return scene.Pop(), nil
+	}
+	return scene.None(), nil
+}
+
+func (s *playScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	ebitenutil.DebugPrint(screen, "Play screen")
+
+	someColor := &color.NRGBA{0x7f, 0xff, 0x00, 0xff}
+	square.Fill(someColor)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(64.0, 64.0)
+	screen.DrawImage(square, opts)
+}
+
+// optionsScene draws a purple square, it is opened over the title screen
+type optionsScene struct{}
+
+func (s *optionsScene) Enter() {
+	if square == nil {
+		square, _ = ebiten.NewImage(32, 32, ebiten.FilterNearest)
+	}
+}
+
+func (s *optionsScene) Exit() {}
+
+func (s *optionsScene) Update() (scene.Transition, error) {
+	if input.JustPressed(input.Back) {
+		return scene.Pop(), nil
+	}
+	return scene.None(), nil
+}
+
+func (s *optionsScene) Draw(screen *ebiten.Image) {
+	screen.Fill(color.NRGBA{0x00, 0x00, 0x00, 0xff})
+
+	ebitenutil.DebugPrint(screen, "Options screen")
+
+	someColor := &color.NRGBA{0x8a, 0x2b, 0xe2, 0xff}
+	square.Fill(someColor)
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(64.0, 64.0)
+	screen.DrawImage(square, opts)
+}
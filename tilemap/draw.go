@@ -0,0 +1,95 @@
+package tilemap
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// Viewport decides where the map is drawn on screen, *camera.Camera is a
+// Viewport
+type Viewport interface {
+	View() image.Rectangle // area of the map on screen, in pixels
+	GeoM() ebiten.GeoM     // transform from map pixels to the screen
+}
+
+// Draw draws every visible layer, only tiles inside the viewport are drawn
+func (m *Map) Draw(screen *ebiten.Image, vp Viewport) {
+	for i, l := range m.Layers {
+		if l.Visible {
+			m.DrawLayer(screen, i, vp)
+		}
+	}
+}
+
+// DrawLayer draws one layer, see Draw
+func (m *Map) DrawLayer(screen *ebiten.Image, layer int, vp Viewport) {
+	l := m.Layers[layer]
+	view, geom := vp.View(), vp.GeoM()
+
+	// tiles taller or wider than the map's tiles stick up and to the right
+	// of their cell, so cells just outside the view may still be seen
+	extraX, extraY := 0, 0
+	for _, ts := range m.Tilesets {
+		extraX = max(extraX, (ts.TileWidth-1)/m.TileWidth)
+		extraY = max(extraY, (ts.TileHeight-1)/m.TileHeight)
+	}
+
+	x0 := max(floorDiv(view.Min.X, m.TileWidth)-extraX, 0)
+	y0 := max(floorDiv(view.Min.Y, m.TileHeight), 0)
+	x1 := min(floorDiv(view.Max.X-1, m.TileWidth)+1, m.Width)
+	y1 := min(floorDiv(view.Max.Y-1, m.TileHeight)+1+extraY, m.Height)
+
+	for ty := y0; ty < y1; ty++ {
+		for tx := x0; tx < x1; tx++ {
+			gid := l.tiles[ty*m.Width+tx]
+			if gid == 0 {
+				continue
+			}
+			ts := m.tileset(gid &^ flipMask)
+			img := ts.tileImage(int(gid&^flipMask - ts.FirstGID))
+
+			opts := &ebiten.DrawImageOptions{}
+			flip(&opts.GeoM, gid, ts.TileWidth, ts.TileHeight)
+			// tiles are anchored to the bottom left of their cell
+			x := tx * m.TileWidth
+			y := (ty+1)*m.TileHeight - ts.TileHeight
+			opts.GeoM.Translate(float64(x), float64(y))
+			opts.GeoM.Concat(geom)
+			if l.Opacity < 1 {
+				opts.ColorM.Scale(1, 1, 1, l.Opacity)
+			}
+			screen.DrawImage(img, opts)
+		}
+	}
+}
+
+// flip applies a tile's flip flags, the tile stays in the same w x h cell
+func flip(g *ebiten.GeoM, gid uint32, w, h int) {
+	fw, fh := float64(w), float64(h)
+	if gid&flipD != 0 {
+		// flipping diagonally swaps x and y
+		g.Rotate(-math.Pi / 2)
+		g.Scale(1, -1)
+		fw, fh = fh, fw
+	}
+	if gid&flipH != 0 {
+		g.Scale(-1, 1)
+		g.Translate(fw, 0)
+	}
+	if gid&flipV != 0 {
+		g.Scale(1, -1)
+		g.Translate(0, fh)
+	}
+}
+
+// floorDiv divides rounding towards negative infinity, so views which start
+// left of or above the map work
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
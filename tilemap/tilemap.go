@@ -0,0 +1,508 @@
+// Package tilemap loads orthogonal maps saved by the Tiled map editor in its
+// JSON format (.tmj maps and .tsj tilesets) and draws them with Ebiten.
+//
+// Custom properties set on tiles in Tiled are exposed to game logic, the
+// properties named by the Prop constants have helpers on Map.
+package tilemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/png" // required to decode png tileset images
+	"io"
+	"io/fs"
+	"path"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+// tile properties understood by Map's helpers
+const (
+	PropWalkable  = "walkable"  // bool, false for tiles which block movement
+	PropEncounter = "encounter" // string, name of the encounter zone the tile is in
+	PropDoor      = "door"      // string, where the door leads
+)
+
+// gid flags Tiled stores in the top bits of a tile to flip it
+const (
+	flipH    = 0x80000000
+	flipV    = 0x40000000
+	flipD    = 0x20000000
+	flipMask = flipH | flipV | flipD
+)
+
+// Map is a map loaded from a Tiled JSON file
+type Map struct {
+	Width      int // in tiles
+	Height     int // in tiles
+	TileWidth  int
+	TileHeight int
+	Properties Properties
+	Layers     []*Layer
+	Tilesets   []*Tileset
+	Objects    []Object // objects from every object layer
+}
+
+// Layer is a tile layer, tiles are global tile IDs in rows from the top left
+type Layer struct {
+	Name       string
+	Visible    bool
+	Opacity    float64
+	Properties Properties
+	tiles      []uint32
+}
+
+// Object is a shape placed on an object layer, positions are in pixels
+type Object struct {
+	ID         int
+	Name       string
+	Type       string
+	Layer      string // name of the object layer
+	X, Y       float64
+	Width      float64
+	Height     float64
+	Properties Properties
+}
+
+// Tileset is a single image cut into equal sized tiles
+type Tileset struct {
+	Name       string
+	FirstGID   uint32
+	TileWidth  int
+	TileHeight int
+	TileCount  int
+	Columns    int
+	Margin     int
+	Spacing    int
+	image      *ebiten.Image
+	tiles      []*ebiten.Image    // sub images, made on first use
+	properties map[int]Properties // properties by local tile ID
+}
+
+// Tile is a tile of a layer
+type Tile struct {
+	ID         int // local ID within the tileset
+	Tileset    *Tileset
+	Properties Properties
+}
+
+// Properties are the custom properties of a map, layer, tile or object
+type Properties map[string]interface{}
+
+// Bool returns a bool property, ok is false if it is missing or another type
+func (p Properties) Bool(name string) (value, ok bool) {
+	value, ok = p[name].(bool)
+	return value, ok
+}
+
+// String returns a string property, it is empty if missing
+func (p Properties) String(name string) string {
+	s, _ := p[name].(string)
+	return s
+}
+
+// Int returns an int property, it is 0 if missing
+func (p Properties) Int(name string) int {
+	f, _ := p[name].(float64)
+	return int(f)
+}
+
+// jsonProperty is a property as Tiled writes it
+type jsonProperty struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+func properties(list []jsonProperty) Properties {
+	p := Properties{}
+	for _, jp := range list {
+		p[jp.Name] = jp.Value
+	}
+	return p
+}
+
+type jsonMap struct {
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	TileWidth   int            `json:"tilewidth"`
+	TileHeight  int            `json:"tileheight"`
+	Orientation string         `json:"orientation"`
+	Infinite    bool           `json:"infinite"`
+	Properties  []jsonProperty `json:"properties"`
+	Layers      []jsonLayer    `json:"layers"`
+	Tilesets    []jsonTileset  `json:"tilesets"`
+}
+
+type jsonLayer struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Width       int             `json:"width"`
+	Height      int             `json:"height"`
+	Visible     bool            `json:"visible"`
+	Opacity     float64         `json:"opacity"`
+	Properties  []jsonProperty  `json:"properties"`
+	Data        json.RawMessage `json:"data"`
+	Encoding    string          `json:"encoding"`
+	Compression string          `json:"compression"`
+	Objects     []jsonObject    `json:"objects"`
+	Layers      []jsonLayer     `json:"layers"`
+}
+
+type jsonObject struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Class      string         `json:"class"` // replaces type from Tiled 1.9
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	Width      float64        `json:"width"`
+	Height     float64        `json:"height"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+type jsonTileset struct {
+	FirstGID   uint32         `json:"firstgid"`
+	Source     string         `json:"source"` // set when the tileset is in its own file
+	Name       string         `json:"name"`
+	TileWidth  int            `json:"tilewidth"`
+	TileHeight int            `json:"tileheight"`
+	TileCount  int            `json:"tilecount"`
+	Columns    int            `json:"columns"`
+	Margin     int            `json:"margin"`
+	Spacing    int            `json:"spacing"`
+	Image      string         `json:"image"`
+	Tiles      []jsonTileInfo `json:"tiles"`
+}
+
+type jsonTileInfo struct {
+	ID         int            `json:"id"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+// Load reads a map from fsys. Tilesets and their images are read relative to
+// the map file, the same way Tiled stores them
+func Load(fsys fs.FS, file string) (*Map, error) {
+	var jm jsonMap
+	if err := readJSON(fsys, file, &jm); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case jm.Orientation != "orthogonal":
+		return nil, fmt.Errorf("%s: %s maps are not supported, only orthogonal", file, jm.Orientation)
+	case jm.Infinite:
+		return nil, fmt.Errorf("%s: infinite maps are not supported", file)
+	case jm.Width <= 0 || jm.Height <= 0 || jm.TileWidth <= 0 || jm.TileHeight <= 0:
+		return nil, fmt.Errorf("%s: map and tile sizes must be more than 0", file)
+	}
+
+	m := &Map{
+		Width:      jm.Width,
+		Height:     jm.Height,
+		TileWidth:  jm.TileWidth,
+		TileHeight: jm.TileHeight,
+		Properties: properties(jm.Properties),
+	}
+
+	dir := path.Dir(file)
+	for _, jt := range jm.Tilesets {
+		ts, err := loadTileset(fsys, dir, jt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		m.Tilesets = append(m.Tilesets, ts)
+	}
+
+	if err := m.addLayers(jm.Layers); err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return m, nil
+}
+
+// addLayers adds tile and object layers, the layers of groups are added in
+// place of the group
+func (m *Map) addLayers(layers []jsonLayer) error {
+	for _, jl := range layers {
+		switch jl.Type {
+		case "tilelayer":
+			tiles, err := decodeTiles(jl)
+			if err != nil {
+				return fmt.Errorf("layer %q: %v", jl.Name, err)
+			}
+			if jl.Width != m.Width || jl.Height != m.Height || len(tiles) != m.Width*m.Height {
+				return fmt.Errorf("layer %q: is not the same size as the map", jl.Name)
+			}
+			for _, gid := range tiles {
+				if gid != 0 && m.tileset(gid&^flipMask) == nil {
+					return fmt.Errorf("layer %q: tile %d is not in any tileset", jl.Name, gid&^flipMask)
+				}
+			}
+			m.Layers = append(m.Layers, &Layer{
+				Name:       jl.Name,
+				Visible:    jl.Visible,
+				Opacity:    jl.Opacity,
+				Properties: properties(jl.Properties),
+				tiles:      tiles,
+			})
+
+		case "objectgroup":
+			for _, jo := range jl.Objects {
+				typ := jo.Type
+				if typ == "" {
+					typ = jo.Class
+				}
+				m.Objects = append(m.Objects, Object{
+					ID:         jo.ID,
+					Name:       jo.Name,
+					Type:       typ,
+					Layer:      jl.Name,
+					X:          jo.X,
+					Y:          jo.Y,
+					Width:      jo.Width,
+					Height:     jo.Height,
+					Properties: properties(jo.Properties),
+				})
+			}
+
+		case "group":
+			if err := m.addLayers(jl.Layers); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("layer %q: %s layers are not supported", jl.Name, jl.Type)
+		}
+	}
+	return nil
+}
+
+// decodeTiles reads the tiles of a layer, which Tiled writes either as a JSON
+// array or as base64, optionally compressed
+func decodeTiles(jl jsonLayer) ([]uint32, error) {
+	if jl.Encoding == "" || jl.Encoding == "csv" {
+		var tiles []uint32
+		err := json.Unmarshal(jl.Data, &tiles)
+		return tiles, err
+	}
+	if jl.Encoding != "base64" {
+		return nil, fmt.Errorf("unknown encoding %q", jl.Encoding)
+	}
+
+	var s string
+	if err := json.Unmarshal(jl.Data, &s); err != nil {
+		return nil, err
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var r io.Reader = bytes.NewReader(b)
+	switch jl.Compression {
+	case "":
+	case "zlib":
+		if r, err = zlib.NewReader(r); err != nil {
+			return nil, err
+		}
+	case "gzip":
+		if r, err = gzip.NewReader(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", jl.Compression)
+	}
+	if b, err = io.ReadAll(r); err != nil {
+		return nil, err
+	}
+
+	tiles := make([]uint32, len(b)/4)
+	for i := range tiles {
+		tiles[i] = binary.LittleEndian.Uint32(b[i*4:])
+	}
+	return tiles, nil
+}
+
+// loadTileset reads a tileset, either from the map or from its own file
+func loadTileset(fsys fs.FS, dir string, jt jsonTileset) (*Tileset, error) {
+	firstGID := jt.FirstGID
+	if jt.Source != "" {
+		file := path.Join(dir, jt.Source)
+		if err := readJSON(fsys, file, &jt); err != nil {
+			return nil, err
+		}
+		dir = path.Dir(file)
+	}
+
+	if jt.Image == "" {
+		return nil, fmt.Errorf("tileset %q: only tilesets made from a single image are supported", jt.Name)
+	}
+	if jt.TileWidth <= 0 || jt.TileHeight <= 0 || jt.Columns <= 0 {
+		return nil, fmt.Errorf("tileset %q: tile size and columns must be more than 0", jt.Name)
+	}
+
+	f, err := fsys.Open(path.Join(dir, jt.Image))
+	if err != nil {
+		return nil, fmt.Errorf("tileset %q: %v", jt.Name, err)
+	}
+	defer f.Close()
+	src, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("tileset %q: unable to decode %s: %v", jt.Name, jt.Image, err)
+	}
+	img, err := ebiten.NewImageFromImage(src, ebiten.FilterDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &Tileset{
+		Name:       jt.Name,
+		FirstGID:   firstGID,
+		TileWidth:  jt.TileWidth,
+		TileHeight: jt.TileHeight,
+		TileCount:  jt.TileCount,
+		Columns:    jt.Columns,
+		Margin:     jt.Margin,
+		Spacing:    jt.Spacing,
+		image:      img,
+		tiles:      make([]*ebiten.Image, jt.TileCount),
+		properties: map[int]Properties{},
+	}
+	for _, t := range jt.Tiles {
+		ts.properties[t.ID] = properties(t.Properties)
+	}
+	return ts, nil
+}
+
+func readJSON(fsys fs.FS, file string, v interface{}) error {
+	b, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %v", file, err)
+	}
+	return nil
+}
+
+// tileset returns the tileset a global tile ID belongs to
+func (m *Map) tileset(gid uint32) *Tileset {
+	var found *Tileset
+	for _, ts := range m.Tilesets {
+		if ts.FirstGID <= gid && (found == nil || ts.FirstGID > found.FirstGID) {
+			found = ts
+		}
+	}
+	if found == nil || int(gid-found.FirstGID) >= found.TileCount {
+		return nil
+	}
+	return found
+}
+
+// tileImage returns the part of the tileset image for a local tile ID
+func (ts *Tileset) tileImage(id int) *ebiten.Image {
+	if ts.tiles[id] == nil {
+		x := ts.Margin + id%ts.Columns*(ts.TileWidth+ts.Spacing)
+		y := ts.Margin + id/ts.Columns*(ts.TileHeight+ts.Spacing)
+		ts.tiles[id] = ts.image.SubImage(image.Rect(x, y, x+ts.TileWidth, y+ts.TileHeight)).(*ebiten.Image)
+	}
+	return ts.tiles[id]
+}
+
+// InBounds reports whether a tile position is on the map
+func (m *Map) InBounds(tx, ty int) bool {
+	return tx >= 0 && ty >= 0 && tx < m.Width && ty < m.Height
+}
+
+// Tile returns the tile of a layer at a tile position, ok is false if the
+// position is empty or off the map
+func (m *Map) Tile(layer, tx, ty int) (t Tile, ok bool) {
+	if layer < 0 || layer >= len(m.Layers) || !m.InBounds(tx, ty) {
+		return Tile{}, false
+	}
+	gid := m.Layers[layer].tiles[ty*m.Width+tx] &^ flipMask
+	if gid == 0 {
+		return Tile{}, false
+	}
+	ts := m.tileset(gid)
+	id := int(gid - ts.FirstGID)
+	return Tile{ID: id, Tileset: ts, Properties: ts.properties[id]}, true
+}
+
+// Property returns a property from the topmost tile at a tile position which
+// has it, ok is false if no tile there has the property
+func (m *Map) Property(tx, ty int, name string) (value interface{}, ok bool) {
+	for i := len(m.Layers) - 1; i >= 0; i-- {
+		t, ok := m.Tile(i, tx, ty)
+		if !ok {
+			continue
+		}
+		if v, ok := t.Properties[name]; ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Walkable reports whether a tile position can be walked on. The topmost
+// tile with a walkable property decides, so a bridge can be laid over water.
+// Positions off the map are never walkable
+func (m *Map) Walkable(tx, ty int) bool {
+	if !m.InBounds(tx, ty) {
+		return false
+	}
+	v, ok := m.Property(tx, ty, PropWalkable)
+	if !ok {
+		return true
+	}
+	walkable, _ := v.(bool)
+	return walkable
+}
+
+// Encounter returns the name of the encounter zone at a tile position, it is
+// empty if encounters can't happen there
+func (m *Map) Encounter(tx, ty int) string {
+	v, _ := m.Property(tx, ty, PropEncounter)
+	s, _ := v.(string)
+	return s
+}
+
+// Door returns where the door at a tile position leads, it is empty if there
+// is no door
+func (m *Map) Door(tx, ty int) string {
+	v, _ := m.Property(tx, ty, PropDoor)
+	s, _ := v.(string)
+	return s
+}
+
+// Object returns the first object with a name
+func (m *Map) Object(name string) (Object, bool) {
+	for _, o := range m.Objects {
+		if o.Name == name {
+			return o, true
+		}
+	}
+	return Object{}, false
+}
+
+// ObjectsOfType returns every object with a type
+func (m *Map) ObjectsOfType(typ string) []Object {
+	found := []Object{}
+	for _, o := range m.Objects {
+		if o.Type == typ {
+			found = append(found, o)
+		}
+	}
+	return found
+}
+
+// PixelSize returns the size of the whole map in pixels
+func (m *Map) PixelSize() (w, h int) {
+	return m.Width * m.TileWidth, m.Height * m.TileHeight
+}
@@ -0,0 +1,198 @@
+package tilemap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"slices"
+	"testing"
+)
+
+// the layers below are 3 x 2 tiles, the top left tile is flipped
+var testTiles = []uint32{1 | flipH, 2, 0, 3, 0, 4}
+
+// encode writes tiles the way Tiled does with base64 encoding
+func encode(t *testing.T, compression string) json.RawMessage {
+	t.Helper()
+	raw := make([]byte, len(testTiles)*4)
+	for i, gid := range testTiles {
+		binary.LittleEndian.PutUint32(raw[i*4:], gid)
+	}
+
+	var b bytes.Buffer
+	var w io.WriteCloser
+	switch compression {
+	case "zlib":
+		w = zlib.NewWriter(&b)
+	case "gzip":
+		w = gzip.NewWriter(&b)
+	}
+	if w != nil {
+		w.Write(raw)
+		w.Close()
+		raw = b.Bytes()
+	}
+
+	s, _ := json.Marshal(base64.StdEncoding.EncodeToString(raw))
+	return s
+}
+
+func TestDecodeTiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		layer jsonLayer
+	}{
+		{name: "array", layer: jsonLayer{Data: json.RawMessage(`[2147483649, 2, 0, 3, 0, 4]`)}},
+		{name: "csv", layer: jsonLayer{Encoding: "csv", Data: json.RawMessage(`[2147483649, 2, 0, 3, 0, 4]`)}},
+		{name: "base64", layer: jsonLayer{Encoding: "base64", Data: encode(t, "")}},
+		{name: "zlib", layer: jsonLayer{Encoding: "base64", Compression: "zlib", Data: encode(t, "zlib")}},
+		{name: "gzip", layer: jsonLayer{Encoding: "base64", Compression: "gzip", Data: encode(t, "gzip")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tiles, err := decodeTiles(tt.layer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(tiles, testTiles) {
+				t.Errorf("decoded %v, want %v", tiles, testTiles)
+			}
+		})
+	}
+}
+
+func TestDecodeTilesRejectsUnknownFormats(t *testing.T) {
+	for _, l := range []jsonLayer{
+		{Encoding: "hex", Data: json.RawMessage(`"00"`)},
+		{Encoding: "base64", Compression: "zstd", Data: encode(t, "")},
+		{Encoding: "base64", Data: json.RawMessage(`"not base64!"`)},
+	} {
+		if _, err := decodeTiles(l); err == nil {
+			t.Errorf("a layer encoded as %q compressed as %q was decoded", l.Encoding, l.Compression)
+		}
+	}
+}
+
+// testMap returns a map the size of the test layers with a tileset of 4 tiles
+// in which tile 2 (local ID 1) can't be walked on and tile 4 (local ID 3) can
+func testMap() *Map {
+	return &Map{
+		Width: 3, Height: 2, TileWidth: 16, TileHeight: 16,
+		Tilesets: []*Tileset{{
+			Name: "test", FirstGID: 1, TileCount: 4,
+			properties: map[int]Properties{
+				1: {PropWalkable: false},
+				3: {PropWalkable: true, PropDoor: "house"},
+			},
+		}},
+	}
+}
+
+func TestAddLayers(t *testing.T) {
+	var layers []jsonLayer
+	err := json.Unmarshal([]byte(`[
+		{"name": "ground", "type": "tilelayer", "width": 3, "height": 2, "visible": true, "opacity": 1,
+			"data": [2147483649, 2, 0, 3, 0, 4]},
+		{"name": "group", "type": "group", "layers": [
+			{"name": "over", "type": "tilelayer", "width": 3, "height": 2, "visible": false,
+				"encoding": "base64", "compression": "zlib", "data": `+string(encode(t, "zlib"))+`},
+			{"name": "things", "type": "objectgroup", "objects": [
+				{"id": 1, "name": "start", "type": "spawn", "x": 8, "y": 24},
+				{"id": 2, "name": "villager", "class": "npc", "x": 40, "y": 8,
+					"properties": [{"name": "dialogue", "type": "string", "value": "villager"}]}
+			]}
+		]}
+	]`), &layers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := testMap()
+	if err := m.addLayers(layers); err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	for _, l := range m.Layers {
+		names = append(names, l.Name)
+	}
+	if !slices.Equal(names, []string{"ground", "over"}) {
+		t.Errorf("tile layers are %v, want ground then the grouped over layer", names)
+	}
+	if !m.Layers[0].Visible || m.Layers[1].Visible {
+		t.Error("layer visibility was not read")
+	}
+
+	if tile, ok := m.Tile(0, 0, 0); !ok || tile.ID != 0 {
+		t.Errorf("the flipped tile at 0, 0 is %+v, want local ID 0", tile)
+	}
+	if _, ok := m.Tile(0, 2, 0); ok {
+		t.Error("the empty tile at 2, 0 has a tile")
+	}
+
+	if start, ok := m.Object("start"); !ok || start.Type != "spawn" || start.Layer != "things" || start.X != 8 || start.Y != 24 {
+		t.Errorf("start object is %+v", start)
+	}
+	// Tiled 1.9 and later save the type as class
+	npcs := m.ObjectsOfType("npc")
+	if len(npcs) != 1 || npcs[0].Properties.String("dialogue") != "villager" {
+		t.Errorf("npc objects are %+v, want the villager with its dialogue", npcs)
+	}
+}
+
+func TestAddLayersErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		layer string
+	}{
+		{name: "wrong size", layer: `{"name": "l", "type": "tilelayer", "width": 2, "height": 2, "data": [1, 1, 1, 1]}`},
+		{name: "short data", layer: `{"name": "l", "type": "tilelayer", "width": 3, "height": 2, "data": [1, 1, 1]}`},
+		{name: "unknown tile", layer: `{"name": "l", "type": "tilelayer", "width": 3, "height": 2, "data": [1, 1, 1, 1, 1, 5]}`},
+		{name: "unsupported type", layer: `{"name": "l", "type": "imagelayer"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l jsonLayer
+			if err := json.Unmarshal([]byte(tt.layer), &l); err != nil {
+				t.Fatal(err)
+			}
+			if err := testMap().addLayers([]jsonLayer{l}); err == nil {
+				t.Error("the layer was added")
+			}
+		})
+	}
+}
+
+func TestWalkableTopmostTileDecides(t *testing.T) {
+	m := testMap()
+	m.Layers = []*Layer{
+		{Name: "ground", tiles: []uint32{2, 2, 1, 0, 0, 0}},
+		{Name: "bridge", tiles: []uint32{4, 0, 0, 0, 0, 0}},
+	}
+
+	tests := []struct {
+		tx, ty int
+		want   bool
+	}{
+		{0, 0, true},   // a walkable bridge over a wall
+		{1, 0, false},  // a wall
+		{2, 0, true},   // a tile without the property
+		{0, 1, true},   // no tile at all
+		{-1, 0, false}, // off the map
+		{0, 2, false},
+	}
+	for _, tt := range tests {
+		if got := m.Walkable(tt.tx, tt.ty); got != tt.want {
+			t.Errorf("Walkable(%d, %d) is %v, want %v", tt.tx, tt.ty, got, tt.want)
+		}
+	}
+	if door := m.Door(0, 0); door != "house" {
+		t.Errorf("door at 0, 0 leads to %q, want %q", door, "house")
+	}
+}